@@ -22,6 +22,15 @@ export function badgeColors(
   return (v: string) => map[v?.toUpperCase()] ?? fallback
 }
 
+/** Badge colors for gold finding triage status */
+export const findingStatusBadge = badgeColors({
+  OPEN: badge.red,
+  ACKNOWLEDGED: badge.amber,
+  SUPPRESSED: badge.purple,
+  FALSE_POSITIVE: badge.zinc,
+  RESOLVED: badge.emerald,
+})
+
 /** Shorten GCP service account email: strip .iam.gserviceaccount.com domain */
 export function shortEmail(v: string): string {
   if (!v) return ''
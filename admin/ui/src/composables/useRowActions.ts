@@ -0,0 +1,31 @@
+import { computed } from 'vue'
+import { useUIConfig, type NavAction, type NavItem } from '@/composables/useUIConfig'
+
+function find(items: readonly NavItem[], api: string): NavAction[] {
+  for (const item of items) {
+    if (item.api === api) return item.actions ?? []
+    if (item.children) {
+      const found = find(item.children, api)
+      if (found.length) return found
+    }
+  }
+  return []
+}
+
+/** Row actions registered for a list API (empty for read-only lists). */
+export function useRowActions(api: string | (() => string)) {
+  const { config } = useUIConfig()
+  return computed(() => find(config.value.nav as NavItem[], typeof api === 'function' ? api() : api))
+}
+
+/** Call a row action for the given resource_id and return the updated row. */
+export async function runRowAction(action: NavAction, id: string, body?: Record<string, any>) {
+  const res = await window.fetch(action.path.replace('{id}', encodeURIComponent(id)), {
+    method: action.method,
+    headers: { 'Content-Type': 'application/json' },
+    body: JSON.stringify(body ?? {}),
+  })
+  const json = await res.json().catch(() => null)
+  if (!res.ok) throw new Error(json?.error?.message || `HTTP ${res.status}`)
+  return json.data as Record<string, any>
+}
@@ -1,11 +1,20 @@
 import { ref, readonly } from 'vue'
 import router, { registerNavRoutes } from '@/router'
 
+/** A row action (write endpoint) offered on a list page. `path` contains `{id}`. */
+export interface NavAction {
+  name: string
+  label: string
+  method: string
+  path: string
+}
+
 export interface NavItem {
   label: string
   icon?: string
   path?: string
   api?: string
+  actions?: NavAction[]
   children?: NavItem[]
 }
 
@@ -77,7 +86,11 @@ export async function loadUIConfig() {
         loaded.value = true
         return
       }
-      addStatus(`Server returned ${res.status}. Retrying in 3s...`)
+      if (res.status === 401 || res.status === 403) {
+        addStatus('Not signed in or not allowed. Check the admin auth setup. Retrying in 3s...')
+      } else {
+        addStatus(`Server returned ${res.status}. Retrying in 3s...`)
+      }
     } catch {
       addStatus('Cannot reach server. Retrying in 3s...')
     }
@@ -12,7 +12,7 @@ import (
 	"danny.vn/hotpot/pkg/base/logger"
 	entapicatalog "danny.vn/hotpot/pkg/storage/ent/apicatalog"
 
-	// CSV import logic is inlined here to avoid Temporal dependency.
+	// Import activities are called directly to avoid a Temporal dependency.
 	"danny.vn/hotpot/pkg/ingest/apicatalog"
 )
 
@@ -20,11 +20,15 @@ func main() {
 	slog.SetDefault(logger.New(slog.LevelInfo))
 
 	filePath := flag.String("file", "", "path to CSV file")
+	openAPIPath := flag.String("openapi", "", "path to an OpenAPI/Swagger spec or a directory of specs")
+	specName := flag.String("name", "", "spec name, overrides info.title (single spec only)")
+	dryRun := flag.Bool("dry-run", false, "report added and removed endpoints without writing")
 	logSourceID := flag.String("log-source-id", "", "optional log source ID")
 	flag.Parse()
 
-	if *filePath == "" {
-		fmt.Fprintln(os.Stderr, "Usage: import-api-endpoints -file <csv-path> [-log-source-id <id>]")
+	if (*filePath == "") == (*openAPIPath == "") {
+		fmt.Fprintln(os.Stderr, "Usage: import-apicatalog -file <csv-path> [-log-source-id <id>]")
+		fmt.Fprintln(os.Stderr, "       import-apicatalog -openapi <spec-or-dir> [-name <spec>] [-dry-run] [-log-source-id <id>]")
 		os.Exit(1)
 	}
 
@@ -51,6 +55,16 @@ func main() {
 	activities := apicatalog.NewActivities(application.ConfigService(), entClient)
 
 	start := time.Now()
+	if *openAPIPath != "" {
+		importOpenAPI(ctx, activities, apicatalog.ImportOpenAPIParams{
+			Path:        *openAPIPath,
+			SpecName:    *specName,
+			LogSourceID: *logSourceID,
+			DryRun:      *dryRun,
+		}, start)
+		return
+	}
+
 	result, err := activities.ImportCSV(ctx, apicatalog.ImportCSVParams{
 		FilePath:    *filePath,
 		LogSourceID: *logSourceID,
@@ -65,3 +79,26 @@ func main() {
 		"updated", result.Updated,
 		"duration", time.Since(start).Round(time.Millisecond))
 }
+
+func importOpenAPI(ctx context.Context, activities *apicatalog.Activities, params apicatalog.ImportOpenAPIParams, start time.Time) {
+	result, err := activities.ImportOpenAPI(ctx, params)
+	if err != nil {
+		slog.Error("Import failed", "error", err)
+		os.Exit(1)
+	}
+
+	for _, c := range result.Added {
+		fmt.Printf("+ %s %s %s\n", c.SpecName, c.Method, c.URI)
+	}
+	for _, c := range result.Removed {
+		fmt.Printf("- %s %s %s\n", c.SpecName, c.Method, c.URI)
+	}
+
+	slog.Info("Import complete",
+		"specs", result.Specs,
+		"created", result.Created,
+		"updated", result.Updated,
+		"deleted", result.Deleted,
+		"dryRun", params.DryRun,
+		"duration", time.Since(start).Round(time.Millisecond))
+}
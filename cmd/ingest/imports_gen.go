@@ -4,7 +4,9 @@ package main
 
 import (
 	_ "danny.vn/hotpot/pkg/ingest/accesslog"
+	_ "danny.vn/hotpot/pkg/ingest/accesslog/filelog"
 	_ "danny.vn/hotpot/pkg/ingest/accesslog/gcplogging"
+	_ "danny.vn/hotpot/pkg/ingest/accesslog/httppush"
 	_ "danny.vn/hotpot/pkg/ingest/gcp"
 	_ "danny.vn/hotpot/pkg/ingest/gcp/compute"
 	_ "danny.vn/hotpot/pkg/ingest/gcp/container"
@@ -15,6 +17,15 @@ import (
 	_ "danny.vn/hotpot/pkg/ingest/greennode/network"
 	_ "danny.vn/hotpot/pkg/ingest/greennode/portal"
 	_ "danny.vn/hotpot/pkg/ingest/greennode/volume"
+	_ "danny.vn/hotpot/pkg/ingest/kubernetes"
+	_ "danny.vn/hotpot/pkg/ingest/kubernetes/image"
+	_ "danny.vn/hotpot/pkg/ingest/kubernetes/namespace"
+	_ "danny.vn/hotpot/pkg/ingest/kubernetes/networkpolicy"
+	_ "danny.vn/hotpot/pkg/ingest/kubernetes/node"
+	_ "danny.vn/hotpot/pkg/ingest/kubernetes/pod"
+	_ "danny.vn/hotpot/pkg/ingest/kubernetes/rolebinding"
+	_ "danny.vn/hotpot/pkg/ingest/kubernetes/serviceaccount"
+	_ "danny.vn/hotpot/pkg/ingest/kubernetes/workload"
 	_ "danny.vn/hotpot/pkg/ingest/meec"
 	_ "danny.vn/hotpot/pkg/ingest/meec/computer"
 	_ "danny.vn/hotpot/pkg/ingest/meec/installed_software"
@@ -22,17 +33,27 @@ import (
 	_ "danny.vn/hotpot/pkg/ingest/reference"
 	_ "danny.vn/hotpot/pkg/ingest/reference/cpe"
 	_ "danny.vn/hotpot/pkg/ingest/reference/eol"
+	_ "danny.vn/hotpot/pkg/ingest/reference/nvd"
+	_ "danny.vn/hotpot/pkg/ingest/reference/osv"
 	_ "danny.vn/hotpot/pkg/ingest/reference/rpm"
+	_ "danny.vn/hotpot/pkg/ingest/reference/threatintel"
 	_ "danny.vn/hotpot/pkg/ingest/reference/ubuntu"
 	_ "danny.vn/hotpot/pkg/ingest/reference/xeol"
 	_ "danny.vn/hotpot/pkg/ingest/sentinelone"
 	_ "danny.vn/hotpot/pkg/ingest/sentinelone/account"
+	_ "danny.vn/hotpot/pkg/ingest/sentinelone/activity"
 	_ "danny.vn/hotpot/pkg/ingest/sentinelone/agent"
+	_ "danny.vn/hotpot/pkg/ingest/sentinelone/alert"
+	_ "danny.vn/hotpot/pkg/ingest/sentinelone/app_cve"
 	_ "danny.vn/hotpot/pkg/ingest/sentinelone/app_inventory"
 	_ "danny.vn/hotpot/pkg/ingest/sentinelone/endpoint_app"
+	_ "danny.vn/hotpot/pkg/ingest/sentinelone/exclusion"
 	_ "danny.vn/hotpot/pkg/ingest/sentinelone/group"
 	_ "danny.vn/hotpot/pkg/ingest/sentinelone/network_discovery"
+	_ "danny.vn/hotpot/pkg/ingest/sentinelone/policy"
+	_ "danny.vn/hotpot/pkg/ingest/sentinelone/restriction"
 	_ "danny.vn/hotpot/pkg/ingest/sentinelone/site"
+	_ "danny.vn/hotpot/pkg/ingest/sentinelone/threat"
 	_ "danny.vn/hotpot/pkg/ingest/vault"
 	_ "danny.vn/hotpot/pkg/ingest/vault/pki"
 )
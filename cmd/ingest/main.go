@@ -12,7 +12,7 @@ import (
 
 //go:generate go run danny.vn/hotpot/tools/ingestgen
 
-var _ = ingest.ProviderSet("gcp", "greennode", "meec", "sentinelone", "vault", "reference", "accesslog")
+var _ = ingest.ProviderSet("gcp", "greennode", "meec", "sentinelone", "vault", "reference", "accesslog", "kubernetes")
 var _ = ingest.DisableServiceSet("greennode", "dns", "glb", "loadbalancer")
 var _ = ingest.DisableServiceSet("gcp",
 	"accesscontextmanager",
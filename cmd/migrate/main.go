@@ -25,13 +25,13 @@ import (
 var _ = migrate.ProviderSet("rule")
 
 // Bronze providers.
-var _ = migrate.ProviderSet("accesslog", "apicatalog", "gcp", "greennode", "meec", "s1", "vault", "reference")
+var _ = migrate.ProviderSet("accesslog", "apicatalog", "gcp", "greennode", "meec", "s1", "vault", "reference", "kubernetes")
 
 // Silver providers.
 var _ = migrate.ProviderSet("inventory", "httptraffic")
 
 // Gold providers.
-var _ = migrate.ProviderSet("lifecycle", "httpmonitor")
+var _ = migrate.ProviderSet("lifecycle", "httpmonitor", "posture", "triage", "notify", "vulnerability")
 
 func main() {
 	seedFlag := flag.Bool("seed", false, "seed config data after migration")
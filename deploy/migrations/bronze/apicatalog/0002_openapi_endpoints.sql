@@ -0,0 +1,24 @@
+-- Create "apicatalog_openapi_endpoints" table
+CREATE TABLE "bronze"."apicatalog_openapi_endpoints" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "spec_name" character varying NOT NULL,
+  "spec_version" character varying NULL,
+  "openapi_version" character varying NULL,
+  "log_source_id" character varying NULL,
+  "uri" character varying NOT NULL,
+  "method" character varying NOT NULL,
+  "operation_id" character varying NULL,
+  "summary" character varying NULL,
+  "tags_json" jsonb NULL,
+  "security_json" jsonb NULL,
+  "access_level" character varying NOT NULL DEFAULT '',
+  "deprecated" boolean NOT NULL DEFAULT false,
+  "source_file" character varying NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeapicatalogopenapiendpoint_spec_name" to table: "apicatalog_openapi_endpoints"
+CREATE INDEX "bronzeapicatalogopenapiendpoint_spec_name" ON "bronze"."apicatalog_openapi_endpoints" ("spec_name");
+-- Create index "bronzeapicatalogopenapiendpoint_uri" to table: "apicatalog_openapi_endpoints"
+CREATE INDEX "bronzeapicatalogopenapiendpoint_uri" ON "bronze"."apicatalog_openapi_endpoints" ("uri");
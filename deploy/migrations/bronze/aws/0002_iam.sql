@@ -0,0 +1,158 @@
+-- Create "aws_iam_access_keys" table
+CREATE TABLE "bronze"."aws_iam_access_keys" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "user_name" character varying NOT NULL,
+  "status" character varying NULL,
+  "create_date" timestamptz NULL,
+  "last_used_date" timestamptz NULL,
+  "last_used_service" character varying NULL,
+  "last_used_region" character varying NULL,
+  "account_id" character varying NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeawsiamaccesskey_account_id" to table: "aws_iam_access_keys"
+CREATE INDEX "bronzeawsiamaccesskey_account_id" ON "bronze"."aws_iam_access_keys" ("account_id");
+-- Create index "bronzeawsiamaccesskey_collected_at" to table: "aws_iam_access_keys"
+CREATE INDEX "bronzeawsiamaccesskey_collected_at" ON "bronze"."aws_iam_access_keys" ("collected_at");
+-- Create index "bronzeawsiamaccesskey_create_date" to table: "aws_iam_access_keys"
+CREATE INDEX "bronzeawsiamaccesskey_create_date" ON "bronze"."aws_iam_access_keys" ("create_date");
+-- Create index "bronzeawsiamaccesskey_status" to table: "aws_iam_access_keys"
+CREATE INDEX "bronzeawsiamaccesskey_status" ON "bronze"."aws_iam_access_keys" ("status");
+-- Create index "bronzeawsiamaccesskey_user_name" to table: "aws_iam_access_keys"
+CREATE INDEX "bronzeawsiamaccesskey_user_name" ON "bronze"."aws_iam_access_keys" ("user_name");
+-- Create "aws_iam_credential_reports" table
+CREATE TABLE "bronze"."aws_iam_credential_reports" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "user" character varying NOT NULL,
+  "user_creation_time" timestamptz NULL,
+  "password_enabled" character varying NULL,
+  "password_last_used" timestamptz NULL,
+  "password_last_changed" timestamptz NULL,
+  "password_next_rotation" timestamptz NULL,
+  "mfa_active" boolean NOT NULL DEFAULT false,
+  "access_key_1_active" boolean NOT NULL DEFAULT false,
+  "access_key_1_last_rotated" timestamptz NULL,
+  "access_key_1_last_used_date" timestamptz NULL,
+  "access_key_1_last_used_region" character varying NULL,
+  "access_key_1_last_used_service" character varying NULL,
+  "access_key_2_active" boolean NOT NULL DEFAULT false,
+  "access_key_2_last_rotated" timestamptz NULL,
+  "access_key_2_last_used_date" timestamptz NULL,
+  "access_key_2_last_used_region" character varying NULL,
+  "access_key_2_last_used_service" character varying NULL,
+  "cert_1_active" boolean NOT NULL DEFAULT false,
+  "cert_1_last_rotated" timestamptz NULL,
+  "cert_2_active" boolean NOT NULL DEFAULT false,
+  "cert_2_last_rotated" timestamptz NULL,
+  "report_generated_at" timestamptz NULL,
+  "account_id" character varying NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeawsiamcredentialreport_account_id" to table: "aws_iam_credential_reports"
+CREATE INDEX "bronzeawsiamcredentialreport_account_id" ON "bronze"."aws_iam_credential_reports" ("account_id");
+-- Create index "bronzeawsiamcredentialreport_collected_at" to table: "aws_iam_credential_reports"
+CREATE INDEX "bronzeawsiamcredentialreport_collected_at" ON "bronze"."aws_iam_credential_reports" ("collected_at");
+-- Create index "bronzeawsiamcredentialreport_user" to table: "aws_iam_credential_reports"
+CREATE INDEX "bronzeawsiamcredentialreport_user" ON "bronze"."aws_iam_credential_reports" ("user");
+-- Create "aws_iam_groups" table
+CREATE TABLE "bronze"."aws_iam_groups" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "arn" character varying NOT NULL,
+  "group_name" character varying NOT NULL,
+  "path" character varying NULL,
+  "create_date" timestamptz NULL,
+  "attached_policies_json" jsonb NULL,
+  "inline_policies_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeawsiamgroup_account_id" to table: "aws_iam_groups"
+CREATE INDEX "bronzeawsiamgroup_account_id" ON "bronze"."aws_iam_groups" ("account_id");
+-- Create index "bronzeawsiamgroup_collected_at" to table: "aws_iam_groups"
+CREATE INDEX "bronzeawsiamgroup_collected_at" ON "bronze"."aws_iam_groups" ("collected_at");
+-- Create index "bronzeawsiamgroup_group_name" to table: "aws_iam_groups"
+CREATE INDEX "bronzeawsiamgroup_group_name" ON "bronze"."aws_iam_groups" ("group_name");
+-- Create "aws_iam_policies" table
+CREATE TABLE "bronze"."aws_iam_policies" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "arn" character varying NOT NULL,
+  "policy_name" character varying NOT NULL,
+  "path" character varying NULL,
+  "description" character varying NULL,
+  "default_version_id" character varying NULL,
+  "attachment_count" bigint NOT NULL DEFAULT 0,
+  "permissions_boundary_usage_count" bigint NOT NULL DEFAULT 0,
+  "is_attachable" boolean NOT NULL DEFAULT false,
+  "aws_managed" boolean NOT NULL DEFAULT false,
+  "create_date" timestamptz NULL,
+  "update_date" timestamptz NULL,
+  "versions_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeawsiampolicy_account_id" to table: "aws_iam_policies"
+CREATE INDEX "bronzeawsiampolicy_account_id" ON "bronze"."aws_iam_policies" ("account_id");
+-- Create index "bronzeawsiampolicy_aws_managed" to table: "aws_iam_policies"
+CREATE INDEX "bronzeawsiampolicy_aws_managed" ON "bronze"."aws_iam_policies" ("aws_managed");
+-- Create index "bronzeawsiampolicy_collected_at" to table: "aws_iam_policies"
+CREATE INDEX "bronzeawsiampolicy_collected_at" ON "bronze"."aws_iam_policies" ("collected_at");
+-- Create index "bronzeawsiampolicy_policy_name" to table: "aws_iam_policies"
+CREATE INDEX "bronzeawsiampolicy_policy_name" ON "bronze"."aws_iam_policies" ("policy_name");
+-- Create "aws_iam_roles" table
+CREATE TABLE "bronze"."aws_iam_roles" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "arn" character varying NOT NULL,
+  "role_name" character varying NOT NULL,
+  "path" character varying NULL,
+  "create_date" timestamptz NULL,
+  "role_last_used_date" timestamptz NULL,
+  "role_last_used_region" character varying NULL,
+  "permissions_boundary_arn" character varying NULL,
+  "assume_role_policy_json" jsonb NULL,
+  "attached_policies_json" jsonb NULL,
+  "inline_policies_json" jsonb NULL,
+  "instance_profiles_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeawsiamrole_account_id" to table: "aws_iam_roles"
+CREATE INDEX "bronzeawsiamrole_account_id" ON "bronze"."aws_iam_roles" ("account_id");
+-- Create index "bronzeawsiamrole_collected_at" to table: "aws_iam_roles"
+CREATE INDEX "bronzeawsiamrole_collected_at" ON "bronze"."aws_iam_roles" ("collected_at");
+-- Create index "bronzeawsiamrole_role_name" to table: "aws_iam_roles"
+CREATE INDEX "bronzeawsiamrole_role_name" ON "bronze"."aws_iam_roles" ("role_name");
+-- Create "aws_iam_users" table
+CREATE TABLE "bronze"."aws_iam_users" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "arn" character varying NOT NULL,
+  "user_name" character varying NOT NULL,
+  "path" character varying NULL,
+  "create_date" timestamptz NULL,
+  "permissions_boundary_arn" character varying NULL,
+  "attached_policies_json" jsonb NULL,
+  "inline_policies_json" jsonb NULL,
+  "groups_json" jsonb NULL,
+  "mfa_devices_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeawsiamuser_account_id" to table: "aws_iam_users"
+CREATE INDEX "bronzeawsiamuser_account_id" ON "bronze"."aws_iam_users" ("account_id");
+-- Create index "bronzeawsiamuser_collected_at" to table: "aws_iam_users"
+CREATE INDEX "bronzeawsiamuser_collected_at" ON "bronze"."aws_iam_users" ("collected_at");
+-- Create index "bronzeawsiamuser_user_name" to table: "aws_iam_users"
+CREATE INDEX "bronzeawsiamuser_user_name" ON "bronze"."aws_iam_users" ("user_name");
@@ -0,0 +1,291 @@
+-- Create "aws_ec2_addresses" table
+CREATE TABLE "bronze"."aws_ec2_addresses" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "name" character varying NULL,
+  "public_ip" character varying NULL,
+  "domain" character varying NULL,
+  "association_id" character varying NULL,
+  "instance_id" character varying NULL,
+  "network_interface_id" character varying NULL,
+  "network_interface_owner_id" character varying NULL,
+  "private_ip_address" character varying NULL,
+  "public_ipv4_pool" character varying NULL,
+  "network_border_group" character varying NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeawsec2address_account_id" to table: "aws_ec2_addresses"
+CREATE INDEX "bronzeawsec2address_account_id" ON "bronze"."aws_ec2_addresses" ("account_id");
+-- Create index "bronzeawsec2address_collected_at" to table: "aws_ec2_addresses"
+CREATE INDEX "bronzeawsec2address_collected_at" ON "bronze"."aws_ec2_addresses" ("collected_at");
+-- Create index "bronzeawsec2address_instance_id" to table: "aws_ec2_addresses"
+CREATE INDEX "bronzeawsec2address_instance_id" ON "bronze"."aws_ec2_addresses" ("instance_id");
+-- Create index "bronzeawsec2address_public_ip" to table: "aws_ec2_addresses"
+CREATE INDEX "bronzeawsec2address_public_ip" ON "bronze"."aws_ec2_addresses" ("public_ip");
+-- Create index "bronzeawsec2address_region" to table: "aws_ec2_addresses"
+CREATE INDEX "bronzeawsec2address_region" ON "bronze"."aws_ec2_addresses" ("region");
+-- Create "aws_ec2_internet_gateways" table
+CREATE TABLE "bronze"."aws_ec2_internet_gateways" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "name" character varying NULL,
+  "vpc_id" character varying NULL,
+  "attachment_state" character varying NULL,
+  "owner_id" character varying NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeawsec2internetgateway_account_id" to table: "aws_ec2_internet_gateways"
+CREATE INDEX "bronzeawsec2internetgateway_account_id" ON "bronze"."aws_ec2_internet_gateways" ("account_id");
+-- Create index "bronzeawsec2internetgateway_collected_at" to table: "aws_ec2_internet_gateways"
+CREATE INDEX "bronzeawsec2internetgateway_collected_at" ON "bronze"."aws_ec2_internet_gateways" ("collected_at");
+-- Create index "bronzeawsec2internetgateway_region" to table: "aws_ec2_internet_gateways"
+CREATE INDEX "bronzeawsec2internetgateway_region" ON "bronze"."aws_ec2_internet_gateways" ("region");
+-- Create index "bronzeawsec2internetgateway_vpc_id" to table: "aws_ec2_internet_gateways"
+CREATE INDEX "bronzeawsec2internetgateway_vpc_id" ON "bronze"."aws_ec2_internet_gateways" ("vpc_id");
+-- Create "aws_ec2_nat_gateways" table
+CREATE TABLE "bronze"."aws_ec2_nat_gateways" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "name" character varying NULL,
+  "vpc_id" character varying NULL,
+  "subnet_id" character varying NULL,
+  "state" character varying NULL,
+  "connectivity_type" character varying NULL,
+  "create_time" timestamptz NULL,
+  "failure_code" character varying NULL,
+  "failure_message" character varying NULL,
+  "addresses_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeawsec2natgateway_account_id" to table: "aws_ec2_nat_gateways"
+CREATE INDEX "bronzeawsec2natgateway_account_id" ON "bronze"."aws_ec2_nat_gateways" ("account_id");
+-- Create index "bronzeawsec2natgateway_collected_at" to table: "aws_ec2_nat_gateways"
+CREATE INDEX "bronzeawsec2natgateway_collected_at" ON "bronze"."aws_ec2_nat_gateways" ("collected_at");
+-- Create index "bronzeawsec2natgateway_region" to table: "aws_ec2_nat_gateways"
+CREATE INDEX "bronzeawsec2natgateway_region" ON "bronze"."aws_ec2_nat_gateways" ("region");
+-- Create index "bronzeawsec2natgateway_state" to table: "aws_ec2_nat_gateways"
+CREATE INDEX "bronzeawsec2natgateway_state" ON "bronze"."aws_ec2_nat_gateways" ("state");
+-- Create index "bronzeawsec2natgateway_vpc_id" to table: "aws_ec2_nat_gateways"
+CREATE INDEX "bronzeawsec2natgateway_vpc_id" ON "bronze"."aws_ec2_nat_gateways" ("vpc_id");
+-- Create "aws_ec2_network_acls" table
+CREATE TABLE "bronze"."aws_ec2_network_acls" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "name" character varying NULL,
+  "vpc_id" character varying NULL,
+  "is_default" boolean NULL,
+  "owner_id" character varying NULL,
+  "entries_json" jsonb NULL,
+  "associations_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeawsec2networkacl_account_id" to table: "aws_ec2_network_acls"
+CREATE INDEX "bronzeawsec2networkacl_account_id" ON "bronze"."aws_ec2_network_acls" ("account_id");
+-- Create index "bronzeawsec2networkacl_collected_at" to table: "aws_ec2_network_acls"
+CREATE INDEX "bronzeawsec2networkacl_collected_at" ON "bronze"."aws_ec2_network_acls" ("collected_at");
+-- Create index "bronzeawsec2networkacl_region" to table: "aws_ec2_network_acls"
+CREATE INDEX "bronzeawsec2networkacl_region" ON "bronze"."aws_ec2_network_acls" ("region");
+-- Create index "bronzeawsec2networkacl_vpc_id" to table: "aws_ec2_network_acls"
+CREATE INDEX "bronzeawsec2networkacl_vpc_id" ON "bronze"."aws_ec2_network_acls" ("vpc_id");
+-- Create "aws_ec2_network_interfaces" table
+CREATE TABLE "bronze"."aws_ec2_network_interfaces" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "description" character varying NULL,
+  "interface_type" character varying NULL,
+  "status" character varying NULL,
+  "vpc_id" character varying NULL,
+  "subnet_id" character varying NULL,
+  "availability_zone" character varying NULL,
+  "mac_address" character varying NULL,
+  "private_ip_address" character varying NULL,
+  "private_dns_name" character varying NULL,
+  "public_ip" character varying NULL,
+  "public_dns_name" character varying NULL,
+  "source_dest_check" boolean NULL,
+  "requester_managed" boolean NULL,
+  "requester_id" character varying NULL,
+  "owner_id" character varying NULL,
+  "attachment_id" character varying NULL,
+  "attachment_instance_id" character varying NULL,
+  "attachment_status" character varying NULL,
+  "groups_json" jsonb NULL,
+  "private_ip_addresses_json" jsonb NULL,
+  "ipv6_addresses_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeawsec2networkinterface_account_id" to table: "aws_ec2_network_interfaces"
+CREATE INDEX "bronzeawsec2networkinterface_account_id" ON "bronze"."aws_ec2_network_interfaces" ("account_id");
+-- Create index "bronzeawsec2networkinterface_attachment_instance_id" to table: "aws_ec2_network_interfaces"
+CREATE INDEX "bronzeawsec2networkinterface_attachment_instance_id" ON "bronze"."aws_ec2_network_interfaces" ("attachment_instance_id");
+-- Create index "bronzeawsec2networkinterface_collected_at" to table: "aws_ec2_network_interfaces"
+CREATE INDEX "bronzeawsec2networkinterface_collected_at" ON "bronze"."aws_ec2_network_interfaces" ("collected_at");
+-- Create index "bronzeawsec2networkinterface_public_ip" to table: "aws_ec2_network_interfaces"
+CREATE INDEX "bronzeawsec2networkinterface_public_ip" ON "bronze"."aws_ec2_network_interfaces" ("public_ip");
+-- Create index "bronzeawsec2networkinterface_region" to table: "aws_ec2_network_interfaces"
+CREATE INDEX "bronzeawsec2networkinterface_region" ON "bronze"."aws_ec2_network_interfaces" ("region");
+-- Create index "bronzeawsec2networkinterface_subnet_id" to table: "aws_ec2_network_interfaces"
+CREATE INDEX "bronzeawsec2networkinterface_subnet_id" ON "bronze"."aws_ec2_network_interfaces" ("subnet_id");
+-- Create index "bronzeawsec2networkinterface_vpc_id" to table: "aws_ec2_network_interfaces"
+CREATE INDEX "bronzeawsec2networkinterface_vpc_id" ON "bronze"."aws_ec2_network_interfaces" ("vpc_id");
+-- Create "aws_ec2_route_tables" table
+CREATE TABLE "bronze"."aws_ec2_route_tables" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "name" character varying NULL,
+  "vpc_id" character varying NULL,
+  "owner_id" character varying NULL,
+  "routes_json" jsonb NULL,
+  "associations_json" jsonb NULL,
+  "propagating_vgws_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeawsec2routetable_account_id" to table: "aws_ec2_route_tables"
+CREATE INDEX "bronzeawsec2routetable_account_id" ON "bronze"."aws_ec2_route_tables" ("account_id");
+-- Create index "bronzeawsec2routetable_collected_at" to table: "aws_ec2_route_tables"
+CREATE INDEX "bronzeawsec2routetable_collected_at" ON "bronze"."aws_ec2_route_tables" ("collected_at");
+-- Create index "bronzeawsec2routetable_region" to table: "aws_ec2_route_tables"
+CREATE INDEX "bronzeawsec2routetable_region" ON "bronze"."aws_ec2_route_tables" ("region");
+-- Create index "bronzeawsec2routetable_vpc_id" to table: "aws_ec2_route_tables"
+CREATE INDEX "bronzeawsec2routetable_vpc_id" ON "bronze"."aws_ec2_route_tables" ("vpc_id");
+-- Create "aws_ec2_security_group_rules" table
+CREATE TABLE "bronze"."aws_ec2_security_group_rules" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "group_id" character varying NULL,
+  "group_owner_id" character varying NULL,
+  "is_egress" boolean NULL,
+  "ip_protocol" character varying NULL,
+  "from_port" integer NULL,
+  "to_port" integer NULL,
+  "cidr_ipv4" character varying NULL,
+  "cidr_ipv6" character varying NULL,
+  "prefix_list_id" character varying NULL,
+  "referenced_group_id" character varying NULL,
+  "referenced_user_id" character varying NULL,
+  "description" character varying NULL,
+  "security_group_rule_arn" character varying NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeawsec2securitygrouprule_account_id" to table: "aws_ec2_security_group_rules"
+CREATE INDEX "bronzeawsec2securitygrouprule_account_id" ON "bronze"."aws_ec2_security_group_rules" ("account_id");
+-- Create index "bronzeawsec2securitygrouprule_cidr_ipv4" to table: "aws_ec2_security_group_rules"
+CREATE INDEX "bronzeawsec2securitygrouprule_cidr_ipv4" ON "bronze"."aws_ec2_security_group_rules" ("cidr_ipv4");
+-- Create index "bronzeawsec2securitygrouprule_collected_at" to table: "aws_ec2_security_group_rules"
+CREATE INDEX "bronzeawsec2securitygrouprule_collected_at" ON "bronze"."aws_ec2_security_group_rules" ("collected_at");
+-- Create index "bronzeawsec2securitygrouprule_group_id" to table: "aws_ec2_security_group_rules"
+CREATE INDEX "bronzeawsec2securitygrouprule_group_id" ON "bronze"."aws_ec2_security_group_rules" ("group_id");
+-- Create index "bronzeawsec2securitygrouprule_is_egress" to table: "aws_ec2_security_group_rules"
+CREATE INDEX "bronzeawsec2securitygrouprule_is_egress" ON "bronze"."aws_ec2_security_group_rules" ("is_egress");
+-- Create index "bronzeawsec2securitygrouprule_region" to table: "aws_ec2_security_group_rules"
+CREATE INDEX "bronzeawsec2securitygrouprule_region" ON "bronze"."aws_ec2_security_group_rules" ("region");
+-- Create "aws_ec2_security_groups" table
+CREATE TABLE "bronze"."aws_ec2_security_groups" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "group_name" character varying NULL,
+  "description" character varying NULL,
+  "vpc_id" character varying NULL,
+  "owner_id" character varying NULL,
+  "security_group_arn" character varying NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeawsec2securitygroup_account_id" to table: "aws_ec2_security_groups"
+CREATE INDEX "bronzeawsec2securitygroup_account_id" ON "bronze"."aws_ec2_security_groups" ("account_id");
+-- Create index "bronzeawsec2securitygroup_collected_at" to table: "aws_ec2_security_groups"
+CREATE INDEX "bronzeawsec2securitygroup_collected_at" ON "bronze"."aws_ec2_security_groups" ("collected_at");
+-- Create index "bronzeawsec2securitygroup_group_name" to table: "aws_ec2_security_groups"
+CREATE INDEX "bronzeawsec2securitygroup_group_name" ON "bronze"."aws_ec2_security_groups" ("group_name");
+-- Create index "bronzeawsec2securitygroup_region" to table: "aws_ec2_security_groups"
+CREATE INDEX "bronzeawsec2securitygroup_region" ON "bronze"."aws_ec2_security_groups" ("region");
+-- Create index "bronzeawsec2securitygroup_vpc_id" to table: "aws_ec2_security_groups"
+CREATE INDEX "bronzeawsec2securitygroup_vpc_id" ON "bronze"."aws_ec2_security_groups" ("vpc_id");
+-- Create "aws_ec2_subnets" table
+CREATE TABLE "bronze"."aws_ec2_subnets" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "name" character varying NULL,
+  "vpc_id" character varying NULL,
+  "cidr_block" character varying NULL,
+  "availability_zone" character varying NULL,
+  "availability_zone_id" character varying NULL,
+  "state" character varying NULL,
+  "default_for_az" boolean NULL,
+  "map_public_ip_on_launch" boolean NULL,
+  "assign_ipv6_address_on_creation" boolean NULL,
+  "subnet_arn" character varying NULL,
+  "owner_id" character varying NULL,
+  "ipv6_cidr_block_associations_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeawsec2subnet_account_id" to table: "aws_ec2_subnets"
+CREATE INDEX "bronzeawsec2subnet_account_id" ON "bronze"."aws_ec2_subnets" ("account_id");
+-- Create index "bronzeawsec2subnet_collected_at" to table: "aws_ec2_subnets"
+CREATE INDEX "bronzeawsec2subnet_collected_at" ON "bronze"."aws_ec2_subnets" ("collected_at");
+-- Create index "bronzeawsec2subnet_region" to table: "aws_ec2_subnets"
+CREATE INDEX "bronzeawsec2subnet_region" ON "bronze"."aws_ec2_subnets" ("region");
+-- Create index "bronzeawsec2subnet_vpc_id" to table: "aws_ec2_subnets"
+CREATE INDEX "bronzeawsec2subnet_vpc_id" ON "bronze"."aws_ec2_subnets" ("vpc_id");
+-- Create "aws_ec2_vpcs" table
+CREATE TABLE "bronze"."aws_ec2_vpcs" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "name" character varying NULL,
+  "cidr_block" character varying NULL,
+  "state" character varying NULL,
+  "is_default" boolean NULL,
+  "instance_tenancy" character varying NULL,
+  "dhcp_options_id" character varying NULL,
+  "owner_id" character varying NULL,
+  "cidr_block_associations_json" jsonb NULL,
+  "ipv6_cidr_block_associations_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeawsec2vpc_account_id" to table: "aws_ec2_vpcs"
+CREATE INDEX "bronzeawsec2vpc_account_id" ON "bronze"."aws_ec2_vpcs" ("account_id");
+-- Create index "bronzeawsec2vpc_collected_at" to table: "aws_ec2_vpcs"
+CREATE INDEX "bronzeawsec2vpc_collected_at" ON "bronze"."aws_ec2_vpcs" ("collected_at");
+-- Create index "bronzeawsec2vpc_is_default" to table: "aws_ec2_vpcs"
+CREATE INDEX "bronzeawsec2vpc_is_default" ON "bronze"."aws_ec2_vpcs" ("is_default");
+-- Create index "bronzeawsec2vpc_region" to table: "aws_ec2_vpcs"
+CREATE INDEX "bronzeawsec2vpc_region" ON "bronze"."aws_ec2_vpcs" ("region");
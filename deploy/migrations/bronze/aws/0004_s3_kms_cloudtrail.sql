@@ -0,0 +1,105 @@
+-- Create "aws_cloudtrail_trails" table
+CREATE TABLE "bronze"."aws_cloudtrail_trails" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "name" character varying NULL,
+  "s3_bucket_name" character varying NULL,
+  "s3_key_prefix" character varying NULL,
+  "sns_topic_arn" character varying NULL,
+  "is_multi_region_trail" boolean NULL,
+  "is_organization_trail" boolean NULL,
+  "include_global_service_events" boolean NULL,
+  "log_file_validation_enabled" boolean NULL,
+  "kms_key_id" character varying NULL,
+  "cloud_watch_logs_log_group_arn" character varying NULL,
+  "cloud_watch_logs_role_arn" character varying NULL,
+  "has_custom_event_selectors" boolean NULL,
+  "has_insight_selectors" boolean NULL,
+  "is_logging" boolean NULL,
+  "event_selectors_json" jsonb NULL,
+  "advanced_event_selectors_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeawscloudtrailtrail_account_id" to table: "aws_cloudtrail_trails"
+CREATE INDEX "bronzeawscloudtrailtrail_account_id" ON "bronze"."aws_cloudtrail_trails" ("account_id");
+-- Create index "bronzeawscloudtrailtrail_collected_at" to table: "aws_cloudtrail_trails"
+CREATE INDEX "bronzeawscloudtrailtrail_collected_at" ON "bronze"."aws_cloudtrail_trails" ("collected_at");
+-- Create index "bronzeawscloudtrailtrail_is_multi_region_trail" to table: "aws_cloudtrail_trails"
+CREATE INDEX "bronzeawscloudtrailtrail_is_multi_region_trail" ON "bronze"."aws_cloudtrail_trails" ("is_multi_region_trail");
+-- Create index "bronzeawscloudtrailtrail_region" to table: "aws_cloudtrail_trails"
+CREATE INDEX "bronzeawscloudtrailtrail_region" ON "bronze"."aws_cloudtrail_trails" ("region");
+-- Create "aws_kms_keys" table
+CREATE TABLE "bronze"."aws_kms_keys" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "arn" character varying NULL,
+  "description" character varying NULL,
+  "key_state" character varying NULL,
+  "key_usage" character varying NULL,
+  "key_spec" character varying NULL,
+  "key_manager" character varying NULL,
+  "origin" character varying NULL,
+  "enabled" boolean NULL,
+  "multi_region" boolean NULL,
+  "creation_date" timestamptz NULL,
+  "deletion_date" timestamptz NULL,
+  "rotation_enabled" boolean NULL,
+  "rotation_period_in_days" integer NULL,
+  "next_rotation_date" timestamptz NULL,
+  "policy_json" jsonb NULL,
+  "aliases_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeawskmskey_account_id" to table: "aws_kms_keys"
+CREATE INDEX "bronzeawskmskey_account_id" ON "bronze"."aws_kms_keys" ("account_id");
+-- Create index "bronzeawskmskey_collected_at" to table: "aws_kms_keys"
+CREATE INDEX "bronzeawskmskey_collected_at" ON "bronze"."aws_kms_keys" ("collected_at");
+-- Create index "bronzeawskmskey_key_manager" to table: "aws_kms_keys"
+CREATE INDEX "bronzeawskmskey_key_manager" ON "bronze"."aws_kms_keys" ("key_manager");
+-- Create index "bronzeawskmskey_key_state" to table: "aws_kms_keys"
+CREATE INDEX "bronzeawskmskey_key_state" ON "bronze"."aws_kms_keys" ("key_state");
+-- Create index "bronzeawskmskey_region" to table: "aws_kms_keys"
+CREATE INDEX "bronzeawskmskey_region" ON "bronze"."aws_kms_keys" ("region");
+-- Create "aws_s3_buckets" table
+CREATE TABLE "bronze"."aws_s3_buckets" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "arn" character varying NULL,
+  "creation_date" timestamptz NULL,
+  "owner_id" character varying NULL,
+  "block_public_acls" boolean NULL,
+  "ignore_public_acls" boolean NULL,
+  "block_public_policy" boolean NULL,
+  "restrict_public_buckets" boolean NULL,
+  "policy_is_public" boolean NULL,
+  "sse_algorithm" character varying NULL,
+  "kms_master_key_id" character varying NULL,
+  "bucket_key_enabled" boolean NULL,
+  "versioning_status" character varying NULL,
+  "mfa_delete" character varying NULL,
+  "logging_target_bucket" character varying NULL,
+  "logging_target_prefix" character varying NULL,
+  "policy_json" jsonb NULL,
+  "acl_grants_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzeawss3bucket_account_id" to table: "aws_s3_buckets"
+CREATE INDEX "bronzeawss3bucket_account_id" ON "bronze"."aws_s3_buckets" ("account_id");
+-- Create index "bronzeawss3bucket_collected_at" to table: "aws_s3_buckets"
+CREATE INDEX "bronzeawss3bucket_collected_at" ON "bronze"."aws_s3_buckets" ("collected_at");
+-- Create index "bronzeawss3bucket_policy_is_public" to table: "aws_s3_buckets"
+CREATE INDEX "bronzeawss3bucket_policy_is_public" ON "bronze"."aws_s3_buckets" ("policy_is_public");
+-- Create index "bronzeawss3bucket_region" to table: "aws_s3_buckets"
+CREATE INDEX "bronzeawss3bucket_region" ON "bronze"."aws_s3_buckets" ("region");
@@ -0,0 +1,162 @@
+-- Add new schema named "bronze"
+CREATE SCHEMA IF NOT EXISTS "bronze";
+-- Create "k8s_images" table
+CREATE TABLE "bronze"."k8s_images" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "cluster_name" character varying NOT NULL,
+  "registry" character varying NOT NULL,
+  "repository" character varying NOT NULL,
+  "digest" character varying NULL,
+  "tags_json" jsonb NULL,
+  "namespaces_json" jsonb NULL,
+  "workloads_json" jsonb NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzek8simage_cluster_name" to table: "k8s_images"
+CREATE INDEX "bronzek8simage_cluster_name" ON "bronze"."k8s_images" ("cluster_name");
+-- Create index "bronzek8simage_collected_at" to table: "k8s_images"
+CREATE INDEX "bronzek8simage_collected_at" ON "bronze"."k8s_images" ("collected_at");
+-- Create index "bronzek8simage_digest" to table: "k8s_images"
+CREATE INDEX "bronzek8simage_digest" ON "bronze"."k8s_images" ("digest");
+-- Create index "bronzek8simage_registry_repository" to table: "k8s_images"
+CREATE INDEX "bronzek8simage_registry_repository" ON "bronze"."k8s_images" ("registry", "repository");
+-- Create "k8s_namespaces" table
+CREATE TABLE "bronze"."k8s_namespaces" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "cluster_name" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "uid" character varying NULL,
+  "phase" character varying NULL,
+  "labels_json" jsonb NULL,
+  "api_created_at" timestamptz NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzek8snamespace_cluster_name" to table: "k8s_namespaces"
+CREATE INDEX "bronzek8snamespace_cluster_name" ON "bronze"."k8s_namespaces" ("cluster_name");
+-- Create index "bronzek8snamespace_collected_at" to table: "k8s_namespaces"
+CREATE INDEX "bronzek8snamespace_collected_at" ON "bronze"."k8s_namespaces" ("collected_at");
+-- Create "k8s_network_policies" table
+CREATE TABLE "bronze"."k8s_network_policies" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "cluster_name" character varying NOT NULL,
+  "namespace" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "uid" character varying NULL,
+  "pod_selector_json" jsonb NULL,
+  "policy_types_json" jsonb NULL,
+  "ingress_json" jsonb NULL,
+  "egress_json" jsonb NULL,
+  "labels_json" jsonb NULL,
+  "api_created_at" timestamptz NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzek8snetworkpolicy_cluster_name_namespace" to table: "k8s_network_policies"
+CREATE INDEX "bronzek8snetworkpolicy_cluster_name_namespace" ON "bronze"."k8s_network_policies" ("cluster_name", "namespace");
+-- Create index "bronzek8snetworkpolicy_collected_at" to table: "k8s_network_policies"
+CREATE INDEX "bronzek8snetworkpolicy_collected_at" ON "bronze"."k8s_network_policies" ("collected_at");
+-- Create "k8s_pods" table
+CREATE TABLE "bronze"."k8s_pods" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "cluster_name" character varying NOT NULL,
+  "namespace" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "uid" character varying NULL,
+  "phase" character varying NULL,
+  "node_name" character varying NULL,
+  "pod_ip" character varying NULL,
+  "host_ip" character varying NULL,
+  "service_account" character varying NULL,
+  "workload_kind" character varying NULL,
+  "workload_name" character varying NULL,
+  "host_network" boolean NOT NULL DEFAULT false,
+  "containers_json" jsonb NULL,
+  "labels_json" jsonb NULL,
+  "api_created_at" timestamptz NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzek8spod_cluster_name_namespace" to table: "k8s_pods"
+CREATE INDEX "bronzek8spod_cluster_name_namespace" ON "bronze"."k8s_pods" ("cluster_name", "namespace");
+-- Create index "bronzek8spod_collected_at" to table: "k8s_pods"
+CREATE INDEX "bronzek8spod_collected_at" ON "bronze"."k8s_pods" ("collected_at");
+-- Create index "bronzek8spod_node_name" to table: "k8s_pods"
+CREATE INDEX "bronzek8spod_node_name" ON "bronze"."k8s_pods" ("node_name");
+-- Create "k8s_role_bindings" table
+CREATE TABLE "bronze"."k8s_role_bindings" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "cluster_name" character varying NOT NULL,
+  "kind" character varying NOT NULL,
+  "namespace" character varying NULL,
+  "name" character varying NOT NULL,
+  "uid" character varying NULL,
+  "role_kind" character varying NOT NULL,
+  "role_name" character varying NOT NULL,
+  "subjects_json" jsonb NULL,
+  "rules_json" jsonb NULL,
+  "labels_json" jsonb NULL,
+  "api_created_at" timestamptz NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzek8srolebinding_cluster_name_namespace" to table: "k8s_role_bindings"
+CREATE INDEX "bronzek8srolebinding_cluster_name_namespace" ON "bronze"."k8s_role_bindings" ("cluster_name", "namespace");
+-- Create index "bronzek8srolebinding_collected_at" to table: "k8s_role_bindings"
+CREATE INDEX "bronzek8srolebinding_collected_at" ON "bronze"."k8s_role_bindings" ("collected_at");
+-- Create index "bronzek8srolebinding_role_name" to table: "k8s_role_bindings"
+CREATE INDEX "bronzek8srolebinding_role_name" ON "bronze"."k8s_role_bindings" ("role_name");
+-- Create "k8s_service_accounts" table
+CREATE TABLE "bronze"."k8s_service_accounts" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "cluster_name" character varying NOT NULL,
+  "namespace" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "uid" character varying NULL,
+  "automount_token" boolean NULL,
+  "cloud_identity" character varying NULL,
+  "image_pull_secrets_json" jsonb NULL,
+  "labels_json" jsonb NULL,
+  "api_created_at" timestamptz NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzek8sserviceaccount_cluster_name_namespace" to table: "k8s_service_accounts"
+CREATE INDEX "bronzek8sserviceaccount_cluster_name_namespace" ON "bronze"."k8s_service_accounts" ("cluster_name", "namespace");
+-- Create index "bronzek8sserviceaccount_collected_at" to table: "k8s_service_accounts"
+CREATE INDEX "bronzek8sserviceaccount_collected_at" ON "bronze"."k8s_service_accounts" ("collected_at");
+-- Create "k8s_workloads" table
+CREATE TABLE "bronze"."k8s_workloads" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "cluster_name" character varying NOT NULL,
+  "namespace" character varying NOT NULL,
+  "kind" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "uid" character varying NULL,
+  "replicas" integer NULL,
+  "service_account" character varying NULL,
+  "host_network" boolean NOT NULL DEFAULT false,
+  "host_pid" boolean NOT NULL DEFAULT false,
+  "host_ipc" boolean NOT NULL DEFAULT false,
+  "images_json" jsonb NULL,
+  "containers_json" jsonb NULL,
+  "selector_json" jsonb NULL,
+  "labels_json" jsonb NULL,
+  "api_created_at" timestamptz NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzek8sworkload_cluster_name_namespace" to table: "k8s_workloads"
+CREATE INDEX "bronzek8sworkload_cluster_name_namespace" ON "bronze"."k8s_workloads" ("cluster_name", "namespace");
+-- Create index "bronzek8sworkload_collected_at" to table: "k8s_workloads"
+CREATE INDEX "bronzek8sworkload_collected_at" ON "bronze"."k8s_workloads" ("collected_at");
+-- Create index "bronzek8sworkload_kind" to table: "k8s_workloads"
+CREATE INDEX "bronzek8sworkload_kind" ON "bronze"."k8s_workloads" ("kind");
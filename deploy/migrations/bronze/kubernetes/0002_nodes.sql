@@ -0,0 +1,33 @@
+-- Create "k8s_nodes" table
+CREATE TABLE "bronze"."k8s_nodes" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "cluster_name" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "uid" character varying NULL,
+  "provider_id" character varying NULL,
+  "internal_ip" character varying NULL,
+  "external_ip" character varying NULL,
+  "node_pool" character varying NULL,
+  "instance_type" character varying NULL,
+  "region" character varying NULL,
+  "zone" character varying NULL,
+  "kubelet_version" character varying NULL,
+  "os_image" character varying NULL,
+  "kernel_version" character varying NULL,
+  "container_runtime" character varying NULL,
+  "architecture" character varying NULL,
+  "ready" boolean NOT NULL DEFAULT false,
+  "unschedulable" boolean NOT NULL DEFAULT false,
+  "labels_json" jsonb NULL,
+  "taints_json" jsonb NULL,
+  "api_created_at" timestamptz NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzek8snode_cluster_name" to table: "k8s_nodes"
+CREATE INDEX "bronzek8snode_cluster_name" ON "bronze"."k8s_nodes" ("cluster_name");
+-- Create index "bronzek8snode_collected_at" to table: "k8s_nodes"
+CREATE INDEX "bronzek8snode_collected_at" ON "bronze"."k8s_nodes" ("collected_at");
+-- Create index "bronzek8snode_provider_id" to table: "k8s_nodes"
+CREATE INDEX "bronzek8snode_provider_id" ON "bronze"."k8s_nodes" ("provider_id");
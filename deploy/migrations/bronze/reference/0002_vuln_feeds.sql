@@ -0,0 +1,89 @@
+-- Create "reference_nvd_cpe_matches" table
+CREATE TABLE "bronze"."reference_nvd_cpe_matches" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "cve_id" character varying NOT NULL,
+  "criteria" character varying NOT NULL,
+  "part" character varying NOT NULL,
+  "cpe_vendor" character varying NOT NULL,
+  "cpe_product" character varying NOT NULL,
+  "cpe_version" character varying NOT NULL,
+  "vulnerable" boolean NOT NULL DEFAULT true,
+  "version_start_including" character varying NULL,
+  "version_start_excluding" character varying NULL,
+  "version_end_including" character varying NULL,
+  "version_end_excluding" character varying NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzereferencenvdcpematch_collected_at" to table: "reference_nvd_cpe_matches"
+CREATE INDEX "bronzereferencenvdcpematch_collected_at" ON "bronze"."reference_nvd_cpe_matches" ("collected_at");
+-- Create index "bronzereferencenvdcpematch_cpe_vendor_cpe_product" to table: "reference_nvd_cpe_matches"
+CREATE INDEX "bronzereferencenvdcpematch_cpe_vendor_cpe_product" ON "bronze"."reference_nvd_cpe_matches" ("cpe_vendor", "cpe_product");
+-- Create index "bronzereferencenvdcpematch_cve_id" to table: "reference_nvd_cpe_matches"
+CREATE INDEX "bronzereferencenvdcpematch_cve_id" ON "bronze"."reference_nvd_cpe_matches" ("cve_id");
+-- Create "reference_nvd_cves" table
+CREATE TABLE "bronze"."reference_nvd_cves" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "description" text NULL,
+  "vuln_status" character varying NULL,
+  "cvss_version" character varying NULL,
+  "cvss_score" double precision NULL,
+  "cvss_severity" character varying NULL,
+  "cvss_vector" character varying NULL,
+  "cwes" jsonb NULL,
+  "published" timestamptz NOT NULL,
+  "last_modified" timestamptz NOT NULL,
+  "cisa_exploit_add" timestamptz NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzereferencenvdcve_collected_at" to table: "reference_nvd_cves"
+CREATE INDEX "bronzereferencenvdcve_collected_at" ON "bronze"."reference_nvd_cves" ("collected_at");
+-- Create index "bronzereferencenvdcve_cvss_severity" to table: "reference_nvd_cves"
+CREATE INDEX "bronzereferencenvdcve_cvss_severity" ON "bronze"."reference_nvd_cves" ("cvss_severity");
+-- Create index "bronzereferencenvdcve_last_modified" to table: "reference_nvd_cves"
+CREATE INDEX "bronzereferencenvdcve_last_modified" ON "bronze"."reference_nvd_cves" ("last_modified");
+-- Create "reference_osv_affected" table
+CREATE TABLE "bronze"."reference_osv_affected" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "vuln_id" character varying NOT NULL,
+  "ecosystem" character varying NOT NULL,
+  "package_name" character varying NOT NULL,
+  "purl" character varying NULL,
+  "range_type" character varying NULL,
+  "introduced" character varying NULL,
+  "fixed" character varying NULL,
+  "last_affected" character varying NULL,
+  "versions" jsonb NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzereferenceosvaffected_collected_at" to table: "reference_osv_affected"
+CREATE INDEX "bronzereferenceosvaffected_collected_at" ON "bronze"."reference_osv_affected" ("collected_at");
+-- Create index "bronzereferenceosvaffected_ecosystem_package_name" to table: "reference_osv_affected"
+CREATE INDEX "bronzereferenceosvaffected_ecosystem_package_name" ON "bronze"."reference_osv_affected" ("ecosystem", "package_name");
+-- Create index "bronzereferenceosvaffected_vuln_id" to table: "reference_osv_affected"
+CREATE INDEX "bronzereferenceosvaffected_vuln_id" ON "bronze"."reference_osv_affected" ("vuln_id");
+-- Create "reference_osv_vulns" table
+CREATE TABLE "bronze"."reference_osv_vulns" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "summary" text NULL,
+  "details" text NULL,
+  "aliases" jsonb NULL,
+  "severity_type" character varying NULL,
+  "severity_score" character varying NULL,
+  "database_severity" character varying NULL,
+  "published" timestamptz NULL,
+  "modified" timestamptz NOT NULL,
+  "withdrawn" timestamptz NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzereferenceosvvuln_collected_at" to table: "reference_osv_vulns"
+CREATE INDEX "bronzereferenceosvvuln_collected_at" ON "bronze"."reference_osv_vulns" ("collected_at");
+-- Create index "bronzereferenceosvvuln_modified" to table: "reference_osv_vulns"
+CREATE INDEX "bronzereferenceosvvuln_modified" ON "bronze"."reference_osv_vulns" ("modified");
@@ -0,0 +1,18 @@
+-- Create "reference_threat_intel_indicators" table
+CREATE TABLE "bronze"."reference_threat_intel_indicators" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "feed_name" character varying NOT NULL,
+  "feed_source" character varying NOT NULL,
+  "feed_format" character varying NOT NULL,
+  "category" character varying NOT NULL,
+  "cidr" character varying NOT NULL,
+  "description" character varying NULL,
+  "expires_at" timestamptz NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzereferencethreatintelindicator_expires_at" to table: "reference_threat_intel_indicators"
+CREATE INDEX "bronzereferencethreatintelindicator_expires_at" ON "bronze"."reference_threat_intel_indicators" ("expires_at");
+-- Create index "bronzereferencethreatintelindicator_feed_name" to table: "reference_threat_intel_indicators"
+CREATE INDEX "bronzereferencethreatintelindicator_feed_name" ON "bronze"."reference_threat_intel_indicators" ("feed_name");
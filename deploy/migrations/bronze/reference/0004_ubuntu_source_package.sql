@@ -0,0 +1,2 @@
+-- Modify "reference_ubuntu_packages" table
+ALTER TABLE "bronze"."reference_ubuntu_packages" ADD COLUMN "source_package" character varying NULL;
@@ -0,0 +1,106 @@
+-- Create "s1_alerts" table
+CREATE TABLE "bronze"."s1_alerts" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "agent_id" character varying NULL,
+  "agent_uuid" character varying NULL,
+  "agent_name" character varying NULL,
+  "agent_os_name" character varying NULL,
+  "site_id" character varying NULL,
+  "rule_id" character varying NULL,
+  "rule_name" character varying NULL,
+  "rule_severity" character varying NULL,
+  "rule_description" character varying NULL,
+  "rule_scope_level" character varying NULL,
+  "rule_treat_as_threat" character varying NULL,
+  "analyst_verdict" character varying NULL,
+  "incident_status" character varying NULL,
+  "event_type" character varying NULL,
+  "hit_type" character varying NULL,
+  "source" character varying NULL,
+  "dv_event_id" character varying NULL,
+  "is_edr" boolean NOT NULL DEFAULT false,
+  "reported_at" timestamptz NULL,
+  "api_created_at" timestamptz NULL,
+  "api_updated_at" timestamptz NULL,
+  "source_process_json" jsonb NULL,
+  "target_process_json" jsonb NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzes1alert_agent_id" to table: "s1_alerts"
+CREATE INDEX "bronzes1alert_agent_id" ON "bronze"."s1_alerts" ("agent_id");
+-- Create index "bronzes1alert_api_updated_at" to table: "s1_alerts"
+CREATE INDEX "bronzes1alert_api_updated_at" ON "bronze"."s1_alerts" ("api_updated_at");
+-- Create index "bronzes1alert_collected_at" to table: "s1_alerts"
+CREATE INDEX "bronzes1alert_collected_at" ON "bronze"."s1_alerts" ("collected_at");
+-- Create index "bronzes1alert_incident_status" to table: "s1_alerts"
+CREATE INDEX "bronzes1alert_incident_status" ON "bronze"."s1_alerts" ("incident_status");
+-- Create index "bronzes1alert_rule_id" to table: "s1_alerts"
+CREATE INDEX "bronzes1alert_rule_id" ON "bronze"."s1_alerts" ("rule_id");
+-- Create index "bronzes1alert_rule_severity" to table: "s1_alerts"
+CREATE INDEX "bronzes1alert_rule_severity" ON "bronze"."s1_alerts" ("rule_severity");
+-- Create index "bronzes1alert_site_id" to table: "s1_alerts"
+CREATE INDEX "bronzes1alert_site_id" ON "bronze"."s1_alerts" ("site_id");
+-- Create "s1_ingest_cursors" table
+CREATE TABLE "bronze"."s1_ingest_cursors" (
+  "cursor_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "name" character varying NOT NULL,
+  "last_updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("cursor_id")
+);
+-- Create index "bronzes1ingestcursor_name" to table: "s1_ingest_cursors"
+CREATE UNIQUE INDEX "bronzes1ingestcursor_name" ON "bronze"."s1_ingest_cursors" ("name");
+-- Create "s1_threats" table
+CREATE TABLE "bronze"."s1_threats" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "agent_id" character varying NULL,
+  "agent_uuid" character varying NULL,
+  "agent_computer_name" character varying NULL,
+  "agent_os_type" character varying NULL,
+  "account_id" character varying NULL,
+  "site_id" character varying NULL,
+  "site_name" character varying NULL,
+  "group_id" character varying NULL,
+  "threat_name" character varying NULL,
+  "classification" character varying NULL,
+  "classification_source" character varying NULL,
+  "confidence_level" character varying NULL,
+  "mitigation_status" character varying NULL,
+  "analyst_verdict" character varying NULL,
+  "incident_status" character varying NULL,
+  "initiated_by" character varying NULL,
+  "detection_type" character varying NULL,
+  "file_path" character varying NULL,
+  "sha1" character varying NULL,
+  "sha256" character varying NULL,
+  "md5" character varying NULL,
+  "storyline" character varying NULL,
+  "is_fileless" boolean NOT NULL DEFAULT false,
+  "mitigated_preemptively" boolean NOT NULL DEFAULT false,
+  "identified_at" timestamptz NULL,
+  "api_created_at" timestamptz NULL,
+  "api_updated_at" timestamptz NULL,
+  "engines_json" jsonb NULL,
+  "indicators_json" jsonb NULL,
+  "mitigation_actions_json" jsonb NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzes1threat_account_id" to table: "s1_threats"
+CREATE INDEX "bronzes1threat_account_id" ON "bronze"."s1_threats" ("account_id");
+-- Create index "bronzes1threat_agent_id" to table: "s1_threats"
+CREATE INDEX "bronzes1threat_agent_id" ON "bronze"."s1_threats" ("agent_id");
+-- Create index "bronzes1threat_api_updated_at" to table: "s1_threats"
+CREATE INDEX "bronzes1threat_api_updated_at" ON "bronze"."s1_threats" ("api_updated_at");
+-- Create index "bronzes1threat_classification" to table: "s1_threats"
+CREATE INDEX "bronzes1threat_classification" ON "bronze"."s1_threats" ("classification");
+-- Create index "bronzes1threat_collected_at" to table: "s1_threats"
+CREATE INDEX "bronzes1threat_collected_at" ON "bronze"."s1_threats" ("collected_at");
+-- Create index "bronzes1threat_mitigation_status" to table: "s1_threats"
+CREATE INDEX "bronzes1threat_mitigation_status" ON "bronze"."s1_threats" ("mitigation_status");
+-- Create index "bronzes1threat_site_id" to table: "s1_threats"
+CREATE INDEX "bronzes1threat_site_id" ON "bronze"."s1_threats" ("site_id");
@@ -0,0 +1,38 @@
+-- Create "s1_app_cves" table
+CREATE TABLE "bronze"."s1_app_cves" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "agent_id" character varying NOT NULL,
+  "endpoint_name" character varying NULL,
+  "endpoint_type" character varying NULL,
+  "os_type" character varying NULL,
+  "application_name" character varying NOT NULL,
+  "application_vendor" character varying NULL,
+  "application_version" character varying NULL,
+  "cve_id" character varying NOT NULL,
+  "cvss_score" double precision NOT NULL DEFAULT 0,
+  "cvss_version" character varying NULL,
+  "nvd_severity" character varying NULL,
+  "risk_score" double precision NOT NULL DEFAULT 0,
+  "exploit_code_maturity" character varying NULL,
+  "exploited_in_the_wild" boolean NOT NULL DEFAULT false,
+  "remediation_level" character varying NULL,
+  "report_confidence" character varying NULL,
+  "status" character varying NULL,
+  "published_date" timestamptz NULL,
+  "detection_date" timestamptz NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzes1appcve_agent_id" to table: "s1_app_cves"
+CREATE INDEX "bronzes1appcve_agent_id" ON "bronze"."s1_app_cves" ("agent_id");
+-- Create index "bronzes1appcve_application_name_application_version" to table: "s1_app_cves"
+CREATE INDEX "bronzes1appcve_application_name_application_version" ON "bronze"."s1_app_cves" ("application_name", "application_version");
+-- Create index "bronzes1appcve_collected_at" to table: "s1_app_cves"
+CREATE INDEX "bronzes1appcve_collected_at" ON "bronze"."s1_app_cves" ("collected_at");
+-- Create index "bronzes1appcve_cve_id" to table: "s1_app_cves"
+CREATE INDEX "bronzes1appcve_cve_id" ON "bronze"."s1_app_cves" ("cve_id");
+-- Create index "bronzes1appcve_exploited_in_the_wild" to table: "s1_app_cves"
+CREATE INDEX "bronzes1appcve_exploited_in_the_wild" ON "bronze"."s1_app_cves" ("exploited_in_the_wild");
+-- Create index "bronzes1appcve_nvd_severity" to table: "s1_app_cves"
+CREATE INDEX "bronzes1appcve_nvd_severity" ON "bronze"."s1_app_cves" ("nvd_severity");
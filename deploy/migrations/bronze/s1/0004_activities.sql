@@ -0,0 +1,36 @@
+-- Create "s1_activities" table
+CREATE TABLE "bronze"."s1_activities" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "activity_type" bigint NOT NULL,
+  "activity_uuid" character varying NULL,
+  "primary_description" character varying NULL,
+  "secondary_description" character varying NULL,
+  "comments" character varying NULL,
+  "account_id" character varying NULL,
+  "account_name" character varying NULL,
+  "site_id" character varying NULL,
+  "site_name" character varying NULL,
+  "group_id" character varying NULL,
+  "group_name" character varying NULL,
+  "agent_id" character varying NULL,
+  "user_id" character varying NULL,
+  "threat_id" character varying NULL,
+  "hash" character varying NULL,
+  "os_family" character varying NULL,
+  "api_created_at" timestamptz NOT NULL,
+  "api_updated_at" timestamptz NULL,
+  "data_json" jsonb NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzes1activity_activity_type" to table: "s1_activities"
+CREATE INDEX "bronzes1activity_activity_type" ON "bronze"."s1_activities" ("activity_type");
+-- Create index "bronzes1activity_agent_id" to table: "s1_activities"
+CREATE INDEX "bronzes1activity_agent_id" ON "bronze"."s1_activities" ("agent_id");
+-- Create index "bronzes1activity_api_created_at" to table: "s1_activities"
+CREATE INDEX "bronzes1activity_api_created_at" ON "bronze"."s1_activities" ("api_created_at");
+-- Create index "bronzes1activity_site_id" to table: "s1_activities"
+CREATE INDEX "bronzes1activity_site_id" ON "bronze"."s1_activities" ("site_id");
+-- Create index "bronzes1activity_user_id" to table: "s1_activities"
+CREATE INDEX "bronzes1activity_user_id" ON "bronze"."s1_activities" ("user_id");
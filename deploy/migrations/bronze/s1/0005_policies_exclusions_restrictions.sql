@@ -0,0 +1,104 @@
+-- Create "s1_exclusions" table
+CREATE TABLE "bronze"."s1_exclusions" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "scope_level" character varying NOT NULL,
+  "account_id" character varying NULL,
+  "site_id" character varying NULL,
+  "group_id" character varying NULL,
+  "scope_name" character varying NULL,
+  "scope_path" character varying NULL,
+  "exclusion_type" character varying NOT NULL,
+  "exclusion_value" character varying NULL,
+  "os_type" character varying NULL,
+  "mode" character varying NULL,
+  "path_exclusion_type" character varying NULL,
+  "description" character varying NULL,
+  "source" character varying NULL,
+  "user_id" character varying NULL,
+  "user_name" character varying NULL,
+  "application_name" character varying NULL,
+  "include_children" boolean NOT NULL DEFAULT false,
+  "include_subfolders" boolean NOT NULL DEFAULT false,
+  "imported" boolean NOT NULL DEFAULT false,
+  "actions_json" jsonb NULL,
+  "api_created_at" timestamptz NULL,
+  "api_updated_at" timestamptz NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzes1exclusion_collected_at" to table: "s1_exclusions"
+CREATE INDEX "bronzes1exclusion_collected_at" ON "bronze"."s1_exclusions" ("collected_at");
+-- Create index "bronzes1exclusion_exclusion_type" to table: "s1_exclusions"
+CREATE INDEX "bronzes1exclusion_exclusion_type" ON "bronze"."s1_exclusions" ("exclusion_type");
+-- Create index "bronzes1exclusion_group_id" to table: "s1_exclusions"
+CREATE INDEX "bronzes1exclusion_group_id" ON "bronze"."s1_exclusions" ("group_id");
+-- Create index "bronzes1exclusion_scope_level" to table: "s1_exclusions"
+CREATE INDEX "bronzes1exclusion_scope_level" ON "bronze"."s1_exclusions" ("scope_level");
+-- Create index "bronzes1exclusion_site_id" to table: "s1_exclusions"
+CREATE INDEX "bronzes1exclusion_site_id" ON "bronze"."s1_exclusions" ("site_id");
+-- Create "s1_policies" table
+CREATE TABLE "bronze"."s1_policies" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "scope_level" character varying NOT NULL,
+  "account_id" character varying NULL,
+  "site_id" character varying NULL,
+  "group_id" character varying NULL,
+  "scope_name" character varying NULL,
+  "inherited_from" character varying NULL,
+  "mitigation_mode" character varying NULL,
+  "mitigation_mode_suspicious" character varying NULL,
+  "auto_mitigation_action" character varying NULL,
+  "anti_tampering_on" boolean NOT NULL DEFAULT false,
+  "snapshots_on" boolean NOT NULL DEFAULT false,
+  "agent_logging_on" boolean NOT NULL DEFAULT false,
+  "scan_new_agents" boolean NOT NULL DEFAULT false,
+  "network_quarantine_on" boolean NOT NULL DEFAULT false,
+  "engines_json" jsonb NULL,
+  "policy_json" jsonb NULL,
+  "api_updated_at" timestamptz NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzes1policy_collected_at" to table: "s1_policies"
+CREATE INDEX "bronzes1policy_collected_at" ON "bronze"."s1_policies" ("collected_at");
+-- Create index "bronzes1policy_group_id" to table: "s1_policies"
+CREATE INDEX "bronzes1policy_group_id" ON "bronze"."s1_policies" ("group_id");
+-- Create index "bronzes1policy_scope_level" to table: "s1_policies"
+CREATE INDEX "bronzes1policy_scope_level" ON "bronze"."s1_policies" ("scope_level");
+-- Create index "bronzes1policy_site_id" to table: "s1_policies"
+CREATE INDEX "bronzes1policy_site_id" ON "bronze"."s1_policies" ("site_id");
+-- Create "s1_restrictions" table
+CREATE TABLE "bronze"."s1_restrictions" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "scope_level" character varying NOT NULL,
+  "account_id" character varying NULL,
+  "site_id" character varying NULL,
+  "group_id" character varying NULL,
+  "scope_name" character varying NULL,
+  "scope_path" character varying NULL,
+  "restriction_type" character varying NOT NULL,
+  "restriction_value" character varying NULL,
+  "os_type" character varying NULL,
+  "description" character varying NULL,
+  "source" character varying NULL,
+  "user_id" character varying NULL,
+  "user_name" character varying NULL,
+  "imported" boolean NOT NULL DEFAULT false,
+  "api_created_at" timestamptz NULL,
+  "api_updated_at" timestamptz NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "bronzes1restriction_collected_at" to table: "s1_restrictions"
+CREATE INDEX "bronzes1restriction_collected_at" ON "bronze"."s1_restrictions" ("collected_at");
+-- Create index "bronzes1restriction_group_id" to table: "s1_restrictions"
+CREATE INDEX "bronzes1restriction_group_id" ON "bronze"."s1_restrictions" ("group_id");
+-- Create index "bronzes1restriction_restriction_value" to table: "s1_restrictions"
+CREATE INDEX "bronzes1restriction_restriction_value" ON "bronze"."s1_restrictions" ("restriction_value");
+-- Create index "bronzes1restriction_scope_level" to table: "s1_restrictions"
+CREATE INDEX "bronzes1restriction_scope_level" ON "bronze"."s1_restrictions" ("scope_level");
+-- Create index "bronzes1restriction_site_id" to table: "s1_restrictions"
+CREATE INDEX "bronzes1restriction_site_id" ON "bronze"."s1_restrictions" ("site_id");
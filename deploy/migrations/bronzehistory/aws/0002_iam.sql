@@ -0,0 +1,182 @@
+-- Create "aws_iam_access_keys_history" table
+CREATE TABLE "bronze_history"."aws_iam_access_keys_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "user_name" character varying NOT NULL,
+  "status" character varying NULL,
+  "create_date" timestamptz NULL,
+  "last_used_date" timestamptz NULL,
+  "last_used_service" character varying NULL,
+  "last_used_region" character varying NULL,
+  "account_id" character varying NOT NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryawsiamaccesskey_account_id" to table: "aws_iam_access_keys_history"
+CREATE INDEX "bronzehistoryawsiamaccesskey_account_id" ON "bronze_history"."aws_iam_access_keys_history" ("account_id");
+-- Create index "bronzehistoryawsiamaccesskey_collected_at" to table: "aws_iam_access_keys_history"
+CREATE INDEX "bronzehistoryawsiamaccesskey_collected_at" ON "bronze_history"."aws_iam_access_keys_history" ("collected_at");
+-- Create index "bronzehistoryawsiamaccesskey_resource_id_valid_from" to table: "aws_iam_access_keys_history"
+CREATE INDEX "bronzehistoryawsiamaccesskey_resource_id_valid_from" ON "bronze_history"."aws_iam_access_keys_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryawsiamaccesskey_valid_to" to table: "aws_iam_access_keys_history"
+CREATE INDEX "bronzehistoryawsiamaccesskey_valid_to" ON "bronze_history"."aws_iam_access_keys_history" ("valid_to");
+-- Create "aws_iam_credential_reports_history" table
+CREATE TABLE "bronze_history"."aws_iam_credential_reports_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "user" character varying NOT NULL,
+  "user_creation_time" timestamptz NULL,
+  "password_enabled" character varying NULL,
+  "password_last_used" timestamptz NULL,
+  "password_last_changed" timestamptz NULL,
+  "password_next_rotation" timestamptz NULL,
+  "mfa_active" boolean NOT NULL DEFAULT false,
+  "access_key_1_active" boolean NOT NULL DEFAULT false,
+  "access_key_1_last_rotated" timestamptz NULL,
+  "access_key_1_last_used_date" timestamptz NULL,
+  "access_key_1_last_used_region" character varying NULL,
+  "access_key_1_last_used_service" character varying NULL,
+  "access_key_2_active" boolean NOT NULL DEFAULT false,
+  "access_key_2_last_rotated" timestamptz NULL,
+  "access_key_2_last_used_date" timestamptz NULL,
+  "access_key_2_last_used_region" character varying NULL,
+  "access_key_2_last_used_service" character varying NULL,
+  "cert_1_active" boolean NOT NULL DEFAULT false,
+  "cert_1_last_rotated" timestamptz NULL,
+  "cert_2_active" boolean NOT NULL DEFAULT false,
+  "cert_2_last_rotated" timestamptz NULL,
+  "report_generated_at" timestamptz NULL,
+  "account_id" character varying NOT NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryawsiamcredentialreport_account_id" to table: "aws_iam_credential_reports_history"
+CREATE INDEX "bronzehistoryawsiamcredentialreport_account_id" ON "bronze_history"."aws_iam_credential_reports_history" ("account_id");
+-- Create index "bronzehistoryawsiamcredentialreport_collected_at" to table: "aws_iam_credential_reports_history"
+CREATE INDEX "bronzehistoryawsiamcredentialreport_collected_at" ON "bronze_history"."aws_iam_credential_reports_history" ("collected_at");
+-- Create index "bronzehistoryawsiamcredentialreport_resource_id_valid_from" to table: "aws_iam_credential_reports_history"
+CREATE INDEX "bronzehistoryawsiamcredentialreport_resource_id_valid_from" ON "bronze_history"."aws_iam_credential_reports_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryawsiamcredentialreport_valid_to" to table: "aws_iam_credential_reports_history"
+CREATE INDEX "bronzehistoryawsiamcredentialreport_valid_to" ON "bronze_history"."aws_iam_credential_reports_history" ("valid_to");
+-- Create "aws_iam_groups_history" table
+CREATE TABLE "bronze_history"."aws_iam_groups_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "arn" character varying NOT NULL,
+  "group_name" character varying NOT NULL,
+  "path" character varying NULL,
+  "create_date" timestamptz NULL,
+  "attached_policies_json" jsonb NULL,
+  "inline_policies_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryawsiamgroup_account_id" to table: "aws_iam_groups_history"
+CREATE INDEX "bronzehistoryawsiamgroup_account_id" ON "bronze_history"."aws_iam_groups_history" ("account_id");
+-- Create index "bronzehistoryawsiamgroup_collected_at" to table: "aws_iam_groups_history"
+CREATE INDEX "bronzehistoryawsiamgroup_collected_at" ON "bronze_history"."aws_iam_groups_history" ("collected_at");
+-- Create index "bronzehistoryawsiamgroup_resource_id_valid_from" to table: "aws_iam_groups_history"
+CREATE INDEX "bronzehistoryawsiamgroup_resource_id_valid_from" ON "bronze_history"."aws_iam_groups_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryawsiamgroup_valid_to" to table: "aws_iam_groups_history"
+CREATE INDEX "bronzehistoryawsiamgroup_valid_to" ON "bronze_history"."aws_iam_groups_history" ("valid_to");
+-- Create "aws_iam_policies_history" table
+CREATE TABLE "bronze_history"."aws_iam_policies_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "arn" character varying NOT NULL,
+  "policy_name" character varying NOT NULL,
+  "path" character varying NULL,
+  "description" character varying NULL,
+  "default_version_id" character varying NULL,
+  "attachment_count" bigint NOT NULL DEFAULT 0,
+  "permissions_boundary_usage_count" bigint NOT NULL DEFAULT 0,
+  "is_attachable" boolean NOT NULL DEFAULT false,
+  "aws_managed" boolean NOT NULL DEFAULT false,
+  "create_date" timestamptz NULL,
+  "update_date" timestamptz NULL,
+  "versions_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryawsiampolicy_account_id" to table: "aws_iam_policies_history"
+CREATE INDEX "bronzehistoryawsiampolicy_account_id" ON "bronze_history"."aws_iam_policies_history" ("account_id");
+-- Create index "bronzehistoryawsiampolicy_collected_at" to table: "aws_iam_policies_history"
+CREATE INDEX "bronzehistoryawsiampolicy_collected_at" ON "bronze_history"."aws_iam_policies_history" ("collected_at");
+-- Create index "bronzehistoryawsiampolicy_resource_id_valid_from" to table: "aws_iam_policies_history"
+CREATE INDEX "bronzehistoryawsiampolicy_resource_id_valid_from" ON "bronze_history"."aws_iam_policies_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryawsiampolicy_valid_to" to table: "aws_iam_policies_history"
+CREATE INDEX "bronzehistoryawsiampolicy_valid_to" ON "bronze_history"."aws_iam_policies_history" ("valid_to");
+-- Create "aws_iam_roles_history" table
+CREATE TABLE "bronze_history"."aws_iam_roles_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "arn" character varying NOT NULL,
+  "role_name" character varying NOT NULL,
+  "path" character varying NULL,
+  "create_date" timestamptz NULL,
+  "role_last_used_date" timestamptz NULL,
+  "role_last_used_region" character varying NULL,
+  "permissions_boundary_arn" character varying NULL,
+  "assume_role_policy_json" jsonb NULL,
+  "attached_policies_json" jsonb NULL,
+  "inline_policies_json" jsonb NULL,
+  "instance_profiles_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryawsiamrole_account_id" to table: "aws_iam_roles_history"
+CREATE INDEX "bronzehistoryawsiamrole_account_id" ON "bronze_history"."aws_iam_roles_history" ("account_id");
+-- Create index "bronzehistoryawsiamrole_collected_at" to table: "aws_iam_roles_history"
+CREATE INDEX "bronzehistoryawsiamrole_collected_at" ON "bronze_history"."aws_iam_roles_history" ("collected_at");
+-- Create index "bronzehistoryawsiamrole_resource_id_valid_from" to table: "aws_iam_roles_history"
+CREATE INDEX "bronzehistoryawsiamrole_resource_id_valid_from" ON "bronze_history"."aws_iam_roles_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryawsiamrole_valid_to" to table: "aws_iam_roles_history"
+CREATE INDEX "bronzehistoryawsiamrole_valid_to" ON "bronze_history"."aws_iam_roles_history" ("valid_to");
+-- Create "aws_iam_users_history" table
+CREATE TABLE "bronze_history"."aws_iam_users_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "arn" character varying NOT NULL,
+  "user_name" character varying NOT NULL,
+  "path" character varying NULL,
+  "create_date" timestamptz NULL,
+  "permissions_boundary_arn" character varying NULL,
+  "attached_policies_json" jsonb NULL,
+  "inline_policies_json" jsonb NULL,
+  "groups_json" jsonb NULL,
+  "mfa_devices_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryawsiamuser_account_id" to table: "aws_iam_users_history"
+CREATE INDEX "bronzehistoryawsiamuser_account_id" ON "bronze_history"."aws_iam_users_history" ("account_id");
+-- Create index "bronzehistoryawsiamuser_collected_at" to table: "aws_iam_users_history"
+CREATE INDEX "bronzehistoryawsiamuser_collected_at" ON "bronze_history"."aws_iam_users_history" ("collected_at");
+-- Create index "bronzehistoryawsiamuser_resource_id_valid_from" to table: "aws_iam_users_history"
+CREATE INDEX "bronzehistoryawsiamuser_resource_id_valid_from" ON "bronze_history"."aws_iam_users_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryawsiamuser_valid_to" to table: "aws_iam_users_history"
+CREATE INDEX "bronzehistoryawsiamuser_valid_to" ON "bronze_history"."aws_iam_users_history" ("valid_to");
@@ -0,0 +1,325 @@
+-- Create "aws_ec2_addresses_history" table
+CREATE TABLE "bronzehistory"."aws_ec2_addresses_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "name" character varying NULL,
+  "public_ip" character varying NULL,
+  "domain" character varying NULL,
+  "association_id" character varying NULL,
+  "instance_id" character varying NULL,
+  "network_interface_id" character varying NULL,
+  "network_interface_owner_id" character varying NULL,
+  "private_ip_address" character varying NULL,
+  "public_ipv4_pool" character varying NULL,
+  "network_border_group" character varying NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryawsec2address_account_id" to table: "aws_ec2_addresses_history"
+CREATE INDEX "bronzehistoryawsec2address_account_id" ON "bronzehistory"."aws_ec2_addresses_history" ("account_id");
+-- Create index "bronzehistoryawsec2address_collected_at" to table: "aws_ec2_addresses_history"
+CREATE INDEX "bronzehistoryawsec2address_collected_at" ON "bronzehistory"."aws_ec2_addresses_history" ("collected_at");
+-- Create index "bronzehistoryawsec2address_region" to table: "aws_ec2_addresses_history"
+CREATE INDEX "bronzehistoryawsec2address_region" ON "bronzehistory"."aws_ec2_addresses_history" ("region");
+-- Create index "bronzehistoryawsec2address_resource_id_valid_from" to table: "aws_ec2_addresses_history"
+CREATE INDEX "bronzehistoryawsec2address_resource_id_valid_from" ON "bronzehistory"."aws_ec2_addresses_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryawsec2address_valid_to" to table: "aws_ec2_addresses_history"
+CREATE INDEX "bronzehistoryawsec2address_valid_to" ON "bronzehistory"."aws_ec2_addresses_history" ("valid_to");
+-- Create "aws_ec2_internet_gateways_history" table
+CREATE TABLE "bronzehistory"."aws_ec2_internet_gateways_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "name" character varying NULL,
+  "vpc_id" character varying NULL,
+  "attachment_state" character varying NULL,
+  "owner_id" character varying NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryawsec2internetgateway_account_id" to table: "aws_ec2_internet_gateways_history"
+CREATE INDEX "bronzehistoryawsec2internetgateway_account_id" ON "bronzehistory"."aws_ec2_internet_gateways_history" ("account_id");
+-- Create index "bronzehistoryawsec2internetgateway_collected_at" to table: "aws_ec2_internet_gateways_history"
+CREATE INDEX "bronzehistoryawsec2internetgateway_collected_at" ON "bronzehistory"."aws_ec2_internet_gateways_history" ("collected_at");
+-- Create index "bronzehistoryawsec2internetgateway_region" to table: "aws_ec2_internet_gateways_history"
+CREATE INDEX "bronzehistoryawsec2internetgateway_region" ON "bronzehistory"."aws_ec2_internet_gateways_history" ("region");
+-- Create index "bronzehistoryawsec2internetgateway_resource_id_valid_from" to table: "aws_ec2_internet_gateways_history"
+CREATE INDEX "bronzehistoryawsec2internetgateway_resource_id_valid_from" ON "bronzehistory"."aws_ec2_internet_gateways_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryawsec2internetgateway_valid_to" to table: "aws_ec2_internet_gateways_history"
+CREATE INDEX "bronzehistoryawsec2internetgateway_valid_to" ON "bronzehistory"."aws_ec2_internet_gateways_history" ("valid_to");
+-- Create "aws_ec2_nat_gateways_history" table
+CREATE TABLE "bronzehistory"."aws_ec2_nat_gateways_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "name" character varying NULL,
+  "vpc_id" character varying NULL,
+  "subnet_id" character varying NULL,
+  "state" character varying NULL,
+  "connectivity_type" character varying NULL,
+  "create_time" timestamptz NULL,
+  "failure_code" character varying NULL,
+  "failure_message" character varying NULL,
+  "addresses_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryawsec2natgateway_account_id" to table: "aws_ec2_nat_gateways_history"
+CREATE INDEX "bronzehistoryawsec2natgateway_account_id" ON "bronzehistory"."aws_ec2_nat_gateways_history" ("account_id");
+-- Create index "bronzehistoryawsec2natgateway_collected_at" to table: "aws_ec2_nat_gateways_history"
+CREATE INDEX "bronzehistoryawsec2natgateway_collected_at" ON "bronzehistory"."aws_ec2_nat_gateways_history" ("collected_at");
+-- Create index "bronzehistoryawsec2natgateway_region" to table: "aws_ec2_nat_gateways_history"
+CREATE INDEX "bronzehistoryawsec2natgateway_region" ON "bronzehistory"."aws_ec2_nat_gateways_history" ("region");
+-- Create index "bronzehistoryawsec2natgateway_resource_id_valid_from" to table: "aws_ec2_nat_gateways_history"
+CREATE INDEX "bronzehistoryawsec2natgateway_resource_id_valid_from" ON "bronzehistory"."aws_ec2_nat_gateways_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryawsec2natgateway_valid_to" to table: "aws_ec2_nat_gateways_history"
+CREATE INDEX "bronzehistoryawsec2natgateway_valid_to" ON "bronzehistory"."aws_ec2_nat_gateways_history" ("valid_to");
+-- Create "aws_ec2_network_acls_history" table
+CREATE TABLE "bronzehistory"."aws_ec2_network_acls_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "name" character varying NULL,
+  "vpc_id" character varying NULL,
+  "is_default" boolean NULL,
+  "owner_id" character varying NULL,
+  "entries_json" jsonb NULL,
+  "associations_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryawsec2networkacl_account_id" to table: "aws_ec2_network_acls_history"
+CREATE INDEX "bronzehistoryawsec2networkacl_account_id" ON "bronzehistory"."aws_ec2_network_acls_history" ("account_id");
+-- Create index "bronzehistoryawsec2networkacl_collected_at" to table: "aws_ec2_network_acls_history"
+CREATE INDEX "bronzehistoryawsec2networkacl_collected_at" ON "bronzehistory"."aws_ec2_network_acls_history" ("collected_at");
+-- Create index "bronzehistoryawsec2networkacl_region" to table: "aws_ec2_network_acls_history"
+CREATE INDEX "bronzehistoryawsec2networkacl_region" ON "bronzehistory"."aws_ec2_network_acls_history" ("region");
+-- Create index "bronzehistoryawsec2networkacl_resource_id_valid_from" to table: "aws_ec2_network_acls_history"
+CREATE INDEX "bronzehistoryawsec2networkacl_resource_id_valid_from" ON "bronzehistory"."aws_ec2_network_acls_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryawsec2networkacl_valid_to" to table: "aws_ec2_network_acls_history"
+CREATE INDEX "bronzehistoryawsec2networkacl_valid_to" ON "bronzehistory"."aws_ec2_network_acls_history" ("valid_to");
+-- Create "aws_ec2_network_interfaces_history" table
+CREATE TABLE "bronzehistory"."aws_ec2_network_interfaces_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "description" character varying NULL,
+  "interface_type" character varying NULL,
+  "status" character varying NULL,
+  "vpc_id" character varying NULL,
+  "subnet_id" character varying NULL,
+  "availability_zone" character varying NULL,
+  "mac_address" character varying NULL,
+  "private_ip_address" character varying NULL,
+  "private_dns_name" character varying NULL,
+  "public_ip" character varying NULL,
+  "public_dns_name" character varying NULL,
+  "source_dest_check" boolean NULL,
+  "requester_managed" boolean NULL,
+  "requester_id" character varying NULL,
+  "owner_id" character varying NULL,
+  "attachment_id" character varying NULL,
+  "attachment_instance_id" character varying NULL,
+  "attachment_status" character varying NULL,
+  "groups_json" jsonb NULL,
+  "private_ip_addresses_json" jsonb NULL,
+  "ipv6_addresses_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryawsec2networkinterface_account_id" to table: "aws_ec2_network_interfaces_history"
+CREATE INDEX "bronzehistoryawsec2networkinterface_account_id" ON "bronzehistory"."aws_ec2_network_interfaces_history" ("account_id");
+-- Create index "bronzehistoryawsec2networkinterface_collected_at" to table: "aws_ec2_network_interfaces_history"
+CREATE INDEX "bronzehistoryawsec2networkinterface_collected_at" ON "bronzehistory"."aws_ec2_network_interfaces_history" ("collected_at");
+-- Create index "bronzehistoryawsec2networkinterface_region" to table: "aws_ec2_network_interfaces_history"
+CREATE INDEX "bronzehistoryawsec2networkinterface_region" ON "bronzehistory"."aws_ec2_network_interfaces_history" ("region");
+-- Create index "bronzehistoryawsec2networkinterface_resource_id_valid_from" to table: "aws_ec2_network_interfaces_history"
+CREATE INDEX "bronzehistoryawsec2networkinterface_resource_id_valid_from" ON "bronzehistory"."aws_ec2_network_interfaces_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryawsec2networkinterface_valid_to" to table: "aws_ec2_network_interfaces_history"
+CREATE INDEX "bronzehistoryawsec2networkinterface_valid_to" ON "bronzehistory"."aws_ec2_network_interfaces_history" ("valid_to");
+-- Create "aws_ec2_route_tables_history" table
+CREATE TABLE "bronzehistory"."aws_ec2_route_tables_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "name" character varying NULL,
+  "vpc_id" character varying NULL,
+  "owner_id" character varying NULL,
+  "routes_json" jsonb NULL,
+  "associations_json" jsonb NULL,
+  "propagating_vgws_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryawsec2routetable_account_id" to table: "aws_ec2_route_tables_history"
+CREATE INDEX "bronzehistoryawsec2routetable_account_id" ON "bronzehistory"."aws_ec2_route_tables_history" ("account_id");
+-- Create index "bronzehistoryawsec2routetable_collected_at" to table: "aws_ec2_route_tables_history"
+CREATE INDEX "bronzehistoryawsec2routetable_collected_at" ON "bronzehistory"."aws_ec2_route_tables_history" ("collected_at");
+-- Create index "bronzehistoryawsec2routetable_region" to table: "aws_ec2_route_tables_history"
+CREATE INDEX "bronzehistoryawsec2routetable_region" ON "bronzehistory"."aws_ec2_route_tables_history" ("region");
+-- Create index "bronzehistoryawsec2routetable_resource_id_valid_from" to table: "aws_ec2_route_tables_history"
+CREATE INDEX "bronzehistoryawsec2routetable_resource_id_valid_from" ON "bronzehistory"."aws_ec2_route_tables_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryawsec2routetable_valid_to" to table: "aws_ec2_route_tables_history"
+CREATE INDEX "bronzehistoryawsec2routetable_valid_to" ON "bronzehistory"."aws_ec2_route_tables_history" ("valid_to");
+-- Create "aws_ec2_security_group_rules_history" table
+CREATE TABLE "bronzehistory"."aws_ec2_security_group_rules_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "group_id" character varying NULL,
+  "group_owner_id" character varying NULL,
+  "is_egress" boolean NULL,
+  "ip_protocol" character varying NULL,
+  "from_port" integer NULL,
+  "to_port" integer NULL,
+  "cidr_ipv4" character varying NULL,
+  "cidr_ipv6" character varying NULL,
+  "prefix_list_id" character varying NULL,
+  "referenced_group_id" character varying NULL,
+  "referenced_user_id" character varying NULL,
+  "description" character varying NULL,
+  "security_group_rule_arn" character varying NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryawsec2securitygrouprule_account_id" to table: "aws_ec2_security_group_rules_history"
+CREATE INDEX "bronzehistoryawsec2securitygrouprule_account_id" ON "bronzehistory"."aws_ec2_security_group_rules_history" ("account_id");
+-- Create index "bronzehistoryawsec2securitygrouprule_collected_at" to table: "aws_ec2_security_group_rules_history"
+CREATE INDEX "bronzehistoryawsec2securitygrouprule_collected_at" ON "bronzehistory"."aws_ec2_security_group_rules_history" ("collected_at");
+-- Create index "bronzehistoryawsec2securitygrouprule_region" to table: "aws_ec2_security_group_rules_history"
+CREATE INDEX "bronzehistoryawsec2securitygrouprule_region" ON "bronzehistory"."aws_ec2_security_group_rules_history" ("region");
+-- Create index "bronzehistoryawsec2securitygrouprule_resource_id_valid_from" to table: "aws_ec2_security_group_rules_history"
+CREATE INDEX "bronzehistoryawsec2securitygrouprule_resource_id_valid_from" ON "bronzehistory"."aws_ec2_security_group_rules_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryawsec2securitygrouprule_valid_to" to table: "aws_ec2_security_group_rules_history"
+CREATE INDEX "bronzehistoryawsec2securitygrouprule_valid_to" ON "bronzehistory"."aws_ec2_security_group_rules_history" ("valid_to");
+-- Create "aws_ec2_security_groups_history" table
+CREATE TABLE "bronzehistory"."aws_ec2_security_groups_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "group_name" character varying NULL,
+  "description" character varying NULL,
+  "vpc_id" character varying NULL,
+  "owner_id" character varying NULL,
+  "security_group_arn" character varying NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryawsec2securitygroup_account_id" to table: "aws_ec2_security_groups_history"
+CREATE INDEX "bronzehistoryawsec2securitygroup_account_id" ON "bronzehistory"."aws_ec2_security_groups_history" ("account_id");
+-- Create index "bronzehistoryawsec2securitygroup_collected_at" to table: "aws_ec2_security_groups_history"
+CREATE INDEX "bronzehistoryawsec2securitygroup_collected_at" ON "bronzehistory"."aws_ec2_security_groups_history" ("collected_at");
+-- Create index "bronzehistoryawsec2securitygroup_region" to table: "aws_ec2_security_groups_history"
+CREATE INDEX "bronzehistoryawsec2securitygroup_region" ON "bronzehistory"."aws_ec2_security_groups_history" ("region");
+-- Create index "bronzehistoryawsec2securitygroup_resource_id_valid_from" to table: "aws_ec2_security_groups_history"
+CREATE INDEX "bronzehistoryawsec2securitygroup_resource_id_valid_from" ON "bronzehistory"."aws_ec2_security_groups_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryawsec2securitygroup_valid_to" to table: "aws_ec2_security_groups_history"
+CREATE INDEX "bronzehistoryawsec2securitygroup_valid_to" ON "bronzehistory"."aws_ec2_security_groups_history" ("valid_to");
+-- Create "aws_ec2_subnets_history" table
+CREATE TABLE "bronzehistory"."aws_ec2_subnets_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "name" character varying NULL,
+  "vpc_id" character varying NULL,
+  "cidr_block" character varying NULL,
+  "availability_zone" character varying NULL,
+  "availability_zone_id" character varying NULL,
+  "state" character varying NULL,
+  "default_for_az" boolean NULL,
+  "map_public_ip_on_launch" boolean NULL,
+  "assign_ipv6_address_on_creation" boolean NULL,
+  "subnet_arn" character varying NULL,
+  "owner_id" character varying NULL,
+  "ipv6_cidr_block_associations_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryawsec2subnet_account_id" to table: "aws_ec2_subnets_history"
+CREATE INDEX "bronzehistoryawsec2subnet_account_id" ON "bronzehistory"."aws_ec2_subnets_history" ("account_id");
+-- Create index "bronzehistoryawsec2subnet_collected_at" to table: "aws_ec2_subnets_history"
+CREATE INDEX "bronzehistoryawsec2subnet_collected_at" ON "bronzehistory"."aws_ec2_subnets_history" ("collected_at");
+-- Create index "bronzehistoryawsec2subnet_region" to table: "aws_ec2_subnets_history"
+CREATE INDEX "bronzehistoryawsec2subnet_region" ON "bronzehistory"."aws_ec2_subnets_history" ("region");
+-- Create index "bronzehistoryawsec2subnet_resource_id_valid_from" to table: "aws_ec2_subnets_history"
+CREATE INDEX "bronzehistoryawsec2subnet_resource_id_valid_from" ON "bronzehistory"."aws_ec2_subnets_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryawsec2subnet_valid_to" to table: "aws_ec2_subnets_history"
+CREATE INDEX "bronzehistoryawsec2subnet_valid_to" ON "bronzehistory"."aws_ec2_subnets_history" ("valid_to");
+-- Create "aws_ec2_vpcs_history" table
+CREATE TABLE "bronzehistory"."aws_ec2_vpcs_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "name" character varying NULL,
+  "cidr_block" character varying NULL,
+  "state" character varying NULL,
+  "is_default" boolean NULL,
+  "instance_tenancy" character varying NULL,
+  "dhcp_options_id" character varying NULL,
+  "owner_id" character varying NULL,
+  "cidr_block_associations_json" jsonb NULL,
+  "ipv6_cidr_block_associations_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryawsec2vpc_account_id" to table: "aws_ec2_vpcs_history"
+CREATE INDEX "bronzehistoryawsec2vpc_account_id" ON "bronzehistory"."aws_ec2_vpcs_history" ("account_id");
+-- Create index "bronzehistoryawsec2vpc_collected_at" to table: "aws_ec2_vpcs_history"
+CREATE INDEX "bronzehistoryawsec2vpc_collected_at" ON "bronzehistory"."aws_ec2_vpcs_history" ("collected_at");
+-- Create index "bronzehistoryawsec2vpc_region" to table: "aws_ec2_vpcs_history"
+CREATE INDEX "bronzehistoryawsec2vpc_region" ON "bronzehistory"."aws_ec2_vpcs_history" ("region");
+-- Create index "bronzehistoryawsec2vpc_resource_id_valid_from" to table: "aws_ec2_vpcs_history"
+CREATE INDEX "bronzehistoryawsec2vpc_resource_id_valid_from" ON "bronzehistory"."aws_ec2_vpcs_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryawsec2vpc_valid_to" to table: "aws_ec2_vpcs_history"
+CREATE INDEX "bronzehistoryawsec2vpc_valid_to" ON "bronzehistory"."aws_ec2_vpcs_history" ("valid_to");
@@ -0,0 +1,118 @@
+-- Create "aws_cloudtrail_trails_history" table
+CREATE TABLE "bronzehistory"."aws_cloudtrail_trails_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "name" character varying NULL,
+  "s3_bucket_name" character varying NULL,
+  "s3_key_prefix" character varying NULL,
+  "sns_topic_arn" character varying NULL,
+  "is_multi_region_trail" boolean NULL,
+  "is_organization_trail" boolean NULL,
+  "include_global_service_events" boolean NULL,
+  "log_file_validation_enabled" boolean NULL,
+  "kms_key_id" character varying NULL,
+  "cloud_watch_logs_log_group_arn" character varying NULL,
+  "cloud_watch_logs_role_arn" character varying NULL,
+  "has_custom_event_selectors" boolean NULL,
+  "has_insight_selectors" boolean NULL,
+  "is_logging" boolean NULL,
+  "event_selectors_json" jsonb NULL,
+  "advanced_event_selectors_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryawscloudtrailtrail_account_id" to table: "aws_cloudtrail_trails_history"
+CREATE INDEX "bronzehistoryawscloudtrailtrail_account_id" ON "bronzehistory"."aws_cloudtrail_trails_history" ("account_id");
+-- Create index "bronzehistoryawscloudtrailtrail_collected_at" to table: "aws_cloudtrail_trails_history"
+CREATE INDEX "bronzehistoryawscloudtrailtrail_collected_at" ON "bronzehistory"."aws_cloudtrail_trails_history" ("collected_at");
+-- Create index "bronzehistoryawscloudtrailtrail_region" to table: "aws_cloudtrail_trails_history"
+CREATE INDEX "bronzehistoryawscloudtrailtrail_region" ON "bronzehistory"."aws_cloudtrail_trails_history" ("region");
+-- Create index "bronzehistoryawscloudtrailtrail_resource_id_valid_from" to table: "aws_cloudtrail_trails_history"
+CREATE INDEX "bronzehistoryawscloudtrailtrail_resource_id_valid_from" ON "bronzehistory"."aws_cloudtrail_trails_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryawscloudtrailtrail_valid_to" to table: "aws_cloudtrail_trails_history"
+CREATE INDEX "bronzehistoryawscloudtrailtrail_valid_to" ON "bronzehistory"."aws_cloudtrail_trails_history" ("valid_to");
+-- Create "aws_kms_keys_history" table
+CREATE TABLE "bronzehistory"."aws_kms_keys_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "arn" character varying NULL,
+  "description" character varying NULL,
+  "key_state" character varying NULL,
+  "key_usage" character varying NULL,
+  "key_spec" character varying NULL,
+  "key_manager" character varying NULL,
+  "origin" character varying NULL,
+  "enabled" boolean NULL,
+  "multi_region" boolean NULL,
+  "creation_date" timestamptz NULL,
+  "deletion_date" timestamptz NULL,
+  "rotation_enabled" boolean NULL,
+  "rotation_period_in_days" integer NULL,
+  "next_rotation_date" timestamptz NULL,
+  "policy_json" jsonb NULL,
+  "aliases_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryawskmskey_account_id" to table: "aws_kms_keys_history"
+CREATE INDEX "bronzehistoryawskmskey_account_id" ON "bronzehistory"."aws_kms_keys_history" ("account_id");
+-- Create index "bronzehistoryawskmskey_collected_at" to table: "aws_kms_keys_history"
+CREATE INDEX "bronzehistoryawskmskey_collected_at" ON "bronzehistory"."aws_kms_keys_history" ("collected_at");
+-- Create index "bronzehistoryawskmskey_region" to table: "aws_kms_keys_history"
+CREATE INDEX "bronzehistoryawskmskey_region" ON "bronzehistory"."aws_kms_keys_history" ("region");
+-- Create index "bronzehistoryawskmskey_resource_id_valid_from" to table: "aws_kms_keys_history"
+CREATE INDEX "bronzehistoryawskmskey_resource_id_valid_from" ON "bronzehistory"."aws_kms_keys_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryawskmskey_valid_to" to table: "aws_kms_keys_history"
+CREATE INDEX "bronzehistoryawskmskey_valid_to" ON "bronzehistory"."aws_kms_keys_history" ("valid_to");
+-- Create "aws_s3_buckets_history" table
+CREATE TABLE "bronzehistory"."aws_s3_buckets_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "arn" character varying NULL,
+  "creation_date" timestamptz NULL,
+  "owner_id" character varying NULL,
+  "block_public_acls" boolean NULL,
+  "ignore_public_acls" boolean NULL,
+  "block_public_policy" boolean NULL,
+  "restrict_public_buckets" boolean NULL,
+  "policy_is_public" boolean NULL,
+  "sse_algorithm" character varying NULL,
+  "kms_master_key_id" character varying NULL,
+  "bucket_key_enabled" boolean NULL,
+  "versioning_status" character varying NULL,
+  "mfa_delete" character varying NULL,
+  "logging_target_bucket" character varying NULL,
+  "logging_target_prefix" character varying NULL,
+  "policy_json" jsonb NULL,
+  "acl_grants_json" jsonb NULL,
+  "tags_json" jsonb NULL,
+  "account_id" character varying NOT NULL,
+  "region" character varying NOT NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryawss3bucket_account_id" to table: "aws_s3_buckets_history"
+CREATE INDEX "bronzehistoryawss3bucket_account_id" ON "bronzehistory"."aws_s3_buckets_history" ("account_id");
+-- Create index "bronzehistoryawss3bucket_collected_at" to table: "aws_s3_buckets_history"
+CREATE INDEX "bronzehistoryawss3bucket_collected_at" ON "bronzehistory"."aws_s3_buckets_history" ("collected_at");
+-- Create index "bronzehistoryawss3bucket_region" to table: "aws_s3_buckets_history"
+CREATE INDEX "bronzehistoryawss3bucket_region" ON "bronzehistory"."aws_s3_buckets_history" ("region");
+-- Create index "bronzehistoryawss3bucket_resource_id_valid_from" to table: "aws_s3_buckets_history"
+CREATE INDEX "bronzehistoryawss3bucket_resource_id_valid_from" ON "bronzehistory"."aws_s3_buckets_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryawss3bucket_valid_to" to table: "aws_s3_buckets_history"
+CREATE INDEX "bronzehistoryawss3bucket_valid_to" ON "bronzehistory"."aws_s3_buckets_history" ("valid_to");
@@ -0,0 +1,201 @@
+-- Add new schema named "bronze_history"
+CREATE SCHEMA IF NOT EXISTS "bronze_history";
+-- Create "k8s_images_history" table
+CREATE TABLE "bronze_history"."k8s_images_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "cluster_name" character varying NOT NULL,
+  "registry" character varying NOT NULL,
+  "repository" character varying NOT NULL,
+  "digest" character varying NULL,
+  "tags_json" jsonb NULL,
+  "namespaces_json" jsonb NULL,
+  "workloads_json" jsonb NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryk8simage_cluster_name" to table: "k8s_images_history"
+CREATE INDEX "bronzehistoryk8simage_cluster_name" ON "bronze_history"."k8s_images_history" ("cluster_name");
+-- Create index "bronzehistoryk8simage_collected_at" to table: "k8s_images_history"
+CREATE INDEX "bronzehistoryk8simage_collected_at" ON "bronze_history"."k8s_images_history" ("collected_at");
+-- Create index "bronzehistoryk8simage_resource_id_valid_from" to table: "k8s_images_history"
+CREATE INDEX "bronzehistoryk8simage_resource_id_valid_from" ON "bronze_history"."k8s_images_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryk8simage_valid_to" to table: "k8s_images_history"
+CREATE INDEX "bronzehistoryk8simage_valid_to" ON "bronze_history"."k8s_images_history" ("valid_to");
+-- Create "k8s_namespaces_history" table
+CREATE TABLE "bronze_history"."k8s_namespaces_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "cluster_name" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "uid" character varying NULL,
+  "phase" character varying NULL,
+  "labels_json" jsonb NULL,
+  "api_created_at" timestamptz NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryk8snamespace_cluster_name" to table: "k8s_namespaces_history"
+CREATE INDEX "bronzehistoryk8snamespace_cluster_name" ON "bronze_history"."k8s_namespaces_history" ("cluster_name");
+-- Create index "bronzehistoryk8snamespace_collected_at" to table: "k8s_namespaces_history"
+CREATE INDEX "bronzehistoryk8snamespace_collected_at" ON "bronze_history"."k8s_namespaces_history" ("collected_at");
+-- Create index "bronzehistoryk8snamespace_resource_id_valid_from" to table: "k8s_namespaces_history"
+CREATE INDEX "bronzehistoryk8snamespace_resource_id_valid_from" ON "bronze_history"."k8s_namespaces_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryk8snamespace_valid_to" to table: "k8s_namespaces_history"
+CREATE INDEX "bronzehistoryk8snamespace_valid_to" ON "bronze_history"."k8s_namespaces_history" ("valid_to");
+-- Create "k8s_network_policies_history" table
+CREATE TABLE "bronze_history"."k8s_network_policies_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "cluster_name" character varying NOT NULL,
+  "namespace" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "uid" character varying NULL,
+  "pod_selector_json" jsonb NULL,
+  "policy_types_json" jsonb NULL,
+  "ingress_json" jsonb NULL,
+  "egress_json" jsonb NULL,
+  "labels_json" jsonb NULL,
+  "api_created_at" timestamptz NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryk8snetworkpolicy_cluster_name" to table: "k8s_network_policies_history"
+CREATE INDEX "bronzehistoryk8snetworkpolicy_cluster_name" ON "bronze_history"."k8s_network_policies_history" ("cluster_name");
+-- Create index "bronzehistoryk8snetworkpolicy_collected_at" to table: "k8s_network_policies_history"
+CREATE INDEX "bronzehistoryk8snetworkpolicy_collected_at" ON "bronze_history"."k8s_network_policies_history" ("collected_at");
+-- Create index "bronzehistoryk8snetworkpolicy_resource_id_valid_from" to table: "k8s_network_policies_history"
+CREATE INDEX "bronzehistoryk8snetworkpolicy_resource_id_valid_from" ON "bronze_history"."k8s_network_policies_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryk8snetworkpolicy_valid_to" to table: "k8s_network_policies_history"
+CREATE INDEX "bronzehistoryk8snetworkpolicy_valid_to" ON "bronze_history"."k8s_network_policies_history" ("valid_to");
+-- Create "k8s_pods_history" table
+CREATE TABLE "bronze_history"."k8s_pods_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "cluster_name" character varying NOT NULL,
+  "namespace" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "uid" character varying NULL,
+  "phase" character varying NULL,
+  "node_name" character varying NULL,
+  "pod_ip" character varying NULL,
+  "host_ip" character varying NULL,
+  "service_account" character varying NULL,
+  "workload_kind" character varying NULL,
+  "workload_name" character varying NULL,
+  "host_network" boolean NOT NULL DEFAULT false,
+  "containers_json" jsonb NULL,
+  "labels_json" jsonb NULL,
+  "api_created_at" timestamptz NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryk8spod_cluster_name" to table: "k8s_pods_history"
+CREATE INDEX "bronzehistoryk8spod_cluster_name" ON "bronze_history"."k8s_pods_history" ("cluster_name");
+-- Create index "bronzehistoryk8spod_collected_at" to table: "k8s_pods_history"
+CREATE INDEX "bronzehistoryk8spod_collected_at" ON "bronze_history"."k8s_pods_history" ("collected_at");
+-- Create index "bronzehistoryk8spod_resource_id_valid_from" to table: "k8s_pods_history"
+CREATE INDEX "bronzehistoryk8spod_resource_id_valid_from" ON "bronze_history"."k8s_pods_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryk8spod_valid_to" to table: "k8s_pods_history"
+CREATE INDEX "bronzehistoryk8spod_valid_to" ON "bronze_history"."k8s_pods_history" ("valid_to");
+-- Create "k8s_role_bindings_history" table
+CREATE TABLE "bronze_history"."k8s_role_bindings_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "cluster_name" character varying NOT NULL,
+  "kind" character varying NOT NULL,
+  "namespace" character varying NULL,
+  "name" character varying NOT NULL,
+  "uid" character varying NULL,
+  "role_kind" character varying NOT NULL,
+  "role_name" character varying NOT NULL,
+  "subjects_json" jsonb NULL,
+  "rules_json" jsonb NULL,
+  "labels_json" jsonb NULL,
+  "api_created_at" timestamptz NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryk8srolebinding_cluster_name" to table: "k8s_role_bindings_history"
+CREATE INDEX "bronzehistoryk8srolebinding_cluster_name" ON "bronze_history"."k8s_role_bindings_history" ("cluster_name");
+-- Create index "bronzehistoryk8srolebinding_collected_at" to table: "k8s_role_bindings_history"
+CREATE INDEX "bronzehistoryk8srolebinding_collected_at" ON "bronze_history"."k8s_role_bindings_history" ("collected_at");
+-- Create index "bronzehistoryk8srolebinding_resource_id_valid_from" to table: "k8s_role_bindings_history"
+CREATE INDEX "bronzehistoryk8srolebinding_resource_id_valid_from" ON "bronze_history"."k8s_role_bindings_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryk8srolebinding_valid_to" to table: "k8s_role_bindings_history"
+CREATE INDEX "bronzehistoryk8srolebinding_valid_to" ON "bronze_history"."k8s_role_bindings_history" ("valid_to");
+-- Create "k8s_service_accounts_history" table
+CREATE TABLE "bronze_history"."k8s_service_accounts_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "cluster_name" character varying NOT NULL,
+  "namespace" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "uid" character varying NULL,
+  "automount_token" boolean NULL,
+  "cloud_identity" character varying NULL,
+  "image_pull_secrets_json" jsonb NULL,
+  "labels_json" jsonb NULL,
+  "api_created_at" timestamptz NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryk8sserviceaccount_cluster_name" to table: "k8s_service_accounts_history"
+CREATE INDEX "bronzehistoryk8sserviceaccount_cluster_name" ON "bronze_history"."k8s_service_accounts_history" ("cluster_name");
+-- Create index "bronzehistoryk8sserviceaccount_collected_at" to table: "k8s_service_accounts_history"
+CREATE INDEX "bronzehistoryk8sserviceaccount_collected_at" ON "bronze_history"."k8s_service_accounts_history" ("collected_at");
+-- Create index "bronzehistoryk8sserviceaccount_resource_id_valid_from" to table: "k8s_service_accounts_history"
+CREATE INDEX "bronzehistoryk8sserviceaccount_resource_id_valid_from" ON "bronze_history"."k8s_service_accounts_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryk8sserviceaccount_valid_to" to table: "k8s_service_accounts_history"
+CREATE INDEX "bronzehistoryk8sserviceaccount_valid_to" ON "bronze_history"."k8s_service_accounts_history" ("valid_to");
+-- Create "k8s_workloads_history" table
+CREATE TABLE "bronze_history"."k8s_workloads_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "cluster_name" character varying NOT NULL,
+  "namespace" character varying NOT NULL,
+  "kind" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "uid" character varying NULL,
+  "replicas" integer NULL,
+  "service_account" character varying NULL,
+  "host_network" boolean NOT NULL DEFAULT false,
+  "host_pid" boolean NOT NULL DEFAULT false,
+  "host_ipc" boolean NOT NULL DEFAULT false,
+  "images_json" jsonb NULL,
+  "containers_json" jsonb NULL,
+  "selector_json" jsonb NULL,
+  "labels_json" jsonb NULL,
+  "api_created_at" timestamptz NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryk8sworkload_cluster_name" to table: "k8s_workloads_history"
+CREATE INDEX "bronzehistoryk8sworkload_cluster_name" ON "bronze_history"."k8s_workloads_history" ("cluster_name");
+-- Create index "bronzehistoryk8sworkload_collected_at" to table: "k8s_workloads_history"
+CREATE INDEX "bronzehistoryk8sworkload_collected_at" ON "bronze_history"."k8s_workloads_history" ("collected_at");
+-- Create index "bronzehistoryk8sworkload_resource_id_valid_from" to table: "k8s_workloads_history"
+CREATE INDEX "bronzehistoryk8sworkload_resource_id_valid_from" ON "bronze_history"."k8s_workloads_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryk8sworkload_valid_to" to table: "k8s_workloads_history"
+CREATE INDEX "bronzehistoryk8sworkload_valid_to" ON "bronze_history"."k8s_workloads_history" ("valid_to");
@@ -0,0 +1,38 @@
+-- Create "k8s_nodes_history" table
+CREATE TABLE "bronze_history"."k8s_nodes_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "cluster_name" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "uid" character varying NULL,
+  "provider_id" character varying NULL,
+  "internal_ip" character varying NULL,
+  "external_ip" character varying NULL,
+  "node_pool" character varying NULL,
+  "instance_type" character varying NULL,
+  "region" character varying NULL,
+  "zone" character varying NULL,
+  "kubelet_version" character varying NULL,
+  "os_image" character varying NULL,
+  "kernel_version" character varying NULL,
+  "container_runtime" character varying NULL,
+  "architecture" character varying NULL,
+  "ready" boolean NOT NULL DEFAULT false,
+  "unschedulable" boolean NOT NULL DEFAULT false,
+  "labels_json" jsonb NULL,
+  "taints_json" jsonb NULL,
+  "api_created_at" timestamptz NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistoryk8snode_cluster_name" to table: "k8s_nodes_history"
+CREATE INDEX "bronzehistoryk8snode_cluster_name" ON "bronze_history"."k8s_nodes_history" ("cluster_name");
+-- Create index "bronzehistoryk8snode_collected_at" to table: "k8s_nodes_history"
+CREATE INDEX "bronzehistoryk8snode_collected_at" ON "bronze_history"."k8s_nodes_history" ("collected_at");
+-- Create index "bronzehistoryk8snode_resource_id_valid_from" to table: "k8s_nodes_history"
+CREATE INDEX "bronzehistoryk8snode_resource_id_valid_from" ON "bronze_history"."k8s_nodes_history" ("resource_id", "valid_from");
+-- Create index "bronzehistoryk8snode_valid_to" to table: "k8s_nodes_history"
+CREATE INDEX "bronzehistoryk8snode_valid_to" ON "bronze_history"."k8s_nodes_history" ("valid_to");
@@ -0,0 +1,89 @@
+-- Create "s1_alerts_history" table
+CREATE TABLE "bronzehistory"."s1_alerts_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "agent_id" character varying NULL,
+  "agent_uuid" character varying NULL,
+  "agent_name" character varying NULL,
+  "agent_os_name" character varying NULL,
+  "site_id" character varying NULL,
+  "rule_id" character varying NULL,
+  "rule_name" character varying NULL,
+  "rule_severity" character varying NULL,
+  "rule_description" character varying NULL,
+  "rule_scope_level" character varying NULL,
+  "rule_treat_as_threat" character varying NULL,
+  "analyst_verdict" character varying NULL,
+  "incident_status" character varying NULL,
+  "event_type" character varying NULL,
+  "hit_type" character varying NULL,
+  "source" character varying NULL,
+  "dv_event_id" character varying NULL,
+  "is_edr" boolean NOT NULL DEFAULT false,
+  "reported_at" timestamptz NULL,
+  "api_created_at" timestamptz NULL,
+  "api_updated_at" timestamptz NULL,
+  "source_process_json" jsonb NULL,
+  "target_process_json" jsonb NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistorys1alert_agent_id" to table: "s1_alerts_history"
+CREATE INDEX "bronzehistorys1alert_agent_id" ON "bronzehistory"."s1_alerts_history" ("agent_id");
+-- Create index "bronzehistorys1alert_collected_at" to table: "s1_alerts_history"
+CREATE INDEX "bronzehistorys1alert_collected_at" ON "bronzehistory"."s1_alerts_history" ("collected_at");
+-- Create index "bronzehistorys1alert_resource_id_valid_from" to table: "s1_alerts_history"
+CREATE INDEX "bronzehistorys1alert_resource_id_valid_from" ON "bronzehistory"."s1_alerts_history" ("resource_id", "valid_from");
+-- Create index "bronzehistorys1alert_valid_to" to table: "s1_alerts_history"
+CREATE INDEX "bronzehistorys1alert_valid_to" ON "bronzehistory"."s1_alerts_history" ("valid_to");
+-- Create "s1_threats_history" table
+CREATE TABLE "bronzehistory"."s1_threats_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "agent_id" character varying NULL,
+  "agent_uuid" character varying NULL,
+  "agent_computer_name" character varying NULL,
+  "agent_os_type" character varying NULL,
+  "account_id" character varying NULL,
+  "site_id" character varying NULL,
+  "site_name" character varying NULL,
+  "group_id" character varying NULL,
+  "threat_name" character varying NULL,
+  "classification" character varying NULL,
+  "classification_source" character varying NULL,
+  "confidence_level" character varying NULL,
+  "mitigation_status" character varying NULL,
+  "analyst_verdict" character varying NULL,
+  "incident_status" character varying NULL,
+  "initiated_by" character varying NULL,
+  "detection_type" character varying NULL,
+  "file_path" character varying NULL,
+  "sha1" character varying NULL,
+  "sha256" character varying NULL,
+  "md5" character varying NULL,
+  "storyline" character varying NULL,
+  "is_fileless" boolean NOT NULL DEFAULT false,
+  "mitigated_preemptively" boolean NOT NULL DEFAULT false,
+  "identified_at" timestamptz NULL,
+  "api_created_at" timestamptz NULL,
+  "api_updated_at" timestamptz NULL,
+  "engines_json" jsonb NULL,
+  "indicators_json" jsonb NULL,
+  "mitigation_actions_json" jsonb NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistorys1threat_agent_id" to table: "s1_threats_history"
+CREATE INDEX "bronzehistorys1threat_agent_id" ON "bronzehistory"."s1_threats_history" ("agent_id");
+-- Create index "bronzehistorys1threat_collected_at" to table: "s1_threats_history"
+CREATE INDEX "bronzehistorys1threat_collected_at" ON "bronzehistory"."s1_threats_history" ("collected_at");
+-- Create index "bronzehistorys1threat_resource_id_valid_from" to table: "s1_threats_history"
+CREATE INDEX "bronzehistorys1threat_resource_id_valid_from" ON "bronzehistory"."s1_threats_history" ("resource_id", "valid_from");
+-- Create index "bronzehistorys1threat_valid_to" to table: "s1_threats_history"
+CREATE INDEX "bronzehistorys1threat_valid_to" ON "bronzehistory"."s1_threats_history" ("valid_to");
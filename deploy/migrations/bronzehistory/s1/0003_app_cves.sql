@@ -0,0 +1,39 @@
+-- Create "s1_app_cves_history" table
+CREATE TABLE "bronzehistory"."s1_app_cves_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "agent_id" character varying NOT NULL,
+  "endpoint_name" character varying NULL,
+  "endpoint_type" character varying NULL,
+  "os_type" character varying NULL,
+  "application_name" character varying NOT NULL,
+  "application_vendor" character varying NULL,
+  "application_version" character varying NULL,
+  "cve_id" character varying NOT NULL,
+  "cvss_score" double precision NOT NULL DEFAULT 0,
+  "cvss_version" character varying NULL,
+  "nvd_severity" character varying NULL,
+  "risk_score" double precision NOT NULL DEFAULT 0,
+  "exploit_code_maturity" character varying NULL,
+  "exploited_in_the_wild" boolean NOT NULL DEFAULT false,
+  "remediation_level" character varying NULL,
+  "report_confidence" character varying NULL,
+  "status" character varying NULL,
+  "published_date" timestamptz NULL,
+  "detection_date" timestamptz NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistorys1appcve_agent_id" to table: "s1_app_cves_history"
+CREATE INDEX "bronzehistorys1appcve_agent_id" ON "bronzehistory"."s1_app_cves_history" ("agent_id");
+-- Create index "bronzehistorys1appcve_collected_at" to table: "s1_app_cves_history"
+CREATE INDEX "bronzehistorys1appcve_collected_at" ON "bronzehistory"."s1_app_cves_history" ("collected_at");
+-- Create index "bronzehistorys1appcve_cve_id" to table: "s1_app_cves_history"
+CREATE INDEX "bronzehistorys1appcve_cve_id" ON "bronzehistory"."s1_app_cves_history" ("cve_id");
+-- Create index "bronzehistorys1appcve_resource_id_valid_from" to table: "s1_app_cves_history"
+CREATE INDEX "bronzehistorys1appcve_resource_id_valid_from" ON "bronzehistory"."s1_app_cves_history" ("resource_id", "valid_from");
+-- Create index "bronzehistorys1appcve_valid_to" to table: "s1_app_cves_history"
+CREATE INDEX "bronzehistorys1appcve_valid_to" ON "bronzehistory"."s1_app_cves_history" ("valid_to");
@@ -0,0 +1,107 @@
+-- Create "s1_exclusions_history" table
+CREATE TABLE "bronzehistory"."s1_exclusions_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "scope_level" character varying NOT NULL,
+  "account_id" character varying NULL,
+  "site_id" character varying NULL,
+  "group_id" character varying NULL,
+  "scope_name" character varying NULL,
+  "scope_path" character varying NULL,
+  "exclusion_type" character varying NOT NULL,
+  "exclusion_value" character varying NULL,
+  "os_type" character varying NULL,
+  "mode" character varying NULL,
+  "path_exclusion_type" character varying NULL,
+  "description" character varying NULL,
+  "source" character varying NULL,
+  "user_id" character varying NULL,
+  "user_name" character varying NULL,
+  "application_name" character varying NULL,
+  "include_children" boolean NOT NULL DEFAULT false,
+  "include_subfolders" boolean NOT NULL DEFAULT false,
+  "imported" boolean NOT NULL DEFAULT false,
+  "actions_json" jsonb NULL,
+  "api_created_at" timestamptz NULL,
+  "api_updated_at" timestamptz NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistorys1exclusion_collected_at" to table: "s1_exclusions_history"
+CREATE INDEX "bronzehistorys1exclusion_collected_at" ON "bronzehistory"."s1_exclusions_history" ("collected_at");
+-- Create index "bronzehistorys1exclusion_exclusion_type" to table: "s1_exclusions_history"
+CREATE INDEX "bronzehistorys1exclusion_exclusion_type" ON "bronzehistory"."s1_exclusions_history" ("exclusion_type");
+-- Create index "bronzehistorys1exclusion_resource_id_valid_from" to table: "s1_exclusions_history"
+CREATE INDEX "bronzehistorys1exclusion_resource_id_valid_from" ON "bronzehistory"."s1_exclusions_history" ("resource_id", "valid_from");
+-- Create index "bronzehistorys1exclusion_valid_to" to table: "s1_exclusions_history"
+CREATE INDEX "bronzehistorys1exclusion_valid_to" ON "bronzehistory"."s1_exclusions_history" ("valid_to");
+-- Create "s1_policies_history" table
+CREATE TABLE "bronzehistory"."s1_policies_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "scope_level" character varying NOT NULL,
+  "account_id" character varying NULL,
+  "site_id" character varying NULL,
+  "group_id" character varying NULL,
+  "scope_name" character varying NULL,
+  "inherited_from" character varying NULL,
+  "mitigation_mode" character varying NULL,
+  "mitigation_mode_suspicious" character varying NULL,
+  "auto_mitigation_action" character varying NULL,
+  "anti_tampering_on" boolean NOT NULL DEFAULT false,
+  "snapshots_on" boolean NOT NULL DEFAULT false,
+  "agent_logging_on" boolean NOT NULL DEFAULT false,
+  "scan_new_agents" boolean NOT NULL DEFAULT false,
+  "network_quarantine_on" boolean NOT NULL DEFAULT false,
+  "engines_json" jsonb NULL,
+  "policy_json" jsonb NULL,
+  "api_updated_at" timestamptz NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistorys1policy_collected_at" to table: "s1_policies_history"
+CREATE INDEX "bronzehistorys1policy_collected_at" ON "bronzehistory"."s1_policies_history" ("collected_at");
+-- Create index "bronzehistorys1policy_resource_id_valid_from" to table: "s1_policies_history"
+CREATE INDEX "bronzehistorys1policy_resource_id_valid_from" ON "bronzehistory"."s1_policies_history" ("resource_id", "valid_from");
+-- Create index "bronzehistorys1policy_valid_to" to table: "s1_policies_history"
+CREATE INDEX "bronzehistorys1policy_valid_to" ON "bronzehistory"."s1_policies_history" ("valid_to");
+-- Create "s1_restrictions_history" table
+CREATE TABLE "bronzehistory"."s1_restrictions_history" (
+  "history_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "valid_from" timestamptz NOT NULL,
+  "valid_to" timestamptz NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "resource_id" character varying NOT NULL,
+  "scope_level" character varying NOT NULL,
+  "account_id" character varying NULL,
+  "site_id" character varying NULL,
+  "group_id" character varying NULL,
+  "scope_name" character varying NULL,
+  "scope_path" character varying NULL,
+  "restriction_type" character varying NOT NULL,
+  "restriction_value" character varying NULL,
+  "os_type" character varying NULL,
+  "description" character varying NULL,
+  "source" character varying NULL,
+  "user_id" character varying NULL,
+  "user_name" character varying NULL,
+  "imported" boolean NOT NULL DEFAULT false,
+  "api_created_at" timestamptz NULL,
+  "api_updated_at" timestamptz NULL,
+  PRIMARY KEY ("history_id")
+);
+-- Create index "bronzehistorys1restriction_collected_at" to table: "s1_restrictions_history"
+CREATE INDEX "bronzehistorys1restriction_collected_at" ON "bronzehistory"."s1_restrictions_history" ("collected_at");
+-- Create index "bronzehistorys1restriction_resource_id_valid_from" to table: "s1_restrictions_history"
+CREATE INDEX "bronzehistorys1restriction_resource_id_valid_from" ON "bronzehistory"."s1_restrictions_history" ("resource_id", "valid_from");
+-- Create index "bronzehistorys1restriction_restriction_value" to table: "s1_restrictions_history"
+CREATE INDEX "bronzehistorys1restriction_restriction_value" ON "bronzehistory"."s1_restrictions_history" ("restriction_value");
+-- Create index "bronzehistorys1restriction_valid_to" to table: "s1_restrictions_history"
+CREATE INDEX "bronzehistorys1restriction_valid_to" ON "bronzehistory"."s1_restrictions_history" ("valid_to");
@@ -0,0 +1,28 @@
+-- Create "detection_rules" table
+CREATE TABLE "config"."detection_rules" (
+  "rule_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "rule_key" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "description" character varying NULL,
+  "category" character varying NOT NULL,
+  "severity" character varying NOT NULL,
+  "provider" character varying NOT NULL,
+  "resource_type" character varying NOT NULL,
+  "remediation" character varying NULL,
+  "query" text NOT NULL,
+  "params_json" jsonb NULL,
+  "timeout_seconds" bigint NOT NULL DEFAULT 30,
+  "source" character varying NOT NULL DEFAULT 'custom',
+  "is_active" boolean NOT NULL DEFAULT true,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("rule_id")
+);
+-- Create index "configdetectionrule_category" to table: "detection_rules"
+CREATE INDEX "configdetectionrule_category" ON "config"."detection_rules" ("category");
+-- Create index "configdetectionrule_is_active" to table: "detection_rules"
+CREATE INDEX "configdetectionrule_is_active" ON "config"."detection_rules" ("is_active");
+-- Create index "configdetectionrule_resource_type" to table: "detection_rules"
+CREATE INDEX "configdetectionrule_resource_type" ON "config"."detection_rules" ("resource_type");
+-- Create index "detection_rules_rule_key_key" to table: "detection_rules"
+CREATE UNIQUE INDEX "detection_rules_rule_key_key" ON "config"."detection_rules" ("rule_key");
@@ -0,0 +1,21 @@
+-- Create "notification_rules" table
+CREATE TABLE "config"."notification_rules" (
+  "rule_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "rule_key" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "sources" jsonb NULL,
+  "severities" jsonb NULL,
+  "anomaly_types" jsonb NULL,
+  "source_ids" jsonb NULL,
+  "destinations" jsonb NOT NULL,
+  "dedup_minutes" bigint NOT NULL DEFAULT 1440,
+  "rate_limit_per_hour" bigint NOT NULL DEFAULT 30,
+  "is_active" boolean NOT NULL DEFAULT true,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("rule_id")
+);
+-- Create index "confignotificationrule_is_active" to table: "notification_rules"
+CREATE INDEX "confignotificationrule_is_active" ON "config"."notification_rules" ("is_active");
+-- Create index "notification_rules_rule_key_key" to table: "notification_rules"
+CREATE UNIQUE INDEX "notification_rules_rule_key_key" ON "config"."notification_rules" ("rule_key");
@@ -0,0 +1,7 @@
+-- Modify "httpmonitor_anomalies" table
+ALTER TABLE "gold"."httpmonitor_anomalies" ADD COLUMN "status" character varying NOT NULL DEFAULT 'open', ADD COLUMN "assignee" character varying NULL, ADD COLUMN "note" text NULL, ADD COLUMN "suppressed_until" timestamptz NULL, ADD COLUMN "resolved_at" timestamptz NULL, ADD COLUMN "last_seen_at" timestamptz NULL;
+-- Backfill "last_seen_at" from the last detection before enforcing NOT NULL
+UPDATE "gold"."httpmonitor_anomalies" SET "last_seen_at" = "detected_at";
+ALTER TABLE "gold"."httpmonitor_anomalies" ALTER COLUMN "last_seen_at" SET NOT NULL;
+-- Create index "goldhttpmonitoranomaly_status" to table: "httpmonitor_anomalies"
+CREATE INDEX "goldhttpmonitoranomaly_status" ON "gold"."httpmonitor_anomalies" ("status");
@@ -0,0 +1,14 @@
+-- Modify "lifecycle_os" table
+ALTER TABLE "gold"."lifecycle_os" ADD COLUMN "status" character varying NOT NULL DEFAULT 'open', ADD COLUMN "assignee" character varying NULL, ADD COLUMN "note" text NULL, ADD COLUMN "suppressed_until" timestamptz NULL, ADD COLUMN "resolved_at" timestamptz NULL, ADD COLUMN "last_seen_at" timestamptz NULL;
+-- Backfill "last_seen_at" from the last detection before enforcing NOT NULL
+UPDATE "gold"."lifecycle_os" SET "last_seen_at" = "detected_at";
+ALTER TABLE "gold"."lifecycle_os" ALTER COLUMN "last_seen_at" SET NOT NULL;
+-- Create index "goldlifecycleos_status" to table: "lifecycle_os"
+CREATE INDEX "goldlifecycleos_status" ON "gold"."lifecycle_os" ("status");
+-- Modify "lifecycle_software" table
+ALTER TABLE "gold"."lifecycle_software" ADD COLUMN "status" character varying NOT NULL DEFAULT 'open', ADD COLUMN "assignee" character varying NULL, ADD COLUMN "note" text NULL, ADD COLUMN "suppressed_until" timestamptz NULL, ADD COLUMN "resolved_at" timestamptz NULL, ADD COLUMN "last_seen_at" timestamptz NULL;
+-- Backfill "last_seen_at" from the last detection before enforcing NOT NULL
+UPDATE "gold"."lifecycle_software" SET "last_seen_at" = "detected_at";
+ALTER TABLE "gold"."lifecycle_software" ALTER COLUMN "last_seen_at" SET NOT NULL;
+-- Create index "goldlifecyclesoftware_status" to table: "lifecycle_software"
+CREATE INDEX "goldlifecyclesoftware_status" ON "gold"."lifecycle_software" ("status");
@@ -0,0 +1,20 @@
+-- Add new schema named "gold"
+CREATE SCHEMA IF NOT EXISTS "gold";
+-- Create "notify_deliveries" table
+CREATE TABLE "gold"."notify_deliveries" (
+  "delivery_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "rule_key" character varying NOT NULL,
+  "source" character varying NOT NULL,
+  "finding_id" character varying NOT NULL,
+  "destination" character varying NOT NULL,
+  "status" character varying NOT NULL,
+  "error" character varying NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("delivery_id")
+);
+-- Create index "goldnotifydelivery_rule_key_created_at" to table: "notify_deliveries"
+CREATE INDEX "goldnotifydelivery_rule_key_created_at" ON "gold"."notify_deliveries" ("rule_key", "created_at");
+-- Create index "goldnotifydelivery_rule_key_finding_id_created_at" to table: "notify_deliveries"
+CREATE INDEX "goldnotifydelivery_rule_key_finding_id_created_at" ON "gold"."notify_deliveries" ("rule_key", "finding_id", "created_at");
+-- Create index "goldnotifydelivery_status" to table: "notify_deliveries"
+CREATE INDEX "goldnotifydelivery_status" ON "gold"."notify_deliveries" ("status");
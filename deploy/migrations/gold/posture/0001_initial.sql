@@ -0,0 +1,31 @@
+-- Add new schema named "gold"
+CREATE SCHEMA IF NOT EXISTS "gold";
+-- Create "posture_findings" table
+CREATE TABLE "gold"."posture_findings" (
+  "resource_id" character varying NOT NULL,
+  "detected_at" timestamptz NOT NULL,
+  "first_detected_at" timestamptz NOT NULL,
+  "rule_key" character varying NOT NULL,
+  "category" character varying NOT NULL,
+  "severity" character varying NOT NULL,
+  "provider" character varying NOT NULL,
+  "resource_type" character varying NOT NULL,
+  "asset_id" character varying NOT NULL,
+  "asset_name" character varying NULL,
+  "project_id" character varying NULL,
+  "title" character varying NOT NULL,
+  "description" character varying NULL,
+  "remediation" character varying NULL,
+  "evidence_json" jsonb NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "goldposturefinding_asset_id" to table: "posture_findings"
+CREATE INDEX "goldposturefinding_asset_id" ON "gold"."posture_findings" ("asset_id");
+-- Create index "goldposturefinding_project_id" to table: "posture_findings"
+CREATE INDEX "goldposturefinding_project_id" ON "gold"."posture_findings" ("project_id");
+-- Create index "goldposturefinding_resource_type" to table: "posture_findings"
+CREATE INDEX "goldposturefinding_resource_type" ON "gold"."posture_findings" ("resource_type");
+-- Create index "goldposturefinding_rule_key" to table: "posture_findings"
+CREATE INDEX "goldposturefinding_rule_key" ON "gold"."posture_findings" ("rule_key");
+-- Create index "goldposturefinding_severity" to table: "posture_findings"
+CREATE INDEX "goldposturefinding_severity" ON "gold"."posture_findings" ("severity");
@@ -0,0 +1,7 @@
+-- Modify "posture_findings" table
+ALTER TABLE "gold"."posture_findings" ADD COLUMN "status" character varying NOT NULL DEFAULT 'open', ADD COLUMN "assignee" character varying NULL, ADD COLUMN "note" text NULL, ADD COLUMN "suppressed_until" timestamptz NULL, ADD COLUMN "resolved_at" timestamptz NULL, ADD COLUMN "last_seen_at" timestamptz NULL;
+-- Backfill "last_seen_at" from the last detection before enforcing NOT NULL
+UPDATE "gold"."posture_findings" SET "last_seen_at" = "detected_at";
+ALTER TABLE "gold"."posture_findings" ALTER COLUMN "last_seen_at" SET NOT NULL;
+-- Create index "goldposturefinding_status" to table: "posture_findings"
+CREATE INDEX "goldposturefinding_status" ON "gold"."posture_findings" ("status");
@@ -0,0 +1,22 @@
+-- Add new schema named "gold"
+CREATE SCHEMA IF NOT EXISTS "gold";
+-- Create "triage_audits" table
+CREATE TABLE "gold"."triage_audits" (
+  "audit_id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "finding_table" character varying NOT NULL,
+  "finding_id" character varying NOT NULL,
+  "action" character varying NOT NULL,
+  "actor" character varying NOT NULL,
+  "from_status" character varying NULL,
+  "to_status" character varying NULL,
+  "reason" character varying NULL,
+  "changes_json" jsonb NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("audit_id")
+);
+-- Create index "goldtriageaudit_actor" to table: "triage_audits"
+CREATE INDEX "goldtriageaudit_actor" ON "gold"."triage_audits" ("actor");
+-- Create index "goldtriageaudit_created_at" to table: "triage_audits"
+CREATE INDEX "goldtriageaudit_created_at" ON "gold"."triage_audits" ("created_at");
+-- Create index "goldtriageaudit_finding_table_finding_id" to table: "triage_audits"
+CREATE INDEX "goldtriageaudit_finding_table_finding_id" ON "gold"."triage_audits" ("finding_table", "finding_id");
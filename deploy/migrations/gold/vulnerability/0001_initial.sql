@@ -0,0 +1,42 @@
+-- Add new schema named "gold"
+CREATE SCHEMA IF NOT EXISTS "gold";
+-- Create "vulnerability_findings" table
+CREATE TABLE "gold"."vulnerability_findings" (
+  "resource_id" character varying NOT NULL,
+  "detected_at" timestamptz NOT NULL,
+  "first_detected_at" timestamptz NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'open',
+  "assignee" character varying NULL,
+  "note" text NULL,
+  "suppressed_until" timestamptz NULL,
+  "resolved_at" timestamptz NULL,
+  "last_seen_at" timestamptz NOT NULL,
+  "machine_id" character varying NOT NULL,
+  "hostname" character varying NULL,
+  "package_name" character varying NOT NULL,
+  "package_version" character varying NOT NULL,
+  "vuln_id" character varying NOT NULL,
+  "aliases" jsonb NULL,
+  "source" character varying NOT NULL,
+  "ecosystem" character varying NULL,
+  "version_scheme" character varying NOT NULL,
+  "severity" character varying NOT NULL,
+  "cvss_score" double precision NULL,
+  "cvss_vector" character varying NULL,
+  "fixed_version" character varying NULL,
+  "summary" text NULL,
+  "published" timestamptz NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "goldvulnerabilityfinding_machine_id" to table: "vulnerability_findings"
+CREATE INDEX "goldvulnerabilityfinding_machine_id" ON "gold"."vulnerability_findings" ("machine_id");
+-- Create index "goldvulnerabilityfinding_machine_id_package_name_vuln_id" to table: "vulnerability_findings"
+CREATE UNIQUE INDEX "goldvulnerabilityfinding_machine_id_package_name_vuln_id" ON "gold"."vulnerability_findings" ("machine_id", "package_name", "vuln_id");
+-- Create index "goldvulnerabilityfinding_package_name" to table: "vulnerability_findings"
+CREATE INDEX "goldvulnerabilityfinding_package_name" ON "gold"."vulnerability_findings" ("package_name");
+-- Create index "goldvulnerabilityfinding_severity" to table: "vulnerability_findings"
+CREATE INDEX "goldvulnerabilityfinding_severity" ON "gold"."vulnerability_findings" ("severity");
+-- Create index "goldvulnerabilityfinding_status" to table: "vulnerability_findings"
+CREATE INDEX "goldvulnerabilityfinding_status" ON "gold"."vulnerability_findings" ("status");
+-- Create index "goldvulnerabilityfinding_vuln_id" to table: "vulnerability_findings"
+CREATE INDEX "goldvulnerabilityfinding_vuln_id" ON "gold"."vulnerability_findings" ("vuln_id");
@@ -0,0 +1,4 @@
+-- Modify "httptraffic_client_ip_5m" table
+ALTER TABLE "silver"."httptraffic_client_ip_5m" ADD COLUMN "is_threat_listed" boolean NOT NULL DEFAULT false, ADD COLUMN "threat_feeds" jsonb NULL, ADD COLUMN "threat_categories" jsonb NULL;
+-- Create index "silverhttptrafficclientip5m_is_threat_listed_window_start" to table: "httptraffic_client_ip_5m"
+CREATE INDEX "silverhttptrafficclientip5m_is_threat_listed_window_start" ON "silver"."httptraffic_client_ip_5m" ("is_threat_listed", "window_start");
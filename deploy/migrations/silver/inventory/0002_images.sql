@@ -0,0 +1,56 @@
+-- Create "inventory_image_normalized" table
+CREATE TABLE "silver"."inventory_image_normalized" (
+  "resource_id" character varying NOT NULL,
+  "provider" character varying NOT NULL,
+  "is_base" boolean NOT NULL,
+  "bronze_table" character varying NOT NULL,
+  "bronze_resource_id" character varying NOT NULL,
+  "registry" character varying NOT NULL,
+  "repository" character varying NOT NULL,
+  "digest" character varying NULL,
+  "tags_json" jsonb NULL,
+  "workloads_json" jsonb NULL,
+  "vulnerabilities_json" jsonb NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "normalized_at" timestamptz NOT NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "inventoryimagenormalized_provider" to table: "inventory_image_normalized"
+CREATE INDEX "inventoryimagenormalized_provider" ON "silver"."inventory_image_normalized" ("provider");
+-- Create index "inventoryimagenormalized_provider_bronze_resource_id" to table: "inventory_image_normalized"
+CREATE UNIQUE INDEX "inventoryimagenormalized_provider_bronze_resource_id" ON "silver"."inventory_image_normalized" ("provider", "bronze_resource_id");
+-- Create index "inventoryimagenormalized_registry_repository_digest" to table: "inventory_image_normalized"
+CREATE INDEX "inventoryimagenormalized_registry_repository_digest" ON "silver"."inventory_image_normalized" ("registry", "repository", "digest");
+-- Create "inventory_images" table
+CREATE TABLE "silver"."inventory_images" (
+  "resource_id" character varying NOT NULL,
+  "collected_at" timestamptz NOT NULL,
+  "first_collected_at" timestamptz NOT NULL,
+  "normalized_at" timestamptz NOT NULL,
+  "registry" character varying NOT NULL,
+  "repository" character varying NOT NULL,
+  "digest" character varying NOT NULL DEFAULT '',
+  "tags_json" jsonb NULL,
+  "workloads_json" jsonb NULL,
+  "vulnerabilities_json" jsonb NULL,
+  "vulnerability_count" bigint NOT NULL DEFAULT 0,
+  "max_severity" character varying NULL,
+  PRIMARY KEY ("resource_id")
+);
+-- Create index "inventoryimage_collected_at" to table: "inventory_images"
+CREATE INDEX "inventoryimage_collected_at" ON "silver"."inventory_images" ("collected_at");
+-- Create index "inventoryimage_max_severity" to table: "inventory_images"
+CREATE INDEX "inventoryimage_max_severity" ON "silver"."inventory_images" ("max_severity");
+-- Create index "inventoryimage_registry_repository_digest" to table: "inventory_images"
+CREATE UNIQUE INDEX "inventoryimage_registry_repository_digest" ON "silver"."inventory_images" ("registry", "repository", "digest");
+-- Create "inventory_image_links" table
+CREATE TABLE "silver"."inventory_image_links" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "provider" character varying NOT NULL,
+  "bronze_table" character varying NOT NULL,
+  "bronze_resource_id" character varying NOT NULL,
+  "inventory_image_bronze_links" character varying NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "inventory_image_links_inventory_images_bronze_links" FOREIGN KEY ("inventory_image_bronze_links") REFERENCES "silver"."inventory_images" ("resource_id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
@@ -0,0 +1,4 @@
+-- Modify "inventory_api_endpoints" table
+ALTER TABLE "silver"."inventory_api_endpoints" ADD COLUMN "is_discovered" boolean NOT NULL DEFAULT false;
+-- Create index "inventoryapiendpoint_is_discovered" to table: "inventory_api_endpoints"
+CREATE INDEX "inventoryapiendpoint_is_discovered" ON "silver"."inventory_api_endpoints" ("is_discovered");
@@ -0,0 +1,25 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+)
+
+type actorKey struct{}
+
+// AnonymousActor is recorded for writes made without an authenticated identity.
+const AnonymousActor = "anonymous"
+
+// WithActor returns a context carrying the identity of the admin user.
+// Authentication middleware sets it; write handlers read it with Actor.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// Actor returns the identity attached to the request, or AnonymousActor.
+func Actor(r *http.Request) string {
+	if a, ok := r.Context().Value(actorKey{}).(string); ok && a != "" {
+		return a
+	}
+	return AnonymousActor
+}
@@ -0,0 +1,76 @@
+package admin
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"danny.vn/hotpot/pkg/admin/auth"
+)
+
+// routeGroups resolves the nav group of every route. Routes without Nav
+// (detail, stats, row actions) inherit the group of their list route: the
+// Action.List route, or the longest nav route whose path prefixes theirs.
+func routeGroups(routes []RouteRegistration) map[string][]string {
+	navGroups := map[string][]string{}
+	for _, r := range routes {
+		if r.Nav != nil {
+			navGroups[r.Path] = r.Nav.Group
+		}
+	}
+
+	groups := make(map[string][]string, len(routes))
+	for _, r := range routes {
+		key := r.Method + " " + r.Path
+		switch {
+		case r.Nav != nil:
+			groups[key] = r.Nav.Group
+		case r.Action != nil && navGroups[r.Action.List] != nil:
+			groups[key] = navGroups[r.Action.List]
+		default:
+			best := ""
+			for p := range navGroups {
+				if strings.HasPrefix(r.Path, p+"/") && len(p) > len(best) {
+					best = p
+				}
+			}
+			if best != "" {
+				groups[key] = navGroups[best]
+			}
+		}
+	}
+	return groups
+}
+
+// authenticate wraps the /api/ routes with authn. The identity is attached to
+// the request context and its name becomes the audit actor.
+func authenticate(authn auth.Authenticator, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/api/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+		id, err := authn.Authenticate(r)
+		if err != nil {
+			slog.Debug("admin auth rejected", "path", r.URL.Path, "remote", r.RemoteAddr, "error", err)
+			w.Header().Set("WWW-Authenticate", `Bearer realm="hotpot"`)
+			WriteError(w, http.StatusUnauthorized, "authentication required")
+			return
+		}
+		ctx := auth.NewContext(r.Context(), id)
+		ctx = WithActor(ctx, id.Name)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// authorize checks the caller's roles before running a route handler.
+func authorize(policy *auth.Policy, route auth.Route, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := auth.FromContext(r.Context())
+		if !ok || !policy.Allowed(id.Roles, route) {
+			WriteError(w, http.StatusForbidden, "forbidden")
+			return
+		}
+		next(w, r)
+	}
+}
@@ -0,0 +1,100 @@
+// Package auth authenticates admin API requests and authorizes them against
+// the roles configured in AdminConfig.
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"danny.vn/hotpot/pkg/base/config"
+)
+
+// ErrNoCredentials means the request carries nothing this authenticator
+// understands; the next one in the chain is tried.
+var ErrNoCredentials = errors.New("no credentials")
+
+// Identity is an authenticated caller.
+type Identity struct {
+	// Name is recorded as the actor in audit logs.
+	Name string
+	// Roles are role names from AdminConfig.Roles.
+	Roles []string
+	// Method is the authenticator that accepted the request: token, oidc, proxy.
+	Method string
+}
+
+// Authenticator resolves the caller of a request.
+type Authenticator interface {
+	Authenticate(r *http.Request) (*Identity, error)
+}
+
+// Chain tries each authenticator in order. The first identity wins; if none
+// applies, the last real error (or ErrNoCredentials) is returned.
+type Chain []Authenticator
+
+// Authenticate implements Authenticator.
+func (c Chain) Authenticate(r *http.Request) (*Identity, error) {
+	err := ErrNoCredentials
+	for _, a := range c {
+		id, aerr := a.Authenticate(r)
+		if aerr == nil {
+			return id, nil
+		}
+		if !errors.Is(aerr, ErrNoCredentials) {
+			err = aerr
+		}
+	}
+	return nil, err
+}
+
+// New builds the authenticator chain from config. It returns nil when no
+// method is configured.
+func New(ctx context.Context, cfg config.AdminAuthConfig) (Authenticator, error) {
+	var chain Chain
+	if len(cfg.Tokens) > 0 {
+		chain = append(chain, NewTokenAuthenticator(cfg.Tokens))
+	}
+	if cfg.OIDC.Issuer != "" {
+		o, err := NewOIDCAuthenticator(ctx, cfg.OIDC)
+		if err != nil {
+			return nil, fmt.Errorf("oidc: %w", err)
+		}
+		chain = append(chain, o)
+	}
+	if cfg.Proxy.UserHeader != "" {
+		p, err := NewProxyAuthenticator(cfg.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("proxy: %w", err)
+		}
+		chain = append(chain, p)
+	}
+	if len(chain) == 0 {
+		return nil, nil
+	}
+	return chain, nil
+}
+
+type identityKey struct{}
+
+// NewContext returns a context carrying the identity.
+func NewContext(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext returns the identity attached by the admin middleware, if any.
+func FromContext(ctx context.Context) (*Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(*Identity)
+	return id, ok
+}
+
+// bearerToken extracts the token from an "Authorization: Bearer" header.
+func bearerToken(r *http.Request) string {
+	h := r.Header.Get("Authorization")
+	if len(h) > 7 && strings.EqualFold(h[:7], "bearer ") {
+		return strings.TrimSpace(h[7:])
+	}
+	return ""
+}
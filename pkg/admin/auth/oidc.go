@@ -0,0 +1,250 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+
+	"danny.vn/hotpot/pkg/base/config"
+)
+
+const (
+	jwksMaxAge       = time.Hour
+	jwksMinRefresh   = time.Minute
+	jwtLeeway        = time.Minute
+	oidcFetchTimeout = 10 * time.Second
+)
+
+// signatureAlgorithms are the JWS algorithms accepted from the issuer.
+// "none" and HMAC algorithms are never accepted.
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// OIDCAuthenticator validates bearer JWTs against an issuer's key set.
+type OIDCAuthenticator struct {
+	cfg    config.AdminOIDCConfig
+	client *http.Client
+	now    func() time.Time
+
+	mu        sync.Mutex
+	jwksURL   string
+	keys      jose.JSONWebKeySet
+	fetchedAt time.Time
+}
+
+// NewOIDCAuthenticator creates an OIDCAuthenticator and loads the key set.
+// With JWKSFile set the keys are read from disk and never fetched.
+func NewOIDCAuthenticator(ctx context.Context, cfg config.AdminOIDCConfig) (*OIDCAuthenticator, error) {
+	if cfg.UsernameClaim == "" {
+		cfg.UsernameClaim = "email"
+	}
+	if cfg.RolesClaim == "" {
+		cfg.RolesClaim = "groups"
+	}
+	a := &OIDCAuthenticator{
+		cfg:     cfg,
+		client:  &http.Client{Timeout: oidcFetchTimeout},
+		now:     time.Now,
+		jwksURL: cfg.JWKSURL,
+	}
+
+	if cfg.JWKSFile != "" {
+		data, err := os.ReadFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("read jwks file: %w", err)
+		}
+		if err := json.Unmarshal(data, &a.keys); err != nil {
+			return nil, fmt.Errorf("parse jwks file: %w", err)
+		}
+		if len(a.keys.Keys) == 0 {
+			return nil, fmt.Errorf("jwks file %s has no keys", cfg.JWKSFile)
+		}
+		return a, nil
+	}
+
+	if a.jwksURL == "" {
+		u, err := a.discoverJWKSURL(ctx)
+		if err != nil {
+			return nil, err
+		}
+		a.jwksURL = u
+	}
+	if err := a.refresh(ctx); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Authenticate implements Authenticator. Bearer values that are not JWTs
+// return ErrNoCredentials; malformed or invalid JWTs are rejected.
+func (a *OIDCAuthenticator) Authenticate(r *http.Request) (*Identity, error) {
+	raw := bearerToken(r)
+	if raw == "" || strings.Count(raw, ".") != 2 {
+		return nil, ErrNoCredentials
+	}
+
+	tok, err := jwt.ParseSigned(raw, signatureAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("parse jwt: %w", err)
+	}
+	if len(tok.Headers) != 1 {
+		return nil, errors.New("jwt must have exactly one signature")
+	}
+
+	key, err := a.key(r.Context(), tok.Headers[0].KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var std jwt.Claims
+	var all map[string]any
+	if err := tok.Claims(key, &std, &all); err != nil {
+		return nil, fmt.Errorf("verify jwt: %w", err)
+	}
+	if std.Expiry == nil {
+		return nil, errors.New("jwt has no exp claim")
+	}
+	expected := jwt.Expected{Issuer: a.cfg.Issuer, Time: a.now()}
+	if a.cfg.Audience != "" {
+		expected.AnyAudience = jwt.Audience{a.cfg.Audience}
+	}
+	if err := std.ValidateWithLeeway(expected, jwtLeeway); err != nil {
+		return nil, fmt.Errorf("validate jwt: %w", err)
+	}
+
+	name, _ := claim(all, a.cfg.UsernameClaim).(string)
+	if name == "" {
+		name = std.Subject
+	}
+	if name == "" {
+		return nil, errors.New("jwt has no subject")
+	}
+
+	return &Identity{Name: name, Roles: stringList(claim(all, a.cfg.RolesClaim)), Method: "oidc"}, nil
+}
+
+// key returns the verification key for kid, refetching the key set when the
+// kid is unknown (key rotation) or the cached set is old.
+func (a *OIDCAuthenticator) key(ctx context.Context, kid string) (*jose.JSONWebKey, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.jwksURL != "" {
+		age := a.now().Sub(a.fetchedAt)
+		if age > jwksMaxAge || (len(a.lookup(kid)) == 0 && age > jwksMinRefresh) {
+			if err := a.refresh(ctx); err != nil {
+				// Keep serving the cached keys if the issuer is briefly down.
+				if len(a.keys.Keys) == 0 {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	keys := a.lookup(kid)
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return &keys[0], nil
+}
+
+// lookup finds keys by kid. An empty kid matches only a single-key set.
+func (a *OIDCAuthenticator) lookup(kid string) []jose.JSONWebKey {
+	if kid == "" {
+		if len(a.keys.Keys) == 1 {
+			return a.keys.Keys
+		}
+		return nil
+	}
+	return a.keys.Key(kid)
+}
+
+// refresh fetches the key set from jwksURL. Caller holds mu (or is the constructor).
+func (a *OIDCAuthenticator) refresh(ctx context.Context) error {
+	a.fetchedAt = a.now()
+	var keys jose.JSONWebKeySet
+	if err := a.getJSON(ctx, a.jwksURL, &keys); err != nil {
+		return fmt.Errorf("fetch jwks: %w", err)
+	}
+	a.keys = keys
+	return nil
+}
+
+func (a *OIDCAuthenticator) discoverJWKSURL(ctx context.Context) (string, error) {
+	var doc struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	u := strings.TrimSuffix(a.cfg.Issuer, "/") + "/.well-known/openid-configuration"
+	if err := a.getJSON(ctx, u, &doc); err != nil {
+		return "", fmt.Errorf("discovery: %w", err)
+	}
+	if doc.Issuer != a.cfg.Issuer {
+		return "", fmt.Errorf("discovery: issuer mismatch %q", doc.Issuer)
+	}
+	if doc.JWKSURI == "" {
+		return "", errors.New("discovery: no jwks_uri")
+	}
+	return doc.JWKSURI, nil
+}
+
+func (a *OIDCAuthenticator) getJSON(ctx context.Context, url string, dst any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(dst)
+}
+
+// claim looks up a claim by dotted path, e.g. "realm_access.roles".
+func claim(claims map[string]any, path string) any {
+	var cur any = claims
+	for _, part := range strings.Split(path, ".") {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil
+		}
+		cur = m[part]
+	}
+	return cur
+}
+
+// stringList accepts a JSON array of strings or a single space/comma
+// separated string.
+func stringList(v any) []string {
+	switch v := v.(type) {
+	case []any:
+		out := make([]string, 0, len(v))
+		for _, e := range v {
+			if s, ok := e.(string); ok && s != "" {
+				out = append(out, s)
+			}
+		}
+		return out
+	case string:
+		return strings.FieldsFunc(v, func(r rune) bool { return r == ',' || r == ' ' })
+	}
+	return nil
+}
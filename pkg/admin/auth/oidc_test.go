@@ -0,0 +1,201 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+
+	"danny.vn/hotpot/pkg/base/config"
+)
+
+const testIssuer = "https://idp.example.com"
+
+func newTestOIDC(t *testing.T) (*OIDCAuthenticator, jose.Signer) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jwks := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: &key.PublicKey, KeyID: "k1", Algorithm: string(jose.RS256), Use: "sig"},
+	}}
+	data, err := json.Marshal(jwks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := NewOIDCAuthenticator(context.Background(), config.AdminOIDCConfig{
+		Issuer:     testIssuer,
+		Audience:   "hotpot",
+		JWKSFile:   path,
+		RolesClaim: "realm_access.roles",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "k1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a, signer
+}
+
+func sign(t *testing.T, signer jose.Signer, claims jwt.Claims, extra map[string]any) string {
+	t.Helper()
+	raw, err := jwt.Signed(signer).Claims(claims).Claims(extra).Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+func TestOIDCAuthenticate(t *testing.T) {
+	a, signer := newTestOIDC(t)
+	now := time.Now()
+	valid := jwt.Claims{
+		Issuer:   testIssuer,
+		Subject:  "u-123",
+		Audience: jwt.Audience{"hotpot"},
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+		IssuedAt: jwt.NewNumericDate(now),
+	}
+	roles := map[string]any{
+		"email":        "alice@example.com",
+		"realm_access": map[string]any{"roles": []string{"viewer", "triager"}},
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/api/v1/gold/posture/findings", nil)
+		r.Header.Set("Authorization", "Bearer "+sign(t, signer, valid, roles))
+		id, err := a.Authenticate(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id.Name != "alice@example.com" || id.Method != "oidc" {
+			t.Errorf("identity = %+v", id)
+		}
+		if len(id.Roles) != 2 || id.Roles[0] != "viewer" || id.Roles[1] != "triager" {
+			t.Errorf("roles = %v", id.Roles)
+		}
+	})
+
+	t.Run("subject fallback", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.Header.Set("Authorization", "Bearer "+sign(t, signer, valid, nil))
+		id, err := a.Authenticate(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id.Name != "u-123" || len(id.Roles) != 0 {
+			t.Errorf("identity = %+v", id)
+		}
+	})
+
+	otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	forged, _ := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: otherKey},
+		(&jose.SignerOptions{}).WithHeader("kid", "k1"))
+
+	bad := map[string]func(c *jwt.Claims) (jose.Signer, error){
+		"expired": func(c *jwt.Claims) (jose.Signer, error) {
+			c.Expiry = jwt.NewNumericDate(now.Add(-time.Hour))
+			return signer, nil
+		},
+		"no exp": func(c *jwt.Claims) (jose.Signer, error) {
+			c.Expiry = nil
+			return signer, nil
+		},
+		"wrong issuer": func(c *jwt.Claims) (jose.Signer, error) {
+			c.Issuer = "https://evil.example.com"
+			return signer, nil
+		},
+		"wrong audience": func(c *jwt.Claims) (jose.Signer, error) {
+			c.Audience = jwt.Audience{"other"}
+			return signer, nil
+		},
+		"bad signature": func(c *jwt.Claims) (jose.Signer, error) {
+			return forged, nil
+		},
+	}
+	for name, mutate := range bad {
+		t.Run(name, func(t *testing.T) {
+			c := valid
+			s, _ := mutate(&c)
+			r := httptest.NewRequest("GET", "/", nil)
+			r.Header.Set("Authorization", "Bearer "+sign(t, s, c, roles))
+			id, err := a.Authenticate(r)
+			if err == nil {
+				t.Fatalf("accepted: %+v", id)
+			}
+			if errors.Is(err, ErrNoCredentials) {
+				t.Errorf("err = %v, want a rejection", err)
+			}
+		})
+	}
+
+	t.Run("not a jwt", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.Header.Set("Authorization", "Bearer static-token")
+		if _, err := a.Authenticate(r); !errors.Is(err, ErrNoCredentials) {
+			t.Errorf("err = %v, want ErrNoCredentials", err)
+		}
+	})
+}
+
+func TestChain(t *testing.T) {
+	a, signer := newTestOIDC(t)
+	chain := Chain{
+		NewTokenAuthenticator([]config.AdminTokenConfig{{Name: "ci", Token: "s3cret", Roles: []string{"viewer"}}}),
+		a,
+	}
+
+	tests := []struct {
+		name, header, want string
+		wantErr            bool
+	}{
+		{name: "static token", header: "Bearer s3cret", want: "token:ci"},
+		{name: "jwt", header: "Bearer " + sign(t, signer, jwt.Claims{
+			Issuer: testIssuer, Subject: "bob", Audience: jwt.Audience{"hotpot"},
+			Expiry: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		}, nil), want: "bob"},
+		{name: "unknown token", header: "Bearer nope", wantErr: true},
+		{name: "missing", header: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			if tt.header != "" {
+				r.Header.Set("Authorization", tt.header)
+			}
+			id, err := chain.Authenticate(r)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("accepted: %+v", id)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id.Name != tt.want {
+				t.Errorf("name = %q, want %q", id.Name, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+
+	"danny.vn/hotpot/pkg/base/config"
+)
+
+// Route is what authorization needs to know about an admin API route.
+type Route struct {
+	Method string
+	Path   string
+	// Group is the nav group path of the route (e.g. ["Bronze", "Vault"]),
+	// inherited from its list route for detail and action routes.
+	Group []string
+}
+
+// Write reports whether the route modifies data.
+func (r Route) Write() bool {
+	return r.Method != http.MethodGet && r.Method != http.MethodHead
+}
+
+// Policy maps role names to access rules.
+type Policy struct {
+	roles map[string]config.AdminRoleConfig
+}
+
+// NewPolicy creates a Policy from the configured roles.
+func NewPolicy(roles []config.AdminRoleConfig) *Policy {
+	p := &Policy{roles: make(map[string]config.AdminRoleConfig, len(roles))}
+	for _, r := range roles {
+		p.roles[r.Name] = r
+	}
+	return p
+}
+
+// Allowed reports whether any of the roles grants access to the route.
+// Within a role, Deny overrides Allow, and write routes need Write.
+// Unknown role names grant nothing.
+func (p *Policy) Allowed(roles []string, route Route) bool {
+	for _, name := range roles {
+		role, ok := p.roles[name]
+		if !ok {
+			continue
+		}
+		if route.Write() && !role.Write {
+			continue
+		}
+		if matchAny(role.Allow, route) && !matchAny(role.Deny, route) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchAny(entries []string, route Route) bool {
+	for _, e := range entries {
+		if match(e, route) {
+			return true
+		}
+	}
+	return false
+}
+
+// match checks one Allow/Deny entry: "*", an API path ("/"-prefixed; a
+// trailing "/" matches everything below it), or a nav group path compared
+// case-insensitively ("Bronze/Vault" matches Bronze → Vault → PKI).
+func match(entry string, route Route) bool {
+	switch {
+	case entry == "*":
+		return true
+	case strings.HasPrefix(entry, "/"):
+		if strings.HasSuffix(entry, "/") {
+			return strings.HasPrefix(route.Path, entry)
+		}
+		return route.Path == entry
+	}
+
+	parts := strings.Split(strings.Trim(entry, "/"), "/")
+	if len(parts) > len(route.Group) {
+		return false
+	}
+	for i, p := range parts {
+		if !strings.EqualFold(strings.TrimSpace(p), route.Group[i]) {
+			return false
+		}
+	}
+	return true
+}
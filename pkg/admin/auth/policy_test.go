@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"testing"
+
+	"danny.vn/hotpot/pkg/base/config"
+)
+
+func TestPolicyAllowed(t *testing.T) {
+	p := NewPolicy([]config.AdminRoleConfig{
+		{Name: "admin", Allow: []string{"*"}, Write: true},
+		{Name: "viewer", Allow: []string{"Bronze", "Silver", "Gold", "/api/v1/stats/"}, Deny: []string{"Bronze/Vault"}},
+		{Name: "triager", Allow: []string{"gold"}, Write: true},
+	})
+
+	vault := Route{Method: "GET", Path: "/api/v1/bronze/vault/pki/certificates", Group: []string{"Bronze", "Vault", "PKI"}}
+	gcp := Route{Method: "GET", Path: "/api/v1/bronze/gcp/compute/instances", Group: []string{"Bronze", "GCP", "Compute"}}
+	stats := Route{Method: "GET", Path: "/api/v1/stats/overview"}
+	ack := Route{Method: "POST", Path: "/api/v1/gold/posture/findings/{id}/acknowledge", Group: []string{"Gold", "Posture"}}
+
+	tests := []struct {
+		name  string
+		roles []string
+		route Route
+		want  bool
+	}{
+		{"admin reads vault", []string{"admin"}, vault, true},
+		{"viewer denied vault", []string{"viewer"}, vault, false},
+		{"viewer reads gcp", []string{"viewer"}, gcp, true},
+		{"viewer reads stats by path", []string{"viewer"}, stats, true},
+		{"viewer cannot write", []string{"viewer"}, ack, false},
+		{"triager writes gold", []string{"triager"}, ack, true},
+		{"triager cannot read bronze", []string{"triager"}, gcp, false},
+		{"roles combine", []string{"viewer", "triager"}, ack, true},
+		{"another role grants what one denies", []string{"viewer", "admin"}, vault, true},
+		{"unknown role", []string{"root"}, gcp, false},
+		{"no roles", nil, gcp, false},
+		{"group-less route needs path or star", []string{"triager"}, stats, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.Allowed(tt.roles, tt.route); got != tt.want {
+				t.Errorf("Allowed(%v, %s) = %v, want %v", tt.roles, tt.route.Path, got, tt.want)
+			}
+		})
+	}
+}
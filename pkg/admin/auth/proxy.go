@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"danny.vn/hotpot/pkg/base/config"
+)
+
+// ProxyAuthenticator trusts identity headers set by a reverse proxy, but only
+// on connections from the configured proxy addresses.
+type ProxyAuthenticator struct {
+	userHeader  string
+	rolesHeader string
+	trusted     []netip.Prefix
+}
+
+// NewProxyAuthenticator creates a ProxyAuthenticator.
+func NewProxyAuthenticator(cfg config.AdminProxyConfig) (*ProxyAuthenticator, error) {
+	a := &ProxyAuthenticator{userHeader: cfg.UserHeader, rolesHeader: cfg.RolesHeader}
+	for _, c := range cfg.TrustedCIDRs {
+		p, err := netip.ParsePrefix(c)
+		if err != nil {
+			addr, aerr := netip.ParseAddr(c)
+			if aerr != nil {
+				return nil, fmt.Errorf("trusted_cidrs: %w", err)
+			}
+			p = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		a.trusted = append(a.trusted, p.Masked())
+	}
+	return a, nil
+}
+
+// Authenticate implements Authenticator.
+func (a *ProxyAuthenticator) Authenticate(r *http.Request) (*Identity, error) {
+	if !a.fromTrustedProxy(r.RemoteAddr) {
+		return nil, ErrNoCredentials
+	}
+	user := strings.TrimSpace(r.Header.Get(a.userHeader))
+	if user == "" {
+		return nil, ErrNoCredentials
+	}
+	var roles []string
+	if a.rolesHeader != "" {
+		roles = stringList(r.Header.Get(a.rolesHeader))
+	}
+	return &Identity{Name: user, Roles: roles, Method: "proxy"}, nil
+}
+
+func (a *ProxyAuthenticator) fromTrustedProxy(remoteAddr string) bool {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, p := range a.trusted {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"danny.vn/hotpot/pkg/base/config"
+)
+
+func TestProxyAuthenticate(t *testing.T) {
+	a, err := NewProxyAuthenticator(config.AdminProxyConfig{
+		UserHeader:   "X-Forwarded-Email",
+		RolesHeader:  "X-Forwarded-Groups",
+		TrustedCIDRs: []string{"10.0.0.0/8", "127.0.0.1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		remote string
+		user   string
+		want   bool
+	}{
+		{"trusted cidr", "10.1.2.3:5555", "alice@example.com", true},
+		{"trusted single ip", "127.0.0.1:5555", "alice@example.com", true},
+		{"untrusted source", "192.168.1.1:5555", "alice@example.com", false},
+		{"missing header", "10.1.2.3:5555", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r.RemoteAddr = tt.remote
+			if tt.user != "" {
+				r.Header.Set("X-Forwarded-Email", tt.user)
+			}
+			r.Header.Set("X-Forwarded-Groups", "viewer, triager")
+			id, err := a.Authenticate(r)
+			if (err == nil) != tt.want {
+				t.Fatalf("err = %v, want ok=%v", err, tt.want)
+			}
+			if tt.want && (id.Name != tt.user || len(id.Roles) != 2) {
+				t.Errorf("identity = %+v", id)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"net/http"
+
+	"danny.vn/hotpot/pkg/base/config"
+)
+
+// TokenAuthenticator accepts static bearer tokens from config.
+type TokenAuthenticator struct {
+	tokens []staticToken
+}
+
+type staticToken struct {
+	hash  [sha256.Size]byte
+	name  string
+	roles []string
+}
+
+// NewTokenAuthenticator creates a TokenAuthenticator.
+func NewTokenAuthenticator(tokens []config.AdminTokenConfig) *TokenAuthenticator {
+	a := &TokenAuthenticator{}
+	for _, t := range tokens {
+		a.tokens = append(a.tokens, staticToken{
+			hash:  sha256.Sum256([]byte(t.Token)),
+			name:  t.Name,
+			roles: t.Roles,
+		})
+	}
+	return a
+}
+
+// Authenticate implements Authenticator. Unknown tokens return
+// ErrNoCredentials so a JWT can still be checked by the OIDC authenticator.
+func (a *TokenAuthenticator) Authenticate(r *http.Request) (*Identity, error) {
+	raw := bearerToken(r)
+	if raw == "" {
+		return nil, ErrNoCredentials
+	}
+	// Compare fixed-size hashes so timing does not leak token length.
+	h := sha256.Sum256([]byte(raw))
+	for _, t := range a.tokens {
+		if subtle.ConstantTimeCompare(h[:], t.hash[:]) == 1 {
+			return &Identity{Name: "token:" + t.name, Roles: t.roles, Method: "token"}, nil
+		}
+	}
+	return nil, ErrNoCredentials
+}
@@ -34,4 +34,25 @@ var sqlTables = []lh.SQLTable{
 		DefaultDesc:         true,
 		FilterOptionColumns: []string{"upstream", "route_status", "method"},
 	},
+	{
+		API:    "/api/v1/bronze/apicatalog/openapi-endpoints",
+		Schema: "bronze",
+		Table:  "apicatalog_openapi_endpoints",
+		Nav:    admin.NavMeta{Label: "OpenAPI Endpoints", Group: []string{"Bronze", "API Catalog"}},
+		Columns: []string{
+			"resource_id", "spec_name", "spec_version", "uri", "method",
+			"operation_id", "access_level", "deprecated", "source_file",
+			"collected_at", "first_collected_at",
+		},
+		Filters: []lh.SQLFilterDef{
+			{Column: "uri", Kind: lh.Search},
+			{Column: "operation_id", Kind: lh.Search},
+			{Column: "spec_name", Kind: lh.Multi},
+			{Column: "method", Kind: lh.Multi},
+			{Column: "access_level", Kind: lh.Multi},
+		},
+		DefaultSort:         "collected_at",
+		DefaultDesc:         true,
+		FilterOptionColumns: []string{"spec_name", "method", "access_level"},
+	},
 }
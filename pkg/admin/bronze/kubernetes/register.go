@@ -0,0 +1,166 @@
+package kubernetes
+
+import (
+	"database/sql"
+
+	"danny.vn/hotpot/pkg/admin"
+	lh "danny.vn/hotpot/pkg/admin/listhandler"
+)
+
+// Register registers all Kubernetes admin routes.
+func Register(db *sql.DB) {
+	lh.RegisterSQL(db, sqlTables)
+}
+
+var sqlTables = []lh.SQLTable{
+	{
+		API:    "/api/v1/bronze/kubernetes/namespaces",
+		Schema: "bronze",
+		Table:  "k8s_namespaces",
+		Nav:    admin.NavMeta{Label: "Namespaces", Group: []string{"Bronze", "Kubernetes"}},
+		Columns: []string{
+			"resource_id", "cluster_name", "name", "phase",
+			"api_created_at", "collected_at", "first_collected_at",
+		},
+		Filters: []lh.SQLFilterDef{
+			{Column: "name", Kind: lh.Search},
+			{Column: "cluster_name", Kind: lh.Multi},
+			{Column: "phase", Kind: lh.Multi},
+		},
+		DefaultSort:         "name",
+		FilterOptionColumns: []string{"cluster_name", "phase"},
+	},
+	{
+		API:    "/api/v1/bronze/kubernetes/nodes",
+		Schema: "bronze",
+		Table:  "k8s_nodes",
+		Nav:    admin.NavMeta{Label: "Nodes", Group: []string{"Bronze", "Kubernetes"}},
+		Columns: []string{
+			"resource_id", "cluster_name", "name", "provider_id", "internal_ip",
+			"node_pool", "instance_type", "zone", "kubelet_version", "os_image",
+			"ready", "unschedulable", "api_created_at", "collected_at", "first_collected_at",
+		},
+		Filters: []lh.SQLFilterDef{
+			{Column: "name", Kind: lh.Search},
+			{Column: "cluster_name", Kind: lh.Multi},
+			{Column: "node_pool", Kind: lh.Multi},
+			{Column: "kubelet_version", Kind: lh.Multi},
+			{Column: "ready", Kind: lh.Multi},
+		},
+		DefaultSort:         "name",
+		FilterOptionColumns: []string{"cluster_name", "node_pool", "kubelet_version", "ready"},
+	},
+	{
+		API:    "/api/v1/bronze/kubernetes/workloads",
+		Schema: "bronze",
+		Table:  "k8s_workloads",
+		Nav:    admin.NavMeta{Label: "Workloads", Group: []string{"Bronze", "Kubernetes"}},
+		Columns: []string{
+			"resource_id", "cluster_name", "namespace", "kind", "name",
+			"replicas", "service_account", "host_network", "host_pid", "host_ipc",
+			"images_json", "api_created_at", "collected_at", "first_collected_at",
+		},
+		Filters: []lh.SQLFilterDef{
+			{Column: "name", Kind: lh.Search},
+			{Column: "cluster_name", Kind: lh.Multi},
+			{Column: "namespace", Kind: lh.Multi},
+			{Column: "kind", Kind: lh.Multi},
+			{Column: "host_network", Kind: lh.Multi},
+		},
+		DefaultSort:         "name",
+		FilterOptionColumns: []string{"cluster_name", "namespace", "kind", "host_network"},
+	},
+	{
+		API:    "/api/v1/bronze/kubernetes/pods",
+		Schema: "bronze",
+		Table:  "k8s_pods",
+		Nav:    admin.NavMeta{Label: "Pods", Group: []string{"Bronze", "Kubernetes"}},
+		Columns: []string{
+			"resource_id", "cluster_name", "namespace", "name", "phase",
+			"node_name", "pod_ip", "workload_kind", "workload_name", "service_account",
+			"api_created_at", "collected_at", "first_collected_at",
+		},
+		Filters: []lh.SQLFilterDef{
+			{Column: "name", Kind: lh.Search},
+			{Column: "cluster_name", Kind: lh.Multi},
+			{Column: "namespace", Kind: lh.Multi},
+			{Column: "phase", Kind: lh.Multi},
+			{Column: "workload_kind", Kind: lh.Multi},
+		},
+		DefaultSort:         "name",
+		FilterOptionColumns: []string{"cluster_name", "namespace", "phase", "workload_kind"},
+	},
+	{
+		API:    "/api/v1/bronze/kubernetes/images",
+		Schema: "bronze",
+		Table:  "k8s_images",
+		Nav:    admin.NavMeta{Label: "Images", Group: []string{"Bronze", "Kubernetes"}},
+		Columns: []string{
+			"resource_id", "cluster_name", "registry", "repository", "digest",
+			"tags_json", "namespaces_json", "workloads_json",
+			"collected_at", "first_collected_at",
+		},
+		Filters: []lh.SQLFilterDef{
+			{Column: "repository", Kind: lh.Search},
+			{Column: "cluster_name", Kind: lh.Multi},
+			{Column: "registry", Kind: lh.Multi},
+		},
+		DefaultSort:         "repository",
+		FilterOptionColumns: []string{"cluster_name", "registry"},
+	},
+	{
+		API:    "/api/v1/bronze/kubernetes/service-accounts",
+		Schema: "bronze",
+		Table:  "k8s_service_accounts",
+		Nav:    admin.NavMeta{Label: "Service Accounts", Group: []string{"Bronze", "Kubernetes"}},
+		Columns: []string{
+			"resource_id", "cluster_name", "namespace", "name",
+			"automount_token", "cloud_identity",
+			"api_created_at", "collected_at", "first_collected_at",
+		},
+		Filters: []lh.SQLFilterDef{
+			{Column: "name", Kind: lh.Search},
+			{Column: "cluster_name", Kind: lh.Multi},
+			{Column: "namespace", Kind: lh.Multi},
+		},
+		DefaultSort:         "name",
+		FilterOptionColumns: []string{"cluster_name", "namespace"},
+	},
+	{
+		API:    "/api/v1/bronze/kubernetes/role-bindings",
+		Schema: "bronze",
+		Table:  "k8s_role_bindings",
+		Nav:    admin.NavMeta{Label: "Role Bindings", Group: []string{"Bronze", "Kubernetes"}},
+		Columns: []string{
+			"resource_id", "cluster_name", "kind", "namespace", "name",
+			"role_kind", "role_name", "subjects_json",
+			"api_created_at", "collected_at", "first_collected_at",
+		},
+		Filters: []lh.SQLFilterDef{
+			{Column: "name", Kind: lh.Search},
+			{Column: "cluster_name", Kind: lh.Multi},
+			{Column: "kind", Kind: lh.Multi},
+			{Column: "namespace", Kind: lh.Multi},
+			{Column: "role_name", Kind: lh.Multi},
+		},
+		DefaultSort:         "name",
+		FilterOptionColumns: []string{"cluster_name", "kind", "namespace", "role_name"},
+	},
+	{
+		API:    "/api/v1/bronze/kubernetes/network-policies",
+		Schema: "bronze",
+		Table:  "k8s_network_policies",
+		Nav:    admin.NavMeta{Label: "Network Policies", Group: []string{"Bronze", "Kubernetes"}},
+		Columns: []string{
+			"resource_id", "cluster_name", "namespace", "name",
+			"policy_types_json", "api_created_at", "collected_at", "first_collected_at",
+		},
+		Filters: []lh.SQLFilterDef{
+			{Column: "name", Kind: lh.Search},
+			{Column: "cluster_name", Kind: lh.Multi},
+			{Column: "namespace", Kind: lh.Multi},
+		},
+		DefaultSort:         "name",
+		FilterOptionColumns: []string{"cluster_name", "namespace"},
+	},
+}
@@ -8,6 +8,7 @@ import (
 	"danny.vn/hotpot/pkg/admin/bronze/apicatalog"
 	"danny.vn/hotpot/pkg/admin/bronze/gcp"
 	"danny.vn/hotpot/pkg/admin/bronze/greennode"
+	"danny.vn/hotpot/pkg/admin/bronze/kubernetes"
 	"danny.vn/hotpot/pkg/admin/bronze/meec"
 	"danny.vn/hotpot/pkg/admin/bronze/s1"
 	"danny.vn/hotpot/pkg/admin/bronze/vault"
@@ -21,4 +22,5 @@ func Register(driver dialect.Driver, db *sql.DB) {
 	meec.Register(db)
 	apicatalog.Register(db)
 	vault.Register(db)
+	kubernetes.Register(db)
 }
@@ -209,4 +209,67 @@ var sqlTables = []lh.SQLTable{
 		DefaultSort:         "collected_at", DefaultDesc: true,
 		FilterOptionColumns: []string{"enabled"},
 	},
+	// Threats
+	{
+		API: "/api/v1/bronze/s1/threats", Schema: "bronze",
+		Table: "s1_threats", Nav: admin.NavMeta{Label: "Threats", Group: []string{"Bronze", "SentinelOne"}},
+		Columns:             []string{"resource_id", "threat_name", "agent_computer_name", "classification", "confidence_level", "mitigation_status", "analyst_verdict", "incident_status", "site_name", "identified_at", "api_updated_at", "collected_at", "first_collected_at"},
+		Filters:             []lh.SQLFilterDef{{Column: "threat_name", Kind: lh.Search}, {Column: "classification", Kind: lh.Multi}, {Column: "confidence_level", Kind: lh.Multi}, {Column: "mitigation_status", Kind: lh.Multi}, {Column: "incident_status", Kind: lh.Multi}, {Column: "site_name", Kind: lh.Multi}},
+		DefaultSort:         "api_updated_at", DefaultDesc: true,
+		FilterOptionColumns: []string{"classification", "confidence_level", "mitigation_status", "incident_status", "site_name"},
+	},
+	// Alerts
+	{
+		API: "/api/v1/bronze/s1/alerts", Schema: "bronze",
+		Table: "s1_alerts", Nav: admin.NavMeta{Label: "Alerts", Group: []string{"Bronze", "SentinelOne"}},
+		Columns:             []string{"resource_id", "rule_name", "rule_severity", "agent_name", "event_type", "analyst_verdict", "incident_status", "reported_at", "api_updated_at", "collected_at", "first_collected_at"},
+		Filters:             []lh.SQLFilterDef{{Column: "rule_name", Kind: lh.Search}, {Column: "rule_severity", Kind: lh.Multi}, {Column: "event_type", Kind: lh.Multi}, {Column: "incident_status", Kind: lh.Multi}},
+		DefaultSort:         "api_updated_at", DefaultDesc: true,
+		FilterOptionColumns: []string{"rule_severity", "event_type", "incident_status"},
+	},
+	// App CVEs
+	{
+		API: "/api/v1/bronze/s1/app-cves", Schema: "bronze",
+		Table: "s1_app_cves", Nav: admin.NavMeta{Label: "App CVEs", Group: []string{"Bronze", "SentinelOne"}},
+		Columns:             []string{"resource_id", "endpoint_name", "application_name", "application_version", "cve_id", "cvss_score", "nvd_severity", "exploit_code_maturity", "exploited_in_the_wild", "status", "published_date", "detection_date", "collected_at", "first_collected_at"},
+		Filters:             []lh.SQLFilterDef{{Column: "cve_id", Kind: lh.Search}, {Column: "nvd_severity", Kind: lh.Multi}, {Column: "exploited_in_the_wild", Kind: lh.Multi}, {Column: "status", Kind: lh.Multi}, {Column: "os_type", Kind: lh.Multi}},
+		DefaultSort:         "cvss_score", DefaultDesc: true,
+		FilterOptionColumns: []string{"nvd_severity", "exploited_in_the_wild", "status", "os_type"},
+	},
+	// Activities
+	{
+		API: "/api/v1/bronze/s1/activities", Schema: "bronze",
+		Table: "s1_activities", Nav: admin.NavMeta{Label: "Activities", Group: []string{"Bronze", "SentinelOne"}},
+		Columns:             []string{"resource_id", "activity_type", "primary_description", "account_name", "site_name", "agent_id", "user_id", "threat_id", "api_created_at", "collected_at"},
+		Filters:             []lh.SQLFilterDef{{Column: "primary_description", Kind: lh.Search}, {Column: "activity_type", Kind: lh.Multi}, {Column: "site_name", Kind: lh.Multi}},
+		DefaultSort:         "api_created_at", DefaultDesc: true,
+		FilterOptionColumns: []string{"activity_type", "site_name"},
+	},
+	// Policies
+	{
+		API: "/api/v1/bronze/s1/policies", Schema: "bronze",
+		Table: "s1_policies", Nav: admin.NavMeta{Label: "Policies", Group: []string{"Bronze", "SentinelOne"}},
+		Columns:             []string{"resource_id", "scope_level", "scope_name", "mitigation_mode", "mitigation_mode_suspicious", "anti_tampering_on", "snapshots_on", "network_quarantine_on", "api_updated_at", "collected_at", "first_collected_at"},
+		Filters:             []lh.SQLFilterDef{{Column: "scope_name", Kind: lh.Search}, {Column: "scope_level", Kind: lh.Multi}, {Column: "mitigation_mode", Kind: lh.Multi}, {Column: "anti_tampering_on", Kind: lh.Multi}},
+		DefaultSort:         "scope_level",
+		FilterOptionColumns: []string{"scope_level", "mitigation_mode", "anti_tampering_on"},
+	},
+	// Exclusions
+	{
+		API: "/api/v1/bronze/s1/exclusions", Schema: "bronze",
+		Table: "s1_exclusions", Nav: admin.NavMeta{Label: "Exclusions", Group: []string{"Bronze", "SentinelOne"}},
+		Columns:             []string{"resource_id", "exclusion_type", "exclusion_value", "os_type", "mode", "scope_level", "scope_path", "user_name", "description", "api_updated_at", "collected_at", "first_collected_at"},
+		Filters:             []lh.SQLFilterDef{{Column: "exclusion_value", Kind: lh.Search}, {Column: "exclusion_type", Kind: lh.Multi}, {Column: "os_type", Kind: lh.Multi}, {Column: "scope_level", Kind: lh.Multi}},
+		DefaultSort:         "first_collected_at", DefaultDesc: true,
+		FilterOptionColumns: []string{"exclusion_type", "os_type", "scope_level"},
+	},
+	// Blocklist
+	{
+		API: "/api/v1/bronze/s1/restrictions", Schema: "bronze",
+		Table: "s1_restrictions", Nav: admin.NavMeta{Label: "Blocklist", Group: []string{"Bronze", "SentinelOne"}},
+		Columns:             []string{"resource_id", "restriction_value", "os_type", "scope_level", "scope_path", "source", "user_name", "description", "api_updated_at", "collected_at", "first_collected_at"},
+		Filters:             []lh.SQLFilterDef{{Column: "restriction_value", Kind: lh.Search}, {Column: "os_type", Kind: lh.Multi}, {Column: "scope_level", Kind: lh.Multi}},
+		DefaultSort:         "first_collected_at", DefaultDesc: true,
+		FilterOptionColumns: []string{"os_type", "scope_level"},
+	},
 }
@@ -8,6 +8,7 @@ import (
 	entsql "entgo.io/ent/dialect/sql"
 
 	"danny.vn/hotpot/pkg/admin"
+	"danny.vn/hotpot/pkg/admin/gold/triage"
 	lh "danny.vn/hotpot/pkg/admin/listhandler"
 	entlifecycle "danny.vn/hotpot/pkg/storage/ent/lifecycle"
 	"danny.vn/hotpot/pkg/storage/ent/lifecycle/goldlifecyclesoftware"
@@ -25,7 +26,7 @@ func Register(driver dialect.Driver, db *sql.DB) {
 		DB:      db,
 		Schema:  "gold",
 		Table:   "lifecycle_software",
-		Columns: []string{"classification", "eol_status"},
+		Columns: []string{"classification", "eol_status", "status"},
 	}
 
 	admin.RegisterRoute(admin.RouteRegistration{
@@ -38,6 +39,7 @@ func Register(driver dialect.Driver, db *sql.DB) {
 				"name": true, "version": true, "classification": true,
 				"eol_status": true, "eol_product_name": true, "eol_cycle": true,
 				"eol_date": true, "machine_id": true, "detected_at": true, "first_detected_at": true,
+				"status": true, "assignee": true, "last_seen_at": true,
 			},
 			NewQuery: func() lh.QueryAdapter {
 				q := entClient.GoldLifecycleSoftware.Query()
@@ -52,6 +54,7 @@ func Register(driver dialect.Driver, db *sql.DB) {
 				{Field: "name", Kind: lh.Search, Pred: lh.Pred(goldlifecyclesoftware.NameContainsFold)},
 				{Field: "classification", Kind: lh.Multi, InFn: lh.PredIn(goldlifecyclesoftware.ClassificationIn), EqFn: lh.Pred(goldlifecyclesoftware.ClassificationEQ)},
 				{Field: "eol_status", Kind: lh.Multi, InFn: lh.PredIn(goldlifecyclesoftware.EolStatusIn), EqFn: lh.Pred(goldlifecyclesoftware.EolStatusEQ)},
+				{Field: "status", Kind: lh.Multi, InFn: lh.PredIn(goldlifecyclesoftware.StatusIn), EqFn: lh.Pred(goldlifecyclesoftware.StatusEQ)},
 			},
 			SortFields: map[string]lh.SortFunc{
 				"name":              lh.Sort(goldlifecyclesoftware.ByName),
@@ -60,6 +63,8 @@ func Register(driver dialect.Driver, db *sql.DB) {
 				"eol_date":          lh.Sort(goldlifecyclesoftware.ByEolDate),
 				"detected_at":       lh.Sort(goldlifecyclesoftware.ByDetectedAt),
 				"first_detected_at": lh.Sort(goldlifecyclesoftware.ByFirstDetectedAt),
+				"status":            lh.Sort(goldlifecyclesoftware.ByStatus),
+				"last_seen_at":      lh.Sort(goldlifecyclesoftware.ByLastSeenAt),
 			},
 			DefaultOrder:  goldlifecyclesoftware.ByDetectedAt(entsql.OrderDesc()),
 			FilterOptions: softwareFilterOpts,
@@ -67,6 +72,8 @@ func Register(driver dialect.Driver, db *sql.DB) {
 	})
 
 	lh.RegisterSQL(db, sqlTables)
+	triage.RegisterTable(db, triage.Table{API: "/api/v1/gold/lifecycle/software", Table: "lifecycle_software"})
+	triage.RegisterTable(db, triage.Table{API: "/api/v1/gold/lifecycle/os", Table: "lifecycle_os"})
 }
 
 func goldLifecycle(api, table, label string) lh.SQLTable {
@@ -83,9 +90,9 @@ var sqlTables = []lh.SQLTable{
 	{
 		API: "/api/v1/gold/lifecycle/os", Schema: "gold",
 		Table: "lifecycle_os", Nav: admin.NavMeta{Label: "OS EOL", Group: []string{"Gold", "Lifecycle"}},
-		Columns:             []string{"resource_id", "machine_id", "hostname", "os_type", "os_name", "eol_status", "eol_product_name", "eol_cycle", "eol_date", "eoas_date", "latest_version", "detected_at", "first_detected_at"},
-		Filters:             []lh.SQLFilterDef{{Column: "hostname", Kind: lh.Search}, {Column: "eol_status", Kind: lh.Multi}, {Column: "os_type", Kind: lh.Multi}, {Column: "eol_product_name", Kind: lh.Multi}},
+		Columns:             []string{"resource_id", "status", "assignee", "machine_id", "hostname", "os_type", "os_name", "eol_status", "eol_product_name", "eol_cycle", "eol_date", "eoas_date", "latest_version", "detected_at", "first_detected_at", "last_seen_at", "resolved_at"},
+		Filters:             []lh.SQLFilterDef{{Column: "hostname", Kind: lh.Search}, {Column: "status", Kind: lh.Multi}, {Column: "eol_status", Kind: lh.Multi}, {Column: "os_type", Kind: lh.Multi}, {Column: "eol_product_name", Kind: lh.Multi}},
 		DefaultSort:         "detected_at", DefaultDesc: true,
-		FilterOptionColumns: []string{"eol_status", "os_type", "eol_product_name"},
+		FilterOptionColumns: []string{"status", "eol_status", "os_type", "eol_product_name"},
 	},
 }
@@ -0,0 +1,27 @@
+package posture
+
+import (
+	"database/sql"
+
+	"danny.vn/hotpot/pkg/admin"
+	"danny.vn/hotpot/pkg/admin/gold/triage"
+	lh "danny.vn/hotpot/pkg/admin/listhandler"
+)
+
+// Register registers all Gold Posture admin routes.
+func Register(db *sql.DB) {
+	lh.RegisterSQL(db, sqlTables)
+	triage.RegisterTable(db, triage.Table{API: "/api/v1/gold/posture/findings", Table: "posture_findings"})
+}
+
+var sqlTables = []lh.SQLTable{
+	// Findings
+	{
+		API: "/api/v1/gold/posture/findings", Schema: "gold",
+		Table: "posture_findings", Nav: admin.NavMeta{Label: "Findings", Group: []string{"Gold", "Posture"}},
+		Columns:             []string{"resource_id", "status", "assignee", "rule_key", "title", "severity", "category", "provider", "resource_type", "asset_id", "asset_name", "project_id", "remediation", "detected_at", "first_detected_at", "last_seen_at", "resolved_at"},
+		Filters:             []lh.SQLFilterDef{{Column: "asset_name", Kind: lh.Search}, {Column: "rule_key", Kind: lh.Multi}, {Column: "status", Kind: lh.Multi}, {Column: "severity", Kind: lh.Multi}, {Column: "category", Kind: lh.Multi}, {Column: "project_id", Kind: lh.Multi}},
+		DefaultSort:         "detected_at", DefaultDesc: true,
+		FilterOptionColumns: []string{"status", "rule_key", "severity", "category", "project_id"},
+	},
+}
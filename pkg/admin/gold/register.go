@@ -7,10 +7,16 @@ import (
 
 	"danny.vn/hotpot/pkg/admin/gold/httpmonitor"
 	"danny.vn/hotpot/pkg/admin/gold/lifecycle"
+	"danny.vn/hotpot/pkg/admin/gold/posture"
+	"danny.vn/hotpot/pkg/admin/gold/triage"
+	"danny.vn/hotpot/pkg/admin/gold/vulnerability"
 )
 
 // Register registers all Gold layer admin routes.
 func Register(driver dialect.Driver, db *sql.DB) {
 	lifecycle.Register(driver, db)
 	httpmonitor.Register(db)
+	posture.Register(db)
+	vulnerability.Register(db)
+	triage.Register(db)
 }
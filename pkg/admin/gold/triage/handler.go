@@ -0,0 +1,163 @@
+package triage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"danny.vn/hotpot/pkg/admin"
+)
+
+// maxBodyBytes bounds write request bodies.
+const maxBodyBytes = 64 << 10
+
+// changeBody is the JSON body accepted by the write endpoints. Which fields
+// are required depends on the action.
+type changeBody struct {
+	Reason   string     `json:"reason"`
+	Until    *time.Time `json:"until"`
+	Assignee *string    `json:"assignee"`
+	Note     *string    `json:"note"`
+}
+
+// changeHandler applies action to the finding identified by {id} in a single
+// transaction: lock the row, validate the transition, update it and append
+// an audit entry. It responds with the updated row.
+func changeHandler(db *sql.DB, t Table, action string) http.HandlerFunc {
+	selectState := fmt.Sprintf(`SELECT status, assignee, note, suppressed_until, resolved_at
+		FROM "gold"."%s" WHERE resource_id = $1 FOR UPDATE`, t.Table)
+	updateState := fmt.Sprintf(`UPDATE "gold"."%s"
+		SET status = $2, assignee = $3, note = $4, suppressed_until = $5, resolved_at = $6
+		WHERE resource_id = $1`, t.Table)
+	selectRow := fmt.Sprintf(`SELECT row_to_json(t) FROM "gold"."%s" t WHERE resource_id = $1`, t.Table)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if id == "" {
+			admin.WriteError(w, http.StatusBadRequest, "missing id")
+			return
+		}
+
+		var body changeBody
+		if r.ContentLength != 0 {
+			dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+			dec.DisallowUnknownFields()
+			if err := dec.Decode(&body); err != nil {
+				admin.WriteError(w, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+				return
+			}
+		}
+
+		ctx := r.Context()
+		now := time.Now()
+
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			admin.WriteServerError(w, "failed to begin transaction", err)
+			return
+		}
+		defer tx.Rollback()
+
+		var cur state
+		err = tx.QueryRowContext(ctx, selectState, id).
+			Scan(&cur.Status, &cur.Assignee, &cur.Note, &cur.SuppressedUntil, &cur.ResolvedAt)
+		if err == sql.ErrNoRows {
+			admin.WriteError(w, http.StatusNotFound, "not found")
+			return
+		}
+		if err != nil {
+			admin.WriteServerError(w, "failed to load finding", err)
+			return
+		}
+
+		next, err := apply(cur, change{
+			Action:   action,
+			Reason:   body.Reason,
+			Until:    body.Until,
+			Assignee: body.Assignee,
+			Note:     body.Note,
+		}, now)
+		if err != nil {
+			var te *transitionError
+			if errors.As(err, &te) {
+				admin.WriteError(w, te.code, te.msg)
+				return
+			}
+			admin.WriteServerError(w, "failed to apply change", err)
+			return
+		}
+
+		if _, err := tx.ExecContext(ctx, updateState, id,
+			next.Status, next.Assignee, next.Note, next.SuppressedUntil, next.ResolvedAt); err != nil {
+			admin.WriteServerError(w, "failed to update finding", err)
+			return
+		}
+
+		changes, err := json.Marshal(diff(cur, next))
+		if err != nil {
+			admin.WriteServerError(w, "failed to encode changes", err)
+			return
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO gold.triage_audits
+			(finding_table, finding_id, action, actor, from_status, to_status, reason, changes_json, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			t.Table, id, action, admin.Actor(r), cur.Status, next.Status,
+			emptyToNil(body.Reason), changes, now); err != nil {
+			admin.WriteServerError(w, "failed to write audit entry", err)
+			return
+		}
+
+		var raw json.RawMessage
+		if err := tx.QueryRowContext(ctx, selectRow, id).Scan(&raw); err != nil {
+			admin.WriteServerError(w, "failed to load finding", err)
+			return
+		}
+		if err := tx.Commit(); err != nil {
+			admin.WriteServerError(w, "failed to commit", err)
+			return
+		}
+
+		admin.WriteJSON(w, http.StatusOK, admin.DetailResponse{Data: raw})
+	}
+}
+
+// historyHandler returns the audit entries of one finding, newest first.
+func historyHandler(db *sql.DB, t Table) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if id == "" {
+			admin.WriteError(w, http.StatusBadRequest, "missing id")
+			return
+		}
+
+		rows, err := db.QueryContext(r.Context(), `SELECT row_to_json(a)
+			FROM gold.triage_audits a
+			WHERE finding_table = $1 AND finding_id = $2
+			ORDER BY created_at DESC, audit_id DESC
+			LIMIT 200`, t.Table, id)
+		if err != nil {
+			admin.WriteServerError(w, "failed to load audit history", err)
+			return
+		}
+		defer rows.Close()
+
+		entries := []json.RawMessage{}
+		for rows.Next() {
+			var raw json.RawMessage
+			if err := rows.Scan(&raw); err != nil {
+				admin.WriteServerError(w, "failed to load audit history", err)
+				return
+			}
+			entries = append(entries, raw)
+		}
+		if err := rows.Err(); err != nil {
+			admin.WriteServerError(w, "failed to load audit history", err)
+			return
+		}
+
+		admin.WriteJSON(w, http.StatusOK, admin.DetailResponse{Data: entries})
+	}
+}
@@ -0,0 +1,87 @@
+// Package triage provides the admin write endpoints for gold finding state
+// (see goldmixin.FindingState) and the audit log they append to.
+package triage
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+
+	"danny.vn/hotpot/pkg/admin"
+	lh "danny.vn/hotpot/pkg/admin/listhandler"
+)
+
+// Table is a gold finding table exposed for triage.
+type Table struct {
+	API   string // list API, e.g. "/api/v1/gold/httpmonitor/anomalies"
+	Table string // table in the gold schema, e.g. "httpmonitor_anomalies"
+}
+
+var validTable = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// rowActions are the POST {API}/{id}/{segment} endpoints.
+var rowActions = []struct {
+	segment string
+	action  string
+	label   string
+}{
+	{"acknowledge", actionAcknowledge, "Acknowledge"},
+	{"suppress", actionSuppress, "Suppress"},
+	{"false-positive", actionFalsePositive, "False Positive"},
+	{"reopen", actionReopen, "Reopen"},
+}
+
+// Register registers the triage audit log list.
+func Register(db *sql.DB) {
+	lh.RegisterSQL(db, sqlTables)
+}
+
+// RegisterTable registers the write and history routes for a finding table:
+//
+//	POST  {API}/{id}/acknowledge
+//	POST  {API}/{id}/suppress        {"until": RFC3339, "reason": "..."}
+//	POST  {API}/{id}/false-positive  {"reason": "..."}
+//	POST  {API}/{id}/reopen
+//	PATCH {API}/{id}                 {"assignee": "...", "note": "..."}
+//	GET   {API}/{id}/audit
+//
+// Every write accepts an optional "reason" that is stored in the audit log.
+func RegisterTable(db *sql.DB, t Table) {
+	if !validTable.MatchString(t.Table) {
+		panic(fmt.Sprintf("triage: invalid table %q", t.Table))
+	}
+
+	for _, a := range rowActions {
+		admin.RegisterRoute(admin.RouteRegistration{
+			Method:  "POST",
+			Path:    t.API + "/{id}/" + a.segment,
+			Handler: changeHandler(db, t, a.action),
+			Action:  &admin.ActionMeta{List: t.API, Name: a.action, Label: a.label},
+		})
+	}
+
+	admin.RegisterRoute(admin.RouteRegistration{
+		Method:  "PATCH",
+		Path:    t.API + "/{id}",
+		Handler: changeHandler(db, t, actionUpdate),
+		Action:  &admin.ActionMeta{List: t.API, Name: actionUpdate, Label: "Edit"},
+	})
+
+	admin.RegisterRoute(admin.RouteRegistration{
+		Method:  "GET",
+		Path:    t.API + "/{id}/audit",
+		Handler: historyHandler(db, t),
+	})
+}
+
+var sqlTables = []lh.SQLTable{
+	// Audit log
+	{
+		API: "/api/v1/gold/triage/audits", Schema: "gold",
+		Table: "triage_audits", Nav: admin.NavMeta{Label: "Audit Log", Group: []string{"Gold", "Triage"}},
+		Columns:             []string{"audit_id", "finding_table", "finding_id", "action", "actor", "from_status", "to_status", "reason", "changes_json", "created_at"},
+		Filters:             []lh.SQLFilterDef{{Column: "finding_id", Kind: lh.Search}, {Column: "finding_table", Kind: lh.Multi}, {Column: "action", Kind: lh.Multi}, {Column: "actor", Kind: lh.Multi}},
+		DefaultSort:         "created_at", DefaultDesc: true,
+		FilterOptionColumns: []string{"finding_table", "action", "actor"},
+	},
+}
@@ -0,0 +1,163 @@
+package triage
+
+import (
+	"net/http"
+	"time"
+
+	"danny.vn/hotpot/pkg/detect/finding"
+)
+
+// Actions accepted by the write endpoints.
+const (
+	actionAcknowledge   = "acknowledge"
+	actionSuppress      = "suppress"
+	actionFalsePositive = "false_positive"
+	actionReopen        = "reopen"
+	actionUpdate        = "update"
+)
+
+// state is the analyst-owned part of a finding row.
+type state struct {
+	Status          string
+	Assignee        *string
+	Note            *string
+	SuppressedUntil *time.Time
+	ResolvedAt      *time.Time
+}
+
+// change is a decoded write request.
+type change struct {
+	Action   string
+	Reason   string
+	Until    *time.Time
+	Assignee *string
+	Note     *string
+}
+
+// transitionError carries the HTTP status for a rejected change.
+type transitionError struct {
+	code int
+	msg  string
+}
+
+func (e *transitionError) Error() string { return e.msg }
+
+func badRequest(msg string) error { return &transitionError{http.StatusBadRequest, msg} }
+func conflict(msg string) error   { return &transitionError{http.StatusConflict, msg} }
+
+// apply validates c against the current state and returns the new state.
+// Detector-owned transitions (resolve, reopen on re-detection) live in
+// pkg/detect/finding; this only covers what an analyst may do.
+func apply(cur state, c change, now time.Time) (state, error) {
+	next := cur
+
+	switch c.Action {
+	case actionAcknowledge:
+		switch cur.Status {
+		case finding.StatusAcknowledged:
+			return cur, conflict("finding is already acknowledged")
+		case finding.StatusResolved:
+			return cur, conflict("finding is resolved; reopen it first")
+		}
+		next.Status = finding.StatusAcknowledged
+		next.SuppressedUntil = nil
+
+	case actionSuppress:
+		if c.Until == nil {
+			return cur, badRequest("until is required")
+		}
+		if !c.Until.After(now) {
+			return cur, badRequest("until must be in the future")
+		}
+		if c.Reason == "" {
+			return cur, badRequest("reason is required")
+		}
+		if cur.Status == finding.StatusResolved {
+			return cur, conflict("finding is resolved; reopen it first")
+		}
+		next.Status = finding.StatusSuppressed
+		next.SuppressedUntil = c.Until
+
+	case actionFalsePositive:
+		if c.Reason == "" {
+			return cur, badRequest("reason is required")
+		}
+		if cur.Status == finding.StatusFalsePositive {
+			return cur, conflict("finding is already marked as false positive")
+		}
+		next.Status = finding.StatusFalsePositive
+		next.SuppressedUntil = nil
+
+	case actionReopen:
+		if cur.Status == finding.StatusOpen {
+			return cur, conflict("finding is already open")
+		}
+		next.Status = finding.StatusOpen
+		next.SuppressedUntil = nil
+		next.ResolvedAt = nil
+
+	case actionUpdate:
+		if c.Assignee == nil && c.Note == nil {
+			return cur, badRequest("assignee or note is required")
+		}
+		if c.Assignee != nil {
+			next.Assignee = emptyToNil(*c.Assignee)
+		}
+		if c.Note != nil {
+			next.Note = emptyToNil(*c.Note)
+		}
+
+	default:
+		return cur, badRequest("unknown action " + c.Action)
+	}
+
+	return next, nil
+}
+
+// fieldChange is one entry of the audit changes_json object.
+type fieldChange struct {
+	From any `json:"from"`
+	To   any `json:"to"`
+}
+
+// diff returns the changed fields as {field: {from, to}} for the audit log.
+func diff(from, to state) map[string]fieldChange {
+	d := map[string]fieldChange{}
+	if from.Status != to.Status {
+		d["status"] = fieldChange{from.Status, to.Status}
+	}
+	if !eqString(from.Assignee, to.Assignee) {
+		d["assignee"] = fieldChange{from.Assignee, to.Assignee}
+	}
+	if !eqString(from.Note, to.Note) {
+		d["note"] = fieldChange{from.Note, to.Note}
+	}
+	if !eqTime(from.SuppressedUntil, to.SuppressedUntil) {
+		d["suppressed_until"] = fieldChange{from.SuppressedUntil, to.SuppressedUntil}
+	}
+	if !eqTime(from.ResolvedAt, to.ResolvedAt) {
+		d["resolved_at"] = fieldChange{from.ResolvedAt, to.ResolvedAt}
+	}
+	return d
+}
+
+func emptyToNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func eqString(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func eqTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
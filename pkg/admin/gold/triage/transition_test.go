@@ -0,0 +1,93 @@
+package triage
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestApply(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	future := now.Add(24 * time.Hour)
+	past := now.Add(-time.Hour)
+	resolvedAt := now.Add(-2 * time.Hour)
+	alice := "alice"
+	empty := ""
+
+	tests := []struct {
+		name     string
+		cur      state
+		change   change
+		want     state
+		wantCode int // 0 = success
+	}{
+		{"acknowledge open", state{Status: "open"}, change{Action: actionAcknowledge},
+			state{Status: "acknowledged"}, 0},
+		{"acknowledge twice", state{Status: "acknowledged"}, change{Action: actionAcknowledge},
+			state{}, http.StatusConflict},
+		{"acknowledge resolved", state{Status: "resolved"}, change{Action: actionAcknowledge},
+			state{}, http.StatusConflict},
+		{"acknowledge clears suppression", state{Status: "suppressed", SuppressedUntil: &future},
+			change{Action: actionAcknowledge}, state{Status: "acknowledged"}, 0},
+
+		{"suppress", state{Status: "open"}, change{Action: actionSuppress, Until: &future, Reason: "maintenance"},
+			state{Status: "suppressed", SuppressedUntil: &future}, 0},
+		{"suppress without until", state{Status: "open"}, change{Action: actionSuppress, Reason: "x"},
+			state{}, http.StatusBadRequest},
+		{"suppress in the past", state{Status: "open"}, change{Action: actionSuppress, Until: &past, Reason: "x"},
+			state{}, http.StatusBadRequest},
+		{"suppress without reason", state{Status: "open"}, change{Action: actionSuppress, Until: &future},
+			state{}, http.StatusBadRequest},
+
+		{"false positive", state{Status: "open"}, change{Action: actionFalsePositive, Reason: "test traffic"},
+			state{Status: "false_positive"}, 0},
+		{"false positive without reason", state{Status: "open"}, change{Action: actionFalsePositive},
+			state{}, http.StatusBadRequest},
+
+		{"reopen resolved", state{Status: "resolved", ResolvedAt: &resolvedAt}, change{Action: actionReopen},
+			state{Status: "open"}, 0},
+		{"reopen open", state{Status: "open"}, change{Action: actionReopen},
+			state{}, http.StatusConflict},
+
+		{"assign", state{Status: "open"}, change{Action: actionUpdate, Assignee: &alice},
+			state{Status: "open", Assignee: &alice}, 0},
+		{"unassign", state{Status: "open", Assignee: &alice}, change{Action: actionUpdate, Assignee: &empty},
+			state{Status: "open"}, 0},
+		{"update nothing", state{Status: "open"}, change{Action: actionUpdate},
+			state{}, http.StatusBadRequest},
+
+		{"unknown action", state{Status: "open"}, change{Action: "delete"},
+			state{}, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := apply(tt.cur, tt.change, now)
+			if tt.wantCode != 0 {
+				var te *transitionError
+				if !errors.As(err, &te) || te.code != tt.wantCode {
+					t.Fatalf("apply() error = %v, want code %d", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("apply() error = %v", err)
+			}
+			if len(diff(got, tt.want)) != 0 {
+				t.Errorf("apply() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	alice := "alice"
+	d := diff(state{Status: "open"}, state{Status: "acknowledged", Assignee: &alice})
+	if len(d) != 2 {
+		t.Fatalf("diff() = %v, want status and assignee", d)
+	}
+	if d["status"].From != "open" || d["status"].To != "acknowledged" {
+		t.Errorf("diff()[status] = %+v", d["status"])
+	}
+}
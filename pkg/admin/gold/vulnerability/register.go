@@ -0,0 +1,27 @@
+package vulnerability
+
+import (
+	"database/sql"
+
+	"danny.vn/hotpot/pkg/admin"
+	"danny.vn/hotpot/pkg/admin/gold/triage"
+	lh "danny.vn/hotpot/pkg/admin/listhandler"
+)
+
+// Register registers all Gold Vulnerability admin routes.
+func Register(db *sql.DB) {
+	lh.RegisterSQL(db, sqlTables)
+	triage.RegisterTable(db, triage.Table{API: "/api/v1/gold/vulnerability/findings", Table: "vulnerability_findings"})
+}
+
+var sqlTables = []lh.SQLTable{
+	// Findings
+	{
+		API: "/api/v1/gold/vulnerability/findings", Schema: "gold",
+		Table: "vulnerability_findings", Nav: admin.NavMeta{Label: "Findings", Group: []string{"Gold", "Vulnerability"}},
+		Columns:     []string{"resource_id", "status", "assignee", "vuln_id", "severity", "cvss_score", "hostname", "machine_id", "package_name", "package_version", "fixed_version", "source", "ecosystem", "summary", "published", "detected_at", "first_detected_at", "last_seen_at", "resolved_at"},
+		Filters:     []lh.SQLFilterDef{{Column: "package_name", Kind: lh.Search}, {Column: "vuln_id", Kind: lh.Search}, {Column: "status", Kind: lh.Multi}, {Column: "severity", Kind: lh.Multi}, {Column: "source", Kind: lh.Multi}, {Column: "ecosystem", Kind: lh.Multi}},
+		DefaultSort: "detected_at", DefaultDesc: true,
+		FilterOptionColumns: []string{"status", "severity", "source", "ecosystem"},
+	},
+}
@@ -14,6 +14,23 @@ type RouteRegistration struct {
 	// Nav provides sidebar navigation metadata.
 	// If nil, the route is not shown in the sidebar (e.g., stats endpoints).
 	Nav *NavMeta
+
+	// Action marks a write route that acts on one row of a list API.
+	// The UI offers it on rows of that list.
+	Action *ActionMeta
+}
+
+// ActionMeta describes a row action attached to a list route.
+type ActionMeta struct {
+	// List is the list API path the action belongs to
+	// (e.g., "/api/v1/gold/httpmonitor/anomalies").
+	List string
+
+	// Name is the action key (e.g., "acknowledge").
+	Name string
+
+	// Label is the button text (e.g., "Acknowledge").
+	Label string
 }
 
 // NavMeta describes how a route appears in the sidebar navigation.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"embed"
+	"fmt"
 	"io/fs"
 	"log/slog"
 	"net/http"
@@ -12,6 +13,7 @@ import (
 
 	"entgo.io/ent/dialect"
 
+	"danny.vn/hotpot/pkg/admin/auth"
 	"danny.vn/hotpot/pkg/base/config"
 )
 
@@ -30,11 +32,21 @@ type uiConfigResponse struct {
 }
 
 type navItem struct {
-	Label    string    `json:"label"`
-	Icon     string    `json:"icon,omitempty"`
-	Path     string    `json:"path,omitempty"`
-	API      string    `json:"api,omitempty"`
-	Children []navItem `json:"children,omitempty"`
+	Label    string      `json:"label"`
+	Icon     string      `json:"icon,omitempty"`
+	Path     string      `json:"path,omitempty"`
+	API      string      `json:"api,omitempty"`
+	Actions  []navAction `json:"actions,omitempty"`
+	Children []navItem   `json:"children,omitempty"`
+}
+
+// navAction is a row action offered on a list page. Path contains an
+// "{id}" placeholder for the row's resource_id.
+type navAction struct {
+	Name   string `json:"name"`
+	Label  string `json:"label"`
+	Method string `json:"method"`
+	Path   string `json:"path"`
 }
 
 // groupIcons maps top-level group names to lucide icon names.
@@ -91,15 +103,27 @@ func isDisabled(path string, disable []string) bool {
 }
 
 // buildNav constructs the sidebar nav tree from registered routes,
-// excluding any disabled API paths.
-func buildNav(disable []string) []navItem {
+// excluding any disabled API paths and routes the caller may not access.
+// A nil allowed func shows every route.
+func buildNav(disable []string, allowed func(RouteRegistration) bool) []navItem {
 	// Dashboard is always first.
 	nav := []navItem{{Label: "Dashboard", Icon: "layout-dashboard", Path: "/dashboard"}}
 
 	root := newNavNode("")
 
+	// Collect row actions keyed by the list API they belong to.
+	actions := map[string][]navAction{}
 	for _, r := range Routes() {
-		if r.Nav == nil || isDisabled(r.Path, disable) || len(r.Nav.Group) == 0 {
+		if r.Action == nil || isDisabled(r.Path, disable) || (allowed != nil && !allowed(r)) {
+			continue
+		}
+		actions[r.Action.List] = append(actions[r.Action.List], navAction{
+			Name: r.Action.Name, Label: r.Action.Label, Method: r.Method, Path: r.Path,
+		})
+	}
+
+	for _, r := range Routes() {
+		if r.Nav == nil || isDisabled(r.Path, disable) || len(r.Nav.Group) == 0 || (allowed != nil && !allowed(r)) {
 			continue
 		}
 
@@ -112,7 +136,7 @@ func buildNav(disable []string) []navItem {
 		// Derive frontend path: /api/v1/bronze/gcp/compute/instances → /bronze/gcp/compute/instances
 		frontendPath := strings.TrimPrefix(r.Path, "/api/v1")
 		current.leaves = append(current.leaves, navItem{
-			Label: r.Nav.Label, Path: frontendPath, API: r.Path,
+			Label: r.Nav.Label, Path: frontendPath, API: r.Path, Actions: actions[r.Path],
 		})
 	}
 
@@ -145,38 +169,70 @@ func extractDB(driver dialect.Driver) *sql.DB {
 }
 
 // newAPIMux creates an HTTP mux with all registered API routes
-// plus the built-in ui-config endpoint.
-func newAPIMux(configService *config.Service, driver dialect.Driver) *http.ServeMux {
+// plus the built-in ui-config endpoint. When admin auth is configured, each
+// route is guarded by the role policy and the returned authenticator must
+// wrap the mux (see withAuth).
+func newAPIMux(ctx context.Context, configService *config.Service, driver dialect.Driver) (*http.ServeMux, auth.Authenticator, error) {
 	// Register all routes via the callback set by cmd/ entry points.
 	if RegisterAll != nil {
 		RegisterAll(driver, extractDB(driver))
 	}
 
+	authn, err := auth.New(ctx, configService.AdminAuthConfig())
+	if err != nil {
+		return nil, nil, fmt.Errorf("admin auth: %w", err)
+	}
+	if authn == nil {
+		slog.Warn("admin API has no authentication configured; all routes are open")
+	}
+	policy := auth.NewPolicy(configService.AdminRoles())
+
 	mux := http.NewServeMux()
+	routes := Routes()
+	groups := routeGroups(routes)
+	toAuthRoute := func(r RouteRegistration) auth.Route {
+		return auth.Route{Method: r.Method, Path: r.Path, Group: groups[r.Method+" "+r.Path]}
+	}
 
 	// Built-in: serve auto-generated UI config.
 	ui := configService.AdminUIConfig()
 	disable := ui.Disable
 
 	mux.HandleFunc("GET /api/v1/admin/ui-config", func(w http.ResponseWriter, r *http.Request) {
+		var allowed func(RouteRegistration) bool
+		if id, ok := auth.FromContext(r.Context()); ok {
+			allowed = func(rr RouteRegistration) bool { return policy.Allowed(id.Roles, toAuthRoute(rr)) }
+		}
 		resp := uiConfigResponse{
 			Name:        ui.Name,
 			Description: ui.Description,
 			Title:       ui.Title,
 			Icon:        ui.Icon,
 			Color:       ui.Color,
-			Nav:         buildNav(disable),
+			Nav:         buildNav(disable, allowed),
 		}
 		WriteJSON(w, http.StatusOK, resp)
 	})
 
-	for _, r := range Routes() {
+	for _, r := range routes {
 		if isDisabled(r.Path, disable) {
 			continue
 		}
-		mux.HandleFunc(r.Method+" "+r.Path, r.Handler)
+		h := r.Handler
+		if authn != nil {
+			h = authorize(policy, toAuthRoute(r), h)
+		}
+		mux.HandleFunc(r.Method+" "+r.Path, h)
+	}
+	return mux, authn, nil
+}
+
+// withAuth wraps the handler with authentication when it is configured.
+func withAuth(authn auth.Authenticator, h http.Handler) http.Handler {
+	if authn == nil {
+		return h
 	}
-	return mux
+	return authenticate(authn, h)
 }
 
 // serve starts an HTTP server with graceful shutdown on ctx cancellation.
@@ -197,15 +253,21 @@ func serve(ctx context.Context, addr string, handler http.Handler) error {
 // RunAPI starts the admin API server without serving the frontend.
 // Use this for development with Vite HMR handling the UI.
 func RunAPI(ctx context.Context, configService *config.Service, driver dialect.Driver) error {
-	mux := newAPIMux(configService, driver)
+	mux, authn, err := newAPIMux(ctx, configService, driver)
+	if err != nil {
+		return err
+	}
 	addr := configService.AdminAddr()
 	slog.Info("admin API server started", "addr", addr)
-	return serve(ctx, addr, mux)
+	return serve(ctx, addr, withAuth(authn, mux))
 }
 
 // Run starts the admin HTTP server with both API routes and embedded Vue SPA.
 func Run(ctx context.Context, configService *config.Service, driver dialect.Driver, distFS embed.FS) error {
-	mux := newAPIMux(configService, driver)
+	mux, authn, err := newAPIMux(ctx, configService, driver)
+	if err != nil {
+		return err
+	}
 
 	// Serve Vue SPA from embedded filesystem.
 	uiDist, err := fs.Sub(distFS, "ui/dist")
@@ -226,5 +288,5 @@ func Run(ctx context.Context, configService *config.Service, driver dialect.Driv
 
 	addr := configService.AdminAddr()
 	slog.Info("admin server started", "addr", addr)
-	return serve(ctx, addr, mux)
+	return serve(ctx, addr, withAuth(authn, mux))
 }
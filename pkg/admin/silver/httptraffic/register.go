@@ -35,10 +35,10 @@ var sqlTables = []lh.SQLTable{
 	{
 		API: "/api/v1/silver/httptraffic/client-ip-5m", Schema: "silver",
 		Table: "httptraffic_client_ip_5m", Nav: admin.NavMeta{Label: "Client IP 5m", Group: []string{"Silver", "HTTP Traffic"}},
-		Columns:             []string{"resource_id", "client_ip", "uri", "method", "country_code", "country_name", "asn", "org_name", "is_internal", "request_count", "is_mapped", "window_start", "window_end", "collected_at", "first_collected_at", "normalized_at"},
-		Filters:             []lh.SQLFilterDef{{Column: "client_ip", Kind: lh.Search}, {Column: "country_code", Kind: lh.Multi}, {Column: "org_name", Kind: lh.Multi}, {Column: "is_internal", Kind: lh.Multi}, {Column: "is_mapped", Kind: lh.Multi}},
+		Columns:             []string{"resource_id", "client_ip", "uri", "method", "country_code", "country_name", "asn", "org_name", "is_internal", "is_threat_listed", "threat_feeds", "threat_categories", "request_count", "is_mapped", "window_start", "window_end", "collected_at", "first_collected_at", "normalized_at"},
+		Filters:             []lh.SQLFilterDef{{Column: "client_ip", Kind: lh.Search}, {Column: "country_code", Kind: lh.Multi}, {Column: "org_name", Kind: lh.Multi}, {Column: "is_internal", Kind: lh.Multi}, {Column: "is_threat_listed", Kind: lh.Multi}, {Column: "is_mapped", Kind: lh.Multi}},
 		DefaultSort:         "window_start", DefaultDesc: true,
-		FilterOptionColumns: []string{"country_code", "org_name", "is_internal", "is_mapped"},
+		FilterOptionColumns: []string{"country_code", "org_name", "is_internal", "is_threat_listed", "is_mapped"},
 	},
 	// User Agent 5m
 	{
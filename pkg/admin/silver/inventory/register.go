@@ -124,6 +124,15 @@ var sqlTables = []lh.SQLTable{
 		DefaultSort:         "collected_at", DefaultDesc: true,
 		FilterOptionColumns: []string{"cluster_name", "status", "cloud_project"},
 	},
+	// Container Images
+	{
+		API: "/api/v1/silver/inventory/images", Schema: "silver",
+		Table: "inventory_images", Nav: admin.NavMeta{Label: "Images", Group: []string{"Silver", "Inventory"}},
+		Columns:             []string{"resource_id", "registry", "repository", "digest", "tags_json", "workloads_json", "vulnerability_count", "max_severity", "vulnerabilities_json", "collected_at", "first_collected_at", "normalized_at"},
+		Filters:             []lh.SQLFilterDef{{Column: "repository", Kind: lh.Search}, {Column: "registry", Kind: lh.Multi}, {Column: "max_severity", Kind: lh.Multi}},
+		DefaultSort:         "collected_at", DefaultDesc: true,
+		FilterOptionColumns: []string{"registry", "max_severity"},
+	},
 	// Software — joined with machines to expose hostname, status, environment.
 	{
 		API: "/api/v1/silver/inventory/software", Schema: "silver",
@@ -150,9 +159,9 @@ var sqlTables = []lh.SQLTable{
 	{
 		API: "/api/v1/silver/inventory/api-endpoints", Schema: "silver",
 		Table: "inventory_api_endpoints", Nav: admin.NavMeta{Label: "API Endpoints", Group: []string{"Silver", "Inventory"}},
-		Columns:             []string{"resource_id", "name", "service", "uri_pattern", "is_active", "access_level", "collected_at", "first_collected_at", "normalized_at"},
-		Filters:             []lh.SQLFilterDef{{Column: "uri_pattern", Kind: lh.Search}, {Column: "service", Kind: lh.Multi}, {Column: "access_level", Kind: lh.Multi}, {Column: "is_active", Kind: lh.Multi}},
+		Columns:             []string{"resource_id", "name", "service", "uri_pattern", "is_active", "access_level", "is_discovered", "collected_at", "first_collected_at", "normalized_at"},
+		Filters:             []lh.SQLFilterDef{{Column: "uri_pattern", Kind: lh.Search}, {Column: "service", Kind: lh.Multi}, {Column: "access_level", Kind: lh.Multi}, {Column: "is_active", Kind: lh.Multi}, {Column: "is_discovered", Kind: lh.Multi}},
 		DefaultSort:         "collected_at", DefaultDesc: true,
-		FilterOptionColumns: []string{"service", "access_level", "is_active"},
+		FilterOptionColumns: []string{"service", "access_level", "is_active", "is_discovered"},
 	},
 }
@@ -70,8 +70,15 @@ var bronzeProviderDefs = []struct {
 	{"vault", []bronzeHighlight{
 		{"vault_pki_certificates", "PKI Certificates"},
 	}},
+	{"kubernetes", []bronzeHighlight{
+		{"k8s_workloads", "Workloads"},
+		{"k8s_pods", "Pods"},
+		{"k8s_images", "Images"},
+		{"k8s_role_bindings", "Role Bindings"},
+	}},
 	{"apicatalog", []bronzeHighlight{
 		{"apicatalog_endpoints_raw", "API Endpoints"},
+		{"apicatalog_openapi_endpoints", "OpenAPI Endpoints"},
 	}},
 }
 
@@ -112,6 +119,7 @@ func querySilver(ctx context.Context, db *sql.DB) map[string]stat {
 		"machines":       {Count: countRows(ctx, db, `SELECT COUNT(*) FROM silver.inventory_machines`)},
 		"k8s_nodes":      {Count: countRows(ctx, db, `SELECT COUNT(*) FROM silver.inventory_k8s_nodes`)},
 		"software":       {Count: countRows(ctx, db, `SELECT COUNT(*) FROM silver.inventory_software`)},
+		"images":         {Count: countRows(ctx, db, `SELECT COUNT(*) FROM silver.inventory_images`)},
 		"api_endpoints":  {Count: countRows(ctx, db, `SELECT COUNT(*) FROM silver.inventory_api_endpoints`)},
 		"traffic_5m":     {Count: countRows(ctx, db, `SELECT COUNT(*) FROM silver.httptraffic_traffic_5m`)},
 		"client_ips_5m":  {Count: countRows(ctx, db, `SELECT COUNT(*) FROM silver.httptraffic_client_ip_5m`)},
@@ -121,10 +129,10 @@ func querySilver(ctx context.Context, db *sql.DB) map[string]stat {
 
 func queryGold(ctx context.Context, db *sql.DB) map[string]stat {
 	return map[string]stat{
-		"software_eol":  {Count: countRows(ctx, db, `SELECT COUNT(*) FROM gold.lifecycle_software WHERE eol_status = 'eol_expired'`)},
-		"software_eoes": {Count: countRows(ctx, db, `SELECT COUNT(*) FROM gold.lifecycle_software WHERE eol_status = 'eoes_expired'`)},
-		"os_eol":        {Count: countRows(ctx, db, `SELECT COUNT(*) FROM gold.lifecycle_os WHERE eol_status = 'eol_expired'`)},
-		"os_eoes":       {Count: countRows(ctx, db, `SELECT COUNT(*) FROM gold.lifecycle_os WHERE eol_status = 'eoes_expired'`)},
+		"software_eol":  {Count: countRows(ctx, db, `SELECT COUNT(*) FROM gold.lifecycle_software WHERE eol_status = 'eol_expired' AND status IN ('open', 'acknowledged')`)},
+		"software_eoes": {Count: countRows(ctx, db, `SELECT COUNT(*) FROM gold.lifecycle_software WHERE eol_status = 'eoes_expired' AND status IN ('open', 'acknowledged')`)},
+		"os_eol":        {Count: countRows(ctx, db, `SELECT COUNT(*) FROM gold.lifecycle_os WHERE eol_status = 'eol_expired' AND status IN ('open', 'acknowledged')`)},
+		"os_eoes":       {Count: countRows(ctx, db, `SELECT COUNT(*) FROM gold.lifecycle_os WHERE eol_status = 'eoes_expired' AND status IN ('open', 'acknowledged')`)},
 		"anomalies":          {Count: countRows(ctx, db, `SELECT COUNT(*) FROM gold.httpmonitor_anomalies`)},
 		"anomalies_critical": {Count: countRows(ctx, db, `SELECT COUNT(*) FROM gold.httpmonitor_anomalies WHERE severity = 'critical'`)},
 		"anomalies_high":     {Count: countRows(ctx, db, `SELECT COUNT(*) FROM gold.httpmonitor_anomalies WHERE severity = 'high'`)},
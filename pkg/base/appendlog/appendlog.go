@@ -0,0 +1,135 @@
+// Package appendlog drives append-only ingestion of event-style sources such
+// as audit logs. Unlike inventory ingestion (list everything, diff, delete
+// stale), events are fetched in consecutive time windows starting at a
+// persisted high-water mark, inserted once, and pruned by age.
+//
+// A source implements Stream; Run computes the windows, ingests them oldest
+// first and advances the watermark after each one, so an interrupted run
+// resumes at the first window that was not committed. Stream.Ingest must be
+// idempotent for a window (e.g. skip primary key conflicts) because a window
+// whose watermark commit failed is fetched again on the next run.
+package appendlog
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Window is a half-open [Start, End) time range of events.
+type Window struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Config controls windowing and retention for one stream.
+type Config struct {
+	// Backfill is how far before now the first run starts when the stream
+	// has no watermark yet.
+	Backfill time.Duration
+
+	// WindowSize caps the span of one window. The watermark advances after
+	// every window, bounding the work redone after an interruption.
+	WindowSize time.Duration
+
+	// Lag keeps the newest window end this far behind now, so events the
+	// source indexes late are not skipped by an already-advanced watermark.
+	Lag time.Duration
+
+	// Retention deletes events older than now-Retention after ingestion.
+	// Zero keeps events forever.
+	Retention time.Duration
+}
+
+// Stream is the source-specific half of an append-only ingestion.
+type Stream interface {
+	// Watermark returns the end of the last committed window, or the zero
+	// time when the stream has never been ingested.
+	Watermark(ctx context.Context) (time.Time, error)
+
+	// Ingest fetches and stores every event in w and returns how many new
+	// events were stored.
+	Ingest(ctx context.Context, w Window) (int, error)
+
+	// Commit persists end as the new watermark.
+	Commit(ctx context.Context, end time.Time) error
+
+	// Prune deletes events older than before and returns how many were
+	// deleted.
+	Prune(ctx context.Context, before time.Time) (int, error)
+}
+
+// Result summarizes one Run.
+type Result struct {
+	Windows   int
+	Events    int
+	Pruned    int
+	Watermark time.Time
+}
+
+// Windows splits the range from the watermark (or now-Backfill on the first
+// run) up to now-Lag into consecutive windows of at most WindowSize. It
+// returns nil when there is nothing to ingest yet.
+func Windows(watermark, now time.Time, cfg Config) []Window {
+	start := watermark
+	if start.IsZero() {
+		start = now.Add(-cfg.Backfill)
+	}
+	end := now.Add(-cfg.Lag)
+	if !start.Before(end) {
+		return nil
+	}
+	size := cfg.WindowSize
+	if size <= 0 {
+		size = end.Sub(start)
+	}
+
+	var windows []Window
+	for ws := start; ws.Before(end); ws = ws.Add(size) {
+		we := ws.Add(size)
+		if we.After(end) {
+			we = end
+		}
+		windows = append(windows, Window{Start: ws, End: we})
+	}
+	return windows
+}
+
+// Run ingests every pending window of s, oldest first, committing the
+// watermark after each, then prunes events past retention. heartbeat, if
+// not nil, is called after every window.
+func Run(ctx context.Context, s Stream, cfg Config, now time.Time, heartbeat func()) (*Result, error) {
+	watermark, err := s.Watermark(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load watermark: %w", err)
+	}
+
+	result := &Result{Watermark: watermark}
+	for _, w := range Windows(watermark, now, cfg) {
+		n, err := s.Ingest(ctx, w)
+		if err != nil {
+			return nil, fmt.Errorf("ingest window %s..%s: %w",
+				w.Start.Format(time.RFC3339), w.End.Format(time.RFC3339), err)
+		}
+		if err := s.Commit(ctx, w.End); err != nil {
+			return nil, fmt.Errorf("commit watermark %s: %w", w.End.Format(time.RFC3339), err)
+		}
+		result.Windows++
+		result.Events += n
+		result.Watermark = w.End
+
+		if heartbeat != nil {
+			heartbeat()
+		}
+	}
+
+	if cfg.Retention > 0 {
+		pruned, err := s.Prune(ctx, now.Add(-cfg.Retention))
+		if err != nil {
+			return nil, fmt.Errorf("prune: %w", err)
+		}
+		result.Pruned = pruned
+	}
+
+	return result, nil
+}
@@ -0,0 +1,105 @@
+package appendlog
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var t0 = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func TestWindows_FirstRunBackfill(t *testing.T) {
+	cfg := Config{Backfill: 3 * time.Hour, WindowSize: time.Hour, Lag: 30 * time.Minute}
+	got := Windows(time.Time{}, t0, cfg)
+
+	want := []Window{
+		{t0.Add(-3 * time.Hour), t0.Add(-2 * time.Hour)},
+		{t0.Add(-2 * time.Hour), t0.Add(-time.Hour)},
+		{t0.Add(-time.Hour), t0.Add(-30 * time.Minute)},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d windows, want %d: %v", len(got), len(want), got)
+	}
+	for i := range want {
+		if !got[i].Start.Equal(want[i].Start) || !got[i].End.Equal(want[i].End) {
+			t.Errorf("window %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestWindows_UpToDate(t *testing.T) {
+	cfg := Config{WindowSize: time.Hour, Lag: 5 * time.Minute}
+	if got := Windows(t0.Add(-time.Minute), t0, cfg); got != nil {
+		t.Errorf("got %v, want nil when watermark is within lag", got)
+	}
+}
+
+func TestWindows_NoWindowSize(t *testing.T) {
+	got := Windows(t0.Add(-48*time.Hour), t0, Config{})
+	if len(got) != 1 || !got[0].End.Equal(t0) {
+		t.Errorf("got %v, want a single window up to now", got)
+	}
+}
+
+type fakeStream struct {
+	watermark time.Time
+	failAt    int
+	ingested  []Window
+	commits   []time.Time
+	pruneAt   time.Time
+}
+
+func (f *fakeStream) Watermark(context.Context) (time.Time, error) { return f.watermark, nil }
+
+func (f *fakeStream) Ingest(_ context.Context, w Window) (int, error) {
+	if f.failAt > 0 && len(f.ingested)+1 == f.failAt {
+		return 0, errors.New("boom")
+	}
+	f.ingested = append(f.ingested, w)
+	return 10, nil
+}
+
+func (f *fakeStream) Commit(_ context.Context, end time.Time) error {
+	f.commits = append(f.commits, end)
+	return nil
+}
+
+func (f *fakeStream) Prune(_ context.Context, before time.Time) (int, error) {
+	f.pruneAt = before
+	return 3, nil
+}
+
+func TestRun(t *testing.T) {
+	s := &fakeStream{watermark: t0.Add(-2 * time.Hour)}
+	cfg := Config{WindowSize: time.Hour, Retention: 24 * time.Hour}
+
+	res, err := Run(context.Background(), s, cfg, t0, nil)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if res.Windows != 2 || res.Events != 20 || res.Pruned != 3 || !res.Watermark.Equal(t0) {
+		t.Errorf("result = %+v", res)
+	}
+	if len(s.commits) != 2 || !s.commits[0].Equal(t0.Add(-time.Hour)) {
+		t.Errorf("commits = %v, want one per window", s.commits)
+	}
+	if !s.pruneAt.Equal(t0.Add(-24 * time.Hour)) {
+		t.Errorf("pruneAt = %v", s.pruneAt)
+	}
+}
+
+func TestRun_StopsAtFailedWindow(t *testing.T) {
+	s := &fakeStream{watermark: t0.Add(-3 * time.Hour), failAt: 2}
+	cfg := Config{WindowSize: time.Hour, Retention: time.Hour}
+
+	if _, err := Run(context.Background(), s, cfg, t0, nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if len(s.commits) != 1 || !s.commits[0].Equal(t0.Add(-2*time.Hour)) {
+		t.Errorf("commits = %v, want only the first window", s.commits)
+	}
+	if !s.pruneAt.IsZero() {
+		t.Error("prune ran after a failed window")
+	}
+}
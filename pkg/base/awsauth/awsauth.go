@@ -0,0 +1,105 @@
+package awsauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+)
+
+// SessionName is the role session name recorded in CloudTrail for assumed roles.
+const SessionName = "hotpot-ingest"
+
+// LoadConfig builds an AWS SDK config for an account and region.
+//
+// Credential resolution:
+//  1. If a static access key is configured, it is used as the base credentials.
+//     Otherwise falls back to the default credential chain (environment, shared
+//     config files, and the EC2/ECS metadata endpoints).
+//  2. If an assume role name is configured and accountID is non-empty, the base
+//     credentials assume arn:aws:iam::<accountID>:role/<name> in that account.
+//     An empty accountID always uses the base credentials, e.g. for account discovery,
+//     and so does the account of the base credentials themselves: the
+//     organization management account has no OrganizationAccountAccessRole.
+//
+// Transport chain: http.DefaultTransport → RateLimitedTransport → http.Client,
+// shared by the service clients and the STS AssumeRole calls.
+func LoadConfig(ctx context.Context, configService *config.Service, limiter ratelimit.Limiter, accountID, region string) (aws.Config, error) {
+	var opts []func(*awsconfig.LoadOptions) error
+
+	opts = append(opts, awsconfig.WithRegion(region))
+
+	// Static credentials if configured
+	if accessKey := configService.AWSAccessKeyID(); accessKey != "" {
+		secretKey := configService.AWSSecretAccessKey()
+		opts = append(opts, awsconfig.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(accessKey, secretKey, ""),
+		))
+	}
+
+	// Rate-limited HTTP client
+	opts = append(opts, awsconfig.WithHTTPClient(&http.Client{
+		Transport: ratelimit.NewRateLimitedTransport(limiter, nil),
+	}))
+
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return aws.Config{}, fmt.Errorf("awsauth: load AWS config: %w", err)
+	}
+
+	roleName := configService.AWSAssumeRoleName()
+	if roleName == "" || accountID == "" {
+		return cfg, nil
+	}
+	caller, err := callerAccount(ctx, cfg, configService.AWSAccessKeyID())
+	if err != nil {
+		return aws.Config{}, err
+	}
+	if accountID == caller {
+		return cfg, nil
+	}
+
+	externalID := configService.AWSExternalID()
+	provider := stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), RoleARN(accountID, roleName),
+		func(o *stscreds.AssumeRoleOptions) {
+			o.RoleSessionName = SessionName
+			if externalID != "" {
+				o.ExternalID = aws.String(externalID)
+			}
+		})
+	cfg.Credentials = aws.NewCredentialsCache(provider)
+
+	return cfg, nil
+}
+
+// callerAccounts caches the account of the base credentials by access key
+// ID ("" for the default credential chain), saving an STS call per config.
+var callerAccounts sync.Map
+
+// callerAccount returns the account of the base credentials.
+func callerAccount(ctx context.Context, cfg aws.Config, accessKey string) (string, error) {
+	if id, ok := callerAccounts.Load(accessKey); ok {
+		return id.(string), nil
+	}
+	output, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", fmt.Errorf("awsauth: get caller identity: %w", err)
+	}
+	id := aws.ToString(output.Account)
+	callerAccounts.Store(accessKey, id)
+	return id, nil
+}
+
+// RoleARN returns the ARN of the named IAM role in an account.
+func RoleARN(accountID, roleName string) string {
+	return fmt.Sprintf("arn:aws:iam::%s:role/%s", accountID, roleName)
+}
@@ -12,12 +12,14 @@ type Config struct {
 	DO        DOConfig        `yaml:"do"`
 	GreenNode GreenNodeConfig `yaml:"greennode"`
 	Vault     VaultConfig     `yaml:"vault"`
+	Kubernetes KubernetesConfig `yaml:"kubernetes"`
 	Jenkins   JenkinsConfig   `yaml:"jenkins"`
 	MEEC      MEECConfig      `yaml:"meec"`
 	Reference  ReferenceConfig  `yaml:"reference"`
 	ApiCatalog ApiCatalogConfig `yaml:"apicatalog"`
 	AccessLog  AccessLogConfig  `yaml:"accesslog"`
 	Admin      AdminConfig      `yaml:"admin"`
+	Notify     NotifyConfig     `yaml:"notify"`
 	Database   DatabaseConfig   `yaml:"database"`
 	Temporal TemporalConfig `yaml:"temporal"`
 	Redis    RedisConfig    `yaml:"redis"`
@@ -40,6 +42,18 @@ type AWSConfig struct {
 	// If empty, all enabled regions are discovered via DescribeRegions.
 	Regions []string `yaml:"regions,omitempty"`
 
+	// AssumeRoleName is the IAM role assumed in every scanned account,
+	// e.g. "OrganizationAccountAccessRole". If empty, only the account of
+	// the configured credentials is scanned.
+	AssumeRoleName string `yaml:"assume_role_name,omitempty"`
+
+	// ExternalID is passed to sts:AssumeRole when the role's trust policy requires one.
+	ExternalID string `yaml:"external_id,omitempty"`
+
+	// AccountIDs lists the accounts to scan with AssumeRoleName.
+	// If empty, active accounts are discovered via Organizations ListAccounts.
+	AccountIDs []string `yaml:"account_ids,omitempty"`
+
 	// RateLimitPerMinute is the max API requests per minute across all AWS clients.
 	// Default: 600 (see Service.AWSRateLimitPerMinute()).
 	RateLimitPerMinute int `yaml:"rate_limit_per_minute,omitempty"`
@@ -88,6 +102,14 @@ type S1Config struct {
 	APIToken           string `yaml:"api_token"`
 	RateLimitPerMinute int    `yaml:"rate_limit_per_minute,omitempty"`
 	BatchSize          int    `yaml:"batch_size,omitempty"`
+
+	// ActivityBackfillDays is how many days of console activities the first
+	// run fetches before the activity cursor exists. Default: 7.
+	ActivityBackfillDays int `yaml:"activity_backfill_days,omitempty"`
+
+	// ActivityRetentionDays prunes console activities older than this many
+	// days. Default: 365.
+	ActivityRetentionDays int `yaml:"activity_retention_days,omitempty"`
 }
 
 // DOConfig holds DigitalOcean configuration.
@@ -159,6 +181,47 @@ type VaultInstance struct {
 	VerifySSL *bool `yaml:"verify_ssl,omitempty"`
 }
 
+// KubernetesConfig holds Kubernetes API configuration for in-cluster inventory.
+type KubernetesConfig struct {
+	// Enabled controls whether Kubernetes ingestion runs and tables are created.
+	Enabled bool `yaml:"enabled"`
+
+	// RateLimitPerMinute is the max API requests per minute across all clusters.
+	// Default: 600 (see Service.KubernetesRateLimitPerMinute()).
+	RateLimitPerMinute int `yaml:"rate_limit_per_minute,omitempty"`
+
+	// Clusters is the list of clusters to ingest from.
+	Clusters []KubernetesCluster `yaml:"clusters"`
+}
+
+// KubernetesCluster holds connection details for a single cluster. Exactly
+// one of Kubeconfig, GKE or DOKSClusterID selects how to authenticate.
+type KubernetesCluster struct {
+	// Name is a unique identifier for this cluster (e.g., "prod-gke").
+	// Stored as cluster_name on every ingested object.
+	Name string `yaml:"name"`
+
+	// Kubeconfig is a kubeconfig file path, or the kubeconfig YAML itself
+	// (e.g., when stored in Vault).
+	Kubeconfig string `yaml:"kubeconfig,omitempty"`
+
+	// Context selects a kubeconfig context. Default: the current context.
+	Context string `yaml:"context,omitempty"`
+
+	// GKE reaches a GKE cluster with the gcp credentials_json (or ADC).
+	GKE *GKEClusterRef `yaml:"gke,omitempty"`
+
+	// DOKSClusterID reaches a DOKS cluster with the do api_token.
+	DOKSClusterID string `yaml:"doks_cluster_id,omitempty"`
+}
+
+// GKEClusterRef identifies a GKE cluster.
+type GKEClusterRef struct {
+	Project  string `yaml:"project"`
+	Location string `yaml:"location"`
+	Cluster  string `yaml:"cluster"`
+}
+
 // JenkinsConfig holds Jenkins CI configuration.
 type JenkinsConfig struct {
 	// Enabled controls whether Jenkins ingestion runs and tables are created.
@@ -231,6 +294,68 @@ type ReferenceConfig struct {
 	// RateLimitPerMinute is the max HTTP requests per minute to public servers.
 	// Default: 30 (see Service.ReferenceRateLimitPerMinute()).
 	RateLimitPerMinute int `yaml:"rate_limit_per_minute,omitempty"`
+
+	// OSV configures the OSV advisory feed.
+	OSV ReferenceOSVConfig `yaml:"osv,omitempty"`
+
+	// NVD configures the NVD CVE feed.
+	NVD ReferenceNVDConfig `yaml:"nvd,omitempty"`
+
+	// ThreatIntel configures IP reputation feeds (scanners, Tor exits, blocklists).
+	ThreatIntel ReferenceThreatIntelConfig `yaml:"threatintel,omitempty"`
+}
+
+// ReferenceOSVConfig holds OSV advisory feed configuration.
+type ReferenceOSVConfig struct {
+	// Source is a local OSV dump: a zip (all.zip or a per-ecosystem zip), an
+	// advisory JSON file, or a directory of them. Empty downloads the public
+	// per-ecosystem zips.
+	Source string `yaml:"source,omitempty"`
+
+	// Ecosystems lists the ecosystems downloaded when Source is empty.
+	// Default: the ecosystems in osv.DefaultEcosystems.
+	Ecosystems []string `yaml:"ecosystems,omitempty"`
+}
+
+// ReferenceNVDConfig holds NVD CVE feed configuration.
+type ReferenceNVDConfig struct {
+	// Source is a local NVD JSON 2.0 feed file (.json or .json.gz) or a
+	// directory of them. Empty downloads the public yearly feeds.
+	Source string `yaml:"source,omitempty"`
+
+	// StartYear is the first yearly feed downloaded when Source is empty.
+	// Default: 2002.
+	StartYear int `yaml:"start_year,omitempty"`
+}
+
+// ReferenceThreatIntelConfig holds IP reputation feed configuration.
+type ReferenceThreatIntelConfig struct {
+	// Feeds lists the IP/CIDR feeds to load. No feeds are loaded by default.
+	Feeds []ReferenceThreatIntelFeed `yaml:"feeds,omitempty"`
+}
+
+// ReferenceThreatIntelFeed describes one IP/CIDR feed.
+type ReferenceThreatIntelFeed struct {
+	// Name identifies the feed; indicators are stored and replaced per name.
+	Name string `yaml:"name"`
+
+	// Source is an http(s) URL, or a local file or directory of feed files.
+	Source string `yaml:"source"`
+
+	// Format is text (one IP or CIDR per line), csv or stix (STIX 2.x JSON).
+	// Default: text.
+	Format string `yaml:"format,omitempty"`
+
+	// Category is scanner, tor or blocklist. Default: blocklist.
+	Category string `yaml:"category,omitempty"`
+
+	// Column is the CSV header holding the IP or CIDR. Empty uses the first
+	// field of each row that parses as one.
+	Column string `yaml:"column,omitempty"`
+
+	// ExpiryHours is how long indicators stay valid after a load, so a feed
+	// that stops updating ages out. Default: 72.
+	ExpiryHours int `yaml:"expiry_hours,omitempty"`
 }
 
 // ApiCatalogConfig holds API catalog ingestion configuration.
@@ -279,13 +404,47 @@ type AccessLogConfig struct {
 	// Required for automatic ASN database updates.
 	IPInfoToken string `yaml:"ipinfo_token,omitempty"`
 
+	// Receiver configures the HTTP endpoint that httppush sources send to.
+	Receiver AccessLogReceiverConfig `yaml:"receiver,omitempty"`
+
 	// Sources is the list of access log sources to ingest from.
 	Sources []AccessLogSourceConfig `yaml:"sources,omitempty"`
 }
 
+// AccessLogReceiverConfig configures the push receiver for httppush sources.
+type AccessLogReceiverConfig struct {
+	// Addr is the listen address of the receiver, e.g. ":8088".
+	// Required when any httppush source is configured.
+	Addr string `yaml:"addr,omitempty"`
+
+	// Host is the hostname of the ingest worker that runs the receiver.
+	// Only that worker starts it, and flushes are routed to its task queue,
+	// so senders must point at this host.
+	// Required when any httppush source is configured.
+	Host string `yaml:"host,omitempty"`
+
+	// SpoolDir holds received entries until their window is flushed to bronze.
+	// Default: "data/accesslog-spool" relative to the binary.
+	SpoolDir string `yaml:"spool_dir,omitempty"`
+
+	// MaxBodyBytes is the largest accepted request body.
+	// Default: 10 MiB (see Service.AccessLogReceiverMaxBodyBytes()).
+	MaxBodyBytes int64 `yaml:"max_body_bytes,omitempty"`
+
+	// MaxInFlight is how many requests are processed at once; further
+	// requests are rejected with 429 until one completes.
+	// Default: 16 (see Service.AccessLogReceiverMaxInFlight()).
+	MaxInFlight int `yaml:"max_in_flight,omitempty"`
+
+	// MaxSpoolBytes caps the spool size; requests are rejected with 503
+	// while it is full, e.g. when bronze flushes are failing.
+	// Default: 1 GiB (see Service.AccessLogReceiverMaxSpoolBytes()).
+	MaxSpoolBytes int64 `yaml:"max_spool_bytes,omitempty"`
+}
+
 // AccessLogSourceConfig defines a single access log source.
 type AccessLogSourceConfig struct {
-	// Type is the source type (e.g. "gcplogging").
+	// Type is the source type: "gcplogging", "filelog" or "httppush".
 	Type string `yaml:"type"`
 
 	// Name is a unique identifier for this source.
@@ -310,6 +469,24 @@ type AccessLogSourceConfig struct {
 	// FieldMapping maps log JSON keys to standard names.
 	FieldMapping map[string]string `yaml:"field_mapping,omitempty"`
 
+	// Path is the directory or object-store prefix holding the log files
+	// (for filelog sources), e.g. "/var/log/nginx" or "s3://bucket/AWSLogs/".
+	Path string `yaml:"path,omitempty"`
+
+	// Pattern is a glob matched against file names under Path (for filelog
+	// sources), e.g. "access.log*". Default: all files.
+	Pattern string `yaml:"pattern,omitempty"`
+
+	// Format is the log line format (for filelog sources): "nginx"
+	// (combined), "json", "envoy" or "alb".
+	Format string `yaml:"format,omitempty"`
+
+	// Region is the AWS region of an s3:// Path (for filelog sources).
+	Region string `yaml:"region,omitempty"`
+
+	// Token is the bearer token senders must present (for httppush sources).
+	Token string `yaml:"token,omitempty"`
+
 	// IntervalMinutes is the collection interval in minutes.
 	// Default: 5.
 	IntervalMinutes int `yaml:"interval_minutes,omitempty"`
@@ -327,6 +504,101 @@ type AdminConfig struct {
 
 	// UI holds the frontend UI configuration (project name, sidebar nav, etc.).
 	UI AdminUIConfig `yaml:"ui"`
+
+	// Auth configures how API callers are authenticated.
+	// If no method is configured, the API is open (development only).
+	Auth AdminAuthConfig `yaml:"auth,omitempty"`
+
+	// Roles defines what each role may access. Identities carry role names
+	// from their token entry, OIDC claim, or proxy header.
+	Roles []AdminRoleConfig `yaml:"roles,omitempty"`
+}
+
+// AdminAuthConfig holds the admin API authentication methods.
+// Methods are tried in order: static tokens, OIDC, trusted proxy.
+type AdminAuthConfig struct {
+	// Tokens are static bearer tokens, e.g. for scripts and CI.
+	Tokens []AdminTokenConfig `yaml:"tokens,omitempty"`
+
+	// OIDC validates bearer JWTs issued by an OpenID Connect provider.
+	OIDC AdminOIDCConfig `yaml:"oidc,omitempty"`
+
+	// Proxy trusts identity headers set by an authenticating reverse proxy
+	// (e.g. oauth2-proxy) in front of the admin server.
+	Proxy AdminProxyConfig `yaml:"proxy,omitempty"`
+}
+
+// Enabled reports whether any authentication method is configured.
+func (a AdminAuthConfig) Enabled() bool {
+	return len(a.Tokens) > 0 || a.OIDC.Issuer != "" || a.Proxy.UserHeader != ""
+}
+
+// AdminTokenConfig is a static API token.
+type AdminTokenConfig struct {
+	// Name identifies the token holder in the audit log.
+	Name string `yaml:"name"`
+
+	// Token is the bearer token value.
+	Token string `yaml:"token"`
+
+	// Roles granted to requests using this token.
+	Roles []string `yaml:"roles"`
+}
+
+// AdminOIDCConfig holds OIDC bearer JWT validation settings.
+type AdminOIDCConfig struct {
+	// Issuer is the expected "iss" claim, e.g. "https://accounts.example.com".
+	Issuer string `yaml:"issuer,omitempty"`
+
+	// Audience is the expected "aud" claim (usually the client ID).
+	Audience string `yaml:"audience,omitempty"`
+
+	// JWKSURL overrides the key set URL.
+	// Default: jwks_uri from {issuer}/.well-known/openid-configuration.
+	JWKSURL string `yaml:"jwks_url,omitempty"`
+
+	// JWKSFile loads the key set from a local file instead of fetching it.
+	// Useful for air-gapped deployments and tests.
+	JWKSFile string `yaml:"jwks_file,omitempty"`
+
+	// UsernameClaim is the claim used as the actor name.
+	// Default: "email", falling back to "sub".
+	UsernameClaim string `yaml:"username_claim,omitempty"`
+
+	// RolesClaim is the claim holding role names. Dots address nested
+	// claims (e.g. "realm_access.roles").
+	// Default: "groups".
+	RolesClaim string `yaml:"roles_claim,omitempty"`
+}
+
+// AdminProxyConfig holds trusted-proxy header authentication settings.
+type AdminProxyConfig struct {
+	// UserHeader carries the authenticated user, e.g. "X-Forwarded-Email".
+	UserHeader string `yaml:"user_header,omitempty"`
+
+	// RolesHeader carries comma-separated role names, e.g. "X-Forwarded-Groups".
+	RolesHeader string `yaml:"roles_header,omitempty"`
+
+	// TrustedCIDRs lists proxy addresses allowed to set the headers.
+	// Requests from other addresses are not authenticated by this method.
+	TrustedCIDRs []string `yaml:"trusted_cidrs,omitempty"`
+}
+
+// AdminRoleConfig grants access to parts of the admin API.
+type AdminRoleConfig struct {
+	// Name is the role name referenced by tokens, claims, and headers.
+	Name string `yaml:"name"`
+
+	// Allow lists what the role can read. Entries are "*", a nav group path
+	// such as "Bronze" or "Bronze/Vault", or an API path ("/api/v1/gold/"
+	// as a prefix, otherwise exact).
+	Allow []string `yaml:"allow"`
+
+	// Deny removes access granted by Allow, using the same entry forms.
+	Deny []string `yaml:"deny,omitempty"`
+
+	// Write allows non-GET requests (e.g. finding triage) on allowed routes.
+	Write bool `yaml:"write,omitempty"`
 }
 
 // AdminUIConfig defines the user-customizable frontend layout.
@@ -361,6 +633,44 @@ type AdminUIConfig struct {
 	Disable []string `yaml:"disable,omitempty"`
 }
 
+// NotifyConfig holds outbound notification destinations. Routing lives in
+// the config.notification_rules table; rules refer to destinations by name.
+type NotifyConfig struct {
+	Destinations []NotifyDestinationConfig `yaml:"destinations,omitempty"`
+}
+
+// NotifyDestinationConfig is one place notifications are delivered to.
+type NotifyDestinationConfig struct {
+	// Name is referenced by notification rules.
+	Name string `yaml:"name"`
+
+	// Type is the delivery method: webhook, slack, teams, smtp.
+	Type string `yaml:"type"`
+
+	// URL is the endpoint for webhook, slack, and teams destinations.
+	URL string `yaml:"url,omitempty"`
+
+	// Secret signs webhook payloads with HMAC-SHA256 (X-Hotpot-Signature).
+	Secret string `yaml:"secret,omitempty"`
+
+	// SMTP holds mail server settings for smtp destinations.
+	SMTP NotifySMTPConfig `yaml:"smtp,omitempty"`
+}
+
+// NotifySMTPConfig holds SMTP delivery settings.
+type NotifySMTPConfig struct {
+	Host     string   `yaml:"host"`
+	Port     int      `yaml:"port,omitempty"` // Default: 587
+	Username string   `yaml:"username,omitempty"`
+	Password string   `yaml:"password,omitempty"`
+	From     string   `yaml:"from"`
+	To       []string `yaml:"to"`
+
+	// ImplicitTLS connects with TLS from the start (port 465).
+	// Otherwise STARTTLS is used when the server offers it.
+	ImplicitTLS bool `yaml:"implicit_tls,omitempty"`
+}
+
 // RedisConfig holds Redis connection configuration.
 type RedisConfig struct {
 	Address  string `yaml:"address"`
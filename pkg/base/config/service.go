@@ -216,6 +216,9 @@ func (s *Service) EnabledProviders() []string {
 	if s.config.Jenkins.Enabled {
 		providers = append(providers, "jenkins")
 	}
+	if s.config.Kubernetes.Enabled {
+		providers = append(providers, "kubernetes")
+	}
 	if s.config.MEEC.Enabled {
 		providers = append(providers, "meec")
 	}
@@ -268,6 +271,72 @@ func (s *Service) AccessLogSources() []AccessLogSourceConfig {
 	return result
 }
 
+// AccessLogReceiverAddr returns the listen address of the push receiver,
+// or "" if it is not configured.
+func (s *Service) AccessLogReceiverAddr() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil {
+		return ""
+	}
+	return s.config.AccessLog.Receiver.Addr
+}
+
+// AccessLogReceiverHost returns the hostname of the ingest worker that runs
+// the push receiver, or "" if it is not configured.
+func (s *Service) AccessLogReceiverHost() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil {
+		return ""
+	}
+	return s.config.AccessLog.Receiver.Host
+}
+
+// AccessLogReceiverSpoolDir returns the push receiver spool directory.
+// Defaults to "data/accesslog-spool" next to the binary if not configured.
+func (s *Service) AccessLogReceiverSpoolDir() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil || s.config.AccessLog.Receiver.SpoolDir == "" {
+		return dataDefaultPath("accesslog-spool")
+	}
+	return s.config.AccessLog.Receiver.SpoolDir
+}
+
+// AccessLogReceiverMaxBodyBytes returns the largest request body the push
+// receiver accepts. Defaults to 10 MiB if not configured.
+func (s *Service) AccessLogReceiverMaxBodyBytes() int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil || s.config.AccessLog.Receiver.MaxBodyBytes <= 0 {
+		return 10 << 20
+	}
+	return s.config.AccessLog.Receiver.MaxBodyBytes
+}
+
+// AccessLogReceiverMaxInFlight returns how many requests the push receiver
+// processes at once. Defaults to 16 if not configured.
+func (s *Service) AccessLogReceiverMaxInFlight() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil || s.config.AccessLog.Receiver.MaxInFlight <= 0 {
+		return 16
+	}
+	return s.config.AccessLog.Receiver.MaxInFlight
+}
+
+// AccessLogReceiverMaxSpoolBytes returns the push receiver spool size cap.
+// Defaults to 1 GiB if not configured.
+func (s *Service) AccessLogReceiverMaxSpoolBytes() int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil || s.config.AccessLog.Receiver.MaxSpoolBytes <= 0 {
+		return 1 << 30
+	}
+	return s.config.AccessLog.Receiver.MaxSpoolBytes
+}
+
 // GeoIPCityPath returns the path to the city-level GeoIP .mmdb file.
 // Defaults to "data/geoip/dbip-city.mmdb" next to the binary if not configured.
 func (s *Service) GeoIPCityPath() string {
@@ -292,15 +361,20 @@ func (s *Service) GeoIPASNPath() string {
 
 // geoipDefaultPath returns a path under data/geoip/ next to the running binary.
 func geoipDefaultPath(filename string) string {
+	return dataDefaultPath(filepath.Join("geoip", filename))
+}
+
+// dataDefaultPath returns a path under data/ next to the running binary.
+func dataDefaultPath(name string) string {
 	exe, err := os.Executable()
 	if err != nil {
-		return filepath.Join("data", "geoip", filename)
+		return filepath.Join("data", name)
 	}
 	exe, err = filepath.EvalSymlinks(exe)
 	if err != nil {
-		return filepath.Join("data", "geoip", filename)
+		return filepath.Join("data", name)
 	}
-	return filepath.Join(filepath.Dir(exe), "data", "geoip", filename)
+	return filepath.Join(filepath.Dir(exe), "data", name)
 }
 
 // AccessLogRetentionDays returns how many days to keep anomalies and silver traffic data.
@@ -397,6 +471,36 @@ func (s *Service) AdminUIConfig() AdminUIConfig {
 	return ui
 }
 
+// AdminAuthConfig returns the admin API authentication settings.
+func (s *Service) AdminAuthConfig() AdminAuthConfig {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil {
+		return AdminAuthConfig{}
+	}
+	return s.config.Admin.Auth
+}
+
+// AdminRoles returns the admin role definitions.
+func (s *Service) AdminRoles() []AdminRoleConfig {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil {
+		return nil
+	}
+	return append([]AdminRoleConfig{}, s.config.Admin.Roles...)
+}
+
+// NotifyDestinations returns the configured notification destinations.
+func (s *Service) NotifyDestinations() []NotifyDestinationConfig {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil {
+		return nil
+	}
+	return append([]NotifyDestinationConfig{}, s.config.Notify.Destinations...)
+}
+
 // DatabaseDSN returns the database connection string.
 func (s *Service) DatabaseDSN() string {
 	s.mu.RLock()
@@ -480,6 +584,28 @@ func (s *Service) S1BatchSize() int {
 	return s.config.S1.BatchSize
 }
 
+// S1ActivityBackfillDays returns how many days of SentinelOne activities the
+// first run fetches. Defaults to 7 if not configured.
+func (s *Service) S1ActivityBackfillDays() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil || s.config.S1.ActivityBackfillDays <= 0 {
+		return 7
+	}
+	return s.config.S1.ActivityBackfillDays
+}
+
+// S1ActivityRetentionDays returns how many days of SentinelOne activities are
+// kept. Defaults to 365 if not configured.
+func (s *Service) S1ActivityRetentionDays() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil || s.config.S1.ActivityRetentionDays <= 0 {
+		return 365
+	}
+	return s.config.S1.ActivityRetentionDays
+}
+
 // S1Enabled returns true if SentinelOne ingestion is enabled in config.
 func (s *Service) S1Enabled() bool {
 	s.mu.RLock()
@@ -557,6 +683,40 @@ func (s *Service) AWSRegions() []string {
 	return result
 }
 
+// AWSAssumeRoleName returns the IAM role name assumed in each scanned account.
+// Returns empty string if not configured (caller should scan only the credentials' own account).
+func (s *Service) AWSAssumeRoleName() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil {
+		return ""
+	}
+	return s.config.AWS.AssumeRoleName
+}
+
+// AWSExternalID returns the external ID for sts:AssumeRole.
+func (s *Service) AWSExternalID() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil {
+		return ""
+	}
+	return s.config.AWS.ExternalID
+}
+
+// AWSAccountIDs returns the configured list of accounts to scan.
+// Returns nil if not configured (caller should discover accounts via Organizations).
+func (s *Service) AWSAccountIDs() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil || len(s.config.AWS.AccountIDs) == 0 {
+		return nil
+	}
+	result := make([]string, len(s.config.AWS.AccountIDs))
+	copy(result, s.config.AWS.AccountIDs)
+	return result
+}
+
 // AWSRateLimitPerMinute returns the max API requests per minute for AWS.
 // Defaults to 600 if not configured.
 func (s *Service) AWSRateLimitPerMinute() int {
@@ -715,6 +875,53 @@ func (s *Service) VaultInstance(name string) *VaultInstance {
 	return nil
 }
 
+// KubernetesEnabled returns true if Kubernetes ingestion is enabled in config.
+func (s *Service) KubernetesEnabled() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.config != nil && s.config.Kubernetes.Enabled
+}
+
+// KubernetesRateLimitPerMinute returns the max API requests per minute for Kubernetes.
+// Defaults to 600 if not configured.
+func (s *Service) KubernetesRateLimitPerMinute() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil || s.config.Kubernetes.RateLimitPerMinute <= 0 {
+		return 600
+	}
+	return s.config.Kubernetes.RateLimitPerMinute
+}
+
+// KubernetesClusters returns a copy of configured Kubernetes clusters.
+func (s *Service) KubernetesClusters() []KubernetesCluster {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil || len(s.config.Kubernetes.Clusters) == 0 {
+		return nil
+	}
+	result := make([]KubernetesCluster, len(s.config.Kubernetes.Clusters))
+	copy(result, s.config.Kubernetes.Clusters)
+	return result
+}
+
+// KubernetesCluster looks up a Kubernetes cluster by name.
+// Returns nil if not found.
+func (s *Service) KubernetesCluster(name string) *KubernetesCluster {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil {
+		return nil
+	}
+	for _, c := range s.config.Kubernetes.Clusters {
+		if c.Name == name {
+			v := c
+			return &v
+		}
+	}
+	return nil
+}
+
 // JenkinsEnabled returns true if Jenkins ingestion is enabled in config.
 func (s *Service) JenkinsEnabled() bool {
 	s.mu.RLock()
@@ -932,6 +1139,65 @@ func (s *Service) ReferenceRateLimitPerMinute() int {
 	return s.config.Reference.RateLimitPerMinute
 }
 
+// ReferenceOSVSource returns the local OSV dump path.
+// Returns empty string if not configured (caller should download the public dump).
+func (s *Service) ReferenceOSVSource() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil {
+		return ""
+	}
+	return s.config.Reference.OSV.Source
+}
+
+// ReferenceOSVEcosystems returns the OSV ecosystems to download.
+// Returns nil if not configured (caller should use its default list).
+func (s *Service) ReferenceOSVEcosystems() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil || len(s.config.Reference.OSV.Ecosystems) == 0 {
+		return nil
+	}
+	result := make([]string, len(s.config.Reference.OSV.Ecosystems))
+	copy(result, s.config.Reference.OSV.Ecosystems)
+	return result
+}
+
+// ReferenceNVDSource returns the local NVD feed path.
+// Returns empty string if not configured (caller should download the public feeds).
+func (s *Service) ReferenceNVDSource() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil {
+		return ""
+	}
+	return s.config.Reference.NVD.Source
+}
+
+// ReferenceNVDStartYear returns the first NVD yearly feed to download.
+// Defaults to 2002 (the first NVD feed) if not configured.
+func (s *Service) ReferenceNVDStartYear() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil || s.config.Reference.NVD.StartYear <= 0 {
+		return 2002
+	}
+	return s.config.Reference.NVD.StartYear
+}
+
+// ReferenceThreatIntelFeeds returns the configured IP reputation feeds.
+// Returns nil if none are configured.
+func (s *Service) ReferenceThreatIntelFeeds() []ReferenceThreatIntelFeed {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil || len(s.config.Reference.ThreatIntel.Feeds) == 0 {
+		return nil
+	}
+	result := make([]ReferenceThreatIntelFeed, len(s.config.Reference.ThreatIntel.Feeds))
+	copy(result, s.config.Reference.ThreatIntel.Feeds)
+	return result
+}
+
 // RedisConfig returns the Redis configuration.
 // Returns nil if not configured.
 func (s *Service) RedisConfig() *RedisConfig {
@@ -23,5 +23,139 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("database.dbname is required")
 	}
 
+	if err := c.Admin.validate(); err != nil {
+		return err
+	}
+	if err := c.Notify.validate(); err != nil {
+		return err
+	}
+	if err := c.Kubernetes.validate(); err != nil {
+		return err
+	}
+	if err := c.AccessLog.validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validate checks admin auth settings that would otherwise fail open or
+// lock everyone out at request time.
+func (a *AdminConfig) validate() error {
+	roles := map[string]bool{}
+	for i, r := range a.Roles {
+		if r.Name == "" {
+			return fmt.Errorf("admin.roles[%d].name is required", i)
+		}
+		if roles[r.Name] {
+			return fmt.Errorf("admin.roles: duplicate role %q", r.Name)
+		}
+		roles[r.Name] = true
+	}
+	checkRoles := func(field string, names []string) error {
+		for _, n := range names {
+			if !roles[n] {
+				return fmt.Errorf("%s: unknown role %q", field, n)
+			}
+		}
+		return nil
+	}
+
+	for i, t := range a.Auth.Tokens {
+		if t.Name == "" || t.Token == "" {
+			return fmt.Errorf("admin.auth.tokens[%d]: name and token are required", i)
+		}
+		if err := checkRoles(fmt.Sprintf("admin.auth.tokens[%d].roles", i), t.Roles); err != nil {
+			return err
+		}
+	}
+	if a.Auth.OIDC.Issuer == "" && (a.Auth.OIDC.JWKSURL != "" || a.Auth.OIDC.JWKSFile != "") {
+		return fmt.Errorf("admin.auth.oidc.issuer is required")
+	}
+	if a.Auth.Proxy.UserHeader != "" && len(a.Auth.Proxy.TrustedCIDRs) == 0 {
+		return fmt.Errorf("admin.auth.proxy.trusted_cidrs is required with user_header")
+	}
+	return nil
+}
+
+// validate checks notification destinations so a typo fails the reload
+// instead of silently dropping alerts.
+func (n *NotifyConfig) validate() error {
+	names := map[string]bool{}
+	for i, d := range n.Destinations {
+		if d.Name == "" {
+			return fmt.Errorf("notify.destinations[%d].name is required", i)
+		}
+		if names[d.Name] {
+			return fmt.Errorf("notify.destinations: duplicate name %q", d.Name)
+		}
+		names[d.Name] = true
+
+		switch d.Type {
+		case "webhook", "slack", "teams":
+			if d.URL == "" {
+				return fmt.Errorf("notify.destinations[%s].url is required", d.Name)
+			}
+		case "smtp":
+			if d.SMTP.Host == "" || d.SMTP.From == "" || len(d.SMTP.To) == 0 {
+				return fmt.Errorf("notify.destinations[%s].smtp: host, from and to are required", d.Name)
+			}
+		default:
+			return fmt.Errorf("notify.destinations[%s].type %q is not one of webhook, slack, teams, smtp", d.Name, d.Type)
+		}
+	}
+	return nil
+}
+
+// validate checks that every cluster has a unique name and exactly one
+// credential source.
+func (k *KubernetesConfig) validate() error {
+	names := map[string]bool{}
+	for i, c := range k.Clusters {
+		if c.Name == "" {
+			return fmt.Errorf("kubernetes.clusters[%d].name is required", i)
+		}
+		if names[c.Name] {
+			return fmt.Errorf("kubernetes.clusters: duplicate cluster %q", c.Name)
+		}
+		names[c.Name] = true
+
+		sources := 0
+		if c.Kubeconfig != "" {
+			sources++
+		}
+		if c.GKE != nil {
+			sources++
+			if c.GKE.Project == "" || c.GKE.Location == "" || c.GKE.Cluster == "" {
+				return fmt.Errorf("kubernetes.clusters[%d].gke: project, location and cluster are required", i)
+			}
+		}
+		if c.DOKSClusterID != "" {
+			sources++
+		}
+		if sources != 1 {
+			return fmt.Errorf("kubernetes.clusters[%d]: exactly one of kubeconfig, gke or doks_cluster_id is required", i)
+		}
+	}
+	return nil
+}
+
+// validate checks that push sources can be reached and cannot be written
+// to without a token.
+func (a *AccessLogConfig) validate() error {
+	for i, src := range a.Sources {
+		if src.Type != "httppush" {
+			continue
+		}
+		if src.Token == "" {
+			return fmt.Errorf("accesslog.sources[%d].token is required for httppush sources", i)
+		}
+		if a.Receiver.Addr == "" {
+			return fmt.Errorf("accesslog.receiver.addr is required for httppush sources")
+		}
+		if a.Receiver.Host == "" {
+			return fmt.Errorf("accesslog.receiver.host is required for httppush sources")
+		}
+	}
 	return nil
 }
@@ -94,6 +94,62 @@ func TestConfig_Validate(t *testing.T) {
 			},
 			wantErr: "database.dbname is required",
 		},
+		{
+			name: "admin token with unknown role",
+			config: Config{
+				Temporal: TemporalConfig{HostPort: "localhost:7233"},
+				Database: DatabaseConfig{Host: "localhost", Port: 5432, User: "user", DBName: "hotpot"},
+				Admin: AdminConfig{
+					Roles: []AdminRoleConfig{{Name: "viewer", Allow: []string{"*"}}},
+					Auth:  AdminAuthConfig{Tokens: []AdminTokenConfig{{Name: "ci", Token: "x", Roles: []string{"admin"}}}},
+				},
+			},
+			wantErr: `admin.auth.tokens[0].roles: unknown role "admin"`,
+		},
+		{
+			name: "admin proxy without trusted cidrs",
+			config: Config{
+				Temporal: TemporalConfig{HostPort: "localhost:7233"},
+				Database: DatabaseConfig{Host: "localhost", Port: 5432, User: "user", DBName: "hotpot"},
+				Admin:    AdminConfig{Auth: AdminAuthConfig{Proxy: AdminProxyConfig{UserHeader: "X-Forwarded-Email"}}},
+			},
+			wantErr: "admin.auth.proxy.trusted_cidrs is required with user_header",
+		},
+		{
+			name: "kubernetes cluster with two credential sources",
+			config: Config{
+				Temporal: TemporalConfig{HostPort: "localhost:7233"},
+				Database: DatabaseConfig{Host: "localhost", Port: 5432, User: "user", DBName: "hotpot"},
+				Kubernetes: KubernetesConfig{Clusters: []KubernetesCluster{
+					{Name: "prod", Kubeconfig: "/etc/kube/prod", DOKSClusterID: "abc"},
+				}},
+			},
+			wantErr: "kubernetes.clusters[0]: exactly one of kubeconfig, gke or doks_cluster_id is required",
+		},
+		{
+			name: "httppush source without token",
+			config: Config{
+				Temporal: TemporalConfig{HostPort: "localhost:7233"},
+				Database: DatabaseConfig{Host: "localhost", Port: 5432, User: "user", DBName: "hotpot"},
+				AccessLog: AccessLogConfig{
+					Receiver: AccessLogReceiverConfig{Addr: ":8088"},
+					Sources:  []AccessLogSourceConfig{{Type: "httppush", Name: "kong"}},
+				},
+			},
+			wantErr: "accesslog.sources[0].token is required for httppush sources",
+		},
+		{
+			name: "httppush source without receiver host",
+			config: Config{
+				Temporal: TemporalConfig{HostPort: "localhost:7233"},
+				Database: DatabaseConfig{Host: "localhost", Port: 5432, User: "user", DBName: "hotpot"},
+				AccessLog: AccessLogConfig{
+					Receiver: AccessLogReceiverConfig{Addr: ":8088"},
+					Sources:  []AccessLogSourceConfig{{Type: "httppush", Name: "kong", Token: "secret"}},
+				},
+			},
+			wantErr: "accesslog.receiver.host is required for httppush sources",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,56 @@
+// Package imageref parses container image references. The Kubernetes image
+// ingest and the image inventory normalize both use it, so image keys built
+// on either side agree.
+package imageref
+
+import "strings"
+
+const (
+	defaultRegistry = "docker.io"
+	defaultTag      = "latest"
+)
+
+// Reference is a parsed container image reference.
+type Reference struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+// Parse parses an image reference such as "nginx:1.27",
+// "ghcr.io/org/app@sha256:..." or "registry:5000/app:v1". Docker Hub
+// references are expanded to docker.io and library/, and a reference with
+// neither tag nor digest gets the latest tag.
+func Parse(ref string) Reference {
+	var out Reference
+
+	if i := strings.IndexByte(ref, '@'); i >= 0 {
+		out.Digest = ref[i+1:]
+		ref = ref[:i]
+	}
+
+	// A colon after the last slash separates the tag; one before it belongs
+	// to a registry port.
+	if i := strings.LastIndexByte(ref, ':'); i > strings.LastIndexByte(ref, '/') {
+		out.Tag = ref[i+1:]
+		ref = ref[:i]
+	}
+
+	first, rest, found := strings.Cut(ref, "/")
+	if found && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		out.Registry = first
+		out.Repository = rest
+	} else {
+		out.Registry = defaultRegistry
+		out.Repository = ref
+	}
+	if out.Registry == defaultRegistry && !strings.Contains(out.Repository, "/") {
+		out.Repository = "library/" + out.Repository
+	}
+
+	if out.Tag == "" && out.Digest == "" {
+		out.Tag = defaultTag
+	}
+	return out
+}
@@ -0,0 +1,23 @@
+package imageref
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		ref  string
+		want Reference
+	}{
+		{"nginx", Reference{Registry: "docker.io", Repository: "library/nginx", Tag: "latest"}},
+		{"nginx:1.27", Reference{Registry: "docker.io", Repository: "library/nginx", Tag: "1.27"}},
+		{"bitnami/redis:7", Reference{Registry: "docker.io", Repository: "bitnami/redis", Tag: "7"}},
+		{"localhost/app", Reference{Registry: "localhost", Repository: "app", Tag: "latest"}},
+		{"registry:5000/team/app", Reference{Registry: "registry:5000", Repository: "team/app", Tag: "latest"}},
+		{"ghcr.io/org/app:v1@sha256:abc", Reference{Registry: "ghcr.io", Repository: "org/app", Tag: "v1", Digest: "sha256:abc"}},
+		{"gcr.io/p/app@sha256:def", Reference{Registry: "gcr.io", Repository: "p/app", Digest: "sha256:def"}},
+	}
+	for _, tt := range tests {
+		if got := Parse(tt.ref); got != tt.want {
+			t.Errorf("Parse(%q) = %+v, want %+v", tt.ref, got, tt.want)
+		}
+	}
+}
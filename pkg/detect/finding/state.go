@@ -0,0 +1,100 @@
+// Package finding implements the shared triage lifecycle for gold finding
+// tables that use the goldmixin.FindingState schema mixin.
+//
+//	open ──► acknowledged ──► resolved (no longer detected)
+//	  │            │               │
+//	  ├──► suppressed (until T)    └──► open (re-detected)
+//	  └──► false_positive
+//
+// Detectors only insert new findings and advance last_seen_at. Status,
+// assignee, note and suppressed_until belong to analysts and survive
+// re-detection; the only automatic transitions are resolve-on-absence and
+// reopen-on-return.
+package finding
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// Finding statuses.
+const (
+	StatusOpen          = "open"
+	StatusAcknowledged  = "acknowledged"
+	StatusSuppressed    = "suppressed"
+	StatusFalsePositive = "false_positive"
+	StatusResolved      = "resolved"
+)
+
+// ResolvedRetention is how long resolved findings are kept before
+// PurgeResolved deletes them. A finding that returns within it is reopened
+// with its triage history.
+const ResolvedRetention = 90 * 24 * time.Hour
+
+// Statuses lists every valid status in lifecycle order.
+var Statuses = []string{StatusOpen, StatusAcknowledged, StatusSuppressed, StatusFalsePositive, StatusResolved}
+
+// ValidStatus reports whether s is a known status.
+func ValidStatus(s string) bool {
+	for _, v := range Statuses {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ObservedSet returns the SET list that records a re-detection of an existing
+// finding aliased as "t". seenAt is the SQL expression for the observation
+// time, e.g. "EXCLUDED.last_seen_at" inside ON CONFLICT DO UPDATE or "$2" in
+// a plain UPDATE. It:
+//   - advances last_seen_at;
+//   - reopens resolved findings and clears resolved_at;
+//   - reopens suppressed findings whose suppressed_until has passed;
+//   - leaves every other status, assignee and note untouched.
+func ObservedSet(seenAt string) string {
+	return fmt.Sprintf(`last_seen_at = %[1]s,
+		status = CASE
+			WHEN t.status = 'resolved' THEN 'open'
+			WHEN t.status = 'suppressed' AND t.suppressed_until IS NOT NULL
+			     AND t.suppressed_until <= %[1]s THEN 'open'
+			ELSE t.status
+		END,
+		resolved_at = CASE WHEN t.status = 'resolved' THEN NULL ELSE t.resolved_at END`, seenAt)
+}
+
+// ResolveStale marks open and acknowledged findings in table that were not
+// seen since runTimestamp as resolved. Suppressed and false-positive findings
+// keep their analyst-set status. where is an optional extra condition whose
+// placeholders start at $2; args are bound to them.
+func ResolveStale(ctx context.Context, db *sql.DB, table string, runTimestamp time.Time, where string, args ...any) (int, error) {
+	query := fmt.Sprintf(`UPDATE %s
+		SET status = 'resolved', resolved_at = $1
+		WHERE last_seen_at < $1
+		  AND status IN ('open', 'acknowledged')`, table)
+	if where != "" {
+		query += " AND (" + where + ")"
+	}
+
+	result, err := db.ExecContext(ctx, query, append([]any{runTimestamp}, args...)...)
+	if err != nil {
+		return 0, fmt.Errorf("resolve stale findings in %s: %w", table, err)
+	}
+	n, _ := result.RowsAffected()
+	return int(n), nil
+}
+
+// PurgeResolved deletes findings in table that have been resolved for longer
+// than ResolvedRetention, so resolve-instead-of-delete does not grow the
+// table without bound. Suppressed and false-positive findings are kept.
+func PurgeResolved(ctx context.Context, db *sql.DB, table string, now time.Time) (int, error) {
+	result, err := db.ExecContext(ctx, fmt.Sprintf(`DELETE FROM %s
+		WHERE status = 'resolved' AND resolved_at < $1`, table), now.Add(-ResolvedRetention))
+	if err != nil {
+		return 0, fmt.Errorf("purge resolved findings in %s: %w", table, err)
+	}
+	n, _ := result.RowsAffected()
+	return int(n), nil
+}
@@ -6,12 +6,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"go.temporal.io/sdk/activity"
 
 	"danny.vn/hotpot/pkg/base/config"
 	"danny.vn/hotpot/pkg/base/matchrule"
+	"danny.vn/hotpot/pkg/detect/finding"
 	enthttpmonitor "danny.vn/hotpot/pkg/storage/ent/httpmonitor"
 )
 
@@ -61,6 +63,7 @@ var (
 	DetectASNAnomaliesActivity         = (*Activities).DetectASNAnomalies
 	DetectNewEndpointsActivity         = (*Activities).DetectNewEndpoints
 	DetectAuthAnomaliesActivity        = (*Activities).DetectAuthAnomalies
+	DetectThreatIntelMatchesActivity   = (*Activities).DetectThreatIntelMatches
 	CleanupStaleActivity               = (*Activities).CleanupStale
 )
 
@@ -190,8 +193,8 @@ func (a *Activities) DetectRateAnomalies(ctx context.Context) (*DetectRateAnomal
 			continue
 		}
 
-		resourceID := fmt.Sprintf("rate:%s:%s:%s:%s:%s",
-			e.sourceID, windowStart.Format(time.RFC3339), e.uri, e.method, anomalyType)
+		resourceID := fmt.Sprintf("rate:%s:%s:%s:%s",
+			e.sourceID, e.uri, e.method, anomalyType)
 
 		a.createAnomaly(ctx, resourceID, e.endpointID, e.sourceID, anomalyType, severity,
 			windowStart, windowEnd, e.uri, e.method, bl.avg, float64(e.count), z,
@@ -266,8 +269,8 @@ func (a *Activities) DetectRateAnomalies(ctx context.Context) (*DetectRateAnomal
 		}
 		z := (float64(e.totalBytes) - bl.avg) / bl.stddev
 		if z > zResponseSize {
-			resourceID := fmt.Sprintf("respsize:%s:%s:%s:%s",
-				e.sourceID, windowStart.Format(time.RFC3339), e.uri, e.method)
+			resourceID := fmt.Sprintf("respsize:%s:%s:%s",
+				e.sourceID, e.uri, e.method)
 			a.createAnomaly(ctx, resourceID, e.endpointID, e.sourceID, "response_size_anomaly", "high",
 				windowStart, windowEnd, e.uri, e.method, bl.avg, float64(e.totalBytes), z,
 				fmt.Sprintf("Response body bytes z-score=%.1f, baseline=%.0f, actual=%d", z, bl.avg, e.totalBytes),
@@ -290,8 +293,8 @@ func (a *Activities) DetectRateAnomalies(ctx context.Context) (*DetectRateAnomal
 				continue
 			}
 			if float64(e.count) > bl.avg*offHoursMultiplier {
-				resourceID := fmt.Sprintf("offhours:%s:%s:%s:%s",
-					e.sourceID, windowStart.Format(time.RFC3339), e.uri, e.method)
+				resourceID := fmt.Sprintf("offhours:%s:%s:%s",
+					e.sourceID, e.uri, e.method)
 				ratio := float64(e.count) / bl.avg
 				a.createAnomaly(ctx, resourceID, e.endpointID, e.sourceID, "off_hours_spike", "medium",
 					windowStart, windowEnd, e.uri, e.method, bl.avg, float64(e.count), ratio,
@@ -326,8 +329,8 @@ func (a *Activities) DetectRateAnomalies(ctx context.Context) (*DetectRateAnomal
 		if err := bulkRows.Scan(&sourceID, &endpointID, &uri, &method, &bodyBytes); err != nil {
 			return nil, fmt.Errorf("scan bulk data extraction: %w", err)
 		}
-		resourceID := fmt.Sprintf("bulkdata:%s:%s:%s:%s",
-			sourceID, windowStart.Format(time.RFC3339), uri, method)
+		resourceID := fmt.Sprintf("bulkdata:%s:%s:%s",
+			sourceID, uri, method)
 		mb := float64(bodyBytes) / (1024 * 1024)
 		evidence, _ := json.Marshal(map[string]any{
 			"uri": uri, "method": method, "bytes": bodyBytes, "mb": mb,
@@ -411,16 +414,16 @@ func (a *Activities) DetectErrorBursts(ctx context.Context) (*DetectErrorBurstsR
 		fiveXXRate := float64(serverErrors) / float64(total)
 
 		if fiveXXRate > fiveXXRateMin && serverErrors > fiveXXCountMin {
-			resourceID := fmt.Sprintf("5xx:%s:%s:%s:%s",
-				sourceID, windowStart.Format(time.RFC3339), uri, method)
+			resourceID := fmt.Sprintf("5xx:%s:%s:%s",
+				sourceID, uri, method)
 			a.createAnomaly(ctx, resourceID, endpointID, sourceID, "5xx_burst", "high",
 				windowStart, windowEnd, uri, method, 0, fiveXXRate, 0,
 				fmt.Sprintf("5xx rate=%.1f%%, count=%d/%d", fiveXXRate*100, serverErrors, total),
 				detectedAt, nil)
 			fiveXXBursts++
 		} else if errorRate > errorRateMin && errors > errorCountMin {
-			resourceID := fmt.Sprintf("err:%s:%s:%s:%s",
-				sourceID, windowStart.Format(time.RFC3339), uri, method)
+			resourceID := fmt.Sprintf("err:%s:%s:%s",
+				sourceID, uri, method)
 			a.createAnomaly(ctx, resourceID, endpointID, sourceID, "error_burst", "medium",
 				windowStart, windowEnd, uri, method, 0, errorRate, 0,
 				fmt.Sprintf("error rate=%.1f%%, count=%d/%d", errorRate*100, errors, total),
@@ -485,8 +488,8 @@ func (a *Activities) DetectSuspiciousPatterns(ctx context.Context) (*DetectSuspi
 		if err := scannerRows.Scan(&sourceID, &uri, &method, &reqCount); err != nil {
 			return nil, fmt.Errorf("scan scanner: %w", err)
 		}
-		resourceID := fmt.Sprintf("scanner:%s:%s:%s:%s",
-			sourceID, windowStart.Format(time.RFC3339), uri, method)
+		resourceID := fmt.Sprintf("scanner:%s:%s:%s",
+			sourceID, uri, method)
 		a.createAnomaly(ctx, resourceID, "", sourceID, "scanner_detected", "medium",
 			windowStart, windowEnd, uri, method, 0, float64(reqCount), 0,
 			fmt.Sprintf("scanner UA detected, %d requests", reqCount),
@@ -516,8 +519,8 @@ func (a *Activities) DetectSuspiciousPatterns(ctx context.Context) (*DetectSuspi
 		if err := floodRows.Scan(&sourceID, &uri, &method, &reqCount); err != nil {
 			return nil, fmt.Errorf("scan flood: %w", err)
 		}
-		resourceID := fmt.Sprintf("ipflood:%s:%s:%s:%s",
-			sourceID, windowStart.Format(time.RFC3339), uri, method)
+		resourceID := fmt.Sprintf("ipflood:%s:%s:%s",
+			sourceID, uri, method)
 		a.createAnomaly(ctx, resourceID, "", sourceID, "single_ip_flood", "medium",
 			windowStart, windowEnd, uri, method, 0, float64(reqCount), 0,
 			fmt.Sprintf("single IP sent %d requests", reqCount),
@@ -554,8 +557,8 @@ func (a *Activities) DetectSuspiciousPatterns(ctx context.Context) (*DetectSuspi
 		if err := enumRows.Scan(&sourceID, &clientIP, &uriCount); err != nil {
 			return nil, fmt.Errorf("scan endpoint enumeration: %w", err)
 		}
-		resourceID := fmt.Sprintf("enum:%s:%s:%s",
-			sourceID, windowStart.Format(time.RFC3339), clientIP)
+		resourceID := fmt.Sprintf("enum:%s:%s",
+			sourceID, clientIP)
 		evidence, _ := json.Marshal(map[string]any{"ip": clientIP, "uri_count": uriCount})
 		a.createAnomaly(ctx, resourceID, "", sourceID, "endpoint_enumeration", "high",
 			windowStart, windowEnd, "", "", 0, float64(uriCount), 0,
@@ -603,8 +606,8 @@ func (a *Activities) DetectSuspiciousPatterns(ctx context.Context) (*DetectSuspi
 				atkRows.Close()
 				return nil, fmt.Errorf("scan %s: %w", atk.prefix, err)
 			}
-			resourceID := fmt.Sprintf("%s:%s:%s:%s:%s",
-				atk.prefix, sourceID, windowStart.Format(time.RFC3339), uri, method)
+			resourceID := fmt.Sprintf("%s:%s:%s:%s",
+				atk.prefix, sourceID, uri, method)
 			a.createAnomaly(ctx, resourceID, "", sourceID, atk.anomalyType, atk.severity,
 				windowStart, windowEnd, uri, method, 0, float64(reqCount), 0,
 				fmt.Sprintf("%s detected, %d requests", atk.label, reqCount),
@@ -644,8 +647,8 @@ func (a *Activities) DetectSuspiciousPatterns(ctx context.Context) (*DetectSuspi
 		if err := pagRows.Scan(&sourceID, &clientIP, &basePath, &uriCount, &reqCount); err != nil {
 			return nil, fmt.Errorf("scan pagination scraping: %w", err)
 		}
-		resourceID := fmt.Sprintf("pagscrape:%s:%s:%s:%s",
-			sourceID, windowStart.Format(time.RFC3339), clientIP, basePath)
+		resourceID := fmt.Sprintf("pagscrape:%s:%s:%s",
+			sourceID, clientIP, basePath)
 		evidence, _ := json.Marshal(map[string]any{
 			"ip": clientIP, "base_path": basePath,
 			"distinct_uris": uriCount, "total_requests": reqCount,
@@ -730,8 +733,8 @@ func (a *Activities) DetectMethodMismatch(ctx context.Context) (*DetectMethodMis
 			severity = "high"
 		}
 
-		resourceID := fmt.Sprintf("method:%s:%s:%s:%s",
-			sourceID, windowStart.Format(time.RFC3339), uri, method)
+		resourceID := fmt.Sprintf("method:%s:%s:%s",
+			sourceID, uri, method)
 		a.createAnomaly(ctx, resourceID, endpointID, sourceID, "method_mismatch", severity,
 			windowStart, windowEnd, uri, method, 0, float64(totalCount), 0,
 			fmt.Sprintf("%s on endpoint that does not allow it, %d requests", method, totalCount),
@@ -794,8 +797,8 @@ func (a *Activities) DetectNewEndpoints(ctx context.Context) (*DetectNewEndpoint
 			return nil, fmt.Errorf("scan unmapped: %w", err)
 		}
 
-		resourceID := fmt.Sprintf("newep:%s:%s:%s:%s",
-			sourceID, windowEnd.Format(time.RFC3339), uri, method)
+		resourceID := fmt.Sprintf("newep:%s:%s:%s",
+			sourceID, uri, method)
 		a.createAnomaly(ctx, resourceID, "", sourceID, "new_endpoint", "info",
 			lookback, windowEnd, uri, method, 0, float64(totalCount), 0,
 			fmt.Sprintf("unmapped URI with %d requests in 1h", totalCount),
@@ -956,7 +959,7 @@ func (a *Activities) DetectUserAgentAnomalies(ctx context.Context) (*DetectUserA
 			// new_user_agent: family not in baseline.
 			if _, inBaseline := baseline[family]; !inBaseline {
 				if currentShare > newUAShareHigh {
-					resourceID := fmt.Sprintf("newua:%s:%s:%s", ep.endpointID, windowStart.Format(time.RFC3339), family)
+					resourceID := fmt.Sprintf("newua:%s:%s", ep.endpointID, family)
 					evidence, _ := json.Marshal(map[string]any{"ua_family": family, "share": currentShare, "count": count})
 					a.createAnomaly(ctx, resourceID, ep.endpointID, "", "new_user_agent", "high",
 						windowStart, windowEnd, ep.uri, "", 0, currentShare, 0,
@@ -964,7 +967,7 @@ func (a *Activities) DetectUserAgentAnomalies(ctx context.Context) (*DetectUserA
 						detectedAt, evidence)
 					result.NewUA++
 				} else if currentShare > newUAShareWarning {
-					resourceID := fmt.Sprintf("newua:%s:%s:%s", ep.endpointID, windowStart.Format(time.RFC3339), family)
+					resourceID := fmt.Sprintf("newua:%s:%s", ep.endpointID, family)
 					evidence, _ := json.Marshal(map[string]any{"ua_family": family, "share": currentShare, "count": count})
 					a.createAnomaly(ctx, resourceID, ep.endpointID, "", "new_user_agent", "low",
 						windowStart, windowEnd, ep.uri, "", 0, currentShare, 0,
@@ -979,7 +982,7 @@ func (a *Activities) DetectUserAgentAnomalies(ctx context.Context) (*DetectUserA
 				baselineShare := float64(baseline[family]) / float64(baselineTotal)
 				delta := currentShare - baselineShare
 				if delta > uaShareShiftDelta || delta < -uaShareShiftDelta {
-					resourceID := fmt.Sprintf("uashift:%s:%s:%s", ep.endpointID, windowStart.Format(time.RFC3339), family)
+					resourceID := fmt.Sprintf("uashift:%s:%s", ep.endpointID, family)
 					a.createAnomaly(ctx, resourceID, ep.endpointID, "", "ua_share_shift", "medium",
 						windowStart, windowEnd, ep.uri, "", baselineShare, currentShare, delta,
 						fmt.Sprintf("UA family '%s' share changed from %.0f%% to %.0f%%", family, baselineShare*100, currentShare*100),
@@ -991,7 +994,7 @@ func (a *Activities) DetectUserAgentAnomalies(ctx context.Context) (*DetectUserA
 			// automated_client: library UA on protected endpoint.
 			if matchRules.IsLibraryUA(family) && ep.endpointID != "" {
 				if accessLevels[ep.endpointID] == "protected" {
-					resourceID := fmt.Sprintf("autoua:%s:%s:%s", ep.endpointID, windowStart.Format(time.RFC3339), family)
+					resourceID := fmt.Sprintf("autoua:%s:%s", ep.endpointID, family)
 					a.createAnomaly(ctx, resourceID, ep.endpointID, "", "automated_client", "medium",
 						windowStart, windowEnd, ep.uri, "", 0, float64(count), 0,
 						fmt.Sprintf("Library UA '%s' on protected endpoint %s", family, ep.uri),
@@ -1056,8 +1059,8 @@ func (a *Activities) DetectUserAgentAnomalies(ctx context.Context) (*DetectUserA
 			return nil, fmt.Errorf("query static assets for %s: %w", sc.clientIP, err)
 		}
 		if staticCount == 0 {
-			resourceID := fmt.Sprintf("uaspoof:%s:%s:%s:%s",
-				sc.sourceID, windowStart.Format(time.RFC3339), sc.clientIP, sc.uaFamily)
+			resourceID := fmt.Sprintf("uaspoof:%s:%s:%s",
+				sc.sourceID, sc.clientIP, sc.uaFamily)
 			evidence, _ := json.Marshal(map[string]any{
 				"ip": sc.clientIP, "ua_family": sc.uaFamily,
 				"request_count": sc.reqCount, "static_assets": 0,
@@ -1127,8 +1130,8 @@ func (a *Activities) DetectClientIPAnomalies(ctx context.Context) (*DetectClient
 		if err := sanctionedRows.Scan(&sourceID, &clientIP, &countryCode, &countryName, &reqCount); err != nil {
 			return nil, fmt.Errorf("scan sanctioned: %w", err)
 		}
-		resourceID := fmt.Sprintf("sanctioned:%s:%s:%s:%s",
-			sourceID, windowStart.Format(time.RFC3339), clientIP, countryCode)
+		resourceID := fmt.Sprintf("sanctioned:%s:%s:%s",
+			sourceID, clientIP, countryCode)
 		evidence, _ := json.Marshal(map[string]any{
 			"ip": clientIP, "country_code": countryCode, "country_name": countryName, "count": reqCount,
 		})
@@ -1253,7 +1256,7 @@ func (a *Activities) DetectClientIPAnomalies(ctx context.Context) (*DetectClient
 			// new_source_ip: IP not in 7d baseline.
 			if !baselineIPs[e.ip] {
 				if share > newIPShareHigh {
-					resourceID := fmt.Sprintf("newip:%s:%s:%s", ep.endpointID, windowStart.Format(time.RFC3339), e.ip)
+					resourceID := fmt.Sprintf("newip:%s:%s", ep.endpointID, e.ip)
 					evidence, _ := json.Marshal(map[string]any{"ip": e.ip, "country": e.country, "share": share})
 					a.createAnomaly(ctx, resourceID, ep.endpointID, "", "new_source_ip", "high",
 						windowStart, windowEnd, ep.uri, "", 0, share, 0,
@@ -1261,7 +1264,7 @@ func (a *Activities) DetectClientIPAnomalies(ctx context.Context) (*DetectClient
 						detectedAt, evidence)
 					result.NewSourceIP++
 				} else if share > newIPShareWarning {
-					resourceID := fmt.Sprintf("newip:%s:%s:%s", ep.endpointID, windowStart.Format(time.RFC3339), e.ip)
+					resourceID := fmt.Sprintf("newip:%s:%s", ep.endpointID, e.ip)
 					evidence, _ := json.Marshal(map[string]any{"ip": e.ip, "country": e.country, "share": share})
 					a.createAnomaly(ctx, resourceID, ep.endpointID, "", "new_source_ip", "low",
 						windowStart, windowEnd, ep.uri, "", 0, share, 0,
@@ -1273,7 +1276,7 @@ func (a *Activities) DetectClientIPAnomalies(ctx context.Context) (*DetectClient
 
 			// ip_concentration: single IP dominates traffic.
 			if share > ipConcentrationMin {
-				resourceID := fmt.Sprintf("ipconc:%s:%s:%s", ep.endpointID, windowStart.Format(time.RFC3339), e.ip)
+				resourceID := fmt.Sprintf("ipconc:%s:%s", ep.endpointID, e.ip)
 				a.createAnomaly(ctx, resourceID, ep.endpointID, "", "ip_concentration", "medium",
 					windowStart, windowEnd, ep.uri, "", 0, share, 0,
 					fmt.Sprintf("Single IP %s sent %.0f%% of traffic (%d/%d)", e.ip, share*100, e.count, currentTotal),
@@ -1298,7 +1301,7 @@ func (a *Activities) DetectClientIPAnomalies(ctx context.Context) (*DetectClient
 				if share > geoNewCountryHigh {
 					severity = "high"
 				}
-				resourceID := fmt.Sprintf("geo:%s:%s:%s", ep.endpointID, windowStart.Format(time.RFC3339), country)
+				resourceID := fmt.Sprintf("geo:%s:%s", ep.endpointID, country)
 				a.createAnomaly(ctx, resourceID, ep.endpointID, "", "geo_shift", severity,
 					windowStart, windowEnd, ep.uri, "", 0, share, 0,
 					fmt.Sprintf("New country %s appeared with %.0f%% share", country, share*100),
@@ -1308,7 +1311,7 @@ func (a *Activities) DetectClientIPAnomalies(ctx context.Context) (*DetectClient
 				baselineShare := float64(baselineCountry[country]) / float64(baselineTotal)
 				delta := share - baselineShare
 				if delta > geoShiftDelta || delta < -geoShiftDelta {
-					resourceID := fmt.Sprintf("geo:%s:%s:%s", ep.endpointID, windowStart.Format(time.RFC3339), country)
+					resourceID := fmt.Sprintf("geo:%s:%s", ep.endpointID, country)
 					a.createAnomaly(ctx, resourceID, ep.endpointID, "", "geo_shift", "medium",
 						windowStart, windowEnd, ep.uri, "", baselineShare, share, delta,
 						fmt.Sprintf("Country %s share changed from %.0f%% to %.0f%%", country, baselineShare*100, share*100),
@@ -1326,7 +1329,7 @@ func (a *Activities) DetectClientIPAnomalies(ctx context.Context) (*DetectClient
 				// Check if current has external traffic.
 				for _, e := range currentEntries {
 					if !e.isInternal {
-						resourceID := fmt.Sprintf("extint:%s:%s:%s", ep.endpointID, windowStart.Format(time.RFC3339), e.ip)
+						resourceID := fmt.Sprintf("extint:%s:%s", ep.endpointID, e.ip)
 						a.createAnomaly(ctx, resourceID, ep.endpointID, "", "external_on_internal", "high",
 							windowStart, windowEnd, ep.uri, "", baselineInternalPct, float64(e.count), 0,
 							fmt.Sprintf("External IP %s on endpoint with %.0f%% internal baseline", e.ip, baselineInternalPct*100),
@@ -1361,8 +1364,8 @@ func (a *Activities) DetectClientIPAnomalies(ctx context.Context) (*DetectClient
 		if err := rotationRows.Scan(&sourceID, &uri, &method, &subnet, &ipCount); err != nil {
 			return nil, fmt.Errorf("scan ip rotation: %w", err)
 		}
-		resourceID := fmt.Sprintf("iprotation:%s:%s:%s.0/24:%s:%s",
-			sourceID, windowStart.Format(time.RFC3339), subnet, uri, method)
+		resourceID := fmt.Sprintf("iprotation:%s:%s.0/24:%s:%s",
+			sourceID, subnet, uri, method)
 		evidence, _ := json.Marshal(map[string]any{
 			"subnet": subnet + ".0/24", "distinct_ips": ipCount, "uri": uri, "method": method,
 		})
@@ -1535,7 +1538,7 @@ func (a *Activities) DetectASNAnomalies(ctx context.Context) (*DetectASNAnomalie
 			// new_asn: ASN not in 7d baseline.
 			if _, inBaseline := baselineASN[e.asn]; !inBaseline {
 				if share > newASNShareHigh {
-					resourceID := fmt.Sprintf("newasn:%s:%s:%d", ep.endpointID, windowStart.Format(time.RFC3339), e.asn)
+					resourceID := fmt.Sprintf("newasn:%s:%d", ep.endpointID, e.asn)
 					evidence, _ := json.Marshal(map[string]any{"asn": e.asn, "org": e.orgName, "domain": e.asDomain, "share": share, "count": e.count})
 					a.createAnomaly(ctx, resourceID, ep.endpointID, "", "new_asn", "high",
 						windowStart, windowEnd, ep.uri, "", 0, share, 0,
@@ -1543,7 +1546,7 @@ func (a *Activities) DetectASNAnomalies(ctx context.Context) (*DetectASNAnomalie
 						detectedAt, evidence)
 					result.NewASN++
 				} else if share > newASNShareWarning {
-					resourceID := fmt.Sprintf("newasn:%s:%s:%d", ep.endpointID, windowStart.Format(time.RFC3339), e.asn)
+					resourceID := fmt.Sprintf("newasn:%s:%d", ep.endpointID, e.asn)
 					evidence, _ := json.Marshal(map[string]any{"asn": e.asn, "org": e.orgName, "domain": e.asDomain, "share": share})
 					a.createAnomaly(ctx, resourceID, ep.endpointID, "", "new_asn", "low",
 						windowStart, windowEnd, ep.uri, "", 0, share, 0,
@@ -1556,7 +1559,7 @@ func (a *Activities) DetectASNAnomalies(ctx context.Context) (*DetectASNAnomalie
 			// hosting_provider: traffic from hosting domain/type, high share, baseline was low.
 			if matchRules.IsHostingDomain(e.asDomain, e.asnType) {
 				if share > hostingShareMin && baselineHostingShare < hostingBaselineMax {
-					resourceID := fmt.Sprintf("hosting:%s:%s:%d", ep.endpointID, windowStart.Format(time.RFC3339), e.asn)
+					resourceID := fmt.Sprintf("hosting:%s:%d", ep.endpointID, e.asn)
 					evidence, _ := json.Marshal(map[string]any{"asn": e.asn, "org": e.orgName, "domain": e.asDomain, "share": share, "baseline_hosting_share": baselineHostingShare})
 					a.createAnomaly(ctx, resourceID, ep.endpointID, "", "hosting_provider", "medium",
 						windowStart, windowEnd, ep.uri, "", baselineHostingShare, share, 0,
@@ -1572,7 +1575,7 @@ func (a *Activities) DetectASNAnomalies(ctx context.Context) (*DetectASNAnomalie
 			if share > asnConcentrationMin && baselineTotal > 0 {
 				baselineShare := float64(baselineASN[e.asn]) / float64(baselineTotal)
 				if baselineShare < asnConcentrationBaselineMax {
-					resourceID := fmt.Sprintf("asnconc:%s:%s:%d", ep.endpointID, windowStart.Format(time.RFC3339), e.asn)
+					resourceID := fmt.Sprintf("asnconc:%s:%d", ep.endpointID, e.asn)
 					a.createAnomaly(ctx, resourceID, ep.endpointID, "", "asn_concentration", "medium",
 						windowStart, windowEnd, ep.uri, "", baselineShare, share, 0,
 						fmt.Sprintf("Single ASN %d (%s) sends %.0f%% of traffic", e.asn, e.orgName, share*100),
@@ -1666,7 +1669,7 @@ func (a *Activities) DetectAuthAnomalies(ctx context.Context) (*DetectAuthAnomal
 				rows.Close()
 				return nil, fmt.Errorf("scan auth failure burst: %w", err)
 			}
-			resourceID := fmt.Sprintf("authfail:%s:%s:%s", sourceID, windowStart.Format(time.RFC3339), clientIP)
+			resourceID := fmt.Sprintf("authfail:%s:%s", sourceID, clientIP)
 			evidence, _ := json.Marshal(map[string]any{"ip": clientIP, "fail_count": failCount})
 			a.createAnomaly(ctx, resourceID, "", sourceID, "auth_failure_burst", "critical",
 				windowStart, windowEnd, "", "", 0, float64(failCount), 0,
@@ -1705,7 +1708,7 @@ func (a *Activities) DetectAuthAnomalies(ctx context.Context) (*DetectAuthAnomal
 				rows.Close()
 				return nil, fmt.Errorf("scan credential stuffing: %w", err)
 			}
-			resourceID := fmt.Sprintf("credstuff:%s:%s:%s", sourceID, windowStart.Format(time.RFC3339), clientIP)
+			resourceID := fmt.Sprintf("credstuff:%s:%s", sourceID, clientIP)
 			evidence, _ := json.Marshal(map[string]any{"ip": clientIP, "distinct_uris": uriCount})
 			a.createAnomaly(ctx, resourceID, "", sourceID, "credential_stuffing", "critical",
 				windowStart, windowEnd, "", "", 0, float64(uriCount), 0,
@@ -1743,7 +1746,7 @@ func (a *Activities) DetectAuthAnomalies(ctx context.Context) (*DetectAuthAnomal
 				rows.Close()
 				return nil, fmt.Errorf("scan otp brute force: %w", err)
 			}
-			resourceID := fmt.Sprintf("otpbrute:%s:%s:%s", sourceID, windowStart.Format(time.RFC3339), clientIP)
+			resourceID := fmt.Sprintf("otpbrute:%s:%s", sourceID, clientIP)
 			evidence, _ := json.Marshal(map[string]any{"ip": clientIP, "count": reqCount})
 			a.createAnomaly(ctx, resourceID, "", sourceID, "otp_brute_force", "critical",
 				windowStart, windowEnd, "", "", 0, float64(reqCount), 0,
@@ -1780,7 +1783,7 @@ func (a *Activities) DetectAuthAnomalies(ctx context.Context) (*DetectAuthAnomal
 				rows.Close()
 				return nil, fmt.Errorf("scan privilege escalation: %w", err)
 			}
-			resourceID := fmt.Sprintf("privesc:%s:%s:%s:%s", sourceID, windowStart.Format(time.RFC3339), clientIP, uri)
+			resourceID := fmt.Sprintf("privesc:%s:%s:%s", sourceID, clientIP, uri)
 			evidence, _ := json.Marshal(map[string]any{"ip": clientIP, "uri": uri, "count": reqCount})
 			a.createAnomaly(ctx, resourceID, "", sourceID, "privilege_escalation_probe", "high",
 				windowStart, windowEnd, uri, "", 0, float64(reqCount), 0,
@@ -1818,7 +1821,7 @@ func (a *Activities) DetectAuthAnomalies(ctx context.Context) (*DetectAuthAnomal
 				rows.Close()
 				return nil, fmt.Errorf("scan password reset flood: %w", err)
 			}
-			resourceID := fmt.Sprintf("resetflood:%s:%s:%s", sourceID, windowStart.Format(time.RFC3339), clientIP)
+			resourceID := fmt.Sprintf("resetflood:%s:%s", sourceID, clientIP)
 			evidence, _ := json.Marshal(map[string]any{"ip": clientIP, "count": reqCount})
 			a.createAnomaly(ctx, resourceID, "", sourceID, "password_reset_flood", "high",
 				windowStart, windowEnd, "", "", 0, float64(reqCount), 0,
@@ -1856,7 +1859,7 @@ func (a *Activities) DetectAuthAnomalies(ctx context.Context) (*DetectAuthAnomal
 				rows.Close()
 				return nil, fmt.Errorf("scan registration abuse: %w", err)
 			}
-			resourceID := fmt.Sprintf("regabuse:%s:%s:%s", sourceID, windowStart.Format(time.RFC3339), clientIP)
+			resourceID := fmt.Sprintf("regabuse:%s:%s", sourceID, clientIP)
 			evidence, _ := json.Marshal(map[string]any{"ip": clientIP, "count": reqCount})
 			a.createAnomaly(ctx, resourceID, "", sourceID, "registration_abuse", "medium",
 				windowStart, windowEnd, "", "", 0, float64(reqCount), 0,
@@ -1893,7 +1896,7 @@ func (a *Activities) DetectAuthAnomalies(ctx context.Context) (*DetectAuthAnomal
 			rlRows.Close()
 			return nil, fmt.Errorf("scan rate limit triggered: %w", err)
 		}
-		resourceID := fmt.Sprintf("ratelimit:%s:%s:%s", sourceID, windowStart.Format(time.RFC3339), clientIP)
+		resourceID := fmt.Sprintf("ratelimit:%s:%s", sourceID, clientIP)
 		evidence, _ := json.Marshal(map[string]any{"ip": clientIP, "count_429": count429})
 		a.createAnomaly(ctx, resourceID, "", sourceID, "rate_limit_triggered", "medium",
 			windowStart, windowEnd, "", "", 0, float64(count429), 0,
@@ -1934,7 +1937,7 @@ func (a *Activities) DetectAuthAnomalies(ctx context.Context) (*DetectAuthAnomal
 				rows.Close()
 				return nil, fmt.Errorf("scan auth success after burst: %w", err)
 			}
-			resourceID := fmt.Sprintf("authsuccess:%s:%s:%s", sourceID, windowStart.Format(time.RFC3339), clientIP)
+			resourceID := fmt.Sprintf("authsuccess:%s:%s", sourceID, clientIP)
 			evidence, _ := json.Marshal(map[string]any{"ip": clientIP, "fails": fails, "successes": successes})
 			a.createAnomaly(ctx, resourceID, "", sourceID, "auth_success_after_burst", "critical",
 				windowStart, windowEnd, "", "", float64(fails), float64(successes), 0,
@@ -1961,31 +1964,139 @@ func (a *Activities) DetectAuthAnomalies(ctx context.Context) (*DetectAuthAnomal
 	return result, nil
 }
 
+// --- Activity 9: DetectThreatIntelMatches ---
+
+// DetectThreatIntelMatchesResult holds output.
+type DetectThreatIntelMatchesResult struct {
+	TorExitNode  int
+	KnownScanner int
+	Blocklisted  int
+}
+
+// threatIntelAnomalies maps a feed category to its anomaly type and severity.
+var threatIntelAnomalies = map[string]struct{ anomalyType, severity, label string }{
+	"tor":       {"tor_exit_node", "high", "Tor exit node"},
+	"scanner":   {"known_scanner_ip", "medium", "known scanner"},
+	"blocklist": {"blocklisted_ip", "high", "blocklisted IP"},
+}
+
+// DetectThreatIntelMatches raises one anomaly per source, client IP and feed
+// category for traffic from IPs tagged by threat intel feeds during
+// normalization.
+func (a *Activities) DetectThreatIntelMatches(ctx context.Context) (*DetectThreatIntelMatchesResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Detecting threat intel matches")
+
+	r, err := a.getRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load rules: %w", err)
+	}
+
+	now := time.Now()
+	windowEnd := now.Truncate(5 * time.Minute)
+	windowStart := windowEnd.Add(-5 * time.Minute)
+	detectedAt := time.Now()
+
+	result := &DetectThreatIntelMatchesResult{}
+
+	rows, err := a.db.QueryContext(ctx, `
+		SELECT source_id, client_ip, threat_feeds, threat_categories,
+			COALESCE(MAX(country_code), ''), SUM(request_count)
+		FROM silver.httptraffic_client_ip_5m
+		WHERE is_threat_listed = true
+			AND window_start >= $1 AND window_start < $2
+		GROUP BY source_id, client_ip, threat_feeds, threat_categories`, windowStart, windowEnd)
+	if err != nil {
+		return nil, fmt.Errorf("query threat listed IPs: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var sourceID, clientIP, countryCode string
+		var feedsJSON, categoriesJSON []byte
+		var reqCount int64
+		if err := rows.Scan(&sourceID, &clientIP, &feedsJSON, &categoriesJSON, &countryCode, &reqCount); err != nil {
+			return nil, fmt.Errorf("scan threat listed IP: %w", err)
+		}
+		var feeds, categories []string
+		_ = json.Unmarshal(feedsJSON, &feeds)
+		_ = json.Unmarshal(categoriesJSON, &categories)
+
+		for _, category := range categories {
+			t, ok := threatIntelAnomalies[category]
+			if !ok || reqCount < r.ThresholdInt(t.anomalyType, "min_requests", 1) {
+				continue
+			}
+			resourceID := fmt.Sprintf("threatintel:%s:%s:%s",
+				t.anomalyType, sourceID, clientIP)
+			evidence, _ := json.Marshal(map[string]any{
+				"ip": clientIP, "feeds": feeds, "category": category,
+				"country_code": countryCode, "count": reqCount,
+			})
+			desc := fmt.Sprintf("Traffic from %s %s (feeds: %s), %d requests",
+				t.label, clientIP, strings.Join(feeds, ", "), reqCount)
+			a.createAnomaly(ctx, resourceID, "", sourceID, t.anomalyType, t.severity,
+				windowStart, windowEnd, "", "", 0, float64(reqCount), 0, desc,
+				detectedAt, evidence)
+
+			switch category {
+			case "tor":
+				result.TorExitNode++
+			case "scanner":
+				result.KnownScanner++
+			case "blocklist":
+				result.Blocklisted++
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate threat listed IP rows: %w", err)
+	}
+
+	logger.Info("Threat intel detection complete",
+		"torExitNode", result.TorExitNode,
+		"knownScanner", result.KnownScanner,
+		"blocklisted", result.Blocklisted)
+	return result, nil
+}
+
 // --- Activity: CleanupStale ---
 
 // CleanupStaleResult holds cleanup statistics.
 type CleanupStaleResult struct {
+	AnomaliesResolved    int
 	AnomaliesDeleted     int
 	SilverTrafficDeleted int
 	SilverUADeleted      int
 	SilverIPDeleted      int
 }
 
-// CleanupStale removes old anomalies and silver traffic data beyond retention period.
+// anomalyResolveAfter is how long an anomaly goes undetected before it is
+// resolved. Detectors only see the windows they evaluate, so one quiet run
+// does not mean the anomaly has ended.
+const anomalyResolveAfter = time.Hour
+
+// CleanupStale resolves anomalies no longer detected, purges long-resolved
+// ones and removes silver traffic data beyond the retention period.
 func (a *Activities) CleanupStale(ctx context.Context) (*CleanupStaleResult, error) {
 	logger := activity.GetLogger(ctx)
 	logger.Info("Cleaning up stale data")
 
+	now := time.Now()
 	retentionDays := a.configService.AccessLogRetentionDays()
-	cutoff := time.Now().Add(-time.Duration(retentionDays) * 24 * time.Hour)
+	cutoff := now.Add(-time.Duration(retentionDays) * 24 * time.Hour)
 
-	// Delete old anomalies.
-	anomalyResult, err := a.db.ExecContext(ctx,
-		`DELETE FROM gold.httpmonitor_anomalies WHERE window_start < $1`, cutoff)
+	// Resolve anomalies instead of deleting them, so triage survives a
+	// recurrence; only long-resolved ones are deleted.
+	anomaliesResolved, err := finding.ResolveStale(ctx, a.db, "gold.httpmonitor_anomalies",
+		now, "last_seen_at < $2", now.Add(-anomalyResolveAfter))
+	if err != nil {
+		return nil, err
+	}
+	anomaliesDeleted, err := finding.PurgeResolved(ctx, a.db, "gold.httpmonitor_anomalies", now)
 	if err != nil {
-		return nil, fmt.Errorf("delete old anomalies: %w", err)
+		return nil, err
 	}
-	anomaliesDeleted, _ := anomalyResult.RowsAffected()
 
 	// Delete old silver traffic data.
 	silverResult, err := a.db.ExecContext(ctx,
@@ -2012,13 +2123,15 @@ func (a *Activities) CleanupStale(ctx context.Context) (*CleanupStaleResult, err
 	ipDeleted, _ := ipResult.RowsAffected()
 
 	logger.Info("Cleanup complete",
+		"anomaliesResolved", anomaliesResolved,
 		"anomaliesDeleted", anomaliesDeleted,
 		"silverTrafficDeleted", silverDeleted,
 		"silverUADeleted", uaDeleted,
 		"silverIPDeleted", ipDeleted)
 
 	return &CleanupStaleResult{
-		AnomaliesDeleted:     int(anomaliesDeleted),
+		AnomaliesResolved:    anomaliesResolved,
+		AnomaliesDeleted:     anomaliesDeleted,
 		SilverTrafficDeleted: int(silverDeleted),
 		SilverUADeleted:      int(uaDeleted),
 		SilverIPDeleted:      int(ipDeleted),
@@ -2027,6 +2140,10 @@ func (a *Activities) CleanupStale(ctx context.Context) (*CleanupStaleResult, err
 
 // --- Helpers ---
 
+// createAnomaly records an anomaly. resourceID identifies the anomaly by kind,
+// source and subject (URI, client IP, ASN, ...) but not by window, so an
+// anomaly that recurs is one finding: re-detection moves it to the latest
+// window and keeps its triage state.
 func (a *Activities) createAnomaly(ctx context.Context, resourceID, endpointID, sourceID, anomalyType, severity string,
 	windowStart, windowEnd time.Time, uri, method string,
 	baselineValue, actualValue, deviation float64, description string, detectedAt time.Time,
@@ -2040,7 +2157,8 @@ func (a *Activities) createAnomaly(ctx context.Context, resourceID, endpointID,
 		SetWindowStart(windowStart).
 		SetWindowEnd(windowEnd).
 		SetDetectedAt(detectedAt).
-		SetFirstDetectedAt(detectedAt)
+		SetFirstDetectedAt(detectedAt).
+		SetLastSeenAt(detectedAt)
 
 	if endpointID != "" {
 		create.SetEndpointID(endpointID)
@@ -2064,10 +2182,34 @@ func (a *Activities) createAnomaly(ctx context.Context, resourceID, endpointID,
 	}
 
 	if err := create.Exec(ctx); err != nil {
-		// Skip duplicates.
 		if !enthttpmonitor.IsConstraintError(err) {
 			activity.GetLogger(ctx).Warn("Failed to create anomaly",
 				"resourceID", resourceID, "error", err)
+			return
+		}
+		// Re-detected: move the anomaly to the latest window and advance
+		// last_seen_at, keeping triage state.
+		var deviationArg any
+		if !math.IsNaN(deviation) && !math.IsInf(deviation, 0) {
+			deviationArg = deviation
+		}
+		var descriptionArg, evidenceArg any
+		if description != "" {
+			descriptionArg = description
+		}
+		if len(evidenceJSON) > 0 {
+			evidenceArg = string(evidenceJSON)
+		}
+		if _, err := a.db.ExecContext(ctx, `UPDATE gold.httpmonitor_anomalies AS t
+			SET detected_at = $2, severity = $3, window_start = $4, window_end = $5,
+				baseline_value = $6, actual_value = $7, deviation = $8,
+				description = COALESCE($9, t.description),
+				evidence_json = COALESCE($10, t.evidence_json),
+				`+finding.ObservedSet("$2")+`
+			WHERE resource_id = $1`, resourceID, detectedAt, severity, windowStart, windowEnd,
+			baselineValue, actualValue, deviationArg, descriptionArg, evidenceArg); err != nil {
+			activity.GetLogger(ctx).Warn("Failed to update anomaly",
+				"resourceID", resourceID, "error", err)
 		}
 	}
 }
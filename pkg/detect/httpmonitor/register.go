@@ -29,6 +29,7 @@ func Register(w worker.Worker, configService *config.Service, driver dialect.Dri
 	w.RegisterActivity(activities.DetectASNAnomalies)
 	w.RegisterActivity(activities.DetectNewEndpoints)
 	w.RegisterActivity(activities.DetectAuthAnomalies)
+	w.RegisterActivity(activities.DetectThreatIntelMatches)
 	w.RegisterActivity(activities.CleanupStale)
 	w.RegisterWorkflow(HttpMonitorAnomalyWorkflow)
 }
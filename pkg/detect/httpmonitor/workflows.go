@@ -7,6 +7,8 @@ import (
 
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/detect/notify"
 )
 
 // HttpMonitorAnomalyResult holds the combined result of the anomaly detection workflow.
@@ -20,7 +22,9 @@ type HttpMonitorAnomalyResult struct {
 	ASNResult            DetectASNAnomaliesResult
 	NewEndpointResult    DetectNewEndpointsResult
 	AuthResult           DetectAuthAnomaliesResult
+	ThreatIntelResult    DetectThreatIntelMatchesResult
 	CleanupResult        CleanupStaleResult
+	NotifyResult         notify.DispatchResult
 }
 
 // HttpMonitorAnomalyWorkflow orchestrates the sequential anomaly detection pipeline.
@@ -40,6 +44,7 @@ func HttpMonitorAnomalyWorkflow(ctx workflow.Context) (*HttpMonitorAnomalyResult
 	}
 	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
 
+	runStart := workflow.Now(ctx)
 	result := &HttpMonitorAnomalyResult{}
 	var detectionErrors []error
 
@@ -162,19 +167,46 @@ func HttpMonitorAnomalyWorkflow(ctx workflow.Context) (*HttpMonitorAnomalyResult
 			"authSuccess", result.AuthResult.AuthSuccessAfterBurst)
 	}
 
-	// 10. Cleanup stale data (always runs regardless of detection errors).
+	// 10. Detect traffic from threat intel listed IPs.
+	if err := workflow.ExecuteActivity(activityCtx, DetectThreatIntelMatchesActivity).
+		Get(ctx, &result.ThreatIntelResult); err != nil {
+		logger.Error("DetectThreatIntelMatches failed", "error", err)
+		detectionErrors = append(detectionErrors, fmt.Errorf("threat intel matches: %w", err))
+	} else {
+		logger.Info("DetectThreatIntelMatches done",
+			"torExitNode", result.ThreatIntelResult.TorExitNode,
+			"knownScanner", result.ThreatIntelResult.KnownScanner,
+			"blocklisted", result.ThreatIntelResult.Blocklisted)
+	}
+
+	// 11. Cleanup stale data (always runs regardless of detection errors).
 	if err := workflow.ExecuteActivity(activityCtx, CleanupStaleActivity).
 		Get(ctx, &result.CleanupResult); err != nil {
 		logger.Error("CleanupStale failed", "error", err)
 		detectionErrors = append(detectionErrors, fmt.Errorf("cleanup stale: %w", err))
 	} else {
 		logger.Info("CleanupStale done",
+			"anomaliesResolved", result.CleanupResult.AnomaliesResolved,
 			"anomaliesDeleted", result.CleanupResult.AnomaliesDeleted,
 			"silverTrafficDeleted", result.CleanupResult.SilverTrafficDeleted,
 			"silverUADeleted", result.CleanupResult.SilverUADeleted,
 			"silverIPDeleted", result.CleanupResult.SilverIPDeleted)
 	}
 
+	// 12. Notify about anomalies raised or re-observed in this run.
+	if err := workflow.ExecuteActivity(activityCtx, notify.DispatchActivity,
+		notify.DispatchParams{Source: notify.SourceHttpMonitor, Since: runStart}).
+		Get(ctx, &result.NotifyResult); err != nil {
+		logger.Error("Dispatch failed", "error", err)
+		detectionErrors = append(detectionErrors, fmt.Errorf("notify: %w", err))
+	} else {
+		logger.Info("Dispatch done",
+			"sent", result.NotifyResult.Sent,
+			"failed", result.NotifyResult.Failed,
+			"deduped", result.NotifyResult.Deduped,
+			"rateLimited", result.NotifyResult.RateLimited)
+	}
+
 	logger.Info("HttpMonitorAnomalyWorkflow complete")
 
 	if err := errors.Join(detectionErrors...); err != nil {
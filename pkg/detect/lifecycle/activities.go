@@ -12,6 +12,7 @@ import (
 	"go.temporal.io/sdk/activity"
 
 	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/detect/finding"
 )
 
 const batchSize = 1000
@@ -348,24 +349,27 @@ type CleanupStaleParams struct {
 
 // CleanupStaleResult holds output from the CleanupStale activity.
 type CleanupStaleResult struct {
-	Deleted int
+	Resolved int
+	Purged   int
 }
 
-// CleanupStale deletes gold.lifecycle_software rows not updated in this run.
+// CleanupStale resolves gold.lifecycle_software rows not seen in this run.
+// Rows are kept so triage state survives if the software comes back.
 func (a *Activities) CleanupStale(ctx context.Context, params CleanupStaleParams) (*CleanupStaleResult, error) {
 	logger := activity.GetLogger(ctx)
 	logger.Info("Starting CleanupStale activity")
 
-	result, err := a.db.ExecContext(ctx,
-		`DELETE FROM gold.lifecycle_software WHERE detected_at < $1`,
-		params.RunTimestamp)
+	resolved, err := finding.ResolveStale(ctx, a.db, "gold.lifecycle_software", params.RunTimestamp, "")
 	if err != nil {
-		return nil, fmt.Errorf("delete stale rows: %w", err)
+		return nil, err
+	}
+	purged, err := finding.PurgeResolved(ctx, a.db, "gold.lifecycle_software", params.RunTimestamp)
+	if err != nil {
+		return nil, err
 	}
 
-	deleted, _ := result.RowsAffected()
-	logger.Info("CleanupStale complete", "deleted", deleted)
-	return &CleanupStaleResult{Deleted: int(deleted)}, nil
+	logger.Info("CleanupStale complete", "resolved", resolved, "purged", purged)
+	return &CleanupStaleResult{Resolved: resolved, Purged: purged}, nil
 }
 
 // --- Data loading ---
@@ -736,10 +740,10 @@ func (a *Activities) upsertLifecycleBatch(ctx context.Context, rows []lifecycleR
 		return nil
 	}
 
-	const cols = 16
+	const cols = 17
 	var b strings.Builder
-	b.WriteString(`INSERT INTO gold.lifecycle_software
-		(resource_id, detected_at, first_detected_at, machine_id, name, version,
+	b.WriteString(`INSERT INTO gold.lifecycle_software AS t
+		(resource_id, detected_at, first_detected_at, last_seen_at, machine_id, name, version,
 		 classification, eol_product_slug, eol_product_name, eol_category,
 		 eol_cycle, eol_date, eoas_date, eoes_date, eol_status, latest_version)
 		VALUES `)
@@ -761,7 +765,7 @@ func (a *Activities) upsertLifecycleBatch(ctx context.Context, rows []lifecycleR
 		b.WriteByte(')')
 
 		resourceID := r.machineID + ":" + r.name
-		args = append(args, resourceID, runTimestamp, runTimestamp,
+		args = append(args, resourceID, runTimestamp, runTimestamp, runTimestamp,
 			r.machineID, r.name, nilIfEmpty(r.version),
 			r.classification, r.eolProductSlug, r.eolProductName, r.eolCategory,
 			r.eolCycle, r.eolDate, r.eoasDate, r.eoesDate, r.eolStatus, r.latestVersion)
@@ -781,7 +785,8 @@ func (a *Activities) upsertLifecycleBatch(ctx context.Context, rows []lifecycleR
 		eoas_date = EXCLUDED.eoas_date,
 		eoes_date = EXCLUDED.eoes_date,
 		eol_status = EXCLUDED.eol_status,
-		latest_version = EXCLUDED.latest_version`)
+		latest_version = EXCLUDED.latest_version,
+		` + finding.ObservedSet("EXCLUDED.last_seen_at"))
 
 	_, err := a.db.ExecContext(ctx, b.String(), args...)
 	return err
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/detect/finding"
 )
 
 // Activity function references for OS lifecycle Temporal registration.
@@ -163,24 +165,26 @@ type CleanupStaleOSParams struct {
 
 // CleanupStaleOSResult holds output from the CleanupStaleOS activity.
 type CleanupStaleOSResult struct {
-	Deleted int
+	Resolved int
+	Purged   int
 }
 
-// CleanupStaleOS deletes gold.lifecycle_os rows not updated in this run.
+// CleanupStaleOS resolves gold.lifecycle_os rows not seen in this run.
 func (a *Activities) CleanupStaleOS(ctx context.Context, params CleanupStaleOSParams) (*CleanupStaleOSResult, error) {
 	logger := activity.GetLogger(ctx)
 	logger.Info("Starting CleanupStaleOS activity")
 
-	result, err := a.db.ExecContext(ctx,
-		`DELETE FROM gold.lifecycle_os WHERE detected_at < $1`,
-		params.RunTimestamp)
+	resolved, err := finding.ResolveStale(ctx, a.db, "gold.lifecycle_os", params.RunTimestamp, "")
+	if err != nil {
+		return nil, err
+	}
+	purged, err := finding.PurgeResolved(ctx, a.db, "gold.lifecycle_os", params.RunTimestamp)
 	if err != nil {
-		return nil, fmt.Errorf("delete stale OS rows: %w", err)
+		return nil, err
 	}
 
-	deleted, _ := result.RowsAffected()
-	logger.Info("CleanupStaleOS complete", "deleted", deleted)
-	return &CleanupStaleOSResult{Deleted: int(deleted)}, nil
+	logger.Info("CleanupStaleOS complete", "resolved", resolved, "purged", purged)
+	return &CleanupStaleOSResult{Resolved: resolved, Purged: purged}, nil
 }
 
 // --- Data loading ---
@@ -234,10 +238,10 @@ func (a *Activities) upsertOSLifecycleBatch(ctx context.Context, rows []osLifecy
 		return nil
 	}
 
-	const cols = 15
+	const cols = 16
 	var b strings.Builder
-	b.WriteString(`INSERT INTO gold.lifecycle_os
-		(resource_id, detected_at, first_detected_at, machine_id, hostname,
+	b.WriteString(`INSERT INTO gold.lifecycle_os AS t
+		(resource_id, detected_at, first_detected_at, last_seen_at, machine_id, hostname,
 		 os_type, os_name, eol_product_slug, eol_product_name,
 		 eol_cycle, eol_date, eoas_date, eoes_date, eol_status, latest_version)
 		VALUES `)
@@ -258,7 +262,7 @@ func (a *Activities) upsertOSLifecycleBatch(ctx context.Context, rows []osLifecy
 		}
 		b.WriteByte(')')
 
-		args = append(args, r.machineID, runTimestamp, runTimestamp,
+		args = append(args, r.machineID, runTimestamp, runTimestamp, runTimestamp,
 			r.machineID, nilIfEmpty(r.hostname),
 			nilIfEmpty(r.osType), nilIfEmpty(r.osName),
 			r.eolProductSlug, r.eolProductName,
@@ -278,7 +282,8 @@ func (a *Activities) upsertOSLifecycleBatch(ctx context.Context, rows []osLifecy
 		eoas_date = EXCLUDED.eoas_date,
 		eoes_date = EXCLUDED.eoes_date,
 		eol_status = EXCLUDED.eol_status,
-		latest_version = EXCLUDED.latest_version`)
+		latest_version = EXCLUDED.latest_version,
+		` + finding.ObservedSet("EXCLUDED.last_seen_at"))
 
 	_, err := a.db.ExecContext(ctx, b.String(), args...)
 	return err
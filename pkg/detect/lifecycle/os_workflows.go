@@ -5,12 +5,15 @@ import (
 
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/detect/notify"
 )
 
 // OSLifecycleResult holds the combined result of the OS lifecycle workflow.
 type OSLifecycleResult struct {
 	MatchResult   MatchOSLifecycleResult
 	CleanupResult CleanupStaleOSResult
+	NotifyResult  notify.DispatchResult
 }
 
 // OSLifecycleWorkflow orchestrates the OS lifecycle detection pipeline.
@@ -46,17 +49,29 @@ func OSLifecycleWorkflow(ctx workflow.Context) (*OSLifecycleResult, error) {
 		CleanupStaleOSParams{RunTimestamp: runTimestamp}).Get(ctx, &cleanupResult); err != nil {
 		return nil, err
 	}
-	logger.Info("CleanupStaleOS done", "deleted", cleanupResult.Deleted)
+	logger.Info("CleanupStaleOS done", "resolved", cleanupResult.Resolved, "purged", cleanupResult.Purged)
+
+	// 3. Notify about expired-EOL rows seen in this run.
+	var notifyResult notify.DispatchResult
+	// The rows are already stored, so a failed dispatch is logged rather
+	// than failing the run.
+	if err := workflow.ExecuteActivity(activityCtx, notify.DispatchActivity,
+		notify.DispatchParams{Source: notify.SourceLifecycleOS, Since: runTimestamp}).Get(ctx, &notifyResult); err != nil {
+		logger.Error("Dispatch failed", "error", err)
+	} else {
+		logger.Info("Dispatch done", "sent", notifyResult.Sent, "failed", notifyResult.Failed)
+	}
 
 	result := &OSLifecycleResult{
 		MatchResult:   matchResult,
 		CleanupResult: cleanupResult,
+		NotifyResult:  notifyResult,
 	}
 
 	logger.Info("OSLifecycleWorkflow complete",
 		"matched", matchResult.Matched,
 		"unmatched", matchResult.Unmatched,
-		"deleted", cleanupResult.Deleted)
+		"resolved", cleanupResult.Resolved)
 
 	return result, nil
 }
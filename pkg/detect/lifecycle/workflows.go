@@ -5,6 +5,8 @@ import (
 
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/detect/notify"
 )
 
 // SoftwareLifecycleResult holds the combined result of the workflow.
@@ -13,9 +15,11 @@ type SoftwareLifecycleResult struct {
 	OSCoreResult    ClassifyOSCoreResult
 	UnmatchedResult MarkUnmatchedResult
 	CleanupResult   CleanupStaleResult
+	NotifyResult    notify.DispatchResult
 }
 
-// SoftwareLifecycleWorkflow orchestrates the 4-activity lifecycle detection pipeline.
+// SoftwareLifecycleWorkflow orchestrates the lifecycle detection pipeline and
+// notifies about expired-EOL rows.
 func SoftwareLifecycleWorkflow(ctx workflow.Context) (*SoftwareLifecycleResult, error) {
 	logger := workflow.GetLogger(ctx)
 	logger.Info("Starting SoftwareLifecycleWorkflow")
@@ -71,20 +75,32 @@ func SoftwareLifecycleWorkflow(ctx workflow.Context) (*SoftwareLifecycleResult,
 		CleanupStaleParams{RunTimestamp: runTimestamp}).Get(ctx, &cleanupResult); err != nil {
 		return nil, err
 	}
-	logger.Info("CleanupStale done", "deleted", cleanupResult.Deleted)
+	logger.Info("CleanupStale done", "resolved", cleanupResult.Resolved, "purged", cleanupResult.Purged)
+
+	// 5. Notify about expired-EOL rows seen in this run.
+	var notifyResult notify.DispatchResult
+	// The rows are already stored, so a failed dispatch is logged rather
+	// than failing the run.
+	if err := workflow.ExecuteActivity(activityCtx, notify.DispatchActivity,
+		notify.DispatchParams{Source: notify.SourceLifecycleSoftware, Since: runTimestamp}).Get(ctx, &notifyResult); err != nil {
+		logger.Error("Dispatch failed", "error", err)
+	} else {
+		logger.Info("Dispatch done", "sent", notifyResult.Sent, "failed", notifyResult.Failed)
+	}
 
 	result := &SoftwareLifecycleResult{
 		MatchResult:     matchResult,
 		OSCoreResult:    osCoreResult,
 		UnmatchedResult: unmatchedResult,
 		CleanupResult:   cleanupResult,
+		NotifyResult:    notifyResult,
 	}
 
 	logger.Info("SoftwareLifecycleWorkflow complete",
 		"matched", matchResult.Matched,
 		"os_core", osCoreResult.OSCore,
 		"unmatched", unmatchedResult.Unmatched,
-		"deleted", cleanupResult.Deleted)
+		"resolved", cleanupResult.Resolved)
 
 	return result, nil
 }
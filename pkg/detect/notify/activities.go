@@ -0,0 +1,297 @@
+package notify
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/config"
+)
+
+// Activities holds dependencies for notification Temporal activities.
+type Activities struct {
+	configService *config.Service
+	db            *sql.DB
+	destinations  *Destinations
+}
+
+// NewActivities creates an Activities instance.
+func NewActivities(configService *config.Service, db *sql.DB, destinations *Destinations) *Activities {
+	return &Activities{
+		configService: configService,
+		db:            db,
+		destinations:  destinations,
+	}
+}
+
+// Activity function references for Temporal registration.
+var (
+	DispatchActivity = (*Activities).Dispatch
+)
+
+// Delivery statuses recorded in gold.notify_deliveries.
+const (
+	statusSent        = "sent"
+	statusFailed      = "failed"
+	statusRateLimited = "rate_limited"
+)
+
+// DispatchParams holds input for the Dispatch activity.
+type DispatchParams struct {
+	// Source selects the finding table: SourceHttpMonitor, SourceLifecycleSoftware, SourceLifecycleOS.
+	Source string
+	// Since selects the open findings observed at or after this time, i.e.
+	// in the detection run that just finished. Open findings whose last
+	// delivery failed or was rate limited are retried regardless.
+	Since time.Time
+}
+
+// DispatchResult holds output from the Dispatch activity.
+type DispatchResult struct {
+	Events      int
+	Sent        int
+	Failed      int
+	Deduped     int
+	RateLimited int
+}
+
+// Dispatch matches the findings of one detection run against the active
+// notification rules and delivers them. Delivery errors are recorded per
+// destination and do not fail the activity; a rule whose last attempt at an
+// open finding failed or was rate limited retries it on every run until it
+// is sent, whether or not the finding was re-detected.
+func (a *Activities) Dispatch(ctx context.Context, params DispatchParams) (*DispatchResult, error) {
+	logger := activity.GetLogger(ctx)
+
+	rules, err := loadRules(ctx, a.db)
+	if err != nil {
+		return nil, fmt.Errorf("load notification rules: %w", err)
+	}
+	result := &DispatchResult{}
+	if len(rules) == 0 {
+		return result, nil
+	}
+
+	pending, err := a.pendingDeliveries(ctx, params.Source)
+	if err != nil {
+		return nil, fmt.Errorf("load pending %s deliveries: %w", params.Source, err)
+	}
+	pendingIDs := make([]string, 0, len(pending))
+	for id := range pending {
+		pendingIDs = append(pendingIDs, id)
+	}
+	events, observed, err := a.loadEvents(ctx, params.Source, params.Since, pendingIDs)
+	if err != nil {
+		return nil, fmt.Errorf("load %s events: %w", params.Source, err)
+	}
+	result.Events = len(events)
+	logger.Info("Starting Dispatch activity", "source", params.Source, "events", len(events), "rules", len(rules))
+
+	for i := range rules {
+		rule := &rules[i]
+		sentLastHour, err := a.sentSince(ctx, rule.Key, time.Now().Add(-time.Hour))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range events {
+			if !observed[e.FindingID] && !pending[e.FindingID][rule.Key] {
+				continue
+			}
+			if !rule.Matches(e) {
+				continue
+			}
+			dup, err := a.recentlySent(ctx, rule, e)
+			if err != nil {
+				return nil, err
+			}
+			if dup {
+				result.Deduped++
+				continue
+			}
+
+			limited := rule.RateLimitPerHour > 0 && sentLastHour >= rule.RateLimitPerHour
+			sent := false
+			for _, dest := range rule.Destinations {
+				status, errMsg := statusRateLimited, ""
+				if !limited {
+					status, errMsg = a.send(ctx, dest, e)
+				}
+				switch status {
+				case statusSent:
+					result.Sent++
+					sent = true
+				case statusFailed:
+					result.Failed++
+					logger.Warn("Notification failed", "rule", rule.Key, "destination", dest, "finding", e.FindingID, "error", errMsg)
+				case statusRateLimited:
+					result.RateLimited++
+				}
+				if err := a.recordDelivery(ctx, rule.Key, e, dest, status, errMsg); err != nil {
+					return nil, err
+				}
+			}
+			// Only delivered events count against the rate limit.
+			if sent {
+				sentLastHour++
+			}
+			activity.RecordHeartbeat(ctx, rule.Key)
+		}
+	}
+
+	logger.Info("Dispatch complete", "source", params.Source,
+		"sent", result.Sent, "failed", result.Failed,
+		"deduped", result.Deduped, "rateLimited", result.RateLimited)
+	return result, nil
+}
+
+func (a *Activities) send(ctx context.Context, dest string, e Event) (status, errMsg string) {
+	sender, err := a.destinations.Get(dest)
+	if err == nil {
+		err = sender.Send(ctx, e)
+	}
+	if err != nil {
+		return statusFailed, err.Error()
+	}
+	return statusSent, ""
+}
+
+// recentlySent reports whether the rule already delivered this finding
+// within its dedup window (ever, when the window is zero).
+func (a *Activities) recentlySent(ctx context.Context, rule *Rule, e Event) (bool, error) {
+	since := time.Time{}
+	if rule.Dedup > 0 {
+		since = time.Now().Add(-rule.Dedup)
+	}
+	var exists bool
+	err := a.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM gold.notify_deliveries
+			WHERE rule_key = $1 AND finding_id = $2 AND source = $3
+			  AND status = 'sent' AND created_at >= $4)`,
+		rule.Key, e.FindingID, e.Source, since).Scan(&exists)
+	return exists, err
+}
+
+// sentSince counts events (not destinations) the rule delivered since t.
+func (a *Activities) sentSince(ctx context.Context, ruleKey string, t time.Time) (int, error) {
+	var n int
+	err := a.db.QueryRowContext(ctx, `
+		SELECT count(DISTINCT (source, finding_id)) FROM gold.notify_deliveries
+		WHERE rule_key = $1 AND status = 'sent' AND created_at >= $2`,
+		ruleKey, t).Scan(&n)
+	return n, err
+}
+
+// pendingDeliveries returns, per finding of source, the rules whose attempts
+// at it failed or were rate limited and have not been sent since.
+func (a *Activities) pendingDeliveries(ctx context.Context, source string) (map[string]map[string]bool, error) {
+	rows, err := a.db.QueryContext(ctx, `
+		SELECT d.finding_id, d.rule_key FROM gold.notify_deliveries d
+		WHERE d.source = $1 AND d.status IN ('failed', 'rate_limited')
+		  AND NOT EXISTS (
+			SELECT 1 FROM gold.notify_deliveries s
+			WHERE s.rule_key = d.rule_key AND s.finding_id = d.finding_id AND s.source = d.source
+			  AND s.status = 'sent' AND s.created_at >= d.created_at)
+		GROUP BY d.finding_id, d.rule_key`, source)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	pending := make(map[string]map[string]bool)
+	for rows.Next() {
+		var findingID, ruleKey string
+		if err := rows.Scan(&findingID, &ruleKey); err != nil {
+			return nil, err
+		}
+		if pending[findingID] == nil {
+			pending[findingID] = make(map[string]bool)
+		}
+		pending[findingID][ruleKey] = true
+	}
+	return pending, rows.Err()
+}
+
+func (a *Activities) recordDelivery(ctx context.Context, ruleKey string, e Event, dest, status, errMsg string) error {
+	var errVal any
+	if errMsg != "" {
+		errVal = errMsg
+	}
+	_, err := a.db.ExecContext(ctx, `
+		INSERT INTO gold.notify_deliveries
+			(rule_key, source, finding_id, destination, status, error, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		ruleKey, e.Source, e.FindingID, dest, status, errVal, time.Now())
+	return err
+}
+
+// --- Event loaders ---
+
+// loadEvents returns the open findings of source observed since the run
+// start or listed in pendingIDs, and which of them were observed.
+func (a *Activities) loadEvents(ctx context.Context, source string, since time.Time, pendingIDs []string) ([]Event, map[string]bool, error) {
+	switch source {
+	case SourceHttpMonitor:
+		return a.queryEvents(ctx, source, `
+			SELECT resource_id, anomaly_type, severity, source_id,
+			       COALESCE(NULLIF(trim(COALESCE(method, '') || ' ' || COALESCE(uri, '')), ''), source_id),
+			       COALESCE(description, ''), detected_at, last_seen_at >= $1
+			FROM gold.httpmonitor_anomalies
+			WHERE status = 'open' AND (last_seen_at >= $1 OR resource_id = ANY($2))`, since, pendingIDs)
+	case SourceLifecycleSoftware:
+		return a.queryEvents(ctx, source, `
+			SELECT resource_id, eol_status, `+eolSeverity+`, machine_id,
+			       trim(name || ' ' || COALESCE(version, '')),
+			       'EOL ' || COALESCE(eol_product_name, '') || ' ' || COALESCE(eol_cycle, '') ||
+			           COALESCE(' (' || to_char(eol_date, 'YYYY-MM-DD') || ')', ''),
+			       detected_at, last_seen_at >= $1
+			FROM gold.lifecycle_software
+			WHERE status = 'open' AND (last_seen_at >= $1 OR resource_id = ANY($2))
+			  AND eol_status IN ('eol_expired', 'eoes_expired')`, since, pendingIDs)
+	case SourceLifecycleOS:
+		return a.queryEvents(ctx, source, `
+			SELECT resource_id, eol_status, `+eolSeverity+`, machine_id,
+			       trim(COALESCE(hostname, machine_id) || ': ' || COALESCE(os_name, '')),
+			       'EOL ' || COALESCE(eol_product_name, '') || ' ' || COALESCE(eol_cycle, '') ||
+			           COALESCE(' (' || to_char(eol_date, 'YYYY-MM-DD') || ')', ''),
+			       detected_at, last_seen_at >= $1
+			FROM gold.lifecycle_os
+			WHERE status = 'open' AND (last_seen_at >= $1 OR resource_id = ANY($2))
+			  AND eol_status IN ('eol_expired', 'eoes_expired')`, since, pendingIDs)
+	}
+	return nil, nil, fmt.Errorf("unknown source %q", source)
+}
+
+// eolSeverity maps lifecycle eol_status to a notification severity: past
+// extended support is critical, past standard support is high.
+const eolSeverity = `CASE eol_status WHEN 'eoes_expired' THEN 'critical' ELSE 'high' END`
+
+// queryEvents scans events whose last column reports whether the finding
+// was observed in this run.
+func (a *Activities) queryEvents(ctx context.Context, source, query string, args ...any) ([]Event, map[string]bool, error) {
+	rows, err := a.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var events []Event
+	observed := make(map[string]bool)
+	for rows.Next() {
+		e := Event{Source: source}
+		var seen bool
+		if err := rows.Scan(&e.FindingID, &e.Type, &e.Severity, &e.SourceID,
+			&e.Title, &e.Detail, &e.DetectedAt, &seen); err != nil {
+			return nil, nil, err
+		}
+		e.Detail = strings.TrimSpace(e.Detail)
+		events = append(events, e)
+		observed[e.FindingID] = seen
+	}
+	return events, observed, rows.Err()
+}
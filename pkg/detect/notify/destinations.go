@@ -0,0 +1,69 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"danny.vn/hotpot/pkg/base/config"
+)
+
+const sendTimeout = 15 * time.Second
+
+// Sender delivers one event to one destination.
+type Sender interface {
+	Send(ctx context.Context, e Event) error
+}
+
+// Destinations holds the senders built from config. It is rebuilt on every
+// config reload so URL, secret and SMTP changes apply without a restart.
+type Destinations struct {
+	client *http.Client
+
+	mu      sync.RWMutex
+	senders map[string]Sender
+}
+
+// NewDestinations builds senders from the current config and subscribes to
+// reloads.
+func NewDestinations(configService *config.Service) *Destinations {
+	d := &Destinations{client: &http.Client{Timeout: sendTimeout}}
+	d.Update(configService.NotifyDestinations())
+	configService.OnReload(func(cfg *config.Config) {
+		d.Update(cfg.Notify.Destinations)
+	})
+	return d
+}
+
+// Update replaces all senders.
+func (d *Destinations) Update(cfgs []config.NotifyDestinationConfig) {
+	senders := make(map[string]Sender, len(cfgs))
+	for _, c := range cfgs {
+		switch c.Type {
+		case "webhook":
+			senders[c.Name] = &WebhookSender{URL: c.URL, Secret: c.Secret, Client: d.client}
+		case "slack":
+			senders[c.Name] = &ChatSender{URL: c.URL, Format: FormatSlack, Client: d.client}
+		case "teams":
+			senders[c.Name] = &ChatSender{URL: c.URL, Format: FormatTeams, Client: d.client}
+		case "smtp":
+			senders[c.Name] = &SMTPSender{Config: c.SMTP}
+		}
+	}
+	d.mu.Lock()
+	d.senders = senders
+	d.mu.Unlock()
+}
+
+// Get returns the sender for a destination name.
+func (d *Destinations) Get(name string) (Sender, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	s, ok := d.senders[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown destination %q", name)
+	}
+	return s, nil
+}
@@ -0,0 +1,48 @@
+// Package notify delivers new gold findings to webhooks, chat and email,
+// routed by the rules in config.notification_rules.
+package notify
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Finding sources that can raise notifications.
+const (
+	SourceHttpMonitor       = "httpmonitor"
+	SourceLifecycleSoftware = "lifecycle_software"
+	SourceLifecycleOS       = "lifecycle_os"
+)
+
+// Event is a finding to notify about, normalized across sources.
+type Event struct {
+	Source     string    `json:"source"`
+	FindingID  string    `json:"finding_id"`
+	Type       string    `json:"type"`
+	Severity   string    `json:"severity"`
+	SourceID   string    `json:"source_id"`
+	Title      string    `json:"title"`
+	Detail     string    `json:"detail,omitempty"`
+	DetectedAt time.Time `json:"detected_at"`
+}
+
+// Subject is a one-line summary used for chat text and email subjects.
+func (e Event) Subject() string {
+	s := fmt.Sprintf("[%s] %s: %s", strings.ToUpper(e.Severity), e.Type, e.Title)
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(s)
+}
+
+// Text is the plain-text body shared by chat and email messages.
+func (e Event) Text() string {
+	var b strings.Builder
+	b.WriteString(e.Subject())
+	b.WriteString("\n")
+	if e.Detail != "" {
+		b.WriteString(e.Detail)
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "Source: %s (%s)\nFinding: %s\nDetected: %s\n",
+		e.Source, e.SourceID, e.FindingID, e.DetectedAt.UTC().Format(time.RFC3339))
+	return b.String()
+}
@@ -0,0 +1,16 @@
+package notify
+
+import (
+	"database/sql"
+
+	"go.temporal.io/sdk/worker"
+
+	"danny.vn/hotpot/pkg/base/config"
+)
+
+// Register wires the notification activity to the worker. Detection
+// workflows call DispatchActivity after they finish writing findings.
+func Register(w worker.Worker, configService *config.Service, db *sql.DB) {
+	activities := NewActivities(configService, db, NewDestinations(configService))
+	w.RegisterActivity(activities.Dispatch)
+}
@@ -0,0 +1,86 @@
+package notify
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Rule is an active row of config.notification_rules.
+type Rule struct {
+	Key              string
+	Sources          []string
+	Severities       []string
+	AnomalyTypes     []string
+	SourceIDs        []string
+	Destinations     []string
+	Dedup            time.Duration
+	RateLimitPerHour int
+}
+
+// Matches reports whether the event passes every non-empty match list.
+func (r *Rule) Matches(e Event) bool {
+	return matchList(r.Sources, e.Source) &&
+		matchList(r.Severities, e.Severity) &&
+		matchList(r.AnomalyTypes, e.Type) &&
+		matchList(r.SourceIDs, e.SourceID)
+}
+
+func matchList(list []string, v string) bool {
+	if len(list) == 0 {
+		return true
+	}
+	for _, s := range list {
+		if strings.EqualFold(s, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadRules reads the active notification rules.
+func loadRules(ctx context.Context, db *sql.DB) ([]Rule, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT rule_key, sources, severities, anomaly_types, source_ids,
+		       destinations, dedup_minutes, rate_limit_per_hour
+		FROM config.notification_rules
+		WHERE is_active
+		ORDER BY rule_key`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []Rule
+	for rows.Next() {
+		var (
+			r                                            Rule
+			sources, severities, types, sourceIDs, dests []byte
+			dedupMinutes                                 int
+		)
+		if err := rows.Scan(&r.Key, &sources, &severities, &types, &sourceIDs,
+			&dests, &dedupMinutes, &r.RateLimitPerHour); err != nil {
+			return nil, err
+		}
+		for _, f := range []struct {
+			raw []byte
+			dst *[]string
+		}{
+			{sources, &r.Sources}, {severities, &r.Severities}, {types, &r.AnomalyTypes},
+			{sourceIDs, &r.SourceIDs}, {dests, &r.Destinations},
+		} {
+			if len(f.raw) == 0 {
+				continue
+			}
+			if err := json.Unmarshal(f.raw, f.dst); err != nil {
+				return nil, fmt.Errorf("rule %s: %w", r.Key, err)
+			}
+		}
+		r.Dedup = time.Duration(dedupMinutes) * time.Minute
+		rules = append(rules, r)
+	}
+	return rules, rows.Err()
+}
@@ -0,0 +1,28 @@
+package notify
+
+import "testing"
+
+func TestRuleMatches(t *testing.T) {
+	e := Event{Source: SourceHttpMonitor, Type: "sql_injection", Severity: "critical", SourceID: "prod-lb"}
+
+	tests := []struct {
+		name string
+		rule Rule
+		want bool
+	}{
+		{"empty rule matches all", Rule{}, true},
+		{"severity", Rule{Severities: []string{"high", "critical"}}, true},
+		{"severity case-insensitive", Rule{Severities: []string{"CRITICAL"}}, true},
+		{"severity miss", Rule{Severities: []string{"low"}}, false},
+		{"type and source", Rule{AnomalyTypes: []string{"sql_injection"}, SourceIDs: []string{"prod-lb"}}, true},
+		{"source id miss", Rule{AnomalyTypes: []string{"sql_injection"}, SourceIDs: []string{"staging-lb"}}, false},
+		{"other pipeline", Rule{Sources: []string{SourceLifecycleOS}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.Matches(e); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/base/config"
+)
+
+// SMTPSender emails the event as plain text.
+type SMTPSender struct {
+	Config config.NotifySMTPConfig
+}
+
+// Send implements Sender.
+func (s *SMTPSender) Send(ctx context.Context, e Event) error {
+	cfg := s.Config
+	port := cfg.Port
+	if port == 0 {
+		port = 587
+	}
+	addr := net.JoinHostPort(cfg.Host, strconv.Itoa(port))
+	tlsConfig := &tls.Config{ServerName: cfg.Host}
+
+	dialer := &net.Dialer{Timeout: sendTimeout}
+	var conn net.Conn
+	var err error
+	if cfg.ImplicitTLS {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("dial smtp: %w", err)
+	}
+	deadline := time.Now().Add(sendTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	conn.SetDeadline(deadline)
+
+	c, err := smtp.NewClient(conn, cfg.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("smtp handshake: %w", err)
+	}
+	defer c.Close()
+
+	if !cfg.ImplicitTLS {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(tlsConfig); err != nil {
+				return fmt.Errorf("starttls: %w", err)
+			}
+		}
+	}
+	if cfg.Username != "" {
+		// PlainAuth refuses to send credentials over an unencrypted connection.
+		if err := c.Auth(smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+
+	if err := c.Mail(cfg.From); err != nil {
+		return err
+	}
+	for _, to := range cfg.To {
+		if err := c.Rcpt(to); err != nil {
+			return fmt.Errorf("rcpt %s: %w", to, err)
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(buildMessage(cfg.From, cfg.To, e, time.Now())); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+func buildMessage(from string, to []string, e Event, now time.Time) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", e.Subject()))
+	fmt.Fprintf(&b, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(e.Text(), "\n", "\r\n"))
+	return b.Bytes()
+}
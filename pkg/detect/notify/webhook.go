@@ -0,0 +1,141 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"strconv"
+	"time"
+)
+
+// Webhook signature headers. Receivers verify
+// hex(HMAC-SHA256(secret, timestamp + "." + body)) and reject old timestamps.
+const (
+	SignatureHeader = "X-Hotpot-Signature"
+	TimestampHeader = "X-Hotpot-Timestamp"
+)
+
+// WebhookSender posts the event as JSON, signed when Secret is set.
+type WebhookSender struct {
+	URL    string
+	Secret string
+	Client *http.Client
+
+	now func() time.Time
+}
+
+// Send implements Sender.
+func (s *WebhookSender) Send(ctx context.Context, e Event) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	headers := map[string]string{}
+	if s.Secret != "" {
+		now := time.Now
+		if s.now != nil {
+			now = s.now
+		}
+		ts := strconv.FormatInt(now().Unix(), 10)
+		headers[TimestampHeader] = ts
+		headers[SignatureHeader] = "sha256=" + Sign(s.Secret, ts, body)
+	}
+	return postJSON(ctx, s.Client, s.URL, body, headers)
+}
+
+// Sign returns the hex HMAC-SHA256 of timestamp + "." + body.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Chat payload formats for incoming webhooks.
+const (
+	FormatSlack = "slack"
+	FormatTeams = "teams"
+)
+
+// ChatSender posts to a Slack-compatible or Teams incoming webhook.
+type ChatSender struct {
+	URL    string
+	Format string
+	Client *http.Client
+}
+
+// severityColors are attachment/card colors by severity.
+var severityColors = map[string]string{
+	"critical": "#b91c1c",
+	"high":     "#ea580c",
+	"medium":   "#d97706",
+	"low":      "#2563eb",
+	"info":     "#71717a",
+}
+
+// Send implements Sender.
+func (s *ChatSender) Send(ctx context.Context, e Event) error {
+	var payload any
+	switch s.Format {
+	case FormatTeams:
+		payload = map[string]any{
+			"@type":      "MessageCard",
+			"@context":   "https://schema.org/extensions",
+			"summary":    e.Subject(),
+			"title":      e.Subject(),
+			"text":       e.Text(),
+			"themeColor": severityColors[e.Severity],
+		}
+	default:
+		payload = map[string]any{
+			"text": e.Subject(),
+			"attachments": []map[string]any{{
+				"color": severityColors[e.Severity],
+				"text":  e.Text(),
+			}},
+		}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return postJSON(ctx, s.Client, s.URL, body, nil)
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, body []byte, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "hotpot-notify")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		// Drop the URL from the error: chat webhook URLs embed their secret.
+		var uerr *neturl.Error
+		if errors.As(err, &uerr) {
+			return uerr.Err
+		}
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
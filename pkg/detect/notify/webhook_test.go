@@ -0,0 +1,78 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWebhookSenderSignsPayload(t *testing.T) {
+	var gotBody []byte
+	var gotSig, gotTS string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotBody, _ = io.ReadAll(r.Body)
+		gotSig = r.Header.Get(SignatureHeader)
+		gotTS = r.Header.Get(TimestampHeader)
+	}))
+	defer srv.Close()
+
+	s := &WebhookSender{
+		URL: srv.URL, Secret: "shh", Client: srv.Client(),
+		now: func() time.Time { return time.Unix(1700000000, 0) },
+	}
+	e := Event{Source: SourceHttpMonitor, FindingID: "a1", Type: "5xx_burst", Severity: "high"}
+	if err := s.Send(context.Background(), e); err != nil {
+		t.Fatal(err)
+	}
+
+	if gotTS != "1700000000" {
+		t.Errorf("timestamp = %q", gotTS)
+	}
+	if want := "sha256=" + Sign("shh", gotTS, gotBody); gotSig != want {
+		t.Errorf("signature = %q, want %q", gotSig, want)
+	}
+	var decoded Event
+	if err := json.Unmarshal(gotBody, &decoded); err != nil || decoded.FindingID != "a1" {
+		t.Errorf("body = %s (%v)", gotBody, err)
+	}
+}
+
+func TestChatSenderFormats(t *testing.T) {
+	var got map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&got)
+	}))
+	defer srv.Close()
+
+	e := Event{Type: "eol_expired", Severity: "critical", Title: "web-1: Ubuntu 18.04"}
+
+	if err := (&ChatSender{URL: srv.URL, Format: FormatSlack, Client: srv.Client()}).Send(context.Background(), e); err != nil {
+		t.Fatal(err)
+	}
+	if got["text"] != "[CRITICAL] eol_expired: web-1: Ubuntu 18.04" {
+		t.Errorf("slack text = %v", got["text"])
+	}
+
+	if err := (&ChatSender{URL: srv.URL, Format: FormatTeams, Client: srv.Client()}).Send(context.Background(), e); err != nil {
+		t.Fatal(err)
+	}
+	if got["@type"] != "MessageCard" || got["themeColor"] != severityColors["critical"] {
+		t.Errorf("teams card = %v", got)
+	}
+}
+
+func TestWebhookSenderErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	s := &WebhookSender{URL: srv.URL, Client: srv.Client()}
+	if err := s.Send(context.Background(), Event{}); err == nil {
+		t.Fatal("expected error for 502")
+	}
+}
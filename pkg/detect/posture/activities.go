@@ -0,0 +1,220 @@
+package posture
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/detect/finding"
+	"danny.vn/hotpot/pkg/detect/sqlrule"
+)
+
+const batchSize = 500
+
+// Activities holds dependencies for posture detection Temporal activities.
+type Activities struct {
+	configService *config.Service
+	db            *sql.DB
+}
+
+// NewActivities creates an Activities instance.
+func NewActivities(configService *config.Service, db *sql.DB) *Activities {
+	return &Activities{
+		configService: configService,
+		db:            db,
+	}
+}
+
+// Activity function references for Temporal registration.
+var (
+	EvaluateRulesActivity = (*Activities).EvaluateRules
+	ResolveStaleActivity  = (*Activities).ResolveStale
+)
+
+// --- Types ---
+
+type findingRow struct {
+	rule *sqlrule.Rule
+	sqlrule.Finding
+}
+
+// --- Activity 1: EvaluateRules ---
+
+// EvaluateRulesParams holds input for the EvaluateRules activity.
+type EvaluateRulesParams struct {
+	RunTimestamp time.Time
+}
+
+// EvaluateRulesResult holds output from the EvaluateRules activity.
+type EvaluateRulesResult struct {
+	Rules       int
+	FailedRules []string
+	Findings    int
+}
+
+// EvaluateRules loads the active rules from config.detection_rules, runs each
+// one through the sqlrule engine and upserts the offending resources into
+// gold.posture_findings. A failing rule (e.g. its bronze table does not exist
+// because the provider is disabled, or it hit its statement timeout) is logged
+// and skipped so one broken query does not hide the other findings.
+func (a *Activities) EvaluateRules(ctx context.Context, params EvaluateRulesParams) (*EvaluateRulesResult, error) {
+	logger := activity.GetLogger(ctx)
+
+	rules, err := sqlrule.LoadActive(ctx, a.db)
+	if err != nil {
+		return nil, fmt.Errorf("load rules: %w", err)
+	}
+	logger.Info("Starting EvaluateRules activity", "rules", len(rules))
+
+	result := &EvaluateRulesResult{Rules: len(rules)}
+	for i := range rules {
+		rule := &rules[i]
+
+		findings, err := rule.Evaluate(ctx, a.db)
+		if err != nil {
+			logger.Warn("Posture rule failed", "rule", rule.Key, "error", err)
+			result.FailedRules = append(result.FailedRules, rule.Key)
+			continue
+		}
+
+		rows := make([]findingRow, len(findings))
+		for j, f := range findings {
+			rows[j] = findingRow{rule: rule, Finding: f}
+		}
+
+		for j := 0; j < len(rows); j += batchSize {
+			end := min(j+batchSize, len(rows))
+			if err := a.upsertFindingBatch(ctx, rows[j:end], params.RunTimestamp); err != nil {
+				return nil, fmt.Errorf("upsert findings for %s: %w", rule.Key, err)
+			}
+		}
+
+		result.Findings += len(rows)
+		logger.Info("Posture rule evaluated", "rule", rule.Key, "findings", len(rows))
+		activity.RecordHeartbeat(ctx, fmt.Sprintf("rule %d/%d", i+1, len(rules)))
+	}
+
+	logger.Info("EvaluateRules complete",
+		"rules", result.Rules, "failed", len(result.FailedRules), "findings", result.Findings)
+	return result, nil
+}
+
+// --- Activity 2: ResolveStale ---
+
+// ResolveStaleParams holds input for the ResolveStale activity.
+type ResolveStaleParams struct {
+	RunTimestamp time.Time
+	// FailedRules are skipped so a transient query error does not resolve
+	// the previous findings of that rule.
+	FailedRules []string
+}
+
+// ResolveStaleResult holds output from the ResolveStale activity.
+type ResolveStaleResult struct {
+	Resolved int
+	Purged   int
+}
+
+// ResolveStale marks findings that were not re-detected in this run as
+// resolved. Rows are kept so triage history survives; a finding that comes
+// back is reopened by the next upsert.
+func (a *Activities) ResolveStale(ctx context.Context, params ResolveStaleParams) (*ResolveStaleResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting ResolveStale activity")
+
+	failed := params.FailedRules
+	if failed == nil {
+		failed = []string{}
+	}
+
+	resolved, err := finding.ResolveStale(ctx, a.db, "gold.posture_findings",
+		params.RunTimestamp, "NOT (rule_key = ANY($2))", failed)
+	if err != nil {
+		return nil, err
+	}
+	purged, err := finding.PurgeResolved(ctx, a.db, "gold.posture_findings", params.RunTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("ResolveStale complete", "resolved", resolved, "purged", purged)
+	return &ResolveStaleResult{Resolved: resolved, Purged: purged}, nil
+}
+
+// --- Bulk upsert ---
+
+func (a *Activities) upsertFindingBatch(ctx context.Context, rows []findingRow, runTimestamp time.Time) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	const cols = 16
+	var b strings.Builder
+	b.WriteString(`INSERT INTO gold.posture_findings AS t
+		(resource_id, detected_at, first_detected_at, last_seen_at, rule_key,
+		 category, severity, provider, resource_type, asset_id,
+		 asset_name, project_id, title, description, remediation,
+		 evidence_json)
+		VALUES `)
+
+	args := make([]any, 0, len(rows)*cols)
+	for i, r := range rows {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		base := i * cols
+		b.WriteByte('(')
+		for j := range cols {
+			if j > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteByte('$')
+			b.WriteString(strconv.Itoa(base + j + 1))
+		}
+		b.WriteByte(')')
+
+		var evidence any
+		if len(r.Evidence) > 0 {
+			evidence = []byte(r.Evidence)
+		}
+
+		args = append(args, findingID(r.rule.Key, r.AssetID), runTimestamp, runTimestamp, runTimestamp,
+			r.rule.Key, r.rule.Category, r.rule.Severity, r.rule.Provider, r.rule.ResourceType,
+			r.AssetID, r.AssetName, r.ProjectID,
+			r.rule.Name, nilIfEmpty(r.rule.Description), nilIfEmpty(r.rule.Remediation), evidence)
+	}
+
+	b.WriteString(` ON CONFLICT (resource_id) DO UPDATE SET
+		detected_at = EXCLUDED.detected_at,
+		category = EXCLUDED.category,
+		severity = EXCLUDED.severity,
+		resource_type = EXCLUDED.resource_type,
+		asset_name = EXCLUDED.asset_name,
+		project_id = EXCLUDED.project_id,
+		title = EXCLUDED.title,
+		description = EXCLUDED.description,
+		remediation = EXCLUDED.remediation,
+		evidence_json = EXCLUDED.evidence_json,
+		` + finding.ObservedSet("EXCLUDED.last_seen_at"))
+
+	_, err := a.db.ExecContext(ctx, b.String(), args...)
+	return err
+}
+
+// findingID builds the gold resource_id for a (rule, asset) pair.
+func findingID(ruleKey, assetID string) string {
+	return ruleKey + ":" + assetID
+}
+
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
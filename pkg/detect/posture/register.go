@@ -0,0 +1,17 @@
+package posture
+
+import (
+	"database/sql"
+
+	"go.temporal.io/sdk/worker"
+
+	"danny.vn/hotpot/pkg/base/config"
+)
+
+// Register wires posture detection activities and workflow to the worker.
+func Register(w worker.Worker, configService *config.Service, db *sql.DB) {
+	activities := NewActivities(configService, db)
+	w.RegisterActivity(activities.EvaluateRules)
+	w.RegisterActivity(activities.ResolveStale)
+	w.RegisterWorkflow(PostureWorkflow)
+}
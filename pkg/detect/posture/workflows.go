@@ -0,0 +1,68 @@
+package posture
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// PostureResult holds the combined result of the posture workflow.
+type PostureResult struct {
+	EvaluateResult EvaluateRulesResult
+	ResolveResult  ResolveStaleResult
+}
+
+// PostureWorkflow evaluates the active detection rules and resolves findings
+// that are no longer present.
+func PostureWorkflow(ctx workflow.Context) (*PostureResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting PostureWorkflow")
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 15 * time.Minute,
+		HeartbeatTimeout:    2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	runTimestamp := workflow.Now(ctx)
+
+	// 1. Evaluate rules.
+	var evaluateResult EvaluateRulesResult
+	if err := workflow.ExecuteActivity(activityCtx, EvaluateRulesActivity,
+		EvaluateRulesParams{RunTimestamp: runTimestamp}).Get(ctx, &evaluateResult); err != nil {
+		return nil, err
+	}
+	logger.Info("EvaluateRules done",
+		"rules", evaluateResult.Rules,
+		"failed", len(evaluateResult.FailedRules),
+		"findings", evaluateResult.Findings)
+
+	// 2. Resolve stale findings.
+	var resolveResult ResolveStaleResult
+	if err := workflow.ExecuteActivity(activityCtx, ResolveStaleActivity,
+		ResolveStaleParams{
+			RunTimestamp: runTimestamp,
+			FailedRules:  evaluateResult.FailedRules,
+		}).Get(ctx, &resolveResult); err != nil {
+		return nil, err
+	}
+	logger.Info("ResolveStale done", "resolved", resolveResult.Resolved, "purged", resolveResult.Purged)
+
+	result := &PostureResult{
+		EvaluateResult: evaluateResult,
+		ResolveResult:  resolveResult,
+	}
+
+	logger.Info("PostureWorkflow complete",
+		"findings", evaluateResult.Findings,
+		"resolved", resolveResult.Resolved)
+
+	return result, nil
+}
@@ -9,10 +9,16 @@ import (
 	"danny.vn/hotpot/pkg/base/config"
 	detecthttpmon "danny.vn/hotpot/pkg/detect/httpmonitor"
 	"danny.vn/hotpot/pkg/detect/lifecycle"
+	"danny.vn/hotpot/pkg/detect/notify"
+	"danny.vn/hotpot/pkg/detect/posture"
+	"danny.vn/hotpot/pkg/detect/vulnerability"
 )
 
 // Register wires all detect domains to the worker.
 func Register(w worker.Worker, configService *config.Service, driver dialect.Driver, db *sql.DB) {
 	lifecycle.Register(w, configService, db)
 	detecthttpmon.Register(w, configService, driver, db)
+	posture.Register(w, configService, db)
+	vulnerability.Register(w, configService, db)
+	notify.Register(w, configService, db)
 }
@@ -18,6 +18,8 @@ import (
 	hotpottemporal "danny.vn/hotpot/pkg/base/temporal"
 	detecthttpmon "danny.vn/hotpot/pkg/detect/httpmonitor"
 	"danny.vn/hotpot/pkg/detect/lifecycle"
+	"danny.vn/hotpot/pkg/detect/posture"
+	"danny.vn/hotpot/pkg/detect/vulnerability"
 )
 
 // Run starts the detect worker.
@@ -112,5 +114,34 @@ func ensureSchedules(ctx context.Context, temporalClient client.Client) {
 		},
 		Paused: true,
 	})
-}
 
+	hotpottemporal.EnsureSchedule(ctx, sc, client.ScheduleOptions{
+		ID: "hotpot-detect-posture-hourly",
+		Spec: client.ScheduleSpec{
+			Intervals: []client.ScheduleIntervalSpec{
+				{Every: time.Hour},
+			},
+		},
+		Action: &client.ScheduleWorkflowAction{
+			ID:        "hotpot-detect-posture",
+			Workflow:  posture.PostureWorkflow,
+			TaskQueue: "detect",
+		},
+		Paused: true,
+	})
+
+	hotpottemporal.EnsureSchedule(ctx, sc, client.ScheduleOptions{
+		ID: "hotpot-detect-vulnerability-daily",
+		Spec: client.ScheduleSpec{
+			Intervals: []client.ScheduleIntervalSpec{
+				{Every: 24 * time.Hour},
+			},
+		},
+		Action: &client.ScheduleWorkflowAction{
+			ID:        "hotpot-detect-vulnerability",
+			Workflow:  vulnerability.VulnerabilityWorkflow,
+			TaskQueue: "detect",
+		},
+		Paused: true,
+	})
+}
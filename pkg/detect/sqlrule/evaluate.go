@@ -0,0 +1,51 @@
+package sqlrule
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// Evaluate runs the rule query in a read-only transaction with the rule's
+// statement timeout. The transaction is always rolled back, so a rule can
+// never modify data even if its SQL tries to.
+func (r *Rule) Evaluate(ctx context.Context, db *sql.DB) ([]Finding, error) {
+	query, args, err := Bind(r.Query, r.Params)
+	if err != nil {
+		return nil, fmt.Errorf("bind params: %w", err)
+	}
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("begin read-only tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	// SET does not accept bind parameters; the value is an int we control.
+	if _, err := tx.ExecContext(ctx,
+		fmt.Sprintf("SET LOCAL statement_timeout = %d", r.Timeout.Milliseconds())); err != nil {
+		return nil, fmt.Errorf("set statement timeout: %w", err)
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var result []Finding
+	for rows.Next() {
+		var (
+			f        Finding
+			evidence []byte
+		)
+		if err := rows.Scan(&f.AssetID, &f.AssetName, &f.ProjectID, &evidence); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		if len(evidence) > 0 {
+			f.Evidence = evidence
+		}
+		result = append(result, f)
+	}
+	return result, rows.Err()
+}
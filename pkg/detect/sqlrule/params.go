@@ -0,0 +1,170 @@
+package sqlrule
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Bind rewrites @name parameters in query to positional $n placeholders and
+// returns the matching argument list. Each distinct name is bound once, in
+// order of first appearance. Text inside single-quoted literals, double-quoted
+// identifiers, dollar-quoted strings and line or block comments is left
+// untouched, and "@@" / "@>"-style operators are not treated as parameters.
+func Bind(query string, params map[string]any) (string, []any, error) {
+	var (
+		b       strings.Builder
+		args    []any
+		indexOf = map[string]int{}
+	)
+	b.Grow(len(query))
+
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '\'' || c == '"':
+			end := skipQuoted(query, i, c)
+			b.WriteString(query[i:end])
+			i = end - 1
+
+		case c == '-' && i+1 < len(query) && query[i+1] == '-':
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				end = len(query) - i
+			}
+			b.WriteString(query[i : i+end])
+			i += end - 1
+
+		case c == '/' && i+1 < len(query) && query[i+1] == '*':
+			end := skipBlockComment(query, i)
+			b.WriteString(query[i:end])
+			i = end - 1
+
+		case c == '$' && (i == 0 || !isIdentChar(query[i-1])) && dollarTag(query, i) != "":
+			end := skipDollarQuoted(query, i, dollarTag(query, i))
+			b.WriteString(query[i:end])
+			i = end - 1
+
+		case c == '@' && i+1 < len(query) && isIdentStart(query[i+1]) && (i == 0 || !isIdentChar(query[i-1]) && query[i-1] != '@'):
+			j := i + 1
+			for j < len(query) && isIdentChar(query[j]) {
+				j++
+			}
+			name := query[i+1 : j]
+			n, ok := indexOf[name]
+			if !ok {
+				v, found := params[name]
+				if !found {
+					return "", nil, fmt.Errorf("parameter @%s has no value", name)
+				}
+				args = append(args, normalizeParam(v))
+				n = len(args)
+				indexOf[name] = n
+			}
+			b.WriteByte('$')
+			b.WriteString(strconv.Itoa(n))
+			i = j - 1
+
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String(), args, nil
+}
+
+// skipQuoted returns the index just past the quoted token starting at start.
+// A doubled quote character is an escaped quote.
+func skipQuoted(s string, start int, quote byte) int {
+	for i := start + 1; i < len(s); i++ {
+		if s[i] != quote {
+			continue
+		}
+		if i+1 < len(s) && s[i+1] == quote {
+			i++
+			continue
+		}
+		return i + 1
+	}
+	return len(s)
+}
+
+// skipBlockComment returns the index just past the block comment starting at
+// start. Block comments nest, as in PostgreSQL.
+func skipBlockComment(s string, start int) int {
+	depth := 0
+	for i := start; i+1 < len(s); i++ {
+		switch {
+		case s[i] == '/' && s[i+1] == '*':
+			depth++
+			i++
+		case s[i] == '*' && s[i+1] == '/':
+			depth--
+			i++
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(s)
+}
+
+// dollarTag returns the opening tag ("$$" or "$name$") of a dollar-quoted
+// string at start, or "" if there is none. Positional parameters such as
+// "$1" are not tags.
+func dollarTag(s string, start int) string {
+	j := start + 1
+	if j < len(s) && isIdentStart(s[j]) {
+		for j < len(s) && isIdentChar(s[j]) {
+			j++
+		}
+	}
+	if j < len(s) && s[j] == '$' {
+		return s[start : j+1]
+	}
+	return ""
+}
+
+// skipDollarQuoted returns the index just past the dollar-quoted string
+// starting at start with the given tag.
+func skipDollarQuoted(s string, start int, tag string) int {
+	end := strings.Index(s[start+len(tag):], tag)
+	if end < 0 {
+		return len(s)
+	}
+	return start + len(tag) + end + len(tag)
+}
+
+// normalizeParam converts JSON-decoded values into driver-friendly types.
+// json.Number becomes int64 when integral, otherwise float64; arrays of
+// strings become []string so they bind to text[].
+func normalizeParam(v any) any {
+	switch t := v.(type) {
+	case json.Number:
+		if n, err := t.Int64(); err == nil {
+			return n
+		}
+		f, _ := t.Float64()
+		return f
+	case []any:
+		strs := make([]string, 0, len(t))
+		for _, e := range t {
+			s, ok := e.(string)
+			if !ok {
+				return v
+			}
+			strs = append(strs, s)
+		}
+		return strs
+	default:
+		return v
+	}
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || c >= '0' && c <= '9'
+}
@@ -0,0 +1,83 @@
+package sqlrule
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestBind(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		params   map[string]any
+		want     string
+		wantArgs []any
+		wantErr  bool
+	}{
+		{"no params", "SELECT 1", nil, "SELECT 1", nil, false},
+		{"single", "WHERE port = @port", map[string]any{"port": 22}, "WHERE port = $1", []any{22}, false},
+		{"reused", "WHERE a <= @port AND b >= @port::int", map[string]any{"port": 22},
+			"WHERE a <= $1 AND b >= $1::int", []any{22}, false},
+		{"order of appearance", "@b, @a, @b", map[string]any{"a": 1, "b": 2}, "$1, $2, $1", []any{2, 1}, false},
+		{"string literal untouched", "WHERE x = '@port' AND y = @port", map[string]any{"port": 1},
+			"WHERE x = '@port' AND y = $1", []any{1}, false},
+		{"escaped quote", "WHERE x = 'it''s @a' AND y = @b", map[string]any{"b": 1},
+			"WHERE x = 'it''s @a' AND y = $1", []any{1}, false},
+		{"quoted identifier untouched", `SELECT "@col" FROM t`, nil, `SELECT "@col" FROM t`, nil, false},
+		{"comment untouched", "SELECT 1 -- @port\nWHERE y = @port", map[string]any{"port": 1},
+			"SELECT 1 -- @port\nWHERE y = $1", []any{1}, false},
+		{"block comment untouched", "SELECT 1 /* @port /* nested @a */ still */ WHERE y = @port", map[string]any{"port": 1},
+			"SELECT 1 /* @port /* nested @a */ still */ WHERE y = $1", []any{1}, false},
+		{"unterminated block comment", "SELECT 1 /* @port", nil, "SELECT 1 /* @port", nil, false},
+		{"dollar quoted untouched", "WHERE x = $$it's @a$$ AND y = @b", map[string]any{"b": 1},
+			"WHERE x = $$it's @a$$ AND y = $1", []any{1}, false},
+		{"tagged dollar quoted untouched", "WHERE x = $q$ $$ @a $q$ AND y = @b", map[string]any{"b": 1},
+			"WHERE x = $q$ $$ @a $q$ AND y = $1", []any{1}, false},
+		{"dollar in identifier not quoted", "WHERE x$y$ = @b", map[string]any{"b": 2},
+			"WHERE x$y$ = $1", []any{2}, false},
+		{"operators untouched", "WHERE a @> b AND c @@ d", nil, "WHERE a @> b AND c @@ d", nil, false},
+		{"email-like untouched", "WHERE e = x@y", nil, "WHERE e = x@y", nil, false},
+		{"missing param", "WHERE port = @port", nil, "", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, args, err := Bind(tt.query, tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Bind() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Bind() query = %q, want %q", got, tt.want)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("Bind() args = %#v, want %#v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestNormalizeParam(t *testing.T) {
+	tests := []struct {
+		name string
+		in   any
+		want any
+	}{
+		{"integer number", json.Number("90"), int64(90)},
+		{"float number", json.Number("0.5"), 0.5},
+		{"string array", []any{"a", "b"}, []string{"a", "b"}},
+		{"mixed array kept", []any{"a", json.Number("1")}, []any{"a", json.Number("1")}},
+		{"string", "x", "x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeParam(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeParam(%#v) = %#v, want %#v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Package sqlrule runs declarative detection rules stored in
+// config.detection_rules. A rule is a parameterised read-only SELECT over
+// bronze/silver that returns one row per offending resource; callers decide
+// which gold table the rows are written to.
+package sqlrule
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// DefaultTimeout is the statement timeout used when a rule does not set one.
+const DefaultTimeout = 30 * time.Second
+
+// Rule is a single active rule loaded from config.detection_rules.
+type Rule struct {
+	ID           int
+	Key          string
+	Name         string
+	Description  string
+	Category     string
+	Severity     string
+	Provider     string
+	ResourceType string
+	Remediation  string
+	Query        string
+	Params       map[string]any
+	Timeout      time.Duration
+}
+
+// Finding is one row returned by a rule query.
+type Finding struct {
+	AssetID   string
+	AssetName *string
+	ProjectID *string
+	Evidence  json.RawMessage
+}
+
+// LoadActive reads all active rules ordered by rule_key.
+func LoadActive(ctx context.Context, db *sql.DB) ([]Rule, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT rule_id, rule_key, name, COALESCE(description, ''), category, severity,
+		       provider, resource_type, COALESCE(remediation, ''), query, params_json,
+		       timeout_seconds
+		FROM config.detection_rules
+		WHERE is_active = true
+		ORDER BY rule_key`)
+	if err != nil {
+		return nil, fmt.Errorf("query detection rules: %w", err)
+	}
+	defer rows.Close()
+
+	var result []Rule
+	for rows.Next() {
+		var (
+			r          Rule
+			paramsJSON []byte
+			timeoutSec int
+		)
+		if err := rows.Scan(&r.ID, &r.Key, &r.Name, &r.Description, &r.Category, &r.Severity,
+			&r.Provider, &r.ResourceType, &r.Remediation, &r.Query, &paramsJSON,
+			&timeoutSec); err != nil {
+			return nil, fmt.Errorf("scan detection rule: %w", err)
+		}
+		if len(paramsJSON) > 0 {
+			// UseNumber keeps integer parameters as integers so they bind
+			// to int columns without a float cast.
+			dec := json.NewDecoder(bytes.NewReader(paramsJSON))
+			dec.UseNumber()
+			if err := dec.Decode(&r.Params); err != nil {
+				return nil, fmt.Errorf("unmarshal params for %s: %w", r.Key, err)
+			}
+		}
+		r.Timeout = DefaultTimeout
+		if timeoutSec > 0 {
+			r.Timeout = time.Duration(timeoutSec) * time.Second
+		}
+		result = append(result, r)
+	}
+	return result, rows.Err()
+}
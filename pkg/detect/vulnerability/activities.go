@@ -0,0 +1,758 @@
+package vulnerability
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/detect/finding"
+)
+
+const batchSize = 1000
+
+// Activities holds dependencies for vulnerability detection Temporal activities.
+type Activities struct {
+	configService *config.Service
+	db            *sql.DB
+}
+
+// NewActivities creates an Activities instance.
+func NewActivities(configService *config.Service, db *sql.DB) *Activities {
+	return &Activities{
+		configService: configService,
+		db:            db,
+	}
+}
+
+// Activity function references for Temporal registration.
+var (
+	MatchVulnerabilitiesActivity        = (*Activities).MatchVulnerabilities
+	CleanupStaleVulnerabilitiesActivity = (*Activities).CleanupStaleVulnerabilities
+)
+
+// --- Types ---
+
+type machineInfo struct {
+	hostname string
+	distro   *distro
+}
+
+type installedPackage struct {
+	machineID string
+	name      string
+	version   string
+}
+
+type osvRange struct {
+	vulnID    string
+	ecosystem string
+	rng       versionRange
+}
+
+type osvVuln struct {
+	summary          string
+	aliases          []string
+	databaseSeverity string
+	severityScore    string
+	published        *time.Time
+}
+
+type nvdMatch struct {
+	cveID   string
+	vendor  string
+	product string
+	bounds  cpeBounds
+}
+
+type nvdCVE struct {
+	description string
+	score       *float64
+	severity    string
+	vector      string
+	published   *time.Time
+}
+
+type findingRow struct {
+	machineID    string
+	hostname     string
+	packageName  string
+	version      string
+	vulnID       string
+	aliases      []string
+	source       string
+	ecosystem    string
+	scheme       string
+	severity     string
+	cvssScore    *float64
+	cvssVector   string
+	fixedVersion string
+	summary      string
+	published    *time.Time
+}
+
+// --- Activity 1: MatchVulnerabilities ---
+
+// MatchVulnerabilitiesParams holds input for the MatchVulnerabilities activity.
+type MatchVulnerabilitiesParams struct {
+	RunTimestamp time.Time
+}
+
+// MatchVulnerabilitiesResult holds output from the MatchVulnerabilities activity.
+type MatchVulnerabilitiesResult struct {
+	Packages    int
+	OSVFindings int
+	NVDFindings int
+	Machines    int
+}
+
+// MatchVulnerabilities matches installed software against the OSV and NVD
+// reference feeds and writes results to gold.vulnerability_findings.
+//
+// Packages on machines with an OSV distro ecosystem (Debian, Ubuntu, RPM
+// distros) are matched by package name against that ecosystem's advisories,
+// which account for distro backports. Software on other machines is matched
+// against NVD CPE criteria derived from its display name.
+func (a *Activities) MatchVulnerabilities(ctx context.Context, params MatchVulnerabilitiesParams) (*MatchVulnerabilitiesResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting MatchVulnerabilities activity")
+
+	// 1. Load machines and installed software.
+	machines, err := a.loadMachines(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load machines: %w", err)
+	}
+	packages, err := a.loadInstalledPackages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load installed software: %w", err)
+	}
+	logger.Info("Loaded inventory", "machines", len(machines), "packages", len(packages))
+
+	var distroPkgs, otherPkgs []installedPackage
+	for _, p := range packages {
+		if m, ok := machines[p.machineID]; ok && m.distro != nil {
+			distroPkgs = append(distroPkgs, p)
+		} else {
+			otherPkgs = append(otherPkgs, p)
+		}
+	}
+
+	findings := make(map[string]*findingRow)
+	var cveIDs []string
+
+	// 2. OSV: distro packages.
+	osvCount, osvCVEs, err := a.matchOSV(ctx, machines, distroPkgs, findings)
+	if err != nil {
+		return nil, fmt.Errorf("match OSV: %w", err)
+	}
+	cveIDs = append(cveIDs, osvCVEs...)
+	activity.RecordHeartbeat(ctx, fmt.Sprintf("osv %d findings", osvCount))
+
+	// 3. NVD: everything else.
+	nvdCount, nvdCVEs, err := a.matchNVD(ctx, machines, otherPkgs, findings)
+	if err != nil {
+		return nil, fmt.Errorf("match NVD: %w", err)
+	}
+	cveIDs = append(cveIDs, nvdCVEs...)
+	activity.RecordHeartbeat(ctx, fmt.Sprintf("nvd %d findings", nvdCount))
+
+	// 4. Enrich with NVD CVSS and descriptions.
+	cves, err := a.loadNVDCVEs(ctx, cveIDs)
+	if err != nil {
+		return nil, fmt.Errorf("load NVD CVEs: %w", err)
+	}
+	rows := make([]findingRow, 0, len(findings))
+	affectedMachines := make(map[string]bool)
+	for _, f := range findings {
+		if c, ok := cves[f.vulnID]; ok {
+			if c.severity != "" {
+				f.severity = normalizeSeverity(c.severity)
+			}
+			f.cvssScore = c.score
+			if c.vector != "" {
+				f.cvssVector = c.vector
+			}
+			if f.summary == "" {
+				f.summary = c.description
+			}
+			if f.published == nil {
+				f.published = c.published
+			}
+		}
+		rows = append(rows, *f)
+		affectedMachines[f.machineID] = true
+	}
+
+	// 5. Bulk upsert.
+	for i := 0; i < len(rows); i += batchSize {
+		end := min(i+batchSize, len(rows))
+		if err := a.upsertFindingBatch(ctx, rows[i:end], params.RunTimestamp); err != nil {
+			return nil, fmt.Errorf("upsert finding batch: %w", err)
+		}
+		activity.RecordHeartbeat(ctx, fmt.Sprintf("findings %d/%d", end, len(rows)))
+	}
+
+	logger.Info("MatchVulnerabilities complete", "osv", osvCount, "nvd", nvdCount, "machines", len(affectedMachines))
+	return &MatchVulnerabilitiesResult{
+		Packages:    len(packages),
+		OSVFindings: osvCount,
+		NVDFindings: nvdCount,
+		Machines:    len(affectedMachines),
+	}, nil
+}
+
+// matchOSV adds findings for distro packages and returns how many it added
+// and the CVE IDs they reference.
+func (a *Activities) matchOSV(ctx context.Context, machines map[string]machineInfo, pkgs []installedPackage, findings map[string]*findingRow) (int, []string, error) {
+	if len(pkgs) == 0 {
+		return 0, nil, nil
+	}
+
+	// Debian and Ubuntu advisories name source packages, so binary packages
+	// are looked up under their source (libssl3 under openssl).
+	debNames := make(map[string]bool)
+	for _, p := range pkgs {
+		if machines[p.machineID].distro.scheme == SchemeDeb {
+			debNames[p.name] = true
+		}
+	}
+	sources, err := a.loadSourcePackages(ctx, sortedKeys(debNames))
+	if err != nil {
+		return 0, nil, err
+	}
+	advisoryName := func(p installedPackage) string {
+		if machines[p.machineID].distro.scheme == SchemeDeb {
+			if src, ok := sources[p.name]; ok {
+				return src
+			}
+		}
+		return p.name
+	}
+
+	names := make(map[string]bool)
+	patterns := make(map[string]bool)
+	rebuildMachines := make(map[string]bool)
+	for _, p := range pkgs {
+		d := machines[p.machineID].distro
+		names[advisoryName(p)] = true
+		for _, eco := range d.ecosystems {
+			patterns[eco] = true
+			patterns[eco+":%"] = true
+		}
+		if d.rebuild {
+			rebuildMachines[p.machineID] = true
+		}
+	}
+	if len(rebuildMachines) > 0 {
+		activity.GetLogger(ctx).Info("Matching RHEL-compatible machines against AlmaLinux and Rocky Linux advisories",
+			"machines", len(rebuildMachines))
+	}
+
+	ranges, err := a.loadOSVRanges(ctx, sortedKeys(names), sortedKeys(patterns))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	vulnIDs := make(map[string]bool)
+	for _, list := range ranges {
+		for _, r := range list {
+			vulnIDs[r.vulnID] = true
+		}
+	}
+	vulns, err := a.loadOSVVulns(ctx, sortedKeys(vulnIDs))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	count := 0
+	cveIDs := make(map[string]bool)
+	for _, p := range pkgs {
+		d := machines[p.machineID].distro
+		for _, r := range ranges[advisoryName(p)] {
+			if !d.matchesEcosystem(r.ecosystem) || !r.rng.affects(d.scheme, p.version) {
+				continue
+			}
+			v, ok := vulns[r.vulnID]
+			if !ok {
+				continue // withdrawn
+			}
+			for _, id := range cveIDsOf(r.vulnID, v.aliases) {
+				f := &findingRow{
+					machineID:    p.machineID,
+					hostname:     machines[p.machineID].hostname,
+					packageName:  p.name,
+					version:      p.version,
+					vulnID:       id,
+					source:       "osv",
+					ecosystem:    r.ecosystem,
+					scheme:       d.scheme,
+					severity:     normalizeSeverity(v.databaseSeverity),
+					cvssVector:   v.severityScore,
+					fixedVersion: r.rng.fixed,
+					summary:      v.summary,
+					published:    v.published,
+				}
+				if id != r.vulnID {
+					f.aliases = []string{r.vulnID}
+				}
+				if mergeFinding(findings, f) {
+					count++
+				}
+				if strings.HasPrefix(id, "CVE-") {
+					cveIDs[id] = true
+				}
+			}
+		}
+	}
+	return count, sortedKeys(cveIDs), nil
+}
+
+// matchNVD adds findings for non-distro software and returns how many it
+// added and the CVE IDs they reference.
+func (a *Activities) matchNVD(ctx context.Context, machines map[string]machineInfo, pkgs []installedPackage, findings map[string]*findingRow) (int, []string, error) {
+	if len(pkgs) == 0 {
+		return 0, nil, nil
+	}
+
+	type candidates struct {
+		pairs    []cpeKey
+		products []string
+	}
+	byName := make(map[string]candidates)
+	allProducts := make(map[string]bool)
+	for _, p := range pkgs {
+		if _, ok := byName[p.name]; ok {
+			continue
+		}
+		pairs, products := cpeCandidates(p.name)
+		byName[p.name] = candidates{pairs: pairs, products: products}
+		for _, k := range pairs {
+			allProducts[k.product] = true
+		}
+		for _, prod := range products {
+			allProducts[prod] = true
+		}
+	}
+
+	matches, err := a.loadNVDMatches(ctx, sortedKeys(allProducts))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	count := 0
+	cveIDs := make(map[string]bool)
+	for _, p := range pkgs {
+		c := byName[p.name]
+		var hits []nvdMatch
+		for _, k := range c.pairs {
+			for _, m := range matches[k.product] {
+				if m.vendor == k.vendor {
+					hits = append(hits, m)
+				}
+			}
+		}
+		for _, prod := range c.products {
+			hits = append(hits, matches[prod]...)
+		}
+
+		for _, m := range hits {
+			if !m.bounds.affects(p.version) {
+				continue
+			}
+			f := &findingRow{
+				machineID:    p.machineID,
+				hostname:     machines[p.machineID].hostname,
+				packageName:  p.name,
+				version:      p.version,
+				vulnID:       m.cveID,
+				source:       "nvd",
+				ecosystem:    "cpe:" + m.vendor + ":" + m.product,
+				scheme:       SchemeSemver,
+				severity:     "unknown",
+				fixedVersion: m.bounds.endExcluding,
+			}
+			if mergeFinding(findings, f) {
+				count++
+			}
+			cveIDs[m.cveID] = true
+		}
+	}
+	return count, sortedKeys(cveIDs), nil
+}
+
+// mergeFinding adds f, or folds it into the finding already recorded for the
+// same (machine, package, vulnerability). It reports whether f was new.
+func mergeFinding(findings map[string]*findingRow, f *findingRow) bool {
+	key := f.machineID + ":" + f.packageName + ":" + f.vulnID
+	existing, ok := findings[key]
+	if !ok {
+		findings[key] = f
+		return true
+	}
+	for _, alias := range f.aliases {
+		if !slices.Contains(existing.aliases, alias) {
+			existing.aliases = append(existing.aliases, alias)
+		}
+	}
+	if severityRank(f.severity) > severityRank(existing.severity) {
+		existing.severity = f.severity
+	}
+	// Keep the highest fixed version: upgrading to it clears every advisory.
+	if f.fixedVersion != "" && (existing.fixedVersion == "" ||
+		compareVersions(existing.scheme, f.fixedVersion, existing.fixedVersion) > 0) {
+		existing.fixedVersion = f.fixedVersion
+	}
+	if existing.summary == "" {
+		existing.summary = f.summary
+	}
+	return false
+}
+
+// cveIDsOf returns the CVE IDs an advisory reports, or the advisory ID
+// itself when it has no CVE alias.
+func cveIDsOf(id string, aliases []string) []string {
+	if strings.HasPrefix(id, "CVE-") {
+		return []string{id}
+	}
+	var ids []string
+	for _, alias := range aliases {
+		if strings.HasPrefix(alias, "CVE-") {
+			ids = append(ids, alias)
+		}
+	}
+	if len(ids) == 0 {
+		return []string{id}
+	}
+	return ids
+}
+
+// --- Activity 2: CleanupStaleVulnerabilities ---
+
+// CleanupStaleVulnerabilitiesParams holds input for the CleanupStaleVulnerabilities activity.
+type CleanupStaleVulnerabilitiesParams struct {
+	RunTimestamp time.Time
+}
+
+// CleanupStaleVulnerabilitiesResult holds output from the CleanupStaleVulnerabilities activity.
+type CleanupStaleVulnerabilitiesResult struct {
+	Resolved int
+	Purged   int
+}
+
+// CleanupStaleVulnerabilities resolves gold.vulnerability_findings rows not
+// seen in this run, e.g. after the package was upgraded past the fixed version.
+func (a *Activities) CleanupStaleVulnerabilities(ctx context.Context, params CleanupStaleVulnerabilitiesParams) (*CleanupStaleVulnerabilitiesResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting CleanupStaleVulnerabilities activity")
+
+	resolved, err := finding.ResolveStale(ctx, a.db, "gold.vulnerability_findings", params.RunTimestamp, "")
+	if err != nil {
+		return nil, err
+	}
+	purged, err := finding.PurgeResolved(ctx, a.db, "gold.vulnerability_findings", params.RunTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("CleanupStaleVulnerabilities complete", "resolved", resolved, "purged", purged)
+	return &CleanupStaleVulnerabilitiesResult{Resolved: resolved, Purged: purged}, nil
+}
+
+// --- Data loading ---
+
+func (a *Activities) loadMachines(ctx context.Context) (map[string]machineInfo, error) {
+	rows, err := a.db.QueryContext(ctx, `
+		SELECT resource_id, COALESCE(hostname, ''), COALESCE(os_name, '')
+		FROM silver.inventory_machines`)
+	if err != nil {
+		return nil, fmt.Errorf("query machines: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string]machineInfo)
+	for rows.Next() {
+		var id, hostname, osName string
+		if err := rows.Scan(&id, &hostname, &osName); err != nil {
+			return nil, fmt.Errorf("scan machine: %w", err)
+		}
+		result[id] = machineInfo{hostname: hostname, distro: parseDistro(osName)}
+	}
+	return result, rows.Err()
+}
+
+func (a *Activities) loadInstalledPackages(ctx context.Context) ([]installedPackage, error) {
+	rows, err := a.db.QueryContext(ctx, `
+		SELECT machine_id, name, version
+		FROM silver.inventory_software
+		WHERE version IS NOT NULL AND version != ''`)
+	if err != nil {
+		return nil, fmt.Errorf("query inventory_software: %w", err)
+	}
+	defer rows.Close()
+
+	var result []installedPackage
+	for rows.Next() {
+		var p installedPackage
+		if err := rows.Scan(&p.machineID, &p.name, &p.version); err != nil {
+			return nil, fmt.Errorf("scan installed software: %w", err)
+		}
+		result = append(result, p)
+	}
+	return result, rows.Err()
+}
+
+// loadSourcePackages maps Debian-family binary package names to their source
+// package, from the Ubuntu Packages index. Debian uses the same source names
+// for the packages it shares with Ubuntu; binaries found in neither keep
+// their own name.
+func (a *Activities) loadSourcePackages(ctx context.Context, names []string) (map[string]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	rows, err := a.db.QueryContext(ctx, `
+		SELECT DISTINCT ON (package_name) package_name, source_package
+		FROM bronze.reference_ubuntu_packages
+		WHERE package_name = ANY($1) AND source_package IS NOT NULL
+		ORDER BY package_name, collected_at DESC`, names)
+	if err != nil {
+		return nil, fmt.Errorf("query ubuntu source packages: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string]string)
+	for rows.Next() {
+		var name, source string
+		if err := rows.Scan(&name, &source); err != nil {
+			return nil, fmt.Errorf("scan ubuntu source package: %w", err)
+		}
+		result[name] = source
+	}
+	return result, rows.Err()
+}
+
+// loadOSVRanges returns the version ranges of the named packages in the
+// ecosystems matching any LIKE pattern, keyed by package name. GIT ranges
+// carry commits and are skipped.
+func (a *Activities) loadOSVRanges(ctx context.Context, names, ecosystemPatterns []string) (map[string][]osvRange, error) {
+	rows, err := a.db.QueryContext(ctx, `
+		SELECT vuln_id, ecosystem, package_name,
+		       COALESCE(introduced, ''), COALESCE(fixed, ''), COALESCE(last_affected, ''), versions
+		FROM bronze.reference_osv_affected
+		WHERE package_name = ANY($1)
+		  AND ecosystem LIKE ANY($2)
+		  AND (range_type IS NULL OR range_type != 'GIT')`, names, ecosystemPatterns)
+	if err != nil {
+		return nil, fmt.Errorf("query OSV affected: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string][]osvRange)
+	for rows.Next() {
+		var r osvRange
+		var name string
+		var versions []byte
+		if err := rows.Scan(&r.vulnID, &r.ecosystem, &name,
+			&r.rng.introduced, &r.rng.fixed, &r.rng.lastAffected, &versions); err != nil {
+			return nil, fmt.Errorf("scan OSV affected: %w", err)
+		}
+		if len(versions) > 0 {
+			if err := json.Unmarshal(versions, &r.rng.versions); err != nil {
+				return nil, fmt.Errorf("decode versions of %s: %w", r.vulnID, err)
+			}
+		}
+		result[name] = append(result[name], r)
+	}
+	return result, rows.Err()
+}
+
+// loadOSVVulns returns the non-withdrawn advisories among ids.
+func (a *Activities) loadOSVVulns(ctx context.Context, ids []string) (map[string]osvVuln, error) {
+	rows, err := a.db.QueryContext(ctx, `
+		SELECT resource_id, COALESCE(summary, ''), aliases,
+		       COALESCE(database_severity, ''), COALESCE(severity_score, ''), published
+		FROM bronze.reference_osv_vulns
+		WHERE resource_id = ANY($1)
+		  AND withdrawn IS NULL`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("query OSV vulns: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string]osvVuln, len(ids))
+	for rows.Next() {
+		var id string
+		var v osvVuln
+		var aliases []byte
+		var published sql.NullTime
+		if err := rows.Scan(&id, &v.summary, &aliases, &v.databaseSeverity, &v.severityScore, &published); err != nil {
+			return nil, fmt.Errorf("scan OSV vuln: %w", err)
+		}
+		if len(aliases) > 0 {
+			if err := json.Unmarshal(aliases, &v.aliases); err != nil {
+				return nil, fmt.Errorf("decode aliases of %s: %w", id, err)
+			}
+		}
+		if published.Valid {
+			v.published = &published.Time
+		}
+		result[id] = v
+	}
+	return result, rows.Err()
+}
+
+// loadNVDMatches returns the vulnerable application CPE matches of the
+// given products, keyed by product.
+func (a *Activities) loadNVDMatches(ctx context.Context, products []string) (map[string][]nvdMatch, error) {
+	rows, err := a.db.QueryContext(ctx, `
+		SELECT cve_id, cpe_vendor, cpe_product, cpe_version,
+		       COALESCE(version_start_including, ''), COALESCE(version_start_excluding, ''),
+		       COALESCE(version_end_including, ''), COALESCE(version_end_excluding, '')
+		FROM bronze.reference_nvd_cpe_matches
+		WHERE part = 'a'
+		  AND vulnerable
+		  AND cpe_product = ANY($1)`, products)
+	if err != nil {
+		return nil, fmt.Errorf("query NVD CPE matches: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string][]nvdMatch)
+	for rows.Next() {
+		var m nvdMatch
+		if err := rows.Scan(&m.cveID, &m.vendor, &m.product, &m.bounds.version,
+			&m.bounds.startIncluding, &m.bounds.startExcluding,
+			&m.bounds.endIncluding, &m.bounds.endExcluding); err != nil {
+			return nil, fmt.Errorf("scan NVD CPE match: %w", err)
+		}
+		result[m.product] = append(result[m.product], m)
+	}
+	return result, rows.Err()
+}
+
+func (a *Activities) loadNVDCVEs(ctx context.Context, ids []string) (map[string]nvdCVE, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	rows, err := a.db.QueryContext(ctx, `
+		SELECT resource_id, COALESCE(description, ''), cvss_score,
+		       COALESCE(cvss_severity, ''), COALESCE(cvss_vector, ''), published
+		FROM bronze.reference_nvd_cves
+		WHERE resource_id = ANY($1)`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("query NVD CVEs: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string]nvdCVE, len(ids))
+	for rows.Next() {
+		var id string
+		var c nvdCVE
+		var score sql.NullFloat64
+		var published sql.NullTime
+		if err := rows.Scan(&id, &c.description, &score, &c.severity, &c.vector, &published); err != nil {
+			return nil, fmt.Errorf("scan NVD CVE: %w", err)
+		}
+		if score.Valid {
+			c.score = &score.Float64
+		}
+		if published.Valid {
+			c.published = &published.Time
+		}
+		result[id] = c
+	}
+	return result, rows.Err()
+}
+
+// --- Bulk upsert ---
+
+func (a *Activities) upsertFindingBatch(ctx context.Context, rows []findingRow, runTimestamp time.Time) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	const cols = 19
+	var b strings.Builder
+	b.WriteString(`INSERT INTO gold.vulnerability_findings AS t
+		(resource_id, detected_at, first_detected_at, last_seen_at, machine_id, hostname,
+		 package_name, package_version, vuln_id, aliases, source, ecosystem, version_scheme,
+		 severity, cvss_score, cvss_vector, fixed_version, summary, published)
+		VALUES `)
+
+	args := make([]any, 0, len(rows)*cols)
+	for i, r := range rows {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		base := i * cols
+		b.WriteByte('(')
+		for j := range cols {
+			if j > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteByte('$')
+			b.WriteString(strconv.Itoa(base + j + 1))
+		}
+		b.WriteByte(')')
+
+		var aliases *string
+		if len(r.aliases) > 0 {
+			data, err := json.Marshal(r.aliases)
+			if err != nil {
+				return fmt.Errorf("encode aliases: %w", err)
+			}
+			s := string(data)
+			aliases = &s
+		}
+
+		resourceID := r.machineID + ":" + r.packageName + ":" + r.vulnID
+		args = append(args, resourceID, runTimestamp, runTimestamp, runTimestamp,
+			r.machineID, nilIfEmpty(r.hostname),
+			r.packageName, r.version, r.vulnID, aliases, r.source, nilIfEmpty(r.ecosystem), r.scheme,
+			r.severity, r.cvssScore, nilIfEmpty(r.cvssVector), nilIfEmpty(r.fixedVersion),
+			nilIfEmpty(r.summary), r.published)
+	}
+
+	b.WriteString(` ON CONFLICT (resource_id) DO UPDATE SET
+		detected_at = EXCLUDED.detected_at,
+		hostname = EXCLUDED.hostname,
+		package_version = EXCLUDED.package_version,
+		aliases = EXCLUDED.aliases,
+		source = EXCLUDED.source,
+		ecosystem = EXCLUDED.ecosystem,
+		version_scheme = EXCLUDED.version_scheme,
+		severity = EXCLUDED.severity,
+		cvss_score = EXCLUDED.cvss_score,
+		cvss_vector = EXCLUDED.cvss_vector,
+		fixed_version = EXCLUDED.fixed_version,
+		summary = EXCLUDED.summary,
+		published = EXCLUDED.published,
+		` + finding.ObservedSet("EXCLUDED.last_seen_at"))
+
+	_, err := a.db.ExecContext(ctx, b.String(), args...)
+	return err
+}
+
+// --- Helpers ---
+
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,212 @@
+package vulnerability
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// distro is the OSV view of a machine's operating system.
+type distro struct {
+	// ecosystems are OSV ecosystem prefixes whose advisories apply, e.g.
+	// "Ubuntu:22.04" matches "Ubuntu:22.04:LTS" and "Ubuntu:Pro:22.04:LTS".
+	ecosystems []string
+	scheme     string
+	// rebuild is set when the ecosystems belong to a rebuild of the
+	// machine's distro rather than the distro itself.
+	rebuild bool
+}
+
+var distroVersionRe = regexp.MustCompile(`(\d+)(?:\.(\d+))?`)
+
+// rhelRebuildMinMajor is the first major release with AlmaLinux and Rocky
+// Linux advisories.
+const rhelRebuildMinMajor = 8
+
+// parseDistro maps an os_name to the OSV ecosystems of its package manager.
+// It returns nil for operating systems without an OSV distro ecosystem
+// (Windows, macOS, ...).
+//
+// OSV has no RHEL, CentOS or Oracle Linux ecosystem. Those hosts are matched
+// against the AlmaLinux and Rocky Linux advisories of the same major
+// release, which rebuild the same source RPMs; the resulting distro has
+// rebuild set, and findings carry the AlmaLinux or Rocky ecosystem. CentOS
+// Stream runs ahead of RHEL and releases before 8 have no rebuild
+// advisories, so both return nil.
+func parseDistro(osName string) *distro {
+	lower := strings.ToLower(osName)
+	m := distroVersionRe.FindStringSubmatch(lower)
+	if m == nil {
+		return nil
+	}
+	major, minor := m[1], m[2]
+
+	switch {
+	case strings.Contains(lower, "ubuntu"):
+		if minor == "" {
+			return nil
+		}
+		v := major + "." + minor
+		return &distro{ecosystems: []string{"Ubuntu:" + v, "Ubuntu:Pro:" + v}, scheme: SchemeDeb}
+	case strings.Contains(lower, "debian"):
+		return &distro{ecosystems: []string{"Debian:" + major}, scheme: SchemeDeb}
+	case strings.Contains(lower, "alma"):
+		return &distro{ecosystems: []string{"AlmaLinux:" + major}, scheme: SchemeRPM}
+	case strings.Contains(lower, "rocky"):
+		return &distro{ecosystems: []string{"Rocky Linux:" + major}, scheme: SchemeRPM}
+	case strings.Contains(lower, "centos stream"):
+		return nil
+	case strings.Contains(lower, "red hat enterprise"), strings.HasPrefix(lower, "rhel"),
+		strings.Contains(lower, "centos"), strings.Contains(lower, "oracle linux"):
+		if n, err := strconv.Atoi(major); err != nil || n < rhelRebuildMinMajor {
+			return nil
+		}
+		return &distro{ecosystems: []string{"AlmaLinux:" + major, "Rocky Linux:" + major}, scheme: SchemeRPM, rebuild: true}
+	}
+	return nil
+}
+
+// matchesEcosystem reports whether the OSV ecosystem eco belongs to d.
+func (d *distro) matchesEcosystem(eco string) bool {
+	for _, prefix := range d.ecosystems {
+		if eco == prefix || strings.HasPrefix(eco, prefix+":") {
+			return true
+		}
+	}
+	return false
+}
+
+// versionRange is one affected interval of a package. Bounds left empty are
+// open; an interval with neither fixed nor lastAffected has no fix yet.
+type versionRange struct {
+	introduced   string
+	fixed        string
+	lastAffected string
+	versions     []string // explicit affected versions, used instead of bounds
+}
+
+// affects reports whether version falls inside r under scheme.
+func (r versionRange) affects(scheme, version string) bool {
+	if len(r.versions) > 0 {
+		for _, v := range r.versions {
+			if compareVersions(scheme, version, v) == 0 {
+				return true
+			}
+		}
+		return false
+	}
+	if r.introduced != "" && r.introduced != "0" && compareVersions(scheme, version, r.introduced) < 0 {
+		return false
+	}
+	if r.fixed != "" && compareVersions(scheme, version, r.fixed) >= 0 {
+		return false
+	}
+	if r.lastAffected != "" && compareVersions(scheme, version, r.lastAffected) > 0 {
+		return false
+	}
+	return true
+}
+
+// cpeBounds is the version constraint of one NVD CPE match.
+type cpeBounds struct {
+	version        string // exact CPE version, or "*" / "-" for any
+	startIncluding string
+	startExcluding string
+	endIncluding   string
+	endExcluding   string
+}
+
+// hasBounds reports whether b constrains the version at all. A "*" match
+// without bounds means "every version" and is too broad to report per
+// machine.
+func (b cpeBounds) hasBounds() bool {
+	return (b.version != "*" && b.version != "-" && b.version != "") ||
+		b.startIncluding != "" || b.startExcluding != "" ||
+		b.endIncluding != "" || b.endExcluding != ""
+}
+
+// affects reports whether version satisfies the CPE match.
+func (b cpeBounds) affects(version string) bool {
+	if !b.hasBounds() {
+		return false
+	}
+	if b.version != "*" && b.version != "-" && b.version != "" {
+		return compareSemver(version, b.version) == 0
+	}
+	if b.startIncluding != "" && compareSemver(version, b.startIncluding) < 0 {
+		return false
+	}
+	if b.startExcluding != "" && compareSemver(version, b.startExcluding) <= 0 {
+		return false
+	}
+	if b.endIncluding != "" && compareSemver(version, b.endIncluding) > 0 {
+		return false
+	}
+	if b.endExcluding != "" && compareSemver(version, b.endExcluding) >= 0 {
+		return false
+	}
+	return true
+}
+
+// cpeKey identifies a CPE vendor/product pair.
+type cpeKey struct {
+	vendor  string
+	product string
+}
+
+var (
+	// nameNoiseRe strips version numbers, architectures and bitness from
+	// display names such as "Mozilla Firefox 128.0 (x64 en-US)".
+	nameNoiseRe = regexp.MustCompile(`\(.*?\)|\bv?\d+(\.\d+)+\b|\b(x64|x86|amd64|arm64|64-bit|32-bit)\b`)
+	nameSepRe   = regexp.MustCompile(`[^a-z0-9+]+`)
+)
+
+// cpeCandidates derives the CPE vendor/product pairs an installed software
+// name may be listed under in NVD. "Google Chrome" yields google:chrome and
+// the bare product google_chrome; "openssl" yields the product openssl with
+// any vendor.
+func cpeCandidates(name string) (pairs []cpeKey, products []string) {
+	lower := strings.ToLower(name)
+	lower = nameNoiseRe.ReplaceAllString(lower, " ")
+	words := strings.Fields(nameSepRe.ReplaceAllString(lower, " "))
+	if len(words) == 0 {
+		return nil, nil
+	}
+
+	products = append(products, strings.Join(words, "_"))
+	if len(words) > 1 {
+		pairs = append(pairs, cpeKey{vendor: words[0], product: strings.Join(words[1:], "_")})
+	}
+	return pairs, products
+}
+
+// normalizeSeverity maps CVSS and distro severity labels to
+// critical/high/medium/low/unknown.
+func normalizeSeverity(s string) string {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "critical":
+		return "critical"
+	case "high", "important":
+		return "high"
+	case "medium", "moderate":
+		return "medium"
+	case "low", "negligible":
+		return "low"
+	}
+	return "unknown"
+}
+
+// severityRank orders severities for picking the worst of several advisories.
+func severityRank(s string) int {
+	switch s {
+	case "critical":
+		return 4
+	case "high":
+		return 3
+	case "medium":
+		return 2
+	case "low":
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,103 @@
+package vulnerability
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseDistro(t *testing.T) {
+	tests := []struct {
+		osName     string
+		ecosystem  string
+		wantScheme string
+		wantMatch  bool
+	}{
+		{"Ubuntu 22.04.5 LTS", "Ubuntu:22.04:LTS", SchemeDeb, true},
+		{"Ubuntu 22.04.5 LTS", "Ubuntu:Pro:22.04:LTS", SchemeDeb, true},
+		{"Ubuntu 22.04.5 LTS", "Ubuntu:24.04:LTS", SchemeDeb, false},
+		{"Debian GNU/Linux 12 (bookworm)", "Debian:12", SchemeDeb, true},
+		{"Debian GNU/Linux 12 (bookworm)", "Debian:11", SchemeDeb, false},
+		{"Rocky Linux release 9.4 (Blue Onyx)", "Rocky Linux:9", SchemeRPM, true},
+		{"Red Hat Enterprise Linux release 9.3 (Plow)", "AlmaLinux:9", SchemeRPM, true},
+		{"Oracle Linux Server 8.9", "Rocky Linux:8", SchemeRPM, true},
+		{"AlmaLinux release 8.10", "AlmaLinux:9", SchemeRPM, false},
+	}
+	for _, tt := range tests {
+		d := parseDistro(tt.osName)
+		if d == nil {
+			t.Errorf("parseDistro(%q) = nil", tt.osName)
+			continue
+		}
+		if d.scheme != tt.wantScheme {
+			t.Errorf("parseDistro(%q).scheme = %q, want %q", tt.osName, d.scheme, tt.wantScheme)
+		}
+		if got := d.matchesEcosystem(tt.ecosystem); got != tt.wantMatch {
+			t.Errorf("parseDistro(%q).matchesEcosystem(%q) = %v, want %v", tt.osName, tt.ecosystem, got, tt.wantMatch)
+		}
+	}
+
+	if d := parseDistro("Red Hat Enterprise Linux release 9.3 (Plow)"); d == nil || !d.rebuild {
+		t.Errorf("RHEL should be matched as a rebuild: %+v", d)
+	}
+	if d := parseDistro("AlmaLinux release 9.4"); d == nil || d.rebuild {
+		t.Errorf("AlmaLinux should match its own advisories: %+v", d)
+	}
+
+	for _, osName := range []string{"Windows 11 Pro (Build 26200)", "macOS 15.7.4", "", "CentOS Stream release 9", "CentOS Linux release 7.9.2009 (Core)"} {
+		if d := parseDistro(osName); d != nil {
+			t.Errorf("parseDistro(%q) = %+v, want nil", osName, d)
+		}
+	}
+}
+
+func TestVersionRangeAffects(t *testing.T) {
+	tests := []struct {
+		name    string
+		rng     versionRange
+		version string
+		want    bool
+	}{
+		{"before fix", versionRange{introduced: "0", fixed: "3.0.2-0ubuntu1.15"}, "3.0.2-0ubuntu1.10", true},
+		{"at fix", versionRange{introduced: "0", fixed: "3.0.2-0ubuntu1.15"}, "3.0.2-0ubuntu1.15", false},
+		{"before introduced", versionRange{introduced: "3.0.0-1", fixed: "3.0.5-1"}, "2.9.0-1", false},
+		{"last affected", versionRange{introduced: "0", lastAffected: "1.2-1"}, "1.2-1", true},
+		{"past last affected", versionRange{introduced: "0", lastAffected: "1.2-1"}, "1.3-1", false},
+		{"no fix", versionRange{introduced: "0"}, "9.9-1", true},
+		{"explicit versions", versionRange{versions: []string{"1.0-1", "1.1-1"}}, "1.1-1", true},
+		{"not in versions", versionRange{versions: []string{"1.0-1"}}, "1.2-1", false},
+	}
+	for _, tt := range tests {
+		if got := tt.rng.affects(SchemeDeb, tt.version); got != tt.want {
+			t.Errorf("%s: affects(%q) = %v, want %v", tt.name, tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestCPEBoundsAffects(t *testing.T) {
+	openssl := cpeBounds{version: "*", startIncluding: "3.0.0", endExcluding: "3.0.7"}
+	if !openssl.affects("3.0.2") || openssl.affects("3.0.7") || openssl.affects("1.1.1t") {
+		t.Error("range bounds not applied")
+	}
+	exact := cpeBounds{version: "2.4.49"}
+	if !exact.affects("2.4.49") || exact.affects("2.4.50") {
+		t.Error("exact version not applied")
+	}
+	if (cpeBounds{version: "*"}).affects("1.0") {
+		t.Error("unbounded match reported")
+	}
+}
+
+func TestCPECandidates(t *testing.T) {
+	pairs, products := cpeCandidates("Mozilla Firefox 128.0 (x64 en-US)")
+	if !slices.Contains(pairs, cpeKey{vendor: "mozilla", product: "firefox"}) {
+		t.Errorf("pairs = %v, want mozilla:firefox", pairs)
+	}
+	if !slices.Contains(products, "mozilla_firefox") {
+		t.Errorf("products = %v", products)
+	}
+
+	pairs, products = cpeCandidates("openssl")
+	if len(pairs) != 0 || !slices.Equal(products, []string{"openssl"}) {
+		t.Errorf("openssl: pairs = %v, products = %v", pairs, products)
+	}
+}
@@ -0,0 +1,17 @@
+package vulnerability
+
+import (
+	"database/sql"
+
+	"go.temporal.io/sdk/worker"
+
+	"danny.vn/hotpot/pkg/base/config"
+)
+
+// Register wires vulnerability detection activities and workflow to the worker.
+func Register(w worker.Worker, configService *config.Service, db *sql.DB) {
+	activities := NewActivities(configService, db)
+	w.RegisterActivity(activities.MatchVulnerabilities)
+	w.RegisterActivity(activities.CleanupStaleVulnerabilities)
+	w.RegisterWorkflow(VulnerabilityWorkflow)
+}
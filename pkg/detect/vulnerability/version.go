@@ -0,0 +1,372 @@
+package vulnerability
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Version schemes used to compare installed versions with advisory bounds.
+const (
+	SchemeDeb    = "deb"    // dpkg: [epoch:]upstream[-revision]
+	SchemeRPM    = "rpm"    // rpm: [epoch:]version[-release]
+	SchemeSemver = "semver" // semver and other dotted versions (language packages, NVD)
+)
+
+// compareVersions returns -1, 0 or 1 as a is older than, equal to or newer
+// than b under scheme.
+func compareVersions(scheme, a, b string) int {
+	switch scheme {
+	case SchemeDeb:
+		return compareDeb(a, b)
+	case SchemeRPM:
+		return compareRPM(a, b)
+	default:
+		return compareSemver(a, b)
+	}
+}
+
+// --- Debian ---
+
+// compareDeb implements dpkg's version ordering (deb-version(7)).
+func compareDeb(a, b string) int {
+	ea, ua, ra := splitDeb(a)
+	eb, ub, rb := splitDeb(b)
+	if c := compareInt(ea, eb); c != 0 {
+		return c
+	}
+	if c := debVerRevCmp(ua, ub); c != 0 {
+		return c
+	}
+	return debVerRevCmp(ra, rb)
+}
+
+func splitDeb(v string) (epoch int, upstream, revision string) {
+	if i := strings.IndexByte(v, ':'); i >= 0 {
+		epoch, _ = strconv.Atoi(v[:i])
+		v = v[i+1:]
+	}
+	if i := strings.LastIndexByte(v, '-'); i >= 0 {
+		return epoch, v[:i], v[i+1:]
+	}
+	return epoch, v, ""
+}
+
+// debOrder ranks a non-digit character: '~' sorts before everything, even
+// the end of the string, and letters sort before other characters.
+func debOrder(c byte) int {
+	switch {
+	case c == '~':
+		return -1
+	case isDigit(c):
+		return 0
+	case isAlpha(c):
+		return int(c)
+	default:
+		return int(c) + 256
+	}
+}
+
+func debVerRevCmp(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		// Non-digit prefix, compared character by character.
+		for (i < len(a) && !isDigit(a[i])) || (j < len(b) && !isDigit(b[j])) {
+			ac, bc := 0, 0
+			if i < len(a) {
+				ac = debOrder(a[i])
+			}
+			if j < len(b) {
+				bc = debOrder(b[j])
+			}
+			if ac != bc {
+				return sign(ac - bc)
+			}
+			i++
+			j++
+		}
+		// Digit run, compared numerically.
+		for i < len(a) && a[i] == '0' {
+			i++
+		}
+		for j < len(b) && b[j] == '0' {
+			j++
+		}
+		first := 0
+		for i < len(a) && isDigit(a[i]) && j < len(b) && isDigit(b[j]) {
+			if first == 0 {
+				first = int(a[i]) - int(b[j])
+			}
+			i++
+			j++
+		}
+		if i < len(a) && isDigit(a[i]) {
+			return 1
+		}
+		if j < len(b) && isDigit(b[j]) {
+			return -1
+		}
+		if first != 0 {
+			return sign(first)
+		}
+	}
+	return 0
+}
+
+// --- RPM ---
+
+// compareRPM compares epoch:version-release. A release missing on either
+// side is ignored, as rpm does when matching a bare version.
+func compareRPM(a, b string) int {
+	ea, va, ra := splitRPM(a)
+	eb, vb, rb := splitRPM(b)
+	if c := compareInt(ea, eb); c != 0 {
+		return c
+	}
+	if c := rpmVerCmp(va, vb); c != 0 {
+		return c
+	}
+	if ra == "" || rb == "" {
+		return 0
+	}
+	return rpmVerCmp(ra, rb)
+}
+
+func splitRPM(v string) (epoch int, version, release string) {
+	if i := strings.IndexByte(v, ':'); i >= 0 {
+		epoch, _ = strconv.Atoi(v[:i])
+		v = v[i+1:]
+	}
+	if i := strings.LastIndexByte(v, '-'); i >= 0 {
+		return epoch, v[:i], v[i+1:]
+	}
+	return epoch, v, ""
+}
+
+// rpmVerCmp implements rpmvercmp: alternating numeric and alphabetic
+// segments, separators ignored, numeric segments newer than alphabetic
+// ones, '~' sorting before and '^' after the end of the string.
+func rpmVerCmp(a, b string) int {
+	if a == b {
+		return 0
+	}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		for i < len(a) && !isAlnum(a[i]) && a[i] != '~' && a[i] != '^' {
+			i++
+		}
+		for j < len(b) && !isAlnum(b[j]) && b[j] != '~' && b[j] != '^' {
+			j++
+		}
+
+		// Tilde: pre-release, older than anything else.
+		if (i < len(a) && a[i] == '~') || (j < len(b) && b[j] == '~') {
+			if i >= len(a) || a[i] != '~' {
+				return 1
+			}
+			if j >= len(b) || b[j] != '~' {
+				return -1
+			}
+			i++
+			j++
+			continue
+		}
+
+		// Caret: post-release, newer than the end of the string only.
+		if (i < len(a) && a[i] == '^') || (j < len(b) && b[j] == '^') {
+			if i >= len(a) {
+				return -1
+			}
+			if j >= len(b) {
+				return 1
+			}
+			if a[i] != '^' {
+				return 1
+			}
+			if b[j] != '^' {
+				return -1
+			}
+			i++
+			j++
+			continue
+		}
+
+		if i >= len(a) || j >= len(b) {
+			break
+		}
+
+		numeric := isDigit(a[i])
+		si, sj := i, j
+		if numeric {
+			for i < len(a) && isDigit(a[i]) {
+				i++
+			}
+			for j < len(b) && isDigit(b[j]) {
+				j++
+			}
+		} else {
+			for i < len(a) && isAlpha(a[i]) {
+				i++
+			}
+			for j < len(b) && isAlpha(b[j]) {
+				j++
+			}
+		}
+		segA, segB := a[si:i], b[sj:j]
+
+		// Segments of different types: numeric is newer.
+		if segB == "" {
+			if numeric {
+				return 1
+			}
+			return -1
+		}
+
+		if numeric {
+			segA = strings.TrimLeft(segA, "0")
+			segB = strings.TrimLeft(segB, "0")
+			if len(segA) != len(segB) {
+				return sign(len(segA) - len(segB))
+			}
+		}
+		if c := strings.Compare(segA, segB); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case i >= len(a) && j >= len(b):
+		return 0
+	case i >= len(a):
+		return -1
+	default:
+		return 1
+	}
+}
+
+// --- Semver ---
+
+// compareSemver orders semver-like versions: an optional "v" prefix and
+// build metadata are ignored, missing core components count as zero, and a
+// pre-release sorts before its release. Core components that are not plain
+// numbers (e.g. OpenSSL's "1t") compare by numeric prefix, then suffix.
+func compareSemver(a, b string) int {
+	ca, pa := splitSemver(a)
+	cb, pb := splitSemver(b)
+
+	partsA := strings.Split(ca, ".")
+	partsB := strings.Split(cb, ".")
+	for k := 0; k < max(len(partsA), len(partsB)); k++ {
+		var x, y string
+		if k < len(partsA) {
+			x = partsA[k]
+		}
+		if k < len(partsB) {
+			y = partsB[k]
+		}
+		if c := compareCoreComponent(x, y); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case pa == "" && pb == "":
+		return 0
+	case pa == "":
+		return 1
+	case pb == "":
+		return -1
+	}
+	return comparePrerelease(pa, pb)
+}
+
+func splitSemver(v string) (core, prerelease string) {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if i := strings.IndexByte(v, '+'); i >= 0 {
+		v = v[:i]
+	}
+	if i := strings.IndexByte(v, '-'); i >= 0 {
+		return v[:i], v[i+1:]
+	}
+	return v, ""
+}
+
+func compareCoreComponent(x, y string) int {
+	nx, sx := splitNumericPrefix(x)
+	ny, sy := splitNumericPrefix(y)
+	if c := compareNumericString(nx, ny); c != 0 {
+		return c
+	}
+	return strings.Compare(sx, sy)
+}
+
+func comparePrerelease(a, b string) int {
+	idsA := strings.Split(a, ".")
+	idsB := strings.Split(b, ".")
+	for k := 0; k < len(idsA) && k < len(idsB); k++ {
+		x, y := idsA[k], idsB[k]
+		xNum, yNum := isNumeric(x), isNumeric(y)
+		var c int
+		switch {
+		case xNum && yNum:
+			c = compareNumericString(x, y)
+		case xNum:
+			c = -1
+		case yNum:
+			c = 1
+		default:
+			c = strings.Compare(x, y)
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return sign(len(idsA) - len(idsB))
+}
+
+// --- Helpers ---
+
+func splitNumericPrefix(s string) (num, rest string) {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return s[:i], s[i:]
+}
+
+// compareNumericString compares digit strings of any length; empty is zero.
+func compareNumericString(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if len(a) != len(b) {
+		return sign(len(a) - len(b))
+	}
+	return strings.Compare(a, b)
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func compareInt(a, b int) int { return sign(a - b) }
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+	return 0
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+func isAlpha(c byte) bool { return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') }
+func isAlnum(c byte) bool { return isDigit(c) || isAlpha(c) }
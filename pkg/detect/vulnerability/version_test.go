@@ -0,0 +1,53 @@
+package vulnerability
+
+import "testing"
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		scheme string
+		a, b   string
+		want   int
+	}{
+		// Debian / Ubuntu
+		{SchemeDeb, "1.2.3-1ubuntu1", "1.2.3-1ubuntu1", 0},
+		{SchemeDeb, "3.0.2-0ubuntu1.10", "3.0.2-0ubuntu1.15", -1},
+		{SchemeDeb, "3.0.2-0ubuntu1.15", "3.0.2-0ubuntu1.9", 1},
+		{SchemeDeb, "1:1.0-1", "2.0-1", 1},
+		{SchemeDeb, "1.0~rc1-1", "1.0-1", -1},
+		{SchemeDeb, "1.0-1", "1.0-1+deb12u1", -1},
+		{SchemeDeb, "2.36-9+deb12u4", "2.36-9+deb12u10", -1},
+		{SchemeDeb, "1.0a", "1.0", 1},
+
+		// RPM
+		{SchemeRPM, "1.1.1k-7.el8_6", "1.1.1k-12.el8_9", -1},
+		{SchemeRPM, "3.0.7-25.el9_3", "3.0.7-25.el9_3", 0},
+		{SchemeRPM, "1:3.0.7-24.el9", "3.0.7-27.el9", 1},
+		{SchemeRPM, "2.0~beta1-1", "2.0-1", -1},
+		{SchemeRPM, "1.0^git1-1", "1.0-1", 1},
+		{SchemeRPM, "1.10-1", "1.9-1", 1},
+		{SchemeRPM, "1.0a-1", "1.0.1-1", -1},
+		{SchemeRPM, "5.14.0-362.8.1.el9_3", "5.14.0", 0},
+
+		// Semver and dotted versions
+		{SchemeSemver, "1.2.3", "1.2.3", 0},
+		{SchemeSemver, "v1.2.3", "1.2.3", 0},
+		{SchemeSemver, "1.2", "1.2.0", 0},
+		{SchemeSemver, "1.10.0", "1.9.0", 1},
+		{SchemeSemver, "1.0.0-rc.1", "1.0.0", -1},
+		{SchemeSemver, "1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{SchemeSemver, "1.0.0-2", "1.0.0-beta", -1},
+		{SchemeSemver, "1.0.0+build5", "1.0.0", 0},
+		{SchemeSemver, "1.1.1t", "1.1.1u", -1},
+		{SchemeSemver, "1.1.1", "1.1.1t", -1},
+		{SchemeSemver, "128.0.6613.120", "128.0.6613.84", 1},
+	}
+
+	for _, tt := range tests {
+		if got := compareVersions(tt.scheme, tt.a, tt.b); got != tt.want {
+			t.Errorf("compareVersions(%s, %q, %q) = %d, want %d", tt.scheme, tt.a, tt.b, got, tt.want)
+		}
+		if got := compareVersions(tt.scheme, tt.b, tt.a); got != -tt.want {
+			t.Errorf("compareVersions(%s, %q, %q) = %d, want %d", tt.scheme, tt.b, tt.a, got, -tt.want)
+		}
+	}
+}
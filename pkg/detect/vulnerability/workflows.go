@@ -0,0 +1,64 @@
+package vulnerability
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// VulnerabilityResult holds the combined result of the workflow.
+type VulnerabilityResult struct {
+	MatchResult   MatchVulnerabilitiesResult
+	CleanupResult CleanupStaleVulnerabilitiesResult
+}
+
+// VulnerabilityWorkflow matches installed software against the vulnerability
+// reference feeds and resolves findings that are no longer present.
+func VulnerabilityWorkflow(ctx workflow.Context) (*VulnerabilityResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting VulnerabilityWorkflow")
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	runTimestamp := workflow.Now(ctx)
+
+	// 1. Match installed software.
+	var matchResult MatchVulnerabilitiesResult
+	if err := workflow.ExecuteActivity(activityCtx, MatchVulnerabilitiesActivity,
+		MatchVulnerabilitiesParams{RunTimestamp: runTimestamp}).Get(ctx, &matchResult); err != nil {
+		return nil, err
+	}
+	logger.Info("MatchVulnerabilities done", "osv", matchResult.OSVFindings, "nvd", matchResult.NVDFindings)
+
+	// 2. Cleanup stale rows.
+	var cleanupResult CleanupStaleVulnerabilitiesResult
+	if err := workflow.ExecuteActivity(activityCtx, CleanupStaleVulnerabilitiesActivity,
+		CleanupStaleVulnerabilitiesParams{RunTimestamp: runTimestamp}).Get(ctx, &cleanupResult); err != nil {
+		return nil, err
+	}
+	logger.Info("CleanupStaleVulnerabilities done", "resolved", cleanupResult.Resolved, "purged", cleanupResult.Purged)
+
+	result := &VulnerabilityResult{
+		MatchResult:   matchResult,
+		CleanupResult: cleanupResult,
+	}
+
+	logger.Info("VulnerabilityWorkflow complete",
+		"osv", matchResult.OSVFindings,
+		"nvd", matchResult.NVDFindings,
+		"machines", matchResult.Machines,
+		"resolved", cleanupResult.Resolved)
+
+	return result, nil
+}
@@ -50,6 +50,15 @@ type LogSourceInfo struct {
 	FieldMapping    map[string]string
 	IntervalMinutes int
 
+	// File log settings (filelog sources).
+	Path    string
+	Pattern string
+	Format  string
+	Region  string
+
+	// ReceiverHost runs the push receiver (httppush sources).
+	ReceiverHost string
+
 	// Backfill settings (global, applied to all sources).
 	BackfillDays            int
 	BackfillIntervalMinutes int
@@ -62,6 +71,7 @@ func (a *Activities) DiscoverLogSources(ctx context.Context) (*DiscoverLogSource
 	sources := a.configService.AccessLogSources()
 	backfillDays := a.configService.AccessLogBackfillDays()
 	backfillInterval := a.configService.AccessLogBackfillIntervalMinutes()
+	receiverHost := a.configService.AccessLogReceiverHost()
 
 	result := &DiscoverLogSourcesResult{
 		Sources: make([]LogSourceInfo, 0, len(sources)),
@@ -76,6 +86,11 @@ func (a *Activities) DiscoverLogSources(ctx context.Context) (*DiscoverLogSource
 			BQFilter:                s.BQFilter,
 			FieldMapping:            s.FieldMapping,
 			IntervalMinutes:         s.IntervalMinutes,
+			Path:                    s.Path,
+			Pattern:                 s.Pattern,
+			Format:                  s.Format,
+			Region:                  s.Region,
+			ReceiverHost:            receiverHost,
 			BackfillDays:            backfillDays,
 			BackfillIntervalMinutes: backfillInterval,
 		})
@@ -0,0 +1,117 @@
+package filelog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/awsauth"
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/base/temporalerr"
+	"danny.vn/hotpot/pkg/ingest/accesslog"
+	entaccesslog "danny.vn/hotpot/pkg/storage/ent/accesslog"
+)
+
+// Activities holds dependencies for file log traffic activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entaccesslog.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates an Activities instance.
+func NewActivities(configService *config.Service, entClient *entaccesslog.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// IngestFileLogsActivity function reference for Temporal registration.
+var IngestFileLogsActivity = (*Activities).IngestFileLogs
+
+// IngestFileLogs reads access log files and stores aggregated traffic counts.
+func (a *Activities) IngestFileLogs(ctx context.Context, params accesslog.ServiceWorkflowParams) (*accesslog.ServiceWorkflowResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Ingesting file logs",
+		"name", params.Name,
+		"path", params.Path,
+		"format", params.Format)
+
+	parser, err := ParserFor(params.Format)
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("source %q: %w", params.Name, err))
+	}
+
+	source, err := a.createSource(ctx, params)
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("source %q: %w", params.Name, err))
+	}
+
+	svc := NewService(source, parser, a.entClient)
+	result, err := svc.Ingest(ctx, IngestParams{
+		Name:            params.Name,
+		SourceType:      params.SourceType,
+		Role:            params.Role,
+		Path:            params.Path,
+		FieldMapping:    params.FieldMapping,
+		IntervalMinutes: params.IntervalMinutes,
+		BackfillDays:    params.BackfillDays,
+		Progress: func(file string) {
+			activity.RecordHeartbeat(ctx, file)
+		},
+	})
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(err)
+	}
+
+	logger.Info("File log ingestion complete",
+		"name", result.Name,
+		"files", result.FilesRead,
+		"windows", result.WindowsIngested,
+		"counts", result.CountsCreated)
+
+	return &accesslog.ServiceWorkflowResult{
+		Name:   result.Name,
+		Counts: result.CountsCreated,
+	}, nil
+}
+
+// createSource returns the file source of params.Path: an S3 prefix for
+// s3:// paths, otherwise a local directory.
+func (a *Activities) createSource(ctx context.Context, params accesslog.ServiceWorkflowParams) (Source, error) {
+	pattern := params.Pattern
+	if pattern == "" {
+		pattern = "*"
+	}
+
+	if strings.HasPrefix(params.Path, "s3://") {
+		bucket, prefix, ok := parseS3Path(params.Path)
+		if !ok {
+			return nil, fmt.Errorf("invalid path %q", params.Path)
+		}
+		if params.Region == "" {
+			return nil, fmt.Errorf("region is required for %s", params.Path)
+		}
+		cfg, err := awsauth.LoadConfig(ctx, a.configService, a.limiter, "", params.Region)
+		if err != nil {
+			return nil, fmt.Errorf("load aws config: %w", err)
+		}
+		return &s3Source{
+			client:  s3.NewFromConfig(cfg),
+			bucket:  bucket,
+			prefix:  prefix,
+			pattern: pattern,
+		}, nil
+	}
+
+	if params.Path == "" {
+		return nil, fmt.Errorf("path is required")
+	}
+	return &dirSource{dir: params.Path, pattern: pattern}, nil
+}
@@ -0,0 +1,216 @@
+package filelog
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"danny.vn/hotpot/pkg/ingest/accesslog"
+)
+
+// Supported log formats.
+const (
+	FormatNginx = "nginx" // nginx combined, optionally followed by extra fields
+	FormatJSON  = "json"  // one JSON object per line (nginx escape=json, Envoy json_format)
+	FormatEnvoy = "envoy" // Envoy default text format
+	FormatALB   = "alb"   // AWS Application Load Balancer access logs
+)
+
+// Parser parses one log line into a record keyed by field name. Text
+// parsers emit the standard field names where the format has one, so the
+// default FieldMapping works unchanged, plus the format's own field names.
+type Parser func(line []byte) (accesslog.Record, error)
+
+// ParserFor returns the parser of a format.
+func ParserFor(format string) (Parser, error) {
+	switch format {
+	case FormatNginx:
+		return parseNginx, nil
+	case FormatJSON:
+		return accesslog.ParseJSONRecord, nil
+	case FormatEnvoy:
+		return parseEnvoy, nil
+	case FormatALB:
+		return parseALB, nil
+	}
+	return nil, fmt.Errorf("unsupported log format %q", format)
+}
+
+// parseNginx parses the combined format:
+//
+//	$remote_addr - $remote_user [$time_local] "$request" $status $body_bytes_sent "$http_referer" "$http_user_agent"
+//
+// Fields appended to the format are exposed as extra_1, extra_2, ..., e.g.
+// field_mapping request_time: extra_1 for a trailing $request_time.
+func parseNginx(line []byte) (accesslog.Record, error) {
+	f := splitFields(string(line))
+	if len(f) < 9 {
+		return nil, fmt.Errorf("nginx: expected at least 9 fields, got %d", len(f))
+	}
+	rec := accesslog.Record{
+		"remote_addr":     f[0],
+		"remote_user":     f[2],
+		"time_local":      f[3],
+		"timestamp":       f[3],
+		"request":         f[4],
+		"status":          f[5],
+		"body_bytes_sent": f[6],
+		"http_referer":    f[7],
+		"http_user_agent": f[8],
+	}
+	setRequestLine(rec, f[4])
+	for i, v := range f[9:] {
+		rec["extra_"+strconv.Itoa(i+1)] = v
+	}
+	return rec, nil
+}
+
+// parseEnvoy parses Envoy's default access log format:
+//
+//	[%START_TIME%] "%REQ(:METHOD)% %REQ(X-ENVOY-ORIGINAL-PATH?:PATH)% %PROTOCOL%"
+//	%RESPONSE_CODE% %RESPONSE_FLAGS% %BYTES_RECEIVED% %BYTES_SENT% %DURATION%
+//	%RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)% "%REQ(X-FORWARDED-FOR)%" "%REQ(USER-AGENT)%"
+//	"%REQ(X-REQUEST-ID)%" "%REQ(:AUTHORITY)%" "%UPSTREAM_HOST%"
+//
+// The default format has no downstream address, so the client IP is the
+// first X-Forwarded-For entry. DURATION is converted to seconds.
+func parseEnvoy(line []byte) (accesslog.Record, error) {
+	f := splitFields(string(line))
+	if len(f) < 13 {
+		return nil, fmt.Errorf("envoy: expected at least 13 fields, got %d", len(f))
+	}
+	rec := accesslog.Record{
+		"start_time":            f[0],
+		"timestamp":             f[0],
+		"request":               f[1],
+		"response_code":         f[2],
+		"status":                f[2],
+		"response_flags":        f[3],
+		"bytes_received":        f[4],
+		"bytes_sent":            f[5],
+		"body_bytes_sent":       f[5],
+		"duration":              f[6],
+		"upstream_service_time": f[7],
+		"x_forwarded_for":       f[8],
+		"remote_addr":           f[8],
+		"user_agent":            f[9],
+		"http_user_agent":       f[9],
+		"request_id":            f[10],
+		"authority":             f[11],
+		"http_host":             f[11],
+		"upstream_host":         f[12],
+	}
+	setRequestLine(rec, f[1])
+	if ms, err := strconv.ParseFloat(f[6], 64); err == nil {
+		rec["request_time"] = strconv.FormatFloat(ms/1000, 'f', -1, 64)
+	}
+	return rec, nil
+}
+
+// albFields names the space-separated fields of an ALB access log entry, in
+// order. Fields added by later log versions are ignored.
+var albFields = []string{
+	"type", "time", "elb", "client", "target",
+	"request_processing_time", "target_processing_time", "response_processing_time",
+	"elb_status_code", "target_status_code", "received_bytes", "sent_bytes",
+	"request", "user_agent", "ssl_cipher", "ssl_protocol", "target_group_arn",
+	"trace_id", "domain_name", "chosen_cert_arn", "matched_rule_priority",
+	"request_creation_time", "actions_executed", "redirect_url", "error_reason",
+	"target_port_list", "target_status_code_list", "classification", "classification_reason",
+}
+
+// parseALB parses an AWS ALB access log entry. The request URL is absolute
+// ("GET https://api.example.com:443/v1/users?id=1 HTTP/1.1"), so the path and
+// host are split out of it, and request_time is the sum of the three
+// processing times (-1 when the request never reached a target).
+func parseALB(line []byte) (accesslog.Record, error) {
+	f := splitFields(string(line))
+	if len(f) < 13 {
+		return nil, fmt.Errorf("alb: expected at least 13 fields, got %d", len(f))
+	}
+	rec := make(accesslog.Record, len(albFields)+8)
+	for i, name := range albFields {
+		if i < len(f) {
+			rec[name] = f[i]
+		}
+	}
+	rec["timestamp"] = rec["time"]
+	rec["status"] = rec["elb_status_code"]
+	rec["body_bytes_sent"] = rec["sent_bytes"]
+	rec["remote_addr"] = rec["client"]
+	rec["http_user_agent"] = rec["user_agent"]
+	setRequestLine(rec, rec["request"])
+	if u, err := url.Parse(rec["uri"]); err == nil && u.Host != "" {
+		rec["uri"] = u.EscapedPath()
+		rec["http_host"] = u.Hostname()
+	}
+
+	var total float64
+	for _, name := range []string{"request_processing_time", "target_processing_time", "response_processing_time"} {
+		if v, err := strconv.ParseFloat(rec[name], 64); err == nil && v > 0 {
+			total += v
+		}
+	}
+	rec["request_time"] = strconv.FormatFloat(total, 'f', -1, 64)
+	return rec, nil
+}
+
+// setRequestLine splits "METHOD URI PROTOCOL" into method, uri and protocol.
+func setRequestLine(rec accesslog.Record, request string) {
+	parts := strings.Fields(request)
+	if len(parts) >= 2 {
+		rec["method"] = parts[0]
+		rec["uri"] = parts[1]
+	}
+	if len(parts) >= 3 {
+		rec["protocol"] = parts[2]
+	}
+}
+
+// splitFields splits a log line on spaces, keeping "double-quoted" and
+// [bracketed] fields whole without their delimiters. Backslash escapes
+// inside quotes are kept as-is except for \" and \\.
+func splitFields(line string) []string {
+	var fields []string
+	i := 0
+	for i < len(line) {
+		for i < len(line) && line[i] == ' ' {
+			i++
+		}
+		if i >= len(line) {
+			break
+		}
+		switch line[i] {
+		case '"':
+			var b strings.Builder
+			i++
+			for i < len(line) && line[i] != '"' {
+				if line[i] == '\\' && i+1 < len(line) && (line[i+1] == '"' || line[i+1] == '\\') {
+					i++
+				}
+				b.WriteByte(line[i])
+				i++
+			}
+			i++ // closing quote
+			fields = append(fields, b.String())
+		case '[':
+			end := strings.IndexByte(line[i:], ']')
+			if end < 0 {
+				fields = append(fields, line[i+1:])
+				i = len(line)
+				continue
+			}
+			fields = append(fields, line[i+1:i+end])
+			i += end + 1
+		default:
+			end := strings.IndexByte(line[i:], ' ')
+			if end < 0 {
+				end = len(line) - i
+			}
+			fields = append(fields, line[i:i+end])
+			i += end
+		}
+	}
+	return fields
+}
@@ -0,0 +1,168 @@
+package filelog
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"danny.vn/hotpot/pkg/ingest/accesslog"
+)
+
+func TestParsers(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		fm     map[string]string
+		line   string
+		want   accesslog.Entry
+	}{
+		{
+			name:   "nginx combined with request_time",
+			format: FormatNginx,
+			fm:     map[string]string{"request_time": "extra_1"},
+			line:   `203.0.113.7 - - [10/Oct/2024:13:55:36 +0000] "GET /v1/users/42?x=1 HTTP/1.1" 200 512 "-" "curl/8.4.0" 0.025`,
+			want: accesslog.Entry{
+				Time: time.Date(2024, 10, 10, 13, 55, 36, 0, time.UTC), URI: "/v1/users/42", Method: "GET",
+				Status: 200, BodyBytes: 512, RequestTime: 0.025, UserAgent: "curl/8.4.0", ClientIP: "203.0.113.7",
+			},
+		},
+		{
+			name:   "json with nested fields",
+			format: FormatJSON,
+			fm:     map[string]string{"uri": "req.path", "remote_addr": "client"},
+			line:   `{"timestamp":"2024-10-10T13:55:36Z","req":{"path":"/health"},"method":"get","status":204,"client":"198.51.100.1"}`,
+			want: accesslog.Entry{
+				Time: time.Date(2024, 10, 10, 13, 55, 36, 0, time.UTC), URI: "/health", Method: "GET",
+				Status: 204, ClientIP: "198.51.100.1",
+			},
+		},
+		{
+			name:   "envoy default",
+			format: FormatEnvoy,
+			line:   `[2024-10-10T13:55:36.123Z] "POST /api/login HTTP/2" 401 - 57 19 12 11 "198.51.100.9, 10.0.0.1" "okhttp/4.9" "b1c2" "api.example.com" "10.0.3.4:8080"`,
+			want: accesslog.Entry{
+				Time: time.Date(2024, 10, 10, 13, 55, 36, 123e6, time.UTC), URI: "/api/login", Method: "POST",
+				Status: 401, BodyBytes: 19, RequestTime: 0.012, HTTPHost: "api.example.com",
+				UserAgent: "okhttp/4.9", ClientIP: "198.51.100.9",
+			},
+		},
+		{
+			name:   "alb",
+			format: FormatALB,
+			line:   `https 2024-10-10T13:55:36.000000Z app/prod-alb/50dc6c495c0c9188 192.0.2.10:46532 10.0.0.66:8080 0.001 0.048 0.002 200 200 34 366 "GET https://api.example.com:443/v1/orders?page=2 HTTP/1.1" "Mozilla/5.0" ECDHE-RSA-AES128-GCM-SHA256 TLSv1.2 arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/tg/73e2d6bc24d8a067 "Root=1-58337262" "api.example.com" "-" 0 2024-10-10T13:55:35.950000Z "forward" "-" "-" "10.0.0.66:8080" "200" "-" "-"`,
+			want: accesslog.Entry{
+				Time: time.Date(2024, 10, 10, 13, 55, 36, 0, time.UTC), URI: "/v1/orders", Method: "GET",
+				Status: 200, BodyBytes: 366, RequestTime: 0.051, HTTPHost: "api.example.com",
+				UserAgent: "Mozilla/5.0", ClientIP: "192.0.2.10",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parse, err := ParserFor(tt.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rec, err := parse([]byte(tt.line))
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			got, err := rec.Entry(tt.fm)
+			if err != nil {
+				t.Fatalf("entry: %v", err)
+			}
+			if !got.Time.Equal(tt.want.Time) {
+				t.Errorf("time = %v, want %v", got.Time, tt.want.Time)
+			}
+			got.Time = tt.want.Time
+			if d := got.RequestTime - tt.want.RequestTime; d > 1e-9 || d < -1e-9 {
+				t.Errorf("request time = %v, want %v", got.RequestTime, tt.want.RequestTime)
+			}
+			got.RequestTime = tt.want.RequestTime
+			if got != tt.want {
+				t.Errorf("entry = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := ParserFor("syslog"); err == nil {
+		t.Error("ParserFor(syslog) succeeded, want error")
+	}
+}
+
+func TestDirSourceAggregate(t *testing.T) {
+	dir := t.TempDir()
+	lines := []string{
+		`203.0.113.7 - - [10/Oct/2024:13:55:36 +0000] "GET /a HTTP/1.1" 200 100 "-" "ua-1"`,
+		`203.0.113.7 - - [10/Oct/2024:13:56:00 +0000] "GET /a HTTP/1.1" 200 50 "-" "ua-2"`,
+		`not a log line`,
+		`198.51.100.1 - - [10/Oct/2024:14:01:00 +0000] "GET /a HTTP/1.1" 404 0 "-" "ua-1"`,
+		`198.51.100.1 - - [10/Oct/2024:14:20:00 +0000] "GET /a HTTP/1.1" 200 0 "-" "ua-1"`, // after end
+	}
+
+	// First two lines in a rotated gzip file, the rest in the live file.
+	gz, err := os.Create(filepath.Join(dir, "access.log.1.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw := gzip.NewWriter(gz)
+	io.WriteString(zw, lines[0]+"\n"+lines[1]+"\n")
+	zw.Close()
+	gz.Close()
+	if err := os.WriteFile(filepath.Join(dir, "access.log"), []byte(lines[2]+"\n"+lines[3]+"\n"+lines[4]+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "error.log"), []byte("ignored\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	src := &dirSource{dir: dir, pattern: "access.log*"}
+	files, err := src.List(ctx, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("files = %v, want 2", files)
+	}
+
+	start := time.Date(2024, 10, 10, 13, 55, 0, 0, time.UTC)
+	agg := accesslog.NewAggregator(5*time.Minute, start, start.Add(15*time.Minute))
+	svc := NewService(src, parseNginx, nil)
+	skipped := 0
+	for _, f := range files {
+		n, err := svc.readFile(ctx, f.Name, nil, agg)
+		if err != nil {
+			t.Fatalf("read %s: %v", f.Name, err)
+		}
+		skipped += n
+	}
+	if skipped != 1 {
+		t.Errorf("skipped = %d, want 1", skipped)
+	}
+
+	windows := agg.Windows()
+	if len(windows) != 2 {
+		t.Fatalf("windows = %d, want 2", len(windows))
+	}
+	first := windows[0]
+	c := first.Counts[accesslog.CountKey{URI: "/a", Method: "GET", Status: 200}]
+	if !first.Start.Equal(start) || c == nil || c.Requests != 2 || c.TotalBodyBytes != 150 {
+		t.Errorf("first window = %v %+v", first.Start, c)
+	}
+	rk := accesslog.RequestKey{URI: "/a", Method: "GET"}
+	if got := first.UserAgents[rk]; len(got) != 2 {
+		t.Errorf("user agents = %v", got)
+	}
+	if got := first.ClientIPs[rk]["203.0.113.7"]; got != 2 {
+		t.Errorf("client ip count = %d, want 2", got)
+	}
+	if c := windows[1].Counts[accesslog.CountKey{URI: "/a", Method: "GET", Status: 404}]; c == nil || c.Requests != 1 {
+		t.Errorf("second window = %+v", windows[1].Counts)
+	}
+}
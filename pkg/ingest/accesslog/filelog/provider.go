@@ -0,0 +1,20 @@
+package filelog
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/accesslog"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider: "accesslog",
+		Name:     "filelog",
+		Scope:    ingest.ScopeRegional,
+		Register: Register,
+		Workflow: FileLogTrafficWorkflow,
+		NewParams: func(_, _, _ string) any {
+			return accesslog.ServiceWorkflowParams{}
+		},
+		NewResult: func() any { return &accesslog.ServiceWorkflowResult{} },
+	})
+}
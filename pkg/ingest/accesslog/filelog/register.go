@@ -0,0 +1,17 @@
+package filelog
+
+import (
+	"go.temporal.io/sdk/worker"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	entaccesslog "danny.vn/hotpot/pkg/storage/ent/accesslog"
+)
+
+// Register registers file log traffic activities and workflows.
+func Register(w worker.Worker, configService *config.Service, entClient *entaccesslog.Client) {
+	limiter := ratelimit.NewLimiter(configService.AccessLogRateLimitPerMinute())
+	activities := NewActivities(configService, entClient, limiter)
+	w.RegisterActivity(activities.IngestFileLogs)
+	w.RegisterWorkflow(FileLogTrafficWorkflow)
+}
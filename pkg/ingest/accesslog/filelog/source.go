@@ -0,0 +1,216 @@
+package filelog
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// FileInfo describes one log file.
+type FileInfo struct {
+	Name    string // path or object key
+	ModTime time.Time
+}
+
+// Source lists and opens the log files of a directory or object-store prefix.
+type Source interface {
+	// List returns the files matching the pattern that were modified at or
+	// after since, oldest first. A file last written before since cannot
+	// hold entries of a later window.
+	List(ctx context.Context, since time.Time) ([]FileInfo, error)
+
+	// Open opens a file for reading, decompressing gzip transparently.
+	Open(ctx context.Context, name string) (io.ReadCloser, error)
+}
+
+// dirSource reads log files from a local directory, e.g. /var/log/nginx
+// with access.log, access.log.1 and access.log.2.gz.
+type dirSource struct {
+	dir     string
+	pattern string
+}
+
+func (s *dirSource) List(_ context.Context, since time.Time) ([]FileInfo, error) {
+	matches, err := filepath.Glob(filepath.Join(s.dir, s.pattern))
+	if err != nil {
+		return nil, fmt.Errorf("glob %s: %w", s.dir, err)
+	}
+	var files []FileInfo
+	for _, m := range matches {
+		st, err := os.Stat(m)
+		if err != nil || !st.Mode().IsRegular() {
+			continue
+		}
+		if st.ModTime().Before(since) {
+			continue
+		}
+		files = append(files, FileInfo{Name: m, ModTime: st.ModTime()})
+	}
+	sortFiles(files)
+	return files, nil
+}
+
+func (s *dirSource) Open(_ context.Context, name string) (io.ReadCloser, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return maybeGunzip(f)
+}
+
+// s3Source reads log objects under an S3 prefix, e.g. the
+// AWSLogs/{account}/elasticloadbalancing/{region}/ prefix an ALB delivers to.
+type s3Source struct {
+	client  *s3.Client
+	bucket  string
+	prefix  string
+	pattern string
+}
+
+// List lists only the day sub-prefixes from since to now when the prefix is
+// date-partitioned (YYYY/MM/DD/, as ALB delivers), so the cost of a run
+// does not grow with the days of logs kept; other prefixes are listed whole.
+func (s *s3Source) List(ctx context.Context, since time.Time) ([]FileInfo, error) {
+	prefixes := []string{s.prefix}
+	if !since.IsZero() {
+		partitioned, err := s.datePartitioned(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if partitioned {
+			prefixes = dayPrefixes(dirPrefix(s.prefix), since, time.Now())
+		}
+	}
+
+	var files []FileInfo
+	for _, prefix := range prefixes {
+		p := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+			Bucket: aws.String(s.bucket),
+			Prefix: aws.String(prefix),
+		})
+		for p.HasMorePages() {
+			page, err := p.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("list s3://%s/%s: %w", s.bucket, prefix, err)
+			}
+			for _, obj := range page.Contents {
+				key := aws.ToString(obj.Key)
+				if ok, _ := path.Match(s.pattern, path.Base(key)); !ok {
+					continue
+				}
+				modTime := aws.ToTime(obj.LastModified)
+				if modTime.Before(since) {
+					continue
+				}
+				files = append(files, FileInfo{Name: key, ModTime: modTime})
+			}
+		}
+	}
+	sortFiles(files)
+	return files, nil
+}
+
+// datePartitioned reports whether the first sub-prefix of the prefix is a
+// year, as in AWSLogs/{account}/elasticloadbalancing/{region}/2025/.
+func (s *s3Source) datePartitioned(ctx context.Context) (bool, error) {
+	base := dirPrefix(s.prefix)
+	out, err := s.client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+		Bucket:    aws.String(s.bucket),
+		Prefix:    aws.String(base),
+		Delimiter: aws.String("/"),
+		MaxKeys:   aws.Int32(1),
+	})
+	if err != nil {
+		return false, fmt.Errorf("list s3://%s/%s: %w", s.bucket, base, err)
+	}
+	if len(out.CommonPrefixes) == 0 {
+		return false, nil
+	}
+	year := strings.TrimSuffix(strings.TrimPrefix(aws.ToString(out.CommonPrefixes[0].Prefix), base), "/")
+	_, err = time.Parse("2006", year)
+	return len(year) == 4 && err == nil, nil
+}
+
+// dirPrefix returns prefix as a "directory", ending in "/" unless empty.
+func dirPrefix(prefix string) string {
+	if prefix == "" || strings.HasSuffix(prefix, "/") {
+		return prefix
+	}
+	return prefix + "/"
+}
+
+// dayPrefixes returns the base+"YYYY/MM/DD/" prefixes (UTC) from the day
+// before since to now. The day is that of the logged interval, so an object
+// written just after midnight can sit in the previous day's prefix.
+func dayPrefixes(base string, since, now time.Time) []string {
+	since, now = since.UTC(), now.UTC()
+	day := time.Date(since.Year(), since.Month(), since.Day()-1, 0, 0, 0, 0, time.UTC)
+	var prefixes []string
+	for ; !day.After(now); day = day.AddDate(0, 0, 1) {
+		prefixes = append(prefixes, base+day.Format("2006/01/02/"))
+	}
+	return prefixes
+}
+
+func (s *s3Source) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get s3://%s/%s: %w", s.bucket, name, err)
+	}
+	return maybeGunzip(out.Body)
+}
+
+// parseS3Path splits "s3://bucket/prefix" into bucket and prefix.
+func parseS3Path(p string) (bucket, prefix string, ok bool) {
+	rest, found := strings.CutPrefix(p, "s3://")
+	if !found {
+		return "", "", false
+	}
+	bucket, prefix, _ = strings.Cut(rest, "/")
+	return bucket, prefix, bucket != ""
+}
+
+func sortFiles(files []FileInfo) {
+	slices.SortFunc(files, func(a, b FileInfo) int {
+		if c := a.ModTime.Compare(b.ModTime); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+// maybeGunzip wraps rc in a gzip reader when it starts with the gzip magic
+// bytes, so rotated access.log.N.gz files and ALB's .log.gz objects read the
+// same as plain files.
+func maybeGunzip(rc io.ReadCloser) (io.ReadCloser, error) {
+	br := bufio.NewReader(rc)
+	magic, _ := br.Peek(2)
+	if len(magic) < 2 || magic[0] != 0x1f || magic[1] != 0x8b {
+		return readCloser{Reader: br, Closer: rc}, nil
+	}
+	zr, err := gzip.NewReader(br)
+	if err != nil {
+		rc.Close()
+		return nil, fmt.Errorf("open gzip: %w", err)
+	}
+	return readCloser{Reader: zr, Closer: rc}, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
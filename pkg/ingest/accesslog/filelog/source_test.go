@@ -0,0 +1,35 @@
+package filelog
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestDayPrefixes(t *testing.T) {
+	since := time.Date(2025, 2, 28, 0, 2, 0, 0, time.UTC)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	got := dayPrefixes("AWSLogs/123/elasticloadbalancing/us-east-1/", since, now)
+	want := []string{
+		"AWSLogs/123/elasticloadbalancing/us-east-1/2025/02/27/",
+		"AWSLogs/123/elasticloadbalancing/us-east-1/2025/02/28/",
+		"AWSLogs/123/elasticloadbalancing/us-east-1/2025/03/01/",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("dayPrefixes = %v, want %v", got, want)
+	}
+
+	// A since in another zone is mapped to its UTC day.
+	since = time.Date(2025, 3, 1, 8, 0, 0, 0, time.FixedZone("ICT", 7*3600))
+	if got := dayPrefixes("", since, now); !slices.Equal(got, []string{"2025/02/28/", "2025/03/01/"}) {
+		t.Errorf("dayPrefixes(ICT) = %v", got)
+	}
+}
+
+func TestDirPrefix(t *testing.T) {
+	for in, want := range map[string]string{"": "", "logs": "logs/", "logs/": "logs/"} {
+		if got := dirPrefix(in); got != want {
+			t.Errorf("dirPrefix(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
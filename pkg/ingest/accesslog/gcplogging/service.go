@@ -8,6 +8,7 @@ import (
 	"log/slog"
 	"time"
 
+	"danny.vn/hotpot/pkg/ingest/accesslog"
 	entaccesslog "danny.vn/hotpot/pkg/storage/ent/accesslog"
 	"danny.vn/hotpot/pkg/storage/ent/accesslog/bronzeaccesslogingestcursor"
 )
@@ -178,101 +179,15 @@ func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResul
 			)
 		}
 
-		// Store HTTP counts.
-		collectedAt := time.Now()
-		for _, row := range httpCounts {
-			resourceID := fmt.Sprintf("%s:%s:%s:%s:%d",
-				params.Name,
-				windowStart.Format(time.RFC3339),
-				row.URI, row.Method, row.StatusCode)
-
-			create := s.entClient.BronzeAccesslogHttpCount.Create().
-				SetID(resourceID).
-				SetSourceID(params.Name).
-				SetWindowStart(windowStart).
-				SetWindowEnd(windowEnd).
-				SetURI(row.URI).
-				SetStatusCode(int(row.StatusCode)).
-				SetRequestCount(row.RequestCount).
-				SetTotalBodyBytesSent(row.TotalBodyBytes).
-				SetCollectedAt(collectedAt).
-				SetFirstCollectedAt(collectedAt)
-
-			if row.Method != "" {
-				create.SetMethod(row.Method)
-			}
-			if row.HTTPHost != "" {
-				create.SetHTTPHost(row.HTTPHost)
-			}
-			if row.TotalRequestTime > 0 {
-				create.SetTotalRequestTime(row.TotalRequestTime)
-			}
-			if row.MaxRequestTime > 0 {
-				create.SetMaxRequestTime(row.MaxRequestTime)
-			}
-
-			if err := create.Exec(ctx); err != nil {
-				if !entaccesslog.IsConstraintError(err) {
-					return nil, fmt.Errorf("create http count %s: %w", resourceID, err)
-				}
-				continue
-			}
-			result.CountsCreated++
-		}
-
-		// Store user agents.
-		for _, row := range userAgents {
-			uaHash := sha256Short(row.UserAgent)
-			uaResourceID := fmt.Sprintf("%s:%s:%s:%s:%s",
-				params.Name, windowStart.Format(time.RFC3339),
-				row.URI, row.Method, uaHash)
-
-			uaCreate := s.entClient.BronzeAccesslogUserAgent.Create().
-				SetID(uaResourceID).
-				SetSourceID(params.Name).
-				SetWindowStart(windowStart).
-				SetWindowEnd(windowEnd).
-				SetURI(row.URI).
-				SetMethod(row.Method).
-				SetUserAgent(row.UserAgent).
-				SetRequestCount(row.RequestCount).
-				SetCollectedAt(collectedAt).
-				SetFirstCollectedAt(collectedAt)
-
-			if err := uaCreate.Exec(ctx); err != nil {
-				if !entaccesslog.IsConstraintError(err) {
-					return nil, fmt.Errorf("create user agent %s: %w", uaResourceID, err)
-				}
-			}
-		}
-
-		// Store client IPs.
-		for _, row := range clientIPs {
-			ipResourceID := fmt.Sprintf("%s:%s:%s:%s:%s",
-				params.Name, windowStart.Format(time.RFC3339),
-				row.URI, row.Method, row.ClientIP)
-
-			ipCreate := s.entClient.BronzeAccesslogClientIp.Create().
-				SetID(ipResourceID).
-				SetSourceID(params.Name).
-				SetWindowStart(windowStart).
-				SetWindowEnd(windowEnd).
-				SetURI(row.URI).
-				SetMethod(row.Method).
-				SetClientIP(row.ClientIP).
-				SetRequestCount(row.RequestCount).
-				SetCollectedAt(collectedAt).
-				SetFirstCollectedAt(collectedAt)
-
-			if err := ipCreate.Exec(ctx); err != nil {
-				if !entaccesslog.IsConstraintError(err) {
-					return nil, fmt.Errorf("create client ip %s: %w", ipResourceID, err)
-				}
-			}
+		created, err := accesslog.StoreWindow(ctx, s.entClient, params.Name,
+			toWindow(windowStart, windowEnd, httpCounts, userAgents, clientIPs))
+		if err != nil {
+			return nil, err
 		}
+		result.CountsCreated += created
 
 		// Upsert cursor after each window.
-		collectedAt = time.Now()
+		collectedAt := time.Now()
 		if cursor != nil {
 			_, err = s.entClient.BronzeAccesslogIngestCursor.UpdateOne(cursor).
 				SetLastWindowEnd(windowEnd).
@@ -297,8 +212,39 @@ func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResul
 	return result, nil
 }
 
-// sha256Short returns the first 8 hex chars of the SHA-256 hash of s.
-func sha256Short(s string) string {
-	h := sha256.Sum256([]byte(s))
-	return hex.EncodeToString(h[:4])
+// toWindow converts the BigQuery aggregates of one window to the shape
+// StoreWindow writes.
+func toWindow(start, end time.Time, httpCounts []HttpCountRow, userAgents []UserAgentRow, clientIPs []ClientIPRow) *accesslog.Window {
+	w := &accesslog.Window{
+		Start:      start,
+		End:        end,
+		Counts:     make(map[accesslog.CountKey]*accesslog.Count, len(httpCounts)),
+		UserAgents: make(map[accesslog.RequestKey]map[string]int64),
+		ClientIPs:  make(map[accesslog.RequestKey]map[string]int64),
+	}
+	for _, row := range httpCounts {
+		w.Counts[accesslog.CountKey{URI: row.URI, Method: row.Method, Status: int(row.StatusCode)}] = &accesslog.Count{
+			Requests:         row.RequestCount,
+			TotalBodyBytes:   row.TotalBodyBytes,
+			TotalRequestTime: row.TotalRequestTime,
+			MaxRequestTime:   row.MaxRequestTime,
+			HTTPHost:         row.HTTPHost,
+		}
+	}
+	for _, row := range userAgents {
+		addCount(w.UserAgents, accesslog.RequestKey{URI: row.URI, Method: row.Method}, row.UserAgent, row.RequestCount)
+	}
+	for _, row := range clientIPs {
+		addCount(w.ClientIPs, accesslog.RequestKey{URI: row.URI, Method: row.Method}, row.ClientIP, row.RequestCount)
+	}
+	return w
+}
+
+func addCount(m map[accesslog.RequestKey]map[string]int64, key accesslog.RequestKey, value string, n int64) {
+	inner, ok := m[key]
+	if !ok {
+		inner = make(map[string]int64)
+		m[key] = inner
+	}
+	inner[value] += n
 }
@@ -0,0 +1,128 @@
+package httppush
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/temporalerr"
+	"danny.vn/hotpot/pkg/ingest/accesslog"
+	entaccesslog "danny.vn/hotpot/pkg/storage/ent/accesslog"
+	"danny.vn/hotpot/pkg/storage/ent/accesslog/bronzeaccesslogingestcursor"
+)
+
+// Activities holds dependencies for push receiver activities.
+type Activities struct {
+	receiver  *Receiver
+	entClient *entaccesslog.Client
+}
+
+// NewActivities creates an Activities instance.
+func NewActivities(receiver *Receiver, entClient *entaccesslog.Client) *Activities {
+	return &Activities{
+		receiver:  receiver,
+		entClient: entClient,
+	}
+}
+
+// FlushPushedLogsActivity function reference for Temporal registration.
+var FlushPushedLogsActivity = (*Activities).FlushPushedLogs
+
+// FlushPushedLogs stores the settled windows the receiver has aggregated for
+// a source and advances the source's cursor.
+func (a *Activities) FlushPushedLogs(ctx context.Context, params accesslog.ServiceWorkflowParams) (*accesslog.ServiceWorkflowResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Flushing pushed access logs", "name", params.Name)
+
+	result, err := a.receiver.Flush(ctx, params.Name, func(ctx context.Context, w *accesslog.Window) (int, error) {
+		activity.RecordHeartbeat(ctx, w.Start)
+		return accesslog.StoreWindow(ctx, a.entClient, params.Name, w)
+	})
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(err)
+	}
+
+	if err := a.updateCursor(ctx, params, result.End); err != nil {
+		return nil, temporalerr.MaybeNonRetryable(err)
+	}
+
+	logger.Info("Pushed access log flush complete",
+		"name", params.Name,
+		"windows", result.Windows,
+		"counts", result.Counts)
+
+	return &accesslog.ServiceWorkflowResult{
+		Name:   params.Name,
+		Counts: result.Counts,
+	}, nil
+}
+
+// updateCursor records the end of the flushed windows, as the pull sources
+// do, so source freshness reads the same for every source type.
+func (a *Activities) updateCursor(ctx context.Context, params accesslog.ServiceWorkflowParams, end time.Time) error {
+	cursor, err := readCursor(ctx, a.entClient, params.Name)
+	if err != nil {
+		return err
+	}
+
+	collectedAt := time.Now()
+	if cursor != nil {
+		_, err = a.entClient.BronzeAccesslogIngestCursor.UpdateOne(cursor).
+			SetRole(params.Role).
+			SetLastWindowEnd(end).
+			SetCollectedAt(collectedAt).
+			Save(ctx)
+	} else {
+		_, err = a.entClient.BronzeAccesslogIngestCursor.Create().
+			SetName(params.Name).
+			SetSourceType(params.SourceType).
+			SetSourceKey(sourceKey(params.Name)).
+			SetRole(params.Role).
+			SetLastWindowEnd(end).
+			SetCollectedAt(collectedAt).
+			SetFirstCollectedAt(collectedAt).
+			Save(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("update cursor for %s: %w", params.Name, err)
+	}
+	return nil
+}
+
+// lastWindowEnd returns the end of the windows stored for a push source, or
+// the zero time if none have been.
+func lastWindowEnd(ctx context.Context, entClient *entaccesslog.Client, name string) (time.Time, error) {
+	cursor, err := readCursor(ctx, entClient, name)
+	if err != nil || cursor == nil {
+		return time.Time{}, err
+	}
+	return cursor.LastWindowEnd, nil
+}
+
+// readCursor returns the cursor of a push source, or nil if it has none.
+func readCursor(ctx context.Context, entClient *entaccesslog.Client, name string) (*entaccesslog.BronzeAccesslogIngestCursor, error) {
+	cursor, err := entClient.BronzeAccesslogIngestCursor.Query().
+		Where(
+			bronzeaccesslogingestcursor.NameEQ(name),
+			bronzeaccesslogingestcursor.SourceTypeEQ(SourceType),
+			bronzeaccesslogingestcursor.SourceKeyEQ(sourceKey(name)),
+		).
+		Only(ctx)
+	if entaccesslog.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read cursor for %s: %w", name, err)
+	}
+	return cursor, nil
+}
+
+// sourceKey is the cursor source key of a push source.
+func sourceKey(name string) string {
+	h := sha256.Sum256([]byte(name))
+	return hex.EncodeToString(h[:8])
+}
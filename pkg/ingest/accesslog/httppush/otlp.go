@@ -0,0 +1,370 @@
+package httppush
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"danny.vn/hotpot/pkg/ingest/accesslog"
+)
+
+// OTLP/HTTP log export decoding. Only the parts of the logs data model an
+// access log needs are read: resource and record attributes, the body, and
+// the timestamps. Both the protobuf and the JSON encoding are accepted.
+
+// otlpValue is a decoded AnyValue: a scalar rendered as a string, or a
+// key/value list. Arrays and bytes are dropped.
+type otlpValue struct {
+	scalar    string
+	hasScalar bool
+	kvlist    []otlpAttr
+}
+
+type otlpAttr struct {
+	key   string
+	value otlpValue
+}
+
+type otlpLogRecord struct {
+	timeUnixNano     uint64
+	observedUnixNano uint64
+	body             otlpValue
+	attrs            []otlpAttr // resource attributes first
+}
+
+// semconvFields lists the OpenTelemetry semantic convention attributes
+// (current, then legacy) read for a standard field the record lacks.
+var semconvFields = map[string][]string{
+	accesslog.FieldURI:           {"url.path", "http.target"},
+	accesslog.FieldMethod:        {"http.request.method", "http.method"},
+	accesslog.FieldStatus:        {"http.response.status_code", "http.status_code"},
+	accesslog.FieldBodyBytesSent: {"http.response.body.size", "http.response_content_length"},
+	accesslog.FieldHTTPHost:      {"server.address", "http.host"},
+	accesslog.FieldUserAgent:     {"user_agent.original", "http.user_agent"},
+	accesslog.FieldRemoteAddr:    {"client.address", "http.client_ip"},
+}
+
+// record flattens a log record into an accesslog.Record. Attributes keep
+// their names; a key/value list body is flattened with dotted keys, and a
+// string body holding a JSON object (a gateway's JSON access log line) is
+// parsed as one. Record attributes win over body fields.
+func (lr otlpLogRecord) record() accesslog.Record {
+	rec := accesslog.Record{}
+	switch {
+	case lr.body.kvlist != nil:
+		flattenAttrs(rec, "", lr.body.kvlist)
+	case lr.body.hasScalar && len(lr.body.scalar) > 0 && lr.body.scalar[0] == '{':
+		if body, err := accesslog.ParseJSONRecord([]byte(lr.body.scalar)); err == nil {
+			for k, v := range body {
+				rec[k] = v
+			}
+		}
+	}
+	flattenAttrs(rec, "", lr.attrs)
+
+	if rec[accesslog.FieldTimestamp] == "" {
+		ts := lr.timeUnixNano
+		if ts == 0 {
+			ts = lr.observedUnixNano
+		}
+		if ts != 0 {
+			rec[accesslog.FieldTimestamp] = time.Unix(0, int64(ts)).UTC().Format(time.RFC3339Nano)
+		}
+	}
+	for field, names := range semconvFields {
+		if rec[field] != "" {
+			continue
+		}
+		for _, name := range names {
+			if v := rec[name]; v != "" {
+				rec[field] = v
+				break
+			}
+		}
+	}
+	return rec
+}
+
+func flattenAttrs(rec accesslog.Record, prefix string, attrs []otlpAttr) {
+	for _, a := range attrs {
+		key := prefix + a.key
+		if a.value.kvlist != nil {
+			flattenAttrs(rec, key+".", a.value.kvlist)
+		} else if a.value.hasScalar {
+			rec[key] = a.value.scalar
+		}
+	}
+}
+
+// --- protobuf encoding ---
+
+// decodeOTLPProto decodes an ExportLogsServiceRequest.
+func decodeOTLPProto(b []byte) ([]otlpLogRecord, error) {
+	var out []otlpLogRecord
+	err := eachField(b, func(num protowire.Number, v []byte, _ uint64) error {
+		if num != 1 { // resource_logs
+			return nil
+		}
+		return decodeResourceLogs(v, &out)
+	})
+	return out, err
+}
+
+func decodeResourceLogs(b []byte, out *[]otlpLogRecord) error {
+	var resourceAttrs []otlpAttr
+	var records []otlpLogRecord
+	err := eachField(b, func(num protowire.Number, v []byte, _ uint64) error {
+		switch num {
+		case 1: // resource
+			return eachField(v, func(num protowire.Number, v []byte, _ uint64) error {
+				if num != 1 { // attributes
+					return nil
+				}
+				a, err := decodeKeyValue(v)
+				resourceAttrs = append(resourceAttrs, a)
+				return err
+			})
+		case 2: // scope_logs
+			return eachField(v, func(num protowire.Number, v []byte, _ uint64) error {
+				if num != 2 { // log_records
+					return nil
+				}
+				lr, err := decodeLogRecord(v)
+				records = append(records, lr)
+				return err
+			})
+		}
+		return nil
+	})
+	for _, lr := range records {
+		lr.attrs = append(append([]otlpAttr(nil), resourceAttrs...), lr.attrs...)
+		*out = append(*out, lr)
+	}
+	return err
+}
+
+func decodeLogRecord(b []byte) (otlpLogRecord, error) {
+	var lr otlpLogRecord
+	err := eachField(b, func(num protowire.Number, v []byte, x uint64) error {
+		var err error
+		switch num {
+		case 1:
+			lr.timeUnixNano = x
+		case 11:
+			lr.observedUnixNano = x
+		case 5:
+			lr.body, err = decodeAnyValue(v)
+		case 6:
+			var a otlpAttr
+			a, err = decodeKeyValue(v)
+			lr.attrs = append(lr.attrs, a)
+		}
+		return err
+	})
+	return lr, err
+}
+
+func decodeKeyValue(b []byte) (otlpAttr, error) {
+	var a otlpAttr
+	err := eachField(b, func(num protowire.Number, v []byte, _ uint64) error {
+		var err error
+		switch num {
+		case 1:
+			a.key = string(v)
+		case 2:
+			a.value, err = decodeAnyValue(v)
+		}
+		return err
+	})
+	return a, err
+}
+
+func decodeAnyValue(b []byte) (otlpValue, error) {
+	var val otlpValue
+	err := eachField(b, func(num protowire.Number, v []byte, x uint64) error {
+		switch num {
+		case 1: // string_value
+			val = otlpValue{scalar: string(v), hasScalar: true}
+		case 2: // bool_value
+			val = otlpValue{scalar: strconv.FormatBool(x != 0), hasScalar: true}
+		case 3: // int_value
+			val = otlpValue{scalar: strconv.FormatInt(int64(x), 10), hasScalar: true}
+		case 4: // double_value
+			val = otlpValue{scalar: strconv.FormatFloat(math.Float64frombits(x), 'f', -1, 64), hasScalar: true}
+		case 6: // kvlist_value
+			val = otlpValue{kvlist: []otlpAttr{}}
+			return eachField(v, func(num protowire.Number, v []byte, _ uint64) error {
+				if num != 1 {
+					return nil
+				}
+				a, err := decodeKeyValue(v)
+				val.kvlist = append(val.kvlist, a)
+				return err
+			})
+		}
+		return nil
+	})
+	return val, err
+}
+
+// eachField calls fn for each field of a protobuf message with the field's
+// bytes (length-delimited fields) or scalar value (varint and fixed fields).
+func eachField(b []byte, fn func(num protowire.Number, v []byte, x uint64) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		var v []byte
+		var x uint64
+		switch typ {
+		case protowire.BytesType:
+			v, n = protowire.ConsumeBytes(b)
+		case protowire.VarintType:
+			x, n = protowire.ConsumeVarint(b)
+		case protowire.Fixed64Type:
+			x, n = protowire.ConsumeFixed64(b)
+		case protowire.Fixed32Type:
+			var x32 uint32
+			x32, n = protowire.ConsumeFixed32(b)
+			x = uint64(x32)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		if err := fn(num, v, x); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeOTLPProtoResponse encodes an ExportLogsServiceResponse, with a
+// partial success when records were rejected.
+func encodeOTLPProtoResponse(rejected int, msg string) []byte {
+	if rejected == 0 {
+		return nil
+	}
+	var ps []byte
+	ps = protowire.AppendTag(ps, 1, protowire.VarintType)
+	ps = protowire.AppendVarint(ps, uint64(rejected))
+	ps = protowire.AppendTag(ps, 2, protowire.BytesType)
+	ps = protowire.AppendString(ps, msg)
+
+	var out []byte
+	out = protowire.AppendTag(out, 1, protowire.BytesType)
+	return protowire.AppendBytes(out, ps)
+}
+
+// --- JSON encoding ---
+
+type otlpJSONRequest struct {
+	ResourceLogs []struct {
+		Resource struct {
+			Attributes []otlpJSONKeyValue `json:"attributes"`
+		} `json:"resource"`
+		ScopeLogs []struct {
+			LogRecords []struct {
+				TimeUnixNano         json.RawMessage    `json:"timeUnixNano"`
+				ObservedTimeUnixNano json.RawMessage    `json:"observedTimeUnixNano"`
+				Body                 *otlpJSONAnyValue  `json:"body"`
+				Attributes           []otlpJSONKeyValue `json:"attributes"`
+			} `json:"logRecords"`
+		} `json:"scopeLogs"`
+	} `json:"resourceLogs"`
+}
+
+type otlpJSONKeyValue struct {
+	Key   string           `json:"key"`
+	Value otlpJSONAnyValue `json:"value"`
+}
+
+type otlpJSONAnyValue struct {
+	StringValue *string         `json:"stringValue"`
+	BoolValue   *bool           `json:"boolValue"`
+	IntValue    json.RawMessage `json:"intValue"` // int64 is a JSON string in OTLP
+	DoubleValue *float64        `json:"doubleValue"`
+	KvlistValue *struct {
+		Values []otlpJSONKeyValue `json:"values"`
+	} `json:"kvlistValue"`
+}
+
+// decodeOTLPJSON decodes an ExportLogsServiceRequest in the OTLP JSON encoding.
+func decodeOTLPJSON(b []byte) ([]otlpLogRecord, error) {
+	var req otlpJSONRequest
+	if err := json.Unmarshal(b, &req); err != nil {
+		return nil, fmt.Errorf("parse otlp json: %w", err)
+	}
+	var out []otlpLogRecord
+	for _, rl := range req.ResourceLogs {
+		resourceAttrs := jsonAttrs(rl.Resource.Attributes)
+		for _, sl := range rl.ScopeLogs {
+			for _, r := range sl.LogRecords {
+				lr := otlpLogRecord{
+					timeUnixNano:     jsonUint(r.TimeUnixNano),
+					observedUnixNano: jsonUint(r.ObservedTimeUnixNano),
+					attrs:            append(append([]otlpAttr(nil), resourceAttrs...), jsonAttrs(r.Attributes)...),
+				}
+				if r.Body != nil {
+					lr.body = r.Body.value()
+				}
+				out = append(out, lr)
+			}
+		}
+	}
+	return out, nil
+}
+
+func jsonAttrs(kvs []otlpJSONKeyValue) []otlpAttr {
+	attrs := make([]otlpAttr, 0, len(kvs))
+	for _, kv := range kvs {
+		attrs = append(attrs, otlpAttr{key: kv.Key, value: kv.Value.value()})
+	}
+	return attrs
+}
+
+func (v otlpJSONAnyValue) value() otlpValue {
+	switch {
+	case v.StringValue != nil:
+		return otlpValue{scalar: *v.StringValue, hasScalar: true}
+	case v.BoolValue != nil:
+		return otlpValue{scalar: strconv.FormatBool(*v.BoolValue), hasScalar: true}
+	case len(v.IntValue) > 0:
+		return otlpValue{scalar: string(bytes.Trim(v.IntValue, `"`)), hasScalar: true}
+	case v.DoubleValue != nil:
+		return otlpValue{scalar: strconv.FormatFloat(*v.DoubleValue, 'f', -1, 64), hasScalar: true}
+	case v.KvlistValue != nil:
+		return otlpValue{kvlist: append([]otlpAttr{}, jsonAttrs(v.KvlistValue.Values)...)}
+	}
+	return otlpValue{}
+}
+
+// jsonUint reads a uint64 encoded as a JSON string or number.
+func jsonUint(raw json.RawMessage) uint64 {
+	n, _ := strconv.ParseUint(string(bytes.Trim(raw, `"`)), 10, 64)
+	return n
+}
+
+// encodeOTLPJSONResponse encodes an ExportLogsServiceResponse in JSON.
+func encodeOTLPJSONResponse(rejected int, msg string) []byte {
+	if rejected == 0 {
+		return []byte("{}")
+	}
+	b, _ := json.Marshal(map[string]any{
+		"partialSuccess": map[string]any{
+			"rejectedLogRecords": strconv.Itoa(rejected),
+			"errorMessage":       msg,
+		},
+	})
+	return b
+}
@@ -0,0 +1,20 @@
+package httppush
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/accesslog"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider: "accesslog",
+		Name:     SourceType,
+		Scope:    ingest.ScopeRegional,
+		Register: Register,
+		Workflow: HTTPPushTrafficWorkflow,
+		NewParams: func(_, _, _ string) any {
+			return accesslog.ServiceWorkflowParams{}
+		},
+		NewResult: func() any { return &accesslog.ServiceWorkflowResult{} },
+	})
+}
@@ -0,0 +1,443 @@
+package httppush
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/ingest/accesslog"
+)
+
+// SourceType is the accesslog source type served by the receiver.
+const SourceType = "httppush"
+
+const (
+	// settleDelay is how long after a window closes it is flushed, leaving
+	// time for senders' batching. Entries for flushed windows are rejected,
+	// with 409 when a request holds nothing else.
+	settleDelay = time.Minute
+
+	// maxClockSkew bounds how far in the future an entry may be.
+	maxClockSkew = 10 * time.Minute
+)
+
+// ReceiverOptions configures a Receiver.
+type ReceiverOptions struct {
+	// Sources returns the configured access log sources; it is called per
+	// request, so token changes apply without a restart.
+	Sources func() []config.AccessLogSourceConfig
+
+	// LastWindowEnd returns the end of the windows already stored for a
+	// source, so entries for them are rejected after a restart too. Nil
+	// treats every source as never flushed.
+	LastWindowEnd func(source string) (time.Time, error)
+
+	SpoolDir      string
+	MaxBodyBytes  int64
+	MaxInFlight   int
+	MaxSpoolBytes int64
+}
+
+// Receiver accepts access logs pushed over HTTP, aggregates them into
+// windows in memory and spools them to disk until each window is flushed
+// to bronze.
+//
+// Endpoints, authenticated with "Authorization: Bearer <source token>":
+//
+//	POST /v1/accesslog/{source}          newline-delimited JSON
+//	POST /v1/accesslog/{source}/v1/logs  OTLP/HTTP logs (protobuf or JSON)
+//
+// so an OTLP exporter's endpoint is http://host:port/v1/accesslog/{source}.
+type Receiver struct {
+	sources       func() []config.AccessLogSourceConfig
+	lastWindowEnd func(string) (time.Time, error)
+	spool         *spool
+	maxBodyBytes  int64
+	maxSpoolBytes int64
+	inFlight      chan struct{}
+
+	mu     sync.Mutex
+	states map[string]*sourceState
+}
+
+// sourceState holds the unflushed windows of one source.
+type sourceState struct {
+	mu        sync.Mutex
+	interval  time.Duration
+	watermark time.Time // windows before this have been flushed
+	agg       *accesslog.Aggregator
+}
+
+// NewReceiver opens the spool and reloads the entries it holds. Spooled
+// windows before a source's last stored window end were stored before a
+// restart and are removed instead.
+func NewReceiver(opts ReceiverOptions) (*Receiver, error) {
+	sp, err := openSpool(opts.SpoolDir)
+	if err != nil {
+		return nil, err
+	}
+	r := &Receiver{
+		sources:       opts.Sources,
+		lastWindowEnd: opts.LastWindowEnd,
+		spool:         sp,
+		maxBodyBytes:  opts.MaxBodyBytes,
+		maxSpoolBytes: opts.MaxSpoolBytes,
+		inFlight:      make(chan struct{}, max(1, opts.MaxInFlight)),
+		states:        make(map[string]*sourceState),
+	}
+
+	names, err := sp.Sources()
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		src, ok := r.source(name)
+		if !ok {
+			slog.Warn("Keeping spooled access logs of unconfigured push source", "source", name)
+			continue
+		}
+		st, err := r.state(src)
+		if err != nil {
+			return nil, err
+		}
+		if err := sp.Remove(name, time.Time{}, st.watermark); err != nil {
+			return nil, err
+		}
+		if err := sp.Load(name, st.watermark, time.Time{}, func(e accesslog.Entry) { st.agg.Add(e) }); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Start serves the receiver on addr in the background. Requests are only
+// acknowledged once spooled, and senders retry unacknowledged ones, so the
+// server is not drained on shutdown.
+func (r *Receiver) Start(addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("listen %s: %w", addr, err)
+	}
+	srv := &http.Server{
+		Handler:           r.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("Access log push receiver stopped", "addr", addr, "error", err)
+		}
+	}()
+	return nil
+}
+
+// Handler returns the receiver's HTTP handler.
+func (r *Receiver) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/accesslog/{source}", r.handleNDJSON)
+	mux.HandleFunc("POST /v1/accesslog/{source}/v1/logs", r.handleOTLP)
+	return r.limit(mux)
+}
+
+// limit rejects requests beyond MaxInFlight with 429 so senders back off
+// instead of queueing on the receiver.
+func (r *Receiver) limit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case r.inFlight <- struct{}{}:
+			defer func() { <-r.inFlight }()
+			next.ServeHTTP(w, req)
+		default:
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, "too many concurrent requests", http.StatusTooManyRequests)
+		}
+	})
+}
+
+func (r *Receiver) handleNDJSON(w http.ResponseWriter, req *http.Request) {
+	src, body, ok := r.readRequest(w, req)
+	if !ok {
+		return
+	}
+
+	var recs []accesslog.Record
+	rejected := 0
+	for line := range bytes.Lines(body) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		rec, err := accesslog.ParseJSONRecord(line)
+		if err != nil {
+			rejected++
+			continue
+		}
+		recs = append(recs, rec)
+	}
+
+	accepted, n, late, err := r.accept(src, recs)
+	if err != nil {
+		slog.Error("Failed to accept pushed access logs", "source", src.Name, "error", err)
+		http.Error(w, "failed to store entries", http.StatusInternalServerError)
+		return
+	}
+	// Accepted entries are spooled, so a partly late batch must not look
+	// failed: a sender retrying it would count them twice.
+	status := http.StatusAccepted
+	if late > 0 && accepted == 0 && rejected+n == 0 {
+		status = http.StatusConflict
+	}
+	writeJSON(w, status, map[string]int{
+		"accepted": accepted,
+		"rejected": rejected + n,
+		"late":     late,
+	})
+}
+
+func (r *Receiver) handleOTLP(w http.ResponseWriter, req *http.Request) {
+	src, body, ok := r.readRequest(w, req)
+	if !ok {
+		return
+	}
+
+	isJSON := strings.HasPrefix(req.Header.Get("Content-Type"), "application/json")
+	var lrs []otlpLogRecord
+	var err error
+	if isJSON {
+		lrs, err = decodeOTLPJSON(body)
+	} else {
+		lrs, err = decodeOTLPProto(body)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	recs := make([]accesslog.Record, len(lrs))
+	for i, lr := range lrs {
+		recs[i] = lr.record()
+	}
+	accepted, rejected, late, err := r.accept(src, recs)
+	if err != nil {
+		slog.Error("Failed to accept pushed access logs", "source", src.Name, "error", err)
+		http.Error(w, "failed to store entries", http.StatusInternalServerError)
+		return
+	}
+	if late > 0 && accepted == 0 && rejected == 0 {
+		http.Error(w, fmt.Sprintf("all %d records are for an already flushed window", late), http.StatusConflict)
+		return
+	}
+
+	rejected += late
+	const msg = "records without a valid timestamp, uri or status, or for an already flushed window"
+	if isJSON {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(encodeOTLPJSONResponse(rejected, msg))
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.WriteHeader(http.StatusOK)
+	w.Write(encodeOTLPProtoResponse(rejected, msg))
+}
+
+// readRequest authenticates the request, applies spool backpressure and
+// reads the (optionally gzip-encoded) body. It writes the error response
+// and returns false on failure.
+func (r *Receiver) readRequest(w http.ResponseWriter, req *http.Request) (config.AccessLogSourceConfig, []byte, bool) {
+	src, ok := r.source(req.PathValue("source"))
+	token, hasToken := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !ok || !hasToken || src.Token == "" ||
+		subtle.ConstantTimeCompare([]byte(token), []byte(src.Token)) != 1 {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return src, nil, false
+	}
+
+	if r.spool.Size() >= r.maxSpoolBytes {
+		w.Header().Set("Retry-After", "30")
+		http.Error(w, "spool full", http.StatusServiceUnavailable)
+		return src, nil, false
+	}
+
+	var rd io.Reader = http.MaxBytesReader(w, req.Body, r.maxBodyBytes)
+	if req.Header.Get("Content-Encoding") == "gzip" {
+		zr, err := gzip.NewReader(rd)
+		if err != nil {
+			http.Error(w, "invalid gzip body", http.StatusBadRequest)
+			return src, nil, false
+		}
+		defer zr.Close()
+		rd = io.LimitReader(zr, r.maxBodyBytes+1)
+	}
+	body, err := io.ReadAll(rd)
+	if err == nil && int64(len(body)) > r.maxBodyBytes {
+		err = &http.MaxBytesError{Limit: r.maxBodyBytes}
+	}
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+		} else {
+			http.Error(w, "read body: "+err.Error(), http.StatusBadRequest)
+		}
+		return src, nil, false
+	}
+	return src, body, true
+}
+
+// accept converts records to entries, spools them and adds them to their
+// windows. It returns the number of entries accepted, rejected because they
+// do not convert or lie too far in the future, and late because their
+// window is already flushed.
+func (r *Receiver) accept(src config.AccessLogSourceConfig, recs []accesslog.Record) (accepted, rejected, late int, err error) {
+	st, err := r.state(src)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	limit := time.Now().Add(maxClockSkew)
+
+	byWindow := make(map[time.Time][]accesslog.Entry)
+	for _, rec := range recs {
+		e, err := rec.Entry(src.FieldMapping)
+		if err != nil || e.Time.After(limit) {
+			rejected++
+			continue
+		}
+		start := e.Time.Truncate(st.interval)
+		byWindow[start] = append(byWindow[start], e)
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	for start, entries := range byWindow {
+		if start.Before(st.watermark) {
+			late += len(entries)
+			continue
+		}
+		if err := r.spool.Append(src.Name, start, entries); err != nil {
+			return accepted, rejected, late, err
+		}
+		for _, e := range entries {
+			st.agg.Add(e)
+		}
+		accepted += len(entries)
+	}
+	return accepted, rejected, late, nil
+}
+
+// FlushResult summarizes one Flush.
+type FlushResult struct {
+	Windows int
+	Counts  int
+	End     time.Time // windows before End have been stored
+}
+
+// Flush stores the settled windows of a source with store, oldest first,
+// and removes them from the spool. If a store fails, the windows not yet
+// stored are reloaded from the spool for the next flush.
+func (r *Receiver) Flush(ctx context.Context, name string, store func(context.Context, *accesslog.Window) (int, error)) (*FlushResult, error) {
+	src, ok := r.source(name)
+	if !ok {
+		return nil, fmt.Errorf("push source %q not found in accesslog config", name)
+	}
+	st, err := r.state(src)
+	if err != nil {
+		return nil, err
+	}
+
+	st.mu.Lock()
+	cutoff := time.Now().Add(-settleDelay).Truncate(st.interval)
+	if cutoff.Before(st.watermark) {
+		cutoff = st.watermark
+	}
+	windows := st.agg.Take(cutoff)
+	st.watermark = cutoff
+	st.mu.Unlock()
+
+	result := &FlushResult{End: cutoff}
+	for _, w := range windows {
+		created, err := store(ctx, w)
+		if err != nil {
+			if rerr := r.reload(name, st, w.Start, cutoff); rerr != nil {
+				err = errors.Join(err, rerr)
+			}
+			return result, fmt.Errorf("flush %s window %s: %w", name, w.Start.Format(time.RFC3339), err)
+		}
+		result.Windows++
+		result.Counts += created
+
+		// A spool file left behind is removed after a restart, or stored
+		// again if the cursor was not advanced, which the idempotent store
+		// ignores.
+		if err := r.spool.Remove(name, w.Start, w.End); err != nil {
+			slog.Warn("Failed to remove flushed spool window", "source", name, "window", w.Start, "error", err)
+		}
+	}
+	return result, nil
+}
+
+// reload puts the spooled windows in [from, to) back in memory and moves
+// the watermark back to from, after a failed flush.
+func (r *Receiver) reload(name string, st *sourceState, from, to time.Time) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.watermark = from
+	return r.spool.Load(name, from, to, func(e accesslog.Entry) { st.agg.Add(e) })
+}
+
+// source returns the configured push source of a name.
+func (r *Receiver) source(name string) (config.AccessLogSourceConfig, bool) {
+	for _, src := range r.sources() {
+		if src.Name == name && src.Type == SourceType {
+			return src, true
+		}
+	}
+	return config.AccessLogSourceConfig{}, false
+}
+
+// state returns the state of a source, creating it on first use with the
+// watermark at the source's last stored window end.
+func (r *Receiver) state(src config.AccessLogSourceConfig) (*sourceState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if st, ok := r.states[src.Name]; ok {
+		return st, nil
+	}
+
+	var watermark time.Time
+	if r.lastWindowEnd != nil {
+		end, err := r.lastWindowEnd(src.Name)
+		if err != nil {
+			return nil, fmt.Errorf("read last window end of %s: %w", src.Name, err)
+		}
+		watermark = end
+	}
+	interval := time.Duration(src.IntervalMinutes) * time.Minute
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+	st := &sourceState{
+		interval:  interval,
+		watermark: watermark,
+		agg:       accesslog.NewAggregator(interval, time.Time{}, time.Time{}),
+	}
+	r.states[src.Name] = st
+	return st, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,258 @@
+package httppush
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/ingest/accesslog"
+)
+
+func testSources() []config.AccessLogSourceConfig {
+	return []config.AccessLogSourceConfig{
+		{Type: SourceType, Name: "kong", Token: "secret", FieldMapping: map[string]string{"uri": "path"}},
+		{Type: "gcplogging", Name: "nginx", Token: "secret"},
+	}
+}
+
+func newTestReceiver(t *testing.T, dir string, maxSpool int64) *Receiver {
+	t.Helper()
+	r, err := NewReceiver(ReceiverOptions{
+		Sources:       testSources,
+		SpoolDir:      dir,
+		MaxBodyBytes:  1 << 20,
+		MaxInFlight:   4,
+		MaxSpoolBytes: maxSpool,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func post(t *testing.T, h http.Handler, path, token, contentType string, body []byte) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", contentType)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+// otlpProto builds an ExportLogsServiceRequest with one record per status.
+func otlpProto(ts time.Time, statuses ...int64) []byte {
+	kv := func(key string, value []byte) []byte {
+		var b []byte
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, key)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		return protowire.AppendBytes(b, value)
+	}
+	str := func(s string) []byte {
+		return protowire.AppendString(protowire.AppendTag(nil, 1, protowire.BytesType), s)
+	}
+	integer := func(n int64) []byte {
+		return protowire.AppendVarint(protowire.AppendTag(nil, 3, protowire.VarintType), uint64(n))
+	}
+
+	var scope []byte
+	for _, status := range statuses {
+		var lr []byte
+		lr = protowire.AppendTag(lr, 1, protowire.Fixed64Type)
+		lr = protowire.AppendFixed64(lr, uint64(ts.UnixNano()))
+		for _, a := range [][]byte{
+			kv("url.path", str("/v1/orders")),
+			kv("http.request.method", str("POST")),
+			kv("http.response.status_code", integer(status)),
+			kv("client.address", str("198.51.100.4")),
+		} {
+			lr = protowire.AppendTag(lr, 6, protowire.BytesType)
+			lr = protowire.AppendBytes(lr, a)
+		}
+		scope = protowire.AppendTag(scope, 2, protowire.BytesType)
+		scope = protowire.AppendBytes(scope, lr)
+	}
+
+	var resource []byte
+	resource = protowire.AppendTag(resource, 1, protowire.BytesType)
+	resource = protowire.AppendBytes(resource, kv("server.address", str("api.example.com")))
+
+	var rl []byte
+	rl = protowire.AppendTag(rl, 1, protowire.BytesType)
+	rl = protowire.AppendBytes(rl, resource)
+	rl = protowire.AppendTag(rl, 2, protowire.BytesType)
+	rl = protowire.AppendBytes(rl, scope)
+
+	return protowire.AppendBytes(protowire.AppendTag(nil, 1, protowire.BytesType), rl)
+}
+
+func TestReceiver(t *testing.T) {
+	dir := t.TempDir()
+	r := newTestReceiver(t, dir, 1<<20)
+	h := r.Handler()
+
+	// A settled window: old enough to flush, recent enough to accept.
+	ts := time.Now().Add(-20 * time.Minute).Truncate(5 * time.Minute).Add(time.Minute).UTC()
+	line := func(path string, status int) string {
+		return fmt.Sprintf(`{"timestamp":%q,"path":%q,"method":"GET","status":%d,"remote_addr":"203.0.113.9","http_user_agent":"ua"}`,
+			ts.Format(time.RFC3339), path, status)
+	}
+
+	if rec := post(t, h, "/v1/accesslog/kong", "wrong", "application/x-ndjson", nil); rec.Code != http.StatusUnauthorized {
+		t.Errorf("wrong token: status %d", rec.Code)
+	}
+	if rec := post(t, h, "/v1/accesslog/nginx", "secret", "application/x-ndjson", nil); rec.Code != http.StatusUnauthorized {
+		t.Errorf("non-push source: status %d", rec.Code)
+	}
+
+	body := strings.Join([]string{line("/a?q=1", 200), line("/a", 200), "not json", line("/b", 500)}, "\n")
+	rec := post(t, h, "/v1/accesslog/kong", "secret", "application/x-ndjson", []byte(body))
+	var got map[string]int
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil || rec.Code != http.StatusAccepted {
+		t.Fatalf("ndjson: status %d body %s", rec.Code, rec.Body)
+	}
+	if got["accepted"] != 3 || got["rejected"] != 1 {
+		t.Errorf("ndjson result = %v", got)
+	}
+
+	rec = post(t, h, "/v1/accesslog/kong/v1/logs", "secret", "application/x-protobuf", otlpProto(ts, 201, 201))
+	if rec.Code != http.StatusOK || rec.Body.Len() != 0 {
+		t.Fatalf("otlp proto: status %d body %q", rec.Code, rec.Body)
+	}
+
+	otlpJSON := fmt.Sprintf(`{"resourceLogs":[{"scopeLogs":[{"logRecords":[
+		{"timeUnixNano":"%d","body":{"stringValue":"{\"path\":\"/c\",\"method\":\"GET\",\"status\":404}"}},
+		{"timeUnixNano":"%d","attributes":[{"key":"url.path","value":{"stringValue":"/c"}}]}
+	]}]}]}`, ts.UnixNano(), ts.UnixNano())
+	rec = post(t, h, "/v1/accesslog/kong/v1/logs", "secret", "application/json", []byte(otlpJSON))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"rejectedLogRecords":"1"`) {
+		t.Fatalf("otlp json: status %d body %s", rec.Code, rec.Body)
+	}
+
+	// Restart: a new receiver reloads the spool.
+	r = newTestReceiver(t, dir, 1<<20)
+	var windows []*accesslog.Window
+	res, err := r.Flush(context.Background(), "kong", func(_ context.Context, w *accesslog.Window) (int, error) {
+		windows = append(windows, w)
+		return len(w.Counts), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Windows != 1 || len(windows) != 1 {
+		t.Fatalf("flushed %d windows", res.Windows)
+	}
+	w := windows[0]
+	checks := []struct {
+		key  accesslog.CountKey
+		want int64
+	}{
+		{accesslog.CountKey{URI: "/a", Method: "GET", Status: 200}, 2},
+		{accesslog.CountKey{URI: "/b", Method: "GET", Status: 500}, 1},
+		{accesslog.CountKey{URI: "/v1/orders", Method: "POST", Status: 201}, 2},
+		{accesslog.CountKey{URI: "/c", Method: "GET", Status: 404}, 1},
+	}
+	for _, c := range checks {
+		if got := w.Counts[c.key]; got == nil || got.Requests != c.want {
+			t.Errorf("count %v = %+v, want %d", c.key, got, c.want)
+		}
+	}
+	if c := w.Counts[checks[2].key]; c == nil || c.HTTPHost != "api.example.com" {
+		t.Errorf("otlp resource attribute not applied: %+v", c)
+	}
+	if n := w.ClientIPs[accesslog.RequestKey{URI: "/a", Method: "GET"}]["203.0.113.9"]; n != 2 {
+		t.Errorf("client ip count = %d, want 2", n)
+	}
+	if r.spool.Size() != 0 {
+		t.Errorf("spool size after flush = %d", r.spool.Size())
+	}
+
+	// The window is flushed, so entries for it are late.
+	h = r.Handler()
+	rec = post(t, h, "/v1/accesslog/kong", "secret", "application/x-ndjson", []byte(line("/a", 200)))
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil || rec.Code != http.StatusConflict || got["late"] != 1 {
+		t.Errorf("late entry: status %d body %s", rec.Code, rec.Body)
+	}
+	if rec := post(t, h, "/v1/accesslog/kong/v1/logs", "secret", "application/x-protobuf", otlpProto(ts, 200)); rec.Code != http.StatusConflict {
+		t.Errorf("late otlp record: status %d body %s", rec.Code, rec.Body)
+	}
+
+	// A partly late batch is accepted, so a sender does not resend the rest.
+	now := time.Now().UTC()
+	current := fmt.Sprintf(`{"timestamp":%q,"path":"/a","method":"GET","status":200}`, now.Format(time.RFC3339))
+	rec = post(t, h, "/v1/accesslog/kong", "secret", "application/x-ndjson", []byte(line("/a", 200)+"\n"+current))
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil || rec.Code != http.StatusAccepted ||
+		got["accepted"] != 1 || got["late"] != 1 {
+		t.Errorf("partly late batch: status %d body %s", rec.Code, rec.Body)
+	}
+	rec = post(t, h, "/v1/accesslog/kong/v1/logs", "secret", "application/json", []byte(fmt.Sprintf(
+		`{"resourceLogs":[{"scopeLogs":[{"logRecords":[
+		{"timeUnixNano":"%d","attributes":[{"key":"url.path","value":{"stringValue":"/a"}},{"key":"http.response.status_code","value":{"intValue":"200"}}]},
+		{"timeUnixNano":"%d","attributes":[{"key":"url.path","value":{"stringValue":"/a"}},{"key":"http.response.status_code","value":{"intValue":"200"}}]}
+	]}]}]}`, ts.UnixNano(), now.UnixNano())))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"rejectedLogRecords":"1"`) {
+		t.Errorf("partly late otlp batch: status %d body %s", rec.Code, rec.Body)
+	}
+}
+
+func TestReceiverLastWindowEnd(t *testing.T) {
+	dir := t.TempDir()
+	ts := time.Now().Add(-20 * time.Minute).Truncate(5 * time.Minute).UTC()
+	line := fmt.Sprintf(`{"timestamp":%q,"uri":"/a","status":200}`, ts.Format(time.RFC3339))
+	h := newTestReceiver(t, dir, 1<<20).Handler()
+	if rec := post(t, h, "/v1/accesslog/kong", "secret", "application/x-ndjson", []byte(line)); rec.Code != http.StatusAccepted {
+		t.Fatalf("status %d body %s", rec.Code, rec.Body)
+	}
+
+	// Restart after the window was stored but before its spool file was
+	// removed: the file is dropped and the window stays closed.
+	r, err := NewReceiver(ReceiverOptions{
+		Sources:       testSources,
+		LastWindowEnd: func(string) (time.Time, error) { return ts.Add(5 * time.Minute), nil },
+		SpoolDir:      dir,
+		MaxBodyBytes:  1 << 20,
+		MaxInFlight:   4,
+		MaxSpoolBytes: 1 << 20,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.spool.Size() != 0 {
+		t.Errorf("spool size = %d, want 0", r.spool.Size())
+	}
+	rec := post(t, r.Handler(), "/v1/accesslog/kong", "secret", "application/x-ndjson", []byte(line))
+	if rec.Code != http.StatusConflict {
+		t.Errorf("late entry after restart: status %d body %s", rec.Code, rec.Body)
+	}
+	res, err := r.Flush(context.Background(), "kong", func(context.Context, *accesslog.Window) (int, error) {
+		t.Error("stored a window before the last window end")
+		return 0, nil
+	})
+	if err != nil || res.Windows != 0 {
+		t.Errorf("Flush = %+v, %v", res, err)
+	}
+}
+
+func TestReceiverSpoolFull(t *testing.T) {
+	r := newTestReceiver(t, t.TempDir(), 1)
+	h := r.Handler()
+
+	line := fmt.Sprintf(`{"timestamp":%q,"uri":"/a","status":200}`, time.Now().Format(time.RFC3339))
+	if rec := post(t, h, "/v1/accesslog/kong", "secret", "application/x-ndjson", []byte(line)); rec.Code != http.StatusAccepted {
+		t.Fatalf("first request: status %d", rec.Code)
+	}
+	rec := post(t, h, "/v1/accesslog/kong", "secret", "application/x-ndjson", []byte(line))
+	if rec.Code != http.StatusServiceUnavailable || rec.Header().Get("Retry-After") == "" {
+		t.Errorf("spool full: status %d", rec.Code)
+	}
+}
@@ -0,0 +1,67 @@
+package httppush
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+
+	"danny.vn/hotpot/pkg/base/config"
+	entaccesslog "danny.vn/hotpot/pkg/storage/ent/accesslog"
+)
+
+// TaskQueue returns the task queue served by the receiver host, which runs
+// the flush activity.
+func TaskQueue(host string) string {
+	return "hotpot-ingest-accesslog-receiver-" + strings.ToLower(host)
+}
+
+// Register registers the push flush workflow. On the configured receiver
+// host it also starts the receiver and a worker on the host's task queue
+// for the flush activity.
+func Register(w worker.Worker, configService *config.Service, entClient *entaccesslog.Client) {
+	w.RegisterWorkflow(HTTPPushTrafficWorkflow)
+
+	addr, host := configService.AccessLogReceiverAddr(), configService.AccessLogReceiverHost()
+	if addr == "" || host == "" {
+		return
+	}
+	if hostname, _ := os.Hostname(); !strings.EqualFold(hostname, host) {
+		slog.Info("Access log push receiver runs on another host", "host", host)
+		return
+	}
+
+	receiver, err := NewReceiver(ReceiverOptions{
+		Sources: configService.AccessLogSources,
+		LastWindowEnd: func(name string) (time.Time, error) {
+			return lastWindowEnd(context.Background(), entClient, name)
+		},
+		SpoolDir:      configService.AccessLogReceiverSpoolDir(),
+		MaxBodyBytes:  configService.AccessLogReceiverMaxBodyBytes(),
+		MaxInFlight:   configService.AccessLogReceiverMaxInFlight(),
+		MaxSpoolBytes: configService.AccessLogReceiverMaxSpoolBytes(),
+	})
+	if err != nil {
+		slog.Error("Access log push receiver not started", "addr", addr, "error", err)
+		return
+	}
+
+	// The flush worker starts first so spooled windows are flushed even if
+	// the listener fails.
+	taskQueue := TaskQueue(host)
+	hw := worker.New(configService.TemporalClient().(client.Client), taskQueue, worker.Options{})
+	hw.RegisterActivity(NewActivities(receiver, entClient).FlushPushedLogs)
+	if err := hw.Start(); err != nil {
+		slog.Error("Access log push receiver worker not started", "taskQueue", taskQueue, "error", err)
+		return
+	}
+	if err := receiver.Start(addr); err != nil {
+		slog.Error("Access log push receiver not started", "addr", addr, "error", err)
+		return
+	}
+	slog.Info("Access log push receiver started", "addr", addr, "taskQueue", taskQueue)
+}
@@ -0,0 +1,211 @@
+package httppush
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"danny.vn/hotpot/pkg/ingest/accesslog"
+)
+
+// spool persists accepted entries until their window is stored in bronze.
+// Entries are appended as JSON lines to one file per source and window,
+// {dir}/{source}/{window start unix}.ndjson, and synced before the request
+// is acknowledged. A window's files are removed once it is stored, so the
+// spool holds exactly the entries not yet in bronze.
+type spool struct {
+	dir string
+
+	mu   sync.Mutex
+	size int64
+}
+
+// openSpool creates the spool directory if needed and measures its size.
+func openSpool(dir string) (*spool, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("create spool dir: %w", err)
+	}
+	s := &spool{dir: dir}
+	err := filepath.WalkDir(dir, func(_ string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		s.size += info.Size()
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read spool dir: %w", err)
+	}
+	return s, nil
+}
+
+// Size returns the total size of the spool files in bytes.
+func (s *spool) Size() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.size
+}
+
+func (s *spool) sourceDir(source string) string {
+	return filepath.Join(s.dir, url.PathEscape(source))
+}
+
+func (s *spool) windowFile(source string, start time.Time) string {
+	return filepath.Join(s.sourceDir(source), strconv.FormatInt(start.Unix(), 10)+".ndjson")
+}
+
+// Append durably appends the entries of one window.
+func (s *spool) Append(source string, start time.Time, entries []accesslog.Entry) error {
+	var buf []byte
+	for _, e := range entries {
+		b, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		buf = append(append(buf, b...), '\n')
+	}
+
+	if err := os.MkdirAll(s.sourceDir(source), 0o750); err != nil {
+		return fmt.Errorf("create spool dir: %w", err)
+	}
+	f, err := os.OpenFile(s.windowFile(source, start), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o640)
+	if err != nil {
+		return fmt.Errorf("open spool: %w", err)
+	}
+	_, err = f.Write(buf)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("write spool: %w", err)
+	}
+
+	s.mu.Lock()
+	s.size += int64(len(buf))
+	s.mu.Unlock()
+	return nil
+}
+
+// Remove deletes the files of the windows starting in [start, end).
+func (s *spool) Remove(source string, start, end time.Time) error {
+	files, err := s.files(source)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if f.start.Before(start) || !f.start.Before(end) {
+			continue
+		}
+		info, err := os.Stat(f.path)
+		if err != nil {
+			continue
+		}
+		if err := os.Remove(f.path); err != nil {
+			return fmt.Errorf("remove spool: %w", err)
+		}
+		s.mu.Lock()
+		s.size -= info.Size()
+		s.mu.Unlock()
+	}
+	return nil
+}
+
+// Sources returns the names of the sources with spooled entries.
+func (s *spool) Sources() ([]string, error) {
+	dirs, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("read spool dir: %w", err)
+	}
+	var names []string
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+		if name, err := url.PathUnescape(d.Name()); err == nil {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// Load calls fn for every spooled entry of a source whose window starts in
+// [start, end); a zero end leaves the range open. Lines that do not decode,
+// such as one cut short by a crash, are skipped.
+func (s *spool) Load(source string, start, end time.Time, fn func(accesslog.Entry)) error {
+	files, err := s.files(source)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if f.start.Before(start) || (!end.IsZero() && !f.start.Before(end)) {
+			continue
+		}
+		if err := loadFile(f.path, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func loadFile(path string, fn func(accesslog.Entry)) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open spool: %w", err)
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 64*1024), 1<<20)
+	for sc.Scan() {
+		var e accesslog.Entry
+		if err := json.Unmarshal(sc.Bytes(), &e); err != nil {
+			continue
+		}
+		fn(e)
+	}
+	if err := sc.Err(); err != nil {
+		return fmt.Errorf("read spool %s: %w", path, err)
+	}
+	return nil
+}
+
+type spoolFile struct {
+	path  string
+	start time.Time
+}
+
+func (s *spool) files(source string) ([]spoolFile, error) {
+	entries, err := os.ReadDir(s.sourceDir(source))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read spool dir: %w", err)
+	}
+	var files []spoolFile
+	for _, e := range entries {
+		secs, err := strconv.ParseInt(strings.TrimSuffix(e.Name(), ".ndjson"), 10, 64)
+		if err != nil || e.IsDir() {
+			continue
+		}
+		files = append(files, spoolFile{
+			path:  filepath.Join(s.sourceDir(source), e.Name()),
+			start: time.Unix(secs, 0),
+		})
+	}
+	return files, nil
+}
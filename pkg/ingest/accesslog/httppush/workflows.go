@@ -0,0 +1,48 @@
+package httppush
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/base/temporalerr"
+	"danny.vn/hotpot/pkg/ingest/accesslog"
+)
+
+// HTTPPushTrafficWorkflow flushes the traffic counts received for a single
+// push source to bronze. The windows live in the receiver's memory and
+// spool, so the flush runs on the receiver host's task queue.
+func HTTPPushTrafficWorkflow(ctx workflow.Context, params accesslog.ServiceWorkflowParams) (*accesslog.ServiceWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting HTTPPushTrafficWorkflow", "sourceID", params.Name)
+
+	activityOpts := workflow.ActivityOptions{
+		TaskQueue:              TaskQueue(params.ReceiverHost),
+		ScheduleToStartTimeout: 10 * time.Minute,
+		StartToCloseTimeout:    20 * time.Minute,
+		HeartbeatTimeout:       2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result accesslog.ServiceWorkflowResult
+	err := workflow.ExecuteActivity(activityCtx, FlushPushedLogsActivity, params).
+		Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to flush pushed access logs",
+			"sourceID", params.Name, "error", err)
+		return nil, temporalerr.PropagateNonRetryable(err)
+	}
+
+	logger.Info("Completed HTTPPushTrafficWorkflow",
+		"sourceID", result.Name,
+		"counts", result.Counts)
+
+	return &result, nil
+}
@@ -0,0 +1,153 @@
+package accesslog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Standard field names. FieldMapping maps these to the keys of a source's
+// records; an unmapped name is read from the record key of the same name.
+const (
+	FieldTimestamp     = "timestamp"
+	FieldURI           = "uri"
+	FieldMethod        = "method"
+	FieldStatus        = "status"
+	FieldBodyBytesSent = "body_bytes_sent"
+	FieldRequestTime   = "request_time" // seconds
+	FieldHTTPHost      = "http_host"
+	FieldUserAgent     = "http_user_agent"
+	FieldRemoteAddr    = "remote_addr"
+)
+
+// Record is one parsed access log line, keyed by field name.
+type Record map[string]string
+
+// Get returns the value of a standard field: the record key fm maps it to,
+// or the key of the same name when the record lacks the mapped key.
+func (r Record) Get(fm map[string]string, name string) string {
+	if key := fm[name]; key != "" {
+		if v, ok := r[key]; ok {
+			return v
+		}
+	}
+	return r[name]
+}
+
+// Entry is one request read from an access log.
+type Entry struct {
+	Time        time.Time `json:"time"`
+	URI         string    `json:"uri"`
+	Method      string    `json:"method,omitempty"`
+	Status      int       `json:"status"`
+	BodyBytes   int64     `json:"body_bytes,omitempty"`
+	RequestTime float64   `json:"request_time,omitempty"`
+	HTTPHost    string    `json:"http_host,omitempty"`
+	UserAgent   string    `json:"user_agent,omitempty"`
+	ClientIP    string    `json:"client_ip,omitempty"`
+}
+
+// Entry converts r to an Entry. The query string is dropped from the URI,
+// as nginx's $uri does, and the client IP is the first address of a
+// forwarded-for list with any port removed.
+func (r Record) Entry(fm map[string]string) (Entry, error) {
+	ts, err := ParseTimestamp(r.Get(fm, FieldTimestamp))
+	if err != nil {
+		return Entry{}, err
+	}
+	status, err := strconv.Atoi(r.Get(fm, FieldStatus))
+	if err != nil {
+		return Entry{}, fmt.Errorf("parse status: %w", err)
+	}
+
+	uri := r.Get(fm, FieldURI)
+	if i := strings.IndexAny(uri, "?#"); i >= 0 {
+		uri = uri[:i]
+	}
+
+	e := Entry{
+		Time:      ts,
+		URI:       uri,
+		Method:    strings.ToUpper(r.Get(fm, FieldMethod)),
+		Status:    status,
+		HTTPHost:  r.Get(fm, FieldHTTPHost),
+		UserAgent: r.Get(fm, FieldUserAgent),
+		ClientIP:  clientIP(r.Get(fm, FieldRemoteAddr)),
+	}
+	e.BodyBytes, _ = strconv.ParseInt(r.Get(fm, FieldBodyBytesSent), 10, 64)
+	e.RequestTime, _ = strconv.ParseFloat(r.Get(fm, FieldRequestTime), 64)
+	return e, nil
+}
+
+// clientIP returns the first address of a comma-separated list without its
+// port, e.g. "203.0.113.7:51234, 10.0.0.1" → "203.0.113.7".
+func clientIP(s string) string {
+	s, _, _ = strings.Cut(s, ",")
+	s = strings.TrimSpace(s)
+	if s == "-" {
+		return ""
+	}
+	if host, _, err := net.SplitHostPort(s); err == nil {
+		return host
+	}
+	return s
+}
+
+// timestampLayouts are the time formats of the supported log formats.
+var timestampLayouts = []string{
+	time.RFC3339Nano,             // nginx $time_iso8601, Envoy, ALB
+	"02/Jan/2006:15:04:05 -0700", // nginx $time_local
+	"2006-01-02 15:04:05",
+}
+
+// ParseTimestamp parses a log timestamp in any supported layout, or as Unix
+// seconds with an optional fraction (nginx $msec).
+func ParseTimestamp(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, fmt.Errorf("missing timestamp")
+	}
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	if secs, err := strconv.ParseFloat(s, 64); err == nil {
+		return time.Unix(0, int64(secs*float64(time.Second))), nil
+	}
+	return time.Time{}, fmt.Errorf("unrecognized timestamp %q", s)
+}
+
+// ParseJSONRecord parses one JSON log line. Nested objects are flattened
+// with dotted keys ({"request":{"uri":"/"}} → "request.uri"), which is how
+// FieldMapping refers to them.
+func ParseJSONRecord(line []byte) (Record, error) {
+	dec := json.NewDecoder(bytes.NewReader(line))
+	dec.UseNumber()
+	var obj map[string]any
+	if err := dec.Decode(&obj); err != nil {
+		return nil, fmt.Errorf("parse json: %w", err)
+	}
+	rec := make(Record, len(obj))
+	flattenJSON(rec, "", obj)
+	return rec, nil
+}
+
+func flattenJSON(rec Record, prefix string, obj map[string]any) {
+	for k, v := range obj {
+		key := prefix + k
+		switch v := v.(type) {
+		case map[string]any:
+			flattenJSON(rec, key+".", v)
+		case string:
+			rec[key] = v
+		case json.Number:
+			rec[key] = v.String()
+		case bool:
+			rec[key] = strconv.FormatBool(v)
+		}
+	}
+}
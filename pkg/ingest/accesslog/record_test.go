@@ -0,0 +1,137 @@
+package accesslog
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecordGet(t *testing.T) {
+	rec := Record{"req_uri": "/mapped", "uri": "/plain", "status": "200"}
+	fm := map[string]string{FieldURI: "req_uri", FieldStatus: "code"}
+
+	if got := rec.Get(fm, FieldURI); got != "/mapped" {
+		t.Errorf("mapped key: got %q", got)
+	}
+	// The mapped key is missing, so the standard name is used.
+	if got := rec.Get(fm, FieldStatus); got != "200" {
+		t.Errorf("fallback: got %q", got)
+	}
+	if got := rec.Get(nil, FieldMethod); got != "" {
+		t.Errorf("missing: got %q", got)
+	}
+}
+
+func TestRecordEntry(t *testing.T) {
+	rec := Record{
+		"time":            "2026-03-01T10:00:00+07:00",
+		"request.uri":     "/search?q=x#top",
+		"method":          "post",
+		"status":          "201",
+		"body_bytes_sent": "512",
+		"request_time":    "0.25",
+		"http_host":       "api.example",
+		"http_user_agent": "curl/8.5",
+		"remote_addr":     "203.0.113.7:51234, 10.0.0.1",
+	}
+	fm := map[string]string{FieldTimestamp: "time", FieldURI: "request.uri"}
+
+	got, err := rec.Entry(fm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Entry{
+		Time:        time.Date(2026, 3, 1, 3, 0, 0, 0, time.UTC),
+		URI:         "/search",
+		Method:      "POST",
+		Status:      201,
+		BodyBytes:   512,
+		RequestTime: 0.25,
+		HTTPHost:    "api.example",
+		UserAgent:   "curl/8.5",
+		ClientIP:    "203.0.113.7",
+	}
+	if !got.Time.Equal(want.Time) {
+		t.Errorf("Time = %v, want %v", got.Time, want.Time)
+	}
+	got.Time = want.Time
+	if got != want {
+		t.Errorf("Entry = %+v, want %+v", got, want)
+	}
+
+	for _, bad := range []Record{
+		{"status": "200"},
+		{"timestamp": "2026-03-01T10:00:00Z", "status": "-"},
+	} {
+		if _, err := bad.Entry(nil); err == nil {
+			t.Errorf("Entry(%v): expected error", bad)
+		}
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	tests := map[string]string{
+		"203.0.113.7":              "203.0.113.7",
+		"203.0.113.7:443":          "203.0.113.7",
+		" 198.51.100.1 , 10.0.0.1": "198.51.100.1",
+		"[2001:db8::1]:8080":       "2001:db8::1",
+		"2001:db8::1":              "2001:db8::1",
+		"-":                        "",
+		"":                         "",
+	}
+	for input, want := range tests {
+		if got := clientIP(input); got != want {
+			t.Errorf("clientIP(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestParseTimestamp(t *testing.T) {
+	want := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	for _, s := range []string{
+		"2026-03-01T10:00:00Z",
+		"2026-03-01T17:00:00+07:00",
+		"01/Mar/2026:10:00:00 +0000",
+		"2026-03-01 10:00:00",
+		"1772359200",
+	} {
+		got, err := ParseTimestamp(s)
+		if err != nil {
+			t.Errorf("ParseTimestamp(%q): %v", s, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("ParseTimestamp(%q) = %v, want %v", s, got, want)
+		}
+	}
+
+	// $msec has millisecond resolution; float parsing is exact to well
+	// within it.
+	if got, err := ParseTimestamp("1772359200.250"); err != nil || !got.Round(time.Millisecond).Equal(want.Add(250*time.Millisecond)) {
+		t.Errorf("msec: got %v, %v", got, err)
+	}
+	for _, s := range []string{"", "yesterday"} {
+		if _, err := ParseTimestamp(s); err == nil {
+			t.Errorf("ParseTimestamp(%q): expected error", s)
+		}
+	}
+}
+
+func TestParseJSONRecord(t *testing.T) {
+	rec, err := ParseJSONRecord([]byte(`{"status":200,"request":{"uri":"/","tls":true},"upstream":null,"tags":["a"]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Record{"status": "200", "request.uri": "/", "request.tls": "true"}
+	if len(rec) != len(want) {
+		t.Errorf("record = %v, want %v", rec, want)
+	}
+	for k, v := range want {
+		if rec[k] != v {
+			t.Errorf("%s = %q, want %q", k, rec[k], v)
+		}
+	}
+
+	if _, err := ParseJSONRecord([]byte(`not json`)); err == nil {
+		t.Error("invalid json: expected error")
+	}
+}
@@ -0,0 +1,239 @@
+package accesslog
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"time"
+
+	entaccesslog "danny.vn/hotpot/pkg/storage/ent/accesslog"
+)
+
+// CountKey groups requests into one bronze HTTP count row.
+type CountKey struct {
+	URI    string
+	Method string
+	Status int
+}
+
+// Count is the aggregate of one CountKey in a window.
+type Count struct {
+	Requests         int64
+	TotalBodyBytes   int64
+	TotalRequestTime float64
+	MaxRequestTime   float64
+	HTTPHost         string // any host seen, as BigQuery's ANY_VALUE
+}
+
+// RequestKey groups requests by URI and method, for user agent and client
+// IP rows.
+type RequestKey struct {
+	URI    string
+	Method string
+}
+
+// Window holds the aggregates of one collection window, in the shape of the
+// bronze accesslog tables.
+type Window struct {
+	Start      time.Time
+	End        time.Time
+	Counts     map[CountKey]*Count
+	UserAgents map[RequestKey]map[string]int64
+	ClientIPs  map[RequestKey]map[string]int64
+}
+
+// Aggregator buckets log entries into fixed windows, producing the same
+// aggregates the gcplogging source computes in BigQuery.
+type Aggregator struct {
+	interval time.Duration
+	start    time.Time
+	end      time.Time
+	windows  map[time.Time]*Window
+}
+
+// NewAggregator returns an aggregator for entries in [start, end), which
+// should be aligned to interval. Entries outside the range are ignored; a
+// zero end leaves the range open.
+func NewAggregator(interval time.Duration, start, end time.Time) *Aggregator {
+	return &Aggregator{
+		interval: interval,
+		start:    start,
+		end:      end,
+		windows:  make(map[time.Time]*Window),
+	}
+}
+
+// Add counts e in its window. It reports whether e was inside the range.
+func (a *Aggregator) Add(e Entry) bool {
+	if e.Time.Before(a.start) || (!a.end.IsZero() && !e.Time.Before(a.end)) {
+		return false
+	}
+	start := e.Time.Truncate(a.interval)
+	w, ok := a.windows[start]
+	if !ok {
+		w = &Window{
+			Start:      start,
+			End:        start.Add(a.interval),
+			Counts:     make(map[CountKey]*Count),
+			UserAgents: make(map[RequestKey]map[string]int64),
+			ClientIPs:  make(map[RequestKey]map[string]int64),
+		}
+		a.windows[start] = w
+	}
+
+	ck := CountKey{URI: e.URI, Method: e.Method, Status: e.Status}
+	c, ok := w.Counts[ck]
+	if !ok {
+		c = &Count{}
+		w.Counts[ck] = c
+	}
+	c.Requests++
+	c.TotalBodyBytes += e.BodyBytes
+	c.TotalRequestTime += e.RequestTime
+	c.MaxRequestTime = max(c.MaxRequestTime, e.RequestTime)
+	if c.HTTPHost == "" {
+		c.HTTPHost = e.HTTPHost
+	}
+
+	rk := RequestKey{URI: e.URI, Method: e.Method}
+	addTo(w.UserAgents, rk, e.UserAgent)
+	addTo(w.ClientIPs, rk, e.ClientIP)
+	return true
+}
+
+func addTo(m map[RequestKey]map[string]int64, key RequestKey, value string) {
+	if value == "" {
+		return
+	}
+	inner, ok := m[key]
+	if !ok {
+		inner = make(map[string]int64)
+		m[key] = inner
+	}
+	inner[value]++
+}
+
+// Windows returns the non-empty windows in time order.
+func (a *Aggregator) Windows() []*Window {
+	out := make([]*Window, 0, len(a.windows))
+	for _, w := range a.windows {
+		out = append(out, w)
+	}
+	slices.SortFunc(out, func(x, y *Window) int { return x.Start.Compare(y.Start) })
+	return out
+}
+
+// Take removes and returns the windows that end at or before t, in time order.
+func (a *Aggregator) Take(t time.Time) []*Window {
+	var out []*Window
+	for start, w := range a.windows {
+		if !w.End.After(t) {
+			out = append(out, w)
+			delete(a.windows, start)
+		}
+	}
+	slices.SortFunc(out, func(x, y *Window) int { return x.Start.Compare(y.Start) })
+	return out
+}
+
+// StoreWindow writes the aggregates of w to the bronze accesslog tables
+// under sourceName and returns the number of HTTP count rows created. Every
+// accesslog source stores its windows through it. Resource IDs are derived
+// from the source, window start and group, so re-storing a window is a
+// no-op.
+func StoreWindow(ctx context.Context, entClient *entaccesslog.Client, sourceName string, w *Window) (int, error) {
+	collectedAt := time.Now()
+	windowKey := sourceName + ":" + w.Start.Format(time.RFC3339)
+	created := 0
+
+	for k, c := range w.Counts {
+		resourceID := fmt.Sprintf("%s:%s:%s:%d", windowKey, k.URI, k.Method, k.Status)
+
+		create := entClient.BronzeAccesslogHttpCount.Create().
+			SetID(resourceID).
+			SetSourceID(sourceName).
+			SetWindowStart(w.Start).
+			SetWindowEnd(w.End).
+			SetURI(k.URI).
+			SetStatusCode(k.Status).
+			SetRequestCount(c.Requests).
+			SetTotalBodyBytesSent(c.TotalBodyBytes).
+			SetCollectedAt(collectedAt).
+			SetFirstCollectedAt(collectedAt)
+
+		if k.Method != "" {
+			create.SetMethod(k.Method)
+		}
+		if c.HTTPHost != "" {
+			create.SetHTTPHost(c.HTTPHost)
+		}
+		if c.TotalRequestTime > 0 {
+			create.SetTotalRequestTime(c.TotalRequestTime)
+		}
+		if c.MaxRequestTime > 0 {
+			create.SetMaxRequestTime(c.MaxRequestTime)
+		}
+
+		if err := create.Exec(ctx); err != nil {
+			if !entaccesslog.IsConstraintError(err) {
+				return created, fmt.Errorf("create http count %s: %w", resourceID, err)
+			}
+			continue
+		}
+		created++
+	}
+
+	for k, agents := range w.UserAgents {
+		for ua, n := range agents {
+			resourceID := fmt.Sprintf("%s:%s:%s:%s", windowKey, k.URI, k.Method, sha256Short(ua))
+
+			err := entClient.BronzeAccesslogUserAgent.Create().
+				SetID(resourceID).
+				SetSourceID(sourceName).
+				SetWindowStart(w.Start).
+				SetWindowEnd(w.End).
+				SetURI(k.URI).
+				SetMethod(k.Method).
+				SetUserAgent(ua).
+				SetRequestCount(n).
+				SetCollectedAt(collectedAt).
+				SetFirstCollectedAt(collectedAt).
+				Exec(ctx)
+			if err != nil && !entaccesslog.IsConstraintError(err) {
+				return created, fmt.Errorf("create user agent %s: %w", resourceID, err)
+			}
+		}
+	}
+
+	for k, ips := range w.ClientIPs {
+		for ip, n := range ips {
+			resourceID := fmt.Sprintf("%s:%s:%s:%s", windowKey, k.URI, k.Method, ip)
+
+			err := entClient.BronzeAccesslogClientIp.Create().
+				SetID(resourceID).
+				SetSourceID(sourceName).
+				SetWindowStart(w.Start).
+				SetWindowEnd(w.End).
+				SetURI(k.URI).
+				SetMethod(k.Method).
+				SetClientIP(ip).
+				SetRequestCount(n).
+				SetCollectedAt(collectedAt).
+				SetFirstCollectedAt(collectedAt).
+				Exec(ctx)
+			if err != nil && !entaccesslog.IsConstraintError(err) {
+				return created, fmt.Errorf("create client ip %s: %w", resourceID, err)
+			}
+		}
+	}
+
+	return created, nil
+}
+
+// sha256Short returns the first 8 hex chars of the SHA-256 hash of s.
+func sha256Short(s string) string {
+	h := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(h[:4])
+}
@@ -0,0 +1,149 @@
+package accesslog
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "modernc.org/sqlite"
+
+	entaccesslog "danny.vn/hotpot/pkg/storage/ent/accesslog"
+	"danny.vn/hotpot/pkg/storage/ent/accesslog/bronzeaccessloghttpcount"
+)
+
+var t0 = time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+func TestAggregator(t *testing.T) {
+	agg := NewAggregator(5*time.Minute, t0, t0.Add(15*time.Minute))
+
+	entries := []struct {
+		e    Entry
+		want bool
+	}{
+		{Entry{Time: t0.Add(-time.Second), URI: "/", Status: 200}, false},
+		{Entry{Time: t0, URI: "/api", Method: "GET", Status: 200, BodyBytes: 100, RequestTime: 0.2, HTTPHost: "a.example", UserAgent: "curl", ClientIP: "198.51.100.1"}, true},
+		{Entry{Time: t0.Add(time.Minute), URI: "/api", Method: "GET", Status: 200, BodyBytes: 50, RequestTime: 0.5, HTTPHost: "b.example", UserAgent: "curl", ClientIP: "198.51.100.2"}, true},
+		{Entry{Time: t0.Add(2 * time.Minute), URI: "/api", Method: "GET", Status: 500}, true},
+		{Entry{Time: t0.Add(11 * time.Minute), URI: "/health", Method: "GET", Status: 200}, true},
+		{Entry{Time: t0.Add(15 * time.Minute), URI: "/", Status: 200}, false},
+	}
+	for _, tt := range entries {
+		if got := agg.Add(tt.e); got != tt.want {
+			t.Errorf("Add(%s %s) = %v, want %v", tt.e.Time.Format(time.TimeOnly), tt.e.URI, got, tt.want)
+		}
+	}
+
+	windows := agg.Windows()
+	if len(windows) != 2 || !windows[0].Start.Equal(t0) || !windows[1].Start.Equal(t0.Add(10*time.Minute)) {
+		t.Fatalf("windows = %+v", windows)
+	}
+	w := windows[0]
+	if !w.End.Equal(t0.Add(5 * time.Minute)) {
+		t.Errorf("End = %v", w.End)
+	}
+	c := w.Counts[CountKey{URI: "/api", Method: "GET", Status: 200}]
+	if c == nil || c.Requests != 2 || c.TotalBodyBytes != 150 || c.TotalRequestTime != 0.7 || c.MaxRequestTime != 0.5 || c.HTTPHost != "a.example" {
+		t.Errorf("count = %+v", c)
+	}
+	if c := w.Counts[CountKey{URI: "/api", Method: "GET", Status: 500}]; c == nil || c.Requests != 1 {
+		t.Errorf("500 count = %+v", c)
+	}
+	rk := RequestKey{URI: "/api", Method: "GET"}
+	if n := w.UserAgents[rk]["curl"]; n != 2 || len(w.UserAgents[rk]) != 1 {
+		t.Errorf("user agents = %v (entries without one are not counted)", w.UserAgents[rk])
+	}
+	if len(w.ClientIPs[rk]) != 2 {
+		t.Errorf("client ips = %v", w.ClientIPs[rk])
+	}
+
+	// An open range accepts anything from start on.
+	if !NewAggregator(time.Minute, t0, time.Time{}).Add(Entry{Time: t0.AddDate(1, 0, 0)}) {
+		t.Error("open range rejected a late entry")
+	}
+}
+
+func TestAggregatorTake(t *testing.T) {
+	agg := NewAggregator(5*time.Minute, t0, time.Time{})
+	for _, m := range []int{0, 6, 12} {
+		agg.Add(Entry{Time: t0.Add(time.Duration(m) * time.Minute), URI: "/", Status: 200})
+	}
+
+	taken := agg.Take(t0.Add(10 * time.Minute))
+	if len(taken) != 2 || !taken[0].Start.Equal(t0) || !taken[1].Start.Equal(t0.Add(5*time.Minute)) {
+		t.Fatalf("Take = %+v", taken)
+	}
+	if rest := agg.Windows(); len(rest) != 1 || !rest[0].Start.Equal(t0.Add(10*time.Minute)) {
+		t.Errorf("remaining = %+v", rest)
+	}
+	if taken := agg.Take(t0.Add(10 * time.Minute)); len(taken) != 0 {
+		t.Errorf("second Take = %+v", taken)
+	}
+}
+
+// newTestClient returns an ent client on an in-memory SQLite database with
+// the accesslog tables.
+func newTestClient(t *testing.T) *entaccesslog.Client {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:"+t.Name()+"?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := entaccesslog.NewClient(entaccesslog.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+	return client
+}
+
+func TestStoreWindow(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	agg := NewAggregator(5*time.Minute, t0, time.Time{})
+	agg.Add(Entry{Time: t0, URI: "/api", Method: "GET", Status: 200, BodyBytes: 10, RequestTime: 0.1, HTTPHost: "a.example", UserAgent: "curl", ClientIP: "198.51.100.1"})
+	agg.Add(Entry{Time: t0.Add(time.Second), URI: "/api", Method: "GET", Status: 404, UserAgent: "curl", ClientIP: "198.51.100.1"})
+	w := agg.Windows()[0]
+
+	created, err := StoreWindow(ctx, client, "edge", w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created != 2 {
+		t.Errorf("created = %d, want 2", created)
+	}
+
+	row, err := client.BronzeAccesslogHttpCount.Query().
+		Where(bronzeaccessloghttpcount.StatusCode(200)).
+		Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "edge:2026-03-01T10:00:00Z:/api:GET:200"; row.ID != want {
+		t.Errorf("resource id = %q, want %q", row.ID, want)
+	}
+	if row.SourceID != "edge" || row.RequestCount != 1 || row.TotalBodyBytesSent != 10 ||
+		row.HTTPHost != "a.example" || row.MaxRequestTime != 0.1 || !row.WindowEnd.Equal(t0.Add(5*time.Minute)) {
+		t.Errorf("row = %+v", row)
+	}
+
+	uas := client.BronzeAccesslogUserAgent.Query().AllX(ctx)
+	if len(uas) != 1 || uas[0].RequestCount != 2 || uas[0].ID != "edge:2026-03-01T10:00:00Z:/api:GET:"+sha256Short("curl") {
+		t.Errorf("user agents = %+v", uas)
+	}
+	if n := client.BronzeAccesslogClientIp.Query().CountX(ctx); n != 1 {
+		t.Errorf("client ip rows = %d, want 1", n)
+	}
+
+	// Storing the same window again does not duplicate rows.
+	created, err = StoreWindow(ctx, client, "edge", w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created != 0 || client.BronzeAccesslogHttpCount.Query().CountX(ctx) != 2 {
+		t.Errorf("re-store created %d rows", created)
+	}
+}
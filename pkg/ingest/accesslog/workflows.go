@@ -37,6 +37,15 @@ type ServiceWorkflowParams struct {
 	FieldMapping    map[string]string
 	IntervalMinutes int
 
+	// File log settings (filelog sources).
+	Path    string
+	Pattern string
+	Format  string
+	Region  string
+
+	// ReceiverHost runs the push receiver (httppush sources).
+	ReceiverHost string
+
 	// Backfill settings for first run (no cursor).
 	BackfillDays            int
 	BackfillIntervalMinutes int
@@ -126,6 +135,11 @@ func AccessLogWorkflow(ctx workflow.Context) (*AccessLogWorkflowResult, error) {
 			BQFilter:                src.BQFilter,
 			FieldMapping:            src.FieldMapping,
 			IntervalMinutes:         src.IntervalMinutes,
+			Path:                    src.Path,
+			Pattern:                 src.Pattern,
+			Format:                  src.Format,
+			Region:                  src.Region,
+			ReceiverHost:            src.ReceiverHost,
 			BackfillDays:            src.BackfillDays,
 			BackfillIntervalMinutes: src.BackfillIntervalMinutes,
 		}
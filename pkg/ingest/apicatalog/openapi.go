@@ -0,0 +1,228 @@
+package apicatalog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Access levels carried into the catalog, matching the URI prefix convention
+// of the CSV catalog.
+const (
+	accessPublic    = "public"
+	accessProtected = "protected"
+	accessPrivate   = "private"
+)
+
+// securityRequirements is an OpenAPI security requirement list. Each entry
+// maps scheme names to scopes; an empty entry ({}) makes auth optional.
+type securityRequirements []map[string][]string
+
+// openAPIDocument is the part of an OpenAPI 3.x or Swagger 2.0 document the
+// importer reads. Unknown fields are ignored.
+type openAPIDocument struct {
+	OpenAPI string `yaml:"openapi" json:"openapi"`
+	Swagger string `yaml:"swagger" json:"swagger"`
+	Info    struct {
+		Title   string `yaml:"title" json:"title"`
+		Version string `yaml:"version" json:"version"`
+	} `yaml:"info" json:"info"`
+	Servers     []openAPIServer             `yaml:"servers" json:"servers"`
+	BasePath    string                      `yaml:"basePath" json:"basePath"`
+	Security    securityRequirements        `yaml:"security" json:"security"`
+	AccessLevel string                      `yaml:"x-access-level" json:"x-access-level"`
+	Paths       map[string]*openAPIPathItem `yaml:"paths" json:"paths"`
+}
+
+type openAPIServer struct {
+	URL       string `yaml:"url" json:"url"`
+	Variables map[string]struct {
+		Default string `yaml:"default" json:"default"`
+	} `yaml:"variables" json:"variables"`
+}
+
+type openAPIPathItem struct {
+	Get         *openAPIOperation `yaml:"get" json:"get"`
+	Put         *openAPIOperation `yaml:"put" json:"put"`
+	Post        *openAPIOperation `yaml:"post" json:"post"`
+	Delete      *openAPIOperation `yaml:"delete" json:"delete"`
+	Options     *openAPIOperation `yaml:"options" json:"options"`
+	Head        *openAPIOperation `yaml:"head" json:"head"`
+	Patch       *openAPIOperation `yaml:"patch" json:"patch"`
+	Trace       *openAPIOperation `yaml:"trace" json:"trace"`
+	AccessLevel string            `yaml:"x-access-level" json:"x-access-level"`
+}
+
+type openAPIOperation struct {
+	OperationID string   `yaml:"operationId" json:"operationId"`
+	Summary     string   `yaml:"summary" json:"summary"`
+	Tags        []string `yaml:"tags" json:"tags"`
+	Deprecated  bool     `yaml:"deprecated" json:"deprecated"`
+	// Security is nil when the operation inherits the document's requirements;
+	// an explicit empty list removes them.
+	Security    *securityRequirements `yaml:"security" json:"security"`
+	AccessLevel string                `yaml:"x-access-level" json:"x-access-level"`
+}
+
+// operations returns the operations of a path item keyed by HTTP method.
+func (p *openAPIPathItem) operations() map[string]*openAPIOperation {
+	ops := map[string]*openAPIOperation{
+		"GET":     p.Get,
+		"PUT":     p.Put,
+		"POST":    p.Post,
+		"DELETE":  p.Delete,
+		"OPTIONS": p.Options,
+		"HEAD":    p.Head,
+		"PATCH":   p.Patch,
+		"TRACE":   p.Trace,
+	}
+	for method, op := range ops {
+		if op == nil {
+			delete(ops, method)
+		}
+	}
+	return ops
+}
+
+// openAPISpec is a parsed document expanded into one endpoint per operation.
+type openAPISpec struct {
+	title          string
+	version        string
+	openAPIVersion string
+	endpoints      []openAPIEndpoint
+}
+
+type openAPIEndpoint struct {
+	uri         string
+	method      string
+	operationID string
+	summary     string
+	tags        []string
+	security    securityRequirements
+	accessLevel string
+	deprecated  bool
+}
+
+// key identifies an endpoint within its spec.
+func (e openAPIEndpoint) key() string {
+	return e.method + " " + e.uri
+}
+
+// errNotOpenAPI is returned by parseOpenAPI for a document with neither an
+// openapi nor a swagger field.
+var errNotOpenAPI = errors.New("not an OpenAPI document: missing openapi or swagger field")
+
+// parseOpenAPI parses an OpenAPI 3.x or Swagger 2.0 document in YAML or JSON.
+func parseOpenAPI(data []byte) (*openAPISpec, error) {
+	var doc openAPIDocument
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		if err := json.Unmarshal(trimmed, &doc); err != nil {
+			return nil, fmt.Errorf("decode json: %w", err)
+		}
+	} else if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("decode yaml: %w", err)
+	}
+
+	var basePath string
+	switch {
+	case strings.HasPrefix(doc.OpenAPI, "3."):
+		basePath = serverBasePath(doc.Servers)
+	case doc.Swagger == "2.0":
+		basePath = doc.BasePath
+	case doc.OpenAPI != "":
+		return nil, fmt.Errorf("unsupported openapi version %q", doc.OpenAPI)
+	case doc.Swagger != "":
+		return nil, fmt.Errorf("unsupported swagger version %q", doc.Swagger)
+	default:
+		return nil, errNotOpenAPI
+	}
+	basePath = strings.TrimRight(basePath, "/")
+
+	spec := &openAPISpec{
+		title:          strings.TrimSpace(doc.Info.Title),
+		version:        doc.Info.Version,
+		openAPIVersion: doc.OpenAPI + doc.Swagger,
+	}
+
+	for path, item := range doc.Paths {
+		if item == nil || !strings.HasPrefix(path, "/") {
+			continue
+		}
+		uri := basePath + path
+		if path == "/" && basePath != "" {
+			uri = basePath
+		}
+		for method, op := range item.operations() {
+			security := doc.Security
+			if op.Security != nil {
+				security = *op.Security
+			}
+			spec.endpoints = append(spec.endpoints, openAPIEndpoint{
+				uri:         uri,
+				method:      method,
+				operationID: op.OperationID,
+				summary:     op.Summary,
+				tags:        op.Tags,
+				security:    security,
+				accessLevel: accessLevel(security, op.AccessLevel, item.AccessLevel, doc.AccessLevel),
+				deprecated:  op.Deprecated,
+			})
+		}
+	}
+
+	sort.Slice(spec.endpoints, func(i, j int) bool {
+		a, b := spec.endpoints[i], spec.endpoints[j]
+		if a.uri != b.uri {
+			return a.uri < b.uri
+		}
+		return a.method < b.method
+	})
+	return spec, nil
+}
+
+// serverBasePath returns the path of the first server URL, with server
+// variables replaced by their defaults. Both absolute ("https://host/v1")
+// and relative ("/v1") URLs are accepted.
+func serverBasePath(servers []openAPIServer) string {
+	if len(servers) == 0 {
+		return ""
+	}
+	raw := servers[0].URL
+	for name, v := range servers[0].Variables {
+		raw = strings.ReplaceAll(raw, "{"+name+"}", v.Default)
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	return u.Path
+}
+
+// accessLevel derives the catalog access level of an operation. An
+// x-access-level extension on the operation, path item or document wins, in
+// that order. Otherwise an operation that requires a security scheme is
+// protected, and one without requirements, or where auth is optional, is
+// public.
+func accessLevel(security securityRequirements, overrides ...string) string {
+	for _, o := range overrides {
+		switch level := strings.ToLower(strings.TrimSpace(o)); level {
+		case accessPublic, accessProtected, accessPrivate:
+			return level
+		}
+	}
+	if len(security) == 0 {
+		return accessPublic
+	}
+	for _, req := range security {
+		if len(req) == 0 {
+			return accessPublic
+		}
+	}
+	return accessProtected
+}
@@ -0,0 +1,168 @@
+package apicatalog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testOAS3 = `
+openapi: 3.0.3
+info:
+  title: Orders API
+  version: "1.4"
+servers:
+  - url: https://{region}.example.com/{base}/
+    variables:
+      region: {default: eu}
+      base: {default: v1}
+security:
+  - bearerAuth: []
+paths:
+  /orders:
+    get:
+      operationId: listOrders
+      tags: [orders]
+    post:
+      operationId: createOrder
+  /orders/{id}:
+    parameters:
+      - name: id
+        in: path
+    get:
+      security: []
+    delete:
+      deprecated: true
+      x-access-level: Private
+  /health:
+    x-access-level: public
+    get: {}
+  /me:
+    get:
+      security:
+        - {}
+        - bearerAuth: []
+`
+
+const testSwagger2 = `{
+	"swagger": "2.0",
+	"info": {"title": "Legacy", "version": "0.9"},
+	"basePath": "/legacy",
+	"paths": {
+		"/": {"get": {}},
+		"/items/{itemId}.json": {
+			"put": {"security": [{"apiKey": []}]}
+		}
+	}
+}`
+
+func TestParseOpenAPI3(t *testing.T) {
+	spec, err := parseOpenAPI([]byte(testOAS3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spec.title != "Orders API" || spec.version != "1.4" || spec.openAPIVersion != "3.0.3" {
+		t.Errorf("spec = %q %q %q", spec.title, spec.version, spec.openAPIVersion)
+	}
+
+	want := []struct {
+		key, access string
+	}{
+		{"GET /v1/health", accessPublic},
+		{"GET /v1/me", accessPublic},
+		{"GET /v1/orders", accessProtected},
+		{"POST /v1/orders", accessProtected},
+		{"DELETE /v1/orders/{id}", accessPrivate},
+		{"GET /v1/orders/{id}", accessPublic},
+	}
+	if len(spec.endpoints) != len(want) {
+		t.Fatalf("got %d endpoints, want %d", len(spec.endpoints), len(want))
+	}
+	for i, w := range want {
+		ep := spec.endpoints[i]
+		if ep.key() != w.key || ep.accessLevel != w.access {
+			t.Errorf("endpoint %d = %s (%s), want %s (%s)", i, ep.key(), ep.accessLevel, w.key, w.access)
+		}
+	}
+	if ep := spec.endpoints[2]; ep.operationID != "listOrders" || len(ep.tags) != 1 || len(ep.security) != 1 {
+		t.Errorf("listOrders = %+v", ep)
+	}
+	if ep := spec.endpoints[4]; !ep.deprecated {
+		t.Errorf("delete order not deprecated")
+	}
+}
+
+func TestParseSwagger2(t *testing.T) {
+	spec, err := parseOpenAPI([]byte(testSwagger2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(spec.endpoints) != 2 {
+		t.Fatalf("got %d endpoints", len(spec.endpoints))
+	}
+	if ep := spec.endpoints[0]; ep.key() != "GET /legacy" || ep.accessLevel != accessPublic {
+		t.Errorf("root = %s (%s)", ep.key(), ep.accessLevel)
+	}
+	if ep := spec.endpoints[1]; ep.key() != "PUT /legacy/items/{itemId}.json" || ep.accessLevel != accessProtected {
+		t.Errorf("item = %s (%s)", ep.key(), ep.accessLevel)
+	}
+}
+
+func TestParseOpenAPIErrors(t *testing.T) {
+	for name, doc := range map[string]string{
+		"not openapi": "title: x\n",
+		"openapi 4":   "openapi: 4.0.0\n",
+		"swagger 1.2": `{"swagger": "1.2"}`,
+		"bad yaml":    "openapi: [\n",
+	} {
+		if _, err := parseOpenAPI([]byte(doc)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestReadOpenAPIInputs(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, data string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("orders.yaml", testOAS3)
+	write("legacy.json", testSwagger2)
+	write("notes.txt", "ignored")
+
+	inputs, err := readOpenAPIInputs(ImportOpenAPIParams{Path: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inputs) != 2 || inputs[0].name != "Legacy" || inputs[1].name != "Orders API" || inputs[1].sourceFile != "orders.yaml" {
+		t.Fatalf("inputs = %+v", inputs)
+	}
+
+	if _, err := readOpenAPIInputs(ImportOpenAPIParams{Path: dir, SpecName: "x"}); err == nil {
+		t.Error("SpecName with several specs: expected error")
+	}
+
+	// Other YAML and JSON files in the directory are skipped.
+	write("values.yaml", "replicas: 2\n")
+	if inputs, err := readOpenAPIInputs(ImportOpenAPIParams{Path: dir}); err != nil || len(inputs) != 2 {
+		t.Errorf("with a non-spec file: %d inputs, %v", len(inputs), err)
+	}
+	if _, err := readOpenAPIInputs(ImportOpenAPIParams{Path: filepath.Join(dir, "values.yaml")}); err == nil {
+		t.Error("single non-spec file: expected error")
+	}
+	empty := t.TempDir()
+	if err := os.WriteFile(filepath.Join(empty, "values.yaml"), []byte("replicas: 2\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readOpenAPIInputs(ImportOpenAPIParams{Path: empty}); err == nil {
+		t.Error("no specs: expected error")
+	}
+
+	write("orders-copy.yml", testOAS3)
+	if _, err := readOpenAPIInputs(ImportOpenAPIParams{Path: dir}); err == nil {
+		t.Error("duplicate spec name: expected error")
+	}
+}
@@ -0,0 +1,322 @@
+package apicatalog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	entapicatalog "danny.vn/hotpot/pkg/storage/ent/apicatalog"
+	"danny.vn/hotpot/pkg/storage/ent/apicatalog/bronzeapicatalogopenapiendpoint"
+)
+
+// ImportOpenAPIActivity function reference for Temporal registration.
+var ImportOpenAPIActivity = (*Activities).ImportOpenAPI
+
+// ImportOpenAPIParams holds input for the ImportOpenAPI activity.
+type ImportOpenAPIParams struct {
+	// Path is a spec file, or a directory searched recursively for
+	// *.yaml, *.yml and *.json specs; other documents there are skipped.
+	Path string
+	// SpecData is a single spec document, used instead of Path.
+	SpecData []byte
+	// SpecName overrides the spec's info.title. Only valid for one document.
+	SpecName    string
+	LogSourceID string
+	SourceFile  string
+	// DryRun reports the diff without writing.
+	DryRun bool
+}
+
+// EndpointChange identifies an endpoint added to or removed from a spec.
+type EndpointChange struct {
+	SpecName string
+	Method   string
+	URI      string
+}
+
+// ImportOpenAPIResult holds output from the ImportOpenAPI activity.
+type ImportOpenAPIResult struct {
+	Specs   int
+	Created int
+	Updated int
+	Deleted int
+	Added   []EndpointChange
+	Removed []EndpointChange
+}
+
+type openAPIInput struct {
+	name       string // spec name
+	sourceFile string
+	spec       *openAPISpec
+}
+
+// ImportOpenAPI imports the operations of OpenAPI 3.x and Swagger 2.0 specs
+// into bronze, one row per path and method. Each spec replaces its previous
+// import: endpoints no longer in the spec are deleted and reported as removed.
+func (a *Activities) ImportOpenAPI(ctx context.Context, params ImportOpenAPIParams) (*ImportOpenAPIResult, error) {
+	logger := slog.Default()
+
+	inputs, err := readOpenAPIInputs(params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ImportOpenAPIResult{Specs: len(inputs)}
+	now := time.Now()
+	for _, in := range inputs {
+		if err := a.importOpenAPISpec(ctx, in, params, now, result); err != nil {
+			return nil, fmt.Errorf("import spec %q: %w", in.name, err)
+		}
+	}
+
+	logger.InfoContext(ctx, "OpenAPI import complete",
+		"specs", result.Specs,
+		"created", result.Created,
+		"updated", result.Updated,
+		"deleted", result.Deleted,
+		"dryRun", params.DryRun)
+
+	return result, nil
+}
+
+// readOpenAPIInputs reads and parses every document before anything is
+// written, so a bad spec fails the import as a whole. In a directory, files
+// that are not OpenAPI documents are skipped with a warning.
+func readOpenAPIInputs(params ImportOpenAPIParams) ([]openAPIInput, error) {
+	type document struct {
+		file    string
+		data    []byte
+		fromDir bool
+	}
+	var docs []document
+
+	switch {
+	case len(params.SpecData) > 0:
+		docs = append(docs, document{file: params.SourceFile, data: params.SpecData})
+	case params.Path != "":
+		info, err := os.Stat(params.Path)
+		if err != nil {
+			return nil, fmt.Errorf("open spec path: %w", err)
+		}
+		var files []string
+		fromDir := info.IsDir()
+		if fromDir {
+			err := filepath.WalkDir(params.Path, func(path string, d fs.DirEntry, err error) error {
+				if err != nil || d.IsDir() {
+					return err
+				}
+				switch strings.ToLower(filepath.Ext(path)) {
+				case ".yaml", ".yml", ".json":
+					files = append(files, path)
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("read spec dir: %w", err)
+			}
+			if len(files) == 0 {
+				return nil, fmt.Errorf("no *.yaml, *.yml or *.json specs in %s", params.Path)
+			}
+		} else {
+			files = []string{params.Path}
+		}
+		for _, f := range files {
+			data, err := os.ReadFile(f)
+			if err != nil {
+				return nil, fmt.Errorf("read spec: %w", err)
+			}
+			docs = append(docs, document{file: f, data: data, fromDir: fromDir})
+		}
+	default:
+		return nil, fmt.Errorf("either Path or SpecData must be provided")
+	}
+
+	type parsedDocument struct {
+		file string
+		spec *openAPISpec
+	}
+	var parsed []parsedDocument
+	for _, d := range docs {
+		spec, err := parseOpenAPI(d.data)
+		if errors.Is(err, errNotOpenAPI) && d.fromDir {
+			slog.Warn("Skipping file that is not an OpenAPI document", "file", d.file)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", d.file, err)
+		}
+		parsed = append(parsed, parsedDocument{file: d.file, spec: spec})
+	}
+	if len(parsed) == 0 {
+		return nil, fmt.Errorf("no OpenAPI specs in %s", params.Path)
+	}
+	if params.SpecName != "" && len(parsed) > 1 {
+		return nil, fmt.Errorf("SpecName can only be set for a single spec, found %d", len(parsed))
+	}
+
+	inputs := make([]openAPIInput, 0, len(parsed))
+	seen := make(map[string]string) // spec name → file
+	for _, d := range parsed {
+		spec := d.spec
+
+		sourceFile := params.SourceFile
+		if sourceFile == "" {
+			sourceFile = filepath.Base(d.file)
+		}
+		name := params.SpecName
+		if name == "" {
+			name = spec.title
+		}
+		if name == "" {
+			name = strings.TrimSuffix(sourceFile, filepath.Ext(sourceFile))
+		}
+		if name == "" {
+			return nil, fmt.Errorf("spec %s has no info.title; set SpecName", d.file)
+		}
+		if prev, ok := seen[name]; ok {
+			return nil, fmt.Errorf("spec name %q used by both %s and %s", name, prev, d.file)
+		}
+		seen[name] = d.file
+
+		inputs = append(inputs, openAPIInput{name: name, sourceFile: sourceFile, spec: spec})
+	}
+	return inputs, nil
+}
+
+// importOpenAPISpec diffs one spec against its previous import and, unless
+// this is a dry run, upserts its endpoints and deletes the removed ones.
+func (a *Activities) importOpenAPISpec(ctx context.Context, in openAPIInput, params ImportOpenAPIParams, now time.Time, result *ImportOpenAPIResult) error {
+	existing, err := a.entClient.BronzeApicatalogOpenapiEndpoint.Query().
+		Where(bronzeapicatalogopenapiendpoint.SpecName(in.name)).
+		Select(bronzeapicatalogopenapiendpoint.FieldID,
+			bronzeapicatalogopenapiendpoint.FieldMethod,
+			bronzeapicatalogopenapiendpoint.FieldURI).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query existing endpoints: %w", err)
+	}
+	existingByKey := make(map[string]*entapicatalog.BronzeApicatalogOpenapiEndpoint, len(existing))
+	for _, row := range existing {
+		existingByKey[row.Method+" "+row.URI] = row
+	}
+
+	var created, updated []openAPIEndpoint
+	current := make(map[string]bool, len(in.spec.endpoints))
+	for _, ep := range in.spec.endpoints {
+		current[ep.key()] = true
+		if _, ok := existingByKey[ep.key()]; ok {
+			updated = append(updated, ep)
+		} else {
+			created = append(created, ep)
+			result.Added = append(result.Added, EndpointChange{SpecName: in.name, Method: ep.method, URI: ep.uri})
+		}
+	}
+	var removedIDs []string
+	for _, row := range existing {
+		if !current[row.Method+" "+row.URI] {
+			removedIDs = append(removedIDs, row.ID)
+			result.Removed = append(result.Removed, EndpointChange{SpecName: in.name, Method: row.Method, URI: row.URI})
+		}
+	}
+
+	result.Created += len(created)
+	result.Updated += len(updated)
+	result.Deleted += len(removedIDs)
+	if params.DryRun {
+		return nil
+	}
+
+	tx, err := a.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, ep := range created {
+		create := tx.BronzeApicatalogOpenapiEndpoint.Create().
+			SetID(in.name + ":" + ep.method + ":" + ep.uri).
+			SetFirstCollectedAt(now)
+		setOpenAPIEndpoint(create.Mutation(), in, params.LogSourceID, ep, now)
+		if err := create.Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("create endpoint %s: %w", ep.key(), err)
+		}
+	}
+	for _, ep := range updated {
+		update := tx.BronzeApicatalogOpenapiEndpoint.UpdateOneID(existingByKey[ep.key()].ID)
+		setOpenAPIEndpoint(update.Mutation(), in, params.LogSourceID, ep, now)
+		if err := update.Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("update endpoint %s: %w", ep.key(), err)
+		}
+	}
+	if len(removedIDs) > 0 {
+		_, err := tx.BronzeApicatalogOpenapiEndpoint.Delete().
+			Where(bronzeapicatalogopenapiendpoint.IDIn(removedIDs...)).
+			Exec(ctx)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("delete removed endpoints: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// setOpenAPIEndpoint sets the fields shared by create and update. Optional
+// fields that are empty in the spec are cleared so a re-import drops them.
+func setOpenAPIEndpoint(m *entapicatalog.BronzeApicatalogOpenapiEndpointMutation, in openAPIInput, logSourceID string, ep openAPIEndpoint, now time.Time) {
+	m.SetCollectedAt(now)
+	m.SetSpecName(in.name)
+	m.SetURI(ep.uri)
+	m.SetMethod(ep.method)
+	m.SetAccessLevel(ep.accessLevel)
+	m.SetDeprecated(ep.deprecated)
+
+	optional := []struct {
+		value string
+		set   func(string)
+		clear func()
+	}{
+		{in.spec.version, m.SetSpecVersion, m.ClearSpecVersion},
+		{in.spec.openAPIVersion, m.SetOpenapiVersion, m.ClearOpenapiVersion},
+		{logSourceID, m.SetLogSourceID, m.ClearLogSourceID},
+		{ep.operationID, m.SetOperationID, m.ClearOperationID},
+		{ep.summary, m.SetSummary, m.ClearSummary},
+		{in.sourceFile, m.SetSourceFile, m.ClearSourceFile},
+	}
+	for _, f := range optional {
+		if f.value != "" {
+			f.set(f.value)
+		} else {
+			f.clear()
+		}
+	}
+
+	if len(ep.tags) > 0 {
+		m.SetTagsJSON(ep.tags)
+	} else {
+		m.ClearTagsJSON()
+	}
+	if security, err := json.Marshal(ep.security); err == nil && len(ep.security) > 0 {
+		m.SetSecurityJSON(security)
+	} else {
+		m.ClearSecurityJSON()
+	}
+}
@@ -17,5 +17,6 @@ func Register(w worker.Worker, configService *config.Service, driver dialect.Dri
 
 	activities := NewActivities(configService, entClient)
 	w.RegisterActivity(activities.ImportCSV)
+	w.RegisterActivity(activities.ImportOpenAPI)
 	w.RegisterWorkflow(ApiCatalogWorkflow)
 }
@@ -10,20 +10,29 @@ import (
 )
 
 // ApiCatalogWorkflowParams holds parameters for the API catalog workflow.
+// Setting OpenAPIPath or OpenAPIData imports OpenAPI specs instead of CSV.
 type ApiCatalogWorkflowParams struct {
 	FilePath    string
 	CSVData     []byte
 	LogSourceID string
 	SourceFile  string
+
+	OpenAPIPath string
+	OpenAPIData []byte
+	SpecName    string
+	DryRun      bool
 }
 
 // ApiCatalogWorkflowResult holds the result of the API catalog workflow.
 type ApiCatalogWorkflowResult struct {
 	Created int
 	Updated int
+	Deleted int
+	Added   []EndpointChange
+	Removed []EndpointChange
 }
 
-// ApiCatalogWorkflow imports API endpoint data from CSV.
+// ApiCatalogWorkflow imports API endpoint data from CSV or OpenAPI specs.
 func ApiCatalogWorkflow(ctx workflow.Context, params ApiCatalogWorkflowParams) (*ApiCatalogWorkflowResult, error) {
 	logger := workflow.GetLogger(ctx)
 	logger.Info("Starting ApiCatalogWorkflow")
@@ -39,6 +48,10 @@ func ApiCatalogWorkflow(ctx workflow.Context, params ApiCatalogWorkflowParams) (
 	}
 	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
 
+	if params.OpenAPIPath != "" || len(params.OpenAPIData) > 0 {
+		return importOpenAPI(ctx, activityCtx, params)
+	}
+
 	var result ImportCSVResult
 	err := workflow.ExecuteActivity(activityCtx, ImportCSVActivity, ImportCSVParams{
 		FilePath:    params.FilePath,
@@ -60,3 +73,35 @@ func ApiCatalogWorkflow(ctx workflow.Context, params ApiCatalogWorkflowParams) (
 		Updated: result.Updated,
 	}, nil
 }
+
+func importOpenAPI(ctx, activityCtx workflow.Context, params ApiCatalogWorkflowParams) (*ApiCatalogWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+
+	var result ImportOpenAPIResult
+	err := workflow.ExecuteActivity(activityCtx, ImportOpenAPIActivity, ImportOpenAPIParams{
+		Path:        params.OpenAPIPath,
+		SpecData:    params.OpenAPIData,
+		SpecName:    params.SpecName,
+		LogSourceID: params.LogSourceID,
+		SourceFile:  params.SourceFile,
+		DryRun:      params.DryRun,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to import OpenAPI specs", "error", err)
+		return nil, temporalerr.PropagateNonRetryable(err)
+	}
+
+	logger.Info("Completed ApiCatalogWorkflow",
+		"specs", result.Specs,
+		"created", result.Created,
+		"updated", result.Updated,
+		"deleted", result.Deleted)
+
+	return &ApiCatalogWorkflowResult{
+		Created: result.Created,
+		Updated: result.Updated,
+		Deleted: result.Deleted,
+		Added:   result.Added,
+		Removed: result.Removed,
+	}, nil
+}
@@ -3,14 +3,15 @@ package aws
 import (
 	"context"
 	"fmt"
-	"net/http"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-	awsconfig "github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/credentials"
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgtypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"go.temporal.io/sdk/activity"
 
+	"danny.vn/hotpot/pkg/base/awsauth"
 	"danny.vn/hotpot/pkg/base/config"
 	"danny.vn/hotpot/pkg/base/ratelimit"
 )
@@ -29,8 +30,90 @@ func NewActivities(configService *config.Service, limiter ratelimit.Limiter) *Ac
 	}
 }
 
+// defaultRegion is used for account and region discovery and for global services such as IAM.
+const defaultRegion = "us-east-1"
+
+// Account is an AWS account to ingest.
+type Account struct {
+	ID   string
+	Name string
+}
+
+// DiscoverAccountsParams contains parameters for the account discovery activity.
+type DiscoverAccountsParams struct{}
+
+// DiscoverAccountsResult contains the result of account discovery.
+type DiscoverAccountsResult struct {
+	Accounts []Account
+}
+
+// DiscoverAccountsActivity is the activity function reference for workflow registration.
+var DiscoverAccountsActivity = (*Activities).DiscoverAccounts
+
+// DiscoverAccounts resolves the AWS accounts to ingest.
+// Without an assume role configured, this is the account of the configured
+// credentials. With one, it is the configured account list, or every active
+// account in the organization via Organizations ListAccounts. The account of
+// the credentials themselves, e.g. the management account, is scanned with
+// them directly (see awsauth.LoadConfig).
+func (a *Activities) DiscoverAccounts(ctx context.Context, _ DiscoverAccountsParams) (*DiscoverAccountsResult, error) {
+	logger := activity.GetLogger(ctx)
+
+	cfg, err := awsauth.LoadConfig(ctx, a.configService, a.limiter, "", defaultRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts []Account
+	switch {
+	case a.configService.AWSAssumeRoleName() == "":
+		output, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+		if err != nil {
+			return nil, fmt.Errorf("get caller identity: %w", err)
+		}
+		accounts = append(accounts, Account{ID: aws.ToString(output.Account)})
+
+	case len(a.configService.AWSAccountIDs()) > 0:
+		for _, id := range a.configService.AWSAccountIDs() {
+			accounts = append(accounts, Account{ID: id})
+		}
+
+	default:
+		paginator := organizations.NewListAccountsPaginator(organizations.NewFromConfig(cfg), &organizations.ListAccountsInput{})
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("list organization accounts: %w", err)
+			}
+			for _, acct := range output.Accounts {
+				if !isActive(acct) {
+					continue
+				}
+				accounts = append(accounts, Account{
+					ID:   aws.ToString(acct.Id),
+					Name: aws.ToString(acct.Name),
+				})
+			}
+		}
+	}
+
+	logger.Info("Discovered AWS accounts", "count", len(accounts))
+	return &DiscoverAccountsResult{Accounts: accounts}, nil
+}
+
+// isActive reports whether an organization account can be scanned.
+// Suspended and closing accounts reject API calls.
+func isActive(acct orgtypes.Account) bool {
+	if acct.State != "" {
+		return acct.State == orgtypes.AccountStateActive
+	}
+	return acct.Status == orgtypes.AccountStatusActive
+}
+
 // DiscoverRegionsParams contains parameters for the region discovery activity.
-type DiscoverRegionsParams struct{}
+type DiscoverRegionsParams struct {
+	AccountID string
+}
 
 // DiscoverRegionsResult contains the result of region discovery.
 type DiscoverRegionsResult struct {
@@ -41,14 +124,15 @@ type DiscoverRegionsResult struct {
 var DiscoverRegionsActivity = (*Activities).DiscoverRegions
 
 // DiscoverRegions discovers all enabled AWS regions for the account.
+// Opt-in regions differ per account, so this runs once per account.
 // If config Regions is set, filters to only those regions.
-func (a *Activities) DiscoverRegions(ctx context.Context, _ DiscoverRegionsParams) (*DiscoverRegionsResult, error) {
+func (a *Activities) DiscoverRegions(ctx context.Context, params DiscoverRegionsParams) (*DiscoverRegionsResult, error) {
 	logger := activity.GetLogger(ctx)
-	logger.Info("Discovering AWS regions")
+	logger.Info("Discovering AWS regions", "accountID", params.AccountID)
 
-	cfg, err := a.loadAWSConfig(ctx, "us-east-1")
+	cfg, err := awsauth.LoadConfig(ctx, a.configService, a.limiter, params.AccountID, defaultRegion)
 	if err != nil {
-		return nil, fmt.Errorf("load AWS config: %w", err)
+		return nil, err
 	}
 
 	client := awsec2.NewFromConfig(cfg)
@@ -82,28 +166,6 @@ func (a *Activities) DiscoverRegions(ctx context.Context, _ DiscoverRegionsParam
 		regions = filtered
 	}
 
-	logger.Info("Discovered AWS regions", "count", len(regions))
+	logger.Info("Discovered AWS regions", "accountID", params.AccountID, "count", len(regions))
 	return &DiscoverRegionsResult{Regions: regions}, nil
 }
-
-// loadAWSConfig builds an AWS SDK config with credentials and rate-limited HTTP transport.
-func (a *Activities) loadAWSConfig(ctx context.Context, region string) (aws.Config, error) {
-	var opts []func(*awsconfig.LoadOptions) error
-
-	opts = append(opts, awsconfig.WithRegion(region))
-
-	// Static credentials if configured
-	if accessKey := a.configService.AWSAccessKeyID(); accessKey != "" {
-		secretKey := a.configService.AWSSecretAccessKey()
-		opts = append(opts, awsconfig.WithCredentialsProvider(
-			credentials.NewStaticCredentialsProvider(accessKey, secretKey, ""),
-		))
-	}
-
-	// Rate-limited HTTP client
-	opts = append(opts, awsconfig.WithHTTPClient(&http.Client{
-		Transport: ratelimit.NewRateLimitedTransport(a.limiter, nil),
-	}))
-
-	return awsconfig.LoadDefaultConfig(ctx, opts...)
-}
@@ -0,0 +1,24 @@
+package cloudtrail
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/aws"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider: "aws",
+		Name:     "cloudtrail",
+		Scope:    ingest.ScopeRegional,
+		Register: Register,
+		Workflow: AWSCloudTrailWorkflow,
+		NewParams: func(accountID, region, _ string) any {
+			return AWSCloudTrailWorkflowParams{AccountID: accountID, Region: region}
+		},
+		NewResult: func() any { return &AWSCloudTrailWorkflowResult{} },
+		Aggregate: func(result *aws.AWSInventoryWorkflowResult, _ *aws.RegionResult, child any) {
+			r := child.(*AWSCloudTrailWorkflowResult)
+			result.TotalCloudTrailTrails += r.TrailCount
+		},
+	})
+}
@@ -0,0 +1,22 @@
+package cloudtrail
+
+import (
+	"entgo.io/ent/dialect"
+	"go.temporal.io/sdk/worker"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/ingest/aws/cloudtrail/trail"
+	entcloudtrail "danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail"
+)
+
+// Register registers all CloudTrail activities and workflows.
+func Register(w worker.Worker, configService *config.Service, driver dialect.Driver, limiter ratelimit.Limiter) {
+	entClient := entcloudtrail.NewClient(entcloudtrail.Driver(driver), entcloudtrail.AlternateSchema(entcloudtrail.DefaultSchemaConfig()))
+
+	// Register CloudTrail sub-packages
+	trail.Register(w, configService, entClient, limiter)
+
+	// Register CloudTrail workflow
+	w.RegisterWorkflow(AWSCloudTrailWorkflow)
+}
@@ -0,0 +1,97 @@
+package trail
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/awsauth"
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	entcloudtrail "danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entcloudtrail.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entcloudtrail.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// createClient creates a rate-limited AWS CloudTrail client for the given account and region.
+func (a *Activities) createClient(ctx context.Context, accountID, region string) (*Client, error) {
+	cfg, err := awsauth.LoadConfig(ctx, a.configService, a.limiter, accountID, region)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(cfg), nil
+}
+
+// IngestCloudTrailTrailsParams contains parameters for the ingest activity.
+type IngestCloudTrailTrailsParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestCloudTrailTrailsResult contains the result of the ingest activity.
+type IngestCloudTrailTrailsResult struct {
+	AccountID      string
+	Region         string
+	TrailCount     int
+	DurationMillis int64
+}
+
+// IngestCloudTrailTrailsActivity is the activity function reference for workflow registration.
+var IngestCloudTrailTrailsActivity = (*Activities).IngestCloudTrailTrails
+
+// IngestCloudTrailTrails is a Temporal activity that ingests AWS CloudTrail trails.
+func (a *Activities) IngestCloudTrailTrails(ctx context.Context, params IngestCloudTrailTrailsParams) (*IngestCloudTrailTrailsResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting AWS CloudTrail trail ingestion",
+		"accountID", params.AccountID,
+		"region", params.Region,
+	)
+
+	client, err := a.createClient(ctx, params.AccountID, params.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create client: %w", err)
+	}
+
+	service := NewService(client, a.entClient)
+	result, err := service.Ingest(ctx, IngestParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to ingest CloudTrail trails: %w", err)
+	}
+
+	// Delete stale CloudTrail trails
+	if err := service.DeleteStaleTrails(ctx, params.AccountID, params.Region, result.CollectedAt); err != nil {
+		logger.Warn("Failed to delete stale CloudTrail trails", "error", err)
+	}
+
+	logger.Info("Completed AWS CloudTrail trail ingestion",
+		"accountID", params.AccountID,
+		"region", params.Region,
+		"trailCount", result.TrailCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestCloudTrailTrailsResult{
+		AccountID:      result.AccountID,
+		Region:         result.Region,
+		TrailCount:     result.TrailCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package trail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+)
+
+// Client wraps the AWS CloudTrail API for trails.
+type Client struct {
+	cloudtrailClient *cloudtrail.Client
+}
+
+// NewClient creates a new CloudTrail trail client from an AWS config.
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		cloudtrailClient: cloudtrail.NewFromConfig(cfg),
+	}
+}
+
+// TrailDetails holds a trail together with its logging status, event
+// selectors and tags.
+type TrailDetails struct {
+	Trail                  types.Trail
+	IsLogging              bool
+	EventSelectors         []types.EventSelector
+	AdvancedEventSelectors []types.AdvancedEventSelector
+	Tags                   []types.Tag
+}
+
+// ListTrails lists the trails whose home region is the configured region.
+// Shadow copies of multi-region and organization trails are excluded so
+// each trail is collected once, from its home region.
+func (c *Client) ListTrails(ctx context.Context) ([]types.Trail, error) {
+	output, err := c.cloudtrailClient.DescribeTrails(ctx, &cloudtrail.DescribeTrailsInput{
+		IncludeShadowTrails: aws.Bool(false),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe trails: %w", err)
+	}
+
+	return output.TrailList, nil
+}
+
+// GetTrailDetails fetches the logging status, event selectors and tags of a trail.
+func (c *Client) GetTrailDetails(ctx context.Context, trail types.Trail) (*TrailDetails, error) {
+	arn := aws.ToString(trail.TrailARN)
+	details := &TrailDetails{Trail: trail}
+
+	status, err := c.cloudtrailClient.GetTrailStatus(ctx, &cloudtrail.GetTrailStatusInput{Name: trail.TrailARN})
+	if err != nil {
+		return nil, fmt.Errorf("get trail status for %s: %w", arn, err)
+	}
+	details.IsLogging = aws.ToBool(status.IsLogging)
+
+	selectors, err := c.cloudtrailClient.GetEventSelectors(ctx, &cloudtrail.GetEventSelectorsInput{TrailName: trail.TrailARN})
+	if err != nil {
+		return nil, fmt.Errorf("get event selectors for %s: %w", arn, err)
+	}
+	details.EventSelectors = selectors.EventSelectors
+	details.AdvancedEventSelectors = selectors.AdvancedEventSelectors
+
+	paginator := cloudtrail.NewListTagsPaginator(c.cloudtrailClient, &cloudtrail.ListTagsInput{
+		ResourceIdList: []string{arn},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list tags for %s: %w", arn, err)
+		}
+		for _, rt := range output.ResourceTagList {
+			details.Tags = append(details.Tags, rt.TagsList...)
+		}
+	}
+
+	return details, nil
+}
@@ -0,0 +1,169 @@
+package trail
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// TrailData holds converted CloudTrail trail data ready for Ent insertion.
+type TrailData struct {
+	ResourceID                 string
+	Name                       string
+	S3BucketName               string
+	S3KeyPrefix                string
+	SnsTopicArn                string
+	IsMultiRegionTrail         bool
+	IsOrganizationTrail        bool
+	IncludeGlobalServiceEvents bool
+	LogFileValidationEnabled   bool
+	KmsKeyID                   string
+	CloudWatchLogsLogGroupArn  string
+	CloudWatchLogsRoleArn      string
+	HasCustomEventSelectors    bool
+	HasInsightSelectors        bool
+	IsLogging                  bool
+	EventSelectorsJSON         json.RawMessage
+	AdvancedEventSelectorsJSON json.RawMessage
+	TagsJSON                   json.RawMessage
+	AccountID                  string
+	Region                     string
+	CollectedAt                time.Time
+}
+
+// EventSelector is the JSON structure for basic event selectors.
+type EventSelector struct {
+	ReadWriteType                 string         `json:"read_write_type,omitempty"`
+	IncludeManagementEvents       bool           `json:"include_management_events"`
+	ExcludeManagementEventSources []string       `json:"exclude_management_event_sources,omitempty"`
+	DataResources                 []DataResource `json:"data_resources,omitempty"`
+}
+
+// DataResource is the JSON structure for event selector data resources.
+type DataResource struct {
+	Type   string   `json:"type"`
+	Values []string `json:"values,omitempty"`
+}
+
+// AdvancedEventSelector is the JSON structure for advanced event selectors.
+type AdvancedEventSelector struct {
+	Name           string          `json:"name,omitempty"`
+	FieldSelectors []FieldSelector `json:"field_selectors"`
+}
+
+// FieldSelector is the JSON structure for advanced event selector fields.
+type FieldSelector struct {
+	Field         string   `json:"field"`
+	Equals        []string `json:"equals,omitempty"`
+	NotEquals     []string `json:"not_equals,omitempty"`
+	StartsWith    []string `json:"starts_with,omitempty"`
+	NotStartsWith []string `json:"not_starts_with,omitempty"`
+	EndsWith      []string `json:"ends_with,omitempty"`
+	NotEndsWith   []string `json:"not_ends_with,omitempty"`
+}
+
+// TagData is the JSON structure for tags.
+type TagData struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ConvertTrail converts trail details fetched from the CloudTrail API to TrailData.
+func ConvertTrail(d *TrailDetails, accountID, region string, collectedAt time.Time) (*TrailData, error) {
+	t := d.Trail
+	data := &TrailData{
+		ResourceID:                 derefStr(t.TrailARN),
+		Name:                       derefStr(t.Name),
+		S3BucketName:               derefStr(t.S3BucketName),
+		S3KeyPrefix:                derefStr(t.S3KeyPrefix),
+		SnsTopicArn:                derefStr(t.SnsTopicARN),
+		IsMultiRegionTrail:         derefBool(t.IsMultiRegionTrail),
+		IsOrganizationTrail:        derefBool(t.IsOrganizationTrail),
+		IncludeGlobalServiceEvents: derefBool(t.IncludeGlobalServiceEvents),
+		LogFileValidationEnabled:   derefBool(t.LogFileValidationEnabled),
+		KmsKeyID:                   derefStr(t.KmsKeyId),
+		CloudWatchLogsLogGroupArn:  derefStr(t.CloudWatchLogsLogGroupArn),
+		CloudWatchLogsRoleArn:      derefStr(t.CloudWatchLogsRoleArn),
+		HasCustomEventSelectors:    derefBool(t.HasCustomEventSelectors),
+		HasInsightSelectors:        derefBool(t.HasInsightSelectors),
+		IsLogging:                  d.IsLogging,
+		AccountID:                  accountID,
+		Region:                     region,
+		CollectedAt:                collectedAt,
+	}
+	if home := derefStr(t.HomeRegion); home != "" {
+		data.Region = home
+	}
+
+	var err error
+	if len(d.EventSelectors) > 0 {
+		selectors := make([]EventSelector, 0, len(d.EventSelectors))
+		for _, s := range d.EventSelectors {
+			selector := EventSelector{
+				ReadWriteType:                 string(s.ReadWriteType),
+				IncludeManagementEvents:       derefBool(s.IncludeManagementEvents),
+				ExcludeManagementEventSources: s.ExcludeManagementEventSources,
+			}
+			for _, r := range s.DataResources {
+				selector.DataResources = append(selector.DataResources, DataResource{
+					Type:   derefStr(r.Type),
+					Values: r.Values,
+				})
+			}
+			selectors = append(selectors, selector)
+		}
+		if data.EventSelectorsJSON, err = json.Marshal(selectors); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(d.AdvancedEventSelectors) > 0 {
+		selectors := make([]AdvancedEventSelector, 0, len(d.AdvancedEventSelectors))
+		for _, s := range d.AdvancedEventSelectors {
+			selector := AdvancedEventSelector{
+				Name:           derefStr(s.Name),
+				FieldSelectors: make([]FieldSelector, 0, len(s.FieldSelectors)),
+			}
+			for _, f := range s.FieldSelectors {
+				selector.FieldSelectors = append(selector.FieldSelectors, FieldSelector{
+					Field:         derefStr(f.Field),
+					Equals:        f.Equals,
+					NotEquals:     f.NotEquals,
+					StartsWith:    f.StartsWith,
+					NotStartsWith: f.NotStartsWith,
+					EndsWith:      f.EndsWith,
+					NotEndsWith:   f.NotEndsWith,
+				})
+			}
+			selectors = append(selectors, selector)
+		}
+		if data.AdvancedEventSelectorsJSON, err = json.Marshal(selectors); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(d.Tags) > 0 {
+		tags := make([]TagData, 0, len(d.Tags))
+		for _, t := range d.Tags {
+			tags = append(tags, TagData{Key: derefStr(t.Key), Value: derefStr(t.Value)})
+		}
+		if data.TagsJSON, err = json.Marshal(tags); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+func derefStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func derefBool(b *bool) bool {
+	if b == nil {
+		return false
+	}
+	return *b
+}
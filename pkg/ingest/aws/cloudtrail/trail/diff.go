@@ -0,0 +1,64 @@
+package trail
+
+import (
+	"bytes"
+	"encoding/json"
+
+	entcloudtrail "danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail"
+)
+
+// TrailDiff represents changes between old and new CloudTrail trail states.
+type TrailDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffTrailData compares old Ent entity and new data.
+func DiffTrailData(old *entcloudtrail.BronzeAWSCloudTrailTrail, new *TrailData) *TrailDiff {
+	if old == nil {
+		return &TrailDiff{IsNew: true}
+	}
+
+	return &TrailDiff{
+		IsChanged: old.Name != new.Name ||
+			old.S3BucketName != new.S3BucketName ||
+			old.S3KeyPrefix != new.S3KeyPrefix ||
+			old.SnsTopicArn != new.SnsTopicArn ||
+			old.IsMultiRegionTrail != new.IsMultiRegionTrail ||
+			old.IsOrganizationTrail != new.IsOrganizationTrail ||
+			old.IncludeGlobalServiceEvents != new.IncludeGlobalServiceEvents ||
+			old.LogFileValidationEnabled != new.LogFileValidationEnabled ||
+			old.KmsKeyID != new.KmsKeyID ||
+			old.CloudWatchLogsLogGroupArn != new.CloudWatchLogsLogGroupArn ||
+			old.CloudWatchLogsRoleArn != new.CloudWatchLogsRoleArn ||
+			old.HasCustomEventSelectors != new.HasCustomEventSelectors ||
+			old.HasInsightSelectors != new.HasInsightSelectors ||
+			old.IsLogging != new.IsLogging ||
+			jsonChanged(old.EventSelectorsJSON, new.EventSelectorsJSON) ||
+			jsonChanged(old.AdvancedEventSelectorsJSON, new.AdvancedEventSelectorsJSON) ||
+			jsonChanged(old.TagsJSON, new.TagsJSON),
+	}
+}
+
+// HasAnyChange returns true if any part of the CloudTrail trail changed.
+func (d *TrailDiff) HasAnyChange() bool {
+	return d.IsNew || d.IsChanged
+}
+
+// jsonChanged compares JSON values semantically, since jsonb does not
+// preserve the formatting or key order of what was written.
+func jsonChanged(old, new json.RawMessage) bool {
+	return !bytes.Equal(normalizeJSON(old), normalizeJSON(new))
+}
+
+func normalizeJSON(raw json.RawMessage) []byte {
+	if len(raw) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return raw
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
@@ -0,0 +1,101 @@
+package trail
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entcloudtrail "danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail"
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/bronzehistoryawscloudtrailtrail"
+)
+
+// HistoryService handles history tracking for CloudTrail trails.
+type HistoryService struct {
+	entClient *entcloudtrail.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entcloudtrail.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entcloudtrail.Tx, data *TrailData) *entcloudtrail.BronzeHistoryAWSCloudTrailTrailCreate {
+	create := tx.BronzeHistoryAWSCloudTrailTrail.Create().
+		SetResourceID(data.ResourceID).
+		SetCollectedAt(data.CollectedAt).
+		SetName(data.Name).
+		SetS3BucketName(data.S3BucketName).
+		SetS3KeyPrefix(data.S3KeyPrefix).
+		SetSnsTopicArn(data.SnsTopicArn).
+		SetIsMultiRegionTrail(data.IsMultiRegionTrail).
+		SetIsOrganizationTrail(data.IsOrganizationTrail).
+		SetIncludeGlobalServiceEvents(data.IncludeGlobalServiceEvents).
+		SetLogFileValidationEnabled(data.LogFileValidationEnabled).
+		SetKmsKeyID(data.KmsKeyID).
+		SetCloudWatchLogsLogGroupArn(data.CloudWatchLogsLogGroupArn).
+		SetCloudWatchLogsRoleArn(data.CloudWatchLogsRoleArn).
+		SetHasCustomEventSelectors(data.HasCustomEventSelectors).
+		SetHasInsightSelectors(data.HasInsightSelectors).
+		SetIsLogging(data.IsLogging).
+		SetAccountID(data.AccountID).
+		SetRegion(data.Region)
+
+	if data.EventSelectorsJSON != nil {
+		create.SetEventSelectorsJSON(data.EventSelectorsJSON)
+	}
+	if data.AdvancedEventSelectorsJSON != nil {
+		create.SetAdvancedEventSelectorsJSON(data.AdvancedEventSelectorsJSON)
+	}
+	if data.TagsJSON != nil {
+		create.SetTagsJSON(data.TagsJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new CloudTrail trail.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entcloudtrail.Tx, data *TrailData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create CloudTrail trail history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new history based on diff.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entcloudtrail.Tx, old *entcloudtrail.BronzeAWSCloudTrailTrail, new *TrailData, diff *TrailDiff, now time.Time) error {
+	if !diff.IsChanged {
+		return nil
+	}
+
+	if err := h.CloseHistory(ctx, tx, old.ID, now); err != nil {
+		return err
+	}
+
+	_, err := h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new CloudTrail trail history: %w", err)
+	}
+	return nil
+}
+
+// CloseHistory closes history records for a deleted CloudTrail trail.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entcloudtrail.Tx, resourceID string, now time.Time) error {
+	_, err := tx.BronzeHistoryAWSCloudTrailTrail.Update().
+		Where(
+			bronzehistoryawscloudtrailtrail.ResourceID(resourceID),
+			bronzehistoryawscloudtrailtrail.ValidToIsNil(),
+		).
+		SetValidTo(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close CloudTrail trail history: %w", err)
+	}
+	return nil
+}
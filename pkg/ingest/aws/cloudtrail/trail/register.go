@@ -0,0 +1,18 @@
+package trail
+
+import (
+	"go.temporal.io/sdk/worker"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	entcloudtrail "danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail"
+)
+
+// Register registers CloudTrail trail activities and workflows with the Temporal worker.
+func Register(w worker.Worker, configService *config.Service, entClient *entcloudtrail.Client, limiter ratelimit.Limiter) {
+	activities := NewActivities(configService, entClient, limiter)
+
+	w.RegisterActivity(activities.IngestCloudTrailTrails)
+
+	w.RegisterWorkflow(AWSCloudTrailTrailWorkflow)
+}
@@ -0,0 +1,271 @@
+package trail
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entcloudtrail "danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail"
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/bronzeawscloudtrailtrail"
+)
+
+// Service handles AWS CloudTrail trail ingestion.
+type Service struct {
+	client    *Client
+	entClient *entcloudtrail.Client
+	history   *HistoryService
+}
+
+// NewService creates a new CloudTrail trail ingestion service.
+func NewService(client *Client, entClient *entcloudtrail.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestParams contains parameters for CloudTrail trail ingestion.
+type IngestParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestResult contains the result of CloudTrail trail ingestion.
+type IngestResult struct {
+	AccountID      string
+	Region         string
+	TrailCount     int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches CloudTrail trails from AWS and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	// Fetch trails from AWS
+	trails, err := s.client.ListTrails(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CloudTrail trails: %w", err)
+	}
+
+	// Fetch trail status and selectors and convert to data structs
+	dataList := make([]*TrailData, 0, len(trails))
+	for _, t := range trails {
+		details, err := s.client.GetTrailDetails(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+		data, err := ConvertTrail(details, params.AccountID, params.Region, collectedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert CloudTrail trail: %w", err)
+		}
+		dataList = append(dataList, data)
+	}
+
+	// Save to database
+	if err := s.saveTrails(ctx, dataList); err != nil {
+		return nil, fmt.Errorf("failed to save CloudTrail trails: %w", err)
+	}
+
+	return &IngestResult{
+		AccountID:      params.AccountID,
+		Region:         params.Region,
+		TrailCount:     len(dataList),
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveTrails saves CloudTrail trails to the database with history tracking.
+func (s *Service) saveTrails(ctx context.Context, trails []*TrailData) error {
+	if len(trails) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, trailData := range trails {
+		// Load existing CloudTrail trail
+		existing, err := tx.BronzeAWSCloudTrailTrail.Query().
+			Where(bronzeawscloudtrailtrail.ID(trailData.ResourceID)).
+			First(ctx)
+		if err != nil && !entcloudtrail.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("failed to load existing CloudTrail trail %s: %w", trailData.ResourceID, err)
+		}
+
+		// Compute diff
+		diff := DiffTrailData(existing, trailData)
+
+		// Skip if no changes
+		if !diff.HasAnyChange() {
+			if err := tx.BronzeAWSCloudTrailTrail.UpdateOneID(trailData.ResourceID).
+				SetCollectedAt(trailData.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update collected_at for CloudTrail trail %s: %w", trailData.ResourceID, err)
+			}
+			continue
+		}
+
+		// Create or update CloudTrail trail
+		if existing == nil {
+			create := tx.BronzeAWSCloudTrailTrail.Create().
+				SetID(trailData.ResourceID).
+				SetName(trailData.Name).
+				SetS3BucketName(trailData.S3BucketName).
+				SetS3KeyPrefix(trailData.S3KeyPrefix).
+				SetSnsTopicArn(trailData.SnsTopicArn).
+				SetIsMultiRegionTrail(trailData.IsMultiRegionTrail).
+				SetIsOrganizationTrail(trailData.IsOrganizationTrail).
+				SetIncludeGlobalServiceEvents(trailData.IncludeGlobalServiceEvents).
+				SetLogFileValidationEnabled(trailData.LogFileValidationEnabled).
+				SetKmsKeyID(trailData.KmsKeyID).
+				SetCloudWatchLogsLogGroupArn(trailData.CloudWatchLogsLogGroupArn).
+				SetCloudWatchLogsRoleArn(trailData.CloudWatchLogsRoleArn).
+				SetHasCustomEventSelectors(trailData.HasCustomEventSelectors).
+				SetHasInsightSelectors(trailData.HasInsightSelectors).
+				SetIsLogging(trailData.IsLogging).
+				SetAccountID(trailData.AccountID).
+				SetRegion(trailData.Region).
+				SetCollectedAt(trailData.CollectedAt).
+				SetFirstCollectedAt(trailData.CollectedAt)
+
+			if trailData.EventSelectorsJSON != nil {
+				create.SetEventSelectorsJSON(trailData.EventSelectorsJSON)
+			}
+			if trailData.AdvancedEventSelectorsJSON != nil {
+				create.SetAdvancedEventSelectorsJSON(trailData.AdvancedEventSelectorsJSON)
+			}
+			if trailData.TagsJSON != nil {
+				create.SetTagsJSON(trailData.TagsJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create CloudTrail trail %s: %w", trailData.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeAWSCloudTrailTrail.UpdateOneID(trailData.ResourceID).
+				SetName(trailData.Name).
+				SetS3BucketName(trailData.S3BucketName).
+				SetS3KeyPrefix(trailData.S3KeyPrefix).
+				SetSnsTopicArn(trailData.SnsTopicArn).
+				SetIsMultiRegionTrail(trailData.IsMultiRegionTrail).
+				SetIsOrganizationTrail(trailData.IsOrganizationTrail).
+				SetIncludeGlobalServiceEvents(trailData.IncludeGlobalServiceEvents).
+				SetLogFileValidationEnabled(trailData.LogFileValidationEnabled).
+				SetKmsKeyID(trailData.KmsKeyID).
+				SetCloudWatchLogsLogGroupArn(trailData.CloudWatchLogsLogGroupArn).
+				SetCloudWatchLogsRoleArn(trailData.CloudWatchLogsRoleArn).
+				SetHasCustomEventSelectors(trailData.HasCustomEventSelectors).
+				SetHasInsightSelectors(trailData.HasInsightSelectors).
+				SetIsLogging(trailData.IsLogging).
+				SetAccountID(trailData.AccountID).
+				SetRegion(trailData.Region).
+				SetCollectedAt(trailData.CollectedAt)
+
+			if trailData.EventSelectorsJSON != nil {
+				update.SetEventSelectorsJSON(trailData.EventSelectorsJSON)
+			} else {
+				update.ClearEventSelectorsJSON()
+			}
+			if trailData.AdvancedEventSelectorsJSON != nil {
+				update.SetAdvancedEventSelectorsJSON(trailData.AdvancedEventSelectorsJSON)
+			} else {
+				update.ClearAdvancedEventSelectorsJSON()
+			}
+			if trailData.TagsJSON != nil {
+				update.SetTagsJSON(trailData.TagsJSON)
+			} else {
+				update.ClearTagsJSON()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update CloudTrail trail %s: %w", trailData.ResourceID, err)
+			}
+		}
+
+		// Track history
+		if diff.IsNew {
+			if err := s.history.CreateHistory(ctx, tx, trailData, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create history for CloudTrail trail %s: %w", trailData.ResourceID, err)
+			}
+		} else {
+			if err := s.history.UpdateHistory(ctx, tx, existing, trailData, diff, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update history for CloudTrail trail %s: %w", trailData.ResourceID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleTrails removes CloudTrail trails that were not collected in the latest run.
+func (s *Service) DeleteStaleTrails(ctx context.Context, accountID, region string, collectedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	stale, err := tx.BronzeAWSCloudTrailTrail.Query().
+		Where(
+			bronzeawscloudtrailtrail.AccountID(accountID),
+			bronzeawscloudtrailtrail.Region(region),
+			bronzeawscloudtrailtrail.CollectedAtLT(collectedAt),
+		).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range stale {
+		if err := s.history.CloseHistory(ctx, tx, e.ID, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to close history for CloudTrail trail %s: %w", e.ID, err)
+		}
+
+		if err := tx.BronzeAWSCloudTrailTrail.DeleteOne(e).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete CloudTrail trail %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package trail
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AWSCloudTrailTrailWorkflowParams contains parameters for the CloudTrail trail workflow.
+type AWSCloudTrailTrailWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSCloudTrailTrailWorkflowResult contains the result of the CloudTrail trail workflow.
+type AWSCloudTrailTrailWorkflowResult struct {
+	Region         string
+	TrailCount     int
+	DurationMillis int64
+}
+
+// AWSCloudTrailTrailWorkflow ingests AWS CloudTrail trails for a single region.
+func AWSCloudTrailTrailWorkflow(ctx workflow.Context, params AWSCloudTrailTrailWorkflowParams) (*AWSCloudTrailTrailWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSCloudTrailTrailWorkflow", "region", params.Region)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestCloudTrailTrailsResult
+	err := workflow.ExecuteActivity(activityCtx, IngestCloudTrailTrailsActivity, IngestCloudTrailTrailsParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest CloudTrail trails", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Completed AWSCloudTrailTrailWorkflow",
+		"region", params.Region,
+		"trailCount", result.TrailCount,
+	)
+
+	return &AWSCloudTrailTrailWorkflowResult{
+		Region:         result.Region,
+		TrailCount:     result.TrailCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
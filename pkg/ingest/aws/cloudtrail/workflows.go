@@ -0,0 +1,61 @@
+package cloudtrail
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/ingest/aws/cloudtrail/trail"
+)
+
+// AWSCloudTrailWorkflowParams contains parameters for the CloudTrail workflow.
+type AWSCloudTrailWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSCloudTrailWorkflowResult contains the result of the CloudTrail workflow.
+type AWSCloudTrailWorkflowResult struct {
+	Region     string
+	TrailCount int
+}
+
+// AWSCloudTrailWorkflow ingests all CloudTrail resources for a single region.
+func AWSCloudTrailWorkflow(ctx workflow.Context, params AWSCloudTrailWorkflowParams) (*AWSCloudTrailWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSCloudTrailWorkflow", "region", params.Region)
+
+	// Child workflow options
+	childOpts := workflow.ChildWorkflowOptions{
+		WorkflowExecutionTimeout: 30 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	ctx = workflow.WithChildOptions(ctx, childOpts)
+
+	result := &AWSCloudTrailWorkflowResult{Region: params.Region}
+
+	// Execute CloudTrail Trail workflow
+	var trailResult trail.AWSCloudTrailTrailWorkflowResult
+	err := workflow.ExecuteChildWorkflow(ctx, trail.AWSCloudTrailTrailWorkflow, trail.AWSCloudTrailTrailWorkflowParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &trailResult)
+	if err != nil {
+		logger.Error("Failed to execute AWSCloudTrailTrailWorkflow", "region", params.Region, "error", err)
+		return nil, err
+	}
+	result.TrailCount = trailResult.TrailCount
+
+	logger.Info("Completed AWSCloudTrailWorkflow",
+		"region", params.Region,
+		"trailCount", result.TrailCount,
+	)
+
+	return result, nil
+}
@@ -0,0 +1,33 @@
+package address
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// Client wraps the AWS EC2 API for Elastic IP addresses.
+type Client struct {
+	ec2Client *ec2.Client
+}
+
+// NewClient creates a new EC2 Elastic IP address client from an AWS config.
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		ec2Client: ec2.NewFromConfig(cfg),
+	}
+}
+
+// ListAddresses lists all Elastic IP addresses in the configured region. DescribeAddresses
+// is not paginated and returns every address in one call.
+func (c *Client) ListAddresses(ctx context.Context) ([]types.Address, error) {
+	output, err := c.ec2Client.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{})
+	if err != nil {
+		return nil, fmt.Errorf("describe addresses: %w", err)
+	}
+
+	return output.Addresses, nil
+}
@@ -0,0 +1,98 @@
+package address
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// AddressData holds converted Elastic IP address data ready for Ent insertion.
+type AddressData struct {
+	ResourceID              string
+	Name                    string
+	PublicIP                string
+	Domain                  string
+	AssociationID           string
+	InstanceID              string
+	NetworkInterfaceID      string
+	NetworkInterfaceOwnerID string
+	PrivateIPAddress        string
+	PublicIpv4Pool          string
+	NetworkBorderGroup      string
+	TagsJSON                json.RawMessage
+	AccountID               string
+	Region                  string
+	CollectedAt             time.Time
+}
+
+// TagData is the JSON structure for resource tags.
+type TagData struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ConvertAddress converts an AWS API Address to AddressData.
+func ConvertAddress(v types.Address, accountID, region string, collectedAt time.Time) (*AddressData, error) {
+	data := &AddressData{
+		ResourceID:              derefStr(v.AllocationId),
+		PublicIP:                derefStr(v.PublicIp),
+		Domain:                  string(v.Domain),
+		AssociationID:           derefStr(v.AssociationId),
+		InstanceID:              derefStr(v.InstanceId),
+		NetworkInterfaceID:      derefStr(v.NetworkInterfaceId),
+		NetworkInterfaceOwnerID: derefStr(v.NetworkInterfaceOwnerId),
+		PrivateIPAddress:        derefStr(v.PrivateIpAddress),
+		PublicIpv4Pool:          derefStr(v.PublicIpv4Pool),
+		NetworkBorderGroup:      derefStr(v.NetworkBorderGroup),
+		AccountID:               accountID,
+		Region:                  region,
+		CollectedAt:             collectedAt,
+	}
+
+	// Addresses without an allocation ID are keyed by their public IP
+	if data.ResourceID == "" {
+		data.ResourceID = data.PublicIP
+	}
+
+	// Convert tags to JSON
+	var err error
+	data.Name, data.TagsJSON, err = convertTags(v.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// convertTags converts AWS tags to JSON and returns the value of the Name tag.
+func convertTags(tags []types.Tag) (string, json.RawMessage, error) {
+	if len(tags) == 0 {
+		return "", nil, nil
+	}
+
+	var name string
+	result := make([]TagData, 0, len(tags))
+	for _, tag := range tags {
+		if derefStr(tag.Key) == "Name" {
+			name = derefStr(tag.Value)
+		}
+		result = append(result, TagData{
+			Key:   derefStr(tag.Key),
+			Value: derefStr(tag.Value),
+		})
+	}
+
+	tagsJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, tagsJSON, nil
+}
+
+func derefStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,58 @@
+package address
+
+import (
+	"bytes"
+	"encoding/json"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+)
+
+// AddressDiff represents changes between old and new Elastic IP address states.
+type AddressDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffAddressData compares old Ent entity and new data.
+func DiffAddressData(old *entec2.BronzeAWSEC2Address, new *AddressData) *AddressDiff {
+	if old == nil {
+		return &AddressDiff{IsNew: true}
+	}
+
+	return &AddressDiff{
+		IsChanged: old.Name != new.Name ||
+			old.PublicIP != new.PublicIP ||
+			old.Domain != new.Domain ||
+			old.AssociationID != new.AssociationID ||
+			old.InstanceID != new.InstanceID ||
+			old.NetworkInterfaceID != new.NetworkInterfaceID ||
+			old.NetworkInterfaceOwnerID != new.NetworkInterfaceOwnerID ||
+			old.PrivateIPAddress != new.PrivateIPAddress ||
+			old.PublicIpv4Pool != new.PublicIpv4Pool ||
+			old.NetworkBorderGroup != new.NetworkBorderGroup ||
+			jsonChanged(old.TagsJSON, new.TagsJSON),
+	}
+}
+
+// HasAnyChange returns true if any part of the Elastic IP address changed.
+func (d *AddressDiff) HasAnyChange() bool {
+	return d.IsNew || d.IsChanged
+}
+
+// jsonChanged compares JSON values semantically, since jsonb does not
+// preserve the formatting or key order of what was written.
+func jsonChanged(old, new json.RawMessage) bool {
+	return !bytes.Equal(normalizeJSON(old), normalizeJSON(new))
+}
+
+func normalizeJSON(raw json.RawMessage) []byte {
+	if len(raw) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return raw
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
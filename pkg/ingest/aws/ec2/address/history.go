@@ -0,0 +1,91 @@
+package address
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2address"
+)
+
+// HistoryService handles history tracking for Elastic IP addresses.
+type HistoryService struct {
+	entClient *entec2.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entec2.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entec2.Tx, data *AddressData) *entec2.BronzeHistoryAWSEC2AddressCreate {
+	create := tx.BronzeHistoryAWSEC2Address.Create().
+		SetResourceID(data.ResourceID).
+		SetCollectedAt(data.CollectedAt).
+		SetName(data.Name).
+		SetPublicIP(data.PublicIP).
+		SetDomain(data.Domain).
+		SetAssociationID(data.AssociationID).
+		SetInstanceID(data.InstanceID).
+		SetNetworkInterfaceID(data.NetworkInterfaceID).
+		SetNetworkInterfaceOwnerID(data.NetworkInterfaceOwnerID).
+		SetPrivateIPAddress(data.PrivateIPAddress).
+		SetPublicIpv4Pool(data.PublicIpv4Pool).
+		SetNetworkBorderGroup(data.NetworkBorderGroup).
+		SetAccountID(data.AccountID).
+		SetRegion(data.Region)
+
+	if data.TagsJSON != nil {
+		create.SetTagsJSON(data.TagsJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new Elastic IP address.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entec2.Tx, data *AddressData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create Elastic IP address history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new history based on diff.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entec2.Tx, old *entec2.BronzeAWSEC2Address, new *AddressData, diff *AddressDiff, now time.Time) error {
+	if !diff.IsChanged {
+		return nil
+	}
+
+	if err := h.CloseHistory(ctx, tx, old.ID, now); err != nil {
+		return err
+	}
+
+	_, err := h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new Elastic IP address history: %w", err)
+	}
+	return nil
+}
+
+// CloseHistory closes history records for a deleted Elastic IP address.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entec2.Tx, resourceID string, now time.Time) error {
+	_, err := tx.BronzeHistoryAWSEC2Address.Update().
+		Where(
+			bronzehistoryawsec2address.ResourceID(resourceID),
+			bronzehistoryawsec2address.ValidToIsNil(),
+		).
+		SetValidTo(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close Elastic IP address history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package address
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2address"
+)
+
+// Service handles AWS EC2 Elastic IP address ingestion.
+type Service struct {
+	client    *Client
+	entClient *entec2.Client
+	history   *HistoryService
+}
+
+// NewService creates a new Elastic IP address ingestion service.
+func NewService(client *Client, entClient *entec2.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestParams contains parameters for Elastic IP address ingestion.
+type IngestParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestResult contains the result of Elastic IP address ingestion.
+type IngestResult struct {
+	AccountID      string
+	Region         string
+	AddressCount   int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches Elastic IP addresses from AWS and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	// Fetch Elastic IP addresses from AWS
+	addresses, err := s.client.ListAddresses(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Elastic IP addresses: %w", err)
+	}
+
+	// Convert to data structs
+	dataList := make([]*AddressData, 0, len(addresses))
+	for _, v := range addresses {
+		data, err := ConvertAddress(v, params.AccountID, params.Region, collectedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert Elastic IP address: %w", err)
+		}
+		dataList = append(dataList, data)
+	}
+
+	// Save to database
+	if err := s.saveAddresses(ctx, dataList); err != nil {
+		return nil, fmt.Errorf("failed to save Elastic IP addresses: %w", err)
+	}
+
+	return &IngestResult{
+		AccountID:      params.AccountID,
+		Region:         params.Region,
+		AddressCount:   len(dataList),
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveAddresses saves Elastic IP addresses to the database with history tracking.
+func (s *Service) saveAddresses(ctx context.Context, addresses []*AddressData) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, addressData := range addresses {
+		// Load existing Elastic IP address
+		existing, err := tx.BronzeAWSEC2Address.Query().
+			Where(bronzeawsec2address.ID(addressData.ResourceID)).
+			First(ctx)
+		if err != nil && !entec2.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("failed to load existing Elastic IP address %s: %w", addressData.ResourceID, err)
+		}
+
+		// Compute diff
+		diff := DiffAddressData(existing, addressData)
+
+		// Skip if no changes
+		if !diff.HasAnyChange() {
+			if err := tx.BronzeAWSEC2Address.UpdateOneID(addressData.ResourceID).
+				SetCollectedAt(addressData.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update collected_at for Elastic IP address %s: %w", addressData.ResourceID, err)
+			}
+			continue
+		}
+
+		// Create or update Elastic IP address
+		if existing == nil {
+			create := tx.BronzeAWSEC2Address.Create().
+				SetID(addressData.ResourceID).
+				SetName(addressData.Name).
+				SetPublicIP(addressData.PublicIP).
+				SetDomain(addressData.Domain).
+				SetAssociationID(addressData.AssociationID).
+				SetInstanceID(addressData.InstanceID).
+				SetNetworkInterfaceID(addressData.NetworkInterfaceID).
+				SetNetworkInterfaceOwnerID(addressData.NetworkInterfaceOwnerID).
+				SetPrivateIPAddress(addressData.PrivateIPAddress).
+				SetPublicIpv4Pool(addressData.PublicIpv4Pool).
+				SetNetworkBorderGroup(addressData.NetworkBorderGroup).
+				SetAccountID(addressData.AccountID).
+				SetRegion(addressData.Region).
+				SetCollectedAt(addressData.CollectedAt).
+				SetFirstCollectedAt(addressData.CollectedAt)
+
+			if addressData.TagsJSON != nil {
+				create.SetTagsJSON(addressData.TagsJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create Elastic IP address %s: %w", addressData.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeAWSEC2Address.UpdateOneID(addressData.ResourceID).
+				SetName(addressData.Name).
+				SetPublicIP(addressData.PublicIP).
+				SetDomain(addressData.Domain).
+				SetAssociationID(addressData.AssociationID).
+				SetInstanceID(addressData.InstanceID).
+				SetNetworkInterfaceID(addressData.NetworkInterfaceID).
+				SetNetworkInterfaceOwnerID(addressData.NetworkInterfaceOwnerID).
+				SetPrivateIPAddress(addressData.PrivateIPAddress).
+				SetPublicIpv4Pool(addressData.PublicIpv4Pool).
+				SetNetworkBorderGroup(addressData.NetworkBorderGroup).
+				SetAccountID(addressData.AccountID).
+				SetRegion(addressData.Region).
+				SetCollectedAt(addressData.CollectedAt)
+
+			if addressData.TagsJSON != nil {
+				update.SetTagsJSON(addressData.TagsJSON)
+			} else {
+				update.ClearTagsJSON()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update Elastic IP address %s: %w", addressData.ResourceID, err)
+			}
+		}
+
+		// Track history
+		if diff.IsNew {
+			if err := s.history.CreateHistory(ctx, tx, addressData, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create history for Elastic IP address %s: %w", addressData.ResourceID, err)
+			}
+		} else {
+			if err := s.history.UpdateHistory(ctx, tx, existing, addressData, diff, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update history for Elastic IP address %s: %w", addressData.ResourceID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleAddresses removes Elastic IP addresses that were not collected in the latest run.
+func (s *Service) DeleteStaleAddresses(ctx context.Context, accountID, region string, collectedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	stale, err := tx.BronzeAWSEC2Address.Query().
+		Where(
+			bronzeawsec2address.AccountID(accountID),
+			bronzeawsec2address.Region(region),
+			bronzeawsec2address.CollectedAtLT(collectedAt),
+		).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range stale {
+		if err := s.history.CloseHistory(ctx, tx, e.ID, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to close history for Elastic IP address %s: %w", e.ID, err)
+		}
+
+		if err := tx.BronzeAWSEC2Address.DeleteOne(e).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete Elastic IP address %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
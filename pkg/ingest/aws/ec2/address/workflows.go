@@ -0,0 +1,59 @@
+package address
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AWSEC2AddressWorkflowParams contains parameters for the Elastic IP address workflow.
+type AWSEC2AddressWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSEC2AddressWorkflowResult contains the result of the Elastic IP address workflow.
+type AWSEC2AddressWorkflowResult struct {
+	Region         string
+	AddressCount   int
+	DurationMillis int64
+}
+
+// AWSEC2AddressWorkflow ingests AWS EC2 Elastic IP addresses for a single region.
+func AWSEC2AddressWorkflow(ctx workflow.Context, params AWSEC2AddressWorkflowParams) (*AWSEC2AddressWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSEC2AddressWorkflow", "region", params.Region)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestEC2AddressesResult
+	err := workflow.ExecuteActivity(activityCtx, IngestEC2AddressesActivity, IngestEC2AddressesParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest Elastic IP addresses", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Completed AWSEC2AddressWorkflow",
+		"region", params.Region,
+		"addressCount", result.AddressCount,
+	)
+
+	return &AWSEC2AddressWorkflowResult{
+		Region:         result.Region,
+		AddressCount:   result.AddressCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
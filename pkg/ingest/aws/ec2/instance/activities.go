@@ -3,12 +3,10 @@ package instance
 import (
 	"context"
 	"fmt"
-	"net/http"
 
-	awsconfig "github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/credentials"
 	"go.temporal.io/sdk/activity"
 
+	"danny.vn/hotpot/pkg/base/awsauth"
 	"danny.vn/hotpot/pkg/base/config"
 	"danny.vn/hotpot/pkg/base/ratelimit"
 	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
@@ -30,30 +28,12 @@ func NewActivities(configService *config.Service, entClient *entec2.Client, limi
 	}
 }
 
-// createClient creates a rate-limited AWS EC2 client for the given region.
-func (a *Activities) createClient(ctx context.Context, region string) (*Client, error) {
-	var opts []func(*awsconfig.LoadOptions) error
-
-	opts = append(opts, awsconfig.WithRegion(region))
-
-	// Static credentials if configured
-	if accessKey := a.configService.AWSAccessKeyID(); accessKey != "" {
-		secretKey := a.configService.AWSSecretAccessKey()
-		opts = append(opts, awsconfig.WithCredentialsProvider(
-			credentials.NewStaticCredentialsProvider(accessKey, secretKey, ""),
-		))
-	}
-
-	// Rate-limited HTTP client
-	opts = append(opts, awsconfig.WithHTTPClient(&http.Client{
-		Transport: ratelimit.NewRateLimitedTransport(a.limiter, nil),
-	}))
-
-	cfg, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+// createClient creates a rate-limited AWS EC2 client for the given account and region.
+func (a *Activities) createClient(ctx context.Context, accountID, region string) (*Client, error) {
+	cfg, err := awsauth.LoadConfig(ctx, a.configService, a.limiter, accountID, region)
 	if err != nil {
-		return nil, fmt.Errorf("load AWS config: %w", err)
+		return nil, err
 	}
-
 	return NewClient(cfg), nil
 }
 
@@ -82,7 +62,7 @@ func (a *Activities) IngestEC2Instances(ctx context.Context, params IngestEC2Ins
 		"region", params.Region,
 	)
 
-	client, err := a.createClient(ctx, params.Region)
+	client, err := a.createClient(ctx, params.AccountID, params.Region)
 	if err != nil {
 		return nil, fmt.Errorf("create client: %w", err)
 	}
@@ -0,0 +1,97 @@
+package internetgateway
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/awsauth"
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entec2.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entec2.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// createClient creates a rate-limited AWS EC2 client for the given account and region.
+func (a *Activities) createClient(ctx context.Context, accountID, region string) (*Client, error) {
+	cfg, err := awsauth.LoadConfig(ctx, a.configService, a.limiter, accountID, region)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(cfg), nil
+}
+
+// IngestEC2InternetGatewaysParams contains parameters for the ingest activity.
+type IngestEC2InternetGatewaysParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestEC2InternetGatewaysResult contains the result of the ingest activity.
+type IngestEC2InternetGatewaysResult struct {
+	AccountID            string
+	Region               string
+	InternetGatewayCount int
+	DurationMillis       int64
+}
+
+// IngestEC2InternetGatewaysActivity is the activity function reference for workflow registration.
+var IngestEC2InternetGatewaysActivity = (*Activities).IngestEC2InternetGateways
+
+// IngestEC2InternetGateways is a Temporal activity that ingests AWS EC2 internet gateways.
+func (a *Activities) IngestEC2InternetGateways(ctx context.Context, params IngestEC2InternetGatewaysParams) (*IngestEC2InternetGatewaysResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting AWS EC2 internet gateway ingestion",
+		"accountID", params.AccountID,
+		"region", params.Region,
+	)
+
+	client, err := a.createClient(ctx, params.AccountID, params.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create client: %w", err)
+	}
+
+	service := NewService(client, a.entClient)
+	result, err := service.Ingest(ctx, IngestParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to ingest internet gateways: %w", err)
+	}
+
+	// Delete stale internet gateways
+	if err := service.DeleteStaleInternetGateways(ctx, params.AccountID, params.Region, result.CollectedAt); err != nil {
+		logger.Warn("Failed to delete stale internet gateways", "error", err)
+	}
+
+	logger.Info("Completed AWS EC2 internet gateway ingestion",
+		"accountID", params.AccountID,
+		"region", params.Region,
+		"internetGatewayCount", result.InternetGatewayCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestEC2InternetGatewaysResult{
+		AccountID:            result.AccountID,
+		Region:               result.Region,
+		InternetGatewayCount: result.InternetGatewayCount,
+		DurationMillis:       result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package internetgateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// Client wraps the AWS EC2 API for internet gateways.
+type Client struct {
+	ec2Client *ec2.Client
+}
+
+// NewClient creates a new EC2 internet gateway client from an AWS config.
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		ec2Client: ec2.NewFromConfig(cfg),
+	}
+}
+
+// ListInternetGateways lists all internet gateways in the configured region using pagination.
+func (c *Client) ListInternetGateways(ctx context.Context) ([]types.InternetGateway, error) {
+	var internetGateways []types.InternetGateway
+
+	paginator := ec2.NewDescribeInternetGatewaysPaginator(c.ec2Client, &ec2.DescribeInternetGatewaysInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describe internet gateways: %w", err)
+		}
+
+		internetGateways = append(internetGateways, output.InternetGateways...)
+	}
+
+	return internetGateways, nil
+}
@@ -0,0 +1,85 @@
+package internetgateway
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// InternetGatewayData holds converted internet gateway data ready for Ent insertion.
+type InternetGatewayData struct {
+	ResourceID      string
+	Name            string
+	VpcID           string
+	AttachmentState string
+	OwnerID         string
+	TagsJSON        json.RawMessage
+	AccountID       string
+	Region          string
+	CollectedAt     time.Time
+}
+
+// TagData is the JSON structure for resource tags.
+type TagData struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ConvertInternetGateway converts an AWS API InternetGateway to InternetGatewayData.
+func ConvertInternetGateway(v types.InternetGateway, accountID, region string, collectedAt time.Time) (*InternetGatewayData, error) {
+	data := &InternetGatewayData{
+		ResourceID:  derefStr(v.InternetGatewayId),
+		OwnerID:     derefStr(v.OwnerId),
+		AccountID:   accountID,
+		Region:      region,
+		CollectedAt: collectedAt,
+	}
+
+	// Convert tags to JSON
+	var err error
+	data.Name, data.TagsJSON, err = convertTags(v.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	// An internet gateway attaches to at most one VPC
+	if len(v.Attachments) > 0 {
+		data.VpcID = derefStr(v.Attachments[0].VpcId)
+		data.AttachmentState = string(v.Attachments[0].State)
+	}
+
+	return data, nil
+}
+
+// convertTags converts AWS tags to JSON and returns the value of the Name tag.
+func convertTags(tags []types.Tag) (string, json.RawMessage, error) {
+	if len(tags) == 0 {
+		return "", nil, nil
+	}
+
+	var name string
+	result := make([]TagData, 0, len(tags))
+	for _, tag := range tags {
+		if derefStr(tag.Key) == "Name" {
+			name = derefStr(tag.Value)
+		}
+		result = append(result, TagData{
+			Key:   derefStr(tag.Key),
+			Value: derefStr(tag.Value),
+		})
+	}
+
+	tagsJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, tagsJSON, nil
+}
+
+func derefStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,52 @@
+package internetgateway
+
+import (
+	"bytes"
+	"encoding/json"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+)
+
+// InternetGatewayDiff represents changes between old and new internet gateway states.
+type InternetGatewayDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffInternetGatewayData compares old Ent entity and new data.
+func DiffInternetGatewayData(old *entec2.BronzeAWSEC2InternetGateway, new *InternetGatewayData) *InternetGatewayDiff {
+	if old == nil {
+		return &InternetGatewayDiff{IsNew: true}
+	}
+
+	return &InternetGatewayDiff{
+		IsChanged: old.Name != new.Name ||
+			old.VpcID != new.VpcID ||
+			old.AttachmentState != new.AttachmentState ||
+			old.OwnerID != new.OwnerID ||
+			jsonChanged(old.TagsJSON, new.TagsJSON),
+	}
+}
+
+// HasAnyChange returns true if any part of the internet gateway changed.
+func (d *InternetGatewayDiff) HasAnyChange() bool {
+	return d.IsNew || d.IsChanged
+}
+
+// jsonChanged compares JSON values semantically, since jsonb does not
+// preserve the formatting or key order of what was written.
+func jsonChanged(old, new json.RawMessage) bool {
+	return !bytes.Equal(normalizeJSON(old), normalizeJSON(new))
+}
+
+func normalizeJSON(raw json.RawMessage) []byte {
+	if len(raw) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return raw
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
@@ -0,0 +1,85 @@
+package internetgateway
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2internetgateway"
+)
+
+// HistoryService handles history tracking for internet gateways.
+type HistoryService struct {
+	entClient *entec2.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entec2.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entec2.Tx, data *InternetGatewayData) *entec2.BronzeHistoryAWSEC2InternetGatewayCreate {
+	create := tx.BronzeHistoryAWSEC2InternetGateway.Create().
+		SetResourceID(data.ResourceID).
+		SetCollectedAt(data.CollectedAt).
+		SetName(data.Name).
+		SetVpcID(data.VpcID).
+		SetAttachmentState(data.AttachmentState).
+		SetOwnerID(data.OwnerID).
+		SetAccountID(data.AccountID).
+		SetRegion(data.Region)
+
+	if data.TagsJSON != nil {
+		create.SetTagsJSON(data.TagsJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new internet gateway.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entec2.Tx, data *InternetGatewayData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create internet gateway history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new history based on diff.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entec2.Tx, old *entec2.BronzeAWSEC2InternetGateway, new *InternetGatewayData, diff *InternetGatewayDiff, now time.Time) error {
+	if !diff.IsChanged {
+		return nil
+	}
+
+	if err := h.CloseHistory(ctx, tx, old.ID, now); err != nil {
+		return err
+	}
+
+	_, err := h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new internet gateway history: %w", err)
+	}
+	return nil
+}
+
+// CloseHistory closes history records for a deleted internet gateway.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entec2.Tx, resourceID string, now time.Time) error {
+	_, err := tx.BronzeHistoryAWSEC2InternetGateway.Update().
+		Where(
+			bronzehistoryawsec2internetgateway.ResourceID(resourceID),
+			bronzehistoryawsec2internetgateway.ValidToIsNil(),
+		).
+		SetValidTo(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close internet gateway history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package internetgateway
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2internetgateway"
+)
+
+// Service handles AWS EC2 internet gateway ingestion.
+type Service struct {
+	client    *Client
+	entClient *entec2.Client
+	history   *HistoryService
+}
+
+// NewService creates a new internet gateway ingestion service.
+func NewService(client *Client, entClient *entec2.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestParams contains parameters for internet gateway ingestion.
+type IngestParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestResult contains the result of internet gateway ingestion.
+type IngestResult struct {
+	AccountID            string
+	Region               string
+	InternetGatewayCount int
+	CollectedAt          time.Time
+	DurationMillis       int64
+}
+
+// Ingest fetches internet gateways from AWS and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	// Fetch internet gateways from AWS
+	internetGateways, err := s.client.ListInternetGateways(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list internet gateways: %w", err)
+	}
+
+	// Convert to data structs
+	dataList := make([]*InternetGatewayData, 0, len(internetGateways))
+	for _, v := range internetGateways {
+		data, err := ConvertInternetGateway(v, params.AccountID, params.Region, collectedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert internet gateway: %w", err)
+		}
+		dataList = append(dataList, data)
+	}
+
+	// Save to database
+	if err := s.saveInternetGateways(ctx, dataList); err != nil {
+		return nil, fmt.Errorf("failed to save internet gateways: %w", err)
+	}
+
+	return &IngestResult{
+		AccountID:            params.AccountID,
+		Region:               params.Region,
+		InternetGatewayCount: len(dataList),
+		CollectedAt:          collectedAt,
+		DurationMillis:       time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveInternetGateways saves internet gateways to the database with history tracking.
+func (s *Service) saveInternetGateways(ctx context.Context, internetGateways []*InternetGatewayData) error {
+	if len(internetGateways) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, internetGatewayData := range internetGateways {
+		// Load existing internet gateway
+		existing, err := tx.BronzeAWSEC2InternetGateway.Query().
+			Where(bronzeawsec2internetgateway.ID(internetGatewayData.ResourceID)).
+			First(ctx)
+		if err != nil && !entec2.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("failed to load existing internet gateway %s: %w", internetGatewayData.ResourceID, err)
+		}
+
+		// Compute diff
+		diff := DiffInternetGatewayData(existing, internetGatewayData)
+
+		// Skip if no changes
+		if !diff.HasAnyChange() {
+			if err := tx.BronzeAWSEC2InternetGateway.UpdateOneID(internetGatewayData.ResourceID).
+				SetCollectedAt(internetGatewayData.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update collected_at for internet gateway %s: %w", internetGatewayData.ResourceID, err)
+			}
+			continue
+		}
+
+		// Create or update internet gateway
+		if existing == nil {
+			create := tx.BronzeAWSEC2InternetGateway.Create().
+				SetID(internetGatewayData.ResourceID).
+				SetName(internetGatewayData.Name).
+				SetVpcID(internetGatewayData.VpcID).
+				SetAttachmentState(internetGatewayData.AttachmentState).
+				SetOwnerID(internetGatewayData.OwnerID).
+				SetAccountID(internetGatewayData.AccountID).
+				SetRegion(internetGatewayData.Region).
+				SetCollectedAt(internetGatewayData.CollectedAt).
+				SetFirstCollectedAt(internetGatewayData.CollectedAt)
+
+			if internetGatewayData.TagsJSON != nil {
+				create.SetTagsJSON(internetGatewayData.TagsJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create internet gateway %s: %w", internetGatewayData.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeAWSEC2InternetGateway.UpdateOneID(internetGatewayData.ResourceID).
+				SetName(internetGatewayData.Name).
+				SetVpcID(internetGatewayData.VpcID).
+				SetAttachmentState(internetGatewayData.AttachmentState).
+				SetOwnerID(internetGatewayData.OwnerID).
+				SetAccountID(internetGatewayData.AccountID).
+				SetRegion(internetGatewayData.Region).
+				SetCollectedAt(internetGatewayData.CollectedAt)
+
+			if internetGatewayData.TagsJSON != nil {
+				update.SetTagsJSON(internetGatewayData.TagsJSON)
+			} else {
+				update.ClearTagsJSON()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update internet gateway %s: %w", internetGatewayData.ResourceID, err)
+			}
+		}
+
+		// Track history
+		if diff.IsNew {
+			if err := s.history.CreateHistory(ctx, tx, internetGatewayData, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create history for internet gateway %s: %w", internetGatewayData.ResourceID, err)
+			}
+		} else {
+			if err := s.history.UpdateHistory(ctx, tx, existing, internetGatewayData, diff, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update history for internet gateway %s: %w", internetGatewayData.ResourceID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleInternetGateways removes internet gateways that were not collected in the latest run.
+func (s *Service) DeleteStaleInternetGateways(ctx context.Context, accountID, region string, collectedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	stale, err := tx.BronzeAWSEC2InternetGateway.Query().
+		Where(
+			bronzeawsec2internetgateway.AccountID(accountID),
+			bronzeawsec2internetgateway.Region(region),
+			bronzeawsec2internetgateway.CollectedAtLT(collectedAt),
+		).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range stale {
+		if err := s.history.CloseHistory(ctx, tx, e.ID, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to close history for internet gateway %s: %w", e.ID, err)
+		}
+
+		if err := tx.BronzeAWSEC2InternetGateway.DeleteOne(e).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete internet gateway %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
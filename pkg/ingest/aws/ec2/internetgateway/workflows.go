@@ -0,0 +1,59 @@
+package internetgateway
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AWSEC2InternetGatewayWorkflowParams contains parameters for the internet gateway workflow.
+type AWSEC2InternetGatewayWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSEC2InternetGatewayWorkflowResult contains the result of the internet gateway workflow.
+type AWSEC2InternetGatewayWorkflowResult struct {
+	Region               string
+	InternetGatewayCount int
+	DurationMillis       int64
+}
+
+// AWSEC2InternetGatewayWorkflow ingests AWS EC2 internet gateways for a single region.
+func AWSEC2InternetGatewayWorkflow(ctx workflow.Context, params AWSEC2InternetGatewayWorkflowParams) (*AWSEC2InternetGatewayWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSEC2InternetGatewayWorkflow", "region", params.Region)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestEC2InternetGatewaysResult
+	err := workflow.ExecuteActivity(activityCtx, IngestEC2InternetGatewaysActivity, IngestEC2InternetGatewaysParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest internet gateways", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Completed AWSEC2InternetGatewayWorkflow",
+		"region", params.Region,
+		"internetGatewayCount", result.InternetGatewayCount,
+	)
+
+	return &AWSEC2InternetGatewayWorkflowResult{
+		Region:               result.Region,
+		InternetGatewayCount: result.InternetGatewayCount,
+		DurationMillis:       result.DurationMillis,
+	}, nil
+}
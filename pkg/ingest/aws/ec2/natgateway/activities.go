@@ -0,0 +1,97 @@
+package natgateway
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/awsauth"
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entec2.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entec2.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// createClient creates a rate-limited AWS EC2 client for the given account and region.
+func (a *Activities) createClient(ctx context.Context, accountID, region string) (*Client, error) {
+	cfg, err := awsauth.LoadConfig(ctx, a.configService, a.limiter, accountID, region)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(cfg), nil
+}
+
+// IngestEC2NATGatewaysParams contains parameters for the ingest activity.
+type IngestEC2NATGatewaysParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestEC2NATGatewaysResult contains the result of the ingest activity.
+type IngestEC2NATGatewaysResult struct {
+	AccountID       string
+	Region          string
+	NATGatewayCount int
+	DurationMillis  int64
+}
+
+// IngestEC2NATGatewaysActivity is the activity function reference for workflow registration.
+var IngestEC2NATGatewaysActivity = (*Activities).IngestEC2NATGateways
+
+// IngestEC2NATGateways is a Temporal activity that ingests AWS EC2 NAT gateways.
+func (a *Activities) IngestEC2NATGateways(ctx context.Context, params IngestEC2NATGatewaysParams) (*IngestEC2NATGatewaysResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting AWS EC2 NAT gateway ingestion",
+		"accountID", params.AccountID,
+		"region", params.Region,
+	)
+
+	client, err := a.createClient(ctx, params.AccountID, params.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create client: %w", err)
+	}
+
+	service := NewService(client, a.entClient)
+	result, err := service.Ingest(ctx, IngestParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to ingest NAT gateways: %w", err)
+	}
+
+	// Delete stale NAT gateways
+	if err := service.DeleteStaleNATGateways(ctx, params.AccountID, params.Region, result.CollectedAt); err != nil {
+		logger.Warn("Failed to delete stale NAT gateways", "error", err)
+	}
+
+	logger.Info("Completed AWS EC2 NAT gateway ingestion",
+		"accountID", params.AccountID,
+		"region", params.Region,
+		"natGatewayCount", result.NATGatewayCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestEC2NATGatewaysResult{
+		AccountID:       result.AccountID,
+		Region:          result.Region,
+		NATGatewayCount: result.NATGatewayCount,
+		DurationMillis:  result.DurationMillis,
+	}, nil
+}
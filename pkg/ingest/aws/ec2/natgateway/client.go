@@ -0,0 +1,45 @@
+package natgateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// Client wraps the AWS EC2 API for NAT gateways.
+type Client struct {
+	ec2Client *ec2.Client
+}
+
+// NewClient creates a new EC2 NAT gateway client from an AWS config.
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		ec2Client: ec2.NewFromConfig(cfg),
+	}
+}
+
+// ListNATGateways lists all NAT gateways in the configured region using pagination.
+// Deleted gateways, which AWS keeps returning for about an hour, are excluded.
+func (c *Client) ListNATGateways(ctx context.Context) ([]types.NatGateway, error) {
+	var natGateways []types.NatGateway
+
+	paginator := ec2.NewDescribeNatGatewaysPaginator(c.ec2Client, &ec2.DescribeNatGatewaysInput{
+		Filter: []types.Filter{{
+			Name:   aws.String("state"),
+			Values: []string{"pending", "failed", "available", "deleting"},
+		}},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describe nat gateways: %w", err)
+		}
+
+		natGateways = append(natGateways, output.NatGateways...)
+	}
+
+	return natGateways, nil
+}
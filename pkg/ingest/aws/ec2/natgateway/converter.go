@@ -0,0 +1,125 @@
+package natgateway
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// NATGatewayData holds converted NAT gateway data ready for Ent insertion.
+type NATGatewayData struct {
+	ResourceID       string
+	Name             string
+	VpcID            string
+	SubnetID         string
+	State            string
+	ConnectivityType string
+	CreateTime       *time.Time
+	FailureCode      string
+	FailureMessage   string
+	AddressesJSON    json.RawMessage
+	TagsJSON         json.RawMessage
+	AccountID        string
+	Region           string
+	CollectedAt      time.Time
+}
+
+// TagData is the JSON structure for resource tags.
+type TagData struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// AddressData is the JSON structure for NAT gateway addresses.
+type AddressData struct {
+	AllocationID       string `json:"allocation_id,omitempty"`
+	NetworkInterfaceID string `json:"network_interface_id,omitempty"`
+	PrivateIP          string `json:"private_ip,omitempty"`
+	PublicIP           string `json:"public_ip,omitempty"`
+	IsPrimary          bool   `json:"is_primary"`
+	Status             string `json:"status,omitempty"`
+}
+
+// ConvertNATGateway converts an AWS API NatGateway to NATGatewayData.
+func ConvertNATGateway(v types.NatGateway, accountID, region string, collectedAt time.Time) (*NATGatewayData, error) {
+	data := &NATGatewayData{
+		ResourceID:       derefStr(v.NatGatewayId),
+		VpcID:            derefStr(v.VpcId),
+		SubnetID:         derefStr(v.SubnetId),
+		State:            string(v.State),
+		ConnectivityType: string(v.ConnectivityType),
+		CreateTime:       v.CreateTime,
+		FailureCode:      derefStr(v.FailureCode),
+		FailureMessage:   derefStr(v.FailureMessage),
+		AccountID:        accountID,
+		Region:           region,
+		CollectedAt:      collectedAt,
+	}
+
+	// Convert tags to JSON
+	var err error
+	data.Name, data.TagsJSON, err = convertTags(v.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert addresses to JSON
+	if len(v.NatGatewayAddresses) > 0 {
+		addrs := make([]AddressData, 0, len(v.NatGatewayAddresses))
+		for _, a := range v.NatGatewayAddresses {
+			addrs = append(addrs, AddressData{
+				AllocationID:       derefStr(a.AllocationId),
+				NetworkInterfaceID: derefStr(a.NetworkInterfaceId),
+				PrivateIP:          derefStr(a.PrivateIp),
+				PublicIP:           derefStr(a.PublicIp),
+				IsPrimary:          derefBool(a.IsPrimary),
+				Status:             string(a.Status),
+			})
+		}
+		if data.AddressesJSON, err = json.Marshal(addrs); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// convertTags converts AWS tags to JSON and returns the value of the Name tag.
+func convertTags(tags []types.Tag) (string, json.RawMessage, error) {
+	if len(tags) == 0 {
+		return "", nil, nil
+	}
+
+	var name string
+	result := make([]TagData, 0, len(tags))
+	for _, tag := range tags {
+		if derefStr(tag.Key) == "Name" {
+			name = derefStr(tag.Value)
+		}
+		result = append(result, TagData{
+			Key:   derefStr(tag.Key),
+			Value: derefStr(tag.Value),
+		})
+	}
+
+	tagsJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, tagsJSON, nil
+}
+
+func derefStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func derefBool(b *bool) bool {
+	if b == nil {
+		return false
+	}
+	return *b
+}
@@ -0,0 +1,65 @@
+package natgateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+)
+
+// NATGatewayDiff represents changes between old and new NAT gateway states.
+type NATGatewayDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffNATGatewayData compares old Ent entity and new data.
+func DiffNATGatewayData(old *entec2.BronzeAWSEC2NATGateway, new *NATGatewayData) *NATGatewayDiff {
+	if old == nil {
+		return &NATGatewayDiff{IsNew: true}
+	}
+
+	return &NATGatewayDiff{
+		IsChanged: old.Name != new.Name ||
+			old.VpcID != new.VpcID ||
+			old.SubnetID != new.SubnetID ||
+			old.State != new.State ||
+			old.ConnectivityType != new.ConnectivityType ||
+			!timeEqual(old.CreateTime, new.CreateTime) ||
+			old.FailureCode != new.FailureCode ||
+			old.FailureMessage != new.FailureMessage ||
+			jsonChanged(old.AddressesJSON, new.AddressesJSON) ||
+			jsonChanged(old.TagsJSON, new.TagsJSON),
+	}
+}
+
+// HasAnyChange returns true if any part of the NAT gateway changed.
+func (d *NATGatewayDiff) HasAnyChange() bool {
+	return d.IsNew || d.IsChanged
+}
+
+func timeEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+// jsonChanged compares JSON values semantically, since jsonb does not
+// preserve the formatting or key order of what was written.
+func jsonChanged(old, new json.RawMessage) bool {
+	return !bytes.Equal(normalizeJSON(old), normalizeJSON(new))
+}
+
+func normalizeJSON(raw json.RawMessage) []byte {
+	if len(raw) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return raw
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
@@ -0,0 +1,94 @@
+package natgateway
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2natgateway"
+)
+
+// HistoryService handles history tracking for NAT gateways.
+type HistoryService struct {
+	entClient *entec2.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entec2.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entec2.Tx, data *NATGatewayData) *entec2.BronzeHistoryAWSEC2NATGatewayCreate {
+	create := tx.BronzeHistoryAWSEC2NATGateway.Create().
+		SetResourceID(data.ResourceID).
+		SetCollectedAt(data.CollectedAt).
+		SetName(data.Name).
+		SetVpcID(data.VpcID).
+		SetSubnetID(data.SubnetID).
+		SetState(data.State).
+		SetConnectivityType(data.ConnectivityType).
+		SetFailureCode(data.FailureCode).
+		SetFailureMessage(data.FailureMessage).
+		SetAccountID(data.AccountID).
+		SetRegion(data.Region)
+
+	if data.CreateTime != nil {
+		create.SetCreateTime(*data.CreateTime)
+	}
+	if data.AddressesJSON != nil {
+		create.SetAddressesJSON(data.AddressesJSON)
+	}
+	if data.TagsJSON != nil {
+		create.SetTagsJSON(data.TagsJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new NAT gateway.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entec2.Tx, data *NATGatewayData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create NAT gateway history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new history based on diff.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entec2.Tx, old *entec2.BronzeAWSEC2NATGateway, new *NATGatewayData, diff *NATGatewayDiff, now time.Time) error {
+	if !diff.IsChanged {
+		return nil
+	}
+
+	if err := h.CloseHistory(ctx, tx, old.ID, now); err != nil {
+		return err
+	}
+
+	_, err := h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new NAT gateway history: %w", err)
+	}
+	return nil
+}
+
+// CloseHistory closes history records for a deleted NAT gateway.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entec2.Tx, resourceID string, now time.Time) error {
+	_, err := tx.BronzeHistoryAWSEC2NATGateway.Update().
+		Where(
+			bronzehistoryawsec2natgateway.ResourceID(resourceID),
+			bronzehistoryawsec2natgateway.ValidToIsNil(),
+		).
+		SetValidTo(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close NAT gateway history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,253 @@
+package natgateway
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2natgateway"
+)
+
+// Service handles AWS EC2 NAT gateway ingestion.
+type Service struct {
+	client    *Client
+	entClient *entec2.Client
+	history   *HistoryService
+}
+
+// NewService creates a new NAT gateway ingestion service.
+func NewService(client *Client, entClient *entec2.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestParams contains parameters for NAT gateway ingestion.
+type IngestParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestResult contains the result of NAT gateway ingestion.
+type IngestResult struct {
+	AccountID       string
+	Region          string
+	NATGatewayCount int
+	CollectedAt     time.Time
+	DurationMillis  int64
+}
+
+// Ingest fetches NAT gateways from AWS and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	// Fetch NAT gateways from AWS
+	natGateways, err := s.client.ListNATGateways(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list NAT gateways: %w", err)
+	}
+
+	// Convert to data structs
+	dataList := make([]*NATGatewayData, 0, len(natGateways))
+	for _, v := range natGateways {
+		data, err := ConvertNATGateway(v, params.AccountID, params.Region, collectedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert NAT gateway: %w", err)
+		}
+		dataList = append(dataList, data)
+	}
+
+	// Save to database
+	if err := s.saveNATGateways(ctx, dataList); err != nil {
+		return nil, fmt.Errorf("failed to save NAT gateways: %w", err)
+	}
+
+	return &IngestResult{
+		AccountID:       params.AccountID,
+		Region:          params.Region,
+		NATGatewayCount: len(dataList),
+		CollectedAt:     collectedAt,
+		DurationMillis:  time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveNATGateways saves NAT gateways to the database with history tracking.
+func (s *Service) saveNATGateways(ctx context.Context, natGateways []*NATGatewayData) error {
+	if len(natGateways) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, natGatewayData := range natGateways {
+		// Load existing NAT gateway
+		existing, err := tx.BronzeAWSEC2NATGateway.Query().
+			Where(bronzeawsec2natgateway.ID(natGatewayData.ResourceID)).
+			First(ctx)
+		if err != nil && !entec2.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("failed to load existing NAT gateway %s: %w", natGatewayData.ResourceID, err)
+		}
+
+		// Compute diff
+		diff := DiffNATGatewayData(existing, natGatewayData)
+
+		// Skip if no changes
+		if !diff.HasAnyChange() {
+			if err := tx.BronzeAWSEC2NATGateway.UpdateOneID(natGatewayData.ResourceID).
+				SetCollectedAt(natGatewayData.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update collected_at for NAT gateway %s: %w", natGatewayData.ResourceID, err)
+			}
+			continue
+		}
+
+		// Create or update NAT gateway
+		if existing == nil {
+			create := tx.BronzeAWSEC2NATGateway.Create().
+				SetID(natGatewayData.ResourceID).
+				SetName(natGatewayData.Name).
+				SetVpcID(natGatewayData.VpcID).
+				SetSubnetID(natGatewayData.SubnetID).
+				SetState(natGatewayData.State).
+				SetConnectivityType(natGatewayData.ConnectivityType).
+				SetFailureCode(natGatewayData.FailureCode).
+				SetFailureMessage(natGatewayData.FailureMessage).
+				SetAccountID(natGatewayData.AccountID).
+				SetRegion(natGatewayData.Region).
+				SetCollectedAt(natGatewayData.CollectedAt).
+				SetFirstCollectedAt(natGatewayData.CollectedAt)
+
+			if natGatewayData.CreateTime != nil {
+				create.SetCreateTime(*natGatewayData.CreateTime)
+			}
+			if natGatewayData.AddressesJSON != nil {
+				create.SetAddressesJSON(natGatewayData.AddressesJSON)
+			}
+			if natGatewayData.TagsJSON != nil {
+				create.SetTagsJSON(natGatewayData.TagsJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create NAT gateway %s: %w", natGatewayData.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeAWSEC2NATGateway.UpdateOneID(natGatewayData.ResourceID).
+				SetName(natGatewayData.Name).
+				SetVpcID(natGatewayData.VpcID).
+				SetSubnetID(natGatewayData.SubnetID).
+				SetState(natGatewayData.State).
+				SetConnectivityType(natGatewayData.ConnectivityType).
+				SetFailureCode(natGatewayData.FailureCode).
+				SetFailureMessage(natGatewayData.FailureMessage).
+				SetAccountID(natGatewayData.AccountID).
+				SetRegion(natGatewayData.Region).
+				SetCollectedAt(natGatewayData.CollectedAt)
+
+			if natGatewayData.CreateTime != nil {
+				update.SetCreateTime(*natGatewayData.CreateTime)
+			} else {
+				update.ClearCreateTime()
+			}
+			if natGatewayData.AddressesJSON != nil {
+				update.SetAddressesJSON(natGatewayData.AddressesJSON)
+			} else {
+				update.ClearAddressesJSON()
+			}
+			if natGatewayData.TagsJSON != nil {
+				update.SetTagsJSON(natGatewayData.TagsJSON)
+			} else {
+				update.ClearTagsJSON()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update NAT gateway %s: %w", natGatewayData.ResourceID, err)
+			}
+		}
+
+		// Track history
+		if diff.IsNew {
+			if err := s.history.CreateHistory(ctx, tx, natGatewayData, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create history for NAT gateway %s: %w", natGatewayData.ResourceID, err)
+			}
+		} else {
+			if err := s.history.UpdateHistory(ctx, tx, existing, natGatewayData, diff, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update history for NAT gateway %s: %w", natGatewayData.ResourceID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleNATGateways removes NAT gateways that were not collected in the latest run.
+func (s *Service) DeleteStaleNATGateways(ctx context.Context, accountID, region string, collectedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	stale, err := tx.BronzeAWSEC2NATGateway.Query().
+		Where(
+			bronzeawsec2natgateway.AccountID(accountID),
+			bronzeawsec2natgateway.Region(region),
+			bronzeawsec2natgateway.CollectedAtLT(collectedAt),
+		).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range stale {
+		if err := s.history.CloseHistory(ctx, tx, e.ID, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to close history for NAT gateway %s: %w", e.ID, err)
+		}
+
+		if err := tx.BronzeAWSEC2NATGateway.DeleteOne(e).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete NAT gateway %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package natgateway
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AWSEC2NATGatewayWorkflowParams contains parameters for the NAT gateway workflow.
+type AWSEC2NATGatewayWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSEC2NATGatewayWorkflowResult contains the result of the NAT gateway workflow.
+type AWSEC2NATGatewayWorkflowResult struct {
+	Region          string
+	NATGatewayCount int
+	DurationMillis  int64
+}
+
+// AWSEC2NATGatewayWorkflow ingests AWS EC2 NAT gateways for a single region.
+func AWSEC2NATGatewayWorkflow(ctx workflow.Context, params AWSEC2NATGatewayWorkflowParams) (*AWSEC2NATGatewayWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSEC2NATGatewayWorkflow", "region", params.Region)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestEC2NATGatewaysResult
+	err := workflow.ExecuteActivity(activityCtx, IngestEC2NATGatewaysActivity, IngestEC2NATGatewaysParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest NAT gateways", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Completed AWSEC2NATGatewayWorkflow",
+		"region", params.Region,
+		"natGatewayCount", result.NATGatewayCount,
+	)
+
+	return &AWSEC2NATGatewayWorkflowResult{
+		Region:          result.Region,
+		NATGatewayCount: result.NATGatewayCount,
+		DurationMillis:  result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package networkacl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// Client wraps the AWS EC2 API for network ACLs.
+type Client struct {
+	ec2Client *ec2.Client
+}
+
+// NewClient creates a new EC2 network ACL client from an AWS config.
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		ec2Client: ec2.NewFromConfig(cfg),
+	}
+}
+
+// ListNetworkACLs lists all network ACLs in the configured region using pagination.
+func (c *Client) ListNetworkACLs(ctx context.Context) ([]types.NetworkAcl, error) {
+	var networkACLs []types.NetworkAcl
+
+	paginator := ec2.NewDescribeNetworkAclsPaginator(c.ec2Client, &ec2.DescribeNetworkAclsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describe network acls: %w", err)
+		}
+
+		networkACLs = append(networkACLs, output.NetworkAcls...)
+	}
+
+	return networkACLs, nil
+}
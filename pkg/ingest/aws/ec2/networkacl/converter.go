@@ -0,0 +1,172 @@
+package networkacl
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// NetworkACLData holds converted network ACL data ready for Ent insertion.
+type NetworkACLData struct {
+	ResourceID       string
+	Name             string
+	VpcID            string
+	IsDefault        bool
+	OwnerID          string
+	EntriesJSON      json.RawMessage
+	AssociationsJSON json.RawMessage
+	TagsJSON         json.RawMessage
+	AccountID        string
+	Region           string
+	CollectedAt      time.Time
+}
+
+// TagData is the JSON structure for resource tags.
+type TagData struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// EntryData is the JSON structure for network ACL entries.
+type EntryData struct {
+	RuleNumber    int32          `json:"rule_number"`
+	Egress        bool           `json:"egress"`
+	Protocol      string         `json:"protocol"`
+	RuleAction    string         `json:"rule_action"`
+	CidrBlock     string         `json:"cidr_block,omitempty"`
+	Ipv6CidrBlock string         `json:"ipv6_cidr_block,omitempty"`
+	PortRange     *PortRangeData `json:"port_range,omitempty"`
+	IcmpTypeCode  *IcmpTypeData  `json:"icmp_type_code,omitempty"`
+}
+
+// PortRangeData is the JSON structure for an entry port range.
+type PortRangeData struct {
+	From int32 `json:"from"`
+	To   int32 `json:"to"`
+}
+
+// IcmpTypeData is the JSON structure for an entry ICMP type and code.
+type IcmpTypeData struct {
+	Type int32 `json:"type"`
+	Code int32 `json:"code"`
+}
+
+// AssociationData is the JSON structure for network ACL subnet associations.
+type AssociationData struct {
+	AssociationID string `json:"association_id"`
+	SubnetID      string `json:"subnet_id"`
+}
+
+// ConvertNetworkACL converts an AWS API NetworkAcl to NetworkACLData.
+func ConvertNetworkACL(v types.NetworkAcl, accountID, region string, collectedAt time.Time) (*NetworkACLData, error) {
+	data := &NetworkACLData{
+		ResourceID:  derefStr(v.NetworkAclId),
+		VpcID:       derefStr(v.VpcId),
+		IsDefault:   derefBool(v.IsDefault),
+		OwnerID:     derefStr(v.OwnerId),
+		AccountID:   accountID,
+		Region:      region,
+		CollectedAt: collectedAt,
+	}
+
+	// Convert tags to JSON
+	var err error
+	data.Name, data.TagsJSON, err = convertTags(v.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert entries to JSON
+	if len(v.Entries) > 0 {
+		entries := make([]EntryData, 0, len(v.Entries))
+		for _, e := range v.Entries {
+			entry := EntryData{
+				RuleNumber:    derefInt32(e.RuleNumber),
+				Egress:        derefBool(e.Egress),
+				Protocol:      derefStr(e.Protocol),
+				RuleAction:    string(e.RuleAction),
+				CidrBlock:     derefStr(e.CidrBlock),
+				Ipv6CidrBlock: derefStr(e.Ipv6CidrBlock),
+			}
+			if e.PortRange != nil {
+				entry.PortRange = &PortRangeData{
+					From: derefInt32(e.PortRange.From),
+					To:   derefInt32(e.PortRange.To),
+				}
+			}
+			if e.IcmpTypeCode != nil {
+				entry.IcmpTypeCode = &IcmpTypeData{
+					Type: derefInt32(e.IcmpTypeCode.Type),
+					Code: derefInt32(e.IcmpTypeCode.Code),
+				}
+			}
+			entries = append(entries, entry)
+		}
+		if data.EntriesJSON, err = json.Marshal(entries); err != nil {
+			return nil, err
+		}
+	}
+
+	// Convert subnet associations to JSON
+	if len(v.Associations) > 0 {
+		assocs := make([]AssociationData, 0, len(v.Associations))
+		for _, a := range v.Associations {
+			assocs = append(assocs, AssociationData{
+				AssociationID: derefStr(a.NetworkAclAssociationId),
+				SubnetID:      derefStr(a.SubnetId),
+			})
+		}
+		if data.AssociationsJSON, err = json.Marshal(assocs); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// convertTags converts AWS tags to JSON and returns the value of the Name tag.
+func convertTags(tags []types.Tag) (string, json.RawMessage, error) {
+	if len(tags) == 0 {
+		return "", nil, nil
+	}
+
+	var name string
+	result := make([]TagData, 0, len(tags))
+	for _, tag := range tags {
+		if derefStr(tag.Key) == "Name" {
+			name = derefStr(tag.Value)
+		}
+		result = append(result, TagData{
+			Key:   derefStr(tag.Key),
+			Value: derefStr(tag.Value),
+		})
+	}
+
+	tagsJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, tagsJSON, nil
+}
+
+func derefStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func derefBool(b *bool) bool {
+	if b == nil {
+		return false
+	}
+	return *b
+}
+
+func derefInt32(i *int32) int32 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
@@ -0,0 +1,80 @@
+package networkacl
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func TestConvertNetworkACL(t *testing.T) {
+	collected := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	acl := types.NetworkAcl{
+		NetworkAclId: aws.String("acl-123"),
+		VpcId:        aws.String("vpc-1"),
+		IsDefault:    aws.Bool(true),
+		Entries: []types.NetworkAclEntry{
+			{
+				RuleNumber: aws.Int32(100),
+				Egress:     aws.Bool(false),
+				Protocol:   aws.String("6"),
+				RuleAction: types.RuleActionAllow,
+				CidrBlock:  aws.String("0.0.0.0/0"),
+				PortRange:  &types.PortRange{From: aws.Int32(22), To: aws.Int32(22)},
+			},
+			{
+				RuleNumber: aws.Int32(32767),
+				Egress:     aws.Bool(false),
+				Protocol:   aws.String("-1"),
+				RuleAction: types.RuleActionDeny,
+				CidrBlock:  aws.String("0.0.0.0/0"),
+			},
+		},
+		Associations: []types.NetworkAclAssociation{
+			{NetworkAclAssociationId: aws.String("aclassoc-1"), SubnetId: aws.String("subnet-1")},
+		},
+		Tags: []types.Tag{{Key: aws.String("Name"), Value: aws.String("public")}},
+	}
+
+	data, err := ConvertNetworkACL(acl, "123456789012", "us-east-1", collected)
+	if err != nil {
+		t.Fatalf("ConvertNetworkACL: %v", err)
+	}
+	if data.ResourceID != "acl-123" || data.VpcID != "vpc-1" || !data.IsDefault || data.Name != "public" {
+		t.Errorf("got %+v", data)
+	}
+
+	var entries []EntryData
+	if err := json.Unmarshal(data.EntriesJSON, &entries); err != nil {
+		t.Fatalf("unmarshal entries: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(entries))
+	}
+	if e := entries[0]; e.RuleAction != "allow" || e.PortRange == nil || e.PortRange.From != 22 || e.IcmpTypeCode != nil {
+		t.Errorf("entry 0 = %+v", e)
+	}
+	if e := entries[1]; e.RuleAction != "deny" || e.PortRange != nil {
+		t.Errorf("entry 1 = %+v", e)
+	}
+
+	var assocs []AssociationData
+	if err := json.Unmarshal(data.AssociationsJSON, &assocs); err != nil {
+		t.Fatalf("unmarshal associations: %v", err)
+	}
+	if len(assocs) != 1 || assocs[0].SubnetID != "subnet-1" {
+		t.Errorf("associations = %+v", assocs)
+	}
+}
+
+func TestConvertNetworkACLEmptyCollections(t *testing.T) {
+	data, err := ConvertNetworkACL(types.NetworkAcl{NetworkAclId: aws.String("acl-1")}, "1", "r", time.Now())
+	if err != nil {
+		t.Fatalf("ConvertNetworkACL: %v", err)
+	}
+	if data.EntriesJSON != nil || data.AssociationsJSON != nil || data.TagsJSON != nil {
+		t.Errorf("empty collections should be stored as NULL, got %s %s %s", data.EntriesJSON, data.AssociationsJSON, data.TagsJSON)
+	}
+}
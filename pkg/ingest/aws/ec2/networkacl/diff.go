@@ -0,0 +1,54 @@
+package networkacl
+
+import (
+	"bytes"
+	"encoding/json"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+)
+
+// NetworkACLDiff represents changes between old and new network ACL states.
+type NetworkACLDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffNetworkACLData compares old Ent entity and new data.
+func DiffNetworkACLData(old *entec2.BronzeAWSEC2NetworkACL, new *NetworkACLData) *NetworkACLDiff {
+	if old == nil {
+		return &NetworkACLDiff{IsNew: true}
+	}
+
+	return &NetworkACLDiff{
+		IsChanged: old.Name != new.Name ||
+			old.VpcID != new.VpcID ||
+			old.IsDefault != new.IsDefault ||
+			old.OwnerID != new.OwnerID ||
+			jsonChanged(old.EntriesJSON, new.EntriesJSON) ||
+			jsonChanged(old.AssociationsJSON, new.AssociationsJSON) ||
+			jsonChanged(old.TagsJSON, new.TagsJSON),
+	}
+}
+
+// HasAnyChange returns true if any part of the network ACL changed.
+func (d *NetworkACLDiff) HasAnyChange() bool {
+	return d.IsNew || d.IsChanged
+}
+
+// jsonChanged compares JSON values semantically, since jsonb does not
+// preserve the formatting or key order of what was written.
+func jsonChanged(old, new json.RawMessage) bool {
+	return !bytes.Equal(normalizeJSON(old), normalizeJSON(new))
+}
+
+func normalizeJSON(raw json.RawMessage) []byte {
+	if len(raw) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return raw
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
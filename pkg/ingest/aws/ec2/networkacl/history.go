@@ -0,0 +1,91 @@
+package networkacl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2networkacl"
+)
+
+// HistoryService handles history tracking for network ACLs.
+type HistoryService struct {
+	entClient *entec2.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entec2.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entec2.Tx, data *NetworkACLData) *entec2.BronzeHistoryAWSEC2NetworkACLCreate {
+	create := tx.BronzeHistoryAWSEC2NetworkACL.Create().
+		SetResourceID(data.ResourceID).
+		SetCollectedAt(data.CollectedAt).
+		SetName(data.Name).
+		SetVpcID(data.VpcID).
+		SetIsDefault(data.IsDefault).
+		SetOwnerID(data.OwnerID).
+		SetAccountID(data.AccountID).
+		SetRegion(data.Region)
+
+	if data.EntriesJSON != nil {
+		create.SetEntriesJSON(data.EntriesJSON)
+	}
+	if data.AssociationsJSON != nil {
+		create.SetAssociationsJSON(data.AssociationsJSON)
+	}
+	if data.TagsJSON != nil {
+		create.SetTagsJSON(data.TagsJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new network ACL.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entec2.Tx, data *NetworkACLData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create network ACL history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new history based on diff.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entec2.Tx, old *entec2.BronzeAWSEC2NetworkACL, new *NetworkACLData, diff *NetworkACLDiff, now time.Time) error {
+	if !diff.IsChanged {
+		return nil
+	}
+
+	if err := h.CloseHistory(ctx, tx, old.ID, now); err != nil {
+		return err
+	}
+
+	_, err := h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new network ACL history: %w", err)
+	}
+	return nil
+}
+
+// CloseHistory closes history records for a deleted network ACL.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entec2.Tx, resourceID string, now time.Time) error {
+	_, err := tx.BronzeHistoryAWSEC2NetworkACL.Update().
+		Where(
+			bronzehistoryawsec2networkacl.ResourceID(resourceID),
+			bronzehistoryawsec2networkacl.ValidToIsNil(),
+		).
+		SetValidTo(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close network ACL history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+package networkacl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2networkacl"
+)
+
+// Service handles AWS EC2 network ACL ingestion.
+type Service struct {
+	client    *Client
+	entClient *entec2.Client
+	history   *HistoryService
+}
+
+// NewService creates a new network ACL ingestion service.
+func NewService(client *Client, entClient *entec2.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestParams contains parameters for network ACL ingestion.
+type IngestParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestResult contains the result of network ACL ingestion.
+type IngestResult struct {
+	AccountID       string
+	Region          string
+	NetworkACLCount int
+	CollectedAt     time.Time
+	DurationMillis  int64
+}
+
+// Ingest fetches network ACLs from AWS and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	// Fetch network ACLs from AWS
+	networkACLs, err := s.client.ListNetworkACLs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list network ACLs: %w", err)
+	}
+
+	// Convert to data structs
+	dataList := make([]*NetworkACLData, 0, len(networkACLs))
+	for _, v := range networkACLs {
+		data, err := ConvertNetworkACL(v, params.AccountID, params.Region, collectedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert network ACL: %w", err)
+		}
+		dataList = append(dataList, data)
+	}
+
+	// Save to database
+	if err := s.saveNetworkACLs(ctx, dataList); err != nil {
+		return nil, fmt.Errorf("failed to save network ACLs: %w", err)
+	}
+
+	return &IngestResult{
+		AccountID:       params.AccountID,
+		Region:          params.Region,
+		NetworkACLCount: len(dataList),
+		CollectedAt:     collectedAt,
+		DurationMillis:  time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveNetworkACLs saves network ACLs to the database with history tracking.
+func (s *Service) saveNetworkACLs(ctx context.Context, networkACLs []*NetworkACLData) error {
+	if len(networkACLs) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, networkACLData := range networkACLs {
+		// Load existing network ACL
+		existing, err := tx.BronzeAWSEC2NetworkACL.Query().
+			Where(bronzeawsec2networkacl.ID(networkACLData.ResourceID)).
+			First(ctx)
+		if err != nil && !entec2.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("failed to load existing network ACL %s: %w", networkACLData.ResourceID, err)
+		}
+
+		// Compute diff
+		diff := DiffNetworkACLData(existing, networkACLData)
+
+		// Skip if no changes
+		if !diff.HasAnyChange() {
+			if err := tx.BronzeAWSEC2NetworkACL.UpdateOneID(networkACLData.ResourceID).
+				SetCollectedAt(networkACLData.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update collected_at for network ACL %s: %w", networkACLData.ResourceID, err)
+			}
+			continue
+		}
+
+		// Create or update network ACL
+		if existing == nil {
+			create := tx.BronzeAWSEC2NetworkACL.Create().
+				SetID(networkACLData.ResourceID).
+				SetName(networkACLData.Name).
+				SetVpcID(networkACLData.VpcID).
+				SetIsDefault(networkACLData.IsDefault).
+				SetOwnerID(networkACLData.OwnerID).
+				SetAccountID(networkACLData.AccountID).
+				SetRegion(networkACLData.Region).
+				SetCollectedAt(networkACLData.CollectedAt).
+				SetFirstCollectedAt(networkACLData.CollectedAt)
+
+			if networkACLData.EntriesJSON != nil {
+				create.SetEntriesJSON(networkACLData.EntriesJSON)
+			}
+			if networkACLData.AssociationsJSON != nil {
+				create.SetAssociationsJSON(networkACLData.AssociationsJSON)
+			}
+			if networkACLData.TagsJSON != nil {
+				create.SetTagsJSON(networkACLData.TagsJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create network ACL %s: %w", networkACLData.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeAWSEC2NetworkACL.UpdateOneID(networkACLData.ResourceID).
+				SetName(networkACLData.Name).
+				SetVpcID(networkACLData.VpcID).
+				SetIsDefault(networkACLData.IsDefault).
+				SetOwnerID(networkACLData.OwnerID).
+				SetAccountID(networkACLData.AccountID).
+				SetRegion(networkACLData.Region).
+				SetCollectedAt(networkACLData.CollectedAt)
+
+			if networkACLData.EntriesJSON != nil {
+				update.SetEntriesJSON(networkACLData.EntriesJSON)
+			} else {
+				update.ClearEntriesJSON()
+			}
+			if networkACLData.AssociationsJSON != nil {
+				update.SetAssociationsJSON(networkACLData.AssociationsJSON)
+			} else {
+				update.ClearAssociationsJSON()
+			}
+			if networkACLData.TagsJSON != nil {
+				update.SetTagsJSON(networkACLData.TagsJSON)
+			} else {
+				update.ClearTagsJSON()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update network ACL %s: %w", networkACLData.ResourceID, err)
+			}
+		}
+
+		// Track history
+		if diff.IsNew {
+			if err := s.history.CreateHistory(ctx, tx, networkACLData, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create history for network ACL %s: %w", networkACLData.ResourceID, err)
+			}
+		} else {
+			if err := s.history.UpdateHistory(ctx, tx, existing, networkACLData, diff, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update history for network ACL %s: %w", networkACLData.ResourceID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleNetworkACLs removes network ACLs that were not collected in the latest run.
+func (s *Service) DeleteStaleNetworkACLs(ctx context.Context, accountID, region string, collectedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	stale, err := tx.BronzeAWSEC2NetworkACL.Query().
+		Where(
+			bronzeawsec2networkacl.AccountID(accountID),
+			bronzeawsec2networkacl.Region(region),
+			bronzeawsec2networkacl.CollectedAtLT(collectedAt),
+		).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range stale {
+		if err := s.history.CloseHistory(ctx, tx, e.ID, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to close history for network ACL %s: %w", e.ID, err)
+		}
+
+		if err := tx.BronzeAWSEC2NetworkACL.DeleteOne(e).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete network ACL %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package networkacl
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AWSEC2NetworkACLWorkflowParams contains parameters for the network ACL workflow.
+type AWSEC2NetworkACLWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSEC2NetworkACLWorkflowResult contains the result of the network ACL workflow.
+type AWSEC2NetworkACLWorkflowResult struct {
+	Region          string
+	NetworkACLCount int
+	DurationMillis  int64
+}
+
+// AWSEC2NetworkACLWorkflow ingests AWS EC2 network ACLs for a single region.
+func AWSEC2NetworkACLWorkflow(ctx workflow.Context, params AWSEC2NetworkACLWorkflowParams) (*AWSEC2NetworkACLWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSEC2NetworkACLWorkflow", "region", params.Region)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestEC2NetworkACLsResult
+	err := workflow.ExecuteActivity(activityCtx, IngestEC2NetworkACLsActivity, IngestEC2NetworkACLsParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest network ACLs", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Completed AWSEC2NetworkACLWorkflow",
+		"region", params.Region,
+		"networkACLCount", result.NetworkACLCount,
+	)
+
+	return &AWSEC2NetworkACLWorkflowResult{
+		Region:          result.Region,
+		NetworkACLCount: result.NetworkACLCount,
+		DurationMillis:  result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package networkinterface
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// Client wraps the AWS EC2 API for network interfaces.
+type Client struct {
+	ec2Client *ec2.Client
+}
+
+// NewClient creates a new EC2 network interface client from an AWS config.
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		ec2Client: ec2.NewFromConfig(cfg),
+	}
+}
+
+// ListNetworkInterfaces lists all network interfaces in the configured region using pagination.
+func (c *Client) ListNetworkInterfaces(ctx context.Context) ([]types.NetworkInterface, error) {
+	var networkInterfaces []types.NetworkInterface
+
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(c.ec2Client, &ec2.DescribeNetworkInterfacesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describe network interfaces: %w", err)
+		}
+
+		networkInterfaces = append(networkInterfaces, output.NetworkInterfaces...)
+	}
+
+	return networkInterfaces, nil
+}
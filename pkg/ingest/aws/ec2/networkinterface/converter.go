@@ -0,0 +1,184 @@
+package networkinterface
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// NetworkInterfaceData holds converted network interface data ready for Ent insertion.
+type NetworkInterfaceData struct {
+	ResourceID             string
+	Description            string
+	InterfaceType          string
+	Status                 string
+	VpcID                  string
+	SubnetID               string
+	AvailabilityZone       string
+	MACAddress             string
+	PrivateIPAddress       string
+	PrivateDNSName         string
+	PublicIP               string
+	PublicDNSName          string
+	SourceDestCheck        bool
+	RequesterManaged       bool
+	RequesterID            string
+	OwnerID                string
+	AttachmentID           string
+	AttachmentInstanceID   string
+	AttachmentStatus       string
+	GroupsJSON             json.RawMessage
+	PrivateIPAddressesJSON json.RawMessage
+	Ipv6AddressesJSON      json.RawMessage
+	TagsJSON               json.RawMessage
+	AccountID              string
+	Region                 string
+	CollectedAt            time.Time
+}
+
+// TagData is the JSON structure for resource tags.
+type TagData struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// SecurityGroupRef is the JSON structure for security group references.
+type SecurityGroupRef struct {
+	GroupID   string `json:"group_id"`
+	GroupName string `json:"group_name"`
+}
+
+// PrivateIPAddressData is the JSON structure for private IPv4 addresses.
+type PrivateIPAddressData struct {
+	PrivateIPAddress string `json:"private_ip_address"`
+	Primary          bool   `json:"primary"`
+	PublicIP         string `json:"public_ip,omitempty"`
+}
+
+// ConvertNetworkInterface converts an AWS API NetworkInterface to NetworkInterfaceData.
+func ConvertNetworkInterface(v types.NetworkInterface, accountID, region string, collectedAt time.Time) (*NetworkInterfaceData, error) {
+	data := &NetworkInterfaceData{
+		ResourceID:       derefStr(v.NetworkInterfaceId),
+		Description:      derefStr(v.Description),
+		InterfaceType:    string(v.InterfaceType),
+		Status:           string(v.Status),
+		VpcID:            derefStr(v.VpcId),
+		SubnetID:         derefStr(v.SubnetId),
+		AvailabilityZone: derefStr(v.AvailabilityZone),
+		MACAddress:       derefStr(v.MacAddress),
+		PrivateIPAddress: derefStr(v.PrivateIpAddress),
+		PrivateDNSName:   derefStr(v.PrivateDnsName),
+		SourceDestCheck:  derefBool(v.SourceDestCheck),
+		RequesterManaged: derefBool(v.RequesterManaged),
+		RequesterID:      derefStr(v.RequesterId),
+		OwnerID:          derefStr(v.OwnerId),
+		AccountID:        accountID,
+		Region:           region,
+		CollectedAt:      collectedAt,
+	}
+
+	// Convert tags to JSON
+	var err error
+	_, data.TagsJSON, err = convertTags(v.TagSet)
+	if err != nil {
+		return nil, err
+	}
+
+	// Public IP association
+	if v.Association != nil {
+		data.PublicIP = derefStr(v.Association.PublicIp)
+		data.PublicDNSName = derefStr(v.Association.PublicDnsName)
+	}
+
+	// Attachment
+	if v.Attachment != nil {
+		data.AttachmentID = derefStr(v.Attachment.AttachmentId)
+		data.AttachmentInstanceID = derefStr(v.Attachment.InstanceId)
+		data.AttachmentStatus = string(v.Attachment.Status)
+	}
+
+	// Convert security groups to JSON
+	if len(v.Groups) > 0 {
+		groups := make([]SecurityGroupRef, 0, len(v.Groups))
+		for _, g := range v.Groups {
+			groups = append(groups, SecurityGroupRef{
+				GroupID:   derefStr(g.GroupId),
+				GroupName: derefStr(g.GroupName),
+			})
+		}
+		if data.GroupsJSON, err = json.Marshal(groups); err != nil {
+			return nil, err
+		}
+	}
+
+	// Convert private IP addresses to JSON
+	if len(v.PrivateIpAddresses) > 0 {
+		addrs := make([]PrivateIPAddressData, 0, len(v.PrivateIpAddresses))
+		for _, a := range v.PrivateIpAddresses {
+			addr := PrivateIPAddressData{
+				PrivateIPAddress: derefStr(a.PrivateIpAddress),
+				Primary:          derefBool(a.Primary),
+			}
+			if a.Association != nil {
+				addr.PublicIP = derefStr(a.Association.PublicIp)
+			}
+			addrs = append(addrs, addr)
+		}
+		if data.PrivateIPAddressesJSON, err = json.Marshal(addrs); err != nil {
+			return nil, err
+		}
+	}
+
+	// Convert IPv6 addresses to JSON
+	if len(v.Ipv6Addresses) > 0 {
+		addrs := make([]string, 0, len(v.Ipv6Addresses))
+		for _, a := range v.Ipv6Addresses {
+			addrs = append(addrs, derefStr(a.Ipv6Address))
+		}
+		if data.Ipv6AddressesJSON, err = json.Marshal(addrs); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// convertTags converts AWS tags to JSON and returns the value of the Name tag.
+func convertTags(tags []types.Tag) (string, json.RawMessage, error) {
+	if len(tags) == 0 {
+		return "", nil, nil
+	}
+
+	var name string
+	result := make([]TagData, 0, len(tags))
+	for _, tag := range tags {
+		if derefStr(tag.Key) == "Name" {
+			name = derefStr(tag.Value)
+		}
+		result = append(result, TagData{
+			Key:   derefStr(tag.Key),
+			Value: derefStr(tag.Value),
+		})
+	}
+
+	tagsJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, tagsJSON, nil
+}
+
+func derefStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func derefBool(b *bool) bool {
+	if b == nil {
+		return false
+	}
+	return *b
+}
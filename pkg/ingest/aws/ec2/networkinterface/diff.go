@@ -0,0 +1,69 @@
+package networkinterface
+
+import (
+	"bytes"
+	"encoding/json"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+)
+
+// NetworkInterfaceDiff represents changes between old and new network interface states.
+type NetworkInterfaceDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffNetworkInterfaceData compares old Ent entity and new data.
+func DiffNetworkInterfaceData(old *entec2.BronzeAWSEC2NetworkInterface, new *NetworkInterfaceData) *NetworkInterfaceDiff {
+	if old == nil {
+		return &NetworkInterfaceDiff{IsNew: true}
+	}
+
+	return &NetworkInterfaceDiff{
+		IsChanged: old.Description != new.Description ||
+			old.InterfaceType != new.InterfaceType ||
+			old.Status != new.Status ||
+			old.VpcID != new.VpcID ||
+			old.SubnetID != new.SubnetID ||
+			old.AvailabilityZone != new.AvailabilityZone ||
+			old.MACAddress != new.MACAddress ||
+			old.PrivateIPAddress != new.PrivateIPAddress ||
+			old.PrivateDNSName != new.PrivateDNSName ||
+			old.PublicIP != new.PublicIP ||
+			old.PublicDNSName != new.PublicDNSName ||
+			old.SourceDestCheck != new.SourceDestCheck ||
+			old.RequesterManaged != new.RequesterManaged ||
+			old.RequesterID != new.RequesterID ||
+			old.OwnerID != new.OwnerID ||
+			old.AttachmentID != new.AttachmentID ||
+			old.AttachmentInstanceID != new.AttachmentInstanceID ||
+			old.AttachmentStatus != new.AttachmentStatus ||
+			jsonChanged(old.GroupsJSON, new.GroupsJSON) ||
+			jsonChanged(old.PrivateIPAddressesJSON, new.PrivateIPAddressesJSON) ||
+			jsonChanged(old.Ipv6AddressesJSON, new.Ipv6AddressesJSON) ||
+			jsonChanged(old.TagsJSON, new.TagsJSON),
+	}
+}
+
+// HasAnyChange returns true if any part of the network interface changed.
+func (d *NetworkInterfaceDiff) HasAnyChange() bool {
+	return d.IsNew || d.IsChanged
+}
+
+// jsonChanged compares JSON values semantically, since jsonb does not
+// preserve the formatting or key order of what was written.
+func jsonChanged(old, new json.RawMessage) bool {
+	return !bytes.Equal(normalizeJSON(old), normalizeJSON(new))
+}
+
+func normalizeJSON(raw json.RawMessage) []byte {
+	if len(raw) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return raw
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
@@ -0,0 +1,108 @@
+package networkinterface
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2networkinterface"
+)
+
+// HistoryService handles history tracking for network interfaces.
+type HistoryService struct {
+	entClient *entec2.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entec2.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entec2.Tx, data *NetworkInterfaceData) *entec2.BronzeHistoryAWSEC2NetworkInterfaceCreate {
+	create := tx.BronzeHistoryAWSEC2NetworkInterface.Create().
+		SetResourceID(data.ResourceID).
+		SetCollectedAt(data.CollectedAt).
+		SetDescription(data.Description).
+		SetInterfaceType(data.InterfaceType).
+		SetStatus(data.Status).
+		SetVpcID(data.VpcID).
+		SetSubnetID(data.SubnetID).
+		SetAvailabilityZone(data.AvailabilityZone).
+		SetMACAddress(data.MACAddress).
+		SetPrivateIPAddress(data.PrivateIPAddress).
+		SetPrivateDNSName(data.PrivateDNSName).
+		SetPublicIP(data.PublicIP).
+		SetPublicDNSName(data.PublicDNSName).
+		SetSourceDestCheck(data.SourceDestCheck).
+		SetRequesterManaged(data.RequesterManaged).
+		SetRequesterID(data.RequesterID).
+		SetOwnerID(data.OwnerID).
+		SetAttachmentID(data.AttachmentID).
+		SetAttachmentInstanceID(data.AttachmentInstanceID).
+		SetAttachmentStatus(data.AttachmentStatus).
+		SetAccountID(data.AccountID).
+		SetRegion(data.Region)
+
+	if data.GroupsJSON != nil {
+		create.SetGroupsJSON(data.GroupsJSON)
+	}
+	if data.PrivateIPAddressesJSON != nil {
+		create.SetPrivateIPAddressesJSON(data.PrivateIPAddressesJSON)
+	}
+	if data.Ipv6AddressesJSON != nil {
+		create.SetIpv6AddressesJSON(data.Ipv6AddressesJSON)
+	}
+	if data.TagsJSON != nil {
+		create.SetTagsJSON(data.TagsJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new network interface.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entec2.Tx, data *NetworkInterfaceData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create network interface history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new history based on diff.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entec2.Tx, old *entec2.BronzeAWSEC2NetworkInterface, new *NetworkInterfaceData, diff *NetworkInterfaceDiff, now time.Time) error {
+	if !diff.IsChanged {
+		return nil
+	}
+
+	if err := h.CloseHistory(ctx, tx, old.ID, now); err != nil {
+		return err
+	}
+
+	_, err := h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new network interface history: %w", err)
+	}
+	return nil
+}
+
+// CloseHistory closes history records for a deleted network interface.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entec2.Tx, resourceID string, now time.Time) error {
+	_, err := tx.BronzeHistoryAWSEC2NetworkInterface.Update().
+		Where(
+			bronzehistoryawsec2networkinterface.ResourceID(resourceID),
+			bronzehistoryawsec2networkinterface.ValidToIsNil(),
+		).
+		SetValidTo(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close network interface history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package networkinterface
+
+import (
+	"go.temporal.io/sdk/worker"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+)
+
+// Register registers network interface activities and workflows with the Temporal worker.
+func Register(w worker.Worker, configService *config.Service, entClient *entec2.Client, limiter ratelimit.Limiter) {
+	activities := NewActivities(configService, entClient, limiter)
+
+	w.RegisterActivity(activities.IngestEC2NetworkInterfaces)
+
+	w.RegisterWorkflow(AWSEC2NetworkInterfaceWorkflow)
+}
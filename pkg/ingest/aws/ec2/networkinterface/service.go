@@ -0,0 +1,283 @@
+package networkinterface
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2networkinterface"
+)
+
+// Service handles AWS EC2 network interface ingestion.
+type Service struct {
+	client    *Client
+	entClient *entec2.Client
+	history   *HistoryService
+}
+
+// NewService creates a new network interface ingestion service.
+func NewService(client *Client, entClient *entec2.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestParams contains parameters for network interface ingestion.
+type IngestParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestResult contains the result of network interface ingestion.
+type IngestResult struct {
+	AccountID             string
+	Region                string
+	NetworkInterfaceCount int
+	CollectedAt           time.Time
+	DurationMillis        int64
+}
+
+// Ingest fetches network interfaces from AWS and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	// Fetch network interfaces from AWS
+	networkInterfaces, err := s.client.ListNetworkInterfaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list network interfaces: %w", err)
+	}
+
+	// Convert to data structs
+	dataList := make([]*NetworkInterfaceData, 0, len(networkInterfaces))
+	for _, v := range networkInterfaces {
+		data, err := ConvertNetworkInterface(v, params.AccountID, params.Region, collectedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert network interface: %w", err)
+		}
+		dataList = append(dataList, data)
+	}
+
+	// Save to database
+	if err := s.saveNetworkInterfaces(ctx, dataList); err != nil {
+		return nil, fmt.Errorf("failed to save network interfaces: %w", err)
+	}
+
+	return &IngestResult{
+		AccountID:             params.AccountID,
+		Region:                params.Region,
+		NetworkInterfaceCount: len(dataList),
+		CollectedAt:           collectedAt,
+		DurationMillis:        time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveNetworkInterfaces saves network interfaces to the database with history tracking.
+func (s *Service) saveNetworkInterfaces(ctx context.Context, networkInterfaces []*NetworkInterfaceData) error {
+	if len(networkInterfaces) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, networkInterfaceData := range networkInterfaces {
+		// Load existing network interface
+		existing, err := tx.BronzeAWSEC2NetworkInterface.Query().
+			Where(bronzeawsec2networkinterface.ID(networkInterfaceData.ResourceID)).
+			First(ctx)
+		if err != nil && !entec2.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("failed to load existing network interface %s: %w", networkInterfaceData.ResourceID, err)
+		}
+
+		// Compute diff
+		diff := DiffNetworkInterfaceData(existing, networkInterfaceData)
+
+		// Skip if no changes
+		if !diff.HasAnyChange() {
+			if err := tx.BronzeAWSEC2NetworkInterface.UpdateOneID(networkInterfaceData.ResourceID).
+				SetCollectedAt(networkInterfaceData.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update collected_at for network interface %s: %w", networkInterfaceData.ResourceID, err)
+			}
+			continue
+		}
+
+		// Create or update network interface
+		if existing == nil {
+			create := tx.BronzeAWSEC2NetworkInterface.Create().
+				SetID(networkInterfaceData.ResourceID).
+				SetDescription(networkInterfaceData.Description).
+				SetInterfaceType(networkInterfaceData.InterfaceType).
+				SetStatus(networkInterfaceData.Status).
+				SetVpcID(networkInterfaceData.VpcID).
+				SetSubnetID(networkInterfaceData.SubnetID).
+				SetAvailabilityZone(networkInterfaceData.AvailabilityZone).
+				SetMACAddress(networkInterfaceData.MACAddress).
+				SetPrivateIPAddress(networkInterfaceData.PrivateIPAddress).
+				SetPrivateDNSName(networkInterfaceData.PrivateDNSName).
+				SetPublicIP(networkInterfaceData.PublicIP).
+				SetPublicDNSName(networkInterfaceData.PublicDNSName).
+				SetSourceDestCheck(networkInterfaceData.SourceDestCheck).
+				SetRequesterManaged(networkInterfaceData.RequesterManaged).
+				SetRequesterID(networkInterfaceData.RequesterID).
+				SetOwnerID(networkInterfaceData.OwnerID).
+				SetAttachmentID(networkInterfaceData.AttachmentID).
+				SetAttachmentInstanceID(networkInterfaceData.AttachmentInstanceID).
+				SetAttachmentStatus(networkInterfaceData.AttachmentStatus).
+				SetAccountID(networkInterfaceData.AccountID).
+				SetRegion(networkInterfaceData.Region).
+				SetCollectedAt(networkInterfaceData.CollectedAt).
+				SetFirstCollectedAt(networkInterfaceData.CollectedAt)
+
+			if networkInterfaceData.GroupsJSON != nil {
+				create.SetGroupsJSON(networkInterfaceData.GroupsJSON)
+			}
+			if networkInterfaceData.PrivateIPAddressesJSON != nil {
+				create.SetPrivateIPAddressesJSON(networkInterfaceData.PrivateIPAddressesJSON)
+			}
+			if networkInterfaceData.Ipv6AddressesJSON != nil {
+				create.SetIpv6AddressesJSON(networkInterfaceData.Ipv6AddressesJSON)
+			}
+			if networkInterfaceData.TagsJSON != nil {
+				create.SetTagsJSON(networkInterfaceData.TagsJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create network interface %s: %w", networkInterfaceData.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeAWSEC2NetworkInterface.UpdateOneID(networkInterfaceData.ResourceID).
+				SetDescription(networkInterfaceData.Description).
+				SetInterfaceType(networkInterfaceData.InterfaceType).
+				SetStatus(networkInterfaceData.Status).
+				SetVpcID(networkInterfaceData.VpcID).
+				SetSubnetID(networkInterfaceData.SubnetID).
+				SetAvailabilityZone(networkInterfaceData.AvailabilityZone).
+				SetMACAddress(networkInterfaceData.MACAddress).
+				SetPrivateIPAddress(networkInterfaceData.PrivateIPAddress).
+				SetPrivateDNSName(networkInterfaceData.PrivateDNSName).
+				SetPublicIP(networkInterfaceData.PublicIP).
+				SetPublicDNSName(networkInterfaceData.PublicDNSName).
+				SetSourceDestCheck(networkInterfaceData.SourceDestCheck).
+				SetRequesterManaged(networkInterfaceData.RequesterManaged).
+				SetRequesterID(networkInterfaceData.RequesterID).
+				SetOwnerID(networkInterfaceData.OwnerID).
+				SetAttachmentID(networkInterfaceData.AttachmentID).
+				SetAttachmentInstanceID(networkInterfaceData.AttachmentInstanceID).
+				SetAttachmentStatus(networkInterfaceData.AttachmentStatus).
+				SetAccountID(networkInterfaceData.AccountID).
+				SetRegion(networkInterfaceData.Region).
+				SetCollectedAt(networkInterfaceData.CollectedAt)
+
+			if networkInterfaceData.GroupsJSON != nil {
+				update.SetGroupsJSON(networkInterfaceData.GroupsJSON)
+			} else {
+				update.ClearGroupsJSON()
+			}
+			if networkInterfaceData.PrivateIPAddressesJSON != nil {
+				update.SetPrivateIPAddressesJSON(networkInterfaceData.PrivateIPAddressesJSON)
+			} else {
+				update.ClearPrivateIPAddressesJSON()
+			}
+			if networkInterfaceData.Ipv6AddressesJSON != nil {
+				update.SetIpv6AddressesJSON(networkInterfaceData.Ipv6AddressesJSON)
+			} else {
+				update.ClearIpv6AddressesJSON()
+			}
+			if networkInterfaceData.TagsJSON != nil {
+				update.SetTagsJSON(networkInterfaceData.TagsJSON)
+			} else {
+				update.ClearTagsJSON()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update network interface %s: %w", networkInterfaceData.ResourceID, err)
+			}
+		}
+
+		// Track history
+		if diff.IsNew {
+			if err := s.history.CreateHistory(ctx, tx, networkInterfaceData, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create history for network interface %s: %w", networkInterfaceData.ResourceID, err)
+			}
+		} else {
+			if err := s.history.UpdateHistory(ctx, tx, existing, networkInterfaceData, diff, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update history for network interface %s: %w", networkInterfaceData.ResourceID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleNetworkInterfaces removes network interfaces that were not collected in the latest run.
+func (s *Service) DeleteStaleNetworkInterfaces(ctx context.Context, accountID, region string, collectedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	stale, err := tx.BronzeAWSEC2NetworkInterface.Query().
+		Where(
+			bronzeawsec2networkinterface.AccountID(accountID),
+			bronzeawsec2networkinterface.Region(region),
+			bronzeawsec2networkinterface.CollectedAtLT(collectedAt),
+		).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range stale {
+		if err := s.history.CloseHistory(ctx, tx, e.ID, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to close history for network interface %s: %w", e.ID, err)
+		}
+
+		if err := tx.BronzeAWSEC2NetworkInterface.DeleteOne(e).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete network interface %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package networkinterface
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AWSEC2NetworkInterfaceWorkflowParams contains parameters for the network interface workflow.
+type AWSEC2NetworkInterfaceWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSEC2NetworkInterfaceWorkflowResult contains the result of the network interface workflow.
+type AWSEC2NetworkInterfaceWorkflowResult struct {
+	Region                string
+	NetworkInterfaceCount int
+	DurationMillis        int64
+}
+
+// AWSEC2NetworkInterfaceWorkflow ingests AWS EC2 network interfaces for a single region.
+func AWSEC2NetworkInterfaceWorkflow(ctx workflow.Context, params AWSEC2NetworkInterfaceWorkflowParams) (*AWSEC2NetworkInterfaceWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSEC2NetworkInterfaceWorkflow", "region", params.Region)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestEC2NetworkInterfacesResult
+	err := workflow.ExecuteActivity(activityCtx, IngestEC2NetworkInterfacesActivity, IngestEC2NetworkInterfacesParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest network interfaces", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Completed AWSEC2NetworkInterfaceWorkflow",
+		"region", params.Region,
+		"networkInterfaceCount", result.NetworkInterfaceCount,
+	)
+
+	return &AWSEC2NetworkInterfaceWorkflowResult{
+		Region:                result.Region,
+		NetworkInterfaceCount: result.NetworkInterfaceCount,
+		DurationMillis:        result.DurationMillis,
+	}, nil
+}
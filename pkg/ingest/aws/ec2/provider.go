@@ -19,7 +19,14 @@ func init() {
 		Aggregate: func(result *aws.AWSInventoryWorkflowResult, rr *aws.RegionResult, child any) {
 			r := child.(*AWSEC2WorkflowResult)
 			rr.InstanceCount = r.InstanceCount
+			rr.VPCCount = r.VPCCount
+			rr.SecurityGroupCount = r.SecurityGroupCount
 			result.TotalInstances += r.InstanceCount
+			result.TotalVPCs += r.VPCCount
+			result.TotalSubnets += r.SubnetCount
+			result.TotalSecurityGroups += r.SecurityGroupCount
+			result.TotalNetworkInterfaces += r.NetworkInterfaceCount
+			result.TotalElasticIPs += r.AddressCount
 		},
 	})
 }
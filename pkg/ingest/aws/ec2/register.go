@@ -6,7 +6,17 @@ import (
 
 	"danny.vn/hotpot/pkg/base/config"
 	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/address"
 	"danny.vn/hotpot/pkg/ingest/aws/ec2/instance"
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/internetgateway"
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/natgateway"
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/networkacl"
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/networkinterface"
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/routetable"
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/securitygroup"
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/securitygrouprule"
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/subnet"
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/vpc"
 	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
 )
 
@@ -14,8 +24,18 @@ import (
 func Register(w worker.Worker, configService *config.Service, driver dialect.Driver, limiter ratelimit.Limiter) {
 	entClient := entec2.NewClient(entec2.Driver(driver), entec2.AlternateSchema(entec2.DefaultSchemaConfig()))
 
-	// Register instance sub-package
+	// Register EC2 sub-packages
 	instance.Register(w, configService, entClient, limiter)
+	vpc.Register(w, configService, entClient, limiter)
+	subnet.Register(w, configService, entClient, limiter)
+	securitygroup.Register(w, configService, entClient, limiter)
+	securitygrouprule.Register(w, configService, entClient, limiter)
+	networkacl.Register(w, configService, entClient, limiter)
+	routetable.Register(w, configService, entClient, limiter)
+	internetgateway.Register(w, configService, entClient, limiter)
+	natgateway.Register(w, configService, entClient, limiter)
+	networkinterface.Register(w, configService, entClient, limiter)
+	address.Register(w, configService, entClient, limiter)
 
 	// Register EC2 workflow
 	w.RegisterWorkflow(AWSEC2Workflow)
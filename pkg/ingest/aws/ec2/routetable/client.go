@@ -0,0 +1,39 @@
+package routetable
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// Client wraps the AWS EC2 API for route tables.
+type Client struct {
+	ec2Client *ec2.Client
+}
+
+// NewClient creates a new EC2 route table client from an AWS config.
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		ec2Client: ec2.NewFromConfig(cfg),
+	}
+}
+
+// ListRouteTables lists all route tables in the configured region using pagination.
+func (c *Client) ListRouteTables(ctx context.Context) ([]types.RouteTable, error) {
+	var routeTables []types.RouteTable
+
+	paginator := ec2.NewDescribeRouteTablesPaginator(c.ec2Client, &ec2.DescribeRouteTablesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describe route tables: %w", err)
+		}
+
+		routeTables = append(routeTables, output.RouteTables...)
+	}
+
+	return routeTables, nil
+}
@@ -0,0 +1,175 @@
+package routetable
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// RouteTableData holds converted route table data ready for Ent insertion.
+type RouteTableData struct {
+	ResourceID          string
+	Name                string
+	VpcID               string
+	OwnerID             string
+	RoutesJSON          json.RawMessage
+	AssociationsJSON    json.RawMessage
+	PropagatingVgwsJSON json.RawMessage
+	TagsJSON            json.RawMessage
+	AccountID           string
+	Region              string
+	CollectedAt         time.Time
+}
+
+// TagData is the JSON structure for resource tags.
+type TagData struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// RouteData is the JSON structure for routes. Only the target that applies is set.
+type RouteData struct {
+	DestinationCidrBlock        string `json:"destination_cidr_block,omitempty"`
+	DestinationIpv6CidrBlock    string `json:"destination_ipv6_cidr_block,omitempty"`
+	DestinationPrefixListID     string `json:"destination_prefix_list_id,omitempty"`
+	GatewayID                   string `json:"gateway_id,omitempty"`
+	NatGatewayID                string `json:"nat_gateway_id,omitempty"`
+	InstanceID                  string `json:"instance_id,omitempty"`
+	NetworkInterfaceID          string `json:"network_interface_id,omitempty"`
+	TransitGatewayID            string `json:"transit_gateway_id,omitempty"`
+	VpcPeeringConnectionID      string `json:"vpc_peering_connection_id,omitempty"`
+	EgressOnlyInternetGatewayID string `json:"egress_only_internet_gateway_id,omitempty"`
+	CarrierGatewayID            string `json:"carrier_gateway_id,omitempty"`
+	LocalGatewayID              string `json:"local_gateway_id,omitempty"`
+	CoreNetworkArn              string `json:"core_network_arn,omitempty"`
+	Origin                      string `json:"origin,omitempty"`
+	State                       string `json:"state,omitempty"`
+}
+
+// AssociationData is the JSON structure for route table associations.
+type AssociationData struct {
+	AssociationID string `json:"association_id"`
+	SubnetID      string `json:"subnet_id,omitempty"`
+	GatewayID     string `json:"gateway_id,omitempty"`
+	Main          bool   `json:"main"`
+	State         string `json:"state,omitempty"`
+}
+
+// ConvertRouteTable converts an AWS API RouteTable to RouteTableData.
+func ConvertRouteTable(v types.RouteTable, accountID, region string, collectedAt time.Time) (*RouteTableData, error) {
+	data := &RouteTableData{
+		ResourceID:  derefStr(v.RouteTableId),
+		VpcID:       derefStr(v.VpcId),
+		OwnerID:     derefStr(v.OwnerId),
+		AccountID:   accountID,
+		Region:      region,
+		CollectedAt: collectedAt,
+	}
+
+	// Convert tags to JSON
+	var err error
+	data.Name, data.TagsJSON, err = convertTags(v.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert routes to JSON
+	if len(v.Routes) > 0 {
+		routes := make([]RouteData, 0, len(v.Routes))
+		for _, r := range v.Routes {
+			routes = append(routes, RouteData{
+				DestinationCidrBlock:        derefStr(r.DestinationCidrBlock),
+				DestinationIpv6CidrBlock:    derefStr(r.DestinationIpv6CidrBlock),
+				DestinationPrefixListID:     derefStr(r.DestinationPrefixListId),
+				GatewayID:                   derefStr(r.GatewayId),
+				NatGatewayID:                derefStr(r.NatGatewayId),
+				InstanceID:                  derefStr(r.InstanceId),
+				NetworkInterfaceID:          derefStr(r.NetworkInterfaceId),
+				TransitGatewayID:            derefStr(r.TransitGatewayId),
+				VpcPeeringConnectionID:      derefStr(r.VpcPeeringConnectionId),
+				EgressOnlyInternetGatewayID: derefStr(r.EgressOnlyInternetGatewayId),
+				CarrierGatewayID:            derefStr(r.CarrierGatewayId),
+				LocalGatewayID:              derefStr(r.LocalGatewayId),
+				CoreNetworkArn:              derefStr(r.CoreNetworkArn),
+				Origin:                      string(r.Origin),
+				State:                       string(r.State),
+			})
+		}
+		if data.RoutesJSON, err = json.Marshal(routes); err != nil {
+			return nil, err
+		}
+	}
+
+	// Convert associations to JSON
+	if len(v.Associations) > 0 {
+		assocs := make([]AssociationData, 0, len(v.Associations))
+		for _, a := range v.Associations {
+			assoc := AssociationData{
+				AssociationID: derefStr(a.RouteTableAssociationId),
+				SubnetID:      derefStr(a.SubnetId),
+				GatewayID:     derefStr(a.GatewayId),
+				Main:          derefBool(a.Main),
+			}
+			if a.AssociationState != nil {
+				assoc.State = string(a.AssociationState.State)
+			}
+			assocs = append(assocs, assoc)
+		}
+		if data.AssociationsJSON, err = json.Marshal(assocs); err != nil {
+			return nil, err
+		}
+	}
+
+	// Convert propagating virtual private gateways to JSON
+	if len(v.PropagatingVgws) > 0 {
+		vgws := make([]string, 0, len(v.PropagatingVgws))
+		for _, p := range v.PropagatingVgws {
+			vgws = append(vgws, derefStr(p.GatewayId))
+		}
+		if data.PropagatingVgwsJSON, err = json.Marshal(vgws); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// convertTags converts AWS tags to JSON and returns the value of the Name tag.
+func convertTags(tags []types.Tag) (string, json.RawMessage, error) {
+	if len(tags) == 0 {
+		return "", nil, nil
+	}
+
+	var name string
+	result := make([]TagData, 0, len(tags))
+	for _, tag := range tags {
+		if derefStr(tag.Key) == "Name" {
+			name = derefStr(tag.Value)
+		}
+		result = append(result, TagData{
+			Key:   derefStr(tag.Key),
+			Value: derefStr(tag.Value),
+		})
+	}
+
+	tagsJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, tagsJSON, nil
+}
+
+func derefStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func derefBool(b *bool) bool {
+	if b == nil {
+		return false
+	}
+	return *b
+}
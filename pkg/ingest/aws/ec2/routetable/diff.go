@@ -0,0 +1,54 @@
+package routetable
+
+import (
+	"bytes"
+	"encoding/json"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+)
+
+// RouteTableDiff represents changes between old and new route table states.
+type RouteTableDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffRouteTableData compares old Ent entity and new data.
+func DiffRouteTableData(old *entec2.BronzeAWSEC2RouteTable, new *RouteTableData) *RouteTableDiff {
+	if old == nil {
+		return &RouteTableDiff{IsNew: true}
+	}
+
+	return &RouteTableDiff{
+		IsChanged: old.Name != new.Name ||
+			old.VpcID != new.VpcID ||
+			old.OwnerID != new.OwnerID ||
+			jsonChanged(old.RoutesJSON, new.RoutesJSON) ||
+			jsonChanged(old.AssociationsJSON, new.AssociationsJSON) ||
+			jsonChanged(old.PropagatingVgwsJSON, new.PropagatingVgwsJSON) ||
+			jsonChanged(old.TagsJSON, new.TagsJSON),
+	}
+}
+
+// HasAnyChange returns true if any part of the route table changed.
+func (d *RouteTableDiff) HasAnyChange() bool {
+	return d.IsNew || d.IsChanged
+}
+
+// jsonChanged compares JSON values semantically, since jsonb does not
+// preserve the formatting or key order of what was written.
+func jsonChanged(old, new json.RawMessage) bool {
+	return !bytes.Equal(normalizeJSON(old), normalizeJSON(new))
+}
+
+func normalizeJSON(raw json.RawMessage) []byte {
+	if len(raw) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return raw
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
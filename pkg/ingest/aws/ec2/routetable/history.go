@@ -0,0 +1,93 @@
+package routetable
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2routetable"
+)
+
+// HistoryService handles history tracking for route tables.
+type HistoryService struct {
+	entClient *entec2.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entec2.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entec2.Tx, data *RouteTableData) *entec2.BronzeHistoryAWSEC2RouteTableCreate {
+	create := tx.BronzeHistoryAWSEC2RouteTable.Create().
+		SetResourceID(data.ResourceID).
+		SetCollectedAt(data.CollectedAt).
+		SetName(data.Name).
+		SetVpcID(data.VpcID).
+		SetOwnerID(data.OwnerID).
+		SetAccountID(data.AccountID).
+		SetRegion(data.Region)
+
+	if data.RoutesJSON != nil {
+		create.SetRoutesJSON(data.RoutesJSON)
+	}
+	if data.AssociationsJSON != nil {
+		create.SetAssociationsJSON(data.AssociationsJSON)
+	}
+	if data.PropagatingVgwsJSON != nil {
+		create.SetPropagatingVgwsJSON(data.PropagatingVgwsJSON)
+	}
+	if data.TagsJSON != nil {
+		create.SetTagsJSON(data.TagsJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new route table.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entec2.Tx, data *RouteTableData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create route table history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new history based on diff.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entec2.Tx, old *entec2.BronzeAWSEC2RouteTable, new *RouteTableData, diff *RouteTableDiff, now time.Time) error {
+	if !diff.IsChanged {
+		return nil
+	}
+
+	if err := h.CloseHistory(ctx, tx, old.ID, now); err != nil {
+		return err
+	}
+
+	_, err := h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new route table history: %w", err)
+	}
+	return nil
+}
+
+// CloseHistory closes history records for a deleted route table.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entec2.Tx, resourceID string, now time.Time) error {
+	_, err := tx.BronzeHistoryAWSEC2RouteTable.Update().
+		Where(
+			bronzehistoryawsec2routetable.ResourceID(resourceID),
+			bronzehistoryawsec2routetable.ValidToIsNil(),
+		).
+		SetValidTo(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close route table history: %w", err)
+	}
+	return nil
+}
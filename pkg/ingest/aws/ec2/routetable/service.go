@@ -0,0 +1,253 @@
+package routetable
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2routetable"
+)
+
+// Service handles AWS EC2 route table ingestion.
+type Service struct {
+	client    *Client
+	entClient *entec2.Client
+	history   *HistoryService
+}
+
+// NewService creates a new route table ingestion service.
+func NewService(client *Client, entClient *entec2.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestParams contains parameters for route table ingestion.
+type IngestParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestResult contains the result of route table ingestion.
+type IngestResult struct {
+	AccountID       string
+	Region          string
+	RouteTableCount int
+	CollectedAt     time.Time
+	DurationMillis  int64
+}
+
+// Ingest fetches route tables from AWS and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	// Fetch route tables from AWS
+	routeTables, err := s.client.ListRouteTables(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list route tables: %w", err)
+	}
+
+	// Convert to data structs
+	dataList := make([]*RouteTableData, 0, len(routeTables))
+	for _, v := range routeTables {
+		data, err := ConvertRouteTable(v, params.AccountID, params.Region, collectedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert route table: %w", err)
+		}
+		dataList = append(dataList, data)
+	}
+
+	// Save to database
+	if err := s.saveRouteTables(ctx, dataList); err != nil {
+		return nil, fmt.Errorf("failed to save route tables: %w", err)
+	}
+
+	return &IngestResult{
+		AccountID:       params.AccountID,
+		Region:          params.Region,
+		RouteTableCount: len(dataList),
+		CollectedAt:     collectedAt,
+		DurationMillis:  time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveRouteTables saves route tables to the database with history tracking.
+func (s *Service) saveRouteTables(ctx context.Context, routeTables []*RouteTableData) error {
+	if len(routeTables) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, routeTableData := range routeTables {
+		// Load existing route table
+		existing, err := tx.BronzeAWSEC2RouteTable.Query().
+			Where(bronzeawsec2routetable.ID(routeTableData.ResourceID)).
+			First(ctx)
+		if err != nil && !entec2.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("failed to load existing route table %s: %w", routeTableData.ResourceID, err)
+		}
+
+		// Compute diff
+		diff := DiffRouteTableData(existing, routeTableData)
+
+		// Skip if no changes
+		if !diff.HasAnyChange() {
+			if err := tx.BronzeAWSEC2RouteTable.UpdateOneID(routeTableData.ResourceID).
+				SetCollectedAt(routeTableData.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update collected_at for route table %s: %w", routeTableData.ResourceID, err)
+			}
+			continue
+		}
+
+		// Create or update route table
+		if existing == nil {
+			create := tx.BronzeAWSEC2RouteTable.Create().
+				SetID(routeTableData.ResourceID).
+				SetName(routeTableData.Name).
+				SetVpcID(routeTableData.VpcID).
+				SetOwnerID(routeTableData.OwnerID).
+				SetAccountID(routeTableData.AccountID).
+				SetRegion(routeTableData.Region).
+				SetCollectedAt(routeTableData.CollectedAt).
+				SetFirstCollectedAt(routeTableData.CollectedAt)
+
+			if routeTableData.RoutesJSON != nil {
+				create.SetRoutesJSON(routeTableData.RoutesJSON)
+			}
+			if routeTableData.AssociationsJSON != nil {
+				create.SetAssociationsJSON(routeTableData.AssociationsJSON)
+			}
+			if routeTableData.PropagatingVgwsJSON != nil {
+				create.SetPropagatingVgwsJSON(routeTableData.PropagatingVgwsJSON)
+			}
+			if routeTableData.TagsJSON != nil {
+				create.SetTagsJSON(routeTableData.TagsJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create route table %s: %w", routeTableData.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeAWSEC2RouteTable.UpdateOneID(routeTableData.ResourceID).
+				SetName(routeTableData.Name).
+				SetVpcID(routeTableData.VpcID).
+				SetOwnerID(routeTableData.OwnerID).
+				SetAccountID(routeTableData.AccountID).
+				SetRegion(routeTableData.Region).
+				SetCollectedAt(routeTableData.CollectedAt)
+
+			if routeTableData.RoutesJSON != nil {
+				update.SetRoutesJSON(routeTableData.RoutesJSON)
+			} else {
+				update.ClearRoutesJSON()
+			}
+			if routeTableData.AssociationsJSON != nil {
+				update.SetAssociationsJSON(routeTableData.AssociationsJSON)
+			} else {
+				update.ClearAssociationsJSON()
+			}
+			if routeTableData.PropagatingVgwsJSON != nil {
+				update.SetPropagatingVgwsJSON(routeTableData.PropagatingVgwsJSON)
+			} else {
+				update.ClearPropagatingVgwsJSON()
+			}
+			if routeTableData.TagsJSON != nil {
+				update.SetTagsJSON(routeTableData.TagsJSON)
+			} else {
+				update.ClearTagsJSON()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update route table %s: %w", routeTableData.ResourceID, err)
+			}
+		}
+
+		// Track history
+		if diff.IsNew {
+			if err := s.history.CreateHistory(ctx, tx, routeTableData, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create history for route table %s: %w", routeTableData.ResourceID, err)
+			}
+		} else {
+			if err := s.history.UpdateHistory(ctx, tx, existing, routeTableData, diff, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update history for route table %s: %w", routeTableData.ResourceID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleRouteTables removes route tables that were not collected in the latest run.
+func (s *Service) DeleteStaleRouteTables(ctx context.Context, accountID, region string, collectedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	stale, err := tx.BronzeAWSEC2RouteTable.Query().
+		Where(
+			bronzeawsec2routetable.AccountID(accountID),
+			bronzeawsec2routetable.Region(region),
+			bronzeawsec2routetable.CollectedAtLT(collectedAt),
+		).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range stale {
+		if err := s.history.CloseHistory(ctx, tx, e.ID, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to close history for route table %s: %w", e.ID, err)
+		}
+
+		if err := tx.BronzeAWSEC2RouteTable.DeleteOne(e).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete route table %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
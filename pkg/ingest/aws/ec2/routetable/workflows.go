@@ -0,0 +1,59 @@
+package routetable
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AWSEC2RouteTableWorkflowParams contains parameters for the route table workflow.
+type AWSEC2RouteTableWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSEC2RouteTableWorkflowResult contains the result of the route table workflow.
+type AWSEC2RouteTableWorkflowResult struct {
+	Region          string
+	RouteTableCount int
+	DurationMillis  int64
+}
+
+// AWSEC2RouteTableWorkflow ingests AWS EC2 route tables for a single region.
+func AWSEC2RouteTableWorkflow(ctx workflow.Context, params AWSEC2RouteTableWorkflowParams) (*AWSEC2RouteTableWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSEC2RouteTableWorkflow", "region", params.Region)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestEC2RouteTablesResult
+	err := workflow.ExecuteActivity(activityCtx, IngestEC2RouteTablesActivity, IngestEC2RouteTablesParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest route tables", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Completed AWSEC2RouteTableWorkflow",
+		"region", params.Region,
+		"routeTableCount", result.RouteTableCount,
+	)
+
+	return &AWSEC2RouteTableWorkflowResult{
+		Region:          result.Region,
+		RouteTableCount: result.RouteTableCount,
+		DurationMillis:  result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package securitygroup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// Client wraps the AWS EC2 API for security groups.
+type Client struct {
+	ec2Client *ec2.Client
+}
+
+// NewClient creates a new EC2 security group client from an AWS config.
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		ec2Client: ec2.NewFromConfig(cfg),
+	}
+}
+
+// ListSecurityGroups lists all security groups in the configured region using pagination.
+func (c *Client) ListSecurityGroups(ctx context.Context) ([]types.SecurityGroup, error) {
+	var securityGroups []types.SecurityGroup
+
+	paginator := ec2.NewDescribeSecurityGroupsPaginator(c.ec2Client, &ec2.DescribeSecurityGroupsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describe security groups: %w", err)
+		}
+
+		securityGroups = append(securityGroups, output.SecurityGroups...)
+	}
+
+	return securityGroups, nil
+}
@@ -0,0 +1,84 @@
+package securitygroup
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// SecurityGroupData holds converted security group data ready for Ent insertion.
+type SecurityGroupData struct {
+	ResourceID       string
+	GroupName        string
+	Description      string
+	VpcID            string
+	OwnerID          string
+	SecurityGroupArn string
+	TagsJSON         json.RawMessage
+	AccountID        string
+	Region           string
+	CollectedAt      time.Time
+}
+
+// TagData is the JSON structure for resource tags.
+type TagData struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ConvertSecurityGroup converts an AWS API SecurityGroup to SecurityGroupData.
+func ConvertSecurityGroup(v types.SecurityGroup, accountID, region string, collectedAt time.Time) (*SecurityGroupData, error) {
+	data := &SecurityGroupData{
+		ResourceID:       derefStr(v.GroupId),
+		GroupName:        derefStr(v.GroupName),
+		Description:      derefStr(v.Description),
+		VpcID:            derefStr(v.VpcId),
+		OwnerID:          derefStr(v.OwnerId),
+		SecurityGroupArn: derefStr(v.SecurityGroupArn),
+		AccountID:        accountID,
+		Region:           region,
+		CollectedAt:      collectedAt,
+	}
+
+	// Convert tags to JSON
+	var err error
+	_, data.TagsJSON, err = convertTags(v.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// convertTags converts AWS tags to JSON and returns the value of the Name tag.
+func convertTags(tags []types.Tag) (string, json.RawMessage, error) {
+	if len(tags) == 0 {
+		return "", nil, nil
+	}
+
+	var name string
+	result := make([]TagData, 0, len(tags))
+	for _, tag := range tags {
+		if derefStr(tag.Key) == "Name" {
+			name = derefStr(tag.Value)
+		}
+		result = append(result, TagData{
+			Key:   derefStr(tag.Key),
+			Value: derefStr(tag.Value),
+		})
+	}
+
+	tagsJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, tagsJSON, nil
+}
+
+func derefStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
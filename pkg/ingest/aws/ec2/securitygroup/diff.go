@@ -0,0 +1,53 @@
+package securitygroup
+
+import (
+	"bytes"
+	"encoding/json"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+)
+
+// SecurityGroupDiff represents changes between old and new security group states.
+type SecurityGroupDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffSecurityGroupData compares old Ent entity and new data.
+func DiffSecurityGroupData(old *entec2.BronzeAWSEC2SecurityGroup, new *SecurityGroupData) *SecurityGroupDiff {
+	if old == nil {
+		return &SecurityGroupDiff{IsNew: true}
+	}
+
+	return &SecurityGroupDiff{
+		IsChanged: old.GroupName != new.GroupName ||
+			old.Description != new.Description ||
+			old.VpcID != new.VpcID ||
+			old.OwnerID != new.OwnerID ||
+			old.SecurityGroupArn != new.SecurityGroupArn ||
+			jsonChanged(old.TagsJSON, new.TagsJSON),
+	}
+}
+
+// HasAnyChange returns true if any part of the security group changed.
+func (d *SecurityGroupDiff) HasAnyChange() bool {
+	return d.IsNew || d.IsChanged
+}
+
+// jsonChanged compares JSON values semantically, since jsonb does not
+// preserve the formatting or key order of what was written.
+func jsonChanged(old, new json.RawMessage) bool {
+	return !bytes.Equal(normalizeJSON(old), normalizeJSON(new))
+}
+
+func normalizeJSON(raw json.RawMessage) []byte {
+	if len(raw) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return raw
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
@@ -0,0 +1,86 @@
+package securitygroup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2securitygroup"
+)
+
+// HistoryService handles history tracking for security groups.
+type HistoryService struct {
+	entClient *entec2.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entec2.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entec2.Tx, data *SecurityGroupData) *entec2.BronzeHistoryAWSEC2SecurityGroupCreate {
+	create := tx.BronzeHistoryAWSEC2SecurityGroup.Create().
+		SetResourceID(data.ResourceID).
+		SetCollectedAt(data.CollectedAt).
+		SetGroupName(data.GroupName).
+		SetDescription(data.Description).
+		SetVpcID(data.VpcID).
+		SetOwnerID(data.OwnerID).
+		SetSecurityGroupArn(data.SecurityGroupArn).
+		SetAccountID(data.AccountID).
+		SetRegion(data.Region)
+
+	if data.TagsJSON != nil {
+		create.SetTagsJSON(data.TagsJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new security group.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entec2.Tx, data *SecurityGroupData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create security group history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new history based on diff.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entec2.Tx, old *entec2.BronzeAWSEC2SecurityGroup, new *SecurityGroupData, diff *SecurityGroupDiff, now time.Time) error {
+	if !diff.IsChanged {
+		return nil
+	}
+
+	if err := h.CloseHistory(ctx, tx, old.ID, now); err != nil {
+		return err
+	}
+
+	_, err := h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new security group history: %w", err)
+	}
+	return nil
+}
+
+// CloseHistory closes history records for a deleted security group.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entec2.Tx, resourceID string, now time.Time) error {
+	_, err := tx.BronzeHistoryAWSEC2SecurityGroup.Update().
+		Where(
+			bronzehistoryawsec2securitygroup.ResourceID(resourceID),
+			bronzehistoryawsec2securitygroup.ValidToIsNil(),
+		).
+		SetValidTo(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close security group history: %w", err)
+	}
+	return nil
+}
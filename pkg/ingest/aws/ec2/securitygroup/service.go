@@ -0,0 +1,233 @@
+package securitygroup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2securitygroup"
+)
+
+// Service handles AWS EC2 security group ingestion.
+type Service struct {
+	client    *Client
+	entClient *entec2.Client
+	history   *HistoryService
+}
+
+// NewService creates a new security group ingestion service.
+func NewService(client *Client, entClient *entec2.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestParams contains parameters for security group ingestion.
+type IngestParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestResult contains the result of security group ingestion.
+type IngestResult struct {
+	AccountID          string
+	Region             string
+	SecurityGroupCount int
+	CollectedAt        time.Time
+	DurationMillis     int64
+}
+
+// Ingest fetches security groups from AWS and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	// Fetch security groups from AWS
+	securityGroups, err := s.client.ListSecurityGroups(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list security groups: %w", err)
+	}
+
+	// Convert to data structs
+	dataList := make([]*SecurityGroupData, 0, len(securityGroups))
+	for _, v := range securityGroups {
+		data, err := ConvertSecurityGroup(v, params.AccountID, params.Region, collectedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert security group: %w", err)
+		}
+		dataList = append(dataList, data)
+	}
+
+	// Save to database
+	if err := s.saveSecurityGroups(ctx, dataList); err != nil {
+		return nil, fmt.Errorf("failed to save security groups: %w", err)
+	}
+
+	return &IngestResult{
+		AccountID:          params.AccountID,
+		Region:             params.Region,
+		SecurityGroupCount: len(dataList),
+		CollectedAt:        collectedAt,
+		DurationMillis:     time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveSecurityGroups saves security groups to the database with history tracking.
+func (s *Service) saveSecurityGroups(ctx context.Context, securityGroups []*SecurityGroupData) error {
+	if len(securityGroups) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, securityGroupData := range securityGroups {
+		// Load existing security group
+		existing, err := tx.BronzeAWSEC2SecurityGroup.Query().
+			Where(bronzeawsec2securitygroup.ID(securityGroupData.ResourceID)).
+			First(ctx)
+		if err != nil && !entec2.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("failed to load existing security group %s: %w", securityGroupData.ResourceID, err)
+		}
+
+		// Compute diff
+		diff := DiffSecurityGroupData(existing, securityGroupData)
+
+		// Skip if no changes
+		if !diff.HasAnyChange() {
+			if err := tx.BronzeAWSEC2SecurityGroup.UpdateOneID(securityGroupData.ResourceID).
+				SetCollectedAt(securityGroupData.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update collected_at for security group %s: %w", securityGroupData.ResourceID, err)
+			}
+			continue
+		}
+
+		// Create or update security group
+		if existing == nil {
+			create := tx.BronzeAWSEC2SecurityGroup.Create().
+				SetID(securityGroupData.ResourceID).
+				SetGroupName(securityGroupData.GroupName).
+				SetDescription(securityGroupData.Description).
+				SetVpcID(securityGroupData.VpcID).
+				SetOwnerID(securityGroupData.OwnerID).
+				SetSecurityGroupArn(securityGroupData.SecurityGroupArn).
+				SetAccountID(securityGroupData.AccountID).
+				SetRegion(securityGroupData.Region).
+				SetCollectedAt(securityGroupData.CollectedAt).
+				SetFirstCollectedAt(securityGroupData.CollectedAt)
+
+			if securityGroupData.TagsJSON != nil {
+				create.SetTagsJSON(securityGroupData.TagsJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create security group %s: %w", securityGroupData.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeAWSEC2SecurityGroup.UpdateOneID(securityGroupData.ResourceID).
+				SetGroupName(securityGroupData.GroupName).
+				SetDescription(securityGroupData.Description).
+				SetVpcID(securityGroupData.VpcID).
+				SetOwnerID(securityGroupData.OwnerID).
+				SetSecurityGroupArn(securityGroupData.SecurityGroupArn).
+				SetAccountID(securityGroupData.AccountID).
+				SetRegion(securityGroupData.Region).
+				SetCollectedAt(securityGroupData.CollectedAt)
+
+			if securityGroupData.TagsJSON != nil {
+				update.SetTagsJSON(securityGroupData.TagsJSON)
+			} else {
+				update.ClearTagsJSON()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update security group %s: %w", securityGroupData.ResourceID, err)
+			}
+		}
+
+		// Track history
+		if diff.IsNew {
+			if err := s.history.CreateHistory(ctx, tx, securityGroupData, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create history for security group %s: %w", securityGroupData.ResourceID, err)
+			}
+		} else {
+			if err := s.history.UpdateHistory(ctx, tx, existing, securityGroupData, diff, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update history for security group %s: %w", securityGroupData.ResourceID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleSecurityGroups removes security groups that were not collected in the latest run.
+func (s *Service) DeleteStaleSecurityGroups(ctx context.Context, accountID, region string, collectedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	stale, err := tx.BronzeAWSEC2SecurityGroup.Query().
+		Where(
+			bronzeawsec2securitygroup.AccountID(accountID),
+			bronzeawsec2securitygroup.Region(region),
+			bronzeawsec2securitygroup.CollectedAtLT(collectedAt),
+		).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range stale {
+		if err := s.history.CloseHistory(ctx, tx, e.ID, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to close history for security group %s: %w", e.ID, err)
+		}
+
+		if err := tx.BronzeAWSEC2SecurityGroup.DeleteOne(e).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete security group %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
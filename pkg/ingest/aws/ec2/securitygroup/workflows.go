@@ -0,0 +1,59 @@
+package securitygroup
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AWSEC2SecurityGroupWorkflowParams contains parameters for the security group workflow.
+type AWSEC2SecurityGroupWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSEC2SecurityGroupWorkflowResult contains the result of the security group workflow.
+type AWSEC2SecurityGroupWorkflowResult struct {
+	Region             string
+	SecurityGroupCount int
+	DurationMillis     int64
+}
+
+// AWSEC2SecurityGroupWorkflow ingests AWS EC2 security groups for a single region.
+func AWSEC2SecurityGroupWorkflow(ctx workflow.Context, params AWSEC2SecurityGroupWorkflowParams) (*AWSEC2SecurityGroupWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSEC2SecurityGroupWorkflow", "region", params.Region)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestEC2SecurityGroupsResult
+	err := workflow.ExecuteActivity(activityCtx, IngestEC2SecurityGroupsActivity, IngestEC2SecurityGroupsParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest security groups", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Completed AWSEC2SecurityGroupWorkflow",
+		"region", params.Region,
+		"securityGroupCount", result.SecurityGroupCount,
+	)
+
+	return &AWSEC2SecurityGroupWorkflowResult{
+		Region:             result.Region,
+		SecurityGroupCount: result.SecurityGroupCount,
+		DurationMillis:     result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,97 @@
+package securitygrouprule
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/awsauth"
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entec2.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entec2.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// createClient creates a rate-limited AWS EC2 client for the given account and region.
+func (a *Activities) createClient(ctx context.Context, accountID, region string) (*Client, error) {
+	cfg, err := awsauth.LoadConfig(ctx, a.configService, a.limiter, accountID, region)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(cfg), nil
+}
+
+// IngestEC2SecurityGroupRulesParams contains parameters for the ingest activity.
+type IngestEC2SecurityGroupRulesParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestEC2SecurityGroupRulesResult contains the result of the ingest activity.
+type IngestEC2SecurityGroupRulesResult struct {
+	AccountID              string
+	Region                 string
+	SecurityGroupRuleCount int
+	DurationMillis         int64
+}
+
+// IngestEC2SecurityGroupRulesActivity is the activity function reference for workflow registration.
+var IngestEC2SecurityGroupRulesActivity = (*Activities).IngestEC2SecurityGroupRules
+
+// IngestEC2SecurityGroupRules is a Temporal activity that ingests AWS EC2 security group rules.
+func (a *Activities) IngestEC2SecurityGroupRules(ctx context.Context, params IngestEC2SecurityGroupRulesParams) (*IngestEC2SecurityGroupRulesResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting AWS EC2 security group rule ingestion",
+		"accountID", params.AccountID,
+		"region", params.Region,
+	)
+
+	client, err := a.createClient(ctx, params.AccountID, params.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create client: %w", err)
+	}
+
+	service := NewService(client, a.entClient)
+	result, err := service.Ingest(ctx, IngestParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to ingest security group rules: %w", err)
+	}
+
+	// Delete stale security group rules
+	if err := service.DeleteStaleSecurityGroupRules(ctx, params.AccountID, params.Region, result.CollectedAt); err != nil {
+		logger.Warn("Failed to delete stale security group rules", "error", err)
+	}
+
+	logger.Info("Completed AWS EC2 security group rule ingestion",
+		"accountID", params.AccountID,
+		"region", params.Region,
+		"securityGroupRuleCount", result.SecurityGroupRuleCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestEC2SecurityGroupRulesResult{
+		AccountID:              result.AccountID,
+		Region:                 result.Region,
+		SecurityGroupRuleCount: result.SecurityGroupRuleCount,
+		DurationMillis:         result.DurationMillis,
+	}, nil
+}
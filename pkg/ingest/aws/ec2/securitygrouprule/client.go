@@ -0,0 +1,39 @@
+package securitygrouprule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// Client wraps the AWS EC2 API for security group rules.
+type Client struct {
+	ec2Client *ec2.Client
+}
+
+// NewClient creates a new EC2 security group rule client from an AWS config.
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		ec2Client: ec2.NewFromConfig(cfg),
+	}
+}
+
+// ListSecurityGroupRules lists all security group rules in the configured region using pagination.
+func (c *Client) ListSecurityGroupRules(ctx context.Context) ([]types.SecurityGroupRule, error) {
+	var securityGroupRules []types.SecurityGroupRule
+
+	paginator := ec2.NewDescribeSecurityGroupRulesPaginator(c.ec2Client, &ec2.DescribeSecurityGroupRulesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describe security group rules: %w", err)
+		}
+
+		securityGroupRules = append(securityGroupRules, output.SecurityGroupRules...)
+	}
+
+	return securityGroupRules, nil
+}
@@ -0,0 +1,111 @@
+package securitygrouprule
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// SecurityGroupRuleData holds converted security group rule data ready for Ent insertion.
+type SecurityGroupRuleData struct {
+	ResourceID           string
+	GroupID              string
+	GroupOwnerID         string
+	IsEgress             bool
+	IPProtocol           string
+	FromPort             *int32
+	ToPort               *int32
+	CidrIpv4             string
+	CidrIpv6             string
+	PrefixListID         string
+	ReferencedGroupID    string
+	ReferencedUserID     string
+	Description          string
+	SecurityGroupRuleArn string
+	TagsJSON             json.RawMessage
+	AccountID            string
+	Region               string
+	CollectedAt          time.Time
+}
+
+// TagData is the JSON structure for resource tags.
+type TagData struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ConvertSecurityGroupRule converts an AWS API SecurityGroupRule to SecurityGroupRuleData.
+func ConvertSecurityGroupRule(v types.SecurityGroupRule, accountID, region string, collectedAt time.Time) (*SecurityGroupRuleData, error) {
+	data := &SecurityGroupRuleData{
+		ResourceID:           derefStr(v.SecurityGroupRuleId),
+		GroupID:              derefStr(v.GroupId),
+		GroupOwnerID:         derefStr(v.GroupOwnerId),
+		IsEgress:             derefBool(v.IsEgress),
+		IPProtocol:           derefStr(v.IpProtocol),
+		FromPort:             v.FromPort,
+		ToPort:               v.ToPort,
+		CidrIpv4:             derefStr(v.CidrIpv4),
+		CidrIpv6:             derefStr(v.CidrIpv6),
+		PrefixListID:         derefStr(v.PrefixListId),
+		Description:          derefStr(v.Description),
+		SecurityGroupRuleArn: derefStr(v.SecurityGroupRuleArn),
+		AccountID:            accountID,
+		Region:               region,
+		CollectedAt:          collectedAt,
+	}
+
+	// Convert tags to JSON
+	var err error
+	_, data.TagsJSON, err = convertTags(v.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	// Referenced security group
+	if v.ReferencedGroupInfo != nil {
+		data.ReferencedGroupID = derefStr(v.ReferencedGroupInfo.GroupId)
+		data.ReferencedUserID = derefStr(v.ReferencedGroupInfo.UserId)
+	}
+
+	return data, nil
+}
+
+// convertTags converts AWS tags to JSON and returns the value of the Name tag.
+func convertTags(tags []types.Tag) (string, json.RawMessage, error) {
+	if len(tags) == 0 {
+		return "", nil, nil
+	}
+
+	var name string
+	result := make([]TagData, 0, len(tags))
+	for _, tag := range tags {
+		if derefStr(tag.Key) == "Name" {
+			name = derefStr(tag.Value)
+		}
+		result = append(result, TagData{
+			Key:   derefStr(tag.Key),
+			Value: derefStr(tag.Value),
+		})
+	}
+
+	tagsJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, tagsJSON, nil
+}
+
+func derefStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func derefBool(b *bool) bool {
+	if b == nil {
+		return false
+	}
+	return *b
+}
@@ -0,0 +1,68 @@
+package securitygrouprule
+
+import (
+	"bytes"
+	"encoding/json"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+)
+
+// SecurityGroupRuleDiff represents changes between old and new security group rule states.
+type SecurityGroupRuleDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffSecurityGroupRuleData compares old Ent entity and new data.
+func DiffSecurityGroupRuleData(old *entec2.BronzeAWSEC2SecurityGroupRule, new *SecurityGroupRuleData) *SecurityGroupRuleDiff {
+	if old == nil {
+		return &SecurityGroupRuleDiff{IsNew: true}
+	}
+
+	return &SecurityGroupRuleDiff{
+		IsChanged: old.GroupID != new.GroupID ||
+			old.GroupOwnerID != new.GroupOwnerID ||
+			old.IsEgress != new.IsEgress ||
+			old.IPProtocol != new.IPProtocol ||
+			!int32Equal(old.FromPort, new.FromPort) ||
+			!int32Equal(old.ToPort, new.ToPort) ||
+			old.CidrIpv4 != new.CidrIpv4 ||
+			old.CidrIpv6 != new.CidrIpv6 ||
+			old.PrefixListID != new.PrefixListID ||
+			old.ReferencedGroupID != new.ReferencedGroupID ||
+			old.ReferencedUserID != new.ReferencedUserID ||
+			old.Description != new.Description ||
+			old.SecurityGroupRuleArn != new.SecurityGroupRuleArn ||
+			jsonChanged(old.TagsJSON, new.TagsJSON),
+	}
+}
+
+// HasAnyChange returns true if any part of the security group rule changed.
+func (d *SecurityGroupRuleDiff) HasAnyChange() bool {
+	return d.IsNew || d.IsChanged
+}
+
+func int32Equal(a, b *int32) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// jsonChanged compares JSON values semantically, since jsonb does not
+// preserve the formatting or key order of what was written.
+func jsonChanged(old, new json.RawMessage) bool {
+	return !bytes.Equal(normalizeJSON(old), normalizeJSON(new))
+}
+
+func normalizeJSON(raw json.RawMessage) []byte {
+	if len(raw) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return raw
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
@@ -0,0 +1,98 @@
+package securitygrouprule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2securitygrouprule"
+)
+
+// HistoryService handles history tracking for security group rules.
+type HistoryService struct {
+	entClient *entec2.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entec2.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entec2.Tx, data *SecurityGroupRuleData) *entec2.BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	create := tx.BronzeHistoryAWSEC2SecurityGroupRule.Create().
+		SetResourceID(data.ResourceID).
+		SetCollectedAt(data.CollectedAt).
+		SetGroupID(data.GroupID).
+		SetGroupOwnerID(data.GroupOwnerID).
+		SetIsEgress(data.IsEgress).
+		SetIPProtocol(data.IPProtocol).
+		SetCidrIpv4(data.CidrIpv4).
+		SetCidrIpv6(data.CidrIpv6).
+		SetPrefixListID(data.PrefixListID).
+		SetReferencedGroupID(data.ReferencedGroupID).
+		SetReferencedUserID(data.ReferencedUserID).
+		SetDescription(data.Description).
+		SetSecurityGroupRuleArn(data.SecurityGroupRuleArn).
+		SetAccountID(data.AccountID).
+		SetRegion(data.Region)
+
+	if data.FromPort != nil {
+		create.SetFromPort(*data.FromPort)
+	}
+	if data.ToPort != nil {
+		create.SetToPort(*data.ToPort)
+	}
+	if data.TagsJSON != nil {
+		create.SetTagsJSON(data.TagsJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new security group rule.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entec2.Tx, data *SecurityGroupRuleData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create security group rule history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new history based on diff.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entec2.Tx, old *entec2.BronzeAWSEC2SecurityGroupRule, new *SecurityGroupRuleData, diff *SecurityGroupRuleDiff, now time.Time) error {
+	if !diff.IsChanged {
+		return nil
+	}
+
+	if err := h.CloseHistory(ctx, tx, old.ID, now); err != nil {
+		return err
+	}
+
+	_, err := h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new security group rule history: %w", err)
+	}
+	return nil
+}
+
+// CloseHistory closes history records for a deleted security group rule.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entec2.Tx, resourceID string, now time.Time) error {
+	_, err := tx.BronzeHistoryAWSEC2SecurityGroupRule.Update().
+		Where(
+			bronzehistoryawsec2securitygrouprule.ResourceID(resourceID),
+			bronzehistoryawsec2securitygrouprule.ValidToIsNil(),
+		).
+		SetValidTo(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close security group rule history: %w", err)
+	}
+	return nil
+}
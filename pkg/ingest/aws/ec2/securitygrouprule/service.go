@@ -0,0 +1,261 @@
+package securitygrouprule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2securitygrouprule"
+)
+
+// Service handles AWS EC2 security group rule ingestion.
+type Service struct {
+	client    *Client
+	entClient *entec2.Client
+	history   *HistoryService
+}
+
+// NewService creates a new security group rule ingestion service.
+func NewService(client *Client, entClient *entec2.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestParams contains parameters for security group rule ingestion.
+type IngestParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestResult contains the result of security group rule ingestion.
+type IngestResult struct {
+	AccountID              string
+	Region                 string
+	SecurityGroupRuleCount int
+	CollectedAt            time.Time
+	DurationMillis         int64
+}
+
+// Ingest fetches security group rules from AWS and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	// Fetch security group rules from AWS
+	securityGroupRules, err := s.client.ListSecurityGroupRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list security group rules: %w", err)
+	}
+
+	// Convert to data structs
+	dataList := make([]*SecurityGroupRuleData, 0, len(securityGroupRules))
+	for _, v := range securityGroupRules {
+		data, err := ConvertSecurityGroupRule(v, params.AccountID, params.Region, collectedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert security group rule: %w", err)
+		}
+		dataList = append(dataList, data)
+	}
+
+	// Save to database
+	if err := s.saveSecurityGroupRules(ctx, dataList); err != nil {
+		return nil, fmt.Errorf("failed to save security group rules: %w", err)
+	}
+
+	return &IngestResult{
+		AccountID:              params.AccountID,
+		Region:                 params.Region,
+		SecurityGroupRuleCount: len(dataList),
+		CollectedAt:            collectedAt,
+		DurationMillis:         time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveSecurityGroupRules saves security group rules to the database with history tracking.
+func (s *Service) saveSecurityGroupRules(ctx context.Context, securityGroupRules []*SecurityGroupRuleData) error {
+	if len(securityGroupRules) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, securityGroupRuleData := range securityGroupRules {
+		// Load existing security group rule
+		existing, err := tx.BronzeAWSEC2SecurityGroupRule.Query().
+			Where(bronzeawsec2securitygrouprule.ID(securityGroupRuleData.ResourceID)).
+			First(ctx)
+		if err != nil && !entec2.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("failed to load existing security group rule %s: %w", securityGroupRuleData.ResourceID, err)
+		}
+
+		// Compute diff
+		diff := DiffSecurityGroupRuleData(existing, securityGroupRuleData)
+
+		// Skip if no changes
+		if !diff.HasAnyChange() {
+			if err := tx.BronzeAWSEC2SecurityGroupRule.UpdateOneID(securityGroupRuleData.ResourceID).
+				SetCollectedAt(securityGroupRuleData.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update collected_at for security group rule %s: %w", securityGroupRuleData.ResourceID, err)
+			}
+			continue
+		}
+
+		// Create or update security group rule
+		if existing == nil {
+			create := tx.BronzeAWSEC2SecurityGroupRule.Create().
+				SetID(securityGroupRuleData.ResourceID).
+				SetGroupID(securityGroupRuleData.GroupID).
+				SetGroupOwnerID(securityGroupRuleData.GroupOwnerID).
+				SetIsEgress(securityGroupRuleData.IsEgress).
+				SetIPProtocol(securityGroupRuleData.IPProtocol).
+				SetCidrIpv4(securityGroupRuleData.CidrIpv4).
+				SetCidrIpv6(securityGroupRuleData.CidrIpv6).
+				SetPrefixListID(securityGroupRuleData.PrefixListID).
+				SetReferencedGroupID(securityGroupRuleData.ReferencedGroupID).
+				SetReferencedUserID(securityGroupRuleData.ReferencedUserID).
+				SetDescription(securityGroupRuleData.Description).
+				SetSecurityGroupRuleArn(securityGroupRuleData.SecurityGroupRuleArn).
+				SetAccountID(securityGroupRuleData.AccountID).
+				SetRegion(securityGroupRuleData.Region).
+				SetCollectedAt(securityGroupRuleData.CollectedAt).
+				SetFirstCollectedAt(securityGroupRuleData.CollectedAt)
+
+			if securityGroupRuleData.FromPort != nil {
+				create.SetFromPort(*securityGroupRuleData.FromPort)
+			}
+			if securityGroupRuleData.ToPort != nil {
+				create.SetToPort(*securityGroupRuleData.ToPort)
+			}
+			if securityGroupRuleData.TagsJSON != nil {
+				create.SetTagsJSON(securityGroupRuleData.TagsJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create security group rule %s: %w", securityGroupRuleData.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeAWSEC2SecurityGroupRule.UpdateOneID(securityGroupRuleData.ResourceID).
+				SetGroupID(securityGroupRuleData.GroupID).
+				SetGroupOwnerID(securityGroupRuleData.GroupOwnerID).
+				SetIsEgress(securityGroupRuleData.IsEgress).
+				SetIPProtocol(securityGroupRuleData.IPProtocol).
+				SetCidrIpv4(securityGroupRuleData.CidrIpv4).
+				SetCidrIpv6(securityGroupRuleData.CidrIpv6).
+				SetPrefixListID(securityGroupRuleData.PrefixListID).
+				SetReferencedGroupID(securityGroupRuleData.ReferencedGroupID).
+				SetReferencedUserID(securityGroupRuleData.ReferencedUserID).
+				SetDescription(securityGroupRuleData.Description).
+				SetSecurityGroupRuleArn(securityGroupRuleData.SecurityGroupRuleArn).
+				SetAccountID(securityGroupRuleData.AccountID).
+				SetRegion(securityGroupRuleData.Region).
+				SetCollectedAt(securityGroupRuleData.CollectedAt)
+
+			if securityGroupRuleData.FromPort != nil {
+				update.SetFromPort(*securityGroupRuleData.FromPort)
+			} else {
+				update.ClearFromPort()
+			}
+			if securityGroupRuleData.ToPort != nil {
+				update.SetToPort(*securityGroupRuleData.ToPort)
+			} else {
+				update.ClearToPort()
+			}
+			if securityGroupRuleData.TagsJSON != nil {
+				update.SetTagsJSON(securityGroupRuleData.TagsJSON)
+			} else {
+				update.ClearTagsJSON()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update security group rule %s: %w", securityGroupRuleData.ResourceID, err)
+			}
+		}
+
+		// Track history
+		if diff.IsNew {
+			if err := s.history.CreateHistory(ctx, tx, securityGroupRuleData, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create history for security group rule %s: %w", securityGroupRuleData.ResourceID, err)
+			}
+		} else {
+			if err := s.history.UpdateHistory(ctx, tx, existing, securityGroupRuleData, diff, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update history for security group rule %s: %w", securityGroupRuleData.ResourceID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleSecurityGroupRules removes security group rules that were not collected in the latest run.
+func (s *Service) DeleteStaleSecurityGroupRules(ctx context.Context, accountID, region string, collectedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	stale, err := tx.BronzeAWSEC2SecurityGroupRule.Query().
+		Where(
+			bronzeawsec2securitygrouprule.AccountID(accountID),
+			bronzeawsec2securitygrouprule.Region(region),
+			bronzeawsec2securitygrouprule.CollectedAtLT(collectedAt),
+		).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range stale {
+		if err := s.history.CloseHistory(ctx, tx, e.ID, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to close history for security group rule %s: %w", e.ID, err)
+		}
+
+		if err := tx.BronzeAWSEC2SecurityGroupRule.DeleteOne(e).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete security group rule %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package securitygrouprule
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AWSEC2SecurityGroupRuleWorkflowParams contains parameters for the security group rule workflow.
+type AWSEC2SecurityGroupRuleWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSEC2SecurityGroupRuleWorkflowResult contains the result of the security group rule workflow.
+type AWSEC2SecurityGroupRuleWorkflowResult struct {
+	Region                 string
+	SecurityGroupRuleCount int
+	DurationMillis         int64
+}
+
+// AWSEC2SecurityGroupRuleWorkflow ingests AWS EC2 security group rules for a single region.
+func AWSEC2SecurityGroupRuleWorkflow(ctx workflow.Context, params AWSEC2SecurityGroupRuleWorkflowParams) (*AWSEC2SecurityGroupRuleWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSEC2SecurityGroupRuleWorkflow", "region", params.Region)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestEC2SecurityGroupRulesResult
+	err := workflow.ExecuteActivity(activityCtx, IngestEC2SecurityGroupRulesActivity, IngestEC2SecurityGroupRulesParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest security group rules", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Completed AWSEC2SecurityGroupRuleWorkflow",
+		"region", params.Region,
+		"securityGroupRuleCount", result.SecurityGroupRuleCount,
+	)
+
+	return &AWSEC2SecurityGroupRuleWorkflowResult{
+		Region:                 result.Region,
+		SecurityGroupRuleCount: result.SecurityGroupRuleCount,
+		DurationMillis:         result.DurationMillis,
+	}, nil
+}
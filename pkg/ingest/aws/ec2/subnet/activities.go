@@ -0,0 +1,97 @@
+package subnet
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/awsauth"
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entec2.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entec2.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// createClient creates a rate-limited AWS EC2 client for the given account and region.
+func (a *Activities) createClient(ctx context.Context, accountID, region string) (*Client, error) {
+	cfg, err := awsauth.LoadConfig(ctx, a.configService, a.limiter, accountID, region)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(cfg), nil
+}
+
+// IngestEC2SubnetsParams contains parameters for the ingest activity.
+type IngestEC2SubnetsParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestEC2SubnetsResult contains the result of the ingest activity.
+type IngestEC2SubnetsResult struct {
+	AccountID      string
+	Region         string
+	SubnetCount    int
+	DurationMillis int64
+}
+
+// IngestEC2SubnetsActivity is the activity function reference for workflow registration.
+var IngestEC2SubnetsActivity = (*Activities).IngestEC2Subnets
+
+// IngestEC2Subnets is a Temporal activity that ingests AWS EC2 subnets.
+func (a *Activities) IngestEC2Subnets(ctx context.Context, params IngestEC2SubnetsParams) (*IngestEC2SubnetsResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting AWS EC2 subnet ingestion",
+		"accountID", params.AccountID,
+		"region", params.Region,
+	)
+
+	client, err := a.createClient(ctx, params.AccountID, params.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create client: %w", err)
+	}
+
+	service := NewService(client, a.entClient)
+	result, err := service.Ingest(ctx, IngestParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to ingest subnets: %w", err)
+	}
+
+	// Delete stale subnets
+	if err := service.DeleteStaleSubnets(ctx, params.AccountID, params.Region, result.CollectedAt); err != nil {
+		logger.Warn("Failed to delete stale subnets", "error", err)
+	}
+
+	logger.Info("Completed AWS EC2 subnet ingestion",
+		"accountID", params.AccountID,
+		"region", params.Region,
+		"subnetCount", result.SubnetCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestEC2SubnetsResult{
+		AccountID:      result.AccountID,
+		Region:         result.Region,
+		SubnetCount:    result.SubnetCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
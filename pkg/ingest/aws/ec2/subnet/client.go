@@ -0,0 +1,39 @@
+package subnet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// Client wraps the AWS EC2 API for subnets.
+type Client struct {
+	ec2Client *ec2.Client
+}
+
+// NewClient creates a new EC2 subnet client from an AWS config.
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		ec2Client: ec2.NewFromConfig(cfg),
+	}
+}
+
+// ListSubnets lists all subnets in the configured region using pagination.
+func (c *Client) ListSubnets(ctx context.Context) ([]types.Subnet, error) {
+	var subnets []types.Subnet
+
+	paginator := ec2.NewDescribeSubnetsPaginator(c.ec2Client, &ec2.DescribeSubnetsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describe subnets: %w", err)
+		}
+
+		subnets = append(subnets, output.Subnets...)
+	}
+
+	return subnets, nil
+}
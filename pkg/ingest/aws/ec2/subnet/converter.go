@@ -0,0 +1,128 @@
+package subnet
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// SubnetData holds converted subnet data ready for Ent insertion.
+type SubnetData struct {
+	ResourceID                    string
+	Name                          string
+	VpcID                         string
+	CidrBlock                     string
+	AvailabilityZone              string
+	AvailabilityZoneID            string
+	State                         string
+	DefaultForAz                  bool
+	MapPublicIPOnLaunch           bool
+	AssignIpv6AddressOnCreation   bool
+	SubnetArn                     string
+	OwnerID                       string
+	Ipv6CidrBlockAssociationsJSON json.RawMessage
+	TagsJSON                      json.RawMessage
+	AccountID                     string
+	Region                        string
+	CollectedAt                   time.Time
+}
+
+// TagData is the JSON structure for resource tags.
+type TagData struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Ipv6CidrBlockAssociation is the JSON structure for subnet IPv6 CIDR block associations.
+type Ipv6CidrBlockAssociation struct {
+	AssociationID string `json:"association_id"`
+	Ipv6CidrBlock string `json:"ipv6_cidr_block"`
+	State         string `json:"state,omitempty"`
+}
+
+// ConvertSubnet converts an AWS API Subnet to SubnetData.
+func ConvertSubnet(v types.Subnet, accountID, region string, collectedAt time.Time) (*SubnetData, error) {
+	data := &SubnetData{
+		ResourceID:                  derefStr(v.SubnetId),
+		VpcID:                       derefStr(v.VpcId),
+		CidrBlock:                   derefStr(v.CidrBlock),
+		AvailabilityZone:            derefStr(v.AvailabilityZone),
+		AvailabilityZoneID:          derefStr(v.AvailabilityZoneId),
+		State:                       string(v.State),
+		DefaultForAz:                derefBool(v.DefaultForAz),
+		MapPublicIPOnLaunch:         derefBool(v.MapPublicIpOnLaunch),
+		AssignIpv6AddressOnCreation: derefBool(v.AssignIpv6AddressOnCreation),
+		SubnetArn:                   derefStr(v.SubnetArn),
+		OwnerID:                     derefStr(v.OwnerId),
+		AccountID:                   accountID,
+		Region:                      region,
+		CollectedAt:                 collectedAt,
+	}
+
+	// Convert tags to JSON
+	var err error
+	data.Name, data.TagsJSON, err = convertTags(v.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert IPv6 CIDR block associations to JSON
+	if len(v.Ipv6CidrBlockAssociationSet) > 0 {
+		assocs := make([]Ipv6CidrBlockAssociation, 0, len(v.Ipv6CidrBlockAssociationSet))
+		for _, a := range v.Ipv6CidrBlockAssociationSet {
+			assoc := Ipv6CidrBlockAssociation{
+				AssociationID: derefStr(a.AssociationId),
+				Ipv6CidrBlock: derefStr(a.Ipv6CidrBlock),
+			}
+			if a.Ipv6CidrBlockState != nil {
+				assoc.State = string(a.Ipv6CidrBlockState.State)
+			}
+			assocs = append(assocs, assoc)
+		}
+		if data.Ipv6CidrBlockAssociationsJSON, err = json.Marshal(assocs); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// convertTags converts AWS tags to JSON and returns the value of the Name tag.
+func convertTags(tags []types.Tag) (string, json.RawMessage, error) {
+	if len(tags) == 0 {
+		return "", nil, nil
+	}
+
+	var name string
+	result := make([]TagData, 0, len(tags))
+	for _, tag := range tags {
+		if derefStr(tag.Key) == "Name" {
+			name = derefStr(tag.Value)
+		}
+		result = append(result, TagData{
+			Key:   derefStr(tag.Key),
+			Value: derefStr(tag.Value),
+		})
+	}
+
+	tagsJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, tagsJSON, nil
+}
+
+func derefStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func derefBool(b *bool) bool {
+	if b == nil {
+		return false
+	}
+	return *b
+}
@@ -0,0 +1,60 @@
+package subnet
+
+import (
+	"bytes"
+	"encoding/json"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+)
+
+// SubnetDiff represents changes between old and new subnet states.
+type SubnetDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffSubnetData compares old Ent entity and new data.
+func DiffSubnetData(old *entec2.BronzeAWSEC2Subnet, new *SubnetData) *SubnetDiff {
+	if old == nil {
+		return &SubnetDiff{IsNew: true}
+	}
+
+	return &SubnetDiff{
+		IsChanged: old.Name != new.Name ||
+			old.VpcID != new.VpcID ||
+			old.CidrBlock != new.CidrBlock ||
+			old.AvailabilityZone != new.AvailabilityZone ||
+			old.AvailabilityZoneID != new.AvailabilityZoneID ||
+			old.State != new.State ||
+			old.DefaultForAz != new.DefaultForAz ||
+			old.MapPublicIPOnLaunch != new.MapPublicIPOnLaunch ||
+			old.AssignIpv6AddressOnCreation != new.AssignIpv6AddressOnCreation ||
+			old.SubnetArn != new.SubnetArn ||
+			old.OwnerID != new.OwnerID ||
+			jsonChanged(old.Ipv6CidrBlockAssociationsJSON, new.Ipv6CidrBlockAssociationsJSON) ||
+			jsonChanged(old.TagsJSON, new.TagsJSON),
+	}
+}
+
+// HasAnyChange returns true if any part of the subnet changed.
+func (d *SubnetDiff) HasAnyChange() bool {
+	return d.IsNew || d.IsChanged
+}
+
+// jsonChanged compares JSON values semantically, since jsonb does not
+// preserve the formatting or key order of what was written.
+func jsonChanged(old, new json.RawMessage) bool {
+	return !bytes.Equal(normalizeJSON(old), normalizeJSON(new))
+}
+
+func normalizeJSON(raw json.RawMessage) []byte {
+	if len(raw) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return raw
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
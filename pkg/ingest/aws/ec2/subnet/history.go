@@ -0,0 +1,95 @@
+package subnet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2subnet"
+)
+
+// HistoryService handles history tracking for subnets.
+type HistoryService struct {
+	entClient *entec2.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entec2.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entec2.Tx, data *SubnetData) *entec2.BronzeHistoryAWSEC2SubnetCreate {
+	create := tx.BronzeHistoryAWSEC2Subnet.Create().
+		SetResourceID(data.ResourceID).
+		SetCollectedAt(data.CollectedAt).
+		SetName(data.Name).
+		SetVpcID(data.VpcID).
+		SetCidrBlock(data.CidrBlock).
+		SetAvailabilityZone(data.AvailabilityZone).
+		SetAvailabilityZoneID(data.AvailabilityZoneID).
+		SetState(data.State).
+		SetDefaultForAz(data.DefaultForAz).
+		SetMapPublicIPOnLaunch(data.MapPublicIPOnLaunch).
+		SetAssignIpv6AddressOnCreation(data.AssignIpv6AddressOnCreation).
+		SetSubnetArn(data.SubnetArn).
+		SetOwnerID(data.OwnerID).
+		SetAccountID(data.AccountID).
+		SetRegion(data.Region)
+
+	if data.Ipv6CidrBlockAssociationsJSON != nil {
+		create.SetIpv6CidrBlockAssociationsJSON(data.Ipv6CidrBlockAssociationsJSON)
+	}
+	if data.TagsJSON != nil {
+		create.SetTagsJSON(data.TagsJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new subnet.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entec2.Tx, data *SubnetData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create subnet history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new history based on diff.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entec2.Tx, old *entec2.BronzeAWSEC2Subnet, new *SubnetData, diff *SubnetDiff, now time.Time) error {
+	if !diff.IsChanged {
+		return nil
+	}
+
+	if err := h.CloseHistory(ctx, tx, old.ID, now); err != nil {
+		return err
+	}
+
+	_, err := h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new subnet history: %w", err)
+	}
+	return nil
+}
+
+// CloseHistory closes history records for a deleted subnet.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entec2.Tx, resourceID string, now time.Time) error {
+	_, err := tx.BronzeHistoryAWSEC2Subnet.Update().
+		Where(
+			bronzehistoryawsec2subnet.ResourceID(resourceID),
+			bronzehistoryawsec2subnet.ValidToIsNil(),
+		).
+		SetValidTo(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close subnet history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,253 @@
+package subnet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2subnet"
+)
+
+// Service handles AWS EC2 subnet ingestion.
+type Service struct {
+	client    *Client
+	entClient *entec2.Client
+	history   *HistoryService
+}
+
+// NewService creates a new subnet ingestion service.
+func NewService(client *Client, entClient *entec2.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestParams contains parameters for subnet ingestion.
+type IngestParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestResult contains the result of subnet ingestion.
+type IngestResult struct {
+	AccountID      string
+	Region         string
+	SubnetCount    int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches subnets from AWS and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	// Fetch subnets from AWS
+	subnets, err := s.client.ListSubnets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subnets: %w", err)
+	}
+
+	// Convert to data structs
+	dataList := make([]*SubnetData, 0, len(subnets))
+	for _, v := range subnets {
+		data, err := ConvertSubnet(v, params.AccountID, params.Region, collectedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert subnet: %w", err)
+		}
+		dataList = append(dataList, data)
+	}
+
+	// Save to database
+	if err := s.saveSubnets(ctx, dataList); err != nil {
+		return nil, fmt.Errorf("failed to save subnets: %w", err)
+	}
+
+	return &IngestResult{
+		AccountID:      params.AccountID,
+		Region:         params.Region,
+		SubnetCount:    len(dataList),
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveSubnets saves subnets to the database with history tracking.
+func (s *Service) saveSubnets(ctx context.Context, subnets []*SubnetData) error {
+	if len(subnets) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, subnetData := range subnets {
+		// Load existing subnet
+		existing, err := tx.BronzeAWSEC2Subnet.Query().
+			Where(bronzeawsec2subnet.ID(subnetData.ResourceID)).
+			First(ctx)
+		if err != nil && !entec2.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("failed to load existing subnet %s: %w", subnetData.ResourceID, err)
+		}
+
+		// Compute diff
+		diff := DiffSubnetData(existing, subnetData)
+
+		// Skip if no changes
+		if !diff.HasAnyChange() {
+			if err := tx.BronzeAWSEC2Subnet.UpdateOneID(subnetData.ResourceID).
+				SetCollectedAt(subnetData.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update collected_at for subnet %s: %w", subnetData.ResourceID, err)
+			}
+			continue
+		}
+
+		// Create or update subnet
+		if existing == nil {
+			create := tx.BronzeAWSEC2Subnet.Create().
+				SetID(subnetData.ResourceID).
+				SetName(subnetData.Name).
+				SetVpcID(subnetData.VpcID).
+				SetCidrBlock(subnetData.CidrBlock).
+				SetAvailabilityZone(subnetData.AvailabilityZone).
+				SetAvailabilityZoneID(subnetData.AvailabilityZoneID).
+				SetState(subnetData.State).
+				SetDefaultForAz(subnetData.DefaultForAz).
+				SetMapPublicIPOnLaunch(subnetData.MapPublicIPOnLaunch).
+				SetAssignIpv6AddressOnCreation(subnetData.AssignIpv6AddressOnCreation).
+				SetSubnetArn(subnetData.SubnetArn).
+				SetOwnerID(subnetData.OwnerID).
+				SetAccountID(subnetData.AccountID).
+				SetRegion(subnetData.Region).
+				SetCollectedAt(subnetData.CollectedAt).
+				SetFirstCollectedAt(subnetData.CollectedAt)
+
+			if subnetData.Ipv6CidrBlockAssociationsJSON != nil {
+				create.SetIpv6CidrBlockAssociationsJSON(subnetData.Ipv6CidrBlockAssociationsJSON)
+			}
+			if subnetData.TagsJSON != nil {
+				create.SetTagsJSON(subnetData.TagsJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create subnet %s: %w", subnetData.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeAWSEC2Subnet.UpdateOneID(subnetData.ResourceID).
+				SetName(subnetData.Name).
+				SetVpcID(subnetData.VpcID).
+				SetCidrBlock(subnetData.CidrBlock).
+				SetAvailabilityZone(subnetData.AvailabilityZone).
+				SetAvailabilityZoneID(subnetData.AvailabilityZoneID).
+				SetState(subnetData.State).
+				SetDefaultForAz(subnetData.DefaultForAz).
+				SetMapPublicIPOnLaunch(subnetData.MapPublicIPOnLaunch).
+				SetAssignIpv6AddressOnCreation(subnetData.AssignIpv6AddressOnCreation).
+				SetSubnetArn(subnetData.SubnetArn).
+				SetOwnerID(subnetData.OwnerID).
+				SetAccountID(subnetData.AccountID).
+				SetRegion(subnetData.Region).
+				SetCollectedAt(subnetData.CollectedAt)
+
+			if subnetData.Ipv6CidrBlockAssociationsJSON != nil {
+				update.SetIpv6CidrBlockAssociationsJSON(subnetData.Ipv6CidrBlockAssociationsJSON)
+			} else {
+				update.ClearIpv6CidrBlockAssociationsJSON()
+			}
+			if subnetData.TagsJSON != nil {
+				update.SetTagsJSON(subnetData.TagsJSON)
+			} else {
+				update.ClearTagsJSON()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update subnet %s: %w", subnetData.ResourceID, err)
+			}
+		}
+
+		// Track history
+		if diff.IsNew {
+			if err := s.history.CreateHistory(ctx, tx, subnetData, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create history for subnet %s: %w", subnetData.ResourceID, err)
+			}
+		} else {
+			if err := s.history.UpdateHistory(ctx, tx, existing, subnetData, diff, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update history for subnet %s: %w", subnetData.ResourceID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleSubnets removes subnets that were not collected in the latest run.
+func (s *Service) DeleteStaleSubnets(ctx context.Context, accountID, region string, collectedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	stale, err := tx.BronzeAWSEC2Subnet.Query().
+		Where(
+			bronzeawsec2subnet.AccountID(accountID),
+			bronzeawsec2subnet.Region(region),
+			bronzeawsec2subnet.CollectedAtLT(collectedAt),
+		).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range stale {
+		if err := s.history.CloseHistory(ctx, tx, e.ID, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to close history for subnet %s: %w", e.ID, err)
+		}
+
+		if err := tx.BronzeAWSEC2Subnet.DeleteOne(e).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete subnet %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package subnet
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AWSEC2SubnetWorkflowParams contains parameters for the subnet workflow.
+type AWSEC2SubnetWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSEC2SubnetWorkflowResult contains the result of the subnet workflow.
+type AWSEC2SubnetWorkflowResult struct {
+	Region         string
+	SubnetCount    int
+	DurationMillis int64
+}
+
+// AWSEC2SubnetWorkflow ingests AWS EC2 subnets for a single region.
+func AWSEC2SubnetWorkflow(ctx workflow.Context, params AWSEC2SubnetWorkflowParams) (*AWSEC2SubnetWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSEC2SubnetWorkflow", "region", params.Region)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestEC2SubnetsResult
+	err := workflow.ExecuteActivity(activityCtx, IngestEC2SubnetsActivity, IngestEC2SubnetsParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest subnets", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Completed AWSEC2SubnetWorkflow",
+		"region", params.Region,
+		"subnetCount", result.SubnetCount,
+	)
+
+	return &AWSEC2SubnetWorkflowResult{
+		Region:         result.Region,
+		SubnetCount:    result.SubnetCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// Client wraps the AWS EC2 API for VPCs.
+type Client struct {
+	ec2Client *ec2.Client
+}
+
+// NewClient creates a new EC2 VPC client from an AWS config.
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		ec2Client: ec2.NewFromConfig(cfg),
+	}
+}
+
+// ListVPCs lists all VPCs in the configured region using pagination.
+func (c *Client) ListVPCs(ctx context.Context) ([]types.Vpc, error) {
+	var vpcs []types.Vpc
+
+	paginator := ec2.NewDescribeVpcsPaginator(c.ec2Client, &ec2.DescribeVpcsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describe vpcs: %w", err)
+		}
+
+		vpcs = append(vpcs, output.Vpcs...)
+	}
+
+	return vpcs, nil
+}
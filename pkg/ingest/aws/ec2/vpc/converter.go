@@ -0,0 +1,148 @@
+package vpc
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// VPCData holds converted VPC data ready for Ent insertion.
+type VPCData struct {
+	ResourceID                    string
+	Name                          string
+	CidrBlock                     string
+	State                         string
+	IsDefault                     bool
+	InstanceTenancy               string
+	DhcpOptionsID                 string
+	OwnerID                       string
+	CidrBlockAssociationsJSON     json.RawMessage
+	Ipv6CidrBlockAssociationsJSON json.RawMessage
+	TagsJSON                      json.RawMessage
+	AccountID                     string
+	Region                        string
+	CollectedAt                   time.Time
+}
+
+// TagData is the JSON structure for resource tags.
+type TagData struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// CidrBlockAssociation is the JSON structure for VPC IPv4 CIDR block associations.
+type CidrBlockAssociation struct {
+	AssociationID string `json:"association_id"`
+	CidrBlock     string `json:"cidr_block"`
+	State         string `json:"state,omitempty"`
+}
+
+// Ipv6CidrBlockAssociation is the JSON structure for VPC IPv6 CIDR block associations.
+type Ipv6CidrBlockAssociation struct {
+	AssociationID      string `json:"association_id"`
+	Ipv6CidrBlock      string `json:"ipv6_cidr_block"`
+	State              string `json:"state,omitempty"`
+	NetworkBorderGroup string `json:"network_border_group,omitempty"`
+}
+
+// ConvertVPC converts an AWS API Vpc to VPCData.
+func ConvertVPC(v types.Vpc, accountID, region string, collectedAt time.Time) (*VPCData, error) {
+	data := &VPCData{
+		ResourceID:      derefStr(v.VpcId),
+		CidrBlock:       derefStr(v.CidrBlock),
+		State:           string(v.State),
+		IsDefault:       derefBool(v.IsDefault),
+		InstanceTenancy: string(v.InstanceTenancy),
+		DhcpOptionsID:   derefStr(v.DhcpOptionsId),
+		OwnerID:         derefStr(v.OwnerId),
+		AccountID:       accountID,
+		Region:          region,
+		CollectedAt:     collectedAt,
+	}
+
+	// Convert tags to JSON
+	var err error
+	data.Name, data.TagsJSON, err = convertTags(v.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert CIDR block associations to JSON
+	if len(v.CidrBlockAssociationSet) > 0 {
+		assocs := make([]CidrBlockAssociation, 0, len(v.CidrBlockAssociationSet))
+		for _, a := range v.CidrBlockAssociationSet {
+			assoc := CidrBlockAssociation{
+				AssociationID: derefStr(a.AssociationId),
+				CidrBlock:     derefStr(a.CidrBlock),
+			}
+			if a.CidrBlockState != nil {
+				assoc.State = string(a.CidrBlockState.State)
+			}
+			assocs = append(assocs, assoc)
+		}
+		if data.CidrBlockAssociationsJSON, err = json.Marshal(assocs); err != nil {
+			return nil, err
+		}
+	}
+
+	// Convert IPv6 CIDR block associations to JSON
+	if len(v.Ipv6CidrBlockAssociationSet) > 0 {
+		assocs := make([]Ipv6CidrBlockAssociation, 0, len(v.Ipv6CidrBlockAssociationSet))
+		for _, a := range v.Ipv6CidrBlockAssociationSet {
+			assoc := Ipv6CidrBlockAssociation{
+				AssociationID:      derefStr(a.AssociationId),
+				Ipv6CidrBlock:      derefStr(a.Ipv6CidrBlock),
+				NetworkBorderGroup: derefStr(a.NetworkBorderGroup),
+			}
+			if a.Ipv6CidrBlockState != nil {
+				assoc.State = string(a.Ipv6CidrBlockState.State)
+			}
+			assocs = append(assocs, assoc)
+		}
+		if data.Ipv6CidrBlockAssociationsJSON, err = json.Marshal(assocs); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// convertTags converts AWS tags to JSON and returns the value of the Name tag.
+func convertTags(tags []types.Tag) (string, json.RawMessage, error) {
+	if len(tags) == 0 {
+		return "", nil, nil
+	}
+
+	var name string
+	result := make([]TagData, 0, len(tags))
+	for _, tag := range tags {
+		if derefStr(tag.Key) == "Name" {
+			name = derefStr(tag.Value)
+		}
+		result = append(result, TagData{
+			Key:   derefStr(tag.Key),
+			Value: derefStr(tag.Value),
+		})
+	}
+
+	tagsJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, tagsJSON, nil
+}
+
+func derefStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func derefBool(b *bool) bool {
+	if b == nil {
+		return false
+	}
+	return *b
+}
@@ -0,0 +1,57 @@
+package vpc
+
+import (
+	"bytes"
+	"encoding/json"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+)
+
+// VPCDiff represents changes between old and new VPC states.
+type VPCDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffVPCData compares old Ent entity and new data.
+func DiffVPCData(old *entec2.BronzeAWSEC2VPC, new *VPCData) *VPCDiff {
+	if old == nil {
+		return &VPCDiff{IsNew: true}
+	}
+
+	return &VPCDiff{
+		IsChanged: old.Name != new.Name ||
+			old.CidrBlock != new.CidrBlock ||
+			old.State != new.State ||
+			old.IsDefault != new.IsDefault ||
+			old.InstanceTenancy != new.InstanceTenancy ||
+			old.DhcpOptionsID != new.DhcpOptionsID ||
+			old.OwnerID != new.OwnerID ||
+			jsonChanged(old.CidrBlockAssociationsJSON, new.CidrBlockAssociationsJSON) ||
+			jsonChanged(old.Ipv6CidrBlockAssociationsJSON, new.Ipv6CidrBlockAssociationsJSON) ||
+			jsonChanged(old.TagsJSON, new.TagsJSON),
+	}
+}
+
+// HasAnyChange returns true if any part of the VPC changed.
+func (d *VPCDiff) HasAnyChange() bool {
+	return d.IsNew || d.IsChanged
+}
+
+// jsonChanged compares JSON values semantically, since jsonb does not
+// preserve the formatting or key order of what was written.
+func jsonChanged(old, new json.RawMessage) bool {
+	return !bytes.Equal(normalizeJSON(old), normalizeJSON(new))
+}
+
+func normalizeJSON(raw json.RawMessage) []byte {
+	if len(raw) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return raw
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
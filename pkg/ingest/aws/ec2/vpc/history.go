@@ -0,0 +1,94 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2vpc"
+)
+
+// HistoryService handles history tracking for VPCs.
+type HistoryService struct {
+	entClient *entec2.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entec2.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entec2.Tx, data *VPCData) *entec2.BronzeHistoryAWSEC2VPCCreate {
+	create := tx.BronzeHistoryAWSEC2VPC.Create().
+		SetResourceID(data.ResourceID).
+		SetCollectedAt(data.CollectedAt).
+		SetName(data.Name).
+		SetCidrBlock(data.CidrBlock).
+		SetState(data.State).
+		SetIsDefault(data.IsDefault).
+		SetInstanceTenancy(data.InstanceTenancy).
+		SetDhcpOptionsID(data.DhcpOptionsID).
+		SetOwnerID(data.OwnerID).
+		SetAccountID(data.AccountID).
+		SetRegion(data.Region)
+
+	if data.CidrBlockAssociationsJSON != nil {
+		create.SetCidrBlockAssociationsJSON(data.CidrBlockAssociationsJSON)
+	}
+	if data.Ipv6CidrBlockAssociationsJSON != nil {
+		create.SetIpv6CidrBlockAssociationsJSON(data.Ipv6CidrBlockAssociationsJSON)
+	}
+	if data.TagsJSON != nil {
+		create.SetTagsJSON(data.TagsJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new VPC.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entec2.Tx, data *VPCData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create VPC history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new history based on diff.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entec2.Tx, old *entec2.BronzeAWSEC2VPC, new *VPCData, diff *VPCDiff, now time.Time) error {
+	if !diff.IsChanged {
+		return nil
+	}
+
+	if err := h.CloseHistory(ctx, tx, old.ID, now); err != nil {
+		return err
+	}
+
+	_, err := h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new VPC history: %w", err)
+	}
+	return nil
+}
+
+// CloseHistory closes history records for a deleted VPC.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entec2.Tx, resourceID string, now time.Time) error {
+	_, err := tx.BronzeHistoryAWSEC2VPC.Update().
+		Where(
+			bronzehistoryawsec2vpc.ResourceID(resourceID),
+			bronzehistoryawsec2vpc.ValidToIsNil(),
+		).
+		SetValidTo(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close VPC history: %w", err)
+	}
+	return nil
+}
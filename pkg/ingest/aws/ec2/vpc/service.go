@@ -0,0 +1,253 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entec2 "danny.vn/hotpot/pkg/storage/ent/aws/ec2"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2vpc"
+)
+
+// Service handles AWS EC2 VPC ingestion.
+type Service struct {
+	client    *Client
+	entClient *entec2.Client
+	history   *HistoryService
+}
+
+// NewService creates a new VPC ingestion service.
+func NewService(client *Client, entClient *entec2.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestParams contains parameters for VPC ingestion.
+type IngestParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestResult contains the result of VPC ingestion.
+type IngestResult struct {
+	AccountID      string
+	Region         string
+	VPCCount       int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches VPCs from AWS and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	// Fetch VPCs from AWS
+	vpcs, err := s.client.ListVPCs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list VPCs: %w", err)
+	}
+
+	// Convert to data structs
+	dataList := make([]*VPCData, 0, len(vpcs))
+	for _, v := range vpcs {
+		data, err := ConvertVPC(v, params.AccountID, params.Region, collectedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert VPC: %w", err)
+		}
+		dataList = append(dataList, data)
+	}
+
+	// Save to database
+	if err := s.saveVPCs(ctx, dataList); err != nil {
+		return nil, fmt.Errorf("failed to save VPCs: %w", err)
+	}
+
+	return &IngestResult{
+		AccountID:      params.AccountID,
+		Region:         params.Region,
+		VPCCount:       len(dataList),
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveVPCs saves VPCs to the database with history tracking.
+func (s *Service) saveVPCs(ctx context.Context, vpcs []*VPCData) error {
+	if len(vpcs) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, vpcData := range vpcs {
+		// Load existing VPC
+		existing, err := tx.BronzeAWSEC2VPC.Query().
+			Where(bronzeawsec2vpc.ID(vpcData.ResourceID)).
+			First(ctx)
+		if err != nil && !entec2.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("failed to load existing VPC %s: %w", vpcData.ResourceID, err)
+		}
+
+		// Compute diff
+		diff := DiffVPCData(existing, vpcData)
+
+		// Skip if no changes
+		if !diff.HasAnyChange() {
+			if err := tx.BronzeAWSEC2VPC.UpdateOneID(vpcData.ResourceID).
+				SetCollectedAt(vpcData.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update collected_at for VPC %s: %w", vpcData.ResourceID, err)
+			}
+			continue
+		}
+
+		// Create or update VPC
+		if existing == nil {
+			create := tx.BronzeAWSEC2VPC.Create().
+				SetID(vpcData.ResourceID).
+				SetName(vpcData.Name).
+				SetCidrBlock(vpcData.CidrBlock).
+				SetState(vpcData.State).
+				SetIsDefault(vpcData.IsDefault).
+				SetInstanceTenancy(vpcData.InstanceTenancy).
+				SetDhcpOptionsID(vpcData.DhcpOptionsID).
+				SetOwnerID(vpcData.OwnerID).
+				SetAccountID(vpcData.AccountID).
+				SetRegion(vpcData.Region).
+				SetCollectedAt(vpcData.CollectedAt).
+				SetFirstCollectedAt(vpcData.CollectedAt)
+
+			if vpcData.CidrBlockAssociationsJSON != nil {
+				create.SetCidrBlockAssociationsJSON(vpcData.CidrBlockAssociationsJSON)
+			}
+			if vpcData.Ipv6CidrBlockAssociationsJSON != nil {
+				create.SetIpv6CidrBlockAssociationsJSON(vpcData.Ipv6CidrBlockAssociationsJSON)
+			}
+			if vpcData.TagsJSON != nil {
+				create.SetTagsJSON(vpcData.TagsJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create VPC %s: %w", vpcData.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeAWSEC2VPC.UpdateOneID(vpcData.ResourceID).
+				SetName(vpcData.Name).
+				SetCidrBlock(vpcData.CidrBlock).
+				SetState(vpcData.State).
+				SetIsDefault(vpcData.IsDefault).
+				SetInstanceTenancy(vpcData.InstanceTenancy).
+				SetDhcpOptionsID(vpcData.DhcpOptionsID).
+				SetOwnerID(vpcData.OwnerID).
+				SetAccountID(vpcData.AccountID).
+				SetRegion(vpcData.Region).
+				SetCollectedAt(vpcData.CollectedAt)
+
+			if vpcData.CidrBlockAssociationsJSON != nil {
+				update.SetCidrBlockAssociationsJSON(vpcData.CidrBlockAssociationsJSON)
+			} else {
+				update.ClearCidrBlockAssociationsJSON()
+			}
+			if vpcData.Ipv6CidrBlockAssociationsJSON != nil {
+				update.SetIpv6CidrBlockAssociationsJSON(vpcData.Ipv6CidrBlockAssociationsJSON)
+			} else {
+				update.ClearIpv6CidrBlockAssociationsJSON()
+			}
+			if vpcData.TagsJSON != nil {
+				update.SetTagsJSON(vpcData.TagsJSON)
+			} else {
+				update.ClearTagsJSON()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update VPC %s: %w", vpcData.ResourceID, err)
+			}
+		}
+
+		// Track history
+		if diff.IsNew {
+			if err := s.history.CreateHistory(ctx, tx, vpcData, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create history for VPC %s: %w", vpcData.ResourceID, err)
+			}
+		} else {
+			if err := s.history.UpdateHistory(ctx, tx, existing, vpcData, diff, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update history for VPC %s: %w", vpcData.ResourceID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleVPCs removes VPCs that were not collected in the latest run.
+func (s *Service) DeleteStaleVPCs(ctx context.Context, accountID, region string, collectedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	stale, err := tx.BronzeAWSEC2VPC.Query().
+		Where(
+			bronzeawsec2vpc.AccountID(accountID),
+			bronzeawsec2vpc.Region(region),
+			bronzeawsec2vpc.CollectedAtLT(collectedAt),
+		).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range stale {
+		if err := s.history.CloseHistory(ctx, tx, e.ID, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to close history for VPC %s: %w", e.ID, err)
+		}
+
+		if err := tx.BronzeAWSEC2VPC.DeleteOne(e).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete VPC %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
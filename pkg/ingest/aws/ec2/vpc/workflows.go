@@ -0,0 +1,59 @@
+package vpc
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AWSEC2VPCWorkflowParams contains parameters for the VPC workflow.
+type AWSEC2VPCWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSEC2VPCWorkflowResult contains the result of the VPC workflow.
+type AWSEC2VPCWorkflowResult struct {
+	Region         string
+	VPCCount       int
+	DurationMillis int64
+}
+
+// AWSEC2VPCWorkflow ingests AWS EC2 VPCs for a single region.
+func AWSEC2VPCWorkflow(ctx workflow.Context, params AWSEC2VPCWorkflowParams) (*AWSEC2VPCWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSEC2VPCWorkflow", "region", params.Region)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestEC2VPCsResult
+	err := workflow.ExecuteActivity(activityCtx, IngestEC2VPCsActivity, IngestEC2VPCsParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest VPCs", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Completed AWSEC2VPCWorkflow",
+		"region", params.Region,
+		"vpcCount", result.VPCCount,
+	)
+
+	return &AWSEC2VPCWorkflowResult{
+		Region:         result.Region,
+		VPCCount:       result.VPCCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -6,7 +6,17 @@ import (
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/address"
 	"danny.vn/hotpot/pkg/ingest/aws/ec2/instance"
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/internetgateway"
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/natgateway"
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/networkacl"
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/networkinterface"
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/routetable"
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/securitygroup"
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/securitygrouprule"
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/subnet"
+	"danny.vn/hotpot/pkg/ingest/aws/ec2/vpc"
 )
 
 // AWSEC2WorkflowParams contains parameters for the EC2 workflow.
@@ -17,11 +27,21 @@ type AWSEC2WorkflowParams struct {
 
 // AWSEC2WorkflowResult contains the result of the EC2 workflow.
 type AWSEC2WorkflowResult struct {
-	Region        string
-	InstanceCount int
+	Region                 string
+	InstanceCount          int
+	VPCCount               int
+	SubnetCount            int
+	SecurityGroupCount     int
+	SecurityGroupRuleCount int
+	NetworkACLCount        int
+	RouteTableCount        int
+	InternetGatewayCount   int
+	NATGatewayCount        int
+	NetworkInterfaceCount  int
+	AddressCount           int
 }
 
-// AWSEC2Workflow ingests all EC2 resources for a single region.
+// AWSEC2Workflow ingests all EC2 resources, including VPC networking, for a single region.
 func AWSEC2Workflow(ctx workflow.Context, params AWSEC2WorkflowParams) (*AWSEC2WorkflowResult, error) {
 	logger := workflow.GetLogger(ctx)
 	logger.Info("Starting AWSEC2Workflow", "region", params.Region)
@@ -46,17 +66,145 @@ func AWSEC2Workflow(ctx workflow.Context, params AWSEC2WorkflowParams) (*AWSEC2W
 		AccountID: params.AccountID,
 		Region:    params.Region,
 	}).Get(ctx, &instanceResult)
-
 	if err != nil {
 		logger.Error("Failed to execute AWSEC2InstanceWorkflow", "region", params.Region, "error", err)
 		return nil, err
 	}
-
 	result.InstanceCount = instanceResult.InstanceCount
 
+	// Execute EC2 VPC workflow
+	var vpcResult vpc.AWSEC2VPCWorkflowResult
+	err = workflow.ExecuteChildWorkflow(ctx, vpc.AWSEC2VPCWorkflow, vpc.AWSEC2VPCWorkflowParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &vpcResult)
+	if err != nil {
+		logger.Error("Failed to execute AWSEC2VPCWorkflow", "region", params.Region, "error", err)
+		return nil, err
+	}
+	result.VPCCount = vpcResult.VPCCount
+
+	// Execute EC2 Subnet workflow
+	var subnetResult subnet.AWSEC2SubnetWorkflowResult
+	err = workflow.ExecuteChildWorkflow(ctx, subnet.AWSEC2SubnetWorkflow, subnet.AWSEC2SubnetWorkflowParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &subnetResult)
+	if err != nil {
+		logger.Error("Failed to execute AWSEC2SubnetWorkflow", "region", params.Region, "error", err)
+		return nil, err
+	}
+	result.SubnetCount = subnetResult.SubnetCount
+
+	// Execute EC2 SecurityGroup workflow
+	var securitygroupResult securitygroup.AWSEC2SecurityGroupWorkflowResult
+	err = workflow.ExecuteChildWorkflow(ctx, securitygroup.AWSEC2SecurityGroupWorkflow, securitygroup.AWSEC2SecurityGroupWorkflowParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &securitygroupResult)
+	if err != nil {
+		logger.Error("Failed to execute AWSEC2SecurityGroupWorkflow", "region", params.Region, "error", err)
+		return nil, err
+	}
+	result.SecurityGroupCount = securitygroupResult.SecurityGroupCount
+
+	// Execute EC2 SecurityGroupRule workflow
+	var securitygroupruleResult securitygrouprule.AWSEC2SecurityGroupRuleWorkflowResult
+	err = workflow.ExecuteChildWorkflow(ctx, securitygrouprule.AWSEC2SecurityGroupRuleWorkflow, securitygrouprule.AWSEC2SecurityGroupRuleWorkflowParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &securitygroupruleResult)
+	if err != nil {
+		logger.Error("Failed to execute AWSEC2SecurityGroupRuleWorkflow", "region", params.Region, "error", err)
+		return nil, err
+	}
+	result.SecurityGroupRuleCount = securitygroupruleResult.SecurityGroupRuleCount
+
+	// Execute EC2 NetworkACL workflow
+	var networkaclResult networkacl.AWSEC2NetworkACLWorkflowResult
+	err = workflow.ExecuteChildWorkflow(ctx, networkacl.AWSEC2NetworkACLWorkflow, networkacl.AWSEC2NetworkACLWorkflowParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &networkaclResult)
+	if err != nil {
+		logger.Error("Failed to execute AWSEC2NetworkACLWorkflow", "region", params.Region, "error", err)
+		return nil, err
+	}
+	result.NetworkACLCount = networkaclResult.NetworkACLCount
+
+	// Execute EC2 RouteTable workflow
+	var routetableResult routetable.AWSEC2RouteTableWorkflowResult
+	err = workflow.ExecuteChildWorkflow(ctx, routetable.AWSEC2RouteTableWorkflow, routetable.AWSEC2RouteTableWorkflowParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &routetableResult)
+	if err != nil {
+		logger.Error("Failed to execute AWSEC2RouteTableWorkflow", "region", params.Region, "error", err)
+		return nil, err
+	}
+	result.RouteTableCount = routetableResult.RouteTableCount
+
+	// Execute EC2 InternetGateway workflow
+	var internetgatewayResult internetgateway.AWSEC2InternetGatewayWorkflowResult
+	err = workflow.ExecuteChildWorkflow(ctx, internetgateway.AWSEC2InternetGatewayWorkflow, internetgateway.AWSEC2InternetGatewayWorkflowParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &internetgatewayResult)
+	if err != nil {
+		logger.Error("Failed to execute AWSEC2InternetGatewayWorkflow", "region", params.Region, "error", err)
+		return nil, err
+	}
+	result.InternetGatewayCount = internetgatewayResult.InternetGatewayCount
+
+	// Execute EC2 NATGateway workflow
+	var natgatewayResult natgateway.AWSEC2NATGatewayWorkflowResult
+	err = workflow.ExecuteChildWorkflow(ctx, natgateway.AWSEC2NATGatewayWorkflow, natgateway.AWSEC2NATGatewayWorkflowParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &natgatewayResult)
+	if err != nil {
+		logger.Error("Failed to execute AWSEC2NATGatewayWorkflow", "region", params.Region, "error", err)
+		return nil, err
+	}
+	result.NATGatewayCount = natgatewayResult.NATGatewayCount
+
+	// Execute EC2 NetworkInterface workflow
+	var networkinterfaceResult networkinterface.AWSEC2NetworkInterfaceWorkflowResult
+	err = workflow.ExecuteChildWorkflow(ctx, networkinterface.AWSEC2NetworkInterfaceWorkflow, networkinterface.AWSEC2NetworkInterfaceWorkflowParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &networkinterfaceResult)
+	if err != nil {
+		logger.Error("Failed to execute AWSEC2NetworkInterfaceWorkflow", "region", params.Region, "error", err)
+		return nil, err
+	}
+	result.NetworkInterfaceCount = networkinterfaceResult.NetworkInterfaceCount
+
+	// Execute EC2 Address workflow
+	var addressResult address.AWSEC2AddressWorkflowResult
+	err = workflow.ExecuteChildWorkflow(ctx, address.AWSEC2AddressWorkflow, address.AWSEC2AddressWorkflowParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &addressResult)
+	if err != nil {
+		logger.Error("Failed to execute AWSEC2AddressWorkflow", "region", params.Region, "error", err)
+		return nil, err
+	}
+	result.AddressCount = addressResult.AddressCount
+
 	logger.Info("Completed AWSEC2Workflow",
 		"region", params.Region,
 		"instanceCount", result.InstanceCount,
+		"vpcCount", result.VPCCount,
+		"subnetCount", result.SubnetCount,
+		"securityGroupCount", result.SecurityGroupCount,
+		"securityGroupRuleCount", result.SecurityGroupRuleCount,
+		"networkACLCount", result.NetworkACLCount,
+		"routeTableCount", result.RouteTableCount,
+		"internetGatewayCount", result.InternetGatewayCount,
+		"natGatewayCount", result.NATGatewayCount,
+		"networkInterfaceCount", result.NetworkInterfaceCount,
+		"addressCount", result.AddressCount,
 	)
 
 	return result, nil
@@ -0,0 +1,90 @@
+package accesskey
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/awsauth"
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entiam.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entiam.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// createClient creates a rate-limited AWS IAM client for the given account and region.
+func (a *Activities) createClient(ctx context.Context, accountID, region string) (*Client, error) {
+	cfg, err := awsauth.LoadConfig(ctx, a.configService, a.limiter, accountID, region)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(cfg), nil
+}
+
+// IngestIAMAccessKeysParams contains parameters for the ingest activity.
+type IngestIAMAccessKeysParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestIAMAccessKeysResult contains the result of the ingest activity.
+type IngestIAMAccessKeysResult struct {
+	AccountID      string
+	AccessKeyCount int
+	DurationMillis int64
+}
+
+// IngestIAMAccessKeysActivity is the activity function reference for workflow registration.
+var IngestIAMAccessKeysActivity = (*Activities).IngestIAMAccessKeys
+
+// IngestIAMAccessKeys is a Temporal activity that ingests AWS IAM access keys.
+func (a *Activities) IngestIAMAccessKeys(ctx context.Context, params IngestIAMAccessKeysParams) (*IngestIAMAccessKeysResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting AWS IAM access key ingestion", "accountID", params.AccountID)
+
+	client, err := a.createClient(ctx, params.AccountID, params.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create client: %w", err)
+	}
+
+	service := NewService(client, a.entClient)
+	result, err := service.Ingest(ctx, IngestParams{
+		AccountID: params.AccountID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to ingest access keys: %w", err)
+	}
+
+	// Delete stale access keys
+	if err := service.DeleteStaleAccessKeys(ctx, params.AccountID, result.CollectedAt); err != nil {
+		logger.Warn("Failed to delete stale access keys", "error", err)
+	}
+
+	logger.Info("Completed AWS IAM access key ingestion",
+		"accountID", params.AccountID,
+		"accessKeyCount", result.AccessKeyCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestIAMAccessKeysResult{
+		AccountID:      result.AccountID,
+		AccessKeyCount: result.AccessKeyCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package accesskey
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// Client wraps the AWS IAM API for access keys.
+type Client struct {
+	iamClient *iam.Client
+}
+
+// NewClient creates a new IAM access key client from an AWS config.
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		iamClient: iam.NewFromConfig(cfg),
+	}
+}
+
+// KeyWithLastUsed pairs access key metadata with its last-used information.
+type KeyWithLastUsed struct {
+	Key      types.AccessKeyMetadata
+	LastUsed *types.AccessKeyLastUsed
+}
+
+// ListAccessKeys lists the access keys of every IAM user, with last-used data.
+func (c *Client) ListAccessKeys(ctx context.Context) ([]KeyWithLastUsed, error) {
+	var userNames []string
+
+	usersPaginator := iam.NewListUsersPaginator(c.iamClient, &iam.ListUsersInput{})
+	for usersPaginator.HasMorePages() {
+		output, err := usersPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list users: %w", err)
+		}
+		for _, u := range output.Users {
+			userNames = append(userNames, aws.ToString(u.UserName))
+		}
+	}
+
+	var keys []KeyWithLastUsed
+	for _, userName := range userNames {
+		keysPaginator := iam.NewListAccessKeysPaginator(c.iamClient, &iam.ListAccessKeysInput{
+			UserName: aws.String(userName),
+		})
+		for keysPaginator.HasMorePages() {
+			output, err := keysPaginator.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("list access keys for %s: %w", userName, err)
+			}
+
+			for _, key := range output.AccessKeyMetadata {
+				lastUsed, err := c.iamClient.GetAccessKeyLastUsed(ctx, &iam.GetAccessKeyLastUsedInput{
+					AccessKeyId: key.AccessKeyId,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("get access key last used for %s: %w", aws.ToString(key.AccessKeyId), err)
+				}
+				keys = append(keys, KeyWithLastUsed{Key: key, LastUsed: lastUsed.AccessKeyLastUsed})
+			}
+		}
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,52 @@
+package accesskey
+
+import (
+	"time"
+)
+
+// notApplicable is returned by GetAccessKeyLastUsed for keys that were never used.
+const notApplicable = "N/A"
+
+// AccessKeyData holds converted access key data ready for Ent insertion.
+type AccessKeyData struct {
+	ResourceID      string
+	UserName        string
+	Status          string
+	CreateDate      *time.Time
+	LastUsedDate    *time.Time
+	LastUsedService string
+	LastUsedRegion  string
+	AccountID       string
+	CollectedAt     time.Time
+}
+
+// ConvertAccessKey converts AWS API access key metadata and last-used data to AccessKeyData.
+func ConvertAccessKey(k KeyWithLastUsed, accountID string, collectedAt time.Time) *AccessKeyData {
+	data := &AccessKeyData{
+		ResourceID:  derefStr(k.Key.AccessKeyId),
+		UserName:    derefStr(k.Key.UserName),
+		Status:      string(k.Key.Status),
+		CreateDate:  k.Key.CreateDate,
+		AccountID:   accountID,
+		CollectedAt: collectedAt,
+	}
+
+	if k.LastUsed != nil {
+		data.LastUsedDate = k.LastUsed.LastUsedDate
+		if s := derefStr(k.LastUsed.ServiceName); s != notApplicable {
+			data.LastUsedService = s
+		}
+		if r := derefStr(k.LastUsed.Region); r != notApplicable {
+			data.LastUsedRegion = r
+		}
+	}
+
+	return data
+}
+
+func derefStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
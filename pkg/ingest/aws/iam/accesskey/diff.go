@@ -0,0 +1,41 @@
+package accesskey
+
+import (
+	"time"
+
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+)
+
+// AccessKeyDiff represents changes between old and new access key states.
+type AccessKeyDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffAccessKeyData compares old Ent entity and new data.
+func DiffAccessKeyData(old *entiam.BronzeAWSIAMAccessKey, new *AccessKeyData) *AccessKeyDiff {
+	if old == nil {
+		return &AccessKeyDiff{IsNew: true}
+	}
+
+	return &AccessKeyDiff{
+		IsChanged: old.UserName != new.UserName ||
+			old.Status != new.Status ||
+			!timeEqual(old.CreateDate, new.CreateDate) ||
+			!timeEqual(old.LastUsedDate, new.LastUsedDate) ||
+			old.LastUsedService != new.LastUsedService ||
+			old.LastUsedRegion != new.LastUsedRegion,
+	}
+}
+
+// HasAnyChange returns true if any part of the access key changed.
+func (d *AccessKeyDiff) HasAnyChange() bool {
+	return d.IsNew || d.IsChanged
+}
+
+func timeEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,87 @@
+package accesskey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamaccesskey"
+)
+
+// HistoryService handles history tracking for IAM access keys.
+type HistoryService struct {
+	entClient *entiam.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entiam.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entiam.Tx, data *AccessKeyData) *entiam.BronzeHistoryAWSIAMAccessKeyCreate {
+	create := tx.BronzeHistoryAWSIAMAccessKey.Create().
+		SetResourceID(data.ResourceID).
+		SetCollectedAt(data.CollectedAt).
+		SetUserName(data.UserName).
+		SetStatus(data.Status).
+		SetLastUsedService(data.LastUsedService).
+		SetLastUsedRegion(data.LastUsedRegion).
+		SetAccountID(data.AccountID)
+
+	if data.CreateDate != nil {
+		create.SetCreateDate(*data.CreateDate)
+	}
+	if data.LastUsedDate != nil {
+		create.SetLastUsedDate(*data.LastUsedDate)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new access key.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entiam.Tx, data *AccessKeyData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create access key history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new history based on diff.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entiam.Tx, old *entiam.BronzeAWSIAMAccessKey, new *AccessKeyData, diff *AccessKeyDiff, now time.Time) error {
+	if !diff.IsChanged {
+		return nil
+	}
+
+	if err := h.CloseHistory(ctx, tx, old.ID, now); err != nil {
+		return err
+	}
+
+	_, err := h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new access key history: %w", err)
+	}
+	return nil
+}
+
+// CloseHistory closes history records for a deleted access key.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entiam.Tx, resourceID string, now time.Time) error {
+	_, err := tx.BronzeHistoryAWSIAMAccessKey.Update().
+		Where(
+			bronzehistoryawsiamaccesskey.ResourceID(resourceID),
+			bronzehistoryawsiamaccesskey.ValidToIsNil(),
+		).
+		SetValidTo(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close access key history: %w", err)
+	}
+	return nil
+}
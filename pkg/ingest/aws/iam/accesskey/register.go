@@ -0,0 +1,18 @@
+package accesskey
+
+import (
+	"go.temporal.io/sdk/worker"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+)
+
+// Register registers access key activities and workflows with the Temporal worker.
+func Register(w worker.Worker, configService *config.Service, entClient *entiam.Client, limiter ratelimit.Limiter) {
+	activities := NewActivities(configService, entClient, limiter)
+
+	w.RegisterActivity(activities.IngestIAMAccessKeys)
+
+	w.RegisterWorkflow(AWSIAMAccessKeyWorkflow)
+}
@@ -0,0 +1,229 @@
+package accesskey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamaccesskey"
+)
+
+// Service handles AWS IAM access key ingestion.
+type Service struct {
+	client    *Client
+	entClient *entiam.Client
+	history   *HistoryService
+}
+
+// NewService creates a new access key ingestion service.
+func NewService(client *Client, entClient *entiam.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestParams contains parameters for access key ingestion.
+type IngestParams struct {
+	AccountID string
+}
+
+// IngestResult contains the result of access key ingestion.
+type IngestResult struct {
+	AccountID      string
+	AccessKeyCount int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches access keys from AWS and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	// Fetch access keys from AWS
+	keys, err := s.client.ListAccessKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list access keys: %w", err)
+	}
+
+	// Convert to data structs
+	keyDataList := make([]*AccessKeyData, 0, len(keys))
+	for _, k := range keys {
+		keyDataList = append(keyDataList, ConvertAccessKey(k, params.AccountID, collectedAt))
+	}
+
+	// Save to database
+	if err := s.saveAccessKeys(ctx, keyDataList); err != nil {
+		return nil, fmt.Errorf("failed to save access keys: %w", err)
+	}
+
+	return &IngestResult{
+		AccountID:      params.AccountID,
+		AccessKeyCount: len(keyDataList),
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveAccessKeys saves access keys to the database with history tracking.
+func (s *Service) saveAccessKeys(ctx context.Context, accessKeys []*AccessKeyData) error {
+	if len(accessKeys) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, accessKeyData := range accessKeys {
+		// Load existing access key
+		existing, err := tx.BronzeAWSIAMAccessKey.Query().
+			Where(bronzeawsiamaccesskey.ID(accessKeyData.ResourceID)).
+			First(ctx)
+		if err != nil && !entiam.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("failed to load existing access key %s: %w", accessKeyData.ResourceID, err)
+		}
+
+		// Compute diff
+		diff := DiffAccessKeyData(existing, accessKeyData)
+
+		// Skip if no changes
+		if !diff.HasAnyChange() {
+			if err := tx.BronzeAWSIAMAccessKey.UpdateOneID(accessKeyData.ResourceID).
+				SetCollectedAt(accessKeyData.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update collected_at for access key %s: %w", accessKeyData.ResourceID, err)
+			}
+			continue
+		}
+
+		// Create or update access key
+		if existing == nil {
+			create := tx.BronzeAWSIAMAccessKey.Create().
+				SetID(accessKeyData.ResourceID).
+				SetUserName(accessKeyData.UserName).
+				SetStatus(accessKeyData.Status).
+				SetLastUsedService(accessKeyData.LastUsedService).
+				SetLastUsedRegion(accessKeyData.LastUsedRegion).
+				SetAccountID(accessKeyData.AccountID).
+				SetCollectedAt(accessKeyData.CollectedAt).
+				SetFirstCollectedAt(accessKeyData.CollectedAt)
+
+			if accessKeyData.CreateDate != nil {
+				create.SetCreateDate(*accessKeyData.CreateDate)
+			}
+			if accessKeyData.LastUsedDate != nil {
+				create.SetLastUsedDate(*accessKeyData.LastUsedDate)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create access key %s: %w", accessKeyData.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeAWSIAMAccessKey.UpdateOneID(accessKeyData.ResourceID).
+				SetUserName(accessKeyData.UserName).
+				SetStatus(accessKeyData.Status).
+				SetLastUsedService(accessKeyData.LastUsedService).
+				SetLastUsedRegion(accessKeyData.LastUsedRegion).
+				SetAccountID(accessKeyData.AccountID).
+				SetCollectedAt(accessKeyData.CollectedAt)
+
+			if accessKeyData.CreateDate != nil {
+				update.SetCreateDate(*accessKeyData.CreateDate)
+			} else {
+				update.ClearCreateDate()
+			}
+			if accessKeyData.LastUsedDate != nil {
+				update.SetLastUsedDate(*accessKeyData.LastUsedDate)
+			} else {
+				update.ClearLastUsedDate()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update access key %s: %w", accessKeyData.ResourceID, err)
+			}
+		}
+
+		// Track history
+		if diff.IsNew {
+			if err := s.history.CreateHistory(ctx, tx, accessKeyData, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create history for access key %s: %w", accessKeyData.ResourceID, err)
+			}
+		} else {
+			if err := s.history.UpdateHistory(ctx, tx, existing, accessKeyData, diff, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update history for access key %s: %w", accessKeyData.ResourceID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleAccessKeys removes access keys that were not collected in the latest run.
+func (s *Service) DeleteStaleAccessKeys(ctx context.Context, accountID string, collectedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	stale, err := tx.BronzeAWSIAMAccessKey.Query().
+		Where(
+			bronzeawsiamaccesskey.AccountID(accountID),
+			bronzeawsiamaccesskey.CollectedAtLT(collectedAt),
+		).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range stale {
+		if err := s.history.CloseHistory(ctx, tx, e.ID, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to close history for access key %s: %w", e.ID, err)
+		}
+
+		if err := tx.BronzeAWSIAMAccessKey.DeleteOne(e).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete access key %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
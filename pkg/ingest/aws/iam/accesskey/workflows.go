@@ -0,0 +1,59 @@
+package accesskey
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AWSIAMAccessKeyWorkflowParams contains parameters for the access key workflow.
+type AWSIAMAccessKeyWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSIAMAccessKeyWorkflowResult contains the result of the access key workflow.
+type AWSIAMAccessKeyWorkflowResult struct {
+	AccountID      string
+	AccessKeyCount int
+	DurationMillis int64
+}
+
+// AWSIAMAccessKeyWorkflow ingests AWS IAM access keys for an account.
+func AWSIAMAccessKeyWorkflow(ctx workflow.Context, params AWSIAMAccessKeyWorkflowParams) (*AWSIAMAccessKeyWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSIAMAccessKeyWorkflow", "accountID", params.AccountID)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestIAMAccessKeysResult
+	err := workflow.ExecuteActivity(activityCtx, IngestIAMAccessKeysActivity, IngestIAMAccessKeysParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest access keys", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Completed AWSIAMAccessKeyWorkflow",
+		"accountID", params.AccountID,
+		"accessKeyCount", result.AccessKeyCount,
+	)
+
+	return &AWSIAMAccessKeyWorkflowResult{
+		AccountID:      result.AccountID,
+		AccessKeyCount: result.AccessKeyCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package credentialreport
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/awsauth"
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entiam.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entiam.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// createClient creates a rate-limited AWS IAM client for the given account and region.
+func (a *Activities) createClient(ctx context.Context, accountID, region string) (*Client, error) {
+	cfg, err := awsauth.LoadConfig(ctx, a.configService, a.limiter, accountID, region)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(cfg), nil
+}
+
+// IngestIAMCredentialReportsParams contains parameters for the ingest activity.
+type IngestIAMCredentialReportsParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestIAMCredentialReportsResult contains the result of the ingest activity.
+type IngestIAMCredentialReportsResult struct {
+	AccountID             string
+	CredentialReportCount int
+	DurationMillis        int64
+}
+
+// IngestIAMCredentialReportsActivity is the activity function reference for workflow registration.
+var IngestIAMCredentialReportsActivity = (*Activities).IngestIAMCredentialReports
+
+// IngestIAMCredentialReports is a Temporal activity that ingests AWS IAM credential report rows.
+func (a *Activities) IngestIAMCredentialReports(ctx context.Context, params IngestIAMCredentialReportsParams) (*IngestIAMCredentialReportsResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting AWS IAM credential report row ingestion", "accountID", params.AccountID)
+
+	client, err := a.createClient(ctx, params.AccountID, params.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create client: %w", err)
+	}
+
+	service := NewService(client, a.entClient)
+	result, err := service.Ingest(ctx, IngestParams{
+		AccountID: params.AccountID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to ingest credential report rows: %w", err)
+	}
+
+	// Delete stale credential report rows
+	if err := service.DeleteStaleCredentialReports(ctx, params.AccountID, result.CollectedAt); err != nil {
+		logger.Warn("Failed to delete stale credential report rows", "error", err)
+	}
+
+	logger.Info("Completed AWS IAM credential report row ingestion",
+		"accountID", params.AccountID,
+		"credentialReportCount", result.CredentialReportCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestIAMCredentialReportsResult{
+		AccountID:             result.AccountID,
+		CredentialReportCount: result.CredentialReportCount,
+		DurationMillis:        result.DurationMillis,
+	}, nil
+}
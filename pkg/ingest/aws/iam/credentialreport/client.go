@@ -0,0 +1,60 @@
+package credentialreport
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// Report generation polling. AWS usually completes a report within seconds
+// and reuses a report generated in the last four hours.
+const (
+	generatePollInterval = 2 * time.Second
+	generateMaxAttempts  = 30
+)
+
+// Client wraps the AWS IAM API for the credential report.
+type Client struct {
+	iamClient *iam.Client
+}
+
+// NewClient creates a new IAM credential report client from an AWS config.
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		iamClient: iam.NewFromConfig(cfg),
+	}
+}
+
+// GetCredentialReport generates the credential report if needed, waits for it
+// to complete and returns its CSV content and generation time.
+func (c *Client) GetCredentialReport(ctx context.Context) ([]byte, *time.Time, error) {
+	for attempt := 0; ; attempt++ {
+		output, err := c.iamClient.GenerateCredentialReport(ctx, &iam.GenerateCredentialReportInput{})
+		if err != nil {
+			return nil, nil, fmt.Errorf("generate credential report: %w", err)
+		}
+		if output.State == types.ReportStateTypeComplete {
+			break
+		}
+		if attempt >= generateMaxAttempts {
+			return nil, nil, fmt.Errorf("credential report not ready after %d attempts (state %s)", attempt+1, output.State)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		case <-time.After(generatePollInterval):
+		}
+	}
+
+	output, err := c.iamClient.GetCredentialReport(ctx, &iam.GetCredentialReportInput{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("get credential report: %w", err)
+	}
+
+	return output.Content, output.GeneratedTime, nil
+}
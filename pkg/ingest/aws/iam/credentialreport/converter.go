@@ -0,0 +1,129 @@
+package credentialreport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"time"
+)
+
+// CredentialReportData holds one converted credential report row ready for Ent insertion.
+type CredentialReportData struct {
+	ResourceID                string
+	User                      string
+	UserCreationTime          *time.Time
+	PasswordEnabled           string
+	PasswordLastUsed          *time.Time
+	PasswordLastChanged       *time.Time
+	PasswordNextRotation      *time.Time
+	MFAActive                 bool
+	AccessKey1Active          bool
+	AccessKey1LastRotated     *time.Time
+	AccessKey1LastUsedDate    *time.Time
+	AccessKey1LastUsedRegion  string
+	AccessKey1LastUsedService string
+	AccessKey2Active          bool
+	AccessKey2LastRotated     *time.Time
+	AccessKey2LastUsedDate    *time.Time
+	AccessKey2LastUsedRegion  string
+	AccessKey2LastUsedService string
+	Cert1Active               bool
+	Cert1LastRotated          *time.Time
+	Cert2Active               bool
+	Cert2LastRotated          *time.Time
+	ReportGeneratedAt         *time.Time
+	AccountID                 string
+	CollectedAt               time.Time
+}
+
+// ParseCredentialReport parses the CSV credential report into one row per
+// user. Columns are looked up by header name so that columns added by AWS
+// do not shift the mapping.
+func ParseCredentialReport(content []byte, generatedAt *time.Time, accountID string, collectedAt time.Time) ([]*CredentialReportData, error) {
+	r := csv.NewReader(bytes.NewReader(content))
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	col := make(map[string]int, len(header))
+	for i, name := range header {
+		col[name] = i
+	}
+	if _, ok := col["arn"]; !ok {
+		return nil, fmt.Errorf("credential report has no arn column")
+	}
+
+	var rows []*CredentialReportData
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read row: %w", err)
+		}
+
+		get := func(name string) string {
+			if i, ok := col[name]; ok && i < len(record) {
+				return record[i]
+			}
+			return ""
+		}
+
+		rows = append(rows, &CredentialReportData{
+			ResourceID:                get("arn"),
+			User:                      get("user"),
+			UserCreationTime:          parseTime(get("user_creation_time")),
+			PasswordEnabled:           get("password_enabled"),
+			PasswordLastUsed:          parseTime(get("password_last_used")),
+			PasswordLastChanged:       parseTime(get("password_last_changed")),
+			PasswordNextRotation:      parseTime(get("password_next_rotation")),
+			MFAActive:                 get("mfa_active") == "true",
+			AccessKey1Active:          get("access_key_1_active") == "true",
+			AccessKey1LastRotated:     parseTime(get("access_key_1_last_rotated")),
+			AccessKey1LastUsedDate:    parseTime(get("access_key_1_last_used_date")),
+			AccessKey1LastUsedRegion:  parseValue(get("access_key_1_last_used_region")),
+			AccessKey1LastUsedService: parseValue(get("access_key_1_last_used_service")),
+			AccessKey2Active:          get("access_key_2_active") == "true",
+			AccessKey2LastRotated:     parseTime(get("access_key_2_last_rotated")),
+			AccessKey2LastUsedDate:    parseTime(get("access_key_2_last_used_date")),
+			AccessKey2LastUsedRegion:  parseValue(get("access_key_2_last_used_region")),
+			AccessKey2LastUsedService: parseValue(get("access_key_2_last_used_service")),
+			Cert1Active:               get("cert_1_active") == "true",
+			Cert1LastRotated:          parseTime(get("cert_1_last_rotated")),
+			Cert2Active:               get("cert_2_active") == "true",
+			Cert2LastRotated:          parseTime(get("cert_2_last_rotated")),
+			ReportGeneratedAt:         generatedAt,
+			AccountID:                 accountID,
+			CollectedAt:               collectedAt,
+		})
+	}
+
+	return rows, nil
+}
+
+// parseValue maps the report's placeholders (N/A, not_supported,
+// no_information) to an empty string.
+func parseValue(s string) string {
+	switch s {
+	case "N/A", "not_supported", "no_information":
+		return ""
+	}
+	return s
+}
+
+// parseTime parses an ISO 8601 report timestamp; placeholders yield nil.
+func parseTime(s string) *time.Time {
+	s = parseValue(s)
+	if s == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,59 @@
+package credentialreport
+
+import (
+	"testing"
+	"time"
+)
+
+const sampleReport = `user,arn,user_creation_time,password_enabled,password_last_used,password_last_changed,password_next_rotation,mfa_active,access_key_1_active,access_key_1_last_rotated,access_key_1_last_used_date,access_key_1_last_used_region,access_key_1_last_used_service,access_key_2_active,access_key_2_last_rotated,access_key_2_last_used_date,access_key_2_last_used_region,access_key_2_last_used_service,cert_1_active,cert_1_last_rotated,cert_2_active,cert_2_last_rotated
+<root_account>,arn:aws:iam::123456789012:root,2020-01-01T00:00:00+00:00,not_supported,2024-05-01T10:00:00+00:00,not_supported,not_supported,true,false,N/A,N/A,N/A,N/A,false,N/A,N/A,N/A,N/A,false,N/A,false,N/A
+alice,arn:aws:iam::123456789012:user/alice,2021-03-04T05:06:07+00:00,true,no_information,2021-03-04T05:06:07+00:00,N/A,false,true,2021-03-04T05:10:00+00:00,2024-06-01T12:00:00+00:00,us-east-1,s3,true,2024-01-01T00:00:00+00:00,N/A,N/A,N/A,false,N/A,false,N/A
+`
+
+func TestParseCredentialReport(t *testing.T) {
+	generated := time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)
+	collected := time.Date(2024, 6, 2, 1, 0, 0, 0, time.UTC)
+
+	rows, err := ParseCredentialReport([]byte(sampleReport), &generated, "123456789012", collected)
+	if err != nil {
+		t.Fatalf("ParseCredentialReport: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+
+	root := rows[0]
+	if root.ResourceID != "arn:aws:iam::123456789012:root" || root.User != "<root_account>" {
+		t.Errorf("root identity = %q %q", root.ResourceID, root.User)
+	}
+	if root.PasswordEnabled != "not_supported" || root.PasswordLastChanged != nil {
+		t.Errorf("root password = %q %v", root.PasswordEnabled, root.PasswordLastChanged)
+	}
+	if !root.MFAActive || root.AccessKey1Active || root.AccessKey1LastRotated != nil {
+		t.Errorf("root mfa/key1 = %v %v %v", root.MFAActive, root.AccessKey1Active, root.AccessKey1LastRotated)
+	}
+
+	alice := rows[1]
+	if alice.PasswordLastUsed != nil {
+		t.Errorf("no_information password_last_used = %v, want nil", alice.PasswordLastUsed)
+	}
+	wantRotated := time.Date(2021, 3, 4, 5, 10, 0, 0, time.UTC)
+	if alice.AccessKey1LastRotated == nil || !alice.AccessKey1LastRotated.Equal(wantRotated) {
+		t.Errorf("access_key_1_last_rotated = %v, want %v", alice.AccessKey1LastRotated, wantRotated)
+	}
+	if alice.AccessKey1LastUsedRegion != "us-east-1" || alice.AccessKey1LastUsedService != "s3" {
+		t.Errorf("access key 1 last used = %q %q", alice.AccessKey1LastUsedRegion, alice.AccessKey1LastUsedService)
+	}
+	if !alice.AccessKey2Active || alice.AccessKey2LastUsedDate != nil || alice.AccessKey2LastUsedService != "" {
+		t.Errorf("access key 2 = %v %v %q", alice.AccessKey2Active, alice.AccessKey2LastUsedDate, alice.AccessKey2LastUsedService)
+	}
+	if alice.AccountID != "123456789012" || !alice.CollectedAt.Equal(collected) || alice.ReportGeneratedAt != &generated {
+		t.Errorf("metadata = %q %v %v", alice.AccountID, alice.CollectedAt, alice.ReportGeneratedAt)
+	}
+}
+
+func TestParseCredentialReportMissingArn(t *testing.T) {
+	if _, err := ParseCredentialReport([]byte("user,foo\nalice,bar\n"), nil, "1", time.Now()); err == nil {
+		t.Fatal("expected error for report without arn column")
+	}
+}
@@ -0,0 +1,58 @@
+package credentialreport
+
+import (
+	"time"
+
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+)
+
+// CredentialReportDiff represents changes between old and new credential report rows.
+type CredentialReportDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffCredentialReportData compares old Ent entity and new data. The report
+// generation time is excluded so that regenerating an unchanged report does
+// not create history.
+func DiffCredentialReportData(old *entiam.BronzeAWSIAMCredentialReport, new *CredentialReportData) *CredentialReportDiff {
+	if old == nil {
+		return &CredentialReportDiff{IsNew: true}
+	}
+
+	return &CredentialReportDiff{
+		IsChanged: old.User != new.User ||
+			!timeEqual(old.UserCreationTime, new.UserCreationTime) ||
+			old.PasswordEnabled != new.PasswordEnabled ||
+			!timeEqual(old.PasswordLastUsed, new.PasswordLastUsed) ||
+			!timeEqual(old.PasswordLastChanged, new.PasswordLastChanged) ||
+			!timeEqual(old.PasswordNextRotation, new.PasswordNextRotation) ||
+			old.MfaActive != new.MFAActive ||
+			old.AccessKey1Active != new.AccessKey1Active ||
+			!timeEqual(old.AccessKey1LastRotated, new.AccessKey1LastRotated) ||
+			!timeEqual(old.AccessKey1LastUsedDate, new.AccessKey1LastUsedDate) ||
+			old.AccessKey1LastUsedRegion != new.AccessKey1LastUsedRegion ||
+			old.AccessKey1LastUsedService != new.AccessKey1LastUsedService ||
+			old.AccessKey2Active != new.AccessKey2Active ||
+			!timeEqual(old.AccessKey2LastRotated, new.AccessKey2LastRotated) ||
+			!timeEqual(old.AccessKey2LastUsedDate, new.AccessKey2LastUsedDate) ||
+			old.AccessKey2LastUsedRegion != new.AccessKey2LastUsedRegion ||
+			old.AccessKey2LastUsedService != new.AccessKey2LastUsedService ||
+			old.Cert1Active != new.Cert1Active ||
+			!timeEqual(old.Cert1LastRotated, new.Cert1LastRotated) ||
+			old.Cert2Active != new.Cert2Active ||
+			!timeEqual(old.Cert2LastRotated, new.Cert2LastRotated),
+	}
+}
+
+// HasAnyChange returns true if any part of the credential report row changed.
+func (d *CredentialReportDiff) HasAnyChange() bool {
+	return d.IsNew || d.IsChanged
+}
+
+func timeEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
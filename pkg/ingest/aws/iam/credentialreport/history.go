@@ -0,0 +1,121 @@
+package credentialreport
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamcredentialreport"
+)
+
+// HistoryService handles history tracking for IAM credential report rows.
+type HistoryService struct {
+	entClient *entiam.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entiam.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entiam.Tx, data *CredentialReportData) *entiam.BronzeHistoryAWSIAMCredentialReportCreate {
+	create := tx.BronzeHistoryAWSIAMCredentialReport.Create().
+		SetResourceID(data.ResourceID).
+		SetCollectedAt(data.CollectedAt).
+		SetUser(data.User).
+		SetPasswordEnabled(data.PasswordEnabled).
+		SetMfaActive(data.MFAActive).
+		SetAccessKey1Active(data.AccessKey1Active).
+		SetAccessKey1LastUsedRegion(data.AccessKey1LastUsedRegion).
+		SetAccessKey1LastUsedService(data.AccessKey1LastUsedService).
+		SetAccessKey2Active(data.AccessKey2Active).
+		SetAccessKey2LastUsedRegion(data.AccessKey2LastUsedRegion).
+		SetAccessKey2LastUsedService(data.AccessKey2LastUsedService).
+		SetCert1Active(data.Cert1Active).
+		SetCert2Active(data.Cert2Active).
+		SetAccountID(data.AccountID)
+
+	if data.UserCreationTime != nil {
+		create.SetUserCreationTime(*data.UserCreationTime)
+	}
+	if data.PasswordLastUsed != nil {
+		create.SetPasswordLastUsed(*data.PasswordLastUsed)
+	}
+	if data.PasswordLastChanged != nil {
+		create.SetPasswordLastChanged(*data.PasswordLastChanged)
+	}
+	if data.PasswordNextRotation != nil {
+		create.SetPasswordNextRotation(*data.PasswordNextRotation)
+	}
+	if data.AccessKey1LastRotated != nil {
+		create.SetAccessKey1LastRotated(*data.AccessKey1LastRotated)
+	}
+	if data.AccessKey1LastUsedDate != nil {
+		create.SetAccessKey1LastUsedDate(*data.AccessKey1LastUsedDate)
+	}
+	if data.AccessKey2LastRotated != nil {
+		create.SetAccessKey2LastRotated(*data.AccessKey2LastRotated)
+	}
+	if data.AccessKey2LastUsedDate != nil {
+		create.SetAccessKey2LastUsedDate(*data.AccessKey2LastUsedDate)
+	}
+	if data.Cert1LastRotated != nil {
+		create.SetCert1LastRotated(*data.Cert1LastRotated)
+	}
+	if data.Cert2LastRotated != nil {
+		create.SetCert2LastRotated(*data.Cert2LastRotated)
+	}
+	if data.ReportGeneratedAt != nil {
+		create.SetReportGeneratedAt(*data.ReportGeneratedAt)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new credential report.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entiam.Tx, data *CredentialReportData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create credential report history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new history based on diff.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entiam.Tx, old *entiam.BronzeAWSIAMCredentialReport, new *CredentialReportData, diff *CredentialReportDiff, now time.Time) error {
+	if !diff.IsChanged {
+		return nil
+	}
+
+	if err := h.CloseHistory(ctx, tx, old.ID, now); err != nil {
+		return err
+	}
+
+	_, err := h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new credential report history: %w", err)
+	}
+	return nil
+}
+
+// CloseHistory closes history records for a deleted credential report.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entiam.Tx, resourceID string, now time.Time) error {
+	_, err := tx.BronzeHistoryAWSIAMCredentialReport.Update().
+		Where(
+			bronzehistoryawsiamcredentialreport.ResourceID(resourceID),
+			bronzehistoryawsiamcredentialreport.ValidToIsNil(),
+		).
+		SetValidTo(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close credential report history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,315 @@
+package credentialreport
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamcredentialreport"
+)
+
+// Service handles AWS IAM credential report ingestion.
+type Service struct {
+	client    *Client
+	entClient *entiam.Client
+	history   *HistoryService
+}
+
+// NewService creates a new credential report ingestion service.
+func NewService(client *Client, entClient *entiam.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestParams contains parameters for credential report entry ingestion.
+type IngestParams struct {
+	AccountID string
+}
+
+// IngestResult contains the result of credential report entry ingestion.
+type IngestResult struct {
+	AccountID             string
+	CredentialReportCount int
+	CollectedAt           time.Time
+	DurationMillis        int64
+}
+
+// Ingest fetches the credential report from AWS and stores one row per user in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	// Fetch credential report from AWS
+	content, generatedAt, err := s.client.GetCredentialReport(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credential report: %w", err)
+	}
+
+	// Parse report rows
+	reportDataList, err := ParseCredentialReport(content, generatedAt, params.AccountID, collectedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse credential report: %w", err)
+	}
+
+	// Save to database
+	if err := s.saveCredentialReports(ctx, reportDataList); err != nil {
+		return nil, fmt.Errorf("failed to save credential report: %w", err)
+	}
+
+	return &IngestResult{
+		AccountID:             params.AccountID,
+		CredentialReportCount: len(reportDataList),
+		CollectedAt:           collectedAt,
+		DurationMillis:        time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveCredentialReports saves credential report entries to the database with history tracking.
+func (s *Service) saveCredentialReports(ctx context.Context, credentialReports []*CredentialReportData) error {
+	if len(credentialReports) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, credentialReportData := range credentialReports {
+		// Load existing credential report entry
+		existing, err := tx.BronzeAWSIAMCredentialReport.Query().
+			Where(bronzeawsiamcredentialreport.ID(credentialReportData.ResourceID)).
+			First(ctx)
+		if err != nil && !entiam.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("failed to load existing credential report entry %s: %w", credentialReportData.ResourceID, err)
+		}
+
+		// Compute diff
+		diff := DiffCredentialReportData(existing, credentialReportData)
+
+		// Skip if no changes
+		if !diff.HasAnyChange() {
+			if err := tx.BronzeAWSIAMCredentialReport.UpdateOneID(credentialReportData.ResourceID).
+				SetCollectedAt(credentialReportData.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update collected_at for credential report entry %s: %w", credentialReportData.ResourceID, err)
+			}
+			continue
+		}
+
+		// Create or update credential report entry
+		if existing == nil {
+			create := tx.BronzeAWSIAMCredentialReport.Create().
+				SetID(credentialReportData.ResourceID).
+				SetUser(credentialReportData.User).
+				SetPasswordEnabled(credentialReportData.PasswordEnabled).
+				SetMfaActive(credentialReportData.MFAActive).
+				SetAccessKey1Active(credentialReportData.AccessKey1Active).
+				SetAccessKey1LastUsedRegion(credentialReportData.AccessKey1LastUsedRegion).
+				SetAccessKey1LastUsedService(credentialReportData.AccessKey1LastUsedService).
+				SetAccessKey2Active(credentialReportData.AccessKey2Active).
+				SetAccessKey2LastUsedRegion(credentialReportData.AccessKey2LastUsedRegion).
+				SetAccessKey2LastUsedService(credentialReportData.AccessKey2LastUsedService).
+				SetCert1Active(credentialReportData.Cert1Active).
+				SetCert2Active(credentialReportData.Cert2Active).
+				SetAccountID(credentialReportData.AccountID).
+				SetCollectedAt(credentialReportData.CollectedAt).
+				SetFirstCollectedAt(credentialReportData.CollectedAt)
+
+			if credentialReportData.UserCreationTime != nil {
+				create.SetUserCreationTime(*credentialReportData.UserCreationTime)
+			}
+			if credentialReportData.PasswordLastUsed != nil {
+				create.SetPasswordLastUsed(*credentialReportData.PasswordLastUsed)
+			}
+			if credentialReportData.PasswordLastChanged != nil {
+				create.SetPasswordLastChanged(*credentialReportData.PasswordLastChanged)
+			}
+			if credentialReportData.PasswordNextRotation != nil {
+				create.SetPasswordNextRotation(*credentialReportData.PasswordNextRotation)
+			}
+			if credentialReportData.AccessKey1LastRotated != nil {
+				create.SetAccessKey1LastRotated(*credentialReportData.AccessKey1LastRotated)
+			}
+			if credentialReportData.AccessKey1LastUsedDate != nil {
+				create.SetAccessKey1LastUsedDate(*credentialReportData.AccessKey1LastUsedDate)
+			}
+			if credentialReportData.AccessKey2LastRotated != nil {
+				create.SetAccessKey2LastRotated(*credentialReportData.AccessKey2LastRotated)
+			}
+			if credentialReportData.AccessKey2LastUsedDate != nil {
+				create.SetAccessKey2LastUsedDate(*credentialReportData.AccessKey2LastUsedDate)
+			}
+			if credentialReportData.Cert1LastRotated != nil {
+				create.SetCert1LastRotated(*credentialReportData.Cert1LastRotated)
+			}
+			if credentialReportData.Cert2LastRotated != nil {
+				create.SetCert2LastRotated(*credentialReportData.Cert2LastRotated)
+			}
+			if credentialReportData.ReportGeneratedAt != nil {
+				create.SetReportGeneratedAt(*credentialReportData.ReportGeneratedAt)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create credential report entry %s: %w", credentialReportData.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeAWSIAMCredentialReport.UpdateOneID(credentialReportData.ResourceID).
+				SetUser(credentialReportData.User).
+				SetPasswordEnabled(credentialReportData.PasswordEnabled).
+				SetMfaActive(credentialReportData.MFAActive).
+				SetAccessKey1Active(credentialReportData.AccessKey1Active).
+				SetAccessKey1LastUsedRegion(credentialReportData.AccessKey1LastUsedRegion).
+				SetAccessKey1LastUsedService(credentialReportData.AccessKey1LastUsedService).
+				SetAccessKey2Active(credentialReportData.AccessKey2Active).
+				SetAccessKey2LastUsedRegion(credentialReportData.AccessKey2LastUsedRegion).
+				SetAccessKey2LastUsedService(credentialReportData.AccessKey2LastUsedService).
+				SetCert1Active(credentialReportData.Cert1Active).
+				SetCert2Active(credentialReportData.Cert2Active).
+				SetAccountID(credentialReportData.AccountID).
+				SetCollectedAt(credentialReportData.CollectedAt)
+
+			if credentialReportData.UserCreationTime != nil {
+				update.SetUserCreationTime(*credentialReportData.UserCreationTime)
+			} else {
+				update.ClearUserCreationTime()
+			}
+			if credentialReportData.PasswordLastUsed != nil {
+				update.SetPasswordLastUsed(*credentialReportData.PasswordLastUsed)
+			} else {
+				update.ClearPasswordLastUsed()
+			}
+			if credentialReportData.PasswordLastChanged != nil {
+				update.SetPasswordLastChanged(*credentialReportData.PasswordLastChanged)
+			} else {
+				update.ClearPasswordLastChanged()
+			}
+			if credentialReportData.PasswordNextRotation != nil {
+				update.SetPasswordNextRotation(*credentialReportData.PasswordNextRotation)
+			} else {
+				update.ClearPasswordNextRotation()
+			}
+			if credentialReportData.AccessKey1LastRotated != nil {
+				update.SetAccessKey1LastRotated(*credentialReportData.AccessKey1LastRotated)
+			} else {
+				update.ClearAccessKey1LastRotated()
+			}
+			if credentialReportData.AccessKey1LastUsedDate != nil {
+				update.SetAccessKey1LastUsedDate(*credentialReportData.AccessKey1LastUsedDate)
+			} else {
+				update.ClearAccessKey1LastUsedDate()
+			}
+			if credentialReportData.AccessKey2LastRotated != nil {
+				update.SetAccessKey2LastRotated(*credentialReportData.AccessKey2LastRotated)
+			} else {
+				update.ClearAccessKey2LastRotated()
+			}
+			if credentialReportData.AccessKey2LastUsedDate != nil {
+				update.SetAccessKey2LastUsedDate(*credentialReportData.AccessKey2LastUsedDate)
+			} else {
+				update.ClearAccessKey2LastUsedDate()
+			}
+			if credentialReportData.Cert1LastRotated != nil {
+				update.SetCert1LastRotated(*credentialReportData.Cert1LastRotated)
+			} else {
+				update.ClearCert1LastRotated()
+			}
+			if credentialReportData.Cert2LastRotated != nil {
+				update.SetCert2LastRotated(*credentialReportData.Cert2LastRotated)
+			} else {
+				update.ClearCert2LastRotated()
+			}
+			if credentialReportData.ReportGeneratedAt != nil {
+				update.SetReportGeneratedAt(*credentialReportData.ReportGeneratedAt)
+			} else {
+				update.ClearReportGeneratedAt()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update credential report entry %s: %w", credentialReportData.ResourceID, err)
+			}
+		}
+
+		// Track history
+		if diff.IsNew {
+			if err := s.history.CreateHistory(ctx, tx, credentialReportData, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create history for credential report entry %s: %w", credentialReportData.ResourceID, err)
+			}
+		} else {
+			if err := s.history.UpdateHistory(ctx, tx, existing, credentialReportData, diff, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update history for credential report entry %s: %w", credentialReportData.ResourceID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleCredentialReports removes credential report entries that were not collected in the latest run.
+func (s *Service) DeleteStaleCredentialReports(ctx context.Context, accountID string, collectedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	stale, err := tx.BronzeAWSIAMCredentialReport.Query().
+		Where(
+			bronzeawsiamcredentialreport.AccountID(accountID),
+			bronzeawsiamcredentialreport.CollectedAtLT(collectedAt),
+		).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range stale {
+		if err := s.history.CloseHistory(ctx, tx, e.ID, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to close history for credential report entry %s: %w", e.ID, err)
+		}
+
+		if err := tx.BronzeAWSIAMCredentialReport.DeleteOne(e).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete credential report entry %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package credentialreport
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AWSIAMCredentialReportWorkflowParams contains parameters for the credential report row workflow.
+type AWSIAMCredentialReportWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSIAMCredentialReportWorkflowResult contains the result of the credential report row workflow.
+type AWSIAMCredentialReportWorkflowResult struct {
+	AccountID             string
+	CredentialReportCount int
+	DurationMillis        int64
+}
+
+// AWSIAMCredentialReportWorkflow ingests AWS IAM credential report rows for an account.
+func AWSIAMCredentialReportWorkflow(ctx workflow.Context, params AWSIAMCredentialReportWorkflowParams) (*AWSIAMCredentialReportWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSIAMCredentialReportWorkflow", "accountID", params.AccountID)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestIAMCredentialReportsResult
+	err := workflow.ExecuteActivity(activityCtx, IngestIAMCredentialReportsActivity, IngestIAMCredentialReportsParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest credential report rows", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Completed AWSIAMCredentialReportWorkflow",
+		"accountID", params.AccountID,
+		"credentialReportCount", result.CredentialReportCount,
+	)
+
+	return &AWSIAMCredentialReportWorkflowResult{
+		AccountID:             result.AccountID,
+		CredentialReportCount: result.CredentialReportCount,
+		DurationMillis:        result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package group
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/awsauth"
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entiam.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entiam.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// createClient creates a rate-limited AWS IAM client for the given account and region.
+func (a *Activities) createClient(ctx context.Context, accountID, region string) (*Client, error) {
+	cfg, err := awsauth.LoadConfig(ctx, a.configService, a.limiter, accountID, region)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(cfg), nil
+}
+
+// IngestIAMGroupsParams contains parameters for the ingest activity.
+type IngestIAMGroupsParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestIAMGroupsResult contains the result of the ingest activity.
+type IngestIAMGroupsResult struct {
+	AccountID      string
+	GroupCount     int
+	DurationMillis int64
+}
+
+// IngestIAMGroupsActivity is the activity function reference for workflow registration.
+var IngestIAMGroupsActivity = (*Activities).IngestIAMGroups
+
+// IngestIAMGroups is a Temporal activity that ingests AWS IAM groups.
+func (a *Activities) IngestIAMGroups(ctx context.Context, params IngestIAMGroupsParams) (*IngestIAMGroupsResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting AWS IAM group ingestion", "accountID", params.AccountID)
+
+	client, err := a.createClient(ctx, params.AccountID, params.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create client: %w", err)
+	}
+
+	service := NewService(client, a.entClient)
+	result, err := service.Ingest(ctx, IngestParams{
+		AccountID: params.AccountID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to ingest groups: %w", err)
+	}
+
+	// Delete stale groups
+	if err := service.DeleteStaleGroups(ctx, params.AccountID, result.CollectedAt); err != nil {
+		logger.Warn("Failed to delete stale groups", "error", err)
+	}
+
+	logger.Info("Completed AWS IAM group ingestion",
+		"accountID", params.AccountID,
+		"groupCount", result.GroupCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestIAMGroupsResult{
+		AccountID:      result.AccountID,
+		GroupCount:     result.GroupCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
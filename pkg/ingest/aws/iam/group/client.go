@@ -0,0 +1,41 @@
+package group
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// Client wraps the AWS IAM API for groups.
+type Client struct {
+	iamClient *iam.Client
+}
+
+// NewClient creates a new IAM group client from an AWS config.
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		iamClient: iam.NewFromConfig(cfg),
+	}
+}
+
+// ListGroups lists all IAM groups with their attached and inline policies
+// using GetAccountAuthorizationDetails.
+func (c *Client) ListGroups(ctx context.Context) ([]types.GroupDetail, error) {
+	var groups []types.GroupDetail
+
+	paginator := iam.NewGetAccountAuthorizationDetailsPaginator(c.iamClient, &iam.GetAccountAuthorizationDetailsInput{
+		Filter: []types.EntityType{types.EntityTypeGroup},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get account authorization details: %w", err)
+		}
+		groups = append(groups, output.GroupDetailList...)
+	}
+
+	return groups, nil
+}
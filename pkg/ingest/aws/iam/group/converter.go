@@ -0,0 +1,100 @@
+package group
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// GroupData holds converted IAM group data ready for Ent insertion.
+type GroupData struct {
+	ResourceID           string
+	Arn                  string
+	GroupName            string
+	Path                 string
+	CreateDate           *time.Time
+	AttachedPoliciesJSON json.RawMessage
+	InlinePoliciesJSON   json.RawMessage
+	AccountID            string
+	CollectedAt          time.Time
+}
+
+// AttachedPolicyRef is the JSON structure for attached managed policies.
+type AttachedPolicyRef struct {
+	PolicyArn  string `json:"policy_arn"`
+	PolicyName string `json:"policy_name"`
+}
+
+// InlinePolicy is the JSON structure for inline policies.
+type InlinePolicy struct {
+	PolicyName     string          `json:"policy_name"`
+	PolicyDocument json.RawMessage `json:"policy_document,omitempty"`
+}
+
+// ConvertGroup converts an AWS API GroupDetail to GroupData.
+func ConvertGroup(g types.GroupDetail, accountID string, collectedAt time.Time) (*GroupData, error) {
+	data := &GroupData{
+		ResourceID:  derefStr(g.GroupId),
+		Arn:         derefStr(g.Arn),
+		GroupName:   derefStr(g.GroupName),
+		Path:        derefStr(g.Path),
+		CreateDate:  g.CreateDate,
+		AccountID:   accountID,
+		CollectedAt: collectedAt,
+	}
+
+	var err error
+	if len(g.AttachedManagedPolicies) > 0 {
+		refs := make([]AttachedPolicyRef, 0, len(g.AttachedManagedPolicies))
+		for _, p := range g.AttachedManagedPolicies {
+			refs = append(refs, AttachedPolicyRef{
+				PolicyArn:  derefStr(p.PolicyArn),
+				PolicyName: derefStr(p.PolicyName),
+			})
+		}
+		if data.AttachedPoliciesJSON, err = json.Marshal(refs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(g.GroupPolicyList) > 0 {
+		policies := make([]InlinePolicy, 0, len(g.GroupPolicyList))
+		for _, p := range g.GroupPolicyList {
+			policies = append(policies, InlinePolicy{
+				PolicyName:     derefStr(p.PolicyName),
+				PolicyDocument: decodePolicyDocument(p.PolicyDocument),
+			})
+		}
+		if data.InlinePoliciesJSON, err = json.Marshal(policies); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// decodePolicyDocument decodes a URL-encoded IAM policy document.
+// Documents that are not valid JSON are kept as a JSON string.
+func decodePolicyDocument(doc *string) json.RawMessage {
+	if doc == nil || *doc == "" {
+		return nil
+	}
+	decoded, err := url.QueryUnescape(*doc)
+	if err != nil {
+		decoded = *doc
+	}
+	if json.Valid([]byte(decoded)) {
+		return json.RawMessage(decoded)
+	}
+	raw, _ := json.Marshal(decoded)
+	return raw
+}
+
+func derefStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
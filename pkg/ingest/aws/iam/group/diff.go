@@ -0,0 +1,61 @@
+package group
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+)
+
+// GroupDiff represents changes between old and new group states.
+type GroupDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffGroupData compares old Ent entity and new data.
+func DiffGroupData(old *entiam.BronzeAWSIAMGroup, new *GroupData) *GroupDiff {
+	if old == nil {
+		return &GroupDiff{IsNew: true}
+	}
+
+	return &GroupDiff{
+		IsChanged: old.Arn != new.Arn ||
+			old.GroupName != new.GroupName ||
+			old.Path != new.Path ||
+			!timeEqual(old.CreateDate, new.CreateDate) ||
+			jsonChanged(old.AttachedPoliciesJSON, new.AttachedPoliciesJSON) ||
+			jsonChanged(old.InlinePoliciesJSON, new.InlinePoliciesJSON),
+	}
+}
+
+// HasAnyChange returns true if any part of the group changed.
+func (d *GroupDiff) HasAnyChange() bool {
+	return d.IsNew || d.IsChanged
+}
+
+func timeEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+// jsonChanged compares JSON values semantically, since jsonb does not
+// preserve the formatting or key order of what was written.
+func jsonChanged(old, new json.RawMessage) bool {
+	return !bytes.Equal(normalizeJSON(old), normalizeJSON(new))
+}
+
+func normalizeJSON(raw json.RawMessage) []byte {
+	if len(raw) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return raw
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
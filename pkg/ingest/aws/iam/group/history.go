@@ -0,0 +1,89 @@
+package group
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamgroup"
+)
+
+// HistoryService handles history tracking for IAM groups.
+type HistoryService struct {
+	entClient *entiam.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entiam.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entiam.Tx, data *GroupData) *entiam.BronzeHistoryAWSIAMGroupCreate {
+	create := tx.BronzeHistoryAWSIAMGroup.Create().
+		SetResourceID(data.ResourceID).
+		SetCollectedAt(data.CollectedAt).
+		SetArn(data.Arn).
+		SetGroupName(data.GroupName).
+		SetPath(data.Path).
+		SetAccountID(data.AccountID)
+
+	if data.CreateDate != nil {
+		create.SetCreateDate(*data.CreateDate)
+	}
+	if data.AttachedPoliciesJSON != nil {
+		create.SetAttachedPoliciesJSON(data.AttachedPoliciesJSON)
+	}
+	if data.InlinePoliciesJSON != nil {
+		create.SetInlinePoliciesJSON(data.InlinePoliciesJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new group.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entiam.Tx, data *GroupData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create group history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new history based on diff.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entiam.Tx, old *entiam.BronzeAWSIAMGroup, new *GroupData, diff *GroupDiff, now time.Time) error {
+	if !diff.IsChanged {
+		return nil
+	}
+
+	if err := h.CloseHistory(ctx, tx, old.ID, now); err != nil {
+		return err
+	}
+
+	_, err := h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new group history: %w", err)
+	}
+	return nil
+}
+
+// CloseHistory closes history records for a deleted group.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entiam.Tx, resourceID string, now time.Time) error {
+	_, err := tx.BronzeHistoryAWSIAMGroup.Update().
+		Where(
+			bronzehistoryawsiamgroup.ResourceID(resourceID),
+			bronzehistoryawsiamgroup.ValidToIsNil(),
+		).
+		SetValidTo(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close group history: %w", err)
+	}
+	return nil
+}
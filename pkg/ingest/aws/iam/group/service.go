@@ -0,0 +1,239 @@
+package group
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamgroup"
+)
+
+// Service handles AWS IAM group ingestion.
+type Service struct {
+	client    *Client
+	entClient *entiam.Client
+	history   *HistoryService
+}
+
+// NewService creates a new group ingestion service.
+func NewService(client *Client, entClient *entiam.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestParams contains parameters for group ingestion.
+type IngestParams struct {
+	AccountID string
+}
+
+// IngestResult contains the result of group ingestion.
+type IngestResult struct {
+	AccountID      string
+	GroupCount     int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches groups from AWS and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	// Fetch groups from AWS
+	groups, err := s.client.ListGroups(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+
+	// Convert to data structs
+	groupDataList := make([]*GroupData, 0, len(groups))
+	for _, g := range groups {
+		data, err := ConvertGroup(g, params.AccountID, collectedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert group: %w", err)
+		}
+		groupDataList = append(groupDataList, data)
+	}
+
+	// Save to database
+	if err := s.saveGroups(ctx, groupDataList); err != nil {
+		return nil, fmt.Errorf("failed to save groups: %w", err)
+	}
+
+	return &IngestResult{
+		AccountID:      params.AccountID,
+		GroupCount:     len(groupDataList),
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveGroups saves groups to the database with history tracking.
+func (s *Service) saveGroups(ctx context.Context, groups []*GroupData) error {
+	if len(groups) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, groupData := range groups {
+		// Load existing group
+		existing, err := tx.BronzeAWSIAMGroup.Query().
+			Where(bronzeawsiamgroup.ID(groupData.ResourceID)).
+			First(ctx)
+		if err != nil && !entiam.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("failed to load existing group %s: %w", groupData.ResourceID, err)
+		}
+
+		// Compute diff
+		diff := DiffGroupData(existing, groupData)
+
+		// Skip if no changes
+		if !diff.HasAnyChange() {
+			if err := tx.BronzeAWSIAMGroup.UpdateOneID(groupData.ResourceID).
+				SetCollectedAt(groupData.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update collected_at for group %s: %w", groupData.ResourceID, err)
+			}
+			continue
+		}
+
+		// Create or update group
+		if existing == nil {
+			create := tx.BronzeAWSIAMGroup.Create().
+				SetID(groupData.ResourceID).
+				SetArn(groupData.Arn).
+				SetGroupName(groupData.GroupName).
+				SetPath(groupData.Path).
+				SetAccountID(groupData.AccountID).
+				SetCollectedAt(groupData.CollectedAt).
+				SetFirstCollectedAt(groupData.CollectedAt)
+
+			if groupData.CreateDate != nil {
+				create.SetCreateDate(*groupData.CreateDate)
+			}
+			if groupData.AttachedPoliciesJSON != nil {
+				create.SetAttachedPoliciesJSON(groupData.AttachedPoliciesJSON)
+			}
+			if groupData.InlinePoliciesJSON != nil {
+				create.SetInlinePoliciesJSON(groupData.InlinePoliciesJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create group %s: %w", groupData.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeAWSIAMGroup.UpdateOneID(groupData.ResourceID).
+				SetArn(groupData.Arn).
+				SetGroupName(groupData.GroupName).
+				SetPath(groupData.Path).
+				SetAccountID(groupData.AccountID).
+				SetCollectedAt(groupData.CollectedAt)
+
+			if groupData.CreateDate != nil {
+				update.SetCreateDate(*groupData.CreateDate)
+			} else {
+				update.ClearCreateDate()
+			}
+			if groupData.AttachedPoliciesJSON != nil {
+				update.SetAttachedPoliciesJSON(groupData.AttachedPoliciesJSON)
+			} else {
+				update.ClearAttachedPoliciesJSON()
+			}
+			if groupData.InlinePoliciesJSON != nil {
+				update.SetInlinePoliciesJSON(groupData.InlinePoliciesJSON)
+			} else {
+				update.ClearInlinePoliciesJSON()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update group %s: %w", groupData.ResourceID, err)
+			}
+		}
+
+		// Track history
+		if diff.IsNew {
+			if err := s.history.CreateHistory(ctx, tx, groupData, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create history for group %s: %w", groupData.ResourceID, err)
+			}
+		} else {
+			if err := s.history.UpdateHistory(ctx, tx, existing, groupData, diff, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update history for group %s: %w", groupData.ResourceID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleGroups removes groups that were not collected in the latest run.
+func (s *Service) DeleteStaleGroups(ctx context.Context, accountID string, collectedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	stale, err := tx.BronzeAWSIAMGroup.Query().
+		Where(
+			bronzeawsiamgroup.AccountID(accountID),
+			bronzeawsiamgroup.CollectedAtLT(collectedAt),
+		).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range stale {
+		if err := s.history.CloseHistory(ctx, tx, e.ID, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to close history for group %s: %w", e.ID, err)
+		}
+
+		if err := tx.BronzeAWSIAMGroup.DeleteOne(e).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete group %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
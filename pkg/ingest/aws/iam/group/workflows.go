@@ -0,0 +1,59 @@
+package group
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AWSIAMGroupWorkflowParams contains parameters for the group workflow.
+type AWSIAMGroupWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSIAMGroupWorkflowResult contains the result of the group workflow.
+type AWSIAMGroupWorkflowResult struct {
+	AccountID      string
+	GroupCount     int
+	DurationMillis int64
+}
+
+// AWSIAMGroupWorkflow ingests AWS IAM groups for an account.
+func AWSIAMGroupWorkflow(ctx workflow.Context, params AWSIAMGroupWorkflowParams) (*AWSIAMGroupWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSIAMGroupWorkflow", "accountID", params.AccountID)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestIAMGroupsResult
+	err := workflow.ExecuteActivity(activityCtx, IngestIAMGroupsActivity, IngestIAMGroupsParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest groups", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Completed AWSIAMGroupWorkflow",
+		"accountID", params.AccountID,
+		"groupCount", result.GroupCount,
+	)
+
+	return &AWSIAMGroupWorkflowResult{
+		AccountID:      result.AccountID,
+		GroupCount:     result.GroupCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/awsauth"
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entiam.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entiam.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// createClient creates a rate-limited AWS IAM client for the given account and region.
+func (a *Activities) createClient(ctx context.Context, accountID, region string) (*Client, error) {
+	cfg, err := awsauth.LoadConfig(ctx, a.configService, a.limiter, accountID, region)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(cfg), nil
+}
+
+// IngestIAMPoliciesParams contains parameters for the ingest activity.
+type IngestIAMPoliciesParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestIAMPoliciesResult contains the result of the ingest activity.
+type IngestIAMPoliciesResult struct {
+	AccountID      string
+	PolicyCount    int
+	DurationMillis int64
+}
+
+// IngestIAMPoliciesActivity is the activity function reference for workflow registration.
+var IngestIAMPoliciesActivity = (*Activities).IngestIAMPolicies
+
+// IngestIAMPolicies is a Temporal activity that ingests AWS IAM policies.
+func (a *Activities) IngestIAMPolicies(ctx context.Context, params IngestIAMPoliciesParams) (*IngestIAMPoliciesResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting AWS IAM policy ingestion", "accountID", params.AccountID)
+
+	client, err := a.createClient(ctx, params.AccountID, params.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create client: %w", err)
+	}
+
+	service := NewService(client, a.entClient)
+	result, err := service.Ingest(ctx, IngestParams{
+		AccountID: params.AccountID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to ingest policies: %w", err)
+	}
+
+	// Delete stale policies
+	if err := service.DeleteStalePolicies(ctx, params.AccountID, result.CollectedAt); err != nil {
+		logger.Warn("Failed to delete stale policies", "error", err)
+	}
+
+	logger.Info("Completed AWS IAM policy ingestion",
+		"accountID", params.AccountID,
+		"policyCount", result.PolicyCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestIAMPoliciesResult{
+		AccountID:      result.AccountID,
+		PolicyCount:    result.PolicyCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
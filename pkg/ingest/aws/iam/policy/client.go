@@ -0,0 +1,41 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// Client wraps the AWS IAM API for managed policies.
+type Client struct {
+	iamClient *iam.Client
+}
+
+// NewClient creates a new IAM policy client from an AWS config.
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		iamClient: iam.NewFromConfig(cfg),
+	}
+}
+
+// ListPolicies lists customer managed and AWS managed policies with their
+// versions and documents using GetAccountAuthorizationDetails.
+func (c *Client) ListPolicies(ctx context.Context) ([]types.ManagedPolicyDetail, error) {
+	var policies []types.ManagedPolicyDetail
+
+	paginator := iam.NewGetAccountAuthorizationDetailsPaginator(c.iamClient, &iam.GetAccountAuthorizationDetailsInput{
+		Filter: []types.EntityType{types.EntityTypeLocalManagedPolicy, types.EntityTypeAWSManagedPolicy},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get account authorization details: %w", err)
+		}
+		policies = append(policies, output.Policies...)
+	}
+
+	return policies, nil
+}
@@ -0,0 +1,124 @@
+package policy
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// awsManagedArnPrefix identifies AWS managed policies, e.g. arn:aws:iam::aws:policy/ReadOnlyAccess.
+const awsManagedArnPrefix = ":iam::aws:policy/"
+
+// PolicyData holds converted IAM managed policy data ready for Ent insertion.
+type PolicyData struct {
+	ResourceID                    string
+	Arn                           string
+	PolicyName                    string
+	Path                          string
+	Description                   string
+	DefaultVersionID              string
+	AttachmentCount               int
+	PermissionsBoundaryUsageCount int
+	IsAttachable                  bool
+	AWSManaged                    bool
+	CreateDate                    *time.Time
+	UpdateDate                    *time.Time
+	VersionsJSON                  json.RawMessage
+	AccountID                     string
+	CollectedAt                   time.Time
+}
+
+// PolicyVersion is the JSON structure for policy versions.
+type PolicyVersion struct {
+	VersionID        string          `json:"version_id"`
+	IsDefaultVersion bool            `json:"is_default_version"`
+	CreateDate       *time.Time      `json:"create_date,omitempty"`
+	Document         json.RawMessage `json:"document,omitempty"`
+}
+
+// IsAWSManaged reports whether the policy ARN belongs to an AWS managed policy.
+func IsAWSManaged(arn string) bool {
+	return strings.Contains(arn, awsManagedArnPrefix)
+}
+
+// ConvertPolicy converts an AWS API ManagedPolicyDetail to PolicyData.
+// AWS managed policies keep only their default version: they can have
+// dozens of versions the account has no control over. They also share one
+// policy ID across every account, so their resource ID is prefixed with the
+// account ID to keep one row per account.
+func ConvertPolicy(p types.ManagedPolicyDetail, accountID string, collectedAt time.Time) (*PolicyData, error) {
+	data := &PolicyData{
+		ResourceID:                    derefStr(p.PolicyId),
+		Arn:                           derefStr(p.Arn),
+		PolicyName:                    derefStr(p.PolicyName),
+		Path:                          derefStr(p.Path),
+		Description:                   derefStr(p.Description),
+		DefaultVersionID:              derefStr(p.DefaultVersionId),
+		AttachmentCount:               int(derefInt32(p.AttachmentCount)),
+		PermissionsBoundaryUsageCount: int(derefInt32(p.PermissionsBoundaryUsageCount)),
+		IsAttachable:                  p.IsAttachable,
+		CreateDate:                    p.CreateDate,
+		UpdateDate:                    p.UpdateDate,
+		AccountID:                     accountID,
+		CollectedAt:                   collectedAt,
+	}
+	data.AWSManaged = IsAWSManaged(data.Arn)
+	if data.AWSManaged {
+		data.ResourceID = accountID + ":" + data.ResourceID
+	}
+
+	versions := make([]PolicyVersion, 0, len(p.PolicyVersionList))
+	for _, v := range p.PolicyVersionList {
+		if data.AWSManaged && !v.IsDefaultVersion {
+			continue
+		}
+		versions = append(versions, PolicyVersion{
+			VersionID:        derefStr(v.VersionId),
+			IsDefaultVersion: v.IsDefaultVersion,
+			CreateDate:       v.CreateDate,
+			Document:         decodePolicyDocument(v.Document),
+		})
+	}
+	if len(versions) > 0 {
+		var err error
+		if data.VersionsJSON, err = json.Marshal(versions); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// decodePolicyDocument decodes a URL-encoded IAM policy document.
+// Documents that are not valid JSON are kept as a JSON string.
+func decodePolicyDocument(doc *string) json.RawMessage {
+	if doc == nil || *doc == "" {
+		return nil
+	}
+	decoded, err := url.QueryUnescape(*doc)
+	if err != nil {
+		decoded = *doc
+	}
+	if json.Valid([]byte(decoded)) {
+		return json.RawMessage(decoded)
+	}
+	raw, _ := json.Marshal(decoded)
+	return raw
+}
+
+func derefStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func derefInt32(i *int32) int32 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
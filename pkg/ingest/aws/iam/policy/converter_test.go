@@ -0,0 +1,54 @@
+package policy
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+func TestConvertPolicy(t *testing.T) {
+	collected := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		arn         string
+		wantID      string
+		wantManaged bool
+		wantVers    int
+	}{
+		{"customer managed", "arn:aws:iam::123456789012:policy/app-read", "ANPAEXAMPLE", false, 2},
+		{"aws managed", "arn:aws:iam::aws:policy/ReadOnlyAccess", "123456789012:ANPAEXAMPLE", true, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := types.ManagedPolicyDetail{
+				PolicyId:         aws.String("ANPAEXAMPLE"),
+				Arn:              aws.String(tt.arn),
+				DefaultVersionId: aws.String("v2"),
+				PolicyVersionList: []types.PolicyVersion{
+					{VersionId: aws.String("v1")},
+					{VersionId: aws.String("v2"), IsDefaultVersion: true},
+				},
+			}
+			data, err := ConvertPolicy(p, "123456789012", collected)
+			if err != nil {
+				t.Fatalf("ConvertPolicy: %v", err)
+			}
+			if data.ResourceID != tt.wantID {
+				t.Errorf("ResourceID = %q, want %q", data.ResourceID, tt.wantID)
+			}
+			if data.AWSManaged != tt.wantManaged {
+				t.Errorf("AWSManaged = %v, want %v", data.AWSManaged, tt.wantManaged)
+			}
+			var versions []PolicyVersion
+			if err := json.Unmarshal(data.VersionsJSON, &versions); err != nil {
+				t.Fatalf("unmarshal versions: %v", err)
+			}
+			if len(versions) != tt.wantVers {
+				t.Errorf("versions = %d, want %d", len(versions), tt.wantVers)
+			}
+		})
+	}
+}
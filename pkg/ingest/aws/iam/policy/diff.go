@@ -0,0 +1,67 @@
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+)
+
+// PolicyDiff represents changes between old and new policy states.
+type PolicyDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffPolicyData compares old Ent entity and new data.
+func DiffPolicyData(old *entiam.BronzeAWSIAMPolicy, new *PolicyData) *PolicyDiff {
+	if old == nil {
+		return &PolicyDiff{IsNew: true}
+	}
+
+	return &PolicyDiff{
+		IsChanged: old.Arn != new.Arn ||
+			old.PolicyName != new.PolicyName ||
+			old.Path != new.Path ||
+			old.Description != new.Description ||
+			old.DefaultVersionID != new.DefaultVersionID ||
+			old.AttachmentCount != new.AttachmentCount ||
+			old.PermissionsBoundaryUsageCount != new.PermissionsBoundaryUsageCount ||
+			old.IsAttachable != new.IsAttachable ||
+			old.AWSManaged != new.AWSManaged ||
+			!timeEqual(old.CreateDate, new.CreateDate) ||
+			!timeEqual(old.UpdateDate, new.UpdateDate) ||
+			jsonChanged(old.VersionsJSON, new.VersionsJSON),
+	}
+}
+
+// HasAnyChange returns true if any part of the policy changed.
+func (d *PolicyDiff) HasAnyChange() bool {
+	return d.IsNew || d.IsChanged
+}
+
+func timeEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+// jsonChanged compares JSON values semantically, since jsonb does not
+// preserve the formatting or key order of what was written.
+func jsonChanged(old, new json.RawMessage) bool {
+	return !bytes.Equal(normalizeJSON(old), normalizeJSON(new))
+}
+
+func normalizeJSON(raw json.RawMessage) []byte {
+	if len(raw) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return raw
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
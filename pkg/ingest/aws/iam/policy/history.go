@@ -0,0 +1,95 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiampolicy"
+)
+
+// HistoryService handles history tracking for IAM managed policies.
+type HistoryService struct {
+	entClient *entiam.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entiam.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entiam.Tx, data *PolicyData) *entiam.BronzeHistoryAWSIAMPolicyCreate {
+	create := tx.BronzeHistoryAWSIAMPolicy.Create().
+		SetResourceID(data.ResourceID).
+		SetCollectedAt(data.CollectedAt).
+		SetArn(data.Arn).
+		SetPolicyName(data.PolicyName).
+		SetPath(data.Path).
+		SetDescription(data.Description).
+		SetDefaultVersionID(data.DefaultVersionID).
+		SetAttachmentCount(data.AttachmentCount).
+		SetPermissionsBoundaryUsageCount(data.PermissionsBoundaryUsageCount).
+		SetIsAttachable(data.IsAttachable).
+		SetAWSManaged(data.AWSManaged).
+		SetAccountID(data.AccountID)
+
+	if data.CreateDate != nil {
+		create.SetCreateDate(*data.CreateDate)
+	}
+	if data.UpdateDate != nil {
+		create.SetUpdateDate(*data.UpdateDate)
+	}
+	if data.VersionsJSON != nil {
+		create.SetVersionsJSON(data.VersionsJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new policy.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entiam.Tx, data *PolicyData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create policy history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new history based on diff.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entiam.Tx, old *entiam.BronzeAWSIAMPolicy, new *PolicyData, diff *PolicyDiff, now time.Time) error {
+	if !diff.IsChanged {
+		return nil
+	}
+
+	if err := h.CloseHistory(ctx, tx, old.ID, now); err != nil {
+		return err
+	}
+
+	_, err := h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new policy history: %w", err)
+	}
+	return nil
+}
+
+// CloseHistory closes history records for a deleted policy.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entiam.Tx, resourceID string, now time.Time) error {
+	_, err := tx.BronzeHistoryAWSIAMPolicy.Update().
+		Where(
+			bronzehistoryawsiampolicy.ResourceID(resourceID),
+			bronzehistoryawsiampolicy.ValidToIsNil(),
+		).
+		SetValidTo(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close policy history: %w", err)
+	}
+	return nil
+}
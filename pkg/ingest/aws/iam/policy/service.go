@@ -0,0 +1,256 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiampolicy"
+)
+
+// Service handles AWS IAM policy ingestion.
+type Service struct {
+	client    *Client
+	entClient *entiam.Client
+	history   *HistoryService
+}
+
+// NewService creates a new policy ingestion service.
+func NewService(client *Client, entClient *entiam.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestParams contains parameters for policy ingestion.
+type IngestParams struct {
+	AccountID string
+}
+
+// IngestResult contains the result of policy ingestion.
+type IngestResult struct {
+	AccountID      string
+	PolicyCount    int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches managed policies from AWS and stores them in the bronze layer.
+// AWS managed policies are kept only while attached to a user, group or role,
+// or used as a permissions boundary.
+func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	// Fetch policies from AWS
+	policies, err := s.client.ListPolicies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policies: %w", err)
+	}
+
+	// Convert to data structs
+	policyDataList := make([]*PolicyData, 0, len(policies))
+	for _, p := range policies {
+		data, err := ConvertPolicy(p, params.AccountID, collectedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert policy: %w", err)
+		}
+		if data.AWSManaged && data.AttachmentCount == 0 && data.PermissionsBoundaryUsageCount == 0 {
+			continue
+		}
+		policyDataList = append(policyDataList, data)
+	}
+
+	// Save to database
+	if err := s.savePolicies(ctx, policyDataList); err != nil {
+		return nil, fmt.Errorf("failed to save policies: %w", err)
+	}
+
+	return &IngestResult{
+		AccountID:      params.AccountID,
+		PolicyCount:    len(policyDataList),
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// savePolicies saves policies to the database with history tracking.
+func (s *Service) savePolicies(ctx context.Context, policies []*PolicyData) error {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, policyData := range policies {
+		// Load existing policy
+		existing, err := tx.BronzeAWSIAMPolicy.Query().
+			Where(bronzeawsiampolicy.ID(policyData.ResourceID)).
+			First(ctx)
+		if err != nil && !entiam.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("failed to load existing policy %s: %w", policyData.ResourceID, err)
+		}
+
+		// Compute diff
+		diff := DiffPolicyData(existing, policyData)
+
+		// Skip if no changes
+		if !diff.HasAnyChange() {
+			if err := tx.BronzeAWSIAMPolicy.UpdateOneID(policyData.ResourceID).
+				SetCollectedAt(policyData.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update collected_at for policy %s: %w", policyData.ResourceID, err)
+			}
+			continue
+		}
+
+		// Create or update policy
+		if existing == nil {
+			create := tx.BronzeAWSIAMPolicy.Create().
+				SetID(policyData.ResourceID).
+				SetArn(policyData.Arn).
+				SetPolicyName(policyData.PolicyName).
+				SetPath(policyData.Path).
+				SetDescription(policyData.Description).
+				SetDefaultVersionID(policyData.DefaultVersionID).
+				SetAttachmentCount(policyData.AttachmentCount).
+				SetPermissionsBoundaryUsageCount(policyData.PermissionsBoundaryUsageCount).
+				SetIsAttachable(policyData.IsAttachable).
+				SetAWSManaged(policyData.AWSManaged).
+				SetAccountID(policyData.AccountID).
+				SetCollectedAt(policyData.CollectedAt).
+				SetFirstCollectedAt(policyData.CollectedAt)
+
+			if policyData.CreateDate != nil {
+				create.SetCreateDate(*policyData.CreateDate)
+			}
+			if policyData.UpdateDate != nil {
+				create.SetUpdateDate(*policyData.UpdateDate)
+			}
+			if policyData.VersionsJSON != nil {
+				create.SetVersionsJSON(policyData.VersionsJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create policy %s: %w", policyData.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeAWSIAMPolicy.UpdateOneID(policyData.ResourceID).
+				SetArn(policyData.Arn).
+				SetPolicyName(policyData.PolicyName).
+				SetPath(policyData.Path).
+				SetDescription(policyData.Description).
+				SetDefaultVersionID(policyData.DefaultVersionID).
+				SetAttachmentCount(policyData.AttachmentCount).
+				SetPermissionsBoundaryUsageCount(policyData.PermissionsBoundaryUsageCount).
+				SetIsAttachable(policyData.IsAttachable).
+				SetAWSManaged(policyData.AWSManaged).
+				SetAccountID(policyData.AccountID).
+				SetCollectedAt(policyData.CollectedAt)
+
+			if policyData.CreateDate != nil {
+				update.SetCreateDate(*policyData.CreateDate)
+			} else {
+				update.ClearCreateDate()
+			}
+			if policyData.UpdateDate != nil {
+				update.SetUpdateDate(*policyData.UpdateDate)
+			} else {
+				update.ClearUpdateDate()
+			}
+			if policyData.VersionsJSON != nil {
+				update.SetVersionsJSON(policyData.VersionsJSON)
+			} else {
+				update.ClearVersionsJSON()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update policy %s: %w", policyData.ResourceID, err)
+			}
+		}
+
+		// Track history
+		if diff.IsNew {
+			if err := s.history.CreateHistory(ctx, tx, policyData, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create history for policy %s: %w", policyData.ResourceID, err)
+			}
+		} else {
+			if err := s.history.UpdateHistory(ctx, tx, existing, policyData, diff, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update history for policy %s: %w", policyData.ResourceID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStalePolicies removes policies that were not collected in the latest run.
+func (s *Service) DeleteStalePolicies(ctx context.Context, accountID string, collectedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	stale, err := tx.BronzeAWSIAMPolicy.Query().
+		Where(
+			bronzeawsiampolicy.AccountID(accountID),
+			bronzeawsiampolicy.CollectedAtLT(collectedAt),
+		).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range stale {
+		if err := s.history.CloseHistory(ctx, tx, e.ID, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to close history for policy %s: %w", e.ID, err)
+		}
+
+		if err := tx.BronzeAWSIAMPolicy.DeleteOne(e).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete policy %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package policy
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AWSIAMPolicyWorkflowParams contains parameters for the policy workflow.
+type AWSIAMPolicyWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSIAMPolicyWorkflowResult contains the result of the policy workflow.
+type AWSIAMPolicyWorkflowResult struct {
+	AccountID      string
+	PolicyCount    int
+	DurationMillis int64
+}
+
+// AWSIAMPolicyWorkflow ingests AWS IAM policies for an account.
+func AWSIAMPolicyWorkflow(ctx workflow.Context, params AWSIAMPolicyWorkflowParams) (*AWSIAMPolicyWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSIAMPolicyWorkflow", "accountID", params.AccountID)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestIAMPoliciesResult
+	err := workflow.ExecuteActivity(activityCtx, IngestIAMPoliciesActivity, IngestIAMPoliciesParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest policies", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Completed AWSIAMPolicyWorkflow",
+		"accountID", params.AccountID,
+		"policyCount", result.PolicyCount,
+	)
+
+	return &AWSIAMPolicyWorkflowResult{
+		AccountID:      result.AccountID,
+		PolicyCount:    result.PolicyCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
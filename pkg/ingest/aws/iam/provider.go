@@ -0,0 +1,26 @@
+package iam
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/aws"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider: "aws",
+		Name:     "iam",
+		Scope:    ingest.ScopeGlobal,
+		Register: Register,
+		Workflow: AWSIAMWorkflow,
+		NewParams: func(accountID, region, _ string) any {
+			return AWSIAMWorkflowParams{AccountID: accountID, Region: region}
+		},
+		NewResult: func() any { return &AWSIAMWorkflowResult{} },
+		Aggregate: func(result *aws.AWSInventoryWorkflowResult, _ *aws.RegionResult, child any) {
+			r := child.(*AWSIAMWorkflowResult)
+			result.TotalIAMUsers += r.UserCount
+			result.TotalIAMRoles += r.RoleCount
+			result.TotalAccessKeys += r.AccessKeyCount
+		},
+	})
+}
@@ -0,0 +1,32 @@
+package iam
+
+import (
+	"entgo.io/ent/dialect"
+	"go.temporal.io/sdk/worker"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/ingest/aws/iam/accesskey"
+	"danny.vn/hotpot/pkg/ingest/aws/iam/credentialreport"
+	"danny.vn/hotpot/pkg/ingest/aws/iam/group"
+	"danny.vn/hotpot/pkg/ingest/aws/iam/policy"
+	"danny.vn/hotpot/pkg/ingest/aws/iam/role"
+	"danny.vn/hotpot/pkg/ingest/aws/iam/user"
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+)
+
+// Register registers all IAM activities and workflows.
+func Register(w worker.Worker, configService *config.Service, driver dialect.Driver, limiter ratelimit.Limiter) {
+	entClient := entiam.NewClient(entiam.Driver(driver), entiam.AlternateSchema(entiam.DefaultSchemaConfig()))
+
+	// Register IAM sub-packages
+	user.Register(w, configService, entClient, limiter)
+	group.Register(w, configService, entClient, limiter)
+	role.Register(w, configService, entClient, limiter)
+	policy.Register(w, configService, entClient, limiter)
+	accesskey.Register(w, configService, entClient, limiter)
+	credentialreport.Register(w, configService, entClient, limiter)
+
+	// Register IAM workflow
+	w.RegisterWorkflow(AWSIAMWorkflow)
+}
@@ -0,0 +1,90 @@
+package role
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/awsauth"
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entiam.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entiam.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// createClient creates a rate-limited AWS IAM client for the given account and region.
+func (a *Activities) createClient(ctx context.Context, accountID, region string) (*Client, error) {
+	cfg, err := awsauth.LoadConfig(ctx, a.configService, a.limiter, accountID, region)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(cfg), nil
+}
+
+// IngestIAMRolesParams contains parameters for the ingest activity.
+type IngestIAMRolesParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestIAMRolesResult contains the result of the ingest activity.
+type IngestIAMRolesResult struct {
+	AccountID      string
+	RoleCount      int
+	DurationMillis int64
+}
+
+// IngestIAMRolesActivity is the activity function reference for workflow registration.
+var IngestIAMRolesActivity = (*Activities).IngestIAMRoles
+
+// IngestIAMRoles is a Temporal activity that ingests AWS IAM roles.
+func (a *Activities) IngestIAMRoles(ctx context.Context, params IngestIAMRolesParams) (*IngestIAMRolesResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting AWS IAM role ingestion", "accountID", params.AccountID)
+
+	client, err := a.createClient(ctx, params.AccountID, params.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create client: %w", err)
+	}
+
+	service := NewService(client, a.entClient)
+	result, err := service.Ingest(ctx, IngestParams{
+		AccountID: params.AccountID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to ingest roles: %w", err)
+	}
+
+	// Delete stale roles
+	if err := service.DeleteStaleRoles(ctx, params.AccountID, result.CollectedAt); err != nil {
+		logger.Warn("Failed to delete stale roles", "error", err)
+	}
+
+	logger.Info("Completed AWS IAM role ingestion",
+		"accountID", params.AccountID,
+		"roleCount", result.RoleCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestIAMRolesResult{
+		AccountID:      result.AccountID,
+		RoleCount:      result.RoleCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
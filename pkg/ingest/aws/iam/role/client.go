@@ -0,0 +1,41 @@
+package role
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// Client wraps the AWS IAM API for roles.
+type Client struct {
+	iamClient *iam.Client
+}
+
+// NewClient creates a new IAM role client from an AWS config.
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		iamClient: iam.NewFromConfig(cfg),
+	}
+}
+
+// ListRoles lists all IAM roles with their trust, attached and inline policies
+// using GetAccountAuthorizationDetails.
+func (c *Client) ListRoles(ctx context.Context) ([]types.RoleDetail, error) {
+	var roles []types.RoleDetail
+
+	paginator := iam.NewGetAccountAuthorizationDetailsPaginator(c.iamClient, &iam.GetAccountAuthorizationDetailsInput{
+		Filter: []types.EntityType{types.EntityTypeRole},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get account authorization details: %w", err)
+		}
+		roles = append(roles, output.RoleDetailList...)
+	}
+
+	return roles, nil
+}
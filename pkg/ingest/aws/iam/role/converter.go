@@ -0,0 +1,152 @@
+package role
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// RoleData holds converted IAM role data ready for Ent insertion.
+type RoleData struct {
+	ResourceID             string
+	Arn                    string
+	RoleName               string
+	Path                   string
+	CreateDate             *time.Time
+	RoleLastUsedDate       *time.Time
+	RoleLastUsedRegion     string
+	PermissionsBoundaryArn string
+	AssumeRolePolicyJSON   json.RawMessage
+	AttachedPoliciesJSON   json.RawMessage
+	InlinePoliciesJSON     json.RawMessage
+	InstanceProfilesJSON   json.RawMessage
+	TagsJSON               json.RawMessage
+	AccountID              string
+	CollectedAt            time.Time
+}
+
+// AttachedPolicyRef is the JSON structure for attached managed policies.
+type AttachedPolicyRef struct {
+	PolicyArn  string `json:"policy_arn"`
+	PolicyName string `json:"policy_name"`
+}
+
+// InlinePolicy is the JSON structure for inline policies.
+type InlinePolicy struct {
+	PolicyName     string          `json:"policy_name"`
+	PolicyDocument json.RawMessage `json:"policy_document,omitempty"`
+}
+
+// InstanceProfileRef is the JSON structure for instance profiles.
+type InstanceProfileRef struct {
+	InstanceProfileID   string `json:"instance_profile_id"`
+	InstanceProfileName string `json:"instance_profile_name"`
+	Arn                 string `json:"arn"`
+}
+
+// TagData is the JSON structure for tags.
+type TagData struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ConvertRole converts an AWS API RoleDetail to RoleData.
+func ConvertRole(r types.RoleDetail, accountID string, collectedAt time.Time) (*RoleData, error) {
+	data := &RoleData{
+		ResourceID:           derefStr(r.RoleId),
+		Arn:                  derefStr(r.Arn),
+		RoleName:             derefStr(r.RoleName),
+		Path:                 derefStr(r.Path),
+		CreateDate:           r.CreateDate,
+		AssumeRolePolicyJSON: decodePolicyDocument(r.AssumeRolePolicyDocument),
+		AccountID:            accountID,
+		CollectedAt:          collectedAt,
+	}
+
+	if r.RoleLastUsed != nil {
+		data.RoleLastUsedDate = r.RoleLastUsed.LastUsedDate
+		data.RoleLastUsedRegion = derefStr(r.RoleLastUsed.Region)
+	}
+	if r.PermissionsBoundary != nil {
+		data.PermissionsBoundaryArn = derefStr(r.PermissionsBoundary.PermissionsBoundaryArn)
+	}
+
+	var err error
+	if len(r.AttachedManagedPolicies) > 0 {
+		refs := make([]AttachedPolicyRef, 0, len(r.AttachedManagedPolicies))
+		for _, p := range r.AttachedManagedPolicies {
+			refs = append(refs, AttachedPolicyRef{
+				PolicyArn:  derefStr(p.PolicyArn),
+				PolicyName: derefStr(p.PolicyName),
+			})
+		}
+		if data.AttachedPoliciesJSON, err = json.Marshal(refs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(r.RolePolicyList) > 0 {
+		policies := make([]InlinePolicy, 0, len(r.RolePolicyList))
+		for _, p := range r.RolePolicyList {
+			policies = append(policies, InlinePolicy{
+				PolicyName:     derefStr(p.PolicyName),
+				PolicyDocument: decodePolicyDocument(p.PolicyDocument),
+			})
+		}
+		if data.InlinePoliciesJSON, err = json.Marshal(policies); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(r.InstanceProfileList) > 0 {
+		refs := make([]InstanceProfileRef, 0, len(r.InstanceProfileList))
+		for _, ip := range r.InstanceProfileList {
+			refs = append(refs, InstanceProfileRef{
+				InstanceProfileID:   derefStr(ip.InstanceProfileId),
+				InstanceProfileName: derefStr(ip.InstanceProfileName),
+				Arn:                 derefStr(ip.Arn),
+			})
+		}
+		if data.InstanceProfilesJSON, err = json.Marshal(refs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(r.Tags) > 0 {
+		tags := make([]TagData, 0, len(r.Tags))
+		for _, t := range r.Tags {
+			tags = append(tags, TagData{Key: derefStr(t.Key), Value: derefStr(t.Value)})
+		}
+		if data.TagsJSON, err = json.Marshal(tags); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// decodePolicyDocument decodes a URL-encoded IAM policy document.
+// Documents that are not valid JSON are kept as a JSON string.
+func decodePolicyDocument(doc *string) json.RawMessage {
+	if doc == nil || *doc == "" {
+		return nil
+	}
+	decoded, err := url.QueryUnescape(*doc)
+	if err != nil {
+		decoded = *doc
+	}
+	if json.Valid([]byte(decoded)) {
+		return json.RawMessage(decoded)
+	}
+	raw, _ := json.Marshal(decoded)
+	return raw
+}
+
+func derefStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
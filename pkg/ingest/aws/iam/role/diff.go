@@ -0,0 +1,67 @@
+package role
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+)
+
+// RoleDiff represents changes between old and new role states.
+type RoleDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffRoleData compares old Ent entity and new data.
+func DiffRoleData(old *entiam.BronzeAWSIAMRole, new *RoleData) *RoleDiff {
+	if old == nil {
+		return &RoleDiff{IsNew: true}
+	}
+
+	return &RoleDiff{
+		IsChanged: old.Arn != new.Arn ||
+			old.RoleName != new.RoleName ||
+			old.Path != new.Path ||
+			!timeEqual(old.CreateDate, new.CreateDate) ||
+			!timeEqual(old.RoleLastUsedDate, new.RoleLastUsedDate) ||
+			old.RoleLastUsedRegion != new.RoleLastUsedRegion ||
+			old.PermissionsBoundaryArn != new.PermissionsBoundaryArn ||
+			jsonChanged(old.AssumeRolePolicyJSON, new.AssumeRolePolicyJSON) ||
+			jsonChanged(old.AttachedPoliciesJSON, new.AttachedPoliciesJSON) ||
+			jsonChanged(old.InlinePoliciesJSON, new.InlinePoliciesJSON) ||
+			jsonChanged(old.InstanceProfilesJSON, new.InstanceProfilesJSON) ||
+			jsonChanged(old.TagsJSON, new.TagsJSON),
+	}
+}
+
+// HasAnyChange returns true if any part of the role changed.
+func (d *RoleDiff) HasAnyChange() bool {
+	return d.IsNew || d.IsChanged
+}
+
+func timeEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+// jsonChanged compares JSON values semantically, since jsonb does not
+// preserve the formatting or key order of what was written.
+func jsonChanged(old, new json.RawMessage) bool {
+	return !bytes.Equal(normalizeJSON(old), normalizeJSON(new))
+}
+
+func normalizeJSON(raw json.RawMessage) []byte {
+	if len(raw) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return raw
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
@@ -0,0 +1,103 @@
+package role
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamrole"
+)
+
+// HistoryService handles history tracking for IAM roles.
+type HistoryService struct {
+	entClient *entiam.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entiam.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entiam.Tx, data *RoleData) *entiam.BronzeHistoryAWSIAMRoleCreate {
+	create := tx.BronzeHistoryAWSIAMRole.Create().
+		SetResourceID(data.ResourceID).
+		SetCollectedAt(data.CollectedAt).
+		SetArn(data.Arn).
+		SetRoleName(data.RoleName).
+		SetPath(data.Path).
+		SetRoleLastUsedRegion(data.RoleLastUsedRegion).
+		SetPermissionsBoundaryArn(data.PermissionsBoundaryArn).
+		SetAccountID(data.AccountID)
+
+	if data.CreateDate != nil {
+		create.SetCreateDate(*data.CreateDate)
+	}
+	if data.RoleLastUsedDate != nil {
+		create.SetRoleLastUsedDate(*data.RoleLastUsedDate)
+	}
+	if data.AssumeRolePolicyJSON != nil {
+		create.SetAssumeRolePolicyJSON(data.AssumeRolePolicyJSON)
+	}
+	if data.AttachedPoliciesJSON != nil {
+		create.SetAttachedPoliciesJSON(data.AttachedPoliciesJSON)
+	}
+	if data.InlinePoliciesJSON != nil {
+		create.SetInlinePoliciesJSON(data.InlinePoliciesJSON)
+	}
+	if data.InstanceProfilesJSON != nil {
+		create.SetInstanceProfilesJSON(data.InstanceProfilesJSON)
+	}
+	if data.TagsJSON != nil {
+		create.SetTagsJSON(data.TagsJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new role.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entiam.Tx, data *RoleData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create role history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new history based on diff.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entiam.Tx, old *entiam.BronzeAWSIAMRole, new *RoleData, diff *RoleDiff, now time.Time) error {
+	if !diff.IsChanged {
+		return nil
+	}
+
+	if err := h.CloseHistory(ctx, tx, old.ID, now); err != nil {
+		return err
+	}
+
+	_, err := h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new role history: %w", err)
+	}
+	return nil
+}
+
+// CloseHistory closes history records for a deleted role.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entiam.Tx, resourceID string, now time.Time) error {
+	_, err := tx.BronzeHistoryAWSIAMRole.Update().
+		Where(
+			bronzehistoryawsiamrole.ResourceID(resourceID),
+			bronzehistoryawsiamrole.ValidToIsNil(),
+		).
+		SetValidTo(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close role history: %w", err)
+	}
+	return nil
+}
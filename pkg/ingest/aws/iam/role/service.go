@@ -0,0 +1,275 @@
+package role
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamrole"
+)
+
+// Service handles AWS IAM role ingestion.
+type Service struct {
+	client    *Client
+	entClient *entiam.Client
+	history   *HistoryService
+}
+
+// NewService creates a new role ingestion service.
+func NewService(client *Client, entClient *entiam.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestParams contains parameters for role ingestion.
+type IngestParams struct {
+	AccountID string
+}
+
+// IngestResult contains the result of role ingestion.
+type IngestResult struct {
+	AccountID      string
+	RoleCount      int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches roles from AWS and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	// Fetch roles from AWS
+	roles, err := s.client.ListRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	// Convert to data structs
+	roleDataList := make([]*RoleData, 0, len(roles))
+	for _, r := range roles {
+		data, err := ConvertRole(r, params.AccountID, collectedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert role: %w", err)
+		}
+		roleDataList = append(roleDataList, data)
+	}
+
+	// Save to database
+	if err := s.saveRoles(ctx, roleDataList); err != nil {
+		return nil, fmt.Errorf("failed to save roles: %w", err)
+	}
+
+	return &IngestResult{
+		AccountID:      params.AccountID,
+		RoleCount:      len(roleDataList),
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveRoles saves roles to the database with history tracking.
+func (s *Service) saveRoles(ctx context.Context, roles []*RoleData) error {
+	if len(roles) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, roleData := range roles {
+		// Load existing role
+		existing, err := tx.BronzeAWSIAMRole.Query().
+			Where(bronzeawsiamrole.ID(roleData.ResourceID)).
+			First(ctx)
+		if err != nil && !entiam.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("failed to load existing role %s: %w", roleData.ResourceID, err)
+		}
+
+		// Compute diff
+		diff := DiffRoleData(existing, roleData)
+
+		// Skip if no changes
+		if !diff.HasAnyChange() {
+			if err := tx.BronzeAWSIAMRole.UpdateOneID(roleData.ResourceID).
+				SetCollectedAt(roleData.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update collected_at for role %s: %w", roleData.ResourceID, err)
+			}
+			continue
+		}
+
+		// Create or update role
+		if existing == nil {
+			create := tx.BronzeAWSIAMRole.Create().
+				SetID(roleData.ResourceID).
+				SetArn(roleData.Arn).
+				SetRoleName(roleData.RoleName).
+				SetPath(roleData.Path).
+				SetRoleLastUsedRegion(roleData.RoleLastUsedRegion).
+				SetPermissionsBoundaryArn(roleData.PermissionsBoundaryArn).
+				SetAccountID(roleData.AccountID).
+				SetCollectedAt(roleData.CollectedAt).
+				SetFirstCollectedAt(roleData.CollectedAt)
+
+			if roleData.CreateDate != nil {
+				create.SetCreateDate(*roleData.CreateDate)
+			}
+			if roleData.RoleLastUsedDate != nil {
+				create.SetRoleLastUsedDate(*roleData.RoleLastUsedDate)
+			}
+			if roleData.AssumeRolePolicyJSON != nil {
+				create.SetAssumeRolePolicyJSON(roleData.AssumeRolePolicyJSON)
+			}
+			if roleData.AttachedPoliciesJSON != nil {
+				create.SetAttachedPoliciesJSON(roleData.AttachedPoliciesJSON)
+			}
+			if roleData.InlinePoliciesJSON != nil {
+				create.SetInlinePoliciesJSON(roleData.InlinePoliciesJSON)
+			}
+			if roleData.InstanceProfilesJSON != nil {
+				create.SetInstanceProfilesJSON(roleData.InstanceProfilesJSON)
+			}
+			if roleData.TagsJSON != nil {
+				create.SetTagsJSON(roleData.TagsJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create role %s: %w", roleData.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeAWSIAMRole.UpdateOneID(roleData.ResourceID).
+				SetArn(roleData.Arn).
+				SetRoleName(roleData.RoleName).
+				SetPath(roleData.Path).
+				SetRoleLastUsedRegion(roleData.RoleLastUsedRegion).
+				SetPermissionsBoundaryArn(roleData.PermissionsBoundaryArn).
+				SetAccountID(roleData.AccountID).
+				SetCollectedAt(roleData.CollectedAt)
+
+			if roleData.CreateDate != nil {
+				update.SetCreateDate(*roleData.CreateDate)
+			} else {
+				update.ClearCreateDate()
+			}
+			if roleData.RoleLastUsedDate != nil {
+				update.SetRoleLastUsedDate(*roleData.RoleLastUsedDate)
+			} else {
+				update.ClearRoleLastUsedDate()
+			}
+			if roleData.AssumeRolePolicyJSON != nil {
+				update.SetAssumeRolePolicyJSON(roleData.AssumeRolePolicyJSON)
+			} else {
+				update.ClearAssumeRolePolicyJSON()
+			}
+			if roleData.AttachedPoliciesJSON != nil {
+				update.SetAttachedPoliciesJSON(roleData.AttachedPoliciesJSON)
+			} else {
+				update.ClearAttachedPoliciesJSON()
+			}
+			if roleData.InlinePoliciesJSON != nil {
+				update.SetInlinePoliciesJSON(roleData.InlinePoliciesJSON)
+			} else {
+				update.ClearInlinePoliciesJSON()
+			}
+			if roleData.InstanceProfilesJSON != nil {
+				update.SetInstanceProfilesJSON(roleData.InstanceProfilesJSON)
+			} else {
+				update.ClearInstanceProfilesJSON()
+			}
+			if roleData.TagsJSON != nil {
+				update.SetTagsJSON(roleData.TagsJSON)
+			} else {
+				update.ClearTagsJSON()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update role %s: %w", roleData.ResourceID, err)
+			}
+		}
+
+		// Track history
+		if diff.IsNew {
+			if err := s.history.CreateHistory(ctx, tx, roleData, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create history for role %s: %w", roleData.ResourceID, err)
+			}
+		} else {
+			if err := s.history.UpdateHistory(ctx, tx, existing, roleData, diff, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update history for role %s: %w", roleData.ResourceID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleRoles removes roles that were not collected in the latest run.
+func (s *Service) DeleteStaleRoles(ctx context.Context, accountID string, collectedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	stale, err := tx.BronzeAWSIAMRole.Query().
+		Where(
+			bronzeawsiamrole.AccountID(accountID),
+			bronzeawsiamrole.CollectedAtLT(collectedAt),
+		).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range stale {
+		if err := s.history.CloseHistory(ctx, tx, e.ID, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to close history for role %s: %w", e.ID, err)
+		}
+
+		if err := tx.BronzeAWSIAMRole.DeleteOne(e).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete role %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
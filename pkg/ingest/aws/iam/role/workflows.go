@@ -0,0 +1,59 @@
+package role
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AWSIAMRoleWorkflowParams contains parameters for the role workflow.
+type AWSIAMRoleWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSIAMRoleWorkflowResult contains the result of the role workflow.
+type AWSIAMRoleWorkflowResult struct {
+	AccountID      string
+	RoleCount      int
+	DurationMillis int64
+}
+
+// AWSIAMRoleWorkflow ingests AWS IAM roles for an account.
+func AWSIAMRoleWorkflow(ctx workflow.Context, params AWSIAMRoleWorkflowParams) (*AWSIAMRoleWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSIAMRoleWorkflow", "accountID", params.AccountID)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestIAMRolesResult
+	err := workflow.ExecuteActivity(activityCtx, IngestIAMRolesActivity, IngestIAMRolesParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest roles", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Completed AWSIAMRoleWorkflow",
+		"accountID", params.AccountID,
+		"roleCount", result.RoleCount,
+	)
+
+	return &AWSIAMRoleWorkflowResult{
+		AccountID:      result.AccountID,
+		RoleCount:      result.RoleCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
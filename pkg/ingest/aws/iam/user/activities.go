@@ -0,0 +1,90 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/awsauth"
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entiam.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entiam.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// createClient creates a rate-limited AWS IAM client for the given account and region.
+func (a *Activities) createClient(ctx context.Context, accountID, region string) (*Client, error) {
+	cfg, err := awsauth.LoadConfig(ctx, a.configService, a.limiter, accountID, region)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(cfg), nil
+}
+
+// IngestIAMUsersParams contains parameters for the ingest activity.
+type IngestIAMUsersParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestIAMUsersResult contains the result of the ingest activity.
+type IngestIAMUsersResult struct {
+	AccountID      string
+	UserCount      int
+	DurationMillis int64
+}
+
+// IngestIAMUsersActivity is the activity function reference for workflow registration.
+var IngestIAMUsersActivity = (*Activities).IngestIAMUsers
+
+// IngestIAMUsers is a Temporal activity that ingests AWS IAM users.
+func (a *Activities) IngestIAMUsers(ctx context.Context, params IngestIAMUsersParams) (*IngestIAMUsersResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting AWS IAM user ingestion", "accountID", params.AccountID)
+
+	client, err := a.createClient(ctx, params.AccountID, params.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create client: %w", err)
+	}
+
+	service := NewService(client, a.entClient)
+	result, err := service.Ingest(ctx, IngestParams{
+		AccountID: params.AccountID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to ingest users: %w", err)
+	}
+
+	// Delete stale users
+	if err := service.DeleteStaleUsers(ctx, params.AccountID, result.CollectedAt); err != nil {
+		logger.Warn("Failed to delete stale users", "error", err)
+	}
+
+	logger.Info("Completed AWS IAM user ingestion",
+		"accountID", params.AccountID,
+		"userCount", result.UserCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestIAMUsersResult{
+		AccountID:      result.AccountID,
+		UserCount:      result.UserCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
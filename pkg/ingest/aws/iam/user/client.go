@@ -0,0 +1,59 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// Client wraps the AWS IAM API for users.
+type Client struct {
+	iamClient *iam.Client
+}
+
+// NewClient creates a new IAM user client from an AWS config.
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		iamClient: iam.NewFromConfig(cfg),
+	}
+}
+
+// ListUsers lists all IAM users with their attached and inline policies and
+// group memberships using GetAccountAuthorizationDetails.
+func (c *Client) ListUsers(ctx context.Context) ([]types.UserDetail, error) {
+	var users []types.UserDetail
+
+	paginator := iam.NewGetAccountAuthorizationDetailsPaginator(c.iamClient, &iam.GetAccountAuthorizationDetailsInput{
+		Filter: []types.EntityType{types.EntityTypeUser},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get account authorization details: %w", err)
+		}
+		users = append(users, output.UserDetailList...)
+	}
+
+	return users, nil
+}
+
+// ListMFADevices lists the MFA devices assigned to a user.
+func (c *Client) ListMFADevices(ctx context.Context, userName string) ([]types.MFADevice, error) {
+	var devices []types.MFADevice
+
+	paginator := iam.NewListMFADevicesPaginator(c.iamClient, &iam.ListMFADevicesInput{
+		UserName: aws.String(userName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list MFA devices for %s: %w", userName, err)
+		}
+		devices = append(devices, output.MFADevices...)
+	}
+
+	return devices, nil
+}
@@ -0,0 +1,149 @@
+package user
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// UserData holds converted IAM user data ready for Ent insertion.
+type UserData struct {
+	ResourceID             string
+	Arn                    string
+	UserName               string
+	Path                   string
+	CreateDate             *time.Time
+	PermissionsBoundaryArn string
+	AttachedPoliciesJSON   json.RawMessage
+	InlinePoliciesJSON     json.RawMessage
+	GroupsJSON             json.RawMessage
+	MFADevicesJSON         json.RawMessage
+	TagsJSON               json.RawMessage
+	AccountID              string
+	CollectedAt            time.Time
+}
+
+// AttachedPolicyRef is the JSON structure for attached managed policies.
+type AttachedPolicyRef struct {
+	PolicyArn  string `json:"policy_arn"`
+	PolicyName string `json:"policy_name"`
+}
+
+// InlinePolicy is the JSON structure for inline policies.
+type InlinePolicy struct {
+	PolicyName     string          `json:"policy_name"`
+	PolicyDocument json.RawMessage `json:"policy_document,omitempty"`
+}
+
+// MFADeviceRef is the JSON structure for MFA devices.
+type MFADeviceRef struct {
+	SerialNumber string     `json:"serial_number"`
+	EnableDate   *time.Time `json:"enable_date,omitempty"`
+}
+
+// TagData is the JSON structure for tags.
+type TagData struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ConvertUser converts an AWS API UserDetail and its MFA devices to UserData.
+func ConvertUser(u types.UserDetail, mfaDevices []types.MFADevice, accountID string, collectedAt time.Time) (*UserData, error) {
+	data := &UserData{
+		ResourceID:  derefStr(u.UserId),
+		Arn:         derefStr(u.Arn),
+		UserName:    derefStr(u.UserName),
+		Path:        derefStr(u.Path),
+		CreateDate:  u.CreateDate,
+		AccountID:   accountID,
+		CollectedAt: collectedAt,
+	}
+
+	if u.PermissionsBoundary != nil {
+		data.PermissionsBoundaryArn = derefStr(u.PermissionsBoundary.PermissionsBoundaryArn)
+	}
+
+	var err error
+	if len(u.AttachedManagedPolicies) > 0 {
+		refs := make([]AttachedPolicyRef, 0, len(u.AttachedManagedPolicies))
+		for _, p := range u.AttachedManagedPolicies {
+			refs = append(refs, AttachedPolicyRef{
+				PolicyArn:  derefStr(p.PolicyArn),
+				PolicyName: derefStr(p.PolicyName),
+			})
+		}
+		if data.AttachedPoliciesJSON, err = json.Marshal(refs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(u.UserPolicyList) > 0 {
+		policies := make([]InlinePolicy, 0, len(u.UserPolicyList))
+		for _, p := range u.UserPolicyList {
+			policies = append(policies, InlinePolicy{
+				PolicyName:     derefStr(p.PolicyName),
+				PolicyDocument: decodePolicyDocument(p.PolicyDocument),
+			})
+		}
+		if data.InlinePoliciesJSON, err = json.Marshal(policies); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(u.GroupList) > 0 {
+		if data.GroupsJSON, err = json.Marshal(u.GroupList); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(mfaDevices) > 0 {
+		refs := make([]MFADeviceRef, 0, len(mfaDevices))
+		for _, d := range mfaDevices {
+			refs = append(refs, MFADeviceRef{
+				SerialNumber: derefStr(d.SerialNumber),
+				EnableDate:   d.EnableDate,
+			})
+		}
+		if data.MFADevicesJSON, err = json.Marshal(refs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(u.Tags) > 0 {
+		tags := make([]TagData, 0, len(u.Tags))
+		for _, t := range u.Tags {
+			tags = append(tags, TagData{Key: derefStr(t.Key), Value: derefStr(t.Value)})
+		}
+		if data.TagsJSON, err = json.Marshal(tags); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// decodePolicyDocument decodes a URL-encoded IAM policy document.
+// Documents that are not valid JSON are kept as a JSON string.
+func decodePolicyDocument(doc *string) json.RawMessage {
+	if doc == nil || *doc == "" {
+		return nil
+	}
+	decoded, err := url.QueryUnescape(*doc)
+	if err != nil {
+		decoded = *doc
+	}
+	if json.Valid([]byte(decoded)) {
+		return json.RawMessage(decoded)
+	}
+	raw, _ := json.Marshal(decoded)
+	return raw
+}
+
+func derefStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
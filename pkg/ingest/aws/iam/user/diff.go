@@ -0,0 +1,65 @@
+package user
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+)
+
+// UserDiff represents changes between old and new user states.
+type UserDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffUserData compares old Ent entity and new data.
+func DiffUserData(old *entiam.BronzeAWSIAMUser, new *UserData) *UserDiff {
+	if old == nil {
+		return &UserDiff{IsNew: true}
+	}
+
+	return &UserDiff{
+		IsChanged: old.Arn != new.Arn ||
+			old.UserName != new.UserName ||
+			old.Path != new.Path ||
+			!timeEqual(old.CreateDate, new.CreateDate) ||
+			old.PermissionsBoundaryArn != new.PermissionsBoundaryArn ||
+			jsonChanged(old.AttachedPoliciesJSON, new.AttachedPoliciesJSON) ||
+			jsonChanged(old.InlinePoliciesJSON, new.InlinePoliciesJSON) ||
+			jsonChanged(old.GroupsJSON, new.GroupsJSON) ||
+			jsonChanged(old.MfaDevicesJSON, new.MFADevicesJSON) ||
+			jsonChanged(old.TagsJSON, new.TagsJSON),
+	}
+}
+
+// HasAnyChange returns true if any part of the user changed.
+func (d *UserDiff) HasAnyChange() bool {
+	return d.IsNew || d.IsChanged
+}
+
+func timeEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+// jsonChanged compares JSON values semantically, since jsonb does not
+// preserve the formatting or key order of what was written.
+func jsonChanged(old, new json.RawMessage) bool {
+	return !bytes.Equal(normalizeJSON(old), normalizeJSON(new))
+}
+
+func normalizeJSON(raw json.RawMessage) []byte {
+	if len(raw) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return raw
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
@@ -0,0 +1,99 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamuser"
+)
+
+// HistoryService handles history tracking for IAM users.
+type HistoryService struct {
+	entClient *entiam.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entiam.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entiam.Tx, data *UserData) *entiam.BronzeHistoryAWSIAMUserCreate {
+	create := tx.BronzeHistoryAWSIAMUser.Create().
+		SetResourceID(data.ResourceID).
+		SetCollectedAt(data.CollectedAt).
+		SetArn(data.Arn).
+		SetUserName(data.UserName).
+		SetPath(data.Path).
+		SetPermissionsBoundaryArn(data.PermissionsBoundaryArn).
+		SetAccountID(data.AccountID)
+
+	if data.CreateDate != nil {
+		create.SetCreateDate(*data.CreateDate)
+	}
+	if data.AttachedPoliciesJSON != nil {
+		create.SetAttachedPoliciesJSON(data.AttachedPoliciesJSON)
+	}
+	if data.InlinePoliciesJSON != nil {
+		create.SetInlinePoliciesJSON(data.InlinePoliciesJSON)
+	}
+	if data.GroupsJSON != nil {
+		create.SetGroupsJSON(data.GroupsJSON)
+	}
+	if data.MFADevicesJSON != nil {
+		create.SetMfaDevicesJSON(data.MFADevicesJSON)
+	}
+	if data.TagsJSON != nil {
+		create.SetTagsJSON(data.TagsJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new user.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entiam.Tx, data *UserData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create user history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new history based on diff.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entiam.Tx, old *entiam.BronzeAWSIAMUser, new *UserData, diff *UserDiff, now time.Time) error {
+	if !diff.IsChanged {
+		return nil
+	}
+
+	if err := h.CloseHistory(ctx, tx, old.ID, now); err != nil {
+		return err
+	}
+
+	_, err := h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new user history: %w", err)
+	}
+	return nil
+}
+
+// CloseHistory closes history records for a deleted user.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entiam.Tx, resourceID string, now time.Time) error {
+	_, err := tx.BronzeHistoryAWSIAMUser.Update().
+		Where(
+			bronzehistoryawsiamuser.ResourceID(resourceID),
+			bronzehistoryawsiamuser.ValidToIsNil(),
+		).
+		SetValidTo(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close user history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entiam "danny.vn/hotpot/pkg/storage/ent/aws/iam"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamuser"
+)
+
+// Service handles AWS IAM user ingestion.
+type Service struct {
+	client    *Client
+	entClient *entiam.Client
+	history   *HistoryService
+}
+
+// NewService creates a new user ingestion service.
+func NewService(client *Client, entClient *entiam.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestParams contains parameters for user ingestion.
+type IngestParams struct {
+	AccountID string
+}
+
+// IngestResult contains the result of user ingestion.
+type IngestResult struct {
+	AccountID      string
+	UserCount      int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches users from AWS and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	// Fetch users from AWS
+	users, err := s.client.ListUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	// Fetch MFA devices and convert to data structs
+	userDataList := make([]*UserData, 0, len(users))
+	for _, u := range users {
+		mfaDevices, err := s.client.ListMFADevices(ctx, derefStr(u.UserName))
+		if err != nil {
+			return nil, err
+		}
+		data, err := ConvertUser(u, mfaDevices, params.AccountID, collectedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert user: %w", err)
+		}
+		userDataList = append(userDataList, data)
+	}
+
+	// Save to database
+	if err := s.saveUsers(ctx, userDataList); err != nil {
+		return nil, fmt.Errorf("failed to save users: %w", err)
+	}
+
+	return &IngestResult{
+		AccountID:      params.AccountID,
+		UserCount:      len(userDataList),
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveUsers saves users to the database with history tracking.
+func (s *Service) saveUsers(ctx context.Context, users []*UserData) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, userData := range users {
+		// Load existing user
+		existing, err := tx.BronzeAWSIAMUser.Query().
+			Where(bronzeawsiamuser.ID(userData.ResourceID)).
+			First(ctx)
+		if err != nil && !entiam.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("failed to load existing user %s: %w", userData.ResourceID, err)
+		}
+
+		// Compute diff
+		diff := DiffUserData(existing, userData)
+
+		// Skip if no changes
+		if !diff.HasAnyChange() {
+			if err := tx.BronzeAWSIAMUser.UpdateOneID(userData.ResourceID).
+				SetCollectedAt(userData.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update collected_at for user %s: %w", userData.ResourceID, err)
+			}
+			continue
+		}
+
+		// Create or update user
+		if existing == nil {
+			create := tx.BronzeAWSIAMUser.Create().
+				SetID(userData.ResourceID).
+				SetArn(userData.Arn).
+				SetUserName(userData.UserName).
+				SetPath(userData.Path).
+				SetPermissionsBoundaryArn(userData.PermissionsBoundaryArn).
+				SetAccountID(userData.AccountID).
+				SetCollectedAt(userData.CollectedAt).
+				SetFirstCollectedAt(userData.CollectedAt)
+
+			if userData.CreateDate != nil {
+				create.SetCreateDate(*userData.CreateDate)
+			}
+			if userData.AttachedPoliciesJSON != nil {
+				create.SetAttachedPoliciesJSON(userData.AttachedPoliciesJSON)
+			}
+			if userData.InlinePoliciesJSON != nil {
+				create.SetInlinePoliciesJSON(userData.InlinePoliciesJSON)
+			}
+			if userData.GroupsJSON != nil {
+				create.SetGroupsJSON(userData.GroupsJSON)
+			}
+			if userData.MFADevicesJSON != nil {
+				create.SetMfaDevicesJSON(userData.MFADevicesJSON)
+			}
+			if userData.TagsJSON != nil {
+				create.SetTagsJSON(userData.TagsJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create user %s: %w", userData.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeAWSIAMUser.UpdateOneID(userData.ResourceID).
+				SetArn(userData.Arn).
+				SetUserName(userData.UserName).
+				SetPath(userData.Path).
+				SetPermissionsBoundaryArn(userData.PermissionsBoundaryArn).
+				SetAccountID(userData.AccountID).
+				SetCollectedAt(userData.CollectedAt)
+
+			if userData.CreateDate != nil {
+				update.SetCreateDate(*userData.CreateDate)
+			} else {
+				update.ClearCreateDate()
+			}
+			if userData.AttachedPoliciesJSON != nil {
+				update.SetAttachedPoliciesJSON(userData.AttachedPoliciesJSON)
+			} else {
+				update.ClearAttachedPoliciesJSON()
+			}
+			if userData.InlinePoliciesJSON != nil {
+				update.SetInlinePoliciesJSON(userData.InlinePoliciesJSON)
+			} else {
+				update.ClearInlinePoliciesJSON()
+			}
+			if userData.GroupsJSON != nil {
+				update.SetGroupsJSON(userData.GroupsJSON)
+			} else {
+				update.ClearGroupsJSON()
+			}
+			if userData.MFADevicesJSON != nil {
+				update.SetMfaDevicesJSON(userData.MFADevicesJSON)
+			} else {
+				update.ClearMfaDevicesJSON()
+			}
+			if userData.TagsJSON != nil {
+				update.SetTagsJSON(userData.TagsJSON)
+			} else {
+				update.ClearTagsJSON()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update user %s: %w", userData.ResourceID, err)
+			}
+		}
+
+		// Track history
+		if diff.IsNew {
+			if err := s.history.CreateHistory(ctx, tx, userData, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create history for user %s: %w", userData.ResourceID, err)
+			}
+		} else {
+			if err := s.history.UpdateHistory(ctx, tx, existing, userData, diff, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update history for user %s: %w", userData.ResourceID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleUsers removes users that were not collected in the latest run.
+func (s *Service) DeleteStaleUsers(ctx context.Context, accountID string, collectedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	stale, err := tx.BronzeAWSIAMUser.Query().
+		Where(
+			bronzeawsiamuser.AccountID(accountID),
+			bronzeawsiamuser.CollectedAtLT(collectedAt),
+		).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range stale {
+		if err := s.history.CloseHistory(ctx, tx, e.ID, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to close history for user %s: %w", e.ID, err)
+		}
+
+		if err := tx.BronzeAWSIAMUser.DeleteOne(e).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete user %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
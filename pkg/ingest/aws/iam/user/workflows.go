@@ -0,0 +1,59 @@
+package user
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AWSIAMUserWorkflowParams contains parameters for the user workflow.
+type AWSIAMUserWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSIAMUserWorkflowResult contains the result of the user workflow.
+type AWSIAMUserWorkflowResult struct {
+	AccountID      string
+	UserCount      int
+	DurationMillis int64
+}
+
+// AWSIAMUserWorkflow ingests AWS IAM users for an account.
+func AWSIAMUserWorkflow(ctx workflow.Context, params AWSIAMUserWorkflowParams) (*AWSIAMUserWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSIAMUserWorkflow", "accountID", params.AccountID)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestIAMUsersResult
+	err := workflow.ExecuteActivity(activityCtx, IngestIAMUsersActivity, IngestIAMUsersParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest users", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Completed AWSIAMUserWorkflow",
+		"accountID", params.AccountID,
+		"userCount", result.UserCount,
+	)
+
+	return &AWSIAMUserWorkflowResult{
+		AccountID:      result.AccountID,
+		UserCount:      result.UserCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
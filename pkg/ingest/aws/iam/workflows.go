@@ -0,0 +1,137 @@
+package iam
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/ingest/aws/iam/accesskey"
+	"danny.vn/hotpot/pkg/ingest/aws/iam/credentialreport"
+	"danny.vn/hotpot/pkg/ingest/aws/iam/group"
+	"danny.vn/hotpot/pkg/ingest/aws/iam/policy"
+	"danny.vn/hotpot/pkg/ingest/aws/iam/role"
+	"danny.vn/hotpot/pkg/ingest/aws/iam/user"
+)
+
+// AWSIAMWorkflowParams contains parameters for the IAM workflow.
+type AWSIAMWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSIAMWorkflowResult contains the result of the IAM workflow.
+type AWSIAMWorkflowResult struct {
+	AccountID             string
+	UserCount             int
+	GroupCount            int
+	RoleCount             int
+	PolicyCount           int
+	AccessKeyCount        int
+	CredentialReportCount int
+}
+
+// AWSIAMWorkflow ingests all IAM resources for a single account. IAM is a
+// global service, so Region only selects the API endpoint.
+func AWSIAMWorkflow(ctx workflow.Context, params AWSIAMWorkflowParams) (*AWSIAMWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSIAMWorkflow", "accountID", params.AccountID)
+
+	// Child workflow options
+	childOpts := workflow.ChildWorkflowOptions{
+		WorkflowExecutionTimeout: 30 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	ctx = workflow.WithChildOptions(ctx, childOpts)
+
+	result := &AWSIAMWorkflowResult{AccountID: params.AccountID}
+
+	// Execute IAM User workflow
+	var userResult user.AWSIAMUserWorkflowResult
+	err := workflow.ExecuteChildWorkflow(ctx, user.AWSIAMUserWorkflow, user.AWSIAMUserWorkflowParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &userResult)
+	if err != nil {
+		logger.Error("Failed to execute AWSIAMUserWorkflow", "error", err)
+		return nil, err
+	}
+	result.UserCount = userResult.UserCount
+
+	// Execute IAM Group workflow
+	var groupResult group.AWSIAMGroupWorkflowResult
+	err = workflow.ExecuteChildWorkflow(ctx, group.AWSIAMGroupWorkflow, group.AWSIAMGroupWorkflowParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &groupResult)
+	if err != nil {
+		logger.Error("Failed to execute AWSIAMGroupWorkflow", "error", err)
+		return nil, err
+	}
+	result.GroupCount = groupResult.GroupCount
+
+	// Execute IAM Role workflow
+	var roleResult role.AWSIAMRoleWorkflowResult
+	err = workflow.ExecuteChildWorkflow(ctx, role.AWSIAMRoleWorkflow, role.AWSIAMRoleWorkflowParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &roleResult)
+	if err != nil {
+		logger.Error("Failed to execute AWSIAMRoleWorkflow", "error", err)
+		return nil, err
+	}
+	result.RoleCount = roleResult.RoleCount
+
+	// Execute IAM Policy workflow
+	var policyResult policy.AWSIAMPolicyWorkflowResult
+	err = workflow.ExecuteChildWorkflow(ctx, policy.AWSIAMPolicyWorkflow, policy.AWSIAMPolicyWorkflowParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &policyResult)
+	if err != nil {
+		logger.Error("Failed to execute AWSIAMPolicyWorkflow", "error", err)
+		return nil, err
+	}
+	result.PolicyCount = policyResult.PolicyCount
+
+	// Execute IAM AccessKey workflow
+	var accesskeyResult accesskey.AWSIAMAccessKeyWorkflowResult
+	err = workflow.ExecuteChildWorkflow(ctx, accesskey.AWSIAMAccessKeyWorkflow, accesskey.AWSIAMAccessKeyWorkflowParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &accesskeyResult)
+	if err != nil {
+		logger.Error("Failed to execute AWSIAMAccessKeyWorkflow", "error", err)
+		return nil, err
+	}
+	result.AccessKeyCount = accesskeyResult.AccessKeyCount
+
+	// Execute IAM CredentialReport workflow
+	var credentialreportResult credentialreport.AWSIAMCredentialReportWorkflowResult
+	err = workflow.ExecuteChildWorkflow(ctx, credentialreport.AWSIAMCredentialReportWorkflow, credentialreport.AWSIAMCredentialReportWorkflowParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &credentialreportResult)
+	if err != nil {
+		logger.Error("Failed to execute AWSIAMCredentialReportWorkflow", "error", err)
+		return nil, err
+	}
+	result.CredentialReportCount = credentialreportResult.CredentialReportCount
+
+	logger.Info("Completed AWSIAMWorkflow",
+		"accountID", params.AccountID,
+		"userCount", result.UserCount,
+		"groupCount", result.GroupCount,
+		"roleCount", result.RoleCount,
+		"policyCount", result.PolicyCount,
+		"accessKeyCount", result.AccessKeyCount,
+		"credentialReportCount", result.CredentialReportCount,
+	)
+
+	return result, nil
+}
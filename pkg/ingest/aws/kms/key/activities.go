@@ -0,0 +1,97 @@
+package key
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/awsauth"
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	entkms "danny.vn/hotpot/pkg/storage/ent/aws/kms"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entkms.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entkms.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// createClient creates a rate-limited AWS KMS client for the given account and region.
+func (a *Activities) createClient(ctx context.Context, accountID, region string) (*Client, error) {
+	cfg, err := awsauth.LoadConfig(ctx, a.configService, a.limiter, accountID, region)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(cfg), nil
+}
+
+// IngestKMSKeysParams contains parameters for the ingest activity.
+type IngestKMSKeysParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestKMSKeysResult contains the result of the ingest activity.
+type IngestKMSKeysResult struct {
+	AccountID      string
+	Region         string
+	KeyCount       int
+	DurationMillis int64
+}
+
+// IngestKMSKeysActivity is the activity function reference for workflow registration.
+var IngestKMSKeysActivity = (*Activities).IngestKMSKeys
+
+// IngestKMSKeys is a Temporal activity that ingests AWS KMS keys.
+func (a *Activities) IngestKMSKeys(ctx context.Context, params IngestKMSKeysParams) (*IngestKMSKeysResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting AWS KMS key ingestion",
+		"accountID", params.AccountID,
+		"region", params.Region,
+	)
+
+	client, err := a.createClient(ctx, params.AccountID, params.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create client: %w", err)
+	}
+
+	service := NewService(client, a.entClient)
+	result, err := service.Ingest(ctx, IngestParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to ingest KMS keys: %w", err)
+	}
+
+	// Delete stale KMS keys
+	if err := service.DeleteStaleKeys(ctx, params.AccountID, params.Region, result.CollectedAt); err != nil {
+		logger.Warn("Failed to delete stale KMS keys", "error", err)
+	}
+
+	logger.Info("Completed AWS KMS key ingestion",
+		"accountID", params.AccountID,
+		"region", params.Region,
+		"keyCount", result.KeyCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestKMSKeysResult{
+		AccountID:      result.AccountID,
+		Region:         result.Region,
+		KeyCount:       result.KeyCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,125 @@
+package key
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+)
+
+// Client wraps the AWS KMS API for keys.
+type Client struct {
+	kmsClient *kms.Client
+}
+
+// NewClient creates a new KMS key client from an AWS config.
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		kmsClient: kms.NewFromConfig(cfg),
+	}
+}
+
+// KeyDetails holds a key's metadata together with its rotation status,
+// policy and tags.
+type KeyDetails struct {
+	Metadata             *types.KeyMetadata
+	RotationEnabled      *bool
+	RotationPeriodInDays *int32
+	NextRotationDate     *time.Time
+	Policy               *string
+	Tags                 []types.Tag
+}
+
+// ListKeys lists all KMS keys in the configured region using pagination.
+func (c *Client) ListKeys(ctx context.Context) ([]types.KeyListEntry, error) {
+	var keys []types.KeyListEntry
+
+	paginator := kms.NewListKeysPaginator(c.kmsClient, &kms.ListKeysInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list keys: %w", err)
+		}
+		keys = append(keys, output.Keys...)
+	}
+
+	return keys, nil
+}
+
+// ListAliases lists all aliases in the configured region and groups the
+// alias names by target key ID. Aliases not pointing at a key are skipped.
+func (c *Client) ListAliases(ctx context.Context) (map[string][]string, error) {
+	aliases := make(map[string][]string)
+
+	paginator := kms.NewListAliasesPaginator(c.kmsClient, &kms.ListAliasesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list aliases: %w", err)
+		}
+		for _, a := range output.Aliases {
+			if a.TargetKeyId == nil {
+				continue
+			}
+			aliases[*a.TargetKeyId] = append(aliases[*a.TargetKeyId], aws.ToString(a.AliasName))
+		}
+	}
+
+	return aliases, nil
+}
+
+// GetKeyDetails fetches metadata, rotation status, the default key policy
+// and tags for a key. Rotation status is left nil for keys that do not
+// support automatic rotation, and tags are only read for customer managed keys.
+func (c *Client) GetKeyDetails(ctx context.Context, keyID string) (*KeyDetails, error) {
+	described, err := c.kmsClient.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: aws.String(keyID)})
+	if err != nil {
+		return nil, fmt.Errorf("describe key %s: %w", keyID, err)
+	}
+	details := &KeyDetails{Metadata: described.KeyMetadata}
+
+	rotation, err := c.kmsClient.GetKeyRotationStatus(ctx, &kms.GetKeyRotationStatusInput{KeyId: aws.String(keyID)})
+	if err != nil && !isRotationUnsupported(err) {
+		return nil, fmt.Errorf("get key rotation status for %s: %w", keyID, err)
+	}
+	if err == nil {
+		details.RotationEnabled = aws.Bool(rotation.KeyRotationEnabled)
+		details.RotationPeriodInDays = rotation.RotationPeriodInDays
+		details.NextRotationDate = rotation.NextRotationDate
+	}
+
+	policy, err := c.kmsClient.GetKeyPolicy(ctx, &kms.GetKeyPolicyInput{
+		KeyId:      aws.String(keyID),
+		PolicyName: aws.String("default"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get key policy for %s: %w", keyID, err)
+	}
+	details.Policy = policy.Policy
+
+	if details.Metadata.KeyManager == types.KeyManagerTypeCustomer {
+		paginator := kms.NewListResourceTagsPaginator(c.kmsClient, &kms.ListResourceTagsInput{KeyId: aws.String(keyID)})
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("list resource tags for %s: %w", keyID, err)
+			}
+			details.Tags = append(details.Tags, output.Tags...)
+		}
+	}
+
+	return details, nil
+}
+
+// isRotationUnsupported reports whether GetKeyRotationStatus failed because
+// the key cannot be rotated: asymmetric, HMAC, imported or custom key store
+// keys, and keys pending deletion or import.
+func isRotationUnsupported(err error) bool {
+	var unsupported *types.UnsupportedOperationException
+	var invalidState *types.KMSInvalidStateException
+	return errors.As(err, &unsupported) || errors.As(err, &invalidState)
+}
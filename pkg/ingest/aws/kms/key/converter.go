@@ -0,0 +1,109 @@
+package key
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// KeyData holds converted KMS key data ready for Ent insertion.
+type KeyData struct {
+	ResourceID           string
+	Arn                  string
+	Description          string
+	KeyState             string
+	KeyUsage             string
+	KeySpec              string
+	KeyManager           string
+	Origin               string
+	Enabled              bool
+	MultiRegion          bool
+	CreationDate         *time.Time
+	DeletionDate         *time.Time
+	RotationEnabled      *bool
+	RotationPeriodInDays *int32
+	NextRotationDate     *time.Time
+	PolicyJSON           json.RawMessage
+	AliasesJSON          json.RawMessage
+	TagsJSON             json.RawMessage
+	AccountID            string
+	Region               string
+	CollectedAt          time.Time
+}
+
+// TagData is the JSON structure for tags.
+type TagData struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ConvertKey converts key details fetched from the KMS API to KeyData.
+func ConvertKey(d *KeyDetails, aliases []string, accountID, region string, collectedAt time.Time) (*KeyData, error) {
+	m := d.Metadata
+	data := &KeyData{
+		ResourceID:           derefStr(m.KeyId),
+		Arn:                  derefStr(m.Arn),
+		Description:          derefStr(m.Description),
+		KeyState:             string(m.KeyState),
+		KeyUsage:             string(m.KeyUsage),
+		KeySpec:              string(m.KeySpec),
+		KeyManager:           string(m.KeyManager),
+		Origin:               string(m.Origin),
+		Enabled:              m.Enabled,
+		MultiRegion:          derefBool(m.MultiRegion),
+		CreationDate:         m.CreationDate,
+		DeletionDate:         m.DeletionDate,
+		RotationEnabled:      d.RotationEnabled,
+		RotationPeriodInDays: d.RotationPeriodInDays,
+		NextRotationDate:     d.NextRotationDate,
+		PolicyJSON:           policyDocument(d.Policy),
+		AccountID:            accountID,
+		Region:               region,
+		CollectedAt:          collectedAt,
+	}
+
+	var err error
+	if len(aliases) > 0 {
+		if data.AliasesJSON, err = json.Marshal(aliases); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(d.Tags) > 0 {
+		tags := make([]TagData, 0, len(d.Tags))
+		for _, t := range d.Tags {
+			tags = append(tags, TagData{Key: derefStr(t.TagKey), Value: derefStr(t.TagValue)})
+		}
+		if data.TagsJSON, err = json.Marshal(tags); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// policyDocument returns a key policy as JSON. Documents that are not valid
+// JSON are kept as a JSON string.
+func policyDocument(doc *string) json.RawMessage {
+	if doc == nil || *doc == "" {
+		return nil
+	}
+	if json.Valid([]byte(*doc)) {
+		return json.RawMessage(*doc)
+	}
+	raw, _ := json.Marshal(*doc)
+	return raw
+}
+
+func derefStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func derefBool(b *bool) bool {
+	if b == nil {
+		return false
+	}
+	return *b
+}
@@ -0,0 +1,86 @@
+package key
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	entkms "danny.vn/hotpot/pkg/storage/ent/aws/kms"
+)
+
+// KeyDiff represents changes between old and new KMS key states.
+type KeyDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffKeyData compares old Ent entity and new data.
+func DiffKeyData(old *entkms.BronzeAWSKMSKey, new *KeyData) *KeyDiff {
+	if old == nil {
+		return &KeyDiff{IsNew: true}
+	}
+
+	return &KeyDiff{
+		IsChanged: old.Arn != new.Arn ||
+			old.Description != new.Description ||
+			old.KeyState != new.KeyState ||
+			old.KeyUsage != new.KeyUsage ||
+			old.KeySpec != new.KeySpec ||
+			old.KeyManager != new.KeyManager ||
+			old.Origin != new.Origin ||
+			old.Enabled != new.Enabled ||
+			old.MultiRegion != new.MultiRegion ||
+			!timeEqual(old.CreationDate, new.CreationDate) ||
+			!timeEqual(old.DeletionDate, new.DeletionDate) ||
+			!boolEqual(old.RotationEnabled, new.RotationEnabled) ||
+			!int32Equal(old.RotationPeriodInDays, new.RotationPeriodInDays) ||
+			!timeEqual(old.NextRotationDate, new.NextRotationDate) ||
+			jsonChanged(old.PolicyJSON, new.PolicyJSON) ||
+			jsonChanged(old.AliasesJSON, new.AliasesJSON) ||
+			jsonChanged(old.TagsJSON, new.TagsJSON),
+	}
+}
+
+// HasAnyChange returns true if any part of the KMS key changed.
+func (d *KeyDiff) HasAnyChange() bool {
+	return d.IsNew || d.IsChanged
+}
+
+func timeEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+func int32Equal(a, b *int32) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func boolEqual(a, b *bool) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// jsonChanged compares JSON values semantically, since jsonb does not
+// preserve the formatting or key order of what was written.
+func jsonChanged(old, new json.RawMessage) bool {
+	return !bytes.Equal(normalizeJSON(old), normalizeJSON(new))
+}
+
+func normalizeJSON(raw json.RawMessage) []byte {
+	if len(raw) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return raw
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
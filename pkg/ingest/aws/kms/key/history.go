@@ -0,0 +1,111 @@
+package key
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entkms "danny.vn/hotpot/pkg/storage/ent/aws/kms"
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/bronzehistoryawskmskey"
+)
+
+// HistoryService handles history tracking for KMS keys.
+type HistoryService struct {
+	entClient *entkms.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entkms.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entkms.Tx, data *KeyData) *entkms.BronzeHistoryAWSKMSKeyCreate {
+	create := tx.BronzeHistoryAWSKMSKey.Create().
+		SetResourceID(data.ResourceID).
+		SetCollectedAt(data.CollectedAt).
+		SetArn(data.Arn).
+		SetDescription(data.Description).
+		SetKeyState(data.KeyState).
+		SetKeyUsage(data.KeyUsage).
+		SetKeySpec(data.KeySpec).
+		SetKeyManager(data.KeyManager).
+		SetOrigin(data.Origin).
+		SetEnabled(data.Enabled).
+		SetMultiRegion(data.MultiRegion).
+		SetAccountID(data.AccountID).
+		SetRegion(data.Region)
+
+	if data.CreationDate != nil {
+		create.SetCreationDate(*data.CreationDate)
+	}
+	if data.DeletionDate != nil {
+		create.SetDeletionDate(*data.DeletionDate)
+	}
+	if data.RotationEnabled != nil {
+		create.SetRotationEnabled(*data.RotationEnabled)
+	}
+	if data.RotationPeriodInDays != nil {
+		create.SetRotationPeriodInDays(*data.RotationPeriodInDays)
+	}
+	if data.NextRotationDate != nil {
+		create.SetNextRotationDate(*data.NextRotationDate)
+	}
+	if data.PolicyJSON != nil {
+		create.SetPolicyJSON(data.PolicyJSON)
+	}
+	if data.AliasesJSON != nil {
+		create.SetAliasesJSON(data.AliasesJSON)
+	}
+	if data.TagsJSON != nil {
+		create.SetTagsJSON(data.TagsJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new KMS key.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entkms.Tx, data *KeyData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create KMS key history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new history based on diff.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entkms.Tx, old *entkms.BronzeAWSKMSKey, new *KeyData, diff *KeyDiff, now time.Time) error {
+	if !diff.IsChanged {
+		return nil
+	}
+
+	if err := h.CloseHistory(ctx, tx, old.ID, now); err != nil {
+		return err
+	}
+
+	_, err := h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new KMS key history: %w", err)
+	}
+	return nil
+}
+
+// CloseHistory closes history records for a deleted KMS key.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entkms.Tx, resourceID string, now time.Time) error {
+	_, err := tx.BronzeHistoryAWSKMSKey.Update().
+		Where(
+			bronzehistoryawskmskey.ResourceID(resourceID),
+			bronzehistoryawskmskey.ValidToIsNil(),
+		).
+		SetValidTo(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close KMS key history: %w", err)
+	}
+	return nil
+}
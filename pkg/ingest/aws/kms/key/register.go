@@ -0,0 +1,18 @@
+package key
+
+import (
+	"go.temporal.io/sdk/worker"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	entkms "danny.vn/hotpot/pkg/storage/ent/aws/kms"
+)
+
+// Register registers KMS key activities and workflows with the Temporal worker.
+func Register(w worker.Worker, configService *config.Service, entClient *entkms.Client, limiter ratelimit.Limiter) {
+	activities := NewActivities(configService, entClient, limiter)
+
+	w.RegisterActivity(activities.IngestKMSKeys)
+
+	w.RegisterWorkflow(AWSKMSKeyWorkflow)
+}
@@ -0,0 +1,306 @@
+package key
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entkms "danny.vn/hotpot/pkg/storage/ent/aws/kms"
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/bronzeawskmskey"
+)
+
+// Service handles AWS KMS key ingestion.
+type Service struct {
+	client    *Client
+	entClient *entkms.Client
+	history   *HistoryService
+}
+
+// NewService creates a new KMS key ingestion service.
+func NewService(client *Client, entClient *entkms.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestParams contains parameters for KMS key ingestion.
+type IngestParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestResult contains the result of KMS key ingestion.
+type IngestResult struct {
+	AccountID      string
+	Region         string
+	KeyCount       int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches KMS keys from AWS and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	// Fetch keys and aliases from AWS
+	keys, err := s.client.ListKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list KMS keys: %w", err)
+	}
+	aliases, err := s.client.ListAliases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list KMS aliases: %w", err)
+	}
+
+	// Fetch key details and convert to data structs
+	dataList := make([]*KeyData, 0, len(keys))
+	for _, k := range keys {
+		keyID := derefStr(k.KeyId)
+		details, err := s.client.GetKeyDetails(ctx, keyID)
+		if err != nil {
+			return nil, err
+		}
+		data, err := ConvertKey(details, aliases[keyID], params.AccountID, params.Region, collectedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert KMS key: %w", err)
+		}
+		dataList = append(dataList, data)
+	}
+
+	// Save to database
+	if err := s.saveKeys(ctx, dataList); err != nil {
+		return nil, fmt.Errorf("failed to save KMS keys: %w", err)
+	}
+
+	return &IngestResult{
+		AccountID:      params.AccountID,
+		Region:         params.Region,
+		KeyCount:       len(dataList),
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveKeys saves KMS keys to the database with history tracking.
+func (s *Service) saveKeys(ctx context.Context, keys []*KeyData) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, keyData := range keys {
+		// Load existing KMS key
+		existing, err := tx.BronzeAWSKMSKey.Query().
+			Where(bronzeawskmskey.ID(keyData.ResourceID)).
+			First(ctx)
+		if err != nil && !entkms.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("failed to load existing KMS key %s: %w", keyData.ResourceID, err)
+		}
+
+		// Compute diff
+		diff := DiffKeyData(existing, keyData)
+
+		// Skip if no changes
+		if !diff.HasAnyChange() {
+			if err := tx.BronzeAWSKMSKey.UpdateOneID(keyData.ResourceID).
+				SetCollectedAt(keyData.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update collected_at for KMS key %s: %w", keyData.ResourceID, err)
+			}
+			continue
+		}
+
+		// Create or update KMS key
+		if existing == nil {
+			create := tx.BronzeAWSKMSKey.Create().
+				SetID(keyData.ResourceID).
+				SetArn(keyData.Arn).
+				SetDescription(keyData.Description).
+				SetKeyState(keyData.KeyState).
+				SetKeyUsage(keyData.KeyUsage).
+				SetKeySpec(keyData.KeySpec).
+				SetKeyManager(keyData.KeyManager).
+				SetOrigin(keyData.Origin).
+				SetEnabled(keyData.Enabled).
+				SetMultiRegion(keyData.MultiRegion).
+				SetAccountID(keyData.AccountID).
+				SetRegion(keyData.Region).
+				SetCollectedAt(keyData.CollectedAt).
+				SetFirstCollectedAt(keyData.CollectedAt)
+
+			if keyData.CreationDate != nil {
+				create.SetCreationDate(*keyData.CreationDate)
+			}
+			if keyData.DeletionDate != nil {
+				create.SetDeletionDate(*keyData.DeletionDate)
+			}
+			if keyData.RotationEnabled != nil {
+				create.SetRotationEnabled(*keyData.RotationEnabled)
+			}
+			if keyData.RotationPeriodInDays != nil {
+				create.SetRotationPeriodInDays(*keyData.RotationPeriodInDays)
+			}
+			if keyData.NextRotationDate != nil {
+				create.SetNextRotationDate(*keyData.NextRotationDate)
+			}
+			if keyData.PolicyJSON != nil {
+				create.SetPolicyJSON(keyData.PolicyJSON)
+			}
+			if keyData.AliasesJSON != nil {
+				create.SetAliasesJSON(keyData.AliasesJSON)
+			}
+			if keyData.TagsJSON != nil {
+				create.SetTagsJSON(keyData.TagsJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create KMS key %s: %w", keyData.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeAWSKMSKey.UpdateOneID(keyData.ResourceID).
+				SetArn(keyData.Arn).
+				SetDescription(keyData.Description).
+				SetKeyState(keyData.KeyState).
+				SetKeyUsage(keyData.KeyUsage).
+				SetKeySpec(keyData.KeySpec).
+				SetKeyManager(keyData.KeyManager).
+				SetOrigin(keyData.Origin).
+				SetEnabled(keyData.Enabled).
+				SetMultiRegion(keyData.MultiRegion).
+				SetAccountID(keyData.AccountID).
+				SetRegion(keyData.Region).
+				SetCollectedAt(keyData.CollectedAt)
+
+			if keyData.CreationDate != nil {
+				update.SetCreationDate(*keyData.CreationDate)
+			} else {
+				update.ClearCreationDate()
+			}
+			if keyData.DeletionDate != nil {
+				update.SetDeletionDate(*keyData.DeletionDate)
+			} else {
+				update.ClearDeletionDate()
+			}
+			if keyData.RotationEnabled != nil {
+				update.SetRotationEnabled(*keyData.RotationEnabled)
+			} else {
+				update.ClearRotationEnabled()
+			}
+			if keyData.RotationPeriodInDays != nil {
+				update.SetRotationPeriodInDays(*keyData.RotationPeriodInDays)
+			} else {
+				update.ClearRotationPeriodInDays()
+			}
+			if keyData.NextRotationDate != nil {
+				update.SetNextRotationDate(*keyData.NextRotationDate)
+			} else {
+				update.ClearNextRotationDate()
+			}
+			if keyData.PolicyJSON != nil {
+				update.SetPolicyJSON(keyData.PolicyJSON)
+			} else {
+				update.ClearPolicyJSON()
+			}
+			if keyData.AliasesJSON != nil {
+				update.SetAliasesJSON(keyData.AliasesJSON)
+			} else {
+				update.ClearAliasesJSON()
+			}
+			if keyData.TagsJSON != nil {
+				update.SetTagsJSON(keyData.TagsJSON)
+			} else {
+				update.ClearTagsJSON()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update KMS key %s: %w", keyData.ResourceID, err)
+			}
+		}
+
+		// Track history
+		if diff.IsNew {
+			if err := s.history.CreateHistory(ctx, tx, keyData, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create history for KMS key %s: %w", keyData.ResourceID, err)
+			}
+		} else {
+			if err := s.history.UpdateHistory(ctx, tx, existing, keyData, diff, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update history for KMS key %s: %w", keyData.ResourceID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleKeys removes KMS keys that were not collected in the latest run.
+func (s *Service) DeleteStaleKeys(ctx context.Context, accountID, region string, collectedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	stale, err := tx.BronzeAWSKMSKey.Query().
+		Where(
+			bronzeawskmskey.AccountID(accountID),
+			bronzeawskmskey.Region(region),
+			bronzeawskmskey.CollectedAtLT(collectedAt),
+		).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range stale {
+		if err := s.history.CloseHistory(ctx, tx, e.ID, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to close history for KMS key %s: %w", e.ID, err)
+		}
+
+		if err := tx.BronzeAWSKMSKey.DeleteOne(e).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete KMS key %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
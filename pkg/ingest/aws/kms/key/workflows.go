@@ -0,0 +1,59 @@
+package key
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AWSKMSKeyWorkflowParams contains parameters for the KMS key workflow.
+type AWSKMSKeyWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSKMSKeyWorkflowResult contains the result of the KMS key workflow.
+type AWSKMSKeyWorkflowResult struct {
+	Region         string
+	KeyCount       int
+	DurationMillis int64
+}
+
+// AWSKMSKeyWorkflow ingests AWS KMS keys for a single region.
+func AWSKMSKeyWorkflow(ctx workflow.Context, params AWSKMSKeyWorkflowParams) (*AWSKMSKeyWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSKMSKeyWorkflow", "region", params.Region)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestKMSKeysResult
+	err := workflow.ExecuteActivity(activityCtx, IngestKMSKeysActivity, IngestKMSKeysParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest KMS keys", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Completed AWSKMSKeyWorkflow",
+		"region", params.Region,
+		"keyCount", result.KeyCount,
+	)
+
+	return &AWSKMSKeyWorkflowResult{
+		Region:         result.Region,
+		KeyCount:       result.KeyCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
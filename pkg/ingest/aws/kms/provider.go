@@ -0,0 +1,24 @@
+package kms
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/aws"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider: "aws",
+		Name:     "kms",
+		Scope:    ingest.ScopeRegional,
+		Register: Register,
+		Workflow: AWSKMSWorkflow,
+		NewParams: func(accountID, region, _ string) any {
+			return AWSKMSWorkflowParams{AccountID: accountID, Region: region}
+		},
+		NewResult: func() any { return &AWSKMSWorkflowResult{} },
+		Aggregate: func(result *aws.AWSInventoryWorkflowResult, _ *aws.RegionResult, child any) {
+			r := child.(*AWSKMSWorkflowResult)
+			result.TotalKMSKeys += r.KeyCount
+		},
+	})
+}
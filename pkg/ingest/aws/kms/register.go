@@ -0,0 +1,22 @@
+package kms
+
+import (
+	"entgo.io/ent/dialect"
+	"go.temporal.io/sdk/worker"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/ingest/aws/kms/key"
+	entkms "danny.vn/hotpot/pkg/storage/ent/aws/kms"
+)
+
+// Register registers all KMS activities and workflows.
+func Register(w worker.Worker, configService *config.Service, driver dialect.Driver, limiter ratelimit.Limiter) {
+	entClient := entkms.NewClient(entkms.Driver(driver), entkms.AlternateSchema(entkms.DefaultSchemaConfig()))
+
+	// Register KMS sub-packages
+	key.Register(w, configService, entClient, limiter)
+
+	// Register KMS workflow
+	w.RegisterWorkflow(AWSKMSWorkflow)
+}
@@ -0,0 +1,61 @@
+package kms
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/ingest/aws/kms/key"
+)
+
+// AWSKMSWorkflowParams contains parameters for the KMS workflow.
+type AWSKMSWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSKMSWorkflowResult contains the result of the KMS workflow.
+type AWSKMSWorkflowResult struct {
+	Region   string
+	KeyCount int
+}
+
+// AWSKMSWorkflow ingests all KMS resources for a single region.
+func AWSKMSWorkflow(ctx workflow.Context, params AWSKMSWorkflowParams) (*AWSKMSWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSKMSWorkflow", "region", params.Region)
+
+	// Child workflow options
+	childOpts := workflow.ChildWorkflowOptions{
+		WorkflowExecutionTimeout: 30 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	ctx = workflow.WithChildOptions(ctx, childOpts)
+
+	result := &AWSKMSWorkflowResult{Region: params.Region}
+
+	// Execute KMS Key workflow
+	var keyResult key.AWSKMSKeyWorkflowResult
+	err := workflow.ExecuteChildWorkflow(ctx, key.AWSKMSKeyWorkflow, key.AWSKMSKeyWorkflowParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &keyResult)
+	if err != nil {
+		logger.Error("Failed to execute AWSKMSKeyWorkflow", "region", params.Region, "error", err)
+		return nil, err
+	}
+	result.KeyCount = keyResult.KeyCount
+
+	logger.Info("Completed AWSKMSWorkflow",
+		"region", params.Region,
+		"keyCount", result.KeyCount,
+	)
+
+	return result, nil
+}
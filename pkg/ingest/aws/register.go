@@ -23,8 +23,9 @@ func Register(w worker.Worker, configService *config.Service, driver dialect.Dri
 	})
 	limiter := rateLimitSvc.Limiter()
 
-	// Register provider-level activities (region discovery)
+	// Register provider-level activities (account and region discovery)
 	activities := NewActivities(configService, limiter)
+	w.RegisterActivity(activities.DiscoverAccounts)
 	w.RegisterActivity(activities.DiscoverRegions)
 
 	for _, svc := range ingest.Services("aws") {
@@ -0,0 +1,90 @@
+package bucket
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/awsauth"
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	ents3 "danny.vn/hotpot/pkg/storage/ent/aws/s3"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *ents3.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *ents3.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// createClient creates a rate-limited AWS S3 client for the given account and region.
+func (a *Activities) createClient(ctx context.Context, accountID, region string) (*Client, error) {
+	cfg, err := awsauth.LoadConfig(ctx, a.configService, a.limiter, accountID, region)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(cfg), nil
+}
+
+// IngestS3BucketsParams contains parameters for the ingest activity.
+type IngestS3BucketsParams struct {
+	AccountID string
+	Region    string
+}
+
+// IngestS3BucketsResult contains the result of the ingest activity.
+type IngestS3BucketsResult struct {
+	AccountID      string
+	BucketCount    int
+	DurationMillis int64
+}
+
+// IngestS3BucketsActivity is the activity function reference for workflow registration.
+var IngestS3BucketsActivity = (*Activities).IngestS3Buckets
+
+// IngestS3Buckets is a Temporal activity that ingests AWS S3 buckets.
+func (a *Activities) IngestS3Buckets(ctx context.Context, params IngestS3BucketsParams) (*IngestS3BucketsResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting AWS S3 bucket ingestion", "accountID", params.AccountID)
+
+	client, err := a.createClient(ctx, params.AccountID, params.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create client: %w", err)
+	}
+
+	service := NewService(client, a.entClient)
+	result, err := service.Ingest(ctx, IngestParams{
+		AccountID: params.AccountID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to ingest S3 buckets: %w", err)
+	}
+
+	// Delete stale S3 buckets
+	if err := service.DeleteStaleBuckets(ctx, params.AccountID, result.CollectedAt); err != nil {
+		logger.Warn("Failed to delete stale S3 buckets", "error", err)
+	}
+
+	logger.Info("Completed AWS S3 bucket ingestion",
+		"accountID", params.AccountID,
+		"bucketCount", result.BucketCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestS3BucketsResult{
+		AccountID:      result.AccountID,
+		BucketCount:    result.BucketCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
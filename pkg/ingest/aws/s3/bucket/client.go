@@ -0,0 +1,163 @@
+package bucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+)
+
+// Client wraps the AWS S3 API for buckets.
+type Client struct {
+	s3Client *s3.Client
+}
+
+// NewClient creates a new S3 bucket client from an AWS config.
+func NewClient(cfg aws.Config) *Client {
+	return &Client{
+		s3Client: s3.NewFromConfig(cfg),
+	}
+}
+
+// BucketDetails holds a bucket together with its configuration. Settings
+// the bucket does not have are left nil.
+type BucketDetails struct {
+	Bucket            types.Bucket
+	Region            string
+	PublicAccessBlock *types.PublicAccessBlockConfiguration
+	Policy            *string
+	PolicyIsPublic    *bool
+	ACL               *s3.GetBucketAclOutput
+	Encryption        *types.ServerSideEncryptionConfiguration
+	Versioning        *s3.GetBucketVersioningOutput
+	Logging           *types.LoggingEnabled
+	Tags              []types.Tag
+}
+
+// ListBuckets lists all buckets owned by the account using pagination.
+// Bucket listing is global, so buckets from every region are returned.
+func (c *Client) ListBuckets(ctx context.Context) ([]types.Bucket, error) {
+	var buckets []types.Bucket
+
+	paginator := s3.NewListBucketsPaginator(c.s3Client, &s3.ListBucketsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list buckets: %w", err)
+		}
+		buckets = append(buckets, output.Buckets...)
+	}
+
+	return buckets, nil
+}
+
+// GetBucketDetails fetches the configuration of a bucket. Requests are sent
+// to the bucket's own region, since S3 rejects cross-region configuration calls.
+func (c *Client) GetBucketDetails(ctx context.Context, bucket types.Bucket) (*BucketDetails, error) {
+	name := aws.ToString(bucket.Name)
+
+	region := aws.ToString(bucket.BucketRegion)
+	if region == "" {
+		var err error
+		if region, err = c.getBucketRegion(ctx, name); err != nil {
+			return nil, err
+		}
+	}
+	inRegion := func(o *s3.Options) { o.Region = region }
+
+	details := &BucketDetails{Bucket: bucket, Region: region}
+
+	pab, err := c.s3Client.GetPublicAccessBlock(ctx, &s3.GetPublicAccessBlockInput{Bucket: bucket.Name}, inRegion)
+	if err != nil && !isNotConfigured(err) {
+		return nil, fmt.Errorf("get public access block for %s: %w", name, err)
+	}
+	if err == nil {
+		details.PublicAccessBlock = pab.PublicAccessBlockConfiguration
+	}
+
+	policy, err := c.s3Client.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{Bucket: bucket.Name}, inRegion)
+	if err != nil && !isNotConfigured(err) {
+		return nil, fmt.Errorf("get bucket policy for %s: %w", name, err)
+	}
+	if err == nil {
+		details.Policy = policy.Policy
+
+		status, err := c.s3Client.GetBucketPolicyStatus(ctx, &s3.GetBucketPolicyStatusInput{Bucket: bucket.Name}, inRegion)
+		if err != nil && !isNotConfigured(err) {
+			return nil, fmt.Errorf("get bucket policy status for %s: %w", name, err)
+		}
+		if err == nil && status.PolicyStatus != nil {
+			details.PolicyIsPublic = status.PolicyStatus.IsPublic
+		}
+	}
+
+	details.ACL, err = c.s3Client.GetBucketAcl(ctx, &s3.GetBucketAclInput{Bucket: bucket.Name}, inRegion)
+	if err != nil {
+		return nil, fmt.Errorf("get bucket acl for %s: %w", name, err)
+	}
+
+	encryption, err := c.s3Client.GetBucketEncryption(ctx, &s3.GetBucketEncryptionInput{Bucket: bucket.Name}, inRegion)
+	if err != nil && !isNotConfigured(err) {
+		return nil, fmt.Errorf("get bucket encryption for %s: %w", name, err)
+	}
+	if err == nil {
+		details.Encryption = encryption.ServerSideEncryptionConfiguration
+	}
+
+	details.Versioning, err = c.s3Client.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{Bucket: bucket.Name}, inRegion)
+	if err != nil {
+		return nil, fmt.Errorf("get bucket versioning for %s: %w", name, err)
+	}
+
+	logging, err := c.s3Client.GetBucketLogging(ctx, &s3.GetBucketLoggingInput{Bucket: bucket.Name}, inRegion)
+	if err != nil {
+		return nil, fmt.Errorf("get bucket logging for %s: %w", name, err)
+	}
+	details.Logging = logging.LoggingEnabled
+
+	tagging, err := c.s3Client.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{Bucket: bucket.Name}, inRegion)
+	if err != nil && !isNotConfigured(err) {
+		return nil, fmt.Errorf("get bucket tagging for %s: %w", name, err)
+	}
+	if err == nil {
+		details.Tags = tagging.TagSet
+	}
+
+	return details, nil
+}
+
+// getBucketRegion resolves a bucket's region with GetBucketLocation, for
+// buckets listed without BucketRegion.
+func (c *Client) getBucketRegion(ctx context.Context, name string) (string, error) {
+	output, err := c.s3Client.GetBucketLocation(ctx, &s3.GetBucketLocationInput{Bucket: aws.String(name)})
+	if err != nil {
+		return "", fmt.Errorf("get bucket location for %s: %w", name, err)
+	}
+
+	// Legacy location constraints: empty means us-east-1 and EU means eu-west-1
+	switch output.LocationConstraint {
+	case "":
+		return "us-east-1", nil
+	case types.BucketLocationConstraintEu:
+		return "eu-west-1", nil
+	}
+	return string(output.LocationConstraint), nil
+}
+
+// notConfiguredCodes are the error codes S3 returns when a bucket has no
+// configuration of the requested kind.
+var notConfiguredCodes = map[string]bool{
+	"NoSuchPublicAccessBlockConfiguration":           true,
+	"NoSuchBucketPolicy":                             true,
+	"ServerSideEncryptionConfigurationNotFoundError": true,
+	"NoSuchTagSet":                                   true,
+}
+
+func isNotConfigured(err error) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && notConfiguredCodes[apiErr.ErrorCode()]
+}
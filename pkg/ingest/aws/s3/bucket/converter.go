@@ -0,0 +1,155 @@
+package bucket
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// BucketData holds converted S3 bucket data ready for Ent insertion.
+type BucketData struct {
+	ResourceID            string
+	Arn                   string
+	CreationDate          *time.Time
+	OwnerID               string
+	BlockPublicAcls       *bool
+	IgnorePublicAcls      *bool
+	BlockPublicPolicy     *bool
+	RestrictPublicBuckets *bool
+	PolicyIsPublic        *bool
+	SseAlgorithm          string
+	KmsMasterKeyID        string
+	BucketKeyEnabled      bool
+	VersioningStatus      string
+	MfaDelete             string
+	LoggingTargetBucket   string
+	LoggingTargetPrefix   string
+	PolicyJSON            json.RawMessage
+	ACLGrantsJSON         json.RawMessage
+	TagsJSON              json.RawMessage
+	AccountID             string
+	Region                string
+	CollectedAt           time.Time
+}
+
+// ACLGrant is the JSON structure for bucket ACL grants.
+type ACLGrant struct {
+	GranteeType  string `json:"grantee_type"`
+	GranteeID    string `json:"grantee_id,omitempty"`
+	GranteeURI   string `json:"grantee_uri,omitempty"`
+	GranteeEmail string `json:"grantee_email,omitempty"`
+	Permission   string `json:"permission"`
+}
+
+// TagData is the JSON structure for tags.
+type TagData struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ConvertBucket converts bucket details fetched from the S3 API to BucketData.
+func ConvertBucket(d *BucketDetails, accountID string, collectedAt time.Time) (*BucketData, error) {
+	data := &BucketData{
+		ResourceID:   derefStr(d.Bucket.Name),
+		Arn:          derefStr(d.Bucket.BucketArn),
+		CreationDate: d.Bucket.CreationDate,
+		PolicyJSON:   policyDocument(d.Policy),
+		AccountID:    accountID,
+		Region:       d.Region,
+		CollectedAt:  collectedAt,
+	}
+	if data.Arn == "" {
+		data.Arn = "arn:aws:s3:::" + data.ResourceID
+	}
+
+	if pab := d.PublicAccessBlock; pab != nil {
+		data.BlockPublicAcls = pab.BlockPublicAcls
+		data.IgnorePublicAcls = pab.IgnorePublicAcls
+		data.BlockPublicPolicy = pab.BlockPublicPolicy
+		data.RestrictPublicBuckets = pab.RestrictPublicBuckets
+	}
+	if d.Policy != nil {
+		data.PolicyIsPublic = d.PolicyIsPublic
+	}
+
+	// Default encryption uses the first rule; S3 allows only one
+	if d.Encryption != nil && len(d.Encryption.Rules) > 0 {
+		rule := d.Encryption.Rules[0]
+		if rule.ApplyServerSideEncryptionByDefault != nil {
+			data.SseAlgorithm = string(rule.ApplyServerSideEncryptionByDefault.SSEAlgorithm)
+			data.KmsMasterKeyID = derefStr(rule.ApplyServerSideEncryptionByDefault.KMSMasterKeyID)
+		}
+		data.BucketKeyEnabled = derefBool(rule.BucketKeyEnabled)
+	}
+
+	if d.Versioning != nil {
+		data.VersioningStatus = string(d.Versioning.Status)
+		data.MfaDelete = string(d.Versioning.MFADelete)
+	}
+
+	if d.Logging != nil {
+		data.LoggingTargetBucket = derefStr(d.Logging.TargetBucket)
+		data.LoggingTargetPrefix = derefStr(d.Logging.TargetPrefix)
+	}
+
+	var err error
+	if d.ACL != nil {
+		if d.ACL.Owner != nil {
+			data.OwnerID = derefStr(d.ACL.Owner.ID)
+		}
+		if len(d.ACL.Grants) > 0 {
+			grants := make([]ACLGrant, 0, len(d.ACL.Grants))
+			for _, g := range d.ACL.Grants {
+				grant := ACLGrant{Permission: string(g.Permission)}
+				if g.Grantee != nil {
+					grant.GranteeType = string(g.Grantee.Type)
+					grant.GranteeID = derefStr(g.Grantee.ID)
+					grant.GranteeURI = derefStr(g.Grantee.URI)
+					grant.GranteeEmail = derefStr(g.Grantee.EmailAddress)
+				}
+				grants = append(grants, grant)
+			}
+			if data.ACLGrantsJSON, err = json.Marshal(grants); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(d.Tags) > 0 {
+		tags := make([]TagData, 0, len(d.Tags))
+		for _, t := range d.Tags {
+			tags = append(tags, TagData{Key: derefStr(t.Key), Value: derefStr(t.Value)})
+		}
+		if data.TagsJSON, err = json.Marshal(tags); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// policyDocument returns a bucket policy as JSON. Documents that are not
+// valid JSON are kept as a JSON string.
+func policyDocument(doc *string) json.RawMessage {
+	if doc == nil || *doc == "" {
+		return nil
+	}
+	if json.Valid([]byte(*doc)) {
+		return json.RawMessage(*doc)
+	}
+	raw, _ := json.Marshal(*doc)
+	return raw
+}
+
+func derefStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func derefBool(b *bool) bool {
+	if b == nil {
+		return false
+	}
+	return *b
+}
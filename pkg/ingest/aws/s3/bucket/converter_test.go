@@ -0,0 +1,66 @@
+package bucket
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+func TestConvertBucket(t *testing.T) {
+	collected := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	details := &BucketDetails{
+		Bucket:         types.Bucket{Name: aws.String("logs-bucket")},
+		Region:         "eu-west-1",
+		Policy:         aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject"}]}`),
+		PolicyIsPublic: aws.Bool(true),
+		ACL: &s3.GetBucketAclOutput{
+			Owner: &types.Owner{ID: aws.String("owner-1")},
+			Grants: []types.Grant{{
+				Grantee:    &types.Grantee{Type: types.TypeGroup, URI: aws.String("http://acs.amazonaws.com/groups/global/AllUsers")},
+				Permission: types.PermissionRead,
+			}},
+		},
+		Encryption: &types.ServerSideEncryptionConfiguration{
+			Rules: []types.ServerSideEncryptionRule{{
+				ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{
+					SSEAlgorithm:   types.ServerSideEncryptionAwsKms,
+					KMSMasterKeyID: aws.String("arn:aws:kms:eu-west-1:123456789012:key/k-1"),
+				},
+				BucketKeyEnabled: aws.Bool(true),
+			}},
+		},
+		Versioning: &s3.GetBucketVersioningOutput{Status: types.BucketVersioningStatusEnabled},
+	}
+
+	data, err := ConvertBucket(details, "123456789012", collected)
+	if err != nil {
+		t.Fatalf("ConvertBucket: %v", err)
+	}
+	if data.ResourceID != "logs-bucket" || data.Arn != "arn:aws:s3:::logs-bucket" || data.Region != "eu-west-1" {
+		t.Errorf("got %+v", data)
+	}
+	if data.BlockPublicAcls != nil || data.RestrictPublicBuckets != nil {
+		t.Errorf("public access block should be nil without a configuration")
+	}
+	if data.PolicyIsPublic == nil || !*data.PolicyIsPublic {
+		t.Errorf("PolicyIsPublic = %v, want true", data.PolicyIsPublic)
+	}
+	if data.SseAlgorithm != "aws:kms" || !data.BucketKeyEnabled || data.VersioningStatus != "Enabled" || data.OwnerID != "owner-1" {
+		t.Errorf("got %+v", data)
+	}
+	if !json.Valid(data.PolicyJSON) {
+		t.Errorf("PolicyJSON is not valid JSON: %s", data.PolicyJSON)
+	}
+
+	var grants []ACLGrant
+	if err := json.Unmarshal(data.ACLGrantsJSON, &grants); err != nil {
+		t.Fatalf("unmarshal grants: %v", err)
+	}
+	if len(grants) != 1 || grants[0].GranteeType != "Group" || grants[0].Permission != "READ" {
+		t.Errorf("grants = %+v", grants)
+	}
+}
@@ -0,0 +1,80 @@
+package bucket
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	ents3 "danny.vn/hotpot/pkg/storage/ent/aws/s3"
+)
+
+// BucketDiff represents changes between old and new S3 bucket states.
+type BucketDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffBucketData compares old Ent entity and new data.
+func DiffBucketData(old *ents3.BronzeAWSS3Bucket, new *BucketData) *BucketDiff {
+	if old == nil {
+		return &BucketDiff{IsNew: true}
+	}
+
+	return &BucketDiff{
+		IsChanged: old.Arn != new.Arn ||
+			!timeEqual(old.CreationDate, new.CreationDate) ||
+			old.OwnerID != new.OwnerID ||
+			!boolEqual(old.BlockPublicAcls, new.BlockPublicAcls) ||
+			!boolEqual(old.IgnorePublicAcls, new.IgnorePublicAcls) ||
+			!boolEqual(old.BlockPublicPolicy, new.BlockPublicPolicy) ||
+			!boolEqual(old.RestrictPublicBuckets, new.RestrictPublicBuckets) ||
+			!boolEqual(old.PolicyIsPublic, new.PolicyIsPublic) ||
+			old.SseAlgorithm != new.SseAlgorithm ||
+			old.KmsMasterKeyID != new.KmsMasterKeyID ||
+			old.BucketKeyEnabled != new.BucketKeyEnabled ||
+			old.VersioningStatus != new.VersioningStatus ||
+			old.MfaDelete != new.MfaDelete ||
+			old.LoggingTargetBucket != new.LoggingTargetBucket ||
+			old.LoggingTargetPrefix != new.LoggingTargetPrefix ||
+			jsonChanged(old.PolicyJSON, new.PolicyJSON) ||
+			jsonChanged(old.ACLGrantsJSON, new.ACLGrantsJSON) ||
+			jsonChanged(old.TagsJSON, new.TagsJSON),
+	}
+}
+
+// HasAnyChange returns true if any part of the S3 bucket changed.
+func (d *BucketDiff) HasAnyChange() bool {
+	return d.IsNew || d.IsChanged
+}
+
+func timeEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+func boolEqual(a, b *bool) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// jsonChanged compares JSON values semantically, since jsonb does not
+// preserve the formatting or key order of what was written.
+func jsonChanged(old, new json.RawMessage) bool {
+	return !bytes.Equal(normalizeJSON(old), normalizeJSON(new))
+}
+
+func normalizeJSON(raw json.RawMessage) []byte {
+	if len(raw) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return raw
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
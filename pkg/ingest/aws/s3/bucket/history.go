@@ -0,0 +1,114 @@
+package bucket
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ents3 "danny.vn/hotpot/pkg/storage/ent/aws/s3"
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/bronzehistoryawss3bucket"
+)
+
+// HistoryService handles history tracking for S3 buckets.
+type HistoryService struct {
+	entClient *ents3.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *ents3.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *ents3.Tx, data *BucketData) *ents3.BronzeHistoryAWSS3BucketCreate {
+	create := tx.BronzeHistoryAWSS3Bucket.Create().
+		SetResourceID(data.ResourceID).
+		SetCollectedAt(data.CollectedAt).
+		SetArn(data.Arn).
+		SetOwnerID(data.OwnerID).
+		SetSseAlgorithm(data.SseAlgorithm).
+		SetKmsMasterKeyID(data.KmsMasterKeyID).
+		SetBucketKeyEnabled(data.BucketKeyEnabled).
+		SetVersioningStatus(data.VersioningStatus).
+		SetMfaDelete(data.MfaDelete).
+		SetLoggingTargetBucket(data.LoggingTargetBucket).
+		SetLoggingTargetPrefix(data.LoggingTargetPrefix).
+		SetAccountID(data.AccountID).
+		SetRegion(data.Region)
+
+	if data.CreationDate != nil {
+		create.SetCreationDate(*data.CreationDate)
+	}
+	if data.BlockPublicAcls != nil {
+		create.SetBlockPublicAcls(*data.BlockPublicAcls)
+	}
+	if data.IgnorePublicAcls != nil {
+		create.SetIgnorePublicAcls(*data.IgnorePublicAcls)
+	}
+	if data.BlockPublicPolicy != nil {
+		create.SetBlockPublicPolicy(*data.BlockPublicPolicy)
+	}
+	if data.RestrictPublicBuckets != nil {
+		create.SetRestrictPublicBuckets(*data.RestrictPublicBuckets)
+	}
+	if data.PolicyIsPublic != nil {
+		create.SetPolicyIsPublic(*data.PolicyIsPublic)
+	}
+	if data.PolicyJSON != nil {
+		create.SetPolicyJSON(data.PolicyJSON)
+	}
+	if data.ACLGrantsJSON != nil {
+		create.SetACLGrantsJSON(data.ACLGrantsJSON)
+	}
+	if data.TagsJSON != nil {
+		create.SetTagsJSON(data.TagsJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new S3 bucket.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *ents3.Tx, data *BucketData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create S3 bucket history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new history based on diff.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *ents3.Tx, old *ents3.BronzeAWSS3Bucket, new *BucketData, diff *BucketDiff, now time.Time) error {
+	if !diff.IsChanged {
+		return nil
+	}
+
+	if err := h.CloseHistory(ctx, tx, old.ID, now); err != nil {
+		return err
+	}
+
+	_, err := h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new S3 bucket history: %w", err)
+	}
+	return nil
+}
+
+// CloseHistory closes history records for a deleted S3 bucket.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *ents3.Tx, resourceID string, now time.Time) error {
+	_, err := tx.BronzeHistoryAWSS3Bucket.Update().
+		Where(
+			bronzehistoryawss3bucket.ResourceID(resourceID),
+			bronzehistoryawss3bucket.ValidToIsNil(),
+		).
+		SetValidTo(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close S3 bucket history: %w", err)
+	}
+	return nil
+}
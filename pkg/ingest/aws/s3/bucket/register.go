@@ -0,0 +1,18 @@
+package bucket
+
+import (
+	"go.temporal.io/sdk/worker"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	ents3 "danny.vn/hotpot/pkg/storage/ent/aws/s3"
+)
+
+// Register registers S3 bucket activities and workflows with the Temporal worker.
+func Register(w worker.Worker, configService *config.Service, entClient *ents3.Client, limiter ratelimit.Limiter) {
+	activities := NewActivities(configService, entClient, limiter)
+
+	w.RegisterActivity(activities.IngestS3Buckets)
+
+	w.RegisterWorkflow(AWSS3BucketWorkflow)
+}
@@ -0,0 +1,305 @@
+package bucket
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ents3 "danny.vn/hotpot/pkg/storage/ent/aws/s3"
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/bronzeawss3bucket"
+)
+
+// Service handles AWS S3 bucket ingestion.
+type Service struct {
+	client    *Client
+	entClient *ents3.Client
+	history   *HistoryService
+}
+
+// NewService creates a new S3 bucket ingestion service.
+func NewService(client *Client, entClient *ents3.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestParams contains parameters for S3 bucket ingestion.
+type IngestParams struct {
+	AccountID string
+}
+
+// IngestResult contains the result of S3 bucket ingestion.
+type IngestResult struct {
+	AccountID      string
+	BucketCount    int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches S3 buckets from AWS and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, params IngestParams) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	// Fetch buckets from AWS
+	buckets, err := s.client.ListBuckets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list S3 buckets: %w", err)
+	}
+
+	// Fetch bucket configuration and convert to data structs
+	dataList := make([]*BucketData, 0, len(buckets))
+	for _, b := range buckets {
+		details, err := s.client.GetBucketDetails(ctx, b)
+		if err != nil {
+			return nil, err
+		}
+		data, err := ConvertBucket(details, params.AccountID, collectedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert S3 bucket: %w", err)
+		}
+		dataList = append(dataList, data)
+	}
+
+	// Save to database
+	if err := s.saveBuckets(ctx, dataList); err != nil {
+		return nil, fmt.Errorf("failed to save S3 buckets: %w", err)
+	}
+
+	return &IngestResult{
+		AccountID:      params.AccountID,
+		BucketCount:    len(dataList),
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveBuckets saves S3 buckets to the database with history tracking.
+func (s *Service) saveBuckets(ctx context.Context, buckets []*BucketData) error {
+	if len(buckets) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, bucketData := range buckets {
+		// Load existing S3 bucket
+		existing, err := tx.BronzeAWSS3Bucket.Query().
+			Where(bronzeawss3bucket.ID(bucketData.ResourceID)).
+			First(ctx)
+		if err != nil && !ents3.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("failed to load existing S3 bucket %s: %w", bucketData.ResourceID, err)
+		}
+
+		// Compute diff
+		diff := DiffBucketData(existing, bucketData)
+
+		// Skip if no changes
+		if !diff.HasAnyChange() {
+			if err := tx.BronzeAWSS3Bucket.UpdateOneID(bucketData.ResourceID).
+				SetCollectedAt(bucketData.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update collected_at for S3 bucket %s: %w", bucketData.ResourceID, err)
+			}
+			continue
+		}
+
+		// Create or update S3 bucket
+		if existing == nil {
+			create := tx.BronzeAWSS3Bucket.Create().
+				SetID(bucketData.ResourceID).
+				SetArn(bucketData.Arn).
+				SetOwnerID(bucketData.OwnerID).
+				SetSseAlgorithm(bucketData.SseAlgorithm).
+				SetKmsMasterKeyID(bucketData.KmsMasterKeyID).
+				SetBucketKeyEnabled(bucketData.BucketKeyEnabled).
+				SetVersioningStatus(bucketData.VersioningStatus).
+				SetMfaDelete(bucketData.MfaDelete).
+				SetLoggingTargetBucket(bucketData.LoggingTargetBucket).
+				SetLoggingTargetPrefix(bucketData.LoggingTargetPrefix).
+				SetAccountID(bucketData.AccountID).
+				SetRegion(bucketData.Region).
+				SetCollectedAt(bucketData.CollectedAt).
+				SetFirstCollectedAt(bucketData.CollectedAt)
+
+			if bucketData.CreationDate != nil {
+				create.SetCreationDate(*bucketData.CreationDate)
+			}
+			if bucketData.BlockPublicAcls != nil {
+				create.SetBlockPublicAcls(*bucketData.BlockPublicAcls)
+			}
+			if bucketData.IgnorePublicAcls != nil {
+				create.SetIgnorePublicAcls(*bucketData.IgnorePublicAcls)
+			}
+			if bucketData.BlockPublicPolicy != nil {
+				create.SetBlockPublicPolicy(*bucketData.BlockPublicPolicy)
+			}
+			if bucketData.RestrictPublicBuckets != nil {
+				create.SetRestrictPublicBuckets(*bucketData.RestrictPublicBuckets)
+			}
+			if bucketData.PolicyIsPublic != nil {
+				create.SetPolicyIsPublic(*bucketData.PolicyIsPublic)
+			}
+			if bucketData.PolicyJSON != nil {
+				create.SetPolicyJSON(bucketData.PolicyJSON)
+			}
+			if bucketData.ACLGrantsJSON != nil {
+				create.SetACLGrantsJSON(bucketData.ACLGrantsJSON)
+			}
+			if bucketData.TagsJSON != nil {
+				create.SetTagsJSON(bucketData.TagsJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create S3 bucket %s: %w", bucketData.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeAWSS3Bucket.UpdateOneID(bucketData.ResourceID).
+				SetArn(bucketData.Arn).
+				SetOwnerID(bucketData.OwnerID).
+				SetSseAlgorithm(bucketData.SseAlgorithm).
+				SetKmsMasterKeyID(bucketData.KmsMasterKeyID).
+				SetBucketKeyEnabled(bucketData.BucketKeyEnabled).
+				SetVersioningStatus(bucketData.VersioningStatus).
+				SetMfaDelete(bucketData.MfaDelete).
+				SetLoggingTargetBucket(bucketData.LoggingTargetBucket).
+				SetLoggingTargetPrefix(bucketData.LoggingTargetPrefix).
+				SetAccountID(bucketData.AccountID).
+				SetRegion(bucketData.Region).
+				SetCollectedAt(bucketData.CollectedAt)
+
+			if bucketData.CreationDate != nil {
+				update.SetCreationDate(*bucketData.CreationDate)
+			} else {
+				update.ClearCreationDate()
+			}
+			if bucketData.BlockPublicAcls != nil {
+				update.SetBlockPublicAcls(*bucketData.BlockPublicAcls)
+			} else {
+				update.ClearBlockPublicAcls()
+			}
+			if bucketData.IgnorePublicAcls != nil {
+				update.SetIgnorePublicAcls(*bucketData.IgnorePublicAcls)
+			} else {
+				update.ClearIgnorePublicAcls()
+			}
+			if bucketData.BlockPublicPolicy != nil {
+				update.SetBlockPublicPolicy(*bucketData.BlockPublicPolicy)
+			} else {
+				update.ClearBlockPublicPolicy()
+			}
+			if bucketData.RestrictPublicBuckets != nil {
+				update.SetRestrictPublicBuckets(*bucketData.RestrictPublicBuckets)
+			} else {
+				update.ClearRestrictPublicBuckets()
+			}
+			if bucketData.PolicyIsPublic != nil {
+				update.SetPolicyIsPublic(*bucketData.PolicyIsPublic)
+			} else {
+				update.ClearPolicyIsPublic()
+			}
+			if bucketData.PolicyJSON != nil {
+				update.SetPolicyJSON(bucketData.PolicyJSON)
+			} else {
+				update.ClearPolicyJSON()
+			}
+			if bucketData.ACLGrantsJSON != nil {
+				update.SetACLGrantsJSON(bucketData.ACLGrantsJSON)
+			} else {
+				update.ClearACLGrantsJSON()
+			}
+			if bucketData.TagsJSON != nil {
+				update.SetTagsJSON(bucketData.TagsJSON)
+			} else {
+				update.ClearTagsJSON()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update S3 bucket %s: %w", bucketData.ResourceID, err)
+			}
+		}
+
+		// Track history
+		if diff.IsNew {
+			if err := s.history.CreateHistory(ctx, tx, bucketData, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to create history for S3 bucket %s: %w", bucketData.ResourceID, err)
+			}
+		} else {
+			if err := s.history.UpdateHistory(ctx, tx, existing, bucketData, diff, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to update history for S3 bucket %s: %w", bucketData.ResourceID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleBuckets removes S3 buckets that were not collected in the latest run.
+func (s *Service) DeleteStaleBuckets(ctx context.Context, accountID string, collectedAt time.Time) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	stale, err := tx.BronzeAWSS3Bucket.Query().
+		Where(
+			bronzeawss3bucket.AccountID(accountID),
+			bronzeawss3bucket.CollectedAtLT(collectedAt),
+		).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, e := range stale {
+		if err := s.history.CloseHistory(ctx, tx, e.ID, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to close history for S3 bucket %s: %w", e.ID, err)
+		}
+
+		if err := tx.BronzeAWSS3Bucket.DeleteOne(e).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete S3 bucket %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package bucket
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// AWSS3BucketWorkflowParams contains parameters for the S3 bucket workflow.
+type AWSS3BucketWorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSS3BucketWorkflowResult contains the result of the S3 bucket workflow.
+type AWSS3BucketWorkflowResult struct {
+	AccountID      string
+	BucketCount    int
+	DurationMillis int64
+}
+
+// AWSS3BucketWorkflow ingests AWS S3 buckets for an account.
+func AWSS3BucketWorkflow(ctx workflow.Context, params AWSS3BucketWorkflowParams) (*AWSS3BucketWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSS3BucketWorkflow", "accountID", params.AccountID)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestS3BucketsResult
+	err := workflow.ExecuteActivity(activityCtx, IngestS3BucketsActivity, IngestS3BucketsParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest S3 buckets", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Completed AWSS3BucketWorkflow",
+		"accountID", params.AccountID,
+		"bucketCount", result.BucketCount,
+	)
+
+	return &AWSS3BucketWorkflowResult{
+		AccountID:      result.AccountID,
+		BucketCount:    result.BucketCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package s3
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/aws"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider: "aws",
+		Name:     "s3",
+		Scope:    ingest.ScopeGlobal,
+		Register: Register,
+		Workflow: AWSS3Workflow,
+		NewParams: func(accountID, region, _ string) any {
+			return AWSS3WorkflowParams{AccountID: accountID, Region: region}
+		},
+		NewResult: func() any { return &AWSS3WorkflowResult{} },
+		Aggregate: func(result *aws.AWSInventoryWorkflowResult, _ *aws.RegionResult, child any) {
+			r := child.(*AWSS3WorkflowResult)
+			result.TotalS3Buckets += r.BucketCount
+		},
+	})
+}
@@ -0,0 +1,22 @@
+package s3
+
+import (
+	"entgo.io/ent/dialect"
+	"go.temporal.io/sdk/worker"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/ingest/aws/s3/bucket"
+	ents3 "danny.vn/hotpot/pkg/storage/ent/aws/s3"
+)
+
+// Register registers all S3 activities and workflows.
+func Register(w worker.Worker, configService *config.Service, driver dialect.Driver, limiter ratelimit.Limiter) {
+	entClient := ents3.NewClient(ents3.Driver(driver), ents3.AlternateSchema(ents3.DefaultSchemaConfig()))
+
+	// Register S3 sub-packages
+	bucket.Register(w, configService, entClient, limiter)
+
+	// Register S3 workflow
+	w.RegisterWorkflow(AWSS3Workflow)
+}
@@ -0,0 +1,62 @@
+package s3
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/ingest/aws/s3/bucket"
+)
+
+// AWSS3WorkflowParams contains parameters for the S3 workflow.
+type AWSS3WorkflowParams struct {
+	AccountID string
+	Region    string
+}
+
+// AWSS3WorkflowResult contains the result of the S3 workflow.
+type AWSS3WorkflowResult struct {
+	AccountID   string
+	BucketCount int
+}
+
+// AWSS3Workflow ingests all S3 resources for a single account. Bucket
+// listing is global, so Region only selects the API endpoint.
+func AWSS3Workflow(ctx workflow.Context, params AWSS3WorkflowParams) (*AWSS3WorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting AWSS3Workflow", "accountID", params.AccountID)
+
+	// Child workflow options
+	childOpts := workflow.ChildWorkflowOptions{
+		WorkflowExecutionTimeout: 30 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	ctx = workflow.WithChildOptions(ctx, childOpts)
+
+	result := &AWSS3WorkflowResult{AccountID: params.AccountID}
+
+	// Execute S3 Bucket workflow
+	var bucketResult bucket.AWSS3BucketWorkflowResult
+	err := workflow.ExecuteChildWorkflow(ctx, bucket.AWSS3BucketWorkflow, bucket.AWSS3BucketWorkflowParams{
+		AccountID: params.AccountID,
+		Region:    params.Region,
+	}).Get(ctx, &bucketResult)
+	if err != nil {
+		logger.Error("Failed to execute AWSS3BucketWorkflow", "error", err)
+		return nil, err
+	}
+	result.BucketCount = bucketResult.BucketCount
+
+	logger.Info("Completed AWSS3Workflow",
+		"accountID", params.AccountID,
+		"bucketCount", result.BucketCount,
+	)
+
+	return result, nil
+}
@@ -14,27 +14,53 @@ type AWSInventoryWorkflowParams struct{}
 
 // AWSInventoryWorkflowResult contains the result of the AWS inventory workflow.
 type AWSInventoryWorkflowResult struct {
-	RegionResults  []RegionResult
-	TotalInstances int
+	AccountResults         []AccountResult
+	RegionResults          []RegionResult
+	TotalInstances         int
+	TotalVPCs              int
+	TotalSubnets           int
+	TotalSecurityGroups    int
+	TotalNetworkInterfaces int
+	TotalElasticIPs        int
+	TotalIAMUsers          int
+	TotalIAMRoles          int
+	TotalAccessKeys        int
+	TotalS3Buckets         int
+	TotalKMSKeys           int
+	TotalCloudTrailTrails  int
 }
 
-// RegionResult contains the ingestion result for a single region.
+// AccountResult contains the ingestion result for a single account.
+type AccountResult struct {
+	AccountID   string
+	Name        string
+	RegionCount int
+	Error       string
+}
+
+// RegionResult contains the ingestion result for a single account and region.
 type RegionResult struct {
-	Region        string
-	InstanceCount int
-	Error         string
+	AccountID          string
+	Region             string
+	InstanceCount      int
+	VPCCount           int
+	SecurityGroupCount int
+	Error              string
 }
 
 // aggregateFunc is the function signature for merging a service result into the provider-level results.
+// The RegionResult is nil for global services.
 type aggregateFunc = func(*AWSInventoryWorkflowResult, *RegionResult, any)
 
-// AWSInventoryWorkflow ingests all AWS resources across all enabled regions.
-// It first discovers regions, then orchestrates per-region child workflows.
+// AWSInventoryWorkflow ingests all AWS resources across all accounts and enabled regions.
+// It first discovers the accounts, then for each account discovers its regions,
+// runs global services once and orchestrates per-region child workflows.
+// A failing account is recorded in AccountResults and does not stop the others.
 func AWSInventoryWorkflow(ctx workflow.Context, _ AWSInventoryWorkflowParams) (*AWSInventoryWorkflowResult, error) {
 	logger := workflow.GetLogger(ctx)
 	logger.Info("Starting AWSInventoryWorkflow")
 
-	// Activity options for region discovery
+	// Activity options for account and region discovery
 	activityOpts := workflow.ActivityOptions{
 		StartToCloseTimeout: 2 * time.Minute,
 		RetryPolicy: &temporal.RetryPolicy{
@@ -46,16 +72,16 @@ func AWSInventoryWorkflow(ctx workflow.Context, _ AWSInventoryWorkflowParams) (*
 	}
 	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
 
-	// Discover regions
-	var discoverResult DiscoverRegionsResult
-	err := workflow.ExecuteActivity(activityCtx, DiscoverRegionsActivity, DiscoverRegionsParams{}).
-		Get(ctx, &discoverResult)
+	// Discover accounts
+	var accountsResult DiscoverAccountsResult
+	err := workflow.ExecuteActivity(activityCtx, DiscoverAccountsActivity, DiscoverAccountsParams{}).
+		Get(ctx, &accountsResult)
 	if err != nil {
-		logger.Error("Failed to discover regions", "error", err)
+		logger.Error("Failed to discover accounts", "error", err)
 		return nil, err
 	}
 
-	logger.Info("Discovered regions", "count", len(discoverResult.Regions))
+	logger.Info("Discovered accounts", "count", len(accountsResult.Accounts))
 
 	// Child workflow options
 	childOpts := workflow.ChildWorkflowOptions{
@@ -70,33 +96,85 @@ func AWSInventoryWorkflow(ctx workflow.Context, _ AWSInventoryWorkflowParams) (*
 	ctx = workflow.WithChildOptions(ctx, childOpts)
 
 	result := &AWSInventoryWorkflowResult{
-		RegionResults: make([]RegionResult, 0, len(discoverResult.Regions)),
+		AccountResults: make([]AccountResult, 0, len(accountsResult.Accounts)),
 	}
 
 	services := ingest.Services("aws")
 
-	// Process each region
-	for _, region := range discoverResult.Regions {
-		regionResult := RegionResult{Region: region}
+	for _, account := range accountsResult.Accounts {
+		accountResult := AccountResult{AccountID: account.ID, Name: account.Name}
+
+		// Discover regions enabled in this account
+		var discoverResult DiscoverRegionsResult
+		err := workflow.ExecuteActivity(activityCtx, DiscoverRegionsActivity, DiscoverRegionsParams{
+			AccountID: account.ID,
+		}).Get(ctx, &discoverResult)
+		if err != nil {
+			logger.Error("Failed to discover regions", "accountID", account.ID, "error", err)
+			accountResult.Error = err.Error()
+			result.AccountResults = append(result.AccountResults, accountResult)
+			continue
+		}
+		accountResult.RegionCount = len(discoverResult.Regions)
+
+		logger.Info("Discovered regions", "accountID", account.ID, "count", len(discoverResult.Regions))
 
+		// Global services (IAM, S3) — run once per account
 		for _, svc := range services {
+			if svc.Scope != ingest.ScopeGlobal {
+				continue
+			}
 			res := svc.NewResult()
 			err := workflow.ExecuteChildWorkflow(ctx, svc.Workflow,
-				svc.NewParams("", region, "")).Get(ctx, res)
+				svc.NewParams(account.ID, defaultRegion, "")).Get(ctx, res)
 			if err != nil {
-				logger.Error("Failed ingestion", "service", svc.Name, "region", region, "error", err)
-				appendError(&regionResult, err)
+				logger.Error("Failed ingestion", "service", svc.Name, "accountID", account.ID, "error", err)
+				appendAccountError(&accountResult, err)
 			} else {
-				svc.Aggregate.(aggregateFunc)(result, &regionResult, res)
+				svc.Aggregate.(aggregateFunc)(result, nil, res)
 			}
 		}
 
-		result.RegionResults = append(result.RegionResults, regionResult)
+		// Process each region
+		for _, region := range discoverResult.Regions {
+			regionResult := RegionResult{AccountID: account.ID, Region: region}
+
+			for _, svc := range services {
+				if svc.Scope != ingest.ScopeRegional {
+					continue
+				}
+				res := svc.NewResult()
+				err := workflow.ExecuteChildWorkflow(ctx, svc.Workflow,
+					svc.NewParams(account.ID, region, "")).Get(ctx, res)
+				if err != nil {
+					logger.Error("Failed ingestion", "service", svc.Name, "accountID", account.ID, "region", region, "error", err)
+					appendError(&regionResult, err)
+				} else {
+					svc.Aggregate.(aggregateFunc)(result, &regionResult, res)
+				}
+			}
+
+			result.RegionResults = append(result.RegionResults, regionResult)
+		}
+
+		result.AccountResults = append(result.AccountResults, accountResult)
 	}
 
 	logger.Info("Completed AWSInventoryWorkflow",
-		"regionCount", len(discoverResult.Regions),
+		"accountCount", len(result.AccountResults),
+		"regionCount", len(result.RegionResults),
 		"totalInstances", result.TotalInstances,
+		"totalVPCs", result.TotalVPCs,
+		"totalSubnets", result.TotalSubnets,
+		"totalSecurityGroups", result.TotalSecurityGroups,
+		"totalNetworkInterfaces", result.TotalNetworkInterfaces,
+		"totalElasticIPs", result.TotalElasticIPs,
+		"totalIAMUsers", result.TotalIAMUsers,
+		"totalIAMRoles", result.TotalIAMRoles,
+		"totalAccessKeys", result.TotalAccessKeys,
+		"totalS3Buckets", result.TotalS3Buckets,
+		"totalKMSKeys", result.TotalKMSKeys,
+		"totalCloudTrailTrails", result.TotalCloudTrailTrails,
 	)
 
 	return result, nil
@@ -109,3 +187,11 @@ func appendError(rr *RegionResult, err error) {
 		rr.Error += "; " + err.Error()
 	}
 }
+
+func appendAccountError(ar *AccountResult, err error) {
+	if ar.Error == "" {
+		ar.Error = err.Error()
+	} else {
+		ar.Error += "; " + err.Error()
+	}
+}
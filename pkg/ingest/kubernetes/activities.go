@@ -0,0 +1,42 @@
+package kubernetes
+
+import (
+	"context"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/config"
+)
+
+// Activities holds dependencies for Kubernetes provider-level Temporal activities.
+type Activities struct {
+	configService *config.Service
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service) *Activities {
+	return &Activities{configService: configService}
+}
+
+// ListKubernetesClustersResult contains the result of listing clusters.
+type ListKubernetesClustersResult struct {
+	ClusterNames []string
+}
+
+// ListKubernetesClustersActivity is the activity function reference for workflow registration.
+var ListKubernetesClustersActivity = (*Activities).ListKubernetesClusters
+
+// ListKubernetesClusters reads cluster names from config.
+func (a *Activities) ListKubernetesClusters(ctx context.Context) (*ListKubernetesClustersResult, error) {
+	logger := activity.GetLogger(ctx)
+
+	clusters := a.configService.KubernetesClusters()
+	names := make([]string, 0, len(clusters))
+	for _, c := range clusters {
+		names = append(names, c.Name)
+	}
+
+	logger.Info("Listed Kubernetes clusters", "count", len(names))
+
+	return &ListKubernetesClustersResult{ClusterNames: names}, nil
+}
@@ -0,0 +1,138 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+
+	container "cloud.google.com/go/container/apiv1"
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/digitalocean/godo"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/option"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+)
+
+const cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+// NewClientset creates a clientset for a configured cluster. All API calls
+// share the provider rate limiter.
+func NewClientset(ctx context.Context, configService *config.Service, clusterName string, limiter ratelimit.Limiter) (k8s.Interface, error) {
+	cluster := configService.KubernetesCluster(clusterName)
+	if cluster == nil {
+		return nil, fmt.Errorf("kubernetes cluster %q not found in config", clusterName)
+	}
+
+	var restConfig *rest.Config
+	var err error
+	switch {
+	case cluster.GKE != nil:
+		restConfig, err = gkeRestConfig(ctx, configService.GCPCredentialsJSON(), cluster.GKE)
+	case cluster.DOKSClusterID != "":
+		restConfig, err = doksRestConfig(ctx, configService.DOAPIToken(), cluster.DOKSClusterID)
+	default:
+		restConfig, err = kubeconfigRestConfig(cluster.Kubeconfig, cluster.Context)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cluster %s: %w", clusterName, err)
+	}
+
+	restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return ratelimit.NewRateLimitedTransport(limiter, rt)
+	})
+
+	clientset, err := k8s.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("create clientset for %s: %w", clusterName, err)
+	}
+	return clientset, nil
+}
+
+// kubeconfigRestConfig loads a kubeconfig given as a file path or as YAML.
+// An empty contextName selects the kubeconfig's current context.
+func kubeconfigRestConfig(kubeconfig, contextName string) (*rest.Config, error) {
+	var cfg *clientcmdapi.Config
+	var err error
+	if strings.Contains(kubeconfig, "\n") {
+		cfg, err = clientcmd.Load([]byte(kubeconfig))
+	} else {
+		cfg, err = clientcmd.LoadFromFile(kubeconfig)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load kubeconfig: %w", err)
+	}
+
+	restConfig, err := clientcmd.NewNonInteractiveClientConfig(*cfg, contextName, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("kubeconfig context %q: %w", contextName, err)
+	}
+	return restConfig, nil
+}
+
+// gkeRestConfig looks up the cluster endpoint and CA through the GKE API
+// and authenticates with an OAuth2 token from the GCP credentials (or ADC).
+func gkeRestConfig(ctx context.Context, credJSON []byte, ref *config.GKEClusterRef) (*rest.Config, error) {
+	var creds *google.Credentials
+	var err error
+	if len(credJSON) > 0 {
+		creds, err = google.CredentialsFromJSONWithType(ctx, credJSON, google.ServiceAccount, cloudPlatformScope)
+	} else {
+		creds, err = google.FindDefaultCredentials(ctx, cloudPlatformScope)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load gcp credentials: %w", err)
+	}
+
+	clusterManager, err := container.NewClusterManagerClient(ctx, option.WithTokenSource(creds.TokenSource))
+	if err != nil {
+		return nil, fmt.Errorf("create cluster manager client: %w", err)
+	}
+	defer clusterManager.Close()
+
+	cluster, err := clusterManager.GetCluster(ctx, &containerpb.GetClusterRequest{
+		Name: fmt.Sprintf("projects/%s/locations/%s/clusters/%s", ref.Project, ref.Location, ref.Cluster),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get gke cluster %s: %w", ref.Cluster, err)
+	}
+
+	caData, err := base64.StdEncoding.DecodeString(cluster.GetMasterAuth().GetClusterCaCertificate())
+	if err != nil {
+		return nil, fmt.Errorf("decode gke cluster CA: %w", err)
+	}
+
+	return &rest.Config{
+		Host:            "https://" + cluster.GetEndpoint(),
+		TLSClientConfig: rest.TLSClientConfig{CAData: caData},
+		WrapTransport: func(rt http.RoundTripper) http.RoundTripper {
+			return &oauth2.Transport{Source: creds.TokenSource, Base: rt}
+		},
+	}, nil
+}
+
+// doksRestConfig fetches short-lived cluster credentials from the
+// DigitalOcean API.
+func doksRestConfig(ctx context.Context, apiToken, clusterID string) (*rest.Config, error) {
+	tokenSource := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: apiToken})
+	godoClient := godo.NewClient(oauth2.NewClient(ctx, tokenSource))
+
+	creds, _, err := godoClient.Kubernetes.GetCredentials(ctx, clusterID, &godo.KubernetesClusterCredentialsGetRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("get doks credentials for %s: %w", clusterID, err)
+	}
+
+	return &rest.Config{
+		Host:            creds.Server,
+		BearerToken:     creds.Token,
+		TLSClientConfig: rest.TLSClientConfig{CAData: creds.CertificateAuthorityData},
+	}, nil
+}
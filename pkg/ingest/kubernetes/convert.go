@@ -0,0 +1,127 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// pageSize is the number of objects requested per List call.
+const pageSize = 500
+
+// ListAll pages through a List call. fetch returns one page of items and
+// the continue token of the next page.
+func ListAll[T any](fetch func(opts metav1.ListOptions) ([]T, string, error)) ([]T, error) {
+	var all []T
+	opts := metav1.ListOptions{Limit: pageSize}
+	for {
+		items, next, err := fetch(opts)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if next == "" {
+			return all, nil
+		}
+		opts.Continue = next
+	}
+}
+
+// ResourceID joins a cluster name and object path segments, e.g.
+// "prod/default/Deployment/web".
+func ResourceID(clusterName string, parts ...string) string {
+	return clusterName + "/" + strings.Join(parts, "/")
+}
+
+// MarshalJSON encodes v for a JSON column. Empty maps and slices are stored
+// as null so that nil and empty compare equal across runs.
+func MarshalJSON(v any) json.RawMessage {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	switch string(b) {
+	case "null", "{}", "[]":
+		return nil
+	}
+	return b
+}
+
+// CreatedAt returns the object creation timestamp, or nil when unset.
+func CreatedAt(meta metav1.ObjectMeta) *time.Time {
+	if meta.CreationTimestamp.IsZero() {
+		return nil
+	}
+	t := meta.CreationTimestamp.UTC()
+	return &t
+}
+
+// Container summarizes the security-relevant settings of a container.
+type Container struct {
+	Name                     string   `json:"name"`
+	Image                    string   `json:"image"`
+	Init                     bool     `json:"init,omitempty"`
+	Privileged               bool     `json:"privileged,omitempty"`
+	AllowPrivilegeEscalation *bool    `json:"allowPrivilegeEscalation,omitempty"`
+	RunAsNonRoot             *bool    `json:"runAsNonRoot,omitempty"`
+	ReadOnlyRootFilesystem   bool     `json:"readOnlyRootFilesystem,omitempty"`
+	CapabilitiesAdd          []string `json:"capabilitiesAdd,omitempty"`
+	Ports                    []int32  `json:"ports,omitempty"`
+}
+
+// Containers summarizes the init and regular containers of a pod spec.
+func Containers(spec corev1.PodSpec) []Container {
+	out := make([]Container, 0, len(spec.InitContainers)+len(spec.Containers))
+	for _, c := range spec.InitContainers {
+		out = append(out, convertContainer(c, spec.SecurityContext, true))
+	}
+	for _, c := range spec.Containers {
+		out = append(out, convertContainer(c, spec.SecurityContext, false))
+	}
+	return out
+}
+
+func convertContainer(c corev1.Container, podSC *corev1.PodSecurityContext, init bool) Container {
+	out := Container{Name: c.Name, Image: c.Image, Init: init}
+	if podSC != nil {
+		out.RunAsNonRoot = podSC.RunAsNonRoot
+	}
+	if sc := c.SecurityContext; sc != nil {
+		out.Privileged = sc.Privileged != nil && *sc.Privileged
+		out.AllowPrivilegeEscalation = sc.AllowPrivilegeEscalation
+		if sc.RunAsNonRoot != nil {
+			out.RunAsNonRoot = sc.RunAsNonRoot
+		}
+		out.ReadOnlyRootFilesystem = sc.ReadOnlyRootFilesystem != nil && *sc.ReadOnlyRootFilesystem
+		if sc.Capabilities != nil {
+			for _, capability := range sc.Capabilities.Add {
+				out.CapabilitiesAdd = append(out.CapabilitiesAdd, string(capability))
+			}
+		}
+	}
+	for _, p := range c.Ports {
+		out.Ports = append(out.Ports, p.ContainerPort)
+	}
+	return out
+}
+
+// Images returns the sorted, de-duplicated image references of a pod spec.
+func Images(spec corev1.PodSpec) []string {
+	seen := make(map[string]struct{})
+	var out []string
+	for _, list := range [][]corev1.Container{spec.InitContainers, spec.Containers} {
+		for _, c := range list {
+			if _, ok := seen[c.Image]; ok || c.Image == "" {
+				continue
+			}
+			seen[c.Image] = struct{}{}
+			out = append(out, c.Image)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
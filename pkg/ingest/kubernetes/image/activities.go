@@ -0,0 +1,74 @@
+package image
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/base/temporalerr"
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entk8s.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entk8s.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// IngestK8sImagesParams contains parameters for the ingest activity.
+type IngestK8sImagesParams struct {
+	ClusterName string
+}
+
+// IngestK8sImagesResult contains the result of the ingest activity.
+type IngestK8sImagesResult struct {
+	ImageCount     int
+	DurationMillis int64
+}
+
+// IngestK8sImagesActivity is the activity function reference for workflow registration.
+var IngestK8sImagesActivity = (*Activities).IngestK8sImages
+
+// IngestK8sImages is a Temporal activity that ingests images from a Kubernetes cluster.
+func (a *Activities) IngestK8sImages(ctx context.Context, params IngestK8sImagesParams) (*IngestK8sImagesResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting Kubernetes image ingestion", "clusterName", params.ClusterName)
+
+	clientset, err := kubernetes.NewClientset(ctx, a.configService, params.ClusterName, a.limiter)
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("create clientset: %w", err))
+	}
+
+	service := NewService(clientset, a.entClient)
+	result, err := service.Ingest(ctx, params.ClusterName, func() {
+		activity.RecordHeartbeat(ctx, nil)
+	})
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("ingest images: %w", err))
+	}
+
+	logger.Info("Completed Kubernetes image ingestion",
+		"clusterName", params.ClusterName,
+		"imageCount", result.ImageCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestK8sImagesResult{
+		ImageCount:     result.ImageCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,140 @@
+package image
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"danny.vn/hotpot/pkg/base/imageref"
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+)
+
+// ImageData holds converted image data ready for Ent insertion.
+type ImageData struct {
+	ResourceID     string
+	ClusterName    string
+	Registry       string
+	Repository     string
+	Digest         string
+	TagsJSON       json.RawMessage
+	NamespacesJSON json.RawMessage
+	WorkloadsJSON  json.RawMessage
+	CollectedAt    time.Time
+}
+
+// digestFromImageID extracts the sha256 digest from a container status
+// image ID, e.g. "docker-pullable://nginx@sha256:..." or "sha256:...".
+func digestFromImageID(imageID string) string {
+	if i := strings.LastIndexByte(imageID, '@'); i >= 0 {
+		return imageID[i+1:]
+	}
+	if strings.HasPrefix(imageID, "sha256:") {
+		return imageID
+	}
+	return ""
+}
+
+// imageAgg accumulates the usage of one image across pods.
+type imageAgg struct {
+	ref        imageref.Reference
+	tags       map[string]struct{}
+	namespaces map[string]struct{}
+	workloads  map[string]struct{}
+}
+
+// AggregateImages groups the containers of pods by image. Images are keyed
+// by digest when the kubelet reports one, so that a tag pointing to
+// different digests across nodes yields one row per digest.
+func AggregateImages(clusterName string, set *kubernetes.PodSet, collectedAt time.Time) []*ImageData {
+	aggs := make(map[string]*imageAgg)
+
+	for i := range set.Pods {
+		pod := &set.Pods[i]
+
+		imageIDs := make(map[string]string)
+		for _, statuses := range [][]corev1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+			for _, cs := range statuses {
+				imageIDs[cs.Name] = cs.ImageID
+			}
+		}
+
+		workload := pod.Namespace + "/Pod/" + pod.Name
+		if kind, name := set.Workload(pod); kind != "" {
+			workload = pod.Namespace + "/" + kind + "/" + name
+		}
+
+		for _, containers := range [][]corev1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+			for _, c := range containers {
+				if c.Image == "" {
+					continue
+				}
+				ref := imageref.Parse(c.Image)
+				if ref.Digest == "" {
+					ref.Digest = digestFromImageID(imageIDs[c.Name])
+				}
+
+				key := ref.Registry + "/" + ref.Repository
+				if ref.Digest != "" {
+					key += "@" + ref.Digest
+				} else {
+					key += ":" + ref.Tag
+				}
+
+				agg, ok := aggs[key]
+				if !ok {
+					agg = &imageAgg{
+						ref:        imageref.Reference{Registry: ref.Registry, Repository: ref.Repository, Digest: ref.Digest},
+						tags:       make(map[string]struct{}),
+						namespaces: make(map[string]struct{}),
+						workloads:  make(map[string]struct{}),
+					}
+					aggs[key] = agg
+				}
+				if ref.Tag != "" {
+					agg.tags[ref.Tag] = struct{}{}
+				}
+				agg.namespaces[pod.Namespace] = struct{}{}
+				agg.workloads[workload] = struct{}{}
+			}
+		}
+	}
+
+	result := make([]*ImageData, 0, len(aggs))
+	for key, agg := range aggs {
+		result = append(result, &ImageData{
+			ResourceID:     kubernetes.ResourceID(clusterName, key),
+			ClusterName:    clusterName,
+			Registry:       agg.ref.Registry,
+			Repository:     agg.ref.Repository,
+			Digest:         agg.ref.Digest,
+			TagsJSON:       kubernetes.MarshalJSON(sortedKeys(agg.tags)),
+			NamespacesJSON: kubernetes.MarshalJSON(sortedKeys(agg.namespaces)),
+			WorkloadsJSON:  kubernetes.MarshalJSON(sortedKeys(agg.workloads)),
+			CollectedAt:    collectedAt,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ResourceID < result[j].ResourceID })
+	return result
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// fetchImages lists the pods of all namespaces and aggregates their images.
+func (s *Service) fetchImages(ctx context.Context, clusterName string, collectedAt time.Time) ([]*ImageData, error) {
+	set, err := kubernetes.ListPods(ctx, s.clientset)
+	if err != nil {
+		return nil, err
+	}
+	return AggregateImages(clusterName, set, collectedAt), nil
+}
@@ -0,0 +1,91 @@
+package image
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+)
+
+func controller(kind, name string) []metav1.OwnerReference {
+	isController := true
+	return []metav1.OwnerReference{{Kind: kind, Name: name, Controller: &isController}}
+}
+
+func TestAggregateImages(t *testing.T) {
+	clientset := fake.NewClientset(
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "web",
+				Name:            "api-7c9f-x1",
+				Labels:          map[string]string{appsv1.DefaultDeploymentUniqueLabelKey: "7c9f"},
+				OwnerReferences: controller("ReplicaSet", "api-7c9f"),
+			},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: "nginx:1.27"}}},
+			Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "app", ImageID: "docker.io/library/nginx@sha256:aaa"},
+			}},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "ops",
+				Name:            "backup-28000000-abcde",
+				OwnerReferences: controller("Job", "backup-28000000"),
+			},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "job", Image: "nginx:stable"}}},
+			Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "job", ImageID: "docker-pullable://nginx@sha256:aaa"},
+			}},
+		},
+		&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "ops",
+				Name:            "backup-28000000",
+				OwnerReferences: controller("CronJob", "backup"),
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "web", Name: "debug"},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "sh", Image: "busybox"}}},
+		},
+	)
+
+	set, err := kubernetes.ListPods(context.Background(), clientset)
+	if err != nil {
+		t.Fatalf("ListPods: %v", err)
+	}
+
+	collected := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	images := AggregateImages("prod", set, collected)
+	if len(images) != 2 {
+		t.Fatalf("got %d images, want 2", len(images))
+	}
+
+	busybox, nginx := images[0], images[1]
+	if busybox.ResourceID != "prod/docker.io/library/busybox:latest" || busybox.Digest != "" {
+		t.Errorf("busybox = %+v", busybox)
+	}
+	if got := string(busybox.WorkloadsJSON); got != `["web/Pod/debug"]` {
+		t.Errorf("busybox workloads = %s", got)
+	}
+
+	if nginx.ResourceID != "prod/docker.io/library/nginx@sha256:aaa" {
+		t.Errorf("nginx ResourceID = %s", nginx.ResourceID)
+	}
+	if got := string(nginx.TagsJSON); got != `["1.27","stable"]` {
+		t.Errorf("nginx tags = %s", got)
+	}
+	if got := string(nginx.NamespacesJSON); got != `["ops","web"]` {
+		t.Errorf("nginx namespaces = %s", got)
+	}
+	if got := string(nginx.WorkloadsJSON); got != `["ops/CronJob/backup","web/Deployment/api"]` {
+		t.Errorf("nginx workloads = %s", got)
+	}
+}
@@ -0,0 +1,30 @@
+package image
+
+import (
+	"bytes"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+)
+
+// ImageDiff represents changes between old and new image states.
+type ImageDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffImageData compares old Ent entity and new data.
+func DiffImageData(old *entk8s.BronzeK8sImage, new *ImageData) *ImageDiff {
+	if old == nil {
+		return &ImageDiff{IsNew: true}
+	}
+
+	changed := old.ClusterName != new.ClusterName ||
+		old.Registry != new.Registry ||
+		old.Repository != new.Repository ||
+		old.Digest != new.Digest ||
+		!bytes.Equal(old.TagsJSON, new.TagsJSON) ||
+		!bytes.Equal(old.NamespacesJSON, new.NamespacesJSON) ||
+		!bytes.Equal(old.WorkloadsJSON, new.WorkloadsJSON)
+
+	return &ImageDiff{IsChanged: changed}
+}
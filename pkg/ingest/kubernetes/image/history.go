@@ -0,0 +1,107 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8simage"
+)
+
+// HistoryService handles history tracking for images.
+type HistoryService struct {
+	entClient *entk8s.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entk8s.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entk8s.Tx, data *ImageData) *entk8s.BronzeHistoryK8sImageCreate {
+	create := tx.BronzeHistoryK8sImage.Create().
+		SetResourceID(data.ResourceID).
+		SetClusterName(data.ClusterName).
+		SetRegistry(data.Registry).
+		SetRepository(data.Repository).
+		SetDigest(data.Digest)
+	if data.TagsJSON != nil {
+		create.SetTagsJSON(data.TagsJSON)
+	}
+	if data.NamespacesJSON != nil {
+		create.SetNamespacesJSON(data.NamespacesJSON)
+	}
+	if data.WorkloadsJSON != nil {
+		create.SetWorkloadsJSON(data.WorkloadsJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new image.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entk8s.Tx, data *ImageData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetCollectedAt(data.CollectedAt).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create image history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new for a changed image.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entk8s.Tx, old *entk8s.BronzeK8sImage, new *ImageData, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryK8sImage.Query().
+		Where(
+			bronzehistoryk8simage.ResourceID(old.ID),
+			bronzehistoryk8simage.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		return fmt.Errorf("find current image history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryK8sImage.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close image history: %w", err)
+	}
+
+	_, err = h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetCollectedAt(new.CollectedAt).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create new image history: %w", err)
+	}
+
+	return nil
+}
+
+// CloseHistory closes history records for a deleted image.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entk8s.Tx, resourceID string, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryK8sImage.Query().
+		Where(
+			bronzehistoryk8simage.ResourceID(resourceID),
+			bronzehistoryk8simage.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		if entk8s.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("find current image history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryK8sImage.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close image history: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package image
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider: "kubernetes",
+		Name:     "image",
+		Register: Register,
+		Workflow: K8sImageWorkflow,
+		NewParams: func(clusterName, _, _ string) any {
+			return K8sImageWorkflowParams{ClusterName: clusterName}
+		},
+		NewResult: func() any { return &K8sImageWorkflowResult{} },
+		Aggregate: func(parent *kubernetes.KubernetesInventoryWorkflowResult, child any) {
+			r := child.(*K8sImageWorkflowResult)
+			parent.ImageCount += r.ImageCount
+		},
+	})
+}
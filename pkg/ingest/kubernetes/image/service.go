@@ -0,0 +1,207 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	k8s "k8s.io/client-go/kubernetes"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8simage"
+)
+
+// Service handles Kubernetes image ingestion.
+type Service struct {
+	clientset k8s.Interface
+	entClient *entk8s.Client
+	history   *HistoryService
+}
+
+// NewService creates a new image ingestion service.
+func NewService(clientset k8s.Interface, entClient *entk8s.Client) *Service {
+	return &Service{
+		clientset: clientset,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestResult contains the result of image ingestion.
+type IngestResult struct {
+	ImageCount     int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches all images of a cluster and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, clusterName string, heartbeat func()) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	images, err := s.fetchImages(ctx, clusterName, collectedAt)
+	if err != nil {
+		return nil, err
+	}
+	if heartbeat != nil {
+		heartbeat()
+	}
+
+	slog.Info("k8s images fetched", "clusterName", clusterName, "count", len(images))
+
+	if err := s.saveImages(ctx, clusterName, images); err != nil {
+		return nil, fmt.Errorf("save images: %w", err)
+	}
+
+	return &IngestResult{
+		ImageCount:     len(images),
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveImages upserts images with history tracking and removes the cluster's
+// images that no longer exist.
+func (s *Service) saveImages(ctx context.Context, clusterName string, items []*ImageData) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	activeIDs := make(map[string]struct{}, len(items))
+
+	for _, data := range items {
+		existing, err := tx.BronzeK8sImage.Query().
+			Where(bronzek8simage.ID(data.ResourceID)).
+			First(ctx)
+		if err != nil && !entk8s.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("load existing image %s: %w", data.ResourceID, err)
+		}
+
+		activeIDs[data.ResourceID] = struct{}{}
+		diff := DiffImageData(existing, data)
+
+		if !diff.IsNew && !diff.IsChanged {
+			if err := tx.BronzeK8sImage.UpdateOneID(data.ResourceID).
+				SetCollectedAt(data.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update collected_at for image %s: %w", data.ResourceID, err)
+			}
+			continue
+		}
+
+		if existing == nil {
+			create := tx.BronzeK8sImage.Create().
+				SetID(data.ResourceID).
+				SetClusterName(data.ClusterName).
+				SetRegistry(data.Registry).
+				SetRepository(data.Repository).
+				SetDigest(data.Digest).
+				SetCollectedAt(data.CollectedAt).
+				SetFirstCollectedAt(data.CollectedAt)
+
+			if data.TagsJSON != nil {
+				create.SetTagsJSON(data.TagsJSON)
+			}
+			if data.NamespacesJSON != nil {
+				create.SetNamespacesJSON(data.NamespacesJSON)
+			}
+			if data.WorkloadsJSON != nil {
+				create.SetWorkloadsJSON(data.WorkloadsJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create image %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.CreateHistory(ctx, tx, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create history for image %s: %w", data.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeK8sImage.UpdateOneID(data.ResourceID).
+				SetClusterName(data.ClusterName).
+				SetRegistry(data.Registry).
+				SetRepository(data.Repository).
+				SetDigest(data.Digest).
+				SetCollectedAt(data.CollectedAt)
+
+			if data.TagsJSON != nil {
+				update.SetTagsJSON(data.TagsJSON)
+			} else {
+				update.ClearTagsJSON()
+			}
+			if data.NamespacesJSON != nil {
+				update.SetNamespacesJSON(data.NamespacesJSON)
+			} else {
+				update.ClearNamespacesJSON()
+			}
+			if data.WorkloadsJSON != nil {
+				update.SetWorkloadsJSON(data.WorkloadsJSON)
+			} else {
+				update.ClearWorkloadsJSON()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update image %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.UpdateHistory(ctx, tx, existing, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update history for image %s: %w", data.ResourceID, err)
+			}
+		}
+	}
+
+	// Delete stale images of this cluster not returned by the API.
+	dbIDs, err := tx.BronzeK8sImage.Query().
+		Where(bronzek8simage.ClusterName(clusterName)).
+		Select(bronzek8simage.FieldID).
+		Strings(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("query image IDs: %w", err)
+	}
+
+	staleCount := 0
+	for _, id := range dbIDs {
+		if _, ok := activeIDs[id]; ok {
+			continue
+		}
+
+		if err := s.history.CloseHistory(ctx, tx, id, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("close history for stale image %s: %w", id, err)
+		}
+
+		if err := tx.BronzeK8sImage.DeleteOneID(id).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("delete stale image %s: %w", id, err)
+		}
+		staleCount++
+	}
+
+	if staleCount > 0 {
+		slog.Info("k8s images: deleted stale", "clusterName", clusterName, "count", staleCount)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package image
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/base/temporalerr"
+)
+
+// K8sImageWorkflowParams contains parameters for the image workflow.
+type K8sImageWorkflowParams struct {
+	ClusterName string
+}
+
+// K8sImageWorkflowResult contains the result of the image workflow.
+type K8sImageWorkflowResult struct {
+	ImageCount     int
+	DurationMillis int64
+}
+
+// K8sImageWorkflow ingests Kubernetes images from a single cluster.
+func K8sImageWorkflow(ctx workflow.Context, params K8sImageWorkflowParams) (*K8sImageWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting K8sImageWorkflow", "clusterName", params.ClusterName)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestK8sImagesResult
+	err := workflow.ExecuteActivity(activityCtx, IngestK8sImagesActivity, IngestK8sImagesParams{
+		ClusterName: params.ClusterName,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest images", "clusterName", params.ClusterName, "error", err)
+		return nil, temporalerr.PropagateNonRetryable(err)
+	}
+
+	logger.Info("Completed K8sImageWorkflow",
+		"clusterName", params.ClusterName,
+		"imageCount", result.ImageCount,
+	)
+
+	return &K8sImageWorkflowResult{
+		ImageCount:     result.ImageCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package namespace
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/base/temporalerr"
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entk8s.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entk8s.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// IngestK8sNamespacesParams contains parameters for the ingest activity.
+type IngestK8sNamespacesParams struct {
+	ClusterName string
+}
+
+// IngestK8sNamespacesResult contains the result of the ingest activity.
+type IngestK8sNamespacesResult struct {
+	NamespaceCount int
+	DurationMillis int64
+}
+
+// IngestK8sNamespacesActivity is the activity function reference for workflow registration.
+var IngestK8sNamespacesActivity = (*Activities).IngestK8sNamespaces
+
+// IngestK8sNamespaces is a Temporal activity that ingests namespaces from a Kubernetes cluster.
+func (a *Activities) IngestK8sNamespaces(ctx context.Context, params IngestK8sNamespacesParams) (*IngestK8sNamespacesResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting Kubernetes namespace ingestion", "clusterName", params.ClusterName)
+
+	clientset, err := kubernetes.NewClientset(ctx, a.configService, params.ClusterName, a.limiter)
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("create clientset: %w", err))
+	}
+
+	service := NewService(clientset, a.entClient)
+	result, err := service.Ingest(ctx, params.ClusterName, func() {
+		activity.RecordHeartbeat(ctx, nil)
+	})
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("ingest namespaces: %w", err))
+	}
+
+	logger.Info("Completed Kubernetes namespace ingestion",
+		"clusterName", params.ClusterName,
+		"namespaceCount", result.NamespaceCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestK8sNamespacesResult{
+		NamespaceCount: result.NamespaceCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package namespace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+)
+
+// NamespaceData holds converted namespace data ready for Ent insertion.
+type NamespaceData struct {
+	ResourceID   string
+	ClusterName  string
+	Name         string
+	UID          string
+	Phase        string
+	LabelsJSON   json.RawMessage
+	APICreatedAt *time.Time
+	CollectedAt  time.Time
+}
+
+// ConvertNamespace converts a Kubernetes namespace to NamespaceData.
+func ConvertNamespace(clusterName string, ns corev1.Namespace, collectedAt time.Time) *NamespaceData {
+	return &NamespaceData{
+		ResourceID:   kubernetes.ResourceID(clusterName, ns.Name),
+		ClusterName:  clusterName,
+		Name:         ns.Name,
+		UID:          string(ns.UID),
+		Phase:        string(ns.Status.Phase),
+		LabelsJSON:   kubernetes.MarshalJSON(ns.Labels),
+		APICreatedAt: kubernetes.CreatedAt(ns.ObjectMeta),
+		CollectedAt:  collectedAt,
+	}
+}
+
+// fetchNamespaces lists all namespaces of the cluster.
+func (s *Service) fetchNamespaces(ctx context.Context, clusterName string, collectedAt time.Time) ([]*NamespaceData, error) {
+	items, err := kubernetes.ListAll(func(opts metav1.ListOptions) ([]corev1.Namespace, string, error) {
+		list, err := s.clientset.CoreV1().Namespaces().List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return list.Items, list.Continue, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list namespaces: %w", err)
+	}
+
+	result := make([]*NamespaceData, 0, len(items))
+	for _, ns := range items {
+		result = append(result, ConvertNamespace(clusterName, ns, collectedAt))
+	}
+	return result, nil
+}
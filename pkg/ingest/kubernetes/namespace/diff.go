@@ -0,0 +1,29 @@
+package namespace
+
+import (
+	"bytes"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+)
+
+// NamespaceDiff represents changes between old and new namespace states.
+type NamespaceDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffNamespaceData compares old Ent entity and new data. The creation timestamp
+// is immutable and not compared.
+func DiffNamespaceData(old *entk8s.BronzeK8sNamespace, new *NamespaceData) *NamespaceDiff {
+	if old == nil {
+		return &NamespaceDiff{IsNew: true}
+	}
+
+	changed := old.ClusterName != new.ClusterName ||
+		old.Name != new.Name ||
+		old.UID != new.UID ||
+		old.Phase != new.Phase ||
+		!bytes.Equal(old.LabelsJSON, new.LabelsJSON)
+
+	return &NamespaceDiff{IsChanged: changed}
+}
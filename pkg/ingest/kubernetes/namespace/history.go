@@ -0,0 +1,104 @@
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snamespace"
+)
+
+// HistoryService handles history tracking for namespaces.
+type HistoryService struct {
+	entClient *entk8s.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entk8s.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entk8s.Tx, data *NamespaceData) *entk8s.BronzeHistoryK8sNamespaceCreate {
+	create := tx.BronzeHistoryK8sNamespace.Create().
+		SetResourceID(data.ResourceID).
+		SetClusterName(data.ClusterName).
+		SetName(data.Name).
+		SetUID(data.UID).
+		SetPhase(data.Phase)
+	if data.LabelsJSON != nil {
+		create.SetLabelsJSON(data.LabelsJSON)
+	}
+	if data.APICreatedAt != nil {
+		create.SetAPICreatedAt(*data.APICreatedAt)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new namespace.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entk8s.Tx, data *NamespaceData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetCollectedAt(data.CollectedAt).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create namespace history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new for a changed namespace.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entk8s.Tx, old *entk8s.BronzeK8sNamespace, new *NamespaceData, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryK8sNamespace.Query().
+		Where(
+			bronzehistoryk8snamespace.ResourceID(old.ID),
+			bronzehistoryk8snamespace.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		return fmt.Errorf("find current namespace history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryK8sNamespace.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close namespace history: %w", err)
+	}
+
+	_, err = h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetCollectedAt(new.CollectedAt).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create new namespace history: %w", err)
+	}
+
+	return nil
+}
+
+// CloseHistory closes history records for a deleted namespace.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entk8s.Tx, resourceID string, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryK8sNamespace.Query().
+		Where(
+			bronzehistoryk8snamespace.ResourceID(resourceID),
+			bronzehistoryk8snamespace.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		if entk8s.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("find current namespace history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryK8sNamespace.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close namespace history: %w", err)
+	}
+
+	return nil
+}
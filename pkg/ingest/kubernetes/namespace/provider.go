@@ -0,0 +1,23 @@
+package namespace
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider: "kubernetes",
+		Name:     "namespace",
+		Register: Register,
+		Workflow: K8sNamespaceWorkflow,
+		NewParams: func(clusterName, _, _ string) any {
+			return K8sNamespaceWorkflowParams{ClusterName: clusterName}
+		},
+		NewResult: func() any { return &K8sNamespaceWorkflowResult{} },
+		Aggregate: func(parent *kubernetes.KubernetesInventoryWorkflowResult, child any) {
+			r := child.(*K8sNamespaceWorkflowResult)
+			parent.NamespaceCount += r.NamespaceCount
+		},
+	})
+}
@@ -0,0 +1,18 @@
+package namespace
+
+import (
+	"go.temporal.io/sdk/worker"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+)
+
+// Register registers namespace activities and workflows with the Temporal worker.
+func Register(w worker.Worker, configService *config.Service, entClient *entk8s.Client, limiter ratelimit.Limiter) {
+	activities := NewActivities(configService, entClient, limiter)
+
+	w.RegisterActivity(activities.IngestK8sNamespaces)
+
+	w.RegisterWorkflow(K8sNamespaceWorkflow)
+}
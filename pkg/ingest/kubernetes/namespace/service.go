@@ -0,0 +1,199 @@
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	k8s "k8s.io/client-go/kubernetes"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snamespace"
+)
+
+// Service handles Kubernetes namespace ingestion.
+type Service struct {
+	clientset k8s.Interface
+	entClient *entk8s.Client
+	history   *HistoryService
+}
+
+// NewService creates a new namespace ingestion service.
+func NewService(clientset k8s.Interface, entClient *entk8s.Client) *Service {
+	return &Service{
+		clientset: clientset,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestResult contains the result of namespace ingestion.
+type IngestResult struct {
+	NamespaceCount int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches all namespaces of a cluster and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, clusterName string, heartbeat func()) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	namespaces, err := s.fetchNamespaces(ctx, clusterName, collectedAt)
+	if err != nil {
+		return nil, err
+	}
+	if heartbeat != nil {
+		heartbeat()
+	}
+
+	slog.Info("k8s namespaces fetched", "clusterName", clusterName, "count", len(namespaces))
+
+	if err := s.saveNamespaces(ctx, clusterName, namespaces); err != nil {
+		return nil, fmt.Errorf("save namespaces: %w", err)
+	}
+
+	return &IngestResult{
+		NamespaceCount: len(namespaces),
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveNamespaces upserts namespaces with history tracking and removes the cluster's
+// namespaces that no longer exist.
+func (s *Service) saveNamespaces(ctx context.Context, clusterName string, items []*NamespaceData) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	activeIDs := make(map[string]struct{}, len(items))
+
+	for _, data := range items {
+		existing, err := tx.BronzeK8sNamespace.Query().
+			Where(bronzek8snamespace.ID(data.ResourceID)).
+			First(ctx)
+		if err != nil && !entk8s.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("load existing namespace %s: %w", data.ResourceID, err)
+		}
+
+		activeIDs[data.ResourceID] = struct{}{}
+		diff := DiffNamespaceData(existing, data)
+
+		if !diff.IsNew && !diff.IsChanged {
+			if err := tx.BronzeK8sNamespace.UpdateOneID(data.ResourceID).
+				SetCollectedAt(data.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update collected_at for namespace %s: %w", data.ResourceID, err)
+			}
+			continue
+		}
+
+		if existing == nil {
+			create := tx.BronzeK8sNamespace.Create().
+				SetID(data.ResourceID).
+				SetClusterName(data.ClusterName).
+				SetName(data.Name).
+				SetUID(data.UID).
+				SetPhase(data.Phase).
+				SetCollectedAt(data.CollectedAt).
+				SetFirstCollectedAt(data.CollectedAt)
+
+			if data.LabelsJSON != nil {
+				create.SetLabelsJSON(data.LabelsJSON)
+			}
+			if data.APICreatedAt != nil {
+				create.SetAPICreatedAt(*data.APICreatedAt)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create namespace %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.CreateHistory(ctx, tx, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create history for namespace %s: %w", data.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeK8sNamespace.UpdateOneID(data.ResourceID).
+				SetClusterName(data.ClusterName).
+				SetName(data.Name).
+				SetUID(data.UID).
+				SetPhase(data.Phase).
+				SetCollectedAt(data.CollectedAt)
+
+			if data.LabelsJSON != nil {
+				update.SetLabelsJSON(data.LabelsJSON)
+			} else {
+				update.ClearLabelsJSON()
+			}
+			if data.APICreatedAt != nil {
+				update.SetAPICreatedAt(*data.APICreatedAt)
+			} else {
+				update.ClearAPICreatedAt()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update namespace %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.UpdateHistory(ctx, tx, existing, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update history for namespace %s: %w", data.ResourceID, err)
+			}
+		}
+	}
+
+	// Delete stale namespaces of this cluster not returned by the API.
+	dbIDs, err := tx.BronzeK8sNamespace.Query().
+		Where(bronzek8snamespace.ClusterName(clusterName)).
+		Select(bronzek8snamespace.FieldID).
+		Strings(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("query namespace IDs: %w", err)
+	}
+
+	staleCount := 0
+	for _, id := range dbIDs {
+		if _, ok := activeIDs[id]; ok {
+			continue
+		}
+
+		if err := s.history.CloseHistory(ctx, tx, id, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("close history for stale namespace %s: %w", id, err)
+		}
+
+		if err := tx.BronzeK8sNamespace.DeleteOneID(id).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("delete stale namespace %s: %w", id, err)
+		}
+		staleCount++
+	}
+
+	if staleCount > 0 {
+		slog.Info("k8s namespaces: deleted stale", "clusterName", clusterName, "count", staleCount)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
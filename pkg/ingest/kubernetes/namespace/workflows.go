@@ -0,0 +1,58 @@
+package namespace
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/base/temporalerr"
+)
+
+// K8sNamespaceWorkflowParams contains parameters for the namespace workflow.
+type K8sNamespaceWorkflowParams struct {
+	ClusterName string
+}
+
+// K8sNamespaceWorkflowResult contains the result of the namespace workflow.
+type K8sNamespaceWorkflowResult struct {
+	NamespaceCount int
+	DurationMillis int64
+}
+
+// K8sNamespaceWorkflow ingests Kubernetes namespaces from a single cluster.
+func K8sNamespaceWorkflow(ctx workflow.Context, params K8sNamespaceWorkflowParams) (*K8sNamespaceWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting K8sNamespaceWorkflow", "clusterName", params.ClusterName)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestK8sNamespacesResult
+	err := workflow.ExecuteActivity(activityCtx, IngestK8sNamespacesActivity, IngestK8sNamespacesParams{
+		ClusterName: params.ClusterName,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest namespaces", "clusterName", params.ClusterName, "error", err)
+		return nil, temporalerr.PropagateNonRetryable(err)
+	}
+
+	logger.Info("Completed K8sNamespaceWorkflow",
+		"clusterName", params.ClusterName,
+		"namespaceCount", result.NamespaceCount,
+	)
+
+	return &K8sNamespaceWorkflowResult{
+		NamespaceCount: result.NamespaceCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
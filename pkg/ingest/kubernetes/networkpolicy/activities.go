@@ -0,0 +1,74 @@
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/base/temporalerr"
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entk8s.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entk8s.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// IngestK8sNetworkPoliciesParams contains parameters for the ingest activity.
+type IngestK8sNetworkPoliciesParams struct {
+	ClusterName string
+}
+
+// IngestK8sNetworkPoliciesResult contains the result of the ingest activity.
+type IngestK8sNetworkPoliciesResult struct {
+	NetworkPolicyCount int
+	DurationMillis     int64
+}
+
+// IngestK8sNetworkPoliciesActivity is the activity function reference for workflow registration.
+var IngestK8sNetworkPoliciesActivity = (*Activities).IngestK8sNetworkPolicies
+
+// IngestK8sNetworkPolicies is a Temporal activity that ingests network policies from a Kubernetes cluster.
+func (a *Activities) IngestK8sNetworkPolicies(ctx context.Context, params IngestK8sNetworkPoliciesParams) (*IngestK8sNetworkPoliciesResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting Kubernetes network policy ingestion", "clusterName", params.ClusterName)
+
+	clientset, err := kubernetes.NewClientset(ctx, a.configService, params.ClusterName, a.limiter)
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("create clientset: %w", err))
+	}
+
+	service := NewService(clientset, a.entClient)
+	result, err := service.Ingest(ctx, params.ClusterName, func() {
+		activity.RecordHeartbeat(ctx, nil)
+	})
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("ingest network policies: %w", err))
+	}
+
+	logger.Info("Completed Kubernetes network policy ingestion",
+		"clusterName", params.ClusterName,
+		"networkPolicyCount", result.NetworkPolicyCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestK8sNetworkPoliciesResult{
+		NetworkPolicyCount: result.NetworkPolicyCount,
+		DurationMillis:     result.DurationMillis,
+	}, nil
+}
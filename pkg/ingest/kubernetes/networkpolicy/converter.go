@@ -0,0 +1,67 @@
+package networkpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+)
+
+// NetworkPolicyData holds converted network policy data ready for Ent insertion.
+type NetworkPolicyData struct {
+	ResourceID      string
+	ClusterName     string
+	Namespace       string
+	Name            string
+	UID             string
+	PodSelectorJSON json.RawMessage
+	PolicyTypesJSON json.RawMessage
+	IngressJSON     json.RawMessage
+	EgressJSON      json.RawMessage
+	LabelsJSON      json.RawMessage
+	APICreatedAt    *time.Time
+	CollectedAt     time.Time
+}
+
+// ConvertNetworkPolicy converts a Kubernetes network policy to NetworkPolicyData.
+func ConvertNetworkPolicy(clusterName string, np networkingv1.NetworkPolicy, collectedAt time.Time) *NetworkPolicyData {
+	return &NetworkPolicyData{
+		ResourceID:      kubernetes.ResourceID(clusterName, np.Namespace, np.Name),
+		ClusterName:     clusterName,
+		Namespace:       np.Namespace,
+		Name:            np.Name,
+		UID:             string(np.UID),
+		PodSelectorJSON: kubernetes.MarshalJSON(np.Spec.PodSelector),
+		PolicyTypesJSON: kubernetes.MarshalJSON(np.Spec.PolicyTypes),
+		IngressJSON:     kubernetes.MarshalJSON(np.Spec.Ingress),
+		EgressJSON:      kubernetes.MarshalJSON(np.Spec.Egress),
+		LabelsJSON:      kubernetes.MarshalJSON(np.Labels),
+		APICreatedAt:    kubernetes.CreatedAt(np.ObjectMeta),
+		CollectedAt:     collectedAt,
+	}
+}
+
+// fetchNetworkPolicies lists the network policies of all namespaces.
+func (s *Service) fetchNetworkPolicies(ctx context.Context, clusterName string, collectedAt time.Time) ([]*NetworkPolicyData, error) {
+	items, err := kubernetes.ListAll(func(opts metav1.ListOptions) ([]networkingv1.NetworkPolicy, string, error) {
+		list, err := s.clientset.NetworkingV1().NetworkPolicies(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return list.Items, list.Continue, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list network policies: %w", err)
+	}
+
+	result := make([]*NetworkPolicyData, 0, len(items))
+	for _, np := range items {
+		result = append(result, ConvertNetworkPolicy(clusterName, np, collectedAt))
+	}
+	return result, nil
+}
@@ -0,0 +1,33 @@
+package networkpolicy
+
+import (
+	"bytes"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+)
+
+// NetworkPolicyDiff represents changes between old and new network policy states.
+type NetworkPolicyDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffNetworkPolicyData compares old Ent entity and new data. The creation timestamp
+// is immutable and not compared.
+func DiffNetworkPolicyData(old *entk8s.BronzeK8sNetworkPolicy, new *NetworkPolicyData) *NetworkPolicyDiff {
+	if old == nil {
+		return &NetworkPolicyDiff{IsNew: true}
+	}
+
+	changed := old.ClusterName != new.ClusterName ||
+		old.Namespace != new.Namespace ||
+		old.Name != new.Name ||
+		old.UID != new.UID ||
+		!bytes.Equal(old.PodSelectorJSON, new.PodSelectorJSON) ||
+		!bytes.Equal(old.PolicyTypesJSON, new.PolicyTypesJSON) ||
+		!bytes.Equal(old.IngressJSON, new.IngressJSON) ||
+		!bytes.Equal(old.EgressJSON, new.EgressJSON) ||
+		!bytes.Equal(old.LabelsJSON, new.LabelsJSON)
+
+	return &NetworkPolicyDiff{IsChanged: changed}
+}
@@ -0,0 +1,116 @@
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snetworkpolicy"
+)
+
+// HistoryService handles history tracking for network policies.
+type HistoryService struct {
+	entClient *entk8s.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entk8s.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entk8s.Tx, data *NetworkPolicyData) *entk8s.BronzeHistoryK8sNetworkPolicyCreate {
+	create := tx.BronzeHistoryK8sNetworkPolicy.Create().
+		SetResourceID(data.ResourceID).
+		SetClusterName(data.ClusterName).
+		SetNamespace(data.Namespace).
+		SetName(data.Name).
+		SetUID(data.UID)
+	if data.PodSelectorJSON != nil {
+		create.SetPodSelectorJSON(data.PodSelectorJSON)
+	}
+	if data.PolicyTypesJSON != nil {
+		create.SetPolicyTypesJSON(data.PolicyTypesJSON)
+	}
+	if data.IngressJSON != nil {
+		create.SetIngressJSON(data.IngressJSON)
+	}
+	if data.EgressJSON != nil {
+		create.SetEgressJSON(data.EgressJSON)
+	}
+	if data.LabelsJSON != nil {
+		create.SetLabelsJSON(data.LabelsJSON)
+	}
+	if data.APICreatedAt != nil {
+		create.SetAPICreatedAt(*data.APICreatedAt)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new network policy.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entk8s.Tx, data *NetworkPolicyData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetCollectedAt(data.CollectedAt).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create network policy history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new for a changed network policy.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entk8s.Tx, old *entk8s.BronzeK8sNetworkPolicy, new *NetworkPolicyData, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryK8sNetworkPolicy.Query().
+		Where(
+			bronzehistoryk8snetworkpolicy.ResourceID(old.ID),
+			bronzehistoryk8snetworkpolicy.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		return fmt.Errorf("find current network policy history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryK8sNetworkPolicy.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close network policy history: %w", err)
+	}
+
+	_, err = h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetCollectedAt(new.CollectedAt).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create new network policy history: %w", err)
+	}
+
+	return nil
+}
+
+// CloseHistory closes history records for a deleted network policy.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entk8s.Tx, resourceID string, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryK8sNetworkPolicy.Query().
+		Where(
+			bronzehistoryk8snetworkpolicy.ResourceID(resourceID),
+			bronzehistoryk8snetworkpolicy.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		if entk8s.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("find current network policy history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryK8sNetworkPolicy.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close network policy history: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package networkpolicy
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider: "kubernetes",
+		Name:     "networkpolicy",
+		Register: Register,
+		Workflow: K8sNetworkPolicyWorkflow,
+		NewParams: func(clusterName, _, _ string) any {
+			return K8sNetworkPolicyWorkflowParams{ClusterName: clusterName}
+		},
+		NewResult: func() any { return &K8sNetworkPolicyWorkflowResult{} },
+		Aggregate: func(parent *kubernetes.KubernetesInventoryWorkflowResult, child any) {
+			r := child.(*K8sNetworkPolicyWorkflowResult)
+			parent.NetworkPolicyCount += r.NetworkPolicyCount
+		},
+	})
+}
@@ -0,0 +1,231 @@
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	k8s "k8s.io/client-go/kubernetes"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snetworkpolicy"
+)
+
+// Service handles Kubernetes network policy ingestion.
+type Service struct {
+	clientset k8s.Interface
+	entClient *entk8s.Client
+	history   *HistoryService
+}
+
+// NewService creates a new network policy ingestion service.
+func NewService(clientset k8s.Interface, entClient *entk8s.Client) *Service {
+	return &Service{
+		clientset: clientset,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestResult contains the result of network policy ingestion.
+type IngestResult struct {
+	NetworkPolicyCount int
+	CollectedAt        time.Time
+	DurationMillis     int64
+}
+
+// Ingest fetches all network policies of a cluster and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, clusterName string, heartbeat func()) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	networkPolicies, err := s.fetchNetworkPolicies(ctx, clusterName, collectedAt)
+	if err != nil {
+		return nil, err
+	}
+	if heartbeat != nil {
+		heartbeat()
+	}
+
+	slog.Info("k8s network policies fetched", "clusterName", clusterName, "count", len(networkPolicies))
+
+	if err := s.saveNetworkPolicies(ctx, clusterName, networkPolicies); err != nil {
+		return nil, fmt.Errorf("save network policies: %w", err)
+	}
+
+	return &IngestResult{
+		NetworkPolicyCount: len(networkPolicies),
+		CollectedAt:        collectedAt,
+		DurationMillis:     time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveNetworkPolicies upserts network policies with history tracking and removes the cluster's
+// network policies that no longer exist.
+func (s *Service) saveNetworkPolicies(ctx context.Context, clusterName string, items []*NetworkPolicyData) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	activeIDs := make(map[string]struct{}, len(items))
+
+	for _, data := range items {
+		existing, err := tx.BronzeK8sNetworkPolicy.Query().
+			Where(bronzek8snetworkpolicy.ID(data.ResourceID)).
+			First(ctx)
+		if err != nil && !entk8s.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("load existing network policy %s: %w", data.ResourceID, err)
+		}
+
+		activeIDs[data.ResourceID] = struct{}{}
+		diff := DiffNetworkPolicyData(existing, data)
+
+		if !diff.IsNew && !diff.IsChanged {
+			if err := tx.BronzeK8sNetworkPolicy.UpdateOneID(data.ResourceID).
+				SetCollectedAt(data.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update collected_at for network policy %s: %w", data.ResourceID, err)
+			}
+			continue
+		}
+
+		if existing == nil {
+			create := tx.BronzeK8sNetworkPolicy.Create().
+				SetID(data.ResourceID).
+				SetClusterName(data.ClusterName).
+				SetNamespace(data.Namespace).
+				SetName(data.Name).
+				SetUID(data.UID).
+				SetCollectedAt(data.CollectedAt).
+				SetFirstCollectedAt(data.CollectedAt)
+
+			if data.PodSelectorJSON != nil {
+				create.SetPodSelectorJSON(data.PodSelectorJSON)
+			}
+			if data.PolicyTypesJSON != nil {
+				create.SetPolicyTypesJSON(data.PolicyTypesJSON)
+			}
+			if data.IngressJSON != nil {
+				create.SetIngressJSON(data.IngressJSON)
+			}
+			if data.EgressJSON != nil {
+				create.SetEgressJSON(data.EgressJSON)
+			}
+			if data.LabelsJSON != nil {
+				create.SetLabelsJSON(data.LabelsJSON)
+			}
+			if data.APICreatedAt != nil {
+				create.SetAPICreatedAt(*data.APICreatedAt)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create network policy %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.CreateHistory(ctx, tx, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create history for network policy %s: %w", data.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeK8sNetworkPolicy.UpdateOneID(data.ResourceID).
+				SetClusterName(data.ClusterName).
+				SetNamespace(data.Namespace).
+				SetName(data.Name).
+				SetUID(data.UID).
+				SetCollectedAt(data.CollectedAt)
+
+			if data.PodSelectorJSON != nil {
+				update.SetPodSelectorJSON(data.PodSelectorJSON)
+			} else {
+				update.ClearPodSelectorJSON()
+			}
+			if data.PolicyTypesJSON != nil {
+				update.SetPolicyTypesJSON(data.PolicyTypesJSON)
+			} else {
+				update.ClearPolicyTypesJSON()
+			}
+			if data.IngressJSON != nil {
+				update.SetIngressJSON(data.IngressJSON)
+			} else {
+				update.ClearIngressJSON()
+			}
+			if data.EgressJSON != nil {
+				update.SetEgressJSON(data.EgressJSON)
+			} else {
+				update.ClearEgressJSON()
+			}
+			if data.LabelsJSON != nil {
+				update.SetLabelsJSON(data.LabelsJSON)
+			} else {
+				update.ClearLabelsJSON()
+			}
+			if data.APICreatedAt != nil {
+				update.SetAPICreatedAt(*data.APICreatedAt)
+			} else {
+				update.ClearAPICreatedAt()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update network policy %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.UpdateHistory(ctx, tx, existing, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update history for network policy %s: %w", data.ResourceID, err)
+			}
+		}
+	}
+
+	// Delete stale network policies of this cluster not returned by the API.
+	dbIDs, err := tx.BronzeK8sNetworkPolicy.Query().
+		Where(bronzek8snetworkpolicy.ClusterName(clusterName)).
+		Select(bronzek8snetworkpolicy.FieldID).
+		Strings(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("query network policy IDs: %w", err)
+	}
+
+	staleCount := 0
+	for _, id := range dbIDs {
+		if _, ok := activeIDs[id]; ok {
+			continue
+		}
+
+		if err := s.history.CloseHistory(ctx, tx, id, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("close history for stale network policy %s: %w", id, err)
+		}
+
+		if err := tx.BronzeK8sNetworkPolicy.DeleteOneID(id).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("delete stale network policy %s: %w", id, err)
+		}
+		staleCount++
+	}
+
+	if staleCount > 0 {
+		slog.Info("k8s network policies: deleted stale", "clusterName", clusterName, "count", staleCount)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
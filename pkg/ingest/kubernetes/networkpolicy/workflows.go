@@ -0,0 +1,58 @@
+package networkpolicy
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/base/temporalerr"
+)
+
+// K8sNetworkPolicyWorkflowParams contains parameters for the network policy workflow.
+type K8sNetworkPolicyWorkflowParams struct {
+	ClusterName string
+}
+
+// K8sNetworkPolicyWorkflowResult contains the result of the network policy workflow.
+type K8sNetworkPolicyWorkflowResult struct {
+	NetworkPolicyCount int
+	DurationMillis     int64
+}
+
+// K8sNetworkPolicyWorkflow ingests Kubernetes network policies from a single cluster.
+func K8sNetworkPolicyWorkflow(ctx workflow.Context, params K8sNetworkPolicyWorkflowParams) (*K8sNetworkPolicyWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting K8sNetworkPolicyWorkflow", "clusterName", params.ClusterName)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestK8sNetworkPoliciesResult
+	err := workflow.ExecuteActivity(activityCtx, IngestK8sNetworkPoliciesActivity, IngestK8sNetworkPoliciesParams{
+		ClusterName: params.ClusterName,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest network policies", "clusterName", params.ClusterName, "error", err)
+		return nil, temporalerr.PropagateNonRetryable(err)
+	}
+
+	logger.Info("Completed K8sNetworkPolicyWorkflow",
+		"clusterName", params.ClusterName,
+		"networkPolicyCount", result.NetworkPolicyCount,
+	)
+
+	return &K8sNetworkPolicyWorkflowResult{
+		NetworkPolicyCount: result.NetworkPolicyCount,
+		DurationMillis:     result.DurationMillis,
+	}, nil
+}
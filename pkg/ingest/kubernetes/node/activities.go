@@ -0,0 +1,74 @@
+package node
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/base/temporalerr"
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entk8s.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entk8s.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// IngestK8sNodesParams contains parameters for the ingest activity.
+type IngestK8sNodesParams struct {
+	ClusterName string
+}
+
+// IngestK8sNodesResult contains the result of the ingest activity.
+type IngestK8sNodesResult struct {
+	NodeCount      int
+	DurationMillis int64
+}
+
+// IngestK8sNodesActivity is the activity function reference for workflow registration.
+var IngestK8sNodesActivity = (*Activities).IngestK8sNodes
+
+// IngestK8sNodes is a Temporal activity that ingests nodes from a Kubernetes cluster.
+func (a *Activities) IngestK8sNodes(ctx context.Context, params IngestK8sNodesParams) (*IngestK8sNodesResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting Kubernetes node ingestion", "clusterName", params.ClusterName)
+
+	clientset, err := kubernetes.NewClientset(ctx, a.configService, params.ClusterName, a.limiter)
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("create clientset: %w", err))
+	}
+
+	service := NewService(clientset, a.entClient)
+	result, err := service.Ingest(ctx, params.ClusterName, func() {
+		activity.RecordHeartbeat(ctx, nil)
+	})
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("ingest nodes: %w", err))
+	}
+
+	logger.Info("Completed Kubernetes node ingestion",
+		"clusterName", params.ClusterName,
+		"nodeCount", result.NodeCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestK8sNodesResult{
+		NodeCount:      result.NodeCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
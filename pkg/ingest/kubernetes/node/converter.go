@@ -0,0 +1,123 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+)
+
+// nodePoolLabels are the node pool labels set by managed Kubernetes
+// services, in lookup order.
+var nodePoolLabels = []string{
+	"cloud.google.com/gke-nodepool",
+	"doks.digitalocean.com/node-pool",
+	"eks.amazonaws.com/nodegroup",
+	"vks.vngcloud.vn/nodegroup",
+	"karpenter.sh/nodepool",
+}
+
+// NodeData holds converted node data ready for Ent insertion.
+type NodeData struct {
+	ResourceID       string
+	ClusterName      string
+	Name             string
+	UID              string
+	ProviderID       string
+	InternalIP       string
+	ExternalIP       string
+	NodePool         string
+	InstanceType     string
+	Region           string
+	Zone             string
+	KubeletVersion   string
+	OSImage          string
+	KernelVersion    string
+	ContainerRuntime string
+	Architecture     string
+	Ready            bool
+	Unschedulable    bool
+	LabelsJSON       json.RawMessage
+	TaintsJSON       json.RawMessage
+	APICreatedAt     *time.Time
+	CollectedAt      time.Time
+}
+
+// ConvertNode converts a Kubernetes node to NodeData.
+func ConvertNode(clusterName string, node corev1.Node, collectedAt time.Time) *NodeData {
+	info := node.Status.NodeInfo
+	data := &NodeData{
+		ResourceID:       kubernetes.ResourceID(clusterName, node.Name),
+		ClusterName:      clusterName,
+		Name:             node.Name,
+		UID:              string(node.UID),
+		ProviderID:       node.Spec.ProviderID,
+		InstanceType:     node.Labels[corev1.LabelInstanceTypeStable],
+		Region:           node.Labels[corev1.LabelTopologyRegion],
+		Zone:             node.Labels[corev1.LabelTopologyZone],
+		KubeletVersion:   info.KubeletVersion,
+		OSImage:          info.OSImage,
+		KernelVersion:    info.KernelVersion,
+		ContainerRuntime: info.ContainerRuntimeVersion,
+		Architecture:     info.Architecture,
+		Unschedulable:    node.Spec.Unschedulable,
+		LabelsJSON:       kubernetes.MarshalJSON(node.Labels),
+		TaintsJSON:       kubernetes.MarshalJSON(node.Spec.Taints),
+		APICreatedAt:     kubernetes.CreatedAt(node.ObjectMeta),
+		CollectedAt:      collectedAt,
+	}
+
+	for _, label := range nodePoolLabels {
+		if v := node.Labels[label]; v != "" {
+			data.NodePool = v
+			break
+		}
+	}
+
+	for _, addr := range node.Status.Addresses {
+		switch addr.Type {
+		case corev1.NodeInternalIP:
+			if data.InternalIP == "" {
+				data.InternalIP = addr.Address
+			}
+		case corev1.NodeExternalIP:
+			if data.ExternalIP == "" {
+				data.ExternalIP = addr.Address
+			}
+		}
+	}
+
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == corev1.NodeReady {
+			data.Ready = cond.Status == corev1.ConditionTrue
+			break
+		}
+	}
+
+	return data
+}
+
+// fetchNodes lists all nodes of the cluster.
+func (s *Service) fetchNodes(ctx context.Context, clusterName string, collectedAt time.Time) ([]*NodeData, error) {
+	items, err := kubernetes.ListAll(func(opts metav1.ListOptions) ([]corev1.Node, string, error) {
+		list, err := s.clientset.CoreV1().Nodes().List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return list.Items, list.Continue, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list nodes: %w", err)
+	}
+
+	result := make([]*NodeData, 0, len(items))
+	for _, node := range items {
+		result = append(result, ConvertNode(clusterName, node, collectedAt))
+	}
+	return result, nil
+}
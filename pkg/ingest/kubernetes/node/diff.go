@@ -0,0 +1,43 @@
+package node
+
+import (
+	"bytes"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+)
+
+// NodeDiff represents changes between old and new node states.
+type NodeDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffNodeData compares old Ent entity and new data. The creation timestamp
+// is immutable and not compared.
+func DiffNodeData(old *entk8s.BronzeK8sNode, new *NodeData) *NodeDiff {
+	if old == nil {
+		return &NodeDiff{IsNew: true}
+	}
+
+	changed := old.ClusterName != new.ClusterName ||
+		old.Name != new.Name ||
+		old.UID != new.UID ||
+		old.ProviderID != new.ProviderID ||
+		old.InternalIP != new.InternalIP ||
+		old.ExternalIP != new.ExternalIP ||
+		old.NodePool != new.NodePool ||
+		old.InstanceType != new.InstanceType ||
+		old.Region != new.Region ||
+		old.Zone != new.Zone ||
+		old.KubeletVersion != new.KubeletVersion ||
+		old.OsImage != new.OSImage ||
+		old.KernelVersion != new.KernelVersion ||
+		old.ContainerRuntime != new.ContainerRuntime ||
+		old.Architecture != new.Architecture ||
+		old.Ready != new.Ready ||
+		old.Unschedulable != new.Unschedulable ||
+		!bytes.Equal(old.LabelsJSON, new.LabelsJSON) ||
+		!bytes.Equal(old.TaintsJSON, new.TaintsJSON)
+
+	return &NodeDiff{IsChanged: changed}
+}
@@ -0,0 +1,120 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snode"
+)
+
+// HistoryService handles history tracking for nodes.
+type HistoryService struct {
+	entClient *entk8s.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entk8s.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entk8s.Tx, data *NodeData) *entk8s.BronzeHistoryK8sNodeCreate {
+	create := tx.BronzeHistoryK8sNode.Create().
+		SetResourceID(data.ResourceID).
+		SetClusterName(data.ClusterName).
+		SetName(data.Name).
+		SetUID(data.UID).
+		SetProviderID(data.ProviderID).
+		SetInternalIP(data.InternalIP).
+		SetExternalIP(data.ExternalIP).
+		SetNodePool(data.NodePool).
+		SetInstanceType(data.InstanceType).
+		SetRegion(data.Region).
+		SetZone(data.Zone).
+		SetKubeletVersion(data.KubeletVersion).
+		SetOsImage(data.OSImage).
+		SetKernelVersion(data.KernelVersion).
+		SetContainerRuntime(data.ContainerRuntime).
+		SetArchitecture(data.Architecture).
+		SetReady(data.Ready).
+		SetUnschedulable(data.Unschedulable)
+	if data.LabelsJSON != nil {
+		create.SetLabelsJSON(data.LabelsJSON)
+	}
+	if data.TaintsJSON != nil {
+		create.SetTaintsJSON(data.TaintsJSON)
+	}
+	if data.APICreatedAt != nil {
+		create.SetAPICreatedAt(*data.APICreatedAt)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new node.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entk8s.Tx, data *NodeData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetCollectedAt(data.CollectedAt).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create node history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new for a changed node.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entk8s.Tx, old *entk8s.BronzeK8sNode, new *NodeData, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryK8sNode.Query().
+		Where(
+			bronzehistoryk8snode.ResourceID(old.ID),
+			bronzehistoryk8snode.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		return fmt.Errorf("find current node history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryK8sNode.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close node history: %w", err)
+	}
+
+	_, err = h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetCollectedAt(new.CollectedAt).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create new node history: %w", err)
+	}
+
+	return nil
+}
+
+// CloseHistory closes history records for a deleted node.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entk8s.Tx, resourceID string, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryK8sNode.Query().
+		Where(
+			bronzehistoryk8snode.ResourceID(resourceID),
+			bronzehistoryk8snode.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		if entk8s.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("find current node history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryK8sNode.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close node history: %w", err)
+	}
+
+	return nil
+}
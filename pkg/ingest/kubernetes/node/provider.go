@@ -0,0 +1,23 @@
+package node
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider: "kubernetes",
+		Name:     "node",
+		Register: Register,
+		Workflow: K8sNodeWorkflow,
+		NewParams: func(clusterName, _, _ string) any {
+			return K8sNodeWorkflowParams{ClusterName: clusterName}
+		},
+		NewResult: func() any { return &K8sNodeWorkflowResult{} },
+		Aggregate: func(parent *kubernetes.KubernetesInventoryWorkflowResult, child any) {
+			r := child.(*K8sNodeWorkflowResult)
+			parent.NodeCount += r.NodeCount
+		},
+	})
+}
@@ -0,0 +1,233 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	k8s "k8s.io/client-go/kubernetes"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snode"
+)
+
+// Service handles Kubernetes node ingestion.
+type Service struct {
+	clientset k8s.Interface
+	entClient *entk8s.Client
+	history   *HistoryService
+}
+
+// NewService creates a new node ingestion service.
+func NewService(clientset k8s.Interface, entClient *entk8s.Client) *Service {
+	return &Service{
+		clientset: clientset,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestResult contains the result of node ingestion.
+type IngestResult struct {
+	NodeCount      int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches all nodes of a cluster and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, clusterName string, heartbeat func()) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	nodes, err := s.fetchNodes(ctx, clusterName, collectedAt)
+	if err != nil {
+		return nil, err
+	}
+	if heartbeat != nil {
+		heartbeat()
+	}
+
+	slog.Info("k8s nodes fetched", "clusterName", clusterName, "count", len(nodes))
+
+	if err := s.saveNodes(ctx, clusterName, nodes); err != nil {
+		return nil, fmt.Errorf("save nodes: %w", err)
+	}
+
+	return &IngestResult{
+		NodeCount:      len(nodes),
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveNodes upserts nodes with history tracking and removes the cluster's
+// nodes that no longer exist.
+func (s *Service) saveNodes(ctx context.Context, clusterName string, items []*NodeData) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	activeIDs := make(map[string]struct{}, len(items))
+
+	for _, data := range items {
+		existing, err := tx.BronzeK8sNode.Query().
+			Where(bronzek8snode.ID(data.ResourceID)).
+			First(ctx)
+		if err != nil && !entk8s.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("load existing node %s: %w", data.ResourceID, err)
+		}
+
+		activeIDs[data.ResourceID] = struct{}{}
+		diff := DiffNodeData(existing, data)
+
+		if !diff.IsNew && !diff.IsChanged {
+			if err := tx.BronzeK8sNode.UpdateOneID(data.ResourceID).
+				SetCollectedAt(data.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update collected_at for node %s: %w", data.ResourceID, err)
+			}
+			continue
+		}
+
+		if existing == nil {
+			create := tx.BronzeK8sNode.Create().
+				SetID(data.ResourceID).
+				SetClusterName(data.ClusterName).
+				SetName(data.Name).
+				SetUID(data.UID).
+				SetProviderID(data.ProviderID).
+				SetInternalIP(data.InternalIP).
+				SetExternalIP(data.ExternalIP).
+				SetNodePool(data.NodePool).
+				SetInstanceType(data.InstanceType).
+				SetRegion(data.Region).
+				SetZone(data.Zone).
+				SetKubeletVersion(data.KubeletVersion).
+				SetOsImage(data.OSImage).
+				SetKernelVersion(data.KernelVersion).
+				SetContainerRuntime(data.ContainerRuntime).
+				SetArchitecture(data.Architecture).
+				SetReady(data.Ready).
+				SetUnschedulable(data.Unschedulable).
+				SetCollectedAt(data.CollectedAt).
+				SetFirstCollectedAt(data.CollectedAt)
+
+			if data.LabelsJSON != nil {
+				create.SetLabelsJSON(data.LabelsJSON)
+			}
+			if data.TaintsJSON != nil {
+				create.SetTaintsJSON(data.TaintsJSON)
+			}
+			if data.APICreatedAt != nil {
+				create.SetAPICreatedAt(*data.APICreatedAt)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create node %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.CreateHistory(ctx, tx, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create history for node %s: %w", data.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeK8sNode.UpdateOneID(data.ResourceID).
+				SetClusterName(data.ClusterName).
+				SetName(data.Name).
+				SetUID(data.UID).
+				SetProviderID(data.ProviderID).
+				SetInternalIP(data.InternalIP).
+				SetExternalIP(data.ExternalIP).
+				SetNodePool(data.NodePool).
+				SetInstanceType(data.InstanceType).
+				SetRegion(data.Region).
+				SetZone(data.Zone).
+				SetKubeletVersion(data.KubeletVersion).
+				SetOsImage(data.OSImage).
+				SetKernelVersion(data.KernelVersion).
+				SetContainerRuntime(data.ContainerRuntime).
+				SetArchitecture(data.Architecture).
+				SetReady(data.Ready).
+				SetUnschedulable(data.Unschedulable).
+				SetCollectedAt(data.CollectedAt)
+
+			if data.LabelsJSON != nil {
+				update.SetLabelsJSON(data.LabelsJSON)
+			} else {
+				update.ClearLabelsJSON()
+			}
+			if data.TaintsJSON != nil {
+				update.SetTaintsJSON(data.TaintsJSON)
+			} else {
+				update.ClearTaintsJSON()
+			}
+			if data.APICreatedAt != nil {
+				update.SetAPICreatedAt(*data.APICreatedAt)
+			} else {
+				update.ClearAPICreatedAt()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update node %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.UpdateHistory(ctx, tx, existing, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update history for node %s: %w", data.ResourceID, err)
+			}
+		}
+	}
+
+	// Delete stale nodes of this cluster not returned by the API.
+	dbIDs, err := tx.BronzeK8sNode.Query().
+		Where(bronzek8snode.ClusterName(clusterName)).
+		Select(bronzek8snode.FieldID).
+		Strings(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("query node IDs: %w", err)
+	}
+
+	staleCount := 0
+	for _, id := range dbIDs {
+		if _, ok := activeIDs[id]; ok {
+			continue
+		}
+
+		if err := s.history.CloseHistory(ctx, tx, id, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("close history for stale node %s: %w", id, err)
+		}
+
+		if err := tx.BronzeK8sNode.DeleteOneID(id).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("delete stale node %s: %w", id, err)
+		}
+		staleCount++
+	}
+
+	if staleCount > 0 {
+		slog.Info("k8s nodes: deleted stale", "clusterName", clusterName, "count", staleCount)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package node
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/base/temporalerr"
+)
+
+// K8sNodeWorkflowParams contains parameters for the node workflow.
+type K8sNodeWorkflowParams struct {
+	ClusterName string
+}
+
+// K8sNodeWorkflowResult contains the result of the node workflow.
+type K8sNodeWorkflowResult struct {
+	NodeCount      int
+	DurationMillis int64
+}
+
+// K8sNodeWorkflow ingests Kubernetes nodes from a single cluster.
+func K8sNodeWorkflow(ctx workflow.Context, params K8sNodeWorkflowParams) (*K8sNodeWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting K8sNodeWorkflow", "clusterName", params.ClusterName)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestK8sNodesResult
+	err := workflow.ExecuteActivity(activityCtx, IngestK8sNodesActivity, IngestK8sNodesParams{
+		ClusterName: params.ClusterName,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest nodes", "clusterName", params.ClusterName, "error", err)
+		return nil, temporalerr.PropagateNonRetryable(err)
+	}
+
+	logger.Info("Completed K8sNodeWorkflow",
+		"clusterName", params.ClusterName,
+		"nodeCount", result.NodeCount,
+	)
+
+	return &K8sNodeWorkflowResult{
+		NodeCount:      result.NodeCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package pod
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/base/temporalerr"
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entk8s.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entk8s.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// IngestK8sPodsParams contains parameters for the ingest activity.
+type IngestK8sPodsParams struct {
+	ClusterName string
+}
+
+// IngestK8sPodsResult contains the result of the ingest activity.
+type IngestK8sPodsResult struct {
+	PodCount       int
+	DurationMillis int64
+}
+
+// IngestK8sPodsActivity is the activity function reference for workflow registration.
+var IngestK8sPodsActivity = (*Activities).IngestK8sPods
+
+// IngestK8sPods is a Temporal activity that ingests pods from a Kubernetes cluster.
+func (a *Activities) IngestK8sPods(ctx context.Context, params IngestK8sPodsParams) (*IngestK8sPodsResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting Kubernetes pod ingestion", "clusterName", params.ClusterName)
+
+	clientset, err := kubernetes.NewClientset(ctx, a.configService, params.ClusterName, a.limiter)
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("create clientset: %w", err))
+	}
+
+	service := NewService(clientset, a.entClient)
+	result, err := service.Ingest(ctx, params.ClusterName, func() {
+		activity.RecordHeartbeat(ctx, nil)
+	})
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("ingest pods: %w", err))
+	}
+
+	logger.Info("Completed Kubernetes pod ingestion",
+		"clusterName", params.ClusterName,
+		"podCount", result.PodCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestK8sPodsResult{
+		PodCount:       result.PodCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package pod
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+)
+
+// PodData holds converted pod data ready for Ent insertion.
+type PodData struct {
+	ResourceID     string
+	ClusterName    string
+	Namespace      string
+	Name           string
+	UID            string
+	Phase          string
+	NodeName       string
+	PodIP          string
+	HostIP         string
+	ServiceAccount string
+	WorkloadKind   string
+	WorkloadName   string
+	HostNetwork    bool
+	ContainersJSON json.RawMessage
+	LabelsJSON     json.RawMessage
+	APICreatedAt   *time.Time
+	CollectedAt    time.Time
+}
+
+// ConvertPod converts a Kubernetes pod to PodData. workloadKind and
+// workloadName identify the top-level workload the pod belongs to.
+func ConvertPod(clusterName string, pod corev1.Pod, workloadKind, workloadName string, collectedAt time.Time) *PodData {
+	return &PodData{
+		ResourceID:     kubernetes.ResourceID(clusterName, pod.Namespace, pod.Name),
+		ClusterName:    clusterName,
+		Namespace:      pod.Namespace,
+		Name:           pod.Name,
+		UID:            string(pod.UID),
+		Phase:          string(pod.Status.Phase),
+		NodeName:       pod.Spec.NodeName,
+		PodIP:          pod.Status.PodIP,
+		HostIP:         pod.Status.HostIP,
+		ServiceAccount: pod.Spec.ServiceAccountName,
+		WorkloadKind:   workloadKind,
+		WorkloadName:   workloadName,
+		HostNetwork:    pod.Spec.HostNetwork,
+		ContainersJSON: kubernetes.MarshalJSON(kubernetes.Containers(pod.Spec)),
+		LabelsJSON:     kubernetes.MarshalJSON(pod.Labels),
+		APICreatedAt:   kubernetes.CreatedAt(pod.ObjectMeta),
+		CollectedAt:    collectedAt,
+	}
+}
+
+// fetchPods lists the pods of all namespaces.
+func (s *Service) fetchPods(ctx context.Context, clusterName string, collectedAt time.Time) ([]*PodData, error) {
+	set, err := kubernetes.ListPods(ctx, s.clientset)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*PodData, 0, len(set.Pods))
+	for i := range set.Pods {
+		kind, name := set.Workload(&set.Pods[i])
+		result = append(result, ConvertPod(clusterName, set.Pods[i], kind, name, collectedAt))
+	}
+	return result, nil
+}
@@ -0,0 +1,38 @@
+package pod
+
+import (
+	"bytes"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+)
+
+// PodDiff represents changes between old and new pod states.
+type PodDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffPodData compares old Ent entity and new data. The creation timestamp
+// is immutable and not compared.
+func DiffPodData(old *entk8s.BronzeK8sPod, new *PodData) *PodDiff {
+	if old == nil {
+		return &PodDiff{IsNew: true}
+	}
+
+	changed := old.ClusterName != new.ClusterName ||
+		old.Namespace != new.Namespace ||
+		old.Name != new.Name ||
+		old.UID != new.UID ||
+		old.Phase != new.Phase ||
+		old.NodeName != new.NodeName ||
+		old.PodIP != new.PodIP ||
+		old.HostIP != new.HostIP ||
+		old.ServiceAccount != new.ServiceAccount ||
+		old.WorkloadKind != new.WorkloadKind ||
+		old.WorkloadName != new.WorkloadName ||
+		old.HostNetwork != new.HostNetwork ||
+		!bytes.Equal(old.ContainersJSON, new.ContainersJSON) ||
+		!bytes.Equal(old.LabelsJSON, new.LabelsJSON)
+
+	return &PodDiff{IsChanged: changed}
+}
@@ -0,0 +1,115 @@
+package pod
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8spod"
+)
+
+// HistoryService handles history tracking for pods.
+type HistoryService struct {
+	entClient *entk8s.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entk8s.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entk8s.Tx, data *PodData) *entk8s.BronzeHistoryK8sPodCreate {
+	create := tx.BronzeHistoryK8sPod.Create().
+		SetResourceID(data.ResourceID).
+		SetClusterName(data.ClusterName).
+		SetNamespace(data.Namespace).
+		SetName(data.Name).
+		SetUID(data.UID).
+		SetPhase(data.Phase).
+		SetNodeName(data.NodeName).
+		SetPodIP(data.PodIP).
+		SetHostIP(data.HostIP).
+		SetServiceAccount(data.ServiceAccount).
+		SetWorkloadKind(data.WorkloadKind).
+		SetWorkloadName(data.WorkloadName).
+		SetHostNetwork(data.HostNetwork)
+	if data.ContainersJSON != nil {
+		create.SetContainersJSON(data.ContainersJSON)
+	}
+	if data.LabelsJSON != nil {
+		create.SetLabelsJSON(data.LabelsJSON)
+	}
+	if data.APICreatedAt != nil {
+		create.SetAPICreatedAt(*data.APICreatedAt)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new pod.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entk8s.Tx, data *PodData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetCollectedAt(data.CollectedAt).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create pod history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new for a changed pod.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entk8s.Tx, old *entk8s.BronzeK8sPod, new *PodData, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryK8sPod.Query().
+		Where(
+			bronzehistoryk8spod.ResourceID(old.ID),
+			bronzehistoryk8spod.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		return fmt.Errorf("find current pod history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryK8sPod.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close pod history: %w", err)
+	}
+
+	_, err = h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetCollectedAt(new.CollectedAt).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create new pod history: %w", err)
+	}
+
+	return nil
+}
+
+// CloseHistory closes history records for a deleted pod.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entk8s.Tx, resourceID string, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryK8sPod.Query().
+		Where(
+			bronzehistoryk8spod.ResourceID(resourceID),
+			bronzehistoryk8spod.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		if entk8s.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("find current pod history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryK8sPod.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close pod history: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package pod
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider: "kubernetes",
+		Name:     "pod",
+		Register: Register,
+		Workflow: K8sPodWorkflow,
+		NewParams: func(clusterName, _, _ string) any {
+			return K8sPodWorkflowParams{ClusterName: clusterName}
+		},
+		NewResult: func() any { return &K8sPodWorkflowResult{} },
+		Aggregate: func(parent *kubernetes.KubernetesInventoryWorkflowResult, child any) {
+			r := child.(*K8sPodWorkflowResult)
+			parent.PodCount += r.PodCount
+		},
+	})
+}
@@ -0,0 +1,223 @@
+package pod
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	k8s "k8s.io/client-go/kubernetes"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8spod"
+)
+
+// Service handles Kubernetes pod ingestion.
+type Service struct {
+	clientset k8s.Interface
+	entClient *entk8s.Client
+	history   *HistoryService
+}
+
+// NewService creates a new pod ingestion service.
+func NewService(clientset k8s.Interface, entClient *entk8s.Client) *Service {
+	return &Service{
+		clientset: clientset,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestResult contains the result of pod ingestion.
+type IngestResult struct {
+	PodCount       int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches all pods of a cluster and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, clusterName string, heartbeat func()) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	pods, err := s.fetchPods(ctx, clusterName, collectedAt)
+	if err != nil {
+		return nil, err
+	}
+	if heartbeat != nil {
+		heartbeat()
+	}
+
+	slog.Info("k8s pods fetched", "clusterName", clusterName, "count", len(pods))
+
+	if err := s.savePods(ctx, clusterName, pods); err != nil {
+		return nil, fmt.Errorf("save pods: %w", err)
+	}
+
+	return &IngestResult{
+		PodCount:       len(pods),
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// savePods upserts pods with history tracking and removes the cluster's
+// pods that no longer exist.
+func (s *Service) savePods(ctx context.Context, clusterName string, items []*PodData) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	activeIDs := make(map[string]struct{}, len(items))
+
+	for _, data := range items {
+		existing, err := tx.BronzeK8sPod.Query().
+			Where(bronzek8spod.ID(data.ResourceID)).
+			First(ctx)
+		if err != nil && !entk8s.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("load existing pod %s: %w", data.ResourceID, err)
+		}
+
+		activeIDs[data.ResourceID] = struct{}{}
+		diff := DiffPodData(existing, data)
+
+		if !diff.IsNew && !diff.IsChanged {
+			if err := tx.BronzeK8sPod.UpdateOneID(data.ResourceID).
+				SetCollectedAt(data.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update collected_at for pod %s: %w", data.ResourceID, err)
+			}
+			continue
+		}
+
+		if existing == nil {
+			create := tx.BronzeK8sPod.Create().
+				SetID(data.ResourceID).
+				SetClusterName(data.ClusterName).
+				SetNamespace(data.Namespace).
+				SetName(data.Name).
+				SetUID(data.UID).
+				SetPhase(data.Phase).
+				SetNodeName(data.NodeName).
+				SetPodIP(data.PodIP).
+				SetHostIP(data.HostIP).
+				SetServiceAccount(data.ServiceAccount).
+				SetWorkloadKind(data.WorkloadKind).
+				SetWorkloadName(data.WorkloadName).
+				SetHostNetwork(data.HostNetwork).
+				SetCollectedAt(data.CollectedAt).
+				SetFirstCollectedAt(data.CollectedAt)
+
+			if data.ContainersJSON != nil {
+				create.SetContainersJSON(data.ContainersJSON)
+			}
+			if data.LabelsJSON != nil {
+				create.SetLabelsJSON(data.LabelsJSON)
+			}
+			if data.APICreatedAt != nil {
+				create.SetAPICreatedAt(*data.APICreatedAt)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create pod %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.CreateHistory(ctx, tx, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create history for pod %s: %w", data.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeK8sPod.UpdateOneID(data.ResourceID).
+				SetClusterName(data.ClusterName).
+				SetNamespace(data.Namespace).
+				SetName(data.Name).
+				SetUID(data.UID).
+				SetPhase(data.Phase).
+				SetNodeName(data.NodeName).
+				SetPodIP(data.PodIP).
+				SetHostIP(data.HostIP).
+				SetServiceAccount(data.ServiceAccount).
+				SetWorkloadKind(data.WorkloadKind).
+				SetWorkloadName(data.WorkloadName).
+				SetHostNetwork(data.HostNetwork).
+				SetCollectedAt(data.CollectedAt)
+
+			if data.ContainersJSON != nil {
+				update.SetContainersJSON(data.ContainersJSON)
+			} else {
+				update.ClearContainersJSON()
+			}
+			if data.LabelsJSON != nil {
+				update.SetLabelsJSON(data.LabelsJSON)
+			} else {
+				update.ClearLabelsJSON()
+			}
+			if data.APICreatedAt != nil {
+				update.SetAPICreatedAt(*data.APICreatedAt)
+			} else {
+				update.ClearAPICreatedAt()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update pod %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.UpdateHistory(ctx, tx, existing, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update history for pod %s: %w", data.ResourceID, err)
+			}
+		}
+	}
+
+	// Delete stale pods of this cluster not returned by the API.
+	dbIDs, err := tx.BronzeK8sPod.Query().
+		Where(bronzek8spod.ClusterName(clusterName)).
+		Select(bronzek8spod.FieldID).
+		Strings(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("query pod IDs: %w", err)
+	}
+
+	staleCount := 0
+	for _, id := range dbIDs {
+		if _, ok := activeIDs[id]; ok {
+			continue
+		}
+
+		if err := s.history.CloseHistory(ctx, tx, id, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("close history for stale pod %s: %w", id, err)
+		}
+
+		if err := tx.BronzeK8sPod.DeleteOneID(id).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("delete stale pod %s: %w", id, err)
+		}
+		staleCount++
+	}
+
+	if staleCount > 0 {
+		slog.Info("k8s pods: deleted stale", "clusterName", clusterName, "count", staleCount)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package pod
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/base/temporalerr"
+)
+
+// K8sPodWorkflowParams contains parameters for the pod workflow.
+type K8sPodWorkflowParams struct {
+	ClusterName string
+}
+
+// K8sPodWorkflowResult contains the result of the pod workflow.
+type K8sPodWorkflowResult struct {
+	PodCount       int
+	DurationMillis int64
+}
+
+// K8sPodWorkflow ingests Kubernetes pods from a single cluster.
+func K8sPodWorkflow(ctx workflow.Context, params K8sPodWorkflowParams) (*K8sPodWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting K8sPodWorkflow", "clusterName", params.ClusterName)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestK8sPodsResult
+	err := workflow.ExecuteActivity(activityCtx, IngestK8sPodsActivity, IngestK8sPodsParams{
+		ClusterName: params.ClusterName,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest pods", "clusterName", params.ClusterName, "error", err)
+		return nil, temporalerr.PropagateNonRetryable(err)
+	}
+
+	logger.Info("Completed K8sPodWorkflow",
+		"clusterName", params.ClusterName,
+		"podCount", result.PodCount,
+	)
+
+	return &K8sPodWorkflowResult{
+		PodCount:       result.PodCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,93 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+// PodSet is the pods of a cluster together with the job owners needed to
+// attribute pods to their top-level workload.
+type PodSet struct {
+	Pods []corev1.Pod
+
+	// cronJobs maps namespace/job to the cron job that created the job.
+	cronJobs map[string]string
+}
+
+// ListPods lists the pods of all namespaces, and the jobs if any pod is
+// owned by one.
+func ListPods(ctx context.Context, clientset k8s.Interface) (*PodSet, error) {
+	pods, err := ListAll(func(opts metav1.ListOptions) ([]corev1.Pod, string, error) {
+		list, err := clientset.CoreV1().Pods(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return list.Items, list.Continue, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list pods: %w", err)
+	}
+
+	set := &PodSet{Pods: pods, cronJobs: make(map[string]string)}
+
+	ownedByJob := false
+	for i := range pods {
+		if ref := metav1.GetControllerOf(&pods[i]); ref != nil && ref.Kind == "Job" {
+			ownedByJob = true
+			break
+		}
+	}
+	if !ownedByJob {
+		return set, nil
+	}
+
+	jobs, err := ListAll(func(opts metav1.ListOptions) ([]batchv1.Job, string, error) {
+		list, err := clientset.BatchV1().Jobs(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return list.Items, list.Continue, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list jobs: %w", err)
+	}
+	for i := range jobs {
+		if ref := metav1.GetControllerOf(&jobs[i]); ref != nil && ref.Kind == "CronJob" {
+			set.cronJobs[jobs[i].Namespace+"/"+jobs[i].Name] = ref.Name
+		}
+	}
+
+	return set, nil
+}
+
+// Workload returns the kind and name of the top-level workload running pod:
+// a replica set created by a deployment resolves to the deployment and a
+// job created by a cron job to the cron job. Bare pods return empty strings.
+func (s *PodSet) Workload(pod *corev1.Pod) (kind, name string) {
+	ref := metav1.GetControllerOf(pod)
+	if ref == nil {
+		return "", ""
+	}
+
+	switch ref.Kind {
+	case "ReplicaSet":
+		// Deployments name their replica sets <deployment>-<pod-template-hash>.
+		if hash := pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; hash != "" {
+			if name, ok := strings.CutSuffix(ref.Name, "-"+hash); ok {
+				return "Deployment", name
+			}
+		}
+	case "Job":
+		if cronJob, ok := s.cronJobs[pod.Namespace+"/"+ref.Name]; ok {
+			return "CronJob", cronJob
+		}
+	}
+	return ref.Kind, ref.Name
+}
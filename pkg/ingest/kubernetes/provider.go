@@ -0,0 +1,24 @@
+package kubernetes
+
+import (
+	"io"
+
+	"entgo.io/ent/dialect"
+	"go.temporal.io/sdk/worker"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/ingest"
+)
+
+func init() {
+	ingest.RegisterProvider(ingest.ProviderRegistration{
+		Name:               "kubernetes",
+		TaskQueue:          "hotpot-ingest-kubernetes",
+		Enabled:            (*config.Service).KubernetesEnabled,
+		RateLimitPerMinute: (*config.Service).KubernetesRateLimitPerMinute,
+		Register: func(w worker.Worker, cs *config.Service, drv dialect.Driver) io.Closer {
+			return Register(w, cs, drv)
+		},
+		Workflow: KubernetesInventoryWorkflow,
+	})
+}
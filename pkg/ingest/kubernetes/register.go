@@ -0,0 +1,41 @@
+package kubernetes
+
+import (
+	"entgo.io/ent/dialect"
+	"go.temporal.io/sdk/worker"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/ingest"
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+)
+
+// serviceRegFunc is the function signature for Kubernetes service registration.
+type serviceRegFunc = func(worker.Worker, *config.Service, *entk8s.Client, ratelimit.Limiter)
+
+// Register registers all Kubernetes activities and workflows with the Temporal worker.
+// Returns the rate limit service for cleanup (caller should defer Close()).
+func Register(w worker.Worker, configService *config.Service, driver dialect.Driver) *ratelimit.Service {
+	// Create shared rate limiter for all cluster API calls
+	rateLimitSvc := ratelimit.NewService(ratelimit.ServiceOptions{
+		RedisConfig: configService.RedisConfig(),
+		KeyPrefix:   "ratelimit:kubernetes",
+		ReqPerMin:   configService.KubernetesRateLimitPerMinute(),
+	})
+	limiter := rateLimitSvc.Limiter()
+
+	entClient := entk8s.NewClient(entk8s.Driver(driver), entk8s.AlternateSchema(entk8s.DefaultSchemaConfig()))
+
+	// Register provider-level activities
+	activities := NewActivities(configService)
+	w.RegisterActivity(activities.ListKubernetesClusters)
+
+	for _, svc := range ingest.Services("kubernetes") {
+		svc.Register.(serviceRegFunc)(w, configService, entClient, limiter)
+	}
+
+	// Register inventory workflow
+	w.RegisterWorkflow(KubernetesInventoryWorkflow)
+
+	return rateLimitSvc
+}
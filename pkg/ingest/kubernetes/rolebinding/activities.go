@@ -0,0 +1,74 @@
+package rolebinding
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/base/temporalerr"
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entk8s.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entk8s.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// IngestK8sRoleBindingsParams contains parameters for the ingest activity.
+type IngestK8sRoleBindingsParams struct {
+	ClusterName string
+}
+
+// IngestK8sRoleBindingsResult contains the result of the ingest activity.
+type IngestK8sRoleBindingsResult struct {
+	RoleBindingCount int
+	DurationMillis   int64
+}
+
+// IngestK8sRoleBindingsActivity is the activity function reference for workflow registration.
+var IngestK8sRoleBindingsActivity = (*Activities).IngestK8sRoleBindings
+
+// IngestK8sRoleBindings is a Temporal activity that ingests role bindings from a Kubernetes cluster.
+func (a *Activities) IngestK8sRoleBindings(ctx context.Context, params IngestK8sRoleBindingsParams) (*IngestK8sRoleBindingsResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting Kubernetes role binding ingestion", "clusterName", params.ClusterName)
+
+	clientset, err := kubernetes.NewClientset(ctx, a.configService, params.ClusterName, a.limiter)
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("create clientset: %w", err))
+	}
+
+	service := NewService(clientset, a.entClient)
+	result, err := service.Ingest(ctx, params.ClusterName, func() {
+		activity.RecordHeartbeat(ctx, nil)
+	})
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("ingest role bindings: %w", err))
+	}
+
+	logger.Info("Completed Kubernetes role binding ingestion",
+		"clusterName", params.ClusterName,
+		"roleBindingCount", result.RoleBindingCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestK8sRoleBindingsResult{
+		RoleBindingCount: result.RoleBindingCount,
+		DurationMillis:   result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,161 @@
+package rolebinding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+)
+
+// Binding kinds.
+const (
+	KindRoleBinding        = "RoleBinding"
+	KindClusterRoleBinding = "ClusterRoleBinding"
+)
+
+// RoleBindingData holds converted role binding data ready for Ent insertion.
+type RoleBindingData struct {
+	ResourceID   string
+	ClusterName  string
+	Kind         string
+	Namespace    string
+	Name         string
+	UID          string
+	RoleKind     string
+	RoleName     string
+	SubjectsJSON json.RawMessage
+	RulesJSON    json.RawMessage
+	LabelsJSON   json.RawMessage
+	APICreatedAt *time.Time
+	CollectedAt  time.Time
+}
+
+// Roles indexes the rules of roles and cluster roles by reference.
+type Roles struct {
+	roles        map[string][]rbacv1.PolicyRule // namespace/name
+	clusterRoles map[string][]rbacv1.PolicyRule // name
+}
+
+// Rules returns the rules of the role a binding in namespace refers to, or
+// nil when the role does not exist.
+func (r *Roles) Rules(namespace string, ref rbacv1.RoleRef) []rbacv1.PolicyRule {
+	if ref.Kind == "ClusterRole" {
+		return r.clusterRoles[ref.Name]
+	}
+	return r.roles[namespace+"/"+ref.Name]
+}
+
+// ConvertRoleBinding converts a namespaced role binding to RoleBindingData.
+func ConvertRoleBinding(clusterName string, rb rbacv1.RoleBinding, roles *Roles, collectedAt time.Time) *RoleBindingData {
+	data := convertBinding(clusterName, KindRoleBinding, rb.ObjectMeta, rb.RoleRef, rb.Subjects, collectedAt)
+	data.ResourceID = kubernetes.ResourceID(clusterName, KindRoleBinding, rb.Namespace, rb.Name)
+	data.RulesJSON = kubernetes.MarshalJSON(roles.Rules(rb.Namespace, rb.RoleRef))
+	return data
+}
+
+// ConvertClusterRoleBinding converts a cluster role binding to RoleBindingData.
+func ConvertClusterRoleBinding(clusterName string, crb rbacv1.ClusterRoleBinding, roles *Roles, collectedAt time.Time) *RoleBindingData {
+	data := convertBinding(clusterName, KindClusterRoleBinding, crb.ObjectMeta, crb.RoleRef, crb.Subjects, collectedAt)
+	data.ResourceID = kubernetes.ResourceID(clusterName, KindClusterRoleBinding, crb.Name)
+	data.RulesJSON = kubernetes.MarshalJSON(roles.Rules("", crb.RoleRef))
+	return data
+}
+
+func convertBinding(clusterName, kind string, meta metav1.ObjectMeta, ref rbacv1.RoleRef, subjects []rbacv1.Subject, collectedAt time.Time) *RoleBindingData {
+	return &RoleBindingData{
+		ClusterName:  clusterName,
+		Kind:         kind,
+		Namespace:    meta.Namespace,
+		Name:         meta.Name,
+		UID:          string(meta.UID),
+		RoleKind:     ref.Kind,
+		RoleName:     ref.Name,
+		SubjectsJSON: kubernetes.MarshalJSON(subjects),
+		LabelsJSON:   kubernetes.MarshalJSON(meta.Labels),
+		APICreatedAt: kubernetes.CreatedAt(meta),
+		CollectedAt:  collectedAt,
+	}
+}
+
+// fetchRoles lists roles and cluster roles so that bindings can carry the
+// rules they grant.
+func (s *Service) fetchRoles(ctx context.Context) (*Roles, error) {
+	roles, err := kubernetes.ListAll(func(opts metav1.ListOptions) ([]rbacv1.Role, string, error) {
+		list, err := s.clientset.RbacV1().Roles(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return list.Items, list.Continue, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list roles: %w", err)
+	}
+
+	clusterRoles, err := kubernetes.ListAll(func(opts metav1.ListOptions) ([]rbacv1.ClusterRole, string, error) {
+		list, err := s.clientset.RbacV1().ClusterRoles().List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return list.Items, list.Continue, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list cluster roles: %w", err)
+	}
+
+	result := &Roles{
+		roles:        make(map[string][]rbacv1.PolicyRule, len(roles)),
+		clusterRoles: make(map[string][]rbacv1.PolicyRule, len(clusterRoles)),
+	}
+	for _, r := range roles {
+		result.roles[r.Namespace+"/"+r.Name] = r.Rules
+	}
+	for _, cr := range clusterRoles {
+		result.clusterRoles[cr.Name] = cr.Rules
+	}
+	return result, nil
+}
+
+// fetchRoleBindings lists role bindings of all namespaces and cluster role
+// bindings.
+func (s *Service) fetchRoleBindings(ctx context.Context, clusterName string, collectedAt time.Time) ([]*RoleBindingData, error) {
+	roles, err := s.fetchRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings, err := kubernetes.ListAll(func(opts metav1.ListOptions) ([]rbacv1.RoleBinding, string, error) {
+		list, err := s.clientset.RbacV1().RoleBindings(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return list.Items, list.Continue, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list role bindings: %w", err)
+	}
+
+	clusterBindings, err := kubernetes.ListAll(func(opts metav1.ListOptions) ([]rbacv1.ClusterRoleBinding, string, error) {
+		list, err := s.clientset.RbacV1().ClusterRoleBindings().List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return list.Items, list.Continue, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list cluster role bindings: %w", err)
+	}
+
+	result := make([]*RoleBindingData, 0, len(bindings)+len(clusterBindings))
+	for _, rb := range bindings {
+		result = append(result, ConvertRoleBinding(clusterName, rb, roles, collectedAt))
+	}
+	for _, crb := range clusterBindings {
+		result = append(result, ConvertClusterRoleBinding(clusterName, crb, roles, collectedAt))
+	}
+	return result, nil
+}
@@ -0,0 +1,72 @@
+package rolebinding
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestFetchRoleBindings(t *testing.T) {
+	clientset := fake.NewClientset(
+		&rbacv1.Role{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "web", Name: "reader"},
+			Rules:      []rbacv1.PolicyRule{{Verbs: []string{"get"}, Resources: []string{"pods"}, APIGroups: []string{""}}},
+		},
+		&rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-admin"},
+			Rules:      []rbacv1.PolicyRule{{Verbs: []string{"*"}, Resources: []string{"*"}, APIGroups: []string{"*"}}},
+		},
+		&rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "web", Name: "read-pods"},
+			RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: "reader"},
+			Subjects:   []rbacv1.Subject{{Kind: "ServiceAccount", Name: "api", Namespace: "web"}},
+		},
+		&rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "web", Name: "dangling"},
+			RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: "missing"},
+		},
+		&rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "admins"},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "cluster-admin"},
+			Subjects:   []rbacv1.Subject{{Kind: "Group", Name: "ops"}},
+		},
+	)
+
+	svc := &Service{clientset: clientset}
+	collected := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	bindings, err := svc.fetchRoleBindings(context.Background(), "prod", collected)
+	if err != nil {
+		t.Fatalf("fetchRoleBindings: %v", err)
+	}
+
+	byID := make(map[string]*RoleBindingData, len(bindings))
+	for _, b := range bindings {
+		byID[b.ResourceID] = b
+	}
+
+	rb := byID["prod/RoleBinding/web/read-pods"]
+	if rb == nil {
+		t.Fatalf("role binding missing, got %v", byID)
+	}
+	var rules []rbacv1.PolicyRule
+	if err := json.Unmarshal(rb.RulesJSON, &rules); err != nil || len(rules) != 1 || rules[0].Verbs[0] != "get" {
+		t.Errorf("role binding rules = %s", rb.RulesJSON)
+	}
+
+	if d := byID["prod/RoleBinding/web/dangling"]; d == nil || d.RulesJSON != nil || d.SubjectsJSON != nil {
+		t.Errorf("dangling binding = %+v", d)
+	}
+
+	crb := byID["prod/ClusterRoleBinding/admins"]
+	if crb == nil || crb.Kind != KindClusterRoleBinding || crb.Namespace != "" || crb.RoleName != "cluster-admin" {
+		t.Fatalf("cluster role binding = %+v", crb)
+	}
+	if err := json.Unmarshal(crb.RulesJSON, &rules); err != nil || rules[0].Verbs[0] != "*" {
+		t.Errorf("cluster role binding rules = %s", crb.RulesJSON)
+	}
+}
@@ -0,0 +1,34 @@
+package rolebinding
+
+import (
+	"bytes"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+)
+
+// RoleBindingDiff represents changes between old and new role binding states.
+type RoleBindingDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffRoleBindingData compares old Ent entity and new data. The creation timestamp
+// is immutable and not compared.
+func DiffRoleBindingData(old *entk8s.BronzeK8sRoleBinding, new *RoleBindingData) *RoleBindingDiff {
+	if old == nil {
+		return &RoleBindingDiff{IsNew: true}
+	}
+
+	changed := old.ClusterName != new.ClusterName ||
+		old.Kind != new.Kind ||
+		old.Namespace != new.Namespace ||
+		old.Name != new.Name ||
+		old.UID != new.UID ||
+		old.RoleKind != new.RoleKind ||
+		old.RoleName != new.RoleName ||
+		!bytes.Equal(old.SubjectsJSON, new.SubjectsJSON) ||
+		!bytes.Equal(old.RulesJSON, new.RulesJSON) ||
+		!bytes.Equal(old.LabelsJSON, new.LabelsJSON)
+
+	return &RoleBindingDiff{IsChanged: changed}
+}
@@ -0,0 +1,113 @@
+package rolebinding
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8srolebinding"
+)
+
+// HistoryService handles history tracking for role bindings.
+type HistoryService struct {
+	entClient *entk8s.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entk8s.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entk8s.Tx, data *RoleBindingData) *entk8s.BronzeHistoryK8sRoleBindingCreate {
+	create := tx.BronzeHistoryK8sRoleBinding.Create().
+		SetResourceID(data.ResourceID).
+		SetClusterName(data.ClusterName).
+		SetKind(data.Kind).
+		SetNamespace(data.Namespace).
+		SetName(data.Name).
+		SetUID(data.UID).
+		SetRoleKind(data.RoleKind).
+		SetRoleName(data.RoleName)
+	if data.SubjectsJSON != nil {
+		create.SetSubjectsJSON(data.SubjectsJSON)
+	}
+	if data.RulesJSON != nil {
+		create.SetRulesJSON(data.RulesJSON)
+	}
+	if data.LabelsJSON != nil {
+		create.SetLabelsJSON(data.LabelsJSON)
+	}
+	if data.APICreatedAt != nil {
+		create.SetAPICreatedAt(*data.APICreatedAt)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new role binding.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entk8s.Tx, data *RoleBindingData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetCollectedAt(data.CollectedAt).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create role binding history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new for a changed role binding.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entk8s.Tx, old *entk8s.BronzeK8sRoleBinding, new *RoleBindingData, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryK8sRoleBinding.Query().
+		Where(
+			bronzehistoryk8srolebinding.ResourceID(old.ID),
+			bronzehistoryk8srolebinding.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		return fmt.Errorf("find current role binding history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryK8sRoleBinding.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close role binding history: %w", err)
+	}
+
+	_, err = h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetCollectedAt(new.CollectedAt).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create new role binding history: %w", err)
+	}
+
+	return nil
+}
+
+// CloseHistory closes history records for a deleted role binding.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entk8s.Tx, resourceID string, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryK8sRoleBinding.Query().
+		Where(
+			bronzehistoryk8srolebinding.ResourceID(resourceID),
+			bronzehistoryk8srolebinding.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		if entk8s.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("find current role binding history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryK8sRoleBinding.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close role binding history: %w", err)
+	}
+
+	return nil
+}
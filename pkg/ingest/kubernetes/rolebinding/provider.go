@@ -0,0 +1,23 @@
+package rolebinding
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider: "kubernetes",
+		Name:     "rolebinding",
+		Register: Register,
+		Workflow: K8sRoleBindingWorkflow,
+		NewParams: func(clusterName, _, _ string) any {
+			return K8sRoleBindingWorkflowParams{ClusterName: clusterName}
+		},
+		NewResult: func() any { return &K8sRoleBindingWorkflowResult{} },
+		Aggregate: func(parent *kubernetes.KubernetesInventoryWorkflowResult, child any) {
+			r := child.(*K8sRoleBindingWorkflowResult)
+			parent.RoleBindingCount += r.RoleBindingCount
+		},
+	})
+}
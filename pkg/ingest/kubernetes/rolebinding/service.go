@@ -0,0 +1,221 @@
+package rolebinding
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	k8s "k8s.io/client-go/kubernetes"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8srolebinding"
+)
+
+// Service handles Kubernetes role binding ingestion.
+type Service struct {
+	clientset k8s.Interface
+	entClient *entk8s.Client
+	history   *HistoryService
+}
+
+// NewService creates a new role binding ingestion service.
+func NewService(clientset k8s.Interface, entClient *entk8s.Client) *Service {
+	return &Service{
+		clientset: clientset,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestResult contains the result of role binding ingestion.
+type IngestResult struct {
+	RoleBindingCount int
+	CollectedAt      time.Time
+	DurationMillis   int64
+}
+
+// Ingest fetches all role bindings of a cluster and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, clusterName string, heartbeat func()) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	roleBindings, err := s.fetchRoleBindings(ctx, clusterName, collectedAt)
+	if err != nil {
+		return nil, err
+	}
+	if heartbeat != nil {
+		heartbeat()
+	}
+
+	slog.Info("k8s role bindings fetched", "clusterName", clusterName, "count", len(roleBindings))
+
+	if err := s.saveRoleBindings(ctx, clusterName, roleBindings); err != nil {
+		return nil, fmt.Errorf("save role bindings: %w", err)
+	}
+
+	return &IngestResult{
+		RoleBindingCount: len(roleBindings),
+		CollectedAt:      collectedAt,
+		DurationMillis:   time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveRoleBindings upserts role bindings with history tracking and removes the cluster's
+// role bindings that no longer exist.
+func (s *Service) saveRoleBindings(ctx context.Context, clusterName string, items []*RoleBindingData) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	activeIDs := make(map[string]struct{}, len(items))
+
+	for _, data := range items {
+		existing, err := tx.BronzeK8sRoleBinding.Query().
+			Where(bronzek8srolebinding.ID(data.ResourceID)).
+			First(ctx)
+		if err != nil && !entk8s.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("load existing role binding %s: %w", data.ResourceID, err)
+		}
+
+		activeIDs[data.ResourceID] = struct{}{}
+		diff := DiffRoleBindingData(existing, data)
+
+		if !diff.IsNew && !diff.IsChanged {
+			if err := tx.BronzeK8sRoleBinding.UpdateOneID(data.ResourceID).
+				SetCollectedAt(data.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update collected_at for role binding %s: %w", data.ResourceID, err)
+			}
+			continue
+		}
+
+		if existing == nil {
+			create := tx.BronzeK8sRoleBinding.Create().
+				SetID(data.ResourceID).
+				SetClusterName(data.ClusterName).
+				SetKind(data.Kind).
+				SetNamespace(data.Namespace).
+				SetName(data.Name).
+				SetUID(data.UID).
+				SetRoleKind(data.RoleKind).
+				SetRoleName(data.RoleName).
+				SetCollectedAt(data.CollectedAt).
+				SetFirstCollectedAt(data.CollectedAt)
+
+			if data.SubjectsJSON != nil {
+				create.SetSubjectsJSON(data.SubjectsJSON)
+			}
+			if data.RulesJSON != nil {
+				create.SetRulesJSON(data.RulesJSON)
+			}
+			if data.LabelsJSON != nil {
+				create.SetLabelsJSON(data.LabelsJSON)
+			}
+			if data.APICreatedAt != nil {
+				create.SetAPICreatedAt(*data.APICreatedAt)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create role binding %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.CreateHistory(ctx, tx, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create history for role binding %s: %w", data.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeK8sRoleBinding.UpdateOneID(data.ResourceID).
+				SetClusterName(data.ClusterName).
+				SetKind(data.Kind).
+				SetNamespace(data.Namespace).
+				SetName(data.Name).
+				SetUID(data.UID).
+				SetRoleKind(data.RoleKind).
+				SetRoleName(data.RoleName).
+				SetCollectedAt(data.CollectedAt)
+
+			if data.SubjectsJSON != nil {
+				update.SetSubjectsJSON(data.SubjectsJSON)
+			} else {
+				update.ClearSubjectsJSON()
+			}
+			if data.RulesJSON != nil {
+				update.SetRulesJSON(data.RulesJSON)
+			} else {
+				update.ClearRulesJSON()
+			}
+			if data.LabelsJSON != nil {
+				update.SetLabelsJSON(data.LabelsJSON)
+			} else {
+				update.ClearLabelsJSON()
+			}
+			if data.APICreatedAt != nil {
+				update.SetAPICreatedAt(*data.APICreatedAt)
+			} else {
+				update.ClearAPICreatedAt()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update role binding %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.UpdateHistory(ctx, tx, existing, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update history for role binding %s: %w", data.ResourceID, err)
+			}
+		}
+	}
+
+	// Delete stale role bindings of this cluster not returned by the API.
+	dbIDs, err := tx.BronzeK8sRoleBinding.Query().
+		Where(bronzek8srolebinding.ClusterName(clusterName)).
+		Select(bronzek8srolebinding.FieldID).
+		Strings(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("query role binding IDs: %w", err)
+	}
+
+	staleCount := 0
+	for _, id := range dbIDs {
+		if _, ok := activeIDs[id]; ok {
+			continue
+		}
+
+		if err := s.history.CloseHistory(ctx, tx, id, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("close history for stale role binding %s: %w", id, err)
+		}
+
+		if err := tx.BronzeK8sRoleBinding.DeleteOneID(id).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("delete stale role binding %s: %w", id, err)
+		}
+		staleCount++
+	}
+
+	if staleCount > 0 {
+		slog.Info("k8s role bindings: deleted stale", "clusterName", clusterName, "count", staleCount)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package rolebinding
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/base/temporalerr"
+)
+
+// K8sRoleBindingWorkflowParams contains parameters for the role binding workflow.
+type K8sRoleBindingWorkflowParams struct {
+	ClusterName string
+}
+
+// K8sRoleBindingWorkflowResult contains the result of the role binding workflow.
+type K8sRoleBindingWorkflowResult struct {
+	RoleBindingCount int
+	DurationMillis   int64
+}
+
+// K8sRoleBindingWorkflow ingests Kubernetes role bindings from a single cluster.
+func K8sRoleBindingWorkflow(ctx workflow.Context, params K8sRoleBindingWorkflowParams) (*K8sRoleBindingWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting K8sRoleBindingWorkflow", "clusterName", params.ClusterName)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestK8sRoleBindingsResult
+	err := workflow.ExecuteActivity(activityCtx, IngestK8sRoleBindingsActivity, IngestK8sRoleBindingsParams{
+		ClusterName: params.ClusterName,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest role bindings", "clusterName", params.ClusterName, "error", err)
+		return nil, temporalerr.PropagateNonRetryable(err)
+	}
+
+	logger.Info("Completed K8sRoleBindingWorkflow",
+		"clusterName", params.ClusterName,
+		"roleBindingCount", result.RoleBindingCount,
+	)
+
+	return &K8sRoleBindingWorkflowResult{
+		RoleBindingCount: result.RoleBindingCount,
+		DurationMillis:   result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package serviceaccount
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/base/temporalerr"
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entk8s.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entk8s.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// IngestK8sServiceAccountsParams contains parameters for the ingest activity.
+type IngestK8sServiceAccountsParams struct {
+	ClusterName string
+}
+
+// IngestK8sServiceAccountsResult contains the result of the ingest activity.
+type IngestK8sServiceAccountsResult struct {
+	ServiceAccountCount int
+	DurationMillis      int64
+}
+
+// IngestK8sServiceAccountsActivity is the activity function reference for workflow registration.
+var IngestK8sServiceAccountsActivity = (*Activities).IngestK8sServiceAccounts
+
+// IngestK8sServiceAccounts is a Temporal activity that ingests service accounts from a Kubernetes cluster.
+func (a *Activities) IngestK8sServiceAccounts(ctx context.Context, params IngestK8sServiceAccountsParams) (*IngestK8sServiceAccountsResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting Kubernetes service account ingestion", "clusterName", params.ClusterName)
+
+	clientset, err := kubernetes.NewClientset(ctx, a.configService, params.ClusterName, a.limiter)
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("create clientset: %w", err))
+	}
+
+	service := NewService(clientset, a.entClient)
+	result, err := service.Ingest(ctx, params.ClusterName, func() {
+		activity.RecordHeartbeat(ctx, nil)
+	})
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("ingest service accounts: %w", err))
+	}
+
+	logger.Info("Completed Kubernetes service account ingestion",
+		"clusterName", params.ClusterName,
+		"serviceAccountCount", result.ServiceAccountCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestK8sServiceAccountsResult{
+		ServiceAccountCount: result.ServiceAccountCount,
+		DurationMillis:      result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,85 @@
+package serviceaccount
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+)
+
+// Annotations binding a service account to a cloud identity.
+const (
+	annotationGKEWorkloadIdentity = "iam.gke.io/gcp-service-account"
+	annotationEKSRoleARN          = "eks.amazonaws.com/role-arn"
+)
+
+// ServiceAccountData holds converted service account data ready for Ent insertion.
+type ServiceAccountData struct {
+	ResourceID           string
+	ClusterName          string
+	Namespace            string
+	Name                 string
+	UID                  string
+	AutomountToken       *bool
+	CloudIdentity        string
+	ImagePullSecretsJSON json.RawMessage
+	LabelsJSON           json.RawMessage
+	APICreatedAt         *time.Time
+	CollectedAt          time.Time
+}
+
+// ConvertServiceAccount converts a Kubernetes service account to
+// ServiceAccountData. CloudIdentity is the GCP service account or AWS role
+// the account impersonates through workload identity, if any.
+func ConvertServiceAccount(clusterName string, sa corev1.ServiceAccount, collectedAt time.Time) *ServiceAccountData {
+	data := &ServiceAccountData{
+		ResourceID:     kubernetes.ResourceID(clusterName, sa.Namespace, sa.Name),
+		ClusterName:    clusterName,
+		Namespace:      sa.Namespace,
+		Name:           sa.Name,
+		UID:            string(sa.UID),
+		AutomountToken: sa.AutomountServiceAccountToken,
+		LabelsJSON:     kubernetes.MarshalJSON(sa.Labels),
+		APICreatedAt:   kubernetes.CreatedAt(sa.ObjectMeta),
+		CollectedAt:    collectedAt,
+	}
+
+	if v := sa.Annotations[annotationGKEWorkloadIdentity]; v != "" {
+		data.CloudIdentity = v
+	} else if v := sa.Annotations[annotationEKSRoleARN]; v != "" {
+		data.CloudIdentity = v
+	}
+
+	secrets := make([]string, 0, len(sa.ImagePullSecrets))
+	for _, ref := range sa.ImagePullSecrets {
+		secrets = append(secrets, ref.Name)
+	}
+	data.ImagePullSecretsJSON = kubernetes.MarshalJSON(secrets)
+
+	return data
+}
+
+// fetchServiceAccounts lists the service accounts of all namespaces.
+func (s *Service) fetchServiceAccounts(ctx context.Context, clusterName string, collectedAt time.Time) ([]*ServiceAccountData, error) {
+	items, err := kubernetes.ListAll(func(opts metav1.ListOptions) ([]corev1.ServiceAccount, string, error) {
+		list, err := s.clientset.CoreV1().ServiceAccounts(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return list.Items, list.Continue, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list service accounts: %w", err)
+	}
+
+	result := make([]*ServiceAccountData, 0, len(items))
+	for _, sa := range items {
+		result = append(result, ConvertServiceAccount(clusterName, sa, collectedAt))
+	}
+	return result, nil
+}
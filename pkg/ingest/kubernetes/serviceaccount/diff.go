@@ -0,0 +1,39 @@
+package serviceaccount
+
+import (
+	"bytes"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+)
+
+// ServiceAccountDiff represents changes between old and new service account states.
+type ServiceAccountDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffServiceAccountData compares old Ent entity and new data. The creation timestamp
+// is immutable and not compared.
+func DiffServiceAccountData(old *entk8s.BronzeK8sServiceAccount, new *ServiceAccountData) *ServiceAccountDiff {
+	if old == nil {
+		return &ServiceAccountDiff{IsNew: true}
+	}
+
+	changed := old.ClusterName != new.ClusterName ||
+		old.Namespace != new.Namespace ||
+		old.Name != new.Name ||
+		old.UID != new.UID ||
+		!boolPtrEqual(old.AutomountToken, new.AutomountToken) ||
+		old.CloudIdentity != new.CloudIdentity ||
+		!bytes.Equal(old.ImagePullSecretsJSON, new.ImagePullSecretsJSON) ||
+		!bytes.Equal(old.LabelsJSON, new.LabelsJSON)
+
+	return &ServiceAccountDiff{IsChanged: changed}
+}
+
+func boolPtrEqual(a, b *bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
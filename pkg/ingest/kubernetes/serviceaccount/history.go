@@ -0,0 +1,111 @@
+package serviceaccount
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8sserviceaccount"
+)
+
+// HistoryService handles history tracking for service accounts.
+type HistoryService struct {
+	entClient *entk8s.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entk8s.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entk8s.Tx, data *ServiceAccountData) *entk8s.BronzeHistoryK8sServiceAccountCreate {
+	create := tx.BronzeHistoryK8sServiceAccount.Create().
+		SetResourceID(data.ResourceID).
+		SetClusterName(data.ClusterName).
+		SetNamespace(data.Namespace).
+		SetName(data.Name).
+		SetUID(data.UID).
+		SetCloudIdentity(data.CloudIdentity)
+	if data.AutomountToken != nil {
+		create.SetAutomountToken(*data.AutomountToken)
+	}
+	if data.ImagePullSecretsJSON != nil {
+		create.SetImagePullSecretsJSON(data.ImagePullSecretsJSON)
+	}
+	if data.LabelsJSON != nil {
+		create.SetLabelsJSON(data.LabelsJSON)
+	}
+	if data.APICreatedAt != nil {
+		create.SetAPICreatedAt(*data.APICreatedAt)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new service account.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entk8s.Tx, data *ServiceAccountData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetCollectedAt(data.CollectedAt).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create service account history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new for a changed service account.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entk8s.Tx, old *entk8s.BronzeK8sServiceAccount, new *ServiceAccountData, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryK8sServiceAccount.Query().
+		Where(
+			bronzehistoryk8sserviceaccount.ResourceID(old.ID),
+			bronzehistoryk8sserviceaccount.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		return fmt.Errorf("find current service account history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryK8sServiceAccount.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close service account history: %w", err)
+	}
+
+	_, err = h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetCollectedAt(new.CollectedAt).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create new service account history: %w", err)
+	}
+
+	return nil
+}
+
+// CloseHistory closes history records for a deleted service account.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entk8s.Tx, resourceID string, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryK8sServiceAccount.Query().
+		Where(
+			bronzehistoryk8sserviceaccount.ResourceID(resourceID),
+			bronzehistoryk8sserviceaccount.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		if entk8s.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("find current service account history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryK8sServiceAccount.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close service account history: %w", err)
+	}
+
+	return nil
+}
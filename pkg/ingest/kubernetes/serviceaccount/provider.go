@@ -0,0 +1,23 @@
+package serviceaccount
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider: "kubernetes",
+		Name:     "serviceaccount",
+		Register: Register,
+		Workflow: K8sServiceAccountWorkflow,
+		NewParams: func(clusterName, _, _ string) any {
+			return K8sServiceAccountWorkflowParams{ClusterName: clusterName}
+		},
+		NewResult: func() any { return &K8sServiceAccountWorkflowResult{} },
+		Aggregate: func(parent *kubernetes.KubernetesInventoryWorkflowResult, child any) {
+			r := child.(*K8sServiceAccountWorkflowResult)
+			parent.ServiceAccountCount += r.ServiceAccountCount
+		},
+	})
+}
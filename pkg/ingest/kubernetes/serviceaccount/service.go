@@ -0,0 +1,217 @@
+package serviceaccount
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	k8s "k8s.io/client-go/kubernetes"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8sserviceaccount"
+)
+
+// Service handles Kubernetes service account ingestion.
+type Service struct {
+	clientset k8s.Interface
+	entClient *entk8s.Client
+	history   *HistoryService
+}
+
+// NewService creates a new service account ingestion service.
+func NewService(clientset k8s.Interface, entClient *entk8s.Client) *Service {
+	return &Service{
+		clientset: clientset,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestResult contains the result of service account ingestion.
+type IngestResult struct {
+	ServiceAccountCount int
+	CollectedAt         time.Time
+	DurationMillis      int64
+}
+
+// Ingest fetches all service accounts of a cluster and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, clusterName string, heartbeat func()) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	serviceAccounts, err := s.fetchServiceAccounts(ctx, clusterName, collectedAt)
+	if err != nil {
+		return nil, err
+	}
+	if heartbeat != nil {
+		heartbeat()
+	}
+
+	slog.Info("k8s service accounts fetched", "clusterName", clusterName, "count", len(serviceAccounts))
+
+	if err := s.saveServiceAccounts(ctx, clusterName, serviceAccounts); err != nil {
+		return nil, fmt.Errorf("save service accounts: %w", err)
+	}
+
+	return &IngestResult{
+		ServiceAccountCount: len(serviceAccounts),
+		CollectedAt:         collectedAt,
+		DurationMillis:      time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveServiceAccounts upserts service accounts with history tracking and removes the cluster's
+// service accounts that no longer exist.
+func (s *Service) saveServiceAccounts(ctx context.Context, clusterName string, items []*ServiceAccountData) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	activeIDs := make(map[string]struct{}, len(items))
+
+	for _, data := range items {
+		existing, err := tx.BronzeK8sServiceAccount.Query().
+			Where(bronzek8sserviceaccount.ID(data.ResourceID)).
+			First(ctx)
+		if err != nil && !entk8s.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("load existing service account %s: %w", data.ResourceID, err)
+		}
+
+		activeIDs[data.ResourceID] = struct{}{}
+		diff := DiffServiceAccountData(existing, data)
+
+		if !diff.IsNew && !diff.IsChanged {
+			if err := tx.BronzeK8sServiceAccount.UpdateOneID(data.ResourceID).
+				SetCollectedAt(data.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update collected_at for service account %s: %w", data.ResourceID, err)
+			}
+			continue
+		}
+
+		if existing == nil {
+			create := tx.BronzeK8sServiceAccount.Create().
+				SetID(data.ResourceID).
+				SetClusterName(data.ClusterName).
+				SetNamespace(data.Namespace).
+				SetName(data.Name).
+				SetUID(data.UID).
+				SetCloudIdentity(data.CloudIdentity).
+				SetCollectedAt(data.CollectedAt).
+				SetFirstCollectedAt(data.CollectedAt)
+
+			if data.AutomountToken != nil {
+				create.SetAutomountToken(*data.AutomountToken)
+			}
+			if data.ImagePullSecretsJSON != nil {
+				create.SetImagePullSecretsJSON(data.ImagePullSecretsJSON)
+			}
+			if data.LabelsJSON != nil {
+				create.SetLabelsJSON(data.LabelsJSON)
+			}
+			if data.APICreatedAt != nil {
+				create.SetAPICreatedAt(*data.APICreatedAt)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create service account %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.CreateHistory(ctx, tx, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create history for service account %s: %w", data.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeK8sServiceAccount.UpdateOneID(data.ResourceID).
+				SetClusterName(data.ClusterName).
+				SetNamespace(data.Namespace).
+				SetName(data.Name).
+				SetUID(data.UID).
+				SetCloudIdentity(data.CloudIdentity).
+				SetCollectedAt(data.CollectedAt)
+
+			if data.AutomountToken != nil {
+				update.SetAutomountToken(*data.AutomountToken)
+			} else {
+				update.ClearAutomountToken()
+			}
+			if data.ImagePullSecretsJSON != nil {
+				update.SetImagePullSecretsJSON(data.ImagePullSecretsJSON)
+			} else {
+				update.ClearImagePullSecretsJSON()
+			}
+			if data.LabelsJSON != nil {
+				update.SetLabelsJSON(data.LabelsJSON)
+			} else {
+				update.ClearLabelsJSON()
+			}
+			if data.APICreatedAt != nil {
+				update.SetAPICreatedAt(*data.APICreatedAt)
+			} else {
+				update.ClearAPICreatedAt()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update service account %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.UpdateHistory(ctx, tx, existing, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update history for service account %s: %w", data.ResourceID, err)
+			}
+		}
+	}
+
+	// Delete stale service accounts of this cluster not returned by the API.
+	dbIDs, err := tx.BronzeK8sServiceAccount.Query().
+		Where(bronzek8sserviceaccount.ClusterName(clusterName)).
+		Select(bronzek8sserviceaccount.FieldID).
+		Strings(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("query service account IDs: %w", err)
+	}
+
+	staleCount := 0
+	for _, id := range dbIDs {
+		if _, ok := activeIDs[id]; ok {
+			continue
+		}
+
+		if err := s.history.CloseHistory(ctx, tx, id, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("close history for stale service account %s: %w", id, err)
+		}
+
+		if err := tx.BronzeK8sServiceAccount.DeleteOneID(id).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("delete stale service account %s: %w", id, err)
+		}
+		staleCount++
+	}
+
+	if staleCount > 0 {
+		slog.Info("k8s service accounts: deleted stale", "clusterName", clusterName, "count", staleCount)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package serviceaccount
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/base/temporalerr"
+)
+
+// K8sServiceAccountWorkflowParams contains parameters for the service account workflow.
+type K8sServiceAccountWorkflowParams struct {
+	ClusterName string
+}
+
+// K8sServiceAccountWorkflowResult contains the result of the service account workflow.
+type K8sServiceAccountWorkflowResult struct {
+	ServiceAccountCount int
+	DurationMillis      int64
+}
+
+// K8sServiceAccountWorkflow ingests Kubernetes service accounts from a single cluster.
+func K8sServiceAccountWorkflow(ctx workflow.Context, params K8sServiceAccountWorkflowParams) (*K8sServiceAccountWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting K8sServiceAccountWorkflow", "clusterName", params.ClusterName)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestK8sServiceAccountsResult
+	err := workflow.ExecuteActivity(activityCtx, IngestK8sServiceAccountsActivity, IngestK8sServiceAccountsParams{
+		ClusterName: params.ClusterName,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest service accounts", "clusterName", params.ClusterName, "error", err)
+		return nil, temporalerr.PropagateNonRetryable(err)
+	}
+
+	logger.Info("Completed K8sServiceAccountWorkflow",
+		"clusterName", params.ClusterName,
+		"serviceAccountCount", result.ServiceAccountCount,
+	)
+
+	return &K8sServiceAccountWorkflowResult{
+		ServiceAccountCount: result.ServiceAccountCount,
+		DurationMillis:      result.DurationMillis,
+	}, nil
+}
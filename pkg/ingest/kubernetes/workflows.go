@@ -0,0 +1,113 @@
+package kubernetes
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/ingest"
+)
+
+// KubernetesInventoryWorkflowResult contains the result of the Kubernetes inventory workflow.
+type KubernetesInventoryWorkflowResult struct {
+	NamespaceCount      int
+	NodeCount           int
+	WorkloadCount       int
+	PodCount            int
+	ImageCount          int
+	ServiceAccountCount int
+	RoleBindingCount    int
+	NetworkPolicyCount  int
+	ClusterResults      []ClusterResult
+}
+
+// ClusterResult contains the ingestion result for a single cluster.
+type ClusterResult struct {
+	ClusterName string
+	Error       string
+}
+
+// aggregateFunc is the function signature for merging a service result into the provider result.
+type aggregateFunc = func(*KubernetesInventoryWorkflowResult, any)
+
+// KubernetesInventoryWorkflow ingests in-cluster objects from all configured clusters.
+func KubernetesInventoryWorkflow(ctx workflow.Context) (*KubernetesInventoryWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting KubernetesInventoryWorkflow")
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 1 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var listResult ListKubernetesClustersResult
+	err := workflow.ExecuteActivity(activityCtx, ListKubernetesClustersActivity).Get(ctx, &listResult)
+	if err != nil {
+		logger.Error("Failed to list Kubernetes clusters", "error", err)
+		return nil, err
+	}
+
+	childOpts := workflow.ChildWorkflowOptions{
+		WorkflowExecutionTimeout: 30 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	ctx = workflow.WithChildOptions(ctx, childOpts)
+
+	result := &KubernetesInventoryWorkflowResult{
+		ClusterResults: make([]ClusterResult, 0, len(listResult.ClusterNames)),
+	}
+
+	services := ingest.Services("kubernetes")
+
+	for _, clusterName := range listResult.ClusterNames {
+		clusterResult := ClusterResult{ClusterName: clusterName}
+
+		for _, svc := range services {
+			res := svc.NewResult()
+			err := workflow.ExecuteChildWorkflow(ctx, svc.Workflow,
+				svc.NewParams(clusterName, "", "")).Get(ctx, res)
+			if err != nil {
+				logger.Error("Failed ingestion", "service", svc.Name, "clusterName", clusterName, "error", err)
+				appendError(&clusterResult, err)
+			} else {
+				svc.Aggregate.(aggregateFunc)(result, res)
+			}
+		}
+
+		result.ClusterResults = append(result.ClusterResults, clusterResult)
+	}
+
+	logger.Info("Completed KubernetesInventoryWorkflow",
+		"clusters", len(listResult.ClusterNames),
+		"namespaces", result.NamespaceCount,
+		"nodes", result.NodeCount,
+		"workloads", result.WorkloadCount,
+		"pods", result.PodCount,
+		"images", result.ImageCount,
+		"serviceAccounts", result.ServiceAccountCount,
+		"roleBindings", result.RoleBindingCount,
+		"networkPolicies", result.NetworkPolicyCount,
+	)
+
+	return result, nil
+}
+
+func appendError(cr *ClusterResult, err error) {
+	if cr.Error == "" {
+		cr.Error = err.Error()
+	} else {
+		cr.Error += "; " + err.Error()
+	}
+}
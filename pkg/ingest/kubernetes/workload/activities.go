@@ -0,0 +1,74 @@
+package workload
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/base/temporalerr"
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entk8s.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entk8s.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+// IngestK8sWorkloadsParams contains parameters for the ingest activity.
+type IngestK8sWorkloadsParams struct {
+	ClusterName string
+}
+
+// IngestK8sWorkloadsResult contains the result of the ingest activity.
+type IngestK8sWorkloadsResult struct {
+	WorkloadCount  int
+	DurationMillis int64
+}
+
+// IngestK8sWorkloadsActivity is the activity function reference for workflow registration.
+var IngestK8sWorkloadsActivity = (*Activities).IngestK8sWorkloads
+
+// IngestK8sWorkloads is a Temporal activity that ingests workloads from a Kubernetes cluster.
+func (a *Activities) IngestK8sWorkloads(ctx context.Context, params IngestK8sWorkloadsParams) (*IngestK8sWorkloadsResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting Kubernetes workload ingestion", "clusterName", params.ClusterName)
+
+	clientset, err := kubernetes.NewClientset(ctx, a.configService, params.ClusterName, a.limiter)
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("create clientset: %w", err))
+	}
+
+	service := NewService(clientset, a.entClient)
+	result, err := service.Ingest(ctx, params.ClusterName, func() {
+		activity.RecordHeartbeat(ctx, nil)
+	})
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("ingest workloads: %w", err))
+	}
+
+	logger.Info("Completed Kubernetes workload ingestion",
+		"clusterName", params.ClusterName,
+		"workloadCount", result.WorkloadCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestK8sWorkloadsResult{
+		WorkloadCount:  result.WorkloadCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
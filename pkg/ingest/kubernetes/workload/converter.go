@@ -0,0 +1,184 @@
+package workload
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+)
+
+// Workload kinds.
+const (
+	KindDeployment  = "Deployment"
+	KindStatefulSet = "StatefulSet"
+	KindDaemonSet   = "DaemonSet"
+	KindReplicaSet  = "ReplicaSet"
+	KindJob         = "Job"
+	KindCronJob     = "CronJob"
+)
+
+// WorkloadData holds converted workload data ready for Ent insertion.
+type WorkloadData struct {
+	ResourceID     string
+	ClusterName    string
+	Namespace      string
+	Kind           string
+	Name           string
+	UID            string
+	Replicas       *int32
+	ServiceAccount string
+	HostNetwork    bool
+	HostPID        bool
+	HostIPC        bool
+	ImagesJSON     json.RawMessage
+	ContainersJSON json.RawMessage
+	SelectorJSON   json.RawMessage
+	LabelsJSON     json.RawMessage
+	APICreatedAt   *time.Time
+	CollectedAt    time.Time
+}
+
+// convertWorkload converts the metadata and pod template of a workload.
+func convertWorkload(clusterName, kind string, meta metav1.ObjectMeta, replicas *int32, selector *metav1.LabelSelector, spec corev1.PodSpec, collectedAt time.Time) *WorkloadData {
+	return &WorkloadData{
+		ResourceID:     kubernetes.ResourceID(clusterName, meta.Namespace, kind, meta.Name),
+		ClusterName:    clusterName,
+		Namespace:      meta.Namespace,
+		Kind:           kind,
+		Name:           meta.Name,
+		UID:            string(meta.UID),
+		Replicas:       replicas,
+		ServiceAccount: spec.ServiceAccountName,
+		HostNetwork:    spec.HostNetwork,
+		HostPID:        spec.HostPID,
+		HostIPC:        spec.HostIPC,
+		ImagesJSON:     kubernetes.MarshalJSON(kubernetes.Images(spec)),
+		ContainersJSON: kubernetes.MarshalJSON(kubernetes.Containers(spec)),
+		SelectorJSON:   kubernetes.MarshalJSON(selector),
+		LabelsJSON:     kubernetes.MarshalJSON(meta.Labels),
+		APICreatedAt:   kubernetes.CreatedAt(meta),
+		CollectedAt:    collectedAt,
+	}
+}
+
+// hasController reports whether the object is managed by a controller of
+// one of the given kinds.
+func hasController(meta metav1.ObjectMeta, kinds ...string) bool {
+	ref := metav1.GetControllerOf(&meta)
+	if ref == nil {
+		return false
+	}
+	for _, kind := range kinds {
+		if ref.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchWorkloads lists deployments, stateful sets, daemon sets, jobs and
+// cron jobs of all namespaces. Replica sets owned by a deployment and jobs
+// owned by a cron job are skipped: their pod template is the owner's.
+func (s *Service) fetchWorkloads(ctx context.Context, clusterName string, collectedAt time.Time) ([]*WorkloadData, error) {
+	var result []*WorkloadData
+
+	deployments, err := kubernetes.ListAll(func(opts metav1.ListOptions) ([]appsv1.Deployment, string, error) {
+		list, err := s.clientset.AppsV1().Deployments(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return list.Items, list.Continue, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list deployments: %w", err)
+	}
+	for _, d := range deployments {
+		result = append(result, convertWorkload(clusterName, KindDeployment, d.ObjectMeta, d.Spec.Replicas, d.Spec.Selector, d.Spec.Template.Spec, collectedAt))
+	}
+
+	statefulSets, err := kubernetes.ListAll(func(opts metav1.ListOptions) ([]appsv1.StatefulSet, string, error) {
+		list, err := s.clientset.AppsV1().StatefulSets(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return list.Items, list.Continue, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list stateful sets: %w", err)
+	}
+	for _, ss := range statefulSets {
+		result = append(result, convertWorkload(clusterName, KindStatefulSet, ss.ObjectMeta, ss.Spec.Replicas, ss.Spec.Selector, ss.Spec.Template.Spec, collectedAt))
+	}
+
+	daemonSets, err := kubernetes.ListAll(func(opts metav1.ListOptions) ([]appsv1.DaemonSet, string, error) {
+		list, err := s.clientset.AppsV1().DaemonSets(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return list.Items, list.Continue, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list daemon sets: %w", err)
+	}
+	for _, ds := range daemonSets {
+		result = append(result, convertWorkload(clusterName, KindDaemonSet, ds.ObjectMeta, nil, ds.Spec.Selector, ds.Spec.Template.Spec, collectedAt))
+	}
+
+	replicaSets, err := kubernetes.ListAll(func(opts metav1.ListOptions) ([]appsv1.ReplicaSet, string, error) {
+		list, err := s.clientset.AppsV1().ReplicaSets(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return list.Items, list.Continue, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list replica sets: %w", err)
+	}
+	for _, rs := range replicaSets {
+		if hasController(rs.ObjectMeta, KindDeployment) {
+			continue
+		}
+		result = append(result, convertWorkload(clusterName, KindReplicaSet, rs.ObjectMeta, rs.Spec.Replicas, rs.Spec.Selector, rs.Spec.Template.Spec, collectedAt))
+	}
+
+	jobs, err := kubernetes.ListAll(func(opts metav1.ListOptions) ([]batchv1.Job, string, error) {
+		list, err := s.clientset.BatchV1().Jobs(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return list.Items, list.Continue, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list jobs: %w", err)
+	}
+	for _, j := range jobs {
+		if hasController(j.ObjectMeta, KindCronJob) {
+			continue
+		}
+		result = append(result, convertWorkload(clusterName, KindJob, j.ObjectMeta, j.Spec.Parallelism, j.Spec.Selector, j.Spec.Template.Spec, collectedAt))
+	}
+
+	cronJobs, err := kubernetes.ListAll(func(opts metav1.ListOptions) ([]batchv1.CronJob, string, error) {
+		list, err := s.clientset.BatchV1().CronJobs(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return list.Items, list.Continue, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list cron jobs: %w", err)
+	}
+	for _, cj := range cronJobs {
+		tmpl := cj.Spec.JobTemplate.Spec
+		result = append(result, convertWorkload(clusterName, KindCronJob, cj.ObjectMeta, nil, tmpl.Selector, tmpl.Template.Spec, collectedAt))
+	}
+
+	return result, nil
+}
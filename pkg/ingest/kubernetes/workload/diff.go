@@ -0,0 +1,45 @@
+package workload
+
+import (
+	"bytes"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+)
+
+// WorkloadDiff represents changes between old and new workload states.
+type WorkloadDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffWorkloadData compares old Ent entity and new data. The creation timestamp
+// is immutable and not compared.
+func DiffWorkloadData(old *entk8s.BronzeK8sWorkload, new *WorkloadData) *WorkloadDiff {
+	if old == nil {
+		return &WorkloadDiff{IsNew: true}
+	}
+
+	changed := old.ClusterName != new.ClusterName ||
+		old.Namespace != new.Namespace ||
+		old.Kind != new.Kind ||
+		old.Name != new.Name ||
+		old.UID != new.UID ||
+		!int32PtrEqual(old.Replicas, new.Replicas) ||
+		old.ServiceAccount != new.ServiceAccount ||
+		old.HostNetwork != new.HostNetwork ||
+		old.HostPid != new.HostPID ||
+		old.HostIpc != new.HostIPC ||
+		!bytes.Equal(old.ImagesJSON, new.ImagesJSON) ||
+		!bytes.Equal(old.ContainersJSON, new.ContainersJSON) ||
+		!bytes.Equal(old.SelectorJSON, new.SelectorJSON) ||
+		!bytes.Equal(old.LabelsJSON, new.LabelsJSON)
+
+	return &WorkloadDiff{IsChanged: changed}
+}
+
+func int32PtrEqual(a, b *int32) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
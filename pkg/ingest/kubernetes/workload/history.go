@@ -0,0 +1,121 @@
+package workload
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8sworkload"
+)
+
+// HistoryService handles history tracking for workloads.
+type HistoryService struct {
+	entClient *entk8s.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *entk8s.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *entk8s.Tx, data *WorkloadData) *entk8s.BronzeHistoryK8sWorkloadCreate {
+	create := tx.BronzeHistoryK8sWorkload.Create().
+		SetResourceID(data.ResourceID).
+		SetClusterName(data.ClusterName).
+		SetNamespace(data.Namespace).
+		SetKind(data.Kind).
+		SetName(data.Name).
+		SetUID(data.UID).
+		SetServiceAccount(data.ServiceAccount).
+		SetHostNetwork(data.HostNetwork).
+		SetHostPid(data.HostPID).
+		SetHostIpc(data.HostIPC)
+	if data.Replicas != nil {
+		create.SetReplicas(*data.Replicas)
+	}
+	if data.ImagesJSON != nil {
+		create.SetImagesJSON(data.ImagesJSON)
+	}
+	if data.ContainersJSON != nil {
+		create.SetContainersJSON(data.ContainersJSON)
+	}
+	if data.SelectorJSON != nil {
+		create.SetSelectorJSON(data.SelectorJSON)
+	}
+	if data.LabelsJSON != nil {
+		create.SetLabelsJSON(data.LabelsJSON)
+	}
+	if data.APICreatedAt != nil {
+		create.SetAPICreatedAt(*data.APICreatedAt)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new workload.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *entk8s.Tx, data *WorkloadData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetCollectedAt(data.CollectedAt).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create workload history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new for a changed workload.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *entk8s.Tx, old *entk8s.BronzeK8sWorkload, new *WorkloadData, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryK8sWorkload.Query().
+		Where(
+			bronzehistoryk8sworkload.ResourceID(old.ID),
+			bronzehistoryk8sworkload.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		return fmt.Errorf("find current workload history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryK8sWorkload.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close workload history: %w", err)
+	}
+
+	_, err = h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetCollectedAt(new.CollectedAt).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create new workload history: %w", err)
+	}
+
+	return nil
+}
+
+// CloseHistory closes history records for a deleted workload.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *entk8s.Tx, resourceID string, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryK8sWorkload.Query().
+		Where(
+			bronzehistoryk8sworkload.ResourceID(resourceID),
+			bronzehistoryk8sworkload.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		if entk8s.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("find current workload history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryK8sWorkload.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close workload history: %w", err)
+	}
+
+	return nil
+}
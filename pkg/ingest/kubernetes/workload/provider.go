@@ -0,0 +1,23 @@
+package workload
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/kubernetes"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider: "kubernetes",
+		Name:     "workload",
+		Register: Register,
+		Workflow: K8sWorkloadWorkflow,
+		NewParams: func(clusterName, _, _ string) any {
+			return K8sWorkloadWorkflowParams{ClusterName: clusterName}
+		},
+		NewResult: func() any { return &K8sWorkloadWorkflowResult{} },
+		Aggregate: func(parent *kubernetes.KubernetesInventoryWorkflowResult, child any) {
+			r := child.(*K8sWorkloadWorkflowResult)
+			parent.WorkloadCount += r.WorkloadCount
+		},
+	})
+}
@@ -0,0 +1,241 @@
+package workload
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	k8s "k8s.io/client-go/kubernetes"
+
+	entk8s "danny.vn/hotpot/pkg/storage/ent/kubernetes"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8sworkload"
+)
+
+// Service handles Kubernetes workload ingestion.
+type Service struct {
+	clientset k8s.Interface
+	entClient *entk8s.Client
+	history   *HistoryService
+}
+
+// NewService creates a new workload ingestion service.
+func NewService(clientset k8s.Interface, entClient *entk8s.Client) *Service {
+	return &Service{
+		clientset: clientset,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestResult contains the result of workload ingestion.
+type IngestResult struct {
+	WorkloadCount  int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches all workloads of a cluster and stores them in the bronze layer.
+func (s *Service) Ingest(ctx context.Context, clusterName string, heartbeat func()) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	workloads, err := s.fetchWorkloads(ctx, clusterName, collectedAt)
+	if err != nil {
+		return nil, err
+	}
+	if heartbeat != nil {
+		heartbeat()
+	}
+
+	slog.Info("k8s workloads fetched", "clusterName", clusterName, "count", len(workloads))
+
+	if err := s.saveWorkloads(ctx, clusterName, workloads); err != nil {
+		return nil, fmt.Errorf("save workloads: %w", err)
+	}
+
+	return &IngestResult{
+		WorkloadCount:  len(workloads),
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// saveWorkloads upserts workloads with history tracking and removes the cluster's
+// workloads that no longer exist.
+func (s *Service) saveWorkloads(ctx context.Context, clusterName string, items []*WorkloadData) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	activeIDs := make(map[string]struct{}, len(items))
+
+	for _, data := range items {
+		existing, err := tx.BronzeK8sWorkload.Query().
+			Where(bronzek8sworkload.ID(data.ResourceID)).
+			First(ctx)
+		if err != nil && !entk8s.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("load existing workload %s: %w", data.ResourceID, err)
+		}
+
+		activeIDs[data.ResourceID] = struct{}{}
+		diff := DiffWorkloadData(existing, data)
+
+		if !diff.IsNew && !diff.IsChanged {
+			if err := tx.BronzeK8sWorkload.UpdateOneID(data.ResourceID).
+				SetCollectedAt(data.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update collected_at for workload %s: %w", data.ResourceID, err)
+			}
+			continue
+		}
+
+		if existing == nil {
+			create := tx.BronzeK8sWorkload.Create().
+				SetID(data.ResourceID).
+				SetClusterName(data.ClusterName).
+				SetNamespace(data.Namespace).
+				SetKind(data.Kind).
+				SetName(data.Name).
+				SetUID(data.UID).
+				SetServiceAccount(data.ServiceAccount).
+				SetHostNetwork(data.HostNetwork).
+				SetHostPid(data.HostPID).
+				SetHostIpc(data.HostIPC).
+				SetCollectedAt(data.CollectedAt).
+				SetFirstCollectedAt(data.CollectedAt)
+
+			if data.Replicas != nil {
+				create.SetReplicas(*data.Replicas)
+			}
+			if data.ImagesJSON != nil {
+				create.SetImagesJSON(data.ImagesJSON)
+			}
+			if data.ContainersJSON != nil {
+				create.SetContainersJSON(data.ContainersJSON)
+			}
+			if data.SelectorJSON != nil {
+				create.SetSelectorJSON(data.SelectorJSON)
+			}
+			if data.LabelsJSON != nil {
+				create.SetLabelsJSON(data.LabelsJSON)
+			}
+			if data.APICreatedAt != nil {
+				create.SetAPICreatedAt(*data.APICreatedAt)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create workload %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.CreateHistory(ctx, tx, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create history for workload %s: %w", data.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeK8sWorkload.UpdateOneID(data.ResourceID).
+				SetClusterName(data.ClusterName).
+				SetNamespace(data.Namespace).
+				SetKind(data.Kind).
+				SetName(data.Name).
+				SetUID(data.UID).
+				SetServiceAccount(data.ServiceAccount).
+				SetHostNetwork(data.HostNetwork).
+				SetHostPid(data.HostPID).
+				SetHostIpc(data.HostIPC).
+				SetCollectedAt(data.CollectedAt)
+
+			if data.Replicas != nil {
+				update.SetReplicas(*data.Replicas)
+			} else {
+				update.ClearReplicas()
+			}
+			if data.ImagesJSON != nil {
+				update.SetImagesJSON(data.ImagesJSON)
+			} else {
+				update.ClearImagesJSON()
+			}
+			if data.ContainersJSON != nil {
+				update.SetContainersJSON(data.ContainersJSON)
+			} else {
+				update.ClearContainersJSON()
+			}
+			if data.SelectorJSON != nil {
+				update.SetSelectorJSON(data.SelectorJSON)
+			} else {
+				update.ClearSelectorJSON()
+			}
+			if data.LabelsJSON != nil {
+				update.SetLabelsJSON(data.LabelsJSON)
+			} else {
+				update.ClearLabelsJSON()
+			}
+			if data.APICreatedAt != nil {
+				update.SetAPICreatedAt(*data.APICreatedAt)
+			} else {
+				update.ClearAPICreatedAt()
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update workload %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.UpdateHistory(ctx, tx, existing, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update history for workload %s: %w", data.ResourceID, err)
+			}
+		}
+	}
+
+	// Delete stale workloads of this cluster not returned by the API.
+	dbIDs, err := tx.BronzeK8sWorkload.Query().
+		Where(bronzek8sworkload.ClusterName(clusterName)).
+		Select(bronzek8sworkload.FieldID).
+		Strings(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("query workload IDs: %w", err)
+	}
+
+	staleCount := 0
+	for _, id := range dbIDs {
+		if _, ok := activeIDs[id]; ok {
+			continue
+		}
+
+		if err := s.history.CloseHistory(ctx, tx, id, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("close history for stale workload %s: %w", id, err)
+		}
+
+		if err := tx.BronzeK8sWorkload.DeleteOneID(id).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("delete stale workload %s: %w", id, err)
+		}
+		staleCount++
+	}
+
+	if staleCount > 0 {
+		slog.Info("k8s workloads: deleted stale", "clusterName", clusterName, "count", staleCount)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package workload
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/base/temporalerr"
+)
+
+// K8sWorkloadWorkflowParams contains parameters for the workload workflow.
+type K8sWorkloadWorkflowParams struct {
+	ClusterName string
+}
+
+// K8sWorkloadWorkflowResult contains the result of the workload workflow.
+type K8sWorkloadWorkflowResult struct {
+	WorkloadCount  int
+	DurationMillis int64
+}
+
+// K8sWorkloadWorkflow ingests Kubernetes workloads from a single cluster.
+func K8sWorkloadWorkflow(ctx workflow.Context, params K8sWorkloadWorkflowParams) (*K8sWorkloadWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting K8sWorkloadWorkflow", "clusterName", params.ClusterName)
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestK8sWorkloadsResult
+	err := workflow.ExecuteActivity(activityCtx, IngestK8sWorkloadsActivity, IngestK8sWorkloadsParams{
+		ClusterName: params.ClusterName,
+	}).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest workloads", "clusterName", params.ClusterName, "error", err)
+		return nil, temporalerr.PropagateNonRetryable(err)
+	}
+
+	logger.Info("Completed K8sWorkloadWorkflow",
+		"clusterName", params.ClusterName,
+		"workloadCount", result.WorkloadCount,
+	)
+
+	return &K8sWorkloadWorkflowResult{
+		WorkloadCount:  result.WorkloadCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -11,27 +11,10 @@ import (
 	"time"
 
 	"danny.vn/hotpot/pkg/base/httputil"
+	"danny.vn/hotpot/pkg/ingest/reference"
 )
 
-const cpeFeedURL     = "https://nvd.nist.gov/feeds/json/cpe/2.0/nvdcpe-2.0.tar.gz"
-const cpeFeedReferer = "https://nvd.nist.gov/vuln/data-feeds"
-
-// setBrowserHeaders sets headers mimicking a Chrome browser click from the NVD data-feeds page.
-// NVD throttles non-browser requests significantly (~5x slower).
-func setBrowserHeaders(req *http.Request) {
-	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8,application/signed-exchange;v=b3;q=0.7")
-	req.Header.Set("Accept-Language", "en-US,en;q=0.9")
-	req.Header.Set("Referer", cpeFeedReferer)
-	req.Header.Set("Sec-Ch-Ua", `"Google Chrome";v="131", "Chromium";v="131", "Not_A Brand";v="24"`)
-	req.Header.Set("Sec-Ch-Ua-Mobile", "?0")
-	req.Header.Set("Sec-Ch-Ua-Platform", `"Linux"`)
-	req.Header.Set("Sec-Fetch-Dest", "document")
-	req.Header.Set("Sec-Fetch-Mode", "navigate")
-	req.Header.Set("Sec-Fetch-Site", "same-origin")
-	req.Header.Set("Sec-Fetch-User", "?1")
-	req.Header.Set("Upgrade-Insecure-Requests", "1")
-	req.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Safari/537.36")
-}
+const cpeFeedURL = "https://nvd.nist.gov/feeds/json/cpe/2.0/nvdcpe-2.0.tar.gz"
 
 // Client downloads and parses the NVD CPE Dictionary.
 type Client struct {
@@ -81,7 +64,7 @@ func (c *Client) LastModified() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("HEAD %s: %w", cpeFeedURL, err)
 	}
-	setBrowserHeaders(req)
+	reference.SetBrowserHeaders(req)
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return "", fmt.Errorf("HEAD %s: %w", cpeFeedURL, err)
@@ -97,7 +80,7 @@ func (c *Client) Download(heartbeat func(string)) ([]CPEData, error) {
 	if err != nil {
 		return nil, fmt.Errorf("GET %s: %w", cpeFeedURL, err)
 	}
-	setBrowserHeaders(req)
+	reference.SetBrowserHeaders(req)
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("GET %s: %w", cpeFeedURL, err)
@@ -0,0 +1,71 @@
+package reference
+
+import (
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// LocalFiles resolves a local feed source for air-gapped ingestion. A file
+// is returned as is; a directory is walked recursively for files ending in
+// one of suffixes, returned in lexical order.
+func LocalFiles(source string, suffixes ...string) ([]string, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, fmt.Errorf("stat %s: %w", source, err)
+	}
+	if !info.IsDir() {
+		return []string{source}, nil
+	}
+
+	var files []string
+	err = filepath.WalkDir(source, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		for _, suffix := range suffixes {
+			if strings.HasSuffix(path, suffix) {
+				files = append(files, path)
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walk %s: %w", source, err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no %s files in %s", strings.Join(suffixes, "/"), source)
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+// nvdFeedsPage is the NVD data-feeds page the feed downloads are linked from.
+const nvdFeedsPage = "https://nvd.nist.gov/vuln/data-feeds"
+
+// SetBrowserHeaders sets headers mimicking a Chrome browser click from the
+// NVD data-feeds page. NVD throttles non-browser requests significantly
+// (~5x slower).
+func SetBrowserHeaders(req *http.Request) {
+	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8,application/signed-exchange;v=b3;q=0.7")
+	req.Header.Set("Accept-Language", "en-US,en;q=0.9")
+	req.Header.Set("Referer", nvdFeedsPage)
+	req.Header.Set("Sec-Ch-Ua", `"Google Chrome";v="131", "Chromium";v="131", "Not_A Brand";v="24"`)
+	req.Header.Set("Sec-Ch-Ua-Mobile", "?0")
+	req.Header.Set("Sec-Ch-Ua-Platform", `"Linux"`)
+	req.Header.Set("Sec-Fetch-Dest", "document")
+	req.Header.Set("Sec-Fetch-Mode", "navigate")
+	req.Header.Set("Sec-Fetch-Site", "same-origin")
+	req.Header.Set("Sec-Fetch-User", "?1")
+	req.Header.Set("Upgrade-Insecure-Requests", "1")
+	req.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Safari/537.36")
+}
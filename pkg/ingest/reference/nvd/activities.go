@@ -0,0 +1,78 @@
+package nvd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/base/temporalerr"
+	entreference "danny.vn/hotpot/pkg/storage/ent/reference"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entreference.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entreference.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+func (a *Activities) createClient() *Client {
+	httpClient := &http.Client{
+		Transport: ratelimit.NewRateLimitedTransport(a.limiter, nil),
+	}
+	return NewClient(httpClient)
+}
+
+// IngestNVDResult contains the result of the NVD ingest activity.
+type IngestNVDResult struct {
+	CVECount       int
+	CPEMatchCount  int
+	DurationMillis int64
+}
+
+// IngestNVDActivity is the activity function reference for workflow registration.
+var IngestNVDActivity = (*Activities).IngestNVD
+
+// IngestNVD loads and ingests NVD CVEs.
+func (a *Activities) IngestNVD(ctx context.Context) (*IngestNVDResult, error) {
+	logger := activity.GetLogger(ctx)
+
+	source := a.configService.ReferenceNVDSource()
+	startYear := a.configService.ReferenceNVDStartYear()
+	logger.Info("Starting NVD ingestion", "source", source, "startYear", startYear)
+
+	client := a.createClient()
+	service := NewService(client, a.entClient)
+
+	result, err := service.Ingest(ctx, source, startYear, func(details string) {
+		activity.RecordHeartbeat(ctx, details)
+	})
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("ingest NVD: %w", err))
+	}
+
+	logger.Info("Completed NVD ingestion",
+		"cveCount", result.CVECount,
+		"cpeMatchCount", result.CPEMatchCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestNVDResult{
+		CVECount:       result.CVECount,
+		CPEMatchCount:  result.CPEMatchCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,333 @@
+package nvd
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/base/httputil"
+	"danny.vn/hotpot/pkg/ingest/reference"
+)
+
+const cveFeedURL = "https://nvd.nist.gov/feeds/json/cve/2.0/nvdcve-2.0-%d.json.gz"
+
+// Client downloads and parses the NVD CVE JSON 2.0 data feeds.
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient creates a new NVD client.
+func NewClient(httpClient *http.Client) *Client {
+	return &Client{httpClient: httpClient}
+}
+
+// CVE holds a parsed NVD CVE.
+type CVE struct {
+	ID             string
+	Description    string
+	VulnStatus     string
+	CVSSVersion    string
+	CVSSScore      *float64
+	CVSSSeverity   string
+	CVSSVector     string
+	CWEs           []string
+	Published      time.Time
+	LastModified   time.Time
+	CISAExploitAdd *time.Time
+	CPEMatches     []CPEMatch
+}
+
+// CPEMatch holds one CPE match criterion of a CVE configuration.
+type CPEMatch struct {
+	Criteria              string
+	Part                  string
+	Vendor                string
+	Product               string
+	Version               string
+	Vulnerable            bool
+	VersionStartIncluding string
+	VersionStartExcluding string
+	VersionEndIncluding   string
+	VersionEndExcluding   string
+}
+
+// nvdItem is one element of the feed's "vulnerabilities" array.
+type nvdItem struct {
+	CVE nvdCVE `json:"cve"`
+}
+
+type nvdCVE struct {
+	ID             string `json:"id"`
+	Published      string `json:"published"`
+	LastModified   string `json:"lastModified"`
+	VulnStatus     string `json:"vulnStatus"`
+	CISAExploitAdd string `json:"cisaExploitAdd"`
+	Descriptions   []struct {
+		Lang  string `json:"lang"`
+		Value string `json:"value"`
+	} `json:"descriptions"`
+	Metrics struct {
+		V40 []nvdMetric `json:"cvssMetricV40"`
+		V31 []nvdMetric `json:"cvssMetricV31"`
+		V30 []nvdMetric `json:"cvssMetricV30"`
+		V2  []nvdMetric `json:"cvssMetricV2"`
+	} `json:"metrics"`
+	Weaknesses []struct {
+		Description []struct {
+			Value string `json:"value"`
+		} `json:"description"`
+	} `json:"weaknesses"`
+	Configurations []struct {
+		Nodes []struct {
+			CPEMatch []nvdCPEMatch `json:"cpeMatch"`
+		} `json:"nodes"`
+	} `json:"configurations"`
+}
+
+type nvdMetric struct {
+	Type     string `json:"type"`
+	CVSSData struct {
+		Version      string  `json:"version"`
+		VectorString string  `json:"vectorString"`
+		BaseScore    float64 `json:"baseScore"`
+		BaseSeverity string  `json:"baseSeverity"`
+	} `json:"cvssData"`
+	// BaseSeverity sits outside cvssData for CVSS v2.
+	BaseSeverity string `json:"baseSeverity"`
+}
+
+type nvdCPEMatch struct {
+	Vulnerable            bool   `json:"vulnerable"`
+	Criteria              string `json:"criteria"`
+	VersionStartIncluding string `json:"versionStartIncluding"`
+	VersionStartExcluding string `json:"versionStartExcluding"`
+	VersionEndIncluding   string `json:"versionEndIncluding"`
+	VersionEndExcluding   string `json:"versionEndExcluding"`
+}
+
+// Load reads every CVE from source, a local feed file or directory, or
+// downloads the yearly feeds from startYear to the current year when source
+// is empty. fn is called once per CVE.
+func (c *Client) Load(ctx context.Context, source string, startYear int, heartbeat func(string), fn func(*CVE) error) error {
+	if source != "" {
+		files, err := reference.LocalFiles(source, ".json", ".json.gz")
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			heartbeat(fmt.Sprintf("reading %s", file))
+			if err := loadFile(file, fn); err != nil {
+				return fmt.Errorf("load %s: %w", file, err)
+			}
+		}
+		return nil
+	}
+
+	for year := startYear; year <= time.Now().Year(); year++ {
+		if err := c.loadYear(ctx, year, heartbeat, fn); err != nil {
+			return fmt.Errorf("load %d feed: %w", year, err)
+		}
+	}
+	return nil
+}
+
+func (c *Client) loadYear(ctx context.Context, year int, heartbeat func(string), fn func(*CVE) error) error {
+	feedURL := fmt.Sprintf(cveFeedURL, year)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, feedURL, nil)
+	if err != nil {
+		return fmt.Errorf("create request for %s: %w", feedURL, err)
+	}
+	reference.SetBrowserHeaders(req)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("GET %s: %w", feedURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: status %d", feedURL, resp.StatusCode)
+	}
+
+	body := httputil.NewProgressReader(resp.Body, resp.ContentLength, fmt.Sprintf("nvd-cve-%d", year), 5*time.Second, heartbeat)
+	gr, err := gzip.NewReader(body)
+	if err != nil {
+		return fmt.Errorf("gzip reader: %w", err)
+	}
+	defer gr.Close()
+
+	return parseFeed(gr, fn)
+}
+
+func loadFile(path string, fn func(*CVE) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open: %w", err)
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gr, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("gzip reader: %w", err)
+		}
+		defer gr.Close()
+		r = gr
+	}
+	return parseFeed(r, fn)
+}
+
+// parseFeed streams the "vulnerabilities" array of a feed so a whole year
+// is never held in memory.
+func parseFeed(r io.Reader, fn func(*CVE) error) error {
+	dec := json.NewDecoder(r)
+
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("read feed start: %w", err)
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("read feed key: %w", err)
+		}
+		if key, _ := tok.(string); key != "vulnerabilities" {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return fmt.Errorf("skip %v: %w", tok, err)
+			}
+			continue
+		}
+
+		if _, err := dec.Token(); err != nil {
+			return fmt.Errorf("read vulnerabilities start: %w", err)
+		}
+		for dec.More() {
+			var item nvdItem
+			if err := dec.Decode(&item); err != nil {
+				return fmt.Errorf("decode CVE: %w", err)
+			}
+			cve, err := convertCVE(item.CVE)
+			if err != nil {
+				return err
+			}
+			if err := fn(cve); err != nil {
+				return err
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			return fmt.Errorf("read vulnerabilities end: %w", err)
+		}
+	}
+	return nil
+}
+
+func convertCVE(c nvdCVE) (*CVE, error) {
+	published, err := parseTime(c.Published)
+	if err != nil {
+		return nil, fmt.Errorf("%s published: %w", c.ID, err)
+	}
+	lastModified, err := parseTime(c.LastModified)
+	if err != nil {
+		return nil, fmt.Errorf("%s lastModified: %w", c.ID, err)
+	}
+
+	cve := &CVE{
+		ID:           c.ID,
+		VulnStatus:   c.VulnStatus,
+		Published:    published,
+		LastModified: lastModified,
+	}
+	for _, d := range c.Descriptions {
+		if d.Lang == "en" {
+			cve.Description = d.Value
+			break
+		}
+	}
+	if c.CISAExploitAdd != "" {
+		if t, err := time.Parse(time.DateOnly, c.CISAExploitAdd); err == nil {
+			cve.CISAExploitAdd = &t
+		}
+	}
+
+	if m := preferredMetric(c.Metrics.V31, c.Metrics.V30, c.Metrics.V40, c.Metrics.V2); m != nil {
+		score := m.CVSSData.BaseScore
+		cve.CVSSVersion = m.CVSSData.Version
+		cve.CVSSScore = &score
+		cve.CVSSVector = m.CVSSData.VectorString
+		cve.CVSSSeverity = m.CVSSData.BaseSeverity
+		if cve.CVSSSeverity == "" {
+			cve.CVSSSeverity = m.BaseSeverity
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, w := range c.Weaknesses {
+		for _, d := range w.Description {
+			if strings.HasPrefix(d.Value, "CWE-") && !seen[d.Value] {
+				seen[d.Value] = true
+				cve.CWEs = append(cve.CWEs, d.Value)
+			}
+		}
+	}
+
+	for _, cfg := range c.Configurations {
+		for _, node := range cfg.Nodes {
+			for _, m := range node.CPEMatch {
+				fields := strings.Split(m.Criteria, ":")
+				if len(fields) < 6 || fields[0] != "cpe" || fields[1] != "2.3" {
+					continue
+				}
+				cve.CPEMatches = append(cve.CPEMatches, CPEMatch{
+					Criteria:              m.Criteria,
+					Part:                  fields[2],
+					Vendor:                fields[3],
+					Product:               fields[4],
+					Version:               fields[5],
+					Vulnerable:            m.Vulnerable,
+					VersionStartIncluding: m.VersionStartIncluding,
+					VersionStartExcluding: m.VersionStartExcluding,
+					VersionEndIncluding:   m.VersionEndIncluding,
+					VersionEndExcluding:   m.VersionEndExcluding,
+				})
+			}
+		}
+	}
+
+	return cve, nil
+}
+
+// preferredMetric returns the NVD primary metric of the first non-empty
+// list, falling back to its first (secondary) entry. Callers order the
+// lists by preference: CVSS v3.x stays the severity basis while v4.0
+// coverage is sparse.
+func preferredMetric(lists ...[]nvdMetric) *nvdMetric {
+	for _, list := range lists {
+		if len(list) == 0 {
+			continue
+		}
+		for i := range list {
+			if list[i].Type == "Primary" {
+				return &list[i]
+			}
+		}
+		return &list[0]
+	}
+	return nil
+}
+
+// parseTime parses NVD timestamps, which carry no zone and are UTC.
+func parseTime(s string) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02T15:04:05", time.RFC3339Nano} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized time %q", s)
+}
@@ -0,0 +1,93 @@
+package nvd
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const sampleFeed = `{
+  "resultsPerPage": 2,
+  "format": "NVD_CVE",
+  "vulnerabilities": [
+    {"cve": {
+      "id": "CVE-2024-0001",
+      "published": "2024-01-02T03:04:05.123",
+      "lastModified": "2024-02-01T00:00:00.000",
+      "vulnStatus": "Analyzed",
+      "cisaExploitAdd": "2024-01-10",
+      "descriptions": [{"lang": "es", "value": "hola"}, {"lang": "en", "value": "An overflow."}],
+      "metrics": {
+        "cvssMetricV31": [
+          {"type": "Secondary", "cvssData": {"version": "3.1", "baseScore": 5.0, "baseSeverity": "MEDIUM"}},
+          {"type": "Primary", "cvssData": {"version": "3.1", "vectorString": "CVSS:3.1/AV:N", "baseScore": 9.8, "baseSeverity": "CRITICAL"}}
+        ],
+        "cvssMetricV2": [
+          {"type": "Primary", "cvssData": {"version": "2.0", "baseScore": 7.5}, "baseSeverity": "HIGH"}
+        ]
+      },
+      "weaknesses": [{"description": [{"value": "CWE-787"}, {"value": "NVD-CWE-Other"}]}],
+      "configurations": [{"nodes": [{"cpeMatch": [
+        {"vulnerable": true, "criteria": "cpe:2.3:a:openssl:openssl:*:*:*:*:*:*:*:*", "versionStartIncluding": "3.0.0", "versionEndExcluding": "3.0.7"},
+        {"vulnerable": false, "criteria": "cpe:2.3:o:linux:linux_kernel:-:*:*:*:*:*:*:*"}
+      ]}]}]
+    }},
+    {"cve": {
+      "id": "CVE-2005-0001",
+      "published": "2005-01-01T00:00:00",
+      "lastModified": "2005-01-01T00:00:00",
+      "metrics": {
+        "cvssMetricV2": [
+          {"type": "Primary", "cvssData": {"version": "2.0", "baseScore": 4.3}, "baseSeverity": "MEDIUM"}
+        ]
+      }
+    }}
+  ],
+  "timestamp": "2024-02-02T00:00:00.000"
+}`
+
+func TestParseFeed(t *testing.T) {
+	var cves []*CVE
+	if err := parseFeed(strings.NewReader(sampleFeed), func(c *CVE) error {
+		cves = append(cves, c)
+		return nil
+	}); err != nil {
+		t.Fatalf("parseFeed: %v", err)
+	}
+	if len(cves) != 2 {
+		t.Fatalf("got %d CVEs, want 2", len(cves))
+	}
+
+	c := cves[0]
+	if c.Description != "An overflow." {
+		t.Errorf("description = %q", c.Description)
+	}
+	if want := time.Date(2024, 1, 2, 3, 4, 5, 123000000, time.UTC); !c.Published.Equal(want) {
+		t.Errorf("published = %v, want %v", c.Published, want)
+	}
+	if c.CVSSScore == nil || *c.CVSSScore != 9.8 || c.CVSSSeverity != "CRITICAL" || c.CVSSVersion != "3.1" {
+		t.Errorf("cvss = %v %q %q, want the primary v3.1 metric", c.CVSSScore, c.CVSSSeverity, c.CVSSVersion)
+	}
+	if len(c.CWEs) != 1 || c.CWEs[0] != "CWE-787" {
+		t.Errorf("cwes = %v", c.CWEs)
+	}
+	if c.CISAExploitAdd == nil {
+		t.Error("cisaExploitAdd not parsed")
+	}
+	if len(c.CPEMatches) != 2 {
+		t.Fatalf("got %d CPE matches, want 2", len(c.CPEMatches))
+	}
+	m := c.CPEMatches[0]
+	if m.Part != "a" || m.Vendor != "openssl" || m.Product != "openssl" || m.Version != "*" ||
+		!m.Vulnerable || m.VersionStartIncluding != "3.0.0" || m.VersionEndExcluding != "3.0.7" {
+		t.Errorf("cpe match = %+v", m)
+	}
+	if c.CPEMatches[1].Vulnerable {
+		t.Error("platform match marked vulnerable")
+	}
+
+	// CVSS v2 keeps baseSeverity outside cvssData.
+	if old := cves[1]; old.CVSSSeverity != "MEDIUM" || old.CVSSVersion != "2.0" {
+		t.Errorf("v2 cvss = %q %q", old.CVSSSeverity, old.CVSSVersion)
+	}
+}
@@ -0,0 +1,21 @@
+package nvd
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/reference"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider:  "reference",
+		Name:      "nvd",
+		Register:  Register,
+		Workflow:  NVDWorkflow,
+		NewResult: func() any { return &NVDWorkflowResult{} },
+		Aggregate: func(parent *reference.ReferenceInventoryWorkflowResult, child any) {
+			r := child.(*NVDWorkflowResult)
+			parent.NVDCVECount = r.CVECount
+			parent.NVDCPEMatchCount = r.CPEMatchCount
+		},
+	})
+}
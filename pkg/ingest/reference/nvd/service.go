@@ -0,0 +1,165 @@
+package nvd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	entreference "danny.vn/hotpot/pkg/storage/ent/reference"
+)
+
+const insertBatchSize = 1000
+
+// Service handles NVD CVE data persistence.
+type Service struct {
+	client    *Client
+	entClient *entreference.Client
+}
+
+// NewService creates a new NVD service.
+func NewService(client *Client, entClient *entreference.Client) *Service {
+	return &Service{client: client, entClient: entClient}
+}
+
+// IngestResult contains the result of an NVD ingestion.
+type IngestResult struct {
+	CVECount       int
+	CPEMatchCount  int
+	DurationMillis int64
+}
+
+// Ingest loads NVD CVEs from source (a local path) or the public yearly
+// feeds and replaces all NVD CVE data. CVEs are streamed into the
+// transaction in batches; a CVE seen twice is kept once.
+func (s *Service) Ingest(ctx context.Context, source string, startYear int, heartbeat func(string)) (*IngestResult, error) {
+	start := time.Now()
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Delete children first, then parents
+	deletedMatches, err := tx.BronzeReferenceNVDCPEMatch.Delete().Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("delete existing NVD CPE matches: %w", err)
+	}
+	deletedCVEs, err := tx.BronzeReferenceNVDCVE.Delete().Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("delete existing NVD CVEs: %w", err)
+	}
+	slog.Info("Deleted existing NVD data", "cves", deletedCVEs, "cpeMatches", deletedMatches)
+
+	seen := make(map[string]bool)
+	var (
+		cveBuilders   []*entreference.BronzeReferenceNVDCVECreate
+		matchBuilders []*entreference.BronzeReferenceNVDCPEMatchCreate
+		cveCount      int
+		matchCount    int
+	)
+
+	flush := func() error {
+		if len(cveBuilders) > 0 {
+			if err := tx.BronzeReferenceNVDCVE.CreateBulk(cveBuilders...).Exec(ctx); err != nil {
+				return fmt.Errorf("bulk insert NVD CVEs: %w", err)
+			}
+			cveBuilders = cveBuilders[:0]
+		}
+		for i := 0; i < len(matchBuilders); i += insertBatchSize {
+			end := min(i+insertBatchSize, len(matchBuilders))
+			if err := tx.BronzeReferenceNVDCPEMatch.CreateBulk(matchBuilders[i:end]...).Exec(ctx); err != nil {
+				return fmt.Errorf("bulk insert NVD CPE matches: %w", err)
+			}
+		}
+		matchBuilders = matchBuilders[:0]
+		heartbeat(fmt.Sprintf("saved %d NVD CVEs, %d CPE matches", cveCount, matchCount))
+		return nil
+	}
+
+	err = s.client.Load(ctx, source, startYear, heartbeat, func(c *CVE) error {
+		if seen[c.ID] {
+			return nil
+		}
+		seen[c.ID] = true
+
+		b := tx.BronzeReferenceNVDCVE.Create().
+			SetID(c.ID).
+			SetPublished(c.Published).
+			SetLastModified(c.LastModified).
+			SetNillableCvssScore(c.CVSSScore).
+			SetNillableCisaExploitAdd(c.CISAExploitAdd).
+			SetCollectedAt(now).
+			SetFirstCollectedAt(now)
+		if c.Description != "" {
+			b.SetDescription(c.Description)
+		}
+		if c.VulnStatus != "" {
+			b.SetVulnStatus(c.VulnStatus)
+		}
+		if c.CVSSVersion != "" {
+			b.SetCvssVersion(c.CVSSVersion)
+		}
+		if c.CVSSSeverity != "" {
+			b.SetCvssSeverity(c.CVSSSeverity)
+		}
+		if c.CVSSVector != "" {
+			b.SetCvssVector(c.CVSSVector)
+		}
+		if len(c.CWEs) > 0 {
+			b.SetCwes(c.CWEs)
+		}
+		cveBuilders = append(cveBuilders, b)
+		cveCount++
+
+		for i, m := range c.CPEMatches {
+			mb := tx.BronzeReferenceNVDCPEMatch.Create().
+				SetID(fmt.Sprintf("%s:%d", c.ID, i)).
+				SetCveID(c.ID).
+				SetCriteria(m.Criteria).
+				SetPart(m.Part).
+				SetCpeVendor(m.Vendor).
+				SetCpeProduct(m.Product).
+				SetCpeVersion(m.Version).
+				SetVulnerable(m.Vulnerable).
+				SetNillableVersionStartIncluding(nilIfEmpty(m.VersionStartIncluding)).
+				SetNillableVersionStartExcluding(nilIfEmpty(m.VersionStartExcluding)).
+				SetNillableVersionEndIncluding(nilIfEmpty(m.VersionEndIncluding)).
+				SetNillableVersionEndExcluding(nilIfEmpty(m.VersionEndExcluding)).
+				SetCollectedAt(now).
+				SetFirstCollectedAt(now)
+			matchBuilders = append(matchBuilders, mb)
+			matchCount++
+		}
+
+		if len(cveBuilders) >= insertBatchSize {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load NVD data: %w", err)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx: %w", err)
+	}
+
+	return &IngestResult{
+		CVECount:       cveCount,
+		CPEMatchCount:  matchCount,
+		DurationMillis: time.Since(start).Milliseconds(),
+	}, nil
+}
+
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,53 @@
+package nvd
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/base/temporalerr"
+)
+
+// NVDWorkflowResult contains the result of the NVD workflow.
+type NVDWorkflowResult struct {
+	CVECount       int
+	CPEMatchCount  int
+	DurationMillis int64
+}
+
+// NVDWorkflow ingests NVD CVEs and their CPE applicability.
+func NVDWorkflow(ctx workflow.Context) (*NVDWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting NVDWorkflow")
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 60 * time.Minute,
+		HeartbeatTimeout:    5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestNVDResult
+	err := workflow.ExecuteActivity(activityCtx, IngestNVDActivity).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest NVD data", "error", err)
+		return nil, temporalerr.PropagateNonRetryable(err)
+	}
+
+	logger.Info("Completed NVDWorkflow",
+		"cveCount", result.CVECount,
+		"cpeMatchCount", result.CPEMatchCount,
+	)
+
+	return &NVDWorkflowResult{
+		CVECount:       result.CVECount,
+		CPEMatchCount:  result.CPEMatchCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,81 @@
+package osv
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/base/temporalerr"
+	entreference "danny.vn/hotpot/pkg/storage/ent/reference"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entreference.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entreference.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+func (a *Activities) createClient() *Client {
+	httpClient := &http.Client{
+		Transport: ratelimit.NewRateLimitedTransport(a.limiter, nil),
+	}
+	return NewClient(httpClient)
+}
+
+// IngestOSVResult contains the result of the OSV ingest activity.
+type IngestOSVResult struct {
+	VulnCount      int
+	AffectedCount  int
+	DurationMillis int64
+}
+
+// IngestOSVActivity is the activity function reference for workflow registration.
+var IngestOSVActivity = (*Activities).IngestOSV
+
+// IngestOSV loads and ingests OSV advisories.
+func (a *Activities) IngestOSV(ctx context.Context) (*IngestOSVResult, error) {
+	logger := activity.GetLogger(ctx)
+
+	source := a.configService.ReferenceOSVSource()
+	ecosystems := a.configService.ReferenceOSVEcosystems()
+	if len(ecosystems) == 0 {
+		ecosystems = DefaultEcosystems
+	}
+	logger.Info("Starting OSV ingestion", "source", source, "ecosystems", ecosystems)
+
+	client := a.createClient()
+	service := NewService(client, a.entClient)
+
+	result, err := service.Ingest(ctx, source, ecosystems, func(details string) {
+		activity.RecordHeartbeat(ctx, details)
+	})
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("ingest OSV: %w", err))
+	}
+
+	logger.Info("Completed OSV ingestion",
+		"vulnCount", result.VulnCount,
+		"affectedCount", result.AffectedCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestOSVResult{
+		VulnCount:      result.VulnCount,
+		AffectedCount:  result.AffectedCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
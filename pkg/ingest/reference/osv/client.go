@@ -0,0 +1,326 @@
+package osv
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/base/httputil"
+	"danny.vn/hotpot/pkg/ingest/reference"
+)
+
+const bucketURL = "https://osv-vulnerabilities.storage.googleapis.com"
+
+// DefaultEcosystems lists the OSV ecosystems downloaded when none are configured.
+var DefaultEcosystems = []string{
+	"Go",
+	"npm",
+	"PyPI",
+	"Maven",
+	"crates.io",
+	"RubyGems",
+	"NuGet",
+	"Packagist",
+	"Debian",
+	"Ubuntu",
+	"Alpine",
+	"AlmaLinux",
+	"Rocky Linux",
+}
+
+// Client downloads and parses OSV advisory dumps.
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient creates a new OSV client.
+func NewClient(httpClient *http.Client) *Client {
+	return &Client{httpClient: httpClient}
+}
+
+// Vuln holds a parsed OSV advisory.
+type Vuln struct {
+	ID               string
+	Summary          string
+	Details          string
+	Aliases          []string
+	SeverityType     string
+	SeverityScore    string
+	DatabaseSeverity string
+	Published        *time.Time
+	Modified         time.Time
+	Withdrawn        *time.Time
+	Affected         []Affected
+}
+
+// Affected holds one affected version interval of a package.
+type Affected struct {
+	Ecosystem    string
+	PackageName  string
+	PURL         string
+	RangeType    string
+	Introduced   string
+	Fixed        string
+	LastAffected string
+	Versions     []string
+}
+
+// osvRecord is the OSV schema (https://ossf.github.io/osv-schema/), reduced
+// to the fields we store.
+type osvRecord struct {
+	ID        string        `json:"id"`
+	Summary   string        `json:"summary"`
+	Details   string        `json:"details"`
+	Aliases   []string      `json:"aliases"`
+	Published *time.Time    `json:"published"`
+	Modified  time.Time     `json:"modified"`
+	Withdrawn *time.Time    `json:"withdrawn"`
+	Severity  []osvSeverity `json:"severity"`
+	Affected  []osvAffected `json:"affected"`
+
+	DatabaseSpecific map[string]any `json:"database_specific"`
+}
+
+type osvSeverity struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+type osvAffected struct {
+	Package struct {
+		Ecosystem string `json:"ecosystem"`
+		Name      string `json:"name"`
+		PURL      string `json:"purl"`
+	} `json:"package"`
+	Ranges   []osvRange `json:"ranges"`
+	Versions []string   `json:"versions"`
+}
+
+type osvRange struct {
+	Type   string     `json:"type"`
+	Events []osvEvent `json:"events"`
+}
+
+type osvEvent struct {
+	Introduced   string `json:"introduced"`
+	Fixed        string `json:"fixed"`
+	LastAffected string `json:"last_affected"`
+}
+
+// Load reads every advisory from source, a local zip, JSON file or
+// directory, or downloads the public zip of each ecosystem when source is
+// empty. fn is called once per advisory.
+func (c *Client) Load(ctx context.Context, source string, ecosystems []string, heartbeat func(string), fn func(*Vuln) error) error {
+	if source != "" {
+		files, err := reference.LocalFiles(source, ".zip", ".json")
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			heartbeat(fmt.Sprintf("reading %s", file))
+			if err := loadFile(file, fn); err != nil {
+				return fmt.Errorf("load %s: %w", file, err)
+			}
+		}
+		return nil
+	}
+
+	for _, ecosystem := range ecosystems {
+		if err := c.loadEcosystem(ctx, ecosystem, heartbeat, fn); err != nil {
+			return fmt.Errorf("load ecosystem %s: %w", ecosystem, err)
+		}
+	}
+	return nil
+}
+
+func (c *Client) loadEcosystem(ctx context.Context, ecosystem string, heartbeat func(string), fn func(*Vuln) error) error {
+	zipURL := fmt.Sprintf("%s/%s/all.zip", bucketURL, url.PathEscape(ecosystem))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, zipURL, nil)
+	if err != nil {
+		return fmt.Errorf("create request for %s: %w", zipURL, err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("GET %s: %w", zipURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: status %d", zipURL, resp.StatusCode)
+	}
+
+	// zip needs random access, so spool the archive to disk first.
+	tmp, err := os.CreateTemp("", "osv-*.zip")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	body := httputil.NewProgressReader(resp.Body, resp.ContentLength, "osv-"+ecosystem, 5*time.Second, heartbeat)
+	if _, err := io.Copy(tmp, body); err != nil {
+		return fmt.Errorf("download to temp: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close temp file: %w", err)
+	}
+
+	slog.Info("Downloaded OSV ecosystem dump", "ecosystem", ecosystem, "bytes", resp.ContentLength)
+	heartbeat(fmt.Sprintf("parsing OSV %s", ecosystem))
+	return loadZip(tmp.Name(), fn)
+}
+
+func loadFile(path string, fn func(*Vuln) error) error {
+	if strings.HasSuffix(path, ".zip") {
+		return loadZip(path, fn)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open: %w", err)
+	}
+	defer f.Close()
+
+	v, err := parseVuln(f)
+	if err != nil {
+		return err
+	}
+	return fn(v)
+}
+
+func loadZip(path string, fn func(*Vuln) error) error {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return fmt.Errorf("open zip: %w", err)
+	}
+	defer zr.Close()
+
+	for _, zf := range zr.File {
+		if !strings.HasSuffix(zf.Name, ".json") {
+			continue
+		}
+		rc, err := zf.Open()
+		if err != nil {
+			return fmt.Errorf("open %s: %w", zf.Name, err)
+		}
+		v, err := parseVuln(rc)
+		rc.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", zf.Name, err)
+		}
+		if err := fn(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseVuln decodes one OSV advisory and flattens its affected ranges.
+func parseVuln(r io.Reader) (*Vuln, error) {
+	var rec osvRecord
+	if err := json.NewDecoder(r).Decode(&rec); err != nil {
+		return nil, fmt.Errorf("decode JSON: %w", err)
+	}
+	if rec.ID == "" {
+		return nil, fmt.Errorf("advisory without id")
+	}
+
+	v := &Vuln{
+		ID:        rec.ID,
+		Summary:   rec.Summary,
+		Details:   rec.Details,
+		Aliases:   rec.Aliases,
+		Published: rec.Published,
+		Modified:  rec.Modified,
+		Withdrawn: rec.Withdrawn,
+	}
+	if sev := preferredSeverity(rec.Severity); sev != nil {
+		v.SeverityType = sev.Type
+		v.SeverityScore = sev.Score
+	}
+	if s, ok := rec.DatabaseSpecific["severity"].(string); ok {
+		v.DatabaseSeverity = s
+	}
+
+	for _, a := range rec.Affected {
+		v.Affected = append(v.Affected, flattenAffected(a)...)
+	}
+	return v, nil
+}
+
+// preferredSeverity picks the newest CVSS entry, falling back to the first.
+func preferredSeverity(severities []osvSeverity) *osvSeverity {
+	for _, t := range []string{"CVSS_V4", "CVSS_V3", "CVSS_V2"} {
+		for i := range severities {
+			if severities[i].Type == t {
+				return &severities[i]
+			}
+		}
+	}
+	if len(severities) > 0 {
+		return &severities[0]
+	}
+	return nil
+}
+
+// flattenAffected turns the event list of each range into introduced/fixed
+// intervals. Explicit versions get their own row when the package has no
+// SEMVER or ECOSYSTEM range, since GIT ranges carry commits, not versions.
+func flattenAffected(a osvAffected) []Affected {
+	base := Affected{
+		Ecosystem:   a.Package.Ecosystem,
+		PackageName: a.Package.Name,
+		PURL:        a.Package.PURL,
+	}
+
+	var out []Affected
+	versionRange := false
+	for _, r := range a.Ranges {
+		if r.Type != "GIT" {
+			versionRange = true
+		}
+
+		var cur *Affected
+		for _, ev := range r.Events {
+			switch {
+			case ev.Introduced != "":
+				if cur != nil {
+					out = append(out, *cur)
+				}
+				next := base
+				next.RangeType = r.Type
+				next.Introduced = ev.Introduced
+				cur = &next
+			case ev.Fixed != "" || ev.LastAffected != "":
+				if cur == nil {
+					next := base
+					next.RangeType = r.Type
+					cur = &next
+				}
+				cur.Fixed = ev.Fixed
+				cur.LastAffected = ev.LastAffected
+				out = append(out, *cur)
+				cur = nil
+			}
+		}
+		if cur != nil {
+			out = append(out, *cur)
+		}
+	}
+
+	if !versionRange && len(a.Versions) > 0 {
+		row := base
+		row.Versions = a.Versions
+		out = append(out, row)
+	}
+	return out
+}
@@ -0,0 +1,92 @@
+package osv
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const sampleVuln = `{
+  "id": "GHSA-xxxx-yyyy-zzzz",
+  "summary": "Path traversal",
+  "aliases": ["CVE-2024-0001"],
+  "modified": "2024-05-01T10:00:00Z",
+  "published": "2024-04-01T10:00:00Z",
+  "severity": [
+    {"type": "CVSS_V3", "score": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:N/A:N"}
+  ],
+  "affected": [
+    {
+      "package": {"ecosystem": "Go", "name": "example.com/lib", "purl": "pkg:golang/example.com/lib"},
+      "ranges": [
+        {"type": "SEMVER", "events": [
+          {"introduced": "0"}, {"fixed": "1.2.3"},
+          {"introduced": "2.0.0"}, {"last_affected": "2.1.0"}
+        ]},
+        {"type": "GIT", "events": [{"introduced": "abc"}, {"fixed": "def"}]}
+      ],
+      "versions": ["1.0.0", "1.2.2"]
+    },
+    {
+      "package": {"ecosystem": "PyPI", "name": "example"},
+      "versions": ["0.1", "0.2"]
+    }
+  ],
+  "database_specific": {"severity": "HIGH"}
+}`
+
+func TestParseVuln(t *testing.T) {
+	v, err := parseVuln(strings.NewReader(sampleVuln))
+	if err != nil {
+		t.Fatalf("parseVuln: %v", err)
+	}
+	if v.SeverityType != "CVSS_V3" || v.DatabaseSeverity != "HIGH" {
+		t.Errorf("severity = %q/%q", v.SeverityType, v.DatabaseSeverity)
+	}
+	if len(v.Aliases) != 1 || v.Aliases[0] != "CVE-2024-0001" {
+		t.Errorf("aliases = %v", v.Aliases)
+	}
+	if v.Published == nil || v.Withdrawn != nil {
+		t.Errorf("published = %v, withdrawn = %v", v.Published, v.Withdrawn)
+	}
+
+	// Two SEMVER intervals, one GIT interval, and the PyPI versions row.
+	// The Go versions list is dropped because the package has a SEMVER range.
+	if len(v.Affected) != 4 {
+		t.Fatalf("got %d affected rows, want 4: %+v", len(v.Affected), v.Affected)
+	}
+	first, second := v.Affected[0], v.Affected[1]
+	if first.Introduced != "0" || first.Fixed != "1.2.3" || first.RangeType != "SEMVER" {
+		t.Errorf("first interval = %+v", first)
+	}
+	if second.Introduced != "2.0.0" || second.LastAffected != "2.1.0" || second.Fixed != "" {
+		t.Errorf("second interval = %+v", second)
+	}
+	if pypi := v.Affected[3]; pypi.Ecosystem != "PyPI" || len(pypi.Versions) != 2 || pypi.RangeType != "" {
+		t.Errorf("versions row = %+v", pypi)
+	}
+}
+
+func TestLoadLocalDir(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "GHSA-xxxx-yyyy-zzzz.json"), []byte(sampleVuln), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("ignored"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	err := NewClient(nil).Load(context.Background(), dir, nil, func(string) {}, func(v *Vuln) error {
+		ids = append(ids, v.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(ids) != 1 || ids[0] != "GHSA-xxxx-yyyy-zzzz" {
+		t.Errorf("ids = %v", ids)
+	}
+}
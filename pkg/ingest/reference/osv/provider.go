@@ -0,0 +1,21 @@
+package osv
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/reference"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider:  "reference",
+		Name:      "osv",
+		Register:  Register,
+		Workflow:  OSVWorkflow,
+		NewResult: func() any { return &OSVWorkflowResult{} },
+		Aggregate: func(parent *reference.ReferenceInventoryWorkflowResult, child any) {
+			r := child.(*OSVWorkflowResult)
+			parent.OSVVulnCount = r.VulnCount
+			parent.OSVAffectedCount = r.AffectedCount
+		},
+	})
+}
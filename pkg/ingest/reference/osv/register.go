@@ -0,0 +1,18 @@
+package osv
+
+import (
+	"go.temporal.io/sdk/worker"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	entreference "danny.vn/hotpot/pkg/storage/ent/reference"
+)
+
+// Register registers OSV activities and workflows with the Temporal worker.
+func Register(w worker.Worker, configService *config.Service, entClient *entreference.Client, limiter ratelimit.Limiter) {
+	activities := NewActivities(configService, entClient, limiter)
+
+	w.RegisterActivity(activities.IngestOSV)
+
+	w.RegisterWorkflow(OSVWorkflow)
+}
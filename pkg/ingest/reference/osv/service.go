@@ -0,0 +1,162 @@
+package osv
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	entreference "danny.vn/hotpot/pkg/storage/ent/reference"
+)
+
+const insertBatchSize = 1000
+
+// Service handles OSV data persistence.
+type Service struct {
+	client    *Client
+	entClient *entreference.Client
+}
+
+// NewService creates a new OSV service.
+func NewService(client *Client, entClient *entreference.Client) *Service {
+	return &Service{client: client, entClient: entClient}
+}
+
+// IngestResult contains the result of an OSV ingestion.
+type IngestResult struct {
+	VulnCount      int
+	AffectedCount  int
+	DurationMillis int64
+}
+
+// Ingest loads OSV advisories from source (a local path) or the public
+// ecosystem dumps and replaces all OSV data. Advisories are streamed into
+// the transaction in batches; an ID seen twice (e.g. all.zip next to an
+// ecosystem zip) is kept once.
+func (s *Service) Ingest(ctx context.Context, source string, ecosystems []string, heartbeat func(string)) (*IngestResult, error) {
+	start := time.Now()
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Delete children first, then parents
+	deletedAffected, err := tx.BronzeReferenceOSVAffected.Delete().Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("delete existing OSV affected: %w", err)
+	}
+	deletedVulns, err := tx.BronzeReferenceOSVVuln.Delete().Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("delete existing OSV vulns: %w", err)
+	}
+	slog.Info("Deleted existing OSV data", "vulns", deletedVulns, "affected", deletedAffected)
+
+	seen := make(map[string]bool)
+	var (
+		vulnBuilders     []*entreference.BronzeReferenceOSVVulnCreate
+		affectedBuilders []*entreference.BronzeReferenceOSVAffectedCreate
+		vulnCount        int
+		affectedCount    int
+	)
+
+	flush := func() error {
+		if len(vulnBuilders) > 0 {
+			if err := tx.BronzeReferenceOSVVuln.CreateBulk(vulnBuilders...).Exec(ctx); err != nil {
+				return fmt.Errorf("bulk insert OSV vulns: %w", err)
+			}
+			vulnBuilders = vulnBuilders[:0]
+		}
+		for i := 0; i < len(affectedBuilders); i += insertBatchSize {
+			end := min(i+insertBatchSize, len(affectedBuilders))
+			if err := tx.BronzeReferenceOSVAffected.CreateBulk(affectedBuilders[i:end]...).Exec(ctx); err != nil {
+				return fmt.Errorf("bulk insert OSV affected: %w", err)
+			}
+		}
+		affectedBuilders = affectedBuilders[:0]
+		heartbeat(fmt.Sprintf("saved %d OSV vulns, %d affected ranges", vulnCount, affectedCount))
+		return nil
+	}
+
+	err = s.client.Load(ctx, source, ecosystems, heartbeat, func(v *Vuln) error {
+		if seen[v.ID] {
+			return nil
+		}
+		seen[v.ID] = true
+
+		b := tx.BronzeReferenceOSVVuln.Create().
+			SetID(v.ID).
+			SetModified(v.Modified).
+			SetNillablePublished(v.Published).
+			SetNillableWithdrawn(v.Withdrawn).
+			SetCollectedAt(now).
+			SetFirstCollectedAt(now)
+		if v.Summary != "" {
+			b.SetSummary(v.Summary)
+		}
+		if v.Details != "" {
+			b.SetDetails(v.Details)
+		}
+		if len(v.Aliases) > 0 {
+			b.SetAliases(v.Aliases)
+		}
+		if v.SeverityType != "" {
+			b.SetSeverityType(v.SeverityType).SetSeverityScore(v.SeverityScore)
+		}
+		if v.DatabaseSeverity != "" {
+			b.SetDatabaseSeverity(v.DatabaseSeverity)
+		}
+		vulnBuilders = append(vulnBuilders, b)
+		vulnCount++
+
+		for i, a := range v.Affected {
+			ab := tx.BronzeReferenceOSVAffected.Create().
+				SetID(fmt.Sprintf("%s:%d", v.ID, i)).
+				SetVulnID(v.ID).
+				SetEcosystem(a.Ecosystem).
+				SetPackageName(a.PackageName).
+				SetNillablePurl(nilIfEmpty(a.PURL)).
+				SetNillableRangeType(nilIfEmpty(a.RangeType)).
+				SetNillableIntroduced(nilIfEmpty(a.Introduced)).
+				SetNillableFixed(nilIfEmpty(a.Fixed)).
+				SetNillableLastAffected(nilIfEmpty(a.LastAffected)).
+				SetCollectedAt(now).
+				SetFirstCollectedAt(now)
+			if len(a.Versions) > 0 {
+				ab.SetVersions(a.Versions)
+			}
+			affectedBuilders = append(affectedBuilders, ab)
+			affectedCount++
+		}
+
+		if len(vulnBuilders) >= insertBatchSize {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load OSV data: %w", err)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx: %w", err)
+	}
+
+	return &IngestResult{
+		VulnCount:      vulnCount,
+		AffectedCount:  affectedCount,
+		DurationMillis: time.Since(start).Milliseconds(),
+	}, nil
+}
+
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,53 @@
+package osv
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/base/temporalerr"
+)
+
+// OSVWorkflowResult contains the result of the OSV workflow.
+type OSVWorkflowResult struct {
+	VulnCount      int
+	AffectedCount  int
+	DurationMillis int64
+}
+
+// OSVWorkflow ingests OSV vulnerability advisories.
+func OSVWorkflow(ctx workflow.Context) (*OSVWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting OSVWorkflow")
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 60 * time.Minute,
+		HeartbeatTimeout:    5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestOSVResult
+	err := workflow.ExecuteActivity(activityCtx, IngestOSVActivity).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest OSV data", "error", err)
+		return nil, temporalerr.PropagateNonRetryable(err)
+	}
+
+	logger.Info("Completed OSVWorkflow",
+		"vulnCount", result.VulnCount,
+		"affectedCount", result.AffectedCount,
+	)
+
+	return &OSVWorkflowResult{
+		VulnCount:      result.VulnCount,
+		AffectedCount:  result.AffectedCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
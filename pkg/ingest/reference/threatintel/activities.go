@@ -0,0 +1,80 @@
+package threatintel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/base/temporalerr"
+	entreference "danny.vn/hotpot/pkg/storage/ent/reference"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entreference.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *entreference.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+func (a *Activities) createClient() *Client {
+	httpClient := &http.Client{
+		Transport: ratelimit.NewRateLimitedTransport(a.limiter, nil),
+	}
+	return NewClient(httpClient)
+}
+
+// IngestThreatIntelResult contains the result of the threat intel ingest activity.
+type IngestThreatIntelResult struct {
+	FeedCount      int
+	IndicatorCount int
+	SkippedCount   int
+	DurationMillis int64
+}
+
+// IngestThreatIntelActivity is the activity function reference for workflow registration.
+var IngestThreatIntelActivity = (*Activities).IngestThreatIntel
+
+// IngestThreatIntel loads and ingests the configured threat intel feeds.
+func (a *Activities) IngestThreatIntel(ctx context.Context) (*IngestThreatIntelResult, error) {
+	logger := activity.GetLogger(ctx)
+
+	feeds := a.configService.ReferenceThreatIntelFeeds()
+	logger.Info("Starting threat intel ingestion", "feeds", len(feeds))
+
+	client := a.createClient()
+	service := NewService(client, a.entClient)
+
+	result, err := service.Ingest(ctx, feeds, func(details string) {
+		activity.RecordHeartbeat(ctx, details)
+	})
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("ingest threat intel: %w", err))
+	}
+
+	logger.Info("Completed threat intel ingestion",
+		"feedCount", result.FeedCount,
+		"indicatorCount", result.IndicatorCount,
+		"skippedCount", result.SkippedCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestThreatIntelResult{
+		FeedCount:      result.FeedCount,
+		IndicatorCount: result.IndicatorCount,
+		SkippedCount:   result.SkippedCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
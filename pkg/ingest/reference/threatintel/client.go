@@ -0,0 +1,321 @@
+package threatintel
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/httputil"
+	"danny.vn/hotpot/pkg/ingest/reference"
+)
+
+// Feed formats.
+const (
+	FormatText = "text"
+	FormatCSV  = "csv"
+	FormatSTIX = "stix"
+)
+
+// Feed categories.
+const (
+	CategoryScanner   = "scanner"
+	CategoryTor       = "tor"
+	CategoryBlocklist = "blocklist"
+)
+
+// formatSuffixes are the file suffixes read from a local feed directory.
+var formatSuffixes = map[string][]string{
+	FormatText: {".txt", ".list", ".netset", ".ipset"},
+	FormatCSV:  {".csv"},
+	FormatSTIX: {".json"},
+}
+
+// stixIPRe extracts IP and CIDR values from STIX patterns such as
+// "[ipv4-addr:value = '198.51.100.0/24' OR ipv6-addr:value = '2001:db8::1']".
+var stixIPRe = regexp.MustCompile(`ipv[46]-addr:value\s*=\s*'([^']+)'`)
+
+// Client downloads and parses IP/CIDR feeds.
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient creates a new threat intel client.
+func NewClient(httpClient *http.Client) *Client {
+	return &Client{httpClient: httpClient}
+}
+
+// Indicator is a listed IP or CIDR.
+type Indicator struct {
+	Prefix      netip.Prefix
+	Description string
+	ValidUntil  *time.Time
+}
+
+// FeedData holds the parsed indicators of a feed.
+type FeedData struct {
+	Indicators []Indicator
+	// Skipped counts lines, rows or values that are not an IP or CIDR.
+	Skipped int
+}
+
+// Load reads a feed from its URL or local path. Indicators listed twice
+// are kept once, with the first description seen.
+func (c *Client) Load(ctx context.Context, feed config.ReferenceThreatIntelFeed, heartbeat func(string)) (*FeedData, error) {
+	data := &FeedData{}
+	seen := make(map[netip.Prefix]bool)
+	add := func(ind Indicator) {
+		if seen[ind.Prefix] {
+			return
+		}
+		seen[ind.Prefix] = true
+		data.Indicators = append(data.Indicators, ind)
+	}
+
+	if strings.HasPrefix(feed.Source, "http://") || strings.HasPrefix(feed.Source, "https://") {
+		if err := c.download(ctx, feed, heartbeat, data, add); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+
+	files, err := reference.LocalFiles(feed.Source, formatSuffixes[feed.Format]...)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		heartbeat(fmt.Sprintf("reading %s", file))
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, fmt.Errorf("open %s: %w", file, err)
+		}
+		skipped, err := parse(f, feed.Format, feed.Column, add)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", file, err)
+		}
+		data.Skipped += skipped
+	}
+	return data, nil
+}
+
+func (c *Client) download(ctx context.Context, feed config.ReferenceThreatIntelFeed, heartbeat func(string), data *FeedData, add func(Indicator)) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, feed.Source, nil)
+	if err != nil {
+		return fmt.Errorf("create request for %s: %w", feed.Source, err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("GET %s: %w", feed.Source, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: status %d", feed.Source, resp.StatusCode)
+	}
+
+	body := httputil.NewProgressReader(resp.Body, resp.ContentLength, "threatintel-"+feed.Name, 5*time.Second, heartbeat)
+	skipped, err := parse(body, feed.Format, feed.Column, add)
+	if err != nil {
+		return fmt.Errorf("parse %s: %w", feed.Source, err)
+	}
+	data.Skipped += skipped
+	return nil
+}
+
+// parse reads indicators in the given format, returning the number of
+// entries that are not an IP or CIDR.
+func parse(r io.Reader, format, column string, add func(Indicator)) (int, error) {
+	switch format {
+	case FormatText:
+		return parseText(r, add)
+	case FormatCSV:
+		return parseCSV(r, column, add)
+	case FormatSTIX:
+		return parseSTIX(r, add)
+	}
+	return 0, fmt.Errorf("unknown feed format %q", format)
+}
+
+// parseText reads one IP or CIDR per line. "#" starts a comment; a ";"
+// comment after the value (e.g. Spamhaus DROP "1.2.3.0/24 ; SBL123") is
+// kept as the description. Anything after the first field is ignored.
+func parseText(r io.Reader, add func(Indicator)) (int, error) {
+	skipped := 0
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		line, comment, _ := strings.Cut(line, ";")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		prefix, ok := parsePrefix(fields[0])
+		if !ok {
+			skipped++
+			continue
+		}
+		add(Indicator{Prefix: prefix, Description: strings.TrimSpace(comment)})
+	}
+	if err := scanner.Err(); err != nil {
+		return skipped, fmt.Errorf("read lines: %w", err)
+	}
+	return skipped, nil
+}
+
+// parseCSV reads the IP or CIDR from the named header column, or from the
+// first field of each row that parses as one when column is empty.
+func parseCSV(r io.Reader, column string, add func(Indicator)) (int, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	idx := -1
+	if column != "" {
+		header, err := reader.Read()
+		if err != nil {
+			return 0, fmt.Errorf("read header: %w", err)
+		}
+		for i, name := range header {
+			if strings.EqualFold(strings.TrimSpace(name), column) {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			return 0, fmt.Errorf("column %q not in header", column)
+		}
+	}
+
+	skipped := 0
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return skipped, fmt.Errorf("read row: %w", err)
+		}
+
+		var (
+			prefix netip.Prefix
+			ok     bool
+		)
+		if idx >= 0 {
+			if idx < len(record) {
+				prefix, ok = parsePrefix(record[idx])
+			}
+		} else {
+			for _, value := range record {
+				if prefix, ok = parsePrefix(value); ok {
+					break
+				}
+			}
+		}
+		if !ok {
+			skipped++
+			continue
+		}
+		add(Indicator{Prefix: prefix})
+	}
+	return skipped, nil
+}
+
+// stixObject is the part of a STIX 2.x object we read: indicators with a
+// STIX pattern, and ipv4-addr/ipv6-addr observables.
+type stixObject struct {
+	Type        string     `json:"type"`
+	Name        string     `json:"name"`
+	Pattern     string     `json:"pattern"`
+	PatternType string     `json:"pattern_type"`
+	ValidUntil  *time.Time `json:"valid_until"`
+	Revoked     bool       `json:"revoked"`
+	Value       string     `json:"value"`
+}
+
+// parseSTIX reads a STIX 2.x bundle (or TAXII envelope) or a JSON array of
+// objects. Revoked indicators and non-STIX patterns are skipped.
+func parseSTIX(r io.Reader, add func(Indicator)) (int, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return 0, fmt.Errorf("read: %w", err)
+	}
+
+	var objects []stixObject
+	if trimmed := strings.TrimSpace(string(raw)); strings.HasPrefix(trimmed, "[") {
+		err = json.Unmarshal(raw, &objects)
+	} else {
+		var bundle struct {
+			Objects []stixObject `json:"objects"`
+		}
+		err = json.Unmarshal(raw, &bundle)
+		objects = bundle.Objects
+	}
+	if err != nil {
+		return 0, fmt.Errorf("decode STIX: %w", err)
+	}
+
+	skipped := 0
+	for _, obj := range objects {
+		switch obj.Type {
+		case "indicator":
+			if obj.Revoked || (obj.PatternType != "" && obj.PatternType != "stix") {
+				skipped++
+				continue
+			}
+			matches := stixIPRe.FindAllStringSubmatch(obj.Pattern, -1)
+			if len(matches) == 0 {
+				skipped++
+				continue
+			}
+			for _, m := range matches {
+				prefix, ok := parsePrefix(m[1])
+				if !ok {
+					skipped++
+					continue
+				}
+				add(Indicator{Prefix: prefix, Description: obj.Name, ValidUntil: obj.ValidUntil})
+			}
+		case "ipv4-addr", "ipv6-addr":
+			prefix, ok := parsePrefix(obj.Value)
+			if !ok {
+				skipped++
+				continue
+			}
+			add(Indicator{Prefix: prefix})
+		}
+	}
+	return skipped, nil
+}
+
+// parsePrefix parses an IP or CIDR into a masked prefix; a single IP
+// becomes a /32 or /128. IPv4-mapped IPv6 addresses are unmapped.
+func parsePrefix(s string) (netip.Prefix, bool) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, false
+		}
+		return prefix.Masked(), true
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil || addr.Zone() != "" {
+		return netip.Prefix{}, false
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), true
+}
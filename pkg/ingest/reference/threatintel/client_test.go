@@ -0,0 +1,165 @@
+package threatintel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"danny.vn/hotpot/pkg/base/config"
+)
+
+func collect(t *testing.T, format, column, data string) ([]string, map[string]Indicator, int) {
+	t.Helper()
+	var cidrs []string
+	byCIDR := make(map[string]Indicator)
+	skipped, err := parse(strings.NewReader(data), format, column, func(ind Indicator) {
+		cidrs = append(cidrs, ind.Prefix.String())
+		byCIDR[ind.Prefix.String()] = ind
+	})
+	if err != nil {
+		t.Fatalf("parse(%s): %v", format, err)
+	}
+	return cidrs, byCIDR, skipped
+}
+
+func TestParseText(t *testing.T) {
+	data := `# Tor exit list
+1.2.3.4
+  5.6.7.0/24 ; SBL123
+10.1.2.3/16   # masked
+2001:db8::1
+::ffff:192.0.2.1
+not-an-ip
+1.2.3.4-1.2.3.9
+`
+	got, byCIDR, skipped := collect(t, FormatText, "", data)
+	want := []string{"1.2.3.4/32", "5.6.7.0/24", "10.1.0.0/16", "2001:db8::1/128", "192.0.2.1/32"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("cidrs = %v, want %v", got, want)
+	}
+	if skipped != 2 {
+		t.Errorf("skipped = %d, want 2", skipped)
+	}
+	if d := byCIDR["5.6.7.0/24"].Description; d != "SBL123" {
+		t.Errorf("description = %q, want SBL123", d)
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	data := `first_seen,ip_address,port
+2026-01-01,198.51.100.7,22
+2026-01-02,"203.0.113.0/25",80
+2026-01-03,,443
+`
+	got, _, skipped := collect(t, FormatCSV, "IP_Address", data)
+	want := []string{"198.51.100.7/32", "203.0.113.0/25"}
+	if !reflect.DeepEqual(got, want) || skipped != 1 {
+		t.Errorf("with column: cidrs = %v, skipped %d; want %v, 1", got, skipped, want)
+	}
+
+	// Without a column, the first parsable field of each row is used and the
+	// header is skipped.
+	got, _, skipped = collect(t, FormatCSV, "", data)
+	if !reflect.DeepEqual(got, want) || skipped != 2 {
+		t.Errorf("without column: cidrs = %v, skipped %d; want %v, 2", got, skipped, want)
+	}
+
+	if _, err := parse(strings.NewReader(data), FormatCSV, "addr", func(Indicator) {}); err == nil {
+		t.Error("missing column: expected error")
+	}
+}
+
+func TestParseSTIX(t *testing.T) {
+	data := `{
+  "type": "bundle",
+  "objects": [
+    {"type": "indicator", "name": "Scanner range", "pattern_type": "stix",
+     "pattern": "[ipv4-addr:value = '198.51.100.0/24' OR ipv6-addr:value = '2001:db8::/32']",
+     "valid_until": "2026-12-01T00:00:00Z"},
+    {"type": "indicator", "revoked": true, "pattern": "[ipv4-addr:value = '192.0.2.9']"},
+    {"type": "indicator", "pattern_type": "snort", "pattern": "alert ip 192.0.2.10 any"},
+    {"type": "indicator", "pattern": "[domain-name:value = 'evil.example']"},
+    {"type": "ipv4-addr", "value": "203.0.113.5"},
+    {"type": "malware", "name": "ignored"}
+  ]
+}`
+	got, byCIDR, skipped := collect(t, FormatSTIX, "", data)
+	want := []string{"198.51.100.0/24", "2001:db8::/32", "203.0.113.5/32"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("cidrs = %v, want %v", got, want)
+	}
+	if skipped != 3 {
+		t.Errorf("skipped = %d, want 3", skipped)
+	}
+	ind := byCIDR["198.51.100.0/24"]
+	if ind.Description != "Scanner range" || ind.ValidUntil == nil ||
+		!ind.ValidUntil.Equal(time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("indicator = %+v", ind)
+	}
+
+	// A bare JSON array of objects is accepted too.
+	got, _, _ = collect(t, FormatSTIX, "", `[{"type": "ipv6-addr", "value": "2001:db8::5"}]`)
+	if !reflect.DeepEqual(got, []string{"2001:db8::5/128"}) {
+		t.Errorf("array: cidrs = %v", got)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("1.2.3.4\n1.2.3.4/32\n5.6.7.8\n"))
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.Client())
+	data, err := client.Load(context.Background(), config.ReferenceThreatIntelFeed{
+		Name: "tor", Source: srv.URL, Format: FormatText,
+	}, func(string) {})
+	if err != nil {
+		t.Fatalf("Load(url): %v", err)
+	}
+	if len(data.Indicators) != 2 {
+		t.Errorf("Load(url): %d indicators, want 2 (duplicates merged)", len(data.Indicators))
+	}
+
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "a.csv"), []byte("ip\n192.0.2.1\n"), 0o644)
+	os.WriteFile(filepath.Join(dir, "b.csv"), []byte("ip\n192.0.2.2\n"), 0o644)
+	os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("192.0.2.3\n"), 0o644)
+	data, err = client.Load(context.Background(), config.ReferenceThreatIntelFeed{
+		Name: "local", Source: dir, Format: FormatCSV, Column: "ip",
+	}, func(string) {})
+	if err != nil {
+		t.Fatalf("Load(dir): %v", err)
+	}
+	if len(data.Indicators) != 2 {
+		t.Errorf("Load(dir): %d indicators, want 2", len(data.Indicators))
+	}
+}
+
+func TestWithDefaults(t *testing.T) {
+	feeds, err := withDefaults([]config.ReferenceThreatIntelFeed{{Name: "a", Source: "/x"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f := feeds[0]; f.Format != FormatText || f.Category != CategoryBlocklist || f.ExpiryHours != defaultExpiryHours {
+		t.Errorf("defaults = %+v", f)
+	}
+
+	bad := [][]config.ReferenceThreatIntelFeed{
+		{{Name: "a"}},
+		{{Name: "a", Source: "/x"}, {Name: "a", Source: "/y"}},
+		{{Name: "a", Source: "/x", Format: "xml"}},
+		{{Name: "a", Source: "/x", Category: "vpn"}},
+	}
+	for _, feeds := range bad {
+		if _, err := withDefaults(feeds); err == nil {
+			t.Errorf("withDefaults(%+v): expected error", feeds)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package threatintel
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/reference"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider:  "reference",
+		Name:      "threatintel",
+		Register:  Register,
+		Workflow:  ThreatIntelWorkflow,
+		NewResult: func() any { return &ThreatIntelWorkflowResult{} },
+		Aggregate: func(parent *reference.ReferenceInventoryWorkflowResult, child any) {
+			r := child.(*ThreatIntelWorkflowResult)
+			parent.ThreatIntelFeedCount = r.FeedCount
+			parent.ThreatIntelIndicatorCount = r.IndicatorCount
+		},
+	})
+}
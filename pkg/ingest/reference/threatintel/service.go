@@ -0,0 +1,216 @@
+package threatintel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"danny.vn/hotpot/pkg/base/config"
+	entreference "danny.vn/hotpot/pkg/storage/ent/reference"
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencethreatintelindicator"
+)
+
+const (
+	insertBatchSize = 1000
+
+	// defaultExpiryHours keeps indicators through two missed daily runs.
+	defaultExpiryHours = 72
+)
+
+// Service handles threat intel feed persistence.
+type Service struct {
+	client    *Client
+	entClient *entreference.Client
+}
+
+// NewService creates a new threat intel service.
+func NewService(client *Client, entClient *entreference.Client) *Service {
+	return &Service{client: client, entClient: entClient}
+}
+
+// IngestResult contains the result of a threat intel ingestion.
+type IngestResult struct {
+	FeedCount      int
+	IndicatorCount int
+	SkippedCount   int
+	DurationMillis int64
+}
+
+// Ingest loads every configured feed and replaces its indicators. Feeds
+// are independent: a feed that fails to load keeps its previous indicators
+// until they expire, and the errors are returned after the other feeds are
+// stored. Indicators of feeds no longer configured, and expired ones, are
+// deleted.
+func (s *Service) Ingest(ctx context.Context, feeds []config.ReferenceThreatIntelFeed, heartbeat func(string)) (*IngestResult, error) {
+	start := time.Now()
+
+	feeds, err := withDefaults(feeds)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(feeds))
+	for i, feed := range feeds {
+		names[i] = feed.Name
+	}
+	deleted, err := s.entClient.BronzeReferenceThreatIntelIndicator.Delete().
+		Where(bronzereferencethreatintelindicator.Or(
+			bronzereferencethreatintelindicator.FeedNameNotIn(names...),
+			bronzereferencethreatintelindicator.ExpiresAtLT(start),
+		)).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("delete stale indicators: %w", err)
+	}
+	slog.Info("Deleted stale threat intel indicators", "count", deleted)
+
+	result := &IngestResult{}
+	var errs []error
+	for _, feed := range feeds {
+		heartbeat(fmt.Sprintf("loading feed %s", feed.Name))
+		data, err := s.client.Load(ctx, feed, heartbeat)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("load feed %s: %w", feed.Name, err))
+			continue
+		}
+		count, err := s.replaceFeed(ctx, feed, data, heartbeat)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("store feed %s: %w", feed.Name, err))
+			continue
+		}
+		slog.Info("Loaded threat intel feed",
+			"feed", feed.Name, "indicators", count, "skipped", data.Skipped)
+		result.FeedCount++
+		result.IndicatorCount += count
+		result.SkippedCount += data.Skipped
+	}
+
+	result.DurationMillis = time.Since(start).Milliseconds()
+	return result, errors.Join(errs...)
+}
+
+// replaceFeed replaces the indicators of one feed in a transaction, keeping
+// first_collected_at of indicators already listed.
+func (s *Service) replaceFeed(ctx context.Context, feed config.ReferenceThreatIntelFeed, data *FeedData, heartbeat func(string)) (int, error) {
+	now := time.Now()
+	expiresAt := now.Add(time.Duration(feed.ExpiryHours) * time.Hour)
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	existing, err := tx.BronzeReferenceThreatIntelIndicator.Query().
+		Where(bronzereferencethreatintelindicator.FeedName(feed.Name)).
+		Select(bronzereferencethreatintelindicator.FieldFirstCollectedAt).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("query existing indicators: %w", err)
+	}
+	firstCollected := make(map[string]time.Time, len(existing))
+	for _, e := range existing {
+		firstCollected[e.ID] = e.FirstCollectedAt
+	}
+
+	if _, err := tx.BronzeReferenceThreatIntelIndicator.Delete().
+		Where(bronzereferencethreatintelindicator.FeedName(feed.Name)).
+		Exec(ctx); err != nil {
+		return 0, fmt.Errorf("delete existing indicators: %w", err)
+	}
+
+	var (
+		builders []*entreference.BronzeReferenceThreatIntelIndicatorCreate
+		count    int
+	)
+	for _, ind := range data.Indicators {
+		expires := expiresAt
+		if ind.ValidUntil != nil && ind.ValidUntil.Before(expires) {
+			if !ind.ValidUntil.After(now) {
+				continue
+			}
+			expires = *ind.ValidUntil
+		}
+
+		cidr := ind.Prefix.String()
+		id := feed.Name + ":" + cidr
+		first, ok := firstCollected[id]
+		if !ok {
+			first = now
+		}
+		builders = append(builders, tx.BronzeReferenceThreatIntelIndicator.Create().
+			SetID(id).
+			SetFeedName(feed.Name).
+			SetFeedSource(feed.Source).
+			SetFeedFormat(feed.Format).
+			SetCategory(feed.Category).
+			SetCidr(cidr).
+			SetNillableDescription(nilIfEmpty(ind.Description)).
+			SetExpiresAt(expires).
+			SetCollectedAt(now).
+			SetFirstCollectedAt(first))
+		count++
+
+		if len(builders) >= insertBatchSize {
+			if err := tx.BronzeReferenceThreatIntelIndicator.CreateBulk(builders...).Exec(ctx); err != nil {
+				return 0, fmt.Errorf("bulk insert indicators: %w", err)
+			}
+			builders = builders[:0]
+			heartbeat(fmt.Sprintf("saved %d indicators of feed %s", count, feed.Name))
+		}
+	}
+	if len(builders) > 0 {
+		if err := tx.BronzeReferenceThreatIntelIndicator.CreateBulk(builders...).Exec(ctx); err != nil {
+			return 0, fmt.Errorf("bulk insert indicators: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit tx: %w", err)
+	}
+	return count, nil
+}
+
+// withDefaults validates feeds and fills in the default format, category
+// and expiry.
+func withDefaults(feeds []config.ReferenceThreatIntelFeed) ([]config.ReferenceThreatIntelFeed, error) {
+	seen := make(map[string]bool, len(feeds))
+	result := make([]config.ReferenceThreatIntelFeed, len(feeds))
+	for i, feed := range feeds {
+		if feed.Name == "" || feed.Source == "" {
+			return nil, fmt.Errorf("threat intel feed %d: name and source are required", i)
+		}
+		if seen[feed.Name] {
+			return nil, fmt.Errorf("threat intel feed %s: duplicate name", feed.Name)
+		}
+		seen[feed.Name] = true
+
+		if feed.Format == "" {
+			feed.Format = FormatText
+		}
+		if _, ok := formatSuffixes[feed.Format]; !ok {
+			return nil, fmt.Errorf("threat intel feed %s: unknown format %q", feed.Name, feed.Format)
+		}
+		switch feed.Category {
+		case "":
+			feed.Category = CategoryBlocklist
+		case CategoryScanner, CategoryTor, CategoryBlocklist:
+		default:
+			return nil, fmt.Errorf("threat intel feed %s: unknown category %q", feed.Name, feed.Category)
+		}
+		if feed.ExpiryHours <= 0 {
+			feed.ExpiryHours = defaultExpiryHours
+		}
+		result[i] = feed
+	}
+	return result, nil
+}
+
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
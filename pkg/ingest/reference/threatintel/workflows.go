@@ -0,0 +1,53 @@
+package threatintel
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/base/temporalerr"
+)
+
+// ThreatIntelWorkflowResult contains the result of the threat intel workflow.
+type ThreatIntelWorkflowResult struct {
+	FeedCount      int
+	IndicatorCount int
+	DurationMillis int64
+}
+
+// ThreatIntelWorkflow ingests the configured IP/CIDR threat intel feeds.
+func ThreatIntelWorkflow(ctx workflow.Context) (*ThreatIntelWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting ThreatIntelWorkflow")
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestThreatIntelResult
+	err := workflow.ExecuteActivity(activityCtx, IngestThreatIntelActivity).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest threat intel feeds", "error", err)
+		return nil, temporalerr.PropagateNonRetryable(err)
+	}
+
+	logger.Info("Completed ThreatIntelWorkflow",
+		"feedCount", result.FeedCount,
+		"indicatorCount", result.IndicatorCount,
+	)
+
+	return &ThreatIntelWorkflowResult{
+		FeedCount:      result.FeedCount,
+		IndicatorCount: result.IndicatorCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -40,11 +40,12 @@ func NewClient(httpClient *http.Client) *Client {
 
 // UbuntuPackageData holds a parsed Ubuntu package entry.
 type UbuntuPackageData struct {
-	PackageName string
-	Release     string
-	Component   string
-	Section     string
-	Description string
+	PackageName   string
+	SourcePackage string // empty when the source package has the same name
+	Release       string
+	Component     string
+	Section       string
+	Description   string
 }
 
 // DownloadFeed fetches a single Ubuntu Packages.gz feed and parses it.
@@ -92,7 +93,7 @@ func parsePackages(r io.Reader, release, component string) ([]UbuntuPackageData,
 	// Increase buffer for long lines
 	scanner.Buffer(make([]byte, 0, 1024*1024), 1024*1024)
 
-	var pkg, section, description string
+	var pkg, source, section, description string
 	// Deduplicate — Packages index can list the same package name multiple
 	// times (e.g., different architectures merged into one index).
 	seen := make(map[string]struct{})
@@ -101,16 +102,21 @@ func parsePackages(r io.Reader, release, component string) ([]UbuntuPackageData,
 		if pkg != "" && section != "" {
 			if _, dup := seen[pkg]; !dup {
 				seen[pkg] = struct{}{}
+				if source == pkg {
+					source = ""
+				}
 				result = append(result, UbuntuPackageData{
-					PackageName: pkg,
-					Release:     release,
-					Component:   component,
-					Section:     section,
-					Description: description,
+					PackageName:   pkg,
+					SourcePackage: source,
+					Release:       release,
+					Component:     component,
+					Section:       section,
+					Description:   description,
 				})
 			}
 		}
 		pkg = ""
+		source = ""
 		section = ""
 		description = ""
 	}
@@ -137,6 +143,9 @@ func parsePackages(r io.Reader, release, component string) ([]UbuntuPackageData,
 		switch key {
 		case "Package":
 			pkg = value
+		case "Source":
+			// "openssl (3.0.13-0ubuntu3)" when the source version differs.
+			source, _, _ = strings.Cut(value, " ")
 		case "Section":
 			section = value
 		case "Description":
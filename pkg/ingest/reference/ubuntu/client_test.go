@@ -0,0 +1,43 @@
+package ubuntu
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParsePackages(t *testing.T) {
+	data := `Package: libssl3t64
+Source: openssl (3.0.13-0ubuntu3.4)
+Section: libs
+Description: Secure Sockets Layer toolkit - shared libraries
+ This package is part of the OpenSSL project's implementation.
+
+Package: openssl
+Source: openssl
+Section: utils
+Description: Secure Sockets Layer toolkit - cryptographic utility
+
+Package: rsyslog
+Section: admin
+Description: reliable system and kernel logging daemon
+
+Package: no-section
+Description: skipped
+
+Package: rsyslog
+Section: admin
+`
+	got, err := parsePackages(strings.NewReader(data), "noble", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []UbuntuPackageData{
+		{PackageName: "libssl3t64", SourcePackage: "openssl", Release: "noble", Component: "main", Section: "libs", Description: "Secure Sockets Layer toolkit - shared libraries"},
+		{PackageName: "openssl", Release: "noble", Component: "main", Section: "utils", Description: "Secure Sockets Layer toolkit - cryptographic utility"},
+		{PackageName: "rsyslog", Release: "noble", Component: "main", Section: "admin", Description: "reliable system and kernel logging daemon"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parsePackages() =\n%+v\nwant\n%+v", got, want)
+	}
+}
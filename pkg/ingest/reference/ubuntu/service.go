@@ -75,6 +75,9 @@ func (s *Service) IngestFeed(ctx context.Context, release, component string, pac
 				SetCollectedAt(now).
 				SetFirstCollectedAt(now)
 
+			if d.SourcePackage != "" {
+				b.SetSourcePackage(d.SourcePackage)
+			}
 			if d.Description != "" {
 				b.SetDescription(d.Description)
 			}
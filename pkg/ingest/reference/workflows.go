@@ -20,6 +20,13 @@ type ReferenceInventoryWorkflowResult struct {
 	XeolCycleCount     int
 	XeolPurlCount      int
 	XeolVulnCount      int
+	OSVVulnCount       int
+	OSVAffectedCount   int
+	NVDCVECount        int
+	NVDCPEMatchCount   int
+
+	ThreatIntelFeedCount      int
+	ThreatIntelIndicatorCount int
 }
 
 // aggregateFunc is the function signature for merging a service result into the provider result.
@@ -78,6 +85,12 @@ func ReferenceInventoryWorkflow(ctx workflow.Context) (*ReferenceInventoryWorkfl
 		"xeolCycles", result.XeolCycleCount,
 		"xeolPurls", result.XeolPurlCount,
 		"xeolVulns", result.XeolVulnCount,
+		"osvVulns", result.OSVVulnCount,
+		"osvAffected", result.OSVAffectedCount,
+		"nvdCVEs", result.NVDCVECount,
+		"nvdCPEMatches", result.NVDCPEMatchCount,
+		"threatIntelFeeds", result.ThreatIntelFeedCount,
+		"threatIntelIndicators", result.ThreatIntelIndicatorCount,
 	)
 
 	return result, nil
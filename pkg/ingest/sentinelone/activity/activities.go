@@ -0,0 +1,86 @@
+package activity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/base/temporalerr"
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *ents1.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *ents1.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+func (a *Activities) createClient() *Client {
+	httpClient := &http.Client{
+		Transport: ratelimit.NewRateLimitedTransport(a.limiter, nil),
+	}
+	return NewClient(
+		a.configService.S1BaseURL(),
+		a.configService.S1APIToken(),
+		a.configService.S1BatchSize(),
+		httpClient,
+	)
+}
+
+// IngestS1ActivitiesResult contains the result of the ingest activity.
+type IngestS1ActivitiesResult struct {
+	ActivityCount  int
+	PrunedCount    int
+	DurationMillis int64
+}
+
+// IngestS1ActivitiesActivity is the activity function reference for workflow registration.
+var IngestS1ActivitiesActivity = (*Activities).IngestS1Activities
+
+// IngestS1Activities is a Temporal activity that appends new SentinelOne console activities.
+func (a *Activities) IngestS1Activities(ctx context.Context) (*IngestS1ActivitiesResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting SentinelOne activity ingestion")
+
+	client := a.createClient()
+	service := NewService(client, a.entClient)
+
+	day := 24 * time.Hour
+	backfill := time.Duration(a.configService.S1ActivityBackfillDays()) * day
+	retention := time.Duration(a.configService.S1ActivityRetentionDays()) * day
+
+	result, err := service.Ingest(ctx, backfill, retention, func() {
+		activity.RecordHeartbeat(ctx, nil)
+	})
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("ingest activities: %w", err))
+	}
+
+	logger.Info("Completed SentinelOne activity ingestion",
+		"activityCount", result.ActivityCount,
+		"prunedCount", result.PrunedCount,
+		"watermark", result.Watermark,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestS1ActivitiesResult{
+		ActivityCount:  result.ActivityCount,
+		PrunedCount:    result.PrunedCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package activity
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"danny.vn/hotpot/pkg/base/httperr"
+)
+
+// Client wraps the SentinelOne Activities API.
+type Client struct {
+	baseURL    string
+	apiToken   string
+	batchSize  int
+	httpClient *http.Client
+}
+
+// NewClient creates a new SentinelOne activities client.
+func NewClient(baseURL, apiToken string, batchSize int, httpClient *http.Client) *Client {
+	return &Client{
+		baseURL:    baseURL,
+		apiToken:   apiToken,
+		batchSize:  batchSize,
+		httpClient: httpClient,
+	}
+}
+
+// APIActivity represents a console activity from the SentinelOne API response.
+type APIActivity struct {
+	ID                   string          `json:"id"`
+	ActivityType         int             `json:"activityType"`
+	ActivityUUID         string          `json:"activityUuid"`
+	PrimaryDescription   string          `json:"primaryDescription"`
+	SecondaryDescription string          `json:"secondaryDescription"`
+	Comments             string          `json:"comments"`
+	AccountID            string          `json:"accountId"`
+	AccountName          string          `json:"accountName"`
+	SiteID               string          `json:"siteId"`
+	SiteName             string          `json:"siteName"`
+	GroupID              string          `json:"groupId"`
+	GroupName            string          `json:"groupName"`
+	AgentID              string          `json:"agentId"`
+	UserID               string          `json:"userId"`
+	ThreatID             string          `json:"threatId"`
+	Hash                 string          `json:"hash"`
+	OSFamily             string          `json:"osFamily"`
+	Data                 json.RawMessage `json:"data"`
+	CreatedAt            *time.Time      `json:"createdAt"`
+	UpdatedAt            *time.Time      `json:"updatedAt"`
+}
+
+// ActivityBatchResult contains a batch of activities and pagination info.
+type ActivityBatchResult struct {
+	Activities []APIActivity
+	NextCursor string
+	HasMore    bool
+}
+
+// GetActivitiesBatch retrieves a batch of activities created in [from, to),
+// oldest first, with cursor pagination.
+func (c *Client) GetActivitiesBatch(from, to time.Time, cursor string) (*ActivityBatchResult, error) {
+	params := url.Values{}
+	params.Set("limit", fmt.Sprintf("%d", c.batchSize))
+	params.Set("sortBy", "createdAt")
+	params.Set("sortOrder", "asc")
+	params.Set("createdAt__gte", from.UTC().Format(time.RFC3339Nano))
+	params.Set("createdAt__lt", to.UTC().Format(time.RFC3339Nano))
+	if cursor != "" {
+		params.Set("cursor", cursor)
+	}
+
+	body, err := c.doRequest("GET", "/web/api/v2.1/activities", params)
+	if err != nil {
+		return nil, fmt.Errorf("get activities: %w", err)
+	}
+
+	var response struct {
+		Data       []APIActivity `json:"data"`
+		Pagination struct {
+			NextCursor string `json:"nextCursor"`
+		} `json:"pagination"`
+	}
+
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("parse activities response: %w", err)
+	}
+
+	return &ActivityBatchResult{
+		Activities: response.Data,
+		NextCursor: response.Pagination.NextCursor,
+		HasMore:    response.Pagination.NextCursor != "",
+	}, nil
+}
+
+func (c *Client) doRequest(method, endpoint string, params url.Values) ([]byte, error) {
+	requestURL := fmt.Sprintf("%s%s", c.baseURL, endpoint)
+	if params != nil {
+		requestURL = fmt.Sprintf("%s?%s", requestURL, params.Encode())
+	}
+
+	start := time.Now()
+
+	req, err := http.NewRequest(method, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("ApiToken %s", c.apiToken))
+	req.Header.Set("Content-Type", "application/json")
+
+	slog.Debug("s1 api request", "method", method, "endpoint", endpoint)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		slog.Error("s1 api request failed", "method", method, "endpoint", endpoint, "error", err, "durationMs", time.Since(start).Milliseconds())
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	slog.Info("s1 api response", "method", method, "endpoint", endpoint, "status", resp.StatusCode, "responseBytes", len(body), "durationMs", time.Since(start).Milliseconds())
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return nil, &httperr.APIError{Code: resp.StatusCode}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return body, nil
+}
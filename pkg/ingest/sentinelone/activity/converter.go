@@ -0,0 +1,66 @@
+package activity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ActivityData holds converted activity data ready for Ent insertion.
+type ActivityData struct {
+	ResourceID           string
+	ActivityType         int
+	ActivityUUID         string
+	PrimaryDescription   string
+	SecondaryDescription string
+	Comments             string
+	AccountID            string
+	AccountName          string
+	SiteID               string
+	SiteName             string
+	GroupID              string
+	GroupName            string
+	AgentID              string
+	UserID               string
+	ThreatID             string
+	Hash                 string
+	OSFamily             string
+	APICreatedAt         time.Time
+	APIUpdatedAt         *time.Time
+	DataJSON             json.RawMessage
+	CollectedAt          time.Time
+}
+
+// ConvertActivity converts an API activity to ActivityData. An activity
+// without createdAt is dated at collection time so retention still applies.
+func ConvertActivity(a APIActivity, collectedAt time.Time) *ActivityData {
+	data := &ActivityData{
+		ResourceID:           a.ID,
+		ActivityType:         a.ActivityType,
+		ActivityUUID:         a.ActivityUUID,
+		PrimaryDescription:   a.PrimaryDescription,
+		SecondaryDescription: a.SecondaryDescription,
+		Comments:             a.Comments,
+		AccountID:            a.AccountID,
+		AccountName:          a.AccountName,
+		SiteID:               a.SiteID,
+		SiteName:             a.SiteName,
+		GroupID:              a.GroupID,
+		GroupName:            a.GroupName,
+		AgentID:              a.AgentID,
+		UserID:               a.UserID,
+		ThreatID:             a.ThreatID,
+		Hash:                 a.Hash,
+		OSFamily:             a.OSFamily,
+		APICreatedAt:         collectedAt,
+		APIUpdatedAt:         a.UpdatedAt,
+		CollectedAt:          collectedAt,
+	}
+	if a.CreatedAt != nil {
+		data.APICreatedAt = *a.CreatedAt
+	}
+	if len(a.Data) > 0 && string(a.Data) != "null" {
+		data.DataJSON = a.Data
+	}
+
+	return data
+}
@@ -0,0 +1,52 @@
+package activity
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestConvertActivity(t *testing.T) {
+	raw := `{
+		"id": "1800000000000000001",
+		"activityType": 27,
+		"primaryDescription": "The management user alice logged in",
+		"accountId": "acc-1",
+		"siteId": "site-1",
+		"userId": "user-1",
+		"createdAt": "2024-05-01T10:00:00.123456Z",
+		"data": {"username": "alice", "ipAddress": "10.0.0.5"}
+	}`
+	var api APIActivity
+	if err := json.Unmarshal([]byte(raw), &api); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	collected := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	data := ConvertActivity(api, collected)
+
+	if data.ResourceID != "1800000000000000001" || data.ActivityType != 27 || data.UserID != "user-1" {
+		t.Errorf("data = %+v", data)
+	}
+	if want := time.Date(2024, 5, 1, 10, 0, 0, 123456000, time.UTC); !data.APICreatedAt.Equal(want) {
+		t.Errorf("APICreatedAt = %v, want %v", data.APICreatedAt, want)
+	}
+	if data.APIUpdatedAt != nil {
+		t.Errorf("APIUpdatedAt = %v, want nil", data.APIUpdatedAt)
+	}
+	if data.DataJSON == nil {
+		t.Error("DataJSON not kept")
+	}
+}
+
+func TestConvertActivity_NoCreatedAt(t *testing.T) {
+	collected := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	data := ConvertActivity(APIActivity{ID: "1", Data: json.RawMessage("null")}, collected)
+
+	if !data.APICreatedAt.Equal(collected) {
+		t.Errorf("APICreatedAt = %v, want collection time", data.APICreatedAt)
+	}
+	if data.DataJSON != nil {
+		t.Errorf("DataJSON = %s, want nil for null payload", data.DataJSON)
+	}
+}
@@ -0,0 +1,20 @@
+package activity
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider:  "sentinelone",
+		Name:      "activity",
+		Register:  Register,
+		Workflow:  S1ActivityWorkflow,
+		NewResult: func() any { return &S1ActivityWorkflowResult{} },
+		Aggregate: func(parent *sentinelone.S1InventoryWorkflowResult, child any) {
+			r := child.(*S1ActivityWorkflowResult)
+			parent.ActivityCount = r.ActivityCount
+		},
+	})
+}
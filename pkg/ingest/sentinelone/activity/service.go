@@ -0,0 +1,216 @@
+package activity
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"danny.vn/hotpot/pkg/base/appendlog"
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1activity"
+)
+
+// CursorName is the s1_ingest_cursors stream name for activities. Its
+// watermark is the createdAt end of the last committed window.
+const CursorName = "activities"
+
+const (
+	// windowSize bounds the work redone when a run is interrupted.
+	windowSize = time.Hour
+
+	// windowLag keeps clear of activities the console indexes late.
+	windowLag = 5 * time.Minute
+)
+
+// Service handles SentinelOne activity ingestion.
+type Service struct {
+	client    *Client
+	entClient *ents1.Client
+}
+
+// NewService creates a new activity ingestion service.
+func NewService(client *Client, entClient *ents1.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+	}
+}
+
+// IngestResult contains the result of activity ingestion.
+type IngestResult struct {
+	ActivityCount  int
+	PrunedCount    int
+	Watermark      time.Time
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest appends activities created since the persisted watermark, one hour
+// window at a time, then prunes activities older than the retention period.
+// The first run backfills the given period.
+func (s *Service) Ingest(ctx context.Context, backfill, retention time.Duration, heartbeat func()) (*IngestResult, error) {
+	startTime := time.Now()
+
+	stream := &stream{
+		service:     s,
+		collectedAt: startTime,
+		heartbeat:   heartbeat,
+	}
+	cfg := appendlog.Config{
+		Backfill:   backfill,
+		WindowSize: windowSize,
+		Lag:        windowLag,
+		Retention:  retention,
+	}
+
+	result, err := appendlog.Run(ctx, stream, cfg, startTime, heartbeat)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IngestResult{
+		ActivityCount:  result.Events,
+		PrunedCount:    result.Pruned,
+		Watermark:      result.Watermark,
+		CollectedAt:    startTime,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+// stream adapts Service to appendlog.Stream for one run.
+type stream struct {
+	service     *Service
+	collectedAt time.Time
+	heartbeat   func()
+}
+
+func (st *stream) Watermark(ctx context.Context) (time.Time, error) {
+	return sentinelone.LoadCursor(ctx, st.service.entClient, CursorName)
+}
+
+func (st *stream) Commit(ctx context.Context, end time.Time) error {
+	return sentinelone.SaveCursor(ctx, st.service.entClient, CursorName, end)
+}
+
+func (st *stream) Prune(ctx context.Context, before time.Time) (int, error) {
+	n, err := st.service.entClient.BronzeS1Activity.Delete().
+		Where(bronzes1activity.APICreatedAtLT(before)).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("delete activities before %s: %w", before.Format(time.RFC3339), err)
+	}
+	return n, nil
+}
+
+func (st *stream) Ingest(ctx context.Context, w appendlog.Window) (int, error) {
+	total := 0
+	cursor := ""
+	batchNum := 0
+
+	for {
+		batchNum++
+		batch, err := st.service.client.GetActivitiesBatch(w.Start, w.End, cursor)
+		if err != nil {
+			slog.Error("s1 activities batch failed", "batch", batchNum, "windowStart", w.Start, "error", err)
+			return 0, fmt.Errorf("get activities batch: %w", err)
+		}
+
+		activities := make([]*ActivityData, 0, len(batch.Activities))
+		for _, apiActivity := range batch.Activities {
+			activities = append(activities, ConvertActivity(apiActivity, st.collectedAt))
+		}
+
+		n, err := st.service.appendActivities(ctx, activities)
+		if err != nil {
+			return 0, fmt.Errorf("save activities: %w", err)
+		}
+		total += n
+
+		slog.Info("s1 activities batch saved", "batch", batchNum, "batchItems", len(activities), "inserted", n, "windowStart", w.Start, "hasMore", batch.HasMore)
+
+		if st.heartbeat != nil {
+			st.heartbeat()
+		}
+
+		if !batch.HasMore {
+			break
+		}
+		cursor = batch.NextCursor
+	}
+
+	return total, nil
+}
+
+// appendActivities inserts activities not stored yet and returns how many
+// were inserted. Activities already present (a window fetched again after a
+// failed watermark commit) are skipped, never updated.
+func (s *Service) appendActivities(ctx context.Context, activities []*ActivityData) (int, error) {
+	if len(activities) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]string, 0, len(activities))
+	for _, data := range activities {
+		ids = append(ids, data.ResourceID)
+	}
+	existing, err := s.entClient.BronzeS1Activity.Query().
+		Where(bronzes1activity.IDIn(ids...)).
+		IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("load existing activities: %w", err)
+	}
+	seen := make(map[string]bool, len(activities))
+	for _, id := range existing {
+		seen[id] = true
+	}
+
+	builders := make([]*ents1.BronzeS1ActivityCreate, 0, len(activities))
+	for _, data := range activities {
+		if seen[data.ResourceID] {
+			continue
+		}
+		seen[data.ResourceID] = true
+
+		create := s.entClient.BronzeS1Activity.Create().
+			SetID(data.ResourceID).
+			SetActivityType(data.ActivityType).
+			SetActivityUUID(data.ActivityUUID).
+			SetPrimaryDescription(data.PrimaryDescription).
+			SetSecondaryDescription(data.SecondaryDescription).
+			SetComments(data.Comments).
+			SetAccountID(data.AccountID).
+			SetAccountName(data.AccountName).
+			SetSiteID(data.SiteID).
+			SetSiteName(data.SiteName).
+			SetGroupID(data.GroupID).
+			SetGroupName(data.GroupName).
+			SetAgentID(data.AgentID).
+			SetUserID(data.UserID).
+			SetThreatID(data.ThreatID).
+			SetHash(data.Hash).
+			SetOsFamily(data.OSFamily).
+			SetAPICreatedAt(data.APICreatedAt).
+			SetCollectedAt(data.CollectedAt).
+			SetFirstCollectedAt(data.CollectedAt)
+
+		if data.APIUpdatedAt != nil {
+			create.SetAPIUpdatedAt(*data.APIUpdatedAt)
+		}
+		if data.DataJSON != nil {
+			create.SetDataJSON(data.DataJSON)
+		}
+
+		builders = append(builders, create)
+	}
+
+	if len(builders) == 0 {
+		return 0, nil
+	}
+	if err := s.entClient.BronzeS1Activity.CreateBulk(builders...).Exec(ctx); err != nil {
+		return 0, fmt.Errorf("insert activities: %w", err)
+	}
+
+	return len(builders), nil
+}
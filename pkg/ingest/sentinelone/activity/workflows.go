@@ -0,0 +1,53 @@
+package activity
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/base/temporalerr"
+)
+
+// S1ActivityWorkflowResult contains the result of the activity workflow.
+type S1ActivityWorkflowResult struct {
+	ActivityCount  int
+	PrunedCount    int
+	DurationMillis int64
+}
+
+// S1ActivityWorkflow appends SentinelOne console activities created since the last run.
+func S1ActivityWorkflow(ctx workflow.Context) (*S1ActivityWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting S1ActivityWorkflow")
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestS1ActivitiesResult
+	err := workflow.ExecuteActivity(activityCtx, IngestS1ActivitiesActivity).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest activities", "error", err)
+		return nil, temporalerr.PropagateNonRetryable(err)
+	}
+
+	logger.Info("Completed S1ActivityWorkflow",
+		"activityCount", result.ActivityCount,
+		"prunedCount", result.PrunedCount,
+	)
+
+	return &S1ActivityWorkflowResult{
+		ActivityCount:  result.ActivityCount,
+		PrunedCount:    result.PrunedCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,169 @@
+package alert
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"danny.vn/hotpot/pkg/base/httperr"
+)
+
+// Client wraps the SentinelOne Cloud Detection alerts API.
+type Client struct {
+	baseURL    string
+	apiToken   string
+	batchSize  int
+	httpClient *http.Client
+}
+
+// NewClient creates a new SentinelOne alerts client.
+func NewClient(baseURL, apiToken string, batchSize int, httpClient *http.Client) *Client {
+	return &Client{
+		baseURL:    baseURL,
+		apiToken:   apiToken,
+		batchSize:  batchSize,
+		httpClient: httpClient,
+	}
+}
+
+// APIAlertAgentDetectionInfo is the agent state captured when the alert fired.
+type APIAlertAgentDetectionInfo struct {
+	Name   string `json:"name"`
+	OSName string `json:"osName"`
+	SiteID string `json:"siteId"`
+	UUID   string `json:"uuid"`
+}
+
+// APIAlertAgentRealtimeInfo is the current state of the agent that raised the alert.
+type APIAlertAgentRealtimeInfo struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	OS   string `json:"os"`
+	UUID string `json:"uuid"`
+}
+
+// APIAlertInfo holds the alert lifecycle and event details.
+type APIAlertInfo struct {
+	AlertID        string     `json:"alertId"`
+	AnalystVerdict string     `json:"analystVerdict"`
+	IncidentStatus string     `json:"incidentStatus"`
+	EventType      string     `json:"eventType"`
+	HitType        string     `json:"hitType"`
+	Source         string     `json:"source"`
+	DVEventID      string     `json:"dvEventId"`
+	IsEDR          bool       `json:"isEdr"`
+	ReportedAt     *time.Time `json:"reportedAt"`
+	CreatedAt      *time.Time `json:"createdAt"`
+	UpdatedAt      *time.Time `json:"updatedAt"`
+}
+
+// APIRuleInfo describes the STAR rule that raised the alert.
+type APIRuleInfo struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Severity      string `json:"severity"`
+	Description   string `json:"description"`
+	ScopeLevel    string `json:"scopeLevel"`
+	TreatAsThreat string `json:"treatAsThreat"`
+}
+
+// APIAlert represents a cloud-detection alert from the SentinelOne API response.
+type APIAlert struct {
+	AgentDetectionInfo APIAlertAgentDetectionInfo `json:"agentDetectionInfo"`
+	AgentRealtimeInfo  APIAlertAgentRealtimeInfo  `json:"agentRealtimeInfo"`
+	AlertInfo          APIAlertInfo               `json:"alertInfo"`
+	RuleInfo           APIRuleInfo                `json:"ruleInfo"`
+	SourceProcessInfo  json.RawMessage            `json:"sourceProcessInfo"`
+	TargetProcessInfo  json.RawMessage            `json:"targetProcessInfo"`
+}
+
+// AlertBatchResult contains a batch of alerts and pagination info.
+type AlertBatchResult struct {
+	Alerts     []APIAlert
+	NextCursor string
+	HasMore    bool
+}
+
+// GetAlertsBatch retrieves a batch of alerts updated at or after since,
+// oldest first. A zero since fetches every alert.
+func (c *Client) GetAlertsBatch(since time.Time, cursor string) (*AlertBatchResult, error) {
+	params := url.Values{}
+	params.Set("limit", fmt.Sprintf("%d", c.batchSize))
+	params.Set("sortBy", "alertInfoUpdatedAt")
+	params.Set("sortOrder", "asc")
+	if !since.IsZero() {
+		params.Set("updatedAt__gte", since.UTC().Format(time.RFC3339Nano))
+	}
+	if cursor != "" {
+		params.Set("cursor", cursor)
+	}
+
+	body, err := c.doRequest("GET", "/web/api/v2.1/cloud-detection/alerts", params)
+	if err != nil {
+		return nil, fmt.Errorf("get alerts: %w", err)
+	}
+
+	var response struct {
+		Data       []APIAlert `json:"data"`
+		Pagination struct {
+			NextCursor string `json:"nextCursor"`
+		} `json:"pagination"`
+	}
+
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("parse alerts response: %w", err)
+	}
+
+	return &AlertBatchResult{
+		Alerts:     response.Data,
+		NextCursor: response.Pagination.NextCursor,
+		HasMore:    response.Pagination.NextCursor != "",
+	}, nil
+}
+
+func (c *Client) doRequest(method, endpoint string, params url.Values) ([]byte, error) {
+	requestURL := fmt.Sprintf("%s%s", c.baseURL, endpoint)
+	if params != nil {
+		requestURL = fmt.Sprintf("%s?%s", requestURL, params.Encode())
+	}
+
+	start := time.Now()
+
+	req, err := http.NewRequest(method, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("ApiToken %s", c.apiToken))
+	req.Header.Set("Content-Type", "application/json")
+
+	slog.Debug("s1 api request", "method", method, "endpoint", endpoint)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		slog.Error("s1 api request failed", "method", method, "endpoint", endpoint, "error", err, "durationMs", time.Since(start).Milliseconds())
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	slog.Info("s1 api response", "method", method, "endpoint", endpoint, "status", resp.StatusCode, "responseBytes", len(body), "durationMs", time.Since(start).Milliseconds())
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return nil, &httperr.APIError{Code: resp.StatusCode}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return body, nil
+}
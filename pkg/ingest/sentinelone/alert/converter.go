@@ -0,0 +1,84 @@
+package alert
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AlertData holds converted cloud-detection alert data ready for Ent insertion.
+type AlertData struct {
+	ResourceID        string
+	AgentID           string
+	AgentUUID         string
+	AgentName         string
+	AgentOSName       string
+	SiteID            string
+	RuleID            string
+	RuleName          string
+	RuleSeverity      string
+	RuleDescription   string
+	RuleScopeLevel    string
+	RuleTreatAsThreat string
+	AnalystVerdict    string
+	IncidentStatus    string
+	EventType         string
+	HitType           string
+	Source            string
+	DVEventID         string
+	IsEDR             bool
+	ReportedAt        *time.Time
+	APICreatedAt      *time.Time
+	APIUpdatedAt      *time.Time
+	SourceProcessJSON json.RawMessage
+	TargetProcessJSON json.RawMessage
+	CollectedAt       time.Time
+}
+
+// ConvertAlert converts an API alert to AlertData.
+func ConvertAlert(a APIAlert, collectedAt time.Time) *AlertData {
+	rt, det, info, rule := a.AgentRealtimeInfo, a.AgentDetectionInfo, a.AlertInfo, a.RuleInfo
+	return &AlertData{
+		ResourceID:        info.AlertID,
+		AgentID:           rt.ID,
+		AgentUUID:         firstNonEmpty(rt.UUID, det.UUID),
+		AgentName:         firstNonEmpty(rt.Name, det.Name),
+		AgentOSName:       firstNonEmpty(det.OSName, rt.OS),
+		SiteID:            det.SiteID,
+		RuleID:            rule.ID,
+		RuleName:          rule.Name,
+		RuleSeverity:      rule.Severity,
+		RuleDescription:   rule.Description,
+		RuleScopeLevel:    rule.ScopeLevel,
+		RuleTreatAsThreat: rule.TreatAsThreat,
+		AnalystVerdict:    info.AnalystVerdict,
+		IncidentStatus:    info.IncidentStatus,
+		EventType:         info.EventType,
+		HitType:           info.HitType,
+		Source:            info.Source,
+		DVEventID:         info.DVEventID,
+		IsEDR:             info.IsEDR,
+		ReportedAt:        info.ReportedAt,
+		APICreatedAt:      info.CreatedAt,
+		APIUpdatedAt:      info.UpdatedAt,
+		SourceProcessJSON: nonNullJSON(a.SourceProcessInfo),
+		TargetProcessJSON: nonNullJSON(a.TargetProcessInfo),
+		CollectedAt:       collectedAt,
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// nonNullJSON drops JSON null so optional columns stay NULL instead of 'null'.
+func nonNullJSON(raw json.RawMessage) json.RawMessage {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	return raw
+}
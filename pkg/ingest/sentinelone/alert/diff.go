@@ -0,0 +1,44 @@
+package alert
+
+import (
+	"bytes"
+
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+)
+
+// AlertDiff represents changes between old and new alert states.
+type AlertDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffAlertData compares old Ent entity and new data. API timestamps are
+// ignored so that a bare updatedAt bump does not create a history row.
+func DiffAlertData(old *ents1.BronzeS1Alert, new *AlertData) *AlertDiff {
+	if old == nil {
+		return &AlertDiff{IsNew: true}
+	}
+
+	changed := old.AgentID != new.AgentID ||
+		old.AgentUUID != new.AgentUUID ||
+		old.AgentName != new.AgentName ||
+		old.AgentOsName != new.AgentOSName ||
+		old.SiteID != new.SiteID ||
+		old.RuleID != new.RuleID ||
+		old.RuleName != new.RuleName ||
+		old.RuleSeverity != new.RuleSeverity ||
+		old.RuleDescription != new.RuleDescription ||
+		old.RuleScopeLevel != new.RuleScopeLevel ||
+		old.RuleTreatAsThreat != new.RuleTreatAsThreat ||
+		old.AnalystVerdict != new.AnalystVerdict ||
+		old.IncidentStatus != new.IncidentStatus ||
+		old.EventType != new.EventType ||
+		old.HitType != new.HitType ||
+		old.Source != new.Source ||
+		old.DvEventID != new.DVEventID ||
+		old.IsEdr != new.IsEDR ||
+		!bytes.Equal(old.SourceProcessJSON, new.SourceProcessJSON) ||
+		!bytes.Equal(old.TargetProcessJSON, new.TargetProcessJSON)
+
+	return &AlertDiff{IsChanged: changed}
+}
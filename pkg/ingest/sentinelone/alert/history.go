@@ -0,0 +1,104 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1alert"
+)
+
+// HistoryService handles history tracking for alerts.
+type HistoryService struct {
+	entClient *ents1.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *ents1.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *ents1.Tx, data *AlertData) *ents1.BronzeHistoryS1AlertCreate {
+	create := tx.BronzeHistoryS1Alert.Create().
+		SetResourceID(data.ResourceID).
+		SetAgentID(data.AgentID).
+		SetAgentUUID(data.AgentUUID).
+		SetAgentName(data.AgentName).
+		SetAgentOsName(data.AgentOSName).
+		SetSiteID(data.SiteID).
+		SetRuleID(data.RuleID).
+		SetRuleName(data.RuleName).
+		SetRuleSeverity(data.RuleSeverity).
+		SetRuleDescription(data.RuleDescription).
+		SetRuleScopeLevel(data.RuleScopeLevel).
+		SetRuleTreatAsThreat(data.RuleTreatAsThreat).
+		SetAnalystVerdict(data.AnalystVerdict).
+		SetIncidentStatus(data.IncidentStatus).
+		SetEventType(data.EventType).
+		SetHitType(data.HitType).
+		SetSource(data.Source).
+		SetDvEventID(data.DVEventID).
+		SetIsEdr(data.IsEDR)
+
+	if data.ReportedAt != nil {
+		create.SetReportedAt(*data.ReportedAt)
+	}
+	if data.APICreatedAt != nil {
+		create.SetAPICreatedAt(*data.APICreatedAt)
+	}
+	if data.APIUpdatedAt != nil {
+		create.SetAPIUpdatedAt(*data.APIUpdatedAt)
+	}
+	if data.SourceProcessJSON != nil {
+		create.SetSourceProcessJSON(data.SourceProcessJSON)
+	}
+	if data.TargetProcessJSON != nil {
+		create.SetTargetProcessJSON(data.TargetProcessJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new alert.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *ents1.Tx, data *AlertData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetCollectedAt(data.CollectedAt).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create alert history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new for a changed alert.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *ents1.Tx, old *ents1.BronzeS1Alert, new *AlertData, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryS1Alert.Query().
+		Where(
+			bronzehistorys1alert.ResourceID(old.ID),
+			bronzehistorys1alert.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		return fmt.Errorf("find current alert history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryS1Alert.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close alert history: %w", err)
+	}
+
+	_, err = h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetCollectedAt(new.CollectedAt).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create new alert history: %w", err)
+	}
+
+	return nil
+}
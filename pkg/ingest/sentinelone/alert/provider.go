@@ -0,0 +1,20 @@
+package alert
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider:  "sentinelone",
+		Name:      "alert",
+		Register:  Register,
+		Workflow:  S1AlertWorkflow,
+		NewResult: func() any { return &S1AlertWorkflowResult{} },
+		Aggregate: func(parent *sentinelone.S1InventoryWorkflowResult, child any) {
+			r := child.(*S1AlertWorkflowResult)
+			parent.AlertCount = r.AlertCount
+		},
+	})
+}
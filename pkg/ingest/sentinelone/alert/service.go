@@ -0,0 +1,255 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1alert"
+)
+
+// CursorName is the s1_ingest_cursors stream name for alerts.
+const CursorName = "alerts"
+
+// Service handles SentinelOne alert ingestion.
+type Service struct {
+	client    *Client
+	entClient *ents1.Client
+	history   *HistoryService
+}
+
+// NewService creates a new alert ingestion service.
+func NewService(client *Client, entClient *ents1.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestResult contains the result of alert ingestion.
+type IngestResult struct {
+	AlertCount     int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches alerts updated since the persisted cursor and upserts them
+// batch by batch. Each batch commits together with the advanced cursor, so an
+// interrupted run resumes where the last committed batch ended. Alerts are
+// never deleted as stale: an incremental fetch cannot tell a removed alert
+// from an unchanged one.
+func (s *Service) Ingest(ctx context.Context, heartbeat func()) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	since, err := sentinelone.LoadCursor(ctx, s.entClient, CursorName)
+	if err != nil {
+		return nil, err
+	}
+
+	total := 0
+	cursor := ""
+	batchNum := 0
+
+	for {
+		batchNum++
+		batch, err := s.client.GetAlertsBatch(since, cursor)
+		if err != nil {
+			slog.Error("s1 alerts batch failed", "batch", batchNum, "totalSoFar", total, "error", err)
+			return nil, fmt.Errorf("get alerts batch: %w", err)
+		}
+
+		alerts := make([]*AlertData, 0, len(batch.Alerts))
+		for _, apiAlert := range batch.Alerts {
+			alerts = append(alerts, ConvertAlert(apiAlert, collectedAt))
+		}
+
+		if err := s.saveAlerts(ctx, alerts); err != nil {
+			return nil, fmt.Errorf("save alerts: %w", err)
+		}
+		total += len(alerts)
+
+		slog.Info("s1 alerts batch saved", "batch", batchNum, "batchItems", len(alerts), "totalSaved", total, "since", since, "hasMore", batch.HasMore)
+
+		if heartbeat != nil {
+			heartbeat()
+		}
+
+		if !batch.HasMore {
+			break
+		}
+		cursor = batch.NextCursor
+	}
+
+	return &IngestResult{
+		AlertCount:     total,
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+func (s *Service) saveAlerts(ctx context.Context, alerts []*AlertData) error {
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	var watermark time.Time
+
+	for _, data := range alerts {
+		if data.APIUpdatedAt != nil && data.APIUpdatedAt.After(watermark) {
+			watermark = *data.APIUpdatedAt
+		}
+
+		existing, err := tx.BronzeS1Alert.Query().
+			Where(bronzes1alert.ID(data.ResourceID)).
+			First(ctx)
+		if err != nil && !ents1.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("load existing alert %s: %w", data.ResourceID, err)
+		}
+
+		diff := DiffAlertData(existing, data)
+
+		if !diff.IsNew && !diff.IsChanged {
+			update := tx.BronzeS1Alert.UpdateOneID(data.ResourceID).
+				SetCollectedAt(data.CollectedAt)
+			if data.APIUpdatedAt != nil {
+				update.SetAPIUpdatedAt(*data.APIUpdatedAt)
+			}
+			if err := update.Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update collected_at for alert %s: %w", data.ResourceID, err)
+			}
+			continue
+		}
+
+		if existing == nil {
+			create := tx.BronzeS1Alert.Create().
+				SetID(data.ResourceID).
+				SetAgentID(data.AgentID).
+				SetAgentUUID(data.AgentUUID).
+				SetAgentName(data.AgentName).
+				SetAgentOsName(data.AgentOSName).
+				SetSiteID(data.SiteID).
+				SetRuleID(data.RuleID).
+				SetRuleName(data.RuleName).
+				SetRuleSeverity(data.RuleSeverity).
+				SetRuleDescription(data.RuleDescription).
+				SetRuleScopeLevel(data.RuleScopeLevel).
+				SetRuleTreatAsThreat(data.RuleTreatAsThreat).
+				SetAnalystVerdict(data.AnalystVerdict).
+				SetIncidentStatus(data.IncidentStatus).
+				SetEventType(data.EventType).
+				SetHitType(data.HitType).
+				SetSource(data.Source).
+				SetDvEventID(data.DVEventID).
+				SetIsEdr(data.IsEDR).
+				SetCollectedAt(data.CollectedAt).
+				SetFirstCollectedAt(data.CollectedAt)
+
+			if data.ReportedAt != nil {
+				create.SetReportedAt(*data.ReportedAt)
+			}
+			if data.APICreatedAt != nil {
+				create.SetAPICreatedAt(*data.APICreatedAt)
+			}
+			if data.APIUpdatedAt != nil {
+				create.SetAPIUpdatedAt(*data.APIUpdatedAt)
+			}
+			if data.SourceProcessJSON != nil {
+				create.SetSourceProcessJSON(data.SourceProcessJSON)
+			}
+			if data.TargetProcessJSON != nil {
+				create.SetTargetProcessJSON(data.TargetProcessJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create alert %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.CreateHistory(ctx, tx, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create history for alert %s: %w", data.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeS1Alert.UpdateOneID(data.ResourceID).
+				SetAgentID(data.AgentID).
+				SetAgentUUID(data.AgentUUID).
+				SetAgentName(data.AgentName).
+				SetAgentOsName(data.AgentOSName).
+				SetSiteID(data.SiteID).
+				SetRuleID(data.RuleID).
+				SetRuleName(data.RuleName).
+				SetRuleSeverity(data.RuleSeverity).
+				SetRuleDescription(data.RuleDescription).
+				SetRuleScopeLevel(data.RuleScopeLevel).
+				SetRuleTreatAsThreat(data.RuleTreatAsThreat).
+				SetAnalystVerdict(data.AnalystVerdict).
+				SetIncidentStatus(data.IncidentStatus).
+				SetEventType(data.EventType).
+				SetHitType(data.HitType).
+				SetSource(data.Source).
+				SetDvEventID(data.DVEventID).
+				SetIsEdr(data.IsEDR).
+				SetCollectedAt(data.CollectedAt)
+
+			if data.ReportedAt != nil {
+				update.SetReportedAt(*data.ReportedAt)
+			}
+			if data.APICreatedAt != nil {
+				update.SetAPICreatedAt(*data.APICreatedAt)
+			}
+			if data.APIUpdatedAt != nil {
+				update.SetAPIUpdatedAt(*data.APIUpdatedAt)
+			}
+			if data.SourceProcessJSON != nil {
+				update.SetSourceProcessJSON(data.SourceProcessJSON)
+			}
+			if data.TargetProcessJSON != nil {
+				update.SetTargetProcessJSON(data.TargetProcessJSON)
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update alert %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.UpdateHistory(ctx, tx, existing, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update history for alert %s: %w", data.ResourceID, err)
+			}
+		}
+	}
+
+	if !watermark.IsZero() {
+		if err := sentinelone.SaveCursor(ctx, tx.Client(), CursorName, watermark); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package alert
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/base/temporalerr"
+)
+
+// S1AlertWorkflowResult contains the result of the alert workflow.
+type S1AlertWorkflowResult struct {
+	AlertCount     int
+	DurationMillis int64
+}
+
+// S1AlertWorkflow ingests SentinelOne alerts updated since the last run.
+func S1AlertWorkflow(ctx workflow.Context) (*S1AlertWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting S1AlertWorkflow")
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestS1AlertsResult
+	err := workflow.ExecuteActivity(activityCtx, IngestS1AlertsActivity).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest alerts", "error", err)
+		return nil, temporalerr.PropagateNonRetryable(err)
+	}
+
+	logger.Info("Completed S1AlertWorkflow", "alertCount", result.AlertCount)
+
+	return &S1AlertWorkflowResult{
+		AlertCount:     result.AlertCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package app_cve
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/base/temporalerr"
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *ents1.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *ents1.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+func (a *Activities) createClient() *Client {
+	httpClient := &http.Client{
+		Transport: ratelimit.NewRateLimitedTransport(a.limiter, nil),
+	}
+	return NewClient(
+		a.configService.S1BaseURL(),
+		a.configService.S1APIToken(),
+		a.configService.S1BatchSize(),
+		httpClient,
+	)
+}
+
+// IngestS1AppCVEsResult contains the result of the ingest activity.
+type IngestS1AppCVEsResult struct {
+	AppCVECount    int
+	DurationMillis int64
+}
+
+// IngestS1AppCVEsActivity is the activity function reference for workflow registration.
+var IngestS1AppCVEsActivity = (*Activities).IngestS1AppCVEs
+
+// IngestS1AppCVEs is a Temporal activity that ingests SentinelOne app CVEs.
+func (a *Activities) IngestS1AppCVEs(ctx context.Context) (*IngestS1AppCVEsResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting SentinelOne app CVE ingestion")
+
+	client := a.createClient()
+	service := NewService(client, a.entClient)
+
+	result, err := service.Ingest(ctx, func() {
+		activity.RecordHeartbeat(ctx, nil)
+	})
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("ingest app CVEs: %w", err))
+	}
+
+	logger.Info("Completed SentinelOne app CVE ingestion",
+		"appCVECount", result.AppCVECount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestS1AppCVEsResult{
+		AppCVECount:    result.AppCVECount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
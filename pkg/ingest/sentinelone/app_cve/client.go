@@ -0,0 +1,164 @@
+package app_cve
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"danny.vn/hotpot/pkg/base/httperr"
+)
+
+// risksEndpoint lists CVEs per endpoint and application.
+const risksEndpoint = "/web/api/v2.1/application-management/risks"
+
+// Client wraps the SentinelOne Application Risk API.
+type Client struct {
+	baseURL    string
+	apiToken   string
+	batchSize  int
+	httpClient *http.Client
+}
+
+// NewClient creates a new SentinelOne application risk client.
+func NewClient(baseURL, apiToken string, batchSize int, httpClient *http.Client) *Client {
+	return &Client{
+		baseURL:    baseURL,
+		apiToken:   apiToken,
+		batchSize:  batchSize,
+		httpClient: httpClient,
+	}
+}
+
+// APIAppCVE represents one CVE on one application on one endpoint from the
+// SentinelOne /application-management/risks API.
+type APIAppCVE struct {
+	EndpointID          string   `json:"endpointId"`
+	EndpointName        string   `json:"endpointName"`
+	EndpointType        string   `json:"endpointType"`
+	OSType              string   `json:"osType"`
+	ApplicationName     string   `json:"applicationName"`
+	ApplicationVendor   string   `json:"applicationVendor"`
+	ApplicationVersion  string   `json:"applicationVersion"`
+	CVEID               string   `json:"cveId"`
+	NVDBaseScore        *float64 `json:"nvdBaseScore"`
+	NVDCVSSVersion      string   `json:"nvdCvssVersion"`
+	Severity            string   `json:"severity"`
+	RiskScore           *float64 `json:"riskScore"`
+	ExploitCodeMaturity string   `json:"exploitCodeMaturity"`
+	ExploitedInTheWild  bool     `json:"exploitedInTheWild"`
+	RemediationLevel    string   `json:"remediationLevel"`
+	ReportConfidence    string   `json:"reportConfidence"`
+	Status              string   `json:"status"`
+	PublishedDate       *string  `json:"publishedDate"`
+	DetectionDate       *string  `json:"detectionDate"`
+}
+
+// AppCVEBatchResult contains a batch of application CVEs and pagination info.
+type AppCVEBatchResult struct {
+	AppCVEs    []APIAppCVE
+	NextCursor string
+	HasMore    bool
+	TotalItems int
+}
+
+// GetAppCVEsBatch retrieves a batch of application CVEs with cursor pagination.
+func (c *Client) GetAppCVEsBatch(cursor string) (*AppCVEBatchResult, error) {
+	params := url.Values{}
+	params.Set("limit", fmt.Sprintf("%d", c.batchSize))
+	if cursor != "" {
+		params.Set("cursor", cursor)
+	}
+
+	body, err := c.doRequest("GET", risksEndpoint, params)
+	if err != nil {
+		return nil, fmt.Errorf("get app CVEs: %w", err)
+	}
+
+	var response struct {
+		Data       []APIAppCVE `json:"data"`
+		Pagination struct {
+			NextCursor string `json:"nextCursor"`
+			TotalItems int    `json:"totalItems"`
+		} `json:"pagination"`
+	}
+
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("parse app CVEs response: %w", err)
+	}
+
+	return &AppCVEBatchResult{
+		AppCVEs:    response.Data,
+		NextCursor: response.Pagination.NextCursor,
+		HasMore:    response.Pagination.NextCursor != "",
+		TotalItems: response.Pagination.TotalItems,
+	}, nil
+}
+
+// GetCount returns the total number of application CVEs using countOnly mode.
+func (c *Client) GetCount() (int, error) {
+	params := url.Values{}
+	params.Set("countOnly", "true")
+
+	body, err := c.doRequest("GET", risksEndpoint, params)
+	if err != nil {
+		return 0, fmt.Errorf("get app CVEs count: %w", err)
+	}
+
+	var response struct {
+		Pagination struct {
+			TotalItems int `json:"totalItems"`
+		} `json:"pagination"`
+	}
+
+	if err := json.Unmarshal(body, &response); err != nil {
+		return 0, fmt.Errorf("parse app CVEs count response: %w", err)
+	}
+
+	return response.Pagination.TotalItems, nil
+}
+
+func (c *Client) doRequest(method, endpoint string, params url.Values) ([]byte, error) {
+	requestURL := fmt.Sprintf("%s%s", c.baseURL, endpoint)
+	if params != nil {
+		requestURL = fmt.Sprintf("%s?%s", requestURL, params.Encode())
+	}
+	start := time.Now()
+
+	req, err := http.NewRequest(method, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("ApiToken %s", c.apiToken))
+	req.Header.Set("Content-Type", "application/json")
+
+	slog.Debug("s1 api request", "method", method, "endpoint", endpoint)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		slog.Error("s1 api request failed", "method", method, "endpoint", endpoint, "error", err, "durationMs", time.Since(start).Milliseconds())
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	slog.Info("s1 api response", "method", method, "endpoint", endpoint, "status", resp.StatusCode, "responseBytes", len(body), "durationMs", time.Since(start).Milliseconds())
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return nil, &httperr.APIError{Code: resp.StatusCode}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return body, nil
+}
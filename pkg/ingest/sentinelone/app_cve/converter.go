@@ -0,0 +1,71 @@
+package app_cve
+
+import "time"
+
+// AppCVEData holds converted application CVE data ready for Ent insertion.
+type AppCVEData struct {
+	ResourceID          string
+	AgentID             string
+	EndpointName        string
+	EndpointType        string
+	OSType              string
+	ApplicationName     string
+	ApplicationVendor   string
+	ApplicationVersion  string
+	CVEID               string
+	CVSSScore           float64
+	CVSSVersion         string
+	NVDSeverity         string
+	RiskScore           float64
+	ExploitCodeMaturity string
+	ExploitedInTheWild  bool
+	RemediationLevel    string
+	ReportConfidence    string
+	Status              string
+	PublishedDate       *time.Time
+	DetectionDate       *time.Time
+	CollectedAt         time.Time
+}
+
+// ConvertAppCVE converts an API application CVE to AppCVEData.
+func ConvertAppCVE(c APIAppCVE, collectedAt time.Time) *AppCVEData {
+	data := &AppCVEData{
+		ResourceID:          c.EndpointID + "||" + c.CVEID + "||" + c.ApplicationName + "||" + c.ApplicationVersion,
+		AgentID:             c.EndpointID,
+		EndpointName:        c.EndpointName,
+		EndpointType:        c.EndpointType,
+		OSType:              c.OSType,
+		ApplicationName:     c.ApplicationName,
+		ApplicationVendor:   c.ApplicationVendor,
+		ApplicationVersion:  c.ApplicationVersion,
+		CVEID:               c.CVEID,
+		CVSSVersion:         c.NVDCVSSVersion,
+		NVDSeverity:         c.Severity,
+		ExploitCodeMaturity: c.ExploitCodeMaturity,
+		ExploitedInTheWild:  c.ExploitedInTheWild,
+		RemediationLevel:    c.RemediationLevel,
+		ReportConfidence:    c.ReportConfidence,
+		Status:              c.Status,
+		PublishedDate:       parseTime(c.PublishedDate),
+		DetectionDate:       parseTime(c.DetectionDate),
+		CollectedAt:         collectedAt,
+	}
+	if c.NVDBaseScore != nil {
+		data.CVSSScore = *c.NVDBaseScore
+	}
+	if c.RiskScore != nil {
+		data.RiskScore = *c.RiskScore
+	}
+
+	return data
+}
+
+func parseTime(s *string) *time.Time {
+	if s == nil {
+		return nil
+	}
+	if t, err := time.Parse(time.RFC3339, *s); err == nil {
+		return &t
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package app_cve
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestConvertAppCVE(t *testing.T) {
+	raw := `{
+		"endpointId": "agent-1",
+		"endpointName": "web-01",
+		"osType": "linux",
+		"applicationName": "xz-utils",
+		"applicationVendor": "Tukaani",
+		"applicationVersion": "5.6.0",
+		"cveId": "CVE-2024-3094",
+		"nvdBaseScore": 10,
+		"nvdCvssVersion": "3.1",
+		"severity": "CRITICAL",
+		"exploitCodeMaturity": "FUNCTIONAL",
+		"exploitedInTheWild": true,
+		"publishedDate": "2024-03-29T17:15:21Z",
+		"detectionDate": "not-a-date"
+	}`
+	var api APIAppCVE
+	if err := json.Unmarshal([]byte(raw), &api); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	data := ConvertAppCVE(api, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	if want := "agent-1||CVE-2024-3094||xz-utils||5.6.0"; data.ResourceID != want {
+		t.Errorf("ResourceID = %q, want %q", data.ResourceID, want)
+	}
+	if data.AgentID != "agent-1" || data.CVEID != "CVE-2024-3094" {
+		t.Errorf("AgentID/CVEID = %q/%q", data.AgentID, data.CVEID)
+	}
+	if data.CVSSScore != 10 || data.NVDSeverity != "CRITICAL" || !data.ExploitedInTheWild {
+		t.Errorf("score/severity/exploited = %v/%q/%v", data.CVSSScore, data.NVDSeverity, data.ExploitedInTheWild)
+	}
+	if data.RiskScore != 0 {
+		t.Errorf("RiskScore = %v, want 0 when absent", data.RiskScore)
+	}
+	if data.PublishedDate == nil || !data.PublishedDate.Equal(time.Date(2024, 3, 29, 17, 15, 21, 0, time.UTC)) {
+		t.Errorf("PublishedDate = %v", data.PublishedDate)
+	}
+	if data.DetectionDate != nil {
+		t.Errorf("DetectionDate = %v, want nil for unparsable date", data.DetectionDate)
+	}
+}
@@ -0,0 +1,36 @@
+package app_cve
+
+import ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+
+// AppCVEDiff represents changes between old and new app CVE states.
+type AppCVEDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffAppCVEData compares old Ent entity and new data.
+func DiffAppCVEData(old *ents1.BronzeS1AppCVE, new *AppCVEData) *AppCVEDiff {
+	if old == nil {
+		return &AppCVEDiff{IsNew: true}
+	}
+
+	changed := old.AgentID != new.AgentID ||
+		old.EndpointName != new.EndpointName ||
+		old.EndpointType != new.EndpointType ||
+		old.OsType != new.OSType ||
+		old.ApplicationName != new.ApplicationName ||
+		old.ApplicationVendor != new.ApplicationVendor ||
+		old.ApplicationVersion != new.ApplicationVersion ||
+		old.CveID != new.CVEID ||
+		old.CvssScore != new.CVSSScore ||
+		old.CvssVersion != new.CVSSVersion ||
+		old.NvdSeverity != new.NVDSeverity ||
+		old.RiskScore != new.RiskScore ||
+		old.ExploitCodeMaturity != new.ExploitCodeMaturity ||
+		old.ExploitedInTheWild != new.ExploitedInTheWild ||
+		old.RemediationLevel != new.RemediationLevel ||
+		old.ReportConfidence != new.ReportConfidence ||
+		old.Status != new.Status
+
+	return &AppCVEDiff{IsChanged: changed}
+}
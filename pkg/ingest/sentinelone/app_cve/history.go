@@ -0,0 +1,118 @@
+package app_cve
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1appcve"
+)
+
+// HistoryService handles history tracking for app CVEs.
+type HistoryService struct {
+	entClient *ents1.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *ents1.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *ents1.Tx, data *AppCVEData) *ents1.BronzeHistoryS1AppCVECreate {
+	create := tx.BronzeHistoryS1AppCVE.Create().
+		SetResourceID(data.ResourceID).
+		SetAgentID(data.AgentID).
+		SetEndpointName(data.EndpointName).
+		SetEndpointType(data.EndpointType).
+		SetOsType(data.OSType).
+		SetApplicationName(data.ApplicationName).
+		SetApplicationVendor(data.ApplicationVendor).
+		SetApplicationVersion(data.ApplicationVersion).
+		SetCveID(data.CVEID).
+		SetCvssScore(data.CVSSScore).
+		SetCvssVersion(data.CVSSVersion).
+		SetNvdSeverity(data.NVDSeverity).
+		SetRiskScore(data.RiskScore).
+		SetExploitCodeMaturity(data.ExploitCodeMaturity).
+		SetExploitedInTheWild(data.ExploitedInTheWild).
+		SetRemediationLevel(data.RemediationLevel).
+		SetReportConfidence(data.ReportConfidence).
+		SetStatus(data.Status)
+
+	if data.PublishedDate != nil {
+		create.SetPublishedDate(*data.PublishedDate)
+	}
+	if data.DetectionDate != nil {
+		create.SetDetectionDate(*data.DetectionDate)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new app CVE.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *ents1.Tx, data *AppCVEData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetCollectedAt(data.CollectedAt).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create app CVE history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new for a changed app CVE.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *ents1.Tx, old *ents1.BronzeS1AppCVE, new *AppCVEData, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryS1AppCVE.Query().
+		Where(
+			bronzehistorys1appcve.ResourceID(old.ID),
+			bronzehistorys1appcve.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		return fmt.Errorf("find current app CVE history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryS1AppCVE.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close app CVE history: %w", err)
+	}
+
+	_, err = h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetCollectedAt(new.CollectedAt).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create new app CVE history: %w", err)
+	}
+
+	return nil
+}
+
+// CloseHistory closes history records for a deleted app CVE.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *ents1.Tx, resourceID string, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryS1AppCVE.Query().
+		Where(
+			bronzehistorys1appcve.ResourceID(resourceID),
+			bronzehistorys1appcve.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		if ents1.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("find current app CVE history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryS1AppCVE.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close app CVE history: %w", err)
+	}
+
+	return nil
+}
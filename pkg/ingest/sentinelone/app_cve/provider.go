@@ -0,0 +1,20 @@
+package app_cve
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider:  "sentinelone",
+		Name:      "app_cve",
+		Register:  Register,
+		Workflow:  S1AppCVEWorkflow,
+		NewResult: func() any { return &S1AppCVEWorkflowResult{} },
+		Aggregate: func(parent *sentinelone.S1InventoryWorkflowResult, child any) {
+			r := child.(*S1AppCVEWorkflowResult)
+			parent.AppCVECount = r.AppCVECount
+		},
+	})
+}
@@ -0,0 +1,245 @@
+package app_cve
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1appcve"
+)
+
+// Service handles SentinelOne app CVE ingestion.
+type Service struct {
+	client    *Client
+	entClient *ents1.Client
+	history   *HistoryService
+}
+
+// NewService creates a new app CVE ingestion service.
+func NewService(client *Client, entClient *ents1.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestResult contains the result of app CVE ingestion.
+type IngestResult struct {
+	AppCVECount    int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches all app CVEs from SentinelOne using cursor pagination.
+func (s *Service) Ingest(ctx context.Context, heartbeat func()) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	totalExpected, err := s.client.GetCount()
+	if err != nil {
+		slog.Warn("s1 app CVEs: failed to get count, continuing without total", "error", err)
+	}
+
+	var allAppCVEs []*AppCVEData
+	cursor := ""
+	batchNum := 0
+
+	for {
+		batchNum++
+		batch, err := s.client.GetAppCVEsBatch(cursor)
+		if err != nil {
+			slog.Error("s1 app CVEs batch failed", "batch", batchNum, "totalSoFar", len(allAppCVEs), "error", err)
+			return nil, fmt.Errorf("get app CVEs batch: %w", err)
+		}
+
+		for _, apiAppCVE := range batch.AppCVEs {
+			allAppCVEs = append(allAppCVEs, ConvertAppCVE(apiAppCVE, collectedAt))
+		}
+
+		slog.Info("s1 app CVEs batch fetched", "batch", batchNum, "batchItems", len(batch.AppCVEs), "totalFetched", len(allAppCVEs), "totalExpected", totalExpected, "hasMore", batch.HasMore)
+
+		if heartbeat != nil {
+			heartbeat()
+		}
+
+		if !batch.HasMore {
+			break
+		}
+		cursor = batch.NextCursor
+	}
+
+	if err := s.saveAppCVEs(ctx, allAppCVEs); err != nil {
+		return nil, fmt.Errorf("save app CVEs: %w", err)
+	}
+
+	return &IngestResult{
+		AppCVECount:    len(allAppCVEs),
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+func (s *Service) saveAppCVEs(ctx context.Context, appCVEs []*AppCVEData) error {
+	if len(appCVEs) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	activeIDs := make(map[string]struct{}, len(appCVEs))
+
+	for _, data := range appCVEs {
+		existing, err := tx.BronzeS1AppCVE.Query().
+			Where(bronzes1appcve.ID(data.ResourceID)).
+			First(ctx)
+		if err != nil && !ents1.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("load existing app CVE %s: %w", data.ResourceID, err)
+		}
+
+		diff := DiffAppCVEData(existing, data)
+
+		if !diff.IsNew && !diff.IsChanged {
+			if err := tx.BronzeS1AppCVE.UpdateOneID(data.ResourceID).
+				SetCollectedAt(data.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update collected_at for app CVE %s: %w", data.ResourceID, err)
+			}
+			activeIDs[data.ResourceID] = struct{}{}
+			continue
+		}
+
+		if existing == nil {
+			create := tx.BronzeS1AppCVE.Create().
+				SetID(data.ResourceID).
+				SetAgentID(data.AgentID).
+				SetEndpointName(data.EndpointName).
+				SetEndpointType(data.EndpointType).
+				SetOsType(data.OSType).
+				SetApplicationName(data.ApplicationName).
+				SetApplicationVendor(data.ApplicationVendor).
+				SetApplicationVersion(data.ApplicationVersion).
+				SetCveID(data.CVEID).
+				SetCvssScore(data.CVSSScore).
+				SetCvssVersion(data.CVSSVersion).
+				SetNvdSeverity(data.NVDSeverity).
+				SetRiskScore(data.RiskScore).
+				SetExploitCodeMaturity(data.ExploitCodeMaturity).
+				SetExploitedInTheWild(data.ExploitedInTheWild).
+				SetRemediationLevel(data.RemediationLevel).
+				SetReportConfidence(data.ReportConfidence).
+				SetStatus(data.Status).
+				SetCollectedAt(data.CollectedAt).
+				SetFirstCollectedAt(data.CollectedAt)
+
+			if data.PublishedDate != nil {
+				create.SetPublishedDate(*data.PublishedDate)
+			}
+			if data.DetectionDate != nil {
+				create.SetDetectionDate(*data.DetectionDate)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create app CVE %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.CreateHistory(ctx, tx, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create history for app CVE %s: %w", data.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeS1AppCVE.UpdateOneID(data.ResourceID).
+				SetAgentID(data.AgentID).
+				SetEndpointName(data.EndpointName).
+				SetEndpointType(data.EndpointType).
+				SetOsType(data.OSType).
+				SetApplicationName(data.ApplicationName).
+				SetApplicationVendor(data.ApplicationVendor).
+				SetApplicationVersion(data.ApplicationVersion).
+				SetCveID(data.CVEID).
+				SetCvssScore(data.CVSSScore).
+				SetCvssVersion(data.CVSSVersion).
+				SetNvdSeverity(data.NVDSeverity).
+				SetRiskScore(data.RiskScore).
+				SetExploitCodeMaturity(data.ExploitCodeMaturity).
+				SetExploitedInTheWild(data.ExploitedInTheWild).
+				SetRemediationLevel(data.RemediationLevel).
+				SetReportConfidence(data.ReportConfidence).
+				SetStatus(data.Status).
+				SetCollectedAt(data.CollectedAt)
+
+			if data.PublishedDate != nil {
+				update.SetPublishedDate(*data.PublishedDate)
+			}
+			if data.DetectionDate != nil {
+				update.SetDetectionDate(*data.DetectionDate)
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update app CVE %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.UpdateHistory(ctx, tx, existing, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update history for app CVE %s: %w", data.ResourceID, err)
+			}
+		}
+
+		activeIDs[data.ResourceID] = struct{}{}
+	}
+
+	// Delete stale app CVEs not returned by the API.
+	allDBIDs, err := tx.BronzeS1AppCVE.Query().
+		Select(bronzes1appcve.FieldID).
+		Strings(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("query all app CVE IDs: %w", err)
+	}
+
+	staleCount := 0
+	for _, id := range allDBIDs {
+		if _, ok := activeIDs[id]; ok {
+			continue
+		}
+
+		if err := s.history.CloseHistory(ctx, tx, id, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("close history for stale app CVE %s: %w", id, err)
+		}
+
+		if err := tx.BronzeS1AppCVE.DeleteOneID(id).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("delete stale app CVE %s: %w", id, err)
+		}
+		staleCount++
+	}
+
+	if staleCount > 0 {
+		slog.Info("s1 app CVEs: deleted stale", "count", staleCount)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package app_cve
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/base/temporalerr"
+)
+
+// S1AppCVEWorkflowResult contains the result of the app CVE workflow.
+type S1AppCVEWorkflowResult struct {
+	AppCVECount    int
+	DurationMillis int64
+}
+
+// S1AppCVEWorkflow ingests SentinelOne application CVEs per endpoint.
+func S1AppCVEWorkflow(ctx workflow.Context) (*S1AppCVEWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting S1AppCVEWorkflow")
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestS1AppCVEsResult
+	err := workflow.ExecuteActivity(activityCtx, IngestS1AppCVEsActivity).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest app CVEs", "error", err)
+		return nil, temporalerr.PropagateNonRetryable(err)
+	}
+
+	logger.Info("Completed S1AppCVEWorkflow", "appCVECount", result.AppCVECount)
+
+	return &S1AppCVEWorkflowResult{
+		AppCVECount:    result.AppCVECount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
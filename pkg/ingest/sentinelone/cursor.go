@@ -0,0 +1,52 @@
+package sentinelone
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1ingestcursor"
+)
+
+// LoadCursor returns the watermark persisted for an incremental
+// stream, or the zero time when the stream has never been ingested.
+func LoadCursor(ctx context.Context, entClient *ents1.Client, name string) (time.Time, error) {
+	cursor, err := entClient.BronzeS1IngestCursor.Query().
+		Where(bronzes1ingestcursor.NameEQ(name)).
+		Only(ctx)
+	if err != nil {
+		if ents1.IsNotFound(err) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, fmt.Errorf("read cursor %s: %w", name, err)
+	}
+	return cursor.LastUpdatedAt, nil
+}
+
+// SaveCursor advances the watermark of an incremental stream. Pass a
+// transactional client (tx.Client()) to move the cursor together with the
+// rows it covers.
+func SaveCursor(ctx context.Context, entClient *ents1.Client, name string, lastUpdatedAt time.Time) error {
+	now := time.Now()
+	n, err := entClient.BronzeS1IngestCursor.Update().
+		Where(bronzes1ingestcursor.NameEQ(name)).
+		SetLastUpdatedAt(lastUpdatedAt).
+		SetCollectedAt(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update cursor %s: %w", name, err)
+	}
+	if n > 0 {
+		return nil
+	}
+	if err := entClient.BronzeS1IngestCursor.Create().
+		SetName(name).
+		SetLastUpdatedAt(lastUpdatedAt).
+		SetCollectedAt(now).
+		SetFirstCollectedAt(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("create cursor %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package exclusion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/base/temporalerr"
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *ents1.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *ents1.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+func (a *Activities) createClient() *Client {
+	httpClient := &http.Client{
+		Transport: ratelimit.NewRateLimitedTransport(a.limiter, nil),
+	}
+	return NewClient(
+		a.configService.S1BaseURL(),
+		a.configService.S1APIToken(),
+		a.configService.S1BatchSize(),
+		httpClient,
+	)
+}
+
+// IngestS1ExclusionsResult contains the result of the ingest activity.
+type IngestS1ExclusionsResult struct {
+	ExclusionCount int
+	DurationMillis int64
+}
+
+// IngestS1ExclusionsActivity is the activity function reference for workflow registration.
+var IngestS1ExclusionsActivity = (*Activities).IngestS1Exclusions
+
+// IngestS1Exclusions is a Temporal activity that ingests SentinelOne exclusions.
+func (a *Activities) IngestS1Exclusions(ctx context.Context) (*IngestS1ExclusionsResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting SentinelOne exclusion ingestion")
+
+	client := a.createClient()
+	service := NewService(client, a.entClient)
+
+	result, err := service.Ingest(ctx, func() {
+		activity.RecordHeartbeat(ctx, nil)
+	})
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("ingest exclusions: %w", err))
+	}
+
+	logger.Info("Completed SentinelOne exclusion ingestion",
+		"exclusionCount", result.ExclusionCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestS1ExclusionsResult{
+		ExclusionCount: result.ExclusionCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
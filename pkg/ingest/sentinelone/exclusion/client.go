@@ -0,0 +1,138 @@
+package exclusion
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"danny.vn/hotpot/pkg/base/httperr"
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+)
+
+// Client wraps the SentinelOne Exclusions API.
+type Client struct {
+	baseURL    string
+	apiToken   string
+	batchSize  int
+	httpClient *http.Client
+}
+
+// NewClient creates a new SentinelOne exclusions client.
+func NewClient(baseURL, apiToken string, batchSize int, httpClient *http.Client) *Client {
+	return &Client{
+		baseURL:    baseURL,
+		apiToken:   apiToken,
+		batchSize:  batchSize,
+		httpClient: httpClient,
+	}
+}
+
+// APIExclusion represents an exclusion from the SentinelOne API response.
+type APIExclusion struct {
+	ID                string          `json:"id"`
+	Type              string          `json:"type"`
+	Value             string          `json:"value"`
+	OSType            string          `json:"osType"`
+	Mode              string          `json:"mode"`
+	PathExclusionType string          `json:"pathExclusionType"`
+	Description       string          `json:"description"`
+	Source            string          `json:"source"`
+	UserID            string          `json:"userId"`
+	UserName          string          `json:"userName"`
+	ApplicationName   string          `json:"applicationName"`
+	ScopeName         string          `json:"scopeName"`
+	ScopePath         string          `json:"scopePath"`
+	IncludeChildren   bool            `json:"includeChildren"`
+	IncludeSubfolders bool            `json:"includeSubfolders"`
+	Imported          bool            `json:"imported"`
+	Actions           json.RawMessage `json:"actions"`
+	CreatedAt         *time.Time      `json:"createdAt"`
+	UpdatedAt         *time.Time      `json:"updatedAt"`
+}
+
+// ExclusionBatchResult contains a batch of exclusions and pagination info.
+type ExclusionBatchResult struct {
+	Exclusions []APIExclusion
+	NextCursor string
+	HasMore    bool
+}
+
+// GetExclusionsBatch retrieves a batch of the exclusions defined at scope
+// with cursor pagination.
+func (c *Client) GetExclusionsBatch(scope sentinelone.Scope, cursor string) (*ExclusionBatchResult, error) {
+	params := url.Values{}
+	params.Set("limit", fmt.Sprintf("%d", c.batchSize))
+	scope.SetFilter(params)
+	if cursor != "" {
+		params.Set("cursor", cursor)
+	}
+
+	body, err := c.doRequest("GET", "/web/api/v2.1/exclusions", params)
+	if err != nil {
+		return nil, fmt.Errorf("get exclusions: %w", err)
+	}
+
+	var response struct {
+		Data       []APIExclusion `json:"data"`
+		Pagination struct {
+			NextCursor string `json:"nextCursor"`
+		} `json:"pagination"`
+	}
+
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("parse exclusions response: %w", err)
+	}
+
+	return &ExclusionBatchResult{
+		Exclusions: response.Data,
+		NextCursor: response.Pagination.NextCursor,
+		HasMore:    response.Pagination.NextCursor != "",
+	}, nil
+}
+
+func (c *Client) doRequest(method, endpoint string, params url.Values) ([]byte, error) {
+	requestURL := fmt.Sprintf("%s%s", c.baseURL, endpoint)
+	if params != nil {
+		requestURL = fmt.Sprintf("%s?%s", requestURL, params.Encode())
+	}
+
+	start := time.Now()
+
+	req, err := http.NewRequest(method, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("ApiToken %s", c.apiToken))
+	req.Header.Set("Content-Type", "application/json")
+
+	slog.Debug("s1 api request", "method", method, "endpoint", endpoint)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		slog.Error("s1 api request failed", "method", method, "endpoint", endpoint, "error", err, "durationMs", time.Since(start).Milliseconds())
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	slog.Info("s1 api response", "method", method, "endpoint", endpoint, "status", resp.StatusCode, "responseBytes", len(body), "durationMs", time.Since(start).Milliseconds())
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return nil, &httperr.APIError{Code: resp.StatusCode}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return body, nil
+}
@@ -0,0 +1,73 @@
+package exclusion
+
+import (
+	"encoding/json"
+	"time"
+
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+)
+
+// ExclusionData holds converted exclusion data ready for Ent insertion.
+type ExclusionData struct {
+	ResourceID        string
+	ScopeLevel        string
+	AccountID         string
+	SiteID            string
+	GroupID           string
+	ScopeName         string
+	ScopePath         string
+	ExclusionType     string
+	ExclusionValue    string
+	OSType            string
+	Mode              string
+	PathExclusionType string
+	Description       string
+	Source            string
+	UserID            string
+	UserName          string
+	ApplicationName   string
+	IncludeChildren   bool
+	IncludeSubfolders bool
+	Imported          bool
+	ActionsJSON       json.RawMessage
+	APICreatedAt      *time.Time
+	APIUpdatedAt      *time.Time
+	CollectedAt       time.Time
+}
+
+// ConvertExclusion converts an API exclusion fetched for scope to ExclusionData.
+func ConvertExclusion(e APIExclusion, scope sentinelone.Scope, collectedAt time.Time) *ExclusionData {
+	data := &ExclusionData{
+		ResourceID:        e.ID,
+		ScopeLevel:        scope.Level,
+		AccountID:         scope.AccountID,
+		SiteID:            scope.SiteID,
+		GroupID:           scope.GroupID,
+		ScopeName:         e.ScopeName,
+		ScopePath:         e.ScopePath,
+		ExclusionType:     e.Type,
+		ExclusionValue:    e.Value,
+		OSType:            e.OSType,
+		Mode:              e.Mode,
+		PathExclusionType: e.PathExclusionType,
+		Description:       e.Description,
+		Source:            e.Source,
+		UserID:            e.UserID,
+		UserName:          e.UserName,
+		ApplicationName:   e.ApplicationName,
+		IncludeChildren:   e.IncludeChildren,
+		IncludeSubfolders: e.IncludeSubfolders,
+		Imported:          e.Imported,
+		APICreatedAt:      e.CreatedAt,
+		APIUpdatedAt:      e.UpdatedAt,
+		CollectedAt:       collectedAt,
+	}
+	if data.ScopeName == "" {
+		data.ScopeName = scope.Name
+	}
+	if len(e.Actions) > 0 && string(e.Actions) != "null" {
+		data.ActionsJSON = e.Actions
+	}
+
+	return data
+}
@@ -0,0 +1,50 @@
+package exclusion
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+)
+
+func TestConvertExclusion(t *testing.T) {
+	raw := `{
+		"id": "1900000000000000001",
+		"type": "path",
+		"value": "/opt/app/bin/",
+		"osType": "linux",
+		"mode": "disable_all_monitors",
+		"pathExclusionType": "subfolders",
+		"userName": "alice",
+		"scopePath": "Global / Acme / Prod / Web",
+		"includeSubfolders": true,
+		"actions": null,
+		"updatedAt": "2024-05-02T08:30:00Z"
+	}`
+	var api APIExclusion
+	if err := json.Unmarshal([]byte(raw), &api); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	scope := sentinelone.Scope{Level: sentinelone.ScopeGroup, ID: "g1", Name: "Web", AccountID: "a1", SiteID: "s1", GroupID: "g1"}
+	collected := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	data := ConvertExclusion(api, scope, collected)
+
+	if data.ResourceID != "1900000000000000001" || data.ExclusionType != "path" || data.ExclusionValue != "/opt/app/bin/" {
+		t.Errorf("data = %+v", data)
+	}
+	if data.ScopeLevel != "group" || data.AccountID != "a1" || data.SiteID != "s1" || data.GroupID != "g1" {
+		t.Errorf("scope = %s/%s/%s/%s, want group a1/s1/g1", data.ScopeLevel, data.AccountID, data.SiteID, data.GroupID)
+	}
+	// scopeName is missing from the response, so the scope's own name fills it.
+	if data.ScopeName != "Web" {
+		t.Errorf("ScopeName = %q, want Web", data.ScopeName)
+	}
+	if data.ActionsJSON != nil {
+		t.Errorf("ActionsJSON = %s, want nil for null actions", data.ActionsJSON)
+	}
+	if !data.IncludeSubfolders || data.APIUpdatedAt == nil {
+		t.Errorf("IncludeSubfolders/APIUpdatedAt not converted: %+v", data)
+	}
+}
@@ -0,0 +1,44 @@
+package exclusion
+
+import (
+	"bytes"
+
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+)
+
+// ExclusionDiff represents changes between old and new exclusion states.
+type ExclusionDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffExclusionData compares old Ent entity and new data. API timestamps are
+// ignored so that a bare updatedAt bump does not create a history row.
+func DiffExclusionData(old *ents1.BronzeS1Exclusion, new *ExclusionData) *ExclusionDiff {
+	if old == nil {
+		return &ExclusionDiff{IsNew: true}
+	}
+
+	changed := old.ScopeLevel != new.ScopeLevel ||
+		old.AccountID != new.AccountID ||
+		old.SiteID != new.SiteID ||
+		old.GroupID != new.GroupID ||
+		old.ScopeName != new.ScopeName ||
+		old.ScopePath != new.ScopePath ||
+		old.ExclusionType != new.ExclusionType ||
+		old.ExclusionValue != new.ExclusionValue ||
+		old.OsType != new.OSType ||
+		old.Mode != new.Mode ||
+		old.PathExclusionType != new.PathExclusionType ||
+		old.Description != new.Description ||
+		old.Source != new.Source ||
+		old.UserID != new.UserID ||
+		old.UserName != new.UserName ||
+		old.ApplicationName != new.ApplicationName ||
+		old.IncludeChildren != new.IncludeChildren ||
+		old.IncludeSubfolders != new.IncludeSubfolders ||
+		old.Imported != new.Imported ||
+		!bytes.Equal(old.ActionsJSON, new.ActionsJSON)
+
+	return &ExclusionDiff{IsChanged: changed}
+}
@@ -0,0 +1,123 @@
+package exclusion
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1exclusion"
+)
+
+// HistoryService handles history tracking for exclusions.
+type HistoryService struct {
+	entClient *ents1.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *ents1.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *ents1.Tx, data *ExclusionData) *ents1.BronzeHistoryS1ExclusionCreate {
+	create := tx.BronzeHistoryS1Exclusion.Create().
+		SetResourceID(data.ResourceID).
+		SetScopeLevel(data.ScopeLevel).
+		SetAccountID(data.AccountID).
+		SetSiteID(data.SiteID).
+		SetGroupID(data.GroupID).
+		SetScopeName(data.ScopeName).
+		SetScopePath(data.ScopePath).
+		SetExclusionType(data.ExclusionType).
+		SetExclusionValue(data.ExclusionValue).
+		SetOsType(data.OSType).
+		SetMode(data.Mode).
+		SetPathExclusionType(data.PathExclusionType).
+		SetDescription(data.Description).
+		SetSource(data.Source).
+		SetUserID(data.UserID).
+		SetUserName(data.UserName).
+		SetApplicationName(data.ApplicationName).
+		SetIncludeChildren(data.IncludeChildren).
+		SetIncludeSubfolders(data.IncludeSubfolders).
+		SetImported(data.Imported)
+
+	if data.ActionsJSON != nil {
+		create.SetActionsJSON(data.ActionsJSON)
+	}
+	if data.APICreatedAt != nil {
+		create.SetAPICreatedAt(*data.APICreatedAt)
+	}
+	if data.APIUpdatedAt != nil {
+		create.SetAPIUpdatedAt(*data.APIUpdatedAt)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new exclusion.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *ents1.Tx, data *ExclusionData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetCollectedAt(data.CollectedAt).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create exclusion history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new for a changed exclusion.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *ents1.Tx, old *ents1.BronzeS1Exclusion, new *ExclusionData, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryS1Exclusion.Query().
+		Where(
+			bronzehistorys1exclusion.ResourceID(old.ID),
+			bronzehistorys1exclusion.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		return fmt.Errorf("find current exclusion history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryS1Exclusion.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close exclusion history: %w", err)
+	}
+
+	_, err = h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetCollectedAt(new.CollectedAt).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create new exclusion history: %w", err)
+	}
+
+	return nil
+}
+
+// CloseHistory closes history records for a deleted exclusion.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *ents1.Tx, resourceID string, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryS1Exclusion.Query().
+		Where(
+			bronzehistorys1exclusion.ResourceID(resourceID),
+			bronzehistorys1exclusion.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		if ents1.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("find current exclusion history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryS1Exclusion.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close exclusion history: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package exclusion
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider:  "sentinelone",
+		Name:      "exclusion",
+		Register:  Register,
+		Workflow:  S1ExclusionWorkflow,
+		NewResult: func() any { return &S1ExclusionWorkflowResult{} },
+		Aggregate: func(parent *sentinelone.S1InventoryWorkflowResult, child any) {
+			r := child.(*S1ExclusionWorkflowResult)
+			parent.ExclusionCount = r.ExclusionCount
+		},
+	})
+}
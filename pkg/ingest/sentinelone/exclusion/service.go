@@ -0,0 +1,267 @@
+package exclusion
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1exclusion"
+)
+
+// Service handles SentinelOne exclusion ingestion.
+type Service struct {
+	client    *Client
+	entClient *ents1.Client
+	history   *HistoryService
+}
+
+// NewService creates a new exclusion ingestion service.
+func NewService(client *Client, entClient *ents1.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestResult contains the result of exclusion ingestion.
+type IngestResult struct {
+	ExclusionCount int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches the exclusions defined at every scope (tenant, accounts,
+// sites and groups). Each exclusion is attributed to the scope it is defined
+// at; exclusions no longer returned are deleted and their history closed.
+func (s *Service) Ingest(ctx context.Context, heartbeat func()) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	scopes, err := sentinelone.LoadScopes(ctx, s.entClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var allExclusions []*ExclusionData
+	// tenantSkipped is set when the tenant scope is not readable; its stored
+	// rows are then left as they are rather than treated as removed.
+	tenantSkipped := false
+
+	for _, scope := range scopes {
+		cursor := ""
+		for {
+			batch, err := s.client.GetExclusionsBatch(scope, cursor)
+			if err != nil {
+				if scope.Level == sentinelone.ScopeTenant && sentinelone.IsAccessDenied(err) {
+					slog.Warn("s1 exclusions: no access to tenant scope, skipping", "error", err)
+					tenantSkipped = true
+					break
+				}
+				slog.Error("s1 exclusions batch failed", "scope", scope.Key(), "totalSoFar", len(allExclusions), "error", err)
+				return nil, fmt.Errorf("get exclusions for %s: %w", scope.Key(), err)
+			}
+
+			for _, apiExclusion := range batch.Exclusions {
+				allExclusions = append(allExclusions, ConvertExclusion(apiExclusion, scope, collectedAt))
+			}
+
+			if heartbeat != nil {
+				heartbeat()
+			}
+
+			if !batch.HasMore {
+				break
+			}
+			cursor = batch.NextCursor
+		}
+	}
+
+	slog.Info("s1 exclusions fetched", "scopes", len(scopes), "totalExclusions", len(allExclusions))
+
+	if err := s.saveExclusions(ctx, allExclusions, tenantSkipped); err != nil {
+		return nil, fmt.Errorf("save exclusions: %w", err)
+	}
+
+	return &IngestResult{
+		ExclusionCount: len(allExclusions),
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+func (s *Service) saveExclusions(ctx context.Context, exclusions []*ExclusionData, tenantSkipped bool) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	activeIDs := make(map[string]struct{}, len(exclusions))
+
+	for _, data := range exclusions {
+		existing, err := tx.BronzeS1Exclusion.Query().
+			Where(bronzes1exclusion.ID(data.ResourceID)).
+			First(ctx)
+		if err != nil && !ents1.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("load existing exclusion %s: %w", data.ResourceID, err)
+		}
+
+		diff := DiffExclusionData(existing, data)
+
+		if !diff.IsNew && !diff.IsChanged {
+			if err := tx.BronzeS1Exclusion.UpdateOneID(data.ResourceID).
+				SetCollectedAt(data.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update collected_at for exclusion %s: %w", data.ResourceID, err)
+			}
+			activeIDs[data.ResourceID] = struct{}{}
+			continue
+		}
+
+		if existing == nil {
+			create := tx.BronzeS1Exclusion.Create().
+				SetID(data.ResourceID).
+				SetScopeLevel(data.ScopeLevel).
+				SetAccountID(data.AccountID).
+				SetSiteID(data.SiteID).
+				SetGroupID(data.GroupID).
+				SetScopeName(data.ScopeName).
+				SetScopePath(data.ScopePath).
+				SetExclusionType(data.ExclusionType).
+				SetExclusionValue(data.ExclusionValue).
+				SetOsType(data.OSType).
+				SetMode(data.Mode).
+				SetPathExclusionType(data.PathExclusionType).
+				SetDescription(data.Description).
+				SetSource(data.Source).
+				SetUserID(data.UserID).
+				SetUserName(data.UserName).
+				SetApplicationName(data.ApplicationName).
+				SetIncludeChildren(data.IncludeChildren).
+				SetIncludeSubfolders(data.IncludeSubfolders).
+				SetImported(data.Imported).
+				SetCollectedAt(data.CollectedAt).
+				SetFirstCollectedAt(data.CollectedAt)
+
+			if data.ActionsJSON != nil {
+				create.SetActionsJSON(data.ActionsJSON)
+			}
+			if data.APICreatedAt != nil {
+				create.SetAPICreatedAt(*data.APICreatedAt)
+			}
+			if data.APIUpdatedAt != nil {
+				create.SetAPIUpdatedAt(*data.APIUpdatedAt)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create exclusion %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.CreateHistory(ctx, tx, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create history for exclusion %s: %w", data.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeS1Exclusion.UpdateOneID(data.ResourceID).
+				SetScopeLevel(data.ScopeLevel).
+				SetAccountID(data.AccountID).
+				SetSiteID(data.SiteID).
+				SetGroupID(data.GroupID).
+				SetScopeName(data.ScopeName).
+				SetScopePath(data.ScopePath).
+				SetExclusionType(data.ExclusionType).
+				SetExclusionValue(data.ExclusionValue).
+				SetOsType(data.OSType).
+				SetMode(data.Mode).
+				SetPathExclusionType(data.PathExclusionType).
+				SetDescription(data.Description).
+				SetSource(data.Source).
+				SetUserID(data.UserID).
+				SetUserName(data.UserName).
+				SetApplicationName(data.ApplicationName).
+				SetIncludeChildren(data.IncludeChildren).
+				SetIncludeSubfolders(data.IncludeSubfolders).
+				SetImported(data.Imported).
+				SetCollectedAt(data.CollectedAt)
+
+			if data.ActionsJSON != nil {
+				update.SetActionsJSON(data.ActionsJSON)
+			}
+			if data.APICreatedAt != nil {
+				update.SetAPICreatedAt(*data.APICreatedAt)
+			}
+			if data.APIUpdatedAt != nil {
+				update.SetAPIUpdatedAt(*data.APIUpdatedAt)
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update exclusion %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.UpdateHistory(ctx, tx, existing, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update history for exclusion %s: %w", data.ResourceID, err)
+			}
+		}
+
+		activeIDs[data.ResourceID] = struct{}{}
+	}
+
+	// Delete stale exclusions not returned by the API, among the scopes that
+	// were fetched.
+	staleQuery := tx.BronzeS1Exclusion.Query()
+	if tenantSkipped {
+		staleQuery = staleQuery.Where(bronzes1exclusion.ScopeLevelNEQ(sentinelone.ScopeTenant))
+	}
+	allDBIDs, err := staleQuery.
+		Select(bronzes1exclusion.FieldID).
+		Strings(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("query all exclusion IDs: %w", err)
+	}
+
+	staleCount := 0
+	for _, id := range allDBIDs {
+		if _, ok := activeIDs[id]; ok {
+			continue
+		}
+
+		if err := s.history.CloseHistory(ctx, tx, id, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("close history for stale exclusion %s: %w", id, err)
+		}
+
+		if err := tx.BronzeS1Exclusion.DeleteOneID(id).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("delete stale exclusion %s: %w", id, err)
+		}
+		staleCount++
+	}
+
+	if staleCount > 0 {
+		slog.Info("s1 exclusions: deleted stale", "count", staleCount)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package exclusion
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/base/temporalerr"
+)
+
+// S1ExclusionWorkflowResult contains the result of the exclusion workflow.
+type S1ExclusionWorkflowResult struct {
+	ExclusionCount int
+	DurationMillis int64
+}
+
+// S1ExclusionWorkflow ingests SentinelOne exclusions per scope.
+func S1ExclusionWorkflow(ctx workflow.Context) (*S1ExclusionWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting S1ExclusionWorkflow")
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestS1ExclusionsResult
+	err := workflow.ExecuteActivity(activityCtx, IngestS1ExclusionsActivity).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest exclusions", "error", err)
+		return nil, temporalerr.PropagateNonRetryable(err)
+	}
+
+	logger.Info("Completed S1ExclusionWorkflow", "exclusionCount", result.ExclusionCount)
+
+	return &S1ExclusionWorkflowResult{
+		ExclusionCount: result.ExclusionCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.temporal.io/sdk/activity"
+
+	"danny.vn/hotpot/pkg/base/config"
+	"danny.vn/hotpot/pkg/base/ratelimit"
+	"danny.vn/hotpot/pkg/base/temporalerr"
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+)
+
+// Activities holds dependencies for Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *ents1.Client
+	limiter       ratelimit.Limiter
+}
+
+// NewActivities creates a new Activities instance.
+func NewActivities(configService *config.Service, entClient *ents1.Client, limiter ratelimit.Limiter) *Activities {
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		limiter:       limiter,
+	}
+}
+
+func (a *Activities) createClient() *Client {
+	httpClient := &http.Client{
+		Transport: ratelimit.NewRateLimitedTransport(a.limiter, nil),
+	}
+	return NewClient(
+		a.configService.S1BaseURL(),
+		a.configService.S1APIToken(),
+		a.configService.S1BatchSize(),
+		httpClient,
+	)
+}
+
+// IngestS1PoliciesResult contains the result of the ingest activity.
+type IngestS1PoliciesResult struct {
+	PolicyCount    int
+	DurationMillis int64
+}
+
+// IngestS1PoliciesActivity is the activity function reference for workflow registration.
+var IngestS1PoliciesActivity = (*Activities).IngestS1Policies
+
+// IngestS1Policies is a Temporal activity that ingests SentinelOne policies.
+func (a *Activities) IngestS1Policies(ctx context.Context) (*IngestS1PoliciesResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting SentinelOne policy ingestion")
+
+	client := a.createClient()
+	service := NewService(client, a.entClient)
+
+	result, err := service.Ingest(ctx, func() {
+		activity.RecordHeartbeat(ctx, nil)
+	})
+	if err != nil {
+		return nil, temporalerr.MaybeNonRetryable(fmt.Errorf("ingest policies: %w", err))
+	}
+
+	logger.Info("Completed SentinelOne policy ingestion",
+		"policyCount", result.PolicyCount,
+		"durationMillis", result.DurationMillis,
+	)
+
+	return &IngestS1PoliciesResult{
+		PolicyCount:    result.PolicyCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
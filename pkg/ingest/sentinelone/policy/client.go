@@ -0,0 +1,129 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"danny.vn/hotpot/pkg/base/httperr"
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+)
+
+// Client wraps the SentinelOne Policies API.
+type Client struct {
+	baseURL    string
+	apiToken   string
+	batchSize  int
+	httpClient *http.Client
+}
+
+// NewClient creates a new SentinelOne policies client.
+func NewClient(baseURL, apiToken string, batchSize int, httpClient *http.Client) *Client {
+	return &Client{
+		baseURL:    baseURL,
+		apiToken:   apiToken,
+		batchSize:  batchSize,
+		httpClient: httpClient,
+	}
+}
+
+// APIPolicy represents the agent policy of one scope from the SentinelOne
+// API response. Raw keeps the full policy object.
+type APIPolicy struct {
+	InheritedFrom            string          `json:"inheritedFrom"`
+	MitigationMode           string          `json:"mitigationMode"`
+	MitigationModeSuspicious string          `json:"mitigationModeSuspicious"`
+	AutoMitigationAction     string          `json:"autoMitigationAction"`
+	AntiTamperingOn          bool            `json:"antiTamperingOn"`
+	SnapshotsOn              bool            `json:"snapshotsOn"`
+	AgentLoggingOn           bool            `json:"agentLoggingOn"`
+	ScanNewAgents            bool            `json:"scanNewAgents"`
+	NetworkQuarantineOn      bool            `json:"networkQuarantineOn"`
+	Engines                  json.RawMessage `json:"engines"`
+	UpdatedAt                *time.Time      `json:"updatedAt"`
+	Raw                      json.RawMessage `json:"-"`
+}
+
+// GetPolicy retrieves the policy of scope.
+func (c *Client) GetPolicy(scope sentinelone.Scope) (*APIPolicy, error) {
+	var endpoint string
+	switch scope.Level {
+	case sentinelone.ScopeTenant:
+		endpoint = "/web/api/v2.1/tenant/policy"
+	case sentinelone.ScopeAccount:
+		endpoint = fmt.Sprintf("/web/api/v2.1/accounts/%s/policy", url.PathEscape(scope.ID))
+	case sentinelone.ScopeSite:
+		endpoint = fmt.Sprintf("/web/api/v2.1/sites/%s/policy", url.PathEscape(scope.ID))
+	case sentinelone.ScopeGroup:
+		endpoint = fmt.Sprintf("/web/api/v2.1/groups/%s/policy", url.PathEscape(scope.ID))
+	default:
+		return nil, fmt.Errorf("unknown scope level %q", scope.Level)
+	}
+
+	body, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get %s policy: %w", scope.Key(), err)
+	}
+
+	var response struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("parse %s policy response: %w", scope.Key(), err)
+	}
+
+	var policy APIPolicy
+	if err := json.Unmarshal(response.Data, &policy); err != nil {
+		return nil, fmt.Errorf("parse %s policy: %w", scope.Key(), err)
+	}
+	policy.Raw = response.Data
+
+	return &policy, nil
+}
+
+func (c *Client) doRequest(method, endpoint string, params url.Values) ([]byte, error) {
+	requestURL := fmt.Sprintf("%s%s", c.baseURL, endpoint)
+	if params != nil {
+		requestURL = fmt.Sprintf("%s?%s", requestURL, params.Encode())
+	}
+
+	start := time.Now()
+
+	req, err := http.NewRequest(method, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("ApiToken %s", c.apiToken))
+	req.Header.Set("Content-Type", "application/json")
+
+	slog.Debug("s1 api request", "method", method, "endpoint", endpoint)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		slog.Error("s1 api request failed", "method", method, "endpoint", endpoint, "error", err, "durationMs", time.Since(start).Milliseconds())
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	slog.Info("s1 api response", "method", method, "endpoint", endpoint, "status", resp.StatusCode, "responseBytes", len(body), "durationMs", time.Since(start).Milliseconds())
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return nil, &httperr.APIError{Code: resp.StatusCode}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return body, nil
+}
@@ -0,0 +1,63 @@
+package policy
+
+import (
+	"encoding/json"
+	"time"
+
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+)
+
+// PolicyData holds converted policy data ready for Ent insertion.
+type PolicyData struct {
+	ResourceID               string
+	ScopeLevel               string
+	AccountID                string
+	SiteID                   string
+	GroupID                  string
+	ScopeName                string
+	InheritedFrom            string
+	MitigationMode           string
+	MitigationModeSuspicious string
+	AutoMitigationAction     string
+	AntiTamperingOn          bool
+	SnapshotsOn              bool
+	AgentLoggingOn           bool
+	ScanNewAgents            bool
+	NetworkQuarantineOn      bool
+	EnginesJSON              json.RawMessage
+	PolicyJSON               json.RawMessage
+	APIUpdatedAt             *time.Time
+	CollectedAt              time.Time
+}
+
+// ConvertPolicy converts the API policy of scope to PolicyData. The resource
+// ID is the scope key, so each scope has exactly one policy row.
+func ConvertPolicy(p APIPolicy, scope sentinelone.Scope, collectedAt time.Time) *PolicyData {
+	data := &PolicyData{
+		ResourceID:               scope.Key(),
+		ScopeLevel:               scope.Level,
+		AccountID:                scope.AccountID,
+		SiteID:                   scope.SiteID,
+		GroupID:                  scope.GroupID,
+		ScopeName:                scope.Name,
+		InheritedFrom:            p.InheritedFrom,
+		MitigationMode:           p.MitigationMode,
+		MitigationModeSuspicious: p.MitigationModeSuspicious,
+		AutoMitigationAction:     p.AutoMitigationAction,
+		AntiTamperingOn:          p.AntiTamperingOn,
+		SnapshotsOn:              p.SnapshotsOn,
+		AgentLoggingOn:           p.AgentLoggingOn,
+		ScanNewAgents:            p.ScanNewAgents,
+		NetworkQuarantineOn:      p.NetworkQuarantineOn,
+		APIUpdatedAt:             p.UpdatedAt,
+		CollectedAt:              collectedAt,
+	}
+	if len(p.Engines) > 0 && string(p.Engines) != "null" {
+		data.EnginesJSON = p.Engines
+	}
+	if len(p.Raw) > 0 && string(p.Raw) != "null" {
+		data.PolicyJSON = p.Raw
+	}
+
+	return data
+}
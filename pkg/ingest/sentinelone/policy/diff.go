@@ -0,0 +1,40 @@
+package policy
+
+import (
+	"bytes"
+
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+)
+
+// PolicyDiff represents changes between old and new policy states.
+type PolicyDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffPolicyData compares old Ent entity and new data. API timestamps are
+// ignored so that a bare updatedAt bump does not create a history row.
+func DiffPolicyData(old *ents1.BronzeS1Policy, new *PolicyData) *PolicyDiff {
+	if old == nil {
+		return &PolicyDiff{IsNew: true}
+	}
+
+	changed := old.ScopeLevel != new.ScopeLevel ||
+		old.AccountID != new.AccountID ||
+		old.SiteID != new.SiteID ||
+		old.GroupID != new.GroupID ||
+		old.ScopeName != new.ScopeName ||
+		old.InheritedFrom != new.InheritedFrom ||
+		old.MitigationMode != new.MitigationMode ||
+		old.MitigationModeSuspicious != new.MitigationModeSuspicious ||
+		old.AutoMitigationAction != new.AutoMitigationAction ||
+		old.AntiTamperingOn != new.AntiTamperingOn ||
+		old.SnapshotsOn != new.SnapshotsOn ||
+		old.AgentLoggingOn != new.AgentLoggingOn ||
+		old.ScanNewAgents != new.ScanNewAgents ||
+		old.NetworkQuarantineOn != new.NetworkQuarantineOn ||
+		!bytes.Equal(old.EnginesJSON, new.EnginesJSON) ||
+		!bytes.Equal(old.PolicyJSON, new.PolicyJSON)
+
+	return &PolicyDiff{IsChanged: changed}
+}
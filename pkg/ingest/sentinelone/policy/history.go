@@ -0,0 +1,118 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1policy"
+)
+
+// HistoryService handles history tracking for policies.
+type HistoryService struct {
+	entClient *ents1.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *ents1.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *ents1.Tx, data *PolicyData) *ents1.BronzeHistoryS1PolicyCreate {
+	create := tx.BronzeHistoryS1Policy.Create().
+		SetResourceID(data.ResourceID).
+		SetScopeLevel(data.ScopeLevel).
+		SetAccountID(data.AccountID).
+		SetSiteID(data.SiteID).
+		SetGroupID(data.GroupID).
+		SetScopeName(data.ScopeName).
+		SetInheritedFrom(data.InheritedFrom).
+		SetMitigationMode(data.MitigationMode).
+		SetMitigationModeSuspicious(data.MitigationModeSuspicious).
+		SetAutoMitigationAction(data.AutoMitigationAction).
+		SetAntiTamperingOn(data.AntiTamperingOn).
+		SetSnapshotsOn(data.SnapshotsOn).
+		SetAgentLoggingOn(data.AgentLoggingOn).
+		SetScanNewAgents(data.ScanNewAgents).
+		SetNetworkQuarantineOn(data.NetworkQuarantineOn)
+
+	if data.EnginesJSON != nil {
+		create.SetEnginesJSON(data.EnginesJSON)
+	}
+	if data.PolicyJSON != nil {
+		create.SetPolicyJSON(data.PolicyJSON)
+	}
+	if data.APIUpdatedAt != nil {
+		create.SetAPIUpdatedAt(*data.APIUpdatedAt)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new policy.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *ents1.Tx, data *PolicyData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetCollectedAt(data.CollectedAt).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create policy history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new for a changed policy.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *ents1.Tx, old *ents1.BronzeS1Policy, new *PolicyData, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryS1Policy.Query().
+		Where(
+			bronzehistorys1policy.ResourceID(old.ID),
+			bronzehistorys1policy.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		return fmt.Errorf("find current policy history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryS1Policy.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close policy history: %w", err)
+	}
+
+	_, err = h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetCollectedAt(new.CollectedAt).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create new policy history: %w", err)
+	}
+
+	return nil
+}
+
+// CloseHistory closes history records for a deleted policy.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *ents1.Tx, resourceID string, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryS1Policy.Query().
+		Where(
+			bronzehistorys1policy.ResourceID(resourceID),
+			bronzehistorys1policy.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		if ents1.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("find current policy history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryS1Policy.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close policy history: %w", err)
+	}
+
+	return nil
+}
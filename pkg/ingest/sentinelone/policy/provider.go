@@ -0,0 +1,20 @@
+package policy
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider:  "sentinelone",
+		Name:      "policy",
+		Register:  Register,
+		Workflow:  S1PolicyWorkflow,
+		NewResult: func() any { return &S1PolicyWorkflowResult{} },
+		Aggregate: func(parent *sentinelone.S1InventoryWorkflowResult, child any) {
+			r := child.(*S1PolicyWorkflowResult)
+			parent.PolicyCount = r.PolicyCount
+		},
+	})
+}
@@ -0,0 +1,249 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1policy"
+)
+
+// Service handles SentinelOne policy ingestion.
+type Service struct {
+	client    *Client
+	entClient *ents1.Client
+	history   *HistoryService
+}
+
+// NewService creates a new policy ingestion service.
+func NewService(client *Client, entClient *ents1.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestResult contains the result of policy ingestion.
+type IngestResult struct {
+	PolicyCount    int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches the policy of the tenant and of every account, site and
+// group that does not inherit its site policy.
+func (s *Service) Ingest(ctx context.Context, heartbeat func()) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	scopes, err := sentinelone.LoadScopes(ctx, s.entClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var allPolicies []*PolicyData
+	// tenantSkipped is set when the tenant scope is not readable; its stored
+	// rows are then left as they are rather than treated as removed.
+	tenantSkipped := false
+
+	for _, scope := range scopes {
+		if scope.Inherits {
+			continue
+		}
+
+		apiPolicy, err := s.client.GetPolicy(scope)
+		if err != nil {
+			if scope.Level == sentinelone.ScopeTenant && sentinelone.IsAccessDenied(err) {
+				slog.Warn("s1 policies: no access to tenant scope, skipping", "error", err)
+				tenantSkipped = true
+				continue
+			}
+			slog.Error("s1 policy fetch failed", "scope", scope.Key(), "totalSoFar", len(allPolicies), "error", err)
+			return nil, fmt.Errorf("get policy for %s: %w", scope.Key(), err)
+		}
+		allPolicies = append(allPolicies, ConvertPolicy(*apiPolicy, scope, collectedAt))
+
+		if heartbeat != nil {
+			heartbeat()
+		}
+	}
+
+	slog.Info("s1 policies fetched", "scopes", len(scopes), "totalPolicies", len(allPolicies))
+
+	if err := s.savePolicies(ctx, allPolicies, tenantSkipped); err != nil {
+		return nil, fmt.Errorf("save policies: %w", err)
+	}
+
+	return &IngestResult{
+		PolicyCount:    len(allPolicies),
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+func (s *Service) savePolicies(ctx context.Context, policies []*PolicyData, tenantSkipped bool) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	activeIDs := make(map[string]struct{}, len(policies))
+
+	for _, data := range policies {
+		existing, err := tx.BronzeS1Policy.Query().
+			Where(bronzes1policy.ID(data.ResourceID)).
+			First(ctx)
+		if err != nil && !ents1.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("load existing policy %s: %w", data.ResourceID, err)
+		}
+
+		diff := DiffPolicyData(existing, data)
+
+		if !diff.IsNew && !diff.IsChanged {
+			if err := tx.BronzeS1Policy.UpdateOneID(data.ResourceID).
+				SetCollectedAt(data.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update collected_at for policy %s: %w", data.ResourceID, err)
+			}
+			activeIDs[data.ResourceID] = struct{}{}
+			continue
+		}
+
+		if existing == nil {
+			create := tx.BronzeS1Policy.Create().
+				SetID(data.ResourceID).
+				SetScopeLevel(data.ScopeLevel).
+				SetAccountID(data.AccountID).
+				SetSiteID(data.SiteID).
+				SetGroupID(data.GroupID).
+				SetScopeName(data.ScopeName).
+				SetInheritedFrom(data.InheritedFrom).
+				SetMitigationMode(data.MitigationMode).
+				SetMitigationModeSuspicious(data.MitigationModeSuspicious).
+				SetAutoMitigationAction(data.AutoMitigationAction).
+				SetAntiTamperingOn(data.AntiTamperingOn).
+				SetSnapshotsOn(data.SnapshotsOn).
+				SetAgentLoggingOn(data.AgentLoggingOn).
+				SetScanNewAgents(data.ScanNewAgents).
+				SetNetworkQuarantineOn(data.NetworkQuarantineOn).
+				SetCollectedAt(data.CollectedAt).
+				SetFirstCollectedAt(data.CollectedAt)
+
+			if data.EnginesJSON != nil {
+				create.SetEnginesJSON(data.EnginesJSON)
+			}
+			if data.PolicyJSON != nil {
+				create.SetPolicyJSON(data.PolicyJSON)
+			}
+			if data.APIUpdatedAt != nil {
+				create.SetAPIUpdatedAt(*data.APIUpdatedAt)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create policy %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.CreateHistory(ctx, tx, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create history for policy %s: %w", data.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeS1Policy.UpdateOneID(data.ResourceID).
+				SetScopeLevel(data.ScopeLevel).
+				SetAccountID(data.AccountID).
+				SetSiteID(data.SiteID).
+				SetGroupID(data.GroupID).
+				SetScopeName(data.ScopeName).
+				SetInheritedFrom(data.InheritedFrom).
+				SetMitigationMode(data.MitigationMode).
+				SetMitigationModeSuspicious(data.MitigationModeSuspicious).
+				SetAutoMitigationAction(data.AutoMitigationAction).
+				SetAntiTamperingOn(data.AntiTamperingOn).
+				SetSnapshotsOn(data.SnapshotsOn).
+				SetAgentLoggingOn(data.AgentLoggingOn).
+				SetScanNewAgents(data.ScanNewAgents).
+				SetNetworkQuarantineOn(data.NetworkQuarantineOn).
+				SetCollectedAt(data.CollectedAt)
+
+			if data.EnginesJSON != nil {
+				update.SetEnginesJSON(data.EnginesJSON)
+			}
+			if data.PolicyJSON != nil {
+				update.SetPolicyJSON(data.PolicyJSON)
+			}
+			if data.APIUpdatedAt != nil {
+				update.SetAPIUpdatedAt(*data.APIUpdatedAt)
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update policy %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.UpdateHistory(ctx, tx, existing, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update history for policy %s: %w", data.ResourceID, err)
+			}
+		}
+
+		activeIDs[data.ResourceID] = struct{}{}
+	}
+
+	// Delete stale policies not returned by the API, among the scopes that
+	// were fetched.
+	staleQuery := tx.BronzeS1Policy.Query()
+	if tenantSkipped {
+		staleQuery = staleQuery.Where(bronzes1policy.ScopeLevelNEQ(sentinelone.ScopeTenant))
+	}
+	allDBIDs, err := staleQuery.
+		Select(bronzes1policy.FieldID).
+		Strings(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("query all policy IDs: %w", err)
+	}
+
+	staleCount := 0
+	for _, id := range allDBIDs {
+		if _, ok := activeIDs[id]; ok {
+			continue
+		}
+
+		if err := s.history.CloseHistory(ctx, tx, id, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("close history for stale policy %s: %w", id, err)
+		}
+
+		if err := tx.BronzeS1Policy.DeleteOneID(id).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("delete stale policy %s: %w", id, err)
+		}
+		staleCount++
+	}
+
+	if staleCount > 0 {
+		slog.Info("s1 policies: deleted stale", "count", staleCount)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
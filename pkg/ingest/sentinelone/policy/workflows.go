@@ -0,0 +1,48 @@
+package policy
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/base/temporalerr"
+)
+
+// S1PolicyWorkflowResult contains the result of the policy workflow.
+type S1PolicyWorkflowResult struct {
+	PolicyCount    int
+	DurationMillis int64
+}
+
+// S1PolicyWorkflow ingests SentinelOne agent policies per scope.
+func S1PolicyWorkflow(ctx workflow.Context) (*S1PolicyWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting S1PolicyWorkflow")
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestS1PoliciesResult
+	err := workflow.ExecuteActivity(activityCtx, IngestS1PoliciesActivity).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest policies", "error", err)
+		return nil, temporalerr.PropagateNonRetryable(err)
+	}
+
+	logger.Info("Completed S1PolicyWorkflow", "policyCount", result.PolicyCount)
+
+	return &S1PolicyWorkflowResult{
+		PolicyCount:    result.PolicyCount,
+		DurationMillis: result.DurationMillis,
+	}, nil
+}
@@ -0,0 +1,137 @@
+package restriction
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"danny.vn/hotpot/pkg/base/httperr"
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+)
+
+// Client wraps the SentinelOne Restrictions (blocklist) API.
+type Client struct {
+	baseURL    string
+	apiToken   string
+	batchSize  int
+	httpClient *http.Client
+}
+
+// NewClient creates a new SentinelOne restrictions client.
+func NewClient(baseURL, apiToken string, batchSize int, httpClient *http.Client) *Client {
+	return &Client{
+		baseURL:    baseURL,
+		apiToken:   apiToken,
+		batchSize:  batchSize,
+		httpClient: httpClient,
+	}
+}
+
+// restrictionType is the only restriction type the API exposes: a blocked
+// file hash.
+const restrictionType = "black_hash"
+
+// APIRestriction represents a blocklist entry from the SentinelOne API response.
+type APIRestriction struct {
+	ID          string     `json:"id"`
+	Type        string     `json:"type"`
+	Value       string     `json:"value"`
+	OSType      string     `json:"osType"`
+	Description string     `json:"description"`
+	Source      string     `json:"source"`
+	UserID      string     `json:"userId"`
+	UserName    string     `json:"userName"`
+	ScopeName   string     `json:"scopeName"`
+	ScopePath   string     `json:"scopePath"`
+	Imported    bool       `json:"imported"`
+	CreatedAt   *time.Time `json:"createdAt"`
+	UpdatedAt   *time.Time `json:"updatedAt"`
+}
+
+// RestrictionBatchResult contains a batch of restrictions and pagination info.
+type RestrictionBatchResult struct {
+	Restrictions []APIRestriction
+	NextCursor   string
+	HasMore      bool
+}
+
+// GetRestrictionsBatch retrieves a batch of the blocklist entries defined at
+// scope with cursor pagination.
+func (c *Client) GetRestrictionsBatch(scope sentinelone.Scope, cursor string) (*RestrictionBatchResult, error) {
+	params := url.Values{}
+	params.Set("limit", fmt.Sprintf("%d", c.batchSize))
+	params.Set("type", restrictionType)
+	scope.SetFilter(params)
+	if cursor != "" {
+		params.Set("cursor", cursor)
+	}
+
+	body, err := c.doRequest("GET", "/web/api/v2.1/restrictions", params)
+	if err != nil {
+		return nil, fmt.Errorf("get restrictions: %w", err)
+	}
+
+	var response struct {
+		Data       []APIRestriction `json:"data"`
+		Pagination struct {
+			NextCursor string `json:"nextCursor"`
+		} `json:"pagination"`
+	}
+
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("parse restrictions response: %w", err)
+	}
+
+	return &RestrictionBatchResult{
+		Restrictions: response.Data,
+		NextCursor:   response.Pagination.NextCursor,
+		HasMore:      response.Pagination.NextCursor != "",
+	}, nil
+}
+
+func (c *Client) doRequest(method, endpoint string, params url.Values) ([]byte, error) {
+	requestURL := fmt.Sprintf("%s%s", c.baseURL, endpoint)
+	if params != nil {
+		requestURL = fmt.Sprintf("%s?%s", requestURL, params.Encode())
+	}
+
+	start := time.Now()
+
+	req, err := http.NewRequest(method, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("ApiToken %s", c.apiToken))
+	req.Header.Set("Content-Type", "application/json")
+
+	slog.Debug("s1 api request", "method", method, "endpoint", endpoint)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		slog.Error("s1 api request failed", "method", method, "endpoint", endpoint, "error", err, "durationMs", time.Since(start).Milliseconds())
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	slog.Info("s1 api response", "method", method, "endpoint", endpoint, "status", resp.StatusCode, "responseBytes", len(body), "durationMs", time.Since(start).Milliseconds())
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return nil, &httperr.APIError{Code: resp.StatusCode}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return body, nil
+}
@@ -0,0 +1,61 @@
+package restriction
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+)
+
+// RestrictionData holds converted restriction data ready for Ent insertion.
+type RestrictionData struct {
+	ResourceID       string
+	ScopeLevel       string
+	AccountID        string
+	SiteID           string
+	GroupID          string
+	ScopeName        string
+	ScopePath        string
+	RestrictionType  string
+	RestrictionValue string
+	OSType           string
+	Description      string
+	Source           string
+	UserID           string
+	UserName         string
+	Imported         bool
+	APICreatedAt     *time.Time
+	APIUpdatedAt     *time.Time
+	CollectedAt      time.Time
+}
+
+// ConvertRestriction converts an API restriction fetched for scope to RestrictionData.
+func ConvertRestriction(r APIRestriction, scope sentinelone.Scope, collectedAt time.Time) *RestrictionData {
+	data := &RestrictionData{
+		ResourceID:       r.ID,
+		ScopeLevel:       scope.Level,
+		AccountID:        scope.AccountID,
+		SiteID:           scope.SiteID,
+		GroupID:          scope.GroupID,
+		ScopeName:        r.ScopeName,
+		ScopePath:        r.ScopePath,
+		RestrictionType:  r.Type,
+		RestrictionValue: r.Value,
+		OSType:           r.OSType,
+		Description:      r.Description,
+		Source:           r.Source,
+		UserID:           r.UserID,
+		UserName:         r.UserName,
+		Imported:         r.Imported,
+		APICreatedAt:     r.CreatedAt,
+		APIUpdatedAt:     r.UpdatedAt,
+		CollectedAt:      collectedAt,
+	}
+	if data.RestrictionType == "" {
+		data.RestrictionType = restrictionType
+	}
+	if data.ScopeName == "" {
+		data.ScopeName = scope.Name
+	}
+
+	return data
+}
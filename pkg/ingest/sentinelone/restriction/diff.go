@@ -0,0 +1,34 @@
+package restriction
+
+import ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+
+// RestrictionDiff represents changes between old and new restriction states.
+type RestrictionDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffRestrictionData compares old Ent entity and new data. API timestamps are
+// ignored so that a bare updatedAt bump does not create a history row.
+func DiffRestrictionData(old *ents1.BronzeS1Restriction, new *RestrictionData) *RestrictionDiff {
+	if old == nil {
+		return &RestrictionDiff{IsNew: true}
+	}
+
+	changed := old.ScopeLevel != new.ScopeLevel ||
+		old.AccountID != new.AccountID ||
+		old.SiteID != new.SiteID ||
+		old.GroupID != new.GroupID ||
+		old.ScopeName != new.ScopeName ||
+		old.ScopePath != new.ScopePath ||
+		old.RestrictionType != new.RestrictionType ||
+		old.RestrictionValue != new.RestrictionValue ||
+		old.OsType != new.OSType ||
+		old.Description != new.Description ||
+		old.Source != new.Source ||
+		old.UserID != new.UserID ||
+		old.UserName != new.UserName ||
+		old.Imported != new.Imported
+
+	return &RestrictionDiff{IsChanged: changed}
+}
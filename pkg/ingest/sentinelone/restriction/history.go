@@ -0,0 +1,115 @@
+package restriction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1restriction"
+)
+
+// HistoryService handles history tracking for restrictions.
+type HistoryService struct {
+	entClient *ents1.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *ents1.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *ents1.Tx, data *RestrictionData) *ents1.BronzeHistoryS1RestrictionCreate {
+	create := tx.BronzeHistoryS1Restriction.Create().
+		SetResourceID(data.ResourceID).
+		SetScopeLevel(data.ScopeLevel).
+		SetAccountID(data.AccountID).
+		SetSiteID(data.SiteID).
+		SetGroupID(data.GroupID).
+		SetScopeName(data.ScopeName).
+		SetScopePath(data.ScopePath).
+		SetRestrictionType(data.RestrictionType).
+		SetRestrictionValue(data.RestrictionValue).
+		SetOsType(data.OSType).
+		SetDescription(data.Description).
+		SetSource(data.Source).
+		SetUserID(data.UserID).
+		SetUserName(data.UserName).
+		SetImported(data.Imported)
+
+	if data.APICreatedAt != nil {
+		create.SetAPICreatedAt(*data.APICreatedAt)
+	}
+	if data.APIUpdatedAt != nil {
+		create.SetAPIUpdatedAt(*data.APIUpdatedAt)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new restriction.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *ents1.Tx, data *RestrictionData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetCollectedAt(data.CollectedAt).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create restriction history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new for a changed restriction.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *ents1.Tx, old *ents1.BronzeS1Restriction, new *RestrictionData, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryS1Restriction.Query().
+		Where(
+			bronzehistorys1restriction.ResourceID(old.ID),
+			bronzehistorys1restriction.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		return fmt.Errorf("find current restriction history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryS1Restriction.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close restriction history: %w", err)
+	}
+
+	_, err = h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetCollectedAt(new.CollectedAt).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create new restriction history: %w", err)
+	}
+
+	return nil
+}
+
+// CloseHistory closes history records for a deleted restriction.
+func (h *HistoryService) CloseHistory(ctx context.Context, tx *ents1.Tx, resourceID string, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryS1Restriction.Query().
+		Where(
+			bronzehistorys1restriction.ResourceID(resourceID),
+			bronzehistorys1restriction.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		if ents1.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("find current restriction history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryS1Restriction.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close restriction history: %w", err)
+	}
+
+	return nil
+}
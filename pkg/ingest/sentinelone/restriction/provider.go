@@ -0,0 +1,20 @@
+package restriction
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider:  "sentinelone",
+		Name:      "restriction",
+		Register:  Register,
+		Workflow:  S1RestrictionWorkflow,
+		NewResult: func() any { return &S1RestrictionWorkflowResult{} },
+		Aggregate: func(parent *sentinelone.S1InventoryWorkflowResult, child any) {
+			r := child.(*S1RestrictionWorkflowResult)
+			parent.RestrictionCount = r.RestrictionCount
+		},
+	})
+}
@@ -0,0 +1,251 @@
+package restriction
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1restriction"
+)
+
+// Service handles SentinelOne restriction ingestion.
+type Service struct {
+	client    *Client
+	entClient *ents1.Client
+	history   *HistoryService
+}
+
+// NewService creates a new restriction ingestion service.
+func NewService(client *Client, entClient *ents1.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestResult contains the result of restriction ingestion.
+type IngestResult struct {
+	RestrictionCount int
+	CollectedAt      time.Time
+	DurationMillis   int64
+}
+
+// Ingest fetches the blocklist entries defined at every scope (tenant,
+// accounts, sites and groups). Entries no longer returned are deleted and
+// their history closed.
+func (s *Service) Ingest(ctx context.Context, heartbeat func()) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	scopes, err := sentinelone.LoadScopes(ctx, s.entClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var allRestrictions []*RestrictionData
+	// tenantSkipped is set when the tenant scope is not readable; its stored
+	// rows are then left as they are rather than treated as removed.
+	tenantSkipped := false
+
+	for _, scope := range scopes {
+		cursor := ""
+		for {
+			batch, err := s.client.GetRestrictionsBatch(scope, cursor)
+			if err != nil {
+				if scope.Level == sentinelone.ScopeTenant && sentinelone.IsAccessDenied(err) {
+					slog.Warn("s1 restrictions: no access to tenant scope, skipping", "error", err)
+					tenantSkipped = true
+					break
+				}
+				slog.Error("s1 restrictions batch failed", "scope", scope.Key(), "totalSoFar", len(allRestrictions), "error", err)
+				return nil, fmt.Errorf("get restrictions for %s: %w", scope.Key(), err)
+			}
+
+			for _, apiRestriction := range batch.Restrictions {
+				allRestrictions = append(allRestrictions, ConvertRestriction(apiRestriction, scope, collectedAt))
+			}
+
+			if heartbeat != nil {
+				heartbeat()
+			}
+
+			if !batch.HasMore {
+				break
+			}
+			cursor = batch.NextCursor
+		}
+	}
+
+	slog.Info("s1 restrictions fetched", "scopes", len(scopes), "totalRestrictions", len(allRestrictions))
+
+	if err := s.saveRestrictions(ctx, allRestrictions, tenantSkipped); err != nil {
+		return nil, fmt.Errorf("save restrictions: %w", err)
+	}
+
+	return &IngestResult{
+		RestrictionCount: len(allRestrictions),
+		CollectedAt:      collectedAt,
+		DurationMillis:   time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+func (s *Service) saveRestrictions(ctx context.Context, restrictions []*RestrictionData, tenantSkipped bool) error {
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	activeIDs := make(map[string]struct{}, len(restrictions))
+
+	for _, data := range restrictions {
+		existing, err := tx.BronzeS1Restriction.Query().
+			Where(bronzes1restriction.ID(data.ResourceID)).
+			First(ctx)
+		if err != nil && !ents1.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("load existing restriction %s: %w", data.ResourceID, err)
+		}
+
+		diff := DiffRestrictionData(existing, data)
+
+		if !diff.IsNew && !diff.IsChanged {
+			if err := tx.BronzeS1Restriction.UpdateOneID(data.ResourceID).
+				SetCollectedAt(data.CollectedAt).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update collected_at for restriction %s: %w", data.ResourceID, err)
+			}
+			activeIDs[data.ResourceID] = struct{}{}
+			continue
+		}
+
+		if existing == nil {
+			create := tx.BronzeS1Restriction.Create().
+				SetID(data.ResourceID).
+				SetScopeLevel(data.ScopeLevel).
+				SetAccountID(data.AccountID).
+				SetSiteID(data.SiteID).
+				SetGroupID(data.GroupID).
+				SetScopeName(data.ScopeName).
+				SetScopePath(data.ScopePath).
+				SetRestrictionType(data.RestrictionType).
+				SetRestrictionValue(data.RestrictionValue).
+				SetOsType(data.OSType).
+				SetDescription(data.Description).
+				SetSource(data.Source).
+				SetUserID(data.UserID).
+				SetUserName(data.UserName).
+				SetImported(data.Imported).
+				SetCollectedAt(data.CollectedAt).
+				SetFirstCollectedAt(data.CollectedAt)
+
+			if data.APICreatedAt != nil {
+				create.SetAPICreatedAt(*data.APICreatedAt)
+			}
+			if data.APIUpdatedAt != nil {
+				create.SetAPIUpdatedAt(*data.APIUpdatedAt)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create restriction %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.CreateHistory(ctx, tx, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create history for restriction %s: %w", data.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeS1Restriction.UpdateOneID(data.ResourceID).
+				SetScopeLevel(data.ScopeLevel).
+				SetAccountID(data.AccountID).
+				SetSiteID(data.SiteID).
+				SetGroupID(data.GroupID).
+				SetScopeName(data.ScopeName).
+				SetScopePath(data.ScopePath).
+				SetRestrictionType(data.RestrictionType).
+				SetRestrictionValue(data.RestrictionValue).
+				SetOsType(data.OSType).
+				SetDescription(data.Description).
+				SetSource(data.Source).
+				SetUserID(data.UserID).
+				SetUserName(data.UserName).
+				SetImported(data.Imported).
+				SetCollectedAt(data.CollectedAt)
+
+			if data.APICreatedAt != nil {
+				update.SetAPICreatedAt(*data.APICreatedAt)
+			}
+			if data.APIUpdatedAt != nil {
+				update.SetAPIUpdatedAt(*data.APIUpdatedAt)
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update restriction %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.UpdateHistory(ctx, tx, existing, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update history for restriction %s: %w", data.ResourceID, err)
+			}
+		}
+
+		activeIDs[data.ResourceID] = struct{}{}
+	}
+
+	// Delete stale restrictions not returned by the API, among the scopes that
+	// were fetched.
+	staleQuery := tx.BronzeS1Restriction.Query()
+	if tenantSkipped {
+		staleQuery = staleQuery.Where(bronzes1restriction.ScopeLevelNEQ(sentinelone.ScopeTenant))
+	}
+	allDBIDs, err := staleQuery.
+		Select(bronzes1restriction.FieldID).
+		Strings(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("query all restriction IDs: %w", err)
+	}
+
+	staleCount := 0
+	for _, id := range allDBIDs {
+		if _, ok := activeIDs[id]; ok {
+			continue
+		}
+
+		if err := s.history.CloseHistory(ctx, tx, id, now); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("close history for stale restriction %s: %w", id, err)
+		}
+
+		if err := tx.BronzeS1Restriction.DeleteOneID(id).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("delete stale restriction %s: %w", id, err)
+		}
+		staleCount++
+	}
+
+	if staleCount > 0 {
+		slog.Info("s1 restrictions: deleted stale", "count", staleCount)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
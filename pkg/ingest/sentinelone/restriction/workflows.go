@@ -0,0 +1,48 @@
+package restriction
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"danny.vn/hotpot/pkg/base/temporalerr"
+)
+
+// S1RestrictionWorkflowResult contains the result of the restriction workflow.
+type S1RestrictionWorkflowResult struct {
+	RestrictionCount int
+	DurationMillis   int64
+}
+
+// S1RestrictionWorkflow ingests SentinelOne blocklist restrictions per scope.
+func S1RestrictionWorkflow(ctx workflow.Context) (*S1RestrictionWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting S1RestrictionWorkflow")
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	var result IngestS1RestrictionsResult
+	err := workflow.ExecuteActivity(activityCtx, IngestS1RestrictionsActivity).Get(ctx, &result)
+	if err != nil {
+		logger.Error("Failed to ingest restrictions", "error", err)
+		return nil, temporalerr.PropagateNonRetryable(err)
+	}
+
+	logger.Info("Completed S1RestrictionWorkflow", "restrictionCount", result.RestrictionCount)
+
+	return &S1RestrictionWorkflowResult{
+		RestrictionCount: result.RestrictionCount,
+		DurationMillis:   result.DurationMillis,
+	}, nil
+}
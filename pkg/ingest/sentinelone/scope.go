@@ -0,0 +1,112 @@
+package sentinelone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"danny.vn/hotpot/pkg/base/httperr"
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+)
+
+// Scope levels of the SentinelOne management hierarchy.
+const (
+	ScopeTenant  = "tenant"
+	ScopeAccount = "account"
+	ScopeSite    = "site"
+	ScopeGroup   = "group"
+)
+
+// Scope is one node of the tenant > account > site > group hierarchy that
+// policies, exclusions and restrictions are defined at.
+type Scope struct {
+	Level     string
+	ID        string // empty for the tenant
+	Name      string
+	AccountID string
+	SiteID    string
+	GroupID   string
+
+	// Inherits is true for a group that inherits its site policy.
+	Inherits bool
+}
+
+// Key returns a stable identifier for the scope, e.g. "site:123" or "tenant".
+func (s Scope) Key() string {
+	if s.Level == ScopeTenant {
+		return ScopeTenant
+	}
+	return s.Level + ":" + s.ID
+}
+
+// SetFilter adds the list filter selecting objects defined exactly at the
+// scope, not at its parents or children.
+func (s Scope) SetFilter(params url.Values) {
+	switch s.Level {
+	case ScopeTenant:
+		params.Set("tenant", "true")
+	case ScopeAccount:
+		params.Set("accountIds", s.ID)
+	case ScopeSite:
+		params.Set("siteIds", s.ID)
+	case ScopeGroup:
+		params.Set("groupIds", s.ID)
+	}
+	params.Set("includeParents", "false")
+	params.Set("includeChildren", "false")
+}
+
+// LoadScopes returns the tenant followed by every account, site and group
+// in bronze. A scope created since the last account, site or group ingestion
+// is picked up on the next run.
+func LoadScopes(ctx context.Context, entClient *ents1.Client) ([]Scope, error) {
+	scopes := []Scope{{Level: ScopeTenant, Name: "Global"}}
+
+	accounts, err := entClient.BronzeS1Account.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load accounts: %w", err)
+	}
+	for _, a := range accounts {
+		scopes = append(scopes, Scope{Level: ScopeAccount, ID: a.ID, Name: a.Name, AccountID: a.ID})
+	}
+
+	sites, err := entClient.BronzeS1Site.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load sites: %w", err)
+	}
+	siteAccounts := make(map[string]string, len(sites))
+	for _, s := range sites {
+		siteAccounts[s.ID] = s.AccountID
+		scopes = append(scopes, Scope{Level: ScopeSite, ID: s.ID, Name: s.Name, AccountID: s.AccountID, SiteID: s.ID})
+	}
+
+	groups, err := entClient.BronzeS1Group.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load groups: %w", err)
+	}
+	for _, g := range groups {
+		scopes = append(scopes, Scope{
+			Level:     ScopeGroup,
+			ID:        g.ID,
+			Name:      g.Name,
+			AccountID: siteAccounts[g.SiteID],
+			SiteID:    g.SiteID,
+			GroupID:   g.ID,
+			Inherits:  g.Inherits,
+		})
+	}
+
+	return scopes, nil
+}
+
+// IsAccessDenied reports whether err is a 401/403 from the API, as returned
+// for tenant-level reads with an account- or site-scoped token.
+func IsAccessDenied(err error) bool {
+	var apiErr *httperr.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.Code == http.StatusUnauthorized || apiErr.Code == http.StatusForbidden
+}
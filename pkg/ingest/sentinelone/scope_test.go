@@ -0,0 +1,33 @@
+package sentinelone
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestScopeKeyAndFilter(t *testing.T) {
+	tests := []struct {
+		scope     Scope
+		wantKey   string
+		wantParam string
+		wantValue string
+	}{
+		{Scope{Level: ScopeTenant}, "tenant", "tenant", "true"},
+		{Scope{Level: ScopeAccount, ID: "a1"}, "account:a1", "accountIds", "a1"},
+		{Scope{Level: ScopeSite, ID: "s1"}, "site:s1", "siteIds", "s1"},
+		{Scope{Level: ScopeGroup, ID: "g1"}, "group:g1", "groupIds", "g1"},
+	}
+	for _, tt := range tests {
+		if got := tt.scope.Key(); got != tt.wantKey {
+			t.Errorf("Key() = %q, want %q", got, tt.wantKey)
+		}
+		params := url.Values{}
+		tt.scope.SetFilter(params)
+		if got := params.Get(tt.wantParam); got != tt.wantValue {
+			t.Errorf("%s: %s = %q, want %q", tt.wantKey, tt.wantParam, got, tt.wantValue)
+		}
+		if params.Get("includeParents") != "false" || params.Get("includeChildren") != "false" {
+			t.Errorf("%s: filter must not include parents or children: %v", tt.wantKey, params)
+		}
+	}
+}
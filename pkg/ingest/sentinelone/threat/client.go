@@ -0,0 +1,173 @@
+package threat
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"danny.vn/hotpot/pkg/base/httperr"
+)
+
+// Client wraps the SentinelOne Threats API.
+type Client struct {
+	baseURL    string
+	apiToken   string
+	batchSize  int
+	httpClient *http.Client
+}
+
+// NewClient creates a new SentinelOne threats client.
+func NewClient(baseURL, apiToken string, batchSize int, httpClient *http.Client) *Client {
+	return &Client{
+		baseURL:    baseURL,
+		apiToken:   apiToken,
+		batchSize:  batchSize,
+		httpClient: httpClient,
+	}
+}
+
+// APIAgentDetectionInfo is the agent state captured when the threat was detected.
+type APIAgentDetectionInfo struct {
+	AgentUUID string `json:"agentUuid"`
+	AccountID string `json:"accountId"`
+	SiteID    string `json:"siteId"`
+	SiteName  string `json:"siteName"`
+	GroupID   string `json:"groupId"`
+}
+
+// APIAgentRealtimeInfo is the current state of the agent that reported the threat.
+type APIAgentRealtimeInfo struct {
+	AgentID           string `json:"agentId"`
+	AgentUUID         string `json:"agentUuid"`
+	AgentComputerName string `json:"agentComputerName"`
+	AgentOSType       string `json:"agentOsType"`
+	AccountID         string `json:"accountId"`
+	SiteID            string `json:"siteId"`
+	SiteName          string `json:"siteName"`
+	GroupID           string `json:"groupId"`
+}
+
+// APIThreatInfo holds the threat classification and mitigation state.
+type APIThreatInfo struct {
+	ThreatName            string          `json:"threatName"`
+	Classification        string          `json:"classification"`
+	ClassificationSource  string          `json:"classificationSource"`
+	ConfidenceLevel       string          `json:"confidenceLevel"`
+	MitigationStatus      string          `json:"mitigationStatus"`
+	AnalystVerdict        string          `json:"analystVerdict"`
+	IncidentStatus        string          `json:"incidentStatus"`
+	InitiatedBy           string          `json:"initiatedBy"`
+	DetectionType         string          `json:"detectionType"`
+	FilePath              string          `json:"filePath"`
+	SHA1                  string          `json:"sha1"`
+	SHA256                string          `json:"sha256"`
+	MD5                   string          `json:"md5"`
+	Storyline             string          `json:"storyline"`
+	IsFileless            bool            `json:"isFileless"`
+	MitigatedPreemptively bool            `json:"mitigatedPreemptively"`
+	Engines               json.RawMessage `json:"engines"`
+	IdentifiedAt          *time.Time      `json:"identifiedAt"`
+	CreatedAt             *time.Time      `json:"createdAt"`
+	UpdatedAt             *time.Time      `json:"updatedAt"`
+}
+
+// APIThreat represents a threat from the SentinelOne API response.
+type APIThreat struct {
+	ID                 string                `json:"id"`
+	AgentDetectionInfo APIAgentDetectionInfo `json:"agentDetectionInfo"`
+	AgentRealtimeInfo  APIAgentRealtimeInfo  `json:"agentRealtimeInfo"`
+	ThreatInfo         APIThreatInfo         `json:"threatInfo"`
+	Indicators         json.RawMessage       `json:"indicators"`
+	MitigationStatus   json.RawMessage       `json:"mitigationStatus"`
+}
+
+// ThreatBatchResult contains a batch of threats and pagination info.
+type ThreatBatchResult struct {
+	Threats    []APIThreat
+	NextCursor string
+	HasMore    bool
+}
+
+// GetThreatsBatch retrieves a batch of threats updated at or after since,
+// oldest first. A zero since fetches every threat.
+func (c *Client) GetThreatsBatch(since time.Time, cursor string) (*ThreatBatchResult, error) {
+	params := url.Values{}
+	params.Set("limit", fmt.Sprintf("%d", c.batchSize))
+	params.Set("sortBy", "updatedAt")
+	params.Set("sortOrder", "asc")
+	if !since.IsZero() {
+		params.Set("updatedAt__gte", since.UTC().Format(time.RFC3339Nano))
+	}
+	if cursor != "" {
+		params.Set("cursor", cursor)
+	}
+
+	body, err := c.doRequest("GET", "/web/api/v2.1/threats", params)
+	if err != nil {
+		return nil, fmt.Errorf("get threats: %w", err)
+	}
+
+	var response struct {
+		Data       []APIThreat `json:"data"`
+		Pagination struct {
+			NextCursor string `json:"nextCursor"`
+		} `json:"pagination"`
+	}
+
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("parse threats response: %w", err)
+	}
+
+	return &ThreatBatchResult{
+		Threats:    response.Data,
+		NextCursor: response.Pagination.NextCursor,
+		HasMore:    response.Pagination.NextCursor != "",
+	}, nil
+}
+
+func (c *Client) doRequest(method, endpoint string, params url.Values) ([]byte, error) {
+	requestURL := fmt.Sprintf("%s%s", c.baseURL, endpoint)
+	if params != nil {
+		requestURL = fmt.Sprintf("%s?%s", requestURL, params.Encode())
+	}
+
+	start := time.Now()
+
+	req, err := http.NewRequest(method, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("ApiToken %s", c.apiToken))
+	req.Header.Set("Content-Type", "application/json")
+
+	slog.Debug("s1 api request", "method", method, "endpoint", endpoint)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		slog.Error("s1 api request failed", "method", method, "endpoint", endpoint, "error", err, "durationMs", time.Since(start).Milliseconds())
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	slog.Info("s1 api response", "method", method, "endpoint", endpoint, "status", resp.StatusCode, "responseBytes", len(body), "durationMs", time.Since(start).Milliseconds())
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return nil, &httperr.APIError{Code: resp.StatusCode}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return body, nil
+}
@@ -0,0 +1,100 @@
+package threat
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ThreatData holds converted threat data ready for Ent insertion.
+type ThreatData struct {
+	ResourceID            string
+	AgentID               string
+	AgentUUID             string
+	AgentComputerName     string
+	AgentOSType           string
+	AccountID             string
+	SiteID                string
+	SiteName              string
+	GroupID               string
+	ThreatName            string
+	Classification        string
+	ClassificationSource  string
+	ConfidenceLevel       string
+	MitigationStatus      string
+	AnalystVerdict        string
+	IncidentStatus        string
+	InitiatedBy           string
+	DetectionType         string
+	FilePath              string
+	SHA1                  string
+	SHA256                string
+	MD5                   string
+	Storyline             string
+	IsFileless            bool
+	MitigatedPreemptively bool
+	IdentifiedAt          *time.Time
+	APICreatedAt          *time.Time
+	APIUpdatedAt          *time.Time
+	EnginesJSON           json.RawMessage
+	IndicatorsJSON        json.RawMessage
+	MitigationActionsJSON json.RawMessage
+	CollectedAt           time.Time
+}
+
+// ConvertThreat converts an API threat to ThreatData. Agent placement comes
+// from the realtime info and falls back to the detection-time snapshot, which
+// is all that is left once the agent has been decommissioned.
+func ConvertThreat(t APIThreat, collectedAt time.Time) *ThreatData {
+	rt, det, info := t.AgentRealtimeInfo, t.AgentDetectionInfo, t.ThreatInfo
+	return &ThreatData{
+		ResourceID:            t.ID,
+		AgentID:               rt.AgentID,
+		AgentUUID:             firstNonEmpty(rt.AgentUUID, det.AgentUUID),
+		AgentComputerName:     rt.AgentComputerName,
+		AgentOSType:           rt.AgentOSType,
+		AccountID:             firstNonEmpty(rt.AccountID, det.AccountID),
+		SiteID:                firstNonEmpty(rt.SiteID, det.SiteID),
+		SiteName:              firstNonEmpty(rt.SiteName, det.SiteName),
+		GroupID:               firstNonEmpty(rt.GroupID, det.GroupID),
+		ThreatName:            info.ThreatName,
+		Classification:        info.Classification,
+		ClassificationSource:  info.ClassificationSource,
+		ConfidenceLevel:       info.ConfidenceLevel,
+		MitigationStatus:      info.MitigationStatus,
+		AnalystVerdict:        info.AnalystVerdict,
+		IncidentStatus:        info.IncidentStatus,
+		InitiatedBy:           info.InitiatedBy,
+		DetectionType:         info.DetectionType,
+		FilePath:              info.FilePath,
+		SHA1:                  info.SHA1,
+		SHA256:                info.SHA256,
+		MD5:                   info.MD5,
+		Storyline:             info.Storyline,
+		IsFileless:            info.IsFileless,
+		MitigatedPreemptively: info.MitigatedPreemptively,
+		IdentifiedAt:          info.IdentifiedAt,
+		APICreatedAt:          info.CreatedAt,
+		APIUpdatedAt:          info.UpdatedAt,
+		EnginesJSON:           nonNullJSON(info.Engines),
+		IndicatorsJSON:        nonNullJSON(t.Indicators),
+		MitigationActionsJSON: nonNullJSON(t.MitigationStatus),
+		CollectedAt:           collectedAt,
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// nonNullJSON drops JSON null so optional columns stay NULL instead of 'null'.
+func nonNullJSON(raw json.RawMessage) json.RawMessage {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	return raw
+}
@@ -0,0 +1,58 @@
+package threat
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestConvertThreat(t *testing.T) {
+	raw := `{
+		"id": "1700000000000000001",
+		"agentDetectionInfo": {"agentUuid": "uuid-1", "accountId": "acc-1", "siteId": "site-old", "siteName": "Old", "groupId": "grp-1"},
+		"agentRealtimeInfo": {"agentId": "agent-1", "agentComputerName": "web-01", "agentOsType": "linux", "siteId": "site-1", "siteName": "Prod"},
+		"threatInfo": {
+			"threatName": "xmrig",
+			"classification": "Miner",
+			"confidenceLevel": "malicious",
+			"mitigationStatus": "mitigated",
+			"sha1": "abc",
+			"engines": ["On-Write Static AI"],
+			"identifiedAt": "2024-05-01T10:00:00.123Z",
+			"updatedAt": "2024-05-02T08:30:00.456789Z"
+		},
+		"indicators": null,
+		"mitigationStatus": [{"action": "kill", "status": "success"}]
+	}`
+	var api APIThreat
+	if err := json.Unmarshal([]byte(raw), &api); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	collected := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	data := ConvertThreat(api, collected)
+
+	if data.ResourceID != "1700000000000000001" || data.AgentID != "agent-1" {
+		t.Errorf("ids = %q/%q", data.ResourceID, data.AgentID)
+	}
+	// Realtime placement wins; detection info fills the gaps.
+	if data.SiteID != "site-1" || data.SiteName != "Prod" {
+		t.Errorf("site = %q/%q, want realtime site", data.SiteID, data.SiteName)
+	}
+	if data.AccountID != "acc-1" || data.GroupID != "grp-1" || data.AgentUUID != "uuid-1" {
+		t.Errorf("fallback fields = %q/%q/%q", data.AccountID, data.GroupID, data.AgentUUID)
+	}
+	if data.MitigationStatus != "mitigated" || data.SHA1 != "abc" {
+		t.Errorf("threat info = %q/%q", data.MitigationStatus, data.SHA1)
+	}
+	want := time.Date(2024, 5, 2, 8, 30, 0, 456789000, time.UTC)
+	if data.APIUpdatedAt == nil || !data.APIUpdatedAt.Equal(want) {
+		t.Errorf("APIUpdatedAt = %v, want %v", data.APIUpdatedAt, want)
+	}
+	if data.IndicatorsJSON != nil {
+		t.Errorf("IndicatorsJSON = %s, want nil for JSON null", data.IndicatorsJSON)
+	}
+	if string(data.MitigationActionsJSON) != `[{"action": "kill", "status": "success"}]` {
+		t.Errorf("MitigationActionsJSON = %s", data.MitigationActionsJSON)
+	}
+}
@@ -0,0 +1,51 @@
+package threat
+
+import (
+	"bytes"
+
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+)
+
+// ThreatDiff represents changes between old and new threat states.
+type ThreatDiff struct {
+	IsNew     bool
+	IsChanged bool
+}
+
+// DiffThreatData compares old Ent entity and new data. API timestamps are
+// ignored so that a bare updatedAt bump does not create a history row.
+func DiffThreatData(old *ents1.BronzeS1Threat, new *ThreatData) *ThreatDiff {
+	if old == nil {
+		return &ThreatDiff{IsNew: true}
+	}
+
+	changed := old.AgentID != new.AgentID ||
+		old.AgentUUID != new.AgentUUID ||
+		old.AgentComputerName != new.AgentComputerName ||
+		old.AgentOsType != new.AgentOSType ||
+		old.AccountID != new.AccountID ||
+		old.SiteID != new.SiteID ||
+		old.SiteName != new.SiteName ||
+		old.GroupID != new.GroupID ||
+		old.ThreatName != new.ThreatName ||
+		old.Classification != new.Classification ||
+		old.ClassificationSource != new.ClassificationSource ||
+		old.ConfidenceLevel != new.ConfidenceLevel ||
+		old.MitigationStatus != new.MitigationStatus ||
+		old.AnalystVerdict != new.AnalystVerdict ||
+		old.IncidentStatus != new.IncidentStatus ||
+		old.InitiatedBy != new.InitiatedBy ||
+		old.DetectionType != new.DetectionType ||
+		old.FilePath != new.FilePath ||
+		old.Sha1 != new.SHA1 ||
+		old.Sha256 != new.SHA256 ||
+		old.Md5 != new.MD5 ||
+		old.Storyline != new.Storyline ||
+		old.IsFileless != new.IsFileless ||
+		old.MitigatedPreemptively != new.MitigatedPreemptively ||
+		!bytes.Equal(old.EnginesJSON, new.EnginesJSON) ||
+		!bytes.Equal(old.IndicatorsJSON, new.IndicatorsJSON) ||
+		!bytes.Equal(old.MitigationActionsJSON, new.MitigationActionsJSON)
+
+	return &ThreatDiff{IsChanged: changed}
+}
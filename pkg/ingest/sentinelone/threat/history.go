@@ -0,0 +1,113 @@
+package threat
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1threat"
+)
+
+// HistoryService handles history tracking for threats.
+type HistoryService struct {
+	entClient *ents1.Client
+}
+
+// NewHistoryService creates a new history service.
+func NewHistoryService(entClient *ents1.Client) *HistoryService {
+	return &HistoryService{entClient: entClient}
+}
+
+func (h *HistoryService) buildCreate(tx *ents1.Tx, data *ThreatData) *ents1.BronzeHistoryS1ThreatCreate {
+	create := tx.BronzeHistoryS1Threat.Create().
+		SetResourceID(data.ResourceID).
+		SetAgentID(data.AgentID).
+		SetAgentUUID(data.AgentUUID).
+		SetAgentComputerName(data.AgentComputerName).
+		SetAgentOsType(data.AgentOSType).
+		SetAccountID(data.AccountID).
+		SetSiteID(data.SiteID).
+		SetSiteName(data.SiteName).
+		SetGroupID(data.GroupID).
+		SetThreatName(data.ThreatName).
+		SetClassification(data.Classification).
+		SetClassificationSource(data.ClassificationSource).
+		SetConfidenceLevel(data.ConfidenceLevel).
+		SetMitigationStatus(data.MitigationStatus).
+		SetAnalystVerdict(data.AnalystVerdict).
+		SetIncidentStatus(data.IncidentStatus).
+		SetInitiatedBy(data.InitiatedBy).
+		SetDetectionType(data.DetectionType).
+		SetFilePath(data.FilePath).
+		SetSha1(data.SHA1).
+		SetSha256(data.SHA256).
+		SetMd5(data.MD5).
+		SetStoryline(data.Storyline).
+		SetIsFileless(data.IsFileless).
+		SetMitigatedPreemptively(data.MitigatedPreemptively)
+
+	if data.IdentifiedAt != nil {
+		create.SetIdentifiedAt(*data.IdentifiedAt)
+	}
+	if data.APICreatedAt != nil {
+		create.SetAPICreatedAt(*data.APICreatedAt)
+	}
+	if data.APIUpdatedAt != nil {
+		create.SetAPIUpdatedAt(*data.APIUpdatedAt)
+	}
+	if data.EnginesJSON != nil {
+		create.SetEnginesJSON(data.EnginesJSON)
+	}
+	if data.IndicatorsJSON != nil {
+		create.SetIndicatorsJSON(data.IndicatorsJSON)
+	}
+	if data.MitigationActionsJSON != nil {
+		create.SetMitigationActionsJSON(data.MitigationActionsJSON)
+	}
+
+	return create
+}
+
+// CreateHistory creates a history record for a new threat.
+func (h *HistoryService) CreateHistory(ctx context.Context, tx *ents1.Tx, data *ThreatData, now time.Time) error {
+	_, err := h.buildCreate(tx, data).
+		SetValidFrom(now).
+		SetCollectedAt(data.CollectedAt).
+		SetFirstCollectedAt(data.CollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create threat history: %w", err)
+	}
+	return nil
+}
+
+// UpdateHistory closes old history and creates new for a changed threat.
+func (h *HistoryService) UpdateHistory(ctx context.Context, tx *ents1.Tx, old *ents1.BronzeS1Threat, new *ThreatData, now time.Time) error {
+	currentHist, err := tx.BronzeHistoryS1Threat.Query().
+		Where(
+			bronzehistorys1threat.ResourceID(old.ID),
+			bronzehistorys1threat.ValidToIsNil(),
+		).
+		First(ctx)
+	if err != nil {
+		return fmt.Errorf("find current threat history: %w", err)
+	}
+
+	if err := tx.BronzeHistoryS1Threat.UpdateOne(currentHist).
+		SetValidTo(now).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("close threat history: %w", err)
+	}
+
+	_, err = h.buildCreate(tx, new).
+		SetValidFrom(now).
+		SetCollectedAt(new.CollectedAt).
+		SetFirstCollectedAt(old.FirstCollectedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create new threat history: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package threat
+
+import (
+	"danny.vn/hotpot/pkg/ingest"
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+)
+
+func init() {
+	ingest.RegisterService(ingest.ServiceRegistration{
+		Provider:  "sentinelone",
+		Name:      "threat",
+		Register:  Register,
+		Workflow:  S1ThreatWorkflow,
+		NewResult: func() any { return &S1ThreatWorkflowResult{} },
+		Aggregate: func(parent *sentinelone.S1InventoryWorkflowResult, child any) {
+			r := child.(*S1ThreatWorkflowResult)
+			parent.ThreatCount = r.ThreatCount
+		},
+	})
+}
@@ -0,0 +1,273 @@
+package threat
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"danny.vn/hotpot/pkg/ingest/sentinelone"
+	ents1 "danny.vn/hotpot/pkg/storage/ent/s1"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1threat"
+)
+
+// CursorName is the s1_ingest_cursors stream name for threats.
+const CursorName = "threats"
+
+// Service handles SentinelOne threat ingestion.
+type Service struct {
+	client    *Client
+	entClient *ents1.Client
+	history   *HistoryService
+}
+
+// NewService creates a new threat ingestion service.
+func NewService(client *Client, entClient *ents1.Client) *Service {
+	return &Service{
+		client:    client,
+		entClient: entClient,
+		history:   NewHistoryService(entClient),
+	}
+}
+
+// IngestResult contains the result of threat ingestion.
+type IngestResult struct {
+	ThreatCount    int
+	CollectedAt    time.Time
+	DurationMillis int64
+}
+
+// Ingest fetches threats updated since the persisted cursor and upserts them
+// batch by batch. Each batch commits together with the advanced cursor, so an
+// interrupted run resumes where the last committed batch ended. Threats are
+// never deleted as stale: an incremental fetch cannot tell a removed threat
+// from an unchanged one.
+func (s *Service) Ingest(ctx context.Context, heartbeat func()) (*IngestResult, error) {
+	startTime := time.Now()
+	collectedAt := startTime
+
+	since, err := sentinelone.LoadCursor(ctx, s.entClient, CursorName)
+	if err != nil {
+		return nil, err
+	}
+
+	total := 0
+	cursor := ""
+	batchNum := 0
+
+	for {
+		batchNum++
+		batch, err := s.client.GetThreatsBatch(since, cursor)
+		if err != nil {
+			slog.Error("s1 threats batch failed", "batch", batchNum, "totalSoFar", total, "error", err)
+			return nil, fmt.Errorf("get threats batch: %w", err)
+		}
+
+		threats := make([]*ThreatData, 0, len(batch.Threats))
+		for _, apiThreat := range batch.Threats {
+			threats = append(threats, ConvertThreat(apiThreat, collectedAt))
+		}
+
+		if err := s.saveThreats(ctx, threats); err != nil {
+			return nil, fmt.Errorf("save threats: %w", err)
+		}
+		total += len(threats)
+
+		slog.Info("s1 threats batch saved", "batch", batchNum, "batchItems", len(threats), "totalSaved", total, "since", since, "hasMore", batch.HasMore)
+
+		if heartbeat != nil {
+			heartbeat()
+		}
+
+		if !batch.HasMore {
+			break
+		}
+		cursor = batch.NextCursor
+	}
+
+	return &IngestResult{
+		ThreatCount:    total,
+		CollectedAt:    collectedAt,
+		DurationMillis: time.Since(startTime).Milliseconds(),
+	}, nil
+}
+
+func (s *Service) saveThreats(ctx context.Context, threats []*ThreatData) error {
+	if len(threats) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	var watermark time.Time
+
+	for _, data := range threats {
+		if data.APIUpdatedAt != nil && data.APIUpdatedAt.After(watermark) {
+			watermark = *data.APIUpdatedAt
+		}
+
+		existing, err := tx.BronzeS1Threat.Query().
+			Where(bronzes1threat.ID(data.ResourceID)).
+			First(ctx)
+		if err != nil && !ents1.IsNotFound(err) {
+			tx.Rollback()
+			return fmt.Errorf("load existing threat %s: %w", data.ResourceID, err)
+		}
+
+		diff := DiffThreatData(existing, data)
+
+		if !diff.IsNew && !diff.IsChanged {
+			update := tx.BronzeS1Threat.UpdateOneID(data.ResourceID).
+				SetCollectedAt(data.CollectedAt)
+			if data.APIUpdatedAt != nil {
+				update.SetAPIUpdatedAt(*data.APIUpdatedAt)
+			}
+			if err := update.Exec(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update collected_at for threat %s: %w", data.ResourceID, err)
+			}
+			continue
+		}
+
+		if existing == nil {
+			create := tx.BronzeS1Threat.Create().
+				SetID(data.ResourceID).
+				SetAgentID(data.AgentID).
+				SetAgentUUID(data.AgentUUID).
+				SetAgentComputerName(data.AgentComputerName).
+				SetAgentOsType(data.AgentOSType).
+				SetAccountID(data.AccountID).
+				SetSiteID(data.SiteID).
+				SetSiteName(data.SiteName).
+				SetGroupID(data.GroupID).
+				SetThreatName(data.ThreatName).
+				SetClassification(data.Classification).
+				SetClassificationSource(data.ClassificationSource).
+				SetConfidenceLevel(data.ConfidenceLevel).
+				SetMitigationStatus(data.MitigationStatus).
+				SetAnalystVerdict(data.AnalystVerdict).
+				SetIncidentStatus(data.IncidentStatus).
+				SetInitiatedBy(data.InitiatedBy).
+				SetDetectionType(data.DetectionType).
+				SetFilePath(data.FilePath).
+				SetSha1(data.SHA1).
+				SetSha256(data.SHA256).
+				SetMd5(data.MD5).
+				SetStoryline(data.Storyline).
+				SetIsFileless(data.IsFileless).
+				SetMitigatedPreemptively(data.MitigatedPreemptively).
+				SetCollectedAt(data.CollectedAt).
+				SetFirstCollectedAt(data.CollectedAt)
+
+			if data.IdentifiedAt != nil {
+				create.SetIdentifiedAt(*data.IdentifiedAt)
+			}
+			if data.APICreatedAt != nil {
+				create.SetAPICreatedAt(*data.APICreatedAt)
+			}
+			if data.APIUpdatedAt != nil {
+				create.SetAPIUpdatedAt(*data.APIUpdatedAt)
+			}
+			if data.EnginesJSON != nil {
+				create.SetEnginesJSON(data.EnginesJSON)
+			}
+			if data.IndicatorsJSON != nil {
+				create.SetIndicatorsJSON(data.IndicatorsJSON)
+			}
+			if data.MitigationActionsJSON != nil {
+				create.SetMitigationActionsJSON(data.MitigationActionsJSON)
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create threat %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.CreateHistory(ctx, tx, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("create history for threat %s: %w", data.ResourceID, err)
+			}
+		} else {
+			update := tx.BronzeS1Threat.UpdateOneID(data.ResourceID).
+				SetAgentID(data.AgentID).
+				SetAgentUUID(data.AgentUUID).
+				SetAgentComputerName(data.AgentComputerName).
+				SetAgentOsType(data.AgentOSType).
+				SetAccountID(data.AccountID).
+				SetSiteID(data.SiteID).
+				SetSiteName(data.SiteName).
+				SetGroupID(data.GroupID).
+				SetThreatName(data.ThreatName).
+				SetClassification(data.Classification).
+				SetClassificationSource(data.ClassificationSource).
+				SetConfidenceLevel(data.ConfidenceLevel).
+				SetMitigationStatus(data.MitigationStatus).
+				SetAnalystVerdict(data.AnalystVerdict).
+				SetIncidentStatus(data.IncidentStatus).
+				SetInitiatedBy(data.InitiatedBy).
+				SetDetectionType(data.DetectionType).
+				SetFilePath(data.FilePath).
+				SetSha1(data.SHA1).
+				SetSha256(data.SHA256).
+				SetMd5(data.MD5).
+				SetStoryline(data.Storyline).
+				SetIsFileless(data.IsFileless).
+				SetMitigatedPreemptively(data.MitigatedPreemptively).
+				SetCollectedAt(data.CollectedAt)
+
+			if data.IdentifiedAt != nil {
+				update.SetIdentifiedAt(*data.IdentifiedAt)
+			}
+			if data.APICreatedAt != nil {
+				update.SetAPICreatedAt(*data.APICreatedAt)
+			}
+			if data.APIUpdatedAt != nil {
+				update.SetAPIUpdatedAt(*data.APIUpdatedAt)
+			}
+			if data.EnginesJSON != nil {
+				update.SetEnginesJSON(data.EnginesJSON)
+			}
+			if data.IndicatorsJSON != nil {
+				update.SetIndicatorsJSON(data.IndicatorsJSON)
+			}
+			if data.MitigationActionsJSON != nil {
+				update.SetMitigationActionsJSON(data.MitigationActionsJSON)
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update threat %s: %w", data.ResourceID, err)
+			}
+
+			if err := s.history.UpdateHistory(ctx, tx, existing, data, now); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("update history for threat %s: %w", data.ResourceID, err)
+			}
+		}
+	}
+
+	if !watermark.IsZero() {
+		if err := sentinelone.SaveCursor(ctx, tx.Client(), CursorName, watermark); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -22,6 +22,13 @@ type S1InventoryWorkflowResult struct {
 	NetworkDiscoveryCount int
 	AppInventoryCount     int
 	EndpointAppCount      int
+	AppCVECount           int
+	ThreatCount           int
+	AlertCount            int
+	ActivityCount         int
+	PolicyCount           int
+	ExclusionCount        int
+	RestrictionCount      int
 }
 
 // aggregateFunc is the function signature for merging a service result into the provider result.
@@ -68,6 +75,13 @@ func S1InventoryWorkflow(ctx workflow.Context) (*S1InventoryWorkflowResult, erro
 		"networkDiscoveries", result.NetworkDiscoveryCount,
 		"appInventory", result.AppInventoryCount,
 		"endpointApps", result.EndpointAppCount,
+		"appCVEs", result.AppCVECount,
+		"threats", result.ThreatCount,
+		"alerts", result.AlertCount,
+		"activities", result.ActivityCount,
+		"policies", result.PolicyCount,
+		"exclusions", result.ExclusionCount,
+		"restrictions", result.RestrictionCount,
 	)
 
 	if len(failedServices) > 0 {
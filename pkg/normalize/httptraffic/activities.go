@@ -26,6 +26,9 @@ type Activities struct {
 
 	cachedMatcher   *PathMatcher
 	cachedMatcherAt time.Time
+
+	cachedThreats   *ThreatMatcher
+	cachedThreatsAt time.Time
 }
 
 // NewActivities creates an Activities instance.
@@ -56,6 +59,21 @@ func (a *Activities) getPathMatcher(ctx context.Context) (*PathMatcher, error) {
 	return a.cachedMatcher, nil
 }
 
+// getThreatMatcher returns a cached ThreatMatcher, rebuilding it if the cache
+// is older than 5 minutes.
+func (a *Activities) getThreatMatcher(ctx context.Context) (*ThreatMatcher, error) {
+	if a.cachedThreats != nil && time.Since(a.cachedThreatsAt) < pathMatcherCacheTTL {
+		return a.cachedThreats, nil
+	}
+	indicators, err := loadThreatIndicators(ctx, a.db)
+	if err != nil {
+		return nil, err
+	}
+	a.cachedThreats = NewThreatMatcher(indicators)
+	a.cachedThreatsAt = time.Now()
+	return a.cachedThreats, nil
+}
+
 // Activity function references for Temporal registration.
 var NormalizeTrafficActivity = (*Activities).NormalizeTraffic
 
@@ -124,7 +142,7 @@ func (a *Activities) NormalizeTraffic(ctx context.Context, params NormalizeTraff
 	var mapped, unmapped int
 
 	for _, row := range bronzeRows {
-		ep := pm.Match(row.uri)
+		ep := pm.MatchMethod(row.uri, row.method)
 
 		resourceID := fmt.Sprintf("%s:%s:%s:%s:%d",
 			row.sourceID,
@@ -235,6 +253,9 @@ type trafficKey struct {
 	method      string
 }
 
+// loadEndpoints loads the active catalog endpoints. Discovered candidates are
+// left out until reviewed: their traffic stays unmapped, which is what the
+// traffic provider clusters them from.
 func loadEndpoints(ctx context.Context, db *sql.DB) ([]MatchEndpoint, error) {
 	rows, err := db.QueryContext(ctx, `
 		SELECT resource_id, uri_pattern,
@@ -242,7 +263,7 @@ func loadEndpoints(ctx context.Context, db *sql.DB) ([]MatchEndpoint, error) {
 			COALESCE(service, ''),
 			COALESCE(access_level, '')
 		FROM silver.inventory_api_endpoints
-		WHERE is_active = true`)
+		WHERE is_active = true AND is_discovered = false`)
 	if err != nil {
 		return nil, fmt.Errorf("query inventory_api_endpoints: %w", err)
 	}
@@ -15,13 +15,15 @@ var NormalizeClientIPsActivity = (*Activities).NormalizeClientIPs
 
 // NormalizeClientIPsResult holds normalization statistics.
 type NormalizeClientIPsResult struct {
-	Processed int
-	Mapped    int
-	GeoHits   int
-	ASNHits   int
+	Processed  int
+	Mapped     int
+	GeoHits    int
+	ASNHits    int
+	ThreatHits int
 }
 
-// NormalizeClientIPs reads bronze client IP data, enriches with endpoint match + GeoIP/ASN, writes to silver.
+// NormalizeClientIPs reads bronze client IP data, enriches with endpoint match + GeoIP/ASN
+// + threat intel feed matches, writes to silver.
 func (a *Activities) NormalizeClientIPs(ctx context.Context, params NormalizeTrafficParams) (*NormalizeClientIPsResult, error) {
 	logger := activity.GetLogger(ctx)
 	logger.Info("Normalizing client IPs")
@@ -34,6 +36,12 @@ func (a *Activities) NormalizeClientIPs(ctx context.Context, params NormalizeTra
 		return nil, err
 	}
 
+	// Load unexpired threat intel indicators.
+	tm, err := a.getThreatMatcher(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Reload GeoIP files to pick up any updates.
 	a.geoip.Reload()
 
@@ -50,7 +58,7 @@ func (a *Activities) NormalizeClientIPs(ctx context.Context, params NormalizeTra
 	defer rows.Close()
 
 	now := time.Now()
-	var processed, mapped, geoHits, asnHits int
+	var processed, mapped, geoHits, asnHits, threatHits int
 
 	for rows.Next() {
 		var sourceID, uri, method, clientIP string
@@ -61,8 +69,9 @@ func (a *Activities) NormalizeClientIPs(ctx context.Context, params NormalizeTra
 			return nil, fmt.Errorf("scan bronze client IP: %w", err)
 		}
 
-		ep := pm.Match(uri)
+		ep := pm.MatchMethod(uri, method)
 		geo := a.geoip.LookupIP(clientIP)
+		threat := tm.Match(clientIP)
 
 		resourceID := fmt.Sprintf("%s:%s:%s:%s:%s",
 			sourceID, windowStart.Format(time.RFC3339),
@@ -97,6 +106,12 @@ func (a *Activities) NormalizeClientIPs(ctx context.Context, params NormalizeTra
 			}
 			asnHits++
 		}
+		if threat != nil {
+			create.SetIsThreatListed(true).
+				SetThreatFeeds(threat.Feeds).
+				SetThreatCategories(threat.Categories)
+			threatHits++
+		}
 		if ep != nil {
 			create.SetEndpointID(ep.ID).SetIsMapped(true)
 			mapped++
@@ -115,9 +130,10 @@ func (a *Activities) NormalizeClientIPs(ctx context.Context, params NormalizeTra
 
 	logger.Info("Client IP normalization complete",
 		"processed", processed, "mapped", mapped,
-		"geoHits", geoHits, "asnHits", asnHits)
+		"geoHits", geoHits, "asnHits", asnHits, "threatHits", threatHits)
 	return &NormalizeClientIPsResult{
 		Processed: processed, Mapped: mapped,
 		GeoHits: geoHits, ASNHits: asnHits,
+		ThreatHits: threatHits,
 	}, nil
 }
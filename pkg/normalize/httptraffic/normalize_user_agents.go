@@ -58,7 +58,7 @@ func (a *Activities) NormalizeUserAgents(ctx context.Context, params NormalizeTr
 			return nil, fmt.Errorf("scan bronze user agent: %w", err)
 		}
 
-		ep := pm.Match(uri)
+		ep := pm.MatchMethod(uri, method)
 		uaFamily := ParseUAFamily(userAgent)
 		uaHash := sha256Short(userAgent)
 		resourceID := fmt.Sprintf("%s:%s:%s:%s:%s",
@@ -1,6 +1,9 @@
 package httptraffic
 
-import "strings"
+import (
+	"regexp"
+	"strings"
+)
 
 // MatchEndpoint holds the data needed for path matching.
 type MatchEndpoint struct {
@@ -13,89 +16,178 @@ type MatchEndpoint struct {
 
 // trieNode is a node in the segment-based path trie.
 type trieNode struct {
-	children map[string]*trieNode
-	wildcard *trieNode // "*" single-segment wildcard
-	catchAll bool      // trailing "*" catch-all
-	endpoint *MatchEndpoint
+	children  map[string]*trieNode
+	patterns  []*patternChild  // segments mixing text and {param}, e.g. "{id}.json"
+	wildcard  *trieNode        // "*" or "{param}" single-segment wildcard
+	catchAll  []*MatchEndpoint // trailing "*" catch-all
+	endpoints []*MatchEndpoint // endpoints ending at this node, in registration order
 }
 
-// PathMatcher uses a segment-based trie for URI matching.
+// patternChild is a child reached by a segment matching a partial template.
+type patternChild struct {
+	re   *regexp.Regexp
+	node *trieNode
+}
+
+func newTrieNode() *trieNode {
+	return &trieNode{children: make(map[string]*trieNode)}
+}
+
+// PathMatcher uses a segment-based trie for URI matching. Patterns may use
+// "*" for one segment, a trailing "*" for any remainder, and OpenAPI path
+// templates: "{id}" matches one segment and "{id}.json" matches a segment
+// with that shape.
 type PathMatcher struct {
 	root *trieNode
 }
 
 // NewPathMatcher builds a trie from the given endpoints.
 func NewPathMatcher(endpoints []MatchEndpoint) *PathMatcher {
-	root := &trieNode{children: make(map[string]*trieNode)}
+	root := newTrieNode()
 
 	for i := range endpoints {
 		ep := &endpoints[i]
 		segments := splitPath(ep.URIPattern)
 
 		node := root
+		catchAll := false
 		for j, seg := range segments {
 			if seg == "*" && j == len(segments)-1 {
 				// Trailing wildcard: catch-all.
-				node.catchAll = true
-				node.endpoint = ep
+				node.catchAll = append(node.catchAll, ep)
+				catchAll = true
 				break
 			}
-			if seg == "*" {
+			switch {
+			case seg == "*" || isParam(seg):
 				// Single-segment wildcard.
 				if node.wildcard == nil {
-					node.wildcard = &trieNode{children: make(map[string]*trieNode)}
+					node.wildcard = newTrieNode()
 				}
 				node = node.wildcard
-			} else {
+			case strings.Contains(seg, "{"):
+				node = node.patternChild(seg)
+			default:
 				child, ok := node.children[seg]
 				if !ok {
-					child = &trieNode{children: make(map[string]*trieNode)}
+					child = newTrieNode()
 					node.children[seg] = child
 				}
 				node = child
 			}
 		}
-		if node.endpoint == nil {
-			node.endpoint = ep
+		if !catchAll {
+			node.endpoints = append(node.endpoints, ep)
 		}
 	}
 
 	return &PathMatcher{root: root}
 }
 
+// isParam reports whether seg is a whole-segment template such as "{id}".
+func isParam(seg string) bool {
+	return len(seg) > 2 && seg[0] == '{' && seg[len(seg)-1] == '}' &&
+		strings.Count(seg, "{") == 1 && strings.Count(seg, "}") == 1
+}
+
+// patternChild returns the child for a partial template segment, compiling
+// each {param} to a non-empty wildcard and quoting the text around it.
+func (n *trieNode) patternChild(seg string) *trieNode {
+	var expr strings.Builder
+	expr.WriteString("^")
+	for rest := seg; rest != ""; {
+		open := strings.Index(rest, "{")
+		end := strings.Index(rest, "}")
+		if open < 0 || end < open {
+			expr.WriteString(regexp.QuoteMeta(rest))
+			break
+		}
+		expr.WriteString(regexp.QuoteMeta(rest[:open]))
+		expr.WriteString("(.+?)")
+		rest = rest[end+1:]
+	}
+	expr.WriteString("$")
+
+	for _, p := range n.patterns {
+		if p.re.String() == expr.String() {
+			return p.node
+		}
+	}
+	child := &patternChild{re: regexp.MustCompile(expr.String()), node: newTrieNode()}
+	n.patterns = append(n.patterns, child)
+	return child.node
+}
+
 // Match finds the best-matching endpoint for the given URI.
 // Returns nil if no match found.
 func (pm *PathMatcher) Match(uri string) *MatchEndpoint {
 	segments := splitPath(uri)
-	return pm.matchNode(pm.root, segments, 0)
+	return pm.matchNode(pm.root, segments, 0, func(*MatchEndpoint) bool { return true })
 }
 
-func (pm *PathMatcher) matchNode(node *trieNode, segments []string, depth int) *MatchEndpoint {
+// MatchMethod finds the best-matching endpoint for the given URI that allows
+// method. Endpoints without methods allow any. When no matching endpoint
+// allows the method, it falls back to Match so the caller can flag the
+// method mismatch.
+func (pm *PathMatcher) MatchMethod(uri, method string) *MatchEndpoint {
+	if method != "" {
+		segments := splitPath(uri)
+		ep := pm.matchNode(pm.root, segments, 0, func(ep *MatchEndpoint) bool {
+			return len(ep.Methods) == 0 || methodAllowed(method, ep.Methods)
+		})
+		if ep != nil {
+			return ep
+		}
+	}
+	return pm.Match(uri)
+}
+
+// matchNode returns the first accepted endpoint, trying exact segments, then
+// partial templates, then wildcards, then catch-alls.
+func (pm *PathMatcher) matchNode(node *trieNode, segments []string, depth int, accept func(*MatchEndpoint) bool) *MatchEndpoint {
 	if depth == len(segments) {
-		return node.endpoint
+		if ep := firstAccepted(node.endpoints, accept); ep != nil {
+			return ep
+		}
+		// A catch-all also matches its own prefix.
+		return firstAccepted(node.catchAll, accept)
 	}
 
 	seg := segments[depth]
 
 	// 1. Try exact match first.
 	if child, ok := node.children[seg]; ok {
-		if result := pm.matchNode(child, segments, depth+1); result != nil {
+		if result := pm.matchNode(child, segments, depth+1, accept); result != nil {
 			return result
 		}
 	}
 
-	// 2. Try wildcard match.
+	// 2. Try partial templates.
+	for _, p := range node.patterns {
+		if p.re.MatchString(seg) {
+			if result := pm.matchNode(p.node, segments, depth+1, accept); result != nil {
+				return result
+			}
+		}
+	}
+
+	// 3. Try wildcard match.
 	if node.wildcard != nil {
-		if result := pm.matchNode(node.wildcard, segments, depth+1); result != nil {
+		if result := pm.matchNode(node.wildcard, segments, depth+1, accept); result != nil {
 			return result
 		}
 	}
 
-	// 3. Try catch-all.
-	if node.catchAll && node.endpoint != nil {
-		return node.endpoint
-	}
+	// 4. Try catch-all.
+	return firstAccepted(node.catchAll, accept)
+}
 
+func firstAccepted(endpoints []*MatchEndpoint, accept func(*MatchEndpoint) bool) *MatchEndpoint {
+	for _, ep := range endpoints {
+		if accept(ep) {
+			return ep
+		}
+	}
 	return nil
 }
 
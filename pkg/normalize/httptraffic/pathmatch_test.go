@@ -219,7 +219,7 @@ func TestPathMatcherEmptySegment(t *testing.T) {
 
 func TestPathMatcherDuplicateRegistration(t *testing.T) {
 	// Register two endpoints with the same pattern. The first one wins because
-	// NewPathMatcher only sets node.endpoint when it is nil.
+	// endpoints on a node are tried in registration order.
 	endpoints := []MatchEndpoint{
 		{ID: "first", URIPattern: "/api/users"},
 		{ID: "second", URIPattern: "/api/users"},
@@ -231,3 +231,69 @@ func TestPathMatcherDuplicateRegistration(t *testing.T) {
 		t.Errorf("Match(%q) = %v, want endpoint 'first' (first registration wins)", "/api/users", ep)
 	}
 }
+
+func TestPathMatcherTemplates(t *testing.T) {
+	endpoints := []MatchEndpoint{
+		{ID: "user", URIPattern: "/v1/users/{id}"},
+		{ID: "me", URIPattern: "/v1/users/me"},
+		{ID: "export", URIPattern: "/v1/users/{id}.json"},
+		{ID: "item", URIPattern: "/v1/orders/{orderId}/items/{itemId}"},
+		{ID: "range", URIPattern: "/v1/reports/{from}..{to}"},
+	}
+	pm := NewPathMatcher(endpoints)
+
+	tests := []struct {
+		uri    string
+		wantID string
+	}{
+		{"/v1/users/42", "user"},
+		{"/v1/users/me", "me"},
+		{"/v1/users/42.json", "export"},
+		{"/v1/users/.json", "user"},
+		{"/v1/orders/7/items/9", "item"},
+		{"/v1/orders/7/items", ""},
+		{"/v1/reports/2024..2025", "range"},
+		{"/v1/reports/2024", ""},
+		{"/v1/users/42/extra", ""},
+	}
+	for _, tc := range tests {
+		ep := pm.Match(tc.uri)
+		if tc.wantID == "" {
+			if ep != nil {
+				t.Errorf("Match(%q) = %v, want nil", tc.uri, ep)
+			}
+		} else if ep == nil || ep.ID != tc.wantID {
+			t.Errorf("Match(%q) = %v, want endpoint %s", tc.uri, ep, tc.wantID)
+		}
+	}
+}
+
+func TestPathMatcherMatchMethod(t *testing.T) {
+	// OpenAPI imports register one endpoint per method on the same pattern.
+	endpoints := []MatchEndpoint{
+		{ID: "get-me", URIPattern: "/users/me", Methods: []string{"GET"}},
+		{ID: "get-user", URIPattern: "/users/{id}", Methods: []string{"GET"}},
+		{ID: "delete-user", URIPattern: "/users/{id}", Methods: []string{"DELETE"}},
+		{ID: "any", URIPattern: "/files/*"},
+	}
+	pm := NewPathMatcher(endpoints)
+
+	tests := []struct {
+		uri, method string
+		wantID      string
+	}{
+		{"/users/1", "GET", "get-user"},
+		{"/users/1", "delete", "delete-user"},
+		{"/users/me", "GET", "get-me"},
+		{"/users/me", "DELETE", "delete-user"},
+		{"/users/1", "POST", "get-user"}, // no method matches: first endpoint, flagged as a mismatch
+		{"/users/1", "", "get-user"},
+		{"/files/a/b", "PUT", "any"},
+	}
+	for _, tc := range tests {
+		ep := pm.MatchMethod(tc.uri, tc.method)
+		if ep == nil || ep.ID != tc.wantID {
+			t.Errorf("MatchMethod(%q, %q) = %v, want endpoint %s", tc.uri, tc.method, ep, tc.wantID)
+		}
+	}
+}
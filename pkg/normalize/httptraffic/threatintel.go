@@ -0,0 +1,133 @@
+package httptraffic
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/netip"
+	"slices"
+	"sort"
+)
+
+// ThreatMatch lists the feeds and categories that list an IP.
+type ThreatMatch struct {
+	Feeds      []string
+	Categories []string
+}
+
+// threatEntry is one listed prefix.
+type threatEntry struct {
+	feed     string
+	category string
+}
+
+// ThreatMatcher looks up client IPs in threat intel indicators. Prefixes are
+// indexed by length, so a lookup masks the IP once per distinct length.
+type ThreatMatcher struct {
+	byBits map[int]map[netip.Prefix][]threatEntry
+	bits   []int
+}
+
+// ThreatIndicator is a listed prefix and the feed that lists it.
+type ThreatIndicator struct {
+	Feed     string
+	Category string
+	CIDR     string
+}
+
+// NewThreatMatcher builds a matcher from indicators. Unparsable CIDRs are
+// ignored.
+func NewThreatMatcher(indicators []ThreatIndicator) *ThreatMatcher {
+	m := &ThreatMatcher{byBits: make(map[int]map[netip.Prefix][]threatEntry)}
+	for _, ind := range indicators {
+		prefix, err := netip.ParsePrefix(ind.CIDR)
+		if err != nil {
+			continue
+		}
+		prefix = prefix.Masked()
+		// IPv6 lengths are offset so they never share a bucket with IPv4.
+		bits := prefix.Bits()
+		if prefix.Addr().Is6() {
+			bits += 33
+		}
+		if m.byBits[bits] == nil {
+			m.byBits[bits] = make(map[netip.Prefix][]threatEntry)
+			m.bits = append(m.bits, bits)
+		}
+		m.byBits[bits][prefix] = append(m.byBits[bits][prefix], threatEntry{feed: ind.Feed, category: ind.Category})
+	}
+	sort.Ints(m.bits)
+	return m
+}
+
+// Match returns the feeds and categories listing ip, sorted, or nil if it
+// is not listed or not an IP.
+func (m *ThreatMatcher) Match(ip string) *ThreatMatch {
+	if m == nil || len(m.bits) == 0 {
+		return nil
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return nil
+	}
+	addr = addr.Unmap().WithZone("")
+
+	var match *ThreatMatch
+	for _, bits := range m.bits {
+		plen := bits
+		if addr.Is6() {
+			plen -= 33
+			if plen < 0 {
+				continue
+			}
+		} else if bits > 32 {
+			break
+		}
+		prefix, err := addr.Prefix(plen)
+		if err != nil {
+			continue
+		}
+		for _, e := range m.byBits[bits][prefix] {
+			if match == nil {
+				match = &ThreatMatch{}
+			}
+			if !slices.Contains(match.Feeds, e.feed) {
+				match.Feeds = append(match.Feeds, e.feed)
+			}
+			if !slices.Contains(match.Categories, e.category) {
+				match.Categories = append(match.Categories, e.category)
+			}
+		}
+	}
+	if match != nil {
+		sort.Strings(match.Feeds)
+		sort.Strings(match.Categories)
+	}
+	return match
+}
+
+// loadThreatIndicators reads unexpired indicators from
+// bronze.reference_threat_intel_indicators.
+func loadThreatIndicators(ctx context.Context, db *sql.DB) ([]ThreatIndicator, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT feed_name, category, cidr
+		FROM bronze.reference_threat_intel_indicators
+		WHERE expires_at > now()`)
+	if err != nil {
+		return nil, fmt.Errorf("query threat intel indicators: %w", err)
+	}
+	defer rows.Close()
+
+	var indicators []ThreatIndicator
+	for rows.Next() {
+		var ind ThreatIndicator
+		if err := rows.Scan(&ind.Feed, &ind.Category, &ind.CIDR); err != nil {
+			return nil, fmt.Errorf("scan threat intel indicator: %w", err)
+		}
+		indicators = append(indicators, ind)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate threat intel indicators: %w", err)
+	}
+	return indicators, nil
+}
@@ -0,0 +1,42 @@
+package httptraffic
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestThreatMatcher(t *testing.T) {
+	tm := NewThreatMatcher([]ThreatIndicator{
+		{Feed: "tor-exits", Category: "tor", CIDR: "198.51.100.7/32"},
+		{Feed: "spamhaus-drop", Category: "blocklist", CIDR: "198.51.100.0/24"},
+		{Feed: "firehol", Category: "blocklist", CIDR: "198.51.0.0/16"},
+		{Feed: "scanners", Category: "scanner", CIDR: "2001:db8::/32"},
+		{Feed: "all-v6", Category: "blocklist", CIDR: "::/0"},
+		{Feed: "broken", Category: "blocklist", CIDR: "not-a-cidr"},
+	})
+
+	tests := []struct {
+		ip   string
+		want *ThreatMatch
+	}{
+		{"198.51.100.7", &ThreatMatch{
+			Feeds:      []string{"firehol", "spamhaus-drop", "tor-exits"},
+			Categories: []string{"blocklist", "tor"},
+		}},
+		{"198.51.100.8", &ThreatMatch{Feeds: []string{"firehol", "spamhaus-drop"}, Categories: []string{"blocklist"}}},
+		{"::ffff:198.51.7.1", &ThreatMatch{Feeds: []string{"firehol"}, Categories: []string{"blocklist"}}},
+		{"2001:db8::1", &ThreatMatch{Feeds: []string{"all-v6", "scanners"}, Categories: []string{"blocklist", "scanner"}}},
+		{"2001:db9::1", &ThreatMatch{Feeds: []string{"all-v6"}, Categories: []string{"blocklist"}}},
+		{"203.0.113.1", nil},
+		{"unknown", nil},
+	}
+	for _, tt := range tests {
+		if got := tm.Match(tt.ip); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Match(%q) = %+v, want %+v", tt.ip, got, tt.want)
+		}
+	}
+
+	if got := NewThreatMatcher(nil).Match("198.51.100.7"); got != nil {
+		t.Errorf("empty matcher: got %+v", got)
+	}
+}
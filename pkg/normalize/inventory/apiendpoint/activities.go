@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -62,6 +63,7 @@ func (a *Activities) NormalizeApiEndpoints(ctx context.Context) (*NormalizeApiEn
 		}
 		allRecords = append(allRecords, records...)
 	}
+	allRecords = dropCataloged(allRecords)
 
 	// Load existing endpoints with bronze links for stable ID matching.
 	existingEndpoints, err := a.entClient.InventoryApiEndpoint.Query().
@@ -102,6 +104,7 @@ func (a *Activities) NormalizeApiEndpoints(ctx context.Context) (*NormalizeApiEn
 				SetMethods(rec.Methods).
 				SetIsActive(rec.IsActive).
 				SetNillableAccessLevel(nilIfEmpty(rec.AccessLevel)).
+				SetIsDiscovered(rec.Discovered).
 				SetCollectedAt(rec.CollectedAt).
 				SetNormalizedAt(now).
 				Save(ctx)
@@ -127,6 +130,7 @@ func (a *Activities) NormalizeApiEndpoints(ctx context.Context) (*NormalizeApiEn
 				SetMethods(rec.Methods).
 				SetIsActive(rec.IsActive).
 				SetNillableAccessLevel(nilIfEmpty(rec.AccessLevel)).
+				SetIsDiscovered(rec.Discovered).
 				SetCollectedAt(rec.CollectedAt).
 				SetFirstCollectedAt(rec.FirstCollectedAt).
 				SetNormalizedAt(now).
@@ -187,6 +191,37 @@ func (a *Activities) NormalizeApiEndpoints(ctx context.Context) (*NormalizeApiEn
 	}, nil
 }
 
+// dropCataloged removes discovered candidates whose URI pattern is already in
+// the reviewed catalog, so only new endpoints are left for review.
+func dropCataloged(records []NormalizedApiEndpoint) []NormalizedApiEndpoint {
+	cataloged := make(map[string]bool)
+	for _, rec := range records {
+		if !rec.Discovered {
+			cataloged[patternKey(rec.URIPattern)] = true
+		}
+	}
+	kept := records[:0]
+	for _, rec := range records {
+		if rec.Discovered && cataloged[patternKey(rec.URIPattern)] {
+			continue
+		}
+		kept = append(kept, rec)
+	}
+	return kept
+}
+
+// patternKey canonicalizes a URI pattern for comparison: parameter and
+// single-segment wildcards compare equal whatever their names.
+func patternKey(pattern string) string {
+	segments := strings.Split(strings.Trim(pattern, "/"), "/")
+	for i, seg := range segments {
+		if (seg == "*" && i < len(segments)-1) || (strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}")) {
+			segments[i] = "{}"
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
 func nilIfEmpty(s string) *string {
 	if s == "" {
 		return nil
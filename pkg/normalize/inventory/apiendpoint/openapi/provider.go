@@ -0,0 +1,66 @@
+package openapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"danny.vn/hotpot/pkg/normalize/inventory/apiendpoint"
+)
+
+const (
+	key         = "openapi"
+	bronzeTable = "apicatalog_openapi_endpoints"
+)
+
+// Provider normalizes bronze.apicatalog_openapi_endpoints into NormalizedApiEndpoint records.
+// Each bronze row is one operation, so every endpoint carries a single method.
+type Provider struct{}
+
+func (Provider) Key() string { return key }
+
+func (Provider) Load(ctx context.Context, db *sql.DB) ([]apiendpoint.NormalizedApiEndpoint, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT resource_id, spec_name, uri, method,
+			COALESCE(NULLIF(operation_id, ''), summary, ''),
+			access_level,
+			collected_at, first_collected_at
+		FROM bronze.apicatalog_openapi_endpoints`)
+	if err != nil {
+		return nil, fmt.Errorf("query apicatalog_openapi_endpoints: %w", err)
+	}
+	defer rows.Close()
+
+	var result []apiendpoint.NormalizedApiEndpoint
+	for rows.Next() {
+		var (
+			resourceID, specName, uri, method string
+			name, accessLevel                 string
+			collectedAt, firstCollectedAt     sql.NullTime
+		)
+		if err := rows.Scan(&resourceID, &specName, &uri, &method,
+			&name, &accessLevel,
+			&collectedAt, &firstCollectedAt); err != nil {
+			return nil, fmt.Errorf("scan openapi endpoint row: %w", err)
+		}
+
+		result = append(result, apiendpoint.NormalizedApiEndpoint{
+			BronzeResourceID: resourceID,
+			Name:             name,
+			Service:          specName,
+			URIPattern:       uri,
+			Methods:          []string{method},
+			IsActive:         true,
+			AccessLevel:      accessLevel,
+			Provider:         key,
+			BronzeTable:      bronzeTable,
+			CollectedAt:      collectedAt.Time,
+			FirstCollectedAt: firstCollectedAt.Time,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate openapi endpoint rows: %w", err)
+	}
+
+	return result, nil
+}
@@ -15,6 +15,7 @@ type NormalizedApiEndpoint struct {
 	Methods          []string
 	IsActive         bool
 	AccessLevel      string
+	Discovered       bool // candidate from routing or traffic, pending review
 	Provider         string
 	BronzeTable      string
 	CollectedAt      time.Time
@@ -0,0 +1,222 @@
+package routing
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"danny.vn/hotpot/pkg/normalize/inventory/apiendpoint"
+)
+
+const (
+	key = "routing"
+
+	tableURLMaps   = "gcp_compute_url_maps"
+	tableRun       = "gcp_run_services"
+	tableListeners = "greennode_loadbalancer_listeners"
+
+	// Cloud Run IngressTraffic enum values (run/apiv2/runpb).
+	runIngressInternalOnly         = 2
+	runIngressInternalLoadBalancer = 3
+	runIngressNone                 = 4
+)
+
+// Provider derives candidate endpoints from cloud routing configuration: the
+// path and route rules of GCP URL maps served by a target HTTP(S) proxy, and
+// the entry points of Cloud Run services and GreenNode HTTP(S) load balancer
+// listeners, with their L7 policy path rules when known. Every candidate is
+// flagged as discovered.
+type Provider struct{}
+
+func (Provider) Key() string { return key }
+
+func (Provider) Load(ctx context.Context, db *sql.DB) ([]apiendpoint.NormalizedApiEndpoint, error) {
+	var result []apiendpoint.NormalizedApiEndpoint
+	for _, load := range []func(context.Context, *sql.DB) ([]apiendpoint.NormalizedApiEndpoint, error){
+		loadURLMaps,
+		loadRunServices,
+		loadListeners,
+	} {
+		records, err := load(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, records...)
+	}
+	return result, nil
+}
+
+// loadURLMaps loads the URL maps attached to a target HTTP or HTTPS proxy.
+func loadURLMaps(ctx context.Context, db *sql.DB) ([]apiendpoint.NormalizedApiEndpoint, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT u.resource_id, u.name,
+			COALESCE(u.host_rules_json, '[]'),
+			COALESCE(u.path_matchers_json, '[]'),
+			u.collected_at, u.first_collected_at
+		FROM bronze.gcp_compute_url_maps u
+		WHERE u.self_link IN (
+			SELECT url_map FROM bronze.gcp_compute_target_http_proxies
+			UNION
+			SELECT url_map FROM bronze.gcp_compute_target_https_proxies
+		)`)
+	if err != nil {
+		return nil, fmt.Errorf("query gcp_compute_url_maps: %w", err)
+	}
+	defer rows.Close()
+
+	var result []apiendpoint.NormalizedApiEndpoint
+	for rows.Next() {
+		var (
+			resourceID, name              string
+			hostRules, pathMatchers       []byte
+			collectedAt, firstCollectedAt sql.NullTime
+		)
+		if err := rows.Scan(&resourceID, &name, &hostRules, &pathMatchers,
+			&collectedAt, &firstCollectedAt); err != nil {
+			return nil, fmt.Errorf("scan url map: %w", err)
+		}
+
+		for _, r := range urlMapRoutes(hostRules, pathMatchers) {
+			result = append(result, apiendpoint.NormalizedApiEndpoint{
+				BronzeResourceID: resourceID + ":" + r.pattern,
+				Name:             name,
+				Service:          r.service,
+				URIPattern:       r.pattern,
+				IsActive:         true,
+				Discovered:       true,
+				Provider:         key,
+				BronzeTable:      tableURLMaps,
+				CollectedAt:      collectedAt.Time,
+				FirstCollectedAt: firstCollectedAt.Time,
+			})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate url map rows: %w", err)
+	}
+
+	return result, nil
+}
+
+// loadRunServices loads one entry-point candidate per Cloud Run service that
+// accepts traffic. Services restricted to internal ingress are private.
+func loadRunServices(ctx context.Context, db *sql.DB) ([]apiendpoint.NormalizedApiEndpoint, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT resource_id, name, COALESCE(uri, ''), COALESCE(ingress, 0),
+			collected_at, first_collected_at
+		FROM bronze.gcp_run_services
+		WHERE COALESCE(delete_time, '') = '' AND COALESCE(ingress, 0) <> $1`, runIngressNone)
+	if err != nil {
+		return nil, fmt.Errorf("query gcp_run_services: %w", err)
+	}
+	defer rows.Close()
+
+	var result []apiendpoint.NormalizedApiEndpoint
+	for rows.Next() {
+		var (
+			resourceID, name, uri         string
+			ingress                       int
+			collectedAt, firstCollectedAt sql.NullTime
+		)
+		if err := rows.Scan(&resourceID, &name, &uri, &ingress,
+			&collectedAt, &firstCollectedAt); err != nil {
+			return nil, fmt.Errorf("scan cloud run service: %w", err)
+		}
+
+		var accessLevel string
+		if ingress == runIngressInternalOnly || ingress == runIngressInternalLoadBalancer {
+			accessLevel = "private"
+		}
+
+		result = append(result, apiendpoint.NormalizedApiEndpoint{
+			BronzeResourceID: resourceID,
+			Name:             uri,
+			Service:          lastSegment(name),
+			URIPattern:       "/*",
+			IsActive:         true,
+			AccessLevel:      accessLevel,
+			Discovered:       true,
+			Provider:         key,
+			BronzeTable:      tableRun,
+			CollectedAt:      collectedAt.Time,
+			FirstCollectedAt: firstCollectedAt.Time,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate cloud run service rows: %w", err)
+	}
+
+	return result, nil
+}
+
+// loadListeners loads the HTTP(S) listeners of GreenNode load balancers. A
+// listener yields its L7 policy path rules, or a single entry point when it
+// has none. Listeners of internal load balancers are private.
+func loadListeners(ctx context.Context, db *sql.DB) ([]apiendpoint.NormalizedApiEndpoint, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT lb.resource_id, l.listener_id, lb.name, l.name,
+			COALESCE(l.default_pool_name, ''),
+			COALESCE(l.policies_json, 'null'),
+			lb.internal,
+			lb.collected_at, lb.first_collected_at
+		FROM bronze.greennode_loadbalancer_listeners l
+		JOIN bronze.greennode_loadbalancer_lbs lb
+			ON lb.resource_id = l.bronze_green_node_load_balancer_lb_listeners
+		WHERE UPPER(COALESCE(l.protocol, '')) IN ('HTTP', 'HTTPS')`)
+	if err != nil {
+		return nil, fmt.Errorf("query greennode_loadbalancer_listeners: %w", err)
+	}
+	defer rows.Close()
+
+	var result []apiendpoint.NormalizedApiEndpoint
+	for rows.Next() {
+		var (
+			lbID, listenerID, lbName, listenerName string
+			defaultPool                            string
+			policies                               []byte
+			internal                               bool
+			collectedAt, firstCollectedAt          sql.NullTime
+		)
+		if err := rows.Scan(&lbID, &listenerID, &lbName, &listenerName,
+			&defaultPool, &policies, &internal,
+			&collectedAt, &firstCollectedAt); err != nil {
+			return nil, fmt.Errorf("scan greennode listener: %w", err)
+		}
+
+		var accessLevel string
+		if internal {
+			accessLevel = "private"
+		}
+
+		routes := listenerRoutes(policies)
+		if len(routes) == 0 {
+			routes = []route{{pattern: "/*", service: defaultPool}}
+		}
+		for _, r := range routes {
+			result = append(result, apiendpoint.NormalizedApiEndpoint{
+				BronzeResourceID: lbID + ":" + listenerID + ":" + r.pattern,
+				Name:             lbName + "/" + listenerName,
+				Service:          r.service,
+				URIPattern:       r.pattern,
+				IsActive:         true,
+				AccessLevel:      accessLevel,
+				Discovered:       true,
+				Provider:         key,
+				BronzeTable:      tableListeners,
+				CollectedAt:      collectedAt.Time,
+				FirstCollectedAt: firstCollectedAt.Time,
+			})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate greennode listener rows: %w", err)
+	}
+
+	return result, nil
+}
+
+// lastSegment returns the part after the last "/" of a resource name or URL.
+func lastSegment(s string) string {
+	return s[strings.LastIndex(s, "/")+1:]
+}
@@ -0,0 +1,182 @@
+package routing
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// route is a candidate URI pattern and the backend serving it.
+type route struct {
+	pattern string
+	service string
+}
+
+// urlMap is the part of the URL map JSON (computepb field names) that
+// describes routing.
+type urlMap struct {
+	HostRules []struct {
+		Hosts       []string `json:"hosts"`
+		PathMatcher string   `json:"path_matcher"`
+	}
+	PathMatchers []struct {
+		Name           string `json:"name"`
+		DefaultService string `json:"default_service"`
+		PathRules      []struct {
+			Paths   []string `json:"paths"`
+			Service string   `json:"service"`
+		} `json:"path_rules"`
+		RouteRules []struct {
+			Service    string `json:"service"`
+			MatchRules []struct {
+				FullPathMatch     string `json:"full_path_match"`
+				PrefixMatch       string `json:"prefix_match"`
+				PathTemplateMatch string `json:"path_template_match"`
+			} `json:"match_rules"`
+		} `json:"route_rules"`
+	}
+}
+
+// urlMapRoutes returns the routes of the path matchers a host rule uses.
+// The root catch-all ("/*") is left out: like the default service, it routes
+// whatever is not an endpoint. Regex matches are skipped.
+func urlMapRoutes(hostRulesJSON, pathMatchersJSON []byte) []route {
+	var m urlMap
+	if json.Unmarshal(hostRulesJSON, &m.HostRules) != nil ||
+		json.Unmarshal(pathMatchersJSON, &m.PathMatchers) != nil {
+		return nil
+	}
+
+	used := make(map[string]bool, len(m.HostRules))
+	for _, hr := range m.HostRules {
+		used[hr.PathMatcher] = true
+	}
+
+	var routes []route
+	seen := make(map[string]bool)
+	add := func(pattern, service, fallback string) {
+		if pattern == "" || pattern == "/*" || seen[pattern] {
+			return
+		}
+		seen[pattern] = true
+		if service == "" {
+			service = fallback
+		}
+		routes = append(routes, route{pattern: pattern, service: lastSegment(service)})
+	}
+
+	for _, pm := range m.PathMatchers {
+		if !used[pm.Name] {
+			continue
+		}
+		for _, pr := range pm.PathRules {
+			for _, p := range pr.Paths {
+				add(p, pr.Service, pm.DefaultService)
+			}
+		}
+		for _, rr := range pm.RouteRules {
+			for _, mr := range rr.MatchRules {
+				switch {
+				case mr.FullPathMatch != "":
+					add(mr.FullPathMatch, rr.Service, pm.DefaultService)
+				case mr.PrefixMatch != "":
+					add(prefixPattern(mr.PrefixMatch), rr.Service, pm.DefaultService)
+				case mr.PathTemplateMatch != "":
+					add(templatePattern(mr.PathTemplateMatch), rr.Service, pm.DefaultService)
+				}
+			}
+		}
+	}
+	return routes
+}
+
+// prefixPattern turns a path prefix into a catch-all pattern, "/api/" and
+// "/api" both becoming "/api/*".
+func prefixPattern(prefix string) string {
+	return strings.TrimSuffix(prefix, "/") + "/*"
+}
+
+// templatePattern converts a URL map path template to a catch-all or
+// {param} pattern: "/users/{id=*}/files/{path=**}" becomes
+// "/users/{id}/files/*". Unnamed "*" stays a single-segment wildcard and
+// "**" matches the remainder.
+func templatePattern(template string) string {
+	segments := strings.Split(strings.Trim(template, "/"), "/")
+	for i, seg := range segments {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			name, match, _ := strings.Cut(seg[1:len(seg)-1], "=")
+			if match == "**" {
+				seg = "**"
+			} else {
+				seg = "{" + name + "}"
+			}
+		}
+		if seg == "**" {
+			return "/" + strings.Join(append(segments[:i], "*"), "/")
+		}
+		segments[i] = seg
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+// listenerRoutes returns the path rules of GreenNode L7 policies that forward
+// to a pool. Keys are matched case-insensitively, ignoring underscores, since
+// policies are stored as the SDK marshals them.
+func listenerRoutes(policiesJSON []byte) []route {
+	var policies []map[string]any
+	if json.Unmarshal(policiesJSON, &policies) != nil {
+		return nil
+	}
+
+	var routes []route
+	seen := make(map[string]bool)
+	for _, p := range policies {
+		if action := str(p, "action"); action != "" && !strings.EqualFold(action, "REDIRECT_TO_POOL") {
+			continue
+		}
+		rules, _ := lookup(p, "l7rules", "rules").([]any)
+		for _, r := range rules {
+			rule, ok := r.(map[string]any)
+			if !ok || !strings.EqualFold(str(rule, "ruletype", "type"), "PATH") {
+				continue
+			}
+			value := str(rule, "rulevalue", "value")
+			if !strings.HasPrefix(value, "/") {
+				continue
+			}
+			var pattern string
+			switch strings.ToUpper(str(rule, "comparetype")) {
+			case "EQUAL_TO":
+				pattern = value
+			case "STARTS_WITH":
+				pattern = prefixPattern(value)
+			default:
+				continue
+			}
+			if pattern == "/*" || seen[pattern] {
+				continue
+			}
+			seen[pattern] = true
+			routes = append(routes, route{pattern: pattern, service: str(p, "redirectpoolname")})
+		}
+	}
+	return routes
+}
+
+// lookup returns the first value whose key matches one of names, compared
+// case-insensitively with underscores removed.
+func lookup(m map[string]any, names ...string) any {
+	for k, v := range m {
+		norm := strings.ToLower(strings.ReplaceAll(k, "_", ""))
+		for _, name := range names {
+			if norm == name {
+				return v
+			}
+		}
+	}
+	return nil
+}
+
+func str(m map[string]any, names ...string) string {
+	s, _ := lookup(m, names...).(string)
+	return s
+}
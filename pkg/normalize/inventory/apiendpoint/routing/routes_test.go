@@ -0,0 +1,93 @@
+package routing
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestURLMapRoutes(t *testing.T) {
+	hostRules := []byte(`[{"hosts": ["api.example.com"], "path_matcher": "api"}]`)
+	pathMatchers := []byte(`[
+		{
+			"name": "api",
+			"default_service": "https://www.googleapis.com/compute/v1/projects/p/global/backendServices/api-default",
+			"path_rules": [
+				{"paths": ["/v1/orders", "/v1/orders/*", "/*"], "service": "projects/p/global/backendServices/orders"},
+				{"paths": ["/v1/users"]}
+			],
+			"route_rules": [
+				{"service": "projects/p/global/backendServices/files", "match_rules": [
+					{"path_template_match": "/v1/files/{bucket=*}/{object=**}"},
+					{"prefix_match": "/v1/blobs/"},
+					{"full_path_match": "/v1/orders"},
+					{"regex_match": "^/v2/.*$"}
+				]}
+			]
+		},
+		{
+			"name": "unused",
+			"path_rules": [{"paths": ["/internal"], "service": "x"}]
+		}
+	]`)
+
+	got := urlMapRoutes(hostRules, pathMatchers)
+	want := []route{
+		{pattern: "/v1/orders", service: "orders"},
+		{pattern: "/v1/orders/*", service: "orders"},
+		{pattern: "/v1/users", service: "api-default"},
+		{pattern: "/v1/files/{bucket}/*", service: "files"},
+		{pattern: "/v1/blobs/*", service: "files"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("urlMapRoutes() =\n%v\nwant\n%v", got, want)
+	}
+
+	if got := urlMapRoutes([]byte(`not json`), pathMatchers); got != nil {
+		t.Errorf("bad host rules: got %v", got)
+	}
+}
+
+func TestTemplatePattern(t *testing.T) {
+	tests := map[string]string{
+		"/users/{id}":              "/users/{id}",
+		"/users/{id=*}/posts/*":    "/users/{id}/posts/*",
+		"/static/**":               "/static/*",
+		"/files/{path=**}":         "/files/*",
+		"/a/{x=*}/{rest=**}":       "/a/{x}/*",
+		"/v1/{name=*}:batchUpdate": "/v1/{name=*}:batchUpdate",
+	}
+	for in, want := range tests {
+		if got := templatePattern(in); got != want {
+			t.Errorf("templatePattern(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestListenerRoutes(t *testing.T) {
+	policies := []byte(`[
+		{"Name": "api", "Action": "REDIRECT_TO_POOL", "RedirectPoolName": "api-pool", "L7Rules": [
+			{"RuleType": "PATH", "CompareType": "STARTS_WITH", "RuleValue": "/api/"},
+			{"RuleType": "HOST_NAME", "CompareType": "EQUAL_TO", "RuleValue": "example.com"}
+		]},
+		{"name": "health", "action": "REDIRECT_TO_POOL", "redirect_pool_name": "ops", "l7_rules": [
+			{"rule_type": "PATH", "compare_type": "EQUAL_TO", "rule_value": "/healthz"},
+			{"rule_type": "PATH", "compare_type": "REGEX", "rule_value": "/v[0-9]+/.*"}
+		]},
+		{"name": "legacy", "action": "REDIRECT_TO_URL", "l7_rules": [
+			{"rule_type": "PATH", "compare_type": "STARTS_WITH", "rule_value": "/old"}
+		]}
+	]`)
+
+	got := listenerRoutes(policies)
+	want := []route{
+		{pattern: "/api/*", service: "api-pool"},
+		{pattern: "/healthz", service: "ops"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("listenerRoutes() = %v, want %v", got, want)
+	}
+
+	if got := listenerRoutes([]byte(`null`)); got != nil {
+		t.Errorf("no policies: got %v", got)
+	}
+}
@@ -0,0 +1,164 @@
+package traffic
+
+import (
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// paramSegment replaces identifier-like segments.
+	paramSegment = "{id}"
+
+	// minVariants is the number of distinct values at one segment position,
+	// with the rest of the path equal, above which the position is collapsed
+	// to a parameter even when the values do not look like identifiers
+	// (e.g. user names).
+	minVariants = 10
+)
+
+var (
+	numericRe = regexp.MustCompile(`^[0-9]+$`)
+	uuidRe    = regexp.MustCompile(`^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{12}$`)
+	hexRe     = regexp.MustCompile(`^[0-9a-fA-F]{16,}$`)
+	// tokenRe matches opaque tokens: long, URL-safe and containing a digit.
+	tokenRe = regexp.MustCompile(`^[A-Za-z0-9_-]{20,}$`)
+	digitRe = regexp.MustCompile(`[0-9]`)
+)
+
+// staticExts are file extensions of assets, which are not API endpoints.
+var staticExts = map[string]bool{
+	".js": true, ".mjs": true, ".css": true, ".map": true, ".html": true, ".htm": true,
+	".png": true, ".jpg": true, ".jpeg": true, ".gif": true, ".svg": true, ".ico": true, ".webp": true,
+	".woff": true, ".woff2": true, ".ttf": true, ".eot": true, ".txt": true,
+}
+
+// observation is the traffic seen for one URI and method.
+type observation struct {
+	uri       string
+	method    string
+	requests  int64
+	firstSeen time.Time
+	lastSeen  time.Time
+}
+
+// cluster is a templated URI pattern and the traffic it covers.
+type cluster struct {
+	pattern   string
+	methods   []string
+	requests  int64
+	uris      int // distinct URIs collapsed into the pattern
+	firstSeen time.Time
+	lastSeen  time.Time
+}
+
+// isIdentifier reports whether a path segment looks like a generated
+// identifier rather than a route name.
+func isIdentifier(seg string) bool {
+	switch {
+	case numericRe.MatchString(seg), uuidRe.MatchString(seg), hexRe.MatchString(seg):
+		return true
+	case tokenRe.MatchString(seg) && digitRe.MatchString(seg):
+		return true
+	}
+	return false
+}
+
+// templatize replaces identifier-like segments of a URI with {id}.
+func templatize(uri string) []string {
+	segments := strings.Split(strings.Trim(uri, "/"), "/")
+	for i, seg := range segments {
+		if isIdentifier(seg) {
+			segments[i] = paramSegment
+		}
+	}
+	return segments
+}
+
+// clusterURIs groups observed URIs into templated patterns. Identifier-like
+// segments become {id}; then, per segment position, values that vary more
+// than minVariants times with the rest of the path equal are collapsed too.
+// Static assets and empty paths are skipped, and clusters with fewer than
+// minRequests requests are dropped.
+func clusterURIs(observations []observation, minRequests int64) []cluster {
+	type entry struct {
+		segments []string
+		obs      observation
+	}
+	var entries []entry
+	for _, o := range observations {
+		if strings.Trim(o.uri, "/") == "" || strings.Contains(o.uri, "//") || staticExts[strings.ToLower(path.Ext(o.uri))] {
+			continue
+		}
+		entries = append(entries, entry{segments: templatize(o.uri), obs: o})
+	}
+
+	// Collapse high-cardinality positions, left to right so that a collapsed
+	// segment groups the variants of the ones after it.
+	for pos := 0; ; pos++ {
+		variants := make(map[string]map[string]bool)
+		found := false
+		for _, e := range entries {
+			if pos >= len(e.segments) {
+				continue
+			}
+			found = true
+			key := siblingKey(e.segments, pos)
+			if variants[key] == nil {
+				variants[key] = make(map[string]bool)
+			}
+			variants[key][e.segments[pos]] = true
+		}
+		if !found {
+			break
+		}
+		for _, e := range entries {
+			if pos < len(e.segments) && len(variants[siblingKey(e.segments, pos)]) > minVariants {
+				e.segments[pos] = paramSegment
+			}
+		}
+	}
+
+	byPattern := make(map[string]*cluster)
+	uris := make(map[string]map[string]bool)
+	for _, e := range entries {
+		pattern := "/" + strings.Join(e.segments, "/")
+		c := byPattern[pattern]
+		if c == nil {
+			c = &cluster{pattern: pattern}
+			byPattern[pattern] = c
+			uris[pattern] = make(map[string]bool)
+		}
+		c.requests += e.obs.requests
+		if c.firstSeen.IsZero() || e.obs.firstSeen.Before(c.firstSeen) {
+			c.firstSeen = e.obs.firstSeen
+		}
+		if e.obs.lastSeen.After(c.lastSeen) {
+			c.lastSeen = e.obs.lastSeen
+		}
+		if m := strings.ToUpper(e.obs.method); m != "" && !slices.Contains(c.methods, m) {
+			c.methods = append(c.methods, m)
+		}
+		uris[pattern][e.obs.uri] = true
+	}
+
+	var result []cluster
+	for pattern, c := range byPattern {
+		if c.requests < minRequests {
+			continue
+		}
+		c.uris = len(uris[pattern])
+		sort.Strings(c.methods)
+		result = append(result, *c)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].pattern < result[j].pattern })
+	return result
+}
+
+// siblingKey identifies the paths that differ from segments only at pos.
+func siblingKey(segments []string, pos int) string {
+	return strings.Join(segments[:pos], "/") + "\x00" + strings.Join(segments[pos+1:], "/")
+}
@@ -0,0 +1,67 @@
+package traffic
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestIsIdentifier(t *testing.T) {
+	ids := []string{"42", "550e8400-e29b-41d4-a716-446655440000", "5f3a9c0e1b2d4f6a", "dGhpc2lzYXRva2VuMTIz_x-y"}
+	names := []string{"users", "v1", "me", "orders-history", "abcdefghijklmnopqrstuvwx"}
+	for _, s := range ids {
+		if !isIdentifier(s) {
+			t.Errorf("isIdentifier(%q) = false", s)
+		}
+	}
+	for _, s := range names {
+		if isIdentifier(s) {
+			t.Errorf("isIdentifier(%q) = true", s)
+		}
+	}
+}
+
+func TestClusterURIs(t *testing.T) {
+	t0 := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	obs := func(uri, method string, requests int64, day int) observation {
+		at := t0.AddDate(0, 0, day)
+		return observation{uri: uri, method: method, requests: requests, firstSeen: at, lastSeen: at}
+	}
+
+	observations := []observation{
+		obs("/v1/orders/1001", "GET", 30, 0),
+		obs("/v1/orders/1002", "GET", 30, 2),
+		obs("/v1/orders/1003", "delete", 5, 1),
+		obs("/v1/orders/550e8400-e29b-41d4-a716-446655440000/items", "GET", 60, 0),
+		obs("/v1/health", "GET", 10, 0),      // below minRequests
+		obs("/assets/app.js", "GET", 500, 0), // static asset
+		obs("/", "GET", 500, 0),
+	}
+	// User names are not identifiers, but vary enough to collapse.
+	for i := 0; i <= minVariants; i++ {
+		observations = append(observations, obs(fmt.Sprintf("/v1/users/user%c/profile", 'a'+i), "GET", 10, 0))
+	}
+	// A few named siblings stay as they are.
+	observations = append(observations,
+		obs("/v1/reports/daily", "GET", 100, 0),
+		obs("/v1/reports/weekly", "GET", 100, 0),
+	)
+
+	got := clusterURIs(observations, 50)
+	want := []cluster{
+		{pattern: "/v1/orders/{id}", methods: []string{"DELETE", "GET"}, requests: 65, uris: 3,
+			firstSeen: t0, lastSeen: t0.AddDate(0, 0, 2)},
+		{pattern: "/v1/orders/{id}/items", methods: []string{"GET"}, requests: 60, uris: 1,
+			firstSeen: t0, lastSeen: t0},
+		{pattern: "/v1/reports/daily", methods: []string{"GET"}, requests: 100, uris: 1,
+			firstSeen: t0, lastSeen: t0},
+		{pattern: "/v1/reports/weekly", methods: []string{"GET"}, requests: 100, uris: 1,
+			firstSeen: t0, lastSeen: t0},
+		{pattern: "/v1/users/{id}/profile", methods: []string{"GET"}, requests: 10 * (minVariants + 1), uris: minVariants + 1,
+			firstSeen: t0, lastSeen: t0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("clusterURIs() =\n%+v\nwant\n%+v", got, want)
+	}
+}
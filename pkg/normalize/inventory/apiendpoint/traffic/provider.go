@@ -0,0 +1,80 @@
+package traffic
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/normalize/inventory/apiendpoint"
+)
+
+const (
+	key         = "traffic"
+	sourceTable = "httptraffic_traffic_5m"
+
+	// lookback is how far back unmapped traffic is clustered.
+	lookback = 7 * 24 * time.Hour
+
+	// minRequests is the traffic a cluster needs over the lookback to become
+	// a candidate, which keeps one-off probes out of the catalog.
+	minRequests = 50
+)
+
+// Provider derives candidate endpoints from unmapped traffic in
+// silver.httptraffic_traffic_5m, clustering URIs into templated patterns
+// such as "/v1/orders/{id}". Only successful (2xx/3xx) requests without
+// attack detections are considered. Every candidate is flagged as
+// discovered; its bronze link points at the pattern in the traffic table.
+type Provider struct{}
+
+func (Provider) Key() string { return key }
+
+func (Provider) Load(ctx context.Context, db *sql.DB) ([]apiendpoint.NormalizedApiEndpoint, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT uri, COALESCE(method, ''), SUM(request_count),
+			MAX(collected_at), MIN(first_collected_at)
+		FROM silver.httptraffic_traffic_5m
+		WHERE is_mapped = false
+			AND window_start >= $1
+			AND status_code BETWEEN 200 AND 399
+			AND NOT (is_scanner_detected OR is_lfi_detected OR is_sqli_detected
+				OR is_rce_detected OR is_xss_detected OR is_ssrf_detected)
+		GROUP BY uri, method`, time.Now().Add(-lookback))
+	if err != nil {
+		return nil, fmt.Errorf("query unmapped traffic: %w", err)
+	}
+	defer rows.Close()
+
+	var observations []observation
+	for rows.Next() {
+		var (
+			o                   observation
+			lastSeen, firstSeen sql.NullTime
+		)
+		if err := rows.Scan(&o.uri, &o.method, &o.requests, &lastSeen, &firstSeen); err != nil {
+			return nil, fmt.Errorf("scan unmapped traffic: %w", err)
+		}
+		o.lastSeen, o.firstSeen = lastSeen.Time, firstSeen.Time
+		observations = append(observations, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate unmapped traffic rows: %w", err)
+	}
+
+	var result []apiendpoint.NormalizedApiEndpoint
+	for _, c := range clusterURIs(observations, minRequests) {
+		result = append(result, apiendpoint.NormalizedApiEndpoint{
+			BronzeResourceID: c.pattern,
+			URIPattern:       c.pattern,
+			Methods:          c.methods,
+			IsActive:         true,
+			Discovered:       true,
+			Provider:         key,
+			BronzeTable:      sourceTable,
+			CollectedAt:      c.lastSeen,
+			FirstCollectedAt: c.firstSeen,
+		})
+	}
+	return result, nil
+}
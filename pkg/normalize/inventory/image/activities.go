@@ -0,0 +1,466 @@
+package image
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/activity"
+	"golang.org/x/sync/errgroup"
+
+	"danny.vn/hotpot/pkg/base/config"
+	entimage "danny.vn/hotpot/pkg/storage/ent/inventory/image"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimage"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagebronzelink"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagenormalized"
+)
+
+const (
+	batchSize   = 1000
+	concurrency = 4
+)
+
+// Activities holds dependencies for normalize/merge Temporal activities.
+type Activities struct {
+	configService *config.Service
+	entClient     *entimage.Client
+	db            *sql.DB
+	providers     map[string]Provider
+	providerOrder []string
+}
+
+// NewActivities creates an Activities instance.
+func NewActivities(configService *config.Service, entClient *entimage.Client, db *sql.DB, providers []Provider) *Activities {
+	pmap := make(map[string]Provider, len(providers))
+	order := make([]string, 0, len(providers))
+	for _, p := range providers {
+		pmap[p.Key()] = p
+		order = append(order, p.Key())
+	}
+	return &Activities{
+		configService: configService,
+		entClient:     entClient,
+		db:            db,
+		providers:     pmap,
+		providerOrder: order,
+	}
+}
+
+// NormalizeImageProviderActivity function reference for Temporal registration.
+var NormalizeImageProviderActivity = (*Activities).NormalizeImageProvider
+
+// MergeImagesActivity function reference for Temporal registration.
+var MergeImagesActivity = (*Activities).MergeImages
+
+// NormalizeProviderParams identifies which provider to normalize.
+type NormalizeProviderParams struct {
+	ProviderKey string
+}
+
+// NormalizeProviderResult holds normalization statistics.
+type NormalizeProviderResult struct {
+	ProviderKey string
+	Upserted    int
+	Deleted     int
+}
+
+// NormalizeImageProvider loads bronze data for one provider, bulk-upserts
+// to inventory_image_normalized, and deletes stale rows.
+func (a *Activities) NormalizeImageProvider(ctx context.Context, params NormalizeProviderParams) (*NormalizeProviderResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Normalizing provider", "provider", params.ProviderKey)
+
+	provider, ok := a.providers[params.ProviderKey]
+	if !ok {
+		return nil, fmt.Errorf("unknown provider: %s", params.ProviderKey)
+	}
+
+	records, err := provider.Load(ctx, a.db)
+	if err != nil {
+		return nil, fmt.Errorf("load %s: %w", params.ProviderKey, err)
+	}
+	logger.Info("Loaded bronze records", "provider", params.ProviderKey, "count", len(records))
+
+	now := time.Now()
+
+	// Batch upsert with parallel workers.
+	batches := makeBatches(records, batchSize)
+	var done atomic.Int64
+	total := int64(len(records))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrency)
+
+	for _, batch := range batches {
+		g.Go(func() error {
+			if err := a.upsertNormalizedBatch(gctx, batch, now); err != nil {
+				return err
+			}
+			n := done.Add(int64(len(batch)))
+			activity.RecordHeartbeat(ctx, fmt.Sprintf("upserted %d/%d", n, total))
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Delete stale: anything for this provider not updated this run.
+	deleted, err := a.entClient.InventoryImageNormalized.Delete().
+		Where(
+			inventoryimagenormalized.ProviderEQ(params.ProviderKey),
+			inventoryimagenormalized.NormalizedAtLT(now),
+		).Exec(ctx)
+	if err != nil {
+		slog.Warn("Failed to delete stale normalized rows",
+			"provider", params.ProviderKey, "error", err)
+	}
+
+	logger.Info("Normalized provider",
+		"provider", params.ProviderKey,
+		"upserted", len(records),
+		"deleted", deleted)
+
+	return &NormalizeProviderResult{
+		ProviderKey: params.ProviderKey,
+		Upserted:    len(records),
+		Deleted:     deleted,
+	}, nil
+}
+
+// upsertNormalizedBatch bulk-upserts a batch of records via raw SQL INSERT...ON CONFLICT.
+func (a *Activities) upsertNormalizedBatch(ctx context.Context, batch []NormalizedImage, now time.Time) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	var b strings.Builder
+	b.WriteString(`INSERT INTO silver.inventory_image_normalized
+		(resource_id, provider, is_base, bronze_table, bronze_resource_id,
+		 registry, repository, digest, tags_json, workloads_json, vulnerabilities_json,
+		 collected_at, first_collected_at, normalized_at)
+		VALUES `)
+
+	const cols = 14
+	args := make([]any, 0, len(batch)*cols)
+	for i, rec := range batch {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		writePlaceholders(&b, i*cols, cols)
+		args = append(args,
+			rec.ResourceID(), rec.Provider, rec.IsBase, rec.BronzeTable, rec.BronzeResourceID,
+			rec.Registry, rec.Repository, rec.Digest,
+			jsonOrNull(rec.Tags), jsonOrNull(rec.Workloads), jsonOrNull(rec.Vulnerabilities),
+			rec.CollectedAt, rec.FirstCollectedAt, now,
+		)
+	}
+	b.WriteString(` ON CONFLICT (resource_id) DO UPDATE SET
+		provider = EXCLUDED.provider,
+		is_base = EXCLUDED.is_base,
+		bronze_table = EXCLUDED.bronze_table,
+		bronze_resource_id = EXCLUDED.bronze_resource_id,
+		registry = EXCLUDED.registry,
+		repository = EXCLUDED.repository,
+		digest = EXCLUDED.digest,
+		tags_json = EXCLUDED.tags_json,
+		workloads_json = EXCLUDED.workloads_json,
+		vulnerabilities_json = EXCLUDED.vulnerabilities_json,
+		collected_at = EXCLUDED.collected_at,
+		first_collected_at = EXCLUDED.first_collected_at,
+		normalized_at = EXCLUDED.normalized_at`)
+
+	if _, err := a.db.ExecContext(ctx, b.String(), args...); err != nil {
+		return fmt.Errorf("upsert normalized batch: %w", err)
+	}
+	return nil
+}
+
+// MergeImagesResult holds merge statistics.
+type MergeImagesResult struct {
+	Created int
+	Updated int
+	Deleted int
+}
+
+// mergedEntry is a merged image with its assigned resource ID.
+type mergedEntry struct {
+	ID string // UUID — reused or generated
+	MergedImage
+}
+
+// MergeImages reads all normalized rows, dedups them on registry/repository/digest,
+// and writes to silver.inventory_images.
+func (a *Activities) MergeImages(ctx context.Context) (*MergeImagesResult, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Starting image merge")
+
+	// Read all normalized rows.
+	normalizedRows, err := a.entClient.InventoryImageNormalized.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query normalized rows: %w", err)
+	}
+	logger.Info("Loaded normalized rows", "count", len(normalizedRows))
+
+	// Convert ent models to domain types.
+	rows := make([]NormalizedImage, 0, len(normalizedRows))
+	for _, r := range normalizedRows {
+		var vulns []Vulnerability
+		if len(r.VulnerabilitiesJSON) > 0 {
+			if err := json.Unmarshal(r.VulnerabilitiesJSON, &vulns); err != nil {
+				return nil, fmt.Errorf("decode vulnerabilities of %s: %w", r.ID, err)
+			}
+		}
+		rows = append(rows, NormalizedImage{
+			Provider:         r.Provider,
+			IsBase:           r.IsBase,
+			BronzeTable:      r.BronzeTable,
+			BronzeResourceID: r.BronzeResourceID,
+			Registry:         r.Registry,
+			Repository:       r.Repository,
+			Digest:           r.Digest,
+			Tags:             r.TagsJSON,
+			Workloads:        r.WorkloadsJSON,
+			Vulnerabilities:  vulns,
+			CollectedAt:      r.CollectedAt,
+			FirstCollectedAt: r.FirstCollectedAt,
+		})
+	}
+
+	// Run merge engine.
+	merged := MergeImages(rows, a.providerOrder)
+	logger.Info("Deduplicated images", "normalized", len(rows), "merged", len(merged))
+
+	// Load existing images for stable UUID matching on the image key.
+	existingRecords, err := a.entClient.InventoryImage.Query().
+		Select(inventoryimage.FieldID, inventoryimage.FieldRegistry,
+			inventoryimage.FieldRepository, inventoryimage.FieldDigest).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query existing images: %w", err)
+	}
+	existingMap := make(map[string]string, len(existingRecords))
+	for _, img := range existingRecords {
+		existingMap[imageKey(img.Registry, img.Repository, img.Digest)] = img.ID
+	}
+
+	// Assign stable UUIDs.
+	var created, updated int
+	activeIDs := make(map[string]bool, len(merged))
+	entries := make([]*mergedEntry, 0, len(merged))
+	for _, m := range merged {
+		e := &mergedEntry{MergedImage: m}
+		if id, ok := existingMap[imageKey(m.Registry, m.Repository, m.Digest)]; ok {
+			e.ID = id
+			updated++
+		} else {
+			e.ID = uuid.New().String()
+			created++
+		}
+		activeIDs[e.ID] = true
+		entries = append(entries, e)
+	}
+
+	now := time.Now()
+
+	// Batch upsert images + bronze_links in parallel.
+	batches := makeMergedBatches(entries, batchSize)
+	var done atomic.Int64
+	total := int64(len(entries))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrency)
+
+	for _, batch := range batches {
+		g.Go(func() error {
+			if err := a.upsertMergedBatch(gctx, batch, now); err != nil {
+				return err
+			}
+			n := done.Add(int64(len(batch)))
+			activity.RecordHeartbeat(ctx, fmt.Sprintf("merged %d/%d", n, total))
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Delete stale: bronze_links first, then images.
+	var staleIDs []string
+	for _, img := range existingRecords {
+		if !activeIDs[img.ID] {
+			staleIDs = append(staleIDs, img.ID)
+		}
+	}
+
+	deleted := 0
+	if len(staleIDs) > 0 {
+		_, err = a.entClient.InventoryImageBronzeLink.Delete().
+			Where(inventoryimagebronzelink.HasImageWith(inventoryimage.IDIn(staleIDs...))).
+			Exec(ctx)
+		if err != nil {
+			slog.Warn("Failed to delete stale bronze links", "count", len(staleIDs), "error", err)
+		}
+		deleted, err = a.entClient.InventoryImage.Delete().
+			Where(inventoryimage.IDIn(staleIDs...)).
+			Exec(ctx)
+		if err != nil {
+			slog.Warn("Failed to delete stale images", "count", len(staleIDs), "error", err)
+		}
+	}
+
+	logger.Info("Image merge complete",
+		"created", created,
+		"updated", updated,
+		"deleted", deleted,
+		"total", len(entries))
+
+	return &MergeImagesResult{
+		Created: created,
+		Updated: updated,
+		Deleted: deleted,
+	}, nil
+}
+
+// upsertMergedBatch bulk-upserts a batch of merged images to inventory_images
+// and rebuilds their bronze_links, all in a single transaction.
+func (a *Activities) upsertMergedBatch(ctx context.Context, batch []*mergedEntry, now time.Time) error {
+	tx, err := a.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	// 1. Bulk upsert inventory_images.
+	{
+		var b strings.Builder
+		b.WriteString(`INSERT INTO silver.inventory_images
+			(resource_id, registry, repository, digest, tags_json, workloads_json,
+			 vulnerabilities_json, vulnerability_count, max_severity,
+			 collected_at, first_collected_at, normalized_at)
+			VALUES `)
+
+		const cols = 12
+		args := make([]any, 0, len(batch)*cols)
+		for i, e := range batch {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			writePlaceholders(&b, i*cols, cols)
+			args = append(args, e.ID, e.Registry, e.Repository, e.Digest,
+				jsonOrNull(e.Tags), jsonOrNull(e.Workloads), jsonOrNull(e.Vulnerabilities),
+				len(e.Vulnerabilities), e.MaxSeverity,
+				e.CollectedAt, e.FirstCollectedAt, now)
+		}
+		b.WriteString(` ON CONFLICT (resource_id) DO UPDATE SET
+			tags_json = EXCLUDED.tags_json,
+			workloads_json = EXCLUDED.workloads_json,
+			vulnerabilities_json = EXCLUDED.vulnerabilities_json,
+			vulnerability_count = EXCLUDED.vulnerability_count,
+			max_severity = EXCLUDED.max_severity,
+			collected_at = EXCLUDED.collected_at,
+			normalized_at = EXCLUDED.normalized_at`)
+
+		if _, err := tx.ExecContext(ctx, b.String(), args...); err != nil {
+			return fmt.Errorf("upsert inventory_images batch: %w", err)
+		}
+	}
+
+	// 2. Delete old bronze_links for this batch.
+	{
+		ids := make([]any, len(batch))
+		placeholders := make([]string, len(batch))
+		for i, e := range batch {
+			ids[i] = e.ID
+			placeholders[i] = "$" + strconv.Itoa(i+1)
+		}
+		q := `DELETE FROM silver.inventory_image_links
+			WHERE inventory_image_bronze_links IN (` + strings.Join(placeholders, ",") + `)`
+		if _, err := tx.ExecContext(ctx, q, ids...); err != nil {
+			return fmt.Errorf("delete old bronze links: %w", err)
+		}
+	}
+
+	// 3. Bulk insert new bronze_links. Every vulnerability occurrence is a
+	// link, so they are chunked to stay under the bind parameter limit.
+	{
+		type linkRow struct {
+			imageID string
+			link    BronzeLink
+		}
+		var links []linkRow
+		for _, e := range batch {
+			for _, link := range e.BronzeLinks {
+				links = append(links, linkRow{imageID: e.ID, link: link})
+			}
+		}
+		for start := 0; start < len(links); start += batchSize {
+			chunk := links[start:min(start+batchSize, len(links))]
+
+			var b strings.Builder
+			b.WriteString(`INSERT INTO silver.inventory_image_links
+				(provider, bronze_table, bronze_resource_id, inventory_image_bronze_links)
+				VALUES `)
+			args := make([]any, 0, len(chunk)*4)
+			for i, l := range chunk {
+				if i > 0 {
+					b.WriteByte(',')
+				}
+				writePlaceholders(&b, i*4, 4)
+				args = append(args, l.link.Provider, l.link.BronzeTable, l.link.BronzeResourceID, l.imageID)
+			}
+			if _, err := tx.ExecContext(ctx, b.String(), args...); err != nil {
+				return fmt.Errorf("insert bronze links: %w", err)
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// writePlaceholders writes "($base+1,...,$base+n)".
+func writePlaceholders(b *strings.Builder, base, n int) {
+	b.WriteByte('(')
+	for j := range n {
+		if j > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteByte('$')
+		b.WriteString(strconv.Itoa(base + j + 1))
+	}
+	b.WriteByte(')')
+}
+
+// jsonOrNull marshals a slice for a jsonb column, or returns nil for an
+// empty one.
+func jsonOrNull[T any](v []T) any {
+	if len(v) == 0 {
+		return nil
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// makeBatches splits a slice into chunks of the given size.
+func makeBatches(records []NormalizedImage, size int) [][]NormalizedImage {
+	var batches [][]NormalizedImage
+	for i := 0; i < len(records); i += size {
+		batches = append(batches, records[i:min(i+size, len(records))])
+	}
+	return batches
+}
+
+func makeMergedBatches(entries []*mergedEntry, size int) [][]*mergedEntry {
+	var batches [][]*mergedEntry
+	for i := 0; i < len(entries); i += size {
+		batches = append(batches, entries[i:min(i+size, len(entries))])
+	}
+	return batches
+}
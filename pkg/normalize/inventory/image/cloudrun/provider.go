@@ -0,0 +1,110 @@
+package cloudrun
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"danny.vn/hotpot/pkg/base/imageref"
+	"danny.vn/hotpot/pkg/normalize/inventory/image"
+)
+
+const (
+	key         = "cloudrun"
+	label       = "GCP Cloud Run"
+	bronzeTable = "gcp_run_revisions"
+)
+
+// Provider normalizes the container images of the revision serving each
+// Cloud Run service (bronze.gcp_run_services.latest_ready_revision) into
+// NormalizedImage records, one per container.
+type Provider struct{}
+
+func (Provider) Key() string   { return key }
+func (Provider) Label() string { return label }
+func (Provider) IsBase() bool  { return true }
+
+func (Provider) Load(ctx context.Context, db *sql.DB) ([]image.NormalizedImage, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT r.resource_id, s.resource_id, r.containers_json,
+			r.collected_at, r.first_collected_at
+		FROM bronze.gcp_run_revisions r
+		JOIN bronze.gcp_run_services s ON s.latest_ready_revision = r.resource_id
+		WHERE r.containers_json IS NOT NULL`)
+	if err != nil {
+		return nil, fmt.Errorf("query cloud run revisions: %w", err)
+	}
+	defer rows.Close()
+
+	var result []image.NormalizedImage
+	for rows.Next() {
+		var (
+			revisionID, serviceID         string
+			containersJSON                []byte
+			collectedAt, firstCollectedAt sql.NullTime
+		)
+		if err := rows.Scan(&revisionID, &serviceID, &containersJSON,
+			&collectedAt, &firstCollectedAt); err != nil {
+			return nil, fmt.Errorf("scan cloud run revision: %w", err)
+		}
+
+		var containers []struct {
+			Name  string `json:"name"`
+			Image string `json:"image"`
+		}
+		if err := json.Unmarshal(containersJSON, &containers); err != nil {
+			continue
+		}
+
+		for i, c := range containers {
+			if c.Image == "" {
+				continue
+			}
+			ref := imageref.Parse(c.Image)
+			var tags []string
+			if ref.Tag != "" {
+				tags = []string{ref.Tag}
+			}
+			result = append(result, image.NormalizedImage{
+				Provider:         key,
+				IsBase:           true,
+				BronzeTable:      bronzeTable,
+				BronzeResourceID: containerID(revisionID, c.Name, i),
+				Registry:         ref.Registry,
+				Repository:       ref.Repository,
+				Digest:           ref.Digest,
+				Tags:             tags,
+				Workloads:        []string{workload(serviceID)},
+				CollectedAt:      collectedAt.Time,
+				FirstCollectedAt: firstCollectedAt.Time,
+			})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate cloud run revisions: %w", err)
+	}
+	return result, nil
+}
+
+// containerID identifies one container of a revision as
+// "{revision}#{container}", since a revision with sidecars runs several
+// images. A container without a name is identified by its index.
+func containerID(revisionID, name string, index int) string {
+	if name == "" {
+		name = strconv.Itoa(index)
+	}
+	return revisionID + "#" + name
+}
+
+// workload turns "projects/{p}/locations/{l}/services/{s}" into
+// "cloudrun:{p}/{l}/{s}".
+func workload(serviceID string) string {
+	parts := strings.Split(serviceID, "/")
+	if len(parts) == 6 {
+		return "cloudrun:" + parts[1] + "/" + parts[3] + "/" + parts[5]
+	}
+	return "cloudrun:" + serviceID
+}
@@ -0,0 +1,142 @@
+package containeranalysis
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"danny.vn/hotpot/pkg/normalize/inventory/image"
+)
+
+const (
+	key         = "containeranalysis"
+	label       = "GCP Container Analysis"
+	bronzeTable = "gcp_containeranalysis_occurrences"
+
+	// kindVulnerability is NoteKind VULNERABILITY.
+	kindVulnerability = 1
+)
+
+// Provider normalizes Container Analysis vulnerability occurrences into
+// NormalizedImage records carrying one vulnerability each. It is merge-only:
+// occurrences attach to images a base provider runs, matched on the digest
+// in the occurrence resource URI, and scans of images nobody runs are dropped.
+type Provider struct{}
+
+func (Provider) Key() string   { return key }
+func (Provider) Label() string { return label }
+func (Provider) IsBase() bool  { return false }
+
+func (Provider) Load(ctx context.Context, db *sql.DB) ([]image.NormalizedImage, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT resource_id, resource_uri, COALESCE(note_name, ''), vulnerability_json,
+			collected_at, first_collected_at
+		FROM bronze.gcp_containeranalysis_occurrences
+		WHERE kind = $1 AND resource_uri IS NOT NULL AND vulnerability_json IS NOT NULL`,
+		kindVulnerability)
+	if err != nil {
+		return nil, fmt.Errorf("query container analysis occurrences: %w", err)
+	}
+	defer rows.Close()
+
+	var result []image.NormalizedImage
+	for rows.Next() {
+		var (
+			resourceID, resourceURI, noteName string
+			vulnJSON                          []byte
+			collectedAt, firstCollectedAt     sql.NullTime
+		)
+		if err := rows.Scan(&resourceID, &resourceURI, &noteName, &vulnJSON,
+			&collectedAt, &firstCollectedAt); err != nil {
+			return nil, fmt.Errorf("scan container analysis occurrence: %w", err)
+		}
+
+		ref, ok := image.ParseResourceURI(resourceURI)
+		if !ok {
+			continue
+		}
+		vuln, err := parseVulnerability(resourceID, noteName, vulnJSON)
+		if err != nil {
+			continue
+		}
+
+		result = append(result, image.NormalizedImage{
+			Provider:         key,
+			IsBase:           false,
+			BronzeTable:      bronzeTable,
+			BronzeResourceID: resourceID,
+			Registry:         ref.Registry,
+			Repository:       ref.Repository,
+			Digest:           ref.Digest,
+			Vulnerabilities:  []image.Vulnerability{vuln},
+			CollectedAt:      collectedAt.Time,
+			FirstCollectedAt: firstCollectedAt.Time,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate container analysis occurrences: %w", err)
+	}
+	return result, nil
+}
+
+// vulnerabilityOccurrence is the subset of grafeas VulnerabilityOccurrence
+// read from vulnerability_json (protojson with proto field names).
+type vulnerabilityOccurrence struct {
+	Severity          string  `json:"severity"`
+	EffectiveSeverity string  `json:"effective_severity"`
+	CVSSScore         float64 `json:"cvss_score"`
+	ShortDescription  string  `json:"short_description"`
+	FixAvailable      bool    `json:"fix_available"`
+	PackageIssue      []struct {
+		AffectedPackage string `json:"affected_package"`
+	} `json:"package_issue"`
+}
+
+// parseVulnerability builds the image vulnerability of one occurrence. The
+// CVE comes from the note name (projects/goog-vulnz/notes/CVE-2024-1234),
+// and the distro-adjusted effective severity wins over the base one.
+func parseVulnerability(occurrenceID, noteName string, raw []byte) (image.Vulnerability, error) {
+	var occ vulnerabilityOccurrence
+	if err := json.Unmarshal(raw, &occ); err != nil {
+		return image.Vulnerability{}, err
+	}
+
+	cve := noteName[strings.LastIndexByte(noteName, '/')+1:]
+	if cve == "" {
+		cve = occ.ShortDescription
+	}
+	severity := occ.EffectiveSeverity
+	if severity == "" || severity == "SEVERITY_UNSPECIFIED" {
+		severity = occ.Severity
+	}
+	var pkg string
+	if len(occ.PackageIssue) > 0 {
+		pkg = occ.PackageIssue[0].AffectedPackage
+	}
+
+	return image.Vulnerability{
+		OccurrenceID: occurrenceID,
+		CVE:          cve,
+		Severity:     normalizeSeverity(severity),
+		CVSSScore:    occ.CVSSScore,
+		Package:      pkg,
+		FixAvailable: occ.FixAvailable,
+	}, nil
+}
+
+// normalizeSeverity maps grafeas Severity names to critical/high/medium/low/unknown.
+func normalizeSeverity(s string) string {
+	switch s {
+	case "CRITICAL":
+		return "critical"
+	case "HIGH":
+		return "high"
+	case "MEDIUM":
+		return "medium"
+	case "LOW", "MINIMAL":
+		return "low"
+	}
+	return "unknown"
+}
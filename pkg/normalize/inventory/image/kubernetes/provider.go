@@ -0,0 +1,86 @@
+package kubernetes
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"danny.vn/hotpot/pkg/normalize/inventory/image"
+)
+
+const (
+	key         = "kubernetes"
+	label       = "Kubernetes API"
+	bronzeTable = "k8s_images"
+)
+
+// Provider normalizes bronze.k8s_images, the images referenced by the pods
+// of each cluster, into NormalizedImage records. Workloads are prefixed with
+// the cluster so that the same deployment name in two clusters stays apart.
+type Provider struct{}
+
+func (Provider) Key() string   { return key }
+func (Provider) Label() string { return label }
+func (Provider) IsBase() bool  { return true }
+
+func (Provider) Load(ctx context.Context, db *sql.DB) ([]image.NormalizedImage, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT resource_id, cluster_name, registry, repository, COALESCE(digest, ''),
+			tags_json, workloads_json,
+			collected_at, first_collected_at
+		FROM bronze.k8s_images`)
+	if err != nil {
+		return nil, fmt.Errorf("query k8s images: %w", err)
+	}
+	defer rows.Close()
+
+	var result []image.NormalizedImage
+	for rows.Next() {
+		var (
+			resourceID, clusterName, registry, repository, digest string
+			tagsJSON, workloadsJSON                               []byte
+			collectedAt, firstCollectedAt                         sql.NullTime
+		)
+		if err := rows.Scan(&resourceID, &clusterName, &registry, &repository, &digest,
+			&tagsJSON, &workloadsJSON, &collectedAt, &firstCollectedAt); err != nil {
+			return nil, fmt.Errorf("scan k8s image: %w", err)
+		}
+
+		var tags, workloads []string
+		if len(tagsJSON) > 0 {
+			_ = json.Unmarshal(tagsJSON, &tags)
+		}
+		if len(workloadsJSON) > 0 {
+			_ = json.Unmarshal(workloadsJSON, &workloads)
+		}
+
+		result = append(result, image.NormalizedImage{
+			Provider:         key,
+			IsBase:           true,
+			BronzeTable:      bronzeTable,
+			BronzeResourceID: resourceID,
+			Registry:         registry,
+			Repository:       repository,
+			Digest:           digest,
+			Tags:             tags,
+			Workloads:        clusterWorkloads(clusterName, workloads),
+			CollectedAt:      collectedAt.Time,
+			FirstCollectedAt: firstCollectedAt.Time,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate k8s images: %w", err)
+	}
+	return result, nil
+}
+
+// clusterWorkloads turns "{namespace}/{kind}/{name}" into
+// "k8s:{cluster}/{namespace}/{kind}/{name}".
+func clusterWorkloads(clusterName string, workloads []string) []string {
+	out := make([]string, 0, len(workloads))
+	for _, w := range workloads {
+		out = append(out, "k8s:"+clusterName+"/"+w)
+	}
+	return out
+}
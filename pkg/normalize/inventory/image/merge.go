@@ -0,0 +1,156 @@
+package image
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// MergedImage is the result of merging normalized rows from multiple providers.
+type MergedImage struct {
+	Registry         string
+	Repository       string
+	Digest           string
+	Tags             []string
+	Workloads        []string
+	Vulnerabilities  []Vulnerability
+	MaxSeverity      string
+	CollectedAt      time.Time
+	FirstCollectedAt time.Time
+	BronzeLinks      []BronzeLink
+}
+
+// BronzeLink tracks which bronze record contributed to a merged image.
+type BronzeLink struct {
+	Provider         string
+	BronzeTable      string
+	BronzeResourceID string
+}
+
+// MergeImages takes normalized rows and dedups them on registry/repository/digest.
+// Providers are processed in registered order: base providers create images,
+// merge-only providers (vulnerability scanners) only enrich images a base
+// provider already reported. Tags, workloads and vulnerabilities are unioned.
+func MergeImages(rows []NormalizedImage, providerOrder []string) []MergedImage {
+	byProvider := make(map[string][]NormalizedImage)
+	for i := range rows {
+		byProvider[rows[i].Provider] = append(byProvider[rows[i].Provider], rows[i])
+	}
+
+	var images []*MergedImage
+	index := make(map[string]int)
+
+	for _, pkey := range providerOrder {
+		providerRows := byProvider[pkey]
+		for i := range providerRows {
+			row := &providerRows[i]
+			link := BronzeLink{
+				Provider:         row.Provider,
+				BronzeTable:      row.BronzeTable,
+				BronzeResourceID: row.BronzeResourceID,
+			}
+
+			key := row.Key()
+			idx, ok := index[key]
+			if !ok {
+				if !row.IsBase {
+					// Merge-only provider with no match — record is dropped.
+					continue
+				}
+				idx = len(images)
+				index[key] = idx
+				images = append(images, &MergedImage{
+					Registry:         row.Registry,
+					Repository:       row.Repository,
+					Digest:           row.Digest,
+					CollectedAt:      row.CollectedAt,
+					FirstCollectedAt: row.FirstCollectedAt,
+				})
+			}
+
+			m := images[idx]
+			m.Tags = append(m.Tags, row.Tags...)
+			m.Workloads = append(m.Workloads, row.Workloads...)
+			m.Vulnerabilities = append(m.Vulnerabilities, row.Vulnerabilities...)
+			mergeTimestamps(m, row.CollectedAt, row.FirstCollectedAt)
+			m.BronzeLinks = append(m.BronzeLinks, link)
+		}
+	}
+
+	result := make([]MergedImage, 0, len(images))
+	for _, m := range images {
+		m.Tags = uniqueSorted(m.Tags)
+		m.Workloads = uniqueSorted(m.Workloads)
+		m.Vulnerabilities = uniqueVulnerabilities(m.Vulnerabilities)
+		m.MaxSeverity = maxSeverity(m.Vulnerabilities)
+		result = append(result, *m)
+	}
+	return result
+}
+
+func mergeTimestamps(m *MergedImage, collected, firstCollected time.Time) {
+	if m.CollectedAt.IsZero() || collected.After(m.CollectedAt) {
+		m.CollectedAt = collected
+	}
+	if m.FirstCollectedAt.IsZero() || firstCollected.Before(m.FirstCollectedAt) {
+		m.FirstCollectedAt = firstCollected
+	}
+}
+
+func uniqueSorted(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	slices.Sort(values)
+	return slices.Compact(values)
+}
+
+// uniqueVulnerabilities drops repeated occurrences and orders the rest by
+// severity, then CVE.
+func uniqueVulnerabilities(vulns []Vulnerability) []Vulnerability {
+	seen := make(map[string]bool, len(vulns))
+	out := vulns[:0]
+	for _, v := range vulns {
+		if seen[v.OccurrenceID] {
+			continue
+		}
+		seen[v.OccurrenceID] = true
+		out = append(out, v)
+	}
+	slices.SortFunc(out, func(a, b Vulnerability) int {
+		return cmp.Or(
+			cmp.Compare(severityRank(b.Severity), severityRank(a.Severity)),
+			cmp.Compare(a.CVE, b.CVE),
+			cmp.Compare(a.Package, b.Package),
+		)
+	})
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// maxSeverity returns the worst severity of vulns, or "" when there are none.
+func maxSeverity(vulns []Vulnerability) string {
+	best := ""
+	for _, v := range vulns {
+		if best == "" || severityRank(v.Severity) > severityRank(best) {
+			best = v.Severity
+		}
+	}
+	return best
+}
+
+func severityRank(s string) int {
+	switch s {
+	case "critical":
+		return 4
+	case "high":
+		return 3
+	case "medium":
+		return 2
+	case "low":
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,106 @@
+package image
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"danny.vn/hotpot/pkg/base/imageref"
+)
+
+func TestMergeImages(t *testing.T) {
+	t1 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(24 * time.Hour)
+	const digest = "sha256:abc"
+
+	rows := []NormalizedImage{
+		{
+			Provider: "containeranalysis", BronzeTable: "gcp_containeranalysis_occurrences", BronzeResourceID: "occ-1",
+			Registry: "us-docker.pkg.dev", Repository: "p/r/api", Digest: digest,
+			Vulnerabilities: []Vulnerability{{OccurrenceID: "occ-1", CVE: "CVE-2024-1", Severity: "medium"}},
+			CollectedAt:     t1, FirstCollectedAt: t1,
+		},
+		{
+			Provider: "containeranalysis", BronzeTable: "gcp_containeranalysis_occurrences", BronzeResourceID: "occ-2",
+			Registry: "us-docker.pkg.dev", Repository: "p/r/api", Digest: digest,
+			Vulnerabilities: []Vulnerability{{OccurrenceID: "occ-2", CVE: "CVE-2024-2", Severity: "critical"}},
+			CollectedAt:     t1, FirstCollectedAt: t1,
+		},
+		{
+			// Scan of an image nothing runs — dropped.
+			Provider: "containeranalysis", BronzeTable: "gcp_containeranalysis_occurrences", BronzeResourceID: "occ-3",
+			Registry: "us-docker.pkg.dev", Repository: "p/r/batch", Digest: "sha256:def",
+			Vulnerabilities: []Vulnerability{{OccurrenceID: "occ-3", CVE: "CVE-2024-3", Severity: "high"}},
+		},
+		{
+			Provider: "kubernetes", IsBase: true, BronzeTable: "k8s_images", BronzeResourceID: "prod/us-docker.pkg.dev/p/r/api@" + digest,
+			Registry: "us-docker.pkg.dev", Repository: "p/r/api", Digest: digest,
+			Tags: []string{"v2"}, Workloads: []string{"k8s:prod/web/Deployment/api"},
+			CollectedAt: t1, FirstCollectedAt: t1,
+		},
+		{
+			Provider: "kubernetes", IsBase: true, BronzeTable: "k8s_images", BronzeResourceID: "staging/us-docker.pkg.dev/p/r/api@" + digest,
+			Registry: "us-docker.pkg.dev", Repository: "p/r/api", Digest: digest,
+			Tags: []string{"v2", "latest"}, Workloads: []string{"k8s:staging/web/Deployment/api"},
+			CollectedAt: t2, FirstCollectedAt: t2,
+		},
+		{
+			Provider: "cloudrun", IsBase: true, BronzeTable: "gcp_run_revisions", BronzeResourceID: "projects/p/locations/l/services/api/revisions/api-1#api",
+			Registry: "us-docker.pkg.dev", Repository: "p/r/api", Digest: digest,
+			Workloads:   []string{"cloudrun:p/l/api", "k8s:prod/web/Deployment/api"},
+			CollectedAt: t1, FirstCollectedAt: t1,
+		},
+	}
+
+	merged := MergeImages(rows, []string{"kubernetes", "cloudrun", "containeranalysis"})
+	if len(merged) != 1 {
+		t.Fatalf("got %d images, want 1", len(merged))
+	}
+	m := merged[0]
+
+	if want := []string{"latest", "v2"}; !slices.Equal(m.Tags, want) {
+		t.Errorf("Tags = %v, want %v", m.Tags, want)
+	}
+	if want := []string{"cloudrun:p/l/api", "k8s:prod/web/Deployment/api", "k8s:staging/web/Deployment/api"}; !slices.Equal(m.Workloads, want) {
+		t.Errorf("Workloads = %v, want %v", m.Workloads, want)
+	}
+	if len(m.Vulnerabilities) != 2 || m.Vulnerabilities[0].CVE != "CVE-2024-2" {
+		t.Errorf("Vulnerabilities = %+v, want CVE-2024-2 then CVE-2024-1", m.Vulnerabilities)
+	}
+	if m.MaxSeverity != "critical" {
+		t.Errorf("MaxSeverity = %q, want critical", m.MaxSeverity)
+	}
+	if len(m.BronzeLinks) != 5 {
+		t.Errorf("got %d bronze links, want 5", len(m.BronzeLinks))
+	}
+	if !m.CollectedAt.Equal(t2) || !m.FirstCollectedAt.Equal(t1) {
+		t.Errorf("CollectedAt = %v, FirstCollectedAt = %v", m.CollectedAt, m.FirstCollectedAt)
+	}
+}
+
+func TestParseResourceURI(t *testing.T) {
+	tests := []struct {
+		uri  string
+		want imageref.Reference
+		ok   bool
+	}{
+		{
+			uri:  "https://us-docker.pkg.dev/proj/repo/app@sha256:abc",
+			want: imageref.Reference{Registry: "us-docker.pkg.dev", Repository: "proj/repo/app", Digest: "sha256:abc"},
+			ok:   true,
+		},
+		{
+			uri:  "https://gcr.io/proj/app@sha256:abc",
+			want: imageref.Reference{Registry: "gcr.io", Repository: "proj/app", Digest: "sha256:abc"},
+			ok:   true,
+		},
+		{uri: "https://gcr.io/proj/app:v1"},
+		{uri: "deb://ubuntu:22.04:openssl"},
+	}
+	for _, tc := range tests {
+		got, ok := ParseResourceURI(tc.uri)
+		if ok != tc.ok || got != tc.want {
+			t.Errorf("ParseResourceURI(%q) = %+v, %v; want %+v, %v", tc.uri, got, ok, tc.want, tc.ok)
+		}
+	}
+}
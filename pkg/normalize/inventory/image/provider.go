@@ -0,0 +1,56 @@
+package image
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// NormalizedImage is the common representation produced by each provider.
+type NormalizedImage struct {
+	Provider         string
+	IsBase           bool
+	BronzeTable      string
+	BronzeResourceID string
+	Registry         string
+	Repository       string
+	Digest           string
+	Tags             []string
+	Workloads        []string
+	Vulnerabilities  []Vulnerability
+	CollectedAt      time.Time
+	FirstCollectedAt time.Time
+}
+
+// ResourceID returns the deterministic resource ID: "{provider}:{bronze_resource_id}".
+func (n *NormalizedImage) ResourceID() string {
+	return n.Provider + ":" + n.BronzeResourceID
+}
+
+// Key returns the merge key "{registry}/{repository}@{digest}". Images only
+// referenced by tag share the key of their repository with an empty digest.
+func (n *NormalizedImage) Key() string {
+	return imageKey(n.Registry, n.Repository, n.Digest)
+}
+
+func imageKey(registry, repository, digest string) string {
+	return registry + "/" + repository + "@" + digest
+}
+
+// Vulnerability is one vulnerability occurrence reported for an image digest.
+type Vulnerability struct {
+	OccurrenceID string  `json:"occurrence_id"`
+	CVE          string  `json:"cve"`
+	Severity     string  `json:"severity"` // critical, high, medium, low or unknown
+	CVSSScore    float64 `json:"cvss_score,omitempty"`
+	Package      string  `json:"package,omitempty"`
+	FixAvailable bool    `json:"fix_available"`
+}
+
+// Provider loads bronze data and normalizes it into NormalizedImage records.
+type Provider interface {
+	Key() string
+	Label() string
+	IsBase() bool
+	Load(ctx context.Context, db *sql.DB) ([]NormalizedImage, error)
+}
@@ -0,0 +1,20 @@
+package image
+
+import (
+	"strings"
+
+	"danny.vn/hotpot/pkg/base/imageref"
+)
+
+// ParseResourceURI parses a Container Analysis resource URI such as
+// "https://us-docker.pkg.dev/project/repo/app@sha256:...". It returns false
+// for URIs that do not name an image digest (packages, VMs, ...).
+func ParseResourceURI(uri string) (imageref.Reference, bool) {
+	ref, ok := strings.CutPrefix(uri, "https://")
+	if !ok || !strings.Contains(ref, "@sha256:") {
+		return imageref.Reference{}, false
+	}
+	out := imageref.Parse(ref)
+	out.Tag = ""
+	return out, true
+}
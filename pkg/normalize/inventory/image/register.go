@@ -0,0 +1,25 @@
+package image
+
+import (
+	"database/sql"
+
+	"entgo.io/ent/dialect"
+	"go.temporal.io/sdk/worker"
+
+	"danny.vn/hotpot/pkg/base/config"
+	entimage "danny.vn/hotpot/pkg/storage/ent/inventory/image"
+)
+
+// Register wires image normalize activities and workflow to the worker.
+// Providers are passed in to avoid import cycles (sub-packages import image).
+func Register(w worker.Worker, configService *config.Service, driver dialect.Driver, db *sql.DB, providers []Provider) {
+	entClient := entimage.NewClient(
+		entimage.Driver(driver),
+		entimage.AlternateSchema(entimage.DefaultSchemaConfig()),
+	)
+
+	activities := NewActivities(configService, entClient, db, providers)
+	w.RegisterActivity(activities.NormalizeImageProvider)
+	w.RegisterActivity(activities.MergeImages)
+	w.RegisterWorkflow(NormalizeImagesWorkflow)
+}
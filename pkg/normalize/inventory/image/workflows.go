@@ -0,0 +1,79 @@
+package image
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// NormalizeImagesWorkflowParams holds workflow input parameters.
+type NormalizeImagesWorkflowParams struct {
+	ProviderKeys []string
+}
+
+// NormalizeImagesWorkflowResult holds the final result.
+type NormalizeImagesWorkflowResult struct {
+	NormalizeResults []NormalizeProviderResult
+	MergeResult      MergeImagesResult
+}
+
+// NormalizeImagesWorkflow runs the two-phase normalize+merge pipeline.
+func NormalizeImagesWorkflow(ctx workflow.Context, params NormalizeImagesWorkflowParams) (*NormalizeImagesWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting NormalizeImagesWorkflow")
+
+	activityOpts := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, activityOpts)
+
+	// Phase 1: Normalize all providers in parallel.
+	providerKeys := params.ProviderKeys
+	futures := make([]workflow.Future, len(providerKeys))
+	for i, key := range providerKeys {
+		futures[i] = workflow.ExecuteActivity(activityCtx, NormalizeImageProviderActivity,
+			NormalizeProviderParams{ProviderKey: key})
+	}
+
+	// Wait for all normalizations.
+	result := &NormalizeImagesWorkflowResult{
+		NormalizeResults: make([]NormalizeProviderResult, 0, len(providerKeys)),
+	}
+	var errs []error
+	for i, f := range futures {
+		var nr NormalizeProviderResult
+		if err := f.Get(ctx, &nr); err != nil {
+			logger.Error("Failed to normalize provider", "provider", providerKeys[i], "error", err)
+			errs = append(errs, err)
+		} else {
+			result.NormalizeResults = append(result.NormalizeResults, nr)
+		}
+	}
+
+	// Phase 2: Merge normalized rows into final inventory_images.
+	var mergeResult MergeImagesResult
+	if err := workflow.ExecuteActivity(activityCtx, MergeImagesActivity).Get(ctx, &mergeResult); err != nil {
+		logger.Error("Failed to merge images", "error", err)
+		return result, err
+	}
+	result.MergeResult = mergeResult
+
+	logger.Info("Completed NormalizeImagesWorkflow",
+		"created", mergeResult.Created,
+		"updated", mergeResult.Updated,
+		"deleted", mergeResult.Deleted)
+
+	if len(errs) > 0 {
+		logger.Warn("NormalizeImagesWorkflow completed with provider errors", "errorCount", len(errs))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,119 @@
+package digitalocean
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"danny.vn/hotpot/pkg/normalize/inventory/k8snode"
+)
+
+const (
+	key         = "digitalocean"
+	label       = "DigitalOcean DOKS"
+	bronzeTable = "do_droplets"
+)
+
+// Provider normalizes the nodes embedded in bronze.do_kubernetes_node_pools
+// into NormalizedK8sNode records. Each node is recorded against its droplet,
+// so the node links to the same bronze row as its machine in
+// silver.inventory_machines. Nodes without a droplet yet are skipped.
+type Provider struct{}
+
+func (Provider) Key() string   { return key }
+func (Provider) Label() string { return label }
+func (Provider) IsBase() bool  { return true }
+
+func (Provider) Load(ctx context.Context, db *sql.DB) ([]k8snode.NormalizedK8sNode, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT n->>'droplet_id', COALESCE(n->>'name', ''),
+			COALESCE(n->'status'->>'state', ''),
+			COALESCE(np.name, ''), COALESCE(np.size, ''),
+			COALESCE(c.name, ''), COALESCE(c.region_slug, ''),
+			COALESCE(d.networks_json::text, '{}'),
+			np.collected_at, np.first_collected_at
+		FROM bronze.do_kubernetes_node_pools np
+		JOIN bronze.do_kubernetes_clusters c ON c.resource_id = np.cluster_id
+		CROSS JOIN LATERAL jsonb_array_elements(COALESCE(np.nodes_json, '[]'::jsonb)) n
+		LEFT JOIN bronze.do_droplets d ON d.resource_id = n->>'droplet_id'
+		WHERE COALESCE(n->>'droplet_id', '') <> ''`)
+	if err != nil {
+		return nil, fmt.Errorf("query doks nodes: %w", err)
+	}
+	defer rows.Close()
+
+	var result []k8snode.NormalizedK8sNode
+	seen := make(map[string]bool)
+	for rows.Next() {
+		var (
+			dropletID, name, state, poolName, size string
+			clusterName, region, networksJSON      string
+			collectedAt, firstCollectedAt          sql.NullTime
+		)
+		if err := rows.Scan(&dropletID, &name, &state, &poolName, &size,
+			&clusterName, &region, &networksJSON, &collectedAt, &firstCollectedAt); err != nil {
+			return nil, fmt.Errorf("scan doks node: %w", err)
+		}
+		if seen[dropletID] {
+			continue
+		}
+		seen[dropletID] = true
+
+		internalIP, externalIP := parseNetworks(networksJSON)
+
+		result = append(result, k8snode.NormalizedK8sNode{
+			Provider:         key,
+			IsBase:           true,
+			BronzeTable:      bronzeTable,
+			BronzeResourceID: dropletID,
+			NodeName:         name,
+			ClusterName:      clusterName,
+			NodePool:         poolName,
+			Status:           normalizeStatus(state),
+			CloudZone:        region,
+			CloudMachineType: size,
+			InternalIP:       internalIP,
+			ExternalIP:       externalIP,
+			CollectedAt:      collectedAt.Time,
+			FirstCollectedAt: firstCollectedAt.Time,
+			MergeKeys: map[string][]string{
+				"provider_id": {"digitalocean://" + dropletID},
+			},
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate doks nodes: %w", err)
+	}
+	return result, nil
+}
+
+// parseNetworks returns the first private and public IPv4 of a droplet's
+// networks_json.
+func parseNetworks(jsonStr string) (private, public string) {
+	var networks struct {
+		V4 []struct {
+			IPAddress string `json:"ip_address"`
+			Type      string `json:"type"`
+		} `json:"v4"`
+	}
+	if err := json.Unmarshal([]byte(jsonStr), &networks); err != nil {
+		return "", ""
+	}
+	for _, n := range networks.V4 {
+		switch {
+		case n.Type == "private" && private == "":
+			private = n.IPAddress
+		case n.Type == "public" && public == "":
+			public = n.IPAddress
+		}
+	}
+	return private, public
+}
+
+// normalizeStatus maps DOKS node states (provisioning, running, draining,
+// deleting) to the lowercase status used across providers.
+func normalizeStatus(s string) string {
+	return strings.ToLower(s)
+}
@@ -166,6 +166,7 @@ func (Provider) Load(ctx context.Context, db *sql.DB) ([]k8snode.NormalizedK8sNo
 			FirstCollectedAt: inst.firstCollectedAt.Time,
 			MergeKeys: map[string][]string{
 				"internal_ip": {inst.internalIP},
+				"provider_id": {providerID(inst.projectID, inst.zone, inst.name)},
 			},
 		})
 	}
@@ -183,6 +184,12 @@ func normalizeStatus(s string) string {
 	}
 }
 
+// providerID rebuilds the spec.providerID GKE nodes report, so that nodes
+// from the Kubernetes API merge with their instance.
+func providerID(projectID, zone, name string) string {
+	return "gce://" + projectID + "/" + shortName(zone) + "/" + name
+}
+
 func shortName(url string) string {
 	if i := strings.LastIndex(url, "/"); i >= 0 {
 		return url[i+1:]
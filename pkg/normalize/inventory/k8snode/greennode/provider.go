@@ -0,0 +1,68 @@
+package greennode
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"danny.vn/hotpot/pkg/normalize/inventory/k8snode"
+)
+
+const (
+	key         = "greennode"
+	label       = "GreenNode VKS"
+	bronzeTable = "greennode_compute_servers"
+)
+
+// Provider enriches VKS nodes with their GreenNode server. It is merge-only:
+// servers match nodes from the Kubernetes API by provider ID
+// (vngcloud://{server_id}), and servers that are not nodes are dropped. The
+// match links the node to the same bronze row as its machine in
+// silver.inventory_machines.
+type Provider struct{}
+
+func (Provider) Key() string   { return key }
+func (Provider) Label() string { return label }
+func (Provider) IsBase() bool  { return false }
+
+func (Provider) Load(ctx context.Context, db *sql.DB) ([]k8snode.NormalizedK8sNode, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT resource_id, name, COALESCE(region, ''),
+			COALESCE(flavor_name, ''), COALESCE(project_id, ''),
+			collected_at, first_collected_at
+		FROM bronze.greennode_compute_servers
+		WHERE status <> 'STOPPED'`)
+	if err != nil {
+		return nil, fmt.Errorf("query greennode servers: %w", err)
+	}
+	defer rows.Close()
+
+	var result []k8snode.NormalizedK8sNode
+	for rows.Next() {
+		var resourceID, name, region, flavorName, projectID string
+		var collectedAt, firstCollectedAt sql.NullTime
+		if err := rows.Scan(&resourceID, &name, &region, &flavorName, &projectID,
+			&collectedAt, &firstCollectedAt); err != nil {
+			return nil, fmt.Errorf("scan greennode server: %w", err)
+		}
+
+		result = append(result, k8snode.NormalizedK8sNode{
+			Provider:         key,
+			BronzeTable:      bronzeTable,
+			BronzeResourceID: resourceID,
+			NodeName:         name,
+			CloudProject:     projectID,
+			CloudZone:        region,
+			CloudMachineType: flavorName,
+			CollectedAt:      collectedAt.Time,
+			FirstCollectedAt: firstCollectedAt.Time,
+			MergeKeys: map[string][]string{
+				"provider_id": {"vngcloud://" + resourceID},
+			},
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate greennode servers: %w", err)
+	}
+	return result, nil
+}
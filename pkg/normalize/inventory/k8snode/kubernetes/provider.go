@@ -0,0 +1,97 @@
+package kubernetes
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"danny.vn/hotpot/pkg/normalize/inventory/k8snode"
+)
+
+const (
+	key         = "kubernetes"
+	label       = "Kubernetes API"
+	bronzeTable = "k8s_nodes"
+)
+
+// Provider normalizes bronze.k8s_nodes, the nodes reported by each cluster's
+// API, into NormalizedK8sNode records. It covers clusters without a cloud
+// node source (on-premise, VKS) and merges with cloud nodes on the node's
+// spec.providerID, which the cloud providers rebuild from their instances.
+type Provider struct{}
+
+func (Provider) Key() string   { return key }
+func (Provider) Label() string { return label }
+func (Provider) IsBase() bool  { return true }
+
+func (Provider) Load(ctx context.Context, db *sql.DB) ([]k8snode.NormalizedK8sNode, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT resource_id, cluster_name, name,
+			COALESCE(provider_id, ''), COALESCE(node_pool, ''),
+			COALESCE(zone, ''), COALESCE(instance_type, ''),
+			COALESCE(internal_ip, ''), COALESCE(external_ip, ''),
+			ready, unschedulable,
+			collected_at, first_collected_at
+		FROM bronze.k8s_nodes`)
+	if err != nil {
+		return nil, fmt.Errorf("query k8s nodes: %w", err)
+	}
+	defer rows.Close()
+
+	var result []k8snode.NormalizedK8sNode
+	for rows.Next() {
+		var (
+			resourceID, clusterName, name, providerID, nodePool string
+			zone, instanceType, internalIP, externalIP          string
+			ready, unschedulable                                bool
+			collectedAt, firstCollectedAt                       sql.NullTime
+		)
+		if err := rows.Scan(&resourceID, &clusterName, &name, &providerID, &nodePool,
+			&zone, &instanceType, &internalIP, &externalIP, &ready, &unschedulable,
+			&collectedAt, &firstCollectedAt); err != nil {
+			return nil, fmt.Errorf("scan k8s node: %w", err)
+		}
+
+		result = append(result, k8snode.NormalizedK8sNode{
+			Provider:         key,
+			IsBase:           true,
+			BronzeTable:      bronzeTable,
+			BronzeResourceID: resourceID,
+			NodeName:         name,
+			ClusterName:      clusterName,
+			NodePool:         nodePool,
+			Status:           nodeStatus(ready, unschedulable),
+			CloudZone:        zone,
+			CloudMachineType: instanceType,
+			InternalIP:       internalIP,
+			ExternalIP:       externalIP,
+			CollectedAt:      collectedAt.Time,
+			FirstCollectedAt: firstCollectedAt.Time,
+			MergeKeys:        mergeKeys(resourceID, providerID),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate k8s nodes: %w", err)
+	}
+	return result, nil
+}
+
+// mergeKeys keys a node by its provider ID, or by its own resource ID
+// ({cluster_name}/{name}) when the cluster has no cloud controller.
+func mergeKeys(resourceID, providerID string) map[string][]string {
+	if providerID == "" {
+		return map[string][]string{"node": {resourceID}}
+	}
+	return map[string][]string{"provider_id": {providerID}}
+}
+
+func nodeStatus(ready, unschedulable bool) string {
+	switch {
+	case !ready:
+		return "not_ready"
+	case unschedulable:
+		return "cordoned"
+	default:
+		return "running"
+	}
+}
@@ -0,0 +1,79 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"danny.vn/hotpot/pkg/normalize/inventory/k8snode"
+)
+
+func TestNodeStatus(t *testing.T) {
+	tests := []struct {
+		ready, unschedulable bool
+		want                 string
+	}{
+		{true, false, "running"},
+		{true, true, "cordoned"},
+		{false, true, "not_ready"},
+		{false, false, "not_ready"},
+	}
+	for _, tt := range tests {
+		if got := nodeStatus(tt.ready, tt.unschedulable); got != tt.want {
+			t.Errorf("nodeStatus(%v, %v) = %q, want %q", tt.ready, tt.unschedulable, got, tt.want)
+		}
+	}
+}
+
+func TestMergeWithCloudNodes(t *testing.T) {
+	rows := []k8snode.NormalizedK8sNode{
+		{
+			Provider: "gcp", IsBase: true, BronzeTable: "gcp_compute_instances", BronzeResourceID: "123",
+			NodeName: "gke-prod-pool-1", ClusterName: "prod", CloudMachineType: "e2-standard-4",
+			MergeKeys: map[string][]string{"internal_ip": {"10.0.0.5"}, "provider_id": {"gce://p/asia-southeast1-a/gke-prod-pool-1"}},
+		},
+		{
+			Provider: key, IsBase: true, BronzeTable: bronzeTable, BronzeResourceID: "prod-gke/gke-prod-pool-1",
+			NodeName: "gke-prod-pool-1", ClusterName: "prod-gke", Status: "running",
+			MergeKeys: mergeKeys("prod-gke/gke-prod-pool-1", "gce://p/asia-southeast1-a/gke-prod-pool-1"),
+		},
+		{
+			Provider: key, IsBase: true, BronzeTable: bronzeTable, BronzeResourceID: "vks/node-a",
+			NodeName: "node-a", ClusterName: "vks", Status: "running",
+			MergeKeys: mergeKeys("vks/node-a", "vngcloud://ins-1"),
+		},
+		{
+			Provider: key, IsBase: true, BronzeTable: bronzeTable, BronzeResourceID: "onprem/worker-1",
+			NodeName: "worker-1", ClusterName: "onprem", Status: "running",
+			MergeKeys: mergeKeys("onprem/worker-1", ""),
+		},
+		{
+			Provider: "greennode", BronzeTable: "greennode_compute_servers", BronzeResourceID: "ins-1",
+			NodeName: "vks-node-a", CloudMachineType: "v2-highcpu-4",
+			MergeKeys: map[string][]string{"provider_id": {"vngcloud://ins-1"}},
+		},
+		{
+			Provider: "greennode", BronzeTable: "greennode_compute_servers", BronzeResourceID: "ins-2",
+			NodeName:  "not-a-node",
+			MergeKeys: map[string][]string{"provider_id": {"vngcloud://ins-2"}},
+		},
+	}
+
+	merged := k8snode.MergeK8sNodes(rows, []string{"gcp", "digitalocean", key, "greennode"})
+	if len(merged) != 3 {
+		t.Fatalf("got %d nodes, want 3: %+v", len(merged), merged)
+	}
+
+	gke := merged[0]
+	if gke.ClusterName != "prod" || gke.Status != "running" || len(gke.BronzeLinks) != 2 {
+		t.Errorf("gke node = %+v", gke)
+	}
+
+	vks := merged[1]
+	if vks.NodeName != "node-a" || vks.CloudMachineType != "v2-highcpu-4" || len(vks.BronzeLinks) != 2 ||
+		vks.BronzeLinks[1].BronzeTable != "greennode_compute_servers" {
+		t.Errorf("vks node = %+v", vks)
+	}
+
+	if onprem := merged[2]; onprem.NodeName != "worker-1" || len(onprem.BronzeLinks) != 1 {
+		t.Errorf("on-prem node = %+v", onprem)
+	}
+}
@@ -0,0 +1,279 @@
+package aws
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/normalize/inventory/machine"
+)
+
+const (
+	key         = "aws"
+	label       = "AWS EC2"
+	bronzeTable = "aws_ec2_instances"
+)
+
+// Provider normalizes bronze.aws_ec2_instances into NormalizedMachine records.
+// Terminated instances are excluded.
+//
+// Merge keys are the ENI MAC addresses and the Name tag hostname, which S1
+// and MEEC also report, plus private IPs and private DNS names scoped by VPC
+// ("vpc-123/10.0.1.5") and public IPs. Private addresses repeat across
+// accounts (every default VPC is 172.31.0.0/16), so they are only unique
+// together with the VPC, and the private DNS short name ("ip-172-31-0-10")
+// is not used as a hostname; Name tags shared by several instances are
+// dropped.
+type Provider struct{}
+
+// instance is an EC2 instance with the addresses of its attached ENIs.
+type instance struct {
+	id               string
+	name             string
+	instanceType     string
+	state            string
+	vpcID            string
+	privateIP        string
+	publicIP         string
+	platform         string
+	launchTime       sql.NullTime
+	accountID        string
+	region           string
+	collectedAt      sql.NullTime
+	firstCollectedAt sql.NullTime
+	zone             string
+	environment      string
+	macs             []string
+	privateIPs       []string
+	publicIPs        []string
+	privateDNS       []string
+}
+
+func (Provider) Key() string   { return key }
+func (Provider) Label() string { return label }
+func (Provider) IsBase() bool  { return true }
+
+func (Provider) Load(ctx context.Context, db *sql.DB) ([]machine.NormalizedMachine, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT resource_id, COALESCE(name, ''),
+			COALESCE(instance_type, ''), COALESCE(state, ''),
+			COALESCE(vpc_id, ''), COALESCE(private_ip_address, ''),
+			COALESCE(public_ip_address, ''), COALESCE(platform, ''),
+			launch_time, account_id, region,
+			collected_at, first_collected_at
+		FROM bronze.aws_ec2_instances`)
+	if err != nil {
+		return nil, fmt.Errorf("query aws ec2 instances: %w", err)
+	}
+	defer rows.Close()
+
+	instances := make(map[string]*instance)
+	var order []string
+	for rows.Next() {
+		var inst instance
+		if err := rows.Scan(&inst.id, &inst.name, &inst.instanceType, &inst.state,
+			&inst.vpcID, &inst.privateIP, &inst.publicIP, &inst.platform,
+			&inst.launchTime, &inst.accountID, &inst.region,
+			&inst.collectedAt, &inst.firstCollectedAt); err != nil {
+			return nil, fmt.Errorf("scan aws ec2 instance: %w", err)
+		}
+		if inst.state == "terminated" || inst.state == "shutting-down" {
+			continue
+		}
+		instances[inst.id] = &inst
+		order = append(order, inst.id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate aws ec2 instances: %w", err)
+	}
+
+	// Load attached ENIs for MACs, addresses and availability zone.
+	eniRows, err := db.QueryContext(ctx, `
+		SELECT attachment_instance_id, COALESCE(mac_address, ''),
+			COALESCE(availability_zone, ''), COALESCE(private_dns_name, ''),
+			COALESCE(public_ip, ''), COALESCE(private_ip_addresses_json::text, '[]')
+		FROM bronze.aws_ec2_network_interfaces
+		WHERE attachment_instance_id IS NOT NULL AND attachment_instance_id != ''`)
+	if err != nil {
+		return nil, fmt.Errorf("query aws ec2 network interfaces: %w", err)
+	}
+	defer eniRows.Close()
+
+	for eniRows.Next() {
+		var instanceID, mac, zone, privateDNS, publicIP, addrsJSON string
+		if err := eniRows.Scan(&instanceID, &mac, &zone, &privateDNS, &publicIP, &addrsJSON); err != nil {
+			return nil, fmt.Errorf("scan aws ec2 network interface: %w", err)
+		}
+		inst, ok := instances[instanceID]
+		if !ok {
+			continue
+		}
+		if norm := machine.NormalizeMAC(mac); norm != "" {
+			inst.macs = append(inst.macs, norm)
+		}
+		if inst.zone == "" {
+			inst.zone = zone
+		}
+		if privateDNS != "" {
+			inst.privateDNS = append(inst.privateDNS, strings.ToLower(privateDNS))
+		}
+		if publicIP != "" {
+			inst.publicIPs = append(inst.publicIPs, publicIP)
+		}
+		private, public := parsePrivateIPAddresses(addrsJSON)
+		inst.privateIPs = append(inst.privateIPs, private...)
+		inst.publicIPs = append(inst.publicIPs, public...)
+	}
+	if err := eniRows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate aws ec2 network interfaces: %w", err)
+	}
+
+	// Environment from the instance's Environment/Env tag.
+	tagRows, err := db.QueryContext(ctx, `
+		SELECT bronze_awsec2instance_tags, COALESCE(value, '')
+		FROM bronze.aws_ec2_instance_tags
+		WHERE LOWER(key) IN ('environment', 'env')`)
+	if err != nil {
+		return nil, fmt.Errorf("query aws ec2 instance tags: %w", err)
+	}
+	defer tagRows.Close()
+
+	for tagRows.Next() {
+		var instanceID, value string
+		if err := tagRows.Scan(&instanceID, &value); err != nil {
+			return nil, fmt.Errorf("scan aws ec2 instance tag: %w", err)
+		}
+		if inst, ok := instances[instanceID]; ok && inst.environment == "" {
+			inst.environment = value
+		}
+	}
+	if err := tagRows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate aws ec2 instance tags: %w", err)
+	}
+
+	result := make([]machine.NormalizedMachine, 0, len(order))
+	for _, id := range order {
+		inst := instances[id]
+
+		if inst.privateIP != "" {
+			inst.privateIPs = append(inst.privateIPs, inst.privateIP)
+		}
+		if inst.publicIP != "" {
+			inst.publicIPs = append(inst.publicIPs, inst.publicIP)
+		}
+
+		hostname := inst.name
+		if hostname == "" && len(inst.privateDNS) > 0 {
+			hostname, _, _ = strings.Cut(inst.privateDNS[0], ".")
+		}
+		if hostname == "" {
+			hostname = inst.id
+		}
+
+		environment := inst.environment
+		if environment == "" {
+			environment = machine.InferEnvironment(hostname, "")
+		}
+
+		osType := "linux"
+		if strings.EqualFold(inst.platform, "windows") {
+			osType = "windows"
+		}
+
+		zone := inst.zone
+		if zone == "" {
+			zone = inst.region
+		}
+
+		var created *time.Time
+		if inst.launchTime.Valid {
+			created = &inst.launchTime.Time
+		}
+
+		result = append(result, machine.NormalizedMachine{
+			Provider:         key,
+			IsBase:           true,
+			BronzeTable:      bronzeTable,
+			BronzeResourceID: inst.id,
+			Hostname:         hostname,
+			OSType:           osType,
+			Status:           normalizeState(inst.state),
+			InternalIP:       inst.privateIP,
+			ExternalIP:       inst.publicIP,
+			Environment:      environment,
+			CloudProject:     inst.accountID,
+			CloudZone:        zone,
+			CloudMachineType: inst.instanceType,
+			Created:          created,
+			CollectedAt:      inst.collectedAt.Time,
+			FirstCollectedAt: inst.firstCollectedAt.Time,
+			MergeKeys:        inst.mergeKeys(),
+		})
+	}
+	machine.DropSharedHostnames(result)
+	return result, nil
+}
+
+// mergeKeys returns the merge keys of inst. Call it after the instance's own
+// private and public IPs are added to the ENI addresses.
+func (inst *instance) mergeKeys() map[string][]string {
+	var hostnames []string
+	if h := machine.NormalizeHostname(inst.name); h != "" {
+		hostnames = append(hostnames, h)
+	}
+	return map[string][]string{
+		"mac":         inst.macs,
+		"internal_ip": scoped(inst.vpcID, inst.privateIPs),
+		"external_ip": inst.publicIPs,
+		"private_dns": scoped(inst.vpcID, inst.privateDNS),
+		"hostname":    hostnames,
+	}
+}
+
+// parsePrivateIPAddresses extracts the private and associated public IPs of
+// an ENI's private_ip_addresses_json.
+func parsePrivateIPAddresses(jsonStr string) (private, public []string) {
+	var addrs []struct {
+		PrivateIPAddress string `json:"private_ip_address"`
+		PublicIP         string `json:"public_ip"`
+	}
+	if err := json.Unmarshal([]byte(jsonStr), &addrs); err != nil {
+		return nil, nil
+	}
+	for _, a := range addrs {
+		if a.PrivateIPAddress != "" {
+			private = append(private, a.PrivateIPAddress)
+		}
+		if a.PublicIP != "" {
+			public = append(public, a.PublicIP)
+		}
+	}
+	return private, public
+}
+
+// scoped prefixes VPC-local values with the VPC ID. Without a VPC the values
+// are not unique and are dropped.
+func scoped(vpcID string, values []string) []string {
+	if vpcID == "" {
+		return nil
+	}
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		out = append(out, vpcID+"/"+v)
+	}
+	return out
+}
+
+func normalizeState(s string) string {
+	switch s {
+	case "running", "pending":
+		return "running"
+	case "stopped", "stopping":
+		return "stopped"
+	default:
+		return s
+	}
+}
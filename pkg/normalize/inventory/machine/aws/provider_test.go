@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"danny.vn/hotpot/pkg/normalize/inventory/machine"
+)
+
+func TestParsePrivateIPAddresses(t *testing.T) {
+	private, public := parsePrivateIPAddresses(`[
+		{"private_ip_address":"10.0.1.5","primary":true,"public_ip":"203.0.113.7"},
+		{"private_ip_address":"10.0.1.6"}
+	]`)
+	if !slices.Equal(private, []string{"10.0.1.5", "10.0.1.6"}) {
+		t.Errorf("private = %v", private)
+	}
+	if !slices.Equal(public, []string{"203.0.113.7"}) {
+		t.Errorf("public = %v", public)
+	}
+
+	if p, q := parsePrivateIPAddresses(`not json`); p != nil || q != nil {
+		t.Errorf("invalid json = %v, %v", p, q)
+	}
+}
+
+func TestMergeKeys(t *testing.T) {
+	inst := &instance{
+		name:       "API-01",
+		vpcID:      "vpc-123",
+		macs:       []string{"0A:1B:2C:3D:4E:5F"},
+		privateIPs: []string{"10.0.1.5"},
+		publicIPs:  []string{"203.0.113.7"},
+		privateDNS: []string{"ip-10-0-1-5.ec2.internal"},
+	}
+	want := map[string][]string{
+		"mac":         {"0A:1B:2C:3D:4E:5F"},
+		"internal_ip": {"vpc-123/10.0.1.5"},
+		"external_ip": {"203.0.113.7"},
+		"private_dns": {"vpc-123/ip-10-0-1-5.ec2.internal"},
+		"hostname":    {"api-01"},
+	}
+	if got := inst.mergeKeys(); !reflect.DeepEqual(got, want) {
+		t.Errorf("mergeKeys() = %v, want %v", got, want)
+	}
+
+	// Without a VPC, private addresses are not unique and are dropped, and
+	// the private DNS short name is never a hostname.
+	inst = &instance{privateIPs: []string{"172.31.0.10"}, privateDNS: []string{"ip-172-31-0-10.ec2.internal"}}
+	got := inst.mergeKeys()
+	if len(got["internal_ip"]) != 0 || len(got["private_dns"]) != 0 || len(got["hostname"]) != 0 {
+		t.Errorf("no vpc: keys = %v", got)
+	}
+}
+
+func TestMergeWithAgent(t *testing.T) {
+	inst := &instance{
+		id:         "i-abc",
+		name:       "api-01",
+		vpcID:      "vpc-123",
+		privateIP:  "172.31.0.10",
+		privateIPs: []string{"172.31.0.10"},
+		privateDNS: []string{"ip-172-31-0-10.ec2.internal"},
+	}
+	agent := func(id, hostname, ip string) machine.NormalizedMachine {
+		return machine.NormalizedMachine{Provider: "s1", IsBase: true, BronzeResourceID: id, Hostname: hostname, InternalIP: ip,
+			MergeKeys: map[string][]string{"hostname": {machine.NormalizeHostname(hostname)}}}
+	}
+	ec2 := machine.NormalizedMachine{Provider: key, IsBase: true, BronzeResourceID: inst.id,
+		InternalIP: inst.privateIP, MergeKeys: inst.mergeKeys()}
+
+	tests := []struct {
+		name  string
+		agent machine.NormalizedMachine
+		want  int
+	}{
+		{"name tag and ip", agent("agent-1", "API-01.corp.example.com", "172.31.0.10"), 1},
+		// The same default-VPC address in an account that is not ingested.
+		{"private dns name", agent("agent-2", "ip-172-31-0-10", "172.31.0.10"), 2},
+		{"name tag, other ip", agent("agent-3", "api-01", "10.0.0.7"), 2},
+	}
+	for _, tt := range tests {
+		if merged := machine.MergeMachines([]machine.NormalizedMachine{tt.agent, ec2}, []string{"s1", key}); len(merged) != tt.want {
+			t.Errorf("%s: got %d machines, want %d", tt.name, len(merged), tt.want)
+		}
+	}
+}
+
+func TestScoped(t *testing.T) {
+	if got := scoped("vpc-1", []string{"10.0.0.1"}); !slices.Equal(got, []string{"vpc-1/10.0.0.1"}) {
+		t.Errorf("scoped = %v", got)
+	}
+	if got := scoped("", []string{"10.0.0.1"}); got != nil {
+		t.Errorf("scoped without vpc = %v", got)
+	}
+}
@@ -0,0 +1,186 @@
+package digitalocean
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/normalize/inventory/machine"
+)
+
+const (
+	key         = "digitalocean"
+	label       = "DigitalOcean"
+	bronzeTable = "do_droplets"
+)
+
+// Provider normalizes bronze.do_droplets into NormalizedMachine records.
+// Archived droplets are excluded.
+//
+// The DigitalOcean API reports no MAC addresses. Droplets merge with agent
+// providers on their name, which is the droplet's hostname, when the
+// private or public IP agrees and no other droplet has the same name; among
+// themselves they merge on private IPs
+// scoped by VPC ("<vpc_uuid>/10.104.0.2") and public IPs.
+type Provider struct{}
+
+func (Provider) Key() string   { return key }
+func (Provider) Label() string { return label }
+func (Provider) IsBase() bool  { return true }
+
+func (Provider) Load(ctx context.Context, db *sql.DB) ([]machine.NormalizedMachine, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT d.resource_id, d.name, COALESCE(d.region, ''),
+			COALESCE(d.size_slug, ''), COALESCE(d.status, ''),
+			COALESCE(d.vpc_uuid, ''), COALESCE(d.api_created_at, ''),
+			COALESCE(d.image_json::text, '{}'), COALESCE(d.networks_json::text, '{}'),
+			COALESCE(p.name, ''), COALESCE(p.environment, ''),
+			d.collected_at, d.first_collected_at
+		FROM bronze.do_droplets d
+		LEFT JOIN bronze.do_project_resources pr ON pr.urn = 'do:droplet:' || d.resource_id
+		LEFT JOIN bronze.do_projects p ON p.resource_id = pr.project_id`)
+	if err != nil {
+		return nil, fmt.Errorf("query do droplets: %w", err)
+	}
+	defer rows.Close()
+
+	var result []machine.NormalizedMachine
+	seen := make(map[string]bool)
+	for rows.Next() {
+		var (
+			id, name, region, sizeSlug, status, vpcUUID, createdAt string
+			imageJSON, networksJSON, projectName, projectEnv       string
+			collectedAt, firstCollectedAt                          sql.NullTime
+		)
+		if err := rows.Scan(&id, &name, &region, &sizeSlug, &status, &vpcUUID, &createdAt,
+			&imageJSON, &networksJSON, &projectName, &projectEnv,
+			&collectedAt, &firstCollectedAt); err != nil {
+			return nil, fmt.Errorf("scan do droplet: %w", err)
+		}
+		if status == "archive" || seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		private, public := parseNetworks(networksJSON)
+		osName := parseImageOSName(imageJSON)
+
+		environment := projectEnv
+		if environment == "" {
+			environment = machine.InferEnvironment(name, "")
+		}
+
+		var created *time.Time
+		if t, err := time.Parse(time.RFC3339, createdAt); err == nil {
+			created = &t
+		}
+
+		var internalIP, externalIP string
+		if len(private) > 0 {
+			internalIP = private[0]
+		}
+		if len(public) > 0 {
+			externalIP = public[0]
+		}
+
+		var internalKeys []string
+		if vpcUUID != "" {
+			for _, ip := range private {
+				internalKeys = append(internalKeys, vpcUUID+"/"+ip)
+			}
+		}
+
+		result = append(result, machine.NormalizedMachine{
+			Provider:         key,
+			IsBase:           true,
+			BronzeTable:      bronzeTable,
+			BronzeResourceID: id,
+			Hostname:         name,
+			OSType:           osType(osName),
+			OSName:           osName,
+			Status:           normalizeStatus(status),
+			InternalIP:       internalIP,
+			ExternalIP:       externalIP,
+			Environment:      environment,
+			CloudProject:     projectName,
+			CloudZone:        region,
+			CloudMachineType: sizeSlug,
+			Created:          created,
+			CollectedAt:      collectedAt.Time,
+			FirstCollectedAt: firstCollectedAt.Time,
+			MergeKeys: map[string][]string{
+				"internal_ip": internalKeys,
+				"external_ip": public,
+				"hostname":    {machine.NormalizeHostname(name)},
+			},
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate do droplets: %w", err)
+	}
+	machine.DropSharedHostnames(result)
+	return result, nil
+}
+
+// parseNetworks returns the private and public IPv4 addresses of a droplet's
+// networks_json.
+func parseNetworks(jsonStr string) (private, public []string) {
+	var networks struct {
+		V4 []struct {
+			IPAddress string `json:"ip_address"`
+			Type      string `json:"type"`
+		} `json:"v4"`
+	}
+	if err := json.Unmarshal([]byte(jsonStr), &networks); err != nil {
+		return nil, nil
+	}
+	for _, n := range networks.V4 {
+		if n.IPAddress == "" {
+			continue
+		}
+		switch n.Type {
+		case "private":
+			private = append(private, n.IPAddress)
+		case "public":
+			public = append(public, n.IPAddress)
+		}
+	}
+	return private, public
+}
+
+// parseImageOSName builds an OS name such as "Ubuntu 22.04 (LTS) x64" from a
+// droplet's image_json.
+func parseImageOSName(jsonStr string) string {
+	var image struct {
+		Name         string `json:"name"`
+		Distribution string `json:"distribution"`
+	}
+	if err := json.Unmarshal([]byte(jsonStr), &image); err != nil {
+		return ""
+	}
+	if image.Distribution == "" || strings.HasPrefix(image.Name, image.Distribution) {
+		return image.Name
+	}
+	return strings.TrimSpace(image.Distribution + " " + image.Name)
+}
+
+func osType(osName string) string {
+	if strings.Contains(strings.ToLower(osName), "windows") {
+		return "windows"
+	}
+	return "linux"
+}
+
+func normalizeStatus(s string) string {
+	switch s {
+	case "active", "new":
+		return "running"
+	case "off":
+		return "stopped"
+	default:
+		return s
+	}
+}
@@ -0,0 +1,40 @@
+package digitalocean
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseNetworks(t *testing.T) {
+	private, public := parseNetworks(`{"v4":[
+		{"ip_address":"10.104.0.2","netmask":"255.255.240.0","type":"private"},
+		{"ip_address":"203.0.113.10","netmask":"255.255.255.0","gateway":"203.0.113.1","type":"public"}
+	],"v6":[{"ip_address":"2001:db8::1","type":"public"}]}`)
+	if !slices.Equal(private, []string{"10.104.0.2"}) {
+		t.Errorf("private = %v", private)
+	}
+	if !slices.Equal(public, []string{"203.0.113.10"}) {
+		t.Errorf("public = %v", public)
+	}
+
+	if p, q := parseNetworks(`not json`); p != nil || q != nil {
+		t.Errorf("invalid json = %v, %v", p, q)
+	}
+}
+
+func TestParseImageOSName(t *testing.T) {
+	tests := []struct {
+		json string
+		want string
+	}{
+		{`{"name":"22.04 (LTS) x64","distribution":"Ubuntu"}`, "Ubuntu 22.04 (LTS) x64"},
+		{`{"name":"Debian 12 x64","distribution":"Debian"}`, "Debian 12 x64"},
+		{`{"name":"my-snapshot"}`, "my-snapshot"},
+		{`{}`, ""},
+	}
+	for _, tt := range tests {
+		if got := parseImageOSName(tt.json); got != tt.want {
+			t.Errorf("parseImageOSName(%s) = %q, want %q", tt.json, got, tt.want)
+		}
+	}
+}
@@ -17,6 +17,8 @@ const (
 )
 
 // Provider normalizes bronze.meec_inventory_computers into NormalizedMachine records.
+// Computers merge on their MAC addresses and on their hostname when no other
+// computer shares it and the IP address agrees.
 type Provider struct{}
 
 func (Provider) Key() string   { return key }
@@ -31,6 +33,7 @@ func (Provider) Load(ctx context.Context, db *sql.DB) ([]machine.NormalizedMachi
 			computer_live_status,
 			collected_at, first_collected_at,
 			COALESCE(mac_address, ''),
+			COALESCE(ip_address, ''),
 			agent_installed_on
 		FROM bronze.meec_inventory_computers`)
 	if err != nil {
@@ -40,12 +43,12 @@ func (Provider) Load(ctx context.Context, db *sql.DB) ([]machine.NormalizedMachi
 
 	var result []machine.NormalizedMachine
 	for rows.Next() {
-		var resourceID, hostname, osType, osName, macRaw string
+		var resourceID, hostname, osType, osName, macRaw, ipRaw string
 		var liveStatus int
 		var collectedAt, firstCollectedAt sql.NullTime
 		var agentInstalledOn sql.NullInt64
 		if err := rows.Scan(&resourceID, &hostname, &osType, &osName,
-			&liveStatus, &collectedAt, &firstCollectedAt, &macRaw, &agentInstalledOn); err != nil {
+			&liveStatus, &collectedAt, &firstCollectedAt, &macRaw, &ipRaw, &agentInstalledOn); err != nil {
 			return nil, fmt.Errorf("scan meec computer: %w", err)
 		}
 
@@ -56,6 +59,8 @@ func (Provider) Load(ctx context.Context, db *sql.DB) ([]machine.NormalizedMachi
 			}
 		}
 
+		internalIP, _, _ := strings.Cut(ipRaw, ",")
+
 		status := "running"
 		if liveStatus != 1 {
 			status = "stopped"
@@ -75,16 +80,19 @@ func (Provider) Load(ctx context.Context, db *sql.DB) ([]machine.NormalizedMachi
 			OSType:           osType,
 			OSName:           osName,
 			Status:           status,
+			InternalIP:       strings.TrimSpace(internalIP),
 			Created:          created,
 			CollectedAt:      collectedAt.Time,
 			FirstCollectedAt: firstCollectedAt.Time,
 			MergeKeys: map[string][]string{
-				"mac": macs,
+				"mac":      macs,
+				"hostname": {machine.NormalizeHostname(hostname)},
 			},
 		})
 	}
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("iterate meec computers: %w", err)
 	}
+	machine.DropSharedHostnames(result)
 	return result, nil
 }
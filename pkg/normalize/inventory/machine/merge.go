@@ -1,6 +1,7 @@
 package machine
 
 import (
+	"strings"
 	"time"
 
 	"danny.vn/hotpot/pkg/normalize/inventory/mergeutil"
@@ -66,16 +67,37 @@ func (p *mergePool) add(m MergedMachine, mergeKeys map[string][]string) {
 	}
 }
 
-// find returns the index of an existing machine sharing any merge key, or -1.
-func (p *mergePool) find(mergeKeys map[string][]string) int {
-	for _, key := range mergeutil.NamespacedKeys(mergeKeys) {
+// hostnameKey is the weak merge key type: names repeat across unrelated
+// machines (clones, default names, the same name in two clouds), so a
+// hostname only matches when the internal or external IP also agrees.
+const hostnameKey = "hostname"
+
+// find returns the index of an existing machine sharing a merge key with
+// row, or -1.
+func (p *mergePool) find(row *NormalizedMachine) int {
+	for _, key := range mergeutil.NamespacedKeys(row.MergeKeys) {
+		if strings.HasPrefix(key, hostnameKey+":") {
+			continue
+		}
 		if idx, ok := p.keyIndex[key]; ok {
 			return idx
 		}
 	}
+	for _, h := range row.MergeKeys[hostnameKey] {
+		if idx, ok := p.keyIndex[hostnameKey+":"+h]; ok && sameAddress(&p.machines[idx].machine, row) {
+			return idx
+		}
+	}
 	return -1
 }
 
+// sameAddress reports whether row shares the merged machine's internal or
+// external IP.
+func sameAddress(m *MergedMachine, row *NormalizedMachine) bool {
+	return (m.InternalIP != "" && m.InternalIP == row.InternalIP) ||
+		(m.ExternalIP != "" && m.ExternalIP == row.ExternalIP)
+}
+
 // absorbKeys adds new merge keys to an existing machine and updates the index.
 func (p *mergePool) absorbKeys(idx int, mergeKeys map[string][]string) {
 	mm := p.machines[idx]
@@ -107,7 +129,7 @@ func MergeMachines(rows []NormalizedMachine, providerOrder []string) []MergedMac
 				BronzeResourceID: row.BronzeResourceID,
 			}
 
-			if idx := pool.find(row.MergeKeys); idx >= 0 {
+			if idx := pool.find(row); idx >= 0 {
 				// Matched existing machine — enrich it.
 				m := &pool.machines[idx].machine
 				mergeutil.SetIfEmpty(&m.Hostname, row.Hostname)
@@ -276,3 +276,69 @@ func TestInferEnvironment(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeHostname(t *testing.T) {
+	tests := map[string]string{
+		"WEB-01.corp.example.com":  "web-01",
+		" web-01 ":                 "web-01",
+		"ip-10-0-1-5.ec2.internal": "ip-10-0-1-5",
+		"localhost":                "",
+		"":                         "",
+	}
+	for input, want := range tests {
+		if got := NormalizeHostname(input); got != want {
+			t.Errorf("NormalizeHostname(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestDropSharedHostnames(t *testing.T) {
+	rows := []NormalizedMachine{
+		{BronzeResourceID: "1", MergeKeys: map[string][]string{"hostname": {"web-01", "web-01"}}},
+		{BronzeResourceID: "2", MergeKeys: map[string][]string{"hostname": {"ubuntu-s-1vcpu", "db-01"}}},
+		{BronzeResourceID: "3", MergeKeys: map[string][]string{"hostname": {"ubuntu-s-1vcpu"}}},
+		{BronzeResourceID: "4", MergeKeys: map[string][]string{"mac": {"AA:BB:CC:DD:EE:FF"}}},
+	}
+	DropSharedHostnames(rows)
+
+	want := [][]string{{"web-01", "web-01"}, {"db-01"}, {}, nil}
+	for i, row := range rows {
+		if got := row.MergeKeys["hostname"]; len(got) != len(want[i]) || (len(got) > 0 && got[0] != want[i][0]) {
+			t.Errorf("row %s hostnames = %v, want %v", row.BronzeResourceID, got, want[i])
+		}
+	}
+	if _, ok := rows[3].MergeKeys["hostname"]; ok {
+		t.Error("row without hostname keys gained one")
+	}
+}
+
+func TestMergeMachinesAcrossProviders(t *testing.T) {
+	rows := []NormalizedMachine{
+		{Provider: "s1", IsBase: true, BronzeResourceID: "agent-1", Hostname: "web-01", InternalIP: "10.104.0.2",
+			MergeKeys: map[string][]string{"mac": {"02:00:00:00:00:01"}, "hostname": {"web-01"}}},
+		{Provider: "s1", IsBase: true, BronzeResourceID: "agent-2", Hostname: "ip-10-0-1-5",
+			MergeKeys: map[string][]string{"mac": {"0A:00:00:00:00:02"}, "hostname": {"ip-10-0-1-5"}}},
+		{Provider: "s1", IsBase: true, BronzeResourceID: "agent-3", Hostname: "db-01", InternalIP: "172.31.0.10",
+			MergeKeys: map[string][]string{"mac": {"02:00:00:00:00:03"}, "hostname": {"db-01"}}},
+		{Provider: "digitalocean", IsBase: true, BronzeResourceID: "123", Hostname: "web-01", InternalIP: "10.104.0.2", CloudZone: "sgp1",
+			MergeKeys: map[string][]string{"internal_ip": {"vpc-a/10.104.0.2"}, "hostname": {"web-01"}}},
+		{Provider: "digitalocean", IsBase: true, BronzeResourceID: "456", Hostname: "db-01", InternalIP: "10.104.0.3", CloudZone: "sgp1",
+			MergeKeys: map[string][]string{"internal_ip": {"vpc-a/10.104.0.3"}, "hostname": {"db-01"}}},
+		{Provider: "aws", IsBase: true, BronzeResourceID: "i-abc", Hostname: "api", CloudZone: "us-east-1a",
+			MergeKeys: map[string][]string{"mac": {"0A:00:00:00:00:02"}, "internal_ip": {"vpc-1/10.0.1.5"}}},
+	}
+	merged := MergeMachines(rows, []string{"s1", "digitalocean", "aws"})
+	if len(merged) != 4 {
+		t.Fatalf("got %d machines, want 4: %+v", len(merged), merged)
+	}
+	for _, m := range merged {
+		// The db-01 names match but the IPs do not, so they stay apart.
+		want := 2
+		if m.Hostname == "db-01" {
+			want = 1
+		}
+		if len(m.BronzeLinks) != want {
+			t.Errorf("machine %s (%s) has %d links, want %d: %+v", m.Hostname, m.InternalIP, len(m.BronzeLinks), want, m.BronzeLinks)
+		}
+	}
+}
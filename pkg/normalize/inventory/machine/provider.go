@@ -3,6 +3,7 @@ package machine
 import (
 	"context"
 	"database/sql"
+	"slices"
 	"strings"
 	"time"
 )
@@ -65,6 +66,38 @@ func NormalizeMAC(mac string) string {
 	return raw[0:2] + ":" + raw[2:4] + ":" + raw[4:6] + ":" + raw[6:8] + ":" + raw[8:10] + ":" + raw[10:12]
 }
 
+// NormalizeHostname lowercases a hostname and strips its domain, so
+// "WEB-01.corp.example.com" and "web-01" compare equal. Returns empty string
+// for names that do not identify a machine.
+func NormalizeHostname(hostname string) string {
+	h, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(hostname)), ".")
+	if h == "localhost" {
+		return ""
+	}
+	return h
+}
+
+// DropSharedHostnames removes "hostname" merge keys that more than one of a
+// provider's rows carry. Clones and default names would otherwise merge
+// unrelated machines of the same provider.
+func DropSharedHostnames(rows []NormalizedMachine) {
+	owners := make(map[string]int)
+	for i := range rows {
+		for _, h := range slices.Compact(slices.Sorted(slices.Values(rows[i].MergeKeys["hostname"]))) {
+			owners[h]++
+		}
+	}
+	for i := range rows {
+		hostnames, ok := rows[i].MergeKeys["hostname"]
+		if !ok {
+			continue
+		}
+		rows[i].MergeKeys["hostname"] = slices.DeleteFunc(slices.Clone(hostnames), func(h string) bool {
+			return owners[h] > 1
+		})
+	}
+}
+
 // InferEnvironment guesses environment from hostname prefix or S1 site name.
 func InferEnvironment(hostname, s1Site string) string {
 	if s1Site != "" {
@@ -37,6 +37,8 @@ func bestOSName(osType, osName, osRevision string) string {
 }
 
 // Provider normalizes bronze.s1_agents into NormalizedMachine records.
+// Agents merge on their MAC addresses and on their hostname when no other
+// agent shares it and the internal IP agrees.
 type Provider struct{}
 
 func (Provider) Key() string   { return key }
@@ -174,9 +176,11 @@ func (Provider) Load(ctx context.Context, db *sql.DB) ([]machine.NormalizedMachi
 			CollectedAt:      a.collectedAt.Time,
 			FirstCollectedAt: a.firstCollectedAt.Time,
 			MergeKeys: map[string][]string{
-				"mac": a.macs,
+				"mac":      a.macs,
+				"hostname": {machine.NormalizeHostname(a.hostname)},
 			},
 		})
 	}
+	machine.DropSharedHostnames(result)
 	return result, nil
 }
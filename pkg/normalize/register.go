@@ -10,9 +10,21 @@ import (
 	normhttptraffic "danny.vn/hotpot/pkg/normalize/httptraffic"
 	"danny.vn/hotpot/pkg/normalize/inventory/apiendpoint"
 	"danny.vn/hotpot/pkg/normalize/inventory/apiendpoint/manual"
+	apiopenapi "danny.vn/hotpot/pkg/normalize/inventory/apiendpoint/openapi"
+	apirouting "danny.vn/hotpot/pkg/normalize/inventory/apiendpoint/routing"
+	apitraffic "danny.vn/hotpot/pkg/normalize/inventory/apiendpoint/traffic"
+	"danny.vn/hotpot/pkg/normalize/inventory/image"
+	imagecloudrun "danny.vn/hotpot/pkg/normalize/inventory/image/cloudrun"
+	imageca "danny.vn/hotpot/pkg/normalize/inventory/image/containeranalysis"
+	imagek8s "danny.vn/hotpot/pkg/normalize/inventory/image/kubernetes"
 	"danny.vn/hotpot/pkg/normalize/inventory/k8snode"
+	k8snodedo "danny.vn/hotpot/pkg/normalize/inventory/k8snode/digitalocean"
 	k8snodegcp "danny.vn/hotpot/pkg/normalize/inventory/k8snode/gcp"
+	k8snodegreennode "danny.vn/hotpot/pkg/normalize/inventory/k8snode/greennode"
+	k8snodek8s "danny.vn/hotpot/pkg/normalize/inventory/k8snode/kubernetes"
 	"danny.vn/hotpot/pkg/normalize/inventory/machine"
+	"danny.vn/hotpot/pkg/normalize/inventory/machine/aws"
+	"danny.vn/hotpot/pkg/normalize/inventory/machine/digitalocean"
 	"danny.vn/hotpot/pkg/normalize/inventory/machine/gcp"
 	"danny.vn/hotpot/pkg/normalize/inventory/machine/greennode"
 	"danny.vn/hotpot/pkg/normalize/inventory/machine/meec"
@@ -30,12 +42,18 @@ func Register(w worker.Worker, configService *config.Service, driver dialect.Dri
 		meec.Provider{},
 		greennode.Provider{},
 		gcp.Provider{},
+		aws.Provider{},
+		digitalocean.Provider{},
 	}
 	machine.Register(w, configService, driver, db, providers)
 
-	// K8s node providers.
+	// K8s node providers. Cloud nodes come first so that their instance
+	// fields win; merge-only providers must follow the bases they enrich.
 	k8sProviders := []k8snode.Provider{
 		k8snodegcp.Provider{},
+		k8snodedo.Provider{},
+		k8snodek8s.Provider{},
+		k8snodegreennode.Provider{},
 	}
 	k8snode.Register(w, configService, driver, db, k8sProviders)
 
@@ -46,9 +64,22 @@ func Register(w worker.Worker, configService *config.Service, driver dialect.Dri
 	}
 	software.Register(w, configService, driver, db, swProviders)
 
-	// API endpoint providers.
+	// Container image providers. Vulnerability scanners are merge-only and
+	// must follow the bases that run the images.
+	imageProviders := []image.Provider{
+		imagek8s.Provider{},
+		imagecloudrun.Provider{},
+		imageca.Provider{},
+	}
+	image.Register(w, configService, driver, db, imageProviders)
+
+	// API endpoint providers. Routing and traffic only propose candidates,
+	// flagged as discovered, for endpoints missing from the catalog.
 	apiProviders := []apiendpoint.Provider{
 		manual.Provider{},
+		apiopenapi.Provider{},
+		apirouting.Provider{},
+		apitraffic.Provider{},
 	}
 	apiendpoint.Register(w, configService, driver, db, apiProviders)
 
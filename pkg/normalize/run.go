@@ -18,6 +18,7 @@ import (
 	hotpottemporal "danny.vn/hotpot/pkg/base/temporal"
 	normhttptraffic "danny.vn/hotpot/pkg/normalize/httptraffic"
 	"danny.vn/hotpot/pkg/normalize/inventory/apiendpoint"
+	"danny.vn/hotpot/pkg/normalize/inventory/image"
 	"danny.vn/hotpot/pkg/normalize/inventory/k8snode"
 	"danny.vn/hotpot/pkg/normalize/inventory/machine"
 	"danny.vn/hotpot/pkg/normalize/inventory/software"
@@ -82,7 +83,7 @@ func ensureSchedules(ctx context.Context, temporalClient client.Client) {
 		Action: &client.ScheduleWorkflowAction{
 			ID:        "hotpot-normalize-machines",
 			Workflow:  machine.NormalizeMachinesWorkflow,
-			Args:      []interface{}{machine.NormalizeMachinesWorkflowParams{ProviderKeys: []string{"s1", "meec", "greennode", "gcp"}}},
+			Args:      []interface{}{machine.NormalizeMachinesWorkflowParams{ProviderKeys: []string{"s1", "meec", "greennode", "gcp", "aws", "digitalocean"}}},
 			TaskQueue: "normalize",
 		},
 		Paused: true,
@@ -98,7 +99,7 @@ func ensureSchedules(ctx context.Context, temporalClient client.Client) {
 		Action: &client.ScheduleWorkflowAction{
 			ID:        "hotpot-normalize-k8s-nodes",
 			Workflow:  k8snode.NormalizeK8sNodesWorkflow,
-			Args:      []interface{}{k8snode.NormalizeK8sNodesWorkflowParams{ProviderKeys: []string{"gcp"}}},
+			Args:      []interface{}{k8snode.NormalizeK8sNodesWorkflowParams{ProviderKeys: []string{"gcp", "digitalocean", "kubernetes", "greennode"}}},
 			TaskQueue: "normalize",
 		},
 		Paused: true,
@@ -120,6 +121,22 @@ func ensureSchedules(ctx context.Context, temporalClient client.Client) {
 		Paused: true,
 	})
 
+	hotpottemporal.EnsureSchedule(ctx, sc, client.ScheduleOptions{
+		ID: "hotpot-normalize-images-daily",
+		Spec: client.ScheduleSpec{
+			Intervals: []client.ScheduleIntervalSpec{
+				{Every: 24 * time.Hour},
+			},
+		},
+		Action: &client.ScheduleWorkflowAction{
+			ID:        "hotpot-normalize-images",
+			Workflow:  image.NormalizeImagesWorkflow,
+			Args:      []interface{}{image.NormalizeImagesWorkflowParams{ProviderKeys: []string{"kubernetes", "cloudrun", "containeranalysis"}}},
+			TaskQueue: "normalize",
+		},
+		Paused: true,
+	})
+
 	hotpottemporal.EnsureSchedule(ctx, sc, client.ScheduleOptions{
 		ID: "hotpot-normalize-api-endpoints-daily",
 		Spec: client.ScheduleSpec{
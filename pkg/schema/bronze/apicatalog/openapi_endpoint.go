@@ -0,0 +1,83 @@
+package apicatalog
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeApicatalogOpenapiEndpoint holds one operation (path + method) imported
+// from an OpenAPI 3.x or Swagger 2.0 document.
+type BronzeApicatalogOpenapiEndpoint struct {
+	ent.Schema
+}
+
+func (BronzeApicatalogOpenapiEndpoint) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeApicatalogOpenapiEndpoint) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("{spec_name}:{METHOD}:{uri}"),
+		field.String("spec_name").
+			NotEmpty().
+			Comment("Document the operation belongs to, from info.title unless overridden"),
+		field.String("spec_version").
+			Optional().
+			Comment("info.version of the document"),
+		field.String("openapi_version").
+			Optional().
+			Comment("openapi or swagger field, e.g. \"3.0.3\", \"2.0\""),
+		field.String("log_source_id").
+			Optional().
+			Comment("Which traffic source these belong to"),
+		field.String("uri").
+			NotEmpty().
+			Comment("Base path + path template, e.g. \"/v1/users/{id}\""),
+		field.String("method").
+			NotEmpty().
+			Comment("Upper-case HTTP method"),
+		field.String("operation_id").
+			Optional(),
+		field.String("summary").
+			Optional(),
+		field.JSON("tags_json", []string{}).
+			Optional(),
+		field.JSON("security_json", json.RawMessage{}).
+			Optional().
+			Comment("Effective security requirements of the operation"),
+		field.String("access_level").
+			Default("").
+			Comment("public, protected or private; from x-access-level or the security requirements"),
+		field.Bool("deprecated").
+			Default(false),
+		field.String("source_file").
+			Optional().
+			Comment("File this was imported from"),
+	}
+}
+
+func (BronzeApicatalogOpenapiEndpoint) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("spec_name"),
+		index.Fields("uri"),
+	}
+}
+
+func (BronzeApicatalogOpenapiEndpoint) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "apicatalog_openapi_endpoints"},
+	}
+}
@@ -0,0 +1,106 @@
+package cloudtrail
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeAWSCloudTrailTrail represents an AWS CloudTrail trail in the bronze layer.
+// Fields preserve raw API response data from cloudtrail.DescribeTrails,
+// cloudtrail.GetTrailStatus, cloudtrail.GetEventSelectors and cloudtrail.ListTags.
+type BronzeAWSCloudTrailTrail struct {
+	ent.Schema
+}
+
+func (BronzeAWSCloudTrailTrail) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeAWSCloudTrailTrail) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("Trail ARN, used as primary key"),
+		field.String("name").
+			Optional(),
+		field.String("s3_bucket_name").
+			Optional(),
+		field.String("s3_key_prefix").
+			Optional(),
+		field.String("sns_topic_arn").
+			Optional(),
+		field.Bool("is_multi_region_trail").
+			Optional(),
+		field.Bool("is_organization_trail").
+			Optional(),
+		field.Bool("include_global_service_events").
+			Optional(),
+		field.Bool("log_file_validation_enabled").
+			Optional(),
+		field.String("kms_key_id").
+			Optional().
+			Comment("KMS key ARN used to encrypt delivered logs, empty for SSE-S3"),
+		field.String("cloud_watch_logs_log_group_arn").
+			Optional(),
+		field.String("cloud_watch_logs_role_arn").
+			Optional(),
+		field.Bool("has_custom_event_selectors").
+			Optional(),
+		field.Bool("has_insight_selectors").
+			Optional(),
+		field.Bool("is_logging").
+			Optional().
+			Comment("Whether the trail is currently logging, from GetTrailStatus"),
+
+		// EventSelectorsJSON stores the basic event selectors.
+		//
+		//	[{"read_write_type": "All", "include_management_events": true, "data_resources": [...]}, ...]
+		field.JSON("event_selectors_json", json.RawMessage{}).
+			Optional(),
+
+		// AdvancedEventSelectorsJSON stores the advanced event selectors.
+		//
+		//	[{"name": "Log all S3 data events", "field_selectors": [{"field": "eventCategory", "equals": ["Data"]}, ...]}, ...]
+		field.JSON("advanced_event_selectors_json", json.RawMessage{}).
+			Optional(),
+
+		// TagsJSON stores trail tags.
+		//
+		//	[{"key": "env", "value": "prod"}, ...]
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+
+		// Collection metadata
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty().
+			Comment("Home region of the trail"),
+	}
+}
+
+func (BronzeAWSCloudTrailTrail) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("is_multi_region_trail"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeAWSCloudTrailTrail) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_cloudtrail_trails"},
+	}
+}
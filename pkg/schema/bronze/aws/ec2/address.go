@@ -0,0 +1,85 @@
+package ec2
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeAWSEC2Address represents an AWS Elastic IP address in the bronze layer.
+// Fields preserve raw API response data from ec2.DescribeAddresses.
+type BronzeAWSEC2Address struct {
+	ent.Schema
+}
+
+func (BronzeAWSEC2Address) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeAWSEC2Address) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("Allocation ID (eipalloc-xxxx), used as primary key"),
+		field.String("name").
+			Optional().
+			Comment("Value of the Name tag"),
+		field.String("public_ip").
+			Optional(),
+		field.String("domain").
+			Optional(),
+		field.String("association_id").
+			Optional().
+			Comment("Empty when the address is not associated"),
+		field.String("instance_id").
+			Optional(),
+		field.String("network_interface_id").
+			Optional(),
+		field.String("network_interface_owner_id").
+			Optional(),
+		field.String("private_ip_address").
+			Optional(),
+		field.String("public_ipv4_pool").
+			Optional(),
+		field.String("network_border_group").
+			Optional(),
+
+		// TagsJSON stores address tags.
+		//
+		//	[{"key": "env", "value": "prod"}, ...]
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+
+		// Collection metadata
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeAWSEC2Address) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("public_ip"),
+		index.Fields("instance_id"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeAWSEC2Address) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_ec2_addresses"},
+	}
+}
@@ -0,0 +1,73 @@
+package ec2
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeAWSEC2InternetGateway represents an AWS internet gateway in the bronze layer.
+// Fields preserve raw API response data from ec2.DescribeInternetGateways.
+type BronzeAWSEC2InternetGateway struct {
+	ent.Schema
+}
+
+func (BronzeAWSEC2InternetGateway) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeAWSEC2InternetGateway) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("Internet gateway ID (igw-xxxx), used as primary key"),
+		field.String("name").
+			Optional().
+			Comment("Value of the Name tag"),
+		field.String("vpc_id").
+			Optional().
+			Comment("VPC the gateway is attached to, empty when detached"),
+		field.String("attachment_state").
+			Optional().
+			Comment("attaching, attached, detaching, detached or available"),
+		field.String("owner_id").
+			Optional(),
+
+		// TagsJSON stores internet gateway tags.
+		//
+		//	[{"key": "env", "value": "prod"}, ...]
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+
+		// Collection metadata
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeAWSEC2InternetGateway) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vpc_id"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeAWSEC2InternetGateway) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_ec2_internet_gateways"},
+	}
+}
@@ -0,0 +1,89 @@
+package ec2
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeAWSEC2NATGateway represents an AWS NAT gateway in the bronze layer.
+// Fields preserve raw API response data from ec2.DescribeNatGateways.
+type BronzeAWSEC2NATGateway struct {
+	ent.Schema
+}
+
+func (BronzeAWSEC2NATGateway) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeAWSEC2NATGateway) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("NAT gateway ID (nat-xxxx), used as primary key"),
+		field.String("name").
+			Optional().
+			Comment("Value of the Name tag"),
+		field.String("vpc_id").
+			Optional(),
+		field.String("subnet_id").
+			Optional(),
+		field.String("state").
+			Optional().
+			Comment("pending, failed, available or deleting"),
+		field.String("connectivity_type").
+			Optional().
+			Comment("public or private"),
+		field.Time("create_time").
+			Optional().
+			Nillable(),
+		field.String("failure_code").
+			Optional(),
+		field.String("failure_message").
+			Optional(),
+
+		// AddressesJSON stores the IP addresses of the NAT gateway.
+		//
+		//	[{"allocation_id": "eipalloc-xxx", "network_interface_id": "eni-xxx", "private_ip": "10.0.1.5", "public_ip": "203.0.113.10", "is_primary": true, "status": "succeeded"}, ...]
+		field.JSON("addresses_json", json.RawMessage{}).
+			Optional(),
+
+		// TagsJSON stores NAT gateway tags.
+		//
+		//	[{"key": "env", "value": "prod"}, ...]
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+
+		// Collection metadata
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeAWSEC2NATGateway) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vpc_id"),
+		index.Fields("state"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeAWSEC2NATGateway) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_ec2_nat_gateways"},
+	}
+}
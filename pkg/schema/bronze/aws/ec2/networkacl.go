@@ -0,0 +1,83 @@
+package ec2
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeAWSEC2NetworkACL represents an AWS network ACL in the bronze layer.
+// Fields preserve raw API response data from ec2.DescribeNetworkAcls.
+type BronzeAWSEC2NetworkACL struct {
+	ent.Schema
+}
+
+func (BronzeAWSEC2NetworkACL) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeAWSEC2NetworkACL) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("Network ACL ID (acl-xxxx), used as primary key"),
+		field.String("name").
+			Optional().
+			Comment("Value of the Name tag"),
+		field.String("vpc_id").
+			Optional(),
+		field.Bool("is_default").
+			Optional(),
+		field.String("owner_id").
+			Optional(),
+
+		// EntriesJSON stores the numbered allow/deny entries, evaluated in rule_number order.
+		//
+		//	[{"rule_number": 100, "egress": false, "protocol": "6", "rule_action": "allow", "cidr_block": "0.0.0.0/0", "port_range": {"from": 22, "to": 22}}, ...]
+		field.JSON("entries_json", json.RawMessage{}).
+			Optional(),
+
+		// AssociationsJSON stores the subnets associated with the network ACL.
+		//
+		//	[{"association_id": "aclassoc-xxx", "subnet_id": "subnet-xxx"}, ...]
+		field.JSON("associations_json", json.RawMessage{}).
+			Optional(),
+
+		// TagsJSON stores network ACL tags.
+		//
+		//	[{"key": "env", "value": "prod"}, ...]
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+
+		// Collection metadata
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeAWSEC2NetworkACL) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vpc_id"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeAWSEC2NetworkACL) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_ec2_network_acls"},
+	}
+}
@@ -0,0 +1,125 @@
+package ec2
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeAWSEC2NetworkInterface represents an AWS network interface in the bronze layer.
+// Fields preserve raw API response data from ec2.DescribeNetworkInterfaces.
+type BronzeAWSEC2NetworkInterface struct {
+	ent.Schema
+}
+
+func (BronzeAWSEC2NetworkInterface) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeAWSEC2NetworkInterface) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("Network interface ID (eni-xxxx), used as primary key"),
+		field.String("description").
+			Optional(),
+		field.String("interface_type").
+			Optional().
+			Comment("interface, nat_gateway, load_balancer, lambda, vpc_endpoint, etc."),
+		field.String("status").
+			Optional().
+			Comment("available, associated, attaching, in-use or detaching"),
+		field.String("vpc_id").
+			Optional(),
+		field.String("subnet_id").
+			Optional(),
+		field.String("availability_zone").
+			Optional(),
+		field.String("mac_address").
+			Optional(),
+		field.String("private_ip_address").
+			Optional().
+			Comment("Primary private IPv4 address"),
+		field.String("private_dns_name").
+			Optional(),
+		field.String("public_ip").
+			Optional().
+			Comment("Public IPv4 address associated with the primary private address"),
+		field.String("public_dns_name").
+			Optional(),
+		field.Bool("source_dest_check").
+			Optional(),
+		field.Bool("requester_managed").
+			Optional().
+			Comment("Whether the interface is managed by an AWS service"),
+		field.String("requester_id").
+			Optional(),
+		field.String("owner_id").
+			Optional(),
+		field.String("attachment_id").
+			Optional(),
+		field.String("attachment_instance_id").
+			Optional().
+			Comment("Instance the interface is attached to, if any"),
+		field.String("attachment_status").
+			Optional(),
+
+		// GroupsJSON stores the security groups of the interface.
+		//
+		//	[{"group_id": "sg-xxx", "group_name": "my-sg"}, ...]
+		field.JSON("groups_json", json.RawMessage{}).
+			Optional(),
+
+		// PrivateIPAddressesJSON stores all private IPv4 addresses with their public association.
+		//
+		//	[{"private_ip_address": "10.0.1.5", "primary": true, "public_ip": "203.0.113.10"}, ...]
+		field.JSON("private_ip_addresses_json", json.RawMessage{}).
+			Optional(),
+
+		// Ipv6AddressesJSON stores the IPv6 addresses of the interface.
+		//
+		//	["2600:1f18::1", ...]
+		field.JSON("ipv6_addresses_json", json.RawMessage{}).
+			Optional(),
+
+		// TagsJSON stores network interface tags.
+		//
+		//	[{"key": "env", "value": "prod"}, ...]
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+
+		// Collection metadata
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeAWSEC2NetworkInterface) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vpc_id"),
+		index.Fields("subnet_id"),
+		index.Fields("attachment_instance_id"),
+		index.Fields("public_ip"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeAWSEC2NetworkInterface) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_ec2_network_interfaces"},
+	}
+}
@@ -0,0 +1,87 @@
+package ec2
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeAWSEC2RouteTable represents an AWS route table in the bronze layer.
+// Fields preserve raw API response data from ec2.DescribeRouteTables.
+type BronzeAWSEC2RouteTable struct {
+	ent.Schema
+}
+
+func (BronzeAWSEC2RouteTable) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeAWSEC2RouteTable) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("Route table ID (rtb-xxxx), used as primary key"),
+		field.String("name").
+			Optional().
+			Comment("Value of the Name tag"),
+		field.String("vpc_id").
+			Optional(),
+		field.String("owner_id").
+			Optional(),
+
+		// RoutesJSON stores the routes with their destination and target.
+		//
+		//	[{"destination_cidr_block": "0.0.0.0/0", "gateway_id": "igw-xxx", "origin": "CreateRoute", "state": "active"}, ...]
+		field.JSON("routes_json", json.RawMessage{}).
+			Optional(),
+
+		// AssociationsJSON stores the subnet and gateway associations, including the main association.
+		//
+		//	[{"association_id": "rtbassoc-xxx", "subnet_id": "subnet-xxx", "main": false, "state": "associated"}, ...]
+		field.JSON("associations_json", json.RawMessage{}).
+			Optional(),
+
+		// PropagatingVgwsJSON stores the virtual private gateways propagating routes.
+		//
+		//	["vgw-xxx", ...]
+		field.JSON("propagating_vgws_json", json.RawMessage{}).
+			Optional(),
+
+		// TagsJSON stores route table tags.
+		//
+		//	[{"key": "env", "value": "prod"}, ...]
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+
+		// Collection metadata
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeAWSEC2RouteTable) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vpc_id"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeAWSEC2RouteTable) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_ec2_route_tables"},
+	}
+}
@@ -0,0 +1,74 @@
+package ec2
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeAWSEC2SecurityGroup represents an AWS security group in the bronze layer.
+// Fields preserve raw API response data from ec2.DescribeSecurityGroups.
+// Ingress and egress rules are stored separately in aws_ec2_security_group_rules.
+type BronzeAWSEC2SecurityGroup struct {
+	ent.Schema
+}
+
+func (BronzeAWSEC2SecurityGroup) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeAWSEC2SecurityGroup) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("Security group ID (sg-xxxx), used as primary key"),
+		field.String("group_name").
+			Optional(),
+		field.String("description").
+			Optional(),
+		field.String("vpc_id").
+			Optional(),
+		field.String("owner_id").
+			Optional(),
+		field.String("security_group_arn").
+			Optional(),
+
+		// TagsJSON stores security group tags.
+		//
+		//	[{"key": "env", "value": "prod"}, ...]
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+
+		// Collection metadata
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeAWSEC2SecurityGroup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vpc_id"),
+		index.Fields("group_name"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeAWSEC2SecurityGroup) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_ec2_security_groups"},
+	}
+}
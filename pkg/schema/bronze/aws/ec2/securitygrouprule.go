@@ -0,0 +1,100 @@
+package ec2
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeAWSEC2SecurityGroupRule represents an AWS security group rule in the bronze layer.
+// Fields preserve raw API response data from ec2.DescribeSecurityGroupRules.
+// Each row is one ingress or egress rule with a single source or destination.
+type BronzeAWSEC2SecurityGroupRule struct {
+	ent.Schema
+}
+
+func (BronzeAWSEC2SecurityGroupRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeAWSEC2SecurityGroupRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("Security group rule ID (sgr-xxxx), used as primary key"),
+		field.String("group_id").
+			Optional().
+			Comment("Security group the rule belongs to"),
+		field.String("group_owner_id").
+			Optional(),
+		field.Bool("is_egress").
+			Optional().
+			Comment("True for outbound rules, false for inbound rules"),
+		field.String("ip_protocol").
+			Optional().
+			Comment("tcp, udp, icmp, icmpv6, a protocol number, or -1 for all"),
+		field.Int32("from_port").
+			Optional().
+			Nillable().
+			Comment("Start of the port range, or ICMP type; -1 means all"),
+		field.Int32("to_port").
+			Optional().
+			Nillable().
+			Comment("End of the port range, or ICMP code; -1 means all"),
+		field.String("cidr_ipv4").
+			Optional(),
+		field.String("cidr_ipv6").
+			Optional(),
+		field.String("prefix_list_id").
+			Optional(),
+		field.String("referenced_group_id").
+			Optional().
+			Comment("Security group referenced as source or destination"),
+		field.String("referenced_user_id").
+			Optional().
+			Comment("Account that owns the referenced security group"),
+		field.String("description").
+			Optional(),
+		field.String("security_group_rule_arn").
+			Optional(),
+
+		// TagsJSON stores rule tags.
+		//
+		//	[{"key": "env", "value": "prod"}, ...]
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+
+		// Collection metadata
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeAWSEC2SecurityGroupRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_id"),
+		index.Fields("is_egress"),
+		index.Fields("cidr_ipv4"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeAWSEC2SecurityGroupRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_ec2_security_group_rules"},
+	}
+}
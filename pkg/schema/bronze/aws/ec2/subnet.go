@@ -0,0 +1,93 @@
+package ec2
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeAWSEC2Subnet represents an AWS subnet in the bronze layer.
+// Fields preserve raw API response data from ec2.DescribeSubnets.
+type BronzeAWSEC2Subnet struct {
+	ent.Schema
+}
+
+func (BronzeAWSEC2Subnet) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeAWSEC2Subnet) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("Subnet ID (subnet-xxxx), used as primary key"),
+		field.String("name").
+			Optional().
+			Comment("Value of the Name tag"),
+		field.String("vpc_id").
+			Optional(),
+		field.String("cidr_block").
+			Optional(),
+		field.String("availability_zone").
+			Optional(),
+		field.String("availability_zone_id").
+			Optional(),
+		field.String("state").
+			Optional().
+			Comment("Subnet state: pending, available, unavailable, failed"),
+		field.Bool("default_for_az").
+			Optional(),
+		field.Bool("map_public_ip_on_launch").
+			Optional().
+			Comment("Whether instances launched in the subnet get a public IPv4 address"),
+		field.Bool("assign_ipv6_address_on_creation").
+			Optional(),
+		field.String("subnet_arn").
+			Optional(),
+		field.String("owner_id").
+			Optional(),
+
+		// Ipv6CidrBlockAssociationsJSON stores the IPv6 CIDR blocks associated with the subnet.
+		//
+		//	[{"association_id": "subnet-cidr-assoc-xxx", "ipv6_cidr_block": "2600:1f18::/64", "state": "associated"}, ...]
+		field.JSON("ipv6_cidr_block_associations_json", json.RawMessage{}).
+			Optional(),
+
+		// TagsJSON stores subnet tags.
+		//
+		//	[{"key": "env", "value": "prod"}, ...]
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+
+		// Collection metadata
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeAWSEC2Subnet) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vpc_id"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeAWSEC2Subnet) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_ec2_subnets"},
+	}
+}
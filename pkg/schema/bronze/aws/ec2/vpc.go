@@ -0,0 +1,92 @@
+package ec2
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeAWSEC2VPC represents an AWS VPC in the bronze layer.
+// Fields preserve raw API response data from ec2.DescribeVpcs.
+type BronzeAWSEC2VPC struct {
+	ent.Schema
+}
+
+func (BronzeAWSEC2VPC) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeAWSEC2VPC) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("VPC ID (vpc-xxxx), used as primary key"),
+		field.String("name").
+			Optional().
+			Comment("Value of the Name tag"),
+		field.String("cidr_block").
+			Optional().
+			Comment("Primary IPv4 CIDR block"),
+		field.String("state").
+			Optional().
+			Comment("VPC state: pending or available"),
+		field.Bool("is_default").
+			Optional(),
+		field.String("instance_tenancy").
+			Optional().
+			Comment("default, dedicated or host"),
+		field.String("dhcp_options_id").
+			Optional(),
+		field.String("owner_id").
+			Optional(),
+
+		// CidrBlockAssociationsJSON stores the IPv4 CIDR blocks associated with the VPC.
+		//
+		//	[{"association_id": "vpc-cidr-assoc-xxx", "cidr_block": "10.0.0.0/16", "state": "associated"}, ...]
+		field.JSON("cidr_block_associations_json", json.RawMessage{}).
+			Optional(),
+
+		// Ipv6CidrBlockAssociationsJSON stores the IPv6 CIDR blocks associated with the VPC.
+		//
+		//	[{"association_id": "vpc-cidr-assoc-xxx", "ipv6_cidr_block": "2600:1f18::/56", "state": "associated", "network_border_group": "us-east-1"}, ...]
+		field.JSON("ipv6_cidr_block_associations_json", json.RawMessage{}).
+			Optional(),
+
+		// TagsJSON stores VPC tags.
+		//
+		//	[{"key": "env", "value": "prod"}, ...]
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+
+		// Collection metadata
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeAWSEC2VPC) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("is_default"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeAWSEC2VPC) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_ec2_vpcs"},
+	}
+}
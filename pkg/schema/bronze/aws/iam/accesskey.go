@@ -0,0 +1,70 @@
+package iam
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeAWSIAMAccessKey represents an AWS IAM user access key in the bronze layer.
+// Fields preserve raw API response data from iam.ListAccessKeys and iam.GetAccessKeyLastUsed.
+type BronzeAWSIAMAccessKey struct {
+	ent.Schema
+}
+
+func (BronzeAWSIAMAccessKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeAWSIAMAccessKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("Access key ID (AKIA...), used as primary key"),
+		field.String("user_name").
+			NotEmpty().
+			Comment("Name of the IAM user that owns the key"),
+		field.String("status").
+			Optional().
+			Comment("Active or Inactive"),
+		field.Time("create_date").
+			Optional().
+			Nillable(),
+		field.Time("last_used_date").
+			Optional().
+			Nillable().
+			Comment("Null if the key has never been used"),
+		field.String("last_used_service").
+			Optional(),
+		field.String("last_used_region").
+			Optional(),
+
+		// Collection metadata
+		field.String("account_id").
+			NotEmpty(),
+	}
+}
+
+func (BronzeAWSIAMAccessKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_name"),
+		index.Fields("status"),
+		index.Fields("create_date"),
+		index.Fields("account_id"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeAWSIAMAccessKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_iam_access_keys"},
+	}
+}
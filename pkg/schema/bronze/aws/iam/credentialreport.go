@@ -0,0 +1,110 @@
+package iam
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeAWSIAMCredentialReport represents one row (one user, or the root
+// account) of the AWS IAM credential report in the bronze layer.
+// Fields preserve the parsed CSV from iam.GetCredentialReport.
+type BronzeAWSIAMCredentialReport struct {
+	ent.Schema
+}
+
+func (BronzeAWSIAMCredentialReport) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeAWSIAMCredentialReport) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("User ARN, used as primary key"),
+		field.String("user").
+			NotEmpty().
+			Comment("User name, or <root_account> for the account root user"),
+		field.Time("user_creation_time").
+			Optional().
+			Nillable(),
+		field.String("password_enabled").
+			Optional().
+			Comment("true, false or not_supported (root user)"),
+		field.Time("password_last_used").
+			Optional().
+			Nillable(),
+		field.Time("password_last_changed").
+			Optional().
+			Nillable(),
+		field.Time("password_next_rotation").
+			Optional().
+			Nillable(),
+		field.Bool("mfa_active").
+			Default(false),
+		field.Bool("access_key_1_active").
+			Default(false),
+		field.Time("access_key_1_last_rotated").
+			Optional().
+			Nillable(),
+		field.Time("access_key_1_last_used_date").
+			Optional().
+			Nillable(),
+		field.String("access_key_1_last_used_region").
+			Optional(),
+		field.String("access_key_1_last_used_service").
+			Optional(),
+		field.Bool("access_key_2_active").
+			Default(false),
+		field.Time("access_key_2_last_rotated").
+			Optional().
+			Nillable(),
+		field.Time("access_key_2_last_used_date").
+			Optional().
+			Nillable(),
+		field.String("access_key_2_last_used_region").
+			Optional(),
+		field.String("access_key_2_last_used_service").
+			Optional(),
+		field.Bool("cert_1_active").
+			Default(false),
+		field.Time("cert_1_last_rotated").
+			Optional().
+			Nillable(),
+		field.Bool("cert_2_active").
+			Default(false),
+		field.Time("cert_2_last_rotated").
+			Optional().
+			Nillable(),
+		field.Time("report_generated_at").
+			Optional().
+			Nillable().
+			Comment("When AWS generated the credential report"),
+
+		// Collection metadata
+		field.String("account_id").
+			NotEmpty(),
+	}
+}
+
+func (BronzeAWSIAMCredentialReport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user"),
+		index.Fields("account_id"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeAWSIAMCredentialReport) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_iam_credential_reports"},
+	}
+}
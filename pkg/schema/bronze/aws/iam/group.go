@@ -0,0 +1,74 @@
+package iam
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeAWSIAMGroup represents an AWS IAM group in the bronze layer.
+// Fields preserve raw API response data from iam.GetAccountAuthorizationDetails.
+type BronzeAWSIAMGroup struct {
+	ent.Schema
+}
+
+func (BronzeAWSIAMGroup) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeAWSIAMGroup) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("IAM group ID (AGPA...), used as primary key"),
+		field.String("arn").
+			NotEmpty(),
+		field.String("group_name").
+			NotEmpty(),
+		field.String("path").
+			Optional(),
+		field.Time("create_date").
+			Optional().
+			Nillable(),
+
+		// AttachedPoliciesJSON stores managed policies attached to the group.
+		//
+		//	[{"policy_arn": "...", "policy_name": "..."}, ...]
+		field.JSON("attached_policies_json", json.RawMessage{}).
+			Optional(),
+
+		// InlinePoliciesJSON stores inline policies with their decoded documents.
+		//
+		//	[{"policy_name": "...", "policy_document": {...}}, ...]
+		field.JSON("inline_policies_json", json.RawMessage{}).
+			Optional(),
+
+		// Collection metadata
+		field.String("account_id").
+			NotEmpty(),
+	}
+}
+
+func (BronzeAWSIAMGroup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_name"),
+		index.Fields("account_id"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeAWSIAMGroup) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_iam_groups"},
+	}
+}
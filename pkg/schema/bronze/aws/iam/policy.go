@@ -0,0 +1,86 @@
+package iam
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeAWSIAMPolicy represents an AWS IAM managed policy in the bronze layer.
+// Covers customer managed policies and AWS managed policies attached in the account.
+// Fields preserve raw API response data from iam.GetAccountAuthorizationDetails.
+type BronzeAWSIAMPolicy struct {
+	ent.Schema
+}
+
+func (BronzeAWSIAMPolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeAWSIAMPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("IAM policy ID (ANPA...), prefixed with \"<account_id>:\" for AWS managed policies; used as primary key"),
+		field.String("arn").
+			NotEmpty(),
+		field.String("policy_name").
+			NotEmpty(),
+		field.String("path").
+			Optional(),
+		field.String("description").
+			Optional(),
+		field.String("default_version_id").
+			Optional(),
+		field.Int("attachment_count").
+			Default(0),
+		field.Int("permissions_boundary_usage_count").
+			Default(0),
+		field.Bool("is_attachable").
+			Default(false),
+		field.Bool("aws_managed").
+			Default(false).
+			Comment("True for AWS managed policies (arn:aws:iam::aws:policy/...)"),
+		field.Time("create_date").
+			Optional().
+			Nillable(),
+		field.Time("update_date").
+			Optional().
+			Nillable(),
+
+		// VersionsJSON stores policy versions with their decoded documents.
+		//
+		//	[{"version_id": "v2", "is_default_version": true, "create_date": "...", "document": {...}}, ...]
+		field.JSON("versions_json", json.RawMessage{}).
+			Optional(),
+
+		// Collection metadata
+		field.String("account_id").
+			NotEmpty(),
+	}
+}
+
+func (BronzeAWSIAMPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("policy_name"),
+		index.Fields("aws_managed"),
+		index.Fields("account_id"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeAWSIAMPolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_iam_policies"},
+	}
+}
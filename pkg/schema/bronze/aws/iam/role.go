@@ -0,0 +1,99 @@
+package iam
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeAWSIAMRole represents an AWS IAM role in the bronze layer.
+// Fields preserve raw API response data from iam.GetAccountAuthorizationDetails.
+type BronzeAWSIAMRole struct {
+	ent.Schema
+}
+
+func (BronzeAWSIAMRole) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeAWSIAMRole) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("IAM role ID (AROA...), used as primary key"),
+		field.String("arn").
+			NotEmpty(),
+		field.String("role_name").
+			NotEmpty(),
+		field.String("path").
+			Optional(),
+		field.Time("create_date").
+			Optional().
+			Nillable(),
+		field.Time("role_last_used_date").
+			Optional().
+			Nillable(),
+		field.String("role_last_used_region").
+			Optional(),
+		field.String("permissions_boundary_arn").
+			Optional(),
+
+		// AssumeRolePolicyJSON stores the decoded trust policy document.
+		//
+		//	{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Principal": {...}, "Action": "sts:AssumeRole"}]}
+		field.JSON("assume_role_policy_json", json.RawMessage{}).
+			Optional(),
+
+		// AttachedPoliciesJSON stores managed policies attached to the role.
+		//
+		//	[{"policy_arn": "...", "policy_name": "..."}, ...]
+		field.JSON("attached_policies_json", json.RawMessage{}).
+			Optional(),
+
+		// InlinePoliciesJSON stores inline policies with their decoded documents.
+		//
+		//	[{"policy_name": "...", "policy_document": {...}}, ...]
+		field.JSON("inline_policies_json", json.RawMessage{}).
+			Optional(),
+
+		// InstanceProfilesJSON stores instance profiles the role belongs to.
+		//
+		//	[{"instance_profile_id": "AIPA...", "instance_profile_name": "...", "arn": "..."}, ...]
+		field.JSON("instance_profiles_json", json.RawMessage{}).
+			Optional(),
+
+		// TagsJSON stores role tags.
+		//
+		//	[{"key": "team", "value": "platform"}, ...]
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+
+		// Collection metadata
+		field.String("account_id").
+			NotEmpty(),
+	}
+}
+
+func (BronzeAWSIAMRole) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("role_name"),
+		index.Fields("account_id"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeAWSIAMRole) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_iam_roles"},
+	}
+}
@@ -0,0 +1,95 @@
+package iam
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeAWSIAMUser represents an AWS IAM user in the bronze layer.
+// Fields preserve raw API response data from iam.GetAccountAuthorizationDetails
+// and iam.ListMFADevices.
+type BronzeAWSIAMUser struct {
+	ent.Schema
+}
+
+func (BronzeAWSIAMUser) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeAWSIAMUser) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("IAM user ID (AIDA...), used as primary key"),
+		field.String("arn").
+			NotEmpty(),
+		field.String("user_name").
+			NotEmpty(),
+		field.String("path").
+			Optional(),
+		field.Time("create_date").
+			Optional().
+			Nillable(),
+		field.String("permissions_boundary_arn").
+			Optional(),
+
+		// AttachedPoliciesJSON stores managed policies attached to the user.
+		//
+		//	[{"policy_arn": "arn:aws:iam::aws:policy/ReadOnlyAccess", "policy_name": "ReadOnlyAccess"}, ...]
+		field.JSON("attached_policies_json", json.RawMessage{}).
+			Optional(),
+
+		// InlinePoliciesJSON stores inline policies with their decoded documents.
+		//
+		//	[{"policy_name": "...", "policy_document": {"Version": "2012-10-17", "Statement": [...]}}, ...]
+		field.JSON("inline_policies_json", json.RawMessage{}).
+			Optional(),
+
+		// GroupsJSON stores the names of groups the user belongs to.
+		//
+		//	["admins", "developers"]
+		field.JSON("groups_json", json.RawMessage{}).
+			Optional(),
+
+		// MFADevicesJSON stores the user's MFA devices.
+		//
+		//	[{"serial_number": "arn:aws:iam::123456789012:mfa/alice", "enable_date": "2024-01-02T03:04:05Z"}, ...]
+		field.JSON("mfa_devices_json", json.RawMessage{}).
+			Optional(),
+
+		// TagsJSON stores user tags.
+		//
+		//	[{"key": "team", "value": "platform"}, ...]
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+
+		// Collection metadata
+		field.String("account_id").
+			NotEmpty(),
+	}
+}
+
+func (BronzeAWSIAMUser) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_name"),
+		index.Fields("account_id"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeAWSIAMUser) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_iam_users"},
+	}
+}
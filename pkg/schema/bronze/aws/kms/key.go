@@ -0,0 +1,116 @@
+package kms
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeAWSKMSKey represents an AWS KMS key in the bronze layer.
+// Fields preserve raw API response data from kms.ListKeys, kms.DescribeKey,
+// kms.GetKeyRotationStatus, kms.GetKeyPolicy, kms.ListAliases and kms.ListResourceTags.
+type BronzeAWSKMSKey struct {
+	ent.Schema
+}
+
+func (BronzeAWSKMSKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeAWSKMSKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("KMS key ID (UUID), used as primary key"),
+		field.String("arn").
+			Optional(),
+		field.String("description").
+			Optional(),
+		field.String("key_state").
+			Optional().
+			Comment("Enabled, Disabled, PendingDeletion, PendingImport, Unavailable, ..."),
+		field.String("key_usage").
+			Optional().
+			Comment("ENCRYPT_DECRYPT, SIGN_VERIFY, GENERATE_VERIFY_MAC or KEY_AGREEMENT"),
+		field.String("key_spec").
+			Optional().
+			Comment("SYMMETRIC_DEFAULT, RSA_2048, ECC_NIST_P256, HMAC_256, ..."),
+		field.String("key_manager").
+			Optional().
+			Comment("AWS or CUSTOMER"),
+		field.String("origin").
+			Optional().
+			Comment("AWS_KMS, EXTERNAL, AWS_CLOUDHSM or EXTERNAL_KEY_STORE"),
+		field.Bool("enabled").
+			Optional(),
+		field.Bool("multi_region").
+			Optional(),
+		field.Time("creation_date").
+			Optional().
+			Nillable(),
+		field.Time("deletion_date").
+			Optional().
+			Nillable().
+			Comment("Scheduled deletion date when key_state is PendingDeletion"),
+		field.Bool("rotation_enabled").
+			Optional().
+			Nillable().
+			Comment("Automatic rotation status, nil when the key does not support rotation"),
+		field.Int32("rotation_period_in_days").
+			Optional().
+			Nillable(),
+		field.Time("next_rotation_date").
+			Optional().
+			Nillable(),
+
+		// PolicyJSON stores the default key policy document.
+		//
+		//	{"Version": "2012-10-17", "Statement": [{"Sid": "Enable IAM User Permissions", ...}]}
+		field.JSON("policy_json", json.RawMessage{}).
+			Optional(),
+
+		// AliasesJSON stores the alias names pointing at the key.
+		//
+		//	["alias/app-data", ...]
+		field.JSON("aliases_json", json.RawMessage{}).
+			Optional(),
+
+		// TagsJSON stores key tags.
+		//
+		//	[{"key": "env", "value": "prod"}, ...]
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+
+		// Collection metadata
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeAWSKMSKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key_manager"),
+		index.Fields("key_state"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeAWSKMSKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_kms_keys"},
+	}
+}
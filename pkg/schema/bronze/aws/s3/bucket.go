@@ -0,0 +1,120 @@
+package s3
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeAWSS3Bucket represents an AWS S3 bucket in the bronze layer.
+// Fields preserve raw API response data from s3.ListBuckets and the per-bucket
+// configuration calls (GetPublicAccessBlock, GetBucketPolicy, GetBucketAcl,
+// GetBucketEncryption, GetBucketVersioning, GetBucketLogging, GetBucketTagging).
+type BronzeAWSS3Bucket struct {
+	ent.Schema
+}
+
+func (BronzeAWSS3Bucket) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeAWSS3Bucket) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("Bucket name, used as primary key"),
+		field.String("arn").
+			Optional(),
+		field.Time("creation_date").
+			Optional().
+			Nillable(),
+		field.String("owner_id").
+			Optional().
+			Comment("Canonical user ID of the bucket owner"),
+		field.Bool("block_public_acls").
+			Optional().
+			Nillable().
+			Comment("Public access block setting, nil when the bucket has no configuration"),
+		field.Bool("ignore_public_acls").
+			Optional().
+			Nillable(),
+		field.Bool("block_public_policy").
+			Optional().
+			Nillable(),
+		field.Bool("restrict_public_buckets").
+			Optional().
+			Nillable(),
+		field.Bool("policy_is_public").
+			Optional().
+			Nillable().
+			Comment("Policy status from GetBucketPolicyStatus, nil when the bucket has no policy"),
+		field.String("sse_algorithm").
+			Optional().
+			Comment("Default encryption: AES256, aws:kms or aws:kms:dsse"),
+		field.String("kms_master_key_id").
+			Optional(),
+		field.Bool("bucket_key_enabled").
+			Optional(),
+		field.String("versioning_status").
+			Optional().
+			Comment("Enabled, Suspended, or empty if versioning was never enabled"),
+		field.String("mfa_delete").
+			Optional().
+			Comment("Enabled, Disabled, or empty if never configured"),
+		field.String("logging_target_bucket").
+			Optional().
+			Comment("Server access logging target, empty when logging is disabled"),
+		field.String("logging_target_prefix").
+			Optional(),
+
+		// PolicyJSON stores the bucket policy document.
+		//
+		//	{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Principal": "*", ...}]}
+		field.JSON("policy_json", json.RawMessage{}).
+			Optional(),
+
+		// ACLGrantsJSON stores the bucket ACL grants.
+		//
+		//	[{"grantee_type": "Group", "grantee_uri": "http://acs.amazonaws.com/groups/global/AllUsers", "permission": "READ"}, ...]
+		field.JSON("acl_grants_json", json.RawMessage{}).
+			Optional(),
+
+		// TagsJSON stores bucket tags.
+		//
+		//	[{"key": "env", "value": "prod"}, ...]
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+
+		// Collection metadata
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty().
+			Comment("Region the bucket lives in"),
+	}
+}
+
+func (BronzeAWSS3Bucket) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("policy_is_public"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeAWSS3Bucket) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_s3_buckets"},
+	}
+}
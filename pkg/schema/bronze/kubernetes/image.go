@@ -0,0 +1,69 @@
+package kubernetes
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeK8sImage represents a container image running in a Kubernetes
+// cluster, aggregated from the cluster's pods, in the bronze layer.
+type BronzeK8sImage struct {
+	ent.Schema
+}
+
+func (BronzeK8sImage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeK8sImage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("{cluster_name}/{registry}/{repository}@{digest}, or :{tag} when the digest is unknown"),
+		field.String("cluster_name").
+			NotEmpty(),
+		field.String("registry").
+			NotEmpty().
+			Comment("e.g. docker.io, gcr.io, registry.digitalocean.com"),
+		field.String("repository").
+			NotEmpty().
+			Comment("e.g. library/nginx"),
+		field.String("digest").
+			Optional().
+			Comment("sha256:... from the container status image ID"),
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional().
+			Comment("Tags the image is referenced by"),
+		field.JSON("namespaces_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("workloads_json", json.RawMessage{}).
+			Optional().
+			Comment("Workloads running the image: {namespace}/{kind}/{name}"),
+	}
+}
+
+func (BronzeK8sImage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("cluster_name"),
+		index.Fields("registry", "repository"),
+		index.Fields("digest"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeK8sImage) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "k8s_images"},
+	}
+}
@@ -0,0 +1,62 @@
+package kubernetes
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeK8sNamespace represents a Kubernetes namespace in the bronze layer.
+type BronzeK8sNamespace struct {
+	ent.Schema
+}
+
+func (BronzeK8sNamespace) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeK8sNamespace) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("{cluster_name}/{name}"),
+		field.String("cluster_name").
+			NotEmpty().
+			Comment("Cluster name from kubernetes.clusters config"),
+		field.String("name").
+			NotEmpty(),
+		field.String("uid").
+			Optional(),
+		field.String("phase").
+			Optional().
+			Comment("Active or Terminating"),
+		field.JSON("labels_json", json.RawMessage{}).
+			Optional(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeK8sNamespace) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("cluster_name"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeK8sNamespace) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "k8s_namespaces"},
+	}
+}
@@ -0,0 +1,69 @@
+package kubernetes
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeK8sNetworkPolicy represents a Kubernetes NetworkPolicy in the bronze layer.
+type BronzeK8sNetworkPolicy struct {
+	ent.Schema
+}
+
+func (BronzeK8sNetworkPolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeK8sNetworkPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("{cluster_name}/{namespace}/{name}"),
+		field.String("cluster_name").
+			NotEmpty(),
+		field.String("namespace").
+			NotEmpty(),
+		field.String("name").
+			NotEmpty(),
+		field.String("uid").
+			Optional(),
+		field.JSON("pod_selector_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("policy_types_json", json.RawMessage{}).
+			Optional().
+			Comment("Ingress and/or Egress"),
+		field.JSON("ingress_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("egress_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("labels_json", json.RawMessage{}).
+			Optional(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeK8sNetworkPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("cluster_name", "namespace"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeK8sNetworkPolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "k8s_network_policies"},
+	}
+}
@@ -0,0 +1,91 @@
+package kubernetes
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeK8sNode represents a Kubernetes node in the bronze layer.
+type BronzeK8sNode struct {
+	ent.Schema
+}
+
+func (BronzeK8sNode) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeK8sNode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("{cluster_name}/{name}"),
+		field.String("cluster_name").
+			NotEmpty(),
+		field.String("name").
+			NotEmpty(),
+		field.String("uid").
+			Optional(),
+		field.String("provider_id").
+			Optional().
+			Comment("spec.providerID, e.g. gce://{project}/{zone}/{name} or digitalocean://{droplet_id}"),
+		field.String("internal_ip").
+			Optional(),
+		field.String("external_ip").
+			Optional(),
+		field.String("node_pool").
+			Optional().
+			Comment("Node pool from the managed-cluster node pool label"),
+		field.String("instance_type").
+			Optional(),
+		field.String("region").
+			Optional(),
+		field.String("zone").
+			Optional(),
+		field.String("kubelet_version").
+			Optional(),
+		field.String("os_image").
+			Optional(),
+		field.String("kernel_version").
+			Optional(),
+		field.String("container_runtime").
+			Optional(),
+		field.String("architecture").
+			Optional(),
+		field.Bool("ready").
+			Default(false),
+		field.Bool("unschedulable").
+			Default(false),
+		field.JSON("labels_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("taints_json", json.RawMessage{}).
+			Optional(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeK8sNode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("cluster_name"),
+		index.Fields("provider_id"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeK8sNode) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "k8s_nodes"},
+	}
+}
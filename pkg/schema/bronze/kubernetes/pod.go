@@ -0,0 +1,82 @@
+package kubernetes
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeK8sPod represents a Kubernetes pod in the bronze layer.
+type BronzeK8sPod struct {
+	ent.Schema
+}
+
+func (BronzeK8sPod) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeK8sPod) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("{cluster_name}/{namespace}/{name}"),
+		field.String("cluster_name").
+			NotEmpty(),
+		field.String("namespace").
+			NotEmpty(),
+		field.String("name").
+			NotEmpty(),
+		field.String("uid").
+			Optional(),
+		field.String("phase").
+			Optional().
+			Comment("Pending, Running, Succeeded, Failed or Unknown"),
+		field.String("node_name").
+			Optional(),
+		field.String("pod_ip").
+			Optional(),
+		field.String("host_ip").
+			Optional(),
+		field.String("service_account").
+			Optional(),
+		field.String("workload_kind").
+			Optional().
+			Comment("Owning workload kind, with ReplicaSets resolved to their Deployment"),
+		field.String("workload_name").
+			Optional(),
+		field.Bool("host_network").
+			Default(false),
+		field.JSON("containers_json", json.RawMessage{}).
+			Optional().
+			Comment("Containers: name, image, image_id, init"),
+		field.JSON("labels_json", json.RawMessage{}).
+			Optional(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeK8sPod) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("cluster_name", "namespace"),
+		index.Fields("node_name"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeK8sPod) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "k8s_pods"},
+	}
+}
@@ -0,0 +1,77 @@
+package kubernetes
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeK8sRoleBinding represents a Kubernetes RoleBinding or
+// ClusterRoleBinding, with the rules of its role, in the bronze layer.
+type BronzeK8sRoleBinding struct {
+	ent.Schema
+}
+
+func (BronzeK8sRoleBinding) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeK8sRoleBinding) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("{cluster_name}/{kind}/{namespace}/{name}, without namespace for ClusterRoleBindings"),
+		field.String("cluster_name").
+			NotEmpty(),
+		field.String("kind").
+			NotEmpty().
+			Comment("RoleBinding or ClusterRoleBinding"),
+		field.String("namespace").
+			Optional().
+			Comment("Empty for ClusterRoleBindings"),
+		field.String("name").
+			NotEmpty(),
+		field.String("uid").
+			Optional(),
+		field.String("role_kind").
+			NotEmpty().
+			Comment("Role or ClusterRole"),
+		field.String("role_name").
+			NotEmpty(),
+		field.JSON("subjects_json", json.RawMessage{}).
+			Optional().
+			Comment("Subjects: kind, name, namespace"),
+		field.JSON("rules_json", json.RawMessage{}).
+			Optional().
+			Comment("Policy rules of the bound role; null when the role does not exist"),
+		field.JSON("labels_json", json.RawMessage{}).
+			Optional(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeK8sRoleBinding) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("cluster_name", "namespace"),
+		index.Fields("role_name"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeK8sRoleBinding) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "k8s_role_bindings"},
+	}
+}
@@ -0,0 +1,69 @@
+package kubernetes
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeK8sServiceAccount represents a Kubernetes service account in the bronze layer.
+type BronzeK8sServiceAccount struct {
+	ent.Schema
+}
+
+func (BronzeK8sServiceAccount) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeK8sServiceAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("{cluster_name}/{namespace}/{name}"),
+		field.String("cluster_name").
+			NotEmpty(),
+		field.String("namespace").
+			NotEmpty(),
+		field.String("name").
+			NotEmpty(),
+		field.String("uid").
+			Optional(),
+		field.Bool("automount_token").
+			Optional().
+			Nillable().
+			Comment("automountServiceAccountToken; null means the default (true)"),
+		field.String("cloud_identity").
+			Optional().
+			Comment("Bound cloud identity: GKE Workload Identity service account or EKS IAM role ARN"),
+		field.JSON("image_pull_secrets_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("labels_json", json.RawMessage{}).
+			Optional(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeK8sServiceAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("cluster_name", "namespace"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeK8sServiceAccount) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "k8s_service_accounts"},
+	}
+}
@@ -0,0 +1,85 @@
+package kubernetes
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeK8sWorkload represents a Kubernetes workload controller (Deployment,
+// StatefulSet, DaemonSet, CronJob or standalone Job) in the bronze layer.
+type BronzeK8sWorkload struct {
+	ent.Schema
+}
+
+func (BronzeK8sWorkload) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeK8sWorkload) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("{cluster_name}/{namespace}/{kind}/{name}"),
+		field.String("cluster_name").
+			NotEmpty(),
+		field.String("namespace").
+			NotEmpty(),
+		field.String("kind").
+			NotEmpty().
+			Comment("Deployment, StatefulSet, DaemonSet, CronJob or Job"),
+		field.String("name").
+			NotEmpty(),
+		field.String("uid").
+			Optional(),
+		field.Int32("replicas").
+			Optional().
+			Nillable().
+			Comment("Desired replicas; null for DaemonSets, CronJobs and Jobs"),
+		field.String("service_account").
+			Optional(),
+		field.Bool("host_network").
+			Default(false),
+		field.Bool("host_pid").
+			Default(false),
+		field.Bool("host_ipc").
+			Default(false),
+		field.JSON("images_json", json.RawMessage{}).
+			Optional().
+			Comment("Container and init container images of the pod template"),
+		field.JSON("containers_json", json.RawMessage{}).
+			Optional().
+			Comment("Pod template containers: name, image, privileged, run_as_non_root, ..."),
+		field.JSON("selector_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("labels_json", json.RawMessage{}).
+			Optional(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeK8sWorkload) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("cluster_name", "namespace"),
+		index.Fields("kind"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeK8sWorkload) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "k8s_workloads"},
+	}
+}
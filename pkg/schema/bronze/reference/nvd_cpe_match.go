@@ -0,0 +1,70 @@
+package reference
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeReferenceNVDCPEMatch represents a CPE match criterion from the
+// configurations of an NVD CVE.
+type BronzeReferenceNVDCPEMatch struct {
+	ent.Schema
+}
+
+func (BronzeReferenceNVDCPEMatch) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeReferenceNVDCPEMatch) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("Composite key: {cve_id}:{n}"),
+		field.String("cve_id").
+			Comment("Reference to NVD CVE ID"),
+		field.String("criteria").
+			Comment("CPE 2.3 match string (e.g. cpe:2.3:a:tukaani:xz:*:*:*:*:*:*:*:*)"),
+		field.String("part").
+			Comment("a (application), o (operating system) or h (hardware)"),
+		field.String("cpe_vendor").
+			Comment("Vendor name from CPE"),
+		field.String("cpe_product").
+			Comment("Product name from CPE"),
+		field.String("cpe_version").
+			Comment("Version from CPE; * when bounded by the version range"),
+		field.Bool("vulnerable").
+			Default(true).
+			Comment("False for platform-only criteria (e.g. the OS a product runs on)"),
+		field.String("version_start_including").
+			Optional(),
+		field.String("version_start_excluding").
+			Optional(),
+		field.String("version_end_including").
+			Optional(),
+		field.String("version_end_excluding").
+			Optional(),
+	}
+}
+
+func (BronzeReferenceNVDCPEMatch) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("cve_id"),
+		index.Fields("cpe_vendor", "cpe_product"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeReferenceNVDCPEMatch) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "reference_nvd_cpe_matches"},
+	}
+}
@@ -0,0 +1,73 @@
+package reference
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeReferenceNVDCVE represents a CVE from the NVD JSON 2.0 data feeds.
+type BronzeReferenceNVDCVE struct {
+	ent.Schema
+}
+
+func (BronzeReferenceNVDCVE) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeReferenceNVDCVE) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("CVE ID (e.g. CVE-2024-3094)"),
+		field.Text("description").
+			Optional().
+			Comment("English description"),
+		field.String("vuln_status").
+			Optional().
+			Comment("e.g. Analyzed, Modified, Awaiting Analysis, Rejected"),
+		field.String("cvss_version").
+			Optional().
+			Comment("Version of the preferred CVSS metric (4.0, 3.1, 3.0 or 2.0)"),
+		field.Float("cvss_score").
+			Optional().
+			Nillable().
+			Comment("Base score of the preferred CVSS metric"),
+		field.String("cvss_severity").
+			Optional().
+			Comment("LOW, MEDIUM, HIGH or CRITICAL"),
+		field.String("cvss_vector").
+			Optional(),
+		field.JSON("cwes", []string{}).
+			Optional().
+			Comment("CWE IDs (e.g. CWE-79)"),
+		field.Time("published"),
+		field.Time("last_modified"),
+		field.Time("cisa_exploit_add").
+			Optional().
+			Nillable().
+			Comment("Date added to the CISA Known Exploited Vulnerabilities catalog"),
+	}
+}
+
+func (BronzeReferenceNVDCVE) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("cvss_severity"),
+		index.Fields("last_modified"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeReferenceNVDCVE) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "reference_nvd_cves"},
+	}
+}
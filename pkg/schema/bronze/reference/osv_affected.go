@@ -0,0 +1,70 @@
+package reference
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeReferenceOSVAffected represents one affected version interval of a
+// package in an OSV advisory. An advisory range with several introduced
+// events yields one row per interval.
+type BronzeReferenceOSVAffected struct {
+	ent.Schema
+}
+
+func (BronzeReferenceOSVAffected) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeReferenceOSVAffected) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("Composite key: {vuln_id}:{n}"),
+		field.String("vuln_id").
+			Comment("Reference to OSV vuln ID"),
+		field.String("ecosystem").
+			Comment("OSV ecosystem (e.g. Go, npm, PyPI, Debian:12, Rocky Linux:9)"),
+		field.String("package_name"),
+		field.String("purl").
+			Optional(),
+		field.String("range_type").
+			Optional().
+			Comment("SEMVER, ECOSYSTEM or GIT; empty when only versions are listed"),
+		field.String("introduced").
+			Optional().
+			Comment("First affected version; 0 means all versions before fixed"),
+		field.String("fixed").
+			Optional().
+			Comment("First fixed version (exclusive end)"),
+		field.String("last_affected").
+			Optional().
+			Comment("Last affected version (inclusive end)"),
+		field.JSON("versions", []string{}).
+			Optional().
+			Comment("Explicitly enumerated affected versions"),
+	}
+}
+
+func (BronzeReferenceOSVAffected) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vuln_id"),
+		index.Fields("ecosystem", "package_name"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeReferenceOSVAffected) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "reference_osv_affected"},
+	}
+}
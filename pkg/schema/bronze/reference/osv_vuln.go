@@ -0,0 +1,69 @@
+package reference
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeReferenceOSVVuln represents an advisory from the OSV database.
+type BronzeReferenceOSVVuln struct {
+	ent.Schema
+}
+
+func (BronzeReferenceOSVVuln) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeReferenceOSVVuln) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("OSV ID (e.g. GHSA-xxxx-xxxx-xxxx, GO-2024-0001, DSA-5678-1)"),
+		field.Text("summary").
+			Optional(),
+		field.Text("details").
+			Optional(),
+		field.JSON("aliases", []string{}).
+			Optional().
+			Comment("Other IDs of the same vulnerability (e.g. CVE-xxx)"),
+		field.String("severity_type").
+			Optional().
+			Comment("CVSS_V2, CVSS_V3, CVSS_V4 or Ubuntu"),
+		field.String("severity_score").
+			Optional().
+			Comment("CVSS vector string, or qualitative score for Ubuntu"),
+		field.String("database_severity").
+			Optional().
+			Comment("Severity from database_specific (e.g. HIGH for GHSA)"),
+		field.Time("published").
+			Optional().
+			Nillable(),
+		field.Time("modified").
+			Comment("Last modification time from OSV"),
+		field.Time("withdrawn").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeReferenceOSVVuln) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("modified"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeReferenceOSVVuln) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "reference_osv_vulns"},
+	}
+}
@@ -0,0 +1,66 @@
+package reference
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeReferenceThreatIntelIndicator represents an IP or CIDR listed by a
+// threat intelligence feed.
+type BronzeReferenceThreatIntelIndicator struct {
+	ent.Schema
+}
+
+func (BronzeReferenceThreatIntelIndicator) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeReferenceThreatIntelIndicator) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("Composite ID: {feed_name}:{cidr}"),
+		field.String("feed_name").
+			NotEmpty().
+			Comment("Configured feed name"),
+		field.String("feed_source").
+			NotEmpty().
+			Comment("URL or local path the indicator was loaded from"),
+		field.String("feed_format").
+			NotEmpty().
+			Comment("text, csv or stix"),
+		field.String("category").
+			NotEmpty().
+			Comment("scanner, tor or blocklist"),
+		field.String("cidr").
+			NotEmpty().
+			Comment("Masked prefix; single IPs are stored as /32 or /128"),
+		field.String("description").
+			Optional().
+			Comment("Indicator name or comment from the feed, if any"),
+		field.Time("expires_at").
+			Comment("Load time plus the feed expiry, or the STIX valid_until if earlier"),
+	}
+}
+
+func (BronzeReferenceThreatIntelIndicator) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("feed_name"),
+		index.Fields("expires_at"),
+	}
+}
+
+func (BronzeReferenceThreatIntelIndicator) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "reference_threat_intel_indicators"},
+	}
+}
@@ -30,6 +30,9 @@ func (BronzeReferenceUbuntuPackage) Fields() []ent.Field {
 			Comment("Composite key: {release}:{component}:{package}"),
 		field.String("package_name").
 			Comment("Package name (e.g. rsyslog)"),
+		field.String("source_package").
+			Optional().
+			Comment("Source package when it differs from package_name (e.g. openssl for libssl3)"),
 		field.String("release").
 			Comment("Ubuntu release codename (e.g. noble, jammy)"),
 		field.String("component").
@@ -0,0 +1,96 @@
+package s1
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeS1Activity represents a SentinelOne console activity (audit log entry)
+// in the bronze layer. Activities are append-only: rows are inserted once per
+// createdAt window and removed only by retention pruning, so there is no
+// history table.
+type BronzeS1Activity struct {
+	ent.Schema
+}
+
+func (BronzeS1Activity) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeS1Activity) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("SentinelOne activity ID"),
+		field.Int("activity_type").
+			Comment("SentinelOne activity type code, see /activities/types"),
+		field.String("activity_uuid").
+			Optional(),
+		field.String("primary_description").
+			Optional(),
+		field.String("secondary_description").
+			Optional(),
+		field.String("comments").
+			Optional(),
+		field.String("account_id").
+			Optional(),
+		field.String("account_name").
+			Optional(),
+		field.String("site_id").
+			Optional(),
+		field.String("site_name").
+			Optional(),
+		field.String("group_id").
+			Optional(),
+		field.String("group_name").
+			Optional(),
+		field.String("agent_id").
+			Optional().
+			Comment("SentinelOne agent ID; joins to s1_agents.resource_id"),
+		field.String("user_id").
+			Optional().
+			Comment("Console user who performed the action"),
+		field.String("threat_id").
+			Optional().
+			Comment("Related threat; joins to s1_threats.resource_id"),
+		field.String("hash").
+			Optional(),
+		field.String("os_family").
+			Optional(),
+		field.Time("api_created_at").
+			Comment("Activity time; drives windowing and retention"),
+		field.Time("api_updated_at").
+			Optional().
+			Nillable(),
+		field.JSON("data_json", json.RawMessage{}).
+			Optional().
+			Comment("Activity-type specific payload"),
+	}
+}
+
+func (BronzeS1Activity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("api_created_at"),
+		index.Fields("activity_type"),
+		index.Fields("agent_id"),
+		index.Fields("site_id"),
+		index.Fields("user_id"),
+	}
+}
+
+func (BronzeS1Activity) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "s1_activities"},
+	}
+}
@@ -0,0 +1,104 @@
+package s1
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeS1Alert represents a SentinelOne cloud-detection (STAR) alert in the bronze layer.
+// Rows are upserted incrementally by updatedAt and are never deleted as stale.
+type BronzeS1Alert struct {
+	ent.Schema
+}
+
+func (BronzeS1Alert) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeS1Alert) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("SentinelOne alert ID"),
+		field.String("agent_id").
+			Optional().
+			Comment("SentinelOne agent ID; joins to s1_agents.resource_id"),
+		field.String("agent_uuid").
+			Optional(),
+		field.String("agent_name").
+			Optional(),
+		field.String("agent_os_name").
+			Optional(),
+		field.String("site_id").
+			Optional(),
+		field.String("rule_id").
+			Optional(),
+		field.String("rule_name").
+			Optional(),
+		field.String("rule_severity").
+			Optional().
+			Comment("e.g. Low, Medium, High, Critical"),
+		field.String("rule_description").
+			Optional(),
+		field.String("rule_scope_level").
+			Optional(),
+		field.String("rule_treat_as_threat").
+			Optional(),
+		field.String("analyst_verdict").
+			Optional(),
+		field.String("incident_status").
+			Optional(),
+		field.String("event_type").
+			Optional(),
+		field.String("hit_type").
+			Optional(),
+		field.String("source").
+			Optional(),
+		field.String("dv_event_id").
+			Optional(),
+		field.Bool("is_edr").
+			Default(false),
+		field.Time("reported_at").
+			Optional().
+			Nillable(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+		field.Time("api_updated_at").
+			Optional().
+			Nillable(),
+		field.JSON("source_process_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("target_process_json", json.RawMessage{}).
+			Optional(),
+	}
+}
+
+func (BronzeS1Alert) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("agent_id"),
+		index.Fields("site_id"),
+		index.Fields("rule_id"),
+		index.Fields("rule_severity"),
+		index.Fields("incident_status"),
+		index.Fields("api_updated_at"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeS1Alert) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "s1_alerts"},
+	}
+}
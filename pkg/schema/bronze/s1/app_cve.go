@@ -0,0 +1,98 @@
+package s1
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeS1AppCVE represents a CVE affecting an application installed on a SentinelOne endpoint.
+// Fields preserve the /application-management/risks response.
+type BronzeS1AppCVE struct {
+	ent.Schema
+}
+
+func (BronzeS1AppCVE) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeS1AppCVE) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("Synthesized: endpointId||cveId||applicationName||applicationVersion"),
+		field.String("agent_id").
+			NotEmpty().
+			Comment("SentinelOne agent (endpoint) ID; joins to s1_agents.resource_id"),
+		field.String("endpoint_name").
+			Optional(),
+		field.String("endpoint_type").
+			Optional(),
+		field.String("os_type").
+			Optional(),
+		field.String("application_name").
+			NotEmpty(),
+		field.String("application_vendor").
+			Optional(),
+		field.String("application_version").
+			Optional(),
+		field.String("cve_id").
+			NotEmpty().
+			Comment("e.g. CVE-2024-3094"),
+		field.Float("cvss_score").
+			Default(0).
+			Comment("NVD CVSS base score"),
+		field.String("cvss_version").
+			Optional().
+			Comment("NVD CVSS version of cvss_score, e.g. 3.1"),
+		field.String("nvd_severity").
+			Optional().
+			Comment("NVD severity: LOW, MEDIUM, HIGH, CRITICAL"),
+		field.Float("risk_score").
+			Default(0).
+			Comment("SentinelOne risk score"),
+		field.String("exploit_code_maturity").
+			Optional().
+			Comment("e.g. UNPROVEN, PROOF_OF_CONCEPT, FUNCTIONAL, HIGH"),
+		field.Bool("exploited_in_the_wild").
+			Default(false).
+			Comment("Known exploited (e.g. listed in CISA KEV)"),
+		field.String("remediation_level").
+			Optional(),
+		field.String("report_confidence").
+			Optional(),
+		field.String("status").
+			Optional(),
+		field.Time("published_date").
+			Optional().
+			Nillable(),
+		field.Time("detection_date").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeS1AppCVE) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("agent_id"),
+		index.Fields("cve_id"),
+		index.Fields("nvd_severity"),
+		index.Fields("exploited_in_the_wild"),
+		index.Fields("application_name", "application_version"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeS1AppCVE) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "s1_app_cves"},
+	}
+}
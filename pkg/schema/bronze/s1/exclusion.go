@@ -0,0 +1,105 @@
+package s1
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeS1Exclusion represents a SentinelOne exclusion (allow-list entry) in the bronze
+// layer, attributed to the scope it is defined at.
+type BronzeS1Exclusion struct {
+	ent.Schema
+}
+
+func (BronzeS1Exclusion) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeS1Exclusion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("SentinelOne exclusion ID"),
+		field.String("scope_level").
+			NotEmpty().
+			Comment("Scope level: tenant, account, site or group"),
+		field.String("account_id").
+			Optional().
+			Comment("SentinelOne account ID; joins to s1_accounts.resource_id"),
+		field.String("site_id").
+			Optional().
+			Comment("SentinelOne site ID; joins to s1_sites.resource_id"),
+		field.String("group_id").
+			Optional().
+			Comment("SentinelOne group ID; joins to s1_groups.resource_id"),
+		field.String("scope_name").
+			Optional(),
+		field.String("scope_path").
+			Optional().
+			Comment("e.g. Global / Acme / Prod"),
+		field.String("exclusion_type").
+			NotEmpty().
+			Comment("path, white_hash, certificate, browser or file_type"),
+		field.String("exclusion_value").
+			Optional().
+			Comment("Excluded path, hash, signer or browser"),
+		field.String("os_type").
+			Optional(),
+		field.String("mode").
+			Optional().
+			Comment("Exclusion mode for path exclusions, e.g. suppress or disable_all_monitors"),
+		field.String("path_exclusion_type").
+			Optional(),
+		field.String("description").
+			Optional(),
+		field.String("source").
+			Optional(),
+		field.String("user_id").
+			Optional(),
+		field.String("user_name").
+			Optional(),
+		field.String("application_name").
+			Optional(),
+		field.Bool("include_children").
+			Default(false),
+		field.Bool("include_subfolders").
+			Default(false),
+		field.Bool("imported").
+			Default(false),
+		field.JSON("actions_json", json.RawMessage{}).
+			Optional(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+		field.Time("api_updated_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeS1Exclusion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("scope_level"),
+		index.Fields("site_id"),
+		index.Fields("group_id"),
+		index.Fields("exclusion_type"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeS1Exclusion) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "s1_exclusions"},
+	}
+}
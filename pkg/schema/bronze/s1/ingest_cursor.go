@@ -0,0 +1,46 @@
+package s1
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeS1IngestCursor tracks the ingestion watermark per incremental SentinelOne stream.
+type BronzeS1IngestCursor struct {
+	ent.Schema
+}
+
+func (BronzeS1IngestCursor) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeS1IngestCursor) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").
+			StorageKey("cursor_id"),
+		field.String("name").
+			NotEmpty().
+			Comment("Stream name, e.g. \"threats\""),
+		field.Time("last_updated_at").
+			Comment("Watermark: highest API updatedAt successfully ingested"),
+	}
+}
+
+func (BronzeS1IngestCursor) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Unique(),
+	}
+}
+
+func (BronzeS1IngestCursor) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "s1_ingest_cursors"},
+	}
+}
@@ -0,0 +1,95 @@
+package s1
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeS1Policy represents the agent policy defined at a SentinelOne scope
+// (tenant, account, site or non-inheriting group) in the bronze layer.
+type BronzeS1Policy struct {
+	ent.Schema
+}
+
+func (BronzeS1Policy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeS1Policy) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("Synthesized: scope level and ID, e.g. site:123 or tenant"),
+		field.String("scope_level").
+			NotEmpty().
+			Comment("Scope level: tenant, account, site or group"),
+		field.String("account_id").
+			Optional().
+			Comment("SentinelOne account ID; joins to s1_accounts.resource_id"),
+		field.String("site_id").
+			Optional().
+			Comment("SentinelOne site ID; joins to s1_sites.resource_id"),
+		field.String("group_id").
+			Optional().
+			Comment("SentinelOne group ID; joins to s1_groups.resource_id"),
+		field.String("scope_name").
+			Optional(),
+		field.String("inherited_from").
+			Optional().
+			Comment("Scope level the policy is inherited from, empty when set locally"),
+		field.String("mitigation_mode").
+			Optional().
+			Comment("Response to malicious threats: detect or protect"),
+		field.String("mitigation_mode_suspicious").
+			Optional().
+			Comment("Response to suspicious threats: detect or protect"),
+		field.String("auto_mitigation_action").
+			Optional().
+			Comment("e.g. mitigation.quarantineThreat"),
+		field.Bool("anti_tampering_on").
+			Default(false),
+		field.Bool("snapshots_on").
+			Default(false),
+		field.Bool("agent_logging_on").
+			Default(false),
+		field.Bool("scan_new_agents").
+			Default(false),
+		field.Bool("network_quarantine_on").
+			Default(false),
+		field.JSON("engines_json", json.RawMessage{}).
+			Optional().
+			Comment("Detection engine toggles"),
+		field.JSON("policy_json", json.RawMessage{}).
+			Optional().
+			Comment("Full policy as returned by the API"),
+		field.Time("api_updated_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeS1Policy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("scope_level"),
+		index.Fields("site_id"),
+		index.Fields("group_id"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeS1Policy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "s1_policies"},
+	}
+}
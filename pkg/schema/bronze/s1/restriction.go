@@ -0,0 +1,90 @@
+package s1
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeS1Restriction represents a SentinelOne blocklist (restriction) entry in the bronze
+// layer, attributed to the scope it is defined at.
+type BronzeS1Restriction struct {
+	ent.Schema
+}
+
+func (BronzeS1Restriction) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeS1Restriction) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("SentinelOne restriction ID"),
+		field.String("scope_level").
+			NotEmpty().
+			Comment("Scope level: tenant, account, site or group"),
+		field.String("account_id").
+			Optional().
+			Comment("SentinelOne account ID; joins to s1_accounts.resource_id"),
+		field.String("site_id").
+			Optional().
+			Comment("SentinelOne site ID; joins to s1_sites.resource_id"),
+		field.String("group_id").
+			Optional().
+			Comment("SentinelOne group ID; joins to s1_groups.resource_id"),
+		field.String("scope_name").
+			Optional(),
+		field.String("scope_path").
+			Optional().
+			Comment("e.g. Global / Acme / Prod"),
+		field.String("restriction_type").
+			NotEmpty().
+			Comment("e.g. black_hash"),
+		field.String("restriction_value").
+			Optional().
+			Comment("Blocked file hash"),
+		field.String("os_type").
+			Optional(),
+		field.String("description").
+			Optional(),
+		field.String("source").
+			Optional(),
+		field.String("user_id").
+			Optional(),
+		field.String("user_name").
+			Optional(),
+		field.Bool("imported").
+			Default(false),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+		field.Time("api_updated_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeS1Restriction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("scope_level"),
+		index.Fields("site_id"),
+		index.Fields("group_id"),
+		index.Fields("restriction_value"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeS1Restriction) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "s1_restrictions"},
+	}
+}
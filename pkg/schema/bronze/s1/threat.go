@@ -0,0 +1,120 @@
+package s1
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"danny.vn/hotpot/pkg/schema/bronze/mixin"
+)
+
+// BronzeS1Threat represents a SentinelOne threat in the bronze layer.
+// Rows are upserted incrementally by updatedAt and are never deleted as stale.
+type BronzeS1Threat struct {
+	ent.Schema
+}
+
+func (BronzeS1Threat) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Timestamp{},
+	}
+}
+
+func (BronzeS1Threat) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("resource_id").
+			Unique().
+			Immutable().
+			Comment("SentinelOne threat ID"),
+		field.String("agent_id").
+			Optional().
+			Comment("SentinelOne agent ID; joins to s1_agents.resource_id"),
+		field.String("agent_uuid").
+			Optional(),
+		field.String("agent_computer_name").
+			Optional(),
+		field.String("agent_os_type").
+			Optional(),
+		field.String("account_id").
+			Optional(),
+		field.String("site_id").
+			Optional(),
+		field.String("site_name").
+			Optional(),
+		field.String("group_id").
+			Optional(),
+		field.String("threat_name").
+			Optional(),
+		field.String("classification").
+			Optional().
+			Comment("e.g. Malware, Ransomware, PUA"),
+		field.String("classification_source").
+			Optional(),
+		field.String("confidence_level").
+			Optional().
+			Comment("malicious or suspicious"),
+		field.String("mitigation_status").
+			Optional().
+			Comment("e.g. mitigated, not_mitigated, marked_as_benign"),
+		field.String("analyst_verdict").
+			Optional(),
+		field.String("incident_status").
+			Optional(),
+		field.String("initiated_by").
+			Optional(),
+		field.String("detection_type").
+			Optional(),
+		field.String("file_path").
+			Optional(),
+		field.String("sha1").
+			Optional(),
+		field.String("sha256").
+			Optional(),
+		field.String("md5").
+			Optional(),
+		field.String("storyline").
+			Optional(),
+		field.Bool("is_fileless").
+			Default(false),
+		field.Bool("mitigated_preemptively").
+			Default(false),
+		field.Time("identified_at").
+			Optional().
+			Nillable(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+		field.Time("api_updated_at").
+			Optional().
+			Nillable(),
+		field.JSON("engines_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("indicators_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("mitigation_actions_json", json.RawMessage{}).
+			Optional(),
+	}
+}
+
+func (BronzeS1Threat) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("agent_id"),
+		index.Fields("account_id"),
+		index.Fields("site_id"),
+		index.Fields("mitigation_status"),
+		index.Fields("classification"),
+		index.Fields("api_updated_at"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeS1Threat) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "s1_threats"},
+	}
+}
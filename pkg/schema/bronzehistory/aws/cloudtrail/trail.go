@@ -0,0 +1,88 @@
+package cloudtrail
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryAWSCloudTrailTrail stores historical snapshots of AWS CloudTrail trails.
+// Uses resource_id for lookup, with valid_from/valid_to for time range.
+type BronzeHistoryAWSCloudTrailTrail struct {
+	ent.Schema
+}
+
+func (BronzeHistoryAWSCloudTrailTrail) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryAWSCloudTrailTrail) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze CloudTrail trail by resource_id"),
+
+		// All CloudTrail trail fields (same as bronze.BronzeAWSCloudTrailTrail)
+		field.String("name").
+			Optional(),
+		field.String("s3_bucket_name").
+			Optional(),
+		field.String("s3_key_prefix").
+			Optional(),
+		field.String("sns_topic_arn").
+			Optional(),
+		field.Bool("is_multi_region_trail").
+			Optional(),
+		field.Bool("is_organization_trail").
+			Optional(),
+		field.Bool("include_global_service_events").
+			Optional(),
+		field.Bool("log_file_validation_enabled").
+			Optional(),
+		field.String("kms_key_id").
+			Optional(),
+		field.String("cloud_watch_logs_log_group_arn").
+			Optional(),
+		field.String("cloud_watch_logs_role_arn").
+			Optional(),
+		field.Bool("has_custom_event_selectors").
+			Optional(),
+		field.Bool("has_insight_selectors").
+			Optional(),
+		field.Bool("is_logging").
+			Optional(),
+		field.JSON("event_selectors_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("advanced_event_selectors_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeHistoryAWSCloudTrailTrail) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+	}
+}
+
+func (BronzeHistoryAWSCloudTrailTrail) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_cloudtrail_trails_history"},
+	}
+}
@@ -0,0 +1,76 @@
+package ec2
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryAWSEC2Address stores historical snapshots of AWS Elastic IP addresses.
+// Uses resource_id for lookup, with valid_from/valid_to for time range.
+type BronzeHistoryAWSEC2Address struct {
+	ent.Schema
+}
+
+func (BronzeHistoryAWSEC2Address) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryAWSEC2Address) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze Elastic IP address by resource_id"),
+
+		// All Elastic IP address fields (same as bronze.BronzeAWSEC2Address)
+		field.String("name").
+			Optional(),
+		field.String("public_ip").
+			Optional(),
+		field.String("domain").
+			Optional(),
+		field.String("association_id").
+			Optional(),
+		field.String("instance_id").
+			Optional(),
+		field.String("network_interface_id").
+			Optional(),
+		field.String("network_interface_owner_id").
+			Optional(),
+		field.String("private_ip_address").
+			Optional(),
+		field.String("public_ipv4_pool").
+			Optional(),
+		field.String("network_border_group").
+			Optional(),
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeHistoryAWSEC2Address) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+	}
+}
+
+func (BronzeHistoryAWSEC2Address) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_ec2_addresses_history"},
+	}
+}
@@ -0,0 +1,64 @@
+package ec2
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryAWSEC2InternetGateway stores historical snapshots of AWS internet gateways.
+// Uses resource_id for lookup, with valid_from/valid_to for time range.
+type BronzeHistoryAWSEC2InternetGateway struct {
+	ent.Schema
+}
+
+func (BronzeHistoryAWSEC2InternetGateway) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryAWSEC2InternetGateway) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze internet gateway by resource_id"),
+
+		// All internet gateway fields (same as bronze.BronzeAWSEC2InternetGateway)
+		field.String("name").
+			Optional(),
+		field.String("vpc_id").
+			Optional(),
+		field.String("attachment_state").
+			Optional(),
+		field.String("owner_id").
+			Optional(),
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeHistoryAWSEC2InternetGateway) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+	}
+}
+
+func (BronzeHistoryAWSEC2InternetGateway) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_ec2_internet_gateways_history"},
+	}
+}
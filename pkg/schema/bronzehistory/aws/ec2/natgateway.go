@@ -0,0 +1,75 @@
+package ec2
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryAWSEC2NATGateway stores historical snapshots of AWS NAT gateways.
+// Uses resource_id for lookup, with valid_from/valid_to for time range.
+type BronzeHistoryAWSEC2NATGateway struct {
+	ent.Schema
+}
+
+func (BronzeHistoryAWSEC2NATGateway) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryAWSEC2NATGateway) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze NAT gateway by resource_id"),
+
+		// All NAT gateway fields (same as bronze.BronzeAWSEC2NATGateway)
+		field.String("name").
+			Optional(),
+		field.String("vpc_id").
+			Optional(),
+		field.String("subnet_id").
+			Optional(),
+		field.String("state").
+			Optional(),
+		field.String("connectivity_type").
+			Optional(),
+		field.Time("create_time").
+			Optional().
+			Nillable(),
+		field.String("failure_code").
+			Optional(),
+		field.String("failure_message").
+			Optional(),
+		field.JSON("addresses_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeHistoryAWSEC2NATGateway) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+	}
+}
+
+func (BronzeHistoryAWSEC2NATGateway) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_ec2_nat_gateways_history"},
+	}
+}
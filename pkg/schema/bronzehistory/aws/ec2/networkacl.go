@@ -0,0 +1,68 @@
+package ec2
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryAWSEC2NetworkACL stores historical snapshots of AWS network ACLs.
+// Uses resource_id for lookup, with valid_from/valid_to for time range.
+type BronzeHistoryAWSEC2NetworkACL struct {
+	ent.Schema
+}
+
+func (BronzeHistoryAWSEC2NetworkACL) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryAWSEC2NetworkACL) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze network ACL by resource_id"),
+
+		// All network ACL fields (same as bronze.BronzeAWSEC2NetworkACL)
+		field.String("name").
+			Optional(),
+		field.String("vpc_id").
+			Optional(),
+		field.Bool("is_default").
+			Optional(),
+		field.String("owner_id").
+			Optional(),
+		field.JSON("entries_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("associations_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeHistoryAWSEC2NetworkACL) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+	}
+}
+
+func (BronzeHistoryAWSEC2NetworkACL) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_ec2_network_acls_history"},
+	}
+}
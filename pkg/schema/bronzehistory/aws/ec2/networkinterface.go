@@ -0,0 +1,98 @@
+package ec2
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryAWSEC2NetworkInterface stores historical snapshots of AWS network interfaces.
+// Uses resource_id for lookup, with valid_from/valid_to for time range.
+type BronzeHistoryAWSEC2NetworkInterface struct {
+	ent.Schema
+}
+
+func (BronzeHistoryAWSEC2NetworkInterface) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryAWSEC2NetworkInterface) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze network interface by resource_id"),
+
+		// All network interface fields (same as bronze.BronzeAWSEC2NetworkInterface)
+		field.String("description").
+			Optional(),
+		field.String("interface_type").
+			Optional(),
+		field.String("status").
+			Optional(),
+		field.String("vpc_id").
+			Optional(),
+		field.String("subnet_id").
+			Optional(),
+		field.String("availability_zone").
+			Optional(),
+		field.String("mac_address").
+			Optional(),
+		field.String("private_ip_address").
+			Optional(),
+		field.String("private_dns_name").
+			Optional(),
+		field.String("public_ip").
+			Optional(),
+		field.String("public_dns_name").
+			Optional(),
+		field.Bool("source_dest_check").
+			Optional(),
+		field.Bool("requester_managed").
+			Optional(),
+		field.String("requester_id").
+			Optional(),
+		field.String("owner_id").
+			Optional(),
+		field.String("attachment_id").
+			Optional(),
+		field.String("attachment_instance_id").
+			Optional(),
+		field.String("attachment_status").
+			Optional(),
+		field.JSON("groups_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("private_ip_addresses_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("ipv6_addresses_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeHistoryAWSEC2NetworkInterface) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+	}
+}
+
+func (BronzeHistoryAWSEC2NetworkInterface) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_ec2_network_interfaces_history"},
+	}
+}
@@ -0,0 +1,68 @@
+package ec2
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryAWSEC2RouteTable stores historical snapshots of AWS route tables.
+// Uses resource_id for lookup, with valid_from/valid_to for time range.
+type BronzeHistoryAWSEC2RouteTable struct {
+	ent.Schema
+}
+
+func (BronzeHistoryAWSEC2RouteTable) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryAWSEC2RouteTable) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze route table by resource_id"),
+
+		// All route table fields (same as bronze.BronzeAWSEC2RouteTable)
+		field.String("name").
+			Optional(),
+		field.String("vpc_id").
+			Optional(),
+		field.String("owner_id").
+			Optional(),
+		field.JSON("routes_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("associations_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("propagating_vgws_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeHistoryAWSEC2RouteTable) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+	}
+}
+
+func (BronzeHistoryAWSEC2RouteTable) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_ec2_route_tables_history"},
+	}
+}
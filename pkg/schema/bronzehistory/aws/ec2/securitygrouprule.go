@@ -0,0 +1,84 @@
+package ec2
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryAWSEC2SecurityGroupRule stores historical snapshots of AWS security group rules.
+// Uses resource_id for lookup, with valid_from/valid_to for time range.
+type BronzeHistoryAWSEC2SecurityGroupRule struct {
+	ent.Schema
+}
+
+func (BronzeHistoryAWSEC2SecurityGroupRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryAWSEC2SecurityGroupRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze security group rule by resource_id"),
+
+		// All security group rule fields (same as bronze.BronzeAWSEC2SecurityGroupRule)
+		field.String("group_id").
+			Optional(),
+		field.String("group_owner_id").
+			Optional(),
+		field.Bool("is_egress").
+			Optional(),
+		field.String("ip_protocol").
+			Optional(),
+		field.Int32("from_port").
+			Optional().
+			Nillable(),
+		field.Int32("to_port").
+			Optional().
+			Nillable(),
+		field.String("cidr_ipv4").
+			Optional(),
+		field.String("cidr_ipv6").
+			Optional(),
+		field.String("prefix_list_id").
+			Optional(),
+		field.String("referenced_group_id").
+			Optional(),
+		field.String("referenced_user_id").
+			Optional(),
+		field.String("description").
+			Optional(),
+		field.String("security_group_rule_arn").
+			Optional(),
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeHistoryAWSEC2SecurityGroupRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+	}
+}
+
+func (BronzeHistoryAWSEC2SecurityGroupRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_ec2_security_group_rules_history"},
+	}
+}
@@ -0,0 +1,80 @@
+package ec2
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryAWSEC2Subnet stores historical snapshots of AWS subnets.
+// Uses resource_id for lookup, with valid_from/valid_to for time range.
+type BronzeHistoryAWSEC2Subnet struct {
+	ent.Schema
+}
+
+func (BronzeHistoryAWSEC2Subnet) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryAWSEC2Subnet) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze subnet by resource_id"),
+
+		// All subnet fields (same as bronze.BronzeAWSEC2Subnet)
+		field.String("name").
+			Optional(),
+		field.String("vpc_id").
+			Optional(),
+		field.String("cidr_block").
+			Optional(),
+		field.String("availability_zone").
+			Optional(),
+		field.String("availability_zone_id").
+			Optional(),
+		field.String("state").
+			Optional(),
+		field.Bool("default_for_az").
+			Optional(),
+		field.Bool("map_public_ip_on_launch").
+			Optional(),
+		field.Bool("assign_ipv6_address_on_creation").
+			Optional(),
+		field.String("subnet_arn").
+			Optional(),
+		field.String("owner_id").
+			Optional(),
+		field.JSON("ipv6_cidr_block_associations_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeHistoryAWSEC2Subnet) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+	}
+}
+
+func (BronzeHistoryAWSEC2Subnet) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_ec2_subnets_history"},
+	}
+}
@@ -0,0 +1,74 @@
+package ec2
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryAWSEC2VPC stores historical snapshots of AWS VPCs.
+// Uses resource_id for lookup, with valid_from/valid_to for time range.
+type BronzeHistoryAWSEC2VPC struct {
+	ent.Schema
+}
+
+func (BronzeHistoryAWSEC2VPC) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryAWSEC2VPC) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze VPC by resource_id"),
+
+		// All VPC fields (same as bronze.BronzeAWSEC2VPC)
+		field.String("name").
+			Optional(),
+		field.String("cidr_block").
+			Optional(),
+		field.String("state").
+			Optional(),
+		field.Bool("is_default").
+			Optional(),
+		field.String("instance_tenancy").
+			Optional(),
+		field.String("dhcp_options_id").
+			Optional(),
+		field.String("owner_id").
+			Optional(),
+		field.JSON("cidr_block_associations_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("ipv6_cidr_block_associations_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeHistoryAWSEC2VPC) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+	}
+}
+
+func (BronzeHistoryAWSEC2VPC) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_ec2_vpcs_history"},
+	}
+}
@@ -0,0 +1,63 @@
+package iam
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryAWSIAMAccessKey stores historical snapshots of AWS IAM access keys.
+// Uses resource_id for lookup, with valid_from/valid_to for time range.
+type BronzeHistoryAWSIAMAccessKey struct {
+	ent.Schema
+}
+
+func (BronzeHistoryAWSIAMAccessKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryAWSIAMAccessKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze access key by resource_id"),
+
+		// All access key fields (same as bronze.BronzeAWSIAMAccessKey)
+		field.String("user_name").
+			NotEmpty(),
+		field.String("status").
+			Optional(),
+		field.Time("create_date").
+			Optional().
+			Nillable(),
+		field.Time("last_used_date").
+			Optional().
+			Nillable(),
+		field.String("last_used_service").
+			Optional(),
+		field.String("last_used_region").
+			Optional(),
+		field.String("account_id").
+			NotEmpty(),
+	}
+}
+
+func (BronzeHistoryAWSIAMAccessKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("account_id"),
+	}
+}
+
+func (BronzeHistoryAWSIAMAccessKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_iam_access_keys_history"},
+	}
+}
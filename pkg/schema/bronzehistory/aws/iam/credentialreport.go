@@ -0,0 +1,104 @@
+package iam
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryAWSIAMCredentialReport stores historical snapshots of AWS IAM credential report rows.
+// Uses resource_id for lookup, with valid_from/valid_to for time range.
+type BronzeHistoryAWSIAMCredentialReport struct {
+	ent.Schema
+}
+
+func (BronzeHistoryAWSIAMCredentialReport) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryAWSIAMCredentialReport) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze credential report row by resource_id"),
+
+		// All credential report row fields (same as bronze.BronzeAWSIAMCredentialReport)
+		field.String("user").
+			NotEmpty(),
+		field.Time("user_creation_time").
+			Optional().
+			Nillable(),
+		field.String("password_enabled").
+			Optional(),
+		field.Time("password_last_used").
+			Optional().
+			Nillable(),
+		field.Time("password_last_changed").
+			Optional().
+			Nillable(),
+		field.Time("password_next_rotation").
+			Optional().
+			Nillable(),
+		field.Bool("mfa_active").
+			Default(false),
+		field.Bool("access_key_1_active").
+			Default(false),
+		field.Time("access_key_1_last_rotated").
+			Optional().
+			Nillable(),
+		field.Time("access_key_1_last_used_date").
+			Optional().
+			Nillable(),
+		field.String("access_key_1_last_used_region").
+			Optional(),
+		field.String("access_key_1_last_used_service").
+			Optional(),
+		field.Bool("access_key_2_active").
+			Default(false),
+		field.Time("access_key_2_last_rotated").
+			Optional().
+			Nillable(),
+		field.Time("access_key_2_last_used_date").
+			Optional().
+			Nillable(),
+		field.String("access_key_2_last_used_region").
+			Optional(),
+		field.String("access_key_2_last_used_service").
+			Optional(),
+		field.Bool("cert_1_active").
+			Default(false),
+		field.Time("cert_1_last_rotated").
+			Optional().
+			Nillable(),
+		field.Bool("cert_2_active").
+			Default(false),
+		field.Time("cert_2_last_rotated").
+			Optional().
+			Nillable(),
+		field.Time("report_generated_at").
+			Optional().
+			Nillable(),
+		field.String("account_id").
+			NotEmpty(),
+	}
+}
+
+func (BronzeHistoryAWSIAMCredentialReport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("account_id"),
+	}
+}
+
+func (BronzeHistoryAWSIAMCredentialReport) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_iam_credential_reports_history"},
+	}
+}
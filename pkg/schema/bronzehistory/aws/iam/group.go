@@ -0,0 +1,64 @@
+package iam
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryAWSIAMGroup stores historical snapshots of AWS IAM groups.
+// Uses resource_id for lookup, with valid_from/valid_to for time range.
+type BronzeHistoryAWSIAMGroup struct {
+	ent.Schema
+}
+
+func (BronzeHistoryAWSIAMGroup) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryAWSIAMGroup) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze group by resource_id"),
+
+		// All group fields (same as bronze.BronzeAWSIAMGroup)
+		field.String("arn").
+			NotEmpty(),
+		field.String("group_name").
+			NotEmpty(),
+		field.String("path").
+			Optional(),
+		field.Time("create_date").
+			Optional().
+			Nillable(),
+		field.JSON("attached_policies_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("inline_policies_json", json.RawMessage{}).
+			Optional(),
+		field.String("account_id").
+			NotEmpty(),
+	}
+}
+
+func (BronzeHistoryAWSIAMGroup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("account_id"),
+	}
+}
+
+func (BronzeHistoryAWSIAMGroup) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_iam_groups_history"},
+	}
+}
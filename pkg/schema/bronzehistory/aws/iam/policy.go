@@ -0,0 +1,77 @@
+package iam
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryAWSIAMPolicy stores historical snapshots of AWS IAM managed policies.
+// Uses resource_id for lookup, with valid_from/valid_to for time range.
+type BronzeHistoryAWSIAMPolicy struct {
+	ent.Schema
+}
+
+func (BronzeHistoryAWSIAMPolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryAWSIAMPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze policy by resource_id"),
+
+		// All policy fields (same as bronze.BronzeAWSIAMPolicy)
+		field.String("arn").
+			NotEmpty(),
+		field.String("policy_name").
+			NotEmpty(),
+		field.String("path").
+			Optional(),
+		field.String("description").
+			Optional(),
+		field.String("default_version_id").
+			Optional(),
+		field.Int("attachment_count").
+			Default(0),
+		field.Int("permissions_boundary_usage_count").
+			Default(0),
+		field.Bool("is_attachable").
+			Default(false),
+		field.Bool("aws_managed").
+			Default(false),
+		field.Time("create_date").
+			Optional().
+			Nillable(),
+		field.Time("update_date").
+			Optional().
+			Nillable(),
+		field.JSON("versions_json", json.RawMessage{}).
+			Optional(),
+		field.String("account_id").
+			NotEmpty(),
+	}
+}
+
+func (BronzeHistoryAWSIAMPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("account_id"),
+	}
+}
+
+func (BronzeHistoryAWSIAMPolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_iam_policies_history"},
+	}
+}
@@ -0,0 +1,77 @@
+package iam
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryAWSIAMRole stores historical snapshots of AWS IAM roles.
+// Uses resource_id for lookup, with valid_from/valid_to for time range.
+type BronzeHistoryAWSIAMRole struct {
+	ent.Schema
+}
+
+func (BronzeHistoryAWSIAMRole) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryAWSIAMRole) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze role by resource_id"),
+
+		// All role fields (same as bronze.BronzeAWSIAMRole)
+		field.String("arn").
+			NotEmpty(),
+		field.String("role_name").
+			NotEmpty(),
+		field.String("path").
+			Optional(),
+		field.Time("create_date").
+			Optional().
+			Nillable(),
+		field.Time("role_last_used_date").
+			Optional().
+			Nillable(),
+		field.String("role_last_used_region").
+			Optional(),
+		field.String("permissions_boundary_arn").
+			Optional(),
+		field.JSON("assume_role_policy_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("attached_policies_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("inline_policies_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("instance_profiles_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+		field.String("account_id").
+			NotEmpty(),
+	}
+}
+
+func (BronzeHistoryAWSIAMRole) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("account_id"),
+	}
+}
+
+func (BronzeHistoryAWSIAMRole) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_iam_roles_history"},
+	}
+}
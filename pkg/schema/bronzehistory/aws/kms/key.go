@@ -0,0 +1,93 @@
+package kms
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryAWSKMSKey stores historical snapshots of AWS KMS keys.
+// Uses resource_id for lookup, with valid_from/valid_to for time range.
+type BronzeHistoryAWSKMSKey struct {
+	ent.Schema
+}
+
+func (BronzeHistoryAWSKMSKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryAWSKMSKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze KMS key by resource_id"),
+
+		// All KMS key fields (same as bronze.BronzeAWSKMSKey)
+		field.String("arn").
+			Optional(),
+		field.String("description").
+			Optional(),
+		field.String("key_state").
+			Optional(),
+		field.String("key_usage").
+			Optional(),
+		field.String("key_spec").
+			Optional(),
+		field.String("key_manager").
+			Optional(),
+		field.String("origin").
+			Optional(),
+		field.Bool("enabled").
+			Optional(),
+		field.Bool("multi_region").
+			Optional(),
+		field.Time("creation_date").
+			Optional().
+			Nillable(),
+		field.Time("deletion_date").
+			Optional().
+			Nillable(),
+		field.Bool("rotation_enabled").
+			Optional().
+			Nillable(),
+		field.Int32("rotation_period_in_days").
+			Optional().
+			Nillable(),
+		field.Time("next_rotation_date").
+			Optional().
+			Nillable(),
+		field.JSON("policy_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("aliases_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeHistoryAWSKMSKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+	}
+}
+
+func (BronzeHistoryAWSKMSKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_kms_keys_history"},
+	}
+}
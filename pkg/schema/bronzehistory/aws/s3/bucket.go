@@ -0,0 +1,96 @@
+package s3
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryAWSS3Bucket stores historical snapshots of AWS S3 buckets.
+// Uses resource_id for lookup, with valid_from/valid_to for time range.
+type BronzeHistoryAWSS3Bucket struct {
+	ent.Schema
+}
+
+func (BronzeHistoryAWSS3Bucket) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryAWSS3Bucket) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze S3 bucket by resource_id"),
+
+		// All S3 bucket fields (same as bronze.BronzeAWSS3Bucket)
+		field.String("arn").
+			Optional(),
+		field.Time("creation_date").
+			Optional().
+			Nillable(),
+		field.String("owner_id").
+			Optional(),
+		field.Bool("block_public_acls").
+			Optional().
+			Nillable(),
+		field.Bool("ignore_public_acls").
+			Optional().
+			Nillable(),
+		field.Bool("block_public_policy").
+			Optional().
+			Nillable(),
+		field.Bool("restrict_public_buckets").
+			Optional().
+			Nillable(),
+		field.Bool("policy_is_public").
+			Optional().
+			Nillable(),
+		field.String("sse_algorithm").
+			Optional(),
+		field.String("kms_master_key_id").
+			Optional(),
+		field.Bool("bucket_key_enabled").
+			Optional(),
+		field.String("versioning_status").
+			Optional(),
+		field.String("mfa_delete").
+			Optional(),
+		field.String("logging_target_bucket").
+			Optional(),
+		field.String("logging_target_prefix").
+			Optional(),
+		field.JSON("policy_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("acl_grants_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional(),
+		field.String("account_id").
+			NotEmpty(),
+		field.String("region").
+			NotEmpty(),
+	}
+}
+
+func (BronzeHistoryAWSS3Bucket) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("account_id"),
+		index.Fields("region"),
+	}
+}
+
+func (BronzeHistoryAWSS3Bucket) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "aws_s3_buckets_history"},
+	}
+}
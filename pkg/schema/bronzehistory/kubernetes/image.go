@@ -0,0 +1,65 @@
+package kubernetes
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryK8sImage stores historical snapshots of container images running in Kubernetes clusters.
+type BronzeHistoryK8sImage struct {
+	ent.Schema
+}
+
+func (BronzeHistoryK8sImage) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryK8sImage) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze image by resource_id"),
+		field.String("cluster_name").
+			NotEmpty(),
+		field.String("registry").
+			NotEmpty().
+			Comment("e.g. docker.io, gcr.io, registry.digitalocean.com"),
+		field.String("repository").
+			NotEmpty().
+			Comment("e.g. library/nginx"),
+		field.String("digest").
+			Optional().
+			Comment("sha256:... from the container status image ID"),
+		field.JSON("tags_json", json.RawMessage{}).
+			Optional().
+			Comment("Tags the image is referenced by"),
+		field.JSON("namespaces_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("workloads_json", json.RawMessage{}).
+			Optional().
+			Comment("Workloads running the image: {namespace}/{kind}/{name}"),
+	}
+}
+
+func (BronzeHistoryK8sImage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("cluster_name"),
+	}
+}
+
+func (BronzeHistoryK8sImage) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "k8s_images_history"},
+	}
+}
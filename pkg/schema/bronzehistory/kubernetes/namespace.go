@@ -0,0 +1,61 @@
+package kubernetes
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryK8sNamespace stores historical snapshots of Kubernetes namespaces.
+type BronzeHistoryK8sNamespace struct {
+	ent.Schema
+}
+
+func (BronzeHistoryK8sNamespace) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryK8sNamespace) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze namespace by resource_id"),
+		field.String("cluster_name").
+			NotEmpty().
+			Comment("Cluster name from kubernetes.clusters config"),
+		field.String("name").
+			NotEmpty(),
+		field.String("uid").
+			Optional(),
+		field.String("phase").
+			Optional().
+			Comment("Active or Terminating"),
+		field.JSON("labels_json", json.RawMessage{}).
+			Optional(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeHistoryK8sNamespace) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("cluster_name"),
+	}
+}
+
+func (BronzeHistoryK8sNamespace) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "k8s_namespaces_history"},
+	}
+}
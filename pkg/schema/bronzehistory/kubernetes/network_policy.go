@@ -0,0 +1,68 @@
+package kubernetes
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryK8sNetworkPolicy stores historical snapshots of Kubernetes network policies.
+type BronzeHistoryK8sNetworkPolicy struct {
+	ent.Schema
+}
+
+func (BronzeHistoryK8sNetworkPolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryK8sNetworkPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze network policy by resource_id"),
+		field.String("cluster_name").
+			NotEmpty(),
+		field.String("namespace").
+			NotEmpty(),
+		field.String("name").
+			NotEmpty(),
+		field.String("uid").
+			Optional(),
+		field.JSON("pod_selector_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("policy_types_json", json.RawMessage{}).
+			Optional().
+			Comment("Ingress and/or Egress"),
+		field.JSON("ingress_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("egress_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("labels_json", json.RawMessage{}).
+			Optional(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeHistoryK8sNetworkPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("cluster_name"),
+	}
+}
+
+func (BronzeHistoryK8sNetworkPolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "k8s_network_policies_history"},
+	}
+}
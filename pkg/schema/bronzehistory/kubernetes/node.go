@@ -0,0 +1,89 @@
+package kubernetes
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryK8sNode stores historical snapshots of Kubernetes nodes.
+type BronzeHistoryK8sNode struct {
+	ent.Schema
+}
+
+func (BronzeHistoryK8sNode) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryK8sNode) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze node by resource_id"),
+		field.String("cluster_name").
+			NotEmpty(),
+		field.String("name").
+			NotEmpty(),
+		field.String("uid").
+			Optional(),
+		field.String("provider_id").
+			Optional().
+			Comment("spec.providerID, e.g. gce://{project}/{zone}/{name} or digitalocean://{droplet_id}"),
+		field.String("internal_ip").
+			Optional(),
+		field.String("external_ip").
+			Optional(),
+		field.String("node_pool").
+			Optional().
+			Comment("Node pool from the managed-cluster node pool label"),
+		field.String("instance_type").
+			Optional(),
+		field.String("region").
+			Optional(),
+		field.String("zone").
+			Optional(),
+		field.String("kubelet_version").
+			Optional(),
+		field.String("os_image").
+			Optional(),
+		field.String("kernel_version").
+			Optional(),
+		field.String("container_runtime").
+			Optional(),
+		field.String("architecture").
+			Optional(),
+		field.Bool("ready").
+			Default(false),
+		field.Bool("unschedulable").
+			Default(false),
+		field.JSON("labels_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("taints_json", json.RawMessage{}).
+			Optional(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeHistoryK8sNode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("cluster_name"),
+	}
+}
+
+func (BronzeHistoryK8sNode) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "k8s_nodes_history"},
+	}
+}
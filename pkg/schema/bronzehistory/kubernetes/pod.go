@@ -0,0 +1,80 @@
+package kubernetes
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryK8sPod stores historical snapshots of Kubernetes pods.
+type BronzeHistoryK8sPod struct {
+	ent.Schema
+}
+
+func (BronzeHistoryK8sPod) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryK8sPod) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze pod by resource_id"),
+		field.String("cluster_name").
+			NotEmpty(),
+		field.String("namespace").
+			NotEmpty(),
+		field.String("name").
+			NotEmpty(),
+		field.String("uid").
+			Optional(),
+		field.String("phase").
+			Optional().
+			Comment("Pending, Running, Succeeded, Failed or Unknown"),
+		field.String("node_name").
+			Optional(),
+		field.String("pod_ip").
+			Optional(),
+		field.String("host_ip").
+			Optional(),
+		field.String("service_account").
+			Optional(),
+		field.String("workload_kind").
+			Optional().
+			Comment("Owning workload kind, with ReplicaSets resolved to their Deployment"),
+		field.String("workload_name").
+			Optional(),
+		field.Bool("host_network").
+			Default(false),
+		field.JSON("containers_json", json.RawMessage{}).
+			Optional().
+			Comment("Containers: name, image, image_id, init"),
+		field.JSON("labels_json", json.RawMessage{}).
+			Optional(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeHistoryK8sPod) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("cluster_name"),
+	}
+}
+
+func (BronzeHistoryK8sPod) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "k8s_pods_history"},
+	}
+}
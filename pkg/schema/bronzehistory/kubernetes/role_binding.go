@@ -0,0 +1,74 @@
+package kubernetes
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryK8sRoleBinding stores historical snapshots of Kubernetes role bindings.
+type BronzeHistoryK8sRoleBinding struct {
+	ent.Schema
+}
+
+func (BronzeHistoryK8sRoleBinding) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryK8sRoleBinding) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze role binding by resource_id"),
+		field.String("cluster_name").
+			NotEmpty(),
+		field.String("kind").
+			NotEmpty().
+			Comment("RoleBinding or ClusterRoleBinding"),
+		field.String("namespace").
+			Optional().
+			Comment("Empty for ClusterRoleBindings"),
+		field.String("name").
+			NotEmpty(),
+		field.String("uid").
+			Optional(),
+		field.String("role_kind").
+			NotEmpty().
+			Comment("Role or ClusterRole"),
+		field.String("role_name").
+			NotEmpty(),
+		field.JSON("subjects_json", json.RawMessage{}).
+			Optional().
+			Comment("Subjects: kind, name, namespace"),
+		field.JSON("rules_json", json.RawMessage{}).
+			Optional().
+			Comment("Policy rules of the bound role; null when the role does not exist"),
+		field.JSON("labels_json", json.RawMessage{}).
+			Optional(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeHistoryK8sRoleBinding) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("cluster_name"),
+	}
+}
+
+func (BronzeHistoryK8sRoleBinding) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "k8s_role_bindings_history"},
+	}
+}
@@ -0,0 +1,68 @@
+package kubernetes
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryK8sServiceAccount stores historical snapshots of Kubernetes service accounts.
+type BronzeHistoryK8sServiceAccount struct {
+	ent.Schema
+}
+
+func (BronzeHistoryK8sServiceAccount) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryK8sServiceAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze service account by resource_id"),
+		field.String("cluster_name").
+			NotEmpty(),
+		field.String("namespace").
+			NotEmpty(),
+		field.String("name").
+			NotEmpty(),
+		field.String("uid").
+			Optional(),
+		field.Bool("automount_token").
+			Optional().
+			Nillable().
+			Comment("automountServiceAccountToken; null means the default (true)"),
+		field.String("cloud_identity").
+			Optional().
+			Comment("Bound cloud identity: GKE Workload Identity service account or EKS IAM role ARN"),
+		field.JSON("image_pull_secrets_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("labels_json", json.RawMessage{}).
+			Optional(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeHistoryK8sServiceAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("cluster_name"),
+	}
+}
+
+func (BronzeHistoryK8sServiceAccount) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "k8s_service_accounts_history"},
+	}
+}
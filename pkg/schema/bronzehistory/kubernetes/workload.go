@@ -0,0 +1,82 @@
+package kubernetes
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryK8sWorkload stores historical snapshots of Kubernetes workloads.
+type BronzeHistoryK8sWorkload struct {
+	ent.Schema
+}
+
+func (BronzeHistoryK8sWorkload) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryK8sWorkload) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze workload by resource_id"),
+		field.String("cluster_name").
+			NotEmpty(),
+		field.String("namespace").
+			NotEmpty(),
+		field.String("kind").
+			NotEmpty().
+			Comment("Deployment, StatefulSet, DaemonSet, CronJob or Job"),
+		field.String("name").
+			NotEmpty(),
+		field.String("uid").
+			Optional(),
+		field.Int32("replicas").
+			Optional().
+			Nillable().
+			Comment("Desired replicas; null for DaemonSets, CronJobs and Jobs"),
+		field.String("service_account").
+			Optional(),
+		field.Bool("host_network").
+			Default(false),
+		field.Bool("host_pid").
+			Default(false),
+		field.Bool("host_ipc").
+			Default(false),
+		field.JSON("images_json", json.RawMessage{}).
+			Optional().
+			Comment("Container and init container images of the pod template"),
+		field.JSON("containers_json", json.RawMessage{}).
+			Optional().
+			Comment("Pod template containers: name, image, privileged, run_as_non_root, ..."),
+		field.JSON("selector_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("labels_json", json.RawMessage{}).
+			Optional(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeHistoryK8sWorkload) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("cluster_name"),
+	}
+}
+
+func (BronzeHistoryK8sWorkload) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "k8s_workloads_history"},
+	}
+}
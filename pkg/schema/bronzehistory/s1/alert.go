@@ -0,0 +1,96 @@
+package s1
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryS1Alert stores historical snapshots of SentinelOne cloud-detection alerts.
+type BronzeHistoryS1Alert struct {
+	ent.Schema
+}
+
+func (BronzeHistoryS1Alert) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryS1Alert) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze alert by resource_id"),
+
+		field.String("agent_id").
+			Optional(),
+		field.String("agent_uuid").
+			Optional(),
+		field.String("agent_name").
+			Optional(),
+		field.String("agent_os_name").
+			Optional(),
+		field.String("site_id").
+			Optional(),
+		field.String("rule_id").
+			Optional(),
+		field.String("rule_name").
+			Optional(),
+		field.String("rule_severity").
+			Optional(),
+		field.String("rule_description").
+			Optional(),
+		field.String("rule_scope_level").
+			Optional(),
+		field.String("rule_treat_as_threat").
+			Optional(),
+		field.String("analyst_verdict").
+			Optional(),
+		field.String("incident_status").
+			Optional(),
+		field.String("event_type").
+			Optional(),
+		field.String("hit_type").
+			Optional(),
+		field.String("source").
+			Optional(),
+		field.String("dv_event_id").
+			Optional(),
+		field.Bool("is_edr").
+			Default(false),
+		field.Time("reported_at").
+			Optional().
+			Nillable(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+		field.Time("api_updated_at").
+			Optional().
+			Nillable(),
+		field.JSON("source_process_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("target_process_json", json.RawMessage{}).
+			Optional(),
+	}
+}
+
+func (BronzeHistoryS1Alert) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("agent_id"),
+	}
+}
+
+func (BronzeHistoryS1Alert) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "s1_alerts_history"},
+	}
+}
@@ -0,0 +1,86 @@
+package s1
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryS1AppCVE stores historical snapshots of SentinelOne application CVEs per endpoint.
+type BronzeHistoryS1AppCVE struct {
+	ent.Schema
+}
+
+func (BronzeHistoryS1AppCVE) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryS1AppCVE) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze app CVE by resource_id"),
+
+		field.String("agent_id").
+			NotEmpty(),
+		field.String("endpoint_name").
+			Optional(),
+		field.String("endpoint_type").
+			Optional(),
+		field.String("os_type").
+			Optional(),
+		field.String("application_name").
+			NotEmpty(),
+		field.String("application_vendor").
+			Optional(),
+		field.String("application_version").
+			Optional(),
+		field.String("cve_id").
+			NotEmpty(),
+		field.Float("cvss_score").
+			Default(0),
+		field.String("cvss_version").
+			Optional(),
+		field.String("nvd_severity").
+			Optional(),
+		field.Float("risk_score").
+			Default(0),
+		field.String("exploit_code_maturity").
+			Optional(),
+		field.Bool("exploited_in_the_wild").
+			Default(false),
+		field.String("remediation_level").
+			Optional(),
+		field.String("report_confidence").
+			Optional(),
+		field.String("status").
+			Optional(),
+		field.Time("published_date").
+			Optional().
+			Nillable(),
+		field.Time("detection_date").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeHistoryS1AppCVE) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("agent_id"),
+		index.Fields("cve_id"),
+	}
+}
+
+func (BronzeHistoryS1AppCVE) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "s1_app_cves_history"},
+	}
+}
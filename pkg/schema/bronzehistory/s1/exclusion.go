@@ -0,0 +1,93 @@
+package s1
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryS1Exclusion stores historical snapshots of SentinelOne exclusions.
+type BronzeHistoryS1Exclusion struct {
+	ent.Schema
+}
+
+func (BronzeHistoryS1Exclusion) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryS1Exclusion) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze exclusion by resource_id"),
+
+		field.String("scope_level").
+			NotEmpty(),
+		field.String("account_id").
+			Optional(),
+		field.String("site_id").
+			Optional(),
+		field.String("group_id").
+			Optional(),
+		field.String("scope_name").
+			Optional(),
+		field.String("scope_path").
+			Optional(),
+		field.String("exclusion_type").
+			NotEmpty(),
+		field.String("exclusion_value").
+			Optional(),
+		field.String("os_type").
+			Optional(),
+		field.String("mode").
+			Optional(),
+		field.String("path_exclusion_type").
+			Optional(),
+		field.String("description").
+			Optional(),
+		field.String("source").
+			Optional(),
+		field.String("user_id").
+			Optional(),
+		field.String("user_name").
+			Optional(),
+		field.String("application_name").
+			Optional(),
+		field.Bool("include_children").
+			Default(false),
+		field.Bool("include_subfolders").
+			Default(false),
+		field.Bool("imported").
+			Default(false),
+		field.JSON("actions_json", json.RawMessage{}).
+			Optional(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+		field.Time("api_updated_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeHistoryS1Exclusion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("exclusion_type"),
+	}
+}
+
+func (BronzeHistoryS1Exclusion) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "s1_exclusions_history"},
+	}
+}
@@ -0,0 +1,81 @@
+package s1
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryS1Policy stores historical snapshots of SentinelOne policies.
+type BronzeHistoryS1Policy struct {
+	ent.Schema
+}
+
+func (BronzeHistoryS1Policy) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryS1Policy) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze policy by resource_id"),
+
+		field.String("scope_level").
+			NotEmpty(),
+		field.String("account_id").
+			Optional(),
+		field.String("site_id").
+			Optional(),
+		field.String("group_id").
+			Optional(),
+		field.String("scope_name").
+			Optional(),
+		field.String("inherited_from").
+			Optional(),
+		field.String("mitigation_mode").
+			Optional(),
+		field.String("mitigation_mode_suspicious").
+			Optional(),
+		field.String("auto_mitigation_action").
+			Optional(),
+		field.Bool("anti_tampering_on").
+			Default(false),
+		field.Bool("snapshots_on").
+			Default(false),
+		field.Bool("agent_logging_on").
+			Default(false),
+		field.Bool("scan_new_agents").
+			Default(false),
+		field.Bool("network_quarantine_on").
+			Default(false),
+		field.JSON("engines_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("policy_json", json.RawMessage{}).
+			Optional(),
+		field.Time("api_updated_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeHistoryS1Policy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (BronzeHistoryS1Policy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "s1_policies_history"},
+	}
+}
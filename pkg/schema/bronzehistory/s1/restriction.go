@@ -0,0 +1,79 @@
+package s1
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryS1Restriction stores historical snapshots of SentinelOne blocklist restrictions.
+type BronzeHistoryS1Restriction struct {
+	ent.Schema
+}
+
+func (BronzeHistoryS1Restriction) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryS1Restriction) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze restriction by resource_id"),
+
+		field.String("scope_level").
+			NotEmpty(),
+		field.String("account_id").
+			Optional(),
+		field.String("site_id").
+			Optional(),
+		field.String("group_id").
+			Optional(),
+		field.String("scope_name").
+			Optional(),
+		field.String("scope_path").
+			Optional(),
+		field.String("restriction_type").
+			NotEmpty(),
+		field.String("restriction_value").
+			Optional(),
+		field.String("os_type").
+			Optional(),
+		field.String("description").
+			Optional(),
+		field.String("source").
+			Optional(),
+		field.String("user_id").
+			Optional(),
+		field.String("user_name").
+			Optional(),
+		field.Bool("imported").
+			Default(false),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+		field.Time("api_updated_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (BronzeHistoryS1Restriction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("restriction_value"),
+	}
+}
+
+func (BronzeHistoryS1Restriction) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "s1_restrictions_history"},
+	}
+}
@@ -0,0 +1,110 @@
+package s1
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	historymixin "danny.vn/hotpot/pkg/schema/bronzehistory/mixin"
+)
+
+// BronzeHistoryS1Threat stores historical snapshots of SentinelOne threats.
+type BronzeHistoryS1Threat struct {
+	ent.Schema
+}
+
+func (BronzeHistoryS1Threat) Mixin() []ent.Mixin {
+	return []ent.Mixin{historymixin.Timestamp{}}
+}
+
+func (BronzeHistoryS1Threat) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").StorageKey("history_id"),
+		field.String("resource_id").
+			NotEmpty().
+			Comment("Link to bronze threat by resource_id"),
+
+		field.String("agent_id").
+			Optional(),
+		field.String("agent_uuid").
+			Optional(),
+		field.String("agent_computer_name").
+			Optional(),
+		field.String("agent_os_type").
+			Optional(),
+		field.String("account_id").
+			Optional(),
+		field.String("site_id").
+			Optional(),
+		field.String("site_name").
+			Optional(),
+		field.String("group_id").
+			Optional(),
+		field.String("threat_name").
+			Optional(),
+		field.String("classification").
+			Optional(),
+		field.String("classification_source").
+			Optional(),
+		field.String("confidence_level").
+			Optional(),
+		field.String("mitigation_status").
+			Optional(),
+		field.String("analyst_verdict").
+			Optional(),
+		field.String("incident_status").
+			Optional(),
+		field.String("initiated_by").
+			Optional(),
+		field.String("detection_type").
+			Optional(),
+		field.String("file_path").
+			Optional(),
+		field.String("sha1").
+			Optional(),
+		field.String("sha256").
+			Optional(),
+		field.String("md5").
+			Optional(),
+		field.String("storyline").
+			Optional(),
+		field.Bool("is_fileless").
+			Default(false),
+		field.Bool("mitigated_preemptively").
+			Default(false),
+		field.Time("identified_at").
+			Optional().
+			Nillable(),
+		field.Time("api_created_at").
+			Optional().
+			Nillable(),
+		field.Time("api_updated_at").
+			Optional().
+			Nillable(),
+		field.JSON("engines_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("indicators_json", json.RawMessage{}).
+			Optional(),
+		field.JSON("mitigation_actions_json", json.RawMessage{}).
+			Optional(),
+	}
+}
+
+func (BronzeHistoryS1Threat) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "valid_from"),
+		index.Fields("valid_to"),
+		index.Fields("collected_at"),
+		index.Fields("agent_id"),
+	}
+}
+
+func (BronzeHistoryS1Threat) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "s1_threats_history"},
+	}
+}
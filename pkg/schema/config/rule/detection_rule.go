@@ -0,0 +1,64 @@
+package rule
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ConfigDetectionRule defines a declarative SQL detection rule. Each rule is a
+// read-only query over bronze/silver that returns one row per offending
+// resource; the detect worker runs active rules and upserts the rows as gold
+// findings. Security engineers can add checks here without a Go release.
+type ConfigDetectionRule struct {
+	ent.Schema
+}
+
+func (ConfigDetectionRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").StorageKey("rule_id"),
+		field.String("rule_key").Unique().Immutable().
+			Comment("Code-facing identifier, e.g. gcp_firewall_open_ssh"),
+		field.String("name").NotEmpty().
+			Comment("Human-readable finding title"),
+		field.String("description").Optional().
+			Comment("What the rule detects"),
+		field.String("category").NotEmpty().
+			Comment("network, storage, iam, database, org_policy, ..."),
+		field.String("severity").NotEmpty().
+			Comment("critical, high, medium, low, info"),
+		field.String("provider").NotEmpty().
+			Comment("gcp, aws, s1, ..."),
+		field.String("resource_type").NotEmpty().
+			Comment("Table the offending resource lives in, e.g. gcp_compute_firewalls"),
+		field.String("remediation").Optional().
+			Comment("How to fix a finding"),
+		field.Text("query").NotEmpty().
+			Comment("Read-only SELECT returning asset_id, asset_name, project_id, evidence (jsonb). Named parameters use @name"),
+		field.JSON("params_json", map[string]any{}).Optional().
+			Comment("Values bound to @name parameters, e.g. {\"port\": 22}"),
+		field.Int("timeout_seconds").Default(30).
+			Comment("Statement timeout for the query"),
+		field.String("source").NotEmpty().Default("custom").
+			Comment("Origin: seed (shipped default) or custom (user-added)"),
+		field.Bool("is_active").Default(true),
+		field.Time("created_at").Immutable(),
+		field.Time("updated_at"),
+	}
+}
+
+func (ConfigDetectionRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("category"),
+		index.Fields("resource_type"),
+		index.Fields("is_active"),
+	}
+}
+
+func (ConfigDetectionRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "detection_rules"},
+	}
+}
@@ -0,0 +1,55 @@
+package rule
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ConfigNotificationRule routes new gold findings to notification
+// destinations. Match lists are ANDed; an empty list matches anything.
+// Destination names refer to notify.destinations in config (YAML/Vault).
+type ConfigNotificationRule struct {
+	ent.Schema
+}
+
+func (ConfigNotificationRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").StorageKey("rule_id"),
+		field.String("rule_key").Unique().Immutable().
+			Comment("Code-facing identifier, e.g. httpmonitor_critical_to_soc"),
+		field.String("name").NotEmpty().
+			Comment("Human-readable rule name"),
+		field.JSON("sources", []string{}).Optional().
+			Comment("Finding sources: httpmonitor, lifecycle_software, lifecycle_os"),
+		field.JSON("severities", []string{}).Optional().
+			Comment("critical, high, medium, low, info"),
+		field.JSON("anomaly_types", []string{}).Optional().
+			Comment("anomaly_type for httpmonitor, eol_status for lifecycle"),
+		field.JSON("source_ids", []string{}).Optional().
+			Comment("httpmonitor source_id or lifecycle machine_id"),
+		field.JSON("destinations", []string{}).
+			Comment("Destination names from notify.destinations config"),
+		field.Int("dedup_minutes").Default(1440).
+			Comment("Do not resend the same finding within this window; 0 sends once"),
+		field.Int("rate_limit_per_hour").Default(30).
+			Comment("Max notifications sent by this rule per hour; 0 disables the limit"),
+		field.Bool("is_active").Default(true),
+		field.Time("created_at").Immutable(),
+		field.Time("updated_at"),
+	}
+}
+
+func (ConfigNotificationRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("is_active"),
+	}
+}
+
+func (ConfigNotificationRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "notification_rules"},
+	}
+}
@@ -20,6 +20,7 @@ type GoldHttpmonitorAnomaly struct {
 func (GoldHttpmonitorAnomaly) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		goldmixin.Timestamp{},
+		goldmixin.FindingState{},
 	}
 }
 
@@ -30,7 +31,7 @@ func (GoldHttpmonitorAnomaly) Fields() []ent.Field {
 		field.String("source_id").NotEmpty(),
 		field.String("anomaly_type").
 			NotEmpty().
-			Comment("traffic_spike, traffic_drop, error_burst, 5xx_burst, new_endpoint, scanner_detected, single_ip_flood, method_mismatch, new_user_agent, ua_share_shift, automated_client, ua_spoofing, new_source_ip, geo_shift, external_on_internal, ip_concentration, ip_rotation, new_asn, hosting_provider, asn_concentration, response_size_anomaly, off_hours_spike, endpoint_enumeration, sanctioned_country, path_traversal, sql_injection_probe, command_injection_probe, xss_probe, ssrf_probe, auth_failure_burst, credential_stuffing, otp_brute_force, privilege_escalation_probe, password_reset_flood, registration_abuse, rate_limit_triggered, auth_success_after_burst, bulk_data_extraction, pagination_scraping"),
+			Comment("traffic_spike, traffic_drop, error_burst, 5xx_burst, new_endpoint, scanner_detected, single_ip_flood, method_mismatch, new_user_agent, ua_share_shift, automated_client, ua_spoofing, new_source_ip, geo_shift, external_on_internal, ip_concentration, ip_rotation, new_asn, hosting_provider, asn_concentration, response_size_anomaly, off_hours_spike, endpoint_enumeration, sanctioned_country, tor_exit_node, known_scanner_ip, blocklisted_ip, path_traversal, sql_injection_probe, command_injection_probe, xss_probe, ssrf_probe, auth_failure_burst, credential_stuffing, otp_brute_force, privilege_escalation_probe, password_reset_flood, registration_abuse, rate_limit_triggered, auth_success_after_burst, bulk_data_extraction, pagination_scraping"),
 		field.String("severity").
 			NotEmpty().
 			Comment("info, low, medium, high, critical"),
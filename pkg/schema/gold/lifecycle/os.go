@@ -20,6 +20,7 @@ type GoldLifecycleOS struct {
 func (GoldLifecycleOS) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		goldmixin.Timestamp{},
+		goldmixin.FindingState{},
 	}
 }
 
@@ -20,6 +20,7 @@ type GoldLifecycleSoftware struct {
 func (GoldLifecycleSoftware) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		goldmixin.Timestamp{},
+		goldmixin.FindingState{},
 	}
 }
 
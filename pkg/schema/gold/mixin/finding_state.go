@@ -0,0 +1,43 @@
+package mixin
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// FindingState provides analyst triage state for gold finding tables.
+// Detectors only advance last_seen_at (and reopen resolved findings);
+// status, assignee, note and suppressed_until are owned by analysts and
+// survive re-detection.
+type FindingState struct {
+	mixin.Schema
+}
+
+func (FindingState) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("status").
+			NotEmpty().
+			Default("open").
+			Comment("open, acknowledged, suppressed, false_positive, resolved"),
+		field.String("assignee").Optional(),
+		field.Text("note").Optional(),
+		field.Time("suppressed_until").
+			Optional().
+			Nillable().
+			Comment("Suppressed findings reopen when re-detected after this time"),
+		field.Time("resolved_at").
+			Optional().
+			Nillable().
+			Comment("Set when a finding is no longer detected, cleared on reopen"),
+		field.Time("last_seen_at").
+			Comment("Advances every time the detector observes the finding"),
+	}
+}
+
+func (FindingState) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
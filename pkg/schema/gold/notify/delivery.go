@@ -0,0 +1,57 @@
+package notify
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GoldNotifyDelivery records every notification attempt. It is the state
+// behind per-rule dedup (last sent per finding) and rate limiting (sent in
+// the last hour), and an audit trail of what was sent where.
+type GoldNotifyDelivery struct {
+	ent.Schema
+}
+
+func (GoldNotifyDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").StorageKey("delivery_id"),
+		field.String("rule_key").
+			NotEmpty().
+			Immutable(),
+		field.String("source").
+			NotEmpty().
+			Immutable().
+			Comment("httpmonitor, lifecycle_software, lifecycle_os"),
+		field.String("finding_id").
+			NotEmpty().
+			Immutable().
+			Comment("resource_id of the finding"),
+		field.String("destination").
+			NotEmpty().
+			Immutable().
+			Comment("Destination name from config"),
+		field.String("status").
+			NotEmpty().
+			Immutable().
+			Comment("sent, failed, rate_limited"),
+		field.String("error").Optional().Immutable(),
+		field.Time("created_at").Immutable(),
+	}
+}
+
+func (GoldNotifyDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("rule_key", "finding_id", "created_at"),
+		index.Fields("rule_key", "created_at"),
+		index.Fields("status"),
+	}
+}
+
+func (GoldNotifyDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "notify_deliveries"},
+	}
+}
@@ -0,0 +1,74 @@
+package posture
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	goldmixin "danny.vn/hotpot/pkg/schema/gold/mixin"
+)
+
+// GoldPostureFinding holds cloud misconfiguration findings.
+// Each row is one (rule, resource) pair produced by evaluating the posture
+// rule catalogue against bronze tables.
+type GoldPostureFinding struct {
+	ent.Schema
+}
+
+func (GoldPostureFinding) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		goldmixin.Timestamp{},
+		goldmixin.FindingState{},
+	}
+}
+
+func (GoldPostureFinding) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").StorageKey("resource_id").Unique().Immutable(),
+		field.String("rule_key").
+			NotEmpty().
+			Comment("Catalogue rule identifier, e.g. gcp_firewall_open_ssh"),
+		field.String("category").
+			NotEmpty().
+			Comment("network, storage, iam, database, org_policy"),
+		field.String("severity").
+			NotEmpty().
+			Comment("info, low, medium, high, critical"),
+		field.String("provider").
+			NotEmpty().
+			Comment("gcp, aws, ..."),
+		field.String("resource_type").
+			NotEmpty().
+			Comment("Bronze table the offending resource lives in, e.g. gcp_compute_firewalls"),
+
+		// Offending resource (bronze resource_id and display name).
+		field.String("asset_id").NotEmpty(),
+		field.String("asset_name").Optional(),
+		field.String("project_id").Optional(),
+
+		field.String("title").NotEmpty(),
+		field.String("description").Optional(),
+		field.String("remediation").Optional(),
+		field.JSON("evidence_json", json.RawMessage{}).Optional(),
+	}
+}
+
+func (GoldPostureFinding) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("rule_key"),
+		index.Fields("severity"),
+		index.Fields("resource_type"),
+		index.Fields("project_id"),
+		index.Fields("asset_id"),
+	}
+}
+
+func (GoldPostureFinding) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "posture_findings"},
+	}
+}
@@ -0,0 +1,62 @@
+package triage
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GoldTriageAudit is the append-only log of analyst changes to gold finding
+// state. One row per admin write (acknowledge, suppress, reopen, ...),
+// recording who changed which finding, when, and the before/after values.
+type GoldTriageAudit struct {
+	ent.Schema
+}
+
+func (GoldTriageAudit) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").StorageKey("audit_id"),
+		field.String("finding_table").
+			NotEmpty().
+			Immutable().
+			Comment("Gold table of the finding, e.g. httpmonitor_anomalies"),
+		field.String("finding_id").
+			NotEmpty().
+			Immutable().
+			Comment("resource_id of the finding"),
+		field.String("action").
+			NotEmpty().
+			Immutable().
+			Comment("acknowledge, suppress, false_positive, reopen, update"),
+		field.String("actor").
+			NotEmpty().
+			Immutable().
+			Comment("Authenticated admin identity"),
+		field.String("from_status").Optional().Immutable(),
+		field.String("to_status").Optional().Immutable(),
+		field.String("reason").Optional().Immutable(),
+		field.JSON("changes_json", json.RawMessage{}).
+			Optional().
+			Immutable().
+			Comment("Changed fields as {field: {from, to}}"),
+		field.Time("created_at").Immutable(),
+	}
+}
+
+func (GoldTriageAudit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("finding_table", "finding_id"),
+		index.Fields("actor"),
+		index.Fields("created_at"),
+	}
+}
+
+func (GoldTriageAudit) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "triage_audits"},
+	}
+}
@@ -0,0 +1,78 @@
+package vulnerability
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	goldmixin "danny.vn/hotpot/pkg/schema/gold/mixin"
+)
+
+// GoldVulnerabilityFinding holds known vulnerabilities of installed software.
+// Each row is one (machine_id, package_name, vuln_id) triple produced by
+// matching silver.inventory_software against the OSV and NVD reference feeds.
+type GoldVulnerabilityFinding struct {
+	ent.Schema
+}
+
+func (GoldVulnerabilityFinding) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		goldmixin.Timestamp{},
+		goldmixin.FindingState{},
+	}
+}
+
+func (GoldVulnerabilityFinding) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").StorageKey("resource_id").Unique().Immutable(),
+		field.String("machine_id").NotEmpty(),
+		field.String("hostname").Optional(),
+		field.String("package_name").NotEmpty(),
+		field.String("package_version").NotEmpty(),
+
+		field.String("vuln_id").
+			NotEmpty().
+			Comment("CVE ID, or the advisory ID when the advisory has no CVE alias"),
+		field.JSON("aliases", []string{}).
+			Optional().
+			Comment("Advisory IDs that reported this vulnerability, e.g. USN-6543-1, GHSA-..."),
+		field.String("source").
+			NotEmpty().
+			Comment("osv, nvd"),
+		field.String("ecosystem").
+			Optional().
+			Comment("OSV ecosystem (e.g. Ubuntu:22.04:LTS) or cpe:{vendor}:{product}"),
+		field.String("version_scheme").
+			NotEmpty().
+			Comment("deb, rpm, semver"),
+
+		field.String("severity").
+			NotEmpty().
+			Comment("critical, high, medium, low, unknown"),
+		field.Float("cvss_score").Optional().Nillable(),
+		field.String("cvss_vector").Optional(),
+		field.String("fixed_version").
+			Optional().
+			Comment("First fixed version; empty when no fix is published"),
+		field.Text("summary").Optional(),
+		field.Time("published").Optional().Nillable(),
+	}
+}
+
+func (GoldVulnerabilityFinding) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("machine_id"),
+		index.Fields("vuln_id"),
+		index.Fields("severity"),
+		index.Fields("package_name"),
+		index.Fields("machine_id", "package_name", "vuln_id").Unique(),
+	}
+}
+
+func (GoldVulnerabilityFinding) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "vulnerability_findings"},
+	}
+}
@@ -47,6 +47,12 @@ func (SilverHttptrafficClientIp5m) Fields() []ent.Field {
 			Comment("RFC1918/loopback/link-local"),
 		field.Int64("request_count"),
 		field.Bool("is_mapped").Default(false),
+		field.Bool("is_threat_listed").Default(false).
+			Comment("Listed by an unexpired threat intel feed"),
+		field.JSON("threat_feeds", []string{}).Optional().
+			Comment("Names of the threat intel feeds listing the IP"),
+		field.JSON("threat_categories", []string{}).Optional().
+			Comment("Categories of the matching feeds: scanner, tor, blocklist"),
 	}
 }
 
@@ -57,6 +63,7 @@ func (SilverHttptrafficClientIp5m) Indexes() []ent.Index {
 		index.Fields("country_code", "window_start"),
 		index.Fields("asn", "window_start"),
 		index.Fields("is_internal"),
+		index.Fields("is_threat_listed", "window_start"),
 		index.Fields("window_start"),
 	}
 }
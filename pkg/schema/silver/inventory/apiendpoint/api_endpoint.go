@@ -38,7 +38,10 @@ func (InventoryApiEndpoint) Fields() []ent.Field {
 		field.Bool("is_active").Default(true),
 		field.String("access_level").
 			Optional().
-			Comment("Derived from URI prefix: public, protected, private"),
+			Comment("public, protected, private; from the URI prefix (manual) or security requirements (openapi)"),
+		field.Bool("is_discovered").
+			Default(false).
+			Comment("Candidate from cloud routing or observed traffic, pending review; not used to map traffic"),
 	}
 }
 
@@ -53,6 +56,7 @@ func (InventoryApiEndpoint) Indexes() []ent.Index {
 		index.Fields("uri_pattern"),
 		index.Fields("service"),
 		index.Fields("access_level"),
+		index.Fields("is_discovered"),
 	}
 }
 
@@ -0,0 +1,64 @@
+package image
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	inventorymixin "danny.vn/hotpot/pkg/schema/silver/inventory/mixin"
+)
+
+// InventoryImage is the final merged container image table, one row per
+// registry/repository/digest.
+type InventoryImage struct {
+	ent.Schema
+}
+
+func (InventoryImage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		inventorymixin.Timestamp{},
+	}
+}
+
+func (InventoryImage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").StorageKey("resource_id").Unique().Immutable(),
+		field.String("registry").NotEmpty(),
+		field.String("repository").NotEmpty(),
+		// Empty for images only ever referenced by tag; kept non-null so the
+		// unique index also dedups those.
+		field.String("digest").Default(""),
+		field.JSON("tags_json", []string{}).Optional(),
+		// Workloads running the image, e.g. "k8s:prod/web/Deployment/api".
+		field.JSON("workloads_json", []string{}).Optional(),
+		// Vulnerability occurrences reported for the image digest.
+		field.JSON("vulnerabilities_json", json.RawMessage{}).Optional(),
+		field.Int("vulnerability_count").Default(0),
+		field.String("max_severity").Optional(),
+	}
+}
+
+func (InventoryImage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("bronze_links", InventoryImageBronzeLink.Type),
+	}
+}
+
+func (InventoryImage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("registry", "repository", "digest").Unique(),
+		index.Fields("max_severity"),
+		index.Fields("collected_at"),
+	}
+}
+
+func (InventoryImage) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "inventory_images"},
+	}
+}
@@ -0,0 +1,34 @@
+package image
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryImageBronzeLink tracks which bronze records contributed to an image.
+type InventoryImageBronzeLink struct {
+	ent.Schema
+}
+
+func (InventoryImageBronzeLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provider").NotEmpty(),
+		field.String("bronze_table").NotEmpty(),
+		field.String("bronze_resource_id").NotEmpty(),
+	}
+}
+
+func (InventoryImageBronzeLink) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("image", InventoryImage.Type).Ref("bronze_links").Unique().Required(),
+	}
+}
+
+func (InventoryImageBronzeLink) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "inventory_image_links"},
+	}
+}
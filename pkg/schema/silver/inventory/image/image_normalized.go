@@ -0,0 +1,56 @@
+package image
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InventoryImageNormalized holds per-provider normalized rows before merge.
+type InventoryImageNormalized struct {
+	ent.Schema
+}
+
+func (InventoryImageNormalized) Fields() []ent.Field {
+	return []ent.Field{
+		// Identity — unique per provider+bronze record.
+		field.String("id").StorageKey("resource_id").Unique().Immutable(),
+		field.String("provider").NotEmpty(),
+		field.Bool("is_base"),
+		field.String("bronze_table").NotEmpty(),
+		field.String("bronze_resource_id").NotEmpty(),
+
+		// Normalized image fields — the merge key is registry/repository/digest.
+		field.String("registry").NotEmpty(),
+		field.String("repository").NotEmpty(),
+		field.String("digest").Optional(),
+		field.JSON("tags_json", []string{}).Optional(),
+		field.JSON("workloads_json", []string{}).Optional(),
+		field.JSON("vulnerabilities_json", json.RawMessage{}).Optional(),
+
+		// Timestamps from bronze (NOT immutable — upserts may update).
+		field.Time("collected_at"),
+		field.Time("first_collected_at"),
+
+		// When this row was last normalized.
+		field.Time("normalized_at"),
+	}
+}
+
+func (InventoryImageNormalized) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider"),
+		index.Fields("provider", "bronze_resource_id").Unique(),
+		index.Fields("registry", "repository", "digest"),
+	}
+}
+
+func (InventoryImageNormalized) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "inventory_image_normalized"},
+	}
+}
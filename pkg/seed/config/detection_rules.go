@@ -0,0 +1,179 @@
+package config
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type detectionRule struct {
+	ruleKey      string
+	name         string
+	description  string
+	category     string
+	severity     string
+	provider     string
+	resourceType string
+	remediation  string
+	query        string
+	params       map[string]any
+}
+
+// SeedDetectionRules inserts the shipped posture rules. Existing rows are
+// left untouched so edited queries and thresholds survive re-seeding.
+func SeedDetectionRules(ctx context.Context, db *sql.DB) error {
+	if len(detectionRules) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	var b strings.Builder
+	b.WriteString(`INSERT INTO config.detection_rules
+		(rule_key, name, description, category, severity, provider, resource_type, remediation, query, params_json, source, is_active, created_at, updated_at)
+		VALUES `)
+
+	args := make([]any, 0, len(detectionRules)*14)
+	for i, r := range detectionRules {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		base := i * 14
+		fmt.Fprintf(&b, "($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			base+1, base+2, base+3, base+4, base+5, base+6, base+7, base+8, base+9, base+10, base+11, base+12, base+13, base+14)
+
+		var paramsJSON []byte
+		if r.params != nil {
+			paramsJSON, _ = json.Marshal(r.params)
+		}
+
+		args = append(args, r.ruleKey, r.name, r.description, r.category, r.severity,
+			r.provider, r.resourceType, r.remediation, r.query, paramsJSON,
+			"seed", true, now, now)
+	}
+
+	b.WriteString(` ON CONFLICT (rule_key) DO NOTHING`)
+
+	_, err := db.ExecContext(ctx, b.String(), args...)
+	if err != nil {
+		return fmt.Errorf("upsert detection rules (%d rules): %w", len(detectionRules), err)
+	}
+	return nil
+}
+
+// Queries return asset_id, asset_name, project_id and evidence (jsonb), one
+// row per offending resource. @name placeholders are bound from params.
+var detectionRules = []detectionRule{
+	// --- Network ---
+	{"gcp_firewall_open_ssh", "Firewall allows SSH from the internet",
+		"An enabled ingress firewall rule allows TCP/22 from 0.0.0.0/0 or ::/0.",
+		"network", "high", "gcp", "gcp_compute_firewalls",
+		"Restrict source ranges to known CIDRs or use IAP TCP forwarding (35.235.240.0/20).",
+		firewallOpenPortQuery, map[string]any{"port": 22}},
+	{"gcp_firewall_open_rdp", "Firewall allows RDP from the internet",
+		"An enabled ingress firewall rule allows TCP/3389 from 0.0.0.0/0 or ::/0.",
+		"network", "high", "gcp", "gcp_compute_firewalls",
+		"Restrict source ranges to known CIDRs or use IAP TCP forwarding (35.235.240.0/20).",
+		firewallOpenPortQuery, map[string]any{"port": 3389}},
+
+	// --- Storage ---
+	{"gcp_bucket_public_read", "Bucket is publicly accessible",
+		"The bucket IAM policy grants a role to allUsers or allAuthenticatedUsers.",
+		"storage", "critical", "gcp", "gcp_storage_bucket_iam_policies",
+		"Remove the allUsers/allAuthenticatedUsers bindings and enforce public access prevention.",
+		`SELECT DISTINCT ON (p.resource_id)
+       p.resource_id, p.bucket_name, p.project_id,
+       jsonb_build_object('role', b.role, 'members', b.members_json)
+FROM bronze.gcp_storage_bucket_iam_policies p
+JOIN bronze.gcp_storage_bucket_iam_policy_bindings b
+  ON b.bronze_gcp_storage_bucket_iam_policy_bindings = p.resource_id
+WHERE b.members_json ?| array['allUsers', 'allAuthenticatedUsers']
+ORDER BY p.resource_id, b.role`, nil},
+	{"gcp_bucket_uniform_access_disabled", "Bucket does not use uniform bucket-level access",
+		"Object ACLs are still honoured, so individual objects can be shared publicly.",
+		"storage", "low", "gcp", "gcp_storage_buckets",
+		"Enable uniform bucket-level access on the bucket.",
+		`SELECT resource_id, name, project_id,
+       jsonb_build_object('iam_configuration', iam_configuration_json)
+FROM bronze.gcp_storage_buckets
+WHERE COALESCE((iam_configuration_json->'uniformBucketLevelAccess'->>'enabled')::boolean, false) = false`, nil},
+
+	// --- IAM ---
+	{"gcp_sa_key_older_than_90d", "Service account key older than 90 days",
+		"An enabled user-managed service account key was created more than 90 days ago.",
+		"iam", "medium", "gcp", "gcp_iam_service_account_keys",
+		"Rotate the key, or replace it with workload identity federation.",
+		`SELECT resource_id, service_account_email, project_id,
+       jsonb_build_object('valid_after_time', valid_after_time, 'key_algorithm', key_algorithm)
+FROM bronze.gcp_iam_service_account_keys
+WHERE key_type = 'USER_MANAGED'
+  AND NOT disabled
+  AND valid_after_time < now() - make_interval(days => @max_age_days::int)`,
+		map[string]any{"max_age_days": 90}},
+
+	// --- Database ---
+	{"gcp_sql_public_ip_no_authorized_networks", "Cloud SQL instance has a public IP without authorized networks",
+		"The instance exposes a public IPv4 address but no authorized networks are configured.",
+		"database", "medium", "gcp", "gcp_sql_instances",
+		"Disable the public IP and connect over private IP, or restrict access with authorized networks.",
+		`SELECT resource_id, name, project_id,
+       jsonb_build_object('ip_configuration', settings_json->'ipConfiguration')
+FROM bronze.gcp_sql_instances
+WHERE COALESCE((settings_json->'ipConfiguration'->>'ipv4Enabled')::boolean, false)
+  AND COALESCE(jsonb_array_length(settings_json->'ipConfiguration'->'authorizedNetworks'), 0) = 0`, nil},
+	{"gcp_sql_authorized_network_open", "Cloud SQL instance is open to the internet",
+		"An authorized network of 0.0.0.0/0 allows connections from any address.",
+		"database", "critical", "gcp", "gcp_sql_instances",
+		"Remove the 0.0.0.0/0 authorized network and restrict to known CIDRs.",
+		`SELECT resource_id, name, project_id,
+       jsonb_build_object('authorized_networks', settings_json->'ipConfiguration'->'authorizedNetworks')
+FROM bronze.gcp_sql_instances
+WHERE EXISTS (
+    SELECT 1
+    FROM jsonb_array_elements(settings_json->'ipConfiguration'->'authorizedNetworks') n
+    WHERE n->>'value' = '0.0.0.0/0'
+)`, nil},
+
+	// --- Org policy ---
+	{"gcp_orgpolicy_sa_key_creation_allowed", "Service account key creation is not restricted",
+		"The organization does not enforce iam.disableServiceAccountKeyCreation.",
+		"org_policy", "low", "gcp", "gcp_orgpolicy_policies",
+		"Enforce constraints/iam.disableServiceAccountKeyCreation at the organization level.",
+		`SELECT o.resource_id, COALESCE(o.display_name, o.name), NULL,
+       jsonb_build_object('constraint', 'iam.disableServiceAccountKeyCreation', 'spec', p.spec)
+FROM bronze.gcp_organizations o
+LEFT JOIN bronze.gcp_orgpolicy_policies p
+  ON p.organization_id = o.resource_id
+ AND p.resource_id LIKE '%/policies/iam.disableServiceAccountKeyCreation'
+WHERE NOT COALESCE((p.spec->'rules'->0->>'enforce')::boolean, false)`, nil},
+}
+
+// firewallOpenPortQuery finds enabled ingress firewalls allowing TCP @port
+// from any IPv4/IPv6 address. An allowed entry with no ports (or protocol
+// "all") covers every port.
+const firewallOpenPortQuery = `SELECT DISTINCT ON (f.resource_id)
+       f.resource_id, f.name, f.project_id,
+       jsonb_build_object('source_ranges', f.source_ranges_json,
+                          'ip_protocol', a.ip_protocol,
+                          'ports', a.ports_json,
+                          'network', f.network)
+FROM bronze.gcp_compute_firewalls f
+JOIN bronze.gcp_compute_firewall_alloweds a
+  ON a.bronze_gcp_compute_firewall_allowed = f.resource_id
+WHERE NOT f.disabled
+  AND COALESCE(f.direction, 'INGRESS') = 'INGRESS'
+  AND f.source_ranges_json ?| array['0.0.0.0/0', '::/0']
+  AND a.ip_protocol IN ('tcp', 'all')
+  AND (
+      a.ports_json IS NULL
+      OR jsonb_array_length(a.ports_json) = 0
+      OR EXISTS (
+          SELECT 1
+          FROM jsonb_array_elements_text(a.ports_json) p
+          WHERE split_part(p, '-', 1)::int <= @port::int
+            AND COALESCE(NULLIF(split_part(p, '-', 2), ''), split_part(p, '-', 1))::int >= @port::int
+      )
+  )
+ORDER BY f.resource_id`
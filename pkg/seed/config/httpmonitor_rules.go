@@ -156,7 +156,13 @@ var httpmonitorRules = []httpmonitorRule{
 		nil, "live"},
 	{"tor_exit_node", "tor_exit_node", "high", "ip_geo", "Tor Exit Node",
 		"Traffic from known Tor exit node IPs", "Tor exit list feed",
-		nil, "planned"},
+		map[string]float64{"min_requests": 1}, "live"},
+	{"known_scanner_ip", "known_scanner_ip", "medium", "ip_geo", "Known Scanner IP",
+		"Traffic from IPs listed by a scanner feed", "Threat intel scanner feed",
+		map[string]float64{"min_requests": 1}, "live"},
+	{"blocklisted_ip", "blocklisted_ip", "high", "ip_geo", "Blocklisted IP",
+		"Traffic from IPs or CIDRs listed by a blocklist feed", "Threat intel blocklist feed",
+		map[string]float64{"min_requests": 1}, "live"},
 	{"vpn_proxy_detected", "vpn_proxy_detected", "medium", "ip_geo", "VPN/Proxy Detected",
 		"Traffic from known VPN/proxy provider", "VPN/proxy IP list",
 		nil, "planned"},
@@ -26,6 +26,7 @@ func Run(ctx context.Context, db *sql.DB) error {
 		{"sanctioned_countries", config.SeedSanctionedCountries},
 		{"uri_attack_patterns", config.SeedURIAttackPatterns},
 		{"auth_endpoint_patterns", config.SeedAuthEndpointPatterns},
+		{"detection_rules", config.SeedDetectionRules},
 		// Config tables — lifecycle.
 		{"software_match_rules", config.SeedSoftwareMatchRules},
 		{"os_core_rules", config.SeedOSCoreRules},
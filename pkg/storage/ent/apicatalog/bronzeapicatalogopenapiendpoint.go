@@ -0,0 +1,268 @@
+// Code generated by ent, DO NOT EDIT.
+
+package apicatalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/apicatalog/bronzeapicatalogopenapiendpoint"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeApicatalogOpenapiEndpoint is the model entity for the BronzeApicatalogOpenapiEndpoint schema.
+type BronzeApicatalogOpenapiEndpoint struct {
+	config `json:"-"`
+	// ID of the ent.
+	// {spec_name}:{METHOD}:{uri}
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Document the operation belongs to, from info.title unless overridden
+	SpecName string `json:"spec_name,omitempty"`
+	// info.version of the document
+	SpecVersion string `json:"spec_version,omitempty"`
+	// openapi or swagger field, e.g. "3.0.3", "2.0"
+	OpenapiVersion string `json:"openapi_version,omitempty"`
+	// Which traffic source these belong to
+	LogSourceID string `json:"log_source_id,omitempty"`
+	// Base path + path template, e.g. "/v1/users/{id}"
+	URI string `json:"uri,omitempty"`
+	// Upper-case HTTP method
+	Method string `json:"method,omitempty"`
+	// OperationID holds the value of the "operation_id" field.
+	OperationID string `json:"operation_id,omitempty"`
+	// Summary holds the value of the "summary" field.
+	Summary string `json:"summary,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON []string `json:"tags_json,omitempty"`
+	// Effective security requirements of the operation
+	SecurityJSON json.RawMessage `json:"security_json,omitempty"`
+	// public, protected or private; from x-access-level or the security requirements
+	AccessLevel string `json:"access_level,omitempty"`
+	// Deprecated holds the value of the "deprecated" field.
+	Deprecated bool `json:"deprecated,omitempty"`
+	// File this was imported from
+	SourceFile   string `json:"source_file,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeApicatalogOpenapiEndpoint) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzeapicatalogopenapiendpoint.FieldTagsJSON, bronzeapicatalogopenapiendpoint.FieldSecurityJSON:
+			values[i] = new([]byte)
+		case bronzeapicatalogopenapiendpoint.FieldDeprecated:
+			values[i] = new(sql.NullBool)
+		case bronzeapicatalogopenapiendpoint.FieldID, bronzeapicatalogopenapiendpoint.FieldSpecName, bronzeapicatalogopenapiendpoint.FieldSpecVersion, bronzeapicatalogopenapiendpoint.FieldOpenapiVersion, bronzeapicatalogopenapiendpoint.FieldLogSourceID, bronzeapicatalogopenapiendpoint.FieldURI, bronzeapicatalogopenapiendpoint.FieldMethod, bronzeapicatalogopenapiendpoint.FieldOperationID, bronzeapicatalogopenapiendpoint.FieldSummary, bronzeapicatalogopenapiendpoint.FieldAccessLevel, bronzeapicatalogopenapiendpoint.FieldSourceFile:
+			values[i] = new(sql.NullString)
+		case bronzeapicatalogopenapiendpoint.FieldCollectedAt, bronzeapicatalogopenapiendpoint.FieldFirstCollectedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeApicatalogOpenapiEndpoint fields.
+func (_m *BronzeApicatalogOpenapiEndpoint) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzeapicatalogopenapiendpoint.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzeapicatalogopenapiendpoint.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzeapicatalogopenapiendpoint.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzeapicatalogopenapiendpoint.FieldSpecName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field spec_name", values[i])
+			} else if value.Valid {
+				_m.SpecName = value.String
+			}
+		case bronzeapicatalogopenapiendpoint.FieldSpecVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field spec_version", values[i])
+			} else if value.Valid {
+				_m.SpecVersion = value.String
+			}
+		case bronzeapicatalogopenapiendpoint.FieldOpenapiVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field openapi_version", values[i])
+			} else if value.Valid {
+				_m.OpenapiVersion = value.String
+			}
+		case bronzeapicatalogopenapiendpoint.FieldLogSourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field log_source_id", values[i])
+			} else if value.Valid {
+				_m.LogSourceID = value.String
+			}
+		case bronzeapicatalogopenapiendpoint.FieldURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uri", values[i])
+			} else if value.Valid {
+				_m.URI = value.String
+			}
+		case bronzeapicatalogopenapiendpoint.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				_m.Method = value.String
+			}
+		case bronzeapicatalogopenapiendpoint.FieldOperationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation_id", values[i])
+			} else if value.Valid {
+				_m.OperationID = value.String
+			}
+		case bronzeapicatalogopenapiendpoint.FieldSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field summary", values[i])
+			} else if value.Valid {
+				_m.Summary = value.String
+			}
+		case bronzeapicatalogopenapiendpoint.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzeapicatalogopenapiendpoint.FieldSecurityJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field security_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SecurityJSON); err != nil {
+					return fmt.Errorf("unmarshal field security_json: %w", err)
+				}
+			}
+		case bronzeapicatalogopenapiendpoint.FieldAccessLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_level", values[i])
+			} else if value.Valid {
+				_m.AccessLevel = value.String
+			}
+		case bronzeapicatalogopenapiendpoint.FieldDeprecated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field deprecated", values[i])
+			} else if value.Valid {
+				_m.Deprecated = value.Bool
+			}
+		case bronzeapicatalogopenapiendpoint.FieldSourceFile:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_file", values[i])
+			} else if value.Valid {
+				_m.SourceFile = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeApicatalogOpenapiEndpoint.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeApicatalogOpenapiEndpoint) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeApicatalogOpenapiEndpoint.
+// Note that you need to call BronzeApicatalogOpenapiEndpoint.Unwrap() before calling this method if this BronzeApicatalogOpenapiEndpoint
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeApicatalogOpenapiEndpoint) Update() *BronzeApicatalogOpenapiEndpointUpdateOne {
+	return NewBronzeApicatalogOpenapiEndpointClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeApicatalogOpenapiEndpoint entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeApicatalogOpenapiEndpoint) Unwrap() *BronzeApicatalogOpenapiEndpoint {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("apicatalog: BronzeApicatalogOpenapiEndpoint is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeApicatalogOpenapiEndpoint) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeApicatalogOpenapiEndpoint(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("spec_name=")
+	builder.WriteString(_m.SpecName)
+	builder.WriteString(", ")
+	builder.WriteString("spec_version=")
+	builder.WriteString(_m.SpecVersion)
+	builder.WriteString(", ")
+	builder.WriteString("openapi_version=")
+	builder.WriteString(_m.OpenapiVersion)
+	builder.WriteString(", ")
+	builder.WriteString("log_source_id=")
+	builder.WriteString(_m.LogSourceID)
+	builder.WriteString(", ")
+	builder.WriteString("uri=")
+	builder.WriteString(_m.URI)
+	builder.WriteString(", ")
+	builder.WriteString("method=")
+	builder.WriteString(_m.Method)
+	builder.WriteString(", ")
+	builder.WriteString("operation_id=")
+	builder.WriteString(_m.OperationID)
+	builder.WriteString(", ")
+	builder.WriteString("summary=")
+	builder.WriteString(_m.Summary)
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("security_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SecurityJSON))
+	builder.WriteString(", ")
+	builder.WriteString("access_level=")
+	builder.WriteString(_m.AccessLevel)
+	builder.WriteString(", ")
+	builder.WriteString("deprecated=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Deprecated))
+	builder.WriteString(", ")
+	builder.WriteString("source_file=")
+	builder.WriteString(_m.SourceFile)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeApicatalogOpenapiEndpoints is a parsable slice of BronzeApicatalogOpenapiEndpoint.
+type BronzeApicatalogOpenapiEndpoints []*BronzeApicatalogOpenapiEndpoint
@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeapicatalogopenapiendpoint
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzeapicatalogopenapiendpoint type in the database.
+	Label = "bronze_apicatalog_openapi_endpoint"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldSpecName holds the string denoting the spec_name field in the database.
+	FieldSpecName = "spec_name"
+	// FieldSpecVersion holds the string denoting the spec_version field in the database.
+	FieldSpecVersion = "spec_version"
+	// FieldOpenapiVersion holds the string denoting the openapi_version field in the database.
+	FieldOpenapiVersion = "openapi_version"
+	// FieldLogSourceID holds the string denoting the log_source_id field in the database.
+	FieldLogSourceID = "log_source_id"
+	// FieldURI holds the string denoting the uri field in the database.
+	FieldURI = "uri"
+	// FieldMethod holds the string denoting the method field in the database.
+	FieldMethod = "method"
+	// FieldOperationID holds the string denoting the operation_id field in the database.
+	FieldOperationID = "operation_id"
+	// FieldSummary holds the string denoting the summary field in the database.
+	FieldSummary = "summary"
+	// FieldTagsJSON holds the string denoting the tags_json field in the database.
+	FieldTagsJSON = "tags_json"
+	// FieldSecurityJSON holds the string denoting the security_json field in the database.
+	FieldSecurityJSON = "security_json"
+	// FieldAccessLevel holds the string denoting the access_level field in the database.
+	FieldAccessLevel = "access_level"
+	// FieldDeprecated holds the string denoting the deprecated field in the database.
+	FieldDeprecated = "deprecated"
+	// FieldSourceFile holds the string denoting the source_file field in the database.
+	FieldSourceFile = "source_file"
+	// Table holds the table name of the bronzeapicatalogopenapiendpoint in the database.
+	Table = "apicatalog_openapi_endpoints"
+)
+
+// Columns holds all SQL columns for bronzeapicatalogopenapiendpoint fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldSpecName,
+	FieldSpecVersion,
+	FieldOpenapiVersion,
+	FieldLogSourceID,
+	FieldURI,
+	FieldMethod,
+	FieldOperationID,
+	FieldSummary,
+	FieldTagsJSON,
+	FieldSecurityJSON,
+	FieldAccessLevel,
+	FieldDeprecated,
+	FieldSourceFile,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SpecNameValidator is a validator for the "spec_name" field. It is called by the builders before save.
+	SpecNameValidator func(string) error
+	// URIValidator is a validator for the "uri" field. It is called by the builders before save.
+	URIValidator func(string) error
+	// MethodValidator is a validator for the "method" field. It is called by the builders before save.
+	MethodValidator func(string) error
+	// DefaultAccessLevel holds the default value on creation for the "access_level" field.
+	DefaultAccessLevel string
+	// DefaultDeprecated holds the default value on creation for the "deprecated" field.
+	DefaultDeprecated bool
+)
+
+// OrderOption defines the ordering options for the BronzeApicatalogOpenapiEndpoint queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// BySpecName orders the results by the spec_name field.
+func BySpecName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpecName, opts...).ToFunc()
+}
+
+// BySpecVersion orders the results by the spec_version field.
+func BySpecVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpecVersion, opts...).ToFunc()
+}
+
+// ByOpenapiVersion orders the results by the openapi_version field.
+func ByOpenapiVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpenapiVersion, opts...).ToFunc()
+}
+
+// ByLogSourceID orders the results by the log_source_id field.
+func ByLogSourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogSourceID, opts...).ToFunc()
+}
+
+// ByURI orders the results by the uri field.
+func ByURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURI, opts...).ToFunc()
+}
+
+// ByMethod orders the results by the method field.
+func ByMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMethod, opts...).ToFunc()
+}
+
+// ByOperationID orders the results by the operation_id field.
+func ByOperationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOperationID, opts...).ToFunc()
+}
+
+// BySummary orders the results by the summary field.
+func BySummary(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSummary, opts...).ToFunc()
+}
+
+// ByAccessLevel orders the results by the access_level field.
+func ByAccessLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessLevel, opts...).ToFunc()
+}
+
+// ByDeprecated orders the results by the deprecated field.
+func ByDeprecated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeprecated, opts...).ToFunc()
+}
+
+// BySourceFile orders the results by the source_file field.
+func BySourceFile(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceFile, opts...).ToFunc()
+}
@@ -0,0 +1,965 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeapicatalogopenapiendpoint
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/apicatalog/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// SpecName applies equality check predicate on the "spec_name" field. It's identical to SpecNameEQ.
+func SpecName(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldSpecName, v))
+}
+
+// SpecVersion applies equality check predicate on the "spec_version" field. It's identical to SpecVersionEQ.
+func SpecVersion(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldSpecVersion, v))
+}
+
+// OpenapiVersion applies equality check predicate on the "openapi_version" field. It's identical to OpenapiVersionEQ.
+func OpenapiVersion(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldOpenapiVersion, v))
+}
+
+// LogSourceID applies equality check predicate on the "log_source_id" field. It's identical to LogSourceIDEQ.
+func LogSourceID(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldLogSourceID, v))
+}
+
+// URI applies equality check predicate on the "uri" field. It's identical to URIEQ.
+func URI(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldURI, v))
+}
+
+// Method applies equality check predicate on the "method" field. It's identical to MethodEQ.
+func Method(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldMethod, v))
+}
+
+// OperationID applies equality check predicate on the "operation_id" field. It's identical to OperationIDEQ.
+func OperationID(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldOperationID, v))
+}
+
+// Summary applies equality check predicate on the "summary" field. It's identical to SummaryEQ.
+func Summary(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldSummary, v))
+}
+
+// AccessLevel applies equality check predicate on the "access_level" field. It's identical to AccessLevelEQ.
+func AccessLevel(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldAccessLevel, v))
+}
+
+// Deprecated applies equality check predicate on the "deprecated" field. It's identical to DeprecatedEQ.
+func Deprecated(v bool) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldDeprecated, v))
+}
+
+// SourceFile applies equality check predicate on the "source_file" field. It's identical to SourceFileEQ.
+func SourceFile(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldSourceFile, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// SpecNameEQ applies the EQ predicate on the "spec_name" field.
+func SpecNameEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldSpecName, v))
+}
+
+// SpecNameNEQ applies the NEQ predicate on the "spec_name" field.
+func SpecNameNEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNEQ(FieldSpecName, v))
+}
+
+// SpecNameIn applies the In predicate on the "spec_name" field.
+func SpecNameIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIn(FieldSpecName, vs...))
+}
+
+// SpecNameNotIn applies the NotIn predicate on the "spec_name" field.
+func SpecNameNotIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotIn(FieldSpecName, vs...))
+}
+
+// SpecNameGT applies the GT predicate on the "spec_name" field.
+func SpecNameGT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGT(FieldSpecName, v))
+}
+
+// SpecNameGTE applies the GTE predicate on the "spec_name" field.
+func SpecNameGTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGTE(FieldSpecName, v))
+}
+
+// SpecNameLT applies the LT predicate on the "spec_name" field.
+func SpecNameLT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLT(FieldSpecName, v))
+}
+
+// SpecNameLTE applies the LTE predicate on the "spec_name" field.
+func SpecNameLTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLTE(FieldSpecName, v))
+}
+
+// SpecNameContains applies the Contains predicate on the "spec_name" field.
+func SpecNameContains(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContains(FieldSpecName, v))
+}
+
+// SpecNameHasPrefix applies the HasPrefix predicate on the "spec_name" field.
+func SpecNameHasPrefix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasPrefix(FieldSpecName, v))
+}
+
+// SpecNameHasSuffix applies the HasSuffix predicate on the "spec_name" field.
+func SpecNameHasSuffix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasSuffix(FieldSpecName, v))
+}
+
+// SpecNameEqualFold applies the EqualFold predicate on the "spec_name" field.
+func SpecNameEqualFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEqualFold(FieldSpecName, v))
+}
+
+// SpecNameContainsFold applies the ContainsFold predicate on the "spec_name" field.
+func SpecNameContainsFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContainsFold(FieldSpecName, v))
+}
+
+// SpecVersionEQ applies the EQ predicate on the "spec_version" field.
+func SpecVersionEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldSpecVersion, v))
+}
+
+// SpecVersionNEQ applies the NEQ predicate on the "spec_version" field.
+func SpecVersionNEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNEQ(FieldSpecVersion, v))
+}
+
+// SpecVersionIn applies the In predicate on the "spec_version" field.
+func SpecVersionIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIn(FieldSpecVersion, vs...))
+}
+
+// SpecVersionNotIn applies the NotIn predicate on the "spec_version" field.
+func SpecVersionNotIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotIn(FieldSpecVersion, vs...))
+}
+
+// SpecVersionGT applies the GT predicate on the "spec_version" field.
+func SpecVersionGT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGT(FieldSpecVersion, v))
+}
+
+// SpecVersionGTE applies the GTE predicate on the "spec_version" field.
+func SpecVersionGTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGTE(FieldSpecVersion, v))
+}
+
+// SpecVersionLT applies the LT predicate on the "spec_version" field.
+func SpecVersionLT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLT(FieldSpecVersion, v))
+}
+
+// SpecVersionLTE applies the LTE predicate on the "spec_version" field.
+func SpecVersionLTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLTE(FieldSpecVersion, v))
+}
+
+// SpecVersionContains applies the Contains predicate on the "spec_version" field.
+func SpecVersionContains(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContains(FieldSpecVersion, v))
+}
+
+// SpecVersionHasPrefix applies the HasPrefix predicate on the "spec_version" field.
+func SpecVersionHasPrefix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasPrefix(FieldSpecVersion, v))
+}
+
+// SpecVersionHasSuffix applies the HasSuffix predicate on the "spec_version" field.
+func SpecVersionHasSuffix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasSuffix(FieldSpecVersion, v))
+}
+
+// SpecVersionIsNil applies the IsNil predicate on the "spec_version" field.
+func SpecVersionIsNil() predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIsNull(FieldSpecVersion))
+}
+
+// SpecVersionNotNil applies the NotNil predicate on the "spec_version" field.
+func SpecVersionNotNil() predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotNull(FieldSpecVersion))
+}
+
+// SpecVersionEqualFold applies the EqualFold predicate on the "spec_version" field.
+func SpecVersionEqualFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEqualFold(FieldSpecVersion, v))
+}
+
+// SpecVersionContainsFold applies the ContainsFold predicate on the "spec_version" field.
+func SpecVersionContainsFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContainsFold(FieldSpecVersion, v))
+}
+
+// OpenapiVersionEQ applies the EQ predicate on the "openapi_version" field.
+func OpenapiVersionEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldOpenapiVersion, v))
+}
+
+// OpenapiVersionNEQ applies the NEQ predicate on the "openapi_version" field.
+func OpenapiVersionNEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNEQ(FieldOpenapiVersion, v))
+}
+
+// OpenapiVersionIn applies the In predicate on the "openapi_version" field.
+func OpenapiVersionIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIn(FieldOpenapiVersion, vs...))
+}
+
+// OpenapiVersionNotIn applies the NotIn predicate on the "openapi_version" field.
+func OpenapiVersionNotIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotIn(FieldOpenapiVersion, vs...))
+}
+
+// OpenapiVersionGT applies the GT predicate on the "openapi_version" field.
+func OpenapiVersionGT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGT(FieldOpenapiVersion, v))
+}
+
+// OpenapiVersionGTE applies the GTE predicate on the "openapi_version" field.
+func OpenapiVersionGTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGTE(FieldOpenapiVersion, v))
+}
+
+// OpenapiVersionLT applies the LT predicate on the "openapi_version" field.
+func OpenapiVersionLT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLT(FieldOpenapiVersion, v))
+}
+
+// OpenapiVersionLTE applies the LTE predicate on the "openapi_version" field.
+func OpenapiVersionLTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLTE(FieldOpenapiVersion, v))
+}
+
+// OpenapiVersionContains applies the Contains predicate on the "openapi_version" field.
+func OpenapiVersionContains(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContains(FieldOpenapiVersion, v))
+}
+
+// OpenapiVersionHasPrefix applies the HasPrefix predicate on the "openapi_version" field.
+func OpenapiVersionHasPrefix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasPrefix(FieldOpenapiVersion, v))
+}
+
+// OpenapiVersionHasSuffix applies the HasSuffix predicate on the "openapi_version" field.
+func OpenapiVersionHasSuffix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasSuffix(FieldOpenapiVersion, v))
+}
+
+// OpenapiVersionIsNil applies the IsNil predicate on the "openapi_version" field.
+func OpenapiVersionIsNil() predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIsNull(FieldOpenapiVersion))
+}
+
+// OpenapiVersionNotNil applies the NotNil predicate on the "openapi_version" field.
+func OpenapiVersionNotNil() predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotNull(FieldOpenapiVersion))
+}
+
+// OpenapiVersionEqualFold applies the EqualFold predicate on the "openapi_version" field.
+func OpenapiVersionEqualFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEqualFold(FieldOpenapiVersion, v))
+}
+
+// OpenapiVersionContainsFold applies the ContainsFold predicate on the "openapi_version" field.
+func OpenapiVersionContainsFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContainsFold(FieldOpenapiVersion, v))
+}
+
+// LogSourceIDEQ applies the EQ predicate on the "log_source_id" field.
+func LogSourceIDEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldLogSourceID, v))
+}
+
+// LogSourceIDNEQ applies the NEQ predicate on the "log_source_id" field.
+func LogSourceIDNEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNEQ(FieldLogSourceID, v))
+}
+
+// LogSourceIDIn applies the In predicate on the "log_source_id" field.
+func LogSourceIDIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIn(FieldLogSourceID, vs...))
+}
+
+// LogSourceIDNotIn applies the NotIn predicate on the "log_source_id" field.
+func LogSourceIDNotIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotIn(FieldLogSourceID, vs...))
+}
+
+// LogSourceIDGT applies the GT predicate on the "log_source_id" field.
+func LogSourceIDGT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGT(FieldLogSourceID, v))
+}
+
+// LogSourceIDGTE applies the GTE predicate on the "log_source_id" field.
+func LogSourceIDGTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGTE(FieldLogSourceID, v))
+}
+
+// LogSourceIDLT applies the LT predicate on the "log_source_id" field.
+func LogSourceIDLT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLT(FieldLogSourceID, v))
+}
+
+// LogSourceIDLTE applies the LTE predicate on the "log_source_id" field.
+func LogSourceIDLTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLTE(FieldLogSourceID, v))
+}
+
+// LogSourceIDContains applies the Contains predicate on the "log_source_id" field.
+func LogSourceIDContains(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContains(FieldLogSourceID, v))
+}
+
+// LogSourceIDHasPrefix applies the HasPrefix predicate on the "log_source_id" field.
+func LogSourceIDHasPrefix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasPrefix(FieldLogSourceID, v))
+}
+
+// LogSourceIDHasSuffix applies the HasSuffix predicate on the "log_source_id" field.
+func LogSourceIDHasSuffix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasSuffix(FieldLogSourceID, v))
+}
+
+// LogSourceIDIsNil applies the IsNil predicate on the "log_source_id" field.
+func LogSourceIDIsNil() predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIsNull(FieldLogSourceID))
+}
+
+// LogSourceIDNotNil applies the NotNil predicate on the "log_source_id" field.
+func LogSourceIDNotNil() predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotNull(FieldLogSourceID))
+}
+
+// LogSourceIDEqualFold applies the EqualFold predicate on the "log_source_id" field.
+func LogSourceIDEqualFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEqualFold(FieldLogSourceID, v))
+}
+
+// LogSourceIDContainsFold applies the ContainsFold predicate on the "log_source_id" field.
+func LogSourceIDContainsFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContainsFold(FieldLogSourceID, v))
+}
+
+// URIEQ applies the EQ predicate on the "uri" field.
+func URIEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldURI, v))
+}
+
+// URINEQ applies the NEQ predicate on the "uri" field.
+func URINEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNEQ(FieldURI, v))
+}
+
+// URIIn applies the In predicate on the "uri" field.
+func URIIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIn(FieldURI, vs...))
+}
+
+// URINotIn applies the NotIn predicate on the "uri" field.
+func URINotIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotIn(FieldURI, vs...))
+}
+
+// URIGT applies the GT predicate on the "uri" field.
+func URIGT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGT(FieldURI, v))
+}
+
+// URIGTE applies the GTE predicate on the "uri" field.
+func URIGTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGTE(FieldURI, v))
+}
+
+// URILT applies the LT predicate on the "uri" field.
+func URILT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLT(FieldURI, v))
+}
+
+// URILTE applies the LTE predicate on the "uri" field.
+func URILTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLTE(FieldURI, v))
+}
+
+// URIContains applies the Contains predicate on the "uri" field.
+func URIContains(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContains(FieldURI, v))
+}
+
+// URIHasPrefix applies the HasPrefix predicate on the "uri" field.
+func URIHasPrefix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasPrefix(FieldURI, v))
+}
+
+// URIHasSuffix applies the HasSuffix predicate on the "uri" field.
+func URIHasSuffix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasSuffix(FieldURI, v))
+}
+
+// URIEqualFold applies the EqualFold predicate on the "uri" field.
+func URIEqualFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEqualFold(FieldURI, v))
+}
+
+// URIContainsFold applies the ContainsFold predicate on the "uri" field.
+func URIContainsFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContainsFold(FieldURI, v))
+}
+
+// MethodEQ applies the EQ predicate on the "method" field.
+func MethodEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldMethod, v))
+}
+
+// MethodNEQ applies the NEQ predicate on the "method" field.
+func MethodNEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNEQ(FieldMethod, v))
+}
+
+// MethodIn applies the In predicate on the "method" field.
+func MethodIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIn(FieldMethod, vs...))
+}
+
+// MethodNotIn applies the NotIn predicate on the "method" field.
+func MethodNotIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotIn(FieldMethod, vs...))
+}
+
+// MethodGT applies the GT predicate on the "method" field.
+func MethodGT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGT(FieldMethod, v))
+}
+
+// MethodGTE applies the GTE predicate on the "method" field.
+func MethodGTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGTE(FieldMethod, v))
+}
+
+// MethodLT applies the LT predicate on the "method" field.
+func MethodLT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLT(FieldMethod, v))
+}
+
+// MethodLTE applies the LTE predicate on the "method" field.
+func MethodLTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLTE(FieldMethod, v))
+}
+
+// MethodContains applies the Contains predicate on the "method" field.
+func MethodContains(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContains(FieldMethod, v))
+}
+
+// MethodHasPrefix applies the HasPrefix predicate on the "method" field.
+func MethodHasPrefix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasPrefix(FieldMethod, v))
+}
+
+// MethodHasSuffix applies the HasSuffix predicate on the "method" field.
+func MethodHasSuffix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasSuffix(FieldMethod, v))
+}
+
+// MethodEqualFold applies the EqualFold predicate on the "method" field.
+func MethodEqualFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEqualFold(FieldMethod, v))
+}
+
+// MethodContainsFold applies the ContainsFold predicate on the "method" field.
+func MethodContainsFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContainsFold(FieldMethod, v))
+}
+
+// OperationIDEQ applies the EQ predicate on the "operation_id" field.
+func OperationIDEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldOperationID, v))
+}
+
+// OperationIDNEQ applies the NEQ predicate on the "operation_id" field.
+func OperationIDNEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNEQ(FieldOperationID, v))
+}
+
+// OperationIDIn applies the In predicate on the "operation_id" field.
+func OperationIDIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIn(FieldOperationID, vs...))
+}
+
+// OperationIDNotIn applies the NotIn predicate on the "operation_id" field.
+func OperationIDNotIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotIn(FieldOperationID, vs...))
+}
+
+// OperationIDGT applies the GT predicate on the "operation_id" field.
+func OperationIDGT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGT(FieldOperationID, v))
+}
+
+// OperationIDGTE applies the GTE predicate on the "operation_id" field.
+func OperationIDGTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGTE(FieldOperationID, v))
+}
+
+// OperationIDLT applies the LT predicate on the "operation_id" field.
+func OperationIDLT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLT(FieldOperationID, v))
+}
+
+// OperationIDLTE applies the LTE predicate on the "operation_id" field.
+func OperationIDLTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLTE(FieldOperationID, v))
+}
+
+// OperationIDContains applies the Contains predicate on the "operation_id" field.
+func OperationIDContains(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContains(FieldOperationID, v))
+}
+
+// OperationIDHasPrefix applies the HasPrefix predicate on the "operation_id" field.
+func OperationIDHasPrefix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasPrefix(FieldOperationID, v))
+}
+
+// OperationIDHasSuffix applies the HasSuffix predicate on the "operation_id" field.
+func OperationIDHasSuffix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasSuffix(FieldOperationID, v))
+}
+
+// OperationIDIsNil applies the IsNil predicate on the "operation_id" field.
+func OperationIDIsNil() predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIsNull(FieldOperationID))
+}
+
+// OperationIDNotNil applies the NotNil predicate on the "operation_id" field.
+func OperationIDNotNil() predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotNull(FieldOperationID))
+}
+
+// OperationIDEqualFold applies the EqualFold predicate on the "operation_id" field.
+func OperationIDEqualFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEqualFold(FieldOperationID, v))
+}
+
+// OperationIDContainsFold applies the ContainsFold predicate on the "operation_id" field.
+func OperationIDContainsFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContainsFold(FieldOperationID, v))
+}
+
+// SummaryEQ applies the EQ predicate on the "summary" field.
+func SummaryEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldSummary, v))
+}
+
+// SummaryNEQ applies the NEQ predicate on the "summary" field.
+func SummaryNEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNEQ(FieldSummary, v))
+}
+
+// SummaryIn applies the In predicate on the "summary" field.
+func SummaryIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIn(FieldSummary, vs...))
+}
+
+// SummaryNotIn applies the NotIn predicate on the "summary" field.
+func SummaryNotIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotIn(FieldSummary, vs...))
+}
+
+// SummaryGT applies the GT predicate on the "summary" field.
+func SummaryGT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGT(FieldSummary, v))
+}
+
+// SummaryGTE applies the GTE predicate on the "summary" field.
+func SummaryGTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGTE(FieldSummary, v))
+}
+
+// SummaryLT applies the LT predicate on the "summary" field.
+func SummaryLT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLT(FieldSummary, v))
+}
+
+// SummaryLTE applies the LTE predicate on the "summary" field.
+func SummaryLTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLTE(FieldSummary, v))
+}
+
+// SummaryContains applies the Contains predicate on the "summary" field.
+func SummaryContains(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContains(FieldSummary, v))
+}
+
+// SummaryHasPrefix applies the HasPrefix predicate on the "summary" field.
+func SummaryHasPrefix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasPrefix(FieldSummary, v))
+}
+
+// SummaryHasSuffix applies the HasSuffix predicate on the "summary" field.
+func SummaryHasSuffix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasSuffix(FieldSummary, v))
+}
+
+// SummaryIsNil applies the IsNil predicate on the "summary" field.
+func SummaryIsNil() predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIsNull(FieldSummary))
+}
+
+// SummaryNotNil applies the NotNil predicate on the "summary" field.
+func SummaryNotNil() predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotNull(FieldSummary))
+}
+
+// SummaryEqualFold applies the EqualFold predicate on the "summary" field.
+func SummaryEqualFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEqualFold(FieldSummary, v))
+}
+
+// SummaryContainsFold applies the ContainsFold predicate on the "summary" field.
+func SummaryContainsFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContainsFold(FieldSummary, v))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// SecurityJSONIsNil applies the IsNil predicate on the "security_json" field.
+func SecurityJSONIsNil() predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIsNull(FieldSecurityJSON))
+}
+
+// SecurityJSONNotNil applies the NotNil predicate on the "security_json" field.
+func SecurityJSONNotNil() predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotNull(FieldSecurityJSON))
+}
+
+// AccessLevelEQ applies the EQ predicate on the "access_level" field.
+func AccessLevelEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldAccessLevel, v))
+}
+
+// AccessLevelNEQ applies the NEQ predicate on the "access_level" field.
+func AccessLevelNEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNEQ(FieldAccessLevel, v))
+}
+
+// AccessLevelIn applies the In predicate on the "access_level" field.
+func AccessLevelIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIn(FieldAccessLevel, vs...))
+}
+
+// AccessLevelNotIn applies the NotIn predicate on the "access_level" field.
+func AccessLevelNotIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotIn(FieldAccessLevel, vs...))
+}
+
+// AccessLevelGT applies the GT predicate on the "access_level" field.
+func AccessLevelGT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGT(FieldAccessLevel, v))
+}
+
+// AccessLevelGTE applies the GTE predicate on the "access_level" field.
+func AccessLevelGTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGTE(FieldAccessLevel, v))
+}
+
+// AccessLevelLT applies the LT predicate on the "access_level" field.
+func AccessLevelLT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLT(FieldAccessLevel, v))
+}
+
+// AccessLevelLTE applies the LTE predicate on the "access_level" field.
+func AccessLevelLTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLTE(FieldAccessLevel, v))
+}
+
+// AccessLevelContains applies the Contains predicate on the "access_level" field.
+func AccessLevelContains(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContains(FieldAccessLevel, v))
+}
+
+// AccessLevelHasPrefix applies the HasPrefix predicate on the "access_level" field.
+func AccessLevelHasPrefix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasPrefix(FieldAccessLevel, v))
+}
+
+// AccessLevelHasSuffix applies the HasSuffix predicate on the "access_level" field.
+func AccessLevelHasSuffix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasSuffix(FieldAccessLevel, v))
+}
+
+// AccessLevelEqualFold applies the EqualFold predicate on the "access_level" field.
+func AccessLevelEqualFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEqualFold(FieldAccessLevel, v))
+}
+
+// AccessLevelContainsFold applies the ContainsFold predicate on the "access_level" field.
+func AccessLevelContainsFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContainsFold(FieldAccessLevel, v))
+}
+
+// DeprecatedEQ applies the EQ predicate on the "deprecated" field.
+func DeprecatedEQ(v bool) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldDeprecated, v))
+}
+
+// DeprecatedNEQ applies the NEQ predicate on the "deprecated" field.
+func DeprecatedNEQ(v bool) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNEQ(FieldDeprecated, v))
+}
+
+// SourceFileEQ applies the EQ predicate on the "source_file" field.
+func SourceFileEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEQ(FieldSourceFile, v))
+}
+
+// SourceFileNEQ applies the NEQ predicate on the "source_file" field.
+func SourceFileNEQ(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNEQ(FieldSourceFile, v))
+}
+
+// SourceFileIn applies the In predicate on the "source_file" field.
+func SourceFileIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIn(FieldSourceFile, vs...))
+}
+
+// SourceFileNotIn applies the NotIn predicate on the "source_file" field.
+func SourceFileNotIn(vs ...string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotIn(FieldSourceFile, vs...))
+}
+
+// SourceFileGT applies the GT predicate on the "source_file" field.
+func SourceFileGT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGT(FieldSourceFile, v))
+}
+
+// SourceFileGTE applies the GTE predicate on the "source_file" field.
+func SourceFileGTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldGTE(FieldSourceFile, v))
+}
+
+// SourceFileLT applies the LT predicate on the "source_file" field.
+func SourceFileLT(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLT(FieldSourceFile, v))
+}
+
+// SourceFileLTE applies the LTE predicate on the "source_file" field.
+func SourceFileLTE(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldLTE(FieldSourceFile, v))
+}
+
+// SourceFileContains applies the Contains predicate on the "source_file" field.
+func SourceFileContains(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContains(FieldSourceFile, v))
+}
+
+// SourceFileHasPrefix applies the HasPrefix predicate on the "source_file" field.
+func SourceFileHasPrefix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasPrefix(FieldSourceFile, v))
+}
+
+// SourceFileHasSuffix applies the HasSuffix predicate on the "source_file" field.
+func SourceFileHasSuffix(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldHasSuffix(FieldSourceFile, v))
+}
+
+// SourceFileIsNil applies the IsNil predicate on the "source_file" field.
+func SourceFileIsNil() predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldIsNull(FieldSourceFile))
+}
+
+// SourceFileNotNil applies the NotNil predicate on the "source_file" field.
+func SourceFileNotNil() predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldNotNull(FieldSourceFile))
+}
+
+// SourceFileEqualFold applies the EqualFold predicate on the "source_file" field.
+func SourceFileEqualFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldEqualFold(FieldSourceFile, v))
+}
+
+// SourceFileContainsFold applies the ContainsFold predicate on the "source_file" field.
+func SourceFileContainsFold(v string) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.FieldContainsFold(FieldSourceFile, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeApicatalogOpenapiEndpoint) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeApicatalogOpenapiEndpoint) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeApicatalogOpenapiEndpoint) predicate.BronzeApicatalogOpenapiEndpoint {
+	return predicate.BronzeApicatalogOpenapiEndpoint(sql.NotPredicates(p))
+}
@@ -0,0 +1,448 @@
+// Code generated by ent, DO NOT EDIT.
+
+package apicatalog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/apicatalog/bronzeapicatalogopenapiendpoint"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeApicatalogOpenapiEndpointCreate is the builder for creating a BronzeApicatalogOpenapiEndpoint entity.
+type BronzeApicatalogOpenapiEndpointCreate struct {
+	config
+	mutation *BronzeApicatalogOpenapiEndpointMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetCollectedAt(v time.Time) *BronzeApicatalogOpenapiEndpointCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetFirstCollectedAt(v time.Time) *BronzeApicatalogOpenapiEndpointCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetSpecName sets the "spec_name" field.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetSpecName(v string) *BronzeApicatalogOpenapiEndpointCreate {
+	_c.mutation.SetSpecName(v)
+	return _c
+}
+
+// SetSpecVersion sets the "spec_version" field.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetSpecVersion(v string) *BronzeApicatalogOpenapiEndpointCreate {
+	_c.mutation.SetSpecVersion(v)
+	return _c
+}
+
+// SetNillableSpecVersion sets the "spec_version" field if the given value is not nil.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetNillableSpecVersion(v *string) *BronzeApicatalogOpenapiEndpointCreate {
+	if v != nil {
+		_c.SetSpecVersion(*v)
+	}
+	return _c
+}
+
+// SetOpenapiVersion sets the "openapi_version" field.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetOpenapiVersion(v string) *BronzeApicatalogOpenapiEndpointCreate {
+	_c.mutation.SetOpenapiVersion(v)
+	return _c
+}
+
+// SetNillableOpenapiVersion sets the "openapi_version" field if the given value is not nil.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetNillableOpenapiVersion(v *string) *BronzeApicatalogOpenapiEndpointCreate {
+	if v != nil {
+		_c.SetOpenapiVersion(*v)
+	}
+	return _c
+}
+
+// SetLogSourceID sets the "log_source_id" field.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetLogSourceID(v string) *BronzeApicatalogOpenapiEndpointCreate {
+	_c.mutation.SetLogSourceID(v)
+	return _c
+}
+
+// SetNillableLogSourceID sets the "log_source_id" field if the given value is not nil.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetNillableLogSourceID(v *string) *BronzeApicatalogOpenapiEndpointCreate {
+	if v != nil {
+		_c.SetLogSourceID(*v)
+	}
+	return _c
+}
+
+// SetURI sets the "uri" field.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetURI(v string) *BronzeApicatalogOpenapiEndpointCreate {
+	_c.mutation.SetURI(v)
+	return _c
+}
+
+// SetMethod sets the "method" field.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetMethod(v string) *BronzeApicatalogOpenapiEndpointCreate {
+	_c.mutation.SetMethod(v)
+	return _c
+}
+
+// SetOperationID sets the "operation_id" field.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetOperationID(v string) *BronzeApicatalogOpenapiEndpointCreate {
+	_c.mutation.SetOperationID(v)
+	return _c
+}
+
+// SetNillableOperationID sets the "operation_id" field if the given value is not nil.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetNillableOperationID(v *string) *BronzeApicatalogOpenapiEndpointCreate {
+	if v != nil {
+		_c.SetOperationID(*v)
+	}
+	return _c
+}
+
+// SetSummary sets the "summary" field.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetSummary(v string) *BronzeApicatalogOpenapiEndpointCreate {
+	_c.mutation.SetSummary(v)
+	return _c
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetNillableSummary(v *string) *BronzeApicatalogOpenapiEndpointCreate {
+	if v != nil {
+		_c.SetSummary(*v)
+	}
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetTagsJSON(v []string) *BronzeApicatalogOpenapiEndpointCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetSecurityJSON sets the "security_json" field.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetSecurityJSON(v json.RawMessage) *BronzeApicatalogOpenapiEndpointCreate {
+	_c.mutation.SetSecurityJSON(v)
+	return _c
+}
+
+// SetAccessLevel sets the "access_level" field.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetAccessLevel(v string) *BronzeApicatalogOpenapiEndpointCreate {
+	_c.mutation.SetAccessLevel(v)
+	return _c
+}
+
+// SetNillableAccessLevel sets the "access_level" field if the given value is not nil.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetNillableAccessLevel(v *string) *BronzeApicatalogOpenapiEndpointCreate {
+	if v != nil {
+		_c.SetAccessLevel(*v)
+	}
+	return _c
+}
+
+// SetDeprecated sets the "deprecated" field.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetDeprecated(v bool) *BronzeApicatalogOpenapiEndpointCreate {
+	_c.mutation.SetDeprecated(v)
+	return _c
+}
+
+// SetNillableDeprecated sets the "deprecated" field if the given value is not nil.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetNillableDeprecated(v *bool) *BronzeApicatalogOpenapiEndpointCreate {
+	if v != nil {
+		_c.SetDeprecated(*v)
+	}
+	return _c
+}
+
+// SetSourceFile sets the "source_file" field.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetSourceFile(v string) *BronzeApicatalogOpenapiEndpointCreate {
+	_c.mutation.SetSourceFile(v)
+	return _c
+}
+
+// SetNillableSourceFile sets the "source_file" field if the given value is not nil.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetNillableSourceFile(v *string) *BronzeApicatalogOpenapiEndpointCreate {
+	if v != nil {
+		_c.SetSourceFile(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SetID(v string) *BronzeApicatalogOpenapiEndpointCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeApicatalogOpenapiEndpointMutation object of the builder.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) Mutation() *BronzeApicatalogOpenapiEndpointMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeApicatalogOpenapiEndpoint in the database.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) Save(ctx context.Context) (*BronzeApicatalogOpenapiEndpoint, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) SaveX(ctx context.Context) *BronzeApicatalogOpenapiEndpoint {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) defaults() {
+	if _, ok := _c.mutation.AccessLevel(); !ok {
+		v := bronzeapicatalogopenapiendpoint.DefaultAccessLevel
+		_c.mutation.SetAccessLevel(v)
+	}
+	if _, ok := _c.mutation.Deprecated(); !ok {
+		v := bronzeapicatalogopenapiendpoint.DefaultDeprecated
+		_c.mutation.SetDeprecated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeApicatalogOpenapiEndpointCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`apicatalog: missing required field "BronzeApicatalogOpenapiEndpoint.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`apicatalog: missing required field "BronzeApicatalogOpenapiEndpoint.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.SpecName(); !ok {
+		return &ValidationError{Name: "spec_name", err: errors.New(`apicatalog: missing required field "BronzeApicatalogOpenapiEndpoint.spec_name"`)}
+	}
+	if v, ok := _c.mutation.SpecName(); ok {
+		if err := bronzeapicatalogopenapiendpoint.SpecNameValidator(v); err != nil {
+			return &ValidationError{Name: "spec_name", err: fmt.Errorf(`apicatalog: validator failed for field "BronzeApicatalogOpenapiEndpoint.spec_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.URI(); !ok {
+		return &ValidationError{Name: "uri", err: errors.New(`apicatalog: missing required field "BronzeApicatalogOpenapiEndpoint.uri"`)}
+	}
+	if v, ok := _c.mutation.URI(); ok {
+		if err := bronzeapicatalogopenapiendpoint.URIValidator(v); err != nil {
+			return &ValidationError{Name: "uri", err: fmt.Errorf(`apicatalog: validator failed for field "BronzeApicatalogOpenapiEndpoint.uri": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`apicatalog: missing required field "BronzeApicatalogOpenapiEndpoint.method"`)}
+	}
+	if v, ok := _c.mutation.Method(); ok {
+		if err := bronzeapicatalogopenapiendpoint.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`apicatalog: validator failed for field "BronzeApicatalogOpenapiEndpoint.method": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccessLevel(); !ok {
+		return &ValidationError{Name: "access_level", err: errors.New(`apicatalog: missing required field "BronzeApicatalogOpenapiEndpoint.access_level"`)}
+	}
+	if _, ok := _c.mutation.Deprecated(); !ok {
+		return &ValidationError{Name: "deprecated", err: errors.New(`apicatalog: missing required field "BronzeApicatalogOpenapiEndpoint.deprecated"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeApicatalogOpenapiEndpointCreate) sqlSave(ctx context.Context) (*BronzeApicatalogOpenapiEndpoint, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeApicatalogOpenapiEndpoint.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeApicatalogOpenapiEndpointCreate) createSpec() (*BronzeApicatalogOpenapiEndpoint, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeApicatalogOpenapiEndpoint{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzeapicatalogopenapiendpoint.Table, sqlgraph.NewFieldSpec(bronzeapicatalogopenapiendpoint.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeApicatalogOpenapiEndpoint
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.SpecName(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldSpecName, field.TypeString, value)
+		_node.SpecName = value
+	}
+	if value, ok := _c.mutation.SpecVersion(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldSpecVersion, field.TypeString, value)
+		_node.SpecVersion = value
+	}
+	if value, ok := _c.mutation.OpenapiVersion(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldOpenapiVersion, field.TypeString, value)
+		_node.OpenapiVersion = value
+	}
+	if value, ok := _c.mutation.LogSourceID(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldLogSourceID, field.TypeString, value)
+		_node.LogSourceID = value
+	}
+	if value, ok := _c.mutation.URI(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldURI, field.TypeString, value)
+		_node.URI = value
+	}
+	if value, ok := _c.mutation.Method(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldMethod, field.TypeString, value)
+		_node.Method = value
+	}
+	if value, ok := _c.mutation.OperationID(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldOperationID, field.TypeString, value)
+		_node.OperationID = value
+	}
+	if value, ok := _c.mutation.Summary(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldSummary, field.TypeString, value)
+		_node.Summary = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.SecurityJSON(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldSecurityJSON, field.TypeJSON, value)
+		_node.SecurityJSON = value
+	}
+	if value, ok := _c.mutation.AccessLevel(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldAccessLevel, field.TypeString, value)
+		_node.AccessLevel = value
+	}
+	if value, ok := _c.mutation.Deprecated(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldDeprecated, field.TypeBool, value)
+		_node.Deprecated = value
+	}
+	if value, ok := _c.mutation.SourceFile(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldSourceFile, field.TypeString, value)
+		_node.SourceFile = value
+	}
+	return _node, _spec
+}
+
+// BronzeApicatalogOpenapiEndpointCreateBulk is the builder for creating many BronzeApicatalogOpenapiEndpoint entities in bulk.
+type BronzeApicatalogOpenapiEndpointCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeApicatalogOpenapiEndpointCreate
+}
+
+// Save creates the BronzeApicatalogOpenapiEndpoint entities in the database.
+func (_c *BronzeApicatalogOpenapiEndpointCreateBulk) Save(ctx context.Context) ([]*BronzeApicatalogOpenapiEndpoint, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeApicatalogOpenapiEndpoint, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeApicatalogOpenapiEndpointMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeApicatalogOpenapiEndpointCreateBulk) SaveX(ctx context.Context) []*BronzeApicatalogOpenapiEndpoint {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeApicatalogOpenapiEndpointCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeApicatalogOpenapiEndpointCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
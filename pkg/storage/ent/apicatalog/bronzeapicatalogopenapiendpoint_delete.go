@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package apicatalog
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/apicatalog/bronzeapicatalogopenapiendpoint"
+	"danny.vn/hotpot/pkg/storage/ent/apicatalog/internal"
+	"danny.vn/hotpot/pkg/storage/ent/apicatalog/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeApicatalogOpenapiEndpointDelete is the builder for deleting a BronzeApicatalogOpenapiEndpoint entity.
+type BronzeApicatalogOpenapiEndpointDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeApicatalogOpenapiEndpointMutation
+}
+
+// Where appends a list predicates to the BronzeApicatalogOpenapiEndpointDelete builder.
+func (_d *BronzeApicatalogOpenapiEndpointDelete) Where(ps ...predicate.BronzeApicatalogOpenapiEndpoint) *BronzeApicatalogOpenapiEndpointDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeApicatalogOpenapiEndpointDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeApicatalogOpenapiEndpointDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeApicatalogOpenapiEndpointDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzeapicatalogopenapiendpoint.Table, sqlgraph.NewFieldSpec(bronzeapicatalogopenapiendpoint.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.BronzeApicatalogOpenapiEndpoint
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeApicatalogOpenapiEndpointDeleteOne is the builder for deleting a single BronzeApicatalogOpenapiEndpoint entity.
+type BronzeApicatalogOpenapiEndpointDeleteOne struct {
+	_d *BronzeApicatalogOpenapiEndpointDelete
+}
+
+// Where appends a list predicates to the BronzeApicatalogOpenapiEndpointDelete builder.
+func (_d *BronzeApicatalogOpenapiEndpointDeleteOne) Where(ps ...predicate.BronzeApicatalogOpenapiEndpoint) *BronzeApicatalogOpenapiEndpointDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeApicatalogOpenapiEndpointDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzeapicatalogopenapiendpoint.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeApicatalogOpenapiEndpointDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,861 @@
+// Code generated by ent, DO NOT EDIT.
+
+package apicatalog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/apicatalog/bronzeapicatalogopenapiendpoint"
+	"danny.vn/hotpot/pkg/storage/ent/apicatalog/internal"
+	"danny.vn/hotpot/pkg/storage/ent/apicatalog/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeApicatalogOpenapiEndpointUpdate is the builder for updating BronzeApicatalogOpenapiEndpoint entities.
+type BronzeApicatalogOpenapiEndpointUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeApicatalogOpenapiEndpointMutation
+}
+
+// Where appends a list predicates to the BronzeApicatalogOpenapiEndpointUpdate builder.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) Where(ps ...predicate.BronzeApicatalogOpenapiEndpoint) *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetCollectedAt(v time.Time) *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetNillableCollectedAt(v *time.Time) *BronzeApicatalogOpenapiEndpointUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetSpecName sets the "spec_name" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetSpecName(v string) *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.SetSpecName(v)
+	return _u
+}
+
+// SetNillableSpecName sets the "spec_name" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetNillableSpecName(v *string) *BronzeApicatalogOpenapiEndpointUpdate {
+	if v != nil {
+		_u.SetSpecName(*v)
+	}
+	return _u
+}
+
+// SetSpecVersion sets the "spec_version" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetSpecVersion(v string) *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.SetSpecVersion(v)
+	return _u
+}
+
+// SetNillableSpecVersion sets the "spec_version" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetNillableSpecVersion(v *string) *BronzeApicatalogOpenapiEndpointUpdate {
+	if v != nil {
+		_u.SetSpecVersion(*v)
+	}
+	return _u
+}
+
+// ClearSpecVersion clears the value of the "spec_version" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) ClearSpecVersion() *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.ClearSpecVersion()
+	return _u
+}
+
+// SetOpenapiVersion sets the "openapi_version" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetOpenapiVersion(v string) *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.SetOpenapiVersion(v)
+	return _u
+}
+
+// SetNillableOpenapiVersion sets the "openapi_version" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetNillableOpenapiVersion(v *string) *BronzeApicatalogOpenapiEndpointUpdate {
+	if v != nil {
+		_u.SetOpenapiVersion(*v)
+	}
+	return _u
+}
+
+// ClearOpenapiVersion clears the value of the "openapi_version" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) ClearOpenapiVersion() *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.ClearOpenapiVersion()
+	return _u
+}
+
+// SetLogSourceID sets the "log_source_id" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetLogSourceID(v string) *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.SetLogSourceID(v)
+	return _u
+}
+
+// SetNillableLogSourceID sets the "log_source_id" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetNillableLogSourceID(v *string) *BronzeApicatalogOpenapiEndpointUpdate {
+	if v != nil {
+		_u.SetLogSourceID(*v)
+	}
+	return _u
+}
+
+// ClearLogSourceID clears the value of the "log_source_id" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) ClearLogSourceID() *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.ClearLogSourceID()
+	return _u
+}
+
+// SetURI sets the "uri" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetURI(v string) *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.SetURI(v)
+	return _u
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetNillableURI(v *string) *BronzeApicatalogOpenapiEndpointUpdate {
+	if v != nil {
+		_u.SetURI(*v)
+	}
+	return _u
+}
+
+// SetMethod sets the "method" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetMethod(v string) *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.SetMethod(v)
+	return _u
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetNillableMethod(v *string) *BronzeApicatalogOpenapiEndpointUpdate {
+	if v != nil {
+		_u.SetMethod(*v)
+	}
+	return _u
+}
+
+// SetOperationID sets the "operation_id" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetOperationID(v string) *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.SetOperationID(v)
+	return _u
+}
+
+// SetNillableOperationID sets the "operation_id" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetNillableOperationID(v *string) *BronzeApicatalogOpenapiEndpointUpdate {
+	if v != nil {
+		_u.SetOperationID(*v)
+	}
+	return _u
+}
+
+// ClearOperationID clears the value of the "operation_id" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) ClearOperationID() *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.ClearOperationID()
+	return _u
+}
+
+// SetSummary sets the "summary" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetSummary(v string) *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.SetSummary(v)
+	return _u
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetNillableSummary(v *string) *BronzeApicatalogOpenapiEndpointUpdate {
+	if v != nil {
+		_u.SetSummary(*v)
+	}
+	return _u
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) ClearSummary() *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.ClearSummary()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetTagsJSON(v []string) *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) AppendTagsJSON(v []string) *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) ClearTagsJSON() *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetSecurityJSON sets the "security_json" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetSecurityJSON(v json.RawMessage) *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.SetSecurityJSON(v)
+	return _u
+}
+
+// AppendSecurityJSON appends value to the "security_json" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) AppendSecurityJSON(v json.RawMessage) *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.AppendSecurityJSON(v)
+	return _u
+}
+
+// ClearSecurityJSON clears the value of the "security_json" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) ClearSecurityJSON() *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.ClearSecurityJSON()
+	return _u
+}
+
+// SetAccessLevel sets the "access_level" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetAccessLevel(v string) *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.SetAccessLevel(v)
+	return _u
+}
+
+// SetNillableAccessLevel sets the "access_level" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetNillableAccessLevel(v *string) *BronzeApicatalogOpenapiEndpointUpdate {
+	if v != nil {
+		_u.SetAccessLevel(*v)
+	}
+	return _u
+}
+
+// SetDeprecated sets the "deprecated" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetDeprecated(v bool) *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.SetDeprecated(v)
+	return _u
+}
+
+// SetNillableDeprecated sets the "deprecated" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetNillableDeprecated(v *bool) *BronzeApicatalogOpenapiEndpointUpdate {
+	if v != nil {
+		_u.SetDeprecated(*v)
+	}
+	return _u
+}
+
+// SetSourceFile sets the "source_file" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetSourceFile(v string) *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.SetSourceFile(v)
+	return _u
+}
+
+// SetNillableSourceFile sets the "source_file" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SetNillableSourceFile(v *string) *BronzeApicatalogOpenapiEndpointUpdate {
+	if v != nil {
+		_u.SetSourceFile(*v)
+	}
+	return _u
+}
+
+// ClearSourceFile clears the value of the "source_file" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) ClearSourceFile() *BronzeApicatalogOpenapiEndpointUpdate {
+	_u.mutation.ClearSourceFile()
+	return _u
+}
+
+// Mutation returns the BronzeApicatalogOpenapiEndpointMutation object of the builder.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) Mutation() *BronzeApicatalogOpenapiEndpointMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) check() error {
+	if v, ok := _u.mutation.SpecName(); ok {
+		if err := bronzeapicatalogopenapiendpoint.SpecNameValidator(v); err != nil {
+			return &ValidationError{Name: "spec_name", err: fmt.Errorf(`apicatalog: validator failed for field "BronzeApicatalogOpenapiEndpoint.spec_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.URI(); ok {
+		if err := bronzeapicatalogopenapiendpoint.URIValidator(v); err != nil {
+			return &ValidationError{Name: "uri", err: fmt.Errorf(`apicatalog: validator failed for field "BronzeApicatalogOpenapiEndpoint.uri": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Method(); ok {
+		if err := bronzeapicatalogopenapiendpoint.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`apicatalog: validator failed for field "BronzeApicatalogOpenapiEndpoint.method": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeApicatalogOpenapiEndpointUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeapicatalogopenapiendpoint.Table, bronzeapicatalogopenapiendpoint.Columns, sqlgraph.NewFieldSpec(bronzeapicatalogopenapiendpoint.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.SpecName(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldSpecName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SpecVersion(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldSpecVersion, field.TypeString, value)
+	}
+	if _u.mutation.SpecVersionCleared() {
+		_spec.ClearField(bronzeapicatalogopenapiendpoint.FieldSpecVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.OpenapiVersion(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldOpenapiVersion, field.TypeString, value)
+	}
+	if _u.mutation.OpenapiVersionCleared() {
+		_spec.ClearField(bronzeapicatalogopenapiendpoint.FieldOpenapiVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.LogSourceID(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldLogSourceID, field.TypeString, value)
+	}
+	if _u.mutation.LogSourceIDCleared() {
+		_spec.ClearField(bronzeapicatalogopenapiendpoint.FieldLogSourceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.URI(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Method(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OperationID(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldOperationID, field.TypeString, value)
+	}
+	if _u.mutation.OperationIDCleared() {
+		_spec.ClearField(bronzeapicatalogopenapiendpoint.FieldOperationID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Summary(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldSummary, field.TypeString, value)
+	}
+	if _u.mutation.SummaryCleared() {
+		_spec.ClearField(bronzeapicatalogopenapiendpoint.FieldSummary, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeapicatalogopenapiendpoint.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeapicatalogopenapiendpoint.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SecurityJSON(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldSecurityJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSecurityJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeapicatalogopenapiendpoint.FieldSecurityJSON, value)
+		})
+	}
+	if _u.mutation.SecurityJSONCleared() {
+		_spec.ClearField(bronzeapicatalogopenapiendpoint.FieldSecurityJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccessLevel(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldAccessLevel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Deprecated(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldDeprecated, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SourceFile(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldSourceFile, field.TypeString, value)
+	}
+	if _u.mutation.SourceFileCleared() {
+		_spec.ClearField(bronzeapicatalogopenapiendpoint.FieldSourceFile, field.TypeString)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeApicatalogOpenapiEndpoint
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeapicatalogopenapiendpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeApicatalogOpenapiEndpointUpdateOne is the builder for updating a single BronzeApicatalogOpenapiEndpoint entity.
+type BronzeApicatalogOpenapiEndpointUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeApicatalogOpenapiEndpointMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetCollectedAt(v time.Time) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetSpecName sets the "spec_name" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetSpecName(v string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.SetSpecName(v)
+	return _u
+}
+
+// SetNillableSpecName sets the "spec_name" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetNillableSpecName(v *string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	if v != nil {
+		_u.SetSpecName(*v)
+	}
+	return _u
+}
+
+// SetSpecVersion sets the "spec_version" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetSpecVersion(v string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.SetSpecVersion(v)
+	return _u
+}
+
+// SetNillableSpecVersion sets the "spec_version" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetNillableSpecVersion(v *string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	if v != nil {
+		_u.SetSpecVersion(*v)
+	}
+	return _u
+}
+
+// ClearSpecVersion clears the value of the "spec_version" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) ClearSpecVersion() *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.ClearSpecVersion()
+	return _u
+}
+
+// SetOpenapiVersion sets the "openapi_version" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetOpenapiVersion(v string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.SetOpenapiVersion(v)
+	return _u
+}
+
+// SetNillableOpenapiVersion sets the "openapi_version" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetNillableOpenapiVersion(v *string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	if v != nil {
+		_u.SetOpenapiVersion(*v)
+	}
+	return _u
+}
+
+// ClearOpenapiVersion clears the value of the "openapi_version" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) ClearOpenapiVersion() *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.ClearOpenapiVersion()
+	return _u
+}
+
+// SetLogSourceID sets the "log_source_id" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetLogSourceID(v string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.SetLogSourceID(v)
+	return _u
+}
+
+// SetNillableLogSourceID sets the "log_source_id" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetNillableLogSourceID(v *string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	if v != nil {
+		_u.SetLogSourceID(*v)
+	}
+	return _u
+}
+
+// ClearLogSourceID clears the value of the "log_source_id" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) ClearLogSourceID() *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.ClearLogSourceID()
+	return _u
+}
+
+// SetURI sets the "uri" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetURI(v string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.SetURI(v)
+	return _u
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetNillableURI(v *string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	if v != nil {
+		_u.SetURI(*v)
+	}
+	return _u
+}
+
+// SetMethod sets the "method" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetMethod(v string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.SetMethod(v)
+	return _u
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetNillableMethod(v *string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	if v != nil {
+		_u.SetMethod(*v)
+	}
+	return _u
+}
+
+// SetOperationID sets the "operation_id" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetOperationID(v string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.SetOperationID(v)
+	return _u
+}
+
+// SetNillableOperationID sets the "operation_id" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetNillableOperationID(v *string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	if v != nil {
+		_u.SetOperationID(*v)
+	}
+	return _u
+}
+
+// ClearOperationID clears the value of the "operation_id" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) ClearOperationID() *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.ClearOperationID()
+	return _u
+}
+
+// SetSummary sets the "summary" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetSummary(v string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.SetSummary(v)
+	return _u
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetNillableSummary(v *string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	if v != nil {
+		_u.SetSummary(*v)
+	}
+	return _u
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) ClearSummary() *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.ClearSummary()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetTagsJSON(v []string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) AppendTagsJSON(v []string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) ClearTagsJSON() *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetSecurityJSON sets the "security_json" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetSecurityJSON(v json.RawMessage) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.SetSecurityJSON(v)
+	return _u
+}
+
+// AppendSecurityJSON appends value to the "security_json" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) AppendSecurityJSON(v json.RawMessage) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.AppendSecurityJSON(v)
+	return _u
+}
+
+// ClearSecurityJSON clears the value of the "security_json" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) ClearSecurityJSON() *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.ClearSecurityJSON()
+	return _u
+}
+
+// SetAccessLevel sets the "access_level" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetAccessLevel(v string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.SetAccessLevel(v)
+	return _u
+}
+
+// SetNillableAccessLevel sets the "access_level" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetNillableAccessLevel(v *string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	if v != nil {
+		_u.SetAccessLevel(*v)
+	}
+	return _u
+}
+
+// SetDeprecated sets the "deprecated" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetDeprecated(v bool) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.SetDeprecated(v)
+	return _u
+}
+
+// SetNillableDeprecated sets the "deprecated" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetNillableDeprecated(v *bool) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	if v != nil {
+		_u.SetDeprecated(*v)
+	}
+	return _u
+}
+
+// SetSourceFile sets the "source_file" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetSourceFile(v string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.SetSourceFile(v)
+	return _u
+}
+
+// SetNillableSourceFile sets the "source_file" field if the given value is not nil.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SetNillableSourceFile(v *string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	if v != nil {
+		_u.SetSourceFile(*v)
+	}
+	return _u
+}
+
+// ClearSourceFile clears the value of the "source_file" field.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) ClearSourceFile() *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.ClearSourceFile()
+	return _u
+}
+
+// Mutation returns the BronzeApicatalogOpenapiEndpointMutation object of the builder.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) Mutation() *BronzeApicatalogOpenapiEndpointMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeApicatalogOpenapiEndpointUpdate builder.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) Where(ps ...predicate.BronzeApicatalogOpenapiEndpoint) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) Select(field string, fields ...string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeApicatalogOpenapiEndpoint entity.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) Save(ctx context.Context) (*BronzeApicatalogOpenapiEndpoint, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) SaveX(ctx context.Context) *BronzeApicatalogOpenapiEndpoint {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) check() error {
+	if v, ok := _u.mutation.SpecName(); ok {
+		if err := bronzeapicatalogopenapiendpoint.SpecNameValidator(v); err != nil {
+			return &ValidationError{Name: "spec_name", err: fmt.Errorf(`apicatalog: validator failed for field "BronzeApicatalogOpenapiEndpoint.spec_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.URI(); ok {
+		if err := bronzeapicatalogopenapiendpoint.URIValidator(v); err != nil {
+			return &ValidationError{Name: "uri", err: fmt.Errorf(`apicatalog: validator failed for field "BronzeApicatalogOpenapiEndpoint.uri": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Method(); ok {
+		if err := bronzeapicatalogopenapiendpoint.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`apicatalog: validator failed for field "BronzeApicatalogOpenapiEndpoint.method": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeApicatalogOpenapiEndpointUpdateOne) sqlSave(ctx context.Context) (_node *BronzeApicatalogOpenapiEndpoint, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeapicatalogopenapiendpoint.Table, bronzeapicatalogopenapiendpoint.Columns, sqlgraph.NewFieldSpec(bronzeapicatalogopenapiendpoint.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`apicatalog: missing "BronzeApicatalogOpenapiEndpoint.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeapicatalogopenapiendpoint.FieldID)
+		for _, f := range fields {
+			if !bronzeapicatalogopenapiendpoint.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("apicatalog: invalid field %q for query", f)}
+			}
+			if f != bronzeapicatalogopenapiendpoint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.SpecName(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldSpecName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SpecVersion(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldSpecVersion, field.TypeString, value)
+	}
+	if _u.mutation.SpecVersionCleared() {
+		_spec.ClearField(bronzeapicatalogopenapiendpoint.FieldSpecVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.OpenapiVersion(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldOpenapiVersion, field.TypeString, value)
+	}
+	if _u.mutation.OpenapiVersionCleared() {
+		_spec.ClearField(bronzeapicatalogopenapiendpoint.FieldOpenapiVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.LogSourceID(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldLogSourceID, field.TypeString, value)
+	}
+	if _u.mutation.LogSourceIDCleared() {
+		_spec.ClearField(bronzeapicatalogopenapiendpoint.FieldLogSourceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.URI(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Method(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OperationID(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldOperationID, field.TypeString, value)
+	}
+	if _u.mutation.OperationIDCleared() {
+		_spec.ClearField(bronzeapicatalogopenapiendpoint.FieldOperationID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Summary(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldSummary, field.TypeString, value)
+	}
+	if _u.mutation.SummaryCleared() {
+		_spec.ClearField(bronzeapicatalogopenapiendpoint.FieldSummary, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeapicatalogopenapiendpoint.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeapicatalogopenapiendpoint.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SecurityJSON(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldSecurityJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSecurityJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeapicatalogopenapiendpoint.FieldSecurityJSON, value)
+		})
+	}
+	if _u.mutation.SecurityJSONCleared() {
+		_spec.ClearField(bronzeapicatalogopenapiendpoint.FieldSecurityJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccessLevel(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldAccessLevel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Deprecated(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldDeprecated, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SourceFile(); ok {
+		_spec.SetField(bronzeapicatalogopenapiendpoint.FieldSourceFile, field.TypeString, value)
+	}
+	if _u.mutation.SourceFileCleared() {
+		_spec.ClearField(bronzeapicatalogopenapiendpoint.FieldSourceFile, field.TypeString)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeApicatalogOpenapiEndpoint
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeApicatalogOpenapiEndpoint{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeapicatalogopenapiendpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
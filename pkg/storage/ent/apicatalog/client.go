@@ -12,6 +12,7 @@ import (
 	"danny.vn/hotpot/pkg/storage/ent/apicatalog/migrate"
 
 	"danny.vn/hotpot/pkg/storage/ent/apicatalog/bronzeapicatalogendpointsraw"
+	"danny.vn/hotpot/pkg/storage/ent/apicatalog/bronzeapicatalogopenapiendpoint"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -26,6 +27,8 @@ type Client struct {
 	Schema *migrate.Schema
 	// BronzeApicatalogEndpointsRaw is the client for interacting with the BronzeApicatalogEndpointsRaw builders.
 	BronzeApicatalogEndpointsRaw *BronzeApicatalogEndpointsRawClient
+	// BronzeApicatalogOpenapiEndpoint is the client for interacting with the BronzeApicatalogOpenapiEndpoint builders.
+	BronzeApicatalogOpenapiEndpoint *BronzeApicatalogOpenapiEndpointClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -38,6 +41,7 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.BronzeApicatalogEndpointsRaw = NewBronzeApicatalogEndpointsRawClient(c.config)
+	c.BronzeApicatalogOpenapiEndpoint = NewBronzeApicatalogOpenapiEndpointClient(c.config)
 }
 
 type (
@@ -130,9 +134,10 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                          ctx,
-		config:                       cfg,
-		BronzeApicatalogEndpointsRaw: NewBronzeApicatalogEndpointsRawClient(cfg),
+		ctx:                             ctx,
+		config:                          cfg,
+		BronzeApicatalogEndpointsRaw:    NewBronzeApicatalogEndpointsRawClient(cfg),
+		BronzeApicatalogOpenapiEndpoint: NewBronzeApicatalogOpenapiEndpointClient(cfg),
 	}, nil
 }
 
@@ -150,9 +155,10 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                          ctx,
-		config:                       cfg,
-		BronzeApicatalogEndpointsRaw: NewBronzeApicatalogEndpointsRawClient(cfg),
+		ctx:                             ctx,
+		config:                          cfg,
+		BronzeApicatalogEndpointsRaw:    NewBronzeApicatalogEndpointsRawClient(cfg),
+		BronzeApicatalogOpenapiEndpoint: NewBronzeApicatalogOpenapiEndpointClient(cfg),
 	}, nil
 }
 
@@ -182,12 +188,14 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	c.BronzeApicatalogEndpointsRaw.Use(hooks...)
+	c.BronzeApicatalogOpenapiEndpoint.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	c.BronzeApicatalogEndpointsRaw.Intercept(interceptors...)
+	c.BronzeApicatalogOpenapiEndpoint.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -195,6 +203,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *BronzeApicatalogEndpointsRawMutation:
 		return c.BronzeApicatalogEndpointsRaw.mutate(ctx, m)
+	case *BronzeApicatalogOpenapiEndpointMutation:
+		return c.BronzeApicatalogOpenapiEndpoint.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("apicatalog: unknown mutation type %T", m)
 	}
@@ -333,13 +343,146 @@ func (c *BronzeApicatalogEndpointsRawClient) mutate(ctx context.Context, m *Bron
 	}
 }
 
+// BronzeApicatalogOpenapiEndpointClient is a client for the BronzeApicatalogOpenapiEndpoint schema.
+type BronzeApicatalogOpenapiEndpointClient struct {
+	config
+}
+
+// NewBronzeApicatalogOpenapiEndpointClient returns a client for the BronzeApicatalogOpenapiEndpoint from the given config.
+func NewBronzeApicatalogOpenapiEndpointClient(c config) *BronzeApicatalogOpenapiEndpointClient {
+	return &BronzeApicatalogOpenapiEndpointClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzeapicatalogopenapiendpoint.Hooks(f(g(h())))`.
+func (c *BronzeApicatalogOpenapiEndpointClient) Use(hooks ...Hook) {
+	c.hooks.BronzeApicatalogOpenapiEndpoint = append(c.hooks.BronzeApicatalogOpenapiEndpoint, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzeapicatalogopenapiendpoint.Intercept(f(g(h())))`.
+func (c *BronzeApicatalogOpenapiEndpointClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeApicatalogOpenapiEndpoint = append(c.inters.BronzeApicatalogOpenapiEndpoint, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeApicatalogOpenapiEndpoint entity.
+func (c *BronzeApicatalogOpenapiEndpointClient) Create() *BronzeApicatalogOpenapiEndpointCreate {
+	mutation := newBronzeApicatalogOpenapiEndpointMutation(c.config, OpCreate)
+	return &BronzeApicatalogOpenapiEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeApicatalogOpenapiEndpoint entities.
+func (c *BronzeApicatalogOpenapiEndpointClient) CreateBulk(builders ...*BronzeApicatalogOpenapiEndpointCreate) *BronzeApicatalogOpenapiEndpointCreateBulk {
+	return &BronzeApicatalogOpenapiEndpointCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeApicatalogOpenapiEndpointClient) MapCreateBulk(slice any, setFunc func(*BronzeApicatalogOpenapiEndpointCreate, int)) *BronzeApicatalogOpenapiEndpointCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeApicatalogOpenapiEndpointCreateBulk{err: fmt.Errorf("calling to BronzeApicatalogOpenapiEndpointClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeApicatalogOpenapiEndpointCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeApicatalogOpenapiEndpointCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeApicatalogOpenapiEndpoint.
+func (c *BronzeApicatalogOpenapiEndpointClient) Update() *BronzeApicatalogOpenapiEndpointUpdate {
+	mutation := newBronzeApicatalogOpenapiEndpointMutation(c.config, OpUpdate)
+	return &BronzeApicatalogOpenapiEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeApicatalogOpenapiEndpointClient) UpdateOne(_m *BronzeApicatalogOpenapiEndpoint) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	mutation := newBronzeApicatalogOpenapiEndpointMutation(c.config, OpUpdateOne, withBronzeApicatalogOpenapiEndpoint(_m))
+	return &BronzeApicatalogOpenapiEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeApicatalogOpenapiEndpointClient) UpdateOneID(id string) *BronzeApicatalogOpenapiEndpointUpdateOne {
+	mutation := newBronzeApicatalogOpenapiEndpointMutation(c.config, OpUpdateOne, withBronzeApicatalogOpenapiEndpointID(id))
+	return &BronzeApicatalogOpenapiEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeApicatalogOpenapiEndpoint.
+func (c *BronzeApicatalogOpenapiEndpointClient) Delete() *BronzeApicatalogOpenapiEndpointDelete {
+	mutation := newBronzeApicatalogOpenapiEndpointMutation(c.config, OpDelete)
+	return &BronzeApicatalogOpenapiEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeApicatalogOpenapiEndpointClient) DeleteOne(_m *BronzeApicatalogOpenapiEndpoint) *BronzeApicatalogOpenapiEndpointDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeApicatalogOpenapiEndpointClient) DeleteOneID(id string) *BronzeApicatalogOpenapiEndpointDeleteOne {
+	builder := c.Delete().Where(bronzeapicatalogopenapiendpoint.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeApicatalogOpenapiEndpointDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeApicatalogOpenapiEndpoint.
+func (c *BronzeApicatalogOpenapiEndpointClient) Query() *BronzeApicatalogOpenapiEndpointQuery {
+	return &BronzeApicatalogOpenapiEndpointQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeApicatalogOpenapiEndpoint},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeApicatalogOpenapiEndpoint entity by its id.
+func (c *BronzeApicatalogOpenapiEndpointClient) Get(ctx context.Context, id string) (*BronzeApicatalogOpenapiEndpoint, error) {
+	return c.Query().Where(bronzeapicatalogopenapiendpoint.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeApicatalogOpenapiEndpointClient) GetX(ctx context.Context, id string) *BronzeApicatalogOpenapiEndpoint {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeApicatalogOpenapiEndpointClient) Hooks() []Hook {
+	return c.hooks.BronzeApicatalogOpenapiEndpoint
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeApicatalogOpenapiEndpointClient) Interceptors() []Interceptor {
+	return c.inters.BronzeApicatalogOpenapiEndpoint
+}
+
+func (c *BronzeApicatalogOpenapiEndpointClient) mutate(ctx context.Context, m *BronzeApicatalogOpenapiEndpointMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeApicatalogOpenapiEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeApicatalogOpenapiEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeApicatalogOpenapiEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeApicatalogOpenapiEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("apicatalog: unknown BronzeApicatalogOpenapiEndpoint mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		BronzeApicatalogEndpointsRaw []ent.Hook
+		BronzeApicatalogEndpointsRaw, BronzeApicatalogOpenapiEndpoint []ent.Hook
 	}
 	inters struct {
-		BronzeApicatalogEndpointsRaw []ent.Interceptor
+		BronzeApicatalogEndpointsRaw, BronzeApicatalogOpenapiEndpoint []ent.Interceptor
 	}
 )
 
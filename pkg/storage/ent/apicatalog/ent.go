@@ -10,6 +10,7 @@ import (
 	"sync"
 
 	"danny.vn/hotpot/pkg/storage/ent/apicatalog/bronzeapicatalogendpointsraw"
+	"danny.vn/hotpot/pkg/storage/ent/apicatalog/bronzeapicatalogopenapiendpoint"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -73,7 +74,8 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			bronzeapicatalogendpointsraw.Table: bronzeapicatalogendpointsraw.ValidColumn,
+			bronzeapicatalogendpointsraw.Table:    bronzeapicatalogendpointsraw.ValidColumn,
+			bronzeapicatalogopenapiendpoint.Table: bronzeapicatalogopenapiendpoint.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
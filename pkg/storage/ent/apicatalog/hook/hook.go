@@ -21,6 +21,18 @@ func (f BronzeApicatalogEndpointsRawFunc) Mutate(ctx context.Context, m apicatal
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *apicatalog.BronzeApicatalogEndpointsRawMutation", m)
 }
 
+// The BronzeApicatalogOpenapiEndpointFunc type is an adapter to allow the use of ordinary
+// function as BronzeApicatalogOpenapiEndpoint mutator.
+type BronzeApicatalogOpenapiEndpointFunc func(context.Context, *apicatalog.BronzeApicatalogOpenapiEndpointMutation) (apicatalog.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeApicatalogOpenapiEndpointFunc) Mutate(ctx context.Context, m apicatalog.Mutation) (apicatalog.Value, error) {
+	if mv, ok := m.(*apicatalog.BronzeApicatalogOpenapiEndpointMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *apicatalog.BronzeApicatalogOpenapiEndpointMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, apicatalog.Mutation) bool
 
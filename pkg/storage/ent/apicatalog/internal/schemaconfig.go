@@ -7,7 +7,8 @@ import "context"
 // SchemaConfig represents alternative schema names for all tables
 // that can be passed at runtime.
 type SchemaConfig struct {
-	BronzeApicatalogEndpointsRaw string // BronzeApicatalogEndpointsRaw table.
+	BronzeApicatalogEndpointsRaw    string // BronzeApicatalogEndpointsRaw table.
+	BronzeApicatalogOpenapiEndpoint string // BronzeApicatalogOpenapiEndpoint table.
 }
 
 type schemaCtxKey struct{}
@@ -32,9 +32,9 @@ var (
 		PrimaryKey: []*schema.Column{ApicatalogEndpointsRawColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "bronzeapicatalogendpointsraw_uri_method_route_status",
+				Name:    "bronzeapicatalogendpointsraw_name_upstream_uri_method_route_status",
 				Unique:  true,
-				Columns: []*schema.Column{ApicatalogEndpointsRawColumns[7], ApicatalogEndpointsRawColumns[8], ApicatalogEndpointsRawColumns[9]},
+				Columns: []*schema.Column{ApicatalogEndpointsRawColumns[4], ApicatalogEndpointsRawColumns[6], ApicatalogEndpointsRawColumns[7], ApicatalogEndpointsRawColumns[8], ApicatalogEndpointsRawColumns[9]},
 			},
 			{
 				Name:    "bronzeapicatalogendpointsraw_upstream",
@@ -48,9 +48,47 @@ var (
 			},
 		},
 	}
+	// ApicatalogOpenapiEndpointsColumns holds the columns for the "apicatalog_openapi_endpoints" table.
+	ApicatalogOpenapiEndpointsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "spec_name", Type: field.TypeString},
+		{Name: "spec_version", Type: field.TypeString, Nullable: true},
+		{Name: "openapi_version", Type: field.TypeString, Nullable: true},
+		{Name: "log_source_id", Type: field.TypeString, Nullable: true},
+		{Name: "uri", Type: field.TypeString},
+		{Name: "method", Type: field.TypeString},
+		{Name: "operation_id", Type: field.TypeString, Nullable: true},
+		{Name: "summary", Type: field.TypeString, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "security_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "access_level", Type: field.TypeString, Default: ""},
+		{Name: "deprecated", Type: field.TypeBool, Default: false},
+		{Name: "source_file", Type: field.TypeString, Nullable: true},
+	}
+	// ApicatalogOpenapiEndpointsTable holds the schema information for the "apicatalog_openapi_endpoints" table.
+	ApicatalogOpenapiEndpointsTable = &schema.Table{
+		Name:       "apicatalog_openapi_endpoints",
+		Columns:    ApicatalogOpenapiEndpointsColumns,
+		PrimaryKey: []*schema.Column{ApicatalogOpenapiEndpointsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzeapicatalogopenapiendpoint_spec_name",
+				Unique:  false,
+				Columns: []*schema.Column{ApicatalogOpenapiEndpointsColumns[3]},
+			},
+			{
+				Name:    "bronzeapicatalogopenapiendpoint_uri",
+				Unique:  false,
+				Columns: []*schema.Column{ApicatalogOpenapiEndpointsColumns[7]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ApicatalogEndpointsRawTable,
+		ApicatalogOpenapiEndpointsTable,
 	}
 )
 
@@ -58,4 +96,7 @@ func init() {
 	ApicatalogEndpointsRawTable.Annotation = &entsql.Annotation{
 		Table: "apicatalog_endpoints_raw",
 	}
+	ApicatalogOpenapiEndpointsTable.Annotation = &entsql.Annotation{
+		Table: "apicatalog_openapi_endpoints",
+	}
 }
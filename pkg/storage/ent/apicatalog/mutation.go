@@ -4,12 +4,14 @@ package apicatalog
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sync"
 	"time"
 
 	"danny.vn/hotpot/pkg/storage/ent/apicatalog/bronzeapicatalogendpointsraw"
+	"danny.vn/hotpot/pkg/storage/ent/apicatalog/bronzeapicatalogopenapiendpoint"
 	"danny.vn/hotpot/pkg/storage/ent/apicatalog/predicate"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -24,7 +26,8 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeBronzeApicatalogEndpointsRaw = "BronzeApicatalogEndpointsRaw"
+	TypeBronzeApicatalogEndpointsRaw    = "BronzeApicatalogEndpointsRaw"
+	TypeBronzeApicatalogOpenapiEndpoint = "BronzeApicatalogOpenapiEndpoint"
 )
 
 // BronzeApicatalogEndpointsRawMutation represents an operation that mutates the BronzeApicatalogEndpointsRaw nodes in the graph.
@@ -1088,3 +1091,1280 @@ func (m *BronzeApicatalogEndpointsRawMutation) ClearEdge(name string) error {
 func (m *BronzeApicatalogEndpointsRawMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown BronzeApicatalogEndpointsRaw edge %s", name)
 }
+
+// BronzeApicatalogOpenapiEndpointMutation represents an operation that mutates the BronzeApicatalogOpenapiEndpoint nodes in the graph.
+type BronzeApicatalogOpenapiEndpointMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *string
+	collected_at        *time.Time
+	first_collected_at  *time.Time
+	spec_name           *string
+	spec_version        *string
+	openapi_version     *string
+	log_source_id       *string
+	uri                 *string
+	method              *string
+	operation_id        *string
+	summary             *string
+	tags_json           *[]string
+	appendtags_json     []string
+	security_json       *json.RawMessage
+	appendsecurity_json json.RawMessage
+	access_level        *string
+	deprecated          *bool
+	source_file         *string
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*BronzeApicatalogOpenapiEndpoint, error)
+	predicates          []predicate.BronzeApicatalogOpenapiEndpoint
+}
+
+var _ ent.Mutation = (*BronzeApicatalogOpenapiEndpointMutation)(nil)
+
+// bronzeapicatalogopenapiendpointOption allows management of the mutation configuration using functional options.
+type bronzeapicatalogopenapiendpointOption func(*BronzeApicatalogOpenapiEndpointMutation)
+
+// newBronzeApicatalogOpenapiEndpointMutation creates new mutation for the BronzeApicatalogOpenapiEndpoint entity.
+func newBronzeApicatalogOpenapiEndpointMutation(c config, op Op, opts ...bronzeapicatalogopenapiendpointOption) *BronzeApicatalogOpenapiEndpointMutation {
+	m := &BronzeApicatalogOpenapiEndpointMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeApicatalogOpenapiEndpoint,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeApicatalogOpenapiEndpointID sets the ID field of the mutation.
+func withBronzeApicatalogOpenapiEndpointID(id string) bronzeapicatalogopenapiendpointOption {
+	return func(m *BronzeApicatalogOpenapiEndpointMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeApicatalogOpenapiEndpoint
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeApicatalogOpenapiEndpoint, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeApicatalogOpenapiEndpoint.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeApicatalogOpenapiEndpoint sets the old BronzeApicatalogOpenapiEndpoint of the mutation.
+func withBronzeApicatalogOpenapiEndpoint(node *BronzeApicatalogOpenapiEndpoint) bronzeapicatalogopenapiendpointOption {
+	return func(m *BronzeApicatalogOpenapiEndpointMutation) {
+		m.oldValue = func(context.Context) (*BronzeApicatalogOpenapiEndpoint, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeApicatalogOpenapiEndpointMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeApicatalogOpenapiEndpointMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("apicatalog: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeApicatalogOpenapiEndpoint entities.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeApicatalogOpenapiEndpoint.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeApicatalogOpenapiEndpoint entity.
+// If the BronzeApicatalogOpenapiEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeApicatalogOpenapiEndpoint entity.
+// If the BronzeApicatalogOpenapiEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetSpecName sets the "spec_name" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SetSpecName(s string) {
+	m.spec_name = &s
+}
+
+// SpecName returns the value of the "spec_name" field in the mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SpecName() (r string, exists bool) {
+	v := m.spec_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpecName returns the old "spec_name" field's value of the BronzeApicatalogOpenapiEndpoint entity.
+// If the BronzeApicatalogOpenapiEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OldSpecName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpecName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpecName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpecName: %w", err)
+	}
+	return oldValue.SpecName, nil
+}
+
+// ResetSpecName resets all changes to the "spec_name" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ResetSpecName() {
+	m.spec_name = nil
+}
+
+// SetSpecVersion sets the "spec_version" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SetSpecVersion(s string) {
+	m.spec_version = &s
+}
+
+// SpecVersion returns the value of the "spec_version" field in the mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SpecVersion() (r string, exists bool) {
+	v := m.spec_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpecVersion returns the old "spec_version" field's value of the BronzeApicatalogOpenapiEndpoint entity.
+// If the BronzeApicatalogOpenapiEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OldSpecVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpecVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpecVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpecVersion: %w", err)
+	}
+	return oldValue.SpecVersion, nil
+}
+
+// ClearSpecVersion clears the value of the "spec_version" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ClearSpecVersion() {
+	m.spec_version = nil
+	m.clearedFields[bronzeapicatalogopenapiendpoint.FieldSpecVersion] = struct{}{}
+}
+
+// SpecVersionCleared returns if the "spec_version" field was cleared in this mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SpecVersionCleared() bool {
+	_, ok := m.clearedFields[bronzeapicatalogopenapiendpoint.FieldSpecVersion]
+	return ok
+}
+
+// ResetSpecVersion resets all changes to the "spec_version" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ResetSpecVersion() {
+	m.spec_version = nil
+	delete(m.clearedFields, bronzeapicatalogopenapiendpoint.FieldSpecVersion)
+}
+
+// SetOpenapiVersion sets the "openapi_version" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SetOpenapiVersion(s string) {
+	m.openapi_version = &s
+}
+
+// OpenapiVersion returns the value of the "openapi_version" field in the mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OpenapiVersion() (r string, exists bool) {
+	v := m.openapi_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpenapiVersion returns the old "openapi_version" field's value of the BronzeApicatalogOpenapiEndpoint entity.
+// If the BronzeApicatalogOpenapiEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OldOpenapiVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpenapiVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpenapiVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpenapiVersion: %w", err)
+	}
+	return oldValue.OpenapiVersion, nil
+}
+
+// ClearOpenapiVersion clears the value of the "openapi_version" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ClearOpenapiVersion() {
+	m.openapi_version = nil
+	m.clearedFields[bronzeapicatalogopenapiendpoint.FieldOpenapiVersion] = struct{}{}
+}
+
+// OpenapiVersionCleared returns if the "openapi_version" field was cleared in this mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OpenapiVersionCleared() bool {
+	_, ok := m.clearedFields[bronzeapicatalogopenapiendpoint.FieldOpenapiVersion]
+	return ok
+}
+
+// ResetOpenapiVersion resets all changes to the "openapi_version" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ResetOpenapiVersion() {
+	m.openapi_version = nil
+	delete(m.clearedFields, bronzeapicatalogopenapiendpoint.FieldOpenapiVersion)
+}
+
+// SetLogSourceID sets the "log_source_id" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SetLogSourceID(s string) {
+	m.log_source_id = &s
+}
+
+// LogSourceID returns the value of the "log_source_id" field in the mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) LogSourceID() (r string, exists bool) {
+	v := m.log_source_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogSourceID returns the old "log_source_id" field's value of the BronzeApicatalogOpenapiEndpoint entity.
+// If the BronzeApicatalogOpenapiEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OldLogSourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogSourceID: %w", err)
+	}
+	return oldValue.LogSourceID, nil
+}
+
+// ClearLogSourceID clears the value of the "log_source_id" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ClearLogSourceID() {
+	m.log_source_id = nil
+	m.clearedFields[bronzeapicatalogopenapiendpoint.FieldLogSourceID] = struct{}{}
+}
+
+// LogSourceIDCleared returns if the "log_source_id" field was cleared in this mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) LogSourceIDCleared() bool {
+	_, ok := m.clearedFields[bronzeapicatalogopenapiendpoint.FieldLogSourceID]
+	return ok
+}
+
+// ResetLogSourceID resets all changes to the "log_source_id" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ResetLogSourceID() {
+	m.log_source_id = nil
+	delete(m.clearedFields, bronzeapicatalogopenapiendpoint.FieldLogSourceID)
+}
+
+// SetURI sets the "uri" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SetURI(s string) {
+	m.uri = &s
+}
+
+// URI returns the value of the "uri" field in the mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) URI() (r string, exists bool) {
+	v := m.uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURI returns the old "uri" field's value of the BronzeApicatalogOpenapiEndpoint entity.
+// If the BronzeApicatalogOpenapiEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OldURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURI: %w", err)
+	}
+	return oldValue.URI, nil
+}
+
+// ResetURI resets all changes to the "uri" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ResetURI() {
+	m.uri = nil
+}
+
+// SetMethod sets the "method" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SetMethod(s string) {
+	m.method = &s
+}
+
+// Method returns the value of the "method" field in the mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) Method() (r string, exists bool) {
+	v := m.method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMethod returns the old "method" field's value of the BronzeApicatalogOpenapiEndpoint entity.
+// If the BronzeApicatalogOpenapiEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OldMethod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
+	}
+	return oldValue.Method, nil
+}
+
+// ResetMethod resets all changes to the "method" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ResetMethod() {
+	m.method = nil
+}
+
+// SetOperationID sets the "operation_id" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SetOperationID(s string) {
+	m.operation_id = &s
+}
+
+// OperationID returns the value of the "operation_id" field in the mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OperationID() (r string, exists bool) {
+	v := m.operation_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOperationID returns the old "operation_id" field's value of the BronzeApicatalogOpenapiEndpoint entity.
+// If the BronzeApicatalogOpenapiEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OldOperationID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperationID: %w", err)
+	}
+	return oldValue.OperationID, nil
+}
+
+// ClearOperationID clears the value of the "operation_id" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ClearOperationID() {
+	m.operation_id = nil
+	m.clearedFields[bronzeapicatalogopenapiendpoint.FieldOperationID] = struct{}{}
+}
+
+// OperationIDCleared returns if the "operation_id" field was cleared in this mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OperationIDCleared() bool {
+	_, ok := m.clearedFields[bronzeapicatalogopenapiendpoint.FieldOperationID]
+	return ok
+}
+
+// ResetOperationID resets all changes to the "operation_id" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ResetOperationID() {
+	m.operation_id = nil
+	delete(m.clearedFields, bronzeapicatalogopenapiendpoint.FieldOperationID)
+}
+
+// SetSummary sets the "summary" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SetSummary(s string) {
+	m.summary = &s
+}
+
+// Summary returns the value of the "summary" field in the mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) Summary() (r string, exists bool) {
+	v := m.summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummary returns the old "summary" field's value of the BronzeApicatalogOpenapiEndpoint entity.
+// If the BronzeApicatalogOpenapiEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OldSummary(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+	}
+	return oldValue.Summary, nil
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ClearSummary() {
+	m.summary = nil
+	m.clearedFields[bronzeapicatalogopenapiendpoint.FieldSummary] = struct{}{}
+}
+
+// SummaryCleared returns if the "summary" field was cleared in this mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SummaryCleared() bool {
+	_, ok := m.clearedFields[bronzeapicatalogopenapiendpoint.FieldSummary]
+	return ok
+}
+
+// ResetSummary resets all changes to the "summary" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ResetSummary() {
+	m.summary = nil
+	delete(m.clearedFields, bronzeapicatalogopenapiendpoint.FieldSummary)
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SetTagsJSON(s []string) {
+	m.tags_json = &s
+	m.appendtags_json = nil
+}
+
+// TagsJSON returns the value of the "tags_json" field in the mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) TagsJSON() (r []string, exists bool) {
+	v := m.tags_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagsJSON returns the old "tags_json" field's value of the BronzeApicatalogOpenapiEndpoint entity.
+// If the BronzeApicatalogOpenapiEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OldTagsJSON(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagsJSON: %w", err)
+	}
+	return oldValue.TagsJSON, nil
+}
+
+// AppendTagsJSON adds s to the "tags_json" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) AppendTagsJSON(s []string) {
+	m.appendtags_json = append(m.appendtags_json, s...)
+}
+
+// AppendedTagsJSON returns the list of values that were appended to the "tags_json" field in this mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) AppendedTagsJSON() ([]string, bool) {
+	if len(m.appendtags_json) == 0 {
+		return nil, false
+	}
+	return m.appendtags_json, true
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ClearTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	m.clearedFields[bronzeapicatalogopenapiendpoint.FieldTagsJSON] = struct{}{}
+}
+
+// TagsJSONCleared returns if the "tags_json" field was cleared in this mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) TagsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeapicatalogopenapiendpoint.FieldTagsJSON]
+	return ok
+}
+
+// ResetTagsJSON resets all changes to the "tags_json" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ResetTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	delete(m.clearedFields, bronzeapicatalogopenapiendpoint.FieldTagsJSON)
+}
+
+// SetSecurityJSON sets the "security_json" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SetSecurityJSON(jm json.RawMessage) {
+	m.security_json = &jm
+	m.appendsecurity_json = nil
+}
+
+// SecurityJSON returns the value of the "security_json" field in the mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SecurityJSON() (r json.RawMessage, exists bool) {
+	v := m.security_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecurityJSON returns the old "security_json" field's value of the BronzeApicatalogOpenapiEndpoint entity.
+// If the BronzeApicatalogOpenapiEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OldSecurityJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecurityJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecurityJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecurityJSON: %w", err)
+	}
+	return oldValue.SecurityJSON, nil
+}
+
+// AppendSecurityJSON adds jm to the "security_json" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) AppendSecurityJSON(jm json.RawMessage) {
+	m.appendsecurity_json = append(m.appendsecurity_json, jm...)
+}
+
+// AppendedSecurityJSON returns the list of values that were appended to the "security_json" field in this mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) AppendedSecurityJSON() (json.RawMessage, bool) {
+	if len(m.appendsecurity_json) == 0 {
+		return nil, false
+	}
+	return m.appendsecurity_json, true
+}
+
+// ClearSecurityJSON clears the value of the "security_json" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ClearSecurityJSON() {
+	m.security_json = nil
+	m.appendsecurity_json = nil
+	m.clearedFields[bronzeapicatalogopenapiendpoint.FieldSecurityJSON] = struct{}{}
+}
+
+// SecurityJSONCleared returns if the "security_json" field was cleared in this mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SecurityJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeapicatalogopenapiendpoint.FieldSecurityJSON]
+	return ok
+}
+
+// ResetSecurityJSON resets all changes to the "security_json" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ResetSecurityJSON() {
+	m.security_json = nil
+	m.appendsecurity_json = nil
+	delete(m.clearedFields, bronzeapicatalogopenapiendpoint.FieldSecurityJSON)
+}
+
+// SetAccessLevel sets the "access_level" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SetAccessLevel(s string) {
+	m.access_level = &s
+}
+
+// AccessLevel returns the value of the "access_level" field in the mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) AccessLevel() (r string, exists bool) {
+	v := m.access_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessLevel returns the old "access_level" field's value of the BronzeApicatalogOpenapiEndpoint entity.
+// If the BronzeApicatalogOpenapiEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OldAccessLevel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessLevel: %w", err)
+	}
+	return oldValue.AccessLevel, nil
+}
+
+// ResetAccessLevel resets all changes to the "access_level" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ResetAccessLevel() {
+	m.access_level = nil
+}
+
+// SetDeprecated sets the "deprecated" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SetDeprecated(b bool) {
+	m.deprecated = &b
+}
+
+// Deprecated returns the value of the "deprecated" field in the mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) Deprecated() (r bool, exists bool) {
+	v := m.deprecated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeprecated returns the old "deprecated" field's value of the BronzeApicatalogOpenapiEndpoint entity.
+// If the BronzeApicatalogOpenapiEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OldDeprecated(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeprecated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeprecated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeprecated: %w", err)
+	}
+	return oldValue.Deprecated, nil
+}
+
+// ResetDeprecated resets all changes to the "deprecated" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ResetDeprecated() {
+	m.deprecated = nil
+}
+
+// SetSourceFile sets the "source_file" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SetSourceFile(s string) {
+	m.source_file = &s
+}
+
+// SourceFile returns the value of the "source_file" field in the mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SourceFile() (r string, exists bool) {
+	v := m.source_file
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceFile returns the old "source_file" field's value of the BronzeApicatalogOpenapiEndpoint entity.
+// If the BronzeApicatalogOpenapiEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OldSourceFile(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceFile is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceFile requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceFile: %w", err)
+	}
+	return oldValue.SourceFile, nil
+}
+
+// ClearSourceFile clears the value of the "source_file" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ClearSourceFile() {
+	m.source_file = nil
+	m.clearedFields[bronzeapicatalogopenapiendpoint.FieldSourceFile] = struct{}{}
+}
+
+// SourceFileCleared returns if the "source_file" field was cleared in this mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SourceFileCleared() bool {
+	_, ok := m.clearedFields[bronzeapicatalogopenapiendpoint.FieldSourceFile]
+	return ok
+}
+
+// ResetSourceFile resets all changes to the "source_file" field.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ResetSourceFile() {
+	m.source_file = nil
+	delete(m.clearedFields, bronzeapicatalogopenapiendpoint.FieldSourceFile)
+}
+
+// Where appends a list predicates to the BronzeApicatalogOpenapiEndpointMutation builder.
+func (m *BronzeApicatalogOpenapiEndpointMutation) Where(ps ...predicate.BronzeApicatalogOpenapiEndpoint) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeApicatalogOpenapiEndpointMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeApicatalogOpenapiEndpointMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeApicatalogOpenapiEndpoint, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeApicatalogOpenapiEndpointMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeApicatalogOpenapiEndpoint).
+func (m *BronzeApicatalogOpenapiEndpointMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeApicatalogOpenapiEndpointMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.collected_at != nil {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldFirstCollectedAt)
+	}
+	if m.spec_name != nil {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldSpecName)
+	}
+	if m.spec_version != nil {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldSpecVersion)
+	}
+	if m.openapi_version != nil {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldOpenapiVersion)
+	}
+	if m.log_source_id != nil {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldLogSourceID)
+	}
+	if m.uri != nil {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldURI)
+	}
+	if m.method != nil {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldMethod)
+	}
+	if m.operation_id != nil {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldOperationID)
+	}
+	if m.summary != nil {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldSummary)
+	}
+	if m.tags_json != nil {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldTagsJSON)
+	}
+	if m.security_json != nil {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldSecurityJSON)
+	}
+	if m.access_level != nil {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldAccessLevel)
+	}
+	if m.deprecated != nil {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldDeprecated)
+	}
+	if m.source_file != nil {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldSourceFile)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeApicatalogOpenapiEndpointMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzeapicatalogopenapiendpoint.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzeapicatalogopenapiendpoint.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzeapicatalogopenapiendpoint.FieldSpecName:
+		return m.SpecName()
+	case bronzeapicatalogopenapiendpoint.FieldSpecVersion:
+		return m.SpecVersion()
+	case bronzeapicatalogopenapiendpoint.FieldOpenapiVersion:
+		return m.OpenapiVersion()
+	case bronzeapicatalogopenapiendpoint.FieldLogSourceID:
+		return m.LogSourceID()
+	case bronzeapicatalogopenapiendpoint.FieldURI:
+		return m.URI()
+	case bronzeapicatalogopenapiendpoint.FieldMethod:
+		return m.Method()
+	case bronzeapicatalogopenapiendpoint.FieldOperationID:
+		return m.OperationID()
+	case bronzeapicatalogopenapiendpoint.FieldSummary:
+		return m.Summary()
+	case bronzeapicatalogopenapiendpoint.FieldTagsJSON:
+		return m.TagsJSON()
+	case bronzeapicatalogopenapiendpoint.FieldSecurityJSON:
+		return m.SecurityJSON()
+	case bronzeapicatalogopenapiendpoint.FieldAccessLevel:
+		return m.AccessLevel()
+	case bronzeapicatalogopenapiendpoint.FieldDeprecated:
+		return m.Deprecated()
+	case bronzeapicatalogopenapiendpoint.FieldSourceFile:
+		return m.SourceFile()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeApicatalogOpenapiEndpointMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzeapicatalogopenapiendpoint.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzeapicatalogopenapiendpoint.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzeapicatalogopenapiendpoint.FieldSpecName:
+		return m.OldSpecName(ctx)
+	case bronzeapicatalogopenapiendpoint.FieldSpecVersion:
+		return m.OldSpecVersion(ctx)
+	case bronzeapicatalogopenapiendpoint.FieldOpenapiVersion:
+		return m.OldOpenapiVersion(ctx)
+	case bronzeapicatalogopenapiendpoint.FieldLogSourceID:
+		return m.OldLogSourceID(ctx)
+	case bronzeapicatalogopenapiendpoint.FieldURI:
+		return m.OldURI(ctx)
+	case bronzeapicatalogopenapiendpoint.FieldMethod:
+		return m.OldMethod(ctx)
+	case bronzeapicatalogopenapiendpoint.FieldOperationID:
+		return m.OldOperationID(ctx)
+	case bronzeapicatalogopenapiendpoint.FieldSummary:
+		return m.OldSummary(ctx)
+	case bronzeapicatalogopenapiendpoint.FieldTagsJSON:
+		return m.OldTagsJSON(ctx)
+	case bronzeapicatalogopenapiendpoint.FieldSecurityJSON:
+		return m.OldSecurityJSON(ctx)
+	case bronzeapicatalogopenapiendpoint.FieldAccessLevel:
+		return m.OldAccessLevel(ctx)
+	case bronzeapicatalogopenapiendpoint.FieldDeprecated:
+		return m.OldDeprecated(ctx)
+	case bronzeapicatalogopenapiendpoint.FieldSourceFile:
+		return m.OldSourceFile(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeApicatalogOpenapiEndpoint field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeApicatalogOpenapiEndpointMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzeapicatalogopenapiendpoint.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldSpecName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpecName(v)
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldSpecVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpecVersion(v)
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldOpenapiVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpenapiVersion(v)
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldLogSourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogSourceID(v)
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURI(v)
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldMethod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMethod(v)
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldOperationID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperationID(v)
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummary(v)
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldTagsJSON:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagsJSON(v)
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldSecurityJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecurityJSON(v)
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldAccessLevel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessLevel(v)
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldDeprecated:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeprecated(v)
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldSourceFile:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceFile(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeApicatalogOpenapiEndpoint field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeApicatalogOpenapiEndpointMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeApicatalogOpenapiEndpointMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeApicatalogOpenapiEndpoint numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzeapicatalogopenapiendpoint.FieldSpecVersion) {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldSpecVersion)
+	}
+	if m.FieldCleared(bronzeapicatalogopenapiendpoint.FieldOpenapiVersion) {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldOpenapiVersion)
+	}
+	if m.FieldCleared(bronzeapicatalogopenapiendpoint.FieldLogSourceID) {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldLogSourceID)
+	}
+	if m.FieldCleared(bronzeapicatalogopenapiendpoint.FieldOperationID) {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldOperationID)
+	}
+	if m.FieldCleared(bronzeapicatalogopenapiendpoint.FieldSummary) {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldSummary)
+	}
+	if m.FieldCleared(bronzeapicatalogopenapiendpoint.FieldTagsJSON) {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldTagsJSON)
+	}
+	if m.FieldCleared(bronzeapicatalogopenapiendpoint.FieldSecurityJSON) {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldSecurityJSON)
+	}
+	if m.FieldCleared(bronzeapicatalogopenapiendpoint.FieldSourceFile) {
+		fields = append(fields, bronzeapicatalogopenapiendpoint.FieldSourceFile)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ClearField(name string) error {
+	switch name {
+	case bronzeapicatalogopenapiendpoint.FieldSpecVersion:
+		m.ClearSpecVersion()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldOpenapiVersion:
+		m.ClearOpenapiVersion()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldLogSourceID:
+		m.ClearLogSourceID()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldOperationID:
+		m.ClearOperationID()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldSummary:
+		m.ClearSummary()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldTagsJSON:
+		m.ClearTagsJSON()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldSecurityJSON:
+		m.ClearSecurityJSON()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldSourceFile:
+		m.ClearSourceFile()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeApicatalogOpenapiEndpoint nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ResetField(name string) error {
+	switch name {
+	case bronzeapicatalogopenapiendpoint.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldSpecName:
+		m.ResetSpecName()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldSpecVersion:
+		m.ResetSpecVersion()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldOpenapiVersion:
+		m.ResetOpenapiVersion()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldLogSourceID:
+		m.ResetLogSourceID()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldURI:
+		m.ResetURI()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldMethod:
+		m.ResetMethod()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldOperationID:
+		m.ResetOperationID()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldSummary:
+		m.ResetSummary()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldTagsJSON:
+		m.ResetTagsJSON()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldSecurityJSON:
+		m.ResetSecurityJSON()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldAccessLevel:
+		m.ResetAccessLevel()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldDeprecated:
+		m.ResetDeprecated()
+		return nil
+	case bronzeapicatalogopenapiendpoint.FieldSourceFile:
+		m.ResetSourceFile()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeApicatalogOpenapiEndpoint field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeApicatalogOpenapiEndpointMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeApicatalogOpenapiEndpoint unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeApicatalogOpenapiEndpointMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeApicatalogOpenapiEndpoint edge %s", name)
+}
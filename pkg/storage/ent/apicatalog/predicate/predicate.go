@@ -8,3 +8,6 @@ import (
 
 // BronzeApicatalogEndpointsRaw is the predicate function for bronzeapicatalogendpointsraw builders.
 type BronzeApicatalogEndpointsRaw func(*sql.Selector)
+
+// BronzeApicatalogOpenapiEndpoint is the predicate function for bronzeapicatalogopenapiendpoint builders.
+type BronzeApicatalogOpenapiEndpoint func(*sql.Selector)
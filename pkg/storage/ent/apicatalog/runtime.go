@@ -4,6 +4,7 @@ package apicatalog
 
 import (
 	"danny.vn/hotpot/pkg/storage/ent/apicatalog/bronzeapicatalogendpointsraw"
+	"danny.vn/hotpot/pkg/storage/ent/apicatalog/bronzeapicatalogopenapiendpoint"
 	"danny.vn/hotpot/pkg/storage/ent/apicatalog/schema"
 )
 
@@ -25,4 +26,26 @@ func init() {
 	bronzeapicatalogendpointsrawDescRouteStatus := bronzeapicatalogendpointsrawFields[7].Descriptor()
 	// bronzeapicatalogendpointsraw.DefaultRouteStatus holds the default value on creation for the route_status field.
 	bronzeapicatalogendpointsraw.DefaultRouteStatus = bronzeapicatalogendpointsrawDescRouteStatus.Default.(string)
+	bronzeapicatalogopenapiendpointFields := schema.BronzeApicatalogOpenapiEndpoint{}.Fields()
+	_ = bronzeapicatalogopenapiendpointFields
+	// bronzeapicatalogopenapiendpointDescSpecName is the schema descriptor for spec_name field.
+	bronzeapicatalogopenapiendpointDescSpecName := bronzeapicatalogopenapiendpointFields[1].Descriptor()
+	// bronzeapicatalogopenapiendpoint.SpecNameValidator is a validator for the "spec_name" field. It is called by the builders before save.
+	bronzeapicatalogopenapiendpoint.SpecNameValidator = bronzeapicatalogopenapiendpointDescSpecName.Validators[0].(func(string) error)
+	// bronzeapicatalogopenapiendpointDescURI is the schema descriptor for uri field.
+	bronzeapicatalogopenapiendpointDescURI := bronzeapicatalogopenapiendpointFields[5].Descriptor()
+	// bronzeapicatalogopenapiendpoint.URIValidator is a validator for the "uri" field. It is called by the builders before save.
+	bronzeapicatalogopenapiendpoint.URIValidator = bronzeapicatalogopenapiendpointDescURI.Validators[0].(func(string) error)
+	// bronzeapicatalogopenapiendpointDescMethod is the schema descriptor for method field.
+	bronzeapicatalogopenapiendpointDescMethod := bronzeapicatalogopenapiendpointFields[6].Descriptor()
+	// bronzeapicatalogopenapiendpoint.MethodValidator is a validator for the "method" field. It is called by the builders before save.
+	bronzeapicatalogopenapiendpoint.MethodValidator = bronzeapicatalogopenapiendpointDescMethod.Validators[0].(func(string) error)
+	// bronzeapicatalogopenapiendpointDescAccessLevel is the schema descriptor for access_level field.
+	bronzeapicatalogopenapiendpointDescAccessLevel := bronzeapicatalogopenapiendpointFields[11].Descriptor()
+	// bronzeapicatalogopenapiendpoint.DefaultAccessLevel holds the default value on creation for the access_level field.
+	bronzeapicatalogopenapiendpoint.DefaultAccessLevel = bronzeapicatalogopenapiendpointDescAccessLevel.Default.(string)
+	// bronzeapicatalogopenapiendpointDescDeprecated is the schema descriptor for deprecated field.
+	bronzeapicatalogopenapiendpointDescDeprecated := bronzeapicatalogopenapiendpointFields[12].Descriptor()
+	// bronzeapicatalogopenapiendpoint.DefaultDeprecated holds the default value on creation for the deprecated field.
+	bronzeapicatalogopenapiendpoint.DefaultDeprecated = bronzeapicatalogopenapiendpointDescDeprecated.Default.(bool)
 }
@@ -8,3 +8,7 @@ import (
 type BronzeApicatalogEndpointsRaw struct {
 	bronze_apicatalog.BronzeApicatalogEndpointsRaw
 }
+
+type BronzeApicatalogOpenapiEndpoint struct {
+	bronze_apicatalog.BronzeApicatalogOpenapiEndpoint
+}
@@ -4,6 +4,7 @@ package apicatalog
 // DefaultSchemaConfig returns the schema config mapping each type to its PG schema.
 func DefaultSchemaConfig() SchemaConfig {
 	return SchemaConfig{
-		BronzeApicatalogEndpointsRaw: "bronze",
+		BronzeApicatalogEndpointsRaw:    "bronze",
+		BronzeApicatalogOpenapiEndpoint: "bronze",
 	}
 }
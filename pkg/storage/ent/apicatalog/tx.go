@@ -14,6 +14,8 @@ type Tx struct {
 	config
 	// BronzeApicatalogEndpointsRaw is the client for interacting with the BronzeApicatalogEndpointsRaw builders.
 	BronzeApicatalogEndpointsRaw *BronzeApicatalogEndpointsRawClient
+	// BronzeApicatalogOpenapiEndpoint is the client for interacting with the BronzeApicatalogOpenapiEndpoint builders.
+	BronzeApicatalogOpenapiEndpoint *BronzeApicatalogOpenapiEndpointClient
 
 	// lazily loaded.
 	client     *Client
@@ -146,6 +148,7 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.BronzeApicatalogEndpointsRaw = NewBronzeApicatalogEndpointsRawClient(tx.config)
+	tx.BronzeApicatalogOpenapiEndpoint = NewBronzeApicatalogOpenapiEndpointClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
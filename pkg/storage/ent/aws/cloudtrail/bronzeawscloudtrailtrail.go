@@ -0,0 +1,336 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cloudtrail
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/bronzeawscloudtrailtrail"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeAWSCloudTrailTrail is the model entity for the BronzeAWSCloudTrailTrail schema.
+type BronzeAWSCloudTrailTrail struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Trail ARN, used as primary key
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// S3BucketName holds the value of the "s3_bucket_name" field.
+	S3BucketName string `json:"s3_bucket_name,omitempty"`
+	// S3KeyPrefix holds the value of the "s3_key_prefix" field.
+	S3KeyPrefix string `json:"s3_key_prefix,omitempty"`
+	// SnsTopicArn holds the value of the "sns_topic_arn" field.
+	SnsTopicArn string `json:"sns_topic_arn,omitempty"`
+	// IsMultiRegionTrail holds the value of the "is_multi_region_trail" field.
+	IsMultiRegionTrail bool `json:"is_multi_region_trail,omitempty"`
+	// IsOrganizationTrail holds the value of the "is_organization_trail" field.
+	IsOrganizationTrail bool `json:"is_organization_trail,omitempty"`
+	// IncludeGlobalServiceEvents holds the value of the "include_global_service_events" field.
+	IncludeGlobalServiceEvents bool `json:"include_global_service_events,omitempty"`
+	// LogFileValidationEnabled holds the value of the "log_file_validation_enabled" field.
+	LogFileValidationEnabled bool `json:"log_file_validation_enabled,omitempty"`
+	// KMS key ARN used to encrypt delivered logs, empty for SSE-S3
+	KmsKeyID string `json:"kms_key_id,omitempty"`
+	// CloudWatchLogsLogGroupArn holds the value of the "cloud_watch_logs_log_group_arn" field.
+	CloudWatchLogsLogGroupArn string `json:"cloud_watch_logs_log_group_arn,omitempty"`
+	// CloudWatchLogsRoleArn holds the value of the "cloud_watch_logs_role_arn" field.
+	CloudWatchLogsRoleArn string `json:"cloud_watch_logs_role_arn,omitempty"`
+	// HasCustomEventSelectors holds the value of the "has_custom_event_selectors" field.
+	HasCustomEventSelectors bool `json:"has_custom_event_selectors,omitempty"`
+	// HasInsightSelectors holds the value of the "has_insight_selectors" field.
+	HasInsightSelectors bool `json:"has_insight_selectors,omitempty"`
+	// Whether the trail is currently logging, from GetTrailStatus
+	IsLogging bool `json:"is_logging,omitempty"`
+	// EventSelectorsJSON holds the value of the "event_selectors_json" field.
+	EventSelectorsJSON json.RawMessage `json:"event_selectors_json,omitempty"`
+	// AdvancedEventSelectorsJSON holds the value of the "advanced_event_selectors_json" field.
+	AdvancedEventSelectorsJSON json.RawMessage `json:"advanced_event_selectors_json,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Home region of the trail
+	Region       string `json:"region,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeAWSCloudTrailTrail) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawscloudtrailtrail.FieldEventSelectorsJSON, bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON, bronzeawscloudtrailtrail.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzeawscloudtrailtrail.FieldIsMultiRegionTrail, bronzeawscloudtrailtrail.FieldIsOrganizationTrail, bronzeawscloudtrailtrail.FieldIncludeGlobalServiceEvents, bronzeawscloudtrailtrail.FieldLogFileValidationEnabled, bronzeawscloudtrailtrail.FieldHasCustomEventSelectors, bronzeawscloudtrailtrail.FieldHasInsightSelectors, bronzeawscloudtrailtrail.FieldIsLogging:
+			values[i] = new(sql.NullBool)
+		case bronzeawscloudtrailtrail.FieldID, bronzeawscloudtrailtrail.FieldName, bronzeawscloudtrailtrail.FieldS3BucketName, bronzeawscloudtrailtrail.FieldS3KeyPrefix, bronzeawscloudtrailtrail.FieldSnsTopicArn, bronzeawscloudtrailtrail.FieldKmsKeyID, bronzeawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn, bronzeawscloudtrailtrail.FieldCloudWatchLogsRoleArn, bronzeawscloudtrailtrail.FieldAccountID, bronzeawscloudtrailtrail.FieldRegion:
+			values[i] = new(sql.NullString)
+		case bronzeawscloudtrailtrail.FieldCollectedAt, bronzeawscloudtrailtrail.FieldFirstCollectedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeAWSCloudTrailTrail fields.
+func (_m *BronzeAWSCloudTrailTrail) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawscloudtrailtrail.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzeawscloudtrailtrail.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzeawscloudtrailtrail.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzeawscloudtrailtrail.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzeawscloudtrailtrail.FieldS3BucketName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field s3_bucket_name", values[i])
+			} else if value.Valid {
+				_m.S3BucketName = value.String
+			}
+		case bronzeawscloudtrailtrail.FieldS3KeyPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field s3_key_prefix", values[i])
+			} else if value.Valid {
+				_m.S3KeyPrefix = value.String
+			}
+		case bronzeawscloudtrailtrail.FieldSnsTopicArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sns_topic_arn", values[i])
+			} else if value.Valid {
+				_m.SnsTopicArn = value.String
+			}
+		case bronzeawscloudtrailtrail.FieldIsMultiRegionTrail:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_multi_region_trail", values[i])
+			} else if value.Valid {
+				_m.IsMultiRegionTrail = value.Bool
+			}
+		case bronzeawscloudtrailtrail.FieldIsOrganizationTrail:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_organization_trail", values[i])
+			} else if value.Valid {
+				_m.IsOrganizationTrail = value.Bool
+			}
+		case bronzeawscloudtrailtrail.FieldIncludeGlobalServiceEvents:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field include_global_service_events", values[i])
+			} else if value.Valid {
+				_m.IncludeGlobalServiceEvents = value.Bool
+			}
+		case bronzeawscloudtrailtrail.FieldLogFileValidationEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field log_file_validation_enabled", values[i])
+			} else if value.Valid {
+				_m.LogFileValidationEnabled = value.Bool
+			}
+		case bronzeawscloudtrailtrail.FieldKmsKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kms_key_id", values[i])
+			} else if value.Valid {
+				_m.KmsKeyID = value.String
+			}
+		case bronzeawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cloud_watch_logs_log_group_arn", values[i])
+			} else if value.Valid {
+				_m.CloudWatchLogsLogGroupArn = value.String
+			}
+		case bronzeawscloudtrailtrail.FieldCloudWatchLogsRoleArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cloud_watch_logs_role_arn", values[i])
+			} else if value.Valid {
+				_m.CloudWatchLogsRoleArn = value.String
+			}
+		case bronzeawscloudtrailtrail.FieldHasCustomEventSelectors:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field has_custom_event_selectors", values[i])
+			} else if value.Valid {
+				_m.HasCustomEventSelectors = value.Bool
+			}
+		case bronzeawscloudtrailtrail.FieldHasInsightSelectors:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field has_insight_selectors", values[i])
+			} else if value.Valid {
+				_m.HasInsightSelectors = value.Bool
+			}
+		case bronzeawscloudtrailtrail.FieldIsLogging:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_logging", values[i])
+			} else if value.Valid {
+				_m.IsLogging = value.Bool
+			}
+		case bronzeawscloudtrailtrail.FieldEventSelectorsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field event_selectors_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EventSelectorsJSON); err != nil {
+					return fmt.Errorf("unmarshal field event_selectors_json: %w", err)
+				}
+			}
+		case bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field advanced_event_selectors_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AdvancedEventSelectorsJSON); err != nil {
+					return fmt.Errorf("unmarshal field advanced_event_selectors_json: %w", err)
+				}
+			}
+		case bronzeawscloudtrailtrail.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzeawscloudtrailtrail.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzeawscloudtrailtrail.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeAWSCloudTrailTrail.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeAWSCloudTrailTrail) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeAWSCloudTrailTrail.
+// Note that you need to call BronzeAWSCloudTrailTrail.Unwrap() before calling this method if this BronzeAWSCloudTrailTrail
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeAWSCloudTrailTrail) Update() *BronzeAWSCloudTrailTrailUpdateOne {
+	return NewBronzeAWSCloudTrailTrailClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeAWSCloudTrailTrail entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeAWSCloudTrailTrail) Unwrap() *BronzeAWSCloudTrailTrail {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("cloudtrail: BronzeAWSCloudTrailTrail is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeAWSCloudTrailTrail) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeAWSCloudTrailTrail(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("s3_bucket_name=")
+	builder.WriteString(_m.S3BucketName)
+	builder.WriteString(", ")
+	builder.WriteString("s3_key_prefix=")
+	builder.WriteString(_m.S3KeyPrefix)
+	builder.WriteString(", ")
+	builder.WriteString("sns_topic_arn=")
+	builder.WriteString(_m.SnsTopicArn)
+	builder.WriteString(", ")
+	builder.WriteString("is_multi_region_trail=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsMultiRegionTrail))
+	builder.WriteString(", ")
+	builder.WriteString("is_organization_trail=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsOrganizationTrail))
+	builder.WriteString(", ")
+	builder.WriteString("include_global_service_events=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IncludeGlobalServiceEvents))
+	builder.WriteString(", ")
+	builder.WriteString("log_file_validation_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LogFileValidationEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("kms_key_id=")
+	builder.WriteString(_m.KmsKeyID)
+	builder.WriteString(", ")
+	builder.WriteString("cloud_watch_logs_log_group_arn=")
+	builder.WriteString(_m.CloudWatchLogsLogGroupArn)
+	builder.WriteString(", ")
+	builder.WriteString("cloud_watch_logs_role_arn=")
+	builder.WriteString(_m.CloudWatchLogsRoleArn)
+	builder.WriteString(", ")
+	builder.WriteString("has_custom_event_selectors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HasCustomEventSelectors))
+	builder.WriteString(", ")
+	builder.WriteString("has_insight_selectors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HasInsightSelectors))
+	builder.WriteString(", ")
+	builder.WriteString("is_logging=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsLogging))
+	builder.WriteString(", ")
+	builder.WriteString("event_selectors_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventSelectorsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("advanced_event_selectors_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AdvancedEventSelectorsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeAWSCloudTrailTrails is a parsable slice of BronzeAWSCloudTrailTrail.
+type BronzeAWSCloudTrailTrails []*BronzeAWSCloudTrailTrail
@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawscloudtrailtrail
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzeawscloudtrailtrail type in the database.
+	Label = "bronze_aws_cloud_trail_trail"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldS3BucketName holds the string denoting the s3_bucket_name field in the database.
+	FieldS3BucketName = "s3_bucket_name"
+	// FieldS3KeyPrefix holds the string denoting the s3_key_prefix field in the database.
+	FieldS3KeyPrefix = "s3_key_prefix"
+	// FieldSnsTopicArn holds the string denoting the sns_topic_arn field in the database.
+	FieldSnsTopicArn = "sns_topic_arn"
+	// FieldIsMultiRegionTrail holds the string denoting the is_multi_region_trail field in the database.
+	FieldIsMultiRegionTrail = "is_multi_region_trail"
+	// FieldIsOrganizationTrail holds the string denoting the is_organization_trail field in the database.
+	FieldIsOrganizationTrail = "is_organization_trail"
+	// FieldIncludeGlobalServiceEvents holds the string denoting the include_global_service_events field in the database.
+	FieldIncludeGlobalServiceEvents = "include_global_service_events"
+	// FieldLogFileValidationEnabled holds the string denoting the log_file_validation_enabled field in the database.
+	FieldLogFileValidationEnabled = "log_file_validation_enabled"
+	// FieldKmsKeyID holds the string denoting the kms_key_id field in the database.
+	FieldKmsKeyID = "kms_key_id"
+	// FieldCloudWatchLogsLogGroupArn holds the string denoting the cloud_watch_logs_log_group_arn field in the database.
+	FieldCloudWatchLogsLogGroupArn = "cloud_watch_logs_log_group_arn"
+	// FieldCloudWatchLogsRoleArn holds the string denoting the cloud_watch_logs_role_arn field in the database.
+	FieldCloudWatchLogsRoleArn = "cloud_watch_logs_role_arn"
+	// FieldHasCustomEventSelectors holds the string denoting the has_custom_event_selectors field in the database.
+	FieldHasCustomEventSelectors = "has_custom_event_selectors"
+	// FieldHasInsightSelectors holds the string denoting the has_insight_selectors field in the database.
+	FieldHasInsightSelectors = "has_insight_selectors"
+	// FieldIsLogging holds the string denoting the is_logging field in the database.
+	FieldIsLogging = "is_logging"
+	// FieldEventSelectorsJSON holds the string denoting the event_selectors_json field in the database.
+	FieldEventSelectorsJSON = "event_selectors_json"
+	// FieldAdvancedEventSelectorsJSON holds the string denoting the advanced_event_selectors_json field in the database.
+	FieldAdvancedEventSelectorsJSON = "advanced_event_selectors_json"
+	// FieldTagsJSON holds the string denoting the tags_json field in the database.
+	FieldTagsJSON = "tags_json"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
+	// Table holds the table name of the bronzeawscloudtrailtrail in the database.
+	Table = "aws_cloudtrail_trails"
+)
+
+// Columns holds all SQL columns for bronzeawscloudtrailtrail fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldName,
+	FieldS3BucketName,
+	FieldS3KeyPrefix,
+	FieldSnsTopicArn,
+	FieldIsMultiRegionTrail,
+	FieldIsOrganizationTrail,
+	FieldIncludeGlobalServiceEvents,
+	FieldLogFileValidationEnabled,
+	FieldKmsKeyID,
+	FieldCloudWatchLogsLogGroupArn,
+	FieldCloudWatchLogsRoleArn,
+	FieldHasCustomEventSelectors,
+	FieldHasInsightSelectors,
+	FieldIsLogging,
+	FieldEventSelectorsJSON,
+	FieldAdvancedEventSelectorsJSON,
+	FieldTagsJSON,
+	FieldAccountID,
+	FieldRegion,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	AccountIDValidator func(string) error
+	// RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	RegionValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BronzeAWSCloudTrailTrail queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByS3BucketName orders the results by the s3_bucket_name field.
+func ByS3BucketName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldS3BucketName, opts...).ToFunc()
+}
+
+// ByS3KeyPrefix orders the results by the s3_key_prefix field.
+func ByS3KeyPrefix(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldS3KeyPrefix, opts...).ToFunc()
+}
+
+// BySnsTopicArn orders the results by the sns_topic_arn field.
+func BySnsTopicArn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSnsTopicArn, opts...).ToFunc()
+}
+
+// ByIsMultiRegionTrail orders the results by the is_multi_region_trail field.
+func ByIsMultiRegionTrail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsMultiRegionTrail, opts...).ToFunc()
+}
+
+// ByIsOrganizationTrail orders the results by the is_organization_trail field.
+func ByIsOrganizationTrail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsOrganizationTrail, opts...).ToFunc()
+}
+
+// ByIncludeGlobalServiceEvents orders the results by the include_global_service_events field.
+func ByIncludeGlobalServiceEvents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncludeGlobalServiceEvents, opts...).ToFunc()
+}
+
+// ByLogFileValidationEnabled orders the results by the log_file_validation_enabled field.
+func ByLogFileValidationEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogFileValidationEnabled, opts...).ToFunc()
+}
+
+// ByKmsKeyID orders the results by the kms_key_id field.
+func ByKmsKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKmsKeyID, opts...).ToFunc()
+}
+
+// ByCloudWatchLogsLogGroupArn orders the results by the cloud_watch_logs_log_group_arn field.
+func ByCloudWatchLogsLogGroupArn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCloudWatchLogsLogGroupArn, opts...).ToFunc()
+}
+
+// ByCloudWatchLogsRoleArn orders the results by the cloud_watch_logs_role_arn field.
+func ByCloudWatchLogsRoleArn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCloudWatchLogsRoleArn, opts...).ToFunc()
+}
+
+// ByHasCustomEventSelectors orders the results by the has_custom_event_selectors field.
+func ByHasCustomEventSelectors(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHasCustomEventSelectors, opts...).ToFunc()
+}
+
+// ByHasInsightSelectors orders the results by the has_insight_selectors field.
+func ByHasInsightSelectors(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHasInsightSelectors, opts...).ToFunc()
+}
+
+// ByIsLogging orders the results by the is_logging field.
+func ByIsLogging(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsLogging, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByRegion orders the results by the region field.
+func ByRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegion, opts...).ToFunc()
+}
@@ -0,0 +1,528 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cloudtrail
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/bronzeawscloudtrailtrail"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSCloudTrailTrailCreate is the builder for creating a BronzeAWSCloudTrailTrail entity.
+type BronzeAWSCloudTrailTrailCreate struct {
+	config
+	mutation *BronzeAWSCloudTrailTrailMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetCollectedAt(v time.Time) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetFirstCollectedAt(v time.Time) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetName(v string) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetNillableName(v *string) *BronzeAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetS3BucketName sets the "s3_bucket_name" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetS3BucketName(v string) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetS3BucketName(v)
+	return _c
+}
+
+// SetNillableS3BucketName sets the "s3_bucket_name" field if the given value is not nil.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetNillableS3BucketName(v *string) *BronzeAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetS3BucketName(*v)
+	}
+	return _c
+}
+
+// SetS3KeyPrefix sets the "s3_key_prefix" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetS3KeyPrefix(v string) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetS3KeyPrefix(v)
+	return _c
+}
+
+// SetNillableS3KeyPrefix sets the "s3_key_prefix" field if the given value is not nil.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetNillableS3KeyPrefix(v *string) *BronzeAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetS3KeyPrefix(*v)
+	}
+	return _c
+}
+
+// SetSnsTopicArn sets the "sns_topic_arn" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetSnsTopicArn(v string) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetSnsTopicArn(v)
+	return _c
+}
+
+// SetNillableSnsTopicArn sets the "sns_topic_arn" field if the given value is not nil.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetNillableSnsTopicArn(v *string) *BronzeAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetSnsTopicArn(*v)
+	}
+	return _c
+}
+
+// SetIsMultiRegionTrail sets the "is_multi_region_trail" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetIsMultiRegionTrail(v bool) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetIsMultiRegionTrail(v)
+	return _c
+}
+
+// SetNillableIsMultiRegionTrail sets the "is_multi_region_trail" field if the given value is not nil.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetNillableIsMultiRegionTrail(v *bool) *BronzeAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetIsMultiRegionTrail(*v)
+	}
+	return _c
+}
+
+// SetIsOrganizationTrail sets the "is_organization_trail" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetIsOrganizationTrail(v bool) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetIsOrganizationTrail(v)
+	return _c
+}
+
+// SetNillableIsOrganizationTrail sets the "is_organization_trail" field if the given value is not nil.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetNillableIsOrganizationTrail(v *bool) *BronzeAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetIsOrganizationTrail(*v)
+	}
+	return _c
+}
+
+// SetIncludeGlobalServiceEvents sets the "include_global_service_events" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetIncludeGlobalServiceEvents(v bool) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetIncludeGlobalServiceEvents(v)
+	return _c
+}
+
+// SetNillableIncludeGlobalServiceEvents sets the "include_global_service_events" field if the given value is not nil.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetNillableIncludeGlobalServiceEvents(v *bool) *BronzeAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetIncludeGlobalServiceEvents(*v)
+	}
+	return _c
+}
+
+// SetLogFileValidationEnabled sets the "log_file_validation_enabled" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetLogFileValidationEnabled(v bool) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetLogFileValidationEnabled(v)
+	return _c
+}
+
+// SetNillableLogFileValidationEnabled sets the "log_file_validation_enabled" field if the given value is not nil.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetNillableLogFileValidationEnabled(v *bool) *BronzeAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetLogFileValidationEnabled(*v)
+	}
+	return _c
+}
+
+// SetKmsKeyID sets the "kms_key_id" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetKmsKeyID(v string) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetKmsKeyID(v)
+	return _c
+}
+
+// SetNillableKmsKeyID sets the "kms_key_id" field if the given value is not nil.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetNillableKmsKeyID(v *string) *BronzeAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetKmsKeyID(*v)
+	}
+	return _c
+}
+
+// SetCloudWatchLogsLogGroupArn sets the "cloud_watch_logs_log_group_arn" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetCloudWatchLogsLogGroupArn(v string) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetCloudWatchLogsLogGroupArn(v)
+	return _c
+}
+
+// SetNillableCloudWatchLogsLogGroupArn sets the "cloud_watch_logs_log_group_arn" field if the given value is not nil.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetNillableCloudWatchLogsLogGroupArn(v *string) *BronzeAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetCloudWatchLogsLogGroupArn(*v)
+	}
+	return _c
+}
+
+// SetCloudWatchLogsRoleArn sets the "cloud_watch_logs_role_arn" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetCloudWatchLogsRoleArn(v string) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetCloudWatchLogsRoleArn(v)
+	return _c
+}
+
+// SetNillableCloudWatchLogsRoleArn sets the "cloud_watch_logs_role_arn" field if the given value is not nil.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetNillableCloudWatchLogsRoleArn(v *string) *BronzeAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetCloudWatchLogsRoleArn(*v)
+	}
+	return _c
+}
+
+// SetHasCustomEventSelectors sets the "has_custom_event_selectors" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetHasCustomEventSelectors(v bool) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetHasCustomEventSelectors(v)
+	return _c
+}
+
+// SetNillableHasCustomEventSelectors sets the "has_custom_event_selectors" field if the given value is not nil.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetNillableHasCustomEventSelectors(v *bool) *BronzeAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetHasCustomEventSelectors(*v)
+	}
+	return _c
+}
+
+// SetHasInsightSelectors sets the "has_insight_selectors" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetHasInsightSelectors(v bool) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetHasInsightSelectors(v)
+	return _c
+}
+
+// SetNillableHasInsightSelectors sets the "has_insight_selectors" field if the given value is not nil.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetNillableHasInsightSelectors(v *bool) *BronzeAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetHasInsightSelectors(*v)
+	}
+	return _c
+}
+
+// SetIsLogging sets the "is_logging" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetIsLogging(v bool) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetIsLogging(v)
+	return _c
+}
+
+// SetNillableIsLogging sets the "is_logging" field if the given value is not nil.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetNillableIsLogging(v *bool) *BronzeAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetIsLogging(*v)
+	}
+	return _c
+}
+
+// SetEventSelectorsJSON sets the "event_selectors_json" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetEventSelectorsJSON(v json.RawMessage) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetEventSelectorsJSON(v)
+	return _c
+}
+
+// SetAdvancedEventSelectorsJSON sets the "advanced_event_selectors_json" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetAdvancedEventSelectorsJSON(v json.RawMessage) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetAdvancedEventSelectorsJSON(v)
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetTagsJSON(v json.RawMessage) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetAccountID(v string) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetRegion(v string) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeAWSCloudTrailTrailCreate) SetID(v string) *BronzeAWSCloudTrailTrailCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeAWSCloudTrailTrailMutation object of the builder.
+func (_c *BronzeAWSCloudTrailTrailCreate) Mutation() *BronzeAWSCloudTrailTrailMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeAWSCloudTrailTrail in the database.
+func (_c *BronzeAWSCloudTrailTrailCreate) Save(ctx context.Context) (*BronzeAWSCloudTrailTrail, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeAWSCloudTrailTrailCreate) SaveX(ctx context.Context) *BronzeAWSCloudTrailTrail {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSCloudTrailTrailCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSCloudTrailTrailCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeAWSCloudTrailTrailCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`cloudtrail: missing required field "BronzeAWSCloudTrailTrail.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`cloudtrail: missing required field "BronzeAWSCloudTrailTrail.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`cloudtrail: missing required field "BronzeAWSCloudTrailTrail.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzeawscloudtrailtrail.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`cloudtrail: validator failed for field "BronzeAWSCloudTrailTrail.account_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`cloudtrail: missing required field "BronzeAWSCloudTrailTrail.region"`)}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := bronzeawscloudtrailtrail.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`cloudtrail: validator failed for field "BronzeAWSCloudTrailTrail.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeAWSCloudTrailTrailCreate) sqlSave(ctx context.Context) (*BronzeAWSCloudTrailTrail, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeAWSCloudTrailTrail.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeAWSCloudTrailTrailCreate) createSpec() (*BronzeAWSCloudTrailTrail, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeAWSCloudTrailTrail{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzeawscloudtrailtrail.Table, sqlgraph.NewFieldSpec(bronzeawscloudtrailtrail.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeAWSCloudTrailTrail
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.S3BucketName(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldS3BucketName, field.TypeString, value)
+		_node.S3BucketName = value
+	}
+	if value, ok := _c.mutation.S3KeyPrefix(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldS3KeyPrefix, field.TypeString, value)
+		_node.S3KeyPrefix = value
+	}
+	if value, ok := _c.mutation.SnsTopicArn(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldSnsTopicArn, field.TypeString, value)
+		_node.SnsTopicArn = value
+	}
+	if value, ok := _c.mutation.IsMultiRegionTrail(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldIsMultiRegionTrail, field.TypeBool, value)
+		_node.IsMultiRegionTrail = value
+	}
+	if value, ok := _c.mutation.IsOrganizationTrail(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldIsOrganizationTrail, field.TypeBool, value)
+		_node.IsOrganizationTrail = value
+	}
+	if value, ok := _c.mutation.IncludeGlobalServiceEvents(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldIncludeGlobalServiceEvents, field.TypeBool, value)
+		_node.IncludeGlobalServiceEvents = value
+	}
+	if value, ok := _c.mutation.LogFileValidationEnabled(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldLogFileValidationEnabled, field.TypeBool, value)
+		_node.LogFileValidationEnabled = value
+	}
+	if value, ok := _c.mutation.KmsKeyID(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldKmsKeyID, field.TypeString, value)
+		_node.KmsKeyID = value
+	}
+	if value, ok := _c.mutation.CloudWatchLogsLogGroupArn(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn, field.TypeString, value)
+		_node.CloudWatchLogsLogGroupArn = value
+	}
+	if value, ok := _c.mutation.CloudWatchLogsRoleArn(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldCloudWatchLogsRoleArn, field.TypeString, value)
+		_node.CloudWatchLogsRoleArn = value
+	}
+	if value, ok := _c.mutation.HasCustomEventSelectors(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldHasCustomEventSelectors, field.TypeBool, value)
+		_node.HasCustomEventSelectors = value
+	}
+	if value, ok := _c.mutation.HasInsightSelectors(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldHasInsightSelectors, field.TypeBool, value)
+		_node.HasInsightSelectors = value
+	}
+	if value, ok := _c.mutation.IsLogging(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldIsLogging, field.TypeBool, value)
+		_node.IsLogging = value
+	}
+	if value, ok := _c.mutation.EventSelectorsJSON(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldEventSelectorsJSON, field.TypeJSON, value)
+		_node.EventSelectorsJSON = value
+	}
+	if value, ok := _c.mutation.AdvancedEventSelectorsJSON(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON, field.TypeJSON, value)
+		_node.AdvancedEventSelectorsJSON = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	return _node, _spec
+}
+
+// BronzeAWSCloudTrailTrailCreateBulk is the builder for creating many BronzeAWSCloudTrailTrail entities in bulk.
+type BronzeAWSCloudTrailTrailCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeAWSCloudTrailTrailCreate
+}
+
+// Save creates the BronzeAWSCloudTrailTrail entities in the database.
+func (_c *BronzeAWSCloudTrailTrailCreateBulk) Save(ctx context.Context) ([]*BronzeAWSCloudTrailTrail, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeAWSCloudTrailTrail, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeAWSCloudTrailTrailMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeAWSCloudTrailTrailCreateBulk) SaveX(ctx context.Context) []*BronzeAWSCloudTrailTrail {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSCloudTrailTrailCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSCloudTrailTrailCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cloudtrail
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/bronzeawscloudtrailtrail"
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSCloudTrailTrailDelete is the builder for deleting a BronzeAWSCloudTrailTrail entity.
+type BronzeAWSCloudTrailTrailDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSCloudTrailTrailMutation
+}
+
+// Where appends a list predicates to the BronzeAWSCloudTrailTrailDelete builder.
+func (_d *BronzeAWSCloudTrailTrailDelete) Where(ps ...predicate.BronzeAWSCloudTrailTrail) *BronzeAWSCloudTrailTrailDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeAWSCloudTrailTrailDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeAWSCloudTrailTrailDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeAWSCloudTrailTrailDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzeawscloudtrailtrail.Table, sqlgraph.NewFieldSpec(bronzeawscloudtrailtrail.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.BronzeAWSCloudTrailTrail
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeAWSCloudTrailTrailDeleteOne is the builder for deleting a single BronzeAWSCloudTrailTrail entity.
+type BronzeAWSCloudTrailTrailDeleteOne struct {
+	_d *BronzeAWSCloudTrailTrailDelete
+}
+
+// Where appends a list predicates to the BronzeAWSCloudTrailTrailDelete builder.
+func (_d *BronzeAWSCloudTrailTrailDeleteOne) Where(ps ...predicate.BronzeAWSCloudTrailTrail) *BronzeAWSCloudTrailTrailDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeAWSCloudTrailTrailDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzeawscloudtrailtrail.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeAWSCloudTrailTrailDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cloudtrail
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/bronzeawscloudtrailtrail"
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/predicate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSCloudTrailTrailQuery is the builder for querying BronzeAWSCloudTrailTrail entities.
+type BronzeAWSCloudTrailTrailQuery struct {
+	config
+	ctx        *QueryContext
+	order      []bronzeawscloudtrailtrail.OrderOption
+	inters     []Interceptor
+	predicates []predicate.BronzeAWSCloudTrailTrail
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BronzeAWSCloudTrailTrailQuery builder.
+func (_q *BronzeAWSCloudTrailTrailQuery) Where(ps ...predicate.BronzeAWSCloudTrailTrail) *BronzeAWSCloudTrailTrailQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *BronzeAWSCloudTrailTrailQuery) Limit(limit int) *BronzeAWSCloudTrailTrailQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *BronzeAWSCloudTrailTrailQuery) Offset(offset int) *BronzeAWSCloudTrailTrailQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *BronzeAWSCloudTrailTrailQuery) Unique(unique bool) *BronzeAWSCloudTrailTrailQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *BronzeAWSCloudTrailTrailQuery) Order(o ...bronzeawscloudtrailtrail.OrderOption) *BronzeAWSCloudTrailTrailQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// First returns the first BronzeAWSCloudTrailTrail entity from the query.
+// Returns a *NotFoundError when no BronzeAWSCloudTrailTrail was found.
+func (_q *BronzeAWSCloudTrailTrailQuery) First(ctx context.Context) (*BronzeAWSCloudTrailTrail, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{bronzeawscloudtrailtrail.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *BronzeAWSCloudTrailTrailQuery) FirstX(ctx context.Context) *BronzeAWSCloudTrailTrail {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BronzeAWSCloudTrailTrail ID from the query.
+// Returns a *NotFoundError when no BronzeAWSCloudTrailTrail ID was found.
+func (_q *BronzeAWSCloudTrailTrailQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{bronzeawscloudtrailtrail.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *BronzeAWSCloudTrailTrailQuery) FirstIDX(ctx context.Context) string {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BronzeAWSCloudTrailTrail entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BronzeAWSCloudTrailTrail entity is found.
+// Returns a *NotFoundError when no BronzeAWSCloudTrailTrail entities are found.
+func (_q *BronzeAWSCloudTrailTrailQuery) Only(ctx context.Context) (*BronzeAWSCloudTrailTrail, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{bronzeawscloudtrailtrail.Label}
+	default:
+		return nil, &NotSingularError{bronzeawscloudtrailtrail.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *BronzeAWSCloudTrailTrailQuery) OnlyX(ctx context.Context) *BronzeAWSCloudTrailTrail {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BronzeAWSCloudTrailTrail ID in the query.
+// Returns a *NotSingularError when more than one BronzeAWSCloudTrailTrail ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *BronzeAWSCloudTrailTrailQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{bronzeawscloudtrailtrail.Label}
+	default:
+		err = &NotSingularError{bronzeawscloudtrailtrail.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *BronzeAWSCloudTrailTrailQuery) OnlyIDX(ctx context.Context) string {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BronzeAWSCloudTrailTrails.
+func (_q *BronzeAWSCloudTrailTrailQuery) All(ctx context.Context) ([]*BronzeAWSCloudTrailTrail, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BronzeAWSCloudTrailTrail, *BronzeAWSCloudTrailTrailQuery]()
+	return withInterceptors[[]*BronzeAWSCloudTrailTrail](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *BronzeAWSCloudTrailTrailQuery) AllX(ctx context.Context) []*BronzeAWSCloudTrailTrail {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BronzeAWSCloudTrailTrail IDs.
+func (_q *BronzeAWSCloudTrailTrailQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(bronzeawscloudtrailtrail.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *BronzeAWSCloudTrailTrailQuery) IDsX(ctx context.Context) []string {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *BronzeAWSCloudTrailTrailQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*BronzeAWSCloudTrailTrailQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *BronzeAWSCloudTrailTrailQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *BronzeAWSCloudTrailTrailQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("cloudtrail: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *BronzeAWSCloudTrailTrailQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BronzeAWSCloudTrailTrailQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *BronzeAWSCloudTrailTrailQuery) Clone() *BronzeAWSCloudTrailTrailQuery {
+	if _q == nil {
+		return nil
+	}
+	return &BronzeAWSCloudTrailTrailQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]bronzeawscloudtrailtrail.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.BronzeAWSCloudTrailTrail{}, _q.predicates...),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CollectedAt time.Time `json:"collected_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BronzeAWSCloudTrailTrail.Query().
+//		GroupBy(bronzeawscloudtrailtrail.FieldCollectedAt).
+//		Aggregate(cloudtrail.Count()).
+//		Scan(ctx, &v)
+func (_q *BronzeAWSCloudTrailTrailQuery) GroupBy(field string, fields ...string) *BronzeAWSCloudTrailTrailGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BronzeAWSCloudTrailTrailGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = bronzeawscloudtrailtrail.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CollectedAt time.Time `json:"collected_at,omitempty"`
+//	}
+//
+//	client.BronzeAWSCloudTrailTrail.Query().
+//		Select(bronzeawscloudtrailtrail.FieldCollectedAt).
+//		Scan(ctx, &v)
+func (_q *BronzeAWSCloudTrailTrailQuery) Select(fields ...string) *BronzeAWSCloudTrailTrailSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &BronzeAWSCloudTrailTrailSelect{BronzeAWSCloudTrailTrailQuery: _q}
+	sbuild.label = bronzeawscloudtrailtrail.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BronzeAWSCloudTrailTrailSelect configured with the given aggregations.
+func (_q *BronzeAWSCloudTrailTrailQuery) Aggregate(fns ...AggregateFunc) *BronzeAWSCloudTrailTrailSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *BronzeAWSCloudTrailTrailQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("cloudtrail: uninitialized interceptor (forgotten import cloudtrail/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !bronzeawscloudtrailtrail.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("cloudtrail: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *BronzeAWSCloudTrailTrailQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BronzeAWSCloudTrailTrail, error) {
+	var (
+		nodes = []*BronzeAWSCloudTrailTrail{}
+		_spec = _q.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BronzeAWSCloudTrailTrail).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BronzeAWSCloudTrailTrail{config: _q.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = _q.schemaConfig.BronzeAWSCloudTrailTrail
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (_q *BronzeAWSCloudTrailTrailQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Schema = _q.schemaConfig.BronzeAWSCloudTrailTrail
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *BronzeAWSCloudTrailTrailQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(bronzeawscloudtrailtrail.Table, bronzeawscloudtrailtrail.Columns, sqlgraph.NewFieldSpec(bronzeawscloudtrailtrail.FieldID, field.TypeString))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawscloudtrailtrail.FieldID)
+		for i := range fields {
+			if fields[i] != bronzeawscloudtrailtrail.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *BronzeAWSCloudTrailTrailQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(bronzeawscloudtrailtrail.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = bronzeawscloudtrailtrail.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(_q.schemaConfig.BronzeAWSCloudTrailTrail)
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	selector.WithContext(ctx)
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BronzeAWSCloudTrailTrailGroupBy is the group-by builder for BronzeAWSCloudTrailTrail entities.
+type BronzeAWSCloudTrailTrailGroupBy struct {
+	selector
+	build *BronzeAWSCloudTrailTrailQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *BronzeAWSCloudTrailTrailGroupBy) Aggregate(fns ...AggregateFunc) *BronzeAWSCloudTrailTrailGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *BronzeAWSCloudTrailTrailGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BronzeAWSCloudTrailTrailQuery, *BronzeAWSCloudTrailTrailGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *BronzeAWSCloudTrailTrailGroupBy) sqlScan(ctx context.Context, root *BronzeAWSCloudTrailTrailQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BronzeAWSCloudTrailTrailSelect is the builder for selecting fields of BronzeAWSCloudTrailTrail entities.
+type BronzeAWSCloudTrailTrailSelect struct {
+	*BronzeAWSCloudTrailTrailQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *BronzeAWSCloudTrailTrailSelect) Aggregate(fns ...AggregateFunc) *BronzeAWSCloudTrailTrailSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *BronzeAWSCloudTrailTrailSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BronzeAWSCloudTrailTrailQuery, *BronzeAWSCloudTrailTrailSelect](ctx, _s.BronzeAWSCloudTrailTrailQuery, _s, _s.inters, v)
+}
+
+func (_s *BronzeAWSCloudTrailTrailSelect) sqlScan(ctx context.Context, root *BronzeAWSCloudTrailTrailQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
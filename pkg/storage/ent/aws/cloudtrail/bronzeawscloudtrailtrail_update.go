@@ -0,0 +1,1223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cloudtrail
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/bronzeawscloudtrailtrail"
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSCloudTrailTrailUpdate is the builder for updating BronzeAWSCloudTrailTrail entities.
+type BronzeAWSCloudTrailTrailUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSCloudTrailTrailMutation
+}
+
+// Where appends a list predicates to the BronzeAWSCloudTrailTrailUpdate builder.
+func (_u *BronzeAWSCloudTrailTrailUpdate) Where(ps ...predicate.BronzeAWSCloudTrailTrail) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetCollectedAt(v time.Time) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetNillableCollectedAt(v *time.Time) *BronzeAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetName(v string) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetNillableName(v *string) *BronzeAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) ClearName() *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetS3BucketName sets the "s3_bucket_name" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetS3BucketName(v string) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetS3BucketName(v)
+	return _u
+}
+
+// SetNillableS3BucketName sets the "s3_bucket_name" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetNillableS3BucketName(v *string) *BronzeAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetS3BucketName(*v)
+	}
+	return _u
+}
+
+// ClearS3BucketName clears the value of the "s3_bucket_name" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) ClearS3BucketName() *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearS3BucketName()
+	return _u
+}
+
+// SetS3KeyPrefix sets the "s3_key_prefix" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetS3KeyPrefix(v string) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetS3KeyPrefix(v)
+	return _u
+}
+
+// SetNillableS3KeyPrefix sets the "s3_key_prefix" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetNillableS3KeyPrefix(v *string) *BronzeAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetS3KeyPrefix(*v)
+	}
+	return _u
+}
+
+// ClearS3KeyPrefix clears the value of the "s3_key_prefix" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) ClearS3KeyPrefix() *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearS3KeyPrefix()
+	return _u
+}
+
+// SetSnsTopicArn sets the "sns_topic_arn" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetSnsTopicArn(v string) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetSnsTopicArn(v)
+	return _u
+}
+
+// SetNillableSnsTopicArn sets the "sns_topic_arn" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetNillableSnsTopicArn(v *string) *BronzeAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetSnsTopicArn(*v)
+	}
+	return _u
+}
+
+// ClearSnsTopicArn clears the value of the "sns_topic_arn" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) ClearSnsTopicArn() *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearSnsTopicArn()
+	return _u
+}
+
+// SetIsMultiRegionTrail sets the "is_multi_region_trail" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetIsMultiRegionTrail(v bool) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetIsMultiRegionTrail(v)
+	return _u
+}
+
+// SetNillableIsMultiRegionTrail sets the "is_multi_region_trail" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetNillableIsMultiRegionTrail(v *bool) *BronzeAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetIsMultiRegionTrail(*v)
+	}
+	return _u
+}
+
+// ClearIsMultiRegionTrail clears the value of the "is_multi_region_trail" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) ClearIsMultiRegionTrail() *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearIsMultiRegionTrail()
+	return _u
+}
+
+// SetIsOrganizationTrail sets the "is_organization_trail" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetIsOrganizationTrail(v bool) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetIsOrganizationTrail(v)
+	return _u
+}
+
+// SetNillableIsOrganizationTrail sets the "is_organization_trail" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetNillableIsOrganizationTrail(v *bool) *BronzeAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetIsOrganizationTrail(*v)
+	}
+	return _u
+}
+
+// ClearIsOrganizationTrail clears the value of the "is_organization_trail" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) ClearIsOrganizationTrail() *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearIsOrganizationTrail()
+	return _u
+}
+
+// SetIncludeGlobalServiceEvents sets the "include_global_service_events" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetIncludeGlobalServiceEvents(v bool) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetIncludeGlobalServiceEvents(v)
+	return _u
+}
+
+// SetNillableIncludeGlobalServiceEvents sets the "include_global_service_events" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetNillableIncludeGlobalServiceEvents(v *bool) *BronzeAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetIncludeGlobalServiceEvents(*v)
+	}
+	return _u
+}
+
+// ClearIncludeGlobalServiceEvents clears the value of the "include_global_service_events" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) ClearIncludeGlobalServiceEvents() *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearIncludeGlobalServiceEvents()
+	return _u
+}
+
+// SetLogFileValidationEnabled sets the "log_file_validation_enabled" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetLogFileValidationEnabled(v bool) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetLogFileValidationEnabled(v)
+	return _u
+}
+
+// SetNillableLogFileValidationEnabled sets the "log_file_validation_enabled" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetNillableLogFileValidationEnabled(v *bool) *BronzeAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetLogFileValidationEnabled(*v)
+	}
+	return _u
+}
+
+// ClearLogFileValidationEnabled clears the value of the "log_file_validation_enabled" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) ClearLogFileValidationEnabled() *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearLogFileValidationEnabled()
+	return _u
+}
+
+// SetKmsKeyID sets the "kms_key_id" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetKmsKeyID(v string) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetKmsKeyID(v)
+	return _u
+}
+
+// SetNillableKmsKeyID sets the "kms_key_id" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetNillableKmsKeyID(v *string) *BronzeAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetKmsKeyID(*v)
+	}
+	return _u
+}
+
+// ClearKmsKeyID clears the value of the "kms_key_id" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) ClearKmsKeyID() *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearKmsKeyID()
+	return _u
+}
+
+// SetCloudWatchLogsLogGroupArn sets the "cloud_watch_logs_log_group_arn" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetCloudWatchLogsLogGroupArn(v string) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetCloudWatchLogsLogGroupArn(v)
+	return _u
+}
+
+// SetNillableCloudWatchLogsLogGroupArn sets the "cloud_watch_logs_log_group_arn" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetNillableCloudWatchLogsLogGroupArn(v *string) *BronzeAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetCloudWatchLogsLogGroupArn(*v)
+	}
+	return _u
+}
+
+// ClearCloudWatchLogsLogGroupArn clears the value of the "cloud_watch_logs_log_group_arn" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) ClearCloudWatchLogsLogGroupArn() *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearCloudWatchLogsLogGroupArn()
+	return _u
+}
+
+// SetCloudWatchLogsRoleArn sets the "cloud_watch_logs_role_arn" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetCloudWatchLogsRoleArn(v string) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetCloudWatchLogsRoleArn(v)
+	return _u
+}
+
+// SetNillableCloudWatchLogsRoleArn sets the "cloud_watch_logs_role_arn" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetNillableCloudWatchLogsRoleArn(v *string) *BronzeAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetCloudWatchLogsRoleArn(*v)
+	}
+	return _u
+}
+
+// ClearCloudWatchLogsRoleArn clears the value of the "cloud_watch_logs_role_arn" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) ClearCloudWatchLogsRoleArn() *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearCloudWatchLogsRoleArn()
+	return _u
+}
+
+// SetHasCustomEventSelectors sets the "has_custom_event_selectors" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetHasCustomEventSelectors(v bool) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetHasCustomEventSelectors(v)
+	return _u
+}
+
+// SetNillableHasCustomEventSelectors sets the "has_custom_event_selectors" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetNillableHasCustomEventSelectors(v *bool) *BronzeAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetHasCustomEventSelectors(*v)
+	}
+	return _u
+}
+
+// ClearHasCustomEventSelectors clears the value of the "has_custom_event_selectors" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) ClearHasCustomEventSelectors() *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearHasCustomEventSelectors()
+	return _u
+}
+
+// SetHasInsightSelectors sets the "has_insight_selectors" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetHasInsightSelectors(v bool) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetHasInsightSelectors(v)
+	return _u
+}
+
+// SetNillableHasInsightSelectors sets the "has_insight_selectors" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetNillableHasInsightSelectors(v *bool) *BronzeAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetHasInsightSelectors(*v)
+	}
+	return _u
+}
+
+// ClearHasInsightSelectors clears the value of the "has_insight_selectors" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) ClearHasInsightSelectors() *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearHasInsightSelectors()
+	return _u
+}
+
+// SetIsLogging sets the "is_logging" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetIsLogging(v bool) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetIsLogging(v)
+	return _u
+}
+
+// SetNillableIsLogging sets the "is_logging" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetNillableIsLogging(v *bool) *BronzeAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetIsLogging(*v)
+	}
+	return _u
+}
+
+// ClearIsLogging clears the value of the "is_logging" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) ClearIsLogging() *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearIsLogging()
+	return _u
+}
+
+// SetEventSelectorsJSON sets the "event_selectors_json" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetEventSelectorsJSON(v json.RawMessage) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetEventSelectorsJSON(v)
+	return _u
+}
+
+// AppendEventSelectorsJSON appends value to the "event_selectors_json" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) AppendEventSelectorsJSON(v json.RawMessage) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.AppendEventSelectorsJSON(v)
+	return _u
+}
+
+// ClearEventSelectorsJSON clears the value of the "event_selectors_json" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) ClearEventSelectorsJSON() *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearEventSelectorsJSON()
+	return _u
+}
+
+// SetAdvancedEventSelectorsJSON sets the "advanced_event_selectors_json" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetAdvancedEventSelectorsJSON(v json.RawMessage) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetAdvancedEventSelectorsJSON(v)
+	return _u
+}
+
+// AppendAdvancedEventSelectorsJSON appends value to the "advanced_event_selectors_json" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) AppendAdvancedEventSelectorsJSON(v json.RawMessage) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.AppendAdvancedEventSelectorsJSON(v)
+	return _u
+}
+
+// ClearAdvancedEventSelectorsJSON clears the value of the "advanced_event_selectors_json" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) ClearAdvancedEventSelectorsJSON() *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearAdvancedEventSelectorsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetTagsJSON(v json.RawMessage) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) AppendTagsJSON(v json.RawMessage) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) ClearTagsJSON() *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetAccountID(v string) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetNillableAccountID(v *string) *BronzeAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetRegion(v string) *BronzeAWSCloudTrailTrailUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SetNillableRegion(v *string) *BronzeAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSCloudTrailTrailMutation object of the builder.
+func (_u *BronzeAWSCloudTrailTrailUpdate) Mutation() *BronzeAWSCloudTrailTrailMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeAWSCloudTrailTrailUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSCloudTrailTrailUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeAWSCloudTrailTrailUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSCloudTrailTrailUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSCloudTrailTrailUpdate) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawscloudtrailtrail.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`cloudtrail: validator failed for field "BronzeAWSCloudTrailTrail.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawscloudtrailtrail.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`cloudtrail: validator failed for field "BronzeAWSCloudTrailTrail.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSCloudTrailTrailUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawscloudtrailtrail.Table, bronzeawscloudtrailtrail.Columns, sqlgraph.NewFieldSpec(bronzeawscloudtrailtrail.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.S3BucketName(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldS3BucketName, field.TypeString, value)
+	}
+	if _u.mutation.S3BucketNameCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldS3BucketName, field.TypeString)
+	}
+	if value, ok := _u.mutation.S3KeyPrefix(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldS3KeyPrefix, field.TypeString, value)
+	}
+	if _u.mutation.S3KeyPrefixCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldS3KeyPrefix, field.TypeString)
+	}
+	if value, ok := _u.mutation.SnsTopicArn(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldSnsTopicArn, field.TypeString, value)
+	}
+	if _u.mutation.SnsTopicArnCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldSnsTopicArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsMultiRegionTrail(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldIsMultiRegionTrail, field.TypeBool, value)
+	}
+	if _u.mutation.IsMultiRegionTrailCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldIsMultiRegionTrail, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IsOrganizationTrail(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldIsOrganizationTrail, field.TypeBool, value)
+	}
+	if _u.mutation.IsOrganizationTrailCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldIsOrganizationTrail, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IncludeGlobalServiceEvents(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldIncludeGlobalServiceEvents, field.TypeBool, value)
+	}
+	if _u.mutation.IncludeGlobalServiceEventsCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldIncludeGlobalServiceEvents, field.TypeBool)
+	}
+	if value, ok := _u.mutation.LogFileValidationEnabled(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldLogFileValidationEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.LogFileValidationEnabledCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldLogFileValidationEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.KmsKeyID(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldKmsKeyID, field.TypeString, value)
+	}
+	if _u.mutation.KmsKeyIDCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldKmsKeyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CloudWatchLogsLogGroupArn(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn, field.TypeString, value)
+	}
+	if _u.mutation.CloudWatchLogsLogGroupArnCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.CloudWatchLogsRoleArn(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldCloudWatchLogsRoleArn, field.TypeString, value)
+	}
+	if _u.mutation.CloudWatchLogsRoleArnCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldCloudWatchLogsRoleArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.HasCustomEventSelectors(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldHasCustomEventSelectors, field.TypeBool, value)
+	}
+	if _u.mutation.HasCustomEventSelectorsCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldHasCustomEventSelectors, field.TypeBool)
+	}
+	if value, ok := _u.mutation.HasInsightSelectors(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldHasInsightSelectors, field.TypeBool, value)
+	}
+	if _u.mutation.HasInsightSelectorsCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldHasInsightSelectors, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IsLogging(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldIsLogging, field.TypeBool, value)
+	}
+	if _u.mutation.IsLoggingCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldIsLogging, field.TypeBool)
+	}
+	if value, ok := _u.mutation.EventSelectorsJSON(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldEventSelectorsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEventSelectorsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawscloudtrailtrail.FieldEventSelectorsJSON, value)
+		})
+	}
+	if _u.mutation.EventSelectorsJSONCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldEventSelectorsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AdvancedEventSelectorsJSON(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAdvancedEventSelectorsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON, value)
+		})
+	}
+	if _u.mutation.AdvancedEventSelectorsJSONCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawscloudtrailtrail.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSCloudTrailTrail
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawscloudtrailtrail.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeAWSCloudTrailTrailUpdateOne is the builder for updating a single BronzeAWSCloudTrailTrail entity.
+type BronzeAWSCloudTrailTrailUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeAWSCloudTrailTrailMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetCollectedAt(v time.Time) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetName(v string) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetNillableName(v *string) *BronzeAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) ClearName() *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetS3BucketName sets the "s3_bucket_name" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetS3BucketName(v string) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetS3BucketName(v)
+	return _u
+}
+
+// SetNillableS3BucketName sets the "s3_bucket_name" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetNillableS3BucketName(v *string) *BronzeAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetS3BucketName(*v)
+	}
+	return _u
+}
+
+// ClearS3BucketName clears the value of the "s3_bucket_name" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) ClearS3BucketName() *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearS3BucketName()
+	return _u
+}
+
+// SetS3KeyPrefix sets the "s3_key_prefix" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetS3KeyPrefix(v string) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetS3KeyPrefix(v)
+	return _u
+}
+
+// SetNillableS3KeyPrefix sets the "s3_key_prefix" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetNillableS3KeyPrefix(v *string) *BronzeAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetS3KeyPrefix(*v)
+	}
+	return _u
+}
+
+// ClearS3KeyPrefix clears the value of the "s3_key_prefix" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) ClearS3KeyPrefix() *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearS3KeyPrefix()
+	return _u
+}
+
+// SetSnsTopicArn sets the "sns_topic_arn" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetSnsTopicArn(v string) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetSnsTopicArn(v)
+	return _u
+}
+
+// SetNillableSnsTopicArn sets the "sns_topic_arn" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetNillableSnsTopicArn(v *string) *BronzeAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetSnsTopicArn(*v)
+	}
+	return _u
+}
+
+// ClearSnsTopicArn clears the value of the "sns_topic_arn" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) ClearSnsTopicArn() *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearSnsTopicArn()
+	return _u
+}
+
+// SetIsMultiRegionTrail sets the "is_multi_region_trail" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetIsMultiRegionTrail(v bool) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetIsMultiRegionTrail(v)
+	return _u
+}
+
+// SetNillableIsMultiRegionTrail sets the "is_multi_region_trail" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetNillableIsMultiRegionTrail(v *bool) *BronzeAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetIsMultiRegionTrail(*v)
+	}
+	return _u
+}
+
+// ClearIsMultiRegionTrail clears the value of the "is_multi_region_trail" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) ClearIsMultiRegionTrail() *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearIsMultiRegionTrail()
+	return _u
+}
+
+// SetIsOrganizationTrail sets the "is_organization_trail" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetIsOrganizationTrail(v bool) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetIsOrganizationTrail(v)
+	return _u
+}
+
+// SetNillableIsOrganizationTrail sets the "is_organization_trail" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetNillableIsOrganizationTrail(v *bool) *BronzeAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetIsOrganizationTrail(*v)
+	}
+	return _u
+}
+
+// ClearIsOrganizationTrail clears the value of the "is_organization_trail" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) ClearIsOrganizationTrail() *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearIsOrganizationTrail()
+	return _u
+}
+
+// SetIncludeGlobalServiceEvents sets the "include_global_service_events" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetIncludeGlobalServiceEvents(v bool) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetIncludeGlobalServiceEvents(v)
+	return _u
+}
+
+// SetNillableIncludeGlobalServiceEvents sets the "include_global_service_events" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetNillableIncludeGlobalServiceEvents(v *bool) *BronzeAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetIncludeGlobalServiceEvents(*v)
+	}
+	return _u
+}
+
+// ClearIncludeGlobalServiceEvents clears the value of the "include_global_service_events" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) ClearIncludeGlobalServiceEvents() *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearIncludeGlobalServiceEvents()
+	return _u
+}
+
+// SetLogFileValidationEnabled sets the "log_file_validation_enabled" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetLogFileValidationEnabled(v bool) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetLogFileValidationEnabled(v)
+	return _u
+}
+
+// SetNillableLogFileValidationEnabled sets the "log_file_validation_enabled" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetNillableLogFileValidationEnabled(v *bool) *BronzeAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetLogFileValidationEnabled(*v)
+	}
+	return _u
+}
+
+// ClearLogFileValidationEnabled clears the value of the "log_file_validation_enabled" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) ClearLogFileValidationEnabled() *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearLogFileValidationEnabled()
+	return _u
+}
+
+// SetKmsKeyID sets the "kms_key_id" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetKmsKeyID(v string) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetKmsKeyID(v)
+	return _u
+}
+
+// SetNillableKmsKeyID sets the "kms_key_id" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetNillableKmsKeyID(v *string) *BronzeAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetKmsKeyID(*v)
+	}
+	return _u
+}
+
+// ClearKmsKeyID clears the value of the "kms_key_id" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) ClearKmsKeyID() *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearKmsKeyID()
+	return _u
+}
+
+// SetCloudWatchLogsLogGroupArn sets the "cloud_watch_logs_log_group_arn" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetCloudWatchLogsLogGroupArn(v string) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetCloudWatchLogsLogGroupArn(v)
+	return _u
+}
+
+// SetNillableCloudWatchLogsLogGroupArn sets the "cloud_watch_logs_log_group_arn" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetNillableCloudWatchLogsLogGroupArn(v *string) *BronzeAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetCloudWatchLogsLogGroupArn(*v)
+	}
+	return _u
+}
+
+// ClearCloudWatchLogsLogGroupArn clears the value of the "cloud_watch_logs_log_group_arn" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) ClearCloudWatchLogsLogGroupArn() *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearCloudWatchLogsLogGroupArn()
+	return _u
+}
+
+// SetCloudWatchLogsRoleArn sets the "cloud_watch_logs_role_arn" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetCloudWatchLogsRoleArn(v string) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetCloudWatchLogsRoleArn(v)
+	return _u
+}
+
+// SetNillableCloudWatchLogsRoleArn sets the "cloud_watch_logs_role_arn" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetNillableCloudWatchLogsRoleArn(v *string) *BronzeAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetCloudWatchLogsRoleArn(*v)
+	}
+	return _u
+}
+
+// ClearCloudWatchLogsRoleArn clears the value of the "cloud_watch_logs_role_arn" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) ClearCloudWatchLogsRoleArn() *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearCloudWatchLogsRoleArn()
+	return _u
+}
+
+// SetHasCustomEventSelectors sets the "has_custom_event_selectors" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetHasCustomEventSelectors(v bool) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetHasCustomEventSelectors(v)
+	return _u
+}
+
+// SetNillableHasCustomEventSelectors sets the "has_custom_event_selectors" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetNillableHasCustomEventSelectors(v *bool) *BronzeAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetHasCustomEventSelectors(*v)
+	}
+	return _u
+}
+
+// ClearHasCustomEventSelectors clears the value of the "has_custom_event_selectors" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) ClearHasCustomEventSelectors() *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearHasCustomEventSelectors()
+	return _u
+}
+
+// SetHasInsightSelectors sets the "has_insight_selectors" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetHasInsightSelectors(v bool) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetHasInsightSelectors(v)
+	return _u
+}
+
+// SetNillableHasInsightSelectors sets the "has_insight_selectors" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetNillableHasInsightSelectors(v *bool) *BronzeAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetHasInsightSelectors(*v)
+	}
+	return _u
+}
+
+// ClearHasInsightSelectors clears the value of the "has_insight_selectors" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) ClearHasInsightSelectors() *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearHasInsightSelectors()
+	return _u
+}
+
+// SetIsLogging sets the "is_logging" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetIsLogging(v bool) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetIsLogging(v)
+	return _u
+}
+
+// SetNillableIsLogging sets the "is_logging" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetNillableIsLogging(v *bool) *BronzeAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetIsLogging(*v)
+	}
+	return _u
+}
+
+// ClearIsLogging clears the value of the "is_logging" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) ClearIsLogging() *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearIsLogging()
+	return _u
+}
+
+// SetEventSelectorsJSON sets the "event_selectors_json" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetEventSelectorsJSON(v json.RawMessage) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetEventSelectorsJSON(v)
+	return _u
+}
+
+// AppendEventSelectorsJSON appends value to the "event_selectors_json" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) AppendEventSelectorsJSON(v json.RawMessage) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.AppendEventSelectorsJSON(v)
+	return _u
+}
+
+// ClearEventSelectorsJSON clears the value of the "event_selectors_json" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) ClearEventSelectorsJSON() *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearEventSelectorsJSON()
+	return _u
+}
+
+// SetAdvancedEventSelectorsJSON sets the "advanced_event_selectors_json" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetAdvancedEventSelectorsJSON(v json.RawMessage) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetAdvancedEventSelectorsJSON(v)
+	return _u
+}
+
+// AppendAdvancedEventSelectorsJSON appends value to the "advanced_event_selectors_json" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) AppendAdvancedEventSelectorsJSON(v json.RawMessage) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.AppendAdvancedEventSelectorsJSON(v)
+	return _u
+}
+
+// ClearAdvancedEventSelectorsJSON clears the value of the "advanced_event_selectors_json" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) ClearAdvancedEventSelectorsJSON() *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearAdvancedEventSelectorsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) ClearTagsJSON() *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetAccountID(v string) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetNillableAccountID(v *string) *BronzeAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetRegion(v string) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SetNillableRegion(v *string) *BronzeAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSCloudTrailTrailMutation object of the builder.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) Mutation() *BronzeAWSCloudTrailTrailMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeAWSCloudTrailTrailUpdate builder.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) Where(ps ...predicate.BronzeAWSCloudTrailTrail) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) Select(field string, fields ...string) *BronzeAWSCloudTrailTrailUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeAWSCloudTrailTrail entity.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) Save(ctx context.Context) (*BronzeAWSCloudTrailTrail, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) SaveX(ctx context.Context) *BronzeAWSCloudTrailTrail {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawscloudtrailtrail.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`cloudtrail: validator failed for field "BronzeAWSCloudTrailTrail.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawscloudtrailtrail.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`cloudtrail: validator failed for field "BronzeAWSCloudTrailTrail.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSCloudTrailTrailUpdateOne) sqlSave(ctx context.Context) (_node *BronzeAWSCloudTrailTrail, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawscloudtrailtrail.Table, bronzeawscloudtrailtrail.Columns, sqlgraph.NewFieldSpec(bronzeawscloudtrailtrail.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`cloudtrail: missing "BronzeAWSCloudTrailTrail.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawscloudtrailtrail.FieldID)
+		for _, f := range fields {
+			if !bronzeawscloudtrailtrail.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("cloudtrail: invalid field %q for query", f)}
+			}
+			if f != bronzeawscloudtrailtrail.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.S3BucketName(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldS3BucketName, field.TypeString, value)
+	}
+	if _u.mutation.S3BucketNameCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldS3BucketName, field.TypeString)
+	}
+	if value, ok := _u.mutation.S3KeyPrefix(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldS3KeyPrefix, field.TypeString, value)
+	}
+	if _u.mutation.S3KeyPrefixCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldS3KeyPrefix, field.TypeString)
+	}
+	if value, ok := _u.mutation.SnsTopicArn(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldSnsTopicArn, field.TypeString, value)
+	}
+	if _u.mutation.SnsTopicArnCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldSnsTopicArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsMultiRegionTrail(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldIsMultiRegionTrail, field.TypeBool, value)
+	}
+	if _u.mutation.IsMultiRegionTrailCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldIsMultiRegionTrail, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IsOrganizationTrail(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldIsOrganizationTrail, field.TypeBool, value)
+	}
+	if _u.mutation.IsOrganizationTrailCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldIsOrganizationTrail, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IncludeGlobalServiceEvents(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldIncludeGlobalServiceEvents, field.TypeBool, value)
+	}
+	if _u.mutation.IncludeGlobalServiceEventsCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldIncludeGlobalServiceEvents, field.TypeBool)
+	}
+	if value, ok := _u.mutation.LogFileValidationEnabled(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldLogFileValidationEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.LogFileValidationEnabledCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldLogFileValidationEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.KmsKeyID(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldKmsKeyID, field.TypeString, value)
+	}
+	if _u.mutation.KmsKeyIDCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldKmsKeyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CloudWatchLogsLogGroupArn(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn, field.TypeString, value)
+	}
+	if _u.mutation.CloudWatchLogsLogGroupArnCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.CloudWatchLogsRoleArn(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldCloudWatchLogsRoleArn, field.TypeString, value)
+	}
+	if _u.mutation.CloudWatchLogsRoleArnCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldCloudWatchLogsRoleArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.HasCustomEventSelectors(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldHasCustomEventSelectors, field.TypeBool, value)
+	}
+	if _u.mutation.HasCustomEventSelectorsCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldHasCustomEventSelectors, field.TypeBool)
+	}
+	if value, ok := _u.mutation.HasInsightSelectors(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldHasInsightSelectors, field.TypeBool, value)
+	}
+	if _u.mutation.HasInsightSelectorsCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldHasInsightSelectors, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IsLogging(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldIsLogging, field.TypeBool, value)
+	}
+	if _u.mutation.IsLoggingCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldIsLogging, field.TypeBool)
+	}
+	if value, ok := _u.mutation.EventSelectorsJSON(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldEventSelectorsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEventSelectorsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawscloudtrailtrail.FieldEventSelectorsJSON, value)
+		})
+	}
+	if _u.mutation.EventSelectorsJSONCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldEventSelectorsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AdvancedEventSelectorsJSON(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAdvancedEventSelectorsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON, value)
+		})
+	}
+	if _u.mutation.AdvancedEventSelectorsJSONCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawscloudtrailtrail.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawscloudtrailtrail.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawscloudtrailtrail.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSCloudTrailTrail
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeAWSCloudTrailTrail{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawscloudtrailtrail.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
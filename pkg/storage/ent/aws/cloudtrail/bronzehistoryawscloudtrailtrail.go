@@ -0,0 +1,373 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cloudtrail
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/bronzehistoryawscloudtrailtrail"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryAWSCloudTrailTrail is the model entity for the BronzeHistoryAWSCloudTrailTrail schema.
+type BronzeHistoryAWSCloudTrailTrail struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze CloudTrail trail by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// S3BucketName holds the value of the "s3_bucket_name" field.
+	S3BucketName string `json:"s3_bucket_name,omitempty"`
+	// S3KeyPrefix holds the value of the "s3_key_prefix" field.
+	S3KeyPrefix string `json:"s3_key_prefix,omitempty"`
+	// SnsTopicArn holds the value of the "sns_topic_arn" field.
+	SnsTopicArn string `json:"sns_topic_arn,omitempty"`
+	// IsMultiRegionTrail holds the value of the "is_multi_region_trail" field.
+	IsMultiRegionTrail bool `json:"is_multi_region_trail,omitempty"`
+	// IsOrganizationTrail holds the value of the "is_organization_trail" field.
+	IsOrganizationTrail bool `json:"is_organization_trail,omitempty"`
+	// IncludeGlobalServiceEvents holds the value of the "include_global_service_events" field.
+	IncludeGlobalServiceEvents bool `json:"include_global_service_events,omitempty"`
+	// LogFileValidationEnabled holds the value of the "log_file_validation_enabled" field.
+	LogFileValidationEnabled bool `json:"log_file_validation_enabled,omitempty"`
+	// KmsKeyID holds the value of the "kms_key_id" field.
+	KmsKeyID string `json:"kms_key_id,omitempty"`
+	// CloudWatchLogsLogGroupArn holds the value of the "cloud_watch_logs_log_group_arn" field.
+	CloudWatchLogsLogGroupArn string `json:"cloud_watch_logs_log_group_arn,omitempty"`
+	// CloudWatchLogsRoleArn holds the value of the "cloud_watch_logs_role_arn" field.
+	CloudWatchLogsRoleArn string `json:"cloud_watch_logs_role_arn,omitempty"`
+	// HasCustomEventSelectors holds the value of the "has_custom_event_selectors" field.
+	HasCustomEventSelectors bool `json:"has_custom_event_selectors,omitempty"`
+	// HasInsightSelectors holds the value of the "has_insight_selectors" field.
+	HasInsightSelectors bool `json:"has_insight_selectors,omitempty"`
+	// IsLogging holds the value of the "is_logging" field.
+	IsLogging bool `json:"is_logging,omitempty"`
+	// EventSelectorsJSON holds the value of the "event_selectors_json" field.
+	EventSelectorsJSON json.RawMessage `json:"event_selectors_json,omitempty"`
+	// AdvancedEventSelectorsJSON holds the value of the "advanced_event_selectors_json" field.
+	AdvancedEventSelectorsJSON json.RawMessage `json:"advanced_event_selectors_json,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Region holds the value of the "region" field.
+	Region       string `json:"region,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryAWSCloudTrailTrail) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON, bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON, bronzehistoryawscloudtrailtrail.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzehistoryawscloudtrailtrail.FieldIsMultiRegionTrail, bronzehistoryawscloudtrailtrail.FieldIsOrganizationTrail, bronzehistoryawscloudtrailtrail.FieldIncludeGlobalServiceEvents, bronzehistoryawscloudtrailtrail.FieldLogFileValidationEnabled, bronzehistoryawscloudtrailtrail.FieldHasCustomEventSelectors, bronzehistoryawscloudtrailtrail.FieldHasInsightSelectors, bronzehistoryawscloudtrailtrail.FieldIsLogging:
+			values[i] = new(sql.NullBool)
+		case bronzehistoryawscloudtrailtrail.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryawscloudtrailtrail.FieldResourceID, bronzehistoryawscloudtrailtrail.FieldName, bronzehistoryawscloudtrailtrail.FieldS3BucketName, bronzehistoryawscloudtrailtrail.FieldS3KeyPrefix, bronzehistoryawscloudtrailtrail.FieldSnsTopicArn, bronzehistoryawscloudtrailtrail.FieldKmsKeyID, bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn, bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsRoleArn, bronzehistoryawscloudtrailtrail.FieldAccountID, bronzehistoryawscloudtrailtrail.FieldRegion:
+			values[i] = new(sql.NullString)
+		case bronzehistoryawscloudtrailtrail.FieldValidFrom, bronzehistoryawscloudtrailtrail.FieldValidTo, bronzehistoryawscloudtrailtrail.FieldCollectedAt, bronzehistoryawscloudtrailtrail.FieldFirstCollectedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryAWSCloudTrailTrail fields.
+func (_m *BronzeHistoryAWSCloudTrailTrail) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawscloudtrailtrail.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryawscloudtrailtrail.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryawscloudtrailtrail.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryawscloudtrailtrail.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryawscloudtrailtrail.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryawscloudtrailtrail.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryawscloudtrailtrail.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzehistoryawscloudtrailtrail.FieldS3BucketName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field s3_bucket_name", values[i])
+			} else if value.Valid {
+				_m.S3BucketName = value.String
+			}
+		case bronzehistoryawscloudtrailtrail.FieldS3KeyPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field s3_key_prefix", values[i])
+			} else if value.Valid {
+				_m.S3KeyPrefix = value.String
+			}
+		case bronzehistoryawscloudtrailtrail.FieldSnsTopicArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sns_topic_arn", values[i])
+			} else if value.Valid {
+				_m.SnsTopicArn = value.String
+			}
+		case bronzehistoryawscloudtrailtrail.FieldIsMultiRegionTrail:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_multi_region_trail", values[i])
+			} else if value.Valid {
+				_m.IsMultiRegionTrail = value.Bool
+			}
+		case bronzehistoryawscloudtrailtrail.FieldIsOrganizationTrail:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_organization_trail", values[i])
+			} else if value.Valid {
+				_m.IsOrganizationTrail = value.Bool
+			}
+		case bronzehistoryawscloudtrailtrail.FieldIncludeGlobalServiceEvents:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field include_global_service_events", values[i])
+			} else if value.Valid {
+				_m.IncludeGlobalServiceEvents = value.Bool
+			}
+		case bronzehistoryawscloudtrailtrail.FieldLogFileValidationEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field log_file_validation_enabled", values[i])
+			} else if value.Valid {
+				_m.LogFileValidationEnabled = value.Bool
+			}
+		case bronzehistoryawscloudtrailtrail.FieldKmsKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kms_key_id", values[i])
+			} else if value.Valid {
+				_m.KmsKeyID = value.String
+			}
+		case bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cloud_watch_logs_log_group_arn", values[i])
+			} else if value.Valid {
+				_m.CloudWatchLogsLogGroupArn = value.String
+			}
+		case bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsRoleArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cloud_watch_logs_role_arn", values[i])
+			} else if value.Valid {
+				_m.CloudWatchLogsRoleArn = value.String
+			}
+		case bronzehistoryawscloudtrailtrail.FieldHasCustomEventSelectors:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field has_custom_event_selectors", values[i])
+			} else if value.Valid {
+				_m.HasCustomEventSelectors = value.Bool
+			}
+		case bronzehistoryawscloudtrailtrail.FieldHasInsightSelectors:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field has_insight_selectors", values[i])
+			} else if value.Valid {
+				_m.HasInsightSelectors = value.Bool
+			}
+		case bronzehistoryawscloudtrailtrail.FieldIsLogging:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_logging", values[i])
+			} else if value.Valid {
+				_m.IsLogging = value.Bool
+			}
+		case bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field event_selectors_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EventSelectorsJSON); err != nil {
+					return fmt.Errorf("unmarshal field event_selectors_json: %w", err)
+				}
+			}
+		case bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field advanced_event_selectors_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AdvancedEventSelectorsJSON); err != nil {
+					return fmt.Errorf("unmarshal field advanced_event_selectors_json: %w", err)
+				}
+			}
+		case bronzehistoryawscloudtrailtrail.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzehistoryawscloudtrailtrail.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzehistoryawscloudtrailtrail.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryAWSCloudTrailTrail.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryAWSCloudTrailTrail) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryAWSCloudTrailTrail.
+// Note that you need to call BronzeHistoryAWSCloudTrailTrail.Unwrap() before calling this method if this BronzeHistoryAWSCloudTrailTrail
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryAWSCloudTrailTrail) Update() *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	return NewBronzeHistoryAWSCloudTrailTrailClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryAWSCloudTrailTrail entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryAWSCloudTrailTrail) Unwrap() *BronzeHistoryAWSCloudTrailTrail {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("cloudtrail: BronzeHistoryAWSCloudTrailTrail is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryAWSCloudTrailTrail) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryAWSCloudTrailTrail(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("s3_bucket_name=")
+	builder.WriteString(_m.S3BucketName)
+	builder.WriteString(", ")
+	builder.WriteString("s3_key_prefix=")
+	builder.WriteString(_m.S3KeyPrefix)
+	builder.WriteString(", ")
+	builder.WriteString("sns_topic_arn=")
+	builder.WriteString(_m.SnsTopicArn)
+	builder.WriteString(", ")
+	builder.WriteString("is_multi_region_trail=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsMultiRegionTrail))
+	builder.WriteString(", ")
+	builder.WriteString("is_organization_trail=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsOrganizationTrail))
+	builder.WriteString(", ")
+	builder.WriteString("include_global_service_events=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IncludeGlobalServiceEvents))
+	builder.WriteString(", ")
+	builder.WriteString("log_file_validation_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LogFileValidationEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("kms_key_id=")
+	builder.WriteString(_m.KmsKeyID)
+	builder.WriteString(", ")
+	builder.WriteString("cloud_watch_logs_log_group_arn=")
+	builder.WriteString(_m.CloudWatchLogsLogGroupArn)
+	builder.WriteString(", ")
+	builder.WriteString("cloud_watch_logs_role_arn=")
+	builder.WriteString(_m.CloudWatchLogsRoleArn)
+	builder.WriteString(", ")
+	builder.WriteString("has_custom_event_selectors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HasCustomEventSelectors))
+	builder.WriteString(", ")
+	builder.WriteString("has_insight_selectors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HasInsightSelectors))
+	builder.WriteString(", ")
+	builder.WriteString("is_logging=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsLogging))
+	builder.WriteString(", ")
+	builder.WriteString("event_selectors_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventSelectorsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("advanced_event_selectors_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AdvancedEventSelectorsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryAWSCloudTrailTrails is a parsable slice of BronzeHistoryAWSCloudTrailTrail.
+type BronzeHistoryAWSCloudTrailTrails []*BronzeHistoryAWSCloudTrailTrail
@@ -0,0 +1,1235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryawscloudtrailtrail
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLTE(FieldID, id))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldValidTo, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldResourceID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldName, v))
+}
+
+// S3BucketName applies equality check predicate on the "s3_bucket_name" field. It's identical to S3BucketNameEQ.
+func S3BucketName(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldS3BucketName, v))
+}
+
+// S3KeyPrefix applies equality check predicate on the "s3_key_prefix" field. It's identical to S3KeyPrefixEQ.
+func S3KeyPrefix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldS3KeyPrefix, v))
+}
+
+// SnsTopicArn applies equality check predicate on the "sns_topic_arn" field. It's identical to SnsTopicArnEQ.
+func SnsTopicArn(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldSnsTopicArn, v))
+}
+
+// IsMultiRegionTrail applies equality check predicate on the "is_multi_region_trail" field. It's identical to IsMultiRegionTrailEQ.
+func IsMultiRegionTrail(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldIsMultiRegionTrail, v))
+}
+
+// IsOrganizationTrail applies equality check predicate on the "is_organization_trail" field. It's identical to IsOrganizationTrailEQ.
+func IsOrganizationTrail(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldIsOrganizationTrail, v))
+}
+
+// IncludeGlobalServiceEvents applies equality check predicate on the "include_global_service_events" field. It's identical to IncludeGlobalServiceEventsEQ.
+func IncludeGlobalServiceEvents(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldIncludeGlobalServiceEvents, v))
+}
+
+// LogFileValidationEnabled applies equality check predicate on the "log_file_validation_enabled" field. It's identical to LogFileValidationEnabledEQ.
+func LogFileValidationEnabled(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldLogFileValidationEnabled, v))
+}
+
+// KmsKeyID applies equality check predicate on the "kms_key_id" field. It's identical to KmsKeyIDEQ.
+func KmsKeyID(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldKmsKeyID, v))
+}
+
+// CloudWatchLogsLogGroupArn applies equality check predicate on the "cloud_watch_logs_log_group_arn" field. It's identical to CloudWatchLogsLogGroupArnEQ.
+func CloudWatchLogsLogGroupArn(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldCloudWatchLogsLogGroupArn, v))
+}
+
+// CloudWatchLogsRoleArn applies equality check predicate on the "cloud_watch_logs_role_arn" field. It's identical to CloudWatchLogsRoleArnEQ.
+func CloudWatchLogsRoleArn(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldCloudWatchLogsRoleArn, v))
+}
+
+// HasCustomEventSelectors applies equality check predicate on the "has_custom_event_selectors" field. It's identical to HasCustomEventSelectorsEQ.
+func HasCustomEventSelectors(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldHasCustomEventSelectors, v))
+}
+
+// HasInsightSelectors applies equality check predicate on the "has_insight_selectors" field. It's identical to HasInsightSelectorsEQ.
+func HasInsightSelectors(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldHasInsightSelectors, v))
+}
+
+// IsLogging applies equality check predicate on the "is_logging" field. It's identical to IsLoggingEQ.
+func IsLogging(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldIsLogging, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldRegion, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotNull(FieldValidTo))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContainsFold(FieldName, v))
+}
+
+// S3BucketNameEQ applies the EQ predicate on the "s3_bucket_name" field.
+func S3BucketNameEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldS3BucketName, v))
+}
+
+// S3BucketNameNEQ applies the NEQ predicate on the "s3_bucket_name" field.
+func S3BucketNameNEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldS3BucketName, v))
+}
+
+// S3BucketNameIn applies the In predicate on the "s3_bucket_name" field.
+func S3BucketNameIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIn(FieldS3BucketName, vs...))
+}
+
+// S3BucketNameNotIn applies the NotIn predicate on the "s3_bucket_name" field.
+func S3BucketNameNotIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotIn(FieldS3BucketName, vs...))
+}
+
+// S3BucketNameGT applies the GT predicate on the "s3_bucket_name" field.
+func S3BucketNameGT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGT(FieldS3BucketName, v))
+}
+
+// S3BucketNameGTE applies the GTE predicate on the "s3_bucket_name" field.
+func S3BucketNameGTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGTE(FieldS3BucketName, v))
+}
+
+// S3BucketNameLT applies the LT predicate on the "s3_bucket_name" field.
+func S3BucketNameLT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLT(FieldS3BucketName, v))
+}
+
+// S3BucketNameLTE applies the LTE predicate on the "s3_bucket_name" field.
+func S3BucketNameLTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLTE(FieldS3BucketName, v))
+}
+
+// S3BucketNameContains applies the Contains predicate on the "s3_bucket_name" field.
+func S3BucketNameContains(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContains(FieldS3BucketName, v))
+}
+
+// S3BucketNameHasPrefix applies the HasPrefix predicate on the "s3_bucket_name" field.
+func S3BucketNameHasPrefix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasPrefix(FieldS3BucketName, v))
+}
+
+// S3BucketNameHasSuffix applies the HasSuffix predicate on the "s3_bucket_name" field.
+func S3BucketNameHasSuffix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasSuffix(FieldS3BucketName, v))
+}
+
+// S3BucketNameIsNil applies the IsNil predicate on the "s3_bucket_name" field.
+func S3BucketNameIsNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIsNull(FieldS3BucketName))
+}
+
+// S3BucketNameNotNil applies the NotNil predicate on the "s3_bucket_name" field.
+func S3BucketNameNotNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotNull(FieldS3BucketName))
+}
+
+// S3BucketNameEqualFold applies the EqualFold predicate on the "s3_bucket_name" field.
+func S3BucketNameEqualFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEqualFold(FieldS3BucketName, v))
+}
+
+// S3BucketNameContainsFold applies the ContainsFold predicate on the "s3_bucket_name" field.
+func S3BucketNameContainsFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContainsFold(FieldS3BucketName, v))
+}
+
+// S3KeyPrefixEQ applies the EQ predicate on the "s3_key_prefix" field.
+func S3KeyPrefixEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldS3KeyPrefix, v))
+}
+
+// S3KeyPrefixNEQ applies the NEQ predicate on the "s3_key_prefix" field.
+func S3KeyPrefixNEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldS3KeyPrefix, v))
+}
+
+// S3KeyPrefixIn applies the In predicate on the "s3_key_prefix" field.
+func S3KeyPrefixIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIn(FieldS3KeyPrefix, vs...))
+}
+
+// S3KeyPrefixNotIn applies the NotIn predicate on the "s3_key_prefix" field.
+func S3KeyPrefixNotIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotIn(FieldS3KeyPrefix, vs...))
+}
+
+// S3KeyPrefixGT applies the GT predicate on the "s3_key_prefix" field.
+func S3KeyPrefixGT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGT(FieldS3KeyPrefix, v))
+}
+
+// S3KeyPrefixGTE applies the GTE predicate on the "s3_key_prefix" field.
+func S3KeyPrefixGTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGTE(FieldS3KeyPrefix, v))
+}
+
+// S3KeyPrefixLT applies the LT predicate on the "s3_key_prefix" field.
+func S3KeyPrefixLT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLT(FieldS3KeyPrefix, v))
+}
+
+// S3KeyPrefixLTE applies the LTE predicate on the "s3_key_prefix" field.
+func S3KeyPrefixLTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLTE(FieldS3KeyPrefix, v))
+}
+
+// S3KeyPrefixContains applies the Contains predicate on the "s3_key_prefix" field.
+func S3KeyPrefixContains(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContains(FieldS3KeyPrefix, v))
+}
+
+// S3KeyPrefixHasPrefix applies the HasPrefix predicate on the "s3_key_prefix" field.
+func S3KeyPrefixHasPrefix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasPrefix(FieldS3KeyPrefix, v))
+}
+
+// S3KeyPrefixHasSuffix applies the HasSuffix predicate on the "s3_key_prefix" field.
+func S3KeyPrefixHasSuffix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasSuffix(FieldS3KeyPrefix, v))
+}
+
+// S3KeyPrefixIsNil applies the IsNil predicate on the "s3_key_prefix" field.
+func S3KeyPrefixIsNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIsNull(FieldS3KeyPrefix))
+}
+
+// S3KeyPrefixNotNil applies the NotNil predicate on the "s3_key_prefix" field.
+func S3KeyPrefixNotNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotNull(FieldS3KeyPrefix))
+}
+
+// S3KeyPrefixEqualFold applies the EqualFold predicate on the "s3_key_prefix" field.
+func S3KeyPrefixEqualFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEqualFold(FieldS3KeyPrefix, v))
+}
+
+// S3KeyPrefixContainsFold applies the ContainsFold predicate on the "s3_key_prefix" field.
+func S3KeyPrefixContainsFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContainsFold(FieldS3KeyPrefix, v))
+}
+
+// SnsTopicArnEQ applies the EQ predicate on the "sns_topic_arn" field.
+func SnsTopicArnEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldSnsTopicArn, v))
+}
+
+// SnsTopicArnNEQ applies the NEQ predicate on the "sns_topic_arn" field.
+func SnsTopicArnNEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldSnsTopicArn, v))
+}
+
+// SnsTopicArnIn applies the In predicate on the "sns_topic_arn" field.
+func SnsTopicArnIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIn(FieldSnsTopicArn, vs...))
+}
+
+// SnsTopicArnNotIn applies the NotIn predicate on the "sns_topic_arn" field.
+func SnsTopicArnNotIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotIn(FieldSnsTopicArn, vs...))
+}
+
+// SnsTopicArnGT applies the GT predicate on the "sns_topic_arn" field.
+func SnsTopicArnGT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGT(FieldSnsTopicArn, v))
+}
+
+// SnsTopicArnGTE applies the GTE predicate on the "sns_topic_arn" field.
+func SnsTopicArnGTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGTE(FieldSnsTopicArn, v))
+}
+
+// SnsTopicArnLT applies the LT predicate on the "sns_topic_arn" field.
+func SnsTopicArnLT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLT(FieldSnsTopicArn, v))
+}
+
+// SnsTopicArnLTE applies the LTE predicate on the "sns_topic_arn" field.
+func SnsTopicArnLTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLTE(FieldSnsTopicArn, v))
+}
+
+// SnsTopicArnContains applies the Contains predicate on the "sns_topic_arn" field.
+func SnsTopicArnContains(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContains(FieldSnsTopicArn, v))
+}
+
+// SnsTopicArnHasPrefix applies the HasPrefix predicate on the "sns_topic_arn" field.
+func SnsTopicArnHasPrefix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasPrefix(FieldSnsTopicArn, v))
+}
+
+// SnsTopicArnHasSuffix applies the HasSuffix predicate on the "sns_topic_arn" field.
+func SnsTopicArnHasSuffix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasSuffix(FieldSnsTopicArn, v))
+}
+
+// SnsTopicArnIsNil applies the IsNil predicate on the "sns_topic_arn" field.
+func SnsTopicArnIsNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIsNull(FieldSnsTopicArn))
+}
+
+// SnsTopicArnNotNil applies the NotNil predicate on the "sns_topic_arn" field.
+func SnsTopicArnNotNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotNull(FieldSnsTopicArn))
+}
+
+// SnsTopicArnEqualFold applies the EqualFold predicate on the "sns_topic_arn" field.
+func SnsTopicArnEqualFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEqualFold(FieldSnsTopicArn, v))
+}
+
+// SnsTopicArnContainsFold applies the ContainsFold predicate on the "sns_topic_arn" field.
+func SnsTopicArnContainsFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContainsFold(FieldSnsTopicArn, v))
+}
+
+// IsMultiRegionTrailEQ applies the EQ predicate on the "is_multi_region_trail" field.
+func IsMultiRegionTrailEQ(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldIsMultiRegionTrail, v))
+}
+
+// IsMultiRegionTrailNEQ applies the NEQ predicate on the "is_multi_region_trail" field.
+func IsMultiRegionTrailNEQ(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldIsMultiRegionTrail, v))
+}
+
+// IsMultiRegionTrailIsNil applies the IsNil predicate on the "is_multi_region_trail" field.
+func IsMultiRegionTrailIsNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIsNull(FieldIsMultiRegionTrail))
+}
+
+// IsMultiRegionTrailNotNil applies the NotNil predicate on the "is_multi_region_trail" field.
+func IsMultiRegionTrailNotNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotNull(FieldIsMultiRegionTrail))
+}
+
+// IsOrganizationTrailEQ applies the EQ predicate on the "is_organization_trail" field.
+func IsOrganizationTrailEQ(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldIsOrganizationTrail, v))
+}
+
+// IsOrganizationTrailNEQ applies the NEQ predicate on the "is_organization_trail" field.
+func IsOrganizationTrailNEQ(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldIsOrganizationTrail, v))
+}
+
+// IsOrganizationTrailIsNil applies the IsNil predicate on the "is_organization_trail" field.
+func IsOrganizationTrailIsNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIsNull(FieldIsOrganizationTrail))
+}
+
+// IsOrganizationTrailNotNil applies the NotNil predicate on the "is_organization_trail" field.
+func IsOrganizationTrailNotNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotNull(FieldIsOrganizationTrail))
+}
+
+// IncludeGlobalServiceEventsEQ applies the EQ predicate on the "include_global_service_events" field.
+func IncludeGlobalServiceEventsEQ(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldIncludeGlobalServiceEvents, v))
+}
+
+// IncludeGlobalServiceEventsNEQ applies the NEQ predicate on the "include_global_service_events" field.
+func IncludeGlobalServiceEventsNEQ(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldIncludeGlobalServiceEvents, v))
+}
+
+// IncludeGlobalServiceEventsIsNil applies the IsNil predicate on the "include_global_service_events" field.
+func IncludeGlobalServiceEventsIsNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIsNull(FieldIncludeGlobalServiceEvents))
+}
+
+// IncludeGlobalServiceEventsNotNil applies the NotNil predicate on the "include_global_service_events" field.
+func IncludeGlobalServiceEventsNotNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotNull(FieldIncludeGlobalServiceEvents))
+}
+
+// LogFileValidationEnabledEQ applies the EQ predicate on the "log_file_validation_enabled" field.
+func LogFileValidationEnabledEQ(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldLogFileValidationEnabled, v))
+}
+
+// LogFileValidationEnabledNEQ applies the NEQ predicate on the "log_file_validation_enabled" field.
+func LogFileValidationEnabledNEQ(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldLogFileValidationEnabled, v))
+}
+
+// LogFileValidationEnabledIsNil applies the IsNil predicate on the "log_file_validation_enabled" field.
+func LogFileValidationEnabledIsNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIsNull(FieldLogFileValidationEnabled))
+}
+
+// LogFileValidationEnabledNotNil applies the NotNil predicate on the "log_file_validation_enabled" field.
+func LogFileValidationEnabledNotNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotNull(FieldLogFileValidationEnabled))
+}
+
+// KmsKeyIDEQ applies the EQ predicate on the "kms_key_id" field.
+func KmsKeyIDEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldKmsKeyID, v))
+}
+
+// KmsKeyIDNEQ applies the NEQ predicate on the "kms_key_id" field.
+func KmsKeyIDNEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldKmsKeyID, v))
+}
+
+// KmsKeyIDIn applies the In predicate on the "kms_key_id" field.
+func KmsKeyIDIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIn(FieldKmsKeyID, vs...))
+}
+
+// KmsKeyIDNotIn applies the NotIn predicate on the "kms_key_id" field.
+func KmsKeyIDNotIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotIn(FieldKmsKeyID, vs...))
+}
+
+// KmsKeyIDGT applies the GT predicate on the "kms_key_id" field.
+func KmsKeyIDGT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGT(FieldKmsKeyID, v))
+}
+
+// KmsKeyIDGTE applies the GTE predicate on the "kms_key_id" field.
+func KmsKeyIDGTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGTE(FieldKmsKeyID, v))
+}
+
+// KmsKeyIDLT applies the LT predicate on the "kms_key_id" field.
+func KmsKeyIDLT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLT(FieldKmsKeyID, v))
+}
+
+// KmsKeyIDLTE applies the LTE predicate on the "kms_key_id" field.
+func KmsKeyIDLTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLTE(FieldKmsKeyID, v))
+}
+
+// KmsKeyIDContains applies the Contains predicate on the "kms_key_id" field.
+func KmsKeyIDContains(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContains(FieldKmsKeyID, v))
+}
+
+// KmsKeyIDHasPrefix applies the HasPrefix predicate on the "kms_key_id" field.
+func KmsKeyIDHasPrefix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasPrefix(FieldKmsKeyID, v))
+}
+
+// KmsKeyIDHasSuffix applies the HasSuffix predicate on the "kms_key_id" field.
+func KmsKeyIDHasSuffix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasSuffix(FieldKmsKeyID, v))
+}
+
+// KmsKeyIDIsNil applies the IsNil predicate on the "kms_key_id" field.
+func KmsKeyIDIsNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIsNull(FieldKmsKeyID))
+}
+
+// KmsKeyIDNotNil applies the NotNil predicate on the "kms_key_id" field.
+func KmsKeyIDNotNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotNull(FieldKmsKeyID))
+}
+
+// KmsKeyIDEqualFold applies the EqualFold predicate on the "kms_key_id" field.
+func KmsKeyIDEqualFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEqualFold(FieldKmsKeyID, v))
+}
+
+// KmsKeyIDContainsFold applies the ContainsFold predicate on the "kms_key_id" field.
+func KmsKeyIDContainsFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContainsFold(FieldKmsKeyID, v))
+}
+
+// CloudWatchLogsLogGroupArnEQ applies the EQ predicate on the "cloud_watch_logs_log_group_arn" field.
+func CloudWatchLogsLogGroupArnEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldCloudWatchLogsLogGroupArn, v))
+}
+
+// CloudWatchLogsLogGroupArnNEQ applies the NEQ predicate on the "cloud_watch_logs_log_group_arn" field.
+func CloudWatchLogsLogGroupArnNEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldCloudWatchLogsLogGroupArn, v))
+}
+
+// CloudWatchLogsLogGroupArnIn applies the In predicate on the "cloud_watch_logs_log_group_arn" field.
+func CloudWatchLogsLogGroupArnIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIn(FieldCloudWatchLogsLogGroupArn, vs...))
+}
+
+// CloudWatchLogsLogGroupArnNotIn applies the NotIn predicate on the "cloud_watch_logs_log_group_arn" field.
+func CloudWatchLogsLogGroupArnNotIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotIn(FieldCloudWatchLogsLogGroupArn, vs...))
+}
+
+// CloudWatchLogsLogGroupArnGT applies the GT predicate on the "cloud_watch_logs_log_group_arn" field.
+func CloudWatchLogsLogGroupArnGT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGT(FieldCloudWatchLogsLogGroupArn, v))
+}
+
+// CloudWatchLogsLogGroupArnGTE applies the GTE predicate on the "cloud_watch_logs_log_group_arn" field.
+func CloudWatchLogsLogGroupArnGTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGTE(FieldCloudWatchLogsLogGroupArn, v))
+}
+
+// CloudWatchLogsLogGroupArnLT applies the LT predicate on the "cloud_watch_logs_log_group_arn" field.
+func CloudWatchLogsLogGroupArnLT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLT(FieldCloudWatchLogsLogGroupArn, v))
+}
+
+// CloudWatchLogsLogGroupArnLTE applies the LTE predicate on the "cloud_watch_logs_log_group_arn" field.
+func CloudWatchLogsLogGroupArnLTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLTE(FieldCloudWatchLogsLogGroupArn, v))
+}
+
+// CloudWatchLogsLogGroupArnContains applies the Contains predicate on the "cloud_watch_logs_log_group_arn" field.
+func CloudWatchLogsLogGroupArnContains(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContains(FieldCloudWatchLogsLogGroupArn, v))
+}
+
+// CloudWatchLogsLogGroupArnHasPrefix applies the HasPrefix predicate on the "cloud_watch_logs_log_group_arn" field.
+func CloudWatchLogsLogGroupArnHasPrefix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasPrefix(FieldCloudWatchLogsLogGroupArn, v))
+}
+
+// CloudWatchLogsLogGroupArnHasSuffix applies the HasSuffix predicate on the "cloud_watch_logs_log_group_arn" field.
+func CloudWatchLogsLogGroupArnHasSuffix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasSuffix(FieldCloudWatchLogsLogGroupArn, v))
+}
+
+// CloudWatchLogsLogGroupArnIsNil applies the IsNil predicate on the "cloud_watch_logs_log_group_arn" field.
+func CloudWatchLogsLogGroupArnIsNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIsNull(FieldCloudWatchLogsLogGroupArn))
+}
+
+// CloudWatchLogsLogGroupArnNotNil applies the NotNil predicate on the "cloud_watch_logs_log_group_arn" field.
+func CloudWatchLogsLogGroupArnNotNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotNull(FieldCloudWatchLogsLogGroupArn))
+}
+
+// CloudWatchLogsLogGroupArnEqualFold applies the EqualFold predicate on the "cloud_watch_logs_log_group_arn" field.
+func CloudWatchLogsLogGroupArnEqualFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEqualFold(FieldCloudWatchLogsLogGroupArn, v))
+}
+
+// CloudWatchLogsLogGroupArnContainsFold applies the ContainsFold predicate on the "cloud_watch_logs_log_group_arn" field.
+func CloudWatchLogsLogGroupArnContainsFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContainsFold(FieldCloudWatchLogsLogGroupArn, v))
+}
+
+// CloudWatchLogsRoleArnEQ applies the EQ predicate on the "cloud_watch_logs_role_arn" field.
+func CloudWatchLogsRoleArnEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldCloudWatchLogsRoleArn, v))
+}
+
+// CloudWatchLogsRoleArnNEQ applies the NEQ predicate on the "cloud_watch_logs_role_arn" field.
+func CloudWatchLogsRoleArnNEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldCloudWatchLogsRoleArn, v))
+}
+
+// CloudWatchLogsRoleArnIn applies the In predicate on the "cloud_watch_logs_role_arn" field.
+func CloudWatchLogsRoleArnIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIn(FieldCloudWatchLogsRoleArn, vs...))
+}
+
+// CloudWatchLogsRoleArnNotIn applies the NotIn predicate on the "cloud_watch_logs_role_arn" field.
+func CloudWatchLogsRoleArnNotIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotIn(FieldCloudWatchLogsRoleArn, vs...))
+}
+
+// CloudWatchLogsRoleArnGT applies the GT predicate on the "cloud_watch_logs_role_arn" field.
+func CloudWatchLogsRoleArnGT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGT(FieldCloudWatchLogsRoleArn, v))
+}
+
+// CloudWatchLogsRoleArnGTE applies the GTE predicate on the "cloud_watch_logs_role_arn" field.
+func CloudWatchLogsRoleArnGTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGTE(FieldCloudWatchLogsRoleArn, v))
+}
+
+// CloudWatchLogsRoleArnLT applies the LT predicate on the "cloud_watch_logs_role_arn" field.
+func CloudWatchLogsRoleArnLT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLT(FieldCloudWatchLogsRoleArn, v))
+}
+
+// CloudWatchLogsRoleArnLTE applies the LTE predicate on the "cloud_watch_logs_role_arn" field.
+func CloudWatchLogsRoleArnLTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLTE(FieldCloudWatchLogsRoleArn, v))
+}
+
+// CloudWatchLogsRoleArnContains applies the Contains predicate on the "cloud_watch_logs_role_arn" field.
+func CloudWatchLogsRoleArnContains(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContains(FieldCloudWatchLogsRoleArn, v))
+}
+
+// CloudWatchLogsRoleArnHasPrefix applies the HasPrefix predicate on the "cloud_watch_logs_role_arn" field.
+func CloudWatchLogsRoleArnHasPrefix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasPrefix(FieldCloudWatchLogsRoleArn, v))
+}
+
+// CloudWatchLogsRoleArnHasSuffix applies the HasSuffix predicate on the "cloud_watch_logs_role_arn" field.
+func CloudWatchLogsRoleArnHasSuffix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasSuffix(FieldCloudWatchLogsRoleArn, v))
+}
+
+// CloudWatchLogsRoleArnIsNil applies the IsNil predicate on the "cloud_watch_logs_role_arn" field.
+func CloudWatchLogsRoleArnIsNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIsNull(FieldCloudWatchLogsRoleArn))
+}
+
+// CloudWatchLogsRoleArnNotNil applies the NotNil predicate on the "cloud_watch_logs_role_arn" field.
+func CloudWatchLogsRoleArnNotNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotNull(FieldCloudWatchLogsRoleArn))
+}
+
+// CloudWatchLogsRoleArnEqualFold applies the EqualFold predicate on the "cloud_watch_logs_role_arn" field.
+func CloudWatchLogsRoleArnEqualFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEqualFold(FieldCloudWatchLogsRoleArn, v))
+}
+
+// CloudWatchLogsRoleArnContainsFold applies the ContainsFold predicate on the "cloud_watch_logs_role_arn" field.
+func CloudWatchLogsRoleArnContainsFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContainsFold(FieldCloudWatchLogsRoleArn, v))
+}
+
+// HasCustomEventSelectorsEQ applies the EQ predicate on the "has_custom_event_selectors" field.
+func HasCustomEventSelectorsEQ(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldHasCustomEventSelectors, v))
+}
+
+// HasCustomEventSelectorsNEQ applies the NEQ predicate on the "has_custom_event_selectors" field.
+func HasCustomEventSelectorsNEQ(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldHasCustomEventSelectors, v))
+}
+
+// HasCustomEventSelectorsIsNil applies the IsNil predicate on the "has_custom_event_selectors" field.
+func HasCustomEventSelectorsIsNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIsNull(FieldHasCustomEventSelectors))
+}
+
+// HasCustomEventSelectorsNotNil applies the NotNil predicate on the "has_custom_event_selectors" field.
+func HasCustomEventSelectorsNotNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotNull(FieldHasCustomEventSelectors))
+}
+
+// HasInsightSelectorsEQ applies the EQ predicate on the "has_insight_selectors" field.
+func HasInsightSelectorsEQ(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldHasInsightSelectors, v))
+}
+
+// HasInsightSelectorsNEQ applies the NEQ predicate on the "has_insight_selectors" field.
+func HasInsightSelectorsNEQ(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldHasInsightSelectors, v))
+}
+
+// HasInsightSelectorsIsNil applies the IsNil predicate on the "has_insight_selectors" field.
+func HasInsightSelectorsIsNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIsNull(FieldHasInsightSelectors))
+}
+
+// HasInsightSelectorsNotNil applies the NotNil predicate on the "has_insight_selectors" field.
+func HasInsightSelectorsNotNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotNull(FieldHasInsightSelectors))
+}
+
+// IsLoggingEQ applies the EQ predicate on the "is_logging" field.
+func IsLoggingEQ(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldIsLogging, v))
+}
+
+// IsLoggingNEQ applies the NEQ predicate on the "is_logging" field.
+func IsLoggingNEQ(v bool) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldIsLogging, v))
+}
+
+// IsLoggingIsNil applies the IsNil predicate on the "is_logging" field.
+func IsLoggingIsNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIsNull(FieldIsLogging))
+}
+
+// IsLoggingNotNil applies the NotNil predicate on the "is_logging" field.
+func IsLoggingNotNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotNull(FieldIsLogging))
+}
+
+// EventSelectorsJSONIsNil applies the IsNil predicate on the "event_selectors_json" field.
+func EventSelectorsJSONIsNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIsNull(FieldEventSelectorsJSON))
+}
+
+// EventSelectorsJSONNotNil applies the NotNil predicate on the "event_selectors_json" field.
+func EventSelectorsJSONNotNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotNull(FieldEventSelectorsJSON))
+}
+
+// AdvancedEventSelectorsJSONIsNil applies the IsNil predicate on the "advanced_event_selectors_json" field.
+func AdvancedEventSelectorsJSONIsNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIsNull(FieldAdvancedEventSelectorsJSON))
+}
+
+// AdvancedEventSelectorsJSONNotNil applies the NotNil predicate on the "advanced_event_selectors_json" field.
+func AdvancedEventSelectorsJSONNotNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotNull(FieldAdvancedEventSelectorsJSON))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeHistoryAWSCloudTrailTrail) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeHistoryAWSCloudTrailTrail) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeHistoryAWSCloudTrailTrail) predicate.BronzeHistoryAWSCloudTrailTrail {
+	return predicate.BronzeHistoryAWSCloudTrailTrail(sql.NotPredicates(p))
+}
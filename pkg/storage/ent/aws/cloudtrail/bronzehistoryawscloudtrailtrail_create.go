@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cloudtrail
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/bronzehistoryawscloudtrailtrail"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSCloudTrailTrailCreate is the builder for creating a BronzeHistoryAWSCloudTrailTrail entity.
+type BronzeHistoryAWSCloudTrailTrailCreate struct {
+	config
+	mutation *BronzeHistoryAWSCloudTrailTrailMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetValidFrom(v time.Time) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetValidTo(v time.Time) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetCollectedAt(v time.Time) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetResourceID(v string) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetName(v string) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetNillableName(v *string) *BronzeHistoryAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetS3BucketName sets the "s3_bucket_name" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetS3BucketName(v string) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetS3BucketName(v)
+	return _c
+}
+
+// SetNillableS3BucketName sets the "s3_bucket_name" field if the given value is not nil.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetNillableS3BucketName(v *string) *BronzeHistoryAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetS3BucketName(*v)
+	}
+	return _c
+}
+
+// SetS3KeyPrefix sets the "s3_key_prefix" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetS3KeyPrefix(v string) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetS3KeyPrefix(v)
+	return _c
+}
+
+// SetNillableS3KeyPrefix sets the "s3_key_prefix" field if the given value is not nil.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetNillableS3KeyPrefix(v *string) *BronzeHistoryAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetS3KeyPrefix(*v)
+	}
+	return _c
+}
+
+// SetSnsTopicArn sets the "sns_topic_arn" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetSnsTopicArn(v string) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetSnsTopicArn(v)
+	return _c
+}
+
+// SetNillableSnsTopicArn sets the "sns_topic_arn" field if the given value is not nil.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetNillableSnsTopicArn(v *string) *BronzeHistoryAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetSnsTopicArn(*v)
+	}
+	return _c
+}
+
+// SetIsMultiRegionTrail sets the "is_multi_region_trail" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetIsMultiRegionTrail(v bool) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetIsMultiRegionTrail(v)
+	return _c
+}
+
+// SetNillableIsMultiRegionTrail sets the "is_multi_region_trail" field if the given value is not nil.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetNillableIsMultiRegionTrail(v *bool) *BronzeHistoryAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetIsMultiRegionTrail(*v)
+	}
+	return _c
+}
+
+// SetIsOrganizationTrail sets the "is_organization_trail" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetIsOrganizationTrail(v bool) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetIsOrganizationTrail(v)
+	return _c
+}
+
+// SetNillableIsOrganizationTrail sets the "is_organization_trail" field if the given value is not nil.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetNillableIsOrganizationTrail(v *bool) *BronzeHistoryAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetIsOrganizationTrail(*v)
+	}
+	return _c
+}
+
+// SetIncludeGlobalServiceEvents sets the "include_global_service_events" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetIncludeGlobalServiceEvents(v bool) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetIncludeGlobalServiceEvents(v)
+	return _c
+}
+
+// SetNillableIncludeGlobalServiceEvents sets the "include_global_service_events" field if the given value is not nil.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetNillableIncludeGlobalServiceEvents(v *bool) *BronzeHistoryAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetIncludeGlobalServiceEvents(*v)
+	}
+	return _c
+}
+
+// SetLogFileValidationEnabled sets the "log_file_validation_enabled" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetLogFileValidationEnabled(v bool) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetLogFileValidationEnabled(v)
+	return _c
+}
+
+// SetNillableLogFileValidationEnabled sets the "log_file_validation_enabled" field if the given value is not nil.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetNillableLogFileValidationEnabled(v *bool) *BronzeHistoryAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetLogFileValidationEnabled(*v)
+	}
+	return _c
+}
+
+// SetKmsKeyID sets the "kms_key_id" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetKmsKeyID(v string) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetKmsKeyID(v)
+	return _c
+}
+
+// SetNillableKmsKeyID sets the "kms_key_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetNillableKmsKeyID(v *string) *BronzeHistoryAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetKmsKeyID(*v)
+	}
+	return _c
+}
+
+// SetCloudWatchLogsLogGroupArn sets the "cloud_watch_logs_log_group_arn" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetCloudWatchLogsLogGroupArn(v string) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetCloudWatchLogsLogGroupArn(v)
+	return _c
+}
+
+// SetNillableCloudWatchLogsLogGroupArn sets the "cloud_watch_logs_log_group_arn" field if the given value is not nil.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetNillableCloudWatchLogsLogGroupArn(v *string) *BronzeHistoryAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetCloudWatchLogsLogGroupArn(*v)
+	}
+	return _c
+}
+
+// SetCloudWatchLogsRoleArn sets the "cloud_watch_logs_role_arn" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetCloudWatchLogsRoleArn(v string) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetCloudWatchLogsRoleArn(v)
+	return _c
+}
+
+// SetNillableCloudWatchLogsRoleArn sets the "cloud_watch_logs_role_arn" field if the given value is not nil.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetNillableCloudWatchLogsRoleArn(v *string) *BronzeHistoryAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetCloudWatchLogsRoleArn(*v)
+	}
+	return _c
+}
+
+// SetHasCustomEventSelectors sets the "has_custom_event_selectors" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetHasCustomEventSelectors(v bool) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetHasCustomEventSelectors(v)
+	return _c
+}
+
+// SetNillableHasCustomEventSelectors sets the "has_custom_event_selectors" field if the given value is not nil.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetNillableHasCustomEventSelectors(v *bool) *BronzeHistoryAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetHasCustomEventSelectors(*v)
+	}
+	return _c
+}
+
+// SetHasInsightSelectors sets the "has_insight_selectors" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetHasInsightSelectors(v bool) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetHasInsightSelectors(v)
+	return _c
+}
+
+// SetNillableHasInsightSelectors sets the "has_insight_selectors" field if the given value is not nil.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetNillableHasInsightSelectors(v *bool) *BronzeHistoryAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetHasInsightSelectors(*v)
+	}
+	return _c
+}
+
+// SetIsLogging sets the "is_logging" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetIsLogging(v bool) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetIsLogging(v)
+	return _c
+}
+
+// SetNillableIsLogging sets the "is_logging" field if the given value is not nil.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetNillableIsLogging(v *bool) *BronzeHistoryAWSCloudTrailTrailCreate {
+	if v != nil {
+		_c.SetIsLogging(*v)
+	}
+	return _c
+}
+
+// SetEventSelectorsJSON sets the "event_selectors_json" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetEventSelectorsJSON(v json.RawMessage) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetEventSelectorsJSON(v)
+	return _c
+}
+
+// SetAdvancedEventSelectorsJSON sets the "advanced_event_selectors_json" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetAdvancedEventSelectorsJSON(v json.RawMessage) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetAdvancedEventSelectorsJSON(v)
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetAccountID(v string) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetRegion(v string) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SetID(v uint) *BronzeHistoryAWSCloudTrailTrailCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryAWSCloudTrailTrailMutation object of the builder.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) Mutation() *BronzeHistoryAWSCloudTrailTrailMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryAWSCloudTrailTrail in the database.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) Save(ctx context.Context) (*BronzeHistoryAWSCloudTrailTrail, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) SaveX(ctx context.Context) *BronzeHistoryAWSCloudTrailTrail {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`cloudtrail: missing required field "BronzeHistoryAWSCloudTrailTrail.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`cloudtrail: missing required field "BronzeHistoryAWSCloudTrailTrail.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`cloudtrail: missing required field "BronzeHistoryAWSCloudTrailTrail.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`cloudtrail: missing required field "BronzeHistoryAWSCloudTrailTrail.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryawscloudtrailtrail.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`cloudtrail: validator failed for field "BronzeHistoryAWSCloudTrailTrail.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`cloudtrail: missing required field "BronzeHistoryAWSCloudTrailTrail.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzehistoryawscloudtrailtrail.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`cloudtrail: validator failed for field "BronzeHistoryAWSCloudTrailTrail.account_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`cloudtrail: missing required field "BronzeHistoryAWSCloudTrailTrail.region"`)}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := bronzehistoryawscloudtrailtrail.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`cloudtrail: validator failed for field "BronzeHistoryAWSCloudTrailTrail.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) sqlSave(ctx context.Context) (*BronzeHistoryAWSCloudTrailTrail, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryAWSCloudTrailTrailCreate) createSpec() (*BronzeHistoryAWSCloudTrailTrail, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryAWSCloudTrailTrail{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryawscloudtrailtrail.Table, sqlgraph.NewFieldSpec(bronzehistoryawscloudtrailtrail.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryAWSCloudTrailTrail
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.S3BucketName(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldS3BucketName, field.TypeString, value)
+		_node.S3BucketName = value
+	}
+	if value, ok := _c.mutation.S3KeyPrefix(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldS3KeyPrefix, field.TypeString, value)
+		_node.S3KeyPrefix = value
+	}
+	if value, ok := _c.mutation.SnsTopicArn(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldSnsTopicArn, field.TypeString, value)
+		_node.SnsTopicArn = value
+	}
+	if value, ok := _c.mutation.IsMultiRegionTrail(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldIsMultiRegionTrail, field.TypeBool, value)
+		_node.IsMultiRegionTrail = value
+	}
+	if value, ok := _c.mutation.IsOrganizationTrail(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldIsOrganizationTrail, field.TypeBool, value)
+		_node.IsOrganizationTrail = value
+	}
+	if value, ok := _c.mutation.IncludeGlobalServiceEvents(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldIncludeGlobalServiceEvents, field.TypeBool, value)
+		_node.IncludeGlobalServiceEvents = value
+	}
+	if value, ok := _c.mutation.LogFileValidationEnabled(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldLogFileValidationEnabled, field.TypeBool, value)
+		_node.LogFileValidationEnabled = value
+	}
+	if value, ok := _c.mutation.KmsKeyID(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldKmsKeyID, field.TypeString, value)
+		_node.KmsKeyID = value
+	}
+	if value, ok := _c.mutation.CloudWatchLogsLogGroupArn(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn, field.TypeString, value)
+		_node.CloudWatchLogsLogGroupArn = value
+	}
+	if value, ok := _c.mutation.CloudWatchLogsRoleArn(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsRoleArn, field.TypeString, value)
+		_node.CloudWatchLogsRoleArn = value
+	}
+	if value, ok := _c.mutation.HasCustomEventSelectors(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldHasCustomEventSelectors, field.TypeBool, value)
+		_node.HasCustomEventSelectors = value
+	}
+	if value, ok := _c.mutation.HasInsightSelectors(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldHasInsightSelectors, field.TypeBool, value)
+		_node.HasInsightSelectors = value
+	}
+	if value, ok := _c.mutation.IsLogging(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldIsLogging, field.TypeBool, value)
+		_node.IsLogging = value
+	}
+	if value, ok := _c.mutation.EventSelectorsJSON(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON, field.TypeJSON, value)
+		_node.EventSelectorsJSON = value
+	}
+	if value, ok := _c.mutation.AdvancedEventSelectorsJSON(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON, field.TypeJSON, value)
+		_node.AdvancedEventSelectorsJSON = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryAWSCloudTrailTrailCreateBulk is the builder for creating many BronzeHistoryAWSCloudTrailTrail entities in bulk.
+type BronzeHistoryAWSCloudTrailTrailCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryAWSCloudTrailTrailCreate
+}
+
+// Save creates the BronzeHistoryAWSCloudTrailTrail entities in the database.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryAWSCloudTrailTrail, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryAWSCloudTrailTrail, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryAWSCloudTrailTrailMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryAWSCloudTrailTrail {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSCloudTrailTrailCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cloudtrail
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/bronzehistoryawscloudtrailtrail"
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSCloudTrailTrailDelete is the builder for deleting a BronzeHistoryAWSCloudTrailTrail entity.
+type BronzeHistoryAWSCloudTrailTrailDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSCloudTrailTrailMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSCloudTrailTrailDelete builder.
+func (_d *BronzeHistoryAWSCloudTrailTrailDelete) Where(ps ...predicate.BronzeHistoryAWSCloudTrailTrail) *BronzeHistoryAWSCloudTrailTrailDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeHistoryAWSCloudTrailTrailDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryAWSCloudTrailTrailDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeHistoryAWSCloudTrailTrailDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzehistoryawscloudtrailtrail.Table, sqlgraph.NewFieldSpec(bronzehistoryawscloudtrailtrail.FieldID, field.TypeUint))
+	_spec.Node.Schema = _d.schemaConfig.BronzeHistoryAWSCloudTrailTrail
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeHistoryAWSCloudTrailTrailDeleteOne is the builder for deleting a single BronzeHistoryAWSCloudTrailTrail entity.
+type BronzeHistoryAWSCloudTrailTrailDeleteOne struct {
+	_d *BronzeHistoryAWSCloudTrailTrailDelete
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSCloudTrailTrailDelete builder.
+func (_d *BronzeHistoryAWSCloudTrailTrailDeleteOne) Where(ps ...predicate.BronzeHistoryAWSCloudTrailTrail) *BronzeHistoryAWSCloudTrailTrailDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeHistoryAWSCloudTrailTrailDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzehistoryawscloudtrailtrail.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryAWSCloudTrailTrailDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cloudtrail
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/bronzehistoryawscloudtrailtrail"
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/predicate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSCloudTrailTrailQuery is the builder for querying BronzeHistoryAWSCloudTrailTrail entities.
+type BronzeHistoryAWSCloudTrailTrailQuery struct {
+	config
+	ctx        *QueryContext
+	order      []bronzehistoryawscloudtrailtrail.OrderOption
+	inters     []Interceptor
+	predicates []predicate.BronzeHistoryAWSCloudTrailTrail
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BronzeHistoryAWSCloudTrailTrailQuery builder.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) Where(ps ...predicate.BronzeHistoryAWSCloudTrailTrail) *BronzeHistoryAWSCloudTrailTrailQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) Limit(limit int) *BronzeHistoryAWSCloudTrailTrailQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) Offset(offset int) *BronzeHistoryAWSCloudTrailTrailQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) Unique(unique bool) *BronzeHistoryAWSCloudTrailTrailQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) Order(o ...bronzehistoryawscloudtrailtrail.OrderOption) *BronzeHistoryAWSCloudTrailTrailQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// First returns the first BronzeHistoryAWSCloudTrailTrail entity from the query.
+// Returns a *NotFoundError when no BronzeHistoryAWSCloudTrailTrail was found.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) First(ctx context.Context) (*BronzeHistoryAWSCloudTrailTrail, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{bronzehistoryawscloudtrailtrail.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) FirstX(ctx context.Context) *BronzeHistoryAWSCloudTrailTrail {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BronzeHistoryAWSCloudTrailTrail ID from the query.
+// Returns a *NotFoundError when no BronzeHistoryAWSCloudTrailTrail ID was found.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) FirstID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{bronzehistoryawscloudtrailtrail.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) FirstIDX(ctx context.Context) uint {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BronzeHistoryAWSCloudTrailTrail entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BronzeHistoryAWSCloudTrailTrail entity is found.
+// Returns a *NotFoundError when no BronzeHistoryAWSCloudTrailTrail entities are found.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) Only(ctx context.Context) (*BronzeHistoryAWSCloudTrailTrail, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{bronzehistoryawscloudtrailtrail.Label}
+	default:
+		return nil, &NotSingularError{bronzehistoryawscloudtrailtrail.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) OnlyX(ctx context.Context) *BronzeHistoryAWSCloudTrailTrail {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BronzeHistoryAWSCloudTrailTrail ID in the query.
+// Returns a *NotSingularError when more than one BronzeHistoryAWSCloudTrailTrail ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) OnlyID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{bronzehistoryawscloudtrailtrail.Label}
+	default:
+		err = &NotSingularError{bronzehistoryawscloudtrailtrail.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) OnlyIDX(ctx context.Context) uint {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BronzeHistoryAWSCloudTrailTrails.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) All(ctx context.Context) ([]*BronzeHistoryAWSCloudTrailTrail, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BronzeHistoryAWSCloudTrailTrail, *BronzeHistoryAWSCloudTrailTrailQuery]()
+	return withInterceptors[[]*BronzeHistoryAWSCloudTrailTrail](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) AllX(ctx context.Context) []*BronzeHistoryAWSCloudTrailTrail {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BronzeHistoryAWSCloudTrailTrail IDs.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) IDs(ctx context.Context) (ids []uint, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(bronzehistoryawscloudtrailtrail.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) IDsX(ctx context.Context) []uint {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*BronzeHistoryAWSCloudTrailTrailQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("cloudtrail: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BronzeHistoryAWSCloudTrailTrailQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) Clone() *BronzeHistoryAWSCloudTrailTrailQuery {
+	if _q == nil {
+		return nil
+	}
+	return &BronzeHistoryAWSCloudTrailTrailQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]bronzehistoryawscloudtrailtrail.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.BronzeHistoryAWSCloudTrailTrail{}, _q.predicates...),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ValidFrom time.Time `json:"valid_from,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BronzeHistoryAWSCloudTrailTrail.Query().
+//		GroupBy(bronzehistoryawscloudtrailtrail.FieldValidFrom).
+//		Aggregate(cloudtrail.Count()).
+//		Scan(ctx, &v)
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) GroupBy(field string, fields ...string) *BronzeHistoryAWSCloudTrailTrailGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BronzeHistoryAWSCloudTrailTrailGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = bronzehistoryawscloudtrailtrail.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ValidFrom time.Time `json:"valid_from,omitempty"`
+//	}
+//
+//	client.BronzeHistoryAWSCloudTrailTrail.Query().
+//		Select(bronzehistoryawscloudtrailtrail.FieldValidFrom).
+//		Scan(ctx, &v)
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) Select(fields ...string) *BronzeHistoryAWSCloudTrailTrailSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &BronzeHistoryAWSCloudTrailTrailSelect{BronzeHistoryAWSCloudTrailTrailQuery: _q}
+	sbuild.label = bronzehistoryawscloudtrailtrail.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BronzeHistoryAWSCloudTrailTrailSelect configured with the given aggregations.
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) Aggregate(fns ...AggregateFunc) *BronzeHistoryAWSCloudTrailTrailSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("cloudtrail: uninitialized interceptor (forgotten import cloudtrail/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !bronzehistoryawscloudtrailtrail.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("cloudtrail: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BronzeHistoryAWSCloudTrailTrail, error) {
+	var (
+		nodes = []*BronzeHistoryAWSCloudTrailTrail{}
+		_spec = _q.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BronzeHistoryAWSCloudTrailTrail).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BronzeHistoryAWSCloudTrailTrail{config: _q.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = _q.schemaConfig.BronzeHistoryAWSCloudTrailTrail
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Schema = _q.schemaConfig.BronzeHistoryAWSCloudTrailTrail
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(bronzehistoryawscloudtrailtrail.Table, bronzehistoryawscloudtrailtrail.Columns, sqlgraph.NewFieldSpec(bronzehistoryawscloudtrailtrail.FieldID, field.TypeUint))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawscloudtrailtrail.FieldID)
+		for i := range fields {
+			if fields[i] != bronzehistoryawscloudtrailtrail.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *BronzeHistoryAWSCloudTrailTrailQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(bronzehistoryawscloudtrailtrail.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = bronzehistoryawscloudtrailtrail.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(_q.schemaConfig.BronzeHistoryAWSCloudTrailTrail)
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	selector.WithContext(ctx)
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BronzeHistoryAWSCloudTrailTrailGroupBy is the group-by builder for BronzeHistoryAWSCloudTrailTrail entities.
+type BronzeHistoryAWSCloudTrailTrailGroupBy struct {
+	selector
+	build *BronzeHistoryAWSCloudTrailTrailQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *BronzeHistoryAWSCloudTrailTrailGroupBy) Aggregate(fns ...AggregateFunc) *BronzeHistoryAWSCloudTrailTrailGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *BronzeHistoryAWSCloudTrailTrailGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BronzeHistoryAWSCloudTrailTrailQuery, *BronzeHistoryAWSCloudTrailTrailGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *BronzeHistoryAWSCloudTrailTrailGroupBy) sqlScan(ctx context.Context, root *BronzeHistoryAWSCloudTrailTrailQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BronzeHistoryAWSCloudTrailTrailSelect is the builder for selecting fields of BronzeHistoryAWSCloudTrailTrail entities.
+type BronzeHistoryAWSCloudTrailTrailSelect struct {
+	*BronzeHistoryAWSCloudTrailTrailQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *BronzeHistoryAWSCloudTrailTrailSelect) Aggregate(fns ...AggregateFunc) *BronzeHistoryAWSCloudTrailTrailSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *BronzeHistoryAWSCloudTrailTrailSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BronzeHistoryAWSCloudTrailTrailQuery, *BronzeHistoryAWSCloudTrailTrailSelect](ctx, _s.BronzeHistoryAWSCloudTrailTrailQuery, _s, _s.inters, v)
+}
+
+func (_s *BronzeHistoryAWSCloudTrailTrailSelect) sqlScan(ctx context.Context, root *BronzeHistoryAWSCloudTrailTrailQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
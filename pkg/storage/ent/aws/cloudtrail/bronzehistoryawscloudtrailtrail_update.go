@@ -0,0 +1,1353 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cloudtrail
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/bronzehistoryawscloudtrailtrail"
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSCloudTrailTrailUpdate is the builder for updating BronzeHistoryAWSCloudTrailTrail entities.
+type BronzeHistoryAWSCloudTrailTrailUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSCloudTrailTrailMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSCloudTrailTrailUpdate builder.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) Where(ps ...predicate.BronzeHistoryAWSCloudTrailTrail) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetValidTo(v time.Time) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) ClearValidTo() *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetCollectedAt(v time.Time) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetResourceID(v string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableResourceID(v *string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetName(v string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableName(v *string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) ClearName() *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetS3BucketName sets the "s3_bucket_name" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetS3BucketName(v string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetS3BucketName(v)
+	return _u
+}
+
+// SetNillableS3BucketName sets the "s3_bucket_name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableS3BucketName(v *string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetS3BucketName(*v)
+	}
+	return _u
+}
+
+// ClearS3BucketName clears the value of the "s3_bucket_name" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) ClearS3BucketName() *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearS3BucketName()
+	return _u
+}
+
+// SetS3KeyPrefix sets the "s3_key_prefix" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetS3KeyPrefix(v string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetS3KeyPrefix(v)
+	return _u
+}
+
+// SetNillableS3KeyPrefix sets the "s3_key_prefix" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableS3KeyPrefix(v *string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetS3KeyPrefix(*v)
+	}
+	return _u
+}
+
+// ClearS3KeyPrefix clears the value of the "s3_key_prefix" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) ClearS3KeyPrefix() *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearS3KeyPrefix()
+	return _u
+}
+
+// SetSnsTopicArn sets the "sns_topic_arn" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetSnsTopicArn(v string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetSnsTopicArn(v)
+	return _u
+}
+
+// SetNillableSnsTopicArn sets the "sns_topic_arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableSnsTopicArn(v *string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetSnsTopicArn(*v)
+	}
+	return _u
+}
+
+// ClearSnsTopicArn clears the value of the "sns_topic_arn" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) ClearSnsTopicArn() *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearSnsTopicArn()
+	return _u
+}
+
+// SetIsMultiRegionTrail sets the "is_multi_region_trail" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetIsMultiRegionTrail(v bool) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetIsMultiRegionTrail(v)
+	return _u
+}
+
+// SetNillableIsMultiRegionTrail sets the "is_multi_region_trail" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableIsMultiRegionTrail(v *bool) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetIsMultiRegionTrail(*v)
+	}
+	return _u
+}
+
+// ClearIsMultiRegionTrail clears the value of the "is_multi_region_trail" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) ClearIsMultiRegionTrail() *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearIsMultiRegionTrail()
+	return _u
+}
+
+// SetIsOrganizationTrail sets the "is_organization_trail" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetIsOrganizationTrail(v bool) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetIsOrganizationTrail(v)
+	return _u
+}
+
+// SetNillableIsOrganizationTrail sets the "is_organization_trail" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableIsOrganizationTrail(v *bool) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetIsOrganizationTrail(*v)
+	}
+	return _u
+}
+
+// ClearIsOrganizationTrail clears the value of the "is_organization_trail" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) ClearIsOrganizationTrail() *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearIsOrganizationTrail()
+	return _u
+}
+
+// SetIncludeGlobalServiceEvents sets the "include_global_service_events" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetIncludeGlobalServiceEvents(v bool) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetIncludeGlobalServiceEvents(v)
+	return _u
+}
+
+// SetNillableIncludeGlobalServiceEvents sets the "include_global_service_events" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableIncludeGlobalServiceEvents(v *bool) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetIncludeGlobalServiceEvents(*v)
+	}
+	return _u
+}
+
+// ClearIncludeGlobalServiceEvents clears the value of the "include_global_service_events" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) ClearIncludeGlobalServiceEvents() *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearIncludeGlobalServiceEvents()
+	return _u
+}
+
+// SetLogFileValidationEnabled sets the "log_file_validation_enabled" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetLogFileValidationEnabled(v bool) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetLogFileValidationEnabled(v)
+	return _u
+}
+
+// SetNillableLogFileValidationEnabled sets the "log_file_validation_enabled" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableLogFileValidationEnabled(v *bool) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetLogFileValidationEnabled(*v)
+	}
+	return _u
+}
+
+// ClearLogFileValidationEnabled clears the value of the "log_file_validation_enabled" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) ClearLogFileValidationEnabled() *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearLogFileValidationEnabled()
+	return _u
+}
+
+// SetKmsKeyID sets the "kms_key_id" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetKmsKeyID(v string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetKmsKeyID(v)
+	return _u
+}
+
+// SetNillableKmsKeyID sets the "kms_key_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableKmsKeyID(v *string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetKmsKeyID(*v)
+	}
+	return _u
+}
+
+// ClearKmsKeyID clears the value of the "kms_key_id" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) ClearKmsKeyID() *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearKmsKeyID()
+	return _u
+}
+
+// SetCloudWatchLogsLogGroupArn sets the "cloud_watch_logs_log_group_arn" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetCloudWatchLogsLogGroupArn(v string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetCloudWatchLogsLogGroupArn(v)
+	return _u
+}
+
+// SetNillableCloudWatchLogsLogGroupArn sets the "cloud_watch_logs_log_group_arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableCloudWatchLogsLogGroupArn(v *string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetCloudWatchLogsLogGroupArn(*v)
+	}
+	return _u
+}
+
+// ClearCloudWatchLogsLogGroupArn clears the value of the "cloud_watch_logs_log_group_arn" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) ClearCloudWatchLogsLogGroupArn() *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearCloudWatchLogsLogGroupArn()
+	return _u
+}
+
+// SetCloudWatchLogsRoleArn sets the "cloud_watch_logs_role_arn" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetCloudWatchLogsRoleArn(v string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetCloudWatchLogsRoleArn(v)
+	return _u
+}
+
+// SetNillableCloudWatchLogsRoleArn sets the "cloud_watch_logs_role_arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableCloudWatchLogsRoleArn(v *string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetCloudWatchLogsRoleArn(*v)
+	}
+	return _u
+}
+
+// ClearCloudWatchLogsRoleArn clears the value of the "cloud_watch_logs_role_arn" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) ClearCloudWatchLogsRoleArn() *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearCloudWatchLogsRoleArn()
+	return _u
+}
+
+// SetHasCustomEventSelectors sets the "has_custom_event_selectors" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetHasCustomEventSelectors(v bool) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetHasCustomEventSelectors(v)
+	return _u
+}
+
+// SetNillableHasCustomEventSelectors sets the "has_custom_event_selectors" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableHasCustomEventSelectors(v *bool) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetHasCustomEventSelectors(*v)
+	}
+	return _u
+}
+
+// ClearHasCustomEventSelectors clears the value of the "has_custom_event_selectors" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) ClearHasCustomEventSelectors() *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearHasCustomEventSelectors()
+	return _u
+}
+
+// SetHasInsightSelectors sets the "has_insight_selectors" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetHasInsightSelectors(v bool) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetHasInsightSelectors(v)
+	return _u
+}
+
+// SetNillableHasInsightSelectors sets the "has_insight_selectors" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableHasInsightSelectors(v *bool) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetHasInsightSelectors(*v)
+	}
+	return _u
+}
+
+// ClearHasInsightSelectors clears the value of the "has_insight_selectors" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) ClearHasInsightSelectors() *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearHasInsightSelectors()
+	return _u
+}
+
+// SetIsLogging sets the "is_logging" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetIsLogging(v bool) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetIsLogging(v)
+	return _u
+}
+
+// SetNillableIsLogging sets the "is_logging" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableIsLogging(v *bool) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetIsLogging(*v)
+	}
+	return _u
+}
+
+// ClearIsLogging clears the value of the "is_logging" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) ClearIsLogging() *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearIsLogging()
+	return _u
+}
+
+// SetEventSelectorsJSON sets the "event_selectors_json" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetEventSelectorsJSON(v json.RawMessage) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetEventSelectorsJSON(v)
+	return _u
+}
+
+// AppendEventSelectorsJSON appends value to the "event_selectors_json" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) AppendEventSelectorsJSON(v json.RawMessage) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.AppendEventSelectorsJSON(v)
+	return _u
+}
+
+// ClearEventSelectorsJSON clears the value of the "event_selectors_json" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) ClearEventSelectorsJSON() *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearEventSelectorsJSON()
+	return _u
+}
+
+// SetAdvancedEventSelectorsJSON sets the "advanced_event_selectors_json" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetAdvancedEventSelectorsJSON(v json.RawMessage) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetAdvancedEventSelectorsJSON(v)
+	return _u
+}
+
+// AppendAdvancedEventSelectorsJSON appends value to the "advanced_event_selectors_json" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) AppendAdvancedEventSelectorsJSON(v json.RawMessage) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.AppendAdvancedEventSelectorsJSON(v)
+	return _u
+}
+
+// ClearAdvancedEventSelectorsJSON clears the value of the "advanced_event_selectors_json" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) ClearAdvancedEventSelectorsJSON() *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearAdvancedEventSelectorsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) ClearTagsJSON() *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetAccountID(v string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableAccountID(v *string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetRegion(v string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SetNillableRegion(v *string) *BronzeHistoryAWSCloudTrailTrailUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSCloudTrailTrailMutation object of the builder.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) Mutation() *BronzeHistoryAWSCloudTrailTrailMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawscloudtrailtrail.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`cloudtrail: validator failed for field "BronzeHistoryAWSCloudTrailTrail.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawscloudtrailtrail.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`cloudtrail: validator failed for field "BronzeHistoryAWSCloudTrailTrail.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawscloudtrailtrail.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`cloudtrail: validator failed for field "BronzeHistoryAWSCloudTrailTrail.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawscloudtrailtrail.Table, bronzehistoryawscloudtrailtrail.Columns, sqlgraph.NewFieldSpec(bronzehistoryawscloudtrailtrail.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.S3BucketName(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldS3BucketName, field.TypeString, value)
+	}
+	if _u.mutation.S3BucketNameCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldS3BucketName, field.TypeString)
+	}
+	if value, ok := _u.mutation.S3KeyPrefix(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldS3KeyPrefix, field.TypeString, value)
+	}
+	if _u.mutation.S3KeyPrefixCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldS3KeyPrefix, field.TypeString)
+	}
+	if value, ok := _u.mutation.SnsTopicArn(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldSnsTopicArn, field.TypeString, value)
+	}
+	if _u.mutation.SnsTopicArnCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldSnsTopicArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsMultiRegionTrail(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldIsMultiRegionTrail, field.TypeBool, value)
+	}
+	if _u.mutation.IsMultiRegionTrailCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldIsMultiRegionTrail, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IsOrganizationTrail(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldIsOrganizationTrail, field.TypeBool, value)
+	}
+	if _u.mutation.IsOrganizationTrailCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldIsOrganizationTrail, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IncludeGlobalServiceEvents(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldIncludeGlobalServiceEvents, field.TypeBool, value)
+	}
+	if _u.mutation.IncludeGlobalServiceEventsCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldIncludeGlobalServiceEvents, field.TypeBool)
+	}
+	if value, ok := _u.mutation.LogFileValidationEnabled(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldLogFileValidationEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.LogFileValidationEnabledCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldLogFileValidationEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.KmsKeyID(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldKmsKeyID, field.TypeString, value)
+	}
+	if _u.mutation.KmsKeyIDCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldKmsKeyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CloudWatchLogsLogGroupArn(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn, field.TypeString, value)
+	}
+	if _u.mutation.CloudWatchLogsLogGroupArnCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.CloudWatchLogsRoleArn(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsRoleArn, field.TypeString, value)
+	}
+	if _u.mutation.CloudWatchLogsRoleArnCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsRoleArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.HasCustomEventSelectors(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldHasCustomEventSelectors, field.TypeBool, value)
+	}
+	if _u.mutation.HasCustomEventSelectorsCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldHasCustomEventSelectors, field.TypeBool)
+	}
+	if value, ok := _u.mutation.HasInsightSelectors(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldHasInsightSelectors, field.TypeBool, value)
+	}
+	if _u.mutation.HasInsightSelectorsCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldHasInsightSelectors, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IsLogging(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldIsLogging, field.TypeBool, value)
+	}
+	if _u.mutation.IsLoggingCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldIsLogging, field.TypeBool)
+	}
+	if value, ok := _u.mutation.EventSelectorsJSON(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEventSelectorsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON, value)
+		})
+	}
+	if _u.mutation.EventSelectorsJSONCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AdvancedEventSelectorsJSON(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAdvancedEventSelectorsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON, value)
+		})
+	}
+	if _u.mutation.AdvancedEventSelectorsJSONCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawscloudtrailtrail.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSCloudTrailTrail
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawscloudtrailtrail.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryAWSCloudTrailTrailUpdateOne is the builder for updating a single BronzeHistoryAWSCloudTrailTrail entity.
+type BronzeHistoryAWSCloudTrailTrailUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryAWSCloudTrailTrailMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetValidTo(v time.Time) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) ClearValidTo() *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetResourceID(v string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetName(v string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableName(v *string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) ClearName() *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetS3BucketName sets the "s3_bucket_name" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetS3BucketName(v string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetS3BucketName(v)
+	return _u
+}
+
+// SetNillableS3BucketName sets the "s3_bucket_name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableS3BucketName(v *string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetS3BucketName(*v)
+	}
+	return _u
+}
+
+// ClearS3BucketName clears the value of the "s3_bucket_name" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) ClearS3BucketName() *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearS3BucketName()
+	return _u
+}
+
+// SetS3KeyPrefix sets the "s3_key_prefix" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetS3KeyPrefix(v string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetS3KeyPrefix(v)
+	return _u
+}
+
+// SetNillableS3KeyPrefix sets the "s3_key_prefix" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableS3KeyPrefix(v *string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetS3KeyPrefix(*v)
+	}
+	return _u
+}
+
+// ClearS3KeyPrefix clears the value of the "s3_key_prefix" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) ClearS3KeyPrefix() *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearS3KeyPrefix()
+	return _u
+}
+
+// SetSnsTopicArn sets the "sns_topic_arn" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetSnsTopicArn(v string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetSnsTopicArn(v)
+	return _u
+}
+
+// SetNillableSnsTopicArn sets the "sns_topic_arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableSnsTopicArn(v *string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetSnsTopicArn(*v)
+	}
+	return _u
+}
+
+// ClearSnsTopicArn clears the value of the "sns_topic_arn" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) ClearSnsTopicArn() *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearSnsTopicArn()
+	return _u
+}
+
+// SetIsMultiRegionTrail sets the "is_multi_region_trail" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetIsMultiRegionTrail(v bool) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetIsMultiRegionTrail(v)
+	return _u
+}
+
+// SetNillableIsMultiRegionTrail sets the "is_multi_region_trail" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableIsMultiRegionTrail(v *bool) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetIsMultiRegionTrail(*v)
+	}
+	return _u
+}
+
+// ClearIsMultiRegionTrail clears the value of the "is_multi_region_trail" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) ClearIsMultiRegionTrail() *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearIsMultiRegionTrail()
+	return _u
+}
+
+// SetIsOrganizationTrail sets the "is_organization_trail" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetIsOrganizationTrail(v bool) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetIsOrganizationTrail(v)
+	return _u
+}
+
+// SetNillableIsOrganizationTrail sets the "is_organization_trail" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableIsOrganizationTrail(v *bool) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetIsOrganizationTrail(*v)
+	}
+	return _u
+}
+
+// ClearIsOrganizationTrail clears the value of the "is_organization_trail" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) ClearIsOrganizationTrail() *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearIsOrganizationTrail()
+	return _u
+}
+
+// SetIncludeGlobalServiceEvents sets the "include_global_service_events" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetIncludeGlobalServiceEvents(v bool) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetIncludeGlobalServiceEvents(v)
+	return _u
+}
+
+// SetNillableIncludeGlobalServiceEvents sets the "include_global_service_events" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableIncludeGlobalServiceEvents(v *bool) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetIncludeGlobalServiceEvents(*v)
+	}
+	return _u
+}
+
+// ClearIncludeGlobalServiceEvents clears the value of the "include_global_service_events" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) ClearIncludeGlobalServiceEvents() *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearIncludeGlobalServiceEvents()
+	return _u
+}
+
+// SetLogFileValidationEnabled sets the "log_file_validation_enabled" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetLogFileValidationEnabled(v bool) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetLogFileValidationEnabled(v)
+	return _u
+}
+
+// SetNillableLogFileValidationEnabled sets the "log_file_validation_enabled" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableLogFileValidationEnabled(v *bool) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetLogFileValidationEnabled(*v)
+	}
+	return _u
+}
+
+// ClearLogFileValidationEnabled clears the value of the "log_file_validation_enabled" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) ClearLogFileValidationEnabled() *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearLogFileValidationEnabled()
+	return _u
+}
+
+// SetKmsKeyID sets the "kms_key_id" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetKmsKeyID(v string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetKmsKeyID(v)
+	return _u
+}
+
+// SetNillableKmsKeyID sets the "kms_key_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableKmsKeyID(v *string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetKmsKeyID(*v)
+	}
+	return _u
+}
+
+// ClearKmsKeyID clears the value of the "kms_key_id" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) ClearKmsKeyID() *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearKmsKeyID()
+	return _u
+}
+
+// SetCloudWatchLogsLogGroupArn sets the "cloud_watch_logs_log_group_arn" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetCloudWatchLogsLogGroupArn(v string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetCloudWatchLogsLogGroupArn(v)
+	return _u
+}
+
+// SetNillableCloudWatchLogsLogGroupArn sets the "cloud_watch_logs_log_group_arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableCloudWatchLogsLogGroupArn(v *string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetCloudWatchLogsLogGroupArn(*v)
+	}
+	return _u
+}
+
+// ClearCloudWatchLogsLogGroupArn clears the value of the "cloud_watch_logs_log_group_arn" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) ClearCloudWatchLogsLogGroupArn() *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearCloudWatchLogsLogGroupArn()
+	return _u
+}
+
+// SetCloudWatchLogsRoleArn sets the "cloud_watch_logs_role_arn" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetCloudWatchLogsRoleArn(v string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetCloudWatchLogsRoleArn(v)
+	return _u
+}
+
+// SetNillableCloudWatchLogsRoleArn sets the "cloud_watch_logs_role_arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableCloudWatchLogsRoleArn(v *string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetCloudWatchLogsRoleArn(*v)
+	}
+	return _u
+}
+
+// ClearCloudWatchLogsRoleArn clears the value of the "cloud_watch_logs_role_arn" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) ClearCloudWatchLogsRoleArn() *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearCloudWatchLogsRoleArn()
+	return _u
+}
+
+// SetHasCustomEventSelectors sets the "has_custom_event_selectors" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetHasCustomEventSelectors(v bool) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetHasCustomEventSelectors(v)
+	return _u
+}
+
+// SetNillableHasCustomEventSelectors sets the "has_custom_event_selectors" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableHasCustomEventSelectors(v *bool) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetHasCustomEventSelectors(*v)
+	}
+	return _u
+}
+
+// ClearHasCustomEventSelectors clears the value of the "has_custom_event_selectors" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) ClearHasCustomEventSelectors() *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearHasCustomEventSelectors()
+	return _u
+}
+
+// SetHasInsightSelectors sets the "has_insight_selectors" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetHasInsightSelectors(v bool) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetHasInsightSelectors(v)
+	return _u
+}
+
+// SetNillableHasInsightSelectors sets the "has_insight_selectors" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableHasInsightSelectors(v *bool) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetHasInsightSelectors(*v)
+	}
+	return _u
+}
+
+// ClearHasInsightSelectors clears the value of the "has_insight_selectors" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) ClearHasInsightSelectors() *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearHasInsightSelectors()
+	return _u
+}
+
+// SetIsLogging sets the "is_logging" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetIsLogging(v bool) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetIsLogging(v)
+	return _u
+}
+
+// SetNillableIsLogging sets the "is_logging" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableIsLogging(v *bool) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetIsLogging(*v)
+	}
+	return _u
+}
+
+// ClearIsLogging clears the value of the "is_logging" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) ClearIsLogging() *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearIsLogging()
+	return _u
+}
+
+// SetEventSelectorsJSON sets the "event_selectors_json" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetEventSelectorsJSON(v json.RawMessage) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetEventSelectorsJSON(v)
+	return _u
+}
+
+// AppendEventSelectorsJSON appends value to the "event_selectors_json" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) AppendEventSelectorsJSON(v json.RawMessage) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.AppendEventSelectorsJSON(v)
+	return _u
+}
+
+// ClearEventSelectorsJSON clears the value of the "event_selectors_json" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) ClearEventSelectorsJSON() *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearEventSelectorsJSON()
+	return _u
+}
+
+// SetAdvancedEventSelectorsJSON sets the "advanced_event_selectors_json" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetAdvancedEventSelectorsJSON(v json.RawMessage) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetAdvancedEventSelectorsJSON(v)
+	return _u
+}
+
+// AppendAdvancedEventSelectorsJSON appends value to the "advanced_event_selectors_json" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) AppendAdvancedEventSelectorsJSON(v json.RawMessage) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.AppendAdvancedEventSelectorsJSON(v)
+	return _u
+}
+
+// ClearAdvancedEventSelectorsJSON clears the value of the "advanced_event_selectors_json" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) ClearAdvancedEventSelectorsJSON() *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearAdvancedEventSelectorsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) ClearTagsJSON() *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetAccountID(v string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetRegion(v string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SetNillableRegion(v *string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSCloudTrailTrailMutation object of the builder.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) Mutation() *BronzeHistoryAWSCloudTrailTrailMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSCloudTrailTrailUpdate builder.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) Where(ps ...predicate.BronzeHistoryAWSCloudTrailTrail) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) Select(field string, fields ...string) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryAWSCloudTrailTrail entity.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) Save(ctx context.Context) (*BronzeHistoryAWSCloudTrailTrail, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) SaveX(ctx context.Context) *BronzeHistoryAWSCloudTrailTrail {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawscloudtrailtrail.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`cloudtrail: validator failed for field "BronzeHistoryAWSCloudTrailTrail.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawscloudtrailtrail.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`cloudtrail: validator failed for field "BronzeHistoryAWSCloudTrailTrail.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawscloudtrailtrail.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`cloudtrail: validator failed for field "BronzeHistoryAWSCloudTrailTrail.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSCloudTrailTrailUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryAWSCloudTrailTrail, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawscloudtrailtrail.Table, bronzehistoryawscloudtrailtrail.Columns, sqlgraph.NewFieldSpec(bronzehistoryawscloudtrailtrail.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`cloudtrail: missing "BronzeHistoryAWSCloudTrailTrail.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawscloudtrailtrail.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryawscloudtrailtrail.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("cloudtrail: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryawscloudtrailtrail.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.S3BucketName(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldS3BucketName, field.TypeString, value)
+	}
+	if _u.mutation.S3BucketNameCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldS3BucketName, field.TypeString)
+	}
+	if value, ok := _u.mutation.S3KeyPrefix(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldS3KeyPrefix, field.TypeString, value)
+	}
+	if _u.mutation.S3KeyPrefixCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldS3KeyPrefix, field.TypeString)
+	}
+	if value, ok := _u.mutation.SnsTopicArn(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldSnsTopicArn, field.TypeString, value)
+	}
+	if _u.mutation.SnsTopicArnCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldSnsTopicArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsMultiRegionTrail(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldIsMultiRegionTrail, field.TypeBool, value)
+	}
+	if _u.mutation.IsMultiRegionTrailCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldIsMultiRegionTrail, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IsOrganizationTrail(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldIsOrganizationTrail, field.TypeBool, value)
+	}
+	if _u.mutation.IsOrganizationTrailCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldIsOrganizationTrail, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IncludeGlobalServiceEvents(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldIncludeGlobalServiceEvents, field.TypeBool, value)
+	}
+	if _u.mutation.IncludeGlobalServiceEventsCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldIncludeGlobalServiceEvents, field.TypeBool)
+	}
+	if value, ok := _u.mutation.LogFileValidationEnabled(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldLogFileValidationEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.LogFileValidationEnabledCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldLogFileValidationEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.KmsKeyID(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldKmsKeyID, field.TypeString, value)
+	}
+	if _u.mutation.KmsKeyIDCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldKmsKeyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CloudWatchLogsLogGroupArn(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn, field.TypeString, value)
+	}
+	if _u.mutation.CloudWatchLogsLogGroupArnCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.CloudWatchLogsRoleArn(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsRoleArn, field.TypeString, value)
+	}
+	if _u.mutation.CloudWatchLogsRoleArnCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsRoleArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.HasCustomEventSelectors(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldHasCustomEventSelectors, field.TypeBool, value)
+	}
+	if _u.mutation.HasCustomEventSelectorsCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldHasCustomEventSelectors, field.TypeBool)
+	}
+	if value, ok := _u.mutation.HasInsightSelectors(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldHasInsightSelectors, field.TypeBool, value)
+	}
+	if _u.mutation.HasInsightSelectorsCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldHasInsightSelectors, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IsLogging(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldIsLogging, field.TypeBool, value)
+	}
+	if _u.mutation.IsLoggingCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldIsLogging, field.TypeBool)
+	}
+	if value, ok := _u.mutation.EventSelectorsJSON(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEventSelectorsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON, value)
+		})
+	}
+	if _u.mutation.EventSelectorsJSONCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AdvancedEventSelectorsJSON(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAdvancedEventSelectorsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON, value)
+		})
+	}
+	if _u.mutation.AdvancedEventSelectorsJSONCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawscloudtrailtrail.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawscloudtrailtrail.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawscloudtrailtrail.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSCloudTrailTrail
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryAWSCloudTrailTrail{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawscloudtrailtrail.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,499 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cloudtrail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/migrate"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/bronzeawscloudtrailtrail"
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/bronzehistoryawscloudtrailtrail"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/internal"
+)
+
+// Client is the client that holds all ent builders.
+type Client struct {
+	config
+	// Schema is the client for creating, migrating and dropping schema.
+	Schema *migrate.Schema
+	// BronzeAWSCloudTrailTrail is the client for interacting with the BronzeAWSCloudTrailTrail builders.
+	BronzeAWSCloudTrailTrail *BronzeAWSCloudTrailTrailClient
+	// BronzeHistoryAWSCloudTrailTrail is the client for interacting with the BronzeHistoryAWSCloudTrailTrail builders.
+	BronzeHistoryAWSCloudTrailTrail *BronzeHistoryAWSCloudTrailTrailClient
+}
+
+// NewClient creates a new client configured with the given options.
+func NewClient(opts ...Option) *Client {
+	client := &Client{config: newConfig(opts...)}
+	client.init()
+	return client
+}
+
+func (c *Client) init() {
+	c.Schema = migrate.NewSchema(c.driver)
+	c.BronzeAWSCloudTrailTrail = NewBronzeAWSCloudTrailTrailClient(c.config)
+	c.BronzeHistoryAWSCloudTrailTrail = NewBronzeHistoryAWSCloudTrailTrailClient(c.config)
+}
+
+type (
+	// config is the configuration for the client and its builder.
+	config struct {
+		// driver used for executing database requests.
+		driver dialect.Driver
+		// debug enable a debug logging.
+		debug bool
+		// log used for logging on debug mode.
+		log func(...any)
+		// hooks to execute on mutations.
+		hooks *hooks
+		// interceptors to execute on queries.
+		inters *inters
+		// schemaConfig contains alternative names for all tables.
+		schemaConfig SchemaConfig
+	}
+	// Option function to configure the client.
+	Option func(*config)
+)
+
+// newConfig creates a new config for the client.
+func newConfig(opts ...Option) config {
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
+	cfg.options(opts...)
+	return cfg
+}
+
+// options applies the options on the config object.
+func (c *config) options(opts ...Option) {
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.debug {
+		c.driver = dialect.Debug(c.driver, c.log)
+	}
+}
+
+// Debug enables debug logging on the ent.Driver.
+func Debug() Option {
+	return func(c *config) {
+		c.debug = true
+	}
+}
+
+// Log sets the logging function for debug mode.
+func Log(fn func(...any)) Option {
+	return func(c *config) {
+		c.log = fn
+	}
+}
+
+// Driver configures the client driver.
+func Driver(driver dialect.Driver) Option {
+	return func(c *config) {
+		c.driver = driver
+	}
+}
+
+// Open opens a database/sql.DB specified by the driver name and
+// the data source name, and returns a new client attached to it.
+// Optional parameters can be added for configuring the client.
+func Open(driverName, dataSourceName string, options ...Option) (*Client, error) {
+	switch driverName {
+	case dialect.MySQL, dialect.Postgres, dialect.SQLite:
+		drv, err := sql.Open(driverName, dataSourceName)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(append(options, Driver(drv))...), nil
+	default:
+		return nil, fmt.Errorf("unsupported driver: %q", driverName)
+	}
+}
+
+// ErrTxStarted is returned when trying to start a new transaction from a transactional client.
+var ErrTxStarted = errors.New("cloudtrail: cannot start a transaction within a transaction")
+
+// Tx returns a new transactional client. The provided context
+// is used until the transaction is committed or rolled back.
+func (c *Client) Tx(ctx context.Context) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, ErrTxStarted
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("cloudtrail: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	return &Tx{
+		ctx:                             ctx,
+		config:                          cfg,
+		BronzeAWSCloudTrailTrail:        NewBronzeAWSCloudTrailTrailClient(cfg),
+		BronzeHistoryAWSCloudTrailTrail: NewBronzeHistoryAWSCloudTrailTrailClient(cfg),
+	}, nil
+}
+
+// BeginTx returns a transactional client with specified options.
+func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, errors.New("ent: cannot start a transaction within a transaction")
+	}
+	tx, err := c.driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}).BeginTx(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = &txDriver{tx: tx, drv: c.driver}
+	return &Tx{
+		ctx:                             ctx,
+		config:                          cfg,
+		BronzeAWSCloudTrailTrail:        NewBronzeAWSCloudTrailTrailClient(cfg),
+		BronzeHistoryAWSCloudTrailTrail: NewBronzeHistoryAWSCloudTrailTrailClient(cfg),
+	}, nil
+}
+
+// Debug returns a new debug-client. It's used to get verbose logging on specific operations.
+//
+//	client.Debug().
+//		BronzeAWSCloudTrailTrail.
+//		Query().
+//		Count(ctx)
+func (c *Client) Debug() *Client {
+	if c.debug {
+		return c
+	}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// Close closes the database connection and prevents new queries from starting.
+func (c *Client) Close() error {
+	return c.driver.Close()
+}
+
+// Use adds the mutation hooks to all the entity clients.
+// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
+func (c *Client) Use(hooks ...Hook) {
+	c.BronzeAWSCloudTrailTrail.Use(hooks...)
+	c.BronzeHistoryAWSCloudTrailTrail.Use(hooks...)
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.BronzeAWSCloudTrailTrail.Intercept(interceptors...)
+	c.BronzeHistoryAWSCloudTrailTrail.Intercept(interceptors...)
+}
+
+// Mutate implements the ent.Mutator interface.
+func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
+	switch m := m.(type) {
+	case *BronzeAWSCloudTrailTrailMutation:
+		return c.BronzeAWSCloudTrailTrail.mutate(ctx, m)
+	case *BronzeHistoryAWSCloudTrailTrailMutation:
+		return c.BronzeHistoryAWSCloudTrailTrail.mutate(ctx, m)
+	default:
+		return nil, fmt.Errorf("cloudtrail: unknown mutation type %T", m)
+	}
+}
+
+// BronzeAWSCloudTrailTrailClient is a client for the BronzeAWSCloudTrailTrail schema.
+type BronzeAWSCloudTrailTrailClient struct {
+	config
+}
+
+// NewBronzeAWSCloudTrailTrailClient returns a client for the BronzeAWSCloudTrailTrail from the given config.
+func NewBronzeAWSCloudTrailTrailClient(c config) *BronzeAWSCloudTrailTrailClient {
+	return &BronzeAWSCloudTrailTrailClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzeawscloudtrailtrail.Hooks(f(g(h())))`.
+func (c *BronzeAWSCloudTrailTrailClient) Use(hooks ...Hook) {
+	c.hooks.BronzeAWSCloudTrailTrail = append(c.hooks.BronzeAWSCloudTrailTrail, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzeawscloudtrailtrail.Intercept(f(g(h())))`.
+func (c *BronzeAWSCloudTrailTrailClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeAWSCloudTrailTrail = append(c.inters.BronzeAWSCloudTrailTrail, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeAWSCloudTrailTrail entity.
+func (c *BronzeAWSCloudTrailTrailClient) Create() *BronzeAWSCloudTrailTrailCreate {
+	mutation := newBronzeAWSCloudTrailTrailMutation(c.config, OpCreate)
+	return &BronzeAWSCloudTrailTrailCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeAWSCloudTrailTrail entities.
+func (c *BronzeAWSCloudTrailTrailClient) CreateBulk(builders ...*BronzeAWSCloudTrailTrailCreate) *BronzeAWSCloudTrailTrailCreateBulk {
+	return &BronzeAWSCloudTrailTrailCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeAWSCloudTrailTrailClient) MapCreateBulk(slice any, setFunc func(*BronzeAWSCloudTrailTrailCreate, int)) *BronzeAWSCloudTrailTrailCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeAWSCloudTrailTrailCreateBulk{err: fmt.Errorf("calling to BronzeAWSCloudTrailTrailClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeAWSCloudTrailTrailCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeAWSCloudTrailTrailCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeAWSCloudTrailTrail.
+func (c *BronzeAWSCloudTrailTrailClient) Update() *BronzeAWSCloudTrailTrailUpdate {
+	mutation := newBronzeAWSCloudTrailTrailMutation(c.config, OpUpdate)
+	return &BronzeAWSCloudTrailTrailUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeAWSCloudTrailTrailClient) UpdateOne(_m *BronzeAWSCloudTrailTrail) *BronzeAWSCloudTrailTrailUpdateOne {
+	mutation := newBronzeAWSCloudTrailTrailMutation(c.config, OpUpdateOne, withBronzeAWSCloudTrailTrail(_m))
+	return &BronzeAWSCloudTrailTrailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeAWSCloudTrailTrailClient) UpdateOneID(id string) *BronzeAWSCloudTrailTrailUpdateOne {
+	mutation := newBronzeAWSCloudTrailTrailMutation(c.config, OpUpdateOne, withBronzeAWSCloudTrailTrailID(id))
+	return &BronzeAWSCloudTrailTrailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeAWSCloudTrailTrail.
+func (c *BronzeAWSCloudTrailTrailClient) Delete() *BronzeAWSCloudTrailTrailDelete {
+	mutation := newBronzeAWSCloudTrailTrailMutation(c.config, OpDelete)
+	return &BronzeAWSCloudTrailTrailDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeAWSCloudTrailTrailClient) DeleteOne(_m *BronzeAWSCloudTrailTrail) *BronzeAWSCloudTrailTrailDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeAWSCloudTrailTrailClient) DeleteOneID(id string) *BronzeAWSCloudTrailTrailDeleteOne {
+	builder := c.Delete().Where(bronzeawscloudtrailtrail.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeAWSCloudTrailTrailDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeAWSCloudTrailTrail.
+func (c *BronzeAWSCloudTrailTrailClient) Query() *BronzeAWSCloudTrailTrailQuery {
+	return &BronzeAWSCloudTrailTrailQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeAWSCloudTrailTrail},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeAWSCloudTrailTrail entity by its id.
+func (c *BronzeAWSCloudTrailTrailClient) Get(ctx context.Context, id string) (*BronzeAWSCloudTrailTrail, error) {
+	return c.Query().Where(bronzeawscloudtrailtrail.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeAWSCloudTrailTrailClient) GetX(ctx context.Context, id string) *BronzeAWSCloudTrailTrail {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeAWSCloudTrailTrailClient) Hooks() []Hook {
+	return c.hooks.BronzeAWSCloudTrailTrail
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeAWSCloudTrailTrailClient) Interceptors() []Interceptor {
+	return c.inters.BronzeAWSCloudTrailTrail
+}
+
+func (c *BronzeAWSCloudTrailTrailClient) mutate(ctx context.Context, m *BronzeAWSCloudTrailTrailMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeAWSCloudTrailTrailCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeAWSCloudTrailTrailUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeAWSCloudTrailTrailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeAWSCloudTrailTrailDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("cloudtrail: unknown BronzeAWSCloudTrailTrail mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSCloudTrailTrailClient is a client for the BronzeHistoryAWSCloudTrailTrail schema.
+type BronzeHistoryAWSCloudTrailTrailClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSCloudTrailTrailClient returns a client for the BronzeHistoryAWSCloudTrailTrail from the given config.
+func NewBronzeHistoryAWSCloudTrailTrailClient(c config) *BronzeHistoryAWSCloudTrailTrailClient {
+	return &BronzeHistoryAWSCloudTrailTrailClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawscloudtrailtrail.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSCloudTrailTrailClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSCloudTrailTrail = append(c.hooks.BronzeHistoryAWSCloudTrailTrail, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawscloudtrailtrail.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSCloudTrailTrailClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSCloudTrailTrail = append(c.inters.BronzeHistoryAWSCloudTrailTrail, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSCloudTrailTrail entity.
+func (c *BronzeHistoryAWSCloudTrailTrailClient) Create() *BronzeHistoryAWSCloudTrailTrailCreate {
+	mutation := newBronzeHistoryAWSCloudTrailTrailMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSCloudTrailTrailCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSCloudTrailTrail entities.
+func (c *BronzeHistoryAWSCloudTrailTrailClient) CreateBulk(builders ...*BronzeHistoryAWSCloudTrailTrailCreate) *BronzeHistoryAWSCloudTrailTrailCreateBulk {
+	return &BronzeHistoryAWSCloudTrailTrailCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSCloudTrailTrailClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSCloudTrailTrailCreate, int)) *BronzeHistoryAWSCloudTrailTrailCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSCloudTrailTrailCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSCloudTrailTrailClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSCloudTrailTrailCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSCloudTrailTrailCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSCloudTrailTrail.
+func (c *BronzeHistoryAWSCloudTrailTrailClient) Update() *BronzeHistoryAWSCloudTrailTrailUpdate {
+	mutation := newBronzeHistoryAWSCloudTrailTrailMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSCloudTrailTrailUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSCloudTrailTrailClient) UpdateOne(_m *BronzeHistoryAWSCloudTrailTrail) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	mutation := newBronzeHistoryAWSCloudTrailTrailMutation(c.config, OpUpdateOne, withBronzeHistoryAWSCloudTrailTrail(_m))
+	return &BronzeHistoryAWSCloudTrailTrailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSCloudTrailTrailClient) UpdateOneID(id uint) *BronzeHistoryAWSCloudTrailTrailUpdateOne {
+	mutation := newBronzeHistoryAWSCloudTrailTrailMutation(c.config, OpUpdateOne, withBronzeHistoryAWSCloudTrailTrailID(id))
+	return &BronzeHistoryAWSCloudTrailTrailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSCloudTrailTrail.
+func (c *BronzeHistoryAWSCloudTrailTrailClient) Delete() *BronzeHistoryAWSCloudTrailTrailDelete {
+	mutation := newBronzeHistoryAWSCloudTrailTrailMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSCloudTrailTrailDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSCloudTrailTrailClient) DeleteOne(_m *BronzeHistoryAWSCloudTrailTrail) *BronzeHistoryAWSCloudTrailTrailDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSCloudTrailTrailClient) DeleteOneID(id uint) *BronzeHistoryAWSCloudTrailTrailDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawscloudtrailtrail.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSCloudTrailTrailDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSCloudTrailTrail.
+func (c *BronzeHistoryAWSCloudTrailTrailClient) Query() *BronzeHistoryAWSCloudTrailTrailQuery {
+	return &BronzeHistoryAWSCloudTrailTrailQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSCloudTrailTrail},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSCloudTrailTrail entity by its id.
+func (c *BronzeHistoryAWSCloudTrailTrailClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSCloudTrailTrail, error) {
+	return c.Query().Where(bronzehistoryawscloudtrailtrail.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSCloudTrailTrailClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSCloudTrailTrail {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSCloudTrailTrailClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSCloudTrailTrail
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSCloudTrailTrailClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSCloudTrailTrail
+}
+
+func (c *BronzeHistoryAWSCloudTrailTrailClient) mutate(ctx context.Context, m *BronzeHistoryAWSCloudTrailTrailMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSCloudTrailTrailCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSCloudTrailTrailUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSCloudTrailTrailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSCloudTrailTrailDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("cloudtrail: unknown BronzeHistoryAWSCloudTrailTrail mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		BronzeAWSCloudTrailTrail, BronzeHistoryAWSCloudTrailTrail []ent.Hook
+	}
+	inters struct {
+		BronzeAWSCloudTrailTrail, BronzeHistoryAWSCloudTrailTrail []ent.Interceptor
+	}
+)
+
+// SchemaConfig represents alternative schema names for all tables
+// that can be passed at runtime.
+type SchemaConfig = internal.SchemaConfig
+
+// AlternateSchemas allows alternate schema names to be
+// passed into ent operations.
+func AlternateSchema(schemaConfig SchemaConfig) Option {
+	return func(c *config) {
+		c.schemaConfig = schemaConfig
+	}
+}
@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hook
+
+import (
+	"context"
+	"fmt"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail"
+)
+
+// The BronzeAWSCloudTrailTrailFunc type is an adapter to allow the use of ordinary
+// function as BronzeAWSCloudTrailTrail mutator.
+type BronzeAWSCloudTrailTrailFunc func(context.Context, *cloudtrail.BronzeAWSCloudTrailTrailMutation) (cloudtrail.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeAWSCloudTrailTrailFunc) Mutate(ctx context.Context, m cloudtrail.Mutation) (cloudtrail.Value, error) {
+	if mv, ok := m.(*cloudtrail.BronzeAWSCloudTrailTrailMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *cloudtrail.BronzeAWSCloudTrailTrailMutation", m)
+}
+
+// The BronzeHistoryAWSCloudTrailTrailFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryAWSCloudTrailTrail mutator.
+type BronzeHistoryAWSCloudTrailTrailFunc func(context.Context, *cloudtrail.BronzeHistoryAWSCloudTrailTrailMutation) (cloudtrail.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryAWSCloudTrailTrailFunc) Mutate(ctx context.Context, m cloudtrail.Mutation) (cloudtrail.Value, error) {
+	if mv, ok := m.(*cloudtrail.BronzeHistoryAWSCloudTrailTrailMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *cloudtrail.BronzeHistoryAWSCloudTrailTrailMutation", m)
+}
+
+// Condition is a hook condition function.
+type Condition func(context.Context, cloudtrail.Mutation) bool
+
+// And groups conditions with the AND operator.
+func And(first, second Condition, rest ...Condition) Condition {
+	return func(ctx context.Context, m cloudtrail.Mutation) bool {
+		if !first(ctx, m) || !second(ctx, m) {
+			return false
+		}
+		for _, cond := range rest {
+			if !cond(ctx, m) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or groups conditions with the OR operator.
+func Or(first, second Condition, rest ...Condition) Condition {
+	return func(ctx context.Context, m cloudtrail.Mutation) bool {
+		if first(ctx, m) || second(ctx, m) {
+			return true
+		}
+		for _, cond := range rest {
+			if cond(ctx, m) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not negates a given condition.
+func Not(cond Condition) Condition {
+	return func(ctx context.Context, m cloudtrail.Mutation) bool {
+		return !cond(ctx, m)
+	}
+}
+
+// HasOp is a condition testing mutation operation.
+func HasOp(op cloudtrail.Op) Condition {
+	return func(_ context.Context, m cloudtrail.Mutation) bool {
+		return m.Op().Is(op)
+	}
+}
+
+// HasAddedFields is a condition validating `.AddedField` on fields.
+func HasAddedFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m cloudtrail.Mutation) bool {
+		if _, exists := m.AddedField(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if _, exists := m.AddedField(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// HasClearedFields is a condition validating `.FieldCleared` on fields.
+func HasClearedFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m cloudtrail.Mutation) bool {
+		if exists := m.FieldCleared(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if exists := m.FieldCleared(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// HasFields is a condition validating `.Field` on fields.
+func HasFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m cloudtrail.Mutation) bool {
+		if _, exists := m.Field(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if _, exists := m.Field(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// If executes the given hook under condition.
+//
+//	hook.If(ComputeAverage, And(HasFields(...), HasAddedFields(...)))
+func If(hk cloudtrail.Hook, cond Condition) cloudtrail.Hook {
+	return func(next cloudtrail.Mutator) cloudtrail.Mutator {
+		return cloudtrail.MutateFunc(func(ctx context.Context, m cloudtrail.Mutation) (cloudtrail.Value, error) {
+			if cond(ctx, m) {
+				return hk(next).Mutate(ctx, m)
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// On executes the given hook only for the given operation.
+//
+//	hook.On(Log, cloudtrail.Delete|cloudtrail.Create)
+func On(hk cloudtrail.Hook, op cloudtrail.Op) cloudtrail.Hook {
+	return If(hk, HasOp(op))
+}
+
+// Unless skips the given hook only for the given operation.
+//
+//	hook.Unless(Log, cloudtrail.Update|cloudtrail.UpdateOne)
+func Unless(hk cloudtrail.Hook, op cloudtrail.Op) cloudtrail.Hook {
+	return If(hk, Not(HasOp(op)))
+}
+
+// FixedError is a hook returning a fixed error.
+func FixedError(err error) cloudtrail.Hook {
+	return func(cloudtrail.Mutator) cloudtrail.Mutator {
+		return cloudtrail.MutateFunc(func(context.Context, cloudtrail.Mutation) (cloudtrail.Value, error) {
+			return nil, err
+		})
+	}
+}
+
+// Reject returns a hook that rejects all operations that match op.
+//
+//	func (T) Hooks() []cloudtrail.Hook {
+//		return []cloudtrail.Hook{
+//			Reject(cloudtrail.Delete|cloudtrail.Update),
+//		}
+//	}
+func Reject(op cloudtrail.Op) cloudtrail.Hook {
+	hk := FixedError(fmt.Errorf("%s operation is not allowed", op))
+	return On(hk, op)
+}
+
+// Chain acts as a list of hooks and is effectively immutable.
+// Once created, it will always hold the same set of hooks in the same order.
+type Chain struct {
+	hooks []cloudtrail.Hook
+}
+
+// NewChain creates a new chain of hooks.
+func NewChain(hooks ...cloudtrail.Hook) Chain {
+	return Chain{append([]cloudtrail.Hook(nil), hooks...)}
+}
+
+// Hook chains the list of hooks and returns the final hook.
+func (c Chain) Hook() cloudtrail.Hook {
+	return func(mutator cloudtrail.Mutator) cloudtrail.Mutator {
+		for i := len(c.hooks) - 1; i >= 0; i-- {
+			mutator = c.hooks[i](mutator)
+		}
+		return mutator
+	}
+}
+
+// Append extends a chain, adding the specified hook
+// as the last ones in the mutation flow.
+func (c Chain) Append(hooks ...cloudtrail.Hook) Chain {
+	newHooks := make([]cloudtrail.Hook, 0, len(c.hooks)+len(hooks))
+	newHooks = append(newHooks, c.hooks...)
+	newHooks = append(newHooks, hooks...)
+	return Chain{newHooks}
+}
+
+// Extend extends a chain, adding the specified chain
+// as the last ones in the mutation flow.
+func (c Chain) Extend(chain Chain) Chain {
+	return c.Append(chain.hooks...)
+}
@@ -0,0 +1,140 @@
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// AWSCloudtrailTrailsColumns holds the columns for the "aws_cloudtrail_trails" table.
+	AWSCloudtrailTrailsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "s3_bucket_name", Type: field.TypeString, Nullable: true},
+		{Name: "s3_key_prefix", Type: field.TypeString, Nullable: true},
+		{Name: "sns_topic_arn", Type: field.TypeString, Nullable: true},
+		{Name: "is_multi_region_trail", Type: field.TypeBool, Nullable: true},
+		{Name: "is_organization_trail", Type: field.TypeBool, Nullable: true},
+		{Name: "include_global_service_events", Type: field.TypeBool, Nullable: true},
+		{Name: "log_file_validation_enabled", Type: field.TypeBool, Nullable: true},
+		{Name: "kms_key_id", Type: field.TypeString, Nullable: true},
+		{Name: "cloud_watch_logs_log_group_arn", Type: field.TypeString, Nullable: true},
+		{Name: "cloud_watch_logs_role_arn", Type: field.TypeString, Nullable: true},
+		{Name: "has_custom_event_selectors", Type: field.TypeBool, Nullable: true},
+		{Name: "has_insight_selectors", Type: field.TypeBool, Nullable: true},
+		{Name: "is_logging", Type: field.TypeBool, Nullable: true},
+		{Name: "event_selectors_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "advanced_event_selectors_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSCloudtrailTrailsTable holds the schema information for the "aws_cloudtrail_trails" table.
+	AWSCloudtrailTrailsTable = &schema.Table{
+		Name:       "aws_cloudtrail_trails",
+		Columns:    AWSCloudtrailTrailsColumns,
+		PrimaryKey: []*schema.Column{AWSCloudtrailTrailsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzeawscloudtrailtrail_is_multi_region_trail",
+				Unique:  false,
+				Columns: []*schema.Column{AWSCloudtrailTrailsColumns[7]},
+			},
+			{
+				Name:    "bronzeawscloudtrailtrail_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSCloudtrailTrailsColumns[20]},
+			},
+			{
+				Name:    "bronzeawscloudtrailtrail_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSCloudtrailTrailsColumns[21]},
+			},
+			{
+				Name:    "bronzeawscloudtrailtrail_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSCloudtrailTrailsColumns[1]},
+			},
+		},
+	}
+	// AWSCloudtrailTrailsHistoryColumns holds the columns for the "aws_cloudtrail_trails_history" table.
+	AWSCloudtrailTrailsHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "s3_bucket_name", Type: field.TypeString, Nullable: true},
+		{Name: "s3_key_prefix", Type: field.TypeString, Nullable: true},
+		{Name: "sns_topic_arn", Type: field.TypeString, Nullable: true},
+		{Name: "is_multi_region_trail", Type: field.TypeBool, Nullable: true},
+		{Name: "is_organization_trail", Type: field.TypeBool, Nullable: true},
+		{Name: "include_global_service_events", Type: field.TypeBool, Nullable: true},
+		{Name: "log_file_validation_enabled", Type: field.TypeBool, Nullable: true},
+		{Name: "kms_key_id", Type: field.TypeString, Nullable: true},
+		{Name: "cloud_watch_logs_log_group_arn", Type: field.TypeString, Nullable: true},
+		{Name: "cloud_watch_logs_role_arn", Type: field.TypeString, Nullable: true},
+		{Name: "has_custom_event_selectors", Type: field.TypeBool, Nullable: true},
+		{Name: "has_insight_selectors", Type: field.TypeBool, Nullable: true},
+		{Name: "is_logging", Type: field.TypeBool, Nullable: true},
+		{Name: "event_selectors_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "advanced_event_selectors_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSCloudtrailTrailsHistoryTable holds the schema information for the "aws_cloudtrail_trails_history" table.
+	AWSCloudtrailTrailsHistoryTable = &schema.Table{
+		Name:       "aws_cloudtrail_trails_history",
+		Columns:    AWSCloudtrailTrailsHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSCloudtrailTrailsHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawscloudtrailtrail_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSCloudtrailTrailsHistoryColumns[5], AWSCloudtrailTrailsHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawscloudtrailtrail_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSCloudtrailTrailsHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawscloudtrailtrail_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSCloudtrailTrailsHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawscloudtrailtrail_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSCloudtrailTrailsHistoryColumns[23]},
+			},
+			{
+				Name:    "bronzehistoryawscloudtrailtrail_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSCloudtrailTrailsHistoryColumns[24]},
+			},
+		},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		AWSCloudtrailTrailsTable,
+		AWSCloudtrailTrailsHistoryTable,
+	}
+)
+
+func init() {
+	AWSCloudtrailTrailsTable.Annotation = &entsql.Annotation{
+		Table: "aws_cloudtrail_trails",
+	}
+	AWSCloudtrailTrailsHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_cloudtrail_trails_history",
+	}
+}
@@ -0,0 +1,3790 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cloudtrail
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/bronzeawscloudtrailtrail"
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/bronzehistoryawscloudtrailtrail"
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/predicate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Operation types.
+	OpCreate    = ent.OpCreate
+	OpDelete    = ent.OpDelete
+	OpDeleteOne = ent.OpDeleteOne
+	OpUpdate    = ent.OpUpdate
+	OpUpdateOne = ent.OpUpdateOne
+
+	// Node types.
+	TypeBronzeAWSCloudTrailTrail        = "BronzeAWSCloudTrailTrail"
+	TypeBronzeHistoryAWSCloudTrailTrail = "BronzeHistoryAWSCloudTrailTrail"
+)
+
+// BronzeAWSCloudTrailTrailMutation represents an operation that mutates the BronzeAWSCloudTrailTrail nodes in the graph.
+type BronzeAWSCloudTrailTrailMutation struct {
+	config
+	op                                  Op
+	typ                                 string
+	id                                  *string
+	collected_at                        *time.Time
+	first_collected_at                  *time.Time
+	name                                *string
+	s3_bucket_name                      *string
+	s3_key_prefix                       *string
+	sns_topic_arn                       *string
+	is_multi_region_trail               *bool
+	is_organization_trail               *bool
+	include_global_service_events       *bool
+	log_file_validation_enabled         *bool
+	kms_key_id                          *string
+	cloud_watch_logs_log_group_arn      *string
+	cloud_watch_logs_role_arn           *string
+	has_custom_event_selectors          *bool
+	has_insight_selectors               *bool
+	is_logging                          *bool
+	event_selectors_json                *json.RawMessage
+	appendevent_selectors_json          json.RawMessage
+	advanced_event_selectors_json       *json.RawMessage
+	appendadvanced_event_selectors_json json.RawMessage
+	tags_json                           *json.RawMessage
+	appendtags_json                     json.RawMessage
+	account_id                          *string
+	region                              *string
+	clearedFields                       map[string]struct{}
+	done                                bool
+	oldValue                            func(context.Context) (*BronzeAWSCloudTrailTrail, error)
+	predicates                          []predicate.BronzeAWSCloudTrailTrail
+}
+
+var _ ent.Mutation = (*BronzeAWSCloudTrailTrailMutation)(nil)
+
+// bronzeawscloudtrailtrailOption allows management of the mutation configuration using functional options.
+type bronzeawscloudtrailtrailOption func(*BronzeAWSCloudTrailTrailMutation)
+
+// newBronzeAWSCloudTrailTrailMutation creates new mutation for the BronzeAWSCloudTrailTrail entity.
+func newBronzeAWSCloudTrailTrailMutation(c config, op Op, opts ...bronzeawscloudtrailtrailOption) *BronzeAWSCloudTrailTrailMutation {
+	m := &BronzeAWSCloudTrailTrailMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeAWSCloudTrailTrail,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeAWSCloudTrailTrailID sets the ID field of the mutation.
+func withBronzeAWSCloudTrailTrailID(id string) bronzeawscloudtrailtrailOption {
+	return func(m *BronzeAWSCloudTrailTrailMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeAWSCloudTrailTrail
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeAWSCloudTrailTrail, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeAWSCloudTrailTrail.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeAWSCloudTrailTrail sets the old BronzeAWSCloudTrailTrail of the mutation.
+func withBronzeAWSCloudTrailTrail(node *BronzeAWSCloudTrailTrail) bronzeawscloudtrailtrailOption {
+	return func(m *BronzeAWSCloudTrailTrailMutation) {
+		m.oldValue = func(context.Context) (*BronzeAWSCloudTrailTrail, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeAWSCloudTrailTrailMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeAWSCloudTrailTrailMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("cloudtrail: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeAWSCloudTrailTrail entities.
+func (m *BronzeAWSCloudTrailTrailMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeAWSCloudTrailTrailMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeAWSCloudTrailTrail.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[bronzeawscloudtrailtrail.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) NameCleared() bool {
+	_, ok := m.clearedFields[bronzeawscloudtrailtrail.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, bronzeawscloudtrailtrail.FieldName)
+}
+
+// SetS3BucketName sets the "s3_bucket_name" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetS3BucketName(s string) {
+	m.s3_bucket_name = &s
+}
+
+// S3BucketName returns the value of the "s3_bucket_name" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) S3BucketName() (r string, exists bool) {
+	v := m.s3_bucket_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldS3BucketName returns the old "s3_bucket_name" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldS3BucketName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldS3BucketName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldS3BucketName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldS3BucketName: %w", err)
+	}
+	return oldValue.S3BucketName, nil
+}
+
+// ClearS3BucketName clears the value of the "s3_bucket_name" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearS3BucketName() {
+	m.s3_bucket_name = nil
+	m.clearedFields[bronzeawscloudtrailtrail.FieldS3BucketName] = struct{}{}
+}
+
+// S3BucketNameCleared returns if the "s3_bucket_name" field was cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) S3BucketNameCleared() bool {
+	_, ok := m.clearedFields[bronzeawscloudtrailtrail.FieldS3BucketName]
+	return ok
+}
+
+// ResetS3BucketName resets all changes to the "s3_bucket_name" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetS3BucketName() {
+	m.s3_bucket_name = nil
+	delete(m.clearedFields, bronzeawscloudtrailtrail.FieldS3BucketName)
+}
+
+// SetS3KeyPrefix sets the "s3_key_prefix" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetS3KeyPrefix(s string) {
+	m.s3_key_prefix = &s
+}
+
+// S3KeyPrefix returns the value of the "s3_key_prefix" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) S3KeyPrefix() (r string, exists bool) {
+	v := m.s3_key_prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldS3KeyPrefix returns the old "s3_key_prefix" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldS3KeyPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldS3KeyPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldS3KeyPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldS3KeyPrefix: %w", err)
+	}
+	return oldValue.S3KeyPrefix, nil
+}
+
+// ClearS3KeyPrefix clears the value of the "s3_key_prefix" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearS3KeyPrefix() {
+	m.s3_key_prefix = nil
+	m.clearedFields[bronzeawscloudtrailtrail.FieldS3KeyPrefix] = struct{}{}
+}
+
+// S3KeyPrefixCleared returns if the "s3_key_prefix" field was cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) S3KeyPrefixCleared() bool {
+	_, ok := m.clearedFields[bronzeawscloudtrailtrail.FieldS3KeyPrefix]
+	return ok
+}
+
+// ResetS3KeyPrefix resets all changes to the "s3_key_prefix" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetS3KeyPrefix() {
+	m.s3_key_prefix = nil
+	delete(m.clearedFields, bronzeawscloudtrailtrail.FieldS3KeyPrefix)
+}
+
+// SetSnsTopicArn sets the "sns_topic_arn" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetSnsTopicArn(s string) {
+	m.sns_topic_arn = &s
+}
+
+// SnsTopicArn returns the value of the "sns_topic_arn" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) SnsTopicArn() (r string, exists bool) {
+	v := m.sns_topic_arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSnsTopicArn returns the old "sns_topic_arn" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldSnsTopicArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSnsTopicArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSnsTopicArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSnsTopicArn: %w", err)
+	}
+	return oldValue.SnsTopicArn, nil
+}
+
+// ClearSnsTopicArn clears the value of the "sns_topic_arn" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearSnsTopicArn() {
+	m.sns_topic_arn = nil
+	m.clearedFields[bronzeawscloudtrailtrail.FieldSnsTopicArn] = struct{}{}
+}
+
+// SnsTopicArnCleared returns if the "sns_topic_arn" field was cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) SnsTopicArnCleared() bool {
+	_, ok := m.clearedFields[bronzeawscloudtrailtrail.FieldSnsTopicArn]
+	return ok
+}
+
+// ResetSnsTopicArn resets all changes to the "sns_topic_arn" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetSnsTopicArn() {
+	m.sns_topic_arn = nil
+	delete(m.clearedFields, bronzeawscloudtrailtrail.FieldSnsTopicArn)
+}
+
+// SetIsMultiRegionTrail sets the "is_multi_region_trail" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetIsMultiRegionTrail(b bool) {
+	m.is_multi_region_trail = &b
+}
+
+// IsMultiRegionTrail returns the value of the "is_multi_region_trail" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) IsMultiRegionTrail() (r bool, exists bool) {
+	v := m.is_multi_region_trail
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsMultiRegionTrail returns the old "is_multi_region_trail" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldIsMultiRegionTrail(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsMultiRegionTrail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsMultiRegionTrail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsMultiRegionTrail: %w", err)
+	}
+	return oldValue.IsMultiRegionTrail, nil
+}
+
+// ClearIsMultiRegionTrail clears the value of the "is_multi_region_trail" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearIsMultiRegionTrail() {
+	m.is_multi_region_trail = nil
+	m.clearedFields[bronzeawscloudtrailtrail.FieldIsMultiRegionTrail] = struct{}{}
+}
+
+// IsMultiRegionTrailCleared returns if the "is_multi_region_trail" field was cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) IsMultiRegionTrailCleared() bool {
+	_, ok := m.clearedFields[bronzeawscloudtrailtrail.FieldIsMultiRegionTrail]
+	return ok
+}
+
+// ResetIsMultiRegionTrail resets all changes to the "is_multi_region_trail" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetIsMultiRegionTrail() {
+	m.is_multi_region_trail = nil
+	delete(m.clearedFields, bronzeawscloudtrailtrail.FieldIsMultiRegionTrail)
+}
+
+// SetIsOrganizationTrail sets the "is_organization_trail" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetIsOrganizationTrail(b bool) {
+	m.is_organization_trail = &b
+}
+
+// IsOrganizationTrail returns the value of the "is_organization_trail" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) IsOrganizationTrail() (r bool, exists bool) {
+	v := m.is_organization_trail
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsOrganizationTrail returns the old "is_organization_trail" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldIsOrganizationTrail(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsOrganizationTrail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsOrganizationTrail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsOrganizationTrail: %w", err)
+	}
+	return oldValue.IsOrganizationTrail, nil
+}
+
+// ClearIsOrganizationTrail clears the value of the "is_organization_trail" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearIsOrganizationTrail() {
+	m.is_organization_trail = nil
+	m.clearedFields[bronzeawscloudtrailtrail.FieldIsOrganizationTrail] = struct{}{}
+}
+
+// IsOrganizationTrailCleared returns if the "is_organization_trail" field was cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) IsOrganizationTrailCleared() bool {
+	_, ok := m.clearedFields[bronzeawscloudtrailtrail.FieldIsOrganizationTrail]
+	return ok
+}
+
+// ResetIsOrganizationTrail resets all changes to the "is_organization_trail" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetIsOrganizationTrail() {
+	m.is_organization_trail = nil
+	delete(m.clearedFields, bronzeawscloudtrailtrail.FieldIsOrganizationTrail)
+}
+
+// SetIncludeGlobalServiceEvents sets the "include_global_service_events" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetIncludeGlobalServiceEvents(b bool) {
+	m.include_global_service_events = &b
+}
+
+// IncludeGlobalServiceEvents returns the value of the "include_global_service_events" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) IncludeGlobalServiceEvents() (r bool, exists bool) {
+	v := m.include_global_service_events
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIncludeGlobalServiceEvents returns the old "include_global_service_events" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldIncludeGlobalServiceEvents(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIncludeGlobalServiceEvents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIncludeGlobalServiceEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIncludeGlobalServiceEvents: %w", err)
+	}
+	return oldValue.IncludeGlobalServiceEvents, nil
+}
+
+// ClearIncludeGlobalServiceEvents clears the value of the "include_global_service_events" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearIncludeGlobalServiceEvents() {
+	m.include_global_service_events = nil
+	m.clearedFields[bronzeawscloudtrailtrail.FieldIncludeGlobalServiceEvents] = struct{}{}
+}
+
+// IncludeGlobalServiceEventsCleared returns if the "include_global_service_events" field was cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) IncludeGlobalServiceEventsCleared() bool {
+	_, ok := m.clearedFields[bronzeawscloudtrailtrail.FieldIncludeGlobalServiceEvents]
+	return ok
+}
+
+// ResetIncludeGlobalServiceEvents resets all changes to the "include_global_service_events" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetIncludeGlobalServiceEvents() {
+	m.include_global_service_events = nil
+	delete(m.clearedFields, bronzeawscloudtrailtrail.FieldIncludeGlobalServiceEvents)
+}
+
+// SetLogFileValidationEnabled sets the "log_file_validation_enabled" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetLogFileValidationEnabled(b bool) {
+	m.log_file_validation_enabled = &b
+}
+
+// LogFileValidationEnabled returns the value of the "log_file_validation_enabled" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) LogFileValidationEnabled() (r bool, exists bool) {
+	v := m.log_file_validation_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogFileValidationEnabled returns the old "log_file_validation_enabled" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldLogFileValidationEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogFileValidationEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogFileValidationEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogFileValidationEnabled: %w", err)
+	}
+	return oldValue.LogFileValidationEnabled, nil
+}
+
+// ClearLogFileValidationEnabled clears the value of the "log_file_validation_enabled" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearLogFileValidationEnabled() {
+	m.log_file_validation_enabled = nil
+	m.clearedFields[bronzeawscloudtrailtrail.FieldLogFileValidationEnabled] = struct{}{}
+}
+
+// LogFileValidationEnabledCleared returns if the "log_file_validation_enabled" field was cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) LogFileValidationEnabledCleared() bool {
+	_, ok := m.clearedFields[bronzeawscloudtrailtrail.FieldLogFileValidationEnabled]
+	return ok
+}
+
+// ResetLogFileValidationEnabled resets all changes to the "log_file_validation_enabled" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetLogFileValidationEnabled() {
+	m.log_file_validation_enabled = nil
+	delete(m.clearedFields, bronzeawscloudtrailtrail.FieldLogFileValidationEnabled)
+}
+
+// SetKmsKeyID sets the "kms_key_id" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetKmsKeyID(s string) {
+	m.kms_key_id = &s
+}
+
+// KmsKeyID returns the value of the "kms_key_id" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) KmsKeyID() (r string, exists bool) {
+	v := m.kms_key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKmsKeyID returns the old "kms_key_id" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldKmsKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKmsKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKmsKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKmsKeyID: %w", err)
+	}
+	return oldValue.KmsKeyID, nil
+}
+
+// ClearKmsKeyID clears the value of the "kms_key_id" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearKmsKeyID() {
+	m.kms_key_id = nil
+	m.clearedFields[bronzeawscloudtrailtrail.FieldKmsKeyID] = struct{}{}
+}
+
+// KmsKeyIDCleared returns if the "kms_key_id" field was cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) KmsKeyIDCleared() bool {
+	_, ok := m.clearedFields[bronzeawscloudtrailtrail.FieldKmsKeyID]
+	return ok
+}
+
+// ResetKmsKeyID resets all changes to the "kms_key_id" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetKmsKeyID() {
+	m.kms_key_id = nil
+	delete(m.clearedFields, bronzeawscloudtrailtrail.FieldKmsKeyID)
+}
+
+// SetCloudWatchLogsLogGroupArn sets the "cloud_watch_logs_log_group_arn" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetCloudWatchLogsLogGroupArn(s string) {
+	m.cloud_watch_logs_log_group_arn = &s
+}
+
+// CloudWatchLogsLogGroupArn returns the value of the "cloud_watch_logs_log_group_arn" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) CloudWatchLogsLogGroupArn() (r string, exists bool) {
+	v := m.cloud_watch_logs_log_group_arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCloudWatchLogsLogGroupArn returns the old "cloud_watch_logs_log_group_arn" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldCloudWatchLogsLogGroupArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCloudWatchLogsLogGroupArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCloudWatchLogsLogGroupArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCloudWatchLogsLogGroupArn: %w", err)
+	}
+	return oldValue.CloudWatchLogsLogGroupArn, nil
+}
+
+// ClearCloudWatchLogsLogGroupArn clears the value of the "cloud_watch_logs_log_group_arn" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearCloudWatchLogsLogGroupArn() {
+	m.cloud_watch_logs_log_group_arn = nil
+	m.clearedFields[bronzeawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn] = struct{}{}
+}
+
+// CloudWatchLogsLogGroupArnCleared returns if the "cloud_watch_logs_log_group_arn" field was cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) CloudWatchLogsLogGroupArnCleared() bool {
+	_, ok := m.clearedFields[bronzeawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn]
+	return ok
+}
+
+// ResetCloudWatchLogsLogGroupArn resets all changes to the "cloud_watch_logs_log_group_arn" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetCloudWatchLogsLogGroupArn() {
+	m.cloud_watch_logs_log_group_arn = nil
+	delete(m.clearedFields, bronzeawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn)
+}
+
+// SetCloudWatchLogsRoleArn sets the "cloud_watch_logs_role_arn" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetCloudWatchLogsRoleArn(s string) {
+	m.cloud_watch_logs_role_arn = &s
+}
+
+// CloudWatchLogsRoleArn returns the value of the "cloud_watch_logs_role_arn" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) CloudWatchLogsRoleArn() (r string, exists bool) {
+	v := m.cloud_watch_logs_role_arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCloudWatchLogsRoleArn returns the old "cloud_watch_logs_role_arn" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldCloudWatchLogsRoleArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCloudWatchLogsRoleArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCloudWatchLogsRoleArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCloudWatchLogsRoleArn: %w", err)
+	}
+	return oldValue.CloudWatchLogsRoleArn, nil
+}
+
+// ClearCloudWatchLogsRoleArn clears the value of the "cloud_watch_logs_role_arn" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearCloudWatchLogsRoleArn() {
+	m.cloud_watch_logs_role_arn = nil
+	m.clearedFields[bronzeawscloudtrailtrail.FieldCloudWatchLogsRoleArn] = struct{}{}
+}
+
+// CloudWatchLogsRoleArnCleared returns if the "cloud_watch_logs_role_arn" field was cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) CloudWatchLogsRoleArnCleared() bool {
+	_, ok := m.clearedFields[bronzeawscloudtrailtrail.FieldCloudWatchLogsRoleArn]
+	return ok
+}
+
+// ResetCloudWatchLogsRoleArn resets all changes to the "cloud_watch_logs_role_arn" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetCloudWatchLogsRoleArn() {
+	m.cloud_watch_logs_role_arn = nil
+	delete(m.clearedFields, bronzeawscloudtrailtrail.FieldCloudWatchLogsRoleArn)
+}
+
+// SetHasCustomEventSelectors sets the "has_custom_event_selectors" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetHasCustomEventSelectors(b bool) {
+	m.has_custom_event_selectors = &b
+}
+
+// HasCustomEventSelectors returns the value of the "has_custom_event_selectors" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) HasCustomEventSelectors() (r bool, exists bool) {
+	v := m.has_custom_event_selectors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHasCustomEventSelectors returns the old "has_custom_event_selectors" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldHasCustomEventSelectors(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHasCustomEventSelectors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHasCustomEventSelectors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHasCustomEventSelectors: %w", err)
+	}
+	return oldValue.HasCustomEventSelectors, nil
+}
+
+// ClearHasCustomEventSelectors clears the value of the "has_custom_event_selectors" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearHasCustomEventSelectors() {
+	m.has_custom_event_selectors = nil
+	m.clearedFields[bronzeawscloudtrailtrail.FieldHasCustomEventSelectors] = struct{}{}
+}
+
+// HasCustomEventSelectorsCleared returns if the "has_custom_event_selectors" field was cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) HasCustomEventSelectorsCleared() bool {
+	_, ok := m.clearedFields[bronzeawscloudtrailtrail.FieldHasCustomEventSelectors]
+	return ok
+}
+
+// ResetHasCustomEventSelectors resets all changes to the "has_custom_event_selectors" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetHasCustomEventSelectors() {
+	m.has_custom_event_selectors = nil
+	delete(m.clearedFields, bronzeawscloudtrailtrail.FieldHasCustomEventSelectors)
+}
+
+// SetHasInsightSelectors sets the "has_insight_selectors" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetHasInsightSelectors(b bool) {
+	m.has_insight_selectors = &b
+}
+
+// HasInsightSelectors returns the value of the "has_insight_selectors" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) HasInsightSelectors() (r bool, exists bool) {
+	v := m.has_insight_selectors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHasInsightSelectors returns the old "has_insight_selectors" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldHasInsightSelectors(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHasInsightSelectors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHasInsightSelectors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHasInsightSelectors: %w", err)
+	}
+	return oldValue.HasInsightSelectors, nil
+}
+
+// ClearHasInsightSelectors clears the value of the "has_insight_selectors" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearHasInsightSelectors() {
+	m.has_insight_selectors = nil
+	m.clearedFields[bronzeawscloudtrailtrail.FieldHasInsightSelectors] = struct{}{}
+}
+
+// HasInsightSelectorsCleared returns if the "has_insight_selectors" field was cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) HasInsightSelectorsCleared() bool {
+	_, ok := m.clearedFields[bronzeawscloudtrailtrail.FieldHasInsightSelectors]
+	return ok
+}
+
+// ResetHasInsightSelectors resets all changes to the "has_insight_selectors" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetHasInsightSelectors() {
+	m.has_insight_selectors = nil
+	delete(m.clearedFields, bronzeawscloudtrailtrail.FieldHasInsightSelectors)
+}
+
+// SetIsLogging sets the "is_logging" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetIsLogging(b bool) {
+	m.is_logging = &b
+}
+
+// IsLogging returns the value of the "is_logging" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) IsLogging() (r bool, exists bool) {
+	v := m.is_logging
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsLogging returns the old "is_logging" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldIsLogging(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsLogging is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsLogging requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsLogging: %w", err)
+	}
+	return oldValue.IsLogging, nil
+}
+
+// ClearIsLogging clears the value of the "is_logging" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearIsLogging() {
+	m.is_logging = nil
+	m.clearedFields[bronzeawscloudtrailtrail.FieldIsLogging] = struct{}{}
+}
+
+// IsLoggingCleared returns if the "is_logging" field was cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) IsLoggingCleared() bool {
+	_, ok := m.clearedFields[bronzeawscloudtrailtrail.FieldIsLogging]
+	return ok
+}
+
+// ResetIsLogging resets all changes to the "is_logging" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetIsLogging() {
+	m.is_logging = nil
+	delete(m.clearedFields, bronzeawscloudtrailtrail.FieldIsLogging)
+}
+
+// SetEventSelectorsJSON sets the "event_selectors_json" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetEventSelectorsJSON(jm json.RawMessage) {
+	m.event_selectors_json = &jm
+	m.appendevent_selectors_json = nil
+}
+
+// EventSelectorsJSON returns the value of the "event_selectors_json" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) EventSelectorsJSON() (r json.RawMessage, exists bool) {
+	v := m.event_selectors_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventSelectorsJSON returns the old "event_selectors_json" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldEventSelectorsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventSelectorsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventSelectorsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventSelectorsJSON: %w", err)
+	}
+	return oldValue.EventSelectorsJSON, nil
+}
+
+// AppendEventSelectorsJSON adds jm to the "event_selectors_json" field.
+func (m *BronzeAWSCloudTrailTrailMutation) AppendEventSelectorsJSON(jm json.RawMessage) {
+	m.appendevent_selectors_json = append(m.appendevent_selectors_json, jm...)
+}
+
+// AppendedEventSelectorsJSON returns the list of values that were appended to the "event_selectors_json" field in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) AppendedEventSelectorsJSON() (json.RawMessage, bool) {
+	if len(m.appendevent_selectors_json) == 0 {
+		return nil, false
+	}
+	return m.appendevent_selectors_json, true
+}
+
+// ClearEventSelectorsJSON clears the value of the "event_selectors_json" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearEventSelectorsJSON() {
+	m.event_selectors_json = nil
+	m.appendevent_selectors_json = nil
+	m.clearedFields[bronzeawscloudtrailtrail.FieldEventSelectorsJSON] = struct{}{}
+}
+
+// EventSelectorsJSONCleared returns if the "event_selectors_json" field was cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) EventSelectorsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawscloudtrailtrail.FieldEventSelectorsJSON]
+	return ok
+}
+
+// ResetEventSelectorsJSON resets all changes to the "event_selectors_json" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetEventSelectorsJSON() {
+	m.event_selectors_json = nil
+	m.appendevent_selectors_json = nil
+	delete(m.clearedFields, bronzeawscloudtrailtrail.FieldEventSelectorsJSON)
+}
+
+// SetAdvancedEventSelectorsJSON sets the "advanced_event_selectors_json" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetAdvancedEventSelectorsJSON(jm json.RawMessage) {
+	m.advanced_event_selectors_json = &jm
+	m.appendadvanced_event_selectors_json = nil
+}
+
+// AdvancedEventSelectorsJSON returns the value of the "advanced_event_selectors_json" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) AdvancedEventSelectorsJSON() (r json.RawMessage, exists bool) {
+	v := m.advanced_event_selectors_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdvancedEventSelectorsJSON returns the old "advanced_event_selectors_json" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldAdvancedEventSelectorsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdvancedEventSelectorsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdvancedEventSelectorsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdvancedEventSelectorsJSON: %w", err)
+	}
+	return oldValue.AdvancedEventSelectorsJSON, nil
+}
+
+// AppendAdvancedEventSelectorsJSON adds jm to the "advanced_event_selectors_json" field.
+func (m *BronzeAWSCloudTrailTrailMutation) AppendAdvancedEventSelectorsJSON(jm json.RawMessage) {
+	m.appendadvanced_event_selectors_json = append(m.appendadvanced_event_selectors_json, jm...)
+}
+
+// AppendedAdvancedEventSelectorsJSON returns the list of values that were appended to the "advanced_event_selectors_json" field in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) AppendedAdvancedEventSelectorsJSON() (json.RawMessage, bool) {
+	if len(m.appendadvanced_event_selectors_json) == 0 {
+		return nil, false
+	}
+	return m.appendadvanced_event_selectors_json, true
+}
+
+// ClearAdvancedEventSelectorsJSON clears the value of the "advanced_event_selectors_json" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearAdvancedEventSelectorsJSON() {
+	m.advanced_event_selectors_json = nil
+	m.appendadvanced_event_selectors_json = nil
+	m.clearedFields[bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON] = struct{}{}
+}
+
+// AdvancedEventSelectorsJSONCleared returns if the "advanced_event_selectors_json" field was cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) AdvancedEventSelectorsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON]
+	return ok
+}
+
+// ResetAdvancedEventSelectorsJSON resets all changes to the "advanced_event_selectors_json" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetAdvancedEventSelectorsJSON() {
+	m.advanced_event_selectors_json = nil
+	m.appendadvanced_event_selectors_json = nil
+	delete(m.clearedFields, bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON)
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetTagsJSON(jm json.RawMessage) {
+	m.tags_json = &jm
+	m.appendtags_json = nil
+}
+
+// TagsJSON returns the value of the "tags_json" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) TagsJSON() (r json.RawMessage, exists bool) {
+	v := m.tags_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagsJSON returns the old "tags_json" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldTagsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagsJSON: %w", err)
+	}
+	return oldValue.TagsJSON, nil
+}
+
+// AppendTagsJSON adds jm to the "tags_json" field.
+func (m *BronzeAWSCloudTrailTrailMutation) AppendTagsJSON(jm json.RawMessage) {
+	m.appendtags_json = append(m.appendtags_json, jm...)
+}
+
+// AppendedTagsJSON returns the list of values that were appended to the "tags_json" field in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) AppendedTagsJSON() (json.RawMessage, bool) {
+	if len(m.appendtags_json) == 0 {
+		return nil, false
+	}
+	return m.appendtags_json, true
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	m.clearedFields[bronzeawscloudtrailtrail.FieldTagsJSON] = struct{}{}
+}
+
+// TagsJSONCleared returns if the "tags_json" field was cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) TagsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawscloudtrailtrail.FieldTagsJSON]
+	return ok
+}
+
+// ResetTagsJSON resets all changes to the "tags_json" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	delete(m.clearedFields, bronzeawscloudtrailtrail.FieldTagsJSON)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetAccountID(s string) {
+	m.account_id = &s
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) AccountID() (r string, exists bool) {
+	v := m.account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetAccountID() {
+	m.account_id = nil
+}
+
+// SetRegion sets the "region" field.
+func (m *BronzeAWSCloudTrailTrailMutation) SetRegion(s string) {
+	m.region = &s
+}
+
+// Region returns the value of the "region" field in the mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) Region() (r string, exists bool) {
+	v := m.region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegion returns the old "region" field's value of the BronzeAWSCloudTrailTrail entity.
+// If the BronzeAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSCloudTrailTrailMutation) OldRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
+	}
+	return oldValue.Region, nil
+}
+
+// ResetRegion resets all changes to the "region" field.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetRegion() {
+	m.region = nil
+}
+
+// Where appends a list predicates to the BronzeAWSCloudTrailTrailMutation builder.
+func (m *BronzeAWSCloudTrailTrailMutation) Where(ps ...predicate.BronzeAWSCloudTrailTrail) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeAWSCloudTrailTrailMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeAWSCloudTrailTrailMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeAWSCloudTrailTrail, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeAWSCloudTrailTrailMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeAWSCloudTrailTrailMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeAWSCloudTrailTrail).
+func (m *BronzeAWSCloudTrailTrailMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeAWSCloudTrailTrailMutation) Fields() []string {
+	fields := make([]string, 0, 21)
+	if m.collected_at != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldFirstCollectedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldName)
+	}
+	if m.s3_bucket_name != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldS3BucketName)
+	}
+	if m.s3_key_prefix != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldS3KeyPrefix)
+	}
+	if m.sns_topic_arn != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldSnsTopicArn)
+	}
+	if m.is_multi_region_trail != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldIsMultiRegionTrail)
+	}
+	if m.is_organization_trail != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldIsOrganizationTrail)
+	}
+	if m.include_global_service_events != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldIncludeGlobalServiceEvents)
+	}
+	if m.log_file_validation_enabled != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldLogFileValidationEnabled)
+	}
+	if m.kms_key_id != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldKmsKeyID)
+	}
+	if m.cloud_watch_logs_log_group_arn != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn)
+	}
+	if m.cloud_watch_logs_role_arn != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldCloudWatchLogsRoleArn)
+	}
+	if m.has_custom_event_selectors != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldHasCustomEventSelectors)
+	}
+	if m.has_insight_selectors != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldHasInsightSelectors)
+	}
+	if m.is_logging != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldIsLogging)
+	}
+	if m.event_selectors_json != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldEventSelectorsJSON)
+	}
+	if m.advanced_event_selectors_json != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON)
+	}
+	if m.tags_json != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldTagsJSON)
+	}
+	if m.account_id != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldAccountID)
+	}
+	if m.region != nil {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldRegion)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeAWSCloudTrailTrailMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzeawscloudtrailtrail.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzeawscloudtrailtrail.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzeawscloudtrailtrail.FieldName:
+		return m.Name()
+	case bronzeawscloudtrailtrail.FieldS3BucketName:
+		return m.S3BucketName()
+	case bronzeawscloudtrailtrail.FieldS3KeyPrefix:
+		return m.S3KeyPrefix()
+	case bronzeawscloudtrailtrail.FieldSnsTopicArn:
+		return m.SnsTopicArn()
+	case bronzeawscloudtrailtrail.FieldIsMultiRegionTrail:
+		return m.IsMultiRegionTrail()
+	case bronzeawscloudtrailtrail.FieldIsOrganizationTrail:
+		return m.IsOrganizationTrail()
+	case bronzeawscloudtrailtrail.FieldIncludeGlobalServiceEvents:
+		return m.IncludeGlobalServiceEvents()
+	case bronzeawscloudtrailtrail.FieldLogFileValidationEnabled:
+		return m.LogFileValidationEnabled()
+	case bronzeawscloudtrailtrail.FieldKmsKeyID:
+		return m.KmsKeyID()
+	case bronzeawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn:
+		return m.CloudWatchLogsLogGroupArn()
+	case bronzeawscloudtrailtrail.FieldCloudWatchLogsRoleArn:
+		return m.CloudWatchLogsRoleArn()
+	case bronzeawscloudtrailtrail.FieldHasCustomEventSelectors:
+		return m.HasCustomEventSelectors()
+	case bronzeawscloudtrailtrail.FieldHasInsightSelectors:
+		return m.HasInsightSelectors()
+	case bronzeawscloudtrailtrail.FieldIsLogging:
+		return m.IsLogging()
+	case bronzeawscloudtrailtrail.FieldEventSelectorsJSON:
+		return m.EventSelectorsJSON()
+	case bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON:
+		return m.AdvancedEventSelectorsJSON()
+	case bronzeawscloudtrailtrail.FieldTagsJSON:
+		return m.TagsJSON()
+	case bronzeawscloudtrailtrail.FieldAccountID:
+		return m.AccountID()
+	case bronzeawscloudtrailtrail.FieldRegion:
+		return m.Region()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeAWSCloudTrailTrailMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzeawscloudtrailtrail.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzeawscloudtrailtrail.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzeawscloudtrailtrail.FieldName:
+		return m.OldName(ctx)
+	case bronzeawscloudtrailtrail.FieldS3BucketName:
+		return m.OldS3BucketName(ctx)
+	case bronzeawscloudtrailtrail.FieldS3KeyPrefix:
+		return m.OldS3KeyPrefix(ctx)
+	case bronzeawscloudtrailtrail.FieldSnsTopicArn:
+		return m.OldSnsTopicArn(ctx)
+	case bronzeawscloudtrailtrail.FieldIsMultiRegionTrail:
+		return m.OldIsMultiRegionTrail(ctx)
+	case bronzeawscloudtrailtrail.FieldIsOrganizationTrail:
+		return m.OldIsOrganizationTrail(ctx)
+	case bronzeawscloudtrailtrail.FieldIncludeGlobalServiceEvents:
+		return m.OldIncludeGlobalServiceEvents(ctx)
+	case bronzeawscloudtrailtrail.FieldLogFileValidationEnabled:
+		return m.OldLogFileValidationEnabled(ctx)
+	case bronzeawscloudtrailtrail.FieldKmsKeyID:
+		return m.OldKmsKeyID(ctx)
+	case bronzeawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn:
+		return m.OldCloudWatchLogsLogGroupArn(ctx)
+	case bronzeawscloudtrailtrail.FieldCloudWatchLogsRoleArn:
+		return m.OldCloudWatchLogsRoleArn(ctx)
+	case bronzeawscloudtrailtrail.FieldHasCustomEventSelectors:
+		return m.OldHasCustomEventSelectors(ctx)
+	case bronzeawscloudtrailtrail.FieldHasInsightSelectors:
+		return m.OldHasInsightSelectors(ctx)
+	case bronzeawscloudtrailtrail.FieldIsLogging:
+		return m.OldIsLogging(ctx)
+	case bronzeawscloudtrailtrail.FieldEventSelectorsJSON:
+		return m.OldEventSelectorsJSON(ctx)
+	case bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON:
+		return m.OldAdvancedEventSelectorsJSON(ctx)
+	case bronzeawscloudtrailtrail.FieldTagsJSON:
+		return m.OldTagsJSON(ctx)
+	case bronzeawscloudtrailtrail.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case bronzeawscloudtrailtrail.FieldRegion:
+		return m.OldRegion(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeAWSCloudTrailTrail field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeAWSCloudTrailTrailMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzeawscloudtrailtrail.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldS3BucketName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetS3BucketName(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldS3KeyPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetS3KeyPrefix(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldSnsTopicArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSnsTopicArn(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldIsMultiRegionTrail:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsMultiRegionTrail(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldIsOrganizationTrail:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsOrganizationTrail(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldIncludeGlobalServiceEvents:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIncludeGlobalServiceEvents(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldLogFileValidationEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogFileValidationEnabled(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldKmsKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKmsKeyID(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCloudWatchLogsLogGroupArn(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldCloudWatchLogsRoleArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCloudWatchLogsRoleArn(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldHasCustomEventSelectors:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHasCustomEventSelectors(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldHasInsightSelectors:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHasInsightSelectors(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldIsLogging:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsLogging(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldEventSelectorsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventSelectorsJSON(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdvancedEventSelectorsJSON(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldTagsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagsJSON(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldAccountID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case bronzeawscloudtrailtrail.FieldRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSCloudTrailTrail field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeAWSCloudTrailTrailMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeAWSCloudTrailTrailMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeAWSCloudTrailTrail numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzeawscloudtrailtrail.FieldName) {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldName)
+	}
+	if m.FieldCleared(bronzeawscloudtrailtrail.FieldS3BucketName) {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldS3BucketName)
+	}
+	if m.FieldCleared(bronzeawscloudtrailtrail.FieldS3KeyPrefix) {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldS3KeyPrefix)
+	}
+	if m.FieldCleared(bronzeawscloudtrailtrail.FieldSnsTopicArn) {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldSnsTopicArn)
+	}
+	if m.FieldCleared(bronzeawscloudtrailtrail.FieldIsMultiRegionTrail) {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldIsMultiRegionTrail)
+	}
+	if m.FieldCleared(bronzeawscloudtrailtrail.FieldIsOrganizationTrail) {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldIsOrganizationTrail)
+	}
+	if m.FieldCleared(bronzeawscloudtrailtrail.FieldIncludeGlobalServiceEvents) {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldIncludeGlobalServiceEvents)
+	}
+	if m.FieldCleared(bronzeawscloudtrailtrail.FieldLogFileValidationEnabled) {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldLogFileValidationEnabled)
+	}
+	if m.FieldCleared(bronzeawscloudtrailtrail.FieldKmsKeyID) {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldKmsKeyID)
+	}
+	if m.FieldCleared(bronzeawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn) {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn)
+	}
+	if m.FieldCleared(bronzeawscloudtrailtrail.FieldCloudWatchLogsRoleArn) {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldCloudWatchLogsRoleArn)
+	}
+	if m.FieldCleared(bronzeawscloudtrailtrail.FieldHasCustomEventSelectors) {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldHasCustomEventSelectors)
+	}
+	if m.FieldCleared(bronzeawscloudtrailtrail.FieldHasInsightSelectors) {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldHasInsightSelectors)
+	}
+	if m.FieldCleared(bronzeawscloudtrailtrail.FieldIsLogging) {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldIsLogging)
+	}
+	if m.FieldCleared(bronzeawscloudtrailtrail.FieldEventSelectorsJSON) {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldEventSelectorsJSON)
+	}
+	if m.FieldCleared(bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON) {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON)
+	}
+	if m.FieldCleared(bronzeawscloudtrailtrail.FieldTagsJSON) {
+		fields = append(fields, bronzeawscloudtrailtrail.FieldTagsJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearField(name string) error {
+	switch name {
+	case bronzeawscloudtrailtrail.FieldName:
+		m.ClearName()
+		return nil
+	case bronzeawscloudtrailtrail.FieldS3BucketName:
+		m.ClearS3BucketName()
+		return nil
+	case bronzeawscloudtrailtrail.FieldS3KeyPrefix:
+		m.ClearS3KeyPrefix()
+		return nil
+	case bronzeawscloudtrailtrail.FieldSnsTopicArn:
+		m.ClearSnsTopicArn()
+		return nil
+	case bronzeawscloudtrailtrail.FieldIsMultiRegionTrail:
+		m.ClearIsMultiRegionTrail()
+		return nil
+	case bronzeawscloudtrailtrail.FieldIsOrganizationTrail:
+		m.ClearIsOrganizationTrail()
+		return nil
+	case bronzeawscloudtrailtrail.FieldIncludeGlobalServiceEvents:
+		m.ClearIncludeGlobalServiceEvents()
+		return nil
+	case bronzeawscloudtrailtrail.FieldLogFileValidationEnabled:
+		m.ClearLogFileValidationEnabled()
+		return nil
+	case bronzeawscloudtrailtrail.FieldKmsKeyID:
+		m.ClearKmsKeyID()
+		return nil
+	case bronzeawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn:
+		m.ClearCloudWatchLogsLogGroupArn()
+		return nil
+	case bronzeawscloudtrailtrail.FieldCloudWatchLogsRoleArn:
+		m.ClearCloudWatchLogsRoleArn()
+		return nil
+	case bronzeawscloudtrailtrail.FieldHasCustomEventSelectors:
+		m.ClearHasCustomEventSelectors()
+		return nil
+	case bronzeawscloudtrailtrail.FieldHasInsightSelectors:
+		m.ClearHasInsightSelectors()
+		return nil
+	case bronzeawscloudtrailtrail.FieldIsLogging:
+		m.ClearIsLogging()
+		return nil
+	case bronzeawscloudtrailtrail.FieldEventSelectorsJSON:
+		m.ClearEventSelectorsJSON()
+		return nil
+	case bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON:
+		m.ClearAdvancedEventSelectorsJSON()
+		return nil
+	case bronzeawscloudtrailtrail.FieldTagsJSON:
+		m.ClearTagsJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSCloudTrailTrail nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetField(name string) error {
+	switch name {
+	case bronzeawscloudtrailtrail.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzeawscloudtrailtrail.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzeawscloudtrailtrail.FieldName:
+		m.ResetName()
+		return nil
+	case bronzeawscloudtrailtrail.FieldS3BucketName:
+		m.ResetS3BucketName()
+		return nil
+	case bronzeawscloudtrailtrail.FieldS3KeyPrefix:
+		m.ResetS3KeyPrefix()
+		return nil
+	case bronzeawscloudtrailtrail.FieldSnsTopicArn:
+		m.ResetSnsTopicArn()
+		return nil
+	case bronzeawscloudtrailtrail.FieldIsMultiRegionTrail:
+		m.ResetIsMultiRegionTrail()
+		return nil
+	case bronzeawscloudtrailtrail.FieldIsOrganizationTrail:
+		m.ResetIsOrganizationTrail()
+		return nil
+	case bronzeawscloudtrailtrail.FieldIncludeGlobalServiceEvents:
+		m.ResetIncludeGlobalServiceEvents()
+		return nil
+	case bronzeawscloudtrailtrail.FieldLogFileValidationEnabled:
+		m.ResetLogFileValidationEnabled()
+		return nil
+	case bronzeawscloudtrailtrail.FieldKmsKeyID:
+		m.ResetKmsKeyID()
+		return nil
+	case bronzeawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn:
+		m.ResetCloudWatchLogsLogGroupArn()
+		return nil
+	case bronzeawscloudtrailtrail.FieldCloudWatchLogsRoleArn:
+		m.ResetCloudWatchLogsRoleArn()
+		return nil
+	case bronzeawscloudtrailtrail.FieldHasCustomEventSelectors:
+		m.ResetHasCustomEventSelectors()
+		return nil
+	case bronzeawscloudtrailtrail.FieldHasInsightSelectors:
+		m.ResetHasInsightSelectors()
+		return nil
+	case bronzeawscloudtrailtrail.FieldIsLogging:
+		m.ResetIsLogging()
+		return nil
+	case bronzeawscloudtrailtrail.FieldEventSelectorsJSON:
+		m.ResetEventSelectorsJSON()
+		return nil
+	case bronzeawscloudtrailtrail.FieldAdvancedEventSelectorsJSON:
+		m.ResetAdvancedEventSelectorsJSON()
+		return nil
+	case bronzeawscloudtrailtrail.FieldTagsJSON:
+		m.ResetTagsJSON()
+		return nil
+	case bronzeawscloudtrailtrail.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case bronzeawscloudtrailtrail.FieldRegion:
+		m.ResetRegion()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSCloudTrailTrail field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeAWSCloudTrailTrailMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeAWSCloudTrailTrailMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeAWSCloudTrailTrail unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeAWSCloudTrailTrailMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeAWSCloudTrailTrail edge %s", name)
+}
+
+// BronzeHistoryAWSCloudTrailTrailMutation represents an operation that mutates the BronzeHistoryAWSCloudTrailTrail nodes in the graph.
+type BronzeHistoryAWSCloudTrailTrailMutation struct {
+	config
+	op                                  Op
+	typ                                 string
+	id                                  *uint
+	valid_from                          *time.Time
+	valid_to                            *time.Time
+	collected_at                        *time.Time
+	first_collected_at                  *time.Time
+	resource_id                         *string
+	name                                *string
+	s3_bucket_name                      *string
+	s3_key_prefix                       *string
+	sns_topic_arn                       *string
+	is_multi_region_trail               *bool
+	is_organization_trail               *bool
+	include_global_service_events       *bool
+	log_file_validation_enabled         *bool
+	kms_key_id                          *string
+	cloud_watch_logs_log_group_arn      *string
+	cloud_watch_logs_role_arn           *string
+	has_custom_event_selectors          *bool
+	has_insight_selectors               *bool
+	is_logging                          *bool
+	event_selectors_json                *json.RawMessage
+	appendevent_selectors_json          json.RawMessage
+	advanced_event_selectors_json       *json.RawMessage
+	appendadvanced_event_selectors_json json.RawMessage
+	tags_json                           *json.RawMessage
+	appendtags_json                     json.RawMessage
+	account_id                          *string
+	region                              *string
+	clearedFields                       map[string]struct{}
+	done                                bool
+	oldValue                            func(context.Context) (*BronzeHistoryAWSCloudTrailTrail, error)
+	predicates                          []predicate.BronzeHistoryAWSCloudTrailTrail
+}
+
+var _ ent.Mutation = (*BronzeHistoryAWSCloudTrailTrailMutation)(nil)
+
+// bronzehistoryawscloudtrailtrailOption allows management of the mutation configuration using functional options.
+type bronzehistoryawscloudtrailtrailOption func(*BronzeHistoryAWSCloudTrailTrailMutation)
+
+// newBronzeHistoryAWSCloudTrailTrailMutation creates new mutation for the BronzeHistoryAWSCloudTrailTrail entity.
+func newBronzeHistoryAWSCloudTrailTrailMutation(c config, op Op, opts ...bronzehistoryawscloudtrailtrailOption) *BronzeHistoryAWSCloudTrailTrailMutation {
+	m := &BronzeHistoryAWSCloudTrailTrailMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeHistoryAWSCloudTrailTrail,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeHistoryAWSCloudTrailTrailID sets the ID field of the mutation.
+func withBronzeHistoryAWSCloudTrailTrailID(id uint) bronzehistoryawscloudtrailtrailOption {
+	return func(m *BronzeHistoryAWSCloudTrailTrailMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeHistoryAWSCloudTrailTrail
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeHistoryAWSCloudTrailTrail, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeHistoryAWSCloudTrailTrail.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeHistoryAWSCloudTrailTrail sets the old BronzeHistoryAWSCloudTrailTrail of the mutation.
+func withBronzeHistoryAWSCloudTrailTrail(node *BronzeHistoryAWSCloudTrailTrail) bronzehistoryawscloudtrailtrailOption {
+	return func(m *BronzeHistoryAWSCloudTrailTrailMutation) {
+		m.oldValue = func(context.Context) (*BronzeHistoryAWSCloudTrailTrail, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeHistoryAWSCloudTrailTrailMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeHistoryAWSCloudTrailTrailMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("cloudtrail: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeHistoryAWSCloudTrailTrail entities.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeHistoryAWSCloudTrailTrail.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidTo sets the "valid_to" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetValidTo(t time.Time) {
+	m.valid_to = &t
+}
+
+// ValidTo returns the value of the "valid_to" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ValidTo() (r time.Time, exists bool) {
+	v := m.valid_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidTo returns the old "valid_to" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldValidTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidTo: %w", err)
+	}
+	return oldValue.ValidTo, nil
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearValidTo() {
+	m.valid_to = nil
+	m.clearedFields[bronzehistoryawscloudtrailtrail.FieldValidTo] = struct{}{}
+}
+
+// ValidToCleared returns if the "valid_to" field was cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ValidToCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawscloudtrailtrail.FieldValidTo]
+	return ok
+}
+
+// ResetValidTo resets all changes to the "valid_to" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetValidTo() {
+	m.valid_to = nil
+	delete(m.clearedFields, bronzehistoryawscloudtrailtrail.FieldValidTo)
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[bronzehistoryawscloudtrailtrail.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) NameCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawscloudtrailtrail.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, bronzehistoryawscloudtrailtrail.FieldName)
+}
+
+// SetS3BucketName sets the "s3_bucket_name" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetS3BucketName(s string) {
+	m.s3_bucket_name = &s
+}
+
+// S3BucketName returns the value of the "s3_bucket_name" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) S3BucketName() (r string, exists bool) {
+	v := m.s3_bucket_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldS3BucketName returns the old "s3_bucket_name" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldS3BucketName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldS3BucketName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldS3BucketName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldS3BucketName: %w", err)
+	}
+	return oldValue.S3BucketName, nil
+}
+
+// ClearS3BucketName clears the value of the "s3_bucket_name" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearS3BucketName() {
+	m.s3_bucket_name = nil
+	m.clearedFields[bronzehistoryawscloudtrailtrail.FieldS3BucketName] = struct{}{}
+}
+
+// S3BucketNameCleared returns if the "s3_bucket_name" field was cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) S3BucketNameCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawscloudtrailtrail.FieldS3BucketName]
+	return ok
+}
+
+// ResetS3BucketName resets all changes to the "s3_bucket_name" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetS3BucketName() {
+	m.s3_bucket_name = nil
+	delete(m.clearedFields, bronzehistoryawscloudtrailtrail.FieldS3BucketName)
+}
+
+// SetS3KeyPrefix sets the "s3_key_prefix" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetS3KeyPrefix(s string) {
+	m.s3_key_prefix = &s
+}
+
+// S3KeyPrefix returns the value of the "s3_key_prefix" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) S3KeyPrefix() (r string, exists bool) {
+	v := m.s3_key_prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldS3KeyPrefix returns the old "s3_key_prefix" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldS3KeyPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldS3KeyPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldS3KeyPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldS3KeyPrefix: %w", err)
+	}
+	return oldValue.S3KeyPrefix, nil
+}
+
+// ClearS3KeyPrefix clears the value of the "s3_key_prefix" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearS3KeyPrefix() {
+	m.s3_key_prefix = nil
+	m.clearedFields[bronzehistoryawscloudtrailtrail.FieldS3KeyPrefix] = struct{}{}
+}
+
+// S3KeyPrefixCleared returns if the "s3_key_prefix" field was cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) S3KeyPrefixCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawscloudtrailtrail.FieldS3KeyPrefix]
+	return ok
+}
+
+// ResetS3KeyPrefix resets all changes to the "s3_key_prefix" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetS3KeyPrefix() {
+	m.s3_key_prefix = nil
+	delete(m.clearedFields, bronzehistoryawscloudtrailtrail.FieldS3KeyPrefix)
+}
+
+// SetSnsTopicArn sets the "sns_topic_arn" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetSnsTopicArn(s string) {
+	m.sns_topic_arn = &s
+}
+
+// SnsTopicArn returns the value of the "sns_topic_arn" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SnsTopicArn() (r string, exists bool) {
+	v := m.sns_topic_arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSnsTopicArn returns the old "sns_topic_arn" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldSnsTopicArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSnsTopicArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSnsTopicArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSnsTopicArn: %w", err)
+	}
+	return oldValue.SnsTopicArn, nil
+}
+
+// ClearSnsTopicArn clears the value of the "sns_topic_arn" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearSnsTopicArn() {
+	m.sns_topic_arn = nil
+	m.clearedFields[bronzehistoryawscloudtrailtrail.FieldSnsTopicArn] = struct{}{}
+}
+
+// SnsTopicArnCleared returns if the "sns_topic_arn" field was cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SnsTopicArnCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawscloudtrailtrail.FieldSnsTopicArn]
+	return ok
+}
+
+// ResetSnsTopicArn resets all changes to the "sns_topic_arn" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetSnsTopicArn() {
+	m.sns_topic_arn = nil
+	delete(m.clearedFields, bronzehistoryawscloudtrailtrail.FieldSnsTopicArn)
+}
+
+// SetIsMultiRegionTrail sets the "is_multi_region_trail" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetIsMultiRegionTrail(b bool) {
+	m.is_multi_region_trail = &b
+}
+
+// IsMultiRegionTrail returns the value of the "is_multi_region_trail" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) IsMultiRegionTrail() (r bool, exists bool) {
+	v := m.is_multi_region_trail
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsMultiRegionTrail returns the old "is_multi_region_trail" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldIsMultiRegionTrail(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsMultiRegionTrail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsMultiRegionTrail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsMultiRegionTrail: %w", err)
+	}
+	return oldValue.IsMultiRegionTrail, nil
+}
+
+// ClearIsMultiRegionTrail clears the value of the "is_multi_region_trail" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearIsMultiRegionTrail() {
+	m.is_multi_region_trail = nil
+	m.clearedFields[bronzehistoryawscloudtrailtrail.FieldIsMultiRegionTrail] = struct{}{}
+}
+
+// IsMultiRegionTrailCleared returns if the "is_multi_region_trail" field was cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) IsMultiRegionTrailCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawscloudtrailtrail.FieldIsMultiRegionTrail]
+	return ok
+}
+
+// ResetIsMultiRegionTrail resets all changes to the "is_multi_region_trail" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetIsMultiRegionTrail() {
+	m.is_multi_region_trail = nil
+	delete(m.clearedFields, bronzehistoryawscloudtrailtrail.FieldIsMultiRegionTrail)
+}
+
+// SetIsOrganizationTrail sets the "is_organization_trail" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetIsOrganizationTrail(b bool) {
+	m.is_organization_trail = &b
+}
+
+// IsOrganizationTrail returns the value of the "is_organization_trail" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) IsOrganizationTrail() (r bool, exists bool) {
+	v := m.is_organization_trail
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsOrganizationTrail returns the old "is_organization_trail" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldIsOrganizationTrail(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsOrganizationTrail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsOrganizationTrail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsOrganizationTrail: %w", err)
+	}
+	return oldValue.IsOrganizationTrail, nil
+}
+
+// ClearIsOrganizationTrail clears the value of the "is_organization_trail" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearIsOrganizationTrail() {
+	m.is_organization_trail = nil
+	m.clearedFields[bronzehistoryawscloudtrailtrail.FieldIsOrganizationTrail] = struct{}{}
+}
+
+// IsOrganizationTrailCleared returns if the "is_organization_trail" field was cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) IsOrganizationTrailCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawscloudtrailtrail.FieldIsOrganizationTrail]
+	return ok
+}
+
+// ResetIsOrganizationTrail resets all changes to the "is_organization_trail" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetIsOrganizationTrail() {
+	m.is_organization_trail = nil
+	delete(m.clearedFields, bronzehistoryawscloudtrailtrail.FieldIsOrganizationTrail)
+}
+
+// SetIncludeGlobalServiceEvents sets the "include_global_service_events" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetIncludeGlobalServiceEvents(b bool) {
+	m.include_global_service_events = &b
+}
+
+// IncludeGlobalServiceEvents returns the value of the "include_global_service_events" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) IncludeGlobalServiceEvents() (r bool, exists bool) {
+	v := m.include_global_service_events
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIncludeGlobalServiceEvents returns the old "include_global_service_events" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldIncludeGlobalServiceEvents(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIncludeGlobalServiceEvents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIncludeGlobalServiceEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIncludeGlobalServiceEvents: %w", err)
+	}
+	return oldValue.IncludeGlobalServiceEvents, nil
+}
+
+// ClearIncludeGlobalServiceEvents clears the value of the "include_global_service_events" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearIncludeGlobalServiceEvents() {
+	m.include_global_service_events = nil
+	m.clearedFields[bronzehistoryawscloudtrailtrail.FieldIncludeGlobalServiceEvents] = struct{}{}
+}
+
+// IncludeGlobalServiceEventsCleared returns if the "include_global_service_events" field was cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) IncludeGlobalServiceEventsCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawscloudtrailtrail.FieldIncludeGlobalServiceEvents]
+	return ok
+}
+
+// ResetIncludeGlobalServiceEvents resets all changes to the "include_global_service_events" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetIncludeGlobalServiceEvents() {
+	m.include_global_service_events = nil
+	delete(m.clearedFields, bronzehistoryawscloudtrailtrail.FieldIncludeGlobalServiceEvents)
+}
+
+// SetLogFileValidationEnabled sets the "log_file_validation_enabled" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetLogFileValidationEnabled(b bool) {
+	m.log_file_validation_enabled = &b
+}
+
+// LogFileValidationEnabled returns the value of the "log_file_validation_enabled" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) LogFileValidationEnabled() (r bool, exists bool) {
+	v := m.log_file_validation_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogFileValidationEnabled returns the old "log_file_validation_enabled" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldLogFileValidationEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogFileValidationEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogFileValidationEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogFileValidationEnabled: %w", err)
+	}
+	return oldValue.LogFileValidationEnabled, nil
+}
+
+// ClearLogFileValidationEnabled clears the value of the "log_file_validation_enabled" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearLogFileValidationEnabled() {
+	m.log_file_validation_enabled = nil
+	m.clearedFields[bronzehistoryawscloudtrailtrail.FieldLogFileValidationEnabled] = struct{}{}
+}
+
+// LogFileValidationEnabledCleared returns if the "log_file_validation_enabled" field was cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) LogFileValidationEnabledCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawscloudtrailtrail.FieldLogFileValidationEnabled]
+	return ok
+}
+
+// ResetLogFileValidationEnabled resets all changes to the "log_file_validation_enabled" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetLogFileValidationEnabled() {
+	m.log_file_validation_enabled = nil
+	delete(m.clearedFields, bronzehistoryawscloudtrailtrail.FieldLogFileValidationEnabled)
+}
+
+// SetKmsKeyID sets the "kms_key_id" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetKmsKeyID(s string) {
+	m.kms_key_id = &s
+}
+
+// KmsKeyID returns the value of the "kms_key_id" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) KmsKeyID() (r string, exists bool) {
+	v := m.kms_key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKmsKeyID returns the old "kms_key_id" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldKmsKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKmsKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKmsKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKmsKeyID: %w", err)
+	}
+	return oldValue.KmsKeyID, nil
+}
+
+// ClearKmsKeyID clears the value of the "kms_key_id" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearKmsKeyID() {
+	m.kms_key_id = nil
+	m.clearedFields[bronzehistoryawscloudtrailtrail.FieldKmsKeyID] = struct{}{}
+}
+
+// KmsKeyIDCleared returns if the "kms_key_id" field was cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) KmsKeyIDCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawscloudtrailtrail.FieldKmsKeyID]
+	return ok
+}
+
+// ResetKmsKeyID resets all changes to the "kms_key_id" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetKmsKeyID() {
+	m.kms_key_id = nil
+	delete(m.clearedFields, bronzehistoryawscloudtrailtrail.FieldKmsKeyID)
+}
+
+// SetCloudWatchLogsLogGroupArn sets the "cloud_watch_logs_log_group_arn" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetCloudWatchLogsLogGroupArn(s string) {
+	m.cloud_watch_logs_log_group_arn = &s
+}
+
+// CloudWatchLogsLogGroupArn returns the value of the "cloud_watch_logs_log_group_arn" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) CloudWatchLogsLogGroupArn() (r string, exists bool) {
+	v := m.cloud_watch_logs_log_group_arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCloudWatchLogsLogGroupArn returns the old "cloud_watch_logs_log_group_arn" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldCloudWatchLogsLogGroupArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCloudWatchLogsLogGroupArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCloudWatchLogsLogGroupArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCloudWatchLogsLogGroupArn: %w", err)
+	}
+	return oldValue.CloudWatchLogsLogGroupArn, nil
+}
+
+// ClearCloudWatchLogsLogGroupArn clears the value of the "cloud_watch_logs_log_group_arn" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearCloudWatchLogsLogGroupArn() {
+	m.cloud_watch_logs_log_group_arn = nil
+	m.clearedFields[bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn] = struct{}{}
+}
+
+// CloudWatchLogsLogGroupArnCleared returns if the "cloud_watch_logs_log_group_arn" field was cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) CloudWatchLogsLogGroupArnCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn]
+	return ok
+}
+
+// ResetCloudWatchLogsLogGroupArn resets all changes to the "cloud_watch_logs_log_group_arn" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetCloudWatchLogsLogGroupArn() {
+	m.cloud_watch_logs_log_group_arn = nil
+	delete(m.clearedFields, bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn)
+}
+
+// SetCloudWatchLogsRoleArn sets the "cloud_watch_logs_role_arn" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetCloudWatchLogsRoleArn(s string) {
+	m.cloud_watch_logs_role_arn = &s
+}
+
+// CloudWatchLogsRoleArn returns the value of the "cloud_watch_logs_role_arn" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) CloudWatchLogsRoleArn() (r string, exists bool) {
+	v := m.cloud_watch_logs_role_arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCloudWatchLogsRoleArn returns the old "cloud_watch_logs_role_arn" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldCloudWatchLogsRoleArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCloudWatchLogsRoleArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCloudWatchLogsRoleArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCloudWatchLogsRoleArn: %w", err)
+	}
+	return oldValue.CloudWatchLogsRoleArn, nil
+}
+
+// ClearCloudWatchLogsRoleArn clears the value of the "cloud_watch_logs_role_arn" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearCloudWatchLogsRoleArn() {
+	m.cloud_watch_logs_role_arn = nil
+	m.clearedFields[bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsRoleArn] = struct{}{}
+}
+
+// CloudWatchLogsRoleArnCleared returns if the "cloud_watch_logs_role_arn" field was cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) CloudWatchLogsRoleArnCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsRoleArn]
+	return ok
+}
+
+// ResetCloudWatchLogsRoleArn resets all changes to the "cloud_watch_logs_role_arn" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetCloudWatchLogsRoleArn() {
+	m.cloud_watch_logs_role_arn = nil
+	delete(m.clearedFields, bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsRoleArn)
+}
+
+// SetHasCustomEventSelectors sets the "has_custom_event_selectors" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetHasCustomEventSelectors(b bool) {
+	m.has_custom_event_selectors = &b
+}
+
+// HasCustomEventSelectors returns the value of the "has_custom_event_selectors" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) HasCustomEventSelectors() (r bool, exists bool) {
+	v := m.has_custom_event_selectors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHasCustomEventSelectors returns the old "has_custom_event_selectors" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldHasCustomEventSelectors(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHasCustomEventSelectors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHasCustomEventSelectors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHasCustomEventSelectors: %w", err)
+	}
+	return oldValue.HasCustomEventSelectors, nil
+}
+
+// ClearHasCustomEventSelectors clears the value of the "has_custom_event_selectors" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearHasCustomEventSelectors() {
+	m.has_custom_event_selectors = nil
+	m.clearedFields[bronzehistoryawscloudtrailtrail.FieldHasCustomEventSelectors] = struct{}{}
+}
+
+// HasCustomEventSelectorsCleared returns if the "has_custom_event_selectors" field was cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) HasCustomEventSelectorsCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawscloudtrailtrail.FieldHasCustomEventSelectors]
+	return ok
+}
+
+// ResetHasCustomEventSelectors resets all changes to the "has_custom_event_selectors" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetHasCustomEventSelectors() {
+	m.has_custom_event_selectors = nil
+	delete(m.clearedFields, bronzehistoryawscloudtrailtrail.FieldHasCustomEventSelectors)
+}
+
+// SetHasInsightSelectors sets the "has_insight_selectors" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetHasInsightSelectors(b bool) {
+	m.has_insight_selectors = &b
+}
+
+// HasInsightSelectors returns the value of the "has_insight_selectors" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) HasInsightSelectors() (r bool, exists bool) {
+	v := m.has_insight_selectors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHasInsightSelectors returns the old "has_insight_selectors" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldHasInsightSelectors(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHasInsightSelectors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHasInsightSelectors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHasInsightSelectors: %w", err)
+	}
+	return oldValue.HasInsightSelectors, nil
+}
+
+// ClearHasInsightSelectors clears the value of the "has_insight_selectors" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearHasInsightSelectors() {
+	m.has_insight_selectors = nil
+	m.clearedFields[bronzehistoryawscloudtrailtrail.FieldHasInsightSelectors] = struct{}{}
+}
+
+// HasInsightSelectorsCleared returns if the "has_insight_selectors" field was cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) HasInsightSelectorsCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawscloudtrailtrail.FieldHasInsightSelectors]
+	return ok
+}
+
+// ResetHasInsightSelectors resets all changes to the "has_insight_selectors" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetHasInsightSelectors() {
+	m.has_insight_selectors = nil
+	delete(m.clearedFields, bronzehistoryawscloudtrailtrail.FieldHasInsightSelectors)
+}
+
+// SetIsLogging sets the "is_logging" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetIsLogging(b bool) {
+	m.is_logging = &b
+}
+
+// IsLogging returns the value of the "is_logging" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) IsLogging() (r bool, exists bool) {
+	v := m.is_logging
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsLogging returns the old "is_logging" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldIsLogging(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsLogging is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsLogging requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsLogging: %w", err)
+	}
+	return oldValue.IsLogging, nil
+}
+
+// ClearIsLogging clears the value of the "is_logging" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearIsLogging() {
+	m.is_logging = nil
+	m.clearedFields[bronzehistoryawscloudtrailtrail.FieldIsLogging] = struct{}{}
+}
+
+// IsLoggingCleared returns if the "is_logging" field was cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) IsLoggingCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawscloudtrailtrail.FieldIsLogging]
+	return ok
+}
+
+// ResetIsLogging resets all changes to the "is_logging" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetIsLogging() {
+	m.is_logging = nil
+	delete(m.clearedFields, bronzehistoryawscloudtrailtrail.FieldIsLogging)
+}
+
+// SetEventSelectorsJSON sets the "event_selectors_json" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetEventSelectorsJSON(jm json.RawMessage) {
+	m.event_selectors_json = &jm
+	m.appendevent_selectors_json = nil
+}
+
+// EventSelectorsJSON returns the value of the "event_selectors_json" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) EventSelectorsJSON() (r json.RawMessage, exists bool) {
+	v := m.event_selectors_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventSelectorsJSON returns the old "event_selectors_json" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldEventSelectorsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventSelectorsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventSelectorsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventSelectorsJSON: %w", err)
+	}
+	return oldValue.EventSelectorsJSON, nil
+}
+
+// AppendEventSelectorsJSON adds jm to the "event_selectors_json" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) AppendEventSelectorsJSON(jm json.RawMessage) {
+	m.appendevent_selectors_json = append(m.appendevent_selectors_json, jm...)
+}
+
+// AppendedEventSelectorsJSON returns the list of values that were appended to the "event_selectors_json" field in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) AppendedEventSelectorsJSON() (json.RawMessage, bool) {
+	if len(m.appendevent_selectors_json) == 0 {
+		return nil, false
+	}
+	return m.appendevent_selectors_json, true
+}
+
+// ClearEventSelectorsJSON clears the value of the "event_selectors_json" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearEventSelectorsJSON() {
+	m.event_selectors_json = nil
+	m.appendevent_selectors_json = nil
+	m.clearedFields[bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON] = struct{}{}
+}
+
+// EventSelectorsJSONCleared returns if the "event_selectors_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) EventSelectorsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON]
+	return ok
+}
+
+// ResetEventSelectorsJSON resets all changes to the "event_selectors_json" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetEventSelectorsJSON() {
+	m.event_selectors_json = nil
+	m.appendevent_selectors_json = nil
+	delete(m.clearedFields, bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON)
+}
+
+// SetAdvancedEventSelectorsJSON sets the "advanced_event_selectors_json" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetAdvancedEventSelectorsJSON(jm json.RawMessage) {
+	m.advanced_event_selectors_json = &jm
+	m.appendadvanced_event_selectors_json = nil
+}
+
+// AdvancedEventSelectorsJSON returns the value of the "advanced_event_selectors_json" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) AdvancedEventSelectorsJSON() (r json.RawMessage, exists bool) {
+	v := m.advanced_event_selectors_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdvancedEventSelectorsJSON returns the old "advanced_event_selectors_json" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldAdvancedEventSelectorsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdvancedEventSelectorsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdvancedEventSelectorsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdvancedEventSelectorsJSON: %w", err)
+	}
+	return oldValue.AdvancedEventSelectorsJSON, nil
+}
+
+// AppendAdvancedEventSelectorsJSON adds jm to the "advanced_event_selectors_json" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) AppendAdvancedEventSelectorsJSON(jm json.RawMessage) {
+	m.appendadvanced_event_selectors_json = append(m.appendadvanced_event_selectors_json, jm...)
+}
+
+// AppendedAdvancedEventSelectorsJSON returns the list of values that were appended to the "advanced_event_selectors_json" field in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) AppendedAdvancedEventSelectorsJSON() (json.RawMessage, bool) {
+	if len(m.appendadvanced_event_selectors_json) == 0 {
+		return nil, false
+	}
+	return m.appendadvanced_event_selectors_json, true
+}
+
+// ClearAdvancedEventSelectorsJSON clears the value of the "advanced_event_selectors_json" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearAdvancedEventSelectorsJSON() {
+	m.advanced_event_selectors_json = nil
+	m.appendadvanced_event_selectors_json = nil
+	m.clearedFields[bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON] = struct{}{}
+}
+
+// AdvancedEventSelectorsJSONCleared returns if the "advanced_event_selectors_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) AdvancedEventSelectorsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON]
+	return ok
+}
+
+// ResetAdvancedEventSelectorsJSON resets all changes to the "advanced_event_selectors_json" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetAdvancedEventSelectorsJSON() {
+	m.advanced_event_selectors_json = nil
+	m.appendadvanced_event_selectors_json = nil
+	delete(m.clearedFields, bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON)
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetTagsJSON(jm json.RawMessage) {
+	m.tags_json = &jm
+	m.appendtags_json = nil
+}
+
+// TagsJSON returns the value of the "tags_json" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) TagsJSON() (r json.RawMessage, exists bool) {
+	v := m.tags_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagsJSON returns the old "tags_json" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldTagsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagsJSON: %w", err)
+	}
+	return oldValue.TagsJSON, nil
+}
+
+// AppendTagsJSON adds jm to the "tags_json" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) AppendTagsJSON(jm json.RawMessage) {
+	m.appendtags_json = append(m.appendtags_json, jm...)
+}
+
+// AppendedTagsJSON returns the list of values that were appended to the "tags_json" field in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) AppendedTagsJSON() (json.RawMessage, bool) {
+	if len(m.appendtags_json) == 0 {
+		return nil, false
+	}
+	return m.appendtags_json, true
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	m.clearedFields[bronzehistoryawscloudtrailtrail.FieldTagsJSON] = struct{}{}
+}
+
+// TagsJSONCleared returns if the "tags_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) TagsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawscloudtrailtrail.FieldTagsJSON]
+	return ok
+}
+
+// ResetTagsJSON resets all changes to the "tags_json" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	delete(m.clearedFields, bronzehistoryawscloudtrailtrail.FieldTagsJSON)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetAccountID(s string) {
+	m.account_id = &s
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) AccountID() (r string, exists bool) {
+	v := m.account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetAccountID() {
+	m.account_id = nil
+}
+
+// SetRegion sets the "region" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetRegion(s string) {
+	m.region = &s
+}
+
+// Region returns the value of the "region" field in the mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) Region() (r string, exists bool) {
+	v := m.region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegion returns the old "region" field's value of the BronzeHistoryAWSCloudTrailTrail entity.
+// If the BronzeHistoryAWSCloudTrailTrail object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
+	}
+	return oldValue.Region, nil
+}
+
+// ResetRegion resets all changes to the "region" field.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetRegion() {
+	m.region = nil
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSCloudTrailTrailMutation builder.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) Where(ps ...predicate.BronzeHistoryAWSCloudTrailTrail) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeHistoryAWSCloudTrailTrailMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeHistoryAWSCloudTrailTrail, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeHistoryAWSCloudTrailTrail).
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) Fields() []string {
+	fields := make([]string, 0, 24)
+	if m.valid_from != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldValidFrom)
+	}
+	if m.valid_to != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldValidTo)
+	}
+	if m.collected_at != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldFirstCollectedAt)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldResourceID)
+	}
+	if m.name != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldName)
+	}
+	if m.s3_bucket_name != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldS3BucketName)
+	}
+	if m.s3_key_prefix != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldS3KeyPrefix)
+	}
+	if m.sns_topic_arn != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldSnsTopicArn)
+	}
+	if m.is_multi_region_trail != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldIsMultiRegionTrail)
+	}
+	if m.is_organization_trail != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldIsOrganizationTrail)
+	}
+	if m.include_global_service_events != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldIncludeGlobalServiceEvents)
+	}
+	if m.log_file_validation_enabled != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldLogFileValidationEnabled)
+	}
+	if m.kms_key_id != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldKmsKeyID)
+	}
+	if m.cloud_watch_logs_log_group_arn != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn)
+	}
+	if m.cloud_watch_logs_role_arn != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsRoleArn)
+	}
+	if m.has_custom_event_selectors != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldHasCustomEventSelectors)
+	}
+	if m.has_insight_selectors != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldHasInsightSelectors)
+	}
+	if m.is_logging != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldIsLogging)
+	}
+	if m.event_selectors_json != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON)
+	}
+	if m.advanced_event_selectors_json != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON)
+	}
+	if m.tags_json != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldTagsJSON)
+	}
+	if m.account_id != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldAccountID)
+	}
+	if m.region != nil {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldRegion)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryawscloudtrailtrail.FieldValidFrom:
+		return m.ValidFrom()
+	case bronzehistoryawscloudtrailtrail.FieldValidTo:
+		return m.ValidTo()
+	case bronzehistoryawscloudtrailtrail.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzehistoryawscloudtrailtrail.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzehistoryawscloudtrailtrail.FieldResourceID:
+		return m.ResourceID()
+	case bronzehistoryawscloudtrailtrail.FieldName:
+		return m.Name()
+	case bronzehistoryawscloudtrailtrail.FieldS3BucketName:
+		return m.S3BucketName()
+	case bronzehistoryawscloudtrailtrail.FieldS3KeyPrefix:
+		return m.S3KeyPrefix()
+	case bronzehistoryawscloudtrailtrail.FieldSnsTopicArn:
+		return m.SnsTopicArn()
+	case bronzehistoryawscloudtrailtrail.FieldIsMultiRegionTrail:
+		return m.IsMultiRegionTrail()
+	case bronzehistoryawscloudtrailtrail.FieldIsOrganizationTrail:
+		return m.IsOrganizationTrail()
+	case bronzehistoryawscloudtrailtrail.FieldIncludeGlobalServiceEvents:
+		return m.IncludeGlobalServiceEvents()
+	case bronzehistoryawscloudtrailtrail.FieldLogFileValidationEnabled:
+		return m.LogFileValidationEnabled()
+	case bronzehistoryawscloudtrailtrail.FieldKmsKeyID:
+		return m.KmsKeyID()
+	case bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn:
+		return m.CloudWatchLogsLogGroupArn()
+	case bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsRoleArn:
+		return m.CloudWatchLogsRoleArn()
+	case bronzehistoryawscloudtrailtrail.FieldHasCustomEventSelectors:
+		return m.HasCustomEventSelectors()
+	case bronzehistoryawscloudtrailtrail.FieldHasInsightSelectors:
+		return m.HasInsightSelectors()
+	case bronzehistoryawscloudtrailtrail.FieldIsLogging:
+		return m.IsLogging()
+	case bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON:
+		return m.EventSelectorsJSON()
+	case bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON:
+		return m.AdvancedEventSelectorsJSON()
+	case bronzehistoryawscloudtrailtrail.FieldTagsJSON:
+		return m.TagsJSON()
+	case bronzehistoryawscloudtrailtrail.FieldAccountID:
+		return m.AccountID()
+	case bronzehistoryawscloudtrailtrail.FieldRegion:
+		return m.Region()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzehistoryawscloudtrailtrail.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldValidTo:
+		return m.OldValidTo(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldName:
+		return m.OldName(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldS3BucketName:
+		return m.OldS3BucketName(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldS3KeyPrefix:
+		return m.OldS3KeyPrefix(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldSnsTopicArn:
+		return m.OldSnsTopicArn(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldIsMultiRegionTrail:
+		return m.OldIsMultiRegionTrail(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldIsOrganizationTrail:
+		return m.OldIsOrganizationTrail(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldIncludeGlobalServiceEvents:
+		return m.OldIncludeGlobalServiceEvents(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldLogFileValidationEnabled:
+		return m.OldLogFileValidationEnabled(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldKmsKeyID:
+		return m.OldKmsKeyID(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn:
+		return m.OldCloudWatchLogsLogGroupArn(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsRoleArn:
+		return m.OldCloudWatchLogsRoleArn(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldHasCustomEventSelectors:
+		return m.OldHasCustomEventSelectors(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldHasInsightSelectors:
+		return m.OldHasInsightSelectors(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldIsLogging:
+		return m.OldIsLogging(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON:
+		return m.OldEventSelectorsJSON(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON:
+		return m.OldAdvancedEventSelectorsJSON(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldTagsJSON:
+		return m.OldTagsJSON(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case bronzehistoryawscloudtrailtrail.FieldRegion:
+		return m.OldRegion(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeHistoryAWSCloudTrailTrail field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryawscloudtrailtrail.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldValidTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidTo(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldS3BucketName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetS3BucketName(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldS3KeyPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetS3KeyPrefix(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldSnsTopicArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSnsTopicArn(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldIsMultiRegionTrail:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsMultiRegionTrail(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldIsOrganizationTrail:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsOrganizationTrail(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldIncludeGlobalServiceEvents:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIncludeGlobalServiceEvents(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldLogFileValidationEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogFileValidationEnabled(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldKmsKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKmsKeyID(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCloudWatchLogsLogGroupArn(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsRoleArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCloudWatchLogsRoleArn(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldHasCustomEventSelectors:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHasCustomEventSelectors(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldHasInsightSelectors:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHasInsightSelectors(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldIsLogging:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsLogging(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventSelectorsJSON(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdvancedEventSelectorsJSON(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldTagsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagsJSON(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldAccountID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSCloudTrailTrail field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSCloudTrailTrail numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzehistoryawscloudtrailtrail.FieldValidTo) {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldValidTo)
+	}
+	if m.FieldCleared(bronzehistoryawscloudtrailtrail.FieldName) {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldName)
+	}
+	if m.FieldCleared(bronzehistoryawscloudtrailtrail.FieldS3BucketName) {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldS3BucketName)
+	}
+	if m.FieldCleared(bronzehistoryawscloudtrailtrail.FieldS3KeyPrefix) {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldS3KeyPrefix)
+	}
+	if m.FieldCleared(bronzehistoryawscloudtrailtrail.FieldSnsTopicArn) {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldSnsTopicArn)
+	}
+	if m.FieldCleared(bronzehistoryawscloudtrailtrail.FieldIsMultiRegionTrail) {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldIsMultiRegionTrail)
+	}
+	if m.FieldCleared(bronzehistoryawscloudtrailtrail.FieldIsOrganizationTrail) {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldIsOrganizationTrail)
+	}
+	if m.FieldCleared(bronzehistoryawscloudtrailtrail.FieldIncludeGlobalServiceEvents) {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldIncludeGlobalServiceEvents)
+	}
+	if m.FieldCleared(bronzehistoryawscloudtrailtrail.FieldLogFileValidationEnabled) {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldLogFileValidationEnabled)
+	}
+	if m.FieldCleared(bronzehistoryawscloudtrailtrail.FieldKmsKeyID) {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldKmsKeyID)
+	}
+	if m.FieldCleared(bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn) {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn)
+	}
+	if m.FieldCleared(bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsRoleArn) {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsRoleArn)
+	}
+	if m.FieldCleared(bronzehistoryawscloudtrailtrail.FieldHasCustomEventSelectors) {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldHasCustomEventSelectors)
+	}
+	if m.FieldCleared(bronzehistoryawscloudtrailtrail.FieldHasInsightSelectors) {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldHasInsightSelectors)
+	}
+	if m.FieldCleared(bronzehistoryawscloudtrailtrail.FieldIsLogging) {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldIsLogging)
+	}
+	if m.FieldCleared(bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON) {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON)
+	}
+	if m.FieldCleared(bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON) {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON)
+	}
+	if m.FieldCleared(bronzehistoryawscloudtrailtrail.FieldTagsJSON) {
+		fields = append(fields, bronzehistoryawscloudtrailtrail.FieldTagsJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearField(name string) error {
+	switch name {
+	case bronzehistoryawscloudtrailtrail.FieldValidTo:
+		m.ClearValidTo()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldName:
+		m.ClearName()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldS3BucketName:
+		m.ClearS3BucketName()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldS3KeyPrefix:
+		m.ClearS3KeyPrefix()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldSnsTopicArn:
+		m.ClearSnsTopicArn()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldIsMultiRegionTrail:
+		m.ClearIsMultiRegionTrail()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldIsOrganizationTrail:
+		m.ClearIsOrganizationTrail()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldIncludeGlobalServiceEvents:
+		m.ClearIncludeGlobalServiceEvents()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldLogFileValidationEnabled:
+		m.ClearLogFileValidationEnabled()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldKmsKeyID:
+		m.ClearKmsKeyID()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn:
+		m.ClearCloudWatchLogsLogGroupArn()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsRoleArn:
+		m.ClearCloudWatchLogsRoleArn()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldHasCustomEventSelectors:
+		m.ClearHasCustomEventSelectors()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldHasInsightSelectors:
+		m.ClearHasInsightSelectors()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldIsLogging:
+		m.ClearIsLogging()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON:
+		m.ClearEventSelectorsJSON()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON:
+		m.ClearAdvancedEventSelectorsJSON()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldTagsJSON:
+		m.ClearTagsJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSCloudTrailTrail nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetField(name string) error {
+	switch name {
+	case bronzehistoryawscloudtrailtrail.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldValidTo:
+		m.ResetValidTo()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldName:
+		m.ResetName()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldS3BucketName:
+		m.ResetS3BucketName()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldS3KeyPrefix:
+		m.ResetS3KeyPrefix()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldSnsTopicArn:
+		m.ResetSnsTopicArn()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldIsMultiRegionTrail:
+		m.ResetIsMultiRegionTrail()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldIsOrganizationTrail:
+		m.ResetIsOrganizationTrail()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldIncludeGlobalServiceEvents:
+		m.ResetIncludeGlobalServiceEvents()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldLogFileValidationEnabled:
+		m.ResetLogFileValidationEnabled()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldKmsKeyID:
+		m.ResetKmsKeyID()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsLogGroupArn:
+		m.ResetCloudWatchLogsLogGroupArn()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldCloudWatchLogsRoleArn:
+		m.ResetCloudWatchLogsRoleArn()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldHasCustomEventSelectors:
+		m.ResetHasCustomEventSelectors()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldHasInsightSelectors:
+		m.ResetHasInsightSelectors()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldIsLogging:
+		m.ResetIsLogging()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldEventSelectorsJSON:
+		m.ResetEventSelectorsJSON()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldAdvancedEventSelectorsJSON:
+		m.ResetAdvancedEventSelectorsJSON()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldTagsJSON:
+		m.ResetTagsJSON()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case bronzehistoryawscloudtrailtrail.FieldRegion:
+		m.ResetRegion()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSCloudTrailTrail field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryAWSCloudTrailTrail unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeHistoryAWSCloudTrailTrailMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryAWSCloudTrailTrail edge %s", name)
+}
@@ -0,0 +1,13 @@
+// Code generated by ent, DO NOT EDIT.
+
+package predicate
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeAWSCloudTrailTrail is the predicate function for bronzeawscloudtrailtrail builders.
+type BronzeAWSCloudTrailTrail func(*sql.Selector)
+
+// BronzeHistoryAWSCloudTrailTrail is the predicate function for bronzehistoryawscloudtrailtrail builders.
+type BronzeHistoryAWSCloudTrailTrail func(*sql.Selector)
@@ -0,0 +1,39 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cloudtrail
+
+import (
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/bronzeawscloudtrailtrail"
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/bronzehistoryawscloudtrailtrail"
+	"danny.vn/hotpot/pkg/storage/ent/aws/cloudtrail/schema"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	bronzeawscloudtrailtrailFields := schema.BronzeAWSCloudTrailTrail{}.Fields()
+	_ = bronzeawscloudtrailtrailFields
+	// bronzeawscloudtrailtrailDescAccountID is the schema descriptor for account_id field.
+	bronzeawscloudtrailtrailDescAccountID := bronzeawscloudtrailtrailFields[18].Descriptor()
+	// bronzeawscloudtrailtrail.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzeawscloudtrailtrail.AccountIDValidator = bronzeawscloudtrailtrailDescAccountID.Validators[0].(func(string) error)
+	// bronzeawscloudtrailtrailDescRegion is the schema descriptor for region field.
+	bronzeawscloudtrailtrailDescRegion := bronzeawscloudtrailtrailFields[19].Descriptor()
+	// bronzeawscloudtrailtrail.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzeawscloudtrailtrail.RegionValidator = bronzeawscloudtrailtrailDescRegion.Validators[0].(func(string) error)
+	bronzehistoryawscloudtrailtrailFields := schema.BronzeHistoryAWSCloudTrailTrail{}.Fields()
+	_ = bronzehistoryawscloudtrailtrailFields
+	// bronzehistoryawscloudtrailtrailDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryawscloudtrailtrailDescResourceID := bronzehistoryawscloudtrailtrailFields[1].Descriptor()
+	// bronzehistoryawscloudtrailtrail.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryawscloudtrailtrail.ResourceIDValidator = bronzehistoryawscloudtrailtrailDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryawscloudtrailtrailDescAccountID is the schema descriptor for account_id field.
+	bronzehistoryawscloudtrailtrailDescAccountID := bronzehistoryawscloudtrailtrailFields[19].Descriptor()
+	// bronzehistoryawscloudtrailtrail.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzehistoryawscloudtrailtrail.AccountIDValidator = bronzehistoryawscloudtrailtrailDescAccountID.Validators[0].(func(string) error)
+	// bronzehistoryawscloudtrailtrailDescRegion is the schema descriptor for region field.
+	bronzehistoryawscloudtrailtrailDescRegion := bronzehistoryawscloudtrailtrailFields[20].Descriptor()
+	// bronzehistoryawscloudtrailtrail.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzehistoryawscloudtrailtrail.RegionValidator = bronzehistoryawscloudtrailtrailDescRegion.Validators[0].(func(string) error)
+}
@@ -0,0 +1,15 @@
+// Code generated by entcgen. DO NOT EDIT.
+package schema
+
+import (
+	bronze_aws_cloudtrail "danny.vn/hotpot/pkg/schema/bronze/aws/cloudtrail"
+	bronzehistory_aws_cloudtrail "danny.vn/hotpot/pkg/schema/bronzehistory/aws/cloudtrail"
+)
+
+type BronzeAWSCloudTrailTrail struct {
+	bronze_aws_cloudtrail.BronzeAWSCloudTrailTrail
+}
+
+type BronzeHistoryAWSCloudTrailTrail struct {
+	bronzehistory_aws_cloudtrail.BronzeHistoryAWSCloudTrailTrail
+}
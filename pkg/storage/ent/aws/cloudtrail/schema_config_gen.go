@@ -0,0 +1,10 @@
+// Code generated by entcgen. DO NOT EDIT.
+package cloudtrail
+
+// DefaultSchemaConfig returns the schema config mapping each type to its PG schema.
+func DefaultSchemaConfig() SchemaConfig {
+	return SchemaConfig{
+		BronzeAWSCloudTrailTrail:        "bronze",
+		BronzeHistoryAWSCloudTrailTrail: "bronze_history",
+	}
+}
@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2address"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeAWSEC2Address is the model entity for the BronzeAWSEC2Address schema.
+type BronzeAWSEC2Address struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Allocation ID (eipalloc-xxxx), used as primary key
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Value of the Name tag
+	Name string `json:"name,omitempty"`
+	// PublicIP holds the value of the "public_ip" field.
+	PublicIP string `json:"public_ip,omitempty"`
+	// Domain holds the value of the "domain" field.
+	Domain string `json:"domain,omitempty"`
+	// Empty when the address is not associated
+	AssociationID string `json:"association_id,omitempty"`
+	// InstanceID holds the value of the "instance_id" field.
+	InstanceID string `json:"instance_id,omitempty"`
+	// NetworkInterfaceID holds the value of the "network_interface_id" field.
+	NetworkInterfaceID string `json:"network_interface_id,omitempty"`
+	// NetworkInterfaceOwnerID holds the value of the "network_interface_owner_id" field.
+	NetworkInterfaceOwnerID string `json:"network_interface_owner_id,omitempty"`
+	// PrivateIPAddress holds the value of the "private_ip_address" field.
+	PrivateIPAddress string `json:"private_ip_address,omitempty"`
+	// PublicIpv4Pool holds the value of the "public_ipv4_pool" field.
+	PublicIpv4Pool string `json:"public_ipv4_pool,omitempty"`
+	// NetworkBorderGroup holds the value of the "network_border_group" field.
+	NetworkBorderGroup string `json:"network_border_group,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Region holds the value of the "region" field.
+	Region       string `json:"region,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeAWSEC2Address) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsec2address.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzeawsec2address.FieldID, bronzeawsec2address.FieldName, bronzeawsec2address.FieldPublicIP, bronzeawsec2address.FieldDomain, bronzeawsec2address.FieldAssociationID, bronzeawsec2address.FieldInstanceID, bronzeawsec2address.FieldNetworkInterfaceID, bronzeawsec2address.FieldNetworkInterfaceOwnerID, bronzeawsec2address.FieldPrivateIPAddress, bronzeawsec2address.FieldPublicIpv4Pool, bronzeawsec2address.FieldNetworkBorderGroup, bronzeawsec2address.FieldAccountID, bronzeawsec2address.FieldRegion:
+			values[i] = new(sql.NullString)
+		case bronzeawsec2address.FieldCollectedAt, bronzeawsec2address.FieldFirstCollectedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeAWSEC2Address fields.
+func (_m *BronzeAWSEC2Address) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsec2address.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzeawsec2address.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzeawsec2address.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzeawsec2address.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzeawsec2address.FieldPublicIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field public_ip", values[i])
+			} else if value.Valid {
+				_m.PublicIP = value.String
+			}
+		case bronzeawsec2address.FieldDomain:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field domain", values[i])
+			} else if value.Valid {
+				_m.Domain = value.String
+			}
+		case bronzeawsec2address.FieldAssociationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field association_id", values[i])
+			} else if value.Valid {
+				_m.AssociationID = value.String
+			}
+		case bronzeawsec2address.FieldInstanceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field instance_id", values[i])
+			} else if value.Valid {
+				_m.InstanceID = value.String
+			}
+		case bronzeawsec2address.FieldNetworkInterfaceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network_interface_id", values[i])
+			} else if value.Valid {
+				_m.NetworkInterfaceID = value.String
+			}
+		case bronzeawsec2address.FieldNetworkInterfaceOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network_interface_owner_id", values[i])
+			} else if value.Valid {
+				_m.NetworkInterfaceOwnerID = value.String
+			}
+		case bronzeawsec2address.FieldPrivateIPAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field private_ip_address", values[i])
+			} else if value.Valid {
+				_m.PrivateIPAddress = value.String
+			}
+		case bronzeawsec2address.FieldPublicIpv4Pool:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field public_ipv4_pool", values[i])
+			} else if value.Valid {
+				_m.PublicIpv4Pool = value.String
+			}
+		case bronzeawsec2address.FieldNetworkBorderGroup:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network_border_group", values[i])
+			} else if value.Valid {
+				_m.NetworkBorderGroup = value.String
+			}
+		case bronzeawsec2address.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzeawsec2address.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzeawsec2address.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeAWSEC2Address.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeAWSEC2Address) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeAWSEC2Address.
+// Note that you need to call BronzeAWSEC2Address.Unwrap() before calling this method if this BronzeAWSEC2Address
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeAWSEC2Address) Update() *BronzeAWSEC2AddressUpdateOne {
+	return NewBronzeAWSEC2AddressClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeAWSEC2Address entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeAWSEC2Address) Unwrap() *BronzeAWSEC2Address {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ec2: BronzeAWSEC2Address is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeAWSEC2Address) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeAWSEC2Address(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("public_ip=")
+	builder.WriteString(_m.PublicIP)
+	builder.WriteString(", ")
+	builder.WriteString("domain=")
+	builder.WriteString(_m.Domain)
+	builder.WriteString(", ")
+	builder.WriteString("association_id=")
+	builder.WriteString(_m.AssociationID)
+	builder.WriteString(", ")
+	builder.WriteString("instance_id=")
+	builder.WriteString(_m.InstanceID)
+	builder.WriteString(", ")
+	builder.WriteString("network_interface_id=")
+	builder.WriteString(_m.NetworkInterfaceID)
+	builder.WriteString(", ")
+	builder.WriteString("network_interface_owner_id=")
+	builder.WriteString(_m.NetworkInterfaceOwnerID)
+	builder.WriteString(", ")
+	builder.WriteString("private_ip_address=")
+	builder.WriteString(_m.PrivateIPAddress)
+	builder.WriteString(", ")
+	builder.WriteString("public_ipv4_pool=")
+	builder.WriteString(_m.PublicIpv4Pool)
+	builder.WriteString(", ")
+	builder.WriteString("network_border_group=")
+	builder.WriteString(_m.NetworkBorderGroup)
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeAWSEC2Addresses is a parsable slice of BronzeAWSEC2Address.
+type BronzeAWSEC2Addresses []*BronzeAWSEC2Address
@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawsec2address
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzeawsec2address type in the database.
+	Label = "bronze_awsec2address"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPublicIP holds the string denoting the public_ip field in the database.
+	FieldPublicIP = "public_ip"
+	// FieldDomain holds the string denoting the domain field in the database.
+	FieldDomain = "domain"
+	// FieldAssociationID holds the string denoting the association_id field in the database.
+	FieldAssociationID = "association_id"
+	// FieldInstanceID holds the string denoting the instance_id field in the database.
+	FieldInstanceID = "instance_id"
+	// FieldNetworkInterfaceID holds the string denoting the network_interface_id field in the database.
+	FieldNetworkInterfaceID = "network_interface_id"
+	// FieldNetworkInterfaceOwnerID holds the string denoting the network_interface_owner_id field in the database.
+	FieldNetworkInterfaceOwnerID = "network_interface_owner_id"
+	// FieldPrivateIPAddress holds the string denoting the private_ip_address field in the database.
+	FieldPrivateIPAddress = "private_ip_address"
+	// FieldPublicIpv4Pool holds the string denoting the public_ipv4_pool field in the database.
+	FieldPublicIpv4Pool = "public_ipv4_pool"
+	// FieldNetworkBorderGroup holds the string denoting the network_border_group field in the database.
+	FieldNetworkBorderGroup = "network_border_group"
+	// FieldTagsJSON holds the string denoting the tags_json field in the database.
+	FieldTagsJSON = "tags_json"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
+	// Table holds the table name of the bronzeawsec2address in the database.
+	Table = "aws_ec2_addresses"
+)
+
+// Columns holds all SQL columns for bronzeawsec2address fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldName,
+	FieldPublicIP,
+	FieldDomain,
+	FieldAssociationID,
+	FieldInstanceID,
+	FieldNetworkInterfaceID,
+	FieldNetworkInterfaceOwnerID,
+	FieldPrivateIPAddress,
+	FieldPublicIpv4Pool,
+	FieldNetworkBorderGroup,
+	FieldTagsJSON,
+	FieldAccountID,
+	FieldRegion,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	AccountIDValidator func(string) error
+	// RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	RegionValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BronzeAWSEC2Address queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPublicIP orders the results by the public_ip field.
+func ByPublicIP(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublicIP, opts...).ToFunc()
+}
+
+// ByDomain orders the results by the domain field.
+func ByDomain(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDomain, opts...).ToFunc()
+}
+
+// ByAssociationID orders the results by the association_id field.
+func ByAssociationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssociationID, opts...).ToFunc()
+}
+
+// ByInstanceID orders the results by the instance_id field.
+func ByInstanceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstanceID, opts...).ToFunc()
+}
+
+// ByNetworkInterfaceID orders the results by the network_interface_id field.
+func ByNetworkInterfaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetworkInterfaceID, opts...).ToFunc()
+}
+
+// ByNetworkInterfaceOwnerID orders the results by the network_interface_owner_id field.
+func ByNetworkInterfaceOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetworkInterfaceOwnerID, opts...).ToFunc()
+}
+
+// ByPrivateIPAddress orders the results by the private_ip_address field.
+func ByPrivateIPAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrivateIPAddress, opts...).ToFunc()
+}
+
+// ByPublicIpv4Pool orders the results by the public_ipv4_pool field.
+func ByPublicIpv4Pool(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublicIpv4Pool, opts...).ToFunc()
+}
+
+// ByNetworkBorderGroup orders the results by the network_border_group field.
+func ByNetworkBorderGroup(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetworkBorderGroup, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByRegion orders the results by the region field.
+func ByRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegion, opts...).ToFunc()
+}
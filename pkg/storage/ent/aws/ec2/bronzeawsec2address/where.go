@@ -0,0 +1,1120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawsec2address
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldName, v))
+}
+
+// PublicIP applies equality check predicate on the "public_ip" field. It's identical to PublicIPEQ.
+func PublicIP(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldPublicIP, v))
+}
+
+// Domain applies equality check predicate on the "domain" field. It's identical to DomainEQ.
+func Domain(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldDomain, v))
+}
+
+// AssociationID applies equality check predicate on the "association_id" field. It's identical to AssociationIDEQ.
+func AssociationID(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldAssociationID, v))
+}
+
+// InstanceID applies equality check predicate on the "instance_id" field. It's identical to InstanceIDEQ.
+func InstanceID(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldInstanceID, v))
+}
+
+// NetworkInterfaceID applies equality check predicate on the "network_interface_id" field. It's identical to NetworkInterfaceIDEQ.
+func NetworkInterfaceID(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldNetworkInterfaceID, v))
+}
+
+// NetworkInterfaceOwnerID applies equality check predicate on the "network_interface_owner_id" field. It's identical to NetworkInterfaceOwnerIDEQ.
+func NetworkInterfaceOwnerID(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldNetworkInterfaceOwnerID, v))
+}
+
+// PrivateIPAddress applies equality check predicate on the "private_ip_address" field. It's identical to PrivateIPAddressEQ.
+func PrivateIPAddress(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldPrivateIPAddress, v))
+}
+
+// PublicIpv4Pool applies equality check predicate on the "public_ipv4_pool" field. It's identical to PublicIpv4PoolEQ.
+func PublicIpv4Pool(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldPublicIpv4Pool, v))
+}
+
+// NetworkBorderGroup applies equality check predicate on the "network_border_group" field. It's identical to NetworkBorderGroupEQ.
+func NetworkBorderGroup(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldNetworkBorderGroup, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldRegion, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContainsFold(FieldName, v))
+}
+
+// PublicIPEQ applies the EQ predicate on the "public_ip" field.
+func PublicIPEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldPublicIP, v))
+}
+
+// PublicIPNEQ applies the NEQ predicate on the "public_ip" field.
+func PublicIPNEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNEQ(FieldPublicIP, v))
+}
+
+// PublicIPIn applies the In predicate on the "public_ip" field.
+func PublicIPIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIn(FieldPublicIP, vs...))
+}
+
+// PublicIPNotIn applies the NotIn predicate on the "public_ip" field.
+func PublicIPNotIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotIn(FieldPublicIP, vs...))
+}
+
+// PublicIPGT applies the GT predicate on the "public_ip" field.
+func PublicIPGT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGT(FieldPublicIP, v))
+}
+
+// PublicIPGTE applies the GTE predicate on the "public_ip" field.
+func PublicIPGTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGTE(FieldPublicIP, v))
+}
+
+// PublicIPLT applies the LT predicate on the "public_ip" field.
+func PublicIPLT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLT(FieldPublicIP, v))
+}
+
+// PublicIPLTE applies the LTE predicate on the "public_ip" field.
+func PublicIPLTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLTE(FieldPublicIP, v))
+}
+
+// PublicIPContains applies the Contains predicate on the "public_ip" field.
+func PublicIPContains(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContains(FieldPublicIP, v))
+}
+
+// PublicIPHasPrefix applies the HasPrefix predicate on the "public_ip" field.
+func PublicIPHasPrefix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasPrefix(FieldPublicIP, v))
+}
+
+// PublicIPHasSuffix applies the HasSuffix predicate on the "public_ip" field.
+func PublicIPHasSuffix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasSuffix(FieldPublicIP, v))
+}
+
+// PublicIPIsNil applies the IsNil predicate on the "public_ip" field.
+func PublicIPIsNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIsNull(FieldPublicIP))
+}
+
+// PublicIPNotNil applies the NotNil predicate on the "public_ip" field.
+func PublicIPNotNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotNull(FieldPublicIP))
+}
+
+// PublicIPEqualFold applies the EqualFold predicate on the "public_ip" field.
+func PublicIPEqualFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEqualFold(FieldPublicIP, v))
+}
+
+// PublicIPContainsFold applies the ContainsFold predicate on the "public_ip" field.
+func PublicIPContainsFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContainsFold(FieldPublicIP, v))
+}
+
+// DomainEQ applies the EQ predicate on the "domain" field.
+func DomainEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldDomain, v))
+}
+
+// DomainNEQ applies the NEQ predicate on the "domain" field.
+func DomainNEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNEQ(FieldDomain, v))
+}
+
+// DomainIn applies the In predicate on the "domain" field.
+func DomainIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIn(FieldDomain, vs...))
+}
+
+// DomainNotIn applies the NotIn predicate on the "domain" field.
+func DomainNotIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotIn(FieldDomain, vs...))
+}
+
+// DomainGT applies the GT predicate on the "domain" field.
+func DomainGT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGT(FieldDomain, v))
+}
+
+// DomainGTE applies the GTE predicate on the "domain" field.
+func DomainGTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGTE(FieldDomain, v))
+}
+
+// DomainLT applies the LT predicate on the "domain" field.
+func DomainLT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLT(FieldDomain, v))
+}
+
+// DomainLTE applies the LTE predicate on the "domain" field.
+func DomainLTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLTE(FieldDomain, v))
+}
+
+// DomainContains applies the Contains predicate on the "domain" field.
+func DomainContains(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContains(FieldDomain, v))
+}
+
+// DomainHasPrefix applies the HasPrefix predicate on the "domain" field.
+func DomainHasPrefix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasPrefix(FieldDomain, v))
+}
+
+// DomainHasSuffix applies the HasSuffix predicate on the "domain" field.
+func DomainHasSuffix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasSuffix(FieldDomain, v))
+}
+
+// DomainIsNil applies the IsNil predicate on the "domain" field.
+func DomainIsNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIsNull(FieldDomain))
+}
+
+// DomainNotNil applies the NotNil predicate on the "domain" field.
+func DomainNotNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotNull(FieldDomain))
+}
+
+// DomainEqualFold applies the EqualFold predicate on the "domain" field.
+func DomainEqualFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEqualFold(FieldDomain, v))
+}
+
+// DomainContainsFold applies the ContainsFold predicate on the "domain" field.
+func DomainContainsFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContainsFold(FieldDomain, v))
+}
+
+// AssociationIDEQ applies the EQ predicate on the "association_id" field.
+func AssociationIDEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldAssociationID, v))
+}
+
+// AssociationIDNEQ applies the NEQ predicate on the "association_id" field.
+func AssociationIDNEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNEQ(FieldAssociationID, v))
+}
+
+// AssociationIDIn applies the In predicate on the "association_id" field.
+func AssociationIDIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIn(FieldAssociationID, vs...))
+}
+
+// AssociationIDNotIn applies the NotIn predicate on the "association_id" field.
+func AssociationIDNotIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotIn(FieldAssociationID, vs...))
+}
+
+// AssociationIDGT applies the GT predicate on the "association_id" field.
+func AssociationIDGT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGT(FieldAssociationID, v))
+}
+
+// AssociationIDGTE applies the GTE predicate on the "association_id" field.
+func AssociationIDGTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGTE(FieldAssociationID, v))
+}
+
+// AssociationIDLT applies the LT predicate on the "association_id" field.
+func AssociationIDLT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLT(FieldAssociationID, v))
+}
+
+// AssociationIDLTE applies the LTE predicate on the "association_id" field.
+func AssociationIDLTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLTE(FieldAssociationID, v))
+}
+
+// AssociationIDContains applies the Contains predicate on the "association_id" field.
+func AssociationIDContains(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContains(FieldAssociationID, v))
+}
+
+// AssociationIDHasPrefix applies the HasPrefix predicate on the "association_id" field.
+func AssociationIDHasPrefix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasPrefix(FieldAssociationID, v))
+}
+
+// AssociationIDHasSuffix applies the HasSuffix predicate on the "association_id" field.
+func AssociationIDHasSuffix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasSuffix(FieldAssociationID, v))
+}
+
+// AssociationIDIsNil applies the IsNil predicate on the "association_id" field.
+func AssociationIDIsNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIsNull(FieldAssociationID))
+}
+
+// AssociationIDNotNil applies the NotNil predicate on the "association_id" field.
+func AssociationIDNotNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotNull(FieldAssociationID))
+}
+
+// AssociationIDEqualFold applies the EqualFold predicate on the "association_id" field.
+func AssociationIDEqualFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEqualFold(FieldAssociationID, v))
+}
+
+// AssociationIDContainsFold applies the ContainsFold predicate on the "association_id" field.
+func AssociationIDContainsFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContainsFold(FieldAssociationID, v))
+}
+
+// InstanceIDEQ applies the EQ predicate on the "instance_id" field.
+func InstanceIDEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldInstanceID, v))
+}
+
+// InstanceIDNEQ applies the NEQ predicate on the "instance_id" field.
+func InstanceIDNEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNEQ(FieldInstanceID, v))
+}
+
+// InstanceIDIn applies the In predicate on the "instance_id" field.
+func InstanceIDIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIn(FieldInstanceID, vs...))
+}
+
+// InstanceIDNotIn applies the NotIn predicate on the "instance_id" field.
+func InstanceIDNotIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotIn(FieldInstanceID, vs...))
+}
+
+// InstanceIDGT applies the GT predicate on the "instance_id" field.
+func InstanceIDGT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGT(FieldInstanceID, v))
+}
+
+// InstanceIDGTE applies the GTE predicate on the "instance_id" field.
+func InstanceIDGTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGTE(FieldInstanceID, v))
+}
+
+// InstanceIDLT applies the LT predicate on the "instance_id" field.
+func InstanceIDLT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLT(FieldInstanceID, v))
+}
+
+// InstanceIDLTE applies the LTE predicate on the "instance_id" field.
+func InstanceIDLTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLTE(FieldInstanceID, v))
+}
+
+// InstanceIDContains applies the Contains predicate on the "instance_id" field.
+func InstanceIDContains(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContains(FieldInstanceID, v))
+}
+
+// InstanceIDHasPrefix applies the HasPrefix predicate on the "instance_id" field.
+func InstanceIDHasPrefix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasPrefix(FieldInstanceID, v))
+}
+
+// InstanceIDHasSuffix applies the HasSuffix predicate on the "instance_id" field.
+func InstanceIDHasSuffix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasSuffix(FieldInstanceID, v))
+}
+
+// InstanceIDIsNil applies the IsNil predicate on the "instance_id" field.
+func InstanceIDIsNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIsNull(FieldInstanceID))
+}
+
+// InstanceIDNotNil applies the NotNil predicate on the "instance_id" field.
+func InstanceIDNotNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotNull(FieldInstanceID))
+}
+
+// InstanceIDEqualFold applies the EqualFold predicate on the "instance_id" field.
+func InstanceIDEqualFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEqualFold(FieldInstanceID, v))
+}
+
+// InstanceIDContainsFold applies the ContainsFold predicate on the "instance_id" field.
+func InstanceIDContainsFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContainsFold(FieldInstanceID, v))
+}
+
+// NetworkInterfaceIDEQ applies the EQ predicate on the "network_interface_id" field.
+func NetworkInterfaceIDEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldNetworkInterfaceID, v))
+}
+
+// NetworkInterfaceIDNEQ applies the NEQ predicate on the "network_interface_id" field.
+func NetworkInterfaceIDNEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNEQ(FieldNetworkInterfaceID, v))
+}
+
+// NetworkInterfaceIDIn applies the In predicate on the "network_interface_id" field.
+func NetworkInterfaceIDIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIn(FieldNetworkInterfaceID, vs...))
+}
+
+// NetworkInterfaceIDNotIn applies the NotIn predicate on the "network_interface_id" field.
+func NetworkInterfaceIDNotIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotIn(FieldNetworkInterfaceID, vs...))
+}
+
+// NetworkInterfaceIDGT applies the GT predicate on the "network_interface_id" field.
+func NetworkInterfaceIDGT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGT(FieldNetworkInterfaceID, v))
+}
+
+// NetworkInterfaceIDGTE applies the GTE predicate on the "network_interface_id" field.
+func NetworkInterfaceIDGTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGTE(FieldNetworkInterfaceID, v))
+}
+
+// NetworkInterfaceIDLT applies the LT predicate on the "network_interface_id" field.
+func NetworkInterfaceIDLT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLT(FieldNetworkInterfaceID, v))
+}
+
+// NetworkInterfaceIDLTE applies the LTE predicate on the "network_interface_id" field.
+func NetworkInterfaceIDLTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLTE(FieldNetworkInterfaceID, v))
+}
+
+// NetworkInterfaceIDContains applies the Contains predicate on the "network_interface_id" field.
+func NetworkInterfaceIDContains(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContains(FieldNetworkInterfaceID, v))
+}
+
+// NetworkInterfaceIDHasPrefix applies the HasPrefix predicate on the "network_interface_id" field.
+func NetworkInterfaceIDHasPrefix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasPrefix(FieldNetworkInterfaceID, v))
+}
+
+// NetworkInterfaceIDHasSuffix applies the HasSuffix predicate on the "network_interface_id" field.
+func NetworkInterfaceIDHasSuffix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasSuffix(FieldNetworkInterfaceID, v))
+}
+
+// NetworkInterfaceIDIsNil applies the IsNil predicate on the "network_interface_id" field.
+func NetworkInterfaceIDIsNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIsNull(FieldNetworkInterfaceID))
+}
+
+// NetworkInterfaceIDNotNil applies the NotNil predicate on the "network_interface_id" field.
+func NetworkInterfaceIDNotNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotNull(FieldNetworkInterfaceID))
+}
+
+// NetworkInterfaceIDEqualFold applies the EqualFold predicate on the "network_interface_id" field.
+func NetworkInterfaceIDEqualFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEqualFold(FieldNetworkInterfaceID, v))
+}
+
+// NetworkInterfaceIDContainsFold applies the ContainsFold predicate on the "network_interface_id" field.
+func NetworkInterfaceIDContainsFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContainsFold(FieldNetworkInterfaceID, v))
+}
+
+// NetworkInterfaceOwnerIDEQ applies the EQ predicate on the "network_interface_owner_id" field.
+func NetworkInterfaceOwnerIDEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldNetworkInterfaceOwnerID, v))
+}
+
+// NetworkInterfaceOwnerIDNEQ applies the NEQ predicate on the "network_interface_owner_id" field.
+func NetworkInterfaceOwnerIDNEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNEQ(FieldNetworkInterfaceOwnerID, v))
+}
+
+// NetworkInterfaceOwnerIDIn applies the In predicate on the "network_interface_owner_id" field.
+func NetworkInterfaceOwnerIDIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIn(FieldNetworkInterfaceOwnerID, vs...))
+}
+
+// NetworkInterfaceOwnerIDNotIn applies the NotIn predicate on the "network_interface_owner_id" field.
+func NetworkInterfaceOwnerIDNotIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotIn(FieldNetworkInterfaceOwnerID, vs...))
+}
+
+// NetworkInterfaceOwnerIDGT applies the GT predicate on the "network_interface_owner_id" field.
+func NetworkInterfaceOwnerIDGT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGT(FieldNetworkInterfaceOwnerID, v))
+}
+
+// NetworkInterfaceOwnerIDGTE applies the GTE predicate on the "network_interface_owner_id" field.
+func NetworkInterfaceOwnerIDGTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGTE(FieldNetworkInterfaceOwnerID, v))
+}
+
+// NetworkInterfaceOwnerIDLT applies the LT predicate on the "network_interface_owner_id" field.
+func NetworkInterfaceOwnerIDLT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLT(FieldNetworkInterfaceOwnerID, v))
+}
+
+// NetworkInterfaceOwnerIDLTE applies the LTE predicate on the "network_interface_owner_id" field.
+func NetworkInterfaceOwnerIDLTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLTE(FieldNetworkInterfaceOwnerID, v))
+}
+
+// NetworkInterfaceOwnerIDContains applies the Contains predicate on the "network_interface_owner_id" field.
+func NetworkInterfaceOwnerIDContains(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContains(FieldNetworkInterfaceOwnerID, v))
+}
+
+// NetworkInterfaceOwnerIDHasPrefix applies the HasPrefix predicate on the "network_interface_owner_id" field.
+func NetworkInterfaceOwnerIDHasPrefix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasPrefix(FieldNetworkInterfaceOwnerID, v))
+}
+
+// NetworkInterfaceOwnerIDHasSuffix applies the HasSuffix predicate on the "network_interface_owner_id" field.
+func NetworkInterfaceOwnerIDHasSuffix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasSuffix(FieldNetworkInterfaceOwnerID, v))
+}
+
+// NetworkInterfaceOwnerIDIsNil applies the IsNil predicate on the "network_interface_owner_id" field.
+func NetworkInterfaceOwnerIDIsNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIsNull(FieldNetworkInterfaceOwnerID))
+}
+
+// NetworkInterfaceOwnerIDNotNil applies the NotNil predicate on the "network_interface_owner_id" field.
+func NetworkInterfaceOwnerIDNotNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotNull(FieldNetworkInterfaceOwnerID))
+}
+
+// NetworkInterfaceOwnerIDEqualFold applies the EqualFold predicate on the "network_interface_owner_id" field.
+func NetworkInterfaceOwnerIDEqualFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEqualFold(FieldNetworkInterfaceOwnerID, v))
+}
+
+// NetworkInterfaceOwnerIDContainsFold applies the ContainsFold predicate on the "network_interface_owner_id" field.
+func NetworkInterfaceOwnerIDContainsFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContainsFold(FieldNetworkInterfaceOwnerID, v))
+}
+
+// PrivateIPAddressEQ applies the EQ predicate on the "private_ip_address" field.
+func PrivateIPAddressEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressNEQ applies the NEQ predicate on the "private_ip_address" field.
+func PrivateIPAddressNEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNEQ(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressIn applies the In predicate on the "private_ip_address" field.
+func PrivateIPAddressIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIn(FieldPrivateIPAddress, vs...))
+}
+
+// PrivateIPAddressNotIn applies the NotIn predicate on the "private_ip_address" field.
+func PrivateIPAddressNotIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotIn(FieldPrivateIPAddress, vs...))
+}
+
+// PrivateIPAddressGT applies the GT predicate on the "private_ip_address" field.
+func PrivateIPAddressGT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGT(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressGTE applies the GTE predicate on the "private_ip_address" field.
+func PrivateIPAddressGTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGTE(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressLT applies the LT predicate on the "private_ip_address" field.
+func PrivateIPAddressLT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLT(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressLTE applies the LTE predicate on the "private_ip_address" field.
+func PrivateIPAddressLTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLTE(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressContains applies the Contains predicate on the "private_ip_address" field.
+func PrivateIPAddressContains(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContains(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressHasPrefix applies the HasPrefix predicate on the "private_ip_address" field.
+func PrivateIPAddressHasPrefix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasPrefix(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressHasSuffix applies the HasSuffix predicate on the "private_ip_address" field.
+func PrivateIPAddressHasSuffix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasSuffix(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressIsNil applies the IsNil predicate on the "private_ip_address" field.
+func PrivateIPAddressIsNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIsNull(FieldPrivateIPAddress))
+}
+
+// PrivateIPAddressNotNil applies the NotNil predicate on the "private_ip_address" field.
+func PrivateIPAddressNotNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotNull(FieldPrivateIPAddress))
+}
+
+// PrivateIPAddressEqualFold applies the EqualFold predicate on the "private_ip_address" field.
+func PrivateIPAddressEqualFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEqualFold(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressContainsFold applies the ContainsFold predicate on the "private_ip_address" field.
+func PrivateIPAddressContainsFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContainsFold(FieldPrivateIPAddress, v))
+}
+
+// PublicIpv4PoolEQ applies the EQ predicate on the "public_ipv4_pool" field.
+func PublicIpv4PoolEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldPublicIpv4Pool, v))
+}
+
+// PublicIpv4PoolNEQ applies the NEQ predicate on the "public_ipv4_pool" field.
+func PublicIpv4PoolNEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNEQ(FieldPublicIpv4Pool, v))
+}
+
+// PublicIpv4PoolIn applies the In predicate on the "public_ipv4_pool" field.
+func PublicIpv4PoolIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIn(FieldPublicIpv4Pool, vs...))
+}
+
+// PublicIpv4PoolNotIn applies the NotIn predicate on the "public_ipv4_pool" field.
+func PublicIpv4PoolNotIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotIn(FieldPublicIpv4Pool, vs...))
+}
+
+// PublicIpv4PoolGT applies the GT predicate on the "public_ipv4_pool" field.
+func PublicIpv4PoolGT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGT(FieldPublicIpv4Pool, v))
+}
+
+// PublicIpv4PoolGTE applies the GTE predicate on the "public_ipv4_pool" field.
+func PublicIpv4PoolGTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGTE(FieldPublicIpv4Pool, v))
+}
+
+// PublicIpv4PoolLT applies the LT predicate on the "public_ipv4_pool" field.
+func PublicIpv4PoolLT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLT(FieldPublicIpv4Pool, v))
+}
+
+// PublicIpv4PoolLTE applies the LTE predicate on the "public_ipv4_pool" field.
+func PublicIpv4PoolLTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLTE(FieldPublicIpv4Pool, v))
+}
+
+// PublicIpv4PoolContains applies the Contains predicate on the "public_ipv4_pool" field.
+func PublicIpv4PoolContains(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContains(FieldPublicIpv4Pool, v))
+}
+
+// PublicIpv4PoolHasPrefix applies the HasPrefix predicate on the "public_ipv4_pool" field.
+func PublicIpv4PoolHasPrefix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasPrefix(FieldPublicIpv4Pool, v))
+}
+
+// PublicIpv4PoolHasSuffix applies the HasSuffix predicate on the "public_ipv4_pool" field.
+func PublicIpv4PoolHasSuffix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasSuffix(FieldPublicIpv4Pool, v))
+}
+
+// PublicIpv4PoolIsNil applies the IsNil predicate on the "public_ipv4_pool" field.
+func PublicIpv4PoolIsNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIsNull(FieldPublicIpv4Pool))
+}
+
+// PublicIpv4PoolNotNil applies the NotNil predicate on the "public_ipv4_pool" field.
+func PublicIpv4PoolNotNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotNull(FieldPublicIpv4Pool))
+}
+
+// PublicIpv4PoolEqualFold applies the EqualFold predicate on the "public_ipv4_pool" field.
+func PublicIpv4PoolEqualFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEqualFold(FieldPublicIpv4Pool, v))
+}
+
+// PublicIpv4PoolContainsFold applies the ContainsFold predicate on the "public_ipv4_pool" field.
+func PublicIpv4PoolContainsFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContainsFold(FieldPublicIpv4Pool, v))
+}
+
+// NetworkBorderGroupEQ applies the EQ predicate on the "network_border_group" field.
+func NetworkBorderGroupEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldNetworkBorderGroup, v))
+}
+
+// NetworkBorderGroupNEQ applies the NEQ predicate on the "network_border_group" field.
+func NetworkBorderGroupNEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNEQ(FieldNetworkBorderGroup, v))
+}
+
+// NetworkBorderGroupIn applies the In predicate on the "network_border_group" field.
+func NetworkBorderGroupIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIn(FieldNetworkBorderGroup, vs...))
+}
+
+// NetworkBorderGroupNotIn applies the NotIn predicate on the "network_border_group" field.
+func NetworkBorderGroupNotIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotIn(FieldNetworkBorderGroup, vs...))
+}
+
+// NetworkBorderGroupGT applies the GT predicate on the "network_border_group" field.
+func NetworkBorderGroupGT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGT(FieldNetworkBorderGroup, v))
+}
+
+// NetworkBorderGroupGTE applies the GTE predicate on the "network_border_group" field.
+func NetworkBorderGroupGTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGTE(FieldNetworkBorderGroup, v))
+}
+
+// NetworkBorderGroupLT applies the LT predicate on the "network_border_group" field.
+func NetworkBorderGroupLT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLT(FieldNetworkBorderGroup, v))
+}
+
+// NetworkBorderGroupLTE applies the LTE predicate on the "network_border_group" field.
+func NetworkBorderGroupLTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLTE(FieldNetworkBorderGroup, v))
+}
+
+// NetworkBorderGroupContains applies the Contains predicate on the "network_border_group" field.
+func NetworkBorderGroupContains(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContains(FieldNetworkBorderGroup, v))
+}
+
+// NetworkBorderGroupHasPrefix applies the HasPrefix predicate on the "network_border_group" field.
+func NetworkBorderGroupHasPrefix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasPrefix(FieldNetworkBorderGroup, v))
+}
+
+// NetworkBorderGroupHasSuffix applies the HasSuffix predicate on the "network_border_group" field.
+func NetworkBorderGroupHasSuffix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasSuffix(FieldNetworkBorderGroup, v))
+}
+
+// NetworkBorderGroupIsNil applies the IsNil predicate on the "network_border_group" field.
+func NetworkBorderGroupIsNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIsNull(FieldNetworkBorderGroup))
+}
+
+// NetworkBorderGroupNotNil applies the NotNil predicate on the "network_border_group" field.
+func NetworkBorderGroupNotNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotNull(FieldNetworkBorderGroup))
+}
+
+// NetworkBorderGroupEqualFold applies the EqualFold predicate on the "network_border_group" field.
+func NetworkBorderGroupEqualFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEqualFold(FieldNetworkBorderGroup, v))
+}
+
+// NetworkBorderGroupContainsFold applies the ContainsFold predicate on the "network_border_group" field.
+func NetworkBorderGroupContainsFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContainsFold(FieldNetworkBorderGroup, v))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeAWSEC2Address) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeAWSEC2Address) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeAWSEC2Address) predicate.BronzeAWSEC2Address {
+	return predicate.BronzeAWSEC2Address(sql.NotPredicates(p))
+}
@@ -0,0 +1,899 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2address"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2AddressUpdate is the builder for updating BronzeAWSEC2Address entities.
+type BronzeAWSEC2AddressUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSEC2AddressMutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2AddressUpdate builder.
+func (_u *BronzeAWSEC2AddressUpdate) Where(ps ...predicate.BronzeAWSEC2Address) *BronzeAWSEC2AddressUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2AddressUpdate) SetCollectedAt(v time.Time) *BronzeAWSEC2AddressUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdate) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeAWSEC2AddressUpdate) SetName(v string) *BronzeAWSEC2AddressUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdate) SetNillableName(v *string) *BronzeAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeAWSEC2AddressUpdate) ClearName() *BronzeAWSEC2AddressUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetPublicIP sets the "public_ip" field.
+func (_u *BronzeAWSEC2AddressUpdate) SetPublicIP(v string) *BronzeAWSEC2AddressUpdate {
+	_u.mutation.SetPublicIP(v)
+	return _u
+}
+
+// SetNillablePublicIP sets the "public_ip" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdate) SetNillablePublicIP(v *string) *BronzeAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetPublicIP(*v)
+	}
+	return _u
+}
+
+// ClearPublicIP clears the value of the "public_ip" field.
+func (_u *BronzeAWSEC2AddressUpdate) ClearPublicIP() *BronzeAWSEC2AddressUpdate {
+	_u.mutation.ClearPublicIP()
+	return _u
+}
+
+// SetDomain sets the "domain" field.
+func (_u *BronzeAWSEC2AddressUpdate) SetDomain(v string) *BronzeAWSEC2AddressUpdate {
+	_u.mutation.SetDomain(v)
+	return _u
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdate) SetNillableDomain(v *string) *BronzeAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetDomain(*v)
+	}
+	return _u
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (_u *BronzeAWSEC2AddressUpdate) ClearDomain() *BronzeAWSEC2AddressUpdate {
+	_u.mutation.ClearDomain()
+	return _u
+}
+
+// SetAssociationID sets the "association_id" field.
+func (_u *BronzeAWSEC2AddressUpdate) SetAssociationID(v string) *BronzeAWSEC2AddressUpdate {
+	_u.mutation.SetAssociationID(v)
+	return _u
+}
+
+// SetNillableAssociationID sets the "association_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdate) SetNillableAssociationID(v *string) *BronzeAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetAssociationID(*v)
+	}
+	return _u
+}
+
+// ClearAssociationID clears the value of the "association_id" field.
+func (_u *BronzeAWSEC2AddressUpdate) ClearAssociationID() *BronzeAWSEC2AddressUpdate {
+	_u.mutation.ClearAssociationID()
+	return _u
+}
+
+// SetInstanceID sets the "instance_id" field.
+func (_u *BronzeAWSEC2AddressUpdate) SetInstanceID(v string) *BronzeAWSEC2AddressUpdate {
+	_u.mutation.SetInstanceID(v)
+	return _u
+}
+
+// SetNillableInstanceID sets the "instance_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdate) SetNillableInstanceID(v *string) *BronzeAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetInstanceID(*v)
+	}
+	return _u
+}
+
+// ClearInstanceID clears the value of the "instance_id" field.
+func (_u *BronzeAWSEC2AddressUpdate) ClearInstanceID() *BronzeAWSEC2AddressUpdate {
+	_u.mutation.ClearInstanceID()
+	return _u
+}
+
+// SetNetworkInterfaceID sets the "network_interface_id" field.
+func (_u *BronzeAWSEC2AddressUpdate) SetNetworkInterfaceID(v string) *BronzeAWSEC2AddressUpdate {
+	_u.mutation.SetNetworkInterfaceID(v)
+	return _u
+}
+
+// SetNillableNetworkInterfaceID sets the "network_interface_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdate) SetNillableNetworkInterfaceID(v *string) *BronzeAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetNetworkInterfaceID(*v)
+	}
+	return _u
+}
+
+// ClearNetworkInterfaceID clears the value of the "network_interface_id" field.
+func (_u *BronzeAWSEC2AddressUpdate) ClearNetworkInterfaceID() *BronzeAWSEC2AddressUpdate {
+	_u.mutation.ClearNetworkInterfaceID()
+	return _u
+}
+
+// SetNetworkInterfaceOwnerID sets the "network_interface_owner_id" field.
+func (_u *BronzeAWSEC2AddressUpdate) SetNetworkInterfaceOwnerID(v string) *BronzeAWSEC2AddressUpdate {
+	_u.mutation.SetNetworkInterfaceOwnerID(v)
+	return _u
+}
+
+// SetNillableNetworkInterfaceOwnerID sets the "network_interface_owner_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdate) SetNillableNetworkInterfaceOwnerID(v *string) *BronzeAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetNetworkInterfaceOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearNetworkInterfaceOwnerID clears the value of the "network_interface_owner_id" field.
+func (_u *BronzeAWSEC2AddressUpdate) ClearNetworkInterfaceOwnerID() *BronzeAWSEC2AddressUpdate {
+	_u.mutation.ClearNetworkInterfaceOwnerID()
+	return _u
+}
+
+// SetPrivateIPAddress sets the "private_ip_address" field.
+func (_u *BronzeAWSEC2AddressUpdate) SetPrivateIPAddress(v string) *BronzeAWSEC2AddressUpdate {
+	_u.mutation.SetPrivateIPAddress(v)
+	return _u
+}
+
+// SetNillablePrivateIPAddress sets the "private_ip_address" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdate) SetNillablePrivateIPAddress(v *string) *BronzeAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetPrivateIPAddress(*v)
+	}
+	return _u
+}
+
+// ClearPrivateIPAddress clears the value of the "private_ip_address" field.
+func (_u *BronzeAWSEC2AddressUpdate) ClearPrivateIPAddress() *BronzeAWSEC2AddressUpdate {
+	_u.mutation.ClearPrivateIPAddress()
+	return _u
+}
+
+// SetPublicIpv4Pool sets the "public_ipv4_pool" field.
+func (_u *BronzeAWSEC2AddressUpdate) SetPublicIpv4Pool(v string) *BronzeAWSEC2AddressUpdate {
+	_u.mutation.SetPublicIpv4Pool(v)
+	return _u
+}
+
+// SetNillablePublicIpv4Pool sets the "public_ipv4_pool" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdate) SetNillablePublicIpv4Pool(v *string) *BronzeAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetPublicIpv4Pool(*v)
+	}
+	return _u
+}
+
+// ClearPublicIpv4Pool clears the value of the "public_ipv4_pool" field.
+func (_u *BronzeAWSEC2AddressUpdate) ClearPublicIpv4Pool() *BronzeAWSEC2AddressUpdate {
+	_u.mutation.ClearPublicIpv4Pool()
+	return _u
+}
+
+// SetNetworkBorderGroup sets the "network_border_group" field.
+func (_u *BronzeAWSEC2AddressUpdate) SetNetworkBorderGroup(v string) *BronzeAWSEC2AddressUpdate {
+	_u.mutation.SetNetworkBorderGroup(v)
+	return _u
+}
+
+// SetNillableNetworkBorderGroup sets the "network_border_group" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdate) SetNillableNetworkBorderGroup(v *string) *BronzeAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetNetworkBorderGroup(*v)
+	}
+	return _u
+}
+
+// ClearNetworkBorderGroup clears the value of the "network_border_group" field.
+func (_u *BronzeAWSEC2AddressUpdate) ClearNetworkBorderGroup() *BronzeAWSEC2AddressUpdate {
+	_u.mutation.ClearNetworkBorderGroup()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2AddressUpdate) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2AddressUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2AddressUpdate) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2AddressUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2AddressUpdate) ClearTagsJSON() *BronzeAWSEC2AddressUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2AddressUpdate) SetAccountID(v string) *BronzeAWSEC2AddressUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdate) SetNillableAccountID(v *string) *BronzeAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2AddressUpdate) SetRegion(v string) *BronzeAWSEC2AddressUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdate) SetNillableRegion(v *string) *BronzeAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2AddressMutation object of the builder.
+func (_u *BronzeAWSEC2AddressUpdate) Mutation() *BronzeAWSEC2AddressMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeAWSEC2AddressUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2AddressUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeAWSEC2AddressUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2AddressUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2AddressUpdate) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2address.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2Address.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2address.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2Address.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2AddressUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2address.Table, bronzeawsec2address.Columns, sqlgraph.NewFieldSpec(bronzeawsec2address.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2address.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzeawsec2address.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicIP(); ok {
+		_spec.SetField(bronzeawsec2address.FieldPublicIP, field.TypeString, value)
+	}
+	if _u.mutation.PublicIPCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldPublicIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.Domain(); ok {
+		_spec.SetField(bronzeawsec2address.FieldDomain, field.TypeString, value)
+	}
+	if _u.mutation.DomainCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldDomain, field.TypeString)
+	}
+	if value, ok := _u.mutation.AssociationID(); ok {
+		_spec.SetField(bronzeawsec2address.FieldAssociationID, field.TypeString, value)
+	}
+	if _u.mutation.AssociationIDCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldAssociationID, field.TypeString)
+	}
+	if value, ok := _u.mutation.InstanceID(); ok {
+		_spec.SetField(bronzeawsec2address.FieldInstanceID, field.TypeString, value)
+	}
+	if _u.mutation.InstanceIDCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldInstanceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.NetworkInterfaceID(); ok {
+		_spec.SetField(bronzeawsec2address.FieldNetworkInterfaceID, field.TypeString, value)
+	}
+	if _u.mutation.NetworkInterfaceIDCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldNetworkInterfaceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.NetworkInterfaceOwnerID(); ok {
+		_spec.SetField(bronzeawsec2address.FieldNetworkInterfaceOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.NetworkInterfaceOwnerIDCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldNetworkInterfaceOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrivateIPAddress(); ok {
+		_spec.SetField(bronzeawsec2address.FieldPrivateIPAddress, field.TypeString, value)
+	}
+	if _u.mutation.PrivateIPAddressCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldPrivateIPAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicIpv4Pool(); ok {
+		_spec.SetField(bronzeawsec2address.FieldPublicIpv4Pool, field.TypeString, value)
+	}
+	if _u.mutation.PublicIpv4PoolCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldPublicIpv4Pool, field.TypeString)
+	}
+	if value, ok := _u.mutation.NetworkBorderGroup(); ok {
+		_spec.SetField(bronzeawsec2address.FieldNetworkBorderGroup, field.TypeString, value)
+	}
+	if _u.mutation.NetworkBorderGroupCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldNetworkBorderGroup, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2address.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2address.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2address.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2address.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2Address
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2address.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeAWSEC2AddressUpdateOne is the builder for updating a single BronzeAWSEC2Address entity.
+type BronzeAWSEC2AddressUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeAWSEC2AddressMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetCollectedAt(v time.Time) *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetName(v string) *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetNillableName(v *string) *BronzeAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) ClearName() *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetPublicIP sets the "public_ip" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetPublicIP(v string) *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.SetPublicIP(v)
+	return _u
+}
+
+// SetNillablePublicIP sets the "public_ip" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetNillablePublicIP(v *string) *BronzeAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetPublicIP(*v)
+	}
+	return _u
+}
+
+// ClearPublicIP clears the value of the "public_ip" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) ClearPublicIP() *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.ClearPublicIP()
+	return _u
+}
+
+// SetDomain sets the "domain" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetDomain(v string) *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.SetDomain(v)
+	return _u
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetNillableDomain(v *string) *BronzeAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetDomain(*v)
+	}
+	return _u
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) ClearDomain() *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.ClearDomain()
+	return _u
+}
+
+// SetAssociationID sets the "association_id" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetAssociationID(v string) *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.SetAssociationID(v)
+	return _u
+}
+
+// SetNillableAssociationID sets the "association_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetNillableAssociationID(v *string) *BronzeAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetAssociationID(*v)
+	}
+	return _u
+}
+
+// ClearAssociationID clears the value of the "association_id" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) ClearAssociationID() *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.ClearAssociationID()
+	return _u
+}
+
+// SetInstanceID sets the "instance_id" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetInstanceID(v string) *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.SetInstanceID(v)
+	return _u
+}
+
+// SetNillableInstanceID sets the "instance_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetNillableInstanceID(v *string) *BronzeAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetInstanceID(*v)
+	}
+	return _u
+}
+
+// ClearInstanceID clears the value of the "instance_id" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) ClearInstanceID() *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.ClearInstanceID()
+	return _u
+}
+
+// SetNetworkInterfaceID sets the "network_interface_id" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetNetworkInterfaceID(v string) *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.SetNetworkInterfaceID(v)
+	return _u
+}
+
+// SetNillableNetworkInterfaceID sets the "network_interface_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetNillableNetworkInterfaceID(v *string) *BronzeAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetNetworkInterfaceID(*v)
+	}
+	return _u
+}
+
+// ClearNetworkInterfaceID clears the value of the "network_interface_id" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) ClearNetworkInterfaceID() *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.ClearNetworkInterfaceID()
+	return _u
+}
+
+// SetNetworkInterfaceOwnerID sets the "network_interface_owner_id" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetNetworkInterfaceOwnerID(v string) *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.SetNetworkInterfaceOwnerID(v)
+	return _u
+}
+
+// SetNillableNetworkInterfaceOwnerID sets the "network_interface_owner_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetNillableNetworkInterfaceOwnerID(v *string) *BronzeAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetNetworkInterfaceOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearNetworkInterfaceOwnerID clears the value of the "network_interface_owner_id" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) ClearNetworkInterfaceOwnerID() *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.ClearNetworkInterfaceOwnerID()
+	return _u
+}
+
+// SetPrivateIPAddress sets the "private_ip_address" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetPrivateIPAddress(v string) *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.SetPrivateIPAddress(v)
+	return _u
+}
+
+// SetNillablePrivateIPAddress sets the "private_ip_address" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetNillablePrivateIPAddress(v *string) *BronzeAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetPrivateIPAddress(*v)
+	}
+	return _u
+}
+
+// ClearPrivateIPAddress clears the value of the "private_ip_address" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) ClearPrivateIPAddress() *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.ClearPrivateIPAddress()
+	return _u
+}
+
+// SetPublicIpv4Pool sets the "public_ipv4_pool" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetPublicIpv4Pool(v string) *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.SetPublicIpv4Pool(v)
+	return _u
+}
+
+// SetNillablePublicIpv4Pool sets the "public_ipv4_pool" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetNillablePublicIpv4Pool(v *string) *BronzeAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetPublicIpv4Pool(*v)
+	}
+	return _u
+}
+
+// ClearPublicIpv4Pool clears the value of the "public_ipv4_pool" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) ClearPublicIpv4Pool() *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.ClearPublicIpv4Pool()
+	return _u
+}
+
+// SetNetworkBorderGroup sets the "network_border_group" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetNetworkBorderGroup(v string) *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.SetNetworkBorderGroup(v)
+	return _u
+}
+
+// SetNillableNetworkBorderGroup sets the "network_border_group" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetNillableNetworkBorderGroup(v *string) *BronzeAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetNetworkBorderGroup(*v)
+	}
+	return _u
+}
+
+// ClearNetworkBorderGroup clears the value of the "network_border_group" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) ClearNetworkBorderGroup() *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.ClearNetworkBorderGroup()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) ClearTagsJSON() *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetAccountID(v string) *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetNillableAccountID(v *string) *BronzeAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetRegion(v string) *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2AddressUpdateOne) SetNillableRegion(v *string) *BronzeAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2AddressMutation object of the builder.
+func (_u *BronzeAWSEC2AddressUpdateOne) Mutation() *BronzeAWSEC2AddressMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2AddressUpdate builder.
+func (_u *BronzeAWSEC2AddressUpdateOne) Where(ps ...predicate.BronzeAWSEC2Address) *BronzeAWSEC2AddressUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeAWSEC2AddressUpdateOne) Select(field string, fields ...string) *BronzeAWSEC2AddressUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeAWSEC2Address entity.
+func (_u *BronzeAWSEC2AddressUpdateOne) Save(ctx context.Context) (*BronzeAWSEC2Address, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2AddressUpdateOne) SaveX(ctx context.Context) *BronzeAWSEC2Address {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeAWSEC2AddressUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2AddressUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2AddressUpdateOne) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2address.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2Address.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2address.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2Address.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2AddressUpdateOne) sqlSave(ctx context.Context) (_node *BronzeAWSEC2Address, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2address.Table, bronzeawsec2address.Columns, sqlgraph.NewFieldSpec(bronzeawsec2address.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeAWSEC2Address.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawsec2address.FieldID)
+		for _, f := range fields {
+			if !bronzeawsec2address.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzeawsec2address.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2address.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzeawsec2address.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicIP(); ok {
+		_spec.SetField(bronzeawsec2address.FieldPublicIP, field.TypeString, value)
+	}
+	if _u.mutation.PublicIPCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldPublicIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.Domain(); ok {
+		_spec.SetField(bronzeawsec2address.FieldDomain, field.TypeString, value)
+	}
+	if _u.mutation.DomainCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldDomain, field.TypeString)
+	}
+	if value, ok := _u.mutation.AssociationID(); ok {
+		_spec.SetField(bronzeawsec2address.FieldAssociationID, field.TypeString, value)
+	}
+	if _u.mutation.AssociationIDCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldAssociationID, field.TypeString)
+	}
+	if value, ok := _u.mutation.InstanceID(); ok {
+		_spec.SetField(bronzeawsec2address.FieldInstanceID, field.TypeString, value)
+	}
+	if _u.mutation.InstanceIDCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldInstanceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.NetworkInterfaceID(); ok {
+		_spec.SetField(bronzeawsec2address.FieldNetworkInterfaceID, field.TypeString, value)
+	}
+	if _u.mutation.NetworkInterfaceIDCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldNetworkInterfaceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.NetworkInterfaceOwnerID(); ok {
+		_spec.SetField(bronzeawsec2address.FieldNetworkInterfaceOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.NetworkInterfaceOwnerIDCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldNetworkInterfaceOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrivateIPAddress(); ok {
+		_spec.SetField(bronzeawsec2address.FieldPrivateIPAddress, field.TypeString, value)
+	}
+	if _u.mutation.PrivateIPAddressCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldPrivateIPAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicIpv4Pool(); ok {
+		_spec.SetField(bronzeawsec2address.FieldPublicIpv4Pool, field.TypeString, value)
+	}
+	if _u.mutation.PublicIpv4PoolCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldPublicIpv4Pool, field.TypeString)
+	}
+	if value, ok := _u.mutation.NetworkBorderGroup(); ok {
+		_spec.SetField(bronzeawsec2address.FieldNetworkBorderGroup, field.TypeString, value)
+	}
+	if _u.mutation.NetworkBorderGroupCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldNetworkBorderGroup, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2address.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2address.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2address.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2address.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2address.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2Address
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeAWSEC2Address{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2address.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
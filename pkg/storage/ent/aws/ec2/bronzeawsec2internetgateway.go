@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2internetgateway"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeAWSEC2InternetGateway is the model entity for the BronzeAWSEC2InternetGateway schema.
+type BronzeAWSEC2InternetGateway struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Internet gateway ID (igw-xxxx), used as primary key
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Value of the Name tag
+	Name string `json:"name,omitempty"`
+	// VPC the gateway is attached to, empty when detached
+	VpcID string `json:"vpc_id,omitempty"`
+	// attaching, attached, detaching, detached or available
+	AttachmentState string `json:"attachment_state,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID string `json:"owner_id,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Region holds the value of the "region" field.
+	Region       string `json:"region,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeAWSEC2InternetGateway) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsec2internetgateway.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzeawsec2internetgateway.FieldID, bronzeawsec2internetgateway.FieldName, bronzeawsec2internetgateway.FieldVpcID, bronzeawsec2internetgateway.FieldAttachmentState, bronzeawsec2internetgateway.FieldOwnerID, bronzeawsec2internetgateway.FieldAccountID, bronzeawsec2internetgateway.FieldRegion:
+			values[i] = new(sql.NullString)
+		case bronzeawsec2internetgateway.FieldCollectedAt, bronzeawsec2internetgateway.FieldFirstCollectedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeAWSEC2InternetGateway fields.
+func (_m *BronzeAWSEC2InternetGateway) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsec2internetgateway.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzeawsec2internetgateway.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzeawsec2internetgateway.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzeawsec2internetgateway.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzeawsec2internetgateway.FieldVpcID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vpc_id", values[i])
+			} else if value.Valid {
+				_m.VpcID = value.String
+			}
+		case bronzeawsec2internetgateway.FieldAttachmentState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment_state", values[i])
+			} else if value.Valid {
+				_m.AttachmentState = value.String
+			}
+		case bronzeawsec2internetgateway.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				_m.OwnerID = value.String
+			}
+		case bronzeawsec2internetgateway.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzeawsec2internetgateway.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzeawsec2internetgateway.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeAWSEC2InternetGateway.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeAWSEC2InternetGateway) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeAWSEC2InternetGateway.
+// Note that you need to call BronzeAWSEC2InternetGateway.Unwrap() before calling this method if this BronzeAWSEC2InternetGateway
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeAWSEC2InternetGateway) Update() *BronzeAWSEC2InternetGatewayUpdateOne {
+	return NewBronzeAWSEC2InternetGatewayClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeAWSEC2InternetGateway entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeAWSEC2InternetGateway) Unwrap() *BronzeAWSEC2InternetGateway {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ec2: BronzeAWSEC2InternetGateway is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeAWSEC2InternetGateway) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeAWSEC2InternetGateway(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("vpc_id=")
+	builder.WriteString(_m.VpcID)
+	builder.WriteString(", ")
+	builder.WriteString("attachment_state=")
+	builder.WriteString(_m.AttachmentState)
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(_m.OwnerID)
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeAWSEC2InternetGateways is a parsable slice of BronzeAWSEC2InternetGateway.
+type BronzeAWSEC2InternetGateways []*BronzeAWSEC2InternetGateway
@@ -0,0 +1,113 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawsec2internetgateway
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzeawsec2internetgateway type in the database.
+	Label = "bronze_awsec2internet_gateway"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldVpcID holds the string denoting the vpc_id field in the database.
+	FieldVpcID = "vpc_id"
+	// FieldAttachmentState holds the string denoting the attachment_state field in the database.
+	FieldAttachmentState = "attachment_state"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
+	// FieldTagsJSON holds the string denoting the tags_json field in the database.
+	FieldTagsJSON = "tags_json"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
+	// Table holds the table name of the bronzeawsec2internetgateway in the database.
+	Table = "aws_ec2_internet_gateways"
+)
+
+// Columns holds all SQL columns for bronzeawsec2internetgateway fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldName,
+	FieldVpcID,
+	FieldAttachmentState,
+	FieldOwnerID,
+	FieldTagsJSON,
+	FieldAccountID,
+	FieldRegion,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	AccountIDValidator func(string) error
+	// RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	RegionValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BronzeAWSEC2InternetGateway queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByVpcID orders the results by the vpc_id field.
+func ByVpcID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVpcID, opts...).ToFunc()
+}
+
+// ByAttachmentState orders the results by the attachment_state field.
+func ByAttachmentState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttachmentState, opts...).ToFunc()
+}
+
+// ByOwnerID orders the results by the owner_id field.
+func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByRegion orders the results by the region field.
+func ByRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegion, opts...).ToFunc()
+}
@@ -0,0 +1,587 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2internetgateway"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2InternetGatewayUpdate is the builder for updating BronzeAWSEC2InternetGateway entities.
+type BronzeAWSEC2InternetGatewayUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSEC2InternetGatewayMutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2InternetGatewayUpdate builder.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) Where(ps ...predicate.BronzeAWSEC2InternetGateway) *BronzeAWSEC2InternetGatewayUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) SetCollectedAt(v time.Time) *BronzeAWSEC2InternetGatewayUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2InternetGatewayUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) SetName(v string) *BronzeAWSEC2InternetGatewayUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) SetNillableName(v *string) *BronzeAWSEC2InternetGatewayUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) ClearName() *BronzeAWSEC2InternetGatewayUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) SetVpcID(v string) *BronzeAWSEC2InternetGatewayUpdate {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) SetNillableVpcID(v *string) *BronzeAWSEC2InternetGatewayUpdate {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) ClearVpcID() *BronzeAWSEC2InternetGatewayUpdate {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetAttachmentState sets the "attachment_state" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) SetAttachmentState(v string) *BronzeAWSEC2InternetGatewayUpdate {
+	_u.mutation.SetAttachmentState(v)
+	return _u
+}
+
+// SetNillableAttachmentState sets the "attachment_state" field if the given value is not nil.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) SetNillableAttachmentState(v *string) *BronzeAWSEC2InternetGatewayUpdate {
+	if v != nil {
+		_u.SetAttachmentState(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentState clears the value of the "attachment_state" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) ClearAttachmentState() *BronzeAWSEC2InternetGatewayUpdate {
+	_u.mutation.ClearAttachmentState()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) SetOwnerID(v string) *BronzeAWSEC2InternetGatewayUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) SetNillableOwnerID(v *string) *BronzeAWSEC2InternetGatewayUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) ClearOwnerID() *BronzeAWSEC2InternetGatewayUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2InternetGatewayUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2InternetGatewayUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) ClearTagsJSON() *BronzeAWSEC2InternetGatewayUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) SetAccountID(v string) *BronzeAWSEC2InternetGatewayUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) SetNillableAccountID(v *string) *BronzeAWSEC2InternetGatewayUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) SetRegion(v string) *BronzeAWSEC2InternetGatewayUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) SetNillableRegion(v *string) *BronzeAWSEC2InternetGatewayUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2InternetGatewayMutation object of the builder.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) Mutation() *BronzeAWSEC2InternetGatewayMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2InternetGatewayUpdate) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2internetgateway.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2InternetGateway.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2internetgateway.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2InternetGateway.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2InternetGatewayUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2internetgateway.Table, bronzeawsec2internetgateway.Columns, sqlgraph.NewFieldSpec(bronzeawsec2internetgateway.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2internetgateway.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzeawsec2internetgateway.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzeawsec2internetgateway.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzeawsec2internetgateway.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzeawsec2internetgateway.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentState(); ok {
+		_spec.SetField(bronzeawsec2internetgateway.FieldAttachmentState, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentStateCleared() {
+		_spec.ClearField(bronzeawsec2internetgateway.FieldAttachmentState, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawsec2internetgateway.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzeawsec2internetgateway.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2internetgateway.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2internetgateway.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2internetgateway.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2internetgateway.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2internetgateway.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2InternetGateway
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2internetgateway.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeAWSEC2InternetGatewayUpdateOne is the builder for updating a single BronzeAWSEC2InternetGateway entity.
+type BronzeAWSEC2InternetGatewayUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeAWSEC2InternetGatewayMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) SetCollectedAt(v time.Time) *BronzeAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2InternetGatewayUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) SetName(v string) *BronzeAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) SetNillableName(v *string) *BronzeAWSEC2InternetGatewayUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) ClearName() *BronzeAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) SetVpcID(v string) *BronzeAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) SetNillableVpcID(v *string) *BronzeAWSEC2InternetGatewayUpdateOne {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) ClearVpcID() *BronzeAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetAttachmentState sets the "attachment_state" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) SetAttachmentState(v string) *BronzeAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.SetAttachmentState(v)
+	return _u
+}
+
+// SetNillableAttachmentState sets the "attachment_state" field if the given value is not nil.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) SetNillableAttachmentState(v *string) *BronzeAWSEC2InternetGatewayUpdateOne {
+	if v != nil {
+		_u.SetAttachmentState(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentState clears the value of the "attachment_state" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) ClearAttachmentState() *BronzeAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.ClearAttachmentState()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) SetOwnerID(v string) *BronzeAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) SetNillableOwnerID(v *string) *BronzeAWSEC2InternetGatewayUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) ClearOwnerID() *BronzeAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) ClearTagsJSON() *BronzeAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) SetAccountID(v string) *BronzeAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) SetNillableAccountID(v *string) *BronzeAWSEC2InternetGatewayUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) SetRegion(v string) *BronzeAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) SetNillableRegion(v *string) *BronzeAWSEC2InternetGatewayUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2InternetGatewayMutation object of the builder.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) Mutation() *BronzeAWSEC2InternetGatewayMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2InternetGatewayUpdate builder.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) Where(ps ...predicate.BronzeAWSEC2InternetGateway) *BronzeAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) Select(field string, fields ...string) *BronzeAWSEC2InternetGatewayUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeAWSEC2InternetGateway entity.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) Save(ctx context.Context) (*BronzeAWSEC2InternetGateway, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) SaveX(ctx context.Context) *BronzeAWSEC2InternetGateway {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2internetgateway.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2InternetGateway.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2internetgateway.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2InternetGateway.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2InternetGatewayUpdateOne) sqlSave(ctx context.Context) (_node *BronzeAWSEC2InternetGateway, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2internetgateway.Table, bronzeawsec2internetgateway.Columns, sqlgraph.NewFieldSpec(bronzeawsec2internetgateway.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeAWSEC2InternetGateway.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawsec2internetgateway.FieldID)
+		for _, f := range fields {
+			if !bronzeawsec2internetgateway.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzeawsec2internetgateway.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2internetgateway.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzeawsec2internetgateway.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzeawsec2internetgateway.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzeawsec2internetgateway.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzeawsec2internetgateway.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentState(); ok {
+		_spec.SetField(bronzeawsec2internetgateway.FieldAttachmentState, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentStateCleared() {
+		_spec.ClearField(bronzeawsec2internetgateway.FieldAttachmentState, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawsec2internetgateway.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzeawsec2internetgateway.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2internetgateway.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2internetgateway.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2internetgateway.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2internetgateway.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2internetgateway.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2InternetGateway
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeAWSEC2InternetGateway{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2internetgateway.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
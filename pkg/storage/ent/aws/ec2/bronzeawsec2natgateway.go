@@ -0,0 +1,258 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2natgateway"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeAWSEC2NATGateway is the model entity for the BronzeAWSEC2NATGateway schema.
+type BronzeAWSEC2NATGateway struct {
+	config `json:"-"`
+	// ID of the ent.
+	// NAT gateway ID (nat-xxxx), used as primary key
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Value of the Name tag
+	Name string `json:"name,omitempty"`
+	// VpcID holds the value of the "vpc_id" field.
+	VpcID string `json:"vpc_id,omitempty"`
+	// SubnetID holds the value of the "subnet_id" field.
+	SubnetID string `json:"subnet_id,omitempty"`
+	// pending, failed, available or deleting
+	State string `json:"state,omitempty"`
+	// public or private
+	ConnectivityType string `json:"connectivity_type,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// FailureCode holds the value of the "failure_code" field.
+	FailureCode string `json:"failure_code,omitempty"`
+	// FailureMessage holds the value of the "failure_message" field.
+	FailureMessage string `json:"failure_message,omitempty"`
+	// AddressesJSON holds the value of the "addresses_json" field.
+	AddressesJSON json.RawMessage `json:"addresses_json,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Region holds the value of the "region" field.
+	Region       string `json:"region,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeAWSEC2NATGateway) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsec2natgateway.FieldAddressesJSON, bronzeawsec2natgateway.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzeawsec2natgateway.FieldID, bronzeawsec2natgateway.FieldName, bronzeawsec2natgateway.FieldVpcID, bronzeawsec2natgateway.FieldSubnetID, bronzeawsec2natgateway.FieldState, bronzeawsec2natgateway.FieldConnectivityType, bronzeawsec2natgateway.FieldFailureCode, bronzeawsec2natgateway.FieldFailureMessage, bronzeawsec2natgateway.FieldAccountID, bronzeawsec2natgateway.FieldRegion:
+			values[i] = new(sql.NullString)
+		case bronzeawsec2natgateway.FieldCollectedAt, bronzeawsec2natgateway.FieldFirstCollectedAt, bronzeawsec2natgateway.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeAWSEC2NATGateway fields.
+func (_m *BronzeAWSEC2NATGateway) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsec2natgateway.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzeawsec2natgateway.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzeawsec2natgateway.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzeawsec2natgateway.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzeawsec2natgateway.FieldVpcID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vpc_id", values[i])
+			} else if value.Valid {
+				_m.VpcID = value.String
+			}
+		case bronzeawsec2natgateway.FieldSubnetID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subnet_id", values[i])
+			} else if value.Valid {
+				_m.SubnetID = value.String
+			}
+		case bronzeawsec2natgateway.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				_m.State = value.String
+			}
+		case bronzeawsec2natgateway.FieldConnectivityType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field connectivity_type", values[i])
+			} else if value.Valid {
+				_m.ConnectivityType = value.String
+			}
+		case bronzeawsec2natgateway.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case bronzeawsec2natgateway.FieldFailureCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_code", values[i])
+			} else if value.Valid {
+				_m.FailureCode = value.String
+			}
+		case bronzeawsec2natgateway.FieldFailureMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_message", values[i])
+			} else if value.Valid {
+				_m.FailureMessage = value.String
+			}
+		case bronzeawsec2natgateway.FieldAddressesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field addresses_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AddressesJSON); err != nil {
+					return fmt.Errorf("unmarshal field addresses_json: %w", err)
+				}
+			}
+		case bronzeawsec2natgateway.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzeawsec2natgateway.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzeawsec2natgateway.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeAWSEC2NATGateway.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeAWSEC2NATGateway) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeAWSEC2NATGateway.
+// Note that you need to call BronzeAWSEC2NATGateway.Unwrap() before calling this method if this BronzeAWSEC2NATGateway
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeAWSEC2NATGateway) Update() *BronzeAWSEC2NATGatewayUpdateOne {
+	return NewBronzeAWSEC2NATGatewayClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeAWSEC2NATGateway entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeAWSEC2NATGateway) Unwrap() *BronzeAWSEC2NATGateway {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ec2: BronzeAWSEC2NATGateway is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeAWSEC2NATGateway) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeAWSEC2NATGateway(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("vpc_id=")
+	builder.WriteString(_m.VpcID)
+	builder.WriteString(", ")
+	builder.WriteString("subnet_id=")
+	builder.WriteString(_m.SubnetID)
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(_m.State)
+	builder.WriteString(", ")
+	builder.WriteString("connectivity_type=")
+	builder.WriteString(_m.ConnectivityType)
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("failure_code=")
+	builder.WriteString(_m.FailureCode)
+	builder.WriteString(", ")
+	builder.WriteString("failure_message=")
+	builder.WriteString(_m.FailureMessage)
+	builder.WriteString(", ")
+	builder.WriteString("addresses_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AddressesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeAWSEC2NATGateways is a parsable slice of BronzeAWSEC2NATGateway.
+type BronzeAWSEC2NATGateways []*BronzeAWSEC2NATGateway
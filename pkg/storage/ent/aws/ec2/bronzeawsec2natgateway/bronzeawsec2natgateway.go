@@ -0,0 +1,148 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawsec2natgateway
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzeawsec2natgateway type in the database.
+	Label = "bronze_awsec2nat_gateway"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldVpcID holds the string denoting the vpc_id field in the database.
+	FieldVpcID = "vpc_id"
+	// FieldSubnetID holds the string denoting the subnet_id field in the database.
+	FieldSubnetID = "subnet_id"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldConnectivityType holds the string denoting the connectivity_type field in the database.
+	FieldConnectivityType = "connectivity_type"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldFailureCode holds the string denoting the failure_code field in the database.
+	FieldFailureCode = "failure_code"
+	// FieldFailureMessage holds the string denoting the failure_message field in the database.
+	FieldFailureMessage = "failure_message"
+	// FieldAddressesJSON holds the string denoting the addresses_json field in the database.
+	FieldAddressesJSON = "addresses_json"
+	// FieldTagsJSON holds the string denoting the tags_json field in the database.
+	FieldTagsJSON = "tags_json"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
+	// Table holds the table name of the bronzeawsec2natgateway in the database.
+	Table = "aws_ec2_nat_gateways"
+)
+
+// Columns holds all SQL columns for bronzeawsec2natgateway fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldName,
+	FieldVpcID,
+	FieldSubnetID,
+	FieldState,
+	FieldConnectivityType,
+	FieldCreateTime,
+	FieldFailureCode,
+	FieldFailureMessage,
+	FieldAddressesJSON,
+	FieldTagsJSON,
+	FieldAccountID,
+	FieldRegion,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	AccountIDValidator func(string) error
+	// RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	RegionValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BronzeAWSEC2NATGateway queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByVpcID orders the results by the vpc_id field.
+func ByVpcID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVpcID, opts...).ToFunc()
+}
+
+// BySubnetID orders the results by the subnet_id field.
+func BySubnetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubnetID, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByConnectivityType orders the results by the connectivity_type field.
+func ByConnectivityType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConnectivityType, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByFailureCode orders the results by the failure_code field.
+func ByFailureCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailureCode, opts...).ToFunc()
+}
+
+// ByFailureMessage orders the results by the failure_message field.
+func ByFailureMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailureMessage, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByRegion orders the results by the region field.
+func ByRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegion, opts...).ToFunc()
+}
@@ -0,0 +1,945 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawsec2natgateway
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldName, v))
+}
+
+// VpcID applies equality check predicate on the "vpc_id" field. It's identical to VpcIDEQ.
+func VpcID(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldVpcID, v))
+}
+
+// SubnetID applies equality check predicate on the "subnet_id" field. It's identical to SubnetIDEQ.
+func SubnetID(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldSubnetID, v))
+}
+
+// State applies equality check predicate on the "state" field. It's identical to StateEQ.
+func State(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldState, v))
+}
+
+// ConnectivityType applies equality check predicate on the "connectivity_type" field. It's identical to ConnectivityTypeEQ.
+func ConnectivityType(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldConnectivityType, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// FailureCode applies equality check predicate on the "failure_code" field. It's identical to FailureCodeEQ.
+func FailureCode(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldFailureCode, v))
+}
+
+// FailureMessage applies equality check predicate on the "failure_message" field. It's identical to FailureMessageEQ.
+func FailureMessage(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldFailureMessage, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldRegion, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldContainsFold(FieldName, v))
+}
+
+// VpcIDEQ applies the EQ predicate on the "vpc_id" field.
+func VpcIDEQ(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldVpcID, v))
+}
+
+// VpcIDNEQ applies the NEQ predicate on the "vpc_id" field.
+func VpcIDNEQ(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNEQ(FieldVpcID, v))
+}
+
+// VpcIDIn applies the In predicate on the "vpc_id" field.
+func VpcIDIn(vs ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIn(FieldVpcID, vs...))
+}
+
+// VpcIDNotIn applies the NotIn predicate on the "vpc_id" field.
+func VpcIDNotIn(vs ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotIn(FieldVpcID, vs...))
+}
+
+// VpcIDGT applies the GT predicate on the "vpc_id" field.
+func VpcIDGT(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGT(FieldVpcID, v))
+}
+
+// VpcIDGTE applies the GTE predicate on the "vpc_id" field.
+func VpcIDGTE(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGTE(FieldVpcID, v))
+}
+
+// VpcIDLT applies the LT predicate on the "vpc_id" field.
+func VpcIDLT(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLT(FieldVpcID, v))
+}
+
+// VpcIDLTE applies the LTE predicate on the "vpc_id" field.
+func VpcIDLTE(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLTE(FieldVpcID, v))
+}
+
+// VpcIDContains applies the Contains predicate on the "vpc_id" field.
+func VpcIDContains(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldContains(FieldVpcID, v))
+}
+
+// VpcIDHasPrefix applies the HasPrefix predicate on the "vpc_id" field.
+func VpcIDHasPrefix(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldHasPrefix(FieldVpcID, v))
+}
+
+// VpcIDHasSuffix applies the HasSuffix predicate on the "vpc_id" field.
+func VpcIDHasSuffix(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldHasSuffix(FieldVpcID, v))
+}
+
+// VpcIDIsNil applies the IsNil predicate on the "vpc_id" field.
+func VpcIDIsNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIsNull(FieldVpcID))
+}
+
+// VpcIDNotNil applies the NotNil predicate on the "vpc_id" field.
+func VpcIDNotNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotNull(FieldVpcID))
+}
+
+// VpcIDEqualFold applies the EqualFold predicate on the "vpc_id" field.
+func VpcIDEqualFold(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEqualFold(FieldVpcID, v))
+}
+
+// VpcIDContainsFold applies the ContainsFold predicate on the "vpc_id" field.
+func VpcIDContainsFold(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldContainsFold(FieldVpcID, v))
+}
+
+// SubnetIDEQ applies the EQ predicate on the "subnet_id" field.
+func SubnetIDEQ(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldSubnetID, v))
+}
+
+// SubnetIDNEQ applies the NEQ predicate on the "subnet_id" field.
+func SubnetIDNEQ(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNEQ(FieldSubnetID, v))
+}
+
+// SubnetIDIn applies the In predicate on the "subnet_id" field.
+func SubnetIDIn(vs ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIn(FieldSubnetID, vs...))
+}
+
+// SubnetIDNotIn applies the NotIn predicate on the "subnet_id" field.
+func SubnetIDNotIn(vs ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotIn(FieldSubnetID, vs...))
+}
+
+// SubnetIDGT applies the GT predicate on the "subnet_id" field.
+func SubnetIDGT(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGT(FieldSubnetID, v))
+}
+
+// SubnetIDGTE applies the GTE predicate on the "subnet_id" field.
+func SubnetIDGTE(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGTE(FieldSubnetID, v))
+}
+
+// SubnetIDLT applies the LT predicate on the "subnet_id" field.
+func SubnetIDLT(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLT(FieldSubnetID, v))
+}
+
+// SubnetIDLTE applies the LTE predicate on the "subnet_id" field.
+func SubnetIDLTE(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLTE(FieldSubnetID, v))
+}
+
+// SubnetIDContains applies the Contains predicate on the "subnet_id" field.
+func SubnetIDContains(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldContains(FieldSubnetID, v))
+}
+
+// SubnetIDHasPrefix applies the HasPrefix predicate on the "subnet_id" field.
+func SubnetIDHasPrefix(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldHasPrefix(FieldSubnetID, v))
+}
+
+// SubnetIDHasSuffix applies the HasSuffix predicate on the "subnet_id" field.
+func SubnetIDHasSuffix(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldHasSuffix(FieldSubnetID, v))
+}
+
+// SubnetIDIsNil applies the IsNil predicate on the "subnet_id" field.
+func SubnetIDIsNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIsNull(FieldSubnetID))
+}
+
+// SubnetIDNotNil applies the NotNil predicate on the "subnet_id" field.
+func SubnetIDNotNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotNull(FieldSubnetID))
+}
+
+// SubnetIDEqualFold applies the EqualFold predicate on the "subnet_id" field.
+func SubnetIDEqualFold(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEqualFold(FieldSubnetID, v))
+}
+
+// SubnetIDContainsFold applies the ContainsFold predicate on the "subnet_id" field.
+func SubnetIDContainsFold(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldContainsFold(FieldSubnetID, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotIn(FieldState, vs...))
+}
+
+// StateGT applies the GT predicate on the "state" field.
+func StateGT(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGT(FieldState, v))
+}
+
+// StateGTE applies the GTE predicate on the "state" field.
+func StateGTE(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGTE(FieldState, v))
+}
+
+// StateLT applies the LT predicate on the "state" field.
+func StateLT(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLT(FieldState, v))
+}
+
+// StateLTE applies the LTE predicate on the "state" field.
+func StateLTE(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLTE(FieldState, v))
+}
+
+// StateContains applies the Contains predicate on the "state" field.
+func StateContains(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldContains(FieldState, v))
+}
+
+// StateHasPrefix applies the HasPrefix predicate on the "state" field.
+func StateHasPrefix(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldHasPrefix(FieldState, v))
+}
+
+// StateHasSuffix applies the HasSuffix predicate on the "state" field.
+func StateHasSuffix(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldHasSuffix(FieldState, v))
+}
+
+// StateIsNil applies the IsNil predicate on the "state" field.
+func StateIsNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIsNull(FieldState))
+}
+
+// StateNotNil applies the NotNil predicate on the "state" field.
+func StateNotNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotNull(FieldState))
+}
+
+// StateEqualFold applies the EqualFold predicate on the "state" field.
+func StateEqualFold(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEqualFold(FieldState, v))
+}
+
+// StateContainsFold applies the ContainsFold predicate on the "state" field.
+func StateContainsFold(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldContainsFold(FieldState, v))
+}
+
+// ConnectivityTypeEQ applies the EQ predicate on the "connectivity_type" field.
+func ConnectivityTypeEQ(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldConnectivityType, v))
+}
+
+// ConnectivityTypeNEQ applies the NEQ predicate on the "connectivity_type" field.
+func ConnectivityTypeNEQ(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNEQ(FieldConnectivityType, v))
+}
+
+// ConnectivityTypeIn applies the In predicate on the "connectivity_type" field.
+func ConnectivityTypeIn(vs ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIn(FieldConnectivityType, vs...))
+}
+
+// ConnectivityTypeNotIn applies the NotIn predicate on the "connectivity_type" field.
+func ConnectivityTypeNotIn(vs ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotIn(FieldConnectivityType, vs...))
+}
+
+// ConnectivityTypeGT applies the GT predicate on the "connectivity_type" field.
+func ConnectivityTypeGT(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGT(FieldConnectivityType, v))
+}
+
+// ConnectivityTypeGTE applies the GTE predicate on the "connectivity_type" field.
+func ConnectivityTypeGTE(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGTE(FieldConnectivityType, v))
+}
+
+// ConnectivityTypeLT applies the LT predicate on the "connectivity_type" field.
+func ConnectivityTypeLT(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLT(FieldConnectivityType, v))
+}
+
+// ConnectivityTypeLTE applies the LTE predicate on the "connectivity_type" field.
+func ConnectivityTypeLTE(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLTE(FieldConnectivityType, v))
+}
+
+// ConnectivityTypeContains applies the Contains predicate on the "connectivity_type" field.
+func ConnectivityTypeContains(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldContains(FieldConnectivityType, v))
+}
+
+// ConnectivityTypeHasPrefix applies the HasPrefix predicate on the "connectivity_type" field.
+func ConnectivityTypeHasPrefix(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldHasPrefix(FieldConnectivityType, v))
+}
+
+// ConnectivityTypeHasSuffix applies the HasSuffix predicate on the "connectivity_type" field.
+func ConnectivityTypeHasSuffix(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldHasSuffix(FieldConnectivityType, v))
+}
+
+// ConnectivityTypeIsNil applies the IsNil predicate on the "connectivity_type" field.
+func ConnectivityTypeIsNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIsNull(FieldConnectivityType))
+}
+
+// ConnectivityTypeNotNil applies the NotNil predicate on the "connectivity_type" field.
+func ConnectivityTypeNotNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotNull(FieldConnectivityType))
+}
+
+// ConnectivityTypeEqualFold applies the EqualFold predicate on the "connectivity_type" field.
+func ConnectivityTypeEqualFold(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEqualFold(FieldConnectivityType, v))
+}
+
+// ConnectivityTypeContainsFold applies the ContainsFold predicate on the "connectivity_type" field.
+func ConnectivityTypeContainsFold(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldContainsFold(FieldConnectivityType, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotNull(FieldCreateTime))
+}
+
+// FailureCodeEQ applies the EQ predicate on the "failure_code" field.
+func FailureCodeEQ(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldFailureCode, v))
+}
+
+// FailureCodeNEQ applies the NEQ predicate on the "failure_code" field.
+func FailureCodeNEQ(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNEQ(FieldFailureCode, v))
+}
+
+// FailureCodeIn applies the In predicate on the "failure_code" field.
+func FailureCodeIn(vs ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIn(FieldFailureCode, vs...))
+}
+
+// FailureCodeNotIn applies the NotIn predicate on the "failure_code" field.
+func FailureCodeNotIn(vs ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotIn(FieldFailureCode, vs...))
+}
+
+// FailureCodeGT applies the GT predicate on the "failure_code" field.
+func FailureCodeGT(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGT(FieldFailureCode, v))
+}
+
+// FailureCodeGTE applies the GTE predicate on the "failure_code" field.
+func FailureCodeGTE(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGTE(FieldFailureCode, v))
+}
+
+// FailureCodeLT applies the LT predicate on the "failure_code" field.
+func FailureCodeLT(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLT(FieldFailureCode, v))
+}
+
+// FailureCodeLTE applies the LTE predicate on the "failure_code" field.
+func FailureCodeLTE(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLTE(FieldFailureCode, v))
+}
+
+// FailureCodeContains applies the Contains predicate on the "failure_code" field.
+func FailureCodeContains(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldContains(FieldFailureCode, v))
+}
+
+// FailureCodeHasPrefix applies the HasPrefix predicate on the "failure_code" field.
+func FailureCodeHasPrefix(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldHasPrefix(FieldFailureCode, v))
+}
+
+// FailureCodeHasSuffix applies the HasSuffix predicate on the "failure_code" field.
+func FailureCodeHasSuffix(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldHasSuffix(FieldFailureCode, v))
+}
+
+// FailureCodeIsNil applies the IsNil predicate on the "failure_code" field.
+func FailureCodeIsNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIsNull(FieldFailureCode))
+}
+
+// FailureCodeNotNil applies the NotNil predicate on the "failure_code" field.
+func FailureCodeNotNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotNull(FieldFailureCode))
+}
+
+// FailureCodeEqualFold applies the EqualFold predicate on the "failure_code" field.
+func FailureCodeEqualFold(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEqualFold(FieldFailureCode, v))
+}
+
+// FailureCodeContainsFold applies the ContainsFold predicate on the "failure_code" field.
+func FailureCodeContainsFold(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldContainsFold(FieldFailureCode, v))
+}
+
+// FailureMessageEQ applies the EQ predicate on the "failure_message" field.
+func FailureMessageEQ(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldFailureMessage, v))
+}
+
+// FailureMessageNEQ applies the NEQ predicate on the "failure_message" field.
+func FailureMessageNEQ(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNEQ(FieldFailureMessage, v))
+}
+
+// FailureMessageIn applies the In predicate on the "failure_message" field.
+func FailureMessageIn(vs ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIn(FieldFailureMessage, vs...))
+}
+
+// FailureMessageNotIn applies the NotIn predicate on the "failure_message" field.
+func FailureMessageNotIn(vs ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotIn(FieldFailureMessage, vs...))
+}
+
+// FailureMessageGT applies the GT predicate on the "failure_message" field.
+func FailureMessageGT(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGT(FieldFailureMessage, v))
+}
+
+// FailureMessageGTE applies the GTE predicate on the "failure_message" field.
+func FailureMessageGTE(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGTE(FieldFailureMessage, v))
+}
+
+// FailureMessageLT applies the LT predicate on the "failure_message" field.
+func FailureMessageLT(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLT(FieldFailureMessage, v))
+}
+
+// FailureMessageLTE applies the LTE predicate on the "failure_message" field.
+func FailureMessageLTE(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLTE(FieldFailureMessage, v))
+}
+
+// FailureMessageContains applies the Contains predicate on the "failure_message" field.
+func FailureMessageContains(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldContains(FieldFailureMessage, v))
+}
+
+// FailureMessageHasPrefix applies the HasPrefix predicate on the "failure_message" field.
+func FailureMessageHasPrefix(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldHasPrefix(FieldFailureMessage, v))
+}
+
+// FailureMessageHasSuffix applies the HasSuffix predicate on the "failure_message" field.
+func FailureMessageHasSuffix(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldHasSuffix(FieldFailureMessage, v))
+}
+
+// FailureMessageIsNil applies the IsNil predicate on the "failure_message" field.
+func FailureMessageIsNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIsNull(FieldFailureMessage))
+}
+
+// FailureMessageNotNil applies the NotNil predicate on the "failure_message" field.
+func FailureMessageNotNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotNull(FieldFailureMessage))
+}
+
+// FailureMessageEqualFold applies the EqualFold predicate on the "failure_message" field.
+func FailureMessageEqualFold(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEqualFold(FieldFailureMessage, v))
+}
+
+// FailureMessageContainsFold applies the ContainsFold predicate on the "failure_message" field.
+func FailureMessageContainsFold(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldContainsFold(FieldFailureMessage, v))
+}
+
+// AddressesJSONIsNil applies the IsNil predicate on the "addresses_json" field.
+func AddressesJSONIsNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIsNull(FieldAddressesJSON))
+}
+
+// AddressesJSONNotNil applies the NotNil predicate on the "addresses_json" field.
+func AddressesJSONNotNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotNull(FieldAddressesJSON))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeAWSEC2NATGateway) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeAWSEC2NATGateway) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeAWSEC2NATGateway) predicate.BronzeAWSEC2NATGateway {
+	return predicate.BronzeAWSEC2NATGateway(sql.NotPredicates(p))
+}
@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2natgateway"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2NATGatewayCreate is the builder for creating a BronzeAWSEC2NATGateway entity.
+type BronzeAWSEC2NATGatewayCreate struct {
+	config
+	mutation *BronzeAWSEC2NATGatewayMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetCollectedAt(v time.Time) *BronzeAWSEC2NATGatewayCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetFirstCollectedAt(v time.Time) *BronzeAWSEC2NATGatewayCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetName(v string) *BronzeAWSEC2NATGatewayCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetNillableName(v *string) *BronzeAWSEC2NATGatewayCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetVpcID(v string) *BronzeAWSEC2NATGatewayCreate {
+	_c.mutation.SetVpcID(v)
+	return _c
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetNillableVpcID(v *string) *BronzeAWSEC2NATGatewayCreate {
+	if v != nil {
+		_c.SetVpcID(*v)
+	}
+	return _c
+}
+
+// SetSubnetID sets the "subnet_id" field.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetSubnetID(v string) *BronzeAWSEC2NATGatewayCreate {
+	_c.mutation.SetSubnetID(v)
+	return _c
+}
+
+// SetNillableSubnetID sets the "subnet_id" field if the given value is not nil.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetNillableSubnetID(v *string) *BronzeAWSEC2NATGatewayCreate {
+	if v != nil {
+		_c.SetSubnetID(*v)
+	}
+	return _c
+}
+
+// SetState sets the "state" field.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetState(v string) *BronzeAWSEC2NATGatewayCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetNillableState(v *string) *BronzeAWSEC2NATGatewayCreate {
+	if v != nil {
+		_c.SetState(*v)
+	}
+	return _c
+}
+
+// SetConnectivityType sets the "connectivity_type" field.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetConnectivityType(v string) *BronzeAWSEC2NATGatewayCreate {
+	_c.mutation.SetConnectivityType(v)
+	return _c
+}
+
+// SetNillableConnectivityType sets the "connectivity_type" field if the given value is not nil.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetNillableConnectivityType(v *string) *BronzeAWSEC2NATGatewayCreate {
+	if v != nil {
+		_c.SetConnectivityType(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetCreateTime(v time.Time) *BronzeAWSEC2NATGatewayCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetNillableCreateTime(v *time.Time) *BronzeAWSEC2NATGatewayCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetFailureCode sets the "failure_code" field.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetFailureCode(v string) *BronzeAWSEC2NATGatewayCreate {
+	_c.mutation.SetFailureCode(v)
+	return _c
+}
+
+// SetNillableFailureCode sets the "failure_code" field if the given value is not nil.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetNillableFailureCode(v *string) *BronzeAWSEC2NATGatewayCreate {
+	if v != nil {
+		_c.SetFailureCode(*v)
+	}
+	return _c
+}
+
+// SetFailureMessage sets the "failure_message" field.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetFailureMessage(v string) *BronzeAWSEC2NATGatewayCreate {
+	_c.mutation.SetFailureMessage(v)
+	return _c
+}
+
+// SetNillableFailureMessage sets the "failure_message" field if the given value is not nil.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetNillableFailureMessage(v *string) *BronzeAWSEC2NATGatewayCreate {
+	if v != nil {
+		_c.SetFailureMessage(*v)
+	}
+	return _c
+}
+
+// SetAddressesJSON sets the "addresses_json" field.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetAddressesJSON(v json.RawMessage) *BronzeAWSEC2NATGatewayCreate {
+	_c.mutation.SetAddressesJSON(v)
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2NATGatewayCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetAccountID(v string) *BronzeAWSEC2NATGatewayCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetRegion(v string) *BronzeAWSEC2NATGatewayCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeAWSEC2NATGatewayCreate) SetID(v string) *BronzeAWSEC2NATGatewayCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeAWSEC2NATGatewayMutation object of the builder.
+func (_c *BronzeAWSEC2NATGatewayCreate) Mutation() *BronzeAWSEC2NATGatewayMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeAWSEC2NATGateway in the database.
+func (_c *BronzeAWSEC2NATGatewayCreate) Save(ctx context.Context) (*BronzeAWSEC2NATGateway, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeAWSEC2NATGatewayCreate) SaveX(ctx context.Context) *BronzeAWSEC2NATGateway {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSEC2NATGatewayCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSEC2NATGatewayCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeAWSEC2NATGatewayCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`ec2: missing required field "BronzeAWSEC2NATGateway.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`ec2: missing required field "BronzeAWSEC2NATGateway.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ec2: missing required field "BronzeAWSEC2NATGateway.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzeawsec2natgateway.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2NATGateway.account_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`ec2: missing required field "BronzeAWSEC2NATGateway.region"`)}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := bronzeawsec2natgateway.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2NATGateway.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeAWSEC2NATGatewayCreate) sqlSave(ctx context.Context) (*BronzeAWSEC2NATGateway, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeAWSEC2NATGateway.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeAWSEC2NATGatewayCreate) createSpec() (*BronzeAWSEC2NATGateway, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeAWSEC2NATGateway{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzeawsec2natgateway.Table, sqlgraph.NewFieldSpec(bronzeawsec2natgateway.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeAWSEC2NATGateway
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.VpcID(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldVpcID, field.TypeString, value)
+		_node.VpcID = value
+	}
+	if value, ok := _c.mutation.SubnetID(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldSubnetID, field.TypeString, value)
+		_node.SubnetID = value
+	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	if value, ok := _c.mutation.ConnectivityType(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldConnectivityType, field.TypeString, value)
+		_node.ConnectivityType = value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.FailureCode(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldFailureCode, field.TypeString, value)
+		_node.FailureCode = value
+	}
+	if value, ok := _c.mutation.FailureMessage(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldFailureMessage, field.TypeString, value)
+		_node.FailureMessage = value
+	}
+	if value, ok := _c.mutation.AddressesJSON(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldAddressesJSON, field.TypeJSON, value)
+		_node.AddressesJSON = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	return _node, _spec
+}
+
+// BronzeAWSEC2NATGatewayCreateBulk is the builder for creating many BronzeAWSEC2NATGateway entities in bulk.
+type BronzeAWSEC2NATGatewayCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeAWSEC2NATGatewayCreate
+}
+
+// Save creates the BronzeAWSEC2NATGateway entities in the database.
+func (_c *BronzeAWSEC2NATGatewayCreateBulk) Save(ctx context.Context) ([]*BronzeAWSEC2NATGateway, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeAWSEC2NATGateway, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeAWSEC2NATGatewayMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeAWSEC2NATGatewayCreateBulk) SaveX(ctx context.Context) []*BronzeAWSEC2NATGateway {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSEC2NATGatewayCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSEC2NATGatewayCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2natgateway"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2NATGatewayDelete is the builder for deleting a BronzeAWSEC2NATGateway entity.
+type BronzeAWSEC2NATGatewayDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSEC2NATGatewayMutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2NATGatewayDelete builder.
+func (_d *BronzeAWSEC2NATGatewayDelete) Where(ps ...predicate.BronzeAWSEC2NATGateway) *BronzeAWSEC2NATGatewayDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeAWSEC2NATGatewayDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeAWSEC2NATGatewayDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeAWSEC2NATGatewayDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzeawsec2natgateway.Table, sqlgraph.NewFieldSpec(bronzeawsec2natgateway.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.BronzeAWSEC2NATGateway
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeAWSEC2NATGatewayDeleteOne is the builder for deleting a single BronzeAWSEC2NATGateway entity.
+type BronzeAWSEC2NATGatewayDeleteOne struct {
+	_d *BronzeAWSEC2NATGatewayDelete
+}
+
+// Where appends a list predicates to the BronzeAWSEC2NATGatewayDelete builder.
+func (_d *BronzeAWSEC2NATGatewayDeleteOne) Where(ps ...predicate.BronzeAWSEC2NATGateway) *BronzeAWSEC2NATGatewayDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeAWSEC2NATGatewayDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzeawsec2natgateway.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeAWSEC2NATGatewayDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
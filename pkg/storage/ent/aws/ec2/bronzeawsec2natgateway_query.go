@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2natgateway"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2NATGatewayQuery is the builder for querying BronzeAWSEC2NATGateway entities.
+type BronzeAWSEC2NATGatewayQuery struct {
+	config
+	ctx        *QueryContext
+	order      []bronzeawsec2natgateway.OrderOption
+	inters     []Interceptor
+	predicates []predicate.BronzeAWSEC2NATGateway
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BronzeAWSEC2NATGatewayQuery builder.
+func (_q *BronzeAWSEC2NATGatewayQuery) Where(ps ...predicate.BronzeAWSEC2NATGateway) *BronzeAWSEC2NATGatewayQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *BronzeAWSEC2NATGatewayQuery) Limit(limit int) *BronzeAWSEC2NATGatewayQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *BronzeAWSEC2NATGatewayQuery) Offset(offset int) *BronzeAWSEC2NATGatewayQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *BronzeAWSEC2NATGatewayQuery) Unique(unique bool) *BronzeAWSEC2NATGatewayQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *BronzeAWSEC2NATGatewayQuery) Order(o ...bronzeawsec2natgateway.OrderOption) *BronzeAWSEC2NATGatewayQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// First returns the first BronzeAWSEC2NATGateway entity from the query.
+// Returns a *NotFoundError when no BronzeAWSEC2NATGateway was found.
+func (_q *BronzeAWSEC2NATGatewayQuery) First(ctx context.Context) (*BronzeAWSEC2NATGateway, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{bronzeawsec2natgateway.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *BronzeAWSEC2NATGatewayQuery) FirstX(ctx context.Context) *BronzeAWSEC2NATGateway {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BronzeAWSEC2NATGateway ID from the query.
+// Returns a *NotFoundError when no BronzeAWSEC2NATGateway ID was found.
+func (_q *BronzeAWSEC2NATGatewayQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{bronzeawsec2natgateway.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *BronzeAWSEC2NATGatewayQuery) FirstIDX(ctx context.Context) string {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BronzeAWSEC2NATGateway entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BronzeAWSEC2NATGateway entity is found.
+// Returns a *NotFoundError when no BronzeAWSEC2NATGateway entities are found.
+func (_q *BronzeAWSEC2NATGatewayQuery) Only(ctx context.Context) (*BronzeAWSEC2NATGateway, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{bronzeawsec2natgateway.Label}
+	default:
+		return nil, &NotSingularError{bronzeawsec2natgateway.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *BronzeAWSEC2NATGatewayQuery) OnlyX(ctx context.Context) *BronzeAWSEC2NATGateway {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BronzeAWSEC2NATGateway ID in the query.
+// Returns a *NotSingularError when more than one BronzeAWSEC2NATGateway ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *BronzeAWSEC2NATGatewayQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{bronzeawsec2natgateway.Label}
+	default:
+		err = &NotSingularError{bronzeawsec2natgateway.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *BronzeAWSEC2NATGatewayQuery) OnlyIDX(ctx context.Context) string {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BronzeAWSEC2NATGateways.
+func (_q *BronzeAWSEC2NATGatewayQuery) All(ctx context.Context) ([]*BronzeAWSEC2NATGateway, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BronzeAWSEC2NATGateway, *BronzeAWSEC2NATGatewayQuery]()
+	return withInterceptors[[]*BronzeAWSEC2NATGateway](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *BronzeAWSEC2NATGatewayQuery) AllX(ctx context.Context) []*BronzeAWSEC2NATGateway {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BronzeAWSEC2NATGateway IDs.
+func (_q *BronzeAWSEC2NATGatewayQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(bronzeawsec2natgateway.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *BronzeAWSEC2NATGatewayQuery) IDsX(ctx context.Context) []string {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *BronzeAWSEC2NATGatewayQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*BronzeAWSEC2NATGatewayQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *BronzeAWSEC2NATGatewayQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *BronzeAWSEC2NATGatewayQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ec2: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *BronzeAWSEC2NATGatewayQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BronzeAWSEC2NATGatewayQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *BronzeAWSEC2NATGatewayQuery) Clone() *BronzeAWSEC2NATGatewayQuery {
+	if _q == nil {
+		return nil
+	}
+	return &BronzeAWSEC2NATGatewayQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]bronzeawsec2natgateway.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.BronzeAWSEC2NATGateway{}, _q.predicates...),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CollectedAt time.Time `json:"collected_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BronzeAWSEC2NATGateway.Query().
+//		GroupBy(bronzeawsec2natgateway.FieldCollectedAt).
+//		Aggregate(ec2.Count()).
+//		Scan(ctx, &v)
+func (_q *BronzeAWSEC2NATGatewayQuery) GroupBy(field string, fields ...string) *BronzeAWSEC2NATGatewayGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BronzeAWSEC2NATGatewayGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = bronzeawsec2natgateway.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CollectedAt time.Time `json:"collected_at,omitempty"`
+//	}
+//
+//	client.BronzeAWSEC2NATGateway.Query().
+//		Select(bronzeawsec2natgateway.FieldCollectedAt).
+//		Scan(ctx, &v)
+func (_q *BronzeAWSEC2NATGatewayQuery) Select(fields ...string) *BronzeAWSEC2NATGatewaySelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &BronzeAWSEC2NATGatewaySelect{BronzeAWSEC2NATGatewayQuery: _q}
+	sbuild.label = bronzeawsec2natgateway.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BronzeAWSEC2NATGatewaySelect configured with the given aggregations.
+func (_q *BronzeAWSEC2NATGatewayQuery) Aggregate(fns ...AggregateFunc) *BronzeAWSEC2NATGatewaySelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *BronzeAWSEC2NATGatewayQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ec2: uninitialized interceptor (forgotten import ec2/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !bronzeawsec2natgateway.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *BronzeAWSEC2NATGatewayQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BronzeAWSEC2NATGateway, error) {
+	var (
+		nodes = []*BronzeAWSEC2NATGateway{}
+		_spec = _q.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BronzeAWSEC2NATGateway).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BronzeAWSEC2NATGateway{config: _q.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = _q.schemaConfig.BronzeAWSEC2NATGateway
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (_q *BronzeAWSEC2NATGatewayQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Schema = _q.schemaConfig.BronzeAWSEC2NATGateway
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *BronzeAWSEC2NATGatewayQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(bronzeawsec2natgateway.Table, bronzeawsec2natgateway.Columns, sqlgraph.NewFieldSpec(bronzeawsec2natgateway.FieldID, field.TypeString))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawsec2natgateway.FieldID)
+		for i := range fields {
+			if fields[i] != bronzeawsec2natgateway.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *BronzeAWSEC2NATGatewayQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(bronzeawsec2natgateway.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = bronzeawsec2natgateway.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(_q.schemaConfig.BronzeAWSEC2NATGateway)
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	selector.WithContext(ctx)
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BronzeAWSEC2NATGatewayGroupBy is the group-by builder for BronzeAWSEC2NATGateway entities.
+type BronzeAWSEC2NATGatewayGroupBy struct {
+	selector
+	build *BronzeAWSEC2NATGatewayQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *BronzeAWSEC2NATGatewayGroupBy) Aggregate(fns ...AggregateFunc) *BronzeAWSEC2NATGatewayGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *BronzeAWSEC2NATGatewayGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BronzeAWSEC2NATGatewayQuery, *BronzeAWSEC2NATGatewayGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *BronzeAWSEC2NATGatewayGroupBy) sqlScan(ctx context.Context, root *BronzeAWSEC2NATGatewayQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BronzeAWSEC2NATGatewaySelect is the builder for selecting fields of BronzeAWSEC2NATGateway entities.
+type BronzeAWSEC2NATGatewaySelect struct {
+	*BronzeAWSEC2NATGatewayQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *BronzeAWSEC2NATGatewaySelect) Aggregate(fns ...AggregateFunc) *BronzeAWSEC2NATGatewaySelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *BronzeAWSEC2NATGatewaySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BronzeAWSEC2NATGatewayQuery, *BronzeAWSEC2NATGatewaySelect](ctx, _s.BronzeAWSEC2NATGatewayQuery, _s, _s.inters, v)
+}
+
+func (_s *BronzeAWSEC2NATGatewaySelect) sqlScan(ctx context.Context, root *BronzeAWSEC2NATGatewayQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
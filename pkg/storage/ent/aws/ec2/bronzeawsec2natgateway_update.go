@@ -0,0 +1,853 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2natgateway"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2NATGatewayUpdate is the builder for updating BronzeAWSEC2NATGateway entities.
+type BronzeAWSEC2NATGatewayUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSEC2NATGatewayMutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2NATGatewayUpdate builder.
+func (_u *BronzeAWSEC2NATGatewayUpdate) Where(ps ...predicate.BronzeAWSEC2NATGateway) *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetCollectedAt(v time.Time) *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetName(v string) *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetNillableName(v *string) *BronzeAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) ClearName() *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetVpcID(v string) *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetNillableVpcID(v *string) *BronzeAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) ClearVpcID() *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetSubnetID sets the "subnet_id" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetSubnetID(v string) *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.SetSubnetID(v)
+	return _u
+}
+
+// SetNillableSubnetID sets the "subnet_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetNillableSubnetID(v *string) *BronzeAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetSubnetID(*v)
+	}
+	return _u
+}
+
+// ClearSubnetID clears the value of the "subnet_id" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) ClearSubnetID() *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearSubnetID()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetState(v string) *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetNillableState(v *string) *BronzeAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) ClearState() *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetConnectivityType sets the "connectivity_type" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetConnectivityType(v string) *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.SetConnectivityType(v)
+	return _u
+}
+
+// SetNillableConnectivityType sets the "connectivity_type" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetNillableConnectivityType(v *string) *BronzeAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetConnectivityType(*v)
+	}
+	return _u
+}
+
+// ClearConnectivityType clears the value of the "connectivity_type" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) ClearConnectivityType() *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearConnectivityType()
+	return _u
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetCreateTime(v time.Time) *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.SetCreateTime(v)
+	return _u
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetNillableCreateTime(v *time.Time) *BronzeAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetCreateTime(*v)
+	}
+	return _u
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) ClearCreateTime() *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearCreateTime()
+	return _u
+}
+
+// SetFailureCode sets the "failure_code" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetFailureCode(v string) *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.SetFailureCode(v)
+	return _u
+}
+
+// SetNillableFailureCode sets the "failure_code" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetNillableFailureCode(v *string) *BronzeAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetFailureCode(*v)
+	}
+	return _u
+}
+
+// ClearFailureCode clears the value of the "failure_code" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) ClearFailureCode() *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearFailureCode()
+	return _u
+}
+
+// SetFailureMessage sets the "failure_message" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetFailureMessage(v string) *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.SetFailureMessage(v)
+	return _u
+}
+
+// SetNillableFailureMessage sets the "failure_message" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetNillableFailureMessage(v *string) *BronzeAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetFailureMessage(*v)
+	}
+	return _u
+}
+
+// ClearFailureMessage clears the value of the "failure_message" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) ClearFailureMessage() *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearFailureMessage()
+	return _u
+}
+
+// SetAddressesJSON sets the "addresses_json" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetAddressesJSON(v json.RawMessage) *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.SetAddressesJSON(v)
+	return _u
+}
+
+// AppendAddressesJSON appends value to the "addresses_json" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) AppendAddressesJSON(v json.RawMessage) *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.AppendAddressesJSON(v)
+	return _u
+}
+
+// ClearAddressesJSON clears the value of the "addresses_json" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) ClearAddressesJSON() *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearAddressesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) ClearTagsJSON() *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetAccountID(v string) *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetNillableAccountID(v *string) *BronzeAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetRegion(v string) *BronzeAWSEC2NATGatewayUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SetNillableRegion(v *string) *BronzeAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2NATGatewayMutation object of the builder.
+func (_u *BronzeAWSEC2NATGatewayUpdate) Mutation() *BronzeAWSEC2NATGatewayMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeAWSEC2NATGatewayUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2NATGatewayUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeAWSEC2NATGatewayUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2NATGatewayUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2NATGatewayUpdate) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2natgateway.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2NATGateway.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2natgateway.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2NATGateway.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2NATGatewayUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2natgateway.Table, bronzeawsec2natgateway.Columns, sqlgraph.NewFieldSpec(bronzeawsec2natgateway.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SubnetID(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldSubnetID, field.TypeString, value)
+	}
+	if _u.mutation.SubnetIDCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldSubnetID, field.TypeString)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldState, field.TypeString, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldState, field.TypeString)
+	}
+	if value, ok := _u.mutation.ConnectivityType(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldConnectivityType, field.TypeString, value)
+	}
+	if _u.mutation.ConnectivityTypeCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldConnectivityType, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateTime(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldCreateTime, field.TypeTime, value)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FailureCode(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldFailureCode, field.TypeString, value)
+	}
+	if _u.mutation.FailureCodeCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldFailureCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.FailureMessage(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldFailureMessage, field.TypeString, value)
+	}
+	if _u.mutation.FailureMessageCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldFailureMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.AddressesJSON(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldAddressesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAddressesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2natgateway.FieldAddressesJSON, value)
+		})
+	}
+	if _u.mutation.AddressesJSONCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldAddressesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2natgateway.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2NATGateway
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2natgateway.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeAWSEC2NATGatewayUpdateOne is the builder for updating a single BronzeAWSEC2NATGateway entity.
+type BronzeAWSEC2NATGatewayUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeAWSEC2NATGatewayMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetCollectedAt(v time.Time) *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetName(v string) *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetNillableName(v *string) *BronzeAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) ClearName() *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetVpcID(v string) *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetNillableVpcID(v *string) *BronzeAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) ClearVpcID() *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetSubnetID sets the "subnet_id" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetSubnetID(v string) *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetSubnetID(v)
+	return _u
+}
+
+// SetNillableSubnetID sets the "subnet_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetNillableSubnetID(v *string) *BronzeAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetSubnetID(*v)
+	}
+	return _u
+}
+
+// ClearSubnetID clears the value of the "subnet_id" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) ClearSubnetID() *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearSubnetID()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetState(v string) *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetNillableState(v *string) *BronzeAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) ClearState() *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetConnectivityType sets the "connectivity_type" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetConnectivityType(v string) *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetConnectivityType(v)
+	return _u
+}
+
+// SetNillableConnectivityType sets the "connectivity_type" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetNillableConnectivityType(v *string) *BronzeAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetConnectivityType(*v)
+	}
+	return _u
+}
+
+// ClearConnectivityType clears the value of the "connectivity_type" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) ClearConnectivityType() *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearConnectivityType()
+	return _u
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetCreateTime(v time.Time) *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetCreateTime(v)
+	return _u
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetNillableCreateTime(v *time.Time) *BronzeAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetCreateTime(*v)
+	}
+	return _u
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) ClearCreateTime() *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearCreateTime()
+	return _u
+}
+
+// SetFailureCode sets the "failure_code" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetFailureCode(v string) *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetFailureCode(v)
+	return _u
+}
+
+// SetNillableFailureCode sets the "failure_code" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetNillableFailureCode(v *string) *BronzeAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetFailureCode(*v)
+	}
+	return _u
+}
+
+// ClearFailureCode clears the value of the "failure_code" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) ClearFailureCode() *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearFailureCode()
+	return _u
+}
+
+// SetFailureMessage sets the "failure_message" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetFailureMessage(v string) *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetFailureMessage(v)
+	return _u
+}
+
+// SetNillableFailureMessage sets the "failure_message" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetNillableFailureMessage(v *string) *BronzeAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetFailureMessage(*v)
+	}
+	return _u
+}
+
+// ClearFailureMessage clears the value of the "failure_message" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) ClearFailureMessage() *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearFailureMessage()
+	return _u
+}
+
+// SetAddressesJSON sets the "addresses_json" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetAddressesJSON(v json.RawMessage) *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetAddressesJSON(v)
+	return _u
+}
+
+// AppendAddressesJSON appends value to the "addresses_json" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) AppendAddressesJSON(v json.RawMessage) *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.AppendAddressesJSON(v)
+	return _u
+}
+
+// ClearAddressesJSON clears the value of the "addresses_json" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) ClearAddressesJSON() *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearAddressesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) ClearTagsJSON() *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetAccountID(v string) *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetNillableAccountID(v *string) *BronzeAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetRegion(v string) *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SetNillableRegion(v *string) *BronzeAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2NATGatewayMutation object of the builder.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) Mutation() *BronzeAWSEC2NATGatewayMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2NATGatewayUpdate builder.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) Where(ps ...predicate.BronzeAWSEC2NATGateway) *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) Select(field string, fields ...string) *BronzeAWSEC2NATGatewayUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeAWSEC2NATGateway entity.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) Save(ctx context.Context) (*BronzeAWSEC2NATGateway, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) SaveX(ctx context.Context) *BronzeAWSEC2NATGateway {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2natgateway.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2NATGateway.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2natgateway.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2NATGateway.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2NATGatewayUpdateOne) sqlSave(ctx context.Context) (_node *BronzeAWSEC2NATGateway, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2natgateway.Table, bronzeawsec2natgateway.Columns, sqlgraph.NewFieldSpec(bronzeawsec2natgateway.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeAWSEC2NATGateway.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawsec2natgateway.FieldID)
+		for _, f := range fields {
+			if !bronzeawsec2natgateway.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzeawsec2natgateway.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SubnetID(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldSubnetID, field.TypeString, value)
+	}
+	if _u.mutation.SubnetIDCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldSubnetID, field.TypeString)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldState, field.TypeString, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldState, field.TypeString)
+	}
+	if value, ok := _u.mutation.ConnectivityType(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldConnectivityType, field.TypeString, value)
+	}
+	if _u.mutation.ConnectivityTypeCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldConnectivityType, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateTime(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldCreateTime, field.TypeTime, value)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FailureCode(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldFailureCode, field.TypeString, value)
+	}
+	if _u.mutation.FailureCodeCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldFailureCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.FailureMessage(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldFailureMessage, field.TypeString, value)
+	}
+	if _u.mutation.FailureMessageCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldFailureMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.AddressesJSON(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldAddressesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAddressesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2natgateway.FieldAddressesJSON, value)
+		})
+	}
+	if _u.mutation.AddressesJSONCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldAddressesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2natgateway.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2natgateway.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2natgateway.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2NATGateway
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeAWSEC2NATGateway{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2natgateway.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
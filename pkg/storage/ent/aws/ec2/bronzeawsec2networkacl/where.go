@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawsec2networkacl
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEQ(FieldName, v))
+}
+
+// VpcID applies equality check predicate on the "vpc_id" field. It's identical to VpcIDEQ.
+func VpcID(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEQ(FieldVpcID, v))
+}
+
+// IsDefault applies equality check predicate on the "is_default" field. It's identical to IsDefaultEQ.
+func IsDefault(v bool) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEQ(FieldRegion, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldContainsFold(FieldName, v))
+}
+
+// VpcIDEQ applies the EQ predicate on the "vpc_id" field.
+func VpcIDEQ(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEQ(FieldVpcID, v))
+}
+
+// VpcIDNEQ applies the NEQ predicate on the "vpc_id" field.
+func VpcIDNEQ(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNEQ(FieldVpcID, v))
+}
+
+// VpcIDIn applies the In predicate on the "vpc_id" field.
+func VpcIDIn(vs ...string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldIn(FieldVpcID, vs...))
+}
+
+// VpcIDNotIn applies the NotIn predicate on the "vpc_id" field.
+func VpcIDNotIn(vs ...string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNotIn(FieldVpcID, vs...))
+}
+
+// VpcIDGT applies the GT predicate on the "vpc_id" field.
+func VpcIDGT(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldGT(FieldVpcID, v))
+}
+
+// VpcIDGTE applies the GTE predicate on the "vpc_id" field.
+func VpcIDGTE(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldGTE(FieldVpcID, v))
+}
+
+// VpcIDLT applies the LT predicate on the "vpc_id" field.
+func VpcIDLT(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldLT(FieldVpcID, v))
+}
+
+// VpcIDLTE applies the LTE predicate on the "vpc_id" field.
+func VpcIDLTE(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldLTE(FieldVpcID, v))
+}
+
+// VpcIDContains applies the Contains predicate on the "vpc_id" field.
+func VpcIDContains(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldContains(FieldVpcID, v))
+}
+
+// VpcIDHasPrefix applies the HasPrefix predicate on the "vpc_id" field.
+func VpcIDHasPrefix(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldHasPrefix(FieldVpcID, v))
+}
+
+// VpcIDHasSuffix applies the HasSuffix predicate on the "vpc_id" field.
+func VpcIDHasSuffix(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldHasSuffix(FieldVpcID, v))
+}
+
+// VpcIDIsNil applies the IsNil predicate on the "vpc_id" field.
+func VpcIDIsNil() predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldIsNull(FieldVpcID))
+}
+
+// VpcIDNotNil applies the NotNil predicate on the "vpc_id" field.
+func VpcIDNotNil() predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNotNull(FieldVpcID))
+}
+
+// VpcIDEqualFold applies the EqualFold predicate on the "vpc_id" field.
+func VpcIDEqualFold(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEqualFold(FieldVpcID, v))
+}
+
+// VpcIDContainsFold applies the ContainsFold predicate on the "vpc_id" field.
+func VpcIDContainsFold(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldContainsFold(FieldVpcID, v))
+}
+
+// IsDefaultEQ applies the EQ predicate on the "is_default" field.
+func IsDefaultEQ(v bool) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// IsDefaultNEQ applies the NEQ predicate on the "is_default" field.
+func IsDefaultNEQ(v bool) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNEQ(FieldIsDefault, v))
+}
+
+// IsDefaultIsNil applies the IsNil predicate on the "is_default" field.
+func IsDefaultIsNil() predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldIsNull(FieldIsDefault))
+}
+
+// IsDefaultNotNil applies the NotNil predicate on the "is_default" field.
+func IsDefaultNotNil() predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNotNull(FieldIsDefault))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldContains(FieldOwnerID, v))
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldHasPrefix(FieldOwnerID, v))
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldHasSuffix(FieldOwnerID, v))
+}
+
+// OwnerIDIsNil applies the IsNil predicate on the "owner_id" field.
+func OwnerIDIsNil() predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldIsNull(FieldOwnerID))
+}
+
+// OwnerIDNotNil applies the NotNil predicate on the "owner_id" field.
+func OwnerIDNotNil() predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNotNull(FieldOwnerID))
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEqualFold(FieldOwnerID, v))
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldContainsFold(FieldOwnerID, v))
+}
+
+// EntriesJSONIsNil applies the IsNil predicate on the "entries_json" field.
+func EntriesJSONIsNil() predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldIsNull(FieldEntriesJSON))
+}
+
+// EntriesJSONNotNil applies the NotNil predicate on the "entries_json" field.
+func EntriesJSONNotNil() predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNotNull(FieldEntriesJSON))
+}
+
+// AssociationsJSONIsNil applies the IsNil predicate on the "associations_json" field.
+func AssociationsJSONIsNil() predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldIsNull(FieldAssociationsJSON))
+}
+
+// AssociationsJSONNotNil applies the NotNil predicate on the "associations_json" field.
+func AssociationsJSONNotNil() predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNotNull(FieldAssociationsJSON))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeAWSEC2NetworkACL) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeAWSEC2NetworkACL) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeAWSEC2NetworkACL) predicate.BronzeAWSEC2NetworkACL {
+	return predicate.BronzeAWSEC2NetworkACL(sql.NotPredicates(p))
+}
@@ -0,0 +1,348 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2networkacl"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2NetworkACLCreate is the builder for creating a BronzeAWSEC2NetworkACL entity.
+type BronzeAWSEC2NetworkACLCreate struct {
+	config
+	mutation *BronzeAWSEC2NetworkACLMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeAWSEC2NetworkACLCreate) SetCollectedAt(v time.Time) *BronzeAWSEC2NetworkACLCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeAWSEC2NetworkACLCreate) SetFirstCollectedAt(v time.Time) *BronzeAWSEC2NetworkACLCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeAWSEC2NetworkACLCreate) SetName(v string) *BronzeAWSEC2NetworkACLCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkACLCreate) SetNillableName(v *string) *BronzeAWSEC2NetworkACLCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_c *BronzeAWSEC2NetworkACLCreate) SetVpcID(v string) *BronzeAWSEC2NetworkACLCreate {
+	_c.mutation.SetVpcID(v)
+	return _c
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkACLCreate) SetNillableVpcID(v *string) *BronzeAWSEC2NetworkACLCreate {
+	if v != nil {
+		_c.SetVpcID(*v)
+	}
+	return _c
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_c *BronzeAWSEC2NetworkACLCreate) SetIsDefault(v bool) *BronzeAWSEC2NetworkACLCreate {
+	_c.mutation.SetIsDefault(v)
+	return _c
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkACLCreate) SetNillableIsDefault(v *bool) *BronzeAWSEC2NetworkACLCreate {
+	if v != nil {
+		_c.SetIsDefault(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *BronzeAWSEC2NetworkACLCreate) SetOwnerID(v string) *BronzeAWSEC2NetworkACLCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkACLCreate) SetNillableOwnerID(v *string) *BronzeAWSEC2NetworkACLCreate {
+	if v != nil {
+		_c.SetOwnerID(*v)
+	}
+	return _c
+}
+
+// SetEntriesJSON sets the "entries_json" field.
+func (_c *BronzeAWSEC2NetworkACLCreate) SetEntriesJSON(v json.RawMessage) *BronzeAWSEC2NetworkACLCreate {
+	_c.mutation.SetEntriesJSON(v)
+	return _c
+}
+
+// SetAssociationsJSON sets the "associations_json" field.
+func (_c *BronzeAWSEC2NetworkACLCreate) SetAssociationsJSON(v json.RawMessage) *BronzeAWSEC2NetworkACLCreate {
+	_c.mutation.SetAssociationsJSON(v)
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeAWSEC2NetworkACLCreate) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2NetworkACLCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeAWSEC2NetworkACLCreate) SetAccountID(v string) *BronzeAWSEC2NetworkACLCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BronzeAWSEC2NetworkACLCreate) SetRegion(v string) *BronzeAWSEC2NetworkACLCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeAWSEC2NetworkACLCreate) SetID(v string) *BronzeAWSEC2NetworkACLCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeAWSEC2NetworkACLMutation object of the builder.
+func (_c *BronzeAWSEC2NetworkACLCreate) Mutation() *BronzeAWSEC2NetworkACLMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeAWSEC2NetworkACL in the database.
+func (_c *BronzeAWSEC2NetworkACLCreate) Save(ctx context.Context) (*BronzeAWSEC2NetworkACL, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeAWSEC2NetworkACLCreate) SaveX(ctx context.Context) *BronzeAWSEC2NetworkACL {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSEC2NetworkACLCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSEC2NetworkACLCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeAWSEC2NetworkACLCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`ec2: missing required field "BronzeAWSEC2NetworkACL.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`ec2: missing required field "BronzeAWSEC2NetworkACL.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ec2: missing required field "BronzeAWSEC2NetworkACL.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzeawsec2networkacl.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2NetworkACL.account_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`ec2: missing required field "BronzeAWSEC2NetworkACL.region"`)}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := bronzeawsec2networkacl.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2NetworkACL.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeAWSEC2NetworkACLCreate) sqlSave(ctx context.Context) (*BronzeAWSEC2NetworkACL, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeAWSEC2NetworkACL.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeAWSEC2NetworkACLCreate) createSpec() (*BronzeAWSEC2NetworkACL, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeAWSEC2NetworkACL{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzeawsec2networkacl.Table, sqlgraph.NewFieldSpec(bronzeawsec2networkacl.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeAWSEC2NetworkACL
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.VpcID(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldVpcID, field.TypeString, value)
+		_node.VpcID = value
+	}
+	if value, ok := _c.mutation.IsDefault(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = value
+	}
+	if value, ok := _c.mutation.EntriesJSON(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldEntriesJSON, field.TypeJSON, value)
+		_node.EntriesJSON = value
+	}
+	if value, ok := _c.mutation.AssociationsJSON(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldAssociationsJSON, field.TypeJSON, value)
+		_node.AssociationsJSON = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	return _node, _spec
+}
+
+// BronzeAWSEC2NetworkACLCreateBulk is the builder for creating many BronzeAWSEC2NetworkACL entities in bulk.
+type BronzeAWSEC2NetworkACLCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeAWSEC2NetworkACLCreate
+}
+
+// Save creates the BronzeAWSEC2NetworkACL entities in the database.
+func (_c *BronzeAWSEC2NetworkACLCreateBulk) Save(ctx context.Context) ([]*BronzeAWSEC2NetworkACL, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeAWSEC2NetworkACL, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeAWSEC2NetworkACLMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeAWSEC2NetworkACLCreateBulk) SaveX(ctx context.Context) []*BronzeAWSEC2NetworkACL {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSEC2NetworkACLCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSEC2NetworkACLCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,703 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2networkacl"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2NetworkACLUpdate is the builder for updating BronzeAWSEC2NetworkACL entities.
+type BronzeAWSEC2NetworkACLUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSEC2NetworkACLMutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2NetworkACLUpdate builder.
+func (_u *BronzeAWSEC2NetworkACLUpdate) Where(ps ...predicate.BronzeAWSEC2NetworkACL) *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) SetCollectedAt(v time.Time) *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkACLUpdate) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2NetworkACLUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) SetName(v string) *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkACLUpdate) SetNillableName(v *string) *BronzeAWSEC2NetworkACLUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) ClearName() *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) SetVpcID(v string) *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkACLUpdate) SetNillableVpcID(v *string) *BronzeAWSEC2NetworkACLUpdate {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) ClearVpcID() *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) SetIsDefault(v bool) *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkACLUpdate) SetNillableIsDefault(v *bool) *BronzeAWSEC2NetworkACLUpdate {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// ClearIsDefault clears the value of the "is_default" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) ClearIsDefault() *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.ClearIsDefault()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) SetOwnerID(v string) *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkACLUpdate) SetNillableOwnerID(v *string) *BronzeAWSEC2NetworkACLUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) ClearOwnerID() *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetEntriesJSON sets the "entries_json" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) SetEntriesJSON(v json.RawMessage) *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.SetEntriesJSON(v)
+	return _u
+}
+
+// AppendEntriesJSON appends value to the "entries_json" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) AppendEntriesJSON(v json.RawMessage) *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.AppendEntriesJSON(v)
+	return _u
+}
+
+// ClearEntriesJSON clears the value of the "entries_json" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) ClearEntriesJSON() *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.ClearEntriesJSON()
+	return _u
+}
+
+// SetAssociationsJSON sets the "associations_json" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) SetAssociationsJSON(v json.RawMessage) *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.SetAssociationsJSON(v)
+	return _u
+}
+
+// AppendAssociationsJSON appends value to the "associations_json" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) AppendAssociationsJSON(v json.RawMessage) *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.AppendAssociationsJSON(v)
+	return _u
+}
+
+// ClearAssociationsJSON clears the value of the "associations_json" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) ClearAssociationsJSON() *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.ClearAssociationsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) ClearTagsJSON() *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) SetAccountID(v string) *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkACLUpdate) SetNillableAccountID(v *string) *BronzeAWSEC2NetworkACLUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2NetworkACLUpdate) SetRegion(v string) *BronzeAWSEC2NetworkACLUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkACLUpdate) SetNillableRegion(v *string) *BronzeAWSEC2NetworkACLUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2NetworkACLMutation object of the builder.
+func (_u *BronzeAWSEC2NetworkACLUpdate) Mutation() *BronzeAWSEC2NetworkACLMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeAWSEC2NetworkACLUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2NetworkACLUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeAWSEC2NetworkACLUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2NetworkACLUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2NetworkACLUpdate) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2networkacl.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2NetworkACL.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2networkacl.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2NetworkACL.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2NetworkACLUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2networkacl.Table, bronzeawsec2networkacl.Columns, sqlgraph.NewFieldSpec(bronzeawsec2networkacl.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzeawsec2networkacl.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzeawsec2networkacl.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldIsDefault, field.TypeBool, value)
+	}
+	if _u.mutation.IsDefaultCleared() {
+		_spec.ClearField(bronzeawsec2networkacl.FieldIsDefault, field.TypeBool)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzeawsec2networkacl.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.EntriesJSON(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldEntriesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEntriesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2networkacl.FieldEntriesJSON, value)
+		})
+	}
+	if _u.mutation.EntriesJSONCleared() {
+		_spec.ClearField(bronzeawsec2networkacl.FieldEntriesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AssociationsJSON(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2networkacl.FieldAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.AssociationsJSONCleared() {
+		_spec.ClearField(bronzeawsec2networkacl.FieldAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2networkacl.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2networkacl.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2NetworkACL
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2networkacl.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeAWSEC2NetworkACLUpdateOne is the builder for updating a single BronzeAWSEC2NetworkACL entity.
+type BronzeAWSEC2NetworkACLUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeAWSEC2NetworkACLMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) SetCollectedAt(v time.Time) *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2NetworkACLUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) SetName(v string) *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) SetNillableName(v *string) *BronzeAWSEC2NetworkACLUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) ClearName() *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) SetVpcID(v string) *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) SetNillableVpcID(v *string) *BronzeAWSEC2NetworkACLUpdateOne {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) ClearVpcID() *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) SetIsDefault(v bool) *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) SetNillableIsDefault(v *bool) *BronzeAWSEC2NetworkACLUpdateOne {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// ClearIsDefault clears the value of the "is_default" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) ClearIsDefault() *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.ClearIsDefault()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) SetOwnerID(v string) *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) SetNillableOwnerID(v *string) *BronzeAWSEC2NetworkACLUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) ClearOwnerID() *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetEntriesJSON sets the "entries_json" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) SetEntriesJSON(v json.RawMessage) *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetEntriesJSON(v)
+	return _u
+}
+
+// AppendEntriesJSON appends value to the "entries_json" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) AppendEntriesJSON(v json.RawMessage) *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.AppendEntriesJSON(v)
+	return _u
+}
+
+// ClearEntriesJSON clears the value of the "entries_json" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) ClearEntriesJSON() *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.ClearEntriesJSON()
+	return _u
+}
+
+// SetAssociationsJSON sets the "associations_json" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) SetAssociationsJSON(v json.RawMessage) *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetAssociationsJSON(v)
+	return _u
+}
+
+// AppendAssociationsJSON appends value to the "associations_json" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) AppendAssociationsJSON(v json.RawMessage) *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.AppendAssociationsJSON(v)
+	return _u
+}
+
+// ClearAssociationsJSON clears the value of the "associations_json" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) ClearAssociationsJSON() *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.ClearAssociationsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) ClearTagsJSON() *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) SetAccountID(v string) *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) SetNillableAccountID(v *string) *BronzeAWSEC2NetworkACLUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) SetRegion(v string) *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) SetNillableRegion(v *string) *BronzeAWSEC2NetworkACLUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2NetworkACLMutation object of the builder.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) Mutation() *BronzeAWSEC2NetworkACLMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2NetworkACLUpdate builder.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) Where(ps ...predicate.BronzeAWSEC2NetworkACL) *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) Select(field string, fields ...string) *BronzeAWSEC2NetworkACLUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeAWSEC2NetworkACL entity.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) Save(ctx context.Context) (*BronzeAWSEC2NetworkACL, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) SaveX(ctx context.Context) *BronzeAWSEC2NetworkACL {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2networkacl.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2NetworkACL.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2networkacl.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2NetworkACL.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2NetworkACLUpdateOne) sqlSave(ctx context.Context) (_node *BronzeAWSEC2NetworkACL, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2networkacl.Table, bronzeawsec2networkacl.Columns, sqlgraph.NewFieldSpec(bronzeawsec2networkacl.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeAWSEC2NetworkACL.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawsec2networkacl.FieldID)
+		for _, f := range fields {
+			if !bronzeawsec2networkacl.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzeawsec2networkacl.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzeawsec2networkacl.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzeawsec2networkacl.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldIsDefault, field.TypeBool, value)
+	}
+	if _u.mutation.IsDefaultCleared() {
+		_spec.ClearField(bronzeawsec2networkacl.FieldIsDefault, field.TypeBool)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzeawsec2networkacl.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.EntriesJSON(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldEntriesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEntriesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2networkacl.FieldEntriesJSON, value)
+		})
+	}
+	if _u.mutation.EntriesJSONCleared() {
+		_spec.ClearField(bronzeawsec2networkacl.FieldEntriesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AssociationsJSON(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2networkacl.FieldAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.AssociationsJSONCleared() {
+		_spec.ClearField(bronzeawsec2networkacl.FieldAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2networkacl.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2networkacl.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2networkacl.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2NetworkACL
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeAWSEC2NetworkACL{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2networkacl.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
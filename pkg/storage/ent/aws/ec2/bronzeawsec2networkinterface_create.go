@@ -0,0 +1,610 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2networkinterface"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2NetworkInterfaceCreate is the builder for creating a BronzeAWSEC2NetworkInterface entity.
+type BronzeAWSEC2NetworkInterfaceCreate struct {
+	config
+	mutation *BronzeAWSEC2NetworkInterfaceMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetCollectedAt(v time.Time) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetFirstCollectedAt(v time.Time) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetDescription(v string) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetNillableDescription(v *string) *BronzeAWSEC2NetworkInterfaceCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetInterfaceType sets the "interface_type" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetInterfaceType(v string) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetInterfaceType(v)
+	return _c
+}
+
+// SetNillableInterfaceType sets the "interface_type" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetNillableInterfaceType(v *string) *BronzeAWSEC2NetworkInterfaceCreate {
+	if v != nil {
+		_c.SetInterfaceType(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetStatus(v string) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetNillableStatus(v *string) *BronzeAWSEC2NetworkInterfaceCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetVpcID(v string) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetVpcID(v)
+	return _c
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetNillableVpcID(v *string) *BronzeAWSEC2NetworkInterfaceCreate {
+	if v != nil {
+		_c.SetVpcID(*v)
+	}
+	return _c
+}
+
+// SetSubnetID sets the "subnet_id" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetSubnetID(v string) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetSubnetID(v)
+	return _c
+}
+
+// SetNillableSubnetID sets the "subnet_id" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetNillableSubnetID(v *string) *BronzeAWSEC2NetworkInterfaceCreate {
+	if v != nil {
+		_c.SetSubnetID(*v)
+	}
+	return _c
+}
+
+// SetAvailabilityZone sets the "availability_zone" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetAvailabilityZone(v string) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetAvailabilityZone(v)
+	return _c
+}
+
+// SetNillableAvailabilityZone sets the "availability_zone" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetNillableAvailabilityZone(v *string) *BronzeAWSEC2NetworkInterfaceCreate {
+	if v != nil {
+		_c.SetAvailabilityZone(*v)
+	}
+	return _c
+}
+
+// SetMACAddress sets the "mac_address" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetMACAddress(v string) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetMACAddress(v)
+	return _c
+}
+
+// SetNillableMACAddress sets the "mac_address" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetNillableMACAddress(v *string) *BronzeAWSEC2NetworkInterfaceCreate {
+	if v != nil {
+		_c.SetMACAddress(*v)
+	}
+	return _c
+}
+
+// SetPrivateIPAddress sets the "private_ip_address" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetPrivateIPAddress(v string) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetPrivateIPAddress(v)
+	return _c
+}
+
+// SetNillablePrivateIPAddress sets the "private_ip_address" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetNillablePrivateIPAddress(v *string) *BronzeAWSEC2NetworkInterfaceCreate {
+	if v != nil {
+		_c.SetPrivateIPAddress(*v)
+	}
+	return _c
+}
+
+// SetPrivateDNSName sets the "private_dns_name" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetPrivateDNSName(v string) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetPrivateDNSName(v)
+	return _c
+}
+
+// SetNillablePrivateDNSName sets the "private_dns_name" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetNillablePrivateDNSName(v *string) *BronzeAWSEC2NetworkInterfaceCreate {
+	if v != nil {
+		_c.SetPrivateDNSName(*v)
+	}
+	return _c
+}
+
+// SetPublicIP sets the "public_ip" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetPublicIP(v string) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetPublicIP(v)
+	return _c
+}
+
+// SetNillablePublicIP sets the "public_ip" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetNillablePublicIP(v *string) *BronzeAWSEC2NetworkInterfaceCreate {
+	if v != nil {
+		_c.SetPublicIP(*v)
+	}
+	return _c
+}
+
+// SetPublicDNSName sets the "public_dns_name" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetPublicDNSName(v string) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetPublicDNSName(v)
+	return _c
+}
+
+// SetNillablePublicDNSName sets the "public_dns_name" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetNillablePublicDNSName(v *string) *BronzeAWSEC2NetworkInterfaceCreate {
+	if v != nil {
+		_c.SetPublicDNSName(*v)
+	}
+	return _c
+}
+
+// SetSourceDestCheck sets the "source_dest_check" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetSourceDestCheck(v bool) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetSourceDestCheck(v)
+	return _c
+}
+
+// SetNillableSourceDestCheck sets the "source_dest_check" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetNillableSourceDestCheck(v *bool) *BronzeAWSEC2NetworkInterfaceCreate {
+	if v != nil {
+		_c.SetSourceDestCheck(*v)
+	}
+	return _c
+}
+
+// SetRequesterManaged sets the "requester_managed" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetRequesterManaged(v bool) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetRequesterManaged(v)
+	return _c
+}
+
+// SetNillableRequesterManaged sets the "requester_managed" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetNillableRequesterManaged(v *bool) *BronzeAWSEC2NetworkInterfaceCreate {
+	if v != nil {
+		_c.SetRequesterManaged(*v)
+	}
+	return _c
+}
+
+// SetRequesterID sets the "requester_id" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetRequesterID(v string) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetRequesterID(v)
+	return _c
+}
+
+// SetNillableRequesterID sets the "requester_id" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetNillableRequesterID(v *string) *BronzeAWSEC2NetworkInterfaceCreate {
+	if v != nil {
+		_c.SetRequesterID(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetOwnerID(v string) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetNillableOwnerID(v *string) *BronzeAWSEC2NetworkInterfaceCreate {
+	if v != nil {
+		_c.SetOwnerID(*v)
+	}
+	return _c
+}
+
+// SetAttachmentID sets the "attachment_id" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetAttachmentID(v string) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetAttachmentID(v)
+	return _c
+}
+
+// SetNillableAttachmentID sets the "attachment_id" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetNillableAttachmentID(v *string) *BronzeAWSEC2NetworkInterfaceCreate {
+	if v != nil {
+		_c.SetAttachmentID(*v)
+	}
+	return _c
+}
+
+// SetAttachmentInstanceID sets the "attachment_instance_id" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetAttachmentInstanceID(v string) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetAttachmentInstanceID(v)
+	return _c
+}
+
+// SetNillableAttachmentInstanceID sets the "attachment_instance_id" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetNillableAttachmentInstanceID(v *string) *BronzeAWSEC2NetworkInterfaceCreate {
+	if v != nil {
+		_c.SetAttachmentInstanceID(*v)
+	}
+	return _c
+}
+
+// SetAttachmentStatus sets the "attachment_status" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetAttachmentStatus(v string) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetAttachmentStatus(v)
+	return _c
+}
+
+// SetNillableAttachmentStatus sets the "attachment_status" field if the given value is not nil.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetNillableAttachmentStatus(v *string) *BronzeAWSEC2NetworkInterfaceCreate {
+	if v != nil {
+		_c.SetAttachmentStatus(*v)
+	}
+	return _c
+}
+
+// SetGroupsJSON sets the "groups_json" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetGroupsJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetGroupsJSON(v)
+	return _c
+}
+
+// SetPrivateIPAddressesJSON sets the "private_ip_addresses_json" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetPrivateIPAddressesJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetPrivateIPAddressesJSON(v)
+	return _c
+}
+
+// SetIpv6AddressesJSON sets the "ipv6_addresses_json" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetIpv6AddressesJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetIpv6AddressesJSON(v)
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetAccountID(v string) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetRegion(v string) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SetID(v string) *BronzeAWSEC2NetworkInterfaceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeAWSEC2NetworkInterfaceMutation object of the builder.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) Mutation() *BronzeAWSEC2NetworkInterfaceMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeAWSEC2NetworkInterface in the database.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) Save(ctx context.Context) (*BronzeAWSEC2NetworkInterface, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) SaveX(ctx context.Context) *BronzeAWSEC2NetworkInterface {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`ec2: missing required field "BronzeAWSEC2NetworkInterface.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`ec2: missing required field "BronzeAWSEC2NetworkInterface.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ec2: missing required field "BronzeAWSEC2NetworkInterface.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzeawsec2networkinterface.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2NetworkInterface.account_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`ec2: missing required field "BronzeAWSEC2NetworkInterface.region"`)}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := bronzeawsec2networkinterface.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2NetworkInterface.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) sqlSave(ctx context.Context) (*BronzeAWSEC2NetworkInterface, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeAWSEC2NetworkInterface.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeAWSEC2NetworkInterfaceCreate) createSpec() (*BronzeAWSEC2NetworkInterface, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeAWSEC2NetworkInterface{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzeawsec2networkinterface.Table, sqlgraph.NewFieldSpec(bronzeawsec2networkinterface.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeAWSEC2NetworkInterface
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.InterfaceType(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldInterfaceType, field.TypeString, value)
+		_node.InterfaceType = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.VpcID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldVpcID, field.TypeString, value)
+		_node.VpcID = value
+	}
+	if value, ok := _c.mutation.SubnetID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldSubnetID, field.TypeString, value)
+		_node.SubnetID = value
+	}
+	if value, ok := _c.mutation.AvailabilityZone(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldAvailabilityZone, field.TypeString, value)
+		_node.AvailabilityZone = value
+	}
+	if value, ok := _c.mutation.MACAddress(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldMACAddress, field.TypeString, value)
+		_node.MACAddress = value
+	}
+	if value, ok := _c.mutation.PrivateIPAddress(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldPrivateIPAddress, field.TypeString, value)
+		_node.PrivateIPAddress = value
+	}
+	if value, ok := _c.mutation.PrivateDNSName(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldPrivateDNSName, field.TypeString, value)
+		_node.PrivateDNSName = value
+	}
+	if value, ok := _c.mutation.PublicIP(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldPublicIP, field.TypeString, value)
+		_node.PublicIP = value
+	}
+	if value, ok := _c.mutation.PublicDNSName(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldPublicDNSName, field.TypeString, value)
+		_node.PublicDNSName = value
+	}
+	if value, ok := _c.mutation.SourceDestCheck(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldSourceDestCheck, field.TypeBool, value)
+		_node.SourceDestCheck = value
+	}
+	if value, ok := _c.mutation.RequesterManaged(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldRequesterManaged, field.TypeBool, value)
+		_node.RequesterManaged = value
+	}
+	if value, ok := _c.mutation.RequesterID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldRequesterID, field.TypeString, value)
+		_node.RequesterID = value
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = value
+	}
+	if value, ok := _c.mutation.AttachmentID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldAttachmentID, field.TypeString, value)
+		_node.AttachmentID = value
+	}
+	if value, ok := _c.mutation.AttachmentInstanceID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldAttachmentInstanceID, field.TypeString, value)
+		_node.AttachmentInstanceID = value
+	}
+	if value, ok := _c.mutation.AttachmentStatus(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldAttachmentStatus, field.TypeString, value)
+		_node.AttachmentStatus = value
+	}
+	if value, ok := _c.mutation.GroupsJSON(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldGroupsJSON, field.TypeJSON, value)
+		_node.GroupsJSON = value
+	}
+	if value, ok := _c.mutation.PrivateIPAddressesJSON(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldPrivateIPAddressesJSON, field.TypeJSON, value)
+		_node.PrivateIPAddressesJSON = value
+	}
+	if value, ok := _c.mutation.Ipv6AddressesJSON(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldIpv6AddressesJSON, field.TypeJSON, value)
+		_node.Ipv6AddressesJSON = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	return _node, _spec
+}
+
+// BronzeAWSEC2NetworkInterfaceCreateBulk is the builder for creating many BronzeAWSEC2NetworkInterface entities in bulk.
+type BronzeAWSEC2NetworkInterfaceCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeAWSEC2NetworkInterfaceCreate
+}
+
+// Save creates the BronzeAWSEC2NetworkInterface entities in the database.
+func (_c *BronzeAWSEC2NetworkInterfaceCreateBulk) Save(ctx context.Context) ([]*BronzeAWSEC2NetworkInterface, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeAWSEC2NetworkInterface, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeAWSEC2NetworkInterfaceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeAWSEC2NetworkInterfaceCreateBulk) SaveX(ctx context.Context) []*BronzeAWSEC2NetworkInterface {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSEC2NetworkInterfaceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSEC2NetworkInterfaceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
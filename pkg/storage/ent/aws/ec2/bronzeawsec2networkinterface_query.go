@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2networkinterface"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2NetworkInterfaceQuery is the builder for querying BronzeAWSEC2NetworkInterface entities.
+type BronzeAWSEC2NetworkInterfaceQuery struct {
+	config
+	ctx        *QueryContext
+	order      []bronzeawsec2networkinterface.OrderOption
+	inters     []Interceptor
+	predicates []predicate.BronzeAWSEC2NetworkInterface
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BronzeAWSEC2NetworkInterfaceQuery builder.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) Where(ps ...predicate.BronzeAWSEC2NetworkInterface) *BronzeAWSEC2NetworkInterfaceQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) Limit(limit int) *BronzeAWSEC2NetworkInterfaceQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) Offset(offset int) *BronzeAWSEC2NetworkInterfaceQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) Unique(unique bool) *BronzeAWSEC2NetworkInterfaceQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) Order(o ...bronzeawsec2networkinterface.OrderOption) *BronzeAWSEC2NetworkInterfaceQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// First returns the first BronzeAWSEC2NetworkInterface entity from the query.
+// Returns a *NotFoundError when no BronzeAWSEC2NetworkInterface was found.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) First(ctx context.Context) (*BronzeAWSEC2NetworkInterface, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{bronzeawsec2networkinterface.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) FirstX(ctx context.Context) *BronzeAWSEC2NetworkInterface {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BronzeAWSEC2NetworkInterface ID from the query.
+// Returns a *NotFoundError when no BronzeAWSEC2NetworkInterface ID was found.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{bronzeawsec2networkinterface.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) FirstIDX(ctx context.Context) string {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BronzeAWSEC2NetworkInterface entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BronzeAWSEC2NetworkInterface entity is found.
+// Returns a *NotFoundError when no BronzeAWSEC2NetworkInterface entities are found.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) Only(ctx context.Context) (*BronzeAWSEC2NetworkInterface, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{bronzeawsec2networkinterface.Label}
+	default:
+		return nil, &NotSingularError{bronzeawsec2networkinterface.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) OnlyX(ctx context.Context) *BronzeAWSEC2NetworkInterface {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BronzeAWSEC2NetworkInterface ID in the query.
+// Returns a *NotSingularError when more than one BronzeAWSEC2NetworkInterface ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{bronzeawsec2networkinterface.Label}
+	default:
+		err = &NotSingularError{bronzeawsec2networkinterface.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) OnlyIDX(ctx context.Context) string {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BronzeAWSEC2NetworkInterfaces.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) All(ctx context.Context) ([]*BronzeAWSEC2NetworkInterface, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BronzeAWSEC2NetworkInterface, *BronzeAWSEC2NetworkInterfaceQuery]()
+	return withInterceptors[[]*BronzeAWSEC2NetworkInterface](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) AllX(ctx context.Context) []*BronzeAWSEC2NetworkInterface {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BronzeAWSEC2NetworkInterface IDs.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(bronzeawsec2networkinterface.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) IDsX(ctx context.Context) []string {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*BronzeAWSEC2NetworkInterfaceQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ec2: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BronzeAWSEC2NetworkInterfaceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) Clone() *BronzeAWSEC2NetworkInterfaceQuery {
+	if _q == nil {
+		return nil
+	}
+	return &BronzeAWSEC2NetworkInterfaceQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]bronzeawsec2networkinterface.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.BronzeAWSEC2NetworkInterface{}, _q.predicates...),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CollectedAt time.Time `json:"collected_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BronzeAWSEC2NetworkInterface.Query().
+//		GroupBy(bronzeawsec2networkinterface.FieldCollectedAt).
+//		Aggregate(ec2.Count()).
+//		Scan(ctx, &v)
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) GroupBy(field string, fields ...string) *BronzeAWSEC2NetworkInterfaceGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BronzeAWSEC2NetworkInterfaceGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = bronzeawsec2networkinterface.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CollectedAt time.Time `json:"collected_at,omitempty"`
+//	}
+//
+//	client.BronzeAWSEC2NetworkInterface.Query().
+//		Select(bronzeawsec2networkinterface.FieldCollectedAt).
+//		Scan(ctx, &v)
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) Select(fields ...string) *BronzeAWSEC2NetworkInterfaceSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &BronzeAWSEC2NetworkInterfaceSelect{BronzeAWSEC2NetworkInterfaceQuery: _q}
+	sbuild.label = bronzeawsec2networkinterface.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BronzeAWSEC2NetworkInterfaceSelect configured with the given aggregations.
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) Aggregate(fns ...AggregateFunc) *BronzeAWSEC2NetworkInterfaceSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ec2: uninitialized interceptor (forgotten import ec2/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !bronzeawsec2networkinterface.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BronzeAWSEC2NetworkInterface, error) {
+	var (
+		nodes = []*BronzeAWSEC2NetworkInterface{}
+		_spec = _q.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BronzeAWSEC2NetworkInterface).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BronzeAWSEC2NetworkInterface{config: _q.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = _q.schemaConfig.BronzeAWSEC2NetworkInterface
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Schema = _q.schemaConfig.BronzeAWSEC2NetworkInterface
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(bronzeawsec2networkinterface.Table, bronzeawsec2networkinterface.Columns, sqlgraph.NewFieldSpec(bronzeawsec2networkinterface.FieldID, field.TypeString))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawsec2networkinterface.FieldID)
+		for i := range fields {
+			if fields[i] != bronzeawsec2networkinterface.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *BronzeAWSEC2NetworkInterfaceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(bronzeawsec2networkinterface.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = bronzeawsec2networkinterface.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(_q.schemaConfig.BronzeAWSEC2NetworkInterface)
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	selector.WithContext(ctx)
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BronzeAWSEC2NetworkInterfaceGroupBy is the group-by builder for BronzeAWSEC2NetworkInterface entities.
+type BronzeAWSEC2NetworkInterfaceGroupBy struct {
+	selector
+	build *BronzeAWSEC2NetworkInterfaceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *BronzeAWSEC2NetworkInterfaceGroupBy) Aggregate(fns ...AggregateFunc) *BronzeAWSEC2NetworkInterfaceGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *BronzeAWSEC2NetworkInterfaceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BronzeAWSEC2NetworkInterfaceQuery, *BronzeAWSEC2NetworkInterfaceGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *BronzeAWSEC2NetworkInterfaceGroupBy) sqlScan(ctx context.Context, root *BronzeAWSEC2NetworkInterfaceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BronzeAWSEC2NetworkInterfaceSelect is the builder for selecting fields of BronzeAWSEC2NetworkInterface entities.
+type BronzeAWSEC2NetworkInterfaceSelect struct {
+	*BronzeAWSEC2NetworkInterfaceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *BronzeAWSEC2NetworkInterfaceSelect) Aggregate(fns ...AggregateFunc) *BronzeAWSEC2NetworkInterfaceSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *BronzeAWSEC2NetworkInterfaceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BronzeAWSEC2NetworkInterfaceQuery, *BronzeAWSEC2NetworkInterfaceSelect](ctx, _s.BronzeAWSEC2NetworkInterfaceQuery, _s, _s.inters, v)
+}
+
+func (_s *BronzeAWSEC2NetworkInterfaceSelect) sqlScan(ctx context.Context, root *BronzeAWSEC2NetworkInterfaceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
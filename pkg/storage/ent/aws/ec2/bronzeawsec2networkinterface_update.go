@@ -0,0 +1,1489 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2networkinterface"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2NetworkInterfaceUpdate is the builder for updating BronzeAWSEC2NetworkInterface entities.
+type BronzeAWSEC2NetworkInterfaceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSEC2NetworkInterfaceMutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2NetworkInterfaceUpdate builder.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) Where(ps ...predicate.BronzeAWSEC2NetworkInterface) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetCollectedAt(v time.Time) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetDescription(v string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillableDescription(v *string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearDescription() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetInterfaceType sets the "interface_type" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetInterfaceType(v string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetInterfaceType(v)
+	return _u
+}
+
+// SetNillableInterfaceType sets the "interface_type" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillableInterfaceType(v *string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetInterfaceType(*v)
+	}
+	return _u
+}
+
+// ClearInterfaceType clears the value of the "interface_type" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearInterfaceType() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearInterfaceType()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetStatus(v string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillableStatus(v *string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearStatus() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetVpcID(v string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillableVpcID(v *string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearVpcID() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetSubnetID sets the "subnet_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetSubnetID(v string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetSubnetID(v)
+	return _u
+}
+
+// SetNillableSubnetID sets the "subnet_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillableSubnetID(v *string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetSubnetID(*v)
+	}
+	return _u
+}
+
+// ClearSubnetID clears the value of the "subnet_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearSubnetID() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearSubnetID()
+	return _u
+}
+
+// SetAvailabilityZone sets the "availability_zone" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetAvailabilityZone(v string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetAvailabilityZone(v)
+	return _u
+}
+
+// SetNillableAvailabilityZone sets the "availability_zone" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillableAvailabilityZone(v *string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetAvailabilityZone(*v)
+	}
+	return _u
+}
+
+// ClearAvailabilityZone clears the value of the "availability_zone" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearAvailabilityZone() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearAvailabilityZone()
+	return _u
+}
+
+// SetMACAddress sets the "mac_address" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetMACAddress(v string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetMACAddress(v)
+	return _u
+}
+
+// SetNillableMACAddress sets the "mac_address" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillableMACAddress(v *string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetMACAddress(*v)
+	}
+	return _u
+}
+
+// ClearMACAddress clears the value of the "mac_address" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearMACAddress() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearMACAddress()
+	return _u
+}
+
+// SetPrivateIPAddress sets the "private_ip_address" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetPrivateIPAddress(v string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetPrivateIPAddress(v)
+	return _u
+}
+
+// SetNillablePrivateIPAddress sets the "private_ip_address" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillablePrivateIPAddress(v *string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetPrivateIPAddress(*v)
+	}
+	return _u
+}
+
+// ClearPrivateIPAddress clears the value of the "private_ip_address" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearPrivateIPAddress() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearPrivateIPAddress()
+	return _u
+}
+
+// SetPrivateDNSName sets the "private_dns_name" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetPrivateDNSName(v string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetPrivateDNSName(v)
+	return _u
+}
+
+// SetNillablePrivateDNSName sets the "private_dns_name" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillablePrivateDNSName(v *string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetPrivateDNSName(*v)
+	}
+	return _u
+}
+
+// ClearPrivateDNSName clears the value of the "private_dns_name" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearPrivateDNSName() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearPrivateDNSName()
+	return _u
+}
+
+// SetPublicIP sets the "public_ip" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetPublicIP(v string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetPublicIP(v)
+	return _u
+}
+
+// SetNillablePublicIP sets the "public_ip" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillablePublicIP(v *string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetPublicIP(*v)
+	}
+	return _u
+}
+
+// ClearPublicIP clears the value of the "public_ip" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearPublicIP() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearPublicIP()
+	return _u
+}
+
+// SetPublicDNSName sets the "public_dns_name" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetPublicDNSName(v string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetPublicDNSName(v)
+	return _u
+}
+
+// SetNillablePublicDNSName sets the "public_dns_name" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillablePublicDNSName(v *string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetPublicDNSName(*v)
+	}
+	return _u
+}
+
+// ClearPublicDNSName clears the value of the "public_dns_name" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearPublicDNSName() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearPublicDNSName()
+	return _u
+}
+
+// SetSourceDestCheck sets the "source_dest_check" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetSourceDestCheck(v bool) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetSourceDestCheck(v)
+	return _u
+}
+
+// SetNillableSourceDestCheck sets the "source_dest_check" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillableSourceDestCheck(v *bool) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetSourceDestCheck(*v)
+	}
+	return _u
+}
+
+// ClearSourceDestCheck clears the value of the "source_dest_check" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearSourceDestCheck() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearSourceDestCheck()
+	return _u
+}
+
+// SetRequesterManaged sets the "requester_managed" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetRequesterManaged(v bool) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetRequesterManaged(v)
+	return _u
+}
+
+// SetNillableRequesterManaged sets the "requester_managed" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillableRequesterManaged(v *bool) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetRequesterManaged(*v)
+	}
+	return _u
+}
+
+// ClearRequesterManaged clears the value of the "requester_managed" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearRequesterManaged() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearRequesterManaged()
+	return _u
+}
+
+// SetRequesterID sets the "requester_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetRequesterID(v string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetRequesterID(v)
+	return _u
+}
+
+// SetNillableRequesterID sets the "requester_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillableRequesterID(v *string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetRequesterID(*v)
+	}
+	return _u
+}
+
+// ClearRequesterID clears the value of the "requester_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearRequesterID() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearRequesterID()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetOwnerID(v string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillableOwnerID(v *string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearOwnerID() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetAttachmentID sets the "attachment_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetAttachmentID(v string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetAttachmentID(v)
+	return _u
+}
+
+// SetNillableAttachmentID sets the "attachment_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillableAttachmentID(v *string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetAttachmentID(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentID clears the value of the "attachment_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearAttachmentID() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearAttachmentID()
+	return _u
+}
+
+// SetAttachmentInstanceID sets the "attachment_instance_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetAttachmentInstanceID(v string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetAttachmentInstanceID(v)
+	return _u
+}
+
+// SetNillableAttachmentInstanceID sets the "attachment_instance_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillableAttachmentInstanceID(v *string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetAttachmentInstanceID(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentInstanceID clears the value of the "attachment_instance_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearAttachmentInstanceID() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearAttachmentInstanceID()
+	return _u
+}
+
+// SetAttachmentStatus sets the "attachment_status" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetAttachmentStatus(v string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetAttachmentStatus(v)
+	return _u
+}
+
+// SetNillableAttachmentStatus sets the "attachment_status" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillableAttachmentStatus(v *string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetAttachmentStatus(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentStatus clears the value of the "attachment_status" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearAttachmentStatus() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearAttachmentStatus()
+	return _u
+}
+
+// SetGroupsJSON sets the "groups_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetGroupsJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetGroupsJSON(v)
+	return _u
+}
+
+// AppendGroupsJSON appends value to the "groups_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) AppendGroupsJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.AppendGroupsJSON(v)
+	return _u
+}
+
+// ClearGroupsJSON clears the value of the "groups_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearGroupsJSON() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearGroupsJSON()
+	return _u
+}
+
+// SetPrivateIPAddressesJSON sets the "private_ip_addresses_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetPrivateIPAddressesJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetPrivateIPAddressesJSON(v)
+	return _u
+}
+
+// AppendPrivateIPAddressesJSON appends value to the "private_ip_addresses_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) AppendPrivateIPAddressesJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.AppendPrivateIPAddressesJSON(v)
+	return _u
+}
+
+// ClearPrivateIPAddressesJSON clears the value of the "private_ip_addresses_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearPrivateIPAddressesJSON() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearPrivateIPAddressesJSON()
+	return _u
+}
+
+// SetIpv6AddressesJSON sets the "ipv6_addresses_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetIpv6AddressesJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetIpv6AddressesJSON(v)
+	return _u
+}
+
+// AppendIpv6AddressesJSON appends value to the "ipv6_addresses_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) AppendIpv6AddressesJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.AppendIpv6AddressesJSON(v)
+	return _u
+}
+
+// ClearIpv6AddressesJSON clears the value of the "ipv6_addresses_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearIpv6AddressesJSON() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearIpv6AddressesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ClearTagsJSON() *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetAccountID(v string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillableAccountID(v *string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetRegion(v string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SetNillableRegion(v *string) *BronzeAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2NetworkInterfaceMutation object of the builder.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) Mutation() *BronzeAWSEC2NetworkInterfaceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2networkinterface.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2NetworkInterface.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2networkinterface.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2NetworkInterface.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2NetworkInterfaceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2networkinterface.Table, bronzeawsec2networkinterface.Columns, sqlgraph.NewFieldSpec(bronzeawsec2networkinterface.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.InterfaceType(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldInterfaceType, field.TypeString, value)
+	}
+	if _u.mutation.InterfaceTypeCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldInterfaceType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SubnetID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldSubnetID, field.TypeString, value)
+	}
+	if _u.mutation.SubnetIDCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldSubnetID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AvailabilityZone(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldAvailabilityZone, field.TypeString, value)
+	}
+	if _u.mutation.AvailabilityZoneCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldAvailabilityZone, field.TypeString)
+	}
+	if value, ok := _u.mutation.MACAddress(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldMACAddress, field.TypeString, value)
+	}
+	if _u.mutation.MACAddressCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldMACAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrivateIPAddress(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldPrivateIPAddress, field.TypeString, value)
+	}
+	if _u.mutation.PrivateIPAddressCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldPrivateIPAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrivateDNSName(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldPrivateDNSName, field.TypeString, value)
+	}
+	if _u.mutation.PrivateDNSNameCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldPrivateDNSName, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicIP(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldPublicIP, field.TypeString, value)
+	}
+	if _u.mutation.PublicIPCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldPublicIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicDNSName(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldPublicDNSName, field.TypeString, value)
+	}
+	if _u.mutation.PublicDNSNameCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldPublicDNSName, field.TypeString)
+	}
+	if value, ok := _u.mutation.SourceDestCheck(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldSourceDestCheck, field.TypeBool, value)
+	}
+	if _u.mutation.SourceDestCheckCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldSourceDestCheck, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RequesterManaged(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldRequesterManaged, field.TypeBool, value)
+	}
+	if _u.mutation.RequesterManagedCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldRequesterManaged, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RequesterID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldRequesterID, field.TypeString, value)
+	}
+	if _u.mutation.RequesterIDCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldRequesterID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldAttachmentID, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentIDCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldAttachmentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentInstanceID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldAttachmentInstanceID, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentInstanceIDCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldAttachmentInstanceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentStatus(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldAttachmentStatus, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentStatusCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldAttachmentStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupsJSON(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldGroupsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2networkinterface.FieldGroupsJSON, value)
+		})
+	}
+	if _u.mutation.GroupsJSONCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldGroupsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PrivateIPAddressesJSON(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldPrivateIPAddressesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPrivateIPAddressesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2networkinterface.FieldPrivateIPAddressesJSON, value)
+		})
+	}
+	if _u.mutation.PrivateIPAddressesJSONCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldPrivateIPAddressesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Ipv6AddressesJSON(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldIpv6AddressesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIpv6AddressesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2networkinterface.FieldIpv6AddressesJSON, value)
+		})
+	}
+	if _u.mutation.Ipv6AddressesJSONCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldIpv6AddressesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2networkinterface.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2NetworkInterface
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2networkinterface.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeAWSEC2NetworkInterfaceUpdateOne is the builder for updating a single BronzeAWSEC2NetworkInterface entity.
+type BronzeAWSEC2NetworkInterfaceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeAWSEC2NetworkInterfaceMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetCollectedAt(v time.Time) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetDescription(v string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillableDescription(v *string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearDescription() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetInterfaceType sets the "interface_type" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetInterfaceType(v string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetInterfaceType(v)
+	return _u
+}
+
+// SetNillableInterfaceType sets the "interface_type" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillableInterfaceType(v *string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetInterfaceType(*v)
+	}
+	return _u
+}
+
+// ClearInterfaceType clears the value of the "interface_type" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearInterfaceType() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearInterfaceType()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetStatus(v string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillableStatus(v *string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearStatus() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetVpcID(v string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillableVpcID(v *string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearVpcID() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetSubnetID sets the "subnet_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetSubnetID(v string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetSubnetID(v)
+	return _u
+}
+
+// SetNillableSubnetID sets the "subnet_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillableSubnetID(v *string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetSubnetID(*v)
+	}
+	return _u
+}
+
+// ClearSubnetID clears the value of the "subnet_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearSubnetID() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearSubnetID()
+	return _u
+}
+
+// SetAvailabilityZone sets the "availability_zone" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetAvailabilityZone(v string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetAvailabilityZone(v)
+	return _u
+}
+
+// SetNillableAvailabilityZone sets the "availability_zone" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillableAvailabilityZone(v *string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetAvailabilityZone(*v)
+	}
+	return _u
+}
+
+// ClearAvailabilityZone clears the value of the "availability_zone" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearAvailabilityZone() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearAvailabilityZone()
+	return _u
+}
+
+// SetMACAddress sets the "mac_address" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetMACAddress(v string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetMACAddress(v)
+	return _u
+}
+
+// SetNillableMACAddress sets the "mac_address" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillableMACAddress(v *string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetMACAddress(*v)
+	}
+	return _u
+}
+
+// ClearMACAddress clears the value of the "mac_address" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearMACAddress() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearMACAddress()
+	return _u
+}
+
+// SetPrivateIPAddress sets the "private_ip_address" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetPrivateIPAddress(v string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetPrivateIPAddress(v)
+	return _u
+}
+
+// SetNillablePrivateIPAddress sets the "private_ip_address" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillablePrivateIPAddress(v *string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetPrivateIPAddress(*v)
+	}
+	return _u
+}
+
+// ClearPrivateIPAddress clears the value of the "private_ip_address" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearPrivateIPAddress() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearPrivateIPAddress()
+	return _u
+}
+
+// SetPrivateDNSName sets the "private_dns_name" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetPrivateDNSName(v string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetPrivateDNSName(v)
+	return _u
+}
+
+// SetNillablePrivateDNSName sets the "private_dns_name" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillablePrivateDNSName(v *string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetPrivateDNSName(*v)
+	}
+	return _u
+}
+
+// ClearPrivateDNSName clears the value of the "private_dns_name" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearPrivateDNSName() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearPrivateDNSName()
+	return _u
+}
+
+// SetPublicIP sets the "public_ip" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetPublicIP(v string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetPublicIP(v)
+	return _u
+}
+
+// SetNillablePublicIP sets the "public_ip" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillablePublicIP(v *string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetPublicIP(*v)
+	}
+	return _u
+}
+
+// ClearPublicIP clears the value of the "public_ip" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearPublicIP() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearPublicIP()
+	return _u
+}
+
+// SetPublicDNSName sets the "public_dns_name" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetPublicDNSName(v string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetPublicDNSName(v)
+	return _u
+}
+
+// SetNillablePublicDNSName sets the "public_dns_name" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillablePublicDNSName(v *string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetPublicDNSName(*v)
+	}
+	return _u
+}
+
+// ClearPublicDNSName clears the value of the "public_dns_name" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearPublicDNSName() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearPublicDNSName()
+	return _u
+}
+
+// SetSourceDestCheck sets the "source_dest_check" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetSourceDestCheck(v bool) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetSourceDestCheck(v)
+	return _u
+}
+
+// SetNillableSourceDestCheck sets the "source_dest_check" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillableSourceDestCheck(v *bool) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetSourceDestCheck(*v)
+	}
+	return _u
+}
+
+// ClearSourceDestCheck clears the value of the "source_dest_check" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearSourceDestCheck() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearSourceDestCheck()
+	return _u
+}
+
+// SetRequesterManaged sets the "requester_managed" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetRequesterManaged(v bool) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetRequesterManaged(v)
+	return _u
+}
+
+// SetNillableRequesterManaged sets the "requester_managed" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillableRequesterManaged(v *bool) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetRequesterManaged(*v)
+	}
+	return _u
+}
+
+// ClearRequesterManaged clears the value of the "requester_managed" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearRequesterManaged() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearRequesterManaged()
+	return _u
+}
+
+// SetRequesterID sets the "requester_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetRequesterID(v string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetRequesterID(v)
+	return _u
+}
+
+// SetNillableRequesterID sets the "requester_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillableRequesterID(v *string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetRequesterID(*v)
+	}
+	return _u
+}
+
+// ClearRequesterID clears the value of the "requester_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearRequesterID() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearRequesterID()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetOwnerID(v string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillableOwnerID(v *string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearOwnerID() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetAttachmentID sets the "attachment_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetAttachmentID(v string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetAttachmentID(v)
+	return _u
+}
+
+// SetNillableAttachmentID sets the "attachment_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillableAttachmentID(v *string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetAttachmentID(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentID clears the value of the "attachment_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearAttachmentID() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearAttachmentID()
+	return _u
+}
+
+// SetAttachmentInstanceID sets the "attachment_instance_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetAttachmentInstanceID(v string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetAttachmentInstanceID(v)
+	return _u
+}
+
+// SetNillableAttachmentInstanceID sets the "attachment_instance_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillableAttachmentInstanceID(v *string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetAttachmentInstanceID(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentInstanceID clears the value of the "attachment_instance_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearAttachmentInstanceID() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearAttachmentInstanceID()
+	return _u
+}
+
+// SetAttachmentStatus sets the "attachment_status" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetAttachmentStatus(v string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetAttachmentStatus(v)
+	return _u
+}
+
+// SetNillableAttachmentStatus sets the "attachment_status" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillableAttachmentStatus(v *string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetAttachmentStatus(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentStatus clears the value of the "attachment_status" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearAttachmentStatus() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearAttachmentStatus()
+	return _u
+}
+
+// SetGroupsJSON sets the "groups_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetGroupsJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetGroupsJSON(v)
+	return _u
+}
+
+// AppendGroupsJSON appends value to the "groups_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) AppendGroupsJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.AppendGroupsJSON(v)
+	return _u
+}
+
+// ClearGroupsJSON clears the value of the "groups_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearGroupsJSON() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearGroupsJSON()
+	return _u
+}
+
+// SetPrivateIPAddressesJSON sets the "private_ip_addresses_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetPrivateIPAddressesJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetPrivateIPAddressesJSON(v)
+	return _u
+}
+
+// AppendPrivateIPAddressesJSON appends value to the "private_ip_addresses_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) AppendPrivateIPAddressesJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.AppendPrivateIPAddressesJSON(v)
+	return _u
+}
+
+// ClearPrivateIPAddressesJSON clears the value of the "private_ip_addresses_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearPrivateIPAddressesJSON() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearPrivateIPAddressesJSON()
+	return _u
+}
+
+// SetIpv6AddressesJSON sets the "ipv6_addresses_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetIpv6AddressesJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetIpv6AddressesJSON(v)
+	return _u
+}
+
+// AppendIpv6AddressesJSON appends value to the "ipv6_addresses_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) AppendIpv6AddressesJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.AppendIpv6AddressesJSON(v)
+	return _u
+}
+
+// ClearIpv6AddressesJSON clears the value of the "ipv6_addresses_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearIpv6AddressesJSON() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearIpv6AddressesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ClearTagsJSON() *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetAccountID(v string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillableAccountID(v *string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetRegion(v string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SetNillableRegion(v *string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2NetworkInterfaceMutation object of the builder.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) Mutation() *BronzeAWSEC2NetworkInterfaceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2NetworkInterfaceUpdate builder.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) Where(ps ...predicate.BronzeAWSEC2NetworkInterface) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) Select(field string, fields ...string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeAWSEC2NetworkInterface entity.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) Save(ctx context.Context) (*BronzeAWSEC2NetworkInterface, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) SaveX(ctx context.Context) *BronzeAWSEC2NetworkInterface {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2networkinterface.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2NetworkInterface.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2networkinterface.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2NetworkInterface.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2NetworkInterfaceUpdateOne) sqlSave(ctx context.Context) (_node *BronzeAWSEC2NetworkInterface, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2networkinterface.Table, bronzeawsec2networkinterface.Columns, sqlgraph.NewFieldSpec(bronzeawsec2networkinterface.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeAWSEC2NetworkInterface.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawsec2networkinterface.FieldID)
+		for _, f := range fields {
+			if !bronzeawsec2networkinterface.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzeawsec2networkinterface.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.InterfaceType(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldInterfaceType, field.TypeString, value)
+	}
+	if _u.mutation.InterfaceTypeCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldInterfaceType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SubnetID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldSubnetID, field.TypeString, value)
+	}
+	if _u.mutation.SubnetIDCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldSubnetID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AvailabilityZone(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldAvailabilityZone, field.TypeString, value)
+	}
+	if _u.mutation.AvailabilityZoneCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldAvailabilityZone, field.TypeString)
+	}
+	if value, ok := _u.mutation.MACAddress(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldMACAddress, field.TypeString, value)
+	}
+	if _u.mutation.MACAddressCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldMACAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrivateIPAddress(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldPrivateIPAddress, field.TypeString, value)
+	}
+	if _u.mutation.PrivateIPAddressCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldPrivateIPAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrivateDNSName(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldPrivateDNSName, field.TypeString, value)
+	}
+	if _u.mutation.PrivateDNSNameCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldPrivateDNSName, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicIP(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldPublicIP, field.TypeString, value)
+	}
+	if _u.mutation.PublicIPCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldPublicIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicDNSName(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldPublicDNSName, field.TypeString, value)
+	}
+	if _u.mutation.PublicDNSNameCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldPublicDNSName, field.TypeString)
+	}
+	if value, ok := _u.mutation.SourceDestCheck(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldSourceDestCheck, field.TypeBool, value)
+	}
+	if _u.mutation.SourceDestCheckCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldSourceDestCheck, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RequesterManaged(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldRequesterManaged, field.TypeBool, value)
+	}
+	if _u.mutation.RequesterManagedCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldRequesterManaged, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RequesterID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldRequesterID, field.TypeString, value)
+	}
+	if _u.mutation.RequesterIDCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldRequesterID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldAttachmentID, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentIDCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldAttachmentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentInstanceID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldAttachmentInstanceID, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentInstanceIDCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldAttachmentInstanceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentStatus(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldAttachmentStatus, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentStatusCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldAttachmentStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupsJSON(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldGroupsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2networkinterface.FieldGroupsJSON, value)
+		})
+	}
+	if _u.mutation.GroupsJSONCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldGroupsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PrivateIPAddressesJSON(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldPrivateIPAddressesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPrivateIPAddressesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2networkinterface.FieldPrivateIPAddressesJSON, value)
+		})
+	}
+	if _u.mutation.PrivateIPAddressesJSONCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldPrivateIPAddressesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Ipv6AddressesJSON(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldIpv6AddressesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIpv6AddressesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2networkinterface.FieldIpv6AddressesJSON, value)
+		})
+	}
+	if _u.mutation.Ipv6AddressesJSONCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldIpv6AddressesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2networkinterface.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2networkinterface.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2networkinterface.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2NetworkInterface
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeAWSEC2NetworkInterface{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2networkinterface.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2routetable"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeAWSEC2RouteTable is the model entity for the BronzeAWSEC2RouteTable schema.
+type BronzeAWSEC2RouteTable struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Route table ID (rtb-xxxx), used as primary key
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Value of the Name tag
+	Name string `json:"name,omitempty"`
+	// VpcID holds the value of the "vpc_id" field.
+	VpcID string `json:"vpc_id,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID string `json:"owner_id,omitempty"`
+	// RoutesJSON holds the value of the "routes_json" field.
+	RoutesJSON json.RawMessage `json:"routes_json,omitempty"`
+	// AssociationsJSON holds the value of the "associations_json" field.
+	AssociationsJSON json.RawMessage `json:"associations_json,omitempty"`
+	// PropagatingVgwsJSON holds the value of the "propagating_vgws_json" field.
+	PropagatingVgwsJSON json.RawMessage `json:"propagating_vgws_json,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Region holds the value of the "region" field.
+	Region       string `json:"region,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeAWSEC2RouteTable) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsec2routetable.FieldRoutesJSON, bronzeawsec2routetable.FieldAssociationsJSON, bronzeawsec2routetable.FieldPropagatingVgwsJSON, bronzeawsec2routetable.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzeawsec2routetable.FieldID, bronzeawsec2routetable.FieldName, bronzeawsec2routetable.FieldVpcID, bronzeawsec2routetable.FieldOwnerID, bronzeawsec2routetable.FieldAccountID, bronzeawsec2routetable.FieldRegion:
+			values[i] = new(sql.NullString)
+		case bronzeawsec2routetable.FieldCollectedAt, bronzeawsec2routetable.FieldFirstCollectedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeAWSEC2RouteTable fields.
+func (_m *BronzeAWSEC2RouteTable) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsec2routetable.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzeawsec2routetable.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzeawsec2routetable.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzeawsec2routetable.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzeawsec2routetable.FieldVpcID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vpc_id", values[i])
+			} else if value.Valid {
+				_m.VpcID = value.String
+			}
+		case bronzeawsec2routetable.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				_m.OwnerID = value.String
+			}
+		case bronzeawsec2routetable.FieldRoutesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field routes_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RoutesJSON); err != nil {
+					return fmt.Errorf("unmarshal field routes_json: %w", err)
+				}
+			}
+		case bronzeawsec2routetable.FieldAssociationsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field associations_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AssociationsJSON); err != nil {
+					return fmt.Errorf("unmarshal field associations_json: %w", err)
+				}
+			}
+		case bronzeawsec2routetable.FieldPropagatingVgwsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field propagating_vgws_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PropagatingVgwsJSON); err != nil {
+					return fmt.Errorf("unmarshal field propagating_vgws_json: %w", err)
+				}
+			}
+		case bronzeawsec2routetable.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzeawsec2routetable.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzeawsec2routetable.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeAWSEC2RouteTable.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeAWSEC2RouteTable) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeAWSEC2RouteTable.
+// Note that you need to call BronzeAWSEC2RouteTable.Unwrap() before calling this method if this BronzeAWSEC2RouteTable
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeAWSEC2RouteTable) Update() *BronzeAWSEC2RouteTableUpdateOne {
+	return NewBronzeAWSEC2RouteTableClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeAWSEC2RouteTable entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeAWSEC2RouteTable) Unwrap() *BronzeAWSEC2RouteTable {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ec2: BronzeAWSEC2RouteTable is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeAWSEC2RouteTable) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeAWSEC2RouteTable(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("vpc_id=")
+	builder.WriteString(_m.VpcID)
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(_m.OwnerID)
+	builder.WriteString(", ")
+	builder.WriteString("routes_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RoutesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("associations_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AssociationsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("propagating_vgws_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PropagatingVgwsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeAWSEC2RouteTables is a parsable slice of BronzeAWSEC2RouteTable.
+type BronzeAWSEC2RouteTables []*BronzeAWSEC2RouteTable
@@ -0,0 +1,709 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2routetable"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2RouteTableUpdate is the builder for updating BronzeAWSEC2RouteTable entities.
+type BronzeAWSEC2RouteTableUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSEC2RouteTableMutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2RouteTableUpdate builder.
+func (_u *BronzeAWSEC2RouteTableUpdate) Where(ps ...predicate.BronzeAWSEC2RouteTable) *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) SetCollectedAt(v time.Time) *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2RouteTableUpdate) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2RouteTableUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) SetName(v string) *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeAWSEC2RouteTableUpdate) SetNillableName(v *string) *BronzeAWSEC2RouteTableUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) ClearName() *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) SetVpcID(v string) *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2RouteTableUpdate) SetNillableVpcID(v *string) *BronzeAWSEC2RouteTableUpdate {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) ClearVpcID() *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) SetOwnerID(v string) *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2RouteTableUpdate) SetNillableOwnerID(v *string) *BronzeAWSEC2RouteTableUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) ClearOwnerID() *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetRoutesJSON sets the "routes_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) SetRoutesJSON(v json.RawMessage) *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.SetRoutesJSON(v)
+	return _u
+}
+
+// AppendRoutesJSON appends value to the "routes_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) AppendRoutesJSON(v json.RawMessage) *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.AppendRoutesJSON(v)
+	return _u
+}
+
+// ClearRoutesJSON clears the value of the "routes_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) ClearRoutesJSON() *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.ClearRoutesJSON()
+	return _u
+}
+
+// SetAssociationsJSON sets the "associations_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) SetAssociationsJSON(v json.RawMessage) *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.SetAssociationsJSON(v)
+	return _u
+}
+
+// AppendAssociationsJSON appends value to the "associations_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) AppendAssociationsJSON(v json.RawMessage) *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.AppendAssociationsJSON(v)
+	return _u
+}
+
+// ClearAssociationsJSON clears the value of the "associations_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) ClearAssociationsJSON() *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.ClearAssociationsJSON()
+	return _u
+}
+
+// SetPropagatingVgwsJSON sets the "propagating_vgws_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) SetPropagatingVgwsJSON(v json.RawMessage) *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.SetPropagatingVgwsJSON(v)
+	return _u
+}
+
+// AppendPropagatingVgwsJSON appends value to the "propagating_vgws_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) AppendPropagatingVgwsJSON(v json.RawMessage) *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.AppendPropagatingVgwsJSON(v)
+	return _u
+}
+
+// ClearPropagatingVgwsJSON clears the value of the "propagating_vgws_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) ClearPropagatingVgwsJSON() *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.ClearPropagatingVgwsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) ClearTagsJSON() *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) SetAccountID(v string) *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2RouteTableUpdate) SetNillableAccountID(v *string) *BronzeAWSEC2RouteTableUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2RouteTableUpdate) SetRegion(v string) *BronzeAWSEC2RouteTableUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2RouteTableUpdate) SetNillableRegion(v *string) *BronzeAWSEC2RouteTableUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2RouteTableMutation object of the builder.
+func (_u *BronzeAWSEC2RouteTableUpdate) Mutation() *BronzeAWSEC2RouteTableMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeAWSEC2RouteTableUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2RouteTableUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeAWSEC2RouteTableUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2RouteTableUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2RouteTableUpdate) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2routetable.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2RouteTable.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2routetable.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2RouteTable.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2RouteTableUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2routetable.Table, bronzeawsec2routetable.Columns, sqlgraph.NewFieldSpec(bronzeawsec2routetable.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzeawsec2routetable.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzeawsec2routetable.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzeawsec2routetable.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RoutesJSON(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldRoutesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoutesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2routetable.FieldRoutesJSON, value)
+		})
+	}
+	if _u.mutation.RoutesJSONCleared() {
+		_spec.ClearField(bronzeawsec2routetable.FieldRoutesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AssociationsJSON(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2routetable.FieldAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.AssociationsJSONCleared() {
+		_spec.ClearField(bronzeawsec2routetable.FieldAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PropagatingVgwsJSON(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldPropagatingVgwsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPropagatingVgwsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2routetable.FieldPropagatingVgwsJSON, value)
+		})
+	}
+	if _u.mutation.PropagatingVgwsJSONCleared() {
+		_spec.ClearField(bronzeawsec2routetable.FieldPropagatingVgwsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2routetable.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2routetable.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2RouteTable
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2routetable.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeAWSEC2RouteTableUpdateOne is the builder for updating a single BronzeAWSEC2RouteTable entity.
+type BronzeAWSEC2RouteTableUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeAWSEC2RouteTableMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) SetCollectedAt(v time.Time) *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2RouteTableUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) SetName(v string) *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) SetNillableName(v *string) *BronzeAWSEC2RouteTableUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) ClearName() *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) SetVpcID(v string) *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) SetNillableVpcID(v *string) *BronzeAWSEC2RouteTableUpdateOne {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) ClearVpcID() *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) SetOwnerID(v string) *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) SetNillableOwnerID(v *string) *BronzeAWSEC2RouteTableUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) ClearOwnerID() *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetRoutesJSON sets the "routes_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) SetRoutesJSON(v json.RawMessage) *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetRoutesJSON(v)
+	return _u
+}
+
+// AppendRoutesJSON appends value to the "routes_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) AppendRoutesJSON(v json.RawMessage) *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.AppendRoutesJSON(v)
+	return _u
+}
+
+// ClearRoutesJSON clears the value of the "routes_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) ClearRoutesJSON() *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.ClearRoutesJSON()
+	return _u
+}
+
+// SetAssociationsJSON sets the "associations_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) SetAssociationsJSON(v json.RawMessage) *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetAssociationsJSON(v)
+	return _u
+}
+
+// AppendAssociationsJSON appends value to the "associations_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) AppendAssociationsJSON(v json.RawMessage) *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.AppendAssociationsJSON(v)
+	return _u
+}
+
+// ClearAssociationsJSON clears the value of the "associations_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) ClearAssociationsJSON() *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.ClearAssociationsJSON()
+	return _u
+}
+
+// SetPropagatingVgwsJSON sets the "propagating_vgws_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) SetPropagatingVgwsJSON(v json.RawMessage) *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetPropagatingVgwsJSON(v)
+	return _u
+}
+
+// AppendPropagatingVgwsJSON appends value to the "propagating_vgws_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) AppendPropagatingVgwsJSON(v json.RawMessage) *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.AppendPropagatingVgwsJSON(v)
+	return _u
+}
+
+// ClearPropagatingVgwsJSON clears the value of the "propagating_vgws_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) ClearPropagatingVgwsJSON() *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.ClearPropagatingVgwsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) ClearTagsJSON() *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) SetAccountID(v string) *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) SetNillableAccountID(v *string) *BronzeAWSEC2RouteTableUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) SetRegion(v string) *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) SetNillableRegion(v *string) *BronzeAWSEC2RouteTableUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2RouteTableMutation object of the builder.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) Mutation() *BronzeAWSEC2RouteTableMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2RouteTableUpdate builder.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) Where(ps ...predicate.BronzeAWSEC2RouteTable) *BronzeAWSEC2RouteTableUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) Select(field string, fields ...string) *BronzeAWSEC2RouteTableUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeAWSEC2RouteTable entity.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) Save(ctx context.Context) (*BronzeAWSEC2RouteTable, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) SaveX(ctx context.Context) *BronzeAWSEC2RouteTable {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2RouteTableUpdateOne) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2routetable.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2RouteTable.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2routetable.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2RouteTable.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2RouteTableUpdateOne) sqlSave(ctx context.Context) (_node *BronzeAWSEC2RouteTable, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2routetable.Table, bronzeawsec2routetable.Columns, sqlgraph.NewFieldSpec(bronzeawsec2routetable.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeAWSEC2RouteTable.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawsec2routetable.FieldID)
+		for _, f := range fields {
+			if !bronzeawsec2routetable.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzeawsec2routetable.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzeawsec2routetable.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzeawsec2routetable.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzeawsec2routetable.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RoutesJSON(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldRoutesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoutesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2routetable.FieldRoutesJSON, value)
+		})
+	}
+	if _u.mutation.RoutesJSONCleared() {
+		_spec.ClearField(bronzeawsec2routetable.FieldRoutesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AssociationsJSON(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2routetable.FieldAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.AssociationsJSONCleared() {
+		_spec.ClearField(bronzeawsec2routetable.FieldAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PropagatingVgwsJSON(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldPropagatingVgwsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPropagatingVgwsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2routetable.FieldPropagatingVgwsJSON, value)
+		})
+	}
+	if _u.mutation.PropagatingVgwsJSONCleared() {
+		_spec.ClearField(bronzeawsec2routetable.FieldPropagatingVgwsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2routetable.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2routetable.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2routetable.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2RouteTable
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeAWSEC2RouteTable{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2routetable.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
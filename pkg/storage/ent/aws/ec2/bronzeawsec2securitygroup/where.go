@@ -0,0 +1,720 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawsec2securitygroup
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// GroupName applies equality check predicate on the "group_name" field. It's identical to GroupNameEQ.
+func GroupName(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldGroupName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldDescription, v))
+}
+
+// VpcID applies equality check predicate on the "vpc_id" field. It's identical to VpcIDEQ.
+func VpcID(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldVpcID, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// SecurityGroupArn applies equality check predicate on the "security_group_arn" field. It's identical to SecurityGroupArnEQ.
+func SecurityGroupArn(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldSecurityGroupArn, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldRegion, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// GroupNameEQ applies the EQ predicate on the "group_name" field.
+func GroupNameEQ(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldGroupName, v))
+}
+
+// GroupNameNEQ applies the NEQ predicate on the "group_name" field.
+func GroupNameNEQ(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNEQ(FieldGroupName, v))
+}
+
+// GroupNameIn applies the In predicate on the "group_name" field.
+func GroupNameIn(vs ...string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldIn(FieldGroupName, vs...))
+}
+
+// GroupNameNotIn applies the NotIn predicate on the "group_name" field.
+func GroupNameNotIn(vs ...string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNotIn(FieldGroupName, vs...))
+}
+
+// GroupNameGT applies the GT predicate on the "group_name" field.
+func GroupNameGT(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGT(FieldGroupName, v))
+}
+
+// GroupNameGTE applies the GTE predicate on the "group_name" field.
+func GroupNameGTE(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGTE(FieldGroupName, v))
+}
+
+// GroupNameLT applies the LT predicate on the "group_name" field.
+func GroupNameLT(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLT(FieldGroupName, v))
+}
+
+// GroupNameLTE applies the LTE predicate on the "group_name" field.
+func GroupNameLTE(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLTE(FieldGroupName, v))
+}
+
+// GroupNameContains applies the Contains predicate on the "group_name" field.
+func GroupNameContains(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldContains(FieldGroupName, v))
+}
+
+// GroupNameHasPrefix applies the HasPrefix predicate on the "group_name" field.
+func GroupNameHasPrefix(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldHasPrefix(FieldGroupName, v))
+}
+
+// GroupNameHasSuffix applies the HasSuffix predicate on the "group_name" field.
+func GroupNameHasSuffix(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldHasSuffix(FieldGroupName, v))
+}
+
+// GroupNameIsNil applies the IsNil predicate on the "group_name" field.
+func GroupNameIsNil() predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldIsNull(FieldGroupName))
+}
+
+// GroupNameNotNil applies the NotNil predicate on the "group_name" field.
+func GroupNameNotNil() predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNotNull(FieldGroupName))
+}
+
+// GroupNameEqualFold applies the EqualFold predicate on the "group_name" field.
+func GroupNameEqualFold(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEqualFold(FieldGroupName, v))
+}
+
+// GroupNameContainsFold applies the ContainsFold predicate on the "group_name" field.
+func GroupNameContainsFold(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldContainsFold(FieldGroupName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// VpcIDEQ applies the EQ predicate on the "vpc_id" field.
+func VpcIDEQ(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldVpcID, v))
+}
+
+// VpcIDNEQ applies the NEQ predicate on the "vpc_id" field.
+func VpcIDNEQ(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNEQ(FieldVpcID, v))
+}
+
+// VpcIDIn applies the In predicate on the "vpc_id" field.
+func VpcIDIn(vs ...string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldIn(FieldVpcID, vs...))
+}
+
+// VpcIDNotIn applies the NotIn predicate on the "vpc_id" field.
+func VpcIDNotIn(vs ...string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNotIn(FieldVpcID, vs...))
+}
+
+// VpcIDGT applies the GT predicate on the "vpc_id" field.
+func VpcIDGT(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGT(FieldVpcID, v))
+}
+
+// VpcIDGTE applies the GTE predicate on the "vpc_id" field.
+func VpcIDGTE(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGTE(FieldVpcID, v))
+}
+
+// VpcIDLT applies the LT predicate on the "vpc_id" field.
+func VpcIDLT(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLT(FieldVpcID, v))
+}
+
+// VpcIDLTE applies the LTE predicate on the "vpc_id" field.
+func VpcIDLTE(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLTE(FieldVpcID, v))
+}
+
+// VpcIDContains applies the Contains predicate on the "vpc_id" field.
+func VpcIDContains(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldContains(FieldVpcID, v))
+}
+
+// VpcIDHasPrefix applies the HasPrefix predicate on the "vpc_id" field.
+func VpcIDHasPrefix(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldHasPrefix(FieldVpcID, v))
+}
+
+// VpcIDHasSuffix applies the HasSuffix predicate on the "vpc_id" field.
+func VpcIDHasSuffix(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldHasSuffix(FieldVpcID, v))
+}
+
+// VpcIDIsNil applies the IsNil predicate on the "vpc_id" field.
+func VpcIDIsNil() predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldIsNull(FieldVpcID))
+}
+
+// VpcIDNotNil applies the NotNil predicate on the "vpc_id" field.
+func VpcIDNotNil() predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNotNull(FieldVpcID))
+}
+
+// VpcIDEqualFold applies the EqualFold predicate on the "vpc_id" field.
+func VpcIDEqualFold(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEqualFold(FieldVpcID, v))
+}
+
+// VpcIDContainsFold applies the ContainsFold predicate on the "vpc_id" field.
+func VpcIDContainsFold(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldContainsFold(FieldVpcID, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldContains(FieldOwnerID, v))
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldHasPrefix(FieldOwnerID, v))
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldHasSuffix(FieldOwnerID, v))
+}
+
+// OwnerIDIsNil applies the IsNil predicate on the "owner_id" field.
+func OwnerIDIsNil() predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldIsNull(FieldOwnerID))
+}
+
+// OwnerIDNotNil applies the NotNil predicate on the "owner_id" field.
+func OwnerIDNotNil() predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNotNull(FieldOwnerID))
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEqualFold(FieldOwnerID, v))
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldContainsFold(FieldOwnerID, v))
+}
+
+// SecurityGroupArnEQ applies the EQ predicate on the "security_group_arn" field.
+func SecurityGroupArnEQ(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldSecurityGroupArn, v))
+}
+
+// SecurityGroupArnNEQ applies the NEQ predicate on the "security_group_arn" field.
+func SecurityGroupArnNEQ(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNEQ(FieldSecurityGroupArn, v))
+}
+
+// SecurityGroupArnIn applies the In predicate on the "security_group_arn" field.
+func SecurityGroupArnIn(vs ...string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldIn(FieldSecurityGroupArn, vs...))
+}
+
+// SecurityGroupArnNotIn applies the NotIn predicate on the "security_group_arn" field.
+func SecurityGroupArnNotIn(vs ...string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNotIn(FieldSecurityGroupArn, vs...))
+}
+
+// SecurityGroupArnGT applies the GT predicate on the "security_group_arn" field.
+func SecurityGroupArnGT(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGT(FieldSecurityGroupArn, v))
+}
+
+// SecurityGroupArnGTE applies the GTE predicate on the "security_group_arn" field.
+func SecurityGroupArnGTE(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGTE(FieldSecurityGroupArn, v))
+}
+
+// SecurityGroupArnLT applies the LT predicate on the "security_group_arn" field.
+func SecurityGroupArnLT(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLT(FieldSecurityGroupArn, v))
+}
+
+// SecurityGroupArnLTE applies the LTE predicate on the "security_group_arn" field.
+func SecurityGroupArnLTE(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLTE(FieldSecurityGroupArn, v))
+}
+
+// SecurityGroupArnContains applies the Contains predicate on the "security_group_arn" field.
+func SecurityGroupArnContains(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldContains(FieldSecurityGroupArn, v))
+}
+
+// SecurityGroupArnHasPrefix applies the HasPrefix predicate on the "security_group_arn" field.
+func SecurityGroupArnHasPrefix(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldHasPrefix(FieldSecurityGroupArn, v))
+}
+
+// SecurityGroupArnHasSuffix applies the HasSuffix predicate on the "security_group_arn" field.
+func SecurityGroupArnHasSuffix(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldHasSuffix(FieldSecurityGroupArn, v))
+}
+
+// SecurityGroupArnIsNil applies the IsNil predicate on the "security_group_arn" field.
+func SecurityGroupArnIsNil() predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldIsNull(FieldSecurityGroupArn))
+}
+
+// SecurityGroupArnNotNil applies the NotNil predicate on the "security_group_arn" field.
+func SecurityGroupArnNotNil() predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNotNull(FieldSecurityGroupArn))
+}
+
+// SecurityGroupArnEqualFold applies the EqualFold predicate on the "security_group_arn" field.
+func SecurityGroupArnEqualFold(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEqualFold(FieldSecurityGroupArn, v))
+}
+
+// SecurityGroupArnContainsFold applies the ContainsFold predicate on the "security_group_arn" field.
+func SecurityGroupArnContainsFold(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldContainsFold(FieldSecurityGroupArn, v))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeAWSEC2SecurityGroup) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeAWSEC2SecurityGroup) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeAWSEC2SecurityGroup) predicate.BronzeAWSEC2SecurityGroup {
+	return predicate.BronzeAWSEC2SecurityGroup(sql.NotPredicates(p))
+}
@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2securitygroup"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2SecurityGroupCreate is the builder for creating a BronzeAWSEC2SecurityGroup entity.
+type BronzeAWSEC2SecurityGroupCreate struct {
+	config
+	mutation *BronzeAWSEC2SecurityGroupMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeAWSEC2SecurityGroupCreate) SetCollectedAt(v time.Time) *BronzeAWSEC2SecurityGroupCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeAWSEC2SecurityGroupCreate) SetFirstCollectedAt(v time.Time) *BronzeAWSEC2SecurityGroupCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetGroupName sets the "group_name" field.
+func (_c *BronzeAWSEC2SecurityGroupCreate) SetGroupName(v string) *BronzeAWSEC2SecurityGroupCreate {
+	_c.mutation.SetGroupName(v)
+	return _c
+}
+
+// SetNillableGroupName sets the "group_name" field if the given value is not nil.
+func (_c *BronzeAWSEC2SecurityGroupCreate) SetNillableGroupName(v *string) *BronzeAWSEC2SecurityGroupCreate {
+	if v != nil {
+		_c.SetGroupName(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BronzeAWSEC2SecurityGroupCreate) SetDescription(v string) *BronzeAWSEC2SecurityGroupCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BronzeAWSEC2SecurityGroupCreate) SetNillableDescription(v *string) *BronzeAWSEC2SecurityGroupCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_c *BronzeAWSEC2SecurityGroupCreate) SetVpcID(v string) *BronzeAWSEC2SecurityGroupCreate {
+	_c.mutation.SetVpcID(v)
+	return _c
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_c *BronzeAWSEC2SecurityGroupCreate) SetNillableVpcID(v *string) *BronzeAWSEC2SecurityGroupCreate {
+	if v != nil {
+		_c.SetVpcID(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *BronzeAWSEC2SecurityGroupCreate) SetOwnerID(v string) *BronzeAWSEC2SecurityGroupCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *BronzeAWSEC2SecurityGroupCreate) SetNillableOwnerID(v *string) *BronzeAWSEC2SecurityGroupCreate {
+	if v != nil {
+		_c.SetOwnerID(*v)
+	}
+	return _c
+}
+
+// SetSecurityGroupArn sets the "security_group_arn" field.
+func (_c *BronzeAWSEC2SecurityGroupCreate) SetSecurityGroupArn(v string) *BronzeAWSEC2SecurityGroupCreate {
+	_c.mutation.SetSecurityGroupArn(v)
+	return _c
+}
+
+// SetNillableSecurityGroupArn sets the "security_group_arn" field if the given value is not nil.
+func (_c *BronzeAWSEC2SecurityGroupCreate) SetNillableSecurityGroupArn(v *string) *BronzeAWSEC2SecurityGroupCreate {
+	if v != nil {
+		_c.SetSecurityGroupArn(*v)
+	}
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeAWSEC2SecurityGroupCreate) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2SecurityGroupCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeAWSEC2SecurityGroupCreate) SetAccountID(v string) *BronzeAWSEC2SecurityGroupCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BronzeAWSEC2SecurityGroupCreate) SetRegion(v string) *BronzeAWSEC2SecurityGroupCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeAWSEC2SecurityGroupCreate) SetID(v string) *BronzeAWSEC2SecurityGroupCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeAWSEC2SecurityGroupMutation object of the builder.
+func (_c *BronzeAWSEC2SecurityGroupCreate) Mutation() *BronzeAWSEC2SecurityGroupMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeAWSEC2SecurityGroup in the database.
+func (_c *BronzeAWSEC2SecurityGroupCreate) Save(ctx context.Context) (*BronzeAWSEC2SecurityGroup, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeAWSEC2SecurityGroupCreate) SaveX(ctx context.Context) *BronzeAWSEC2SecurityGroup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSEC2SecurityGroupCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSEC2SecurityGroupCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeAWSEC2SecurityGroupCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`ec2: missing required field "BronzeAWSEC2SecurityGroup.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`ec2: missing required field "BronzeAWSEC2SecurityGroup.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ec2: missing required field "BronzeAWSEC2SecurityGroup.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzeawsec2securitygroup.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2SecurityGroup.account_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`ec2: missing required field "BronzeAWSEC2SecurityGroup.region"`)}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := bronzeawsec2securitygroup.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2SecurityGroup.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeAWSEC2SecurityGroupCreate) sqlSave(ctx context.Context) (*BronzeAWSEC2SecurityGroup, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeAWSEC2SecurityGroup.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeAWSEC2SecurityGroupCreate) createSpec() (*BronzeAWSEC2SecurityGroup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeAWSEC2SecurityGroup{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzeawsec2securitygroup.Table, sqlgraph.NewFieldSpec(bronzeawsec2securitygroup.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeAWSEC2SecurityGroup
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.GroupName(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldGroupName, field.TypeString, value)
+		_node.GroupName = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.VpcID(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldVpcID, field.TypeString, value)
+		_node.VpcID = value
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = value
+	}
+	if value, ok := _c.mutation.SecurityGroupArn(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldSecurityGroupArn, field.TypeString, value)
+		_node.SecurityGroupArn = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	return _node, _spec
+}
+
+// BronzeAWSEC2SecurityGroupCreateBulk is the builder for creating many BronzeAWSEC2SecurityGroup entities in bulk.
+type BronzeAWSEC2SecurityGroupCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeAWSEC2SecurityGroupCreate
+}
+
+// Save creates the BronzeAWSEC2SecurityGroup entities in the database.
+func (_c *BronzeAWSEC2SecurityGroupCreateBulk) Save(ctx context.Context) ([]*BronzeAWSEC2SecurityGroup, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeAWSEC2SecurityGroup, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeAWSEC2SecurityGroupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeAWSEC2SecurityGroupCreateBulk) SaveX(ctx context.Context) []*BronzeAWSEC2SecurityGroup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSEC2SecurityGroupCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSEC2SecurityGroupCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
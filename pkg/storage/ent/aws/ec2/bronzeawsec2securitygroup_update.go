@@ -0,0 +1,639 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2securitygroup"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2SecurityGroupUpdate is the builder for updating BronzeAWSEC2SecurityGroup entities.
+type BronzeAWSEC2SecurityGroupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSEC2SecurityGroupMutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2SecurityGroupUpdate builder.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) Where(ps ...predicate.BronzeAWSEC2SecurityGroup) *BronzeAWSEC2SecurityGroupUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) SetCollectedAt(v time.Time) *BronzeAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2SecurityGroupUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetGroupName sets the "group_name" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) SetGroupName(v string) *BronzeAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetGroupName(v)
+	return _u
+}
+
+// SetNillableGroupName sets the "group_name" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) SetNillableGroupName(v *string) *BronzeAWSEC2SecurityGroupUpdate {
+	if v != nil {
+		_u.SetGroupName(*v)
+	}
+	return _u
+}
+
+// ClearGroupName clears the value of the "group_name" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) ClearGroupName() *BronzeAWSEC2SecurityGroupUpdate {
+	_u.mutation.ClearGroupName()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) SetDescription(v string) *BronzeAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) SetNillableDescription(v *string) *BronzeAWSEC2SecurityGroupUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) ClearDescription() *BronzeAWSEC2SecurityGroupUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) SetVpcID(v string) *BronzeAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) SetNillableVpcID(v *string) *BronzeAWSEC2SecurityGroupUpdate {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) ClearVpcID() *BronzeAWSEC2SecurityGroupUpdate {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) SetOwnerID(v string) *BronzeAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) SetNillableOwnerID(v *string) *BronzeAWSEC2SecurityGroupUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) ClearOwnerID() *BronzeAWSEC2SecurityGroupUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetSecurityGroupArn sets the "security_group_arn" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) SetSecurityGroupArn(v string) *BronzeAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetSecurityGroupArn(v)
+	return _u
+}
+
+// SetNillableSecurityGroupArn sets the "security_group_arn" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) SetNillableSecurityGroupArn(v *string) *BronzeAWSEC2SecurityGroupUpdate {
+	if v != nil {
+		_u.SetSecurityGroupArn(*v)
+	}
+	return _u
+}
+
+// ClearSecurityGroupArn clears the value of the "security_group_arn" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) ClearSecurityGroupArn() *BronzeAWSEC2SecurityGroupUpdate {
+	_u.mutation.ClearSecurityGroupArn()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2SecurityGroupUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) ClearTagsJSON() *BronzeAWSEC2SecurityGroupUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) SetAccountID(v string) *BronzeAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) SetNillableAccountID(v *string) *BronzeAWSEC2SecurityGroupUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) SetRegion(v string) *BronzeAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) SetNillableRegion(v *string) *BronzeAWSEC2SecurityGroupUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2SecurityGroupMutation object of the builder.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) Mutation() *BronzeAWSEC2SecurityGroupMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2SecurityGroupUpdate) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2securitygroup.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2SecurityGroup.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2securitygroup.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2SecurityGroup.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2SecurityGroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2securitygroup.Table, bronzeawsec2securitygroup.Columns, sqlgraph.NewFieldSpec(bronzeawsec2securitygroup.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GroupName(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldGroupName, field.TypeString, value)
+	}
+	if _u.mutation.GroupNameCleared() {
+		_spec.ClearField(bronzeawsec2securitygroup.FieldGroupName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzeawsec2securitygroup.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzeawsec2securitygroup.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzeawsec2securitygroup.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecurityGroupArn(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldSecurityGroupArn, field.TypeString, value)
+	}
+	if _u.mutation.SecurityGroupArnCleared() {
+		_spec.ClearField(bronzeawsec2securitygroup.FieldSecurityGroupArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2securitygroup.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2securitygroup.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2SecurityGroup
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2securitygroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeAWSEC2SecurityGroupUpdateOne is the builder for updating a single BronzeAWSEC2SecurityGroup entity.
+type BronzeAWSEC2SecurityGroupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeAWSEC2SecurityGroupMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) SetCollectedAt(v time.Time) *BronzeAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2SecurityGroupUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetGroupName sets the "group_name" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) SetGroupName(v string) *BronzeAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetGroupName(v)
+	return _u
+}
+
+// SetNillableGroupName sets the "group_name" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) SetNillableGroupName(v *string) *BronzeAWSEC2SecurityGroupUpdateOne {
+	if v != nil {
+		_u.SetGroupName(*v)
+	}
+	return _u
+}
+
+// ClearGroupName clears the value of the "group_name" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) ClearGroupName() *BronzeAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.ClearGroupName()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) SetDescription(v string) *BronzeAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) SetNillableDescription(v *string) *BronzeAWSEC2SecurityGroupUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) ClearDescription() *BronzeAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) SetVpcID(v string) *BronzeAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) SetNillableVpcID(v *string) *BronzeAWSEC2SecurityGroupUpdateOne {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) ClearVpcID() *BronzeAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) SetOwnerID(v string) *BronzeAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) SetNillableOwnerID(v *string) *BronzeAWSEC2SecurityGroupUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) ClearOwnerID() *BronzeAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetSecurityGroupArn sets the "security_group_arn" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) SetSecurityGroupArn(v string) *BronzeAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetSecurityGroupArn(v)
+	return _u
+}
+
+// SetNillableSecurityGroupArn sets the "security_group_arn" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) SetNillableSecurityGroupArn(v *string) *BronzeAWSEC2SecurityGroupUpdateOne {
+	if v != nil {
+		_u.SetSecurityGroupArn(*v)
+	}
+	return _u
+}
+
+// ClearSecurityGroupArn clears the value of the "security_group_arn" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) ClearSecurityGroupArn() *BronzeAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.ClearSecurityGroupArn()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) ClearTagsJSON() *BronzeAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) SetAccountID(v string) *BronzeAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) SetNillableAccountID(v *string) *BronzeAWSEC2SecurityGroupUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) SetRegion(v string) *BronzeAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) SetNillableRegion(v *string) *BronzeAWSEC2SecurityGroupUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2SecurityGroupMutation object of the builder.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) Mutation() *BronzeAWSEC2SecurityGroupMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2SecurityGroupUpdate builder.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) Where(ps ...predicate.BronzeAWSEC2SecurityGroup) *BronzeAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) Select(field string, fields ...string) *BronzeAWSEC2SecurityGroupUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeAWSEC2SecurityGroup entity.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) Save(ctx context.Context) (*BronzeAWSEC2SecurityGroup, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) SaveX(ctx context.Context) *BronzeAWSEC2SecurityGroup {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2securitygroup.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2SecurityGroup.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2securitygroup.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2SecurityGroup.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2SecurityGroupUpdateOne) sqlSave(ctx context.Context) (_node *BronzeAWSEC2SecurityGroup, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2securitygroup.Table, bronzeawsec2securitygroup.Columns, sqlgraph.NewFieldSpec(bronzeawsec2securitygroup.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeAWSEC2SecurityGroup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawsec2securitygroup.FieldID)
+		for _, f := range fields {
+			if !bronzeawsec2securitygroup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzeawsec2securitygroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GroupName(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldGroupName, field.TypeString, value)
+	}
+	if _u.mutation.GroupNameCleared() {
+		_spec.ClearField(bronzeawsec2securitygroup.FieldGroupName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzeawsec2securitygroup.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzeawsec2securitygroup.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzeawsec2securitygroup.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecurityGroupArn(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldSecurityGroupArn, field.TypeString, value)
+	}
+	if _u.mutation.SecurityGroupArnCleared() {
+		_spec.ClearField(bronzeawsec2securitygroup.FieldSecurityGroupArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2securitygroup.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2securitygroup.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2securitygroup.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2SecurityGroup
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeAWSEC2SecurityGroup{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2securitygroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2securitygrouprule"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeAWSEC2SecurityGroupRule is the model entity for the BronzeAWSEC2SecurityGroupRule schema.
+type BronzeAWSEC2SecurityGroupRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Security group rule ID (sgr-xxxx), used as primary key
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Security group the rule belongs to
+	GroupID string `json:"group_id,omitempty"`
+	// GroupOwnerID holds the value of the "group_owner_id" field.
+	GroupOwnerID string `json:"group_owner_id,omitempty"`
+	// True for outbound rules, false for inbound rules
+	IsEgress bool `json:"is_egress,omitempty"`
+	// tcp, udp, icmp, icmpv6, a protocol number, or -1 for all
+	IPProtocol string `json:"ip_protocol,omitempty"`
+	// Start of the port range, or ICMP type; -1 means all
+	FromPort *int32 `json:"from_port,omitempty"`
+	// End of the port range, or ICMP code; -1 means all
+	ToPort *int32 `json:"to_port,omitempty"`
+	// CidrIpv4 holds the value of the "cidr_ipv4" field.
+	CidrIpv4 string `json:"cidr_ipv4,omitempty"`
+	// CidrIpv6 holds the value of the "cidr_ipv6" field.
+	CidrIpv6 string `json:"cidr_ipv6,omitempty"`
+	// PrefixListID holds the value of the "prefix_list_id" field.
+	PrefixListID string `json:"prefix_list_id,omitempty"`
+	// Security group referenced as source or destination
+	ReferencedGroupID string `json:"referenced_group_id,omitempty"`
+	// Account that owns the referenced security group
+	ReferencedUserID string `json:"referenced_user_id,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// SecurityGroupRuleArn holds the value of the "security_group_rule_arn" field.
+	SecurityGroupRuleArn string `json:"security_group_rule_arn,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Region holds the value of the "region" field.
+	Region       string `json:"region,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeAWSEC2SecurityGroupRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsec2securitygrouprule.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzeawsec2securitygrouprule.FieldIsEgress:
+			values[i] = new(sql.NullBool)
+		case bronzeawsec2securitygrouprule.FieldFromPort, bronzeawsec2securitygrouprule.FieldToPort:
+			values[i] = new(sql.NullInt64)
+		case bronzeawsec2securitygrouprule.FieldID, bronzeawsec2securitygrouprule.FieldGroupID, bronzeawsec2securitygrouprule.FieldGroupOwnerID, bronzeawsec2securitygrouprule.FieldIPProtocol, bronzeawsec2securitygrouprule.FieldCidrIpv4, bronzeawsec2securitygrouprule.FieldCidrIpv6, bronzeawsec2securitygrouprule.FieldPrefixListID, bronzeawsec2securitygrouprule.FieldReferencedGroupID, bronzeawsec2securitygrouprule.FieldReferencedUserID, bronzeawsec2securitygrouprule.FieldDescription, bronzeawsec2securitygrouprule.FieldSecurityGroupRuleArn, bronzeawsec2securitygrouprule.FieldAccountID, bronzeawsec2securitygrouprule.FieldRegion:
+			values[i] = new(sql.NullString)
+		case bronzeawsec2securitygrouprule.FieldCollectedAt, bronzeawsec2securitygrouprule.FieldFirstCollectedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeAWSEC2SecurityGroupRule fields.
+func (_m *BronzeAWSEC2SecurityGroupRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsec2securitygrouprule.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzeawsec2securitygrouprule.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzeawsec2securitygrouprule.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzeawsec2securitygrouprule.FieldGroupID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				_m.GroupID = value.String
+			}
+		case bronzeawsec2securitygrouprule.FieldGroupOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_owner_id", values[i])
+			} else if value.Valid {
+				_m.GroupOwnerID = value.String
+			}
+		case bronzeawsec2securitygrouprule.FieldIsEgress:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_egress", values[i])
+			} else if value.Valid {
+				_m.IsEgress = value.Bool
+			}
+		case bronzeawsec2securitygrouprule.FieldIPProtocol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_protocol", values[i])
+			} else if value.Valid {
+				_m.IPProtocol = value.String
+			}
+		case bronzeawsec2securitygrouprule.FieldFromPort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field from_port", values[i])
+			} else if value.Valid {
+				_m.FromPort = new(int32)
+				*_m.FromPort = int32(value.Int64)
+			}
+		case bronzeawsec2securitygrouprule.FieldToPort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field to_port", values[i])
+			} else if value.Valid {
+				_m.ToPort = new(int32)
+				*_m.ToPort = int32(value.Int64)
+			}
+		case bronzeawsec2securitygrouprule.FieldCidrIpv4:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cidr_ipv4", values[i])
+			} else if value.Valid {
+				_m.CidrIpv4 = value.String
+			}
+		case bronzeawsec2securitygrouprule.FieldCidrIpv6:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cidr_ipv6", values[i])
+			} else if value.Valid {
+				_m.CidrIpv6 = value.String
+			}
+		case bronzeawsec2securitygrouprule.FieldPrefixListID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prefix_list_id", values[i])
+			} else if value.Valid {
+				_m.PrefixListID = value.String
+			}
+		case bronzeawsec2securitygrouprule.FieldReferencedGroupID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referenced_group_id", values[i])
+			} else if value.Valid {
+				_m.ReferencedGroupID = value.String
+			}
+		case bronzeawsec2securitygrouprule.FieldReferencedUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referenced_user_id", values[i])
+			} else if value.Valid {
+				_m.ReferencedUserID = value.String
+			}
+		case bronzeawsec2securitygrouprule.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case bronzeawsec2securitygrouprule.FieldSecurityGroupRuleArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field security_group_rule_arn", values[i])
+			} else if value.Valid {
+				_m.SecurityGroupRuleArn = value.String
+			}
+		case bronzeawsec2securitygrouprule.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzeawsec2securitygrouprule.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzeawsec2securitygrouprule.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeAWSEC2SecurityGroupRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeAWSEC2SecurityGroupRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeAWSEC2SecurityGroupRule.
+// Note that you need to call BronzeAWSEC2SecurityGroupRule.Unwrap() before calling this method if this BronzeAWSEC2SecurityGroupRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeAWSEC2SecurityGroupRule) Update() *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	return NewBronzeAWSEC2SecurityGroupRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeAWSEC2SecurityGroupRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeAWSEC2SecurityGroupRule) Unwrap() *BronzeAWSEC2SecurityGroupRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ec2: BronzeAWSEC2SecurityGroupRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeAWSEC2SecurityGroupRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeAWSEC2SecurityGroupRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(_m.GroupID)
+	builder.WriteString(", ")
+	builder.WriteString("group_owner_id=")
+	builder.WriteString(_m.GroupOwnerID)
+	builder.WriteString(", ")
+	builder.WriteString("is_egress=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsEgress))
+	builder.WriteString(", ")
+	builder.WriteString("ip_protocol=")
+	builder.WriteString(_m.IPProtocol)
+	builder.WriteString(", ")
+	if v := _m.FromPort; v != nil {
+		builder.WriteString("from_port=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ToPort; v != nil {
+		builder.WriteString("to_port=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cidr_ipv4=")
+	builder.WriteString(_m.CidrIpv4)
+	builder.WriteString(", ")
+	builder.WriteString("cidr_ipv6=")
+	builder.WriteString(_m.CidrIpv6)
+	builder.WriteString(", ")
+	builder.WriteString("prefix_list_id=")
+	builder.WriteString(_m.PrefixListID)
+	builder.WriteString(", ")
+	builder.WriteString("referenced_group_id=")
+	builder.WriteString(_m.ReferencedGroupID)
+	builder.WriteString(", ")
+	builder.WriteString("referenced_user_id=")
+	builder.WriteString(_m.ReferencedUserID)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("security_group_rule_arn=")
+	builder.WriteString(_m.SecurityGroupRuleArn)
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeAWSEC2SecurityGroupRules is a parsable slice of BronzeAWSEC2SecurityGroupRule.
+type BronzeAWSEC2SecurityGroupRules []*BronzeAWSEC2SecurityGroupRule
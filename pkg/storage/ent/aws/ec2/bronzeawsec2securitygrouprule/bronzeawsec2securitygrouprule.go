@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawsec2securitygrouprule
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzeawsec2securitygrouprule type in the database.
+	Label = "bronze_awsec2security_group_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldGroupID holds the string denoting the group_id field in the database.
+	FieldGroupID = "group_id"
+	// FieldGroupOwnerID holds the string denoting the group_owner_id field in the database.
+	FieldGroupOwnerID = "group_owner_id"
+	// FieldIsEgress holds the string denoting the is_egress field in the database.
+	FieldIsEgress = "is_egress"
+	// FieldIPProtocol holds the string denoting the ip_protocol field in the database.
+	FieldIPProtocol = "ip_protocol"
+	// FieldFromPort holds the string denoting the from_port field in the database.
+	FieldFromPort = "from_port"
+	// FieldToPort holds the string denoting the to_port field in the database.
+	FieldToPort = "to_port"
+	// FieldCidrIpv4 holds the string denoting the cidr_ipv4 field in the database.
+	FieldCidrIpv4 = "cidr_ipv4"
+	// FieldCidrIpv6 holds the string denoting the cidr_ipv6 field in the database.
+	FieldCidrIpv6 = "cidr_ipv6"
+	// FieldPrefixListID holds the string denoting the prefix_list_id field in the database.
+	FieldPrefixListID = "prefix_list_id"
+	// FieldReferencedGroupID holds the string denoting the referenced_group_id field in the database.
+	FieldReferencedGroupID = "referenced_group_id"
+	// FieldReferencedUserID holds the string denoting the referenced_user_id field in the database.
+	FieldReferencedUserID = "referenced_user_id"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldSecurityGroupRuleArn holds the string denoting the security_group_rule_arn field in the database.
+	FieldSecurityGroupRuleArn = "security_group_rule_arn"
+	// FieldTagsJSON holds the string denoting the tags_json field in the database.
+	FieldTagsJSON = "tags_json"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
+	// Table holds the table name of the bronzeawsec2securitygrouprule in the database.
+	Table = "aws_ec2_security_group_rules"
+)
+
+// Columns holds all SQL columns for bronzeawsec2securitygrouprule fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldGroupID,
+	FieldGroupOwnerID,
+	FieldIsEgress,
+	FieldIPProtocol,
+	FieldFromPort,
+	FieldToPort,
+	FieldCidrIpv4,
+	FieldCidrIpv6,
+	FieldPrefixListID,
+	FieldReferencedGroupID,
+	FieldReferencedUserID,
+	FieldDescription,
+	FieldSecurityGroupRuleArn,
+	FieldTagsJSON,
+	FieldAccountID,
+	FieldRegion,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	AccountIDValidator func(string) error
+	// RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	RegionValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BronzeAWSEC2SecurityGroupRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByGroupID orders the results by the group_id field.
+func ByGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupID, opts...).ToFunc()
+}
+
+// ByGroupOwnerID orders the results by the group_owner_id field.
+func ByGroupOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupOwnerID, opts...).ToFunc()
+}
+
+// ByIsEgress orders the results by the is_egress field.
+func ByIsEgress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsEgress, opts...).ToFunc()
+}
+
+// ByIPProtocol orders the results by the ip_protocol field.
+func ByIPProtocol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIPProtocol, opts...).ToFunc()
+}
+
+// ByFromPort orders the results by the from_port field.
+func ByFromPort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromPort, opts...).ToFunc()
+}
+
+// ByToPort orders the results by the to_port field.
+func ByToPort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToPort, opts...).ToFunc()
+}
+
+// ByCidrIpv4 orders the results by the cidr_ipv4 field.
+func ByCidrIpv4(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCidrIpv4, opts...).ToFunc()
+}
+
+// ByCidrIpv6 orders the results by the cidr_ipv6 field.
+func ByCidrIpv6(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCidrIpv6, opts...).ToFunc()
+}
+
+// ByPrefixListID orders the results by the prefix_list_id field.
+func ByPrefixListID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrefixListID, opts...).ToFunc()
+}
+
+// ByReferencedGroupID orders the results by the referenced_group_id field.
+func ByReferencedGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferencedGroupID, opts...).ToFunc()
+}
+
+// ByReferencedUserID orders the results by the referenced_user_id field.
+func ByReferencedUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferencedUserID, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// BySecurityGroupRuleArn orders the results by the security_group_rule_arn field.
+func BySecurityGroupRuleArn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSecurityGroupRuleArn, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByRegion orders the results by the region field.
+func ByRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegion, opts...).ToFunc()
+}
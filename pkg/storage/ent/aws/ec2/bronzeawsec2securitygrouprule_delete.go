@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2securitygrouprule"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2SecurityGroupRuleDelete is the builder for deleting a BronzeAWSEC2SecurityGroupRule entity.
+type BronzeAWSEC2SecurityGroupRuleDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSEC2SecurityGroupRuleMutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2SecurityGroupRuleDelete builder.
+func (_d *BronzeAWSEC2SecurityGroupRuleDelete) Where(ps ...predicate.BronzeAWSEC2SecurityGroupRule) *BronzeAWSEC2SecurityGroupRuleDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeAWSEC2SecurityGroupRuleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeAWSEC2SecurityGroupRuleDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeAWSEC2SecurityGroupRuleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzeawsec2securitygrouprule.Table, sqlgraph.NewFieldSpec(bronzeawsec2securitygrouprule.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.BronzeAWSEC2SecurityGroupRule
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeAWSEC2SecurityGroupRuleDeleteOne is the builder for deleting a single BronzeAWSEC2SecurityGroupRule entity.
+type BronzeAWSEC2SecurityGroupRuleDeleteOne struct {
+	_d *BronzeAWSEC2SecurityGroupRuleDelete
+}
+
+// Where appends a list predicates to the BronzeAWSEC2SecurityGroupRuleDelete builder.
+func (_d *BronzeAWSEC2SecurityGroupRuleDeleteOne) Where(ps ...predicate.BronzeAWSEC2SecurityGroupRule) *BronzeAWSEC2SecurityGroupRuleDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeAWSEC2SecurityGroupRuleDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzeawsec2securitygrouprule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeAWSEC2SecurityGroupRuleDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1095 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2securitygrouprule"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2SecurityGroupRuleUpdate is the builder for updating BronzeAWSEC2SecurityGroupRule entities.
+type BronzeAWSEC2SecurityGroupRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSEC2SecurityGroupRuleMutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2SecurityGroupRuleUpdate builder.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) Where(ps ...predicate.BronzeAWSEC2SecurityGroupRule) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetCollectedAt(v time.Time) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetGroupID(v string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetNillableGroupID(v *string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) ClearGroupID() *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetGroupOwnerID sets the "group_owner_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetGroupOwnerID(v string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetGroupOwnerID(v)
+	return _u
+}
+
+// SetNillableGroupOwnerID sets the "group_owner_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetNillableGroupOwnerID(v *string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetGroupOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearGroupOwnerID clears the value of the "group_owner_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) ClearGroupOwnerID() *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearGroupOwnerID()
+	return _u
+}
+
+// SetIsEgress sets the "is_egress" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetIsEgress(v bool) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetIsEgress(v)
+	return _u
+}
+
+// SetNillableIsEgress sets the "is_egress" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetNillableIsEgress(v *bool) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetIsEgress(*v)
+	}
+	return _u
+}
+
+// ClearIsEgress clears the value of the "is_egress" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) ClearIsEgress() *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearIsEgress()
+	return _u
+}
+
+// SetIPProtocol sets the "ip_protocol" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetIPProtocol(v string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetIPProtocol(v)
+	return _u
+}
+
+// SetNillableIPProtocol sets the "ip_protocol" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetNillableIPProtocol(v *string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetIPProtocol(*v)
+	}
+	return _u
+}
+
+// ClearIPProtocol clears the value of the "ip_protocol" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) ClearIPProtocol() *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearIPProtocol()
+	return _u
+}
+
+// SetFromPort sets the "from_port" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetFromPort(v int32) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ResetFromPort()
+	_u.mutation.SetFromPort(v)
+	return _u
+}
+
+// SetNillableFromPort sets the "from_port" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetNillableFromPort(v *int32) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetFromPort(*v)
+	}
+	return _u
+}
+
+// AddFromPort adds value to the "from_port" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) AddFromPort(v int32) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.AddFromPort(v)
+	return _u
+}
+
+// ClearFromPort clears the value of the "from_port" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) ClearFromPort() *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearFromPort()
+	return _u
+}
+
+// SetToPort sets the "to_port" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetToPort(v int32) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ResetToPort()
+	_u.mutation.SetToPort(v)
+	return _u
+}
+
+// SetNillableToPort sets the "to_port" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetNillableToPort(v *int32) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetToPort(*v)
+	}
+	return _u
+}
+
+// AddToPort adds value to the "to_port" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) AddToPort(v int32) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.AddToPort(v)
+	return _u
+}
+
+// ClearToPort clears the value of the "to_port" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) ClearToPort() *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearToPort()
+	return _u
+}
+
+// SetCidrIpv4 sets the "cidr_ipv4" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetCidrIpv4(v string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetCidrIpv4(v)
+	return _u
+}
+
+// SetNillableCidrIpv4 sets the "cidr_ipv4" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetNillableCidrIpv4(v *string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetCidrIpv4(*v)
+	}
+	return _u
+}
+
+// ClearCidrIpv4 clears the value of the "cidr_ipv4" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) ClearCidrIpv4() *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearCidrIpv4()
+	return _u
+}
+
+// SetCidrIpv6 sets the "cidr_ipv6" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetCidrIpv6(v string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetCidrIpv6(v)
+	return _u
+}
+
+// SetNillableCidrIpv6 sets the "cidr_ipv6" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetNillableCidrIpv6(v *string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetCidrIpv6(*v)
+	}
+	return _u
+}
+
+// ClearCidrIpv6 clears the value of the "cidr_ipv6" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) ClearCidrIpv6() *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearCidrIpv6()
+	return _u
+}
+
+// SetPrefixListID sets the "prefix_list_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetPrefixListID(v string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetPrefixListID(v)
+	return _u
+}
+
+// SetNillablePrefixListID sets the "prefix_list_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetNillablePrefixListID(v *string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetPrefixListID(*v)
+	}
+	return _u
+}
+
+// ClearPrefixListID clears the value of the "prefix_list_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) ClearPrefixListID() *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearPrefixListID()
+	return _u
+}
+
+// SetReferencedGroupID sets the "referenced_group_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetReferencedGroupID(v string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetReferencedGroupID(v)
+	return _u
+}
+
+// SetNillableReferencedGroupID sets the "referenced_group_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetNillableReferencedGroupID(v *string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetReferencedGroupID(*v)
+	}
+	return _u
+}
+
+// ClearReferencedGroupID clears the value of the "referenced_group_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) ClearReferencedGroupID() *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearReferencedGroupID()
+	return _u
+}
+
+// SetReferencedUserID sets the "referenced_user_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetReferencedUserID(v string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetReferencedUserID(v)
+	return _u
+}
+
+// SetNillableReferencedUserID sets the "referenced_user_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetNillableReferencedUserID(v *string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetReferencedUserID(*v)
+	}
+	return _u
+}
+
+// ClearReferencedUserID clears the value of the "referenced_user_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) ClearReferencedUserID() *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearReferencedUserID()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetDescription(v string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetNillableDescription(v *string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) ClearDescription() *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSecurityGroupRuleArn sets the "security_group_rule_arn" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetSecurityGroupRuleArn(v string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetSecurityGroupRuleArn(v)
+	return _u
+}
+
+// SetNillableSecurityGroupRuleArn sets the "security_group_rule_arn" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetNillableSecurityGroupRuleArn(v *string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetSecurityGroupRuleArn(*v)
+	}
+	return _u
+}
+
+// ClearSecurityGroupRuleArn clears the value of the "security_group_rule_arn" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) ClearSecurityGroupRuleArn() *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearSecurityGroupRuleArn()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) ClearTagsJSON() *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetAccountID(v string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetNillableAccountID(v *string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetRegion(v string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SetNillableRegion(v *string) *BronzeAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2SecurityGroupRuleMutation object of the builder.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) Mutation() *BronzeAWSEC2SecurityGroupRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2securitygrouprule.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2SecurityGroupRule.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2securitygrouprule.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2SecurityGroupRule.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2securitygrouprule.Table, bronzeawsec2securitygrouprule.Columns, sqlgraph.NewFieldSpec(bronzeawsec2securitygrouprule.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupOwnerID(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldGroupOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.GroupOwnerIDCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldGroupOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsEgress(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldIsEgress, field.TypeBool, value)
+	}
+	if _u.mutation.IsEgressCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldIsEgress, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IPProtocol(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldIPProtocol, field.TypeString, value)
+	}
+	if _u.mutation.IPProtocolCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldIPProtocol, field.TypeString)
+	}
+	if value, ok := _u.mutation.FromPort(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldFromPort, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedFromPort(); ok {
+		_spec.AddField(bronzeawsec2securitygrouprule.FieldFromPort, field.TypeInt32, value)
+	}
+	if _u.mutation.FromPortCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldFromPort, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.ToPort(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldToPort, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedToPort(); ok {
+		_spec.AddField(bronzeawsec2securitygrouprule.FieldToPort, field.TypeInt32, value)
+	}
+	if _u.mutation.ToPortCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldToPort, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.CidrIpv4(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldCidrIpv4, field.TypeString, value)
+	}
+	if _u.mutation.CidrIpv4Cleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldCidrIpv4, field.TypeString)
+	}
+	if value, ok := _u.mutation.CidrIpv6(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldCidrIpv6, field.TypeString, value)
+	}
+	if _u.mutation.CidrIpv6Cleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldCidrIpv6, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrefixListID(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldPrefixListID, field.TypeString, value)
+	}
+	if _u.mutation.PrefixListIDCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldPrefixListID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReferencedGroupID(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldReferencedGroupID, field.TypeString, value)
+	}
+	if _u.mutation.ReferencedGroupIDCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldReferencedGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReferencedUserID(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldReferencedUserID, field.TypeString, value)
+	}
+	if _u.mutation.ReferencedUserIDCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldReferencedUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecurityGroupRuleArn(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldSecurityGroupRuleArn, field.TypeString, value)
+	}
+	if _u.mutation.SecurityGroupRuleArnCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldSecurityGroupRuleArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2securitygrouprule.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2SecurityGroupRule
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2securitygrouprule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeAWSEC2SecurityGroupRuleUpdateOne is the builder for updating a single BronzeAWSEC2SecurityGroupRule entity.
+type BronzeAWSEC2SecurityGroupRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeAWSEC2SecurityGroupRuleMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetCollectedAt(v time.Time) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetGroupID(v string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetNillableGroupID(v *string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) ClearGroupID() *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetGroupOwnerID sets the "group_owner_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetGroupOwnerID(v string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetGroupOwnerID(v)
+	return _u
+}
+
+// SetNillableGroupOwnerID sets the "group_owner_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetNillableGroupOwnerID(v *string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetGroupOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearGroupOwnerID clears the value of the "group_owner_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) ClearGroupOwnerID() *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearGroupOwnerID()
+	return _u
+}
+
+// SetIsEgress sets the "is_egress" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetIsEgress(v bool) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetIsEgress(v)
+	return _u
+}
+
+// SetNillableIsEgress sets the "is_egress" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetNillableIsEgress(v *bool) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetIsEgress(*v)
+	}
+	return _u
+}
+
+// ClearIsEgress clears the value of the "is_egress" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) ClearIsEgress() *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearIsEgress()
+	return _u
+}
+
+// SetIPProtocol sets the "ip_protocol" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetIPProtocol(v string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetIPProtocol(v)
+	return _u
+}
+
+// SetNillableIPProtocol sets the "ip_protocol" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetNillableIPProtocol(v *string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetIPProtocol(*v)
+	}
+	return _u
+}
+
+// ClearIPProtocol clears the value of the "ip_protocol" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) ClearIPProtocol() *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearIPProtocol()
+	return _u
+}
+
+// SetFromPort sets the "from_port" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetFromPort(v int32) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ResetFromPort()
+	_u.mutation.SetFromPort(v)
+	return _u
+}
+
+// SetNillableFromPort sets the "from_port" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetNillableFromPort(v *int32) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetFromPort(*v)
+	}
+	return _u
+}
+
+// AddFromPort adds value to the "from_port" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) AddFromPort(v int32) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.AddFromPort(v)
+	return _u
+}
+
+// ClearFromPort clears the value of the "from_port" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) ClearFromPort() *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearFromPort()
+	return _u
+}
+
+// SetToPort sets the "to_port" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetToPort(v int32) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ResetToPort()
+	_u.mutation.SetToPort(v)
+	return _u
+}
+
+// SetNillableToPort sets the "to_port" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetNillableToPort(v *int32) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetToPort(*v)
+	}
+	return _u
+}
+
+// AddToPort adds value to the "to_port" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) AddToPort(v int32) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.AddToPort(v)
+	return _u
+}
+
+// ClearToPort clears the value of the "to_port" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) ClearToPort() *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearToPort()
+	return _u
+}
+
+// SetCidrIpv4 sets the "cidr_ipv4" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetCidrIpv4(v string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetCidrIpv4(v)
+	return _u
+}
+
+// SetNillableCidrIpv4 sets the "cidr_ipv4" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetNillableCidrIpv4(v *string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetCidrIpv4(*v)
+	}
+	return _u
+}
+
+// ClearCidrIpv4 clears the value of the "cidr_ipv4" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) ClearCidrIpv4() *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearCidrIpv4()
+	return _u
+}
+
+// SetCidrIpv6 sets the "cidr_ipv6" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetCidrIpv6(v string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetCidrIpv6(v)
+	return _u
+}
+
+// SetNillableCidrIpv6 sets the "cidr_ipv6" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetNillableCidrIpv6(v *string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetCidrIpv6(*v)
+	}
+	return _u
+}
+
+// ClearCidrIpv6 clears the value of the "cidr_ipv6" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) ClearCidrIpv6() *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearCidrIpv6()
+	return _u
+}
+
+// SetPrefixListID sets the "prefix_list_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetPrefixListID(v string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetPrefixListID(v)
+	return _u
+}
+
+// SetNillablePrefixListID sets the "prefix_list_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetNillablePrefixListID(v *string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetPrefixListID(*v)
+	}
+	return _u
+}
+
+// ClearPrefixListID clears the value of the "prefix_list_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) ClearPrefixListID() *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearPrefixListID()
+	return _u
+}
+
+// SetReferencedGroupID sets the "referenced_group_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetReferencedGroupID(v string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetReferencedGroupID(v)
+	return _u
+}
+
+// SetNillableReferencedGroupID sets the "referenced_group_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetNillableReferencedGroupID(v *string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetReferencedGroupID(*v)
+	}
+	return _u
+}
+
+// ClearReferencedGroupID clears the value of the "referenced_group_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) ClearReferencedGroupID() *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearReferencedGroupID()
+	return _u
+}
+
+// SetReferencedUserID sets the "referenced_user_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetReferencedUserID(v string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetReferencedUserID(v)
+	return _u
+}
+
+// SetNillableReferencedUserID sets the "referenced_user_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetNillableReferencedUserID(v *string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetReferencedUserID(*v)
+	}
+	return _u
+}
+
+// ClearReferencedUserID clears the value of the "referenced_user_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) ClearReferencedUserID() *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearReferencedUserID()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetDescription(v string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetNillableDescription(v *string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) ClearDescription() *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSecurityGroupRuleArn sets the "security_group_rule_arn" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetSecurityGroupRuleArn(v string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetSecurityGroupRuleArn(v)
+	return _u
+}
+
+// SetNillableSecurityGroupRuleArn sets the "security_group_rule_arn" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetNillableSecurityGroupRuleArn(v *string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetSecurityGroupRuleArn(*v)
+	}
+	return _u
+}
+
+// ClearSecurityGroupRuleArn clears the value of the "security_group_rule_arn" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) ClearSecurityGroupRuleArn() *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearSecurityGroupRuleArn()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) ClearTagsJSON() *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetAccountID(v string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetNillableAccountID(v *string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetRegion(v string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SetNillableRegion(v *string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2SecurityGroupRuleMutation object of the builder.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) Mutation() *BronzeAWSEC2SecurityGroupRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2SecurityGroupRuleUpdate builder.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) Where(ps ...predicate.BronzeAWSEC2SecurityGroupRule) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) Select(field string, fields ...string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeAWSEC2SecurityGroupRule entity.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) Save(ctx context.Context) (*BronzeAWSEC2SecurityGroupRule, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) SaveX(ctx context.Context) *BronzeAWSEC2SecurityGroupRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2securitygrouprule.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2SecurityGroupRule.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2securitygrouprule.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2SecurityGroupRule.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2SecurityGroupRuleUpdateOne) sqlSave(ctx context.Context) (_node *BronzeAWSEC2SecurityGroupRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2securitygrouprule.Table, bronzeawsec2securitygrouprule.Columns, sqlgraph.NewFieldSpec(bronzeawsec2securitygrouprule.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeAWSEC2SecurityGroupRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawsec2securitygrouprule.FieldID)
+		for _, f := range fields {
+			if !bronzeawsec2securitygrouprule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzeawsec2securitygrouprule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupOwnerID(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldGroupOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.GroupOwnerIDCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldGroupOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsEgress(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldIsEgress, field.TypeBool, value)
+	}
+	if _u.mutation.IsEgressCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldIsEgress, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IPProtocol(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldIPProtocol, field.TypeString, value)
+	}
+	if _u.mutation.IPProtocolCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldIPProtocol, field.TypeString)
+	}
+	if value, ok := _u.mutation.FromPort(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldFromPort, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedFromPort(); ok {
+		_spec.AddField(bronzeawsec2securitygrouprule.FieldFromPort, field.TypeInt32, value)
+	}
+	if _u.mutation.FromPortCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldFromPort, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.ToPort(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldToPort, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedToPort(); ok {
+		_spec.AddField(bronzeawsec2securitygrouprule.FieldToPort, field.TypeInt32, value)
+	}
+	if _u.mutation.ToPortCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldToPort, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.CidrIpv4(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldCidrIpv4, field.TypeString, value)
+	}
+	if _u.mutation.CidrIpv4Cleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldCidrIpv4, field.TypeString)
+	}
+	if value, ok := _u.mutation.CidrIpv6(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldCidrIpv6, field.TypeString, value)
+	}
+	if _u.mutation.CidrIpv6Cleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldCidrIpv6, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrefixListID(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldPrefixListID, field.TypeString, value)
+	}
+	if _u.mutation.PrefixListIDCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldPrefixListID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReferencedGroupID(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldReferencedGroupID, field.TypeString, value)
+	}
+	if _u.mutation.ReferencedGroupIDCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldReferencedGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReferencedUserID(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldReferencedUserID, field.TypeString, value)
+	}
+	if _u.mutation.ReferencedUserIDCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldReferencedUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecurityGroupRuleArn(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldSecurityGroupRuleArn, field.TypeString, value)
+	}
+	if _u.mutation.SecurityGroupRuleArnCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldSecurityGroupRuleArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2securitygrouprule.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2securitygrouprule.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2securitygrouprule.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2SecurityGroupRule
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeAWSEC2SecurityGroupRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2securitygrouprule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
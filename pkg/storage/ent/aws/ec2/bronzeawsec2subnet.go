@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2subnet"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeAWSEC2Subnet is the model entity for the BronzeAWSEC2Subnet schema.
+type BronzeAWSEC2Subnet struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Subnet ID (subnet-xxxx), used as primary key
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Value of the Name tag
+	Name string `json:"name,omitempty"`
+	// VpcID holds the value of the "vpc_id" field.
+	VpcID string `json:"vpc_id,omitempty"`
+	// CidrBlock holds the value of the "cidr_block" field.
+	CidrBlock string `json:"cidr_block,omitempty"`
+	// AvailabilityZone holds the value of the "availability_zone" field.
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+	// AvailabilityZoneID holds the value of the "availability_zone_id" field.
+	AvailabilityZoneID string `json:"availability_zone_id,omitempty"`
+	// Subnet state: pending, available, unavailable, failed
+	State string `json:"state,omitempty"`
+	// DefaultForAz holds the value of the "default_for_az" field.
+	DefaultForAz bool `json:"default_for_az,omitempty"`
+	// Whether instances launched in the subnet get a public IPv4 address
+	MapPublicIPOnLaunch bool `json:"map_public_ip_on_launch,omitempty"`
+	// AssignIpv6AddressOnCreation holds the value of the "assign_ipv6_address_on_creation" field.
+	AssignIpv6AddressOnCreation bool `json:"assign_ipv6_address_on_creation,omitempty"`
+	// SubnetArn holds the value of the "subnet_arn" field.
+	SubnetArn string `json:"subnet_arn,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID string `json:"owner_id,omitempty"`
+	// Ipv6CidrBlockAssociationsJSON holds the value of the "ipv6_cidr_block_associations_json" field.
+	Ipv6CidrBlockAssociationsJSON json.RawMessage `json:"ipv6_cidr_block_associations_json,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Region holds the value of the "region" field.
+	Region       string `json:"region,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeAWSEC2Subnet) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsec2subnet.FieldIpv6CidrBlockAssociationsJSON, bronzeawsec2subnet.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzeawsec2subnet.FieldDefaultForAz, bronzeawsec2subnet.FieldMapPublicIPOnLaunch, bronzeawsec2subnet.FieldAssignIpv6AddressOnCreation:
+			values[i] = new(sql.NullBool)
+		case bronzeawsec2subnet.FieldID, bronzeawsec2subnet.FieldName, bronzeawsec2subnet.FieldVpcID, bronzeawsec2subnet.FieldCidrBlock, bronzeawsec2subnet.FieldAvailabilityZone, bronzeawsec2subnet.FieldAvailabilityZoneID, bronzeawsec2subnet.FieldState, bronzeawsec2subnet.FieldSubnetArn, bronzeawsec2subnet.FieldOwnerID, bronzeawsec2subnet.FieldAccountID, bronzeawsec2subnet.FieldRegion:
+			values[i] = new(sql.NullString)
+		case bronzeawsec2subnet.FieldCollectedAt, bronzeawsec2subnet.FieldFirstCollectedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeAWSEC2Subnet fields.
+func (_m *BronzeAWSEC2Subnet) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsec2subnet.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzeawsec2subnet.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzeawsec2subnet.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzeawsec2subnet.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzeawsec2subnet.FieldVpcID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vpc_id", values[i])
+			} else if value.Valid {
+				_m.VpcID = value.String
+			}
+		case bronzeawsec2subnet.FieldCidrBlock:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cidr_block", values[i])
+			} else if value.Valid {
+				_m.CidrBlock = value.String
+			}
+		case bronzeawsec2subnet.FieldAvailabilityZone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field availability_zone", values[i])
+			} else if value.Valid {
+				_m.AvailabilityZone = value.String
+			}
+		case bronzeawsec2subnet.FieldAvailabilityZoneID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field availability_zone_id", values[i])
+			} else if value.Valid {
+				_m.AvailabilityZoneID = value.String
+			}
+		case bronzeawsec2subnet.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				_m.State = value.String
+			}
+		case bronzeawsec2subnet.FieldDefaultForAz:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field default_for_az", values[i])
+			} else if value.Valid {
+				_m.DefaultForAz = value.Bool
+			}
+		case bronzeawsec2subnet.FieldMapPublicIPOnLaunch:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field map_public_ip_on_launch", values[i])
+			} else if value.Valid {
+				_m.MapPublicIPOnLaunch = value.Bool
+			}
+		case bronzeawsec2subnet.FieldAssignIpv6AddressOnCreation:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field assign_ipv6_address_on_creation", values[i])
+			} else if value.Valid {
+				_m.AssignIpv6AddressOnCreation = value.Bool
+			}
+		case bronzeawsec2subnet.FieldSubnetArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subnet_arn", values[i])
+			} else if value.Valid {
+				_m.SubnetArn = value.String
+			}
+		case bronzeawsec2subnet.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				_m.OwnerID = value.String
+			}
+		case bronzeawsec2subnet.FieldIpv6CidrBlockAssociationsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ipv6_cidr_block_associations_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Ipv6CidrBlockAssociationsJSON); err != nil {
+					return fmt.Errorf("unmarshal field ipv6_cidr_block_associations_json: %w", err)
+				}
+			}
+		case bronzeawsec2subnet.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzeawsec2subnet.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzeawsec2subnet.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeAWSEC2Subnet.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeAWSEC2Subnet) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeAWSEC2Subnet.
+// Note that you need to call BronzeAWSEC2Subnet.Unwrap() before calling this method if this BronzeAWSEC2Subnet
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeAWSEC2Subnet) Update() *BronzeAWSEC2SubnetUpdateOne {
+	return NewBronzeAWSEC2SubnetClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeAWSEC2Subnet entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeAWSEC2Subnet) Unwrap() *BronzeAWSEC2Subnet {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ec2: BronzeAWSEC2Subnet is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeAWSEC2Subnet) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeAWSEC2Subnet(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("vpc_id=")
+	builder.WriteString(_m.VpcID)
+	builder.WriteString(", ")
+	builder.WriteString("cidr_block=")
+	builder.WriteString(_m.CidrBlock)
+	builder.WriteString(", ")
+	builder.WriteString("availability_zone=")
+	builder.WriteString(_m.AvailabilityZone)
+	builder.WriteString(", ")
+	builder.WriteString("availability_zone_id=")
+	builder.WriteString(_m.AvailabilityZoneID)
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(_m.State)
+	builder.WriteString(", ")
+	builder.WriteString("default_for_az=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefaultForAz))
+	builder.WriteString(", ")
+	builder.WriteString("map_public_ip_on_launch=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MapPublicIPOnLaunch))
+	builder.WriteString(", ")
+	builder.WriteString("assign_ipv6_address_on_creation=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AssignIpv6AddressOnCreation))
+	builder.WriteString(", ")
+	builder.WriteString("subnet_arn=")
+	builder.WriteString(_m.SubnetArn)
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(_m.OwnerID)
+	builder.WriteString(", ")
+	builder.WriteString("ipv6_cidr_block_associations_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Ipv6CidrBlockAssociationsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeAWSEC2Subnets is a parsable slice of BronzeAWSEC2Subnet.
+type BronzeAWSEC2Subnets []*BronzeAWSEC2Subnet
@@ -0,0 +1,464 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2subnet"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2SubnetCreate is the builder for creating a BronzeAWSEC2Subnet entity.
+type BronzeAWSEC2SubnetCreate struct {
+	config
+	mutation *BronzeAWSEC2SubnetMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeAWSEC2SubnetCreate) SetCollectedAt(v time.Time) *BronzeAWSEC2SubnetCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeAWSEC2SubnetCreate) SetFirstCollectedAt(v time.Time) *BronzeAWSEC2SubnetCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeAWSEC2SubnetCreate) SetName(v string) *BronzeAWSEC2SubnetCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *BronzeAWSEC2SubnetCreate) SetNillableName(v *string) *BronzeAWSEC2SubnetCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_c *BronzeAWSEC2SubnetCreate) SetVpcID(v string) *BronzeAWSEC2SubnetCreate {
+	_c.mutation.SetVpcID(v)
+	return _c
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_c *BronzeAWSEC2SubnetCreate) SetNillableVpcID(v *string) *BronzeAWSEC2SubnetCreate {
+	if v != nil {
+		_c.SetVpcID(*v)
+	}
+	return _c
+}
+
+// SetCidrBlock sets the "cidr_block" field.
+func (_c *BronzeAWSEC2SubnetCreate) SetCidrBlock(v string) *BronzeAWSEC2SubnetCreate {
+	_c.mutation.SetCidrBlock(v)
+	return _c
+}
+
+// SetNillableCidrBlock sets the "cidr_block" field if the given value is not nil.
+func (_c *BronzeAWSEC2SubnetCreate) SetNillableCidrBlock(v *string) *BronzeAWSEC2SubnetCreate {
+	if v != nil {
+		_c.SetCidrBlock(*v)
+	}
+	return _c
+}
+
+// SetAvailabilityZone sets the "availability_zone" field.
+func (_c *BronzeAWSEC2SubnetCreate) SetAvailabilityZone(v string) *BronzeAWSEC2SubnetCreate {
+	_c.mutation.SetAvailabilityZone(v)
+	return _c
+}
+
+// SetNillableAvailabilityZone sets the "availability_zone" field if the given value is not nil.
+func (_c *BronzeAWSEC2SubnetCreate) SetNillableAvailabilityZone(v *string) *BronzeAWSEC2SubnetCreate {
+	if v != nil {
+		_c.SetAvailabilityZone(*v)
+	}
+	return _c
+}
+
+// SetAvailabilityZoneID sets the "availability_zone_id" field.
+func (_c *BronzeAWSEC2SubnetCreate) SetAvailabilityZoneID(v string) *BronzeAWSEC2SubnetCreate {
+	_c.mutation.SetAvailabilityZoneID(v)
+	return _c
+}
+
+// SetNillableAvailabilityZoneID sets the "availability_zone_id" field if the given value is not nil.
+func (_c *BronzeAWSEC2SubnetCreate) SetNillableAvailabilityZoneID(v *string) *BronzeAWSEC2SubnetCreate {
+	if v != nil {
+		_c.SetAvailabilityZoneID(*v)
+	}
+	return _c
+}
+
+// SetState sets the "state" field.
+func (_c *BronzeAWSEC2SubnetCreate) SetState(v string) *BronzeAWSEC2SubnetCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_c *BronzeAWSEC2SubnetCreate) SetNillableState(v *string) *BronzeAWSEC2SubnetCreate {
+	if v != nil {
+		_c.SetState(*v)
+	}
+	return _c
+}
+
+// SetDefaultForAz sets the "default_for_az" field.
+func (_c *BronzeAWSEC2SubnetCreate) SetDefaultForAz(v bool) *BronzeAWSEC2SubnetCreate {
+	_c.mutation.SetDefaultForAz(v)
+	return _c
+}
+
+// SetNillableDefaultForAz sets the "default_for_az" field if the given value is not nil.
+func (_c *BronzeAWSEC2SubnetCreate) SetNillableDefaultForAz(v *bool) *BronzeAWSEC2SubnetCreate {
+	if v != nil {
+		_c.SetDefaultForAz(*v)
+	}
+	return _c
+}
+
+// SetMapPublicIPOnLaunch sets the "map_public_ip_on_launch" field.
+func (_c *BronzeAWSEC2SubnetCreate) SetMapPublicIPOnLaunch(v bool) *BronzeAWSEC2SubnetCreate {
+	_c.mutation.SetMapPublicIPOnLaunch(v)
+	return _c
+}
+
+// SetNillableMapPublicIPOnLaunch sets the "map_public_ip_on_launch" field if the given value is not nil.
+func (_c *BronzeAWSEC2SubnetCreate) SetNillableMapPublicIPOnLaunch(v *bool) *BronzeAWSEC2SubnetCreate {
+	if v != nil {
+		_c.SetMapPublicIPOnLaunch(*v)
+	}
+	return _c
+}
+
+// SetAssignIpv6AddressOnCreation sets the "assign_ipv6_address_on_creation" field.
+func (_c *BronzeAWSEC2SubnetCreate) SetAssignIpv6AddressOnCreation(v bool) *BronzeAWSEC2SubnetCreate {
+	_c.mutation.SetAssignIpv6AddressOnCreation(v)
+	return _c
+}
+
+// SetNillableAssignIpv6AddressOnCreation sets the "assign_ipv6_address_on_creation" field if the given value is not nil.
+func (_c *BronzeAWSEC2SubnetCreate) SetNillableAssignIpv6AddressOnCreation(v *bool) *BronzeAWSEC2SubnetCreate {
+	if v != nil {
+		_c.SetAssignIpv6AddressOnCreation(*v)
+	}
+	return _c
+}
+
+// SetSubnetArn sets the "subnet_arn" field.
+func (_c *BronzeAWSEC2SubnetCreate) SetSubnetArn(v string) *BronzeAWSEC2SubnetCreate {
+	_c.mutation.SetSubnetArn(v)
+	return _c
+}
+
+// SetNillableSubnetArn sets the "subnet_arn" field if the given value is not nil.
+func (_c *BronzeAWSEC2SubnetCreate) SetNillableSubnetArn(v *string) *BronzeAWSEC2SubnetCreate {
+	if v != nil {
+		_c.SetSubnetArn(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *BronzeAWSEC2SubnetCreate) SetOwnerID(v string) *BronzeAWSEC2SubnetCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *BronzeAWSEC2SubnetCreate) SetNillableOwnerID(v *string) *BronzeAWSEC2SubnetCreate {
+	if v != nil {
+		_c.SetOwnerID(*v)
+	}
+	return _c
+}
+
+// SetIpv6CidrBlockAssociationsJSON sets the "ipv6_cidr_block_associations_json" field.
+func (_c *BronzeAWSEC2SubnetCreate) SetIpv6CidrBlockAssociationsJSON(v json.RawMessage) *BronzeAWSEC2SubnetCreate {
+	_c.mutation.SetIpv6CidrBlockAssociationsJSON(v)
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeAWSEC2SubnetCreate) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2SubnetCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeAWSEC2SubnetCreate) SetAccountID(v string) *BronzeAWSEC2SubnetCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BronzeAWSEC2SubnetCreate) SetRegion(v string) *BronzeAWSEC2SubnetCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeAWSEC2SubnetCreate) SetID(v string) *BronzeAWSEC2SubnetCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeAWSEC2SubnetMutation object of the builder.
+func (_c *BronzeAWSEC2SubnetCreate) Mutation() *BronzeAWSEC2SubnetMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeAWSEC2Subnet in the database.
+func (_c *BronzeAWSEC2SubnetCreate) Save(ctx context.Context) (*BronzeAWSEC2Subnet, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeAWSEC2SubnetCreate) SaveX(ctx context.Context) *BronzeAWSEC2Subnet {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSEC2SubnetCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSEC2SubnetCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeAWSEC2SubnetCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`ec2: missing required field "BronzeAWSEC2Subnet.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`ec2: missing required field "BronzeAWSEC2Subnet.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ec2: missing required field "BronzeAWSEC2Subnet.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzeawsec2subnet.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2Subnet.account_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`ec2: missing required field "BronzeAWSEC2Subnet.region"`)}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := bronzeawsec2subnet.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2Subnet.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeAWSEC2SubnetCreate) sqlSave(ctx context.Context) (*BronzeAWSEC2Subnet, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeAWSEC2Subnet.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeAWSEC2SubnetCreate) createSpec() (*BronzeAWSEC2Subnet, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeAWSEC2Subnet{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzeawsec2subnet.Table, sqlgraph.NewFieldSpec(bronzeawsec2subnet.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeAWSEC2Subnet
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.VpcID(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldVpcID, field.TypeString, value)
+		_node.VpcID = value
+	}
+	if value, ok := _c.mutation.CidrBlock(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldCidrBlock, field.TypeString, value)
+		_node.CidrBlock = value
+	}
+	if value, ok := _c.mutation.AvailabilityZone(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldAvailabilityZone, field.TypeString, value)
+		_node.AvailabilityZone = value
+	}
+	if value, ok := _c.mutation.AvailabilityZoneID(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldAvailabilityZoneID, field.TypeString, value)
+		_node.AvailabilityZoneID = value
+	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	if value, ok := _c.mutation.DefaultForAz(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldDefaultForAz, field.TypeBool, value)
+		_node.DefaultForAz = value
+	}
+	if value, ok := _c.mutation.MapPublicIPOnLaunch(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldMapPublicIPOnLaunch, field.TypeBool, value)
+		_node.MapPublicIPOnLaunch = value
+	}
+	if value, ok := _c.mutation.AssignIpv6AddressOnCreation(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldAssignIpv6AddressOnCreation, field.TypeBool, value)
+		_node.AssignIpv6AddressOnCreation = value
+	}
+	if value, ok := _c.mutation.SubnetArn(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldSubnetArn, field.TypeString, value)
+		_node.SubnetArn = value
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = value
+	}
+	if value, ok := _c.mutation.Ipv6CidrBlockAssociationsJSON(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldIpv6CidrBlockAssociationsJSON, field.TypeJSON, value)
+		_node.Ipv6CidrBlockAssociationsJSON = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	return _node, _spec
+}
+
+// BronzeAWSEC2SubnetCreateBulk is the builder for creating many BronzeAWSEC2Subnet entities in bulk.
+type BronzeAWSEC2SubnetCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeAWSEC2SubnetCreate
+}
+
+// Save creates the BronzeAWSEC2Subnet entities in the database.
+func (_c *BronzeAWSEC2SubnetCreateBulk) Save(ctx context.Context) ([]*BronzeAWSEC2Subnet, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeAWSEC2Subnet, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeAWSEC2SubnetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeAWSEC2SubnetCreateBulk) SaveX(ctx context.Context) []*BronzeAWSEC2Subnet {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSEC2SubnetCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSEC2SubnetCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
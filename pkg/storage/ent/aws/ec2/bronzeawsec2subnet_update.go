@@ -0,0 +1,1009 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2subnet"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2SubnetUpdate is the builder for updating BronzeAWSEC2Subnet entities.
+type BronzeAWSEC2SubnetUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSEC2SubnetMutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2SubnetUpdate builder.
+func (_u *BronzeAWSEC2SubnetUpdate) Where(ps ...predicate.BronzeAWSEC2Subnet) *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2SubnetUpdate) SetCollectedAt(v time.Time) *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdate) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeAWSEC2SubnetUpdate) SetName(v string) *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdate) SetNillableName(v *string) *BronzeAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeAWSEC2SubnetUpdate) ClearName() *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeAWSEC2SubnetUpdate) SetVpcID(v string) *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdate) SetNillableVpcID(v *string) *BronzeAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeAWSEC2SubnetUpdate) ClearVpcID() *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetCidrBlock sets the "cidr_block" field.
+func (_u *BronzeAWSEC2SubnetUpdate) SetCidrBlock(v string) *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.SetCidrBlock(v)
+	return _u
+}
+
+// SetNillableCidrBlock sets the "cidr_block" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdate) SetNillableCidrBlock(v *string) *BronzeAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetCidrBlock(*v)
+	}
+	return _u
+}
+
+// ClearCidrBlock clears the value of the "cidr_block" field.
+func (_u *BronzeAWSEC2SubnetUpdate) ClearCidrBlock() *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.ClearCidrBlock()
+	return _u
+}
+
+// SetAvailabilityZone sets the "availability_zone" field.
+func (_u *BronzeAWSEC2SubnetUpdate) SetAvailabilityZone(v string) *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.SetAvailabilityZone(v)
+	return _u
+}
+
+// SetNillableAvailabilityZone sets the "availability_zone" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdate) SetNillableAvailabilityZone(v *string) *BronzeAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetAvailabilityZone(*v)
+	}
+	return _u
+}
+
+// ClearAvailabilityZone clears the value of the "availability_zone" field.
+func (_u *BronzeAWSEC2SubnetUpdate) ClearAvailabilityZone() *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.ClearAvailabilityZone()
+	return _u
+}
+
+// SetAvailabilityZoneID sets the "availability_zone_id" field.
+func (_u *BronzeAWSEC2SubnetUpdate) SetAvailabilityZoneID(v string) *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.SetAvailabilityZoneID(v)
+	return _u
+}
+
+// SetNillableAvailabilityZoneID sets the "availability_zone_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdate) SetNillableAvailabilityZoneID(v *string) *BronzeAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetAvailabilityZoneID(*v)
+	}
+	return _u
+}
+
+// ClearAvailabilityZoneID clears the value of the "availability_zone_id" field.
+func (_u *BronzeAWSEC2SubnetUpdate) ClearAvailabilityZoneID() *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.ClearAvailabilityZoneID()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *BronzeAWSEC2SubnetUpdate) SetState(v string) *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdate) SetNillableState(v *string) *BronzeAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *BronzeAWSEC2SubnetUpdate) ClearState() *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetDefaultForAz sets the "default_for_az" field.
+func (_u *BronzeAWSEC2SubnetUpdate) SetDefaultForAz(v bool) *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.SetDefaultForAz(v)
+	return _u
+}
+
+// SetNillableDefaultForAz sets the "default_for_az" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdate) SetNillableDefaultForAz(v *bool) *BronzeAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetDefaultForAz(*v)
+	}
+	return _u
+}
+
+// ClearDefaultForAz clears the value of the "default_for_az" field.
+func (_u *BronzeAWSEC2SubnetUpdate) ClearDefaultForAz() *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.ClearDefaultForAz()
+	return _u
+}
+
+// SetMapPublicIPOnLaunch sets the "map_public_ip_on_launch" field.
+func (_u *BronzeAWSEC2SubnetUpdate) SetMapPublicIPOnLaunch(v bool) *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.SetMapPublicIPOnLaunch(v)
+	return _u
+}
+
+// SetNillableMapPublicIPOnLaunch sets the "map_public_ip_on_launch" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdate) SetNillableMapPublicIPOnLaunch(v *bool) *BronzeAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetMapPublicIPOnLaunch(*v)
+	}
+	return _u
+}
+
+// ClearMapPublicIPOnLaunch clears the value of the "map_public_ip_on_launch" field.
+func (_u *BronzeAWSEC2SubnetUpdate) ClearMapPublicIPOnLaunch() *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.ClearMapPublicIPOnLaunch()
+	return _u
+}
+
+// SetAssignIpv6AddressOnCreation sets the "assign_ipv6_address_on_creation" field.
+func (_u *BronzeAWSEC2SubnetUpdate) SetAssignIpv6AddressOnCreation(v bool) *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.SetAssignIpv6AddressOnCreation(v)
+	return _u
+}
+
+// SetNillableAssignIpv6AddressOnCreation sets the "assign_ipv6_address_on_creation" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdate) SetNillableAssignIpv6AddressOnCreation(v *bool) *BronzeAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetAssignIpv6AddressOnCreation(*v)
+	}
+	return _u
+}
+
+// ClearAssignIpv6AddressOnCreation clears the value of the "assign_ipv6_address_on_creation" field.
+func (_u *BronzeAWSEC2SubnetUpdate) ClearAssignIpv6AddressOnCreation() *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.ClearAssignIpv6AddressOnCreation()
+	return _u
+}
+
+// SetSubnetArn sets the "subnet_arn" field.
+func (_u *BronzeAWSEC2SubnetUpdate) SetSubnetArn(v string) *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.SetSubnetArn(v)
+	return _u
+}
+
+// SetNillableSubnetArn sets the "subnet_arn" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdate) SetNillableSubnetArn(v *string) *BronzeAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetSubnetArn(*v)
+	}
+	return _u
+}
+
+// ClearSubnetArn clears the value of the "subnet_arn" field.
+func (_u *BronzeAWSEC2SubnetUpdate) ClearSubnetArn() *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.ClearSubnetArn()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeAWSEC2SubnetUpdate) SetOwnerID(v string) *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdate) SetNillableOwnerID(v *string) *BronzeAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeAWSEC2SubnetUpdate) ClearOwnerID() *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetIpv6CidrBlockAssociationsJSON sets the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeAWSEC2SubnetUpdate) SetIpv6CidrBlockAssociationsJSON(v json.RawMessage) *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.SetIpv6CidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// AppendIpv6CidrBlockAssociationsJSON appends value to the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeAWSEC2SubnetUpdate) AppendIpv6CidrBlockAssociationsJSON(v json.RawMessage) *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.AppendIpv6CidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// ClearIpv6CidrBlockAssociationsJSON clears the value of the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeAWSEC2SubnetUpdate) ClearIpv6CidrBlockAssociationsJSON() *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.ClearIpv6CidrBlockAssociationsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2SubnetUpdate) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2SubnetUpdate) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2SubnetUpdate) ClearTagsJSON() *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2SubnetUpdate) SetAccountID(v string) *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdate) SetNillableAccountID(v *string) *BronzeAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2SubnetUpdate) SetRegion(v string) *BronzeAWSEC2SubnetUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdate) SetNillableRegion(v *string) *BronzeAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2SubnetMutation object of the builder.
+func (_u *BronzeAWSEC2SubnetUpdate) Mutation() *BronzeAWSEC2SubnetMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeAWSEC2SubnetUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2SubnetUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeAWSEC2SubnetUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2SubnetUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2SubnetUpdate) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2subnet.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2Subnet.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2subnet.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2Subnet.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2SubnetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2subnet.Table, bronzeawsec2subnet.Columns, sqlgraph.NewFieldSpec(bronzeawsec2subnet.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CidrBlock(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldCidrBlock, field.TypeString, value)
+	}
+	if _u.mutation.CidrBlockCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldCidrBlock, field.TypeString)
+	}
+	if value, ok := _u.mutation.AvailabilityZone(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldAvailabilityZone, field.TypeString, value)
+	}
+	if _u.mutation.AvailabilityZoneCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldAvailabilityZone, field.TypeString)
+	}
+	if value, ok := _u.mutation.AvailabilityZoneID(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldAvailabilityZoneID, field.TypeString, value)
+	}
+	if _u.mutation.AvailabilityZoneIDCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldAvailabilityZoneID, field.TypeString)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldState, field.TypeString, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldState, field.TypeString)
+	}
+	if value, ok := _u.mutation.DefaultForAz(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldDefaultForAz, field.TypeBool, value)
+	}
+	if _u.mutation.DefaultForAzCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldDefaultForAz, field.TypeBool)
+	}
+	if value, ok := _u.mutation.MapPublicIPOnLaunch(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldMapPublicIPOnLaunch, field.TypeBool, value)
+	}
+	if _u.mutation.MapPublicIPOnLaunchCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldMapPublicIPOnLaunch, field.TypeBool)
+	}
+	if value, ok := _u.mutation.AssignIpv6AddressOnCreation(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldAssignIpv6AddressOnCreation, field.TypeBool, value)
+	}
+	if _u.mutation.AssignIpv6AddressOnCreationCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldAssignIpv6AddressOnCreation, field.TypeBool)
+	}
+	if value, ok := _u.mutation.SubnetArn(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldSubnetArn, field.TypeString, value)
+	}
+	if _u.mutation.SubnetArnCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldSubnetArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Ipv6CidrBlockAssociationsJSON(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldIpv6CidrBlockAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIpv6CidrBlockAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2subnet.FieldIpv6CidrBlockAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.Ipv6CidrBlockAssociationsJSONCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldIpv6CidrBlockAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2subnet.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2Subnet
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2subnet.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeAWSEC2SubnetUpdateOne is the builder for updating a single BronzeAWSEC2Subnet entity.
+type BronzeAWSEC2SubnetUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeAWSEC2SubnetMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetCollectedAt(v time.Time) *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetName(v string) *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetNillableName(v *string) *BronzeAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) ClearName() *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetVpcID(v string) *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetNillableVpcID(v *string) *BronzeAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) ClearVpcID() *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetCidrBlock sets the "cidr_block" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetCidrBlock(v string) *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.SetCidrBlock(v)
+	return _u
+}
+
+// SetNillableCidrBlock sets the "cidr_block" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetNillableCidrBlock(v *string) *BronzeAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetCidrBlock(*v)
+	}
+	return _u
+}
+
+// ClearCidrBlock clears the value of the "cidr_block" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) ClearCidrBlock() *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearCidrBlock()
+	return _u
+}
+
+// SetAvailabilityZone sets the "availability_zone" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetAvailabilityZone(v string) *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.SetAvailabilityZone(v)
+	return _u
+}
+
+// SetNillableAvailabilityZone sets the "availability_zone" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetNillableAvailabilityZone(v *string) *BronzeAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetAvailabilityZone(*v)
+	}
+	return _u
+}
+
+// ClearAvailabilityZone clears the value of the "availability_zone" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) ClearAvailabilityZone() *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearAvailabilityZone()
+	return _u
+}
+
+// SetAvailabilityZoneID sets the "availability_zone_id" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetAvailabilityZoneID(v string) *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.SetAvailabilityZoneID(v)
+	return _u
+}
+
+// SetNillableAvailabilityZoneID sets the "availability_zone_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetNillableAvailabilityZoneID(v *string) *BronzeAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetAvailabilityZoneID(*v)
+	}
+	return _u
+}
+
+// ClearAvailabilityZoneID clears the value of the "availability_zone_id" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) ClearAvailabilityZoneID() *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearAvailabilityZoneID()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetState(v string) *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetNillableState(v *string) *BronzeAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) ClearState() *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetDefaultForAz sets the "default_for_az" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetDefaultForAz(v bool) *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.SetDefaultForAz(v)
+	return _u
+}
+
+// SetNillableDefaultForAz sets the "default_for_az" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetNillableDefaultForAz(v *bool) *BronzeAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetDefaultForAz(*v)
+	}
+	return _u
+}
+
+// ClearDefaultForAz clears the value of the "default_for_az" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) ClearDefaultForAz() *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearDefaultForAz()
+	return _u
+}
+
+// SetMapPublicIPOnLaunch sets the "map_public_ip_on_launch" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetMapPublicIPOnLaunch(v bool) *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.SetMapPublicIPOnLaunch(v)
+	return _u
+}
+
+// SetNillableMapPublicIPOnLaunch sets the "map_public_ip_on_launch" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetNillableMapPublicIPOnLaunch(v *bool) *BronzeAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetMapPublicIPOnLaunch(*v)
+	}
+	return _u
+}
+
+// ClearMapPublicIPOnLaunch clears the value of the "map_public_ip_on_launch" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) ClearMapPublicIPOnLaunch() *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearMapPublicIPOnLaunch()
+	return _u
+}
+
+// SetAssignIpv6AddressOnCreation sets the "assign_ipv6_address_on_creation" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetAssignIpv6AddressOnCreation(v bool) *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.SetAssignIpv6AddressOnCreation(v)
+	return _u
+}
+
+// SetNillableAssignIpv6AddressOnCreation sets the "assign_ipv6_address_on_creation" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetNillableAssignIpv6AddressOnCreation(v *bool) *BronzeAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetAssignIpv6AddressOnCreation(*v)
+	}
+	return _u
+}
+
+// ClearAssignIpv6AddressOnCreation clears the value of the "assign_ipv6_address_on_creation" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) ClearAssignIpv6AddressOnCreation() *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearAssignIpv6AddressOnCreation()
+	return _u
+}
+
+// SetSubnetArn sets the "subnet_arn" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetSubnetArn(v string) *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.SetSubnetArn(v)
+	return _u
+}
+
+// SetNillableSubnetArn sets the "subnet_arn" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetNillableSubnetArn(v *string) *BronzeAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetSubnetArn(*v)
+	}
+	return _u
+}
+
+// ClearSubnetArn clears the value of the "subnet_arn" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) ClearSubnetArn() *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearSubnetArn()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetOwnerID(v string) *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetNillableOwnerID(v *string) *BronzeAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) ClearOwnerID() *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetIpv6CidrBlockAssociationsJSON sets the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetIpv6CidrBlockAssociationsJSON(v json.RawMessage) *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.SetIpv6CidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// AppendIpv6CidrBlockAssociationsJSON appends value to the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) AppendIpv6CidrBlockAssociationsJSON(v json.RawMessage) *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.AppendIpv6CidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// ClearIpv6CidrBlockAssociationsJSON clears the value of the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) ClearIpv6CidrBlockAssociationsJSON() *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearIpv6CidrBlockAssociationsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) ClearTagsJSON() *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetAccountID(v string) *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetNillableAccountID(v *string) *BronzeAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetRegion(v string) *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SetNillableRegion(v *string) *BronzeAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2SubnetMutation object of the builder.
+func (_u *BronzeAWSEC2SubnetUpdateOne) Mutation() *BronzeAWSEC2SubnetMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2SubnetUpdate builder.
+func (_u *BronzeAWSEC2SubnetUpdateOne) Where(ps ...predicate.BronzeAWSEC2Subnet) *BronzeAWSEC2SubnetUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeAWSEC2SubnetUpdateOne) Select(field string, fields ...string) *BronzeAWSEC2SubnetUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeAWSEC2Subnet entity.
+func (_u *BronzeAWSEC2SubnetUpdateOne) Save(ctx context.Context) (*BronzeAWSEC2Subnet, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2SubnetUpdateOne) SaveX(ctx context.Context) *BronzeAWSEC2Subnet {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeAWSEC2SubnetUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2SubnetUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2SubnetUpdateOne) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2subnet.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2Subnet.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2subnet.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2Subnet.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2SubnetUpdateOne) sqlSave(ctx context.Context) (_node *BronzeAWSEC2Subnet, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2subnet.Table, bronzeawsec2subnet.Columns, sqlgraph.NewFieldSpec(bronzeawsec2subnet.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeAWSEC2Subnet.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawsec2subnet.FieldID)
+		for _, f := range fields {
+			if !bronzeawsec2subnet.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzeawsec2subnet.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CidrBlock(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldCidrBlock, field.TypeString, value)
+	}
+	if _u.mutation.CidrBlockCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldCidrBlock, field.TypeString)
+	}
+	if value, ok := _u.mutation.AvailabilityZone(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldAvailabilityZone, field.TypeString, value)
+	}
+	if _u.mutation.AvailabilityZoneCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldAvailabilityZone, field.TypeString)
+	}
+	if value, ok := _u.mutation.AvailabilityZoneID(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldAvailabilityZoneID, field.TypeString, value)
+	}
+	if _u.mutation.AvailabilityZoneIDCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldAvailabilityZoneID, field.TypeString)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldState, field.TypeString, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldState, field.TypeString)
+	}
+	if value, ok := _u.mutation.DefaultForAz(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldDefaultForAz, field.TypeBool, value)
+	}
+	if _u.mutation.DefaultForAzCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldDefaultForAz, field.TypeBool)
+	}
+	if value, ok := _u.mutation.MapPublicIPOnLaunch(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldMapPublicIPOnLaunch, field.TypeBool, value)
+	}
+	if _u.mutation.MapPublicIPOnLaunchCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldMapPublicIPOnLaunch, field.TypeBool)
+	}
+	if value, ok := _u.mutation.AssignIpv6AddressOnCreation(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldAssignIpv6AddressOnCreation, field.TypeBool, value)
+	}
+	if _u.mutation.AssignIpv6AddressOnCreationCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldAssignIpv6AddressOnCreation, field.TypeBool)
+	}
+	if value, ok := _u.mutation.SubnetArn(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldSubnetArn, field.TypeString, value)
+	}
+	if _u.mutation.SubnetArnCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldSubnetArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Ipv6CidrBlockAssociationsJSON(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldIpv6CidrBlockAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIpv6CidrBlockAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2subnet.FieldIpv6CidrBlockAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.Ipv6CidrBlockAssociationsJSONCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldIpv6CidrBlockAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2subnet.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2subnet.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2subnet.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2Subnet
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeAWSEC2Subnet{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2subnet.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,259 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2vpc"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeAWSEC2VPC is the model entity for the BronzeAWSEC2VPC schema.
+type BronzeAWSEC2VPC struct {
+	config `json:"-"`
+	// ID of the ent.
+	// VPC ID (vpc-xxxx), used as primary key
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Value of the Name tag
+	Name string `json:"name,omitempty"`
+	// Primary IPv4 CIDR block
+	CidrBlock string `json:"cidr_block,omitempty"`
+	// VPC state: pending or available
+	State string `json:"state,omitempty"`
+	// IsDefault holds the value of the "is_default" field.
+	IsDefault bool `json:"is_default,omitempty"`
+	// default, dedicated or host
+	InstanceTenancy string `json:"instance_tenancy,omitempty"`
+	// DhcpOptionsID holds the value of the "dhcp_options_id" field.
+	DhcpOptionsID string `json:"dhcp_options_id,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID string `json:"owner_id,omitempty"`
+	// CidrBlockAssociationsJSON holds the value of the "cidr_block_associations_json" field.
+	CidrBlockAssociationsJSON json.RawMessage `json:"cidr_block_associations_json,omitempty"`
+	// Ipv6CidrBlockAssociationsJSON holds the value of the "ipv6_cidr_block_associations_json" field.
+	Ipv6CidrBlockAssociationsJSON json.RawMessage `json:"ipv6_cidr_block_associations_json,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Region holds the value of the "region" field.
+	Region       string `json:"region,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeAWSEC2VPC) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsec2vpc.FieldCidrBlockAssociationsJSON, bronzeawsec2vpc.FieldIpv6CidrBlockAssociationsJSON, bronzeawsec2vpc.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzeawsec2vpc.FieldIsDefault:
+			values[i] = new(sql.NullBool)
+		case bronzeawsec2vpc.FieldID, bronzeawsec2vpc.FieldName, bronzeawsec2vpc.FieldCidrBlock, bronzeawsec2vpc.FieldState, bronzeawsec2vpc.FieldInstanceTenancy, bronzeawsec2vpc.FieldDhcpOptionsID, bronzeawsec2vpc.FieldOwnerID, bronzeawsec2vpc.FieldAccountID, bronzeawsec2vpc.FieldRegion:
+			values[i] = new(sql.NullString)
+		case bronzeawsec2vpc.FieldCollectedAt, bronzeawsec2vpc.FieldFirstCollectedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeAWSEC2VPC fields.
+func (_m *BronzeAWSEC2VPC) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsec2vpc.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzeawsec2vpc.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzeawsec2vpc.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzeawsec2vpc.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzeawsec2vpc.FieldCidrBlock:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cidr_block", values[i])
+			} else if value.Valid {
+				_m.CidrBlock = value.String
+			}
+		case bronzeawsec2vpc.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				_m.State = value.String
+			}
+		case bronzeawsec2vpc.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				_m.IsDefault = value.Bool
+			}
+		case bronzeawsec2vpc.FieldInstanceTenancy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field instance_tenancy", values[i])
+			} else if value.Valid {
+				_m.InstanceTenancy = value.String
+			}
+		case bronzeawsec2vpc.FieldDhcpOptionsID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dhcp_options_id", values[i])
+			} else if value.Valid {
+				_m.DhcpOptionsID = value.String
+			}
+		case bronzeawsec2vpc.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				_m.OwnerID = value.String
+			}
+		case bronzeawsec2vpc.FieldCidrBlockAssociationsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field cidr_block_associations_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.CidrBlockAssociationsJSON); err != nil {
+					return fmt.Errorf("unmarshal field cidr_block_associations_json: %w", err)
+				}
+			}
+		case bronzeawsec2vpc.FieldIpv6CidrBlockAssociationsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ipv6_cidr_block_associations_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Ipv6CidrBlockAssociationsJSON); err != nil {
+					return fmt.Errorf("unmarshal field ipv6_cidr_block_associations_json: %w", err)
+				}
+			}
+		case bronzeawsec2vpc.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzeawsec2vpc.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzeawsec2vpc.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeAWSEC2VPC.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeAWSEC2VPC) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeAWSEC2VPC.
+// Note that you need to call BronzeAWSEC2VPC.Unwrap() before calling this method if this BronzeAWSEC2VPC
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeAWSEC2VPC) Update() *BronzeAWSEC2VPCUpdateOne {
+	return NewBronzeAWSEC2VPCClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeAWSEC2VPC entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeAWSEC2VPC) Unwrap() *BronzeAWSEC2VPC {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ec2: BronzeAWSEC2VPC is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeAWSEC2VPC) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeAWSEC2VPC(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("cidr_block=")
+	builder.WriteString(_m.CidrBlock)
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(_m.State)
+	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsDefault))
+	builder.WriteString(", ")
+	builder.WriteString("instance_tenancy=")
+	builder.WriteString(_m.InstanceTenancy)
+	builder.WriteString(", ")
+	builder.WriteString("dhcp_options_id=")
+	builder.WriteString(_m.DhcpOptionsID)
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(_m.OwnerID)
+	builder.WriteString(", ")
+	builder.WriteString("cidr_block_associations_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CidrBlockAssociationsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("ipv6_cidr_block_associations_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Ipv6CidrBlockAssociationsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeAWSEC2VPCs is a parsable slice of BronzeAWSEC2VPC.
+type BronzeAWSEC2VPCs []*BronzeAWSEC2VPC
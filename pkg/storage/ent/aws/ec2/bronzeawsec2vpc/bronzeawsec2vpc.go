@@ -0,0 +1,143 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawsec2vpc
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzeawsec2vpc type in the database.
+	Label = "bronze_awsec2vpc"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldCidrBlock holds the string denoting the cidr_block field in the database.
+	FieldCidrBlock = "cidr_block"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldIsDefault holds the string denoting the is_default field in the database.
+	FieldIsDefault = "is_default"
+	// FieldInstanceTenancy holds the string denoting the instance_tenancy field in the database.
+	FieldInstanceTenancy = "instance_tenancy"
+	// FieldDhcpOptionsID holds the string denoting the dhcp_options_id field in the database.
+	FieldDhcpOptionsID = "dhcp_options_id"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
+	// FieldCidrBlockAssociationsJSON holds the string denoting the cidr_block_associations_json field in the database.
+	FieldCidrBlockAssociationsJSON = "cidr_block_associations_json"
+	// FieldIpv6CidrBlockAssociationsJSON holds the string denoting the ipv6_cidr_block_associations_json field in the database.
+	FieldIpv6CidrBlockAssociationsJSON = "ipv6_cidr_block_associations_json"
+	// FieldTagsJSON holds the string denoting the tags_json field in the database.
+	FieldTagsJSON = "tags_json"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
+	// Table holds the table name of the bronzeawsec2vpc in the database.
+	Table = "aws_ec2_vpcs"
+)
+
+// Columns holds all SQL columns for bronzeawsec2vpc fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldName,
+	FieldCidrBlock,
+	FieldState,
+	FieldIsDefault,
+	FieldInstanceTenancy,
+	FieldDhcpOptionsID,
+	FieldOwnerID,
+	FieldCidrBlockAssociationsJSON,
+	FieldIpv6CidrBlockAssociationsJSON,
+	FieldTagsJSON,
+	FieldAccountID,
+	FieldRegion,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	AccountIDValidator func(string) error
+	// RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	RegionValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BronzeAWSEC2VPC queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByCidrBlock orders the results by the cidr_block field.
+func ByCidrBlock(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCidrBlock, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByIsDefault orders the results by the is_default field.
+func ByIsDefault(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsDefault, opts...).ToFunc()
+}
+
+// ByInstanceTenancy orders the results by the instance_tenancy field.
+func ByInstanceTenancy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstanceTenancy, opts...).ToFunc()
+}
+
+// ByDhcpOptionsID orders the results by the dhcp_options_id field.
+func ByDhcpOptionsID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDhcpOptionsID, opts...).ToFunc()
+}
+
+// ByOwnerID orders the results by the owner_id field.
+func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByRegion orders the results by the region field.
+func ByRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegion, opts...).ToFunc()
+}
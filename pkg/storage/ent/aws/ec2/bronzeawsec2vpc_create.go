@@ -0,0 +1,402 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2vpc"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2VPCCreate is the builder for creating a BronzeAWSEC2VPC entity.
+type BronzeAWSEC2VPCCreate struct {
+	config
+	mutation *BronzeAWSEC2VPCMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeAWSEC2VPCCreate) SetCollectedAt(v time.Time) *BronzeAWSEC2VPCCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeAWSEC2VPCCreate) SetFirstCollectedAt(v time.Time) *BronzeAWSEC2VPCCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeAWSEC2VPCCreate) SetName(v string) *BronzeAWSEC2VPCCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *BronzeAWSEC2VPCCreate) SetNillableName(v *string) *BronzeAWSEC2VPCCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetCidrBlock sets the "cidr_block" field.
+func (_c *BronzeAWSEC2VPCCreate) SetCidrBlock(v string) *BronzeAWSEC2VPCCreate {
+	_c.mutation.SetCidrBlock(v)
+	return _c
+}
+
+// SetNillableCidrBlock sets the "cidr_block" field if the given value is not nil.
+func (_c *BronzeAWSEC2VPCCreate) SetNillableCidrBlock(v *string) *BronzeAWSEC2VPCCreate {
+	if v != nil {
+		_c.SetCidrBlock(*v)
+	}
+	return _c
+}
+
+// SetState sets the "state" field.
+func (_c *BronzeAWSEC2VPCCreate) SetState(v string) *BronzeAWSEC2VPCCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_c *BronzeAWSEC2VPCCreate) SetNillableState(v *string) *BronzeAWSEC2VPCCreate {
+	if v != nil {
+		_c.SetState(*v)
+	}
+	return _c
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_c *BronzeAWSEC2VPCCreate) SetIsDefault(v bool) *BronzeAWSEC2VPCCreate {
+	_c.mutation.SetIsDefault(v)
+	return _c
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_c *BronzeAWSEC2VPCCreate) SetNillableIsDefault(v *bool) *BronzeAWSEC2VPCCreate {
+	if v != nil {
+		_c.SetIsDefault(*v)
+	}
+	return _c
+}
+
+// SetInstanceTenancy sets the "instance_tenancy" field.
+func (_c *BronzeAWSEC2VPCCreate) SetInstanceTenancy(v string) *BronzeAWSEC2VPCCreate {
+	_c.mutation.SetInstanceTenancy(v)
+	return _c
+}
+
+// SetNillableInstanceTenancy sets the "instance_tenancy" field if the given value is not nil.
+func (_c *BronzeAWSEC2VPCCreate) SetNillableInstanceTenancy(v *string) *BronzeAWSEC2VPCCreate {
+	if v != nil {
+		_c.SetInstanceTenancy(*v)
+	}
+	return _c
+}
+
+// SetDhcpOptionsID sets the "dhcp_options_id" field.
+func (_c *BronzeAWSEC2VPCCreate) SetDhcpOptionsID(v string) *BronzeAWSEC2VPCCreate {
+	_c.mutation.SetDhcpOptionsID(v)
+	return _c
+}
+
+// SetNillableDhcpOptionsID sets the "dhcp_options_id" field if the given value is not nil.
+func (_c *BronzeAWSEC2VPCCreate) SetNillableDhcpOptionsID(v *string) *BronzeAWSEC2VPCCreate {
+	if v != nil {
+		_c.SetDhcpOptionsID(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *BronzeAWSEC2VPCCreate) SetOwnerID(v string) *BronzeAWSEC2VPCCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *BronzeAWSEC2VPCCreate) SetNillableOwnerID(v *string) *BronzeAWSEC2VPCCreate {
+	if v != nil {
+		_c.SetOwnerID(*v)
+	}
+	return _c
+}
+
+// SetCidrBlockAssociationsJSON sets the "cidr_block_associations_json" field.
+func (_c *BronzeAWSEC2VPCCreate) SetCidrBlockAssociationsJSON(v json.RawMessage) *BronzeAWSEC2VPCCreate {
+	_c.mutation.SetCidrBlockAssociationsJSON(v)
+	return _c
+}
+
+// SetIpv6CidrBlockAssociationsJSON sets the "ipv6_cidr_block_associations_json" field.
+func (_c *BronzeAWSEC2VPCCreate) SetIpv6CidrBlockAssociationsJSON(v json.RawMessage) *BronzeAWSEC2VPCCreate {
+	_c.mutation.SetIpv6CidrBlockAssociationsJSON(v)
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeAWSEC2VPCCreate) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2VPCCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeAWSEC2VPCCreate) SetAccountID(v string) *BronzeAWSEC2VPCCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BronzeAWSEC2VPCCreate) SetRegion(v string) *BronzeAWSEC2VPCCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeAWSEC2VPCCreate) SetID(v string) *BronzeAWSEC2VPCCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeAWSEC2VPCMutation object of the builder.
+func (_c *BronzeAWSEC2VPCCreate) Mutation() *BronzeAWSEC2VPCMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeAWSEC2VPC in the database.
+func (_c *BronzeAWSEC2VPCCreate) Save(ctx context.Context) (*BronzeAWSEC2VPC, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeAWSEC2VPCCreate) SaveX(ctx context.Context) *BronzeAWSEC2VPC {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSEC2VPCCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSEC2VPCCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeAWSEC2VPCCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`ec2: missing required field "BronzeAWSEC2VPC.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`ec2: missing required field "BronzeAWSEC2VPC.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ec2: missing required field "BronzeAWSEC2VPC.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzeawsec2vpc.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2VPC.account_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`ec2: missing required field "BronzeAWSEC2VPC.region"`)}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := bronzeawsec2vpc.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2VPC.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeAWSEC2VPCCreate) sqlSave(ctx context.Context) (*BronzeAWSEC2VPC, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeAWSEC2VPC.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeAWSEC2VPCCreate) createSpec() (*BronzeAWSEC2VPC, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeAWSEC2VPC{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzeawsec2vpc.Table, sqlgraph.NewFieldSpec(bronzeawsec2vpc.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeAWSEC2VPC
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.CidrBlock(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldCidrBlock, field.TypeString, value)
+		_node.CidrBlock = value
+	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	if value, ok := _c.mutation.IsDefault(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if value, ok := _c.mutation.InstanceTenancy(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldInstanceTenancy, field.TypeString, value)
+		_node.InstanceTenancy = value
+	}
+	if value, ok := _c.mutation.DhcpOptionsID(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldDhcpOptionsID, field.TypeString, value)
+		_node.DhcpOptionsID = value
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = value
+	}
+	if value, ok := _c.mutation.CidrBlockAssociationsJSON(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldCidrBlockAssociationsJSON, field.TypeJSON, value)
+		_node.CidrBlockAssociationsJSON = value
+	}
+	if value, ok := _c.mutation.Ipv6CidrBlockAssociationsJSON(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldIpv6CidrBlockAssociationsJSON, field.TypeJSON, value)
+		_node.Ipv6CidrBlockAssociationsJSON = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	return _node, _spec
+}
+
+// BronzeAWSEC2VPCCreateBulk is the builder for creating many BronzeAWSEC2VPC entities in bulk.
+type BronzeAWSEC2VPCCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeAWSEC2VPCCreate
+}
+
+// Save creates the BronzeAWSEC2VPC entities in the database.
+func (_c *BronzeAWSEC2VPCCreateBulk) Save(ctx context.Context) ([]*BronzeAWSEC2VPC, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeAWSEC2VPC, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeAWSEC2VPCMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeAWSEC2VPCCreateBulk) SaveX(ctx context.Context) []*BronzeAWSEC2VPC {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSEC2VPCCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSEC2VPCCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,859 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2vpc"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSEC2VPCUpdate is the builder for updating BronzeAWSEC2VPC entities.
+type BronzeAWSEC2VPCUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSEC2VPCMutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2VPCUpdate builder.
+func (_u *BronzeAWSEC2VPCUpdate) Where(ps ...predicate.BronzeAWSEC2VPC) *BronzeAWSEC2VPCUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2VPCUpdate) SetCollectedAt(v time.Time) *BronzeAWSEC2VPCUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdate) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeAWSEC2VPCUpdate) SetName(v string) *BronzeAWSEC2VPCUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdate) SetNillableName(v *string) *BronzeAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeAWSEC2VPCUpdate) ClearName() *BronzeAWSEC2VPCUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetCidrBlock sets the "cidr_block" field.
+func (_u *BronzeAWSEC2VPCUpdate) SetCidrBlock(v string) *BronzeAWSEC2VPCUpdate {
+	_u.mutation.SetCidrBlock(v)
+	return _u
+}
+
+// SetNillableCidrBlock sets the "cidr_block" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdate) SetNillableCidrBlock(v *string) *BronzeAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetCidrBlock(*v)
+	}
+	return _u
+}
+
+// ClearCidrBlock clears the value of the "cidr_block" field.
+func (_u *BronzeAWSEC2VPCUpdate) ClearCidrBlock() *BronzeAWSEC2VPCUpdate {
+	_u.mutation.ClearCidrBlock()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *BronzeAWSEC2VPCUpdate) SetState(v string) *BronzeAWSEC2VPCUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdate) SetNillableState(v *string) *BronzeAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *BronzeAWSEC2VPCUpdate) ClearState() *BronzeAWSEC2VPCUpdate {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *BronzeAWSEC2VPCUpdate) SetIsDefault(v bool) *BronzeAWSEC2VPCUpdate {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdate) SetNillableIsDefault(v *bool) *BronzeAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// ClearIsDefault clears the value of the "is_default" field.
+func (_u *BronzeAWSEC2VPCUpdate) ClearIsDefault() *BronzeAWSEC2VPCUpdate {
+	_u.mutation.ClearIsDefault()
+	return _u
+}
+
+// SetInstanceTenancy sets the "instance_tenancy" field.
+func (_u *BronzeAWSEC2VPCUpdate) SetInstanceTenancy(v string) *BronzeAWSEC2VPCUpdate {
+	_u.mutation.SetInstanceTenancy(v)
+	return _u
+}
+
+// SetNillableInstanceTenancy sets the "instance_tenancy" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdate) SetNillableInstanceTenancy(v *string) *BronzeAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetInstanceTenancy(*v)
+	}
+	return _u
+}
+
+// ClearInstanceTenancy clears the value of the "instance_tenancy" field.
+func (_u *BronzeAWSEC2VPCUpdate) ClearInstanceTenancy() *BronzeAWSEC2VPCUpdate {
+	_u.mutation.ClearInstanceTenancy()
+	return _u
+}
+
+// SetDhcpOptionsID sets the "dhcp_options_id" field.
+func (_u *BronzeAWSEC2VPCUpdate) SetDhcpOptionsID(v string) *BronzeAWSEC2VPCUpdate {
+	_u.mutation.SetDhcpOptionsID(v)
+	return _u
+}
+
+// SetNillableDhcpOptionsID sets the "dhcp_options_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdate) SetNillableDhcpOptionsID(v *string) *BronzeAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetDhcpOptionsID(*v)
+	}
+	return _u
+}
+
+// ClearDhcpOptionsID clears the value of the "dhcp_options_id" field.
+func (_u *BronzeAWSEC2VPCUpdate) ClearDhcpOptionsID() *BronzeAWSEC2VPCUpdate {
+	_u.mutation.ClearDhcpOptionsID()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeAWSEC2VPCUpdate) SetOwnerID(v string) *BronzeAWSEC2VPCUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdate) SetNillableOwnerID(v *string) *BronzeAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeAWSEC2VPCUpdate) ClearOwnerID() *BronzeAWSEC2VPCUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetCidrBlockAssociationsJSON sets the "cidr_block_associations_json" field.
+func (_u *BronzeAWSEC2VPCUpdate) SetCidrBlockAssociationsJSON(v json.RawMessage) *BronzeAWSEC2VPCUpdate {
+	_u.mutation.SetCidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// AppendCidrBlockAssociationsJSON appends value to the "cidr_block_associations_json" field.
+func (_u *BronzeAWSEC2VPCUpdate) AppendCidrBlockAssociationsJSON(v json.RawMessage) *BronzeAWSEC2VPCUpdate {
+	_u.mutation.AppendCidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// ClearCidrBlockAssociationsJSON clears the value of the "cidr_block_associations_json" field.
+func (_u *BronzeAWSEC2VPCUpdate) ClearCidrBlockAssociationsJSON() *BronzeAWSEC2VPCUpdate {
+	_u.mutation.ClearCidrBlockAssociationsJSON()
+	return _u
+}
+
+// SetIpv6CidrBlockAssociationsJSON sets the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeAWSEC2VPCUpdate) SetIpv6CidrBlockAssociationsJSON(v json.RawMessage) *BronzeAWSEC2VPCUpdate {
+	_u.mutation.SetIpv6CidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// AppendIpv6CidrBlockAssociationsJSON appends value to the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeAWSEC2VPCUpdate) AppendIpv6CidrBlockAssociationsJSON(v json.RawMessage) *BronzeAWSEC2VPCUpdate {
+	_u.mutation.AppendIpv6CidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// ClearIpv6CidrBlockAssociationsJSON clears the value of the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeAWSEC2VPCUpdate) ClearIpv6CidrBlockAssociationsJSON() *BronzeAWSEC2VPCUpdate {
+	_u.mutation.ClearIpv6CidrBlockAssociationsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2VPCUpdate) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2VPCUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2VPCUpdate) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2VPCUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2VPCUpdate) ClearTagsJSON() *BronzeAWSEC2VPCUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2VPCUpdate) SetAccountID(v string) *BronzeAWSEC2VPCUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdate) SetNillableAccountID(v *string) *BronzeAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2VPCUpdate) SetRegion(v string) *BronzeAWSEC2VPCUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdate) SetNillableRegion(v *string) *BronzeAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2VPCMutation object of the builder.
+func (_u *BronzeAWSEC2VPCUpdate) Mutation() *BronzeAWSEC2VPCMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeAWSEC2VPCUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2VPCUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeAWSEC2VPCUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2VPCUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2VPCUpdate) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2vpc.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2VPC.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2vpc.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2VPC.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2VPCUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2vpc.Table, bronzeawsec2vpc.Columns, sqlgraph.NewFieldSpec(bronzeawsec2vpc.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.CidrBlock(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldCidrBlock, field.TypeString, value)
+	}
+	if _u.mutation.CidrBlockCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldCidrBlock, field.TypeString)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldState, field.TypeString, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldState, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldIsDefault, field.TypeBool, value)
+	}
+	if _u.mutation.IsDefaultCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldIsDefault, field.TypeBool)
+	}
+	if value, ok := _u.mutation.InstanceTenancy(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldInstanceTenancy, field.TypeString, value)
+	}
+	if _u.mutation.InstanceTenancyCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldInstanceTenancy, field.TypeString)
+	}
+	if value, ok := _u.mutation.DhcpOptionsID(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldDhcpOptionsID, field.TypeString, value)
+	}
+	if _u.mutation.DhcpOptionsIDCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldDhcpOptionsID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CidrBlockAssociationsJSON(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldCidrBlockAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCidrBlockAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2vpc.FieldCidrBlockAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.CidrBlockAssociationsJSONCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldCidrBlockAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Ipv6CidrBlockAssociationsJSON(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldIpv6CidrBlockAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIpv6CidrBlockAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2vpc.FieldIpv6CidrBlockAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.Ipv6CidrBlockAssociationsJSONCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldIpv6CidrBlockAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2vpc.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2VPC
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2vpc.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeAWSEC2VPCUpdateOne is the builder for updating a single BronzeAWSEC2VPC entity.
+type BronzeAWSEC2VPCUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeAWSEC2VPCMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetCollectedAt(v time.Time) *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetName(v string) *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetNillableName(v *string) *BronzeAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) ClearName() *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetCidrBlock sets the "cidr_block" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetCidrBlock(v string) *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.SetCidrBlock(v)
+	return _u
+}
+
+// SetNillableCidrBlock sets the "cidr_block" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetNillableCidrBlock(v *string) *BronzeAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetCidrBlock(*v)
+	}
+	return _u
+}
+
+// ClearCidrBlock clears the value of the "cidr_block" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) ClearCidrBlock() *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.ClearCidrBlock()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetState(v string) *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetNillableState(v *string) *BronzeAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) ClearState() *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetIsDefault(v bool) *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetNillableIsDefault(v *bool) *BronzeAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// ClearIsDefault clears the value of the "is_default" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) ClearIsDefault() *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.ClearIsDefault()
+	return _u
+}
+
+// SetInstanceTenancy sets the "instance_tenancy" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetInstanceTenancy(v string) *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.SetInstanceTenancy(v)
+	return _u
+}
+
+// SetNillableInstanceTenancy sets the "instance_tenancy" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetNillableInstanceTenancy(v *string) *BronzeAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetInstanceTenancy(*v)
+	}
+	return _u
+}
+
+// ClearInstanceTenancy clears the value of the "instance_tenancy" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) ClearInstanceTenancy() *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.ClearInstanceTenancy()
+	return _u
+}
+
+// SetDhcpOptionsID sets the "dhcp_options_id" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetDhcpOptionsID(v string) *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.SetDhcpOptionsID(v)
+	return _u
+}
+
+// SetNillableDhcpOptionsID sets the "dhcp_options_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetNillableDhcpOptionsID(v *string) *BronzeAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetDhcpOptionsID(*v)
+	}
+	return _u
+}
+
+// ClearDhcpOptionsID clears the value of the "dhcp_options_id" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) ClearDhcpOptionsID() *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.ClearDhcpOptionsID()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetOwnerID(v string) *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetNillableOwnerID(v *string) *BronzeAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) ClearOwnerID() *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetCidrBlockAssociationsJSON sets the "cidr_block_associations_json" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetCidrBlockAssociationsJSON(v json.RawMessage) *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.SetCidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// AppendCidrBlockAssociationsJSON appends value to the "cidr_block_associations_json" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) AppendCidrBlockAssociationsJSON(v json.RawMessage) *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.AppendCidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// ClearCidrBlockAssociationsJSON clears the value of the "cidr_block_associations_json" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) ClearCidrBlockAssociationsJSON() *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.ClearCidrBlockAssociationsJSON()
+	return _u
+}
+
+// SetIpv6CidrBlockAssociationsJSON sets the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetIpv6CidrBlockAssociationsJSON(v json.RawMessage) *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.SetIpv6CidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// AppendIpv6CidrBlockAssociationsJSON appends value to the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) AppendIpv6CidrBlockAssociationsJSON(v json.RawMessage) *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.AppendIpv6CidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// ClearIpv6CidrBlockAssociationsJSON clears the value of the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) ClearIpv6CidrBlockAssociationsJSON() *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.ClearIpv6CidrBlockAssociationsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) ClearTagsJSON() *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetAccountID(v string) *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetNillableAccountID(v *string) *BronzeAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetRegion(v string) *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSEC2VPCUpdateOne) SetNillableRegion(v *string) *BronzeAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSEC2VPCMutation object of the builder.
+func (_u *BronzeAWSEC2VPCUpdateOne) Mutation() *BronzeAWSEC2VPCMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeAWSEC2VPCUpdate builder.
+func (_u *BronzeAWSEC2VPCUpdateOne) Where(ps ...predicate.BronzeAWSEC2VPC) *BronzeAWSEC2VPCUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeAWSEC2VPCUpdateOne) Select(field string, fields ...string) *BronzeAWSEC2VPCUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeAWSEC2VPC entity.
+func (_u *BronzeAWSEC2VPCUpdateOne) Save(ctx context.Context) (*BronzeAWSEC2VPC, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSEC2VPCUpdateOne) SaveX(ctx context.Context) *BronzeAWSEC2VPC {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeAWSEC2VPCUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSEC2VPCUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSEC2VPCUpdateOne) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsec2vpc.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2VPC.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawsec2vpc.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeAWSEC2VPC.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSEC2VPCUpdateOne) sqlSave(ctx context.Context) (_node *BronzeAWSEC2VPC, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsec2vpc.Table, bronzeawsec2vpc.Columns, sqlgraph.NewFieldSpec(bronzeawsec2vpc.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeAWSEC2VPC.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawsec2vpc.FieldID)
+		for _, f := range fields {
+			if !bronzeawsec2vpc.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzeawsec2vpc.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.CidrBlock(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldCidrBlock, field.TypeString, value)
+	}
+	if _u.mutation.CidrBlockCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldCidrBlock, field.TypeString)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldState, field.TypeString, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldState, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldIsDefault, field.TypeBool, value)
+	}
+	if _u.mutation.IsDefaultCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldIsDefault, field.TypeBool)
+	}
+	if value, ok := _u.mutation.InstanceTenancy(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldInstanceTenancy, field.TypeString, value)
+	}
+	if _u.mutation.InstanceTenancyCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldInstanceTenancy, field.TypeString)
+	}
+	if value, ok := _u.mutation.DhcpOptionsID(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldDhcpOptionsID, field.TypeString, value)
+	}
+	if _u.mutation.DhcpOptionsIDCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldDhcpOptionsID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CidrBlockAssociationsJSON(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldCidrBlockAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCidrBlockAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2vpc.FieldCidrBlockAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.CidrBlockAssociationsJSONCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldCidrBlockAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Ipv6CidrBlockAssociationsJSON(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldIpv6CidrBlockAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIpv6CidrBlockAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2vpc.FieldIpv6CidrBlockAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.Ipv6CidrBlockAssociationsJSONCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldIpv6CidrBlockAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsec2vpc.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsec2vpc.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawsec2vpc.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSEC2VPC
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeAWSEC2VPC{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsec2vpc.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,301 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2address"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryAWSEC2Address is the model entity for the BronzeHistoryAWSEC2Address schema.
+type BronzeHistoryAWSEC2Address struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze Elastic IP address by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// PublicIP holds the value of the "public_ip" field.
+	PublicIP string `json:"public_ip,omitempty"`
+	// Domain holds the value of the "domain" field.
+	Domain string `json:"domain,omitempty"`
+	// AssociationID holds the value of the "association_id" field.
+	AssociationID string `json:"association_id,omitempty"`
+	// InstanceID holds the value of the "instance_id" field.
+	InstanceID string `json:"instance_id,omitempty"`
+	// NetworkInterfaceID holds the value of the "network_interface_id" field.
+	NetworkInterfaceID string `json:"network_interface_id,omitempty"`
+	// NetworkInterfaceOwnerID holds the value of the "network_interface_owner_id" field.
+	NetworkInterfaceOwnerID string `json:"network_interface_owner_id,omitempty"`
+	// PrivateIPAddress holds the value of the "private_ip_address" field.
+	PrivateIPAddress string `json:"private_ip_address,omitempty"`
+	// PublicIpv4Pool holds the value of the "public_ipv4_pool" field.
+	PublicIpv4Pool string `json:"public_ipv4_pool,omitempty"`
+	// NetworkBorderGroup holds the value of the "network_border_group" field.
+	NetworkBorderGroup string `json:"network_border_group,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Region holds the value of the "region" field.
+	Region       string `json:"region,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryAWSEC2Address) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsec2address.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzehistoryawsec2address.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryawsec2address.FieldResourceID, bronzehistoryawsec2address.FieldName, bronzehistoryawsec2address.FieldPublicIP, bronzehistoryawsec2address.FieldDomain, bronzehistoryawsec2address.FieldAssociationID, bronzehistoryawsec2address.FieldInstanceID, bronzehistoryawsec2address.FieldNetworkInterfaceID, bronzehistoryawsec2address.FieldNetworkInterfaceOwnerID, bronzehistoryawsec2address.FieldPrivateIPAddress, bronzehistoryawsec2address.FieldPublicIpv4Pool, bronzehistoryawsec2address.FieldNetworkBorderGroup, bronzehistoryawsec2address.FieldAccountID, bronzehistoryawsec2address.FieldRegion:
+			values[i] = new(sql.NullString)
+		case bronzehistoryawsec2address.FieldValidFrom, bronzehistoryawsec2address.FieldValidTo, bronzehistoryawsec2address.FieldCollectedAt, bronzehistoryawsec2address.FieldFirstCollectedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryAWSEC2Address fields.
+func (_m *BronzeHistoryAWSEC2Address) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsec2address.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryawsec2address.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryawsec2address.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryawsec2address.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryawsec2address.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryawsec2address.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryawsec2address.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzehistoryawsec2address.FieldPublicIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field public_ip", values[i])
+			} else if value.Valid {
+				_m.PublicIP = value.String
+			}
+		case bronzehistoryawsec2address.FieldDomain:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field domain", values[i])
+			} else if value.Valid {
+				_m.Domain = value.String
+			}
+		case bronzehistoryawsec2address.FieldAssociationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field association_id", values[i])
+			} else if value.Valid {
+				_m.AssociationID = value.String
+			}
+		case bronzehistoryawsec2address.FieldInstanceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field instance_id", values[i])
+			} else if value.Valid {
+				_m.InstanceID = value.String
+			}
+		case bronzehistoryawsec2address.FieldNetworkInterfaceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network_interface_id", values[i])
+			} else if value.Valid {
+				_m.NetworkInterfaceID = value.String
+			}
+		case bronzehistoryawsec2address.FieldNetworkInterfaceOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network_interface_owner_id", values[i])
+			} else if value.Valid {
+				_m.NetworkInterfaceOwnerID = value.String
+			}
+		case bronzehistoryawsec2address.FieldPrivateIPAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field private_ip_address", values[i])
+			} else if value.Valid {
+				_m.PrivateIPAddress = value.String
+			}
+		case bronzehistoryawsec2address.FieldPublicIpv4Pool:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field public_ipv4_pool", values[i])
+			} else if value.Valid {
+				_m.PublicIpv4Pool = value.String
+			}
+		case bronzehistoryawsec2address.FieldNetworkBorderGroup:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network_border_group", values[i])
+			} else if value.Valid {
+				_m.NetworkBorderGroup = value.String
+			}
+		case bronzehistoryawsec2address.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzehistoryawsec2address.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzehistoryawsec2address.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryAWSEC2Address.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryAWSEC2Address) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryAWSEC2Address.
+// Note that you need to call BronzeHistoryAWSEC2Address.Unwrap() before calling this method if this BronzeHistoryAWSEC2Address
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryAWSEC2Address) Update() *BronzeHistoryAWSEC2AddressUpdateOne {
+	return NewBronzeHistoryAWSEC2AddressClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryAWSEC2Address entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryAWSEC2Address) Unwrap() *BronzeHistoryAWSEC2Address {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ec2: BronzeHistoryAWSEC2Address is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryAWSEC2Address) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryAWSEC2Address(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("public_ip=")
+	builder.WriteString(_m.PublicIP)
+	builder.WriteString(", ")
+	builder.WriteString("domain=")
+	builder.WriteString(_m.Domain)
+	builder.WriteString(", ")
+	builder.WriteString("association_id=")
+	builder.WriteString(_m.AssociationID)
+	builder.WriteString(", ")
+	builder.WriteString("instance_id=")
+	builder.WriteString(_m.InstanceID)
+	builder.WriteString(", ")
+	builder.WriteString("network_interface_id=")
+	builder.WriteString(_m.NetworkInterfaceID)
+	builder.WriteString(", ")
+	builder.WriteString("network_interface_owner_id=")
+	builder.WriteString(_m.NetworkInterfaceOwnerID)
+	builder.WriteString(", ")
+	builder.WriteString("private_ip_address=")
+	builder.WriteString(_m.PrivateIPAddress)
+	builder.WriteString(", ")
+	builder.WriteString("public_ipv4_pool=")
+	builder.WriteString(_m.PublicIpv4Pool)
+	builder.WriteString(", ")
+	builder.WriteString("network_border_group=")
+	builder.WriteString(_m.NetworkBorderGroup)
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryAWSEC2Addresses is a parsable slice of BronzeHistoryAWSEC2Address.
+type BronzeHistoryAWSEC2Addresses []*BronzeHistoryAWSEC2Address
@@ -0,0 +1,486 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2address"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2AddressCreate is the builder for creating a BronzeHistoryAWSEC2Address entity.
+type BronzeHistoryAWSEC2AddressCreate struct {
+	config
+	mutation *BronzeHistoryAWSEC2AddressMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetValidFrom(v time.Time) *BronzeHistoryAWSEC2AddressCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetValidTo(v time.Time) *BronzeHistoryAWSEC2AddressCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2AddressCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2AddressCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2AddressCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetResourceID(v string) *BronzeHistoryAWSEC2AddressCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetName(v string) *BronzeHistoryAWSEC2AddressCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetNillableName(v *string) *BronzeHistoryAWSEC2AddressCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetPublicIP sets the "public_ip" field.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetPublicIP(v string) *BronzeHistoryAWSEC2AddressCreate {
+	_c.mutation.SetPublicIP(v)
+	return _c
+}
+
+// SetNillablePublicIP sets the "public_ip" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetNillablePublicIP(v *string) *BronzeHistoryAWSEC2AddressCreate {
+	if v != nil {
+		_c.SetPublicIP(*v)
+	}
+	return _c
+}
+
+// SetDomain sets the "domain" field.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetDomain(v string) *BronzeHistoryAWSEC2AddressCreate {
+	_c.mutation.SetDomain(v)
+	return _c
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetNillableDomain(v *string) *BronzeHistoryAWSEC2AddressCreate {
+	if v != nil {
+		_c.SetDomain(*v)
+	}
+	return _c
+}
+
+// SetAssociationID sets the "association_id" field.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetAssociationID(v string) *BronzeHistoryAWSEC2AddressCreate {
+	_c.mutation.SetAssociationID(v)
+	return _c
+}
+
+// SetNillableAssociationID sets the "association_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetNillableAssociationID(v *string) *BronzeHistoryAWSEC2AddressCreate {
+	if v != nil {
+		_c.SetAssociationID(*v)
+	}
+	return _c
+}
+
+// SetInstanceID sets the "instance_id" field.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetInstanceID(v string) *BronzeHistoryAWSEC2AddressCreate {
+	_c.mutation.SetInstanceID(v)
+	return _c
+}
+
+// SetNillableInstanceID sets the "instance_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetNillableInstanceID(v *string) *BronzeHistoryAWSEC2AddressCreate {
+	if v != nil {
+		_c.SetInstanceID(*v)
+	}
+	return _c
+}
+
+// SetNetworkInterfaceID sets the "network_interface_id" field.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetNetworkInterfaceID(v string) *BronzeHistoryAWSEC2AddressCreate {
+	_c.mutation.SetNetworkInterfaceID(v)
+	return _c
+}
+
+// SetNillableNetworkInterfaceID sets the "network_interface_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetNillableNetworkInterfaceID(v *string) *BronzeHistoryAWSEC2AddressCreate {
+	if v != nil {
+		_c.SetNetworkInterfaceID(*v)
+	}
+	return _c
+}
+
+// SetNetworkInterfaceOwnerID sets the "network_interface_owner_id" field.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetNetworkInterfaceOwnerID(v string) *BronzeHistoryAWSEC2AddressCreate {
+	_c.mutation.SetNetworkInterfaceOwnerID(v)
+	return _c
+}
+
+// SetNillableNetworkInterfaceOwnerID sets the "network_interface_owner_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetNillableNetworkInterfaceOwnerID(v *string) *BronzeHistoryAWSEC2AddressCreate {
+	if v != nil {
+		_c.SetNetworkInterfaceOwnerID(*v)
+	}
+	return _c
+}
+
+// SetPrivateIPAddress sets the "private_ip_address" field.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetPrivateIPAddress(v string) *BronzeHistoryAWSEC2AddressCreate {
+	_c.mutation.SetPrivateIPAddress(v)
+	return _c
+}
+
+// SetNillablePrivateIPAddress sets the "private_ip_address" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetNillablePrivateIPAddress(v *string) *BronzeHistoryAWSEC2AddressCreate {
+	if v != nil {
+		_c.SetPrivateIPAddress(*v)
+	}
+	return _c
+}
+
+// SetPublicIpv4Pool sets the "public_ipv4_pool" field.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetPublicIpv4Pool(v string) *BronzeHistoryAWSEC2AddressCreate {
+	_c.mutation.SetPublicIpv4Pool(v)
+	return _c
+}
+
+// SetNillablePublicIpv4Pool sets the "public_ipv4_pool" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetNillablePublicIpv4Pool(v *string) *BronzeHistoryAWSEC2AddressCreate {
+	if v != nil {
+		_c.SetPublicIpv4Pool(*v)
+	}
+	return _c
+}
+
+// SetNetworkBorderGroup sets the "network_border_group" field.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetNetworkBorderGroup(v string) *BronzeHistoryAWSEC2AddressCreate {
+	_c.mutation.SetNetworkBorderGroup(v)
+	return _c
+}
+
+// SetNillableNetworkBorderGroup sets the "network_border_group" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetNillableNetworkBorderGroup(v *string) *BronzeHistoryAWSEC2AddressCreate {
+	if v != nil {
+		_c.SetNetworkBorderGroup(*v)
+	}
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2AddressCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetAccountID(v string) *BronzeHistoryAWSEC2AddressCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetRegion(v string) *BronzeHistoryAWSEC2AddressCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SetID(v uint) *BronzeHistoryAWSEC2AddressCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryAWSEC2AddressMutation object of the builder.
+func (_c *BronzeHistoryAWSEC2AddressCreate) Mutation() *BronzeHistoryAWSEC2AddressMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryAWSEC2Address in the database.
+func (_c *BronzeHistoryAWSEC2AddressCreate) Save(ctx context.Context) (*BronzeHistoryAWSEC2Address, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryAWSEC2AddressCreate) SaveX(ctx context.Context) *BronzeHistoryAWSEC2Address {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSEC2AddressCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSEC2AddressCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryAWSEC2AddressCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2Address.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2Address.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2Address.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2Address.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2address.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2Address.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2Address.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2address.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2Address.account_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2Address.region"`)}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := bronzehistoryawsec2address.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2Address.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryAWSEC2AddressCreate) sqlSave(ctx context.Context) (*BronzeHistoryAWSEC2Address, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryAWSEC2AddressCreate) createSpec() (*BronzeHistoryAWSEC2Address, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryAWSEC2Address{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryawsec2address.Table, sqlgraph.NewFieldSpec(bronzehistoryawsec2address.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryAWSEC2Address
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.PublicIP(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldPublicIP, field.TypeString, value)
+		_node.PublicIP = value
+	}
+	if value, ok := _c.mutation.Domain(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldDomain, field.TypeString, value)
+		_node.Domain = value
+	}
+	if value, ok := _c.mutation.AssociationID(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldAssociationID, field.TypeString, value)
+		_node.AssociationID = value
+	}
+	if value, ok := _c.mutation.InstanceID(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldInstanceID, field.TypeString, value)
+		_node.InstanceID = value
+	}
+	if value, ok := _c.mutation.NetworkInterfaceID(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldNetworkInterfaceID, field.TypeString, value)
+		_node.NetworkInterfaceID = value
+	}
+	if value, ok := _c.mutation.NetworkInterfaceOwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldNetworkInterfaceOwnerID, field.TypeString, value)
+		_node.NetworkInterfaceOwnerID = value
+	}
+	if value, ok := _c.mutation.PrivateIPAddress(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldPrivateIPAddress, field.TypeString, value)
+		_node.PrivateIPAddress = value
+	}
+	if value, ok := _c.mutation.PublicIpv4Pool(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldPublicIpv4Pool, field.TypeString, value)
+		_node.PublicIpv4Pool = value
+	}
+	if value, ok := _c.mutation.NetworkBorderGroup(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldNetworkBorderGroup, field.TypeString, value)
+		_node.NetworkBorderGroup = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryAWSEC2AddressCreateBulk is the builder for creating many BronzeHistoryAWSEC2Address entities in bulk.
+type BronzeHistoryAWSEC2AddressCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryAWSEC2AddressCreate
+}
+
+// Save creates the BronzeHistoryAWSEC2Address entities in the database.
+func (_c *BronzeHistoryAWSEC2AddressCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryAWSEC2Address, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryAWSEC2Address, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryAWSEC2AddressMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryAWSEC2AddressCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryAWSEC2Address {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSEC2AddressCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSEC2AddressCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2address"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2AddressQuery is the builder for querying BronzeHistoryAWSEC2Address entities.
+type BronzeHistoryAWSEC2AddressQuery struct {
+	config
+	ctx        *QueryContext
+	order      []bronzehistoryawsec2address.OrderOption
+	inters     []Interceptor
+	predicates []predicate.BronzeHistoryAWSEC2Address
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BronzeHistoryAWSEC2AddressQuery builder.
+func (_q *BronzeHistoryAWSEC2AddressQuery) Where(ps ...predicate.BronzeHistoryAWSEC2Address) *BronzeHistoryAWSEC2AddressQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *BronzeHistoryAWSEC2AddressQuery) Limit(limit int) *BronzeHistoryAWSEC2AddressQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *BronzeHistoryAWSEC2AddressQuery) Offset(offset int) *BronzeHistoryAWSEC2AddressQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *BronzeHistoryAWSEC2AddressQuery) Unique(unique bool) *BronzeHistoryAWSEC2AddressQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *BronzeHistoryAWSEC2AddressQuery) Order(o ...bronzehistoryawsec2address.OrderOption) *BronzeHistoryAWSEC2AddressQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// First returns the first BronzeHistoryAWSEC2Address entity from the query.
+// Returns a *NotFoundError when no BronzeHistoryAWSEC2Address was found.
+func (_q *BronzeHistoryAWSEC2AddressQuery) First(ctx context.Context) (*BronzeHistoryAWSEC2Address, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{bronzehistoryawsec2address.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *BronzeHistoryAWSEC2AddressQuery) FirstX(ctx context.Context) *BronzeHistoryAWSEC2Address {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BronzeHistoryAWSEC2Address ID from the query.
+// Returns a *NotFoundError when no BronzeHistoryAWSEC2Address ID was found.
+func (_q *BronzeHistoryAWSEC2AddressQuery) FirstID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{bronzehistoryawsec2address.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *BronzeHistoryAWSEC2AddressQuery) FirstIDX(ctx context.Context) uint {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BronzeHistoryAWSEC2Address entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BronzeHistoryAWSEC2Address entity is found.
+// Returns a *NotFoundError when no BronzeHistoryAWSEC2Address entities are found.
+func (_q *BronzeHistoryAWSEC2AddressQuery) Only(ctx context.Context) (*BronzeHistoryAWSEC2Address, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{bronzehistoryawsec2address.Label}
+	default:
+		return nil, &NotSingularError{bronzehistoryawsec2address.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *BronzeHistoryAWSEC2AddressQuery) OnlyX(ctx context.Context) *BronzeHistoryAWSEC2Address {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BronzeHistoryAWSEC2Address ID in the query.
+// Returns a *NotSingularError when more than one BronzeHistoryAWSEC2Address ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *BronzeHistoryAWSEC2AddressQuery) OnlyID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{bronzehistoryawsec2address.Label}
+	default:
+		err = &NotSingularError{bronzehistoryawsec2address.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *BronzeHistoryAWSEC2AddressQuery) OnlyIDX(ctx context.Context) uint {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BronzeHistoryAWSEC2Addresses.
+func (_q *BronzeHistoryAWSEC2AddressQuery) All(ctx context.Context) ([]*BronzeHistoryAWSEC2Address, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BronzeHistoryAWSEC2Address, *BronzeHistoryAWSEC2AddressQuery]()
+	return withInterceptors[[]*BronzeHistoryAWSEC2Address](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *BronzeHistoryAWSEC2AddressQuery) AllX(ctx context.Context) []*BronzeHistoryAWSEC2Address {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BronzeHistoryAWSEC2Address IDs.
+func (_q *BronzeHistoryAWSEC2AddressQuery) IDs(ctx context.Context) (ids []uint, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(bronzehistoryawsec2address.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *BronzeHistoryAWSEC2AddressQuery) IDsX(ctx context.Context) []uint {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *BronzeHistoryAWSEC2AddressQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*BronzeHistoryAWSEC2AddressQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *BronzeHistoryAWSEC2AddressQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *BronzeHistoryAWSEC2AddressQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ec2: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *BronzeHistoryAWSEC2AddressQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BronzeHistoryAWSEC2AddressQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *BronzeHistoryAWSEC2AddressQuery) Clone() *BronzeHistoryAWSEC2AddressQuery {
+	if _q == nil {
+		return nil
+	}
+	return &BronzeHistoryAWSEC2AddressQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]bronzehistoryawsec2address.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.BronzeHistoryAWSEC2Address{}, _q.predicates...),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ValidFrom time.Time `json:"valid_from,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BronzeHistoryAWSEC2Address.Query().
+//		GroupBy(bronzehistoryawsec2address.FieldValidFrom).
+//		Aggregate(ec2.Count()).
+//		Scan(ctx, &v)
+func (_q *BronzeHistoryAWSEC2AddressQuery) GroupBy(field string, fields ...string) *BronzeHistoryAWSEC2AddressGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BronzeHistoryAWSEC2AddressGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = bronzehistoryawsec2address.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ValidFrom time.Time `json:"valid_from,omitempty"`
+//	}
+//
+//	client.BronzeHistoryAWSEC2Address.Query().
+//		Select(bronzehistoryawsec2address.FieldValidFrom).
+//		Scan(ctx, &v)
+func (_q *BronzeHistoryAWSEC2AddressQuery) Select(fields ...string) *BronzeHistoryAWSEC2AddressSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &BronzeHistoryAWSEC2AddressSelect{BronzeHistoryAWSEC2AddressQuery: _q}
+	sbuild.label = bronzehistoryawsec2address.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BronzeHistoryAWSEC2AddressSelect configured with the given aggregations.
+func (_q *BronzeHistoryAWSEC2AddressQuery) Aggregate(fns ...AggregateFunc) *BronzeHistoryAWSEC2AddressSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *BronzeHistoryAWSEC2AddressQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ec2: uninitialized interceptor (forgotten import ec2/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !bronzehistoryawsec2address.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *BronzeHistoryAWSEC2AddressQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BronzeHistoryAWSEC2Address, error) {
+	var (
+		nodes = []*BronzeHistoryAWSEC2Address{}
+		_spec = _q.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BronzeHistoryAWSEC2Address).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BronzeHistoryAWSEC2Address{config: _q.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = _q.schemaConfig.BronzeHistoryAWSEC2Address
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (_q *BronzeHistoryAWSEC2AddressQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Schema = _q.schemaConfig.BronzeHistoryAWSEC2Address
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *BronzeHistoryAWSEC2AddressQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(bronzehistoryawsec2address.Table, bronzehistoryawsec2address.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2address.FieldID, field.TypeUint))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawsec2address.FieldID)
+		for i := range fields {
+			if fields[i] != bronzehistoryawsec2address.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *BronzeHistoryAWSEC2AddressQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(bronzehistoryawsec2address.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = bronzehistoryawsec2address.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(_q.schemaConfig.BronzeHistoryAWSEC2Address)
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	selector.WithContext(ctx)
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BronzeHistoryAWSEC2AddressGroupBy is the group-by builder for BronzeHistoryAWSEC2Address entities.
+type BronzeHistoryAWSEC2AddressGroupBy struct {
+	selector
+	build *BronzeHistoryAWSEC2AddressQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *BronzeHistoryAWSEC2AddressGroupBy) Aggregate(fns ...AggregateFunc) *BronzeHistoryAWSEC2AddressGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *BronzeHistoryAWSEC2AddressGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BronzeHistoryAWSEC2AddressQuery, *BronzeHistoryAWSEC2AddressGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *BronzeHistoryAWSEC2AddressGroupBy) sqlScan(ctx context.Context, root *BronzeHistoryAWSEC2AddressQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BronzeHistoryAWSEC2AddressSelect is the builder for selecting fields of BronzeHistoryAWSEC2Address entities.
+type BronzeHistoryAWSEC2AddressSelect struct {
+	*BronzeHistoryAWSEC2AddressQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *BronzeHistoryAWSEC2AddressSelect) Aggregate(fns ...AggregateFunc) *BronzeHistoryAWSEC2AddressSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *BronzeHistoryAWSEC2AddressSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BronzeHistoryAWSEC2AddressQuery, *BronzeHistoryAWSEC2AddressSelect](ctx, _s.BronzeHistoryAWSEC2AddressQuery, _s, _s.inters, v)
+}
+
+func (_s *BronzeHistoryAWSEC2AddressSelect) sqlScan(ctx context.Context, root *BronzeHistoryAWSEC2AddressQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
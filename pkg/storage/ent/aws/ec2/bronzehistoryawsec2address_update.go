@@ -0,0 +1,1029 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2address"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2AddressUpdate is the builder for updating BronzeHistoryAWSEC2Address entities.
+type BronzeHistoryAWSEC2AddressUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2AddressMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2AddressUpdate builder.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) Where(ps ...predicate.BronzeHistoryAWSEC2Address) *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetValidTo(v time.Time) *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) ClearValidTo() *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetResourceID(v string) *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetName(v string) *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetNillableName(v *string) *BronzeHistoryAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) ClearName() *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetPublicIP sets the "public_ip" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetPublicIP(v string) *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.SetPublicIP(v)
+	return _u
+}
+
+// SetNillablePublicIP sets the "public_ip" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetNillablePublicIP(v *string) *BronzeHistoryAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetPublicIP(*v)
+	}
+	return _u
+}
+
+// ClearPublicIP clears the value of the "public_ip" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) ClearPublicIP() *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.ClearPublicIP()
+	return _u
+}
+
+// SetDomain sets the "domain" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetDomain(v string) *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.SetDomain(v)
+	return _u
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetNillableDomain(v *string) *BronzeHistoryAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetDomain(*v)
+	}
+	return _u
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) ClearDomain() *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.ClearDomain()
+	return _u
+}
+
+// SetAssociationID sets the "association_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetAssociationID(v string) *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.SetAssociationID(v)
+	return _u
+}
+
+// SetNillableAssociationID sets the "association_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetNillableAssociationID(v *string) *BronzeHistoryAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetAssociationID(*v)
+	}
+	return _u
+}
+
+// ClearAssociationID clears the value of the "association_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) ClearAssociationID() *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.ClearAssociationID()
+	return _u
+}
+
+// SetInstanceID sets the "instance_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetInstanceID(v string) *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.SetInstanceID(v)
+	return _u
+}
+
+// SetNillableInstanceID sets the "instance_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetNillableInstanceID(v *string) *BronzeHistoryAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetInstanceID(*v)
+	}
+	return _u
+}
+
+// ClearInstanceID clears the value of the "instance_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) ClearInstanceID() *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.ClearInstanceID()
+	return _u
+}
+
+// SetNetworkInterfaceID sets the "network_interface_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetNetworkInterfaceID(v string) *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.SetNetworkInterfaceID(v)
+	return _u
+}
+
+// SetNillableNetworkInterfaceID sets the "network_interface_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetNillableNetworkInterfaceID(v *string) *BronzeHistoryAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetNetworkInterfaceID(*v)
+	}
+	return _u
+}
+
+// ClearNetworkInterfaceID clears the value of the "network_interface_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) ClearNetworkInterfaceID() *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.ClearNetworkInterfaceID()
+	return _u
+}
+
+// SetNetworkInterfaceOwnerID sets the "network_interface_owner_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetNetworkInterfaceOwnerID(v string) *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.SetNetworkInterfaceOwnerID(v)
+	return _u
+}
+
+// SetNillableNetworkInterfaceOwnerID sets the "network_interface_owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetNillableNetworkInterfaceOwnerID(v *string) *BronzeHistoryAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetNetworkInterfaceOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearNetworkInterfaceOwnerID clears the value of the "network_interface_owner_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) ClearNetworkInterfaceOwnerID() *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.ClearNetworkInterfaceOwnerID()
+	return _u
+}
+
+// SetPrivateIPAddress sets the "private_ip_address" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetPrivateIPAddress(v string) *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.SetPrivateIPAddress(v)
+	return _u
+}
+
+// SetNillablePrivateIPAddress sets the "private_ip_address" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetNillablePrivateIPAddress(v *string) *BronzeHistoryAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetPrivateIPAddress(*v)
+	}
+	return _u
+}
+
+// ClearPrivateIPAddress clears the value of the "private_ip_address" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) ClearPrivateIPAddress() *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.ClearPrivateIPAddress()
+	return _u
+}
+
+// SetPublicIpv4Pool sets the "public_ipv4_pool" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetPublicIpv4Pool(v string) *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.SetPublicIpv4Pool(v)
+	return _u
+}
+
+// SetNillablePublicIpv4Pool sets the "public_ipv4_pool" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetNillablePublicIpv4Pool(v *string) *BronzeHistoryAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetPublicIpv4Pool(*v)
+	}
+	return _u
+}
+
+// ClearPublicIpv4Pool clears the value of the "public_ipv4_pool" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) ClearPublicIpv4Pool() *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.ClearPublicIpv4Pool()
+	return _u
+}
+
+// SetNetworkBorderGroup sets the "network_border_group" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetNetworkBorderGroup(v string) *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.SetNetworkBorderGroup(v)
+	return _u
+}
+
+// SetNillableNetworkBorderGroup sets the "network_border_group" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetNillableNetworkBorderGroup(v *string) *BronzeHistoryAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetNetworkBorderGroup(*v)
+	}
+	return _u
+}
+
+// ClearNetworkBorderGroup clears the value of the "network_border_group" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) ClearNetworkBorderGroup() *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.ClearNetworkBorderGroup()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) ClearTagsJSON() *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetAccountID(v string) *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetRegion(v string) *BronzeHistoryAWSEC2AddressUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SetNillableRegion(v *string) *BronzeHistoryAWSEC2AddressUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2AddressMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) Mutation() *BronzeHistoryAWSEC2AddressMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2AddressUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2address.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2Address.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2address.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2Address.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2address.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2Address.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2AddressUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2address.Table, bronzehistoryawsec2address.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2address.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicIP(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldPublicIP, field.TypeString, value)
+	}
+	if _u.mutation.PublicIPCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldPublicIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.Domain(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldDomain, field.TypeString, value)
+	}
+	if _u.mutation.DomainCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldDomain, field.TypeString)
+	}
+	if value, ok := _u.mutation.AssociationID(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldAssociationID, field.TypeString, value)
+	}
+	if _u.mutation.AssociationIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldAssociationID, field.TypeString)
+	}
+	if value, ok := _u.mutation.InstanceID(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldInstanceID, field.TypeString, value)
+	}
+	if _u.mutation.InstanceIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldInstanceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.NetworkInterfaceID(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldNetworkInterfaceID, field.TypeString, value)
+	}
+	if _u.mutation.NetworkInterfaceIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldNetworkInterfaceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.NetworkInterfaceOwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldNetworkInterfaceOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.NetworkInterfaceOwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldNetworkInterfaceOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrivateIPAddress(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldPrivateIPAddress, field.TypeString, value)
+	}
+	if _u.mutation.PrivateIPAddressCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldPrivateIPAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicIpv4Pool(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldPublicIpv4Pool, field.TypeString, value)
+	}
+	if _u.mutation.PublicIpv4PoolCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldPublicIpv4Pool, field.TypeString)
+	}
+	if value, ok := _u.mutation.NetworkBorderGroup(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldNetworkBorderGroup, field.TypeString, value)
+	}
+	if _u.mutation.NetworkBorderGroupCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldNetworkBorderGroup, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2address.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2Address
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2address.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryAWSEC2AddressUpdateOne is the builder for updating a single BronzeHistoryAWSEC2Address entity.
+type BronzeHistoryAWSEC2AddressUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2AddressMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetValidTo(v time.Time) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) ClearValidTo() *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetResourceID(v string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetName(v string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetNillableName(v *string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) ClearName() *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetPublicIP sets the "public_ip" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetPublicIP(v string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.SetPublicIP(v)
+	return _u
+}
+
+// SetNillablePublicIP sets the "public_ip" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetNillablePublicIP(v *string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetPublicIP(*v)
+	}
+	return _u
+}
+
+// ClearPublicIP clears the value of the "public_ip" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) ClearPublicIP() *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.ClearPublicIP()
+	return _u
+}
+
+// SetDomain sets the "domain" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetDomain(v string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.SetDomain(v)
+	return _u
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetNillableDomain(v *string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetDomain(*v)
+	}
+	return _u
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) ClearDomain() *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.ClearDomain()
+	return _u
+}
+
+// SetAssociationID sets the "association_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetAssociationID(v string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.SetAssociationID(v)
+	return _u
+}
+
+// SetNillableAssociationID sets the "association_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetNillableAssociationID(v *string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetAssociationID(*v)
+	}
+	return _u
+}
+
+// ClearAssociationID clears the value of the "association_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) ClearAssociationID() *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.ClearAssociationID()
+	return _u
+}
+
+// SetInstanceID sets the "instance_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetInstanceID(v string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.SetInstanceID(v)
+	return _u
+}
+
+// SetNillableInstanceID sets the "instance_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetNillableInstanceID(v *string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetInstanceID(*v)
+	}
+	return _u
+}
+
+// ClearInstanceID clears the value of the "instance_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) ClearInstanceID() *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.ClearInstanceID()
+	return _u
+}
+
+// SetNetworkInterfaceID sets the "network_interface_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetNetworkInterfaceID(v string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.SetNetworkInterfaceID(v)
+	return _u
+}
+
+// SetNillableNetworkInterfaceID sets the "network_interface_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetNillableNetworkInterfaceID(v *string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetNetworkInterfaceID(*v)
+	}
+	return _u
+}
+
+// ClearNetworkInterfaceID clears the value of the "network_interface_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) ClearNetworkInterfaceID() *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.ClearNetworkInterfaceID()
+	return _u
+}
+
+// SetNetworkInterfaceOwnerID sets the "network_interface_owner_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetNetworkInterfaceOwnerID(v string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.SetNetworkInterfaceOwnerID(v)
+	return _u
+}
+
+// SetNillableNetworkInterfaceOwnerID sets the "network_interface_owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetNillableNetworkInterfaceOwnerID(v *string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetNetworkInterfaceOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearNetworkInterfaceOwnerID clears the value of the "network_interface_owner_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) ClearNetworkInterfaceOwnerID() *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.ClearNetworkInterfaceOwnerID()
+	return _u
+}
+
+// SetPrivateIPAddress sets the "private_ip_address" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetPrivateIPAddress(v string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.SetPrivateIPAddress(v)
+	return _u
+}
+
+// SetNillablePrivateIPAddress sets the "private_ip_address" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetNillablePrivateIPAddress(v *string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetPrivateIPAddress(*v)
+	}
+	return _u
+}
+
+// ClearPrivateIPAddress clears the value of the "private_ip_address" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) ClearPrivateIPAddress() *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.ClearPrivateIPAddress()
+	return _u
+}
+
+// SetPublicIpv4Pool sets the "public_ipv4_pool" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetPublicIpv4Pool(v string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.SetPublicIpv4Pool(v)
+	return _u
+}
+
+// SetNillablePublicIpv4Pool sets the "public_ipv4_pool" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetNillablePublicIpv4Pool(v *string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetPublicIpv4Pool(*v)
+	}
+	return _u
+}
+
+// ClearPublicIpv4Pool clears the value of the "public_ipv4_pool" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) ClearPublicIpv4Pool() *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.ClearPublicIpv4Pool()
+	return _u
+}
+
+// SetNetworkBorderGroup sets the "network_border_group" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetNetworkBorderGroup(v string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.SetNetworkBorderGroup(v)
+	return _u
+}
+
+// SetNillableNetworkBorderGroup sets the "network_border_group" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetNillableNetworkBorderGroup(v *string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetNetworkBorderGroup(*v)
+	}
+	return _u
+}
+
+// ClearNetworkBorderGroup clears the value of the "network_border_group" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) ClearNetworkBorderGroup() *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.ClearNetworkBorderGroup()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) ClearTagsJSON() *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetAccountID(v string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetRegion(v string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SetNillableRegion(v *string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2AddressMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) Mutation() *BronzeHistoryAWSEC2AddressMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2AddressUpdate builder.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) Where(ps ...predicate.BronzeHistoryAWSEC2Address) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) Select(field string, fields ...string) *BronzeHistoryAWSEC2AddressUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryAWSEC2Address entity.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) Save(ctx context.Context) (*BronzeHistoryAWSEC2Address, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) SaveX(ctx context.Context) *BronzeHistoryAWSEC2Address {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2address.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2Address.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2address.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2Address.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2address.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2Address.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2AddressUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryAWSEC2Address, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2address.Table, bronzehistoryawsec2address.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2address.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeHistoryAWSEC2Address.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawsec2address.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryawsec2address.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryawsec2address.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicIP(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldPublicIP, field.TypeString, value)
+	}
+	if _u.mutation.PublicIPCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldPublicIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.Domain(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldDomain, field.TypeString, value)
+	}
+	if _u.mutation.DomainCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldDomain, field.TypeString)
+	}
+	if value, ok := _u.mutation.AssociationID(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldAssociationID, field.TypeString, value)
+	}
+	if _u.mutation.AssociationIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldAssociationID, field.TypeString)
+	}
+	if value, ok := _u.mutation.InstanceID(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldInstanceID, field.TypeString, value)
+	}
+	if _u.mutation.InstanceIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldInstanceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.NetworkInterfaceID(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldNetworkInterfaceID, field.TypeString, value)
+	}
+	if _u.mutation.NetworkInterfaceIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldNetworkInterfaceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.NetworkInterfaceOwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldNetworkInterfaceOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.NetworkInterfaceOwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldNetworkInterfaceOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrivateIPAddress(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldPrivateIPAddress, field.TypeString, value)
+	}
+	if _u.mutation.PrivateIPAddressCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldPrivateIPAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicIpv4Pool(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldPublicIpv4Pool, field.TypeString, value)
+	}
+	if _u.mutation.PublicIpv4PoolCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldPublicIpv4Pool, field.TypeString)
+	}
+	if value, ok := _u.mutation.NetworkBorderGroup(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldNetworkBorderGroup, field.TypeString, value)
+	}
+	if _u.mutation.NetworkBorderGroupCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldNetworkBorderGroup, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2address.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2address.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2address.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2Address
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryAWSEC2Address{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2address.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2internetgateway"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryAWSEC2InternetGateway is the model entity for the BronzeHistoryAWSEC2InternetGateway schema.
+type BronzeHistoryAWSEC2InternetGateway struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze internet gateway by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// VpcID holds the value of the "vpc_id" field.
+	VpcID string `json:"vpc_id,omitempty"`
+	// AttachmentState holds the value of the "attachment_state" field.
+	AttachmentState string `json:"attachment_state,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID string `json:"owner_id,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Region holds the value of the "region" field.
+	Region       string `json:"region,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryAWSEC2InternetGateway) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsec2internetgateway.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzehistoryawsec2internetgateway.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryawsec2internetgateway.FieldResourceID, bronzehistoryawsec2internetgateway.FieldName, bronzehistoryawsec2internetgateway.FieldVpcID, bronzehistoryawsec2internetgateway.FieldAttachmentState, bronzehistoryawsec2internetgateway.FieldOwnerID, bronzehistoryawsec2internetgateway.FieldAccountID, bronzehistoryawsec2internetgateway.FieldRegion:
+			values[i] = new(sql.NullString)
+		case bronzehistoryawsec2internetgateway.FieldValidFrom, bronzehistoryawsec2internetgateway.FieldValidTo, bronzehistoryawsec2internetgateway.FieldCollectedAt, bronzehistoryawsec2internetgateway.FieldFirstCollectedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryAWSEC2InternetGateway fields.
+func (_m *BronzeHistoryAWSEC2InternetGateway) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsec2internetgateway.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryawsec2internetgateway.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryawsec2internetgateway.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryawsec2internetgateway.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryawsec2internetgateway.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryawsec2internetgateway.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryawsec2internetgateway.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzehistoryawsec2internetgateway.FieldVpcID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vpc_id", values[i])
+			} else if value.Valid {
+				_m.VpcID = value.String
+			}
+		case bronzehistoryawsec2internetgateway.FieldAttachmentState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment_state", values[i])
+			} else if value.Valid {
+				_m.AttachmentState = value.String
+			}
+		case bronzehistoryawsec2internetgateway.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				_m.OwnerID = value.String
+			}
+		case bronzehistoryawsec2internetgateway.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzehistoryawsec2internetgateway.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzehistoryawsec2internetgateway.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryAWSEC2InternetGateway.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryAWSEC2InternetGateway) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryAWSEC2InternetGateway.
+// Note that you need to call BronzeHistoryAWSEC2InternetGateway.Unwrap() before calling this method if this BronzeHistoryAWSEC2InternetGateway
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryAWSEC2InternetGateway) Update() *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	return NewBronzeHistoryAWSEC2InternetGatewayClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryAWSEC2InternetGateway entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryAWSEC2InternetGateway) Unwrap() *BronzeHistoryAWSEC2InternetGateway {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ec2: BronzeHistoryAWSEC2InternetGateway is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryAWSEC2InternetGateway) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryAWSEC2InternetGateway(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("vpc_id=")
+	builder.WriteString(_m.VpcID)
+	builder.WriteString(", ")
+	builder.WriteString("attachment_state=")
+	builder.WriteString(_m.AttachmentState)
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(_m.OwnerID)
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryAWSEC2InternetGateways is a parsable slice of BronzeHistoryAWSEC2InternetGateway.
+type BronzeHistoryAWSEC2InternetGateways []*BronzeHistoryAWSEC2InternetGateway
@@ -0,0 +1,800 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryawsec2internetgateway
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLTE(FieldID, id))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldValidTo, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldResourceID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldName, v))
+}
+
+// VpcID applies equality check predicate on the "vpc_id" field. It's identical to VpcIDEQ.
+func VpcID(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldVpcID, v))
+}
+
+// AttachmentState applies equality check predicate on the "attachment_state" field. It's identical to AttachmentStateEQ.
+func AttachmentState(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldAttachmentState, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldRegion, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNotNull(FieldValidTo))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldContainsFold(FieldName, v))
+}
+
+// VpcIDEQ applies the EQ predicate on the "vpc_id" field.
+func VpcIDEQ(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldVpcID, v))
+}
+
+// VpcIDNEQ applies the NEQ predicate on the "vpc_id" field.
+func VpcIDNEQ(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNEQ(FieldVpcID, v))
+}
+
+// VpcIDIn applies the In predicate on the "vpc_id" field.
+func VpcIDIn(vs ...string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldIn(FieldVpcID, vs...))
+}
+
+// VpcIDNotIn applies the NotIn predicate on the "vpc_id" field.
+func VpcIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNotIn(FieldVpcID, vs...))
+}
+
+// VpcIDGT applies the GT predicate on the "vpc_id" field.
+func VpcIDGT(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGT(FieldVpcID, v))
+}
+
+// VpcIDGTE applies the GTE predicate on the "vpc_id" field.
+func VpcIDGTE(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGTE(FieldVpcID, v))
+}
+
+// VpcIDLT applies the LT predicate on the "vpc_id" field.
+func VpcIDLT(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLT(FieldVpcID, v))
+}
+
+// VpcIDLTE applies the LTE predicate on the "vpc_id" field.
+func VpcIDLTE(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLTE(FieldVpcID, v))
+}
+
+// VpcIDContains applies the Contains predicate on the "vpc_id" field.
+func VpcIDContains(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldContains(FieldVpcID, v))
+}
+
+// VpcIDHasPrefix applies the HasPrefix predicate on the "vpc_id" field.
+func VpcIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldHasPrefix(FieldVpcID, v))
+}
+
+// VpcIDHasSuffix applies the HasSuffix predicate on the "vpc_id" field.
+func VpcIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldHasSuffix(FieldVpcID, v))
+}
+
+// VpcIDIsNil applies the IsNil predicate on the "vpc_id" field.
+func VpcIDIsNil() predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldIsNull(FieldVpcID))
+}
+
+// VpcIDNotNil applies the NotNil predicate on the "vpc_id" field.
+func VpcIDNotNil() predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNotNull(FieldVpcID))
+}
+
+// VpcIDEqualFold applies the EqualFold predicate on the "vpc_id" field.
+func VpcIDEqualFold(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEqualFold(FieldVpcID, v))
+}
+
+// VpcIDContainsFold applies the ContainsFold predicate on the "vpc_id" field.
+func VpcIDContainsFold(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldContainsFold(FieldVpcID, v))
+}
+
+// AttachmentStateEQ applies the EQ predicate on the "attachment_state" field.
+func AttachmentStateEQ(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldAttachmentState, v))
+}
+
+// AttachmentStateNEQ applies the NEQ predicate on the "attachment_state" field.
+func AttachmentStateNEQ(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNEQ(FieldAttachmentState, v))
+}
+
+// AttachmentStateIn applies the In predicate on the "attachment_state" field.
+func AttachmentStateIn(vs ...string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldIn(FieldAttachmentState, vs...))
+}
+
+// AttachmentStateNotIn applies the NotIn predicate on the "attachment_state" field.
+func AttachmentStateNotIn(vs ...string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNotIn(FieldAttachmentState, vs...))
+}
+
+// AttachmentStateGT applies the GT predicate on the "attachment_state" field.
+func AttachmentStateGT(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGT(FieldAttachmentState, v))
+}
+
+// AttachmentStateGTE applies the GTE predicate on the "attachment_state" field.
+func AttachmentStateGTE(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGTE(FieldAttachmentState, v))
+}
+
+// AttachmentStateLT applies the LT predicate on the "attachment_state" field.
+func AttachmentStateLT(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLT(FieldAttachmentState, v))
+}
+
+// AttachmentStateLTE applies the LTE predicate on the "attachment_state" field.
+func AttachmentStateLTE(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLTE(FieldAttachmentState, v))
+}
+
+// AttachmentStateContains applies the Contains predicate on the "attachment_state" field.
+func AttachmentStateContains(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldContains(FieldAttachmentState, v))
+}
+
+// AttachmentStateHasPrefix applies the HasPrefix predicate on the "attachment_state" field.
+func AttachmentStateHasPrefix(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldHasPrefix(FieldAttachmentState, v))
+}
+
+// AttachmentStateHasSuffix applies the HasSuffix predicate on the "attachment_state" field.
+func AttachmentStateHasSuffix(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldHasSuffix(FieldAttachmentState, v))
+}
+
+// AttachmentStateIsNil applies the IsNil predicate on the "attachment_state" field.
+func AttachmentStateIsNil() predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldIsNull(FieldAttachmentState))
+}
+
+// AttachmentStateNotNil applies the NotNil predicate on the "attachment_state" field.
+func AttachmentStateNotNil() predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNotNull(FieldAttachmentState))
+}
+
+// AttachmentStateEqualFold applies the EqualFold predicate on the "attachment_state" field.
+func AttachmentStateEqualFold(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEqualFold(FieldAttachmentState, v))
+}
+
+// AttachmentStateContainsFold applies the ContainsFold predicate on the "attachment_state" field.
+func AttachmentStateContainsFold(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldContainsFold(FieldAttachmentState, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldContains(FieldOwnerID, v))
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldHasPrefix(FieldOwnerID, v))
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldHasSuffix(FieldOwnerID, v))
+}
+
+// OwnerIDIsNil applies the IsNil predicate on the "owner_id" field.
+func OwnerIDIsNil() predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldIsNull(FieldOwnerID))
+}
+
+// OwnerIDNotNil applies the NotNil predicate on the "owner_id" field.
+func OwnerIDNotNil() predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNotNull(FieldOwnerID))
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEqualFold(FieldOwnerID, v))
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldContainsFold(FieldOwnerID, v))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeHistoryAWSEC2InternetGateway) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeHistoryAWSEC2InternetGateway) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeHistoryAWSEC2InternetGateway) predicate.BronzeHistoryAWSEC2InternetGateway {
+	return predicate.BronzeHistoryAWSEC2InternetGateway(sql.NotPredicates(p))
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2internetgateway"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2InternetGatewayDelete is the builder for deleting a BronzeHistoryAWSEC2InternetGateway entity.
+type BronzeHistoryAWSEC2InternetGatewayDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2InternetGatewayMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2InternetGatewayDelete builder.
+func (_d *BronzeHistoryAWSEC2InternetGatewayDelete) Where(ps ...predicate.BronzeHistoryAWSEC2InternetGateway) *BronzeHistoryAWSEC2InternetGatewayDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeHistoryAWSEC2InternetGatewayDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryAWSEC2InternetGatewayDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeHistoryAWSEC2InternetGatewayDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzehistoryawsec2internetgateway.Table, sqlgraph.NewFieldSpec(bronzehistoryawsec2internetgateway.FieldID, field.TypeUint))
+	_spec.Node.Schema = _d.schemaConfig.BronzeHistoryAWSEC2InternetGateway
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeHistoryAWSEC2InternetGatewayDeleteOne is the builder for deleting a single BronzeHistoryAWSEC2InternetGateway entity.
+type BronzeHistoryAWSEC2InternetGatewayDeleteOne struct {
+	_d *BronzeHistoryAWSEC2InternetGatewayDelete
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2InternetGatewayDelete builder.
+func (_d *BronzeHistoryAWSEC2InternetGatewayDeleteOne) Where(ps ...predicate.BronzeHistoryAWSEC2InternetGateway) *BronzeHistoryAWSEC2InternetGatewayDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeHistoryAWSEC2InternetGatewayDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzehistoryawsec2internetgateway.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryAWSEC2InternetGatewayDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
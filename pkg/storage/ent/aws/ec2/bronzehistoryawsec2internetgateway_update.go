@@ -0,0 +1,717 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2internetgateway"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2InternetGatewayUpdate is the builder for updating BronzeHistoryAWSEC2InternetGateway entities.
+type BronzeHistoryAWSEC2InternetGatewayUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2InternetGatewayMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2InternetGatewayUpdate builder.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) Where(ps ...predicate.BronzeHistoryAWSEC2InternetGateway) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetValidTo(v time.Time) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) ClearValidTo() *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetResourceID(v string) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetName(v string) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetNillableName(v *string) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) ClearName() *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetVpcID(v string) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetNillableVpcID(v *string) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) ClearVpcID() *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetAttachmentState sets the "attachment_state" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetAttachmentState(v string) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	_u.mutation.SetAttachmentState(v)
+	return _u
+}
+
+// SetNillableAttachmentState sets the "attachment_state" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetNillableAttachmentState(v *string) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	if v != nil {
+		_u.SetAttachmentState(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentState clears the value of the "attachment_state" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) ClearAttachmentState() *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	_u.mutation.ClearAttachmentState()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetOwnerID(v string) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetNillableOwnerID(v *string) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) ClearOwnerID() *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) ClearTagsJSON() *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetAccountID(v string) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetRegion(v string) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SetNillableRegion(v *string) *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2InternetGatewayMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) Mutation() *BronzeHistoryAWSEC2InternetGatewayMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2internetgateway.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2InternetGateway.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2internetgateway.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2InternetGateway.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2internetgateway.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2InternetGateway.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2internetgateway.Table, bronzehistoryawsec2internetgateway.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2internetgateway.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2internetgateway.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzehistoryawsec2internetgateway.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2internetgateway.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentState(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldAttachmentState, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentStateCleared() {
+		_spec.ClearField(bronzehistoryawsec2internetgateway.FieldAttachmentState, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2internetgateway.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2internetgateway.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2internetgateway.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2InternetGateway
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2internetgateway.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryAWSEC2InternetGatewayUpdateOne is the builder for updating a single BronzeHistoryAWSEC2InternetGateway entity.
+type BronzeHistoryAWSEC2InternetGatewayUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2InternetGatewayMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetValidTo(v time.Time) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) ClearValidTo() *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetResourceID(v string) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetName(v string) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetNillableName(v *string) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) ClearName() *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetVpcID(v string) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetNillableVpcID(v *string) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) ClearVpcID() *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetAttachmentState sets the "attachment_state" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetAttachmentState(v string) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.SetAttachmentState(v)
+	return _u
+}
+
+// SetNillableAttachmentState sets the "attachment_state" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetNillableAttachmentState(v *string) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	if v != nil {
+		_u.SetAttachmentState(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentState clears the value of the "attachment_state" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) ClearAttachmentState() *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.ClearAttachmentState()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetOwnerID(v string) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetNillableOwnerID(v *string) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) ClearOwnerID() *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) ClearTagsJSON() *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetAccountID(v string) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetRegion(v string) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SetNillableRegion(v *string) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2InternetGatewayMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) Mutation() *BronzeHistoryAWSEC2InternetGatewayMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2InternetGatewayUpdate builder.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) Where(ps ...predicate.BronzeHistoryAWSEC2InternetGateway) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) Select(field string, fields ...string) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryAWSEC2InternetGateway entity.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) Save(ctx context.Context) (*BronzeHistoryAWSEC2InternetGateway, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) SaveX(ctx context.Context) *BronzeHistoryAWSEC2InternetGateway {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2internetgateway.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2InternetGateway.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2internetgateway.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2InternetGateway.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2internetgateway.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2InternetGateway.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2InternetGatewayUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryAWSEC2InternetGateway, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2internetgateway.Table, bronzehistoryawsec2internetgateway.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2internetgateway.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeHistoryAWSEC2InternetGateway.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawsec2internetgateway.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryawsec2internetgateway.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryawsec2internetgateway.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2internetgateway.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzehistoryawsec2internetgateway.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2internetgateway.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentState(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldAttachmentState, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentStateCleared() {
+		_spec.ClearField(bronzehistoryawsec2internetgateway.FieldAttachmentState, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2internetgateway.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2internetgateway.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2internetgateway.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2internetgateway.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2InternetGateway
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryAWSEC2InternetGateway{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2internetgateway.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
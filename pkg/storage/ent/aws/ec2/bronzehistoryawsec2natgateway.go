@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2natgateway"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryAWSEC2NATGateway is the model entity for the BronzeHistoryAWSEC2NATGateway schema.
+type BronzeHistoryAWSEC2NATGateway struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze NAT gateway by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// VpcID holds the value of the "vpc_id" field.
+	VpcID string `json:"vpc_id,omitempty"`
+	// SubnetID holds the value of the "subnet_id" field.
+	SubnetID string `json:"subnet_id,omitempty"`
+	// State holds the value of the "state" field.
+	State string `json:"state,omitempty"`
+	// ConnectivityType holds the value of the "connectivity_type" field.
+	ConnectivityType string `json:"connectivity_type,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// FailureCode holds the value of the "failure_code" field.
+	FailureCode string `json:"failure_code,omitempty"`
+	// FailureMessage holds the value of the "failure_message" field.
+	FailureMessage string `json:"failure_message,omitempty"`
+	// AddressesJSON holds the value of the "addresses_json" field.
+	AddressesJSON json.RawMessage `json:"addresses_json,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Region holds the value of the "region" field.
+	Region       string `json:"region,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryAWSEC2NATGateway) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsec2natgateway.FieldAddressesJSON, bronzehistoryawsec2natgateway.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzehistoryawsec2natgateway.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryawsec2natgateway.FieldResourceID, bronzehistoryawsec2natgateway.FieldName, bronzehistoryawsec2natgateway.FieldVpcID, bronzehistoryawsec2natgateway.FieldSubnetID, bronzehistoryawsec2natgateway.FieldState, bronzehistoryawsec2natgateway.FieldConnectivityType, bronzehistoryawsec2natgateway.FieldFailureCode, bronzehistoryawsec2natgateway.FieldFailureMessage, bronzehistoryawsec2natgateway.FieldAccountID, bronzehistoryawsec2natgateway.FieldRegion:
+			values[i] = new(sql.NullString)
+		case bronzehistoryawsec2natgateway.FieldValidFrom, bronzehistoryawsec2natgateway.FieldValidTo, bronzehistoryawsec2natgateway.FieldCollectedAt, bronzehistoryawsec2natgateway.FieldFirstCollectedAt, bronzehistoryawsec2natgateway.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryAWSEC2NATGateway fields.
+func (_m *BronzeHistoryAWSEC2NATGateway) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsec2natgateway.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryawsec2natgateway.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryawsec2natgateway.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryawsec2natgateway.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryawsec2natgateway.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryawsec2natgateway.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryawsec2natgateway.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzehistoryawsec2natgateway.FieldVpcID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vpc_id", values[i])
+			} else if value.Valid {
+				_m.VpcID = value.String
+			}
+		case bronzehistoryawsec2natgateway.FieldSubnetID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subnet_id", values[i])
+			} else if value.Valid {
+				_m.SubnetID = value.String
+			}
+		case bronzehistoryawsec2natgateway.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				_m.State = value.String
+			}
+		case bronzehistoryawsec2natgateway.FieldConnectivityType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field connectivity_type", values[i])
+			} else if value.Valid {
+				_m.ConnectivityType = value.String
+			}
+		case bronzehistoryawsec2natgateway.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case bronzehistoryawsec2natgateway.FieldFailureCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_code", values[i])
+			} else if value.Valid {
+				_m.FailureCode = value.String
+			}
+		case bronzehistoryawsec2natgateway.FieldFailureMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_message", values[i])
+			} else if value.Valid {
+				_m.FailureMessage = value.String
+			}
+		case bronzehistoryawsec2natgateway.FieldAddressesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field addresses_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AddressesJSON); err != nil {
+					return fmt.Errorf("unmarshal field addresses_json: %w", err)
+				}
+			}
+		case bronzehistoryawsec2natgateway.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzehistoryawsec2natgateway.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzehistoryawsec2natgateway.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryAWSEC2NATGateway.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryAWSEC2NATGateway) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryAWSEC2NATGateway.
+// Note that you need to call BronzeHistoryAWSEC2NATGateway.Unwrap() before calling this method if this BronzeHistoryAWSEC2NATGateway
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryAWSEC2NATGateway) Update() *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	return NewBronzeHistoryAWSEC2NATGatewayClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryAWSEC2NATGateway entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryAWSEC2NATGateway) Unwrap() *BronzeHistoryAWSEC2NATGateway {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ec2: BronzeHistoryAWSEC2NATGateway is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryAWSEC2NATGateway) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryAWSEC2NATGateway(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("vpc_id=")
+	builder.WriteString(_m.VpcID)
+	builder.WriteString(", ")
+	builder.WriteString("subnet_id=")
+	builder.WriteString(_m.SubnetID)
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(_m.State)
+	builder.WriteString(", ")
+	builder.WriteString("connectivity_type=")
+	builder.WriteString(_m.ConnectivityType)
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("failure_code=")
+	builder.WriteString(_m.FailureCode)
+	builder.WriteString(", ")
+	builder.WriteString("failure_message=")
+	builder.WriteString(_m.FailureMessage)
+	builder.WriteString(", ")
+	builder.WriteString("addresses_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AddressesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryAWSEC2NATGateways is a parsable slice of BronzeHistoryAWSEC2NATGateway.
+type BronzeHistoryAWSEC2NATGateways []*BronzeHistoryAWSEC2NATGateway
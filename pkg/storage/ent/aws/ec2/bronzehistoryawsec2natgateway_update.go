@@ -0,0 +1,983 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2natgateway"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2NATGatewayUpdate is the builder for updating BronzeHistoryAWSEC2NATGateway entities.
+type BronzeHistoryAWSEC2NATGatewayUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2NATGatewayMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2NATGatewayUpdate builder.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) Where(ps ...predicate.BronzeHistoryAWSEC2NATGateway) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetValidTo(v time.Time) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) ClearValidTo() *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetResourceID(v string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetName(v string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetNillableName(v *string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) ClearName() *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetVpcID(v string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetNillableVpcID(v *string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) ClearVpcID() *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetSubnetID sets the "subnet_id" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetSubnetID(v string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.SetSubnetID(v)
+	return _u
+}
+
+// SetNillableSubnetID sets the "subnet_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetNillableSubnetID(v *string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetSubnetID(*v)
+	}
+	return _u
+}
+
+// ClearSubnetID clears the value of the "subnet_id" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) ClearSubnetID() *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearSubnetID()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetState(v string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetNillableState(v *string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) ClearState() *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetConnectivityType sets the "connectivity_type" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetConnectivityType(v string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.SetConnectivityType(v)
+	return _u
+}
+
+// SetNillableConnectivityType sets the "connectivity_type" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetNillableConnectivityType(v *string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetConnectivityType(*v)
+	}
+	return _u
+}
+
+// ClearConnectivityType clears the value of the "connectivity_type" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) ClearConnectivityType() *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearConnectivityType()
+	return _u
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetCreateTime(v time.Time) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.SetCreateTime(v)
+	return _u
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetNillableCreateTime(v *time.Time) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetCreateTime(*v)
+	}
+	return _u
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) ClearCreateTime() *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearCreateTime()
+	return _u
+}
+
+// SetFailureCode sets the "failure_code" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetFailureCode(v string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.SetFailureCode(v)
+	return _u
+}
+
+// SetNillableFailureCode sets the "failure_code" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetNillableFailureCode(v *string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetFailureCode(*v)
+	}
+	return _u
+}
+
+// ClearFailureCode clears the value of the "failure_code" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) ClearFailureCode() *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearFailureCode()
+	return _u
+}
+
+// SetFailureMessage sets the "failure_message" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetFailureMessage(v string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.SetFailureMessage(v)
+	return _u
+}
+
+// SetNillableFailureMessage sets the "failure_message" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetNillableFailureMessage(v *string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetFailureMessage(*v)
+	}
+	return _u
+}
+
+// ClearFailureMessage clears the value of the "failure_message" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) ClearFailureMessage() *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearFailureMessage()
+	return _u
+}
+
+// SetAddressesJSON sets the "addresses_json" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetAddressesJSON(v json.RawMessage) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.SetAddressesJSON(v)
+	return _u
+}
+
+// AppendAddressesJSON appends value to the "addresses_json" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) AppendAddressesJSON(v json.RawMessage) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.AppendAddressesJSON(v)
+	return _u
+}
+
+// ClearAddressesJSON clears the value of the "addresses_json" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) ClearAddressesJSON() *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearAddressesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) ClearTagsJSON() *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetAccountID(v string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetRegion(v string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SetNillableRegion(v *string) *BronzeHistoryAWSEC2NATGatewayUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2NATGatewayMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) Mutation() *BronzeHistoryAWSEC2NATGatewayMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2natgateway.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NATGateway.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2natgateway.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NATGateway.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2natgateway.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NATGateway.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2natgateway.Table, bronzehistoryawsec2natgateway.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2natgateway.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SubnetID(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldSubnetID, field.TypeString, value)
+	}
+	if _u.mutation.SubnetIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldSubnetID, field.TypeString)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldState, field.TypeString, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldState, field.TypeString)
+	}
+	if value, ok := _u.mutation.ConnectivityType(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldConnectivityType, field.TypeString, value)
+	}
+	if _u.mutation.ConnectivityTypeCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldConnectivityType, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateTime(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldCreateTime, field.TypeTime, value)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FailureCode(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldFailureCode, field.TypeString, value)
+	}
+	if _u.mutation.FailureCodeCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldFailureCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.FailureMessage(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldFailureMessage, field.TypeString, value)
+	}
+	if _u.mutation.FailureMessageCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldFailureMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.AddressesJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldAddressesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAddressesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2natgateway.FieldAddressesJSON, value)
+		})
+	}
+	if _u.mutation.AddressesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldAddressesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2natgateway.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2NATGateway
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2natgateway.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryAWSEC2NATGatewayUpdateOne is the builder for updating a single BronzeHistoryAWSEC2NATGateway entity.
+type BronzeHistoryAWSEC2NATGatewayUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2NATGatewayMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetValidTo(v time.Time) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) ClearValidTo() *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetResourceID(v string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetName(v string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetNillableName(v *string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) ClearName() *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetVpcID(v string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetNillableVpcID(v *string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) ClearVpcID() *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetSubnetID sets the "subnet_id" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetSubnetID(v string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetSubnetID(v)
+	return _u
+}
+
+// SetNillableSubnetID sets the "subnet_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetNillableSubnetID(v *string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetSubnetID(*v)
+	}
+	return _u
+}
+
+// ClearSubnetID clears the value of the "subnet_id" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) ClearSubnetID() *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearSubnetID()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetState(v string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetNillableState(v *string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) ClearState() *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetConnectivityType sets the "connectivity_type" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetConnectivityType(v string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetConnectivityType(v)
+	return _u
+}
+
+// SetNillableConnectivityType sets the "connectivity_type" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetNillableConnectivityType(v *string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetConnectivityType(*v)
+	}
+	return _u
+}
+
+// ClearConnectivityType clears the value of the "connectivity_type" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) ClearConnectivityType() *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearConnectivityType()
+	return _u
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetCreateTime(v time.Time) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetCreateTime(v)
+	return _u
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetNillableCreateTime(v *time.Time) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetCreateTime(*v)
+	}
+	return _u
+}
+
+// ClearCreateTime clears the value of the "create_time" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) ClearCreateTime() *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearCreateTime()
+	return _u
+}
+
+// SetFailureCode sets the "failure_code" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetFailureCode(v string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetFailureCode(v)
+	return _u
+}
+
+// SetNillableFailureCode sets the "failure_code" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetNillableFailureCode(v *string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetFailureCode(*v)
+	}
+	return _u
+}
+
+// ClearFailureCode clears the value of the "failure_code" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) ClearFailureCode() *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearFailureCode()
+	return _u
+}
+
+// SetFailureMessage sets the "failure_message" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetFailureMessage(v string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetFailureMessage(v)
+	return _u
+}
+
+// SetNillableFailureMessage sets the "failure_message" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetNillableFailureMessage(v *string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetFailureMessage(*v)
+	}
+	return _u
+}
+
+// ClearFailureMessage clears the value of the "failure_message" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) ClearFailureMessage() *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearFailureMessage()
+	return _u
+}
+
+// SetAddressesJSON sets the "addresses_json" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetAddressesJSON(v json.RawMessage) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetAddressesJSON(v)
+	return _u
+}
+
+// AppendAddressesJSON appends value to the "addresses_json" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) AppendAddressesJSON(v json.RawMessage) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.AppendAddressesJSON(v)
+	return _u
+}
+
+// ClearAddressesJSON clears the value of the "addresses_json" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) ClearAddressesJSON() *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearAddressesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) ClearTagsJSON() *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetAccountID(v string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetRegion(v string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SetNillableRegion(v *string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2NATGatewayMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) Mutation() *BronzeHistoryAWSEC2NATGatewayMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2NATGatewayUpdate builder.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) Where(ps ...predicate.BronzeHistoryAWSEC2NATGateway) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) Select(field string, fields ...string) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryAWSEC2NATGateway entity.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) Save(ctx context.Context) (*BronzeHistoryAWSEC2NATGateway, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) SaveX(ctx context.Context) *BronzeHistoryAWSEC2NATGateway {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2natgateway.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NATGateway.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2natgateway.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NATGateway.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2natgateway.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NATGateway.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2NATGatewayUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryAWSEC2NATGateway, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2natgateway.Table, bronzehistoryawsec2natgateway.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2natgateway.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeHistoryAWSEC2NATGateway.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawsec2natgateway.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryawsec2natgateway.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryawsec2natgateway.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SubnetID(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldSubnetID, field.TypeString, value)
+	}
+	if _u.mutation.SubnetIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldSubnetID, field.TypeString)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldState, field.TypeString, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldState, field.TypeString)
+	}
+	if value, ok := _u.mutation.ConnectivityType(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldConnectivityType, field.TypeString, value)
+	}
+	if _u.mutation.ConnectivityTypeCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldConnectivityType, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateTime(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldCreateTime, field.TypeTime, value)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FailureCode(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldFailureCode, field.TypeString, value)
+	}
+	if _u.mutation.FailureCodeCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldFailureCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.FailureMessage(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldFailureMessage, field.TypeString, value)
+	}
+	if _u.mutation.FailureMessageCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldFailureMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.AddressesJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldAddressesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAddressesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2natgateway.FieldAddressesJSON, value)
+		})
+	}
+	if _u.mutation.AddressesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldAddressesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2natgateway.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2natgateway.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2natgateway.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2NATGateway
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryAWSEC2NATGateway{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2natgateway.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
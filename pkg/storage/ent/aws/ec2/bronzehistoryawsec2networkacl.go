@@ -0,0 +1,263 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2networkacl"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryAWSEC2NetworkACL is the model entity for the BronzeHistoryAWSEC2NetworkACL schema.
+type BronzeHistoryAWSEC2NetworkACL struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze network ACL by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// VpcID holds the value of the "vpc_id" field.
+	VpcID string `json:"vpc_id,omitempty"`
+	// IsDefault holds the value of the "is_default" field.
+	IsDefault bool `json:"is_default,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID string `json:"owner_id,omitempty"`
+	// EntriesJSON holds the value of the "entries_json" field.
+	EntriesJSON json.RawMessage `json:"entries_json,omitempty"`
+	// AssociationsJSON holds the value of the "associations_json" field.
+	AssociationsJSON json.RawMessage `json:"associations_json,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Region holds the value of the "region" field.
+	Region       string `json:"region,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryAWSEC2NetworkACL) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsec2networkacl.FieldEntriesJSON, bronzehistoryawsec2networkacl.FieldAssociationsJSON, bronzehistoryawsec2networkacl.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzehistoryawsec2networkacl.FieldIsDefault:
+			values[i] = new(sql.NullBool)
+		case bronzehistoryawsec2networkacl.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryawsec2networkacl.FieldResourceID, bronzehistoryawsec2networkacl.FieldName, bronzehistoryawsec2networkacl.FieldVpcID, bronzehistoryawsec2networkacl.FieldOwnerID, bronzehistoryawsec2networkacl.FieldAccountID, bronzehistoryawsec2networkacl.FieldRegion:
+			values[i] = new(sql.NullString)
+		case bronzehistoryawsec2networkacl.FieldValidFrom, bronzehistoryawsec2networkacl.FieldValidTo, bronzehistoryawsec2networkacl.FieldCollectedAt, bronzehistoryawsec2networkacl.FieldFirstCollectedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryAWSEC2NetworkACL fields.
+func (_m *BronzeHistoryAWSEC2NetworkACL) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsec2networkacl.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryawsec2networkacl.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryawsec2networkacl.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryawsec2networkacl.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryawsec2networkacl.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryawsec2networkacl.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryawsec2networkacl.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzehistoryawsec2networkacl.FieldVpcID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vpc_id", values[i])
+			} else if value.Valid {
+				_m.VpcID = value.String
+			}
+		case bronzehistoryawsec2networkacl.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				_m.IsDefault = value.Bool
+			}
+		case bronzehistoryawsec2networkacl.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				_m.OwnerID = value.String
+			}
+		case bronzehistoryawsec2networkacl.FieldEntriesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field entries_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EntriesJSON); err != nil {
+					return fmt.Errorf("unmarshal field entries_json: %w", err)
+				}
+			}
+		case bronzehistoryawsec2networkacl.FieldAssociationsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field associations_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AssociationsJSON); err != nil {
+					return fmt.Errorf("unmarshal field associations_json: %w", err)
+				}
+			}
+		case bronzehistoryawsec2networkacl.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzehistoryawsec2networkacl.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzehistoryawsec2networkacl.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryAWSEC2NetworkACL.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryAWSEC2NetworkACL) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryAWSEC2NetworkACL.
+// Note that you need to call BronzeHistoryAWSEC2NetworkACL.Unwrap() before calling this method if this BronzeHistoryAWSEC2NetworkACL
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryAWSEC2NetworkACL) Update() *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	return NewBronzeHistoryAWSEC2NetworkACLClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryAWSEC2NetworkACL entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryAWSEC2NetworkACL) Unwrap() *BronzeHistoryAWSEC2NetworkACL {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ec2: BronzeHistoryAWSEC2NetworkACL is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryAWSEC2NetworkACL) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryAWSEC2NetworkACL(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("vpc_id=")
+	builder.WriteString(_m.VpcID)
+	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsDefault))
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(_m.OwnerID)
+	builder.WriteString(", ")
+	builder.WriteString("entries_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EntriesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("associations_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AssociationsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryAWSEC2NetworkACLs is a parsable slice of BronzeHistoryAWSEC2NetworkACL.
+type BronzeHistoryAWSEC2NetworkACLs []*BronzeHistoryAWSEC2NetworkACL
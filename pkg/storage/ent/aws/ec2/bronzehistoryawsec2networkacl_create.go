@@ -0,0 +1,398 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2networkacl"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2NetworkACLCreate is the builder for creating a BronzeHistoryAWSEC2NetworkACL entity.
+type BronzeHistoryAWSEC2NetworkACLCreate struct {
+	config
+	mutation *BronzeHistoryAWSEC2NetworkACLMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetValidFrom(v time.Time) *BronzeHistoryAWSEC2NetworkACLCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetValidTo(v time.Time) *BronzeHistoryAWSEC2NetworkACLCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2NetworkACLCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2NetworkACLCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2NetworkACLCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetResourceID(v string) *BronzeHistoryAWSEC2NetworkACLCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetName(v string) *BronzeHistoryAWSEC2NetworkACLCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetNillableName(v *string) *BronzeHistoryAWSEC2NetworkACLCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetVpcID(v string) *BronzeHistoryAWSEC2NetworkACLCreate {
+	_c.mutation.SetVpcID(v)
+	return _c
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetNillableVpcID(v *string) *BronzeHistoryAWSEC2NetworkACLCreate {
+	if v != nil {
+		_c.SetVpcID(*v)
+	}
+	return _c
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetIsDefault(v bool) *BronzeHistoryAWSEC2NetworkACLCreate {
+	_c.mutation.SetIsDefault(v)
+	return _c
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetNillableIsDefault(v *bool) *BronzeHistoryAWSEC2NetworkACLCreate {
+	if v != nil {
+		_c.SetIsDefault(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetOwnerID(v string) *BronzeHistoryAWSEC2NetworkACLCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetNillableOwnerID(v *string) *BronzeHistoryAWSEC2NetworkACLCreate {
+	if v != nil {
+		_c.SetOwnerID(*v)
+	}
+	return _c
+}
+
+// SetEntriesJSON sets the "entries_json" field.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetEntriesJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkACLCreate {
+	_c.mutation.SetEntriesJSON(v)
+	return _c
+}
+
+// SetAssociationsJSON sets the "associations_json" field.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkACLCreate {
+	_c.mutation.SetAssociationsJSON(v)
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkACLCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetAccountID(v string) *BronzeHistoryAWSEC2NetworkACLCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetRegion(v string) *BronzeHistoryAWSEC2NetworkACLCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SetID(v uint) *BronzeHistoryAWSEC2NetworkACLCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryAWSEC2NetworkACLMutation object of the builder.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) Mutation() *BronzeHistoryAWSEC2NetworkACLMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryAWSEC2NetworkACL in the database.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) Save(ctx context.Context) (*BronzeHistoryAWSEC2NetworkACL, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) SaveX(ctx context.Context) *BronzeHistoryAWSEC2NetworkACL {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2NetworkACL.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2NetworkACL.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2NetworkACL.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2NetworkACL.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2networkacl.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NetworkACL.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2NetworkACL.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2networkacl.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NetworkACL.account_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2NetworkACL.region"`)}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := bronzehistoryawsec2networkacl.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NetworkACL.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) sqlSave(ctx context.Context) (*BronzeHistoryAWSEC2NetworkACL, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryAWSEC2NetworkACLCreate) createSpec() (*BronzeHistoryAWSEC2NetworkACL, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryAWSEC2NetworkACL{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryawsec2networkacl.Table, sqlgraph.NewFieldSpec(bronzehistoryawsec2networkacl.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryAWSEC2NetworkACL
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.VpcID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldVpcID, field.TypeString, value)
+		_node.VpcID = value
+	}
+	if value, ok := _c.mutation.IsDefault(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = value
+	}
+	if value, ok := _c.mutation.EntriesJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldEntriesJSON, field.TypeJSON, value)
+		_node.EntriesJSON = value
+	}
+	if value, ok := _c.mutation.AssociationsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldAssociationsJSON, field.TypeJSON, value)
+		_node.AssociationsJSON = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryAWSEC2NetworkACLCreateBulk is the builder for creating many BronzeHistoryAWSEC2NetworkACL entities in bulk.
+type BronzeHistoryAWSEC2NetworkACLCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryAWSEC2NetworkACLCreate
+}
+
+// Save creates the BronzeHistoryAWSEC2NetworkACL entities in the database.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryAWSEC2NetworkACL, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryAWSEC2NetworkACL, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryAWSEC2NetworkACLMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryAWSEC2NetworkACL {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSEC2NetworkACLCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,833 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2networkacl"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2NetworkACLUpdate is the builder for updating BronzeHistoryAWSEC2NetworkACL entities.
+type BronzeHistoryAWSEC2NetworkACLUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2NetworkACLMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2NetworkACLUpdate builder.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) Where(ps ...predicate.BronzeHistoryAWSEC2NetworkACL) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetValidTo(v time.Time) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) ClearValidTo() *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetResourceID(v string) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetName(v string) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetNillableName(v *string) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) ClearName() *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetVpcID(v string) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetNillableVpcID(v *string) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) ClearVpcID() *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetIsDefault(v bool) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetNillableIsDefault(v *bool) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// ClearIsDefault clears the value of the "is_default" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) ClearIsDefault() *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.ClearIsDefault()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetOwnerID(v string) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetNillableOwnerID(v *string) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) ClearOwnerID() *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetEntriesJSON sets the "entries_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetEntriesJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.SetEntriesJSON(v)
+	return _u
+}
+
+// AppendEntriesJSON appends value to the "entries_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) AppendEntriesJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.AppendEntriesJSON(v)
+	return _u
+}
+
+// ClearEntriesJSON clears the value of the "entries_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) ClearEntriesJSON() *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.ClearEntriesJSON()
+	return _u
+}
+
+// SetAssociationsJSON sets the "associations_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.SetAssociationsJSON(v)
+	return _u
+}
+
+// AppendAssociationsJSON appends value to the "associations_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) AppendAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.AppendAssociationsJSON(v)
+	return _u
+}
+
+// ClearAssociationsJSON clears the value of the "associations_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) ClearAssociationsJSON() *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.ClearAssociationsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) ClearTagsJSON() *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetAccountID(v string) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetRegion(v string) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SetNillableRegion(v *string) *BronzeHistoryAWSEC2NetworkACLUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2NetworkACLMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) Mutation() *BronzeHistoryAWSEC2NetworkACLMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2networkacl.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NetworkACL.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2networkacl.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NetworkACL.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2networkacl.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NetworkACL.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2networkacl.Table, bronzehistoryawsec2networkacl.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2networkacl.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkacl.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkacl.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkacl.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldIsDefault, field.TypeBool, value)
+	}
+	if _u.mutation.IsDefaultCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkacl.FieldIsDefault, field.TypeBool)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkacl.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.EntriesJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldEntriesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEntriesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2networkacl.FieldEntriesJSON, value)
+		})
+	}
+	if _u.mutation.EntriesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkacl.FieldEntriesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AssociationsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2networkacl.FieldAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.AssociationsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkacl.FieldAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2networkacl.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkacl.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2NetworkACL
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2networkacl.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryAWSEC2NetworkACLUpdateOne is the builder for updating a single BronzeHistoryAWSEC2NetworkACL entity.
+type BronzeHistoryAWSEC2NetworkACLUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2NetworkACLMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetValidTo(v time.Time) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) ClearValidTo() *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetResourceID(v string) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetName(v string) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetNillableName(v *string) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) ClearName() *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetVpcID(v string) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetNillableVpcID(v *string) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) ClearVpcID() *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetIsDefault(v bool) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetNillableIsDefault(v *bool) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// ClearIsDefault clears the value of the "is_default" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) ClearIsDefault() *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.ClearIsDefault()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetOwnerID(v string) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetNillableOwnerID(v *string) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) ClearOwnerID() *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetEntriesJSON sets the "entries_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetEntriesJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetEntriesJSON(v)
+	return _u
+}
+
+// AppendEntriesJSON appends value to the "entries_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) AppendEntriesJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.AppendEntriesJSON(v)
+	return _u
+}
+
+// ClearEntriesJSON clears the value of the "entries_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) ClearEntriesJSON() *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.ClearEntriesJSON()
+	return _u
+}
+
+// SetAssociationsJSON sets the "associations_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetAssociationsJSON(v)
+	return _u
+}
+
+// AppendAssociationsJSON appends value to the "associations_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) AppendAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.AppendAssociationsJSON(v)
+	return _u
+}
+
+// ClearAssociationsJSON clears the value of the "associations_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) ClearAssociationsJSON() *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.ClearAssociationsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) ClearTagsJSON() *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetAccountID(v string) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetRegion(v string) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SetNillableRegion(v *string) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2NetworkACLMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) Mutation() *BronzeHistoryAWSEC2NetworkACLMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2NetworkACLUpdate builder.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) Where(ps ...predicate.BronzeHistoryAWSEC2NetworkACL) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) Select(field string, fields ...string) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryAWSEC2NetworkACL entity.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) Save(ctx context.Context) (*BronzeHistoryAWSEC2NetworkACL, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) SaveX(ctx context.Context) *BronzeHistoryAWSEC2NetworkACL {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2networkacl.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NetworkACL.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2networkacl.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NetworkACL.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2networkacl.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NetworkACL.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2NetworkACLUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryAWSEC2NetworkACL, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2networkacl.Table, bronzehistoryawsec2networkacl.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2networkacl.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeHistoryAWSEC2NetworkACL.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawsec2networkacl.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryawsec2networkacl.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryawsec2networkacl.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkacl.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkacl.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkacl.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldIsDefault, field.TypeBool, value)
+	}
+	if _u.mutation.IsDefaultCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkacl.FieldIsDefault, field.TypeBool)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkacl.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.EntriesJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldEntriesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEntriesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2networkacl.FieldEntriesJSON, value)
+		})
+	}
+	if _u.mutation.EntriesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkacl.FieldEntriesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AssociationsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2networkacl.FieldAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.AssociationsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkacl.FieldAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2networkacl.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkacl.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2networkacl.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2NetworkACL
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryAWSEC2NetworkACL{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2networkacl.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,430 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2networkinterface"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryAWSEC2NetworkInterface is the model entity for the BronzeHistoryAWSEC2NetworkInterface schema.
+type BronzeHistoryAWSEC2NetworkInterface struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze network interface by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// InterfaceType holds the value of the "interface_type" field.
+	InterfaceType string `json:"interface_type,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// VpcID holds the value of the "vpc_id" field.
+	VpcID string `json:"vpc_id,omitempty"`
+	// SubnetID holds the value of the "subnet_id" field.
+	SubnetID string `json:"subnet_id,omitempty"`
+	// AvailabilityZone holds the value of the "availability_zone" field.
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+	// MACAddress holds the value of the "mac_address" field.
+	MACAddress string `json:"mac_address,omitempty"`
+	// PrivateIPAddress holds the value of the "private_ip_address" field.
+	PrivateIPAddress string `json:"private_ip_address,omitempty"`
+	// PrivateDNSName holds the value of the "private_dns_name" field.
+	PrivateDNSName string `json:"private_dns_name,omitempty"`
+	// PublicIP holds the value of the "public_ip" field.
+	PublicIP string `json:"public_ip,omitempty"`
+	// PublicDNSName holds the value of the "public_dns_name" field.
+	PublicDNSName string `json:"public_dns_name,omitempty"`
+	// SourceDestCheck holds the value of the "source_dest_check" field.
+	SourceDestCheck bool `json:"source_dest_check,omitempty"`
+	// RequesterManaged holds the value of the "requester_managed" field.
+	RequesterManaged bool `json:"requester_managed,omitempty"`
+	// RequesterID holds the value of the "requester_id" field.
+	RequesterID string `json:"requester_id,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID string `json:"owner_id,omitempty"`
+	// AttachmentID holds the value of the "attachment_id" field.
+	AttachmentID string `json:"attachment_id,omitempty"`
+	// AttachmentInstanceID holds the value of the "attachment_instance_id" field.
+	AttachmentInstanceID string `json:"attachment_instance_id,omitempty"`
+	// AttachmentStatus holds the value of the "attachment_status" field.
+	AttachmentStatus string `json:"attachment_status,omitempty"`
+	// GroupsJSON holds the value of the "groups_json" field.
+	GroupsJSON json.RawMessage `json:"groups_json,omitempty"`
+	// PrivateIPAddressesJSON holds the value of the "private_ip_addresses_json" field.
+	PrivateIPAddressesJSON json.RawMessage `json:"private_ip_addresses_json,omitempty"`
+	// Ipv6AddressesJSON holds the value of the "ipv6_addresses_json" field.
+	Ipv6AddressesJSON json.RawMessage `json:"ipv6_addresses_json,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Region holds the value of the "region" field.
+	Region       string `json:"region,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryAWSEC2NetworkInterface) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsec2networkinterface.FieldGroupsJSON, bronzehistoryawsec2networkinterface.FieldPrivateIPAddressesJSON, bronzehistoryawsec2networkinterface.FieldIpv6AddressesJSON, bronzehistoryawsec2networkinterface.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzehistoryawsec2networkinterface.FieldSourceDestCheck, bronzehistoryawsec2networkinterface.FieldRequesterManaged:
+			values[i] = new(sql.NullBool)
+		case bronzehistoryawsec2networkinterface.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryawsec2networkinterface.FieldResourceID, bronzehistoryawsec2networkinterface.FieldDescription, bronzehistoryawsec2networkinterface.FieldInterfaceType, bronzehistoryawsec2networkinterface.FieldStatus, bronzehistoryawsec2networkinterface.FieldVpcID, bronzehistoryawsec2networkinterface.FieldSubnetID, bronzehistoryawsec2networkinterface.FieldAvailabilityZone, bronzehistoryawsec2networkinterface.FieldMACAddress, bronzehistoryawsec2networkinterface.FieldPrivateIPAddress, bronzehistoryawsec2networkinterface.FieldPrivateDNSName, bronzehistoryawsec2networkinterface.FieldPublicIP, bronzehistoryawsec2networkinterface.FieldPublicDNSName, bronzehistoryawsec2networkinterface.FieldRequesterID, bronzehistoryawsec2networkinterface.FieldOwnerID, bronzehistoryawsec2networkinterface.FieldAttachmentID, bronzehistoryawsec2networkinterface.FieldAttachmentInstanceID, bronzehistoryawsec2networkinterface.FieldAttachmentStatus, bronzehistoryawsec2networkinterface.FieldAccountID, bronzehistoryawsec2networkinterface.FieldRegion:
+			values[i] = new(sql.NullString)
+		case bronzehistoryawsec2networkinterface.FieldValidFrom, bronzehistoryawsec2networkinterface.FieldValidTo, bronzehistoryawsec2networkinterface.FieldCollectedAt, bronzehistoryawsec2networkinterface.FieldFirstCollectedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryAWSEC2NetworkInterface fields.
+func (_m *BronzeHistoryAWSEC2NetworkInterface) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsec2networkinterface.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryawsec2networkinterface.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryawsec2networkinterface.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryawsec2networkinterface.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryawsec2networkinterface.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryawsec2networkinterface.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryawsec2networkinterface.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case bronzehistoryawsec2networkinterface.FieldInterfaceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field interface_type", values[i])
+			} else if value.Valid {
+				_m.InterfaceType = value.String
+			}
+		case bronzehistoryawsec2networkinterface.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case bronzehistoryawsec2networkinterface.FieldVpcID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vpc_id", values[i])
+			} else if value.Valid {
+				_m.VpcID = value.String
+			}
+		case bronzehistoryawsec2networkinterface.FieldSubnetID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subnet_id", values[i])
+			} else if value.Valid {
+				_m.SubnetID = value.String
+			}
+		case bronzehistoryawsec2networkinterface.FieldAvailabilityZone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field availability_zone", values[i])
+			} else if value.Valid {
+				_m.AvailabilityZone = value.String
+			}
+		case bronzehistoryawsec2networkinterface.FieldMACAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mac_address", values[i])
+			} else if value.Valid {
+				_m.MACAddress = value.String
+			}
+		case bronzehistoryawsec2networkinterface.FieldPrivateIPAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field private_ip_address", values[i])
+			} else if value.Valid {
+				_m.PrivateIPAddress = value.String
+			}
+		case bronzehistoryawsec2networkinterface.FieldPrivateDNSName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field private_dns_name", values[i])
+			} else if value.Valid {
+				_m.PrivateDNSName = value.String
+			}
+		case bronzehistoryawsec2networkinterface.FieldPublicIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field public_ip", values[i])
+			} else if value.Valid {
+				_m.PublicIP = value.String
+			}
+		case bronzehistoryawsec2networkinterface.FieldPublicDNSName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field public_dns_name", values[i])
+			} else if value.Valid {
+				_m.PublicDNSName = value.String
+			}
+		case bronzehistoryawsec2networkinterface.FieldSourceDestCheck:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field source_dest_check", values[i])
+			} else if value.Valid {
+				_m.SourceDestCheck = value.Bool
+			}
+		case bronzehistoryawsec2networkinterface.FieldRequesterManaged:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field requester_managed", values[i])
+			} else if value.Valid {
+				_m.RequesterManaged = value.Bool
+			}
+		case bronzehistoryawsec2networkinterface.FieldRequesterID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field requester_id", values[i])
+			} else if value.Valid {
+				_m.RequesterID = value.String
+			}
+		case bronzehistoryawsec2networkinterface.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				_m.OwnerID = value.String
+			}
+		case bronzehistoryawsec2networkinterface.FieldAttachmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment_id", values[i])
+			} else if value.Valid {
+				_m.AttachmentID = value.String
+			}
+		case bronzehistoryawsec2networkinterface.FieldAttachmentInstanceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment_instance_id", values[i])
+			} else if value.Valid {
+				_m.AttachmentInstanceID = value.String
+			}
+		case bronzehistoryawsec2networkinterface.FieldAttachmentStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment_status", values[i])
+			} else if value.Valid {
+				_m.AttachmentStatus = value.String
+			}
+		case bronzehistoryawsec2networkinterface.FieldGroupsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field groups_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.GroupsJSON); err != nil {
+					return fmt.Errorf("unmarshal field groups_json: %w", err)
+				}
+			}
+		case bronzehistoryawsec2networkinterface.FieldPrivateIPAddressesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field private_ip_addresses_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PrivateIPAddressesJSON); err != nil {
+					return fmt.Errorf("unmarshal field private_ip_addresses_json: %w", err)
+				}
+			}
+		case bronzehistoryawsec2networkinterface.FieldIpv6AddressesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ipv6_addresses_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Ipv6AddressesJSON); err != nil {
+					return fmt.Errorf("unmarshal field ipv6_addresses_json: %w", err)
+				}
+			}
+		case bronzehistoryawsec2networkinterface.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzehistoryawsec2networkinterface.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzehistoryawsec2networkinterface.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryAWSEC2NetworkInterface.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryAWSEC2NetworkInterface) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryAWSEC2NetworkInterface.
+// Note that you need to call BronzeHistoryAWSEC2NetworkInterface.Unwrap() before calling this method if this BronzeHistoryAWSEC2NetworkInterface
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryAWSEC2NetworkInterface) Update() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	return NewBronzeHistoryAWSEC2NetworkInterfaceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryAWSEC2NetworkInterface entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryAWSEC2NetworkInterface) Unwrap() *BronzeHistoryAWSEC2NetworkInterface {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ec2: BronzeHistoryAWSEC2NetworkInterface is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryAWSEC2NetworkInterface) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryAWSEC2NetworkInterface(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("interface_type=")
+	builder.WriteString(_m.InterfaceType)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("vpc_id=")
+	builder.WriteString(_m.VpcID)
+	builder.WriteString(", ")
+	builder.WriteString("subnet_id=")
+	builder.WriteString(_m.SubnetID)
+	builder.WriteString(", ")
+	builder.WriteString("availability_zone=")
+	builder.WriteString(_m.AvailabilityZone)
+	builder.WriteString(", ")
+	builder.WriteString("mac_address=")
+	builder.WriteString(_m.MACAddress)
+	builder.WriteString(", ")
+	builder.WriteString("private_ip_address=")
+	builder.WriteString(_m.PrivateIPAddress)
+	builder.WriteString(", ")
+	builder.WriteString("private_dns_name=")
+	builder.WriteString(_m.PrivateDNSName)
+	builder.WriteString(", ")
+	builder.WriteString("public_ip=")
+	builder.WriteString(_m.PublicIP)
+	builder.WriteString(", ")
+	builder.WriteString("public_dns_name=")
+	builder.WriteString(_m.PublicDNSName)
+	builder.WriteString(", ")
+	builder.WriteString("source_dest_check=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SourceDestCheck))
+	builder.WriteString(", ")
+	builder.WriteString("requester_managed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequesterManaged))
+	builder.WriteString(", ")
+	builder.WriteString("requester_id=")
+	builder.WriteString(_m.RequesterID)
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(_m.OwnerID)
+	builder.WriteString(", ")
+	builder.WriteString("attachment_id=")
+	builder.WriteString(_m.AttachmentID)
+	builder.WriteString(", ")
+	builder.WriteString("attachment_instance_id=")
+	builder.WriteString(_m.AttachmentInstanceID)
+	builder.WriteString(", ")
+	builder.WriteString("attachment_status=")
+	builder.WriteString(_m.AttachmentStatus)
+	builder.WriteString(", ")
+	builder.WriteString("groups_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GroupsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("private_ip_addresses_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PrivateIPAddressesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("ipv6_addresses_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Ipv6AddressesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryAWSEC2NetworkInterfaces is a parsable slice of BronzeHistoryAWSEC2NetworkInterface.
+type BronzeHistoryAWSEC2NetworkInterfaces []*BronzeHistoryAWSEC2NetworkInterface
@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryawsec2networkinterface
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzehistoryawsec2networkinterface type in the database.
+	Label = "bronze_history_awsec2network_interface"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "history_id"
+	// FieldValidFrom holds the string denoting the valid_from field in the database.
+	FieldValidFrom = "valid_from"
+	// FieldValidTo holds the string denoting the valid_to field in the database.
+	FieldValidTo = "valid_to"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldResourceID holds the string denoting the resource_id field in the database.
+	FieldResourceID = "resource_id"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldInterfaceType holds the string denoting the interface_type field in the database.
+	FieldInterfaceType = "interface_type"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldVpcID holds the string denoting the vpc_id field in the database.
+	FieldVpcID = "vpc_id"
+	// FieldSubnetID holds the string denoting the subnet_id field in the database.
+	FieldSubnetID = "subnet_id"
+	// FieldAvailabilityZone holds the string denoting the availability_zone field in the database.
+	FieldAvailabilityZone = "availability_zone"
+	// FieldMACAddress holds the string denoting the mac_address field in the database.
+	FieldMACAddress = "mac_address"
+	// FieldPrivateIPAddress holds the string denoting the private_ip_address field in the database.
+	FieldPrivateIPAddress = "private_ip_address"
+	// FieldPrivateDNSName holds the string denoting the private_dns_name field in the database.
+	FieldPrivateDNSName = "private_dns_name"
+	// FieldPublicIP holds the string denoting the public_ip field in the database.
+	FieldPublicIP = "public_ip"
+	// FieldPublicDNSName holds the string denoting the public_dns_name field in the database.
+	FieldPublicDNSName = "public_dns_name"
+	// FieldSourceDestCheck holds the string denoting the source_dest_check field in the database.
+	FieldSourceDestCheck = "source_dest_check"
+	// FieldRequesterManaged holds the string denoting the requester_managed field in the database.
+	FieldRequesterManaged = "requester_managed"
+	// FieldRequesterID holds the string denoting the requester_id field in the database.
+	FieldRequesterID = "requester_id"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
+	// FieldAttachmentID holds the string denoting the attachment_id field in the database.
+	FieldAttachmentID = "attachment_id"
+	// FieldAttachmentInstanceID holds the string denoting the attachment_instance_id field in the database.
+	FieldAttachmentInstanceID = "attachment_instance_id"
+	// FieldAttachmentStatus holds the string denoting the attachment_status field in the database.
+	FieldAttachmentStatus = "attachment_status"
+	// FieldGroupsJSON holds the string denoting the groups_json field in the database.
+	FieldGroupsJSON = "groups_json"
+	// FieldPrivateIPAddressesJSON holds the string denoting the private_ip_addresses_json field in the database.
+	FieldPrivateIPAddressesJSON = "private_ip_addresses_json"
+	// FieldIpv6AddressesJSON holds the string denoting the ipv6_addresses_json field in the database.
+	FieldIpv6AddressesJSON = "ipv6_addresses_json"
+	// FieldTagsJSON holds the string denoting the tags_json field in the database.
+	FieldTagsJSON = "tags_json"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
+	// Table holds the table name of the bronzehistoryawsec2networkinterface in the database.
+	Table = "aws_ec2_network_interfaces_history"
+)
+
+// Columns holds all SQL columns for bronzehistoryawsec2networkinterface fields.
+var Columns = []string{
+	FieldID,
+	FieldValidFrom,
+	FieldValidTo,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldResourceID,
+	FieldDescription,
+	FieldInterfaceType,
+	FieldStatus,
+	FieldVpcID,
+	FieldSubnetID,
+	FieldAvailabilityZone,
+	FieldMACAddress,
+	FieldPrivateIPAddress,
+	FieldPrivateDNSName,
+	FieldPublicIP,
+	FieldPublicDNSName,
+	FieldSourceDestCheck,
+	FieldRequesterManaged,
+	FieldRequesterID,
+	FieldOwnerID,
+	FieldAttachmentID,
+	FieldAttachmentInstanceID,
+	FieldAttachmentStatus,
+	FieldGroupsJSON,
+	FieldPrivateIPAddressesJSON,
+	FieldIpv6AddressesJSON,
+	FieldTagsJSON,
+	FieldAccountID,
+	FieldRegion,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	ResourceIDValidator func(string) error
+	// AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	AccountIDValidator func(string) error
+	// RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	RegionValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BronzeHistoryAWSEC2NetworkInterface queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByValidFrom orders the results by the valid_from field.
+func ByValidFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidFrom, opts...).ToFunc()
+}
+
+// ByValidTo orders the results by the valid_to field.
+func ByValidTo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidTo, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByResourceID orders the results by the resource_id field.
+func ByResourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceID, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByInterfaceType orders the results by the interface_type field.
+func ByInterfaceType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInterfaceType, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByVpcID orders the results by the vpc_id field.
+func ByVpcID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVpcID, opts...).ToFunc()
+}
+
+// BySubnetID orders the results by the subnet_id field.
+func BySubnetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubnetID, opts...).ToFunc()
+}
+
+// ByAvailabilityZone orders the results by the availability_zone field.
+func ByAvailabilityZone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvailabilityZone, opts...).ToFunc()
+}
+
+// ByMACAddress orders the results by the mac_address field.
+func ByMACAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMACAddress, opts...).ToFunc()
+}
+
+// ByPrivateIPAddress orders the results by the private_ip_address field.
+func ByPrivateIPAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrivateIPAddress, opts...).ToFunc()
+}
+
+// ByPrivateDNSName orders the results by the private_dns_name field.
+func ByPrivateDNSName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrivateDNSName, opts...).ToFunc()
+}
+
+// ByPublicIP orders the results by the public_ip field.
+func ByPublicIP(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublicIP, opts...).ToFunc()
+}
+
+// ByPublicDNSName orders the results by the public_dns_name field.
+func ByPublicDNSName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublicDNSName, opts...).ToFunc()
+}
+
+// BySourceDestCheck orders the results by the source_dest_check field.
+func BySourceDestCheck(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceDestCheck, opts...).ToFunc()
+}
+
+// ByRequesterManaged orders the results by the requester_managed field.
+func ByRequesterManaged(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequesterManaged, opts...).ToFunc()
+}
+
+// ByRequesterID orders the results by the requester_id field.
+func ByRequesterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequesterID, opts...).ToFunc()
+}
+
+// ByOwnerID orders the results by the owner_id field.
+func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
+}
+
+// ByAttachmentID orders the results by the attachment_id field.
+func ByAttachmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttachmentID, opts...).ToFunc()
+}
+
+// ByAttachmentInstanceID orders the results by the attachment_instance_id field.
+func ByAttachmentInstanceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttachmentInstanceID, opts...).ToFunc()
+}
+
+// ByAttachmentStatus orders the results by the attachment_status field.
+func ByAttachmentStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttachmentStatus, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByRegion orders the results by the region field.
+func ByRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegion, opts...).ToFunc()
+}
@@ -0,0 +1,1840 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryawsec2networkinterface
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldID, id))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldValidTo, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldResourceID, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldDescription, v))
+}
+
+// InterfaceType applies equality check predicate on the "interface_type" field. It's identical to InterfaceTypeEQ.
+func InterfaceType(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldInterfaceType, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldStatus, v))
+}
+
+// VpcID applies equality check predicate on the "vpc_id" field. It's identical to VpcIDEQ.
+func VpcID(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldVpcID, v))
+}
+
+// SubnetID applies equality check predicate on the "subnet_id" field. It's identical to SubnetIDEQ.
+func SubnetID(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldSubnetID, v))
+}
+
+// AvailabilityZone applies equality check predicate on the "availability_zone" field. It's identical to AvailabilityZoneEQ.
+func AvailabilityZone(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldAvailabilityZone, v))
+}
+
+// MACAddress applies equality check predicate on the "mac_address" field. It's identical to MACAddressEQ.
+func MACAddress(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldMACAddress, v))
+}
+
+// PrivateIPAddress applies equality check predicate on the "private_ip_address" field. It's identical to PrivateIPAddressEQ.
+func PrivateIPAddress(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldPrivateIPAddress, v))
+}
+
+// PrivateDNSName applies equality check predicate on the "private_dns_name" field. It's identical to PrivateDNSNameEQ.
+func PrivateDNSName(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldPrivateDNSName, v))
+}
+
+// PublicIP applies equality check predicate on the "public_ip" field. It's identical to PublicIPEQ.
+func PublicIP(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldPublicIP, v))
+}
+
+// PublicDNSName applies equality check predicate on the "public_dns_name" field. It's identical to PublicDNSNameEQ.
+func PublicDNSName(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldPublicDNSName, v))
+}
+
+// SourceDestCheck applies equality check predicate on the "source_dest_check" field. It's identical to SourceDestCheckEQ.
+func SourceDestCheck(v bool) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldSourceDestCheck, v))
+}
+
+// RequesterManaged applies equality check predicate on the "requester_managed" field. It's identical to RequesterManagedEQ.
+func RequesterManaged(v bool) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldRequesterManaged, v))
+}
+
+// RequesterID applies equality check predicate on the "requester_id" field. It's identical to RequesterIDEQ.
+func RequesterID(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldRequesterID, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// AttachmentID applies equality check predicate on the "attachment_id" field. It's identical to AttachmentIDEQ.
+func AttachmentID(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldAttachmentID, v))
+}
+
+// AttachmentInstanceID applies equality check predicate on the "attachment_instance_id" field. It's identical to AttachmentInstanceIDEQ.
+func AttachmentInstanceID(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldAttachmentInstanceID, v))
+}
+
+// AttachmentStatus applies equality check predicate on the "attachment_status" field. It's identical to AttachmentStatusEQ.
+func AttachmentStatus(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldAttachmentStatus, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldRegion, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldValidTo))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// InterfaceTypeEQ applies the EQ predicate on the "interface_type" field.
+func InterfaceTypeEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldInterfaceType, v))
+}
+
+// InterfaceTypeNEQ applies the NEQ predicate on the "interface_type" field.
+func InterfaceTypeNEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldInterfaceType, v))
+}
+
+// InterfaceTypeIn applies the In predicate on the "interface_type" field.
+func InterfaceTypeIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldInterfaceType, vs...))
+}
+
+// InterfaceTypeNotIn applies the NotIn predicate on the "interface_type" field.
+func InterfaceTypeNotIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldInterfaceType, vs...))
+}
+
+// InterfaceTypeGT applies the GT predicate on the "interface_type" field.
+func InterfaceTypeGT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldInterfaceType, v))
+}
+
+// InterfaceTypeGTE applies the GTE predicate on the "interface_type" field.
+func InterfaceTypeGTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldInterfaceType, v))
+}
+
+// InterfaceTypeLT applies the LT predicate on the "interface_type" field.
+func InterfaceTypeLT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldInterfaceType, v))
+}
+
+// InterfaceTypeLTE applies the LTE predicate on the "interface_type" field.
+func InterfaceTypeLTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldInterfaceType, v))
+}
+
+// InterfaceTypeContains applies the Contains predicate on the "interface_type" field.
+func InterfaceTypeContains(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContains(FieldInterfaceType, v))
+}
+
+// InterfaceTypeHasPrefix applies the HasPrefix predicate on the "interface_type" field.
+func InterfaceTypeHasPrefix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasPrefix(FieldInterfaceType, v))
+}
+
+// InterfaceTypeHasSuffix applies the HasSuffix predicate on the "interface_type" field.
+func InterfaceTypeHasSuffix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasSuffix(FieldInterfaceType, v))
+}
+
+// InterfaceTypeIsNil applies the IsNil predicate on the "interface_type" field.
+func InterfaceTypeIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldInterfaceType))
+}
+
+// InterfaceTypeNotNil applies the NotNil predicate on the "interface_type" field.
+func InterfaceTypeNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldInterfaceType))
+}
+
+// InterfaceTypeEqualFold applies the EqualFold predicate on the "interface_type" field.
+func InterfaceTypeEqualFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEqualFold(FieldInterfaceType, v))
+}
+
+// InterfaceTypeContainsFold applies the ContainsFold predicate on the "interface_type" field.
+func InterfaceTypeContainsFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContainsFold(FieldInterfaceType, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldStatus))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// VpcIDEQ applies the EQ predicate on the "vpc_id" field.
+func VpcIDEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldVpcID, v))
+}
+
+// VpcIDNEQ applies the NEQ predicate on the "vpc_id" field.
+func VpcIDNEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldVpcID, v))
+}
+
+// VpcIDIn applies the In predicate on the "vpc_id" field.
+func VpcIDIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldVpcID, vs...))
+}
+
+// VpcIDNotIn applies the NotIn predicate on the "vpc_id" field.
+func VpcIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldVpcID, vs...))
+}
+
+// VpcIDGT applies the GT predicate on the "vpc_id" field.
+func VpcIDGT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldVpcID, v))
+}
+
+// VpcIDGTE applies the GTE predicate on the "vpc_id" field.
+func VpcIDGTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldVpcID, v))
+}
+
+// VpcIDLT applies the LT predicate on the "vpc_id" field.
+func VpcIDLT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldVpcID, v))
+}
+
+// VpcIDLTE applies the LTE predicate on the "vpc_id" field.
+func VpcIDLTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldVpcID, v))
+}
+
+// VpcIDContains applies the Contains predicate on the "vpc_id" field.
+func VpcIDContains(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContains(FieldVpcID, v))
+}
+
+// VpcIDHasPrefix applies the HasPrefix predicate on the "vpc_id" field.
+func VpcIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasPrefix(FieldVpcID, v))
+}
+
+// VpcIDHasSuffix applies the HasSuffix predicate on the "vpc_id" field.
+func VpcIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasSuffix(FieldVpcID, v))
+}
+
+// VpcIDIsNil applies the IsNil predicate on the "vpc_id" field.
+func VpcIDIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldVpcID))
+}
+
+// VpcIDNotNil applies the NotNil predicate on the "vpc_id" field.
+func VpcIDNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldVpcID))
+}
+
+// VpcIDEqualFold applies the EqualFold predicate on the "vpc_id" field.
+func VpcIDEqualFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEqualFold(FieldVpcID, v))
+}
+
+// VpcIDContainsFold applies the ContainsFold predicate on the "vpc_id" field.
+func VpcIDContainsFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContainsFold(FieldVpcID, v))
+}
+
+// SubnetIDEQ applies the EQ predicate on the "subnet_id" field.
+func SubnetIDEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldSubnetID, v))
+}
+
+// SubnetIDNEQ applies the NEQ predicate on the "subnet_id" field.
+func SubnetIDNEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldSubnetID, v))
+}
+
+// SubnetIDIn applies the In predicate on the "subnet_id" field.
+func SubnetIDIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldSubnetID, vs...))
+}
+
+// SubnetIDNotIn applies the NotIn predicate on the "subnet_id" field.
+func SubnetIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldSubnetID, vs...))
+}
+
+// SubnetIDGT applies the GT predicate on the "subnet_id" field.
+func SubnetIDGT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldSubnetID, v))
+}
+
+// SubnetIDGTE applies the GTE predicate on the "subnet_id" field.
+func SubnetIDGTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldSubnetID, v))
+}
+
+// SubnetIDLT applies the LT predicate on the "subnet_id" field.
+func SubnetIDLT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldSubnetID, v))
+}
+
+// SubnetIDLTE applies the LTE predicate on the "subnet_id" field.
+func SubnetIDLTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldSubnetID, v))
+}
+
+// SubnetIDContains applies the Contains predicate on the "subnet_id" field.
+func SubnetIDContains(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContains(FieldSubnetID, v))
+}
+
+// SubnetIDHasPrefix applies the HasPrefix predicate on the "subnet_id" field.
+func SubnetIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasPrefix(FieldSubnetID, v))
+}
+
+// SubnetIDHasSuffix applies the HasSuffix predicate on the "subnet_id" field.
+func SubnetIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasSuffix(FieldSubnetID, v))
+}
+
+// SubnetIDIsNil applies the IsNil predicate on the "subnet_id" field.
+func SubnetIDIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldSubnetID))
+}
+
+// SubnetIDNotNil applies the NotNil predicate on the "subnet_id" field.
+func SubnetIDNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldSubnetID))
+}
+
+// SubnetIDEqualFold applies the EqualFold predicate on the "subnet_id" field.
+func SubnetIDEqualFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEqualFold(FieldSubnetID, v))
+}
+
+// SubnetIDContainsFold applies the ContainsFold predicate on the "subnet_id" field.
+func SubnetIDContainsFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContainsFold(FieldSubnetID, v))
+}
+
+// AvailabilityZoneEQ applies the EQ predicate on the "availability_zone" field.
+func AvailabilityZoneEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneNEQ applies the NEQ predicate on the "availability_zone" field.
+func AvailabilityZoneNEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneIn applies the In predicate on the "availability_zone" field.
+func AvailabilityZoneIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldAvailabilityZone, vs...))
+}
+
+// AvailabilityZoneNotIn applies the NotIn predicate on the "availability_zone" field.
+func AvailabilityZoneNotIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldAvailabilityZone, vs...))
+}
+
+// AvailabilityZoneGT applies the GT predicate on the "availability_zone" field.
+func AvailabilityZoneGT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneGTE applies the GTE predicate on the "availability_zone" field.
+func AvailabilityZoneGTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneLT applies the LT predicate on the "availability_zone" field.
+func AvailabilityZoneLT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneLTE applies the LTE predicate on the "availability_zone" field.
+func AvailabilityZoneLTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneContains applies the Contains predicate on the "availability_zone" field.
+func AvailabilityZoneContains(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContains(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneHasPrefix applies the HasPrefix predicate on the "availability_zone" field.
+func AvailabilityZoneHasPrefix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasPrefix(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneHasSuffix applies the HasSuffix predicate on the "availability_zone" field.
+func AvailabilityZoneHasSuffix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasSuffix(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneIsNil applies the IsNil predicate on the "availability_zone" field.
+func AvailabilityZoneIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldAvailabilityZone))
+}
+
+// AvailabilityZoneNotNil applies the NotNil predicate on the "availability_zone" field.
+func AvailabilityZoneNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldAvailabilityZone))
+}
+
+// AvailabilityZoneEqualFold applies the EqualFold predicate on the "availability_zone" field.
+func AvailabilityZoneEqualFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEqualFold(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneContainsFold applies the ContainsFold predicate on the "availability_zone" field.
+func AvailabilityZoneContainsFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContainsFold(FieldAvailabilityZone, v))
+}
+
+// MACAddressEQ applies the EQ predicate on the "mac_address" field.
+func MACAddressEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldMACAddress, v))
+}
+
+// MACAddressNEQ applies the NEQ predicate on the "mac_address" field.
+func MACAddressNEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldMACAddress, v))
+}
+
+// MACAddressIn applies the In predicate on the "mac_address" field.
+func MACAddressIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldMACAddress, vs...))
+}
+
+// MACAddressNotIn applies the NotIn predicate on the "mac_address" field.
+func MACAddressNotIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldMACAddress, vs...))
+}
+
+// MACAddressGT applies the GT predicate on the "mac_address" field.
+func MACAddressGT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldMACAddress, v))
+}
+
+// MACAddressGTE applies the GTE predicate on the "mac_address" field.
+func MACAddressGTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldMACAddress, v))
+}
+
+// MACAddressLT applies the LT predicate on the "mac_address" field.
+func MACAddressLT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldMACAddress, v))
+}
+
+// MACAddressLTE applies the LTE predicate on the "mac_address" field.
+func MACAddressLTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldMACAddress, v))
+}
+
+// MACAddressContains applies the Contains predicate on the "mac_address" field.
+func MACAddressContains(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContains(FieldMACAddress, v))
+}
+
+// MACAddressHasPrefix applies the HasPrefix predicate on the "mac_address" field.
+func MACAddressHasPrefix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasPrefix(FieldMACAddress, v))
+}
+
+// MACAddressHasSuffix applies the HasSuffix predicate on the "mac_address" field.
+func MACAddressHasSuffix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasSuffix(FieldMACAddress, v))
+}
+
+// MACAddressIsNil applies the IsNil predicate on the "mac_address" field.
+func MACAddressIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldMACAddress))
+}
+
+// MACAddressNotNil applies the NotNil predicate on the "mac_address" field.
+func MACAddressNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldMACAddress))
+}
+
+// MACAddressEqualFold applies the EqualFold predicate on the "mac_address" field.
+func MACAddressEqualFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEqualFold(FieldMACAddress, v))
+}
+
+// MACAddressContainsFold applies the ContainsFold predicate on the "mac_address" field.
+func MACAddressContainsFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContainsFold(FieldMACAddress, v))
+}
+
+// PrivateIPAddressEQ applies the EQ predicate on the "private_ip_address" field.
+func PrivateIPAddressEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressNEQ applies the NEQ predicate on the "private_ip_address" field.
+func PrivateIPAddressNEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressIn applies the In predicate on the "private_ip_address" field.
+func PrivateIPAddressIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldPrivateIPAddress, vs...))
+}
+
+// PrivateIPAddressNotIn applies the NotIn predicate on the "private_ip_address" field.
+func PrivateIPAddressNotIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldPrivateIPAddress, vs...))
+}
+
+// PrivateIPAddressGT applies the GT predicate on the "private_ip_address" field.
+func PrivateIPAddressGT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressGTE applies the GTE predicate on the "private_ip_address" field.
+func PrivateIPAddressGTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressLT applies the LT predicate on the "private_ip_address" field.
+func PrivateIPAddressLT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressLTE applies the LTE predicate on the "private_ip_address" field.
+func PrivateIPAddressLTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressContains applies the Contains predicate on the "private_ip_address" field.
+func PrivateIPAddressContains(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContains(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressHasPrefix applies the HasPrefix predicate on the "private_ip_address" field.
+func PrivateIPAddressHasPrefix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasPrefix(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressHasSuffix applies the HasSuffix predicate on the "private_ip_address" field.
+func PrivateIPAddressHasSuffix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasSuffix(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressIsNil applies the IsNil predicate on the "private_ip_address" field.
+func PrivateIPAddressIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldPrivateIPAddress))
+}
+
+// PrivateIPAddressNotNil applies the NotNil predicate on the "private_ip_address" field.
+func PrivateIPAddressNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldPrivateIPAddress))
+}
+
+// PrivateIPAddressEqualFold applies the EqualFold predicate on the "private_ip_address" field.
+func PrivateIPAddressEqualFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEqualFold(FieldPrivateIPAddress, v))
+}
+
+// PrivateIPAddressContainsFold applies the ContainsFold predicate on the "private_ip_address" field.
+func PrivateIPAddressContainsFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContainsFold(FieldPrivateIPAddress, v))
+}
+
+// PrivateDNSNameEQ applies the EQ predicate on the "private_dns_name" field.
+func PrivateDNSNameEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldPrivateDNSName, v))
+}
+
+// PrivateDNSNameNEQ applies the NEQ predicate on the "private_dns_name" field.
+func PrivateDNSNameNEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldPrivateDNSName, v))
+}
+
+// PrivateDNSNameIn applies the In predicate on the "private_dns_name" field.
+func PrivateDNSNameIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldPrivateDNSName, vs...))
+}
+
+// PrivateDNSNameNotIn applies the NotIn predicate on the "private_dns_name" field.
+func PrivateDNSNameNotIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldPrivateDNSName, vs...))
+}
+
+// PrivateDNSNameGT applies the GT predicate on the "private_dns_name" field.
+func PrivateDNSNameGT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldPrivateDNSName, v))
+}
+
+// PrivateDNSNameGTE applies the GTE predicate on the "private_dns_name" field.
+func PrivateDNSNameGTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldPrivateDNSName, v))
+}
+
+// PrivateDNSNameLT applies the LT predicate on the "private_dns_name" field.
+func PrivateDNSNameLT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldPrivateDNSName, v))
+}
+
+// PrivateDNSNameLTE applies the LTE predicate on the "private_dns_name" field.
+func PrivateDNSNameLTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldPrivateDNSName, v))
+}
+
+// PrivateDNSNameContains applies the Contains predicate on the "private_dns_name" field.
+func PrivateDNSNameContains(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContains(FieldPrivateDNSName, v))
+}
+
+// PrivateDNSNameHasPrefix applies the HasPrefix predicate on the "private_dns_name" field.
+func PrivateDNSNameHasPrefix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasPrefix(FieldPrivateDNSName, v))
+}
+
+// PrivateDNSNameHasSuffix applies the HasSuffix predicate on the "private_dns_name" field.
+func PrivateDNSNameHasSuffix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasSuffix(FieldPrivateDNSName, v))
+}
+
+// PrivateDNSNameIsNil applies the IsNil predicate on the "private_dns_name" field.
+func PrivateDNSNameIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldPrivateDNSName))
+}
+
+// PrivateDNSNameNotNil applies the NotNil predicate on the "private_dns_name" field.
+func PrivateDNSNameNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldPrivateDNSName))
+}
+
+// PrivateDNSNameEqualFold applies the EqualFold predicate on the "private_dns_name" field.
+func PrivateDNSNameEqualFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEqualFold(FieldPrivateDNSName, v))
+}
+
+// PrivateDNSNameContainsFold applies the ContainsFold predicate on the "private_dns_name" field.
+func PrivateDNSNameContainsFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContainsFold(FieldPrivateDNSName, v))
+}
+
+// PublicIPEQ applies the EQ predicate on the "public_ip" field.
+func PublicIPEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldPublicIP, v))
+}
+
+// PublicIPNEQ applies the NEQ predicate on the "public_ip" field.
+func PublicIPNEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldPublicIP, v))
+}
+
+// PublicIPIn applies the In predicate on the "public_ip" field.
+func PublicIPIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldPublicIP, vs...))
+}
+
+// PublicIPNotIn applies the NotIn predicate on the "public_ip" field.
+func PublicIPNotIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldPublicIP, vs...))
+}
+
+// PublicIPGT applies the GT predicate on the "public_ip" field.
+func PublicIPGT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldPublicIP, v))
+}
+
+// PublicIPGTE applies the GTE predicate on the "public_ip" field.
+func PublicIPGTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldPublicIP, v))
+}
+
+// PublicIPLT applies the LT predicate on the "public_ip" field.
+func PublicIPLT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldPublicIP, v))
+}
+
+// PublicIPLTE applies the LTE predicate on the "public_ip" field.
+func PublicIPLTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldPublicIP, v))
+}
+
+// PublicIPContains applies the Contains predicate on the "public_ip" field.
+func PublicIPContains(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContains(FieldPublicIP, v))
+}
+
+// PublicIPHasPrefix applies the HasPrefix predicate on the "public_ip" field.
+func PublicIPHasPrefix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasPrefix(FieldPublicIP, v))
+}
+
+// PublicIPHasSuffix applies the HasSuffix predicate on the "public_ip" field.
+func PublicIPHasSuffix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasSuffix(FieldPublicIP, v))
+}
+
+// PublicIPIsNil applies the IsNil predicate on the "public_ip" field.
+func PublicIPIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldPublicIP))
+}
+
+// PublicIPNotNil applies the NotNil predicate on the "public_ip" field.
+func PublicIPNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldPublicIP))
+}
+
+// PublicIPEqualFold applies the EqualFold predicate on the "public_ip" field.
+func PublicIPEqualFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEqualFold(FieldPublicIP, v))
+}
+
+// PublicIPContainsFold applies the ContainsFold predicate on the "public_ip" field.
+func PublicIPContainsFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContainsFold(FieldPublicIP, v))
+}
+
+// PublicDNSNameEQ applies the EQ predicate on the "public_dns_name" field.
+func PublicDNSNameEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldPublicDNSName, v))
+}
+
+// PublicDNSNameNEQ applies the NEQ predicate on the "public_dns_name" field.
+func PublicDNSNameNEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldPublicDNSName, v))
+}
+
+// PublicDNSNameIn applies the In predicate on the "public_dns_name" field.
+func PublicDNSNameIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldPublicDNSName, vs...))
+}
+
+// PublicDNSNameNotIn applies the NotIn predicate on the "public_dns_name" field.
+func PublicDNSNameNotIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldPublicDNSName, vs...))
+}
+
+// PublicDNSNameGT applies the GT predicate on the "public_dns_name" field.
+func PublicDNSNameGT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldPublicDNSName, v))
+}
+
+// PublicDNSNameGTE applies the GTE predicate on the "public_dns_name" field.
+func PublicDNSNameGTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldPublicDNSName, v))
+}
+
+// PublicDNSNameLT applies the LT predicate on the "public_dns_name" field.
+func PublicDNSNameLT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldPublicDNSName, v))
+}
+
+// PublicDNSNameLTE applies the LTE predicate on the "public_dns_name" field.
+func PublicDNSNameLTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldPublicDNSName, v))
+}
+
+// PublicDNSNameContains applies the Contains predicate on the "public_dns_name" field.
+func PublicDNSNameContains(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContains(FieldPublicDNSName, v))
+}
+
+// PublicDNSNameHasPrefix applies the HasPrefix predicate on the "public_dns_name" field.
+func PublicDNSNameHasPrefix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasPrefix(FieldPublicDNSName, v))
+}
+
+// PublicDNSNameHasSuffix applies the HasSuffix predicate on the "public_dns_name" field.
+func PublicDNSNameHasSuffix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasSuffix(FieldPublicDNSName, v))
+}
+
+// PublicDNSNameIsNil applies the IsNil predicate on the "public_dns_name" field.
+func PublicDNSNameIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldPublicDNSName))
+}
+
+// PublicDNSNameNotNil applies the NotNil predicate on the "public_dns_name" field.
+func PublicDNSNameNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldPublicDNSName))
+}
+
+// PublicDNSNameEqualFold applies the EqualFold predicate on the "public_dns_name" field.
+func PublicDNSNameEqualFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEqualFold(FieldPublicDNSName, v))
+}
+
+// PublicDNSNameContainsFold applies the ContainsFold predicate on the "public_dns_name" field.
+func PublicDNSNameContainsFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContainsFold(FieldPublicDNSName, v))
+}
+
+// SourceDestCheckEQ applies the EQ predicate on the "source_dest_check" field.
+func SourceDestCheckEQ(v bool) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldSourceDestCheck, v))
+}
+
+// SourceDestCheckNEQ applies the NEQ predicate on the "source_dest_check" field.
+func SourceDestCheckNEQ(v bool) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldSourceDestCheck, v))
+}
+
+// SourceDestCheckIsNil applies the IsNil predicate on the "source_dest_check" field.
+func SourceDestCheckIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldSourceDestCheck))
+}
+
+// SourceDestCheckNotNil applies the NotNil predicate on the "source_dest_check" field.
+func SourceDestCheckNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldSourceDestCheck))
+}
+
+// RequesterManagedEQ applies the EQ predicate on the "requester_managed" field.
+func RequesterManagedEQ(v bool) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldRequesterManaged, v))
+}
+
+// RequesterManagedNEQ applies the NEQ predicate on the "requester_managed" field.
+func RequesterManagedNEQ(v bool) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldRequesterManaged, v))
+}
+
+// RequesterManagedIsNil applies the IsNil predicate on the "requester_managed" field.
+func RequesterManagedIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldRequesterManaged))
+}
+
+// RequesterManagedNotNil applies the NotNil predicate on the "requester_managed" field.
+func RequesterManagedNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldRequesterManaged))
+}
+
+// RequesterIDEQ applies the EQ predicate on the "requester_id" field.
+func RequesterIDEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldRequesterID, v))
+}
+
+// RequesterIDNEQ applies the NEQ predicate on the "requester_id" field.
+func RequesterIDNEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldRequesterID, v))
+}
+
+// RequesterIDIn applies the In predicate on the "requester_id" field.
+func RequesterIDIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldRequesterID, vs...))
+}
+
+// RequesterIDNotIn applies the NotIn predicate on the "requester_id" field.
+func RequesterIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldRequesterID, vs...))
+}
+
+// RequesterIDGT applies the GT predicate on the "requester_id" field.
+func RequesterIDGT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldRequesterID, v))
+}
+
+// RequesterIDGTE applies the GTE predicate on the "requester_id" field.
+func RequesterIDGTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldRequesterID, v))
+}
+
+// RequesterIDLT applies the LT predicate on the "requester_id" field.
+func RequesterIDLT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldRequesterID, v))
+}
+
+// RequesterIDLTE applies the LTE predicate on the "requester_id" field.
+func RequesterIDLTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldRequesterID, v))
+}
+
+// RequesterIDContains applies the Contains predicate on the "requester_id" field.
+func RequesterIDContains(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContains(FieldRequesterID, v))
+}
+
+// RequesterIDHasPrefix applies the HasPrefix predicate on the "requester_id" field.
+func RequesterIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasPrefix(FieldRequesterID, v))
+}
+
+// RequesterIDHasSuffix applies the HasSuffix predicate on the "requester_id" field.
+func RequesterIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasSuffix(FieldRequesterID, v))
+}
+
+// RequesterIDIsNil applies the IsNil predicate on the "requester_id" field.
+func RequesterIDIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldRequesterID))
+}
+
+// RequesterIDNotNil applies the NotNil predicate on the "requester_id" field.
+func RequesterIDNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldRequesterID))
+}
+
+// RequesterIDEqualFold applies the EqualFold predicate on the "requester_id" field.
+func RequesterIDEqualFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEqualFold(FieldRequesterID, v))
+}
+
+// RequesterIDContainsFold applies the ContainsFold predicate on the "requester_id" field.
+func RequesterIDContainsFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContainsFold(FieldRequesterID, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContains(FieldOwnerID, v))
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasPrefix(FieldOwnerID, v))
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasSuffix(FieldOwnerID, v))
+}
+
+// OwnerIDIsNil applies the IsNil predicate on the "owner_id" field.
+func OwnerIDIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldOwnerID))
+}
+
+// OwnerIDNotNil applies the NotNil predicate on the "owner_id" field.
+func OwnerIDNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldOwnerID))
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEqualFold(FieldOwnerID, v))
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContainsFold(FieldOwnerID, v))
+}
+
+// AttachmentIDEQ applies the EQ predicate on the "attachment_id" field.
+func AttachmentIDEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldAttachmentID, v))
+}
+
+// AttachmentIDNEQ applies the NEQ predicate on the "attachment_id" field.
+func AttachmentIDNEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldAttachmentID, v))
+}
+
+// AttachmentIDIn applies the In predicate on the "attachment_id" field.
+func AttachmentIDIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldAttachmentID, vs...))
+}
+
+// AttachmentIDNotIn applies the NotIn predicate on the "attachment_id" field.
+func AttachmentIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldAttachmentID, vs...))
+}
+
+// AttachmentIDGT applies the GT predicate on the "attachment_id" field.
+func AttachmentIDGT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldAttachmentID, v))
+}
+
+// AttachmentIDGTE applies the GTE predicate on the "attachment_id" field.
+func AttachmentIDGTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldAttachmentID, v))
+}
+
+// AttachmentIDLT applies the LT predicate on the "attachment_id" field.
+func AttachmentIDLT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldAttachmentID, v))
+}
+
+// AttachmentIDLTE applies the LTE predicate on the "attachment_id" field.
+func AttachmentIDLTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldAttachmentID, v))
+}
+
+// AttachmentIDContains applies the Contains predicate on the "attachment_id" field.
+func AttachmentIDContains(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContains(FieldAttachmentID, v))
+}
+
+// AttachmentIDHasPrefix applies the HasPrefix predicate on the "attachment_id" field.
+func AttachmentIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasPrefix(FieldAttachmentID, v))
+}
+
+// AttachmentIDHasSuffix applies the HasSuffix predicate on the "attachment_id" field.
+func AttachmentIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasSuffix(FieldAttachmentID, v))
+}
+
+// AttachmentIDIsNil applies the IsNil predicate on the "attachment_id" field.
+func AttachmentIDIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldAttachmentID))
+}
+
+// AttachmentIDNotNil applies the NotNil predicate on the "attachment_id" field.
+func AttachmentIDNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldAttachmentID))
+}
+
+// AttachmentIDEqualFold applies the EqualFold predicate on the "attachment_id" field.
+func AttachmentIDEqualFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEqualFold(FieldAttachmentID, v))
+}
+
+// AttachmentIDContainsFold applies the ContainsFold predicate on the "attachment_id" field.
+func AttachmentIDContainsFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContainsFold(FieldAttachmentID, v))
+}
+
+// AttachmentInstanceIDEQ applies the EQ predicate on the "attachment_instance_id" field.
+func AttachmentInstanceIDEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldAttachmentInstanceID, v))
+}
+
+// AttachmentInstanceIDNEQ applies the NEQ predicate on the "attachment_instance_id" field.
+func AttachmentInstanceIDNEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldAttachmentInstanceID, v))
+}
+
+// AttachmentInstanceIDIn applies the In predicate on the "attachment_instance_id" field.
+func AttachmentInstanceIDIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldAttachmentInstanceID, vs...))
+}
+
+// AttachmentInstanceIDNotIn applies the NotIn predicate on the "attachment_instance_id" field.
+func AttachmentInstanceIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldAttachmentInstanceID, vs...))
+}
+
+// AttachmentInstanceIDGT applies the GT predicate on the "attachment_instance_id" field.
+func AttachmentInstanceIDGT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldAttachmentInstanceID, v))
+}
+
+// AttachmentInstanceIDGTE applies the GTE predicate on the "attachment_instance_id" field.
+func AttachmentInstanceIDGTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldAttachmentInstanceID, v))
+}
+
+// AttachmentInstanceIDLT applies the LT predicate on the "attachment_instance_id" field.
+func AttachmentInstanceIDLT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldAttachmentInstanceID, v))
+}
+
+// AttachmentInstanceIDLTE applies the LTE predicate on the "attachment_instance_id" field.
+func AttachmentInstanceIDLTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldAttachmentInstanceID, v))
+}
+
+// AttachmentInstanceIDContains applies the Contains predicate on the "attachment_instance_id" field.
+func AttachmentInstanceIDContains(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContains(FieldAttachmentInstanceID, v))
+}
+
+// AttachmentInstanceIDHasPrefix applies the HasPrefix predicate on the "attachment_instance_id" field.
+func AttachmentInstanceIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasPrefix(FieldAttachmentInstanceID, v))
+}
+
+// AttachmentInstanceIDHasSuffix applies the HasSuffix predicate on the "attachment_instance_id" field.
+func AttachmentInstanceIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasSuffix(FieldAttachmentInstanceID, v))
+}
+
+// AttachmentInstanceIDIsNil applies the IsNil predicate on the "attachment_instance_id" field.
+func AttachmentInstanceIDIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldAttachmentInstanceID))
+}
+
+// AttachmentInstanceIDNotNil applies the NotNil predicate on the "attachment_instance_id" field.
+func AttachmentInstanceIDNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldAttachmentInstanceID))
+}
+
+// AttachmentInstanceIDEqualFold applies the EqualFold predicate on the "attachment_instance_id" field.
+func AttachmentInstanceIDEqualFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEqualFold(FieldAttachmentInstanceID, v))
+}
+
+// AttachmentInstanceIDContainsFold applies the ContainsFold predicate on the "attachment_instance_id" field.
+func AttachmentInstanceIDContainsFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContainsFold(FieldAttachmentInstanceID, v))
+}
+
+// AttachmentStatusEQ applies the EQ predicate on the "attachment_status" field.
+func AttachmentStatusEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldAttachmentStatus, v))
+}
+
+// AttachmentStatusNEQ applies the NEQ predicate on the "attachment_status" field.
+func AttachmentStatusNEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldAttachmentStatus, v))
+}
+
+// AttachmentStatusIn applies the In predicate on the "attachment_status" field.
+func AttachmentStatusIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldAttachmentStatus, vs...))
+}
+
+// AttachmentStatusNotIn applies the NotIn predicate on the "attachment_status" field.
+func AttachmentStatusNotIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldAttachmentStatus, vs...))
+}
+
+// AttachmentStatusGT applies the GT predicate on the "attachment_status" field.
+func AttachmentStatusGT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldAttachmentStatus, v))
+}
+
+// AttachmentStatusGTE applies the GTE predicate on the "attachment_status" field.
+func AttachmentStatusGTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldAttachmentStatus, v))
+}
+
+// AttachmentStatusLT applies the LT predicate on the "attachment_status" field.
+func AttachmentStatusLT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldAttachmentStatus, v))
+}
+
+// AttachmentStatusLTE applies the LTE predicate on the "attachment_status" field.
+func AttachmentStatusLTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldAttachmentStatus, v))
+}
+
+// AttachmentStatusContains applies the Contains predicate on the "attachment_status" field.
+func AttachmentStatusContains(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContains(FieldAttachmentStatus, v))
+}
+
+// AttachmentStatusHasPrefix applies the HasPrefix predicate on the "attachment_status" field.
+func AttachmentStatusHasPrefix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasPrefix(FieldAttachmentStatus, v))
+}
+
+// AttachmentStatusHasSuffix applies the HasSuffix predicate on the "attachment_status" field.
+func AttachmentStatusHasSuffix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasSuffix(FieldAttachmentStatus, v))
+}
+
+// AttachmentStatusIsNil applies the IsNil predicate on the "attachment_status" field.
+func AttachmentStatusIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldAttachmentStatus))
+}
+
+// AttachmentStatusNotNil applies the NotNil predicate on the "attachment_status" field.
+func AttachmentStatusNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldAttachmentStatus))
+}
+
+// AttachmentStatusEqualFold applies the EqualFold predicate on the "attachment_status" field.
+func AttachmentStatusEqualFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEqualFold(FieldAttachmentStatus, v))
+}
+
+// AttachmentStatusContainsFold applies the ContainsFold predicate on the "attachment_status" field.
+func AttachmentStatusContainsFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContainsFold(FieldAttachmentStatus, v))
+}
+
+// GroupsJSONIsNil applies the IsNil predicate on the "groups_json" field.
+func GroupsJSONIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldGroupsJSON))
+}
+
+// GroupsJSONNotNil applies the NotNil predicate on the "groups_json" field.
+func GroupsJSONNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldGroupsJSON))
+}
+
+// PrivateIPAddressesJSONIsNil applies the IsNil predicate on the "private_ip_addresses_json" field.
+func PrivateIPAddressesJSONIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldPrivateIPAddressesJSON))
+}
+
+// PrivateIPAddressesJSONNotNil applies the NotNil predicate on the "private_ip_addresses_json" field.
+func PrivateIPAddressesJSONNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldPrivateIPAddressesJSON))
+}
+
+// Ipv6AddressesJSONIsNil applies the IsNil predicate on the "ipv6_addresses_json" field.
+func Ipv6AddressesJSONIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldIpv6AddressesJSON))
+}
+
+// Ipv6AddressesJSONNotNil applies the NotNil predicate on the "ipv6_addresses_json" field.
+func Ipv6AddressesJSONNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldIpv6AddressesJSON))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeHistoryAWSEC2NetworkInterface) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeHistoryAWSEC2NetworkInterface) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeHistoryAWSEC2NetworkInterface) predicate.BronzeHistoryAWSEC2NetworkInterface {
+	return predicate.BronzeHistoryAWSEC2NetworkInterface(sql.NotPredicates(p))
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2networkinterface"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2NetworkInterfaceDelete is the builder for deleting a BronzeHistoryAWSEC2NetworkInterface entity.
+type BronzeHistoryAWSEC2NetworkInterfaceDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2NetworkInterfaceMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2NetworkInterfaceDelete builder.
+func (_d *BronzeHistoryAWSEC2NetworkInterfaceDelete) Where(ps ...predicate.BronzeHistoryAWSEC2NetworkInterface) *BronzeHistoryAWSEC2NetworkInterfaceDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeHistoryAWSEC2NetworkInterfaceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryAWSEC2NetworkInterfaceDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeHistoryAWSEC2NetworkInterfaceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzehistoryawsec2networkinterface.Table, sqlgraph.NewFieldSpec(bronzehistoryawsec2networkinterface.FieldID, field.TypeUint))
+	_spec.Node.Schema = _d.schemaConfig.BronzeHistoryAWSEC2NetworkInterface
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeHistoryAWSEC2NetworkInterfaceDeleteOne is the builder for deleting a single BronzeHistoryAWSEC2NetworkInterface entity.
+type BronzeHistoryAWSEC2NetworkInterfaceDeleteOne struct {
+	_d *BronzeHistoryAWSEC2NetworkInterfaceDelete
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2NetworkInterfaceDelete builder.
+func (_d *BronzeHistoryAWSEC2NetworkInterfaceDeleteOne) Where(ps ...predicate.BronzeHistoryAWSEC2NetworkInterface) *BronzeHistoryAWSEC2NetworkInterfaceDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeHistoryAWSEC2NetworkInterfaceDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzehistoryawsec2networkinterface.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryAWSEC2NetworkInterfaceDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
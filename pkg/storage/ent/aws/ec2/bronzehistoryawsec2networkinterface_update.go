@@ -0,0 +1,1619 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2networkinterface"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2NetworkInterfaceUpdate is the builder for updating BronzeHistoryAWSEC2NetworkInterface entities.
+type BronzeHistoryAWSEC2NetworkInterfaceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2NetworkInterfaceMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2NetworkInterfaceUpdate builder.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) Where(ps ...predicate.BronzeHistoryAWSEC2NetworkInterface) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetValidTo(v time.Time) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearValidTo() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetResourceID(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetDescription(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableDescription(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearDescription() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetInterfaceType sets the "interface_type" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetInterfaceType(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetInterfaceType(v)
+	return _u
+}
+
+// SetNillableInterfaceType sets the "interface_type" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableInterfaceType(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetInterfaceType(*v)
+	}
+	return _u
+}
+
+// ClearInterfaceType clears the value of the "interface_type" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearInterfaceType() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearInterfaceType()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetStatus(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableStatus(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearStatus() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetVpcID(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableVpcID(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearVpcID() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetSubnetID sets the "subnet_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetSubnetID(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetSubnetID(v)
+	return _u
+}
+
+// SetNillableSubnetID sets the "subnet_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableSubnetID(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetSubnetID(*v)
+	}
+	return _u
+}
+
+// ClearSubnetID clears the value of the "subnet_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearSubnetID() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearSubnetID()
+	return _u
+}
+
+// SetAvailabilityZone sets the "availability_zone" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetAvailabilityZone(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetAvailabilityZone(v)
+	return _u
+}
+
+// SetNillableAvailabilityZone sets the "availability_zone" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableAvailabilityZone(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetAvailabilityZone(*v)
+	}
+	return _u
+}
+
+// ClearAvailabilityZone clears the value of the "availability_zone" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearAvailabilityZone() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearAvailabilityZone()
+	return _u
+}
+
+// SetMACAddress sets the "mac_address" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetMACAddress(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetMACAddress(v)
+	return _u
+}
+
+// SetNillableMACAddress sets the "mac_address" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableMACAddress(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetMACAddress(*v)
+	}
+	return _u
+}
+
+// ClearMACAddress clears the value of the "mac_address" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearMACAddress() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearMACAddress()
+	return _u
+}
+
+// SetPrivateIPAddress sets the "private_ip_address" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetPrivateIPAddress(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetPrivateIPAddress(v)
+	return _u
+}
+
+// SetNillablePrivateIPAddress sets the "private_ip_address" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillablePrivateIPAddress(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetPrivateIPAddress(*v)
+	}
+	return _u
+}
+
+// ClearPrivateIPAddress clears the value of the "private_ip_address" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearPrivateIPAddress() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearPrivateIPAddress()
+	return _u
+}
+
+// SetPrivateDNSName sets the "private_dns_name" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetPrivateDNSName(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetPrivateDNSName(v)
+	return _u
+}
+
+// SetNillablePrivateDNSName sets the "private_dns_name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillablePrivateDNSName(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetPrivateDNSName(*v)
+	}
+	return _u
+}
+
+// ClearPrivateDNSName clears the value of the "private_dns_name" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearPrivateDNSName() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearPrivateDNSName()
+	return _u
+}
+
+// SetPublicIP sets the "public_ip" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetPublicIP(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetPublicIP(v)
+	return _u
+}
+
+// SetNillablePublicIP sets the "public_ip" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillablePublicIP(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetPublicIP(*v)
+	}
+	return _u
+}
+
+// ClearPublicIP clears the value of the "public_ip" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearPublicIP() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearPublicIP()
+	return _u
+}
+
+// SetPublicDNSName sets the "public_dns_name" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetPublicDNSName(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetPublicDNSName(v)
+	return _u
+}
+
+// SetNillablePublicDNSName sets the "public_dns_name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillablePublicDNSName(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetPublicDNSName(*v)
+	}
+	return _u
+}
+
+// ClearPublicDNSName clears the value of the "public_dns_name" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearPublicDNSName() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearPublicDNSName()
+	return _u
+}
+
+// SetSourceDestCheck sets the "source_dest_check" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetSourceDestCheck(v bool) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetSourceDestCheck(v)
+	return _u
+}
+
+// SetNillableSourceDestCheck sets the "source_dest_check" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableSourceDestCheck(v *bool) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetSourceDestCheck(*v)
+	}
+	return _u
+}
+
+// ClearSourceDestCheck clears the value of the "source_dest_check" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearSourceDestCheck() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearSourceDestCheck()
+	return _u
+}
+
+// SetRequesterManaged sets the "requester_managed" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetRequesterManaged(v bool) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetRequesterManaged(v)
+	return _u
+}
+
+// SetNillableRequesterManaged sets the "requester_managed" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableRequesterManaged(v *bool) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetRequesterManaged(*v)
+	}
+	return _u
+}
+
+// ClearRequesterManaged clears the value of the "requester_managed" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearRequesterManaged() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearRequesterManaged()
+	return _u
+}
+
+// SetRequesterID sets the "requester_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetRequesterID(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetRequesterID(v)
+	return _u
+}
+
+// SetNillableRequesterID sets the "requester_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableRequesterID(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetRequesterID(*v)
+	}
+	return _u
+}
+
+// ClearRequesterID clears the value of the "requester_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearRequesterID() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearRequesterID()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetOwnerID(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableOwnerID(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearOwnerID() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetAttachmentID sets the "attachment_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetAttachmentID(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetAttachmentID(v)
+	return _u
+}
+
+// SetNillableAttachmentID sets the "attachment_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableAttachmentID(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetAttachmentID(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentID clears the value of the "attachment_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearAttachmentID() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearAttachmentID()
+	return _u
+}
+
+// SetAttachmentInstanceID sets the "attachment_instance_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetAttachmentInstanceID(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetAttachmentInstanceID(v)
+	return _u
+}
+
+// SetNillableAttachmentInstanceID sets the "attachment_instance_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableAttachmentInstanceID(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetAttachmentInstanceID(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentInstanceID clears the value of the "attachment_instance_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearAttachmentInstanceID() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearAttachmentInstanceID()
+	return _u
+}
+
+// SetAttachmentStatus sets the "attachment_status" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetAttachmentStatus(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetAttachmentStatus(v)
+	return _u
+}
+
+// SetNillableAttachmentStatus sets the "attachment_status" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableAttachmentStatus(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetAttachmentStatus(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentStatus clears the value of the "attachment_status" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearAttachmentStatus() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearAttachmentStatus()
+	return _u
+}
+
+// SetGroupsJSON sets the "groups_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetGroupsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetGroupsJSON(v)
+	return _u
+}
+
+// AppendGroupsJSON appends value to the "groups_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) AppendGroupsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.AppendGroupsJSON(v)
+	return _u
+}
+
+// ClearGroupsJSON clears the value of the "groups_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearGroupsJSON() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearGroupsJSON()
+	return _u
+}
+
+// SetPrivateIPAddressesJSON sets the "private_ip_addresses_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetPrivateIPAddressesJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetPrivateIPAddressesJSON(v)
+	return _u
+}
+
+// AppendPrivateIPAddressesJSON appends value to the "private_ip_addresses_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) AppendPrivateIPAddressesJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.AppendPrivateIPAddressesJSON(v)
+	return _u
+}
+
+// ClearPrivateIPAddressesJSON clears the value of the "private_ip_addresses_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearPrivateIPAddressesJSON() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearPrivateIPAddressesJSON()
+	return _u
+}
+
+// SetIpv6AddressesJSON sets the "ipv6_addresses_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetIpv6AddressesJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetIpv6AddressesJSON(v)
+	return _u
+}
+
+// AppendIpv6AddressesJSON appends value to the "ipv6_addresses_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) AppendIpv6AddressesJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.AppendIpv6AddressesJSON(v)
+	return _u
+}
+
+// ClearIpv6AddressesJSON clears the value of the "ipv6_addresses_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearIpv6AddressesJSON() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearIpv6AddressesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ClearTagsJSON() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetAccountID(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetRegion(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SetNillableRegion(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2NetworkInterfaceMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) Mutation() *BronzeHistoryAWSEC2NetworkInterfaceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2networkinterface.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NetworkInterface.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2networkinterface.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NetworkInterface.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2networkinterface.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NetworkInterface.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2networkinterface.Table, bronzehistoryawsec2networkinterface.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2networkinterface.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.InterfaceType(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldInterfaceType, field.TypeString, value)
+	}
+	if _u.mutation.InterfaceTypeCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldInterfaceType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SubnetID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldSubnetID, field.TypeString, value)
+	}
+	if _u.mutation.SubnetIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldSubnetID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AvailabilityZone(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldAvailabilityZone, field.TypeString, value)
+	}
+	if _u.mutation.AvailabilityZoneCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldAvailabilityZone, field.TypeString)
+	}
+	if value, ok := _u.mutation.MACAddress(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldMACAddress, field.TypeString, value)
+	}
+	if _u.mutation.MACAddressCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldMACAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrivateIPAddress(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldPrivateIPAddress, field.TypeString, value)
+	}
+	if _u.mutation.PrivateIPAddressCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldPrivateIPAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrivateDNSName(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldPrivateDNSName, field.TypeString, value)
+	}
+	if _u.mutation.PrivateDNSNameCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldPrivateDNSName, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicIP(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldPublicIP, field.TypeString, value)
+	}
+	if _u.mutation.PublicIPCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldPublicIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicDNSName(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldPublicDNSName, field.TypeString, value)
+	}
+	if _u.mutation.PublicDNSNameCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldPublicDNSName, field.TypeString)
+	}
+	if value, ok := _u.mutation.SourceDestCheck(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldSourceDestCheck, field.TypeBool, value)
+	}
+	if _u.mutation.SourceDestCheckCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldSourceDestCheck, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RequesterManaged(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldRequesterManaged, field.TypeBool, value)
+	}
+	if _u.mutation.RequesterManagedCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldRequesterManaged, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RequesterID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldRequesterID, field.TypeString, value)
+	}
+	if _u.mutation.RequesterIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldRequesterID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldAttachmentID, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldAttachmentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentInstanceID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldAttachmentInstanceID, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentInstanceIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldAttachmentInstanceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentStatus(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldAttachmentStatus, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentStatusCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldAttachmentStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldGroupsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2networkinterface.FieldGroupsJSON, value)
+		})
+	}
+	if _u.mutation.GroupsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldGroupsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PrivateIPAddressesJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldPrivateIPAddressesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPrivateIPAddressesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2networkinterface.FieldPrivateIPAddressesJSON, value)
+		})
+	}
+	if _u.mutation.PrivateIPAddressesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldPrivateIPAddressesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Ipv6AddressesJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldIpv6AddressesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIpv6AddressesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2networkinterface.FieldIpv6AddressesJSON, value)
+		})
+	}
+	if _u.mutation.Ipv6AddressesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldIpv6AddressesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2networkinterface.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2NetworkInterface
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2networkinterface.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryAWSEC2NetworkInterfaceUpdateOne is the builder for updating a single BronzeHistoryAWSEC2NetworkInterface entity.
+type BronzeHistoryAWSEC2NetworkInterfaceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2NetworkInterfaceMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetValidTo(v time.Time) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearValidTo() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetResourceID(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetDescription(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableDescription(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearDescription() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetInterfaceType sets the "interface_type" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetInterfaceType(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetInterfaceType(v)
+	return _u
+}
+
+// SetNillableInterfaceType sets the "interface_type" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableInterfaceType(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetInterfaceType(*v)
+	}
+	return _u
+}
+
+// ClearInterfaceType clears the value of the "interface_type" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearInterfaceType() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearInterfaceType()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetStatus(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableStatus(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearStatus() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetVpcID(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableVpcID(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearVpcID() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetSubnetID sets the "subnet_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetSubnetID(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetSubnetID(v)
+	return _u
+}
+
+// SetNillableSubnetID sets the "subnet_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableSubnetID(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetSubnetID(*v)
+	}
+	return _u
+}
+
+// ClearSubnetID clears the value of the "subnet_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearSubnetID() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearSubnetID()
+	return _u
+}
+
+// SetAvailabilityZone sets the "availability_zone" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetAvailabilityZone(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetAvailabilityZone(v)
+	return _u
+}
+
+// SetNillableAvailabilityZone sets the "availability_zone" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableAvailabilityZone(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetAvailabilityZone(*v)
+	}
+	return _u
+}
+
+// ClearAvailabilityZone clears the value of the "availability_zone" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearAvailabilityZone() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearAvailabilityZone()
+	return _u
+}
+
+// SetMACAddress sets the "mac_address" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetMACAddress(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetMACAddress(v)
+	return _u
+}
+
+// SetNillableMACAddress sets the "mac_address" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableMACAddress(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetMACAddress(*v)
+	}
+	return _u
+}
+
+// ClearMACAddress clears the value of the "mac_address" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearMACAddress() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearMACAddress()
+	return _u
+}
+
+// SetPrivateIPAddress sets the "private_ip_address" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetPrivateIPAddress(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetPrivateIPAddress(v)
+	return _u
+}
+
+// SetNillablePrivateIPAddress sets the "private_ip_address" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillablePrivateIPAddress(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetPrivateIPAddress(*v)
+	}
+	return _u
+}
+
+// ClearPrivateIPAddress clears the value of the "private_ip_address" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearPrivateIPAddress() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearPrivateIPAddress()
+	return _u
+}
+
+// SetPrivateDNSName sets the "private_dns_name" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetPrivateDNSName(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetPrivateDNSName(v)
+	return _u
+}
+
+// SetNillablePrivateDNSName sets the "private_dns_name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillablePrivateDNSName(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetPrivateDNSName(*v)
+	}
+	return _u
+}
+
+// ClearPrivateDNSName clears the value of the "private_dns_name" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearPrivateDNSName() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearPrivateDNSName()
+	return _u
+}
+
+// SetPublicIP sets the "public_ip" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetPublicIP(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetPublicIP(v)
+	return _u
+}
+
+// SetNillablePublicIP sets the "public_ip" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillablePublicIP(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetPublicIP(*v)
+	}
+	return _u
+}
+
+// ClearPublicIP clears the value of the "public_ip" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearPublicIP() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearPublicIP()
+	return _u
+}
+
+// SetPublicDNSName sets the "public_dns_name" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetPublicDNSName(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetPublicDNSName(v)
+	return _u
+}
+
+// SetNillablePublicDNSName sets the "public_dns_name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillablePublicDNSName(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetPublicDNSName(*v)
+	}
+	return _u
+}
+
+// ClearPublicDNSName clears the value of the "public_dns_name" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearPublicDNSName() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearPublicDNSName()
+	return _u
+}
+
+// SetSourceDestCheck sets the "source_dest_check" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetSourceDestCheck(v bool) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetSourceDestCheck(v)
+	return _u
+}
+
+// SetNillableSourceDestCheck sets the "source_dest_check" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableSourceDestCheck(v *bool) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetSourceDestCheck(*v)
+	}
+	return _u
+}
+
+// ClearSourceDestCheck clears the value of the "source_dest_check" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearSourceDestCheck() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearSourceDestCheck()
+	return _u
+}
+
+// SetRequesterManaged sets the "requester_managed" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetRequesterManaged(v bool) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetRequesterManaged(v)
+	return _u
+}
+
+// SetNillableRequesterManaged sets the "requester_managed" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableRequesterManaged(v *bool) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetRequesterManaged(*v)
+	}
+	return _u
+}
+
+// ClearRequesterManaged clears the value of the "requester_managed" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearRequesterManaged() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearRequesterManaged()
+	return _u
+}
+
+// SetRequesterID sets the "requester_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetRequesterID(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetRequesterID(v)
+	return _u
+}
+
+// SetNillableRequesterID sets the "requester_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableRequesterID(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetRequesterID(*v)
+	}
+	return _u
+}
+
+// ClearRequesterID clears the value of the "requester_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearRequesterID() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearRequesterID()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetOwnerID(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableOwnerID(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearOwnerID() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetAttachmentID sets the "attachment_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetAttachmentID(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetAttachmentID(v)
+	return _u
+}
+
+// SetNillableAttachmentID sets the "attachment_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableAttachmentID(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetAttachmentID(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentID clears the value of the "attachment_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearAttachmentID() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearAttachmentID()
+	return _u
+}
+
+// SetAttachmentInstanceID sets the "attachment_instance_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetAttachmentInstanceID(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetAttachmentInstanceID(v)
+	return _u
+}
+
+// SetNillableAttachmentInstanceID sets the "attachment_instance_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableAttachmentInstanceID(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetAttachmentInstanceID(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentInstanceID clears the value of the "attachment_instance_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearAttachmentInstanceID() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearAttachmentInstanceID()
+	return _u
+}
+
+// SetAttachmentStatus sets the "attachment_status" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetAttachmentStatus(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetAttachmentStatus(v)
+	return _u
+}
+
+// SetNillableAttachmentStatus sets the "attachment_status" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableAttachmentStatus(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetAttachmentStatus(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentStatus clears the value of the "attachment_status" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearAttachmentStatus() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearAttachmentStatus()
+	return _u
+}
+
+// SetGroupsJSON sets the "groups_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetGroupsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetGroupsJSON(v)
+	return _u
+}
+
+// AppendGroupsJSON appends value to the "groups_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) AppendGroupsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.AppendGroupsJSON(v)
+	return _u
+}
+
+// ClearGroupsJSON clears the value of the "groups_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearGroupsJSON() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearGroupsJSON()
+	return _u
+}
+
+// SetPrivateIPAddressesJSON sets the "private_ip_addresses_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetPrivateIPAddressesJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetPrivateIPAddressesJSON(v)
+	return _u
+}
+
+// AppendPrivateIPAddressesJSON appends value to the "private_ip_addresses_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) AppendPrivateIPAddressesJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.AppendPrivateIPAddressesJSON(v)
+	return _u
+}
+
+// ClearPrivateIPAddressesJSON clears the value of the "private_ip_addresses_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearPrivateIPAddressesJSON() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearPrivateIPAddressesJSON()
+	return _u
+}
+
+// SetIpv6AddressesJSON sets the "ipv6_addresses_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetIpv6AddressesJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetIpv6AddressesJSON(v)
+	return _u
+}
+
+// AppendIpv6AddressesJSON appends value to the "ipv6_addresses_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) AppendIpv6AddressesJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.AppendIpv6AddressesJSON(v)
+	return _u
+}
+
+// ClearIpv6AddressesJSON clears the value of the "ipv6_addresses_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearIpv6AddressesJSON() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearIpv6AddressesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ClearTagsJSON() *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetAccountID(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetRegion(v string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SetNillableRegion(v *string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2NetworkInterfaceMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) Mutation() *BronzeHistoryAWSEC2NetworkInterfaceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2NetworkInterfaceUpdate builder.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) Where(ps ...predicate.BronzeHistoryAWSEC2NetworkInterface) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) Select(field string, fields ...string) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryAWSEC2NetworkInterface entity.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) Save(ctx context.Context) (*BronzeHistoryAWSEC2NetworkInterface, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) SaveX(ctx context.Context) *BronzeHistoryAWSEC2NetworkInterface {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2networkinterface.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NetworkInterface.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2networkinterface.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NetworkInterface.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2networkinterface.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2NetworkInterface.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryAWSEC2NetworkInterface, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2networkinterface.Table, bronzehistoryawsec2networkinterface.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2networkinterface.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeHistoryAWSEC2NetworkInterface.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawsec2networkinterface.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryawsec2networkinterface.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryawsec2networkinterface.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.InterfaceType(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldInterfaceType, field.TypeString, value)
+	}
+	if _u.mutation.InterfaceTypeCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldInterfaceType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SubnetID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldSubnetID, field.TypeString, value)
+	}
+	if _u.mutation.SubnetIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldSubnetID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AvailabilityZone(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldAvailabilityZone, field.TypeString, value)
+	}
+	if _u.mutation.AvailabilityZoneCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldAvailabilityZone, field.TypeString)
+	}
+	if value, ok := _u.mutation.MACAddress(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldMACAddress, field.TypeString, value)
+	}
+	if _u.mutation.MACAddressCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldMACAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrivateIPAddress(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldPrivateIPAddress, field.TypeString, value)
+	}
+	if _u.mutation.PrivateIPAddressCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldPrivateIPAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrivateDNSName(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldPrivateDNSName, field.TypeString, value)
+	}
+	if _u.mutation.PrivateDNSNameCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldPrivateDNSName, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicIP(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldPublicIP, field.TypeString, value)
+	}
+	if _u.mutation.PublicIPCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldPublicIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicDNSName(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldPublicDNSName, field.TypeString, value)
+	}
+	if _u.mutation.PublicDNSNameCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldPublicDNSName, field.TypeString)
+	}
+	if value, ok := _u.mutation.SourceDestCheck(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldSourceDestCheck, field.TypeBool, value)
+	}
+	if _u.mutation.SourceDestCheckCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldSourceDestCheck, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RequesterManaged(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldRequesterManaged, field.TypeBool, value)
+	}
+	if _u.mutation.RequesterManagedCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldRequesterManaged, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RequesterID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldRequesterID, field.TypeString, value)
+	}
+	if _u.mutation.RequesterIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldRequesterID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldAttachmentID, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldAttachmentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentInstanceID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldAttachmentInstanceID, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentInstanceIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldAttachmentInstanceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentStatus(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldAttachmentStatus, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentStatusCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldAttachmentStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldGroupsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2networkinterface.FieldGroupsJSON, value)
+		})
+	}
+	if _u.mutation.GroupsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldGroupsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PrivateIPAddressesJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldPrivateIPAddressesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPrivateIPAddressesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2networkinterface.FieldPrivateIPAddressesJSON, value)
+		})
+	}
+	if _u.mutation.PrivateIPAddressesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldPrivateIPAddressesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Ipv6AddressesJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldIpv6AddressesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIpv6AddressesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2networkinterface.FieldIpv6AddressesJSON, value)
+		})
+	}
+	if _u.mutation.Ipv6AddressesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldIpv6AddressesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2networkinterface.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2networkinterface.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2networkinterface.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2NetworkInterface
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryAWSEC2NetworkInterface{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2networkinterface.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2routetable"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2RouteTableCreate is the builder for creating a BronzeHistoryAWSEC2RouteTable entity.
+type BronzeHistoryAWSEC2RouteTableCreate struct {
+	config
+	mutation *BronzeHistoryAWSEC2RouteTableMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SetValidFrom(v time.Time) *BronzeHistoryAWSEC2RouteTableCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SetValidTo(v time.Time) *BronzeHistoryAWSEC2RouteTableCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2RouteTableCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2RouteTableCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2RouteTableCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SetResourceID(v string) *BronzeHistoryAWSEC2RouteTableCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SetName(v string) *BronzeHistoryAWSEC2RouteTableCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SetNillableName(v *string) *BronzeHistoryAWSEC2RouteTableCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SetVpcID(v string) *BronzeHistoryAWSEC2RouteTableCreate {
+	_c.mutation.SetVpcID(v)
+	return _c
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SetNillableVpcID(v *string) *BronzeHistoryAWSEC2RouteTableCreate {
+	if v != nil {
+		_c.SetVpcID(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SetOwnerID(v string) *BronzeHistoryAWSEC2RouteTableCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SetNillableOwnerID(v *string) *BronzeHistoryAWSEC2RouteTableCreate {
+	if v != nil {
+		_c.SetOwnerID(*v)
+	}
+	return _c
+}
+
+// SetRoutesJSON sets the "routes_json" field.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SetRoutesJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableCreate {
+	_c.mutation.SetRoutesJSON(v)
+	return _c
+}
+
+// SetAssociationsJSON sets the "associations_json" field.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SetAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableCreate {
+	_c.mutation.SetAssociationsJSON(v)
+	return _c
+}
+
+// SetPropagatingVgwsJSON sets the "propagating_vgws_json" field.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SetPropagatingVgwsJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableCreate {
+	_c.mutation.SetPropagatingVgwsJSON(v)
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SetAccountID(v string) *BronzeHistoryAWSEC2RouteTableCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SetRegion(v string) *BronzeHistoryAWSEC2RouteTableCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SetID(v uint) *BronzeHistoryAWSEC2RouteTableCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryAWSEC2RouteTableMutation object of the builder.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) Mutation() *BronzeHistoryAWSEC2RouteTableMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryAWSEC2RouteTable in the database.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) Save(ctx context.Context) (*BronzeHistoryAWSEC2RouteTable, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) SaveX(ctx context.Context) *BronzeHistoryAWSEC2RouteTable {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2RouteTable.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2RouteTable.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2RouteTable.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2RouteTable.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2routetable.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2RouteTable.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2RouteTable.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2routetable.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2RouteTable.account_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2RouteTable.region"`)}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := bronzehistoryawsec2routetable.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2RouteTable.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) sqlSave(ctx context.Context) (*BronzeHistoryAWSEC2RouteTable, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryAWSEC2RouteTableCreate) createSpec() (*BronzeHistoryAWSEC2RouteTable, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryAWSEC2RouteTable{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryawsec2routetable.Table, sqlgraph.NewFieldSpec(bronzehistoryawsec2routetable.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryAWSEC2RouteTable
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.VpcID(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldVpcID, field.TypeString, value)
+		_node.VpcID = value
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = value
+	}
+	if value, ok := _c.mutation.RoutesJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldRoutesJSON, field.TypeJSON, value)
+		_node.RoutesJSON = value
+	}
+	if value, ok := _c.mutation.AssociationsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldAssociationsJSON, field.TypeJSON, value)
+		_node.AssociationsJSON = value
+	}
+	if value, ok := _c.mutation.PropagatingVgwsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldPropagatingVgwsJSON, field.TypeJSON, value)
+		_node.PropagatingVgwsJSON = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryAWSEC2RouteTableCreateBulk is the builder for creating many BronzeHistoryAWSEC2RouteTable entities in bulk.
+type BronzeHistoryAWSEC2RouteTableCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryAWSEC2RouteTableCreate
+}
+
+// Save creates the BronzeHistoryAWSEC2RouteTable entities in the database.
+func (_c *BronzeHistoryAWSEC2RouteTableCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryAWSEC2RouteTable, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryAWSEC2RouteTable, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryAWSEC2RouteTableMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryAWSEC2RouteTableCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryAWSEC2RouteTable {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSEC2RouteTableCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSEC2RouteTableCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,839 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2routetable"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2RouteTableUpdate is the builder for updating BronzeHistoryAWSEC2RouteTable entities.
+type BronzeHistoryAWSEC2RouteTableUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2RouteTableMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2RouteTableUpdate builder.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) Where(ps ...predicate.BronzeHistoryAWSEC2RouteTable) *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetValidTo(v time.Time) *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2RouteTableUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) ClearValidTo() *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2RouteTableUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2RouteTableUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetResourceID(v string) *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2RouteTableUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetName(v string) *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetNillableName(v *string) *BronzeHistoryAWSEC2RouteTableUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) ClearName() *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetVpcID(v string) *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetNillableVpcID(v *string) *BronzeHistoryAWSEC2RouteTableUpdate {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) ClearVpcID() *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetOwnerID(v string) *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetNillableOwnerID(v *string) *BronzeHistoryAWSEC2RouteTableUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) ClearOwnerID() *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetRoutesJSON sets the "routes_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetRoutesJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.SetRoutesJSON(v)
+	return _u
+}
+
+// AppendRoutesJSON appends value to the "routes_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) AppendRoutesJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.AppendRoutesJSON(v)
+	return _u
+}
+
+// ClearRoutesJSON clears the value of the "routes_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) ClearRoutesJSON() *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.ClearRoutesJSON()
+	return _u
+}
+
+// SetAssociationsJSON sets the "associations_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.SetAssociationsJSON(v)
+	return _u
+}
+
+// AppendAssociationsJSON appends value to the "associations_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) AppendAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.AppendAssociationsJSON(v)
+	return _u
+}
+
+// ClearAssociationsJSON clears the value of the "associations_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) ClearAssociationsJSON() *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.ClearAssociationsJSON()
+	return _u
+}
+
+// SetPropagatingVgwsJSON sets the "propagating_vgws_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetPropagatingVgwsJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.SetPropagatingVgwsJSON(v)
+	return _u
+}
+
+// AppendPropagatingVgwsJSON appends value to the "propagating_vgws_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) AppendPropagatingVgwsJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.AppendPropagatingVgwsJSON(v)
+	return _u
+}
+
+// ClearPropagatingVgwsJSON clears the value of the "propagating_vgws_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) ClearPropagatingVgwsJSON() *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.ClearPropagatingVgwsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) ClearTagsJSON() *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetAccountID(v string) *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2RouteTableUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetRegion(v string) *BronzeHistoryAWSEC2RouteTableUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SetNillableRegion(v *string) *BronzeHistoryAWSEC2RouteTableUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2RouteTableMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) Mutation() *BronzeHistoryAWSEC2RouteTableMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2routetable.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2RouteTable.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2routetable.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2RouteTable.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2routetable.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2RouteTable.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2RouteTableUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2routetable.Table, bronzehistoryawsec2routetable.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2routetable.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2routetable.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzehistoryawsec2routetable.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2routetable.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2routetable.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RoutesJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldRoutesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoutesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2routetable.FieldRoutesJSON, value)
+		})
+	}
+	if _u.mutation.RoutesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2routetable.FieldRoutesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AssociationsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2routetable.FieldAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.AssociationsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2routetable.FieldAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PropagatingVgwsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldPropagatingVgwsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPropagatingVgwsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2routetable.FieldPropagatingVgwsJSON, value)
+		})
+	}
+	if _u.mutation.PropagatingVgwsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2routetable.FieldPropagatingVgwsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2routetable.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2routetable.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2RouteTable
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2routetable.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryAWSEC2RouteTableUpdateOne is the builder for updating a single BronzeHistoryAWSEC2RouteTable entity.
+type BronzeHistoryAWSEC2RouteTableUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2RouteTableMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetValidTo(v time.Time) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) ClearValidTo() *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetResourceID(v string) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetName(v string) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetNillableName(v *string) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) ClearName() *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetVpcID(v string) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetNillableVpcID(v *string) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) ClearVpcID() *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetOwnerID(v string) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetNillableOwnerID(v *string) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) ClearOwnerID() *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetRoutesJSON sets the "routes_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetRoutesJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetRoutesJSON(v)
+	return _u
+}
+
+// AppendRoutesJSON appends value to the "routes_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) AppendRoutesJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.AppendRoutesJSON(v)
+	return _u
+}
+
+// ClearRoutesJSON clears the value of the "routes_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) ClearRoutesJSON() *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.ClearRoutesJSON()
+	return _u
+}
+
+// SetAssociationsJSON sets the "associations_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetAssociationsJSON(v)
+	return _u
+}
+
+// AppendAssociationsJSON appends value to the "associations_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) AppendAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.AppendAssociationsJSON(v)
+	return _u
+}
+
+// ClearAssociationsJSON clears the value of the "associations_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) ClearAssociationsJSON() *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.ClearAssociationsJSON()
+	return _u
+}
+
+// SetPropagatingVgwsJSON sets the "propagating_vgws_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetPropagatingVgwsJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetPropagatingVgwsJSON(v)
+	return _u
+}
+
+// AppendPropagatingVgwsJSON appends value to the "propagating_vgws_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) AppendPropagatingVgwsJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.AppendPropagatingVgwsJSON(v)
+	return _u
+}
+
+// ClearPropagatingVgwsJSON clears the value of the "propagating_vgws_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) ClearPropagatingVgwsJSON() *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.ClearPropagatingVgwsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) ClearTagsJSON() *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetAccountID(v string) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetRegion(v string) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SetNillableRegion(v *string) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2RouteTableMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) Mutation() *BronzeHistoryAWSEC2RouteTableMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2RouteTableUpdate builder.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) Where(ps ...predicate.BronzeHistoryAWSEC2RouteTable) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) Select(field string, fields ...string) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryAWSEC2RouteTable entity.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) Save(ctx context.Context) (*BronzeHistoryAWSEC2RouteTable, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) SaveX(ctx context.Context) *BronzeHistoryAWSEC2RouteTable {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2routetable.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2RouteTable.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2routetable.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2RouteTable.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2routetable.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2RouteTable.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2RouteTableUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryAWSEC2RouteTable, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2routetable.Table, bronzehistoryawsec2routetable.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2routetable.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeHistoryAWSEC2RouteTable.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawsec2routetable.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryawsec2routetable.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryawsec2routetable.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2routetable.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzehistoryawsec2routetable.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2routetable.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2routetable.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RoutesJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldRoutesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoutesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2routetable.FieldRoutesJSON, value)
+		})
+	}
+	if _u.mutation.RoutesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2routetable.FieldRoutesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AssociationsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2routetable.FieldAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.AssociationsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2routetable.FieldAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PropagatingVgwsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldPropagatingVgwsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPropagatingVgwsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2routetable.FieldPropagatingVgwsJSON, value)
+		})
+	}
+	if _u.mutation.PropagatingVgwsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2routetable.FieldPropagatingVgwsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2routetable.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2routetable.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2routetable.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2RouteTable
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryAWSEC2RouteTable{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2routetable.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,396 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2securitygroup"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2SecurityGroupCreate is the builder for creating a BronzeHistoryAWSEC2SecurityGroup entity.
+type BronzeHistoryAWSEC2SecurityGroupCreate struct {
+	config
+	mutation *BronzeHistoryAWSEC2SecurityGroupMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetValidFrom(v time.Time) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetValidTo(v time.Time) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetResourceID(v string) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetGroupName sets the "group_name" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetGroupName(v string) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	_c.mutation.SetGroupName(v)
+	return _c
+}
+
+// SetNillableGroupName sets the "group_name" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetNillableGroupName(v *string) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	if v != nil {
+		_c.SetGroupName(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetDescription(v string) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetNillableDescription(v *string) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetVpcID(v string) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	_c.mutation.SetVpcID(v)
+	return _c
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetNillableVpcID(v *string) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	if v != nil {
+		_c.SetVpcID(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetOwnerID(v string) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetNillableOwnerID(v *string) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	if v != nil {
+		_c.SetOwnerID(*v)
+	}
+	return _c
+}
+
+// SetSecurityGroupArn sets the "security_group_arn" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetSecurityGroupArn(v string) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	_c.mutation.SetSecurityGroupArn(v)
+	return _c
+}
+
+// SetNillableSecurityGroupArn sets the "security_group_arn" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetNillableSecurityGroupArn(v *string) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	if v != nil {
+		_c.SetSecurityGroupArn(*v)
+	}
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetAccountID(v string) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetRegion(v string) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SetID(v uint) *BronzeHistoryAWSEC2SecurityGroupCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryAWSEC2SecurityGroupMutation object of the builder.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) Mutation() *BronzeHistoryAWSEC2SecurityGroupMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryAWSEC2SecurityGroup in the database.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) Save(ctx context.Context) (*BronzeHistoryAWSEC2SecurityGroup, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) SaveX(ctx context.Context) *BronzeHistoryAWSEC2SecurityGroup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2SecurityGroup.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2SecurityGroup.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2SecurityGroup.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2SecurityGroup.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2securitygroup.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2SecurityGroup.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2SecurityGroup.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2securitygroup.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2SecurityGroup.account_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2SecurityGroup.region"`)}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := bronzehistoryawsec2securitygroup.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2SecurityGroup.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) sqlSave(ctx context.Context) (*BronzeHistoryAWSEC2SecurityGroup, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreate) createSpec() (*BronzeHistoryAWSEC2SecurityGroup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryAWSEC2SecurityGroup{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryawsec2securitygroup.Table, sqlgraph.NewFieldSpec(bronzehistoryawsec2securitygroup.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryAWSEC2SecurityGroup
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.GroupName(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldGroupName, field.TypeString, value)
+		_node.GroupName = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.VpcID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldVpcID, field.TypeString, value)
+		_node.VpcID = value
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = value
+	}
+	if value, ok := _c.mutation.SecurityGroupArn(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldSecurityGroupArn, field.TypeString, value)
+		_node.SecurityGroupArn = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryAWSEC2SecurityGroupCreateBulk is the builder for creating many BronzeHistoryAWSEC2SecurityGroup entities in bulk.
+type BronzeHistoryAWSEC2SecurityGroupCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryAWSEC2SecurityGroupCreate
+}
+
+// Save creates the BronzeHistoryAWSEC2SecurityGroup entities in the database.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryAWSEC2SecurityGroup, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryAWSEC2SecurityGroup, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryAWSEC2SecurityGroupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryAWSEC2SecurityGroup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSEC2SecurityGroupCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
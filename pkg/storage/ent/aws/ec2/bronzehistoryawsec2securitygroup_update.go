@@ -0,0 +1,769 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2securitygroup"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2SecurityGroupUpdate is the builder for updating BronzeHistoryAWSEC2SecurityGroup entities.
+type BronzeHistoryAWSEC2SecurityGroupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2SecurityGroupMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2SecurityGroupUpdate builder.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) Where(ps ...predicate.BronzeHistoryAWSEC2SecurityGroup) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetValidTo(v time.Time) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) ClearValidTo() *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetResourceID(v string) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetGroupName sets the "group_name" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetGroupName(v string) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetGroupName(v)
+	return _u
+}
+
+// SetNillableGroupName sets the "group_name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetNillableGroupName(v *string) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	if v != nil {
+		_u.SetGroupName(*v)
+	}
+	return _u
+}
+
+// ClearGroupName clears the value of the "group_name" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) ClearGroupName() *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.ClearGroupName()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetDescription(v string) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetNillableDescription(v *string) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) ClearDescription() *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetVpcID(v string) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetNillableVpcID(v *string) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) ClearVpcID() *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetOwnerID(v string) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetNillableOwnerID(v *string) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) ClearOwnerID() *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetSecurityGroupArn sets the "security_group_arn" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetSecurityGroupArn(v string) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetSecurityGroupArn(v)
+	return _u
+}
+
+// SetNillableSecurityGroupArn sets the "security_group_arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetNillableSecurityGroupArn(v *string) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	if v != nil {
+		_u.SetSecurityGroupArn(*v)
+	}
+	return _u
+}
+
+// ClearSecurityGroupArn clears the value of the "security_group_arn" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) ClearSecurityGroupArn() *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.ClearSecurityGroupArn()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) ClearTagsJSON() *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetAccountID(v string) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetRegion(v string) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SetNillableRegion(v *string) *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2SecurityGroupMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) Mutation() *BronzeHistoryAWSEC2SecurityGroupMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2securitygroup.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2SecurityGroup.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2securitygroup.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2SecurityGroup.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2securitygroup.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2SecurityGroup.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2securitygroup.Table, bronzehistoryawsec2securitygroup.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2securitygroup.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygroup.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GroupName(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldGroupName, field.TypeString, value)
+	}
+	if _u.mutation.GroupNameCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygroup.FieldGroupName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygroup.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygroup.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygroup.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecurityGroupArn(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldSecurityGroupArn, field.TypeString, value)
+	}
+	if _u.mutation.SecurityGroupArnCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygroup.FieldSecurityGroupArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2securitygroup.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygroup.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2SecurityGroup
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2securitygroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryAWSEC2SecurityGroupUpdateOne is the builder for updating a single BronzeHistoryAWSEC2SecurityGroup entity.
+type BronzeHistoryAWSEC2SecurityGroupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2SecurityGroupMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetValidTo(v time.Time) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) ClearValidTo() *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetResourceID(v string) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetGroupName sets the "group_name" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetGroupName(v string) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetGroupName(v)
+	return _u
+}
+
+// SetNillableGroupName sets the "group_name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetNillableGroupName(v *string) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	if v != nil {
+		_u.SetGroupName(*v)
+	}
+	return _u
+}
+
+// ClearGroupName clears the value of the "group_name" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) ClearGroupName() *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.ClearGroupName()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetDescription(v string) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetNillableDescription(v *string) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) ClearDescription() *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetVpcID(v string) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetNillableVpcID(v *string) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) ClearVpcID() *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetOwnerID(v string) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetNillableOwnerID(v *string) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) ClearOwnerID() *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetSecurityGroupArn sets the "security_group_arn" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetSecurityGroupArn(v string) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetSecurityGroupArn(v)
+	return _u
+}
+
+// SetNillableSecurityGroupArn sets the "security_group_arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetNillableSecurityGroupArn(v *string) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	if v != nil {
+		_u.SetSecurityGroupArn(*v)
+	}
+	return _u
+}
+
+// ClearSecurityGroupArn clears the value of the "security_group_arn" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) ClearSecurityGroupArn() *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.ClearSecurityGroupArn()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) ClearTagsJSON() *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetAccountID(v string) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetRegion(v string) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SetNillableRegion(v *string) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2SecurityGroupMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) Mutation() *BronzeHistoryAWSEC2SecurityGroupMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2SecurityGroupUpdate builder.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) Where(ps ...predicate.BronzeHistoryAWSEC2SecurityGroup) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) Select(field string, fields ...string) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryAWSEC2SecurityGroup entity.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) Save(ctx context.Context) (*BronzeHistoryAWSEC2SecurityGroup, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) SaveX(ctx context.Context) *BronzeHistoryAWSEC2SecurityGroup {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2securitygroup.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2SecurityGroup.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2securitygroup.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2SecurityGroup.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2securitygroup.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2SecurityGroup.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2SecurityGroupUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryAWSEC2SecurityGroup, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2securitygroup.Table, bronzehistoryawsec2securitygroup.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2securitygroup.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeHistoryAWSEC2SecurityGroup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawsec2securitygroup.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryawsec2securitygroup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryawsec2securitygroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygroup.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GroupName(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldGroupName, field.TypeString, value)
+	}
+	if _u.mutation.GroupNameCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygroup.FieldGroupName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygroup.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygroup.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygroup.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecurityGroupArn(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldSecurityGroupArn, field.TypeString, value)
+	}
+	if _u.mutation.SecurityGroupArnCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygroup.FieldSecurityGroupArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2securitygroup.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygroup.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygroup.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2SecurityGroup
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryAWSEC2SecurityGroup{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2securitygroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,342 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2securitygrouprule"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryAWSEC2SecurityGroupRule is the model entity for the BronzeHistoryAWSEC2SecurityGroupRule schema.
+type BronzeHistoryAWSEC2SecurityGroupRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze security group rule by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID string `json:"group_id,omitempty"`
+	// GroupOwnerID holds the value of the "group_owner_id" field.
+	GroupOwnerID string `json:"group_owner_id,omitempty"`
+	// IsEgress holds the value of the "is_egress" field.
+	IsEgress bool `json:"is_egress,omitempty"`
+	// IPProtocol holds the value of the "ip_protocol" field.
+	IPProtocol string `json:"ip_protocol,omitempty"`
+	// FromPort holds the value of the "from_port" field.
+	FromPort *int32 `json:"from_port,omitempty"`
+	// ToPort holds the value of the "to_port" field.
+	ToPort *int32 `json:"to_port,omitempty"`
+	// CidrIpv4 holds the value of the "cidr_ipv4" field.
+	CidrIpv4 string `json:"cidr_ipv4,omitempty"`
+	// CidrIpv6 holds the value of the "cidr_ipv6" field.
+	CidrIpv6 string `json:"cidr_ipv6,omitempty"`
+	// PrefixListID holds the value of the "prefix_list_id" field.
+	PrefixListID string `json:"prefix_list_id,omitempty"`
+	// ReferencedGroupID holds the value of the "referenced_group_id" field.
+	ReferencedGroupID string `json:"referenced_group_id,omitempty"`
+	// ReferencedUserID holds the value of the "referenced_user_id" field.
+	ReferencedUserID string `json:"referenced_user_id,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// SecurityGroupRuleArn holds the value of the "security_group_rule_arn" field.
+	SecurityGroupRuleArn string `json:"security_group_rule_arn,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Region holds the value of the "region" field.
+	Region       string `json:"region,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryAWSEC2SecurityGroupRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsec2securitygrouprule.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzehistoryawsec2securitygrouprule.FieldIsEgress:
+			values[i] = new(sql.NullBool)
+		case bronzehistoryawsec2securitygrouprule.FieldID, bronzehistoryawsec2securitygrouprule.FieldFromPort, bronzehistoryawsec2securitygrouprule.FieldToPort:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryawsec2securitygrouprule.FieldResourceID, bronzehistoryawsec2securitygrouprule.FieldGroupID, bronzehistoryawsec2securitygrouprule.FieldGroupOwnerID, bronzehistoryawsec2securitygrouprule.FieldIPProtocol, bronzehistoryawsec2securitygrouprule.FieldCidrIpv4, bronzehistoryawsec2securitygrouprule.FieldCidrIpv6, bronzehistoryawsec2securitygrouprule.FieldPrefixListID, bronzehistoryawsec2securitygrouprule.FieldReferencedGroupID, bronzehistoryawsec2securitygrouprule.FieldReferencedUserID, bronzehistoryawsec2securitygrouprule.FieldDescription, bronzehistoryawsec2securitygrouprule.FieldSecurityGroupRuleArn, bronzehistoryawsec2securitygrouprule.FieldAccountID, bronzehistoryawsec2securitygrouprule.FieldRegion:
+			values[i] = new(sql.NullString)
+		case bronzehistoryawsec2securitygrouprule.FieldValidFrom, bronzehistoryawsec2securitygrouprule.FieldValidTo, bronzehistoryawsec2securitygrouprule.FieldCollectedAt, bronzehistoryawsec2securitygrouprule.FieldFirstCollectedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryAWSEC2SecurityGroupRule fields.
+func (_m *BronzeHistoryAWSEC2SecurityGroupRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsec2securitygrouprule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryawsec2securitygrouprule.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldGroupID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				_m.GroupID = value.String
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldGroupOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_owner_id", values[i])
+			} else if value.Valid {
+				_m.GroupOwnerID = value.String
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldIsEgress:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_egress", values[i])
+			} else if value.Valid {
+				_m.IsEgress = value.Bool
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldIPProtocol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_protocol", values[i])
+			} else if value.Valid {
+				_m.IPProtocol = value.String
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldFromPort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field from_port", values[i])
+			} else if value.Valid {
+				_m.FromPort = new(int32)
+				*_m.FromPort = int32(value.Int64)
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldToPort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field to_port", values[i])
+			} else if value.Valid {
+				_m.ToPort = new(int32)
+				*_m.ToPort = int32(value.Int64)
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldCidrIpv4:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cidr_ipv4", values[i])
+			} else if value.Valid {
+				_m.CidrIpv4 = value.String
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldCidrIpv6:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cidr_ipv6", values[i])
+			} else if value.Valid {
+				_m.CidrIpv6 = value.String
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldPrefixListID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prefix_list_id", values[i])
+			} else if value.Valid {
+				_m.PrefixListID = value.String
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldReferencedGroupID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referenced_group_id", values[i])
+			} else if value.Valid {
+				_m.ReferencedGroupID = value.String
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldReferencedUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referenced_user_id", values[i])
+			} else if value.Valid {
+				_m.ReferencedUserID = value.String
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldSecurityGroupRuleArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field security_group_rule_arn", values[i])
+			} else if value.Valid {
+				_m.SecurityGroupRuleArn = value.String
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzehistoryawsec2securitygrouprule.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryAWSEC2SecurityGroupRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryAWSEC2SecurityGroupRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryAWSEC2SecurityGroupRule.
+// Note that you need to call BronzeHistoryAWSEC2SecurityGroupRule.Unwrap() before calling this method if this BronzeHistoryAWSEC2SecurityGroupRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryAWSEC2SecurityGroupRule) Update() *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	return NewBronzeHistoryAWSEC2SecurityGroupRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryAWSEC2SecurityGroupRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryAWSEC2SecurityGroupRule) Unwrap() *BronzeHistoryAWSEC2SecurityGroupRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ec2: BronzeHistoryAWSEC2SecurityGroupRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryAWSEC2SecurityGroupRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryAWSEC2SecurityGroupRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(_m.GroupID)
+	builder.WriteString(", ")
+	builder.WriteString("group_owner_id=")
+	builder.WriteString(_m.GroupOwnerID)
+	builder.WriteString(", ")
+	builder.WriteString("is_egress=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsEgress))
+	builder.WriteString(", ")
+	builder.WriteString("ip_protocol=")
+	builder.WriteString(_m.IPProtocol)
+	builder.WriteString(", ")
+	if v := _m.FromPort; v != nil {
+		builder.WriteString("from_port=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ToPort; v != nil {
+		builder.WriteString("to_port=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cidr_ipv4=")
+	builder.WriteString(_m.CidrIpv4)
+	builder.WriteString(", ")
+	builder.WriteString("cidr_ipv6=")
+	builder.WriteString(_m.CidrIpv6)
+	builder.WriteString(", ")
+	builder.WriteString("prefix_list_id=")
+	builder.WriteString(_m.PrefixListID)
+	builder.WriteString(", ")
+	builder.WriteString("referenced_group_id=")
+	builder.WriteString(_m.ReferencedGroupID)
+	builder.WriteString(", ")
+	builder.WriteString("referenced_user_id=")
+	builder.WriteString(_m.ReferencedUserID)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("security_group_rule_arn=")
+	builder.WriteString(_m.SecurityGroupRuleArn)
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryAWSEC2SecurityGroupRules is a parsable slice of BronzeHistoryAWSEC2SecurityGroupRule.
+type BronzeHistoryAWSEC2SecurityGroupRules []*BronzeHistoryAWSEC2SecurityGroupRule
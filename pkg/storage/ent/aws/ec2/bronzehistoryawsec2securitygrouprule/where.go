@@ -0,0 +1,1415 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryawsec2securitygrouprule
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldID, id))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldValidTo, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldResourceID, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupOwnerID applies equality check predicate on the "group_owner_id" field. It's identical to GroupOwnerIDEQ.
+func GroupOwnerID(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldGroupOwnerID, v))
+}
+
+// IsEgress applies equality check predicate on the "is_egress" field. It's identical to IsEgressEQ.
+func IsEgress(v bool) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldIsEgress, v))
+}
+
+// IPProtocol applies equality check predicate on the "ip_protocol" field. It's identical to IPProtocolEQ.
+func IPProtocol(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldIPProtocol, v))
+}
+
+// FromPort applies equality check predicate on the "from_port" field. It's identical to FromPortEQ.
+func FromPort(v int32) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldFromPort, v))
+}
+
+// ToPort applies equality check predicate on the "to_port" field. It's identical to ToPortEQ.
+func ToPort(v int32) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldToPort, v))
+}
+
+// CidrIpv4 applies equality check predicate on the "cidr_ipv4" field. It's identical to CidrIpv4EQ.
+func CidrIpv4(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldCidrIpv4, v))
+}
+
+// CidrIpv6 applies equality check predicate on the "cidr_ipv6" field. It's identical to CidrIpv6EQ.
+func CidrIpv6(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldCidrIpv6, v))
+}
+
+// PrefixListID applies equality check predicate on the "prefix_list_id" field. It's identical to PrefixListIDEQ.
+func PrefixListID(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldPrefixListID, v))
+}
+
+// ReferencedGroupID applies equality check predicate on the "referenced_group_id" field. It's identical to ReferencedGroupIDEQ.
+func ReferencedGroupID(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldReferencedGroupID, v))
+}
+
+// ReferencedUserID applies equality check predicate on the "referenced_user_id" field. It's identical to ReferencedUserIDEQ.
+func ReferencedUserID(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldReferencedUserID, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// SecurityGroupRuleArn applies equality check predicate on the "security_group_rule_arn" field. It's identical to SecurityGroupRuleArnEQ.
+func SecurityGroupRuleArn(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldSecurityGroupRuleArn, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldRegion, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotNull(FieldValidTo))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// GroupIDGT applies the GT predicate on the "group_id" field.
+func GroupIDGT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldGroupID, v))
+}
+
+// GroupIDGTE applies the GTE predicate on the "group_id" field.
+func GroupIDGTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldGroupID, v))
+}
+
+// GroupIDLT applies the LT predicate on the "group_id" field.
+func GroupIDLT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldGroupID, v))
+}
+
+// GroupIDLTE applies the LTE predicate on the "group_id" field.
+func GroupIDLTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldGroupID, v))
+}
+
+// GroupIDContains applies the Contains predicate on the "group_id" field.
+func GroupIDContains(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContains(FieldGroupID, v))
+}
+
+// GroupIDHasPrefix applies the HasPrefix predicate on the "group_id" field.
+func GroupIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasPrefix(FieldGroupID, v))
+}
+
+// GroupIDHasSuffix applies the HasSuffix predicate on the "group_id" field.
+func GroupIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasSuffix(FieldGroupID, v))
+}
+
+// GroupIDIsNil applies the IsNil predicate on the "group_id" field.
+func GroupIDIsNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIsNull(FieldGroupID))
+}
+
+// GroupIDNotNil applies the NotNil predicate on the "group_id" field.
+func GroupIDNotNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotNull(FieldGroupID))
+}
+
+// GroupIDEqualFold applies the EqualFold predicate on the "group_id" field.
+func GroupIDEqualFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEqualFold(FieldGroupID, v))
+}
+
+// GroupIDContainsFold applies the ContainsFold predicate on the "group_id" field.
+func GroupIDContainsFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContainsFold(FieldGroupID, v))
+}
+
+// GroupOwnerIDEQ applies the EQ predicate on the "group_owner_id" field.
+func GroupOwnerIDEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldGroupOwnerID, v))
+}
+
+// GroupOwnerIDNEQ applies the NEQ predicate on the "group_owner_id" field.
+func GroupOwnerIDNEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldGroupOwnerID, v))
+}
+
+// GroupOwnerIDIn applies the In predicate on the "group_owner_id" field.
+func GroupOwnerIDIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldGroupOwnerID, vs...))
+}
+
+// GroupOwnerIDNotIn applies the NotIn predicate on the "group_owner_id" field.
+func GroupOwnerIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldGroupOwnerID, vs...))
+}
+
+// GroupOwnerIDGT applies the GT predicate on the "group_owner_id" field.
+func GroupOwnerIDGT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldGroupOwnerID, v))
+}
+
+// GroupOwnerIDGTE applies the GTE predicate on the "group_owner_id" field.
+func GroupOwnerIDGTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldGroupOwnerID, v))
+}
+
+// GroupOwnerIDLT applies the LT predicate on the "group_owner_id" field.
+func GroupOwnerIDLT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldGroupOwnerID, v))
+}
+
+// GroupOwnerIDLTE applies the LTE predicate on the "group_owner_id" field.
+func GroupOwnerIDLTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldGroupOwnerID, v))
+}
+
+// GroupOwnerIDContains applies the Contains predicate on the "group_owner_id" field.
+func GroupOwnerIDContains(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContains(FieldGroupOwnerID, v))
+}
+
+// GroupOwnerIDHasPrefix applies the HasPrefix predicate on the "group_owner_id" field.
+func GroupOwnerIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasPrefix(FieldGroupOwnerID, v))
+}
+
+// GroupOwnerIDHasSuffix applies the HasSuffix predicate on the "group_owner_id" field.
+func GroupOwnerIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasSuffix(FieldGroupOwnerID, v))
+}
+
+// GroupOwnerIDIsNil applies the IsNil predicate on the "group_owner_id" field.
+func GroupOwnerIDIsNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIsNull(FieldGroupOwnerID))
+}
+
+// GroupOwnerIDNotNil applies the NotNil predicate on the "group_owner_id" field.
+func GroupOwnerIDNotNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotNull(FieldGroupOwnerID))
+}
+
+// GroupOwnerIDEqualFold applies the EqualFold predicate on the "group_owner_id" field.
+func GroupOwnerIDEqualFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEqualFold(FieldGroupOwnerID, v))
+}
+
+// GroupOwnerIDContainsFold applies the ContainsFold predicate on the "group_owner_id" field.
+func GroupOwnerIDContainsFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContainsFold(FieldGroupOwnerID, v))
+}
+
+// IsEgressEQ applies the EQ predicate on the "is_egress" field.
+func IsEgressEQ(v bool) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldIsEgress, v))
+}
+
+// IsEgressNEQ applies the NEQ predicate on the "is_egress" field.
+func IsEgressNEQ(v bool) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldIsEgress, v))
+}
+
+// IsEgressIsNil applies the IsNil predicate on the "is_egress" field.
+func IsEgressIsNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIsNull(FieldIsEgress))
+}
+
+// IsEgressNotNil applies the NotNil predicate on the "is_egress" field.
+func IsEgressNotNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotNull(FieldIsEgress))
+}
+
+// IPProtocolEQ applies the EQ predicate on the "ip_protocol" field.
+func IPProtocolEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldIPProtocol, v))
+}
+
+// IPProtocolNEQ applies the NEQ predicate on the "ip_protocol" field.
+func IPProtocolNEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldIPProtocol, v))
+}
+
+// IPProtocolIn applies the In predicate on the "ip_protocol" field.
+func IPProtocolIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldIPProtocol, vs...))
+}
+
+// IPProtocolNotIn applies the NotIn predicate on the "ip_protocol" field.
+func IPProtocolNotIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldIPProtocol, vs...))
+}
+
+// IPProtocolGT applies the GT predicate on the "ip_protocol" field.
+func IPProtocolGT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldIPProtocol, v))
+}
+
+// IPProtocolGTE applies the GTE predicate on the "ip_protocol" field.
+func IPProtocolGTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldIPProtocol, v))
+}
+
+// IPProtocolLT applies the LT predicate on the "ip_protocol" field.
+func IPProtocolLT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldIPProtocol, v))
+}
+
+// IPProtocolLTE applies the LTE predicate on the "ip_protocol" field.
+func IPProtocolLTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldIPProtocol, v))
+}
+
+// IPProtocolContains applies the Contains predicate on the "ip_protocol" field.
+func IPProtocolContains(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContains(FieldIPProtocol, v))
+}
+
+// IPProtocolHasPrefix applies the HasPrefix predicate on the "ip_protocol" field.
+func IPProtocolHasPrefix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasPrefix(FieldIPProtocol, v))
+}
+
+// IPProtocolHasSuffix applies the HasSuffix predicate on the "ip_protocol" field.
+func IPProtocolHasSuffix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasSuffix(FieldIPProtocol, v))
+}
+
+// IPProtocolIsNil applies the IsNil predicate on the "ip_protocol" field.
+func IPProtocolIsNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIsNull(FieldIPProtocol))
+}
+
+// IPProtocolNotNil applies the NotNil predicate on the "ip_protocol" field.
+func IPProtocolNotNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotNull(FieldIPProtocol))
+}
+
+// IPProtocolEqualFold applies the EqualFold predicate on the "ip_protocol" field.
+func IPProtocolEqualFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEqualFold(FieldIPProtocol, v))
+}
+
+// IPProtocolContainsFold applies the ContainsFold predicate on the "ip_protocol" field.
+func IPProtocolContainsFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContainsFold(FieldIPProtocol, v))
+}
+
+// FromPortEQ applies the EQ predicate on the "from_port" field.
+func FromPortEQ(v int32) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldFromPort, v))
+}
+
+// FromPortNEQ applies the NEQ predicate on the "from_port" field.
+func FromPortNEQ(v int32) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldFromPort, v))
+}
+
+// FromPortIn applies the In predicate on the "from_port" field.
+func FromPortIn(vs ...int32) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldFromPort, vs...))
+}
+
+// FromPortNotIn applies the NotIn predicate on the "from_port" field.
+func FromPortNotIn(vs ...int32) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldFromPort, vs...))
+}
+
+// FromPortGT applies the GT predicate on the "from_port" field.
+func FromPortGT(v int32) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldFromPort, v))
+}
+
+// FromPortGTE applies the GTE predicate on the "from_port" field.
+func FromPortGTE(v int32) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldFromPort, v))
+}
+
+// FromPortLT applies the LT predicate on the "from_port" field.
+func FromPortLT(v int32) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldFromPort, v))
+}
+
+// FromPortLTE applies the LTE predicate on the "from_port" field.
+func FromPortLTE(v int32) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldFromPort, v))
+}
+
+// FromPortIsNil applies the IsNil predicate on the "from_port" field.
+func FromPortIsNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIsNull(FieldFromPort))
+}
+
+// FromPortNotNil applies the NotNil predicate on the "from_port" field.
+func FromPortNotNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotNull(FieldFromPort))
+}
+
+// ToPortEQ applies the EQ predicate on the "to_port" field.
+func ToPortEQ(v int32) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldToPort, v))
+}
+
+// ToPortNEQ applies the NEQ predicate on the "to_port" field.
+func ToPortNEQ(v int32) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldToPort, v))
+}
+
+// ToPortIn applies the In predicate on the "to_port" field.
+func ToPortIn(vs ...int32) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldToPort, vs...))
+}
+
+// ToPortNotIn applies the NotIn predicate on the "to_port" field.
+func ToPortNotIn(vs ...int32) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldToPort, vs...))
+}
+
+// ToPortGT applies the GT predicate on the "to_port" field.
+func ToPortGT(v int32) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldToPort, v))
+}
+
+// ToPortGTE applies the GTE predicate on the "to_port" field.
+func ToPortGTE(v int32) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldToPort, v))
+}
+
+// ToPortLT applies the LT predicate on the "to_port" field.
+func ToPortLT(v int32) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldToPort, v))
+}
+
+// ToPortLTE applies the LTE predicate on the "to_port" field.
+func ToPortLTE(v int32) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldToPort, v))
+}
+
+// ToPortIsNil applies the IsNil predicate on the "to_port" field.
+func ToPortIsNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIsNull(FieldToPort))
+}
+
+// ToPortNotNil applies the NotNil predicate on the "to_port" field.
+func ToPortNotNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotNull(FieldToPort))
+}
+
+// CidrIpv4EQ applies the EQ predicate on the "cidr_ipv4" field.
+func CidrIpv4EQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldCidrIpv4, v))
+}
+
+// CidrIpv4NEQ applies the NEQ predicate on the "cidr_ipv4" field.
+func CidrIpv4NEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldCidrIpv4, v))
+}
+
+// CidrIpv4In applies the In predicate on the "cidr_ipv4" field.
+func CidrIpv4In(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldCidrIpv4, vs...))
+}
+
+// CidrIpv4NotIn applies the NotIn predicate on the "cidr_ipv4" field.
+func CidrIpv4NotIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldCidrIpv4, vs...))
+}
+
+// CidrIpv4GT applies the GT predicate on the "cidr_ipv4" field.
+func CidrIpv4GT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldCidrIpv4, v))
+}
+
+// CidrIpv4GTE applies the GTE predicate on the "cidr_ipv4" field.
+func CidrIpv4GTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldCidrIpv4, v))
+}
+
+// CidrIpv4LT applies the LT predicate on the "cidr_ipv4" field.
+func CidrIpv4LT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldCidrIpv4, v))
+}
+
+// CidrIpv4LTE applies the LTE predicate on the "cidr_ipv4" field.
+func CidrIpv4LTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldCidrIpv4, v))
+}
+
+// CidrIpv4Contains applies the Contains predicate on the "cidr_ipv4" field.
+func CidrIpv4Contains(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContains(FieldCidrIpv4, v))
+}
+
+// CidrIpv4HasPrefix applies the HasPrefix predicate on the "cidr_ipv4" field.
+func CidrIpv4HasPrefix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasPrefix(FieldCidrIpv4, v))
+}
+
+// CidrIpv4HasSuffix applies the HasSuffix predicate on the "cidr_ipv4" field.
+func CidrIpv4HasSuffix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasSuffix(FieldCidrIpv4, v))
+}
+
+// CidrIpv4IsNil applies the IsNil predicate on the "cidr_ipv4" field.
+func CidrIpv4IsNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIsNull(FieldCidrIpv4))
+}
+
+// CidrIpv4NotNil applies the NotNil predicate on the "cidr_ipv4" field.
+func CidrIpv4NotNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotNull(FieldCidrIpv4))
+}
+
+// CidrIpv4EqualFold applies the EqualFold predicate on the "cidr_ipv4" field.
+func CidrIpv4EqualFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEqualFold(FieldCidrIpv4, v))
+}
+
+// CidrIpv4ContainsFold applies the ContainsFold predicate on the "cidr_ipv4" field.
+func CidrIpv4ContainsFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContainsFold(FieldCidrIpv4, v))
+}
+
+// CidrIpv6EQ applies the EQ predicate on the "cidr_ipv6" field.
+func CidrIpv6EQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldCidrIpv6, v))
+}
+
+// CidrIpv6NEQ applies the NEQ predicate on the "cidr_ipv6" field.
+func CidrIpv6NEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldCidrIpv6, v))
+}
+
+// CidrIpv6In applies the In predicate on the "cidr_ipv6" field.
+func CidrIpv6In(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldCidrIpv6, vs...))
+}
+
+// CidrIpv6NotIn applies the NotIn predicate on the "cidr_ipv6" field.
+func CidrIpv6NotIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldCidrIpv6, vs...))
+}
+
+// CidrIpv6GT applies the GT predicate on the "cidr_ipv6" field.
+func CidrIpv6GT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldCidrIpv6, v))
+}
+
+// CidrIpv6GTE applies the GTE predicate on the "cidr_ipv6" field.
+func CidrIpv6GTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldCidrIpv6, v))
+}
+
+// CidrIpv6LT applies the LT predicate on the "cidr_ipv6" field.
+func CidrIpv6LT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldCidrIpv6, v))
+}
+
+// CidrIpv6LTE applies the LTE predicate on the "cidr_ipv6" field.
+func CidrIpv6LTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldCidrIpv6, v))
+}
+
+// CidrIpv6Contains applies the Contains predicate on the "cidr_ipv6" field.
+func CidrIpv6Contains(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContains(FieldCidrIpv6, v))
+}
+
+// CidrIpv6HasPrefix applies the HasPrefix predicate on the "cidr_ipv6" field.
+func CidrIpv6HasPrefix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasPrefix(FieldCidrIpv6, v))
+}
+
+// CidrIpv6HasSuffix applies the HasSuffix predicate on the "cidr_ipv6" field.
+func CidrIpv6HasSuffix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasSuffix(FieldCidrIpv6, v))
+}
+
+// CidrIpv6IsNil applies the IsNil predicate on the "cidr_ipv6" field.
+func CidrIpv6IsNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIsNull(FieldCidrIpv6))
+}
+
+// CidrIpv6NotNil applies the NotNil predicate on the "cidr_ipv6" field.
+func CidrIpv6NotNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotNull(FieldCidrIpv6))
+}
+
+// CidrIpv6EqualFold applies the EqualFold predicate on the "cidr_ipv6" field.
+func CidrIpv6EqualFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEqualFold(FieldCidrIpv6, v))
+}
+
+// CidrIpv6ContainsFold applies the ContainsFold predicate on the "cidr_ipv6" field.
+func CidrIpv6ContainsFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContainsFold(FieldCidrIpv6, v))
+}
+
+// PrefixListIDEQ applies the EQ predicate on the "prefix_list_id" field.
+func PrefixListIDEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldPrefixListID, v))
+}
+
+// PrefixListIDNEQ applies the NEQ predicate on the "prefix_list_id" field.
+func PrefixListIDNEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldPrefixListID, v))
+}
+
+// PrefixListIDIn applies the In predicate on the "prefix_list_id" field.
+func PrefixListIDIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldPrefixListID, vs...))
+}
+
+// PrefixListIDNotIn applies the NotIn predicate on the "prefix_list_id" field.
+func PrefixListIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldPrefixListID, vs...))
+}
+
+// PrefixListIDGT applies the GT predicate on the "prefix_list_id" field.
+func PrefixListIDGT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldPrefixListID, v))
+}
+
+// PrefixListIDGTE applies the GTE predicate on the "prefix_list_id" field.
+func PrefixListIDGTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldPrefixListID, v))
+}
+
+// PrefixListIDLT applies the LT predicate on the "prefix_list_id" field.
+func PrefixListIDLT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldPrefixListID, v))
+}
+
+// PrefixListIDLTE applies the LTE predicate on the "prefix_list_id" field.
+func PrefixListIDLTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldPrefixListID, v))
+}
+
+// PrefixListIDContains applies the Contains predicate on the "prefix_list_id" field.
+func PrefixListIDContains(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContains(FieldPrefixListID, v))
+}
+
+// PrefixListIDHasPrefix applies the HasPrefix predicate on the "prefix_list_id" field.
+func PrefixListIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasPrefix(FieldPrefixListID, v))
+}
+
+// PrefixListIDHasSuffix applies the HasSuffix predicate on the "prefix_list_id" field.
+func PrefixListIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasSuffix(FieldPrefixListID, v))
+}
+
+// PrefixListIDIsNil applies the IsNil predicate on the "prefix_list_id" field.
+func PrefixListIDIsNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIsNull(FieldPrefixListID))
+}
+
+// PrefixListIDNotNil applies the NotNil predicate on the "prefix_list_id" field.
+func PrefixListIDNotNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotNull(FieldPrefixListID))
+}
+
+// PrefixListIDEqualFold applies the EqualFold predicate on the "prefix_list_id" field.
+func PrefixListIDEqualFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEqualFold(FieldPrefixListID, v))
+}
+
+// PrefixListIDContainsFold applies the ContainsFold predicate on the "prefix_list_id" field.
+func PrefixListIDContainsFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContainsFold(FieldPrefixListID, v))
+}
+
+// ReferencedGroupIDEQ applies the EQ predicate on the "referenced_group_id" field.
+func ReferencedGroupIDEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldReferencedGroupID, v))
+}
+
+// ReferencedGroupIDNEQ applies the NEQ predicate on the "referenced_group_id" field.
+func ReferencedGroupIDNEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldReferencedGroupID, v))
+}
+
+// ReferencedGroupIDIn applies the In predicate on the "referenced_group_id" field.
+func ReferencedGroupIDIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldReferencedGroupID, vs...))
+}
+
+// ReferencedGroupIDNotIn applies the NotIn predicate on the "referenced_group_id" field.
+func ReferencedGroupIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldReferencedGroupID, vs...))
+}
+
+// ReferencedGroupIDGT applies the GT predicate on the "referenced_group_id" field.
+func ReferencedGroupIDGT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldReferencedGroupID, v))
+}
+
+// ReferencedGroupIDGTE applies the GTE predicate on the "referenced_group_id" field.
+func ReferencedGroupIDGTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldReferencedGroupID, v))
+}
+
+// ReferencedGroupIDLT applies the LT predicate on the "referenced_group_id" field.
+func ReferencedGroupIDLT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldReferencedGroupID, v))
+}
+
+// ReferencedGroupIDLTE applies the LTE predicate on the "referenced_group_id" field.
+func ReferencedGroupIDLTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldReferencedGroupID, v))
+}
+
+// ReferencedGroupIDContains applies the Contains predicate on the "referenced_group_id" field.
+func ReferencedGroupIDContains(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContains(FieldReferencedGroupID, v))
+}
+
+// ReferencedGroupIDHasPrefix applies the HasPrefix predicate on the "referenced_group_id" field.
+func ReferencedGroupIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasPrefix(FieldReferencedGroupID, v))
+}
+
+// ReferencedGroupIDHasSuffix applies the HasSuffix predicate on the "referenced_group_id" field.
+func ReferencedGroupIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasSuffix(FieldReferencedGroupID, v))
+}
+
+// ReferencedGroupIDIsNil applies the IsNil predicate on the "referenced_group_id" field.
+func ReferencedGroupIDIsNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIsNull(FieldReferencedGroupID))
+}
+
+// ReferencedGroupIDNotNil applies the NotNil predicate on the "referenced_group_id" field.
+func ReferencedGroupIDNotNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotNull(FieldReferencedGroupID))
+}
+
+// ReferencedGroupIDEqualFold applies the EqualFold predicate on the "referenced_group_id" field.
+func ReferencedGroupIDEqualFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEqualFold(FieldReferencedGroupID, v))
+}
+
+// ReferencedGroupIDContainsFold applies the ContainsFold predicate on the "referenced_group_id" field.
+func ReferencedGroupIDContainsFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContainsFold(FieldReferencedGroupID, v))
+}
+
+// ReferencedUserIDEQ applies the EQ predicate on the "referenced_user_id" field.
+func ReferencedUserIDEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldReferencedUserID, v))
+}
+
+// ReferencedUserIDNEQ applies the NEQ predicate on the "referenced_user_id" field.
+func ReferencedUserIDNEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldReferencedUserID, v))
+}
+
+// ReferencedUserIDIn applies the In predicate on the "referenced_user_id" field.
+func ReferencedUserIDIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldReferencedUserID, vs...))
+}
+
+// ReferencedUserIDNotIn applies the NotIn predicate on the "referenced_user_id" field.
+func ReferencedUserIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldReferencedUserID, vs...))
+}
+
+// ReferencedUserIDGT applies the GT predicate on the "referenced_user_id" field.
+func ReferencedUserIDGT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldReferencedUserID, v))
+}
+
+// ReferencedUserIDGTE applies the GTE predicate on the "referenced_user_id" field.
+func ReferencedUserIDGTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldReferencedUserID, v))
+}
+
+// ReferencedUserIDLT applies the LT predicate on the "referenced_user_id" field.
+func ReferencedUserIDLT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldReferencedUserID, v))
+}
+
+// ReferencedUserIDLTE applies the LTE predicate on the "referenced_user_id" field.
+func ReferencedUserIDLTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldReferencedUserID, v))
+}
+
+// ReferencedUserIDContains applies the Contains predicate on the "referenced_user_id" field.
+func ReferencedUserIDContains(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContains(FieldReferencedUserID, v))
+}
+
+// ReferencedUserIDHasPrefix applies the HasPrefix predicate on the "referenced_user_id" field.
+func ReferencedUserIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasPrefix(FieldReferencedUserID, v))
+}
+
+// ReferencedUserIDHasSuffix applies the HasSuffix predicate on the "referenced_user_id" field.
+func ReferencedUserIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasSuffix(FieldReferencedUserID, v))
+}
+
+// ReferencedUserIDIsNil applies the IsNil predicate on the "referenced_user_id" field.
+func ReferencedUserIDIsNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIsNull(FieldReferencedUserID))
+}
+
+// ReferencedUserIDNotNil applies the NotNil predicate on the "referenced_user_id" field.
+func ReferencedUserIDNotNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotNull(FieldReferencedUserID))
+}
+
+// ReferencedUserIDEqualFold applies the EqualFold predicate on the "referenced_user_id" field.
+func ReferencedUserIDEqualFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEqualFold(FieldReferencedUserID, v))
+}
+
+// ReferencedUserIDContainsFold applies the ContainsFold predicate on the "referenced_user_id" field.
+func ReferencedUserIDContainsFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContainsFold(FieldReferencedUserID, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// SecurityGroupRuleArnEQ applies the EQ predicate on the "security_group_rule_arn" field.
+func SecurityGroupRuleArnEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldSecurityGroupRuleArn, v))
+}
+
+// SecurityGroupRuleArnNEQ applies the NEQ predicate on the "security_group_rule_arn" field.
+func SecurityGroupRuleArnNEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldSecurityGroupRuleArn, v))
+}
+
+// SecurityGroupRuleArnIn applies the In predicate on the "security_group_rule_arn" field.
+func SecurityGroupRuleArnIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldSecurityGroupRuleArn, vs...))
+}
+
+// SecurityGroupRuleArnNotIn applies the NotIn predicate on the "security_group_rule_arn" field.
+func SecurityGroupRuleArnNotIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldSecurityGroupRuleArn, vs...))
+}
+
+// SecurityGroupRuleArnGT applies the GT predicate on the "security_group_rule_arn" field.
+func SecurityGroupRuleArnGT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldSecurityGroupRuleArn, v))
+}
+
+// SecurityGroupRuleArnGTE applies the GTE predicate on the "security_group_rule_arn" field.
+func SecurityGroupRuleArnGTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldSecurityGroupRuleArn, v))
+}
+
+// SecurityGroupRuleArnLT applies the LT predicate on the "security_group_rule_arn" field.
+func SecurityGroupRuleArnLT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldSecurityGroupRuleArn, v))
+}
+
+// SecurityGroupRuleArnLTE applies the LTE predicate on the "security_group_rule_arn" field.
+func SecurityGroupRuleArnLTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldSecurityGroupRuleArn, v))
+}
+
+// SecurityGroupRuleArnContains applies the Contains predicate on the "security_group_rule_arn" field.
+func SecurityGroupRuleArnContains(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContains(FieldSecurityGroupRuleArn, v))
+}
+
+// SecurityGroupRuleArnHasPrefix applies the HasPrefix predicate on the "security_group_rule_arn" field.
+func SecurityGroupRuleArnHasPrefix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasPrefix(FieldSecurityGroupRuleArn, v))
+}
+
+// SecurityGroupRuleArnHasSuffix applies the HasSuffix predicate on the "security_group_rule_arn" field.
+func SecurityGroupRuleArnHasSuffix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasSuffix(FieldSecurityGroupRuleArn, v))
+}
+
+// SecurityGroupRuleArnIsNil applies the IsNil predicate on the "security_group_rule_arn" field.
+func SecurityGroupRuleArnIsNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIsNull(FieldSecurityGroupRuleArn))
+}
+
+// SecurityGroupRuleArnNotNil applies the NotNil predicate on the "security_group_rule_arn" field.
+func SecurityGroupRuleArnNotNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotNull(FieldSecurityGroupRuleArn))
+}
+
+// SecurityGroupRuleArnEqualFold applies the EqualFold predicate on the "security_group_rule_arn" field.
+func SecurityGroupRuleArnEqualFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEqualFold(FieldSecurityGroupRuleArn, v))
+}
+
+// SecurityGroupRuleArnContainsFold applies the ContainsFold predicate on the "security_group_rule_arn" field.
+func SecurityGroupRuleArnContainsFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContainsFold(FieldSecurityGroupRuleArn, v))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeHistoryAWSEC2SecurityGroupRule) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeHistoryAWSEC2SecurityGroupRule) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeHistoryAWSEC2SecurityGroupRule) predicate.BronzeHistoryAWSEC2SecurityGroupRule {
+	return predicate.BronzeHistoryAWSEC2SecurityGroupRule(sql.NotPredicates(p))
+}
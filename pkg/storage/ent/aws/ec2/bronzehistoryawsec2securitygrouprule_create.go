@@ -0,0 +1,540 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2securitygrouprule"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2SecurityGroupRuleCreate is the builder for creating a BronzeHistoryAWSEC2SecurityGroupRule entity.
+type BronzeHistoryAWSEC2SecurityGroupRuleCreate struct {
+	config
+	mutation *BronzeHistoryAWSEC2SecurityGroupRuleMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetValidFrom(v time.Time) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetValidTo(v time.Time) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetResourceID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetGroupID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetNillableGroupID(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	if v != nil {
+		_c.SetGroupID(*v)
+	}
+	return _c
+}
+
+// SetGroupOwnerID sets the "group_owner_id" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetGroupOwnerID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetGroupOwnerID(v)
+	return _c
+}
+
+// SetNillableGroupOwnerID sets the "group_owner_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetNillableGroupOwnerID(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	if v != nil {
+		_c.SetGroupOwnerID(*v)
+	}
+	return _c
+}
+
+// SetIsEgress sets the "is_egress" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetIsEgress(v bool) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetIsEgress(v)
+	return _c
+}
+
+// SetNillableIsEgress sets the "is_egress" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetNillableIsEgress(v *bool) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	if v != nil {
+		_c.SetIsEgress(*v)
+	}
+	return _c
+}
+
+// SetIPProtocol sets the "ip_protocol" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetIPProtocol(v string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetIPProtocol(v)
+	return _c
+}
+
+// SetNillableIPProtocol sets the "ip_protocol" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetNillableIPProtocol(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	if v != nil {
+		_c.SetIPProtocol(*v)
+	}
+	return _c
+}
+
+// SetFromPort sets the "from_port" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetFromPort(v int32) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetFromPort(v)
+	return _c
+}
+
+// SetNillableFromPort sets the "from_port" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetNillableFromPort(v *int32) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	if v != nil {
+		_c.SetFromPort(*v)
+	}
+	return _c
+}
+
+// SetToPort sets the "to_port" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetToPort(v int32) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetToPort(v)
+	return _c
+}
+
+// SetNillableToPort sets the "to_port" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetNillableToPort(v *int32) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	if v != nil {
+		_c.SetToPort(*v)
+	}
+	return _c
+}
+
+// SetCidrIpv4 sets the "cidr_ipv4" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetCidrIpv4(v string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetCidrIpv4(v)
+	return _c
+}
+
+// SetNillableCidrIpv4 sets the "cidr_ipv4" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetNillableCidrIpv4(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	if v != nil {
+		_c.SetCidrIpv4(*v)
+	}
+	return _c
+}
+
+// SetCidrIpv6 sets the "cidr_ipv6" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetCidrIpv6(v string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetCidrIpv6(v)
+	return _c
+}
+
+// SetNillableCidrIpv6 sets the "cidr_ipv6" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetNillableCidrIpv6(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	if v != nil {
+		_c.SetCidrIpv6(*v)
+	}
+	return _c
+}
+
+// SetPrefixListID sets the "prefix_list_id" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetPrefixListID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetPrefixListID(v)
+	return _c
+}
+
+// SetNillablePrefixListID sets the "prefix_list_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetNillablePrefixListID(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	if v != nil {
+		_c.SetPrefixListID(*v)
+	}
+	return _c
+}
+
+// SetReferencedGroupID sets the "referenced_group_id" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetReferencedGroupID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetReferencedGroupID(v)
+	return _c
+}
+
+// SetNillableReferencedGroupID sets the "referenced_group_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetNillableReferencedGroupID(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	if v != nil {
+		_c.SetReferencedGroupID(*v)
+	}
+	return _c
+}
+
+// SetReferencedUserID sets the "referenced_user_id" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetReferencedUserID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetReferencedUserID(v)
+	return _c
+}
+
+// SetNillableReferencedUserID sets the "referenced_user_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetNillableReferencedUserID(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	if v != nil {
+		_c.SetReferencedUserID(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetDescription(v string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetNillableDescription(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetSecurityGroupRuleArn sets the "security_group_rule_arn" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetSecurityGroupRuleArn(v string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetSecurityGroupRuleArn(v)
+	return _c
+}
+
+// SetNillableSecurityGroupRuleArn sets the "security_group_rule_arn" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetNillableSecurityGroupRuleArn(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	if v != nil {
+		_c.SetSecurityGroupRuleArn(*v)
+	}
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetAccountID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetRegion(v string) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SetID(v uint) *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryAWSEC2SecurityGroupRuleMutation object of the builder.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) Mutation() *BronzeHistoryAWSEC2SecurityGroupRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryAWSEC2SecurityGroupRule in the database.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) Save(ctx context.Context) (*BronzeHistoryAWSEC2SecurityGroupRule, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) SaveX(ctx context.Context) *BronzeHistoryAWSEC2SecurityGroupRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2SecurityGroupRule.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2SecurityGroupRule.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2SecurityGroupRule.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2SecurityGroupRule.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2securitygrouprule.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2SecurityGroupRule.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2SecurityGroupRule.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2securitygrouprule.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2SecurityGroupRule.account_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2SecurityGroupRule.region"`)}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := bronzehistoryawsec2securitygrouprule.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2SecurityGroupRule.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) sqlSave(ctx context.Context) (*BronzeHistoryAWSEC2SecurityGroupRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreate) createSpec() (*BronzeHistoryAWSEC2SecurityGroupRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryAWSEC2SecurityGroupRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryawsec2securitygrouprule.Table, sqlgraph.NewFieldSpec(bronzehistoryawsec2securitygrouprule.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryAWSEC2SecurityGroupRule
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.GroupID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldGroupID, field.TypeString, value)
+		_node.GroupID = value
+	}
+	if value, ok := _c.mutation.GroupOwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldGroupOwnerID, field.TypeString, value)
+		_node.GroupOwnerID = value
+	}
+	if value, ok := _c.mutation.IsEgress(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldIsEgress, field.TypeBool, value)
+		_node.IsEgress = value
+	}
+	if value, ok := _c.mutation.IPProtocol(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldIPProtocol, field.TypeString, value)
+		_node.IPProtocol = value
+	}
+	if value, ok := _c.mutation.FromPort(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldFromPort, field.TypeInt32, value)
+		_node.FromPort = &value
+	}
+	if value, ok := _c.mutation.ToPort(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldToPort, field.TypeInt32, value)
+		_node.ToPort = &value
+	}
+	if value, ok := _c.mutation.CidrIpv4(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldCidrIpv4, field.TypeString, value)
+		_node.CidrIpv4 = value
+	}
+	if value, ok := _c.mutation.CidrIpv6(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldCidrIpv6, field.TypeString, value)
+		_node.CidrIpv6 = value
+	}
+	if value, ok := _c.mutation.PrefixListID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldPrefixListID, field.TypeString, value)
+		_node.PrefixListID = value
+	}
+	if value, ok := _c.mutation.ReferencedGroupID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldReferencedGroupID, field.TypeString, value)
+		_node.ReferencedGroupID = value
+	}
+	if value, ok := _c.mutation.ReferencedUserID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldReferencedUserID, field.TypeString, value)
+		_node.ReferencedUserID = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.SecurityGroupRuleArn(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldSecurityGroupRuleArn, field.TypeString, value)
+		_node.SecurityGroupRuleArn = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryAWSEC2SecurityGroupRuleCreateBulk is the builder for creating many BronzeHistoryAWSEC2SecurityGroupRule entities in bulk.
+type BronzeHistoryAWSEC2SecurityGroupRuleCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryAWSEC2SecurityGroupRuleCreate
+}
+
+// Save creates the BronzeHistoryAWSEC2SecurityGroupRule entities in the database.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryAWSEC2SecurityGroupRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryAWSEC2SecurityGroupRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryAWSEC2SecurityGroupRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryAWSEC2SecurityGroupRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSEC2SecurityGroupRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2securitygrouprule"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2SecurityGroupRuleDelete is the builder for deleting a BronzeHistoryAWSEC2SecurityGroupRule entity.
+type BronzeHistoryAWSEC2SecurityGroupRuleDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2SecurityGroupRuleMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2SecurityGroupRuleDelete builder.
+func (_d *BronzeHistoryAWSEC2SecurityGroupRuleDelete) Where(ps ...predicate.BronzeHistoryAWSEC2SecurityGroupRule) *BronzeHistoryAWSEC2SecurityGroupRuleDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeHistoryAWSEC2SecurityGroupRuleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryAWSEC2SecurityGroupRuleDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeHistoryAWSEC2SecurityGroupRuleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzehistoryawsec2securitygrouprule.Table, sqlgraph.NewFieldSpec(bronzehistoryawsec2securitygrouprule.FieldID, field.TypeUint))
+	_spec.Node.Schema = _d.schemaConfig.BronzeHistoryAWSEC2SecurityGroupRule
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeHistoryAWSEC2SecurityGroupRuleDeleteOne is the builder for deleting a single BronzeHistoryAWSEC2SecurityGroupRule entity.
+type BronzeHistoryAWSEC2SecurityGroupRuleDeleteOne struct {
+	_d *BronzeHistoryAWSEC2SecurityGroupRuleDelete
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2SecurityGroupRuleDelete builder.
+func (_d *BronzeHistoryAWSEC2SecurityGroupRuleDeleteOne) Where(ps ...predicate.BronzeHistoryAWSEC2SecurityGroupRule) *BronzeHistoryAWSEC2SecurityGroupRuleDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeHistoryAWSEC2SecurityGroupRuleDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzehistoryawsec2securitygrouprule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryAWSEC2SecurityGroupRuleDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2securitygrouprule"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2SecurityGroupRuleUpdate is the builder for updating BronzeHistoryAWSEC2SecurityGroupRule entities.
+type BronzeHistoryAWSEC2SecurityGroupRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2SecurityGroupRuleMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2SecurityGroupRuleUpdate builder.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) Where(ps ...predicate.BronzeHistoryAWSEC2SecurityGroupRule) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetValidTo(v time.Time) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) ClearValidTo() *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetResourceID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetGroupID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetNillableGroupID(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) ClearGroupID() *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetGroupOwnerID sets the "group_owner_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetGroupOwnerID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetGroupOwnerID(v)
+	return _u
+}
+
+// SetNillableGroupOwnerID sets the "group_owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetNillableGroupOwnerID(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetGroupOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearGroupOwnerID clears the value of the "group_owner_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) ClearGroupOwnerID() *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearGroupOwnerID()
+	return _u
+}
+
+// SetIsEgress sets the "is_egress" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetIsEgress(v bool) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetIsEgress(v)
+	return _u
+}
+
+// SetNillableIsEgress sets the "is_egress" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetNillableIsEgress(v *bool) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetIsEgress(*v)
+	}
+	return _u
+}
+
+// ClearIsEgress clears the value of the "is_egress" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) ClearIsEgress() *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearIsEgress()
+	return _u
+}
+
+// SetIPProtocol sets the "ip_protocol" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetIPProtocol(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetIPProtocol(v)
+	return _u
+}
+
+// SetNillableIPProtocol sets the "ip_protocol" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetNillableIPProtocol(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetIPProtocol(*v)
+	}
+	return _u
+}
+
+// ClearIPProtocol clears the value of the "ip_protocol" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) ClearIPProtocol() *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearIPProtocol()
+	return _u
+}
+
+// SetFromPort sets the "from_port" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetFromPort(v int32) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ResetFromPort()
+	_u.mutation.SetFromPort(v)
+	return _u
+}
+
+// SetNillableFromPort sets the "from_port" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetNillableFromPort(v *int32) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetFromPort(*v)
+	}
+	return _u
+}
+
+// AddFromPort adds value to the "from_port" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) AddFromPort(v int32) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.AddFromPort(v)
+	return _u
+}
+
+// ClearFromPort clears the value of the "from_port" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) ClearFromPort() *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearFromPort()
+	return _u
+}
+
+// SetToPort sets the "to_port" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetToPort(v int32) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ResetToPort()
+	_u.mutation.SetToPort(v)
+	return _u
+}
+
+// SetNillableToPort sets the "to_port" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetNillableToPort(v *int32) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetToPort(*v)
+	}
+	return _u
+}
+
+// AddToPort adds value to the "to_port" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) AddToPort(v int32) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.AddToPort(v)
+	return _u
+}
+
+// ClearToPort clears the value of the "to_port" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) ClearToPort() *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearToPort()
+	return _u
+}
+
+// SetCidrIpv4 sets the "cidr_ipv4" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetCidrIpv4(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetCidrIpv4(v)
+	return _u
+}
+
+// SetNillableCidrIpv4 sets the "cidr_ipv4" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetNillableCidrIpv4(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetCidrIpv4(*v)
+	}
+	return _u
+}
+
+// ClearCidrIpv4 clears the value of the "cidr_ipv4" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) ClearCidrIpv4() *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearCidrIpv4()
+	return _u
+}
+
+// SetCidrIpv6 sets the "cidr_ipv6" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetCidrIpv6(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetCidrIpv6(v)
+	return _u
+}
+
+// SetNillableCidrIpv6 sets the "cidr_ipv6" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetNillableCidrIpv6(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetCidrIpv6(*v)
+	}
+	return _u
+}
+
+// ClearCidrIpv6 clears the value of the "cidr_ipv6" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) ClearCidrIpv6() *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearCidrIpv6()
+	return _u
+}
+
+// SetPrefixListID sets the "prefix_list_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetPrefixListID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetPrefixListID(v)
+	return _u
+}
+
+// SetNillablePrefixListID sets the "prefix_list_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetNillablePrefixListID(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetPrefixListID(*v)
+	}
+	return _u
+}
+
+// ClearPrefixListID clears the value of the "prefix_list_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) ClearPrefixListID() *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearPrefixListID()
+	return _u
+}
+
+// SetReferencedGroupID sets the "referenced_group_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetReferencedGroupID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetReferencedGroupID(v)
+	return _u
+}
+
+// SetNillableReferencedGroupID sets the "referenced_group_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetNillableReferencedGroupID(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetReferencedGroupID(*v)
+	}
+	return _u
+}
+
+// ClearReferencedGroupID clears the value of the "referenced_group_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) ClearReferencedGroupID() *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearReferencedGroupID()
+	return _u
+}
+
+// SetReferencedUserID sets the "referenced_user_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetReferencedUserID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetReferencedUserID(v)
+	return _u
+}
+
+// SetNillableReferencedUserID sets the "referenced_user_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetNillableReferencedUserID(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetReferencedUserID(*v)
+	}
+	return _u
+}
+
+// ClearReferencedUserID clears the value of the "referenced_user_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) ClearReferencedUserID() *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearReferencedUserID()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetDescription(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetNillableDescription(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) ClearDescription() *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSecurityGroupRuleArn sets the "security_group_rule_arn" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetSecurityGroupRuleArn(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetSecurityGroupRuleArn(v)
+	return _u
+}
+
+// SetNillableSecurityGroupRuleArn sets the "security_group_rule_arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetNillableSecurityGroupRuleArn(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetSecurityGroupRuleArn(*v)
+	}
+	return _u
+}
+
+// ClearSecurityGroupRuleArn clears the value of the "security_group_rule_arn" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) ClearSecurityGroupRuleArn() *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearSecurityGroupRuleArn()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) ClearTagsJSON() *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetAccountID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetRegion(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SetNillableRegion(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2SecurityGroupRuleMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) Mutation() *BronzeHistoryAWSEC2SecurityGroupRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2securitygrouprule.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2SecurityGroupRule.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2securitygrouprule.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2SecurityGroupRule.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2securitygrouprule.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2SecurityGroupRule.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2securitygrouprule.Table, bronzehistoryawsec2securitygrouprule.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2securitygrouprule.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupOwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldGroupOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.GroupOwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldGroupOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsEgress(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldIsEgress, field.TypeBool, value)
+	}
+	if _u.mutation.IsEgressCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldIsEgress, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IPProtocol(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldIPProtocol, field.TypeString, value)
+	}
+	if _u.mutation.IPProtocolCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldIPProtocol, field.TypeString)
+	}
+	if value, ok := _u.mutation.FromPort(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldFromPort, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedFromPort(); ok {
+		_spec.AddField(bronzehistoryawsec2securitygrouprule.FieldFromPort, field.TypeInt32, value)
+	}
+	if _u.mutation.FromPortCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldFromPort, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.ToPort(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldToPort, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedToPort(); ok {
+		_spec.AddField(bronzehistoryawsec2securitygrouprule.FieldToPort, field.TypeInt32, value)
+	}
+	if _u.mutation.ToPortCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldToPort, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.CidrIpv4(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldCidrIpv4, field.TypeString, value)
+	}
+	if _u.mutation.CidrIpv4Cleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldCidrIpv4, field.TypeString)
+	}
+	if value, ok := _u.mutation.CidrIpv6(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldCidrIpv6, field.TypeString, value)
+	}
+	if _u.mutation.CidrIpv6Cleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldCidrIpv6, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrefixListID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldPrefixListID, field.TypeString, value)
+	}
+	if _u.mutation.PrefixListIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldPrefixListID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReferencedGroupID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldReferencedGroupID, field.TypeString, value)
+	}
+	if _u.mutation.ReferencedGroupIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldReferencedGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReferencedUserID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldReferencedUserID, field.TypeString, value)
+	}
+	if _u.mutation.ReferencedUserIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldReferencedUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecurityGroupRuleArn(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldSecurityGroupRuleArn, field.TypeString, value)
+	}
+	if _u.mutation.SecurityGroupRuleArnCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldSecurityGroupRuleArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2securitygrouprule.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2SecurityGroupRule
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2securitygrouprule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne is the builder for updating a single BronzeHistoryAWSEC2SecurityGroupRule entity.
+type BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2SecurityGroupRuleMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetValidTo(v time.Time) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) ClearValidTo() *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetResourceID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetGroupID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetNillableGroupID(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) ClearGroupID() *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetGroupOwnerID sets the "group_owner_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetGroupOwnerID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetGroupOwnerID(v)
+	return _u
+}
+
+// SetNillableGroupOwnerID sets the "group_owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetNillableGroupOwnerID(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetGroupOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearGroupOwnerID clears the value of the "group_owner_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) ClearGroupOwnerID() *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearGroupOwnerID()
+	return _u
+}
+
+// SetIsEgress sets the "is_egress" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetIsEgress(v bool) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetIsEgress(v)
+	return _u
+}
+
+// SetNillableIsEgress sets the "is_egress" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetNillableIsEgress(v *bool) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetIsEgress(*v)
+	}
+	return _u
+}
+
+// ClearIsEgress clears the value of the "is_egress" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) ClearIsEgress() *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearIsEgress()
+	return _u
+}
+
+// SetIPProtocol sets the "ip_protocol" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetIPProtocol(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetIPProtocol(v)
+	return _u
+}
+
+// SetNillableIPProtocol sets the "ip_protocol" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetNillableIPProtocol(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetIPProtocol(*v)
+	}
+	return _u
+}
+
+// ClearIPProtocol clears the value of the "ip_protocol" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) ClearIPProtocol() *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearIPProtocol()
+	return _u
+}
+
+// SetFromPort sets the "from_port" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetFromPort(v int32) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ResetFromPort()
+	_u.mutation.SetFromPort(v)
+	return _u
+}
+
+// SetNillableFromPort sets the "from_port" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetNillableFromPort(v *int32) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetFromPort(*v)
+	}
+	return _u
+}
+
+// AddFromPort adds value to the "from_port" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) AddFromPort(v int32) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.AddFromPort(v)
+	return _u
+}
+
+// ClearFromPort clears the value of the "from_port" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) ClearFromPort() *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearFromPort()
+	return _u
+}
+
+// SetToPort sets the "to_port" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetToPort(v int32) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ResetToPort()
+	_u.mutation.SetToPort(v)
+	return _u
+}
+
+// SetNillableToPort sets the "to_port" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetNillableToPort(v *int32) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetToPort(*v)
+	}
+	return _u
+}
+
+// AddToPort adds value to the "to_port" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) AddToPort(v int32) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.AddToPort(v)
+	return _u
+}
+
+// ClearToPort clears the value of the "to_port" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) ClearToPort() *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearToPort()
+	return _u
+}
+
+// SetCidrIpv4 sets the "cidr_ipv4" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetCidrIpv4(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetCidrIpv4(v)
+	return _u
+}
+
+// SetNillableCidrIpv4 sets the "cidr_ipv4" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetNillableCidrIpv4(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetCidrIpv4(*v)
+	}
+	return _u
+}
+
+// ClearCidrIpv4 clears the value of the "cidr_ipv4" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) ClearCidrIpv4() *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearCidrIpv4()
+	return _u
+}
+
+// SetCidrIpv6 sets the "cidr_ipv6" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetCidrIpv6(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetCidrIpv6(v)
+	return _u
+}
+
+// SetNillableCidrIpv6 sets the "cidr_ipv6" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetNillableCidrIpv6(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetCidrIpv6(*v)
+	}
+	return _u
+}
+
+// ClearCidrIpv6 clears the value of the "cidr_ipv6" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) ClearCidrIpv6() *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearCidrIpv6()
+	return _u
+}
+
+// SetPrefixListID sets the "prefix_list_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetPrefixListID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetPrefixListID(v)
+	return _u
+}
+
+// SetNillablePrefixListID sets the "prefix_list_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetNillablePrefixListID(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetPrefixListID(*v)
+	}
+	return _u
+}
+
+// ClearPrefixListID clears the value of the "prefix_list_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) ClearPrefixListID() *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearPrefixListID()
+	return _u
+}
+
+// SetReferencedGroupID sets the "referenced_group_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetReferencedGroupID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetReferencedGroupID(v)
+	return _u
+}
+
+// SetNillableReferencedGroupID sets the "referenced_group_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetNillableReferencedGroupID(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetReferencedGroupID(*v)
+	}
+	return _u
+}
+
+// ClearReferencedGroupID clears the value of the "referenced_group_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) ClearReferencedGroupID() *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearReferencedGroupID()
+	return _u
+}
+
+// SetReferencedUserID sets the "referenced_user_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetReferencedUserID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetReferencedUserID(v)
+	return _u
+}
+
+// SetNillableReferencedUserID sets the "referenced_user_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetNillableReferencedUserID(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetReferencedUserID(*v)
+	}
+	return _u
+}
+
+// ClearReferencedUserID clears the value of the "referenced_user_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) ClearReferencedUserID() *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearReferencedUserID()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetDescription(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetNillableDescription(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) ClearDescription() *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSecurityGroupRuleArn sets the "security_group_rule_arn" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetSecurityGroupRuleArn(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetSecurityGroupRuleArn(v)
+	return _u
+}
+
+// SetNillableSecurityGroupRuleArn sets the "security_group_rule_arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetNillableSecurityGroupRuleArn(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetSecurityGroupRuleArn(*v)
+	}
+	return _u
+}
+
+// ClearSecurityGroupRuleArn clears the value of the "security_group_rule_arn" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) ClearSecurityGroupRuleArn() *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearSecurityGroupRuleArn()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) ClearTagsJSON() *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetAccountID(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetRegion(v string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SetNillableRegion(v *string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2SecurityGroupRuleMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) Mutation() *BronzeHistoryAWSEC2SecurityGroupRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2SecurityGroupRuleUpdate builder.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) Where(ps ...predicate.BronzeHistoryAWSEC2SecurityGroupRule) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) Select(field string, fields ...string) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryAWSEC2SecurityGroupRule entity.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) Save(ctx context.Context) (*BronzeHistoryAWSEC2SecurityGroupRule, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) SaveX(ctx context.Context) *BronzeHistoryAWSEC2SecurityGroupRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2securitygrouprule.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2SecurityGroupRule.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2securitygrouprule.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2SecurityGroupRule.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2securitygrouprule.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2SecurityGroupRule.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryAWSEC2SecurityGroupRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2securitygrouprule.Table, bronzehistoryawsec2securitygrouprule.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2securitygrouprule.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeHistoryAWSEC2SecurityGroupRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawsec2securitygrouprule.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryawsec2securitygrouprule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryawsec2securitygrouprule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupOwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldGroupOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.GroupOwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldGroupOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsEgress(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldIsEgress, field.TypeBool, value)
+	}
+	if _u.mutation.IsEgressCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldIsEgress, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IPProtocol(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldIPProtocol, field.TypeString, value)
+	}
+	if _u.mutation.IPProtocolCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldIPProtocol, field.TypeString)
+	}
+	if value, ok := _u.mutation.FromPort(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldFromPort, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedFromPort(); ok {
+		_spec.AddField(bronzehistoryawsec2securitygrouprule.FieldFromPort, field.TypeInt32, value)
+	}
+	if _u.mutation.FromPortCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldFromPort, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.ToPort(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldToPort, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedToPort(); ok {
+		_spec.AddField(bronzehistoryawsec2securitygrouprule.FieldToPort, field.TypeInt32, value)
+	}
+	if _u.mutation.ToPortCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldToPort, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.CidrIpv4(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldCidrIpv4, field.TypeString, value)
+	}
+	if _u.mutation.CidrIpv4Cleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldCidrIpv4, field.TypeString)
+	}
+	if value, ok := _u.mutation.CidrIpv6(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldCidrIpv6, field.TypeString, value)
+	}
+	if _u.mutation.CidrIpv6Cleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldCidrIpv6, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrefixListID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldPrefixListID, field.TypeString, value)
+	}
+	if _u.mutation.PrefixListIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldPrefixListID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReferencedGroupID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldReferencedGroupID, field.TypeString, value)
+	}
+	if _u.mutation.ReferencedGroupIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldReferencedGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReferencedUserID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldReferencedUserID, field.TypeString, value)
+	}
+	if _u.mutation.ReferencedUserIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldReferencedUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecurityGroupRuleArn(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldSecurityGroupRuleArn, field.TypeString, value)
+	}
+	if _u.mutation.SecurityGroupRuleArnCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldSecurityGroupRuleArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2securitygrouprule.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2securitygrouprule.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2securitygrouprule.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2SecurityGroupRule
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryAWSEC2SecurityGroupRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2securitygrouprule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,327 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2subnet"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryAWSEC2Subnet is the model entity for the BronzeHistoryAWSEC2Subnet schema.
+type BronzeHistoryAWSEC2Subnet struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze subnet by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// VpcID holds the value of the "vpc_id" field.
+	VpcID string `json:"vpc_id,omitempty"`
+	// CidrBlock holds the value of the "cidr_block" field.
+	CidrBlock string `json:"cidr_block,omitempty"`
+	// AvailabilityZone holds the value of the "availability_zone" field.
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+	// AvailabilityZoneID holds the value of the "availability_zone_id" field.
+	AvailabilityZoneID string `json:"availability_zone_id,omitempty"`
+	// State holds the value of the "state" field.
+	State string `json:"state,omitempty"`
+	// DefaultForAz holds the value of the "default_for_az" field.
+	DefaultForAz bool `json:"default_for_az,omitempty"`
+	// MapPublicIPOnLaunch holds the value of the "map_public_ip_on_launch" field.
+	MapPublicIPOnLaunch bool `json:"map_public_ip_on_launch,omitempty"`
+	// AssignIpv6AddressOnCreation holds the value of the "assign_ipv6_address_on_creation" field.
+	AssignIpv6AddressOnCreation bool `json:"assign_ipv6_address_on_creation,omitempty"`
+	// SubnetArn holds the value of the "subnet_arn" field.
+	SubnetArn string `json:"subnet_arn,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID string `json:"owner_id,omitempty"`
+	// Ipv6CidrBlockAssociationsJSON holds the value of the "ipv6_cidr_block_associations_json" field.
+	Ipv6CidrBlockAssociationsJSON json.RawMessage `json:"ipv6_cidr_block_associations_json,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Region holds the value of the "region" field.
+	Region       string `json:"region,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryAWSEC2Subnet) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsec2subnet.FieldIpv6CidrBlockAssociationsJSON, bronzehistoryawsec2subnet.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzehistoryawsec2subnet.FieldDefaultForAz, bronzehistoryawsec2subnet.FieldMapPublicIPOnLaunch, bronzehistoryawsec2subnet.FieldAssignIpv6AddressOnCreation:
+			values[i] = new(sql.NullBool)
+		case bronzehistoryawsec2subnet.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryawsec2subnet.FieldResourceID, bronzehistoryawsec2subnet.FieldName, bronzehistoryawsec2subnet.FieldVpcID, bronzehistoryawsec2subnet.FieldCidrBlock, bronzehistoryawsec2subnet.FieldAvailabilityZone, bronzehistoryawsec2subnet.FieldAvailabilityZoneID, bronzehistoryawsec2subnet.FieldState, bronzehistoryawsec2subnet.FieldSubnetArn, bronzehistoryawsec2subnet.FieldOwnerID, bronzehistoryawsec2subnet.FieldAccountID, bronzehistoryawsec2subnet.FieldRegion:
+			values[i] = new(sql.NullString)
+		case bronzehistoryawsec2subnet.FieldValidFrom, bronzehistoryawsec2subnet.FieldValidTo, bronzehistoryawsec2subnet.FieldCollectedAt, bronzehistoryawsec2subnet.FieldFirstCollectedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryAWSEC2Subnet fields.
+func (_m *BronzeHistoryAWSEC2Subnet) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsec2subnet.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryawsec2subnet.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryawsec2subnet.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryawsec2subnet.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryawsec2subnet.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryawsec2subnet.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryawsec2subnet.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzehistoryawsec2subnet.FieldVpcID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vpc_id", values[i])
+			} else if value.Valid {
+				_m.VpcID = value.String
+			}
+		case bronzehistoryawsec2subnet.FieldCidrBlock:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cidr_block", values[i])
+			} else if value.Valid {
+				_m.CidrBlock = value.String
+			}
+		case bronzehistoryawsec2subnet.FieldAvailabilityZone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field availability_zone", values[i])
+			} else if value.Valid {
+				_m.AvailabilityZone = value.String
+			}
+		case bronzehistoryawsec2subnet.FieldAvailabilityZoneID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field availability_zone_id", values[i])
+			} else if value.Valid {
+				_m.AvailabilityZoneID = value.String
+			}
+		case bronzehistoryawsec2subnet.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				_m.State = value.String
+			}
+		case bronzehistoryawsec2subnet.FieldDefaultForAz:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field default_for_az", values[i])
+			} else if value.Valid {
+				_m.DefaultForAz = value.Bool
+			}
+		case bronzehistoryawsec2subnet.FieldMapPublicIPOnLaunch:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field map_public_ip_on_launch", values[i])
+			} else if value.Valid {
+				_m.MapPublicIPOnLaunch = value.Bool
+			}
+		case bronzehistoryawsec2subnet.FieldAssignIpv6AddressOnCreation:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field assign_ipv6_address_on_creation", values[i])
+			} else if value.Valid {
+				_m.AssignIpv6AddressOnCreation = value.Bool
+			}
+		case bronzehistoryawsec2subnet.FieldSubnetArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subnet_arn", values[i])
+			} else if value.Valid {
+				_m.SubnetArn = value.String
+			}
+		case bronzehistoryawsec2subnet.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				_m.OwnerID = value.String
+			}
+		case bronzehistoryawsec2subnet.FieldIpv6CidrBlockAssociationsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ipv6_cidr_block_associations_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Ipv6CidrBlockAssociationsJSON); err != nil {
+					return fmt.Errorf("unmarshal field ipv6_cidr_block_associations_json: %w", err)
+				}
+			}
+		case bronzehistoryawsec2subnet.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzehistoryawsec2subnet.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzehistoryawsec2subnet.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryAWSEC2Subnet.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryAWSEC2Subnet) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryAWSEC2Subnet.
+// Note that you need to call BronzeHistoryAWSEC2Subnet.Unwrap() before calling this method if this BronzeHistoryAWSEC2Subnet
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryAWSEC2Subnet) Update() *BronzeHistoryAWSEC2SubnetUpdateOne {
+	return NewBronzeHistoryAWSEC2SubnetClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryAWSEC2Subnet entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryAWSEC2Subnet) Unwrap() *BronzeHistoryAWSEC2Subnet {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ec2: BronzeHistoryAWSEC2Subnet is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryAWSEC2Subnet) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryAWSEC2Subnet(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("vpc_id=")
+	builder.WriteString(_m.VpcID)
+	builder.WriteString(", ")
+	builder.WriteString("cidr_block=")
+	builder.WriteString(_m.CidrBlock)
+	builder.WriteString(", ")
+	builder.WriteString("availability_zone=")
+	builder.WriteString(_m.AvailabilityZone)
+	builder.WriteString(", ")
+	builder.WriteString("availability_zone_id=")
+	builder.WriteString(_m.AvailabilityZoneID)
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(_m.State)
+	builder.WriteString(", ")
+	builder.WriteString("default_for_az=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefaultForAz))
+	builder.WriteString(", ")
+	builder.WriteString("map_public_ip_on_launch=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MapPublicIPOnLaunch))
+	builder.WriteString(", ")
+	builder.WriteString("assign_ipv6_address_on_creation=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AssignIpv6AddressOnCreation))
+	builder.WriteString(", ")
+	builder.WriteString("subnet_arn=")
+	builder.WriteString(_m.SubnetArn)
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(_m.OwnerID)
+	builder.WriteString(", ")
+	builder.WriteString("ipv6_cidr_block_associations_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Ipv6CidrBlockAssociationsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryAWSEC2Subnets is a parsable slice of BronzeHistoryAWSEC2Subnet.
+type BronzeHistoryAWSEC2Subnets []*BronzeHistoryAWSEC2Subnet
@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryawsec2subnet
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzehistoryawsec2subnet type in the database.
+	Label = "bronze_history_awsec2subnet"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "history_id"
+	// FieldValidFrom holds the string denoting the valid_from field in the database.
+	FieldValidFrom = "valid_from"
+	// FieldValidTo holds the string denoting the valid_to field in the database.
+	FieldValidTo = "valid_to"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldResourceID holds the string denoting the resource_id field in the database.
+	FieldResourceID = "resource_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldVpcID holds the string denoting the vpc_id field in the database.
+	FieldVpcID = "vpc_id"
+	// FieldCidrBlock holds the string denoting the cidr_block field in the database.
+	FieldCidrBlock = "cidr_block"
+	// FieldAvailabilityZone holds the string denoting the availability_zone field in the database.
+	FieldAvailabilityZone = "availability_zone"
+	// FieldAvailabilityZoneID holds the string denoting the availability_zone_id field in the database.
+	FieldAvailabilityZoneID = "availability_zone_id"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldDefaultForAz holds the string denoting the default_for_az field in the database.
+	FieldDefaultForAz = "default_for_az"
+	// FieldMapPublicIPOnLaunch holds the string denoting the map_public_ip_on_launch field in the database.
+	FieldMapPublicIPOnLaunch = "map_public_ip_on_launch"
+	// FieldAssignIpv6AddressOnCreation holds the string denoting the assign_ipv6_address_on_creation field in the database.
+	FieldAssignIpv6AddressOnCreation = "assign_ipv6_address_on_creation"
+	// FieldSubnetArn holds the string denoting the subnet_arn field in the database.
+	FieldSubnetArn = "subnet_arn"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
+	// FieldIpv6CidrBlockAssociationsJSON holds the string denoting the ipv6_cidr_block_associations_json field in the database.
+	FieldIpv6CidrBlockAssociationsJSON = "ipv6_cidr_block_associations_json"
+	// FieldTagsJSON holds the string denoting the tags_json field in the database.
+	FieldTagsJSON = "tags_json"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
+	// Table holds the table name of the bronzehistoryawsec2subnet in the database.
+	Table = "aws_ec2_subnets_history"
+)
+
+// Columns holds all SQL columns for bronzehistoryawsec2subnet fields.
+var Columns = []string{
+	FieldID,
+	FieldValidFrom,
+	FieldValidTo,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldResourceID,
+	FieldName,
+	FieldVpcID,
+	FieldCidrBlock,
+	FieldAvailabilityZone,
+	FieldAvailabilityZoneID,
+	FieldState,
+	FieldDefaultForAz,
+	FieldMapPublicIPOnLaunch,
+	FieldAssignIpv6AddressOnCreation,
+	FieldSubnetArn,
+	FieldOwnerID,
+	FieldIpv6CidrBlockAssociationsJSON,
+	FieldTagsJSON,
+	FieldAccountID,
+	FieldRegion,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	ResourceIDValidator func(string) error
+	// AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	AccountIDValidator func(string) error
+	// RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	RegionValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BronzeHistoryAWSEC2Subnet queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByValidFrom orders the results by the valid_from field.
+func ByValidFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidFrom, opts...).ToFunc()
+}
+
+// ByValidTo orders the results by the valid_to field.
+func ByValidTo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidTo, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByResourceID orders the results by the resource_id field.
+func ByResourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByVpcID orders the results by the vpc_id field.
+func ByVpcID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVpcID, opts...).ToFunc()
+}
+
+// ByCidrBlock orders the results by the cidr_block field.
+func ByCidrBlock(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCidrBlock, opts...).ToFunc()
+}
+
+// ByAvailabilityZone orders the results by the availability_zone field.
+func ByAvailabilityZone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvailabilityZone, opts...).ToFunc()
+}
+
+// ByAvailabilityZoneID orders the results by the availability_zone_id field.
+func ByAvailabilityZoneID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvailabilityZoneID, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByDefaultForAz orders the results by the default_for_az field.
+func ByDefaultForAz(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultForAz, opts...).ToFunc()
+}
+
+// ByMapPublicIPOnLaunch orders the results by the map_public_ip_on_launch field.
+func ByMapPublicIPOnLaunch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMapPublicIPOnLaunch, opts...).ToFunc()
+}
+
+// ByAssignIpv6AddressOnCreation orders the results by the assign_ipv6_address_on_creation field.
+func ByAssignIpv6AddressOnCreation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssignIpv6AddressOnCreation, opts...).ToFunc()
+}
+
+// BySubnetArn orders the results by the subnet_arn field.
+func BySubnetArn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubnetArn, opts...).ToFunc()
+}
+
+// ByOwnerID orders the results by the owner_id field.
+func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByRegion orders the results by the region field.
+func ByRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegion, opts...).ToFunc()
+}
@@ -0,0 +1,1205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryawsec2subnet
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLTE(FieldID, id))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldValidTo, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldResourceID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldName, v))
+}
+
+// VpcID applies equality check predicate on the "vpc_id" field. It's identical to VpcIDEQ.
+func VpcID(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldVpcID, v))
+}
+
+// CidrBlock applies equality check predicate on the "cidr_block" field. It's identical to CidrBlockEQ.
+func CidrBlock(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldCidrBlock, v))
+}
+
+// AvailabilityZone applies equality check predicate on the "availability_zone" field. It's identical to AvailabilityZoneEQ.
+func AvailabilityZone(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneID applies equality check predicate on the "availability_zone_id" field. It's identical to AvailabilityZoneIDEQ.
+func AvailabilityZoneID(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldAvailabilityZoneID, v))
+}
+
+// State applies equality check predicate on the "state" field. It's identical to StateEQ.
+func State(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldState, v))
+}
+
+// DefaultForAz applies equality check predicate on the "default_for_az" field. It's identical to DefaultForAzEQ.
+func DefaultForAz(v bool) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldDefaultForAz, v))
+}
+
+// MapPublicIPOnLaunch applies equality check predicate on the "map_public_ip_on_launch" field. It's identical to MapPublicIPOnLaunchEQ.
+func MapPublicIPOnLaunch(v bool) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldMapPublicIPOnLaunch, v))
+}
+
+// AssignIpv6AddressOnCreation applies equality check predicate on the "assign_ipv6_address_on_creation" field. It's identical to AssignIpv6AddressOnCreationEQ.
+func AssignIpv6AddressOnCreation(v bool) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldAssignIpv6AddressOnCreation, v))
+}
+
+// SubnetArn applies equality check predicate on the "subnet_arn" field. It's identical to SubnetArnEQ.
+func SubnetArn(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldSubnetArn, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldRegion, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotNull(FieldValidTo))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContainsFold(FieldName, v))
+}
+
+// VpcIDEQ applies the EQ predicate on the "vpc_id" field.
+func VpcIDEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldVpcID, v))
+}
+
+// VpcIDNEQ applies the NEQ predicate on the "vpc_id" field.
+func VpcIDNEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNEQ(FieldVpcID, v))
+}
+
+// VpcIDIn applies the In predicate on the "vpc_id" field.
+func VpcIDIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIn(FieldVpcID, vs...))
+}
+
+// VpcIDNotIn applies the NotIn predicate on the "vpc_id" field.
+func VpcIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotIn(FieldVpcID, vs...))
+}
+
+// VpcIDGT applies the GT predicate on the "vpc_id" field.
+func VpcIDGT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGT(FieldVpcID, v))
+}
+
+// VpcIDGTE applies the GTE predicate on the "vpc_id" field.
+func VpcIDGTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGTE(FieldVpcID, v))
+}
+
+// VpcIDLT applies the LT predicate on the "vpc_id" field.
+func VpcIDLT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLT(FieldVpcID, v))
+}
+
+// VpcIDLTE applies the LTE predicate on the "vpc_id" field.
+func VpcIDLTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLTE(FieldVpcID, v))
+}
+
+// VpcIDContains applies the Contains predicate on the "vpc_id" field.
+func VpcIDContains(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContains(FieldVpcID, v))
+}
+
+// VpcIDHasPrefix applies the HasPrefix predicate on the "vpc_id" field.
+func VpcIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasPrefix(FieldVpcID, v))
+}
+
+// VpcIDHasSuffix applies the HasSuffix predicate on the "vpc_id" field.
+func VpcIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasSuffix(FieldVpcID, v))
+}
+
+// VpcIDIsNil applies the IsNil predicate on the "vpc_id" field.
+func VpcIDIsNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIsNull(FieldVpcID))
+}
+
+// VpcIDNotNil applies the NotNil predicate on the "vpc_id" field.
+func VpcIDNotNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotNull(FieldVpcID))
+}
+
+// VpcIDEqualFold applies the EqualFold predicate on the "vpc_id" field.
+func VpcIDEqualFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEqualFold(FieldVpcID, v))
+}
+
+// VpcIDContainsFold applies the ContainsFold predicate on the "vpc_id" field.
+func VpcIDContainsFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContainsFold(FieldVpcID, v))
+}
+
+// CidrBlockEQ applies the EQ predicate on the "cidr_block" field.
+func CidrBlockEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldCidrBlock, v))
+}
+
+// CidrBlockNEQ applies the NEQ predicate on the "cidr_block" field.
+func CidrBlockNEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNEQ(FieldCidrBlock, v))
+}
+
+// CidrBlockIn applies the In predicate on the "cidr_block" field.
+func CidrBlockIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIn(FieldCidrBlock, vs...))
+}
+
+// CidrBlockNotIn applies the NotIn predicate on the "cidr_block" field.
+func CidrBlockNotIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotIn(FieldCidrBlock, vs...))
+}
+
+// CidrBlockGT applies the GT predicate on the "cidr_block" field.
+func CidrBlockGT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGT(FieldCidrBlock, v))
+}
+
+// CidrBlockGTE applies the GTE predicate on the "cidr_block" field.
+func CidrBlockGTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGTE(FieldCidrBlock, v))
+}
+
+// CidrBlockLT applies the LT predicate on the "cidr_block" field.
+func CidrBlockLT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLT(FieldCidrBlock, v))
+}
+
+// CidrBlockLTE applies the LTE predicate on the "cidr_block" field.
+func CidrBlockLTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLTE(FieldCidrBlock, v))
+}
+
+// CidrBlockContains applies the Contains predicate on the "cidr_block" field.
+func CidrBlockContains(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContains(FieldCidrBlock, v))
+}
+
+// CidrBlockHasPrefix applies the HasPrefix predicate on the "cidr_block" field.
+func CidrBlockHasPrefix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasPrefix(FieldCidrBlock, v))
+}
+
+// CidrBlockHasSuffix applies the HasSuffix predicate on the "cidr_block" field.
+func CidrBlockHasSuffix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasSuffix(FieldCidrBlock, v))
+}
+
+// CidrBlockIsNil applies the IsNil predicate on the "cidr_block" field.
+func CidrBlockIsNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIsNull(FieldCidrBlock))
+}
+
+// CidrBlockNotNil applies the NotNil predicate on the "cidr_block" field.
+func CidrBlockNotNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotNull(FieldCidrBlock))
+}
+
+// CidrBlockEqualFold applies the EqualFold predicate on the "cidr_block" field.
+func CidrBlockEqualFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEqualFold(FieldCidrBlock, v))
+}
+
+// CidrBlockContainsFold applies the ContainsFold predicate on the "cidr_block" field.
+func CidrBlockContainsFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContainsFold(FieldCidrBlock, v))
+}
+
+// AvailabilityZoneEQ applies the EQ predicate on the "availability_zone" field.
+func AvailabilityZoneEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneNEQ applies the NEQ predicate on the "availability_zone" field.
+func AvailabilityZoneNEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNEQ(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneIn applies the In predicate on the "availability_zone" field.
+func AvailabilityZoneIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIn(FieldAvailabilityZone, vs...))
+}
+
+// AvailabilityZoneNotIn applies the NotIn predicate on the "availability_zone" field.
+func AvailabilityZoneNotIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotIn(FieldAvailabilityZone, vs...))
+}
+
+// AvailabilityZoneGT applies the GT predicate on the "availability_zone" field.
+func AvailabilityZoneGT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGT(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneGTE applies the GTE predicate on the "availability_zone" field.
+func AvailabilityZoneGTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGTE(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneLT applies the LT predicate on the "availability_zone" field.
+func AvailabilityZoneLT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLT(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneLTE applies the LTE predicate on the "availability_zone" field.
+func AvailabilityZoneLTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLTE(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneContains applies the Contains predicate on the "availability_zone" field.
+func AvailabilityZoneContains(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContains(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneHasPrefix applies the HasPrefix predicate on the "availability_zone" field.
+func AvailabilityZoneHasPrefix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasPrefix(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneHasSuffix applies the HasSuffix predicate on the "availability_zone" field.
+func AvailabilityZoneHasSuffix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasSuffix(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneIsNil applies the IsNil predicate on the "availability_zone" field.
+func AvailabilityZoneIsNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIsNull(FieldAvailabilityZone))
+}
+
+// AvailabilityZoneNotNil applies the NotNil predicate on the "availability_zone" field.
+func AvailabilityZoneNotNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotNull(FieldAvailabilityZone))
+}
+
+// AvailabilityZoneEqualFold applies the EqualFold predicate on the "availability_zone" field.
+func AvailabilityZoneEqualFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEqualFold(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneContainsFold applies the ContainsFold predicate on the "availability_zone" field.
+func AvailabilityZoneContainsFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContainsFold(FieldAvailabilityZone, v))
+}
+
+// AvailabilityZoneIDEQ applies the EQ predicate on the "availability_zone_id" field.
+func AvailabilityZoneIDEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldAvailabilityZoneID, v))
+}
+
+// AvailabilityZoneIDNEQ applies the NEQ predicate on the "availability_zone_id" field.
+func AvailabilityZoneIDNEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNEQ(FieldAvailabilityZoneID, v))
+}
+
+// AvailabilityZoneIDIn applies the In predicate on the "availability_zone_id" field.
+func AvailabilityZoneIDIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIn(FieldAvailabilityZoneID, vs...))
+}
+
+// AvailabilityZoneIDNotIn applies the NotIn predicate on the "availability_zone_id" field.
+func AvailabilityZoneIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotIn(FieldAvailabilityZoneID, vs...))
+}
+
+// AvailabilityZoneIDGT applies the GT predicate on the "availability_zone_id" field.
+func AvailabilityZoneIDGT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGT(FieldAvailabilityZoneID, v))
+}
+
+// AvailabilityZoneIDGTE applies the GTE predicate on the "availability_zone_id" field.
+func AvailabilityZoneIDGTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGTE(FieldAvailabilityZoneID, v))
+}
+
+// AvailabilityZoneIDLT applies the LT predicate on the "availability_zone_id" field.
+func AvailabilityZoneIDLT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLT(FieldAvailabilityZoneID, v))
+}
+
+// AvailabilityZoneIDLTE applies the LTE predicate on the "availability_zone_id" field.
+func AvailabilityZoneIDLTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLTE(FieldAvailabilityZoneID, v))
+}
+
+// AvailabilityZoneIDContains applies the Contains predicate on the "availability_zone_id" field.
+func AvailabilityZoneIDContains(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContains(FieldAvailabilityZoneID, v))
+}
+
+// AvailabilityZoneIDHasPrefix applies the HasPrefix predicate on the "availability_zone_id" field.
+func AvailabilityZoneIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasPrefix(FieldAvailabilityZoneID, v))
+}
+
+// AvailabilityZoneIDHasSuffix applies the HasSuffix predicate on the "availability_zone_id" field.
+func AvailabilityZoneIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasSuffix(FieldAvailabilityZoneID, v))
+}
+
+// AvailabilityZoneIDIsNil applies the IsNil predicate on the "availability_zone_id" field.
+func AvailabilityZoneIDIsNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIsNull(FieldAvailabilityZoneID))
+}
+
+// AvailabilityZoneIDNotNil applies the NotNil predicate on the "availability_zone_id" field.
+func AvailabilityZoneIDNotNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotNull(FieldAvailabilityZoneID))
+}
+
+// AvailabilityZoneIDEqualFold applies the EqualFold predicate on the "availability_zone_id" field.
+func AvailabilityZoneIDEqualFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEqualFold(FieldAvailabilityZoneID, v))
+}
+
+// AvailabilityZoneIDContainsFold applies the ContainsFold predicate on the "availability_zone_id" field.
+func AvailabilityZoneIDContainsFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContainsFold(FieldAvailabilityZoneID, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotIn(FieldState, vs...))
+}
+
+// StateGT applies the GT predicate on the "state" field.
+func StateGT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGT(FieldState, v))
+}
+
+// StateGTE applies the GTE predicate on the "state" field.
+func StateGTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGTE(FieldState, v))
+}
+
+// StateLT applies the LT predicate on the "state" field.
+func StateLT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLT(FieldState, v))
+}
+
+// StateLTE applies the LTE predicate on the "state" field.
+func StateLTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLTE(FieldState, v))
+}
+
+// StateContains applies the Contains predicate on the "state" field.
+func StateContains(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContains(FieldState, v))
+}
+
+// StateHasPrefix applies the HasPrefix predicate on the "state" field.
+func StateHasPrefix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasPrefix(FieldState, v))
+}
+
+// StateHasSuffix applies the HasSuffix predicate on the "state" field.
+func StateHasSuffix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasSuffix(FieldState, v))
+}
+
+// StateIsNil applies the IsNil predicate on the "state" field.
+func StateIsNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIsNull(FieldState))
+}
+
+// StateNotNil applies the NotNil predicate on the "state" field.
+func StateNotNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotNull(FieldState))
+}
+
+// StateEqualFold applies the EqualFold predicate on the "state" field.
+func StateEqualFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEqualFold(FieldState, v))
+}
+
+// StateContainsFold applies the ContainsFold predicate on the "state" field.
+func StateContainsFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContainsFold(FieldState, v))
+}
+
+// DefaultForAzEQ applies the EQ predicate on the "default_for_az" field.
+func DefaultForAzEQ(v bool) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldDefaultForAz, v))
+}
+
+// DefaultForAzNEQ applies the NEQ predicate on the "default_for_az" field.
+func DefaultForAzNEQ(v bool) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNEQ(FieldDefaultForAz, v))
+}
+
+// DefaultForAzIsNil applies the IsNil predicate on the "default_for_az" field.
+func DefaultForAzIsNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIsNull(FieldDefaultForAz))
+}
+
+// DefaultForAzNotNil applies the NotNil predicate on the "default_for_az" field.
+func DefaultForAzNotNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotNull(FieldDefaultForAz))
+}
+
+// MapPublicIPOnLaunchEQ applies the EQ predicate on the "map_public_ip_on_launch" field.
+func MapPublicIPOnLaunchEQ(v bool) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldMapPublicIPOnLaunch, v))
+}
+
+// MapPublicIPOnLaunchNEQ applies the NEQ predicate on the "map_public_ip_on_launch" field.
+func MapPublicIPOnLaunchNEQ(v bool) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNEQ(FieldMapPublicIPOnLaunch, v))
+}
+
+// MapPublicIPOnLaunchIsNil applies the IsNil predicate on the "map_public_ip_on_launch" field.
+func MapPublicIPOnLaunchIsNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIsNull(FieldMapPublicIPOnLaunch))
+}
+
+// MapPublicIPOnLaunchNotNil applies the NotNil predicate on the "map_public_ip_on_launch" field.
+func MapPublicIPOnLaunchNotNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotNull(FieldMapPublicIPOnLaunch))
+}
+
+// AssignIpv6AddressOnCreationEQ applies the EQ predicate on the "assign_ipv6_address_on_creation" field.
+func AssignIpv6AddressOnCreationEQ(v bool) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldAssignIpv6AddressOnCreation, v))
+}
+
+// AssignIpv6AddressOnCreationNEQ applies the NEQ predicate on the "assign_ipv6_address_on_creation" field.
+func AssignIpv6AddressOnCreationNEQ(v bool) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNEQ(FieldAssignIpv6AddressOnCreation, v))
+}
+
+// AssignIpv6AddressOnCreationIsNil applies the IsNil predicate on the "assign_ipv6_address_on_creation" field.
+func AssignIpv6AddressOnCreationIsNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIsNull(FieldAssignIpv6AddressOnCreation))
+}
+
+// AssignIpv6AddressOnCreationNotNil applies the NotNil predicate on the "assign_ipv6_address_on_creation" field.
+func AssignIpv6AddressOnCreationNotNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotNull(FieldAssignIpv6AddressOnCreation))
+}
+
+// SubnetArnEQ applies the EQ predicate on the "subnet_arn" field.
+func SubnetArnEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldSubnetArn, v))
+}
+
+// SubnetArnNEQ applies the NEQ predicate on the "subnet_arn" field.
+func SubnetArnNEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNEQ(FieldSubnetArn, v))
+}
+
+// SubnetArnIn applies the In predicate on the "subnet_arn" field.
+func SubnetArnIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIn(FieldSubnetArn, vs...))
+}
+
+// SubnetArnNotIn applies the NotIn predicate on the "subnet_arn" field.
+func SubnetArnNotIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotIn(FieldSubnetArn, vs...))
+}
+
+// SubnetArnGT applies the GT predicate on the "subnet_arn" field.
+func SubnetArnGT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGT(FieldSubnetArn, v))
+}
+
+// SubnetArnGTE applies the GTE predicate on the "subnet_arn" field.
+func SubnetArnGTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGTE(FieldSubnetArn, v))
+}
+
+// SubnetArnLT applies the LT predicate on the "subnet_arn" field.
+func SubnetArnLT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLT(FieldSubnetArn, v))
+}
+
+// SubnetArnLTE applies the LTE predicate on the "subnet_arn" field.
+func SubnetArnLTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLTE(FieldSubnetArn, v))
+}
+
+// SubnetArnContains applies the Contains predicate on the "subnet_arn" field.
+func SubnetArnContains(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContains(FieldSubnetArn, v))
+}
+
+// SubnetArnHasPrefix applies the HasPrefix predicate on the "subnet_arn" field.
+func SubnetArnHasPrefix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasPrefix(FieldSubnetArn, v))
+}
+
+// SubnetArnHasSuffix applies the HasSuffix predicate on the "subnet_arn" field.
+func SubnetArnHasSuffix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasSuffix(FieldSubnetArn, v))
+}
+
+// SubnetArnIsNil applies the IsNil predicate on the "subnet_arn" field.
+func SubnetArnIsNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIsNull(FieldSubnetArn))
+}
+
+// SubnetArnNotNil applies the NotNil predicate on the "subnet_arn" field.
+func SubnetArnNotNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotNull(FieldSubnetArn))
+}
+
+// SubnetArnEqualFold applies the EqualFold predicate on the "subnet_arn" field.
+func SubnetArnEqualFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEqualFold(FieldSubnetArn, v))
+}
+
+// SubnetArnContainsFold applies the ContainsFold predicate on the "subnet_arn" field.
+func SubnetArnContainsFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContainsFold(FieldSubnetArn, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContains(FieldOwnerID, v))
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasPrefix(FieldOwnerID, v))
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasSuffix(FieldOwnerID, v))
+}
+
+// OwnerIDIsNil applies the IsNil predicate on the "owner_id" field.
+func OwnerIDIsNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIsNull(FieldOwnerID))
+}
+
+// OwnerIDNotNil applies the NotNil predicate on the "owner_id" field.
+func OwnerIDNotNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotNull(FieldOwnerID))
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEqualFold(FieldOwnerID, v))
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContainsFold(FieldOwnerID, v))
+}
+
+// Ipv6CidrBlockAssociationsJSONIsNil applies the IsNil predicate on the "ipv6_cidr_block_associations_json" field.
+func Ipv6CidrBlockAssociationsJSONIsNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIsNull(FieldIpv6CidrBlockAssociationsJSON))
+}
+
+// Ipv6CidrBlockAssociationsJSONNotNil applies the NotNil predicate on the "ipv6_cidr_block_associations_json" field.
+func Ipv6CidrBlockAssociationsJSONNotNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotNull(FieldIpv6CidrBlockAssociationsJSON))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeHistoryAWSEC2Subnet) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeHistoryAWSEC2Subnet) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeHistoryAWSEC2Subnet) predicate.BronzeHistoryAWSEC2Subnet {
+	return predicate.BronzeHistoryAWSEC2Subnet(sql.NotPredicates(p))
+}
@@ -0,0 +1,1139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2subnet"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2SubnetUpdate is the builder for updating BronzeHistoryAWSEC2Subnet entities.
+type BronzeHistoryAWSEC2SubnetUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2SubnetMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2SubnetUpdate builder.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) Where(ps ...predicate.BronzeHistoryAWSEC2Subnet) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetValidTo(v time.Time) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) ClearValidTo() *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetResourceID(v string) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetName(v string) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetNillableName(v *string) *BronzeHistoryAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) ClearName() *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetVpcID(v string) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetNillableVpcID(v *string) *BronzeHistoryAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) ClearVpcID() *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetCidrBlock sets the "cidr_block" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetCidrBlock(v string) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.SetCidrBlock(v)
+	return _u
+}
+
+// SetNillableCidrBlock sets the "cidr_block" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetNillableCidrBlock(v *string) *BronzeHistoryAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetCidrBlock(*v)
+	}
+	return _u
+}
+
+// ClearCidrBlock clears the value of the "cidr_block" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) ClearCidrBlock() *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.ClearCidrBlock()
+	return _u
+}
+
+// SetAvailabilityZone sets the "availability_zone" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetAvailabilityZone(v string) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.SetAvailabilityZone(v)
+	return _u
+}
+
+// SetNillableAvailabilityZone sets the "availability_zone" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetNillableAvailabilityZone(v *string) *BronzeHistoryAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetAvailabilityZone(*v)
+	}
+	return _u
+}
+
+// ClearAvailabilityZone clears the value of the "availability_zone" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) ClearAvailabilityZone() *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.ClearAvailabilityZone()
+	return _u
+}
+
+// SetAvailabilityZoneID sets the "availability_zone_id" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetAvailabilityZoneID(v string) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.SetAvailabilityZoneID(v)
+	return _u
+}
+
+// SetNillableAvailabilityZoneID sets the "availability_zone_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetNillableAvailabilityZoneID(v *string) *BronzeHistoryAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetAvailabilityZoneID(*v)
+	}
+	return _u
+}
+
+// ClearAvailabilityZoneID clears the value of the "availability_zone_id" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) ClearAvailabilityZoneID() *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.ClearAvailabilityZoneID()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetState(v string) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetNillableState(v *string) *BronzeHistoryAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) ClearState() *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetDefaultForAz sets the "default_for_az" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetDefaultForAz(v bool) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.SetDefaultForAz(v)
+	return _u
+}
+
+// SetNillableDefaultForAz sets the "default_for_az" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetNillableDefaultForAz(v *bool) *BronzeHistoryAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetDefaultForAz(*v)
+	}
+	return _u
+}
+
+// ClearDefaultForAz clears the value of the "default_for_az" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) ClearDefaultForAz() *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.ClearDefaultForAz()
+	return _u
+}
+
+// SetMapPublicIPOnLaunch sets the "map_public_ip_on_launch" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetMapPublicIPOnLaunch(v bool) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.SetMapPublicIPOnLaunch(v)
+	return _u
+}
+
+// SetNillableMapPublicIPOnLaunch sets the "map_public_ip_on_launch" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetNillableMapPublicIPOnLaunch(v *bool) *BronzeHistoryAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetMapPublicIPOnLaunch(*v)
+	}
+	return _u
+}
+
+// ClearMapPublicIPOnLaunch clears the value of the "map_public_ip_on_launch" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) ClearMapPublicIPOnLaunch() *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.ClearMapPublicIPOnLaunch()
+	return _u
+}
+
+// SetAssignIpv6AddressOnCreation sets the "assign_ipv6_address_on_creation" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetAssignIpv6AddressOnCreation(v bool) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.SetAssignIpv6AddressOnCreation(v)
+	return _u
+}
+
+// SetNillableAssignIpv6AddressOnCreation sets the "assign_ipv6_address_on_creation" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetNillableAssignIpv6AddressOnCreation(v *bool) *BronzeHistoryAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetAssignIpv6AddressOnCreation(*v)
+	}
+	return _u
+}
+
+// ClearAssignIpv6AddressOnCreation clears the value of the "assign_ipv6_address_on_creation" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) ClearAssignIpv6AddressOnCreation() *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.ClearAssignIpv6AddressOnCreation()
+	return _u
+}
+
+// SetSubnetArn sets the "subnet_arn" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetSubnetArn(v string) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.SetSubnetArn(v)
+	return _u
+}
+
+// SetNillableSubnetArn sets the "subnet_arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetNillableSubnetArn(v *string) *BronzeHistoryAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetSubnetArn(*v)
+	}
+	return _u
+}
+
+// ClearSubnetArn clears the value of the "subnet_arn" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) ClearSubnetArn() *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.ClearSubnetArn()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetOwnerID(v string) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetNillableOwnerID(v *string) *BronzeHistoryAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) ClearOwnerID() *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetIpv6CidrBlockAssociationsJSON sets the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetIpv6CidrBlockAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.SetIpv6CidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// AppendIpv6CidrBlockAssociationsJSON appends value to the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) AppendIpv6CidrBlockAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.AppendIpv6CidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// ClearIpv6CidrBlockAssociationsJSON clears the value of the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) ClearIpv6CidrBlockAssociationsJSON() *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.ClearIpv6CidrBlockAssociationsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) ClearTagsJSON() *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetAccountID(v string) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetRegion(v string) *BronzeHistoryAWSEC2SubnetUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SetNillableRegion(v *string) *BronzeHistoryAWSEC2SubnetUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2SubnetMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) Mutation() *BronzeHistoryAWSEC2SubnetMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2subnet.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2Subnet.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2subnet.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2Subnet.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2subnet.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2Subnet.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2SubnetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2subnet.Table, bronzehistoryawsec2subnet.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2subnet.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CidrBlock(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldCidrBlock, field.TypeString, value)
+	}
+	if _u.mutation.CidrBlockCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldCidrBlock, field.TypeString)
+	}
+	if value, ok := _u.mutation.AvailabilityZone(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldAvailabilityZone, field.TypeString, value)
+	}
+	if _u.mutation.AvailabilityZoneCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldAvailabilityZone, field.TypeString)
+	}
+	if value, ok := _u.mutation.AvailabilityZoneID(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldAvailabilityZoneID, field.TypeString, value)
+	}
+	if _u.mutation.AvailabilityZoneIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldAvailabilityZoneID, field.TypeString)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldState, field.TypeString, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldState, field.TypeString)
+	}
+	if value, ok := _u.mutation.DefaultForAz(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldDefaultForAz, field.TypeBool, value)
+	}
+	if _u.mutation.DefaultForAzCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldDefaultForAz, field.TypeBool)
+	}
+	if value, ok := _u.mutation.MapPublicIPOnLaunch(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldMapPublicIPOnLaunch, field.TypeBool, value)
+	}
+	if _u.mutation.MapPublicIPOnLaunchCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldMapPublicIPOnLaunch, field.TypeBool)
+	}
+	if value, ok := _u.mutation.AssignIpv6AddressOnCreation(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldAssignIpv6AddressOnCreation, field.TypeBool, value)
+	}
+	if _u.mutation.AssignIpv6AddressOnCreationCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldAssignIpv6AddressOnCreation, field.TypeBool)
+	}
+	if value, ok := _u.mutation.SubnetArn(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldSubnetArn, field.TypeString, value)
+	}
+	if _u.mutation.SubnetArnCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldSubnetArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Ipv6CidrBlockAssociationsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldIpv6CidrBlockAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIpv6CidrBlockAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2subnet.FieldIpv6CidrBlockAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.Ipv6CidrBlockAssociationsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldIpv6CidrBlockAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2subnet.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2Subnet
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2subnet.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryAWSEC2SubnetUpdateOne is the builder for updating a single BronzeHistoryAWSEC2Subnet entity.
+type BronzeHistoryAWSEC2SubnetUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2SubnetMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetValidTo(v time.Time) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) ClearValidTo() *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetResourceID(v string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetName(v string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetNillableName(v *string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) ClearName() *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVpcID sets the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetVpcID(v string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.SetVpcID(v)
+	return _u
+}
+
+// SetNillableVpcID sets the "vpc_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetNillableVpcID(v *string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetVpcID(*v)
+	}
+	return _u
+}
+
+// ClearVpcID clears the value of the "vpc_id" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) ClearVpcID() *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearVpcID()
+	return _u
+}
+
+// SetCidrBlock sets the "cidr_block" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetCidrBlock(v string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.SetCidrBlock(v)
+	return _u
+}
+
+// SetNillableCidrBlock sets the "cidr_block" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetNillableCidrBlock(v *string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetCidrBlock(*v)
+	}
+	return _u
+}
+
+// ClearCidrBlock clears the value of the "cidr_block" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) ClearCidrBlock() *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearCidrBlock()
+	return _u
+}
+
+// SetAvailabilityZone sets the "availability_zone" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetAvailabilityZone(v string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.SetAvailabilityZone(v)
+	return _u
+}
+
+// SetNillableAvailabilityZone sets the "availability_zone" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetNillableAvailabilityZone(v *string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetAvailabilityZone(*v)
+	}
+	return _u
+}
+
+// ClearAvailabilityZone clears the value of the "availability_zone" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) ClearAvailabilityZone() *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearAvailabilityZone()
+	return _u
+}
+
+// SetAvailabilityZoneID sets the "availability_zone_id" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetAvailabilityZoneID(v string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.SetAvailabilityZoneID(v)
+	return _u
+}
+
+// SetNillableAvailabilityZoneID sets the "availability_zone_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetNillableAvailabilityZoneID(v *string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetAvailabilityZoneID(*v)
+	}
+	return _u
+}
+
+// ClearAvailabilityZoneID clears the value of the "availability_zone_id" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) ClearAvailabilityZoneID() *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearAvailabilityZoneID()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetState(v string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetNillableState(v *string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) ClearState() *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetDefaultForAz sets the "default_for_az" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetDefaultForAz(v bool) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.SetDefaultForAz(v)
+	return _u
+}
+
+// SetNillableDefaultForAz sets the "default_for_az" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetNillableDefaultForAz(v *bool) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetDefaultForAz(*v)
+	}
+	return _u
+}
+
+// ClearDefaultForAz clears the value of the "default_for_az" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) ClearDefaultForAz() *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearDefaultForAz()
+	return _u
+}
+
+// SetMapPublicIPOnLaunch sets the "map_public_ip_on_launch" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetMapPublicIPOnLaunch(v bool) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.SetMapPublicIPOnLaunch(v)
+	return _u
+}
+
+// SetNillableMapPublicIPOnLaunch sets the "map_public_ip_on_launch" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetNillableMapPublicIPOnLaunch(v *bool) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetMapPublicIPOnLaunch(*v)
+	}
+	return _u
+}
+
+// ClearMapPublicIPOnLaunch clears the value of the "map_public_ip_on_launch" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) ClearMapPublicIPOnLaunch() *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearMapPublicIPOnLaunch()
+	return _u
+}
+
+// SetAssignIpv6AddressOnCreation sets the "assign_ipv6_address_on_creation" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetAssignIpv6AddressOnCreation(v bool) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.SetAssignIpv6AddressOnCreation(v)
+	return _u
+}
+
+// SetNillableAssignIpv6AddressOnCreation sets the "assign_ipv6_address_on_creation" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetNillableAssignIpv6AddressOnCreation(v *bool) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetAssignIpv6AddressOnCreation(*v)
+	}
+	return _u
+}
+
+// ClearAssignIpv6AddressOnCreation clears the value of the "assign_ipv6_address_on_creation" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) ClearAssignIpv6AddressOnCreation() *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearAssignIpv6AddressOnCreation()
+	return _u
+}
+
+// SetSubnetArn sets the "subnet_arn" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetSubnetArn(v string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.SetSubnetArn(v)
+	return _u
+}
+
+// SetNillableSubnetArn sets the "subnet_arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetNillableSubnetArn(v *string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetSubnetArn(*v)
+	}
+	return _u
+}
+
+// ClearSubnetArn clears the value of the "subnet_arn" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) ClearSubnetArn() *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearSubnetArn()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetOwnerID(v string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetNillableOwnerID(v *string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) ClearOwnerID() *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetIpv6CidrBlockAssociationsJSON sets the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetIpv6CidrBlockAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.SetIpv6CidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// AppendIpv6CidrBlockAssociationsJSON appends value to the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) AppendIpv6CidrBlockAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.AppendIpv6CidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// ClearIpv6CidrBlockAssociationsJSON clears the value of the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) ClearIpv6CidrBlockAssociationsJSON() *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearIpv6CidrBlockAssociationsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) ClearTagsJSON() *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetAccountID(v string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetRegion(v string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SetNillableRegion(v *string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2SubnetMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) Mutation() *BronzeHistoryAWSEC2SubnetMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2SubnetUpdate builder.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) Where(ps ...predicate.BronzeHistoryAWSEC2Subnet) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) Select(field string, fields ...string) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryAWSEC2Subnet entity.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) Save(ctx context.Context) (*BronzeHistoryAWSEC2Subnet, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) SaveX(ctx context.Context) *BronzeHistoryAWSEC2Subnet {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2subnet.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2Subnet.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2subnet.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2Subnet.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2subnet.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2Subnet.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2SubnetUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryAWSEC2Subnet, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2subnet.Table, bronzehistoryawsec2subnet.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2subnet.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeHistoryAWSEC2Subnet.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawsec2subnet.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryawsec2subnet.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryawsec2subnet.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.VpcID(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldVpcID, field.TypeString, value)
+	}
+	if _u.mutation.VpcIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldVpcID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CidrBlock(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldCidrBlock, field.TypeString, value)
+	}
+	if _u.mutation.CidrBlockCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldCidrBlock, field.TypeString)
+	}
+	if value, ok := _u.mutation.AvailabilityZone(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldAvailabilityZone, field.TypeString, value)
+	}
+	if _u.mutation.AvailabilityZoneCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldAvailabilityZone, field.TypeString)
+	}
+	if value, ok := _u.mutation.AvailabilityZoneID(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldAvailabilityZoneID, field.TypeString, value)
+	}
+	if _u.mutation.AvailabilityZoneIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldAvailabilityZoneID, field.TypeString)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldState, field.TypeString, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldState, field.TypeString)
+	}
+	if value, ok := _u.mutation.DefaultForAz(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldDefaultForAz, field.TypeBool, value)
+	}
+	if _u.mutation.DefaultForAzCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldDefaultForAz, field.TypeBool)
+	}
+	if value, ok := _u.mutation.MapPublicIPOnLaunch(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldMapPublicIPOnLaunch, field.TypeBool, value)
+	}
+	if _u.mutation.MapPublicIPOnLaunchCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldMapPublicIPOnLaunch, field.TypeBool)
+	}
+	if value, ok := _u.mutation.AssignIpv6AddressOnCreation(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldAssignIpv6AddressOnCreation, field.TypeBool, value)
+	}
+	if _u.mutation.AssignIpv6AddressOnCreationCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldAssignIpv6AddressOnCreation, field.TypeBool)
+	}
+	if value, ok := _u.mutation.SubnetArn(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldSubnetArn, field.TypeString, value)
+	}
+	if _u.mutation.SubnetArnCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldSubnetArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Ipv6CidrBlockAssociationsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldIpv6CidrBlockAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIpv6CidrBlockAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2subnet.FieldIpv6CidrBlockAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.Ipv6CidrBlockAssociationsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldIpv6CidrBlockAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2subnet.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2subnet.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2subnet.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2Subnet
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryAWSEC2Subnet{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2subnet.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
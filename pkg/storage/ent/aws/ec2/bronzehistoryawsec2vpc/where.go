@@ -0,0 +1,1005 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryawsec2vpc
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLTE(FieldID, id))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldValidTo, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldResourceID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldName, v))
+}
+
+// CidrBlock applies equality check predicate on the "cidr_block" field. It's identical to CidrBlockEQ.
+func CidrBlock(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldCidrBlock, v))
+}
+
+// State applies equality check predicate on the "state" field. It's identical to StateEQ.
+func State(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldState, v))
+}
+
+// IsDefault applies equality check predicate on the "is_default" field. It's identical to IsDefaultEQ.
+func IsDefault(v bool) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// InstanceTenancy applies equality check predicate on the "instance_tenancy" field. It's identical to InstanceTenancyEQ.
+func InstanceTenancy(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldInstanceTenancy, v))
+}
+
+// DhcpOptionsID applies equality check predicate on the "dhcp_options_id" field. It's identical to DhcpOptionsIDEQ.
+func DhcpOptionsID(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldDhcpOptionsID, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldRegion, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotNull(FieldValidTo))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldContainsFold(FieldName, v))
+}
+
+// CidrBlockEQ applies the EQ predicate on the "cidr_block" field.
+func CidrBlockEQ(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldCidrBlock, v))
+}
+
+// CidrBlockNEQ applies the NEQ predicate on the "cidr_block" field.
+func CidrBlockNEQ(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNEQ(FieldCidrBlock, v))
+}
+
+// CidrBlockIn applies the In predicate on the "cidr_block" field.
+func CidrBlockIn(vs ...string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIn(FieldCidrBlock, vs...))
+}
+
+// CidrBlockNotIn applies the NotIn predicate on the "cidr_block" field.
+func CidrBlockNotIn(vs ...string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotIn(FieldCidrBlock, vs...))
+}
+
+// CidrBlockGT applies the GT predicate on the "cidr_block" field.
+func CidrBlockGT(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGT(FieldCidrBlock, v))
+}
+
+// CidrBlockGTE applies the GTE predicate on the "cidr_block" field.
+func CidrBlockGTE(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGTE(FieldCidrBlock, v))
+}
+
+// CidrBlockLT applies the LT predicate on the "cidr_block" field.
+func CidrBlockLT(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLT(FieldCidrBlock, v))
+}
+
+// CidrBlockLTE applies the LTE predicate on the "cidr_block" field.
+func CidrBlockLTE(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLTE(FieldCidrBlock, v))
+}
+
+// CidrBlockContains applies the Contains predicate on the "cidr_block" field.
+func CidrBlockContains(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldContains(FieldCidrBlock, v))
+}
+
+// CidrBlockHasPrefix applies the HasPrefix predicate on the "cidr_block" field.
+func CidrBlockHasPrefix(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldHasPrefix(FieldCidrBlock, v))
+}
+
+// CidrBlockHasSuffix applies the HasSuffix predicate on the "cidr_block" field.
+func CidrBlockHasSuffix(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldHasSuffix(FieldCidrBlock, v))
+}
+
+// CidrBlockIsNil applies the IsNil predicate on the "cidr_block" field.
+func CidrBlockIsNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIsNull(FieldCidrBlock))
+}
+
+// CidrBlockNotNil applies the NotNil predicate on the "cidr_block" field.
+func CidrBlockNotNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotNull(FieldCidrBlock))
+}
+
+// CidrBlockEqualFold applies the EqualFold predicate on the "cidr_block" field.
+func CidrBlockEqualFold(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEqualFold(FieldCidrBlock, v))
+}
+
+// CidrBlockContainsFold applies the ContainsFold predicate on the "cidr_block" field.
+func CidrBlockContainsFold(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldContainsFold(FieldCidrBlock, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotIn(FieldState, vs...))
+}
+
+// StateGT applies the GT predicate on the "state" field.
+func StateGT(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGT(FieldState, v))
+}
+
+// StateGTE applies the GTE predicate on the "state" field.
+func StateGTE(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGTE(FieldState, v))
+}
+
+// StateLT applies the LT predicate on the "state" field.
+func StateLT(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLT(FieldState, v))
+}
+
+// StateLTE applies the LTE predicate on the "state" field.
+func StateLTE(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLTE(FieldState, v))
+}
+
+// StateContains applies the Contains predicate on the "state" field.
+func StateContains(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldContains(FieldState, v))
+}
+
+// StateHasPrefix applies the HasPrefix predicate on the "state" field.
+func StateHasPrefix(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldHasPrefix(FieldState, v))
+}
+
+// StateHasSuffix applies the HasSuffix predicate on the "state" field.
+func StateHasSuffix(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldHasSuffix(FieldState, v))
+}
+
+// StateIsNil applies the IsNil predicate on the "state" field.
+func StateIsNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIsNull(FieldState))
+}
+
+// StateNotNil applies the NotNil predicate on the "state" field.
+func StateNotNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotNull(FieldState))
+}
+
+// StateEqualFold applies the EqualFold predicate on the "state" field.
+func StateEqualFold(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEqualFold(FieldState, v))
+}
+
+// StateContainsFold applies the ContainsFold predicate on the "state" field.
+func StateContainsFold(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldContainsFold(FieldState, v))
+}
+
+// IsDefaultEQ applies the EQ predicate on the "is_default" field.
+func IsDefaultEQ(v bool) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// IsDefaultNEQ applies the NEQ predicate on the "is_default" field.
+func IsDefaultNEQ(v bool) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNEQ(FieldIsDefault, v))
+}
+
+// IsDefaultIsNil applies the IsNil predicate on the "is_default" field.
+func IsDefaultIsNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIsNull(FieldIsDefault))
+}
+
+// IsDefaultNotNil applies the NotNil predicate on the "is_default" field.
+func IsDefaultNotNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotNull(FieldIsDefault))
+}
+
+// InstanceTenancyEQ applies the EQ predicate on the "instance_tenancy" field.
+func InstanceTenancyEQ(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldInstanceTenancy, v))
+}
+
+// InstanceTenancyNEQ applies the NEQ predicate on the "instance_tenancy" field.
+func InstanceTenancyNEQ(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNEQ(FieldInstanceTenancy, v))
+}
+
+// InstanceTenancyIn applies the In predicate on the "instance_tenancy" field.
+func InstanceTenancyIn(vs ...string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIn(FieldInstanceTenancy, vs...))
+}
+
+// InstanceTenancyNotIn applies the NotIn predicate on the "instance_tenancy" field.
+func InstanceTenancyNotIn(vs ...string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotIn(FieldInstanceTenancy, vs...))
+}
+
+// InstanceTenancyGT applies the GT predicate on the "instance_tenancy" field.
+func InstanceTenancyGT(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGT(FieldInstanceTenancy, v))
+}
+
+// InstanceTenancyGTE applies the GTE predicate on the "instance_tenancy" field.
+func InstanceTenancyGTE(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGTE(FieldInstanceTenancy, v))
+}
+
+// InstanceTenancyLT applies the LT predicate on the "instance_tenancy" field.
+func InstanceTenancyLT(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLT(FieldInstanceTenancy, v))
+}
+
+// InstanceTenancyLTE applies the LTE predicate on the "instance_tenancy" field.
+func InstanceTenancyLTE(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLTE(FieldInstanceTenancy, v))
+}
+
+// InstanceTenancyContains applies the Contains predicate on the "instance_tenancy" field.
+func InstanceTenancyContains(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldContains(FieldInstanceTenancy, v))
+}
+
+// InstanceTenancyHasPrefix applies the HasPrefix predicate on the "instance_tenancy" field.
+func InstanceTenancyHasPrefix(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldHasPrefix(FieldInstanceTenancy, v))
+}
+
+// InstanceTenancyHasSuffix applies the HasSuffix predicate on the "instance_tenancy" field.
+func InstanceTenancyHasSuffix(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldHasSuffix(FieldInstanceTenancy, v))
+}
+
+// InstanceTenancyIsNil applies the IsNil predicate on the "instance_tenancy" field.
+func InstanceTenancyIsNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIsNull(FieldInstanceTenancy))
+}
+
+// InstanceTenancyNotNil applies the NotNil predicate on the "instance_tenancy" field.
+func InstanceTenancyNotNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotNull(FieldInstanceTenancy))
+}
+
+// InstanceTenancyEqualFold applies the EqualFold predicate on the "instance_tenancy" field.
+func InstanceTenancyEqualFold(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEqualFold(FieldInstanceTenancy, v))
+}
+
+// InstanceTenancyContainsFold applies the ContainsFold predicate on the "instance_tenancy" field.
+func InstanceTenancyContainsFold(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldContainsFold(FieldInstanceTenancy, v))
+}
+
+// DhcpOptionsIDEQ applies the EQ predicate on the "dhcp_options_id" field.
+func DhcpOptionsIDEQ(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldDhcpOptionsID, v))
+}
+
+// DhcpOptionsIDNEQ applies the NEQ predicate on the "dhcp_options_id" field.
+func DhcpOptionsIDNEQ(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNEQ(FieldDhcpOptionsID, v))
+}
+
+// DhcpOptionsIDIn applies the In predicate on the "dhcp_options_id" field.
+func DhcpOptionsIDIn(vs ...string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIn(FieldDhcpOptionsID, vs...))
+}
+
+// DhcpOptionsIDNotIn applies the NotIn predicate on the "dhcp_options_id" field.
+func DhcpOptionsIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotIn(FieldDhcpOptionsID, vs...))
+}
+
+// DhcpOptionsIDGT applies the GT predicate on the "dhcp_options_id" field.
+func DhcpOptionsIDGT(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGT(FieldDhcpOptionsID, v))
+}
+
+// DhcpOptionsIDGTE applies the GTE predicate on the "dhcp_options_id" field.
+func DhcpOptionsIDGTE(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGTE(FieldDhcpOptionsID, v))
+}
+
+// DhcpOptionsIDLT applies the LT predicate on the "dhcp_options_id" field.
+func DhcpOptionsIDLT(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLT(FieldDhcpOptionsID, v))
+}
+
+// DhcpOptionsIDLTE applies the LTE predicate on the "dhcp_options_id" field.
+func DhcpOptionsIDLTE(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLTE(FieldDhcpOptionsID, v))
+}
+
+// DhcpOptionsIDContains applies the Contains predicate on the "dhcp_options_id" field.
+func DhcpOptionsIDContains(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldContains(FieldDhcpOptionsID, v))
+}
+
+// DhcpOptionsIDHasPrefix applies the HasPrefix predicate on the "dhcp_options_id" field.
+func DhcpOptionsIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldHasPrefix(FieldDhcpOptionsID, v))
+}
+
+// DhcpOptionsIDHasSuffix applies the HasSuffix predicate on the "dhcp_options_id" field.
+func DhcpOptionsIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldHasSuffix(FieldDhcpOptionsID, v))
+}
+
+// DhcpOptionsIDIsNil applies the IsNil predicate on the "dhcp_options_id" field.
+func DhcpOptionsIDIsNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIsNull(FieldDhcpOptionsID))
+}
+
+// DhcpOptionsIDNotNil applies the NotNil predicate on the "dhcp_options_id" field.
+func DhcpOptionsIDNotNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotNull(FieldDhcpOptionsID))
+}
+
+// DhcpOptionsIDEqualFold applies the EqualFold predicate on the "dhcp_options_id" field.
+func DhcpOptionsIDEqualFold(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEqualFold(FieldDhcpOptionsID, v))
+}
+
+// DhcpOptionsIDContainsFold applies the ContainsFold predicate on the "dhcp_options_id" field.
+func DhcpOptionsIDContainsFold(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldContainsFold(FieldDhcpOptionsID, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldContains(FieldOwnerID, v))
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldHasPrefix(FieldOwnerID, v))
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldHasSuffix(FieldOwnerID, v))
+}
+
+// OwnerIDIsNil applies the IsNil predicate on the "owner_id" field.
+func OwnerIDIsNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIsNull(FieldOwnerID))
+}
+
+// OwnerIDNotNil applies the NotNil predicate on the "owner_id" field.
+func OwnerIDNotNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotNull(FieldOwnerID))
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEqualFold(FieldOwnerID, v))
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldContainsFold(FieldOwnerID, v))
+}
+
+// CidrBlockAssociationsJSONIsNil applies the IsNil predicate on the "cidr_block_associations_json" field.
+func CidrBlockAssociationsJSONIsNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIsNull(FieldCidrBlockAssociationsJSON))
+}
+
+// CidrBlockAssociationsJSONNotNil applies the NotNil predicate on the "cidr_block_associations_json" field.
+func CidrBlockAssociationsJSONNotNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotNull(FieldCidrBlockAssociationsJSON))
+}
+
+// Ipv6CidrBlockAssociationsJSONIsNil applies the IsNil predicate on the "ipv6_cidr_block_associations_json" field.
+func Ipv6CidrBlockAssociationsJSONIsNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIsNull(FieldIpv6CidrBlockAssociationsJSON))
+}
+
+// Ipv6CidrBlockAssociationsJSONNotNil applies the NotNil predicate on the "ipv6_cidr_block_associations_json" field.
+func Ipv6CidrBlockAssociationsJSONNotNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotNull(FieldIpv6CidrBlockAssociationsJSON))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeHistoryAWSEC2VPC) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeHistoryAWSEC2VPC) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeHistoryAWSEC2VPC) predicate.BronzeHistoryAWSEC2VPC {
+	return predicate.BronzeHistoryAWSEC2VPC(sql.NotPredicates(p))
+}
@@ -0,0 +1,452 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2vpc"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2VPCCreate is the builder for creating a BronzeHistoryAWSEC2VPC entity.
+type BronzeHistoryAWSEC2VPCCreate struct {
+	config
+	mutation *BronzeHistoryAWSEC2VPCMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetValidFrom(v time.Time) *BronzeHistoryAWSEC2VPCCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetValidTo(v time.Time) *BronzeHistoryAWSEC2VPCCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2VPCCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2VPCCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2VPCCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetResourceID(v string) *BronzeHistoryAWSEC2VPCCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetName(v string) *BronzeHistoryAWSEC2VPCCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetNillableName(v *string) *BronzeHistoryAWSEC2VPCCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetCidrBlock sets the "cidr_block" field.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetCidrBlock(v string) *BronzeHistoryAWSEC2VPCCreate {
+	_c.mutation.SetCidrBlock(v)
+	return _c
+}
+
+// SetNillableCidrBlock sets the "cidr_block" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetNillableCidrBlock(v *string) *BronzeHistoryAWSEC2VPCCreate {
+	if v != nil {
+		_c.SetCidrBlock(*v)
+	}
+	return _c
+}
+
+// SetState sets the "state" field.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetState(v string) *BronzeHistoryAWSEC2VPCCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetNillableState(v *string) *BronzeHistoryAWSEC2VPCCreate {
+	if v != nil {
+		_c.SetState(*v)
+	}
+	return _c
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetIsDefault(v bool) *BronzeHistoryAWSEC2VPCCreate {
+	_c.mutation.SetIsDefault(v)
+	return _c
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetNillableIsDefault(v *bool) *BronzeHistoryAWSEC2VPCCreate {
+	if v != nil {
+		_c.SetIsDefault(*v)
+	}
+	return _c
+}
+
+// SetInstanceTenancy sets the "instance_tenancy" field.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetInstanceTenancy(v string) *BronzeHistoryAWSEC2VPCCreate {
+	_c.mutation.SetInstanceTenancy(v)
+	return _c
+}
+
+// SetNillableInstanceTenancy sets the "instance_tenancy" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetNillableInstanceTenancy(v *string) *BronzeHistoryAWSEC2VPCCreate {
+	if v != nil {
+		_c.SetInstanceTenancy(*v)
+	}
+	return _c
+}
+
+// SetDhcpOptionsID sets the "dhcp_options_id" field.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetDhcpOptionsID(v string) *BronzeHistoryAWSEC2VPCCreate {
+	_c.mutation.SetDhcpOptionsID(v)
+	return _c
+}
+
+// SetNillableDhcpOptionsID sets the "dhcp_options_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetNillableDhcpOptionsID(v *string) *BronzeHistoryAWSEC2VPCCreate {
+	if v != nil {
+		_c.SetDhcpOptionsID(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetOwnerID(v string) *BronzeHistoryAWSEC2VPCCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetNillableOwnerID(v *string) *BronzeHistoryAWSEC2VPCCreate {
+	if v != nil {
+		_c.SetOwnerID(*v)
+	}
+	return _c
+}
+
+// SetCidrBlockAssociationsJSON sets the "cidr_block_associations_json" field.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetCidrBlockAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2VPCCreate {
+	_c.mutation.SetCidrBlockAssociationsJSON(v)
+	return _c
+}
+
+// SetIpv6CidrBlockAssociationsJSON sets the "ipv6_cidr_block_associations_json" field.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetIpv6CidrBlockAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2VPCCreate {
+	_c.mutation.SetIpv6CidrBlockAssociationsJSON(v)
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2VPCCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetAccountID(v string) *BronzeHistoryAWSEC2VPCCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetRegion(v string) *BronzeHistoryAWSEC2VPCCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SetID(v uint) *BronzeHistoryAWSEC2VPCCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryAWSEC2VPCMutation object of the builder.
+func (_c *BronzeHistoryAWSEC2VPCCreate) Mutation() *BronzeHistoryAWSEC2VPCMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryAWSEC2VPC in the database.
+func (_c *BronzeHistoryAWSEC2VPCCreate) Save(ctx context.Context) (*BronzeHistoryAWSEC2VPC, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryAWSEC2VPCCreate) SaveX(ctx context.Context) *BronzeHistoryAWSEC2VPC {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSEC2VPCCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSEC2VPCCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryAWSEC2VPCCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2VPC.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2VPC.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2VPC.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2VPC.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2vpc.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2VPC.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2VPC.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2vpc.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2VPC.account_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`ec2: missing required field "BronzeHistoryAWSEC2VPC.region"`)}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := bronzehistoryawsec2vpc.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2VPC.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryAWSEC2VPCCreate) sqlSave(ctx context.Context) (*BronzeHistoryAWSEC2VPC, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryAWSEC2VPCCreate) createSpec() (*BronzeHistoryAWSEC2VPC, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryAWSEC2VPC{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryawsec2vpc.Table, sqlgraph.NewFieldSpec(bronzehistoryawsec2vpc.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryAWSEC2VPC
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.CidrBlock(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldCidrBlock, field.TypeString, value)
+		_node.CidrBlock = value
+	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	if value, ok := _c.mutation.IsDefault(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if value, ok := _c.mutation.InstanceTenancy(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldInstanceTenancy, field.TypeString, value)
+		_node.InstanceTenancy = value
+	}
+	if value, ok := _c.mutation.DhcpOptionsID(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldDhcpOptionsID, field.TypeString, value)
+		_node.DhcpOptionsID = value
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = value
+	}
+	if value, ok := _c.mutation.CidrBlockAssociationsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldCidrBlockAssociationsJSON, field.TypeJSON, value)
+		_node.CidrBlockAssociationsJSON = value
+	}
+	if value, ok := _c.mutation.Ipv6CidrBlockAssociationsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldIpv6CidrBlockAssociationsJSON, field.TypeJSON, value)
+		_node.Ipv6CidrBlockAssociationsJSON = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryAWSEC2VPCCreateBulk is the builder for creating many BronzeHistoryAWSEC2VPC entities in bulk.
+type BronzeHistoryAWSEC2VPCCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryAWSEC2VPCCreate
+}
+
+// Save creates the BronzeHistoryAWSEC2VPC entities in the database.
+func (_c *BronzeHistoryAWSEC2VPCCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryAWSEC2VPC, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryAWSEC2VPC, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryAWSEC2VPCMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryAWSEC2VPCCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryAWSEC2VPC {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSEC2VPCCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSEC2VPCCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
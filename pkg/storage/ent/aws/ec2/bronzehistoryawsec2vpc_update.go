@@ -0,0 +1,989 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2vpc"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSEC2VPCUpdate is the builder for updating BronzeHistoryAWSEC2VPC entities.
+type BronzeHistoryAWSEC2VPCUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2VPCMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2VPCUpdate builder.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) Where(ps ...predicate.BronzeHistoryAWSEC2VPC) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetValidTo(v time.Time) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) ClearValidTo() *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetResourceID(v string) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetName(v string) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetNillableName(v *string) *BronzeHistoryAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) ClearName() *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetCidrBlock sets the "cidr_block" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetCidrBlock(v string) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.SetCidrBlock(v)
+	return _u
+}
+
+// SetNillableCidrBlock sets the "cidr_block" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetNillableCidrBlock(v *string) *BronzeHistoryAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetCidrBlock(*v)
+	}
+	return _u
+}
+
+// ClearCidrBlock clears the value of the "cidr_block" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) ClearCidrBlock() *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.ClearCidrBlock()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetState(v string) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetNillableState(v *string) *BronzeHistoryAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) ClearState() *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetIsDefault(v bool) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetNillableIsDefault(v *bool) *BronzeHistoryAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// ClearIsDefault clears the value of the "is_default" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) ClearIsDefault() *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.ClearIsDefault()
+	return _u
+}
+
+// SetInstanceTenancy sets the "instance_tenancy" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetInstanceTenancy(v string) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.SetInstanceTenancy(v)
+	return _u
+}
+
+// SetNillableInstanceTenancy sets the "instance_tenancy" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetNillableInstanceTenancy(v *string) *BronzeHistoryAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetInstanceTenancy(*v)
+	}
+	return _u
+}
+
+// ClearInstanceTenancy clears the value of the "instance_tenancy" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) ClearInstanceTenancy() *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.ClearInstanceTenancy()
+	return _u
+}
+
+// SetDhcpOptionsID sets the "dhcp_options_id" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetDhcpOptionsID(v string) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.SetDhcpOptionsID(v)
+	return _u
+}
+
+// SetNillableDhcpOptionsID sets the "dhcp_options_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetNillableDhcpOptionsID(v *string) *BronzeHistoryAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetDhcpOptionsID(*v)
+	}
+	return _u
+}
+
+// ClearDhcpOptionsID clears the value of the "dhcp_options_id" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) ClearDhcpOptionsID() *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.ClearDhcpOptionsID()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetOwnerID(v string) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetNillableOwnerID(v *string) *BronzeHistoryAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) ClearOwnerID() *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetCidrBlockAssociationsJSON sets the "cidr_block_associations_json" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetCidrBlockAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.SetCidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// AppendCidrBlockAssociationsJSON appends value to the "cidr_block_associations_json" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) AppendCidrBlockAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.AppendCidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// ClearCidrBlockAssociationsJSON clears the value of the "cidr_block_associations_json" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) ClearCidrBlockAssociationsJSON() *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.ClearCidrBlockAssociationsJSON()
+	return _u
+}
+
+// SetIpv6CidrBlockAssociationsJSON sets the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetIpv6CidrBlockAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.SetIpv6CidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// AppendIpv6CidrBlockAssociationsJSON appends value to the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) AppendIpv6CidrBlockAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.AppendIpv6CidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// ClearIpv6CidrBlockAssociationsJSON clears the value of the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) ClearIpv6CidrBlockAssociationsJSON() *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.ClearIpv6CidrBlockAssociationsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) ClearTagsJSON() *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetAccountID(v string) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetRegion(v string) *BronzeHistoryAWSEC2VPCUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SetNillableRegion(v *string) *BronzeHistoryAWSEC2VPCUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2VPCMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) Mutation() *BronzeHistoryAWSEC2VPCMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2VPCUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2vpc.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2VPC.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2vpc.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2VPC.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2vpc.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2VPC.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2VPCUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2vpc.Table, bronzehistoryawsec2vpc.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2vpc.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.CidrBlock(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldCidrBlock, field.TypeString, value)
+	}
+	if _u.mutation.CidrBlockCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldCidrBlock, field.TypeString)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldState, field.TypeString, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldState, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldIsDefault, field.TypeBool, value)
+	}
+	if _u.mutation.IsDefaultCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldIsDefault, field.TypeBool)
+	}
+	if value, ok := _u.mutation.InstanceTenancy(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldInstanceTenancy, field.TypeString, value)
+	}
+	if _u.mutation.InstanceTenancyCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldInstanceTenancy, field.TypeString)
+	}
+	if value, ok := _u.mutation.DhcpOptionsID(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldDhcpOptionsID, field.TypeString, value)
+	}
+	if _u.mutation.DhcpOptionsIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldDhcpOptionsID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CidrBlockAssociationsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldCidrBlockAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCidrBlockAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2vpc.FieldCidrBlockAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.CidrBlockAssociationsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldCidrBlockAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Ipv6CidrBlockAssociationsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldIpv6CidrBlockAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIpv6CidrBlockAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2vpc.FieldIpv6CidrBlockAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.Ipv6CidrBlockAssociationsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldIpv6CidrBlockAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2vpc.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2VPC
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2vpc.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryAWSEC2VPCUpdateOne is the builder for updating a single BronzeHistoryAWSEC2VPC entity.
+type BronzeHistoryAWSEC2VPCUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryAWSEC2VPCMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetValidTo(v time.Time) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) ClearValidTo() *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetResourceID(v string) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetName(v string) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetNillableName(v *string) *BronzeHistoryAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) ClearName() *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetCidrBlock sets the "cidr_block" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetCidrBlock(v string) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.SetCidrBlock(v)
+	return _u
+}
+
+// SetNillableCidrBlock sets the "cidr_block" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetNillableCidrBlock(v *string) *BronzeHistoryAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetCidrBlock(*v)
+	}
+	return _u
+}
+
+// ClearCidrBlock clears the value of the "cidr_block" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) ClearCidrBlock() *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.ClearCidrBlock()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetState(v string) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetNillableState(v *string) *BronzeHistoryAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) ClearState() *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetIsDefault(v bool) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetNillableIsDefault(v *bool) *BronzeHistoryAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// ClearIsDefault clears the value of the "is_default" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) ClearIsDefault() *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.ClearIsDefault()
+	return _u
+}
+
+// SetInstanceTenancy sets the "instance_tenancy" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetInstanceTenancy(v string) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.SetInstanceTenancy(v)
+	return _u
+}
+
+// SetNillableInstanceTenancy sets the "instance_tenancy" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetNillableInstanceTenancy(v *string) *BronzeHistoryAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetInstanceTenancy(*v)
+	}
+	return _u
+}
+
+// ClearInstanceTenancy clears the value of the "instance_tenancy" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) ClearInstanceTenancy() *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.ClearInstanceTenancy()
+	return _u
+}
+
+// SetDhcpOptionsID sets the "dhcp_options_id" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetDhcpOptionsID(v string) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.SetDhcpOptionsID(v)
+	return _u
+}
+
+// SetNillableDhcpOptionsID sets the "dhcp_options_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetNillableDhcpOptionsID(v *string) *BronzeHistoryAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetDhcpOptionsID(*v)
+	}
+	return _u
+}
+
+// ClearDhcpOptionsID clears the value of the "dhcp_options_id" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) ClearDhcpOptionsID() *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.ClearDhcpOptionsID()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetOwnerID(v string) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetNillableOwnerID(v *string) *BronzeHistoryAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) ClearOwnerID() *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetCidrBlockAssociationsJSON sets the "cidr_block_associations_json" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetCidrBlockAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.SetCidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// AppendCidrBlockAssociationsJSON appends value to the "cidr_block_associations_json" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) AppendCidrBlockAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.AppendCidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// ClearCidrBlockAssociationsJSON clears the value of the "cidr_block_associations_json" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) ClearCidrBlockAssociationsJSON() *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.ClearCidrBlockAssociationsJSON()
+	return _u
+}
+
+// SetIpv6CidrBlockAssociationsJSON sets the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetIpv6CidrBlockAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.SetIpv6CidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// AppendIpv6CidrBlockAssociationsJSON appends value to the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) AppendIpv6CidrBlockAssociationsJSON(v json.RawMessage) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.AppendIpv6CidrBlockAssociationsJSON(v)
+	return _u
+}
+
+// ClearIpv6CidrBlockAssociationsJSON clears the value of the "ipv6_cidr_block_associations_json" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) ClearIpv6CidrBlockAssociationsJSON() *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.ClearIpv6CidrBlockAssociationsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) ClearTagsJSON() *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetAccountID(v string) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetRegion(v string) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SetNillableRegion(v *string) *BronzeHistoryAWSEC2VPCUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSEC2VPCMutation object of the builder.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) Mutation() *BronzeHistoryAWSEC2VPCMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSEC2VPCUpdate builder.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) Where(ps ...predicate.BronzeHistoryAWSEC2VPC) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) Select(field string, fields ...string) *BronzeHistoryAWSEC2VPCUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryAWSEC2VPC entity.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) Save(ctx context.Context) (*BronzeHistoryAWSEC2VPC, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) SaveX(ctx context.Context) *BronzeHistoryAWSEC2VPC {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsec2vpc.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2VPC.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsec2vpc.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2VPC.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawsec2vpc.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ec2: validator failed for field "BronzeHistoryAWSEC2VPC.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSEC2VPCUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryAWSEC2VPC, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsec2vpc.Table, bronzehistoryawsec2vpc.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsec2vpc.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ec2: missing "BronzeHistoryAWSEC2VPC.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawsec2vpc.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryawsec2vpc.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ec2: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryawsec2vpc.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.CidrBlock(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldCidrBlock, field.TypeString, value)
+	}
+	if _u.mutation.CidrBlockCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldCidrBlock, field.TypeString)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldState, field.TypeString, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldState, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldIsDefault, field.TypeBool, value)
+	}
+	if _u.mutation.IsDefaultCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldIsDefault, field.TypeBool)
+	}
+	if value, ok := _u.mutation.InstanceTenancy(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldInstanceTenancy, field.TypeString, value)
+	}
+	if _u.mutation.InstanceTenancyCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldInstanceTenancy, field.TypeString)
+	}
+	if value, ok := _u.mutation.DhcpOptionsID(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldDhcpOptionsID, field.TypeString, value)
+	}
+	if _u.mutation.DhcpOptionsIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldDhcpOptionsID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CidrBlockAssociationsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldCidrBlockAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCidrBlockAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2vpc.FieldCidrBlockAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.CidrBlockAssociationsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldCidrBlockAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Ipv6CidrBlockAssociationsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldIpv6CidrBlockAssociationsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIpv6CidrBlockAssociationsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2vpc.FieldIpv6CidrBlockAssociationsJSON, value)
+		})
+	}
+	if _u.mutation.Ipv6CidrBlockAssociationsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldIpv6CidrBlockAssociationsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsec2vpc.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsec2vpc.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawsec2vpc.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSEC2VPC
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryAWSEC2VPC{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsec2vpc.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
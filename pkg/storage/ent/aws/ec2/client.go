@@ -11,10 +11,30 @@ import (
 
 	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/migrate"
 
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2address"
 	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2instance"
 	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2instancetag"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2internetgateway"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2natgateway"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2networkacl"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2networkinterface"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2routetable"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2securitygroup"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2securitygrouprule"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2subnet"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2vpc"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2address"
 	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2instance"
 	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2instancetag"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2internetgateway"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2natgateway"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2networkacl"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2networkinterface"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2routetable"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2securitygroup"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2securitygrouprule"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2subnet"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2vpc"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -28,14 +48,54 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// BronzeAWSEC2Address is the client for interacting with the BronzeAWSEC2Address builders.
+	BronzeAWSEC2Address *BronzeAWSEC2AddressClient
 	// BronzeAWSEC2Instance is the client for interacting with the BronzeAWSEC2Instance builders.
 	BronzeAWSEC2Instance *BronzeAWSEC2InstanceClient
 	// BronzeAWSEC2InstanceTag is the client for interacting with the BronzeAWSEC2InstanceTag builders.
 	BronzeAWSEC2InstanceTag *BronzeAWSEC2InstanceTagClient
+	// BronzeAWSEC2InternetGateway is the client for interacting with the BronzeAWSEC2InternetGateway builders.
+	BronzeAWSEC2InternetGateway *BronzeAWSEC2InternetGatewayClient
+	// BronzeAWSEC2NATGateway is the client for interacting with the BronzeAWSEC2NATGateway builders.
+	BronzeAWSEC2NATGateway *BronzeAWSEC2NATGatewayClient
+	// BronzeAWSEC2NetworkACL is the client for interacting with the BronzeAWSEC2NetworkACL builders.
+	BronzeAWSEC2NetworkACL *BronzeAWSEC2NetworkACLClient
+	// BronzeAWSEC2NetworkInterface is the client for interacting with the BronzeAWSEC2NetworkInterface builders.
+	BronzeAWSEC2NetworkInterface *BronzeAWSEC2NetworkInterfaceClient
+	// BronzeAWSEC2RouteTable is the client for interacting with the BronzeAWSEC2RouteTable builders.
+	BronzeAWSEC2RouteTable *BronzeAWSEC2RouteTableClient
+	// BronzeAWSEC2SecurityGroup is the client for interacting with the BronzeAWSEC2SecurityGroup builders.
+	BronzeAWSEC2SecurityGroup *BronzeAWSEC2SecurityGroupClient
+	// BronzeAWSEC2SecurityGroupRule is the client for interacting with the BronzeAWSEC2SecurityGroupRule builders.
+	BronzeAWSEC2SecurityGroupRule *BronzeAWSEC2SecurityGroupRuleClient
+	// BronzeAWSEC2Subnet is the client for interacting with the BronzeAWSEC2Subnet builders.
+	BronzeAWSEC2Subnet *BronzeAWSEC2SubnetClient
+	// BronzeAWSEC2VPC is the client for interacting with the BronzeAWSEC2VPC builders.
+	BronzeAWSEC2VPC *BronzeAWSEC2VPCClient
+	// BronzeHistoryAWSEC2Address is the client for interacting with the BronzeHistoryAWSEC2Address builders.
+	BronzeHistoryAWSEC2Address *BronzeHistoryAWSEC2AddressClient
 	// BronzeHistoryAWSEC2Instance is the client for interacting with the BronzeHistoryAWSEC2Instance builders.
 	BronzeHistoryAWSEC2Instance *BronzeHistoryAWSEC2InstanceClient
 	// BronzeHistoryAWSEC2InstanceTag is the client for interacting with the BronzeHistoryAWSEC2InstanceTag builders.
 	BronzeHistoryAWSEC2InstanceTag *BronzeHistoryAWSEC2InstanceTagClient
+	// BronzeHistoryAWSEC2InternetGateway is the client for interacting with the BronzeHistoryAWSEC2InternetGateway builders.
+	BronzeHistoryAWSEC2InternetGateway *BronzeHistoryAWSEC2InternetGatewayClient
+	// BronzeHistoryAWSEC2NATGateway is the client for interacting with the BronzeHistoryAWSEC2NATGateway builders.
+	BronzeHistoryAWSEC2NATGateway *BronzeHistoryAWSEC2NATGatewayClient
+	// BronzeHistoryAWSEC2NetworkACL is the client for interacting with the BronzeHistoryAWSEC2NetworkACL builders.
+	BronzeHistoryAWSEC2NetworkACL *BronzeHistoryAWSEC2NetworkACLClient
+	// BronzeHistoryAWSEC2NetworkInterface is the client for interacting with the BronzeHistoryAWSEC2NetworkInterface builders.
+	BronzeHistoryAWSEC2NetworkInterface *BronzeHistoryAWSEC2NetworkInterfaceClient
+	// BronzeHistoryAWSEC2RouteTable is the client for interacting with the BronzeHistoryAWSEC2RouteTable builders.
+	BronzeHistoryAWSEC2RouteTable *BronzeHistoryAWSEC2RouteTableClient
+	// BronzeHistoryAWSEC2SecurityGroup is the client for interacting with the BronzeHistoryAWSEC2SecurityGroup builders.
+	BronzeHistoryAWSEC2SecurityGroup *BronzeHistoryAWSEC2SecurityGroupClient
+	// BronzeHistoryAWSEC2SecurityGroupRule is the client for interacting with the BronzeHistoryAWSEC2SecurityGroupRule builders.
+	BronzeHistoryAWSEC2SecurityGroupRule *BronzeHistoryAWSEC2SecurityGroupRuleClient
+	// BronzeHistoryAWSEC2Subnet is the client for interacting with the BronzeHistoryAWSEC2Subnet builders.
+	BronzeHistoryAWSEC2Subnet *BronzeHistoryAWSEC2SubnetClient
+	// BronzeHistoryAWSEC2VPC is the client for interacting with the BronzeHistoryAWSEC2VPC builders.
+	BronzeHistoryAWSEC2VPC *BronzeHistoryAWSEC2VPCClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -47,10 +107,30 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.BronzeAWSEC2Address = NewBronzeAWSEC2AddressClient(c.config)
 	c.BronzeAWSEC2Instance = NewBronzeAWSEC2InstanceClient(c.config)
 	c.BronzeAWSEC2InstanceTag = NewBronzeAWSEC2InstanceTagClient(c.config)
+	c.BronzeAWSEC2InternetGateway = NewBronzeAWSEC2InternetGatewayClient(c.config)
+	c.BronzeAWSEC2NATGateway = NewBronzeAWSEC2NATGatewayClient(c.config)
+	c.BronzeAWSEC2NetworkACL = NewBronzeAWSEC2NetworkACLClient(c.config)
+	c.BronzeAWSEC2NetworkInterface = NewBronzeAWSEC2NetworkInterfaceClient(c.config)
+	c.BronzeAWSEC2RouteTable = NewBronzeAWSEC2RouteTableClient(c.config)
+	c.BronzeAWSEC2SecurityGroup = NewBronzeAWSEC2SecurityGroupClient(c.config)
+	c.BronzeAWSEC2SecurityGroupRule = NewBronzeAWSEC2SecurityGroupRuleClient(c.config)
+	c.BronzeAWSEC2Subnet = NewBronzeAWSEC2SubnetClient(c.config)
+	c.BronzeAWSEC2VPC = NewBronzeAWSEC2VPCClient(c.config)
+	c.BronzeHistoryAWSEC2Address = NewBronzeHistoryAWSEC2AddressClient(c.config)
 	c.BronzeHistoryAWSEC2Instance = NewBronzeHistoryAWSEC2InstanceClient(c.config)
 	c.BronzeHistoryAWSEC2InstanceTag = NewBronzeHistoryAWSEC2InstanceTagClient(c.config)
+	c.BronzeHistoryAWSEC2InternetGateway = NewBronzeHistoryAWSEC2InternetGatewayClient(c.config)
+	c.BronzeHistoryAWSEC2NATGateway = NewBronzeHistoryAWSEC2NATGatewayClient(c.config)
+	c.BronzeHistoryAWSEC2NetworkACL = NewBronzeHistoryAWSEC2NetworkACLClient(c.config)
+	c.BronzeHistoryAWSEC2NetworkInterface = NewBronzeHistoryAWSEC2NetworkInterfaceClient(c.config)
+	c.BronzeHistoryAWSEC2RouteTable = NewBronzeHistoryAWSEC2RouteTableClient(c.config)
+	c.BronzeHistoryAWSEC2SecurityGroup = NewBronzeHistoryAWSEC2SecurityGroupClient(c.config)
+	c.BronzeHistoryAWSEC2SecurityGroupRule = NewBronzeHistoryAWSEC2SecurityGroupRuleClient(c.config)
+	c.BronzeHistoryAWSEC2Subnet = NewBronzeHistoryAWSEC2SubnetClient(c.config)
+	c.BronzeHistoryAWSEC2VPC = NewBronzeHistoryAWSEC2VPCClient(c.config)
 }
 
 type (
@@ -143,12 +223,32 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                            ctx,
-		config:                         cfg,
-		BronzeAWSEC2Instance:           NewBronzeAWSEC2InstanceClient(cfg),
-		BronzeAWSEC2InstanceTag:        NewBronzeAWSEC2InstanceTagClient(cfg),
-		BronzeHistoryAWSEC2Instance:    NewBronzeHistoryAWSEC2InstanceClient(cfg),
-		BronzeHistoryAWSEC2InstanceTag: NewBronzeHistoryAWSEC2InstanceTagClient(cfg),
+		ctx:                                  ctx,
+		config:                               cfg,
+		BronzeAWSEC2Address:                  NewBronzeAWSEC2AddressClient(cfg),
+		BronzeAWSEC2Instance:                 NewBronzeAWSEC2InstanceClient(cfg),
+		BronzeAWSEC2InstanceTag:              NewBronzeAWSEC2InstanceTagClient(cfg),
+		BronzeAWSEC2InternetGateway:          NewBronzeAWSEC2InternetGatewayClient(cfg),
+		BronzeAWSEC2NATGateway:               NewBronzeAWSEC2NATGatewayClient(cfg),
+		BronzeAWSEC2NetworkACL:               NewBronzeAWSEC2NetworkACLClient(cfg),
+		BronzeAWSEC2NetworkInterface:         NewBronzeAWSEC2NetworkInterfaceClient(cfg),
+		BronzeAWSEC2RouteTable:               NewBronzeAWSEC2RouteTableClient(cfg),
+		BronzeAWSEC2SecurityGroup:            NewBronzeAWSEC2SecurityGroupClient(cfg),
+		BronzeAWSEC2SecurityGroupRule:        NewBronzeAWSEC2SecurityGroupRuleClient(cfg),
+		BronzeAWSEC2Subnet:                   NewBronzeAWSEC2SubnetClient(cfg),
+		BronzeAWSEC2VPC:                      NewBronzeAWSEC2VPCClient(cfg),
+		BronzeHistoryAWSEC2Address:           NewBronzeHistoryAWSEC2AddressClient(cfg),
+		BronzeHistoryAWSEC2Instance:          NewBronzeHistoryAWSEC2InstanceClient(cfg),
+		BronzeHistoryAWSEC2InstanceTag:       NewBronzeHistoryAWSEC2InstanceTagClient(cfg),
+		BronzeHistoryAWSEC2InternetGateway:   NewBronzeHistoryAWSEC2InternetGatewayClient(cfg),
+		BronzeHistoryAWSEC2NATGateway:        NewBronzeHistoryAWSEC2NATGatewayClient(cfg),
+		BronzeHistoryAWSEC2NetworkACL:        NewBronzeHistoryAWSEC2NetworkACLClient(cfg),
+		BronzeHistoryAWSEC2NetworkInterface:  NewBronzeHistoryAWSEC2NetworkInterfaceClient(cfg),
+		BronzeHistoryAWSEC2RouteTable:        NewBronzeHistoryAWSEC2RouteTableClient(cfg),
+		BronzeHistoryAWSEC2SecurityGroup:     NewBronzeHistoryAWSEC2SecurityGroupClient(cfg),
+		BronzeHistoryAWSEC2SecurityGroupRule: NewBronzeHistoryAWSEC2SecurityGroupRuleClient(cfg),
+		BronzeHistoryAWSEC2Subnet:            NewBronzeHistoryAWSEC2SubnetClient(cfg),
+		BronzeHistoryAWSEC2VPC:               NewBronzeHistoryAWSEC2VPCClient(cfg),
 	}, nil
 }
 
@@ -166,19 +266,39 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                            ctx,
-		config:                         cfg,
-		BronzeAWSEC2Instance:           NewBronzeAWSEC2InstanceClient(cfg),
-		BronzeAWSEC2InstanceTag:        NewBronzeAWSEC2InstanceTagClient(cfg),
-		BronzeHistoryAWSEC2Instance:    NewBronzeHistoryAWSEC2InstanceClient(cfg),
-		BronzeHistoryAWSEC2InstanceTag: NewBronzeHistoryAWSEC2InstanceTagClient(cfg),
+		ctx:                                  ctx,
+		config:                               cfg,
+		BronzeAWSEC2Address:                  NewBronzeAWSEC2AddressClient(cfg),
+		BronzeAWSEC2Instance:                 NewBronzeAWSEC2InstanceClient(cfg),
+		BronzeAWSEC2InstanceTag:              NewBronzeAWSEC2InstanceTagClient(cfg),
+		BronzeAWSEC2InternetGateway:          NewBronzeAWSEC2InternetGatewayClient(cfg),
+		BronzeAWSEC2NATGateway:               NewBronzeAWSEC2NATGatewayClient(cfg),
+		BronzeAWSEC2NetworkACL:               NewBronzeAWSEC2NetworkACLClient(cfg),
+		BronzeAWSEC2NetworkInterface:         NewBronzeAWSEC2NetworkInterfaceClient(cfg),
+		BronzeAWSEC2RouteTable:               NewBronzeAWSEC2RouteTableClient(cfg),
+		BronzeAWSEC2SecurityGroup:            NewBronzeAWSEC2SecurityGroupClient(cfg),
+		BronzeAWSEC2SecurityGroupRule:        NewBronzeAWSEC2SecurityGroupRuleClient(cfg),
+		BronzeAWSEC2Subnet:                   NewBronzeAWSEC2SubnetClient(cfg),
+		BronzeAWSEC2VPC:                      NewBronzeAWSEC2VPCClient(cfg),
+		BronzeHistoryAWSEC2Address:           NewBronzeHistoryAWSEC2AddressClient(cfg),
+		BronzeHistoryAWSEC2Instance:          NewBronzeHistoryAWSEC2InstanceClient(cfg),
+		BronzeHistoryAWSEC2InstanceTag:       NewBronzeHistoryAWSEC2InstanceTagClient(cfg),
+		BronzeHistoryAWSEC2InternetGateway:   NewBronzeHistoryAWSEC2InternetGatewayClient(cfg),
+		BronzeHistoryAWSEC2NATGateway:        NewBronzeHistoryAWSEC2NATGatewayClient(cfg),
+		BronzeHistoryAWSEC2NetworkACL:        NewBronzeHistoryAWSEC2NetworkACLClient(cfg),
+		BronzeHistoryAWSEC2NetworkInterface:  NewBronzeHistoryAWSEC2NetworkInterfaceClient(cfg),
+		BronzeHistoryAWSEC2RouteTable:        NewBronzeHistoryAWSEC2RouteTableClient(cfg),
+		BronzeHistoryAWSEC2SecurityGroup:     NewBronzeHistoryAWSEC2SecurityGroupClient(cfg),
+		BronzeHistoryAWSEC2SecurityGroupRule: NewBronzeHistoryAWSEC2SecurityGroupRuleClient(cfg),
+		BronzeHistoryAWSEC2Subnet:            NewBronzeHistoryAWSEC2SubnetClient(cfg),
+		BronzeHistoryAWSEC2VPC:               NewBronzeHistoryAWSEC2VPCClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		BronzeAWSEC2Instance.
+//		BronzeAWSEC2Address.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -200,37 +320,232 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.BronzeAWSEC2Instance.Use(hooks...)
-	c.BronzeAWSEC2InstanceTag.Use(hooks...)
-	c.BronzeHistoryAWSEC2Instance.Use(hooks...)
-	c.BronzeHistoryAWSEC2InstanceTag.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.BronzeAWSEC2Address, c.BronzeAWSEC2Instance, c.BronzeAWSEC2InstanceTag,
+		c.BronzeAWSEC2InternetGateway, c.BronzeAWSEC2NATGateway,
+		c.BronzeAWSEC2NetworkACL, c.BronzeAWSEC2NetworkInterface,
+		c.BronzeAWSEC2RouteTable, c.BronzeAWSEC2SecurityGroup,
+		c.BronzeAWSEC2SecurityGroupRule, c.BronzeAWSEC2Subnet, c.BronzeAWSEC2VPC,
+		c.BronzeHistoryAWSEC2Address, c.BronzeHistoryAWSEC2Instance,
+		c.BronzeHistoryAWSEC2InstanceTag, c.BronzeHistoryAWSEC2InternetGateway,
+		c.BronzeHistoryAWSEC2NATGateway, c.BronzeHistoryAWSEC2NetworkACL,
+		c.BronzeHistoryAWSEC2NetworkInterface, c.BronzeHistoryAWSEC2RouteTable,
+		c.BronzeHistoryAWSEC2SecurityGroup, c.BronzeHistoryAWSEC2SecurityGroupRule,
+		c.BronzeHistoryAWSEC2Subnet, c.BronzeHistoryAWSEC2VPC,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.BronzeAWSEC2Instance.Intercept(interceptors...)
-	c.BronzeAWSEC2InstanceTag.Intercept(interceptors...)
-	c.BronzeHistoryAWSEC2Instance.Intercept(interceptors...)
-	c.BronzeHistoryAWSEC2InstanceTag.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.BronzeAWSEC2Address, c.BronzeAWSEC2Instance, c.BronzeAWSEC2InstanceTag,
+		c.BronzeAWSEC2InternetGateway, c.BronzeAWSEC2NATGateway,
+		c.BronzeAWSEC2NetworkACL, c.BronzeAWSEC2NetworkInterface,
+		c.BronzeAWSEC2RouteTable, c.BronzeAWSEC2SecurityGroup,
+		c.BronzeAWSEC2SecurityGroupRule, c.BronzeAWSEC2Subnet, c.BronzeAWSEC2VPC,
+		c.BronzeHistoryAWSEC2Address, c.BronzeHistoryAWSEC2Instance,
+		c.BronzeHistoryAWSEC2InstanceTag, c.BronzeHistoryAWSEC2InternetGateway,
+		c.BronzeHistoryAWSEC2NATGateway, c.BronzeHistoryAWSEC2NetworkACL,
+		c.BronzeHistoryAWSEC2NetworkInterface, c.BronzeHistoryAWSEC2RouteTable,
+		c.BronzeHistoryAWSEC2SecurityGroup, c.BronzeHistoryAWSEC2SecurityGroupRule,
+		c.BronzeHistoryAWSEC2Subnet, c.BronzeHistoryAWSEC2VPC,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *BronzeAWSEC2AddressMutation:
+		return c.BronzeAWSEC2Address.mutate(ctx, m)
 	case *BronzeAWSEC2InstanceMutation:
 		return c.BronzeAWSEC2Instance.mutate(ctx, m)
 	case *BronzeAWSEC2InstanceTagMutation:
 		return c.BronzeAWSEC2InstanceTag.mutate(ctx, m)
+	case *BronzeAWSEC2InternetGatewayMutation:
+		return c.BronzeAWSEC2InternetGateway.mutate(ctx, m)
+	case *BronzeAWSEC2NATGatewayMutation:
+		return c.BronzeAWSEC2NATGateway.mutate(ctx, m)
+	case *BronzeAWSEC2NetworkACLMutation:
+		return c.BronzeAWSEC2NetworkACL.mutate(ctx, m)
+	case *BronzeAWSEC2NetworkInterfaceMutation:
+		return c.BronzeAWSEC2NetworkInterface.mutate(ctx, m)
+	case *BronzeAWSEC2RouteTableMutation:
+		return c.BronzeAWSEC2RouteTable.mutate(ctx, m)
+	case *BronzeAWSEC2SecurityGroupMutation:
+		return c.BronzeAWSEC2SecurityGroup.mutate(ctx, m)
+	case *BronzeAWSEC2SecurityGroupRuleMutation:
+		return c.BronzeAWSEC2SecurityGroupRule.mutate(ctx, m)
+	case *BronzeAWSEC2SubnetMutation:
+		return c.BronzeAWSEC2Subnet.mutate(ctx, m)
+	case *BronzeAWSEC2VPCMutation:
+		return c.BronzeAWSEC2VPC.mutate(ctx, m)
+	case *BronzeHistoryAWSEC2AddressMutation:
+		return c.BronzeHistoryAWSEC2Address.mutate(ctx, m)
 	case *BronzeHistoryAWSEC2InstanceMutation:
 		return c.BronzeHistoryAWSEC2Instance.mutate(ctx, m)
 	case *BronzeHistoryAWSEC2InstanceTagMutation:
 		return c.BronzeHistoryAWSEC2InstanceTag.mutate(ctx, m)
+	case *BronzeHistoryAWSEC2InternetGatewayMutation:
+		return c.BronzeHistoryAWSEC2InternetGateway.mutate(ctx, m)
+	case *BronzeHistoryAWSEC2NATGatewayMutation:
+		return c.BronzeHistoryAWSEC2NATGateway.mutate(ctx, m)
+	case *BronzeHistoryAWSEC2NetworkACLMutation:
+		return c.BronzeHistoryAWSEC2NetworkACL.mutate(ctx, m)
+	case *BronzeHistoryAWSEC2NetworkInterfaceMutation:
+		return c.BronzeHistoryAWSEC2NetworkInterface.mutate(ctx, m)
+	case *BronzeHistoryAWSEC2RouteTableMutation:
+		return c.BronzeHistoryAWSEC2RouteTable.mutate(ctx, m)
+	case *BronzeHistoryAWSEC2SecurityGroupMutation:
+		return c.BronzeHistoryAWSEC2SecurityGroup.mutate(ctx, m)
+	case *BronzeHistoryAWSEC2SecurityGroupRuleMutation:
+		return c.BronzeHistoryAWSEC2SecurityGroupRule.mutate(ctx, m)
+	case *BronzeHistoryAWSEC2SubnetMutation:
+		return c.BronzeHistoryAWSEC2Subnet.mutate(ctx, m)
+	case *BronzeHistoryAWSEC2VPCMutation:
+		return c.BronzeHistoryAWSEC2VPC.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ec2: unknown mutation type %T", m)
 	}
 }
 
+// BronzeAWSEC2AddressClient is a client for the BronzeAWSEC2Address schema.
+type BronzeAWSEC2AddressClient struct {
+	config
+}
+
+// NewBronzeAWSEC2AddressClient returns a client for the BronzeAWSEC2Address from the given config.
+func NewBronzeAWSEC2AddressClient(c config) *BronzeAWSEC2AddressClient {
+	return &BronzeAWSEC2AddressClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzeawsec2address.Hooks(f(g(h())))`.
+func (c *BronzeAWSEC2AddressClient) Use(hooks ...Hook) {
+	c.hooks.BronzeAWSEC2Address = append(c.hooks.BronzeAWSEC2Address, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzeawsec2address.Intercept(f(g(h())))`.
+func (c *BronzeAWSEC2AddressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeAWSEC2Address = append(c.inters.BronzeAWSEC2Address, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeAWSEC2Address entity.
+func (c *BronzeAWSEC2AddressClient) Create() *BronzeAWSEC2AddressCreate {
+	mutation := newBronzeAWSEC2AddressMutation(c.config, OpCreate)
+	return &BronzeAWSEC2AddressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeAWSEC2Address entities.
+func (c *BronzeAWSEC2AddressClient) CreateBulk(builders ...*BronzeAWSEC2AddressCreate) *BronzeAWSEC2AddressCreateBulk {
+	return &BronzeAWSEC2AddressCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeAWSEC2AddressClient) MapCreateBulk(slice any, setFunc func(*BronzeAWSEC2AddressCreate, int)) *BronzeAWSEC2AddressCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeAWSEC2AddressCreateBulk{err: fmt.Errorf("calling to BronzeAWSEC2AddressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeAWSEC2AddressCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeAWSEC2AddressCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeAWSEC2Address.
+func (c *BronzeAWSEC2AddressClient) Update() *BronzeAWSEC2AddressUpdate {
+	mutation := newBronzeAWSEC2AddressMutation(c.config, OpUpdate)
+	return &BronzeAWSEC2AddressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeAWSEC2AddressClient) UpdateOne(_m *BronzeAWSEC2Address) *BronzeAWSEC2AddressUpdateOne {
+	mutation := newBronzeAWSEC2AddressMutation(c.config, OpUpdateOne, withBronzeAWSEC2Address(_m))
+	return &BronzeAWSEC2AddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeAWSEC2AddressClient) UpdateOneID(id string) *BronzeAWSEC2AddressUpdateOne {
+	mutation := newBronzeAWSEC2AddressMutation(c.config, OpUpdateOne, withBronzeAWSEC2AddressID(id))
+	return &BronzeAWSEC2AddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeAWSEC2Address.
+func (c *BronzeAWSEC2AddressClient) Delete() *BronzeAWSEC2AddressDelete {
+	mutation := newBronzeAWSEC2AddressMutation(c.config, OpDelete)
+	return &BronzeAWSEC2AddressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeAWSEC2AddressClient) DeleteOne(_m *BronzeAWSEC2Address) *BronzeAWSEC2AddressDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeAWSEC2AddressClient) DeleteOneID(id string) *BronzeAWSEC2AddressDeleteOne {
+	builder := c.Delete().Where(bronzeawsec2address.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeAWSEC2AddressDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeAWSEC2Address.
+func (c *BronzeAWSEC2AddressClient) Query() *BronzeAWSEC2AddressQuery {
+	return &BronzeAWSEC2AddressQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeAWSEC2Address},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeAWSEC2Address entity by its id.
+func (c *BronzeAWSEC2AddressClient) Get(ctx context.Context, id string) (*BronzeAWSEC2Address, error) {
+	return c.Query().Where(bronzeawsec2address.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeAWSEC2AddressClient) GetX(ctx context.Context, id string) *BronzeAWSEC2Address {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeAWSEC2AddressClient) Hooks() []Hook {
+	return c.hooks.BronzeAWSEC2Address
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeAWSEC2AddressClient) Interceptors() []Interceptor {
+	return c.inters.BronzeAWSEC2Address
+}
+
+func (c *BronzeAWSEC2AddressClient) mutate(ctx context.Context, m *BronzeAWSEC2AddressMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeAWSEC2AddressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeAWSEC2AddressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeAWSEC2AddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeAWSEC2AddressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeAWSEC2Address mutation op: %q", m.Op())
+	}
+}
+
 // BronzeAWSEC2InstanceClient is a client for the BronzeAWSEC2Instance schema.
 type BronzeAWSEC2InstanceClient struct {
 	config
@@ -535,107 +850,107 @@ func (c *BronzeAWSEC2InstanceTagClient) mutate(ctx context.Context, m *BronzeAWS
 	}
 }
 
-// BronzeHistoryAWSEC2InstanceClient is a client for the BronzeHistoryAWSEC2Instance schema.
-type BronzeHistoryAWSEC2InstanceClient struct {
+// BronzeAWSEC2InternetGatewayClient is a client for the BronzeAWSEC2InternetGateway schema.
+type BronzeAWSEC2InternetGatewayClient struct {
 	config
 }
 
-// NewBronzeHistoryAWSEC2InstanceClient returns a client for the BronzeHistoryAWSEC2Instance from the given config.
-func NewBronzeHistoryAWSEC2InstanceClient(c config) *BronzeHistoryAWSEC2InstanceClient {
-	return &BronzeHistoryAWSEC2InstanceClient{config: c}
+// NewBronzeAWSEC2InternetGatewayClient returns a client for the BronzeAWSEC2InternetGateway from the given config.
+func NewBronzeAWSEC2InternetGatewayClient(c config) *BronzeAWSEC2InternetGatewayClient {
+	return &BronzeAWSEC2InternetGatewayClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `bronzehistoryawsec2instance.Hooks(f(g(h())))`.
-func (c *BronzeHistoryAWSEC2InstanceClient) Use(hooks ...Hook) {
-	c.hooks.BronzeHistoryAWSEC2Instance = append(c.hooks.BronzeHistoryAWSEC2Instance, hooks...)
+// A call to `Use(f, g, h)` equals to `bronzeawsec2internetgateway.Hooks(f(g(h())))`.
+func (c *BronzeAWSEC2InternetGatewayClient) Use(hooks ...Hook) {
+	c.hooks.BronzeAWSEC2InternetGateway = append(c.hooks.BronzeAWSEC2InternetGateway, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsec2instance.Intercept(f(g(h())))`.
-func (c *BronzeHistoryAWSEC2InstanceClient) Intercept(interceptors ...Interceptor) {
-	c.inters.BronzeHistoryAWSEC2Instance = append(c.inters.BronzeHistoryAWSEC2Instance, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `bronzeawsec2internetgateway.Intercept(f(g(h())))`.
+func (c *BronzeAWSEC2InternetGatewayClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeAWSEC2InternetGateway = append(c.inters.BronzeAWSEC2InternetGateway, interceptors...)
 }
 
-// Create returns a builder for creating a BronzeHistoryAWSEC2Instance entity.
-func (c *BronzeHistoryAWSEC2InstanceClient) Create() *BronzeHistoryAWSEC2InstanceCreate {
-	mutation := newBronzeHistoryAWSEC2InstanceMutation(c.config, OpCreate)
-	return &BronzeHistoryAWSEC2InstanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BronzeAWSEC2InternetGateway entity.
+func (c *BronzeAWSEC2InternetGatewayClient) Create() *BronzeAWSEC2InternetGatewayCreate {
+	mutation := newBronzeAWSEC2InternetGatewayMutation(c.config, OpCreate)
+	return &BronzeAWSEC2InternetGatewayCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSEC2Instance entities.
-func (c *BronzeHistoryAWSEC2InstanceClient) CreateBulk(builders ...*BronzeHistoryAWSEC2InstanceCreate) *BronzeHistoryAWSEC2InstanceCreateBulk {
-	return &BronzeHistoryAWSEC2InstanceCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BronzeAWSEC2InternetGateway entities.
+func (c *BronzeAWSEC2InternetGatewayClient) CreateBulk(builders ...*BronzeAWSEC2InternetGatewayCreate) *BronzeAWSEC2InternetGatewayCreateBulk {
+	return &BronzeAWSEC2InternetGatewayCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *BronzeHistoryAWSEC2InstanceClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSEC2InstanceCreate, int)) *BronzeHistoryAWSEC2InstanceCreateBulk {
+func (c *BronzeAWSEC2InternetGatewayClient) MapCreateBulk(slice any, setFunc func(*BronzeAWSEC2InternetGatewayCreate, int)) *BronzeAWSEC2InternetGatewayCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &BronzeHistoryAWSEC2InstanceCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSEC2InstanceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BronzeAWSEC2InternetGatewayCreateBulk{err: fmt.Errorf("calling to BronzeAWSEC2InternetGatewayClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*BronzeHistoryAWSEC2InstanceCreate, rv.Len())
+	builders := make([]*BronzeAWSEC2InternetGatewayCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &BronzeHistoryAWSEC2InstanceCreateBulk{config: c.config, builders: builders}
+	return &BronzeAWSEC2InternetGatewayCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for BronzeHistoryAWSEC2Instance.
-func (c *BronzeHistoryAWSEC2InstanceClient) Update() *BronzeHistoryAWSEC2InstanceUpdate {
-	mutation := newBronzeHistoryAWSEC2InstanceMutation(c.config, OpUpdate)
-	return &BronzeHistoryAWSEC2InstanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BronzeAWSEC2InternetGateway.
+func (c *BronzeAWSEC2InternetGatewayClient) Update() *BronzeAWSEC2InternetGatewayUpdate {
+	mutation := newBronzeAWSEC2InternetGatewayMutation(c.config, OpUpdate)
+	return &BronzeAWSEC2InternetGatewayUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *BronzeHistoryAWSEC2InstanceClient) UpdateOne(_m *BronzeHistoryAWSEC2Instance) *BronzeHistoryAWSEC2InstanceUpdateOne {
-	mutation := newBronzeHistoryAWSEC2InstanceMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2Instance(_m))
-	return &BronzeHistoryAWSEC2InstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BronzeAWSEC2InternetGatewayClient) UpdateOne(_m *BronzeAWSEC2InternetGateway) *BronzeAWSEC2InternetGatewayUpdateOne {
+	mutation := newBronzeAWSEC2InternetGatewayMutation(c.config, OpUpdateOne, withBronzeAWSEC2InternetGateway(_m))
+	return &BronzeAWSEC2InternetGatewayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *BronzeHistoryAWSEC2InstanceClient) UpdateOneID(id uint) *BronzeHistoryAWSEC2InstanceUpdateOne {
-	mutation := newBronzeHistoryAWSEC2InstanceMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2InstanceID(id))
-	return &BronzeHistoryAWSEC2InstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BronzeAWSEC2InternetGatewayClient) UpdateOneID(id string) *BronzeAWSEC2InternetGatewayUpdateOne {
+	mutation := newBronzeAWSEC2InternetGatewayMutation(c.config, OpUpdateOne, withBronzeAWSEC2InternetGatewayID(id))
+	return &BronzeAWSEC2InternetGatewayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for BronzeHistoryAWSEC2Instance.
-func (c *BronzeHistoryAWSEC2InstanceClient) Delete() *BronzeHistoryAWSEC2InstanceDelete {
-	mutation := newBronzeHistoryAWSEC2InstanceMutation(c.config, OpDelete)
-	return &BronzeHistoryAWSEC2InstanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BronzeAWSEC2InternetGateway.
+func (c *BronzeAWSEC2InternetGatewayClient) Delete() *BronzeAWSEC2InternetGatewayDelete {
+	mutation := newBronzeAWSEC2InternetGatewayMutation(c.config, OpDelete)
+	return &BronzeAWSEC2InternetGatewayDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *BronzeHistoryAWSEC2InstanceClient) DeleteOne(_m *BronzeHistoryAWSEC2Instance) *BronzeHistoryAWSEC2InstanceDeleteOne {
+func (c *BronzeAWSEC2InternetGatewayClient) DeleteOne(_m *BronzeAWSEC2InternetGateway) *BronzeAWSEC2InternetGatewayDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *BronzeHistoryAWSEC2InstanceClient) DeleteOneID(id uint) *BronzeHistoryAWSEC2InstanceDeleteOne {
-	builder := c.Delete().Where(bronzehistoryawsec2instance.ID(id))
+func (c *BronzeAWSEC2InternetGatewayClient) DeleteOneID(id string) *BronzeAWSEC2InternetGatewayDeleteOne {
+	builder := c.Delete().Where(bronzeawsec2internetgateway.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &BronzeHistoryAWSEC2InstanceDeleteOne{builder}
+	return &BronzeAWSEC2InternetGatewayDeleteOne{builder}
 }
 
-// Query returns a query builder for BronzeHistoryAWSEC2Instance.
-func (c *BronzeHistoryAWSEC2InstanceClient) Query() *BronzeHistoryAWSEC2InstanceQuery {
-	return &BronzeHistoryAWSEC2InstanceQuery{
+// Query returns a query builder for BronzeAWSEC2InternetGateway.
+func (c *BronzeAWSEC2InternetGatewayClient) Query() *BronzeAWSEC2InternetGatewayQuery {
+	return &BronzeAWSEC2InternetGatewayQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSEC2Instance},
+		ctx:    &QueryContext{Type: TypeBronzeAWSEC2InternetGateway},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a BronzeHistoryAWSEC2Instance entity by its id.
-func (c *BronzeHistoryAWSEC2InstanceClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSEC2Instance, error) {
-	return c.Query().Where(bronzehistoryawsec2instance.ID(id)).Only(ctx)
+// Get returns a BronzeAWSEC2InternetGateway entity by its id.
+func (c *BronzeAWSEC2InternetGatewayClient) Get(ctx context.Context, id string) (*BronzeAWSEC2InternetGateway, error) {
+	return c.Query().Where(bronzeawsec2internetgateway.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *BronzeHistoryAWSEC2InstanceClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSEC2Instance {
+func (c *BronzeAWSEC2InternetGatewayClient) GetX(ctx context.Context, id string) *BronzeAWSEC2InternetGateway {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -644,131 +959,131 @@ func (c *BronzeHistoryAWSEC2InstanceClient) GetX(ctx context.Context, id uint) *
 }
 
 // Hooks returns the client hooks.
-func (c *BronzeHistoryAWSEC2InstanceClient) Hooks() []Hook {
-	return c.hooks.BronzeHistoryAWSEC2Instance
+func (c *BronzeAWSEC2InternetGatewayClient) Hooks() []Hook {
+	return c.hooks.BronzeAWSEC2InternetGateway
 }
 
 // Interceptors returns the client interceptors.
-func (c *BronzeHistoryAWSEC2InstanceClient) Interceptors() []Interceptor {
-	return c.inters.BronzeHistoryAWSEC2Instance
+func (c *BronzeAWSEC2InternetGatewayClient) Interceptors() []Interceptor {
+	return c.inters.BronzeAWSEC2InternetGateway
 }
 
-func (c *BronzeHistoryAWSEC2InstanceClient) mutate(ctx context.Context, m *BronzeHistoryAWSEC2InstanceMutation) (Value, error) {
+func (c *BronzeAWSEC2InternetGatewayClient) mutate(ctx context.Context, m *BronzeAWSEC2InternetGatewayMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&BronzeHistoryAWSEC2InstanceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BronzeAWSEC2InternetGatewayCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&BronzeHistoryAWSEC2InstanceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BronzeAWSEC2InternetGatewayUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&BronzeHistoryAWSEC2InstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BronzeAWSEC2InternetGatewayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&BronzeHistoryAWSEC2InstanceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BronzeAWSEC2InternetGatewayDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ec2: unknown BronzeHistoryAWSEC2Instance mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ec2: unknown BronzeAWSEC2InternetGateway mutation op: %q", m.Op())
 	}
 }
 
-// BronzeHistoryAWSEC2InstanceTagClient is a client for the BronzeHistoryAWSEC2InstanceTag schema.
-type BronzeHistoryAWSEC2InstanceTagClient struct {
+// BronzeAWSEC2NATGatewayClient is a client for the BronzeAWSEC2NATGateway schema.
+type BronzeAWSEC2NATGatewayClient struct {
 	config
 }
 
-// NewBronzeHistoryAWSEC2InstanceTagClient returns a client for the BronzeHistoryAWSEC2InstanceTag from the given config.
-func NewBronzeHistoryAWSEC2InstanceTagClient(c config) *BronzeHistoryAWSEC2InstanceTagClient {
-	return &BronzeHistoryAWSEC2InstanceTagClient{config: c}
+// NewBronzeAWSEC2NATGatewayClient returns a client for the BronzeAWSEC2NATGateway from the given config.
+func NewBronzeAWSEC2NATGatewayClient(c config) *BronzeAWSEC2NATGatewayClient {
+	return &BronzeAWSEC2NATGatewayClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `bronzehistoryawsec2instancetag.Hooks(f(g(h())))`.
-func (c *BronzeHistoryAWSEC2InstanceTagClient) Use(hooks ...Hook) {
-	c.hooks.BronzeHistoryAWSEC2InstanceTag = append(c.hooks.BronzeHistoryAWSEC2InstanceTag, hooks...)
+// A call to `Use(f, g, h)` equals to `bronzeawsec2natgateway.Hooks(f(g(h())))`.
+func (c *BronzeAWSEC2NATGatewayClient) Use(hooks ...Hook) {
+	c.hooks.BronzeAWSEC2NATGateway = append(c.hooks.BronzeAWSEC2NATGateway, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsec2instancetag.Intercept(f(g(h())))`.
-func (c *BronzeHistoryAWSEC2InstanceTagClient) Intercept(interceptors ...Interceptor) {
-	c.inters.BronzeHistoryAWSEC2InstanceTag = append(c.inters.BronzeHistoryAWSEC2InstanceTag, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `bronzeawsec2natgateway.Intercept(f(g(h())))`.
+func (c *BronzeAWSEC2NATGatewayClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeAWSEC2NATGateway = append(c.inters.BronzeAWSEC2NATGateway, interceptors...)
 }
 
-// Create returns a builder for creating a BronzeHistoryAWSEC2InstanceTag entity.
-func (c *BronzeHistoryAWSEC2InstanceTagClient) Create() *BronzeHistoryAWSEC2InstanceTagCreate {
-	mutation := newBronzeHistoryAWSEC2InstanceTagMutation(c.config, OpCreate)
-	return &BronzeHistoryAWSEC2InstanceTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BronzeAWSEC2NATGateway entity.
+func (c *BronzeAWSEC2NATGatewayClient) Create() *BronzeAWSEC2NATGatewayCreate {
+	mutation := newBronzeAWSEC2NATGatewayMutation(c.config, OpCreate)
+	return &BronzeAWSEC2NATGatewayCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSEC2InstanceTag entities.
-func (c *BronzeHistoryAWSEC2InstanceTagClient) CreateBulk(builders ...*BronzeHistoryAWSEC2InstanceTagCreate) *BronzeHistoryAWSEC2InstanceTagCreateBulk {
-	return &BronzeHistoryAWSEC2InstanceTagCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BronzeAWSEC2NATGateway entities.
+func (c *BronzeAWSEC2NATGatewayClient) CreateBulk(builders ...*BronzeAWSEC2NATGatewayCreate) *BronzeAWSEC2NATGatewayCreateBulk {
+	return &BronzeAWSEC2NATGatewayCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *BronzeHistoryAWSEC2InstanceTagClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSEC2InstanceTagCreate, int)) *BronzeHistoryAWSEC2InstanceTagCreateBulk {
+func (c *BronzeAWSEC2NATGatewayClient) MapCreateBulk(slice any, setFunc func(*BronzeAWSEC2NATGatewayCreate, int)) *BronzeAWSEC2NATGatewayCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &BronzeHistoryAWSEC2InstanceTagCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSEC2InstanceTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BronzeAWSEC2NATGatewayCreateBulk{err: fmt.Errorf("calling to BronzeAWSEC2NATGatewayClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*BronzeHistoryAWSEC2InstanceTagCreate, rv.Len())
+	builders := make([]*BronzeAWSEC2NATGatewayCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &BronzeHistoryAWSEC2InstanceTagCreateBulk{config: c.config, builders: builders}
+	return &BronzeAWSEC2NATGatewayCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for BronzeHistoryAWSEC2InstanceTag.
-func (c *BronzeHistoryAWSEC2InstanceTagClient) Update() *BronzeHistoryAWSEC2InstanceTagUpdate {
-	mutation := newBronzeHistoryAWSEC2InstanceTagMutation(c.config, OpUpdate)
-	return &BronzeHistoryAWSEC2InstanceTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BronzeAWSEC2NATGateway.
+func (c *BronzeAWSEC2NATGatewayClient) Update() *BronzeAWSEC2NATGatewayUpdate {
+	mutation := newBronzeAWSEC2NATGatewayMutation(c.config, OpUpdate)
+	return &BronzeAWSEC2NATGatewayUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *BronzeHistoryAWSEC2InstanceTagClient) UpdateOne(_m *BronzeHistoryAWSEC2InstanceTag) *BronzeHistoryAWSEC2InstanceTagUpdateOne {
-	mutation := newBronzeHistoryAWSEC2InstanceTagMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2InstanceTag(_m))
-	return &BronzeHistoryAWSEC2InstanceTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BronzeAWSEC2NATGatewayClient) UpdateOne(_m *BronzeAWSEC2NATGateway) *BronzeAWSEC2NATGatewayUpdateOne {
+	mutation := newBronzeAWSEC2NATGatewayMutation(c.config, OpUpdateOne, withBronzeAWSEC2NATGateway(_m))
+	return &BronzeAWSEC2NATGatewayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *BronzeHistoryAWSEC2InstanceTagClient) UpdateOneID(id uint) *BronzeHistoryAWSEC2InstanceTagUpdateOne {
-	mutation := newBronzeHistoryAWSEC2InstanceTagMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2InstanceTagID(id))
-	return &BronzeHistoryAWSEC2InstanceTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BronzeAWSEC2NATGatewayClient) UpdateOneID(id string) *BronzeAWSEC2NATGatewayUpdateOne {
+	mutation := newBronzeAWSEC2NATGatewayMutation(c.config, OpUpdateOne, withBronzeAWSEC2NATGatewayID(id))
+	return &BronzeAWSEC2NATGatewayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for BronzeHistoryAWSEC2InstanceTag.
-func (c *BronzeHistoryAWSEC2InstanceTagClient) Delete() *BronzeHistoryAWSEC2InstanceTagDelete {
-	mutation := newBronzeHistoryAWSEC2InstanceTagMutation(c.config, OpDelete)
-	return &BronzeHistoryAWSEC2InstanceTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BronzeAWSEC2NATGateway.
+func (c *BronzeAWSEC2NATGatewayClient) Delete() *BronzeAWSEC2NATGatewayDelete {
+	mutation := newBronzeAWSEC2NATGatewayMutation(c.config, OpDelete)
+	return &BronzeAWSEC2NATGatewayDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *BronzeHistoryAWSEC2InstanceTagClient) DeleteOne(_m *BronzeHistoryAWSEC2InstanceTag) *BronzeHistoryAWSEC2InstanceTagDeleteOne {
+func (c *BronzeAWSEC2NATGatewayClient) DeleteOne(_m *BronzeAWSEC2NATGateway) *BronzeAWSEC2NATGatewayDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *BronzeHistoryAWSEC2InstanceTagClient) DeleteOneID(id uint) *BronzeHistoryAWSEC2InstanceTagDeleteOne {
-	builder := c.Delete().Where(bronzehistoryawsec2instancetag.ID(id))
+func (c *BronzeAWSEC2NATGatewayClient) DeleteOneID(id string) *BronzeAWSEC2NATGatewayDeleteOne {
+	builder := c.Delete().Where(bronzeawsec2natgateway.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &BronzeHistoryAWSEC2InstanceTagDeleteOne{builder}
+	return &BronzeAWSEC2NATGatewayDeleteOne{builder}
 }
 
-// Query returns a query builder for BronzeHistoryAWSEC2InstanceTag.
-func (c *BronzeHistoryAWSEC2InstanceTagClient) Query() *BronzeHistoryAWSEC2InstanceTagQuery {
-	return &BronzeHistoryAWSEC2InstanceTagQuery{
+// Query returns a query builder for BronzeAWSEC2NATGateway.
+func (c *BronzeAWSEC2NATGatewayClient) Query() *BronzeAWSEC2NATGatewayQuery {
+	return &BronzeAWSEC2NATGatewayQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSEC2InstanceTag},
+		ctx:    &QueryContext{Type: TypeBronzeAWSEC2NATGateway},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a BronzeHistoryAWSEC2InstanceTag entity by its id.
-func (c *BronzeHistoryAWSEC2InstanceTagClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSEC2InstanceTag, error) {
-	return c.Query().Where(bronzehistoryawsec2instancetag.ID(id)).Only(ctx)
+// Get returns a BronzeAWSEC2NATGateway entity by its id.
+func (c *BronzeAWSEC2NATGatewayClient) Get(ctx context.Context, id string) (*BronzeAWSEC2NATGateway, error) {
+	return c.Query().Where(bronzeawsec2natgateway.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *BronzeHistoryAWSEC2InstanceTagClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSEC2InstanceTag {
+func (c *BronzeAWSEC2NATGatewayClient) GetX(ctx context.Context, id string) *BronzeAWSEC2NATGateway {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -777,39 +1092,2582 @@ func (c *BronzeHistoryAWSEC2InstanceTagClient) GetX(ctx context.Context, id uint
 }
 
 // Hooks returns the client hooks.
-func (c *BronzeHistoryAWSEC2InstanceTagClient) Hooks() []Hook {
-	return c.hooks.BronzeHistoryAWSEC2InstanceTag
+func (c *BronzeAWSEC2NATGatewayClient) Hooks() []Hook {
+	return c.hooks.BronzeAWSEC2NATGateway
 }
 
 // Interceptors returns the client interceptors.
-func (c *BronzeHistoryAWSEC2InstanceTagClient) Interceptors() []Interceptor {
-	return c.inters.BronzeHistoryAWSEC2InstanceTag
+func (c *BronzeAWSEC2NATGatewayClient) Interceptors() []Interceptor {
+	return c.inters.BronzeAWSEC2NATGateway
 }
 
-func (c *BronzeHistoryAWSEC2InstanceTagClient) mutate(ctx context.Context, m *BronzeHistoryAWSEC2InstanceTagMutation) (Value, error) {
+func (c *BronzeAWSEC2NATGatewayClient) mutate(ctx context.Context, m *BronzeAWSEC2NATGatewayMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&BronzeHistoryAWSEC2InstanceTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BronzeAWSEC2NATGatewayCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&BronzeHistoryAWSEC2InstanceTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BronzeAWSEC2NATGatewayUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&BronzeHistoryAWSEC2InstanceTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BronzeAWSEC2NATGatewayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&BronzeHistoryAWSEC2InstanceTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BronzeAWSEC2NATGatewayDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ec2: unknown BronzeHistoryAWSEC2InstanceTag mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ec2: unknown BronzeAWSEC2NATGateway mutation op: %q", m.Op())
+	}
+}
+
+// BronzeAWSEC2NetworkACLClient is a client for the BronzeAWSEC2NetworkACL schema.
+type BronzeAWSEC2NetworkACLClient struct {
+	config
+}
+
+// NewBronzeAWSEC2NetworkACLClient returns a client for the BronzeAWSEC2NetworkACL from the given config.
+func NewBronzeAWSEC2NetworkACLClient(c config) *BronzeAWSEC2NetworkACLClient {
+	return &BronzeAWSEC2NetworkACLClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzeawsec2networkacl.Hooks(f(g(h())))`.
+func (c *BronzeAWSEC2NetworkACLClient) Use(hooks ...Hook) {
+	c.hooks.BronzeAWSEC2NetworkACL = append(c.hooks.BronzeAWSEC2NetworkACL, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzeawsec2networkacl.Intercept(f(g(h())))`.
+func (c *BronzeAWSEC2NetworkACLClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeAWSEC2NetworkACL = append(c.inters.BronzeAWSEC2NetworkACL, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeAWSEC2NetworkACL entity.
+func (c *BronzeAWSEC2NetworkACLClient) Create() *BronzeAWSEC2NetworkACLCreate {
+	mutation := newBronzeAWSEC2NetworkACLMutation(c.config, OpCreate)
+	return &BronzeAWSEC2NetworkACLCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeAWSEC2NetworkACL entities.
+func (c *BronzeAWSEC2NetworkACLClient) CreateBulk(builders ...*BronzeAWSEC2NetworkACLCreate) *BronzeAWSEC2NetworkACLCreateBulk {
+	return &BronzeAWSEC2NetworkACLCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeAWSEC2NetworkACLClient) MapCreateBulk(slice any, setFunc func(*BronzeAWSEC2NetworkACLCreate, int)) *BronzeAWSEC2NetworkACLCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeAWSEC2NetworkACLCreateBulk{err: fmt.Errorf("calling to BronzeAWSEC2NetworkACLClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeAWSEC2NetworkACLCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeAWSEC2NetworkACLCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeAWSEC2NetworkACL.
+func (c *BronzeAWSEC2NetworkACLClient) Update() *BronzeAWSEC2NetworkACLUpdate {
+	mutation := newBronzeAWSEC2NetworkACLMutation(c.config, OpUpdate)
+	return &BronzeAWSEC2NetworkACLUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeAWSEC2NetworkACLClient) UpdateOne(_m *BronzeAWSEC2NetworkACL) *BronzeAWSEC2NetworkACLUpdateOne {
+	mutation := newBronzeAWSEC2NetworkACLMutation(c.config, OpUpdateOne, withBronzeAWSEC2NetworkACL(_m))
+	return &BronzeAWSEC2NetworkACLUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeAWSEC2NetworkACLClient) UpdateOneID(id string) *BronzeAWSEC2NetworkACLUpdateOne {
+	mutation := newBronzeAWSEC2NetworkACLMutation(c.config, OpUpdateOne, withBronzeAWSEC2NetworkACLID(id))
+	return &BronzeAWSEC2NetworkACLUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeAWSEC2NetworkACL.
+func (c *BronzeAWSEC2NetworkACLClient) Delete() *BronzeAWSEC2NetworkACLDelete {
+	mutation := newBronzeAWSEC2NetworkACLMutation(c.config, OpDelete)
+	return &BronzeAWSEC2NetworkACLDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeAWSEC2NetworkACLClient) DeleteOne(_m *BronzeAWSEC2NetworkACL) *BronzeAWSEC2NetworkACLDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeAWSEC2NetworkACLClient) DeleteOneID(id string) *BronzeAWSEC2NetworkACLDeleteOne {
+	builder := c.Delete().Where(bronzeawsec2networkacl.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeAWSEC2NetworkACLDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeAWSEC2NetworkACL.
+func (c *BronzeAWSEC2NetworkACLClient) Query() *BronzeAWSEC2NetworkACLQuery {
+	return &BronzeAWSEC2NetworkACLQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeAWSEC2NetworkACL},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeAWSEC2NetworkACL entity by its id.
+func (c *BronzeAWSEC2NetworkACLClient) Get(ctx context.Context, id string) (*BronzeAWSEC2NetworkACL, error) {
+	return c.Query().Where(bronzeawsec2networkacl.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeAWSEC2NetworkACLClient) GetX(ctx context.Context, id string) *BronzeAWSEC2NetworkACL {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeAWSEC2NetworkACLClient) Hooks() []Hook {
+	return c.hooks.BronzeAWSEC2NetworkACL
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeAWSEC2NetworkACLClient) Interceptors() []Interceptor {
+	return c.inters.BronzeAWSEC2NetworkACL
+}
+
+func (c *BronzeAWSEC2NetworkACLClient) mutate(ctx context.Context, m *BronzeAWSEC2NetworkACLMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeAWSEC2NetworkACLCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeAWSEC2NetworkACLUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeAWSEC2NetworkACLUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeAWSEC2NetworkACLDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeAWSEC2NetworkACL mutation op: %q", m.Op())
+	}
+}
+
+// BronzeAWSEC2NetworkInterfaceClient is a client for the BronzeAWSEC2NetworkInterface schema.
+type BronzeAWSEC2NetworkInterfaceClient struct {
+	config
+}
+
+// NewBronzeAWSEC2NetworkInterfaceClient returns a client for the BronzeAWSEC2NetworkInterface from the given config.
+func NewBronzeAWSEC2NetworkInterfaceClient(c config) *BronzeAWSEC2NetworkInterfaceClient {
+	return &BronzeAWSEC2NetworkInterfaceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzeawsec2networkinterface.Hooks(f(g(h())))`.
+func (c *BronzeAWSEC2NetworkInterfaceClient) Use(hooks ...Hook) {
+	c.hooks.BronzeAWSEC2NetworkInterface = append(c.hooks.BronzeAWSEC2NetworkInterface, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzeawsec2networkinterface.Intercept(f(g(h())))`.
+func (c *BronzeAWSEC2NetworkInterfaceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeAWSEC2NetworkInterface = append(c.inters.BronzeAWSEC2NetworkInterface, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeAWSEC2NetworkInterface entity.
+func (c *BronzeAWSEC2NetworkInterfaceClient) Create() *BronzeAWSEC2NetworkInterfaceCreate {
+	mutation := newBronzeAWSEC2NetworkInterfaceMutation(c.config, OpCreate)
+	return &BronzeAWSEC2NetworkInterfaceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeAWSEC2NetworkInterface entities.
+func (c *BronzeAWSEC2NetworkInterfaceClient) CreateBulk(builders ...*BronzeAWSEC2NetworkInterfaceCreate) *BronzeAWSEC2NetworkInterfaceCreateBulk {
+	return &BronzeAWSEC2NetworkInterfaceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeAWSEC2NetworkInterfaceClient) MapCreateBulk(slice any, setFunc func(*BronzeAWSEC2NetworkInterfaceCreate, int)) *BronzeAWSEC2NetworkInterfaceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeAWSEC2NetworkInterfaceCreateBulk{err: fmt.Errorf("calling to BronzeAWSEC2NetworkInterfaceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeAWSEC2NetworkInterfaceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeAWSEC2NetworkInterfaceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeAWSEC2NetworkInterface.
+func (c *BronzeAWSEC2NetworkInterfaceClient) Update() *BronzeAWSEC2NetworkInterfaceUpdate {
+	mutation := newBronzeAWSEC2NetworkInterfaceMutation(c.config, OpUpdate)
+	return &BronzeAWSEC2NetworkInterfaceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeAWSEC2NetworkInterfaceClient) UpdateOne(_m *BronzeAWSEC2NetworkInterface) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	mutation := newBronzeAWSEC2NetworkInterfaceMutation(c.config, OpUpdateOne, withBronzeAWSEC2NetworkInterface(_m))
+	return &BronzeAWSEC2NetworkInterfaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeAWSEC2NetworkInterfaceClient) UpdateOneID(id string) *BronzeAWSEC2NetworkInterfaceUpdateOne {
+	mutation := newBronzeAWSEC2NetworkInterfaceMutation(c.config, OpUpdateOne, withBronzeAWSEC2NetworkInterfaceID(id))
+	return &BronzeAWSEC2NetworkInterfaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeAWSEC2NetworkInterface.
+func (c *BronzeAWSEC2NetworkInterfaceClient) Delete() *BronzeAWSEC2NetworkInterfaceDelete {
+	mutation := newBronzeAWSEC2NetworkInterfaceMutation(c.config, OpDelete)
+	return &BronzeAWSEC2NetworkInterfaceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeAWSEC2NetworkInterfaceClient) DeleteOne(_m *BronzeAWSEC2NetworkInterface) *BronzeAWSEC2NetworkInterfaceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeAWSEC2NetworkInterfaceClient) DeleteOneID(id string) *BronzeAWSEC2NetworkInterfaceDeleteOne {
+	builder := c.Delete().Where(bronzeawsec2networkinterface.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeAWSEC2NetworkInterfaceDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeAWSEC2NetworkInterface.
+func (c *BronzeAWSEC2NetworkInterfaceClient) Query() *BronzeAWSEC2NetworkInterfaceQuery {
+	return &BronzeAWSEC2NetworkInterfaceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeAWSEC2NetworkInterface},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeAWSEC2NetworkInterface entity by its id.
+func (c *BronzeAWSEC2NetworkInterfaceClient) Get(ctx context.Context, id string) (*BronzeAWSEC2NetworkInterface, error) {
+	return c.Query().Where(bronzeawsec2networkinterface.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeAWSEC2NetworkInterfaceClient) GetX(ctx context.Context, id string) *BronzeAWSEC2NetworkInterface {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeAWSEC2NetworkInterfaceClient) Hooks() []Hook {
+	return c.hooks.BronzeAWSEC2NetworkInterface
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeAWSEC2NetworkInterfaceClient) Interceptors() []Interceptor {
+	return c.inters.BronzeAWSEC2NetworkInterface
+}
+
+func (c *BronzeAWSEC2NetworkInterfaceClient) mutate(ctx context.Context, m *BronzeAWSEC2NetworkInterfaceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeAWSEC2NetworkInterfaceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeAWSEC2NetworkInterfaceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeAWSEC2NetworkInterfaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeAWSEC2NetworkInterfaceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeAWSEC2NetworkInterface mutation op: %q", m.Op())
+	}
+}
+
+// BronzeAWSEC2RouteTableClient is a client for the BronzeAWSEC2RouteTable schema.
+type BronzeAWSEC2RouteTableClient struct {
+	config
+}
+
+// NewBronzeAWSEC2RouteTableClient returns a client for the BronzeAWSEC2RouteTable from the given config.
+func NewBronzeAWSEC2RouteTableClient(c config) *BronzeAWSEC2RouteTableClient {
+	return &BronzeAWSEC2RouteTableClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzeawsec2routetable.Hooks(f(g(h())))`.
+func (c *BronzeAWSEC2RouteTableClient) Use(hooks ...Hook) {
+	c.hooks.BronzeAWSEC2RouteTable = append(c.hooks.BronzeAWSEC2RouteTable, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzeawsec2routetable.Intercept(f(g(h())))`.
+func (c *BronzeAWSEC2RouteTableClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeAWSEC2RouteTable = append(c.inters.BronzeAWSEC2RouteTable, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeAWSEC2RouteTable entity.
+func (c *BronzeAWSEC2RouteTableClient) Create() *BronzeAWSEC2RouteTableCreate {
+	mutation := newBronzeAWSEC2RouteTableMutation(c.config, OpCreate)
+	return &BronzeAWSEC2RouteTableCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeAWSEC2RouteTable entities.
+func (c *BronzeAWSEC2RouteTableClient) CreateBulk(builders ...*BronzeAWSEC2RouteTableCreate) *BronzeAWSEC2RouteTableCreateBulk {
+	return &BronzeAWSEC2RouteTableCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeAWSEC2RouteTableClient) MapCreateBulk(slice any, setFunc func(*BronzeAWSEC2RouteTableCreate, int)) *BronzeAWSEC2RouteTableCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeAWSEC2RouteTableCreateBulk{err: fmt.Errorf("calling to BronzeAWSEC2RouteTableClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeAWSEC2RouteTableCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeAWSEC2RouteTableCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeAWSEC2RouteTable.
+func (c *BronzeAWSEC2RouteTableClient) Update() *BronzeAWSEC2RouteTableUpdate {
+	mutation := newBronzeAWSEC2RouteTableMutation(c.config, OpUpdate)
+	return &BronzeAWSEC2RouteTableUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeAWSEC2RouteTableClient) UpdateOne(_m *BronzeAWSEC2RouteTable) *BronzeAWSEC2RouteTableUpdateOne {
+	mutation := newBronzeAWSEC2RouteTableMutation(c.config, OpUpdateOne, withBronzeAWSEC2RouteTable(_m))
+	return &BronzeAWSEC2RouteTableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeAWSEC2RouteTableClient) UpdateOneID(id string) *BronzeAWSEC2RouteTableUpdateOne {
+	mutation := newBronzeAWSEC2RouteTableMutation(c.config, OpUpdateOne, withBronzeAWSEC2RouteTableID(id))
+	return &BronzeAWSEC2RouteTableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeAWSEC2RouteTable.
+func (c *BronzeAWSEC2RouteTableClient) Delete() *BronzeAWSEC2RouteTableDelete {
+	mutation := newBronzeAWSEC2RouteTableMutation(c.config, OpDelete)
+	return &BronzeAWSEC2RouteTableDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeAWSEC2RouteTableClient) DeleteOne(_m *BronzeAWSEC2RouteTable) *BronzeAWSEC2RouteTableDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeAWSEC2RouteTableClient) DeleteOneID(id string) *BronzeAWSEC2RouteTableDeleteOne {
+	builder := c.Delete().Where(bronzeawsec2routetable.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeAWSEC2RouteTableDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeAWSEC2RouteTable.
+func (c *BronzeAWSEC2RouteTableClient) Query() *BronzeAWSEC2RouteTableQuery {
+	return &BronzeAWSEC2RouteTableQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeAWSEC2RouteTable},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeAWSEC2RouteTable entity by its id.
+func (c *BronzeAWSEC2RouteTableClient) Get(ctx context.Context, id string) (*BronzeAWSEC2RouteTable, error) {
+	return c.Query().Where(bronzeawsec2routetable.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeAWSEC2RouteTableClient) GetX(ctx context.Context, id string) *BronzeAWSEC2RouteTable {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeAWSEC2RouteTableClient) Hooks() []Hook {
+	return c.hooks.BronzeAWSEC2RouteTable
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeAWSEC2RouteTableClient) Interceptors() []Interceptor {
+	return c.inters.BronzeAWSEC2RouteTable
+}
+
+func (c *BronzeAWSEC2RouteTableClient) mutate(ctx context.Context, m *BronzeAWSEC2RouteTableMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeAWSEC2RouteTableCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeAWSEC2RouteTableUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeAWSEC2RouteTableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeAWSEC2RouteTableDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeAWSEC2RouteTable mutation op: %q", m.Op())
+	}
+}
+
+// BronzeAWSEC2SecurityGroupClient is a client for the BronzeAWSEC2SecurityGroup schema.
+type BronzeAWSEC2SecurityGroupClient struct {
+	config
+}
+
+// NewBronzeAWSEC2SecurityGroupClient returns a client for the BronzeAWSEC2SecurityGroup from the given config.
+func NewBronzeAWSEC2SecurityGroupClient(c config) *BronzeAWSEC2SecurityGroupClient {
+	return &BronzeAWSEC2SecurityGroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzeawsec2securitygroup.Hooks(f(g(h())))`.
+func (c *BronzeAWSEC2SecurityGroupClient) Use(hooks ...Hook) {
+	c.hooks.BronzeAWSEC2SecurityGroup = append(c.hooks.BronzeAWSEC2SecurityGroup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzeawsec2securitygroup.Intercept(f(g(h())))`.
+func (c *BronzeAWSEC2SecurityGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeAWSEC2SecurityGroup = append(c.inters.BronzeAWSEC2SecurityGroup, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeAWSEC2SecurityGroup entity.
+func (c *BronzeAWSEC2SecurityGroupClient) Create() *BronzeAWSEC2SecurityGroupCreate {
+	mutation := newBronzeAWSEC2SecurityGroupMutation(c.config, OpCreate)
+	return &BronzeAWSEC2SecurityGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeAWSEC2SecurityGroup entities.
+func (c *BronzeAWSEC2SecurityGroupClient) CreateBulk(builders ...*BronzeAWSEC2SecurityGroupCreate) *BronzeAWSEC2SecurityGroupCreateBulk {
+	return &BronzeAWSEC2SecurityGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeAWSEC2SecurityGroupClient) MapCreateBulk(slice any, setFunc func(*BronzeAWSEC2SecurityGroupCreate, int)) *BronzeAWSEC2SecurityGroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeAWSEC2SecurityGroupCreateBulk{err: fmt.Errorf("calling to BronzeAWSEC2SecurityGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeAWSEC2SecurityGroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeAWSEC2SecurityGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeAWSEC2SecurityGroup.
+func (c *BronzeAWSEC2SecurityGroupClient) Update() *BronzeAWSEC2SecurityGroupUpdate {
+	mutation := newBronzeAWSEC2SecurityGroupMutation(c.config, OpUpdate)
+	return &BronzeAWSEC2SecurityGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeAWSEC2SecurityGroupClient) UpdateOne(_m *BronzeAWSEC2SecurityGroup) *BronzeAWSEC2SecurityGroupUpdateOne {
+	mutation := newBronzeAWSEC2SecurityGroupMutation(c.config, OpUpdateOne, withBronzeAWSEC2SecurityGroup(_m))
+	return &BronzeAWSEC2SecurityGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeAWSEC2SecurityGroupClient) UpdateOneID(id string) *BronzeAWSEC2SecurityGroupUpdateOne {
+	mutation := newBronzeAWSEC2SecurityGroupMutation(c.config, OpUpdateOne, withBronzeAWSEC2SecurityGroupID(id))
+	return &BronzeAWSEC2SecurityGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeAWSEC2SecurityGroup.
+func (c *BronzeAWSEC2SecurityGroupClient) Delete() *BronzeAWSEC2SecurityGroupDelete {
+	mutation := newBronzeAWSEC2SecurityGroupMutation(c.config, OpDelete)
+	return &BronzeAWSEC2SecurityGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeAWSEC2SecurityGroupClient) DeleteOne(_m *BronzeAWSEC2SecurityGroup) *BronzeAWSEC2SecurityGroupDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeAWSEC2SecurityGroupClient) DeleteOneID(id string) *BronzeAWSEC2SecurityGroupDeleteOne {
+	builder := c.Delete().Where(bronzeawsec2securitygroup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeAWSEC2SecurityGroupDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeAWSEC2SecurityGroup.
+func (c *BronzeAWSEC2SecurityGroupClient) Query() *BronzeAWSEC2SecurityGroupQuery {
+	return &BronzeAWSEC2SecurityGroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeAWSEC2SecurityGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeAWSEC2SecurityGroup entity by its id.
+func (c *BronzeAWSEC2SecurityGroupClient) Get(ctx context.Context, id string) (*BronzeAWSEC2SecurityGroup, error) {
+	return c.Query().Where(bronzeawsec2securitygroup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeAWSEC2SecurityGroupClient) GetX(ctx context.Context, id string) *BronzeAWSEC2SecurityGroup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeAWSEC2SecurityGroupClient) Hooks() []Hook {
+	return c.hooks.BronzeAWSEC2SecurityGroup
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeAWSEC2SecurityGroupClient) Interceptors() []Interceptor {
+	return c.inters.BronzeAWSEC2SecurityGroup
+}
+
+func (c *BronzeAWSEC2SecurityGroupClient) mutate(ctx context.Context, m *BronzeAWSEC2SecurityGroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeAWSEC2SecurityGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeAWSEC2SecurityGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeAWSEC2SecurityGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeAWSEC2SecurityGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeAWSEC2SecurityGroup mutation op: %q", m.Op())
+	}
+}
+
+// BronzeAWSEC2SecurityGroupRuleClient is a client for the BronzeAWSEC2SecurityGroupRule schema.
+type BronzeAWSEC2SecurityGroupRuleClient struct {
+	config
+}
+
+// NewBronzeAWSEC2SecurityGroupRuleClient returns a client for the BronzeAWSEC2SecurityGroupRule from the given config.
+func NewBronzeAWSEC2SecurityGroupRuleClient(c config) *BronzeAWSEC2SecurityGroupRuleClient {
+	return &BronzeAWSEC2SecurityGroupRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzeawsec2securitygrouprule.Hooks(f(g(h())))`.
+func (c *BronzeAWSEC2SecurityGroupRuleClient) Use(hooks ...Hook) {
+	c.hooks.BronzeAWSEC2SecurityGroupRule = append(c.hooks.BronzeAWSEC2SecurityGroupRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzeawsec2securitygrouprule.Intercept(f(g(h())))`.
+func (c *BronzeAWSEC2SecurityGroupRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeAWSEC2SecurityGroupRule = append(c.inters.BronzeAWSEC2SecurityGroupRule, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeAWSEC2SecurityGroupRule entity.
+func (c *BronzeAWSEC2SecurityGroupRuleClient) Create() *BronzeAWSEC2SecurityGroupRuleCreate {
+	mutation := newBronzeAWSEC2SecurityGroupRuleMutation(c.config, OpCreate)
+	return &BronzeAWSEC2SecurityGroupRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeAWSEC2SecurityGroupRule entities.
+func (c *BronzeAWSEC2SecurityGroupRuleClient) CreateBulk(builders ...*BronzeAWSEC2SecurityGroupRuleCreate) *BronzeAWSEC2SecurityGroupRuleCreateBulk {
+	return &BronzeAWSEC2SecurityGroupRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeAWSEC2SecurityGroupRuleClient) MapCreateBulk(slice any, setFunc func(*BronzeAWSEC2SecurityGroupRuleCreate, int)) *BronzeAWSEC2SecurityGroupRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeAWSEC2SecurityGroupRuleCreateBulk{err: fmt.Errorf("calling to BronzeAWSEC2SecurityGroupRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeAWSEC2SecurityGroupRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeAWSEC2SecurityGroupRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeAWSEC2SecurityGroupRule.
+func (c *BronzeAWSEC2SecurityGroupRuleClient) Update() *BronzeAWSEC2SecurityGroupRuleUpdate {
+	mutation := newBronzeAWSEC2SecurityGroupRuleMutation(c.config, OpUpdate)
+	return &BronzeAWSEC2SecurityGroupRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeAWSEC2SecurityGroupRuleClient) UpdateOne(_m *BronzeAWSEC2SecurityGroupRule) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	mutation := newBronzeAWSEC2SecurityGroupRuleMutation(c.config, OpUpdateOne, withBronzeAWSEC2SecurityGroupRule(_m))
+	return &BronzeAWSEC2SecurityGroupRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeAWSEC2SecurityGroupRuleClient) UpdateOneID(id string) *BronzeAWSEC2SecurityGroupRuleUpdateOne {
+	mutation := newBronzeAWSEC2SecurityGroupRuleMutation(c.config, OpUpdateOne, withBronzeAWSEC2SecurityGroupRuleID(id))
+	return &BronzeAWSEC2SecurityGroupRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeAWSEC2SecurityGroupRule.
+func (c *BronzeAWSEC2SecurityGroupRuleClient) Delete() *BronzeAWSEC2SecurityGroupRuleDelete {
+	mutation := newBronzeAWSEC2SecurityGroupRuleMutation(c.config, OpDelete)
+	return &BronzeAWSEC2SecurityGroupRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeAWSEC2SecurityGroupRuleClient) DeleteOne(_m *BronzeAWSEC2SecurityGroupRule) *BronzeAWSEC2SecurityGroupRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeAWSEC2SecurityGroupRuleClient) DeleteOneID(id string) *BronzeAWSEC2SecurityGroupRuleDeleteOne {
+	builder := c.Delete().Where(bronzeawsec2securitygrouprule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeAWSEC2SecurityGroupRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeAWSEC2SecurityGroupRule.
+func (c *BronzeAWSEC2SecurityGroupRuleClient) Query() *BronzeAWSEC2SecurityGroupRuleQuery {
+	return &BronzeAWSEC2SecurityGroupRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeAWSEC2SecurityGroupRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeAWSEC2SecurityGroupRule entity by its id.
+func (c *BronzeAWSEC2SecurityGroupRuleClient) Get(ctx context.Context, id string) (*BronzeAWSEC2SecurityGroupRule, error) {
+	return c.Query().Where(bronzeawsec2securitygrouprule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeAWSEC2SecurityGroupRuleClient) GetX(ctx context.Context, id string) *BronzeAWSEC2SecurityGroupRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeAWSEC2SecurityGroupRuleClient) Hooks() []Hook {
+	return c.hooks.BronzeAWSEC2SecurityGroupRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeAWSEC2SecurityGroupRuleClient) Interceptors() []Interceptor {
+	return c.inters.BronzeAWSEC2SecurityGroupRule
+}
+
+func (c *BronzeAWSEC2SecurityGroupRuleClient) mutate(ctx context.Context, m *BronzeAWSEC2SecurityGroupRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeAWSEC2SecurityGroupRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeAWSEC2SecurityGroupRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeAWSEC2SecurityGroupRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeAWSEC2SecurityGroupRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeAWSEC2SecurityGroupRule mutation op: %q", m.Op())
+	}
+}
+
+// BronzeAWSEC2SubnetClient is a client for the BronzeAWSEC2Subnet schema.
+type BronzeAWSEC2SubnetClient struct {
+	config
+}
+
+// NewBronzeAWSEC2SubnetClient returns a client for the BronzeAWSEC2Subnet from the given config.
+func NewBronzeAWSEC2SubnetClient(c config) *BronzeAWSEC2SubnetClient {
+	return &BronzeAWSEC2SubnetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzeawsec2subnet.Hooks(f(g(h())))`.
+func (c *BronzeAWSEC2SubnetClient) Use(hooks ...Hook) {
+	c.hooks.BronzeAWSEC2Subnet = append(c.hooks.BronzeAWSEC2Subnet, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzeawsec2subnet.Intercept(f(g(h())))`.
+func (c *BronzeAWSEC2SubnetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeAWSEC2Subnet = append(c.inters.BronzeAWSEC2Subnet, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeAWSEC2Subnet entity.
+func (c *BronzeAWSEC2SubnetClient) Create() *BronzeAWSEC2SubnetCreate {
+	mutation := newBronzeAWSEC2SubnetMutation(c.config, OpCreate)
+	return &BronzeAWSEC2SubnetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeAWSEC2Subnet entities.
+func (c *BronzeAWSEC2SubnetClient) CreateBulk(builders ...*BronzeAWSEC2SubnetCreate) *BronzeAWSEC2SubnetCreateBulk {
+	return &BronzeAWSEC2SubnetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeAWSEC2SubnetClient) MapCreateBulk(slice any, setFunc func(*BronzeAWSEC2SubnetCreate, int)) *BronzeAWSEC2SubnetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeAWSEC2SubnetCreateBulk{err: fmt.Errorf("calling to BronzeAWSEC2SubnetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeAWSEC2SubnetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeAWSEC2SubnetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeAWSEC2Subnet.
+func (c *BronzeAWSEC2SubnetClient) Update() *BronzeAWSEC2SubnetUpdate {
+	mutation := newBronzeAWSEC2SubnetMutation(c.config, OpUpdate)
+	return &BronzeAWSEC2SubnetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeAWSEC2SubnetClient) UpdateOne(_m *BronzeAWSEC2Subnet) *BronzeAWSEC2SubnetUpdateOne {
+	mutation := newBronzeAWSEC2SubnetMutation(c.config, OpUpdateOne, withBronzeAWSEC2Subnet(_m))
+	return &BronzeAWSEC2SubnetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeAWSEC2SubnetClient) UpdateOneID(id string) *BronzeAWSEC2SubnetUpdateOne {
+	mutation := newBronzeAWSEC2SubnetMutation(c.config, OpUpdateOne, withBronzeAWSEC2SubnetID(id))
+	return &BronzeAWSEC2SubnetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeAWSEC2Subnet.
+func (c *BronzeAWSEC2SubnetClient) Delete() *BronzeAWSEC2SubnetDelete {
+	mutation := newBronzeAWSEC2SubnetMutation(c.config, OpDelete)
+	return &BronzeAWSEC2SubnetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeAWSEC2SubnetClient) DeleteOne(_m *BronzeAWSEC2Subnet) *BronzeAWSEC2SubnetDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeAWSEC2SubnetClient) DeleteOneID(id string) *BronzeAWSEC2SubnetDeleteOne {
+	builder := c.Delete().Where(bronzeawsec2subnet.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeAWSEC2SubnetDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeAWSEC2Subnet.
+func (c *BronzeAWSEC2SubnetClient) Query() *BronzeAWSEC2SubnetQuery {
+	return &BronzeAWSEC2SubnetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeAWSEC2Subnet},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeAWSEC2Subnet entity by its id.
+func (c *BronzeAWSEC2SubnetClient) Get(ctx context.Context, id string) (*BronzeAWSEC2Subnet, error) {
+	return c.Query().Where(bronzeawsec2subnet.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeAWSEC2SubnetClient) GetX(ctx context.Context, id string) *BronzeAWSEC2Subnet {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeAWSEC2SubnetClient) Hooks() []Hook {
+	return c.hooks.BronzeAWSEC2Subnet
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeAWSEC2SubnetClient) Interceptors() []Interceptor {
+	return c.inters.BronzeAWSEC2Subnet
+}
+
+func (c *BronzeAWSEC2SubnetClient) mutate(ctx context.Context, m *BronzeAWSEC2SubnetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeAWSEC2SubnetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeAWSEC2SubnetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeAWSEC2SubnetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeAWSEC2SubnetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeAWSEC2Subnet mutation op: %q", m.Op())
+	}
+}
+
+// BronzeAWSEC2VPCClient is a client for the BronzeAWSEC2VPC schema.
+type BronzeAWSEC2VPCClient struct {
+	config
+}
+
+// NewBronzeAWSEC2VPCClient returns a client for the BronzeAWSEC2VPC from the given config.
+func NewBronzeAWSEC2VPCClient(c config) *BronzeAWSEC2VPCClient {
+	return &BronzeAWSEC2VPCClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzeawsec2vpc.Hooks(f(g(h())))`.
+func (c *BronzeAWSEC2VPCClient) Use(hooks ...Hook) {
+	c.hooks.BronzeAWSEC2VPC = append(c.hooks.BronzeAWSEC2VPC, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzeawsec2vpc.Intercept(f(g(h())))`.
+func (c *BronzeAWSEC2VPCClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeAWSEC2VPC = append(c.inters.BronzeAWSEC2VPC, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeAWSEC2VPC entity.
+func (c *BronzeAWSEC2VPCClient) Create() *BronzeAWSEC2VPCCreate {
+	mutation := newBronzeAWSEC2VPCMutation(c.config, OpCreate)
+	return &BronzeAWSEC2VPCCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeAWSEC2VPC entities.
+func (c *BronzeAWSEC2VPCClient) CreateBulk(builders ...*BronzeAWSEC2VPCCreate) *BronzeAWSEC2VPCCreateBulk {
+	return &BronzeAWSEC2VPCCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeAWSEC2VPCClient) MapCreateBulk(slice any, setFunc func(*BronzeAWSEC2VPCCreate, int)) *BronzeAWSEC2VPCCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeAWSEC2VPCCreateBulk{err: fmt.Errorf("calling to BronzeAWSEC2VPCClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeAWSEC2VPCCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeAWSEC2VPCCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeAWSEC2VPC.
+func (c *BronzeAWSEC2VPCClient) Update() *BronzeAWSEC2VPCUpdate {
+	mutation := newBronzeAWSEC2VPCMutation(c.config, OpUpdate)
+	return &BronzeAWSEC2VPCUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeAWSEC2VPCClient) UpdateOne(_m *BronzeAWSEC2VPC) *BronzeAWSEC2VPCUpdateOne {
+	mutation := newBronzeAWSEC2VPCMutation(c.config, OpUpdateOne, withBronzeAWSEC2VPC(_m))
+	return &BronzeAWSEC2VPCUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeAWSEC2VPCClient) UpdateOneID(id string) *BronzeAWSEC2VPCUpdateOne {
+	mutation := newBronzeAWSEC2VPCMutation(c.config, OpUpdateOne, withBronzeAWSEC2VPCID(id))
+	return &BronzeAWSEC2VPCUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeAWSEC2VPC.
+func (c *BronzeAWSEC2VPCClient) Delete() *BronzeAWSEC2VPCDelete {
+	mutation := newBronzeAWSEC2VPCMutation(c.config, OpDelete)
+	return &BronzeAWSEC2VPCDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeAWSEC2VPCClient) DeleteOne(_m *BronzeAWSEC2VPC) *BronzeAWSEC2VPCDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeAWSEC2VPCClient) DeleteOneID(id string) *BronzeAWSEC2VPCDeleteOne {
+	builder := c.Delete().Where(bronzeawsec2vpc.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeAWSEC2VPCDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeAWSEC2VPC.
+func (c *BronzeAWSEC2VPCClient) Query() *BronzeAWSEC2VPCQuery {
+	return &BronzeAWSEC2VPCQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeAWSEC2VPC},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeAWSEC2VPC entity by its id.
+func (c *BronzeAWSEC2VPCClient) Get(ctx context.Context, id string) (*BronzeAWSEC2VPC, error) {
+	return c.Query().Where(bronzeawsec2vpc.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeAWSEC2VPCClient) GetX(ctx context.Context, id string) *BronzeAWSEC2VPC {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeAWSEC2VPCClient) Hooks() []Hook {
+	return c.hooks.BronzeAWSEC2VPC
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeAWSEC2VPCClient) Interceptors() []Interceptor {
+	return c.inters.BronzeAWSEC2VPC
+}
+
+func (c *BronzeAWSEC2VPCClient) mutate(ctx context.Context, m *BronzeAWSEC2VPCMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeAWSEC2VPCCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeAWSEC2VPCUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeAWSEC2VPCUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeAWSEC2VPCDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeAWSEC2VPC mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSEC2AddressClient is a client for the BronzeHistoryAWSEC2Address schema.
+type BronzeHistoryAWSEC2AddressClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSEC2AddressClient returns a client for the BronzeHistoryAWSEC2Address from the given config.
+func NewBronzeHistoryAWSEC2AddressClient(c config) *BronzeHistoryAWSEC2AddressClient {
+	return &BronzeHistoryAWSEC2AddressClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawsec2address.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2AddressClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSEC2Address = append(c.hooks.BronzeHistoryAWSEC2Address, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsec2address.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2AddressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSEC2Address = append(c.inters.BronzeHistoryAWSEC2Address, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSEC2Address entity.
+func (c *BronzeHistoryAWSEC2AddressClient) Create() *BronzeHistoryAWSEC2AddressCreate {
+	mutation := newBronzeHistoryAWSEC2AddressMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSEC2AddressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSEC2Address entities.
+func (c *BronzeHistoryAWSEC2AddressClient) CreateBulk(builders ...*BronzeHistoryAWSEC2AddressCreate) *BronzeHistoryAWSEC2AddressCreateBulk {
+	return &BronzeHistoryAWSEC2AddressCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSEC2AddressClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSEC2AddressCreate, int)) *BronzeHistoryAWSEC2AddressCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSEC2AddressCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSEC2AddressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSEC2AddressCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSEC2AddressCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSEC2Address.
+func (c *BronzeHistoryAWSEC2AddressClient) Update() *BronzeHistoryAWSEC2AddressUpdate {
+	mutation := newBronzeHistoryAWSEC2AddressMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSEC2AddressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSEC2AddressClient) UpdateOne(_m *BronzeHistoryAWSEC2Address) *BronzeHistoryAWSEC2AddressUpdateOne {
+	mutation := newBronzeHistoryAWSEC2AddressMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2Address(_m))
+	return &BronzeHistoryAWSEC2AddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSEC2AddressClient) UpdateOneID(id uint) *BronzeHistoryAWSEC2AddressUpdateOne {
+	mutation := newBronzeHistoryAWSEC2AddressMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2AddressID(id))
+	return &BronzeHistoryAWSEC2AddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSEC2Address.
+func (c *BronzeHistoryAWSEC2AddressClient) Delete() *BronzeHistoryAWSEC2AddressDelete {
+	mutation := newBronzeHistoryAWSEC2AddressMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSEC2AddressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSEC2AddressClient) DeleteOne(_m *BronzeHistoryAWSEC2Address) *BronzeHistoryAWSEC2AddressDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSEC2AddressClient) DeleteOneID(id uint) *BronzeHistoryAWSEC2AddressDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawsec2address.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSEC2AddressDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSEC2Address.
+func (c *BronzeHistoryAWSEC2AddressClient) Query() *BronzeHistoryAWSEC2AddressQuery {
+	return &BronzeHistoryAWSEC2AddressQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSEC2Address},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSEC2Address entity by its id.
+func (c *BronzeHistoryAWSEC2AddressClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSEC2Address, error) {
+	return c.Query().Where(bronzehistoryawsec2address.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSEC2AddressClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSEC2Address {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSEC2AddressClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSEC2Address
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSEC2AddressClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSEC2Address
+}
+
+func (c *BronzeHistoryAWSEC2AddressClient) mutate(ctx context.Context, m *BronzeHistoryAWSEC2AddressMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSEC2AddressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSEC2AddressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSEC2AddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSEC2AddressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeHistoryAWSEC2Address mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSEC2InstanceClient is a client for the BronzeHistoryAWSEC2Instance schema.
+type BronzeHistoryAWSEC2InstanceClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSEC2InstanceClient returns a client for the BronzeHistoryAWSEC2Instance from the given config.
+func NewBronzeHistoryAWSEC2InstanceClient(c config) *BronzeHistoryAWSEC2InstanceClient {
+	return &BronzeHistoryAWSEC2InstanceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawsec2instance.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2InstanceClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSEC2Instance = append(c.hooks.BronzeHistoryAWSEC2Instance, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsec2instance.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2InstanceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSEC2Instance = append(c.inters.BronzeHistoryAWSEC2Instance, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSEC2Instance entity.
+func (c *BronzeHistoryAWSEC2InstanceClient) Create() *BronzeHistoryAWSEC2InstanceCreate {
+	mutation := newBronzeHistoryAWSEC2InstanceMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSEC2InstanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSEC2Instance entities.
+func (c *BronzeHistoryAWSEC2InstanceClient) CreateBulk(builders ...*BronzeHistoryAWSEC2InstanceCreate) *BronzeHistoryAWSEC2InstanceCreateBulk {
+	return &BronzeHistoryAWSEC2InstanceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSEC2InstanceClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSEC2InstanceCreate, int)) *BronzeHistoryAWSEC2InstanceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSEC2InstanceCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSEC2InstanceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSEC2InstanceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSEC2InstanceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSEC2Instance.
+func (c *BronzeHistoryAWSEC2InstanceClient) Update() *BronzeHistoryAWSEC2InstanceUpdate {
+	mutation := newBronzeHistoryAWSEC2InstanceMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSEC2InstanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSEC2InstanceClient) UpdateOne(_m *BronzeHistoryAWSEC2Instance) *BronzeHistoryAWSEC2InstanceUpdateOne {
+	mutation := newBronzeHistoryAWSEC2InstanceMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2Instance(_m))
+	return &BronzeHistoryAWSEC2InstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSEC2InstanceClient) UpdateOneID(id uint) *BronzeHistoryAWSEC2InstanceUpdateOne {
+	mutation := newBronzeHistoryAWSEC2InstanceMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2InstanceID(id))
+	return &BronzeHistoryAWSEC2InstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSEC2Instance.
+func (c *BronzeHistoryAWSEC2InstanceClient) Delete() *BronzeHistoryAWSEC2InstanceDelete {
+	mutation := newBronzeHistoryAWSEC2InstanceMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSEC2InstanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSEC2InstanceClient) DeleteOne(_m *BronzeHistoryAWSEC2Instance) *BronzeHistoryAWSEC2InstanceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSEC2InstanceClient) DeleteOneID(id uint) *BronzeHistoryAWSEC2InstanceDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawsec2instance.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSEC2InstanceDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSEC2Instance.
+func (c *BronzeHistoryAWSEC2InstanceClient) Query() *BronzeHistoryAWSEC2InstanceQuery {
+	return &BronzeHistoryAWSEC2InstanceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSEC2Instance},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSEC2Instance entity by its id.
+func (c *BronzeHistoryAWSEC2InstanceClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSEC2Instance, error) {
+	return c.Query().Where(bronzehistoryawsec2instance.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSEC2InstanceClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSEC2Instance {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSEC2InstanceClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSEC2Instance
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSEC2InstanceClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSEC2Instance
+}
+
+func (c *BronzeHistoryAWSEC2InstanceClient) mutate(ctx context.Context, m *BronzeHistoryAWSEC2InstanceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSEC2InstanceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSEC2InstanceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSEC2InstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSEC2InstanceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeHistoryAWSEC2Instance mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSEC2InstanceTagClient is a client for the BronzeHistoryAWSEC2InstanceTag schema.
+type BronzeHistoryAWSEC2InstanceTagClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSEC2InstanceTagClient returns a client for the BronzeHistoryAWSEC2InstanceTag from the given config.
+func NewBronzeHistoryAWSEC2InstanceTagClient(c config) *BronzeHistoryAWSEC2InstanceTagClient {
+	return &BronzeHistoryAWSEC2InstanceTagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawsec2instancetag.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2InstanceTagClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSEC2InstanceTag = append(c.hooks.BronzeHistoryAWSEC2InstanceTag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsec2instancetag.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2InstanceTagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSEC2InstanceTag = append(c.inters.BronzeHistoryAWSEC2InstanceTag, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSEC2InstanceTag entity.
+func (c *BronzeHistoryAWSEC2InstanceTagClient) Create() *BronzeHistoryAWSEC2InstanceTagCreate {
+	mutation := newBronzeHistoryAWSEC2InstanceTagMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSEC2InstanceTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSEC2InstanceTag entities.
+func (c *BronzeHistoryAWSEC2InstanceTagClient) CreateBulk(builders ...*BronzeHistoryAWSEC2InstanceTagCreate) *BronzeHistoryAWSEC2InstanceTagCreateBulk {
+	return &BronzeHistoryAWSEC2InstanceTagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSEC2InstanceTagClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSEC2InstanceTagCreate, int)) *BronzeHistoryAWSEC2InstanceTagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSEC2InstanceTagCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSEC2InstanceTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSEC2InstanceTagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSEC2InstanceTagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSEC2InstanceTag.
+func (c *BronzeHistoryAWSEC2InstanceTagClient) Update() *BronzeHistoryAWSEC2InstanceTagUpdate {
+	mutation := newBronzeHistoryAWSEC2InstanceTagMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSEC2InstanceTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSEC2InstanceTagClient) UpdateOne(_m *BronzeHistoryAWSEC2InstanceTag) *BronzeHistoryAWSEC2InstanceTagUpdateOne {
+	mutation := newBronzeHistoryAWSEC2InstanceTagMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2InstanceTag(_m))
+	return &BronzeHistoryAWSEC2InstanceTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSEC2InstanceTagClient) UpdateOneID(id uint) *BronzeHistoryAWSEC2InstanceTagUpdateOne {
+	mutation := newBronzeHistoryAWSEC2InstanceTagMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2InstanceTagID(id))
+	return &BronzeHistoryAWSEC2InstanceTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSEC2InstanceTag.
+func (c *BronzeHistoryAWSEC2InstanceTagClient) Delete() *BronzeHistoryAWSEC2InstanceTagDelete {
+	mutation := newBronzeHistoryAWSEC2InstanceTagMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSEC2InstanceTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSEC2InstanceTagClient) DeleteOne(_m *BronzeHistoryAWSEC2InstanceTag) *BronzeHistoryAWSEC2InstanceTagDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSEC2InstanceTagClient) DeleteOneID(id uint) *BronzeHistoryAWSEC2InstanceTagDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawsec2instancetag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSEC2InstanceTagDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSEC2InstanceTag.
+func (c *BronzeHistoryAWSEC2InstanceTagClient) Query() *BronzeHistoryAWSEC2InstanceTagQuery {
+	return &BronzeHistoryAWSEC2InstanceTagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSEC2InstanceTag},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSEC2InstanceTag entity by its id.
+func (c *BronzeHistoryAWSEC2InstanceTagClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSEC2InstanceTag, error) {
+	return c.Query().Where(bronzehistoryawsec2instancetag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSEC2InstanceTagClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSEC2InstanceTag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSEC2InstanceTagClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSEC2InstanceTag
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSEC2InstanceTagClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSEC2InstanceTag
+}
+
+func (c *BronzeHistoryAWSEC2InstanceTagClient) mutate(ctx context.Context, m *BronzeHistoryAWSEC2InstanceTagMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSEC2InstanceTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSEC2InstanceTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSEC2InstanceTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSEC2InstanceTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeHistoryAWSEC2InstanceTag mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSEC2InternetGatewayClient is a client for the BronzeHistoryAWSEC2InternetGateway schema.
+type BronzeHistoryAWSEC2InternetGatewayClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSEC2InternetGatewayClient returns a client for the BronzeHistoryAWSEC2InternetGateway from the given config.
+func NewBronzeHistoryAWSEC2InternetGatewayClient(c config) *BronzeHistoryAWSEC2InternetGatewayClient {
+	return &BronzeHistoryAWSEC2InternetGatewayClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawsec2internetgateway.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2InternetGatewayClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSEC2InternetGateway = append(c.hooks.BronzeHistoryAWSEC2InternetGateway, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsec2internetgateway.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2InternetGatewayClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSEC2InternetGateway = append(c.inters.BronzeHistoryAWSEC2InternetGateway, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSEC2InternetGateway entity.
+func (c *BronzeHistoryAWSEC2InternetGatewayClient) Create() *BronzeHistoryAWSEC2InternetGatewayCreate {
+	mutation := newBronzeHistoryAWSEC2InternetGatewayMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSEC2InternetGatewayCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSEC2InternetGateway entities.
+func (c *BronzeHistoryAWSEC2InternetGatewayClient) CreateBulk(builders ...*BronzeHistoryAWSEC2InternetGatewayCreate) *BronzeHistoryAWSEC2InternetGatewayCreateBulk {
+	return &BronzeHistoryAWSEC2InternetGatewayCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSEC2InternetGatewayClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSEC2InternetGatewayCreate, int)) *BronzeHistoryAWSEC2InternetGatewayCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSEC2InternetGatewayCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSEC2InternetGatewayClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSEC2InternetGatewayCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSEC2InternetGatewayCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSEC2InternetGateway.
+func (c *BronzeHistoryAWSEC2InternetGatewayClient) Update() *BronzeHistoryAWSEC2InternetGatewayUpdate {
+	mutation := newBronzeHistoryAWSEC2InternetGatewayMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSEC2InternetGatewayUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSEC2InternetGatewayClient) UpdateOne(_m *BronzeHistoryAWSEC2InternetGateway) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	mutation := newBronzeHistoryAWSEC2InternetGatewayMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2InternetGateway(_m))
+	return &BronzeHistoryAWSEC2InternetGatewayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSEC2InternetGatewayClient) UpdateOneID(id uint) *BronzeHistoryAWSEC2InternetGatewayUpdateOne {
+	mutation := newBronzeHistoryAWSEC2InternetGatewayMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2InternetGatewayID(id))
+	return &BronzeHistoryAWSEC2InternetGatewayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSEC2InternetGateway.
+func (c *BronzeHistoryAWSEC2InternetGatewayClient) Delete() *BronzeHistoryAWSEC2InternetGatewayDelete {
+	mutation := newBronzeHistoryAWSEC2InternetGatewayMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSEC2InternetGatewayDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSEC2InternetGatewayClient) DeleteOne(_m *BronzeHistoryAWSEC2InternetGateway) *BronzeHistoryAWSEC2InternetGatewayDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSEC2InternetGatewayClient) DeleteOneID(id uint) *BronzeHistoryAWSEC2InternetGatewayDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawsec2internetgateway.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSEC2InternetGatewayDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSEC2InternetGateway.
+func (c *BronzeHistoryAWSEC2InternetGatewayClient) Query() *BronzeHistoryAWSEC2InternetGatewayQuery {
+	return &BronzeHistoryAWSEC2InternetGatewayQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSEC2InternetGateway},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSEC2InternetGateway entity by its id.
+func (c *BronzeHistoryAWSEC2InternetGatewayClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSEC2InternetGateway, error) {
+	return c.Query().Where(bronzehistoryawsec2internetgateway.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSEC2InternetGatewayClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSEC2InternetGateway {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSEC2InternetGatewayClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSEC2InternetGateway
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSEC2InternetGatewayClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSEC2InternetGateway
+}
+
+func (c *BronzeHistoryAWSEC2InternetGatewayClient) mutate(ctx context.Context, m *BronzeHistoryAWSEC2InternetGatewayMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSEC2InternetGatewayCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSEC2InternetGatewayUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSEC2InternetGatewayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSEC2InternetGatewayDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeHistoryAWSEC2InternetGateway mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSEC2NATGatewayClient is a client for the BronzeHistoryAWSEC2NATGateway schema.
+type BronzeHistoryAWSEC2NATGatewayClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSEC2NATGatewayClient returns a client for the BronzeHistoryAWSEC2NATGateway from the given config.
+func NewBronzeHistoryAWSEC2NATGatewayClient(c config) *BronzeHistoryAWSEC2NATGatewayClient {
+	return &BronzeHistoryAWSEC2NATGatewayClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawsec2natgateway.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2NATGatewayClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSEC2NATGateway = append(c.hooks.BronzeHistoryAWSEC2NATGateway, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsec2natgateway.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2NATGatewayClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSEC2NATGateway = append(c.inters.BronzeHistoryAWSEC2NATGateway, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSEC2NATGateway entity.
+func (c *BronzeHistoryAWSEC2NATGatewayClient) Create() *BronzeHistoryAWSEC2NATGatewayCreate {
+	mutation := newBronzeHistoryAWSEC2NATGatewayMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSEC2NATGatewayCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSEC2NATGateway entities.
+func (c *BronzeHistoryAWSEC2NATGatewayClient) CreateBulk(builders ...*BronzeHistoryAWSEC2NATGatewayCreate) *BronzeHistoryAWSEC2NATGatewayCreateBulk {
+	return &BronzeHistoryAWSEC2NATGatewayCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSEC2NATGatewayClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSEC2NATGatewayCreate, int)) *BronzeHistoryAWSEC2NATGatewayCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSEC2NATGatewayCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSEC2NATGatewayClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSEC2NATGatewayCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSEC2NATGatewayCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSEC2NATGateway.
+func (c *BronzeHistoryAWSEC2NATGatewayClient) Update() *BronzeHistoryAWSEC2NATGatewayUpdate {
+	mutation := newBronzeHistoryAWSEC2NATGatewayMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSEC2NATGatewayUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSEC2NATGatewayClient) UpdateOne(_m *BronzeHistoryAWSEC2NATGateway) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	mutation := newBronzeHistoryAWSEC2NATGatewayMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2NATGateway(_m))
+	return &BronzeHistoryAWSEC2NATGatewayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSEC2NATGatewayClient) UpdateOneID(id uint) *BronzeHistoryAWSEC2NATGatewayUpdateOne {
+	mutation := newBronzeHistoryAWSEC2NATGatewayMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2NATGatewayID(id))
+	return &BronzeHistoryAWSEC2NATGatewayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSEC2NATGateway.
+func (c *BronzeHistoryAWSEC2NATGatewayClient) Delete() *BronzeHistoryAWSEC2NATGatewayDelete {
+	mutation := newBronzeHistoryAWSEC2NATGatewayMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSEC2NATGatewayDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSEC2NATGatewayClient) DeleteOne(_m *BronzeHistoryAWSEC2NATGateway) *BronzeHistoryAWSEC2NATGatewayDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSEC2NATGatewayClient) DeleteOneID(id uint) *BronzeHistoryAWSEC2NATGatewayDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawsec2natgateway.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSEC2NATGatewayDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSEC2NATGateway.
+func (c *BronzeHistoryAWSEC2NATGatewayClient) Query() *BronzeHistoryAWSEC2NATGatewayQuery {
+	return &BronzeHistoryAWSEC2NATGatewayQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSEC2NATGateway},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSEC2NATGateway entity by its id.
+func (c *BronzeHistoryAWSEC2NATGatewayClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSEC2NATGateway, error) {
+	return c.Query().Where(bronzehistoryawsec2natgateway.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSEC2NATGatewayClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSEC2NATGateway {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSEC2NATGatewayClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSEC2NATGateway
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSEC2NATGatewayClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSEC2NATGateway
+}
+
+func (c *BronzeHistoryAWSEC2NATGatewayClient) mutate(ctx context.Context, m *BronzeHistoryAWSEC2NATGatewayMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSEC2NATGatewayCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSEC2NATGatewayUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSEC2NATGatewayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSEC2NATGatewayDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeHistoryAWSEC2NATGateway mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSEC2NetworkACLClient is a client for the BronzeHistoryAWSEC2NetworkACL schema.
+type BronzeHistoryAWSEC2NetworkACLClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSEC2NetworkACLClient returns a client for the BronzeHistoryAWSEC2NetworkACL from the given config.
+func NewBronzeHistoryAWSEC2NetworkACLClient(c config) *BronzeHistoryAWSEC2NetworkACLClient {
+	return &BronzeHistoryAWSEC2NetworkACLClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawsec2networkacl.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2NetworkACLClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSEC2NetworkACL = append(c.hooks.BronzeHistoryAWSEC2NetworkACL, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsec2networkacl.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2NetworkACLClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSEC2NetworkACL = append(c.inters.BronzeHistoryAWSEC2NetworkACL, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSEC2NetworkACL entity.
+func (c *BronzeHistoryAWSEC2NetworkACLClient) Create() *BronzeHistoryAWSEC2NetworkACLCreate {
+	mutation := newBronzeHistoryAWSEC2NetworkACLMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSEC2NetworkACLCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSEC2NetworkACL entities.
+func (c *BronzeHistoryAWSEC2NetworkACLClient) CreateBulk(builders ...*BronzeHistoryAWSEC2NetworkACLCreate) *BronzeHistoryAWSEC2NetworkACLCreateBulk {
+	return &BronzeHistoryAWSEC2NetworkACLCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSEC2NetworkACLClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSEC2NetworkACLCreate, int)) *BronzeHistoryAWSEC2NetworkACLCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSEC2NetworkACLCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSEC2NetworkACLClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSEC2NetworkACLCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSEC2NetworkACLCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSEC2NetworkACL.
+func (c *BronzeHistoryAWSEC2NetworkACLClient) Update() *BronzeHistoryAWSEC2NetworkACLUpdate {
+	mutation := newBronzeHistoryAWSEC2NetworkACLMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSEC2NetworkACLUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSEC2NetworkACLClient) UpdateOne(_m *BronzeHistoryAWSEC2NetworkACL) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	mutation := newBronzeHistoryAWSEC2NetworkACLMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2NetworkACL(_m))
+	return &BronzeHistoryAWSEC2NetworkACLUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSEC2NetworkACLClient) UpdateOneID(id uint) *BronzeHistoryAWSEC2NetworkACLUpdateOne {
+	mutation := newBronzeHistoryAWSEC2NetworkACLMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2NetworkACLID(id))
+	return &BronzeHistoryAWSEC2NetworkACLUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSEC2NetworkACL.
+func (c *BronzeHistoryAWSEC2NetworkACLClient) Delete() *BronzeHistoryAWSEC2NetworkACLDelete {
+	mutation := newBronzeHistoryAWSEC2NetworkACLMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSEC2NetworkACLDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSEC2NetworkACLClient) DeleteOne(_m *BronzeHistoryAWSEC2NetworkACL) *BronzeHistoryAWSEC2NetworkACLDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSEC2NetworkACLClient) DeleteOneID(id uint) *BronzeHistoryAWSEC2NetworkACLDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawsec2networkacl.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSEC2NetworkACLDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSEC2NetworkACL.
+func (c *BronzeHistoryAWSEC2NetworkACLClient) Query() *BronzeHistoryAWSEC2NetworkACLQuery {
+	return &BronzeHistoryAWSEC2NetworkACLQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSEC2NetworkACL},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSEC2NetworkACL entity by its id.
+func (c *BronzeHistoryAWSEC2NetworkACLClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSEC2NetworkACL, error) {
+	return c.Query().Where(bronzehistoryawsec2networkacl.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSEC2NetworkACLClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSEC2NetworkACL {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSEC2NetworkACLClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSEC2NetworkACL
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSEC2NetworkACLClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSEC2NetworkACL
+}
+
+func (c *BronzeHistoryAWSEC2NetworkACLClient) mutate(ctx context.Context, m *BronzeHistoryAWSEC2NetworkACLMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSEC2NetworkACLCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSEC2NetworkACLUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSEC2NetworkACLUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSEC2NetworkACLDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeHistoryAWSEC2NetworkACL mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSEC2NetworkInterfaceClient is a client for the BronzeHistoryAWSEC2NetworkInterface schema.
+type BronzeHistoryAWSEC2NetworkInterfaceClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSEC2NetworkInterfaceClient returns a client for the BronzeHistoryAWSEC2NetworkInterface from the given config.
+func NewBronzeHistoryAWSEC2NetworkInterfaceClient(c config) *BronzeHistoryAWSEC2NetworkInterfaceClient {
+	return &BronzeHistoryAWSEC2NetworkInterfaceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawsec2networkinterface.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2NetworkInterfaceClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSEC2NetworkInterface = append(c.hooks.BronzeHistoryAWSEC2NetworkInterface, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsec2networkinterface.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2NetworkInterfaceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSEC2NetworkInterface = append(c.inters.BronzeHistoryAWSEC2NetworkInterface, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSEC2NetworkInterface entity.
+func (c *BronzeHistoryAWSEC2NetworkInterfaceClient) Create() *BronzeHistoryAWSEC2NetworkInterfaceCreate {
+	mutation := newBronzeHistoryAWSEC2NetworkInterfaceMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSEC2NetworkInterfaceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSEC2NetworkInterface entities.
+func (c *BronzeHistoryAWSEC2NetworkInterfaceClient) CreateBulk(builders ...*BronzeHistoryAWSEC2NetworkInterfaceCreate) *BronzeHistoryAWSEC2NetworkInterfaceCreateBulk {
+	return &BronzeHistoryAWSEC2NetworkInterfaceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSEC2NetworkInterfaceClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSEC2NetworkInterfaceCreate, int)) *BronzeHistoryAWSEC2NetworkInterfaceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSEC2NetworkInterfaceCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSEC2NetworkInterfaceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSEC2NetworkInterfaceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSEC2NetworkInterfaceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSEC2NetworkInterface.
+func (c *BronzeHistoryAWSEC2NetworkInterfaceClient) Update() *BronzeHistoryAWSEC2NetworkInterfaceUpdate {
+	mutation := newBronzeHistoryAWSEC2NetworkInterfaceMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSEC2NetworkInterfaceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSEC2NetworkInterfaceClient) UpdateOne(_m *BronzeHistoryAWSEC2NetworkInterface) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	mutation := newBronzeHistoryAWSEC2NetworkInterfaceMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2NetworkInterface(_m))
+	return &BronzeHistoryAWSEC2NetworkInterfaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSEC2NetworkInterfaceClient) UpdateOneID(id uint) *BronzeHistoryAWSEC2NetworkInterfaceUpdateOne {
+	mutation := newBronzeHistoryAWSEC2NetworkInterfaceMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2NetworkInterfaceID(id))
+	return &BronzeHistoryAWSEC2NetworkInterfaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSEC2NetworkInterface.
+func (c *BronzeHistoryAWSEC2NetworkInterfaceClient) Delete() *BronzeHistoryAWSEC2NetworkInterfaceDelete {
+	mutation := newBronzeHistoryAWSEC2NetworkInterfaceMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSEC2NetworkInterfaceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSEC2NetworkInterfaceClient) DeleteOne(_m *BronzeHistoryAWSEC2NetworkInterface) *BronzeHistoryAWSEC2NetworkInterfaceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSEC2NetworkInterfaceClient) DeleteOneID(id uint) *BronzeHistoryAWSEC2NetworkInterfaceDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawsec2networkinterface.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSEC2NetworkInterfaceDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSEC2NetworkInterface.
+func (c *BronzeHistoryAWSEC2NetworkInterfaceClient) Query() *BronzeHistoryAWSEC2NetworkInterfaceQuery {
+	return &BronzeHistoryAWSEC2NetworkInterfaceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSEC2NetworkInterface},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSEC2NetworkInterface entity by its id.
+func (c *BronzeHistoryAWSEC2NetworkInterfaceClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSEC2NetworkInterface, error) {
+	return c.Query().Where(bronzehistoryawsec2networkinterface.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSEC2NetworkInterfaceClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSEC2NetworkInterface {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSEC2NetworkInterfaceClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSEC2NetworkInterface
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSEC2NetworkInterfaceClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSEC2NetworkInterface
+}
+
+func (c *BronzeHistoryAWSEC2NetworkInterfaceClient) mutate(ctx context.Context, m *BronzeHistoryAWSEC2NetworkInterfaceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSEC2NetworkInterfaceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSEC2NetworkInterfaceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSEC2NetworkInterfaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSEC2NetworkInterfaceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeHistoryAWSEC2NetworkInterface mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSEC2RouteTableClient is a client for the BronzeHistoryAWSEC2RouteTable schema.
+type BronzeHistoryAWSEC2RouteTableClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSEC2RouteTableClient returns a client for the BronzeHistoryAWSEC2RouteTable from the given config.
+func NewBronzeHistoryAWSEC2RouteTableClient(c config) *BronzeHistoryAWSEC2RouteTableClient {
+	return &BronzeHistoryAWSEC2RouteTableClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawsec2routetable.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2RouteTableClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSEC2RouteTable = append(c.hooks.BronzeHistoryAWSEC2RouteTable, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsec2routetable.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2RouteTableClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSEC2RouteTable = append(c.inters.BronzeHistoryAWSEC2RouteTable, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSEC2RouteTable entity.
+func (c *BronzeHistoryAWSEC2RouteTableClient) Create() *BronzeHistoryAWSEC2RouteTableCreate {
+	mutation := newBronzeHistoryAWSEC2RouteTableMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSEC2RouteTableCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSEC2RouteTable entities.
+func (c *BronzeHistoryAWSEC2RouteTableClient) CreateBulk(builders ...*BronzeHistoryAWSEC2RouteTableCreate) *BronzeHistoryAWSEC2RouteTableCreateBulk {
+	return &BronzeHistoryAWSEC2RouteTableCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSEC2RouteTableClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSEC2RouteTableCreate, int)) *BronzeHistoryAWSEC2RouteTableCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSEC2RouteTableCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSEC2RouteTableClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSEC2RouteTableCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSEC2RouteTableCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSEC2RouteTable.
+func (c *BronzeHistoryAWSEC2RouteTableClient) Update() *BronzeHistoryAWSEC2RouteTableUpdate {
+	mutation := newBronzeHistoryAWSEC2RouteTableMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSEC2RouteTableUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSEC2RouteTableClient) UpdateOne(_m *BronzeHistoryAWSEC2RouteTable) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	mutation := newBronzeHistoryAWSEC2RouteTableMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2RouteTable(_m))
+	return &BronzeHistoryAWSEC2RouteTableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSEC2RouteTableClient) UpdateOneID(id uint) *BronzeHistoryAWSEC2RouteTableUpdateOne {
+	mutation := newBronzeHistoryAWSEC2RouteTableMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2RouteTableID(id))
+	return &BronzeHistoryAWSEC2RouteTableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSEC2RouteTable.
+func (c *BronzeHistoryAWSEC2RouteTableClient) Delete() *BronzeHistoryAWSEC2RouteTableDelete {
+	mutation := newBronzeHistoryAWSEC2RouteTableMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSEC2RouteTableDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSEC2RouteTableClient) DeleteOne(_m *BronzeHistoryAWSEC2RouteTable) *BronzeHistoryAWSEC2RouteTableDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSEC2RouteTableClient) DeleteOneID(id uint) *BronzeHistoryAWSEC2RouteTableDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawsec2routetable.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSEC2RouteTableDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSEC2RouteTable.
+func (c *BronzeHistoryAWSEC2RouteTableClient) Query() *BronzeHistoryAWSEC2RouteTableQuery {
+	return &BronzeHistoryAWSEC2RouteTableQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSEC2RouteTable},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSEC2RouteTable entity by its id.
+func (c *BronzeHistoryAWSEC2RouteTableClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSEC2RouteTable, error) {
+	return c.Query().Where(bronzehistoryawsec2routetable.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSEC2RouteTableClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSEC2RouteTable {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSEC2RouteTableClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSEC2RouteTable
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSEC2RouteTableClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSEC2RouteTable
+}
+
+func (c *BronzeHistoryAWSEC2RouteTableClient) mutate(ctx context.Context, m *BronzeHistoryAWSEC2RouteTableMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSEC2RouteTableCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSEC2RouteTableUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSEC2RouteTableUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSEC2RouteTableDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeHistoryAWSEC2RouteTable mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSEC2SecurityGroupClient is a client for the BronzeHistoryAWSEC2SecurityGroup schema.
+type BronzeHistoryAWSEC2SecurityGroupClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSEC2SecurityGroupClient returns a client for the BronzeHistoryAWSEC2SecurityGroup from the given config.
+func NewBronzeHistoryAWSEC2SecurityGroupClient(c config) *BronzeHistoryAWSEC2SecurityGroupClient {
+	return &BronzeHistoryAWSEC2SecurityGroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawsec2securitygroup.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2SecurityGroupClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSEC2SecurityGroup = append(c.hooks.BronzeHistoryAWSEC2SecurityGroup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsec2securitygroup.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2SecurityGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSEC2SecurityGroup = append(c.inters.BronzeHistoryAWSEC2SecurityGroup, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSEC2SecurityGroup entity.
+func (c *BronzeHistoryAWSEC2SecurityGroupClient) Create() *BronzeHistoryAWSEC2SecurityGroupCreate {
+	mutation := newBronzeHistoryAWSEC2SecurityGroupMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSEC2SecurityGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSEC2SecurityGroup entities.
+func (c *BronzeHistoryAWSEC2SecurityGroupClient) CreateBulk(builders ...*BronzeHistoryAWSEC2SecurityGroupCreate) *BronzeHistoryAWSEC2SecurityGroupCreateBulk {
+	return &BronzeHistoryAWSEC2SecurityGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSEC2SecurityGroupClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSEC2SecurityGroupCreate, int)) *BronzeHistoryAWSEC2SecurityGroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSEC2SecurityGroupCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSEC2SecurityGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSEC2SecurityGroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSEC2SecurityGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSEC2SecurityGroup.
+func (c *BronzeHistoryAWSEC2SecurityGroupClient) Update() *BronzeHistoryAWSEC2SecurityGroupUpdate {
+	mutation := newBronzeHistoryAWSEC2SecurityGroupMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSEC2SecurityGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSEC2SecurityGroupClient) UpdateOne(_m *BronzeHistoryAWSEC2SecurityGroup) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	mutation := newBronzeHistoryAWSEC2SecurityGroupMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2SecurityGroup(_m))
+	return &BronzeHistoryAWSEC2SecurityGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSEC2SecurityGroupClient) UpdateOneID(id uint) *BronzeHistoryAWSEC2SecurityGroupUpdateOne {
+	mutation := newBronzeHistoryAWSEC2SecurityGroupMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2SecurityGroupID(id))
+	return &BronzeHistoryAWSEC2SecurityGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSEC2SecurityGroup.
+func (c *BronzeHistoryAWSEC2SecurityGroupClient) Delete() *BronzeHistoryAWSEC2SecurityGroupDelete {
+	mutation := newBronzeHistoryAWSEC2SecurityGroupMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSEC2SecurityGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSEC2SecurityGroupClient) DeleteOne(_m *BronzeHistoryAWSEC2SecurityGroup) *BronzeHistoryAWSEC2SecurityGroupDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSEC2SecurityGroupClient) DeleteOneID(id uint) *BronzeHistoryAWSEC2SecurityGroupDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawsec2securitygroup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSEC2SecurityGroupDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSEC2SecurityGroup.
+func (c *BronzeHistoryAWSEC2SecurityGroupClient) Query() *BronzeHistoryAWSEC2SecurityGroupQuery {
+	return &BronzeHistoryAWSEC2SecurityGroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSEC2SecurityGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSEC2SecurityGroup entity by its id.
+func (c *BronzeHistoryAWSEC2SecurityGroupClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSEC2SecurityGroup, error) {
+	return c.Query().Where(bronzehistoryawsec2securitygroup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSEC2SecurityGroupClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSEC2SecurityGroup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSEC2SecurityGroupClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSEC2SecurityGroup
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSEC2SecurityGroupClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSEC2SecurityGroup
+}
+
+func (c *BronzeHistoryAWSEC2SecurityGroupClient) mutate(ctx context.Context, m *BronzeHistoryAWSEC2SecurityGroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSEC2SecurityGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSEC2SecurityGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSEC2SecurityGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSEC2SecurityGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeHistoryAWSEC2SecurityGroup mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSEC2SecurityGroupRuleClient is a client for the BronzeHistoryAWSEC2SecurityGroupRule schema.
+type BronzeHistoryAWSEC2SecurityGroupRuleClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSEC2SecurityGroupRuleClient returns a client for the BronzeHistoryAWSEC2SecurityGroupRule from the given config.
+func NewBronzeHistoryAWSEC2SecurityGroupRuleClient(c config) *BronzeHistoryAWSEC2SecurityGroupRuleClient {
+	return &BronzeHistoryAWSEC2SecurityGroupRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawsec2securitygrouprule.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2SecurityGroupRuleClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSEC2SecurityGroupRule = append(c.hooks.BronzeHistoryAWSEC2SecurityGroupRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsec2securitygrouprule.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2SecurityGroupRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSEC2SecurityGroupRule = append(c.inters.BronzeHistoryAWSEC2SecurityGroupRule, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSEC2SecurityGroupRule entity.
+func (c *BronzeHistoryAWSEC2SecurityGroupRuleClient) Create() *BronzeHistoryAWSEC2SecurityGroupRuleCreate {
+	mutation := newBronzeHistoryAWSEC2SecurityGroupRuleMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSEC2SecurityGroupRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSEC2SecurityGroupRule entities.
+func (c *BronzeHistoryAWSEC2SecurityGroupRuleClient) CreateBulk(builders ...*BronzeHistoryAWSEC2SecurityGroupRuleCreate) *BronzeHistoryAWSEC2SecurityGroupRuleCreateBulk {
+	return &BronzeHistoryAWSEC2SecurityGroupRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSEC2SecurityGroupRuleClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSEC2SecurityGroupRuleCreate, int)) *BronzeHistoryAWSEC2SecurityGroupRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSEC2SecurityGroupRuleCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSEC2SecurityGroupRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSEC2SecurityGroupRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSEC2SecurityGroupRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSEC2SecurityGroupRule.
+func (c *BronzeHistoryAWSEC2SecurityGroupRuleClient) Update() *BronzeHistoryAWSEC2SecurityGroupRuleUpdate {
+	mutation := newBronzeHistoryAWSEC2SecurityGroupRuleMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSEC2SecurityGroupRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSEC2SecurityGroupRuleClient) UpdateOne(_m *BronzeHistoryAWSEC2SecurityGroupRule) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	mutation := newBronzeHistoryAWSEC2SecurityGroupRuleMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2SecurityGroupRule(_m))
+	return &BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSEC2SecurityGroupRuleClient) UpdateOneID(id uint) *BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne {
+	mutation := newBronzeHistoryAWSEC2SecurityGroupRuleMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2SecurityGroupRuleID(id))
+	return &BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSEC2SecurityGroupRule.
+func (c *BronzeHistoryAWSEC2SecurityGroupRuleClient) Delete() *BronzeHistoryAWSEC2SecurityGroupRuleDelete {
+	mutation := newBronzeHistoryAWSEC2SecurityGroupRuleMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSEC2SecurityGroupRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSEC2SecurityGroupRuleClient) DeleteOne(_m *BronzeHistoryAWSEC2SecurityGroupRule) *BronzeHistoryAWSEC2SecurityGroupRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSEC2SecurityGroupRuleClient) DeleteOneID(id uint) *BronzeHistoryAWSEC2SecurityGroupRuleDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawsec2securitygrouprule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSEC2SecurityGroupRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSEC2SecurityGroupRule.
+func (c *BronzeHistoryAWSEC2SecurityGroupRuleClient) Query() *BronzeHistoryAWSEC2SecurityGroupRuleQuery {
+	return &BronzeHistoryAWSEC2SecurityGroupRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSEC2SecurityGroupRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSEC2SecurityGroupRule entity by its id.
+func (c *BronzeHistoryAWSEC2SecurityGroupRuleClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSEC2SecurityGroupRule, error) {
+	return c.Query().Where(bronzehistoryawsec2securitygrouprule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSEC2SecurityGroupRuleClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSEC2SecurityGroupRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSEC2SecurityGroupRuleClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSEC2SecurityGroupRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSEC2SecurityGroupRuleClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSEC2SecurityGroupRule
+}
+
+func (c *BronzeHistoryAWSEC2SecurityGroupRuleClient) mutate(ctx context.Context, m *BronzeHistoryAWSEC2SecurityGroupRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSEC2SecurityGroupRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSEC2SecurityGroupRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSEC2SecurityGroupRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSEC2SecurityGroupRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeHistoryAWSEC2SecurityGroupRule mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSEC2SubnetClient is a client for the BronzeHistoryAWSEC2Subnet schema.
+type BronzeHistoryAWSEC2SubnetClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSEC2SubnetClient returns a client for the BronzeHistoryAWSEC2Subnet from the given config.
+func NewBronzeHistoryAWSEC2SubnetClient(c config) *BronzeHistoryAWSEC2SubnetClient {
+	return &BronzeHistoryAWSEC2SubnetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawsec2subnet.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2SubnetClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSEC2Subnet = append(c.hooks.BronzeHistoryAWSEC2Subnet, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsec2subnet.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2SubnetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSEC2Subnet = append(c.inters.BronzeHistoryAWSEC2Subnet, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSEC2Subnet entity.
+func (c *BronzeHistoryAWSEC2SubnetClient) Create() *BronzeHistoryAWSEC2SubnetCreate {
+	mutation := newBronzeHistoryAWSEC2SubnetMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSEC2SubnetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSEC2Subnet entities.
+func (c *BronzeHistoryAWSEC2SubnetClient) CreateBulk(builders ...*BronzeHistoryAWSEC2SubnetCreate) *BronzeHistoryAWSEC2SubnetCreateBulk {
+	return &BronzeHistoryAWSEC2SubnetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSEC2SubnetClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSEC2SubnetCreate, int)) *BronzeHistoryAWSEC2SubnetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSEC2SubnetCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSEC2SubnetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSEC2SubnetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSEC2SubnetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSEC2Subnet.
+func (c *BronzeHistoryAWSEC2SubnetClient) Update() *BronzeHistoryAWSEC2SubnetUpdate {
+	mutation := newBronzeHistoryAWSEC2SubnetMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSEC2SubnetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSEC2SubnetClient) UpdateOne(_m *BronzeHistoryAWSEC2Subnet) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	mutation := newBronzeHistoryAWSEC2SubnetMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2Subnet(_m))
+	return &BronzeHistoryAWSEC2SubnetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSEC2SubnetClient) UpdateOneID(id uint) *BronzeHistoryAWSEC2SubnetUpdateOne {
+	mutation := newBronzeHistoryAWSEC2SubnetMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2SubnetID(id))
+	return &BronzeHistoryAWSEC2SubnetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSEC2Subnet.
+func (c *BronzeHistoryAWSEC2SubnetClient) Delete() *BronzeHistoryAWSEC2SubnetDelete {
+	mutation := newBronzeHistoryAWSEC2SubnetMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSEC2SubnetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSEC2SubnetClient) DeleteOne(_m *BronzeHistoryAWSEC2Subnet) *BronzeHistoryAWSEC2SubnetDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSEC2SubnetClient) DeleteOneID(id uint) *BronzeHistoryAWSEC2SubnetDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawsec2subnet.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSEC2SubnetDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSEC2Subnet.
+func (c *BronzeHistoryAWSEC2SubnetClient) Query() *BronzeHistoryAWSEC2SubnetQuery {
+	return &BronzeHistoryAWSEC2SubnetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSEC2Subnet},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSEC2Subnet entity by its id.
+func (c *BronzeHistoryAWSEC2SubnetClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSEC2Subnet, error) {
+	return c.Query().Where(bronzehistoryawsec2subnet.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSEC2SubnetClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSEC2Subnet {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSEC2SubnetClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSEC2Subnet
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSEC2SubnetClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSEC2Subnet
+}
+
+func (c *BronzeHistoryAWSEC2SubnetClient) mutate(ctx context.Context, m *BronzeHistoryAWSEC2SubnetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSEC2SubnetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSEC2SubnetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSEC2SubnetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSEC2SubnetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeHistoryAWSEC2Subnet mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSEC2VPCClient is a client for the BronzeHistoryAWSEC2VPC schema.
+type BronzeHistoryAWSEC2VPCClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSEC2VPCClient returns a client for the BronzeHistoryAWSEC2VPC from the given config.
+func NewBronzeHistoryAWSEC2VPCClient(c config) *BronzeHistoryAWSEC2VPCClient {
+	return &BronzeHistoryAWSEC2VPCClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawsec2vpc.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2VPCClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSEC2VPC = append(c.hooks.BronzeHistoryAWSEC2VPC, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsec2vpc.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSEC2VPCClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSEC2VPC = append(c.inters.BronzeHistoryAWSEC2VPC, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSEC2VPC entity.
+func (c *BronzeHistoryAWSEC2VPCClient) Create() *BronzeHistoryAWSEC2VPCCreate {
+	mutation := newBronzeHistoryAWSEC2VPCMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSEC2VPCCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSEC2VPC entities.
+func (c *BronzeHistoryAWSEC2VPCClient) CreateBulk(builders ...*BronzeHistoryAWSEC2VPCCreate) *BronzeHistoryAWSEC2VPCCreateBulk {
+	return &BronzeHistoryAWSEC2VPCCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSEC2VPCClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSEC2VPCCreate, int)) *BronzeHistoryAWSEC2VPCCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSEC2VPCCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSEC2VPCClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSEC2VPCCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSEC2VPCCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSEC2VPC.
+func (c *BronzeHistoryAWSEC2VPCClient) Update() *BronzeHistoryAWSEC2VPCUpdate {
+	mutation := newBronzeHistoryAWSEC2VPCMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSEC2VPCUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSEC2VPCClient) UpdateOne(_m *BronzeHistoryAWSEC2VPC) *BronzeHistoryAWSEC2VPCUpdateOne {
+	mutation := newBronzeHistoryAWSEC2VPCMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2VPC(_m))
+	return &BronzeHistoryAWSEC2VPCUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSEC2VPCClient) UpdateOneID(id uint) *BronzeHistoryAWSEC2VPCUpdateOne {
+	mutation := newBronzeHistoryAWSEC2VPCMutation(c.config, OpUpdateOne, withBronzeHistoryAWSEC2VPCID(id))
+	return &BronzeHistoryAWSEC2VPCUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSEC2VPC.
+func (c *BronzeHistoryAWSEC2VPCClient) Delete() *BronzeHistoryAWSEC2VPCDelete {
+	mutation := newBronzeHistoryAWSEC2VPCMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSEC2VPCDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSEC2VPCClient) DeleteOne(_m *BronzeHistoryAWSEC2VPC) *BronzeHistoryAWSEC2VPCDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSEC2VPCClient) DeleteOneID(id uint) *BronzeHistoryAWSEC2VPCDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawsec2vpc.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSEC2VPCDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSEC2VPC.
+func (c *BronzeHistoryAWSEC2VPCClient) Query() *BronzeHistoryAWSEC2VPCQuery {
+	return &BronzeHistoryAWSEC2VPCQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSEC2VPC},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSEC2VPC entity by its id.
+func (c *BronzeHistoryAWSEC2VPCClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSEC2VPC, error) {
+	return c.Query().Where(bronzehistoryawsec2vpc.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSEC2VPCClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSEC2VPC {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSEC2VPCClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSEC2VPC
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSEC2VPCClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSEC2VPC
+}
+
+func (c *BronzeHistoryAWSEC2VPCClient) mutate(ctx context.Context, m *BronzeHistoryAWSEC2VPCMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSEC2VPCCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSEC2VPCUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSEC2VPCUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSEC2VPCDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ec2: unknown BronzeHistoryAWSEC2VPC mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		BronzeAWSEC2Instance, BronzeAWSEC2InstanceTag, BronzeHistoryAWSEC2Instance,
-		BronzeHistoryAWSEC2InstanceTag []ent.Hook
+		BronzeAWSEC2Address, BronzeAWSEC2Instance, BronzeAWSEC2InstanceTag,
+		BronzeAWSEC2InternetGateway, BronzeAWSEC2NATGateway, BronzeAWSEC2NetworkACL,
+		BronzeAWSEC2NetworkInterface, BronzeAWSEC2RouteTable,
+		BronzeAWSEC2SecurityGroup, BronzeAWSEC2SecurityGroupRule, BronzeAWSEC2Subnet,
+		BronzeAWSEC2VPC, BronzeHistoryAWSEC2Address, BronzeHistoryAWSEC2Instance,
+		BronzeHistoryAWSEC2InstanceTag, BronzeHistoryAWSEC2InternetGateway,
+		BronzeHistoryAWSEC2NATGateway, BronzeHistoryAWSEC2NetworkACL,
+		BronzeHistoryAWSEC2NetworkInterface, BronzeHistoryAWSEC2RouteTable,
+		BronzeHistoryAWSEC2SecurityGroup, BronzeHistoryAWSEC2SecurityGroupRule,
+		BronzeHistoryAWSEC2Subnet, BronzeHistoryAWSEC2VPC []ent.Hook
 	}
 	inters struct {
-		BronzeAWSEC2Instance, BronzeAWSEC2InstanceTag, BronzeHistoryAWSEC2Instance,
-		BronzeHistoryAWSEC2InstanceTag []ent.Interceptor
+		BronzeAWSEC2Address, BronzeAWSEC2Instance, BronzeAWSEC2InstanceTag,
+		BronzeAWSEC2InternetGateway, BronzeAWSEC2NATGateway, BronzeAWSEC2NetworkACL,
+		BronzeAWSEC2NetworkInterface, BronzeAWSEC2RouteTable,
+		BronzeAWSEC2SecurityGroup, BronzeAWSEC2SecurityGroupRule, BronzeAWSEC2Subnet,
+		BronzeAWSEC2VPC, BronzeHistoryAWSEC2Address, BronzeHistoryAWSEC2Instance,
+		BronzeHistoryAWSEC2InstanceTag, BronzeHistoryAWSEC2InternetGateway,
+		BronzeHistoryAWSEC2NATGateway, BronzeHistoryAWSEC2NetworkACL,
+		BronzeHistoryAWSEC2NetworkInterface, BronzeHistoryAWSEC2RouteTable,
+		BronzeHistoryAWSEC2SecurityGroup, BronzeHistoryAWSEC2SecurityGroupRule,
+		BronzeHistoryAWSEC2Subnet, BronzeHistoryAWSEC2VPC []ent.Interceptor
 	}
 )
 
@@ -9,10 +9,30 @@ import (
 	"reflect"
 	"sync"
 
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2address"
 	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2instance"
 	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2instancetag"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2internetgateway"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2natgateway"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2networkacl"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2networkinterface"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2routetable"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2securitygroup"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2securitygrouprule"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2subnet"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2vpc"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2address"
 	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2instance"
 	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2instancetag"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2internetgateway"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2natgateway"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2networkacl"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2networkinterface"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2routetable"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2securitygroup"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2securitygrouprule"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2subnet"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2vpc"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -76,10 +96,30 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			bronzeawsec2instance.Table:           bronzeawsec2instance.ValidColumn,
-			bronzeawsec2instancetag.Table:        bronzeawsec2instancetag.ValidColumn,
-			bronzehistoryawsec2instance.Table:    bronzehistoryawsec2instance.ValidColumn,
-			bronzehistoryawsec2instancetag.Table: bronzehistoryawsec2instancetag.ValidColumn,
+			bronzeawsec2address.Table:                  bronzeawsec2address.ValidColumn,
+			bronzeawsec2instance.Table:                 bronzeawsec2instance.ValidColumn,
+			bronzeawsec2instancetag.Table:              bronzeawsec2instancetag.ValidColumn,
+			bronzeawsec2internetgateway.Table:          bronzeawsec2internetgateway.ValidColumn,
+			bronzeawsec2natgateway.Table:               bronzeawsec2natgateway.ValidColumn,
+			bronzeawsec2networkacl.Table:               bronzeawsec2networkacl.ValidColumn,
+			bronzeawsec2networkinterface.Table:         bronzeawsec2networkinterface.ValidColumn,
+			bronzeawsec2routetable.Table:               bronzeawsec2routetable.ValidColumn,
+			bronzeawsec2securitygroup.Table:            bronzeawsec2securitygroup.ValidColumn,
+			bronzeawsec2securitygrouprule.Table:        bronzeawsec2securitygrouprule.ValidColumn,
+			bronzeawsec2subnet.Table:                   bronzeawsec2subnet.ValidColumn,
+			bronzeawsec2vpc.Table:                      bronzeawsec2vpc.ValidColumn,
+			bronzehistoryawsec2address.Table:           bronzehistoryawsec2address.ValidColumn,
+			bronzehistoryawsec2instance.Table:          bronzehistoryawsec2instance.ValidColumn,
+			bronzehistoryawsec2instancetag.Table:       bronzehistoryawsec2instancetag.ValidColumn,
+			bronzehistoryawsec2internetgateway.Table:   bronzehistoryawsec2internetgateway.ValidColumn,
+			bronzehistoryawsec2natgateway.Table:        bronzehistoryawsec2natgateway.ValidColumn,
+			bronzehistoryawsec2networkacl.Table:        bronzehistoryawsec2networkacl.ValidColumn,
+			bronzehistoryawsec2networkinterface.Table:  bronzehistoryawsec2networkinterface.ValidColumn,
+			bronzehistoryawsec2routetable.Table:        bronzehistoryawsec2routetable.ValidColumn,
+			bronzehistoryawsec2securitygroup.Table:     bronzehistoryawsec2securitygroup.ValidColumn,
+			bronzehistoryawsec2securitygrouprule.Table: bronzehistoryawsec2securitygrouprule.ValidColumn,
+			bronzehistoryawsec2subnet.Table:            bronzehistoryawsec2subnet.ValidColumn,
+			bronzehistoryawsec2vpc.Table:               bronzehistoryawsec2vpc.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
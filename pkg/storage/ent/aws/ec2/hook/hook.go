@@ -9,6 +9,18 @@ import (
 	"danny.vn/hotpot/pkg/storage/ent/aws/ec2"
 )
 
+// The BronzeAWSEC2AddressFunc type is an adapter to allow the use of ordinary
+// function as BronzeAWSEC2Address mutator.
+type BronzeAWSEC2AddressFunc func(context.Context, *ec2.BronzeAWSEC2AddressMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeAWSEC2AddressFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeAWSEC2AddressMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeAWSEC2AddressMutation", m)
+}
+
 // The BronzeAWSEC2InstanceFunc type is an adapter to allow the use of ordinary
 // function as BronzeAWSEC2Instance mutator.
 type BronzeAWSEC2InstanceFunc func(context.Context, *ec2.BronzeAWSEC2InstanceMutation) (ec2.Value, error)
@@ -33,6 +45,126 @@ func (f BronzeAWSEC2InstanceTagFunc) Mutate(ctx context.Context, m ec2.Mutation)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeAWSEC2InstanceTagMutation", m)
 }
 
+// The BronzeAWSEC2InternetGatewayFunc type is an adapter to allow the use of ordinary
+// function as BronzeAWSEC2InternetGateway mutator.
+type BronzeAWSEC2InternetGatewayFunc func(context.Context, *ec2.BronzeAWSEC2InternetGatewayMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeAWSEC2InternetGatewayFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeAWSEC2InternetGatewayMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeAWSEC2InternetGatewayMutation", m)
+}
+
+// The BronzeAWSEC2NATGatewayFunc type is an adapter to allow the use of ordinary
+// function as BronzeAWSEC2NATGateway mutator.
+type BronzeAWSEC2NATGatewayFunc func(context.Context, *ec2.BronzeAWSEC2NATGatewayMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeAWSEC2NATGatewayFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeAWSEC2NATGatewayMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeAWSEC2NATGatewayMutation", m)
+}
+
+// The BronzeAWSEC2NetworkACLFunc type is an adapter to allow the use of ordinary
+// function as BronzeAWSEC2NetworkACL mutator.
+type BronzeAWSEC2NetworkACLFunc func(context.Context, *ec2.BronzeAWSEC2NetworkACLMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeAWSEC2NetworkACLFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeAWSEC2NetworkACLMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeAWSEC2NetworkACLMutation", m)
+}
+
+// The BronzeAWSEC2NetworkInterfaceFunc type is an adapter to allow the use of ordinary
+// function as BronzeAWSEC2NetworkInterface mutator.
+type BronzeAWSEC2NetworkInterfaceFunc func(context.Context, *ec2.BronzeAWSEC2NetworkInterfaceMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeAWSEC2NetworkInterfaceFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeAWSEC2NetworkInterfaceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeAWSEC2NetworkInterfaceMutation", m)
+}
+
+// The BronzeAWSEC2RouteTableFunc type is an adapter to allow the use of ordinary
+// function as BronzeAWSEC2RouteTable mutator.
+type BronzeAWSEC2RouteTableFunc func(context.Context, *ec2.BronzeAWSEC2RouteTableMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeAWSEC2RouteTableFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeAWSEC2RouteTableMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeAWSEC2RouteTableMutation", m)
+}
+
+// The BronzeAWSEC2SecurityGroupFunc type is an adapter to allow the use of ordinary
+// function as BronzeAWSEC2SecurityGroup mutator.
+type BronzeAWSEC2SecurityGroupFunc func(context.Context, *ec2.BronzeAWSEC2SecurityGroupMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeAWSEC2SecurityGroupFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeAWSEC2SecurityGroupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeAWSEC2SecurityGroupMutation", m)
+}
+
+// The BronzeAWSEC2SecurityGroupRuleFunc type is an adapter to allow the use of ordinary
+// function as BronzeAWSEC2SecurityGroupRule mutator.
+type BronzeAWSEC2SecurityGroupRuleFunc func(context.Context, *ec2.BronzeAWSEC2SecurityGroupRuleMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeAWSEC2SecurityGroupRuleFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeAWSEC2SecurityGroupRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeAWSEC2SecurityGroupRuleMutation", m)
+}
+
+// The BronzeAWSEC2SubnetFunc type is an adapter to allow the use of ordinary
+// function as BronzeAWSEC2Subnet mutator.
+type BronzeAWSEC2SubnetFunc func(context.Context, *ec2.BronzeAWSEC2SubnetMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeAWSEC2SubnetFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeAWSEC2SubnetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeAWSEC2SubnetMutation", m)
+}
+
+// The BronzeAWSEC2VPCFunc type is an adapter to allow the use of ordinary
+// function as BronzeAWSEC2VPC mutator.
+type BronzeAWSEC2VPCFunc func(context.Context, *ec2.BronzeAWSEC2VPCMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeAWSEC2VPCFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeAWSEC2VPCMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeAWSEC2VPCMutation", m)
+}
+
+// The BronzeHistoryAWSEC2AddressFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryAWSEC2Address mutator.
+type BronzeHistoryAWSEC2AddressFunc func(context.Context, *ec2.BronzeHistoryAWSEC2AddressMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryAWSEC2AddressFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeHistoryAWSEC2AddressMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeHistoryAWSEC2AddressMutation", m)
+}
+
 // The BronzeHistoryAWSEC2InstanceFunc type is an adapter to allow the use of ordinary
 // function as BronzeHistoryAWSEC2Instance mutator.
 type BronzeHistoryAWSEC2InstanceFunc func(context.Context, *ec2.BronzeHistoryAWSEC2InstanceMutation) (ec2.Value, error)
@@ -57,6 +189,114 @@ func (f BronzeHistoryAWSEC2InstanceTagFunc) Mutate(ctx context.Context, m ec2.Mu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeHistoryAWSEC2InstanceTagMutation", m)
 }
 
+// The BronzeHistoryAWSEC2InternetGatewayFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryAWSEC2InternetGateway mutator.
+type BronzeHistoryAWSEC2InternetGatewayFunc func(context.Context, *ec2.BronzeHistoryAWSEC2InternetGatewayMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryAWSEC2InternetGatewayFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeHistoryAWSEC2InternetGatewayMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeHistoryAWSEC2InternetGatewayMutation", m)
+}
+
+// The BronzeHistoryAWSEC2NATGatewayFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryAWSEC2NATGateway mutator.
+type BronzeHistoryAWSEC2NATGatewayFunc func(context.Context, *ec2.BronzeHistoryAWSEC2NATGatewayMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryAWSEC2NATGatewayFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeHistoryAWSEC2NATGatewayMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeHistoryAWSEC2NATGatewayMutation", m)
+}
+
+// The BronzeHistoryAWSEC2NetworkACLFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryAWSEC2NetworkACL mutator.
+type BronzeHistoryAWSEC2NetworkACLFunc func(context.Context, *ec2.BronzeHistoryAWSEC2NetworkACLMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryAWSEC2NetworkACLFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeHistoryAWSEC2NetworkACLMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeHistoryAWSEC2NetworkACLMutation", m)
+}
+
+// The BronzeHistoryAWSEC2NetworkInterfaceFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryAWSEC2NetworkInterface mutator.
+type BronzeHistoryAWSEC2NetworkInterfaceFunc func(context.Context, *ec2.BronzeHistoryAWSEC2NetworkInterfaceMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryAWSEC2NetworkInterfaceFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeHistoryAWSEC2NetworkInterfaceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeHistoryAWSEC2NetworkInterfaceMutation", m)
+}
+
+// The BronzeHistoryAWSEC2RouteTableFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryAWSEC2RouteTable mutator.
+type BronzeHistoryAWSEC2RouteTableFunc func(context.Context, *ec2.BronzeHistoryAWSEC2RouteTableMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryAWSEC2RouteTableFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeHistoryAWSEC2RouteTableMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeHistoryAWSEC2RouteTableMutation", m)
+}
+
+// The BronzeHistoryAWSEC2SecurityGroupFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryAWSEC2SecurityGroup mutator.
+type BronzeHistoryAWSEC2SecurityGroupFunc func(context.Context, *ec2.BronzeHistoryAWSEC2SecurityGroupMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryAWSEC2SecurityGroupFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeHistoryAWSEC2SecurityGroupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeHistoryAWSEC2SecurityGroupMutation", m)
+}
+
+// The BronzeHistoryAWSEC2SecurityGroupRuleFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryAWSEC2SecurityGroupRule mutator.
+type BronzeHistoryAWSEC2SecurityGroupRuleFunc func(context.Context, *ec2.BronzeHistoryAWSEC2SecurityGroupRuleMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryAWSEC2SecurityGroupRuleFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeHistoryAWSEC2SecurityGroupRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeHistoryAWSEC2SecurityGroupRuleMutation", m)
+}
+
+// The BronzeHistoryAWSEC2SubnetFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryAWSEC2Subnet mutator.
+type BronzeHistoryAWSEC2SubnetFunc func(context.Context, *ec2.BronzeHistoryAWSEC2SubnetMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryAWSEC2SubnetFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeHistoryAWSEC2SubnetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeHistoryAWSEC2SubnetMutation", m)
+}
+
+// The BronzeHistoryAWSEC2VPCFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryAWSEC2VPC mutator.
+type BronzeHistoryAWSEC2VPCFunc func(context.Context, *ec2.BronzeHistoryAWSEC2VPCMutation) (ec2.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryAWSEC2VPCFunc) Mutate(ctx context.Context, m ec2.Mutation) (ec2.Value, error) {
+	if mv, ok := m.(*ec2.BronzeHistoryAWSEC2VPCMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ec2.BronzeHistoryAWSEC2VPCMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ec2.Mutation) bool
 
@@ -7,10 +7,30 @@ import "context"
 // SchemaConfig represents alternative schema names for all tables
 // that can be passed at runtime.
 type SchemaConfig struct {
-	BronzeAWSEC2Instance           string // BronzeAWSEC2Instance table.
-	BronzeAWSEC2InstanceTag        string // BronzeAWSEC2InstanceTag table.
-	BronzeHistoryAWSEC2Instance    string // BronzeHistoryAWSEC2Instance table.
-	BronzeHistoryAWSEC2InstanceTag string // BronzeHistoryAWSEC2InstanceTag table.
+	BronzeAWSEC2Address                  string // BronzeAWSEC2Address table.
+	BronzeAWSEC2Instance                 string // BronzeAWSEC2Instance table.
+	BronzeAWSEC2InstanceTag              string // BronzeAWSEC2InstanceTag table.
+	BronzeAWSEC2InternetGateway          string // BronzeAWSEC2InternetGateway table.
+	BronzeAWSEC2NATGateway               string // BronzeAWSEC2NATGateway table.
+	BronzeAWSEC2NetworkACL               string // BronzeAWSEC2NetworkACL table.
+	BronzeAWSEC2NetworkInterface         string // BronzeAWSEC2NetworkInterface table.
+	BronzeAWSEC2RouteTable               string // BronzeAWSEC2RouteTable table.
+	BronzeAWSEC2SecurityGroup            string // BronzeAWSEC2SecurityGroup table.
+	BronzeAWSEC2SecurityGroupRule        string // BronzeAWSEC2SecurityGroupRule table.
+	BronzeAWSEC2Subnet                   string // BronzeAWSEC2Subnet table.
+	BronzeAWSEC2VPC                      string // BronzeAWSEC2VPC table.
+	BronzeHistoryAWSEC2Address           string // BronzeHistoryAWSEC2Address table.
+	BronzeHistoryAWSEC2Instance          string // BronzeHistoryAWSEC2Instance table.
+	BronzeHistoryAWSEC2InstanceTag       string // BronzeHistoryAWSEC2InstanceTag table.
+	BronzeHistoryAWSEC2InternetGateway   string // BronzeHistoryAWSEC2InternetGateway table.
+	BronzeHistoryAWSEC2NATGateway        string // BronzeHistoryAWSEC2NATGateway table.
+	BronzeHistoryAWSEC2NetworkACL        string // BronzeHistoryAWSEC2NetworkACL table.
+	BronzeHistoryAWSEC2NetworkInterface  string // BronzeHistoryAWSEC2NetworkInterface table.
+	BronzeHistoryAWSEC2RouteTable        string // BronzeHistoryAWSEC2RouteTable table.
+	BronzeHistoryAWSEC2SecurityGroup     string // BronzeHistoryAWSEC2SecurityGroup table.
+	BronzeHistoryAWSEC2SecurityGroupRule string // BronzeHistoryAWSEC2SecurityGroupRule table.
+	BronzeHistoryAWSEC2Subnet            string // BronzeHistoryAWSEC2Subnet table.
+	BronzeHistoryAWSEC2VPC               string // BronzeHistoryAWSEC2VPC table.
 }
 
 type schemaCtxKey struct{}
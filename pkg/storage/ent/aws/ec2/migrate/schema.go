@@ -9,6 +9,58 @@ import (
 )
 
 var (
+	// AWSEc2AddressesColumns holds the columns for the "aws_ec2_addresses" table.
+	AWSEc2AddressesColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "public_ip", Type: field.TypeString, Nullable: true},
+		{Name: "domain", Type: field.TypeString, Nullable: true},
+		{Name: "association_id", Type: field.TypeString, Nullable: true},
+		{Name: "instance_id", Type: field.TypeString, Nullable: true},
+		{Name: "network_interface_id", Type: field.TypeString, Nullable: true},
+		{Name: "network_interface_owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "private_ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "public_ipv4_pool", Type: field.TypeString, Nullable: true},
+		{Name: "network_border_group", Type: field.TypeString, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSEc2AddressesTable holds the schema information for the "aws_ec2_addresses" table.
+	AWSEc2AddressesTable = &schema.Table{
+		Name:       "aws_ec2_addresses",
+		Columns:    AWSEc2AddressesColumns,
+		PrimaryKey: []*schema.Column{AWSEc2AddressesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzeawsec2address_public_ip",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2AddressesColumns[4]},
+			},
+			{
+				Name:    "bronzeawsec2address_instance_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2AddressesColumns[7]},
+			},
+			{
+				Name:    "bronzeawsec2address_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2AddressesColumns[14]},
+			},
+			{
+				Name:    "bronzeawsec2address_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2AddressesColumns[15]},
+			},
+			{
+				Name:    "bronzeawsec2address_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2AddressesColumns[1]},
+			},
+		},
+	}
 	// AWSEc2InstancesColumns holds the columns for the "aws_ec2_instances" table.
 	AWSEc2InstancesColumns = []*schema.Column{
 		{Name: "resource_id", Type: field.TypeString, Unique: true},
@@ -79,116 +131,1194 @@ var (
 			},
 		},
 	}
-	// AWSEc2InstancesHistoryColumns holds the columns for the "aws_ec2_instances_history" table.
-	AWSEc2InstancesHistoryColumns = []*schema.Column{
-		{Name: "history_id", Type: field.TypeUint, Increment: true},
-		{Name: "valid_from", Type: field.TypeTime},
-		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+	// AWSEc2InternetGatewaysColumns holds the columns for the "aws_ec2_internet_gateways" table.
+	AWSEc2InternetGatewaysColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
 		{Name: "collected_at", Type: field.TypeTime},
 		{Name: "first_collected_at", Type: field.TypeTime},
-		{Name: "resource_id", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString, Nullable: true},
-		{Name: "instance_type", Type: field.TypeString, Nullable: true},
+		{Name: "vpc_id", Type: field.TypeString, Nullable: true},
+		{Name: "attachment_state", Type: field.TypeString, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSEc2InternetGatewaysTable holds the schema information for the "aws_ec2_internet_gateways" table.
+	AWSEc2InternetGatewaysTable = &schema.Table{
+		Name:       "aws_ec2_internet_gateways",
+		Columns:    AWSEc2InternetGatewaysColumns,
+		PrimaryKey: []*schema.Column{AWSEc2InternetGatewaysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzeawsec2internetgateway_vpc_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2InternetGatewaysColumns[4]},
+			},
+			{
+				Name:    "bronzeawsec2internetgateway_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2InternetGatewaysColumns[8]},
+			},
+			{
+				Name:    "bronzeawsec2internetgateway_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2InternetGatewaysColumns[9]},
+			},
+			{
+				Name:    "bronzeawsec2internetgateway_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2InternetGatewaysColumns[1]},
+			},
+		},
+	}
+	// AWSEc2NatGatewaysColumns holds the columns for the "aws_ec2_nat_gateways" table.
+	AWSEc2NatGatewaysColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "vpc_id", Type: field.TypeString, Nullable: true},
+		{Name: "subnet_id", Type: field.TypeString, Nullable: true},
 		{Name: "state", Type: field.TypeString, Nullable: true},
+		{Name: "connectivity_type", Type: field.TypeString, Nullable: true},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true},
+		{Name: "failure_code", Type: field.TypeString, Nullable: true},
+		{Name: "failure_message", Type: field.TypeString, Nullable: true},
+		{Name: "addresses_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSEc2NatGatewaysTable holds the schema information for the "aws_ec2_nat_gateways" table.
+	AWSEc2NatGatewaysTable = &schema.Table{
+		Name:       "aws_ec2_nat_gateways",
+		Columns:    AWSEc2NatGatewaysColumns,
+		PrimaryKey: []*schema.Column{AWSEc2NatGatewaysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzeawsec2natgateway_vpc_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NatGatewaysColumns[4]},
+			},
+			{
+				Name:    "bronzeawsec2natgateway_state",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NatGatewaysColumns[6]},
+			},
+			{
+				Name:    "bronzeawsec2natgateway_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NatGatewaysColumns[13]},
+			},
+			{
+				Name:    "bronzeawsec2natgateway_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NatGatewaysColumns[14]},
+			},
+			{
+				Name:    "bronzeawsec2natgateway_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NatGatewaysColumns[1]},
+			},
+		},
+	}
+	// AWSEc2NetworkAclsColumns holds the columns for the "aws_ec2_network_acls" table.
+	AWSEc2NetworkAclsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "vpc_id", Type: field.TypeString, Nullable: true},
+		{Name: "is_default", Type: field.TypeBool, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "entries_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "associations_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSEc2NetworkAclsTable holds the schema information for the "aws_ec2_network_acls" table.
+	AWSEc2NetworkAclsTable = &schema.Table{
+		Name:       "aws_ec2_network_acls",
+		Columns:    AWSEc2NetworkAclsColumns,
+		PrimaryKey: []*schema.Column{AWSEc2NetworkAclsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzeawsec2networkacl_vpc_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NetworkAclsColumns[4]},
+			},
+			{
+				Name:    "bronzeawsec2networkacl_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NetworkAclsColumns[10]},
+			},
+			{
+				Name:    "bronzeawsec2networkacl_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NetworkAclsColumns[11]},
+			},
+			{
+				Name:    "bronzeawsec2networkacl_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NetworkAclsColumns[1]},
+			},
+		},
+	}
+	// AWSEc2NetworkInterfacesColumns holds the columns for the "aws_ec2_network_interfaces" table.
+	AWSEc2NetworkInterfacesColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "interface_type", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString, Nullable: true},
 		{Name: "vpc_id", Type: field.TypeString, Nullable: true},
 		{Name: "subnet_id", Type: field.TypeString, Nullable: true},
+		{Name: "availability_zone", Type: field.TypeString, Nullable: true},
+		{Name: "mac_address", Type: field.TypeString, Nullable: true},
 		{Name: "private_ip_address", Type: field.TypeString, Nullable: true},
-		{Name: "public_ip_address", Type: field.TypeString, Nullable: true},
-		{Name: "ami_id", Type: field.TypeString, Nullable: true},
-		{Name: "key_name", Type: field.TypeString, Nullable: true},
-		{Name: "launch_time", Type: field.TypeTime, Nullable: true},
-		{Name: "platform", Type: field.TypeString, Nullable: true},
-		{Name: "architecture", Type: field.TypeString, Nullable: true},
-		{Name: "security_groups_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "private_dns_name", Type: field.TypeString, Nullable: true},
+		{Name: "public_ip", Type: field.TypeString, Nullable: true},
+		{Name: "public_dns_name", Type: field.TypeString, Nullable: true},
+		{Name: "source_dest_check", Type: field.TypeBool, Nullable: true},
+		{Name: "requester_managed", Type: field.TypeBool, Nullable: true},
+		{Name: "requester_id", Type: field.TypeString, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "attachment_id", Type: field.TypeString, Nullable: true},
+		{Name: "attachment_instance_id", Type: field.TypeString, Nullable: true},
+		{Name: "attachment_status", Type: field.TypeString, Nullable: true},
+		{Name: "groups_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "private_ip_addresses_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "ipv6_addresses_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
 		{Name: "account_id", Type: field.TypeString},
 		{Name: "region", Type: field.TypeString},
 	}
-	// AWSEc2InstancesHistoryTable holds the schema information for the "aws_ec2_instances_history" table.
-	AWSEc2InstancesHistoryTable = &schema.Table{
-		Name:       "aws_ec2_instances_history",
-		Columns:    AWSEc2InstancesHistoryColumns,
-		PrimaryKey: []*schema.Column{AWSEc2InstancesHistoryColumns[0]},
+	// AWSEc2NetworkInterfacesTable holds the schema information for the "aws_ec2_network_interfaces" table.
+	AWSEc2NetworkInterfacesTable = &schema.Table{
+		Name:       "aws_ec2_network_interfaces",
+		Columns:    AWSEc2NetworkInterfacesColumns,
+		PrimaryKey: []*schema.Column{AWSEc2NetworkInterfacesColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "bronzehistoryawsec2instance_resource_id_valid_from",
+				Name:    "bronzeawsec2networkinterface_vpc_id",
 				Unique:  false,
-				Columns: []*schema.Column{AWSEc2InstancesHistoryColumns[5], AWSEc2InstancesHistoryColumns[1]},
+				Columns: []*schema.Column{AWSEc2NetworkInterfacesColumns[6]},
 			},
 			{
-				Name:    "bronzehistoryawsec2instance_valid_to",
+				Name:    "bronzeawsec2networkinterface_subnet_id",
 				Unique:  false,
-				Columns: []*schema.Column{AWSEc2InstancesHistoryColumns[2]},
+				Columns: []*schema.Column{AWSEc2NetworkInterfacesColumns[7]},
 			},
 			{
-				Name:    "bronzehistoryawsec2instance_collected_at",
+				Name:    "bronzeawsec2networkinterface_attachment_instance_id",
 				Unique:  false,
-				Columns: []*schema.Column{AWSEc2InstancesHistoryColumns[3]},
+				Columns: []*schema.Column{AWSEc2NetworkInterfacesColumns[19]},
 			},
 			{
-				Name:    "bronzehistoryawsec2instance_account_id",
+				Name:    "bronzeawsec2networkinterface_public_ip",
 				Unique:  false,
-				Columns: []*schema.Column{AWSEc2InstancesHistoryColumns[19]},
+				Columns: []*schema.Column{AWSEc2NetworkInterfacesColumns[12]},
 			},
 			{
-				Name:    "bronzehistoryawsec2instance_region",
+				Name:    "bronzeawsec2networkinterface_account_id",
 				Unique:  false,
-				Columns: []*schema.Column{AWSEc2InstancesHistoryColumns[20]},
+				Columns: []*schema.Column{AWSEc2NetworkInterfacesColumns[25]},
+			},
+			{
+				Name:    "bronzeawsec2networkinterface_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NetworkInterfacesColumns[26]},
+			},
+			{
+				Name:    "bronzeawsec2networkinterface_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NetworkInterfacesColumns[1]},
 			},
 		},
 	}
-	// AWSEc2InstanceTagsHistoryColumns holds the columns for the "aws_ec2_instance_tags_history" table.
-	AWSEc2InstanceTagsHistoryColumns = []*schema.Column{
-		{Name: "history_id", Type: field.TypeUint, Increment: true},
-		{Name: "instance_history_id", Type: field.TypeUint},
-		{Name: "valid_from", Type: field.TypeTime},
-		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
-		{Name: "key", Type: field.TypeString, Nullable: true},
-		{Name: "value", Type: field.TypeString, Nullable: true},
+	// AWSEc2RouteTablesColumns holds the columns for the "aws_ec2_route_tables" table.
+	AWSEc2RouteTablesColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "vpc_id", Type: field.TypeString, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "routes_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "associations_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "propagating_vgws_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
 	}
-	// AWSEc2InstanceTagsHistoryTable holds the schema information for the "aws_ec2_instance_tags_history" table.
-	AWSEc2InstanceTagsHistoryTable = &schema.Table{
-		Name:       "aws_ec2_instance_tags_history",
-		Columns:    AWSEc2InstanceTagsHistoryColumns,
-		PrimaryKey: []*schema.Column{AWSEc2InstanceTagsHistoryColumns[0]},
+	// AWSEc2RouteTablesTable holds the schema information for the "aws_ec2_route_tables" table.
+	AWSEc2RouteTablesTable = &schema.Table{
+		Name:       "aws_ec2_route_tables",
+		Columns:    AWSEc2RouteTablesColumns,
+		PrimaryKey: []*schema.Column{AWSEc2RouteTablesColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "bronzehistoryawsec2instancetag_instance_history_id",
+				Name:    "bronzeawsec2routetable_vpc_id",
 				Unique:  false,
-				Columns: []*schema.Column{AWSEc2InstanceTagsHistoryColumns[1]},
+				Columns: []*schema.Column{AWSEc2RouteTablesColumns[4]},
 			},
 			{
-				Name:    "bronzehistoryawsec2instancetag_valid_from",
+				Name:    "bronzeawsec2routetable_account_id",
 				Unique:  false,
-				Columns: []*schema.Column{AWSEc2InstanceTagsHistoryColumns[2]},
+				Columns: []*schema.Column{AWSEc2RouteTablesColumns[10]},
 			},
 			{
-				Name:    "bronzehistoryawsec2instancetag_valid_to",
+				Name:    "bronzeawsec2routetable_region",
 				Unique:  false,
-				Columns: []*schema.Column{AWSEc2InstanceTagsHistoryColumns[3]},
+				Columns: []*schema.Column{AWSEc2RouteTablesColumns[11]},
+			},
+			{
+				Name:    "bronzeawsec2routetable_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2RouteTablesColumns[1]},
 			},
 		},
 	}
-	// Tables holds all the tables in the schema.
-	Tables = []*schema.Table{
-		AWSEc2InstancesTable,
-		AWSEc2InstanceTagsTable,
-		AWSEc2InstancesHistoryTable,
-		AWSEc2InstanceTagsHistoryTable,
+	// AWSEc2SecurityGroupsColumns holds the columns for the "aws_ec2_security_groups" table.
+	AWSEc2SecurityGroupsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "group_name", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "vpc_id", Type: field.TypeString, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "security_group_arn", Type: field.TypeString, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
 	}
-)
-
-func init() {
-	AWSEc2InstancesTable.Annotation = &entsql.Annotation{
-		Table: "aws_ec2_instances",
+	// AWSEc2SecurityGroupsTable holds the schema information for the "aws_ec2_security_groups" table.
+	AWSEc2SecurityGroupsTable = &schema.Table{
+		Name:       "aws_ec2_security_groups",
+		Columns:    AWSEc2SecurityGroupsColumns,
+		PrimaryKey: []*schema.Column{AWSEc2SecurityGroupsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzeawsec2securitygroup_vpc_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupsColumns[5]},
+			},
+			{
+				Name:    "bronzeawsec2securitygroup_group_name",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupsColumns[3]},
+			},
+			{
+				Name:    "bronzeawsec2securitygroup_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupsColumns[9]},
+			},
+			{
+				Name:    "bronzeawsec2securitygroup_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupsColumns[10]},
+			},
+			{
+				Name:    "bronzeawsec2securitygroup_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupsColumns[1]},
+			},
+		},
 	}
-	AWSEc2InstanceTagsTable.ForeignKeys[0].RefTable = AWSEc2InstancesTable
-	AWSEc2InstanceTagsTable.Annotation = &entsql.Annotation{
-		Table: "aws_ec2_instance_tags",
+	// AWSEc2SecurityGroupRulesColumns holds the columns for the "aws_ec2_security_group_rules" table.
+	AWSEc2SecurityGroupRulesColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "group_id", Type: field.TypeString, Nullable: true},
+		{Name: "group_owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "is_egress", Type: field.TypeBool, Nullable: true},
+		{Name: "ip_protocol", Type: field.TypeString, Nullable: true},
+		{Name: "from_port", Type: field.TypeInt32, Nullable: true},
+		{Name: "to_port", Type: field.TypeInt32, Nullable: true},
+		{Name: "cidr_ipv4", Type: field.TypeString, Nullable: true},
+		{Name: "cidr_ipv6", Type: field.TypeString, Nullable: true},
+		{Name: "prefix_list_id", Type: field.TypeString, Nullable: true},
+		{Name: "referenced_group_id", Type: field.TypeString, Nullable: true},
+		{Name: "referenced_user_id", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "security_group_rule_arn", Type: field.TypeString, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
 	}
-	AWSEc2InstancesHistoryTable.Annotation = &entsql.Annotation{
-		Table: "aws_ec2_instances_history",
+	// AWSEc2SecurityGroupRulesTable holds the schema information for the "aws_ec2_security_group_rules" table.
+	AWSEc2SecurityGroupRulesTable = &schema.Table{
+		Name:       "aws_ec2_security_group_rules",
+		Columns:    AWSEc2SecurityGroupRulesColumns,
+		PrimaryKey: []*schema.Column{AWSEc2SecurityGroupRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzeawsec2securitygrouprule_group_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupRulesColumns[3]},
+			},
+			{
+				Name:    "bronzeawsec2securitygrouprule_is_egress",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupRulesColumns[5]},
+			},
+			{
+				Name:    "bronzeawsec2securitygrouprule_cidr_ipv4",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupRulesColumns[9]},
+			},
+			{
+				Name:    "bronzeawsec2securitygrouprule_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupRulesColumns[17]},
+			},
+			{
+				Name:    "bronzeawsec2securitygrouprule_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupRulesColumns[18]},
+			},
+			{
+				Name:    "bronzeawsec2securitygrouprule_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupRulesColumns[1]},
+			},
+		},
 	}
-	AWSEc2InstanceTagsHistoryTable.Annotation = &entsql.Annotation{
-		Table: "aws_ec2_instance_tags_history",
+	// AWSEc2SubnetsColumns holds the columns for the "aws_ec2_subnets" table.
+	AWSEc2SubnetsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "vpc_id", Type: field.TypeString, Nullable: true},
+		{Name: "cidr_block", Type: field.TypeString, Nullable: true},
+		{Name: "availability_zone", Type: field.TypeString, Nullable: true},
+		{Name: "availability_zone_id", Type: field.TypeString, Nullable: true},
+		{Name: "state", Type: field.TypeString, Nullable: true},
+		{Name: "default_for_az", Type: field.TypeBool, Nullable: true},
+		{Name: "map_public_ip_on_launch", Type: field.TypeBool, Nullable: true},
+		{Name: "assign_ipv6_address_on_creation", Type: field.TypeBool, Nullable: true},
+		{Name: "subnet_arn", Type: field.TypeString, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "ipv6_cidr_block_associations_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSEc2SubnetsTable holds the schema information for the "aws_ec2_subnets" table.
+	AWSEc2SubnetsTable = &schema.Table{
+		Name:       "aws_ec2_subnets",
+		Columns:    AWSEc2SubnetsColumns,
+		PrimaryKey: []*schema.Column{AWSEc2SubnetsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzeawsec2subnet_vpc_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SubnetsColumns[4]},
+			},
+			{
+				Name:    "bronzeawsec2subnet_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SubnetsColumns[16]},
+			},
+			{
+				Name:    "bronzeawsec2subnet_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SubnetsColumns[17]},
+			},
+			{
+				Name:    "bronzeawsec2subnet_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SubnetsColumns[1]},
+			},
+		},
+	}
+	// AWSEc2VpcsColumns holds the columns for the "aws_ec2_vpcs" table.
+	AWSEc2VpcsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "cidr_block", Type: field.TypeString, Nullable: true},
+		{Name: "state", Type: field.TypeString, Nullable: true},
+		{Name: "is_default", Type: field.TypeBool, Nullable: true},
+		{Name: "instance_tenancy", Type: field.TypeString, Nullable: true},
+		{Name: "dhcp_options_id", Type: field.TypeString, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "cidr_block_associations_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "ipv6_cidr_block_associations_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSEc2VpcsTable holds the schema information for the "aws_ec2_vpcs" table.
+	AWSEc2VpcsTable = &schema.Table{
+		Name:       "aws_ec2_vpcs",
+		Columns:    AWSEc2VpcsColumns,
+		PrimaryKey: []*schema.Column{AWSEc2VpcsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzeawsec2vpc_is_default",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2VpcsColumns[6]},
+			},
+			{
+				Name:    "bronzeawsec2vpc_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2VpcsColumns[13]},
+			},
+			{
+				Name:    "bronzeawsec2vpc_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2VpcsColumns[14]},
+			},
+			{
+				Name:    "bronzeawsec2vpc_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2VpcsColumns[1]},
+			},
+		},
+	}
+	// AWSEc2AddressesHistoryColumns holds the columns for the "aws_ec2_addresses_history" table.
+	AWSEc2AddressesHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "public_ip", Type: field.TypeString, Nullable: true},
+		{Name: "domain", Type: field.TypeString, Nullable: true},
+		{Name: "association_id", Type: field.TypeString, Nullable: true},
+		{Name: "instance_id", Type: field.TypeString, Nullable: true},
+		{Name: "network_interface_id", Type: field.TypeString, Nullable: true},
+		{Name: "network_interface_owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "private_ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "public_ipv4_pool", Type: field.TypeString, Nullable: true},
+		{Name: "network_border_group", Type: field.TypeString, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSEc2AddressesHistoryTable holds the schema information for the "aws_ec2_addresses_history" table.
+	AWSEc2AddressesHistoryTable = &schema.Table{
+		Name:       "aws_ec2_addresses_history",
+		Columns:    AWSEc2AddressesHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSEc2AddressesHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawsec2address_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2AddressesHistoryColumns[5], AWSEc2AddressesHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawsec2address_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2AddressesHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawsec2address_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2AddressesHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawsec2address_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2AddressesHistoryColumns[17]},
+			},
+			{
+				Name:    "bronzehistoryawsec2address_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2AddressesHistoryColumns[18]},
+			},
+		},
+	}
+	// AWSEc2InstancesHistoryColumns holds the columns for the "aws_ec2_instances_history" table.
+	AWSEc2InstancesHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "instance_type", Type: field.TypeString, Nullable: true},
+		{Name: "state", Type: field.TypeString, Nullable: true},
+		{Name: "vpc_id", Type: field.TypeString, Nullable: true},
+		{Name: "subnet_id", Type: field.TypeString, Nullable: true},
+		{Name: "private_ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "public_ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "ami_id", Type: field.TypeString, Nullable: true},
+		{Name: "key_name", Type: field.TypeString, Nullable: true},
+		{Name: "launch_time", Type: field.TypeTime, Nullable: true},
+		{Name: "platform", Type: field.TypeString, Nullable: true},
+		{Name: "architecture", Type: field.TypeString, Nullable: true},
+		{Name: "security_groups_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSEc2InstancesHistoryTable holds the schema information for the "aws_ec2_instances_history" table.
+	AWSEc2InstancesHistoryTable = &schema.Table{
+		Name:       "aws_ec2_instances_history",
+		Columns:    AWSEc2InstancesHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSEc2InstancesHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawsec2instance_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2InstancesHistoryColumns[5], AWSEc2InstancesHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawsec2instance_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2InstancesHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawsec2instance_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2InstancesHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawsec2instance_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2InstancesHistoryColumns[19]},
+			},
+			{
+				Name:    "bronzehistoryawsec2instance_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2InstancesHistoryColumns[20]},
+			},
+		},
+	}
+	// AWSEc2InstanceTagsHistoryColumns holds the columns for the "aws_ec2_instance_tags_history" table.
+	AWSEc2InstanceTagsHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "instance_history_id", Type: field.TypeUint},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "key", Type: field.TypeString, Nullable: true},
+		{Name: "value", Type: field.TypeString, Nullable: true},
+	}
+	// AWSEc2InstanceTagsHistoryTable holds the schema information for the "aws_ec2_instance_tags_history" table.
+	AWSEc2InstanceTagsHistoryTable = &schema.Table{
+		Name:       "aws_ec2_instance_tags_history",
+		Columns:    AWSEc2InstanceTagsHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSEc2InstanceTagsHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawsec2instancetag_instance_history_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2InstanceTagsHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawsec2instancetag_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2InstanceTagsHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawsec2instancetag_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2InstanceTagsHistoryColumns[3]},
+			},
+		},
+	}
+	// AWSEc2InternetGatewaysHistoryColumns holds the columns for the "aws_ec2_internet_gateways_history" table.
+	AWSEc2InternetGatewaysHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "vpc_id", Type: field.TypeString, Nullable: true},
+		{Name: "attachment_state", Type: field.TypeString, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSEc2InternetGatewaysHistoryTable holds the schema information for the "aws_ec2_internet_gateways_history" table.
+	AWSEc2InternetGatewaysHistoryTable = &schema.Table{
+		Name:       "aws_ec2_internet_gateways_history",
+		Columns:    AWSEc2InternetGatewaysHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSEc2InternetGatewaysHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawsec2internetgateway_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2InternetGatewaysHistoryColumns[5], AWSEc2InternetGatewaysHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawsec2internetgateway_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2InternetGatewaysHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawsec2internetgateway_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2InternetGatewaysHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawsec2internetgateway_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2InternetGatewaysHistoryColumns[11]},
+			},
+			{
+				Name:    "bronzehistoryawsec2internetgateway_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2InternetGatewaysHistoryColumns[12]},
+			},
+		},
+	}
+	// AWSEc2NatGatewaysHistoryColumns holds the columns for the "aws_ec2_nat_gateways_history" table.
+	AWSEc2NatGatewaysHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "vpc_id", Type: field.TypeString, Nullable: true},
+		{Name: "subnet_id", Type: field.TypeString, Nullable: true},
+		{Name: "state", Type: field.TypeString, Nullable: true},
+		{Name: "connectivity_type", Type: field.TypeString, Nullable: true},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true},
+		{Name: "failure_code", Type: field.TypeString, Nullable: true},
+		{Name: "failure_message", Type: field.TypeString, Nullable: true},
+		{Name: "addresses_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSEc2NatGatewaysHistoryTable holds the schema information for the "aws_ec2_nat_gateways_history" table.
+	AWSEc2NatGatewaysHistoryTable = &schema.Table{
+		Name:       "aws_ec2_nat_gateways_history",
+		Columns:    AWSEc2NatGatewaysHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSEc2NatGatewaysHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawsec2natgateway_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NatGatewaysHistoryColumns[5], AWSEc2NatGatewaysHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawsec2natgateway_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NatGatewaysHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawsec2natgateway_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NatGatewaysHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawsec2natgateway_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NatGatewaysHistoryColumns[16]},
+			},
+			{
+				Name:    "bronzehistoryawsec2natgateway_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NatGatewaysHistoryColumns[17]},
+			},
+		},
+	}
+	// AWSEc2NetworkAclsHistoryColumns holds the columns for the "aws_ec2_network_acls_history" table.
+	AWSEc2NetworkAclsHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "vpc_id", Type: field.TypeString, Nullable: true},
+		{Name: "is_default", Type: field.TypeBool, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "entries_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "associations_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSEc2NetworkAclsHistoryTable holds the schema information for the "aws_ec2_network_acls_history" table.
+	AWSEc2NetworkAclsHistoryTable = &schema.Table{
+		Name:       "aws_ec2_network_acls_history",
+		Columns:    AWSEc2NetworkAclsHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSEc2NetworkAclsHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawsec2networkacl_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NetworkAclsHistoryColumns[5], AWSEc2NetworkAclsHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawsec2networkacl_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NetworkAclsHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawsec2networkacl_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NetworkAclsHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawsec2networkacl_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NetworkAclsHistoryColumns[13]},
+			},
+			{
+				Name:    "bronzehistoryawsec2networkacl_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NetworkAclsHistoryColumns[14]},
+			},
+		},
+	}
+	// AWSEc2NetworkInterfacesHistoryColumns holds the columns for the "aws_ec2_network_interfaces_history" table.
+	AWSEc2NetworkInterfacesHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "interface_type", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString, Nullable: true},
+		{Name: "vpc_id", Type: field.TypeString, Nullable: true},
+		{Name: "subnet_id", Type: field.TypeString, Nullable: true},
+		{Name: "availability_zone", Type: field.TypeString, Nullable: true},
+		{Name: "mac_address", Type: field.TypeString, Nullable: true},
+		{Name: "private_ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "private_dns_name", Type: field.TypeString, Nullable: true},
+		{Name: "public_ip", Type: field.TypeString, Nullable: true},
+		{Name: "public_dns_name", Type: field.TypeString, Nullable: true},
+		{Name: "source_dest_check", Type: field.TypeBool, Nullable: true},
+		{Name: "requester_managed", Type: field.TypeBool, Nullable: true},
+		{Name: "requester_id", Type: field.TypeString, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "attachment_id", Type: field.TypeString, Nullable: true},
+		{Name: "attachment_instance_id", Type: field.TypeString, Nullable: true},
+		{Name: "attachment_status", Type: field.TypeString, Nullable: true},
+		{Name: "groups_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "private_ip_addresses_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "ipv6_addresses_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSEc2NetworkInterfacesHistoryTable holds the schema information for the "aws_ec2_network_interfaces_history" table.
+	AWSEc2NetworkInterfacesHistoryTable = &schema.Table{
+		Name:       "aws_ec2_network_interfaces_history",
+		Columns:    AWSEc2NetworkInterfacesHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSEc2NetworkInterfacesHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawsec2networkinterface_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NetworkInterfacesHistoryColumns[5], AWSEc2NetworkInterfacesHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawsec2networkinterface_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NetworkInterfacesHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawsec2networkinterface_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NetworkInterfacesHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawsec2networkinterface_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NetworkInterfacesHistoryColumns[28]},
+			},
+			{
+				Name:    "bronzehistoryawsec2networkinterface_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2NetworkInterfacesHistoryColumns[29]},
+			},
+		},
+	}
+	// AWSEc2RouteTablesHistoryColumns holds the columns for the "aws_ec2_route_tables_history" table.
+	AWSEc2RouteTablesHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "vpc_id", Type: field.TypeString, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "routes_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "associations_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "propagating_vgws_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSEc2RouteTablesHistoryTable holds the schema information for the "aws_ec2_route_tables_history" table.
+	AWSEc2RouteTablesHistoryTable = &schema.Table{
+		Name:       "aws_ec2_route_tables_history",
+		Columns:    AWSEc2RouteTablesHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSEc2RouteTablesHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawsec2routetable_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2RouteTablesHistoryColumns[5], AWSEc2RouteTablesHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawsec2routetable_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2RouteTablesHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawsec2routetable_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2RouteTablesHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawsec2routetable_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2RouteTablesHistoryColumns[13]},
+			},
+			{
+				Name:    "bronzehistoryawsec2routetable_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2RouteTablesHistoryColumns[14]},
+			},
+		},
+	}
+	// AWSEc2SecurityGroupsHistoryColumns holds the columns for the "aws_ec2_security_groups_history" table.
+	AWSEc2SecurityGroupsHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "group_name", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "vpc_id", Type: field.TypeString, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "security_group_arn", Type: field.TypeString, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSEc2SecurityGroupsHistoryTable holds the schema information for the "aws_ec2_security_groups_history" table.
+	AWSEc2SecurityGroupsHistoryTable = &schema.Table{
+		Name:       "aws_ec2_security_groups_history",
+		Columns:    AWSEc2SecurityGroupsHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSEc2SecurityGroupsHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawsec2securitygroup_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupsHistoryColumns[5], AWSEc2SecurityGroupsHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawsec2securitygroup_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupsHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawsec2securitygroup_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupsHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawsec2securitygroup_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupsHistoryColumns[12]},
+			},
+			{
+				Name:    "bronzehistoryawsec2securitygroup_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupsHistoryColumns[13]},
+			},
+		},
+	}
+	// AWSEc2SecurityGroupRulesHistoryColumns holds the columns for the "aws_ec2_security_group_rules_history" table.
+	AWSEc2SecurityGroupRulesHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "group_id", Type: field.TypeString, Nullable: true},
+		{Name: "group_owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "is_egress", Type: field.TypeBool, Nullable: true},
+		{Name: "ip_protocol", Type: field.TypeString, Nullable: true},
+		{Name: "from_port", Type: field.TypeInt32, Nullable: true},
+		{Name: "to_port", Type: field.TypeInt32, Nullable: true},
+		{Name: "cidr_ipv4", Type: field.TypeString, Nullable: true},
+		{Name: "cidr_ipv6", Type: field.TypeString, Nullable: true},
+		{Name: "prefix_list_id", Type: field.TypeString, Nullable: true},
+		{Name: "referenced_group_id", Type: field.TypeString, Nullable: true},
+		{Name: "referenced_user_id", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "security_group_rule_arn", Type: field.TypeString, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSEc2SecurityGroupRulesHistoryTable holds the schema information for the "aws_ec2_security_group_rules_history" table.
+	AWSEc2SecurityGroupRulesHistoryTable = &schema.Table{
+		Name:       "aws_ec2_security_group_rules_history",
+		Columns:    AWSEc2SecurityGroupRulesHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSEc2SecurityGroupRulesHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawsec2securitygrouprule_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupRulesHistoryColumns[5], AWSEc2SecurityGroupRulesHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawsec2securitygrouprule_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupRulesHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawsec2securitygrouprule_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupRulesHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawsec2securitygrouprule_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupRulesHistoryColumns[20]},
+			},
+			{
+				Name:    "bronzehistoryawsec2securitygrouprule_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SecurityGroupRulesHistoryColumns[21]},
+			},
+		},
+	}
+	// AWSEc2SubnetsHistoryColumns holds the columns for the "aws_ec2_subnets_history" table.
+	AWSEc2SubnetsHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "vpc_id", Type: field.TypeString, Nullable: true},
+		{Name: "cidr_block", Type: field.TypeString, Nullable: true},
+		{Name: "availability_zone", Type: field.TypeString, Nullable: true},
+		{Name: "availability_zone_id", Type: field.TypeString, Nullable: true},
+		{Name: "state", Type: field.TypeString, Nullable: true},
+		{Name: "default_for_az", Type: field.TypeBool, Nullable: true},
+		{Name: "map_public_ip_on_launch", Type: field.TypeBool, Nullable: true},
+		{Name: "assign_ipv6_address_on_creation", Type: field.TypeBool, Nullable: true},
+		{Name: "subnet_arn", Type: field.TypeString, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "ipv6_cidr_block_associations_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSEc2SubnetsHistoryTable holds the schema information for the "aws_ec2_subnets_history" table.
+	AWSEc2SubnetsHistoryTable = &schema.Table{
+		Name:       "aws_ec2_subnets_history",
+		Columns:    AWSEc2SubnetsHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSEc2SubnetsHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawsec2subnet_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SubnetsHistoryColumns[5], AWSEc2SubnetsHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawsec2subnet_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SubnetsHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawsec2subnet_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SubnetsHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawsec2subnet_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SubnetsHistoryColumns[19]},
+			},
+			{
+				Name:    "bronzehistoryawsec2subnet_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2SubnetsHistoryColumns[20]},
+			},
+		},
+	}
+	// AWSEc2VpcsHistoryColumns holds the columns for the "aws_ec2_vpcs_history" table.
+	AWSEc2VpcsHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "cidr_block", Type: field.TypeString, Nullable: true},
+		{Name: "state", Type: field.TypeString, Nullable: true},
+		{Name: "is_default", Type: field.TypeBool, Nullable: true},
+		{Name: "instance_tenancy", Type: field.TypeString, Nullable: true},
+		{Name: "dhcp_options_id", Type: field.TypeString, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "cidr_block_associations_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "ipv6_cidr_block_associations_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSEc2VpcsHistoryTable holds the schema information for the "aws_ec2_vpcs_history" table.
+	AWSEc2VpcsHistoryTable = &schema.Table{
+		Name:       "aws_ec2_vpcs_history",
+		Columns:    AWSEc2VpcsHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSEc2VpcsHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawsec2vpc_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2VpcsHistoryColumns[5], AWSEc2VpcsHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawsec2vpc_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2VpcsHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawsec2vpc_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2VpcsHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawsec2vpc_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2VpcsHistoryColumns[16]},
+			},
+			{
+				Name:    "bronzehistoryawsec2vpc_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSEc2VpcsHistoryColumns[17]},
+			},
+		},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		AWSEc2AddressesTable,
+		AWSEc2InstancesTable,
+		AWSEc2InstanceTagsTable,
+		AWSEc2InternetGatewaysTable,
+		AWSEc2NatGatewaysTable,
+		AWSEc2NetworkAclsTable,
+		AWSEc2NetworkInterfacesTable,
+		AWSEc2RouteTablesTable,
+		AWSEc2SecurityGroupsTable,
+		AWSEc2SecurityGroupRulesTable,
+		AWSEc2SubnetsTable,
+		AWSEc2VpcsTable,
+		AWSEc2AddressesHistoryTable,
+		AWSEc2InstancesHistoryTable,
+		AWSEc2InstanceTagsHistoryTable,
+		AWSEc2InternetGatewaysHistoryTable,
+		AWSEc2NatGatewaysHistoryTable,
+		AWSEc2NetworkAclsHistoryTable,
+		AWSEc2NetworkInterfacesHistoryTable,
+		AWSEc2RouteTablesHistoryTable,
+		AWSEc2SecurityGroupsHistoryTable,
+		AWSEc2SecurityGroupRulesHistoryTable,
+		AWSEc2SubnetsHistoryTable,
+		AWSEc2VpcsHistoryTable,
+	}
+)
+
+func init() {
+	AWSEc2AddressesTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_addresses",
+	}
+	AWSEc2InstancesTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_instances",
+	}
+	AWSEc2InstanceTagsTable.ForeignKeys[0].RefTable = AWSEc2InstancesTable
+	AWSEc2InstanceTagsTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_instance_tags",
+	}
+	AWSEc2InternetGatewaysTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_internet_gateways",
+	}
+	AWSEc2NatGatewaysTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_nat_gateways",
+	}
+	AWSEc2NetworkAclsTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_network_acls",
+	}
+	AWSEc2NetworkInterfacesTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_network_interfaces",
+	}
+	AWSEc2RouteTablesTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_route_tables",
+	}
+	AWSEc2SecurityGroupsTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_security_groups",
+	}
+	AWSEc2SecurityGroupRulesTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_security_group_rules",
+	}
+	AWSEc2SubnetsTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_subnets",
+	}
+	AWSEc2VpcsTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_vpcs",
+	}
+	AWSEc2AddressesHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_addresses_history",
+	}
+	AWSEc2InstancesHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_instances_history",
+	}
+	AWSEc2InstanceTagsHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_instance_tags_history",
+	}
+	AWSEc2InternetGatewaysHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_internet_gateways_history",
+	}
+	AWSEc2NatGatewaysHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_nat_gateways_history",
+	}
+	AWSEc2NetworkAclsHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_network_acls_history",
+	}
+	AWSEc2NetworkInterfacesHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_network_interfaces_history",
+	}
+	AWSEc2RouteTablesHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_route_tables_history",
+	}
+	AWSEc2SecurityGroupsHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_security_groups_history",
+	}
+	AWSEc2SecurityGroupRulesHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_security_group_rules_history",
+	}
+	AWSEc2SubnetsHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_subnets_history",
+	}
+	AWSEc2VpcsHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_ec2_vpcs_history",
 	}
 }
@@ -6,14 +6,74 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// BronzeAWSEC2Address is the predicate function for bronzeawsec2address builders.
+type BronzeAWSEC2Address func(*sql.Selector)
+
 // BronzeAWSEC2Instance is the predicate function for bronzeawsec2instance builders.
 type BronzeAWSEC2Instance func(*sql.Selector)
 
 // BronzeAWSEC2InstanceTag is the predicate function for bronzeawsec2instancetag builders.
 type BronzeAWSEC2InstanceTag func(*sql.Selector)
 
+// BronzeAWSEC2InternetGateway is the predicate function for bronzeawsec2internetgateway builders.
+type BronzeAWSEC2InternetGateway func(*sql.Selector)
+
+// BronzeAWSEC2NATGateway is the predicate function for bronzeawsec2natgateway builders.
+type BronzeAWSEC2NATGateway func(*sql.Selector)
+
+// BronzeAWSEC2NetworkACL is the predicate function for bronzeawsec2networkacl builders.
+type BronzeAWSEC2NetworkACL func(*sql.Selector)
+
+// BronzeAWSEC2NetworkInterface is the predicate function for bronzeawsec2networkinterface builders.
+type BronzeAWSEC2NetworkInterface func(*sql.Selector)
+
+// BronzeAWSEC2RouteTable is the predicate function for bronzeawsec2routetable builders.
+type BronzeAWSEC2RouteTable func(*sql.Selector)
+
+// BronzeAWSEC2SecurityGroup is the predicate function for bronzeawsec2securitygroup builders.
+type BronzeAWSEC2SecurityGroup func(*sql.Selector)
+
+// BronzeAWSEC2SecurityGroupRule is the predicate function for bronzeawsec2securitygrouprule builders.
+type BronzeAWSEC2SecurityGroupRule func(*sql.Selector)
+
+// BronzeAWSEC2Subnet is the predicate function for bronzeawsec2subnet builders.
+type BronzeAWSEC2Subnet func(*sql.Selector)
+
+// BronzeAWSEC2VPC is the predicate function for bronzeawsec2vpc builders.
+type BronzeAWSEC2VPC func(*sql.Selector)
+
+// BronzeHistoryAWSEC2Address is the predicate function for bronzehistoryawsec2address builders.
+type BronzeHistoryAWSEC2Address func(*sql.Selector)
+
 // BronzeHistoryAWSEC2Instance is the predicate function for bronzehistoryawsec2instance builders.
 type BronzeHistoryAWSEC2Instance func(*sql.Selector)
 
 // BronzeHistoryAWSEC2InstanceTag is the predicate function for bronzehistoryawsec2instancetag builders.
 type BronzeHistoryAWSEC2InstanceTag func(*sql.Selector)
+
+// BronzeHistoryAWSEC2InternetGateway is the predicate function for bronzehistoryawsec2internetgateway builders.
+type BronzeHistoryAWSEC2InternetGateway func(*sql.Selector)
+
+// BronzeHistoryAWSEC2NATGateway is the predicate function for bronzehistoryawsec2natgateway builders.
+type BronzeHistoryAWSEC2NATGateway func(*sql.Selector)
+
+// BronzeHistoryAWSEC2NetworkACL is the predicate function for bronzehistoryawsec2networkacl builders.
+type BronzeHistoryAWSEC2NetworkACL func(*sql.Selector)
+
+// BronzeHistoryAWSEC2NetworkInterface is the predicate function for bronzehistoryawsec2networkinterface builders.
+type BronzeHistoryAWSEC2NetworkInterface func(*sql.Selector)
+
+// BronzeHistoryAWSEC2RouteTable is the predicate function for bronzehistoryawsec2routetable builders.
+type BronzeHistoryAWSEC2RouteTable func(*sql.Selector)
+
+// BronzeHistoryAWSEC2SecurityGroup is the predicate function for bronzehistoryawsec2securitygroup builders.
+type BronzeHistoryAWSEC2SecurityGroup func(*sql.Selector)
+
+// BronzeHistoryAWSEC2SecurityGroupRule is the predicate function for bronzehistoryawsec2securitygrouprule builders.
+type BronzeHistoryAWSEC2SecurityGroupRule func(*sql.Selector)
+
+// BronzeHistoryAWSEC2Subnet is the predicate function for bronzehistoryawsec2subnet builders.
+type BronzeHistoryAWSEC2Subnet func(*sql.Selector)
+
+// BronzeHistoryAWSEC2VPC is the predicate function for bronzehistoryawsec2vpc builders.
+type BronzeHistoryAWSEC2VPC func(*sql.Selector)
@@ -3,9 +3,29 @@
 package ec2
 
 import (
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2address"
 	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2instance"
 	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2instancetag"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2internetgateway"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2natgateway"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2networkacl"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2networkinterface"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2routetable"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2securitygroup"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2securitygrouprule"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2subnet"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzeawsec2vpc"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2address"
 	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2instance"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2internetgateway"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2natgateway"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2networkacl"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2networkinterface"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2routetable"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2securitygroup"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2securitygrouprule"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2subnet"
+	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/bronzehistoryawsec2vpc"
 	"danny.vn/hotpot/pkg/storage/ent/aws/ec2/schema"
 )
 
@@ -13,6 +33,16 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	bronzeawsec2addressFields := schema.BronzeAWSEC2Address{}.Fields()
+	_ = bronzeawsec2addressFields
+	// bronzeawsec2addressDescAccountID is the schema descriptor for account_id field.
+	bronzeawsec2addressDescAccountID := bronzeawsec2addressFields[12].Descriptor()
+	// bronzeawsec2address.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzeawsec2address.AccountIDValidator = bronzeawsec2addressDescAccountID.Validators[0].(func(string) error)
+	// bronzeawsec2addressDescRegion is the schema descriptor for region field.
+	bronzeawsec2addressDescRegion := bronzeawsec2addressFields[13].Descriptor()
+	// bronzeawsec2address.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzeawsec2address.RegionValidator = bronzeawsec2addressDescRegion.Validators[0].(func(string) error)
 	bronzeawsec2instanceFields := schema.BronzeAWSEC2Instance{}.Fields()
 	_ = bronzeawsec2instanceFields
 	// bronzeawsec2instanceDescAccountID is the schema descriptor for account_id field.
@@ -29,6 +59,110 @@ func init() {
 	bronzeawsec2instancetagDescKey := bronzeawsec2instancetagFields[0].Descriptor()
 	// bronzeawsec2instancetag.KeyValidator is a validator for the "key" field. It is called by the builders before save.
 	bronzeawsec2instancetag.KeyValidator = bronzeawsec2instancetagDescKey.Validators[0].(func(string) error)
+	bronzeawsec2internetgatewayFields := schema.BronzeAWSEC2InternetGateway{}.Fields()
+	_ = bronzeawsec2internetgatewayFields
+	// bronzeawsec2internetgatewayDescAccountID is the schema descriptor for account_id field.
+	bronzeawsec2internetgatewayDescAccountID := bronzeawsec2internetgatewayFields[6].Descriptor()
+	// bronzeawsec2internetgateway.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzeawsec2internetgateway.AccountIDValidator = bronzeawsec2internetgatewayDescAccountID.Validators[0].(func(string) error)
+	// bronzeawsec2internetgatewayDescRegion is the schema descriptor for region field.
+	bronzeawsec2internetgatewayDescRegion := bronzeawsec2internetgatewayFields[7].Descriptor()
+	// bronzeawsec2internetgateway.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzeawsec2internetgateway.RegionValidator = bronzeawsec2internetgatewayDescRegion.Validators[0].(func(string) error)
+	bronzeawsec2natgatewayFields := schema.BronzeAWSEC2NATGateway{}.Fields()
+	_ = bronzeawsec2natgatewayFields
+	// bronzeawsec2natgatewayDescAccountID is the schema descriptor for account_id field.
+	bronzeawsec2natgatewayDescAccountID := bronzeawsec2natgatewayFields[11].Descriptor()
+	// bronzeawsec2natgateway.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzeawsec2natgateway.AccountIDValidator = bronzeawsec2natgatewayDescAccountID.Validators[0].(func(string) error)
+	// bronzeawsec2natgatewayDescRegion is the schema descriptor for region field.
+	bronzeawsec2natgatewayDescRegion := bronzeawsec2natgatewayFields[12].Descriptor()
+	// bronzeawsec2natgateway.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzeawsec2natgateway.RegionValidator = bronzeawsec2natgatewayDescRegion.Validators[0].(func(string) error)
+	bronzeawsec2networkaclFields := schema.BronzeAWSEC2NetworkACL{}.Fields()
+	_ = bronzeawsec2networkaclFields
+	// bronzeawsec2networkaclDescAccountID is the schema descriptor for account_id field.
+	bronzeawsec2networkaclDescAccountID := bronzeawsec2networkaclFields[8].Descriptor()
+	// bronzeawsec2networkacl.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzeawsec2networkacl.AccountIDValidator = bronzeawsec2networkaclDescAccountID.Validators[0].(func(string) error)
+	// bronzeawsec2networkaclDescRegion is the schema descriptor for region field.
+	bronzeawsec2networkaclDescRegion := bronzeawsec2networkaclFields[9].Descriptor()
+	// bronzeawsec2networkacl.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzeawsec2networkacl.RegionValidator = bronzeawsec2networkaclDescRegion.Validators[0].(func(string) error)
+	bronzeawsec2networkinterfaceFields := schema.BronzeAWSEC2NetworkInterface{}.Fields()
+	_ = bronzeawsec2networkinterfaceFields
+	// bronzeawsec2networkinterfaceDescAccountID is the schema descriptor for account_id field.
+	bronzeawsec2networkinterfaceDescAccountID := bronzeawsec2networkinterfaceFields[23].Descriptor()
+	// bronzeawsec2networkinterface.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzeawsec2networkinterface.AccountIDValidator = bronzeawsec2networkinterfaceDescAccountID.Validators[0].(func(string) error)
+	// bronzeawsec2networkinterfaceDescRegion is the schema descriptor for region field.
+	bronzeawsec2networkinterfaceDescRegion := bronzeawsec2networkinterfaceFields[24].Descriptor()
+	// bronzeawsec2networkinterface.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzeawsec2networkinterface.RegionValidator = bronzeawsec2networkinterfaceDescRegion.Validators[0].(func(string) error)
+	bronzeawsec2routetableFields := schema.BronzeAWSEC2RouteTable{}.Fields()
+	_ = bronzeawsec2routetableFields
+	// bronzeawsec2routetableDescAccountID is the schema descriptor for account_id field.
+	bronzeawsec2routetableDescAccountID := bronzeawsec2routetableFields[8].Descriptor()
+	// bronzeawsec2routetable.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzeawsec2routetable.AccountIDValidator = bronzeawsec2routetableDescAccountID.Validators[0].(func(string) error)
+	// bronzeawsec2routetableDescRegion is the schema descriptor for region field.
+	bronzeawsec2routetableDescRegion := bronzeawsec2routetableFields[9].Descriptor()
+	// bronzeawsec2routetable.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzeawsec2routetable.RegionValidator = bronzeawsec2routetableDescRegion.Validators[0].(func(string) error)
+	bronzeawsec2securitygroupFields := schema.BronzeAWSEC2SecurityGroup{}.Fields()
+	_ = bronzeawsec2securitygroupFields
+	// bronzeawsec2securitygroupDescAccountID is the schema descriptor for account_id field.
+	bronzeawsec2securitygroupDescAccountID := bronzeawsec2securitygroupFields[7].Descriptor()
+	// bronzeawsec2securitygroup.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzeawsec2securitygroup.AccountIDValidator = bronzeawsec2securitygroupDescAccountID.Validators[0].(func(string) error)
+	// bronzeawsec2securitygroupDescRegion is the schema descriptor for region field.
+	bronzeawsec2securitygroupDescRegion := bronzeawsec2securitygroupFields[8].Descriptor()
+	// bronzeawsec2securitygroup.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzeawsec2securitygroup.RegionValidator = bronzeawsec2securitygroupDescRegion.Validators[0].(func(string) error)
+	bronzeawsec2securitygroupruleFields := schema.BronzeAWSEC2SecurityGroupRule{}.Fields()
+	_ = bronzeawsec2securitygroupruleFields
+	// bronzeawsec2securitygroupruleDescAccountID is the schema descriptor for account_id field.
+	bronzeawsec2securitygroupruleDescAccountID := bronzeawsec2securitygroupruleFields[15].Descriptor()
+	// bronzeawsec2securitygrouprule.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzeawsec2securitygrouprule.AccountIDValidator = bronzeawsec2securitygroupruleDescAccountID.Validators[0].(func(string) error)
+	// bronzeawsec2securitygroupruleDescRegion is the schema descriptor for region field.
+	bronzeawsec2securitygroupruleDescRegion := bronzeawsec2securitygroupruleFields[16].Descriptor()
+	// bronzeawsec2securitygrouprule.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzeawsec2securitygrouprule.RegionValidator = bronzeawsec2securitygroupruleDescRegion.Validators[0].(func(string) error)
+	bronzeawsec2subnetFields := schema.BronzeAWSEC2Subnet{}.Fields()
+	_ = bronzeawsec2subnetFields
+	// bronzeawsec2subnetDescAccountID is the schema descriptor for account_id field.
+	bronzeawsec2subnetDescAccountID := bronzeawsec2subnetFields[14].Descriptor()
+	// bronzeawsec2subnet.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzeawsec2subnet.AccountIDValidator = bronzeawsec2subnetDescAccountID.Validators[0].(func(string) error)
+	// bronzeawsec2subnetDescRegion is the schema descriptor for region field.
+	bronzeawsec2subnetDescRegion := bronzeawsec2subnetFields[15].Descriptor()
+	// bronzeawsec2subnet.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzeawsec2subnet.RegionValidator = bronzeawsec2subnetDescRegion.Validators[0].(func(string) error)
+	bronzeawsec2vpcFields := schema.BronzeAWSEC2VPC{}.Fields()
+	_ = bronzeawsec2vpcFields
+	// bronzeawsec2vpcDescAccountID is the schema descriptor for account_id field.
+	bronzeawsec2vpcDescAccountID := bronzeawsec2vpcFields[11].Descriptor()
+	// bronzeawsec2vpc.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzeawsec2vpc.AccountIDValidator = bronzeawsec2vpcDescAccountID.Validators[0].(func(string) error)
+	// bronzeawsec2vpcDescRegion is the schema descriptor for region field.
+	bronzeawsec2vpcDescRegion := bronzeawsec2vpcFields[12].Descriptor()
+	// bronzeawsec2vpc.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzeawsec2vpc.RegionValidator = bronzeawsec2vpcDescRegion.Validators[0].(func(string) error)
+	bronzehistoryawsec2addressFields := schema.BronzeHistoryAWSEC2Address{}.Fields()
+	_ = bronzehistoryawsec2addressFields
+	// bronzehistoryawsec2addressDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryawsec2addressDescResourceID := bronzehistoryawsec2addressFields[1].Descriptor()
+	// bronzehistoryawsec2address.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryawsec2address.ResourceIDValidator = bronzehistoryawsec2addressDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2addressDescAccountID is the schema descriptor for account_id field.
+	bronzehistoryawsec2addressDescAccountID := bronzehistoryawsec2addressFields[13].Descriptor()
+	// bronzehistoryawsec2address.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzehistoryawsec2address.AccountIDValidator = bronzehistoryawsec2addressDescAccountID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2addressDescRegion is the schema descriptor for region field.
+	bronzehistoryawsec2addressDescRegion := bronzehistoryawsec2addressFields[14].Descriptor()
+	// bronzehistoryawsec2address.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzehistoryawsec2address.RegionValidator = bronzehistoryawsec2addressDescRegion.Validators[0].(func(string) error)
 	bronzehistoryawsec2instanceFields := schema.BronzeHistoryAWSEC2Instance{}.Fields()
 	_ = bronzehistoryawsec2instanceFields
 	// bronzehistoryawsec2instanceDescResourceID is the schema descriptor for resource_id field.
@@ -43,4 +177,130 @@ func init() {
 	bronzehistoryawsec2instanceDescRegion := bronzehistoryawsec2instanceFields[16].Descriptor()
 	// bronzehistoryawsec2instance.RegionValidator is a validator for the "region" field. It is called by the builders before save.
 	bronzehistoryawsec2instance.RegionValidator = bronzehistoryawsec2instanceDescRegion.Validators[0].(func(string) error)
+	bronzehistoryawsec2internetgatewayFields := schema.BronzeHistoryAWSEC2InternetGateway{}.Fields()
+	_ = bronzehistoryawsec2internetgatewayFields
+	// bronzehistoryawsec2internetgatewayDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryawsec2internetgatewayDescResourceID := bronzehistoryawsec2internetgatewayFields[1].Descriptor()
+	// bronzehistoryawsec2internetgateway.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryawsec2internetgateway.ResourceIDValidator = bronzehistoryawsec2internetgatewayDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2internetgatewayDescAccountID is the schema descriptor for account_id field.
+	bronzehistoryawsec2internetgatewayDescAccountID := bronzehistoryawsec2internetgatewayFields[7].Descriptor()
+	// bronzehistoryawsec2internetgateway.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzehistoryawsec2internetgateway.AccountIDValidator = bronzehistoryawsec2internetgatewayDescAccountID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2internetgatewayDescRegion is the schema descriptor for region field.
+	bronzehistoryawsec2internetgatewayDescRegion := bronzehistoryawsec2internetgatewayFields[8].Descriptor()
+	// bronzehistoryawsec2internetgateway.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzehistoryawsec2internetgateway.RegionValidator = bronzehistoryawsec2internetgatewayDescRegion.Validators[0].(func(string) error)
+	bronzehistoryawsec2natgatewayFields := schema.BronzeHistoryAWSEC2NATGateway{}.Fields()
+	_ = bronzehistoryawsec2natgatewayFields
+	// bronzehistoryawsec2natgatewayDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryawsec2natgatewayDescResourceID := bronzehistoryawsec2natgatewayFields[1].Descriptor()
+	// bronzehistoryawsec2natgateway.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryawsec2natgateway.ResourceIDValidator = bronzehistoryawsec2natgatewayDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2natgatewayDescAccountID is the schema descriptor for account_id field.
+	bronzehistoryawsec2natgatewayDescAccountID := bronzehistoryawsec2natgatewayFields[12].Descriptor()
+	// bronzehistoryawsec2natgateway.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzehistoryawsec2natgateway.AccountIDValidator = bronzehistoryawsec2natgatewayDescAccountID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2natgatewayDescRegion is the schema descriptor for region field.
+	bronzehistoryawsec2natgatewayDescRegion := bronzehistoryawsec2natgatewayFields[13].Descriptor()
+	// bronzehistoryawsec2natgateway.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzehistoryawsec2natgateway.RegionValidator = bronzehistoryawsec2natgatewayDescRegion.Validators[0].(func(string) error)
+	bronzehistoryawsec2networkaclFields := schema.BronzeHistoryAWSEC2NetworkACL{}.Fields()
+	_ = bronzehistoryawsec2networkaclFields
+	// bronzehistoryawsec2networkaclDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryawsec2networkaclDescResourceID := bronzehistoryawsec2networkaclFields[1].Descriptor()
+	// bronzehistoryawsec2networkacl.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryawsec2networkacl.ResourceIDValidator = bronzehistoryawsec2networkaclDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2networkaclDescAccountID is the schema descriptor for account_id field.
+	bronzehistoryawsec2networkaclDescAccountID := bronzehistoryawsec2networkaclFields[9].Descriptor()
+	// bronzehistoryawsec2networkacl.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzehistoryawsec2networkacl.AccountIDValidator = bronzehistoryawsec2networkaclDescAccountID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2networkaclDescRegion is the schema descriptor for region field.
+	bronzehistoryawsec2networkaclDescRegion := bronzehistoryawsec2networkaclFields[10].Descriptor()
+	// bronzehistoryawsec2networkacl.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzehistoryawsec2networkacl.RegionValidator = bronzehistoryawsec2networkaclDescRegion.Validators[0].(func(string) error)
+	bronzehistoryawsec2networkinterfaceFields := schema.BronzeHistoryAWSEC2NetworkInterface{}.Fields()
+	_ = bronzehistoryawsec2networkinterfaceFields
+	// bronzehistoryawsec2networkinterfaceDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryawsec2networkinterfaceDescResourceID := bronzehistoryawsec2networkinterfaceFields[1].Descriptor()
+	// bronzehistoryawsec2networkinterface.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryawsec2networkinterface.ResourceIDValidator = bronzehistoryawsec2networkinterfaceDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2networkinterfaceDescAccountID is the schema descriptor for account_id field.
+	bronzehistoryawsec2networkinterfaceDescAccountID := bronzehistoryawsec2networkinterfaceFields[24].Descriptor()
+	// bronzehistoryawsec2networkinterface.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzehistoryawsec2networkinterface.AccountIDValidator = bronzehistoryawsec2networkinterfaceDescAccountID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2networkinterfaceDescRegion is the schema descriptor for region field.
+	bronzehistoryawsec2networkinterfaceDescRegion := bronzehistoryawsec2networkinterfaceFields[25].Descriptor()
+	// bronzehistoryawsec2networkinterface.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzehistoryawsec2networkinterface.RegionValidator = bronzehistoryawsec2networkinterfaceDescRegion.Validators[0].(func(string) error)
+	bronzehistoryawsec2routetableFields := schema.BronzeHistoryAWSEC2RouteTable{}.Fields()
+	_ = bronzehistoryawsec2routetableFields
+	// bronzehistoryawsec2routetableDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryawsec2routetableDescResourceID := bronzehistoryawsec2routetableFields[1].Descriptor()
+	// bronzehistoryawsec2routetable.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryawsec2routetable.ResourceIDValidator = bronzehistoryawsec2routetableDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2routetableDescAccountID is the schema descriptor for account_id field.
+	bronzehistoryawsec2routetableDescAccountID := bronzehistoryawsec2routetableFields[9].Descriptor()
+	// bronzehistoryawsec2routetable.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzehistoryawsec2routetable.AccountIDValidator = bronzehistoryawsec2routetableDescAccountID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2routetableDescRegion is the schema descriptor for region field.
+	bronzehistoryawsec2routetableDescRegion := bronzehistoryawsec2routetableFields[10].Descriptor()
+	// bronzehistoryawsec2routetable.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzehistoryawsec2routetable.RegionValidator = bronzehistoryawsec2routetableDescRegion.Validators[0].(func(string) error)
+	bronzehistoryawsec2securitygroupFields := schema.BronzeHistoryAWSEC2SecurityGroup{}.Fields()
+	_ = bronzehistoryawsec2securitygroupFields
+	// bronzehistoryawsec2securitygroupDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryawsec2securitygroupDescResourceID := bronzehistoryawsec2securitygroupFields[1].Descriptor()
+	// bronzehistoryawsec2securitygroup.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryawsec2securitygroup.ResourceIDValidator = bronzehistoryawsec2securitygroupDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2securitygroupDescAccountID is the schema descriptor for account_id field.
+	bronzehistoryawsec2securitygroupDescAccountID := bronzehistoryawsec2securitygroupFields[8].Descriptor()
+	// bronzehistoryawsec2securitygroup.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzehistoryawsec2securitygroup.AccountIDValidator = bronzehistoryawsec2securitygroupDescAccountID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2securitygroupDescRegion is the schema descriptor for region field.
+	bronzehistoryawsec2securitygroupDescRegion := bronzehistoryawsec2securitygroupFields[9].Descriptor()
+	// bronzehistoryawsec2securitygroup.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzehistoryawsec2securitygroup.RegionValidator = bronzehistoryawsec2securitygroupDescRegion.Validators[0].(func(string) error)
+	bronzehistoryawsec2securitygroupruleFields := schema.BronzeHistoryAWSEC2SecurityGroupRule{}.Fields()
+	_ = bronzehistoryawsec2securitygroupruleFields
+	// bronzehistoryawsec2securitygroupruleDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryawsec2securitygroupruleDescResourceID := bronzehistoryawsec2securitygroupruleFields[1].Descriptor()
+	// bronzehistoryawsec2securitygrouprule.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryawsec2securitygrouprule.ResourceIDValidator = bronzehistoryawsec2securitygroupruleDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2securitygroupruleDescAccountID is the schema descriptor for account_id field.
+	bronzehistoryawsec2securitygroupruleDescAccountID := bronzehistoryawsec2securitygroupruleFields[16].Descriptor()
+	// bronzehistoryawsec2securitygrouprule.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzehistoryawsec2securitygrouprule.AccountIDValidator = bronzehistoryawsec2securitygroupruleDescAccountID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2securitygroupruleDescRegion is the schema descriptor for region field.
+	bronzehistoryawsec2securitygroupruleDescRegion := bronzehistoryawsec2securitygroupruleFields[17].Descriptor()
+	// bronzehistoryawsec2securitygrouprule.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzehistoryawsec2securitygrouprule.RegionValidator = bronzehistoryawsec2securitygroupruleDescRegion.Validators[0].(func(string) error)
+	bronzehistoryawsec2subnetFields := schema.BronzeHistoryAWSEC2Subnet{}.Fields()
+	_ = bronzehistoryawsec2subnetFields
+	// bronzehistoryawsec2subnetDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryawsec2subnetDescResourceID := bronzehistoryawsec2subnetFields[1].Descriptor()
+	// bronzehistoryawsec2subnet.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryawsec2subnet.ResourceIDValidator = bronzehistoryawsec2subnetDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2subnetDescAccountID is the schema descriptor for account_id field.
+	bronzehistoryawsec2subnetDescAccountID := bronzehistoryawsec2subnetFields[15].Descriptor()
+	// bronzehistoryawsec2subnet.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzehistoryawsec2subnet.AccountIDValidator = bronzehistoryawsec2subnetDescAccountID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2subnetDescRegion is the schema descriptor for region field.
+	bronzehistoryawsec2subnetDescRegion := bronzehistoryawsec2subnetFields[16].Descriptor()
+	// bronzehistoryawsec2subnet.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzehistoryawsec2subnet.RegionValidator = bronzehistoryawsec2subnetDescRegion.Validators[0].(func(string) error)
+	bronzehistoryawsec2vpcFields := schema.BronzeHistoryAWSEC2VPC{}.Fields()
+	_ = bronzehistoryawsec2vpcFields
+	// bronzehistoryawsec2vpcDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryawsec2vpcDescResourceID := bronzehistoryawsec2vpcFields[1].Descriptor()
+	// bronzehistoryawsec2vpc.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryawsec2vpc.ResourceIDValidator = bronzehistoryawsec2vpcDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2vpcDescAccountID is the schema descriptor for account_id field.
+	bronzehistoryawsec2vpcDescAccountID := bronzehistoryawsec2vpcFields[12].Descriptor()
+	// bronzehistoryawsec2vpc.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzehistoryawsec2vpc.AccountIDValidator = bronzehistoryawsec2vpcDescAccountID.Validators[0].(func(string) error)
+	// bronzehistoryawsec2vpcDescRegion is the schema descriptor for region field.
+	bronzehistoryawsec2vpcDescRegion := bronzehistoryawsec2vpcFields[13].Descriptor()
+	// bronzehistoryawsec2vpc.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzehistoryawsec2vpc.RegionValidator = bronzehistoryawsec2vpcDescRegion.Validators[0].(func(string) error)
 }
@@ -6,6 +6,10 @@ import (
 	bronzehistory_aws_ec2 "danny.vn/hotpot/pkg/schema/bronzehistory/aws/ec2"
 )
 
+type BronzeAWSEC2Address struct {
+	bronze_aws_ec2.BronzeAWSEC2Address
+}
+
 type BronzeAWSEC2Instance struct {
 	bronze_aws_ec2.BronzeAWSEC2Instance
 }
@@ -14,6 +18,46 @@ type BronzeAWSEC2InstanceTag struct {
 	bronze_aws_ec2.BronzeAWSEC2InstanceTag
 }
 
+type BronzeAWSEC2InternetGateway struct {
+	bronze_aws_ec2.BronzeAWSEC2InternetGateway
+}
+
+type BronzeAWSEC2NATGateway struct {
+	bronze_aws_ec2.BronzeAWSEC2NATGateway
+}
+
+type BronzeAWSEC2NetworkACL struct {
+	bronze_aws_ec2.BronzeAWSEC2NetworkACL
+}
+
+type BronzeAWSEC2NetworkInterface struct {
+	bronze_aws_ec2.BronzeAWSEC2NetworkInterface
+}
+
+type BronzeAWSEC2RouteTable struct {
+	bronze_aws_ec2.BronzeAWSEC2RouteTable
+}
+
+type BronzeAWSEC2SecurityGroup struct {
+	bronze_aws_ec2.BronzeAWSEC2SecurityGroup
+}
+
+type BronzeAWSEC2SecurityGroupRule struct {
+	bronze_aws_ec2.BronzeAWSEC2SecurityGroupRule
+}
+
+type BronzeAWSEC2Subnet struct {
+	bronze_aws_ec2.BronzeAWSEC2Subnet
+}
+
+type BronzeAWSEC2VPC struct {
+	bronze_aws_ec2.BronzeAWSEC2VPC
+}
+
+type BronzeHistoryAWSEC2Address struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2Address
+}
+
 type BronzeHistoryAWSEC2Instance struct {
 	bronzehistory_aws_ec2.BronzeHistoryAWSEC2Instance
 }
@@ -21,3 +65,39 @@ type BronzeHistoryAWSEC2Instance struct {
 type BronzeHistoryAWSEC2InstanceTag struct {
 	bronzehistory_aws_ec2.BronzeHistoryAWSEC2InstanceTag
 }
+
+type BronzeHistoryAWSEC2InternetGateway struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2InternetGateway
+}
+
+type BronzeHistoryAWSEC2NATGateway struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2NATGateway
+}
+
+type BronzeHistoryAWSEC2NetworkACL struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2NetworkACL
+}
+
+type BronzeHistoryAWSEC2NetworkInterface struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2NetworkInterface
+}
+
+type BronzeHistoryAWSEC2RouteTable struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2RouteTable
+}
+
+type BronzeHistoryAWSEC2SecurityGroup struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2SecurityGroup
+}
+
+type BronzeHistoryAWSEC2SecurityGroupRule struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2SecurityGroupRule
+}
+
+type BronzeHistoryAWSEC2Subnet struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2Subnet
+}
+
+type BronzeHistoryAWSEC2VPC struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2VPC
+}
@@ -4,9 +4,29 @@ package ec2
 // DefaultSchemaConfig returns the schema config mapping each type to its PG schema.
 func DefaultSchemaConfig() SchemaConfig {
 	return SchemaConfig{
-		BronzeAWSEC2Instance:           "bronze",
-		BronzeAWSEC2InstanceTag:        "bronze",
-		BronzeHistoryAWSEC2Instance:    "bronze_history",
-		BronzeHistoryAWSEC2InstanceTag: "bronze_history",
+		BronzeAWSEC2Address:                  "bronze",
+		BronzeAWSEC2Instance:                 "bronze",
+		BronzeAWSEC2InstanceTag:              "bronze",
+		BronzeAWSEC2InternetGateway:          "bronze",
+		BronzeAWSEC2NATGateway:               "bronze",
+		BronzeAWSEC2NetworkACL:               "bronze",
+		BronzeAWSEC2NetworkInterface:         "bronze",
+		BronzeAWSEC2RouteTable:               "bronze",
+		BronzeAWSEC2SecurityGroup:            "bronze",
+		BronzeAWSEC2SecurityGroupRule:        "bronze",
+		BronzeAWSEC2Subnet:                   "bronze",
+		BronzeAWSEC2VPC:                      "bronze",
+		BronzeHistoryAWSEC2Address:           "bronze_history",
+		BronzeHistoryAWSEC2Instance:          "bronze_history",
+		BronzeHistoryAWSEC2InstanceTag:       "bronze_history",
+		BronzeHistoryAWSEC2InternetGateway:   "bronze_history",
+		BronzeHistoryAWSEC2NATGateway:        "bronze_history",
+		BronzeHistoryAWSEC2NetworkACL:        "bronze_history",
+		BronzeHistoryAWSEC2NetworkInterface:  "bronze_history",
+		BronzeHistoryAWSEC2RouteTable:        "bronze_history",
+		BronzeHistoryAWSEC2SecurityGroup:     "bronze_history",
+		BronzeHistoryAWSEC2SecurityGroupRule: "bronze_history",
+		BronzeHistoryAWSEC2Subnet:            "bronze_history",
+		BronzeHistoryAWSEC2VPC:               "bronze_history",
 	}
 }
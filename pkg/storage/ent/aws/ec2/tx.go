@@ -12,14 +12,54 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// BronzeAWSEC2Address is the client for interacting with the BronzeAWSEC2Address builders.
+	BronzeAWSEC2Address *BronzeAWSEC2AddressClient
 	// BronzeAWSEC2Instance is the client for interacting with the BronzeAWSEC2Instance builders.
 	BronzeAWSEC2Instance *BronzeAWSEC2InstanceClient
 	// BronzeAWSEC2InstanceTag is the client for interacting with the BronzeAWSEC2InstanceTag builders.
 	BronzeAWSEC2InstanceTag *BronzeAWSEC2InstanceTagClient
+	// BronzeAWSEC2InternetGateway is the client for interacting with the BronzeAWSEC2InternetGateway builders.
+	BronzeAWSEC2InternetGateway *BronzeAWSEC2InternetGatewayClient
+	// BronzeAWSEC2NATGateway is the client for interacting with the BronzeAWSEC2NATGateway builders.
+	BronzeAWSEC2NATGateway *BronzeAWSEC2NATGatewayClient
+	// BronzeAWSEC2NetworkACL is the client for interacting with the BronzeAWSEC2NetworkACL builders.
+	BronzeAWSEC2NetworkACL *BronzeAWSEC2NetworkACLClient
+	// BronzeAWSEC2NetworkInterface is the client for interacting with the BronzeAWSEC2NetworkInterface builders.
+	BronzeAWSEC2NetworkInterface *BronzeAWSEC2NetworkInterfaceClient
+	// BronzeAWSEC2RouteTable is the client for interacting with the BronzeAWSEC2RouteTable builders.
+	BronzeAWSEC2RouteTable *BronzeAWSEC2RouteTableClient
+	// BronzeAWSEC2SecurityGroup is the client for interacting with the BronzeAWSEC2SecurityGroup builders.
+	BronzeAWSEC2SecurityGroup *BronzeAWSEC2SecurityGroupClient
+	// BronzeAWSEC2SecurityGroupRule is the client for interacting with the BronzeAWSEC2SecurityGroupRule builders.
+	BronzeAWSEC2SecurityGroupRule *BronzeAWSEC2SecurityGroupRuleClient
+	// BronzeAWSEC2Subnet is the client for interacting with the BronzeAWSEC2Subnet builders.
+	BronzeAWSEC2Subnet *BronzeAWSEC2SubnetClient
+	// BronzeAWSEC2VPC is the client for interacting with the BronzeAWSEC2VPC builders.
+	BronzeAWSEC2VPC *BronzeAWSEC2VPCClient
+	// BronzeHistoryAWSEC2Address is the client for interacting with the BronzeHistoryAWSEC2Address builders.
+	BronzeHistoryAWSEC2Address *BronzeHistoryAWSEC2AddressClient
 	// BronzeHistoryAWSEC2Instance is the client for interacting with the BronzeHistoryAWSEC2Instance builders.
 	BronzeHistoryAWSEC2Instance *BronzeHistoryAWSEC2InstanceClient
 	// BronzeHistoryAWSEC2InstanceTag is the client for interacting with the BronzeHistoryAWSEC2InstanceTag builders.
 	BronzeHistoryAWSEC2InstanceTag *BronzeHistoryAWSEC2InstanceTagClient
+	// BronzeHistoryAWSEC2InternetGateway is the client for interacting with the BronzeHistoryAWSEC2InternetGateway builders.
+	BronzeHistoryAWSEC2InternetGateway *BronzeHistoryAWSEC2InternetGatewayClient
+	// BronzeHistoryAWSEC2NATGateway is the client for interacting with the BronzeHistoryAWSEC2NATGateway builders.
+	BronzeHistoryAWSEC2NATGateway *BronzeHistoryAWSEC2NATGatewayClient
+	// BronzeHistoryAWSEC2NetworkACL is the client for interacting with the BronzeHistoryAWSEC2NetworkACL builders.
+	BronzeHistoryAWSEC2NetworkACL *BronzeHistoryAWSEC2NetworkACLClient
+	// BronzeHistoryAWSEC2NetworkInterface is the client for interacting with the BronzeHistoryAWSEC2NetworkInterface builders.
+	BronzeHistoryAWSEC2NetworkInterface *BronzeHistoryAWSEC2NetworkInterfaceClient
+	// BronzeHistoryAWSEC2RouteTable is the client for interacting with the BronzeHistoryAWSEC2RouteTable builders.
+	BronzeHistoryAWSEC2RouteTable *BronzeHistoryAWSEC2RouteTableClient
+	// BronzeHistoryAWSEC2SecurityGroup is the client for interacting with the BronzeHistoryAWSEC2SecurityGroup builders.
+	BronzeHistoryAWSEC2SecurityGroup *BronzeHistoryAWSEC2SecurityGroupClient
+	// BronzeHistoryAWSEC2SecurityGroupRule is the client for interacting with the BronzeHistoryAWSEC2SecurityGroupRule builders.
+	BronzeHistoryAWSEC2SecurityGroupRule *BronzeHistoryAWSEC2SecurityGroupRuleClient
+	// BronzeHistoryAWSEC2Subnet is the client for interacting with the BronzeHistoryAWSEC2Subnet builders.
+	BronzeHistoryAWSEC2Subnet *BronzeHistoryAWSEC2SubnetClient
+	// BronzeHistoryAWSEC2VPC is the client for interacting with the BronzeHistoryAWSEC2VPC builders.
+	BronzeHistoryAWSEC2VPC *BronzeHistoryAWSEC2VPCClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,10 +191,30 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.BronzeAWSEC2Address = NewBronzeAWSEC2AddressClient(tx.config)
 	tx.BronzeAWSEC2Instance = NewBronzeAWSEC2InstanceClient(tx.config)
 	tx.BronzeAWSEC2InstanceTag = NewBronzeAWSEC2InstanceTagClient(tx.config)
+	tx.BronzeAWSEC2InternetGateway = NewBronzeAWSEC2InternetGatewayClient(tx.config)
+	tx.BronzeAWSEC2NATGateway = NewBronzeAWSEC2NATGatewayClient(tx.config)
+	tx.BronzeAWSEC2NetworkACL = NewBronzeAWSEC2NetworkACLClient(tx.config)
+	tx.BronzeAWSEC2NetworkInterface = NewBronzeAWSEC2NetworkInterfaceClient(tx.config)
+	tx.BronzeAWSEC2RouteTable = NewBronzeAWSEC2RouteTableClient(tx.config)
+	tx.BronzeAWSEC2SecurityGroup = NewBronzeAWSEC2SecurityGroupClient(tx.config)
+	tx.BronzeAWSEC2SecurityGroupRule = NewBronzeAWSEC2SecurityGroupRuleClient(tx.config)
+	tx.BronzeAWSEC2Subnet = NewBronzeAWSEC2SubnetClient(tx.config)
+	tx.BronzeAWSEC2VPC = NewBronzeAWSEC2VPCClient(tx.config)
+	tx.BronzeHistoryAWSEC2Address = NewBronzeHistoryAWSEC2AddressClient(tx.config)
 	tx.BronzeHistoryAWSEC2Instance = NewBronzeHistoryAWSEC2InstanceClient(tx.config)
 	tx.BronzeHistoryAWSEC2InstanceTag = NewBronzeHistoryAWSEC2InstanceTagClient(tx.config)
+	tx.BronzeHistoryAWSEC2InternetGateway = NewBronzeHistoryAWSEC2InternetGatewayClient(tx.config)
+	tx.BronzeHistoryAWSEC2NATGateway = NewBronzeHistoryAWSEC2NATGatewayClient(tx.config)
+	tx.BronzeHistoryAWSEC2NetworkACL = NewBronzeHistoryAWSEC2NetworkACLClient(tx.config)
+	tx.BronzeHistoryAWSEC2NetworkInterface = NewBronzeHistoryAWSEC2NetworkInterfaceClient(tx.config)
+	tx.BronzeHistoryAWSEC2RouteTable = NewBronzeHistoryAWSEC2RouteTableClient(tx.config)
+	tx.BronzeHistoryAWSEC2SecurityGroup = NewBronzeHistoryAWSEC2SecurityGroupClient(tx.config)
+	tx.BronzeHistoryAWSEC2SecurityGroupRule = NewBronzeHistoryAWSEC2SecurityGroupRuleClient(tx.config)
+	tx.BronzeHistoryAWSEC2Subnet = NewBronzeHistoryAWSEC2SubnetClient(tx.config)
+	tx.BronzeHistoryAWSEC2VPC = NewBronzeHistoryAWSEC2VPCClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -164,7 +224,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: BronzeAWSEC2Instance.QueryXXX(), the query will be executed
+// applies a query, for example: BronzeAWSEC2Address.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
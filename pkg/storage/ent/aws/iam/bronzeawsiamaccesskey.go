@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamaccesskey"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeAWSIAMAccessKey is the model entity for the BronzeAWSIAMAccessKey schema.
+type BronzeAWSIAMAccessKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Access key ID (AKIA...), used as primary key
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Name of the IAM user that owns the key
+	UserName string `json:"user_name,omitempty"`
+	// Active or Inactive
+	Status string `json:"status,omitempty"`
+	// CreateDate holds the value of the "create_date" field.
+	CreateDate *time.Time `json:"create_date,omitempty"`
+	// Null if the key has never been used
+	LastUsedDate *time.Time `json:"last_used_date,omitempty"`
+	// LastUsedService holds the value of the "last_used_service" field.
+	LastUsedService string `json:"last_used_service,omitempty"`
+	// LastUsedRegion holds the value of the "last_used_region" field.
+	LastUsedRegion string `json:"last_used_region,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID    string `json:"account_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeAWSIAMAccessKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsiamaccesskey.FieldID, bronzeawsiamaccesskey.FieldUserName, bronzeawsiamaccesskey.FieldStatus, bronzeawsiamaccesskey.FieldLastUsedService, bronzeawsiamaccesskey.FieldLastUsedRegion, bronzeawsiamaccesskey.FieldAccountID:
+			values[i] = new(sql.NullString)
+		case bronzeawsiamaccesskey.FieldCollectedAt, bronzeawsiamaccesskey.FieldFirstCollectedAt, bronzeawsiamaccesskey.FieldCreateDate, bronzeawsiamaccesskey.FieldLastUsedDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeAWSIAMAccessKey fields.
+func (_m *BronzeAWSIAMAccessKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsiamaccesskey.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzeawsiamaccesskey.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzeawsiamaccesskey.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzeawsiamaccesskey.FieldUserName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_name", values[i])
+			} else if value.Valid {
+				_m.UserName = value.String
+			}
+		case bronzeawsiamaccesskey.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case bronzeawsiamaccesskey.FieldCreateDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_date", values[i])
+			} else if value.Valid {
+				_m.CreateDate = new(time.Time)
+				*_m.CreateDate = value.Time
+			}
+		case bronzeawsiamaccesskey.FieldLastUsedDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_date", values[i])
+			} else if value.Valid {
+				_m.LastUsedDate = new(time.Time)
+				*_m.LastUsedDate = value.Time
+			}
+		case bronzeawsiamaccesskey.FieldLastUsedService:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_service", values[i])
+			} else if value.Valid {
+				_m.LastUsedService = value.String
+			}
+		case bronzeawsiamaccesskey.FieldLastUsedRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_region", values[i])
+			} else if value.Valid {
+				_m.LastUsedRegion = value.String
+			}
+		case bronzeawsiamaccesskey.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeAWSIAMAccessKey.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeAWSIAMAccessKey) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeAWSIAMAccessKey.
+// Note that you need to call BronzeAWSIAMAccessKey.Unwrap() before calling this method if this BronzeAWSIAMAccessKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeAWSIAMAccessKey) Update() *BronzeAWSIAMAccessKeyUpdateOne {
+	return NewBronzeAWSIAMAccessKeyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeAWSIAMAccessKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeAWSIAMAccessKey) Unwrap() *BronzeAWSIAMAccessKey {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("iam: BronzeAWSIAMAccessKey is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeAWSIAMAccessKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeAWSIAMAccessKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_name=")
+	builder.WriteString(_m.UserName)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.CreateDate; v != nil {
+		builder.WriteString("create_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastUsedDate; v != nil {
+		builder.WriteString("last_used_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_used_service=")
+	builder.WriteString(_m.LastUsedService)
+	builder.WriteString(", ")
+	builder.WriteString("last_used_region=")
+	builder.WriteString(_m.LastUsedRegion)
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeAWSIAMAccessKeys is a parsable slice of BronzeAWSIAMAccessKey.
+type BronzeAWSIAMAccessKeys []*BronzeAWSIAMAccessKey
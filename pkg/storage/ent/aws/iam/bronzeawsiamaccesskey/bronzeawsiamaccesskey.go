@@ -0,0 +1,118 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawsiamaccesskey
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzeawsiamaccesskey type in the database.
+	Label = "bronze_awsiam_access_key"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldUserName holds the string denoting the user_name field in the database.
+	FieldUserName = "user_name"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreateDate holds the string denoting the create_date field in the database.
+	FieldCreateDate = "create_date"
+	// FieldLastUsedDate holds the string denoting the last_used_date field in the database.
+	FieldLastUsedDate = "last_used_date"
+	// FieldLastUsedService holds the string denoting the last_used_service field in the database.
+	FieldLastUsedService = "last_used_service"
+	// FieldLastUsedRegion holds the string denoting the last_used_region field in the database.
+	FieldLastUsedRegion = "last_used_region"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// Table holds the table name of the bronzeawsiamaccesskey in the database.
+	Table = "aws_iam_access_keys"
+)
+
+// Columns holds all SQL columns for bronzeawsiamaccesskey fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldUserName,
+	FieldStatus,
+	FieldCreateDate,
+	FieldLastUsedDate,
+	FieldLastUsedService,
+	FieldLastUsedRegion,
+	FieldAccountID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserNameValidator is a validator for the "user_name" field. It is called by the builders before save.
+	UserNameValidator func(string) error
+	// AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	AccountIDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BronzeAWSIAMAccessKey queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByUserName orders the results by the user_name field.
+func ByUserName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserName, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreateDate orders the results by the create_date field.
+func ByCreateDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateDate, opts...).ToFunc()
+}
+
+// ByLastUsedDate orders the results by the last_used_date field.
+func ByLastUsedDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastUsedDate, opts...).ToFunc()
+}
+
+// ByLastUsedService orders the results by the last_used_service field.
+func ByLastUsedService(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastUsedService, opts...).ToFunc()
+}
+
+// ByLastUsedRegion orders the results by the last_used_region field.
+func ByLastUsedRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastUsedRegion, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamaccesskey"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSIAMAccessKeyCreate is the builder for creating a BronzeAWSIAMAccessKey entity.
+type BronzeAWSIAMAccessKeyCreate struct {
+	config
+	mutation *BronzeAWSIAMAccessKeyMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeAWSIAMAccessKeyCreate) SetCollectedAt(v time.Time) *BronzeAWSIAMAccessKeyCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeAWSIAMAccessKeyCreate) SetFirstCollectedAt(v time.Time) *BronzeAWSIAMAccessKeyCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetUserName sets the "user_name" field.
+func (_c *BronzeAWSIAMAccessKeyCreate) SetUserName(v string) *BronzeAWSIAMAccessKeyCreate {
+	_c.mutation.SetUserName(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *BronzeAWSIAMAccessKeyCreate) SetStatus(v string) *BronzeAWSIAMAccessKeyCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *BronzeAWSIAMAccessKeyCreate) SetNillableStatus(v *string) *BronzeAWSIAMAccessKeyCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_c *BronzeAWSIAMAccessKeyCreate) SetCreateDate(v time.Time) *BronzeAWSIAMAccessKeyCreate {
+	_c.mutation.SetCreateDate(v)
+	return _c
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_c *BronzeAWSIAMAccessKeyCreate) SetNillableCreateDate(v *time.Time) *BronzeAWSIAMAccessKeyCreate {
+	if v != nil {
+		_c.SetCreateDate(*v)
+	}
+	return _c
+}
+
+// SetLastUsedDate sets the "last_used_date" field.
+func (_c *BronzeAWSIAMAccessKeyCreate) SetLastUsedDate(v time.Time) *BronzeAWSIAMAccessKeyCreate {
+	_c.mutation.SetLastUsedDate(v)
+	return _c
+}
+
+// SetNillableLastUsedDate sets the "last_used_date" field if the given value is not nil.
+func (_c *BronzeAWSIAMAccessKeyCreate) SetNillableLastUsedDate(v *time.Time) *BronzeAWSIAMAccessKeyCreate {
+	if v != nil {
+		_c.SetLastUsedDate(*v)
+	}
+	return _c
+}
+
+// SetLastUsedService sets the "last_used_service" field.
+func (_c *BronzeAWSIAMAccessKeyCreate) SetLastUsedService(v string) *BronzeAWSIAMAccessKeyCreate {
+	_c.mutation.SetLastUsedService(v)
+	return _c
+}
+
+// SetNillableLastUsedService sets the "last_used_service" field if the given value is not nil.
+func (_c *BronzeAWSIAMAccessKeyCreate) SetNillableLastUsedService(v *string) *BronzeAWSIAMAccessKeyCreate {
+	if v != nil {
+		_c.SetLastUsedService(*v)
+	}
+	return _c
+}
+
+// SetLastUsedRegion sets the "last_used_region" field.
+func (_c *BronzeAWSIAMAccessKeyCreate) SetLastUsedRegion(v string) *BronzeAWSIAMAccessKeyCreate {
+	_c.mutation.SetLastUsedRegion(v)
+	return _c
+}
+
+// SetNillableLastUsedRegion sets the "last_used_region" field if the given value is not nil.
+func (_c *BronzeAWSIAMAccessKeyCreate) SetNillableLastUsedRegion(v *string) *BronzeAWSIAMAccessKeyCreate {
+	if v != nil {
+		_c.SetLastUsedRegion(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeAWSIAMAccessKeyCreate) SetAccountID(v string) *BronzeAWSIAMAccessKeyCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeAWSIAMAccessKeyCreate) SetID(v string) *BronzeAWSIAMAccessKeyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeAWSIAMAccessKeyMutation object of the builder.
+func (_c *BronzeAWSIAMAccessKeyCreate) Mutation() *BronzeAWSIAMAccessKeyMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeAWSIAMAccessKey in the database.
+func (_c *BronzeAWSIAMAccessKeyCreate) Save(ctx context.Context) (*BronzeAWSIAMAccessKey, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeAWSIAMAccessKeyCreate) SaveX(ctx context.Context) *BronzeAWSIAMAccessKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSIAMAccessKeyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSIAMAccessKeyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeAWSIAMAccessKeyCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`iam: missing required field "BronzeAWSIAMAccessKey.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`iam: missing required field "BronzeAWSIAMAccessKey.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.UserName(); !ok {
+		return &ValidationError{Name: "user_name", err: errors.New(`iam: missing required field "BronzeAWSIAMAccessKey.user_name"`)}
+	}
+	if v, ok := _c.mutation.UserName(); ok {
+		if err := bronzeawsiamaccesskey.UserNameValidator(v); err != nil {
+			return &ValidationError{Name: "user_name", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMAccessKey.user_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`iam: missing required field "BronzeAWSIAMAccessKey.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzeawsiamaccesskey.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMAccessKey.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeAWSIAMAccessKeyCreate) sqlSave(ctx context.Context) (*BronzeAWSIAMAccessKey, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeAWSIAMAccessKey.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeAWSIAMAccessKeyCreate) createSpec() (*BronzeAWSIAMAccessKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeAWSIAMAccessKey{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzeawsiamaccesskey.Table, sqlgraph.NewFieldSpec(bronzeawsiamaccesskey.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeAWSIAMAccessKey
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.UserName(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldUserName, field.TypeString, value)
+		_node.UserName = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CreateDate(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldCreateDate, field.TypeTime, value)
+		_node.CreateDate = &value
+	}
+	if value, ok := _c.mutation.LastUsedDate(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldLastUsedDate, field.TypeTime, value)
+		_node.LastUsedDate = &value
+	}
+	if value, ok := _c.mutation.LastUsedService(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldLastUsedService, field.TypeString, value)
+		_node.LastUsedService = value
+	}
+	if value, ok := _c.mutation.LastUsedRegion(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldLastUsedRegion, field.TypeString, value)
+		_node.LastUsedRegion = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	return _node, _spec
+}
+
+// BronzeAWSIAMAccessKeyCreateBulk is the builder for creating many BronzeAWSIAMAccessKey entities in bulk.
+type BronzeAWSIAMAccessKeyCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeAWSIAMAccessKeyCreate
+}
+
+// Save creates the BronzeAWSIAMAccessKey entities in the database.
+func (_c *BronzeAWSIAMAccessKeyCreateBulk) Save(ctx context.Context) ([]*BronzeAWSIAMAccessKey, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeAWSIAMAccessKey, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeAWSIAMAccessKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeAWSIAMAccessKeyCreateBulk) SaveX(ctx context.Context) []*BronzeAWSIAMAccessKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSIAMAccessKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSIAMAccessKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamaccesskey"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSIAMAccessKeyDelete is the builder for deleting a BronzeAWSIAMAccessKey entity.
+type BronzeAWSIAMAccessKeyDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSIAMAccessKeyMutation
+}
+
+// Where appends a list predicates to the BronzeAWSIAMAccessKeyDelete builder.
+func (_d *BronzeAWSIAMAccessKeyDelete) Where(ps ...predicate.BronzeAWSIAMAccessKey) *BronzeAWSIAMAccessKeyDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeAWSIAMAccessKeyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeAWSIAMAccessKeyDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeAWSIAMAccessKeyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzeawsiamaccesskey.Table, sqlgraph.NewFieldSpec(bronzeawsiamaccesskey.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.BronzeAWSIAMAccessKey
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeAWSIAMAccessKeyDeleteOne is the builder for deleting a single BronzeAWSIAMAccessKey entity.
+type BronzeAWSIAMAccessKeyDeleteOne struct {
+	_d *BronzeAWSIAMAccessKeyDelete
+}
+
+// Where appends a list predicates to the BronzeAWSIAMAccessKeyDelete builder.
+func (_d *BronzeAWSIAMAccessKeyDeleteOne) Where(ps ...predicate.BronzeAWSIAMAccessKey) *BronzeAWSIAMAccessKeyDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeAWSIAMAccessKeyDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzeawsiamaccesskey.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeAWSIAMAccessKeyDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
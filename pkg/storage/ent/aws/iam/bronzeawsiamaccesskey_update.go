@@ -0,0 +1,579 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamaccesskey"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSIAMAccessKeyUpdate is the builder for updating BronzeAWSIAMAccessKey entities.
+type BronzeAWSIAMAccessKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSIAMAccessKeyMutation
+}
+
+// Where appends a list predicates to the BronzeAWSIAMAccessKeyUpdate builder.
+func (_u *BronzeAWSIAMAccessKeyUpdate) Where(ps ...predicate.BronzeAWSIAMAccessKey) *BronzeAWSIAMAccessKeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSIAMAccessKeyUpdate) SetCollectedAt(v time.Time) *BronzeAWSIAMAccessKeyUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSIAMAccessKeyUpdate) SetNillableCollectedAt(v *time.Time) *BronzeAWSIAMAccessKeyUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *BronzeAWSIAMAccessKeyUpdate) SetUserName(v string) *BronzeAWSIAMAccessKeyUpdate {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *BronzeAWSIAMAccessKeyUpdate) SetNillableUserName(v *string) *BronzeAWSIAMAccessKeyUpdate {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BronzeAWSIAMAccessKeyUpdate) SetStatus(v string) *BronzeAWSIAMAccessKeyUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BronzeAWSIAMAccessKeyUpdate) SetNillableStatus(v *string) *BronzeAWSIAMAccessKeyUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *BronzeAWSIAMAccessKeyUpdate) ClearStatus() *BronzeAWSIAMAccessKeyUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeAWSIAMAccessKeyUpdate) SetCreateDate(v time.Time) *BronzeAWSIAMAccessKeyUpdate {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMAccessKeyUpdate) SetNillableCreateDate(v *time.Time) *BronzeAWSIAMAccessKeyUpdate {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeAWSIAMAccessKeyUpdate) ClearCreateDate() *BronzeAWSIAMAccessKeyUpdate {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetLastUsedDate sets the "last_used_date" field.
+func (_u *BronzeAWSIAMAccessKeyUpdate) SetLastUsedDate(v time.Time) *BronzeAWSIAMAccessKeyUpdate {
+	_u.mutation.SetLastUsedDate(v)
+	return _u
+}
+
+// SetNillableLastUsedDate sets the "last_used_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMAccessKeyUpdate) SetNillableLastUsedDate(v *time.Time) *BronzeAWSIAMAccessKeyUpdate {
+	if v != nil {
+		_u.SetLastUsedDate(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedDate clears the value of the "last_used_date" field.
+func (_u *BronzeAWSIAMAccessKeyUpdate) ClearLastUsedDate() *BronzeAWSIAMAccessKeyUpdate {
+	_u.mutation.ClearLastUsedDate()
+	return _u
+}
+
+// SetLastUsedService sets the "last_used_service" field.
+func (_u *BronzeAWSIAMAccessKeyUpdate) SetLastUsedService(v string) *BronzeAWSIAMAccessKeyUpdate {
+	_u.mutation.SetLastUsedService(v)
+	return _u
+}
+
+// SetNillableLastUsedService sets the "last_used_service" field if the given value is not nil.
+func (_u *BronzeAWSIAMAccessKeyUpdate) SetNillableLastUsedService(v *string) *BronzeAWSIAMAccessKeyUpdate {
+	if v != nil {
+		_u.SetLastUsedService(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedService clears the value of the "last_used_service" field.
+func (_u *BronzeAWSIAMAccessKeyUpdate) ClearLastUsedService() *BronzeAWSIAMAccessKeyUpdate {
+	_u.mutation.ClearLastUsedService()
+	return _u
+}
+
+// SetLastUsedRegion sets the "last_used_region" field.
+func (_u *BronzeAWSIAMAccessKeyUpdate) SetLastUsedRegion(v string) *BronzeAWSIAMAccessKeyUpdate {
+	_u.mutation.SetLastUsedRegion(v)
+	return _u
+}
+
+// SetNillableLastUsedRegion sets the "last_used_region" field if the given value is not nil.
+func (_u *BronzeAWSIAMAccessKeyUpdate) SetNillableLastUsedRegion(v *string) *BronzeAWSIAMAccessKeyUpdate {
+	if v != nil {
+		_u.SetLastUsedRegion(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedRegion clears the value of the "last_used_region" field.
+func (_u *BronzeAWSIAMAccessKeyUpdate) ClearLastUsedRegion() *BronzeAWSIAMAccessKeyUpdate {
+	_u.mutation.ClearLastUsedRegion()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSIAMAccessKeyUpdate) SetAccountID(v string) *BronzeAWSIAMAccessKeyUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSIAMAccessKeyUpdate) SetNillableAccountID(v *string) *BronzeAWSIAMAccessKeyUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSIAMAccessKeyMutation object of the builder.
+func (_u *BronzeAWSIAMAccessKeyUpdate) Mutation() *BronzeAWSIAMAccessKeyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeAWSIAMAccessKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSIAMAccessKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeAWSIAMAccessKeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSIAMAccessKeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSIAMAccessKeyUpdate) check() error {
+	if v, ok := _u.mutation.UserName(); ok {
+		if err := bronzeawsiamaccesskey.UserNameValidator(v); err != nil {
+			return &ValidationError{Name: "user_name", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMAccessKey.user_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsiamaccesskey.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMAccessKey.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSIAMAccessKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsiamaccesskey.Table, bronzeawsiamaccesskey.Columns, sqlgraph.NewFieldSpec(bronzeawsiamaccesskey.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldUserName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(bronzeawsiamaccesskey.FieldStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzeawsiamaccesskey.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedDate(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldLastUsedDate, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedDateCleared() {
+		_spec.ClearField(bronzeawsiamaccesskey.FieldLastUsedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedService(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldLastUsedService, field.TypeString, value)
+	}
+	if _u.mutation.LastUsedServiceCleared() {
+		_spec.ClearField(bronzeawsiamaccesskey.FieldLastUsedService, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastUsedRegion(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldLastUsedRegion, field.TypeString, value)
+	}
+	if _u.mutation.LastUsedRegionCleared() {
+		_spec.ClearField(bronzeawsiamaccesskey.FieldLastUsedRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSIAMAccessKey
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsiamaccesskey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeAWSIAMAccessKeyUpdateOne is the builder for updating a single BronzeAWSIAMAccessKey entity.
+type BronzeAWSIAMAccessKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeAWSIAMAccessKeyMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) SetCollectedAt(v time.Time) *BronzeAWSIAMAccessKeyUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeAWSIAMAccessKeyUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) SetUserName(v string) *BronzeAWSIAMAccessKeyUpdateOne {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) SetNillableUserName(v *string) *BronzeAWSIAMAccessKeyUpdateOne {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) SetStatus(v string) *BronzeAWSIAMAccessKeyUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) SetNillableStatus(v *string) *BronzeAWSIAMAccessKeyUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) ClearStatus() *BronzeAWSIAMAccessKeyUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) SetCreateDate(v time.Time) *BronzeAWSIAMAccessKeyUpdateOne {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) SetNillableCreateDate(v *time.Time) *BronzeAWSIAMAccessKeyUpdateOne {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) ClearCreateDate() *BronzeAWSIAMAccessKeyUpdateOne {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetLastUsedDate sets the "last_used_date" field.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) SetLastUsedDate(v time.Time) *BronzeAWSIAMAccessKeyUpdateOne {
+	_u.mutation.SetLastUsedDate(v)
+	return _u
+}
+
+// SetNillableLastUsedDate sets the "last_used_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) SetNillableLastUsedDate(v *time.Time) *BronzeAWSIAMAccessKeyUpdateOne {
+	if v != nil {
+		_u.SetLastUsedDate(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedDate clears the value of the "last_used_date" field.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) ClearLastUsedDate() *BronzeAWSIAMAccessKeyUpdateOne {
+	_u.mutation.ClearLastUsedDate()
+	return _u
+}
+
+// SetLastUsedService sets the "last_used_service" field.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) SetLastUsedService(v string) *BronzeAWSIAMAccessKeyUpdateOne {
+	_u.mutation.SetLastUsedService(v)
+	return _u
+}
+
+// SetNillableLastUsedService sets the "last_used_service" field if the given value is not nil.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) SetNillableLastUsedService(v *string) *BronzeAWSIAMAccessKeyUpdateOne {
+	if v != nil {
+		_u.SetLastUsedService(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedService clears the value of the "last_used_service" field.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) ClearLastUsedService() *BronzeAWSIAMAccessKeyUpdateOne {
+	_u.mutation.ClearLastUsedService()
+	return _u
+}
+
+// SetLastUsedRegion sets the "last_used_region" field.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) SetLastUsedRegion(v string) *BronzeAWSIAMAccessKeyUpdateOne {
+	_u.mutation.SetLastUsedRegion(v)
+	return _u
+}
+
+// SetNillableLastUsedRegion sets the "last_used_region" field if the given value is not nil.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) SetNillableLastUsedRegion(v *string) *BronzeAWSIAMAccessKeyUpdateOne {
+	if v != nil {
+		_u.SetLastUsedRegion(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedRegion clears the value of the "last_used_region" field.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) ClearLastUsedRegion() *BronzeAWSIAMAccessKeyUpdateOne {
+	_u.mutation.ClearLastUsedRegion()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) SetAccountID(v string) *BronzeAWSIAMAccessKeyUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) SetNillableAccountID(v *string) *BronzeAWSIAMAccessKeyUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSIAMAccessKeyMutation object of the builder.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) Mutation() *BronzeAWSIAMAccessKeyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeAWSIAMAccessKeyUpdate builder.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) Where(ps ...predicate.BronzeAWSIAMAccessKey) *BronzeAWSIAMAccessKeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) Select(field string, fields ...string) *BronzeAWSIAMAccessKeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeAWSIAMAccessKey entity.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) Save(ctx context.Context) (*BronzeAWSIAMAccessKey, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) SaveX(ctx context.Context) *BronzeAWSIAMAccessKey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) check() error {
+	if v, ok := _u.mutation.UserName(); ok {
+		if err := bronzeawsiamaccesskey.UserNameValidator(v); err != nil {
+			return &ValidationError{Name: "user_name", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMAccessKey.user_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsiamaccesskey.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMAccessKey.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSIAMAccessKeyUpdateOne) sqlSave(ctx context.Context) (_node *BronzeAWSIAMAccessKey, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsiamaccesskey.Table, bronzeawsiamaccesskey.Columns, sqlgraph.NewFieldSpec(bronzeawsiamaccesskey.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`iam: missing "BronzeAWSIAMAccessKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawsiamaccesskey.FieldID)
+		for _, f := range fields {
+			if !bronzeawsiamaccesskey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("iam: invalid field %q for query", f)}
+			}
+			if f != bronzeawsiamaccesskey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldUserName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(bronzeawsiamaccesskey.FieldStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzeawsiamaccesskey.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedDate(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldLastUsedDate, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedDateCleared() {
+		_spec.ClearField(bronzeawsiamaccesskey.FieldLastUsedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedService(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldLastUsedService, field.TypeString, value)
+	}
+	if _u.mutation.LastUsedServiceCleared() {
+		_spec.ClearField(bronzeawsiamaccesskey.FieldLastUsedService, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastUsedRegion(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldLastUsedRegion, field.TypeString, value)
+	}
+	if _u.mutation.LastUsedRegionCleared() {
+		_spec.ClearField(bronzeawsiamaccesskey.FieldLastUsedRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsiamaccesskey.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSIAMAccessKey
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeAWSIAMAccessKey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsiamaccesskey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
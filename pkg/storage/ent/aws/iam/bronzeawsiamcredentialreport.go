@@ -0,0 +1,404 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamcredentialreport"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeAWSIAMCredentialReport is the model entity for the BronzeAWSIAMCredentialReport schema.
+type BronzeAWSIAMCredentialReport struct {
+	config `json:"-"`
+	// ID of the ent.
+	// User ARN, used as primary key
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// User name, or <root_account> for the account root user
+	User string `json:"user,omitempty"`
+	// UserCreationTime holds the value of the "user_creation_time" field.
+	UserCreationTime *time.Time `json:"user_creation_time,omitempty"`
+	// true, false or not_supported (root user)
+	PasswordEnabled string `json:"password_enabled,omitempty"`
+	// PasswordLastUsed holds the value of the "password_last_used" field.
+	PasswordLastUsed *time.Time `json:"password_last_used,omitempty"`
+	// PasswordLastChanged holds the value of the "password_last_changed" field.
+	PasswordLastChanged *time.Time `json:"password_last_changed,omitempty"`
+	// PasswordNextRotation holds the value of the "password_next_rotation" field.
+	PasswordNextRotation *time.Time `json:"password_next_rotation,omitempty"`
+	// MfaActive holds the value of the "mfa_active" field.
+	MfaActive bool `json:"mfa_active,omitempty"`
+	// AccessKey1Active holds the value of the "access_key_1_active" field.
+	AccessKey1Active bool `json:"access_key_1_active,omitempty"`
+	// AccessKey1LastRotated holds the value of the "access_key_1_last_rotated" field.
+	AccessKey1LastRotated *time.Time `json:"access_key_1_last_rotated,omitempty"`
+	// AccessKey1LastUsedDate holds the value of the "access_key_1_last_used_date" field.
+	AccessKey1LastUsedDate *time.Time `json:"access_key_1_last_used_date,omitempty"`
+	// AccessKey1LastUsedRegion holds the value of the "access_key_1_last_used_region" field.
+	AccessKey1LastUsedRegion string `json:"access_key_1_last_used_region,omitempty"`
+	// AccessKey1LastUsedService holds the value of the "access_key_1_last_used_service" field.
+	AccessKey1LastUsedService string `json:"access_key_1_last_used_service,omitempty"`
+	// AccessKey2Active holds the value of the "access_key_2_active" field.
+	AccessKey2Active bool `json:"access_key_2_active,omitempty"`
+	// AccessKey2LastRotated holds the value of the "access_key_2_last_rotated" field.
+	AccessKey2LastRotated *time.Time `json:"access_key_2_last_rotated,omitempty"`
+	// AccessKey2LastUsedDate holds the value of the "access_key_2_last_used_date" field.
+	AccessKey2LastUsedDate *time.Time `json:"access_key_2_last_used_date,omitempty"`
+	// AccessKey2LastUsedRegion holds the value of the "access_key_2_last_used_region" field.
+	AccessKey2LastUsedRegion string `json:"access_key_2_last_used_region,omitempty"`
+	// AccessKey2LastUsedService holds the value of the "access_key_2_last_used_service" field.
+	AccessKey2LastUsedService string `json:"access_key_2_last_used_service,omitempty"`
+	// Cert1Active holds the value of the "cert_1_active" field.
+	Cert1Active bool `json:"cert_1_active,omitempty"`
+	// Cert1LastRotated holds the value of the "cert_1_last_rotated" field.
+	Cert1LastRotated *time.Time `json:"cert_1_last_rotated,omitempty"`
+	// Cert2Active holds the value of the "cert_2_active" field.
+	Cert2Active bool `json:"cert_2_active,omitempty"`
+	// Cert2LastRotated holds the value of the "cert_2_last_rotated" field.
+	Cert2LastRotated *time.Time `json:"cert_2_last_rotated,omitempty"`
+	// When AWS generated the credential report
+	ReportGeneratedAt *time.Time `json:"report_generated_at,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID    string `json:"account_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeAWSIAMCredentialReport) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsiamcredentialreport.FieldMfaActive, bronzeawsiamcredentialreport.FieldAccessKey1Active, bronzeawsiamcredentialreport.FieldAccessKey2Active, bronzeawsiamcredentialreport.FieldCert1Active, bronzeawsiamcredentialreport.FieldCert2Active:
+			values[i] = new(sql.NullBool)
+		case bronzeawsiamcredentialreport.FieldID, bronzeawsiamcredentialreport.FieldUser, bronzeawsiamcredentialreport.FieldPasswordEnabled, bronzeawsiamcredentialreport.FieldAccessKey1LastUsedRegion, bronzeawsiamcredentialreport.FieldAccessKey1LastUsedService, bronzeawsiamcredentialreport.FieldAccessKey2LastUsedRegion, bronzeawsiamcredentialreport.FieldAccessKey2LastUsedService, bronzeawsiamcredentialreport.FieldAccountID:
+			values[i] = new(sql.NullString)
+		case bronzeawsiamcredentialreport.FieldCollectedAt, bronzeawsiamcredentialreport.FieldFirstCollectedAt, bronzeawsiamcredentialreport.FieldUserCreationTime, bronzeawsiamcredentialreport.FieldPasswordLastUsed, bronzeawsiamcredentialreport.FieldPasswordLastChanged, bronzeawsiamcredentialreport.FieldPasswordNextRotation, bronzeawsiamcredentialreport.FieldAccessKey1LastRotated, bronzeawsiamcredentialreport.FieldAccessKey1LastUsedDate, bronzeawsiamcredentialreport.FieldAccessKey2LastRotated, bronzeawsiamcredentialreport.FieldAccessKey2LastUsedDate, bronzeawsiamcredentialreport.FieldCert1LastRotated, bronzeawsiamcredentialreport.FieldCert2LastRotated, bronzeawsiamcredentialreport.FieldReportGeneratedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeAWSIAMCredentialReport fields.
+func (_m *BronzeAWSIAMCredentialReport) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsiamcredentialreport.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzeawsiamcredentialreport.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzeawsiamcredentialreport.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzeawsiamcredentialreport.FieldUser:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user", values[i])
+			} else if value.Valid {
+				_m.User = value.String
+			}
+		case bronzeawsiamcredentialreport.FieldUserCreationTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field user_creation_time", values[i])
+			} else if value.Valid {
+				_m.UserCreationTime = new(time.Time)
+				*_m.UserCreationTime = value.Time
+			}
+		case bronzeawsiamcredentialreport.FieldPasswordEnabled:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password_enabled", values[i])
+			} else if value.Valid {
+				_m.PasswordEnabled = value.String
+			}
+		case bronzeawsiamcredentialreport.FieldPasswordLastUsed:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field password_last_used", values[i])
+			} else if value.Valid {
+				_m.PasswordLastUsed = new(time.Time)
+				*_m.PasswordLastUsed = value.Time
+			}
+		case bronzeawsiamcredentialreport.FieldPasswordLastChanged:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field password_last_changed", values[i])
+			} else if value.Valid {
+				_m.PasswordLastChanged = new(time.Time)
+				*_m.PasswordLastChanged = value.Time
+			}
+		case bronzeawsiamcredentialreport.FieldPasswordNextRotation:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field password_next_rotation", values[i])
+			} else if value.Valid {
+				_m.PasswordNextRotation = new(time.Time)
+				*_m.PasswordNextRotation = value.Time
+			}
+		case bronzeawsiamcredentialreport.FieldMfaActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_active", values[i])
+			} else if value.Valid {
+				_m.MfaActive = value.Bool
+			}
+		case bronzeawsiamcredentialreport.FieldAccessKey1Active:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_1_active", values[i])
+			} else if value.Valid {
+				_m.AccessKey1Active = value.Bool
+			}
+		case bronzeawsiamcredentialreport.FieldAccessKey1LastRotated:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_1_last_rotated", values[i])
+			} else if value.Valid {
+				_m.AccessKey1LastRotated = new(time.Time)
+				*_m.AccessKey1LastRotated = value.Time
+			}
+		case bronzeawsiamcredentialreport.FieldAccessKey1LastUsedDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_1_last_used_date", values[i])
+			} else if value.Valid {
+				_m.AccessKey1LastUsedDate = new(time.Time)
+				*_m.AccessKey1LastUsedDate = value.Time
+			}
+		case bronzeawsiamcredentialreport.FieldAccessKey1LastUsedRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_1_last_used_region", values[i])
+			} else if value.Valid {
+				_m.AccessKey1LastUsedRegion = value.String
+			}
+		case bronzeawsiamcredentialreport.FieldAccessKey1LastUsedService:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_1_last_used_service", values[i])
+			} else if value.Valid {
+				_m.AccessKey1LastUsedService = value.String
+			}
+		case bronzeawsiamcredentialreport.FieldAccessKey2Active:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_2_active", values[i])
+			} else if value.Valid {
+				_m.AccessKey2Active = value.Bool
+			}
+		case bronzeawsiamcredentialreport.FieldAccessKey2LastRotated:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_2_last_rotated", values[i])
+			} else if value.Valid {
+				_m.AccessKey2LastRotated = new(time.Time)
+				*_m.AccessKey2LastRotated = value.Time
+			}
+		case bronzeawsiamcredentialreport.FieldAccessKey2LastUsedDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_2_last_used_date", values[i])
+			} else if value.Valid {
+				_m.AccessKey2LastUsedDate = new(time.Time)
+				*_m.AccessKey2LastUsedDate = value.Time
+			}
+		case bronzeawsiamcredentialreport.FieldAccessKey2LastUsedRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_2_last_used_region", values[i])
+			} else if value.Valid {
+				_m.AccessKey2LastUsedRegion = value.String
+			}
+		case bronzeawsiamcredentialreport.FieldAccessKey2LastUsedService:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_2_last_used_service", values[i])
+			} else if value.Valid {
+				_m.AccessKey2LastUsedService = value.String
+			}
+		case bronzeawsiamcredentialreport.FieldCert1Active:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cert_1_active", values[i])
+			} else if value.Valid {
+				_m.Cert1Active = value.Bool
+			}
+		case bronzeawsiamcredentialreport.FieldCert1LastRotated:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cert_1_last_rotated", values[i])
+			} else if value.Valid {
+				_m.Cert1LastRotated = new(time.Time)
+				*_m.Cert1LastRotated = value.Time
+			}
+		case bronzeawsiamcredentialreport.FieldCert2Active:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cert_2_active", values[i])
+			} else if value.Valid {
+				_m.Cert2Active = value.Bool
+			}
+		case bronzeawsiamcredentialreport.FieldCert2LastRotated:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cert_2_last_rotated", values[i])
+			} else if value.Valid {
+				_m.Cert2LastRotated = new(time.Time)
+				*_m.Cert2LastRotated = value.Time
+			}
+		case bronzeawsiamcredentialreport.FieldReportGeneratedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field report_generated_at", values[i])
+			} else if value.Valid {
+				_m.ReportGeneratedAt = new(time.Time)
+				*_m.ReportGeneratedAt = value.Time
+			}
+		case bronzeawsiamcredentialreport.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeAWSIAMCredentialReport.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeAWSIAMCredentialReport) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeAWSIAMCredentialReport.
+// Note that you need to call BronzeAWSIAMCredentialReport.Unwrap() before calling this method if this BronzeAWSIAMCredentialReport
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeAWSIAMCredentialReport) Update() *BronzeAWSIAMCredentialReportUpdateOne {
+	return NewBronzeAWSIAMCredentialReportClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeAWSIAMCredentialReport entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeAWSIAMCredentialReport) Unwrap() *BronzeAWSIAMCredentialReport {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("iam: BronzeAWSIAMCredentialReport is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeAWSIAMCredentialReport) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeAWSIAMCredentialReport(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user=")
+	builder.WriteString(_m.User)
+	builder.WriteString(", ")
+	if v := _m.UserCreationTime; v != nil {
+		builder.WriteString("user_creation_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("password_enabled=")
+	builder.WriteString(_m.PasswordEnabled)
+	builder.WriteString(", ")
+	if v := _m.PasswordLastUsed; v != nil {
+		builder.WriteString("password_last_used=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.PasswordLastChanged; v != nil {
+		builder.WriteString("password_last_changed=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.PasswordNextRotation; v != nil {
+		builder.WriteString("password_next_rotation=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("mfa_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MfaActive))
+	builder.WriteString(", ")
+	builder.WriteString("access_key_1_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccessKey1Active))
+	builder.WriteString(", ")
+	if v := _m.AccessKey1LastRotated; v != nil {
+		builder.WriteString("access_key_1_last_rotated=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.AccessKey1LastUsedDate; v != nil {
+		builder.WriteString("access_key_1_last_used_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("access_key_1_last_used_region=")
+	builder.WriteString(_m.AccessKey1LastUsedRegion)
+	builder.WriteString(", ")
+	builder.WriteString("access_key_1_last_used_service=")
+	builder.WriteString(_m.AccessKey1LastUsedService)
+	builder.WriteString(", ")
+	builder.WriteString("access_key_2_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccessKey2Active))
+	builder.WriteString(", ")
+	if v := _m.AccessKey2LastRotated; v != nil {
+		builder.WriteString("access_key_2_last_rotated=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.AccessKey2LastUsedDate; v != nil {
+		builder.WriteString("access_key_2_last_used_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("access_key_2_last_used_region=")
+	builder.WriteString(_m.AccessKey2LastUsedRegion)
+	builder.WriteString(", ")
+	builder.WriteString("access_key_2_last_used_service=")
+	builder.WriteString(_m.AccessKey2LastUsedService)
+	builder.WriteString(", ")
+	builder.WriteString("cert_1_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Cert1Active))
+	builder.WriteString(", ")
+	if v := _m.Cert1LastRotated; v != nil {
+		builder.WriteString("cert_1_last_rotated=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cert_2_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Cert2Active))
+	builder.WriteString(", ")
+	if v := _m.Cert2LastRotated; v != nil {
+		builder.WriteString("cert_2_last_rotated=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReportGeneratedAt; v != nil {
+		builder.WriteString("report_generated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeAWSIAMCredentialReports is a parsable slice of BronzeAWSIAMCredentialReport.
+type BronzeAWSIAMCredentialReports []*BronzeAWSIAMCredentialReport
@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawsiamcredentialreport
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzeawsiamcredentialreport type in the database.
+	Label = "bronze_awsiam_credential_report"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldUser holds the string denoting the user field in the database.
+	FieldUser = "user"
+	// FieldUserCreationTime holds the string denoting the user_creation_time field in the database.
+	FieldUserCreationTime = "user_creation_time"
+	// FieldPasswordEnabled holds the string denoting the password_enabled field in the database.
+	FieldPasswordEnabled = "password_enabled"
+	// FieldPasswordLastUsed holds the string denoting the password_last_used field in the database.
+	FieldPasswordLastUsed = "password_last_used"
+	// FieldPasswordLastChanged holds the string denoting the password_last_changed field in the database.
+	FieldPasswordLastChanged = "password_last_changed"
+	// FieldPasswordNextRotation holds the string denoting the password_next_rotation field in the database.
+	FieldPasswordNextRotation = "password_next_rotation"
+	// FieldMfaActive holds the string denoting the mfa_active field in the database.
+	FieldMfaActive = "mfa_active"
+	// FieldAccessKey1Active holds the string denoting the access_key_1_active field in the database.
+	FieldAccessKey1Active = "access_key_1_active"
+	// FieldAccessKey1LastRotated holds the string denoting the access_key_1_last_rotated field in the database.
+	FieldAccessKey1LastRotated = "access_key_1_last_rotated"
+	// FieldAccessKey1LastUsedDate holds the string denoting the access_key_1_last_used_date field in the database.
+	FieldAccessKey1LastUsedDate = "access_key_1_last_used_date"
+	// FieldAccessKey1LastUsedRegion holds the string denoting the access_key_1_last_used_region field in the database.
+	FieldAccessKey1LastUsedRegion = "access_key_1_last_used_region"
+	// FieldAccessKey1LastUsedService holds the string denoting the access_key_1_last_used_service field in the database.
+	FieldAccessKey1LastUsedService = "access_key_1_last_used_service"
+	// FieldAccessKey2Active holds the string denoting the access_key_2_active field in the database.
+	FieldAccessKey2Active = "access_key_2_active"
+	// FieldAccessKey2LastRotated holds the string denoting the access_key_2_last_rotated field in the database.
+	FieldAccessKey2LastRotated = "access_key_2_last_rotated"
+	// FieldAccessKey2LastUsedDate holds the string denoting the access_key_2_last_used_date field in the database.
+	FieldAccessKey2LastUsedDate = "access_key_2_last_used_date"
+	// FieldAccessKey2LastUsedRegion holds the string denoting the access_key_2_last_used_region field in the database.
+	FieldAccessKey2LastUsedRegion = "access_key_2_last_used_region"
+	// FieldAccessKey2LastUsedService holds the string denoting the access_key_2_last_used_service field in the database.
+	FieldAccessKey2LastUsedService = "access_key_2_last_used_service"
+	// FieldCert1Active holds the string denoting the cert_1_active field in the database.
+	FieldCert1Active = "cert_1_active"
+	// FieldCert1LastRotated holds the string denoting the cert_1_last_rotated field in the database.
+	FieldCert1LastRotated = "cert_1_last_rotated"
+	// FieldCert2Active holds the string denoting the cert_2_active field in the database.
+	FieldCert2Active = "cert_2_active"
+	// FieldCert2LastRotated holds the string denoting the cert_2_last_rotated field in the database.
+	FieldCert2LastRotated = "cert_2_last_rotated"
+	// FieldReportGeneratedAt holds the string denoting the report_generated_at field in the database.
+	FieldReportGeneratedAt = "report_generated_at"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// Table holds the table name of the bronzeawsiamcredentialreport in the database.
+	Table = "aws_iam_credential_reports"
+)
+
+// Columns holds all SQL columns for bronzeawsiamcredentialreport fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldUser,
+	FieldUserCreationTime,
+	FieldPasswordEnabled,
+	FieldPasswordLastUsed,
+	FieldPasswordLastChanged,
+	FieldPasswordNextRotation,
+	FieldMfaActive,
+	FieldAccessKey1Active,
+	FieldAccessKey1LastRotated,
+	FieldAccessKey1LastUsedDate,
+	FieldAccessKey1LastUsedRegion,
+	FieldAccessKey1LastUsedService,
+	FieldAccessKey2Active,
+	FieldAccessKey2LastRotated,
+	FieldAccessKey2LastUsedDate,
+	FieldAccessKey2LastUsedRegion,
+	FieldAccessKey2LastUsedService,
+	FieldCert1Active,
+	FieldCert1LastRotated,
+	FieldCert2Active,
+	FieldCert2LastRotated,
+	FieldReportGeneratedAt,
+	FieldAccountID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserValidator is a validator for the "user" field. It is called by the builders before save.
+	UserValidator func(string) error
+	// DefaultMfaActive holds the default value on creation for the "mfa_active" field.
+	DefaultMfaActive bool
+	// DefaultAccessKey1Active holds the default value on creation for the "access_key_1_active" field.
+	DefaultAccessKey1Active bool
+	// DefaultAccessKey2Active holds the default value on creation for the "access_key_2_active" field.
+	DefaultAccessKey2Active bool
+	// DefaultCert1Active holds the default value on creation for the "cert_1_active" field.
+	DefaultCert1Active bool
+	// DefaultCert2Active holds the default value on creation for the "cert_2_active" field.
+	DefaultCert2Active bool
+	// AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	AccountIDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BronzeAWSIAMCredentialReport queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByUser orders the results by the user field.
+func ByUser(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUser, opts...).ToFunc()
+}
+
+// ByUserCreationTime orders the results by the user_creation_time field.
+func ByUserCreationTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserCreationTime, opts...).ToFunc()
+}
+
+// ByPasswordEnabled orders the results by the password_enabled field.
+func ByPasswordEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordEnabled, opts...).ToFunc()
+}
+
+// ByPasswordLastUsed orders the results by the password_last_used field.
+func ByPasswordLastUsed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordLastUsed, opts...).ToFunc()
+}
+
+// ByPasswordLastChanged orders the results by the password_last_changed field.
+func ByPasswordLastChanged(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordLastChanged, opts...).ToFunc()
+}
+
+// ByPasswordNextRotation orders the results by the password_next_rotation field.
+func ByPasswordNextRotation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordNextRotation, opts...).ToFunc()
+}
+
+// ByMfaActive orders the results by the mfa_active field.
+func ByMfaActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMfaActive, opts...).ToFunc()
+}
+
+// ByAccessKey1Active orders the results by the access_key_1_active field.
+func ByAccessKey1Active(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessKey1Active, opts...).ToFunc()
+}
+
+// ByAccessKey1LastRotated orders the results by the access_key_1_last_rotated field.
+func ByAccessKey1LastRotated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessKey1LastRotated, opts...).ToFunc()
+}
+
+// ByAccessKey1LastUsedDate orders the results by the access_key_1_last_used_date field.
+func ByAccessKey1LastUsedDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessKey1LastUsedDate, opts...).ToFunc()
+}
+
+// ByAccessKey1LastUsedRegion orders the results by the access_key_1_last_used_region field.
+func ByAccessKey1LastUsedRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessKey1LastUsedRegion, opts...).ToFunc()
+}
+
+// ByAccessKey1LastUsedService orders the results by the access_key_1_last_used_service field.
+func ByAccessKey1LastUsedService(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessKey1LastUsedService, opts...).ToFunc()
+}
+
+// ByAccessKey2Active orders the results by the access_key_2_active field.
+func ByAccessKey2Active(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessKey2Active, opts...).ToFunc()
+}
+
+// ByAccessKey2LastRotated orders the results by the access_key_2_last_rotated field.
+func ByAccessKey2LastRotated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessKey2LastRotated, opts...).ToFunc()
+}
+
+// ByAccessKey2LastUsedDate orders the results by the access_key_2_last_used_date field.
+func ByAccessKey2LastUsedDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessKey2LastUsedDate, opts...).ToFunc()
+}
+
+// ByAccessKey2LastUsedRegion orders the results by the access_key_2_last_used_region field.
+func ByAccessKey2LastUsedRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessKey2LastUsedRegion, opts...).ToFunc()
+}
+
+// ByAccessKey2LastUsedService orders the results by the access_key_2_last_used_service field.
+func ByAccessKey2LastUsedService(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessKey2LastUsedService, opts...).ToFunc()
+}
+
+// ByCert1Active orders the results by the cert_1_active field.
+func ByCert1Active(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCert1Active, opts...).ToFunc()
+}
+
+// ByCert1LastRotated orders the results by the cert_1_last_rotated field.
+func ByCert1LastRotated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCert1LastRotated, opts...).ToFunc()
+}
+
+// ByCert2Active orders the results by the cert_2_active field.
+func ByCert2Active(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCert2Active, opts...).ToFunc()
+}
+
+// ByCert2LastRotated orders the results by the cert_2_last_rotated field.
+func ByCert2LastRotated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCert2LastRotated, opts...).ToFunc()
+}
+
+// ByReportGeneratedAt orders the results by the report_generated_at field.
+func ByReportGeneratedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReportGeneratedAt, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
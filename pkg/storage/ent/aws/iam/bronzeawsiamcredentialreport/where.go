@@ -0,0 +1,1390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawsiamcredentialreport
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// User applies equality check predicate on the "user" field. It's identical to UserEQ.
+func User(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldUser, v))
+}
+
+// UserCreationTime applies equality check predicate on the "user_creation_time" field. It's identical to UserCreationTimeEQ.
+func UserCreationTime(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldUserCreationTime, v))
+}
+
+// PasswordEnabled applies equality check predicate on the "password_enabled" field. It's identical to PasswordEnabledEQ.
+func PasswordEnabled(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldPasswordEnabled, v))
+}
+
+// PasswordLastUsed applies equality check predicate on the "password_last_used" field. It's identical to PasswordLastUsedEQ.
+func PasswordLastUsed(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldPasswordLastUsed, v))
+}
+
+// PasswordLastChanged applies equality check predicate on the "password_last_changed" field. It's identical to PasswordLastChangedEQ.
+func PasswordLastChanged(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldPasswordLastChanged, v))
+}
+
+// PasswordNextRotation applies equality check predicate on the "password_next_rotation" field. It's identical to PasswordNextRotationEQ.
+func PasswordNextRotation(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldPasswordNextRotation, v))
+}
+
+// MfaActive applies equality check predicate on the "mfa_active" field. It's identical to MfaActiveEQ.
+func MfaActive(v bool) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldMfaActive, v))
+}
+
+// AccessKey1Active applies equality check predicate on the "access_key_1_active" field. It's identical to AccessKey1ActiveEQ.
+func AccessKey1Active(v bool) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1Active, v))
+}
+
+// AccessKey1LastRotated applies equality check predicate on the "access_key_1_last_rotated" field. It's identical to AccessKey1LastRotatedEQ.
+func AccessKey1LastRotated(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1LastRotated, v))
+}
+
+// AccessKey1LastUsedDate applies equality check predicate on the "access_key_1_last_used_date" field. It's identical to AccessKey1LastUsedDateEQ.
+func AccessKey1LastUsedDate(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1LastUsedDate, v))
+}
+
+// AccessKey1LastUsedRegion applies equality check predicate on the "access_key_1_last_used_region" field. It's identical to AccessKey1LastUsedRegionEQ.
+func AccessKey1LastUsedRegion(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedService applies equality check predicate on the "access_key_1_last_used_service" field. It's identical to AccessKey1LastUsedServiceEQ.
+func AccessKey1LastUsedService(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey2Active applies equality check predicate on the "access_key_2_active" field. It's identical to AccessKey2ActiveEQ.
+func AccessKey2Active(v bool) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2Active, v))
+}
+
+// AccessKey2LastRotated applies equality check predicate on the "access_key_2_last_rotated" field. It's identical to AccessKey2LastRotatedEQ.
+func AccessKey2LastRotated(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2LastRotated, v))
+}
+
+// AccessKey2LastUsedDate applies equality check predicate on the "access_key_2_last_used_date" field. It's identical to AccessKey2LastUsedDateEQ.
+func AccessKey2LastUsedDate(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2LastUsedDate, v))
+}
+
+// AccessKey2LastUsedRegion applies equality check predicate on the "access_key_2_last_used_region" field. It's identical to AccessKey2LastUsedRegionEQ.
+func AccessKey2LastUsedRegion(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedService applies equality check predicate on the "access_key_2_last_used_service" field. It's identical to AccessKey2LastUsedServiceEQ.
+func AccessKey2LastUsedService(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2LastUsedService, v))
+}
+
+// Cert1Active applies equality check predicate on the "cert_1_active" field. It's identical to Cert1ActiveEQ.
+func Cert1Active(v bool) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldCert1Active, v))
+}
+
+// Cert1LastRotated applies equality check predicate on the "cert_1_last_rotated" field. It's identical to Cert1LastRotatedEQ.
+func Cert1LastRotated(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldCert1LastRotated, v))
+}
+
+// Cert2Active applies equality check predicate on the "cert_2_active" field. It's identical to Cert2ActiveEQ.
+func Cert2Active(v bool) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldCert2Active, v))
+}
+
+// Cert2LastRotated applies equality check predicate on the "cert_2_last_rotated" field. It's identical to Cert2LastRotatedEQ.
+func Cert2LastRotated(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldCert2LastRotated, v))
+}
+
+// ReportGeneratedAt applies equality check predicate on the "report_generated_at" field. It's identical to ReportGeneratedAtEQ.
+func ReportGeneratedAt(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldReportGeneratedAt, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccountID, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// UserEQ applies the EQ predicate on the "user" field.
+func UserEQ(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldUser, v))
+}
+
+// UserNEQ applies the NEQ predicate on the "user" field.
+func UserNEQ(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldUser, v))
+}
+
+// UserIn applies the In predicate on the "user" field.
+func UserIn(vs ...string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldUser, vs...))
+}
+
+// UserNotIn applies the NotIn predicate on the "user" field.
+func UserNotIn(vs ...string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldUser, vs...))
+}
+
+// UserGT applies the GT predicate on the "user" field.
+func UserGT(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldUser, v))
+}
+
+// UserGTE applies the GTE predicate on the "user" field.
+func UserGTE(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldUser, v))
+}
+
+// UserLT applies the LT predicate on the "user" field.
+func UserLT(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldUser, v))
+}
+
+// UserLTE applies the LTE predicate on the "user" field.
+func UserLTE(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldUser, v))
+}
+
+// UserContains applies the Contains predicate on the "user" field.
+func UserContains(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldContains(FieldUser, v))
+}
+
+// UserHasPrefix applies the HasPrefix predicate on the "user" field.
+func UserHasPrefix(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldHasPrefix(FieldUser, v))
+}
+
+// UserHasSuffix applies the HasSuffix predicate on the "user" field.
+func UserHasSuffix(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldHasSuffix(FieldUser, v))
+}
+
+// UserEqualFold applies the EqualFold predicate on the "user" field.
+func UserEqualFold(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEqualFold(FieldUser, v))
+}
+
+// UserContainsFold applies the ContainsFold predicate on the "user" field.
+func UserContainsFold(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldContainsFold(FieldUser, v))
+}
+
+// UserCreationTimeEQ applies the EQ predicate on the "user_creation_time" field.
+func UserCreationTimeEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldUserCreationTime, v))
+}
+
+// UserCreationTimeNEQ applies the NEQ predicate on the "user_creation_time" field.
+func UserCreationTimeNEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldUserCreationTime, v))
+}
+
+// UserCreationTimeIn applies the In predicate on the "user_creation_time" field.
+func UserCreationTimeIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldUserCreationTime, vs...))
+}
+
+// UserCreationTimeNotIn applies the NotIn predicate on the "user_creation_time" field.
+func UserCreationTimeNotIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldUserCreationTime, vs...))
+}
+
+// UserCreationTimeGT applies the GT predicate on the "user_creation_time" field.
+func UserCreationTimeGT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldUserCreationTime, v))
+}
+
+// UserCreationTimeGTE applies the GTE predicate on the "user_creation_time" field.
+func UserCreationTimeGTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldUserCreationTime, v))
+}
+
+// UserCreationTimeLT applies the LT predicate on the "user_creation_time" field.
+func UserCreationTimeLT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldUserCreationTime, v))
+}
+
+// UserCreationTimeLTE applies the LTE predicate on the "user_creation_time" field.
+func UserCreationTimeLTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldUserCreationTime, v))
+}
+
+// UserCreationTimeIsNil applies the IsNil predicate on the "user_creation_time" field.
+func UserCreationTimeIsNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIsNull(FieldUserCreationTime))
+}
+
+// UserCreationTimeNotNil applies the NotNil predicate on the "user_creation_time" field.
+func UserCreationTimeNotNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotNull(FieldUserCreationTime))
+}
+
+// PasswordEnabledEQ applies the EQ predicate on the "password_enabled" field.
+func PasswordEnabledEQ(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledNEQ applies the NEQ predicate on the "password_enabled" field.
+func PasswordEnabledNEQ(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledIn applies the In predicate on the "password_enabled" field.
+func PasswordEnabledIn(vs ...string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldPasswordEnabled, vs...))
+}
+
+// PasswordEnabledNotIn applies the NotIn predicate on the "password_enabled" field.
+func PasswordEnabledNotIn(vs ...string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldPasswordEnabled, vs...))
+}
+
+// PasswordEnabledGT applies the GT predicate on the "password_enabled" field.
+func PasswordEnabledGT(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledGTE applies the GTE predicate on the "password_enabled" field.
+func PasswordEnabledGTE(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledLT applies the LT predicate on the "password_enabled" field.
+func PasswordEnabledLT(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledLTE applies the LTE predicate on the "password_enabled" field.
+func PasswordEnabledLTE(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledContains applies the Contains predicate on the "password_enabled" field.
+func PasswordEnabledContains(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldContains(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledHasPrefix applies the HasPrefix predicate on the "password_enabled" field.
+func PasswordEnabledHasPrefix(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldHasPrefix(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledHasSuffix applies the HasSuffix predicate on the "password_enabled" field.
+func PasswordEnabledHasSuffix(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldHasSuffix(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledIsNil applies the IsNil predicate on the "password_enabled" field.
+func PasswordEnabledIsNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIsNull(FieldPasswordEnabled))
+}
+
+// PasswordEnabledNotNil applies the NotNil predicate on the "password_enabled" field.
+func PasswordEnabledNotNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotNull(FieldPasswordEnabled))
+}
+
+// PasswordEnabledEqualFold applies the EqualFold predicate on the "password_enabled" field.
+func PasswordEnabledEqualFold(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEqualFold(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledContainsFold applies the ContainsFold predicate on the "password_enabled" field.
+func PasswordEnabledContainsFold(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldContainsFold(FieldPasswordEnabled, v))
+}
+
+// PasswordLastUsedEQ applies the EQ predicate on the "password_last_used" field.
+func PasswordLastUsedEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldPasswordLastUsed, v))
+}
+
+// PasswordLastUsedNEQ applies the NEQ predicate on the "password_last_used" field.
+func PasswordLastUsedNEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldPasswordLastUsed, v))
+}
+
+// PasswordLastUsedIn applies the In predicate on the "password_last_used" field.
+func PasswordLastUsedIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldPasswordLastUsed, vs...))
+}
+
+// PasswordLastUsedNotIn applies the NotIn predicate on the "password_last_used" field.
+func PasswordLastUsedNotIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldPasswordLastUsed, vs...))
+}
+
+// PasswordLastUsedGT applies the GT predicate on the "password_last_used" field.
+func PasswordLastUsedGT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldPasswordLastUsed, v))
+}
+
+// PasswordLastUsedGTE applies the GTE predicate on the "password_last_used" field.
+func PasswordLastUsedGTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldPasswordLastUsed, v))
+}
+
+// PasswordLastUsedLT applies the LT predicate on the "password_last_used" field.
+func PasswordLastUsedLT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldPasswordLastUsed, v))
+}
+
+// PasswordLastUsedLTE applies the LTE predicate on the "password_last_used" field.
+func PasswordLastUsedLTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldPasswordLastUsed, v))
+}
+
+// PasswordLastUsedIsNil applies the IsNil predicate on the "password_last_used" field.
+func PasswordLastUsedIsNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIsNull(FieldPasswordLastUsed))
+}
+
+// PasswordLastUsedNotNil applies the NotNil predicate on the "password_last_used" field.
+func PasswordLastUsedNotNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotNull(FieldPasswordLastUsed))
+}
+
+// PasswordLastChangedEQ applies the EQ predicate on the "password_last_changed" field.
+func PasswordLastChangedEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldPasswordLastChanged, v))
+}
+
+// PasswordLastChangedNEQ applies the NEQ predicate on the "password_last_changed" field.
+func PasswordLastChangedNEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldPasswordLastChanged, v))
+}
+
+// PasswordLastChangedIn applies the In predicate on the "password_last_changed" field.
+func PasswordLastChangedIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldPasswordLastChanged, vs...))
+}
+
+// PasswordLastChangedNotIn applies the NotIn predicate on the "password_last_changed" field.
+func PasswordLastChangedNotIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldPasswordLastChanged, vs...))
+}
+
+// PasswordLastChangedGT applies the GT predicate on the "password_last_changed" field.
+func PasswordLastChangedGT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldPasswordLastChanged, v))
+}
+
+// PasswordLastChangedGTE applies the GTE predicate on the "password_last_changed" field.
+func PasswordLastChangedGTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldPasswordLastChanged, v))
+}
+
+// PasswordLastChangedLT applies the LT predicate on the "password_last_changed" field.
+func PasswordLastChangedLT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldPasswordLastChanged, v))
+}
+
+// PasswordLastChangedLTE applies the LTE predicate on the "password_last_changed" field.
+func PasswordLastChangedLTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldPasswordLastChanged, v))
+}
+
+// PasswordLastChangedIsNil applies the IsNil predicate on the "password_last_changed" field.
+func PasswordLastChangedIsNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIsNull(FieldPasswordLastChanged))
+}
+
+// PasswordLastChangedNotNil applies the NotNil predicate on the "password_last_changed" field.
+func PasswordLastChangedNotNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotNull(FieldPasswordLastChanged))
+}
+
+// PasswordNextRotationEQ applies the EQ predicate on the "password_next_rotation" field.
+func PasswordNextRotationEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldPasswordNextRotation, v))
+}
+
+// PasswordNextRotationNEQ applies the NEQ predicate on the "password_next_rotation" field.
+func PasswordNextRotationNEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldPasswordNextRotation, v))
+}
+
+// PasswordNextRotationIn applies the In predicate on the "password_next_rotation" field.
+func PasswordNextRotationIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldPasswordNextRotation, vs...))
+}
+
+// PasswordNextRotationNotIn applies the NotIn predicate on the "password_next_rotation" field.
+func PasswordNextRotationNotIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldPasswordNextRotation, vs...))
+}
+
+// PasswordNextRotationGT applies the GT predicate on the "password_next_rotation" field.
+func PasswordNextRotationGT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldPasswordNextRotation, v))
+}
+
+// PasswordNextRotationGTE applies the GTE predicate on the "password_next_rotation" field.
+func PasswordNextRotationGTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldPasswordNextRotation, v))
+}
+
+// PasswordNextRotationLT applies the LT predicate on the "password_next_rotation" field.
+func PasswordNextRotationLT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldPasswordNextRotation, v))
+}
+
+// PasswordNextRotationLTE applies the LTE predicate on the "password_next_rotation" field.
+func PasswordNextRotationLTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldPasswordNextRotation, v))
+}
+
+// PasswordNextRotationIsNil applies the IsNil predicate on the "password_next_rotation" field.
+func PasswordNextRotationIsNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIsNull(FieldPasswordNextRotation))
+}
+
+// PasswordNextRotationNotNil applies the NotNil predicate on the "password_next_rotation" field.
+func PasswordNextRotationNotNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotNull(FieldPasswordNextRotation))
+}
+
+// MfaActiveEQ applies the EQ predicate on the "mfa_active" field.
+func MfaActiveEQ(v bool) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldMfaActive, v))
+}
+
+// MfaActiveNEQ applies the NEQ predicate on the "mfa_active" field.
+func MfaActiveNEQ(v bool) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldMfaActive, v))
+}
+
+// AccessKey1ActiveEQ applies the EQ predicate on the "access_key_1_active" field.
+func AccessKey1ActiveEQ(v bool) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1Active, v))
+}
+
+// AccessKey1ActiveNEQ applies the NEQ predicate on the "access_key_1_active" field.
+func AccessKey1ActiveNEQ(v bool) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey1Active, v))
+}
+
+// AccessKey1LastRotatedEQ applies the EQ predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1LastRotated, v))
+}
+
+// AccessKey1LastRotatedNEQ applies the NEQ predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedNEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey1LastRotated, v))
+}
+
+// AccessKey1LastRotatedIn applies the In predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldAccessKey1LastRotated, vs...))
+}
+
+// AccessKey1LastRotatedNotIn applies the NotIn predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedNotIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldAccessKey1LastRotated, vs...))
+}
+
+// AccessKey1LastRotatedGT applies the GT predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedGT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldAccessKey1LastRotated, v))
+}
+
+// AccessKey1LastRotatedGTE applies the GTE predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedGTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldAccessKey1LastRotated, v))
+}
+
+// AccessKey1LastRotatedLT applies the LT predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedLT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldAccessKey1LastRotated, v))
+}
+
+// AccessKey1LastRotatedLTE applies the LTE predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedLTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldAccessKey1LastRotated, v))
+}
+
+// AccessKey1LastRotatedIsNil applies the IsNil predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedIsNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIsNull(FieldAccessKey1LastRotated))
+}
+
+// AccessKey1LastRotatedNotNil applies the NotNil predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedNotNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotNull(FieldAccessKey1LastRotated))
+}
+
+// AccessKey1LastUsedDateEQ applies the EQ predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1LastUsedDate, v))
+}
+
+// AccessKey1LastUsedDateNEQ applies the NEQ predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateNEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey1LastUsedDate, v))
+}
+
+// AccessKey1LastUsedDateIn applies the In predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldAccessKey1LastUsedDate, vs...))
+}
+
+// AccessKey1LastUsedDateNotIn applies the NotIn predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateNotIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldAccessKey1LastUsedDate, vs...))
+}
+
+// AccessKey1LastUsedDateGT applies the GT predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateGT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldAccessKey1LastUsedDate, v))
+}
+
+// AccessKey1LastUsedDateGTE applies the GTE predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateGTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldAccessKey1LastUsedDate, v))
+}
+
+// AccessKey1LastUsedDateLT applies the LT predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateLT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldAccessKey1LastUsedDate, v))
+}
+
+// AccessKey1LastUsedDateLTE applies the LTE predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateLTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldAccessKey1LastUsedDate, v))
+}
+
+// AccessKey1LastUsedDateIsNil applies the IsNil predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateIsNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIsNull(FieldAccessKey1LastUsedDate))
+}
+
+// AccessKey1LastUsedDateNotNil applies the NotNil predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateNotNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotNull(FieldAccessKey1LastUsedDate))
+}
+
+// AccessKey1LastUsedRegionEQ applies the EQ predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionEQ(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionNEQ applies the NEQ predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionNEQ(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionIn applies the In predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionIn(vs ...string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldAccessKey1LastUsedRegion, vs...))
+}
+
+// AccessKey1LastUsedRegionNotIn applies the NotIn predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionNotIn(vs ...string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldAccessKey1LastUsedRegion, vs...))
+}
+
+// AccessKey1LastUsedRegionGT applies the GT predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionGT(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionGTE applies the GTE predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionGTE(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionLT applies the LT predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionLT(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionLTE applies the LTE predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionLTE(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionContains applies the Contains predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionContains(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldContains(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionHasPrefix applies the HasPrefix predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionHasPrefix(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldHasPrefix(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionHasSuffix applies the HasSuffix predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionHasSuffix(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldHasSuffix(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionIsNil applies the IsNil predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionIsNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIsNull(FieldAccessKey1LastUsedRegion))
+}
+
+// AccessKey1LastUsedRegionNotNil applies the NotNil predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionNotNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotNull(FieldAccessKey1LastUsedRegion))
+}
+
+// AccessKey1LastUsedRegionEqualFold applies the EqualFold predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionEqualFold(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEqualFold(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionContainsFold applies the ContainsFold predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionContainsFold(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldContainsFold(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedServiceEQ applies the EQ predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceEQ(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceNEQ applies the NEQ predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceNEQ(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceIn applies the In predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceIn(vs ...string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldAccessKey1LastUsedService, vs...))
+}
+
+// AccessKey1LastUsedServiceNotIn applies the NotIn predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceNotIn(vs ...string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldAccessKey1LastUsedService, vs...))
+}
+
+// AccessKey1LastUsedServiceGT applies the GT predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceGT(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceGTE applies the GTE predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceGTE(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceLT applies the LT predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceLT(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceLTE applies the LTE predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceLTE(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceContains applies the Contains predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceContains(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldContains(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceHasPrefix applies the HasPrefix predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceHasPrefix(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldHasPrefix(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceHasSuffix applies the HasSuffix predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceHasSuffix(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldHasSuffix(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceIsNil applies the IsNil predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceIsNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIsNull(FieldAccessKey1LastUsedService))
+}
+
+// AccessKey1LastUsedServiceNotNil applies the NotNil predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceNotNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotNull(FieldAccessKey1LastUsedService))
+}
+
+// AccessKey1LastUsedServiceEqualFold applies the EqualFold predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceEqualFold(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEqualFold(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceContainsFold applies the ContainsFold predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceContainsFold(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldContainsFold(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey2ActiveEQ applies the EQ predicate on the "access_key_2_active" field.
+func AccessKey2ActiveEQ(v bool) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2Active, v))
+}
+
+// AccessKey2ActiveNEQ applies the NEQ predicate on the "access_key_2_active" field.
+func AccessKey2ActiveNEQ(v bool) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey2Active, v))
+}
+
+// AccessKey2LastRotatedEQ applies the EQ predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2LastRotated, v))
+}
+
+// AccessKey2LastRotatedNEQ applies the NEQ predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedNEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey2LastRotated, v))
+}
+
+// AccessKey2LastRotatedIn applies the In predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldAccessKey2LastRotated, vs...))
+}
+
+// AccessKey2LastRotatedNotIn applies the NotIn predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedNotIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldAccessKey2LastRotated, vs...))
+}
+
+// AccessKey2LastRotatedGT applies the GT predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedGT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldAccessKey2LastRotated, v))
+}
+
+// AccessKey2LastRotatedGTE applies the GTE predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedGTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldAccessKey2LastRotated, v))
+}
+
+// AccessKey2LastRotatedLT applies the LT predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedLT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldAccessKey2LastRotated, v))
+}
+
+// AccessKey2LastRotatedLTE applies the LTE predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedLTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldAccessKey2LastRotated, v))
+}
+
+// AccessKey2LastRotatedIsNil applies the IsNil predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedIsNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIsNull(FieldAccessKey2LastRotated))
+}
+
+// AccessKey2LastRotatedNotNil applies the NotNil predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedNotNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotNull(FieldAccessKey2LastRotated))
+}
+
+// AccessKey2LastUsedDateEQ applies the EQ predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2LastUsedDate, v))
+}
+
+// AccessKey2LastUsedDateNEQ applies the NEQ predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateNEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey2LastUsedDate, v))
+}
+
+// AccessKey2LastUsedDateIn applies the In predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldAccessKey2LastUsedDate, vs...))
+}
+
+// AccessKey2LastUsedDateNotIn applies the NotIn predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateNotIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldAccessKey2LastUsedDate, vs...))
+}
+
+// AccessKey2LastUsedDateGT applies the GT predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateGT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldAccessKey2LastUsedDate, v))
+}
+
+// AccessKey2LastUsedDateGTE applies the GTE predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateGTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldAccessKey2LastUsedDate, v))
+}
+
+// AccessKey2LastUsedDateLT applies the LT predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateLT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldAccessKey2LastUsedDate, v))
+}
+
+// AccessKey2LastUsedDateLTE applies the LTE predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateLTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldAccessKey2LastUsedDate, v))
+}
+
+// AccessKey2LastUsedDateIsNil applies the IsNil predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateIsNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIsNull(FieldAccessKey2LastUsedDate))
+}
+
+// AccessKey2LastUsedDateNotNil applies the NotNil predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateNotNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotNull(FieldAccessKey2LastUsedDate))
+}
+
+// AccessKey2LastUsedRegionEQ applies the EQ predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionEQ(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionNEQ applies the NEQ predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionNEQ(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionIn applies the In predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionIn(vs ...string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldAccessKey2LastUsedRegion, vs...))
+}
+
+// AccessKey2LastUsedRegionNotIn applies the NotIn predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionNotIn(vs ...string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldAccessKey2LastUsedRegion, vs...))
+}
+
+// AccessKey2LastUsedRegionGT applies the GT predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionGT(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionGTE applies the GTE predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionGTE(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionLT applies the LT predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionLT(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionLTE applies the LTE predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionLTE(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionContains applies the Contains predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionContains(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldContains(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionHasPrefix applies the HasPrefix predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionHasPrefix(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldHasPrefix(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionHasSuffix applies the HasSuffix predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionHasSuffix(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldHasSuffix(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionIsNil applies the IsNil predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionIsNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIsNull(FieldAccessKey2LastUsedRegion))
+}
+
+// AccessKey2LastUsedRegionNotNil applies the NotNil predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionNotNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotNull(FieldAccessKey2LastUsedRegion))
+}
+
+// AccessKey2LastUsedRegionEqualFold applies the EqualFold predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionEqualFold(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEqualFold(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionContainsFold applies the ContainsFold predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionContainsFold(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldContainsFold(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedServiceEQ applies the EQ predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceEQ(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceNEQ applies the NEQ predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceNEQ(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceIn applies the In predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceIn(vs ...string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldAccessKey2LastUsedService, vs...))
+}
+
+// AccessKey2LastUsedServiceNotIn applies the NotIn predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceNotIn(vs ...string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldAccessKey2LastUsedService, vs...))
+}
+
+// AccessKey2LastUsedServiceGT applies the GT predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceGT(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceGTE applies the GTE predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceGTE(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceLT applies the LT predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceLT(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceLTE applies the LTE predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceLTE(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceContains applies the Contains predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceContains(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldContains(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceHasPrefix applies the HasPrefix predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceHasPrefix(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldHasPrefix(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceHasSuffix applies the HasSuffix predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceHasSuffix(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldHasSuffix(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceIsNil applies the IsNil predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceIsNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIsNull(FieldAccessKey2LastUsedService))
+}
+
+// AccessKey2LastUsedServiceNotNil applies the NotNil predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceNotNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotNull(FieldAccessKey2LastUsedService))
+}
+
+// AccessKey2LastUsedServiceEqualFold applies the EqualFold predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceEqualFold(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEqualFold(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceContainsFold applies the ContainsFold predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceContainsFold(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldContainsFold(FieldAccessKey2LastUsedService, v))
+}
+
+// Cert1ActiveEQ applies the EQ predicate on the "cert_1_active" field.
+func Cert1ActiveEQ(v bool) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldCert1Active, v))
+}
+
+// Cert1ActiveNEQ applies the NEQ predicate on the "cert_1_active" field.
+func Cert1ActiveNEQ(v bool) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldCert1Active, v))
+}
+
+// Cert1LastRotatedEQ applies the EQ predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldCert1LastRotated, v))
+}
+
+// Cert1LastRotatedNEQ applies the NEQ predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedNEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldCert1LastRotated, v))
+}
+
+// Cert1LastRotatedIn applies the In predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldCert1LastRotated, vs...))
+}
+
+// Cert1LastRotatedNotIn applies the NotIn predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedNotIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldCert1LastRotated, vs...))
+}
+
+// Cert1LastRotatedGT applies the GT predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedGT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldCert1LastRotated, v))
+}
+
+// Cert1LastRotatedGTE applies the GTE predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedGTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldCert1LastRotated, v))
+}
+
+// Cert1LastRotatedLT applies the LT predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedLT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldCert1LastRotated, v))
+}
+
+// Cert1LastRotatedLTE applies the LTE predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedLTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldCert1LastRotated, v))
+}
+
+// Cert1LastRotatedIsNil applies the IsNil predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedIsNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIsNull(FieldCert1LastRotated))
+}
+
+// Cert1LastRotatedNotNil applies the NotNil predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedNotNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotNull(FieldCert1LastRotated))
+}
+
+// Cert2ActiveEQ applies the EQ predicate on the "cert_2_active" field.
+func Cert2ActiveEQ(v bool) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldCert2Active, v))
+}
+
+// Cert2ActiveNEQ applies the NEQ predicate on the "cert_2_active" field.
+func Cert2ActiveNEQ(v bool) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldCert2Active, v))
+}
+
+// Cert2LastRotatedEQ applies the EQ predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldCert2LastRotated, v))
+}
+
+// Cert2LastRotatedNEQ applies the NEQ predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedNEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldCert2LastRotated, v))
+}
+
+// Cert2LastRotatedIn applies the In predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldCert2LastRotated, vs...))
+}
+
+// Cert2LastRotatedNotIn applies the NotIn predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedNotIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldCert2LastRotated, vs...))
+}
+
+// Cert2LastRotatedGT applies the GT predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedGT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldCert2LastRotated, v))
+}
+
+// Cert2LastRotatedGTE applies the GTE predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedGTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldCert2LastRotated, v))
+}
+
+// Cert2LastRotatedLT applies the LT predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedLT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldCert2LastRotated, v))
+}
+
+// Cert2LastRotatedLTE applies the LTE predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedLTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldCert2LastRotated, v))
+}
+
+// Cert2LastRotatedIsNil applies the IsNil predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedIsNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIsNull(FieldCert2LastRotated))
+}
+
+// Cert2LastRotatedNotNil applies the NotNil predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedNotNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotNull(FieldCert2LastRotated))
+}
+
+// ReportGeneratedAtEQ applies the EQ predicate on the "report_generated_at" field.
+func ReportGeneratedAtEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldReportGeneratedAt, v))
+}
+
+// ReportGeneratedAtNEQ applies the NEQ predicate on the "report_generated_at" field.
+func ReportGeneratedAtNEQ(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldReportGeneratedAt, v))
+}
+
+// ReportGeneratedAtIn applies the In predicate on the "report_generated_at" field.
+func ReportGeneratedAtIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldReportGeneratedAt, vs...))
+}
+
+// ReportGeneratedAtNotIn applies the NotIn predicate on the "report_generated_at" field.
+func ReportGeneratedAtNotIn(vs ...time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldReportGeneratedAt, vs...))
+}
+
+// ReportGeneratedAtGT applies the GT predicate on the "report_generated_at" field.
+func ReportGeneratedAtGT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldReportGeneratedAt, v))
+}
+
+// ReportGeneratedAtGTE applies the GTE predicate on the "report_generated_at" field.
+func ReportGeneratedAtGTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldReportGeneratedAt, v))
+}
+
+// ReportGeneratedAtLT applies the LT predicate on the "report_generated_at" field.
+func ReportGeneratedAtLT(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldReportGeneratedAt, v))
+}
+
+// ReportGeneratedAtLTE applies the LTE predicate on the "report_generated_at" field.
+func ReportGeneratedAtLTE(v time.Time) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldReportGeneratedAt, v))
+}
+
+// ReportGeneratedAtIsNil applies the IsNil predicate on the "report_generated_at" field.
+func ReportGeneratedAtIsNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIsNull(FieldReportGeneratedAt))
+}
+
+// ReportGeneratedAtNotNil applies the NotNil predicate on the "report_generated_at" field.
+func ReportGeneratedAtNotNil() predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotNull(FieldReportGeneratedAt))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeAWSIAMCredentialReport) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeAWSIAMCredentialReport) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeAWSIAMCredentialReport) predicate.BronzeAWSIAMCredentialReport {
+	return predicate.BronzeAWSIAMCredentialReport(sql.NotPredicates(p))
+}
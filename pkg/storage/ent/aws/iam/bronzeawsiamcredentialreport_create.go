@@ -0,0 +1,664 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamcredentialreport"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSIAMCredentialReportCreate is the builder for creating a BronzeAWSIAMCredentialReport entity.
+type BronzeAWSIAMCredentialReportCreate struct {
+	config
+	mutation *BronzeAWSIAMCredentialReportMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetCollectedAt(v time.Time) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetFirstCollectedAt(v time.Time) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetUser sets the "user" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetUser(v string) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetUser(v)
+	return _c
+}
+
+// SetUserCreationTime sets the "user_creation_time" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetUserCreationTime(v time.Time) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetUserCreationTime(v)
+	return _c
+}
+
+// SetNillableUserCreationTime sets the "user_creation_time" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillableUserCreationTime(v *time.Time) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetUserCreationTime(*v)
+	}
+	return _c
+}
+
+// SetPasswordEnabled sets the "password_enabled" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetPasswordEnabled(v string) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetPasswordEnabled(v)
+	return _c
+}
+
+// SetNillablePasswordEnabled sets the "password_enabled" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillablePasswordEnabled(v *string) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetPasswordEnabled(*v)
+	}
+	return _c
+}
+
+// SetPasswordLastUsed sets the "password_last_used" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetPasswordLastUsed(v time.Time) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetPasswordLastUsed(v)
+	return _c
+}
+
+// SetNillablePasswordLastUsed sets the "password_last_used" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillablePasswordLastUsed(v *time.Time) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetPasswordLastUsed(*v)
+	}
+	return _c
+}
+
+// SetPasswordLastChanged sets the "password_last_changed" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetPasswordLastChanged(v time.Time) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetPasswordLastChanged(v)
+	return _c
+}
+
+// SetNillablePasswordLastChanged sets the "password_last_changed" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillablePasswordLastChanged(v *time.Time) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetPasswordLastChanged(*v)
+	}
+	return _c
+}
+
+// SetPasswordNextRotation sets the "password_next_rotation" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetPasswordNextRotation(v time.Time) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetPasswordNextRotation(v)
+	return _c
+}
+
+// SetNillablePasswordNextRotation sets the "password_next_rotation" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillablePasswordNextRotation(v *time.Time) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetPasswordNextRotation(*v)
+	}
+	return _c
+}
+
+// SetMfaActive sets the "mfa_active" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetMfaActive(v bool) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetMfaActive(v)
+	return _c
+}
+
+// SetNillableMfaActive sets the "mfa_active" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillableMfaActive(v *bool) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetMfaActive(*v)
+	}
+	return _c
+}
+
+// SetAccessKey1Active sets the "access_key_1_active" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetAccessKey1Active(v bool) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey1Active(v)
+	return _c
+}
+
+// SetNillableAccessKey1Active sets the "access_key_1_active" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillableAccessKey1Active(v *bool) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey1Active(*v)
+	}
+	return _c
+}
+
+// SetAccessKey1LastRotated sets the "access_key_1_last_rotated" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetAccessKey1LastRotated(v time.Time) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey1LastRotated(v)
+	return _c
+}
+
+// SetNillableAccessKey1LastRotated sets the "access_key_1_last_rotated" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillableAccessKey1LastRotated(v *time.Time) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey1LastRotated(*v)
+	}
+	return _c
+}
+
+// SetAccessKey1LastUsedDate sets the "access_key_1_last_used_date" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetAccessKey1LastUsedDate(v time.Time) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey1LastUsedDate(v)
+	return _c
+}
+
+// SetNillableAccessKey1LastUsedDate sets the "access_key_1_last_used_date" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillableAccessKey1LastUsedDate(v *time.Time) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey1LastUsedDate(*v)
+	}
+	return _c
+}
+
+// SetAccessKey1LastUsedRegion sets the "access_key_1_last_used_region" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetAccessKey1LastUsedRegion(v string) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey1LastUsedRegion(v)
+	return _c
+}
+
+// SetNillableAccessKey1LastUsedRegion sets the "access_key_1_last_used_region" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillableAccessKey1LastUsedRegion(v *string) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey1LastUsedRegion(*v)
+	}
+	return _c
+}
+
+// SetAccessKey1LastUsedService sets the "access_key_1_last_used_service" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetAccessKey1LastUsedService(v string) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey1LastUsedService(v)
+	return _c
+}
+
+// SetNillableAccessKey1LastUsedService sets the "access_key_1_last_used_service" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillableAccessKey1LastUsedService(v *string) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey1LastUsedService(*v)
+	}
+	return _c
+}
+
+// SetAccessKey2Active sets the "access_key_2_active" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetAccessKey2Active(v bool) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey2Active(v)
+	return _c
+}
+
+// SetNillableAccessKey2Active sets the "access_key_2_active" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillableAccessKey2Active(v *bool) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey2Active(*v)
+	}
+	return _c
+}
+
+// SetAccessKey2LastRotated sets the "access_key_2_last_rotated" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetAccessKey2LastRotated(v time.Time) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey2LastRotated(v)
+	return _c
+}
+
+// SetNillableAccessKey2LastRotated sets the "access_key_2_last_rotated" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillableAccessKey2LastRotated(v *time.Time) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey2LastRotated(*v)
+	}
+	return _c
+}
+
+// SetAccessKey2LastUsedDate sets the "access_key_2_last_used_date" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetAccessKey2LastUsedDate(v time.Time) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey2LastUsedDate(v)
+	return _c
+}
+
+// SetNillableAccessKey2LastUsedDate sets the "access_key_2_last_used_date" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillableAccessKey2LastUsedDate(v *time.Time) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey2LastUsedDate(*v)
+	}
+	return _c
+}
+
+// SetAccessKey2LastUsedRegion sets the "access_key_2_last_used_region" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetAccessKey2LastUsedRegion(v string) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey2LastUsedRegion(v)
+	return _c
+}
+
+// SetNillableAccessKey2LastUsedRegion sets the "access_key_2_last_used_region" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillableAccessKey2LastUsedRegion(v *string) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey2LastUsedRegion(*v)
+	}
+	return _c
+}
+
+// SetAccessKey2LastUsedService sets the "access_key_2_last_used_service" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetAccessKey2LastUsedService(v string) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey2LastUsedService(v)
+	return _c
+}
+
+// SetNillableAccessKey2LastUsedService sets the "access_key_2_last_used_service" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillableAccessKey2LastUsedService(v *string) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey2LastUsedService(*v)
+	}
+	return _c
+}
+
+// SetCert1Active sets the "cert_1_active" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetCert1Active(v bool) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetCert1Active(v)
+	return _c
+}
+
+// SetNillableCert1Active sets the "cert_1_active" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillableCert1Active(v *bool) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetCert1Active(*v)
+	}
+	return _c
+}
+
+// SetCert1LastRotated sets the "cert_1_last_rotated" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetCert1LastRotated(v time.Time) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetCert1LastRotated(v)
+	return _c
+}
+
+// SetNillableCert1LastRotated sets the "cert_1_last_rotated" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillableCert1LastRotated(v *time.Time) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetCert1LastRotated(*v)
+	}
+	return _c
+}
+
+// SetCert2Active sets the "cert_2_active" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetCert2Active(v bool) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetCert2Active(v)
+	return _c
+}
+
+// SetNillableCert2Active sets the "cert_2_active" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillableCert2Active(v *bool) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetCert2Active(*v)
+	}
+	return _c
+}
+
+// SetCert2LastRotated sets the "cert_2_last_rotated" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetCert2LastRotated(v time.Time) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetCert2LastRotated(v)
+	return _c
+}
+
+// SetNillableCert2LastRotated sets the "cert_2_last_rotated" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillableCert2LastRotated(v *time.Time) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetCert2LastRotated(*v)
+	}
+	return _c
+}
+
+// SetReportGeneratedAt sets the "report_generated_at" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetReportGeneratedAt(v time.Time) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetReportGeneratedAt(v)
+	return _c
+}
+
+// SetNillableReportGeneratedAt sets the "report_generated_at" field if the given value is not nil.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetNillableReportGeneratedAt(v *time.Time) *BronzeAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetReportGeneratedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetAccountID(v string) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeAWSIAMCredentialReportCreate) SetID(v string) *BronzeAWSIAMCredentialReportCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeAWSIAMCredentialReportMutation object of the builder.
+func (_c *BronzeAWSIAMCredentialReportCreate) Mutation() *BronzeAWSIAMCredentialReportMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeAWSIAMCredentialReport in the database.
+func (_c *BronzeAWSIAMCredentialReportCreate) Save(ctx context.Context) (*BronzeAWSIAMCredentialReport, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeAWSIAMCredentialReportCreate) SaveX(ctx context.Context) *BronzeAWSIAMCredentialReport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSIAMCredentialReportCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSIAMCredentialReportCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeAWSIAMCredentialReportCreate) defaults() {
+	if _, ok := _c.mutation.MfaActive(); !ok {
+		v := bronzeawsiamcredentialreport.DefaultMfaActive
+		_c.mutation.SetMfaActive(v)
+	}
+	if _, ok := _c.mutation.AccessKey1Active(); !ok {
+		v := bronzeawsiamcredentialreport.DefaultAccessKey1Active
+		_c.mutation.SetAccessKey1Active(v)
+	}
+	if _, ok := _c.mutation.AccessKey2Active(); !ok {
+		v := bronzeawsiamcredentialreport.DefaultAccessKey2Active
+		_c.mutation.SetAccessKey2Active(v)
+	}
+	if _, ok := _c.mutation.Cert1Active(); !ok {
+		v := bronzeawsiamcredentialreport.DefaultCert1Active
+		_c.mutation.SetCert1Active(v)
+	}
+	if _, ok := _c.mutation.Cert2Active(); !ok {
+		v := bronzeawsiamcredentialreport.DefaultCert2Active
+		_c.mutation.SetCert2Active(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeAWSIAMCredentialReportCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`iam: missing required field "BronzeAWSIAMCredentialReport.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`iam: missing required field "BronzeAWSIAMCredentialReport.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.User(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`iam: missing required field "BronzeAWSIAMCredentialReport.user"`)}
+	}
+	if v, ok := _c.mutation.User(); ok {
+		if err := bronzeawsiamcredentialreport.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMCredentialReport.user": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MfaActive(); !ok {
+		return &ValidationError{Name: "mfa_active", err: errors.New(`iam: missing required field "BronzeAWSIAMCredentialReport.mfa_active"`)}
+	}
+	if _, ok := _c.mutation.AccessKey1Active(); !ok {
+		return &ValidationError{Name: "access_key_1_active", err: errors.New(`iam: missing required field "BronzeAWSIAMCredentialReport.access_key_1_active"`)}
+	}
+	if _, ok := _c.mutation.AccessKey2Active(); !ok {
+		return &ValidationError{Name: "access_key_2_active", err: errors.New(`iam: missing required field "BronzeAWSIAMCredentialReport.access_key_2_active"`)}
+	}
+	if _, ok := _c.mutation.Cert1Active(); !ok {
+		return &ValidationError{Name: "cert_1_active", err: errors.New(`iam: missing required field "BronzeAWSIAMCredentialReport.cert_1_active"`)}
+	}
+	if _, ok := _c.mutation.Cert2Active(); !ok {
+		return &ValidationError{Name: "cert_2_active", err: errors.New(`iam: missing required field "BronzeAWSIAMCredentialReport.cert_2_active"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`iam: missing required field "BronzeAWSIAMCredentialReport.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzeawsiamcredentialreport.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMCredentialReport.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeAWSIAMCredentialReportCreate) sqlSave(ctx context.Context) (*BronzeAWSIAMCredentialReport, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeAWSIAMCredentialReport.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeAWSIAMCredentialReportCreate) createSpec() (*BronzeAWSIAMCredentialReport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeAWSIAMCredentialReport{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzeawsiamcredentialreport.Table, sqlgraph.NewFieldSpec(bronzeawsiamcredentialreport.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeAWSIAMCredentialReport
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.User(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldUser, field.TypeString, value)
+		_node.User = value
+	}
+	if value, ok := _c.mutation.UserCreationTime(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldUserCreationTime, field.TypeTime, value)
+		_node.UserCreationTime = &value
+	}
+	if value, ok := _c.mutation.PasswordEnabled(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldPasswordEnabled, field.TypeString, value)
+		_node.PasswordEnabled = value
+	}
+	if value, ok := _c.mutation.PasswordLastUsed(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldPasswordLastUsed, field.TypeTime, value)
+		_node.PasswordLastUsed = &value
+	}
+	if value, ok := _c.mutation.PasswordLastChanged(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldPasswordLastChanged, field.TypeTime, value)
+		_node.PasswordLastChanged = &value
+	}
+	if value, ok := _c.mutation.PasswordNextRotation(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldPasswordNextRotation, field.TypeTime, value)
+		_node.PasswordNextRotation = &value
+	}
+	if value, ok := _c.mutation.MfaActive(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldMfaActive, field.TypeBool, value)
+		_node.MfaActive = value
+	}
+	if value, ok := _c.mutation.AccessKey1Active(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey1Active, field.TypeBool, value)
+		_node.AccessKey1Active = value
+	}
+	if value, ok := _c.mutation.AccessKey1LastRotated(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey1LastRotated, field.TypeTime, value)
+		_node.AccessKey1LastRotated = &value
+	}
+	if value, ok := _c.mutation.AccessKey1LastUsedDate(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey1LastUsedDate, field.TypeTime, value)
+		_node.AccessKey1LastUsedDate = &value
+	}
+	if value, ok := _c.mutation.AccessKey1LastUsedRegion(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey1LastUsedRegion, field.TypeString, value)
+		_node.AccessKey1LastUsedRegion = value
+	}
+	if value, ok := _c.mutation.AccessKey1LastUsedService(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey1LastUsedService, field.TypeString, value)
+		_node.AccessKey1LastUsedService = value
+	}
+	if value, ok := _c.mutation.AccessKey2Active(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey2Active, field.TypeBool, value)
+		_node.AccessKey2Active = value
+	}
+	if value, ok := _c.mutation.AccessKey2LastRotated(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey2LastRotated, field.TypeTime, value)
+		_node.AccessKey2LastRotated = &value
+	}
+	if value, ok := _c.mutation.AccessKey2LastUsedDate(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey2LastUsedDate, field.TypeTime, value)
+		_node.AccessKey2LastUsedDate = &value
+	}
+	if value, ok := _c.mutation.AccessKey2LastUsedRegion(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey2LastUsedRegion, field.TypeString, value)
+		_node.AccessKey2LastUsedRegion = value
+	}
+	if value, ok := _c.mutation.AccessKey2LastUsedService(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey2LastUsedService, field.TypeString, value)
+		_node.AccessKey2LastUsedService = value
+	}
+	if value, ok := _c.mutation.Cert1Active(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldCert1Active, field.TypeBool, value)
+		_node.Cert1Active = value
+	}
+	if value, ok := _c.mutation.Cert1LastRotated(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldCert1LastRotated, field.TypeTime, value)
+		_node.Cert1LastRotated = &value
+	}
+	if value, ok := _c.mutation.Cert2Active(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldCert2Active, field.TypeBool, value)
+		_node.Cert2Active = value
+	}
+	if value, ok := _c.mutation.Cert2LastRotated(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldCert2LastRotated, field.TypeTime, value)
+		_node.Cert2LastRotated = &value
+	}
+	if value, ok := _c.mutation.ReportGeneratedAt(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldReportGeneratedAt, field.TypeTime, value)
+		_node.ReportGeneratedAt = &value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	return _node, _spec
+}
+
+// BronzeAWSIAMCredentialReportCreateBulk is the builder for creating many BronzeAWSIAMCredentialReport entities in bulk.
+type BronzeAWSIAMCredentialReportCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeAWSIAMCredentialReportCreate
+}
+
+// Save creates the BronzeAWSIAMCredentialReport entities in the database.
+func (_c *BronzeAWSIAMCredentialReportCreateBulk) Save(ctx context.Context) ([]*BronzeAWSIAMCredentialReport, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeAWSIAMCredentialReport, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeAWSIAMCredentialReportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeAWSIAMCredentialReportCreateBulk) SaveX(ctx context.Context) []*BronzeAWSIAMCredentialReport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSIAMCredentialReportCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSIAMCredentialReportCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamcredentialreport"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSIAMCredentialReportDelete is the builder for deleting a BronzeAWSIAMCredentialReport entity.
+type BronzeAWSIAMCredentialReportDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSIAMCredentialReportMutation
+}
+
+// Where appends a list predicates to the BronzeAWSIAMCredentialReportDelete builder.
+func (_d *BronzeAWSIAMCredentialReportDelete) Where(ps ...predicate.BronzeAWSIAMCredentialReport) *BronzeAWSIAMCredentialReportDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeAWSIAMCredentialReportDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeAWSIAMCredentialReportDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeAWSIAMCredentialReportDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzeawsiamcredentialreport.Table, sqlgraph.NewFieldSpec(bronzeawsiamcredentialreport.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.BronzeAWSIAMCredentialReport
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeAWSIAMCredentialReportDeleteOne is the builder for deleting a single BronzeAWSIAMCredentialReport entity.
+type BronzeAWSIAMCredentialReportDeleteOne struct {
+	_d *BronzeAWSIAMCredentialReportDelete
+}
+
+// Where appends a list predicates to the BronzeAWSIAMCredentialReportDelete builder.
+func (_d *BronzeAWSIAMCredentialReportDeleteOne) Where(ps ...predicate.BronzeAWSIAMCredentialReport) *BronzeAWSIAMCredentialReportDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeAWSIAMCredentialReportDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzeawsiamcredentialreport.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeAWSIAMCredentialReportDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1321 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamcredentialreport"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSIAMCredentialReportUpdate is the builder for updating BronzeAWSIAMCredentialReport entities.
+type BronzeAWSIAMCredentialReportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSIAMCredentialReportMutation
+}
+
+// Where appends a list predicates to the BronzeAWSIAMCredentialReportUpdate builder.
+func (_u *BronzeAWSIAMCredentialReportUpdate) Where(ps ...predicate.BronzeAWSIAMCredentialReport) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetCollectedAt(v time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableCollectedAt(v *time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetUser sets the "user" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetUser(v string) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetUser(v)
+	return _u
+}
+
+// SetNillableUser sets the "user" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableUser(v *string) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetUser(*v)
+	}
+	return _u
+}
+
+// SetUserCreationTime sets the "user_creation_time" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetUserCreationTime(v time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetUserCreationTime(v)
+	return _u
+}
+
+// SetNillableUserCreationTime sets the "user_creation_time" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableUserCreationTime(v *time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetUserCreationTime(*v)
+	}
+	return _u
+}
+
+// ClearUserCreationTime clears the value of the "user_creation_time" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) ClearUserCreationTime() *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearUserCreationTime()
+	return _u
+}
+
+// SetPasswordEnabled sets the "password_enabled" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetPasswordEnabled(v string) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetPasswordEnabled(v)
+	return _u
+}
+
+// SetNillablePasswordEnabled sets the "password_enabled" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillablePasswordEnabled(v *string) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetPasswordEnabled(*v)
+	}
+	return _u
+}
+
+// ClearPasswordEnabled clears the value of the "password_enabled" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) ClearPasswordEnabled() *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearPasswordEnabled()
+	return _u
+}
+
+// SetPasswordLastUsed sets the "password_last_used" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetPasswordLastUsed(v time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetPasswordLastUsed(v)
+	return _u
+}
+
+// SetNillablePasswordLastUsed sets the "password_last_used" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillablePasswordLastUsed(v *time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetPasswordLastUsed(*v)
+	}
+	return _u
+}
+
+// ClearPasswordLastUsed clears the value of the "password_last_used" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) ClearPasswordLastUsed() *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearPasswordLastUsed()
+	return _u
+}
+
+// SetPasswordLastChanged sets the "password_last_changed" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetPasswordLastChanged(v time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetPasswordLastChanged(v)
+	return _u
+}
+
+// SetNillablePasswordLastChanged sets the "password_last_changed" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillablePasswordLastChanged(v *time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetPasswordLastChanged(*v)
+	}
+	return _u
+}
+
+// ClearPasswordLastChanged clears the value of the "password_last_changed" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) ClearPasswordLastChanged() *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearPasswordLastChanged()
+	return _u
+}
+
+// SetPasswordNextRotation sets the "password_next_rotation" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetPasswordNextRotation(v time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetPasswordNextRotation(v)
+	return _u
+}
+
+// SetNillablePasswordNextRotation sets the "password_next_rotation" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillablePasswordNextRotation(v *time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetPasswordNextRotation(*v)
+	}
+	return _u
+}
+
+// ClearPasswordNextRotation clears the value of the "password_next_rotation" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) ClearPasswordNextRotation() *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearPasswordNextRotation()
+	return _u
+}
+
+// SetMfaActive sets the "mfa_active" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetMfaActive(v bool) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetMfaActive(v)
+	return _u
+}
+
+// SetNillableMfaActive sets the "mfa_active" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableMfaActive(v *bool) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetMfaActive(*v)
+	}
+	return _u
+}
+
+// SetAccessKey1Active sets the "access_key_1_active" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetAccessKey1Active(v bool) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey1Active(v)
+	return _u
+}
+
+// SetNillableAccessKey1Active sets the "access_key_1_active" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableAccessKey1Active(v *bool) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey1Active(*v)
+	}
+	return _u
+}
+
+// SetAccessKey1LastRotated sets the "access_key_1_last_rotated" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetAccessKey1LastRotated(v time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey1LastRotated(v)
+	return _u
+}
+
+// SetNillableAccessKey1LastRotated sets the "access_key_1_last_rotated" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableAccessKey1LastRotated(v *time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey1LastRotated(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey1LastRotated clears the value of the "access_key_1_last_rotated" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) ClearAccessKey1LastRotated() *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearAccessKey1LastRotated()
+	return _u
+}
+
+// SetAccessKey1LastUsedDate sets the "access_key_1_last_used_date" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetAccessKey1LastUsedDate(v time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey1LastUsedDate(v)
+	return _u
+}
+
+// SetNillableAccessKey1LastUsedDate sets the "access_key_1_last_used_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableAccessKey1LastUsedDate(v *time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey1LastUsedDate(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey1LastUsedDate clears the value of the "access_key_1_last_used_date" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) ClearAccessKey1LastUsedDate() *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearAccessKey1LastUsedDate()
+	return _u
+}
+
+// SetAccessKey1LastUsedRegion sets the "access_key_1_last_used_region" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetAccessKey1LastUsedRegion(v string) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey1LastUsedRegion(v)
+	return _u
+}
+
+// SetNillableAccessKey1LastUsedRegion sets the "access_key_1_last_used_region" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableAccessKey1LastUsedRegion(v *string) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey1LastUsedRegion(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey1LastUsedRegion clears the value of the "access_key_1_last_used_region" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) ClearAccessKey1LastUsedRegion() *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearAccessKey1LastUsedRegion()
+	return _u
+}
+
+// SetAccessKey1LastUsedService sets the "access_key_1_last_used_service" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetAccessKey1LastUsedService(v string) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey1LastUsedService(v)
+	return _u
+}
+
+// SetNillableAccessKey1LastUsedService sets the "access_key_1_last_used_service" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableAccessKey1LastUsedService(v *string) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey1LastUsedService(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey1LastUsedService clears the value of the "access_key_1_last_used_service" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) ClearAccessKey1LastUsedService() *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearAccessKey1LastUsedService()
+	return _u
+}
+
+// SetAccessKey2Active sets the "access_key_2_active" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetAccessKey2Active(v bool) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey2Active(v)
+	return _u
+}
+
+// SetNillableAccessKey2Active sets the "access_key_2_active" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableAccessKey2Active(v *bool) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey2Active(*v)
+	}
+	return _u
+}
+
+// SetAccessKey2LastRotated sets the "access_key_2_last_rotated" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetAccessKey2LastRotated(v time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey2LastRotated(v)
+	return _u
+}
+
+// SetNillableAccessKey2LastRotated sets the "access_key_2_last_rotated" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableAccessKey2LastRotated(v *time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey2LastRotated(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey2LastRotated clears the value of the "access_key_2_last_rotated" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) ClearAccessKey2LastRotated() *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearAccessKey2LastRotated()
+	return _u
+}
+
+// SetAccessKey2LastUsedDate sets the "access_key_2_last_used_date" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetAccessKey2LastUsedDate(v time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey2LastUsedDate(v)
+	return _u
+}
+
+// SetNillableAccessKey2LastUsedDate sets the "access_key_2_last_used_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableAccessKey2LastUsedDate(v *time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey2LastUsedDate(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey2LastUsedDate clears the value of the "access_key_2_last_used_date" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) ClearAccessKey2LastUsedDate() *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearAccessKey2LastUsedDate()
+	return _u
+}
+
+// SetAccessKey2LastUsedRegion sets the "access_key_2_last_used_region" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetAccessKey2LastUsedRegion(v string) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey2LastUsedRegion(v)
+	return _u
+}
+
+// SetNillableAccessKey2LastUsedRegion sets the "access_key_2_last_used_region" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableAccessKey2LastUsedRegion(v *string) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey2LastUsedRegion(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey2LastUsedRegion clears the value of the "access_key_2_last_used_region" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) ClearAccessKey2LastUsedRegion() *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearAccessKey2LastUsedRegion()
+	return _u
+}
+
+// SetAccessKey2LastUsedService sets the "access_key_2_last_used_service" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetAccessKey2LastUsedService(v string) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey2LastUsedService(v)
+	return _u
+}
+
+// SetNillableAccessKey2LastUsedService sets the "access_key_2_last_used_service" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableAccessKey2LastUsedService(v *string) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey2LastUsedService(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey2LastUsedService clears the value of the "access_key_2_last_used_service" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) ClearAccessKey2LastUsedService() *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearAccessKey2LastUsedService()
+	return _u
+}
+
+// SetCert1Active sets the "cert_1_active" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetCert1Active(v bool) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetCert1Active(v)
+	return _u
+}
+
+// SetNillableCert1Active sets the "cert_1_active" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableCert1Active(v *bool) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetCert1Active(*v)
+	}
+	return _u
+}
+
+// SetCert1LastRotated sets the "cert_1_last_rotated" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetCert1LastRotated(v time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetCert1LastRotated(v)
+	return _u
+}
+
+// SetNillableCert1LastRotated sets the "cert_1_last_rotated" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableCert1LastRotated(v *time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetCert1LastRotated(*v)
+	}
+	return _u
+}
+
+// ClearCert1LastRotated clears the value of the "cert_1_last_rotated" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) ClearCert1LastRotated() *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearCert1LastRotated()
+	return _u
+}
+
+// SetCert2Active sets the "cert_2_active" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetCert2Active(v bool) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetCert2Active(v)
+	return _u
+}
+
+// SetNillableCert2Active sets the "cert_2_active" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableCert2Active(v *bool) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetCert2Active(*v)
+	}
+	return _u
+}
+
+// SetCert2LastRotated sets the "cert_2_last_rotated" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetCert2LastRotated(v time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetCert2LastRotated(v)
+	return _u
+}
+
+// SetNillableCert2LastRotated sets the "cert_2_last_rotated" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableCert2LastRotated(v *time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetCert2LastRotated(*v)
+	}
+	return _u
+}
+
+// ClearCert2LastRotated clears the value of the "cert_2_last_rotated" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) ClearCert2LastRotated() *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearCert2LastRotated()
+	return _u
+}
+
+// SetReportGeneratedAt sets the "report_generated_at" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetReportGeneratedAt(v time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetReportGeneratedAt(v)
+	return _u
+}
+
+// SetNillableReportGeneratedAt sets the "report_generated_at" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableReportGeneratedAt(v *time.Time) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetReportGeneratedAt(*v)
+	}
+	return _u
+}
+
+// ClearReportGeneratedAt clears the value of the "report_generated_at" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) ClearReportGeneratedAt() *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearReportGeneratedAt()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetAccountID(v string) *BronzeAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SetNillableAccountID(v *string) *BronzeAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSIAMCredentialReportMutation object of the builder.
+func (_u *BronzeAWSIAMCredentialReportUpdate) Mutation() *BronzeAWSIAMCredentialReportMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeAWSIAMCredentialReportUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSIAMCredentialReportUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeAWSIAMCredentialReportUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSIAMCredentialReportUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSIAMCredentialReportUpdate) check() error {
+	if v, ok := _u.mutation.User(); ok {
+		if err := bronzeawsiamcredentialreport.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMCredentialReport.user": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsiamcredentialreport.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMCredentialReport.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSIAMCredentialReportUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsiamcredentialreport.Table, bronzeawsiamcredentialreport.Columns, sqlgraph.NewFieldSpec(bronzeawsiamcredentialreport.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.User(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldUser, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserCreationTime(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldUserCreationTime, field.TypeTime, value)
+	}
+	if _u.mutation.UserCreationTimeCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldUserCreationTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PasswordEnabled(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldPasswordEnabled, field.TypeString, value)
+	}
+	if _u.mutation.PasswordEnabledCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldPasswordEnabled, field.TypeString)
+	}
+	if value, ok := _u.mutation.PasswordLastUsed(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldPasswordLastUsed, field.TypeTime, value)
+	}
+	if _u.mutation.PasswordLastUsedCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldPasswordLastUsed, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PasswordLastChanged(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldPasswordLastChanged, field.TypeTime, value)
+	}
+	if _u.mutation.PasswordLastChangedCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldPasswordLastChanged, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PasswordNextRotation(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldPasswordNextRotation, field.TypeTime, value)
+	}
+	if _u.mutation.PasswordNextRotationCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldPasswordNextRotation, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MfaActive(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldMfaActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccessKey1Active(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey1Active, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccessKey1LastRotated(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey1LastRotated, field.TypeTime, value)
+	}
+	if _u.mutation.AccessKey1LastRotatedCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldAccessKey1LastRotated, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccessKey1LastUsedDate(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey1LastUsedDate, field.TypeTime, value)
+	}
+	if _u.mutation.AccessKey1LastUsedDateCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldAccessKey1LastUsedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccessKey1LastUsedRegion(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey1LastUsedRegion, field.TypeString, value)
+	}
+	if _u.mutation.AccessKey1LastUsedRegionCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldAccessKey1LastUsedRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccessKey1LastUsedService(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey1LastUsedService, field.TypeString, value)
+	}
+	if _u.mutation.AccessKey1LastUsedServiceCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldAccessKey1LastUsedService, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccessKey2Active(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey2Active, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccessKey2LastRotated(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey2LastRotated, field.TypeTime, value)
+	}
+	if _u.mutation.AccessKey2LastRotatedCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldAccessKey2LastRotated, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccessKey2LastUsedDate(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey2LastUsedDate, field.TypeTime, value)
+	}
+	if _u.mutation.AccessKey2LastUsedDateCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldAccessKey2LastUsedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccessKey2LastUsedRegion(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey2LastUsedRegion, field.TypeString, value)
+	}
+	if _u.mutation.AccessKey2LastUsedRegionCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldAccessKey2LastUsedRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccessKey2LastUsedService(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey2LastUsedService, field.TypeString, value)
+	}
+	if _u.mutation.AccessKey2LastUsedServiceCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldAccessKey2LastUsedService, field.TypeString)
+	}
+	if value, ok := _u.mutation.Cert1Active(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldCert1Active, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Cert1LastRotated(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldCert1LastRotated, field.TypeTime, value)
+	}
+	if _u.mutation.Cert1LastRotatedCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldCert1LastRotated, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Cert2Active(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldCert2Active, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Cert2LastRotated(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldCert2LastRotated, field.TypeTime, value)
+	}
+	if _u.mutation.Cert2LastRotatedCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldCert2LastRotated, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReportGeneratedAt(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldReportGeneratedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReportGeneratedAtCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldReportGeneratedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSIAMCredentialReport
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsiamcredentialreport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeAWSIAMCredentialReportUpdateOne is the builder for updating a single BronzeAWSIAMCredentialReport entity.
+type BronzeAWSIAMCredentialReportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeAWSIAMCredentialReportMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetCollectedAt(v time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetUser sets the "user" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetUser(v string) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetUser(v)
+	return _u
+}
+
+// SetNillableUser sets the "user" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableUser(v *string) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetUser(*v)
+	}
+	return _u
+}
+
+// SetUserCreationTime sets the "user_creation_time" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetUserCreationTime(v time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetUserCreationTime(v)
+	return _u
+}
+
+// SetNillableUserCreationTime sets the "user_creation_time" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableUserCreationTime(v *time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetUserCreationTime(*v)
+	}
+	return _u
+}
+
+// ClearUserCreationTime clears the value of the "user_creation_time" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) ClearUserCreationTime() *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearUserCreationTime()
+	return _u
+}
+
+// SetPasswordEnabled sets the "password_enabled" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetPasswordEnabled(v string) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetPasswordEnabled(v)
+	return _u
+}
+
+// SetNillablePasswordEnabled sets the "password_enabled" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillablePasswordEnabled(v *string) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetPasswordEnabled(*v)
+	}
+	return _u
+}
+
+// ClearPasswordEnabled clears the value of the "password_enabled" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) ClearPasswordEnabled() *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearPasswordEnabled()
+	return _u
+}
+
+// SetPasswordLastUsed sets the "password_last_used" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetPasswordLastUsed(v time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetPasswordLastUsed(v)
+	return _u
+}
+
+// SetNillablePasswordLastUsed sets the "password_last_used" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillablePasswordLastUsed(v *time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetPasswordLastUsed(*v)
+	}
+	return _u
+}
+
+// ClearPasswordLastUsed clears the value of the "password_last_used" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) ClearPasswordLastUsed() *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearPasswordLastUsed()
+	return _u
+}
+
+// SetPasswordLastChanged sets the "password_last_changed" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetPasswordLastChanged(v time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetPasswordLastChanged(v)
+	return _u
+}
+
+// SetNillablePasswordLastChanged sets the "password_last_changed" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillablePasswordLastChanged(v *time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetPasswordLastChanged(*v)
+	}
+	return _u
+}
+
+// ClearPasswordLastChanged clears the value of the "password_last_changed" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) ClearPasswordLastChanged() *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearPasswordLastChanged()
+	return _u
+}
+
+// SetPasswordNextRotation sets the "password_next_rotation" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetPasswordNextRotation(v time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetPasswordNextRotation(v)
+	return _u
+}
+
+// SetNillablePasswordNextRotation sets the "password_next_rotation" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillablePasswordNextRotation(v *time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetPasswordNextRotation(*v)
+	}
+	return _u
+}
+
+// ClearPasswordNextRotation clears the value of the "password_next_rotation" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) ClearPasswordNextRotation() *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearPasswordNextRotation()
+	return _u
+}
+
+// SetMfaActive sets the "mfa_active" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetMfaActive(v bool) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetMfaActive(v)
+	return _u
+}
+
+// SetNillableMfaActive sets the "mfa_active" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableMfaActive(v *bool) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetMfaActive(*v)
+	}
+	return _u
+}
+
+// SetAccessKey1Active sets the "access_key_1_active" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetAccessKey1Active(v bool) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey1Active(v)
+	return _u
+}
+
+// SetNillableAccessKey1Active sets the "access_key_1_active" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableAccessKey1Active(v *bool) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey1Active(*v)
+	}
+	return _u
+}
+
+// SetAccessKey1LastRotated sets the "access_key_1_last_rotated" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetAccessKey1LastRotated(v time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey1LastRotated(v)
+	return _u
+}
+
+// SetNillableAccessKey1LastRotated sets the "access_key_1_last_rotated" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableAccessKey1LastRotated(v *time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey1LastRotated(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey1LastRotated clears the value of the "access_key_1_last_rotated" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) ClearAccessKey1LastRotated() *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearAccessKey1LastRotated()
+	return _u
+}
+
+// SetAccessKey1LastUsedDate sets the "access_key_1_last_used_date" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetAccessKey1LastUsedDate(v time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey1LastUsedDate(v)
+	return _u
+}
+
+// SetNillableAccessKey1LastUsedDate sets the "access_key_1_last_used_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableAccessKey1LastUsedDate(v *time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey1LastUsedDate(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey1LastUsedDate clears the value of the "access_key_1_last_used_date" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) ClearAccessKey1LastUsedDate() *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearAccessKey1LastUsedDate()
+	return _u
+}
+
+// SetAccessKey1LastUsedRegion sets the "access_key_1_last_used_region" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetAccessKey1LastUsedRegion(v string) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey1LastUsedRegion(v)
+	return _u
+}
+
+// SetNillableAccessKey1LastUsedRegion sets the "access_key_1_last_used_region" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableAccessKey1LastUsedRegion(v *string) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey1LastUsedRegion(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey1LastUsedRegion clears the value of the "access_key_1_last_used_region" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) ClearAccessKey1LastUsedRegion() *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearAccessKey1LastUsedRegion()
+	return _u
+}
+
+// SetAccessKey1LastUsedService sets the "access_key_1_last_used_service" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetAccessKey1LastUsedService(v string) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey1LastUsedService(v)
+	return _u
+}
+
+// SetNillableAccessKey1LastUsedService sets the "access_key_1_last_used_service" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableAccessKey1LastUsedService(v *string) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey1LastUsedService(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey1LastUsedService clears the value of the "access_key_1_last_used_service" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) ClearAccessKey1LastUsedService() *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearAccessKey1LastUsedService()
+	return _u
+}
+
+// SetAccessKey2Active sets the "access_key_2_active" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetAccessKey2Active(v bool) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey2Active(v)
+	return _u
+}
+
+// SetNillableAccessKey2Active sets the "access_key_2_active" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableAccessKey2Active(v *bool) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey2Active(*v)
+	}
+	return _u
+}
+
+// SetAccessKey2LastRotated sets the "access_key_2_last_rotated" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetAccessKey2LastRotated(v time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey2LastRotated(v)
+	return _u
+}
+
+// SetNillableAccessKey2LastRotated sets the "access_key_2_last_rotated" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableAccessKey2LastRotated(v *time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey2LastRotated(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey2LastRotated clears the value of the "access_key_2_last_rotated" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) ClearAccessKey2LastRotated() *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearAccessKey2LastRotated()
+	return _u
+}
+
+// SetAccessKey2LastUsedDate sets the "access_key_2_last_used_date" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetAccessKey2LastUsedDate(v time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey2LastUsedDate(v)
+	return _u
+}
+
+// SetNillableAccessKey2LastUsedDate sets the "access_key_2_last_used_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableAccessKey2LastUsedDate(v *time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey2LastUsedDate(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey2LastUsedDate clears the value of the "access_key_2_last_used_date" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) ClearAccessKey2LastUsedDate() *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearAccessKey2LastUsedDate()
+	return _u
+}
+
+// SetAccessKey2LastUsedRegion sets the "access_key_2_last_used_region" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetAccessKey2LastUsedRegion(v string) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey2LastUsedRegion(v)
+	return _u
+}
+
+// SetNillableAccessKey2LastUsedRegion sets the "access_key_2_last_used_region" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableAccessKey2LastUsedRegion(v *string) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey2LastUsedRegion(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey2LastUsedRegion clears the value of the "access_key_2_last_used_region" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) ClearAccessKey2LastUsedRegion() *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearAccessKey2LastUsedRegion()
+	return _u
+}
+
+// SetAccessKey2LastUsedService sets the "access_key_2_last_used_service" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetAccessKey2LastUsedService(v string) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey2LastUsedService(v)
+	return _u
+}
+
+// SetNillableAccessKey2LastUsedService sets the "access_key_2_last_used_service" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableAccessKey2LastUsedService(v *string) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey2LastUsedService(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey2LastUsedService clears the value of the "access_key_2_last_used_service" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) ClearAccessKey2LastUsedService() *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearAccessKey2LastUsedService()
+	return _u
+}
+
+// SetCert1Active sets the "cert_1_active" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetCert1Active(v bool) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetCert1Active(v)
+	return _u
+}
+
+// SetNillableCert1Active sets the "cert_1_active" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableCert1Active(v *bool) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetCert1Active(*v)
+	}
+	return _u
+}
+
+// SetCert1LastRotated sets the "cert_1_last_rotated" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetCert1LastRotated(v time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetCert1LastRotated(v)
+	return _u
+}
+
+// SetNillableCert1LastRotated sets the "cert_1_last_rotated" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableCert1LastRotated(v *time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetCert1LastRotated(*v)
+	}
+	return _u
+}
+
+// ClearCert1LastRotated clears the value of the "cert_1_last_rotated" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) ClearCert1LastRotated() *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearCert1LastRotated()
+	return _u
+}
+
+// SetCert2Active sets the "cert_2_active" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetCert2Active(v bool) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetCert2Active(v)
+	return _u
+}
+
+// SetNillableCert2Active sets the "cert_2_active" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableCert2Active(v *bool) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetCert2Active(*v)
+	}
+	return _u
+}
+
+// SetCert2LastRotated sets the "cert_2_last_rotated" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetCert2LastRotated(v time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetCert2LastRotated(v)
+	return _u
+}
+
+// SetNillableCert2LastRotated sets the "cert_2_last_rotated" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableCert2LastRotated(v *time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetCert2LastRotated(*v)
+	}
+	return _u
+}
+
+// ClearCert2LastRotated clears the value of the "cert_2_last_rotated" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) ClearCert2LastRotated() *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearCert2LastRotated()
+	return _u
+}
+
+// SetReportGeneratedAt sets the "report_generated_at" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetReportGeneratedAt(v time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetReportGeneratedAt(v)
+	return _u
+}
+
+// SetNillableReportGeneratedAt sets the "report_generated_at" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableReportGeneratedAt(v *time.Time) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetReportGeneratedAt(*v)
+	}
+	return _u
+}
+
+// ClearReportGeneratedAt clears the value of the "report_generated_at" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) ClearReportGeneratedAt() *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearReportGeneratedAt()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetAccountID(v string) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SetNillableAccountID(v *string) *BronzeAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSIAMCredentialReportMutation object of the builder.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) Mutation() *BronzeAWSIAMCredentialReportMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeAWSIAMCredentialReportUpdate builder.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) Where(ps ...predicate.BronzeAWSIAMCredentialReport) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) Select(field string, fields ...string) *BronzeAWSIAMCredentialReportUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeAWSIAMCredentialReport entity.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) Save(ctx context.Context) (*BronzeAWSIAMCredentialReport, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) SaveX(ctx context.Context) *BronzeAWSIAMCredentialReport {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) check() error {
+	if v, ok := _u.mutation.User(); ok {
+		if err := bronzeawsiamcredentialreport.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMCredentialReport.user": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsiamcredentialreport.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMCredentialReport.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSIAMCredentialReportUpdateOne) sqlSave(ctx context.Context) (_node *BronzeAWSIAMCredentialReport, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsiamcredentialreport.Table, bronzeawsiamcredentialreport.Columns, sqlgraph.NewFieldSpec(bronzeawsiamcredentialreport.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`iam: missing "BronzeAWSIAMCredentialReport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawsiamcredentialreport.FieldID)
+		for _, f := range fields {
+			if !bronzeawsiamcredentialreport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("iam: invalid field %q for query", f)}
+			}
+			if f != bronzeawsiamcredentialreport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.User(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldUser, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserCreationTime(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldUserCreationTime, field.TypeTime, value)
+	}
+	if _u.mutation.UserCreationTimeCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldUserCreationTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PasswordEnabled(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldPasswordEnabled, field.TypeString, value)
+	}
+	if _u.mutation.PasswordEnabledCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldPasswordEnabled, field.TypeString)
+	}
+	if value, ok := _u.mutation.PasswordLastUsed(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldPasswordLastUsed, field.TypeTime, value)
+	}
+	if _u.mutation.PasswordLastUsedCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldPasswordLastUsed, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PasswordLastChanged(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldPasswordLastChanged, field.TypeTime, value)
+	}
+	if _u.mutation.PasswordLastChangedCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldPasswordLastChanged, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PasswordNextRotation(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldPasswordNextRotation, field.TypeTime, value)
+	}
+	if _u.mutation.PasswordNextRotationCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldPasswordNextRotation, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MfaActive(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldMfaActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccessKey1Active(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey1Active, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccessKey1LastRotated(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey1LastRotated, field.TypeTime, value)
+	}
+	if _u.mutation.AccessKey1LastRotatedCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldAccessKey1LastRotated, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccessKey1LastUsedDate(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey1LastUsedDate, field.TypeTime, value)
+	}
+	if _u.mutation.AccessKey1LastUsedDateCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldAccessKey1LastUsedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccessKey1LastUsedRegion(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey1LastUsedRegion, field.TypeString, value)
+	}
+	if _u.mutation.AccessKey1LastUsedRegionCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldAccessKey1LastUsedRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccessKey1LastUsedService(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey1LastUsedService, field.TypeString, value)
+	}
+	if _u.mutation.AccessKey1LastUsedServiceCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldAccessKey1LastUsedService, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccessKey2Active(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey2Active, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccessKey2LastRotated(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey2LastRotated, field.TypeTime, value)
+	}
+	if _u.mutation.AccessKey2LastRotatedCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldAccessKey2LastRotated, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccessKey2LastUsedDate(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey2LastUsedDate, field.TypeTime, value)
+	}
+	if _u.mutation.AccessKey2LastUsedDateCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldAccessKey2LastUsedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccessKey2LastUsedRegion(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey2LastUsedRegion, field.TypeString, value)
+	}
+	if _u.mutation.AccessKey2LastUsedRegionCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldAccessKey2LastUsedRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccessKey2LastUsedService(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccessKey2LastUsedService, field.TypeString, value)
+	}
+	if _u.mutation.AccessKey2LastUsedServiceCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldAccessKey2LastUsedService, field.TypeString)
+	}
+	if value, ok := _u.mutation.Cert1Active(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldCert1Active, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Cert1LastRotated(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldCert1LastRotated, field.TypeTime, value)
+	}
+	if _u.mutation.Cert1LastRotatedCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldCert1LastRotated, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Cert2Active(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldCert2Active, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Cert2LastRotated(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldCert2LastRotated, field.TypeTime, value)
+	}
+	if _u.mutation.Cert2LastRotatedCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldCert2LastRotated, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReportGeneratedAt(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldReportGeneratedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReportGeneratedAtCleared() {
+		_spec.ClearField(bronzeawsiamcredentialreport.FieldReportGeneratedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsiamcredentialreport.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSIAMCredentialReport
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeAWSIAMCredentialReport{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsiamcredentialreport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
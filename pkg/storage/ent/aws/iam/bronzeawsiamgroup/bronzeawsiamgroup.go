@@ -0,0 +1,110 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawsiamgroup
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzeawsiamgroup type in the database.
+	Label = "bronze_awsiam_group"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldArn holds the string denoting the arn field in the database.
+	FieldArn = "arn"
+	// FieldGroupName holds the string denoting the group_name field in the database.
+	FieldGroupName = "group_name"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
+	// FieldCreateDate holds the string denoting the create_date field in the database.
+	FieldCreateDate = "create_date"
+	// FieldAttachedPoliciesJSON holds the string denoting the attached_policies_json field in the database.
+	FieldAttachedPoliciesJSON = "attached_policies_json"
+	// FieldInlinePoliciesJSON holds the string denoting the inline_policies_json field in the database.
+	FieldInlinePoliciesJSON = "inline_policies_json"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// Table holds the table name of the bronzeawsiamgroup in the database.
+	Table = "aws_iam_groups"
+)
+
+// Columns holds all SQL columns for bronzeawsiamgroup fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldArn,
+	FieldGroupName,
+	FieldPath,
+	FieldCreateDate,
+	FieldAttachedPoliciesJSON,
+	FieldInlinePoliciesJSON,
+	FieldAccountID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ArnValidator is a validator for the "arn" field. It is called by the builders before save.
+	ArnValidator func(string) error
+	// GroupNameValidator is a validator for the "group_name" field. It is called by the builders before save.
+	GroupNameValidator func(string) error
+	// AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	AccountIDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BronzeAWSIAMGroup queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByArn orders the results by the arn field.
+func ByArn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArn, opts...).ToFunc()
+}
+
+// ByGroupName orders the results by the group_name field.
+func ByGroupName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupName, opts...).ToFunc()
+}
+
+// ByPath orders the results by the path field.
+func ByPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPath, opts...).ToFunc()
+}
+
+// ByCreateDate orders the results by the create_date field.
+func ByCreateDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateDate, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
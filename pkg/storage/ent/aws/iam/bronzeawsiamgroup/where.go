@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawsiamgroup
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// Arn applies equality check predicate on the "arn" field. It's identical to ArnEQ.
+func Arn(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEQ(FieldArn, v))
+}
+
+// GroupName applies equality check predicate on the "group_name" field. It's identical to GroupNameEQ.
+func GroupName(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEQ(FieldGroupName, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEQ(FieldPath, v))
+}
+
+// CreateDate applies equality check predicate on the "create_date" field. It's identical to CreateDateEQ.
+func CreateDate(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEQ(FieldCreateDate, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEQ(FieldAccountID, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ArnEQ applies the EQ predicate on the "arn" field.
+func ArnEQ(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEQ(FieldArn, v))
+}
+
+// ArnNEQ applies the NEQ predicate on the "arn" field.
+func ArnNEQ(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNEQ(FieldArn, v))
+}
+
+// ArnIn applies the In predicate on the "arn" field.
+func ArnIn(vs ...string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldIn(FieldArn, vs...))
+}
+
+// ArnNotIn applies the NotIn predicate on the "arn" field.
+func ArnNotIn(vs ...string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNotIn(FieldArn, vs...))
+}
+
+// ArnGT applies the GT predicate on the "arn" field.
+func ArnGT(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldGT(FieldArn, v))
+}
+
+// ArnGTE applies the GTE predicate on the "arn" field.
+func ArnGTE(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldGTE(FieldArn, v))
+}
+
+// ArnLT applies the LT predicate on the "arn" field.
+func ArnLT(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldLT(FieldArn, v))
+}
+
+// ArnLTE applies the LTE predicate on the "arn" field.
+func ArnLTE(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldLTE(FieldArn, v))
+}
+
+// ArnContains applies the Contains predicate on the "arn" field.
+func ArnContains(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldContains(FieldArn, v))
+}
+
+// ArnHasPrefix applies the HasPrefix predicate on the "arn" field.
+func ArnHasPrefix(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldHasPrefix(FieldArn, v))
+}
+
+// ArnHasSuffix applies the HasSuffix predicate on the "arn" field.
+func ArnHasSuffix(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldHasSuffix(FieldArn, v))
+}
+
+// ArnEqualFold applies the EqualFold predicate on the "arn" field.
+func ArnEqualFold(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEqualFold(FieldArn, v))
+}
+
+// ArnContainsFold applies the ContainsFold predicate on the "arn" field.
+func ArnContainsFold(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldContainsFold(FieldArn, v))
+}
+
+// GroupNameEQ applies the EQ predicate on the "group_name" field.
+func GroupNameEQ(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEQ(FieldGroupName, v))
+}
+
+// GroupNameNEQ applies the NEQ predicate on the "group_name" field.
+func GroupNameNEQ(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNEQ(FieldGroupName, v))
+}
+
+// GroupNameIn applies the In predicate on the "group_name" field.
+func GroupNameIn(vs ...string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldIn(FieldGroupName, vs...))
+}
+
+// GroupNameNotIn applies the NotIn predicate on the "group_name" field.
+func GroupNameNotIn(vs ...string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNotIn(FieldGroupName, vs...))
+}
+
+// GroupNameGT applies the GT predicate on the "group_name" field.
+func GroupNameGT(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldGT(FieldGroupName, v))
+}
+
+// GroupNameGTE applies the GTE predicate on the "group_name" field.
+func GroupNameGTE(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldGTE(FieldGroupName, v))
+}
+
+// GroupNameLT applies the LT predicate on the "group_name" field.
+func GroupNameLT(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldLT(FieldGroupName, v))
+}
+
+// GroupNameLTE applies the LTE predicate on the "group_name" field.
+func GroupNameLTE(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldLTE(FieldGroupName, v))
+}
+
+// GroupNameContains applies the Contains predicate on the "group_name" field.
+func GroupNameContains(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldContains(FieldGroupName, v))
+}
+
+// GroupNameHasPrefix applies the HasPrefix predicate on the "group_name" field.
+func GroupNameHasPrefix(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldHasPrefix(FieldGroupName, v))
+}
+
+// GroupNameHasSuffix applies the HasSuffix predicate on the "group_name" field.
+func GroupNameHasSuffix(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldHasSuffix(FieldGroupName, v))
+}
+
+// GroupNameEqualFold applies the EqualFold predicate on the "group_name" field.
+func GroupNameEqualFold(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEqualFold(FieldGroupName, v))
+}
+
+// GroupNameContainsFold applies the ContainsFold predicate on the "group_name" field.
+func GroupNameContainsFold(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldContainsFold(FieldGroupName, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathIsNil applies the IsNil predicate on the "path" field.
+func PathIsNil() predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldIsNull(FieldPath))
+}
+
+// PathNotNil applies the NotNil predicate on the "path" field.
+func PathNotNil() predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNotNull(FieldPath))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldContainsFold(FieldPath, v))
+}
+
+// CreateDateEQ applies the EQ predicate on the "create_date" field.
+func CreateDateEQ(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEQ(FieldCreateDate, v))
+}
+
+// CreateDateNEQ applies the NEQ predicate on the "create_date" field.
+func CreateDateNEQ(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNEQ(FieldCreateDate, v))
+}
+
+// CreateDateIn applies the In predicate on the "create_date" field.
+func CreateDateIn(vs ...time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldIn(FieldCreateDate, vs...))
+}
+
+// CreateDateNotIn applies the NotIn predicate on the "create_date" field.
+func CreateDateNotIn(vs ...time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNotIn(FieldCreateDate, vs...))
+}
+
+// CreateDateGT applies the GT predicate on the "create_date" field.
+func CreateDateGT(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldGT(FieldCreateDate, v))
+}
+
+// CreateDateGTE applies the GTE predicate on the "create_date" field.
+func CreateDateGTE(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldGTE(FieldCreateDate, v))
+}
+
+// CreateDateLT applies the LT predicate on the "create_date" field.
+func CreateDateLT(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldLT(FieldCreateDate, v))
+}
+
+// CreateDateLTE applies the LTE predicate on the "create_date" field.
+func CreateDateLTE(v time.Time) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldLTE(FieldCreateDate, v))
+}
+
+// CreateDateIsNil applies the IsNil predicate on the "create_date" field.
+func CreateDateIsNil() predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldIsNull(FieldCreateDate))
+}
+
+// CreateDateNotNil applies the NotNil predicate on the "create_date" field.
+func CreateDateNotNil() predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNotNull(FieldCreateDate))
+}
+
+// AttachedPoliciesJSONIsNil applies the IsNil predicate on the "attached_policies_json" field.
+func AttachedPoliciesJSONIsNil() predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldIsNull(FieldAttachedPoliciesJSON))
+}
+
+// AttachedPoliciesJSONNotNil applies the NotNil predicate on the "attached_policies_json" field.
+func AttachedPoliciesJSONNotNil() predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNotNull(FieldAttachedPoliciesJSON))
+}
+
+// InlinePoliciesJSONIsNil applies the IsNil predicate on the "inline_policies_json" field.
+func InlinePoliciesJSONIsNil() predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldIsNull(FieldInlinePoliciesJSON))
+}
+
+// InlinePoliciesJSONNotNil applies the NotNil predicate on the "inline_policies_json" field.
+func InlinePoliciesJSONNotNil() predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNotNull(FieldInlinePoliciesJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeAWSIAMGroup) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeAWSIAMGroup) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeAWSIAMGroup) predicate.BronzeAWSIAMGroup {
+	return predicate.BronzeAWSIAMGroup(sql.NotPredicates(p))
+}
@@ -0,0 +1,320 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamgroup"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSIAMGroupCreate is the builder for creating a BronzeAWSIAMGroup entity.
+type BronzeAWSIAMGroupCreate struct {
+	config
+	mutation *BronzeAWSIAMGroupMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeAWSIAMGroupCreate) SetCollectedAt(v time.Time) *BronzeAWSIAMGroupCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeAWSIAMGroupCreate) SetFirstCollectedAt(v time.Time) *BronzeAWSIAMGroupCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetArn sets the "arn" field.
+func (_c *BronzeAWSIAMGroupCreate) SetArn(v string) *BronzeAWSIAMGroupCreate {
+	_c.mutation.SetArn(v)
+	return _c
+}
+
+// SetGroupName sets the "group_name" field.
+func (_c *BronzeAWSIAMGroupCreate) SetGroupName(v string) *BronzeAWSIAMGroupCreate {
+	_c.mutation.SetGroupName(v)
+	return _c
+}
+
+// SetPath sets the "path" field.
+func (_c *BronzeAWSIAMGroupCreate) SetPath(v string) *BronzeAWSIAMGroupCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_c *BronzeAWSIAMGroupCreate) SetNillablePath(v *string) *BronzeAWSIAMGroupCreate {
+	if v != nil {
+		_c.SetPath(*v)
+	}
+	return _c
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_c *BronzeAWSIAMGroupCreate) SetCreateDate(v time.Time) *BronzeAWSIAMGroupCreate {
+	_c.mutation.SetCreateDate(v)
+	return _c
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_c *BronzeAWSIAMGroupCreate) SetNillableCreateDate(v *time.Time) *BronzeAWSIAMGroupCreate {
+	if v != nil {
+		_c.SetCreateDate(*v)
+	}
+	return _c
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (_c *BronzeAWSIAMGroupCreate) SetAttachedPoliciesJSON(v json.RawMessage) *BronzeAWSIAMGroupCreate {
+	_c.mutation.SetAttachedPoliciesJSON(v)
+	return _c
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (_c *BronzeAWSIAMGroupCreate) SetInlinePoliciesJSON(v json.RawMessage) *BronzeAWSIAMGroupCreate {
+	_c.mutation.SetInlinePoliciesJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeAWSIAMGroupCreate) SetAccountID(v string) *BronzeAWSIAMGroupCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeAWSIAMGroupCreate) SetID(v string) *BronzeAWSIAMGroupCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeAWSIAMGroupMutation object of the builder.
+func (_c *BronzeAWSIAMGroupCreate) Mutation() *BronzeAWSIAMGroupMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeAWSIAMGroup in the database.
+func (_c *BronzeAWSIAMGroupCreate) Save(ctx context.Context) (*BronzeAWSIAMGroup, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeAWSIAMGroupCreate) SaveX(ctx context.Context) *BronzeAWSIAMGroup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSIAMGroupCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSIAMGroupCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeAWSIAMGroupCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`iam: missing required field "BronzeAWSIAMGroup.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`iam: missing required field "BronzeAWSIAMGroup.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.Arn(); !ok {
+		return &ValidationError{Name: "arn", err: errors.New(`iam: missing required field "BronzeAWSIAMGroup.arn"`)}
+	}
+	if v, ok := _c.mutation.Arn(); ok {
+		if err := bronzeawsiamgroup.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMGroup.arn": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GroupName(); !ok {
+		return &ValidationError{Name: "group_name", err: errors.New(`iam: missing required field "BronzeAWSIAMGroup.group_name"`)}
+	}
+	if v, ok := _c.mutation.GroupName(); ok {
+		if err := bronzeawsiamgroup.GroupNameValidator(v); err != nil {
+			return &ValidationError{Name: "group_name", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMGroup.group_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`iam: missing required field "BronzeAWSIAMGroup.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzeawsiamgroup.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMGroup.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeAWSIAMGroupCreate) sqlSave(ctx context.Context) (*BronzeAWSIAMGroup, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeAWSIAMGroup.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeAWSIAMGroupCreate) createSpec() (*BronzeAWSIAMGroup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeAWSIAMGroup{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzeawsiamgroup.Table, sqlgraph.NewFieldSpec(bronzeawsiamgroup.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeAWSIAMGroup
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.Arn(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldArn, field.TypeString, value)
+		_node.Arn = value
+	}
+	if value, ok := _c.mutation.GroupName(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldGroupName, field.TypeString, value)
+		_node.GroupName = value
+	}
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := _c.mutation.CreateDate(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldCreateDate, field.TypeTime, value)
+		_node.CreateDate = &value
+	}
+	if value, ok := _c.mutation.AttachedPoliciesJSON(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldAttachedPoliciesJSON, field.TypeJSON, value)
+		_node.AttachedPoliciesJSON = value
+	}
+	if value, ok := _c.mutation.InlinePoliciesJSON(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldInlinePoliciesJSON, field.TypeJSON, value)
+		_node.InlinePoliciesJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	return _node, _spec
+}
+
+// BronzeAWSIAMGroupCreateBulk is the builder for creating many BronzeAWSIAMGroup entities in bulk.
+type BronzeAWSIAMGroupCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeAWSIAMGroupCreate
+}
+
+// Save creates the BronzeAWSIAMGroup entities in the database.
+func (_c *BronzeAWSIAMGroupCreateBulk) Save(ctx context.Context) ([]*BronzeAWSIAMGroup, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeAWSIAMGroup, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeAWSIAMGroupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeAWSIAMGroupCreateBulk) SaveX(ctx context.Context) []*BronzeAWSIAMGroup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSIAMGroupCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSIAMGroupCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
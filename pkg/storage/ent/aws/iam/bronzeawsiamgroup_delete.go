@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamgroup"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSIAMGroupDelete is the builder for deleting a BronzeAWSIAMGroup entity.
+type BronzeAWSIAMGroupDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSIAMGroupMutation
+}
+
+// Where appends a list predicates to the BronzeAWSIAMGroupDelete builder.
+func (_d *BronzeAWSIAMGroupDelete) Where(ps ...predicate.BronzeAWSIAMGroup) *BronzeAWSIAMGroupDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeAWSIAMGroupDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeAWSIAMGroupDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeAWSIAMGroupDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzeawsiamgroup.Table, sqlgraph.NewFieldSpec(bronzeawsiamgroup.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.BronzeAWSIAMGroup
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeAWSIAMGroupDeleteOne is the builder for deleting a single BronzeAWSIAMGroup entity.
+type BronzeAWSIAMGroupDeleteOne struct {
+	_d *BronzeAWSIAMGroupDelete
+}
+
+// Where appends a list predicates to the BronzeAWSIAMGroupDelete builder.
+func (_d *BronzeAWSIAMGroupDeleteOne) Where(ps ...predicate.BronzeAWSIAMGroup) *BronzeAWSIAMGroupDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeAWSIAMGroupDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzeawsiamgroup.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeAWSIAMGroupDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,585 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamgroup"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSIAMGroupUpdate is the builder for updating BronzeAWSIAMGroup entities.
+type BronzeAWSIAMGroupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSIAMGroupMutation
+}
+
+// Where appends a list predicates to the BronzeAWSIAMGroupUpdate builder.
+func (_u *BronzeAWSIAMGroupUpdate) Where(ps ...predicate.BronzeAWSIAMGroup) *BronzeAWSIAMGroupUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSIAMGroupUpdate) SetCollectedAt(v time.Time) *BronzeAWSIAMGroupUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSIAMGroupUpdate) SetNillableCollectedAt(v *time.Time) *BronzeAWSIAMGroupUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeAWSIAMGroupUpdate) SetArn(v string) *BronzeAWSIAMGroupUpdate {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeAWSIAMGroupUpdate) SetNillableArn(v *string) *BronzeAWSIAMGroupUpdate {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// SetGroupName sets the "group_name" field.
+func (_u *BronzeAWSIAMGroupUpdate) SetGroupName(v string) *BronzeAWSIAMGroupUpdate {
+	_u.mutation.SetGroupName(v)
+	return _u
+}
+
+// SetNillableGroupName sets the "group_name" field if the given value is not nil.
+func (_u *BronzeAWSIAMGroupUpdate) SetNillableGroupName(v *string) *BronzeAWSIAMGroupUpdate {
+	if v != nil {
+		_u.SetGroupName(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *BronzeAWSIAMGroupUpdate) SetPath(v string) *BronzeAWSIAMGroupUpdate {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BronzeAWSIAMGroupUpdate) SetNillablePath(v *string) *BronzeAWSIAMGroupUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// ClearPath clears the value of the "path" field.
+func (_u *BronzeAWSIAMGroupUpdate) ClearPath() *BronzeAWSIAMGroupUpdate {
+	_u.mutation.ClearPath()
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeAWSIAMGroupUpdate) SetCreateDate(v time.Time) *BronzeAWSIAMGroupUpdate {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMGroupUpdate) SetNillableCreateDate(v *time.Time) *BronzeAWSIAMGroupUpdate {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeAWSIAMGroupUpdate) ClearCreateDate() *BronzeAWSIAMGroupUpdate {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (_u *BronzeAWSIAMGroupUpdate) SetAttachedPoliciesJSON(v json.RawMessage) *BronzeAWSIAMGroupUpdate {
+	_u.mutation.SetAttachedPoliciesJSON(v)
+	return _u
+}
+
+// AppendAttachedPoliciesJSON appends value to the "attached_policies_json" field.
+func (_u *BronzeAWSIAMGroupUpdate) AppendAttachedPoliciesJSON(v json.RawMessage) *BronzeAWSIAMGroupUpdate {
+	_u.mutation.AppendAttachedPoliciesJSON(v)
+	return _u
+}
+
+// ClearAttachedPoliciesJSON clears the value of the "attached_policies_json" field.
+func (_u *BronzeAWSIAMGroupUpdate) ClearAttachedPoliciesJSON() *BronzeAWSIAMGroupUpdate {
+	_u.mutation.ClearAttachedPoliciesJSON()
+	return _u
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (_u *BronzeAWSIAMGroupUpdate) SetInlinePoliciesJSON(v json.RawMessage) *BronzeAWSIAMGroupUpdate {
+	_u.mutation.SetInlinePoliciesJSON(v)
+	return _u
+}
+
+// AppendInlinePoliciesJSON appends value to the "inline_policies_json" field.
+func (_u *BronzeAWSIAMGroupUpdate) AppendInlinePoliciesJSON(v json.RawMessage) *BronzeAWSIAMGroupUpdate {
+	_u.mutation.AppendInlinePoliciesJSON(v)
+	return _u
+}
+
+// ClearInlinePoliciesJSON clears the value of the "inline_policies_json" field.
+func (_u *BronzeAWSIAMGroupUpdate) ClearInlinePoliciesJSON() *BronzeAWSIAMGroupUpdate {
+	_u.mutation.ClearInlinePoliciesJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSIAMGroupUpdate) SetAccountID(v string) *BronzeAWSIAMGroupUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSIAMGroupUpdate) SetNillableAccountID(v *string) *BronzeAWSIAMGroupUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSIAMGroupMutation object of the builder.
+func (_u *BronzeAWSIAMGroupUpdate) Mutation() *BronzeAWSIAMGroupMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeAWSIAMGroupUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSIAMGroupUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeAWSIAMGroupUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSIAMGroupUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSIAMGroupUpdate) check() error {
+	if v, ok := _u.mutation.Arn(); ok {
+		if err := bronzeawsiamgroup.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMGroup.arn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupName(); ok {
+		if err := bronzeawsiamgroup.GroupNameValidator(v); err != nil {
+			return &ValidationError{Name: "group_name", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMGroup.group_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsiamgroup.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMGroup.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSIAMGroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsiamgroup.Table, bronzeawsiamgroup.Columns, sqlgraph.NewFieldSpec(bronzeawsiamgroup.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldArn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GroupName(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldGroupName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldPath, field.TypeString, value)
+	}
+	if _u.mutation.PathCleared() {
+		_spec.ClearField(bronzeawsiamgroup.FieldPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzeawsiamgroup.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AttachedPoliciesJSON(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldAttachedPoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttachedPoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamgroup.FieldAttachedPoliciesJSON, value)
+		})
+	}
+	if _u.mutation.AttachedPoliciesJSONCleared() {
+		_spec.ClearField(bronzeawsiamgroup.FieldAttachedPoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InlinePoliciesJSON(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldInlinePoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedInlinePoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamgroup.FieldInlinePoliciesJSON, value)
+		})
+	}
+	if _u.mutation.InlinePoliciesJSONCleared() {
+		_spec.ClearField(bronzeawsiamgroup.FieldInlinePoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSIAMGroup
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsiamgroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeAWSIAMGroupUpdateOne is the builder for updating a single BronzeAWSIAMGroup entity.
+type BronzeAWSIAMGroupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeAWSIAMGroupMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSIAMGroupUpdateOne) SetCollectedAt(v time.Time) *BronzeAWSIAMGroupUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSIAMGroupUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeAWSIAMGroupUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeAWSIAMGroupUpdateOne) SetArn(v string) *BronzeAWSIAMGroupUpdateOne {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeAWSIAMGroupUpdateOne) SetNillableArn(v *string) *BronzeAWSIAMGroupUpdateOne {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// SetGroupName sets the "group_name" field.
+func (_u *BronzeAWSIAMGroupUpdateOne) SetGroupName(v string) *BronzeAWSIAMGroupUpdateOne {
+	_u.mutation.SetGroupName(v)
+	return _u
+}
+
+// SetNillableGroupName sets the "group_name" field if the given value is not nil.
+func (_u *BronzeAWSIAMGroupUpdateOne) SetNillableGroupName(v *string) *BronzeAWSIAMGroupUpdateOne {
+	if v != nil {
+		_u.SetGroupName(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *BronzeAWSIAMGroupUpdateOne) SetPath(v string) *BronzeAWSIAMGroupUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BronzeAWSIAMGroupUpdateOne) SetNillablePath(v *string) *BronzeAWSIAMGroupUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// ClearPath clears the value of the "path" field.
+func (_u *BronzeAWSIAMGroupUpdateOne) ClearPath() *BronzeAWSIAMGroupUpdateOne {
+	_u.mutation.ClearPath()
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeAWSIAMGroupUpdateOne) SetCreateDate(v time.Time) *BronzeAWSIAMGroupUpdateOne {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMGroupUpdateOne) SetNillableCreateDate(v *time.Time) *BronzeAWSIAMGroupUpdateOne {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeAWSIAMGroupUpdateOne) ClearCreateDate() *BronzeAWSIAMGroupUpdateOne {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (_u *BronzeAWSIAMGroupUpdateOne) SetAttachedPoliciesJSON(v json.RawMessage) *BronzeAWSIAMGroupUpdateOne {
+	_u.mutation.SetAttachedPoliciesJSON(v)
+	return _u
+}
+
+// AppendAttachedPoliciesJSON appends value to the "attached_policies_json" field.
+func (_u *BronzeAWSIAMGroupUpdateOne) AppendAttachedPoliciesJSON(v json.RawMessage) *BronzeAWSIAMGroupUpdateOne {
+	_u.mutation.AppendAttachedPoliciesJSON(v)
+	return _u
+}
+
+// ClearAttachedPoliciesJSON clears the value of the "attached_policies_json" field.
+func (_u *BronzeAWSIAMGroupUpdateOne) ClearAttachedPoliciesJSON() *BronzeAWSIAMGroupUpdateOne {
+	_u.mutation.ClearAttachedPoliciesJSON()
+	return _u
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (_u *BronzeAWSIAMGroupUpdateOne) SetInlinePoliciesJSON(v json.RawMessage) *BronzeAWSIAMGroupUpdateOne {
+	_u.mutation.SetInlinePoliciesJSON(v)
+	return _u
+}
+
+// AppendInlinePoliciesJSON appends value to the "inline_policies_json" field.
+func (_u *BronzeAWSIAMGroupUpdateOne) AppendInlinePoliciesJSON(v json.RawMessage) *BronzeAWSIAMGroupUpdateOne {
+	_u.mutation.AppendInlinePoliciesJSON(v)
+	return _u
+}
+
+// ClearInlinePoliciesJSON clears the value of the "inline_policies_json" field.
+func (_u *BronzeAWSIAMGroupUpdateOne) ClearInlinePoliciesJSON() *BronzeAWSIAMGroupUpdateOne {
+	_u.mutation.ClearInlinePoliciesJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSIAMGroupUpdateOne) SetAccountID(v string) *BronzeAWSIAMGroupUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSIAMGroupUpdateOne) SetNillableAccountID(v *string) *BronzeAWSIAMGroupUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSIAMGroupMutation object of the builder.
+func (_u *BronzeAWSIAMGroupUpdateOne) Mutation() *BronzeAWSIAMGroupMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeAWSIAMGroupUpdate builder.
+func (_u *BronzeAWSIAMGroupUpdateOne) Where(ps ...predicate.BronzeAWSIAMGroup) *BronzeAWSIAMGroupUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeAWSIAMGroupUpdateOne) Select(field string, fields ...string) *BronzeAWSIAMGroupUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeAWSIAMGroup entity.
+func (_u *BronzeAWSIAMGroupUpdateOne) Save(ctx context.Context) (*BronzeAWSIAMGroup, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSIAMGroupUpdateOne) SaveX(ctx context.Context) *BronzeAWSIAMGroup {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeAWSIAMGroupUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSIAMGroupUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSIAMGroupUpdateOne) check() error {
+	if v, ok := _u.mutation.Arn(); ok {
+		if err := bronzeawsiamgroup.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMGroup.arn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupName(); ok {
+		if err := bronzeawsiamgroup.GroupNameValidator(v); err != nil {
+			return &ValidationError{Name: "group_name", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMGroup.group_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsiamgroup.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMGroup.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSIAMGroupUpdateOne) sqlSave(ctx context.Context) (_node *BronzeAWSIAMGroup, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsiamgroup.Table, bronzeawsiamgroup.Columns, sqlgraph.NewFieldSpec(bronzeawsiamgroup.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`iam: missing "BronzeAWSIAMGroup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawsiamgroup.FieldID)
+		for _, f := range fields {
+			if !bronzeawsiamgroup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("iam: invalid field %q for query", f)}
+			}
+			if f != bronzeawsiamgroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldArn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GroupName(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldGroupName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldPath, field.TypeString, value)
+	}
+	if _u.mutation.PathCleared() {
+		_spec.ClearField(bronzeawsiamgroup.FieldPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzeawsiamgroup.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AttachedPoliciesJSON(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldAttachedPoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttachedPoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamgroup.FieldAttachedPoliciesJSON, value)
+		})
+	}
+	if _u.mutation.AttachedPoliciesJSONCleared() {
+		_spec.ClearField(bronzeawsiamgroup.FieldAttachedPoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InlinePoliciesJSON(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldInlinePoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedInlinePoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamgroup.FieldInlinePoliciesJSON, value)
+		})
+	}
+	if _u.mutation.InlinePoliciesJSONCleared() {
+		_spec.ClearField(bronzeawsiamgroup.FieldInlinePoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsiamgroup.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSIAMGroup
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeAWSIAMGroup{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsiamgroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
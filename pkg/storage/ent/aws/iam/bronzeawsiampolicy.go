@@ -0,0 +1,274 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiampolicy"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeAWSIAMPolicy is the model entity for the BronzeAWSIAMPolicy schema.
+type BronzeAWSIAMPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	// IAM policy ID (ANPA...), prefixed with "<account_id>:" for AWS managed policies; used as primary key
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Arn holds the value of the "arn" field.
+	Arn string `json:"arn,omitempty"`
+	// PolicyName holds the value of the "policy_name" field.
+	PolicyName string `json:"policy_name,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// DefaultVersionID holds the value of the "default_version_id" field.
+	DefaultVersionID string `json:"default_version_id,omitempty"`
+	// AttachmentCount holds the value of the "attachment_count" field.
+	AttachmentCount int `json:"attachment_count,omitempty"`
+	// PermissionsBoundaryUsageCount holds the value of the "permissions_boundary_usage_count" field.
+	PermissionsBoundaryUsageCount int `json:"permissions_boundary_usage_count,omitempty"`
+	// IsAttachable holds the value of the "is_attachable" field.
+	IsAttachable bool `json:"is_attachable,omitempty"`
+	// True for AWS managed policies (arn:aws:iam::aws:policy/...)
+	AWSManaged bool `json:"aws_managed,omitempty"`
+	// CreateDate holds the value of the "create_date" field.
+	CreateDate *time.Time `json:"create_date,omitempty"`
+	// UpdateDate holds the value of the "update_date" field.
+	UpdateDate *time.Time `json:"update_date,omitempty"`
+	// VersionsJSON holds the value of the "versions_json" field.
+	VersionsJSON json.RawMessage `json:"versions_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID    string `json:"account_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeAWSIAMPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsiampolicy.FieldVersionsJSON:
+			values[i] = new([]byte)
+		case bronzeawsiampolicy.FieldIsAttachable, bronzeawsiampolicy.FieldAWSManaged:
+			values[i] = new(sql.NullBool)
+		case bronzeawsiampolicy.FieldAttachmentCount, bronzeawsiampolicy.FieldPermissionsBoundaryUsageCount:
+			values[i] = new(sql.NullInt64)
+		case bronzeawsiampolicy.FieldID, bronzeawsiampolicy.FieldArn, bronzeawsiampolicy.FieldPolicyName, bronzeawsiampolicy.FieldPath, bronzeawsiampolicy.FieldDescription, bronzeawsiampolicy.FieldDefaultVersionID, bronzeawsiampolicy.FieldAccountID:
+			values[i] = new(sql.NullString)
+		case bronzeawsiampolicy.FieldCollectedAt, bronzeawsiampolicy.FieldFirstCollectedAt, bronzeawsiampolicy.FieldCreateDate, bronzeawsiampolicy.FieldUpdateDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeAWSIAMPolicy fields.
+func (_m *BronzeAWSIAMPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsiampolicy.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzeawsiampolicy.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzeawsiampolicy.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzeawsiampolicy.FieldArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field arn", values[i])
+			} else if value.Valid {
+				_m.Arn = value.String
+			}
+		case bronzeawsiampolicy.FieldPolicyName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_name", values[i])
+			} else if value.Valid {
+				_m.PolicyName = value.String
+			}
+		case bronzeawsiampolicy.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				_m.Path = value.String
+			}
+		case bronzeawsiampolicy.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case bronzeawsiampolicy.FieldDefaultVersionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field default_version_id", values[i])
+			} else if value.Valid {
+				_m.DefaultVersionID = value.String
+			}
+		case bronzeawsiampolicy.FieldAttachmentCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment_count", values[i])
+			} else if value.Valid {
+				_m.AttachmentCount = int(value.Int64)
+			}
+		case bronzeawsiampolicy.FieldPermissionsBoundaryUsageCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field permissions_boundary_usage_count", values[i])
+			} else if value.Valid {
+				_m.PermissionsBoundaryUsageCount = int(value.Int64)
+			}
+		case bronzeawsiampolicy.FieldIsAttachable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_attachable", values[i])
+			} else if value.Valid {
+				_m.IsAttachable = value.Bool
+			}
+		case bronzeawsiampolicy.FieldAWSManaged:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field aws_managed", values[i])
+			} else if value.Valid {
+				_m.AWSManaged = value.Bool
+			}
+		case bronzeawsiampolicy.FieldCreateDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_date", values[i])
+			} else if value.Valid {
+				_m.CreateDate = new(time.Time)
+				*_m.CreateDate = value.Time
+			}
+		case bronzeawsiampolicy.FieldUpdateDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_date", values[i])
+			} else if value.Valid {
+				_m.UpdateDate = new(time.Time)
+				*_m.UpdateDate = value.Time
+			}
+		case bronzeawsiampolicy.FieldVersionsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field versions_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.VersionsJSON); err != nil {
+					return fmt.Errorf("unmarshal field versions_json: %w", err)
+				}
+			}
+		case bronzeawsiampolicy.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeAWSIAMPolicy.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeAWSIAMPolicy) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeAWSIAMPolicy.
+// Note that you need to call BronzeAWSIAMPolicy.Unwrap() before calling this method if this BronzeAWSIAMPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeAWSIAMPolicy) Update() *BronzeAWSIAMPolicyUpdateOne {
+	return NewBronzeAWSIAMPolicyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeAWSIAMPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeAWSIAMPolicy) Unwrap() *BronzeAWSIAMPolicy {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("iam: BronzeAWSIAMPolicy is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeAWSIAMPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeAWSIAMPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("arn=")
+	builder.WriteString(_m.Arn)
+	builder.WriteString(", ")
+	builder.WriteString("policy_name=")
+	builder.WriteString(_m.PolicyName)
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(_m.Path)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("default_version_id=")
+	builder.WriteString(_m.DefaultVersionID)
+	builder.WriteString(", ")
+	builder.WriteString("attachment_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttachmentCount))
+	builder.WriteString(", ")
+	builder.WriteString("permissions_boundary_usage_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PermissionsBoundaryUsageCount))
+	builder.WriteString(", ")
+	builder.WriteString("is_attachable=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsAttachable))
+	builder.WriteString(", ")
+	builder.WriteString("aws_managed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AWSManaged))
+	builder.WriteString(", ")
+	if v := _m.CreateDate; v != nil {
+		builder.WriteString("create_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateDate; v != nil {
+		builder.WriteString("update_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("versions_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VersionsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeAWSIAMPolicies is a parsable slice of BronzeAWSIAMPolicy.
+type BronzeAWSIAMPolicies []*BronzeAWSIAMPolicy
@@ -0,0 +1,171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawsiampolicy
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzeawsiampolicy type in the database.
+	Label = "bronze_awsiam_policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldArn holds the string denoting the arn field in the database.
+	FieldArn = "arn"
+	// FieldPolicyName holds the string denoting the policy_name field in the database.
+	FieldPolicyName = "policy_name"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldDefaultVersionID holds the string denoting the default_version_id field in the database.
+	FieldDefaultVersionID = "default_version_id"
+	// FieldAttachmentCount holds the string denoting the attachment_count field in the database.
+	FieldAttachmentCount = "attachment_count"
+	// FieldPermissionsBoundaryUsageCount holds the string denoting the permissions_boundary_usage_count field in the database.
+	FieldPermissionsBoundaryUsageCount = "permissions_boundary_usage_count"
+	// FieldIsAttachable holds the string denoting the is_attachable field in the database.
+	FieldIsAttachable = "is_attachable"
+	// FieldAWSManaged holds the string denoting the aws_managed field in the database.
+	FieldAWSManaged = "aws_managed"
+	// FieldCreateDate holds the string denoting the create_date field in the database.
+	FieldCreateDate = "create_date"
+	// FieldUpdateDate holds the string denoting the update_date field in the database.
+	FieldUpdateDate = "update_date"
+	// FieldVersionsJSON holds the string denoting the versions_json field in the database.
+	FieldVersionsJSON = "versions_json"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// Table holds the table name of the bronzeawsiampolicy in the database.
+	Table = "aws_iam_policies"
+)
+
+// Columns holds all SQL columns for bronzeawsiampolicy fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldArn,
+	FieldPolicyName,
+	FieldPath,
+	FieldDescription,
+	FieldDefaultVersionID,
+	FieldAttachmentCount,
+	FieldPermissionsBoundaryUsageCount,
+	FieldIsAttachable,
+	FieldAWSManaged,
+	FieldCreateDate,
+	FieldUpdateDate,
+	FieldVersionsJSON,
+	FieldAccountID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ArnValidator is a validator for the "arn" field. It is called by the builders before save.
+	ArnValidator func(string) error
+	// PolicyNameValidator is a validator for the "policy_name" field. It is called by the builders before save.
+	PolicyNameValidator func(string) error
+	// DefaultAttachmentCount holds the default value on creation for the "attachment_count" field.
+	DefaultAttachmentCount int
+	// DefaultPermissionsBoundaryUsageCount holds the default value on creation for the "permissions_boundary_usage_count" field.
+	DefaultPermissionsBoundaryUsageCount int
+	// DefaultIsAttachable holds the default value on creation for the "is_attachable" field.
+	DefaultIsAttachable bool
+	// DefaultAWSManaged holds the default value on creation for the "aws_managed" field.
+	DefaultAWSManaged bool
+	// AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	AccountIDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BronzeAWSIAMPolicy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByArn orders the results by the arn field.
+func ByArn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArn, opts...).ToFunc()
+}
+
+// ByPolicyName orders the results by the policy_name field.
+func ByPolicyName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPolicyName, opts...).ToFunc()
+}
+
+// ByPath orders the results by the path field.
+func ByPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPath, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByDefaultVersionID orders the results by the default_version_id field.
+func ByDefaultVersionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultVersionID, opts...).ToFunc()
+}
+
+// ByAttachmentCount orders the results by the attachment_count field.
+func ByAttachmentCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttachmentCount, opts...).ToFunc()
+}
+
+// ByPermissionsBoundaryUsageCount orders the results by the permissions_boundary_usage_count field.
+func ByPermissionsBoundaryUsageCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPermissionsBoundaryUsageCount, opts...).ToFunc()
+}
+
+// ByIsAttachable orders the results by the is_attachable field.
+func ByIsAttachable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsAttachable, opts...).ToFunc()
+}
+
+// ByAWSManaged orders the results by the aws_managed field.
+func ByAWSManaged(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAWSManaged, opts...).ToFunc()
+}
+
+// ByCreateDate orders the results by the create_date field.
+func ByCreateDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateDate, opts...).ToFunc()
+}
+
+// ByUpdateDate orders the results by the update_date field.
+func ByUpdateDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateDate, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
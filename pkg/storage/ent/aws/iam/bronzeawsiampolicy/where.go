@@ -0,0 +1,860 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawsiampolicy
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// Arn applies equality check predicate on the "arn" field. It's identical to ArnEQ.
+func Arn(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldArn, v))
+}
+
+// PolicyName applies equality check predicate on the "policy_name" field. It's identical to PolicyNameEQ.
+func PolicyName(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldPolicyName, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldPath, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldDescription, v))
+}
+
+// DefaultVersionID applies equality check predicate on the "default_version_id" field. It's identical to DefaultVersionIDEQ.
+func DefaultVersionID(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldDefaultVersionID, v))
+}
+
+// AttachmentCount applies equality check predicate on the "attachment_count" field. It's identical to AttachmentCountEQ.
+func AttachmentCount(v int) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldAttachmentCount, v))
+}
+
+// PermissionsBoundaryUsageCount applies equality check predicate on the "permissions_boundary_usage_count" field. It's identical to PermissionsBoundaryUsageCountEQ.
+func PermissionsBoundaryUsageCount(v int) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldPermissionsBoundaryUsageCount, v))
+}
+
+// IsAttachable applies equality check predicate on the "is_attachable" field. It's identical to IsAttachableEQ.
+func IsAttachable(v bool) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldIsAttachable, v))
+}
+
+// AWSManaged applies equality check predicate on the "aws_managed" field. It's identical to AWSManagedEQ.
+func AWSManaged(v bool) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldAWSManaged, v))
+}
+
+// CreateDate applies equality check predicate on the "create_date" field. It's identical to CreateDateEQ.
+func CreateDate(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldCreateDate, v))
+}
+
+// UpdateDate applies equality check predicate on the "update_date" field. It's identical to UpdateDateEQ.
+func UpdateDate(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldUpdateDate, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldAccountID, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ArnEQ applies the EQ predicate on the "arn" field.
+func ArnEQ(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldArn, v))
+}
+
+// ArnNEQ applies the NEQ predicate on the "arn" field.
+func ArnNEQ(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNEQ(FieldArn, v))
+}
+
+// ArnIn applies the In predicate on the "arn" field.
+func ArnIn(vs ...string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldIn(FieldArn, vs...))
+}
+
+// ArnNotIn applies the NotIn predicate on the "arn" field.
+func ArnNotIn(vs ...string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNotIn(FieldArn, vs...))
+}
+
+// ArnGT applies the GT predicate on the "arn" field.
+func ArnGT(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGT(FieldArn, v))
+}
+
+// ArnGTE applies the GTE predicate on the "arn" field.
+func ArnGTE(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGTE(FieldArn, v))
+}
+
+// ArnLT applies the LT predicate on the "arn" field.
+func ArnLT(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLT(FieldArn, v))
+}
+
+// ArnLTE applies the LTE predicate on the "arn" field.
+func ArnLTE(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLTE(FieldArn, v))
+}
+
+// ArnContains applies the Contains predicate on the "arn" field.
+func ArnContains(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldContains(FieldArn, v))
+}
+
+// ArnHasPrefix applies the HasPrefix predicate on the "arn" field.
+func ArnHasPrefix(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldHasPrefix(FieldArn, v))
+}
+
+// ArnHasSuffix applies the HasSuffix predicate on the "arn" field.
+func ArnHasSuffix(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldHasSuffix(FieldArn, v))
+}
+
+// ArnEqualFold applies the EqualFold predicate on the "arn" field.
+func ArnEqualFold(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEqualFold(FieldArn, v))
+}
+
+// ArnContainsFold applies the ContainsFold predicate on the "arn" field.
+func ArnContainsFold(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldContainsFold(FieldArn, v))
+}
+
+// PolicyNameEQ applies the EQ predicate on the "policy_name" field.
+func PolicyNameEQ(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldPolicyName, v))
+}
+
+// PolicyNameNEQ applies the NEQ predicate on the "policy_name" field.
+func PolicyNameNEQ(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNEQ(FieldPolicyName, v))
+}
+
+// PolicyNameIn applies the In predicate on the "policy_name" field.
+func PolicyNameIn(vs ...string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldIn(FieldPolicyName, vs...))
+}
+
+// PolicyNameNotIn applies the NotIn predicate on the "policy_name" field.
+func PolicyNameNotIn(vs ...string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNotIn(FieldPolicyName, vs...))
+}
+
+// PolicyNameGT applies the GT predicate on the "policy_name" field.
+func PolicyNameGT(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGT(FieldPolicyName, v))
+}
+
+// PolicyNameGTE applies the GTE predicate on the "policy_name" field.
+func PolicyNameGTE(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGTE(FieldPolicyName, v))
+}
+
+// PolicyNameLT applies the LT predicate on the "policy_name" field.
+func PolicyNameLT(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLT(FieldPolicyName, v))
+}
+
+// PolicyNameLTE applies the LTE predicate on the "policy_name" field.
+func PolicyNameLTE(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLTE(FieldPolicyName, v))
+}
+
+// PolicyNameContains applies the Contains predicate on the "policy_name" field.
+func PolicyNameContains(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldContains(FieldPolicyName, v))
+}
+
+// PolicyNameHasPrefix applies the HasPrefix predicate on the "policy_name" field.
+func PolicyNameHasPrefix(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldHasPrefix(FieldPolicyName, v))
+}
+
+// PolicyNameHasSuffix applies the HasSuffix predicate on the "policy_name" field.
+func PolicyNameHasSuffix(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldHasSuffix(FieldPolicyName, v))
+}
+
+// PolicyNameEqualFold applies the EqualFold predicate on the "policy_name" field.
+func PolicyNameEqualFold(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEqualFold(FieldPolicyName, v))
+}
+
+// PolicyNameContainsFold applies the ContainsFold predicate on the "policy_name" field.
+func PolicyNameContainsFold(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldContainsFold(FieldPolicyName, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathIsNil applies the IsNil predicate on the "path" field.
+func PathIsNil() predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldIsNull(FieldPath))
+}
+
+// PathNotNil applies the NotNil predicate on the "path" field.
+func PathNotNil() predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNotNull(FieldPath))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldContainsFold(FieldPath, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// DefaultVersionIDEQ applies the EQ predicate on the "default_version_id" field.
+func DefaultVersionIDEQ(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDNEQ applies the NEQ predicate on the "default_version_id" field.
+func DefaultVersionIDNEQ(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNEQ(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDIn applies the In predicate on the "default_version_id" field.
+func DefaultVersionIDIn(vs ...string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldIn(FieldDefaultVersionID, vs...))
+}
+
+// DefaultVersionIDNotIn applies the NotIn predicate on the "default_version_id" field.
+func DefaultVersionIDNotIn(vs ...string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNotIn(FieldDefaultVersionID, vs...))
+}
+
+// DefaultVersionIDGT applies the GT predicate on the "default_version_id" field.
+func DefaultVersionIDGT(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGT(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDGTE applies the GTE predicate on the "default_version_id" field.
+func DefaultVersionIDGTE(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGTE(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDLT applies the LT predicate on the "default_version_id" field.
+func DefaultVersionIDLT(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLT(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDLTE applies the LTE predicate on the "default_version_id" field.
+func DefaultVersionIDLTE(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLTE(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDContains applies the Contains predicate on the "default_version_id" field.
+func DefaultVersionIDContains(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldContains(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDHasPrefix applies the HasPrefix predicate on the "default_version_id" field.
+func DefaultVersionIDHasPrefix(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldHasPrefix(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDHasSuffix applies the HasSuffix predicate on the "default_version_id" field.
+func DefaultVersionIDHasSuffix(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldHasSuffix(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDIsNil applies the IsNil predicate on the "default_version_id" field.
+func DefaultVersionIDIsNil() predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldIsNull(FieldDefaultVersionID))
+}
+
+// DefaultVersionIDNotNil applies the NotNil predicate on the "default_version_id" field.
+func DefaultVersionIDNotNil() predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNotNull(FieldDefaultVersionID))
+}
+
+// DefaultVersionIDEqualFold applies the EqualFold predicate on the "default_version_id" field.
+func DefaultVersionIDEqualFold(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEqualFold(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDContainsFold applies the ContainsFold predicate on the "default_version_id" field.
+func DefaultVersionIDContainsFold(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldContainsFold(FieldDefaultVersionID, v))
+}
+
+// AttachmentCountEQ applies the EQ predicate on the "attachment_count" field.
+func AttachmentCountEQ(v int) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldAttachmentCount, v))
+}
+
+// AttachmentCountNEQ applies the NEQ predicate on the "attachment_count" field.
+func AttachmentCountNEQ(v int) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNEQ(FieldAttachmentCount, v))
+}
+
+// AttachmentCountIn applies the In predicate on the "attachment_count" field.
+func AttachmentCountIn(vs ...int) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldIn(FieldAttachmentCount, vs...))
+}
+
+// AttachmentCountNotIn applies the NotIn predicate on the "attachment_count" field.
+func AttachmentCountNotIn(vs ...int) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNotIn(FieldAttachmentCount, vs...))
+}
+
+// AttachmentCountGT applies the GT predicate on the "attachment_count" field.
+func AttachmentCountGT(v int) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGT(FieldAttachmentCount, v))
+}
+
+// AttachmentCountGTE applies the GTE predicate on the "attachment_count" field.
+func AttachmentCountGTE(v int) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGTE(FieldAttachmentCount, v))
+}
+
+// AttachmentCountLT applies the LT predicate on the "attachment_count" field.
+func AttachmentCountLT(v int) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLT(FieldAttachmentCount, v))
+}
+
+// AttachmentCountLTE applies the LTE predicate on the "attachment_count" field.
+func AttachmentCountLTE(v int) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLTE(FieldAttachmentCount, v))
+}
+
+// PermissionsBoundaryUsageCountEQ applies the EQ predicate on the "permissions_boundary_usage_count" field.
+func PermissionsBoundaryUsageCountEQ(v int) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldPermissionsBoundaryUsageCount, v))
+}
+
+// PermissionsBoundaryUsageCountNEQ applies the NEQ predicate on the "permissions_boundary_usage_count" field.
+func PermissionsBoundaryUsageCountNEQ(v int) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNEQ(FieldPermissionsBoundaryUsageCount, v))
+}
+
+// PermissionsBoundaryUsageCountIn applies the In predicate on the "permissions_boundary_usage_count" field.
+func PermissionsBoundaryUsageCountIn(vs ...int) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldIn(FieldPermissionsBoundaryUsageCount, vs...))
+}
+
+// PermissionsBoundaryUsageCountNotIn applies the NotIn predicate on the "permissions_boundary_usage_count" field.
+func PermissionsBoundaryUsageCountNotIn(vs ...int) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNotIn(FieldPermissionsBoundaryUsageCount, vs...))
+}
+
+// PermissionsBoundaryUsageCountGT applies the GT predicate on the "permissions_boundary_usage_count" field.
+func PermissionsBoundaryUsageCountGT(v int) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGT(FieldPermissionsBoundaryUsageCount, v))
+}
+
+// PermissionsBoundaryUsageCountGTE applies the GTE predicate on the "permissions_boundary_usage_count" field.
+func PermissionsBoundaryUsageCountGTE(v int) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGTE(FieldPermissionsBoundaryUsageCount, v))
+}
+
+// PermissionsBoundaryUsageCountLT applies the LT predicate on the "permissions_boundary_usage_count" field.
+func PermissionsBoundaryUsageCountLT(v int) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLT(FieldPermissionsBoundaryUsageCount, v))
+}
+
+// PermissionsBoundaryUsageCountLTE applies the LTE predicate on the "permissions_boundary_usage_count" field.
+func PermissionsBoundaryUsageCountLTE(v int) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLTE(FieldPermissionsBoundaryUsageCount, v))
+}
+
+// IsAttachableEQ applies the EQ predicate on the "is_attachable" field.
+func IsAttachableEQ(v bool) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldIsAttachable, v))
+}
+
+// IsAttachableNEQ applies the NEQ predicate on the "is_attachable" field.
+func IsAttachableNEQ(v bool) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNEQ(FieldIsAttachable, v))
+}
+
+// AWSManagedEQ applies the EQ predicate on the "aws_managed" field.
+func AWSManagedEQ(v bool) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldAWSManaged, v))
+}
+
+// AWSManagedNEQ applies the NEQ predicate on the "aws_managed" field.
+func AWSManagedNEQ(v bool) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNEQ(FieldAWSManaged, v))
+}
+
+// CreateDateEQ applies the EQ predicate on the "create_date" field.
+func CreateDateEQ(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldCreateDate, v))
+}
+
+// CreateDateNEQ applies the NEQ predicate on the "create_date" field.
+func CreateDateNEQ(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNEQ(FieldCreateDate, v))
+}
+
+// CreateDateIn applies the In predicate on the "create_date" field.
+func CreateDateIn(vs ...time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldIn(FieldCreateDate, vs...))
+}
+
+// CreateDateNotIn applies the NotIn predicate on the "create_date" field.
+func CreateDateNotIn(vs ...time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNotIn(FieldCreateDate, vs...))
+}
+
+// CreateDateGT applies the GT predicate on the "create_date" field.
+func CreateDateGT(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGT(FieldCreateDate, v))
+}
+
+// CreateDateGTE applies the GTE predicate on the "create_date" field.
+func CreateDateGTE(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGTE(FieldCreateDate, v))
+}
+
+// CreateDateLT applies the LT predicate on the "create_date" field.
+func CreateDateLT(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLT(FieldCreateDate, v))
+}
+
+// CreateDateLTE applies the LTE predicate on the "create_date" field.
+func CreateDateLTE(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLTE(FieldCreateDate, v))
+}
+
+// CreateDateIsNil applies the IsNil predicate on the "create_date" field.
+func CreateDateIsNil() predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldIsNull(FieldCreateDate))
+}
+
+// CreateDateNotNil applies the NotNil predicate on the "create_date" field.
+func CreateDateNotNil() predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNotNull(FieldCreateDate))
+}
+
+// UpdateDateEQ applies the EQ predicate on the "update_date" field.
+func UpdateDateEQ(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldUpdateDate, v))
+}
+
+// UpdateDateNEQ applies the NEQ predicate on the "update_date" field.
+func UpdateDateNEQ(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNEQ(FieldUpdateDate, v))
+}
+
+// UpdateDateIn applies the In predicate on the "update_date" field.
+func UpdateDateIn(vs ...time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldIn(FieldUpdateDate, vs...))
+}
+
+// UpdateDateNotIn applies the NotIn predicate on the "update_date" field.
+func UpdateDateNotIn(vs ...time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNotIn(FieldUpdateDate, vs...))
+}
+
+// UpdateDateGT applies the GT predicate on the "update_date" field.
+func UpdateDateGT(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGT(FieldUpdateDate, v))
+}
+
+// UpdateDateGTE applies the GTE predicate on the "update_date" field.
+func UpdateDateGTE(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGTE(FieldUpdateDate, v))
+}
+
+// UpdateDateLT applies the LT predicate on the "update_date" field.
+func UpdateDateLT(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLT(FieldUpdateDate, v))
+}
+
+// UpdateDateLTE applies the LTE predicate on the "update_date" field.
+func UpdateDateLTE(v time.Time) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLTE(FieldUpdateDate, v))
+}
+
+// UpdateDateIsNil applies the IsNil predicate on the "update_date" field.
+func UpdateDateIsNil() predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldIsNull(FieldUpdateDate))
+}
+
+// UpdateDateNotNil applies the NotNil predicate on the "update_date" field.
+func UpdateDateNotNil() predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNotNull(FieldUpdateDate))
+}
+
+// VersionsJSONIsNil applies the IsNil predicate on the "versions_json" field.
+func VersionsJSONIsNil() predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldIsNull(FieldVersionsJSON))
+}
+
+// VersionsJSONNotNil applies the NotNil predicate on the "versions_json" field.
+func VersionsJSONNotNil() predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNotNull(FieldVersionsJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeAWSIAMPolicy) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeAWSIAMPolicy) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeAWSIAMPolicy) predicate.BronzeAWSIAMPolicy {
+	return predicate.BronzeAWSIAMPolicy(sql.NotPredicates(p))
+}
@@ -0,0 +1,470 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiampolicy"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSIAMPolicyCreate is the builder for creating a BronzeAWSIAMPolicy entity.
+type BronzeAWSIAMPolicyCreate struct {
+	config
+	mutation *BronzeAWSIAMPolicyMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeAWSIAMPolicyCreate) SetCollectedAt(v time.Time) *BronzeAWSIAMPolicyCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeAWSIAMPolicyCreate) SetFirstCollectedAt(v time.Time) *BronzeAWSIAMPolicyCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetArn sets the "arn" field.
+func (_c *BronzeAWSIAMPolicyCreate) SetArn(v string) *BronzeAWSIAMPolicyCreate {
+	_c.mutation.SetArn(v)
+	return _c
+}
+
+// SetPolicyName sets the "policy_name" field.
+func (_c *BronzeAWSIAMPolicyCreate) SetPolicyName(v string) *BronzeAWSIAMPolicyCreate {
+	_c.mutation.SetPolicyName(v)
+	return _c
+}
+
+// SetPath sets the "path" field.
+func (_c *BronzeAWSIAMPolicyCreate) SetPath(v string) *BronzeAWSIAMPolicyCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_c *BronzeAWSIAMPolicyCreate) SetNillablePath(v *string) *BronzeAWSIAMPolicyCreate {
+	if v != nil {
+		_c.SetPath(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BronzeAWSIAMPolicyCreate) SetDescription(v string) *BronzeAWSIAMPolicyCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BronzeAWSIAMPolicyCreate) SetNillableDescription(v *string) *BronzeAWSIAMPolicyCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetDefaultVersionID sets the "default_version_id" field.
+func (_c *BronzeAWSIAMPolicyCreate) SetDefaultVersionID(v string) *BronzeAWSIAMPolicyCreate {
+	_c.mutation.SetDefaultVersionID(v)
+	return _c
+}
+
+// SetNillableDefaultVersionID sets the "default_version_id" field if the given value is not nil.
+func (_c *BronzeAWSIAMPolicyCreate) SetNillableDefaultVersionID(v *string) *BronzeAWSIAMPolicyCreate {
+	if v != nil {
+		_c.SetDefaultVersionID(*v)
+	}
+	return _c
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (_c *BronzeAWSIAMPolicyCreate) SetAttachmentCount(v int) *BronzeAWSIAMPolicyCreate {
+	_c.mutation.SetAttachmentCount(v)
+	return _c
+}
+
+// SetNillableAttachmentCount sets the "attachment_count" field if the given value is not nil.
+func (_c *BronzeAWSIAMPolicyCreate) SetNillableAttachmentCount(v *int) *BronzeAWSIAMPolicyCreate {
+	if v != nil {
+		_c.SetAttachmentCount(*v)
+	}
+	return _c
+}
+
+// SetPermissionsBoundaryUsageCount sets the "permissions_boundary_usage_count" field.
+func (_c *BronzeAWSIAMPolicyCreate) SetPermissionsBoundaryUsageCount(v int) *BronzeAWSIAMPolicyCreate {
+	_c.mutation.SetPermissionsBoundaryUsageCount(v)
+	return _c
+}
+
+// SetNillablePermissionsBoundaryUsageCount sets the "permissions_boundary_usage_count" field if the given value is not nil.
+func (_c *BronzeAWSIAMPolicyCreate) SetNillablePermissionsBoundaryUsageCount(v *int) *BronzeAWSIAMPolicyCreate {
+	if v != nil {
+		_c.SetPermissionsBoundaryUsageCount(*v)
+	}
+	return _c
+}
+
+// SetIsAttachable sets the "is_attachable" field.
+func (_c *BronzeAWSIAMPolicyCreate) SetIsAttachable(v bool) *BronzeAWSIAMPolicyCreate {
+	_c.mutation.SetIsAttachable(v)
+	return _c
+}
+
+// SetNillableIsAttachable sets the "is_attachable" field if the given value is not nil.
+func (_c *BronzeAWSIAMPolicyCreate) SetNillableIsAttachable(v *bool) *BronzeAWSIAMPolicyCreate {
+	if v != nil {
+		_c.SetIsAttachable(*v)
+	}
+	return _c
+}
+
+// SetAWSManaged sets the "aws_managed" field.
+func (_c *BronzeAWSIAMPolicyCreate) SetAWSManaged(v bool) *BronzeAWSIAMPolicyCreate {
+	_c.mutation.SetAWSManaged(v)
+	return _c
+}
+
+// SetNillableAWSManaged sets the "aws_managed" field if the given value is not nil.
+func (_c *BronzeAWSIAMPolicyCreate) SetNillableAWSManaged(v *bool) *BronzeAWSIAMPolicyCreate {
+	if v != nil {
+		_c.SetAWSManaged(*v)
+	}
+	return _c
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_c *BronzeAWSIAMPolicyCreate) SetCreateDate(v time.Time) *BronzeAWSIAMPolicyCreate {
+	_c.mutation.SetCreateDate(v)
+	return _c
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_c *BronzeAWSIAMPolicyCreate) SetNillableCreateDate(v *time.Time) *BronzeAWSIAMPolicyCreate {
+	if v != nil {
+		_c.SetCreateDate(*v)
+	}
+	return _c
+}
+
+// SetUpdateDate sets the "update_date" field.
+func (_c *BronzeAWSIAMPolicyCreate) SetUpdateDate(v time.Time) *BronzeAWSIAMPolicyCreate {
+	_c.mutation.SetUpdateDate(v)
+	return _c
+}
+
+// SetNillableUpdateDate sets the "update_date" field if the given value is not nil.
+func (_c *BronzeAWSIAMPolicyCreate) SetNillableUpdateDate(v *time.Time) *BronzeAWSIAMPolicyCreate {
+	if v != nil {
+		_c.SetUpdateDate(*v)
+	}
+	return _c
+}
+
+// SetVersionsJSON sets the "versions_json" field.
+func (_c *BronzeAWSIAMPolicyCreate) SetVersionsJSON(v json.RawMessage) *BronzeAWSIAMPolicyCreate {
+	_c.mutation.SetVersionsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeAWSIAMPolicyCreate) SetAccountID(v string) *BronzeAWSIAMPolicyCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeAWSIAMPolicyCreate) SetID(v string) *BronzeAWSIAMPolicyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeAWSIAMPolicyMutation object of the builder.
+func (_c *BronzeAWSIAMPolicyCreate) Mutation() *BronzeAWSIAMPolicyMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeAWSIAMPolicy in the database.
+func (_c *BronzeAWSIAMPolicyCreate) Save(ctx context.Context) (*BronzeAWSIAMPolicy, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeAWSIAMPolicyCreate) SaveX(ctx context.Context) *BronzeAWSIAMPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSIAMPolicyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSIAMPolicyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeAWSIAMPolicyCreate) defaults() {
+	if _, ok := _c.mutation.AttachmentCount(); !ok {
+		v := bronzeawsiampolicy.DefaultAttachmentCount
+		_c.mutation.SetAttachmentCount(v)
+	}
+	if _, ok := _c.mutation.PermissionsBoundaryUsageCount(); !ok {
+		v := bronzeawsiampolicy.DefaultPermissionsBoundaryUsageCount
+		_c.mutation.SetPermissionsBoundaryUsageCount(v)
+	}
+	if _, ok := _c.mutation.IsAttachable(); !ok {
+		v := bronzeawsiampolicy.DefaultIsAttachable
+		_c.mutation.SetIsAttachable(v)
+	}
+	if _, ok := _c.mutation.AWSManaged(); !ok {
+		v := bronzeawsiampolicy.DefaultAWSManaged
+		_c.mutation.SetAWSManaged(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeAWSIAMPolicyCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`iam: missing required field "BronzeAWSIAMPolicy.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`iam: missing required field "BronzeAWSIAMPolicy.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.Arn(); !ok {
+		return &ValidationError{Name: "arn", err: errors.New(`iam: missing required field "BronzeAWSIAMPolicy.arn"`)}
+	}
+	if v, ok := _c.mutation.Arn(); ok {
+		if err := bronzeawsiampolicy.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMPolicy.arn": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PolicyName(); !ok {
+		return &ValidationError{Name: "policy_name", err: errors.New(`iam: missing required field "BronzeAWSIAMPolicy.policy_name"`)}
+	}
+	if v, ok := _c.mutation.PolicyName(); ok {
+		if err := bronzeawsiampolicy.PolicyNameValidator(v); err != nil {
+			return &ValidationError{Name: "policy_name", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMPolicy.policy_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AttachmentCount(); !ok {
+		return &ValidationError{Name: "attachment_count", err: errors.New(`iam: missing required field "BronzeAWSIAMPolicy.attachment_count"`)}
+	}
+	if _, ok := _c.mutation.PermissionsBoundaryUsageCount(); !ok {
+		return &ValidationError{Name: "permissions_boundary_usage_count", err: errors.New(`iam: missing required field "BronzeAWSIAMPolicy.permissions_boundary_usage_count"`)}
+	}
+	if _, ok := _c.mutation.IsAttachable(); !ok {
+		return &ValidationError{Name: "is_attachable", err: errors.New(`iam: missing required field "BronzeAWSIAMPolicy.is_attachable"`)}
+	}
+	if _, ok := _c.mutation.AWSManaged(); !ok {
+		return &ValidationError{Name: "aws_managed", err: errors.New(`iam: missing required field "BronzeAWSIAMPolicy.aws_managed"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`iam: missing required field "BronzeAWSIAMPolicy.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzeawsiampolicy.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMPolicy.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeAWSIAMPolicyCreate) sqlSave(ctx context.Context) (*BronzeAWSIAMPolicy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeAWSIAMPolicy.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeAWSIAMPolicyCreate) createSpec() (*BronzeAWSIAMPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeAWSIAMPolicy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzeawsiampolicy.Table, sqlgraph.NewFieldSpec(bronzeawsiampolicy.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeAWSIAMPolicy
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.Arn(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldArn, field.TypeString, value)
+		_node.Arn = value
+	}
+	if value, ok := _c.mutation.PolicyName(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldPolicyName, field.TypeString, value)
+		_node.PolicyName = value
+	}
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.DefaultVersionID(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldDefaultVersionID, field.TypeString, value)
+		_node.DefaultVersionID = value
+	}
+	if value, ok := _c.mutation.AttachmentCount(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldAttachmentCount, field.TypeInt, value)
+		_node.AttachmentCount = value
+	}
+	if value, ok := _c.mutation.PermissionsBoundaryUsageCount(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldPermissionsBoundaryUsageCount, field.TypeInt, value)
+		_node.PermissionsBoundaryUsageCount = value
+	}
+	if value, ok := _c.mutation.IsAttachable(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldIsAttachable, field.TypeBool, value)
+		_node.IsAttachable = value
+	}
+	if value, ok := _c.mutation.AWSManaged(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldAWSManaged, field.TypeBool, value)
+		_node.AWSManaged = value
+	}
+	if value, ok := _c.mutation.CreateDate(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldCreateDate, field.TypeTime, value)
+		_node.CreateDate = &value
+	}
+	if value, ok := _c.mutation.UpdateDate(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldUpdateDate, field.TypeTime, value)
+		_node.UpdateDate = &value
+	}
+	if value, ok := _c.mutation.VersionsJSON(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldVersionsJSON, field.TypeJSON, value)
+		_node.VersionsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	return _node, _spec
+}
+
+// BronzeAWSIAMPolicyCreateBulk is the builder for creating many BronzeAWSIAMPolicy entities in bulk.
+type BronzeAWSIAMPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeAWSIAMPolicyCreate
+}
+
+// Save creates the BronzeAWSIAMPolicy entities in the database.
+func (_c *BronzeAWSIAMPolicyCreateBulk) Save(ctx context.Context) ([]*BronzeAWSIAMPolicy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeAWSIAMPolicy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeAWSIAMPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeAWSIAMPolicyCreateBulk) SaveX(ctx context.Context) []*BronzeAWSIAMPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSIAMPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSIAMPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
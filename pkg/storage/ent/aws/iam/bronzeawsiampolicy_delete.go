@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiampolicy"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSIAMPolicyDelete is the builder for deleting a BronzeAWSIAMPolicy entity.
+type BronzeAWSIAMPolicyDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSIAMPolicyMutation
+}
+
+// Where appends a list predicates to the BronzeAWSIAMPolicyDelete builder.
+func (_d *BronzeAWSIAMPolicyDelete) Where(ps ...predicate.BronzeAWSIAMPolicy) *BronzeAWSIAMPolicyDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeAWSIAMPolicyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeAWSIAMPolicyDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeAWSIAMPolicyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzeawsiampolicy.Table, sqlgraph.NewFieldSpec(bronzeawsiampolicy.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.BronzeAWSIAMPolicy
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeAWSIAMPolicyDeleteOne is the builder for deleting a single BronzeAWSIAMPolicy entity.
+type BronzeAWSIAMPolicyDeleteOne struct {
+	_d *BronzeAWSIAMPolicyDelete
+}
+
+// Where appends a list predicates to the BronzeAWSIAMPolicyDelete builder.
+func (_d *BronzeAWSIAMPolicyDeleteOne) Where(ps ...predicate.BronzeAWSIAMPolicy) *BronzeAWSIAMPolicyDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeAWSIAMPolicyDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzeawsiampolicy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeAWSIAMPolicyDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,859 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiampolicy"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSIAMPolicyUpdate is the builder for updating BronzeAWSIAMPolicy entities.
+type BronzeAWSIAMPolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSIAMPolicyMutation
+}
+
+// Where appends a list predicates to the BronzeAWSIAMPolicyUpdate builder.
+func (_u *BronzeAWSIAMPolicyUpdate) Where(ps ...predicate.BronzeAWSIAMPolicy) *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSIAMPolicyUpdate) SetCollectedAt(v time.Time) *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdate) SetNillableCollectedAt(v *time.Time) *BronzeAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeAWSIAMPolicyUpdate) SetArn(v string) *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdate) SetNillableArn(v *string) *BronzeAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// SetPolicyName sets the "policy_name" field.
+func (_u *BronzeAWSIAMPolicyUpdate) SetPolicyName(v string) *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.SetPolicyName(v)
+	return _u
+}
+
+// SetNillablePolicyName sets the "policy_name" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdate) SetNillablePolicyName(v *string) *BronzeAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetPolicyName(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *BronzeAWSIAMPolicyUpdate) SetPath(v string) *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdate) SetNillablePath(v *string) *BronzeAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// ClearPath clears the value of the "path" field.
+func (_u *BronzeAWSIAMPolicyUpdate) ClearPath() *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.ClearPath()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeAWSIAMPolicyUpdate) SetDescription(v string) *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdate) SetNillableDescription(v *string) *BronzeAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeAWSIAMPolicyUpdate) ClearDescription() *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetDefaultVersionID sets the "default_version_id" field.
+func (_u *BronzeAWSIAMPolicyUpdate) SetDefaultVersionID(v string) *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.SetDefaultVersionID(v)
+	return _u
+}
+
+// SetNillableDefaultVersionID sets the "default_version_id" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdate) SetNillableDefaultVersionID(v *string) *BronzeAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetDefaultVersionID(*v)
+	}
+	return _u
+}
+
+// ClearDefaultVersionID clears the value of the "default_version_id" field.
+func (_u *BronzeAWSIAMPolicyUpdate) ClearDefaultVersionID() *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.ClearDefaultVersionID()
+	return _u
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (_u *BronzeAWSIAMPolicyUpdate) SetAttachmentCount(v int) *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.ResetAttachmentCount()
+	_u.mutation.SetAttachmentCount(v)
+	return _u
+}
+
+// SetNillableAttachmentCount sets the "attachment_count" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdate) SetNillableAttachmentCount(v *int) *BronzeAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetAttachmentCount(*v)
+	}
+	return _u
+}
+
+// AddAttachmentCount adds value to the "attachment_count" field.
+func (_u *BronzeAWSIAMPolicyUpdate) AddAttachmentCount(v int) *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.AddAttachmentCount(v)
+	return _u
+}
+
+// SetPermissionsBoundaryUsageCount sets the "permissions_boundary_usage_count" field.
+func (_u *BronzeAWSIAMPolicyUpdate) SetPermissionsBoundaryUsageCount(v int) *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.ResetPermissionsBoundaryUsageCount()
+	_u.mutation.SetPermissionsBoundaryUsageCount(v)
+	return _u
+}
+
+// SetNillablePermissionsBoundaryUsageCount sets the "permissions_boundary_usage_count" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdate) SetNillablePermissionsBoundaryUsageCount(v *int) *BronzeAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetPermissionsBoundaryUsageCount(*v)
+	}
+	return _u
+}
+
+// AddPermissionsBoundaryUsageCount adds value to the "permissions_boundary_usage_count" field.
+func (_u *BronzeAWSIAMPolicyUpdate) AddPermissionsBoundaryUsageCount(v int) *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.AddPermissionsBoundaryUsageCount(v)
+	return _u
+}
+
+// SetIsAttachable sets the "is_attachable" field.
+func (_u *BronzeAWSIAMPolicyUpdate) SetIsAttachable(v bool) *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.SetIsAttachable(v)
+	return _u
+}
+
+// SetNillableIsAttachable sets the "is_attachable" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdate) SetNillableIsAttachable(v *bool) *BronzeAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetIsAttachable(*v)
+	}
+	return _u
+}
+
+// SetAWSManaged sets the "aws_managed" field.
+func (_u *BronzeAWSIAMPolicyUpdate) SetAWSManaged(v bool) *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.SetAWSManaged(v)
+	return _u
+}
+
+// SetNillableAWSManaged sets the "aws_managed" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdate) SetNillableAWSManaged(v *bool) *BronzeAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetAWSManaged(*v)
+	}
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeAWSIAMPolicyUpdate) SetCreateDate(v time.Time) *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdate) SetNillableCreateDate(v *time.Time) *BronzeAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeAWSIAMPolicyUpdate) ClearCreateDate() *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetUpdateDate sets the "update_date" field.
+func (_u *BronzeAWSIAMPolicyUpdate) SetUpdateDate(v time.Time) *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.SetUpdateDate(v)
+	return _u
+}
+
+// SetNillableUpdateDate sets the "update_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdate) SetNillableUpdateDate(v *time.Time) *BronzeAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetUpdateDate(*v)
+	}
+	return _u
+}
+
+// ClearUpdateDate clears the value of the "update_date" field.
+func (_u *BronzeAWSIAMPolicyUpdate) ClearUpdateDate() *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.ClearUpdateDate()
+	return _u
+}
+
+// SetVersionsJSON sets the "versions_json" field.
+func (_u *BronzeAWSIAMPolicyUpdate) SetVersionsJSON(v json.RawMessage) *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.SetVersionsJSON(v)
+	return _u
+}
+
+// AppendVersionsJSON appends value to the "versions_json" field.
+func (_u *BronzeAWSIAMPolicyUpdate) AppendVersionsJSON(v json.RawMessage) *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.AppendVersionsJSON(v)
+	return _u
+}
+
+// ClearVersionsJSON clears the value of the "versions_json" field.
+func (_u *BronzeAWSIAMPolicyUpdate) ClearVersionsJSON() *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.ClearVersionsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSIAMPolicyUpdate) SetAccountID(v string) *BronzeAWSIAMPolicyUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdate) SetNillableAccountID(v *string) *BronzeAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSIAMPolicyMutation object of the builder.
+func (_u *BronzeAWSIAMPolicyUpdate) Mutation() *BronzeAWSIAMPolicyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeAWSIAMPolicyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSIAMPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeAWSIAMPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSIAMPolicyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSIAMPolicyUpdate) check() error {
+	if v, ok := _u.mutation.Arn(); ok {
+		if err := bronzeawsiampolicy.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMPolicy.arn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PolicyName(); ok {
+		if err := bronzeawsiampolicy.PolicyNameValidator(v); err != nil {
+			return &ValidationError{Name: "policy_name", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMPolicy.policy_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsiampolicy.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMPolicy.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSIAMPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsiampolicy.Table, bronzeawsiampolicy.Columns, sqlgraph.NewFieldSpec(bronzeawsiampolicy.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldArn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PolicyName(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldPolicyName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldPath, field.TypeString, value)
+	}
+	if _u.mutation.PathCleared() {
+		_spec.ClearField(bronzeawsiampolicy.FieldPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzeawsiampolicy.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.DefaultVersionID(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldDefaultVersionID, field.TypeString, value)
+	}
+	if _u.mutation.DefaultVersionIDCleared() {
+		_spec.ClearField(bronzeawsiampolicy.FieldDefaultVersionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentCount(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttachmentCount(); ok {
+		_spec.AddField(bronzeawsiampolicy.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PermissionsBoundaryUsageCount(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldPermissionsBoundaryUsageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPermissionsBoundaryUsageCount(); ok {
+		_spec.AddField(bronzeawsiampolicy.FieldPermissionsBoundaryUsageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsAttachable(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldIsAttachable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AWSManaged(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldAWSManaged, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzeawsiampolicy.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateDate(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldUpdateDate, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateDateCleared() {
+		_spec.ClearField(bronzeawsiampolicy.FieldUpdateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VersionsJSON(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldVersionsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVersionsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiampolicy.FieldVersionsJSON, value)
+		})
+	}
+	if _u.mutation.VersionsJSONCleared() {
+		_spec.ClearField(bronzeawsiampolicy.FieldVersionsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSIAMPolicy
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsiampolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeAWSIAMPolicyUpdateOne is the builder for updating a single BronzeAWSIAMPolicy entity.
+type BronzeAWSIAMPolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeAWSIAMPolicyMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetCollectedAt(v time.Time) *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetArn(v string) *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetNillableArn(v *string) *BronzeAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// SetPolicyName sets the "policy_name" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetPolicyName(v string) *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.SetPolicyName(v)
+	return _u
+}
+
+// SetNillablePolicyName sets the "policy_name" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetNillablePolicyName(v *string) *BronzeAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetPolicyName(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetPath(v string) *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetNillablePath(v *string) *BronzeAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// ClearPath clears the value of the "path" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) ClearPath() *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.ClearPath()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetDescription(v string) *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetNillableDescription(v *string) *BronzeAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) ClearDescription() *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetDefaultVersionID sets the "default_version_id" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetDefaultVersionID(v string) *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.SetDefaultVersionID(v)
+	return _u
+}
+
+// SetNillableDefaultVersionID sets the "default_version_id" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetNillableDefaultVersionID(v *string) *BronzeAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetDefaultVersionID(*v)
+	}
+	return _u
+}
+
+// ClearDefaultVersionID clears the value of the "default_version_id" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) ClearDefaultVersionID() *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.ClearDefaultVersionID()
+	return _u
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetAttachmentCount(v int) *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.ResetAttachmentCount()
+	_u.mutation.SetAttachmentCount(v)
+	return _u
+}
+
+// SetNillableAttachmentCount sets the "attachment_count" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetNillableAttachmentCount(v *int) *BronzeAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetAttachmentCount(*v)
+	}
+	return _u
+}
+
+// AddAttachmentCount adds value to the "attachment_count" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) AddAttachmentCount(v int) *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.AddAttachmentCount(v)
+	return _u
+}
+
+// SetPermissionsBoundaryUsageCount sets the "permissions_boundary_usage_count" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetPermissionsBoundaryUsageCount(v int) *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.ResetPermissionsBoundaryUsageCount()
+	_u.mutation.SetPermissionsBoundaryUsageCount(v)
+	return _u
+}
+
+// SetNillablePermissionsBoundaryUsageCount sets the "permissions_boundary_usage_count" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetNillablePermissionsBoundaryUsageCount(v *int) *BronzeAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetPermissionsBoundaryUsageCount(*v)
+	}
+	return _u
+}
+
+// AddPermissionsBoundaryUsageCount adds value to the "permissions_boundary_usage_count" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) AddPermissionsBoundaryUsageCount(v int) *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.AddPermissionsBoundaryUsageCount(v)
+	return _u
+}
+
+// SetIsAttachable sets the "is_attachable" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetIsAttachable(v bool) *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.SetIsAttachable(v)
+	return _u
+}
+
+// SetNillableIsAttachable sets the "is_attachable" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetNillableIsAttachable(v *bool) *BronzeAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetIsAttachable(*v)
+	}
+	return _u
+}
+
+// SetAWSManaged sets the "aws_managed" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetAWSManaged(v bool) *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.SetAWSManaged(v)
+	return _u
+}
+
+// SetNillableAWSManaged sets the "aws_managed" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetNillableAWSManaged(v *bool) *BronzeAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetAWSManaged(*v)
+	}
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetCreateDate(v time.Time) *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetNillableCreateDate(v *time.Time) *BronzeAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) ClearCreateDate() *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetUpdateDate sets the "update_date" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetUpdateDate(v time.Time) *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.SetUpdateDate(v)
+	return _u
+}
+
+// SetNillableUpdateDate sets the "update_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetNillableUpdateDate(v *time.Time) *BronzeAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetUpdateDate(*v)
+	}
+	return _u
+}
+
+// ClearUpdateDate clears the value of the "update_date" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) ClearUpdateDate() *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.ClearUpdateDate()
+	return _u
+}
+
+// SetVersionsJSON sets the "versions_json" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetVersionsJSON(v json.RawMessage) *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.SetVersionsJSON(v)
+	return _u
+}
+
+// AppendVersionsJSON appends value to the "versions_json" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) AppendVersionsJSON(v json.RawMessage) *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.AppendVersionsJSON(v)
+	return _u
+}
+
+// ClearVersionsJSON clears the value of the "versions_json" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) ClearVersionsJSON() *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.ClearVersionsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetAccountID(v string) *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SetNillableAccountID(v *string) *BronzeAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSIAMPolicyMutation object of the builder.
+func (_u *BronzeAWSIAMPolicyUpdateOne) Mutation() *BronzeAWSIAMPolicyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeAWSIAMPolicyUpdate builder.
+func (_u *BronzeAWSIAMPolicyUpdateOne) Where(ps ...predicate.BronzeAWSIAMPolicy) *BronzeAWSIAMPolicyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeAWSIAMPolicyUpdateOne) Select(field string, fields ...string) *BronzeAWSIAMPolicyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeAWSIAMPolicy entity.
+func (_u *BronzeAWSIAMPolicyUpdateOne) Save(ctx context.Context) (*BronzeAWSIAMPolicy, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSIAMPolicyUpdateOne) SaveX(ctx context.Context) *BronzeAWSIAMPolicy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeAWSIAMPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSIAMPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSIAMPolicyUpdateOne) check() error {
+	if v, ok := _u.mutation.Arn(); ok {
+		if err := bronzeawsiampolicy.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMPolicy.arn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PolicyName(); ok {
+		if err := bronzeawsiampolicy.PolicyNameValidator(v); err != nil {
+			return &ValidationError{Name: "policy_name", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMPolicy.policy_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsiampolicy.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMPolicy.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSIAMPolicyUpdateOne) sqlSave(ctx context.Context) (_node *BronzeAWSIAMPolicy, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsiampolicy.Table, bronzeawsiampolicy.Columns, sqlgraph.NewFieldSpec(bronzeawsiampolicy.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`iam: missing "BronzeAWSIAMPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawsiampolicy.FieldID)
+		for _, f := range fields {
+			if !bronzeawsiampolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("iam: invalid field %q for query", f)}
+			}
+			if f != bronzeawsiampolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldArn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PolicyName(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldPolicyName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldPath, field.TypeString, value)
+	}
+	if _u.mutation.PathCleared() {
+		_spec.ClearField(bronzeawsiampolicy.FieldPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzeawsiampolicy.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.DefaultVersionID(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldDefaultVersionID, field.TypeString, value)
+	}
+	if _u.mutation.DefaultVersionIDCleared() {
+		_spec.ClearField(bronzeawsiampolicy.FieldDefaultVersionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentCount(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttachmentCount(); ok {
+		_spec.AddField(bronzeawsiampolicy.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PermissionsBoundaryUsageCount(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldPermissionsBoundaryUsageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPermissionsBoundaryUsageCount(); ok {
+		_spec.AddField(bronzeawsiampolicy.FieldPermissionsBoundaryUsageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsAttachable(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldIsAttachable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AWSManaged(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldAWSManaged, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzeawsiampolicy.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateDate(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldUpdateDate, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateDateCleared() {
+		_spec.ClearField(bronzeawsiampolicy.FieldUpdateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VersionsJSON(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldVersionsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVersionsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiampolicy.FieldVersionsJSON, value)
+		})
+	}
+	if _u.mutation.VersionsJSONCleared() {
+		_spec.ClearField(bronzeawsiampolicy.FieldVersionsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsiampolicy.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSIAMPolicy
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeAWSIAMPolicy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsiampolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
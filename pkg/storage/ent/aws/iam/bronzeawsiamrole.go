@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamrole"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeAWSIAMRole is the model entity for the BronzeAWSIAMRole schema.
+type BronzeAWSIAMRole struct {
+	config `json:"-"`
+	// ID of the ent.
+	// IAM role ID (AROA...), used as primary key
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Arn holds the value of the "arn" field.
+	Arn string `json:"arn,omitempty"`
+	// RoleName holds the value of the "role_name" field.
+	RoleName string `json:"role_name,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// CreateDate holds the value of the "create_date" field.
+	CreateDate *time.Time `json:"create_date,omitempty"`
+	// RoleLastUsedDate holds the value of the "role_last_used_date" field.
+	RoleLastUsedDate *time.Time `json:"role_last_used_date,omitempty"`
+	// RoleLastUsedRegion holds the value of the "role_last_used_region" field.
+	RoleLastUsedRegion string `json:"role_last_used_region,omitempty"`
+	// PermissionsBoundaryArn holds the value of the "permissions_boundary_arn" field.
+	PermissionsBoundaryArn string `json:"permissions_boundary_arn,omitempty"`
+	// AssumeRolePolicyJSON holds the value of the "assume_role_policy_json" field.
+	AssumeRolePolicyJSON json.RawMessage `json:"assume_role_policy_json,omitempty"`
+	// AttachedPoliciesJSON holds the value of the "attached_policies_json" field.
+	AttachedPoliciesJSON json.RawMessage `json:"attached_policies_json,omitempty"`
+	// InlinePoliciesJSON holds the value of the "inline_policies_json" field.
+	InlinePoliciesJSON json.RawMessage `json:"inline_policies_json,omitempty"`
+	// InstanceProfilesJSON holds the value of the "instance_profiles_json" field.
+	InstanceProfilesJSON json.RawMessage `json:"instance_profiles_json,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID    string `json:"account_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeAWSIAMRole) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsiamrole.FieldAssumeRolePolicyJSON, bronzeawsiamrole.FieldAttachedPoliciesJSON, bronzeawsiamrole.FieldInlinePoliciesJSON, bronzeawsiamrole.FieldInstanceProfilesJSON, bronzeawsiamrole.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzeawsiamrole.FieldID, bronzeawsiamrole.FieldArn, bronzeawsiamrole.FieldRoleName, bronzeawsiamrole.FieldPath, bronzeawsiamrole.FieldRoleLastUsedRegion, bronzeawsiamrole.FieldPermissionsBoundaryArn, bronzeawsiamrole.FieldAccountID:
+			values[i] = new(sql.NullString)
+		case bronzeawsiamrole.FieldCollectedAt, bronzeawsiamrole.FieldFirstCollectedAt, bronzeawsiamrole.FieldCreateDate, bronzeawsiamrole.FieldRoleLastUsedDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeAWSIAMRole fields.
+func (_m *BronzeAWSIAMRole) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsiamrole.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzeawsiamrole.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzeawsiamrole.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzeawsiamrole.FieldArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field arn", values[i])
+			} else if value.Valid {
+				_m.Arn = value.String
+			}
+		case bronzeawsiamrole.FieldRoleName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role_name", values[i])
+			} else if value.Valid {
+				_m.RoleName = value.String
+			}
+		case bronzeawsiamrole.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				_m.Path = value.String
+			}
+		case bronzeawsiamrole.FieldCreateDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_date", values[i])
+			} else if value.Valid {
+				_m.CreateDate = new(time.Time)
+				*_m.CreateDate = value.Time
+			}
+		case bronzeawsiamrole.FieldRoleLastUsedDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field role_last_used_date", values[i])
+			} else if value.Valid {
+				_m.RoleLastUsedDate = new(time.Time)
+				*_m.RoleLastUsedDate = value.Time
+			}
+		case bronzeawsiamrole.FieldRoleLastUsedRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role_last_used_region", values[i])
+			} else if value.Valid {
+				_m.RoleLastUsedRegion = value.String
+			}
+		case bronzeawsiamrole.FieldPermissionsBoundaryArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field permissions_boundary_arn", values[i])
+			} else if value.Valid {
+				_m.PermissionsBoundaryArn = value.String
+			}
+		case bronzeawsiamrole.FieldAssumeRolePolicyJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field assume_role_policy_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AssumeRolePolicyJSON); err != nil {
+					return fmt.Errorf("unmarshal field assume_role_policy_json: %w", err)
+				}
+			}
+		case bronzeawsiamrole.FieldAttachedPoliciesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attached_policies_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AttachedPoliciesJSON); err != nil {
+					return fmt.Errorf("unmarshal field attached_policies_json: %w", err)
+				}
+			}
+		case bronzeawsiamrole.FieldInlinePoliciesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field inline_policies_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.InlinePoliciesJSON); err != nil {
+					return fmt.Errorf("unmarshal field inline_policies_json: %w", err)
+				}
+			}
+		case bronzeawsiamrole.FieldInstanceProfilesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field instance_profiles_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.InstanceProfilesJSON); err != nil {
+					return fmt.Errorf("unmarshal field instance_profiles_json: %w", err)
+				}
+			}
+		case bronzeawsiamrole.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzeawsiamrole.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeAWSIAMRole.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeAWSIAMRole) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeAWSIAMRole.
+// Note that you need to call BronzeAWSIAMRole.Unwrap() before calling this method if this BronzeAWSIAMRole
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeAWSIAMRole) Update() *BronzeAWSIAMRoleUpdateOne {
+	return NewBronzeAWSIAMRoleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeAWSIAMRole entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeAWSIAMRole) Unwrap() *BronzeAWSIAMRole {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("iam: BronzeAWSIAMRole is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeAWSIAMRole) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeAWSIAMRole(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("arn=")
+	builder.WriteString(_m.Arn)
+	builder.WriteString(", ")
+	builder.WriteString("role_name=")
+	builder.WriteString(_m.RoleName)
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(_m.Path)
+	builder.WriteString(", ")
+	if v := _m.CreateDate; v != nil {
+		builder.WriteString("create_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RoleLastUsedDate; v != nil {
+		builder.WriteString("role_last_used_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("role_last_used_region=")
+	builder.WriteString(_m.RoleLastUsedRegion)
+	builder.WriteString(", ")
+	builder.WriteString("permissions_boundary_arn=")
+	builder.WriteString(_m.PermissionsBoundaryArn)
+	builder.WriteString(", ")
+	builder.WriteString("assume_role_policy_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AssumeRolePolicyJSON))
+	builder.WriteString(", ")
+	builder.WriteString("attached_policies_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttachedPoliciesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("inline_policies_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InlinePoliciesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("instance_profiles_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InstanceProfilesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeAWSIAMRoles is a parsable slice of BronzeAWSIAMRole.
+type BronzeAWSIAMRoles []*BronzeAWSIAMRole
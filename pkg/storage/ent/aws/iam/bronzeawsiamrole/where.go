@@ -0,0 +1,780 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawsiamrole
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// Arn applies equality check predicate on the "arn" field. It's identical to ArnEQ.
+func Arn(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldArn, v))
+}
+
+// RoleName applies equality check predicate on the "role_name" field. It's identical to RoleNameEQ.
+func RoleName(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldRoleName, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldPath, v))
+}
+
+// CreateDate applies equality check predicate on the "create_date" field. It's identical to CreateDateEQ.
+func CreateDate(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldCreateDate, v))
+}
+
+// RoleLastUsedDate applies equality check predicate on the "role_last_used_date" field. It's identical to RoleLastUsedDateEQ.
+func RoleLastUsedDate(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldRoleLastUsedDate, v))
+}
+
+// RoleLastUsedRegion applies equality check predicate on the "role_last_used_region" field. It's identical to RoleLastUsedRegionEQ.
+func RoleLastUsedRegion(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldRoleLastUsedRegion, v))
+}
+
+// PermissionsBoundaryArn applies equality check predicate on the "permissions_boundary_arn" field. It's identical to PermissionsBoundaryArnEQ.
+func PermissionsBoundaryArn(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldPermissionsBoundaryArn, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldAccountID, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ArnEQ applies the EQ predicate on the "arn" field.
+func ArnEQ(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldArn, v))
+}
+
+// ArnNEQ applies the NEQ predicate on the "arn" field.
+func ArnNEQ(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNEQ(FieldArn, v))
+}
+
+// ArnIn applies the In predicate on the "arn" field.
+func ArnIn(vs ...string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIn(FieldArn, vs...))
+}
+
+// ArnNotIn applies the NotIn predicate on the "arn" field.
+func ArnNotIn(vs ...string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotIn(FieldArn, vs...))
+}
+
+// ArnGT applies the GT predicate on the "arn" field.
+func ArnGT(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGT(FieldArn, v))
+}
+
+// ArnGTE applies the GTE predicate on the "arn" field.
+func ArnGTE(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGTE(FieldArn, v))
+}
+
+// ArnLT applies the LT predicate on the "arn" field.
+func ArnLT(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLT(FieldArn, v))
+}
+
+// ArnLTE applies the LTE predicate on the "arn" field.
+func ArnLTE(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLTE(FieldArn, v))
+}
+
+// ArnContains applies the Contains predicate on the "arn" field.
+func ArnContains(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldContains(FieldArn, v))
+}
+
+// ArnHasPrefix applies the HasPrefix predicate on the "arn" field.
+func ArnHasPrefix(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldHasPrefix(FieldArn, v))
+}
+
+// ArnHasSuffix applies the HasSuffix predicate on the "arn" field.
+func ArnHasSuffix(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldHasSuffix(FieldArn, v))
+}
+
+// ArnEqualFold applies the EqualFold predicate on the "arn" field.
+func ArnEqualFold(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEqualFold(FieldArn, v))
+}
+
+// ArnContainsFold applies the ContainsFold predicate on the "arn" field.
+func ArnContainsFold(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldContainsFold(FieldArn, v))
+}
+
+// RoleNameEQ applies the EQ predicate on the "role_name" field.
+func RoleNameEQ(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldRoleName, v))
+}
+
+// RoleNameNEQ applies the NEQ predicate on the "role_name" field.
+func RoleNameNEQ(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNEQ(FieldRoleName, v))
+}
+
+// RoleNameIn applies the In predicate on the "role_name" field.
+func RoleNameIn(vs ...string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIn(FieldRoleName, vs...))
+}
+
+// RoleNameNotIn applies the NotIn predicate on the "role_name" field.
+func RoleNameNotIn(vs ...string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotIn(FieldRoleName, vs...))
+}
+
+// RoleNameGT applies the GT predicate on the "role_name" field.
+func RoleNameGT(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGT(FieldRoleName, v))
+}
+
+// RoleNameGTE applies the GTE predicate on the "role_name" field.
+func RoleNameGTE(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGTE(FieldRoleName, v))
+}
+
+// RoleNameLT applies the LT predicate on the "role_name" field.
+func RoleNameLT(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLT(FieldRoleName, v))
+}
+
+// RoleNameLTE applies the LTE predicate on the "role_name" field.
+func RoleNameLTE(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLTE(FieldRoleName, v))
+}
+
+// RoleNameContains applies the Contains predicate on the "role_name" field.
+func RoleNameContains(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldContains(FieldRoleName, v))
+}
+
+// RoleNameHasPrefix applies the HasPrefix predicate on the "role_name" field.
+func RoleNameHasPrefix(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldHasPrefix(FieldRoleName, v))
+}
+
+// RoleNameHasSuffix applies the HasSuffix predicate on the "role_name" field.
+func RoleNameHasSuffix(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldHasSuffix(FieldRoleName, v))
+}
+
+// RoleNameEqualFold applies the EqualFold predicate on the "role_name" field.
+func RoleNameEqualFold(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEqualFold(FieldRoleName, v))
+}
+
+// RoleNameContainsFold applies the ContainsFold predicate on the "role_name" field.
+func RoleNameContainsFold(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldContainsFold(FieldRoleName, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathIsNil applies the IsNil predicate on the "path" field.
+func PathIsNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIsNull(FieldPath))
+}
+
+// PathNotNil applies the NotNil predicate on the "path" field.
+func PathNotNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotNull(FieldPath))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldContainsFold(FieldPath, v))
+}
+
+// CreateDateEQ applies the EQ predicate on the "create_date" field.
+func CreateDateEQ(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldCreateDate, v))
+}
+
+// CreateDateNEQ applies the NEQ predicate on the "create_date" field.
+func CreateDateNEQ(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNEQ(FieldCreateDate, v))
+}
+
+// CreateDateIn applies the In predicate on the "create_date" field.
+func CreateDateIn(vs ...time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIn(FieldCreateDate, vs...))
+}
+
+// CreateDateNotIn applies the NotIn predicate on the "create_date" field.
+func CreateDateNotIn(vs ...time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotIn(FieldCreateDate, vs...))
+}
+
+// CreateDateGT applies the GT predicate on the "create_date" field.
+func CreateDateGT(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGT(FieldCreateDate, v))
+}
+
+// CreateDateGTE applies the GTE predicate on the "create_date" field.
+func CreateDateGTE(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGTE(FieldCreateDate, v))
+}
+
+// CreateDateLT applies the LT predicate on the "create_date" field.
+func CreateDateLT(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLT(FieldCreateDate, v))
+}
+
+// CreateDateLTE applies the LTE predicate on the "create_date" field.
+func CreateDateLTE(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLTE(FieldCreateDate, v))
+}
+
+// CreateDateIsNil applies the IsNil predicate on the "create_date" field.
+func CreateDateIsNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIsNull(FieldCreateDate))
+}
+
+// CreateDateNotNil applies the NotNil predicate on the "create_date" field.
+func CreateDateNotNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotNull(FieldCreateDate))
+}
+
+// RoleLastUsedDateEQ applies the EQ predicate on the "role_last_used_date" field.
+func RoleLastUsedDateEQ(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldRoleLastUsedDate, v))
+}
+
+// RoleLastUsedDateNEQ applies the NEQ predicate on the "role_last_used_date" field.
+func RoleLastUsedDateNEQ(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNEQ(FieldRoleLastUsedDate, v))
+}
+
+// RoleLastUsedDateIn applies the In predicate on the "role_last_used_date" field.
+func RoleLastUsedDateIn(vs ...time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIn(FieldRoleLastUsedDate, vs...))
+}
+
+// RoleLastUsedDateNotIn applies the NotIn predicate on the "role_last_used_date" field.
+func RoleLastUsedDateNotIn(vs ...time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotIn(FieldRoleLastUsedDate, vs...))
+}
+
+// RoleLastUsedDateGT applies the GT predicate on the "role_last_used_date" field.
+func RoleLastUsedDateGT(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGT(FieldRoleLastUsedDate, v))
+}
+
+// RoleLastUsedDateGTE applies the GTE predicate on the "role_last_used_date" field.
+func RoleLastUsedDateGTE(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGTE(FieldRoleLastUsedDate, v))
+}
+
+// RoleLastUsedDateLT applies the LT predicate on the "role_last_used_date" field.
+func RoleLastUsedDateLT(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLT(FieldRoleLastUsedDate, v))
+}
+
+// RoleLastUsedDateLTE applies the LTE predicate on the "role_last_used_date" field.
+func RoleLastUsedDateLTE(v time.Time) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLTE(FieldRoleLastUsedDate, v))
+}
+
+// RoleLastUsedDateIsNil applies the IsNil predicate on the "role_last_used_date" field.
+func RoleLastUsedDateIsNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIsNull(FieldRoleLastUsedDate))
+}
+
+// RoleLastUsedDateNotNil applies the NotNil predicate on the "role_last_used_date" field.
+func RoleLastUsedDateNotNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotNull(FieldRoleLastUsedDate))
+}
+
+// RoleLastUsedRegionEQ applies the EQ predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionEQ(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionNEQ applies the NEQ predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionNEQ(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNEQ(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionIn applies the In predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionIn(vs ...string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIn(FieldRoleLastUsedRegion, vs...))
+}
+
+// RoleLastUsedRegionNotIn applies the NotIn predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionNotIn(vs ...string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotIn(FieldRoleLastUsedRegion, vs...))
+}
+
+// RoleLastUsedRegionGT applies the GT predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionGT(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGT(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionGTE applies the GTE predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionGTE(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGTE(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionLT applies the LT predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionLT(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLT(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionLTE applies the LTE predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionLTE(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLTE(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionContains applies the Contains predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionContains(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldContains(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionHasPrefix applies the HasPrefix predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionHasPrefix(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldHasPrefix(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionHasSuffix applies the HasSuffix predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionHasSuffix(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldHasSuffix(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionIsNil applies the IsNil predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionIsNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIsNull(FieldRoleLastUsedRegion))
+}
+
+// RoleLastUsedRegionNotNil applies the NotNil predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionNotNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotNull(FieldRoleLastUsedRegion))
+}
+
+// RoleLastUsedRegionEqualFold applies the EqualFold predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionEqualFold(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEqualFold(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionContainsFold applies the ContainsFold predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionContainsFold(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldContainsFold(FieldRoleLastUsedRegion, v))
+}
+
+// PermissionsBoundaryArnEQ applies the EQ predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnEQ(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnNEQ applies the NEQ predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnNEQ(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNEQ(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnIn applies the In predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnIn(vs ...string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIn(FieldPermissionsBoundaryArn, vs...))
+}
+
+// PermissionsBoundaryArnNotIn applies the NotIn predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnNotIn(vs ...string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotIn(FieldPermissionsBoundaryArn, vs...))
+}
+
+// PermissionsBoundaryArnGT applies the GT predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnGT(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGT(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnGTE applies the GTE predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnGTE(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGTE(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnLT applies the LT predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnLT(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLT(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnLTE applies the LTE predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnLTE(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLTE(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnContains applies the Contains predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnContains(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldContains(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnHasPrefix applies the HasPrefix predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnHasPrefix(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldHasPrefix(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnHasSuffix applies the HasSuffix predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnHasSuffix(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldHasSuffix(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnIsNil applies the IsNil predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnIsNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIsNull(FieldPermissionsBoundaryArn))
+}
+
+// PermissionsBoundaryArnNotNil applies the NotNil predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnNotNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotNull(FieldPermissionsBoundaryArn))
+}
+
+// PermissionsBoundaryArnEqualFold applies the EqualFold predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnEqualFold(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEqualFold(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnContainsFold applies the ContainsFold predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnContainsFold(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldContainsFold(FieldPermissionsBoundaryArn, v))
+}
+
+// AssumeRolePolicyJSONIsNil applies the IsNil predicate on the "assume_role_policy_json" field.
+func AssumeRolePolicyJSONIsNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIsNull(FieldAssumeRolePolicyJSON))
+}
+
+// AssumeRolePolicyJSONNotNil applies the NotNil predicate on the "assume_role_policy_json" field.
+func AssumeRolePolicyJSONNotNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotNull(FieldAssumeRolePolicyJSON))
+}
+
+// AttachedPoliciesJSONIsNil applies the IsNil predicate on the "attached_policies_json" field.
+func AttachedPoliciesJSONIsNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIsNull(FieldAttachedPoliciesJSON))
+}
+
+// AttachedPoliciesJSONNotNil applies the NotNil predicate on the "attached_policies_json" field.
+func AttachedPoliciesJSONNotNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotNull(FieldAttachedPoliciesJSON))
+}
+
+// InlinePoliciesJSONIsNil applies the IsNil predicate on the "inline_policies_json" field.
+func InlinePoliciesJSONIsNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIsNull(FieldInlinePoliciesJSON))
+}
+
+// InlinePoliciesJSONNotNil applies the NotNil predicate on the "inline_policies_json" field.
+func InlinePoliciesJSONNotNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotNull(FieldInlinePoliciesJSON))
+}
+
+// InstanceProfilesJSONIsNil applies the IsNil predicate on the "instance_profiles_json" field.
+func InstanceProfilesJSONIsNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIsNull(FieldInstanceProfilesJSON))
+}
+
+// InstanceProfilesJSONNotNil applies the NotNil predicate on the "instance_profiles_json" field.
+func InstanceProfilesJSONNotNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotNull(FieldInstanceProfilesJSON))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeAWSIAMRole) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeAWSIAMRole) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeAWSIAMRole) predicate.BronzeAWSIAMRole {
+	return predicate.BronzeAWSIAMRole(sql.NotPredicates(p))
+}
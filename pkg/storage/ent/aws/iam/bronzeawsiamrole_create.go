@@ -0,0 +1,404 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamrole"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSIAMRoleCreate is the builder for creating a BronzeAWSIAMRole entity.
+type BronzeAWSIAMRoleCreate struct {
+	config
+	mutation *BronzeAWSIAMRoleMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeAWSIAMRoleCreate) SetCollectedAt(v time.Time) *BronzeAWSIAMRoleCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeAWSIAMRoleCreate) SetFirstCollectedAt(v time.Time) *BronzeAWSIAMRoleCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetArn sets the "arn" field.
+func (_c *BronzeAWSIAMRoleCreate) SetArn(v string) *BronzeAWSIAMRoleCreate {
+	_c.mutation.SetArn(v)
+	return _c
+}
+
+// SetRoleName sets the "role_name" field.
+func (_c *BronzeAWSIAMRoleCreate) SetRoleName(v string) *BronzeAWSIAMRoleCreate {
+	_c.mutation.SetRoleName(v)
+	return _c
+}
+
+// SetPath sets the "path" field.
+func (_c *BronzeAWSIAMRoleCreate) SetPath(v string) *BronzeAWSIAMRoleCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_c *BronzeAWSIAMRoleCreate) SetNillablePath(v *string) *BronzeAWSIAMRoleCreate {
+	if v != nil {
+		_c.SetPath(*v)
+	}
+	return _c
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_c *BronzeAWSIAMRoleCreate) SetCreateDate(v time.Time) *BronzeAWSIAMRoleCreate {
+	_c.mutation.SetCreateDate(v)
+	return _c
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_c *BronzeAWSIAMRoleCreate) SetNillableCreateDate(v *time.Time) *BronzeAWSIAMRoleCreate {
+	if v != nil {
+		_c.SetCreateDate(*v)
+	}
+	return _c
+}
+
+// SetRoleLastUsedDate sets the "role_last_used_date" field.
+func (_c *BronzeAWSIAMRoleCreate) SetRoleLastUsedDate(v time.Time) *BronzeAWSIAMRoleCreate {
+	_c.mutation.SetRoleLastUsedDate(v)
+	return _c
+}
+
+// SetNillableRoleLastUsedDate sets the "role_last_used_date" field if the given value is not nil.
+func (_c *BronzeAWSIAMRoleCreate) SetNillableRoleLastUsedDate(v *time.Time) *BronzeAWSIAMRoleCreate {
+	if v != nil {
+		_c.SetRoleLastUsedDate(*v)
+	}
+	return _c
+}
+
+// SetRoleLastUsedRegion sets the "role_last_used_region" field.
+func (_c *BronzeAWSIAMRoleCreate) SetRoleLastUsedRegion(v string) *BronzeAWSIAMRoleCreate {
+	_c.mutation.SetRoleLastUsedRegion(v)
+	return _c
+}
+
+// SetNillableRoleLastUsedRegion sets the "role_last_used_region" field if the given value is not nil.
+func (_c *BronzeAWSIAMRoleCreate) SetNillableRoleLastUsedRegion(v *string) *BronzeAWSIAMRoleCreate {
+	if v != nil {
+		_c.SetRoleLastUsedRegion(*v)
+	}
+	return _c
+}
+
+// SetPermissionsBoundaryArn sets the "permissions_boundary_arn" field.
+func (_c *BronzeAWSIAMRoleCreate) SetPermissionsBoundaryArn(v string) *BronzeAWSIAMRoleCreate {
+	_c.mutation.SetPermissionsBoundaryArn(v)
+	return _c
+}
+
+// SetNillablePermissionsBoundaryArn sets the "permissions_boundary_arn" field if the given value is not nil.
+func (_c *BronzeAWSIAMRoleCreate) SetNillablePermissionsBoundaryArn(v *string) *BronzeAWSIAMRoleCreate {
+	if v != nil {
+		_c.SetPermissionsBoundaryArn(*v)
+	}
+	return _c
+}
+
+// SetAssumeRolePolicyJSON sets the "assume_role_policy_json" field.
+func (_c *BronzeAWSIAMRoleCreate) SetAssumeRolePolicyJSON(v json.RawMessage) *BronzeAWSIAMRoleCreate {
+	_c.mutation.SetAssumeRolePolicyJSON(v)
+	return _c
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (_c *BronzeAWSIAMRoleCreate) SetAttachedPoliciesJSON(v json.RawMessage) *BronzeAWSIAMRoleCreate {
+	_c.mutation.SetAttachedPoliciesJSON(v)
+	return _c
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (_c *BronzeAWSIAMRoleCreate) SetInlinePoliciesJSON(v json.RawMessage) *BronzeAWSIAMRoleCreate {
+	_c.mutation.SetInlinePoliciesJSON(v)
+	return _c
+}
+
+// SetInstanceProfilesJSON sets the "instance_profiles_json" field.
+func (_c *BronzeAWSIAMRoleCreate) SetInstanceProfilesJSON(v json.RawMessage) *BronzeAWSIAMRoleCreate {
+	_c.mutation.SetInstanceProfilesJSON(v)
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeAWSIAMRoleCreate) SetTagsJSON(v json.RawMessage) *BronzeAWSIAMRoleCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeAWSIAMRoleCreate) SetAccountID(v string) *BronzeAWSIAMRoleCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeAWSIAMRoleCreate) SetID(v string) *BronzeAWSIAMRoleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeAWSIAMRoleMutation object of the builder.
+func (_c *BronzeAWSIAMRoleCreate) Mutation() *BronzeAWSIAMRoleMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeAWSIAMRole in the database.
+func (_c *BronzeAWSIAMRoleCreate) Save(ctx context.Context) (*BronzeAWSIAMRole, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeAWSIAMRoleCreate) SaveX(ctx context.Context) *BronzeAWSIAMRole {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSIAMRoleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSIAMRoleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeAWSIAMRoleCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`iam: missing required field "BronzeAWSIAMRole.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`iam: missing required field "BronzeAWSIAMRole.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.Arn(); !ok {
+		return &ValidationError{Name: "arn", err: errors.New(`iam: missing required field "BronzeAWSIAMRole.arn"`)}
+	}
+	if v, ok := _c.mutation.Arn(); ok {
+		if err := bronzeawsiamrole.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMRole.arn": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RoleName(); !ok {
+		return &ValidationError{Name: "role_name", err: errors.New(`iam: missing required field "BronzeAWSIAMRole.role_name"`)}
+	}
+	if v, ok := _c.mutation.RoleName(); ok {
+		if err := bronzeawsiamrole.RoleNameValidator(v); err != nil {
+			return &ValidationError{Name: "role_name", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMRole.role_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`iam: missing required field "BronzeAWSIAMRole.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzeawsiamrole.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMRole.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeAWSIAMRoleCreate) sqlSave(ctx context.Context) (*BronzeAWSIAMRole, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeAWSIAMRole.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeAWSIAMRoleCreate) createSpec() (*BronzeAWSIAMRole, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeAWSIAMRole{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzeawsiamrole.Table, sqlgraph.NewFieldSpec(bronzeawsiamrole.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeAWSIAMRole
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.Arn(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldArn, field.TypeString, value)
+		_node.Arn = value
+	}
+	if value, ok := _c.mutation.RoleName(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldRoleName, field.TypeString, value)
+		_node.RoleName = value
+	}
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := _c.mutation.CreateDate(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldCreateDate, field.TypeTime, value)
+		_node.CreateDate = &value
+	}
+	if value, ok := _c.mutation.RoleLastUsedDate(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldRoleLastUsedDate, field.TypeTime, value)
+		_node.RoleLastUsedDate = &value
+	}
+	if value, ok := _c.mutation.RoleLastUsedRegion(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldRoleLastUsedRegion, field.TypeString, value)
+		_node.RoleLastUsedRegion = value
+	}
+	if value, ok := _c.mutation.PermissionsBoundaryArn(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldPermissionsBoundaryArn, field.TypeString, value)
+		_node.PermissionsBoundaryArn = value
+	}
+	if value, ok := _c.mutation.AssumeRolePolicyJSON(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldAssumeRolePolicyJSON, field.TypeJSON, value)
+		_node.AssumeRolePolicyJSON = value
+	}
+	if value, ok := _c.mutation.AttachedPoliciesJSON(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldAttachedPoliciesJSON, field.TypeJSON, value)
+		_node.AttachedPoliciesJSON = value
+	}
+	if value, ok := _c.mutation.InlinePoliciesJSON(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldInlinePoliciesJSON, field.TypeJSON, value)
+		_node.InlinePoliciesJSON = value
+	}
+	if value, ok := _c.mutation.InstanceProfilesJSON(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldInstanceProfilesJSON, field.TypeJSON, value)
+		_node.InstanceProfilesJSON = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	return _node, _spec
+}
+
+// BronzeAWSIAMRoleCreateBulk is the builder for creating many BronzeAWSIAMRole entities in bulk.
+type BronzeAWSIAMRoleCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeAWSIAMRoleCreate
+}
+
+// Save creates the BronzeAWSIAMRole entities in the database.
+func (_c *BronzeAWSIAMRoleCreateBulk) Save(ctx context.Context) ([]*BronzeAWSIAMRole, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeAWSIAMRole, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeAWSIAMRoleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeAWSIAMRoleCreateBulk) SaveX(ctx context.Context) []*BronzeAWSIAMRole {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSIAMRoleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSIAMRoleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,915 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamrole"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSIAMRoleUpdate is the builder for updating BronzeAWSIAMRole entities.
+type BronzeAWSIAMRoleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSIAMRoleMutation
+}
+
+// Where appends a list predicates to the BronzeAWSIAMRoleUpdate builder.
+func (_u *BronzeAWSIAMRoleUpdate) Where(ps ...predicate.BronzeAWSIAMRole) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSIAMRoleUpdate) SetCollectedAt(v time.Time) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSIAMRoleUpdate) SetNillableCollectedAt(v *time.Time) *BronzeAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeAWSIAMRoleUpdate) SetArn(v string) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeAWSIAMRoleUpdate) SetNillableArn(v *string) *BronzeAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// SetRoleName sets the "role_name" field.
+func (_u *BronzeAWSIAMRoleUpdate) SetRoleName(v string) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.SetRoleName(v)
+	return _u
+}
+
+// SetNillableRoleName sets the "role_name" field if the given value is not nil.
+func (_u *BronzeAWSIAMRoleUpdate) SetNillableRoleName(v *string) *BronzeAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetRoleName(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *BronzeAWSIAMRoleUpdate) SetPath(v string) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BronzeAWSIAMRoleUpdate) SetNillablePath(v *string) *BronzeAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// ClearPath clears the value of the "path" field.
+func (_u *BronzeAWSIAMRoleUpdate) ClearPath() *BronzeAWSIAMRoleUpdate {
+	_u.mutation.ClearPath()
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeAWSIAMRoleUpdate) SetCreateDate(v time.Time) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMRoleUpdate) SetNillableCreateDate(v *time.Time) *BronzeAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeAWSIAMRoleUpdate) ClearCreateDate() *BronzeAWSIAMRoleUpdate {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetRoleLastUsedDate sets the "role_last_used_date" field.
+func (_u *BronzeAWSIAMRoleUpdate) SetRoleLastUsedDate(v time.Time) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.SetRoleLastUsedDate(v)
+	return _u
+}
+
+// SetNillableRoleLastUsedDate sets the "role_last_used_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMRoleUpdate) SetNillableRoleLastUsedDate(v *time.Time) *BronzeAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetRoleLastUsedDate(*v)
+	}
+	return _u
+}
+
+// ClearRoleLastUsedDate clears the value of the "role_last_used_date" field.
+func (_u *BronzeAWSIAMRoleUpdate) ClearRoleLastUsedDate() *BronzeAWSIAMRoleUpdate {
+	_u.mutation.ClearRoleLastUsedDate()
+	return _u
+}
+
+// SetRoleLastUsedRegion sets the "role_last_used_region" field.
+func (_u *BronzeAWSIAMRoleUpdate) SetRoleLastUsedRegion(v string) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.SetRoleLastUsedRegion(v)
+	return _u
+}
+
+// SetNillableRoleLastUsedRegion sets the "role_last_used_region" field if the given value is not nil.
+func (_u *BronzeAWSIAMRoleUpdate) SetNillableRoleLastUsedRegion(v *string) *BronzeAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetRoleLastUsedRegion(*v)
+	}
+	return _u
+}
+
+// ClearRoleLastUsedRegion clears the value of the "role_last_used_region" field.
+func (_u *BronzeAWSIAMRoleUpdate) ClearRoleLastUsedRegion() *BronzeAWSIAMRoleUpdate {
+	_u.mutation.ClearRoleLastUsedRegion()
+	return _u
+}
+
+// SetPermissionsBoundaryArn sets the "permissions_boundary_arn" field.
+func (_u *BronzeAWSIAMRoleUpdate) SetPermissionsBoundaryArn(v string) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.SetPermissionsBoundaryArn(v)
+	return _u
+}
+
+// SetNillablePermissionsBoundaryArn sets the "permissions_boundary_arn" field if the given value is not nil.
+func (_u *BronzeAWSIAMRoleUpdate) SetNillablePermissionsBoundaryArn(v *string) *BronzeAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetPermissionsBoundaryArn(*v)
+	}
+	return _u
+}
+
+// ClearPermissionsBoundaryArn clears the value of the "permissions_boundary_arn" field.
+func (_u *BronzeAWSIAMRoleUpdate) ClearPermissionsBoundaryArn() *BronzeAWSIAMRoleUpdate {
+	_u.mutation.ClearPermissionsBoundaryArn()
+	return _u
+}
+
+// SetAssumeRolePolicyJSON sets the "assume_role_policy_json" field.
+func (_u *BronzeAWSIAMRoleUpdate) SetAssumeRolePolicyJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.SetAssumeRolePolicyJSON(v)
+	return _u
+}
+
+// AppendAssumeRolePolicyJSON appends value to the "assume_role_policy_json" field.
+func (_u *BronzeAWSIAMRoleUpdate) AppendAssumeRolePolicyJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.AppendAssumeRolePolicyJSON(v)
+	return _u
+}
+
+// ClearAssumeRolePolicyJSON clears the value of the "assume_role_policy_json" field.
+func (_u *BronzeAWSIAMRoleUpdate) ClearAssumeRolePolicyJSON() *BronzeAWSIAMRoleUpdate {
+	_u.mutation.ClearAssumeRolePolicyJSON()
+	return _u
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (_u *BronzeAWSIAMRoleUpdate) SetAttachedPoliciesJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.SetAttachedPoliciesJSON(v)
+	return _u
+}
+
+// AppendAttachedPoliciesJSON appends value to the "attached_policies_json" field.
+func (_u *BronzeAWSIAMRoleUpdate) AppendAttachedPoliciesJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.AppendAttachedPoliciesJSON(v)
+	return _u
+}
+
+// ClearAttachedPoliciesJSON clears the value of the "attached_policies_json" field.
+func (_u *BronzeAWSIAMRoleUpdate) ClearAttachedPoliciesJSON() *BronzeAWSIAMRoleUpdate {
+	_u.mutation.ClearAttachedPoliciesJSON()
+	return _u
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (_u *BronzeAWSIAMRoleUpdate) SetInlinePoliciesJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.SetInlinePoliciesJSON(v)
+	return _u
+}
+
+// AppendInlinePoliciesJSON appends value to the "inline_policies_json" field.
+func (_u *BronzeAWSIAMRoleUpdate) AppendInlinePoliciesJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.AppendInlinePoliciesJSON(v)
+	return _u
+}
+
+// ClearInlinePoliciesJSON clears the value of the "inline_policies_json" field.
+func (_u *BronzeAWSIAMRoleUpdate) ClearInlinePoliciesJSON() *BronzeAWSIAMRoleUpdate {
+	_u.mutation.ClearInlinePoliciesJSON()
+	return _u
+}
+
+// SetInstanceProfilesJSON sets the "instance_profiles_json" field.
+func (_u *BronzeAWSIAMRoleUpdate) SetInstanceProfilesJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.SetInstanceProfilesJSON(v)
+	return _u
+}
+
+// AppendInstanceProfilesJSON appends value to the "instance_profiles_json" field.
+func (_u *BronzeAWSIAMRoleUpdate) AppendInstanceProfilesJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.AppendInstanceProfilesJSON(v)
+	return _u
+}
+
+// ClearInstanceProfilesJSON clears the value of the "instance_profiles_json" field.
+func (_u *BronzeAWSIAMRoleUpdate) ClearInstanceProfilesJSON() *BronzeAWSIAMRoleUpdate {
+	_u.mutation.ClearInstanceProfilesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSIAMRoleUpdate) SetTagsJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSIAMRoleUpdate) AppendTagsJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSIAMRoleUpdate) ClearTagsJSON() *BronzeAWSIAMRoleUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSIAMRoleUpdate) SetAccountID(v string) *BronzeAWSIAMRoleUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSIAMRoleUpdate) SetNillableAccountID(v *string) *BronzeAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSIAMRoleMutation object of the builder.
+func (_u *BronzeAWSIAMRoleUpdate) Mutation() *BronzeAWSIAMRoleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeAWSIAMRoleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSIAMRoleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeAWSIAMRoleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSIAMRoleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSIAMRoleUpdate) check() error {
+	if v, ok := _u.mutation.Arn(); ok {
+		if err := bronzeawsiamrole.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMRole.arn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RoleName(); ok {
+		if err := bronzeawsiamrole.RoleNameValidator(v); err != nil {
+			return &ValidationError{Name: "role_name", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMRole.role_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsiamrole.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMRole.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSIAMRoleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsiamrole.Table, bronzeawsiamrole.Columns, sqlgraph.NewFieldSpec(bronzeawsiamrole.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldArn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RoleName(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldRoleName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldPath, field.TypeString, value)
+	}
+	if _u.mutation.PathCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RoleLastUsedDate(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldRoleLastUsedDate, field.TypeTime, value)
+	}
+	if _u.mutation.RoleLastUsedDateCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldRoleLastUsedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RoleLastUsedRegion(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldRoleLastUsedRegion, field.TypeString, value)
+	}
+	if _u.mutation.RoleLastUsedRegionCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldRoleLastUsedRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.PermissionsBoundaryArn(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldPermissionsBoundaryArn, field.TypeString, value)
+	}
+	if _u.mutation.PermissionsBoundaryArnCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldPermissionsBoundaryArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.AssumeRolePolicyJSON(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldAssumeRolePolicyJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAssumeRolePolicyJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamrole.FieldAssumeRolePolicyJSON, value)
+		})
+	}
+	if _u.mutation.AssumeRolePolicyJSONCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldAssumeRolePolicyJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AttachedPoliciesJSON(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldAttachedPoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttachedPoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamrole.FieldAttachedPoliciesJSON, value)
+		})
+	}
+	if _u.mutation.AttachedPoliciesJSONCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldAttachedPoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InlinePoliciesJSON(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldInlinePoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedInlinePoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamrole.FieldInlinePoliciesJSON, value)
+		})
+	}
+	if _u.mutation.InlinePoliciesJSONCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldInlinePoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InstanceProfilesJSON(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldInstanceProfilesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedInstanceProfilesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamrole.FieldInstanceProfilesJSON, value)
+		})
+	}
+	if _u.mutation.InstanceProfilesJSONCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldInstanceProfilesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamrole.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSIAMRole
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsiamrole.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeAWSIAMRoleUpdateOne is the builder for updating a single BronzeAWSIAMRole entity.
+type BronzeAWSIAMRoleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeAWSIAMRoleMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetCollectedAt(v time.Time) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetArn(v string) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetNillableArn(v *string) *BronzeAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// SetRoleName sets the "role_name" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetRoleName(v string) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.SetRoleName(v)
+	return _u
+}
+
+// SetNillableRoleName sets the "role_name" field if the given value is not nil.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetNillableRoleName(v *string) *BronzeAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetRoleName(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetPath(v string) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetNillablePath(v *string) *BronzeAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// ClearPath clears the value of the "path" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) ClearPath() *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.ClearPath()
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetCreateDate(v time.Time) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetNillableCreateDate(v *time.Time) *BronzeAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) ClearCreateDate() *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetRoleLastUsedDate sets the "role_last_used_date" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetRoleLastUsedDate(v time.Time) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.SetRoleLastUsedDate(v)
+	return _u
+}
+
+// SetNillableRoleLastUsedDate sets the "role_last_used_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetNillableRoleLastUsedDate(v *time.Time) *BronzeAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetRoleLastUsedDate(*v)
+	}
+	return _u
+}
+
+// ClearRoleLastUsedDate clears the value of the "role_last_used_date" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) ClearRoleLastUsedDate() *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.ClearRoleLastUsedDate()
+	return _u
+}
+
+// SetRoleLastUsedRegion sets the "role_last_used_region" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetRoleLastUsedRegion(v string) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.SetRoleLastUsedRegion(v)
+	return _u
+}
+
+// SetNillableRoleLastUsedRegion sets the "role_last_used_region" field if the given value is not nil.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetNillableRoleLastUsedRegion(v *string) *BronzeAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetRoleLastUsedRegion(*v)
+	}
+	return _u
+}
+
+// ClearRoleLastUsedRegion clears the value of the "role_last_used_region" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) ClearRoleLastUsedRegion() *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.ClearRoleLastUsedRegion()
+	return _u
+}
+
+// SetPermissionsBoundaryArn sets the "permissions_boundary_arn" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetPermissionsBoundaryArn(v string) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.SetPermissionsBoundaryArn(v)
+	return _u
+}
+
+// SetNillablePermissionsBoundaryArn sets the "permissions_boundary_arn" field if the given value is not nil.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetNillablePermissionsBoundaryArn(v *string) *BronzeAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetPermissionsBoundaryArn(*v)
+	}
+	return _u
+}
+
+// ClearPermissionsBoundaryArn clears the value of the "permissions_boundary_arn" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) ClearPermissionsBoundaryArn() *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.ClearPermissionsBoundaryArn()
+	return _u
+}
+
+// SetAssumeRolePolicyJSON sets the "assume_role_policy_json" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetAssumeRolePolicyJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.SetAssumeRolePolicyJSON(v)
+	return _u
+}
+
+// AppendAssumeRolePolicyJSON appends value to the "assume_role_policy_json" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) AppendAssumeRolePolicyJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.AppendAssumeRolePolicyJSON(v)
+	return _u
+}
+
+// ClearAssumeRolePolicyJSON clears the value of the "assume_role_policy_json" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) ClearAssumeRolePolicyJSON() *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.ClearAssumeRolePolicyJSON()
+	return _u
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetAttachedPoliciesJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.SetAttachedPoliciesJSON(v)
+	return _u
+}
+
+// AppendAttachedPoliciesJSON appends value to the "attached_policies_json" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) AppendAttachedPoliciesJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.AppendAttachedPoliciesJSON(v)
+	return _u
+}
+
+// ClearAttachedPoliciesJSON clears the value of the "attached_policies_json" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) ClearAttachedPoliciesJSON() *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.ClearAttachedPoliciesJSON()
+	return _u
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetInlinePoliciesJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.SetInlinePoliciesJSON(v)
+	return _u
+}
+
+// AppendInlinePoliciesJSON appends value to the "inline_policies_json" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) AppendInlinePoliciesJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.AppendInlinePoliciesJSON(v)
+	return _u
+}
+
+// ClearInlinePoliciesJSON clears the value of the "inline_policies_json" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) ClearInlinePoliciesJSON() *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.ClearInlinePoliciesJSON()
+	return _u
+}
+
+// SetInstanceProfilesJSON sets the "instance_profiles_json" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetInstanceProfilesJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.SetInstanceProfilesJSON(v)
+	return _u
+}
+
+// AppendInstanceProfilesJSON appends value to the "instance_profiles_json" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) AppendInstanceProfilesJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.AppendInstanceProfilesJSON(v)
+	return _u
+}
+
+// ClearInstanceProfilesJSON clears the value of the "instance_profiles_json" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) ClearInstanceProfilesJSON() *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.ClearInstanceProfilesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) ClearTagsJSON() *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetAccountID(v string) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSIAMRoleUpdateOne) SetNillableAccountID(v *string) *BronzeAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSIAMRoleMutation object of the builder.
+func (_u *BronzeAWSIAMRoleUpdateOne) Mutation() *BronzeAWSIAMRoleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeAWSIAMRoleUpdate builder.
+func (_u *BronzeAWSIAMRoleUpdateOne) Where(ps ...predicate.BronzeAWSIAMRole) *BronzeAWSIAMRoleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeAWSIAMRoleUpdateOne) Select(field string, fields ...string) *BronzeAWSIAMRoleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeAWSIAMRole entity.
+func (_u *BronzeAWSIAMRoleUpdateOne) Save(ctx context.Context) (*BronzeAWSIAMRole, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSIAMRoleUpdateOne) SaveX(ctx context.Context) *BronzeAWSIAMRole {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeAWSIAMRoleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSIAMRoleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSIAMRoleUpdateOne) check() error {
+	if v, ok := _u.mutation.Arn(); ok {
+		if err := bronzeawsiamrole.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMRole.arn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RoleName(); ok {
+		if err := bronzeawsiamrole.RoleNameValidator(v); err != nil {
+			return &ValidationError{Name: "role_name", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMRole.role_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsiamrole.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMRole.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSIAMRoleUpdateOne) sqlSave(ctx context.Context) (_node *BronzeAWSIAMRole, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsiamrole.Table, bronzeawsiamrole.Columns, sqlgraph.NewFieldSpec(bronzeawsiamrole.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`iam: missing "BronzeAWSIAMRole.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawsiamrole.FieldID)
+		for _, f := range fields {
+			if !bronzeawsiamrole.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("iam: invalid field %q for query", f)}
+			}
+			if f != bronzeawsiamrole.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldArn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RoleName(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldRoleName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldPath, field.TypeString, value)
+	}
+	if _u.mutation.PathCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RoleLastUsedDate(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldRoleLastUsedDate, field.TypeTime, value)
+	}
+	if _u.mutation.RoleLastUsedDateCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldRoleLastUsedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RoleLastUsedRegion(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldRoleLastUsedRegion, field.TypeString, value)
+	}
+	if _u.mutation.RoleLastUsedRegionCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldRoleLastUsedRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.PermissionsBoundaryArn(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldPermissionsBoundaryArn, field.TypeString, value)
+	}
+	if _u.mutation.PermissionsBoundaryArnCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldPermissionsBoundaryArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.AssumeRolePolicyJSON(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldAssumeRolePolicyJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAssumeRolePolicyJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamrole.FieldAssumeRolePolicyJSON, value)
+		})
+	}
+	if _u.mutation.AssumeRolePolicyJSONCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldAssumeRolePolicyJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AttachedPoliciesJSON(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldAttachedPoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttachedPoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamrole.FieldAttachedPoliciesJSON, value)
+		})
+	}
+	if _u.mutation.AttachedPoliciesJSONCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldAttachedPoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InlinePoliciesJSON(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldInlinePoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedInlinePoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamrole.FieldInlinePoliciesJSON, value)
+		})
+	}
+	if _u.mutation.InlinePoliciesJSONCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldInlinePoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InstanceProfilesJSON(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldInstanceProfilesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedInstanceProfilesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamrole.FieldInstanceProfilesJSON, value)
+		})
+	}
+	if _u.mutation.InstanceProfilesJSONCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldInstanceProfilesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamrole.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsiamrole.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsiamrole.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSIAMRole
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeAWSIAMRole{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsiamrole.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
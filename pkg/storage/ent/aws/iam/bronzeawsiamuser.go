@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamuser"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeAWSIAMUser is the model entity for the BronzeAWSIAMUser schema.
+type BronzeAWSIAMUser struct {
+	config `json:"-"`
+	// ID of the ent.
+	// IAM user ID (AIDA...), used as primary key
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Arn holds the value of the "arn" field.
+	Arn string `json:"arn,omitempty"`
+	// UserName holds the value of the "user_name" field.
+	UserName string `json:"user_name,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// CreateDate holds the value of the "create_date" field.
+	CreateDate *time.Time `json:"create_date,omitempty"`
+	// PermissionsBoundaryArn holds the value of the "permissions_boundary_arn" field.
+	PermissionsBoundaryArn string `json:"permissions_boundary_arn,omitempty"`
+	// AttachedPoliciesJSON holds the value of the "attached_policies_json" field.
+	AttachedPoliciesJSON json.RawMessage `json:"attached_policies_json,omitempty"`
+	// InlinePoliciesJSON holds the value of the "inline_policies_json" field.
+	InlinePoliciesJSON json.RawMessage `json:"inline_policies_json,omitempty"`
+	// GroupsJSON holds the value of the "groups_json" field.
+	GroupsJSON json.RawMessage `json:"groups_json,omitempty"`
+	// MfaDevicesJSON holds the value of the "mfa_devices_json" field.
+	MfaDevicesJSON json.RawMessage `json:"mfa_devices_json,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID    string `json:"account_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeAWSIAMUser) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsiamuser.FieldAttachedPoliciesJSON, bronzeawsiamuser.FieldInlinePoliciesJSON, bronzeawsiamuser.FieldGroupsJSON, bronzeawsiamuser.FieldMfaDevicesJSON, bronzeawsiamuser.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzeawsiamuser.FieldID, bronzeawsiamuser.FieldArn, bronzeawsiamuser.FieldUserName, bronzeawsiamuser.FieldPath, bronzeawsiamuser.FieldPermissionsBoundaryArn, bronzeawsiamuser.FieldAccountID:
+			values[i] = new(sql.NullString)
+		case bronzeawsiamuser.FieldCollectedAt, bronzeawsiamuser.FieldFirstCollectedAt, bronzeawsiamuser.FieldCreateDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeAWSIAMUser fields.
+func (_m *BronzeAWSIAMUser) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawsiamuser.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzeawsiamuser.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzeawsiamuser.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzeawsiamuser.FieldArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field arn", values[i])
+			} else if value.Valid {
+				_m.Arn = value.String
+			}
+		case bronzeawsiamuser.FieldUserName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_name", values[i])
+			} else if value.Valid {
+				_m.UserName = value.String
+			}
+		case bronzeawsiamuser.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				_m.Path = value.String
+			}
+		case bronzeawsiamuser.FieldCreateDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_date", values[i])
+			} else if value.Valid {
+				_m.CreateDate = new(time.Time)
+				*_m.CreateDate = value.Time
+			}
+		case bronzeawsiamuser.FieldPermissionsBoundaryArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field permissions_boundary_arn", values[i])
+			} else if value.Valid {
+				_m.PermissionsBoundaryArn = value.String
+			}
+		case bronzeawsiamuser.FieldAttachedPoliciesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attached_policies_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AttachedPoliciesJSON); err != nil {
+					return fmt.Errorf("unmarshal field attached_policies_json: %w", err)
+				}
+			}
+		case bronzeawsiamuser.FieldInlinePoliciesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field inline_policies_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.InlinePoliciesJSON); err != nil {
+					return fmt.Errorf("unmarshal field inline_policies_json: %w", err)
+				}
+			}
+		case bronzeawsiamuser.FieldGroupsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field groups_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.GroupsJSON); err != nil {
+					return fmt.Errorf("unmarshal field groups_json: %w", err)
+				}
+			}
+		case bronzeawsiamuser.FieldMfaDevicesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_devices_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.MfaDevicesJSON); err != nil {
+					return fmt.Errorf("unmarshal field mfa_devices_json: %w", err)
+				}
+			}
+		case bronzeawsiamuser.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzeawsiamuser.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeAWSIAMUser.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeAWSIAMUser) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeAWSIAMUser.
+// Note that you need to call BronzeAWSIAMUser.Unwrap() before calling this method if this BronzeAWSIAMUser
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeAWSIAMUser) Update() *BronzeAWSIAMUserUpdateOne {
+	return NewBronzeAWSIAMUserClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeAWSIAMUser entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeAWSIAMUser) Unwrap() *BronzeAWSIAMUser {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("iam: BronzeAWSIAMUser is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeAWSIAMUser) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeAWSIAMUser(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("arn=")
+	builder.WriteString(_m.Arn)
+	builder.WriteString(", ")
+	builder.WriteString("user_name=")
+	builder.WriteString(_m.UserName)
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(_m.Path)
+	builder.WriteString(", ")
+	if v := _m.CreateDate; v != nil {
+		builder.WriteString("create_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("permissions_boundary_arn=")
+	builder.WriteString(_m.PermissionsBoundaryArn)
+	builder.WriteString(", ")
+	builder.WriteString("attached_policies_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttachedPoliciesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("inline_policies_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InlinePoliciesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("groups_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GroupsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("mfa_devices_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MfaDevicesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeAWSIAMUsers is a parsable slice of BronzeAWSIAMUser.
+type BronzeAWSIAMUsers []*BronzeAWSIAMUser
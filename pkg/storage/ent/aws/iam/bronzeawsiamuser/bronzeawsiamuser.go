@@ -0,0 +1,127 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawsiamuser
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzeawsiamuser type in the database.
+	Label = "bronze_awsiam_user"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldArn holds the string denoting the arn field in the database.
+	FieldArn = "arn"
+	// FieldUserName holds the string denoting the user_name field in the database.
+	FieldUserName = "user_name"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
+	// FieldCreateDate holds the string denoting the create_date field in the database.
+	FieldCreateDate = "create_date"
+	// FieldPermissionsBoundaryArn holds the string denoting the permissions_boundary_arn field in the database.
+	FieldPermissionsBoundaryArn = "permissions_boundary_arn"
+	// FieldAttachedPoliciesJSON holds the string denoting the attached_policies_json field in the database.
+	FieldAttachedPoliciesJSON = "attached_policies_json"
+	// FieldInlinePoliciesJSON holds the string denoting the inline_policies_json field in the database.
+	FieldInlinePoliciesJSON = "inline_policies_json"
+	// FieldGroupsJSON holds the string denoting the groups_json field in the database.
+	FieldGroupsJSON = "groups_json"
+	// FieldMfaDevicesJSON holds the string denoting the mfa_devices_json field in the database.
+	FieldMfaDevicesJSON = "mfa_devices_json"
+	// FieldTagsJSON holds the string denoting the tags_json field in the database.
+	FieldTagsJSON = "tags_json"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// Table holds the table name of the bronzeawsiamuser in the database.
+	Table = "aws_iam_users"
+)
+
+// Columns holds all SQL columns for bronzeawsiamuser fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldArn,
+	FieldUserName,
+	FieldPath,
+	FieldCreateDate,
+	FieldPermissionsBoundaryArn,
+	FieldAttachedPoliciesJSON,
+	FieldInlinePoliciesJSON,
+	FieldGroupsJSON,
+	FieldMfaDevicesJSON,
+	FieldTagsJSON,
+	FieldAccountID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ArnValidator is a validator for the "arn" field. It is called by the builders before save.
+	ArnValidator func(string) error
+	// UserNameValidator is a validator for the "user_name" field. It is called by the builders before save.
+	UserNameValidator func(string) error
+	// AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	AccountIDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BronzeAWSIAMUser queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByArn orders the results by the arn field.
+func ByArn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArn, opts...).ToFunc()
+}
+
+// ByUserName orders the results by the user_name field.
+func ByUserName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserName, opts...).ToFunc()
+}
+
+// ByPath orders the results by the path field.
+func ByPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPath, opts...).ToFunc()
+}
+
+// ByCreateDate orders the results by the create_date field.
+func ByCreateDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateDate, opts...).ToFunc()
+}
+
+// ByPermissionsBoundaryArn orders the results by the permissions_boundary_arn field.
+func ByPermissionsBoundaryArn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPermissionsBoundaryArn, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
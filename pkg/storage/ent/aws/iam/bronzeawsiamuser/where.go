@@ -0,0 +1,645 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawsiamuser
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// Arn applies equality check predicate on the "arn" field. It's identical to ArnEQ.
+func Arn(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEQ(FieldArn, v))
+}
+
+// UserName applies equality check predicate on the "user_name" field. It's identical to UserNameEQ.
+func UserName(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEQ(FieldUserName, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEQ(FieldPath, v))
+}
+
+// CreateDate applies equality check predicate on the "create_date" field. It's identical to CreateDateEQ.
+func CreateDate(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEQ(FieldCreateDate, v))
+}
+
+// PermissionsBoundaryArn applies equality check predicate on the "permissions_boundary_arn" field. It's identical to PermissionsBoundaryArnEQ.
+func PermissionsBoundaryArn(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEQ(FieldPermissionsBoundaryArn, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEQ(FieldAccountID, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ArnEQ applies the EQ predicate on the "arn" field.
+func ArnEQ(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEQ(FieldArn, v))
+}
+
+// ArnNEQ applies the NEQ predicate on the "arn" field.
+func ArnNEQ(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNEQ(FieldArn, v))
+}
+
+// ArnIn applies the In predicate on the "arn" field.
+func ArnIn(vs ...string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldIn(FieldArn, vs...))
+}
+
+// ArnNotIn applies the NotIn predicate on the "arn" field.
+func ArnNotIn(vs ...string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNotIn(FieldArn, vs...))
+}
+
+// ArnGT applies the GT predicate on the "arn" field.
+func ArnGT(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldGT(FieldArn, v))
+}
+
+// ArnGTE applies the GTE predicate on the "arn" field.
+func ArnGTE(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldGTE(FieldArn, v))
+}
+
+// ArnLT applies the LT predicate on the "arn" field.
+func ArnLT(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldLT(FieldArn, v))
+}
+
+// ArnLTE applies the LTE predicate on the "arn" field.
+func ArnLTE(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldLTE(FieldArn, v))
+}
+
+// ArnContains applies the Contains predicate on the "arn" field.
+func ArnContains(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldContains(FieldArn, v))
+}
+
+// ArnHasPrefix applies the HasPrefix predicate on the "arn" field.
+func ArnHasPrefix(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldHasPrefix(FieldArn, v))
+}
+
+// ArnHasSuffix applies the HasSuffix predicate on the "arn" field.
+func ArnHasSuffix(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldHasSuffix(FieldArn, v))
+}
+
+// ArnEqualFold applies the EqualFold predicate on the "arn" field.
+func ArnEqualFold(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEqualFold(FieldArn, v))
+}
+
+// ArnContainsFold applies the ContainsFold predicate on the "arn" field.
+func ArnContainsFold(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldContainsFold(FieldArn, v))
+}
+
+// UserNameEQ applies the EQ predicate on the "user_name" field.
+func UserNameEQ(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEQ(FieldUserName, v))
+}
+
+// UserNameNEQ applies the NEQ predicate on the "user_name" field.
+func UserNameNEQ(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNEQ(FieldUserName, v))
+}
+
+// UserNameIn applies the In predicate on the "user_name" field.
+func UserNameIn(vs ...string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldIn(FieldUserName, vs...))
+}
+
+// UserNameNotIn applies the NotIn predicate on the "user_name" field.
+func UserNameNotIn(vs ...string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNotIn(FieldUserName, vs...))
+}
+
+// UserNameGT applies the GT predicate on the "user_name" field.
+func UserNameGT(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldGT(FieldUserName, v))
+}
+
+// UserNameGTE applies the GTE predicate on the "user_name" field.
+func UserNameGTE(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldGTE(FieldUserName, v))
+}
+
+// UserNameLT applies the LT predicate on the "user_name" field.
+func UserNameLT(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldLT(FieldUserName, v))
+}
+
+// UserNameLTE applies the LTE predicate on the "user_name" field.
+func UserNameLTE(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldLTE(FieldUserName, v))
+}
+
+// UserNameContains applies the Contains predicate on the "user_name" field.
+func UserNameContains(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldContains(FieldUserName, v))
+}
+
+// UserNameHasPrefix applies the HasPrefix predicate on the "user_name" field.
+func UserNameHasPrefix(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldHasPrefix(FieldUserName, v))
+}
+
+// UserNameHasSuffix applies the HasSuffix predicate on the "user_name" field.
+func UserNameHasSuffix(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldHasSuffix(FieldUserName, v))
+}
+
+// UserNameEqualFold applies the EqualFold predicate on the "user_name" field.
+func UserNameEqualFold(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEqualFold(FieldUserName, v))
+}
+
+// UserNameContainsFold applies the ContainsFold predicate on the "user_name" field.
+func UserNameContainsFold(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldContainsFold(FieldUserName, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathIsNil applies the IsNil predicate on the "path" field.
+func PathIsNil() predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldIsNull(FieldPath))
+}
+
+// PathNotNil applies the NotNil predicate on the "path" field.
+func PathNotNil() predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNotNull(FieldPath))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldContainsFold(FieldPath, v))
+}
+
+// CreateDateEQ applies the EQ predicate on the "create_date" field.
+func CreateDateEQ(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEQ(FieldCreateDate, v))
+}
+
+// CreateDateNEQ applies the NEQ predicate on the "create_date" field.
+func CreateDateNEQ(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNEQ(FieldCreateDate, v))
+}
+
+// CreateDateIn applies the In predicate on the "create_date" field.
+func CreateDateIn(vs ...time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldIn(FieldCreateDate, vs...))
+}
+
+// CreateDateNotIn applies the NotIn predicate on the "create_date" field.
+func CreateDateNotIn(vs ...time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNotIn(FieldCreateDate, vs...))
+}
+
+// CreateDateGT applies the GT predicate on the "create_date" field.
+func CreateDateGT(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldGT(FieldCreateDate, v))
+}
+
+// CreateDateGTE applies the GTE predicate on the "create_date" field.
+func CreateDateGTE(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldGTE(FieldCreateDate, v))
+}
+
+// CreateDateLT applies the LT predicate on the "create_date" field.
+func CreateDateLT(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldLT(FieldCreateDate, v))
+}
+
+// CreateDateLTE applies the LTE predicate on the "create_date" field.
+func CreateDateLTE(v time.Time) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldLTE(FieldCreateDate, v))
+}
+
+// CreateDateIsNil applies the IsNil predicate on the "create_date" field.
+func CreateDateIsNil() predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldIsNull(FieldCreateDate))
+}
+
+// CreateDateNotNil applies the NotNil predicate on the "create_date" field.
+func CreateDateNotNil() predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNotNull(FieldCreateDate))
+}
+
+// PermissionsBoundaryArnEQ applies the EQ predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnEQ(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEQ(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnNEQ applies the NEQ predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnNEQ(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNEQ(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnIn applies the In predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnIn(vs ...string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldIn(FieldPermissionsBoundaryArn, vs...))
+}
+
+// PermissionsBoundaryArnNotIn applies the NotIn predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnNotIn(vs ...string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNotIn(FieldPermissionsBoundaryArn, vs...))
+}
+
+// PermissionsBoundaryArnGT applies the GT predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnGT(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldGT(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnGTE applies the GTE predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnGTE(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldGTE(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnLT applies the LT predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnLT(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldLT(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnLTE applies the LTE predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnLTE(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldLTE(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnContains applies the Contains predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnContains(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldContains(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnHasPrefix applies the HasPrefix predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnHasPrefix(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldHasPrefix(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnHasSuffix applies the HasSuffix predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnHasSuffix(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldHasSuffix(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnIsNil applies the IsNil predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnIsNil() predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldIsNull(FieldPermissionsBoundaryArn))
+}
+
+// PermissionsBoundaryArnNotNil applies the NotNil predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnNotNil() predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNotNull(FieldPermissionsBoundaryArn))
+}
+
+// PermissionsBoundaryArnEqualFold applies the EqualFold predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnEqualFold(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEqualFold(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnContainsFold applies the ContainsFold predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnContainsFold(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldContainsFold(FieldPermissionsBoundaryArn, v))
+}
+
+// AttachedPoliciesJSONIsNil applies the IsNil predicate on the "attached_policies_json" field.
+func AttachedPoliciesJSONIsNil() predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldIsNull(FieldAttachedPoliciesJSON))
+}
+
+// AttachedPoliciesJSONNotNil applies the NotNil predicate on the "attached_policies_json" field.
+func AttachedPoliciesJSONNotNil() predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNotNull(FieldAttachedPoliciesJSON))
+}
+
+// InlinePoliciesJSONIsNil applies the IsNil predicate on the "inline_policies_json" field.
+func InlinePoliciesJSONIsNil() predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldIsNull(FieldInlinePoliciesJSON))
+}
+
+// InlinePoliciesJSONNotNil applies the NotNil predicate on the "inline_policies_json" field.
+func InlinePoliciesJSONNotNil() predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNotNull(FieldInlinePoliciesJSON))
+}
+
+// GroupsJSONIsNil applies the IsNil predicate on the "groups_json" field.
+func GroupsJSONIsNil() predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldIsNull(FieldGroupsJSON))
+}
+
+// GroupsJSONNotNil applies the NotNil predicate on the "groups_json" field.
+func GroupsJSONNotNil() predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNotNull(FieldGroupsJSON))
+}
+
+// MfaDevicesJSONIsNil applies the IsNil predicate on the "mfa_devices_json" field.
+func MfaDevicesJSONIsNil() predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldIsNull(FieldMfaDevicesJSON))
+}
+
+// MfaDevicesJSONNotNil applies the NotNil predicate on the "mfa_devices_json" field.
+func MfaDevicesJSONNotNil() predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNotNull(FieldMfaDevicesJSON))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeAWSIAMUser) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeAWSIAMUser) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeAWSIAMUser) predicate.BronzeAWSIAMUser {
+	return predicate.BronzeAWSIAMUser(sql.NotPredicates(p))
+}
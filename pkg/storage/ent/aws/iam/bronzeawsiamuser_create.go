@@ -0,0 +1,368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamuser"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSIAMUserCreate is the builder for creating a BronzeAWSIAMUser entity.
+type BronzeAWSIAMUserCreate struct {
+	config
+	mutation *BronzeAWSIAMUserMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeAWSIAMUserCreate) SetCollectedAt(v time.Time) *BronzeAWSIAMUserCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeAWSIAMUserCreate) SetFirstCollectedAt(v time.Time) *BronzeAWSIAMUserCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetArn sets the "arn" field.
+func (_c *BronzeAWSIAMUserCreate) SetArn(v string) *BronzeAWSIAMUserCreate {
+	_c.mutation.SetArn(v)
+	return _c
+}
+
+// SetUserName sets the "user_name" field.
+func (_c *BronzeAWSIAMUserCreate) SetUserName(v string) *BronzeAWSIAMUserCreate {
+	_c.mutation.SetUserName(v)
+	return _c
+}
+
+// SetPath sets the "path" field.
+func (_c *BronzeAWSIAMUserCreate) SetPath(v string) *BronzeAWSIAMUserCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_c *BronzeAWSIAMUserCreate) SetNillablePath(v *string) *BronzeAWSIAMUserCreate {
+	if v != nil {
+		_c.SetPath(*v)
+	}
+	return _c
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_c *BronzeAWSIAMUserCreate) SetCreateDate(v time.Time) *BronzeAWSIAMUserCreate {
+	_c.mutation.SetCreateDate(v)
+	return _c
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_c *BronzeAWSIAMUserCreate) SetNillableCreateDate(v *time.Time) *BronzeAWSIAMUserCreate {
+	if v != nil {
+		_c.SetCreateDate(*v)
+	}
+	return _c
+}
+
+// SetPermissionsBoundaryArn sets the "permissions_boundary_arn" field.
+func (_c *BronzeAWSIAMUserCreate) SetPermissionsBoundaryArn(v string) *BronzeAWSIAMUserCreate {
+	_c.mutation.SetPermissionsBoundaryArn(v)
+	return _c
+}
+
+// SetNillablePermissionsBoundaryArn sets the "permissions_boundary_arn" field if the given value is not nil.
+func (_c *BronzeAWSIAMUserCreate) SetNillablePermissionsBoundaryArn(v *string) *BronzeAWSIAMUserCreate {
+	if v != nil {
+		_c.SetPermissionsBoundaryArn(*v)
+	}
+	return _c
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (_c *BronzeAWSIAMUserCreate) SetAttachedPoliciesJSON(v json.RawMessage) *BronzeAWSIAMUserCreate {
+	_c.mutation.SetAttachedPoliciesJSON(v)
+	return _c
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (_c *BronzeAWSIAMUserCreate) SetInlinePoliciesJSON(v json.RawMessage) *BronzeAWSIAMUserCreate {
+	_c.mutation.SetInlinePoliciesJSON(v)
+	return _c
+}
+
+// SetGroupsJSON sets the "groups_json" field.
+func (_c *BronzeAWSIAMUserCreate) SetGroupsJSON(v json.RawMessage) *BronzeAWSIAMUserCreate {
+	_c.mutation.SetGroupsJSON(v)
+	return _c
+}
+
+// SetMfaDevicesJSON sets the "mfa_devices_json" field.
+func (_c *BronzeAWSIAMUserCreate) SetMfaDevicesJSON(v json.RawMessage) *BronzeAWSIAMUserCreate {
+	_c.mutation.SetMfaDevicesJSON(v)
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeAWSIAMUserCreate) SetTagsJSON(v json.RawMessage) *BronzeAWSIAMUserCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeAWSIAMUserCreate) SetAccountID(v string) *BronzeAWSIAMUserCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeAWSIAMUserCreate) SetID(v string) *BronzeAWSIAMUserCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeAWSIAMUserMutation object of the builder.
+func (_c *BronzeAWSIAMUserCreate) Mutation() *BronzeAWSIAMUserMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeAWSIAMUser in the database.
+func (_c *BronzeAWSIAMUserCreate) Save(ctx context.Context) (*BronzeAWSIAMUser, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeAWSIAMUserCreate) SaveX(ctx context.Context) *BronzeAWSIAMUser {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSIAMUserCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSIAMUserCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeAWSIAMUserCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`iam: missing required field "BronzeAWSIAMUser.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`iam: missing required field "BronzeAWSIAMUser.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.Arn(); !ok {
+		return &ValidationError{Name: "arn", err: errors.New(`iam: missing required field "BronzeAWSIAMUser.arn"`)}
+	}
+	if v, ok := _c.mutation.Arn(); ok {
+		if err := bronzeawsiamuser.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMUser.arn": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserName(); !ok {
+		return &ValidationError{Name: "user_name", err: errors.New(`iam: missing required field "BronzeAWSIAMUser.user_name"`)}
+	}
+	if v, ok := _c.mutation.UserName(); ok {
+		if err := bronzeawsiamuser.UserNameValidator(v); err != nil {
+			return &ValidationError{Name: "user_name", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMUser.user_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`iam: missing required field "BronzeAWSIAMUser.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzeawsiamuser.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMUser.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeAWSIAMUserCreate) sqlSave(ctx context.Context) (*BronzeAWSIAMUser, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeAWSIAMUser.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeAWSIAMUserCreate) createSpec() (*BronzeAWSIAMUser, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeAWSIAMUser{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzeawsiamuser.Table, sqlgraph.NewFieldSpec(bronzeawsiamuser.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeAWSIAMUser
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.Arn(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldArn, field.TypeString, value)
+		_node.Arn = value
+	}
+	if value, ok := _c.mutation.UserName(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldUserName, field.TypeString, value)
+		_node.UserName = value
+	}
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := _c.mutation.CreateDate(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldCreateDate, field.TypeTime, value)
+		_node.CreateDate = &value
+	}
+	if value, ok := _c.mutation.PermissionsBoundaryArn(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldPermissionsBoundaryArn, field.TypeString, value)
+		_node.PermissionsBoundaryArn = value
+	}
+	if value, ok := _c.mutation.AttachedPoliciesJSON(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldAttachedPoliciesJSON, field.TypeJSON, value)
+		_node.AttachedPoliciesJSON = value
+	}
+	if value, ok := _c.mutation.InlinePoliciesJSON(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldInlinePoliciesJSON, field.TypeJSON, value)
+		_node.InlinePoliciesJSON = value
+	}
+	if value, ok := _c.mutation.GroupsJSON(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldGroupsJSON, field.TypeJSON, value)
+		_node.GroupsJSON = value
+	}
+	if value, ok := _c.mutation.MfaDevicesJSON(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldMfaDevicesJSON, field.TypeJSON, value)
+		_node.MfaDevicesJSON = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	return _node, _spec
+}
+
+// BronzeAWSIAMUserCreateBulk is the builder for creating many BronzeAWSIAMUser entities in bulk.
+type BronzeAWSIAMUserCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeAWSIAMUserCreate
+}
+
+// Save creates the BronzeAWSIAMUser entities in the database.
+func (_c *BronzeAWSIAMUserCreateBulk) Save(ctx context.Context) ([]*BronzeAWSIAMUser, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeAWSIAMUser, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeAWSIAMUserMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeAWSIAMUserCreateBulk) SaveX(ctx context.Context) []*BronzeAWSIAMUser {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSIAMUserCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSIAMUserCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
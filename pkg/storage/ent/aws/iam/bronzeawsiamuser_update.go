@@ -0,0 +1,811 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamuser"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSIAMUserUpdate is the builder for updating BronzeAWSIAMUser entities.
+type BronzeAWSIAMUserUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSIAMUserMutation
+}
+
+// Where appends a list predicates to the BronzeAWSIAMUserUpdate builder.
+func (_u *BronzeAWSIAMUserUpdate) Where(ps ...predicate.BronzeAWSIAMUser) *BronzeAWSIAMUserUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSIAMUserUpdate) SetCollectedAt(v time.Time) *BronzeAWSIAMUserUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSIAMUserUpdate) SetNillableCollectedAt(v *time.Time) *BronzeAWSIAMUserUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeAWSIAMUserUpdate) SetArn(v string) *BronzeAWSIAMUserUpdate {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeAWSIAMUserUpdate) SetNillableArn(v *string) *BronzeAWSIAMUserUpdate {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *BronzeAWSIAMUserUpdate) SetUserName(v string) *BronzeAWSIAMUserUpdate {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *BronzeAWSIAMUserUpdate) SetNillableUserName(v *string) *BronzeAWSIAMUserUpdate {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *BronzeAWSIAMUserUpdate) SetPath(v string) *BronzeAWSIAMUserUpdate {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BronzeAWSIAMUserUpdate) SetNillablePath(v *string) *BronzeAWSIAMUserUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// ClearPath clears the value of the "path" field.
+func (_u *BronzeAWSIAMUserUpdate) ClearPath() *BronzeAWSIAMUserUpdate {
+	_u.mutation.ClearPath()
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeAWSIAMUserUpdate) SetCreateDate(v time.Time) *BronzeAWSIAMUserUpdate {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMUserUpdate) SetNillableCreateDate(v *time.Time) *BronzeAWSIAMUserUpdate {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeAWSIAMUserUpdate) ClearCreateDate() *BronzeAWSIAMUserUpdate {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetPermissionsBoundaryArn sets the "permissions_boundary_arn" field.
+func (_u *BronzeAWSIAMUserUpdate) SetPermissionsBoundaryArn(v string) *BronzeAWSIAMUserUpdate {
+	_u.mutation.SetPermissionsBoundaryArn(v)
+	return _u
+}
+
+// SetNillablePermissionsBoundaryArn sets the "permissions_boundary_arn" field if the given value is not nil.
+func (_u *BronzeAWSIAMUserUpdate) SetNillablePermissionsBoundaryArn(v *string) *BronzeAWSIAMUserUpdate {
+	if v != nil {
+		_u.SetPermissionsBoundaryArn(*v)
+	}
+	return _u
+}
+
+// ClearPermissionsBoundaryArn clears the value of the "permissions_boundary_arn" field.
+func (_u *BronzeAWSIAMUserUpdate) ClearPermissionsBoundaryArn() *BronzeAWSIAMUserUpdate {
+	_u.mutation.ClearPermissionsBoundaryArn()
+	return _u
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (_u *BronzeAWSIAMUserUpdate) SetAttachedPoliciesJSON(v json.RawMessage) *BronzeAWSIAMUserUpdate {
+	_u.mutation.SetAttachedPoliciesJSON(v)
+	return _u
+}
+
+// AppendAttachedPoliciesJSON appends value to the "attached_policies_json" field.
+func (_u *BronzeAWSIAMUserUpdate) AppendAttachedPoliciesJSON(v json.RawMessage) *BronzeAWSIAMUserUpdate {
+	_u.mutation.AppendAttachedPoliciesJSON(v)
+	return _u
+}
+
+// ClearAttachedPoliciesJSON clears the value of the "attached_policies_json" field.
+func (_u *BronzeAWSIAMUserUpdate) ClearAttachedPoliciesJSON() *BronzeAWSIAMUserUpdate {
+	_u.mutation.ClearAttachedPoliciesJSON()
+	return _u
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (_u *BronzeAWSIAMUserUpdate) SetInlinePoliciesJSON(v json.RawMessage) *BronzeAWSIAMUserUpdate {
+	_u.mutation.SetInlinePoliciesJSON(v)
+	return _u
+}
+
+// AppendInlinePoliciesJSON appends value to the "inline_policies_json" field.
+func (_u *BronzeAWSIAMUserUpdate) AppendInlinePoliciesJSON(v json.RawMessage) *BronzeAWSIAMUserUpdate {
+	_u.mutation.AppendInlinePoliciesJSON(v)
+	return _u
+}
+
+// ClearInlinePoliciesJSON clears the value of the "inline_policies_json" field.
+func (_u *BronzeAWSIAMUserUpdate) ClearInlinePoliciesJSON() *BronzeAWSIAMUserUpdate {
+	_u.mutation.ClearInlinePoliciesJSON()
+	return _u
+}
+
+// SetGroupsJSON sets the "groups_json" field.
+func (_u *BronzeAWSIAMUserUpdate) SetGroupsJSON(v json.RawMessage) *BronzeAWSIAMUserUpdate {
+	_u.mutation.SetGroupsJSON(v)
+	return _u
+}
+
+// AppendGroupsJSON appends value to the "groups_json" field.
+func (_u *BronzeAWSIAMUserUpdate) AppendGroupsJSON(v json.RawMessage) *BronzeAWSIAMUserUpdate {
+	_u.mutation.AppendGroupsJSON(v)
+	return _u
+}
+
+// ClearGroupsJSON clears the value of the "groups_json" field.
+func (_u *BronzeAWSIAMUserUpdate) ClearGroupsJSON() *BronzeAWSIAMUserUpdate {
+	_u.mutation.ClearGroupsJSON()
+	return _u
+}
+
+// SetMfaDevicesJSON sets the "mfa_devices_json" field.
+func (_u *BronzeAWSIAMUserUpdate) SetMfaDevicesJSON(v json.RawMessage) *BronzeAWSIAMUserUpdate {
+	_u.mutation.SetMfaDevicesJSON(v)
+	return _u
+}
+
+// AppendMfaDevicesJSON appends value to the "mfa_devices_json" field.
+func (_u *BronzeAWSIAMUserUpdate) AppendMfaDevicesJSON(v json.RawMessage) *BronzeAWSIAMUserUpdate {
+	_u.mutation.AppendMfaDevicesJSON(v)
+	return _u
+}
+
+// ClearMfaDevicesJSON clears the value of the "mfa_devices_json" field.
+func (_u *BronzeAWSIAMUserUpdate) ClearMfaDevicesJSON() *BronzeAWSIAMUserUpdate {
+	_u.mutation.ClearMfaDevicesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSIAMUserUpdate) SetTagsJSON(v json.RawMessage) *BronzeAWSIAMUserUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSIAMUserUpdate) AppendTagsJSON(v json.RawMessage) *BronzeAWSIAMUserUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSIAMUserUpdate) ClearTagsJSON() *BronzeAWSIAMUserUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSIAMUserUpdate) SetAccountID(v string) *BronzeAWSIAMUserUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSIAMUserUpdate) SetNillableAccountID(v *string) *BronzeAWSIAMUserUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSIAMUserMutation object of the builder.
+func (_u *BronzeAWSIAMUserUpdate) Mutation() *BronzeAWSIAMUserMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeAWSIAMUserUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSIAMUserUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeAWSIAMUserUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSIAMUserUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSIAMUserUpdate) check() error {
+	if v, ok := _u.mutation.Arn(); ok {
+		if err := bronzeawsiamuser.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMUser.arn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserName(); ok {
+		if err := bronzeawsiamuser.UserNameValidator(v); err != nil {
+			return &ValidationError{Name: "user_name", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMUser.user_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsiamuser.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMUser.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSIAMUserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsiamuser.Table, bronzeawsiamuser.Columns, sqlgraph.NewFieldSpec(bronzeawsiamuser.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldArn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldUserName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldPath, field.TypeString, value)
+	}
+	if _u.mutation.PathCleared() {
+		_spec.ClearField(bronzeawsiamuser.FieldPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzeawsiamuser.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PermissionsBoundaryArn(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldPermissionsBoundaryArn, field.TypeString, value)
+	}
+	if _u.mutation.PermissionsBoundaryArnCleared() {
+		_spec.ClearField(bronzeawsiamuser.FieldPermissionsBoundaryArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachedPoliciesJSON(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldAttachedPoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttachedPoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamuser.FieldAttachedPoliciesJSON, value)
+		})
+	}
+	if _u.mutation.AttachedPoliciesJSONCleared() {
+		_spec.ClearField(bronzeawsiamuser.FieldAttachedPoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InlinePoliciesJSON(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldInlinePoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedInlinePoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamuser.FieldInlinePoliciesJSON, value)
+		})
+	}
+	if _u.mutation.InlinePoliciesJSONCleared() {
+		_spec.ClearField(bronzeawsiamuser.FieldInlinePoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GroupsJSON(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldGroupsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamuser.FieldGroupsJSON, value)
+		})
+	}
+	if _u.mutation.GroupsJSONCleared() {
+		_spec.ClearField(bronzeawsiamuser.FieldGroupsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MfaDevicesJSON(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldMfaDevicesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMfaDevicesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamuser.FieldMfaDevicesJSON, value)
+		})
+	}
+	if _u.mutation.MfaDevicesJSONCleared() {
+		_spec.ClearField(bronzeawsiamuser.FieldMfaDevicesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamuser.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsiamuser.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSIAMUser
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsiamuser.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeAWSIAMUserUpdateOne is the builder for updating a single BronzeAWSIAMUser entity.
+type BronzeAWSIAMUserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeAWSIAMUserMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSIAMUserUpdateOne) SetCollectedAt(v time.Time) *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSIAMUserUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeAWSIAMUserUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeAWSIAMUserUpdateOne) SetArn(v string) *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeAWSIAMUserUpdateOne) SetNillableArn(v *string) *BronzeAWSIAMUserUpdateOne {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *BronzeAWSIAMUserUpdateOne) SetUserName(v string) *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *BronzeAWSIAMUserUpdateOne) SetNillableUserName(v *string) *BronzeAWSIAMUserUpdateOne {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *BronzeAWSIAMUserUpdateOne) SetPath(v string) *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BronzeAWSIAMUserUpdateOne) SetNillablePath(v *string) *BronzeAWSIAMUserUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// ClearPath clears the value of the "path" field.
+func (_u *BronzeAWSIAMUserUpdateOne) ClearPath() *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.ClearPath()
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeAWSIAMUserUpdateOne) SetCreateDate(v time.Time) *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeAWSIAMUserUpdateOne) SetNillableCreateDate(v *time.Time) *BronzeAWSIAMUserUpdateOne {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeAWSIAMUserUpdateOne) ClearCreateDate() *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetPermissionsBoundaryArn sets the "permissions_boundary_arn" field.
+func (_u *BronzeAWSIAMUserUpdateOne) SetPermissionsBoundaryArn(v string) *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.SetPermissionsBoundaryArn(v)
+	return _u
+}
+
+// SetNillablePermissionsBoundaryArn sets the "permissions_boundary_arn" field if the given value is not nil.
+func (_u *BronzeAWSIAMUserUpdateOne) SetNillablePermissionsBoundaryArn(v *string) *BronzeAWSIAMUserUpdateOne {
+	if v != nil {
+		_u.SetPermissionsBoundaryArn(*v)
+	}
+	return _u
+}
+
+// ClearPermissionsBoundaryArn clears the value of the "permissions_boundary_arn" field.
+func (_u *BronzeAWSIAMUserUpdateOne) ClearPermissionsBoundaryArn() *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.ClearPermissionsBoundaryArn()
+	return _u
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (_u *BronzeAWSIAMUserUpdateOne) SetAttachedPoliciesJSON(v json.RawMessage) *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.SetAttachedPoliciesJSON(v)
+	return _u
+}
+
+// AppendAttachedPoliciesJSON appends value to the "attached_policies_json" field.
+func (_u *BronzeAWSIAMUserUpdateOne) AppendAttachedPoliciesJSON(v json.RawMessage) *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.AppendAttachedPoliciesJSON(v)
+	return _u
+}
+
+// ClearAttachedPoliciesJSON clears the value of the "attached_policies_json" field.
+func (_u *BronzeAWSIAMUserUpdateOne) ClearAttachedPoliciesJSON() *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.ClearAttachedPoliciesJSON()
+	return _u
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (_u *BronzeAWSIAMUserUpdateOne) SetInlinePoliciesJSON(v json.RawMessage) *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.SetInlinePoliciesJSON(v)
+	return _u
+}
+
+// AppendInlinePoliciesJSON appends value to the "inline_policies_json" field.
+func (_u *BronzeAWSIAMUserUpdateOne) AppendInlinePoliciesJSON(v json.RawMessage) *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.AppendInlinePoliciesJSON(v)
+	return _u
+}
+
+// ClearInlinePoliciesJSON clears the value of the "inline_policies_json" field.
+func (_u *BronzeAWSIAMUserUpdateOne) ClearInlinePoliciesJSON() *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.ClearInlinePoliciesJSON()
+	return _u
+}
+
+// SetGroupsJSON sets the "groups_json" field.
+func (_u *BronzeAWSIAMUserUpdateOne) SetGroupsJSON(v json.RawMessage) *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.SetGroupsJSON(v)
+	return _u
+}
+
+// AppendGroupsJSON appends value to the "groups_json" field.
+func (_u *BronzeAWSIAMUserUpdateOne) AppendGroupsJSON(v json.RawMessage) *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.AppendGroupsJSON(v)
+	return _u
+}
+
+// ClearGroupsJSON clears the value of the "groups_json" field.
+func (_u *BronzeAWSIAMUserUpdateOne) ClearGroupsJSON() *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.ClearGroupsJSON()
+	return _u
+}
+
+// SetMfaDevicesJSON sets the "mfa_devices_json" field.
+func (_u *BronzeAWSIAMUserUpdateOne) SetMfaDevicesJSON(v json.RawMessage) *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.SetMfaDevicesJSON(v)
+	return _u
+}
+
+// AppendMfaDevicesJSON appends value to the "mfa_devices_json" field.
+func (_u *BronzeAWSIAMUserUpdateOne) AppendMfaDevicesJSON(v json.RawMessage) *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.AppendMfaDevicesJSON(v)
+	return _u
+}
+
+// ClearMfaDevicesJSON clears the value of the "mfa_devices_json" field.
+func (_u *BronzeAWSIAMUserUpdateOne) ClearMfaDevicesJSON() *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.ClearMfaDevicesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSIAMUserUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSIAMUserUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSIAMUserUpdateOne) ClearTagsJSON() *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSIAMUserUpdateOne) SetAccountID(v string) *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSIAMUserUpdateOne) SetNillableAccountID(v *string) *BronzeAWSIAMUserUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSIAMUserMutation object of the builder.
+func (_u *BronzeAWSIAMUserUpdateOne) Mutation() *BronzeAWSIAMUserMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeAWSIAMUserUpdate builder.
+func (_u *BronzeAWSIAMUserUpdateOne) Where(ps ...predicate.BronzeAWSIAMUser) *BronzeAWSIAMUserUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeAWSIAMUserUpdateOne) Select(field string, fields ...string) *BronzeAWSIAMUserUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeAWSIAMUser entity.
+func (_u *BronzeAWSIAMUserUpdateOne) Save(ctx context.Context) (*BronzeAWSIAMUser, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSIAMUserUpdateOne) SaveX(ctx context.Context) *BronzeAWSIAMUser {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeAWSIAMUserUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSIAMUserUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSIAMUserUpdateOne) check() error {
+	if v, ok := _u.mutation.Arn(); ok {
+		if err := bronzeawsiamuser.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMUser.arn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserName(); ok {
+		if err := bronzeawsiamuser.UserNameValidator(v); err != nil {
+			return &ValidationError{Name: "user_name", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMUser.user_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawsiamuser.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeAWSIAMUser.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSIAMUserUpdateOne) sqlSave(ctx context.Context) (_node *BronzeAWSIAMUser, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawsiamuser.Table, bronzeawsiamuser.Columns, sqlgraph.NewFieldSpec(bronzeawsiamuser.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`iam: missing "BronzeAWSIAMUser.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawsiamuser.FieldID)
+		for _, f := range fields {
+			if !bronzeawsiamuser.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("iam: invalid field %q for query", f)}
+			}
+			if f != bronzeawsiamuser.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldArn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldUserName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldPath, field.TypeString, value)
+	}
+	if _u.mutation.PathCleared() {
+		_spec.ClearField(bronzeawsiamuser.FieldPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzeawsiamuser.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PermissionsBoundaryArn(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldPermissionsBoundaryArn, field.TypeString, value)
+	}
+	if _u.mutation.PermissionsBoundaryArnCleared() {
+		_spec.ClearField(bronzeawsiamuser.FieldPermissionsBoundaryArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachedPoliciesJSON(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldAttachedPoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttachedPoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamuser.FieldAttachedPoliciesJSON, value)
+		})
+	}
+	if _u.mutation.AttachedPoliciesJSONCleared() {
+		_spec.ClearField(bronzeawsiamuser.FieldAttachedPoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InlinePoliciesJSON(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldInlinePoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedInlinePoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamuser.FieldInlinePoliciesJSON, value)
+		})
+	}
+	if _u.mutation.InlinePoliciesJSONCleared() {
+		_spec.ClearField(bronzeawsiamuser.FieldInlinePoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GroupsJSON(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldGroupsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamuser.FieldGroupsJSON, value)
+		})
+	}
+	if _u.mutation.GroupsJSONCleared() {
+		_spec.ClearField(bronzeawsiamuser.FieldGroupsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MfaDevicesJSON(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldMfaDevicesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMfaDevicesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamuser.FieldMfaDevicesJSON, value)
+		})
+	}
+	if _u.mutation.MfaDevicesJSONCleared() {
+		_spec.ClearField(bronzeawsiamuser.FieldMfaDevicesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawsiamuser.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawsiamuser.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawsiamuser.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSIAMUser
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeAWSIAMUser{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawsiamuser.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
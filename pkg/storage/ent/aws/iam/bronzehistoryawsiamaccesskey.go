@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamaccesskey"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryAWSIAMAccessKey is the model entity for the BronzeHistoryAWSIAMAccessKey schema.
+type BronzeHistoryAWSIAMAccessKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze access key by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// UserName holds the value of the "user_name" field.
+	UserName string `json:"user_name,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreateDate holds the value of the "create_date" field.
+	CreateDate *time.Time `json:"create_date,omitempty"`
+	// LastUsedDate holds the value of the "last_used_date" field.
+	LastUsedDate *time.Time `json:"last_used_date,omitempty"`
+	// LastUsedService holds the value of the "last_used_service" field.
+	LastUsedService string `json:"last_used_service,omitempty"`
+	// LastUsedRegion holds the value of the "last_used_region" field.
+	LastUsedRegion string `json:"last_used_region,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID    string `json:"account_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryAWSIAMAccessKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsiamaccesskey.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryawsiamaccesskey.FieldResourceID, bronzehistoryawsiamaccesskey.FieldUserName, bronzehistoryawsiamaccesskey.FieldStatus, bronzehistoryawsiamaccesskey.FieldLastUsedService, bronzehistoryawsiamaccesskey.FieldLastUsedRegion, bronzehistoryawsiamaccesskey.FieldAccountID:
+			values[i] = new(sql.NullString)
+		case bronzehistoryawsiamaccesskey.FieldValidFrom, bronzehistoryawsiamaccesskey.FieldValidTo, bronzehistoryawsiamaccesskey.FieldCollectedAt, bronzehistoryawsiamaccesskey.FieldFirstCollectedAt, bronzehistoryawsiamaccesskey.FieldCreateDate, bronzehistoryawsiamaccesskey.FieldLastUsedDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryAWSIAMAccessKey fields.
+func (_m *BronzeHistoryAWSIAMAccessKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsiamaccesskey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryawsiamaccesskey.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryawsiamaccesskey.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryawsiamaccesskey.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryawsiamaccesskey.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryawsiamaccesskey.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryawsiamaccesskey.FieldUserName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_name", values[i])
+			} else if value.Valid {
+				_m.UserName = value.String
+			}
+		case bronzehistoryawsiamaccesskey.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case bronzehistoryawsiamaccesskey.FieldCreateDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_date", values[i])
+			} else if value.Valid {
+				_m.CreateDate = new(time.Time)
+				*_m.CreateDate = value.Time
+			}
+		case bronzehistoryawsiamaccesskey.FieldLastUsedDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_date", values[i])
+			} else if value.Valid {
+				_m.LastUsedDate = new(time.Time)
+				*_m.LastUsedDate = value.Time
+			}
+		case bronzehistoryawsiamaccesskey.FieldLastUsedService:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_service", values[i])
+			} else if value.Valid {
+				_m.LastUsedService = value.String
+			}
+		case bronzehistoryawsiamaccesskey.FieldLastUsedRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_region", values[i])
+			} else if value.Valid {
+				_m.LastUsedRegion = value.String
+			}
+		case bronzehistoryawsiamaccesskey.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryAWSIAMAccessKey.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryAWSIAMAccessKey) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryAWSIAMAccessKey.
+// Note that you need to call BronzeHistoryAWSIAMAccessKey.Unwrap() before calling this method if this BronzeHistoryAWSIAMAccessKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryAWSIAMAccessKey) Update() *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	return NewBronzeHistoryAWSIAMAccessKeyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryAWSIAMAccessKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryAWSIAMAccessKey) Unwrap() *BronzeHistoryAWSIAMAccessKey {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("iam: BronzeHistoryAWSIAMAccessKey is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryAWSIAMAccessKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryAWSIAMAccessKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("user_name=")
+	builder.WriteString(_m.UserName)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.CreateDate; v != nil {
+		builder.WriteString("create_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastUsedDate; v != nil {
+		builder.WriteString("last_used_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_used_service=")
+	builder.WriteString(_m.LastUsedService)
+	builder.WriteString(", ")
+	builder.WriteString("last_used_region=")
+	builder.WriteString(_m.LastUsedRegion)
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryAWSIAMAccessKeys is a parsable slice of BronzeHistoryAWSIAMAccessKey.
+type BronzeHistoryAWSIAMAccessKeys []*BronzeHistoryAWSIAMAccessKey
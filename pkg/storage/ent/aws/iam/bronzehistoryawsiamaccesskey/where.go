@@ -0,0 +1,820 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryawsiamaccesskey
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLTE(FieldID, id))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldValidTo, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldResourceID, v))
+}
+
+// UserName applies equality check predicate on the "user_name" field. It's identical to UserNameEQ.
+func UserName(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldUserName, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreateDate applies equality check predicate on the "create_date" field. It's identical to CreateDateEQ.
+func CreateDate(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldCreateDate, v))
+}
+
+// LastUsedDate applies equality check predicate on the "last_used_date" field. It's identical to LastUsedDateEQ.
+func LastUsedDate(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldLastUsedDate, v))
+}
+
+// LastUsedService applies equality check predicate on the "last_used_service" field. It's identical to LastUsedServiceEQ.
+func LastUsedService(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldLastUsedService, v))
+}
+
+// LastUsedRegion applies equality check predicate on the "last_used_region" field. It's identical to LastUsedRegionEQ.
+func LastUsedRegion(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldLastUsedRegion, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldAccountID, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNotNull(FieldValidTo))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// UserNameEQ applies the EQ predicate on the "user_name" field.
+func UserNameEQ(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldUserName, v))
+}
+
+// UserNameNEQ applies the NEQ predicate on the "user_name" field.
+func UserNameNEQ(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNEQ(FieldUserName, v))
+}
+
+// UserNameIn applies the In predicate on the "user_name" field.
+func UserNameIn(vs ...string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldIn(FieldUserName, vs...))
+}
+
+// UserNameNotIn applies the NotIn predicate on the "user_name" field.
+func UserNameNotIn(vs ...string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNotIn(FieldUserName, vs...))
+}
+
+// UserNameGT applies the GT predicate on the "user_name" field.
+func UserNameGT(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGT(FieldUserName, v))
+}
+
+// UserNameGTE applies the GTE predicate on the "user_name" field.
+func UserNameGTE(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGTE(FieldUserName, v))
+}
+
+// UserNameLT applies the LT predicate on the "user_name" field.
+func UserNameLT(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLT(FieldUserName, v))
+}
+
+// UserNameLTE applies the LTE predicate on the "user_name" field.
+func UserNameLTE(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLTE(FieldUserName, v))
+}
+
+// UserNameContains applies the Contains predicate on the "user_name" field.
+func UserNameContains(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldContains(FieldUserName, v))
+}
+
+// UserNameHasPrefix applies the HasPrefix predicate on the "user_name" field.
+func UserNameHasPrefix(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldHasPrefix(FieldUserName, v))
+}
+
+// UserNameHasSuffix applies the HasSuffix predicate on the "user_name" field.
+func UserNameHasSuffix(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldHasSuffix(FieldUserName, v))
+}
+
+// UserNameEqualFold applies the EqualFold predicate on the "user_name" field.
+func UserNameEqualFold(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEqualFold(FieldUserName, v))
+}
+
+// UserNameContainsFold applies the ContainsFold predicate on the "user_name" field.
+func UserNameContainsFold(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldContainsFold(FieldUserName, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNotNull(FieldStatus))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreateDateEQ applies the EQ predicate on the "create_date" field.
+func CreateDateEQ(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldCreateDate, v))
+}
+
+// CreateDateNEQ applies the NEQ predicate on the "create_date" field.
+func CreateDateNEQ(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNEQ(FieldCreateDate, v))
+}
+
+// CreateDateIn applies the In predicate on the "create_date" field.
+func CreateDateIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldIn(FieldCreateDate, vs...))
+}
+
+// CreateDateNotIn applies the NotIn predicate on the "create_date" field.
+func CreateDateNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNotIn(FieldCreateDate, vs...))
+}
+
+// CreateDateGT applies the GT predicate on the "create_date" field.
+func CreateDateGT(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGT(FieldCreateDate, v))
+}
+
+// CreateDateGTE applies the GTE predicate on the "create_date" field.
+func CreateDateGTE(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGTE(FieldCreateDate, v))
+}
+
+// CreateDateLT applies the LT predicate on the "create_date" field.
+func CreateDateLT(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLT(FieldCreateDate, v))
+}
+
+// CreateDateLTE applies the LTE predicate on the "create_date" field.
+func CreateDateLTE(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLTE(FieldCreateDate, v))
+}
+
+// CreateDateIsNil applies the IsNil predicate on the "create_date" field.
+func CreateDateIsNil() predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldIsNull(FieldCreateDate))
+}
+
+// CreateDateNotNil applies the NotNil predicate on the "create_date" field.
+func CreateDateNotNil() predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNotNull(FieldCreateDate))
+}
+
+// LastUsedDateEQ applies the EQ predicate on the "last_used_date" field.
+func LastUsedDateEQ(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldLastUsedDate, v))
+}
+
+// LastUsedDateNEQ applies the NEQ predicate on the "last_used_date" field.
+func LastUsedDateNEQ(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNEQ(FieldLastUsedDate, v))
+}
+
+// LastUsedDateIn applies the In predicate on the "last_used_date" field.
+func LastUsedDateIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldIn(FieldLastUsedDate, vs...))
+}
+
+// LastUsedDateNotIn applies the NotIn predicate on the "last_used_date" field.
+func LastUsedDateNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNotIn(FieldLastUsedDate, vs...))
+}
+
+// LastUsedDateGT applies the GT predicate on the "last_used_date" field.
+func LastUsedDateGT(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGT(FieldLastUsedDate, v))
+}
+
+// LastUsedDateGTE applies the GTE predicate on the "last_used_date" field.
+func LastUsedDateGTE(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGTE(FieldLastUsedDate, v))
+}
+
+// LastUsedDateLT applies the LT predicate on the "last_used_date" field.
+func LastUsedDateLT(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLT(FieldLastUsedDate, v))
+}
+
+// LastUsedDateLTE applies the LTE predicate on the "last_used_date" field.
+func LastUsedDateLTE(v time.Time) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLTE(FieldLastUsedDate, v))
+}
+
+// LastUsedDateIsNil applies the IsNil predicate on the "last_used_date" field.
+func LastUsedDateIsNil() predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldIsNull(FieldLastUsedDate))
+}
+
+// LastUsedDateNotNil applies the NotNil predicate on the "last_used_date" field.
+func LastUsedDateNotNil() predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNotNull(FieldLastUsedDate))
+}
+
+// LastUsedServiceEQ applies the EQ predicate on the "last_used_service" field.
+func LastUsedServiceEQ(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldLastUsedService, v))
+}
+
+// LastUsedServiceNEQ applies the NEQ predicate on the "last_used_service" field.
+func LastUsedServiceNEQ(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNEQ(FieldLastUsedService, v))
+}
+
+// LastUsedServiceIn applies the In predicate on the "last_used_service" field.
+func LastUsedServiceIn(vs ...string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldIn(FieldLastUsedService, vs...))
+}
+
+// LastUsedServiceNotIn applies the NotIn predicate on the "last_used_service" field.
+func LastUsedServiceNotIn(vs ...string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNotIn(FieldLastUsedService, vs...))
+}
+
+// LastUsedServiceGT applies the GT predicate on the "last_used_service" field.
+func LastUsedServiceGT(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGT(FieldLastUsedService, v))
+}
+
+// LastUsedServiceGTE applies the GTE predicate on the "last_used_service" field.
+func LastUsedServiceGTE(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGTE(FieldLastUsedService, v))
+}
+
+// LastUsedServiceLT applies the LT predicate on the "last_used_service" field.
+func LastUsedServiceLT(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLT(FieldLastUsedService, v))
+}
+
+// LastUsedServiceLTE applies the LTE predicate on the "last_used_service" field.
+func LastUsedServiceLTE(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLTE(FieldLastUsedService, v))
+}
+
+// LastUsedServiceContains applies the Contains predicate on the "last_used_service" field.
+func LastUsedServiceContains(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldContains(FieldLastUsedService, v))
+}
+
+// LastUsedServiceHasPrefix applies the HasPrefix predicate on the "last_used_service" field.
+func LastUsedServiceHasPrefix(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldHasPrefix(FieldLastUsedService, v))
+}
+
+// LastUsedServiceHasSuffix applies the HasSuffix predicate on the "last_used_service" field.
+func LastUsedServiceHasSuffix(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldHasSuffix(FieldLastUsedService, v))
+}
+
+// LastUsedServiceIsNil applies the IsNil predicate on the "last_used_service" field.
+func LastUsedServiceIsNil() predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldIsNull(FieldLastUsedService))
+}
+
+// LastUsedServiceNotNil applies the NotNil predicate on the "last_used_service" field.
+func LastUsedServiceNotNil() predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNotNull(FieldLastUsedService))
+}
+
+// LastUsedServiceEqualFold applies the EqualFold predicate on the "last_used_service" field.
+func LastUsedServiceEqualFold(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEqualFold(FieldLastUsedService, v))
+}
+
+// LastUsedServiceContainsFold applies the ContainsFold predicate on the "last_used_service" field.
+func LastUsedServiceContainsFold(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldContainsFold(FieldLastUsedService, v))
+}
+
+// LastUsedRegionEQ applies the EQ predicate on the "last_used_region" field.
+func LastUsedRegionEQ(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldLastUsedRegion, v))
+}
+
+// LastUsedRegionNEQ applies the NEQ predicate on the "last_used_region" field.
+func LastUsedRegionNEQ(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNEQ(FieldLastUsedRegion, v))
+}
+
+// LastUsedRegionIn applies the In predicate on the "last_used_region" field.
+func LastUsedRegionIn(vs ...string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldIn(FieldLastUsedRegion, vs...))
+}
+
+// LastUsedRegionNotIn applies the NotIn predicate on the "last_used_region" field.
+func LastUsedRegionNotIn(vs ...string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNotIn(FieldLastUsedRegion, vs...))
+}
+
+// LastUsedRegionGT applies the GT predicate on the "last_used_region" field.
+func LastUsedRegionGT(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGT(FieldLastUsedRegion, v))
+}
+
+// LastUsedRegionGTE applies the GTE predicate on the "last_used_region" field.
+func LastUsedRegionGTE(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGTE(FieldLastUsedRegion, v))
+}
+
+// LastUsedRegionLT applies the LT predicate on the "last_used_region" field.
+func LastUsedRegionLT(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLT(FieldLastUsedRegion, v))
+}
+
+// LastUsedRegionLTE applies the LTE predicate on the "last_used_region" field.
+func LastUsedRegionLTE(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLTE(FieldLastUsedRegion, v))
+}
+
+// LastUsedRegionContains applies the Contains predicate on the "last_used_region" field.
+func LastUsedRegionContains(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldContains(FieldLastUsedRegion, v))
+}
+
+// LastUsedRegionHasPrefix applies the HasPrefix predicate on the "last_used_region" field.
+func LastUsedRegionHasPrefix(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldHasPrefix(FieldLastUsedRegion, v))
+}
+
+// LastUsedRegionHasSuffix applies the HasSuffix predicate on the "last_used_region" field.
+func LastUsedRegionHasSuffix(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldHasSuffix(FieldLastUsedRegion, v))
+}
+
+// LastUsedRegionIsNil applies the IsNil predicate on the "last_used_region" field.
+func LastUsedRegionIsNil() predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldIsNull(FieldLastUsedRegion))
+}
+
+// LastUsedRegionNotNil applies the NotNil predicate on the "last_used_region" field.
+func LastUsedRegionNotNil() predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNotNull(FieldLastUsedRegion))
+}
+
+// LastUsedRegionEqualFold applies the EqualFold predicate on the "last_used_region" field.
+func LastUsedRegionEqualFold(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEqualFold(FieldLastUsedRegion, v))
+}
+
+// LastUsedRegionContainsFold applies the ContainsFold predicate on the "last_used_region" field.
+func LastUsedRegionContainsFold(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldContainsFold(FieldLastUsedRegion, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeHistoryAWSIAMAccessKey) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeHistoryAWSIAMAccessKey) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeHistoryAWSIAMAccessKey) predicate.BronzeHistoryAWSIAMAccessKey {
+	return predicate.BronzeHistoryAWSIAMAccessKey(sql.NotPredicates(p))
+}
@@ -0,0 +1,385 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamaccesskey"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSIAMAccessKeyCreate is the builder for creating a BronzeHistoryAWSIAMAccessKey entity.
+type BronzeHistoryAWSIAMAccessKeyCreate struct {
+	config
+	mutation *BronzeHistoryAWSIAMAccessKeyMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SetValidFrom(v time.Time) *BronzeHistoryAWSIAMAccessKeyCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SetValidTo(v time.Time) *BronzeHistoryAWSIAMAccessKeyCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSIAMAccessKeyCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SetCollectedAt(v time.Time) *BronzeHistoryAWSIAMAccessKeyCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSIAMAccessKeyCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SetResourceID(v string) *BronzeHistoryAWSIAMAccessKeyCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetUserName sets the "user_name" field.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SetUserName(v string) *BronzeHistoryAWSIAMAccessKeyCreate {
+	_c.mutation.SetUserName(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SetStatus(v string) *BronzeHistoryAWSIAMAccessKeyCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SetNillableStatus(v *string) *BronzeHistoryAWSIAMAccessKeyCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SetCreateDate(v time.Time) *BronzeHistoryAWSIAMAccessKeyCreate {
+	_c.mutation.SetCreateDate(v)
+	return _c
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SetNillableCreateDate(v *time.Time) *BronzeHistoryAWSIAMAccessKeyCreate {
+	if v != nil {
+		_c.SetCreateDate(*v)
+	}
+	return _c
+}
+
+// SetLastUsedDate sets the "last_used_date" field.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SetLastUsedDate(v time.Time) *BronzeHistoryAWSIAMAccessKeyCreate {
+	_c.mutation.SetLastUsedDate(v)
+	return _c
+}
+
+// SetNillableLastUsedDate sets the "last_used_date" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SetNillableLastUsedDate(v *time.Time) *BronzeHistoryAWSIAMAccessKeyCreate {
+	if v != nil {
+		_c.SetLastUsedDate(*v)
+	}
+	return _c
+}
+
+// SetLastUsedService sets the "last_used_service" field.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SetLastUsedService(v string) *BronzeHistoryAWSIAMAccessKeyCreate {
+	_c.mutation.SetLastUsedService(v)
+	return _c
+}
+
+// SetNillableLastUsedService sets the "last_used_service" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SetNillableLastUsedService(v *string) *BronzeHistoryAWSIAMAccessKeyCreate {
+	if v != nil {
+		_c.SetLastUsedService(*v)
+	}
+	return _c
+}
+
+// SetLastUsedRegion sets the "last_used_region" field.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SetLastUsedRegion(v string) *BronzeHistoryAWSIAMAccessKeyCreate {
+	_c.mutation.SetLastUsedRegion(v)
+	return _c
+}
+
+// SetNillableLastUsedRegion sets the "last_used_region" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SetNillableLastUsedRegion(v *string) *BronzeHistoryAWSIAMAccessKeyCreate {
+	if v != nil {
+		_c.SetLastUsedRegion(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SetAccountID(v string) *BronzeHistoryAWSIAMAccessKeyCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SetID(v uint) *BronzeHistoryAWSIAMAccessKeyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryAWSIAMAccessKeyMutation object of the builder.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) Mutation() *BronzeHistoryAWSIAMAccessKeyMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryAWSIAMAccessKey in the database.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) Save(ctx context.Context) (*BronzeHistoryAWSIAMAccessKey, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) SaveX(ctx context.Context) *BronzeHistoryAWSIAMAccessKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMAccessKey.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMAccessKey.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMAccessKey.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMAccessKey.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsiamaccesskey.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMAccessKey.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserName(); !ok {
+		return &ValidationError{Name: "user_name", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMAccessKey.user_name"`)}
+	}
+	if v, ok := _c.mutation.UserName(); ok {
+		if err := bronzehistoryawsiamaccesskey.UserNameValidator(v); err != nil {
+			return &ValidationError{Name: "user_name", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMAccessKey.user_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMAccessKey.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzehistoryawsiamaccesskey.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMAccessKey.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) sqlSave(ctx context.Context) (*BronzeHistoryAWSIAMAccessKey, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryAWSIAMAccessKeyCreate) createSpec() (*BronzeHistoryAWSIAMAccessKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryAWSIAMAccessKey{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryawsiamaccesskey.Table, sqlgraph.NewFieldSpec(bronzehistoryawsiamaccesskey.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryAWSIAMAccessKey
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.UserName(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldUserName, field.TypeString, value)
+		_node.UserName = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CreateDate(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldCreateDate, field.TypeTime, value)
+		_node.CreateDate = &value
+	}
+	if value, ok := _c.mutation.LastUsedDate(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldLastUsedDate, field.TypeTime, value)
+		_node.LastUsedDate = &value
+	}
+	if value, ok := _c.mutation.LastUsedService(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldLastUsedService, field.TypeString, value)
+		_node.LastUsedService = value
+	}
+	if value, ok := _c.mutation.LastUsedRegion(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldLastUsedRegion, field.TypeString, value)
+		_node.LastUsedRegion = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryAWSIAMAccessKeyCreateBulk is the builder for creating many BronzeHistoryAWSIAMAccessKey entities in bulk.
+type BronzeHistoryAWSIAMAccessKeyCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryAWSIAMAccessKeyCreate
+}
+
+// Save creates the BronzeHistoryAWSIAMAccessKey entities in the database.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryAWSIAMAccessKey, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryAWSIAMAccessKey, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryAWSIAMAccessKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryAWSIAMAccessKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSIAMAccessKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
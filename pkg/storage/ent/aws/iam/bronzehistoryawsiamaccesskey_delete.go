@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamaccesskey"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSIAMAccessKeyDelete is the builder for deleting a BronzeHistoryAWSIAMAccessKey entity.
+type BronzeHistoryAWSIAMAccessKeyDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSIAMAccessKeyMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMAccessKeyDelete builder.
+func (_d *BronzeHistoryAWSIAMAccessKeyDelete) Where(ps ...predicate.BronzeHistoryAWSIAMAccessKey) *BronzeHistoryAWSIAMAccessKeyDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeHistoryAWSIAMAccessKeyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryAWSIAMAccessKeyDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeHistoryAWSIAMAccessKeyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzehistoryawsiamaccesskey.Table, sqlgraph.NewFieldSpec(bronzehistoryawsiamaccesskey.FieldID, field.TypeUint))
+	_spec.Node.Schema = _d.schemaConfig.BronzeHistoryAWSIAMAccessKey
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeHistoryAWSIAMAccessKeyDeleteOne is the builder for deleting a single BronzeHistoryAWSIAMAccessKey entity.
+type BronzeHistoryAWSIAMAccessKeyDeleteOne struct {
+	_d *BronzeHistoryAWSIAMAccessKeyDelete
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMAccessKeyDelete builder.
+func (_d *BronzeHistoryAWSIAMAccessKeyDeleteOne) Where(ps ...predicate.BronzeHistoryAWSIAMAccessKey) *BronzeHistoryAWSIAMAccessKeyDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeHistoryAWSIAMAccessKeyDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzehistoryawsiamaccesskey.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryAWSIAMAccessKeyDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
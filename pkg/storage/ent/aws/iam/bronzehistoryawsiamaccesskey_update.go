@@ -0,0 +1,709 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamaccesskey"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSIAMAccessKeyUpdate is the builder for updating BronzeHistoryAWSIAMAccessKey entities.
+type BronzeHistoryAWSIAMAccessKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSIAMAccessKeyMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMAccessKeyUpdate builder.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) Where(ps ...predicate.BronzeHistoryAWSIAMAccessKey) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetValidTo(v time.Time) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) ClearValidTo() *BronzeHistoryAWSIAMAccessKeyUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetCollectedAt(v time.Time) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetResourceID(v string) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetNillableResourceID(v *string) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetUserName(v string) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetNillableUserName(v *string) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetStatus(v string) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetNillableStatus(v *string) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) ClearStatus() *BronzeHistoryAWSIAMAccessKeyUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetCreateDate(v time.Time) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetNillableCreateDate(v *time.Time) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) ClearCreateDate() *BronzeHistoryAWSIAMAccessKeyUpdate {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetLastUsedDate sets the "last_used_date" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetLastUsedDate(v time.Time) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	_u.mutation.SetLastUsedDate(v)
+	return _u
+}
+
+// SetNillableLastUsedDate sets the "last_used_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetNillableLastUsedDate(v *time.Time) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	if v != nil {
+		_u.SetLastUsedDate(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedDate clears the value of the "last_used_date" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) ClearLastUsedDate() *BronzeHistoryAWSIAMAccessKeyUpdate {
+	_u.mutation.ClearLastUsedDate()
+	return _u
+}
+
+// SetLastUsedService sets the "last_used_service" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetLastUsedService(v string) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	_u.mutation.SetLastUsedService(v)
+	return _u
+}
+
+// SetNillableLastUsedService sets the "last_used_service" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetNillableLastUsedService(v *string) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	if v != nil {
+		_u.SetLastUsedService(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedService clears the value of the "last_used_service" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) ClearLastUsedService() *BronzeHistoryAWSIAMAccessKeyUpdate {
+	_u.mutation.ClearLastUsedService()
+	return _u
+}
+
+// SetLastUsedRegion sets the "last_used_region" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetLastUsedRegion(v string) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	_u.mutation.SetLastUsedRegion(v)
+	return _u
+}
+
+// SetNillableLastUsedRegion sets the "last_used_region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetNillableLastUsedRegion(v *string) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	if v != nil {
+		_u.SetLastUsedRegion(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedRegion clears the value of the "last_used_region" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) ClearLastUsedRegion() *BronzeHistoryAWSIAMAccessKeyUpdate {
+	_u.mutation.ClearLastUsedRegion()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetAccountID(v string) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SetNillableAccountID(v *string) *BronzeHistoryAWSIAMAccessKeyUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSIAMAccessKeyMutation object of the builder.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) Mutation() *BronzeHistoryAWSIAMAccessKeyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsiamaccesskey.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMAccessKey.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserName(); ok {
+		if err := bronzehistoryawsiamaccesskey.UserNameValidator(v); err != nil {
+			return &ValidationError{Name: "user_name", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMAccessKey.user_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsiamaccesskey.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMAccessKey.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsiamaccesskey.Table, bronzehistoryawsiamaccesskey.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsiamaccesskey.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsiamaccesskey.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldUserName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(bronzehistoryawsiamaccesskey.FieldStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzehistoryawsiamaccesskey.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedDate(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldLastUsedDate, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedDateCleared() {
+		_spec.ClearField(bronzehistoryawsiamaccesskey.FieldLastUsedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedService(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldLastUsedService, field.TypeString, value)
+	}
+	if _u.mutation.LastUsedServiceCleared() {
+		_spec.ClearField(bronzehistoryawsiamaccesskey.FieldLastUsedService, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastUsedRegion(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldLastUsedRegion, field.TypeString, value)
+	}
+	if _u.mutation.LastUsedRegionCleared() {
+		_spec.ClearField(bronzehistoryawsiamaccesskey.FieldLastUsedRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSIAMAccessKey
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsiamaccesskey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryAWSIAMAccessKeyUpdateOne is the builder for updating a single BronzeHistoryAWSIAMAccessKey entity.
+type BronzeHistoryAWSIAMAccessKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryAWSIAMAccessKeyMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetValidTo(v time.Time) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) ClearValidTo() *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetResourceID(v string) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetUserName(v string) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetNillableUserName(v *string) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetStatus(v string) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetNillableStatus(v *string) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) ClearStatus() *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetCreateDate(v time.Time) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetNillableCreateDate(v *time.Time) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) ClearCreateDate() *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetLastUsedDate sets the "last_used_date" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetLastUsedDate(v time.Time) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	_u.mutation.SetLastUsedDate(v)
+	return _u
+}
+
+// SetNillableLastUsedDate sets the "last_used_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetNillableLastUsedDate(v *time.Time) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	if v != nil {
+		_u.SetLastUsedDate(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedDate clears the value of the "last_used_date" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) ClearLastUsedDate() *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	_u.mutation.ClearLastUsedDate()
+	return _u
+}
+
+// SetLastUsedService sets the "last_used_service" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetLastUsedService(v string) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	_u.mutation.SetLastUsedService(v)
+	return _u
+}
+
+// SetNillableLastUsedService sets the "last_used_service" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetNillableLastUsedService(v *string) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	if v != nil {
+		_u.SetLastUsedService(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedService clears the value of the "last_used_service" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) ClearLastUsedService() *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	_u.mutation.ClearLastUsedService()
+	return _u
+}
+
+// SetLastUsedRegion sets the "last_used_region" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetLastUsedRegion(v string) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	_u.mutation.SetLastUsedRegion(v)
+	return _u
+}
+
+// SetNillableLastUsedRegion sets the "last_used_region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetNillableLastUsedRegion(v *string) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	if v != nil {
+		_u.SetLastUsedRegion(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedRegion clears the value of the "last_used_region" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) ClearLastUsedRegion() *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	_u.mutation.ClearLastUsedRegion()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetAccountID(v string) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSIAMAccessKeyMutation object of the builder.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) Mutation() *BronzeHistoryAWSIAMAccessKeyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMAccessKeyUpdate builder.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) Where(ps ...predicate.BronzeHistoryAWSIAMAccessKey) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) Select(field string, fields ...string) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryAWSIAMAccessKey entity.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) Save(ctx context.Context) (*BronzeHistoryAWSIAMAccessKey, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) SaveX(ctx context.Context) *BronzeHistoryAWSIAMAccessKey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsiamaccesskey.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMAccessKey.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserName(); ok {
+		if err := bronzehistoryawsiamaccesskey.UserNameValidator(v); err != nil {
+			return &ValidationError{Name: "user_name", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMAccessKey.user_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsiamaccesskey.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMAccessKey.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSIAMAccessKeyUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryAWSIAMAccessKey, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsiamaccesskey.Table, bronzehistoryawsiamaccesskey.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsiamaccesskey.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`iam: missing "BronzeHistoryAWSIAMAccessKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawsiamaccesskey.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryawsiamaccesskey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("iam: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryawsiamaccesskey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsiamaccesskey.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldUserName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(bronzehistoryawsiamaccesskey.FieldStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzehistoryawsiamaccesskey.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedDate(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldLastUsedDate, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedDateCleared() {
+		_spec.ClearField(bronzehistoryawsiamaccesskey.FieldLastUsedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedService(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldLastUsedService, field.TypeString, value)
+	}
+	if _u.mutation.LastUsedServiceCleared() {
+		_spec.ClearField(bronzehistoryawsiamaccesskey.FieldLastUsedService, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastUsedRegion(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldLastUsedRegion, field.TypeString, value)
+	}
+	if _u.mutation.LastUsedRegionCleared() {
+		_spec.ClearField(bronzehistoryawsiamaccesskey.FieldLastUsedRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsiamaccesskey.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSIAMAccessKey
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryAWSIAMAccessKey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsiamaccesskey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
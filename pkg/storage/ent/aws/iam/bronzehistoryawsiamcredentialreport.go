@@ -0,0 +1,441 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamcredentialreport"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryAWSIAMCredentialReport is the model entity for the BronzeHistoryAWSIAMCredentialReport schema.
+type BronzeHistoryAWSIAMCredentialReport struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze credential report row by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// User holds the value of the "user" field.
+	User string `json:"user,omitempty"`
+	// UserCreationTime holds the value of the "user_creation_time" field.
+	UserCreationTime *time.Time `json:"user_creation_time,omitempty"`
+	// PasswordEnabled holds the value of the "password_enabled" field.
+	PasswordEnabled string `json:"password_enabled,omitempty"`
+	// PasswordLastUsed holds the value of the "password_last_used" field.
+	PasswordLastUsed *time.Time `json:"password_last_used,omitempty"`
+	// PasswordLastChanged holds the value of the "password_last_changed" field.
+	PasswordLastChanged *time.Time `json:"password_last_changed,omitempty"`
+	// PasswordNextRotation holds the value of the "password_next_rotation" field.
+	PasswordNextRotation *time.Time `json:"password_next_rotation,omitempty"`
+	// MfaActive holds the value of the "mfa_active" field.
+	MfaActive bool `json:"mfa_active,omitempty"`
+	// AccessKey1Active holds the value of the "access_key_1_active" field.
+	AccessKey1Active bool `json:"access_key_1_active,omitempty"`
+	// AccessKey1LastRotated holds the value of the "access_key_1_last_rotated" field.
+	AccessKey1LastRotated *time.Time `json:"access_key_1_last_rotated,omitempty"`
+	// AccessKey1LastUsedDate holds the value of the "access_key_1_last_used_date" field.
+	AccessKey1LastUsedDate *time.Time `json:"access_key_1_last_used_date,omitempty"`
+	// AccessKey1LastUsedRegion holds the value of the "access_key_1_last_used_region" field.
+	AccessKey1LastUsedRegion string `json:"access_key_1_last_used_region,omitempty"`
+	// AccessKey1LastUsedService holds the value of the "access_key_1_last_used_service" field.
+	AccessKey1LastUsedService string `json:"access_key_1_last_used_service,omitempty"`
+	// AccessKey2Active holds the value of the "access_key_2_active" field.
+	AccessKey2Active bool `json:"access_key_2_active,omitempty"`
+	// AccessKey2LastRotated holds the value of the "access_key_2_last_rotated" field.
+	AccessKey2LastRotated *time.Time `json:"access_key_2_last_rotated,omitempty"`
+	// AccessKey2LastUsedDate holds the value of the "access_key_2_last_used_date" field.
+	AccessKey2LastUsedDate *time.Time `json:"access_key_2_last_used_date,omitempty"`
+	// AccessKey2LastUsedRegion holds the value of the "access_key_2_last_used_region" field.
+	AccessKey2LastUsedRegion string `json:"access_key_2_last_used_region,omitempty"`
+	// AccessKey2LastUsedService holds the value of the "access_key_2_last_used_service" field.
+	AccessKey2LastUsedService string `json:"access_key_2_last_used_service,omitempty"`
+	// Cert1Active holds the value of the "cert_1_active" field.
+	Cert1Active bool `json:"cert_1_active,omitempty"`
+	// Cert1LastRotated holds the value of the "cert_1_last_rotated" field.
+	Cert1LastRotated *time.Time `json:"cert_1_last_rotated,omitempty"`
+	// Cert2Active holds the value of the "cert_2_active" field.
+	Cert2Active bool `json:"cert_2_active,omitempty"`
+	// Cert2LastRotated holds the value of the "cert_2_last_rotated" field.
+	Cert2LastRotated *time.Time `json:"cert_2_last_rotated,omitempty"`
+	// ReportGeneratedAt holds the value of the "report_generated_at" field.
+	ReportGeneratedAt *time.Time `json:"report_generated_at,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID    string `json:"account_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryAWSIAMCredentialReport) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsiamcredentialreport.FieldMfaActive, bronzehistoryawsiamcredentialreport.FieldAccessKey1Active, bronzehistoryawsiamcredentialreport.FieldAccessKey2Active, bronzehistoryawsiamcredentialreport.FieldCert1Active, bronzehistoryawsiamcredentialreport.FieldCert2Active:
+			values[i] = new(sql.NullBool)
+		case bronzehistoryawsiamcredentialreport.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryawsiamcredentialreport.FieldResourceID, bronzehistoryawsiamcredentialreport.FieldUser, bronzehistoryawsiamcredentialreport.FieldPasswordEnabled, bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedRegion, bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedService, bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedRegion, bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedService, bronzehistoryawsiamcredentialreport.FieldAccountID:
+			values[i] = new(sql.NullString)
+		case bronzehistoryawsiamcredentialreport.FieldValidFrom, bronzehistoryawsiamcredentialreport.FieldValidTo, bronzehistoryawsiamcredentialreport.FieldCollectedAt, bronzehistoryawsiamcredentialreport.FieldFirstCollectedAt, bronzehistoryawsiamcredentialreport.FieldUserCreationTime, bronzehistoryawsiamcredentialreport.FieldPasswordLastUsed, bronzehistoryawsiamcredentialreport.FieldPasswordLastChanged, bronzehistoryawsiamcredentialreport.FieldPasswordNextRotation, bronzehistoryawsiamcredentialreport.FieldAccessKey1LastRotated, bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedDate, bronzehistoryawsiamcredentialreport.FieldAccessKey2LastRotated, bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedDate, bronzehistoryawsiamcredentialreport.FieldCert1LastRotated, bronzehistoryawsiamcredentialreport.FieldCert2LastRotated, bronzehistoryawsiamcredentialreport.FieldReportGeneratedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryAWSIAMCredentialReport fields.
+func (_m *BronzeHistoryAWSIAMCredentialReport) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsiamcredentialreport.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryawsiamcredentialreport.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryawsiamcredentialreport.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryawsiamcredentialreport.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryawsiamcredentialreport.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryawsiamcredentialreport.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryawsiamcredentialreport.FieldUser:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user", values[i])
+			} else if value.Valid {
+				_m.User = value.String
+			}
+		case bronzehistoryawsiamcredentialreport.FieldUserCreationTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field user_creation_time", values[i])
+			} else if value.Valid {
+				_m.UserCreationTime = new(time.Time)
+				*_m.UserCreationTime = value.Time
+			}
+		case bronzehistoryawsiamcredentialreport.FieldPasswordEnabled:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password_enabled", values[i])
+			} else if value.Valid {
+				_m.PasswordEnabled = value.String
+			}
+		case bronzehistoryawsiamcredentialreport.FieldPasswordLastUsed:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field password_last_used", values[i])
+			} else if value.Valid {
+				_m.PasswordLastUsed = new(time.Time)
+				*_m.PasswordLastUsed = value.Time
+			}
+		case bronzehistoryawsiamcredentialreport.FieldPasswordLastChanged:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field password_last_changed", values[i])
+			} else if value.Valid {
+				_m.PasswordLastChanged = new(time.Time)
+				*_m.PasswordLastChanged = value.Time
+			}
+		case bronzehistoryawsiamcredentialreport.FieldPasswordNextRotation:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field password_next_rotation", values[i])
+			} else if value.Valid {
+				_m.PasswordNextRotation = new(time.Time)
+				*_m.PasswordNextRotation = value.Time
+			}
+		case bronzehistoryawsiamcredentialreport.FieldMfaActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_active", values[i])
+			} else if value.Valid {
+				_m.MfaActive = value.Bool
+			}
+		case bronzehistoryawsiamcredentialreport.FieldAccessKey1Active:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_1_active", values[i])
+			} else if value.Valid {
+				_m.AccessKey1Active = value.Bool
+			}
+		case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastRotated:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_1_last_rotated", values[i])
+			} else if value.Valid {
+				_m.AccessKey1LastRotated = new(time.Time)
+				*_m.AccessKey1LastRotated = value.Time
+			}
+		case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_1_last_used_date", values[i])
+			} else if value.Valid {
+				_m.AccessKey1LastUsedDate = new(time.Time)
+				*_m.AccessKey1LastUsedDate = value.Time
+			}
+		case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_1_last_used_region", values[i])
+			} else if value.Valid {
+				_m.AccessKey1LastUsedRegion = value.String
+			}
+		case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedService:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_1_last_used_service", values[i])
+			} else if value.Valid {
+				_m.AccessKey1LastUsedService = value.String
+			}
+		case bronzehistoryawsiamcredentialreport.FieldAccessKey2Active:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_2_active", values[i])
+			} else if value.Valid {
+				_m.AccessKey2Active = value.Bool
+			}
+		case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastRotated:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_2_last_rotated", values[i])
+			} else if value.Valid {
+				_m.AccessKey2LastRotated = new(time.Time)
+				*_m.AccessKey2LastRotated = value.Time
+			}
+		case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_2_last_used_date", values[i])
+			} else if value.Valid {
+				_m.AccessKey2LastUsedDate = new(time.Time)
+				*_m.AccessKey2LastUsedDate = value.Time
+			}
+		case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_2_last_used_region", values[i])
+			} else if value.Valid {
+				_m.AccessKey2LastUsedRegion = value.String
+			}
+		case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedService:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_2_last_used_service", values[i])
+			} else if value.Valid {
+				_m.AccessKey2LastUsedService = value.String
+			}
+		case bronzehistoryawsiamcredentialreport.FieldCert1Active:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cert_1_active", values[i])
+			} else if value.Valid {
+				_m.Cert1Active = value.Bool
+			}
+		case bronzehistoryawsiamcredentialreport.FieldCert1LastRotated:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cert_1_last_rotated", values[i])
+			} else if value.Valid {
+				_m.Cert1LastRotated = new(time.Time)
+				*_m.Cert1LastRotated = value.Time
+			}
+		case bronzehistoryawsiamcredentialreport.FieldCert2Active:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cert_2_active", values[i])
+			} else if value.Valid {
+				_m.Cert2Active = value.Bool
+			}
+		case bronzehistoryawsiamcredentialreport.FieldCert2LastRotated:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cert_2_last_rotated", values[i])
+			} else if value.Valid {
+				_m.Cert2LastRotated = new(time.Time)
+				*_m.Cert2LastRotated = value.Time
+			}
+		case bronzehistoryawsiamcredentialreport.FieldReportGeneratedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field report_generated_at", values[i])
+			} else if value.Valid {
+				_m.ReportGeneratedAt = new(time.Time)
+				*_m.ReportGeneratedAt = value.Time
+			}
+		case bronzehistoryawsiamcredentialreport.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryAWSIAMCredentialReport.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryAWSIAMCredentialReport) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryAWSIAMCredentialReport.
+// Note that you need to call BronzeHistoryAWSIAMCredentialReport.Unwrap() before calling this method if this BronzeHistoryAWSIAMCredentialReport
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryAWSIAMCredentialReport) Update() *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	return NewBronzeHistoryAWSIAMCredentialReportClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryAWSIAMCredentialReport entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryAWSIAMCredentialReport) Unwrap() *BronzeHistoryAWSIAMCredentialReport {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("iam: BronzeHistoryAWSIAMCredentialReport is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryAWSIAMCredentialReport) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryAWSIAMCredentialReport(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("user=")
+	builder.WriteString(_m.User)
+	builder.WriteString(", ")
+	if v := _m.UserCreationTime; v != nil {
+		builder.WriteString("user_creation_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("password_enabled=")
+	builder.WriteString(_m.PasswordEnabled)
+	builder.WriteString(", ")
+	if v := _m.PasswordLastUsed; v != nil {
+		builder.WriteString("password_last_used=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.PasswordLastChanged; v != nil {
+		builder.WriteString("password_last_changed=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.PasswordNextRotation; v != nil {
+		builder.WriteString("password_next_rotation=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("mfa_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MfaActive))
+	builder.WriteString(", ")
+	builder.WriteString("access_key_1_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccessKey1Active))
+	builder.WriteString(", ")
+	if v := _m.AccessKey1LastRotated; v != nil {
+		builder.WriteString("access_key_1_last_rotated=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.AccessKey1LastUsedDate; v != nil {
+		builder.WriteString("access_key_1_last_used_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("access_key_1_last_used_region=")
+	builder.WriteString(_m.AccessKey1LastUsedRegion)
+	builder.WriteString(", ")
+	builder.WriteString("access_key_1_last_used_service=")
+	builder.WriteString(_m.AccessKey1LastUsedService)
+	builder.WriteString(", ")
+	builder.WriteString("access_key_2_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccessKey2Active))
+	builder.WriteString(", ")
+	if v := _m.AccessKey2LastRotated; v != nil {
+		builder.WriteString("access_key_2_last_rotated=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.AccessKey2LastUsedDate; v != nil {
+		builder.WriteString("access_key_2_last_used_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("access_key_2_last_used_region=")
+	builder.WriteString(_m.AccessKey2LastUsedRegion)
+	builder.WriteString(", ")
+	builder.WriteString("access_key_2_last_used_service=")
+	builder.WriteString(_m.AccessKey2LastUsedService)
+	builder.WriteString(", ")
+	builder.WriteString("cert_1_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Cert1Active))
+	builder.WriteString(", ")
+	if v := _m.Cert1LastRotated; v != nil {
+		builder.WriteString("cert_1_last_rotated=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cert_2_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Cert2Active))
+	builder.WriteString(", ")
+	if v := _m.Cert2LastRotated; v != nil {
+		builder.WriteString("cert_2_last_rotated=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReportGeneratedAt; v != nil {
+		builder.WriteString("report_generated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryAWSIAMCredentialReports is a parsable slice of BronzeHistoryAWSIAMCredentialReport.
+type BronzeHistoryAWSIAMCredentialReports []*BronzeHistoryAWSIAMCredentialReport
@@ -0,0 +1,1550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryawsiamcredentialreport
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldID, id))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldValidTo, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldResourceID, v))
+}
+
+// User applies equality check predicate on the "user" field. It's identical to UserEQ.
+func User(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldUser, v))
+}
+
+// UserCreationTime applies equality check predicate on the "user_creation_time" field. It's identical to UserCreationTimeEQ.
+func UserCreationTime(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldUserCreationTime, v))
+}
+
+// PasswordEnabled applies equality check predicate on the "password_enabled" field. It's identical to PasswordEnabledEQ.
+func PasswordEnabled(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldPasswordEnabled, v))
+}
+
+// PasswordLastUsed applies equality check predicate on the "password_last_used" field. It's identical to PasswordLastUsedEQ.
+func PasswordLastUsed(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldPasswordLastUsed, v))
+}
+
+// PasswordLastChanged applies equality check predicate on the "password_last_changed" field. It's identical to PasswordLastChangedEQ.
+func PasswordLastChanged(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldPasswordLastChanged, v))
+}
+
+// PasswordNextRotation applies equality check predicate on the "password_next_rotation" field. It's identical to PasswordNextRotationEQ.
+func PasswordNextRotation(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldPasswordNextRotation, v))
+}
+
+// MfaActive applies equality check predicate on the "mfa_active" field. It's identical to MfaActiveEQ.
+func MfaActive(v bool) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldMfaActive, v))
+}
+
+// AccessKey1Active applies equality check predicate on the "access_key_1_active" field. It's identical to AccessKey1ActiveEQ.
+func AccessKey1Active(v bool) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1Active, v))
+}
+
+// AccessKey1LastRotated applies equality check predicate on the "access_key_1_last_rotated" field. It's identical to AccessKey1LastRotatedEQ.
+func AccessKey1LastRotated(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1LastRotated, v))
+}
+
+// AccessKey1LastUsedDate applies equality check predicate on the "access_key_1_last_used_date" field. It's identical to AccessKey1LastUsedDateEQ.
+func AccessKey1LastUsedDate(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1LastUsedDate, v))
+}
+
+// AccessKey1LastUsedRegion applies equality check predicate on the "access_key_1_last_used_region" field. It's identical to AccessKey1LastUsedRegionEQ.
+func AccessKey1LastUsedRegion(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedService applies equality check predicate on the "access_key_1_last_used_service" field. It's identical to AccessKey1LastUsedServiceEQ.
+func AccessKey1LastUsedService(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey2Active applies equality check predicate on the "access_key_2_active" field. It's identical to AccessKey2ActiveEQ.
+func AccessKey2Active(v bool) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2Active, v))
+}
+
+// AccessKey2LastRotated applies equality check predicate on the "access_key_2_last_rotated" field. It's identical to AccessKey2LastRotatedEQ.
+func AccessKey2LastRotated(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2LastRotated, v))
+}
+
+// AccessKey2LastUsedDate applies equality check predicate on the "access_key_2_last_used_date" field. It's identical to AccessKey2LastUsedDateEQ.
+func AccessKey2LastUsedDate(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2LastUsedDate, v))
+}
+
+// AccessKey2LastUsedRegion applies equality check predicate on the "access_key_2_last_used_region" field. It's identical to AccessKey2LastUsedRegionEQ.
+func AccessKey2LastUsedRegion(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedService applies equality check predicate on the "access_key_2_last_used_service" field. It's identical to AccessKey2LastUsedServiceEQ.
+func AccessKey2LastUsedService(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2LastUsedService, v))
+}
+
+// Cert1Active applies equality check predicate on the "cert_1_active" field. It's identical to Cert1ActiveEQ.
+func Cert1Active(v bool) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldCert1Active, v))
+}
+
+// Cert1LastRotated applies equality check predicate on the "cert_1_last_rotated" field. It's identical to Cert1LastRotatedEQ.
+func Cert1LastRotated(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldCert1LastRotated, v))
+}
+
+// Cert2Active applies equality check predicate on the "cert_2_active" field. It's identical to Cert2ActiveEQ.
+func Cert2Active(v bool) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldCert2Active, v))
+}
+
+// Cert2LastRotated applies equality check predicate on the "cert_2_last_rotated" field. It's identical to Cert2LastRotatedEQ.
+func Cert2LastRotated(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldCert2LastRotated, v))
+}
+
+// ReportGeneratedAt applies equality check predicate on the "report_generated_at" field. It's identical to ReportGeneratedAtEQ.
+func ReportGeneratedAt(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldReportGeneratedAt, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccountID, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotNull(FieldValidTo))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// UserEQ applies the EQ predicate on the "user" field.
+func UserEQ(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldUser, v))
+}
+
+// UserNEQ applies the NEQ predicate on the "user" field.
+func UserNEQ(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldUser, v))
+}
+
+// UserIn applies the In predicate on the "user" field.
+func UserIn(vs ...string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldUser, vs...))
+}
+
+// UserNotIn applies the NotIn predicate on the "user" field.
+func UserNotIn(vs ...string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldUser, vs...))
+}
+
+// UserGT applies the GT predicate on the "user" field.
+func UserGT(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldUser, v))
+}
+
+// UserGTE applies the GTE predicate on the "user" field.
+func UserGTE(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldUser, v))
+}
+
+// UserLT applies the LT predicate on the "user" field.
+func UserLT(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldUser, v))
+}
+
+// UserLTE applies the LTE predicate on the "user" field.
+func UserLTE(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldUser, v))
+}
+
+// UserContains applies the Contains predicate on the "user" field.
+func UserContains(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldContains(FieldUser, v))
+}
+
+// UserHasPrefix applies the HasPrefix predicate on the "user" field.
+func UserHasPrefix(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldHasPrefix(FieldUser, v))
+}
+
+// UserHasSuffix applies the HasSuffix predicate on the "user" field.
+func UserHasSuffix(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldHasSuffix(FieldUser, v))
+}
+
+// UserEqualFold applies the EqualFold predicate on the "user" field.
+func UserEqualFold(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEqualFold(FieldUser, v))
+}
+
+// UserContainsFold applies the ContainsFold predicate on the "user" field.
+func UserContainsFold(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldContainsFold(FieldUser, v))
+}
+
+// UserCreationTimeEQ applies the EQ predicate on the "user_creation_time" field.
+func UserCreationTimeEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldUserCreationTime, v))
+}
+
+// UserCreationTimeNEQ applies the NEQ predicate on the "user_creation_time" field.
+func UserCreationTimeNEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldUserCreationTime, v))
+}
+
+// UserCreationTimeIn applies the In predicate on the "user_creation_time" field.
+func UserCreationTimeIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldUserCreationTime, vs...))
+}
+
+// UserCreationTimeNotIn applies the NotIn predicate on the "user_creation_time" field.
+func UserCreationTimeNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldUserCreationTime, vs...))
+}
+
+// UserCreationTimeGT applies the GT predicate on the "user_creation_time" field.
+func UserCreationTimeGT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldUserCreationTime, v))
+}
+
+// UserCreationTimeGTE applies the GTE predicate on the "user_creation_time" field.
+func UserCreationTimeGTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldUserCreationTime, v))
+}
+
+// UserCreationTimeLT applies the LT predicate on the "user_creation_time" field.
+func UserCreationTimeLT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldUserCreationTime, v))
+}
+
+// UserCreationTimeLTE applies the LTE predicate on the "user_creation_time" field.
+func UserCreationTimeLTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldUserCreationTime, v))
+}
+
+// UserCreationTimeIsNil applies the IsNil predicate on the "user_creation_time" field.
+func UserCreationTimeIsNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIsNull(FieldUserCreationTime))
+}
+
+// UserCreationTimeNotNil applies the NotNil predicate on the "user_creation_time" field.
+func UserCreationTimeNotNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotNull(FieldUserCreationTime))
+}
+
+// PasswordEnabledEQ applies the EQ predicate on the "password_enabled" field.
+func PasswordEnabledEQ(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledNEQ applies the NEQ predicate on the "password_enabled" field.
+func PasswordEnabledNEQ(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledIn applies the In predicate on the "password_enabled" field.
+func PasswordEnabledIn(vs ...string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldPasswordEnabled, vs...))
+}
+
+// PasswordEnabledNotIn applies the NotIn predicate on the "password_enabled" field.
+func PasswordEnabledNotIn(vs ...string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldPasswordEnabled, vs...))
+}
+
+// PasswordEnabledGT applies the GT predicate on the "password_enabled" field.
+func PasswordEnabledGT(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledGTE applies the GTE predicate on the "password_enabled" field.
+func PasswordEnabledGTE(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledLT applies the LT predicate on the "password_enabled" field.
+func PasswordEnabledLT(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledLTE applies the LTE predicate on the "password_enabled" field.
+func PasswordEnabledLTE(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledContains applies the Contains predicate on the "password_enabled" field.
+func PasswordEnabledContains(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldContains(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledHasPrefix applies the HasPrefix predicate on the "password_enabled" field.
+func PasswordEnabledHasPrefix(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldHasPrefix(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledHasSuffix applies the HasSuffix predicate on the "password_enabled" field.
+func PasswordEnabledHasSuffix(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldHasSuffix(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledIsNil applies the IsNil predicate on the "password_enabled" field.
+func PasswordEnabledIsNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIsNull(FieldPasswordEnabled))
+}
+
+// PasswordEnabledNotNil applies the NotNil predicate on the "password_enabled" field.
+func PasswordEnabledNotNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotNull(FieldPasswordEnabled))
+}
+
+// PasswordEnabledEqualFold applies the EqualFold predicate on the "password_enabled" field.
+func PasswordEnabledEqualFold(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEqualFold(FieldPasswordEnabled, v))
+}
+
+// PasswordEnabledContainsFold applies the ContainsFold predicate on the "password_enabled" field.
+func PasswordEnabledContainsFold(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldContainsFold(FieldPasswordEnabled, v))
+}
+
+// PasswordLastUsedEQ applies the EQ predicate on the "password_last_used" field.
+func PasswordLastUsedEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldPasswordLastUsed, v))
+}
+
+// PasswordLastUsedNEQ applies the NEQ predicate on the "password_last_used" field.
+func PasswordLastUsedNEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldPasswordLastUsed, v))
+}
+
+// PasswordLastUsedIn applies the In predicate on the "password_last_used" field.
+func PasswordLastUsedIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldPasswordLastUsed, vs...))
+}
+
+// PasswordLastUsedNotIn applies the NotIn predicate on the "password_last_used" field.
+func PasswordLastUsedNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldPasswordLastUsed, vs...))
+}
+
+// PasswordLastUsedGT applies the GT predicate on the "password_last_used" field.
+func PasswordLastUsedGT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldPasswordLastUsed, v))
+}
+
+// PasswordLastUsedGTE applies the GTE predicate on the "password_last_used" field.
+func PasswordLastUsedGTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldPasswordLastUsed, v))
+}
+
+// PasswordLastUsedLT applies the LT predicate on the "password_last_used" field.
+func PasswordLastUsedLT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldPasswordLastUsed, v))
+}
+
+// PasswordLastUsedLTE applies the LTE predicate on the "password_last_used" field.
+func PasswordLastUsedLTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldPasswordLastUsed, v))
+}
+
+// PasswordLastUsedIsNil applies the IsNil predicate on the "password_last_used" field.
+func PasswordLastUsedIsNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIsNull(FieldPasswordLastUsed))
+}
+
+// PasswordLastUsedNotNil applies the NotNil predicate on the "password_last_used" field.
+func PasswordLastUsedNotNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotNull(FieldPasswordLastUsed))
+}
+
+// PasswordLastChangedEQ applies the EQ predicate on the "password_last_changed" field.
+func PasswordLastChangedEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldPasswordLastChanged, v))
+}
+
+// PasswordLastChangedNEQ applies the NEQ predicate on the "password_last_changed" field.
+func PasswordLastChangedNEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldPasswordLastChanged, v))
+}
+
+// PasswordLastChangedIn applies the In predicate on the "password_last_changed" field.
+func PasswordLastChangedIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldPasswordLastChanged, vs...))
+}
+
+// PasswordLastChangedNotIn applies the NotIn predicate on the "password_last_changed" field.
+func PasswordLastChangedNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldPasswordLastChanged, vs...))
+}
+
+// PasswordLastChangedGT applies the GT predicate on the "password_last_changed" field.
+func PasswordLastChangedGT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldPasswordLastChanged, v))
+}
+
+// PasswordLastChangedGTE applies the GTE predicate on the "password_last_changed" field.
+func PasswordLastChangedGTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldPasswordLastChanged, v))
+}
+
+// PasswordLastChangedLT applies the LT predicate on the "password_last_changed" field.
+func PasswordLastChangedLT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldPasswordLastChanged, v))
+}
+
+// PasswordLastChangedLTE applies the LTE predicate on the "password_last_changed" field.
+func PasswordLastChangedLTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldPasswordLastChanged, v))
+}
+
+// PasswordLastChangedIsNil applies the IsNil predicate on the "password_last_changed" field.
+func PasswordLastChangedIsNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIsNull(FieldPasswordLastChanged))
+}
+
+// PasswordLastChangedNotNil applies the NotNil predicate on the "password_last_changed" field.
+func PasswordLastChangedNotNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotNull(FieldPasswordLastChanged))
+}
+
+// PasswordNextRotationEQ applies the EQ predicate on the "password_next_rotation" field.
+func PasswordNextRotationEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldPasswordNextRotation, v))
+}
+
+// PasswordNextRotationNEQ applies the NEQ predicate on the "password_next_rotation" field.
+func PasswordNextRotationNEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldPasswordNextRotation, v))
+}
+
+// PasswordNextRotationIn applies the In predicate on the "password_next_rotation" field.
+func PasswordNextRotationIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldPasswordNextRotation, vs...))
+}
+
+// PasswordNextRotationNotIn applies the NotIn predicate on the "password_next_rotation" field.
+func PasswordNextRotationNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldPasswordNextRotation, vs...))
+}
+
+// PasswordNextRotationGT applies the GT predicate on the "password_next_rotation" field.
+func PasswordNextRotationGT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldPasswordNextRotation, v))
+}
+
+// PasswordNextRotationGTE applies the GTE predicate on the "password_next_rotation" field.
+func PasswordNextRotationGTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldPasswordNextRotation, v))
+}
+
+// PasswordNextRotationLT applies the LT predicate on the "password_next_rotation" field.
+func PasswordNextRotationLT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldPasswordNextRotation, v))
+}
+
+// PasswordNextRotationLTE applies the LTE predicate on the "password_next_rotation" field.
+func PasswordNextRotationLTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldPasswordNextRotation, v))
+}
+
+// PasswordNextRotationIsNil applies the IsNil predicate on the "password_next_rotation" field.
+func PasswordNextRotationIsNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIsNull(FieldPasswordNextRotation))
+}
+
+// PasswordNextRotationNotNil applies the NotNil predicate on the "password_next_rotation" field.
+func PasswordNextRotationNotNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotNull(FieldPasswordNextRotation))
+}
+
+// MfaActiveEQ applies the EQ predicate on the "mfa_active" field.
+func MfaActiveEQ(v bool) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldMfaActive, v))
+}
+
+// MfaActiveNEQ applies the NEQ predicate on the "mfa_active" field.
+func MfaActiveNEQ(v bool) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldMfaActive, v))
+}
+
+// AccessKey1ActiveEQ applies the EQ predicate on the "access_key_1_active" field.
+func AccessKey1ActiveEQ(v bool) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1Active, v))
+}
+
+// AccessKey1ActiveNEQ applies the NEQ predicate on the "access_key_1_active" field.
+func AccessKey1ActiveNEQ(v bool) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey1Active, v))
+}
+
+// AccessKey1LastRotatedEQ applies the EQ predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1LastRotated, v))
+}
+
+// AccessKey1LastRotatedNEQ applies the NEQ predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedNEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey1LastRotated, v))
+}
+
+// AccessKey1LastRotatedIn applies the In predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldAccessKey1LastRotated, vs...))
+}
+
+// AccessKey1LastRotatedNotIn applies the NotIn predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldAccessKey1LastRotated, vs...))
+}
+
+// AccessKey1LastRotatedGT applies the GT predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedGT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldAccessKey1LastRotated, v))
+}
+
+// AccessKey1LastRotatedGTE applies the GTE predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedGTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldAccessKey1LastRotated, v))
+}
+
+// AccessKey1LastRotatedLT applies the LT predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedLT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldAccessKey1LastRotated, v))
+}
+
+// AccessKey1LastRotatedLTE applies the LTE predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedLTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldAccessKey1LastRotated, v))
+}
+
+// AccessKey1LastRotatedIsNil applies the IsNil predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedIsNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIsNull(FieldAccessKey1LastRotated))
+}
+
+// AccessKey1LastRotatedNotNil applies the NotNil predicate on the "access_key_1_last_rotated" field.
+func AccessKey1LastRotatedNotNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotNull(FieldAccessKey1LastRotated))
+}
+
+// AccessKey1LastUsedDateEQ applies the EQ predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1LastUsedDate, v))
+}
+
+// AccessKey1LastUsedDateNEQ applies the NEQ predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateNEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey1LastUsedDate, v))
+}
+
+// AccessKey1LastUsedDateIn applies the In predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldAccessKey1LastUsedDate, vs...))
+}
+
+// AccessKey1LastUsedDateNotIn applies the NotIn predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldAccessKey1LastUsedDate, vs...))
+}
+
+// AccessKey1LastUsedDateGT applies the GT predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateGT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldAccessKey1LastUsedDate, v))
+}
+
+// AccessKey1LastUsedDateGTE applies the GTE predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateGTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldAccessKey1LastUsedDate, v))
+}
+
+// AccessKey1LastUsedDateLT applies the LT predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateLT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldAccessKey1LastUsedDate, v))
+}
+
+// AccessKey1LastUsedDateLTE applies the LTE predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateLTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldAccessKey1LastUsedDate, v))
+}
+
+// AccessKey1LastUsedDateIsNil applies the IsNil predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateIsNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIsNull(FieldAccessKey1LastUsedDate))
+}
+
+// AccessKey1LastUsedDateNotNil applies the NotNil predicate on the "access_key_1_last_used_date" field.
+func AccessKey1LastUsedDateNotNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotNull(FieldAccessKey1LastUsedDate))
+}
+
+// AccessKey1LastUsedRegionEQ applies the EQ predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionEQ(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionNEQ applies the NEQ predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionNEQ(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionIn applies the In predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionIn(vs ...string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldAccessKey1LastUsedRegion, vs...))
+}
+
+// AccessKey1LastUsedRegionNotIn applies the NotIn predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionNotIn(vs ...string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldAccessKey1LastUsedRegion, vs...))
+}
+
+// AccessKey1LastUsedRegionGT applies the GT predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionGT(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionGTE applies the GTE predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionGTE(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionLT applies the LT predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionLT(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionLTE applies the LTE predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionLTE(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionContains applies the Contains predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionContains(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldContains(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionHasPrefix applies the HasPrefix predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionHasPrefix(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldHasPrefix(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionHasSuffix applies the HasSuffix predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionHasSuffix(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldHasSuffix(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionIsNil applies the IsNil predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionIsNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIsNull(FieldAccessKey1LastUsedRegion))
+}
+
+// AccessKey1LastUsedRegionNotNil applies the NotNil predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionNotNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotNull(FieldAccessKey1LastUsedRegion))
+}
+
+// AccessKey1LastUsedRegionEqualFold applies the EqualFold predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionEqualFold(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEqualFold(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedRegionContainsFold applies the ContainsFold predicate on the "access_key_1_last_used_region" field.
+func AccessKey1LastUsedRegionContainsFold(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldContainsFold(FieldAccessKey1LastUsedRegion, v))
+}
+
+// AccessKey1LastUsedServiceEQ applies the EQ predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceEQ(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceNEQ applies the NEQ predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceNEQ(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceIn applies the In predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceIn(vs ...string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldAccessKey1LastUsedService, vs...))
+}
+
+// AccessKey1LastUsedServiceNotIn applies the NotIn predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceNotIn(vs ...string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldAccessKey1LastUsedService, vs...))
+}
+
+// AccessKey1LastUsedServiceGT applies the GT predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceGT(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceGTE applies the GTE predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceGTE(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceLT applies the LT predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceLT(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceLTE applies the LTE predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceLTE(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceContains applies the Contains predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceContains(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldContains(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceHasPrefix applies the HasPrefix predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceHasPrefix(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldHasPrefix(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceHasSuffix applies the HasSuffix predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceHasSuffix(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldHasSuffix(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceIsNil applies the IsNil predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceIsNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIsNull(FieldAccessKey1LastUsedService))
+}
+
+// AccessKey1LastUsedServiceNotNil applies the NotNil predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceNotNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotNull(FieldAccessKey1LastUsedService))
+}
+
+// AccessKey1LastUsedServiceEqualFold applies the EqualFold predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceEqualFold(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEqualFold(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey1LastUsedServiceContainsFold applies the ContainsFold predicate on the "access_key_1_last_used_service" field.
+func AccessKey1LastUsedServiceContainsFold(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldContainsFold(FieldAccessKey1LastUsedService, v))
+}
+
+// AccessKey2ActiveEQ applies the EQ predicate on the "access_key_2_active" field.
+func AccessKey2ActiveEQ(v bool) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2Active, v))
+}
+
+// AccessKey2ActiveNEQ applies the NEQ predicate on the "access_key_2_active" field.
+func AccessKey2ActiveNEQ(v bool) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey2Active, v))
+}
+
+// AccessKey2LastRotatedEQ applies the EQ predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2LastRotated, v))
+}
+
+// AccessKey2LastRotatedNEQ applies the NEQ predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedNEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey2LastRotated, v))
+}
+
+// AccessKey2LastRotatedIn applies the In predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldAccessKey2LastRotated, vs...))
+}
+
+// AccessKey2LastRotatedNotIn applies the NotIn predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldAccessKey2LastRotated, vs...))
+}
+
+// AccessKey2LastRotatedGT applies the GT predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedGT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldAccessKey2LastRotated, v))
+}
+
+// AccessKey2LastRotatedGTE applies the GTE predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedGTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldAccessKey2LastRotated, v))
+}
+
+// AccessKey2LastRotatedLT applies the LT predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedLT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldAccessKey2LastRotated, v))
+}
+
+// AccessKey2LastRotatedLTE applies the LTE predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedLTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldAccessKey2LastRotated, v))
+}
+
+// AccessKey2LastRotatedIsNil applies the IsNil predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedIsNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIsNull(FieldAccessKey2LastRotated))
+}
+
+// AccessKey2LastRotatedNotNil applies the NotNil predicate on the "access_key_2_last_rotated" field.
+func AccessKey2LastRotatedNotNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotNull(FieldAccessKey2LastRotated))
+}
+
+// AccessKey2LastUsedDateEQ applies the EQ predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2LastUsedDate, v))
+}
+
+// AccessKey2LastUsedDateNEQ applies the NEQ predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateNEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey2LastUsedDate, v))
+}
+
+// AccessKey2LastUsedDateIn applies the In predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldAccessKey2LastUsedDate, vs...))
+}
+
+// AccessKey2LastUsedDateNotIn applies the NotIn predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldAccessKey2LastUsedDate, vs...))
+}
+
+// AccessKey2LastUsedDateGT applies the GT predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateGT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldAccessKey2LastUsedDate, v))
+}
+
+// AccessKey2LastUsedDateGTE applies the GTE predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateGTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldAccessKey2LastUsedDate, v))
+}
+
+// AccessKey2LastUsedDateLT applies the LT predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateLT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldAccessKey2LastUsedDate, v))
+}
+
+// AccessKey2LastUsedDateLTE applies the LTE predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateLTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldAccessKey2LastUsedDate, v))
+}
+
+// AccessKey2LastUsedDateIsNil applies the IsNil predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateIsNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIsNull(FieldAccessKey2LastUsedDate))
+}
+
+// AccessKey2LastUsedDateNotNil applies the NotNil predicate on the "access_key_2_last_used_date" field.
+func AccessKey2LastUsedDateNotNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotNull(FieldAccessKey2LastUsedDate))
+}
+
+// AccessKey2LastUsedRegionEQ applies the EQ predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionEQ(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionNEQ applies the NEQ predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionNEQ(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionIn applies the In predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionIn(vs ...string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldAccessKey2LastUsedRegion, vs...))
+}
+
+// AccessKey2LastUsedRegionNotIn applies the NotIn predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionNotIn(vs ...string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldAccessKey2LastUsedRegion, vs...))
+}
+
+// AccessKey2LastUsedRegionGT applies the GT predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionGT(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionGTE applies the GTE predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionGTE(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionLT applies the LT predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionLT(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionLTE applies the LTE predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionLTE(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionContains applies the Contains predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionContains(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldContains(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionHasPrefix applies the HasPrefix predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionHasPrefix(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldHasPrefix(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionHasSuffix applies the HasSuffix predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionHasSuffix(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldHasSuffix(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionIsNil applies the IsNil predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionIsNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIsNull(FieldAccessKey2LastUsedRegion))
+}
+
+// AccessKey2LastUsedRegionNotNil applies the NotNil predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionNotNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotNull(FieldAccessKey2LastUsedRegion))
+}
+
+// AccessKey2LastUsedRegionEqualFold applies the EqualFold predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionEqualFold(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEqualFold(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedRegionContainsFold applies the ContainsFold predicate on the "access_key_2_last_used_region" field.
+func AccessKey2LastUsedRegionContainsFold(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldContainsFold(FieldAccessKey2LastUsedRegion, v))
+}
+
+// AccessKey2LastUsedServiceEQ applies the EQ predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceEQ(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceNEQ applies the NEQ predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceNEQ(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceIn applies the In predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceIn(vs ...string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldAccessKey2LastUsedService, vs...))
+}
+
+// AccessKey2LastUsedServiceNotIn applies the NotIn predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceNotIn(vs ...string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldAccessKey2LastUsedService, vs...))
+}
+
+// AccessKey2LastUsedServiceGT applies the GT predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceGT(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceGTE applies the GTE predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceGTE(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceLT applies the LT predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceLT(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceLTE applies the LTE predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceLTE(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceContains applies the Contains predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceContains(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldContains(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceHasPrefix applies the HasPrefix predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceHasPrefix(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldHasPrefix(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceHasSuffix applies the HasSuffix predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceHasSuffix(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldHasSuffix(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceIsNil applies the IsNil predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceIsNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIsNull(FieldAccessKey2LastUsedService))
+}
+
+// AccessKey2LastUsedServiceNotNil applies the NotNil predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceNotNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotNull(FieldAccessKey2LastUsedService))
+}
+
+// AccessKey2LastUsedServiceEqualFold applies the EqualFold predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceEqualFold(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEqualFold(FieldAccessKey2LastUsedService, v))
+}
+
+// AccessKey2LastUsedServiceContainsFold applies the ContainsFold predicate on the "access_key_2_last_used_service" field.
+func AccessKey2LastUsedServiceContainsFold(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldContainsFold(FieldAccessKey2LastUsedService, v))
+}
+
+// Cert1ActiveEQ applies the EQ predicate on the "cert_1_active" field.
+func Cert1ActiveEQ(v bool) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldCert1Active, v))
+}
+
+// Cert1ActiveNEQ applies the NEQ predicate on the "cert_1_active" field.
+func Cert1ActiveNEQ(v bool) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldCert1Active, v))
+}
+
+// Cert1LastRotatedEQ applies the EQ predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldCert1LastRotated, v))
+}
+
+// Cert1LastRotatedNEQ applies the NEQ predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedNEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldCert1LastRotated, v))
+}
+
+// Cert1LastRotatedIn applies the In predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldCert1LastRotated, vs...))
+}
+
+// Cert1LastRotatedNotIn applies the NotIn predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldCert1LastRotated, vs...))
+}
+
+// Cert1LastRotatedGT applies the GT predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedGT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldCert1LastRotated, v))
+}
+
+// Cert1LastRotatedGTE applies the GTE predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedGTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldCert1LastRotated, v))
+}
+
+// Cert1LastRotatedLT applies the LT predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedLT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldCert1LastRotated, v))
+}
+
+// Cert1LastRotatedLTE applies the LTE predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedLTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldCert1LastRotated, v))
+}
+
+// Cert1LastRotatedIsNil applies the IsNil predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedIsNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIsNull(FieldCert1LastRotated))
+}
+
+// Cert1LastRotatedNotNil applies the NotNil predicate on the "cert_1_last_rotated" field.
+func Cert1LastRotatedNotNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotNull(FieldCert1LastRotated))
+}
+
+// Cert2ActiveEQ applies the EQ predicate on the "cert_2_active" field.
+func Cert2ActiveEQ(v bool) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldCert2Active, v))
+}
+
+// Cert2ActiveNEQ applies the NEQ predicate on the "cert_2_active" field.
+func Cert2ActiveNEQ(v bool) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldCert2Active, v))
+}
+
+// Cert2LastRotatedEQ applies the EQ predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldCert2LastRotated, v))
+}
+
+// Cert2LastRotatedNEQ applies the NEQ predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedNEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldCert2LastRotated, v))
+}
+
+// Cert2LastRotatedIn applies the In predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldCert2LastRotated, vs...))
+}
+
+// Cert2LastRotatedNotIn applies the NotIn predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldCert2LastRotated, vs...))
+}
+
+// Cert2LastRotatedGT applies the GT predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedGT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldCert2LastRotated, v))
+}
+
+// Cert2LastRotatedGTE applies the GTE predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedGTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldCert2LastRotated, v))
+}
+
+// Cert2LastRotatedLT applies the LT predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedLT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldCert2LastRotated, v))
+}
+
+// Cert2LastRotatedLTE applies the LTE predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedLTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldCert2LastRotated, v))
+}
+
+// Cert2LastRotatedIsNil applies the IsNil predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedIsNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIsNull(FieldCert2LastRotated))
+}
+
+// Cert2LastRotatedNotNil applies the NotNil predicate on the "cert_2_last_rotated" field.
+func Cert2LastRotatedNotNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotNull(FieldCert2LastRotated))
+}
+
+// ReportGeneratedAtEQ applies the EQ predicate on the "report_generated_at" field.
+func ReportGeneratedAtEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldReportGeneratedAt, v))
+}
+
+// ReportGeneratedAtNEQ applies the NEQ predicate on the "report_generated_at" field.
+func ReportGeneratedAtNEQ(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldReportGeneratedAt, v))
+}
+
+// ReportGeneratedAtIn applies the In predicate on the "report_generated_at" field.
+func ReportGeneratedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldReportGeneratedAt, vs...))
+}
+
+// ReportGeneratedAtNotIn applies the NotIn predicate on the "report_generated_at" field.
+func ReportGeneratedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldReportGeneratedAt, vs...))
+}
+
+// ReportGeneratedAtGT applies the GT predicate on the "report_generated_at" field.
+func ReportGeneratedAtGT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldReportGeneratedAt, v))
+}
+
+// ReportGeneratedAtGTE applies the GTE predicate on the "report_generated_at" field.
+func ReportGeneratedAtGTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldReportGeneratedAt, v))
+}
+
+// ReportGeneratedAtLT applies the LT predicate on the "report_generated_at" field.
+func ReportGeneratedAtLT(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldReportGeneratedAt, v))
+}
+
+// ReportGeneratedAtLTE applies the LTE predicate on the "report_generated_at" field.
+func ReportGeneratedAtLTE(v time.Time) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldReportGeneratedAt, v))
+}
+
+// ReportGeneratedAtIsNil applies the IsNil predicate on the "report_generated_at" field.
+func ReportGeneratedAtIsNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIsNull(FieldReportGeneratedAt))
+}
+
+// ReportGeneratedAtNotNil applies the NotNil predicate on the "report_generated_at" field.
+func ReportGeneratedAtNotNil() predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotNull(FieldReportGeneratedAt))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeHistoryAWSIAMCredentialReport) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeHistoryAWSIAMCredentialReport) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeHistoryAWSIAMCredentialReport) predicate.BronzeHistoryAWSIAMCredentialReport {
+	return predicate.BronzeHistoryAWSIAMCredentialReport(sql.NotPredicates(p))
+}
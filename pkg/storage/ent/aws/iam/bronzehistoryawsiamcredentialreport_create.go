@@ -0,0 +1,714 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamcredentialreport"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSIAMCredentialReportCreate is the builder for creating a BronzeHistoryAWSIAMCredentialReport entity.
+type BronzeHistoryAWSIAMCredentialReportCreate struct {
+	config
+	mutation *BronzeHistoryAWSIAMCredentialReportMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetValidFrom(v time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetValidTo(v time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetCollectedAt(v time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetResourceID(v string) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetUser sets the "user" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetUser(v string) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetUser(v)
+	return _c
+}
+
+// SetUserCreationTime sets the "user_creation_time" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetUserCreationTime(v time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetUserCreationTime(v)
+	return _c
+}
+
+// SetNillableUserCreationTime sets the "user_creation_time" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillableUserCreationTime(v *time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetUserCreationTime(*v)
+	}
+	return _c
+}
+
+// SetPasswordEnabled sets the "password_enabled" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetPasswordEnabled(v string) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetPasswordEnabled(v)
+	return _c
+}
+
+// SetNillablePasswordEnabled sets the "password_enabled" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillablePasswordEnabled(v *string) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetPasswordEnabled(*v)
+	}
+	return _c
+}
+
+// SetPasswordLastUsed sets the "password_last_used" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetPasswordLastUsed(v time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetPasswordLastUsed(v)
+	return _c
+}
+
+// SetNillablePasswordLastUsed sets the "password_last_used" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillablePasswordLastUsed(v *time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetPasswordLastUsed(*v)
+	}
+	return _c
+}
+
+// SetPasswordLastChanged sets the "password_last_changed" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetPasswordLastChanged(v time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetPasswordLastChanged(v)
+	return _c
+}
+
+// SetNillablePasswordLastChanged sets the "password_last_changed" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillablePasswordLastChanged(v *time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetPasswordLastChanged(*v)
+	}
+	return _c
+}
+
+// SetPasswordNextRotation sets the "password_next_rotation" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetPasswordNextRotation(v time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetPasswordNextRotation(v)
+	return _c
+}
+
+// SetNillablePasswordNextRotation sets the "password_next_rotation" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillablePasswordNextRotation(v *time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetPasswordNextRotation(*v)
+	}
+	return _c
+}
+
+// SetMfaActive sets the "mfa_active" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetMfaActive(v bool) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetMfaActive(v)
+	return _c
+}
+
+// SetNillableMfaActive sets the "mfa_active" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillableMfaActive(v *bool) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetMfaActive(*v)
+	}
+	return _c
+}
+
+// SetAccessKey1Active sets the "access_key_1_active" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetAccessKey1Active(v bool) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey1Active(v)
+	return _c
+}
+
+// SetNillableAccessKey1Active sets the "access_key_1_active" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillableAccessKey1Active(v *bool) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey1Active(*v)
+	}
+	return _c
+}
+
+// SetAccessKey1LastRotated sets the "access_key_1_last_rotated" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetAccessKey1LastRotated(v time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey1LastRotated(v)
+	return _c
+}
+
+// SetNillableAccessKey1LastRotated sets the "access_key_1_last_rotated" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillableAccessKey1LastRotated(v *time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey1LastRotated(*v)
+	}
+	return _c
+}
+
+// SetAccessKey1LastUsedDate sets the "access_key_1_last_used_date" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetAccessKey1LastUsedDate(v time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey1LastUsedDate(v)
+	return _c
+}
+
+// SetNillableAccessKey1LastUsedDate sets the "access_key_1_last_used_date" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillableAccessKey1LastUsedDate(v *time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey1LastUsedDate(*v)
+	}
+	return _c
+}
+
+// SetAccessKey1LastUsedRegion sets the "access_key_1_last_used_region" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetAccessKey1LastUsedRegion(v string) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey1LastUsedRegion(v)
+	return _c
+}
+
+// SetNillableAccessKey1LastUsedRegion sets the "access_key_1_last_used_region" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillableAccessKey1LastUsedRegion(v *string) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey1LastUsedRegion(*v)
+	}
+	return _c
+}
+
+// SetAccessKey1LastUsedService sets the "access_key_1_last_used_service" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetAccessKey1LastUsedService(v string) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey1LastUsedService(v)
+	return _c
+}
+
+// SetNillableAccessKey1LastUsedService sets the "access_key_1_last_used_service" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillableAccessKey1LastUsedService(v *string) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey1LastUsedService(*v)
+	}
+	return _c
+}
+
+// SetAccessKey2Active sets the "access_key_2_active" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetAccessKey2Active(v bool) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey2Active(v)
+	return _c
+}
+
+// SetNillableAccessKey2Active sets the "access_key_2_active" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillableAccessKey2Active(v *bool) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey2Active(*v)
+	}
+	return _c
+}
+
+// SetAccessKey2LastRotated sets the "access_key_2_last_rotated" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetAccessKey2LastRotated(v time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey2LastRotated(v)
+	return _c
+}
+
+// SetNillableAccessKey2LastRotated sets the "access_key_2_last_rotated" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillableAccessKey2LastRotated(v *time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey2LastRotated(*v)
+	}
+	return _c
+}
+
+// SetAccessKey2LastUsedDate sets the "access_key_2_last_used_date" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetAccessKey2LastUsedDate(v time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey2LastUsedDate(v)
+	return _c
+}
+
+// SetNillableAccessKey2LastUsedDate sets the "access_key_2_last_used_date" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillableAccessKey2LastUsedDate(v *time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey2LastUsedDate(*v)
+	}
+	return _c
+}
+
+// SetAccessKey2LastUsedRegion sets the "access_key_2_last_used_region" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetAccessKey2LastUsedRegion(v string) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey2LastUsedRegion(v)
+	return _c
+}
+
+// SetNillableAccessKey2LastUsedRegion sets the "access_key_2_last_used_region" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillableAccessKey2LastUsedRegion(v *string) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey2LastUsedRegion(*v)
+	}
+	return _c
+}
+
+// SetAccessKey2LastUsedService sets the "access_key_2_last_used_service" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetAccessKey2LastUsedService(v string) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccessKey2LastUsedService(v)
+	return _c
+}
+
+// SetNillableAccessKey2LastUsedService sets the "access_key_2_last_used_service" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillableAccessKey2LastUsedService(v *string) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetAccessKey2LastUsedService(*v)
+	}
+	return _c
+}
+
+// SetCert1Active sets the "cert_1_active" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetCert1Active(v bool) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetCert1Active(v)
+	return _c
+}
+
+// SetNillableCert1Active sets the "cert_1_active" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillableCert1Active(v *bool) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetCert1Active(*v)
+	}
+	return _c
+}
+
+// SetCert1LastRotated sets the "cert_1_last_rotated" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetCert1LastRotated(v time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetCert1LastRotated(v)
+	return _c
+}
+
+// SetNillableCert1LastRotated sets the "cert_1_last_rotated" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillableCert1LastRotated(v *time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetCert1LastRotated(*v)
+	}
+	return _c
+}
+
+// SetCert2Active sets the "cert_2_active" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetCert2Active(v bool) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetCert2Active(v)
+	return _c
+}
+
+// SetNillableCert2Active sets the "cert_2_active" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillableCert2Active(v *bool) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetCert2Active(*v)
+	}
+	return _c
+}
+
+// SetCert2LastRotated sets the "cert_2_last_rotated" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetCert2LastRotated(v time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetCert2LastRotated(v)
+	return _c
+}
+
+// SetNillableCert2LastRotated sets the "cert_2_last_rotated" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillableCert2LastRotated(v *time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetCert2LastRotated(*v)
+	}
+	return _c
+}
+
+// SetReportGeneratedAt sets the "report_generated_at" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetReportGeneratedAt(v time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetReportGeneratedAt(v)
+	return _c
+}
+
+// SetNillableReportGeneratedAt sets the "report_generated_at" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetNillableReportGeneratedAt(v *time.Time) *BronzeHistoryAWSIAMCredentialReportCreate {
+	if v != nil {
+		_c.SetReportGeneratedAt(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetAccountID(v string) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SetID(v uint) *BronzeHistoryAWSIAMCredentialReportCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryAWSIAMCredentialReportMutation object of the builder.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) Mutation() *BronzeHistoryAWSIAMCredentialReportMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryAWSIAMCredentialReport in the database.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) Save(ctx context.Context) (*BronzeHistoryAWSIAMCredentialReport, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) SaveX(ctx context.Context) *BronzeHistoryAWSIAMCredentialReport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) defaults() {
+	if _, ok := _c.mutation.MfaActive(); !ok {
+		v := bronzehistoryawsiamcredentialreport.DefaultMfaActive
+		_c.mutation.SetMfaActive(v)
+	}
+	if _, ok := _c.mutation.AccessKey1Active(); !ok {
+		v := bronzehistoryawsiamcredentialreport.DefaultAccessKey1Active
+		_c.mutation.SetAccessKey1Active(v)
+	}
+	if _, ok := _c.mutation.AccessKey2Active(); !ok {
+		v := bronzehistoryawsiamcredentialreport.DefaultAccessKey2Active
+		_c.mutation.SetAccessKey2Active(v)
+	}
+	if _, ok := _c.mutation.Cert1Active(); !ok {
+		v := bronzehistoryawsiamcredentialreport.DefaultCert1Active
+		_c.mutation.SetCert1Active(v)
+	}
+	if _, ok := _c.mutation.Cert2Active(); !ok {
+		v := bronzehistoryawsiamcredentialreport.DefaultCert2Active
+		_c.mutation.SetCert2Active(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMCredentialReport.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMCredentialReport.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMCredentialReport.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMCredentialReport.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsiamcredentialreport.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMCredentialReport.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.User(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMCredentialReport.user"`)}
+	}
+	if v, ok := _c.mutation.User(); ok {
+		if err := bronzehistoryawsiamcredentialreport.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMCredentialReport.user": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MfaActive(); !ok {
+		return &ValidationError{Name: "mfa_active", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMCredentialReport.mfa_active"`)}
+	}
+	if _, ok := _c.mutation.AccessKey1Active(); !ok {
+		return &ValidationError{Name: "access_key_1_active", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMCredentialReport.access_key_1_active"`)}
+	}
+	if _, ok := _c.mutation.AccessKey2Active(); !ok {
+		return &ValidationError{Name: "access_key_2_active", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMCredentialReport.access_key_2_active"`)}
+	}
+	if _, ok := _c.mutation.Cert1Active(); !ok {
+		return &ValidationError{Name: "cert_1_active", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMCredentialReport.cert_1_active"`)}
+	}
+	if _, ok := _c.mutation.Cert2Active(); !ok {
+		return &ValidationError{Name: "cert_2_active", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMCredentialReport.cert_2_active"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMCredentialReport.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzehistoryawsiamcredentialreport.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMCredentialReport.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) sqlSave(ctx context.Context) (*BronzeHistoryAWSIAMCredentialReport, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryAWSIAMCredentialReportCreate) createSpec() (*BronzeHistoryAWSIAMCredentialReport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryAWSIAMCredentialReport{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryawsiamcredentialreport.Table, sqlgraph.NewFieldSpec(bronzehistoryawsiamcredentialreport.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryAWSIAMCredentialReport
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.User(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldUser, field.TypeString, value)
+		_node.User = value
+	}
+	if value, ok := _c.mutation.UserCreationTime(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldUserCreationTime, field.TypeTime, value)
+		_node.UserCreationTime = &value
+	}
+	if value, ok := _c.mutation.PasswordEnabled(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldPasswordEnabled, field.TypeString, value)
+		_node.PasswordEnabled = value
+	}
+	if value, ok := _c.mutation.PasswordLastUsed(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldPasswordLastUsed, field.TypeTime, value)
+		_node.PasswordLastUsed = &value
+	}
+	if value, ok := _c.mutation.PasswordLastChanged(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldPasswordLastChanged, field.TypeTime, value)
+		_node.PasswordLastChanged = &value
+	}
+	if value, ok := _c.mutation.PasswordNextRotation(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldPasswordNextRotation, field.TypeTime, value)
+		_node.PasswordNextRotation = &value
+	}
+	if value, ok := _c.mutation.MfaActive(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldMfaActive, field.TypeBool, value)
+		_node.MfaActive = value
+	}
+	if value, ok := _c.mutation.AccessKey1Active(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey1Active, field.TypeBool, value)
+		_node.AccessKey1Active = value
+	}
+	if value, ok := _c.mutation.AccessKey1LastRotated(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastRotated, field.TypeTime, value)
+		_node.AccessKey1LastRotated = &value
+	}
+	if value, ok := _c.mutation.AccessKey1LastUsedDate(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedDate, field.TypeTime, value)
+		_node.AccessKey1LastUsedDate = &value
+	}
+	if value, ok := _c.mutation.AccessKey1LastUsedRegion(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedRegion, field.TypeString, value)
+		_node.AccessKey1LastUsedRegion = value
+	}
+	if value, ok := _c.mutation.AccessKey1LastUsedService(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedService, field.TypeString, value)
+		_node.AccessKey1LastUsedService = value
+	}
+	if value, ok := _c.mutation.AccessKey2Active(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey2Active, field.TypeBool, value)
+		_node.AccessKey2Active = value
+	}
+	if value, ok := _c.mutation.AccessKey2LastRotated(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastRotated, field.TypeTime, value)
+		_node.AccessKey2LastRotated = &value
+	}
+	if value, ok := _c.mutation.AccessKey2LastUsedDate(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedDate, field.TypeTime, value)
+		_node.AccessKey2LastUsedDate = &value
+	}
+	if value, ok := _c.mutation.AccessKey2LastUsedRegion(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedRegion, field.TypeString, value)
+		_node.AccessKey2LastUsedRegion = value
+	}
+	if value, ok := _c.mutation.AccessKey2LastUsedService(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedService, field.TypeString, value)
+		_node.AccessKey2LastUsedService = value
+	}
+	if value, ok := _c.mutation.Cert1Active(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldCert1Active, field.TypeBool, value)
+		_node.Cert1Active = value
+	}
+	if value, ok := _c.mutation.Cert1LastRotated(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldCert1LastRotated, field.TypeTime, value)
+		_node.Cert1LastRotated = &value
+	}
+	if value, ok := _c.mutation.Cert2Active(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldCert2Active, field.TypeBool, value)
+		_node.Cert2Active = value
+	}
+	if value, ok := _c.mutation.Cert2LastRotated(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldCert2LastRotated, field.TypeTime, value)
+		_node.Cert2LastRotated = &value
+	}
+	if value, ok := _c.mutation.ReportGeneratedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldReportGeneratedAt, field.TypeTime, value)
+		_node.ReportGeneratedAt = &value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryAWSIAMCredentialReportCreateBulk is the builder for creating many BronzeHistoryAWSIAMCredentialReport entities in bulk.
+type BronzeHistoryAWSIAMCredentialReportCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryAWSIAMCredentialReportCreate
+}
+
+// Save creates the BronzeHistoryAWSIAMCredentialReport entities in the database.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryAWSIAMCredentialReport, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryAWSIAMCredentialReport, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryAWSIAMCredentialReportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryAWSIAMCredentialReport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSIAMCredentialReportCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
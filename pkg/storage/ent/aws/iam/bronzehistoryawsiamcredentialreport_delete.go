@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamcredentialreport"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSIAMCredentialReportDelete is the builder for deleting a BronzeHistoryAWSIAMCredentialReport entity.
+type BronzeHistoryAWSIAMCredentialReportDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSIAMCredentialReportMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMCredentialReportDelete builder.
+func (_d *BronzeHistoryAWSIAMCredentialReportDelete) Where(ps ...predicate.BronzeHistoryAWSIAMCredentialReport) *BronzeHistoryAWSIAMCredentialReportDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeHistoryAWSIAMCredentialReportDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryAWSIAMCredentialReportDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeHistoryAWSIAMCredentialReportDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzehistoryawsiamcredentialreport.Table, sqlgraph.NewFieldSpec(bronzehistoryawsiamcredentialreport.FieldID, field.TypeUint))
+	_spec.Node.Schema = _d.schemaConfig.BronzeHistoryAWSIAMCredentialReport
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeHistoryAWSIAMCredentialReportDeleteOne is the builder for deleting a single BronzeHistoryAWSIAMCredentialReport entity.
+type BronzeHistoryAWSIAMCredentialReportDeleteOne struct {
+	_d *BronzeHistoryAWSIAMCredentialReportDelete
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMCredentialReportDelete builder.
+func (_d *BronzeHistoryAWSIAMCredentialReportDeleteOne) Where(ps ...predicate.BronzeHistoryAWSIAMCredentialReport) *BronzeHistoryAWSIAMCredentialReportDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeHistoryAWSIAMCredentialReportDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzehistoryawsiamcredentialreport.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryAWSIAMCredentialReportDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1451 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamcredentialreport"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSIAMCredentialReportUpdate is the builder for updating BronzeHistoryAWSIAMCredentialReport entities.
+type BronzeHistoryAWSIAMCredentialReportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSIAMCredentialReportMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMCredentialReportUpdate builder.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) Where(ps ...predicate.BronzeHistoryAWSIAMCredentialReport) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetValidTo(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) ClearValidTo() *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetCollectedAt(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetResourceID(v string) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableResourceID(v *string) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetUser sets the "user" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetUser(v string) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetUser(v)
+	return _u
+}
+
+// SetNillableUser sets the "user" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableUser(v *string) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetUser(*v)
+	}
+	return _u
+}
+
+// SetUserCreationTime sets the "user_creation_time" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetUserCreationTime(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetUserCreationTime(v)
+	return _u
+}
+
+// SetNillableUserCreationTime sets the "user_creation_time" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableUserCreationTime(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetUserCreationTime(*v)
+	}
+	return _u
+}
+
+// ClearUserCreationTime clears the value of the "user_creation_time" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) ClearUserCreationTime() *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearUserCreationTime()
+	return _u
+}
+
+// SetPasswordEnabled sets the "password_enabled" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetPasswordEnabled(v string) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetPasswordEnabled(v)
+	return _u
+}
+
+// SetNillablePasswordEnabled sets the "password_enabled" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillablePasswordEnabled(v *string) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetPasswordEnabled(*v)
+	}
+	return _u
+}
+
+// ClearPasswordEnabled clears the value of the "password_enabled" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) ClearPasswordEnabled() *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearPasswordEnabled()
+	return _u
+}
+
+// SetPasswordLastUsed sets the "password_last_used" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetPasswordLastUsed(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetPasswordLastUsed(v)
+	return _u
+}
+
+// SetNillablePasswordLastUsed sets the "password_last_used" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillablePasswordLastUsed(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetPasswordLastUsed(*v)
+	}
+	return _u
+}
+
+// ClearPasswordLastUsed clears the value of the "password_last_used" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) ClearPasswordLastUsed() *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearPasswordLastUsed()
+	return _u
+}
+
+// SetPasswordLastChanged sets the "password_last_changed" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetPasswordLastChanged(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetPasswordLastChanged(v)
+	return _u
+}
+
+// SetNillablePasswordLastChanged sets the "password_last_changed" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillablePasswordLastChanged(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetPasswordLastChanged(*v)
+	}
+	return _u
+}
+
+// ClearPasswordLastChanged clears the value of the "password_last_changed" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) ClearPasswordLastChanged() *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearPasswordLastChanged()
+	return _u
+}
+
+// SetPasswordNextRotation sets the "password_next_rotation" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetPasswordNextRotation(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetPasswordNextRotation(v)
+	return _u
+}
+
+// SetNillablePasswordNextRotation sets the "password_next_rotation" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillablePasswordNextRotation(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetPasswordNextRotation(*v)
+	}
+	return _u
+}
+
+// ClearPasswordNextRotation clears the value of the "password_next_rotation" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) ClearPasswordNextRotation() *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearPasswordNextRotation()
+	return _u
+}
+
+// SetMfaActive sets the "mfa_active" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetMfaActive(v bool) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetMfaActive(v)
+	return _u
+}
+
+// SetNillableMfaActive sets the "mfa_active" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableMfaActive(v *bool) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetMfaActive(*v)
+	}
+	return _u
+}
+
+// SetAccessKey1Active sets the "access_key_1_active" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetAccessKey1Active(v bool) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey1Active(v)
+	return _u
+}
+
+// SetNillableAccessKey1Active sets the "access_key_1_active" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableAccessKey1Active(v *bool) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey1Active(*v)
+	}
+	return _u
+}
+
+// SetAccessKey1LastRotated sets the "access_key_1_last_rotated" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetAccessKey1LastRotated(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey1LastRotated(v)
+	return _u
+}
+
+// SetNillableAccessKey1LastRotated sets the "access_key_1_last_rotated" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableAccessKey1LastRotated(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey1LastRotated(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey1LastRotated clears the value of the "access_key_1_last_rotated" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) ClearAccessKey1LastRotated() *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearAccessKey1LastRotated()
+	return _u
+}
+
+// SetAccessKey1LastUsedDate sets the "access_key_1_last_used_date" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetAccessKey1LastUsedDate(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey1LastUsedDate(v)
+	return _u
+}
+
+// SetNillableAccessKey1LastUsedDate sets the "access_key_1_last_used_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableAccessKey1LastUsedDate(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey1LastUsedDate(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey1LastUsedDate clears the value of the "access_key_1_last_used_date" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) ClearAccessKey1LastUsedDate() *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearAccessKey1LastUsedDate()
+	return _u
+}
+
+// SetAccessKey1LastUsedRegion sets the "access_key_1_last_used_region" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetAccessKey1LastUsedRegion(v string) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey1LastUsedRegion(v)
+	return _u
+}
+
+// SetNillableAccessKey1LastUsedRegion sets the "access_key_1_last_used_region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableAccessKey1LastUsedRegion(v *string) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey1LastUsedRegion(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey1LastUsedRegion clears the value of the "access_key_1_last_used_region" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) ClearAccessKey1LastUsedRegion() *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearAccessKey1LastUsedRegion()
+	return _u
+}
+
+// SetAccessKey1LastUsedService sets the "access_key_1_last_used_service" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetAccessKey1LastUsedService(v string) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey1LastUsedService(v)
+	return _u
+}
+
+// SetNillableAccessKey1LastUsedService sets the "access_key_1_last_used_service" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableAccessKey1LastUsedService(v *string) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey1LastUsedService(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey1LastUsedService clears the value of the "access_key_1_last_used_service" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) ClearAccessKey1LastUsedService() *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearAccessKey1LastUsedService()
+	return _u
+}
+
+// SetAccessKey2Active sets the "access_key_2_active" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetAccessKey2Active(v bool) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey2Active(v)
+	return _u
+}
+
+// SetNillableAccessKey2Active sets the "access_key_2_active" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableAccessKey2Active(v *bool) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey2Active(*v)
+	}
+	return _u
+}
+
+// SetAccessKey2LastRotated sets the "access_key_2_last_rotated" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetAccessKey2LastRotated(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey2LastRotated(v)
+	return _u
+}
+
+// SetNillableAccessKey2LastRotated sets the "access_key_2_last_rotated" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableAccessKey2LastRotated(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey2LastRotated(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey2LastRotated clears the value of the "access_key_2_last_rotated" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) ClearAccessKey2LastRotated() *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearAccessKey2LastRotated()
+	return _u
+}
+
+// SetAccessKey2LastUsedDate sets the "access_key_2_last_used_date" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetAccessKey2LastUsedDate(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey2LastUsedDate(v)
+	return _u
+}
+
+// SetNillableAccessKey2LastUsedDate sets the "access_key_2_last_used_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableAccessKey2LastUsedDate(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey2LastUsedDate(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey2LastUsedDate clears the value of the "access_key_2_last_used_date" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) ClearAccessKey2LastUsedDate() *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearAccessKey2LastUsedDate()
+	return _u
+}
+
+// SetAccessKey2LastUsedRegion sets the "access_key_2_last_used_region" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetAccessKey2LastUsedRegion(v string) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey2LastUsedRegion(v)
+	return _u
+}
+
+// SetNillableAccessKey2LastUsedRegion sets the "access_key_2_last_used_region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableAccessKey2LastUsedRegion(v *string) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey2LastUsedRegion(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey2LastUsedRegion clears the value of the "access_key_2_last_used_region" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) ClearAccessKey2LastUsedRegion() *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearAccessKey2LastUsedRegion()
+	return _u
+}
+
+// SetAccessKey2LastUsedService sets the "access_key_2_last_used_service" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetAccessKey2LastUsedService(v string) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccessKey2LastUsedService(v)
+	return _u
+}
+
+// SetNillableAccessKey2LastUsedService sets the "access_key_2_last_used_service" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableAccessKey2LastUsedService(v *string) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccessKey2LastUsedService(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey2LastUsedService clears the value of the "access_key_2_last_used_service" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) ClearAccessKey2LastUsedService() *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearAccessKey2LastUsedService()
+	return _u
+}
+
+// SetCert1Active sets the "cert_1_active" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetCert1Active(v bool) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetCert1Active(v)
+	return _u
+}
+
+// SetNillableCert1Active sets the "cert_1_active" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableCert1Active(v *bool) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetCert1Active(*v)
+	}
+	return _u
+}
+
+// SetCert1LastRotated sets the "cert_1_last_rotated" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetCert1LastRotated(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetCert1LastRotated(v)
+	return _u
+}
+
+// SetNillableCert1LastRotated sets the "cert_1_last_rotated" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableCert1LastRotated(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetCert1LastRotated(*v)
+	}
+	return _u
+}
+
+// ClearCert1LastRotated clears the value of the "cert_1_last_rotated" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) ClearCert1LastRotated() *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearCert1LastRotated()
+	return _u
+}
+
+// SetCert2Active sets the "cert_2_active" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetCert2Active(v bool) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetCert2Active(v)
+	return _u
+}
+
+// SetNillableCert2Active sets the "cert_2_active" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableCert2Active(v *bool) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetCert2Active(*v)
+	}
+	return _u
+}
+
+// SetCert2LastRotated sets the "cert_2_last_rotated" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetCert2LastRotated(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetCert2LastRotated(v)
+	return _u
+}
+
+// SetNillableCert2LastRotated sets the "cert_2_last_rotated" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableCert2LastRotated(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetCert2LastRotated(*v)
+	}
+	return _u
+}
+
+// ClearCert2LastRotated clears the value of the "cert_2_last_rotated" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) ClearCert2LastRotated() *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearCert2LastRotated()
+	return _u
+}
+
+// SetReportGeneratedAt sets the "report_generated_at" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetReportGeneratedAt(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetReportGeneratedAt(v)
+	return _u
+}
+
+// SetNillableReportGeneratedAt sets the "report_generated_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableReportGeneratedAt(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetReportGeneratedAt(*v)
+	}
+	return _u
+}
+
+// ClearReportGeneratedAt clears the value of the "report_generated_at" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) ClearReportGeneratedAt() *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.ClearReportGeneratedAt()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetAccountID(v string) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SetNillableAccountID(v *string) *BronzeHistoryAWSIAMCredentialReportUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSIAMCredentialReportMutation object of the builder.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) Mutation() *BronzeHistoryAWSIAMCredentialReportMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsiamcredentialreport.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMCredentialReport.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.User(); ok {
+		if err := bronzehistoryawsiamcredentialreport.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMCredentialReport.user": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsiamcredentialreport.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMCredentialReport.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsiamcredentialreport.Table, bronzehistoryawsiamcredentialreport.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsiamcredentialreport.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.User(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldUser, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserCreationTime(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldUserCreationTime, field.TypeTime, value)
+	}
+	if _u.mutation.UserCreationTimeCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldUserCreationTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PasswordEnabled(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldPasswordEnabled, field.TypeString, value)
+	}
+	if _u.mutation.PasswordEnabledCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldPasswordEnabled, field.TypeString)
+	}
+	if value, ok := _u.mutation.PasswordLastUsed(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldPasswordLastUsed, field.TypeTime, value)
+	}
+	if _u.mutation.PasswordLastUsedCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldPasswordLastUsed, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PasswordLastChanged(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldPasswordLastChanged, field.TypeTime, value)
+	}
+	if _u.mutation.PasswordLastChangedCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldPasswordLastChanged, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PasswordNextRotation(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldPasswordNextRotation, field.TypeTime, value)
+	}
+	if _u.mutation.PasswordNextRotationCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldPasswordNextRotation, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MfaActive(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldMfaActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccessKey1Active(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey1Active, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccessKey1LastRotated(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastRotated, field.TypeTime, value)
+	}
+	if _u.mutation.AccessKey1LastRotatedCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastRotated, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccessKey1LastUsedDate(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedDate, field.TypeTime, value)
+	}
+	if _u.mutation.AccessKey1LastUsedDateCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccessKey1LastUsedRegion(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedRegion, field.TypeString, value)
+	}
+	if _u.mutation.AccessKey1LastUsedRegionCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccessKey1LastUsedService(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedService, field.TypeString, value)
+	}
+	if _u.mutation.AccessKey1LastUsedServiceCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedService, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccessKey2Active(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey2Active, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccessKey2LastRotated(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastRotated, field.TypeTime, value)
+	}
+	if _u.mutation.AccessKey2LastRotatedCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastRotated, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccessKey2LastUsedDate(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedDate, field.TypeTime, value)
+	}
+	if _u.mutation.AccessKey2LastUsedDateCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccessKey2LastUsedRegion(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedRegion, field.TypeString, value)
+	}
+	if _u.mutation.AccessKey2LastUsedRegionCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccessKey2LastUsedService(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedService, field.TypeString, value)
+	}
+	if _u.mutation.AccessKey2LastUsedServiceCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedService, field.TypeString)
+	}
+	if value, ok := _u.mutation.Cert1Active(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldCert1Active, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Cert1LastRotated(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldCert1LastRotated, field.TypeTime, value)
+	}
+	if _u.mutation.Cert1LastRotatedCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldCert1LastRotated, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Cert2Active(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldCert2Active, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Cert2LastRotated(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldCert2LastRotated, field.TypeTime, value)
+	}
+	if _u.mutation.Cert2LastRotatedCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldCert2LastRotated, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReportGeneratedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldReportGeneratedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReportGeneratedAtCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldReportGeneratedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSIAMCredentialReport
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsiamcredentialreport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryAWSIAMCredentialReportUpdateOne is the builder for updating a single BronzeHistoryAWSIAMCredentialReport entity.
+type BronzeHistoryAWSIAMCredentialReportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryAWSIAMCredentialReportMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetValidTo(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) ClearValidTo() *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetResourceID(v string) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetUser sets the "user" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetUser(v string) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetUser(v)
+	return _u
+}
+
+// SetNillableUser sets the "user" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableUser(v *string) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetUser(*v)
+	}
+	return _u
+}
+
+// SetUserCreationTime sets the "user_creation_time" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetUserCreationTime(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetUserCreationTime(v)
+	return _u
+}
+
+// SetNillableUserCreationTime sets the "user_creation_time" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableUserCreationTime(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetUserCreationTime(*v)
+	}
+	return _u
+}
+
+// ClearUserCreationTime clears the value of the "user_creation_time" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) ClearUserCreationTime() *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearUserCreationTime()
+	return _u
+}
+
+// SetPasswordEnabled sets the "password_enabled" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetPasswordEnabled(v string) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetPasswordEnabled(v)
+	return _u
+}
+
+// SetNillablePasswordEnabled sets the "password_enabled" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillablePasswordEnabled(v *string) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetPasswordEnabled(*v)
+	}
+	return _u
+}
+
+// ClearPasswordEnabled clears the value of the "password_enabled" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) ClearPasswordEnabled() *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearPasswordEnabled()
+	return _u
+}
+
+// SetPasswordLastUsed sets the "password_last_used" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetPasswordLastUsed(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetPasswordLastUsed(v)
+	return _u
+}
+
+// SetNillablePasswordLastUsed sets the "password_last_used" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillablePasswordLastUsed(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetPasswordLastUsed(*v)
+	}
+	return _u
+}
+
+// ClearPasswordLastUsed clears the value of the "password_last_used" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) ClearPasswordLastUsed() *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearPasswordLastUsed()
+	return _u
+}
+
+// SetPasswordLastChanged sets the "password_last_changed" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetPasswordLastChanged(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetPasswordLastChanged(v)
+	return _u
+}
+
+// SetNillablePasswordLastChanged sets the "password_last_changed" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillablePasswordLastChanged(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetPasswordLastChanged(*v)
+	}
+	return _u
+}
+
+// ClearPasswordLastChanged clears the value of the "password_last_changed" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) ClearPasswordLastChanged() *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearPasswordLastChanged()
+	return _u
+}
+
+// SetPasswordNextRotation sets the "password_next_rotation" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetPasswordNextRotation(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetPasswordNextRotation(v)
+	return _u
+}
+
+// SetNillablePasswordNextRotation sets the "password_next_rotation" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillablePasswordNextRotation(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetPasswordNextRotation(*v)
+	}
+	return _u
+}
+
+// ClearPasswordNextRotation clears the value of the "password_next_rotation" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) ClearPasswordNextRotation() *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearPasswordNextRotation()
+	return _u
+}
+
+// SetMfaActive sets the "mfa_active" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetMfaActive(v bool) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetMfaActive(v)
+	return _u
+}
+
+// SetNillableMfaActive sets the "mfa_active" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableMfaActive(v *bool) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetMfaActive(*v)
+	}
+	return _u
+}
+
+// SetAccessKey1Active sets the "access_key_1_active" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetAccessKey1Active(v bool) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey1Active(v)
+	return _u
+}
+
+// SetNillableAccessKey1Active sets the "access_key_1_active" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableAccessKey1Active(v *bool) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey1Active(*v)
+	}
+	return _u
+}
+
+// SetAccessKey1LastRotated sets the "access_key_1_last_rotated" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetAccessKey1LastRotated(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey1LastRotated(v)
+	return _u
+}
+
+// SetNillableAccessKey1LastRotated sets the "access_key_1_last_rotated" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableAccessKey1LastRotated(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey1LastRotated(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey1LastRotated clears the value of the "access_key_1_last_rotated" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) ClearAccessKey1LastRotated() *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearAccessKey1LastRotated()
+	return _u
+}
+
+// SetAccessKey1LastUsedDate sets the "access_key_1_last_used_date" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetAccessKey1LastUsedDate(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey1LastUsedDate(v)
+	return _u
+}
+
+// SetNillableAccessKey1LastUsedDate sets the "access_key_1_last_used_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableAccessKey1LastUsedDate(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey1LastUsedDate(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey1LastUsedDate clears the value of the "access_key_1_last_used_date" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) ClearAccessKey1LastUsedDate() *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearAccessKey1LastUsedDate()
+	return _u
+}
+
+// SetAccessKey1LastUsedRegion sets the "access_key_1_last_used_region" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetAccessKey1LastUsedRegion(v string) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey1LastUsedRegion(v)
+	return _u
+}
+
+// SetNillableAccessKey1LastUsedRegion sets the "access_key_1_last_used_region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableAccessKey1LastUsedRegion(v *string) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey1LastUsedRegion(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey1LastUsedRegion clears the value of the "access_key_1_last_used_region" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) ClearAccessKey1LastUsedRegion() *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearAccessKey1LastUsedRegion()
+	return _u
+}
+
+// SetAccessKey1LastUsedService sets the "access_key_1_last_used_service" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetAccessKey1LastUsedService(v string) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey1LastUsedService(v)
+	return _u
+}
+
+// SetNillableAccessKey1LastUsedService sets the "access_key_1_last_used_service" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableAccessKey1LastUsedService(v *string) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey1LastUsedService(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey1LastUsedService clears the value of the "access_key_1_last_used_service" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) ClearAccessKey1LastUsedService() *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearAccessKey1LastUsedService()
+	return _u
+}
+
+// SetAccessKey2Active sets the "access_key_2_active" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetAccessKey2Active(v bool) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey2Active(v)
+	return _u
+}
+
+// SetNillableAccessKey2Active sets the "access_key_2_active" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableAccessKey2Active(v *bool) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey2Active(*v)
+	}
+	return _u
+}
+
+// SetAccessKey2LastRotated sets the "access_key_2_last_rotated" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetAccessKey2LastRotated(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey2LastRotated(v)
+	return _u
+}
+
+// SetNillableAccessKey2LastRotated sets the "access_key_2_last_rotated" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableAccessKey2LastRotated(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey2LastRotated(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey2LastRotated clears the value of the "access_key_2_last_rotated" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) ClearAccessKey2LastRotated() *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearAccessKey2LastRotated()
+	return _u
+}
+
+// SetAccessKey2LastUsedDate sets the "access_key_2_last_used_date" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetAccessKey2LastUsedDate(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey2LastUsedDate(v)
+	return _u
+}
+
+// SetNillableAccessKey2LastUsedDate sets the "access_key_2_last_used_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableAccessKey2LastUsedDate(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey2LastUsedDate(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey2LastUsedDate clears the value of the "access_key_2_last_used_date" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) ClearAccessKey2LastUsedDate() *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearAccessKey2LastUsedDate()
+	return _u
+}
+
+// SetAccessKey2LastUsedRegion sets the "access_key_2_last_used_region" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetAccessKey2LastUsedRegion(v string) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey2LastUsedRegion(v)
+	return _u
+}
+
+// SetNillableAccessKey2LastUsedRegion sets the "access_key_2_last_used_region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableAccessKey2LastUsedRegion(v *string) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey2LastUsedRegion(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey2LastUsedRegion clears the value of the "access_key_2_last_used_region" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) ClearAccessKey2LastUsedRegion() *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearAccessKey2LastUsedRegion()
+	return _u
+}
+
+// SetAccessKey2LastUsedService sets the "access_key_2_last_used_service" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetAccessKey2LastUsedService(v string) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccessKey2LastUsedService(v)
+	return _u
+}
+
+// SetNillableAccessKey2LastUsedService sets the "access_key_2_last_used_service" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableAccessKey2LastUsedService(v *string) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccessKey2LastUsedService(*v)
+	}
+	return _u
+}
+
+// ClearAccessKey2LastUsedService clears the value of the "access_key_2_last_used_service" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) ClearAccessKey2LastUsedService() *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearAccessKey2LastUsedService()
+	return _u
+}
+
+// SetCert1Active sets the "cert_1_active" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetCert1Active(v bool) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetCert1Active(v)
+	return _u
+}
+
+// SetNillableCert1Active sets the "cert_1_active" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableCert1Active(v *bool) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetCert1Active(*v)
+	}
+	return _u
+}
+
+// SetCert1LastRotated sets the "cert_1_last_rotated" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetCert1LastRotated(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetCert1LastRotated(v)
+	return _u
+}
+
+// SetNillableCert1LastRotated sets the "cert_1_last_rotated" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableCert1LastRotated(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetCert1LastRotated(*v)
+	}
+	return _u
+}
+
+// ClearCert1LastRotated clears the value of the "cert_1_last_rotated" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) ClearCert1LastRotated() *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearCert1LastRotated()
+	return _u
+}
+
+// SetCert2Active sets the "cert_2_active" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetCert2Active(v bool) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetCert2Active(v)
+	return _u
+}
+
+// SetNillableCert2Active sets the "cert_2_active" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableCert2Active(v *bool) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetCert2Active(*v)
+	}
+	return _u
+}
+
+// SetCert2LastRotated sets the "cert_2_last_rotated" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetCert2LastRotated(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetCert2LastRotated(v)
+	return _u
+}
+
+// SetNillableCert2LastRotated sets the "cert_2_last_rotated" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableCert2LastRotated(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetCert2LastRotated(*v)
+	}
+	return _u
+}
+
+// ClearCert2LastRotated clears the value of the "cert_2_last_rotated" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) ClearCert2LastRotated() *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearCert2LastRotated()
+	return _u
+}
+
+// SetReportGeneratedAt sets the "report_generated_at" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetReportGeneratedAt(v time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetReportGeneratedAt(v)
+	return _u
+}
+
+// SetNillableReportGeneratedAt sets the "report_generated_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableReportGeneratedAt(v *time.Time) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetReportGeneratedAt(*v)
+	}
+	return _u
+}
+
+// ClearReportGeneratedAt clears the value of the "report_generated_at" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) ClearReportGeneratedAt() *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.ClearReportGeneratedAt()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetAccountID(v string) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSIAMCredentialReportMutation object of the builder.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) Mutation() *BronzeHistoryAWSIAMCredentialReportMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMCredentialReportUpdate builder.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) Where(ps ...predicate.BronzeHistoryAWSIAMCredentialReport) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) Select(field string, fields ...string) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryAWSIAMCredentialReport entity.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) Save(ctx context.Context) (*BronzeHistoryAWSIAMCredentialReport, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) SaveX(ctx context.Context) *BronzeHistoryAWSIAMCredentialReport {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsiamcredentialreport.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMCredentialReport.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.User(); ok {
+		if err := bronzehistoryawsiamcredentialreport.UserValidator(v); err != nil {
+			return &ValidationError{Name: "user", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMCredentialReport.user": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsiamcredentialreport.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMCredentialReport.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSIAMCredentialReportUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryAWSIAMCredentialReport, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsiamcredentialreport.Table, bronzehistoryawsiamcredentialreport.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsiamcredentialreport.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`iam: missing "BronzeHistoryAWSIAMCredentialReport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawsiamcredentialreport.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryawsiamcredentialreport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("iam: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryawsiamcredentialreport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.User(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldUser, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserCreationTime(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldUserCreationTime, field.TypeTime, value)
+	}
+	if _u.mutation.UserCreationTimeCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldUserCreationTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PasswordEnabled(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldPasswordEnabled, field.TypeString, value)
+	}
+	if _u.mutation.PasswordEnabledCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldPasswordEnabled, field.TypeString)
+	}
+	if value, ok := _u.mutation.PasswordLastUsed(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldPasswordLastUsed, field.TypeTime, value)
+	}
+	if _u.mutation.PasswordLastUsedCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldPasswordLastUsed, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PasswordLastChanged(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldPasswordLastChanged, field.TypeTime, value)
+	}
+	if _u.mutation.PasswordLastChangedCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldPasswordLastChanged, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PasswordNextRotation(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldPasswordNextRotation, field.TypeTime, value)
+	}
+	if _u.mutation.PasswordNextRotationCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldPasswordNextRotation, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MfaActive(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldMfaActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccessKey1Active(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey1Active, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccessKey1LastRotated(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastRotated, field.TypeTime, value)
+	}
+	if _u.mutation.AccessKey1LastRotatedCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastRotated, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccessKey1LastUsedDate(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedDate, field.TypeTime, value)
+	}
+	if _u.mutation.AccessKey1LastUsedDateCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccessKey1LastUsedRegion(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedRegion, field.TypeString, value)
+	}
+	if _u.mutation.AccessKey1LastUsedRegionCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccessKey1LastUsedService(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedService, field.TypeString, value)
+	}
+	if _u.mutation.AccessKey1LastUsedServiceCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedService, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccessKey2Active(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey2Active, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccessKey2LastRotated(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastRotated, field.TypeTime, value)
+	}
+	if _u.mutation.AccessKey2LastRotatedCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastRotated, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccessKey2LastUsedDate(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedDate, field.TypeTime, value)
+	}
+	if _u.mutation.AccessKey2LastUsedDateCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccessKey2LastUsedRegion(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedRegion, field.TypeString, value)
+	}
+	if _u.mutation.AccessKey2LastUsedRegionCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccessKey2LastUsedService(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedService, field.TypeString, value)
+	}
+	if _u.mutation.AccessKey2LastUsedServiceCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedService, field.TypeString)
+	}
+	if value, ok := _u.mutation.Cert1Active(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldCert1Active, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Cert1LastRotated(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldCert1LastRotated, field.TypeTime, value)
+	}
+	if _u.mutation.Cert1LastRotatedCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldCert1LastRotated, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Cert2Active(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldCert2Active, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Cert2LastRotated(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldCert2LastRotated, field.TypeTime, value)
+	}
+	if _u.mutation.Cert2LastRotatedCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldCert2LastRotated, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReportGeneratedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldReportGeneratedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReportGeneratedAtCleared() {
+		_spec.ClearField(bronzehistoryawsiamcredentialreport.FieldReportGeneratedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsiamcredentialreport.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSIAMCredentialReport
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryAWSIAMCredentialReport{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsiamcredentialreport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
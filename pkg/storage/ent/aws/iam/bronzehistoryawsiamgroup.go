@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamgroup"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryAWSIAMGroup is the model entity for the BronzeHistoryAWSIAMGroup schema.
+type BronzeHistoryAWSIAMGroup struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze group by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// Arn holds the value of the "arn" field.
+	Arn string `json:"arn,omitempty"`
+	// GroupName holds the value of the "group_name" field.
+	GroupName string `json:"group_name,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// CreateDate holds the value of the "create_date" field.
+	CreateDate *time.Time `json:"create_date,omitempty"`
+	// AttachedPoliciesJSON holds the value of the "attached_policies_json" field.
+	AttachedPoliciesJSON json.RawMessage `json:"attached_policies_json,omitempty"`
+	// InlinePoliciesJSON holds the value of the "inline_policies_json" field.
+	InlinePoliciesJSON json.RawMessage `json:"inline_policies_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID    string `json:"account_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryAWSIAMGroup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON, bronzehistoryawsiamgroup.FieldInlinePoliciesJSON:
+			values[i] = new([]byte)
+		case bronzehistoryawsiamgroup.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryawsiamgroup.FieldResourceID, bronzehistoryawsiamgroup.FieldArn, bronzehistoryawsiamgroup.FieldGroupName, bronzehistoryawsiamgroup.FieldPath, bronzehistoryawsiamgroup.FieldAccountID:
+			values[i] = new(sql.NullString)
+		case bronzehistoryawsiamgroup.FieldValidFrom, bronzehistoryawsiamgroup.FieldValidTo, bronzehistoryawsiamgroup.FieldCollectedAt, bronzehistoryawsiamgroup.FieldFirstCollectedAt, bronzehistoryawsiamgroup.FieldCreateDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryAWSIAMGroup fields.
+func (_m *BronzeHistoryAWSIAMGroup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsiamgroup.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryawsiamgroup.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryawsiamgroup.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryawsiamgroup.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryawsiamgroup.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryawsiamgroup.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryawsiamgroup.FieldArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field arn", values[i])
+			} else if value.Valid {
+				_m.Arn = value.String
+			}
+		case bronzehistoryawsiamgroup.FieldGroupName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_name", values[i])
+			} else if value.Valid {
+				_m.GroupName = value.String
+			}
+		case bronzehistoryawsiamgroup.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				_m.Path = value.String
+			}
+		case bronzehistoryawsiamgroup.FieldCreateDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_date", values[i])
+			} else if value.Valid {
+				_m.CreateDate = new(time.Time)
+				*_m.CreateDate = value.Time
+			}
+		case bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attached_policies_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AttachedPoliciesJSON); err != nil {
+					return fmt.Errorf("unmarshal field attached_policies_json: %w", err)
+				}
+			}
+		case bronzehistoryawsiamgroup.FieldInlinePoliciesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field inline_policies_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.InlinePoliciesJSON); err != nil {
+					return fmt.Errorf("unmarshal field inline_policies_json: %w", err)
+				}
+			}
+		case bronzehistoryawsiamgroup.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryAWSIAMGroup.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryAWSIAMGroup) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryAWSIAMGroup.
+// Note that you need to call BronzeHistoryAWSIAMGroup.Unwrap() before calling this method if this BronzeHistoryAWSIAMGroup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryAWSIAMGroup) Update() *BronzeHistoryAWSIAMGroupUpdateOne {
+	return NewBronzeHistoryAWSIAMGroupClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryAWSIAMGroup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryAWSIAMGroup) Unwrap() *BronzeHistoryAWSIAMGroup {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("iam: BronzeHistoryAWSIAMGroup is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryAWSIAMGroup) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryAWSIAMGroup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("arn=")
+	builder.WriteString(_m.Arn)
+	builder.WriteString(", ")
+	builder.WriteString("group_name=")
+	builder.WriteString(_m.GroupName)
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(_m.Path)
+	builder.WriteString(", ")
+	if v := _m.CreateDate; v != nil {
+		builder.WriteString("create_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attached_policies_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttachedPoliciesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("inline_policies_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InlinePoliciesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryAWSIAMGroups is a parsable slice of BronzeHistoryAWSIAMGroup.
+type BronzeHistoryAWSIAMGroups []*BronzeHistoryAWSIAMGroup
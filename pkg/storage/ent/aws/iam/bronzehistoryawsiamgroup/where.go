@@ -0,0 +1,695 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryawsiamgroup
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLTE(FieldID, id))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldValidTo, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldResourceID, v))
+}
+
+// Arn applies equality check predicate on the "arn" field. It's identical to ArnEQ.
+func Arn(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldArn, v))
+}
+
+// GroupName applies equality check predicate on the "group_name" field. It's identical to GroupNameEQ.
+func GroupName(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldGroupName, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldPath, v))
+}
+
+// CreateDate applies equality check predicate on the "create_date" field. It's identical to CreateDateEQ.
+func CreateDate(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldCreateDate, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldAccountID, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNotNull(FieldValidTo))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// ArnEQ applies the EQ predicate on the "arn" field.
+func ArnEQ(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldArn, v))
+}
+
+// ArnNEQ applies the NEQ predicate on the "arn" field.
+func ArnNEQ(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNEQ(FieldArn, v))
+}
+
+// ArnIn applies the In predicate on the "arn" field.
+func ArnIn(vs ...string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldIn(FieldArn, vs...))
+}
+
+// ArnNotIn applies the NotIn predicate on the "arn" field.
+func ArnNotIn(vs ...string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNotIn(FieldArn, vs...))
+}
+
+// ArnGT applies the GT predicate on the "arn" field.
+func ArnGT(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGT(FieldArn, v))
+}
+
+// ArnGTE applies the GTE predicate on the "arn" field.
+func ArnGTE(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGTE(FieldArn, v))
+}
+
+// ArnLT applies the LT predicate on the "arn" field.
+func ArnLT(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLT(FieldArn, v))
+}
+
+// ArnLTE applies the LTE predicate on the "arn" field.
+func ArnLTE(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLTE(FieldArn, v))
+}
+
+// ArnContains applies the Contains predicate on the "arn" field.
+func ArnContains(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldContains(FieldArn, v))
+}
+
+// ArnHasPrefix applies the HasPrefix predicate on the "arn" field.
+func ArnHasPrefix(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldHasPrefix(FieldArn, v))
+}
+
+// ArnHasSuffix applies the HasSuffix predicate on the "arn" field.
+func ArnHasSuffix(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldHasSuffix(FieldArn, v))
+}
+
+// ArnEqualFold applies the EqualFold predicate on the "arn" field.
+func ArnEqualFold(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEqualFold(FieldArn, v))
+}
+
+// ArnContainsFold applies the ContainsFold predicate on the "arn" field.
+func ArnContainsFold(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldContainsFold(FieldArn, v))
+}
+
+// GroupNameEQ applies the EQ predicate on the "group_name" field.
+func GroupNameEQ(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldGroupName, v))
+}
+
+// GroupNameNEQ applies the NEQ predicate on the "group_name" field.
+func GroupNameNEQ(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNEQ(FieldGroupName, v))
+}
+
+// GroupNameIn applies the In predicate on the "group_name" field.
+func GroupNameIn(vs ...string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldIn(FieldGroupName, vs...))
+}
+
+// GroupNameNotIn applies the NotIn predicate on the "group_name" field.
+func GroupNameNotIn(vs ...string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNotIn(FieldGroupName, vs...))
+}
+
+// GroupNameGT applies the GT predicate on the "group_name" field.
+func GroupNameGT(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGT(FieldGroupName, v))
+}
+
+// GroupNameGTE applies the GTE predicate on the "group_name" field.
+func GroupNameGTE(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGTE(FieldGroupName, v))
+}
+
+// GroupNameLT applies the LT predicate on the "group_name" field.
+func GroupNameLT(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLT(FieldGroupName, v))
+}
+
+// GroupNameLTE applies the LTE predicate on the "group_name" field.
+func GroupNameLTE(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLTE(FieldGroupName, v))
+}
+
+// GroupNameContains applies the Contains predicate on the "group_name" field.
+func GroupNameContains(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldContains(FieldGroupName, v))
+}
+
+// GroupNameHasPrefix applies the HasPrefix predicate on the "group_name" field.
+func GroupNameHasPrefix(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldHasPrefix(FieldGroupName, v))
+}
+
+// GroupNameHasSuffix applies the HasSuffix predicate on the "group_name" field.
+func GroupNameHasSuffix(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldHasSuffix(FieldGroupName, v))
+}
+
+// GroupNameEqualFold applies the EqualFold predicate on the "group_name" field.
+func GroupNameEqualFold(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEqualFold(FieldGroupName, v))
+}
+
+// GroupNameContainsFold applies the ContainsFold predicate on the "group_name" field.
+func GroupNameContainsFold(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldContainsFold(FieldGroupName, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathIsNil applies the IsNil predicate on the "path" field.
+func PathIsNil() predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldIsNull(FieldPath))
+}
+
+// PathNotNil applies the NotNil predicate on the "path" field.
+func PathNotNil() predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNotNull(FieldPath))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldContainsFold(FieldPath, v))
+}
+
+// CreateDateEQ applies the EQ predicate on the "create_date" field.
+func CreateDateEQ(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldCreateDate, v))
+}
+
+// CreateDateNEQ applies the NEQ predicate on the "create_date" field.
+func CreateDateNEQ(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNEQ(FieldCreateDate, v))
+}
+
+// CreateDateIn applies the In predicate on the "create_date" field.
+func CreateDateIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldIn(FieldCreateDate, vs...))
+}
+
+// CreateDateNotIn applies the NotIn predicate on the "create_date" field.
+func CreateDateNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNotIn(FieldCreateDate, vs...))
+}
+
+// CreateDateGT applies the GT predicate on the "create_date" field.
+func CreateDateGT(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGT(FieldCreateDate, v))
+}
+
+// CreateDateGTE applies the GTE predicate on the "create_date" field.
+func CreateDateGTE(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGTE(FieldCreateDate, v))
+}
+
+// CreateDateLT applies the LT predicate on the "create_date" field.
+func CreateDateLT(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLT(FieldCreateDate, v))
+}
+
+// CreateDateLTE applies the LTE predicate on the "create_date" field.
+func CreateDateLTE(v time.Time) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLTE(FieldCreateDate, v))
+}
+
+// CreateDateIsNil applies the IsNil predicate on the "create_date" field.
+func CreateDateIsNil() predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldIsNull(FieldCreateDate))
+}
+
+// CreateDateNotNil applies the NotNil predicate on the "create_date" field.
+func CreateDateNotNil() predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNotNull(FieldCreateDate))
+}
+
+// AttachedPoliciesJSONIsNil applies the IsNil predicate on the "attached_policies_json" field.
+func AttachedPoliciesJSONIsNil() predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldIsNull(FieldAttachedPoliciesJSON))
+}
+
+// AttachedPoliciesJSONNotNil applies the NotNil predicate on the "attached_policies_json" field.
+func AttachedPoliciesJSONNotNil() predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNotNull(FieldAttachedPoliciesJSON))
+}
+
+// InlinePoliciesJSONIsNil applies the IsNil predicate on the "inline_policies_json" field.
+func InlinePoliciesJSONIsNil() predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldIsNull(FieldInlinePoliciesJSON))
+}
+
+// InlinePoliciesJSONNotNil applies the NotNil predicate on the "inline_policies_json" field.
+func InlinePoliciesJSONNotNil() predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNotNull(FieldInlinePoliciesJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeHistoryAWSIAMGroup) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeHistoryAWSIAMGroup) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeHistoryAWSIAMGroup) predicate.BronzeHistoryAWSIAMGroup {
+	return predicate.BronzeHistoryAWSIAMGroup(sql.NotPredicates(p))
+}
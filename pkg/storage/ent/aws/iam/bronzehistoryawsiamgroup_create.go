@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamgroup"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSIAMGroupCreate is the builder for creating a BronzeHistoryAWSIAMGroup entity.
+type BronzeHistoryAWSIAMGroupCreate struct {
+	config
+	mutation *BronzeHistoryAWSIAMGroupMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryAWSIAMGroupCreate) SetValidFrom(v time.Time) *BronzeHistoryAWSIAMGroupCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryAWSIAMGroupCreate) SetValidTo(v time.Time) *BronzeHistoryAWSIAMGroupCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMGroupCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSIAMGroupCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryAWSIAMGroupCreate) SetCollectedAt(v time.Time) *BronzeHistoryAWSIAMGroupCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryAWSIAMGroupCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSIAMGroupCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryAWSIAMGroupCreate) SetResourceID(v string) *BronzeHistoryAWSIAMGroupCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetArn sets the "arn" field.
+func (_c *BronzeHistoryAWSIAMGroupCreate) SetArn(v string) *BronzeHistoryAWSIAMGroupCreate {
+	_c.mutation.SetArn(v)
+	return _c
+}
+
+// SetGroupName sets the "group_name" field.
+func (_c *BronzeHistoryAWSIAMGroupCreate) SetGroupName(v string) *BronzeHistoryAWSIAMGroupCreate {
+	_c.mutation.SetGroupName(v)
+	return _c
+}
+
+// SetPath sets the "path" field.
+func (_c *BronzeHistoryAWSIAMGroupCreate) SetPath(v string) *BronzeHistoryAWSIAMGroupCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMGroupCreate) SetNillablePath(v *string) *BronzeHistoryAWSIAMGroupCreate {
+	if v != nil {
+		_c.SetPath(*v)
+	}
+	return _c
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_c *BronzeHistoryAWSIAMGroupCreate) SetCreateDate(v time.Time) *BronzeHistoryAWSIAMGroupCreate {
+	_c.mutation.SetCreateDate(v)
+	return _c
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMGroupCreate) SetNillableCreateDate(v *time.Time) *BronzeHistoryAWSIAMGroupCreate {
+	if v != nil {
+		_c.SetCreateDate(*v)
+	}
+	return _c
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (_c *BronzeHistoryAWSIAMGroupCreate) SetAttachedPoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMGroupCreate {
+	_c.mutation.SetAttachedPoliciesJSON(v)
+	return _c
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (_c *BronzeHistoryAWSIAMGroupCreate) SetInlinePoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMGroupCreate {
+	_c.mutation.SetInlinePoliciesJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeHistoryAWSIAMGroupCreate) SetAccountID(v string) *BronzeHistoryAWSIAMGroupCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryAWSIAMGroupCreate) SetID(v uint) *BronzeHistoryAWSIAMGroupCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryAWSIAMGroupMutation object of the builder.
+func (_c *BronzeHistoryAWSIAMGroupCreate) Mutation() *BronzeHistoryAWSIAMGroupMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryAWSIAMGroup in the database.
+func (_c *BronzeHistoryAWSIAMGroupCreate) Save(ctx context.Context) (*BronzeHistoryAWSIAMGroup, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryAWSIAMGroupCreate) SaveX(ctx context.Context) *BronzeHistoryAWSIAMGroup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSIAMGroupCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSIAMGroupCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryAWSIAMGroupCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMGroup.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMGroup.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMGroup.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMGroup.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsiamgroup.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMGroup.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Arn(); !ok {
+		return &ValidationError{Name: "arn", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMGroup.arn"`)}
+	}
+	if v, ok := _c.mutation.Arn(); ok {
+		if err := bronzehistoryawsiamgroup.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMGroup.arn": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GroupName(); !ok {
+		return &ValidationError{Name: "group_name", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMGroup.group_name"`)}
+	}
+	if v, ok := _c.mutation.GroupName(); ok {
+		if err := bronzehistoryawsiamgroup.GroupNameValidator(v); err != nil {
+			return &ValidationError{Name: "group_name", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMGroup.group_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMGroup.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzehistoryawsiamgroup.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMGroup.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryAWSIAMGroupCreate) sqlSave(ctx context.Context) (*BronzeHistoryAWSIAMGroup, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryAWSIAMGroupCreate) createSpec() (*BronzeHistoryAWSIAMGroup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryAWSIAMGroup{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryawsiamgroup.Table, sqlgraph.NewFieldSpec(bronzehistoryawsiamgroup.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryAWSIAMGroup
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.Arn(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldArn, field.TypeString, value)
+		_node.Arn = value
+	}
+	if value, ok := _c.mutation.GroupName(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldGroupName, field.TypeString, value)
+		_node.GroupName = value
+	}
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := _c.mutation.CreateDate(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldCreateDate, field.TypeTime, value)
+		_node.CreateDate = &value
+	}
+	if value, ok := _c.mutation.AttachedPoliciesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON, field.TypeJSON, value)
+		_node.AttachedPoliciesJSON = value
+	}
+	if value, ok := _c.mutation.InlinePoliciesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldInlinePoliciesJSON, field.TypeJSON, value)
+		_node.InlinePoliciesJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryAWSIAMGroupCreateBulk is the builder for creating many BronzeHistoryAWSIAMGroup entities in bulk.
+type BronzeHistoryAWSIAMGroupCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryAWSIAMGroupCreate
+}
+
+// Save creates the BronzeHistoryAWSIAMGroup entities in the database.
+func (_c *BronzeHistoryAWSIAMGroupCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryAWSIAMGroup, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryAWSIAMGroup, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryAWSIAMGroupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryAWSIAMGroupCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryAWSIAMGroup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSIAMGroupCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSIAMGroupCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
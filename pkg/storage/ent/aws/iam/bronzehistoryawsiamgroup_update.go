@@ -0,0 +1,715 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamgroup"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSIAMGroupUpdate is the builder for updating BronzeHistoryAWSIAMGroup entities.
+type BronzeHistoryAWSIAMGroupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSIAMGroupMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMGroupUpdate builder.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) Where(ps ...predicate.BronzeHistoryAWSIAMGroup) *BronzeHistoryAWSIAMGroupUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetValidTo(v time.Time) *BronzeHistoryAWSIAMGroupUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSIAMGroupUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) ClearValidTo() *BronzeHistoryAWSIAMGroupUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetCollectedAt(v time.Time) *BronzeHistoryAWSIAMGroupUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSIAMGroupUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSIAMGroupUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSIAMGroupUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetResourceID(v string) *BronzeHistoryAWSIAMGroupUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetNillableResourceID(v *string) *BronzeHistoryAWSIAMGroupUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetArn(v string) *BronzeHistoryAWSIAMGroupUpdate {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetNillableArn(v *string) *BronzeHistoryAWSIAMGroupUpdate {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// SetGroupName sets the "group_name" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetGroupName(v string) *BronzeHistoryAWSIAMGroupUpdate {
+	_u.mutation.SetGroupName(v)
+	return _u
+}
+
+// SetNillableGroupName sets the "group_name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetNillableGroupName(v *string) *BronzeHistoryAWSIAMGroupUpdate {
+	if v != nil {
+		_u.SetGroupName(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetPath(v string) *BronzeHistoryAWSIAMGroupUpdate {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetNillablePath(v *string) *BronzeHistoryAWSIAMGroupUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// ClearPath clears the value of the "path" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) ClearPath() *BronzeHistoryAWSIAMGroupUpdate {
+	_u.mutation.ClearPath()
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetCreateDate(v time.Time) *BronzeHistoryAWSIAMGroupUpdate {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetNillableCreateDate(v *time.Time) *BronzeHistoryAWSIAMGroupUpdate {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) ClearCreateDate() *BronzeHistoryAWSIAMGroupUpdate {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetAttachedPoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMGroupUpdate {
+	_u.mutation.SetAttachedPoliciesJSON(v)
+	return _u
+}
+
+// AppendAttachedPoliciesJSON appends value to the "attached_policies_json" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) AppendAttachedPoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMGroupUpdate {
+	_u.mutation.AppendAttachedPoliciesJSON(v)
+	return _u
+}
+
+// ClearAttachedPoliciesJSON clears the value of the "attached_policies_json" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) ClearAttachedPoliciesJSON() *BronzeHistoryAWSIAMGroupUpdate {
+	_u.mutation.ClearAttachedPoliciesJSON()
+	return _u
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetInlinePoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMGroupUpdate {
+	_u.mutation.SetInlinePoliciesJSON(v)
+	return _u
+}
+
+// AppendInlinePoliciesJSON appends value to the "inline_policies_json" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) AppendInlinePoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMGroupUpdate {
+	_u.mutation.AppendInlinePoliciesJSON(v)
+	return _u
+}
+
+// ClearInlinePoliciesJSON clears the value of the "inline_policies_json" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) ClearInlinePoliciesJSON() *BronzeHistoryAWSIAMGroupUpdate {
+	_u.mutation.ClearInlinePoliciesJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetAccountID(v string) *BronzeHistoryAWSIAMGroupUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SetNillableAccountID(v *string) *BronzeHistoryAWSIAMGroupUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSIAMGroupMutation object of the builder.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) Mutation() *BronzeHistoryAWSIAMGroupMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSIAMGroupUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsiamgroup.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMGroup.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Arn(); ok {
+		if err := bronzehistoryawsiamgroup.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMGroup.arn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupName(); ok {
+		if err := bronzehistoryawsiamgroup.GroupNameValidator(v); err != nil {
+			return &ValidationError{Name: "group_name", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMGroup.group_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsiamgroup.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMGroup.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSIAMGroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsiamgroup.Table, bronzehistoryawsiamgroup.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsiamgroup.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsiamgroup.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldArn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GroupName(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldGroupName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldPath, field.TypeString, value)
+	}
+	if _u.mutation.PathCleared() {
+		_spec.ClearField(bronzehistoryawsiamgroup.FieldPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzehistoryawsiamgroup.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AttachedPoliciesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttachedPoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON, value)
+		})
+	}
+	if _u.mutation.AttachedPoliciesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InlinePoliciesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldInlinePoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedInlinePoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamgroup.FieldInlinePoliciesJSON, value)
+		})
+	}
+	if _u.mutation.InlinePoliciesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamgroup.FieldInlinePoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSIAMGroup
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsiamgroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryAWSIAMGroupUpdateOne is the builder for updating a single BronzeHistoryAWSIAMGroup entity.
+type BronzeHistoryAWSIAMGroupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryAWSIAMGroupMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetValidTo(v time.Time) *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSIAMGroupUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) ClearValidTo() *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSIAMGroupUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSIAMGroupUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetResourceID(v string) *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryAWSIAMGroupUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetArn(v string) *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetNillableArn(v *string) *BronzeHistoryAWSIAMGroupUpdateOne {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// SetGroupName sets the "group_name" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetGroupName(v string) *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.mutation.SetGroupName(v)
+	return _u
+}
+
+// SetNillableGroupName sets the "group_name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetNillableGroupName(v *string) *BronzeHistoryAWSIAMGroupUpdateOne {
+	if v != nil {
+		_u.SetGroupName(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetPath(v string) *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetNillablePath(v *string) *BronzeHistoryAWSIAMGroupUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// ClearPath clears the value of the "path" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) ClearPath() *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.mutation.ClearPath()
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetCreateDate(v time.Time) *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetNillableCreateDate(v *time.Time) *BronzeHistoryAWSIAMGroupUpdateOne {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) ClearCreateDate() *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetAttachedPoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.mutation.SetAttachedPoliciesJSON(v)
+	return _u
+}
+
+// AppendAttachedPoliciesJSON appends value to the "attached_policies_json" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) AppendAttachedPoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.mutation.AppendAttachedPoliciesJSON(v)
+	return _u
+}
+
+// ClearAttachedPoliciesJSON clears the value of the "attached_policies_json" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) ClearAttachedPoliciesJSON() *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.mutation.ClearAttachedPoliciesJSON()
+	return _u
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetInlinePoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.mutation.SetInlinePoliciesJSON(v)
+	return _u
+}
+
+// AppendInlinePoliciesJSON appends value to the "inline_policies_json" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) AppendInlinePoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.mutation.AppendInlinePoliciesJSON(v)
+	return _u
+}
+
+// ClearInlinePoliciesJSON clears the value of the "inline_policies_json" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) ClearInlinePoliciesJSON() *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.mutation.ClearInlinePoliciesJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetAccountID(v string) *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryAWSIAMGroupUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSIAMGroupMutation object of the builder.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) Mutation() *BronzeHistoryAWSIAMGroupMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMGroupUpdate builder.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) Where(ps ...predicate.BronzeHistoryAWSIAMGroup) *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) Select(field string, fields ...string) *BronzeHistoryAWSIAMGroupUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryAWSIAMGroup entity.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) Save(ctx context.Context) (*BronzeHistoryAWSIAMGroup, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) SaveX(ctx context.Context) *BronzeHistoryAWSIAMGroup {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsiamgroup.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMGroup.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Arn(); ok {
+		if err := bronzehistoryawsiamgroup.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMGroup.arn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupName(); ok {
+		if err := bronzehistoryawsiamgroup.GroupNameValidator(v); err != nil {
+			return &ValidationError{Name: "group_name", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMGroup.group_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsiamgroup.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMGroup.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSIAMGroupUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryAWSIAMGroup, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsiamgroup.Table, bronzehistoryawsiamgroup.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsiamgroup.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`iam: missing "BronzeHistoryAWSIAMGroup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawsiamgroup.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryawsiamgroup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("iam: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryawsiamgroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsiamgroup.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldArn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GroupName(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldGroupName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldPath, field.TypeString, value)
+	}
+	if _u.mutation.PathCleared() {
+		_spec.ClearField(bronzehistoryawsiamgroup.FieldPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzehistoryawsiamgroup.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AttachedPoliciesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttachedPoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON, value)
+		})
+	}
+	if _u.mutation.AttachedPoliciesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InlinePoliciesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldInlinePoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedInlinePoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamgroup.FieldInlinePoliciesJSON, value)
+		})
+	}
+	if _u.mutation.InlinePoliciesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamgroup.FieldInlinePoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsiamgroup.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSIAMGroup
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryAWSIAMGroup{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsiamgroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
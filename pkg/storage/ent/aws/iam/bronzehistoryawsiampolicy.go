@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiampolicy"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryAWSIAMPolicy is the model entity for the BronzeHistoryAWSIAMPolicy schema.
+type BronzeHistoryAWSIAMPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze policy by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// Arn holds the value of the "arn" field.
+	Arn string `json:"arn,omitempty"`
+	// PolicyName holds the value of the "policy_name" field.
+	PolicyName string `json:"policy_name,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// DefaultVersionID holds the value of the "default_version_id" field.
+	DefaultVersionID string `json:"default_version_id,omitempty"`
+	// AttachmentCount holds the value of the "attachment_count" field.
+	AttachmentCount int `json:"attachment_count,omitempty"`
+	// PermissionsBoundaryUsageCount holds the value of the "permissions_boundary_usage_count" field.
+	PermissionsBoundaryUsageCount int `json:"permissions_boundary_usage_count,omitempty"`
+	// IsAttachable holds the value of the "is_attachable" field.
+	IsAttachable bool `json:"is_attachable,omitempty"`
+	// AWSManaged holds the value of the "aws_managed" field.
+	AWSManaged bool `json:"aws_managed,omitempty"`
+	// CreateDate holds the value of the "create_date" field.
+	CreateDate *time.Time `json:"create_date,omitempty"`
+	// UpdateDate holds the value of the "update_date" field.
+	UpdateDate *time.Time `json:"update_date,omitempty"`
+	// VersionsJSON holds the value of the "versions_json" field.
+	VersionsJSON json.RawMessage `json:"versions_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID    string `json:"account_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryAWSIAMPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsiampolicy.FieldVersionsJSON:
+			values[i] = new([]byte)
+		case bronzehistoryawsiampolicy.FieldIsAttachable, bronzehistoryawsiampolicy.FieldAWSManaged:
+			values[i] = new(sql.NullBool)
+		case bronzehistoryawsiampolicy.FieldID, bronzehistoryawsiampolicy.FieldAttachmentCount, bronzehistoryawsiampolicy.FieldPermissionsBoundaryUsageCount:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryawsiampolicy.FieldResourceID, bronzehistoryawsiampolicy.FieldArn, bronzehistoryawsiampolicy.FieldPolicyName, bronzehistoryawsiampolicy.FieldPath, bronzehistoryawsiampolicy.FieldDescription, bronzehistoryawsiampolicy.FieldDefaultVersionID, bronzehistoryawsiampolicy.FieldAccountID:
+			values[i] = new(sql.NullString)
+		case bronzehistoryawsiampolicy.FieldValidFrom, bronzehistoryawsiampolicy.FieldValidTo, bronzehistoryawsiampolicy.FieldCollectedAt, bronzehistoryawsiampolicy.FieldFirstCollectedAt, bronzehistoryawsiampolicy.FieldCreateDate, bronzehistoryawsiampolicy.FieldUpdateDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryAWSIAMPolicy fields.
+func (_m *BronzeHistoryAWSIAMPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawsiampolicy.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryawsiampolicy.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryawsiampolicy.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryawsiampolicy.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryawsiampolicy.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryawsiampolicy.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryawsiampolicy.FieldArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field arn", values[i])
+			} else if value.Valid {
+				_m.Arn = value.String
+			}
+		case bronzehistoryawsiampolicy.FieldPolicyName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_name", values[i])
+			} else if value.Valid {
+				_m.PolicyName = value.String
+			}
+		case bronzehistoryawsiampolicy.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				_m.Path = value.String
+			}
+		case bronzehistoryawsiampolicy.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case bronzehistoryawsiampolicy.FieldDefaultVersionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field default_version_id", values[i])
+			} else if value.Valid {
+				_m.DefaultVersionID = value.String
+			}
+		case bronzehistoryawsiampolicy.FieldAttachmentCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment_count", values[i])
+			} else if value.Valid {
+				_m.AttachmentCount = int(value.Int64)
+			}
+		case bronzehistoryawsiampolicy.FieldPermissionsBoundaryUsageCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field permissions_boundary_usage_count", values[i])
+			} else if value.Valid {
+				_m.PermissionsBoundaryUsageCount = int(value.Int64)
+			}
+		case bronzehistoryawsiampolicy.FieldIsAttachable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_attachable", values[i])
+			} else if value.Valid {
+				_m.IsAttachable = value.Bool
+			}
+		case bronzehistoryawsiampolicy.FieldAWSManaged:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field aws_managed", values[i])
+			} else if value.Valid {
+				_m.AWSManaged = value.Bool
+			}
+		case bronzehistoryawsiampolicy.FieldCreateDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_date", values[i])
+			} else if value.Valid {
+				_m.CreateDate = new(time.Time)
+				*_m.CreateDate = value.Time
+			}
+		case bronzehistoryawsiampolicy.FieldUpdateDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_date", values[i])
+			} else if value.Valid {
+				_m.UpdateDate = new(time.Time)
+				*_m.UpdateDate = value.Time
+			}
+		case bronzehistoryawsiampolicy.FieldVersionsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field versions_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.VersionsJSON); err != nil {
+					return fmt.Errorf("unmarshal field versions_json: %w", err)
+				}
+			}
+		case bronzehistoryawsiampolicy.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryAWSIAMPolicy.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryAWSIAMPolicy) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryAWSIAMPolicy.
+// Note that you need to call BronzeHistoryAWSIAMPolicy.Unwrap() before calling this method if this BronzeHistoryAWSIAMPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryAWSIAMPolicy) Update() *BronzeHistoryAWSIAMPolicyUpdateOne {
+	return NewBronzeHistoryAWSIAMPolicyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryAWSIAMPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryAWSIAMPolicy) Unwrap() *BronzeHistoryAWSIAMPolicy {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("iam: BronzeHistoryAWSIAMPolicy is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryAWSIAMPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryAWSIAMPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("arn=")
+	builder.WriteString(_m.Arn)
+	builder.WriteString(", ")
+	builder.WriteString("policy_name=")
+	builder.WriteString(_m.PolicyName)
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(_m.Path)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("default_version_id=")
+	builder.WriteString(_m.DefaultVersionID)
+	builder.WriteString(", ")
+	builder.WriteString("attachment_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttachmentCount))
+	builder.WriteString(", ")
+	builder.WriteString("permissions_boundary_usage_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PermissionsBoundaryUsageCount))
+	builder.WriteString(", ")
+	builder.WriteString("is_attachable=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsAttachable))
+	builder.WriteString(", ")
+	builder.WriteString("aws_managed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AWSManaged))
+	builder.WriteString(", ")
+	if v := _m.CreateDate; v != nil {
+		builder.WriteString("create_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateDate; v != nil {
+		builder.WriteString("update_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("versions_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VersionsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryAWSIAMPolicies is a parsable slice of BronzeHistoryAWSIAMPolicy.
+type BronzeHistoryAWSIAMPolicies []*BronzeHistoryAWSIAMPolicy
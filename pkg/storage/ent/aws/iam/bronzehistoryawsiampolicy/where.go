@@ -0,0 +1,1020 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryawsiampolicy
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLTE(FieldID, id))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldValidTo, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldResourceID, v))
+}
+
+// Arn applies equality check predicate on the "arn" field. It's identical to ArnEQ.
+func Arn(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldArn, v))
+}
+
+// PolicyName applies equality check predicate on the "policy_name" field. It's identical to PolicyNameEQ.
+func PolicyName(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldPolicyName, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldPath, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldDescription, v))
+}
+
+// DefaultVersionID applies equality check predicate on the "default_version_id" field. It's identical to DefaultVersionIDEQ.
+func DefaultVersionID(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldDefaultVersionID, v))
+}
+
+// AttachmentCount applies equality check predicate on the "attachment_count" field. It's identical to AttachmentCountEQ.
+func AttachmentCount(v int) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldAttachmentCount, v))
+}
+
+// PermissionsBoundaryUsageCount applies equality check predicate on the "permissions_boundary_usage_count" field. It's identical to PermissionsBoundaryUsageCountEQ.
+func PermissionsBoundaryUsageCount(v int) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldPermissionsBoundaryUsageCount, v))
+}
+
+// IsAttachable applies equality check predicate on the "is_attachable" field. It's identical to IsAttachableEQ.
+func IsAttachable(v bool) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldIsAttachable, v))
+}
+
+// AWSManaged applies equality check predicate on the "aws_managed" field. It's identical to AWSManagedEQ.
+func AWSManaged(v bool) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldAWSManaged, v))
+}
+
+// CreateDate applies equality check predicate on the "create_date" field. It's identical to CreateDateEQ.
+func CreateDate(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldCreateDate, v))
+}
+
+// UpdateDate applies equality check predicate on the "update_date" field. It's identical to UpdateDateEQ.
+func UpdateDate(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldUpdateDate, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldAccountID, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotNull(FieldValidTo))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// ArnEQ applies the EQ predicate on the "arn" field.
+func ArnEQ(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldArn, v))
+}
+
+// ArnNEQ applies the NEQ predicate on the "arn" field.
+func ArnNEQ(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNEQ(FieldArn, v))
+}
+
+// ArnIn applies the In predicate on the "arn" field.
+func ArnIn(vs ...string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIn(FieldArn, vs...))
+}
+
+// ArnNotIn applies the NotIn predicate on the "arn" field.
+func ArnNotIn(vs ...string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotIn(FieldArn, vs...))
+}
+
+// ArnGT applies the GT predicate on the "arn" field.
+func ArnGT(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGT(FieldArn, v))
+}
+
+// ArnGTE applies the GTE predicate on the "arn" field.
+func ArnGTE(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGTE(FieldArn, v))
+}
+
+// ArnLT applies the LT predicate on the "arn" field.
+func ArnLT(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLT(FieldArn, v))
+}
+
+// ArnLTE applies the LTE predicate on the "arn" field.
+func ArnLTE(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLTE(FieldArn, v))
+}
+
+// ArnContains applies the Contains predicate on the "arn" field.
+func ArnContains(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldContains(FieldArn, v))
+}
+
+// ArnHasPrefix applies the HasPrefix predicate on the "arn" field.
+func ArnHasPrefix(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldHasPrefix(FieldArn, v))
+}
+
+// ArnHasSuffix applies the HasSuffix predicate on the "arn" field.
+func ArnHasSuffix(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldHasSuffix(FieldArn, v))
+}
+
+// ArnEqualFold applies the EqualFold predicate on the "arn" field.
+func ArnEqualFold(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEqualFold(FieldArn, v))
+}
+
+// ArnContainsFold applies the ContainsFold predicate on the "arn" field.
+func ArnContainsFold(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldContainsFold(FieldArn, v))
+}
+
+// PolicyNameEQ applies the EQ predicate on the "policy_name" field.
+func PolicyNameEQ(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldPolicyName, v))
+}
+
+// PolicyNameNEQ applies the NEQ predicate on the "policy_name" field.
+func PolicyNameNEQ(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNEQ(FieldPolicyName, v))
+}
+
+// PolicyNameIn applies the In predicate on the "policy_name" field.
+func PolicyNameIn(vs ...string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIn(FieldPolicyName, vs...))
+}
+
+// PolicyNameNotIn applies the NotIn predicate on the "policy_name" field.
+func PolicyNameNotIn(vs ...string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotIn(FieldPolicyName, vs...))
+}
+
+// PolicyNameGT applies the GT predicate on the "policy_name" field.
+func PolicyNameGT(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGT(FieldPolicyName, v))
+}
+
+// PolicyNameGTE applies the GTE predicate on the "policy_name" field.
+func PolicyNameGTE(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGTE(FieldPolicyName, v))
+}
+
+// PolicyNameLT applies the LT predicate on the "policy_name" field.
+func PolicyNameLT(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLT(FieldPolicyName, v))
+}
+
+// PolicyNameLTE applies the LTE predicate on the "policy_name" field.
+func PolicyNameLTE(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLTE(FieldPolicyName, v))
+}
+
+// PolicyNameContains applies the Contains predicate on the "policy_name" field.
+func PolicyNameContains(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldContains(FieldPolicyName, v))
+}
+
+// PolicyNameHasPrefix applies the HasPrefix predicate on the "policy_name" field.
+func PolicyNameHasPrefix(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldHasPrefix(FieldPolicyName, v))
+}
+
+// PolicyNameHasSuffix applies the HasSuffix predicate on the "policy_name" field.
+func PolicyNameHasSuffix(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldHasSuffix(FieldPolicyName, v))
+}
+
+// PolicyNameEqualFold applies the EqualFold predicate on the "policy_name" field.
+func PolicyNameEqualFold(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEqualFold(FieldPolicyName, v))
+}
+
+// PolicyNameContainsFold applies the ContainsFold predicate on the "policy_name" field.
+func PolicyNameContainsFold(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldContainsFold(FieldPolicyName, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathIsNil applies the IsNil predicate on the "path" field.
+func PathIsNil() predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIsNull(FieldPath))
+}
+
+// PathNotNil applies the NotNil predicate on the "path" field.
+func PathNotNil() predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotNull(FieldPath))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldContainsFold(FieldPath, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// DefaultVersionIDEQ applies the EQ predicate on the "default_version_id" field.
+func DefaultVersionIDEQ(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDNEQ applies the NEQ predicate on the "default_version_id" field.
+func DefaultVersionIDNEQ(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNEQ(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDIn applies the In predicate on the "default_version_id" field.
+func DefaultVersionIDIn(vs ...string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIn(FieldDefaultVersionID, vs...))
+}
+
+// DefaultVersionIDNotIn applies the NotIn predicate on the "default_version_id" field.
+func DefaultVersionIDNotIn(vs ...string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotIn(FieldDefaultVersionID, vs...))
+}
+
+// DefaultVersionIDGT applies the GT predicate on the "default_version_id" field.
+func DefaultVersionIDGT(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGT(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDGTE applies the GTE predicate on the "default_version_id" field.
+func DefaultVersionIDGTE(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGTE(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDLT applies the LT predicate on the "default_version_id" field.
+func DefaultVersionIDLT(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLT(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDLTE applies the LTE predicate on the "default_version_id" field.
+func DefaultVersionIDLTE(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLTE(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDContains applies the Contains predicate on the "default_version_id" field.
+func DefaultVersionIDContains(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldContains(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDHasPrefix applies the HasPrefix predicate on the "default_version_id" field.
+func DefaultVersionIDHasPrefix(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldHasPrefix(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDHasSuffix applies the HasSuffix predicate on the "default_version_id" field.
+func DefaultVersionIDHasSuffix(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldHasSuffix(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDIsNil applies the IsNil predicate on the "default_version_id" field.
+func DefaultVersionIDIsNil() predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIsNull(FieldDefaultVersionID))
+}
+
+// DefaultVersionIDNotNil applies the NotNil predicate on the "default_version_id" field.
+func DefaultVersionIDNotNil() predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotNull(FieldDefaultVersionID))
+}
+
+// DefaultVersionIDEqualFold applies the EqualFold predicate on the "default_version_id" field.
+func DefaultVersionIDEqualFold(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEqualFold(FieldDefaultVersionID, v))
+}
+
+// DefaultVersionIDContainsFold applies the ContainsFold predicate on the "default_version_id" field.
+func DefaultVersionIDContainsFold(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldContainsFold(FieldDefaultVersionID, v))
+}
+
+// AttachmentCountEQ applies the EQ predicate on the "attachment_count" field.
+func AttachmentCountEQ(v int) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldAttachmentCount, v))
+}
+
+// AttachmentCountNEQ applies the NEQ predicate on the "attachment_count" field.
+func AttachmentCountNEQ(v int) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNEQ(FieldAttachmentCount, v))
+}
+
+// AttachmentCountIn applies the In predicate on the "attachment_count" field.
+func AttachmentCountIn(vs ...int) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIn(FieldAttachmentCount, vs...))
+}
+
+// AttachmentCountNotIn applies the NotIn predicate on the "attachment_count" field.
+func AttachmentCountNotIn(vs ...int) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotIn(FieldAttachmentCount, vs...))
+}
+
+// AttachmentCountGT applies the GT predicate on the "attachment_count" field.
+func AttachmentCountGT(v int) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGT(FieldAttachmentCount, v))
+}
+
+// AttachmentCountGTE applies the GTE predicate on the "attachment_count" field.
+func AttachmentCountGTE(v int) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGTE(FieldAttachmentCount, v))
+}
+
+// AttachmentCountLT applies the LT predicate on the "attachment_count" field.
+func AttachmentCountLT(v int) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLT(FieldAttachmentCount, v))
+}
+
+// AttachmentCountLTE applies the LTE predicate on the "attachment_count" field.
+func AttachmentCountLTE(v int) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLTE(FieldAttachmentCount, v))
+}
+
+// PermissionsBoundaryUsageCountEQ applies the EQ predicate on the "permissions_boundary_usage_count" field.
+func PermissionsBoundaryUsageCountEQ(v int) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldPermissionsBoundaryUsageCount, v))
+}
+
+// PermissionsBoundaryUsageCountNEQ applies the NEQ predicate on the "permissions_boundary_usage_count" field.
+func PermissionsBoundaryUsageCountNEQ(v int) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNEQ(FieldPermissionsBoundaryUsageCount, v))
+}
+
+// PermissionsBoundaryUsageCountIn applies the In predicate on the "permissions_boundary_usage_count" field.
+func PermissionsBoundaryUsageCountIn(vs ...int) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIn(FieldPermissionsBoundaryUsageCount, vs...))
+}
+
+// PermissionsBoundaryUsageCountNotIn applies the NotIn predicate on the "permissions_boundary_usage_count" field.
+func PermissionsBoundaryUsageCountNotIn(vs ...int) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotIn(FieldPermissionsBoundaryUsageCount, vs...))
+}
+
+// PermissionsBoundaryUsageCountGT applies the GT predicate on the "permissions_boundary_usage_count" field.
+func PermissionsBoundaryUsageCountGT(v int) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGT(FieldPermissionsBoundaryUsageCount, v))
+}
+
+// PermissionsBoundaryUsageCountGTE applies the GTE predicate on the "permissions_boundary_usage_count" field.
+func PermissionsBoundaryUsageCountGTE(v int) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGTE(FieldPermissionsBoundaryUsageCount, v))
+}
+
+// PermissionsBoundaryUsageCountLT applies the LT predicate on the "permissions_boundary_usage_count" field.
+func PermissionsBoundaryUsageCountLT(v int) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLT(FieldPermissionsBoundaryUsageCount, v))
+}
+
+// PermissionsBoundaryUsageCountLTE applies the LTE predicate on the "permissions_boundary_usage_count" field.
+func PermissionsBoundaryUsageCountLTE(v int) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLTE(FieldPermissionsBoundaryUsageCount, v))
+}
+
+// IsAttachableEQ applies the EQ predicate on the "is_attachable" field.
+func IsAttachableEQ(v bool) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldIsAttachable, v))
+}
+
+// IsAttachableNEQ applies the NEQ predicate on the "is_attachable" field.
+func IsAttachableNEQ(v bool) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNEQ(FieldIsAttachable, v))
+}
+
+// AWSManagedEQ applies the EQ predicate on the "aws_managed" field.
+func AWSManagedEQ(v bool) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldAWSManaged, v))
+}
+
+// AWSManagedNEQ applies the NEQ predicate on the "aws_managed" field.
+func AWSManagedNEQ(v bool) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNEQ(FieldAWSManaged, v))
+}
+
+// CreateDateEQ applies the EQ predicate on the "create_date" field.
+func CreateDateEQ(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldCreateDate, v))
+}
+
+// CreateDateNEQ applies the NEQ predicate on the "create_date" field.
+func CreateDateNEQ(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNEQ(FieldCreateDate, v))
+}
+
+// CreateDateIn applies the In predicate on the "create_date" field.
+func CreateDateIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIn(FieldCreateDate, vs...))
+}
+
+// CreateDateNotIn applies the NotIn predicate on the "create_date" field.
+func CreateDateNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotIn(FieldCreateDate, vs...))
+}
+
+// CreateDateGT applies the GT predicate on the "create_date" field.
+func CreateDateGT(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGT(FieldCreateDate, v))
+}
+
+// CreateDateGTE applies the GTE predicate on the "create_date" field.
+func CreateDateGTE(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGTE(FieldCreateDate, v))
+}
+
+// CreateDateLT applies the LT predicate on the "create_date" field.
+func CreateDateLT(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLT(FieldCreateDate, v))
+}
+
+// CreateDateLTE applies the LTE predicate on the "create_date" field.
+func CreateDateLTE(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLTE(FieldCreateDate, v))
+}
+
+// CreateDateIsNil applies the IsNil predicate on the "create_date" field.
+func CreateDateIsNil() predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIsNull(FieldCreateDate))
+}
+
+// CreateDateNotNil applies the NotNil predicate on the "create_date" field.
+func CreateDateNotNil() predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotNull(FieldCreateDate))
+}
+
+// UpdateDateEQ applies the EQ predicate on the "update_date" field.
+func UpdateDateEQ(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldUpdateDate, v))
+}
+
+// UpdateDateNEQ applies the NEQ predicate on the "update_date" field.
+func UpdateDateNEQ(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNEQ(FieldUpdateDate, v))
+}
+
+// UpdateDateIn applies the In predicate on the "update_date" field.
+func UpdateDateIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIn(FieldUpdateDate, vs...))
+}
+
+// UpdateDateNotIn applies the NotIn predicate on the "update_date" field.
+func UpdateDateNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotIn(FieldUpdateDate, vs...))
+}
+
+// UpdateDateGT applies the GT predicate on the "update_date" field.
+func UpdateDateGT(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGT(FieldUpdateDate, v))
+}
+
+// UpdateDateGTE applies the GTE predicate on the "update_date" field.
+func UpdateDateGTE(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGTE(FieldUpdateDate, v))
+}
+
+// UpdateDateLT applies the LT predicate on the "update_date" field.
+func UpdateDateLT(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLT(FieldUpdateDate, v))
+}
+
+// UpdateDateLTE applies the LTE predicate on the "update_date" field.
+func UpdateDateLTE(v time.Time) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLTE(FieldUpdateDate, v))
+}
+
+// UpdateDateIsNil applies the IsNil predicate on the "update_date" field.
+func UpdateDateIsNil() predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIsNull(FieldUpdateDate))
+}
+
+// UpdateDateNotNil applies the NotNil predicate on the "update_date" field.
+func UpdateDateNotNil() predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotNull(FieldUpdateDate))
+}
+
+// VersionsJSONIsNil applies the IsNil predicate on the "versions_json" field.
+func VersionsJSONIsNil() predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIsNull(FieldVersionsJSON))
+}
+
+// VersionsJSONNotNil applies the NotNil predicate on the "versions_json" field.
+func VersionsJSONNotNil() predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotNull(FieldVersionsJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeHistoryAWSIAMPolicy) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeHistoryAWSIAMPolicy) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeHistoryAWSIAMPolicy) predicate.BronzeHistoryAWSIAMPolicy {
+	return predicate.BronzeHistoryAWSIAMPolicy(sql.NotPredicates(p))
+}
@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiampolicy"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSIAMPolicyCreate is the builder for creating a BronzeHistoryAWSIAMPolicy entity.
+type BronzeHistoryAWSIAMPolicyCreate struct {
+	config
+	mutation *BronzeHistoryAWSIAMPolicyMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetValidFrom(v time.Time) *BronzeHistoryAWSIAMPolicyCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetValidTo(v time.Time) *BronzeHistoryAWSIAMPolicyCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSIAMPolicyCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetCollectedAt(v time.Time) *BronzeHistoryAWSIAMPolicyCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSIAMPolicyCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetResourceID(v string) *BronzeHistoryAWSIAMPolicyCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetArn sets the "arn" field.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetArn(v string) *BronzeHistoryAWSIAMPolicyCreate {
+	_c.mutation.SetArn(v)
+	return _c
+}
+
+// SetPolicyName sets the "policy_name" field.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetPolicyName(v string) *BronzeHistoryAWSIAMPolicyCreate {
+	_c.mutation.SetPolicyName(v)
+	return _c
+}
+
+// SetPath sets the "path" field.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetPath(v string) *BronzeHistoryAWSIAMPolicyCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetNillablePath(v *string) *BronzeHistoryAWSIAMPolicyCreate {
+	if v != nil {
+		_c.SetPath(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetDescription(v string) *BronzeHistoryAWSIAMPolicyCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetNillableDescription(v *string) *BronzeHistoryAWSIAMPolicyCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetDefaultVersionID sets the "default_version_id" field.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetDefaultVersionID(v string) *BronzeHistoryAWSIAMPolicyCreate {
+	_c.mutation.SetDefaultVersionID(v)
+	return _c
+}
+
+// SetNillableDefaultVersionID sets the "default_version_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetNillableDefaultVersionID(v *string) *BronzeHistoryAWSIAMPolicyCreate {
+	if v != nil {
+		_c.SetDefaultVersionID(*v)
+	}
+	return _c
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetAttachmentCount(v int) *BronzeHistoryAWSIAMPolicyCreate {
+	_c.mutation.SetAttachmentCount(v)
+	return _c
+}
+
+// SetNillableAttachmentCount sets the "attachment_count" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetNillableAttachmentCount(v *int) *BronzeHistoryAWSIAMPolicyCreate {
+	if v != nil {
+		_c.SetAttachmentCount(*v)
+	}
+	return _c
+}
+
+// SetPermissionsBoundaryUsageCount sets the "permissions_boundary_usage_count" field.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetPermissionsBoundaryUsageCount(v int) *BronzeHistoryAWSIAMPolicyCreate {
+	_c.mutation.SetPermissionsBoundaryUsageCount(v)
+	return _c
+}
+
+// SetNillablePermissionsBoundaryUsageCount sets the "permissions_boundary_usage_count" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetNillablePermissionsBoundaryUsageCount(v *int) *BronzeHistoryAWSIAMPolicyCreate {
+	if v != nil {
+		_c.SetPermissionsBoundaryUsageCount(*v)
+	}
+	return _c
+}
+
+// SetIsAttachable sets the "is_attachable" field.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetIsAttachable(v bool) *BronzeHistoryAWSIAMPolicyCreate {
+	_c.mutation.SetIsAttachable(v)
+	return _c
+}
+
+// SetNillableIsAttachable sets the "is_attachable" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetNillableIsAttachable(v *bool) *BronzeHistoryAWSIAMPolicyCreate {
+	if v != nil {
+		_c.SetIsAttachable(*v)
+	}
+	return _c
+}
+
+// SetAWSManaged sets the "aws_managed" field.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetAWSManaged(v bool) *BronzeHistoryAWSIAMPolicyCreate {
+	_c.mutation.SetAWSManaged(v)
+	return _c
+}
+
+// SetNillableAWSManaged sets the "aws_managed" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetNillableAWSManaged(v *bool) *BronzeHistoryAWSIAMPolicyCreate {
+	if v != nil {
+		_c.SetAWSManaged(*v)
+	}
+	return _c
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetCreateDate(v time.Time) *BronzeHistoryAWSIAMPolicyCreate {
+	_c.mutation.SetCreateDate(v)
+	return _c
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetNillableCreateDate(v *time.Time) *BronzeHistoryAWSIAMPolicyCreate {
+	if v != nil {
+		_c.SetCreateDate(*v)
+	}
+	return _c
+}
+
+// SetUpdateDate sets the "update_date" field.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetUpdateDate(v time.Time) *BronzeHistoryAWSIAMPolicyCreate {
+	_c.mutation.SetUpdateDate(v)
+	return _c
+}
+
+// SetNillableUpdateDate sets the "update_date" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetNillableUpdateDate(v *time.Time) *BronzeHistoryAWSIAMPolicyCreate {
+	if v != nil {
+		_c.SetUpdateDate(*v)
+	}
+	return _c
+}
+
+// SetVersionsJSON sets the "versions_json" field.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetVersionsJSON(v json.RawMessage) *BronzeHistoryAWSIAMPolicyCreate {
+	_c.mutation.SetVersionsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetAccountID(v string) *BronzeHistoryAWSIAMPolicyCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SetID(v uint) *BronzeHistoryAWSIAMPolicyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryAWSIAMPolicyMutation object of the builder.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) Mutation() *BronzeHistoryAWSIAMPolicyMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryAWSIAMPolicy in the database.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) Save(ctx context.Context) (*BronzeHistoryAWSIAMPolicy, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) SaveX(ctx context.Context) *BronzeHistoryAWSIAMPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) defaults() {
+	if _, ok := _c.mutation.AttachmentCount(); !ok {
+		v := bronzehistoryawsiampolicy.DefaultAttachmentCount
+		_c.mutation.SetAttachmentCount(v)
+	}
+	if _, ok := _c.mutation.PermissionsBoundaryUsageCount(); !ok {
+		v := bronzehistoryawsiampolicy.DefaultPermissionsBoundaryUsageCount
+		_c.mutation.SetPermissionsBoundaryUsageCount(v)
+	}
+	if _, ok := _c.mutation.IsAttachable(); !ok {
+		v := bronzehistoryawsiampolicy.DefaultIsAttachable
+		_c.mutation.SetIsAttachable(v)
+	}
+	if _, ok := _c.mutation.AWSManaged(); !ok {
+		v := bronzehistoryawsiampolicy.DefaultAWSManaged
+		_c.mutation.SetAWSManaged(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryAWSIAMPolicyCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMPolicy.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMPolicy.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMPolicy.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMPolicy.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsiampolicy.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMPolicy.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Arn(); !ok {
+		return &ValidationError{Name: "arn", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMPolicy.arn"`)}
+	}
+	if v, ok := _c.mutation.Arn(); ok {
+		if err := bronzehistoryawsiampolicy.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMPolicy.arn": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PolicyName(); !ok {
+		return &ValidationError{Name: "policy_name", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMPolicy.policy_name"`)}
+	}
+	if v, ok := _c.mutation.PolicyName(); ok {
+		if err := bronzehistoryawsiampolicy.PolicyNameValidator(v); err != nil {
+			return &ValidationError{Name: "policy_name", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMPolicy.policy_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AttachmentCount(); !ok {
+		return &ValidationError{Name: "attachment_count", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMPolicy.attachment_count"`)}
+	}
+	if _, ok := _c.mutation.PermissionsBoundaryUsageCount(); !ok {
+		return &ValidationError{Name: "permissions_boundary_usage_count", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMPolicy.permissions_boundary_usage_count"`)}
+	}
+	if _, ok := _c.mutation.IsAttachable(); !ok {
+		return &ValidationError{Name: "is_attachable", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMPolicy.is_attachable"`)}
+	}
+	if _, ok := _c.mutation.AWSManaged(); !ok {
+		return &ValidationError{Name: "aws_managed", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMPolicy.aws_managed"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMPolicy.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzehistoryawsiampolicy.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMPolicy.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryAWSIAMPolicyCreate) sqlSave(ctx context.Context) (*BronzeHistoryAWSIAMPolicy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryAWSIAMPolicyCreate) createSpec() (*BronzeHistoryAWSIAMPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryAWSIAMPolicy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryawsiampolicy.Table, sqlgraph.NewFieldSpec(bronzehistoryawsiampolicy.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryAWSIAMPolicy
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.Arn(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldArn, field.TypeString, value)
+		_node.Arn = value
+	}
+	if value, ok := _c.mutation.PolicyName(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldPolicyName, field.TypeString, value)
+		_node.PolicyName = value
+	}
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.DefaultVersionID(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldDefaultVersionID, field.TypeString, value)
+		_node.DefaultVersionID = value
+	}
+	if value, ok := _c.mutation.AttachmentCount(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldAttachmentCount, field.TypeInt, value)
+		_node.AttachmentCount = value
+	}
+	if value, ok := _c.mutation.PermissionsBoundaryUsageCount(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldPermissionsBoundaryUsageCount, field.TypeInt, value)
+		_node.PermissionsBoundaryUsageCount = value
+	}
+	if value, ok := _c.mutation.IsAttachable(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldIsAttachable, field.TypeBool, value)
+		_node.IsAttachable = value
+	}
+	if value, ok := _c.mutation.AWSManaged(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldAWSManaged, field.TypeBool, value)
+		_node.AWSManaged = value
+	}
+	if value, ok := _c.mutation.CreateDate(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldCreateDate, field.TypeTime, value)
+		_node.CreateDate = &value
+	}
+	if value, ok := _c.mutation.UpdateDate(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldUpdateDate, field.TypeTime, value)
+		_node.UpdateDate = &value
+	}
+	if value, ok := _c.mutation.VersionsJSON(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldVersionsJSON, field.TypeJSON, value)
+		_node.VersionsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryAWSIAMPolicyCreateBulk is the builder for creating many BronzeHistoryAWSIAMPolicy entities in bulk.
+type BronzeHistoryAWSIAMPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryAWSIAMPolicyCreate
+}
+
+// Save creates the BronzeHistoryAWSIAMPolicy entities in the database.
+func (_c *BronzeHistoryAWSIAMPolicyCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryAWSIAMPolicy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryAWSIAMPolicy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryAWSIAMPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryAWSIAMPolicyCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryAWSIAMPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSIAMPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSIAMPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiampolicy"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSIAMPolicyDelete is the builder for deleting a BronzeHistoryAWSIAMPolicy entity.
+type BronzeHistoryAWSIAMPolicyDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSIAMPolicyMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMPolicyDelete builder.
+func (_d *BronzeHistoryAWSIAMPolicyDelete) Where(ps ...predicate.BronzeHistoryAWSIAMPolicy) *BronzeHistoryAWSIAMPolicyDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeHistoryAWSIAMPolicyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryAWSIAMPolicyDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeHistoryAWSIAMPolicyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzehistoryawsiampolicy.Table, sqlgraph.NewFieldSpec(bronzehistoryawsiampolicy.FieldID, field.TypeUint))
+	_spec.Node.Schema = _d.schemaConfig.BronzeHistoryAWSIAMPolicy
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeHistoryAWSIAMPolicyDeleteOne is the builder for deleting a single BronzeHistoryAWSIAMPolicy entity.
+type BronzeHistoryAWSIAMPolicyDeleteOne struct {
+	_d *BronzeHistoryAWSIAMPolicyDelete
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMPolicyDelete builder.
+func (_d *BronzeHistoryAWSIAMPolicyDeleteOne) Where(ps ...predicate.BronzeHistoryAWSIAMPolicy) *BronzeHistoryAWSIAMPolicyDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeHistoryAWSIAMPolicyDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzehistoryawsiampolicy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryAWSIAMPolicyDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
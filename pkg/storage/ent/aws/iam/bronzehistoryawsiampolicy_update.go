@@ -0,0 +1,989 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiampolicy"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSIAMPolicyUpdate is the builder for updating BronzeHistoryAWSIAMPolicy entities.
+type BronzeHistoryAWSIAMPolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSIAMPolicyMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMPolicyUpdate builder.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) Where(ps ...predicate.BronzeHistoryAWSIAMPolicy) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetValidTo(v time.Time) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) ClearValidTo() *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetCollectedAt(v time.Time) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetResourceID(v string) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetNillableResourceID(v *string) *BronzeHistoryAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetArn(v string) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetNillableArn(v *string) *BronzeHistoryAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// SetPolicyName sets the "policy_name" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetPolicyName(v string) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.SetPolicyName(v)
+	return _u
+}
+
+// SetNillablePolicyName sets the "policy_name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetNillablePolicyName(v *string) *BronzeHistoryAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetPolicyName(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetPath(v string) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetNillablePath(v *string) *BronzeHistoryAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// ClearPath clears the value of the "path" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) ClearPath() *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.ClearPath()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetDescription(v string) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetNillableDescription(v *string) *BronzeHistoryAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) ClearDescription() *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetDefaultVersionID sets the "default_version_id" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetDefaultVersionID(v string) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.SetDefaultVersionID(v)
+	return _u
+}
+
+// SetNillableDefaultVersionID sets the "default_version_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetNillableDefaultVersionID(v *string) *BronzeHistoryAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetDefaultVersionID(*v)
+	}
+	return _u
+}
+
+// ClearDefaultVersionID clears the value of the "default_version_id" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) ClearDefaultVersionID() *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.ClearDefaultVersionID()
+	return _u
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetAttachmentCount(v int) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.ResetAttachmentCount()
+	_u.mutation.SetAttachmentCount(v)
+	return _u
+}
+
+// SetNillableAttachmentCount sets the "attachment_count" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetNillableAttachmentCount(v *int) *BronzeHistoryAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetAttachmentCount(*v)
+	}
+	return _u
+}
+
+// AddAttachmentCount adds value to the "attachment_count" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) AddAttachmentCount(v int) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.AddAttachmentCount(v)
+	return _u
+}
+
+// SetPermissionsBoundaryUsageCount sets the "permissions_boundary_usage_count" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetPermissionsBoundaryUsageCount(v int) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.ResetPermissionsBoundaryUsageCount()
+	_u.mutation.SetPermissionsBoundaryUsageCount(v)
+	return _u
+}
+
+// SetNillablePermissionsBoundaryUsageCount sets the "permissions_boundary_usage_count" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetNillablePermissionsBoundaryUsageCount(v *int) *BronzeHistoryAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetPermissionsBoundaryUsageCount(*v)
+	}
+	return _u
+}
+
+// AddPermissionsBoundaryUsageCount adds value to the "permissions_boundary_usage_count" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) AddPermissionsBoundaryUsageCount(v int) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.AddPermissionsBoundaryUsageCount(v)
+	return _u
+}
+
+// SetIsAttachable sets the "is_attachable" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetIsAttachable(v bool) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.SetIsAttachable(v)
+	return _u
+}
+
+// SetNillableIsAttachable sets the "is_attachable" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetNillableIsAttachable(v *bool) *BronzeHistoryAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetIsAttachable(*v)
+	}
+	return _u
+}
+
+// SetAWSManaged sets the "aws_managed" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetAWSManaged(v bool) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.SetAWSManaged(v)
+	return _u
+}
+
+// SetNillableAWSManaged sets the "aws_managed" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetNillableAWSManaged(v *bool) *BronzeHistoryAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetAWSManaged(*v)
+	}
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetCreateDate(v time.Time) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetNillableCreateDate(v *time.Time) *BronzeHistoryAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) ClearCreateDate() *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetUpdateDate sets the "update_date" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetUpdateDate(v time.Time) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.SetUpdateDate(v)
+	return _u
+}
+
+// SetNillableUpdateDate sets the "update_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetNillableUpdateDate(v *time.Time) *BronzeHistoryAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetUpdateDate(*v)
+	}
+	return _u
+}
+
+// ClearUpdateDate clears the value of the "update_date" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) ClearUpdateDate() *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.ClearUpdateDate()
+	return _u
+}
+
+// SetVersionsJSON sets the "versions_json" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetVersionsJSON(v json.RawMessage) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.SetVersionsJSON(v)
+	return _u
+}
+
+// AppendVersionsJSON appends value to the "versions_json" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) AppendVersionsJSON(v json.RawMessage) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.AppendVersionsJSON(v)
+	return _u
+}
+
+// ClearVersionsJSON clears the value of the "versions_json" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) ClearVersionsJSON() *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.ClearVersionsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetAccountID(v string) *BronzeHistoryAWSIAMPolicyUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SetNillableAccountID(v *string) *BronzeHistoryAWSIAMPolicyUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSIAMPolicyMutation object of the builder.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) Mutation() *BronzeHistoryAWSIAMPolicyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsiampolicy.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMPolicy.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Arn(); ok {
+		if err := bronzehistoryawsiampolicy.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMPolicy.arn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PolicyName(); ok {
+		if err := bronzehistoryawsiampolicy.PolicyNameValidator(v); err != nil {
+			return &ValidationError{Name: "policy_name", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMPolicy.policy_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsiampolicy.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMPolicy.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSIAMPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsiampolicy.Table, bronzehistoryawsiampolicy.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsiampolicy.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsiampolicy.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldArn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PolicyName(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldPolicyName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldPath, field.TypeString, value)
+	}
+	if _u.mutation.PathCleared() {
+		_spec.ClearField(bronzehistoryawsiampolicy.FieldPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzehistoryawsiampolicy.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.DefaultVersionID(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldDefaultVersionID, field.TypeString, value)
+	}
+	if _u.mutation.DefaultVersionIDCleared() {
+		_spec.ClearField(bronzehistoryawsiampolicy.FieldDefaultVersionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentCount(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttachmentCount(); ok {
+		_spec.AddField(bronzehistoryawsiampolicy.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PermissionsBoundaryUsageCount(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldPermissionsBoundaryUsageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPermissionsBoundaryUsageCount(); ok {
+		_spec.AddField(bronzehistoryawsiampolicy.FieldPermissionsBoundaryUsageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsAttachable(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldIsAttachable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AWSManaged(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldAWSManaged, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzehistoryawsiampolicy.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateDate(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldUpdateDate, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateDateCleared() {
+		_spec.ClearField(bronzehistoryawsiampolicy.FieldUpdateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VersionsJSON(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldVersionsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVersionsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiampolicy.FieldVersionsJSON, value)
+		})
+	}
+	if _u.mutation.VersionsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiampolicy.FieldVersionsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSIAMPolicy
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsiampolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryAWSIAMPolicyUpdateOne is the builder for updating a single BronzeHistoryAWSIAMPolicy entity.
+type BronzeHistoryAWSIAMPolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryAWSIAMPolicyMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetValidTo(v time.Time) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) ClearValidTo() *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetResourceID(v string) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetArn(v string) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetNillableArn(v *string) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// SetPolicyName sets the "policy_name" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetPolicyName(v string) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.SetPolicyName(v)
+	return _u
+}
+
+// SetNillablePolicyName sets the "policy_name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetNillablePolicyName(v *string) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetPolicyName(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetPath(v string) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetNillablePath(v *string) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// ClearPath clears the value of the "path" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) ClearPath() *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.ClearPath()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetDescription(v string) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetNillableDescription(v *string) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) ClearDescription() *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetDefaultVersionID sets the "default_version_id" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetDefaultVersionID(v string) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.SetDefaultVersionID(v)
+	return _u
+}
+
+// SetNillableDefaultVersionID sets the "default_version_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetNillableDefaultVersionID(v *string) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetDefaultVersionID(*v)
+	}
+	return _u
+}
+
+// ClearDefaultVersionID clears the value of the "default_version_id" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) ClearDefaultVersionID() *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.ClearDefaultVersionID()
+	return _u
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetAttachmentCount(v int) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.ResetAttachmentCount()
+	_u.mutation.SetAttachmentCount(v)
+	return _u
+}
+
+// SetNillableAttachmentCount sets the "attachment_count" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetNillableAttachmentCount(v *int) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetAttachmentCount(*v)
+	}
+	return _u
+}
+
+// AddAttachmentCount adds value to the "attachment_count" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) AddAttachmentCount(v int) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.AddAttachmentCount(v)
+	return _u
+}
+
+// SetPermissionsBoundaryUsageCount sets the "permissions_boundary_usage_count" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetPermissionsBoundaryUsageCount(v int) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.ResetPermissionsBoundaryUsageCount()
+	_u.mutation.SetPermissionsBoundaryUsageCount(v)
+	return _u
+}
+
+// SetNillablePermissionsBoundaryUsageCount sets the "permissions_boundary_usage_count" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetNillablePermissionsBoundaryUsageCount(v *int) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetPermissionsBoundaryUsageCount(*v)
+	}
+	return _u
+}
+
+// AddPermissionsBoundaryUsageCount adds value to the "permissions_boundary_usage_count" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) AddPermissionsBoundaryUsageCount(v int) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.AddPermissionsBoundaryUsageCount(v)
+	return _u
+}
+
+// SetIsAttachable sets the "is_attachable" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetIsAttachable(v bool) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.SetIsAttachable(v)
+	return _u
+}
+
+// SetNillableIsAttachable sets the "is_attachable" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetNillableIsAttachable(v *bool) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetIsAttachable(*v)
+	}
+	return _u
+}
+
+// SetAWSManaged sets the "aws_managed" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetAWSManaged(v bool) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.SetAWSManaged(v)
+	return _u
+}
+
+// SetNillableAWSManaged sets the "aws_managed" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetNillableAWSManaged(v *bool) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetAWSManaged(*v)
+	}
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetCreateDate(v time.Time) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetNillableCreateDate(v *time.Time) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) ClearCreateDate() *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetUpdateDate sets the "update_date" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetUpdateDate(v time.Time) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.SetUpdateDate(v)
+	return _u
+}
+
+// SetNillableUpdateDate sets the "update_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetNillableUpdateDate(v *time.Time) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetUpdateDate(*v)
+	}
+	return _u
+}
+
+// ClearUpdateDate clears the value of the "update_date" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) ClearUpdateDate() *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.ClearUpdateDate()
+	return _u
+}
+
+// SetVersionsJSON sets the "versions_json" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetVersionsJSON(v json.RawMessage) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.SetVersionsJSON(v)
+	return _u
+}
+
+// AppendVersionsJSON appends value to the "versions_json" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) AppendVersionsJSON(v json.RawMessage) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.AppendVersionsJSON(v)
+	return _u
+}
+
+// ClearVersionsJSON clears the value of the "versions_json" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) ClearVersionsJSON() *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.ClearVersionsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetAccountID(v string) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSIAMPolicyMutation object of the builder.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) Mutation() *BronzeHistoryAWSIAMPolicyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMPolicyUpdate builder.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) Where(ps ...predicate.BronzeHistoryAWSIAMPolicy) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) Select(field string, fields ...string) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryAWSIAMPolicy entity.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) Save(ctx context.Context) (*BronzeHistoryAWSIAMPolicy, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) SaveX(ctx context.Context) *BronzeHistoryAWSIAMPolicy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsiampolicy.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMPolicy.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Arn(); ok {
+		if err := bronzehistoryawsiampolicy.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMPolicy.arn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PolicyName(); ok {
+		if err := bronzehistoryawsiampolicy.PolicyNameValidator(v); err != nil {
+			return &ValidationError{Name: "policy_name", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMPolicy.policy_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsiampolicy.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMPolicy.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSIAMPolicyUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryAWSIAMPolicy, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsiampolicy.Table, bronzehistoryawsiampolicy.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsiampolicy.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`iam: missing "BronzeHistoryAWSIAMPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawsiampolicy.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryawsiampolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("iam: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryawsiampolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsiampolicy.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldArn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PolicyName(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldPolicyName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldPath, field.TypeString, value)
+	}
+	if _u.mutation.PathCleared() {
+		_spec.ClearField(bronzehistoryawsiampolicy.FieldPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzehistoryawsiampolicy.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.DefaultVersionID(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldDefaultVersionID, field.TypeString, value)
+	}
+	if _u.mutation.DefaultVersionIDCleared() {
+		_spec.ClearField(bronzehistoryawsiampolicy.FieldDefaultVersionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentCount(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttachmentCount(); ok {
+		_spec.AddField(bronzehistoryawsiampolicy.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PermissionsBoundaryUsageCount(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldPermissionsBoundaryUsageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPermissionsBoundaryUsageCount(); ok {
+		_spec.AddField(bronzehistoryawsiampolicy.FieldPermissionsBoundaryUsageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsAttachable(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldIsAttachable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AWSManaged(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldAWSManaged, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzehistoryawsiampolicy.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateDate(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldUpdateDate, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateDateCleared() {
+		_spec.ClearField(bronzehistoryawsiampolicy.FieldUpdateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VersionsJSON(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldVersionsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVersionsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiampolicy.FieldVersionsJSON, value)
+		})
+	}
+	if _u.mutation.VersionsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiampolicy.FieldVersionsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsiampolicy.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSIAMPolicy
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryAWSIAMPolicy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsiampolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,169 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryawsiamrole
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzehistoryawsiamrole type in the database.
+	Label = "bronze_history_awsiam_role"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "history_id"
+	// FieldValidFrom holds the string denoting the valid_from field in the database.
+	FieldValidFrom = "valid_from"
+	// FieldValidTo holds the string denoting the valid_to field in the database.
+	FieldValidTo = "valid_to"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldResourceID holds the string denoting the resource_id field in the database.
+	FieldResourceID = "resource_id"
+	// FieldArn holds the string denoting the arn field in the database.
+	FieldArn = "arn"
+	// FieldRoleName holds the string denoting the role_name field in the database.
+	FieldRoleName = "role_name"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
+	// FieldCreateDate holds the string denoting the create_date field in the database.
+	FieldCreateDate = "create_date"
+	// FieldRoleLastUsedDate holds the string denoting the role_last_used_date field in the database.
+	FieldRoleLastUsedDate = "role_last_used_date"
+	// FieldRoleLastUsedRegion holds the string denoting the role_last_used_region field in the database.
+	FieldRoleLastUsedRegion = "role_last_used_region"
+	// FieldPermissionsBoundaryArn holds the string denoting the permissions_boundary_arn field in the database.
+	FieldPermissionsBoundaryArn = "permissions_boundary_arn"
+	// FieldAssumeRolePolicyJSON holds the string denoting the assume_role_policy_json field in the database.
+	FieldAssumeRolePolicyJSON = "assume_role_policy_json"
+	// FieldAttachedPoliciesJSON holds the string denoting the attached_policies_json field in the database.
+	FieldAttachedPoliciesJSON = "attached_policies_json"
+	// FieldInlinePoliciesJSON holds the string denoting the inline_policies_json field in the database.
+	FieldInlinePoliciesJSON = "inline_policies_json"
+	// FieldInstanceProfilesJSON holds the string denoting the instance_profiles_json field in the database.
+	FieldInstanceProfilesJSON = "instance_profiles_json"
+	// FieldTagsJSON holds the string denoting the tags_json field in the database.
+	FieldTagsJSON = "tags_json"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// Table holds the table name of the bronzehistoryawsiamrole in the database.
+	Table = "aws_iam_roles_history"
+)
+
+// Columns holds all SQL columns for bronzehistoryawsiamrole fields.
+var Columns = []string{
+	FieldID,
+	FieldValidFrom,
+	FieldValidTo,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldResourceID,
+	FieldArn,
+	FieldRoleName,
+	FieldPath,
+	FieldCreateDate,
+	FieldRoleLastUsedDate,
+	FieldRoleLastUsedRegion,
+	FieldPermissionsBoundaryArn,
+	FieldAssumeRolePolicyJSON,
+	FieldAttachedPoliciesJSON,
+	FieldInlinePoliciesJSON,
+	FieldInstanceProfilesJSON,
+	FieldTagsJSON,
+	FieldAccountID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	ResourceIDValidator func(string) error
+	// ArnValidator is a validator for the "arn" field. It is called by the builders before save.
+	ArnValidator func(string) error
+	// RoleNameValidator is a validator for the "role_name" field. It is called by the builders before save.
+	RoleNameValidator func(string) error
+	// AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	AccountIDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BronzeHistoryAWSIAMRole queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByValidFrom orders the results by the valid_from field.
+func ByValidFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidFrom, opts...).ToFunc()
+}
+
+// ByValidTo orders the results by the valid_to field.
+func ByValidTo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidTo, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByResourceID orders the results by the resource_id field.
+func ByResourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceID, opts...).ToFunc()
+}
+
+// ByArn orders the results by the arn field.
+func ByArn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArn, opts...).ToFunc()
+}
+
+// ByRoleName orders the results by the role_name field.
+func ByRoleName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoleName, opts...).ToFunc()
+}
+
+// ByPath orders the results by the path field.
+func ByPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPath, opts...).ToFunc()
+}
+
+// ByCreateDate orders the results by the create_date field.
+func ByCreateDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateDate, opts...).ToFunc()
+}
+
+// ByRoleLastUsedDate orders the results by the role_last_used_date field.
+func ByRoleLastUsedDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoleLastUsedDate, opts...).ToFunc()
+}
+
+// ByRoleLastUsedRegion orders the results by the role_last_used_region field.
+func ByRoleLastUsedRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoleLastUsedRegion, opts...).ToFunc()
+}
+
+// ByPermissionsBoundaryArn orders the results by the permissions_boundary_arn field.
+func ByPermissionsBoundaryArn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPermissionsBoundaryArn, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
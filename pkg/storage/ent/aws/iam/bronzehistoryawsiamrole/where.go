@@ -0,0 +1,940 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryawsiamrole
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLTE(FieldID, id))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldValidTo, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldResourceID, v))
+}
+
+// Arn applies equality check predicate on the "arn" field. It's identical to ArnEQ.
+func Arn(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldArn, v))
+}
+
+// RoleName applies equality check predicate on the "role_name" field. It's identical to RoleNameEQ.
+func RoleName(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldRoleName, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldPath, v))
+}
+
+// CreateDate applies equality check predicate on the "create_date" field. It's identical to CreateDateEQ.
+func CreateDate(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldCreateDate, v))
+}
+
+// RoleLastUsedDate applies equality check predicate on the "role_last_used_date" field. It's identical to RoleLastUsedDateEQ.
+func RoleLastUsedDate(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldRoleLastUsedDate, v))
+}
+
+// RoleLastUsedRegion applies equality check predicate on the "role_last_used_region" field. It's identical to RoleLastUsedRegionEQ.
+func RoleLastUsedRegion(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldRoleLastUsedRegion, v))
+}
+
+// PermissionsBoundaryArn applies equality check predicate on the "permissions_boundary_arn" field. It's identical to PermissionsBoundaryArnEQ.
+func PermissionsBoundaryArn(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldPermissionsBoundaryArn, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldAccountID, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotNull(FieldValidTo))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// ArnEQ applies the EQ predicate on the "arn" field.
+func ArnEQ(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldArn, v))
+}
+
+// ArnNEQ applies the NEQ predicate on the "arn" field.
+func ArnNEQ(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNEQ(FieldArn, v))
+}
+
+// ArnIn applies the In predicate on the "arn" field.
+func ArnIn(vs ...string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIn(FieldArn, vs...))
+}
+
+// ArnNotIn applies the NotIn predicate on the "arn" field.
+func ArnNotIn(vs ...string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotIn(FieldArn, vs...))
+}
+
+// ArnGT applies the GT predicate on the "arn" field.
+func ArnGT(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGT(FieldArn, v))
+}
+
+// ArnGTE applies the GTE predicate on the "arn" field.
+func ArnGTE(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGTE(FieldArn, v))
+}
+
+// ArnLT applies the LT predicate on the "arn" field.
+func ArnLT(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLT(FieldArn, v))
+}
+
+// ArnLTE applies the LTE predicate on the "arn" field.
+func ArnLTE(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLTE(FieldArn, v))
+}
+
+// ArnContains applies the Contains predicate on the "arn" field.
+func ArnContains(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldContains(FieldArn, v))
+}
+
+// ArnHasPrefix applies the HasPrefix predicate on the "arn" field.
+func ArnHasPrefix(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldHasPrefix(FieldArn, v))
+}
+
+// ArnHasSuffix applies the HasSuffix predicate on the "arn" field.
+func ArnHasSuffix(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldHasSuffix(FieldArn, v))
+}
+
+// ArnEqualFold applies the EqualFold predicate on the "arn" field.
+func ArnEqualFold(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEqualFold(FieldArn, v))
+}
+
+// ArnContainsFold applies the ContainsFold predicate on the "arn" field.
+func ArnContainsFold(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldContainsFold(FieldArn, v))
+}
+
+// RoleNameEQ applies the EQ predicate on the "role_name" field.
+func RoleNameEQ(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldRoleName, v))
+}
+
+// RoleNameNEQ applies the NEQ predicate on the "role_name" field.
+func RoleNameNEQ(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNEQ(FieldRoleName, v))
+}
+
+// RoleNameIn applies the In predicate on the "role_name" field.
+func RoleNameIn(vs ...string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIn(FieldRoleName, vs...))
+}
+
+// RoleNameNotIn applies the NotIn predicate on the "role_name" field.
+func RoleNameNotIn(vs ...string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotIn(FieldRoleName, vs...))
+}
+
+// RoleNameGT applies the GT predicate on the "role_name" field.
+func RoleNameGT(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGT(FieldRoleName, v))
+}
+
+// RoleNameGTE applies the GTE predicate on the "role_name" field.
+func RoleNameGTE(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGTE(FieldRoleName, v))
+}
+
+// RoleNameLT applies the LT predicate on the "role_name" field.
+func RoleNameLT(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLT(FieldRoleName, v))
+}
+
+// RoleNameLTE applies the LTE predicate on the "role_name" field.
+func RoleNameLTE(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLTE(FieldRoleName, v))
+}
+
+// RoleNameContains applies the Contains predicate on the "role_name" field.
+func RoleNameContains(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldContains(FieldRoleName, v))
+}
+
+// RoleNameHasPrefix applies the HasPrefix predicate on the "role_name" field.
+func RoleNameHasPrefix(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldHasPrefix(FieldRoleName, v))
+}
+
+// RoleNameHasSuffix applies the HasSuffix predicate on the "role_name" field.
+func RoleNameHasSuffix(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldHasSuffix(FieldRoleName, v))
+}
+
+// RoleNameEqualFold applies the EqualFold predicate on the "role_name" field.
+func RoleNameEqualFold(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEqualFold(FieldRoleName, v))
+}
+
+// RoleNameContainsFold applies the ContainsFold predicate on the "role_name" field.
+func RoleNameContainsFold(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldContainsFold(FieldRoleName, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathIsNil applies the IsNil predicate on the "path" field.
+func PathIsNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIsNull(FieldPath))
+}
+
+// PathNotNil applies the NotNil predicate on the "path" field.
+func PathNotNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotNull(FieldPath))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldContainsFold(FieldPath, v))
+}
+
+// CreateDateEQ applies the EQ predicate on the "create_date" field.
+func CreateDateEQ(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldCreateDate, v))
+}
+
+// CreateDateNEQ applies the NEQ predicate on the "create_date" field.
+func CreateDateNEQ(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNEQ(FieldCreateDate, v))
+}
+
+// CreateDateIn applies the In predicate on the "create_date" field.
+func CreateDateIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIn(FieldCreateDate, vs...))
+}
+
+// CreateDateNotIn applies the NotIn predicate on the "create_date" field.
+func CreateDateNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotIn(FieldCreateDate, vs...))
+}
+
+// CreateDateGT applies the GT predicate on the "create_date" field.
+func CreateDateGT(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGT(FieldCreateDate, v))
+}
+
+// CreateDateGTE applies the GTE predicate on the "create_date" field.
+func CreateDateGTE(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGTE(FieldCreateDate, v))
+}
+
+// CreateDateLT applies the LT predicate on the "create_date" field.
+func CreateDateLT(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLT(FieldCreateDate, v))
+}
+
+// CreateDateLTE applies the LTE predicate on the "create_date" field.
+func CreateDateLTE(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLTE(FieldCreateDate, v))
+}
+
+// CreateDateIsNil applies the IsNil predicate on the "create_date" field.
+func CreateDateIsNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIsNull(FieldCreateDate))
+}
+
+// CreateDateNotNil applies the NotNil predicate on the "create_date" field.
+func CreateDateNotNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotNull(FieldCreateDate))
+}
+
+// RoleLastUsedDateEQ applies the EQ predicate on the "role_last_used_date" field.
+func RoleLastUsedDateEQ(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldRoleLastUsedDate, v))
+}
+
+// RoleLastUsedDateNEQ applies the NEQ predicate on the "role_last_used_date" field.
+func RoleLastUsedDateNEQ(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNEQ(FieldRoleLastUsedDate, v))
+}
+
+// RoleLastUsedDateIn applies the In predicate on the "role_last_used_date" field.
+func RoleLastUsedDateIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIn(FieldRoleLastUsedDate, vs...))
+}
+
+// RoleLastUsedDateNotIn applies the NotIn predicate on the "role_last_used_date" field.
+func RoleLastUsedDateNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotIn(FieldRoleLastUsedDate, vs...))
+}
+
+// RoleLastUsedDateGT applies the GT predicate on the "role_last_used_date" field.
+func RoleLastUsedDateGT(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGT(FieldRoleLastUsedDate, v))
+}
+
+// RoleLastUsedDateGTE applies the GTE predicate on the "role_last_used_date" field.
+func RoleLastUsedDateGTE(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGTE(FieldRoleLastUsedDate, v))
+}
+
+// RoleLastUsedDateLT applies the LT predicate on the "role_last_used_date" field.
+func RoleLastUsedDateLT(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLT(FieldRoleLastUsedDate, v))
+}
+
+// RoleLastUsedDateLTE applies the LTE predicate on the "role_last_used_date" field.
+func RoleLastUsedDateLTE(v time.Time) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLTE(FieldRoleLastUsedDate, v))
+}
+
+// RoleLastUsedDateIsNil applies the IsNil predicate on the "role_last_used_date" field.
+func RoleLastUsedDateIsNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIsNull(FieldRoleLastUsedDate))
+}
+
+// RoleLastUsedDateNotNil applies the NotNil predicate on the "role_last_used_date" field.
+func RoleLastUsedDateNotNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotNull(FieldRoleLastUsedDate))
+}
+
+// RoleLastUsedRegionEQ applies the EQ predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionEQ(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionNEQ applies the NEQ predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionNEQ(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNEQ(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionIn applies the In predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionIn(vs ...string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIn(FieldRoleLastUsedRegion, vs...))
+}
+
+// RoleLastUsedRegionNotIn applies the NotIn predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionNotIn(vs ...string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotIn(FieldRoleLastUsedRegion, vs...))
+}
+
+// RoleLastUsedRegionGT applies the GT predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionGT(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGT(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionGTE applies the GTE predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionGTE(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGTE(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionLT applies the LT predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionLT(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLT(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionLTE applies the LTE predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionLTE(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLTE(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionContains applies the Contains predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionContains(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldContains(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionHasPrefix applies the HasPrefix predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionHasPrefix(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldHasPrefix(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionHasSuffix applies the HasSuffix predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionHasSuffix(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldHasSuffix(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionIsNil applies the IsNil predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionIsNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIsNull(FieldRoleLastUsedRegion))
+}
+
+// RoleLastUsedRegionNotNil applies the NotNil predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionNotNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotNull(FieldRoleLastUsedRegion))
+}
+
+// RoleLastUsedRegionEqualFold applies the EqualFold predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionEqualFold(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEqualFold(FieldRoleLastUsedRegion, v))
+}
+
+// RoleLastUsedRegionContainsFold applies the ContainsFold predicate on the "role_last_used_region" field.
+func RoleLastUsedRegionContainsFold(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldContainsFold(FieldRoleLastUsedRegion, v))
+}
+
+// PermissionsBoundaryArnEQ applies the EQ predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnEQ(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnNEQ applies the NEQ predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnNEQ(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNEQ(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnIn applies the In predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnIn(vs ...string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIn(FieldPermissionsBoundaryArn, vs...))
+}
+
+// PermissionsBoundaryArnNotIn applies the NotIn predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnNotIn(vs ...string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotIn(FieldPermissionsBoundaryArn, vs...))
+}
+
+// PermissionsBoundaryArnGT applies the GT predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnGT(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGT(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnGTE applies the GTE predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnGTE(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGTE(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnLT applies the LT predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnLT(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLT(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnLTE applies the LTE predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnLTE(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLTE(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnContains applies the Contains predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnContains(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldContains(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnHasPrefix applies the HasPrefix predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnHasPrefix(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldHasPrefix(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnHasSuffix applies the HasSuffix predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnHasSuffix(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldHasSuffix(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnIsNil applies the IsNil predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnIsNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIsNull(FieldPermissionsBoundaryArn))
+}
+
+// PermissionsBoundaryArnNotNil applies the NotNil predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnNotNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotNull(FieldPermissionsBoundaryArn))
+}
+
+// PermissionsBoundaryArnEqualFold applies the EqualFold predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnEqualFold(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEqualFold(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnContainsFold applies the ContainsFold predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnContainsFold(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldContainsFold(FieldPermissionsBoundaryArn, v))
+}
+
+// AssumeRolePolicyJSONIsNil applies the IsNil predicate on the "assume_role_policy_json" field.
+func AssumeRolePolicyJSONIsNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIsNull(FieldAssumeRolePolicyJSON))
+}
+
+// AssumeRolePolicyJSONNotNil applies the NotNil predicate on the "assume_role_policy_json" field.
+func AssumeRolePolicyJSONNotNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotNull(FieldAssumeRolePolicyJSON))
+}
+
+// AttachedPoliciesJSONIsNil applies the IsNil predicate on the "attached_policies_json" field.
+func AttachedPoliciesJSONIsNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIsNull(FieldAttachedPoliciesJSON))
+}
+
+// AttachedPoliciesJSONNotNil applies the NotNil predicate on the "attached_policies_json" field.
+func AttachedPoliciesJSONNotNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotNull(FieldAttachedPoliciesJSON))
+}
+
+// InlinePoliciesJSONIsNil applies the IsNil predicate on the "inline_policies_json" field.
+func InlinePoliciesJSONIsNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIsNull(FieldInlinePoliciesJSON))
+}
+
+// InlinePoliciesJSONNotNil applies the NotNil predicate on the "inline_policies_json" field.
+func InlinePoliciesJSONNotNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotNull(FieldInlinePoliciesJSON))
+}
+
+// InstanceProfilesJSONIsNil applies the IsNil predicate on the "instance_profiles_json" field.
+func InstanceProfilesJSONIsNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIsNull(FieldInstanceProfilesJSON))
+}
+
+// InstanceProfilesJSONNotNil applies the NotNil predicate on the "instance_profiles_json" field.
+func InstanceProfilesJSONNotNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotNull(FieldInstanceProfilesJSON))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeHistoryAWSIAMRole) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeHistoryAWSIAMRole) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeHistoryAWSIAMRole) predicate.BronzeHistoryAWSIAMRole {
+	return predicate.BronzeHistoryAWSIAMRole(sql.NotPredicates(p))
+}
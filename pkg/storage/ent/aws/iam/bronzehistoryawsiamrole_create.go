@@ -0,0 +1,454 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamrole"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSIAMRoleCreate is the builder for creating a BronzeHistoryAWSIAMRole entity.
+type BronzeHistoryAWSIAMRoleCreate struct {
+	config
+	mutation *BronzeHistoryAWSIAMRoleMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetValidFrom(v time.Time) *BronzeHistoryAWSIAMRoleCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetValidTo(v time.Time) *BronzeHistoryAWSIAMRoleCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSIAMRoleCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetCollectedAt(v time.Time) *BronzeHistoryAWSIAMRoleCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSIAMRoleCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetResourceID(v string) *BronzeHistoryAWSIAMRoleCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetArn sets the "arn" field.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetArn(v string) *BronzeHistoryAWSIAMRoleCreate {
+	_c.mutation.SetArn(v)
+	return _c
+}
+
+// SetRoleName sets the "role_name" field.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetRoleName(v string) *BronzeHistoryAWSIAMRoleCreate {
+	_c.mutation.SetRoleName(v)
+	return _c
+}
+
+// SetPath sets the "path" field.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetPath(v string) *BronzeHistoryAWSIAMRoleCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetNillablePath(v *string) *BronzeHistoryAWSIAMRoleCreate {
+	if v != nil {
+		_c.SetPath(*v)
+	}
+	return _c
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetCreateDate(v time.Time) *BronzeHistoryAWSIAMRoleCreate {
+	_c.mutation.SetCreateDate(v)
+	return _c
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetNillableCreateDate(v *time.Time) *BronzeHistoryAWSIAMRoleCreate {
+	if v != nil {
+		_c.SetCreateDate(*v)
+	}
+	return _c
+}
+
+// SetRoleLastUsedDate sets the "role_last_used_date" field.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetRoleLastUsedDate(v time.Time) *BronzeHistoryAWSIAMRoleCreate {
+	_c.mutation.SetRoleLastUsedDate(v)
+	return _c
+}
+
+// SetNillableRoleLastUsedDate sets the "role_last_used_date" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetNillableRoleLastUsedDate(v *time.Time) *BronzeHistoryAWSIAMRoleCreate {
+	if v != nil {
+		_c.SetRoleLastUsedDate(*v)
+	}
+	return _c
+}
+
+// SetRoleLastUsedRegion sets the "role_last_used_region" field.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetRoleLastUsedRegion(v string) *BronzeHistoryAWSIAMRoleCreate {
+	_c.mutation.SetRoleLastUsedRegion(v)
+	return _c
+}
+
+// SetNillableRoleLastUsedRegion sets the "role_last_used_region" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetNillableRoleLastUsedRegion(v *string) *BronzeHistoryAWSIAMRoleCreate {
+	if v != nil {
+		_c.SetRoleLastUsedRegion(*v)
+	}
+	return _c
+}
+
+// SetPermissionsBoundaryArn sets the "permissions_boundary_arn" field.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetPermissionsBoundaryArn(v string) *BronzeHistoryAWSIAMRoleCreate {
+	_c.mutation.SetPermissionsBoundaryArn(v)
+	return _c
+}
+
+// SetNillablePermissionsBoundaryArn sets the "permissions_boundary_arn" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetNillablePermissionsBoundaryArn(v *string) *BronzeHistoryAWSIAMRoleCreate {
+	if v != nil {
+		_c.SetPermissionsBoundaryArn(*v)
+	}
+	return _c
+}
+
+// SetAssumeRolePolicyJSON sets the "assume_role_policy_json" field.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetAssumeRolePolicyJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleCreate {
+	_c.mutation.SetAssumeRolePolicyJSON(v)
+	return _c
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetAttachedPoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleCreate {
+	_c.mutation.SetAttachedPoliciesJSON(v)
+	return _c
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetInlinePoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleCreate {
+	_c.mutation.SetInlinePoliciesJSON(v)
+	return _c
+}
+
+// SetInstanceProfilesJSON sets the "instance_profiles_json" field.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetInstanceProfilesJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleCreate {
+	_c.mutation.SetInstanceProfilesJSON(v)
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetAccountID(v string) *BronzeHistoryAWSIAMRoleCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SetID(v uint) *BronzeHistoryAWSIAMRoleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryAWSIAMRoleMutation object of the builder.
+func (_c *BronzeHistoryAWSIAMRoleCreate) Mutation() *BronzeHistoryAWSIAMRoleMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryAWSIAMRole in the database.
+func (_c *BronzeHistoryAWSIAMRoleCreate) Save(ctx context.Context) (*BronzeHistoryAWSIAMRole, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryAWSIAMRoleCreate) SaveX(ctx context.Context) *BronzeHistoryAWSIAMRole {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSIAMRoleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSIAMRoleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryAWSIAMRoleCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMRole.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMRole.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMRole.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMRole.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsiamrole.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMRole.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Arn(); !ok {
+		return &ValidationError{Name: "arn", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMRole.arn"`)}
+	}
+	if v, ok := _c.mutation.Arn(); ok {
+		if err := bronzehistoryawsiamrole.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMRole.arn": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RoleName(); !ok {
+		return &ValidationError{Name: "role_name", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMRole.role_name"`)}
+	}
+	if v, ok := _c.mutation.RoleName(); ok {
+		if err := bronzehistoryawsiamrole.RoleNameValidator(v); err != nil {
+			return &ValidationError{Name: "role_name", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMRole.role_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMRole.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzehistoryawsiamrole.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMRole.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryAWSIAMRoleCreate) sqlSave(ctx context.Context) (*BronzeHistoryAWSIAMRole, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryAWSIAMRoleCreate) createSpec() (*BronzeHistoryAWSIAMRole, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryAWSIAMRole{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryawsiamrole.Table, sqlgraph.NewFieldSpec(bronzehistoryawsiamrole.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryAWSIAMRole
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.Arn(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldArn, field.TypeString, value)
+		_node.Arn = value
+	}
+	if value, ok := _c.mutation.RoleName(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldRoleName, field.TypeString, value)
+		_node.RoleName = value
+	}
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := _c.mutation.CreateDate(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldCreateDate, field.TypeTime, value)
+		_node.CreateDate = &value
+	}
+	if value, ok := _c.mutation.RoleLastUsedDate(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldRoleLastUsedDate, field.TypeTime, value)
+		_node.RoleLastUsedDate = &value
+	}
+	if value, ok := _c.mutation.RoleLastUsedRegion(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldRoleLastUsedRegion, field.TypeString, value)
+		_node.RoleLastUsedRegion = value
+	}
+	if value, ok := _c.mutation.PermissionsBoundaryArn(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldPermissionsBoundaryArn, field.TypeString, value)
+		_node.PermissionsBoundaryArn = value
+	}
+	if value, ok := _c.mutation.AssumeRolePolicyJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldAssumeRolePolicyJSON, field.TypeJSON, value)
+		_node.AssumeRolePolicyJSON = value
+	}
+	if value, ok := _c.mutation.AttachedPoliciesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldAttachedPoliciesJSON, field.TypeJSON, value)
+		_node.AttachedPoliciesJSON = value
+	}
+	if value, ok := _c.mutation.InlinePoliciesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldInlinePoliciesJSON, field.TypeJSON, value)
+		_node.InlinePoliciesJSON = value
+	}
+	if value, ok := _c.mutation.InstanceProfilesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldInstanceProfilesJSON, field.TypeJSON, value)
+		_node.InstanceProfilesJSON = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryAWSIAMRoleCreateBulk is the builder for creating many BronzeHistoryAWSIAMRole entities in bulk.
+type BronzeHistoryAWSIAMRoleCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryAWSIAMRoleCreate
+}
+
+// Save creates the BronzeHistoryAWSIAMRole entities in the database.
+func (_c *BronzeHistoryAWSIAMRoleCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryAWSIAMRole, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryAWSIAMRole, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryAWSIAMRoleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryAWSIAMRoleCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryAWSIAMRole {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSIAMRoleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSIAMRoleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1045 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamrole"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSIAMRoleUpdate is the builder for updating BronzeHistoryAWSIAMRole entities.
+type BronzeHistoryAWSIAMRoleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSIAMRoleMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMRoleUpdate builder.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) Where(ps ...predicate.BronzeHistoryAWSIAMRole) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetValidTo(v time.Time) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) ClearValidTo() *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetCollectedAt(v time.Time) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetResourceID(v string) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetNillableResourceID(v *string) *BronzeHistoryAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetArn(v string) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetNillableArn(v *string) *BronzeHistoryAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// SetRoleName sets the "role_name" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetRoleName(v string) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.SetRoleName(v)
+	return _u
+}
+
+// SetNillableRoleName sets the "role_name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetNillableRoleName(v *string) *BronzeHistoryAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetRoleName(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetPath(v string) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetNillablePath(v *string) *BronzeHistoryAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// ClearPath clears the value of the "path" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) ClearPath() *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.ClearPath()
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetCreateDate(v time.Time) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetNillableCreateDate(v *time.Time) *BronzeHistoryAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) ClearCreateDate() *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetRoleLastUsedDate sets the "role_last_used_date" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetRoleLastUsedDate(v time.Time) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.SetRoleLastUsedDate(v)
+	return _u
+}
+
+// SetNillableRoleLastUsedDate sets the "role_last_used_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetNillableRoleLastUsedDate(v *time.Time) *BronzeHistoryAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetRoleLastUsedDate(*v)
+	}
+	return _u
+}
+
+// ClearRoleLastUsedDate clears the value of the "role_last_used_date" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) ClearRoleLastUsedDate() *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.ClearRoleLastUsedDate()
+	return _u
+}
+
+// SetRoleLastUsedRegion sets the "role_last_used_region" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetRoleLastUsedRegion(v string) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.SetRoleLastUsedRegion(v)
+	return _u
+}
+
+// SetNillableRoleLastUsedRegion sets the "role_last_used_region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetNillableRoleLastUsedRegion(v *string) *BronzeHistoryAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetRoleLastUsedRegion(*v)
+	}
+	return _u
+}
+
+// ClearRoleLastUsedRegion clears the value of the "role_last_used_region" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) ClearRoleLastUsedRegion() *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.ClearRoleLastUsedRegion()
+	return _u
+}
+
+// SetPermissionsBoundaryArn sets the "permissions_boundary_arn" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetPermissionsBoundaryArn(v string) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.SetPermissionsBoundaryArn(v)
+	return _u
+}
+
+// SetNillablePermissionsBoundaryArn sets the "permissions_boundary_arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetNillablePermissionsBoundaryArn(v *string) *BronzeHistoryAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetPermissionsBoundaryArn(*v)
+	}
+	return _u
+}
+
+// ClearPermissionsBoundaryArn clears the value of the "permissions_boundary_arn" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) ClearPermissionsBoundaryArn() *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.ClearPermissionsBoundaryArn()
+	return _u
+}
+
+// SetAssumeRolePolicyJSON sets the "assume_role_policy_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetAssumeRolePolicyJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.SetAssumeRolePolicyJSON(v)
+	return _u
+}
+
+// AppendAssumeRolePolicyJSON appends value to the "assume_role_policy_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) AppendAssumeRolePolicyJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.AppendAssumeRolePolicyJSON(v)
+	return _u
+}
+
+// ClearAssumeRolePolicyJSON clears the value of the "assume_role_policy_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) ClearAssumeRolePolicyJSON() *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.ClearAssumeRolePolicyJSON()
+	return _u
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetAttachedPoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.SetAttachedPoliciesJSON(v)
+	return _u
+}
+
+// AppendAttachedPoliciesJSON appends value to the "attached_policies_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) AppendAttachedPoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.AppendAttachedPoliciesJSON(v)
+	return _u
+}
+
+// ClearAttachedPoliciesJSON clears the value of the "attached_policies_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) ClearAttachedPoliciesJSON() *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.ClearAttachedPoliciesJSON()
+	return _u
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetInlinePoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.SetInlinePoliciesJSON(v)
+	return _u
+}
+
+// AppendInlinePoliciesJSON appends value to the "inline_policies_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) AppendInlinePoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.AppendInlinePoliciesJSON(v)
+	return _u
+}
+
+// ClearInlinePoliciesJSON clears the value of the "inline_policies_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) ClearInlinePoliciesJSON() *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.ClearInlinePoliciesJSON()
+	return _u
+}
+
+// SetInstanceProfilesJSON sets the "instance_profiles_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetInstanceProfilesJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.SetInstanceProfilesJSON(v)
+	return _u
+}
+
+// AppendInstanceProfilesJSON appends value to the "instance_profiles_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) AppendInstanceProfilesJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.AppendInstanceProfilesJSON(v)
+	return _u
+}
+
+// ClearInstanceProfilesJSON clears the value of the "instance_profiles_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) ClearInstanceProfilesJSON() *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.ClearInstanceProfilesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) ClearTagsJSON() *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetAccountID(v string) *BronzeHistoryAWSIAMRoleUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SetNillableAccountID(v *string) *BronzeHistoryAWSIAMRoleUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSIAMRoleMutation object of the builder.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) Mutation() *BronzeHistoryAWSIAMRoleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSIAMRoleUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsiamrole.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMRole.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Arn(); ok {
+		if err := bronzehistoryawsiamrole.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMRole.arn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RoleName(); ok {
+		if err := bronzehistoryawsiamrole.RoleNameValidator(v); err != nil {
+			return &ValidationError{Name: "role_name", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMRole.role_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsiamrole.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMRole.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSIAMRoleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsiamrole.Table, bronzehistoryawsiamrole.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsiamrole.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldArn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RoleName(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldRoleName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldPath, field.TypeString, value)
+	}
+	if _u.mutation.PathCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RoleLastUsedDate(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldRoleLastUsedDate, field.TypeTime, value)
+	}
+	if _u.mutation.RoleLastUsedDateCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldRoleLastUsedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RoleLastUsedRegion(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldRoleLastUsedRegion, field.TypeString, value)
+	}
+	if _u.mutation.RoleLastUsedRegionCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldRoleLastUsedRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.PermissionsBoundaryArn(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldPermissionsBoundaryArn, field.TypeString, value)
+	}
+	if _u.mutation.PermissionsBoundaryArnCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldPermissionsBoundaryArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.AssumeRolePolicyJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldAssumeRolePolicyJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAssumeRolePolicyJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamrole.FieldAssumeRolePolicyJSON, value)
+		})
+	}
+	if _u.mutation.AssumeRolePolicyJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldAssumeRolePolicyJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AttachedPoliciesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldAttachedPoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttachedPoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamrole.FieldAttachedPoliciesJSON, value)
+		})
+	}
+	if _u.mutation.AttachedPoliciesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldAttachedPoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InlinePoliciesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldInlinePoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedInlinePoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamrole.FieldInlinePoliciesJSON, value)
+		})
+	}
+	if _u.mutation.InlinePoliciesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldInlinePoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InstanceProfilesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldInstanceProfilesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedInstanceProfilesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamrole.FieldInstanceProfilesJSON, value)
+		})
+	}
+	if _u.mutation.InstanceProfilesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldInstanceProfilesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamrole.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSIAMRole
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsiamrole.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryAWSIAMRoleUpdateOne is the builder for updating a single BronzeHistoryAWSIAMRole entity.
+type BronzeHistoryAWSIAMRoleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryAWSIAMRoleMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetValidTo(v time.Time) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) ClearValidTo() *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetResourceID(v string) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetArn(v string) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetNillableArn(v *string) *BronzeHistoryAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// SetRoleName sets the "role_name" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetRoleName(v string) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.SetRoleName(v)
+	return _u
+}
+
+// SetNillableRoleName sets the "role_name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetNillableRoleName(v *string) *BronzeHistoryAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetRoleName(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetPath(v string) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetNillablePath(v *string) *BronzeHistoryAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// ClearPath clears the value of the "path" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) ClearPath() *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.ClearPath()
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetCreateDate(v time.Time) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetNillableCreateDate(v *time.Time) *BronzeHistoryAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) ClearCreateDate() *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetRoleLastUsedDate sets the "role_last_used_date" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetRoleLastUsedDate(v time.Time) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.SetRoleLastUsedDate(v)
+	return _u
+}
+
+// SetNillableRoleLastUsedDate sets the "role_last_used_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetNillableRoleLastUsedDate(v *time.Time) *BronzeHistoryAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetRoleLastUsedDate(*v)
+	}
+	return _u
+}
+
+// ClearRoleLastUsedDate clears the value of the "role_last_used_date" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) ClearRoleLastUsedDate() *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.ClearRoleLastUsedDate()
+	return _u
+}
+
+// SetRoleLastUsedRegion sets the "role_last_used_region" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetRoleLastUsedRegion(v string) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.SetRoleLastUsedRegion(v)
+	return _u
+}
+
+// SetNillableRoleLastUsedRegion sets the "role_last_used_region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetNillableRoleLastUsedRegion(v *string) *BronzeHistoryAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetRoleLastUsedRegion(*v)
+	}
+	return _u
+}
+
+// ClearRoleLastUsedRegion clears the value of the "role_last_used_region" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) ClearRoleLastUsedRegion() *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.ClearRoleLastUsedRegion()
+	return _u
+}
+
+// SetPermissionsBoundaryArn sets the "permissions_boundary_arn" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetPermissionsBoundaryArn(v string) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.SetPermissionsBoundaryArn(v)
+	return _u
+}
+
+// SetNillablePermissionsBoundaryArn sets the "permissions_boundary_arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetNillablePermissionsBoundaryArn(v *string) *BronzeHistoryAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetPermissionsBoundaryArn(*v)
+	}
+	return _u
+}
+
+// ClearPermissionsBoundaryArn clears the value of the "permissions_boundary_arn" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) ClearPermissionsBoundaryArn() *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.ClearPermissionsBoundaryArn()
+	return _u
+}
+
+// SetAssumeRolePolicyJSON sets the "assume_role_policy_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetAssumeRolePolicyJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.SetAssumeRolePolicyJSON(v)
+	return _u
+}
+
+// AppendAssumeRolePolicyJSON appends value to the "assume_role_policy_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) AppendAssumeRolePolicyJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.AppendAssumeRolePolicyJSON(v)
+	return _u
+}
+
+// ClearAssumeRolePolicyJSON clears the value of the "assume_role_policy_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) ClearAssumeRolePolicyJSON() *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.ClearAssumeRolePolicyJSON()
+	return _u
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetAttachedPoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.SetAttachedPoliciesJSON(v)
+	return _u
+}
+
+// AppendAttachedPoliciesJSON appends value to the "attached_policies_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) AppendAttachedPoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.AppendAttachedPoliciesJSON(v)
+	return _u
+}
+
+// ClearAttachedPoliciesJSON clears the value of the "attached_policies_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) ClearAttachedPoliciesJSON() *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.ClearAttachedPoliciesJSON()
+	return _u
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetInlinePoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.SetInlinePoliciesJSON(v)
+	return _u
+}
+
+// AppendInlinePoliciesJSON appends value to the "inline_policies_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) AppendInlinePoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.AppendInlinePoliciesJSON(v)
+	return _u
+}
+
+// ClearInlinePoliciesJSON clears the value of the "inline_policies_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) ClearInlinePoliciesJSON() *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.ClearInlinePoliciesJSON()
+	return _u
+}
+
+// SetInstanceProfilesJSON sets the "instance_profiles_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetInstanceProfilesJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.SetInstanceProfilesJSON(v)
+	return _u
+}
+
+// AppendInstanceProfilesJSON appends value to the "instance_profiles_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) AppendInstanceProfilesJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.AppendInstanceProfilesJSON(v)
+	return _u
+}
+
+// ClearInstanceProfilesJSON clears the value of the "instance_profiles_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) ClearInstanceProfilesJSON() *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.ClearInstanceProfilesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) ClearTagsJSON() *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetAccountID(v string) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryAWSIAMRoleUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSIAMRoleMutation object of the builder.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) Mutation() *BronzeHistoryAWSIAMRoleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMRoleUpdate builder.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) Where(ps ...predicate.BronzeHistoryAWSIAMRole) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) Select(field string, fields ...string) *BronzeHistoryAWSIAMRoleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryAWSIAMRole entity.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) Save(ctx context.Context) (*BronzeHistoryAWSIAMRole, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) SaveX(ctx context.Context) *BronzeHistoryAWSIAMRole {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsiamrole.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMRole.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Arn(); ok {
+		if err := bronzehistoryawsiamrole.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMRole.arn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RoleName(); ok {
+		if err := bronzehistoryawsiamrole.RoleNameValidator(v); err != nil {
+			return &ValidationError{Name: "role_name", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMRole.role_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsiamrole.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMRole.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSIAMRoleUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryAWSIAMRole, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsiamrole.Table, bronzehistoryawsiamrole.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsiamrole.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`iam: missing "BronzeHistoryAWSIAMRole.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawsiamrole.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryawsiamrole.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("iam: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryawsiamrole.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldArn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RoleName(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldRoleName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldPath, field.TypeString, value)
+	}
+	if _u.mutation.PathCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RoleLastUsedDate(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldRoleLastUsedDate, field.TypeTime, value)
+	}
+	if _u.mutation.RoleLastUsedDateCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldRoleLastUsedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RoleLastUsedRegion(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldRoleLastUsedRegion, field.TypeString, value)
+	}
+	if _u.mutation.RoleLastUsedRegionCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldRoleLastUsedRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.PermissionsBoundaryArn(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldPermissionsBoundaryArn, field.TypeString, value)
+	}
+	if _u.mutation.PermissionsBoundaryArnCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldPermissionsBoundaryArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.AssumeRolePolicyJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldAssumeRolePolicyJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAssumeRolePolicyJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamrole.FieldAssumeRolePolicyJSON, value)
+		})
+	}
+	if _u.mutation.AssumeRolePolicyJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldAssumeRolePolicyJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AttachedPoliciesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldAttachedPoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttachedPoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamrole.FieldAttachedPoliciesJSON, value)
+		})
+	}
+	if _u.mutation.AttachedPoliciesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldAttachedPoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InlinePoliciesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldInlinePoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedInlinePoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamrole.FieldInlinePoliciesJSON, value)
+		})
+	}
+	if _u.mutation.InlinePoliciesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldInlinePoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InstanceProfilesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldInstanceProfilesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedInstanceProfilesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamrole.FieldInstanceProfilesJSON, value)
+		})
+	}
+	if _u.mutation.InstanceProfilesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldInstanceProfilesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamrole.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamrole.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsiamrole.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSIAMRole
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryAWSIAMRole{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsiamrole.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
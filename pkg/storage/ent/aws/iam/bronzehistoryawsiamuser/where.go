@@ -0,0 +1,805 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryawsiamuser
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLTE(FieldID, id))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldValidTo, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldResourceID, v))
+}
+
+// Arn applies equality check predicate on the "arn" field. It's identical to ArnEQ.
+func Arn(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldArn, v))
+}
+
+// UserName applies equality check predicate on the "user_name" field. It's identical to UserNameEQ.
+func UserName(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldUserName, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldPath, v))
+}
+
+// CreateDate applies equality check predicate on the "create_date" field. It's identical to CreateDateEQ.
+func CreateDate(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldCreateDate, v))
+}
+
+// PermissionsBoundaryArn applies equality check predicate on the "permissions_boundary_arn" field. It's identical to PermissionsBoundaryArnEQ.
+func PermissionsBoundaryArn(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldPermissionsBoundaryArn, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldAccountID, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotNull(FieldValidTo))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// ArnEQ applies the EQ predicate on the "arn" field.
+func ArnEQ(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldArn, v))
+}
+
+// ArnNEQ applies the NEQ predicate on the "arn" field.
+func ArnNEQ(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNEQ(FieldArn, v))
+}
+
+// ArnIn applies the In predicate on the "arn" field.
+func ArnIn(vs ...string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIn(FieldArn, vs...))
+}
+
+// ArnNotIn applies the NotIn predicate on the "arn" field.
+func ArnNotIn(vs ...string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotIn(FieldArn, vs...))
+}
+
+// ArnGT applies the GT predicate on the "arn" field.
+func ArnGT(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGT(FieldArn, v))
+}
+
+// ArnGTE applies the GTE predicate on the "arn" field.
+func ArnGTE(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGTE(FieldArn, v))
+}
+
+// ArnLT applies the LT predicate on the "arn" field.
+func ArnLT(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLT(FieldArn, v))
+}
+
+// ArnLTE applies the LTE predicate on the "arn" field.
+func ArnLTE(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLTE(FieldArn, v))
+}
+
+// ArnContains applies the Contains predicate on the "arn" field.
+func ArnContains(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldContains(FieldArn, v))
+}
+
+// ArnHasPrefix applies the HasPrefix predicate on the "arn" field.
+func ArnHasPrefix(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldHasPrefix(FieldArn, v))
+}
+
+// ArnHasSuffix applies the HasSuffix predicate on the "arn" field.
+func ArnHasSuffix(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldHasSuffix(FieldArn, v))
+}
+
+// ArnEqualFold applies the EqualFold predicate on the "arn" field.
+func ArnEqualFold(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEqualFold(FieldArn, v))
+}
+
+// ArnContainsFold applies the ContainsFold predicate on the "arn" field.
+func ArnContainsFold(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldContainsFold(FieldArn, v))
+}
+
+// UserNameEQ applies the EQ predicate on the "user_name" field.
+func UserNameEQ(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldUserName, v))
+}
+
+// UserNameNEQ applies the NEQ predicate on the "user_name" field.
+func UserNameNEQ(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNEQ(FieldUserName, v))
+}
+
+// UserNameIn applies the In predicate on the "user_name" field.
+func UserNameIn(vs ...string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIn(FieldUserName, vs...))
+}
+
+// UserNameNotIn applies the NotIn predicate on the "user_name" field.
+func UserNameNotIn(vs ...string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotIn(FieldUserName, vs...))
+}
+
+// UserNameGT applies the GT predicate on the "user_name" field.
+func UserNameGT(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGT(FieldUserName, v))
+}
+
+// UserNameGTE applies the GTE predicate on the "user_name" field.
+func UserNameGTE(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGTE(FieldUserName, v))
+}
+
+// UserNameLT applies the LT predicate on the "user_name" field.
+func UserNameLT(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLT(FieldUserName, v))
+}
+
+// UserNameLTE applies the LTE predicate on the "user_name" field.
+func UserNameLTE(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLTE(FieldUserName, v))
+}
+
+// UserNameContains applies the Contains predicate on the "user_name" field.
+func UserNameContains(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldContains(FieldUserName, v))
+}
+
+// UserNameHasPrefix applies the HasPrefix predicate on the "user_name" field.
+func UserNameHasPrefix(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldHasPrefix(FieldUserName, v))
+}
+
+// UserNameHasSuffix applies the HasSuffix predicate on the "user_name" field.
+func UserNameHasSuffix(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldHasSuffix(FieldUserName, v))
+}
+
+// UserNameEqualFold applies the EqualFold predicate on the "user_name" field.
+func UserNameEqualFold(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEqualFold(FieldUserName, v))
+}
+
+// UserNameContainsFold applies the ContainsFold predicate on the "user_name" field.
+func UserNameContainsFold(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldContainsFold(FieldUserName, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathIsNil applies the IsNil predicate on the "path" field.
+func PathIsNil() predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIsNull(FieldPath))
+}
+
+// PathNotNil applies the NotNil predicate on the "path" field.
+func PathNotNil() predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotNull(FieldPath))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldContainsFold(FieldPath, v))
+}
+
+// CreateDateEQ applies the EQ predicate on the "create_date" field.
+func CreateDateEQ(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldCreateDate, v))
+}
+
+// CreateDateNEQ applies the NEQ predicate on the "create_date" field.
+func CreateDateNEQ(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNEQ(FieldCreateDate, v))
+}
+
+// CreateDateIn applies the In predicate on the "create_date" field.
+func CreateDateIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIn(FieldCreateDate, vs...))
+}
+
+// CreateDateNotIn applies the NotIn predicate on the "create_date" field.
+func CreateDateNotIn(vs ...time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotIn(FieldCreateDate, vs...))
+}
+
+// CreateDateGT applies the GT predicate on the "create_date" field.
+func CreateDateGT(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGT(FieldCreateDate, v))
+}
+
+// CreateDateGTE applies the GTE predicate on the "create_date" field.
+func CreateDateGTE(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGTE(FieldCreateDate, v))
+}
+
+// CreateDateLT applies the LT predicate on the "create_date" field.
+func CreateDateLT(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLT(FieldCreateDate, v))
+}
+
+// CreateDateLTE applies the LTE predicate on the "create_date" field.
+func CreateDateLTE(v time.Time) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLTE(FieldCreateDate, v))
+}
+
+// CreateDateIsNil applies the IsNil predicate on the "create_date" field.
+func CreateDateIsNil() predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIsNull(FieldCreateDate))
+}
+
+// CreateDateNotNil applies the NotNil predicate on the "create_date" field.
+func CreateDateNotNil() predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotNull(FieldCreateDate))
+}
+
+// PermissionsBoundaryArnEQ applies the EQ predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnEQ(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnNEQ applies the NEQ predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnNEQ(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNEQ(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnIn applies the In predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnIn(vs ...string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIn(FieldPermissionsBoundaryArn, vs...))
+}
+
+// PermissionsBoundaryArnNotIn applies the NotIn predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnNotIn(vs ...string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotIn(FieldPermissionsBoundaryArn, vs...))
+}
+
+// PermissionsBoundaryArnGT applies the GT predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnGT(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGT(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnGTE applies the GTE predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnGTE(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGTE(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnLT applies the LT predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnLT(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLT(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnLTE applies the LTE predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnLTE(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLTE(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnContains applies the Contains predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnContains(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldContains(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnHasPrefix applies the HasPrefix predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnHasPrefix(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldHasPrefix(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnHasSuffix applies the HasSuffix predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnHasSuffix(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldHasSuffix(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnIsNil applies the IsNil predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnIsNil() predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIsNull(FieldPermissionsBoundaryArn))
+}
+
+// PermissionsBoundaryArnNotNil applies the NotNil predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnNotNil() predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotNull(FieldPermissionsBoundaryArn))
+}
+
+// PermissionsBoundaryArnEqualFold applies the EqualFold predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnEqualFold(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEqualFold(FieldPermissionsBoundaryArn, v))
+}
+
+// PermissionsBoundaryArnContainsFold applies the ContainsFold predicate on the "permissions_boundary_arn" field.
+func PermissionsBoundaryArnContainsFold(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldContainsFold(FieldPermissionsBoundaryArn, v))
+}
+
+// AttachedPoliciesJSONIsNil applies the IsNil predicate on the "attached_policies_json" field.
+func AttachedPoliciesJSONIsNil() predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIsNull(FieldAttachedPoliciesJSON))
+}
+
+// AttachedPoliciesJSONNotNil applies the NotNil predicate on the "attached_policies_json" field.
+func AttachedPoliciesJSONNotNil() predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotNull(FieldAttachedPoliciesJSON))
+}
+
+// InlinePoliciesJSONIsNil applies the IsNil predicate on the "inline_policies_json" field.
+func InlinePoliciesJSONIsNil() predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIsNull(FieldInlinePoliciesJSON))
+}
+
+// InlinePoliciesJSONNotNil applies the NotNil predicate on the "inline_policies_json" field.
+func InlinePoliciesJSONNotNil() predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotNull(FieldInlinePoliciesJSON))
+}
+
+// GroupsJSONIsNil applies the IsNil predicate on the "groups_json" field.
+func GroupsJSONIsNil() predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIsNull(FieldGroupsJSON))
+}
+
+// GroupsJSONNotNil applies the NotNil predicate on the "groups_json" field.
+func GroupsJSONNotNil() predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotNull(FieldGroupsJSON))
+}
+
+// MfaDevicesJSONIsNil applies the IsNil predicate on the "mfa_devices_json" field.
+func MfaDevicesJSONIsNil() predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIsNull(FieldMfaDevicesJSON))
+}
+
+// MfaDevicesJSONNotNil applies the NotNil predicate on the "mfa_devices_json" field.
+func MfaDevicesJSONNotNil() predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotNull(FieldMfaDevicesJSON))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeHistoryAWSIAMUser) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeHistoryAWSIAMUser) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeHistoryAWSIAMUser) predicate.BronzeHistoryAWSIAMUser {
+	return predicate.BronzeHistoryAWSIAMUser(sql.NotPredicates(p))
+}
@@ -0,0 +1,418 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamuser"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSIAMUserCreate is the builder for creating a BronzeHistoryAWSIAMUser entity.
+type BronzeHistoryAWSIAMUserCreate struct {
+	config
+	mutation *BronzeHistoryAWSIAMUserMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetValidFrom(v time.Time) *BronzeHistoryAWSIAMUserCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetValidTo(v time.Time) *BronzeHistoryAWSIAMUserCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSIAMUserCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetCollectedAt(v time.Time) *BronzeHistoryAWSIAMUserCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSIAMUserCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetResourceID(v string) *BronzeHistoryAWSIAMUserCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetArn sets the "arn" field.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetArn(v string) *BronzeHistoryAWSIAMUserCreate {
+	_c.mutation.SetArn(v)
+	return _c
+}
+
+// SetUserName sets the "user_name" field.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetUserName(v string) *BronzeHistoryAWSIAMUserCreate {
+	_c.mutation.SetUserName(v)
+	return _c
+}
+
+// SetPath sets the "path" field.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetPath(v string) *BronzeHistoryAWSIAMUserCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetNillablePath(v *string) *BronzeHistoryAWSIAMUserCreate {
+	if v != nil {
+		_c.SetPath(*v)
+	}
+	return _c
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetCreateDate(v time.Time) *BronzeHistoryAWSIAMUserCreate {
+	_c.mutation.SetCreateDate(v)
+	return _c
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetNillableCreateDate(v *time.Time) *BronzeHistoryAWSIAMUserCreate {
+	if v != nil {
+		_c.SetCreateDate(*v)
+	}
+	return _c
+}
+
+// SetPermissionsBoundaryArn sets the "permissions_boundary_arn" field.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetPermissionsBoundaryArn(v string) *BronzeHistoryAWSIAMUserCreate {
+	_c.mutation.SetPermissionsBoundaryArn(v)
+	return _c
+}
+
+// SetNillablePermissionsBoundaryArn sets the "permissions_boundary_arn" field if the given value is not nil.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetNillablePermissionsBoundaryArn(v *string) *BronzeHistoryAWSIAMUserCreate {
+	if v != nil {
+		_c.SetPermissionsBoundaryArn(*v)
+	}
+	return _c
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetAttachedPoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserCreate {
+	_c.mutation.SetAttachedPoliciesJSON(v)
+	return _c
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetInlinePoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserCreate {
+	_c.mutation.SetInlinePoliciesJSON(v)
+	return _c
+}
+
+// SetGroupsJSON sets the "groups_json" field.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetGroupsJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserCreate {
+	_c.mutation.SetGroupsJSON(v)
+	return _c
+}
+
+// SetMfaDevicesJSON sets the "mfa_devices_json" field.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetMfaDevicesJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserCreate {
+	_c.mutation.SetMfaDevicesJSON(v)
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetAccountID(v string) *BronzeHistoryAWSIAMUserCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryAWSIAMUserCreate) SetID(v uint) *BronzeHistoryAWSIAMUserCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryAWSIAMUserMutation object of the builder.
+func (_c *BronzeHistoryAWSIAMUserCreate) Mutation() *BronzeHistoryAWSIAMUserMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryAWSIAMUser in the database.
+func (_c *BronzeHistoryAWSIAMUserCreate) Save(ctx context.Context) (*BronzeHistoryAWSIAMUser, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryAWSIAMUserCreate) SaveX(ctx context.Context) *BronzeHistoryAWSIAMUser {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSIAMUserCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSIAMUserCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryAWSIAMUserCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMUser.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMUser.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMUser.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMUser.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsiamuser.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMUser.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Arn(); !ok {
+		return &ValidationError{Name: "arn", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMUser.arn"`)}
+	}
+	if v, ok := _c.mutation.Arn(); ok {
+		if err := bronzehistoryawsiamuser.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMUser.arn": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserName(); !ok {
+		return &ValidationError{Name: "user_name", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMUser.user_name"`)}
+	}
+	if v, ok := _c.mutation.UserName(); ok {
+		if err := bronzehistoryawsiamuser.UserNameValidator(v); err != nil {
+			return &ValidationError{Name: "user_name", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMUser.user_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`iam: missing required field "BronzeHistoryAWSIAMUser.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzehistoryawsiamuser.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMUser.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryAWSIAMUserCreate) sqlSave(ctx context.Context) (*BronzeHistoryAWSIAMUser, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryAWSIAMUserCreate) createSpec() (*BronzeHistoryAWSIAMUser, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryAWSIAMUser{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryawsiamuser.Table, sqlgraph.NewFieldSpec(bronzehistoryawsiamuser.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryAWSIAMUser
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.Arn(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldArn, field.TypeString, value)
+		_node.Arn = value
+	}
+	if value, ok := _c.mutation.UserName(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldUserName, field.TypeString, value)
+		_node.UserName = value
+	}
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := _c.mutation.CreateDate(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldCreateDate, field.TypeTime, value)
+		_node.CreateDate = &value
+	}
+	if value, ok := _c.mutation.PermissionsBoundaryArn(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldPermissionsBoundaryArn, field.TypeString, value)
+		_node.PermissionsBoundaryArn = value
+	}
+	if value, ok := _c.mutation.AttachedPoliciesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldAttachedPoliciesJSON, field.TypeJSON, value)
+		_node.AttachedPoliciesJSON = value
+	}
+	if value, ok := _c.mutation.InlinePoliciesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldInlinePoliciesJSON, field.TypeJSON, value)
+		_node.InlinePoliciesJSON = value
+	}
+	if value, ok := _c.mutation.GroupsJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldGroupsJSON, field.TypeJSON, value)
+		_node.GroupsJSON = value
+	}
+	if value, ok := _c.mutation.MfaDevicesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldMfaDevicesJSON, field.TypeJSON, value)
+		_node.MfaDevicesJSON = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryAWSIAMUserCreateBulk is the builder for creating many BronzeHistoryAWSIAMUser entities in bulk.
+type BronzeHistoryAWSIAMUserCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryAWSIAMUserCreate
+}
+
+// Save creates the BronzeHistoryAWSIAMUser entities in the database.
+func (_c *BronzeHistoryAWSIAMUserCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryAWSIAMUser, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryAWSIAMUser, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryAWSIAMUserMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryAWSIAMUserCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryAWSIAMUser {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSIAMUserCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSIAMUserCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,941 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamuser"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSIAMUserUpdate is the builder for updating BronzeHistoryAWSIAMUser entities.
+type BronzeHistoryAWSIAMUserUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSIAMUserMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMUserUpdate builder.
+func (_u *BronzeHistoryAWSIAMUserUpdate) Where(ps ...predicate.BronzeHistoryAWSIAMUser) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetValidTo(v time.Time) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSIAMUserUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) ClearValidTo() *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetCollectedAt(v time.Time) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSIAMUserUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSIAMUserUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetResourceID(v string) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetNillableResourceID(v *string) *BronzeHistoryAWSIAMUserUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetArn(v string) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetNillableArn(v *string) *BronzeHistoryAWSIAMUserUpdate {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetUserName(v string) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetNillableUserName(v *string) *BronzeHistoryAWSIAMUserUpdate {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetPath(v string) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetNillablePath(v *string) *BronzeHistoryAWSIAMUserUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// ClearPath clears the value of the "path" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) ClearPath() *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.ClearPath()
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetCreateDate(v time.Time) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetNillableCreateDate(v *time.Time) *BronzeHistoryAWSIAMUserUpdate {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) ClearCreateDate() *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetPermissionsBoundaryArn sets the "permissions_boundary_arn" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetPermissionsBoundaryArn(v string) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.SetPermissionsBoundaryArn(v)
+	return _u
+}
+
+// SetNillablePermissionsBoundaryArn sets the "permissions_boundary_arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetNillablePermissionsBoundaryArn(v *string) *BronzeHistoryAWSIAMUserUpdate {
+	if v != nil {
+		_u.SetPermissionsBoundaryArn(*v)
+	}
+	return _u
+}
+
+// ClearPermissionsBoundaryArn clears the value of the "permissions_boundary_arn" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) ClearPermissionsBoundaryArn() *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.ClearPermissionsBoundaryArn()
+	return _u
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetAttachedPoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.SetAttachedPoliciesJSON(v)
+	return _u
+}
+
+// AppendAttachedPoliciesJSON appends value to the "attached_policies_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) AppendAttachedPoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.AppendAttachedPoliciesJSON(v)
+	return _u
+}
+
+// ClearAttachedPoliciesJSON clears the value of the "attached_policies_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) ClearAttachedPoliciesJSON() *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.ClearAttachedPoliciesJSON()
+	return _u
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetInlinePoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.SetInlinePoliciesJSON(v)
+	return _u
+}
+
+// AppendInlinePoliciesJSON appends value to the "inline_policies_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) AppendInlinePoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.AppendInlinePoliciesJSON(v)
+	return _u
+}
+
+// ClearInlinePoliciesJSON clears the value of the "inline_policies_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) ClearInlinePoliciesJSON() *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.ClearInlinePoliciesJSON()
+	return _u
+}
+
+// SetGroupsJSON sets the "groups_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetGroupsJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.SetGroupsJSON(v)
+	return _u
+}
+
+// AppendGroupsJSON appends value to the "groups_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) AppendGroupsJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.AppendGroupsJSON(v)
+	return _u
+}
+
+// ClearGroupsJSON clears the value of the "groups_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) ClearGroupsJSON() *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.ClearGroupsJSON()
+	return _u
+}
+
+// SetMfaDevicesJSON sets the "mfa_devices_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetMfaDevicesJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.SetMfaDevicesJSON(v)
+	return _u
+}
+
+// AppendMfaDevicesJSON appends value to the "mfa_devices_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) AppendMfaDevicesJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.AppendMfaDevicesJSON(v)
+	return _u
+}
+
+// ClearMfaDevicesJSON clears the value of the "mfa_devices_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) ClearMfaDevicesJSON() *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.ClearMfaDevicesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) ClearTagsJSON() *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetAccountID(v string) *BronzeHistoryAWSIAMUserUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SetNillableAccountID(v *string) *BronzeHistoryAWSIAMUserUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSIAMUserMutation object of the builder.
+func (_u *BronzeHistoryAWSIAMUserUpdate) Mutation() *BronzeHistoryAWSIAMUserMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryAWSIAMUserUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMUserUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryAWSIAMUserUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMUserUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSIAMUserUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsiamuser.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMUser.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Arn(); ok {
+		if err := bronzehistoryawsiamuser.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMUser.arn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserName(); ok {
+		if err := bronzehistoryawsiamuser.UserNameValidator(v); err != nil {
+			return &ValidationError{Name: "user_name", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMUser.user_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsiamuser.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMUser.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSIAMUserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsiamuser.Table, bronzehistoryawsiamuser.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsiamuser.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsiamuser.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldArn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldUserName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldPath, field.TypeString, value)
+	}
+	if _u.mutation.PathCleared() {
+		_spec.ClearField(bronzehistoryawsiamuser.FieldPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzehistoryawsiamuser.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PermissionsBoundaryArn(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldPermissionsBoundaryArn, field.TypeString, value)
+	}
+	if _u.mutation.PermissionsBoundaryArnCleared() {
+		_spec.ClearField(bronzehistoryawsiamuser.FieldPermissionsBoundaryArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachedPoliciesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldAttachedPoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttachedPoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamuser.FieldAttachedPoliciesJSON, value)
+		})
+	}
+	if _u.mutation.AttachedPoliciesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamuser.FieldAttachedPoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InlinePoliciesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldInlinePoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedInlinePoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamuser.FieldInlinePoliciesJSON, value)
+		})
+	}
+	if _u.mutation.InlinePoliciesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamuser.FieldInlinePoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GroupsJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldGroupsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamuser.FieldGroupsJSON, value)
+		})
+	}
+	if _u.mutation.GroupsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamuser.FieldGroupsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MfaDevicesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldMfaDevicesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMfaDevicesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamuser.FieldMfaDevicesJSON, value)
+		})
+	}
+	if _u.mutation.MfaDevicesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamuser.FieldMfaDevicesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamuser.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamuser.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSIAMUser
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsiamuser.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryAWSIAMUserUpdateOne is the builder for updating a single BronzeHistoryAWSIAMUser entity.
+type BronzeHistoryAWSIAMUserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryAWSIAMUserMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetValidTo(v time.Time) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSIAMUserUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) ClearValidTo() *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSIAMUserUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSIAMUserUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetResourceID(v string) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryAWSIAMUserUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetArn(v string) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetNillableArn(v *string) *BronzeHistoryAWSIAMUserUpdateOne {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetUserName(v string) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetNillableUserName(v *string) *BronzeHistoryAWSIAMUserUpdateOne {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetPath(v string) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetNillablePath(v *string) *BronzeHistoryAWSIAMUserUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// ClearPath clears the value of the "path" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) ClearPath() *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.ClearPath()
+	return _u
+}
+
+// SetCreateDate sets the "create_date" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetCreateDate(v time.Time) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.SetCreateDate(v)
+	return _u
+}
+
+// SetNillableCreateDate sets the "create_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetNillableCreateDate(v *time.Time) *BronzeHistoryAWSIAMUserUpdateOne {
+	if v != nil {
+		_u.SetCreateDate(*v)
+	}
+	return _u
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) ClearCreateDate() *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.ClearCreateDate()
+	return _u
+}
+
+// SetPermissionsBoundaryArn sets the "permissions_boundary_arn" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetPermissionsBoundaryArn(v string) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.SetPermissionsBoundaryArn(v)
+	return _u
+}
+
+// SetNillablePermissionsBoundaryArn sets the "permissions_boundary_arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetNillablePermissionsBoundaryArn(v *string) *BronzeHistoryAWSIAMUserUpdateOne {
+	if v != nil {
+		_u.SetPermissionsBoundaryArn(*v)
+	}
+	return _u
+}
+
+// ClearPermissionsBoundaryArn clears the value of the "permissions_boundary_arn" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) ClearPermissionsBoundaryArn() *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.ClearPermissionsBoundaryArn()
+	return _u
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetAttachedPoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.SetAttachedPoliciesJSON(v)
+	return _u
+}
+
+// AppendAttachedPoliciesJSON appends value to the "attached_policies_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) AppendAttachedPoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.AppendAttachedPoliciesJSON(v)
+	return _u
+}
+
+// ClearAttachedPoliciesJSON clears the value of the "attached_policies_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) ClearAttachedPoliciesJSON() *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.ClearAttachedPoliciesJSON()
+	return _u
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetInlinePoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.SetInlinePoliciesJSON(v)
+	return _u
+}
+
+// AppendInlinePoliciesJSON appends value to the "inline_policies_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) AppendInlinePoliciesJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.AppendInlinePoliciesJSON(v)
+	return _u
+}
+
+// ClearInlinePoliciesJSON clears the value of the "inline_policies_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) ClearInlinePoliciesJSON() *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.ClearInlinePoliciesJSON()
+	return _u
+}
+
+// SetGroupsJSON sets the "groups_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetGroupsJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.SetGroupsJSON(v)
+	return _u
+}
+
+// AppendGroupsJSON appends value to the "groups_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) AppendGroupsJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.AppendGroupsJSON(v)
+	return _u
+}
+
+// ClearGroupsJSON clears the value of the "groups_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) ClearGroupsJSON() *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.ClearGroupsJSON()
+	return _u
+}
+
+// SetMfaDevicesJSON sets the "mfa_devices_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetMfaDevicesJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.SetMfaDevicesJSON(v)
+	return _u
+}
+
+// AppendMfaDevicesJSON appends value to the "mfa_devices_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) AppendMfaDevicesJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.AppendMfaDevicesJSON(v)
+	return _u
+}
+
+// ClearMfaDevicesJSON clears the value of the "mfa_devices_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) ClearMfaDevicesJSON() *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.ClearMfaDevicesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) ClearTagsJSON() *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetAccountID(v string) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryAWSIAMUserUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSIAMUserMutation object of the builder.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) Mutation() *BronzeHistoryAWSIAMUserMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMUserUpdate builder.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) Where(ps ...predicate.BronzeHistoryAWSIAMUser) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) Select(field string, fields ...string) *BronzeHistoryAWSIAMUserUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryAWSIAMUser entity.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) Save(ctx context.Context) (*BronzeHistoryAWSIAMUser, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) SaveX(ctx context.Context) *BronzeHistoryAWSIAMUser {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawsiamuser.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMUser.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Arn(); ok {
+		if err := bronzehistoryawsiamuser.ArnValidator(v); err != nil {
+			return &ValidationError{Name: "arn", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMUser.arn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserName(); ok {
+		if err := bronzehistoryawsiamuser.UserNameValidator(v); err != nil {
+			return &ValidationError{Name: "user_name", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMUser.user_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawsiamuser.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`iam: validator failed for field "BronzeHistoryAWSIAMUser.account_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSIAMUserUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryAWSIAMUser, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawsiamuser.Table, bronzehistoryawsiamuser.Columns, sqlgraph.NewFieldSpec(bronzehistoryawsiamuser.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`iam: missing "BronzeHistoryAWSIAMUser.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawsiamuser.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryawsiamuser.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("iam: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryawsiamuser.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawsiamuser.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldArn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldUserName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldPath, field.TypeString, value)
+	}
+	if _u.mutation.PathCleared() {
+		_spec.ClearField(bronzehistoryawsiamuser.FieldPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateDate(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldCreateDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreateDateCleared() {
+		_spec.ClearField(bronzehistoryawsiamuser.FieldCreateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PermissionsBoundaryArn(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldPermissionsBoundaryArn, field.TypeString, value)
+	}
+	if _u.mutation.PermissionsBoundaryArnCleared() {
+		_spec.ClearField(bronzehistoryawsiamuser.FieldPermissionsBoundaryArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachedPoliciesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldAttachedPoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttachedPoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamuser.FieldAttachedPoliciesJSON, value)
+		})
+	}
+	if _u.mutation.AttachedPoliciesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamuser.FieldAttachedPoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InlinePoliciesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldInlinePoliciesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedInlinePoliciesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamuser.FieldInlinePoliciesJSON, value)
+		})
+	}
+	if _u.mutation.InlinePoliciesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamuser.FieldInlinePoliciesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GroupsJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldGroupsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamuser.FieldGroupsJSON, value)
+		})
+	}
+	if _u.mutation.GroupsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamuser.FieldGroupsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MfaDevicesJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldMfaDevicesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMfaDevicesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamuser.FieldMfaDevicesJSON, value)
+		})
+	}
+	if _u.mutation.MfaDevicesJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamuser.FieldMfaDevicesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawsiamuser.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawsiamuser.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawsiamuser.FieldAccountID, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSIAMUser
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryAWSIAMUser{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawsiamuser.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,1931 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/migrate"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamaccesskey"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamcredentialreport"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamgroup"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiampolicy"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamrole"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamuser"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamaccesskey"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamcredentialreport"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamgroup"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiampolicy"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamrole"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamuser"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/internal"
+)
+
+// Client is the client that holds all ent builders.
+type Client struct {
+	config
+	// Schema is the client for creating, migrating and dropping schema.
+	Schema *migrate.Schema
+	// BronzeAWSIAMAccessKey is the client for interacting with the BronzeAWSIAMAccessKey builders.
+	BronzeAWSIAMAccessKey *BronzeAWSIAMAccessKeyClient
+	// BronzeAWSIAMCredentialReport is the client for interacting with the BronzeAWSIAMCredentialReport builders.
+	BronzeAWSIAMCredentialReport *BronzeAWSIAMCredentialReportClient
+	// BronzeAWSIAMGroup is the client for interacting with the BronzeAWSIAMGroup builders.
+	BronzeAWSIAMGroup *BronzeAWSIAMGroupClient
+	// BronzeAWSIAMPolicy is the client for interacting with the BronzeAWSIAMPolicy builders.
+	BronzeAWSIAMPolicy *BronzeAWSIAMPolicyClient
+	// BronzeAWSIAMRole is the client for interacting with the BronzeAWSIAMRole builders.
+	BronzeAWSIAMRole *BronzeAWSIAMRoleClient
+	// BronzeAWSIAMUser is the client for interacting with the BronzeAWSIAMUser builders.
+	BronzeAWSIAMUser *BronzeAWSIAMUserClient
+	// BronzeHistoryAWSIAMAccessKey is the client for interacting with the BronzeHistoryAWSIAMAccessKey builders.
+	BronzeHistoryAWSIAMAccessKey *BronzeHistoryAWSIAMAccessKeyClient
+	// BronzeHistoryAWSIAMCredentialReport is the client for interacting with the BronzeHistoryAWSIAMCredentialReport builders.
+	BronzeHistoryAWSIAMCredentialReport *BronzeHistoryAWSIAMCredentialReportClient
+	// BronzeHistoryAWSIAMGroup is the client for interacting with the BronzeHistoryAWSIAMGroup builders.
+	BronzeHistoryAWSIAMGroup *BronzeHistoryAWSIAMGroupClient
+	// BronzeHistoryAWSIAMPolicy is the client for interacting with the BronzeHistoryAWSIAMPolicy builders.
+	BronzeHistoryAWSIAMPolicy *BronzeHistoryAWSIAMPolicyClient
+	// BronzeHistoryAWSIAMRole is the client for interacting with the BronzeHistoryAWSIAMRole builders.
+	BronzeHistoryAWSIAMRole *BronzeHistoryAWSIAMRoleClient
+	// BronzeHistoryAWSIAMUser is the client for interacting with the BronzeHistoryAWSIAMUser builders.
+	BronzeHistoryAWSIAMUser *BronzeHistoryAWSIAMUserClient
+}
+
+// NewClient creates a new client configured with the given options.
+func NewClient(opts ...Option) *Client {
+	client := &Client{config: newConfig(opts...)}
+	client.init()
+	return client
+}
+
+func (c *Client) init() {
+	c.Schema = migrate.NewSchema(c.driver)
+	c.BronzeAWSIAMAccessKey = NewBronzeAWSIAMAccessKeyClient(c.config)
+	c.BronzeAWSIAMCredentialReport = NewBronzeAWSIAMCredentialReportClient(c.config)
+	c.BronzeAWSIAMGroup = NewBronzeAWSIAMGroupClient(c.config)
+	c.BronzeAWSIAMPolicy = NewBronzeAWSIAMPolicyClient(c.config)
+	c.BronzeAWSIAMRole = NewBronzeAWSIAMRoleClient(c.config)
+	c.BronzeAWSIAMUser = NewBronzeAWSIAMUserClient(c.config)
+	c.BronzeHistoryAWSIAMAccessKey = NewBronzeHistoryAWSIAMAccessKeyClient(c.config)
+	c.BronzeHistoryAWSIAMCredentialReport = NewBronzeHistoryAWSIAMCredentialReportClient(c.config)
+	c.BronzeHistoryAWSIAMGroup = NewBronzeHistoryAWSIAMGroupClient(c.config)
+	c.BronzeHistoryAWSIAMPolicy = NewBronzeHistoryAWSIAMPolicyClient(c.config)
+	c.BronzeHistoryAWSIAMRole = NewBronzeHistoryAWSIAMRoleClient(c.config)
+	c.BronzeHistoryAWSIAMUser = NewBronzeHistoryAWSIAMUserClient(c.config)
+}
+
+type (
+	// config is the configuration for the client and its builder.
+	config struct {
+		// driver used for executing database requests.
+		driver dialect.Driver
+		// debug enable a debug logging.
+		debug bool
+		// log used for logging on debug mode.
+		log func(...any)
+		// hooks to execute on mutations.
+		hooks *hooks
+		// interceptors to execute on queries.
+		inters *inters
+		// schemaConfig contains alternative names for all tables.
+		schemaConfig SchemaConfig
+	}
+	// Option function to configure the client.
+	Option func(*config)
+)
+
+// newConfig creates a new config for the client.
+func newConfig(opts ...Option) config {
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
+	cfg.options(opts...)
+	return cfg
+}
+
+// options applies the options on the config object.
+func (c *config) options(opts ...Option) {
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.debug {
+		c.driver = dialect.Debug(c.driver, c.log)
+	}
+}
+
+// Debug enables debug logging on the ent.Driver.
+func Debug() Option {
+	return func(c *config) {
+		c.debug = true
+	}
+}
+
+// Log sets the logging function for debug mode.
+func Log(fn func(...any)) Option {
+	return func(c *config) {
+		c.log = fn
+	}
+}
+
+// Driver configures the client driver.
+func Driver(driver dialect.Driver) Option {
+	return func(c *config) {
+		c.driver = driver
+	}
+}
+
+// Open opens a database/sql.DB specified by the driver name and
+// the data source name, and returns a new client attached to it.
+// Optional parameters can be added for configuring the client.
+func Open(driverName, dataSourceName string, options ...Option) (*Client, error) {
+	switch driverName {
+	case dialect.MySQL, dialect.Postgres, dialect.SQLite:
+		drv, err := sql.Open(driverName, dataSourceName)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(append(options, Driver(drv))...), nil
+	default:
+		return nil, fmt.Errorf("unsupported driver: %q", driverName)
+	}
+}
+
+// ErrTxStarted is returned when trying to start a new transaction from a transactional client.
+var ErrTxStarted = errors.New("iam: cannot start a transaction within a transaction")
+
+// Tx returns a new transactional client. The provided context
+// is used until the transaction is committed or rolled back.
+func (c *Client) Tx(ctx context.Context) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, ErrTxStarted
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("iam: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	return &Tx{
+		ctx:                                 ctx,
+		config:                              cfg,
+		BronzeAWSIAMAccessKey:               NewBronzeAWSIAMAccessKeyClient(cfg),
+		BronzeAWSIAMCredentialReport:        NewBronzeAWSIAMCredentialReportClient(cfg),
+		BronzeAWSIAMGroup:                   NewBronzeAWSIAMGroupClient(cfg),
+		BronzeAWSIAMPolicy:                  NewBronzeAWSIAMPolicyClient(cfg),
+		BronzeAWSIAMRole:                    NewBronzeAWSIAMRoleClient(cfg),
+		BronzeAWSIAMUser:                    NewBronzeAWSIAMUserClient(cfg),
+		BronzeHistoryAWSIAMAccessKey:        NewBronzeHistoryAWSIAMAccessKeyClient(cfg),
+		BronzeHistoryAWSIAMCredentialReport: NewBronzeHistoryAWSIAMCredentialReportClient(cfg),
+		BronzeHistoryAWSIAMGroup:            NewBronzeHistoryAWSIAMGroupClient(cfg),
+		BronzeHistoryAWSIAMPolicy:           NewBronzeHistoryAWSIAMPolicyClient(cfg),
+		BronzeHistoryAWSIAMRole:             NewBronzeHistoryAWSIAMRoleClient(cfg),
+		BronzeHistoryAWSIAMUser:             NewBronzeHistoryAWSIAMUserClient(cfg),
+	}, nil
+}
+
+// BeginTx returns a transactional client with specified options.
+func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, errors.New("ent: cannot start a transaction within a transaction")
+	}
+	tx, err := c.driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}).BeginTx(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = &txDriver{tx: tx, drv: c.driver}
+	return &Tx{
+		ctx:                                 ctx,
+		config:                              cfg,
+		BronzeAWSIAMAccessKey:               NewBronzeAWSIAMAccessKeyClient(cfg),
+		BronzeAWSIAMCredentialReport:        NewBronzeAWSIAMCredentialReportClient(cfg),
+		BronzeAWSIAMGroup:                   NewBronzeAWSIAMGroupClient(cfg),
+		BronzeAWSIAMPolicy:                  NewBronzeAWSIAMPolicyClient(cfg),
+		BronzeAWSIAMRole:                    NewBronzeAWSIAMRoleClient(cfg),
+		BronzeAWSIAMUser:                    NewBronzeAWSIAMUserClient(cfg),
+		BronzeHistoryAWSIAMAccessKey:        NewBronzeHistoryAWSIAMAccessKeyClient(cfg),
+		BronzeHistoryAWSIAMCredentialReport: NewBronzeHistoryAWSIAMCredentialReportClient(cfg),
+		BronzeHistoryAWSIAMGroup:            NewBronzeHistoryAWSIAMGroupClient(cfg),
+		BronzeHistoryAWSIAMPolicy:           NewBronzeHistoryAWSIAMPolicyClient(cfg),
+		BronzeHistoryAWSIAMRole:             NewBronzeHistoryAWSIAMRoleClient(cfg),
+		BronzeHistoryAWSIAMUser:             NewBronzeHistoryAWSIAMUserClient(cfg),
+	}, nil
+}
+
+// Debug returns a new debug-client. It's used to get verbose logging on specific operations.
+//
+//	client.Debug().
+//		BronzeAWSIAMAccessKey.
+//		Query().
+//		Count(ctx)
+func (c *Client) Debug() *Client {
+	if c.debug {
+		return c
+	}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// Close closes the database connection and prevents new queries from starting.
+func (c *Client) Close() error {
+	return c.driver.Close()
+}
+
+// Use adds the mutation hooks to all the entity clients.
+// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
+func (c *Client) Use(hooks ...Hook) {
+	for _, n := range []interface{ Use(...Hook) }{
+		c.BronzeAWSIAMAccessKey, c.BronzeAWSIAMCredentialReport, c.BronzeAWSIAMGroup,
+		c.BronzeAWSIAMPolicy, c.BronzeAWSIAMRole, c.BronzeAWSIAMUser,
+		c.BronzeHistoryAWSIAMAccessKey, c.BronzeHistoryAWSIAMCredentialReport,
+		c.BronzeHistoryAWSIAMGroup, c.BronzeHistoryAWSIAMPolicy,
+		c.BronzeHistoryAWSIAMRole, c.BronzeHistoryAWSIAMUser,
+	} {
+		n.Use(hooks...)
+	}
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.BronzeAWSIAMAccessKey, c.BronzeAWSIAMCredentialReport, c.BronzeAWSIAMGroup,
+		c.BronzeAWSIAMPolicy, c.BronzeAWSIAMRole, c.BronzeAWSIAMUser,
+		c.BronzeHistoryAWSIAMAccessKey, c.BronzeHistoryAWSIAMCredentialReport,
+		c.BronzeHistoryAWSIAMGroup, c.BronzeHistoryAWSIAMPolicy,
+		c.BronzeHistoryAWSIAMRole, c.BronzeHistoryAWSIAMUser,
+	} {
+		n.Intercept(interceptors...)
+	}
+}
+
+// Mutate implements the ent.Mutator interface.
+func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
+	switch m := m.(type) {
+	case *BronzeAWSIAMAccessKeyMutation:
+		return c.BronzeAWSIAMAccessKey.mutate(ctx, m)
+	case *BronzeAWSIAMCredentialReportMutation:
+		return c.BronzeAWSIAMCredentialReport.mutate(ctx, m)
+	case *BronzeAWSIAMGroupMutation:
+		return c.BronzeAWSIAMGroup.mutate(ctx, m)
+	case *BronzeAWSIAMPolicyMutation:
+		return c.BronzeAWSIAMPolicy.mutate(ctx, m)
+	case *BronzeAWSIAMRoleMutation:
+		return c.BronzeAWSIAMRole.mutate(ctx, m)
+	case *BronzeAWSIAMUserMutation:
+		return c.BronzeAWSIAMUser.mutate(ctx, m)
+	case *BronzeHistoryAWSIAMAccessKeyMutation:
+		return c.BronzeHistoryAWSIAMAccessKey.mutate(ctx, m)
+	case *BronzeHistoryAWSIAMCredentialReportMutation:
+		return c.BronzeHistoryAWSIAMCredentialReport.mutate(ctx, m)
+	case *BronzeHistoryAWSIAMGroupMutation:
+		return c.BronzeHistoryAWSIAMGroup.mutate(ctx, m)
+	case *BronzeHistoryAWSIAMPolicyMutation:
+		return c.BronzeHistoryAWSIAMPolicy.mutate(ctx, m)
+	case *BronzeHistoryAWSIAMRoleMutation:
+		return c.BronzeHistoryAWSIAMRole.mutate(ctx, m)
+	case *BronzeHistoryAWSIAMUserMutation:
+		return c.BronzeHistoryAWSIAMUser.mutate(ctx, m)
+	default:
+		return nil, fmt.Errorf("iam: unknown mutation type %T", m)
+	}
+}
+
+// BronzeAWSIAMAccessKeyClient is a client for the BronzeAWSIAMAccessKey schema.
+type BronzeAWSIAMAccessKeyClient struct {
+	config
+}
+
+// NewBronzeAWSIAMAccessKeyClient returns a client for the BronzeAWSIAMAccessKey from the given config.
+func NewBronzeAWSIAMAccessKeyClient(c config) *BronzeAWSIAMAccessKeyClient {
+	return &BronzeAWSIAMAccessKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzeawsiamaccesskey.Hooks(f(g(h())))`.
+func (c *BronzeAWSIAMAccessKeyClient) Use(hooks ...Hook) {
+	c.hooks.BronzeAWSIAMAccessKey = append(c.hooks.BronzeAWSIAMAccessKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzeawsiamaccesskey.Intercept(f(g(h())))`.
+func (c *BronzeAWSIAMAccessKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeAWSIAMAccessKey = append(c.inters.BronzeAWSIAMAccessKey, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeAWSIAMAccessKey entity.
+func (c *BronzeAWSIAMAccessKeyClient) Create() *BronzeAWSIAMAccessKeyCreate {
+	mutation := newBronzeAWSIAMAccessKeyMutation(c.config, OpCreate)
+	return &BronzeAWSIAMAccessKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeAWSIAMAccessKey entities.
+func (c *BronzeAWSIAMAccessKeyClient) CreateBulk(builders ...*BronzeAWSIAMAccessKeyCreate) *BronzeAWSIAMAccessKeyCreateBulk {
+	return &BronzeAWSIAMAccessKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeAWSIAMAccessKeyClient) MapCreateBulk(slice any, setFunc func(*BronzeAWSIAMAccessKeyCreate, int)) *BronzeAWSIAMAccessKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeAWSIAMAccessKeyCreateBulk{err: fmt.Errorf("calling to BronzeAWSIAMAccessKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeAWSIAMAccessKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeAWSIAMAccessKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeAWSIAMAccessKey.
+func (c *BronzeAWSIAMAccessKeyClient) Update() *BronzeAWSIAMAccessKeyUpdate {
+	mutation := newBronzeAWSIAMAccessKeyMutation(c.config, OpUpdate)
+	return &BronzeAWSIAMAccessKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeAWSIAMAccessKeyClient) UpdateOne(_m *BronzeAWSIAMAccessKey) *BronzeAWSIAMAccessKeyUpdateOne {
+	mutation := newBronzeAWSIAMAccessKeyMutation(c.config, OpUpdateOne, withBronzeAWSIAMAccessKey(_m))
+	return &BronzeAWSIAMAccessKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeAWSIAMAccessKeyClient) UpdateOneID(id string) *BronzeAWSIAMAccessKeyUpdateOne {
+	mutation := newBronzeAWSIAMAccessKeyMutation(c.config, OpUpdateOne, withBronzeAWSIAMAccessKeyID(id))
+	return &BronzeAWSIAMAccessKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeAWSIAMAccessKey.
+func (c *BronzeAWSIAMAccessKeyClient) Delete() *BronzeAWSIAMAccessKeyDelete {
+	mutation := newBronzeAWSIAMAccessKeyMutation(c.config, OpDelete)
+	return &BronzeAWSIAMAccessKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeAWSIAMAccessKeyClient) DeleteOne(_m *BronzeAWSIAMAccessKey) *BronzeAWSIAMAccessKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeAWSIAMAccessKeyClient) DeleteOneID(id string) *BronzeAWSIAMAccessKeyDeleteOne {
+	builder := c.Delete().Where(bronzeawsiamaccesskey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeAWSIAMAccessKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeAWSIAMAccessKey.
+func (c *BronzeAWSIAMAccessKeyClient) Query() *BronzeAWSIAMAccessKeyQuery {
+	return &BronzeAWSIAMAccessKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeAWSIAMAccessKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeAWSIAMAccessKey entity by its id.
+func (c *BronzeAWSIAMAccessKeyClient) Get(ctx context.Context, id string) (*BronzeAWSIAMAccessKey, error) {
+	return c.Query().Where(bronzeawsiamaccesskey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeAWSIAMAccessKeyClient) GetX(ctx context.Context, id string) *BronzeAWSIAMAccessKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeAWSIAMAccessKeyClient) Hooks() []Hook {
+	return c.hooks.BronzeAWSIAMAccessKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeAWSIAMAccessKeyClient) Interceptors() []Interceptor {
+	return c.inters.BronzeAWSIAMAccessKey
+}
+
+func (c *BronzeAWSIAMAccessKeyClient) mutate(ctx context.Context, m *BronzeAWSIAMAccessKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeAWSIAMAccessKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeAWSIAMAccessKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeAWSIAMAccessKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeAWSIAMAccessKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("iam: unknown BronzeAWSIAMAccessKey mutation op: %q", m.Op())
+	}
+}
+
+// BronzeAWSIAMCredentialReportClient is a client for the BronzeAWSIAMCredentialReport schema.
+type BronzeAWSIAMCredentialReportClient struct {
+	config
+}
+
+// NewBronzeAWSIAMCredentialReportClient returns a client for the BronzeAWSIAMCredentialReport from the given config.
+func NewBronzeAWSIAMCredentialReportClient(c config) *BronzeAWSIAMCredentialReportClient {
+	return &BronzeAWSIAMCredentialReportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzeawsiamcredentialreport.Hooks(f(g(h())))`.
+func (c *BronzeAWSIAMCredentialReportClient) Use(hooks ...Hook) {
+	c.hooks.BronzeAWSIAMCredentialReport = append(c.hooks.BronzeAWSIAMCredentialReport, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzeawsiamcredentialreport.Intercept(f(g(h())))`.
+func (c *BronzeAWSIAMCredentialReportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeAWSIAMCredentialReport = append(c.inters.BronzeAWSIAMCredentialReport, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeAWSIAMCredentialReport entity.
+func (c *BronzeAWSIAMCredentialReportClient) Create() *BronzeAWSIAMCredentialReportCreate {
+	mutation := newBronzeAWSIAMCredentialReportMutation(c.config, OpCreate)
+	return &BronzeAWSIAMCredentialReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeAWSIAMCredentialReport entities.
+func (c *BronzeAWSIAMCredentialReportClient) CreateBulk(builders ...*BronzeAWSIAMCredentialReportCreate) *BronzeAWSIAMCredentialReportCreateBulk {
+	return &BronzeAWSIAMCredentialReportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeAWSIAMCredentialReportClient) MapCreateBulk(slice any, setFunc func(*BronzeAWSIAMCredentialReportCreate, int)) *BronzeAWSIAMCredentialReportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeAWSIAMCredentialReportCreateBulk{err: fmt.Errorf("calling to BronzeAWSIAMCredentialReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeAWSIAMCredentialReportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeAWSIAMCredentialReportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeAWSIAMCredentialReport.
+func (c *BronzeAWSIAMCredentialReportClient) Update() *BronzeAWSIAMCredentialReportUpdate {
+	mutation := newBronzeAWSIAMCredentialReportMutation(c.config, OpUpdate)
+	return &BronzeAWSIAMCredentialReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeAWSIAMCredentialReportClient) UpdateOne(_m *BronzeAWSIAMCredentialReport) *BronzeAWSIAMCredentialReportUpdateOne {
+	mutation := newBronzeAWSIAMCredentialReportMutation(c.config, OpUpdateOne, withBronzeAWSIAMCredentialReport(_m))
+	return &BronzeAWSIAMCredentialReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeAWSIAMCredentialReportClient) UpdateOneID(id string) *BronzeAWSIAMCredentialReportUpdateOne {
+	mutation := newBronzeAWSIAMCredentialReportMutation(c.config, OpUpdateOne, withBronzeAWSIAMCredentialReportID(id))
+	return &BronzeAWSIAMCredentialReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeAWSIAMCredentialReport.
+func (c *BronzeAWSIAMCredentialReportClient) Delete() *BronzeAWSIAMCredentialReportDelete {
+	mutation := newBronzeAWSIAMCredentialReportMutation(c.config, OpDelete)
+	return &BronzeAWSIAMCredentialReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeAWSIAMCredentialReportClient) DeleteOne(_m *BronzeAWSIAMCredentialReport) *BronzeAWSIAMCredentialReportDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeAWSIAMCredentialReportClient) DeleteOneID(id string) *BronzeAWSIAMCredentialReportDeleteOne {
+	builder := c.Delete().Where(bronzeawsiamcredentialreport.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeAWSIAMCredentialReportDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeAWSIAMCredentialReport.
+func (c *BronzeAWSIAMCredentialReportClient) Query() *BronzeAWSIAMCredentialReportQuery {
+	return &BronzeAWSIAMCredentialReportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeAWSIAMCredentialReport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeAWSIAMCredentialReport entity by its id.
+func (c *BronzeAWSIAMCredentialReportClient) Get(ctx context.Context, id string) (*BronzeAWSIAMCredentialReport, error) {
+	return c.Query().Where(bronzeawsiamcredentialreport.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeAWSIAMCredentialReportClient) GetX(ctx context.Context, id string) *BronzeAWSIAMCredentialReport {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeAWSIAMCredentialReportClient) Hooks() []Hook {
+	return c.hooks.BronzeAWSIAMCredentialReport
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeAWSIAMCredentialReportClient) Interceptors() []Interceptor {
+	return c.inters.BronzeAWSIAMCredentialReport
+}
+
+func (c *BronzeAWSIAMCredentialReportClient) mutate(ctx context.Context, m *BronzeAWSIAMCredentialReportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeAWSIAMCredentialReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeAWSIAMCredentialReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeAWSIAMCredentialReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeAWSIAMCredentialReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("iam: unknown BronzeAWSIAMCredentialReport mutation op: %q", m.Op())
+	}
+}
+
+// BronzeAWSIAMGroupClient is a client for the BronzeAWSIAMGroup schema.
+type BronzeAWSIAMGroupClient struct {
+	config
+}
+
+// NewBronzeAWSIAMGroupClient returns a client for the BronzeAWSIAMGroup from the given config.
+func NewBronzeAWSIAMGroupClient(c config) *BronzeAWSIAMGroupClient {
+	return &BronzeAWSIAMGroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzeawsiamgroup.Hooks(f(g(h())))`.
+func (c *BronzeAWSIAMGroupClient) Use(hooks ...Hook) {
+	c.hooks.BronzeAWSIAMGroup = append(c.hooks.BronzeAWSIAMGroup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzeawsiamgroup.Intercept(f(g(h())))`.
+func (c *BronzeAWSIAMGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeAWSIAMGroup = append(c.inters.BronzeAWSIAMGroup, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeAWSIAMGroup entity.
+func (c *BronzeAWSIAMGroupClient) Create() *BronzeAWSIAMGroupCreate {
+	mutation := newBronzeAWSIAMGroupMutation(c.config, OpCreate)
+	return &BronzeAWSIAMGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeAWSIAMGroup entities.
+func (c *BronzeAWSIAMGroupClient) CreateBulk(builders ...*BronzeAWSIAMGroupCreate) *BronzeAWSIAMGroupCreateBulk {
+	return &BronzeAWSIAMGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeAWSIAMGroupClient) MapCreateBulk(slice any, setFunc func(*BronzeAWSIAMGroupCreate, int)) *BronzeAWSIAMGroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeAWSIAMGroupCreateBulk{err: fmt.Errorf("calling to BronzeAWSIAMGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeAWSIAMGroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeAWSIAMGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeAWSIAMGroup.
+func (c *BronzeAWSIAMGroupClient) Update() *BronzeAWSIAMGroupUpdate {
+	mutation := newBronzeAWSIAMGroupMutation(c.config, OpUpdate)
+	return &BronzeAWSIAMGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeAWSIAMGroupClient) UpdateOne(_m *BronzeAWSIAMGroup) *BronzeAWSIAMGroupUpdateOne {
+	mutation := newBronzeAWSIAMGroupMutation(c.config, OpUpdateOne, withBronzeAWSIAMGroup(_m))
+	return &BronzeAWSIAMGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeAWSIAMGroupClient) UpdateOneID(id string) *BronzeAWSIAMGroupUpdateOne {
+	mutation := newBronzeAWSIAMGroupMutation(c.config, OpUpdateOne, withBronzeAWSIAMGroupID(id))
+	return &BronzeAWSIAMGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeAWSIAMGroup.
+func (c *BronzeAWSIAMGroupClient) Delete() *BronzeAWSIAMGroupDelete {
+	mutation := newBronzeAWSIAMGroupMutation(c.config, OpDelete)
+	return &BronzeAWSIAMGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeAWSIAMGroupClient) DeleteOne(_m *BronzeAWSIAMGroup) *BronzeAWSIAMGroupDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeAWSIAMGroupClient) DeleteOneID(id string) *BronzeAWSIAMGroupDeleteOne {
+	builder := c.Delete().Where(bronzeawsiamgroup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeAWSIAMGroupDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeAWSIAMGroup.
+func (c *BronzeAWSIAMGroupClient) Query() *BronzeAWSIAMGroupQuery {
+	return &BronzeAWSIAMGroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeAWSIAMGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeAWSIAMGroup entity by its id.
+func (c *BronzeAWSIAMGroupClient) Get(ctx context.Context, id string) (*BronzeAWSIAMGroup, error) {
+	return c.Query().Where(bronzeawsiamgroup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeAWSIAMGroupClient) GetX(ctx context.Context, id string) *BronzeAWSIAMGroup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeAWSIAMGroupClient) Hooks() []Hook {
+	return c.hooks.BronzeAWSIAMGroup
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeAWSIAMGroupClient) Interceptors() []Interceptor {
+	return c.inters.BronzeAWSIAMGroup
+}
+
+func (c *BronzeAWSIAMGroupClient) mutate(ctx context.Context, m *BronzeAWSIAMGroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeAWSIAMGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeAWSIAMGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeAWSIAMGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeAWSIAMGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("iam: unknown BronzeAWSIAMGroup mutation op: %q", m.Op())
+	}
+}
+
+// BronzeAWSIAMPolicyClient is a client for the BronzeAWSIAMPolicy schema.
+type BronzeAWSIAMPolicyClient struct {
+	config
+}
+
+// NewBronzeAWSIAMPolicyClient returns a client for the BronzeAWSIAMPolicy from the given config.
+func NewBronzeAWSIAMPolicyClient(c config) *BronzeAWSIAMPolicyClient {
+	return &BronzeAWSIAMPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzeawsiampolicy.Hooks(f(g(h())))`.
+func (c *BronzeAWSIAMPolicyClient) Use(hooks ...Hook) {
+	c.hooks.BronzeAWSIAMPolicy = append(c.hooks.BronzeAWSIAMPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzeawsiampolicy.Intercept(f(g(h())))`.
+func (c *BronzeAWSIAMPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeAWSIAMPolicy = append(c.inters.BronzeAWSIAMPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeAWSIAMPolicy entity.
+func (c *BronzeAWSIAMPolicyClient) Create() *BronzeAWSIAMPolicyCreate {
+	mutation := newBronzeAWSIAMPolicyMutation(c.config, OpCreate)
+	return &BronzeAWSIAMPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeAWSIAMPolicy entities.
+func (c *BronzeAWSIAMPolicyClient) CreateBulk(builders ...*BronzeAWSIAMPolicyCreate) *BronzeAWSIAMPolicyCreateBulk {
+	return &BronzeAWSIAMPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeAWSIAMPolicyClient) MapCreateBulk(slice any, setFunc func(*BronzeAWSIAMPolicyCreate, int)) *BronzeAWSIAMPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeAWSIAMPolicyCreateBulk{err: fmt.Errorf("calling to BronzeAWSIAMPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeAWSIAMPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeAWSIAMPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeAWSIAMPolicy.
+func (c *BronzeAWSIAMPolicyClient) Update() *BronzeAWSIAMPolicyUpdate {
+	mutation := newBronzeAWSIAMPolicyMutation(c.config, OpUpdate)
+	return &BronzeAWSIAMPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeAWSIAMPolicyClient) UpdateOne(_m *BronzeAWSIAMPolicy) *BronzeAWSIAMPolicyUpdateOne {
+	mutation := newBronzeAWSIAMPolicyMutation(c.config, OpUpdateOne, withBronzeAWSIAMPolicy(_m))
+	return &BronzeAWSIAMPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeAWSIAMPolicyClient) UpdateOneID(id string) *BronzeAWSIAMPolicyUpdateOne {
+	mutation := newBronzeAWSIAMPolicyMutation(c.config, OpUpdateOne, withBronzeAWSIAMPolicyID(id))
+	return &BronzeAWSIAMPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeAWSIAMPolicy.
+func (c *BronzeAWSIAMPolicyClient) Delete() *BronzeAWSIAMPolicyDelete {
+	mutation := newBronzeAWSIAMPolicyMutation(c.config, OpDelete)
+	return &BronzeAWSIAMPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeAWSIAMPolicyClient) DeleteOne(_m *BronzeAWSIAMPolicy) *BronzeAWSIAMPolicyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeAWSIAMPolicyClient) DeleteOneID(id string) *BronzeAWSIAMPolicyDeleteOne {
+	builder := c.Delete().Where(bronzeawsiampolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeAWSIAMPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeAWSIAMPolicy.
+func (c *BronzeAWSIAMPolicyClient) Query() *BronzeAWSIAMPolicyQuery {
+	return &BronzeAWSIAMPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeAWSIAMPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeAWSIAMPolicy entity by its id.
+func (c *BronzeAWSIAMPolicyClient) Get(ctx context.Context, id string) (*BronzeAWSIAMPolicy, error) {
+	return c.Query().Where(bronzeawsiampolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeAWSIAMPolicyClient) GetX(ctx context.Context, id string) *BronzeAWSIAMPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeAWSIAMPolicyClient) Hooks() []Hook {
+	return c.hooks.BronzeAWSIAMPolicy
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeAWSIAMPolicyClient) Interceptors() []Interceptor {
+	return c.inters.BronzeAWSIAMPolicy
+}
+
+func (c *BronzeAWSIAMPolicyClient) mutate(ctx context.Context, m *BronzeAWSIAMPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeAWSIAMPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeAWSIAMPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeAWSIAMPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeAWSIAMPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("iam: unknown BronzeAWSIAMPolicy mutation op: %q", m.Op())
+	}
+}
+
+// BronzeAWSIAMRoleClient is a client for the BronzeAWSIAMRole schema.
+type BronzeAWSIAMRoleClient struct {
+	config
+}
+
+// NewBronzeAWSIAMRoleClient returns a client for the BronzeAWSIAMRole from the given config.
+func NewBronzeAWSIAMRoleClient(c config) *BronzeAWSIAMRoleClient {
+	return &BronzeAWSIAMRoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzeawsiamrole.Hooks(f(g(h())))`.
+func (c *BronzeAWSIAMRoleClient) Use(hooks ...Hook) {
+	c.hooks.BronzeAWSIAMRole = append(c.hooks.BronzeAWSIAMRole, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzeawsiamrole.Intercept(f(g(h())))`.
+func (c *BronzeAWSIAMRoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeAWSIAMRole = append(c.inters.BronzeAWSIAMRole, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeAWSIAMRole entity.
+func (c *BronzeAWSIAMRoleClient) Create() *BronzeAWSIAMRoleCreate {
+	mutation := newBronzeAWSIAMRoleMutation(c.config, OpCreate)
+	return &BronzeAWSIAMRoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeAWSIAMRole entities.
+func (c *BronzeAWSIAMRoleClient) CreateBulk(builders ...*BronzeAWSIAMRoleCreate) *BronzeAWSIAMRoleCreateBulk {
+	return &BronzeAWSIAMRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeAWSIAMRoleClient) MapCreateBulk(slice any, setFunc func(*BronzeAWSIAMRoleCreate, int)) *BronzeAWSIAMRoleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeAWSIAMRoleCreateBulk{err: fmt.Errorf("calling to BronzeAWSIAMRoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeAWSIAMRoleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeAWSIAMRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeAWSIAMRole.
+func (c *BronzeAWSIAMRoleClient) Update() *BronzeAWSIAMRoleUpdate {
+	mutation := newBronzeAWSIAMRoleMutation(c.config, OpUpdate)
+	return &BronzeAWSIAMRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeAWSIAMRoleClient) UpdateOne(_m *BronzeAWSIAMRole) *BronzeAWSIAMRoleUpdateOne {
+	mutation := newBronzeAWSIAMRoleMutation(c.config, OpUpdateOne, withBronzeAWSIAMRole(_m))
+	return &BronzeAWSIAMRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeAWSIAMRoleClient) UpdateOneID(id string) *BronzeAWSIAMRoleUpdateOne {
+	mutation := newBronzeAWSIAMRoleMutation(c.config, OpUpdateOne, withBronzeAWSIAMRoleID(id))
+	return &BronzeAWSIAMRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeAWSIAMRole.
+func (c *BronzeAWSIAMRoleClient) Delete() *BronzeAWSIAMRoleDelete {
+	mutation := newBronzeAWSIAMRoleMutation(c.config, OpDelete)
+	return &BronzeAWSIAMRoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeAWSIAMRoleClient) DeleteOne(_m *BronzeAWSIAMRole) *BronzeAWSIAMRoleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeAWSIAMRoleClient) DeleteOneID(id string) *BronzeAWSIAMRoleDeleteOne {
+	builder := c.Delete().Where(bronzeawsiamrole.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeAWSIAMRoleDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeAWSIAMRole.
+func (c *BronzeAWSIAMRoleClient) Query() *BronzeAWSIAMRoleQuery {
+	return &BronzeAWSIAMRoleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeAWSIAMRole},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeAWSIAMRole entity by its id.
+func (c *BronzeAWSIAMRoleClient) Get(ctx context.Context, id string) (*BronzeAWSIAMRole, error) {
+	return c.Query().Where(bronzeawsiamrole.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeAWSIAMRoleClient) GetX(ctx context.Context, id string) *BronzeAWSIAMRole {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeAWSIAMRoleClient) Hooks() []Hook {
+	return c.hooks.BronzeAWSIAMRole
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeAWSIAMRoleClient) Interceptors() []Interceptor {
+	return c.inters.BronzeAWSIAMRole
+}
+
+func (c *BronzeAWSIAMRoleClient) mutate(ctx context.Context, m *BronzeAWSIAMRoleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeAWSIAMRoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeAWSIAMRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeAWSIAMRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeAWSIAMRoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("iam: unknown BronzeAWSIAMRole mutation op: %q", m.Op())
+	}
+}
+
+// BronzeAWSIAMUserClient is a client for the BronzeAWSIAMUser schema.
+type BronzeAWSIAMUserClient struct {
+	config
+}
+
+// NewBronzeAWSIAMUserClient returns a client for the BronzeAWSIAMUser from the given config.
+func NewBronzeAWSIAMUserClient(c config) *BronzeAWSIAMUserClient {
+	return &BronzeAWSIAMUserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzeawsiamuser.Hooks(f(g(h())))`.
+func (c *BronzeAWSIAMUserClient) Use(hooks ...Hook) {
+	c.hooks.BronzeAWSIAMUser = append(c.hooks.BronzeAWSIAMUser, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzeawsiamuser.Intercept(f(g(h())))`.
+func (c *BronzeAWSIAMUserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeAWSIAMUser = append(c.inters.BronzeAWSIAMUser, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeAWSIAMUser entity.
+func (c *BronzeAWSIAMUserClient) Create() *BronzeAWSIAMUserCreate {
+	mutation := newBronzeAWSIAMUserMutation(c.config, OpCreate)
+	return &BronzeAWSIAMUserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeAWSIAMUser entities.
+func (c *BronzeAWSIAMUserClient) CreateBulk(builders ...*BronzeAWSIAMUserCreate) *BronzeAWSIAMUserCreateBulk {
+	return &BronzeAWSIAMUserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeAWSIAMUserClient) MapCreateBulk(slice any, setFunc func(*BronzeAWSIAMUserCreate, int)) *BronzeAWSIAMUserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeAWSIAMUserCreateBulk{err: fmt.Errorf("calling to BronzeAWSIAMUserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeAWSIAMUserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeAWSIAMUserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeAWSIAMUser.
+func (c *BronzeAWSIAMUserClient) Update() *BronzeAWSIAMUserUpdate {
+	mutation := newBronzeAWSIAMUserMutation(c.config, OpUpdate)
+	return &BronzeAWSIAMUserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeAWSIAMUserClient) UpdateOne(_m *BronzeAWSIAMUser) *BronzeAWSIAMUserUpdateOne {
+	mutation := newBronzeAWSIAMUserMutation(c.config, OpUpdateOne, withBronzeAWSIAMUser(_m))
+	return &BronzeAWSIAMUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeAWSIAMUserClient) UpdateOneID(id string) *BronzeAWSIAMUserUpdateOne {
+	mutation := newBronzeAWSIAMUserMutation(c.config, OpUpdateOne, withBronzeAWSIAMUserID(id))
+	return &BronzeAWSIAMUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeAWSIAMUser.
+func (c *BronzeAWSIAMUserClient) Delete() *BronzeAWSIAMUserDelete {
+	mutation := newBronzeAWSIAMUserMutation(c.config, OpDelete)
+	return &BronzeAWSIAMUserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeAWSIAMUserClient) DeleteOne(_m *BronzeAWSIAMUser) *BronzeAWSIAMUserDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeAWSIAMUserClient) DeleteOneID(id string) *BronzeAWSIAMUserDeleteOne {
+	builder := c.Delete().Where(bronzeawsiamuser.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeAWSIAMUserDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeAWSIAMUser.
+func (c *BronzeAWSIAMUserClient) Query() *BronzeAWSIAMUserQuery {
+	return &BronzeAWSIAMUserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeAWSIAMUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeAWSIAMUser entity by its id.
+func (c *BronzeAWSIAMUserClient) Get(ctx context.Context, id string) (*BronzeAWSIAMUser, error) {
+	return c.Query().Where(bronzeawsiamuser.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeAWSIAMUserClient) GetX(ctx context.Context, id string) *BronzeAWSIAMUser {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeAWSIAMUserClient) Hooks() []Hook {
+	return c.hooks.BronzeAWSIAMUser
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeAWSIAMUserClient) Interceptors() []Interceptor {
+	return c.inters.BronzeAWSIAMUser
+}
+
+func (c *BronzeAWSIAMUserClient) mutate(ctx context.Context, m *BronzeAWSIAMUserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeAWSIAMUserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeAWSIAMUserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeAWSIAMUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeAWSIAMUserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("iam: unknown BronzeAWSIAMUser mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSIAMAccessKeyClient is a client for the BronzeHistoryAWSIAMAccessKey schema.
+type BronzeHistoryAWSIAMAccessKeyClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSIAMAccessKeyClient returns a client for the BronzeHistoryAWSIAMAccessKey from the given config.
+func NewBronzeHistoryAWSIAMAccessKeyClient(c config) *BronzeHistoryAWSIAMAccessKeyClient {
+	return &BronzeHistoryAWSIAMAccessKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawsiamaccesskey.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSIAMAccessKeyClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSIAMAccessKey = append(c.hooks.BronzeHistoryAWSIAMAccessKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsiamaccesskey.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSIAMAccessKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSIAMAccessKey = append(c.inters.BronzeHistoryAWSIAMAccessKey, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSIAMAccessKey entity.
+func (c *BronzeHistoryAWSIAMAccessKeyClient) Create() *BronzeHistoryAWSIAMAccessKeyCreate {
+	mutation := newBronzeHistoryAWSIAMAccessKeyMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSIAMAccessKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSIAMAccessKey entities.
+func (c *BronzeHistoryAWSIAMAccessKeyClient) CreateBulk(builders ...*BronzeHistoryAWSIAMAccessKeyCreate) *BronzeHistoryAWSIAMAccessKeyCreateBulk {
+	return &BronzeHistoryAWSIAMAccessKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSIAMAccessKeyClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSIAMAccessKeyCreate, int)) *BronzeHistoryAWSIAMAccessKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSIAMAccessKeyCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSIAMAccessKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSIAMAccessKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSIAMAccessKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSIAMAccessKey.
+func (c *BronzeHistoryAWSIAMAccessKeyClient) Update() *BronzeHistoryAWSIAMAccessKeyUpdate {
+	mutation := newBronzeHistoryAWSIAMAccessKeyMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSIAMAccessKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSIAMAccessKeyClient) UpdateOne(_m *BronzeHistoryAWSIAMAccessKey) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	mutation := newBronzeHistoryAWSIAMAccessKeyMutation(c.config, OpUpdateOne, withBronzeHistoryAWSIAMAccessKey(_m))
+	return &BronzeHistoryAWSIAMAccessKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSIAMAccessKeyClient) UpdateOneID(id uint) *BronzeHistoryAWSIAMAccessKeyUpdateOne {
+	mutation := newBronzeHistoryAWSIAMAccessKeyMutation(c.config, OpUpdateOne, withBronzeHistoryAWSIAMAccessKeyID(id))
+	return &BronzeHistoryAWSIAMAccessKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSIAMAccessKey.
+func (c *BronzeHistoryAWSIAMAccessKeyClient) Delete() *BronzeHistoryAWSIAMAccessKeyDelete {
+	mutation := newBronzeHistoryAWSIAMAccessKeyMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSIAMAccessKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSIAMAccessKeyClient) DeleteOne(_m *BronzeHistoryAWSIAMAccessKey) *BronzeHistoryAWSIAMAccessKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSIAMAccessKeyClient) DeleteOneID(id uint) *BronzeHistoryAWSIAMAccessKeyDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawsiamaccesskey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSIAMAccessKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSIAMAccessKey.
+func (c *BronzeHistoryAWSIAMAccessKeyClient) Query() *BronzeHistoryAWSIAMAccessKeyQuery {
+	return &BronzeHistoryAWSIAMAccessKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSIAMAccessKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSIAMAccessKey entity by its id.
+func (c *BronzeHistoryAWSIAMAccessKeyClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSIAMAccessKey, error) {
+	return c.Query().Where(bronzehistoryawsiamaccesskey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSIAMAccessKeyClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSIAMAccessKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSIAMAccessKeyClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSIAMAccessKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSIAMAccessKeyClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSIAMAccessKey
+}
+
+func (c *BronzeHistoryAWSIAMAccessKeyClient) mutate(ctx context.Context, m *BronzeHistoryAWSIAMAccessKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSIAMAccessKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSIAMAccessKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSIAMAccessKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSIAMAccessKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("iam: unknown BronzeHistoryAWSIAMAccessKey mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSIAMCredentialReportClient is a client for the BronzeHistoryAWSIAMCredentialReport schema.
+type BronzeHistoryAWSIAMCredentialReportClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSIAMCredentialReportClient returns a client for the BronzeHistoryAWSIAMCredentialReport from the given config.
+func NewBronzeHistoryAWSIAMCredentialReportClient(c config) *BronzeHistoryAWSIAMCredentialReportClient {
+	return &BronzeHistoryAWSIAMCredentialReportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawsiamcredentialreport.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSIAMCredentialReportClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSIAMCredentialReport = append(c.hooks.BronzeHistoryAWSIAMCredentialReport, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsiamcredentialreport.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSIAMCredentialReportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSIAMCredentialReport = append(c.inters.BronzeHistoryAWSIAMCredentialReport, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSIAMCredentialReport entity.
+func (c *BronzeHistoryAWSIAMCredentialReportClient) Create() *BronzeHistoryAWSIAMCredentialReportCreate {
+	mutation := newBronzeHistoryAWSIAMCredentialReportMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSIAMCredentialReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSIAMCredentialReport entities.
+func (c *BronzeHistoryAWSIAMCredentialReportClient) CreateBulk(builders ...*BronzeHistoryAWSIAMCredentialReportCreate) *BronzeHistoryAWSIAMCredentialReportCreateBulk {
+	return &BronzeHistoryAWSIAMCredentialReportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSIAMCredentialReportClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSIAMCredentialReportCreate, int)) *BronzeHistoryAWSIAMCredentialReportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSIAMCredentialReportCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSIAMCredentialReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSIAMCredentialReportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSIAMCredentialReportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSIAMCredentialReport.
+func (c *BronzeHistoryAWSIAMCredentialReportClient) Update() *BronzeHistoryAWSIAMCredentialReportUpdate {
+	mutation := newBronzeHistoryAWSIAMCredentialReportMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSIAMCredentialReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSIAMCredentialReportClient) UpdateOne(_m *BronzeHistoryAWSIAMCredentialReport) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	mutation := newBronzeHistoryAWSIAMCredentialReportMutation(c.config, OpUpdateOne, withBronzeHistoryAWSIAMCredentialReport(_m))
+	return &BronzeHistoryAWSIAMCredentialReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSIAMCredentialReportClient) UpdateOneID(id uint) *BronzeHistoryAWSIAMCredentialReportUpdateOne {
+	mutation := newBronzeHistoryAWSIAMCredentialReportMutation(c.config, OpUpdateOne, withBronzeHistoryAWSIAMCredentialReportID(id))
+	return &BronzeHistoryAWSIAMCredentialReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSIAMCredentialReport.
+func (c *BronzeHistoryAWSIAMCredentialReportClient) Delete() *BronzeHistoryAWSIAMCredentialReportDelete {
+	mutation := newBronzeHistoryAWSIAMCredentialReportMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSIAMCredentialReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSIAMCredentialReportClient) DeleteOne(_m *BronzeHistoryAWSIAMCredentialReport) *BronzeHistoryAWSIAMCredentialReportDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSIAMCredentialReportClient) DeleteOneID(id uint) *BronzeHistoryAWSIAMCredentialReportDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawsiamcredentialreport.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSIAMCredentialReportDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSIAMCredentialReport.
+func (c *BronzeHistoryAWSIAMCredentialReportClient) Query() *BronzeHistoryAWSIAMCredentialReportQuery {
+	return &BronzeHistoryAWSIAMCredentialReportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSIAMCredentialReport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSIAMCredentialReport entity by its id.
+func (c *BronzeHistoryAWSIAMCredentialReportClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSIAMCredentialReport, error) {
+	return c.Query().Where(bronzehistoryawsiamcredentialreport.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSIAMCredentialReportClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSIAMCredentialReport {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSIAMCredentialReportClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSIAMCredentialReport
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSIAMCredentialReportClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSIAMCredentialReport
+}
+
+func (c *BronzeHistoryAWSIAMCredentialReportClient) mutate(ctx context.Context, m *BronzeHistoryAWSIAMCredentialReportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSIAMCredentialReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSIAMCredentialReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSIAMCredentialReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSIAMCredentialReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("iam: unknown BronzeHistoryAWSIAMCredentialReport mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSIAMGroupClient is a client for the BronzeHistoryAWSIAMGroup schema.
+type BronzeHistoryAWSIAMGroupClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSIAMGroupClient returns a client for the BronzeHistoryAWSIAMGroup from the given config.
+func NewBronzeHistoryAWSIAMGroupClient(c config) *BronzeHistoryAWSIAMGroupClient {
+	return &BronzeHistoryAWSIAMGroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawsiamgroup.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSIAMGroupClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSIAMGroup = append(c.hooks.BronzeHistoryAWSIAMGroup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsiamgroup.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSIAMGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSIAMGroup = append(c.inters.BronzeHistoryAWSIAMGroup, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSIAMGroup entity.
+func (c *BronzeHistoryAWSIAMGroupClient) Create() *BronzeHistoryAWSIAMGroupCreate {
+	mutation := newBronzeHistoryAWSIAMGroupMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSIAMGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSIAMGroup entities.
+func (c *BronzeHistoryAWSIAMGroupClient) CreateBulk(builders ...*BronzeHistoryAWSIAMGroupCreate) *BronzeHistoryAWSIAMGroupCreateBulk {
+	return &BronzeHistoryAWSIAMGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSIAMGroupClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSIAMGroupCreate, int)) *BronzeHistoryAWSIAMGroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSIAMGroupCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSIAMGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSIAMGroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSIAMGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSIAMGroup.
+func (c *BronzeHistoryAWSIAMGroupClient) Update() *BronzeHistoryAWSIAMGroupUpdate {
+	mutation := newBronzeHistoryAWSIAMGroupMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSIAMGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSIAMGroupClient) UpdateOne(_m *BronzeHistoryAWSIAMGroup) *BronzeHistoryAWSIAMGroupUpdateOne {
+	mutation := newBronzeHistoryAWSIAMGroupMutation(c.config, OpUpdateOne, withBronzeHistoryAWSIAMGroup(_m))
+	return &BronzeHistoryAWSIAMGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSIAMGroupClient) UpdateOneID(id uint) *BronzeHistoryAWSIAMGroupUpdateOne {
+	mutation := newBronzeHistoryAWSIAMGroupMutation(c.config, OpUpdateOne, withBronzeHistoryAWSIAMGroupID(id))
+	return &BronzeHistoryAWSIAMGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSIAMGroup.
+func (c *BronzeHistoryAWSIAMGroupClient) Delete() *BronzeHistoryAWSIAMGroupDelete {
+	mutation := newBronzeHistoryAWSIAMGroupMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSIAMGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSIAMGroupClient) DeleteOne(_m *BronzeHistoryAWSIAMGroup) *BronzeHistoryAWSIAMGroupDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSIAMGroupClient) DeleteOneID(id uint) *BronzeHistoryAWSIAMGroupDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawsiamgroup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSIAMGroupDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSIAMGroup.
+func (c *BronzeHistoryAWSIAMGroupClient) Query() *BronzeHistoryAWSIAMGroupQuery {
+	return &BronzeHistoryAWSIAMGroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSIAMGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSIAMGroup entity by its id.
+func (c *BronzeHistoryAWSIAMGroupClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSIAMGroup, error) {
+	return c.Query().Where(bronzehistoryawsiamgroup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSIAMGroupClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSIAMGroup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSIAMGroupClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSIAMGroup
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSIAMGroupClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSIAMGroup
+}
+
+func (c *BronzeHistoryAWSIAMGroupClient) mutate(ctx context.Context, m *BronzeHistoryAWSIAMGroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSIAMGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSIAMGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSIAMGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSIAMGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("iam: unknown BronzeHistoryAWSIAMGroup mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSIAMPolicyClient is a client for the BronzeHistoryAWSIAMPolicy schema.
+type BronzeHistoryAWSIAMPolicyClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSIAMPolicyClient returns a client for the BronzeHistoryAWSIAMPolicy from the given config.
+func NewBronzeHistoryAWSIAMPolicyClient(c config) *BronzeHistoryAWSIAMPolicyClient {
+	return &BronzeHistoryAWSIAMPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawsiampolicy.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSIAMPolicyClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSIAMPolicy = append(c.hooks.BronzeHistoryAWSIAMPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsiampolicy.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSIAMPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSIAMPolicy = append(c.inters.BronzeHistoryAWSIAMPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSIAMPolicy entity.
+func (c *BronzeHistoryAWSIAMPolicyClient) Create() *BronzeHistoryAWSIAMPolicyCreate {
+	mutation := newBronzeHistoryAWSIAMPolicyMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSIAMPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSIAMPolicy entities.
+func (c *BronzeHistoryAWSIAMPolicyClient) CreateBulk(builders ...*BronzeHistoryAWSIAMPolicyCreate) *BronzeHistoryAWSIAMPolicyCreateBulk {
+	return &BronzeHistoryAWSIAMPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSIAMPolicyClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSIAMPolicyCreate, int)) *BronzeHistoryAWSIAMPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSIAMPolicyCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSIAMPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSIAMPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSIAMPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSIAMPolicy.
+func (c *BronzeHistoryAWSIAMPolicyClient) Update() *BronzeHistoryAWSIAMPolicyUpdate {
+	mutation := newBronzeHistoryAWSIAMPolicyMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSIAMPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSIAMPolicyClient) UpdateOne(_m *BronzeHistoryAWSIAMPolicy) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	mutation := newBronzeHistoryAWSIAMPolicyMutation(c.config, OpUpdateOne, withBronzeHistoryAWSIAMPolicy(_m))
+	return &BronzeHistoryAWSIAMPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSIAMPolicyClient) UpdateOneID(id uint) *BronzeHistoryAWSIAMPolicyUpdateOne {
+	mutation := newBronzeHistoryAWSIAMPolicyMutation(c.config, OpUpdateOne, withBronzeHistoryAWSIAMPolicyID(id))
+	return &BronzeHistoryAWSIAMPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSIAMPolicy.
+func (c *BronzeHistoryAWSIAMPolicyClient) Delete() *BronzeHistoryAWSIAMPolicyDelete {
+	mutation := newBronzeHistoryAWSIAMPolicyMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSIAMPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSIAMPolicyClient) DeleteOne(_m *BronzeHistoryAWSIAMPolicy) *BronzeHistoryAWSIAMPolicyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSIAMPolicyClient) DeleteOneID(id uint) *BronzeHistoryAWSIAMPolicyDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawsiampolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSIAMPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSIAMPolicy.
+func (c *BronzeHistoryAWSIAMPolicyClient) Query() *BronzeHistoryAWSIAMPolicyQuery {
+	return &BronzeHistoryAWSIAMPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSIAMPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSIAMPolicy entity by its id.
+func (c *BronzeHistoryAWSIAMPolicyClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSIAMPolicy, error) {
+	return c.Query().Where(bronzehistoryawsiampolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSIAMPolicyClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSIAMPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSIAMPolicyClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSIAMPolicy
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSIAMPolicyClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSIAMPolicy
+}
+
+func (c *BronzeHistoryAWSIAMPolicyClient) mutate(ctx context.Context, m *BronzeHistoryAWSIAMPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSIAMPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSIAMPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSIAMPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSIAMPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("iam: unknown BronzeHistoryAWSIAMPolicy mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSIAMRoleClient is a client for the BronzeHistoryAWSIAMRole schema.
+type BronzeHistoryAWSIAMRoleClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSIAMRoleClient returns a client for the BronzeHistoryAWSIAMRole from the given config.
+func NewBronzeHistoryAWSIAMRoleClient(c config) *BronzeHistoryAWSIAMRoleClient {
+	return &BronzeHistoryAWSIAMRoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawsiamrole.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSIAMRoleClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSIAMRole = append(c.hooks.BronzeHistoryAWSIAMRole, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsiamrole.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSIAMRoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSIAMRole = append(c.inters.BronzeHistoryAWSIAMRole, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSIAMRole entity.
+func (c *BronzeHistoryAWSIAMRoleClient) Create() *BronzeHistoryAWSIAMRoleCreate {
+	mutation := newBronzeHistoryAWSIAMRoleMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSIAMRoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSIAMRole entities.
+func (c *BronzeHistoryAWSIAMRoleClient) CreateBulk(builders ...*BronzeHistoryAWSIAMRoleCreate) *BronzeHistoryAWSIAMRoleCreateBulk {
+	return &BronzeHistoryAWSIAMRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSIAMRoleClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSIAMRoleCreate, int)) *BronzeHistoryAWSIAMRoleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSIAMRoleCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSIAMRoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSIAMRoleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSIAMRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSIAMRole.
+func (c *BronzeHistoryAWSIAMRoleClient) Update() *BronzeHistoryAWSIAMRoleUpdate {
+	mutation := newBronzeHistoryAWSIAMRoleMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSIAMRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSIAMRoleClient) UpdateOne(_m *BronzeHistoryAWSIAMRole) *BronzeHistoryAWSIAMRoleUpdateOne {
+	mutation := newBronzeHistoryAWSIAMRoleMutation(c.config, OpUpdateOne, withBronzeHistoryAWSIAMRole(_m))
+	return &BronzeHistoryAWSIAMRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSIAMRoleClient) UpdateOneID(id uint) *BronzeHistoryAWSIAMRoleUpdateOne {
+	mutation := newBronzeHistoryAWSIAMRoleMutation(c.config, OpUpdateOne, withBronzeHistoryAWSIAMRoleID(id))
+	return &BronzeHistoryAWSIAMRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSIAMRole.
+func (c *BronzeHistoryAWSIAMRoleClient) Delete() *BronzeHistoryAWSIAMRoleDelete {
+	mutation := newBronzeHistoryAWSIAMRoleMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSIAMRoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSIAMRoleClient) DeleteOne(_m *BronzeHistoryAWSIAMRole) *BronzeHistoryAWSIAMRoleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSIAMRoleClient) DeleteOneID(id uint) *BronzeHistoryAWSIAMRoleDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawsiamrole.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSIAMRoleDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSIAMRole.
+func (c *BronzeHistoryAWSIAMRoleClient) Query() *BronzeHistoryAWSIAMRoleQuery {
+	return &BronzeHistoryAWSIAMRoleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSIAMRole},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSIAMRole entity by its id.
+func (c *BronzeHistoryAWSIAMRoleClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSIAMRole, error) {
+	return c.Query().Where(bronzehistoryawsiamrole.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSIAMRoleClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSIAMRole {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSIAMRoleClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSIAMRole
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSIAMRoleClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSIAMRole
+}
+
+func (c *BronzeHistoryAWSIAMRoleClient) mutate(ctx context.Context, m *BronzeHistoryAWSIAMRoleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSIAMRoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSIAMRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSIAMRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSIAMRoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("iam: unknown BronzeHistoryAWSIAMRole mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSIAMUserClient is a client for the BronzeHistoryAWSIAMUser schema.
+type BronzeHistoryAWSIAMUserClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSIAMUserClient returns a client for the BronzeHistoryAWSIAMUser from the given config.
+func NewBronzeHistoryAWSIAMUserClient(c config) *BronzeHistoryAWSIAMUserClient {
+	return &BronzeHistoryAWSIAMUserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawsiamuser.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSIAMUserClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSIAMUser = append(c.hooks.BronzeHistoryAWSIAMUser, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawsiamuser.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSIAMUserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSIAMUser = append(c.inters.BronzeHistoryAWSIAMUser, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSIAMUser entity.
+func (c *BronzeHistoryAWSIAMUserClient) Create() *BronzeHistoryAWSIAMUserCreate {
+	mutation := newBronzeHistoryAWSIAMUserMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSIAMUserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSIAMUser entities.
+func (c *BronzeHistoryAWSIAMUserClient) CreateBulk(builders ...*BronzeHistoryAWSIAMUserCreate) *BronzeHistoryAWSIAMUserCreateBulk {
+	return &BronzeHistoryAWSIAMUserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSIAMUserClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSIAMUserCreate, int)) *BronzeHistoryAWSIAMUserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSIAMUserCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSIAMUserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSIAMUserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSIAMUserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSIAMUser.
+func (c *BronzeHistoryAWSIAMUserClient) Update() *BronzeHistoryAWSIAMUserUpdate {
+	mutation := newBronzeHistoryAWSIAMUserMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSIAMUserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSIAMUserClient) UpdateOne(_m *BronzeHistoryAWSIAMUser) *BronzeHistoryAWSIAMUserUpdateOne {
+	mutation := newBronzeHistoryAWSIAMUserMutation(c.config, OpUpdateOne, withBronzeHistoryAWSIAMUser(_m))
+	return &BronzeHistoryAWSIAMUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSIAMUserClient) UpdateOneID(id uint) *BronzeHistoryAWSIAMUserUpdateOne {
+	mutation := newBronzeHistoryAWSIAMUserMutation(c.config, OpUpdateOne, withBronzeHistoryAWSIAMUserID(id))
+	return &BronzeHistoryAWSIAMUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSIAMUser.
+func (c *BronzeHistoryAWSIAMUserClient) Delete() *BronzeHistoryAWSIAMUserDelete {
+	mutation := newBronzeHistoryAWSIAMUserMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSIAMUserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSIAMUserClient) DeleteOne(_m *BronzeHistoryAWSIAMUser) *BronzeHistoryAWSIAMUserDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSIAMUserClient) DeleteOneID(id uint) *BronzeHistoryAWSIAMUserDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawsiamuser.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSIAMUserDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSIAMUser.
+func (c *BronzeHistoryAWSIAMUserClient) Query() *BronzeHistoryAWSIAMUserQuery {
+	return &BronzeHistoryAWSIAMUserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSIAMUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSIAMUser entity by its id.
+func (c *BronzeHistoryAWSIAMUserClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSIAMUser, error) {
+	return c.Query().Where(bronzehistoryawsiamuser.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSIAMUserClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSIAMUser {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSIAMUserClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSIAMUser
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSIAMUserClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSIAMUser
+}
+
+func (c *BronzeHistoryAWSIAMUserClient) mutate(ctx context.Context, m *BronzeHistoryAWSIAMUserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSIAMUserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSIAMUserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSIAMUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSIAMUserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("iam: unknown BronzeHistoryAWSIAMUser mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		BronzeAWSIAMAccessKey, BronzeAWSIAMCredentialReport, BronzeAWSIAMGroup,
+		BronzeAWSIAMPolicy, BronzeAWSIAMRole, BronzeAWSIAMUser,
+		BronzeHistoryAWSIAMAccessKey, BronzeHistoryAWSIAMCredentialReport,
+		BronzeHistoryAWSIAMGroup, BronzeHistoryAWSIAMPolicy, BronzeHistoryAWSIAMRole,
+		BronzeHistoryAWSIAMUser []ent.Hook
+	}
+	inters struct {
+		BronzeAWSIAMAccessKey, BronzeAWSIAMCredentialReport, BronzeAWSIAMGroup,
+		BronzeAWSIAMPolicy, BronzeAWSIAMRole, BronzeAWSIAMUser,
+		BronzeHistoryAWSIAMAccessKey, BronzeHistoryAWSIAMCredentialReport,
+		BronzeHistoryAWSIAMGroup, BronzeHistoryAWSIAMPolicy, BronzeHistoryAWSIAMRole,
+		BronzeHistoryAWSIAMUser []ent.Interceptor
+	}
+)
+
+// SchemaConfig represents alternative schema names for all tables
+// that can be passed at runtime.
+type SchemaConfig = internal.SchemaConfig
+
+// AlternateSchemas allows alternate schema names to be
+// passed into ent operations.
+func AlternateSchema(schemaConfig SchemaConfig) Option {
+	return func(c *config) {
+		c.schemaConfig = schemaConfig
+	}
+}
@@ -0,0 +1,331 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hook
+
+import (
+	"context"
+	"fmt"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam"
+)
+
+// The BronzeAWSIAMAccessKeyFunc type is an adapter to allow the use of ordinary
+// function as BronzeAWSIAMAccessKey mutator.
+type BronzeAWSIAMAccessKeyFunc func(context.Context, *iam.BronzeAWSIAMAccessKeyMutation) (iam.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeAWSIAMAccessKeyFunc) Mutate(ctx context.Context, m iam.Mutation) (iam.Value, error) {
+	if mv, ok := m.(*iam.BronzeAWSIAMAccessKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *iam.BronzeAWSIAMAccessKeyMutation", m)
+}
+
+// The BronzeAWSIAMCredentialReportFunc type is an adapter to allow the use of ordinary
+// function as BronzeAWSIAMCredentialReport mutator.
+type BronzeAWSIAMCredentialReportFunc func(context.Context, *iam.BronzeAWSIAMCredentialReportMutation) (iam.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeAWSIAMCredentialReportFunc) Mutate(ctx context.Context, m iam.Mutation) (iam.Value, error) {
+	if mv, ok := m.(*iam.BronzeAWSIAMCredentialReportMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *iam.BronzeAWSIAMCredentialReportMutation", m)
+}
+
+// The BronzeAWSIAMGroupFunc type is an adapter to allow the use of ordinary
+// function as BronzeAWSIAMGroup mutator.
+type BronzeAWSIAMGroupFunc func(context.Context, *iam.BronzeAWSIAMGroupMutation) (iam.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeAWSIAMGroupFunc) Mutate(ctx context.Context, m iam.Mutation) (iam.Value, error) {
+	if mv, ok := m.(*iam.BronzeAWSIAMGroupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *iam.BronzeAWSIAMGroupMutation", m)
+}
+
+// The BronzeAWSIAMPolicyFunc type is an adapter to allow the use of ordinary
+// function as BronzeAWSIAMPolicy mutator.
+type BronzeAWSIAMPolicyFunc func(context.Context, *iam.BronzeAWSIAMPolicyMutation) (iam.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeAWSIAMPolicyFunc) Mutate(ctx context.Context, m iam.Mutation) (iam.Value, error) {
+	if mv, ok := m.(*iam.BronzeAWSIAMPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *iam.BronzeAWSIAMPolicyMutation", m)
+}
+
+// The BronzeAWSIAMRoleFunc type is an adapter to allow the use of ordinary
+// function as BronzeAWSIAMRole mutator.
+type BronzeAWSIAMRoleFunc func(context.Context, *iam.BronzeAWSIAMRoleMutation) (iam.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeAWSIAMRoleFunc) Mutate(ctx context.Context, m iam.Mutation) (iam.Value, error) {
+	if mv, ok := m.(*iam.BronzeAWSIAMRoleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *iam.BronzeAWSIAMRoleMutation", m)
+}
+
+// The BronzeAWSIAMUserFunc type is an adapter to allow the use of ordinary
+// function as BronzeAWSIAMUser mutator.
+type BronzeAWSIAMUserFunc func(context.Context, *iam.BronzeAWSIAMUserMutation) (iam.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeAWSIAMUserFunc) Mutate(ctx context.Context, m iam.Mutation) (iam.Value, error) {
+	if mv, ok := m.(*iam.BronzeAWSIAMUserMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *iam.BronzeAWSIAMUserMutation", m)
+}
+
+// The BronzeHistoryAWSIAMAccessKeyFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryAWSIAMAccessKey mutator.
+type BronzeHistoryAWSIAMAccessKeyFunc func(context.Context, *iam.BronzeHistoryAWSIAMAccessKeyMutation) (iam.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryAWSIAMAccessKeyFunc) Mutate(ctx context.Context, m iam.Mutation) (iam.Value, error) {
+	if mv, ok := m.(*iam.BronzeHistoryAWSIAMAccessKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *iam.BronzeHistoryAWSIAMAccessKeyMutation", m)
+}
+
+// The BronzeHistoryAWSIAMCredentialReportFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryAWSIAMCredentialReport mutator.
+type BronzeHistoryAWSIAMCredentialReportFunc func(context.Context, *iam.BronzeHistoryAWSIAMCredentialReportMutation) (iam.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryAWSIAMCredentialReportFunc) Mutate(ctx context.Context, m iam.Mutation) (iam.Value, error) {
+	if mv, ok := m.(*iam.BronzeHistoryAWSIAMCredentialReportMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *iam.BronzeHistoryAWSIAMCredentialReportMutation", m)
+}
+
+// The BronzeHistoryAWSIAMGroupFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryAWSIAMGroup mutator.
+type BronzeHistoryAWSIAMGroupFunc func(context.Context, *iam.BronzeHistoryAWSIAMGroupMutation) (iam.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryAWSIAMGroupFunc) Mutate(ctx context.Context, m iam.Mutation) (iam.Value, error) {
+	if mv, ok := m.(*iam.BronzeHistoryAWSIAMGroupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *iam.BronzeHistoryAWSIAMGroupMutation", m)
+}
+
+// The BronzeHistoryAWSIAMPolicyFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryAWSIAMPolicy mutator.
+type BronzeHistoryAWSIAMPolicyFunc func(context.Context, *iam.BronzeHistoryAWSIAMPolicyMutation) (iam.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryAWSIAMPolicyFunc) Mutate(ctx context.Context, m iam.Mutation) (iam.Value, error) {
+	if mv, ok := m.(*iam.BronzeHistoryAWSIAMPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *iam.BronzeHistoryAWSIAMPolicyMutation", m)
+}
+
+// The BronzeHistoryAWSIAMRoleFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryAWSIAMRole mutator.
+type BronzeHistoryAWSIAMRoleFunc func(context.Context, *iam.BronzeHistoryAWSIAMRoleMutation) (iam.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryAWSIAMRoleFunc) Mutate(ctx context.Context, m iam.Mutation) (iam.Value, error) {
+	if mv, ok := m.(*iam.BronzeHistoryAWSIAMRoleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *iam.BronzeHistoryAWSIAMRoleMutation", m)
+}
+
+// The BronzeHistoryAWSIAMUserFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryAWSIAMUser mutator.
+type BronzeHistoryAWSIAMUserFunc func(context.Context, *iam.BronzeHistoryAWSIAMUserMutation) (iam.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryAWSIAMUserFunc) Mutate(ctx context.Context, m iam.Mutation) (iam.Value, error) {
+	if mv, ok := m.(*iam.BronzeHistoryAWSIAMUserMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *iam.BronzeHistoryAWSIAMUserMutation", m)
+}
+
+// Condition is a hook condition function.
+type Condition func(context.Context, iam.Mutation) bool
+
+// And groups conditions with the AND operator.
+func And(first, second Condition, rest ...Condition) Condition {
+	return func(ctx context.Context, m iam.Mutation) bool {
+		if !first(ctx, m) || !second(ctx, m) {
+			return false
+		}
+		for _, cond := range rest {
+			if !cond(ctx, m) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or groups conditions with the OR operator.
+func Or(first, second Condition, rest ...Condition) Condition {
+	return func(ctx context.Context, m iam.Mutation) bool {
+		if first(ctx, m) || second(ctx, m) {
+			return true
+		}
+		for _, cond := range rest {
+			if cond(ctx, m) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not negates a given condition.
+func Not(cond Condition) Condition {
+	return func(ctx context.Context, m iam.Mutation) bool {
+		return !cond(ctx, m)
+	}
+}
+
+// HasOp is a condition testing mutation operation.
+func HasOp(op iam.Op) Condition {
+	return func(_ context.Context, m iam.Mutation) bool {
+		return m.Op().Is(op)
+	}
+}
+
+// HasAddedFields is a condition validating `.AddedField` on fields.
+func HasAddedFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m iam.Mutation) bool {
+		if _, exists := m.AddedField(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if _, exists := m.AddedField(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// HasClearedFields is a condition validating `.FieldCleared` on fields.
+func HasClearedFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m iam.Mutation) bool {
+		if exists := m.FieldCleared(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if exists := m.FieldCleared(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// HasFields is a condition validating `.Field` on fields.
+func HasFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m iam.Mutation) bool {
+		if _, exists := m.Field(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if _, exists := m.Field(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// If executes the given hook under condition.
+//
+//	hook.If(ComputeAverage, And(HasFields(...), HasAddedFields(...)))
+func If(hk iam.Hook, cond Condition) iam.Hook {
+	return func(next iam.Mutator) iam.Mutator {
+		return iam.MutateFunc(func(ctx context.Context, m iam.Mutation) (iam.Value, error) {
+			if cond(ctx, m) {
+				return hk(next).Mutate(ctx, m)
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// On executes the given hook only for the given operation.
+//
+//	hook.On(Log, iam.Delete|iam.Create)
+func On(hk iam.Hook, op iam.Op) iam.Hook {
+	return If(hk, HasOp(op))
+}
+
+// Unless skips the given hook only for the given operation.
+//
+//	hook.Unless(Log, iam.Update|iam.UpdateOne)
+func Unless(hk iam.Hook, op iam.Op) iam.Hook {
+	return If(hk, Not(HasOp(op)))
+}
+
+// FixedError is a hook returning a fixed error.
+func FixedError(err error) iam.Hook {
+	return func(iam.Mutator) iam.Mutator {
+		return iam.MutateFunc(func(context.Context, iam.Mutation) (iam.Value, error) {
+			return nil, err
+		})
+	}
+}
+
+// Reject returns a hook that rejects all operations that match op.
+//
+//	func (T) Hooks() []iam.Hook {
+//		return []iam.Hook{
+//			Reject(iam.Delete|iam.Update),
+//		}
+//	}
+func Reject(op iam.Op) iam.Hook {
+	hk := FixedError(fmt.Errorf("%s operation is not allowed", op))
+	return On(hk, op)
+}
+
+// Chain acts as a list of hooks and is effectively immutable.
+// Once created, it will always hold the same set of hooks in the same order.
+type Chain struct {
+	hooks []iam.Hook
+}
+
+// NewChain creates a new chain of hooks.
+func NewChain(hooks ...iam.Hook) Chain {
+	return Chain{append([]iam.Hook(nil), hooks...)}
+}
+
+// Hook chains the list of hooks and returns the final hook.
+func (c Chain) Hook() iam.Hook {
+	return func(mutator iam.Mutator) iam.Mutator {
+		for i := len(c.hooks) - 1; i >= 0; i-- {
+			mutator = c.hooks[i](mutator)
+		}
+		return mutator
+	}
+}
+
+// Append extends a chain, adding the specified hook
+// as the last ones in the mutation flow.
+func (c Chain) Append(hooks ...iam.Hook) Chain {
+	newHooks := make([]iam.Hook, 0, len(c.hooks)+len(hooks))
+	newHooks = append(newHooks, c.hooks...)
+	newHooks = append(newHooks, hooks...)
+	return Chain{newHooks}
+}
+
+// Extend extends a chain, adding the specified chain
+// as the last ones in the mutation flow.
+func (c Chain) Extend(chain Chain) Chain {
+	return c.Append(chain.hooks...)
+}
@@ -0,0 +1,35 @@
+// Code generated by ent, DO NOT EDIT.
+
+package internal
+
+import "context"
+
+// SchemaConfig represents alternative schema names for all tables
+// that can be passed at runtime.
+type SchemaConfig struct {
+	BronzeAWSIAMAccessKey               string // BronzeAWSIAMAccessKey table.
+	BronzeAWSIAMCredentialReport        string // BronzeAWSIAMCredentialReport table.
+	BronzeAWSIAMGroup                   string // BronzeAWSIAMGroup table.
+	BronzeAWSIAMPolicy                  string // BronzeAWSIAMPolicy table.
+	BronzeAWSIAMRole                    string // BronzeAWSIAMRole table.
+	BronzeAWSIAMUser                    string // BronzeAWSIAMUser table.
+	BronzeHistoryAWSIAMAccessKey        string // BronzeHistoryAWSIAMAccessKey table.
+	BronzeHistoryAWSIAMCredentialReport string // BronzeHistoryAWSIAMCredentialReport table.
+	BronzeHistoryAWSIAMGroup            string // BronzeHistoryAWSIAMGroup table.
+	BronzeHistoryAWSIAMPolicy           string // BronzeHistoryAWSIAMPolicy table.
+	BronzeHistoryAWSIAMRole             string // BronzeHistoryAWSIAMRole table.
+	BronzeHistoryAWSIAMUser             string // BronzeHistoryAWSIAMUser table.
+}
+
+type schemaCtxKey struct{}
+
+// SchemaConfigFromContext returns a SchemaConfig stored inside a context, or empty if there isn't one.
+func SchemaConfigFromContext(ctx context.Context) SchemaConfig {
+	config, _ := ctx.Value(schemaCtxKey{}).(SchemaConfig)
+	return config
+}
+
+// NewSchemaConfigContext returns a new context with the given SchemaConfig attached.
+func NewSchemaConfigContext(parent context.Context, config SchemaConfig) context.Context {
+	return context.WithValue(parent, schemaCtxKey{}, config)
+}
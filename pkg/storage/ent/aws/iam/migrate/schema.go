@@ -0,0 +1,625 @@
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// AWSIamAccessKeysColumns holds the columns for the "aws_iam_access_keys" table.
+	AWSIamAccessKeysColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "user_name", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString, Nullable: true},
+		{Name: "create_date", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_date", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_service", Type: field.TypeString, Nullable: true},
+		{Name: "last_used_region", Type: field.TypeString, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+	}
+	// AWSIamAccessKeysTable holds the schema information for the "aws_iam_access_keys" table.
+	AWSIamAccessKeysTable = &schema.Table{
+		Name:       "aws_iam_access_keys",
+		Columns:    AWSIamAccessKeysColumns,
+		PrimaryKey: []*schema.Column{AWSIamAccessKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzeawsiamaccesskey_user_name",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamAccessKeysColumns[3]},
+			},
+			{
+				Name:    "bronzeawsiamaccesskey_status",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamAccessKeysColumns[4]},
+			},
+			{
+				Name:    "bronzeawsiamaccesskey_create_date",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamAccessKeysColumns[5]},
+			},
+			{
+				Name:    "bronzeawsiamaccesskey_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamAccessKeysColumns[9]},
+			},
+			{
+				Name:    "bronzeawsiamaccesskey_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamAccessKeysColumns[1]},
+			},
+		},
+	}
+	// AWSIamCredentialReportsColumns holds the columns for the "aws_iam_credential_reports" table.
+	AWSIamCredentialReportsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "user", Type: field.TypeString},
+		{Name: "user_creation_time", Type: field.TypeTime, Nullable: true},
+		{Name: "password_enabled", Type: field.TypeString, Nullable: true},
+		{Name: "password_last_used", Type: field.TypeTime, Nullable: true},
+		{Name: "password_last_changed", Type: field.TypeTime, Nullable: true},
+		{Name: "password_next_rotation", Type: field.TypeTime, Nullable: true},
+		{Name: "mfa_active", Type: field.TypeBool, Default: false},
+		{Name: "access_key_1_active", Type: field.TypeBool, Default: false},
+		{Name: "access_key_1_last_rotated", Type: field.TypeTime, Nullable: true},
+		{Name: "access_key_1_last_used_date", Type: field.TypeTime, Nullable: true},
+		{Name: "access_key_1_last_used_region", Type: field.TypeString, Nullable: true},
+		{Name: "access_key_1_last_used_service", Type: field.TypeString, Nullable: true},
+		{Name: "access_key_2_active", Type: field.TypeBool, Default: false},
+		{Name: "access_key_2_last_rotated", Type: field.TypeTime, Nullable: true},
+		{Name: "access_key_2_last_used_date", Type: field.TypeTime, Nullable: true},
+		{Name: "access_key_2_last_used_region", Type: field.TypeString, Nullable: true},
+		{Name: "access_key_2_last_used_service", Type: field.TypeString, Nullable: true},
+		{Name: "cert_1_active", Type: field.TypeBool, Default: false},
+		{Name: "cert_1_last_rotated", Type: field.TypeTime, Nullable: true},
+		{Name: "cert_2_active", Type: field.TypeBool, Default: false},
+		{Name: "cert_2_last_rotated", Type: field.TypeTime, Nullable: true},
+		{Name: "report_generated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+	}
+	// AWSIamCredentialReportsTable holds the schema information for the "aws_iam_credential_reports" table.
+	AWSIamCredentialReportsTable = &schema.Table{
+		Name:       "aws_iam_credential_reports",
+		Columns:    AWSIamCredentialReportsColumns,
+		PrimaryKey: []*schema.Column{AWSIamCredentialReportsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzeawsiamcredentialreport_user",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamCredentialReportsColumns[3]},
+			},
+			{
+				Name:    "bronzeawsiamcredentialreport_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamCredentialReportsColumns[25]},
+			},
+			{
+				Name:    "bronzeawsiamcredentialreport_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamCredentialReportsColumns[1]},
+			},
+		},
+	}
+	// AWSIamGroupsColumns holds the columns for the "aws_iam_groups" table.
+	AWSIamGroupsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "arn", Type: field.TypeString},
+		{Name: "group_name", Type: field.TypeString},
+		{Name: "path", Type: field.TypeString, Nullable: true},
+		{Name: "create_date", Type: field.TypeTime, Nullable: true},
+		{Name: "attached_policies_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "inline_policies_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+	}
+	// AWSIamGroupsTable holds the schema information for the "aws_iam_groups" table.
+	AWSIamGroupsTable = &schema.Table{
+		Name:       "aws_iam_groups",
+		Columns:    AWSIamGroupsColumns,
+		PrimaryKey: []*schema.Column{AWSIamGroupsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzeawsiamgroup_group_name",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamGroupsColumns[4]},
+			},
+			{
+				Name:    "bronzeawsiamgroup_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamGroupsColumns[9]},
+			},
+			{
+				Name:    "bronzeawsiamgroup_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamGroupsColumns[1]},
+			},
+		},
+	}
+	// AWSIamPoliciesColumns holds the columns for the "aws_iam_policies" table.
+	AWSIamPoliciesColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "arn", Type: field.TypeString},
+		{Name: "policy_name", Type: field.TypeString},
+		{Name: "path", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "default_version_id", Type: field.TypeString, Nullable: true},
+		{Name: "attachment_count", Type: field.TypeInt, Default: 0},
+		{Name: "permissions_boundary_usage_count", Type: field.TypeInt, Default: 0},
+		{Name: "is_attachable", Type: field.TypeBool, Default: false},
+		{Name: "aws_managed", Type: field.TypeBool, Default: false},
+		{Name: "create_date", Type: field.TypeTime, Nullable: true},
+		{Name: "update_date", Type: field.TypeTime, Nullable: true},
+		{Name: "versions_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+	}
+	// AWSIamPoliciesTable holds the schema information for the "aws_iam_policies" table.
+	AWSIamPoliciesTable = &schema.Table{
+		Name:       "aws_iam_policies",
+		Columns:    AWSIamPoliciesColumns,
+		PrimaryKey: []*schema.Column{AWSIamPoliciesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzeawsiampolicy_policy_name",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamPoliciesColumns[4]},
+			},
+			{
+				Name:    "bronzeawsiampolicy_aws_managed",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamPoliciesColumns[11]},
+			},
+			{
+				Name:    "bronzeawsiampolicy_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamPoliciesColumns[15]},
+			},
+			{
+				Name:    "bronzeawsiampolicy_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamPoliciesColumns[1]},
+			},
+		},
+	}
+	// AWSIamRolesColumns holds the columns for the "aws_iam_roles" table.
+	AWSIamRolesColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "arn", Type: field.TypeString},
+		{Name: "role_name", Type: field.TypeString},
+		{Name: "path", Type: field.TypeString, Nullable: true},
+		{Name: "create_date", Type: field.TypeTime, Nullable: true},
+		{Name: "role_last_used_date", Type: field.TypeTime, Nullable: true},
+		{Name: "role_last_used_region", Type: field.TypeString, Nullable: true},
+		{Name: "permissions_boundary_arn", Type: field.TypeString, Nullable: true},
+		{Name: "assume_role_policy_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "attached_policies_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "inline_policies_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "instance_profiles_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+	}
+	// AWSIamRolesTable holds the schema information for the "aws_iam_roles" table.
+	AWSIamRolesTable = &schema.Table{
+		Name:       "aws_iam_roles",
+		Columns:    AWSIamRolesColumns,
+		PrimaryKey: []*schema.Column{AWSIamRolesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzeawsiamrole_role_name",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamRolesColumns[4]},
+			},
+			{
+				Name:    "bronzeawsiamrole_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamRolesColumns[15]},
+			},
+			{
+				Name:    "bronzeawsiamrole_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamRolesColumns[1]},
+			},
+		},
+	}
+	// AWSIamUsersColumns holds the columns for the "aws_iam_users" table.
+	AWSIamUsersColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "arn", Type: field.TypeString},
+		{Name: "user_name", Type: field.TypeString},
+		{Name: "path", Type: field.TypeString, Nullable: true},
+		{Name: "create_date", Type: field.TypeTime, Nullable: true},
+		{Name: "permissions_boundary_arn", Type: field.TypeString, Nullable: true},
+		{Name: "attached_policies_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "inline_policies_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "groups_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "mfa_devices_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+	}
+	// AWSIamUsersTable holds the schema information for the "aws_iam_users" table.
+	AWSIamUsersTable = &schema.Table{
+		Name:       "aws_iam_users",
+		Columns:    AWSIamUsersColumns,
+		PrimaryKey: []*schema.Column{AWSIamUsersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzeawsiamuser_user_name",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamUsersColumns[4]},
+			},
+			{
+				Name:    "bronzeawsiamuser_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamUsersColumns[13]},
+			},
+			{
+				Name:    "bronzeawsiamuser_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamUsersColumns[1]},
+			},
+		},
+	}
+	// AWSIamAccessKeysHistoryColumns holds the columns for the "aws_iam_access_keys_history" table.
+	AWSIamAccessKeysHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "user_name", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString, Nullable: true},
+		{Name: "create_date", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_date", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_service", Type: field.TypeString, Nullable: true},
+		{Name: "last_used_region", Type: field.TypeString, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+	}
+	// AWSIamAccessKeysHistoryTable holds the schema information for the "aws_iam_access_keys_history" table.
+	AWSIamAccessKeysHistoryTable = &schema.Table{
+		Name:       "aws_iam_access_keys_history",
+		Columns:    AWSIamAccessKeysHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSIamAccessKeysHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawsiamaccesskey_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamAccessKeysHistoryColumns[5], AWSIamAccessKeysHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawsiamaccesskey_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamAccessKeysHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawsiamaccesskey_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamAccessKeysHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawsiamaccesskey_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamAccessKeysHistoryColumns[12]},
+			},
+		},
+	}
+	// AWSIamCredentialReportsHistoryColumns holds the columns for the "aws_iam_credential_reports_history" table.
+	AWSIamCredentialReportsHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "user", Type: field.TypeString},
+		{Name: "user_creation_time", Type: field.TypeTime, Nullable: true},
+		{Name: "password_enabled", Type: field.TypeString, Nullable: true},
+		{Name: "password_last_used", Type: field.TypeTime, Nullable: true},
+		{Name: "password_last_changed", Type: field.TypeTime, Nullable: true},
+		{Name: "password_next_rotation", Type: field.TypeTime, Nullable: true},
+		{Name: "mfa_active", Type: field.TypeBool, Default: false},
+		{Name: "access_key_1_active", Type: field.TypeBool, Default: false},
+		{Name: "access_key_1_last_rotated", Type: field.TypeTime, Nullable: true},
+		{Name: "access_key_1_last_used_date", Type: field.TypeTime, Nullable: true},
+		{Name: "access_key_1_last_used_region", Type: field.TypeString, Nullable: true},
+		{Name: "access_key_1_last_used_service", Type: field.TypeString, Nullable: true},
+		{Name: "access_key_2_active", Type: field.TypeBool, Default: false},
+		{Name: "access_key_2_last_rotated", Type: field.TypeTime, Nullable: true},
+		{Name: "access_key_2_last_used_date", Type: field.TypeTime, Nullable: true},
+		{Name: "access_key_2_last_used_region", Type: field.TypeString, Nullable: true},
+		{Name: "access_key_2_last_used_service", Type: field.TypeString, Nullable: true},
+		{Name: "cert_1_active", Type: field.TypeBool, Default: false},
+		{Name: "cert_1_last_rotated", Type: field.TypeTime, Nullable: true},
+		{Name: "cert_2_active", Type: field.TypeBool, Default: false},
+		{Name: "cert_2_last_rotated", Type: field.TypeTime, Nullable: true},
+		{Name: "report_generated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+	}
+	// AWSIamCredentialReportsHistoryTable holds the schema information for the "aws_iam_credential_reports_history" table.
+	AWSIamCredentialReportsHistoryTable = &schema.Table{
+		Name:       "aws_iam_credential_reports_history",
+		Columns:    AWSIamCredentialReportsHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSIamCredentialReportsHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawsiamcredentialreport_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamCredentialReportsHistoryColumns[5], AWSIamCredentialReportsHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawsiamcredentialreport_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamCredentialReportsHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawsiamcredentialreport_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamCredentialReportsHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawsiamcredentialreport_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamCredentialReportsHistoryColumns[28]},
+			},
+		},
+	}
+	// AWSIamGroupsHistoryColumns holds the columns for the "aws_iam_groups_history" table.
+	AWSIamGroupsHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "arn", Type: field.TypeString},
+		{Name: "group_name", Type: field.TypeString},
+		{Name: "path", Type: field.TypeString, Nullable: true},
+		{Name: "create_date", Type: field.TypeTime, Nullable: true},
+		{Name: "attached_policies_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "inline_policies_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+	}
+	// AWSIamGroupsHistoryTable holds the schema information for the "aws_iam_groups_history" table.
+	AWSIamGroupsHistoryTable = &schema.Table{
+		Name:       "aws_iam_groups_history",
+		Columns:    AWSIamGroupsHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSIamGroupsHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawsiamgroup_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamGroupsHistoryColumns[5], AWSIamGroupsHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawsiamgroup_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamGroupsHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawsiamgroup_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamGroupsHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawsiamgroup_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamGroupsHistoryColumns[12]},
+			},
+		},
+	}
+	// AWSIamPoliciesHistoryColumns holds the columns for the "aws_iam_policies_history" table.
+	AWSIamPoliciesHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "arn", Type: field.TypeString},
+		{Name: "policy_name", Type: field.TypeString},
+		{Name: "path", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "default_version_id", Type: field.TypeString, Nullable: true},
+		{Name: "attachment_count", Type: field.TypeInt, Default: 0},
+		{Name: "permissions_boundary_usage_count", Type: field.TypeInt, Default: 0},
+		{Name: "is_attachable", Type: field.TypeBool, Default: false},
+		{Name: "aws_managed", Type: field.TypeBool, Default: false},
+		{Name: "create_date", Type: field.TypeTime, Nullable: true},
+		{Name: "update_date", Type: field.TypeTime, Nullable: true},
+		{Name: "versions_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+	}
+	// AWSIamPoliciesHistoryTable holds the schema information for the "aws_iam_policies_history" table.
+	AWSIamPoliciesHistoryTable = &schema.Table{
+		Name:       "aws_iam_policies_history",
+		Columns:    AWSIamPoliciesHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSIamPoliciesHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawsiampolicy_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamPoliciesHistoryColumns[5], AWSIamPoliciesHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawsiampolicy_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamPoliciesHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawsiampolicy_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamPoliciesHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawsiampolicy_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamPoliciesHistoryColumns[18]},
+			},
+		},
+	}
+	// AWSIamRolesHistoryColumns holds the columns for the "aws_iam_roles_history" table.
+	AWSIamRolesHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "arn", Type: field.TypeString},
+		{Name: "role_name", Type: field.TypeString},
+		{Name: "path", Type: field.TypeString, Nullable: true},
+		{Name: "create_date", Type: field.TypeTime, Nullable: true},
+		{Name: "role_last_used_date", Type: field.TypeTime, Nullable: true},
+		{Name: "role_last_used_region", Type: field.TypeString, Nullable: true},
+		{Name: "permissions_boundary_arn", Type: field.TypeString, Nullable: true},
+		{Name: "assume_role_policy_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "attached_policies_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "inline_policies_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "instance_profiles_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+	}
+	// AWSIamRolesHistoryTable holds the schema information for the "aws_iam_roles_history" table.
+	AWSIamRolesHistoryTable = &schema.Table{
+		Name:       "aws_iam_roles_history",
+		Columns:    AWSIamRolesHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSIamRolesHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawsiamrole_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamRolesHistoryColumns[5], AWSIamRolesHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawsiamrole_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamRolesHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawsiamrole_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamRolesHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawsiamrole_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamRolesHistoryColumns[18]},
+			},
+		},
+	}
+	// AWSIamUsersHistoryColumns holds the columns for the "aws_iam_users_history" table.
+	AWSIamUsersHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "arn", Type: field.TypeString},
+		{Name: "user_name", Type: field.TypeString},
+		{Name: "path", Type: field.TypeString, Nullable: true},
+		{Name: "create_date", Type: field.TypeTime, Nullable: true},
+		{Name: "permissions_boundary_arn", Type: field.TypeString, Nullable: true},
+		{Name: "attached_policies_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "inline_policies_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "groups_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "mfa_devices_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+	}
+	// AWSIamUsersHistoryTable holds the schema information for the "aws_iam_users_history" table.
+	AWSIamUsersHistoryTable = &schema.Table{
+		Name:       "aws_iam_users_history",
+		Columns:    AWSIamUsersHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSIamUsersHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawsiamuser_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamUsersHistoryColumns[5], AWSIamUsersHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawsiamuser_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamUsersHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawsiamuser_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamUsersHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawsiamuser_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSIamUsersHistoryColumns[16]},
+			},
+		},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		AWSIamAccessKeysTable,
+		AWSIamCredentialReportsTable,
+		AWSIamGroupsTable,
+		AWSIamPoliciesTable,
+		AWSIamRolesTable,
+		AWSIamUsersTable,
+		AWSIamAccessKeysHistoryTable,
+		AWSIamCredentialReportsHistoryTable,
+		AWSIamGroupsHistoryTable,
+		AWSIamPoliciesHistoryTable,
+		AWSIamRolesHistoryTable,
+		AWSIamUsersHistoryTable,
+	}
+)
+
+func init() {
+	AWSIamAccessKeysTable.Annotation = &entsql.Annotation{
+		Table: "aws_iam_access_keys",
+	}
+	AWSIamCredentialReportsTable.Annotation = &entsql.Annotation{
+		Table: "aws_iam_credential_reports",
+	}
+	AWSIamGroupsTable.Annotation = &entsql.Annotation{
+		Table: "aws_iam_groups",
+	}
+	AWSIamPoliciesTable.Annotation = &entsql.Annotation{
+		Table: "aws_iam_policies",
+	}
+	AWSIamRolesTable.Annotation = &entsql.Annotation{
+		Table: "aws_iam_roles",
+	}
+	AWSIamUsersTable.Annotation = &entsql.Annotation{
+		Table: "aws_iam_users",
+	}
+	AWSIamAccessKeysHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_iam_access_keys_history",
+	}
+	AWSIamCredentialReportsHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_iam_credential_reports_history",
+	}
+	AWSIamGroupsHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_iam_groups_history",
+	}
+	AWSIamPoliciesHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_iam_policies_history",
+	}
+	AWSIamRolesHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_iam_roles_history",
+	}
+	AWSIamUsersHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_iam_users_history",
+	}
+}
@@ -0,0 +1,16239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamaccesskey"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamcredentialreport"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamgroup"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiampolicy"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamrole"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamuser"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamaccesskey"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamcredentialreport"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamgroup"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiampolicy"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamrole"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamuser"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/predicate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Operation types.
+	OpCreate    = ent.OpCreate
+	OpDelete    = ent.OpDelete
+	OpDeleteOne = ent.OpDeleteOne
+	OpUpdate    = ent.OpUpdate
+	OpUpdateOne = ent.OpUpdateOne
+
+	// Node types.
+	TypeBronzeAWSIAMAccessKey               = "BronzeAWSIAMAccessKey"
+	TypeBronzeAWSIAMCredentialReport        = "BronzeAWSIAMCredentialReport"
+	TypeBronzeAWSIAMGroup                   = "BronzeAWSIAMGroup"
+	TypeBronzeAWSIAMPolicy                  = "BronzeAWSIAMPolicy"
+	TypeBronzeAWSIAMRole                    = "BronzeAWSIAMRole"
+	TypeBronzeAWSIAMUser                    = "BronzeAWSIAMUser"
+	TypeBronzeHistoryAWSIAMAccessKey        = "BronzeHistoryAWSIAMAccessKey"
+	TypeBronzeHistoryAWSIAMCredentialReport = "BronzeHistoryAWSIAMCredentialReport"
+	TypeBronzeHistoryAWSIAMGroup            = "BronzeHistoryAWSIAMGroup"
+	TypeBronzeHistoryAWSIAMPolicy           = "BronzeHistoryAWSIAMPolicy"
+	TypeBronzeHistoryAWSIAMRole             = "BronzeHistoryAWSIAMRole"
+	TypeBronzeHistoryAWSIAMUser             = "BronzeHistoryAWSIAMUser"
+)
+
+// BronzeAWSIAMAccessKeyMutation represents an operation that mutates the BronzeAWSIAMAccessKey nodes in the graph.
+type BronzeAWSIAMAccessKeyMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	collected_at       *time.Time
+	first_collected_at *time.Time
+	user_name          *string
+	status             *string
+	create_date        *time.Time
+	last_used_date     *time.Time
+	last_used_service  *string
+	last_used_region   *string
+	account_id         *string
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*BronzeAWSIAMAccessKey, error)
+	predicates         []predicate.BronzeAWSIAMAccessKey
+}
+
+var _ ent.Mutation = (*BronzeAWSIAMAccessKeyMutation)(nil)
+
+// bronzeawsiamaccesskeyOption allows management of the mutation configuration using functional options.
+type bronzeawsiamaccesskeyOption func(*BronzeAWSIAMAccessKeyMutation)
+
+// newBronzeAWSIAMAccessKeyMutation creates new mutation for the BronzeAWSIAMAccessKey entity.
+func newBronzeAWSIAMAccessKeyMutation(c config, op Op, opts ...bronzeawsiamaccesskeyOption) *BronzeAWSIAMAccessKeyMutation {
+	m := &BronzeAWSIAMAccessKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeAWSIAMAccessKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeAWSIAMAccessKeyID sets the ID field of the mutation.
+func withBronzeAWSIAMAccessKeyID(id string) bronzeawsiamaccesskeyOption {
+	return func(m *BronzeAWSIAMAccessKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeAWSIAMAccessKey
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeAWSIAMAccessKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeAWSIAMAccessKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeAWSIAMAccessKey sets the old BronzeAWSIAMAccessKey of the mutation.
+func withBronzeAWSIAMAccessKey(node *BronzeAWSIAMAccessKey) bronzeawsiamaccesskeyOption {
+	return func(m *BronzeAWSIAMAccessKeyMutation) {
+		m.oldValue = func(context.Context) (*BronzeAWSIAMAccessKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeAWSIAMAccessKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeAWSIAMAccessKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("iam: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeAWSIAMAccessKey entities.
+func (m *BronzeAWSIAMAccessKeyMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeAWSIAMAccessKeyMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeAWSIAMAccessKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeAWSIAMAccessKeyMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeAWSIAMAccessKey entity.
+// If the BronzeAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMAccessKeyMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeAWSIAMAccessKeyMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeAWSIAMAccessKeyMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeAWSIAMAccessKey entity.
+// If the BronzeAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMAccessKeyMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeAWSIAMAccessKeyMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetUserName sets the "user_name" field.
+func (m *BronzeAWSIAMAccessKeyMutation) SetUserName(s string) {
+	m.user_name = &s
+}
+
+// UserName returns the value of the "user_name" field in the mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) UserName() (r string, exists bool) {
+	v := m.user_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserName returns the old "user_name" field's value of the BronzeAWSIAMAccessKey entity.
+// If the BronzeAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMAccessKeyMutation) OldUserName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserName: %w", err)
+	}
+	return oldValue.UserName, nil
+}
+
+// ResetUserName resets all changes to the "user_name" field.
+func (m *BronzeAWSIAMAccessKeyMutation) ResetUserName() {
+	m.user_name = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *BronzeAWSIAMAccessKeyMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the BronzeAWSIAMAccessKey entity.
+// If the BronzeAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMAccessKeyMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ClearStatus clears the value of the "status" field.
+func (m *BronzeAWSIAMAccessKeyMutation) ClearStatus() {
+	m.status = nil
+	m.clearedFields[bronzeawsiamaccesskey.FieldStatus] = struct{}{}
+}
+
+// StatusCleared returns if the "status" field was cleared in this mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) StatusCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamaccesskey.FieldStatus]
+	return ok
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *BronzeAWSIAMAccessKeyMutation) ResetStatus() {
+	m.status = nil
+	delete(m.clearedFields, bronzeawsiamaccesskey.FieldStatus)
+}
+
+// SetCreateDate sets the "create_date" field.
+func (m *BronzeAWSIAMAccessKeyMutation) SetCreateDate(t time.Time) {
+	m.create_date = &t
+}
+
+// CreateDate returns the value of the "create_date" field in the mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) CreateDate() (r time.Time, exists bool) {
+	v := m.create_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateDate returns the old "create_date" field's value of the BronzeAWSIAMAccessKey entity.
+// If the BronzeAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMAccessKeyMutation) OldCreateDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateDate: %w", err)
+	}
+	return oldValue.CreateDate, nil
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (m *BronzeAWSIAMAccessKeyMutation) ClearCreateDate() {
+	m.create_date = nil
+	m.clearedFields[bronzeawsiamaccesskey.FieldCreateDate] = struct{}{}
+}
+
+// CreateDateCleared returns if the "create_date" field was cleared in this mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) CreateDateCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamaccesskey.FieldCreateDate]
+	return ok
+}
+
+// ResetCreateDate resets all changes to the "create_date" field.
+func (m *BronzeAWSIAMAccessKeyMutation) ResetCreateDate() {
+	m.create_date = nil
+	delete(m.clearedFields, bronzeawsiamaccesskey.FieldCreateDate)
+}
+
+// SetLastUsedDate sets the "last_used_date" field.
+func (m *BronzeAWSIAMAccessKeyMutation) SetLastUsedDate(t time.Time) {
+	m.last_used_date = &t
+}
+
+// LastUsedDate returns the value of the "last_used_date" field in the mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) LastUsedDate() (r time.Time, exists bool) {
+	v := m.last_used_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedDate returns the old "last_used_date" field's value of the BronzeAWSIAMAccessKey entity.
+// If the BronzeAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMAccessKeyMutation) OldLastUsedDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedDate: %w", err)
+	}
+	return oldValue.LastUsedDate, nil
+}
+
+// ClearLastUsedDate clears the value of the "last_used_date" field.
+func (m *BronzeAWSIAMAccessKeyMutation) ClearLastUsedDate() {
+	m.last_used_date = nil
+	m.clearedFields[bronzeawsiamaccesskey.FieldLastUsedDate] = struct{}{}
+}
+
+// LastUsedDateCleared returns if the "last_used_date" field was cleared in this mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) LastUsedDateCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamaccesskey.FieldLastUsedDate]
+	return ok
+}
+
+// ResetLastUsedDate resets all changes to the "last_used_date" field.
+func (m *BronzeAWSIAMAccessKeyMutation) ResetLastUsedDate() {
+	m.last_used_date = nil
+	delete(m.clearedFields, bronzeawsiamaccesskey.FieldLastUsedDate)
+}
+
+// SetLastUsedService sets the "last_used_service" field.
+func (m *BronzeAWSIAMAccessKeyMutation) SetLastUsedService(s string) {
+	m.last_used_service = &s
+}
+
+// LastUsedService returns the value of the "last_used_service" field in the mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) LastUsedService() (r string, exists bool) {
+	v := m.last_used_service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedService returns the old "last_used_service" field's value of the BronzeAWSIAMAccessKey entity.
+// If the BronzeAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMAccessKeyMutation) OldLastUsedService(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedService is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedService requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedService: %w", err)
+	}
+	return oldValue.LastUsedService, nil
+}
+
+// ClearLastUsedService clears the value of the "last_used_service" field.
+func (m *BronzeAWSIAMAccessKeyMutation) ClearLastUsedService() {
+	m.last_used_service = nil
+	m.clearedFields[bronzeawsiamaccesskey.FieldLastUsedService] = struct{}{}
+}
+
+// LastUsedServiceCleared returns if the "last_used_service" field was cleared in this mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) LastUsedServiceCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamaccesskey.FieldLastUsedService]
+	return ok
+}
+
+// ResetLastUsedService resets all changes to the "last_used_service" field.
+func (m *BronzeAWSIAMAccessKeyMutation) ResetLastUsedService() {
+	m.last_used_service = nil
+	delete(m.clearedFields, bronzeawsiamaccesskey.FieldLastUsedService)
+}
+
+// SetLastUsedRegion sets the "last_used_region" field.
+func (m *BronzeAWSIAMAccessKeyMutation) SetLastUsedRegion(s string) {
+	m.last_used_region = &s
+}
+
+// LastUsedRegion returns the value of the "last_used_region" field in the mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) LastUsedRegion() (r string, exists bool) {
+	v := m.last_used_region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedRegion returns the old "last_used_region" field's value of the BronzeAWSIAMAccessKey entity.
+// If the BronzeAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMAccessKeyMutation) OldLastUsedRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedRegion: %w", err)
+	}
+	return oldValue.LastUsedRegion, nil
+}
+
+// ClearLastUsedRegion clears the value of the "last_used_region" field.
+func (m *BronzeAWSIAMAccessKeyMutation) ClearLastUsedRegion() {
+	m.last_used_region = nil
+	m.clearedFields[bronzeawsiamaccesskey.FieldLastUsedRegion] = struct{}{}
+}
+
+// LastUsedRegionCleared returns if the "last_used_region" field was cleared in this mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) LastUsedRegionCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamaccesskey.FieldLastUsedRegion]
+	return ok
+}
+
+// ResetLastUsedRegion resets all changes to the "last_used_region" field.
+func (m *BronzeAWSIAMAccessKeyMutation) ResetLastUsedRegion() {
+	m.last_used_region = nil
+	delete(m.clearedFields, bronzeawsiamaccesskey.FieldLastUsedRegion)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BronzeAWSIAMAccessKeyMutation) SetAccountID(s string) {
+	m.account_id = &s
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) AccountID() (r string, exists bool) {
+	v := m.account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the BronzeAWSIAMAccessKey entity.
+// If the BronzeAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMAccessKeyMutation) OldAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BronzeAWSIAMAccessKeyMutation) ResetAccountID() {
+	m.account_id = nil
+}
+
+// Where appends a list predicates to the BronzeAWSIAMAccessKeyMutation builder.
+func (m *BronzeAWSIAMAccessKeyMutation) Where(ps ...predicate.BronzeAWSIAMAccessKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeAWSIAMAccessKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeAWSIAMAccessKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeAWSIAMAccessKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeAWSIAMAccessKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeAWSIAMAccessKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeAWSIAMAccessKey).
+func (m *BronzeAWSIAMAccessKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeAWSIAMAccessKeyMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.collected_at != nil {
+		fields = append(fields, bronzeawsiamaccesskey.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzeawsiamaccesskey.FieldFirstCollectedAt)
+	}
+	if m.user_name != nil {
+		fields = append(fields, bronzeawsiamaccesskey.FieldUserName)
+	}
+	if m.status != nil {
+		fields = append(fields, bronzeawsiamaccesskey.FieldStatus)
+	}
+	if m.create_date != nil {
+		fields = append(fields, bronzeawsiamaccesskey.FieldCreateDate)
+	}
+	if m.last_used_date != nil {
+		fields = append(fields, bronzeawsiamaccesskey.FieldLastUsedDate)
+	}
+	if m.last_used_service != nil {
+		fields = append(fields, bronzeawsiamaccesskey.FieldLastUsedService)
+	}
+	if m.last_used_region != nil {
+		fields = append(fields, bronzeawsiamaccesskey.FieldLastUsedRegion)
+	}
+	if m.account_id != nil {
+		fields = append(fields, bronzeawsiamaccesskey.FieldAccountID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeAWSIAMAccessKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzeawsiamaccesskey.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzeawsiamaccesskey.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzeawsiamaccesskey.FieldUserName:
+		return m.UserName()
+	case bronzeawsiamaccesskey.FieldStatus:
+		return m.Status()
+	case bronzeawsiamaccesskey.FieldCreateDate:
+		return m.CreateDate()
+	case bronzeawsiamaccesskey.FieldLastUsedDate:
+		return m.LastUsedDate()
+	case bronzeawsiamaccesskey.FieldLastUsedService:
+		return m.LastUsedService()
+	case bronzeawsiamaccesskey.FieldLastUsedRegion:
+		return m.LastUsedRegion()
+	case bronzeawsiamaccesskey.FieldAccountID:
+		return m.AccountID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeAWSIAMAccessKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzeawsiamaccesskey.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzeawsiamaccesskey.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzeawsiamaccesskey.FieldUserName:
+		return m.OldUserName(ctx)
+	case bronzeawsiamaccesskey.FieldStatus:
+		return m.OldStatus(ctx)
+	case bronzeawsiamaccesskey.FieldCreateDate:
+		return m.OldCreateDate(ctx)
+	case bronzeawsiamaccesskey.FieldLastUsedDate:
+		return m.OldLastUsedDate(ctx)
+	case bronzeawsiamaccesskey.FieldLastUsedService:
+		return m.OldLastUsedService(ctx)
+	case bronzeawsiamaccesskey.FieldLastUsedRegion:
+		return m.OldLastUsedRegion(ctx)
+	case bronzeawsiamaccesskey.FieldAccountID:
+		return m.OldAccountID(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeAWSIAMAccessKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeAWSIAMAccessKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzeawsiamaccesskey.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzeawsiamaccesskey.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzeawsiamaccesskey.FieldUserName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserName(v)
+		return nil
+	case bronzeawsiamaccesskey.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case bronzeawsiamaccesskey.FieldCreateDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateDate(v)
+		return nil
+	case bronzeawsiamaccesskey.FieldLastUsedDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedDate(v)
+		return nil
+	case bronzeawsiamaccesskey.FieldLastUsedService:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedService(v)
+		return nil
+	case bronzeawsiamaccesskey.FieldLastUsedRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedRegion(v)
+		return nil
+	case bronzeawsiamaccesskey.FieldAccountID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMAccessKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeAWSIAMAccessKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeAWSIAMAccessKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMAccessKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzeawsiamaccesskey.FieldStatus) {
+		fields = append(fields, bronzeawsiamaccesskey.FieldStatus)
+	}
+	if m.FieldCleared(bronzeawsiamaccesskey.FieldCreateDate) {
+		fields = append(fields, bronzeawsiamaccesskey.FieldCreateDate)
+	}
+	if m.FieldCleared(bronzeawsiamaccesskey.FieldLastUsedDate) {
+		fields = append(fields, bronzeawsiamaccesskey.FieldLastUsedDate)
+	}
+	if m.FieldCleared(bronzeawsiamaccesskey.FieldLastUsedService) {
+		fields = append(fields, bronzeawsiamaccesskey.FieldLastUsedService)
+	}
+	if m.FieldCleared(bronzeawsiamaccesskey.FieldLastUsedRegion) {
+		fields = append(fields, bronzeawsiamaccesskey.FieldLastUsedRegion)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeAWSIAMAccessKeyMutation) ClearField(name string) error {
+	switch name {
+	case bronzeawsiamaccesskey.FieldStatus:
+		m.ClearStatus()
+		return nil
+	case bronzeawsiamaccesskey.FieldCreateDate:
+		m.ClearCreateDate()
+		return nil
+	case bronzeawsiamaccesskey.FieldLastUsedDate:
+		m.ClearLastUsedDate()
+		return nil
+	case bronzeawsiamaccesskey.FieldLastUsedService:
+		m.ClearLastUsedService()
+		return nil
+	case bronzeawsiamaccesskey.FieldLastUsedRegion:
+		m.ClearLastUsedRegion()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMAccessKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeAWSIAMAccessKeyMutation) ResetField(name string) error {
+	switch name {
+	case bronzeawsiamaccesskey.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzeawsiamaccesskey.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzeawsiamaccesskey.FieldUserName:
+		m.ResetUserName()
+		return nil
+	case bronzeawsiamaccesskey.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case bronzeawsiamaccesskey.FieldCreateDate:
+		m.ResetCreateDate()
+		return nil
+	case bronzeawsiamaccesskey.FieldLastUsedDate:
+		m.ResetLastUsedDate()
+		return nil
+	case bronzeawsiamaccesskey.FieldLastUsedService:
+		m.ResetLastUsedService()
+		return nil
+	case bronzeawsiamaccesskey.FieldLastUsedRegion:
+		m.ResetLastUsedRegion()
+		return nil
+	case bronzeawsiamaccesskey.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMAccessKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeAWSIAMAccessKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeAWSIAMAccessKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeAWSIAMAccessKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeAWSIAMAccessKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeAWSIAMAccessKey edge %s", name)
+}
+
+// BronzeAWSIAMCredentialReportMutation represents an operation that mutates the BronzeAWSIAMCredentialReport nodes in the graph.
+type BronzeAWSIAMCredentialReportMutation struct {
+	config
+	op                             Op
+	typ                            string
+	id                             *string
+	collected_at                   *time.Time
+	first_collected_at             *time.Time
+	user                           *string
+	user_creation_time             *time.Time
+	password_enabled               *string
+	password_last_used             *time.Time
+	password_last_changed          *time.Time
+	password_next_rotation         *time.Time
+	mfa_active                     *bool
+	access_key_1_active            *bool
+	access_key_1_last_rotated      *time.Time
+	access_key_1_last_used_date    *time.Time
+	access_key_1_last_used_region  *string
+	access_key_1_last_used_service *string
+	access_key_2_active            *bool
+	access_key_2_last_rotated      *time.Time
+	access_key_2_last_used_date    *time.Time
+	access_key_2_last_used_region  *string
+	access_key_2_last_used_service *string
+	cert_1_active                  *bool
+	cert_1_last_rotated            *time.Time
+	cert_2_active                  *bool
+	cert_2_last_rotated            *time.Time
+	report_generated_at            *time.Time
+	account_id                     *string
+	clearedFields                  map[string]struct{}
+	done                           bool
+	oldValue                       func(context.Context) (*BronzeAWSIAMCredentialReport, error)
+	predicates                     []predicate.BronzeAWSIAMCredentialReport
+}
+
+var _ ent.Mutation = (*BronzeAWSIAMCredentialReportMutation)(nil)
+
+// bronzeawsiamcredentialreportOption allows management of the mutation configuration using functional options.
+type bronzeawsiamcredentialreportOption func(*BronzeAWSIAMCredentialReportMutation)
+
+// newBronzeAWSIAMCredentialReportMutation creates new mutation for the BronzeAWSIAMCredentialReport entity.
+func newBronzeAWSIAMCredentialReportMutation(c config, op Op, opts ...bronzeawsiamcredentialreportOption) *BronzeAWSIAMCredentialReportMutation {
+	m := &BronzeAWSIAMCredentialReportMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeAWSIAMCredentialReport,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeAWSIAMCredentialReportID sets the ID field of the mutation.
+func withBronzeAWSIAMCredentialReportID(id string) bronzeawsiamcredentialreportOption {
+	return func(m *BronzeAWSIAMCredentialReportMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeAWSIAMCredentialReport
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeAWSIAMCredentialReport, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeAWSIAMCredentialReport.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeAWSIAMCredentialReport sets the old BronzeAWSIAMCredentialReport of the mutation.
+func withBronzeAWSIAMCredentialReport(node *BronzeAWSIAMCredentialReport) bronzeawsiamcredentialreportOption {
+	return func(m *BronzeAWSIAMCredentialReportMutation) {
+		m.oldValue = func(context.Context) (*BronzeAWSIAMCredentialReport, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeAWSIAMCredentialReportMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeAWSIAMCredentialReportMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("iam: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeAWSIAMCredentialReport entities.
+func (m *BronzeAWSIAMCredentialReportMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeAWSIAMCredentialReportMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeAWSIAMCredentialReport.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetUser sets the "user" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetUser(s string) {
+	m.user = &s
+}
+
+// User returns the value of the "user" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) User() (r string, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser returns the old "user" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldUser(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser: %w", err)
+	}
+	return oldValue.User, nil
+}
+
+// ResetUser resets all changes to the "user" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetUser() {
+	m.user = nil
+}
+
+// SetUserCreationTime sets the "user_creation_time" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetUserCreationTime(t time.Time) {
+	m.user_creation_time = &t
+}
+
+// UserCreationTime returns the value of the "user_creation_time" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) UserCreationTime() (r time.Time, exists bool) {
+	v := m.user_creation_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserCreationTime returns the old "user_creation_time" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldUserCreationTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserCreationTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserCreationTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserCreationTime: %w", err)
+	}
+	return oldValue.UserCreationTime, nil
+}
+
+// ClearUserCreationTime clears the value of the "user_creation_time" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearUserCreationTime() {
+	m.user_creation_time = nil
+	m.clearedFields[bronzeawsiamcredentialreport.FieldUserCreationTime] = struct{}{}
+}
+
+// UserCreationTimeCleared returns if the "user_creation_time" field was cleared in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) UserCreationTimeCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamcredentialreport.FieldUserCreationTime]
+	return ok
+}
+
+// ResetUserCreationTime resets all changes to the "user_creation_time" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetUserCreationTime() {
+	m.user_creation_time = nil
+	delete(m.clearedFields, bronzeawsiamcredentialreport.FieldUserCreationTime)
+}
+
+// SetPasswordEnabled sets the "password_enabled" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetPasswordEnabled(s string) {
+	m.password_enabled = &s
+}
+
+// PasswordEnabled returns the value of the "password_enabled" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) PasswordEnabled() (r string, exists bool) {
+	v := m.password_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordEnabled returns the old "password_enabled" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldPasswordEnabled(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordEnabled: %w", err)
+	}
+	return oldValue.PasswordEnabled, nil
+}
+
+// ClearPasswordEnabled clears the value of the "password_enabled" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearPasswordEnabled() {
+	m.password_enabled = nil
+	m.clearedFields[bronzeawsiamcredentialreport.FieldPasswordEnabled] = struct{}{}
+}
+
+// PasswordEnabledCleared returns if the "password_enabled" field was cleared in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) PasswordEnabledCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamcredentialreport.FieldPasswordEnabled]
+	return ok
+}
+
+// ResetPasswordEnabled resets all changes to the "password_enabled" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetPasswordEnabled() {
+	m.password_enabled = nil
+	delete(m.clearedFields, bronzeawsiamcredentialreport.FieldPasswordEnabled)
+}
+
+// SetPasswordLastUsed sets the "password_last_used" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetPasswordLastUsed(t time.Time) {
+	m.password_last_used = &t
+}
+
+// PasswordLastUsed returns the value of the "password_last_used" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) PasswordLastUsed() (r time.Time, exists bool) {
+	v := m.password_last_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordLastUsed returns the old "password_last_used" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldPasswordLastUsed(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordLastUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordLastUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordLastUsed: %w", err)
+	}
+	return oldValue.PasswordLastUsed, nil
+}
+
+// ClearPasswordLastUsed clears the value of the "password_last_used" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearPasswordLastUsed() {
+	m.password_last_used = nil
+	m.clearedFields[bronzeawsiamcredentialreport.FieldPasswordLastUsed] = struct{}{}
+}
+
+// PasswordLastUsedCleared returns if the "password_last_used" field was cleared in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) PasswordLastUsedCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamcredentialreport.FieldPasswordLastUsed]
+	return ok
+}
+
+// ResetPasswordLastUsed resets all changes to the "password_last_used" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetPasswordLastUsed() {
+	m.password_last_used = nil
+	delete(m.clearedFields, bronzeawsiamcredentialreport.FieldPasswordLastUsed)
+}
+
+// SetPasswordLastChanged sets the "password_last_changed" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetPasswordLastChanged(t time.Time) {
+	m.password_last_changed = &t
+}
+
+// PasswordLastChanged returns the value of the "password_last_changed" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) PasswordLastChanged() (r time.Time, exists bool) {
+	v := m.password_last_changed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordLastChanged returns the old "password_last_changed" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldPasswordLastChanged(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordLastChanged is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordLastChanged requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordLastChanged: %w", err)
+	}
+	return oldValue.PasswordLastChanged, nil
+}
+
+// ClearPasswordLastChanged clears the value of the "password_last_changed" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearPasswordLastChanged() {
+	m.password_last_changed = nil
+	m.clearedFields[bronzeawsiamcredentialreport.FieldPasswordLastChanged] = struct{}{}
+}
+
+// PasswordLastChangedCleared returns if the "password_last_changed" field was cleared in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) PasswordLastChangedCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamcredentialreport.FieldPasswordLastChanged]
+	return ok
+}
+
+// ResetPasswordLastChanged resets all changes to the "password_last_changed" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetPasswordLastChanged() {
+	m.password_last_changed = nil
+	delete(m.clearedFields, bronzeawsiamcredentialreport.FieldPasswordLastChanged)
+}
+
+// SetPasswordNextRotation sets the "password_next_rotation" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetPasswordNextRotation(t time.Time) {
+	m.password_next_rotation = &t
+}
+
+// PasswordNextRotation returns the value of the "password_next_rotation" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) PasswordNextRotation() (r time.Time, exists bool) {
+	v := m.password_next_rotation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordNextRotation returns the old "password_next_rotation" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldPasswordNextRotation(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordNextRotation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordNextRotation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordNextRotation: %w", err)
+	}
+	return oldValue.PasswordNextRotation, nil
+}
+
+// ClearPasswordNextRotation clears the value of the "password_next_rotation" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearPasswordNextRotation() {
+	m.password_next_rotation = nil
+	m.clearedFields[bronzeawsiamcredentialreport.FieldPasswordNextRotation] = struct{}{}
+}
+
+// PasswordNextRotationCleared returns if the "password_next_rotation" field was cleared in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) PasswordNextRotationCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamcredentialreport.FieldPasswordNextRotation]
+	return ok
+}
+
+// ResetPasswordNextRotation resets all changes to the "password_next_rotation" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetPasswordNextRotation() {
+	m.password_next_rotation = nil
+	delete(m.clearedFields, bronzeawsiamcredentialreport.FieldPasswordNextRotation)
+}
+
+// SetMfaActive sets the "mfa_active" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetMfaActive(b bool) {
+	m.mfa_active = &b
+}
+
+// MfaActive returns the value of the "mfa_active" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) MfaActive() (r bool, exists bool) {
+	v := m.mfa_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMfaActive returns the old "mfa_active" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldMfaActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMfaActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMfaActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMfaActive: %w", err)
+	}
+	return oldValue.MfaActive, nil
+}
+
+// ResetMfaActive resets all changes to the "mfa_active" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetMfaActive() {
+	m.mfa_active = nil
+}
+
+// SetAccessKey1Active sets the "access_key_1_active" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetAccessKey1Active(b bool) {
+	m.access_key_1_active = &b
+}
+
+// AccessKey1Active returns the value of the "access_key_1_active" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AccessKey1Active() (r bool, exists bool) {
+	v := m.access_key_1_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey1Active returns the old "access_key_1_active" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldAccessKey1Active(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey1Active is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey1Active requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey1Active: %w", err)
+	}
+	return oldValue.AccessKey1Active, nil
+}
+
+// ResetAccessKey1Active resets all changes to the "access_key_1_active" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetAccessKey1Active() {
+	m.access_key_1_active = nil
+}
+
+// SetAccessKey1LastRotated sets the "access_key_1_last_rotated" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetAccessKey1LastRotated(t time.Time) {
+	m.access_key_1_last_rotated = &t
+}
+
+// AccessKey1LastRotated returns the value of the "access_key_1_last_rotated" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AccessKey1LastRotated() (r time.Time, exists bool) {
+	v := m.access_key_1_last_rotated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey1LastRotated returns the old "access_key_1_last_rotated" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldAccessKey1LastRotated(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey1LastRotated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey1LastRotated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey1LastRotated: %w", err)
+	}
+	return oldValue.AccessKey1LastRotated, nil
+}
+
+// ClearAccessKey1LastRotated clears the value of the "access_key_1_last_rotated" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearAccessKey1LastRotated() {
+	m.access_key_1_last_rotated = nil
+	m.clearedFields[bronzeawsiamcredentialreport.FieldAccessKey1LastRotated] = struct{}{}
+}
+
+// AccessKey1LastRotatedCleared returns if the "access_key_1_last_rotated" field was cleared in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AccessKey1LastRotatedCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamcredentialreport.FieldAccessKey1LastRotated]
+	return ok
+}
+
+// ResetAccessKey1LastRotated resets all changes to the "access_key_1_last_rotated" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetAccessKey1LastRotated() {
+	m.access_key_1_last_rotated = nil
+	delete(m.clearedFields, bronzeawsiamcredentialreport.FieldAccessKey1LastRotated)
+}
+
+// SetAccessKey1LastUsedDate sets the "access_key_1_last_used_date" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetAccessKey1LastUsedDate(t time.Time) {
+	m.access_key_1_last_used_date = &t
+}
+
+// AccessKey1LastUsedDate returns the value of the "access_key_1_last_used_date" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AccessKey1LastUsedDate() (r time.Time, exists bool) {
+	v := m.access_key_1_last_used_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey1LastUsedDate returns the old "access_key_1_last_used_date" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldAccessKey1LastUsedDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey1LastUsedDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey1LastUsedDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey1LastUsedDate: %w", err)
+	}
+	return oldValue.AccessKey1LastUsedDate, nil
+}
+
+// ClearAccessKey1LastUsedDate clears the value of the "access_key_1_last_used_date" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearAccessKey1LastUsedDate() {
+	m.access_key_1_last_used_date = nil
+	m.clearedFields[bronzeawsiamcredentialreport.FieldAccessKey1LastUsedDate] = struct{}{}
+}
+
+// AccessKey1LastUsedDateCleared returns if the "access_key_1_last_used_date" field was cleared in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AccessKey1LastUsedDateCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamcredentialreport.FieldAccessKey1LastUsedDate]
+	return ok
+}
+
+// ResetAccessKey1LastUsedDate resets all changes to the "access_key_1_last_used_date" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetAccessKey1LastUsedDate() {
+	m.access_key_1_last_used_date = nil
+	delete(m.clearedFields, bronzeawsiamcredentialreport.FieldAccessKey1LastUsedDate)
+}
+
+// SetAccessKey1LastUsedRegion sets the "access_key_1_last_used_region" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetAccessKey1LastUsedRegion(s string) {
+	m.access_key_1_last_used_region = &s
+}
+
+// AccessKey1LastUsedRegion returns the value of the "access_key_1_last_used_region" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AccessKey1LastUsedRegion() (r string, exists bool) {
+	v := m.access_key_1_last_used_region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey1LastUsedRegion returns the old "access_key_1_last_used_region" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldAccessKey1LastUsedRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey1LastUsedRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey1LastUsedRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey1LastUsedRegion: %w", err)
+	}
+	return oldValue.AccessKey1LastUsedRegion, nil
+}
+
+// ClearAccessKey1LastUsedRegion clears the value of the "access_key_1_last_used_region" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearAccessKey1LastUsedRegion() {
+	m.access_key_1_last_used_region = nil
+	m.clearedFields[bronzeawsiamcredentialreport.FieldAccessKey1LastUsedRegion] = struct{}{}
+}
+
+// AccessKey1LastUsedRegionCleared returns if the "access_key_1_last_used_region" field was cleared in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AccessKey1LastUsedRegionCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamcredentialreport.FieldAccessKey1LastUsedRegion]
+	return ok
+}
+
+// ResetAccessKey1LastUsedRegion resets all changes to the "access_key_1_last_used_region" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetAccessKey1LastUsedRegion() {
+	m.access_key_1_last_used_region = nil
+	delete(m.clearedFields, bronzeawsiamcredentialreport.FieldAccessKey1LastUsedRegion)
+}
+
+// SetAccessKey1LastUsedService sets the "access_key_1_last_used_service" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetAccessKey1LastUsedService(s string) {
+	m.access_key_1_last_used_service = &s
+}
+
+// AccessKey1LastUsedService returns the value of the "access_key_1_last_used_service" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AccessKey1LastUsedService() (r string, exists bool) {
+	v := m.access_key_1_last_used_service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey1LastUsedService returns the old "access_key_1_last_used_service" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldAccessKey1LastUsedService(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey1LastUsedService is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey1LastUsedService requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey1LastUsedService: %w", err)
+	}
+	return oldValue.AccessKey1LastUsedService, nil
+}
+
+// ClearAccessKey1LastUsedService clears the value of the "access_key_1_last_used_service" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearAccessKey1LastUsedService() {
+	m.access_key_1_last_used_service = nil
+	m.clearedFields[bronzeawsiamcredentialreport.FieldAccessKey1LastUsedService] = struct{}{}
+}
+
+// AccessKey1LastUsedServiceCleared returns if the "access_key_1_last_used_service" field was cleared in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AccessKey1LastUsedServiceCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamcredentialreport.FieldAccessKey1LastUsedService]
+	return ok
+}
+
+// ResetAccessKey1LastUsedService resets all changes to the "access_key_1_last_used_service" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetAccessKey1LastUsedService() {
+	m.access_key_1_last_used_service = nil
+	delete(m.clearedFields, bronzeawsiamcredentialreport.FieldAccessKey1LastUsedService)
+}
+
+// SetAccessKey2Active sets the "access_key_2_active" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetAccessKey2Active(b bool) {
+	m.access_key_2_active = &b
+}
+
+// AccessKey2Active returns the value of the "access_key_2_active" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AccessKey2Active() (r bool, exists bool) {
+	v := m.access_key_2_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey2Active returns the old "access_key_2_active" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldAccessKey2Active(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey2Active is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey2Active requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey2Active: %w", err)
+	}
+	return oldValue.AccessKey2Active, nil
+}
+
+// ResetAccessKey2Active resets all changes to the "access_key_2_active" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetAccessKey2Active() {
+	m.access_key_2_active = nil
+}
+
+// SetAccessKey2LastRotated sets the "access_key_2_last_rotated" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetAccessKey2LastRotated(t time.Time) {
+	m.access_key_2_last_rotated = &t
+}
+
+// AccessKey2LastRotated returns the value of the "access_key_2_last_rotated" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AccessKey2LastRotated() (r time.Time, exists bool) {
+	v := m.access_key_2_last_rotated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey2LastRotated returns the old "access_key_2_last_rotated" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldAccessKey2LastRotated(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey2LastRotated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey2LastRotated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey2LastRotated: %w", err)
+	}
+	return oldValue.AccessKey2LastRotated, nil
+}
+
+// ClearAccessKey2LastRotated clears the value of the "access_key_2_last_rotated" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearAccessKey2LastRotated() {
+	m.access_key_2_last_rotated = nil
+	m.clearedFields[bronzeawsiamcredentialreport.FieldAccessKey2LastRotated] = struct{}{}
+}
+
+// AccessKey2LastRotatedCleared returns if the "access_key_2_last_rotated" field was cleared in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AccessKey2LastRotatedCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamcredentialreport.FieldAccessKey2LastRotated]
+	return ok
+}
+
+// ResetAccessKey2LastRotated resets all changes to the "access_key_2_last_rotated" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetAccessKey2LastRotated() {
+	m.access_key_2_last_rotated = nil
+	delete(m.clearedFields, bronzeawsiamcredentialreport.FieldAccessKey2LastRotated)
+}
+
+// SetAccessKey2LastUsedDate sets the "access_key_2_last_used_date" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetAccessKey2LastUsedDate(t time.Time) {
+	m.access_key_2_last_used_date = &t
+}
+
+// AccessKey2LastUsedDate returns the value of the "access_key_2_last_used_date" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AccessKey2LastUsedDate() (r time.Time, exists bool) {
+	v := m.access_key_2_last_used_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey2LastUsedDate returns the old "access_key_2_last_used_date" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldAccessKey2LastUsedDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey2LastUsedDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey2LastUsedDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey2LastUsedDate: %w", err)
+	}
+	return oldValue.AccessKey2LastUsedDate, nil
+}
+
+// ClearAccessKey2LastUsedDate clears the value of the "access_key_2_last_used_date" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearAccessKey2LastUsedDate() {
+	m.access_key_2_last_used_date = nil
+	m.clearedFields[bronzeawsiamcredentialreport.FieldAccessKey2LastUsedDate] = struct{}{}
+}
+
+// AccessKey2LastUsedDateCleared returns if the "access_key_2_last_used_date" field was cleared in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AccessKey2LastUsedDateCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamcredentialreport.FieldAccessKey2LastUsedDate]
+	return ok
+}
+
+// ResetAccessKey2LastUsedDate resets all changes to the "access_key_2_last_used_date" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetAccessKey2LastUsedDate() {
+	m.access_key_2_last_used_date = nil
+	delete(m.clearedFields, bronzeawsiamcredentialreport.FieldAccessKey2LastUsedDate)
+}
+
+// SetAccessKey2LastUsedRegion sets the "access_key_2_last_used_region" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetAccessKey2LastUsedRegion(s string) {
+	m.access_key_2_last_used_region = &s
+}
+
+// AccessKey2LastUsedRegion returns the value of the "access_key_2_last_used_region" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AccessKey2LastUsedRegion() (r string, exists bool) {
+	v := m.access_key_2_last_used_region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey2LastUsedRegion returns the old "access_key_2_last_used_region" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldAccessKey2LastUsedRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey2LastUsedRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey2LastUsedRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey2LastUsedRegion: %w", err)
+	}
+	return oldValue.AccessKey2LastUsedRegion, nil
+}
+
+// ClearAccessKey2LastUsedRegion clears the value of the "access_key_2_last_used_region" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearAccessKey2LastUsedRegion() {
+	m.access_key_2_last_used_region = nil
+	m.clearedFields[bronzeawsiamcredentialreport.FieldAccessKey2LastUsedRegion] = struct{}{}
+}
+
+// AccessKey2LastUsedRegionCleared returns if the "access_key_2_last_used_region" field was cleared in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AccessKey2LastUsedRegionCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamcredentialreport.FieldAccessKey2LastUsedRegion]
+	return ok
+}
+
+// ResetAccessKey2LastUsedRegion resets all changes to the "access_key_2_last_used_region" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetAccessKey2LastUsedRegion() {
+	m.access_key_2_last_used_region = nil
+	delete(m.clearedFields, bronzeawsiamcredentialreport.FieldAccessKey2LastUsedRegion)
+}
+
+// SetAccessKey2LastUsedService sets the "access_key_2_last_used_service" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetAccessKey2LastUsedService(s string) {
+	m.access_key_2_last_used_service = &s
+}
+
+// AccessKey2LastUsedService returns the value of the "access_key_2_last_used_service" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AccessKey2LastUsedService() (r string, exists bool) {
+	v := m.access_key_2_last_used_service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey2LastUsedService returns the old "access_key_2_last_used_service" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldAccessKey2LastUsedService(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey2LastUsedService is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey2LastUsedService requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey2LastUsedService: %w", err)
+	}
+	return oldValue.AccessKey2LastUsedService, nil
+}
+
+// ClearAccessKey2LastUsedService clears the value of the "access_key_2_last_used_service" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearAccessKey2LastUsedService() {
+	m.access_key_2_last_used_service = nil
+	m.clearedFields[bronzeawsiamcredentialreport.FieldAccessKey2LastUsedService] = struct{}{}
+}
+
+// AccessKey2LastUsedServiceCleared returns if the "access_key_2_last_used_service" field was cleared in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AccessKey2LastUsedServiceCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamcredentialreport.FieldAccessKey2LastUsedService]
+	return ok
+}
+
+// ResetAccessKey2LastUsedService resets all changes to the "access_key_2_last_used_service" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetAccessKey2LastUsedService() {
+	m.access_key_2_last_used_service = nil
+	delete(m.clearedFields, bronzeawsiamcredentialreport.FieldAccessKey2LastUsedService)
+}
+
+// SetCert1Active sets the "cert_1_active" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetCert1Active(b bool) {
+	m.cert_1_active = &b
+}
+
+// Cert1Active returns the value of the "cert_1_active" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) Cert1Active() (r bool, exists bool) {
+	v := m.cert_1_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCert1Active returns the old "cert_1_active" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldCert1Active(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCert1Active is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCert1Active requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCert1Active: %w", err)
+	}
+	return oldValue.Cert1Active, nil
+}
+
+// ResetCert1Active resets all changes to the "cert_1_active" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetCert1Active() {
+	m.cert_1_active = nil
+}
+
+// SetCert1LastRotated sets the "cert_1_last_rotated" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetCert1LastRotated(t time.Time) {
+	m.cert_1_last_rotated = &t
+}
+
+// Cert1LastRotated returns the value of the "cert_1_last_rotated" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) Cert1LastRotated() (r time.Time, exists bool) {
+	v := m.cert_1_last_rotated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCert1LastRotated returns the old "cert_1_last_rotated" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldCert1LastRotated(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCert1LastRotated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCert1LastRotated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCert1LastRotated: %w", err)
+	}
+	return oldValue.Cert1LastRotated, nil
+}
+
+// ClearCert1LastRotated clears the value of the "cert_1_last_rotated" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearCert1LastRotated() {
+	m.cert_1_last_rotated = nil
+	m.clearedFields[bronzeawsiamcredentialreport.FieldCert1LastRotated] = struct{}{}
+}
+
+// Cert1LastRotatedCleared returns if the "cert_1_last_rotated" field was cleared in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) Cert1LastRotatedCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamcredentialreport.FieldCert1LastRotated]
+	return ok
+}
+
+// ResetCert1LastRotated resets all changes to the "cert_1_last_rotated" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetCert1LastRotated() {
+	m.cert_1_last_rotated = nil
+	delete(m.clearedFields, bronzeawsiamcredentialreport.FieldCert1LastRotated)
+}
+
+// SetCert2Active sets the "cert_2_active" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetCert2Active(b bool) {
+	m.cert_2_active = &b
+}
+
+// Cert2Active returns the value of the "cert_2_active" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) Cert2Active() (r bool, exists bool) {
+	v := m.cert_2_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCert2Active returns the old "cert_2_active" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldCert2Active(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCert2Active is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCert2Active requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCert2Active: %w", err)
+	}
+	return oldValue.Cert2Active, nil
+}
+
+// ResetCert2Active resets all changes to the "cert_2_active" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetCert2Active() {
+	m.cert_2_active = nil
+}
+
+// SetCert2LastRotated sets the "cert_2_last_rotated" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetCert2LastRotated(t time.Time) {
+	m.cert_2_last_rotated = &t
+}
+
+// Cert2LastRotated returns the value of the "cert_2_last_rotated" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) Cert2LastRotated() (r time.Time, exists bool) {
+	v := m.cert_2_last_rotated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCert2LastRotated returns the old "cert_2_last_rotated" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldCert2LastRotated(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCert2LastRotated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCert2LastRotated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCert2LastRotated: %w", err)
+	}
+	return oldValue.Cert2LastRotated, nil
+}
+
+// ClearCert2LastRotated clears the value of the "cert_2_last_rotated" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearCert2LastRotated() {
+	m.cert_2_last_rotated = nil
+	m.clearedFields[bronzeawsiamcredentialreport.FieldCert2LastRotated] = struct{}{}
+}
+
+// Cert2LastRotatedCleared returns if the "cert_2_last_rotated" field was cleared in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) Cert2LastRotatedCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamcredentialreport.FieldCert2LastRotated]
+	return ok
+}
+
+// ResetCert2LastRotated resets all changes to the "cert_2_last_rotated" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetCert2LastRotated() {
+	m.cert_2_last_rotated = nil
+	delete(m.clearedFields, bronzeawsiamcredentialreport.FieldCert2LastRotated)
+}
+
+// SetReportGeneratedAt sets the "report_generated_at" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetReportGeneratedAt(t time.Time) {
+	m.report_generated_at = &t
+}
+
+// ReportGeneratedAt returns the value of the "report_generated_at" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) ReportGeneratedAt() (r time.Time, exists bool) {
+	v := m.report_generated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReportGeneratedAt returns the old "report_generated_at" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldReportGeneratedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReportGeneratedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReportGeneratedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReportGeneratedAt: %w", err)
+	}
+	return oldValue.ReportGeneratedAt, nil
+}
+
+// ClearReportGeneratedAt clears the value of the "report_generated_at" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearReportGeneratedAt() {
+	m.report_generated_at = nil
+	m.clearedFields[bronzeawsiamcredentialreport.FieldReportGeneratedAt] = struct{}{}
+}
+
+// ReportGeneratedAtCleared returns if the "report_generated_at" field was cleared in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) ReportGeneratedAtCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamcredentialreport.FieldReportGeneratedAt]
+	return ok
+}
+
+// ResetReportGeneratedAt resets all changes to the "report_generated_at" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetReportGeneratedAt() {
+	m.report_generated_at = nil
+	delete(m.clearedFields, bronzeawsiamcredentialreport.FieldReportGeneratedAt)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BronzeAWSIAMCredentialReportMutation) SetAccountID(s string) {
+	m.account_id = &s
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AccountID() (r string, exists bool) {
+	v := m.account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the BronzeAWSIAMCredentialReport entity.
+// If the BronzeAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMCredentialReportMutation) OldAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetAccountID() {
+	m.account_id = nil
+}
+
+// Where appends a list predicates to the BronzeAWSIAMCredentialReportMutation builder.
+func (m *BronzeAWSIAMCredentialReportMutation) Where(ps ...predicate.BronzeAWSIAMCredentialReport) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeAWSIAMCredentialReportMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeAWSIAMCredentialReportMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeAWSIAMCredentialReport, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeAWSIAMCredentialReportMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeAWSIAMCredentialReportMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeAWSIAMCredentialReport).
+func (m *BronzeAWSIAMCredentialReportMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeAWSIAMCredentialReportMutation) Fields() []string {
+	fields := make([]string, 0, 25)
+	if m.collected_at != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldFirstCollectedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldUser)
+	}
+	if m.user_creation_time != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldUserCreationTime)
+	}
+	if m.password_enabled != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldPasswordEnabled)
+	}
+	if m.password_last_used != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldPasswordLastUsed)
+	}
+	if m.password_last_changed != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldPasswordLastChanged)
+	}
+	if m.password_next_rotation != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldPasswordNextRotation)
+	}
+	if m.mfa_active != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldMfaActive)
+	}
+	if m.access_key_1_active != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldAccessKey1Active)
+	}
+	if m.access_key_1_last_rotated != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldAccessKey1LastRotated)
+	}
+	if m.access_key_1_last_used_date != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldAccessKey1LastUsedDate)
+	}
+	if m.access_key_1_last_used_region != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldAccessKey1LastUsedRegion)
+	}
+	if m.access_key_1_last_used_service != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldAccessKey1LastUsedService)
+	}
+	if m.access_key_2_active != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldAccessKey2Active)
+	}
+	if m.access_key_2_last_rotated != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldAccessKey2LastRotated)
+	}
+	if m.access_key_2_last_used_date != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldAccessKey2LastUsedDate)
+	}
+	if m.access_key_2_last_used_region != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldAccessKey2LastUsedRegion)
+	}
+	if m.access_key_2_last_used_service != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldAccessKey2LastUsedService)
+	}
+	if m.cert_1_active != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldCert1Active)
+	}
+	if m.cert_1_last_rotated != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldCert1LastRotated)
+	}
+	if m.cert_2_active != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldCert2Active)
+	}
+	if m.cert_2_last_rotated != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldCert2LastRotated)
+	}
+	if m.report_generated_at != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldReportGeneratedAt)
+	}
+	if m.account_id != nil {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldAccountID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeAWSIAMCredentialReportMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzeawsiamcredentialreport.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzeawsiamcredentialreport.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzeawsiamcredentialreport.FieldUser:
+		return m.User()
+	case bronzeawsiamcredentialreport.FieldUserCreationTime:
+		return m.UserCreationTime()
+	case bronzeawsiamcredentialreport.FieldPasswordEnabled:
+		return m.PasswordEnabled()
+	case bronzeawsiamcredentialreport.FieldPasswordLastUsed:
+		return m.PasswordLastUsed()
+	case bronzeawsiamcredentialreport.FieldPasswordLastChanged:
+		return m.PasswordLastChanged()
+	case bronzeawsiamcredentialreport.FieldPasswordNextRotation:
+		return m.PasswordNextRotation()
+	case bronzeawsiamcredentialreport.FieldMfaActive:
+		return m.MfaActive()
+	case bronzeawsiamcredentialreport.FieldAccessKey1Active:
+		return m.AccessKey1Active()
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastRotated:
+		return m.AccessKey1LastRotated()
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastUsedDate:
+		return m.AccessKey1LastUsedDate()
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastUsedRegion:
+		return m.AccessKey1LastUsedRegion()
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastUsedService:
+		return m.AccessKey1LastUsedService()
+	case bronzeawsiamcredentialreport.FieldAccessKey2Active:
+		return m.AccessKey2Active()
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastRotated:
+		return m.AccessKey2LastRotated()
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastUsedDate:
+		return m.AccessKey2LastUsedDate()
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastUsedRegion:
+		return m.AccessKey2LastUsedRegion()
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastUsedService:
+		return m.AccessKey2LastUsedService()
+	case bronzeawsiamcredentialreport.FieldCert1Active:
+		return m.Cert1Active()
+	case bronzeawsiamcredentialreport.FieldCert1LastRotated:
+		return m.Cert1LastRotated()
+	case bronzeawsiamcredentialreport.FieldCert2Active:
+		return m.Cert2Active()
+	case bronzeawsiamcredentialreport.FieldCert2LastRotated:
+		return m.Cert2LastRotated()
+	case bronzeawsiamcredentialreport.FieldReportGeneratedAt:
+		return m.ReportGeneratedAt()
+	case bronzeawsiamcredentialreport.FieldAccountID:
+		return m.AccountID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeAWSIAMCredentialReportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzeawsiamcredentialreport.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzeawsiamcredentialreport.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzeawsiamcredentialreport.FieldUser:
+		return m.OldUser(ctx)
+	case bronzeawsiamcredentialreport.FieldUserCreationTime:
+		return m.OldUserCreationTime(ctx)
+	case bronzeawsiamcredentialreport.FieldPasswordEnabled:
+		return m.OldPasswordEnabled(ctx)
+	case bronzeawsiamcredentialreport.FieldPasswordLastUsed:
+		return m.OldPasswordLastUsed(ctx)
+	case bronzeawsiamcredentialreport.FieldPasswordLastChanged:
+		return m.OldPasswordLastChanged(ctx)
+	case bronzeawsiamcredentialreport.FieldPasswordNextRotation:
+		return m.OldPasswordNextRotation(ctx)
+	case bronzeawsiamcredentialreport.FieldMfaActive:
+		return m.OldMfaActive(ctx)
+	case bronzeawsiamcredentialreport.FieldAccessKey1Active:
+		return m.OldAccessKey1Active(ctx)
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastRotated:
+		return m.OldAccessKey1LastRotated(ctx)
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastUsedDate:
+		return m.OldAccessKey1LastUsedDate(ctx)
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastUsedRegion:
+		return m.OldAccessKey1LastUsedRegion(ctx)
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastUsedService:
+		return m.OldAccessKey1LastUsedService(ctx)
+	case bronzeawsiamcredentialreport.FieldAccessKey2Active:
+		return m.OldAccessKey2Active(ctx)
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastRotated:
+		return m.OldAccessKey2LastRotated(ctx)
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastUsedDate:
+		return m.OldAccessKey2LastUsedDate(ctx)
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastUsedRegion:
+		return m.OldAccessKey2LastUsedRegion(ctx)
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastUsedService:
+		return m.OldAccessKey2LastUsedService(ctx)
+	case bronzeawsiamcredentialreport.FieldCert1Active:
+		return m.OldCert1Active(ctx)
+	case bronzeawsiamcredentialreport.FieldCert1LastRotated:
+		return m.OldCert1LastRotated(ctx)
+	case bronzeawsiamcredentialreport.FieldCert2Active:
+		return m.OldCert2Active(ctx)
+	case bronzeawsiamcredentialreport.FieldCert2LastRotated:
+		return m.OldCert2LastRotated(ctx)
+	case bronzeawsiamcredentialreport.FieldReportGeneratedAt:
+		return m.OldReportGeneratedAt(ctx)
+	case bronzeawsiamcredentialreport.FieldAccountID:
+		return m.OldAccountID(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeAWSIAMCredentialReport field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeAWSIAMCredentialReportMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzeawsiamcredentialreport.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldUser:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldUserCreationTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserCreationTime(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldPasswordEnabled:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordEnabled(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldPasswordLastUsed:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordLastUsed(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldPasswordLastChanged:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordLastChanged(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldPasswordNextRotation:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordNextRotation(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldMfaActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaActive(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey1Active:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey1Active(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastRotated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey1LastRotated(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastUsedDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey1LastUsedDate(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastUsedRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey1LastUsedRegion(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastUsedService:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey1LastUsedService(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey2Active:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey2Active(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastRotated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey2LastRotated(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastUsedDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey2LastUsedDate(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastUsedRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey2LastUsedRegion(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastUsedService:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey2LastUsedService(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldCert1Active:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCert1Active(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldCert1LastRotated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCert1LastRotated(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldCert2Active:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCert2Active(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldCert2LastRotated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCert2LastRotated(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldReportGeneratedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReportGeneratedAt(v)
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccountID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMCredentialReport field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeAWSIAMCredentialReportMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeAWSIAMCredentialReportMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMCredentialReport numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzeawsiamcredentialreport.FieldUserCreationTime) {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldUserCreationTime)
+	}
+	if m.FieldCleared(bronzeawsiamcredentialreport.FieldPasswordEnabled) {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldPasswordEnabled)
+	}
+	if m.FieldCleared(bronzeawsiamcredentialreport.FieldPasswordLastUsed) {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldPasswordLastUsed)
+	}
+	if m.FieldCleared(bronzeawsiamcredentialreport.FieldPasswordLastChanged) {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldPasswordLastChanged)
+	}
+	if m.FieldCleared(bronzeawsiamcredentialreport.FieldPasswordNextRotation) {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldPasswordNextRotation)
+	}
+	if m.FieldCleared(bronzeawsiamcredentialreport.FieldAccessKey1LastRotated) {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldAccessKey1LastRotated)
+	}
+	if m.FieldCleared(bronzeawsiamcredentialreport.FieldAccessKey1LastUsedDate) {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldAccessKey1LastUsedDate)
+	}
+	if m.FieldCleared(bronzeawsiamcredentialreport.FieldAccessKey1LastUsedRegion) {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldAccessKey1LastUsedRegion)
+	}
+	if m.FieldCleared(bronzeawsiamcredentialreport.FieldAccessKey1LastUsedService) {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldAccessKey1LastUsedService)
+	}
+	if m.FieldCleared(bronzeawsiamcredentialreport.FieldAccessKey2LastRotated) {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldAccessKey2LastRotated)
+	}
+	if m.FieldCleared(bronzeawsiamcredentialreport.FieldAccessKey2LastUsedDate) {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldAccessKey2LastUsedDate)
+	}
+	if m.FieldCleared(bronzeawsiamcredentialreport.FieldAccessKey2LastUsedRegion) {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldAccessKey2LastUsedRegion)
+	}
+	if m.FieldCleared(bronzeawsiamcredentialreport.FieldAccessKey2LastUsedService) {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldAccessKey2LastUsedService)
+	}
+	if m.FieldCleared(bronzeawsiamcredentialreport.FieldCert1LastRotated) {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldCert1LastRotated)
+	}
+	if m.FieldCleared(bronzeawsiamcredentialreport.FieldCert2LastRotated) {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldCert2LastRotated)
+	}
+	if m.FieldCleared(bronzeawsiamcredentialreport.FieldReportGeneratedAt) {
+		fields = append(fields, bronzeawsiamcredentialreport.FieldReportGeneratedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearField(name string) error {
+	switch name {
+	case bronzeawsiamcredentialreport.FieldUserCreationTime:
+		m.ClearUserCreationTime()
+		return nil
+	case bronzeawsiamcredentialreport.FieldPasswordEnabled:
+		m.ClearPasswordEnabled()
+		return nil
+	case bronzeawsiamcredentialreport.FieldPasswordLastUsed:
+		m.ClearPasswordLastUsed()
+		return nil
+	case bronzeawsiamcredentialreport.FieldPasswordLastChanged:
+		m.ClearPasswordLastChanged()
+		return nil
+	case bronzeawsiamcredentialreport.FieldPasswordNextRotation:
+		m.ClearPasswordNextRotation()
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastRotated:
+		m.ClearAccessKey1LastRotated()
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastUsedDate:
+		m.ClearAccessKey1LastUsedDate()
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastUsedRegion:
+		m.ClearAccessKey1LastUsedRegion()
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastUsedService:
+		m.ClearAccessKey1LastUsedService()
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastRotated:
+		m.ClearAccessKey2LastRotated()
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastUsedDate:
+		m.ClearAccessKey2LastUsedDate()
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastUsedRegion:
+		m.ClearAccessKey2LastUsedRegion()
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastUsedService:
+		m.ClearAccessKey2LastUsedService()
+		return nil
+	case bronzeawsiamcredentialreport.FieldCert1LastRotated:
+		m.ClearCert1LastRotated()
+		return nil
+	case bronzeawsiamcredentialreport.FieldCert2LastRotated:
+		m.ClearCert2LastRotated()
+		return nil
+	case bronzeawsiamcredentialreport.FieldReportGeneratedAt:
+		m.ClearReportGeneratedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMCredentialReport nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetField(name string) error {
+	switch name {
+	case bronzeawsiamcredentialreport.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzeawsiamcredentialreport.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzeawsiamcredentialreport.FieldUser:
+		m.ResetUser()
+		return nil
+	case bronzeawsiamcredentialreport.FieldUserCreationTime:
+		m.ResetUserCreationTime()
+		return nil
+	case bronzeawsiamcredentialreport.FieldPasswordEnabled:
+		m.ResetPasswordEnabled()
+		return nil
+	case bronzeawsiamcredentialreport.FieldPasswordLastUsed:
+		m.ResetPasswordLastUsed()
+		return nil
+	case bronzeawsiamcredentialreport.FieldPasswordLastChanged:
+		m.ResetPasswordLastChanged()
+		return nil
+	case bronzeawsiamcredentialreport.FieldPasswordNextRotation:
+		m.ResetPasswordNextRotation()
+		return nil
+	case bronzeawsiamcredentialreport.FieldMfaActive:
+		m.ResetMfaActive()
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey1Active:
+		m.ResetAccessKey1Active()
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastRotated:
+		m.ResetAccessKey1LastRotated()
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastUsedDate:
+		m.ResetAccessKey1LastUsedDate()
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastUsedRegion:
+		m.ResetAccessKey1LastUsedRegion()
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey1LastUsedService:
+		m.ResetAccessKey1LastUsedService()
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey2Active:
+		m.ResetAccessKey2Active()
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastRotated:
+		m.ResetAccessKey2LastRotated()
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastUsedDate:
+		m.ResetAccessKey2LastUsedDate()
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastUsedRegion:
+		m.ResetAccessKey2LastUsedRegion()
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccessKey2LastUsedService:
+		m.ResetAccessKey2LastUsedService()
+		return nil
+	case bronzeawsiamcredentialreport.FieldCert1Active:
+		m.ResetCert1Active()
+		return nil
+	case bronzeawsiamcredentialreport.FieldCert1LastRotated:
+		m.ResetCert1LastRotated()
+		return nil
+	case bronzeawsiamcredentialreport.FieldCert2Active:
+		m.ResetCert2Active()
+		return nil
+	case bronzeawsiamcredentialreport.FieldCert2LastRotated:
+		m.ResetCert2LastRotated()
+		return nil
+	case bronzeawsiamcredentialreport.FieldReportGeneratedAt:
+		m.ResetReportGeneratedAt()
+		return nil
+	case bronzeawsiamcredentialreport.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMCredentialReport field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeAWSIAMCredentialReportMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeAWSIAMCredentialReportMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeAWSIAMCredentialReport unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeAWSIAMCredentialReportMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeAWSIAMCredentialReport edge %s", name)
+}
+
+// BronzeAWSIAMGroupMutation represents an operation that mutates the BronzeAWSIAMGroup nodes in the graph.
+type BronzeAWSIAMGroupMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *string
+	collected_at                 *time.Time
+	first_collected_at           *time.Time
+	arn                          *string
+	group_name                   *string
+	_path                        *string
+	create_date                  *time.Time
+	attached_policies_json       *json.RawMessage
+	appendattached_policies_json json.RawMessage
+	inline_policies_json         *json.RawMessage
+	appendinline_policies_json   json.RawMessage
+	account_id                   *string
+	clearedFields                map[string]struct{}
+	done                         bool
+	oldValue                     func(context.Context) (*BronzeAWSIAMGroup, error)
+	predicates                   []predicate.BronzeAWSIAMGroup
+}
+
+var _ ent.Mutation = (*BronzeAWSIAMGroupMutation)(nil)
+
+// bronzeawsiamgroupOption allows management of the mutation configuration using functional options.
+type bronzeawsiamgroupOption func(*BronzeAWSIAMGroupMutation)
+
+// newBronzeAWSIAMGroupMutation creates new mutation for the BronzeAWSIAMGroup entity.
+func newBronzeAWSIAMGroupMutation(c config, op Op, opts ...bronzeawsiamgroupOption) *BronzeAWSIAMGroupMutation {
+	m := &BronzeAWSIAMGroupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeAWSIAMGroup,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeAWSIAMGroupID sets the ID field of the mutation.
+func withBronzeAWSIAMGroupID(id string) bronzeawsiamgroupOption {
+	return func(m *BronzeAWSIAMGroupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeAWSIAMGroup
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeAWSIAMGroup, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeAWSIAMGroup.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeAWSIAMGroup sets the old BronzeAWSIAMGroup of the mutation.
+func withBronzeAWSIAMGroup(node *BronzeAWSIAMGroup) bronzeawsiamgroupOption {
+	return func(m *BronzeAWSIAMGroupMutation) {
+		m.oldValue = func(context.Context) (*BronzeAWSIAMGroup, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeAWSIAMGroupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeAWSIAMGroupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("iam: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeAWSIAMGroup entities.
+func (m *BronzeAWSIAMGroupMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeAWSIAMGroupMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeAWSIAMGroupMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeAWSIAMGroup.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeAWSIAMGroupMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeAWSIAMGroupMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeAWSIAMGroup entity.
+// If the BronzeAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMGroupMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeAWSIAMGroupMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeAWSIAMGroupMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeAWSIAMGroupMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeAWSIAMGroup entity.
+// If the BronzeAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMGroupMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeAWSIAMGroupMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetArn sets the "arn" field.
+func (m *BronzeAWSIAMGroupMutation) SetArn(s string) {
+	m.arn = &s
+}
+
+// Arn returns the value of the "arn" field in the mutation.
+func (m *BronzeAWSIAMGroupMutation) Arn() (r string, exists bool) {
+	v := m.arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArn returns the old "arn" field's value of the BronzeAWSIAMGroup entity.
+// If the BronzeAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMGroupMutation) OldArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArn: %w", err)
+	}
+	return oldValue.Arn, nil
+}
+
+// ResetArn resets all changes to the "arn" field.
+func (m *BronzeAWSIAMGroupMutation) ResetArn() {
+	m.arn = nil
+}
+
+// SetGroupName sets the "group_name" field.
+func (m *BronzeAWSIAMGroupMutation) SetGroupName(s string) {
+	m.group_name = &s
+}
+
+// GroupName returns the value of the "group_name" field in the mutation.
+func (m *BronzeAWSIAMGroupMutation) GroupName() (r string, exists bool) {
+	v := m.group_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupName returns the old "group_name" field's value of the BronzeAWSIAMGroup entity.
+// If the BronzeAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMGroupMutation) OldGroupName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupName: %w", err)
+	}
+	return oldValue.GroupName, nil
+}
+
+// ResetGroupName resets all changes to the "group_name" field.
+func (m *BronzeAWSIAMGroupMutation) ResetGroupName() {
+	m.group_name = nil
+}
+
+// SetPath sets the "path" field.
+func (m *BronzeAWSIAMGroupMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *BronzeAWSIAMGroupMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the BronzeAWSIAMGroup entity.
+// If the BronzeAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMGroupMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ClearPath clears the value of the "path" field.
+func (m *BronzeAWSIAMGroupMutation) ClearPath() {
+	m._path = nil
+	m.clearedFields[bronzeawsiamgroup.FieldPath] = struct{}{}
+}
+
+// PathCleared returns if the "path" field was cleared in this mutation.
+func (m *BronzeAWSIAMGroupMutation) PathCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamgroup.FieldPath]
+	return ok
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *BronzeAWSIAMGroupMutation) ResetPath() {
+	m._path = nil
+	delete(m.clearedFields, bronzeawsiamgroup.FieldPath)
+}
+
+// SetCreateDate sets the "create_date" field.
+func (m *BronzeAWSIAMGroupMutation) SetCreateDate(t time.Time) {
+	m.create_date = &t
+}
+
+// CreateDate returns the value of the "create_date" field in the mutation.
+func (m *BronzeAWSIAMGroupMutation) CreateDate() (r time.Time, exists bool) {
+	v := m.create_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateDate returns the old "create_date" field's value of the BronzeAWSIAMGroup entity.
+// If the BronzeAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMGroupMutation) OldCreateDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateDate: %w", err)
+	}
+	return oldValue.CreateDate, nil
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (m *BronzeAWSIAMGroupMutation) ClearCreateDate() {
+	m.create_date = nil
+	m.clearedFields[bronzeawsiamgroup.FieldCreateDate] = struct{}{}
+}
+
+// CreateDateCleared returns if the "create_date" field was cleared in this mutation.
+func (m *BronzeAWSIAMGroupMutation) CreateDateCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamgroup.FieldCreateDate]
+	return ok
+}
+
+// ResetCreateDate resets all changes to the "create_date" field.
+func (m *BronzeAWSIAMGroupMutation) ResetCreateDate() {
+	m.create_date = nil
+	delete(m.clearedFields, bronzeawsiamgroup.FieldCreateDate)
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (m *BronzeAWSIAMGroupMutation) SetAttachedPoliciesJSON(jm json.RawMessage) {
+	m.attached_policies_json = &jm
+	m.appendattached_policies_json = nil
+}
+
+// AttachedPoliciesJSON returns the value of the "attached_policies_json" field in the mutation.
+func (m *BronzeAWSIAMGroupMutation) AttachedPoliciesJSON() (r json.RawMessage, exists bool) {
+	v := m.attached_policies_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttachedPoliciesJSON returns the old "attached_policies_json" field's value of the BronzeAWSIAMGroup entity.
+// If the BronzeAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMGroupMutation) OldAttachedPoliciesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttachedPoliciesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttachedPoliciesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttachedPoliciesJSON: %w", err)
+	}
+	return oldValue.AttachedPoliciesJSON, nil
+}
+
+// AppendAttachedPoliciesJSON adds jm to the "attached_policies_json" field.
+func (m *BronzeAWSIAMGroupMutation) AppendAttachedPoliciesJSON(jm json.RawMessage) {
+	m.appendattached_policies_json = append(m.appendattached_policies_json, jm...)
+}
+
+// AppendedAttachedPoliciesJSON returns the list of values that were appended to the "attached_policies_json" field in this mutation.
+func (m *BronzeAWSIAMGroupMutation) AppendedAttachedPoliciesJSON() (json.RawMessage, bool) {
+	if len(m.appendattached_policies_json) == 0 {
+		return nil, false
+	}
+	return m.appendattached_policies_json, true
+}
+
+// ClearAttachedPoliciesJSON clears the value of the "attached_policies_json" field.
+func (m *BronzeAWSIAMGroupMutation) ClearAttachedPoliciesJSON() {
+	m.attached_policies_json = nil
+	m.appendattached_policies_json = nil
+	m.clearedFields[bronzeawsiamgroup.FieldAttachedPoliciesJSON] = struct{}{}
+}
+
+// AttachedPoliciesJSONCleared returns if the "attached_policies_json" field was cleared in this mutation.
+func (m *BronzeAWSIAMGroupMutation) AttachedPoliciesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamgroup.FieldAttachedPoliciesJSON]
+	return ok
+}
+
+// ResetAttachedPoliciesJSON resets all changes to the "attached_policies_json" field.
+func (m *BronzeAWSIAMGroupMutation) ResetAttachedPoliciesJSON() {
+	m.attached_policies_json = nil
+	m.appendattached_policies_json = nil
+	delete(m.clearedFields, bronzeawsiamgroup.FieldAttachedPoliciesJSON)
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (m *BronzeAWSIAMGroupMutation) SetInlinePoliciesJSON(jm json.RawMessage) {
+	m.inline_policies_json = &jm
+	m.appendinline_policies_json = nil
+}
+
+// InlinePoliciesJSON returns the value of the "inline_policies_json" field in the mutation.
+func (m *BronzeAWSIAMGroupMutation) InlinePoliciesJSON() (r json.RawMessage, exists bool) {
+	v := m.inline_policies_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInlinePoliciesJSON returns the old "inline_policies_json" field's value of the BronzeAWSIAMGroup entity.
+// If the BronzeAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMGroupMutation) OldInlinePoliciesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInlinePoliciesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInlinePoliciesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInlinePoliciesJSON: %w", err)
+	}
+	return oldValue.InlinePoliciesJSON, nil
+}
+
+// AppendInlinePoliciesJSON adds jm to the "inline_policies_json" field.
+func (m *BronzeAWSIAMGroupMutation) AppendInlinePoliciesJSON(jm json.RawMessage) {
+	m.appendinline_policies_json = append(m.appendinline_policies_json, jm...)
+}
+
+// AppendedInlinePoliciesJSON returns the list of values that were appended to the "inline_policies_json" field in this mutation.
+func (m *BronzeAWSIAMGroupMutation) AppendedInlinePoliciesJSON() (json.RawMessage, bool) {
+	if len(m.appendinline_policies_json) == 0 {
+		return nil, false
+	}
+	return m.appendinline_policies_json, true
+}
+
+// ClearInlinePoliciesJSON clears the value of the "inline_policies_json" field.
+func (m *BronzeAWSIAMGroupMutation) ClearInlinePoliciesJSON() {
+	m.inline_policies_json = nil
+	m.appendinline_policies_json = nil
+	m.clearedFields[bronzeawsiamgroup.FieldInlinePoliciesJSON] = struct{}{}
+}
+
+// InlinePoliciesJSONCleared returns if the "inline_policies_json" field was cleared in this mutation.
+func (m *BronzeAWSIAMGroupMutation) InlinePoliciesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamgroup.FieldInlinePoliciesJSON]
+	return ok
+}
+
+// ResetInlinePoliciesJSON resets all changes to the "inline_policies_json" field.
+func (m *BronzeAWSIAMGroupMutation) ResetInlinePoliciesJSON() {
+	m.inline_policies_json = nil
+	m.appendinline_policies_json = nil
+	delete(m.clearedFields, bronzeawsiamgroup.FieldInlinePoliciesJSON)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BronzeAWSIAMGroupMutation) SetAccountID(s string) {
+	m.account_id = &s
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BronzeAWSIAMGroupMutation) AccountID() (r string, exists bool) {
+	v := m.account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the BronzeAWSIAMGroup entity.
+// If the BronzeAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMGroupMutation) OldAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BronzeAWSIAMGroupMutation) ResetAccountID() {
+	m.account_id = nil
+}
+
+// Where appends a list predicates to the BronzeAWSIAMGroupMutation builder.
+func (m *BronzeAWSIAMGroupMutation) Where(ps ...predicate.BronzeAWSIAMGroup) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeAWSIAMGroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeAWSIAMGroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeAWSIAMGroup, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeAWSIAMGroupMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeAWSIAMGroupMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeAWSIAMGroup).
+func (m *BronzeAWSIAMGroupMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeAWSIAMGroupMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.collected_at != nil {
+		fields = append(fields, bronzeawsiamgroup.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzeawsiamgroup.FieldFirstCollectedAt)
+	}
+	if m.arn != nil {
+		fields = append(fields, bronzeawsiamgroup.FieldArn)
+	}
+	if m.group_name != nil {
+		fields = append(fields, bronzeawsiamgroup.FieldGroupName)
+	}
+	if m._path != nil {
+		fields = append(fields, bronzeawsiamgroup.FieldPath)
+	}
+	if m.create_date != nil {
+		fields = append(fields, bronzeawsiamgroup.FieldCreateDate)
+	}
+	if m.attached_policies_json != nil {
+		fields = append(fields, bronzeawsiamgroup.FieldAttachedPoliciesJSON)
+	}
+	if m.inline_policies_json != nil {
+		fields = append(fields, bronzeawsiamgroup.FieldInlinePoliciesJSON)
+	}
+	if m.account_id != nil {
+		fields = append(fields, bronzeawsiamgroup.FieldAccountID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeAWSIAMGroupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzeawsiamgroup.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzeawsiamgroup.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzeawsiamgroup.FieldArn:
+		return m.Arn()
+	case bronzeawsiamgroup.FieldGroupName:
+		return m.GroupName()
+	case bronzeawsiamgroup.FieldPath:
+		return m.Path()
+	case bronzeawsiamgroup.FieldCreateDate:
+		return m.CreateDate()
+	case bronzeawsiamgroup.FieldAttachedPoliciesJSON:
+		return m.AttachedPoliciesJSON()
+	case bronzeawsiamgroup.FieldInlinePoliciesJSON:
+		return m.InlinePoliciesJSON()
+	case bronzeawsiamgroup.FieldAccountID:
+		return m.AccountID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeAWSIAMGroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzeawsiamgroup.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzeawsiamgroup.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzeawsiamgroup.FieldArn:
+		return m.OldArn(ctx)
+	case bronzeawsiamgroup.FieldGroupName:
+		return m.OldGroupName(ctx)
+	case bronzeawsiamgroup.FieldPath:
+		return m.OldPath(ctx)
+	case bronzeawsiamgroup.FieldCreateDate:
+		return m.OldCreateDate(ctx)
+	case bronzeawsiamgroup.FieldAttachedPoliciesJSON:
+		return m.OldAttachedPoliciesJSON(ctx)
+	case bronzeawsiamgroup.FieldInlinePoliciesJSON:
+		return m.OldInlinePoliciesJSON(ctx)
+	case bronzeawsiamgroup.FieldAccountID:
+		return m.OldAccountID(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeAWSIAMGroup field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeAWSIAMGroupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzeawsiamgroup.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzeawsiamgroup.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzeawsiamgroup.FieldArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArn(v)
+		return nil
+	case bronzeawsiamgroup.FieldGroupName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupName(v)
+		return nil
+	case bronzeawsiamgroup.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case bronzeawsiamgroup.FieldCreateDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateDate(v)
+		return nil
+	case bronzeawsiamgroup.FieldAttachedPoliciesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttachedPoliciesJSON(v)
+		return nil
+	case bronzeawsiamgroup.FieldInlinePoliciesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInlinePoliciesJSON(v)
+		return nil
+	case bronzeawsiamgroup.FieldAccountID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMGroup field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeAWSIAMGroupMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeAWSIAMGroupMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeAWSIAMGroupMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMGroup numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeAWSIAMGroupMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzeawsiamgroup.FieldPath) {
+		fields = append(fields, bronzeawsiamgroup.FieldPath)
+	}
+	if m.FieldCleared(bronzeawsiamgroup.FieldCreateDate) {
+		fields = append(fields, bronzeawsiamgroup.FieldCreateDate)
+	}
+	if m.FieldCleared(bronzeawsiamgroup.FieldAttachedPoliciesJSON) {
+		fields = append(fields, bronzeawsiamgroup.FieldAttachedPoliciesJSON)
+	}
+	if m.FieldCleared(bronzeawsiamgroup.FieldInlinePoliciesJSON) {
+		fields = append(fields, bronzeawsiamgroup.FieldInlinePoliciesJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeAWSIAMGroupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeAWSIAMGroupMutation) ClearField(name string) error {
+	switch name {
+	case bronzeawsiamgroup.FieldPath:
+		m.ClearPath()
+		return nil
+	case bronzeawsiamgroup.FieldCreateDate:
+		m.ClearCreateDate()
+		return nil
+	case bronzeawsiamgroup.FieldAttachedPoliciesJSON:
+		m.ClearAttachedPoliciesJSON()
+		return nil
+	case bronzeawsiamgroup.FieldInlinePoliciesJSON:
+		m.ClearInlinePoliciesJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMGroup nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeAWSIAMGroupMutation) ResetField(name string) error {
+	switch name {
+	case bronzeawsiamgroup.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzeawsiamgroup.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzeawsiamgroup.FieldArn:
+		m.ResetArn()
+		return nil
+	case bronzeawsiamgroup.FieldGroupName:
+		m.ResetGroupName()
+		return nil
+	case bronzeawsiamgroup.FieldPath:
+		m.ResetPath()
+		return nil
+	case bronzeawsiamgroup.FieldCreateDate:
+		m.ResetCreateDate()
+		return nil
+	case bronzeawsiamgroup.FieldAttachedPoliciesJSON:
+		m.ResetAttachedPoliciesJSON()
+		return nil
+	case bronzeawsiamgroup.FieldInlinePoliciesJSON:
+		m.ResetInlinePoliciesJSON()
+		return nil
+	case bronzeawsiamgroup.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMGroup field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeAWSIAMGroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeAWSIAMGroupMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeAWSIAMGroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeAWSIAMGroupMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeAWSIAMGroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeAWSIAMGroupMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeAWSIAMGroupMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeAWSIAMGroup unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeAWSIAMGroupMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeAWSIAMGroup edge %s", name)
+}
+
+// BronzeAWSIAMPolicyMutation represents an operation that mutates the BronzeAWSIAMPolicy nodes in the graph.
+type BronzeAWSIAMPolicyMutation struct {
+	config
+	op                                  Op
+	typ                                 string
+	id                                  *string
+	collected_at                        *time.Time
+	first_collected_at                  *time.Time
+	arn                                 *string
+	policy_name                         *string
+	_path                               *string
+	description                         *string
+	default_version_id                  *string
+	attachment_count                    *int
+	addattachment_count                 *int
+	permissions_boundary_usage_count    *int
+	addpermissions_boundary_usage_count *int
+	is_attachable                       *bool
+	aws_managed                         *bool
+	create_date                         *time.Time
+	update_date                         *time.Time
+	versions_json                       *json.RawMessage
+	appendversions_json                 json.RawMessage
+	account_id                          *string
+	clearedFields                       map[string]struct{}
+	done                                bool
+	oldValue                            func(context.Context) (*BronzeAWSIAMPolicy, error)
+	predicates                          []predicate.BronzeAWSIAMPolicy
+}
+
+var _ ent.Mutation = (*BronzeAWSIAMPolicyMutation)(nil)
+
+// bronzeawsiampolicyOption allows management of the mutation configuration using functional options.
+type bronzeawsiampolicyOption func(*BronzeAWSIAMPolicyMutation)
+
+// newBronzeAWSIAMPolicyMutation creates new mutation for the BronzeAWSIAMPolicy entity.
+func newBronzeAWSIAMPolicyMutation(c config, op Op, opts ...bronzeawsiampolicyOption) *BronzeAWSIAMPolicyMutation {
+	m := &BronzeAWSIAMPolicyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeAWSIAMPolicy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeAWSIAMPolicyID sets the ID field of the mutation.
+func withBronzeAWSIAMPolicyID(id string) bronzeawsiampolicyOption {
+	return func(m *BronzeAWSIAMPolicyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeAWSIAMPolicy
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeAWSIAMPolicy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeAWSIAMPolicy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeAWSIAMPolicy sets the old BronzeAWSIAMPolicy of the mutation.
+func withBronzeAWSIAMPolicy(node *BronzeAWSIAMPolicy) bronzeawsiampolicyOption {
+	return func(m *BronzeAWSIAMPolicyMutation) {
+		m.oldValue = func(context.Context) (*BronzeAWSIAMPolicy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeAWSIAMPolicyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeAWSIAMPolicyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("iam: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeAWSIAMPolicy entities.
+func (m *BronzeAWSIAMPolicyMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeAWSIAMPolicyMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeAWSIAMPolicyMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeAWSIAMPolicy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeAWSIAMPolicyMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeAWSIAMPolicyMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeAWSIAMPolicy entity.
+// If the BronzeAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMPolicyMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeAWSIAMPolicyMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeAWSIAMPolicyMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeAWSIAMPolicyMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeAWSIAMPolicy entity.
+// If the BronzeAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMPolicyMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeAWSIAMPolicyMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetArn sets the "arn" field.
+func (m *BronzeAWSIAMPolicyMutation) SetArn(s string) {
+	m.arn = &s
+}
+
+// Arn returns the value of the "arn" field in the mutation.
+func (m *BronzeAWSIAMPolicyMutation) Arn() (r string, exists bool) {
+	v := m.arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArn returns the old "arn" field's value of the BronzeAWSIAMPolicy entity.
+// If the BronzeAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMPolicyMutation) OldArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArn: %w", err)
+	}
+	return oldValue.Arn, nil
+}
+
+// ResetArn resets all changes to the "arn" field.
+func (m *BronzeAWSIAMPolicyMutation) ResetArn() {
+	m.arn = nil
+}
+
+// SetPolicyName sets the "policy_name" field.
+func (m *BronzeAWSIAMPolicyMutation) SetPolicyName(s string) {
+	m.policy_name = &s
+}
+
+// PolicyName returns the value of the "policy_name" field in the mutation.
+func (m *BronzeAWSIAMPolicyMutation) PolicyName() (r string, exists bool) {
+	v := m.policy_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolicyName returns the old "policy_name" field's value of the BronzeAWSIAMPolicy entity.
+// If the BronzeAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMPolicyMutation) OldPolicyName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicyName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicyName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicyName: %w", err)
+	}
+	return oldValue.PolicyName, nil
+}
+
+// ResetPolicyName resets all changes to the "policy_name" field.
+func (m *BronzeAWSIAMPolicyMutation) ResetPolicyName() {
+	m.policy_name = nil
+}
+
+// SetPath sets the "path" field.
+func (m *BronzeAWSIAMPolicyMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *BronzeAWSIAMPolicyMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the BronzeAWSIAMPolicy entity.
+// If the BronzeAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMPolicyMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ClearPath clears the value of the "path" field.
+func (m *BronzeAWSIAMPolicyMutation) ClearPath() {
+	m._path = nil
+	m.clearedFields[bronzeawsiampolicy.FieldPath] = struct{}{}
+}
+
+// PathCleared returns if the "path" field was cleared in this mutation.
+func (m *BronzeAWSIAMPolicyMutation) PathCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiampolicy.FieldPath]
+	return ok
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *BronzeAWSIAMPolicyMutation) ResetPath() {
+	m._path = nil
+	delete(m.clearedFields, bronzeawsiampolicy.FieldPath)
+}
+
+// SetDescription sets the "description" field.
+func (m *BronzeAWSIAMPolicyMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *BronzeAWSIAMPolicyMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the BronzeAWSIAMPolicy entity.
+// If the BronzeAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMPolicyMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *BronzeAWSIAMPolicyMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[bronzeawsiampolicy.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *BronzeAWSIAMPolicyMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiampolicy.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *BronzeAWSIAMPolicyMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, bronzeawsiampolicy.FieldDescription)
+}
+
+// SetDefaultVersionID sets the "default_version_id" field.
+func (m *BronzeAWSIAMPolicyMutation) SetDefaultVersionID(s string) {
+	m.default_version_id = &s
+}
+
+// DefaultVersionID returns the value of the "default_version_id" field in the mutation.
+func (m *BronzeAWSIAMPolicyMutation) DefaultVersionID() (r string, exists bool) {
+	v := m.default_version_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultVersionID returns the old "default_version_id" field's value of the BronzeAWSIAMPolicy entity.
+// If the BronzeAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMPolicyMutation) OldDefaultVersionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultVersionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultVersionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultVersionID: %w", err)
+	}
+	return oldValue.DefaultVersionID, nil
+}
+
+// ClearDefaultVersionID clears the value of the "default_version_id" field.
+func (m *BronzeAWSIAMPolicyMutation) ClearDefaultVersionID() {
+	m.default_version_id = nil
+	m.clearedFields[bronzeawsiampolicy.FieldDefaultVersionID] = struct{}{}
+}
+
+// DefaultVersionIDCleared returns if the "default_version_id" field was cleared in this mutation.
+func (m *BronzeAWSIAMPolicyMutation) DefaultVersionIDCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiampolicy.FieldDefaultVersionID]
+	return ok
+}
+
+// ResetDefaultVersionID resets all changes to the "default_version_id" field.
+func (m *BronzeAWSIAMPolicyMutation) ResetDefaultVersionID() {
+	m.default_version_id = nil
+	delete(m.clearedFields, bronzeawsiampolicy.FieldDefaultVersionID)
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (m *BronzeAWSIAMPolicyMutation) SetAttachmentCount(i int) {
+	m.attachment_count = &i
+	m.addattachment_count = nil
+}
+
+// AttachmentCount returns the value of the "attachment_count" field in the mutation.
+func (m *BronzeAWSIAMPolicyMutation) AttachmentCount() (r int, exists bool) {
+	v := m.attachment_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttachmentCount returns the old "attachment_count" field's value of the BronzeAWSIAMPolicy entity.
+// If the BronzeAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMPolicyMutation) OldAttachmentCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttachmentCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttachmentCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttachmentCount: %w", err)
+	}
+	return oldValue.AttachmentCount, nil
+}
+
+// AddAttachmentCount adds i to the "attachment_count" field.
+func (m *BronzeAWSIAMPolicyMutation) AddAttachmentCount(i int) {
+	if m.addattachment_count != nil {
+		*m.addattachment_count += i
+	} else {
+		m.addattachment_count = &i
+	}
+}
+
+// AddedAttachmentCount returns the value that was added to the "attachment_count" field in this mutation.
+func (m *BronzeAWSIAMPolicyMutation) AddedAttachmentCount() (r int, exists bool) {
+	v := m.addattachment_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttachmentCount resets all changes to the "attachment_count" field.
+func (m *BronzeAWSIAMPolicyMutation) ResetAttachmentCount() {
+	m.attachment_count = nil
+	m.addattachment_count = nil
+}
+
+// SetPermissionsBoundaryUsageCount sets the "permissions_boundary_usage_count" field.
+func (m *BronzeAWSIAMPolicyMutation) SetPermissionsBoundaryUsageCount(i int) {
+	m.permissions_boundary_usage_count = &i
+	m.addpermissions_boundary_usage_count = nil
+}
+
+// PermissionsBoundaryUsageCount returns the value of the "permissions_boundary_usage_count" field in the mutation.
+func (m *BronzeAWSIAMPolicyMutation) PermissionsBoundaryUsageCount() (r int, exists bool) {
+	v := m.permissions_boundary_usage_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPermissionsBoundaryUsageCount returns the old "permissions_boundary_usage_count" field's value of the BronzeAWSIAMPolicy entity.
+// If the BronzeAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMPolicyMutation) OldPermissionsBoundaryUsageCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermissionsBoundaryUsageCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermissionsBoundaryUsageCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermissionsBoundaryUsageCount: %w", err)
+	}
+	return oldValue.PermissionsBoundaryUsageCount, nil
+}
+
+// AddPermissionsBoundaryUsageCount adds i to the "permissions_boundary_usage_count" field.
+func (m *BronzeAWSIAMPolicyMutation) AddPermissionsBoundaryUsageCount(i int) {
+	if m.addpermissions_boundary_usage_count != nil {
+		*m.addpermissions_boundary_usage_count += i
+	} else {
+		m.addpermissions_boundary_usage_count = &i
+	}
+}
+
+// AddedPermissionsBoundaryUsageCount returns the value that was added to the "permissions_boundary_usage_count" field in this mutation.
+func (m *BronzeAWSIAMPolicyMutation) AddedPermissionsBoundaryUsageCount() (r int, exists bool) {
+	v := m.addpermissions_boundary_usage_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPermissionsBoundaryUsageCount resets all changes to the "permissions_boundary_usage_count" field.
+func (m *BronzeAWSIAMPolicyMutation) ResetPermissionsBoundaryUsageCount() {
+	m.permissions_boundary_usage_count = nil
+	m.addpermissions_boundary_usage_count = nil
+}
+
+// SetIsAttachable sets the "is_attachable" field.
+func (m *BronzeAWSIAMPolicyMutation) SetIsAttachable(b bool) {
+	m.is_attachable = &b
+}
+
+// IsAttachable returns the value of the "is_attachable" field in the mutation.
+func (m *BronzeAWSIAMPolicyMutation) IsAttachable() (r bool, exists bool) {
+	v := m.is_attachable
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsAttachable returns the old "is_attachable" field's value of the BronzeAWSIAMPolicy entity.
+// If the BronzeAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMPolicyMutation) OldIsAttachable(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsAttachable is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsAttachable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsAttachable: %w", err)
+	}
+	return oldValue.IsAttachable, nil
+}
+
+// ResetIsAttachable resets all changes to the "is_attachable" field.
+func (m *BronzeAWSIAMPolicyMutation) ResetIsAttachable() {
+	m.is_attachable = nil
+}
+
+// SetAWSManaged sets the "aws_managed" field.
+func (m *BronzeAWSIAMPolicyMutation) SetAWSManaged(b bool) {
+	m.aws_managed = &b
+}
+
+// AWSManaged returns the value of the "aws_managed" field in the mutation.
+func (m *BronzeAWSIAMPolicyMutation) AWSManaged() (r bool, exists bool) {
+	v := m.aws_managed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAWSManaged returns the old "aws_managed" field's value of the BronzeAWSIAMPolicy entity.
+// If the BronzeAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMPolicyMutation) OldAWSManaged(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAWSManaged is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAWSManaged requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAWSManaged: %w", err)
+	}
+	return oldValue.AWSManaged, nil
+}
+
+// ResetAWSManaged resets all changes to the "aws_managed" field.
+func (m *BronzeAWSIAMPolicyMutation) ResetAWSManaged() {
+	m.aws_managed = nil
+}
+
+// SetCreateDate sets the "create_date" field.
+func (m *BronzeAWSIAMPolicyMutation) SetCreateDate(t time.Time) {
+	m.create_date = &t
+}
+
+// CreateDate returns the value of the "create_date" field in the mutation.
+func (m *BronzeAWSIAMPolicyMutation) CreateDate() (r time.Time, exists bool) {
+	v := m.create_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateDate returns the old "create_date" field's value of the BronzeAWSIAMPolicy entity.
+// If the BronzeAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMPolicyMutation) OldCreateDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateDate: %w", err)
+	}
+	return oldValue.CreateDate, nil
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (m *BronzeAWSIAMPolicyMutation) ClearCreateDate() {
+	m.create_date = nil
+	m.clearedFields[bronzeawsiampolicy.FieldCreateDate] = struct{}{}
+}
+
+// CreateDateCleared returns if the "create_date" field was cleared in this mutation.
+func (m *BronzeAWSIAMPolicyMutation) CreateDateCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiampolicy.FieldCreateDate]
+	return ok
+}
+
+// ResetCreateDate resets all changes to the "create_date" field.
+func (m *BronzeAWSIAMPolicyMutation) ResetCreateDate() {
+	m.create_date = nil
+	delete(m.clearedFields, bronzeawsiampolicy.FieldCreateDate)
+}
+
+// SetUpdateDate sets the "update_date" field.
+func (m *BronzeAWSIAMPolicyMutation) SetUpdateDate(t time.Time) {
+	m.update_date = &t
+}
+
+// UpdateDate returns the value of the "update_date" field in the mutation.
+func (m *BronzeAWSIAMPolicyMutation) UpdateDate() (r time.Time, exists bool) {
+	v := m.update_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateDate returns the old "update_date" field's value of the BronzeAWSIAMPolicy entity.
+// If the BronzeAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMPolicyMutation) OldUpdateDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateDate: %w", err)
+	}
+	return oldValue.UpdateDate, nil
+}
+
+// ClearUpdateDate clears the value of the "update_date" field.
+func (m *BronzeAWSIAMPolicyMutation) ClearUpdateDate() {
+	m.update_date = nil
+	m.clearedFields[bronzeawsiampolicy.FieldUpdateDate] = struct{}{}
+}
+
+// UpdateDateCleared returns if the "update_date" field was cleared in this mutation.
+func (m *BronzeAWSIAMPolicyMutation) UpdateDateCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiampolicy.FieldUpdateDate]
+	return ok
+}
+
+// ResetUpdateDate resets all changes to the "update_date" field.
+func (m *BronzeAWSIAMPolicyMutation) ResetUpdateDate() {
+	m.update_date = nil
+	delete(m.clearedFields, bronzeawsiampolicy.FieldUpdateDate)
+}
+
+// SetVersionsJSON sets the "versions_json" field.
+func (m *BronzeAWSIAMPolicyMutation) SetVersionsJSON(jm json.RawMessage) {
+	m.versions_json = &jm
+	m.appendversions_json = nil
+}
+
+// VersionsJSON returns the value of the "versions_json" field in the mutation.
+func (m *BronzeAWSIAMPolicyMutation) VersionsJSON() (r json.RawMessage, exists bool) {
+	v := m.versions_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersionsJSON returns the old "versions_json" field's value of the BronzeAWSIAMPolicy entity.
+// If the BronzeAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMPolicyMutation) OldVersionsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersionsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersionsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersionsJSON: %w", err)
+	}
+	return oldValue.VersionsJSON, nil
+}
+
+// AppendVersionsJSON adds jm to the "versions_json" field.
+func (m *BronzeAWSIAMPolicyMutation) AppendVersionsJSON(jm json.RawMessage) {
+	m.appendversions_json = append(m.appendversions_json, jm...)
+}
+
+// AppendedVersionsJSON returns the list of values that were appended to the "versions_json" field in this mutation.
+func (m *BronzeAWSIAMPolicyMutation) AppendedVersionsJSON() (json.RawMessage, bool) {
+	if len(m.appendversions_json) == 0 {
+		return nil, false
+	}
+	return m.appendversions_json, true
+}
+
+// ClearVersionsJSON clears the value of the "versions_json" field.
+func (m *BronzeAWSIAMPolicyMutation) ClearVersionsJSON() {
+	m.versions_json = nil
+	m.appendversions_json = nil
+	m.clearedFields[bronzeawsiampolicy.FieldVersionsJSON] = struct{}{}
+}
+
+// VersionsJSONCleared returns if the "versions_json" field was cleared in this mutation.
+func (m *BronzeAWSIAMPolicyMutation) VersionsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiampolicy.FieldVersionsJSON]
+	return ok
+}
+
+// ResetVersionsJSON resets all changes to the "versions_json" field.
+func (m *BronzeAWSIAMPolicyMutation) ResetVersionsJSON() {
+	m.versions_json = nil
+	m.appendversions_json = nil
+	delete(m.clearedFields, bronzeawsiampolicy.FieldVersionsJSON)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BronzeAWSIAMPolicyMutation) SetAccountID(s string) {
+	m.account_id = &s
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BronzeAWSIAMPolicyMutation) AccountID() (r string, exists bool) {
+	v := m.account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the BronzeAWSIAMPolicy entity.
+// If the BronzeAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMPolicyMutation) OldAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BronzeAWSIAMPolicyMutation) ResetAccountID() {
+	m.account_id = nil
+}
+
+// Where appends a list predicates to the BronzeAWSIAMPolicyMutation builder.
+func (m *BronzeAWSIAMPolicyMutation) Where(ps ...predicate.BronzeAWSIAMPolicy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeAWSIAMPolicyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeAWSIAMPolicyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeAWSIAMPolicy, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeAWSIAMPolicyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeAWSIAMPolicyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeAWSIAMPolicy).
+func (m *BronzeAWSIAMPolicyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeAWSIAMPolicyMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.collected_at != nil {
+		fields = append(fields, bronzeawsiampolicy.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzeawsiampolicy.FieldFirstCollectedAt)
+	}
+	if m.arn != nil {
+		fields = append(fields, bronzeawsiampolicy.FieldArn)
+	}
+	if m.policy_name != nil {
+		fields = append(fields, bronzeawsiampolicy.FieldPolicyName)
+	}
+	if m._path != nil {
+		fields = append(fields, bronzeawsiampolicy.FieldPath)
+	}
+	if m.description != nil {
+		fields = append(fields, bronzeawsiampolicy.FieldDescription)
+	}
+	if m.default_version_id != nil {
+		fields = append(fields, bronzeawsiampolicy.FieldDefaultVersionID)
+	}
+	if m.attachment_count != nil {
+		fields = append(fields, bronzeawsiampolicy.FieldAttachmentCount)
+	}
+	if m.permissions_boundary_usage_count != nil {
+		fields = append(fields, bronzeawsiampolicy.FieldPermissionsBoundaryUsageCount)
+	}
+	if m.is_attachable != nil {
+		fields = append(fields, bronzeawsiampolicy.FieldIsAttachable)
+	}
+	if m.aws_managed != nil {
+		fields = append(fields, bronzeawsiampolicy.FieldAWSManaged)
+	}
+	if m.create_date != nil {
+		fields = append(fields, bronzeawsiampolicy.FieldCreateDate)
+	}
+	if m.update_date != nil {
+		fields = append(fields, bronzeawsiampolicy.FieldUpdateDate)
+	}
+	if m.versions_json != nil {
+		fields = append(fields, bronzeawsiampolicy.FieldVersionsJSON)
+	}
+	if m.account_id != nil {
+		fields = append(fields, bronzeawsiampolicy.FieldAccountID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeAWSIAMPolicyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzeawsiampolicy.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzeawsiampolicy.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzeawsiampolicy.FieldArn:
+		return m.Arn()
+	case bronzeawsiampolicy.FieldPolicyName:
+		return m.PolicyName()
+	case bronzeawsiampolicy.FieldPath:
+		return m.Path()
+	case bronzeawsiampolicy.FieldDescription:
+		return m.Description()
+	case bronzeawsiampolicy.FieldDefaultVersionID:
+		return m.DefaultVersionID()
+	case bronzeawsiampolicy.FieldAttachmentCount:
+		return m.AttachmentCount()
+	case bronzeawsiampolicy.FieldPermissionsBoundaryUsageCount:
+		return m.PermissionsBoundaryUsageCount()
+	case bronzeawsiampolicy.FieldIsAttachable:
+		return m.IsAttachable()
+	case bronzeawsiampolicy.FieldAWSManaged:
+		return m.AWSManaged()
+	case bronzeawsiampolicy.FieldCreateDate:
+		return m.CreateDate()
+	case bronzeawsiampolicy.FieldUpdateDate:
+		return m.UpdateDate()
+	case bronzeawsiampolicy.FieldVersionsJSON:
+		return m.VersionsJSON()
+	case bronzeawsiampolicy.FieldAccountID:
+		return m.AccountID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeAWSIAMPolicyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzeawsiampolicy.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzeawsiampolicy.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzeawsiampolicy.FieldArn:
+		return m.OldArn(ctx)
+	case bronzeawsiampolicy.FieldPolicyName:
+		return m.OldPolicyName(ctx)
+	case bronzeawsiampolicy.FieldPath:
+		return m.OldPath(ctx)
+	case bronzeawsiampolicy.FieldDescription:
+		return m.OldDescription(ctx)
+	case bronzeawsiampolicy.FieldDefaultVersionID:
+		return m.OldDefaultVersionID(ctx)
+	case bronzeawsiampolicy.FieldAttachmentCount:
+		return m.OldAttachmentCount(ctx)
+	case bronzeawsiampolicy.FieldPermissionsBoundaryUsageCount:
+		return m.OldPermissionsBoundaryUsageCount(ctx)
+	case bronzeawsiampolicy.FieldIsAttachable:
+		return m.OldIsAttachable(ctx)
+	case bronzeawsiampolicy.FieldAWSManaged:
+		return m.OldAWSManaged(ctx)
+	case bronzeawsiampolicy.FieldCreateDate:
+		return m.OldCreateDate(ctx)
+	case bronzeawsiampolicy.FieldUpdateDate:
+		return m.OldUpdateDate(ctx)
+	case bronzeawsiampolicy.FieldVersionsJSON:
+		return m.OldVersionsJSON(ctx)
+	case bronzeawsiampolicy.FieldAccountID:
+		return m.OldAccountID(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeAWSIAMPolicy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeAWSIAMPolicyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzeawsiampolicy.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzeawsiampolicy.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzeawsiampolicy.FieldArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArn(v)
+		return nil
+	case bronzeawsiampolicy.FieldPolicyName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicyName(v)
+		return nil
+	case bronzeawsiampolicy.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case bronzeawsiampolicy.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case bronzeawsiampolicy.FieldDefaultVersionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultVersionID(v)
+		return nil
+	case bronzeawsiampolicy.FieldAttachmentCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttachmentCount(v)
+		return nil
+	case bronzeawsiampolicy.FieldPermissionsBoundaryUsageCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermissionsBoundaryUsageCount(v)
+		return nil
+	case bronzeawsiampolicy.FieldIsAttachable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsAttachable(v)
+		return nil
+	case bronzeawsiampolicy.FieldAWSManaged:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAWSManaged(v)
+		return nil
+	case bronzeawsiampolicy.FieldCreateDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateDate(v)
+		return nil
+	case bronzeawsiampolicy.FieldUpdateDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateDate(v)
+		return nil
+	case bronzeawsiampolicy.FieldVersionsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersionsJSON(v)
+		return nil
+	case bronzeawsiampolicy.FieldAccountID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMPolicy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeAWSIAMPolicyMutation) AddedFields() []string {
+	var fields []string
+	if m.addattachment_count != nil {
+		fields = append(fields, bronzeawsiampolicy.FieldAttachmentCount)
+	}
+	if m.addpermissions_boundary_usage_count != nil {
+		fields = append(fields, bronzeawsiampolicy.FieldPermissionsBoundaryUsageCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeAWSIAMPolicyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case bronzeawsiampolicy.FieldAttachmentCount:
+		return m.AddedAttachmentCount()
+	case bronzeawsiampolicy.FieldPermissionsBoundaryUsageCount:
+		return m.AddedPermissionsBoundaryUsageCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeAWSIAMPolicyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case bronzeawsiampolicy.FieldAttachmentCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttachmentCount(v)
+		return nil
+	case bronzeawsiampolicy.FieldPermissionsBoundaryUsageCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPermissionsBoundaryUsageCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMPolicy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeAWSIAMPolicyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzeawsiampolicy.FieldPath) {
+		fields = append(fields, bronzeawsiampolicy.FieldPath)
+	}
+	if m.FieldCleared(bronzeawsiampolicy.FieldDescription) {
+		fields = append(fields, bronzeawsiampolicy.FieldDescription)
+	}
+	if m.FieldCleared(bronzeawsiampolicy.FieldDefaultVersionID) {
+		fields = append(fields, bronzeawsiampolicy.FieldDefaultVersionID)
+	}
+	if m.FieldCleared(bronzeawsiampolicy.FieldCreateDate) {
+		fields = append(fields, bronzeawsiampolicy.FieldCreateDate)
+	}
+	if m.FieldCleared(bronzeawsiampolicy.FieldUpdateDate) {
+		fields = append(fields, bronzeawsiampolicy.FieldUpdateDate)
+	}
+	if m.FieldCleared(bronzeawsiampolicy.FieldVersionsJSON) {
+		fields = append(fields, bronzeawsiampolicy.FieldVersionsJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeAWSIAMPolicyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeAWSIAMPolicyMutation) ClearField(name string) error {
+	switch name {
+	case bronzeawsiampolicy.FieldPath:
+		m.ClearPath()
+		return nil
+	case bronzeawsiampolicy.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case bronzeawsiampolicy.FieldDefaultVersionID:
+		m.ClearDefaultVersionID()
+		return nil
+	case bronzeawsiampolicy.FieldCreateDate:
+		m.ClearCreateDate()
+		return nil
+	case bronzeawsiampolicy.FieldUpdateDate:
+		m.ClearUpdateDate()
+		return nil
+	case bronzeawsiampolicy.FieldVersionsJSON:
+		m.ClearVersionsJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMPolicy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeAWSIAMPolicyMutation) ResetField(name string) error {
+	switch name {
+	case bronzeawsiampolicy.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzeawsiampolicy.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzeawsiampolicy.FieldArn:
+		m.ResetArn()
+		return nil
+	case bronzeawsiampolicy.FieldPolicyName:
+		m.ResetPolicyName()
+		return nil
+	case bronzeawsiampolicy.FieldPath:
+		m.ResetPath()
+		return nil
+	case bronzeawsiampolicy.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case bronzeawsiampolicy.FieldDefaultVersionID:
+		m.ResetDefaultVersionID()
+		return nil
+	case bronzeawsiampolicy.FieldAttachmentCount:
+		m.ResetAttachmentCount()
+		return nil
+	case bronzeawsiampolicy.FieldPermissionsBoundaryUsageCount:
+		m.ResetPermissionsBoundaryUsageCount()
+		return nil
+	case bronzeawsiampolicy.FieldIsAttachable:
+		m.ResetIsAttachable()
+		return nil
+	case bronzeawsiampolicy.FieldAWSManaged:
+		m.ResetAWSManaged()
+		return nil
+	case bronzeawsiampolicy.FieldCreateDate:
+		m.ResetCreateDate()
+		return nil
+	case bronzeawsiampolicy.FieldUpdateDate:
+		m.ResetUpdateDate()
+		return nil
+	case bronzeawsiampolicy.FieldVersionsJSON:
+		m.ResetVersionsJSON()
+		return nil
+	case bronzeawsiampolicy.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMPolicy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeAWSIAMPolicyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeAWSIAMPolicyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeAWSIAMPolicyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeAWSIAMPolicyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeAWSIAMPolicyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeAWSIAMPolicyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeAWSIAMPolicyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeAWSIAMPolicy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeAWSIAMPolicyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeAWSIAMPolicy edge %s", name)
+}
+
+// BronzeAWSIAMRoleMutation represents an operation that mutates the BronzeAWSIAMRole nodes in the graph.
+type BronzeAWSIAMRoleMutation struct {
+	config
+	op                            Op
+	typ                           string
+	id                            *string
+	collected_at                  *time.Time
+	first_collected_at            *time.Time
+	arn                           *string
+	role_name                     *string
+	_path                         *string
+	create_date                   *time.Time
+	role_last_used_date           *time.Time
+	role_last_used_region         *string
+	permissions_boundary_arn      *string
+	assume_role_policy_json       *json.RawMessage
+	appendassume_role_policy_json json.RawMessage
+	attached_policies_json        *json.RawMessage
+	appendattached_policies_json  json.RawMessage
+	inline_policies_json          *json.RawMessage
+	appendinline_policies_json    json.RawMessage
+	instance_profiles_json        *json.RawMessage
+	appendinstance_profiles_json  json.RawMessage
+	tags_json                     *json.RawMessage
+	appendtags_json               json.RawMessage
+	account_id                    *string
+	clearedFields                 map[string]struct{}
+	done                          bool
+	oldValue                      func(context.Context) (*BronzeAWSIAMRole, error)
+	predicates                    []predicate.BronzeAWSIAMRole
+}
+
+var _ ent.Mutation = (*BronzeAWSIAMRoleMutation)(nil)
+
+// bronzeawsiamroleOption allows management of the mutation configuration using functional options.
+type bronzeawsiamroleOption func(*BronzeAWSIAMRoleMutation)
+
+// newBronzeAWSIAMRoleMutation creates new mutation for the BronzeAWSIAMRole entity.
+func newBronzeAWSIAMRoleMutation(c config, op Op, opts ...bronzeawsiamroleOption) *BronzeAWSIAMRoleMutation {
+	m := &BronzeAWSIAMRoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeAWSIAMRole,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeAWSIAMRoleID sets the ID field of the mutation.
+func withBronzeAWSIAMRoleID(id string) bronzeawsiamroleOption {
+	return func(m *BronzeAWSIAMRoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeAWSIAMRole
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeAWSIAMRole, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeAWSIAMRole.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeAWSIAMRole sets the old BronzeAWSIAMRole of the mutation.
+func withBronzeAWSIAMRole(node *BronzeAWSIAMRole) bronzeawsiamroleOption {
+	return func(m *BronzeAWSIAMRoleMutation) {
+		m.oldValue = func(context.Context) (*BronzeAWSIAMRole, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeAWSIAMRoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeAWSIAMRoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("iam: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeAWSIAMRole entities.
+func (m *BronzeAWSIAMRoleMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeAWSIAMRoleMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeAWSIAMRoleMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeAWSIAMRole.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeAWSIAMRoleMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeAWSIAMRoleMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeAWSIAMRole entity.
+// If the BronzeAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMRoleMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeAWSIAMRoleMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeAWSIAMRoleMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeAWSIAMRoleMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeAWSIAMRole entity.
+// If the BronzeAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMRoleMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeAWSIAMRoleMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetArn sets the "arn" field.
+func (m *BronzeAWSIAMRoleMutation) SetArn(s string) {
+	m.arn = &s
+}
+
+// Arn returns the value of the "arn" field in the mutation.
+func (m *BronzeAWSIAMRoleMutation) Arn() (r string, exists bool) {
+	v := m.arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArn returns the old "arn" field's value of the BronzeAWSIAMRole entity.
+// If the BronzeAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMRoleMutation) OldArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArn: %w", err)
+	}
+	return oldValue.Arn, nil
+}
+
+// ResetArn resets all changes to the "arn" field.
+func (m *BronzeAWSIAMRoleMutation) ResetArn() {
+	m.arn = nil
+}
+
+// SetRoleName sets the "role_name" field.
+func (m *BronzeAWSIAMRoleMutation) SetRoleName(s string) {
+	m.role_name = &s
+}
+
+// RoleName returns the value of the "role_name" field in the mutation.
+func (m *BronzeAWSIAMRoleMutation) RoleName() (r string, exists bool) {
+	v := m.role_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleName returns the old "role_name" field's value of the BronzeAWSIAMRole entity.
+// If the BronzeAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMRoleMutation) OldRoleName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleName: %w", err)
+	}
+	return oldValue.RoleName, nil
+}
+
+// ResetRoleName resets all changes to the "role_name" field.
+func (m *BronzeAWSIAMRoleMutation) ResetRoleName() {
+	m.role_name = nil
+}
+
+// SetPath sets the "path" field.
+func (m *BronzeAWSIAMRoleMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *BronzeAWSIAMRoleMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the BronzeAWSIAMRole entity.
+// If the BronzeAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMRoleMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ClearPath clears the value of the "path" field.
+func (m *BronzeAWSIAMRoleMutation) ClearPath() {
+	m._path = nil
+	m.clearedFields[bronzeawsiamrole.FieldPath] = struct{}{}
+}
+
+// PathCleared returns if the "path" field was cleared in this mutation.
+func (m *BronzeAWSIAMRoleMutation) PathCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamrole.FieldPath]
+	return ok
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *BronzeAWSIAMRoleMutation) ResetPath() {
+	m._path = nil
+	delete(m.clearedFields, bronzeawsiamrole.FieldPath)
+}
+
+// SetCreateDate sets the "create_date" field.
+func (m *BronzeAWSIAMRoleMutation) SetCreateDate(t time.Time) {
+	m.create_date = &t
+}
+
+// CreateDate returns the value of the "create_date" field in the mutation.
+func (m *BronzeAWSIAMRoleMutation) CreateDate() (r time.Time, exists bool) {
+	v := m.create_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateDate returns the old "create_date" field's value of the BronzeAWSIAMRole entity.
+// If the BronzeAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMRoleMutation) OldCreateDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateDate: %w", err)
+	}
+	return oldValue.CreateDate, nil
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (m *BronzeAWSIAMRoleMutation) ClearCreateDate() {
+	m.create_date = nil
+	m.clearedFields[bronzeawsiamrole.FieldCreateDate] = struct{}{}
+}
+
+// CreateDateCleared returns if the "create_date" field was cleared in this mutation.
+func (m *BronzeAWSIAMRoleMutation) CreateDateCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamrole.FieldCreateDate]
+	return ok
+}
+
+// ResetCreateDate resets all changes to the "create_date" field.
+func (m *BronzeAWSIAMRoleMutation) ResetCreateDate() {
+	m.create_date = nil
+	delete(m.clearedFields, bronzeawsiamrole.FieldCreateDate)
+}
+
+// SetRoleLastUsedDate sets the "role_last_used_date" field.
+func (m *BronzeAWSIAMRoleMutation) SetRoleLastUsedDate(t time.Time) {
+	m.role_last_used_date = &t
+}
+
+// RoleLastUsedDate returns the value of the "role_last_used_date" field in the mutation.
+func (m *BronzeAWSIAMRoleMutation) RoleLastUsedDate() (r time.Time, exists bool) {
+	v := m.role_last_used_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleLastUsedDate returns the old "role_last_used_date" field's value of the BronzeAWSIAMRole entity.
+// If the BronzeAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMRoleMutation) OldRoleLastUsedDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleLastUsedDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleLastUsedDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleLastUsedDate: %w", err)
+	}
+	return oldValue.RoleLastUsedDate, nil
+}
+
+// ClearRoleLastUsedDate clears the value of the "role_last_used_date" field.
+func (m *BronzeAWSIAMRoleMutation) ClearRoleLastUsedDate() {
+	m.role_last_used_date = nil
+	m.clearedFields[bronzeawsiamrole.FieldRoleLastUsedDate] = struct{}{}
+}
+
+// RoleLastUsedDateCleared returns if the "role_last_used_date" field was cleared in this mutation.
+func (m *BronzeAWSIAMRoleMutation) RoleLastUsedDateCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamrole.FieldRoleLastUsedDate]
+	return ok
+}
+
+// ResetRoleLastUsedDate resets all changes to the "role_last_used_date" field.
+func (m *BronzeAWSIAMRoleMutation) ResetRoleLastUsedDate() {
+	m.role_last_used_date = nil
+	delete(m.clearedFields, bronzeawsiamrole.FieldRoleLastUsedDate)
+}
+
+// SetRoleLastUsedRegion sets the "role_last_used_region" field.
+func (m *BronzeAWSIAMRoleMutation) SetRoleLastUsedRegion(s string) {
+	m.role_last_used_region = &s
+}
+
+// RoleLastUsedRegion returns the value of the "role_last_used_region" field in the mutation.
+func (m *BronzeAWSIAMRoleMutation) RoleLastUsedRegion() (r string, exists bool) {
+	v := m.role_last_used_region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleLastUsedRegion returns the old "role_last_used_region" field's value of the BronzeAWSIAMRole entity.
+// If the BronzeAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMRoleMutation) OldRoleLastUsedRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleLastUsedRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleLastUsedRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleLastUsedRegion: %w", err)
+	}
+	return oldValue.RoleLastUsedRegion, nil
+}
+
+// ClearRoleLastUsedRegion clears the value of the "role_last_used_region" field.
+func (m *BronzeAWSIAMRoleMutation) ClearRoleLastUsedRegion() {
+	m.role_last_used_region = nil
+	m.clearedFields[bronzeawsiamrole.FieldRoleLastUsedRegion] = struct{}{}
+}
+
+// RoleLastUsedRegionCleared returns if the "role_last_used_region" field was cleared in this mutation.
+func (m *BronzeAWSIAMRoleMutation) RoleLastUsedRegionCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamrole.FieldRoleLastUsedRegion]
+	return ok
+}
+
+// ResetRoleLastUsedRegion resets all changes to the "role_last_used_region" field.
+func (m *BronzeAWSIAMRoleMutation) ResetRoleLastUsedRegion() {
+	m.role_last_used_region = nil
+	delete(m.clearedFields, bronzeawsiamrole.FieldRoleLastUsedRegion)
+}
+
+// SetPermissionsBoundaryArn sets the "permissions_boundary_arn" field.
+func (m *BronzeAWSIAMRoleMutation) SetPermissionsBoundaryArn(s string) {
+	m.permissions_boundary_arn = &s
+}
+
+// PermissionsBoundaryArn returns the value of the "permissions_boundary_arn" field in the mutation.
+func (m *BronzeAWSIAMRoleMutation) PermissionsBoundaryArn() (r string, exists bool) {
+	v := m.permissions_boundary_arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPermissionsBoundaryArn returns the old "permissions_boundary_arn" field's value of the BronzeAWSIAMRole entity.
+// If the BronzeAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMRoleMutation) OldPermissionsBoundaryArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermissionsBoundaryArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermissionsBoundaryArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermissionsBoundaryArn: %w", err)
+	}
+	return oldValue.PermissionsBoundaryArn, nil
+}
+
+// ClearPermissionsBoundaryArn clears the value of the "permissions_boundary_arn" field.
+func (m *BronzeAWSIAMRoleMutation) ClearPermissionsBoundaryArn() {
+	m.permissions_boundary_arn = nil
+	m.clearedFields[bronzeawsiamrole.FieldPermissionsBoundaryArn] = struct{}{}
+}
+
+// PermissionsBoundaryArnCleared returns if the "permissions_boundary_arn" field was cleared in this mutation.
+func (m *BronzeAWSIAMRoleMutation) PermissionsBoundaryArnCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamrole.FieldPermissionsBoundaryArn]
+	return ok
+}
+
+// ResetPermissionsBoundaryArn resets all changes to the "permissions_boundary_arn" field.
+func (m *BronzeAWSIAMRoleMutation) ResetPermissionsBoundaryArn() {
+	m.permissions_boundary_arn = nil
+	delete(m.clearedFields, bronzeawsiamrole.FieldPermissionsBoundaryArn)
+}
+
+// SetAssumeRolePolicyJSON sets the "assume_role_policy_json" field.
+func (m *BronzeAWSIAMRoleMutation) SetAssumeRolePolicyJSON(jm json.RawMessage) {
+	m.assume_role_policy_json = &jm
+	m.appendassume_role_policy_json = nil
+}
+
+// AssumeRolePolicyJSON returns the value of the "assume_role_policy_json" field in the mutation.
+func (m *BronzeAWSIAMRoleMutation) AssumeRolePolicyJSON() (r json.RawMessage, exists bool) {
+	v := m.assume_role_policy_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssumeRolePolicyJSON returns the old "assume_role_policy_json" field's value of the BronzeAWSIAMRole entity.
+// If the BronzeAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMRoleMutation) OldAssumeRolePolicyJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssumeRolePolicyJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssumeRolePolicyJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssumeRolePolicyJSON: %w", err)
+	}
+	return oldValue.AssumeRolePolicyJSON, nil
+}
+
+// AppendAssumeRolePolicyJSON adds jm to the "assume_role_policy_json" field.
+func (m *BronzeAWSIAMRoleMutation) AppendAssumeRolePolicyJSON(jm json.RawMessage) {
+	m.appendassume_role_policy_json = append(m.appendassume_role_policy_json, jm...)
+}
+
+// AppendedAssumeRolePolicyJSON returns the list of values that were appended to the "assume_role_policy_json" field in this mutation.
+func (m *BronzeAWSIAMRoleMutation) AppendedAssumeRolePolicyJSON() (json.RawMessage, bool) {
+	if len(m.appendassume_role_policy_json) == 0 {
+		return nil, false
+	}
+	return m.appendassume_role_policy_json, true
+}
+
+// ClearAssumeRolePolicyJSON clears the value of the "assume_role_policy_json" field.
+func (m *BronzeAWSIAMRoleMutation) ClearAssumeRolePolicyJSON() {
+	m.assume_role_policy_json = nil
+	m.appendassume_role_policy_json = nil
+	m.clearedFields[bronzeawsiamrole.FieldAssumeRolePolicyJSON] = struct{}{}
+}
+
+// AssumeRolePolicyJSONCleared returns if the "assume_role_policy_json" field was cleared in this mutation.
+func (m *BronzeAWSIAMRoleMutation) AssumeRolePolicyJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamrole.FieldAssumeRolePolicyJSON]
+	return ok
+}
+
+// ResetAssumeRolePolicyJSON resets all changes to the "assume_role_policy_json" field.
+func (m *BronzeAWSIAMRoleMutation) ResetAssumeRolePolicyJSON() {
+	m.assume_role_policy_json = nil
+	m.appendassume_role_policy_json = nil
+	delete(m.clearedFields, bronzeawsiamrole.FieldAssumeRolePolicyJSON)
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (m *BronzeAWSIAMRoleMutation) SetAttachedPoliciesJSON(jm json.RawMessage) {
+	m.attached_policies_json = &jm
+	m.appendattached_policies_json = nil
+}
+
+// AttachedPoliciesJSON returns the value of the "attached_policies_json" field in the mutation.
+func (m *BronzeAWSIAMRoleMutation) AttachedPoliciesJSON() (r json.RawMessage, exists bool) {
+	v := m.attached_policies_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttachedPoliciesJSON returns the old "attached_policies_json" field's value of the BronzeAWSIAMRole entity.
+// If the BronzeAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMRoleMutation) OldAttachedPoliciesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttachedPoliciesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttachedPoliciesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttachedPoliciesJSON: %w", err)
+	}
+	return oldValue.AttachedPoliciesJSON, nil
+}
+
+// AppendAttachedPoliciesJSON adds jm to the "attached_policies_json" field.
+func (m *BronzeAWSIAMRoleMutation) AppendAttachedPoliciesJSON(jm json.RawMessage) {
+	m.appendattached_policies_json = append(m.appendattached_policies_json, jm...)
+}
+
+// AppendedAttachedPoliciesJSON returns the list of values that were appended to the "attached_policies_json" field in this mutation.
+func (m *BronzeAWSIAMRoleMutation) AppendedAttachedPoliciesJSON() (json.RawMessage, bool) {
+	if len(m.appendattached_policies_json) == 0 {
+		return nil, false
+	}
+	return m.appendattached_policies_json, true
+}
+
+// ClearAttachedPoliciesJSON clears the value of the "attached_policies_json" field.
+func (m *BronzeAWSIAMRoleMutation) ClearAttachedPoliciesJSON() {
+	m.attached_policies_json = nil
+	m.appendattached_policies_json = nil
+	m.clearedFields[bronzeawsiamrole.FieldAttachedPoliciesJSON] = struct{}{}
+}
+
+// AttachedPoliciesJSONCleared returns if the "attached_policies_json" field was cleared in this mutation.
+func (m *BronzeAWSIAMRoleMutation) AttachedPoliciesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamrole.FieldAttachedPoliciesJSON]
+	return ok
+}
+
+// ResetAttachedPoliciesJSON resets all changes to the "attached_policies_json" field.
+func (m *BronzeAWSIAMRoleMutation) ResetAttachedPoliciesJSON() {
+	m.attached_policies_json = nil
+	m.appendattached_policies_json = nil
+	delete(m.clearedFields, bronzeawsiamrole.FieldAttachedPoliciesJSON)
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (m *BronzeAWSIAMRoleMutation) SetInlinePoliciesJSON(jm json.RawMessage) {
+	m.inline_policies_json = &jm
+	m.appendinline_policies_json = nil
+}
+
+// InlinePoliciesJSON returns the value of the "inline_policies_json" field in the mutation.
+func (m *BronzeAWSIAMRoleMutation) InlinePoliciesJSON() (r json.RawMessage, exists bool) {
+	v := m.inline_policies_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInlinePoliciesJSON returns the old "inline_policies_json" field's value of the BronzeAWSIAMRole entity.
+// If the BronzeAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMRoleMutation) OldInlinePoliciesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInlinePoliciesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInlinePoliciesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInlinePoliciesJSON: %w", err)
+	}
+	return oldValue.InlinePoliciesJSON, nil
+}
+
+// AppendInlinePoliciesJSON adds jm to the "inline_policies_json" field.
+func (m *BronzeAWSIAMRoleMutation) AppendInlinePoliciesJSON(jm json.RawMessage) {
+	m.appendinline_policies_json = append(m.appendinline_policies_json, jm...)
+}
+
+// AppendedInlinePoliciesJSON returns the list of values that were appended to the "inline_policies_json" field in this mutation.
+func (m *BronzeAWSIAMRoleMutation) AppendedInlinePoliciesJSON() (json.RawMessage, bool) {
+	if len(m.appendinline_policies_json) == 0 {
+		return nil, false
+	}
+	return m.appendinline_policies_json, true
+}
+
+// ClearInlinePoliciesJSON clears the value of the "inline_policies_json" field.
+func (m *BronzeAWSIAMRoleMutation) ClearInlinePoliciesJSON() {
+	m.inline_policies_json = nil
+	m.appendinline_policies_json = nil
+	m.clearedFields[bronzeawsiamrole.FieldInlinePoliciesJSON] = struct{}{}
+}
+
+// InlinePoliciesJSONCleared returns if the "inline_policies_json" field was cleared in this mutation.
+func (m *BronzeAWSIAMRoleMutation) InlinePoliciesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamrole.FieldInlinePoliciesJSON]
+	return ok
+}
+
+// ResetInlinePoliciesJSON resets all changes to the "inline_policies_json" field.
+func (m *BronzeAWSIAMRoleMutation) ResetInlinePoliciesJSON() {
+	m.inline_policies_json = nil
+	m.appendinline_policies_json = nil
+	delete(m.clearedFields, bronzeawsiamrole.FieldInlinePoliciesJSON)
+}
+
+// SetInstanceProfilesJSON sets the "instance_profiles_json" field.
+func (m *BronzeAWSIAMRoleMutation) SetInstanceProfilesJSON(jm json.RawMessage) {
+	m.instance_profiles_json = &jm
+	m.appendinstance_profiles_json = nil
+}
+
+// InstanceProfilesJSON returns the value of the "instance_profiles_json" field in the mutation.
+func (m *BronzeAWSIAMRoleMutation) InstanceProfilesJSON() (r json.RawMessage, exists bool) {
+	v := m.instance_profiles_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstanceProfilesJSON returns the old "instance_profiles_json" field's value of the BronzeAWSIAMRole entity.
+// If the BronzeAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMRoleMutation) OldInstanceProfilesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInstanceProfilesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInstanceProfilesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstanceProfilesJSON: %w", err)
+	}
+	return oldValue.InstanceProfilesJSON, nil
+}
+
+// AppendInstanceProfilesJSON adds jm to the "instance_profiles_json" field.
+func (m *BronzeAWSIAMRoleMutation) AppendInstanceProfilesJSON(jm json.RawMessage) {
+	m.appendinstance_profiles_json = append(m.appendinstance_profiles_json, jm...)
+}
+
+// AppendedInstanceProfilesJSON returns the list of values that were appended to the "instance_profiles_json" field in this mutation.
+func (m *BronzeAWSIAMRoleMutation) AppendedInstanceProfilesJSON() (json.RawMessage, bool) {
+	if len(m.appendinstance_profiles_json) == 0 {
+		return nil, false
+	}
+	return m.appendinstance_profiles_json, true
+}
+
+// ClearInstanceProfilesJSON clears the value of the "instance_profiles_json" field.
+func (m *BronzeAWSIAMRoleMutation) ClearInstanceProfilesJSON() {
+	m.instance_profiles_json = nil
+	m.appendinstance_profiles_json = nil
+	m.clearedFields[bronzeawsiamrole.FieldInstanceProfilesJSON] = struct{}{}
+}
+
+// InstanceProfilesJSONCleared returns if the "instance_profiles_json" field was cleared in this mutation.
+func (m *BronzeAWSIAMRoleMutation) InstanceProfilesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamrole.FieldInstanceProfilesJSON]
+	return ok
+}
+
+// ResetInstanceProfilesJSON resets all changes to the "instance_profiles_json" field.
+func (m *BronzeAWSIAMRoleMutation) ResetInstanceProfilesJSON() {
+	m.instance_profiles_json = nil
+	m.appendinstance_profiles_json = nil
+	delete(m.clearedFields, bronzeawsiamrole.FieldInstanceProfilesJSON)
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (m *BronzeAWSIAMRoleMutation) SetTagsJSON(jm json.RawMessage) {
+	m.tags_json = &jm
+	m.appendtags_json = nil
+}
+
+// TagsJSON returns the value of the "tags_json" field in the mutation.
+func (m *BronzeAWSIAMRoleMutation) TagsJSON() (r json.RawMessage, exists bool) {
+	v := m.tags_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagsJSON returns the old "tags_json" field's value of the BronzeAWSIAMRole entity.
+// If the BronzeAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMRoleMutation) OldTagsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagsJSON: %w", err)
+	}
+	return oldValue.TagsJSON, nil
+}
+
+// AppendTagsJSON adds jm to the "tags_json" field.
+func (m *BronzeAWSIAMRoleMutation) AppendTagsJSON(jm json.RawMessage) {
+	m.appendtags_json = append(m.appendtags_json, jm...)
+}
+
+// AppendedTagsJSON returns the list of values that were appended to the "tags_json" field in this mutation.
+func (m *BronzeAWSIAMRoleMutation) AppendedTagsJSON() (json.RawMessage, bool) {
+	if len(m.appendtags_json) == 0 {
+		return nil, false
+	}
+	return m.appendtags_json, true
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (m *BronzeAWSIAMRoleMutation) ClearTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	m.clearedFields[bronzeawsiamrole.FieldTagsJSON] = struct{}{}
+}
+
+// TagsJSONCleared returns if the "tags_json" field was cleared in this mutation.
+func (m *BronzeAWSIAMRoleMutation) TagsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamrole.FieldTagsJSON]
+	return ok
+}
+
+// ResetTagsJSON resets all changes to the "tags_json" field.
+func (m *BronzeAWSIAMRoleMutation) ResetTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	delete(m.clearedFields, bronzeawsiamrole.FieldTagsJSON)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BronzeAWSIAMRoleMutation) SetAccountID(s string) {
+	m.account_id = &s
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BronzeAWSIAMRoleMutation) AccountID() (r string, exists bool) {
+	v := m.account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the BronzeAWSIAMRole entity.
+// If the BronzeAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMRoleMutation) OldAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BronzeAWSIAMRoleMutation) ResetAccountID() {
+	m.account_id = nil
+}
+
+// Where appends a list predicates to the BronzeAWSIAMRoleMutation builder.
+func (m *BronzeAWSIAMRoleMutation) Where(ps ...predicate.BronzeAWSIAMRole) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeAWSIAMRoleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeAWSIAMRoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeAWSIAMRole, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeAWSIAMRoleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeAWSIAMRoleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeAWSIAMRole).
+func (m *BronzeAWSIAMRoleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeAWSIAMRoleMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.collected_at != nil {
+		fields = append(fields, bronzeawsiamrole.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzeawsiamrole.FieldFirstCollectedAt)
+	}
+	if m.arn != nil {
+		fields = append(fields, bronzeawsiamrole.FieldArn)
+	}
+	if m.role_name != nil {
+		fields = append(fields, bronzeawsiamrole.FieldRoleName)
+	}
+	if m._path != nil {
+		fields = append(fields, bronzeawsiamrole.FieldPath)
+	}
+	if m.create_date != nil {
+		fields = append(fields, bronzeawsiamrole.FieldCreateDate)
+	}
+	if m.role_last_used_date != nil {
+		fields = append(fields, bronzeawsiamrole.FieldRoleLastUsedDate)
+	}
+	if m.role_last_used_region != nil {
+		fields = append(fields, bronzeawsiamrole.FieldRoleLastUsedRegion)
+	}
+	if m.permissions_boundary_arn != nil {
+		fields = append(fields, bronzeawsiamrole.FieldPermissionsBoundaryArn)
+	}
+	if m.assume_role_policy_json != nil {
+		fields = append(fields, bronzeawsiamrole.FieldAssumeRolePolicyJSON)
+	}
+	if m.attached_policies_json != nil {
+		fields = append(fields, bronzeawsiamrole.FieldAttachedPoliciesJSON)
+	}
+	if m.inline_policies_json != nil {
+		fields = append(fields, bronzeawsiamrole.FieldInlinePoliciesJSON)
+	}
+	if m.instance_profiles_json != nil {
+		fields = append(fields, bronzeawsiamrole.FieldInstanceProfilesJSON)
+	}
+	if m.tags_json != nil {
+		fields = append(fields, bronzeawsiamrole.FieldTagsJSON)
+	}
+	if m.account_id != nil {
+		fields = append(fields, bronzeawsiamrole.FieldAccountID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeAWSIAMRoleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzeawsiamrole.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzeawsiamrole.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzeawsiamrole.FieldArn:
+		return m.Arn()
+	case bronzeawsiamrole.FieldRoleName:
+		return m.RoleName()
+	case bronzeawsiamrole.FieldPath:
+		return m.Path()
+	case bronzeawsiamrole.FieldCreateDate:
+		return m.CreateDate()
+	case bronzeawsiamrole.FieldRoleLastUsedDate:
+		return m.RoleLastUsedDate()
+	case bronzeawsiamrole.FieldRoleLastUsedRegion:
+		return m.RoleLastUsedRegion()
+	case bronzeawsiamrole.FieldPermissionsBoundaryArn:
+		return m.PermissionsBoundaryArn()
+	case bronzeawsiamrole.FieldAssumeRolePolicyJSON:
+		return m.AssumeRolePolicyJSON()
+	case bronzeawsiamrole.FieldAttachedPoliciesJSON:
+		return m.AttachedPoliciesJSON()
+	case bronzeawsiamrole.FieldInlinePoliciesJSON:
+		return m.InlinePoliciesJSON()
+	case bronzeawsiamrole.FieldInstanceProfilesJSON:
+		return m.InstanceProfilesJSON()
+	case bronzeawsiamrole.FieldTagsJSON:
+		return m.TagsJSON()
+	case bronzeawsiamrole.FieldAccountID:
+		return m.AccountID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeAWSIAMRoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzeawsiamrole.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzeawsiamrole.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzeawsiamrole.FieldArn:
+		return m.OldArn(ctx)
+	case bronzeawsiamrole.FieldRoleName:
+		return m.OldRoleName(ctx)
+	case bronzeawsiamrole.FieldPath:
+		return m.OldPath(ctx)
+	case bronzeawsiamrole.FieldCreateDate:
+		return m.OldCreateDate(ctx)
+	case bronzeawsiamrole.FieldRoleLastUsedDate:
+		return m.OldRoleLastUsedDate(ctx)
+	case bronzeawsiamrole.FieldRoleLastUsedRegion:
+		return m.OldRoleLastUsedRegion(ctx)
+	case bronzeawsiamrole.FieldPermissionsBoundaryArn:
+		return m.OldPermissionsBoundaryArn(ctx)
+	case bronzeawsiamrole.FieldAssumeRolePolicyJSON:
+		return m.OldAssumeRolePolicyJSON(ctx)
+	case bronzeawsiamrole.FieldAttachedPoliciesJSON:
+		return m.OldAttachedPoliciesJSON(ctx)
+	case bronzeawsiamrole.FieldInlinePoliciesJSON:
+		return m.OldInlinePoliciesJSON(ctx)
+	case bronzeawsiamrole.FieldInstanceProfilesJSON:
+		return m.OldInstanceProfilesJSON(ctx)
+	case bronzeawsiamrole.FieldTagsJSON:
+		return m.OldTagsJSON(ctx)
+	case bronzeawsiamrole.FieldAccountID:
+		return m.OldAccountID(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeAWSIAMRole field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeAWSIAMRoleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzeawsiamrole.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzeawsiamrole.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzeawsiamrole.FieldArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArn(v)
+		return nil
+	case bronzeawsiamrole.FieldRoleName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleName(v)
+		return nil
+	case bronzeawsiamrole.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case bronzeawsiamrole.FieldCreateDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateDate(v)
+		return nil
+	case bronzeawsiamrole.FieldRoleLastUsedDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleLastUsedDate(v)
+		return nil
+	case bronzeawsiamrole.FieldRoleLastUsedRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleLastUsedRegion(v)
+		return nil
+	case bronzeawsiamrole.FieldPermissionsBoundaryArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermissionsBoundaryArn(v)
+		return nil
+	case bronzeawsiamrole.FieldAssumeRolePolicyJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssumeRolePolicyJSON(v)
+		return nil
+	case bronzeawsiamrole.FieldAttachedPoliciesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttachedPoliciesJSON(v)
+		return nil
+	case bronzeawsiamrole.FieldInlinePoliciesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInlinePoliciesJSON(v)
+		return nil
+	case bronzeawsiamrole.FieldInstanceProfilesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstanceProfilesJSON(v)
+		return nil
+	case bronzeawsiamrole.FieldTagsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagsJSON(v)
+		return nil
+	case bronzeawsiamrole.FieldAccountID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMRole field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeAWSIAMRoleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeAWSIAMRoleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeAWSIAMRoleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMRole numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeAWSIAMRoleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzeawsiamrole.FieldPath) {
+		fields = append(fields, bronzeawsiamrole.FieldPath)
+	}
+	if m.FieldCleared(bronzeawsiamrole.FieldCreateDate) {
+		fields = append(fields, bronzeawsiamrole.FieldCreateDate)
+	}
+	if m.FieldCleared(bronzeawsiamrole.FieldRoleLastUsedDate) {
+		fields = append(fields, bronzeawsiamrole.FieldRoleLastUsedDate)
+	}
+	if m.FieldCleared(bronzeawsiamrole.FieldRoleLastUsedRegion) {
+		fields = append(fields, bronzeawsiamrole.FieldRoleLastUsedRegion)
+	}
+	if m.FieldCleared(bronzeawsiamrole.FieldPermissionsBoundaryArn) {
+		fields = append(fields, bronzeawsiamrole.FieldPermissionsBoundaryArn)
+	}
+	if m.FieldCleared(bronzeawsiamrole.FieldAssumeRolePolicyJSON) {
+		fields = append(fields, bronzeawsiamrole.FieldAssumeRolePolicyJSON)
+	}
+	if m.FieldCleared(bronzeawsiamrole.FieldAttachedPoliciesJSON) {
+		fields = append(fields, bronzeawsiamrole.FieldAttachedPoliciesJSON)
+	}
+	if m.FieldCleared(bronzeawsiamrole.FieldInlinePoliciesJSON) {
+		fields = append(fields, bronzeawsiamrole.FieldInlinePoliciesJSON)
+	}
+	if m.FieldCleared(bronzeawsiamrole.FieldInstanceProfilesJSON) {
+		fields = append(fields, bronzeawsiamrole.FieldInstanceProfilesJSON)
+	}
+	if m.FieldCleared(bronzeawsiamrole.FieldTagsJSON) {
+		fields = append(fields, bronzeawsiamrole.FieldTagsJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeAWSIAMRoleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeAWSIAMRoleMutation) ClearField(name string) error {
+	switch name {
+	case bronzeawsiamrole.FieldPath:
+		m.ClearPath()
+		return nil
+	case bronzeawsiamrole.FieldCreateDate:
+		m.ClearCreateDate()
+		return nil
+	case bronzeawsiamrole.FieldRoleLastUsedDate:
+		m.ClearRoleLastUsedDate()
+		return nil
+	case bronzeawsiamrole.FieldRoleLastUsedRegion:
+		m.ClearRoleLastUsedRegion()
+		return nil
+	case bronzeawsiamrole.FieldPermissionsBoundaryArn:
+		m.ClearPermissionsBoundaryArn()
+		return nil
+	case bronzeawsiamrole.FieldAssumeRolePolicyJSON:
+		m.ClearAssumeRolePolicyJSON()
+		return nil
+	case bronzeawsiamrole.FieldAttachedPoliciesJSON:
+		m.ClearAttachedPoliciesJSON()
+		return nil
+	case bronzeawsiamrole.FieldInlinePoliciesJSON:
+		m.ClearInlinePoliciesJSON()
+		return nil
+	case bronzeawsiamrole.FieldInstanceProfilesJSON:
+		m.ClearInstanceProfilesJSON()
+		return nil
+	case bronzeawsiamrole.FieldTagsJSON:
+		m.ClearTagsJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMRole nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeAWSIAMRoleMutation) ResetField(name string) error {
+	switch name {
+	case bronzeawsiamrole.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzeawsiamrole.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzeawsiamrole.FieldArn:
+		m.ResetArn()
+		return nil
+	case bronzeawsiamrole.FieldRoleName:
+		m.ResetRoleName()
+		return nil
+	case bronzeawsiamrole.FieldPath:
+		m.ResetPath()
+		return nil
+	case bronzeawsiamrole.FieldCreateDate:
+		m.ResetCreateDate()
+		return nil
+	case bronzeawsiamrole.FieldRoleLastUsedDate:
+		m.ResetRoleLastUsedDate()
+		return nil
+	case bronzeawsiamrole.FieldRoleLastUsedRegion:
+		m.ResetRoleLastUsedRegion()
+		return nil
+	case bronzeawsiamrole.FieldPermissionsBoundaryArn:
+		m.ResetPermissionsBoundaryArn()
+		return nil
+	case bronzeawsiamrole.FieldAssumeRolePolicyJSON:
+		m.ResetAssumeRolePolicyJSON()
+		return nil
+	case bronzeawsiamrole.FieldAttachedPoliciesJSON:
+		m.ResetAttachedPoliciesJSON()
+		return nil
+	case bronzeawsiamrole.FieldInlinePoliciesJSON:
+		m.ResetInlinePoliciesJSON()
+		return nil
+	case bronzeawsiamrole.FieldInstanceProfilesJSON:
+		m.ResetInstanceProfilesJSON()
+		return nil
+	case bronzeawsiamrole.FieldTagsJSON:
+		m.ResetTagsJSON()
+		return nil
+	case bronzeawsiamrole.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMRole field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeAWSIAMRoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeAWSIAMRoleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeAWSIAMRoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeAWSIAMRoleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeAWSIAMRoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeAWSIAMRoleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeAWSIAMRoleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeAWSIAMRole unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeAWSIAMRoleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeAWSIAMRole edge %s", name)
+}
+
+// BronzeAWSIAMUserMutation represents an operation that mutates the BronzeAWSIAMUser nodes in the graph.
+type BronzeAWSIAMUserMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *string
+	collected_at                 *time.Time
+	first_collected_at           *time.Time
+	arn                          *string
+	user_name                    *string
+	_path                        *string
+	create_date                  *time.Time
+	permissions_boundary_arn     *string
+	attached_policies_json       *json.RawMessage
+	appendattached_policies_json json.RawMessage
+	inline_policies_json         *json.RawMessage
+	appendinline_policies_json   json.RawMessage
+	groups_json                  *json.RawMessage
+	appendgroups_json            json.RawMessage
+	mfa_devices_json             *json.RawMessage
+	appendmfa_devices_json       json.RawMessage
+	tags_json                    *json.RawMessage
+	appendtags_json              json.RawMessage
+	account_id                   *string
+	clearedFields                map[string]struct{}
+	done                         bool
+	oldValue                     func(context.Context) (*BronzeAWSIAMUser, error)
+	predicates                   []predicate.BronzeAWSIAMUser
+}
+
+var _ ent.Mutation = (*BronzeAWSIAMUserMutation)(nil)
+
+// bronzeawsiamuserOption allows management of the mutation configuration using functional options.
+type bronzeawsiamuserOption func(*BronzeAWSIAMUserMutation)
+
+// newBronzeAWSIAMUserMutation creates new mutation for the BronzeAWSIAMUser entity.
+func newBronzeAWSIAMUserMutation(c config, op Op, opts ...bronzeawsiamuserOption) *BronzeAWSIAMUserMutation {
+	m := &BronzeAWSIAMUserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeAWSIAMUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeAWSIAMUserID sets the ID field of the mutation.
+func withBronzeAWSIAMUserID(id string) bronzeawsiamuserOption {
+	return func(m *BronzeAWSIAMUserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeAWSIAMUser
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeAWSIAMUser, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeAWSIAMUser.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeAWSIAMUser sets the old BronzeAWSIAMUser of the mutation.
+func withBronzeAWSIAMUser(node *BronzeAWSIAMUser) bronzeawsiamuserOption {
+	return func(m *BronzeAWSIAMUserMutation) {
+		m.oldValue = func(context.Context) (*BronzeAWSIAMUser, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeAWSIAMUserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeAWSIAMUserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("iam: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeAWSIAMUser entities.
+func (m *BronzeAWSIAMUserMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeAWSIAMUserMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeAWSIAMUserMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeAWSIAMUser.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeAWSIAMUserMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeAWSIAMUserMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeAWSIAMUser entity.
+// If the BronzeAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMUserMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeAWSIAMUserMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeAWSIAMUserMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeAWSIAMUserMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeAWSIAMUser entity.
+// If the BronzeAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMUserMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeAWSIAMUserMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetArn sets the "arn" field.
+func (m *BronzeAWSIAMUserMutation) SetArn(s string) {
+	m.arn = &s
+}
+
+// Arn returns the value of the "arn" field in the mutation.
+func (m *BronzeAWSIAMUserMutation) Arn() (r string, exists bool) {
+	v := m.arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArn returns the old "arn" field's value of the BronzeAWSIAMUser entity.
+// If the BronzeAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMUserMutation) OldArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArn: %w", err)
+	}
+	return oldValue.Arn, nil
+}
+
+// ResetArn resets all changes to the "arn" field.
+func (m *BronzeAWSIAMUserMutation) ResetArn() {
+	m.arn = nil
+}
+
+// SetUserName sets the "user_name" field.
+func (m *BronzeAWSIAMUserMutation) SetUserName(s string) {
+	m.user_name = &s
+}
+
+// UserName returns the value of the "user_name" field in the mutation.
+func (m *BronzeAWSIAMUserMutation) UserName() (r string, exists bool) {
+	v := m.user_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserName returns the old "user_name" field's value of the BronzeAWSIAMUser entity.
+// If the BronzeAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMUserMutation) OldUserName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserName: %w", err)
+	}
+	return oldValue.UserName, nil
+}
+
+// ResetUserName resets all changes to the "user_name" field.
+func (m *BronzeAWSIAMUserMutation) ResetUserName() {
+	m.user_name = nil
+}
+
+// SetPath sets the "path" field.
+func (m *BronzeAWSIAMUserMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *BronzeAWSIAMUserMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the BronzeAWSIAMUser entity.
+// If the BronzeAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMUserMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ClearPath clears the value of the "path" field.
+func (m *BronzeAWSIAMUserMutation) ClearPath() {
+	m._path = nil
+	m.clearedFields[bronzeawsiamuser.FieldPath] = struct{}{}
+}
+
+// PathCleared returns if the "path" field was cleared in this mutation.
+func (m *BronzeAWSIAMUserMutation) PathCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamuser.FieldPath]
+	return ok
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *BronzeAWSIAMUserMutation) ResetPath() {
+	m._path = nil
+	delete(m.clearedFields, bronzeawsiamuser.FieldPath)
+}
+
+// SetCreateDate sets the "create_date" field.
+func (m *BronzeAWSIAMUserMutation) SetCreateDate(t time.Time) {
+	m.create_date = &t
+}
+
+// CreateDate returns the value of the "create_date" field in the mutation.
+func (m *BronzeAWSIAMUserMutation) CreateDate() (r time.Time, exists bool) {
+	v := m.create_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateDate returns the old "create_date" field's value of the BronzeAWSIAMUser entity.
+// If the BronzeAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMUserMutation) OldCreateDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateDate: %w", err)
+	}
+	return oldValue.CreateDate, nil
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (m *BronzeAWSIAMUserMutation) ClearCreateDate() {
+	m.create_date = nil
+	m.clearedFields[bronzeawsiamuser.FieldCreateDate] = struct{}{}
+}
+
+// CreateDateCleared returns if the "create_date" field was cleared in this mutation.
+func (m *BronzeAWSIAMUserMutation) CreateDateCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamuser.FieldCreateDate]
+	return ok
+}
+
+// ResetCreateDate resets all changes to the "create_date" field.
+func (m *BronzeAWSIAMUserMutation) ResetCreateDate() {
+	m.create_date = nil
+	delete(m.clearedFields, bronzeawsiamuser.FieldCreateDate)
+}
+
+// SetPermissionsBoundaryArn sets the "permissions_boundary_arn" field.
+func (m *BronzeAWSIAMUserMutation) SetPermissionsBoundaryArn(s string) {
+	m.permissions_boundary_arn = &s
+}
+
+// PermissionsBoundaryArn returns the value of the "permissions_boundary_arn" field in the mutation.
+func (m *BronzeAWSIAMUserMutation) PermissionsBoundaryArn() (r string, exists bool) {
+	v := m.permissions_boundary_arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPermissionsBoundaryArn returns the old "permissions_boundary_arn" field's value of the BronzeAWSIAMUser entity.
+// If the BronzeAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMUserMutation) OldPermissionsBoundaryArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermissionsBoundaryArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermissionsBoundaryArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermissionsBoundaryArn: %w", err)
+	}
+	return oldValue.PermissionsBoundaryArn, nil
+}
+
+// ClearPermissionsBoundaryArn clears the value of the "permissions_boundary_arn" field.
+func (m *BronzeAWSIAMUserMutation) ClearPermissionsBoundaryArn() {
+	m.permissions_boundary_arn = nil
+	m.clearedFields[bronzeawsiamuser.FieldPermissionsBoundaryArn] = struct{}{}
+}
+
+// PermissionsBoundaryArnCleared returns if the "permissions_boundary_arn" field was cleared in this mutation.
+func (m *BronzeAWSIAMUserMutation) PermissionsBoundaryArnCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamuser.FieldPermissionsBoundaryArn]
+	return ok
+}
+
+// ResetPermissionsBoundaryArn resets all changes to the "permissions_boundary_arn" field.
+func (m *BronzeAWSIAMUserMutation) ResetPermissionsBoundaryArn() {
+	m.permissions_boundary_arn = nil
+	delete(m.clearedFields, bronzeawsiamuser.FieldPermissionsBoundaryArn)
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (m *BronzeAWSIAMUserMutation) SetAttachedPoliciesJSON(jm json.RawMessage) {
+	m.attached_policies_json = &jm
+	m.appendattached_policies_json = nil
+}
+
+// AttachedPoliciesJSON returns the value of the "attached_policies_json" field in the mutation.
+func (m *BronzeAWSIAMUserMutation) AttachedPoliciesJSON() (r json.RawMessage, exists bool) {
+	v := m.attached_policies_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttachedPoliciesJSON returns the old "attached_policies_json" field's value of the BronzeAWSIAMUser entity.
+// If the BronzeAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMUserMutation) OldAttachedPoliciesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttachedPoliciesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttachedPoliciesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttachedPoliciesJSON: %w", err)
+	}
+	return oldValue.AttachedPoliciesJSON, nil
+}
+
+// AppendAttachedPoliciesJSON adds jm to the "attached_policies_json" field.
+func (m *BronzeAWSIAMUserMutation) AppendAttachedPoliciesJSON(jm json.RawMessage) {
+	m.appendattached_policies_json = append(m.appendattached_policies_json, jm...)
+}
+
+// AppendedAttachedPoliciesJSON returns the list of values that were appended to the "attached_policies_json" field in this mutation.
+func (m *BronzeAWSIAMUserMutation) AppendedAttachedPoliciesJSON() (json.RawMessage, bool) {
+	if len(m.appendattached_policies_json) == 0 {
+		return nil, false
+	}
+	return m.appendattached_policies_json, true
+}
+
+// ClearAttachedPoliciesJSON clears the value of the "attached_policies_json" field.
+func (m *BronzeAWSIAMUserMutation) ClearAttachedPoliciesJSON() {
+	m.attached_policies_json = nil
+	m.appendattached_policies_json = nil
+	m.clearedFields[bronzeawsiamuser.FieldAttachedPoliciesJSON] = struct{}{}
+}
+
+// AttachedPoliciesJSONCleared returns if the "attached_policies_json" field was cleared in this mutation.
+func (m *BronzeAWSIAMUserMutation) AttachedPoliciesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamuser.FieldAttachedPoliciesJSON]
+	return ok
+}
+
+// ResetAttachedPoliciesJSON resets all changes to the "attached_policies_json" field.
+func (m *BronzeAWSIAMUserMutation) ResetAttachedPoliciesJSON() {
+	m.attached_policies_json = nil
+	m.appendattached_policies_json = nil
+	delete(m.clearedFields, bronzeawsiamuser.FieldAttachedPoliciesJSON)
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (m *BronzeAWSIAMUserMutation) SetInlinePoliciesJSON(jm json.RawMessage) {
+	m.inline_policies_json = &jm
+	m.appendinline_policies_json = nil
+}
+
+// InlinePoliciesJSON returns the value of the "inline_policies_json" field in the mutation.
+func (m *BronzeAWSIAMUserMutation) InlinePoliciesJSON() (r json.RawMessage, exists bool) {
+	v := m.inline_policies_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInlinePoliciesJSON returns the old "inline_policies_json" field's value of the BronzeAWSIAMUser entity.
+// If the BronzeAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMUserMutation) OldInlinePoliciesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInlinePoliciesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInlinePoliciesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInlinePoliciesJSON: %w", err)
+	}
+	return oldValue.InlinePoliciesJSON, nil
+}
+
+// AppendInlinePoliciesJSON adds jm to the "inline_policies_json" field.
+func (m *BronzeAWSIAMUserMutation) AppendInlinePoliciesJSON(jm json.RawMessage) {
+	m.appendinline_policies_json = append(m.appendinline_policies_json, jm...)
+}
+
+// AppendedInlinePoliciesJSON returns the list of values that were appended to the "inline_policies_json" field in this mutation.
+func (m *BronzeAWSIAMUserMutation) AppendedInlinePoliciesJSON() (json.RawMessage, bool) {
+	if len(m.appendinline_policies_json) == 0 {
+		return nil, false
+	}
+	return m.appendinline_policies_json, true
+}
+
+// ClearInlinePoliciesJSON clears the value of the "inline_policies_json" field.
+func (m *BronzeAWSIAMUserMutation) ClearInlinePoliciesJSON() {
+	m.inline_policies_json = nil
+	m.appendinline_policies_json = nil
+	m.clearedFields[bronzeawsiamuser.FieldInlinePoliciesJSON] = struct{}{}
+}
+
+// InlinePoliciesJSONCleared returns if the "inline_policies_json" field was cleared in this mutation.
+func (m *BronzeAWSIAMUserMutation) InlinePoliciesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamuser.FieldInlinePoliciesJSON]
+	return ok
+}
+
+// ResetInlinePoliciesJSON resets all changes to the "inline_policies_json" field.
+func (m *BronzeAWSIAMUserMutation) ResetInlinePoliciesJSON() {
+	m.inline_policies_json = nil
+	m.appendinline_policies_json = nil
+	delete(m.clearedFields, bronzeawsiamuser.FieldInlinePoliciesJSON)
+}
+
+// SetGroupsJSON sets the "groups_json" field.
+func (m *BronzeAWSIAMUserMutation) SetGroupsJSON(jm json.RawMessage) {
+	m.groups_json = &jm
+	m.appendgroups_json = nil
+}
+
+// GroupsJSON returns the value of the "groups_json" field in the mutation.
+func (m *BronzeAWSIAMUserMutation) GroupsJSON() (r json.RawMessage, exists bool) {
+	v := m.groups_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupsJSON returns the old "groups_json" field's value of the BronzeAWSIAMUser entity.
+// If the BronzeAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMUserMutation) OldGroupsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupsJSON: %w", err)
+	}
+	return oldValue.GroupsJSON, nil
+}
+
+// AppendGroupsJSON adds jm to the "groups_json" field.
+func (m *BronzeAWSIAMUserMutation) AppendGroupsJSON(jm json.RawMessage) {
+	m.appendgroups_json = append(m.appendgroups_json, jm...)
+}
+
+// AppendedGroupsJSON returns the list of values that were appended to the "groups_json" field in this mutation.
+func (m *BronzeAWSIAMUserMutation) AppendedGroupsJSON() (json.RawMessage, bool) {
+	if len(m.appendgroups_json) == 0 {
+		return nil, false
+	}
+	return m.appendgroups_json, true
+}
+
+// ClearGroupsJSON clears the value of the "groups_json" field.
+func (m *BronzeAWSIAMUserMutation) ClearGroupsJSON() {
+	m.groups_json = nil
+	m.appendgroups_json = nil
+	m.clearedFields[bronzeawsiamuser.FieldGroupsJSON] = struct{}{}
+}
+
+// GroupsJSONCleared returns if the "groups_json" field was cleared in this mutation.
+func (m *BronzeAWSIAMUserMutation) GroupsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamuser.FieldGroupsJSON]
+	return ok
+}
+
+// ResetGroupsJSON resets all changes to the "groups_json" field.
+func (m *BronzeAWSIAMUserMutation) ResetGroupsJSON() {
+	m.groups_json = nil
+	m.appendgroups_json = nil
+	delete(m.clearedFields, bronzeawsiamuser.FieldGroupsJSON)
+}
+
+// SetMfaDevicesJSON sets the "mfa_devices_json" field.
+func (m *BronzeAWSIAMUserMutation) SetMfaDevicesJSON(jm json.RawMessage) {
+	m.mfa_devices_json = &jm
+	m.appendmfa_devices_json = nil
+}
+
+// MfaDevicesJSON returns the value of the "mfa_devices_json" field in the mutation.
+func (m *BronzeAWSIAMUserMutation) MfaDevicesJSON() (r json.RawMessage, exists bool) {
+	v := m.mfa_devices_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMfaDevicesJSON returns the old "mfa_devices_json" field's value of the BronzeAWSIAMUser entity.
+// If the BronzeAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMUserMutation) OldMfaDevicesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMfaDevicesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMfaDevicesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMfaDevicesJSON: %w", err)
+	}
+	return oldValue.MfaDevicesJSON, nil
+}
+
+// AppendMfaDevicesJSON adds jm to the "mfa_devices_json" field.
+func (m *BronzeAWSIAMUserMutation) AppendMfaDevicesJSON(jm json.RawMessage) {
+	m.appendmfa_devices_json = append(m.appendmfa_devices_json, jm...)
+}
+
+// AppendedMfaDevicesJSON returns the list of values that were appended to the "mfa_devices_json" field in this mutation.
+func (m *BronzeAWSIAMUserMutation) AppendedMfaDevicesJSON() (json.RawMessage, bool) {
+	if len(m.appendmfa_devices_json) == 0 {
+		return nil, false
+	}
+	return m.appendmfa_devices_json, true
+}
+
+// ClearMfaDevicesJSON clears the value of the "mfa_devices_json" field.
+func (m *BronzeAWSIAMUserMutation) ClearMfaDevicesJSON() {
+	m.mfa_devices_json = nil
+	m.appendmfa_devices_json = nil
+	m.clearedFields[bronzeawsiamuser.FieldMfaDevicesJSON] = struct{}{}
+}
+
+// MfaDevicesJSONCleared returns if the "mfa_devices_json" field was cleared in this mutation.
+func (m *BronzeAWSIAMUserMutation) MfaDevicesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamuser.FieldMfaDevicesJSON]
+	return ok
+}
+
+// ResetMfaDevicesJSON resets all changes to the "mfa_devices_json" field.
+func (m *BronzeAWSIAMUserMutation) ResetMfaDevicesJSON() {
+	m.mfa_devices_json = nil
+	m.appendmfa_devices_json = nil
+	delete(m.clearedFields, bronzeawsiamuser.FieldMfaDevicesJSON)
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (m *BronzeAWSIAMUserMutation) SetTagsJSON(jm json.RawMessage) {
+	m.tags_json = &jm
+	m.appendtags_json = nil
+}
+
+// TagsJSON returns the value of the "tags_json" field in the mutation.
+func (m *BronzeAWSIAMUserMutation) TagsJSON() (r json.RawMessage, exists bool) {
+	v := m.tags_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagsJSON returns the old "tags_json" field's value of the BronzeAWSIAMUser entity.
+// If the BronzeAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMUserMutation) OldTagsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagsJSON: %w", err)
+	}
+	return oldValue.TagsJSON, nil
+}
+
+// AppendTagsJSON adds jm to the "tags_json" field.
+func (m *BronzeAWSIAMUserMutation) AppendTagsJSON(jm json.RawMessage) {
+	m.appendtags_json = append(m.appendtags_json, jm...)
+}
+
+// AppendedTagsJSON returns the list of values that were appended to the "tags_json" field in this mutation.
+func (m *BronzeAWSIAMUserMutation) AppendedTagsJSON() (json.RawMessage, bool) {
+	if len(m.appendtags_json) == 0 {
+		return nil, false
+	}
+	return m.appendtags_json, true
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (m *BronzeAWSIAMUserMutation) ClearTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	m.clearedFields[bronzeawsiamuser.FieldTagsJSON] = struct{}{}
+}
+
+// TagsJSONCleared returns if the "tags_json" field was cleared in this mutation.
+func (m *BronzeAWSIAMUserMutation) TagsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawsiamuser.FieldTagsJSON]
+	return ok
+}
+
+// ResetTagsJSON resets all changes to the "tags_json" field.
+func (m *BronzeAWSIAMUserMutation) ResetTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	delete(m.clearedFields, bronzeawsiamuser.FieldTagsJSON)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BronzeAWSIAMUserMutation) SetAccountID(s string) {
+	m.account_id = &s
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BronzeAWSIAMUserMutation) AccountID() (r string, exists bool) {
+	v := m.account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the BronzeAWSIAMUser entity.
+// If the BronzeAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSIAMUserMutation) OldAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BronzeAWSIAMUserMutation) ResetAccountID() {
+	m.account_id = nil
+}
+
+// Where appends a list predicates to the BronzeAWSIAMUserMutation builder.
+func (m *BronzeAWSIAMUserMutation) Where(ps ...predicate.BronzeAWSIAMUser) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeAWSIAMUserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeAWSIAMUserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeAWSIAMUser, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeAWSIAMUserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeAWSIAMUserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeAWSIAMUser).
+func (m *BronzeAWSIAMUserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeAWSIAMUserMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.collected_at != nil {
+		fields = append(fields, bronzeawsiamuser.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzeawsiamuser.FieldFirstCollectedAt)
+	}
+	if m.arn != nil {
+		fields = append(fields, bronzeawsiamuser.FieldArn)
+	}
+	if m.user_name != nil {
+		fields = append(fields, bronzeawsiamuser.FieldUserName)
+	}
+	if m._path != nil {
+		fields = append(fields, bronzeawsiamuser.FieldPath)
+	}
+	if m.create_date != nil {
+		fields = append(fields, bronzeawsiamuser.FieldCreateDate)
+	}
+	if m.permissions_boundary_arn != nil {
+		fields = append(fields, bronzeawsiamuser.FieldPermissionsBoundaryArn)
+	}
+	if m.attached_policies_json != nil {
+		fields = append(fields, bronzeawsiamuser.FieldAttachedPoliciesJSON)
+	}
+	if m.inline_policies_json != nil {
+		fields = append(fields, bronzeawsiamuser.FieldInlinePoliciesJSON)
+	}
+	if m.groups_json != nil {
+		fields = append(fields, bronzeawsiamuser.FieldGroupsJSON)
+	}
+	if m.mfa_devices_json != nil {
+		fields = append(fields, bronzeawsiamuser.FieldMfaDevicesJSON)
+	}
+	if m.tags_json != nil {
+		fields = append(fields, bronzeawsiamuser.FieldTagsJSON)
+	}
+	if m.account_id != nil {
+		fields = append(fields, bronzeawsiamuser.FieldAccountID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeAWSIAMUserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzeawsiamuser.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzeawsiamuser.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzeawsiamuser.FieldArn:
+		return m.Arn()
+	case bronzeawsiamuser.FieldUserName:
+		return m.UserName()
+	case bronzeawsiamuser.FieldPath:
+		return m.Path()
+	case bronzeawsiamuser.FieldCreateDate:
+		return m.CreateDate()
+	case bronzeawsiamuser.FieldPermissionsBoundaryArn:
+		return m.PermissionsBoundaryArn()
+	case bronzeawsiamuser.FieldAttachedPoliciesJSON:
+		return m.AttachedPoliciesJSON()
+	case bronzeawsiamuser.FieldInlinePoliciesJSON:
+		return m.InlinePoliciesJSON()
+	case bronzeawsiamuser.FieldGroupsJSON:
+		return m.GroupsJSON()
+	case bronzeawsiamuser.FieldMfaDevicesJSON:
+		return m.MfaDevicesJSON()
+	case bronzeawsiamuser.FieldTagsJSON:
+		return m.TagsJSON()
+	case bronzeawsiamuser.FieldAccountID:
+		return m.AccountID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeAWSIAMUserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzeawsiamuser.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzeawsiamuser.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzeawsiamuser.FieldArn:
+		return m.OldArn(ctx)
+	case bronzeawsiamuser.FieldUserName:
+		return m.OldUserName(ctx)
+	case bronzeawsiamuser.FieldPath:
+		return m.OldPath(ctx)
+	case bronzeawsiamuser.FieldCreateDate:
+		return m.OldCreateDate(ctx)
+	case bronzeawsiamuser.FieldPermissionsBoundaryArn:
+		return m.OldPermissionsBoundaryArn(ctx)
+	case bronzeawsiamuser.FieldAttachedPoliciesJSON:
+		return m.OldAttachedPoliciesJSON(ctx)
+	case bronzeawsiamuser.FieldInlinePoliciesJSON:
+		return m.OldInlinePoliciesJSON(ctx)
+	case bronzeawsiamuser.FieldGroupsJSON:
+		return m.OldGroupsJSON(ctx)
+	case bronzeawsiamuser.FieldMfaDevicesJSON:
+		return m.OldMfaDevicesJSON(ctx)
+	case bronzeawsiamuser.FieldTagsJSON:
+		return m.OldTagsJSON(ctx)
+	case bronzeawsiamuser.FieldAccountID:
+		return m.OldAccountID(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeAWSIAMUser field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeAWSIAMUserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzeawsiamuser.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzeawsiamuser.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzeawsiamuser.FieldArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArn(v)
+		return nil
+	case bronzeawsiamuser.FieldUserName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserName(v)
+		return nil
+	case bronzeawsiamuser.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case bronzeawsiamuser.FieldCreateDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateDate(v)
+		return nil
+	case bronzeawsiamuser.FieldPermissionsBoundaryArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermissionsBoundaryArn(v)
+		return nil
+	case bronzeawsiamuser.FieldAttachedPoliciesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttachedPoliciesJSON(v)
+		return nil
+	case bronzeawsiamuser.FieldInlinePoliciesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInlinePoliciesJSON(v)
+		return nil
+	case bronzeawsiamuser.FieldGroupsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupsJSON(v)
+		return nil
+	case bronzeawsiamuser.FieldMfaDevicesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaDevicesJSON(v)
+		return nil
+	case bronzeawsiamuser.FieldTagsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagsJSON(v)
+		return nil
+	case bronzeawsiamuser.FieldAccountID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMUser field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeAWSIAMUserMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeAWSIAMUserMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeAWSIAMUserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMUser numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeAWSIAMUserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzeawsiamuser.FieldPath) {
+		fields = append(fields, bronzeawsiamuser.FieldPath)
+	}
+	if m.FieldCleared(bronzeawsiamuser.FieldCreateDate) {
+		fields = append(fields, bronzeawsiamuser.FieldCreateDate)
+	}
+	if m.FieldCleared(bronzeawsiamuser.FieldPermissionsBoundaryArn) {
+		fields = append(fields, bronzeawsiamuser.FieldPermissionsBoundaryArn)
+	}
+	if m.FieldCleared(bronzeawsiamuser.FieldAttachedPoliciesJSON) {
+		fields = append(fields, bronzeawsiamuser.FieldAttachedPoliciesJSON)
+	}
+	if m.FieldCleared(bronzeawsiamuser.FieldInlinePoliciesJSON) {
+		fields = append(fields, bronzeawsiamuser.FieldInlinePoliciesJSON)
+	}
+	if m.FieldCleared(bronzeawsiamuser.FieldGroupsJSON) {
+		fields = append(fields, bronzeawsiamuser.FieldGroupsJSON)
+	}
+	if m.FieldCleared(bronzeawsiamuser.FieldMfaDevicesJSON) {
+		fields = append(fields, bronzeawsiamuser.FieldMfaDevicesJSON)
+	}
+	if m.FieldCleared(bronzeawsiamuser.FieldTagsJSON) {
+		fields = append(fields, bronzeawsiamuser.FieldTagsJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeAWSIAMUserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeAWSIAMUserMutation) ClearField(name string) error {
+	switch name {
+	case bronzeawsiamuser.FieldPath:
+		m.ClearPath()
+		return nil
+	case bronzeawsiamuser.FieldCreateDate:
+		m.ClearCreateDate()
+		return nil
+	case bronzeawsiamuser.FieldPermissionsBoundaryArn:
+		m.ClearPermissionsBoundaryArn()
+		return nil
+	case bronzeawsiamuser.FieldAttachedPoliciesJSON:
+		m.ClearAttachedPoliciesJSON()
+		return nil
+	case bronzeawsiamuser.FieldInlinePoliciesJSON:
+		m.ClearInlinePoliciesJSON()
+		return nil
+	case bronzeawsiamuser.FieldGroupsJSON:
+		m.ClearGroupsJSON()
+		return nil
+	case bronzeawsiamuser.FieldMfaDevicesJSON:
+		m.ClearMfaDevicesJSON()
+		return nil
+	case bronzeawsiamuser.FieldTagsJSON:
+		m.ClearTagsJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMUser nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeAWSIAMUserMutation) ResetField(name string) error {
+	switch name {
+	case bronzeawsiamuser.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzeawsiamuser.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzeawsiamuser.FieldArn:
+		m.ResetArn()
+		return nil
+	case bronzeawsiamuser.FieldUserName:
+		m.ResetUserName()
+		return nil
+	case bronzeawsiamuser.FieldPath:
+		m.ResetPath()
+		return nil
+	case bronzeawsiamuser.FieldCreateDate:
+		m.ResetCreateDate()
+		return nil
+	case bronzeawsiamuser.FieldPermissionsBoundaryArn:
+		m.ResetPermissionsBoundaryArn()
+		return nil
+	case bronzeawsiamuser.FieldAttachedPoliciesJSON:
+		m.ResetAttachedPoliciesJSON()
+		return nil
+	case bronzeawsiamuser.FieldInlinePoliciesJSON:
+		m.ResetInlinePoliciesJSON()
+		return nil
+	case bronzeawsiamuser.FieldGroupsJSON:
+		m.ResetGroupsJSON()
+		return nil
+	case bronzeawsiamuser.FieldMfaDevicesJSON:
+		m.ResetMfaDevicesJSON()
+		return nil
+	case bronzeawsiamuser.FieldTagsJSON:
+		m.ResetTagsJSON()
+		return nil
+	case bronzeawsiamuser.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSIAMUser field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeAWSIAMUserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeAWSIAMUserMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeAWSIAMUserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeAWSIAMUserMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeAWSIAMUserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeAWSIAMUserMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeAWSIAMUserMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeAWSIAMUser unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeAWSIAMUserMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeAWSIAMUser edge %s", name)
+}
+
+// BronzeHistoryAWSIAMAccessKeyMutation represents an operation that mutates the BronzeHistoryAWSIAMAccessKey nodes in the graph.
+type BronzeHistoryAWSIAMAccessKeyMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uint
+	valid_from         *time.Time
+	valid_to           *time.Time
+	collected_at       *time.Time
+	first_collected_at *time.Time
+	resource_id        *string
+	user_name          *string
+	status             *string
+	create_date        *time.Time
+	last_used_date     *time.Time
+	last_used_service  *string
+	last_used_region   *string
+	account_id         *string
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*BronzeHistoryAWSIAMAccessKey, error)
+	predicates         []predicate.BronzeHistoryAWSIAMAccessKey
+}
+
+var _ ent.Mutation = (*BronzeHistoryAWSIAMAccessKeyMutation)(nil)
+
+// bronzehistoryawsiamaccesskeyOption allows management of the mutation configuration using functional options.
+type bronzehistoryawsiamaccesskeyOption func(*BronzeHistoryAWSIAMAccessKeyMutation)
+
+// newBronzeHistoryAWSIAMAccessKeyMutation creates new mutation for the BronzeHistoryAWSIAMAccessKey entity.
+func newBronzeHistoryAWSIAMAccessKeyMutation(c config, op Op, opts ...bronzehistoryawsiamaccesskeyOption) *BronzeHistoryAWSIAMAccessKeyMutation {
+	m := &BronzeHistoryAWSIAMAccessKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeHistoryAWSIAMAccessKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeHistoryAWSIAMAccessKeyID sets the ID field of the mutation.
+func withBronzeHistoryAWSIAMAccessKeyID(id uint) bronzehistoryawsiamaccesskeyOption {
+	return func(m *BronzeHistoryAWSIAMAccessKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeHistoryAWSIAMAccessKey
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeHistoryAWSIAMAccessKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeHistoryAWSIAMAccessKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeHistoryAWSIAMAccessKey sets the old BronzeHistoryAWSIAMAccessKey of the mutation.
+func withBronzeHistoryAWSIAMAccessKey(node *BronzeHistoryAWSIAMAccessKey) bronzehistoryawsiamaccesskeyOption {
+	return func(m *BronzeHistoryAWSIAMAccessKeyMutation) {
+		m.oldValue = func(context.Context) (*BronzeHistoryAWSIAMAccessKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeHistoryAWSIAMAccessKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeHistoryAWSIAMAccessKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("iam: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeHistoryAWSIAMAccessKey entities.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeHistoryAWSIAMAccessKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the BronzeHistoryAWSIAMAccessKey entity.
+// If the BronzeHistoryAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidTo sets the "valid_to" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) SetValidTo(t time.Time) {
+	m.valid_to = &t
+}
+
+// ValidTo returns the value of the "valid_to" field in the mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ValidTo() (r time.Time, exists bool) {
+	v := m.valid_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidTo returns the old "valid_to" field's value of the BronzeHistoryAWSIAMAccessKey entity.
+// If the BronzeHistoryAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) OldValidTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidTo: %w", err)
+	}
+	return oldValue.ValidTo, nil
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ClearValidTo() {
+	m.valid_to = nil
+	m.clearedFields[bronzehistoryawsiamaccesskey.FieldValidTo] = struct{}{}
+}
+
+// ValidToCleared returns if the "valid_to" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ValidToCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamaccesskey.FieldValidTo]
+	return ok
+}
+
+// ResetValidTo resets all changes to the "valid_to" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ResetValidTo() {
+	m.valid_to = nil
+	delete(m.clearedFields, bronzehistoryawsiamaccesskey.FieldValidTo)
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeHistoryAWSIAMAccessKey entity.
+// If the BronzeHistoryAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeHistoryAWSIAMAccessKey entity.
+// If the BronzeHistoryAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the BronzeHistoryAWSIAMAccessKey entity.
+// If the BronzeHistoryAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetUserName sets the "user_name" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) SetUserName(s string) {
+	m.user_name = &s
+}
+
+// UserName returns the value of the "user_name" field in the mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) UserName() (r string, exists bool) {
+	v := m.user_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserName returns the old "user_name" field's value of the BronzeHistoryAWSIAMAccessKey entity.
+// If the BronzeHistoryAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) OldUserName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserName: %w", err)
+	}
+	return oldValue.UserName, nil
+}
+
+// ResetUserName resets all changes to the "user_name" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ResetUserName() {
+	m.user_name = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the BronzeHistoryAWSIAMAccessKey entity.
+// If the BronzeHistoryAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ClearStatus clears the value of the "status" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ClearStatus() {
+	m.status = nil
+	m.clearedFields[bronzehistoryawsiamaccesskey.FieldStatus] = struct{}{}
+}
+
+// StatusCleared returns if the "status" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) StatusCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamaccesskey.FieldStatus]
+	return ok
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ResetStatus() {
+	m.status = nil
+	delete(m.clearedFields, bronzehistoryawsiamaccesskey.FieldStatus)
+}
+
+// SetCreateDate sets the "create_date" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) SetCreateDate(t time.Time) {
+	m.create_date = &t
+}
+
+// CreateDate returns the value of the "create_date" field in the mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) CreateDate() (r time.Time, exists bool) {
+	v := m.create_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateDate returns the old "create_date" field's value of the BronzeHistoryAWSIAMAccessKey entity.
+// If the BronzeHistoryAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) OldCreateDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateDate: %w", err)
+	}
+	return oldValue.CreateDate, nil
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ClearCreateDate() {
+	m.create_date = nil
+	m.clearedFields[bronzehistoryawsiamaccesskey.FieldCreateDate] = struct{}{}
+}
+
+// CreateDateCleared returns if the "create_date" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) CreateDateCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamaccesskey.FieldCreateDate]
+	return ok
+}
+
+// ResetCreateDate resets all changes to the "create_date" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ResetCreateDate() {
+	m.create_date = nil
+	delete(m.clearedFields, bronzehistoryawsiamaccesskey.FieldCreateDate)
+}
+
+// SetLastUsedDate sets the "last_used_date" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) SetLastUsedDate(t time.Time) {
+	m.last_used_date = &t
+}
+
+// LastUsedDate returns the value of the "last_used_date" field in the mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) LastUsedDate() (r time.Time, exists bool) {
+	v := m.last_used_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedDate returns the old "last_used_date" field's value of the BronzeHistoryAWSIAMAccessKey entity.
+// If the BronzeHistoryAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) OldLastUsedDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedDate: %w", err)
+	}
+	return oldValue.LastUsedDate, nil
+}
+
+// ClearLastUsedDate clears the value of the "last_used_date" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ClearLastUsedDate() {
+	m.last_used_date = nil
+	m.clearedFields[bronzehistoryawsiamaccesskey.FieldLastUsedDate] = struct{}{}
+}
+
+// LastUsedDateCleared returns if the "last_used_date" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) LastUsedDateCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamaccesskey.FieldLastUsedDate]
+	return ok
+}
+
+// ResetLastUsedDate resets all changes to the "last_used_date" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ResetLastUsedDate() {
+	m.last_used_date = nil
+	delete(m.clearedFields, bronzehistoryawsiamaccesskey.FieldLastUsedDate)
+}
+
+// SetLastUsedService sets the "last_used_service" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) SetLastUsedService(s string) {
+	m.last_used_service = &s
+}
+
+// LastUsedService returns the value of the "last_used_service" field in the mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) LastUsedService() (r string, exists bool) {
+	v := m.last_used_service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedService returns the old "last_used_service" field's value of the BronzeHistoryAWSIAMAccessKey entity.
+// If the BronzeHistoryAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) OldLastUsedService(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedService is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedService requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedService: %w", err)
+	}
+	return oldValue.LastUsedService, nil
+}
+
+// ClearLastUsedService clears the value of the "last_used_service" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ClearLastUsedService() {
+	m.last_used_service = nil
+	m.clearedFields[bronzehistoryawsiamaccesskey.FieldLastUsedService] = struct{}{}
+}
+
+// LastUsedServiceCleared returns if the "last_used_service" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) LastUsedServiceCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamaccesskey.FieldLastUsedService]
+	return ok
+}
+
+// ResetLastUsedService resets all changes to the "last_used_service" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ResetLastUsedService() {
+	m.last_used_service = nil
+	delete(m.clearedFields, bronzehistoryawsiamaccesskey.FieldLastUsedService)
+}
+
+// SetLastUsedRegion sets the "last_used_region" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) SetLastUsedRegion(s string) {
+	m.last_used_region = &s
+}
+
+// LastUsedRegion returns the value of the "last_used_region" field in the mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) LastUsedRegion() (r string, exists bool) {
+	v := m.last_used_region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedRegion returns the old "last_used_region" field's value of the BronzeHistoryAWSIAMAccessKey entity.
+// If the BronzeHistoryAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) OldLastUsedRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedRegion: %w", err)
+	}
+	return oldValue.LastUsedRegion, nil
+}
+
+// ClearLastUsedRegion clears the value of the "last_used_region" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ClearLastUsedRegion() {
+	m.last_used_region = nil
+	m.clearedFields[bronzehistoryawsiamaccesskey.FieldLastUsedRegion] = struct{}{}
+}
+
+// LastUsedRegionCleared returns if the "last_used_region" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) LastUsedRegionCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamaccesskey.FieldLastUsedRegion]
+	return ok
+}
+
+// ResetLastUsedRegion resets all changes to the "last_used_region" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ResetLastUsedRegion() {
+	m.last_used_region = nil
+	delete(m.clearedFields, bronzehistoryawsiamaccesskey.FieldLastUsedRegion)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) SetAccountID(s string) {
+	m.account_id = &s
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) AccountID() (r string, exists bool) {
+	v := m.account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the BronzeHistoryAWSIAMAccessKey entity.
+// If the BronzeHistoryAWSIAMAccessKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) OldAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ResetAccountID() {
+	m.account_id = nil
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMAccessKeyMutation builder.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) Where(ps ...predicate.BronzeHistoryAWSIAMAccessKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeHistoryAWSIAMAccessKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeHistoryAWSIAMAccessKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeHistoryAWSIAMAccessKey).
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.valid_from != nil {
+		fields = append(fields, bronzehistoryawsiamaccesskey.FieldValidFrom)
+	}
+	if m.valid_to != nil {
+		fields = append(fields, bronzehistoryawsiamaccesskey.FieldValidTo)
+	}
+	if m.collected_at != nil {
+		fields = append(fields, bronzehistoryawsiamaccesskey.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzehistoryawsiamaccesskey.FieldFirstCollectedAt)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, bronzehistoryawsiamaccesskey.FieldResourceID)
+	}
+	if m.user_name != nil {
+		fields = append(fields, bronzehistoryawsiamaccesskey.FieldUserName)
+	}
+	if m.status != nil {
+		fields = append(fields, bronzehistoryawsiamaccesskey.FieldStatus)
+	}
+	if m.create_date != nil {
+		fields = append(fields, bronzehistoryawsiamaccesskey.FieldCreateDate)
+	}
+	if m.last_used_date != nil {
+		fields = append(fields, bronzehistoryawsiamaccesskey.FieldLastUsedDate)
+	}
+	if m.last_used_service != nil {
+		fields = append(fields, bronzehistoryawsiamaccesskey.FieldLastUsedService)
+	}
+	if m.last_used_region != nil {
+		fields = append(fields, bronzehistoryawsiamaccesskey.FieldLastUsedRegion)
+	}
+	if m.account_id != nil {
+		fields = append(fields, bronzehistoryawsiamaccesskey.FieldAccountID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryawsiamaccesskey.FieldValidFrom:
+		return m.ValidFrom()
+	case bronzehistoryawsiamaccesskey.FieldValidTo:
+		return m.ValidTo()
+	case bronzehistoryawsiamaccesskey.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzehistoryawsiamaccesskey.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzehistoryawsiamaccesskey.FieldResourceID:
+		return m.ResourceID()
+	case bronzehistoryawsiamaccesskey.FieldUserName:
+		return m.UserName()
+	case bronzehistoryawsiamaccesskey.FieldStatus:
+		return m.Status()
+	case bronzehistoryawsiamaccesskey.FieldCreateDate:
+		return m.CreateDate()
+	case bronzehistoryawsiamaccesskey.FieldLastUsedDate:
+		return m.LastUsedDate()
+	case bronzehistoryawsiamaccesskey.FieldLastUsedService:
+		return m.LastUsedService()
+	case bronzehistoryawsiamaccesskey.FieldLastUsedRegion:
+		return m.LastUsedRegion()
+	case bronzehistoryawsiamaccesskey.FieldAccountID:
+		return m.AccountID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzehistoryawsiamaccesskey.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case bronzehistoryawsiamaccesskey.FieldValidTo:
+		return m.OldValidTo(ctx)
+	case bronzehistoryawsiamaccesskey.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzehistoryawsiamaccesskey.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzehistoryawsiamaccesskey.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case bronzehistoryawsiamaccesskey.FieldUserName:
+		return m.OldUserName(ctx)
+	case bronzehistoryawsiamaccesskey.FieldStatus:
+		return m.OldStatus(ctx)
+	case bronzehistoryawsiamaccesskey.FieldCreateDate:
+		return m.OldCreateDate(ctx)
+	case bronzehistoryawsiamaccesskey.FieldLastUsedDate:
+		return m.OldLastUsedDate(ctx)
+	case bronzehistoryawsiamaccesskey.FieldLastUsedService:
+		return m.OldLastUsedService(ctx)
+	case bronzehistoryawsiamaccesskey.FieldLastUsedRegion:
+		return m.OldLastUsedRegion(ctx)
+	case bronzehistoryawsiamaccesskey.FieldAccountID:
+		return m.OldAccountID(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeHistoryAWSIAMAccessKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryawsiamaccesskey.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldValidTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidTo(v)
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldUserName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserName(v)
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldCreateDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateDate(v)
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldLastUsedDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedDate(v)
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldLastUsedService:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedService(v)
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldLastUsedRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedRegion(v)
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldAccountID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMAccessKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMAccessKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzehistoryawsiamaccesskey.FieldValidTo) {
+		fields = append(fields, bronzehistoryawsiamaccesskey.FieldValidTo)
+	}
+	if m.FieldCleared(bronzehistoryawsiamaccesskey.FieldStatus) {
+		fields = append(fields, bronzehistoryawsiamaccesskey.FieldStatus)
+	}
+	if m.FieldCleared(bronzehistoryawsiamaccesskey.FieldCreateDate) {
+		fields = append(fields, bronzehistoryawsiamaccesskey.FieldCreateDate)
+	}
+	if m.FieldCleared(bronzehistoryawsiamaccesskey.FieldLastUsedDate) {
+		fields = append(fields, bronzehistoryawsiamaccesskey.FieldLastUsedDate)
+	}
+	if m.FieldCleared(bronzehistoryawsiamaccesskey.FieldLastUsedService) {
+		fields = append(fields, bronzehistoryawsiamaccesskey.FieldLastUsedService)
+	}
+	if m.FieldCleared(bronzehistoryawsiamaccesskey.FieldLastUsedRegion) {
+		fields = append(fields, bronzehistoryawsiamaccesskey.FieldLastUsedRegion)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ClearField(name string) error {
+	switch name {
+	case bronzehistoryawsiamaccesskey.FieldValidTo:
+		m.ClearValidTo()
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldStatus:
+		m.ClearStatus()
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldCreateDate:
+		m.ClearCreateDate()
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldLastUsedDate:
+		m.ClearLastUsedDate()
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldLastUsedService:
+		m.ClearLastUsedService()
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldLastUsedRegion:
+		m.ClearLastUsedRegion()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMAccessKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ResetField(name string) error {
+	switch name {
+	case bronzehistoryawsiamaccesskey.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldValidTo:
+		m.ResetValidTo()
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldUserName:
+		m.ResetUserName()
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldCreateDate:
+		m.ResetCreateDate()
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldLastUsedDate:
+		m.ResetLastUsedDate()
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldLastUsedService:
+		m.ResetLastUsedService()
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldLastUsedRegion:
+		m.ResetLastUsedRegion()
+		return nil
+	case bronzehistoryawsiamaccesskey.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMAccessKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMAccessKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeHistoryAWSIAMAccessKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMAccessKey edge %s", name)
+}
+
+// BronzeHistoryAWSIAMCredentialReportMutation represents an operation that mutates the BronzeHistoryAWSIAMCredentialReport nodes in the graph.
+type BronzeHistoryAWSIAMCredentialReportMutation struct {
+	config
+	op                             Op
+	typ                            string
+	id                             *uint
+	valid_from                     *time.Time
+	valid_to                       *time.Time
+	collected_at                   *time.Time
+	first_collected_at             *time.Time
+	resource_id                    *string
+	user                           *string
+	user_creation_time             *time.Time
+	password_enabled               *string
+	password_last_used             *time.Time
+	password_last_changed          *time.Time
+	password_next_rotation         *time.Time
+	mfa_active                     *bool
+	access_key_1_active            *bool
+	access_key_1_last_rotated      *time.Time
+	access_key_1_last_used_date    *time.Time
+	access_key_1_last_used_region  *string
+	access_key_1_last_used_service *string
+	access_key_2_active            *bool
+	access_key_2_last_rotated      *time.Time
+	access_key_2_last_used_date    *time.Time
+	access_key_2_last_used_region  *string
+	access_key_2_last_used_service *string
+	cert_1_active                  *bool
+	cert_1_last_rotated            *time.Time
+	cert_2_active                  *bool
+	cert_2_last_rotated            *time.Time
+	report_generated_at            *time.Time
+	account_id                     *string
+	clearedFields                  map[string]struct{}
+	done                           bool
+	oldValue                       func(context.Context) (*BronzeHistoryAWSIAMCredentialReport, error)
+	predicates                     []predicate.BronzeHistoryAWSIAMCredentialReport
+}
+
+var _ ent.Mutation = (*BronzeHistoryAWSIAMCredentialReportMutation)(nil)
+
+// bronzehistoryawsiamcredentialreportOption allows management of the mutation configuration using functional options.
+type bronzehistoryawsiamcredentialreportOption func(*BronzeHistoryAWSIAMCredentialReportMutation)
+
+// newBronzeHistoryAWSIAMCredentialReportMutation creates new mutation for the BronzeHistoryAWSIAMCredentialReport entity.
+func newBronzeHistoryAWSIAMCredentialReportMutation(c config, op Op, opts ...bronzehistoryawsiamcredentialreportOption) *BronzeHistoryAWSIAMCredentialReportMutation {
+	m := &BronzeHistoryAWSIAMCredentialReportMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeHistoryAWSIAMCredentialReport,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeHistoryAWSIAMCredentialReportID sets the ID field of the mutation.
+func withBronzeHistoryAWSIAMCredentialReportID(id uint) bronzehistoryawsiamcredentialreportOption {
+	return func(m *BronzeHistoryAWSIAMCredentialReportMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeHistoryAWSIAMCredentialReport
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeHistoryAWSIAMCredentialReport, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeHistoryAWSIAMCredentialReport.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeHistoryAWSIAMCredentialReport sets the old BronzeHistoryAWSIAMCredentialReport of the mutation.
+func withBronzeHistoryAWSIAMCredentialReport(node *BronzeHistoryAWSIAMCredentialReport) bronzehistoryawsiamcredentialreportOption {
+	return func(m *BronzeHistoryAWSIAMCredentialReportMutation) {
+		m.oldValue = func(context.Context) (*BronzeHistoryAWSIAMCredentialReport, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeHistoryAWSIAMCredentialReportMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeHistoryAWSIAMCredentialReportMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("iam: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeHistoryAWSIAMCredentialReport entities.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeHistoryAWSIAMCredentialReport.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidTo sets the "valid_to" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetValidTo(t time.Time) {
+	m.valid_to = &t
+}
+
+// ValidTo returns the value of the "valid_to" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ValidTo() (r time.Time, exists bool) {
+	v := m.valid_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidTo returns the old "valid_to" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldValidTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidTo: %w", err)
+	}
+	return oldValue.ValidTo, nil
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearValidTo() {
+	m.valid_to = nil
+	m.clearedFields[bronzehistoryawsiamcredentialreport.FieldValidTo] = struct{}{}
+}
+
+// ValidToCleared returns if the "valid_to" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ValidToCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamcredentialreport.FieldValidTo]
+	return ok
+}
+
+// ResetValidTo resets all changes to the "valid_to" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetValidTo() {
+	m.valid_to = nil
+	delete(m.clearedFields, bronzehistoryawsiamcredentialreport.FieldValidTo)
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetUser sets the "user" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetUser(s string) {
+	m.user = &s
+}
+
+// User returns the value of the "user" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) User() (r string, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser returns the old "user" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldUser(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser: %w", err)
+	}
+	return oldValue.User, nil
+}
+
+// ResetUser resets all changes to the "user" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetUser() {
+	m.user = nil
+}
+
+// SetUserCreationTime sets the "user_creation_time" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetUserCreationTime(t time.Time) {
+	m.user_creation_time = &t
+}
+
+// UserCreationTime returns the value of the "user_creation_time" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) UserCreationTime() (r time.Time, exists bool) {
+	v := m.user_creation_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserCreationTime returns the old "user_creation_time" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldUserCreationTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserCreationTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserCreationTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserCreationTime: %w", err)
+	}
+	return oldValue.UserCreationTime, nil
+}
+
+// ClearUserCreationTime clears the value of the "user_creation_time" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearUserCreationTime() {
+	m.user_creation_time = nil
+	m.clearedFields[bronzehistoryawsiamcredentialreport.FieldUserCreationTime] = struct{}{}
+}
+
+// UserCreationTimeCleared returns if the "user_creation_time" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) UserCreationTimeCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamcredentialreport.FieldUserCreationTime]
+	return ok
+}
+
+// ResetUserCreationTime resets all changes to the "user_creation_time" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetUserCreationTime() {
+	m.user_creation_time = nil
+	delete(m.clearedFields, bronzehistoryawsiamcredentialreport.FieldUserCreationTime)
+}
+
+// SetPasswordEnabled sets the "password_enabled" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetPasswordEnabled(s string) {
+	m.password_enabled = &s
+}
+
+// PasswordEnabled returns the value of the "password_enabled" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) PasswordEnabled() (r string, exists bool) {
+	v := m.password_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordEnabled returns the old "password_enabled" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldPasswordEnabled(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordEnabled: %w", err)
+	}
+	return oldValue.PasswordEnabled, nil
+}
+
+// ClearPasswordEnabled clears the value of the "password_enabled" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearPasswordEnabled() {
+	m.password_enabled = nil
+	m.clearedFields[bronzehistoryawsiamcredentialreport.FieldPasswordEnabled] = struct{}{}
+}
+
+// PasswordEnabledCleared returns if the "password_enabled" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) PasswordEnabledCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamcredentialreport.FieldPasswordEnabled]
+	return ok
+}
+
+// ResetPasswordEnabled resets all changes to the "password_enabled" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetPasswordEnabled() {
+	m.password_enabled = nil
+	delete(m.clearedFields, bronzehistoryawsiamcredentialreport.FieldPasswordEnabled)
+}
+
+// SetPasswordLastUsed sets the "password_last_used" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetPasswordLastUsed(t time.Time) {
+	m.password_last_used = &t
+}
+
+// PasswordLastUsed returns the value of the "password_last_used" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) PasswordLastUsed() (r time.Time, exists bool) {
+	v := m.password_last_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordLastUsed returns the old "password_last_used" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldPasswordLastUsed(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordLastUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordLastUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordLastUsed: %w", err)
+	}
+	return oldValue.PasswordLastUsed, nil
+}
+
+// ClearPasswordLastUsed clears the value of the "password_last_used" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearPasswordLastUsed() {
+	m.password_last_used = nil
+	m.clearedFields[bronzehistoryawsiamcredentialreport.FieldPasswordLastUsed] = struct{}{}
+}
+
+// PasswordLastUsedCleared returns if the "password_last_used" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) PasswordLastUsedCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamcredentialreport.FieldPasswordLastUsed]
+	return ok
+}
+
+// ResetPasswordLastUsed resets all changes to the "password_last_used" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetPasswordLastUsed() {
+	m.password_last_used = nil
+	delete(m.clearedFields, bronzehistoryawsiamcredentialreport.FieldPasswordLastUsed)
+}
+
+// SetPasswordLastChanged sets the "password_last_changed" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetPasswordLastChanged(t time.Time) {
+	m.password_last_changed = &t
+}
+
+// PasswordLastChanged returns the value of the "password_last_changed" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) PasswordLastChanged() (r time.Time, exists bool) {
+	v := m.password_last_changed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordLastChanged returns the old "password_last_changed" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldPasswordLastChanged(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordLastChanged is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordLastChanged requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordLastChanged: %w", err)
+	}
+	return oldValue.PasswordLastChanged, nil
+}
+
+// ClearPasswordLastChanged clears the value of the "password_last_changed" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearPasswordLastChanged() {
+	m.password_last_changed = nil
+	m.clearedFields[bronzehistoryawsiamcredentialreport.FieldPasswordLastChanged] = struct{}{}
+}
+
+// PasswordLastChangedCleared returns if the "password_last_changed" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) PasswordLastChangedCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamcredentialreport.FieldPasswordLastChanged]
+	return ok
+}
+
+// ResetPasswordLastChanged resets all changes to the "password_last_changed" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetPasswordLastChanged() {
+	m.password_last_changed = nil
+	delete(m.clearedFields, bronzehistoryawsiamcredentialreport.FieldPasswordLastChanged)
+}
+
+// SetPasswordNextRotation sets the "password_next_rotation" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetPasswordNextRotation(t time.Time) {
+	m.password_next_rotation = &t
+}
+
+// PasswordNextRotation returns the value of the "password_next_rotation" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) PasswordNextRotation() (r time.Time, exists bool) {
+	v := m.password_next_rotation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordNextRotation returns the old "password_next_rotation" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldPasswordNextRotation(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordNextRotation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordNextRotation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordNextRotation: %w", err)
+	}
+	return oldValue.PasswordNextRotation, nil
+}
+
+// ClearPasswordNextRotation clears the value of the "password_next_rotation" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearPasswordNextRotation() {
+	m.password_next_rotation = nil
+	m.clearedFields[bronzehistoryawsiamcredentialreport.FieldPasswordNextRotation] = struct{}{}
+}
+
+// PasswordNextRotationCleared returns if the "password_next_rotation" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) PasswordNextRotationCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamcredentialreport.FieldPasswordNextRotation]
+	return ok
+}
+
+// ResetPasswordNextRotation resets all changes to the "password_next_rotation" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetPasswordNextRotation() {
+	m.password_next_rotation = nil
+	delete(m.clearedFields, bronzehistoryawsiamcredentialreport.FieldPasswordNextRotation)
+}
+
+// SetMfaActive sets the "mfa_active" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetMfaActive(b bool) {
+	m.mfa_active = &b
+}
+
+// MfaActive returns the value of the "mfa_active" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) MfaActive() (r bool, exists bool) {
+	v := m.mfa_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMfaActive returns the old "mfa_active" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldMfaActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMfaActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMfaActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMfaActive: %w", err)
+	}
+	return oldValue.MfaActive, nil
+}
+
+// ResetMfaActive resets all changes to the "mfa_active" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetMfaActive() {
+	m.mfa_active = nil
+}
+
+// SetAccessKey1Active sets the "access_key_1_active" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetAccessKey1Active(b bool) {
+	m.access_key_1_active = &b
+}
+
+// AccessKey1Active returns the value of the "access_key_1_active" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AccessKey1Active() (r bool, exists bool) {
+	v := m.access_key_1_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey1Active returns the old "access_key_1_active" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldAccessKey1Active(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey1Active is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey1Active requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey1Active: %w", err)
+	}
+	return oldValue.AccessKey1Active, nil
+}
+
+// ResetAccessKey1Active resets all changes to the "access_key_1_active" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetAccessKey1Active() {
+	m.access_key_1_active = nil
+}
+
+// SetAccessKey1LastRotated sets the "access_key_1_last_rotated" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetAccessKey1LastRotated(t time.Time) {
+	m.access_key_1_last_rotated = &t
+}
+
+// AccessKey1LastRotated returns the value of the "access_key_1_last_rotated" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AccessKey1LastRotated() (r time.Time, exists bool) {
+	v := m.access_key_1_last_rotated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey1LastRotated returns the old "access_key_1_last_rotated" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldAccessKey1LastRotated(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey1LastRotated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey1LastRotated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey1LastRotated: %w", err)
+	}
+	return oldValue.AccessKey1LastRotated, nil
+}
+
+// ClearAccessKey1LastRotated clears the value of the "access_key_1_last_rotated" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearAccessKey1LastRotated() {
+	m.access_key_1_last_rotated = nil
+	m.clearedFields[bronzehistoryawsiamcredentialreport.FieldAccessKey1LastRotated] = struct{}{}
+}
+
+// AccessKey1LastRotatedCleared returns if the "access_key_1_last_rotated" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AccessKey1LastRotatedCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamcredentialreport.FieldAccessKey1LastRotated]
+	return ok
+}
+
+// ResetAccessKey1LastRotated resets all changes to the "access_key_1_last_rotated" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetAccessKey1LastRotated() {
+	m.access_key_1_last_rotated = nil
+	delete(m.clearedFields, bronzehistoryawsiamcredentialreport.FieldAccessKey1LastRotated)
+}
+
+// SetAccessKey1LastUsedDate sets the "access_key_1_last_used_date" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetAccessKey1LastUsedDate(t time.Time) {
+	m.access_key_1_last_used_date = &t
+}
+
+// AccessKey1LastUsedDate returns the value of the "access_key_1_last_used_date" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AccessKey1LastUsedDate() (r time.Time, exists bool) {
+	v := m.access_key_1_last_used_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey1LastUsedDate returns the old "access_key_1_last_used_date" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldAccessKey1LastUsedDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey1LastUsedDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey1LastUsedDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey1LastUsedDate: %w", err)
+	}
+	return oldValue.AccessKey1LastUsedDate, nil
+}
+
+// ClearAccessKey1LastUsedDate clears the value of the "access_key_1_last_used_date" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearAccessKey1LastUsedDate() {
+	m.access_key_1_last_used_date = nil
+	m.clearedFields[bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedDate] = struct{}{}
+}
+
+// AccessKey1LastUsedDateCleared returns if the "access_key_1_last_used_date" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AccessKey1LastUsedDateCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedDate]
+	return ok
+}
+
+// ResetAccessKey1LastUsedDate resets all changes to the "access_key_1_last_used_date" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetAccessKey1LastUsedDate() {
+	m.access_key_1_last_used_date = nil
+	delete(m.clearedFields, bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedDate)
+}
+
+// SetAccessKey1LastUsedRegion sets the "access_key_1_last_used_region" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetAccessKey1LastUsedRegion(s string) {
+	m.access_key_1_last_used_region = &s
+}
+
+// AccessKey1LastUsedRegion returns the value of the "access_key_1_last_used_region" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AccessKey1LastUsedRegion() (r string, exists bool) {
+	v := m.access_key_1_last_used_region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey1LastUsedRegion returns the old "access_key_1_last_used_region" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldAccessKey1LastUsedRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey1LastUsedRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey1LastUsedRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey1LastUsedRegion: %w", err)
+	}
+	return oldValue.AccessKey1LastUsedRegion, nil
+}
+
+// ClearAccessKey1LastUsedRegion clears the value of the "access_key_1_last_used_region" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearAccessKey1LastUsedRegion() {
+	m.access_key_1_last_used_region = nil
+	m.clearedFields[bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedRegion] = struct{}{}
+}
+
+// AccessKey1LastUsedRegionCleared returns if the "access_key_1_last_used_region" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AccessKey1LastUsedRegionCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedRegion]
+	return ok
+}
+
+// ResetAccessKey1LastUsedRegion resets all changes to the "access_key_1_last_used_region" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetAccessKey1LastUsedRegion() {
+	m.access_key_1_last_used_region = nil
+	delete(m.clearedFields, bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedRegion)
+}
+
+// SetAccessKey1LastUsedService sets the "access_key_1_last_used_service" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetAccessKey1LastUsedService(s string) {
+	m.access_key_1_last_used_service = &s
+}
+
+// AccessKey1LastUsedService returns the value of the "access_key_1_last_used_service" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AccessKey1LastUsedService() (r string, exists bool) {
+	v := m.access_key_1_last_used_service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey1LastUsedService returns the old "access_key_1_last_used_service" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldAccessKey1LastUsedService(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey1LastUsedService is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey1LastUsedService requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey1LastUsedService: %w", err)
+	}
+	return oldValue.AccessKey1LastUsedService, nil
+}
+
+// ClearAccessKey1LastUsedService clears the value of the "access_key_1_last_used_service" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearAccessKey1LastUsedService() {
+	m.access_key_1_last_used_service = nil
+	m.clearedFields[bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedService] = struct{}{}
+}
+
+// AccessKey1LastUsedServiceCleared returns if the "access_key_1_last_used_service" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AccessKey1LastUsedServiceCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedService]
+	return ok
+}
+
+// ResetAccessKey1LastUsedService resets all changes to the "access_key_1_last_used_service" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetAccessKey1LastUsedService() {
+	m.access_key_1_last_used_service = nil
+	delete(m.clearedFields, bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedService)
+}
+
+// SetAccessKey2Active sets the "access_key_2_active" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetAccessKey2Active(b bool) {
+	m.access_key_2_active = &b
+}
+
+// AccessKey2Active returns the value of the "access_key_2_active" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AccessKey2Active() (r bool, exists bool) {
+	v := m.access_key_2_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey2Active returns the old "access_key_2_active" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldAccessKey2Active(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey2Active is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey2Active requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey2Active: %w", err)
+	}
+	return oldValue.AccessKey2Active, nil
+}
+
+// ResetAccessKey2Active resets all changes to the "access_key_2_active" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetAccessKey2Active() {
+	m.access_key_2_active = nil
+}
+
+// SetAccessKey2LastRotated sets the "access_key_2_last_rotated" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetAccessKey2LastRotated(t time.Time) {
+	m.access_key_2_last_rotated = &t
+}
+
+// AccessKey2LastRotated returns the value of the "access_key_2_last_rotated" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AccessKey2LastRotated() (r time.Time, exists bool) {
+	v := m.access_key_2_last_rotated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey2LastRotated returns the old "access_key_2_last_rotated" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldAccessKey2LastRotated(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey2LastRotated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey2LastRotated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey2LastRotated: %w", err)
+	}
+	return oldValue.AccessKey2LastRotated, nil
+}
+
+// ClearAccessKey2LastRotated clears the value of the "access_key_2_last_rotated" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearAccessKey2LastRotated() {
+	m.access_key_2_last_rotated = nil
+	m.clearedFields[bronzehistoryawsiamcredentialreport.FieldAccessKey2LastRotated] = struct{}{}
+}
+
+// AccessKey2LastRotatedCleared returns if the "access_key_2_last_rotated" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AccessKey2LastRotatedCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamcredentialreport.FieldAccessKey2LastRotated]
+	return ok
+}
+
+// ResetAccessKey2LastRotated resets all changes to the "access_key_2_last_rotated" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetAccessKey2LastRotated() {
+	m.access_key_2_last_rotated = nil
+	delete(m.clearedFields, bronzehistoryawsiamcredentialreport.FieldAccessKey2LastRotated)
+}
+
+// SetAccessKey2LastUsedDate sets the "access_key_2_last_used_date" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetAccessKey2LastUsedDate(t time.Time) {
+	m.access_key_2_last_used_date = &t
+}
+
+// AccessKey2LastUsedDate returns the value of the "access_key_2_last_used_date" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AccessKey2LastUsedDate() (r time.Time, exists bool) {
+	v := m.access_key_2_last_used_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey2LastUsedDate returns the old "access_key_2_last_used_date" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldAccessKey2LastUsedDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey2LastUsedDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey2LastUsedDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey2LastUsedDate: %w", err)
+	}
+	return oldValue.AccessKey2LastUsedDate, nil
+}
+
+// ClearAccessKey2LastUsedDate clears the value of the "access_key_2_last_used_date" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearAccessKey2LastUsedDate() {
+	m.access_key_2_last_used_date = nil
+	m.clearedFields[bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedDate] = struct{}{}
+}
+
+// AccessKey2LastUsedDateCleared returns if the "access_key_2_last_used_date" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AccessKey2LastUsedDateCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedDate]
+	return ok
+}
+
+// ResetAccessKey2LastUsedDate resets all changes to the "access_key_2_last_used_date" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetAccessKey2LastUsedDate() {
+	m.access_key_2_last_used_date = nil
+	delete(m.clearedFields, bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedDate)
+}
+
+// SetAccessKey2LastUsedRegion sets the "access_key_2_last_used_region" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetAccessKey2LastUsedRegion(s string) {
+	m.access_key_2_last_used_region = &s
+}
+
+// AccessKey2LastUsedRegion returns the value of the "access_key_2_last_used_region" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AccessKey2LastUsedRegion() (r string, exists bool) {
+	v := m.access_key_2_last_used_region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey2LastUsedRegion returns the old "access_key_2_last_used_region" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldAccessKey2LastUsedRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey2LastUsedRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey2LastUsedRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey2LastUsedRegion: %w", err)
+	}
+	return oldValue.AccessKey2LastUsedRegion, nil
+}
+
+// ClearAccessKey2LastUsedRegion clears the value of the "access_key_2_last_used_region" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearAccessKey2LastUsedRegion() {
+	m.access_key_2_last_used_region = nil
+	m.clearedFields[bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedRegion] = struct{}{}
+}
+
+// AccessKey2LastUsedRegionCleared returns if the "access_key_2_last_used_region" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AccessKey2LastUsedRegionCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedRegion]
+	return ok
+}
+
+// ResetAccessKey2LastUsedRegion resets all changes to the "access_key_2_last_used_region" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetAccessKey2LastUsedRegion() {
+	m.access_key_2_last_used_region = nil
+	delete(m.clearedFields, bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedRegion)
+}
+
+// SetAccessKey2LastUsedService sets the "access_key_2_last_used_service" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetAccessKey2LastUsedService(s string) {
+	m.access_key_2_last_used_service = &s
+}
+
+// AccessKey2LastUsedService returns the value of the "access_key_2_last_used_service" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AccessKey2LastUsedService() (r string, exists bool) {
+	v := m.access_key_2_last_used_service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKey2LastUsedService returns the old "access_key_2_last_used_service" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldAccessKey2LastUsedService(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKey2LastUsedService is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKey2LastUsedService requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKey2LastUsedService: %w", err)
+	}
+	return oldValue.AccessKey2LastUsedService, nil
+}
+
+// ClearAccessKey2LastUsedService clears the value of the "access_key_2_last_used_service" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearAccessKey2LastUsedService() {
+	m.access_key_2_last_used_service = nil
+	m.clearedFields[bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedService] = struct{}{}
+}
+
+// AccessKey2LastUsedServiceCleared returns if the "access_key_2_last_used_service" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AccessKey2LastUsedServiceCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedService]
+	return ok
+}
+
+// ResetAccessKey2LastUsedService resets all changes to the "access_key_2_last_used_service" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetAccessKey2LastUsedService() {
+	m.access_key_2_last_used_service = nil
+	delete(m.clearedFields, bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedService)
+}
+
+// SetCert1Active sets the "cert_1_active" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetCert1Active(b bool) {
+	m.cert_1_active = &b
+}
+
+// Cert1Active returns the value of the "cert_1_active" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) Cert1Active() (r bool, exists bool) {
+	v := m.cert_1_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCert1Active returns the old "cert_1_active" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldCert1Active(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCert1Active is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCert1Active requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCert1Active: %w", err)
+	}
+	return oldValue.Cert1Active, nil
+}
+
+// ResetCert1Active resets all changes to the "cert_1_active" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetCert1Active() {
+	m.cert_1_active = nil
+}
+
+// SetCert1LastRotated sets the "cert_1_last_rotated" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetCert1LastRotated(t time.Time) {
+	m.cert_1_last_rotated = &t
+}
+
+// Cert1LastRotated returns the value of the "cert_1_last_rotated" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) Cert1LastRotated() (r time.Time, exists bool) {
+	v := m.cert_1_last_rotated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCert1LastRotated returns the old "cert_1_last_rotated" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldCert1LastRotated(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCert1LastRotated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCert1LastRotated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCert1LastRotated: %w", err)
+	}
+	return oldValue.Cert1LastRotated, nil
+}
+
+// ClearCert1LastRotated clears the value of the "cert_1_last_rotated" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearCert1LastRotated() {
+	m.cert_1_last_rotated = nil
+	m.clearedFields[bronzehistoryawsiamcredentialreport.FieldCert1LastRotated] = struct{}{}
+}
+
+// Cert1LastRotatedCleared returns if the "cert_1_last_rotated" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) Cert1LastRotatedCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamcredentialreport.FieldCert1LastRotated]
+	return ok
+}
+
+// ResetCert1LastRotated resets all changes to the "cert_1_last_rotated" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetCert1LastRotated() {
+	m.cert_1_last_rotated = nil
+	delete(m.clearedFields, bronzehistoryawsiamcredentialreport.FieldCert1LastRotated)
+}
+
+// SetCert2Active sets the "cert_2_active" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetCert2Active(b bool) {
+	m.cert_2_active = &b
+}
+
+// Cert2Active returns the value of the "cert_2_active" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) Cert2Active() (r bool, exists bool) {
+	v := m.cert_2_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCert2Active returns the old "cert_2_active" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldCert2Active(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCert2Active is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCert2Active requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCert2Active: %w", err)
+	}
+	return oldValue.Cert2Active, nil
+}
+
+// ResetCert2Active resets all changes to the "cert_2_active" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetCert2Active() {
+	m.cert_2_active = nil
+}
+
+// SetCert2LastRotated sets the "cert_2_last_rotated" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetCert2LastRotated(t time.Time) {
+	m.cert_2_last_rotated = &t
+}
+
+// Cert2LastRotated returns the value of the "cert_2_last_rotated" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) Cert2LastRotated() (r time.Time, exists bool) {
+	v := m.cert_2_last_rotated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCert2LastRotated returns the old "cert_2_last_rotated" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldCert2LastRotated(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCert2LastRotated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCert2LastRotated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCert2LastRotated: %w", err)
+	}
+	return oldValue.Cert2LastRotated, nil
+}
+
+// ClearCert2LastRotated clears the value of the "cert_2_last_rotated" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearCert2LastRotated() {
+	m.cert_2_last_rotated = nil
+	m.clearedFields[bronzehistoryawsiamcredentialreport.FieldCert2LastRotated] = struct{}{}
+}
+
+// Cert2LastRotatedCleared returns if the "cert_2_last_rotated" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) Cert2LastRotatedCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamcredentialreport.FieldCert2LastRotated]
+	return ok
+}
+
+// ResetCert2LastRotated resets all changes to the "cert_2_last_rotated" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetCert2LastRotated() {
+	m.cert_2_last_rotated = nil
+	delete(m.clearedFields, bronzehistoryawsiamcredentialreport.FieldCert2LastRotated)
+}
+
+// SetReportGeneratedAt sets the "report_generated_at" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetReportGeneratedAt(t time.Time) {
+	m.report_generated_at = &t
+}
+
+// ReportGeneratedAt returns the value of the "report_generated_at" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ReportGeneratedAt() (r time.Time, exists bool) {
+	v := m.report_generated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReportGeneratedAt returns the old "report_generated_at" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldReportGeneratedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReportGeneratedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReportGeneratedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReportGeneratedAt: %w", err)
+	}
+	return oldValue.ReportGeneratedAt, nil
+}
+
+// ClearReportGeneratedAt clears the value of the "report_generated_at" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearReportGeneratedAt() {
+	m.report_generated_at = nil
+	m.clearedFields[bronzehistoryawsiamcredentialreport.FieldReportGeneratedAt] = struct{}{}
+}
+
+// ReportGeneratedAtCleared returns if the "report_generated_at" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ReportGeneratedAtCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamcredentialreport.FieldReportGeneratedAt]
+	return ok
+}
+
+// ResetReportGeneratedAt resets all changes to the "report_generated_at" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetReportGeneratedAt() {
+	m.report_generated_at = nil
+	delete(m.clearedFields, bronzehistoryawsiamcredentialreport.FieldReportGeneratedAt)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetAccountID(s string) {
+	m.account_id = &s
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AccountID() (r string, exists bool) {
+	v := m.account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the BronzeHistoryAWSIAMCredentialReport entity.
+// If the BronzeHistoryAWSIAMCredentialReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetAccountID() {
+	m.account_id = nil
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMCredentialReportMutation builder.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) Where(ps ...predicate.BronzeHistoryAWSIAMCredentialReport) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeHistoryAWSIAMCredentialReportMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeHistoryAWSIAMCredentialReport, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeHistoryAWSIAMCredentialReport).
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) Fields() []string {
+	fields := make([]string, 0, 28)
+	if m.valid_from != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldValidFrom)
+	}
+	if m.valid_to != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldValidTo)
+	}
+	if m.collected_at != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldFirstCollectedAt)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldResourceID)
+	}
+	if m.user != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldUser)
+	}
+	if m.user_creation_time != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldUserCreationTime)
+	}
+	if m.password_enabled != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldPasswordEnabled)
+	}
+	if m.password_last_used != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldPasswordLastUsed)
+	}
+	if m.password_last_changed != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldPasswordLastChanged)
+	}
+	if m.password_next_rotation != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldPasswordNextRotation)
+	}
+	if m.mfa_active != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldMfaActive)
+	}
+	if m.access_key_1_active != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldAccessKey1Active)
+	}
+	if m.access_key_1_last_rotated != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldAccessKey1LastRotated)
+	}
+	if m.access_key_1_last_used_date != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedDate)
+	}
+	if m.access_key_1_last_used_region != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedRegion)
+	}
+	if m.access_key_1_last_used_service != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedService)
+	}
+	if m.access_key_2_active != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldAccessKey2Active)
+	}
+	if m.access_key_2_last_rotated != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldAccessKey2LastRotated)
+	}
+	if m.access_key_2_last_used_date != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedDate)
+	}
+	if m.access_key_2_last_used_region != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedRegion)
+	}
+	if m.access_key_2_last_used_service != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedService)
+	}
+	if m.cert_1_active != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldCert1Active)
+	}
+	if m.cert_1_last_rotated != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldCert1LastRotated)
+	}
+	if m.cert_2_active != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldCert2Active)
+	}
+	if m.cert_2_last_rotated != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldCert2LastRotated)
+	}
+	if m.report_generated_at != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldReportGeneratedAt)
+	}
+	if m.account_id != nil {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldAccountID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryawsiamcredentialreport.FieldValidFrom:
+		return m.ValidFrom()
+	case bronzehistoryawsiamcredentialreport.FieldValidTo:
+		return m.ValidTo()
+	case bronzehistoryawsiamcredentialreport.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzehistoryawsiamcredentialreport.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzehistoryawsiamcredentialreport.FieldResourceID:
+		return m.ResourceID()
+	case bronzehistoryawsiamcredentialreport.FieldUser:
+		return m.User()
+	case bronzehistoryawsiamcredentialreport.FieldUserCreationTime:
+		return m.UserCreationTime()
+	case bronzehistoryawsiamcredentialreport.FieldPasswordEnabled:
+		return m.PasswordEnabled()
+	case bronzehistoryawsiamcredentialreport.FieldPasswordLastUsed:
+		return m.PasswordLastUsed()
+	case bronzehistoryawsiamcredentialreport.FieldPasswordLastChanged:
+		return m.PasswordLastChanged()
+	case bronzehistoryawsiamcredentialreport.FieldPasswordNextRotation:
+		return m.PasswordNextRotation()
+	case bronzehistoryawsiamcredentialreport.FieldMfaActive:
+		return m.MfaActive()
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1Active:
+		return m.AccessKey1Active()
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastRotated:
+		return m.AccessKey1LastRotated()
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedDate:
+		return m.AccessKey1LastUsedDate()
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedRegion:
+		return m.AccessKey1LastUsedRegion()
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedService:
+		return m.AccessKey1LastUsedService()
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2Active:
+		return m.AccessKey2Active()
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastRotated:
+		return m.AccessKey2LastRotated()
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedDate:
+		return m.AccessKey2LastUsedDate()
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedRegion:
+		return m.AccessKey2LastUsedRegion()
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedService:
+		return m.AccessKey2LastUsedService()
+	case bronzehistoryawsiamcredentialreport.FieldCert1Active:
+		return m.Cert1Active()
+	case bronzehistoryawsiamcredentialreport.FieldCert1LastRotated:
+		return m.Cert1LastRotated()
+	case bronzehistoryawsiamcredentialreport.FieldCert2Active:
+		return m.Cert2Active()
+	case bronzehistoryawsiamcredentialreport.FieldCert2LastRotated:
+		return m.Cert2LastRotated()
+	case bronzehistoryawsiamcredentialreport.FieldReportGeneratedAt:
+		return m.ReportGeneratedAt()
+	case bronzehistoryawsiamcredentialreport.FieldAccountID:
+		return m.AccountID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzehistoryawsiamcredentialreport.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldValidTo:
+		return m.OldValidTo(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldUser:
+		return m.OldUser(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldUserCreationTime:
+		return m.OldUserCreationTime(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldPasswordEnabled:
+		return m.OldPasswordEnabled(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldPasswordLastUsed:
+		return m.OldPasswordLastUsed(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldPasswordLastChanged:
+		return m.OldPasswordLastChanged(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldPasswordNextRotation:
+		return m.OldPasswordNextRotation(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldMfaActive:
+		return m.OldMfaActive(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1Active:
+		return m.OldAccessKey1Active(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastRotated:
+		return m.OldAccessKey1LastRotated(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedDate:
+		return m.OldAccessKey1LastUsedDate(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedRegion:
+		return m.OldAccessKey1LastUsedRegion(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedService:
+		return m.OldAccessKey1LastUsedService(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2Active:
+		return m.OldAccessKey2Active(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastRotated:
+		return m.OldAccessKey2LastRotated(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedDate:
+		return m.OldAccessKey2LastUsedDate(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedRegion:
+		return m.OldAccessKey2LastUsedRegion(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedService:
+		return m.OldAccessKey2LastUsedService(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldCert1Active:
+		return m.OldCert1Active(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldCert1LastRotated:
+		return m.OldCert1LastRotated(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldCert2Active:
+		return m.OldCert2Active(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldCert2LastRotated:
+		return m.OldCert2LastRotated(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldReportGeneratedAt:
+		return m.OldReportGeneratedAt(ctx)
+	case bronzehistoryawsiamcredentialreport.FieldAccountID:
+		return m.OldAccountID(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeHistoryAWSIAMCredentialReport field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryawsiamcredentialreport.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldValidTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidTo(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldUser:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldUserCreationTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserCreationTime(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldPasswordEnabled:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordEnabled(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldPasswordLastUsed:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordLastUsed(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldPasswordLastChanged:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordLastChanged(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldPasswordNextRotation:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordNextRotation(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldMfaActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaActive(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1Active:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey1Active(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastRotated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey1LastRotated(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey1LastUsedDate(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey1LastUsedRegion(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedService:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey1LastUsedService(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2Active:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey2Active(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastRotated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey2LastRotated(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey2LastUsedDate(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey2LastUsedRegion(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedService:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKey2LastUsedService(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldCert1Active:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCert1Active(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldCert1LastRotated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCert1LastRotated(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldCert2Active:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCert2Active(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldCert2LastRotated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCert2LastRotated(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldReportGeneratedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReportGeneratedAt(v)
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccountID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMCredentialReport field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMCredentialReport numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzehistoryawsiamcredentialreport.FieldValidTo) {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldValidTo)
+	}
+	if m.FieldCleared(bronzehistoryawsiamcredentialreport.FieldUserCreationTime) {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldUserCreationTime)
+	}
+	if m.FieldCleared(bronzehistoryawsiamcredentialreport.FieldPasswordEnabled) {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldPasswordEnabled)
+	}
+	if m.FieldCleared(bronzehistoryawsiamcredentialreport.FieldPasswordLastUsed) {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldPasswordLastUsed)
+	}
+	if m.FieldCleared(bronzehistoryawsiamcredentialreport.FieldPasswordLastChanged) {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldPasswordLastChanged)
+	}
+	if m.FieldCleared(bronzehistoryawsiamcredentialreport.FieldPasswordNextRotation) {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldPasswordNextRotation)
+	}
+	if m.FieldCleared(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastRotated) {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldAccessKey1LastRotated)
+	}
+	if m.FieldCleared(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedDate) {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedDate)
+	}
+	if m.FieldCleared(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedRegion) {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedRegion)
+	}
+	if m.FieldCleared(bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedService) {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedService)
+	}
+	if m.FieldCleared(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastRotated) {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldAccessKey2LastRotated)
+	}
+	if m.FieldCleared(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedDate) {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedDate)
+	}
+	if m.FieldCleared(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedRegion) {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedRegion)
+	}
+	if m.FieldCleared(bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedService) {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedService)
+	}
+	if m.FieldCleared(bronzehistoryawsiamcredentialreport.FieldCert1LastRotated) {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldCert1LastRotated)
+	}
+	if m.FieldCleared(bronzehistoryawsiamcredentialreport.FieldCert2LastRotated) {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldCert2LastRotated)
+	}
+	if m.FieldCleared(bronzehistoryawsiamcredentialreport.FieldReportGeneratedAt) {
+		fields = append(fields, bronzehistoryawsiamcredentialreport.FieldReportGeneratedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearField(name string) error {
+	switch name {
+	case bronzehistoryawsiamcredentialreport.FieldValidTo:
+		m.ClearValidTo()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldUserCreationTime:
+		m.ClearUserCreationTime()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldPasswordEnabled:
+		m.ClearPasswordEnabled()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldPasswordLastUsed:
+		m.ClearPasswordLastUsed()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldPasswordLastChanged:
+		m.ClearPasswordLastChanged()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldPasswordNextRotation:
+		m.ClearPasswordNextRotation()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastRotated:
+		m.ClearAccessKey1LastRotated()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedDate:
+		m.ClearAccessKey1LastUsedDate()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedRegion:
+		m.ClearAccessKey1LastUsedRegion()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedService:
+		m.ClearAccessKey1LastUsedService()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastRotated:
+		m.ClearAccessKey2LastRotated()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedDate:
+		m.ClearAccessKey2LastUsedDate()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedRegion:
+		m.ClearAccessKey2LastUsedRegion()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedService:
+		m.ClearAccessKey2LastUsedService()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldCert1LastRotated:
+		m.ClearCert1LastRotated()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldCert2LastRotated:
+		m.ClearCert2LastRotated()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldReportGeneratedAt:
+		m.ClearReportGeneratedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMCredentialReport nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetField(name string) error {
+	switch name {
+	case bronzehistoryawsiamcredentialreport.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldValidTo:
+		m.ResetValidTo()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldUser:
+		m.ResetUser()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldUserCreationTime:
+		m.ResetUserCreationTime()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldPasswordEnabled:
+		m.ResetPasswordEnabled()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldPasswordLastUsed:
+		m.ResetPasswordLastUsed()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldPasswordLastChanged:
+		m.ResetPasswordLastChanged()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldPasswordNextRotation:
+		m.ResetPasswordNextRotation()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldMfaActive:
+		m.ResetMfaActive()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1Active:
+		m.ResetAccessKey1Active()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastRotated:
+		m.ResetAccessKey1LastRotated()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedDate:
+		m.ResetAccessKey1LastUsedDate()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedRegion:
+		m.ResetAccessKey1LastUsedRegion()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey1LastUsedService:
+		m.ResetAccessKey1LastUsedService()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2Active:
+		m.ResetAccessKey2Active()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastRotated:
+		m.ResetAccessKey2LastRotated()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedDate:
+		m.ResetAccessKey2LastUsedDate()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedRegion:
+		m.ResetAccessKey2LastUsedRegion()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccessKey2LastUsedService:
+		m.ResetAccessKey2LastUsedService()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldCert1Active:
+		m.ResetCert1Active()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldCert1LastRotated:
+		m.ResetCert1LastRotated()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldCert2Active:
+		m.ResetCert2Active()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldCert2LastRotated:
+		m.ResetCert2LastRotated()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldReportGeneratedAt:
+		m.ResetReportGeneratedAt()
+		return nil
+	case bronzehistoryawsiamcredentialreport.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMCredentialReport field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMCredentialReport unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeHistoryAWSIAMCredentialReportMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMCredentialReport edge %s", name)
+}
+
+// BronzeHistoryAWSIAMGroupMutation represents an operation that mutates the BronzeHistoryAWSIAMGroup nodes in the graph.
+type BronzeHistoryAWSIAMGroupMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *uint
+	valid_from                   *time.Time
+	valid_to                     *time.Time
+	collected_at                 *time.Time
+	first_collected_at           *time.Time
+	resource_id                  *string
+	arn                          *string
+	group_name                   *string
+	_path                        *string
+	create_date                  *time.Time
+	attached_policies_json       *json.RawMessage
+	appendattached_policies_json json.RawMessage
+	inline_policies_json         *json.RawMessage
+	appendinline_policies_json   json.RawMessage
+	account_id                   *string
+	clearedFields                map[string]struct{}
+	done                         bool
+	oldValue                     func(context.Context) (*BronzeHistoryAWSIAMGroup, error)
+	predicates                   []predicate.BronzeHistoryAWSIAMGroup
+}
+
+var _ ent.Mutation = (*BronzeHistoryAWSIAMGroupMutation)(nil)
+
+// bronzehistoryawsiamgroupOption allows management of the mutation configuration using functional options.
+type bronzehistoryawsiamgroupOption func(*BronzeHistoryAWSIAMGroupMutation)
+
+// newBronzeHistoryAWSIAMGroupMutation creates new mutation for the BronzeHistoryAWSIAMGroup entity.
+func newBronzeHistoryAWSIAMGroupMutation(c config, op Op, opts ...bronzehistoryawsiamgroupOption) *BronzeHistoryAWSIAMGroupMutation {
+	m := &BronzeHistoryAWSIAMGroupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeHistoryAWSIAMGroup,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeHistoryAWSIAMGroupID sets the ID field of the mutation.
+func withBronzeHistoryAWSIAMGroupID(id uint) bronzehistoryawsiamgroupOption {
+	return func(m *BronzeHistoryAWSIAMGroupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeHistoryAWSIAMGroup
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeHistoryAWSIAMGroup, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeHistoryAWSIAMGroup.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeHistoryAWSIAMGroup sets the old BronzeHistoryAWSIAMGroup of the mutation.
+func withBronzeHistoryAWSIAMGroup(node *BronzeHistoryAWSIAMGroup) bronzehistoryawsiamgroupOption {
+	return func(m *BronzeHistoryAWSIAMGroupMutation) {
+		m.oldValue = func(context.Context) (*BronzeHistoryAWSIAMGroup, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeHistoryAWSIAMGroupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeHistoryAWSIAMGroupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("iam: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeHistoryAWSIAMGroup entities.
+func (m *BronzeHistoryAWSIAMGroupMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeHistoryAWSIAMGroupMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeHistoryAWSIAMGroup.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the BronzeHistoryAWSIAMGroup entity.
+// If the BronzeHistoryAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMGroupMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidTo sets the "valid_to" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) SetValidTo(t time.Time) {
+	m.valid_to = &t
+}
+
+// ValidTo returns the value of the "valid_to" field in the mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) ValidTo() (r time.Time, exists bool) {
+	v := m.valid_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidTo returns the old "valid_to" field's value of the BronzeHistoryAWSIAMGroup entity.
+// If the BronzeHistoryAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMGroupMutation) OldValidTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidTo: %w", err)
+	}
+	return oldValue.ValidTo, nil
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) ClearValidTo() {
+	m.valid_to = nil
+	m.clearedFields[bronzehistoryawsiamgroup.FieldValidTo] = struct{}{}
+}
+
+// ValidToCleared returns if the "valid_to" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) ValidToCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamgroup.FieldValidTo]
+	return ok
+}
+
+// ResetValidTo resets all changes to the "valid_to" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) ResetValidTo() {
+	m.valid_to = nil
+	delete(m.clearedFields, bronzehistoryawsiamgroup.FieldValidTo)
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeHistoryAWSIAMGroup entity.
+// If the BronzeHistoryAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMGroupMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeHistoryAWSIAMGroup entity.
+// If the BronzeHistoryAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMGroupMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the BronzeHistoryAWSIAMGroup entity.
+// If the BronzeHistoryAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMGroupMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetArn sets the "arn" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) SetArn(s string) {
+	m.arn = &s
+}
+
+// Arn returns the value of the "arn" field in the mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) Arn() (r string, exists bool) {
+	v := m.arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArn returns the old "arn" field's value of the BronzeHistoryAWSIAMGroup entity.
+// If the BronzeHistoryAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMGroupMutation) OldArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArn: %w", err)
+	}
+	return oldValue.Arn, nil
+}
+
+// ResetArn resets all changes to the "arn" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) ResetArn() {
+	m.arn = nil
+}
+
+// SetGroupName sets the "group_name" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) SetGroupName(s string) {
+	m.group_name = &s
+}
+
+// GroupName returns the value of the "group_name" field in the mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) GroupName() (r string, exists bool) {
+	v := m.group_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupName returns the old "group_name" field's value of the BronzeHistoryAWSIAMGroup entity.
+// If the BronzeHistoryAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMGroupMutation) OldGroupName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupName: %w", err)
+	}
+	return oldValue.GroupName, nil
+}
+
+// ResetGroupName resets all changes to the "group_name" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) ResetGroupName() {
+	m.group_name = nil
+}
+
+// SetPath sets the "path" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the BronzeHistoryAWSIAMGroup entity.
+// If the BronzeHistoryAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMGroupMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ClearPath clears the value of the "path" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) ClearPath() {
+	m._path = nil
+	m.clearedFields[bronzehistoryawsiamgroup.FieldPath] = struct{}{}
+}
+
+// PathCleared returns if the "path" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) PathCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamgroup.FieldPath]
+	return ok
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) ResetPath() {
+	m._path = nil
+	delete(m.clearedFields, bronzehistoryawsiamgroup.FieldPath)
+}
+
+// SetCreateDate sets the "create_date" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) SetCreateDate(t time.Time) {
+	m.create_date = &t
+}
+
+// CreateDate returns the value of the "create_date" field in the mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) CreateDate() (r time.Time, exists bool) {
+	v := m.create_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateDate returns the old "create_date" field's value of the BronzeHistoryAWSIAMGroup entity.
+// If the BronzeHistoryAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMGroupMutation) OldCreateDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateDate: %w", err)
+	}
+	return oldValue.CreateDate, nil
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) ClearCreateDate() {
+	m.create_date = nil
+	m.clearedFields[bronzehistoryawsiamgroup.FieldCreateDate] = struct{}{}
+}
+
+// CreateDateCleared returns if the "create_date" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) CreateDateCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamgroup.FieldCreateDate]
+	return ok
+}
+
+// ResetCreateDate resets all changes to the "create_date" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) ResetCreateDate() {
+	m.create_date = nil
+	delete(m.clearedFields, bronzehistoryawsiamgroup.FieldCreateDate)
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) SetAttachedPoliciesJSON(jm json.RawMessage) {
+	m.attached_policies_json = &jm
+	m.appendattached_policies_json = nil
+}
+
+// AttachedPoliciesJSON returns the value of the "attached_policies_json" field in the mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) AttachedPoliciesJSON() (r json.RawMessage, exists bool) {
+	v := m.attached_policies_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttachedPoliciesJSON returns the old "attached_policies_json" field's value of the BronzeHistoryAWSIAMGroup entity.
+// If the BronzeHistoryAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMGroupMutation) OldAttachedPoliciesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttachedPoliciesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttachedPoliciesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttachedPoliciesJSON: %w", err)
+	}
+	return oldValue.AttachedPoliciesJSON, nil
+}
+
+// AppendAttachedPoliciesJSON adds jm to the "attached_policies_json" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) AppendAttachedPoliciesJSON(jm json.RawMessage) {
+	m.appendattached_policies_json = append(m.appendattached_policies_json, jm...)
+}
+
+// AppendedAttachedPoliciesJSON returns the list of values that were appended to the "attached_policies_json" field in this mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) AppendedAttachedPoliciesJSON() (json.RawMessage, bool) {
+	if len(m.appendattached_policies_json) == 0 {
+		return nil, false
+	}
+	return m.appendattached_policies_json, true
+}
+
+// ClearAttachedPoliciesJSON clears the value of the "attached_policies_json" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) ClearAttachedPoliciesJSON() {
+	m.attached_policies_json = nil
+	m.appendattached_policies_json = nil
+	m.clearedFields[bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON] = struct{}{}
+}
+
+// AttachedPoliciesJSONCleared returns if the "attached_policies_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) AttachedPoliciesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON]
+	return ok
+}
+
+// ResetAttachedPoliciesJSON resets all changes to the "attached_policies_json" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) ResetAttachedPoliciesJSON() {
+	m.attached_policies_json = nil
+	m.appendattached_policies_json = nil
+	delete(m.clearedFields, bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON)
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) SetInlinePoliciesJSON(jm json.RawMessage) {
+	m.inline_policies_json = &jm
+	m.appendinline_policies_json = nil
+}
+
+// InlinePoliciesJSON returns the value of the "inline_policies_json" field in the mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) InlinePoliciesJSON() (r json.RawMessage, exists bool) {
+	v := m.inline_policies_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInlinePoliciesJSON returns the old "inline_policies_json" field's value of the BronzeHistoryAWSIAMGroup entity.
+// If the BronzeHistoryAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMGroupMutation) OldInlinePoliciesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInlinePoliciesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInlinePoliciesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInlinePoliciesJSON: %w", err)
+	}
+	return oldValue.InlinePoliciesJSON, nil
+}
+
+// AppendInlinePoliciesJSON adds jm to the "inline_policies_json" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) AppendInlinePoliciesJSON(jm json.RawMessage) {
+	m.appendinline_policies_json = append(m.appendinline_policies_json, jm...)
+}
+
+// AppendedInlinePoliciesJSON returns the list of values that were appended to the "inline_policies_json" field in this mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) AppendedInlinePoliciesJSON() (json.RawMessage, bool) {
+	if len(m.appendinline_policies_json) == 0 {
+		return nil, false
+	}
+	return m.appendinline_policies_json, true
+}
+
+// ClearInlinePoliciesJSON clears the value of the "inline_policies_json" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) ClearInlinePoliciesJSON() {
+	m.inline_policies_json = nil
+	m.appendinline_policies_json = nil
+	m.clearedFields[bronzehistoryawsiamgroup.FieldInlinePoliciesJSON] = struct{}{}
+}
+
+// InlinePoliciesJSONCleared returns if the "inline_policies_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) InlinePoliciesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamgroup.FieldInlinePoliciesJSON]
+	return ok
+}
+
+// ResetInlinePoliciesJSON resets all changes to the "inline_policies_json" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) ResetInlinePoliciesJSON() {
+	m.inline_policies_json = nil
+	m.appendinline_policies_json = nil
+	delete(m.clearedFields, bronzehistoryawsiamgroup.FieldInlinePoliciesJSON)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) SetAccountID(s string) {
+	m.account_id = &s
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) AccountID() (r string, exists bool) {
+	v := m.account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the BronzeHistoryAWSIAMGroup entity.
+// If the BronzeHistoryAWSIAMGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMGroupMutation) OldAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BronzeHistoryAWSIAMGroupMutation) ResetAccountID() {
+	m.account_id = nil
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMGroupMutation builder.
+func (m *BronzeHistoryAWSIAMGroupMutation) Where(ps ...predicate.BronzeHistoryAWSIAMGroup) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeHistoryAWSIAMGroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeHistoryAWSIAMGroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeHistoryAWSIAMGroup, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeHistoryAWSIAMGroupMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeHistoryAWSIAMGroupMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeHistoryAWSIAMGroup).
+func (m *BronzeHistoryAWSIAMGroupMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeHistoryAWSIAMGroupMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.valid_from != nil {
+		fields = append(fields, bronzehistoryawsiamgroup.FieldValidFrom)
+	}
+	if m.valid_to != nil {
+		fields = append(fields, bronzehistoryawsiamgroup.FieldValidTo)
+	}
+	if m.collected_at != nil {
+		fields = append(fields, bronzehistoryawsiamgroup.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzehistoryawsiamgroup.FieldFirstCollectedAt)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, bronzehistoryawsiamgroup.FieldResourceID)
+	}
+	if m.arn != nil {
+		fields = append(fields, bronzehistoryawsiamgroup.FieldArn)
+	}
+	if m.group_name != nil {
+		fields = append(fields, bronzehistoryawsiamgroup.FieldGroupName)
+	}
+	if m._path != nil {
+		fields = append(fields, bronzehistoryawsiamgroup.FieldPath)
+	}
+	if m.create_date != nil {
+		fields = append(fields, bronzehistoryawsiamgroup.FieldCreateDate)
+	}
+	if m.attached_policies_json != nil {
+		fields = append(fields, bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON)
+	}
+	if m.inline_policies_json != nil {
+		fields = append(fields, bronzehistoryawsiamgroup.FieldInlinePoliciesJSON)
+	}
+	if m.account_id != nil {
+		fields = append(fields, bronzehistoryawsiamgroup.FieldAccountID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeHistoryAWSIAMGroupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryawsiamgroup.FieldValidFrom:
+		return m.ValidFrom()
+	case bronzehistoryawsiamgroup.FieldValidTo:
+		return m.ValidTo()
+	case bronzehistoryawsiamgroup.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzehistoryawsiamgroup.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzehistoryawsiamgroup.FieldResourceID:
+		return m.ResourceID()
+	case bronzehistoryawsiamgroup.FieldArn:
+		return m.Arn()
+	case bronzehistoryawsiamgroup.FieldGroupName:
+		return m.GroupName()
+	case bronzehistoryawsiamgroup.FieldPath:
+		return m.Path()
+	case bronzehistoryawsiamgroup.FieldCreateDate:
+		return m.CreateDate()
+	case bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON:
+		return m.AttachedPoliciesJSON()
+	case bronzehistoryawsiamgroup.FieldInlinePoliciesJSON:
+		return m.InlinePoliciesJSON()
+	case bronzehistoryawsiamgroup.FieldAccountID:
+		return m.AccountID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeHistoryAWSIAMGroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzehistoryawsiamgroup.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case bronzehistoryawsiamgroup.FieldValidTo:
+		return m.OldValidTo(ctx)
+	case bronzehistoryawsiamgroup.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzehistoryawsiamgroup.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzehistoryawsiamgroup.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case bronzehistoryawsiamgroup.FieldArn:
+		return m.OldArn(ctx)
+	case bronzehistoryawsiamgroup.FieldGroupName:
+		return m.OldGroupName(ctx)
+	case bronzehistoryawsiamgroup.FieldPath:
+		return m.OldPath(ctx)
+	case bronzehistoryawsiamgroup.FieldCreateDate:
+		return m.OldCreateDate(ctx)
+	case bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON:
+		return m.OldAttachedPoliciesJSON(ctx)
+	case bronzehistoryawsiamgroup.FieldInlinePoliciesJSON:
+		return m.OldInlinePoliciesJSON(ctx)
+	case bronzehistoryawsiamgroup.FieldAccountID:
+		return m.OldAccountID(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeHistoryAWSIAMGroup field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryAWSIAMGroupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryawsiamgroup.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case bronzehistoryawsiamgroup.FieldValidTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidTo(v)
+		return nil
+	case bronzehistoryawsiamgroup.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzehistoryawsiamgroup.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzehistoryawsiamgroup.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case bronzehistoryawsiamgroup.FieldArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArn(v)
+		return nil
+	case bronzehistoryawsiamgroup.FieldGroupName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupName(v)
+		return nil
+	case bronzehistoryawsiamgroup.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case bronzehistoryawsiamgroup.FieldCreateDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateDate(v)
+		return nil
+	case bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttachedPoliciesJSON(v)
+		return nil
+	case bronzehistoryawsiamgroup.FieldInlinePoliciesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInlinePoliciesJSON(v)
+		return nil
+	case bronzehistoryawsiamgroup.FieldAccountID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMGroup field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeHistoryAWSIAMGroupMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryAWSIAMGroupMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMGroup numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzehistoryawsiamgroup.FieldValidTo) {
+		fields = append(fields, bronzehistoryawsiamgroup.FieldValidTo)
+	}
+	if m.FieldCleared(bronzehistoryawsiamgroup.FieldPath) {
+		fields = append(fields, bronzehistoryawsiamgroup.FieldPath)
+	}
+	if m.FieldCleared(bronzehistoryawsiamgroup.FieldCreateDate) {
+		fields = append(fields, bronzehistoryawsiamgroup.FieldCreateDate)
+	}
+	if m.FieldCleared(bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON) {
+		fields = append(fields, bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON)
+	}
+	if m.FieldCleared(bronzehistoryawsiamgroup.FieldInlinePoliciesJSON) {
+		fields = append(fields, bronzehistoryawsiamgroup.FieldInlinePoliciesJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeHistoryAWSIAMGroupMutation) ClearField(name string) error {
+	switch name {
+	case bronzehistoryawsiamgroup.FieldValidTo:
+		m.ClearValidTo()
+		return nil
+	case bronzehistoryawsiamgroup.FieldPath:
+		m.ClearPath()
+		return nil
+	case bronzehistoryawsiamgroup.FieldCreateDate:
+		m.ClearCreateDate()
+		return nil
+	case bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON:
+		m.ClearAttachedPoliciesJSON()
+		return nil
+	case bronzehistoryawsiamgroup.FieldInlinePoliciesJSON:
+		m.ClearInlinePoliciesJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMGroup nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeHistoryAWSIAMGroupMutation) ResetField(name string) error {
+	switch name {
+	case bronzehistoryawsiamgroup.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case bronzehistoryawsiamgroup.FieldValidTo:
+		m.ResetValidTo()
+		return nil
+	case bronzehistoryawsiamgroup.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzehistoryawsiamgroup.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzehistoryawsiamgroup.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case bronzehistoryawsiamgroup.FieldArn:
+		m.ResetArn()
+		return nil
+	case bronzehistoryawsiamgroup.FieldGroupName:
+		m.ResetGroupName()
+		return nil
+	case bronzehistoryawsiamgroup.FieldPath:
+		m.ResetPath()
+		return nil
+	case bronzehistoryawsiamgroup.FieldCreateDate:
+		m.ResetCreateDate()
+		return nil
+	case bronzehistoryawsiamgroup.FieldAttachedPoliciesJSON:
+		m.ResetAttachedPoliciesJSON()
+		return nil
+	case bronzehistoryawsiamgroup.FieldInlinePoliciesJSON:
+		m.ResetInlinePoliciesJSON()
+		return nil
+	case bronzehistoryawsiamgroup.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMGroup field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMGroupMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeHistoryAWSIAMGroupMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMGroup unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeHistoryAWSIAMGroupMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMGroup edge %s", name)
+}
+
+// BronzeHistoryAWSIAMPolicyMutation represents an operation that mutates the BronzeHistoryAWSIAMPolicy nodes in the graph.
+type BronzeHistoryAWSIAMPolicyMutation struct {
+	config
+	op                                  Op
+	typ                                 string
+	id                                  *uint
+	valid_from                          *time.Time
+	valid_to                            *time.Time
+	collected_at                        *time.Time
+	first_collected_at                  *time.Time
+	resource_id                         *string
+	arn                                 *string
+	policy_name                         *string
+	_path                               *string
+	description                         *string
+	default_version_id                  *string
+	attachment_count                    *int
+	addattachment_count                 *int
+	permissions_boundary_usage_count    *int
+	addpermissions_boundary_usage_count *int
+	is_attachable                       *bool
+	aws_managed                         *bool
+	create_date                         *time.Time
+	update_date                         *time.Time
+	versions_json                       *json.RawMessage
+	appendversions_json                 json.RawMessage
+	account_id                          *string
+	clearedFields                       map[string]struct{}
+	done                                bool
+	oldValue                            func(context.Context) (*BronzeHistoryAWSIAMPolicy, error)
+	predicates                          []predicate.BronzeHistoryAWSIAMPolicy
+}
+
+var _ ent.Mutation = (*BronzeHistoryAWSIAMPolicyMutation)(nil)
+
+// bronzehistoryawsiampolicyOption allows management of the mutation configuration using functional options.
+type bronzehistoryawsiampolicyOption func(*BronzeHistoryAWSIAMPolicyMutation)
+
+// newBronzeHistoryAWSIAMPolicyMutation creates new mutation for the BronzeHistoryAWSIAMPolicy entity.
+func newBronzeHistoryAWSIAMPolicyMutation(c config, op Op, opts ...bronzehistoryawsiampolicyOption) *BronzeHistoryAWSIAMPolicyMutation {
+	m := &BronzeHistoryAWSIAMPolicyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeHistoryAWSIAMPolicy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeHistoryAWSIAMPolicyID sets the ID field of the mutation.
+func withBronzeHistoryAWSIAMPolicyID(id uint) bronzehistoryawsiampolicyOption {
+	return func(m *BronzeHistoryAWSIAMPolicyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeHistoryAWSIAMPolicy
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeHistoryAWSIAMPolicy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeHistoryAWSIAMPolicy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeHistoryAWSIAMPolicy sets the old BronzeHistoryAWSIAMPolicy of the mutation.
+func withBronzeHistoryAWSIAMPolicy(node *BronzeHistoryAWSIAMPolicy) bronzehistoryawsiampolicyOption {
+	return func(m *BronzeHistoryAWSIAMPolicyMutation) {
+		m.oldValue = func(context.Context) (*BronzeHistoryAWSIAMPolicy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeHistoryAWSIAMPolicyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeHistoryAWSIAMPolicyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("iam: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeHistoryAWSIAMPolicy entities.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeHistoryAWSIAMPolicy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the BronzeHistoryAWSIAMPolicy entity.
+// If the BronzeHistoryAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMPolicyMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidTo sets the "valid_to" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetValidTo(t time.Time) {
+	m.valid_to = &t
+}
+
+// ValidTo returns the value of the "valid_to" field in the mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ValidTo() (r time.Time, exists bool) {
+	v := m.valid_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidTo returns the old "valid_to" field's value of the BronzeHistoryAWSIAMPolicy entity.
+// If the BronzeHistoryAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMPolicyMutation) OldValidTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidTo: %w", err)
+	}
+	return oldValue.ValidTo, nil
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ClearValidTo() {
+	m.valid_to = nil
+	m.clearedFields[bronzehistoryawsiampolicy.FieldValidTo] = struct{}{}
+}
+
+// ValidToCleared returns if the "valid_to" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ValidToCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiampolicy.FieldValidTo]
+	return ok
+}
+
+// ResetValidTo resets all changes to the "valid_to" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetValidTo() {
+	m.valid_to = nil
+	delete(m.clearedFields, bronzehistoryawsiampolicy.FieldValidTo)
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeHistoryAWSIAMPolicy entity.
+// If the BronzeHistoryAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMPolicyMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeHistoryAWSIAMPolicy entity.
+// If the BronzeHistoryAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMPolicyMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the BronzeHistoryAWSIAMPolicy entity.
+// If the BronzeHistoryAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMPolicyMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetArn sets the "arn" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetArn(s string) {
+	m.arn = &s
+}
+
+// Arn returns the value of the "arn" field in the mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) Arn() (r string, exists bool) {
+	v := m.arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArn returns the old "arn" field's value of the BronzeHistoryAWSIAMPolicy entity.
+// If the BronzeHistoryAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMPolicyMutation) OldArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArn: %w", err)
+	}
+	return oldValue.Arn, nil
+}
+
+// ResetArn resets all changes to the "arn" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetArn() {
+	m.arn = nil
+}
+
+// SetPolicyName sets the "policy_name" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetPolicyName(s string) {
+	m.policy_name = &s
+}
+
+// PolicyName returns the value of the "policy_name" field in the mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) PolicyName() (r string, exists bool) {
+	v := m.policy_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolicyName returns the old "policy_name" field's value of the BronzeHistoryAWSIAMPolicy entity.
+// If the BronzeHistoryAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMPolicyMutation) OldPolicyName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicyName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicyName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicyName: %w", err)
+	}
+	return oldValue.PolicyName, nil
+}
+
+// ResetPolicyName resets all changes to the "policy_name" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetPolicyName() {
+	m.policy_name = nil
+}
+
+// SetPath sets the "path" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the BronzeHistoryAWSIAMPolicy entity.
+// If the BronzeHistoryAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMPolicyMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ClearPath clears the value of the "path" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ClearPath() {
+	m._path = nil
+	m.clearedFields[bronzehistoryawsiampolicy.FieldPath] = struct{}{}
+}
+
+// PathCleared returns if the "path" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) PathCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiampolicy.FieldPath]
+	return ok
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetPath() {
+	m._path = nil
+	delete(m.clearedFields, bronzehistoryawsiampolicy.FieldPath)
+}
+
+// SetDescription sets the "description" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the BronzeHistoryAWSIAMPolicy entity.
+// If the BronzeHistoryAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMPolicyMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[bronzehistoryawsiampolicy.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiampolicy.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, bronzehistoryawsiampolicy.FieldDescription)
+}
+
+// SetDefaultVersionID sets the "default_version_id" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetDefaultVersionID(s string) {
+	m.default_version_id = &s
+}
+
+// DefaultVersionID returns the value of the "default_version_id" field in the mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) DefaultVersionID() (r string, exists bool) {
+	v := m.default_version_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultVersionID returns the old "default_version_id" field's value of the BronzeHistoryAWSIAMPolicy entity.
+// If the BronzeHistoryAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMPolicyMutation) OldDefaultVersionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultVersionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultVersionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultVersionID: %w", err)
+	}
+	return oldValue.DefaultVersionID, nil
+}
+
+// ClearDefaultVersionID clears the value of the "default_version_id" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ClearDefaultVersionID() {
+	m.default_version_id = nil
+	m.clearedFields[bronzehistoryawsiampolicy.FieldDefaultVersionID] = struct{}{}
+}
+
+// DefaultVersionIDCleared returns if the "default_version_id" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) DefaultVersionIDCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiampolicy.FieldDefaultVersionID]
+	return ok
+}
+
+// ResetDefaultVersionID resets all changes to the "default_version_id" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetDefaultVersionID() {
+	m.default_version_id = nil
+	delete(m.clearedFields, bronzehistoryawsiampolicy.FieldDefaultVersionID)
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetAttachmentCount(i int) {
+	m.attachment_count = &i
+	m.addattachment_count = nil
+}
+
+// AttachmentCount returns the value of the "attachment_count" field in the mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) AttachmentCount() (r int, exists bool) {
+	v := m.attachment_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttachmentCount returns the old "attachment_count" field's value of the BronzeHistoryAWSIAMPolicy entity.
+// If the BronzeHistoryAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMPolicyMutation) OldAttachmentCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttachmentCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttachmentCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttachmentCount: %w", err)
+	}
+	return oldValue.AttachmentCount, nil
+}
+
+// AddAttachmentCount adds i to the "attachment_count" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) AddAttachmentCount(i int) {
+	if m.addattachment_count != nil {
+		*m.addattachment_count += i
+	} else {
+		m.addattachment_count = &i
+	}
+}
+
+// AddedAttachmentCount returns the value that was added to the "attachment_count" field in this mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) AddedAttachmentCount() (r int, exists bool) {
+	v := m.addattachment_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttachmentCount resets all changes to the "attachment_count" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetAttachmentCount() {
+	m.attachment_count = nil
+	m.addattachment_count = nil
+}
+
+// SetPermissionsBoundaryUsageCount sets the "permissions_boundary_usage_count" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetPermissionsBoundaryUsageCount(i int) {
+	m.permissions_boundary_usage_count = &i
+	m.addpermissions_boundary_usage_count = nil
+}
+
+// PermissionsBoundaryUsageCount returns the value of the "permissions_boundary_usage_count" field in the mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) PermissionsBoundaryUsageCount() (r int, exists bool) {
+	v := m.permissions_boundary_usage_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPermissionsBoundaryUsageCount returns the old "permissions_boundary_usage_count" field's value of the BronzeHistoryAWSIAMPolicy entity.
+// If the BronzeHistoryAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMPolicyMutation) OldPermissionsBoundaryUsageCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermissionsBoundaryUsageCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermissionsBoundaryUsageCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermissionsBoundaryUsageCount: %w", err)
+	}
+	return oldValue.PermissionsBoundaryUsageCount, nil
+}
+
+// AddPermissionsBoundaryUsageCount adds i to the "permissions_boundary_usage_count" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) AddPermissionsBoundaryUsageCount(i int) {
+	if m.addpermissions_boundary_usage_count != nil {
+		*m.addpermissions_boundary_usage_count += i
+	} else {
+		m.addpermissions_boundary_usage_count = &i
+	}
+}
+
+// AddedPermissionsBoundaryUsageCount returns the value that was added to the "permissions_boundary_usage_count" field in this mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) AddedPermissionsBoundaryUsageCount() (r int, exists bool) {
+	v := m.addpermissions_boundary_usage_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPermissionsBoundaryUsageCount resets all changes to the "permissions_boundary_usage_count" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetPermissionsBoundaryUsageCount() {
+	m.permissions_boundary_usage_count = nil
+	m.addpermissions_boundary_usage_count = nil
+}
+
+// SetIsAttachable sets the "is_attachable" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetIsAttachable(b bool) {
+	m.is_attachable = &b
+}
+
+// IsAttachable returns the value of the "is_attachable" field in the mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) IsAttachable() (r bool, exists bool) {
+	v := m.is_attachable
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsAttachable returns the old "is_attachable" field's value of the BronzeHistoryAWSIAMPolicy entity.
+// If the BronzeHistoryAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMPolicyMutation) OldIsAttachable(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsAttachable is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsAttachable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsAttachable: %w", err)
+	}
+	return oldValue.IsAttachable, nil
+}
+
+// ResetIsAttachable resets all changes to the "is_attachable" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetIsAttachable() {
+	m.is_attachable = nil
+}
+
+// SetAWSManaged sets the "aws_managed" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetAWSManaged(b bool) {
+	m.aws_managed = &b
+}
+
+// AWSManaged returns the value of the "aws_managed" field in the mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) AWSManaged() (r bool, exists bool) {
+	v := m.aws_managed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAWSManaged returns the old "aws_managed" field's value of the BronzeHistoryAWSIAMPolicy entity.
+// If the BronzeHistoryAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMPolicyMutation) OldAWSManaged(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAWSManaged is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAWSManaged requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAWSManaged: %w", err)
+	}
+	return oldValue.AWSManaged, nil
+}
+
+// ResetAWSManaged resets all changes to the "aws_managed" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetAWSManaged() {
+	m.aws_managed = nil
+}
+
+// SetCreateDate sets the "create_date" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetCreateDate(t time.Time) {
+	m.create_date = &t
+}
+
+// CreateDate returns the value of the "create_date" field in the mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) CreateDate() (r time.Time, exists bool) {
+	v := m.create_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateDate returns the old "create_date" field's value of the BronzeHistoryAWSIAMPolicy entity.
+// If the BronzeHistoryAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMPolicyMutation) OldCreateDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateDate: %w", err)
+	}
+	return oldValue.CreateDate, nil
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ClearCreateDate() {
+	m.create_date = nil
+	m.clearedFields[bronzehistoryawsiampolicy.FieldCreateDate] = struct{}{}
+}
+
+// CreateDateCleared returns if the "create_date" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) CreateDateCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiampolicy.FieldCreateDate]
+	return ok
+}
+
+// ResetCreateDate resets all changes to the "create_date" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetCreateDate() {
+	m.create_date = nil
+	delete(m.clearedFields, bronzehistoryawsiampolicy.FieldCreateDate)
+}
+
+// SetUpdateDate sets the "update_date" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetUpdateDate(t time.Time) {
+	m.update_date = &t
+}
+
+// UpdateDate returns the value of the "update_date" field in the mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) UpdateDate() (r time.Time, exists bool) {
+	v := m.update_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateDate returns the old "update_date" field's value of the BronzeHistoryAWSIAMPolicy entity.
+// If the BronzeHistoryAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMPolicyMutation) OldUpdateDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateDate: %w", err)
+	}
+	return oldValue.UpdateDate, nil
+}
+
+// ClearUpdateDate clears the value of the "update_date" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ClearUpdateDate() {
+	m.update_date = nil
+	m.clearedFields[bronzehistoryawsiampolicy.FieldUpdateDate] = struct{}{}
+}
+
+// UpdateDateCleared returns if the "update_date" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) UpdateDateCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiampolicy.FieldUpdateDate]
+	return ok
+}
+
+// ResetUpdateDate resets all changes to the "update_date" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetUpdateDate() {
+	m.update_date = nil
+	delete(m.clearedFields, bronzehistoryawsiampolicy.FieldUpdateDate)
+}
+
+// SetVersionsJSON sets the "versions_json" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetVersionsJSON(jm json.RawMessage) {
+	m.versions_json = &jm
+	m.appendversions_json = nil
+}
+
+// VersionsJSON returns the value of the "versions_json" field in the mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) VersionsJSON() (r json.RawMessage, exists bool) {
+	v := m.versions_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersionsJSON returns the old "versions_json" field's value of the BronzeHistoryAWSIAMPolicy entity.
+// If the BronzeHistoryAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMPolicyMutation) OldVersionsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersionsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersionsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersionsJSON: %w", err)
+	}
+	return oldValue.VersionsJSON, nil
+}
+
+// AppendVersionsJSON adds jm to the "versions_json" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) AppendVersionsJSON(jm json.RawMessage) {
+	m.appendversions_json = append(m.appendversions_json, jm...)
+}
+
+// AppendedVersionsJSON returns the list of values that were appended to the "versions_json" field in this mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) AppendedVersionsJSON() (json.RawMessage, bool) {
+	if len(m.appendversions_json) == 0 {
+		return nil, false
+	}
+	return m.appendversions_json, true
+}
+
+// ClearVersionsJSON clears the value of the "versions_json" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ClearVersionsJSON() {
+	m.versions_json = nil
+	m.appendversions_json = nil
+	m.clearedFields[bronzehistoryawsiampolicy.FieldVersionsJSON] = struct{}{}
+}
+
+// VersionsJSONCleared returns if the "versions_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) VersionsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiampolicy.FieldVersionsJSON]
+	return ok
+}
+
+// ResetVersionsJSON resets all changes to the "versions_json" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetVersionsJSON() {
+	m.versions_json = nil
+	m.appendversions_json = nil
+	delete(m.clearedFields, bronzehistoryawsiampolicy.FieldVersionsJSON)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetAccountID(s string) {
+	m.account_id = &s
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) AccountID() (r string, exists bool) {
+	v := m.account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the BronzeHistoryAWSIAMPolicy entity.
+// If the BronzeHistoryAWSIAMPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMPolicyMutation) OldAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetAccountID() {
+	m.account_id = nil
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMPolicyMutation builder.
+func (m *BronzeHistoryAWSIAMPolicyMutation) Where(ps ...predicate.BronzeHistoryAWSIAMPolicy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeHistoryAWSIAMPolicyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeHistoryAWSIAMPolicyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeHistoryAWSIAMPolicy, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeHistoryAWSIAMPolicyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeHistoryAWSIAMPolicy).
+func (m *BronzeHistoryAWSIAMPolicyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeHistoryAWSIAMPolicyMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.valid_from != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldValidFrom)
+	}
+	if m.valid_to != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldValidTo)
+	}
+	if m.collected_at != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldFirstCollectedAt)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldResourceID)
+	}
+	if m.arn != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldArn)
+	}
+	if m.policy_name != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldPolicyName)
+	}
+	if m._path != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldPath)
+	}
+	if m.description != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldDescription)
+	}
+	if m.default_version_id != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldDefaultVersionID)
+	}
+	if m.attachment_count != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldAttachmentCount)
+	}
+	if m.permissions_boundary_usage_count != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldPermissionsBoundaryUsageCount)
+	}
+	if m.is_attachable != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldIsAttachable)
+	}
+	if m.aws_managed != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldAWSManaged)
+	}
+	if m.create_date != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldCreateDate)
+	}
+	if m.update_date != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldUpdateDate)
+	}
+	if m.versions_json != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldVersionsJSON)
+	}
+	if m.account_id != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldAccountID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeHistoryAWSIAMPolicyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryawsiampolicy.FieldValidFrom:
+		return m.ValidFrom()
+	case bronzehistoryawsiampolicy.FieldValidTo:
+		return m.ValidTo()
+	case bronzehistoryawsiampolicy.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzehistoryawsiampolicy.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzehistoryawsiampolicy.FieldResourceID:
+		return m.ResourceID()
+	case bronzehistoryawsiampolicy.FieldArn:
+		return m.Arn()
+	case bronzehistoryawsiampolicy.FieldPolicyName:
+		return m.PolicyName()
+	case bronzehistoryawsiampolicy.FieldPath:
+		return m.Path()
+	case bronzehistoryawsiampolicy.FieldDescription:
+		return m.Description()
+	case bronzehistoryawsiampolicy.FieldDefaultVersionID:
+		return m.DefaultVersionID()
+	case bronzehistoryawsiampolicy.FieldAttachmentCount:
+		return m.AttachmentCount()
+	case bronzehistoryawsiampolicy.FieldPermissionsBoundaryUsageCount:
+		return m.PermissionsBoundaryUsageCount()
+	case bronzehistoryawsiampolicy.FieldIsAttachable:
+		return m.IsAttachable()
+	case bronzehistoryawsiampolicy.FieldAWSManaged:
+		return m.AWSManaged()
+	case bronzehistoryawsiampolicy.FieldCreateDate:
+		return m.CreateDate()
+	case bronzehistoryawsiampolicy.FieldUpdateDate:
+		return m.UpdateDate()
+	case bronzehistoryawsiampolicy.FieldVersionsJSON:
+		return m.VersionsJSON()
+	case bronzehistoryawsiampolicy.FieldAccountID:
+		return m.AccountID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeHistoryAWSIAMPolicyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzehistoryawsiampolicy.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case bronzehistoryawsiampolicy.FieldValidTo:
+		return m.OldValidTo(ctx)
+	case bronzehistoryawsiampolicy.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzehistoryawsiampolicy.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzehistoryawsiampolicy.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case bronzehistoryawsiampolicy.FieldArn:
+		return m.OldArn(ctx)
+	case bronzehistoryawsiampolicy.FieldPolicyName:
+		return m.OldPolicyName(ctx)
+	case bronzehistoryawsiampolicy.FieldPath:
+		return m.OldPath(ctx)
+	case bronzehistoryawsiampolicy.FieldDescription:
+		return m.OldDescription(ctx)
+	case bronzehistoryawsiampolicy.FieldDefaultVersionID:
+		return m.OldDefaultVersionID(ctx)
+	case bronzehistoryawsiampolicy.FieldAttachmentCount:
+		return m.OldAttachmentCount(ctx)
+	case bronzehistoryawsiampolicy.FieldPermissionsBoundaryUsageCount:
+		return m.OldPermissionsBoundaryUsageCount(ctx)
+	case bronzehistoryawsiampolicy.FieldIsAttachable:
+		return m.OldIsAttachable(ctx)
+	case bronzehistoryawsiampolicy.FieldAWSManaged:
+		return m.OldAWSManaged(ctx)
+	case bronzehistoryawsiampolicy.FieldCreateDate:
+		return m.OldCreateDate(ctx)
+	case bronzehistoryawsiampolicy.FieldUpdateDate:
+		return m.OldUpdateDate(ctx)
+	case bronzehistoryawsiampolicy.FieldVersionsJSON:
+		return m.OldVersionsJSON(ctx)
+	case bronzehistoryawsiampolicy.FieldAccountID:
+		return m.OldAccountID(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeHistoryAWSIAMPolicy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryAWSIAMPolicyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryawsiampolicy.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case bronzehistoryawsiampolicy.FieldValidTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidTo(v)
+		return nil
+	case bronzehistoryawsiampolicy.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzehistoryawsiampolicy.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzehistoryawsiampolicy.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case bronzehistoryawsiampolicy.FieldArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArn(v)
+		return nil
+	case bronzehistoryawsiampolicy.FieldPolicyName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicyName(v)
+		return nil
+	case bronzehistoryawsiampolicy.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case bronzehistoryawsiampolicy.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case bronzehistoryawsiampolicy.FieldDefaultVersionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultVersionID(v)
+		return nil
+	case bronzehistoryawsiampolicy.FieldAttachmentCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttachmentCount(v)
+		return nil
+	case bronzehistoryawsiampolicy.FieldPermissionsBoundaryUsageCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermissionsBoundaryUsageCount(v)
+		return nil
+	case bronzehistoryawsiampolicy.FieldIsAttachable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsAttachable(v)
+		return nil
+	case bronzehistoryawsiampolicy.FieldAWSManaged:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAWSManaged(v)
+		return nil
+	case bronzehistoryawsiampolicy.FieldCreateDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateDate(v)
+		return nil
+	case bronzehistoryawsiampolicy.FieldUpdateDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateDate(v)
+		return nil
+	case bronzehistoryawsiampolicy.FieldVersionsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersionsJSON(v)
+		return nil
+	case bronzehistoryawsiampolicy.FieldAccountID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMPolicy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) AddedFields() []string {
+	var fields []string
+	if m.addattachment_count != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldAttachmentCount)
+	}
+	if m.addpermissions_boundary_usage_count != nil {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldPermissionsBoundaryUsageCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeHistoryAWSIAMPolicyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryawsiampolicy.FieldAttachmentCount:
+		return m.AddedAttachmentCount()
+	case bronzehistoryawsiampolicy.FieldPermissionsBoundaryUsageCount:
+		return m.AddedPermissionsBoundaryUsageCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryAWSIAMPolicyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryawsiampolicy.FieldAttachmentCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttachmentCount(v)
+		return nil
+	case bronzehistoryawsiampolicy.FieldPermissionsBoundaryUsageCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPermissionsBoundaryUsageCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMPolicy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzehistoryawsiampolicy.FieldValidTo) {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldValidTo)
+	}
+	if m.FieldCleared(bronzehistoryawsiampolicy.FieldPath) {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldPath)
+	}
+	if m.FieldCleared(bronzehistoryawsiampolicy.FieldDescription) {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldDescription)
+	}
+	if m.FieldCleared(bronzehistoryawsiampolicy.FieldDefaultVersionID) {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldDefaultVersionID)
+	}
+	if m.FieldCleared(bronzehistoryawsiampolicy.FieldCreateDate) {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldCreateDate)
+	}
+	if m.FieldCleared(bronzehistoryawsiampolicy.FieldUpdateDate) {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldUpdateDate)
+	}
+	if m.FieldCleared(bronzehistoryawsiampolicy.FieldVersionsJSON) {
+		fields = append(fields, bronzehistoryawsiampolicy.FieldVersionsJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ClearField(name string) error {
+	switch name {
+	case bronzehistoryawsiampolicy.FieldValidTo:
+		m.ClearValidTo()
+		return nil
+	case bronzehistoryawsiampolicy.FieldPath:
+		m.ClearPath()
+		return nil
+	case bronzehistoryawsiampolicy.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case bronzehistoryawsiampolicy.FieldDefaultVersionID:
+		m.ClearDefaultVersionID()
+		return nil
+	case bronzehistoryawsiampolicy.FieldCreateDate:
+		m.ClearCreateDate()
+		return nil
+	case bronzehistoryawsiampolicy.FieldUpdateDate:
+		m.ClearUpdateDate()
+		return nil
+	case bronzehistoryawsiampolicy.FieldVersionsJSON:
+		m.ClearVersionsJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMPolicy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetField(name string) error {
+	switch name {
+	case bronzehistoryawsiampolicy.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case bronzehistoryawsiampolicy.FieldValidTo:
+		m.ResetValidTo()
+		return nil
+	case bronzehistoryawsiampolicy.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzehistoryawsiampolicy.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzehistoryawsiampolicy.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case bronzehistoryawsiampolicy.FieldArn:
+		m.ResetArn()
+		return nil
+	case bronzehistoryawsiampolicy.FieldPolicyName:
+		m.ResetPolicyName()
+		return nil
+	case bronzehistoryawsiampolicy.FieldPath:
+		m.ResetPath()
+		return nil
+	case bronzehistoryawsiampolicy.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case bronzehistoryawsiampolicy.FieldDefaultVersionID:
+		m.ResetDefaultVersionID()
+		return nil
+	case bronzehistoryawsiampolicy.FieldAttachmentCount:
+		m.ResetAttachmentCount()
+		return nil
+	case bronzehistoryawsiampolicy.FieldPermissionsBoundaryUsageCount:
+		m.ResetPermissionsBoundaryUsageCount()
+		return nil
+	case bronzehistoryawsiampolicy.FieldIsAttachable:
+		m.ResetIsAttachable()
+		return nil
+	case bronzehistoryawsiampolicy.FieldAWSManaged:
+		m.ResetAWSManaged()
+		return nil
+	case bronzehistoryawsiampolicy.FieldCreateDate:
+		m.ResetCreateDate()
+		return nil
+	case bronzehistoryawsiampolicy.FieldUpdateDate:
+		m.ResetUpdateDate()
+		return nil
+	case bronzehistoryawsiampolicy.FieldVersionsJSON:
+		m.ResetVersionsJSON()
+		return nil
+	case bronzehistoryawsiampolicy.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMPolicy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMPolicyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMPolicy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeHistoryAWSIAMPolicyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMPolicy edge %s", name)
+}
+
+// BronzeHistoryAWSIAMRoleMutation represents an operation that mutates the BronzeHistoryAWSIAMRole nodes in the graph.
+type BronzeHistoryAWSIAMRoleMutation struct {
+	config
+	op                            Op
+	typ                           string
+	id                            *uint
+	valid_from                    *time.Time
+	valid_to                      *time.Time
+	collected_at                  *time.Time
+	first_collected_at            *time.Time
+	resource_id                   *string
+	arn                           *string
+	role_name                     *string
+	_path                         *string
+	create_date                   *time.Time
+	role_last_used_date           *time.Time
+	role_last_used_region         *string
+	permissions_boundary_arn      *string
+	assume_role_policy_json       *json.RawMessage
+	appendassume_role_policy_json json.RawMessage
+	attached_policies_json        *json.RawMessage
+	appendattached_policies_json  json.RawMessage
+	inline_policies_json          *json.RawMessage
+	appendinline_policies_json    json.RawMessage
+	instance_profiles_json        *json.RawMessage
+	appendinstance_profiles_json  json.RawMessage
+	tags_json                     *json.RawMessage
+	appendtags_json               json.RawMessage
+	account_id                    *string
+	clearedFields                 map[string]struct{}
+	done                          bool
+	oldValue                      func(context.Context) (*BronzeHistoryAWSIAMRole, error)
+	predicates                    []predicate.BronzeHistoryAWSIAMRole
+}
+
+var _ ent.Mutation = (*BronzeHistoryAWSIAMRoleMutation)(nil)
+
+// bronzehistoryawsiamroleOption allows management of the mutation configuration using functional options.
+type bronzehistoryawsiamroleOption func(*BronzeHistoryAWSIAMRoleMutation)
+
+// newBronzeHistoryAWSIAMRoleMutation creates new mutation for the BronzeHistoryAWSIAMRole entity.
+func newBronzeHistoryAWSIAMRoleMutation(c config, op Op, opts ...bronzehistoryawsiamroleOption) *BronzeHistoryAWSIAMRoleMutation {
+	m := &BronzeHistoryAWSIAMRoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeHistoryAWSIAMRole,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeHistoryAWSIAMRoleID sets the ID field of the mutation.
+func withBronzeHistoryAWSIAMRoleID(id uint) bronzehistoryawsiamroleOption {
+	return func(m *BronzeHistoryAWSIAMRoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeHistoryAWSIAMRole
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeHistoryAWSIAMRole, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeHistoryAWSIAMRole.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeHistoryAWSIAMRole sets the old BronzeHistoryAWSIAMRole of the mutation.
+func withBronzeHistoryAWSIAMRole(node *BronzeHistoryAWSIAMRole) bronzehistoryawsiamroleOption {
+	return func(m *BronzeHistoryAWSIAMRoleMutation) {
+		m.oldValue = func(context.Context) (*BronzeHistoryAWSIAMRole, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeHistoryAWSIAMRoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeHistoryAWSIAMRoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("iam: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeHistoryAWSIAMRole entities.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeHistoryAWSIAMRoleMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeHistoryAWSIAMRole.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the BronzeHistoryAWSIAMRole entity.
+// If the BronzeHistoryAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMRoleMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidTo sets the "valid_to" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetValidTo(t time.Time) {
+	m.valid_to = &t
+}
+
+// ValidTo returns the value of the "valid_to" field in the mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) ValidTo() (r time.Time, exists bool) {
+	v := m.valid_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidTo returns the old "valid_to" field's value of the BronzeHistoryAWSIAMRole entity.
+// If the BronzeHistoryAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMRoleMutation) OldValidTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidTo: %w", err)
+	}
+	return oldValue.ValidTo, nil
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ClearValidTo() {
+	m.valid_to = nil
+	m.clearedFields[bronzehistoryawsiamrole.FieldValidTo] = struct{}{}
+}
+
+// ValidToCleared returns if the "valid_to" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) ValidToCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamrole.FieldValidTo]
+	return ok
+}
+
+// ResetValidTo resets all changes to the "valid_to" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetValidTo() {
+	m.valid_to = nil
+	delete(m.clearedFields, bronzehistoryawsiamrole.FieldValidTo)
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeHistoryAWSIAMRole entity.
+// If the BronzeHistoryAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMRoleMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeHistoryAWSIAMRole entity.
+// If the BronzeHistoryAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMRoleMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the BronzeHistoryAWSIAMRole entity.
+// If the BronzeHistoryAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMRoleMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetArn sets the "arn" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetArn(s string) {
+	m.arn = &s
+}
+
+// Arn returns the value of the "arn" field in the mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) Arn() (r string, exists bool) {
+	v := m.arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArn returns the old "arn" field's value of the BronzeHistoryAWSIAMRole entity.
+// If the BronzeHistoryAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMRoleMutation) OldArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArn: %w", err)
+	}
+	return oldValue.Arn, nil
+}
+
+// ResetArn resets all changes to the "arn" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetArn() {
+	m.arn = nil
+}
+
+// SetRoleName sets the "role_name" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetRoleName(s string) {
+	m.role_name = &s
+}
+
+// RoleName returns the value of the "role_name" field in the mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) RoleName() (r string, exists bool) {
+	v := m.role_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleName returns the old "role_name" field's value of the BronzeHistoryAWSIAMRole entity.
+// If the BronzeHistoryAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMRoleMutation) OldRoleName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleName: %w", err)
+	}
+	return oldValue.RoleName, nil
+}
+
+// ResetRoleName resets all changes to the "role_name" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetRoleName() {
+	m.role_name = nil
+}
+
+// SetPath sets the "path" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the BronzeHistoryAWSIAMRole entity.
+// If the BronzeHistoryAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMRoleMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ClearPath clears the value of the "path" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ClearPath() {
+	m._path = nil
+	m.clearedFields[bronzehistoryawsiamrole.FieldPath] = struct{}{}
+}
+
+// PathCleared returns if the "path" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) PathCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamrole.FieldPath]
+	return ok
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetPath() {
+	m._path = nil
+	delete(m.clearedFields, bronzehistoryawsiamrole.FieldPath)
+}
+
+// SetCreateDate sets the "create_date" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetCreateDate(t time.Time) {
+	m.create_date = &t
+}
+
+// CreateDate returns the value of the "create_date" field in the mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) CreateDate() (r time.Time, exists bool) {
+	v := m.create_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateDate returns the old "create_date" field's value of the BronzeHistoryAWSIAMRole entity.
+// If the BronzeHistoryAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMRoleMutation) OldCreateDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateDate: %w", err)
+	}
+	return oldValue.CreateDate, nil
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ClearCreateDate() {
+	m.create_date = nil
+	m.clearedFields[bronzehistoryawsiamrole.FieldCreateDate] = struct{}{}
+}
+
+// CreateDateCleared returns if the "create_date" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) CreateDateCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamrole.FieldCreateDate]
+	return ok
+}
+
+// ResetCreateDate resets all changes to the "create_date" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetCreateDate() {
+	m.create_date = nil
+	delete(m.clearedFields, bronzehistoryawsiamrole.FieldCreateDate)
+}
+
+// SetRoleLastUsedDate sets the "role_last_used_date" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetRoleLastUsedDate(t time.Time) {
+	m.role_last_used_date = &t
+}
+
+// RoleLastUsedDate returns the value of the "role_last_used_date" field in the mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) RoleLastUsedDate() (r time.Time, exists bool) {
+	v := m.role_last_used_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleLastUsedDate returns the old "role_last_used_date" field's value of the BronzeHistoryAWSIAMRole entity.
+// If the BronzeHistoryAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMRoleMutation) OldRoleLastUsedDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleLastUsedDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleLastUsedDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleLastUsedDate: %w", err)
+	}
+	return oldValue.RoleLastUsedDate, nil
+}
+
+// ClearRoleLastUsedDate clears the value of the "role_last_used_date" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ClearRoleLastUsedDate() {
+	m.role_last_used_date = nil
+	m.clearedFields[bronzehistoryawsiamrole.FieldRoleLastUsedDate] = struct{}{}
+}
+
+// RoleLastUsedDateCleared returns if the "role_last_used_date" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) RoleLastUsedDateCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamrole.FieldRoleLastUsedDate]
+	return ok
+}
+
+// ResetRoleLastUsedDate resets all changes to the "role_last_used_date" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetRoleLastUsedDate() {
+	m.role_last_used_date = nil
+	delete(m.clearedFields, bronzehistoryawsiamrole.FieldRoleLastUsedDate)
+}
+
+// SetRoleLastUsedRegion sets the "role_last_used_region" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetRoleLastUsedRegion(s string) {
+	m.role_last_used_region = &s
+}
+
+// RoleLastUsedRegion returns the value of the "role_last_used_region" field in the mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) RoleLastUsedRegion() (r string, exists bool) {
+	v := m.role_last_used_region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleLastUsedRegion returns the old "role_last_used_region" field's value of the BronzeHistoryAWSIAMRole entity.
+// If the BronzeHistoryAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMRoleMutation) OldRoleLastUsedRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleLastUsedRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleLastUsedRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleLastUsedRegion: %w", err)
+	}
+	return oldValue.RoleLastUsedRegion, nil
+}
+
+// ClearRoleLastUsedRegion clears the value of the "role_last_used_region" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ClearRoleLastUsedRegion() {
+	m.role_last_used_region = nil
+	m.clearedFields[bronzehistoryawsiamrole.FieldRoleLastUsedRegion] = struct{}{}
+}
+
+// RoleLastUsedRegionCleared returns if the "role_last_used_region" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) RoleLastUsedRegionCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamrole.FieldRoleLastUsedRegion]
+	return ok
+}
+
+// ResetRoleLastUsedRegion resets all changes to the "role_last_used_region" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetRoleLastUsedRegion() {
+	m.role_last_used_region = nil
+	delete(m.clearedFields, bronzehistoryawsiamrole.FieldRoleLastUsedRegion)
+}
+
+// SetPermissionsBoundaryArn sets the "permissions_boundary_arn" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetPermissionsBoundaryArn(s string) {
+	m.permissions_boundary_arn = &s
+}
+
+// PermissionsBoundaryArn returns the value of the "permissions_boundary_arn" field in the mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) PermissionsBoundaryArn() (r string, exists bool) {
+	v := m.permissions_boundary_arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPermissionsBoundaryArn returns the old "permissions_boundary_arn" field's value of the BronzeHistoryAWSIAMRole entity.
+// If the BronzeHistoryAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMRoleMutation) OldPermissionsBoundaryArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermissionsBoundaryArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermissionsBoundaryArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermissionsBoundaryArn: %w", err)
+	}
+	return oldValue.PermissionsBoundaryArn, nil
+}
+
+// ClearPermissionsBoundaryArn clears the value of the "permissions_boundary_arn" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ClearPermissionsBoundaryArn() {
+	m.permissions_boundary_arn = nil
+	m.clearedFields[bronzehistoryawsiamrole.FieldPermissionsBoundaryArn] = struct{}{}
+}
+
+// PermissionsBoundaryArnCleared returns if the "permissions_boundary_arn" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) PermissionsBoundaryArnCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamrole.FieldPermissionsBoundaryArn]
+	return ok
+}
+
+// ResetPermissionsBoundaryArn resets all changes to the "permissions_boundary_arn" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetPermissionsBoundaryArn() {
+	m.permissions_boundary_arn = nil
+	delete(m.clearedFields, bronzehistoryawsiamrole.FieldPermissionsBoundaryArn)
+}
+
+// SetAssumeRolePolicyJSON sets the "assume_role_policy_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetAssumeRolePolicyJSON(jm json.RawMessage) {
+	m.assume_role_policy_json = &jm
+	m.appendassume_role_policy_json = nil
+}
+
+// AssumeRolePolicyJSON returns the value of the "assume_role_policy_json" field in the mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) AssumeRolePolicyJSON() (r json.RawMessage, exists bool) {
+	v := m.assume_role_policy_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssumeRolePolicyJSON returns the old "assume_role_policy_json" field's value of the BronzeHistoryAWSIAMRole entity.
+// If the BronzeHistoryAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMRoleMutation) OldAssumeRolePolicyJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssumeRolePolicyJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssumeRolePolicyJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssumeRolePolicyJSON: %w", err)
+	}
+	return oldValue.AssumeRolePolicyJSON, nil
+}
+
+// AppendAssumeRolePolicyJSON adds jm to the "assume_role_policy_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) AppendAssumeRolePolicyJSON(jm json.RawMessage) {
+	m.appendassume_role_policy_json = append(m.appendassume_role_policy_json, jm...)
+}
+
+// AppendedAssumeRolePolicyJSON returns the list of values that were appended to the "assume_role_policy_json" field in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) AppendedAssumeRolePolicyJSON() (json.RawMessage, bool) {
+	if len(m.appendassume_role_policy_json) == 0 {
+		return nil, false
+	}
+	return m.appendassume_role_policy_json, true
+}
+
+// ClearAssumeRolePolicyJSON clears the value of the "assume_role_policy_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ClearAssumeRolePolicyJSON() {
+	m.assume_role_policy_json = nil
+	m.appendassume_role_policy_json = nil
+	m.clearedFields[bronzehistoryawsiamrole.FieldAssumeRolePolicyJSON] = struct{}{}
+}
+
+// AssumeRolePolicyJSONCleared returns if the "assume_role_policy_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) AssumeRolePolicyJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamrole.FieldAssumeRolePolicyJSON]
+	return ok
+}
+
+// ResetAssumeRolePolicyJSON resets all changes to the "assume_role_policy_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetAssumeRolePolicyJSON() {
+	m.assume_role_policy_json = nil
+	m.appendassume_role_policy_json = nil
+	delete(m.clearedFields, bronzehistoryawsiamrole.FieldAssumeRolePolicyJSON)
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetAttachedPoliciesJSON(jm json.RawMessage) {
+	m.attached_policies_json = &jm
+	m.appendattached_policies_json = nil
+}
+
+// AttachedPoliciesJSON returns the value of the "attached_policies_json" field in the mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) AttachedPoliciesJSON() (r json.RawMessage, exists bool) {
+	v := m.attached_policies_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttachedPoliciesJSON returns the old "attached_policies_json" field's value of the BronzeHistoryAWSIAMRole entity.
+// If the BronzeHistoryAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMRoleMutation) OldAttachedPoliciesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttachedPoliciesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttachedPoliciesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttachedPoliciesJSON: %w", err)
+	}
+	return oldValue.AttachedPoliciesJSON, nil
+}
+
+// AppendAttachedPoliciesJSON adds jm to the "attached_policies_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) AppendAttachedPoliciesJSON(jm json.RawMessage) {
+	m.appendattached_policies_json = append(m.appendattached_policies_json, jm...)
+}
+
+// AppendedAttachedPoliciesJSON returns the list of values that were appended to the "attached_policies_json" field in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) AppendedAttachedPoliciesJSON() (json.RawMessage, bool) {
+	if len(m.appendattached_policies_json) == 0 {
+		return nil, false
+	}
+	return m.appendattached_policies_json, true
+}
+
+// ClearAttachedPoliciesJSON clears the value of the "attached_policies_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ClearAttachedPoliciesJSON() {
+	m.attached_policies_json = nil
+	m.appendattached_policies_json = nil
+	m.clearedFields[bronzehistoryawsiamrole.FieldAttachedPoliciesJSON] = struct{}{}
+}
+
+// AttachedPoliciesJSONCleared returns if the "attached_policies_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) AttachedPoliciesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamrole.FieldAttachedPoliciesJSON]
+	return ok
+}
+
+// ResetAttachedPoliciesJSON resets all changes to the "attached_policies_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetAttachedPoliciesJSON() {
+	m.attached_policies_json = nil
+	m.appendattached_policies_json = nil
+	delete(m.clearedFields, bronzehistoryawsiamrole.FieldAttachedPoliciesJSON)
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetInlinePoliciesJSON(jm json.RawMessage) {
+	m.inline_policies_json = &jm
+	m.appendinline_policies_json = nil
+}
+
+// InlinePoliciesJSON returns the value of the "inline_policies_json" field in the mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) InlinePoliciesJSON() (r json.RawMessage, exists bool) {
+	v := m.inline_policies_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInlinePoliciesJSON returns the old "inline_policies_json" field's value of the BronzeHistoryAWSIAMRole entity.
+// If the BronzeHistoryAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMRoleMutation) OldInlinePoliciesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInlinePoliciesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInlinePoliciesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInlinePoliciesJSON: %w", err)
+	}
+	return oldValue.InlinePoliciesJSON, nil
+}
+
+// AppendInlinePoliciesJSON adds jm to the "inline_policies_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) AppendInlinePoliciesJSON(jm json.RawMessage) {
+	m.appendinline_policies_json = append(m.appendinline_policies_json, jm...)
+}
+
+// AppendedInlinePoliciesJSON returns the list of values that were appended to the "inline_policies_json" field in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) AppendedInlinePoliciesJSON() (json.RawMessage, bool) {
+	if len(m.appendinline_policies_json) == 0 {
+		return nil, false
+	}
+	return m.appendinline_policies_json, true
+}
+
+// ClearInlinePoliciesJSON clears the value of the "inline_policies_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ClearInlinePoliciesJSON() {
+	m.inline_policies_json = nil
+	m.appendinline_policies_json = nil
+	m.clearedFields[bronzehistoryawsiamrole.FieldInlinePoliciesJSON] = struct{}{}
+}
+
+// InlinePoliciesJSONCleared returns if the "inline_policies_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) InlinePoliciesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamrole.FieldInlinePoliciesJSON]
+	return ok
+}
+
+// ResetInlinePoliciesJSON resets all changes to the "inline_policies_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetInlinePoliciesJSON() {
+	m.inline_policies_json = nil
+	m.appendinline_policies_json = nil
+	delete(m.clearedFields, bronzehistoryawsiamrole.FieldInlinePoliciesJSON)
+}
+
+// SetInstanceProfilesJSON sets the "instance_profiles_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetInstanceProfilesJSON(jm json.RawMessage) {
+	m.instance_profiles_json = &jm
+	m.appendinstance_profiles_json = nil
+}
+
+// InstanceProfilesJSON returns the value of the "instance_profiles_json" field in the mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) InstanceProfilesJSON() (r json.RawMessage, exists bool) {
+	v := m.instance_profiles_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstanceProfilesJSON returns the old "instance_profiles_json" field's value of the BronzeHistoryAWSIAMRole entity.
+// If the BronzeHistoryAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMRoleMutation) OldInstanceProfilesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInstanceProfilesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInstanceProfilesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstanceProfilesJSON: %w", err)
+	}
+	return oldValue.InstanceProfilesJSON, nil
+}
+
+// AppendInstanceProfilesJSON adds jm to the "instance_profiles_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) AppendInstanceProfilesJSON(jm json.RawMessage) {
+	m.appendinstance_profiles_json = append(m.appendinstance_profiles_json, jm...)
+}
+
+// AppendedInstanceProfilesJSON returns the list of values that were appended to the "instance_profiles_json" field in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) AppendedInstanceProfilesJSON() (json.RawMessage, bool) {
+	if len(m.appendinstance_profiles_json) == 0 {
+		return nil, false
+	}
+	return m.appendinstance_profiles_json, true
+}
+
+// ClearInstanceProfilesJSON clears the value of the "instance_profiles_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ClearInstanceProfilesJSON() {
+	m.instance_profiles_json = nil
+	m.appendinstance_profiles_json = nil
+	m.clearedFields[bronzehistoryawsiamrole.FieldInstanceProfilesJSON] = struct{}{}
+}
+
+// InstanceProfilesJSONCleared returns if the "instance_profiles_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) InstanceProfilesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamrole.FieldInstanceProfilesJSON]
+	return ok
+}
+
+// ResetInstanceProfilesJSON resets all changes to the "instance_profiles_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetInstanceProfilesJSON() {
+	m.instance_profiles_json = nil
+	m.appendinstance_profiles_json = nil
+	delete(m.clearedFields, bronzehistoryawsiamrole.FieldInstanceProfilesJSON)
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetTagsJSON(jm json.RawMessage) {
+	m.tags_json = &jm
+	m.appendtags_json = nil
+}
+
+// TagsJSON returns the value of the "tags_json" field in the mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) TagsJSON() (r json.RawMessage, exists bool) {
+	v := m.tags_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagsJSON returns the old "tags_json" field's value of the BronzeHistoryAWSIAMRole entity.
+// If the BronzeHistoryAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMRoleMutation) OldTagsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagsJSON: %w", err)
+	}
+	return oldValue.TagsJSON, nil
+}
+
+// AppendTagsJSON adds jm to the "tags_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) AppendTagsJSON(jm json.RawMessage) {
+	m.appendtags_json = append(m.appendtags_json, jm...)
+}
+
+// AppendedTagsJSON returns the list of values that were appended to the "tags_json" field in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) AppendedTagsJSON() (json.RawMessage, bool) {
+	if len(m.appendtags_json) == 0 {
+		return nil, false
+	}
+	return m.appendtags_json, true
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ClearTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	m.clearedFields[bronzehistoryawsiamrole.FieldTagsJSON] = struct{}{}
+}
+
+// TagsJSONCleared returns if the "tags_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) TagsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamrole.FieldTagsJSON]
+	return ok
+}
+
+// ResetTagsJSON resets all changes to the "tags_json" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	delete(m.clearedFields, bronzehistoryawsiamrole.FieldTagsJSON)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetAccountID(s string) {
+	m.account_id = &s
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) AccountID() (r string, exists bool) {
+	v := m.account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the BronzeHistoryAWSIAMRole entity.
+// If the BronzeHistoryAWSIAMRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMRoleMutation) OldAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetAccountID() {
+	m.account_id = nil
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMRoleMutation builder.
+func (m *BronzeHistoryAWSIAMRoleMutation) Where(ps ...predicate.BronzeHistoryAWSIAMRole) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeHistoryAWSIAMRoleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeHistoryAWSIAMRoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeHistoryAWSIAMRole, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeHistoryAWSIAMRoleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeHistoryAWSIAMRole).
+func (m *BronzeHistoryAWSIAMRoleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeHistoryAWSIAMRoleMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.valid_from != nil {
+		fields = append(fields, bronzehistoryawsiamrole.FieldValidFrom)
+	}
+	if m.valid_to != nil {
+		fields = append(fields, bronzehistoryawsiamrole.FieldValidTo)
+	}
+	if m.collected_at != nil {
+		fields = append(fields, bronzehistoryawsiamrole.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzehistoryawsiamrole.FieldFirstCollectedAt)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, bronzehistoryawsiamrole.FieldResourceID)
+	}
+	if m.arn != nil {
+		fields = append(fields, bronzehistoryawsiamrole.FieldArn)
+	}
+	if m.role_name != nil {
+		fields = append(fields, bronzehistoryawsiamrole.FieldRoleName)
+	}
+	if m._path != nil {
+		fields = append(fields, bronzehistoryawsiamrole.FieldPath)
+	}
+	if m.create_date != nil {
+		fields = append(fields, bronzehistoryawsiamrole.FieldCreateDate)
+	}
+	if m.role_last_used_date != nil {
+		fields = append(fields, bronzehistoryawsiamrole.FieldRoleLastUsedDate)
+	}
+	if m.role_last_used_region != nil {
+		fields = append(fields, bronzehistoryawsiamrole.FieldRoleLastUsedRegion)
+	}
+	if m.permissions_boundary_arn != nil {
+		fields = append(fields, bronzehistoryawsiamrole.FieldPermissionsBoundaryArn)
+	}
+	if m.assume_role_policy_json != nil {
+		fields = append(fields, bronzehistoryawsiamrole.FieldAssumeRolePolicyJSON)
+	}
+	if m.attached_policies_json != nil {
+		fields = append(fields, bronzehistoryawsiamrole.FieldAttachedPoliciesJSON)
+	}
+	if m.inline_policies_json != nil {
+		fields = append(fields, bronzehistoryawsiamrole.FieldInlinePoliciesJSON)
+	}
+	if m.instance_profiles_json != nil {
+		fields = append(fields, bronzehistoryawsiamrole.FieldInstanceProfilesJSON)
+	}
+	if m.tags_json != nil {
+		fields = append(fields, bronzehistoryawsiamrole.FieldTagsJSON)
+	}
+	if m.account_id != nil {
+		fields = append(fields, bronzehistoryawsiamrole.FieldAccountID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeHistoryAWSIAMRoleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryawsiamrole.FieldValidFrom:
+		return m.ValidFrom()
+	case bronzehistoryawsiamrole.FieldValidTo:
+		return m.ValidTo()
+	case bronzehistoryawsiamrole.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzehistoryawsiamrole.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzehistoryawsiamrole.FieldResourceID:
+		return m.ResourceID()
+	case bronzehistoryawsiamrole.FieldArn:
+		return m.Arn()
+	case bronzehistoryawsiamrole.FieldRoleName:
+		return m.RoleName()
+	case bronzehistoryawsiamrole.FieldPath:
+		return m.Path()
+	case bronzehistoryawsiamrole.FieldCreateDate:
+		return m.CreateDate()
+	case bronzehistoryawsiamrole.FieldRoleLastUsedDate:
+		return m.RoleLastUsedDate()
+	case bronzehistoryawsiamrole.FieldRoleLastUsedRegion:
+		return m.RoleLastUsedRegion()
+	case bronzehistoryawsiamrole.FieldPermissionsBoundaryArn:
+		return m.PermissionsBoundaryArn()
+	case bronzehistoryawsiamrole.FieldAssumeRolePolicyJSON:
+		return m.AssumeRolePolicyJSON()
+	case bronzehistoryawsiamrole.FieldAttachedPoliciesJSON:
+		return m.AttachedPoliciesJSON()
+	case bronzehistoryawsiamrole.FieldInlinePoliciesJSON:
+		return m.InlinePoliciesJSON()
+	case bronzehistoryawsiamrole.FieldInstanceProfilesJSON:
+		return m.InstanceProfilesJSON()
+	case bronzehistoryawsiamrole.FieldTagsJSON:
+		return m.TagsJSON()
+	case bronzehistoryawsiamrole.FieldAccountID:
+		return m.AccountID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeHistoryAWSIAMRoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzehistoryawsiamrole.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case bronzehistoryawsiamrole.FieldValidTo:
+		return m.OldValidTo(ctx)
+	case bronzehistoryawsiamrole.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzehistoryawsiamrole.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzehistoryawsiamrole.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case bronzehistoryawsiamrole.FieldArn:
+		return m.OldArn(ctx)
+	case bronzehistoryawsiamrole.FieldRoleName:
+		return m.OldRoleName(ctx)
+	case bronzehistoryawsiamrole.FieldPath:
+		return m.OldPath(ctx)
+	case bronzehistoryawsiamrole.FieldCreateDate:
+		return m.OldCreateDate(ctx)
+	case bronzehistoryawsiamrole.FieldRoleLastUsedDate:
+		return m.OldRoleLastUsedDate(ctx)
+	case bronzehistoryawsiamrole.FieldRoleLastUsedRegion:
+		return m.OldRoleLastUsedRegion(ctx)
+	case bronzehistoryawsiamrole.FieldPermissionsBoundaryArn:
+		return m.OldPermissionsBoundaryArn(ctx)
+	case bronzehistoryawsiamrole.FieldAssumeRolePolicyJSON:
+		return m.OldAssumeRolePolicyJSON(ctx)
+	case bronzehistoryawsiamrole.FieldAttachedPoliciesJSON:
+		return m.OldAttachedPoliciesJSON(ctx)
+	case bronzehistoryawsiamrole.FieldInlinePoliciesJSON:
+		return m.OldInlinePoliciesJSON(ctx)
+	case bronzehistoryawsiamrole.FieldInstanceProfilesJSON:
+		return m.OldInstanceProfilesJSON(ctx)
+	case bronzehistoryawsiamrole.FieldTagsJSON:
+		return m.OldTagsJSON(ctx)
+	case bronzehistoryawsiamrole.FieldAccountID:
+		return m.OldAccountID(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeHistoryAWSIAMRole field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryAWSIAMRoleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryawsiamrole.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case bronzehistoryawsiamrole.FieldValidTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidTo(v)
+		return nil
+	case bronzehistoryawsiamrole.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzehistoryawsiamrole.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzehistoryawsiamrole.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case bronzehistoryawsiamrole.FieldArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArn(v)
+		return nil
+	case bronzehistoryawsiamrole.FieldRoleName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleName(v)
+		return nil
+	case bronzehistoryawsiamrole.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case bronzehistoryawsiamrole.FieldCreateDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateDate(v)
+		return nil
+	case bronzehistoryawsiamrole.FieldRoleLastUsedDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleLastUsedDate(v)
+		return nil
+	case bronzehistoryawsiamrole.FieldRoleLastUsedRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleLastUsedRegion(v)
+		return nil
+	case bronzehistoryawsiamrole.FieldPermissionsBoundaryArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermissionsBoundaryArn(v)
+		return nil
+	case bronzehistoryawsiamrole.FieldAssumeRolePolicyJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssumeRolePolicyJSON(v)
+		return nil
+	case bronzehistoryawsiamrole.FieldAttachedPoliciesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttachedPoliciesJSON(v)
+		return nil
+	case bronzehistoryawsiamrole.FieldInlinePoliciesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInlinePoliciesJSON(v)
+		return nil
+	case bronzehistoryawsiamrole.FieldInstanceProfilesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstanceProfilesJSON(v)
+		return nil
+	case bronzehistoryawsiamrole.FieldTagsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagsJSON(v)
+		return nil
+	case bronzehistoryawsiamrole.FieldAccountID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMRole field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeHistoryAWSIAMRoleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryAWSIAMRoleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMRole numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzehistoryawsiamrole.FieldValidTo) {
+		fields = append(fields, bronzehistoryawsiamrole.FieldValidTo)
+	}
+	if m.FieldCleared(bronzehistoryawsiamrole.FieldPath) {
+		fields = append(fields, bronzehistoryawsiamrole.FieldPath)
+	}
+	if m.FieldCleared(bronzehistoryawsiamrole.FieldCreateDate) {
+		fields = append(fields, bronzehistoryawsiamrole.FieldCreateDate)
+	}
+	if m.FieldCleared(bronzehistoryawsiamrole.FieldRoleLastUsedDate) {
+		fields = append(fields, bronzehistoryawsiamrole.FieldRoleLastUsedDate)
+	}
+	if m.FieldCleared(bronzehistoryawsiamrole.FieldRoleLastUsedRegion) {
+		fields = append(fields, bronzehistoryawsiamrole.FieldRoleLastUsedRegion)
+	}
+	if m.FieldCleared(bronzehistoryawsiamrole.FieldPermissionsBoundaryArn) {
+		fields = append(fields, bronzehistoryawsiamrole.FieldPermissionsBoundaryArn)
+	}
+	if m.FieldCleared(bronzehistoryawsiamrole.FieldAssumeRolePolicyJSON) {
+		fields = append(fields, bronzehistoryawsiamrole.FieldAssumeRolePolicyJSON)
+	}
+	if m.FieldCleared(bronzehistoryawsiamrole.FieldAttachedPoliciesJSON) {
+		fields = append(fields, bronzehistoryawsiamrole.FieldAttachedPoliciesJSON)
+	}
+	if m.FieldCleared(bronzehistoryawsiamrole.FieldInlinePoliciesJSON) {
+		fields = append(fields, bronzehistoryawsiamrole.FieldInlinePoliciesJSON)
+	}
+	if m.FieldCleared(bronzehistoryawsiamrole.FieldInstanceProfilesJSON) {
+		fields = append(fields, bronzehistoryawsiamrole.FieldInstanceProfilesJSON)
+	}
+	if m.FieldCleared(bronzehistoryawsiamrole.FieldTagsJSON) {
+		fields = append(fields, bronzehistoryawsiamrole.FieldTagsJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeHistoryAWSIAMRoleMutation) ClearField(name string) error {
+	switch name {
+	case bronzehistoryawsiamrole.FieldValidTo:
+		m.ClearValidTo()
+		return nil
+	case bronzehistoryawsiamrole.FieldPath:
+		m.ClearPath()
+		return nil
+	case bronzehistoryawsiamrole.FieldCreateDate:
+		m.ClearCreateDate()
+		return nil
+	case bronzehistoryawsiamrole.FieldRoleLastUsedDate:
+		m.ClearRoleLastUsedDate()
+		return nil
+	case bronzehistoryawsiamrole.FieldRoleLastUsedRegion:
+		m.ClearRoleLastUsedRegion()
+		return nil
+	case bronzehistoryawsiamrole.FieldPermissionsBoundaryArn:
+		m.ClearPermissionsBoundaryArn()
+		return nil
+	case bronzehistoryawsiamrole.FieldAssumeRolePolicyJSON:
+		m.ClearAssumeRolePolicyJSON()
+		return nil
+	case bronzehistoryawsiamrole.FieldAttachedPoliciesJSON:
+		m.ClearAttachedPoliciesJSON()
+		return nil
+	case bronzehistoryawsiamrole.FieldInlinePoliciesJSON:
+		m.ClearInlinePoliciesJSON()
+		return nil
+	case bronzehistoryawsiamrole.FieldInstanceProfilesJSON:
+		m.ClearInstanceProfilesJSON()
+		return nil
+	case bronzehistoryawsiamrole.FieldTagsJSON:
+		m.ClearTagsJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMRole nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetField(name string) error {
+	switch name {
+	case bronzehistoryawsiamrole.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case bronzehistoryawsiamrole.FieldValidTo:
+		m.ResetValidTo()
+		return nil
+	case bronzehistoryawsiamrole.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzehistoryawsiamrole.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzehistoryawsiamrole.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case bronzehistoryawsiamrole.FieldArn:
+		m.ResetArn()
+		return nil
+	case bronzehistoryawsiamrole.FieldRoleName:
+		m.ResetRoleName()
+		return nil
+	case bronzehistoryawsiamrole.FieldPath:
+		m.ResetPath()
+		return nil
+	case bronzehistoryawsiamrole.FieldCreateDate:
+		m.ResetCreateDate()
+		return nil
+	case bronzehistoryawsiamrole.FieldRoleLastUsedDate:
+		m.ResetRoleLastUsedDate()
+		return nil
+	case bronzehistoryawsiamrole.FieldRoleLastUsedRegion:
+		m.ResetRoleLastUsedRegion()
+		return nil
+	case bronzehistoryawsiamrole.FieldPermissionsBoundaryArn:
+		m.ResetPermissionsBoundaryArn()
+		return nil
+	case bronzehistoryawsiamrole.FieldAssumeRolePolicyJSON:
+		m.ResetAssumeRolePolicyJSON()
+		return nil
+	case bronzehistoryawsiamrole.FieldAttachedPoliciesJSON:
+		m.ResetAttachedPoliciesJSON()
+		return nil
+	case bronzehistoryawsiamrole.FieldInlinePoliciesJSON:
+		m.ResetInlinePoliciesJSON()
+		return nil
+	case bronzehistoryawsiamrole.FieldInstanceProfilesJSON:
+		m.ResetInstanceProfilesJSON()
+		return nil
+	case bronzehistoryawsiamrole.FieldTagsJSON:
+		m.ResetTagsJSON()
+		return nil
+	case bronzehistoryawsiamrole.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMRole field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMRoleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeHistoryAWSIAMRoleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMRole unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeHistoryAWSIAMRoleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMRole edge %s", name)
+}
+
+// BronzeHistoryAWSIAMUserMutation represents an operation that mutates the BronzeHistoryAWSIAMUser nodes in the graph.
+type BronzeHistoryAWSIAMUserMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *uint
+	valid_from                   *time.Time
+	valid_to                     *time.Time
+	collected_at                 *time.Time
+	first_collected_at           *time.Time
+	resource_id                  *string
+	arn                          *string
+	user_name                    *string
+	_path                        *string
+	create_date                  *time.Time
+	permissions_boundary_arn     *string
+	attached_policies_json       *json.RawMessage
+	appendattached_policies_json json.RawMessage
+	inline_policies_json         *json.RawMessage
+	appendinline_policies_json   json.RawMessage
+	groups_json                  *json.RawMessage
+	appendgroups_json            json.RawMessage
+	mfa_devices_json             *json.RawMessage
+	appendmfa_devices_json       json.RawMessage
+	tags_json                    *json.RawMessage
+	appendtags_json              json.RawMessage
+	account_id                   *string
+	clearedFields                map[string]struct{}
+	done                         bool
+	oldValue                     func(context.Context) (*BronzeHistoryAWSIAMUser, error)
+	predicates                   []predicate.BronzeHistoryAWSIAMUser
+}
+
+var _ ent.Mutation = (*BronzeHistoryAWSIAMUserMutation)(nil)
+
+// bronzehistoryawsiamuserOption allows management of the mutation configuration using functional options.
+type bronzehistoryawsiamuserOption func(*BronzeHistoryAWSIAMUserMutation)
+
+// newBronzeHistoryAWSIAMUserMutation creates new mutation for the BronzeHistoryAWSIAMUser entity.
+func newBronzeHistoryAWSIAMUserMutation(c config, op Op, opts ...bronzehistoryawsiamuserOption) *BronzeHistoryAWSIAMUserMutation {
+	m := &BronzeHistoryAWSIAMUserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeHistoryAWSIAMUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeHistoryAWSIAMUserID sets the ID field of the mutation.
+func withBronzeHistoryAWSIAMUserID(id uint) bronzehistoryawsiamuserOption {
+	return func(m *BronzeHistoryAWSIAMUserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeHistoryAWSIAMUser
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeHistoryAWSIAMUser, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeHistoryAWSIAMUser.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeHistoryAWSIAMUser sets the old BronzeHistoryAWSIAMUser of the mutation.
+func withBronzeHistoryAWSIAMUser(node *BronzeHistoryAWSIAMUser) bronzehistoryawsiamuserOption {
+	return func(m *BronzeHistoryAWSIAMUserMutation) {
+		m.oldValue = func(context.Context) (*BronzeHistoryAWSIAMUser, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeHistoryAWSIAMUserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeHistoryAWSIAMUserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("iam: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeHistoryAWSIAMUser entities.
+func (m *BronzeHistoryAWSIAMUserMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeHistoryAWSIAMUserMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeHistoryAWSIAMUser.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *BronzeHistoryAWSIAMUserMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the BronzeHistoryAWSIAMUser entity.
+// If the BronzeHistoryAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMUserMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidTo sets the "valid_to" field.
+func (m *BronzeHistoryAWSIAMUserMutation) SetValidTo(t time.Time) {
+	m.valid_to = &t
+}
+
+// ValidTo returns the value of the "valid_to" field in the mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) ValidTo() (r time.Time, exists bool) {
+	v := m.valid_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidTo returns the old "valid_to" field's value of the BronzeHistoryAWSIAMUser entity.
+// If the BronzeHistoryAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMUserMutation) OldValidTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidTo: %w", err)
+	}
+	return oldValue.ValidTo, nil
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ClearValidTo() {
+	m.valid_to = nil
+	m.clearedFields[bronzehistoryawsiamuser.FieldValidTo] = struct{}{}
+}
+
+// ValidToCleared returns if the "valid_to" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) ValidToCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamuser.FieldValidTo]
+	return ok
+}
+
+// ResetValidTo resets all changes to the "valid_to" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ResetValidTo() {
+	m.valid_to = nil
+	delete(m.clearedFields, bronzehistoryawsiamuser.FieldValidTo)
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeHistoryAWSIAMUserMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeHistoryAWSIAMUser entity.
+// If the BronzeHistoryAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMUserMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeHistoryAWSIAMUserMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeHistoryAWSIAMUser entity.
+// If the BronzeHistoryAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMUserMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *BronzeHistoryAWSIAMUserMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the BronzeHistoryAWSIAMUser entity.
+// If the BronzeHistoryAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMUserMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetArn sets the "arn" field.
+func (m *BronzeHistoryAWSIAMUserMutation) SetArn(s string) {
+	m.arn = &s
+}
+
+// Arn returns the value of the "arn" field in the mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) Arn() (r string, exists bool) {
+	v := m.arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArn returns the old "arn" field's value of the BronzeHistoryAWSIAMUser entity.
+// If the BronzeHistoryAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMUserMutation) OldArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArn: %w", err)
+	}
+	return oldValue.Arn, nil
+}
+
+// ResetArn resets all changes to the "arn" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ResetArn() {
+	m.arn = nil
+}
+
+// SetUserName sets the "user_name" field.
+func (m *BronzeHistoryAWSIAMUserMutation) SetUserName(s string) {
+	m.user_name = &s
+}
+
+// UserName returns the value of the "user_name" field in the mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) UserName() (r string, exists bool) {
+	v := m.user_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserName returns the old "user_name" field's value of the BronzeHistoryAWSIAMUser entity.
+// If the BronzeHistoryAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMUserMutation) OldUserName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserName: %w", err)
+	}
+	return oldValue.UserName, nil
+}
+
+// ResetUserName resets all changes to the "user_name" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ResetUserName() {
+	m.user_name = nil
+}
+
+// SetPath sets the "path" field.
+func (m *BronzeHistoryAWSIAMUserMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the BronzeHistoryAWSIAMUser entity.
+// If the BronzeHistoryAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMUserMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ClearPath clears the value of the "path" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ClearPath() {
+	m._path = nil
+	m.clearedFields[bronzehistoryawsiamuser.FieldPath] = struct{}{}
+}
+
+// PathCleared returns if the "path" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) PathCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamuser.FieldPath]
+	return ok
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ResetPath() {
+	m._path = nil
+	delete(m.clearedFields, bronzehistoryawsiamuser.FieldPath)
+}
+
+// SetCreateDate sets the "create_date" field.
+func (m *BronzeHistoryAWSIAMUserMutation) SetCreateDate(t time.Time) {
+	m.create_date = &t
+}
+
+// CreateDate returns the value of the "create_date" field in the mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) CreateDate() (r time.Time, exists bool) {
+	v := m.create_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateDate returns the old "create_date" field's value of the BronzeHistoryAWSIAMUser entity.
+// If the BronzeHistoryAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMUserMutation) OldCreateDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateDate: %w", err)
+	}
+	return oldValue.CreateDate, nil
+}
+
+// ClearCreateDate clears the value of the "create_date" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ClearCreateDate() {
+	m.create_date = nil
+	m.clearedFields[bronzehistoryawsiamuser.FieldCreateDate] = struct{}{}
+}
+
+// CreateDateCleared returns if the "create_date" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) CreateDateCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamuser.FieldCreateDate]
+	return ok
+}
+
+// ResetCreateDate resets all changes to the "create_date" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ResetCreateDate() {
+	m.create_date = nil
+	delete(m.clearedFields, bronzehistoryawsiamuser.FieldCreateDate)
+}
+
+// SetPermissionsBoundaryArn sets the "permissions_boundary_arn" field.
+func (m *BronzeHistoryAWSIAMUserMutation) SetPermissionsBoundaryArn(s string) {
+	m.permissions_boundary_arn = &s
+}
+
+// PermissionsBoundaryArn returns the value of the "permissions_boundary_arn" field in the mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) PermissionsBoundaryArn() (r string, exists bool) {
+	v := m.permissions_boundary_arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPermissionsBoundaryArn returns the old "permissions_boundary_arn" field's value of the BronzeHistoryAWSIAMUser entity.
+// If the BronzeHistoryAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMUserMutation) OldPermissionsBoundaryArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermissionsBoundaryArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermissionsBoundaryArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermissionsBoundaryArn: %w", err)
+	}
+	return oldValue.PermissionsBoundaryArn, nil
+}
+
+// ClearPermissionsBoundaryArn clears the value of the "permissions_boundary_arn" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ClearPermissionsBoundaryArn() {
+	m.permissions_boundary_arn = nil
+	m.clearedFields[bronzehistoryawsiamuser.FieldPermissionsBoundaryArn] = struct{}{}
+}
+
+// PermissionsBoundaryArnCleared returns if the "permissions_boundary_arn" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) PermissionsBoundaryArnCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamuser.FieldPermissionsBoundaryArn]
+	return ok
+}
+
+// ResetPermissionsBoundaryArn resets all changes to the "permissions_boundary_arn" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ResetPermissionsBoundaryArn() {
+	m.permissions_boundary_arn = nil
+	delete(m.clearedFields, bronzehistoryawsiamuser.FieldPermissionsBoundaryArn)
+}
+
+// SetAttachedPoliciesJSON sets the "attached_policies_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) SetAttachedPoliciesJSON(jm json.RawMessage) {
+	m.attached_policies_json = &jm
+	m.appendattached_policies_json = nil
+}
+
+// AttachedPoliciesJSON returns the value of the "attached_policies_json" field in the mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) AttachedPoliciesJSON() (r json.RawMessage, exists bool) {
+	v := m.attached_policies_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttachedPoliciesJSON returns the old "attached_policies_json" field's value of the BronzeHistoryAWSIAMUser entity.
+// If the BronzeHistoryAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMUserMutation) OldAttachedPoliciesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttachedPoliciesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttachedPoliciesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttachedPoliciesJSON: %w", err)
+	}
+	return oldValue.AttachedPoliciesJSON, nil
+}
+
+// AppendAttachedPoliciesJSON adds jm to the "attached_policies_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) AppendAttachedPoliciesJSON(jm json.RawMessage) {
+	m.appendattached_policies_json = append(m.appendattached_policies_json, jm...)
+}
+
+// AppendedAttachedPoliciesJSON returns the list of values that were appended to the "attached_policies_json" field in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) AppendedAttachedPoliciesJSON() (json.RawMessage, bool) {
+	if len(m.appendattached_policies_json) == 0 {
+		return nil, false
+	}
+	return m.appendattached_policies_json, true
+}
+
+// ClearAttachedPoliciesJSON clears the value of the "attached_policies_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ClearAttachedPoliciesJSON() {
+	m.attached_policies_json = nil
+	m.appendattached_policies_json = nil
+	m.clearedFields[bronzehistoryawsiamuser.FieldAttachedPoliciesJSON] = struct{}{}
+}
+
+// AttachedPoliciesJSONCleared returns if the "attached_policies_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) AttachedPoliciesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamuser.FieldAttachedPoliciesJSON]
+	return ok
+}
+
+// ResetAttachedPoliciesJSON resets all changes to the "attached_policies_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ResetAttachedPoliciesJSON() {
+	m.attached_policies_json = nil
+	m.appendattached_policies_json = nil
+	delete(m.clearedFields, bronzehistoryawsiamuser.FieldAttachedPoliciesJSON)
+}
+
+// SetInlinePoliciesJSON sets the "inline_policies_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) SetInlinePoliciesJSON(jm json.RawMessage) {
+	m.inline_policies_json = &jm
+	m.appendinline_policies_json = nil
+}
+
+// InlinePoliciesJSON returns the value of the "inline_policies_json" field in the mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) InlinePoliciesJSON() (r json.RawMessage, exists bool) {
+	v := m.inline_policies_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInlinePoliciesJSON returns the old "inline_policies_json" field's value of the BronzeHistoryAWSIAMUser entity.
+// If the BronzeHistoryAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMUserMutation) OldInlinePoliciesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInlinePoliciesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInlinePoliciesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInlinePoliciesJSON: %w", err)
+	}
+	return oldValue.InlinePoliciesJSON, nil
+}
+
+// AppendInlinePoliciesJSON adds jm to the "inline_policies_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) AppendInlinePoliciesJSON(jm json.RawMessage) {
+	m.appendinline_policies_json = append(m.appendinline_policies_json, jm...)
+}
+
+// AppendedInlinePoliciesJSON returns the list of values that were appended to the "inline_policies_json" field in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) AppendedInlinePoliciesJSON() (json.RawMessage, bool) {
+	if len(m.appendinline_policies_json) == 0 {
+		return nil, false
+	}
+	return m.appendinline_policies_json, true
+}
+
+// ClearInlinePoliciesJSON clears the value of the "inline_policies_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ClearInlinePoliciesJSON() {
+	m.inline_policies_json = nil
+	m.appendinline_policies_json = nil
+	m.clearedFields[bronzehistoryawsiamuser.FieldInlinePoliciesJSON] = struct{}{}
+}
+
+// InlinePoliciesJSONCleared returns if the "inline_policies_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) InlinePoliciesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamuser.FieldInlinePoliciesJSON]
+	return ok
+}
+
+// ResetInlinePoliciesJSON resets all changes to the "inline_policies_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ResetInlinePoliciesJSON() {
+	m.inline_policies_json = nil
+	m.appendinline_policies_json = nil
+	delete(m.clearedFields, bronzehistoryawsiamuser.FieldInlinePoliciesJSON)
+}
+
+// SetGroupsJSON sets the "groups_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) SetGroupsJSON(jm json.RawMessage) {
+	m.groups_json = &jm
+	m.appendgroups_json = nil
+}
+
+// GroupsJSON returns the value of the "groups_json" field in the mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) GroupsJSON() (r json.RawMessage, exists bool) {
+	v := m.groups_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupsJSON returns the old "groups_json" field's value of the BronzeHistoryAWSIAMUser entity.
+// If the BronzeHistoryAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMUserMutation) OldGroupsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupsJSON: %w", err)
+	}
+	return oldValue.GroupsJSON, nil
+}
+
+// AppendGroupsJSON adds jm to the "groups_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) AppendGroupsJSON(jm json.RawMessage) {
+	m.appendgroups_json = append(m.appendgroups_json, jm...)
+}
+
+// AppendedGroupsJSON returns the list of values that were appended to the "groups_json" field in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) AppendedGroupsJSON() (json.RawMessage, bool) {
+	if len(m.appendgroups_json) == 0 {
+		return nil, false
+	}
+	return m.appendgroups_json, true
+}
+
+// ClearGroupsJSON clears the value of the "groups_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ClearGroupsJSON() {
+	m.groups_json = nil
+	m.appendgroups_json = nil
+	m.clearedFields[bronzehistoryawsiamuser.FieldGroupsJSON] = struct{}{}
+}
+
+// GroupsJSONCleared returns if the "groups_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) GroupsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamuser.FieldGroupsJSON]
+	return ok
+}
+
+// ResetGroupsJSON resets all changes to the "groups_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ResetGroupsJSON() {
+	m.groups_json = nil
+	m.appendgroups_json = nil
+	delete(m.clearedFields, bronzehistoryawsiamuser.FieldGroupsJSON)
+}
+
+// SetMfaDevicesJSON sets the "mfa_devices_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) SetMfaDevicesJSON(jm json.RawMessage) {
+	m.mfa_devices_json = &jm
+	m.appendmfa_devices_json = nil
+}
+
+// MfaDevicesJSON returns the value of the "mfa_devices_json" field in the mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) MfaDevicesJSON() (r json.RawMessage, exists bool) {
+	v := m.mfa_devices_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMfaDevicesJSON returns the old "mfa_devices_json" field's value of the BronzeHistoryAWSIAMUser entity.
+// If the BronzeHistoryAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMUserMutation) OldMfaDevicesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMfaDevicesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMfaDevicesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMfaDevicesJSON: %w", err)
+	}
+	return oldValue.MfaDevicesJSON, nil
+}
+
+// AppendMfaDevicesJSON adds jm to the "mfa_devices_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) AppendMfaDevicesJSON(jm json.RawMessage) {
+	m.appendmfa_devices_json = append(m.appendmfa_devices_json, jm...)
+}
+
+// AppendedMfaDevicesJSON returns the list of values that were appended to the "mfa_devices_json" field in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) AppendedMfaDevicesJSON() (json.RawMessage, bool) {
+	if len(m.appendmfa_devices_json) == 0 {
+		return nil, false
+	}
+	return m.appendmfa_devices_json, true
+}
+
+// ClearMfaDevicesJSON clears the value of the "mfa_devices_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ClearMfaDevicesJSON() {
+	m.mfa_devices_json = nil
+	m.appendmfa_devices_json = nil
+	m.clearedFields[bronzehistoryawsiamuser.FieldMfaDevicesJSON] = struct{}{}
+}
+
+// MfaDevicesJSONCleared returns if the "mfa_devices_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) MfaDevicesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamuser.FieldMfaDevicesJSON]
+	return ok
+}
+
+// ResetMfaDevicesJSON resets all changes to the "mfa_devices_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ResetMfaDevicesJSON() {
+	m.mfa_devices_json = nil
+	m.appendmfa_devices_json = nil
+	delete(m.clearedFields, bronzehistoryawsiamuser.FieldMfaDevicesJSON)
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) SetTagsJSON(jm json.RawMessage) {
+	m.tags_json = &jm
+	m.appendtags_json = nil
+}
+
+// TagsJSON returns the value of the "tags_json" field in the mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) TagsJSON() (r json.RawMessage, exists bool) {
+	v := m.tags_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagsJSON returns the old "tags_json" field's value of the BronzeHistoryAWSIAMUser entity.
+// If the BronzeHistoryAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMUserMutation) OldTagsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagsJSON: %w", err)
+	}
+	return oldValue.TagsJSON, nil
+}
+
+// AppendTagsJSON adds jm to the "tags_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) AppendTagsJSON(jm json.RawMessage) {
+	m.appendtags_json = append(m.appendtags_json, jm...)
+}
+
+// AppendedTagsJSON returns the list of values that were appended to the "tags_json" field in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) AppendedTagsJSON() (json.RawMessage, bool) {
+	if len(m.appendtags_json) == 0 {
+		return nil, false
+	}
+	return m.appendtags_json, true
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ClearTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	m.clearedFields[bronzehistoryawsiamuser.FieldTagsJSON] = struct{}{}
+}
+
+// TagsJSONCleared returns if the "tags_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) TagsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawsiamuser.FieldTagsJSON]
+	return ok
+}
+
+// ResetTagsJSON resets all changes to the "tags_json" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ResetTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	delete(m.clearedFields, bronzehistoryawsiamuser.FieldTagsJSON)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BronzeHistoryAWSIAMUserMutation) SetAccountID(s string) {
+	m.account_id = &s
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) AccountID() (r string, exists bool) {
+	v := m.account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the BronzeHistoryAWSIAMUser entity.
+// If the BronzeHistoryAWSIAMUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSIAMUserMutation) OldAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BronzeHistoryAWSIAMUserMutation) ResetAccountID() {
+	m.account_id = nil
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSIAMUserMutation builder.
+func (m *BronzeHistoryAWSIAMUserMutation) Where(ps ...predicate.BronzeHistoryAWSIAMUser) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeHistoryAWSIAMUserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeHistoryAWSIAMUserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeHistoryAWSIAMUser, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeHistoryAWSIAMUserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeHistoryAWSIAMUserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeHistoryAWSIAMUser).
+func (m *BronzeHistoryAWSIAMUserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeHistoryAWSIAMUserMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.valid_from != nil {
+		fields = append(fields, bronzehistoryawsiamuser.FieldValidFrom)
+	}
+	if m.valid_to != nil {
+		fields = append(fields, bronzehistoryawsiamuser.FieldValidTo)
+	}
+	if m.collected_at != nil {
+		fields = append(fields, bronzehistoryawsiamuser.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzehistoryawsiamuser.FieldFirstCollectedAt)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, bronzehistoryawsiamuser.FieldResourceID)
+	}
+	if m.arn != nil {
+		fields = append(fields, bronzehistoryawsiamuser.FieldArn)
+	}
+	if m.user_name != nil {
+		fields = append(fields, bronzehistoryawsiamuser.FieldUserName)
+	}
+	if m._path != nil {
+		fields = append(fields, bronzehistoryawsiamuser.FieldPath)
+	}
+	if m.create_date != nil {
+		fields = append(fields, bronzehistoryawsiamuser.FieldCreateDate)
+	}
+	if m.permissions_boundary_arn != nil {
+		fields = append(fields, bronzehistoryawsiamuser.FieldPermissionsBoundaryArn)
+	}
+	if m.attached_policies_json != nil {
+		fields = append(fields, bronzehistoryawsiamuser.FieldAttachedPoliciesJSON)
+	}
+	if m.inline_policies_json != nil {
+		fields = append(fields, bronzehistoryawsiamuser.FieldInlinePoliciesJSON)
+	}
+	if m.groups_json != nil {
+		fields = append(fields, bronzehistoryawsiamuser.FieldGroupsJSON)
+	}
+	if m.mfa_devices_json != nil {
+		fields = append(fields, bronzehistoryawsiamuser.FieldMfaDevicesJSON)
+	}
+	if m.tags_json != nil {
+		fields = append(fields, bronzehistoryawsiamuser.FieldTagsJSON)
+	}
+	if m.account_id != nil {
+		fields = append(fields, bronzehistoryawsiamuser.FieldAccountID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeHistoryAWSIAMUserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryawsiamuser.FieldValidFrom:
+		return m.ValidFrom()
+	case bronzehistoryawsiamuser.FieldValidTo:
+		return m.ValidTo()
+	case bronzehistoryawsiamuser.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzehistoryawsiamuser.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzehistoryawsiamuser.FieldResourceID:
+		return m.ResourceID()
+	case bronzehistoryawsiamuser.FieldArn:
+		return m.Arn()
+	case bronzehistoryawsiamuser.FieldUserName:
+		return m.UserName()
+	case bronzehistoryawsiamuser.FieldPath:
+		return m.Path()
+	case bronzehistoryawsiamuser.FieldCreateDate:
+		return m.CreateDate()
+	case bronzehistoryawsiamuser.FieldPermissionsBoundaryArn:
+		return m.PermissionsBoundaryArn()
+	case bronzehistoryawsiamuser.FieldAttachedPoliciesJSON:
+		return m.AttachedPoliciesJSON()
+	case bronzehistoryawsiamuser.FieldInlinePoliciesJSON:
+		return m.InlinePoliciesJSON()
+	case bronzehistoryawsiamuser.FieldGroupsJSON:
+		return m.GroupsJSON()
+	case bronzehistoryawsiamuser.FieldMfaDevicesJSON:
+		return m.MfaDevicesJSON()
+	case bronzehistoryawsiamuser.FieldTagsJSON:
+		return m.TagsJSON()
+	case bronzehistoryawsiamuser.FieldAccountID:
+		return m.AccountID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeHistoryAWSIAMUserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzehistoryawsiamuser.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case bronzehistoryawsiamuser.FieldValidTo:
+		return m.OldValidTo(ctx)
+	case bronzehistoryawsiamuser.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzehistoryawsiamuser.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzehistoryawsiamuser.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case bronzehistoryawsiamuser.FieldArn:
+		return m.OldArn(ctx)
+	case bronzehistoryawsiamuser.FieldUserName:
+		return m.OldUserName(ctx)
+	case bronzehistoryawsiamuser.FieldPath:
+		return m.OldPath(ctx)
+	case bronzehistoryawsiamuser.FieldCreateDate:
+		return m.OldCreateDate(ctx)
+	case bronzehistoryawsiamuser.FieldPermissionsBoundaryArn:
+		return m.OldPermissionsBoundaryArn(ctx)
+	case bronzehistoryawsiamuser.FieldAttachedPoliciesJSON:
+		return m.OldAttachedPoliciesJSON(ctx)
+	case bronzehistoryawsiamuser.FieldInlinePoliciesJSON:
+		return m.OldInlinePoliciesJSON(ctx)
+	case bronzehistoryawsiamuser.FieldGroupsJSON:
+		return m.OldGroupsJSON(ctx)
+	case bronzehistoryawsiamuser.FieldMfaDevicesJSON:
+		return m.OldMfaDevicesJSON(ctx)
+	case bronzehistoryawsiamuser.FieldTagsJSON:
+		return m.OldTagsJSON(ctx)
+	case bronzehistoryawsiamuser.FieldAccountID:
+		return m.OldAccountID(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeHistoryAWSIAMUser field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryAWSIAMUserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryawsiamuser.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case bronzehistoryawsiamuser.FieldValidTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidTo(v)
+		return nil
+	case bronzehistoryawsiamuser.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzehistoryawsiamuser.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzehistoryawsiamuser.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case bronzehistoryawsiamuser.FieldArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArn(v)
+		return nil
+	case bronzehistoryawsiamuser.FieldUserName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserName(v)
+		return nil
+	case bronzehistoryawsiamuser.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case bronzehistoryawsiamuser.FieldCreateDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateDate(v)
+		return nil
+	case bronzehistoryawsiamuser.FieldPermissionsBoundaryArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermissionsBoundaryArn(v)
+		return nil
+	case bronzehistoryawsiamuser.FieldAttachedPoliciesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttachedPoliciesJSON(v)
+		return nil
+	case bronzehistoryawsiamuser.FieldInlinePoliciesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInlinePoliciesJSON(v)
+		return nil
+	case bronzehistoryawsiamuser.FieldGroupsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupsJSON(v)
+		return nil
+	case bronzehistoryawsiamuser.FieldMfaDevicesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaDevicesJSON(v)
+		return nil
+	case bronzehistoryawsiamuser.FieldTagsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagsJSON(v)
+		return nil
+	case bronzehistoryawsiamuser.FieldAccountID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMUser field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeHistoryAWSIAMUserMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryAWSIAMUserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMUser numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzehistoryawsiamuser.FieldValidTo) {
+		fields = append(fields, bronzehistoryawsiamuser.FieldValidTo)
+	}
+	if m.FieldCleared(bronzehistoryawsiamuser.FieldPath) {
+		fields = append(fields, bronzehistoryawsiamuser.FieldPath)
+	}
+	if m.FieldCleared(bronzehistoryawsiamuser.FieldCreateDate) {
+		fields = append(fields, bronzehistoryawsiamuser.FieldCreateDate)
+	}
+	if m.FieldCleared(bronzehistoryawsiamuser.FieldPermissionsBoundaryArn) {
+		fields = append(fields, bronzehistoryawsiamuser.FieldPermissionsBoundaryArn)
+	}
+	if m.FieldCleared(bronzehistoryawsiamuser.FieldAttachedPoliciesJSON) {
+		fields = append(fields, bronzehistoryawsiamuser.FieldAttachedPoliciesJSON)
+	}
+	if m.FieldCleared(bronzehistoryawsiamuser.FieldInlinePoliciesJSON) {
+		fields = append(fields, bronzehistoryawsiamuser.FieldInlinePoliciesJSON)
+	}
+	if m.FieldCleared(bronzehistoryawsiamuser.FieldGroupsJSON) {
+		fields = append(fields, bronzehistoryawsiamuser.FieldGroupsJSON)
+	}
+	if m.FieldCleared(bronzehistoryawsiamuser.FieldMfaDevicesJSON) {
+		fields = append(fields, bronzehistoryawsiamuser.FieldMfaDevicesJSON)
+	}
+	if m.FieldCleared(bronzehistoryawsiamuser.FieldTagsJSON) {
+		fields = append(fields, bronzehistoryawsiamuser.FieldTagsJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeHistoryAWSIAMUserMutation) ClearField(name string) error {
+	switch name {
+	case bronzehistoryawsiamuser.FieldValidTo:
+		m.ClearValidTo()
+		return nil
+	case bronzehistoryawsiamuser.FieldPath:
+		m.ClearPath()
+		return nil
+	case bronzehistoryawsiamuser.FieldCreateDate:
+		m.ClearCreateDate()
+		return nil
+	case bronzehistoryawsiamuser.FieldPermissionsBoundaryArn:
+		m.ClearPermissionsBoundaryArn()
+		return nil
+	case bronzehistoryawsiamuser.FieldAttachedPoliciesJSON:
+		m.ClearAttachedPoliciesJSON()
+		return nil
+	case bronzehistoryawsiamuser.FieldInlinePoliciesJSON:
+		m.ClearInlinePoliciesJSON()
+		return nil
+	case bronzehistoryawsiamuser.FieldGroupsJSON:
+		m.ClearGroupsJSON()
+		return nil
+	case bronzehistoryawsiamuser.FieldMfaDevicesJSON:
+		m.ClearMfaDevicesJSON()
+		return nil
+	case bronzehistoryawsiamuser.FieldTagsJSON:
+		m.ClearTagsJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMUser nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeHistoryAWSIAMUserMutation) ResetField(name string) error {
+	switch name {
+	case bronzehistoryawsiamuser.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case bronzehistoryawsiamuser.FieldValidTo:
+		m.ResetValidTo()
+		return nil
+	case bronzehistoryawsiamuser.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzehistoryawsiamuser.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzehistoryawsiamuser.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case bronzehistoryawsiamuser.FieldArn:
+		m.ResetArn()
+		return nil
+	case bronzehistoryawsiamuser.FieldUserName:
+		m.ResetUserName()
+		return nil
+	case bronzehistoryawsiamuser.FieldPath:
+		m.ResetPath()
+		return nil
+	case bronzehistoryawsiamuser.FieldCreateDate:
+		m.ResetCreateDate()
+		return nil
+	case bronzehistoryawsiamuser.FieldPermissionsBoundaryArn:
+		m.ResetPermissionsBoundaryArn()
+		return nil
+	case bronzehistoryawsiamuser.FieldAttachedPoliciesJSON:
+		m.ResetAttachedPoliciesJSON()
+		return nil
+	case bronzehistoryawsiamuser.FieldInlinePoliciesJSON:
+		m.ResetInlinePoliciesJSON()
+		return nil
+	case bronzehistoryawsiamuser.FieldGroupsJSON:
+		m.ResetGroupsJSON()
+		return nil
+	case bronzehistoryawsiamuser.FieldMfaDevicesJSON:
+		m.ResetMfaDevicesJSON()
+		return nil
+	case bronzehistoryawsiamuser.FieldTagsJSON:
+		m.ResetTagsJSON()
+		return nil
+	case bronzehistoryawsiamuser.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMUser field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeHistoryAWSIAMUserMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeHistoryAWSIAMUserMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMUser unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeHistoryAWSIAMUserMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryAWSIAMUser edge %s", name)
+}
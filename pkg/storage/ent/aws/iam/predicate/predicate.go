@@ -0,0 +1,43 @@
+// Code generated by ent, DO NOT EDIT.
+
+package predicate
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeAWSIAMAccessKey is the predicate function for bronzeawsiamaccesskey builders.
+type BronzeAWSIAMAccessKey func(*sql.Selector)
+
+// BronzeAWSIAMCredentialReport is the predicate function for bronzeawsiamcredentialreport builders.
+type BronzeAWSIAMCredentialReport func(*sql.Selector)
+
+// BronzeAWSIAMGroup is the predicate function for bronzeawsiamgroup builders.
+type BronzeAWSIAMGroup func(*sql.Selector)
+
+// BronzeAWSIAMPolicy is the predicate function for bronzeawsiampolicy builders.
+type BronzeAWSIAMPolicy func(*sql.Selector)
+
+// BronzeAWSIAMRole is the predicate function for bronzeawsiamrole builders.
+type BronzeAWSIAMRole func(*sql.Selector)
+
+// BronzeAWSIAMUser is the predicate function for bronzeawsiamuser builders.
+type BronzeAWSIAMUser func(*sql.Selector)
+
+// BronzeHistoryAWSIAMAccessKey is the predicate function for bronzehistoryawsiamaccesskey builders.
+type BronzeHistoryAWSIAMAccessKey func(*sql.Selector)
+
+// BronzeHistoryAWSIAMCredentialReport is the predicate function for bronzehistoryawsiamcredentialreport builders.
+type BronzeHistoryAWSIAMCredentialReport func(*sql.Selector)
+
+// BronzeHistoryAWSIAMGroup is the predicate function for bronzehistoryawsiamgroup builders.
+type BronzeHistoryAWSIAMGroup func(*sql.Selector)
+
+// BronzeHistoryAWSIAMPolicy is the predicate function for bronzehistoryawsiampolicy builders.
+type BronzeHistoryAWSIAMPolicy func(*sql.Selector)
+
+// BronzeHistoryAWSIAMRole is the predicate function for bronzehistoryawsiamrole builders.
+type BronzeHistoryAWSIAMRole func(*sql.Selector)
+
+// BronzeHistoryAWSIAMUser is the predicate function for bronzehistoryawsiamuser builders.
+type BronzeHistoryAWSIAMUser func(*sql.Selector)
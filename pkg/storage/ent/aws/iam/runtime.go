@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iam
+
+import (
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamaccesskey"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamcredentialreport"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamgroup"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiampolicy"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamrole"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzeawsiamuser"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamaccesskey"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamcredentialreport"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamgroup"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiampolicy"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamrole"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/bronzehistoryawsiamuser"
+	"danny.vn/hotpot/pkg/storage/ent/aws/iam/schema"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	bronzeawsiamaccesskeyFields := schema.BronzeAWSIAMAccessKey{}.Fields()
+	_ = bronzeawsiamaccesskeyFields
+	// bronzeawsiamaccesskeyDescUserName is the schema descriptor for user_name field.
+	bronzeawsiamaccesskeyDescUserName := bronzeawsiamaccesskeyFields[1].Descriptor()
+	// bronzeawsiamaccesskey.UserNameValidator is a validator for the "user_name" field. It is called by the builders before save.
+	bronzeawsiamaccesskey.UserNameValidator = bronzeawsiamaccesskeyDescUserName.Validators[0].(func(string) error)
+	// bronzeawsiamaccesskeyDescAccountID is the schema descriptor for account_id field.
+	bronzeawsiamaccesskeyDescAccountID := bronzeawsiamaccesskeyFields[7].Descriptor()
+	// bronzeawsiamaccesskey.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzeawsiamaccesskey.AccountIDValidator = bronzeawsiamaccesskeyDescAccountID.Validators[0].(func(string) error)
+	bronzeawsiamcredentialreportFields := schema.BronzeAWSIAMCredentialReport{}.Fields()
+	_ = bronzeawsiamcredentialreportFields
+	// bronzeawsiamcredentialreportDescUser is the schema descriptor for user field.
+	bronzeawsiamcredentialreportDescUser := bronzeawsiamcredentialreportFields[1].Descriptor()
+	// bronzeawsiamcredentialreport.UserValidator is a validator for the "user" field. It is called by the builders before save.
+	bronzeawsiamcredentialreport.UserValidator = bronzeawsiamcredentialreportDescUser.Validators[0].(func(string) error)
+	// bronzeawsiamcredentialreportDescMfaActive is the schema descriptor for mfa_active field.
+	bronzeawsiamcredentialreportDescMfaActive := bronzeawsiamcredentialreportFields[7].Descriptor()
+	// bronzeawsiamcredentialreport.DefaultMfaActive holds the default value on creation for the mfa_active field.
+	bronzeawsiamcredentialreport.DefaultMfaActive = bronzeawsiamcredentialreportDescMfaActive.Default.(bool)
+	// bronzeawsiamcredentialreportDescAccessKey1Active is the schema descriptor for access_key_1_active field.
+	bronzeawsiamcredentialreportDescAccessKey1Active := bronzeawsiamcredentialreportFields[8].Descriptor()
+	// bronzeawsiamcredentialreport.DefaultAccessKey1Active holds the default value on creation for the access_key_1_active field.
+	bronzeawsiamcredentialreport.DefaultAccessKey1Active = bronzeawsiamcredentialreportDescAccessKey1Active.Default.(bool)
+	// bronzeawsiamcredentialreportDescAccessKey2Active is the schema descriptor for access_key_2_active field.
+	bronzeawsiamcredentialreportDescAccessKey2Active := bronzeawsiamcredentialreportFields[13].Descriptor()
+	// bronzeawsiamcredentialreport.DefaultAccessKey2Active holds the default value on creation for the access_key_2_active field.
+	bronzeawsiamcredentialreport.DefaultAccessKey2Active = bronzeawsiamcredentialreportDescAccessKey2Active.Default.(bool)
+	// bronzeawsiamcredentialreportDescCert1Active is the schema descriptor for cert_1_active field.
+	bronzeawsiamcredentialreportDescCert1Active := bronzeawsiamcredentialreportFields[18].Descriptor()
+	// bronzeawsiamcredentialreport.DefaultCert1Active holds the default value on creation for the cert_1_active field.
+	bronzeawsiamcredentialreport.DefaultCert1Active = bronzeawsiamcredentialreportDescCert1Active.Default.(bool)
+	// bronzeawsiamcredentialreportDescCert2Active is the schema descriptor for cert_2_active field.
+	bronzeawsiamcredentialreportDescCert2Active := bronzeawsiamcredentialreportFields[20].Descriptor()
+	// bronzeawsiamcredentialreport.DefaultCert2Active holds the default value on creation for the cert_2_active field.
+	bronzeawsiamcredentialreport.DefaultCert2Active = bronzeawsiamcredentialreportDescCert2Active.Default.(bool)
+	// bronzeawsiamcredentialreportDescAccountID is the schema descriptor for account_id field.
+	bronzeawsiamcredentialreportDescAccountID := bronzeawsiamcredentialreportFields[23].Descriptor()
+	// bronzeawsiamcredentialreport.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzeawsiamcredentialreport.AccountIDValidator = bronzeawsiamcredentialreportDescAccountID.Validators[0].(func(string) error)
+	bronzeawsiamgroupFields := schema.BronzeAWSIAMGroup{}.Fields()
+	_ = bronzeawsiamgroupFields
+	// bronzeawsiamgroupDescArn is the schema descriptor for arn field.
+	bronzeawsiamgroupDescArn := bronzeawsiamgroupFields[1].Descriptor()
+	// bronzeawsiamgroup.ArnValidator is a validator for the "arn" field. It is called by the builders before save.
+	bronzeawsiamgroup.ArnValidator = bronzeawsiamgroupDescArn.Validators[0].(func(string) error)
+	// bronzeawsiamgroupDescGroupName is the schema descriptor for group_name field.
+	bronzeawsiamgroupDescGroupName := bronzeawsiamgroupFields[2].Descriptor()
+	// bronzeawsiamgroup.GroupNameValidator is a validator for the "group_name" field. It is called by the builders before save.
+	bronzeawsiamgroup.GroupNameValidator = bronzeawsiamgroupDescGroupName.Validators[0].(func(string) error)
+	// bronzeawsiamgroupDescAccountID is the schema descriptor for account_id field.
+	bronzeawsiamgroupDescAccountID := bronzeawsiamgroupFields[7].Descriptor()
+	// bronzeawsiamgroup.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzeawsiamgroup.AccountIDValidator = bronzeawsiamgroupDescAccountID.Validators[0].(func(string) error)
+	bronzeawsiampolicyFields := schema.BronzeAWSIAMPolicy{}.Fields()
+	_ = bronzeawsiampolicyFields
+	// bronzeawsiampolicyDescArn is the schema descriptor for arn field.
+	bronzeawsiampolicyDescArn := bronzeawsiampolicyFields[1].Descriptor()
+	// bronzeawsiampolicy.ArnValidator is a validator for the "arn" field. It is called by the builders before save.
+	bronzeawsiampolicy.ArnValidator = bronzeawsiampolicyDescArn.Validators[0].(func(string) error)
+	// bronzeawsiampolicyDescPolicyName is the schema descriptor for policy_name field.
+	bronzeawsiampolicyDescPolicyName := bronzeawsiampolicyFields[2].Descriptor()
+	// bronzeawsiampolicy.PolicyNameValidator is a validator for the "policy_name" field. It is called by the builders before save.
+	bronzeawsiampolicy.PolicyNameValidator = bronzeawsiampolicyDescPolicyName.Validators[0].(func(string) error)
+	// bronzeawsiampolicyDescAttachmentCount is the schema descriptor for attachment_count field.
+	bronzeawsiampolicyDescAttachmentCount := bronzeawsiampolicyFields[6].Descriptor()
+	// bronzeawsiampolicy.DefaultAttachmentCount holds the default value on creation for the attachment_count field.
+	bronzeawsiampolicy.DefaultAttachmentCount = bronzeawsiampolicyDescAttachmentCount.Default.(int)
+	// bronzeawsiampolicyDescPermissionsBoundaryUsageCount is the schema descriptor for permissions_boundary_usage_count field.
+	bronzeawsiampolicyDescPermissionsBoundaryUsageCount := bronzeawsiampolicyFields[7].Descriptor()
+	// bronzeawsiampolicy.DefaultPermissionsBoundaryUsageCount holds the default value on creation for the permissions_boundary_usage_count field.
+	bronzeawsiampolicy.DefaultPermissionsBoundaryUsageCount = bronzeawsiampolicyDescPermissionsBoundaryUsageCount.Default.(int)
+	// bronzeawsiampolicyDescIsAttachable is the schema descriptor for is_attachable field.
+	bronzeawsiampolicyDescIsAttachable := bronzeawsiampolicyFields[8].Descriptor()
+	// bronzeawsiampolicy.DefaultIsAttachable holds the default value on creation for the is_attachable field.
+	bronzeawsiampolicy.DefaultIsAttachable = bronzeawsiampolicyDescIsAttachable.Default.(bool)
+	// bronzeawsiampolicyDescAWSManaged is the schema descriptor for aws_managed field.
+	bronzeawsiampolicyDescAWSManaged := bronzeawsiampolicyFields[9].Descriptor()
+	// bronzeawsiampolicy.DefaultAWSManaged holds the default value on creation for the aws_managed field.
+	bronzeawsiampolicy.DefaultAWSManaged = bronzeawsiampolicyDescAWSManaged.Default.(bool)
+	// bronzeawsiampolicyDescAccountID is the schema descriptor for account_id field.
+	bronzeawsiampolicyDescAccountID := bronzeawsiampolicyFields[13].Descriptor()
+	// bronzeawsiampolicy.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzeawsiampolicy.AccountIDValidator = bronzeawsiampolicyDescAccountID.Validators[0].(func(string) error)
+	bronzeawsiamroleFields := schema.BronzeAWSIAMRole{}.Fields()
+	_ = bronzeawsiamroleFields
+	// bronzeawsiamroleDescArn is the schema descriptor for arn field.
+	bronzeawsiamroleDescArn := bronzeawsiamroleFields[1].Descriptor()
+	// bronzeawsiamrole.ArnValidator is a validator for the "arn" field. It is called by the builders before save.
+	bronzeawsiamrole.ArnValidator = bronzeawsiamroleDescArn.Validators[0].(func(string) error)
+	// bronzeawsiamroleDescRoleName is the schema descriptor for role_name field.
+	bronzeawsiamroleDescRoleName := bronzeawsiamroleFields[2].Descriptor()
+	// bronzeawsiamrole.RoleNameValidator is a validator for the "role_name" field. It is called by the builders before save.
+	bronzeawsiamrole.RoleNameValidator = bronzeawsiamroleDescRoleName.Validators[0].(func(string) error)
+	// bronzeawsiamroleDescAccountID is the schema descriptor for account_id field.
+	bronzeawsiamroleDescAccountID := bronzeawsiamroleFields[13].Descriptor()
+	// bronzeawsiamrole.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzeawsiamrole.AccountIDValidator = bronzeawsiamroleDescAccountID.Validators[0].(func(string) error)
+	bronzeawsiamuserFields := schema.BronzeAWSIAMUser{}.Fields()
+	_ = bronzeawsiamuserFields
+	// bronzeawsiamuserDescArn is the schema descriptor for arn field.
+	bronzeawsiamuserDescArn := bronzeawsiamuserFields[1].Descriptor()
+	// bronzeawsiamuser.ArnValidator is a validator for the "arn" field. It is called by the builders before save.
+	bronzeawsiamuser.ArnValidator = bronzeawsiamuserDescArn.Validators[0].(func(string) error)
+	// bronzeawsiamuserDescUserName is the schema descriptor for user_name field.
+	bronzeawsiamuserDescUserName := bronzeawsiamuserFields[2].Descriptor()
+	// bronzeawsiamuser.UserNameValidator is a validator for the "user_name" field. It is called by the builders before save.
+	bronzeawsiamuser.UserNameValidator = bronzeawsiamuserDescUserName.Validators[0].(func(string) error)
+	// bronzeawsiamuserDescAccountID is the schema descriptor for account_id field.
+	bronzeawsiamuserDescAccountID := bronzeawsiamuserFields[11].Descriptor()
+	// bronzeawsiamuser.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzeawsiamuser.AccountIDValidator = bronzeawsiamuserDescAccountID.Validators[0].(func(string) error)
+	bronzehistoryawsiamaccesskeyFields := schema.BronzeHistoryAWSIAMAccessKey{}.Fields()
+	_ = bronzehistoryawsiamaccesskeyFields
+	// bronzehistoryawsiamaccesskeyDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryawsiamaccesskeyDescResourceID := bronzehistoryawsiamaccesskeyFields[1].Descriptor()
+	// bronzehistoryawsiamaccesskey.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryawsiamaccesskey.ResourceIDValidator = bronzehistoryawsiamaccesskeyDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryawsiamaccesskeyDescUserName is the schema descriptor for user_name field.
+	bronzehistoryawsiamaccesskeyDescUserName := bronzehistoryawsiamaccesskeyFields[2].Descriptor()
+	// bronzehistoryawsiamaccesskey.UserNameValidator is a validator for the "user_name" field. It is called by the builders before save.
+	bronzehistoryawsiamaccesskey.UserNameValidator = bronzehistoryawsiamaccesskeyDescUserName.Validators[0].(func(string) error)
+	// bronzehistoryawsiamaccesskeyDescAccountID is the schema descriptor for account_id field.
+	bronzehistoryawsiamaccesskeyDescAccountID := bronzehistoryawsiamaccesskeyFields[8].Descriptor()
+	// bronzehistoryawsiamaccesskey.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzehistoryawsiamaccesskey.AccountIDValidator = bronzehistoryawsiamaccesskeyDescAccountID.Validators[0].(func(string) error)
+	bronzehistoryawsiamcredentialreportFields := schema.BronzeHistoryAWSIAMCredentialReport{}.Fields()
+	_ = bronzehistoryawsiamcredentialreportFields
+	// bronzehistoryawsiamcredentialreportDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryawsiamcredentialreportDescResourceID := bronzehistoryawsiamcredentialreportFields[1].Descriptor()
+	// bronzehistoryawsiamcredentialreport.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryawsiamcredentialreport.ResourceIDValidator = bronzehistoryawsiamcredentialreportDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryawsiamcredentialreportDescUser is the schema descriptor for user field.
+	bronzehistoryawsiamcredentialreportDescUser := bronzehistoryawsiamcredentialreportFields[2].Descriptor()
+	// bronzehistoryawsiamcredentialreport.UserValidator is a validator for the "user" field. It is called by the builders before save.
+	bronzehistoryawsiamcredentialreport.UserValidator = bronzehistoryawsiamcredentialreportDescUser.Validators[0].(func(string) error)
+	// bronzehistoryawsiamcredentialreportDescMfaActive is the schema descriptor for mfa_active field.
+	bronzehistoryawsiamcredentialreportDescMfaActive := bronzehistoryawsiamcredentialreportFields[8].Descriptor()
+	// bronzehistoryawsiamcredentialreport.DefaultMfaActive holds the default value on creation for the mfa_active field.
+	bronzehistoryawsiamcredentialreport.DefaultMfaActive = bronzehistoryawsiamcredentialreportDescMfaActive.Default.(bool)
+	// bronzehistoryawsiamcredentialreportDescAccessKey1Active is the schema descriptor for access_key_1_active field.
+	bronzehistoryawsiamcredentialreportDescAccessKey1Active := bronzehistoryawsiamcredentialreportFields[9].Descriptor()
+	// bronzehistoryawsiamcredentialreport.DefaultAccessKey1Active holds the default value on creation for the access_key_1_active field.
+	bronzehistoryawsiamcredentialreport.DefaultAccessKey1Active = bronzehistoryawsiamcredentialreportDescAccessKey1Active.Default.(bool)
+	// bronzehistoryawsiamcredentialreportDescAccessKey2Active is the schema descriptor for access_key_2_active field.
+	bronzehistoryawsiamcredentialreportDescAccessKey2Active := bronzehistoryawsiamcredentialreportFields[14].Descriptor()
+	// bronzehistoryawsiamcredentialreport.DefaultAccessKey2Active holds the default value on creation for the access_key_2_active field.
+	bronzehistoryawsiamcredentialreport.DefaultAccessKey2Active = bronzehistoryawsiamcredentialreportDescAccessKey2Active.Default.(bool)
+	// bronzehistoryawsiamcredentialreportDescCert1Active is the schema descriptor for cert_1_active field.
+	bronzehistoryawsiamcredentialreportDescCert1Active := bronzehistoryawsiamcredentialreportFields[19].Descriptor()
+	// bronzehistoryawsiamcredentialreport.DefaultCert1Active holds the default value on creation for the cert_1_active field.
+	bronzehistoryawsiamcredentialreport.DefaultCert1Active = bronzehistoryawsiamcredentialreportDescCert1Active.Default.(bool)
+	// bronzehistoryawsiamcredentialreportDescCert2Active is the schema descriptor for cert_2_active field.
+	bronzehistoryawsiamcredentialreportDescCert2Active := bronzehistoryawsiamcredentialreportFields[21].Descriptor()
+	// bronzehistoryawsiamcredentialreport.DefaultCert2Active holds the default value on creation for the cert_2_active field.
+	bronzehistoryawsiamcredentialreport.DefaultCert2Active = bronzehistoryawsiamcredentialreportDescCert2Active.Default.(bool)
+	// bronzehistoryawsiamcredentialreportDescAccountID is the schema descriptor for account_id field.
+	bronzehistoryawsiamcredentialreportDescAccountID := bronzehistoryawsiamcredentialreportFields[24].Descriptor()
+	// bronzehistoryawsiamcredentialreport.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzehistoryawsiamcredentialreport.AccountIDValidator = bronzehistoryawsiamcredentialreportDescAccountID.Validators[0].(func(string) error)
+	bronzehistoryawsiamgroupFields := schema.BronzeHistoryAWSIAMGroup{}.Fields()
+	_ = bronzehistoryawsiamgroupFields
+	// bronzehistoryawsiamgroupDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryawsiamgroupDescResourceID := bronzehistoryawsiamgroupFields[1].Descriptor()
+	// bronzehistoryawsiamgroup.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryawsiamgroup.ResourceIDValidator = bronzehistoryawsiamgroupDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryawsiamgroupDescArn is the schema descriptor for arn field.
+	bronzehistoryawsiamgroupDescArn := bronzehistoryawsiamgroupFields[2].Descriptor()
+	// bronzehistoryawsiamgroup.ArnValidator is a validator for the "arn" field. It is called by the builders before save.
+	bronzehistoryawsiamgroup.ArnValidator = bronzehistoryawsiamgroupDescArn.Validators[0].(func(string) error)
+	// bronzehistoryawsiamgroupDescGroupName is the schema descriptor for group_name field.
+	bronzehistoryawsiamgroupDescGroupName := bronzehistoryawsiamgroupFields[3].Descriptor()
+	// bronzehistoryawsiamgroup.GroupNameValidator is a validator for the "group_name" field. It is called by the builders before save.
+	bronzehistoryawsiamgroup.GroupNameValidator = bronzehistoryawsiamgroupDescGroupName.Validators[0].(func(string) error)
+	// bronzehistoryawsiamgroupDescAccountID is the schema descriptor for account_id field.
+	bronzehistoryawsiamgroupDescAccountID := bronzehistoryawsiamgroupFields[8].Descriptor()
+	// bronzehistoryawsiamgroup.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzehistoryawsiamgroup.AccountIDValidator = bronzehistoryawsiamgroupDescAccountID.Validators[0].(func(string) error)
+	bronzehistoryawsiampolicyFields := schema.BronzeHistoryAWSIAMPolicy{}.Fields()
+	_ = bronzehistoryawsiampolicyFields
+	// bronzehistoryawsiampolicyDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryawsiampolicyDescResourceID := bronzehistoryawsiampolicyFields[1].Descriptor()
+	// bronzehistoryawsiampolicy.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryawsiampolicy.ResourceIDValidator = bronzehistoryawsiampolicyDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryawsiampolicyDescArn is the schema descriptor for arn field.
+	bronzehistoryawsiampolicyDescArn := bronzehistoryawsiampolicyFields[2].Descriptor()
+	// bronzehistoryawsiampolicy.ArnValidator is a validator for the "arn" field. It is called by the builders before save.
+	bronzehistoryawsiampolicy.ArnValidator = bronzehistoryawsiampolicyDescArn.Validators[0].(func(string) error)
+	// bronzehistoryawsiampolicyDescPolicyName is the schema descriptor for policy_name field.
+	bronzehistoryawsiampolicyDescPolicyName := bronzehistoryawsiampolicyFields[3].Descriptor()
+	// bronzehistoryawsiampolicy.PolicyNameValidator is a validator for the "policy_name" field. It is called by the builders before save.
+	bronzehistoryawsiampolicy.PolicyNameValidator = bronzehistoryawsiampolicyDescPolicyName.Validators[0].(func(string) error)
+	// bronzehistoryawsiampolicyDescAttachmentCount is the schema descriptor for attachment_count field.
+	bronzehistoryawsiampolicyDescAttachmentCount := bronzehistoryawsiampolicyFields[7].Descriptor()
+	// bronzehistoryawsiampolicy.DefaultAttachmentCount holds the default value on creation for the attachment_count field.
+	bronzehistoryawsiampolicy.DefaultAttachmentCount = bronzehistoryawsiampolicyDescAttachmentCount.Default.(int)
+	// bronzehistoryawsiampolicyDescPermissionsBoundaryUsageCount is the schema descriptor for permissions_boundary_usage_count field.
+	bronzehistoryawsiampolicyDescPermissionsBoundaryUsageCount := bronzehistoryawsiampolicyFields[8].Descriptor()
+	// bronzehistoryawsiampolicy.DefaultPermissionsBoundaryUsageCount holds the default value on creation for the permissions_boundary_usage_count field.
+	bronzehistoryawsiampolicy.DefaultPermissionsBoundaryUsageCount = bronzehistoryawsiampolicyDescPermissionsBoundaryUsageCount.Default.(int)
+	// bronzehistoryawsiampolicyDescIsAttachable is the schema descriptor for is_attachable field.
+	bronzehistoryawsiampolicyDescIsAttachable := bronzehistoryawsiampolicyFields[9].Descriptor()
+	// bronzehistoryawsiampolicy.DefaultIsAttachable holds the default value on creation for the is_attachable field.
+	bronzehistoryawsiampolicy.DefaultIsAttachable = bronzehistoryawsiampolicyDescIsAttachable.Default.(bool)
+	// bronzehistoryawsiampolicyDescAWSManaged is the schema descriptor for aws_managed field.
+	bronzehistoryawsiampolicyDescAWSManaged := bronzehistoryawsiampolicyFields[10].Descriptor()
+	// bronzehistoryawsiampolicy.DefaultAWSManaged holds the default value on creation for the aws_managed field.
+	bronzehistoryawsiampolicy.DefaultAWSManaged = bronzehistoryawsiampolicyDescAWSManaged.Default.(bool)
+	// bronzehistoryawsiampolicyDescAccountID is the schema descriptor for account_id field.
+	bronzehistoryawsiampolicyDescAccountID := bronzehistoryawsiampolicyFields[14].Descriptor()
+	// bronzehistoryawsiampolicy.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzehistoryawsiampolicy.AccountIDValidator = bronzehistoryawsiampolicyDescAccountID.Validators[0].(func(string) error)
+	bronzehistoryawsiamroleFields := schema.BronzeHistoryAWSIAMRole{}.Fields()
+	_ = bronzehistoryawsiamroleFields
+	// bronzehistoryawsiamroleDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryawsiamroleDescResourceID := bronzehistoryawsiamroleFields[1].Descriptor()
+	// bronzehistoryawsiamrole.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryawsiamrole.ResourceIDValidator = bronzehistoryawsiamroleDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryawsiamroleDescArn is the schema descriptor for arn field.
+	bronzehistoryawsiamroleDescArn := bronzehistoryawsiamroleFields[2].Descriptor()
+	// bronzehistoryawsiamrole.ArnValidator is a validator for the "arn" field. It is called by the builders before save.
+	bronzehistoryawsiamrole.ArnValidator = bronzehistoryawsiamroleDescArn.Validators[0].(func(string) error)
+	// bronzehistoryawsiamroleDescRoleName is the schema descriptor for role_name field.
+	bronzehistoryawsiamroleDescRoleName := bronzehistoryawsiamroleFields[3].Descriptor()
+	// bronzehistoryawsiamrole.RoleNameValidator is a validator for the "role_name" field. It is called by the builders before save.
+	bronzehistoryawsiamrole.RoleNameValidator = bronzehistoryawsiamroleDescRoleName.Validators[0].(func(string) error)
+	// bronzehistoryawsiamroleDescAccountID is the schema descriptor for account_id field.
+	bronzehistoryawsiamroleDescAccountID := bronzehistoryawsiamroleFields[14].Descriptor()
+	// bronzehistoryawsiamrole.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzehistoryawsiamrole.AccountIDValidator = bronzehistoryawsiamroleDescAccountID.Validators[0].(func(string) error)
+	bronzehistoryawsiamuserFields := schema.BronzeHistoryAWSIAMUser{}.Fields()
+	_ = bronzehistoryawsiamuserFields
+	// bronzehistoryawsiamuserDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryawsiamuserDescResourceID := bronzehistoryawsiamuserFields[1].Descriptor()
+	// bronzehistoryawsiamuser.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryawsiamuser.ResourceIDValidator = bronzehistoryawsiamuserDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryawsiamuserDescArn is the schema descriptor for arn field.
+	bronzehistoryawsiamuserDescArn := bronzehistoryawsiamuserFields[2].Descriptor()
+	// bronzehistoryawsiamuser.ArnValidator is a validator for the "arn" field. It is called by the builders before save.
+	bronzehistoryawsiamuser.ArnValidator = bronzehistoryawsiamuserDescArn.Validators[0].(func(string) error)
+	// bronzehistoryawsiamuserDescUserName is the schema descriptor for user_name field.
+	bronzehistoryawsiamuserDescUserName := bronzehistoryawsiamuserFields[3].Descriptor()
+	// bronzehistoryawsiamuser.UserNameValidator is a validator for the "user_name" field. It is called by the builders before save.
+	bronzehistoryawsiamuser.UserNameValidator = bronzehistoryawsiamuserDescUserName.Validators[0].(func(string) error)
+	// bronzehistoryawsiamuserDescAccountID is the schema descriptor for account_id field.
+	bronzehistoryawsiamuserDescAccountID := bronzehistoryawsiamuserFields[12].Descriptor()
+	// bronzehistoryawsiamuser.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzehistoryawsiamuser.AccountIDValidator = bronzehistoryawsiamuserDescAccountID.Validators[0].(func(string) error)
+}
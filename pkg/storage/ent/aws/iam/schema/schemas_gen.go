@@ -0,0 +1,55 @@
+// Code generated by entcgen. DO NOT EDIT.
+package schema
+
+import (
+	bronze_aws_iam "danny.vn/hotpot/pkg/schema/bronze/aws/iam"
+	bronzehistory_aws_iam "danny.vn/hotpot/pkg/schema/bronzehistory/aws/iam"
+)
+
+type BronzeAWSIAMAccessKey struct {
+	bronze_aws_iam.BronzeAWSIAMAccessKey
+}
+
+type BronzeAWSIAMCredentialReport struct {
+	bronze_aws_iam.BronzeAWSIAMCredentialReport
+}
+
+type BronzeAWSIAMGroup struct {
+	bronze_aws_iam.BronzeAWSIAMGroup
+}
+
+type BronzeAWSIAMPolicy struct {
+	bronze_aws_iam.BronzeAWSIAMPolicy
+}
+
+type BronzeAWSIAMRole struct {
+	bronze_aws_iam.BronzeAWSIAMRole
+}
+
+type BronzeAWSIAMUser struct {
+	bronze_aws_iam.BronzeAWSIAMUser
+}
+
+type BronzeHistoryAWSIAMAccessKey struct {
+	bronzehistory_aws_iam.BronzeHistoryAWSIAMAccessKey
+}
+
+type BronzeHistoryAWSIAMCredentialReport struct {
+	bronzehistory_aws_iam.BronzeHistoryAWSIAMCredentialReport
+}
+
+type BronzeHistoryAWSIAMGroup struct {
+	bronzehistory_aws_iam.BronzeHistoryAWSIAMGroup
+}
+
+type BronzeHistoryAWSIAMPolicy struct {
+	bronzehistory_aws_iam.BronzeHistoryAWSIAMPolicy
+}
+
+type BronzeHistoryAWSIAMRole struct {
+	bronzehistory_aws_iam.BronzeHistoryAWSIAMRole
+}
+
+type BronzeHistoryAWSIAMUser struct {
+	bronzehistory_aws_iam.BronzeHistoryAWSIAMUser
+}
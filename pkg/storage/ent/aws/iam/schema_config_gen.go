@@ -0,0 +1,20 @@
+// Code generated by entcgen. DO NOT EDIT.
+package iam
+
+// DefaultSchemaConfig returns the schema config mapping each type to its PG schema.
+func DefaultSchemaConfig() SchemaConfig {
+	return SchemaConfig{
+		BronzeAWSIAMAccessKey:               "bronze",
+		BronzeAWSIAMCredentialReport:        "bronze",
+		BronzeAWSIAMGroup:                   "bronze",
+		BronzeAWSIAMPolicy:                  "bronze",
+		BronzeAWSIAMRole:                    "bronze",
+		BronzeAWSIAMUser:                    "bronze",
+		BronzeHistoryAWSIAMAccessKey:        "bronze_history",
+		BronzeHistoryAWSIAMCredentialReport: "bronze_history",
+		BronzeHistoryAWSIAMGroup:            "bronze_history",
+		BronzeHistoryAWSIAMPolicy:           "bronze_history",
+		BronzeHistoryAWSIAMRole:             "bronze_history",
+		BronzeHistoryAWSIAMUser:             "bronze_history",
+	}
+}
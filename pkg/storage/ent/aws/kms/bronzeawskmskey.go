@@ -0,0 +1,353 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kms
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/bronzeawskmskey"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeAWSKMSKey is the model entity for the BronzeAWSKMSKey schema.
+type BronzeAWSKMSKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	// KMS key ID (UUID), used as primary key
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Arn holds the value of the "arn" field.
+	Arn string `json:"arn,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Enabled, Disabled, PendingDeletion, PendingImport, Unavailable, ...
+	KeyState string `json:"key_state,omitempty"`
+	// ENCRYPT_DECRYPT, SIGN_VERIFY, GENERATE_VERIFY_MAC or KEY_AGREEMENT
+	KeyUsage string `json:"key_usage,omitempty"`
+	// SYMMETRIC_DEFAULT, RSA_2048, ECC_NIST_P256, HMAC_256, ...
+	KeySpec string `json:"key_spec,omitempty"`
+	// AWS or CUSTOMER
+	KeyManager string `json:"key_manager,omitempty"`
+	// AWS_KMS, EXTERNAL, AWS_CLOUDHSM or EXTERNAL_KEY_STORE
+	Origin string `json:"origin,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// MultiRegion holds the value of the "multi_region" field.
+	MultiRegion bool `json:"multi_region,omitempty"`
+	// CreationDate holds the value of the "creation_date" field.
+	CreationDate *time.Time `json:"creation_date,omitempty"`
+	// Scheduled deletion date when key_state is PendingDeletion
+	DeletionDate *time.Time `json:"deletion_date,omitempty"`
+	// Automatic rotation status, nil when the key does not support rotation
+	RotationEnabled *bool `json:"rotation_enabled,omitempty"`
+	// RotationPeriodInDays holds the value of the "rotation_period_in_days" field.
+	RotationPeriodInDays *int32 `json:"rotation_period_in_days,omitempty"`
+	// NextRotationDate holds the value of the "next_rotation_date" field.
+	NextRotationDate *time.Time `json:"next_rotation_date,omitempty"`
+	// PolicyJSON holds the value of the "policy_json" field.
+	PolicyJSON json.RawMessage `json:"policy_json,omitempty"`
+	// AliasesJSON holds the value of the "aliases_json" field.
+	AliasesJSON json.RawMessage `json:"aliases_json,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Region holds the value of the "region" field.
+	Region       string `json:"region,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeAWSKMSKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawskmskey.FieldPolicyJSON, bronzeawskmskey.FieldAliasesJSON, bronzeawskmskey.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzeawskmskey.FieldEnabled, bronzeawskmskey.FieldMultiRegion, bronzeawskmskey.FieldRotationEnabled:
+			values[i] = new(sql.NullBool)
+		case bronzeawskmskey.FieldRotationPeriodInDays:
+			values[i] = new(sql.NullInt64)
+		case bronzeawskmskey.FieldID, bronzeawskmskey.FieldArn, bronzeawskmskey.FieldDescription, bronzeawskmskey.FieldKeyState, bronzeawskmskey.FieldKeyUsage, bronzeawskmskey.FieldKeySpec, bronzeawskmskey.FieldKeyManager, bronzeawskmskey.FieldOrigin, bronzeawskmskey.FieldAccountID, bronzeawskmskey.FieldRegion:
+			values[i] = new(sql.NullString)
+		case bronzeawskmskey.FieldCollectedAt, bronzeawskmskey.FieldFirstCollectedAt, bronzeawskmskey.FieldCreationDate, bronzeawskmskey.FieldDeletionDate, bronzeawskmskey.FieldNextRotationDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeAWSKMSKey fields.
+func (_m *BronzeAWSKMSKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzeawskmskey.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzeawskmskey.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzeawskmskey.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzeawskmskey.FieldArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field arn", values[i])
+			} else if value.Valid {
+				_m.Arn = value.String
+			}
+		case bronzeawskmskey.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case bronzeawskmskey.FieldKeyState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_state", values[i])
+			} else if value.Valid {
+				_m.KeyState = value.String
+			}
+		case bronzeawskmskey.FieldKeyUsage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_usage", values[i])
+			} else if value.Valid {
+				_m.KeyUsage = value.String
+			}
+		case bronzeawskmskey.FieldKeySpec:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_spec", values[i])
+			} else if value.Valid {
+				_m.KeySpec = value.String
+			}
+		case bronzeawskmskey.FieldKeyManager:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_manager", values[i])
+			} else if value.Valid {
+				_m.KeyManager = value.String
+			}
+		case bronzeawskmskey.FieldOrigin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field origin", values[i])
+			} else if value.Valid {
+				_m.Origin = value.String
+			}
+		case bronzeawskmskey.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case bronzeawskmskey.FieldMultiRegion:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field multi_region", values[i])
+			} else if value.Valid {
+				_m.MultiRegion = value.Bool
+			}
+		case bronzeawskmskey.FieldCreationDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field creation_date", values[i])
+			} else if value.Valid {
+				_m.CreationDate = new(time.Time)
+				*_m.CreationDate = value.Time
+			}
+		case bronzeawskmskey.FieldDeletionDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_date", values[i])
+			} else if value.Valid {
+				_m.DeletionDate = new(time.Time)
+				*_m.DeletionDate = value.Time
+			}
+		case bronzeawskmskey.FieldRotationEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field rotation_enabled", values[i])
+			} else if value.Valid {
+				_m.RotationEnabled = new(bool)
+				*_m.RotationEnabled = value.Bool
+			}
+		case bronzeawskmskey.FieldRotationPeriodInDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rotation_period_in_days", values[i])
+			} else if value.Valid {
+				_m.RotationPeriodInDays = new(int32)
+				*_m.RotationPeriodInDays = int32(value.Int64)
+			}
+		case bronzeawskmskey.FieldNextRotationDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_rotation_date", values[i])
+			} else if value.Valid {
+				_m.NextRotationDate = new(time.Time)
+				*_m.NextRotationDate = value.Time
+			}
+		case bronzeawskmskey.FieldPolicyJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PolicyJSON); err != nil {
+					return fmt.Errorf("unmarshal field policy_json: %w", err)
+				}
+			}
+		case bronzeawskmskey.FieldAliasesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field aliases_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AliasesJSON); err != nil {
+					return fmt.Errorf("unmarshal field aliases_json: %w", err)
+				}
+			}
+		case bronzeawskmskey.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzeawskmskey.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzeawskmskey.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeAWSKMSKey.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeAWSKMSKey) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeAWSKMSKey.
+// Note that you need to call BronzeAWSKMSKey.Unwrap() before calling this method if this BronzeAWSKMSKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeAWSKMSKey) Update() *BronzeAWSKMSKeyUpdateOne {
+	return NewBronzeAWSKMSKeyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeAWSKMSKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeAWSKMSKey) Unwrap() *BronzeAWSKMSKey {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("kms: BronzeAWSKMSKey is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeAWSKMSKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeAWSKMSKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("arn=")
+	builder.WriteString(_m.Arn)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("key_state=")
+	builder.WriteString(_m.KeyState)
+	builder.WriteString(", ")
+	builder.WriteString("key_usage=")
+	builder.WriteString(_m.KeyUsage)
+	builder.WriteString(", ")
+	builder.WriteString("key_spec=")
+	builder.WriteString(_m.KeySpec)
+	builder.WriteString(", ")
+	builder.WriteString("key_manager=")
+	builder.WriteString(_m.KeyManager)
+	builder.WriteString(", ")
+	builder.WriteString("origin=")
+	builder.WriteString(_m.Origin)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("multi_region=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MultiRegion))
+	builder.WriteString(", ")
+	if v := _m.CreationDate; v != nil {
+		builder.WriteString("creation_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletionDate; v != nil {
+		builder.WriteString("deletion_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RotationEnabled; v != nil {
+		builder.WriteString("rotation_enabled=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RotationPeriodInDays; v != nil {
+		builder.WriteString("rotation_period_in_days=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.NextRotationDate; v != nil {
+		builder.WriteString("next_rotation_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("policy_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PolicyJSON))
+	builder.WriteString(", ")
+	builder.WriteString("aliases_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AliasesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeAWSKMSKeys is a parsable slice of BronzeAWSKMSKey.
+type BronzeAWSKMSKeys []*BronzeAWSKMSKey
@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawskmskey
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzeawskmskey type in the database.
+	Label = "bronze_awskms_key"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldArn holds the string denoting the arn field in the database.
+	FieldArn = "arn"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldKeyState holds the string denoting the key_state field in the database.
+	FieldKeyState = "key_state"
+	// FieldKeyUsage holds the string denoting the key_usage field in the database.
+	FieldKeyUsage = "key_usage"
+	// FieldKeySpec holds the string denoting the key_spec field in the database.
+	FieldKeySpec = "key_spec"
+	// FieldKeyManager holds the string denoting the key_manager field in the database.
+	FieldKeyManager = "key_manager"
+	// FieldOrigin holds the string denoting the origin field in the database.
+	FieldOrigin = "origin"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldMultiRegion holds the string denoting the multi_region field in the database.
+	FieldMultiRegion = "multi_region"
+	// FieldCreationDate holds the string denoting the creation_date field in the database.
+	FieldCreationDate = "creation_date"
+	// FieldDeletionDate holds the string denoting the deletion_date field in the database.
+	FieldDeletionDate = "deletion_date"
+	// FieldRotationEnabled holds the string denoting the rotation_enabled field in the database.
+	FieldRotationEnabled = "rotation_enabled"
+	// FieldRotationPeriodInDays holds the string denoting the rotation_period_in_days field in the database.
+	FieldRotationPeriodInDays = "rotation_period_in_days"
+	// FieldNextRotationDate holds the string denoting the next_rotation_date field in the database.
+	FieldNextRotationDate = "next_rotation_date"
+	// FieldPolicyJSON holds the string denoting the policy_json field in the database.
+	FieldPolicyJSON = "policy_json"
+	// FieldAliasesJSON holds the string denoting the aliases_json field in the database.
+	FieldAliasesJSON = "aliases_json"
+	// FieldTagsJSON holds the string denoting the tags_json field in the database.
+	FieldTagsJSON = "tags_json"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
+	// Table holds the table name of the bronzeawskmskey in the database.
+	Table = "aws_kms_keys"
+)
+
+// Columns holds all SQL columns for bronzeawskmskey fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldArn,
+	FieldDescription,
+	FieldKeyState,
+	FieldKeyUsage,
+	FieldKeySpec,
+	FieldKeyManager,
+	FieldOrigin,
+	FieldEnabled,
+	FieldMultiRegion,
+	FieldCreationDate,
+	FieldDeletionDate,
+	FieldRotationEnabled,
+	FieldRotationPeriodInDays,
+	FieldNextRotationDate,
+	FieldPolicyJSON,
+	FieldAliasesJSON,
+	FieldTagsJSON,
+	FieldAccountID,
+	FieldRegion,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	AccountIDValidator func(string) error
+	// RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	RegionValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BronzeAWSKMSKey queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByArn orders the results by the arn field.
+func ByArn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArn, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByKeyState orders the results by the key_state field.
+func ByKeyState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyState, opts...).ToFunc()
+}
+
+// ByKeyUsage orders the results by the key_usage field.
+func ByKeyUsage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyUsage, opts...).ToFunc()
+}
+
+// ByKeySpec orders the results by the key_spec field.
+func ByKeySpec(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeySpec, opts...).ToFunc()
+}
+
+// ByKeyManager orders the results by the key_manager field.
+func ByKeyManager(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyManager, opts...).ToFunc()
+}
+
+// ByOrigin orders the results by the origin field.
+func ByOrigin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrigin, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByMultiRegion orders the results by the multi_region field.
+func ByMultiRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMultiRegion, opts...).ToFunc()
+}
+
+// ByCreationDate orders the results by the creation_date field.
+func ByCreationDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreationDate, opts...).ToFunc()
+}
+
+// ByDeletionDate orders the results by the deletion_date field.
+func ByDeletionDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionDate, opts...).ToFunc()
+}
+
+// ByRotationEnabled orders the results by the rotation_enabled field.
+func ByRotationEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRotationEnabled, opts...).ToFunc()
+}
+
+// ByRotationPeriodInDays orders the results by the rotation_period_in_days field.
+func ByRotationPeriodInDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRotationPeriodInDays, opts...).ToFunc()
+}
+
+// ByNextRotationDate orders the results by the next_rotation_date field.
+func ByNextRotationDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextRotationDate, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByRegion orders the results by the region field.
+func ByRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegion, opts...).ToFunc()
+}
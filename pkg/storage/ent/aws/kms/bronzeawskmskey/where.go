@@ -0,0 +1,1195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawskmskey
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// Arn applies equality check predicate on the "arn" field. It's identical to ArnEQ.
+func Arn(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldArn, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldDescription, v))
+}
+
+// KeyState applies equality check predicate on the "key_state" field. It's identical to KeyStateEQ.
+func KeyState(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldKeyState, v))
+}
+
+// KeyUsage applies equality check predicate on the "key_usage" field. It's identical to KeyUsageEQ.
+func KeyUsage(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldKeyUsage, v))
+}
+
+// KeySpec applies equality check predicate on the "key_spec" field. It's identical to KeySpecEQ.
+func KeySpec(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldKeySpec, v))
+}
+
+// KeyManager applies equality check predicate on the "key_manager" field. It's identical to KeyManagerEQ.
+func KeyManager(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldKeyManager, v))
+}
+
+// Origin applies equality check predicate on the "origin" field. It's identical to OriginEQ.
+func Origin(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldOrigin, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldEnabled, v))
+}
+
+// MultiRegion applies equality check predicate on the "multi_region" field. It's identical to MultiRegionEQ.
+func MultiRegion(v bool) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldMultiRegion, v))
+}
+
+// CreationDate applies equality check predicate on the "creation_date" field. It's identical to CreationDateEQ.
+func CreationDate(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldCreationDate, v))
+}
+
+// DeletionDate applies equality check predicate on the "deletion_date" field. It's identical to DeletionDateEQ.
+func DeletionDate(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldDeletionDate, v))
+}
+
+// RotationEnabled applies equality check predicate on the "rotation_enabled" field. It's identical to RotationEnabledEQ.
+func RotationEnabled(v bool) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldRotationEnabled, v))
+}
+
+// RotationPeriodInDays applies equality check predicate on the "rotation_period_in_days" field. It's identical to RotationPeriodInDaysEQ.
+func RotationPeriodInDays(v int32) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldRotationPeriodInDays, v))
+}
+
+// NextRotationDate applies equality check predicate on the "next_rotation_date" field. It's identical to NextRotationDateEQ.
+func NextRotationDate(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldNextRotationDate, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldRegion, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ArnEQ applies the EQ predicate on the "arn" field.
+func ArnEQ(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldArn, v))
+}
+
+// ArnNEQ applies the NEQ predicate on the "arn" field.
+func ArnNEQ(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNEQ(FieldArn, v))
+}
+
+// ArnIn applies the In predicate on the "arn" field.
+func ArnIn(vs ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIn(FieldArn, vs...))
+}
+
+// ArnNotIn applies the NotIn predicate on the "arn" field.
+func ArnNotIn(vs ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotIn(FieldArn, vs...))
+}
+
+// ArnGT applies the GT predicate on the "arn" field.
+func ArnGT(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGT(FieldArn, v))
+}
+
+// ArnGTE applies the GTE predicate on the "arn" field.
+func ArnGTE(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGTE(FieldArn, v))
+}
+
+// ArnLT applies the LT predicate on the "arn" field.
+func ArnLT(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLT(FieldArn, v))
+}
+
+// ArnLTE applies the LTE predicate on the "arn" field.
+func ArnLTE(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLTE(FieldArn, v))
+}
+
+// ArnContains applies the Contains predicate on the "arn" field.
+func ArnContains(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldContains(FieldArn, v))
+}
+
+// ArnHasPrefix applies the HasPrefix predicate on the "arn" field.
+func ArnHasPrefix(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldHasPrefix(FieldArn, v))
+}
+
+// ArnHasSuffix applies the HasSuffix predicate on the "arn" field.
+func ArnHasSuffix(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldHasSuffix(FieldArn, v))
+}
+
+// ArnIsNil applies the IsNil predicate on the "arn" field.
+func ArnIsNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIsNull(FieldArn))
+}
+
+// ArnNotNil applies the NotNil predicate on the "arn" field.
+func ArnNotNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotNull(FieldArn))
+}
+
+// ArnEqualFold applies the EqualFold predicate on the "arn" field.
+func ArnEqualFold(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEqualFold(FieldArn, v))
+}
+
+// ArnContainsFold applies the ContainsFold predicate on the "arn" field.
+func ArnContainsFold(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldContainsFold(FieldArn, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// KeyStateEQ applies the EQ predicate on the "key_state" field.
+func KeyStateEQ(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldKeyState, v))
+}
+
+// KeyStateNEQ applies the NEQ predicate on the "key_state" field.
+func KeyStateNEQ(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNEQ(FieldKeyState, v))
+}
+
+// KeyStateIn applies the In predicate on the "key_state" field.
+func KeyStateIn(vs ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIn(FieldKeyState, vs...))
+}
+
+// KeyStateNotIn applies the NotIn predicate on the "key_state" field.
+func KeyStateNotIn(vs ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotIn(FieldKeyState, vs...))
+}
+
+// KeyStateGT applies the GT predicate on the "key_state" field.
+func KeyStateGT(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGT(FieldKeyState, v))
+}
+
+// KeyStateGTE applies the GTE predicate on the "key_state" field.
+func KeyStateGTE(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGTE(FieldKeyState, v))
+}
+
+// KeyStateLT applies the LT predicate on the "key_state" field.
+func KeyStateLT(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLT(FieldKeyState, v))
+}
+
+// KeyStateLTE applies the LTE predicate on the "key_state" field.
+func KeyStateLTE(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLTE(FieldKeyState, v))
+}
+
+// KeyStateContains applies the Contains predicate on the "key_state" field.
+func KeyStateContains(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldContains(FieldKeyState, v))
+}
+
+// KeyStateHasPrefix applies the HasPrefix predicate on the "key_state" field.
+func KeyStateHasPrefix(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldHasPrefix(FieldKeyState, v))
+}
+
+// KeyStateHasSuffix applies the HasSuffix predicate on the "key_state" field.
+func KeyStateHasSuffix(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldHasSuffix(FieldKeyState, v))
+}
+
+// KeyStateIsNil applies the IsNil predicate on the "key_state" field.
+func KeyStateIsNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIsNull(FieldKeyState))
+}
+
+// KeyStateNotNil applies the NotNil predicate on the "key_state" field.
+func KeyStateNotNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotNull(FieldKeyState))
+}
+
+// KeyStateEqualFold applies the EqualFold predicate on the "key_state" field.
+func KeyStateEqualFold(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEqualFold(FieldKeyState, v))
+}
+
+// KeyStateContainsFold applies the ContainsFold predicate on the "key_state" field.
+func KeyStateContainsFold(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldContainsFold(FieldKeyState, v))
+}
+
+// KeyUsageEQ applies the EQ predicate on the "key_usage" field.
+func KeyUsageEQ(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldKeyUsage, v))
+}
+
+// KeyUsageNEQ applies the NEQ predicate on the "key_usage" field.
+func KeyUsageNEQ(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNEQ(FieldKeyUsage, v))
+}
+
+// KeyUsageIn applies the In predicate on the "key_usage" field.
+func KeyUsageIn(vs ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIn(FieldKeyUsage, vs...))
+}
+
+// KeyUsageNotIn applies the NotIn predicate on the "key_usage" field.
+func KeyUsageNotIn(vs ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotIn(FieldKeyUsage, vs...))
+}
+
+// KeyUsageGT applies the GT predicate on the "key_usage" field.
+func KeyUsageGT(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGT(FieldKeyUsage, v))
+}
+
+// KeyUsageGTE applies the GTE predicate on the "key_usage" field.
+func KeyUsageGTE(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGTE(FieldKeyUsage, v))
+}
+
+// KeyUsageLT applies the LT predicate on the "key_usage" field.
+func KeyUsageLT(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLT(FieldKeyUsage, v))
+}
+
+// KeyUsageLTE applies the LTE predicate on the "key_usage" field.
+func KeyUsageLTE(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLTE(FieldKeyUsage, v))
+}
+
+// KeyUsageContains applies the Contains predicate on the "key_usage" field.
+func KeyUsageContains(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldContains(FieldKeyUsage, v))
+}
+
+// KeyUsageHasPrefix applies the HasPrefix predicate on the "key_usage" field.
+func KeyUsageHasPrefix(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldHasPrefix(FieldKeyUsage, v))
+}
+
+// KeyUsageHasSuffix applies the HasSuffix predicate on the "key_usage" field.
+func KeyUsageHasSuffix(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldHasSuffix(FieldKeyUsage, v))
+}
+
+// KeyUsageIsNil applies the IsNil predicate on the "key_usage" field.
+func KeyUsageIsNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIsNull(FieldKeyUsage))
+}
+
+// KeyUsageNotNil applies the NotNil predicate on the "key_usage" field.
+func KeyUsageNotNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotNull(FieldKeyUsage))
+}
+
+// KeyUsageEqualFold applies the EqualFold predicate on the "key_usage" field.
+func KeyUsageEqualFold(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEqualFold(FieldKeyUsage, v))
+}
+
+// KeyUsageContainsFold applies the ContainsFold predicate on the "key_usage" field.
+func KeyUsageContainsFold(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldContainsFold(FieldKeyUsage, v))
+}
+
+// KeySpecEQ applies the EQ predicate on the "key_spec" field.
+func KeySpecEQ(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldKeySpec, v))
+}
+
+// KeySpecNEQ applies the NEQ predicate on the "key_spec" field.
+func KeySpecNEQ(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNEQ(FieldKeySpec, v))
+}
+
+// KeySpecIn applies the In predicate on the "key_spec" field.
+func KeySpecIn(vs ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIn(FieldKeySpec, vs...))
+}
+
+// KeySpecNotIn applies the NotIn predicate on the "key_spec" field.
+func KeySpecNotIn(vs ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotIn(FieldKeySpec, vs...))
+}
+
+// KeySpecGT applies the GT predicate on the "key_spec" field.
+func KeySpecGT(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGT(FieldKeySpec, v))
+}
+
+// KeySpecGTE applies the GTE predicate on the "key_spec" field.
+func KeySpecGTE(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGTE(FieldKeySpec, v))
+}
+
+// KeySpecLT applies the LT predicate on the "key_spec" field.
+func KeySpecLT(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLT(FieldKeySpec, v))
+}
+
+// KeySpecLTE applies the LTE predicate on the "key_spec" field.
+func KeySpecLTE(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLTE(FieldKeySpec, v))
+}
+
+// KeySpecContains applies the Contains predicate on the "key_spec" field.
+func KeySpecContains(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldContains(FieldKeySpec, v))
+}
+
+// KeySpecHasPrefix applies the HasPrefix predicate on the "key_spec" field.
+func KeySpecHasPrefix(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldHasPrefix(FieldKeySpec, v))
+}
+
+// KeySpecHasSuffix applies the HasSuffix predicate on the "key_spec" field.
+func KeySpecHasSuffix(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldHasSuffix(FieldKeySpec, v))
+}
+
+// KeySpecIsNil applies the IsNil predicate on the "key_spec" field.
+func KeySpecIsNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIsNull(FieldKeySpec))
+}
+
+// KeySpecNotNil applies the NotNil predicate on the "key_spec" field.
+func KeySpecNotNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotNull(FieldKeySpec))
+}
+
+// KeySpecEqualFold applies the EqualFold predicate on the "key_spec" field.
+func KeySpecEqualFold(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEqualFold(FieldKeySpec, v))
+}
+
+// KeySpecContainsFold applies the ContainsFold predicate on the "key_spec" field.
+func KeySpecContainsFold(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldContainsFold(FieldKeySpec, v))
+}
+
+// KeyManagerEQ applies the EQ predicate on the "key_manager" field.
+func KeyManagerEQ(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldKeyManager, v))
+}
+
+// KeyManagerNEQ applies the NEQ predicate on the "key_manager" field.
+func KeyManagerNEQ(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNEQ(FieldKeyManager, v))
+}
+
+// KeyManagerIn applies the In predicate on the "key_manager" field.
+func KeyManagerIn(vs ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIn(FieldKeyManager, vs...))
+}
+
+// KeyManagerNotIn applies the NotIn predicate on the "key_manager" field.
+func KeyManagerNotIn(vs ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotIn(FieldKeyManager, vs...))
+}
+
+// KeyManagerGT applies the GT predicate on the "key_manager" field.
+func KeyManagerGT(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGT(FieldKeyManager, v))
+}
+
+// KeyManagerGTE applies the GTE predicate on the "key_manager" field.
+func KeyManagerGTE(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGTE(FieldKeyManager, v))
+}
+
+// KeyManagerLT applies the LT predicate on the "key_manager" field.
+func KeyManagerLT(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLT(FieldKeyManager, v))
+}
+
+// KeyManagerLTE applies the LTE predicate on the "key_manager" field.
+func KeyManagerLTE(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLTE(FieldKeyManager, v))
+}
+
+// KeyManagerContains applies the Contains predicate on the "key_manager" field.
+func KeyManagerContains(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldContains(FieldKeyManager, v))
+}
+
+// KeyManagerHasPrefix applies the HasPrefix predicate on the "key_manager" field.
+func KeyManagerHasPrefix(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldHasPrefix(FieldKeyManager, v))
+}
+
+// KeyManagerHasSuffix applies the HasSuffix predicate on the "key_manager" field.
+func KeyManagerHasSuffix(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldHasSuffix(FieldKeyManager, v))
+}
+
+// KeyManagerIsNil applies the IsNil predicate on the "key_manager" field.
+func KeyManagerIsNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIsNull(FieldKeyManager))
+}
+
+// KeyManagerNotNil applies the NotNil predicate on the "key_manager" field.
+func KeyManagerNotNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotNull(FieldKeyManager))
+}
+
+// KeyManagerEqualFold applies the EqualFold predicate on the "key_manager" field.
+func KeyManagerEqualFold(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEqualFold(FieldKeyManager, v))
+}
+
+// KeyManagerContainsFold applies the ContainsFold predicate on the "key_manager" field.
+func KeyManagerContainsFold(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldContainsFold(FieldKeyManager, v))
+}
+
+// OriginEQ applies the EQ predicate on the "origin" field.
+func OriginEQ(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldOrigin, v))
+}
+
+// OriginNEQ applies the NEQ predicate on the "origin" field.
+func OriginNEQ(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNEQ(FieldOrigin, v))
+}
+
+// OriginIn applies the In predicate on the "origin" field.
+func OriginIn(vs ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIn(FieldOrigin, vs...))
+}
+
+// OriginNotIn applies the NotIn predicate on the "origin" field.
+func OriginNotIn(vs ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotIn(FieldOrigin, vs...))
+}
+
+// OriginGT applies the GT predicate on the "origin" field.
+func OriginGT(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGT(FieldOrigin, v))
+}
+
+// OriginGTE applies the GTE predicate on the "origin" field.
+func OriginGTE(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGTE(FieldOrigin, v))
+}
+
+// OriginLT applies the LT predicate on the "origin" field.
+func OriginLT(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLT(FieldOrigin, v))
+}
+
+// OriginLTE applies the LTE predicate on the "origin" field.
+func OriginLTE(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLTE(FieldOrigin, v))
+}
+
+// OriginContains applies the Contains predicate on the "origin" field.
+func OriginContains(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldContains(FieldOrigin, v))
+}
+
+// OriginHasPrefix applies the HasPrefix predicate on the "origin" field.
+func OriginHasPrefix(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldHasPrefix(FieldOrigin, v))
+}
+
+// OriginHasSuffix applies the HasSuffix predicate on the "origin" field.
+func OriginHasSuffix(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldHasSuffix(FieldOrigin, v))
+}
+
+// OriginIsNil applies the IsNil predicate on the "origin" field.
+func OriginIsNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIsNull(FieldOrigin))
+}
+
+// OriginNotNil applies the NotNil predicate on the "origin" field.
+func OriginNotNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotNull(FieldOrigin))
+}
+
+// OriginEqualFold applies the EqualFold predicate on the "origin" field.
+func OriginEqualFold(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEqualFold(FieldOrigin, v))
+}
+
+// OriginContainsFold applies the ContainsFold predicate on the "origin" field.
+func OriginContainsFold(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldContainsFold(FieldOrigin, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// EnabledIsNil applies the IsNil predicate on the "enabled" field.
+func EnabledIsNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIsNull(FieldEnabled))
+}
+
+// EnabledNotNil applies the NotNil predicate on the "enabled" field.
+func EnabledNotNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotNull(FieldEnabled))
+}
+
+// MultiRegionEQ applies the EQ predicate on the "multi_region" field.
+func MultiRegionEQ(v bool) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldMultiRegion, v))
+}
+
+// MultiRegionNEQ applies the NEQ predicate on the "multi_region" field.
+func MultiRegionNEQ(v bool) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNEQ(FieldMultiRegion, v))
+}
+
+// MultiRegionIsNil applies the IsNil predicate on the "multi_region" field.
+func MultiRegionIsNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIsNull(FieldMultiRegion))
+}
+
+// MultiRegionNotNil applies the NotNil predicate on the "multi_region" field.
+func MultiRegionNotNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotNull(FieldMultiRegion))
+}
+
+// CreationDateEQ applies the EQ predicate on the "creation_date" field.
+func CreationDateEQ(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldCreationDate, v))
+}
+
+// CreationDateNEQ applies the NEQ predicate on the "creation_date" field.
+func CreationDateNEQ(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNEQ(FieldCreationDate, v))
+}
+
+// CreationDateIn applies the In predicate on the "creation_date" field.
+func CreationDateIn(vs ...time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIn(FieldCreationDate, vs...))
+}
+
+// CreationDateNotIn applies the NotIn predicate on the "creation_date" field.
+func CreationDateNotIn(vs ...time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotIn(FieldCreationDate, vs...))
+}
+
+// CreationDateGT applies the GT predicate on the "creation_date" field.
+func CreationDateGT(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGT(FieldCreationDate, v))
+}
+
+// CreationDateGTE applies the GTE predicate on the "creation_date" field.
+func CreationDateGTE(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGTE(FieldCreationDate, v))
+}
+
+// CreationDateLT applies the LT predicate on the "creation_date" field.
+func CreationDateLT(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLT(FieldCreationDate, v))
+}
+
+// CreationDateLTE applies the LTE predicate on the "creation_date" field.
+func CreationDateLTE(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLTE(FieldCreationDate, v))
+}
+
+// CreationDateIsNil applies the IsNil predicate on the "creation_date" field.
+func CreationDateIsNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIsNull(FieldCreationDate))
+}
+
+// CreationDateNotNil applies the NotNil predicate on the "creation_date" field.
+func CreationDateNotNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotNull(FieldCreationDate))
+}
+
+// DeletionDateEQ applies the EQ predicate on the "deletion_date" field.
+func DeletionDateEQ(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldDeletionDate, v))
+}
+
+// DeletionDateNEQ applies the NEQ predicate on the "deletion_date" field.
+func DeletionDateNEQ(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNEQ(FieldDeletionDate, v))
+}
+
+// DeletionDateIn applies the In predicate on the "deletion_date" field.
+func DeletionDateIn(vs ...time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIn(FieldDeletionDate, vs...))
+}
+
+// DeletionDateNotIn applies the NotIn predicate on the "deletion_date" field.
+func DeletionDateNotIn(vs ...time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotIn(FieldDeletionDate, vs...))
+}
+
+// DeletionDateGT applies the GT predicate on the "deletion_date" field.
+func DeletionDateGT(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGT(FieldDeletionDate, v))
+}
+
+// DeletionDateGTE applies the GTE predicate on the "deletion_date" field.
+func DeletionDateGTE(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGTE(FieldDeletionDate, v))
+}
+
+// DeletionDateLT applies the LT predicate on the "deletion_date" field.
+func DeletionDateLT(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLT(FieldDeletionDate, v))
+}
+
+// DeletionDateLTE applies the LTE predicate on the "deletion_date" field.
+func DeletionDateLTE(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLTE(FieldDeletionDate, v))
+}
+
+// DeletionDateIsNil applies the IsNil predicate on the "deletion_date" field.
+func DeletionDateIsNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIsNull(FieldDeletionDate))
+}
+
+// DeletionDateNotNil applies the NotNil predicate on the "deletion_date" field.
+func DeletionDateNotNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotNull(FieldDeletionDate))
+}
+
+// RotationEnabledEQ applies the EQ predicate on the "rotation_enabled" field.
+func RotationEnabledEQ(v bool) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldRotationEnabled, v))
+}
+
+// RotationEnabledNEQ applies the NEQ predicate on the "rotation_enabled" field.
+func RotationEnabledNEQ(v bool) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNEQ(FieldRotationEnabled, v))
+}
+
+// RotationEnabledIsNil applies the IsNil predicate on the "rotation_enabled" field.
+func RotationEnabledIsNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIsNull(FieldRotationEnabled))
+}
+
+// RotationEnabledNotNil applies the NotNil predicate on the "rotation_enabled" field.
+func RotationEnabledNotNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotNull(FieldRotationEnabled))
+}
+
+// RotationPeriodInDaysEQ applies the EQ predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysEQ(v int32) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldRotationPeriodInDays, v))
+}
+
+// RotationPeriodInDaysNEQ applies the NEQ predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysNEQ(v int32) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNEQ(FieldRotationPeriodInDays, v))
+}
+
+// RotationPeriodInDaysIn applies the In predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysIn(vs ...int32) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIn(FieldRotationPeriodInDays, vs...))
+}
+
+// RotationPeriodInDaysNotIn applies the NotIn predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysNotIn(vs ...int32) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotIn(FieldRotationPeriodInDays, vs...))
+}
+
+// RotationPeriodInDaysGT applies the GT predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysGT(v int32) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGT(FieldRotationPeriodInDays, v))
+}
+
+// RotationPeriodInDaysGTE applies the GTE predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysGTE(v int32) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGTE(FieldRotationPeriodInDays, v))
+}
+
+// RotationPeriodInDaysLT applies the LT predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysLT(v int32) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLT(FieldRotationPeriodInDays, v))
+}
+
+// RotationPeriodInDaysLTE applies the LTE predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysLTE(v int32) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLTE(FieldRotationPeriodInDays, v))
+}
+
+// RotationPeriodInDaysIsNil applies the IsNil predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysIsNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIsNull(FieldRotationPeriodInDays))
+}
+
+// RotationPeriodInDaysNotNil applies the NotNil predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysNotNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotNull(FieldRotationPeriodInDays))
+}
+
+// NextRotationDateEQ applies the EQ predicate on the "next_rotation_date" field.
+func NextRotationDateEQ(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldNextRotationDate, v))
+}
+
+// NextRotationDateNEQ applies the NEQ predicate on the "next_rotation_date" field.
+func NextRotationDateNEQ(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNEQ(FieldNextRotationDate, v))
+}
+
+// NextRotationDateIn applies the In predicate on the "next_rotation_date" field.
+func NextRotationDateIn(vs ...time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIn(FieldNextRotationDate, vs...))
+}
+
+// NextRotationDateNotIn applies the NotIn predicate on the "next_rotation_date" field.
+func NextRotationDateNotIn(vs ...time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotIn(FieldNextRotationDate, vs...))
+}
+
+// NextRotationDateGT applies the GT predicate on the "next_rotation_date" field.
+func NextRotationDateGT(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGT(FieldNextRotationDate, v))
+}
+
+// NextRotationDateGTE applies the GTE predicate on the "next_rotation_date" field.
+func NextRotationDateGTE(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGTE(FieldNextRotationDate, v))
+}
+
+// NextRotationDateLT applies the LT predicate on the "next_rotation_date" field.
+func NextRotationDateLT(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLT(FieldNextRotationDate, v))
+}
+
+// NextRotationDateLTE applies the LTE predicate on the "next_rotation_date" field.
+func NextRotationDateLTE(v time.Time) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLTE(FieldNextRotationDate, v))
+}
+
+// NextRotationDateIsNil applies the IsNil predicate on the "next_rotation_date" field.
+func NextRotationDateIsNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIsNull(FieldNextRotationDate))
+}
+
+// NextRotationDateNotNil applies the NotNil predicate on the "next_rotation_date" field.
+func NextRotationDateNotNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotNull(FieldNextRotationDate))
+}
+
+// PolicyJSONIsNil applies the IsNil predicate on the "policy_json" field.
+func PolicyJSONIsNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIsNull(FieldPolicyJSON))
+}
+
+// PolicyJSONNotNil applies the NotNil predicate on the "policy_json" field.
+func PolicyJSONNotNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotNull(FieldPolicyJSON))
+}
+
+// AliasesJSONIsNil applies the IsNil predicate on the "aliases_json" field.
+func AliasesJSONIsNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIsNull(FieldAliasesJSON))
+}
+
+// AliasesJSONNotNil applies the NotNil predicate on the "aliases_json" field.
+func AliasesJSONNotNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotNull(FieldAliasesJSON))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeAWSKMSKey) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeAWSKMSKey) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeAWSKMSKey) predicate.BronzeAWSKMSKey {
+	return predicate.BronzeAWSKMSKey(sql.NotPredicates(p))
+}
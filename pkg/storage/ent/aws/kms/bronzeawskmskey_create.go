@@ -0,0 +1,528 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/bronzeawskmskey"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSKMSKeyCreate is the builder for creating a BronzeAWSKMSKey entity.
+type BronzeAWSKMSKeyCreate struct {
+	config
+	mutation *BronzeAWSKMSKeyMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeAWSKMSKeyCreate) SetCollectedAt(v time.Time) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeAWSKMSKeyCreate) SetFirstCollectedAt(v time.Time) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetArn sets the "arn" field.
+func (_c *BronzeAWSKMSKeyCreate) SetArn(v string) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetArn(v)
+	return _c
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_c *BronzeAWSKMSKeyCreate) SetNillableArn(v *string) *BronzeAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetArn(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BronzeAWSKMSKeyCreate) SetDescription(v string) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BronzeAWSKMSKeyCreate) SetNillableDescription(v *string) *BronzeAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetKeyState sets the "key_state" field.
+func (_c *BronzeAWSKMSKeyCreate) SetKeyState(v string) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetKeyState(v)
+	return _c
+}
+
+// SetNillableKeyState sets the "key_state" field if the given value is not nil.
+func (_c *BronzeAWSKMSKeyCreate) SetNillableKeyState(v *string) *BronzeAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetKeyState(*v)
+	}
+	return _c
+}
+
+// SetKeyUsage sets the "key_usage" field.
+func (_c *BronzeAWSKMSKeyCreate) SetKeyUsage(v string) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetKeyUsage(v)
+	return _c
+}
+
+// SetNillableKeyUsage sets the "key_usage" field if the given value is not nil.
+func (_c *BronzeAWSKMSKeyCreate) SetNillableKeyUsage(v *string) *BronzeAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetKeyUsage(*v)
+	}
+	return _c
+}
+
+// SetKeySpec sets the "key_spec" field.
+func (_c *BronzeAWSKMSKeyCreate) SetKeySpec(v string) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetKeySpec(v)
+	return _c
+}
+
+// SetNillableKeySpec sets the "key_spec" field if the given value is not nil.
+func (_c *BronzeAWSKMSKeyCreate) SetNillableKeySpec(v *string) *BronzeAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetKeySpec(*v)
+	}
+	return _c
+}
+
+// SetKeyManager sets the "key_manager" field.
+func (_c *BronzeAWSKMSKeyCreate) SetKeyManager(v string) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetKeyManager(v)
+	return _c
+}
+
+// SetNillableKeyManager sets the "key_manager" field if the given value is not nil.
+func (_c *BronzeAWSKMSKeyCreate) SetNillableKeyManager(v *string) *BronzeAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetKeyManager(*v)
+	}
+	return _c
+}
+
+// SetOrigin sets the "origin" field.
+func (_c *BronzeAWSKMSKeyCreate) SetOrigin(v string) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetOrigin(v)
+	return _c
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (_c *BronzeAWSKMSKeyCreate) SetNillableOrigin(v *string) *BronzeAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetOrigin(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *BronzeAWSKMSKeyCreate) SetEnabled(v bool) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *BronzeAWSKMSKeyCreate) SetNillableEnabled(v *bool) *BronzeAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetMultiRegion sets the "multi_region" field.
+func (_c *BronzeAWSKMSKeyCreate) SetMultiRegion(v bool) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetMultiRegion(v)
+	return _c
+}
+
+// SetNillableMultiRegion sets the "multi_region" field if the given value is not nil.
+func (_c *BronzeAWSKMSKeyCreate) SetNillableMultiRegion(v *bool) *BronzeAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetMultiRegion(*v)
+	}
+	return _c
+}
+
+// SetCreationDate sets the "creation_date" field.
+func (_c *BronzeAWSKMSKeyCreate) SetCreationDate(v time.Time) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetCreationDate(v)
+	return _c
+}
+
+// SetNillableCreationDate sets the "creation_date" field if the given value is not nil.
+func (_c *BronzeAWSKMSKeyCreate) SetNillableCreationDate(v *time.Time) *BronzeAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetCreationDate(*v)
+	}
+	return _c
+}
+
+// SetDeletionDate sets the "deletion_date" field.
+func (_c *BronzeAWSKMSKeyCreate) SetDeletionDate(v time.Time) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetDeletionDate(v)
+	return _c
+}
+
+// SetNillableDeletionDate sets the "deletion_date" field if the given value is not nil.
+func (_c *BronzeAWSKMSKeyCreate) SetNillableDeletionDate(v *time.Time) *BronzeAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetDeletionDate(*v)
+	}
+	return _c
+}
+
+// SetRotationEnabled sets the "rotation_enabled" field.
+func (_c *BronzeAWSKMSKeyCreate) SetRotationEnabled(v bool) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetRotationEnabled(v)
+	return _c
+}
+
+// SetNillableRotationEnabled sets the "rotation_enabled" field if the given value is not nil.
+func (_c *BronzeAWSKMSKeyCreate) SetNillableRotationEnabled(v *bool) *BronzeAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetRotationEnabled(*v)
+	}
+	return _c
+}
+
+// SetRotationPeriodInDays sets the "rotation_period_in_days" field.
+func (_c *BronzeAWSKMSKeyCreate) SetRotationPeriodInDays(v int32) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetRotationPeriodInDays(v)
+	return _c
+}
+
+// SetNillableRotationPeriodInDays sets the "rotation_period_in_days" field if the given value is not nil.
+func (_c *BronzeAWSKMSKeyCreate) SetNillableRotationPeriodInDays(v *int32) *BronzeAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetRotationPeriodInDays(*v)
+	}
+	return _c
+}
+
+// SetNextRotationDate sets the "next_rotation_date" field.
+func (_c *BronzeAWSKMSKeyCreate) SetNextRotationDate(v time.Time) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetNextRotationDate(v)
+	return _c
+}
+
+// SetNillableNextRotationDate sets the "next_rotation_date" field if the given value is not nil.
+func (_c *BronzeAWSKMSKeyCreate) SetNillableNextRotationDate(v *time.Time) *BronzeAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetNextRotationDate(*v)
+	}
+	return _c
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (_c *BronzeAWSKMSKeyCreate) SetPolicyJSON(v json.RawMessage) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetPolicyJSON(v)
+	return _c
+}
+
+// SetAliasesJSON sets the "aliases_json" field.
+func (_c *BronzeAWSKMSKeyCreate) SetAliasesJSON(v json.RawMessage) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetAliasesJSON(v)
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeAWSKMSKeyCreate) SetTagsJSON(v json.RawMessage) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeAWSKMSKeyCreate) SetAccountID(v string) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BronzeAWSKMSKeyCreate) SetRegion(v string) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeAWSKMSKeyCreate) SetID(v string) *BronzeAWSKMSKeyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeAWSKMSKeyMutation object of the builder.
+func (_c *BronzeAWSKMSKeyCreate) Mutation() *BronzeAWSKMSKeyMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeAWSKMSKey in the database.
+func (_c *BronzeAWSKMSKeyCreate) Save(ctx context.Context) (*BronzeAWSKMSKey, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeAWSKMSKeyCreate) SaveX(ctx context.Context) *BronzeAWSKMSKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSKMSKeyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSKMSKeyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeAWSKMSKeyCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`kms: missing required field "BronzeAWSKMSKey.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`kms: missing required field "BronzeAWSKMSKey.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`kms: missing required field "BronzeAWSKMSKey.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzeawskmskey.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`kms: validator failed for field "BronzeAWSKMSKey.account_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`kms: missing required field "BronzeAWSKMSKey.region"`)}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := bronzeawskmskey.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`kms: validator failed for field "BronzeAWSKMSKey.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeAWSKMSKeyCreate) sqlSave(ctx context.Context) (*BronzeAWSKMSKey, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeAWSKMSKey.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeAWSKMSKeyCreate) createSpec() (*BronzeAWSKMSKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeAWSKMSKey{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzeawskmskey.Table, sqlgraph.NewFieldSpec(bronzeawskmskey.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeAWSKMSKey
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawskmskey.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzeawskmskey.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.Arn(); ok {
+		_spec.SetField(bronzeawskmskey.FieldArn, field.TypeString, value)
+		_node.Arn = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(bronzeawskmskey.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.KeyState(); ok {
+		_spec.SetField(bronzeawskmskey.FieldKeyState, field.TypeString, value)
+		_node.KeyState = value
+	}
+	if value, ok := _c.mutation.KeyUsage(); ok {
+		_spec.SetField(bronzeawskmskey.FieldKeyUsage, field.TypeString, value)
+		_node.KeyUsage = value
+	}
+	if value, ok := _c.mutation.KeySpec(); ok {
+		_spec.SetField(bronzeawskmskey.FieldKeySpec, field.TypeString, value)
+		_node.KeySpec = value
+	}
+	if value, ok := _c.mutation.KeyManager(); ok {
+		_spec.SetField(bronzeawskmskey.FieldKeyManager, field.TypeString, value)
+		_node.KeyManager = value
+	}
+	if value, ok := _c.mutation.Origin(); ok {
+		_spec.SetField(bronzeawskmskey.FieldOrigin, field.TypeString, value)
+		_node.Origin = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(bronzeawskmskey.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.MultiRegion(); ok {
+		_spec.SetField(bronzeawskmskey.FieldMultiRegion, field.TypeBool, value)
+		_node.MultiRegion = value
+	}
+	if value, ok := _c.mutation.CreationDate(); ok {
+		_spec.SetField(bronzeawskmskey.FieldCreationDate, field.TypeTime, value)
+		_node.CreationDate = &value
+	}
+	if value, ok := _c.mutation.DeletionDate(); ok {
+		_spec.SetField(bronzeawskmskey.FieldDeletionDate, field.TypeTime, value)
+		_node.DeletionDate = &value
+	}
+	if value, ok := _c.mutation.RotationEnabled(); ok {
+		_spec.SetField(bronzeawskmskey.FieldRotationEnabled, field.TypeBool, value)
+		_node.RotationEnabled = &value
+	}
+	if value, ok := _c.mutation.RotationPeriodInDays(); ok {
+		_spec.SetField(bronzeawskmskey.FieldRotationPeriodInDays, field.TypeInt32, value)
+		_node.RotationPeriodInDays = &value
+	}
+	if value, ok := _c.mutation.NextRotationDate(); ok {
+		_spec.SetField(bronzeawskmskey.FieldNextRotationDate, field.TypeTime, value)
+		_node.NextRotationDate = &value
+	}
+	if value, ok := _c.mutation.PolicyJSON(); ok {
+		_spec.SetField(bronzeawskmskey.FieldPolicyJSON, field.TypeJSON, value)
+		_node.PolicyJSON = value
+	}
+	if value, ok := _c.mutation.AliasesJSON(); ok {
+		_spec.SetField(bronzeawskmskey.FieldAliasesJSON, field.TypeJSON, value)
+		_node.AliasesJSON = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawskmskey.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawskmskey.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(bronzeawskmskey.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	return _node, _spec
+}
+
+// BronzeAWSKMSKeyCreateBulk is the builder for creating many BronzeAWSKMSKey entities in bulk.
+type BronzeAWSKMSKeyCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeAWSKMSKeyCreate
+}
+
+// Save creates the BronzeAWSKMSKey entities in the database.
+func (_c *BronzeAWSKMSKeyCreateBulk) Save(ctx context.Context) ([]*BronzeAWSKMSKey, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeAWSKMSKey, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeAWSKMSKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeAWSKMSKeyCreateBulk) SaveX(ctx context.Context) []*BronzeAWSKMSKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeAWSKMSKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeAWSKMSKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kms
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/bronzeawskmskey"
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSKMSKeyDelete is the builder for deleting a BronzeAWSKMSKey entity.
+type BronzeAWSKMSKeyDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSKMSKeyMutation
+}
+
+// Where appends a list predicates to the BronzeAWSKMSKeyDelete builder.
+func (_d *BronzeAWSKMSKeyDelete) Where(ps ...predicate.BronzeAWSKMSKey) *BronzeAWSKMSKeyDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeAWSKMSKeyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeAWSKMSKeyDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeAWSKMSKeyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzeawskmskey.Table, sqlgraph.NewFieldSpec(bronzeawskmskey.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.BronzeAWSKMSKey
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeAWSKMSKeyDeleteOne is the builder for deleting a single BronzeAWSKMSKey entity.
+type BronzeAWSKMSKeyDeleteOne struct {
+	_d *BronzeAWSKMSKeyDelete
+}
+
+// Where appends a list predicates to the BronzeAWSKMSKeyDelete builder.
+func (_d *BronzeAWSKMSKeyDeleteOne) Where(ps ...predicate.BronzeAWSKMSKey) *BronzeAWSKMSKeyDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeAWSKMSKeyDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzeawskmskey.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeAWSKMSKeyDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/bronzeawskmskey"
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSKMSKeyUpdate is the builder for updating BronzeAWSKMSKey entities.
+type BronzeAWSKMSKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSKMSKeyMutation
+}
+
+// Where appends a list predicates to the BronzeAWSKMSKeyUpdate builder.
+func (_u *BronzeAWSKMSKeyUpdate) Where(ps ...predicate.BronzeAWSKMSKey) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetCollectedAt(v time.Time) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdate) SetNillableCollectedAt(v *time.Time) *BronzeAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetArn(v string) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdate) SetNillableArn(v *string) *BronzeAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// ClearArn clears the value of the "arn" field.
+func (_u *BronzeAWSKMSKeyUpdate) ClearArn() *BronzeAWSKMSKeyUpdate {
+	_u.mutation.ClearArn()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetDescription(v string) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdate) SetNillableDescription(v *string) *BronzeAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeAWSKMSKeyUpdate) ClearDescription() *BronzeAWSKMSKeyUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetKeyState sets the "key_state" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetKeyState(v string) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.SetKeyState(v)
+	return _u
+}
+
+// SetNillableKeyState sets the "key_state" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdate) SetNillableKeyState(v *string) *BronzeAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetKeyState(*v)
+	}
+	return _u
+}
+
+// ClearKeyState clears the value of the "key_state" field.
+func (_u *BronzeAWSKMSKeyUpdate) ClearKeyState() *BronzeAWSKMSKeyUpdate {
+	_u.mutation.ClearKeyState()
+	return _u
+}
+
+// SetKeyUsage sets the "key_usage" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetKeyUsage(v string) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.SetKeyUsage(v)
+	return _u
+}
+
+// SetNillableKeyUsage sets the "key_usage" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdate) SetNillableKeyUsage(v *string) *BronzeAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetKeyUsage(*v)
+	}
+	return _u
+}
+
+// ClearKeyUsage clears the value of the "key_usage" field.
+func (_u *BronzeAWSKMSKeyUpdate) ClearKeyUsage() *BronzeAWSKMSKeyUpdate {
+	_u.mutation.ClearKeyUsage()
+	return _u
+}
+
+// SetKeySpec sets the "key_spec" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetKeySpec(v string) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.SetKeySpec(v)
+	return _u
+}
+
+// SetNillableKeySpec sets the "key_spec" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdate) SetNillableKeySpec(v *string) *BronzeAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetKeySpec(*v)
+	}
+	return _u
+}
+
+// ClearKeySpec clears the value of the "key_spec" field.
+func (_u *BronzeAWSKMSKeyUpdate) ClearKeySpec() *BronzeAWSKMSKeyUpdate {
+	_u.mutation.ClearKeySpec()
+	return _u
+}
+
+// SetKeyManager sets the "key_manager" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetKeyManager(v string) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.SetKeyManager(v)
+	return _u
+}
+
+// SetNillableKeyManager sets the "key_manager" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdate) SetNillableKeyManager(v *string) *BronzeAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetKeyManager(*v)
+	}
+	return _u
+}
+
+// ClearKeyManager clears the value of the "key_manager" field.
+func (_u *BronzeAWSKMSKeyUpdate) ClearKeyManager() *BronzeAWSKMSKeyUpdate {
+	_u.mutation.ClearKeyManager()
+	return _u
+}
+
+// SetOrigin sets the "origin" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetOrigin(v string) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.SetOrigin(v)
+	return _u
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdate) SetNillableOrigin(v *string) *BronzeAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetOrigin(*v)
+	}
+	return _u
+}
+
+// ClearOrigin clears the value of the "origin" field.
+func (_u *BronzeAWSKMSKeyUpdate) ClearOrigin() *BronzeAWSKMSKeyUpdate {
+	_u.mutation.ClearOrigin()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetEnabled(v bool) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdate) SetNillableEnabled(v *bool) *BronzeAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (_u *BronzeAWSKMSKeyUpdate) ClearEnabled() *BronzeAWSKMSKeyUpdate {
+	_u.mutation.ClearEnabled()
+	return _u
+}
+
+// SetMultiRegion sets the "multi_region" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetMultiRegion(v bool) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.SetMultiRegion(v)
+	return _u
+}
+
+// SetNillableMultiRegion sets the "multi_region" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdate) SetNillableMultiRegion(v *bool) *BronzeAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetMultiRegion(*v)
+	}
+	return _u
+}
+
+// ClearMultiRegion clears the value of the "multi_region" field.
+func (_u *BronzeAWSKMSKeyUpdate) ClearMultiRegion() *BronzeAWSKMSKeyUpdate {
+	_u.mutation.ClearMultiRegion()
+	return _u
+}
+
+// SetCreationDate sets the "creation_date" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetCreationDate(v time.Time) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.SetCreationDate(v)
+	return _u
+}
+
+// SetNillableCreationDate sets the "creation_date" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdate) SetNillableCreationDate(v *time.Time) *BronzeAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetCreationDate(*v)
+	}
+	return _u
+}
+
+// ClearCreationDate clears the value of the "creation_date" field.
+func (_u *BronzeAWSKMSKeyUpdate) ClearCreationDate() *BronzeAWSKMSKeyUpdate {
+	_u.mutation.ClearCreationDate()
+	return _u
+}
+
+// SetDeletionDate sets the "deletion_date" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetDeletionDate(v time.Time) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.SetDeletionDate(v)
+	return _u
+}
+
+// SetNillableDeletionDate sets the "deletion_date" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdate) SetNillableDeletionDate(v *time.Time) *BronzeAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetDeletionDate(*v)
+	}
+	return _u
+}
+
+// ClearDeletionDate clears the value of the "deletion_date" field.
+func (_u *BronzeAWSKMSKeyUpdate) ClearDeletionDate() *BronzeAWSKMSKeyUpdate {
+	_u.mutation.ClearDeletionDate()
+	return _u
+}
+
+// SetRotationEnabled sets the "rotation_enabled" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetRotationEnabled(v bool) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.SetRotationEnabled(v)
+	return _u
+}
+
+// SetNillableRotationEnabled sets the "rotation_enabled" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdate) SetNillableRotationEnabled(v *bool) *BronzeAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetRotationEnabled(*v)
+	}
+	return _u
+}
+
+// ClearRotationEnabled clears the value of the "rotation_enabled" field.
+func (_u *BronzeAWSKMSKeyUpdate) ClearRotationEnabled() *BronzeAWSKMSKeyUpdate {
+	_u.mutation.ClearRotationEnabled()
+	return _u
+}
+
+// SetRotationPeriodInDays sets the "rotation_period_in_days" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetRotationPeriodInDays(v int32) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.ResetRotationPeriodInDays()
+	_u.mutation.SetRotationPeriodInDays(v)
+	return _u
+}
+
+// SetNillableRotationPeriodInDays sets the "rotation_period_in_days" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdate) SetNillableRotationPeriodInDays(v *int32) *BronzeAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetRotationPeriodInDays(*v)
+	}
+	return _u
+}
+
+// AddRotationPeriodInDays adds value to the "rotation_period_in_days" field.
+func (_u *BronzeAWSKMSKeyUpdate) AddRotationPeriodInDays(v int32) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.AddRotationPeriodInDays(v)
+	return _u
+}
+
+// ClearRotationPeriodInDays clears the value of the "rotation_period_in_days" field.
+func (_u *BronzeAWSKMSKeyUpdate) ClearRotationPeriodInDays() *BronzeAWSKMSKeyUpdate {
+	_u.mutation.ClearRotationPeriodInDays()
+	return _u
+}
+
+// SetNextRotationDate sets the "next_rotation_date" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetNextRotationDate(v time.Time) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.SetNextRotationDate(v)
+	return _u
+}
+
+// SetNillableNextRotationDate sets the "next_rotation_date" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdate) SetNillableNextRotationDate(v *time.Time) *BronzeAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetNextRotationDate(*v)
+	}
+	return _u
+}
+
+// ClearNextRotationDate clears the value of the "next_rotation_date" field.
+func (_u *BronzeAWSKMSKeyUpdate) ClearNextRotationDate() *BronzeAWSKMSKeyUpdate {
+	_u.mutation.ClearNextRotationDate()
+	return _u
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetPolicyJSON(v json.RawMessage) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.SetPolicyJSON(v)
+	return _u
+}
+
+// AppendPolicyJSON appends value to the "policy_json" field.
+func (_u *BronzeAWSKMSKeyUpdate) AppendPolicyJSON(v json.RawMessage) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.AppendPolicyJSON(v)
+	return _u
+}
+
+// ClearPolicyJSON clears the value of the "policy_json" field.
+func (_u *BronzeAWSKMSKeyUpdate) ClearPolicyJSON() *BronzeAWSKMSKeyUpdate {
+	_u.mutation.ClearPolicyJSON()
+	return _u
+}
+
+// SetAliasesJSON sets the "aliases_json" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetAliasesJSON(v json.RawMessage) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.SetAliasesJSON(v)
+	return _u
+}
+
+// AppendAliasesJSON appends value to the "aliases_json" field.
+func (_u *BronzeAWSKMSKeyUpdate) AppendAliasesJSON(v json.RawMessage) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.AppendAliasesJSON(v)
+	return _u
+}
+
+// ClearAliasesJSON clears the value of the "aliases_json" field.
+func (_u *BronzeAWSKMSKeyUpdate) ClearAliasesJSON() *BronzeAWSKMSKeyUpdate {
+	_u.mutation.ClearAliasesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetTagsJSON(v json.RawMessage) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSKMSKeyUpdate) AppendTagsJSON(v json.RawMessage) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSKMSKeyUpdate) ClearTagsJSON() *BronzeAWSKMSKeyUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetAccountID(v string) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdate) SetNillableAccountID(v *string) *BronzeAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSKMSKeyUpdate) SetRegion(v string) *BronzeAWSKMSKeyUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdate) SetNillableRegion(v *string) *BronzeAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSKMSKeyMutation object of the builder.
+func (_u *BronzeAWSKMSKeyUpdate) Mutation() *BronzeAWSKMSKeyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeAWSKMSKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSKMSKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeAWSKMSKeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSKMSKeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSKMSKeyUpdate) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawskmskey.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`kms: validator failed for field "BronzeAWSKMSKey.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawskmskey.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`kms: validator failed for field "BronzeAWSKMSKey.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSKMSKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawskmskey.Table, bronzeawskmskey.Columns, sqlgraph.NewFieldSpec(bronzeawskmskey.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawskmskey.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzeawskmskey.FieldArn, field.TypeString, value)
+	}
+	if _u.mutation.ArnCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzeawskmskey.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeyState(); ok {
+		_spec.SetField(bronzeawskmskey.FieldKeyState, field.TypeString, value)
+	}
+	if _u.mutation.KeyStateCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldKeyState, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeyUsage(); ok {
+		_spec.SetField(bronzeawskmskey.FieldKeyUsage, field.TypeString, value)
+	}
+	if _u.mutation.KeyUsageCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldKeyUsage, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeySpec(); ok {
+		_spec.SetField(bronzeawskmskey.FieldKeySpec, field.TypeString, value)
+	}
+	if _u.mutation.KeySpecCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldKeySpec, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeyManager(); ok {
+		_spec.SetField(bronzeawskmskey.FieldKeyManager, field.TypeString, value)
+	}
+	if _u.mutation.KeyManagerCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldKeyManager, field.TypeString)
+	}
+	if value, ok := _u.mutation.Origin(); ok {
+		_spec.SetField(bronzeawskmskey.FieldOrigin, field.TypeString, value)
+	}
+	if _u.mutation.OriginCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldOrigin, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(bronzeawskmskey.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.EnabledCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.MultiRegion(); ok {
+		_spec.SetField(bronzeawskmskey.FieldMultiRegion, field.TypeBool, value)
+	}
+	if _u.mutation.MultiRegionCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldMultiRegion, field.TypeBool)
+	}
+	if value, ok := _u.mutation.CreationDate(); ok {
+		_spec.SetField(bronzeawskmskey.FieldCreationDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreationDateCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldCreationDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletionDate(); ok {
+		_spec.SetField(bronzeawskmskey.FieldDeletionDate, field.TypeTime, value)
+	}
+	if _u.mutation.DeletionDateCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldDeletionDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RotationEnabled(); ok {
+		_spec.SetField(bronzeawskmskey.FieldRotationEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.RotationEnabledCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldRotationEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RotationPeriodInDays(); ok {
+		_spec.SetField(bronzeawskmskey.FieldRotationPeriodInDays, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedRotationPeriodInDays(); ok {
+		_spec.AddField(bronzeawskmskey.FieldRotationPeriodInDays, field.TypeInt32, value)
+	}
+	if _u.mutation.RotationPeriodInDaysCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldRotationPeriodInDays, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.NextRotationDate(); ok {
+		_spec.SetField(bronzeawskmskey.FieldNextRotationDate, field.TypeTime, value)
+	}
+	if _u.mutation.NextRotationDateCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldNextRotationDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PolicyJSON(); ok {
+		_spec.SetField(bronzeawskmskey.FieldPolicyJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolicyJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawskmskey.FieldPolicyJSON, value)
+		})
+	}
+	if _u.mutation.PolicyJSONCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldPolicyJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AliasesJSON(); ok {
+		_spec.SetField(bronzeawskmskey.FieldAliasesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAliasesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawskmskey.FieldAliasesJSON, value)
+		})
+	}
+	if _u.mutation.AliasesJSONCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldAliasesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawskmskey.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawskmskey.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawskmskey.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawskmskey.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSKMSKey
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawskmskey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeAWSKMSKeyUpdateOne is the builder for updating a single BronzeAWSKMSKey entity.
+type BronzeAWSKMSKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeAWSKMSKeyMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetCollectedAt(v time.Time) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetArn(v string) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetNillableArn(v *string) *BronzeAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// ClearArn clears the value of the "arn" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) ClearArn() *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.ClearArn()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetDescription(v string) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetNillableDescription(v *string) *BronzeAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) ClearDescription() *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetKeyState sets the "key_state" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetKeyState(v string) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.SetKeyState(v)
+	return _u
+}
+
+// SetNillableKeyState sets the "key_state" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetNillableKeyState(v *string) *BronzeAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetKeyState(*v)
+	}
+	return _u
+}
+
+// ClearKeyState clears the value of the "key_state" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) ClearKeyState() *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.ClearKeyState()
+	return _u
+}
+
+// SetKeyUsage sets the "key_usage" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetKeyUsage(v string) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.SetKeyUsage(v)
+	return _u
+}
+
+// SetNillableKeyUsage sets the "key_usage" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetNillableKeyUsage(v *string) *BronzeAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetKeyUsage(*v)
+	}
+	return _u
+}
+
+// ClearKeyUsage clears the value of the "key_usage" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) ClearKeyUsage() *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.ClearKeyUsage()
+	return _u
+}
+
+// SetKeySpec sets the "key_spec" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetKeySpec(v string) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.SetKeySpec(v)
+	return _u
+}
+
+// SetNillableKeySpec sets the "key_spec" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetNillableKeySpec(v *string) *BronzeAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetKeySpec(*v)
+	}
+	return _u
+}
+
+// ClearKeySpec clears the value of the "key_spec" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) ClearKeySpec() *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.ClearKeySpec()
+	return _u
+}
+
+// SetKeyManager sets the "key_manager" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetKeyManager(v string) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.SetKeyManager(v)
+	return _u
+}
+
+// SetNillableKeyManager sets the "key_manager" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetNillableKeyManager(v *string) *BronzeAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetKeyManager(*v)
+	}
+	return _u
+}
+
+// ClearKeyManager clears the value of the "key_manager" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) ClearKeyManager() *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.ClearKeyManager()
+	return _u
+}
+
+// SetOrigin sets the "origin" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetOrigin(v string) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.SetOrigin(v)
+	return _u
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetNillableOrigin(v *string) *BronzeAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetOrigin(*v)
+	}
+	return _u
+}
+
+// ClearOrigin clears the value of the "origin" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) ClearOrigin() *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.ClearOrigin()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetEnabled(v bool) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetNillableEnabled(v *bool) *BronzeAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) ClearEnabled() *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.ClearEnabled()
+	return _u
+}
+
+// SetMultiRegion sets the "multi_region" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetMultiRegion(v bool) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.SetMultiRegion(v)
+	return _u
+}
+
+// SetNillableMultiRegion sets the "multi_region" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetNillableMultiRegion(v *bool) *BronzeAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetMultiRegion(*v)
+	}
+	return _u
+}
+
+// ClearMultiRegion clears the value of the "multi_region" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) ClearMultiRegion() *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.ClearMultiRegion()
+	return _u
+}
+
+// SetCreationDate sets the "creation_date" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetCreationDate(v time.Time) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.SetCreationDate(v)
+	return _u
+}
+
+// SetNillableCreationDate sets the "creation_date" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetNillableCreationDate(v *time.Time) *BronzeAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetCreationDate(*v)
+	}
+	return _u
+}
+
+// ClearCreationDate clears the value of the "creation_date" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) ClearCreationDate() *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.ClearCreationDate()
+	return _u
+}
+
+// SetDeletionDate sets the "deletion_date" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetDeletionDate(v time.Time) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.SetDeletionDate(v)
+	return _u
+}
+
+// SetNillableDeletionDate sets the "deletion_date" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetNillableDeletionDate(v *time.Time) *BronzeAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetDeletionDate(*v)
+	}
+	return _u
+}
+
+// ClearDeletionDate clears the value of the "deletion_date" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) ClearDeletionDate() *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.ClearDeletionDate()
+	return _u
+}
+
+// SetRotationEnabled sets the "rotation_enabled" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetRotationEnabled(v bool) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.SetRotationEnabled(v)
+	return _u
+}
+
+// SetNillableRotationEnabled sets the "rotation_enabled" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetNillableRotationEnabled(v *bool) *BronzeAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetRotationEnabled(*v)
+	}
+	return _u
+}
+
+// ClearRotationEnabled clears the value of the "rotation_enabled" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) ClearRotationEnabled() *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.ClearRotationEnabled()
+	return _u
+}
+
+// SetRotationPeriodInDays sets the "rotation_period_in_days" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetRotationPeriodInDays(v int32) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.ResetRotationPeriodInDays()
+	_u.mutation.SetRotationPeriodInDays(v)
+	return _u
+}
+
+// SetNillableRotationPeriodInDays sets the "rotation_period_in_days" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetNillableRotationPeriodInDays(v *int32) *BronzeAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetRotationPeriodInDays(*v)
+	}
+	return _u
+}
+
+// AddRotationPeriodInDays adds value to the "rotation_period_in_days" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) AddRotationPeriodInDays(v int32) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.AddRotationPeriodInDays(v)
+	return _u
+}
+
+// ClearRotationPeriodInDays clears the value of the "rotation_period_in_days" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) ClearRotationPeriodInDays() *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.ClearRotationPeriodInDays()
+	return _u
+}
+
+// SetNextRotationDate sets the "next_rotation_date" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetNextRotationDate(v time.Time) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.SetNextRotationDate(v)
+	return _u
+}
+
+// SetNillableNextRotationDate sets the "next_rotation_date" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetNillableNextRotationDate(v *time.Time) *BronzeAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetNextRotationDate(*v)
+	}
+	return _u
+}
+
+// ClearNextRotationDate clears the value of the "next_rotation_date" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) ClearNextRotationDate() *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.ClearNextRotationDate()
+	return _u
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetPolicyJSON(v json.RawMessage) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.SetPolicyJSON(v)
+	return _u
+}
+
+// AppendPolicyJSON appends value to the "policy_json" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) AppendPolicyJSON(v json.RawMessage) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.AppendPolicyJSON(v)
+	return _u
+}
+
+// ClearPolicyJSON clears the value of the "policy_json" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) ClearPolicyJSON() *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.ClearPolicyJSON()
+	return _u
+}
+
+// SetAliasesJSON sets the "aliases_json" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetAliasesJSON(v json.RawMessage) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.SetAliasesJSON(v)
+	return _u
+}
+
+// AppendAliasesJSON appends value to the "aliases_json" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) AppendAliasesJSON(v json.RawMessage) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.AppendAliasesJSON(v)
+	return _u
+}
+
+// ClearAliasesJSON clears the value of the "aliases_json" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) ClearAliasesJSON() *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.ClearAliasesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) ClearTagsJSON() *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetAccountID(v string) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetNillableAccountID(v *string) *BronzeAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetRegion(v string) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSKMSKeyUpdateOne) SetNillableRegion(v *string) *BronzeAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSKMSKeyMutation object of the builder.
+func (_u *BronzeAWSKMSKeyUpdateOne) Mutation() *BronzeAWSKMSKeyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeAWSKMSKeyUpdate builder.
+func (_u *BronzeAWSKMSKeyUpdateOne) Where(ps ...predicate.BronzeAWSKMSKey) *BronzeAWSKMSKeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeAWSKMSKeyUpdateOne) Select(field string, fields ...string) *BronzeAWSKMSKeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeAWSKMSKey entity.
+func (_u *BronzeAWSKMSKeyUpdateOne) Save(ctx context.Context) (*BronzeAWSKMSKey, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSKMSKeyUpdateOne) SaveX(ctx context.Context) *BronzeAWSKMSKey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeAWSKMSKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSKMSKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSKMSKeyUpdateOne) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawskmskey.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`kms: validator failed for field "BronzeAWSKMSKey.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawskmskey.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`kms: validator failed for field "BronzeAWSKMSKey.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSKMSKeyUpdateOne) sqlSave(ctx context.Context) (_node *BronzeAWSKMSKey, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawskmskey.Table, bronzeawskmskey.Columns, sqlgraph.NewFieldSpec(bronzeawskmskey.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`kms: missing "BronzeAWSKMSKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawskmskey.FieldID)
+		for _, f := range fields {
+			if !bronzeawskmskey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("kms: invalid field %q for query", f)}
+			}
+			if f != bronzeawskmskey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawskmskey.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzeawskmskey.FieldArn, field.TypeString, value)
+	}
+	if _u.mutation.ArnCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzeawskmskey.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeyState(); ok {
+		_spec.SetField(bronzeawskmskey.FieldKeyState, field.TypeString, value)
+	}
+	if _u.mutation.KeyStateCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldKeyState, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeyUsage(); ok {
+		_spec.SetField(bronzeawskmskey.FieldKeyUsage, field.TypeString, value)
+	}
+	if _u.mutation.KeyUsageCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldKeyUsage, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeySpec(); ok {
+		_spec.SetField(bronzeawskmskey.FieldKeySpec, field.TypeString, value)
+	}
+	if _u.mutation.KeySpecCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldKeySpec, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeyManager(); ok {
+		_spec.SetField(bronzeawskmskey.FieldKeyManager, field.TypeString, value)
+	}
+	if _u.mutation.KeyManagerCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldKeyManager, field.TypeString)
+	}
+	if value, ok := _u.mutation.Origin(); ok {
+		_spec.SetField(bronzeawskmskey.FieldOrigin, field.TypeString, value)
+	}
+	if _u.mutation.OriginCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldOrigin, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(bronzeawskmskey.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.EnabledCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.MultiRegion(); ok {
+		_spec.SetField(bronzeawskmskey.FieldMultiRegion, field.TypeBool, value)
+	}
+	if _u.mutation.MultiRegionCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldMultiRegion, field.TypeBool)
+	}
+	if value, ok := _u.mutation.CreationDate(); ok {
+		_spec.SetField(bronzeawskmskey.FieldCreationDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreationDateCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldCreationDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletionDate(); ok {
+		_spec.SetField(bronzeawskmskey.FieldDeletionDate, field.TypeTime, value)
+	}
+	if _u.mutation.DeletionDateCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldDeletionDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RotationEnabled(); ok {
+		_spec.SetField(bronzeawskmskey.FieldRotationEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.RotationEnabledCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldRotationEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RotationPeriodInDays(); ok {
+		_spec.SetField(bronzeawskmskey.FieldRotationPeriodInDays, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedRotationPeriodInDays(); ok {
+		_spec.AddField(bronzeawskmskey.FieldRotationPeriodInDays, field.TypeInt32, value)
+	}
+	if _u.mutation.RotationPeriodInDaysCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldRotationPeriodInDays, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.NextRotationDate(); ok {
+		_spec.SetField(bronzeawskmskey.FieldNextRotationDate, field.TypeTime, value)
+	}
+	if _u.mutation.NextRotationDateCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldNextRotationDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PolicyJSON(); ok {
+		_spec.SetField(bronzeawskmskey.FieldPolicyJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolicyJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawskmskey.FieldPolicyJSON, value)
+		})
+	}
+	if _u.mutation.PolicyJSONCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldPolicyJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AliasesJSON(); ok {
+		_spec.SetField(bronzeawskmskey.FieldAliasesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAliasesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawskmskey.FieldAliasesJSON, value)
+		})
+	}
+	if _u.mutation.AliasesJSONCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldAliasesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawskmskey.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawskmskey.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawskmskey.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawskmskey.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawskmskey.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSKMSKey
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeAWSKMSKey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawskmskey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
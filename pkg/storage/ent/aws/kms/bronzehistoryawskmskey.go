@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kms
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/bronzehistoryawskmskey"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryAWSKMSKey is the model entity for the BronzeHistoryAWSKMSKey schema.
+type BronzeHistoryAWSKMSKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze KMS key by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// Arn holds the value of the "arn" field.
+	Arn string `json:"arn,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// KeyState holds the value of the "key_state" field.
+	KeyState string `json:"key_state,omitempty"`
+	// KeyUsage holds the value of the "key_usage" field.
+	KeyUsage string `json:"key_usage,omitempty"`
+	// KeySpec holds the value of the "key_spec" field.
+	KeySpec string `json:"key_spec,omitempty"`
+	// KeyManager holds the value of the "key_manager" field.
+	KeyManager string `json:"key_manager,omitempty"`
+	// Origin holds the value of the "origin" field.
+	Origin string `json:"origin,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// MultiRegion holds the value of the "multi_region" field.
+	MultiRegion bool `json:"multi_region,omitempty"`
+	// CreationDate holds the value of the "creation_date" field.
+	CreationDate *time.Time `json:"creation_date,omitempty"`
+	// DeletionDate holds the value of the "deletion_date" field.
+	DeletionDate *time.Time `json:"deletion_date,omitempty"`
+	// RotationEnabled holds the value of the "rotation_enabled" field.
+	RotationEnabled *bool `json:"rotation_enabled,omitempty"`
+	// RotationPeriodInDays holds the value of the "rotation_period_in_days" field.
+	RotationPeriodInDays *int32 `json:"rotation_period_in_days,omitempty"`
+	// NextRotationDate holds the value of the "next_rotation_date" field.
+	NextRotationDate *time.Time `json:"next_rotation_date,omitempty"`
+	// PolicyJSON holds the value of the "policy_json" field.
+	PolicyJSON json.RawMessage `json:"policy_json,omitempty"`
+	// AliasesJSON holds the value of the "aliases_json" field.
+	AliasesJSON json.RawMessage `json:"aliases_json,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Region holds the value of the "region" field.
+	Region       string `json:"region,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryAWSKMSKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawskmskey.FieldPolicyJSON, bronzehistoryawskmskey.FieldAliasesJSON, bronzehistoryawskmskey.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzehistoryawskmskey.FieldEnabled, bronzehistoryawskmskey.FieldMultiRegion, bronzehistoryawskmskey.FieldRotationEnabled:
+			values[i] = new(sql.NullBool)
+		case bronzehistoryawskmskey.FieldID, bronzehistoryawskmskey.FieldRotationPeriodInDays:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryawskmskey.FieldResourceID, bronzehistoryawskmskey.FieldArn, bronzehistoryawskmskey.FieldDescription, bronzehistoryawskmskey.FieldKeyState, bronzehistoryawskmskey.FieldKeyUsage, bronzehistoryawskmskey.FieldKeySpec, bronzehistoryawskmskey.FieldKeyManager, bronzehistoryawskmskey.FieldOrigin, bronzehistoryawskmskey.FieldAccountID, bronzehistoryawskmskey.FieldRegion:
+			values[i] = new(sql.NullString)
+		case bronzehistoryawskmskey.FieldValidFrom, bronzehistoryawskmskey.FieldValidTo, bronzehistoryawskmskey.FieldCollectedAt, bronzehistoryawskmskey.FieldFirstCollectedAt, bronzehistoryawskmskey.FieldCreationDate, bronzehistoryawskmskey.FieldDeletionDate, bronzehistoryawskmskey.FieldNextRotationDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryAWSKMSKey fields.
+func (_m *BronzeHistoryAWSKMSKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawskmskey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryawskmskey.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryawskmskey.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryawskmskey.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryawskmskey.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryawskmskey.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryawskmskey.FieldArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field arn", values[i])
+			} else if value.Valid {
+				_m.Arn = value.String
+			}
+		case bronzehistoryawskmskey.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case bronzehistoryawskmskey.FieldKeyState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_state", values[i])
+			} else if value.Valid {
+				_m.KeyState = value.String
+			}
+		case bronzehistoryawskmskey.FieldKeyUsage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_usage", values[i])
+			} else if value.Valid {
+				_m.KeyUsage = value.String
+			}
+		case bronzehistoryawskmskey.FieldKeySpec:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_spec", values[i])
+			} else if value.Valid {
+				_m.KeySpec = value.String
+			}
+		case bronzehistoryawskmskey.FieldKeyManager:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_manager", values[i])
+			} else if value.Valid {
+				_m.KeyManager = value.String
+			}
+		case bronzehistoryawskmskey.FieldOrigin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field origin", values[i])
+			} else if value.Valid {
+				_m.Origin = value.String
+			}
+		case bronzehistoryawskmskey.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case bronzehistoryawskmskey.FieldMultiRegion:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field multi_region", values[i])
+			} else if value.Valid {
+				_m.MultiRegion = value.Bool
+			}
+		case bronzehistoryawskmskey.FieldCreationDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field creation_date", values[i])
+			} else if value.Valid {
+				_m.CreationDate = new(time.Time)
+				*_m.CreationDate = value.Time
+			}
+		case bronzehistoryawskmskey.FieldDeletionDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_date", values[i])
+			} else if value.Valid {
+				_m.DeletionDate = new(time.Time)
+				*_m.DeletionDate = value.Time
+			}
+		case bronzehistoryawskmskey.FieldRotationEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field rotation_enabled", values[i])
+			} else if value.Valid {
+				_m.RotationEnabled = new(bool)
+				*_m.RotationEnabled = value.Bool
+			}
+		case bronzehistoryawskmskey.FieldRotationPeriodInDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rotation_period_in_days", values[i])
+			} else if value.Valid {
+				_m.RotationPeriodInDays = new(int32)
+				*_m.RotationPeriodInDays = int32(value.Int64)
+			}
+		case bronzehistoryawskmskey.FieldNextRotationDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_rotation_date", values[i])
+			} else if value.Valid {
+				_m.NextRotationDate = new(time.Time)
+				*_m.NextRotationDate = value.Time
+			}
+		case bronzehistoryawskmskey.FieldPolicyJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PolicyJSON); err != nil {
+					return fmt.Errorf("unmarshal field policy_json: %w", err)
+				}
+			}
+		case bronzehistoryawskmskey.FieldAliasesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field aliases_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AliasesJSON); err != nil {
+					return fmt.Errorf("unmarshal field aliases_json: %w", err)
+				}
+			}
+		case bronzehistoryawskmskey.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzehistoryawskmskey.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzehistoryawskmskey.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryAWSKMSKey.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryAWSKMSKey) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryAWSKMSKey.
+// Note that you need to call BronzeHistoryAWSKMSKey.Unwrap() before calling this method if this BronzeHistoryAWSKMSKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryAWSKMSKey) Update() *BronzeHistoryAWSKMSKeyUpdateOne {
+	return NewBronzeHistoryAWSKMSKeyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryAWSKMSKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryAWSKMSKey) Unwrap() *BronzeHistoryAWSKMSKey {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("kms: BronzeHistoryAWSKMSKey is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryAWSKMSKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryAWSKMSKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("arn=")
+	builder.WriteString(_m.Arn)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("key_state=")
+	builder.WriteString(_m.KeyState)
+	builder.WriteString(", ")
+	builder.WriteString("key_usage=")
+	builder.WriteString(_m.KeyUsage)
+	builder.WriteString(", ")
+	builder.WriteString("key_spec=")
+	builder.WriteString(_m.KeySpec)
+	builder.WriteString(", ")
+	builder.WriteString("key_manager=")
+	builder.WriteString(_m.KeyManager)
+	builder.WriteString(", ")
+	builder.WriteString("origin=")
+	builder.WriteString(_m.Origin)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("multi_region=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MultiRegion))
+	builder.WriteString(", ")
+	if v := _m.CreationDate; v != nil {
+		builder.WriteString("creation_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletionDate; v != nil {
+		builder.WriteString("deletion_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RotationEnabled; v != nil {
+		builder.WriteString("rotation_enabled=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RotationPeriodInDays; v != nil {
+		builder.WriteString("rotation_period_in_days=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.NextRotationDate; v != nil {
+		builder.WriteString("next_rotation_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("policy_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PolicyJSON))
+	builder.WriteString(", ")
+	builder.WriteString("aliases_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AliasesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryAWSKMSKeys is a parsable slice of BronzeHistoryAWSKMSKey.
+type BronzeHistoryAWSKMSKeys []*BronzeHistoryAWSKMSKey
@@ -0,0 +1,1355 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryawskmskey
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLTE(FieldID, id))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldValidTo, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldResourceID, v))
+}
+
+// Arn applies equality check predicate on the "arn" field. It's identical to ArnEQ.
+func Arn(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldArn, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldDescription, v))
+}
+
+// KeyState applies equality check predicate on the "key_state" field. It's identical to KeyStateEQ.
+func KeyState(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldKeyState, v))
+}
+
+// KeyUsage applies equality check predicate on the "key_usage" field. It's identical to KeyUsageEQ.
+func KeyUsage(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldKeyUsage, v))
+}
+
+// KeySpec applies equality check predicate on the "key_spec" field. It's identical to KeySpecEQ.
+func KeySpec(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldKeySpec, v))
+}
+
+// KeyManager applies equality check predicate on the "key_manager" field. It's identical to KeyManagerEQ.
+func KeyManager(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldKeyManager, v))
+}
+
+// Origin applies equality check predicate on the "origin" field. It's identical to OriginEQ.
+func Origin(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldOrigin, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldEnabled, v))
+}
+
+// MultiRegion applies equality check predicate on the "multi_region" field. It's identical to MultiRegionEQ.
+func MultiRegion(v bool) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldMultiRegion, v))
+}
+
+// CreationDate applies equality check predicate on the "creation_date" field. It's identical to CreationDateEQ.
+func CreationDate(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldCreationDate, v))
+}
+
+// DeletionDate applies equality check predicate on the "deletion_date" field. It's identical to DeletionDateEQ.
+func DeletionDate(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldDeletionDate, v))
+}
+
+// RotationEnabled applies equality check predicate on the "rotation_enabled" field. It's identical to RotationEnabledEQ.
+func RotationEnabled(v bool) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldRotationEnabled, v))
+}
+
+// RotationPeriodInDays applies equality check predicate on the "rotation_period_in_days" field. It's identical to RotationPeriodInDaysEQ.
+func RotationPeriodInDays(v int32) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldRotationPeriodInDays, v))
+}
+
+// NextRotationDate applies equality check predicate on the "next_rotation_date" field. It's identical to NextRotationDateEQ.
+func NextRotationDate(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldNextRotationDate, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldRegion, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotNull(FieldValidTo))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// ArnEQ applies the EQ predicate on the "arn" field.
+func ArnEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldArn, v))
+}
+
+// ArnNEQ applies the NEQ predicate on the "arn" field.
+func ArnNEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldArn, v))
+}
+
+// ArnIn applies the In predicate on the "arn" field.
+func ArnIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIn(FieldArn, vs...))
+}
+
+// ArnNotIn applies the NotIn predicate on the "arn" field.
+func ArnNotIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotIn(FieldArn, vs...))
+}
+
+// ArnGT applies the GT predicate on the "arn" field.
+func ArnGT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGT(FieldArn, v))
+}
+
+// ArnGTE applies the GTE predicate on the "arn" field.
+func ArnGTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGTE(FieldArn, v))
+}
+
+// ArnLT applies the LT predicate on the "arn" field.
+func ArnLT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLT(FieldArn, v))
+}
+
+// ArnLTE applies the LTE predicate on the "arn" field.
+func ArnLTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLTE(FieldArn, v))
+}
+
+// ArnContains applies the Contains predicate on the "arn" field.
+func ArnContains(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContains(FieldArn, v))
+}
+
+// ArnHasPrefix applies the HasPrefix predicate on the "arn" field.
+func ArnHasPrefix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasPrefix(FieldArn, v))
+}
+
+// ArnHasSuffix applies the HasSuffix predicate on the "arn" field.
+func ArnHasSuffix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasSuffix(FieldArn, v))
+}
+
+// ArnIsNil applies the IsNil predicate on the "arn" field.
+func ArnIsNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIsNull(FieldArn))
+}
+
+// ArnNotNil applies the NotNil predicate on the "arn" field.
+func ArnNotNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotNull(FieldArn))
+}
+
+// ArnEqualFold applies the EqualFold predicate on the "arn" field.
+func ArnEqualFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEqualFold(FieldArn, v))
+}
+
+// ArnContainsFold applies the ContainsFold predicate on the "arn" field.
+func ArnContainsFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContainsFold(FieldArn, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// KeyStateEQ applies the EQ predicate on the "key_state" field.
+func KeyStateEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldKeyState, v))
+}
+
+// KeyStateNEQ applies the NEQ predicate on the "key_state" field.
+func KeyStateNEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldKeyState, v))
+}
+
+// KeyStateIn applies the In predicate on the "key_state" field.
+func KeyStateIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIn(FieldKeyState, vs...))
+}
+
+// KeyStateNotIn applies the NotIn predicate on the "key_state" field.
+func KeyStateNotIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotIn(FieldKeyState, vs...))
+}
+
+// KeyStateGT applies the GT predicate on the "key_state" field.
+func KeyStateGT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGT(FieldKeyState, v))
+}
+
+// KeyStateGTE applies the GTE predicate on the "key_state" field.
+func KeyStateGTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGTE(FieldKeyState, v))
+}
+
+// KeyStateLT applies the LT predicate on the "key_state" field.
+func KeyStateLT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLT(FieldKeyState, v))
+}
+
+// KeyStateLTE applies the LTE predicate on the "key_state" field.
+func KeyStateLTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLTE(FieldKeyState, v))
+}
+
+// KeyStateContains applies the Contains predicate on the "key_state" field.
+func KeyStateContains(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContains(FieldKeyState, v))
+}
+
+// KeyStateHasPrefix applies the HasPrefix predicate on the "key_state" field.
+func KeyStateHasPrefix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasPrefix(FieldKeyState, v))
+}
+
+// KeyStateHasSuffix applies the HasSuffix predicate on the "key_state" field.
+func KeyStateHasSuffix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasSuffix(FieldKeyState, v))
+}
+
+// KeyStateIsNil applies the IsNil predicate on the "key_state" field.
+func KeyStateIsNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIsNull(FieldKeyState))
+}
+
+// KeyStateNotNil applies the NotNil predicate on the "key_state" field.
+func KeyStateNotNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotNull(FieldKeyState))
+}
+
+// KeyStateEqualFold applies the EqualFold predicate on the "key_state" field.
+func KeyStateEqualFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEqualFold(FieldKeyState, v))
+}
+
+// KeyStateContainsFold applies the ContainsFold predicate on the "key_state" field.
+func KeyStateContainsFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContainsFold(FieldKeyState, v))
+}
+
+// KeyUsageEQ applies the EQ predicate on the "key_usage" field.
+func KeyUsageEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldKeyUsage, v))
+}
+
+// KeyUsageNEQ applies the NEQ predicate on the "key_usage" field.
+func KeyUsageNEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldKeyUsage, v))
+}
+
+// KeyUsageIn applies the In predicate on the "key_usage" field.
+func KeyUsageIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIn(FieldKeyUsage, vs...))
+}
+
+// KeyUsageNotIn applies the NotIn predicate on the "key_usage" field.
+func KeyUsageNotIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotIn(FieldKeyUsage, vs...))
+}
+
+// KeyUsageGT applies the GT predicate on the "key_usage" field.
+func KeyUsageGT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGT(FieldKeyUsage, v))
+}
+
+// KeyUsageGTE applies the GTE predicate on the "key_usage" field.
+func KeyUsageGTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGTE(FieldKeyUsage, v))
+}
+
+// KeyUsageLT applies the LT predicate on the "key_usage" field.
+func KeyUsageLT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLT(FieldKeyUsage, v))
+}
+
+// KeyUsageLTE applies the LTE predicate on the "key_usage" field.
+func KeyUsageLTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLTE(FieldKeyUsage, v))
+}
+
+// KeyUsageContains applies the Contains predicate on the "key_usage" field.
+func KeyUsageContains(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContains(FieldKeyUsage, v))
+}
+
+// KeyUsageHasPrefix applies the HasPrefix predicate on the "key_usage" field.
+func KeyUsageHasPrefix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasPrefix(FieldKeyUsage, v))
+}
+
+// KeyUsageHasSuffix applies the HasSuffix predicate on the "key_usage" field.
+func KeyUsageHasSuffix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasSuffix(FieldKeyUsage, v))
+}
+
+// KeyUsageIsNil applies the IsNil predicate on the "key_usage" field.
+func KeyUsageIsNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIsNull(FieldKeyUsage))
+}
+
+// KeyUsageNotNil applies the NotNil predicate on the "key_usage" field.
+func KeyUsageNotNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotNull(FieldKeyUsage))
+}
+
+// KeyUsageEqualFold applies the EqualFold predicate on the "key_usage" field.
+func KeyUsageEqualFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEqualFold(FieldKeyUsage, v))
+}
+
+// KeyUsageContainsFold applies the ContainsFold predicate on the "key_usage" field.
+func KeyUsageContainsFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContainsFold(FieldKeyUsage, v))
+}
+
+// KeySpecEQ applies the EQ predicate on the "key_spec" field.
+func KeySpecEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldKeySpec, v))
+}
+
+// KeySpecNEQ applies the NEQ predicate on the "key_spec" field.
+func KeySpecNEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldKeySpec, v))
+}
+
+// KeySpecIn applies the In predicate on the "key_spec" field.
+func KeySpecIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIn(FieldKeySpec, vs...))
+}
+
+// KeySpecNotIn applies the NotIn predicate on the "key_spec" field.
+func KeySpecNotIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotIn(FieldKeySpec, vs...))
+}
+
+// KeySpecGT applies the GT predicate on the "key_spec" field.
+func KeySpecGT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGT(FieldKeySpec, v))
+}
+
+// KeySpecGTE applies the GTE predicate on the "key_spec" field.
+func KeySpecGTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGTE(FieldKeySpec, v))
+}
+
+// KeySpecLT applies the LT predicate on the "key_spec" field.
+func KeySpecLT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLT(FieldKeySpec, v))
+}
+
+// KeySpecLTE applies the LTE predicate on the "key_spec" field.
+func KeySpecLTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLTE(FieldKeySpec, v))
+}
+
+// KeySpecContains applies the Contains predicate on the "key_spec" field.
+func KeySpecContains(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContains(FieldKeySpec, v))
+}
+
+// KeySpecHasPrefix applies the HasPrefix predicate on the "key_spec" field.
+func KeySpecHasPrefix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasPrefix(FieldKeySpec, v))
+}
+
+// KeySpecHasSuffix applies the HasSuffix predicate on the "key_spec" field.
+func KeySpecHasSuffix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasSuffix(FieldKeySpec, v))
+}
+
+// KeySpecIsNil applies the IsNil predicate on the "key_spec" field.
+func KeySpecIsNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIsNull(FieldKeySpec))
+}
+
+// KeySpecNotNil applies the NotNil predicate on the "key_spec" field.
+func KeySpecNotNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotNull(FieldKeySpec))
+}
+
+// KeySpecEqualFold applies the EqualFold predicate on the "key_spec" field.
+func KeySpecEqualFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEqualFold(FieldKeySpec, v))
+}
+
+// KeySpecContainsFold applies the ContainsFold predicate on the "key_spec" field.
+func KeySpecContainsFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContainsFold(FieldKeySpec, v))
+}
+
+// KeyManagerEQ applies the EQ predicate on the "key_manager" field.
+func KeyManagerEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldKeyManager, v))
+}
+
+// KeyManagerNEQ applies the NEQ predicate on the "key_manager" field.
+func KeyManagerNEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldKeyManager, v))
+}
+
+// KeyManagerIn applies the In predicate on the "key_manager" field.
+func KeyManagerIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIn(FieldKeyManager, vs...))
+}
+
+// KeyManagerNotIn applies the NotIn predicate on the "key_manager" field.
+func KeyManagerNotIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotIn(FieldKeyManager, vs...))
+}
+
+// KeyManagerGT applies the GT predicate on the "key_manager" field.
+func KeyManagerGT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGT(FieldKeyManager, v))
+}
+
+// KeyManagerGTE applies the GTE predicate on the "key_manager" field.
+func KeyManagerGTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGTE(FieldKeyManager, v))
+}
+
+// KeyManagerLT applies the LT predicate on the "key_manager" field.
+func KeyManagerLT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLT(FieldKeyManager, v))
+}
+
+// KeyManagerLTE applies the LTE predicate on the "key_manager" field.
+func KeyManagerLTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLTE(FieldKeyManager, v))
+}
+
+// KeyManagerContains applies the Contains predicate on the "key_manager" field.
+func KeyManagerContains(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContains(FieldKeyManager, v))
+}
+
+// KeyManagerHasPrefix applies the HasPrefix predicate on the "key_manager" field.
+func KeyManagerHasPrefix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasPrefix(FieldKeyManager, v))
+}
+
+// KeyManagerHasSuffix applies the HasSuffix predicate on the "key_manager" field.
+func KeyManagerHasSuffix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasSuffix(FieldKeyManager, v))
+}
+
+// KeyManagerIsNil applies the IsNil predicate on the "key_manager" field.
+func KeyManagerIsNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIsNull(FieldKeyManager))
+}
+
+// KeyManagerNotNil applies the NotNil predicate on the "key_manager" field.
+func KeyManagerNotNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotNull(FieldKeyManager))
+}
+
+// KeyManagerEqualFold applies the EqualFold predicate on the "key_manager" field.
+func KeyManagerEqualFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEqualFold(FieldKeyManager, v))
+}
+
+// KeyManagerContainsFold applies the ContainsFold predicate on the "key_manager" field.
+func KeyManagerContainsFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContainsFold(FieldKeyManager, v))
+}
+
+// OriginEQ applies the EQ predicate on the "origin" field.
+func OriginEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldOrigin, v))
+}
+
+// OriginNEQ applies the NEQ predicate on the "origin" field.
+func OriginNEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldOrigin, v))
+}
+
+// OriginIn applies the In predicate on the "origin" field.
+func OriginIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIn(FieldOrigin, vs...))
+}
+
+// OriginNotIn applies the NotIn predicate on the "origin" field.
+func OriginNotIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotIn(FieldOrigin, vs...))
+}
+
+// OriginGT applies the GT predicate on the "origin" field.
+func OriginGT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGT(FieldOrigin, v))
+}
+
+// OriginGTE applies the GTE predicate on the "origin" field.
+func OriginGTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGTE(FieldOrigin, v))
+}
+
+// OriginLT applies the LT predicate on the "origin" field.
+func OriginLT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLT(FieldOrigin, v))
+}
+
+// OriginLTE applies the LTE predicate on the "origin" field.
+func OriginLTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLTE(FieldOrigin, v))
+}
+
+// OriginContains applies the Contains predicate on the "origin" field.
+func OriginContains(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContains(FieldOrigin, v))
+}
+
+// OriginHasPrefix applies the HasPrefix predicate on the "origin" field.
+func OriginHasPrefix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasPrefix(FieldOrigin, v))
+}
+
+// OriginHasSuffix applies the HasSuffix predicate on the "origin" field.
+func OriginHasSuffix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasSuffix(FieldOrigin, v))
+}
+
+// OriginIsNil applies the IsNil predicate on the "origin" field.
+func OriginIsNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIsNull(FieldOrigin))
+}
+
+// OriginNotNil applies the NotNil predicate on the "origin" field.
+func OriginNotNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotNull(FieldOrigin))
+}
+
+// OriginEqualFold applies the EqualFold predicate on the "origin" field.
+func OriginEqualFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEqualFold(FieldOrigin, v))
+}
+
+// OriginContainsFold applies the ContainsFold predicate on the "origin" field.
+func OriginContainsFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContainsFold(FieldOrigin, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// EnabledIsNil applies the IsNil predicate on the "enabled" field.
+func EnabledIsNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIsNull(FieldEnabled))
+}
+
+// EnabledNotNil applies the NotNil predicate on the "enabled" field.
+func EnabledNotNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotNull(FieldEnabled))
+}
+
+// MultiRegionEQ applies the EQ predicate on the "multi_region" field.
+func MultiRegionEQ(v bool) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldMultiRegion, v))
+}
+
+// MultiRegionNEQ applies the NEQ predicate on the "multi_region" field.
+func MultiRegionNEQ(v bool) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldMultiRegion, v))
+}
+
+// MultiRegionIsNil applies the IsNil predicate on the "multi_region" field.
+func MultiRegionIsNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIsNull(FieldMultiRegion))
+}
+
+// MultiRegionNotNil applies the NotNil predicate on the "multi_region" field.
+func MultiRegionNotNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotNull(FieldMultiRegion))
+}
+
+// CreationDateEQ applies the EQ predicate on the "creation_date" field.
+func CreationDateEQ(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldCreationDate, v))
+}
+
+// CreationDateNEQ applies the NEQ predicate on the "creation_date" field.
+func CreationDateNEQ(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldCreationDate, v))
+}
+
+// CreationDateIn applies the In predicate on the "creation_date" field.
+func CreationDateIn(vs ...time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIn(FieldCreationDate, vs...))
+}
+
+// CreationDateNotIn applies the NotIn predicate on the "creation_date" field.
+func CreationDateNotIn(vs ...time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotIn(FieldCreationDate, vs...))
+}
+
+// CreationDateGT applies the GT predicate on the "creation_date" field.
+func CreationDateGT(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGT(FieldCreationDate, v))
+}
+
+// CreationDateGTE applies the GTE predicate on the "creation_date" field.
+func CreationDateGTE(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGTE(FieldCreationDate, v))
+}
+
+// CreationDateLT applies the LT predicate on the "creation_date" field.
+func CreationDateLT(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLT(FieldCreationDate, v))
+}
+
+// CreationDateLTE applies the LTE predicate on the "creation_date" field.
+func CreationDateLTE(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLTE(FieldCreationDate, v))
+}
+
+// CreationDateIsNil applies the IsNil predicate on the "creation_date" field.
+func CreationDateIsNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIsNull(FieldCreationDate))
+}
+
+// CreationDateNotNil applies the NotNil predicate on the "creation_date" field.
+func CreationDateNotNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotNull(FieldCreationDate))
+}
+
+// DeletionDateEQ applies the EQ predicate on the "deletion_date" field.
+func DeletionDateEQ(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldDeletionDate, v))
+}
+
+// DeletionDateNEQ applies the NEQ predicate on the "deletion_date" field.
+func DeletionDateNEQ(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldDeletionDate, v))
+}
+
+// DeletionDateIn applies the In predicate on the "deletion_date" field.
+func DeletionDateIn(vs ...time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIn(FieldDeletionDate, vs...))
+}
+
+// DeletionDateNotIn applies the NotIn predicate on the "deletion_date" field.
+func DeletionDateNotIn(vs ...time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotIn(FieldDeletionDate, vs...))
+}
+
+// DeletionDateGT applies the GT predicate on the "deletion_date" field.
+func DeletionDateGT(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGT(FieldDeletionDate, v))
+}
+
+// DeletionDateGTE applies the GTE predicate on the "deletion_date" field.
+func DeletionDateGTE(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGTE(FieldDeletionDate, v))
+}
+
+// DeletionDateLT applies the LT predicate on the "deletion_date" field.
+func DeletionDateLT(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLT(FieldDeletionDate, v))
+}
+
+// DeletionDateLTE applies the LTE predicate on the "deletion_date" field.
+func DeletionDateLTE(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLTE(FieldDeletionDate, v))
+}
+
+// DeletionDateIsNil applies the IsNil predicate on the "deletion_date" field.
+func DeletionDateIsNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIsNull(FieldDeletionDate))
+}
+
+// DeletionDateNotNil applies the NotNil predicate on the "deletion_date" field.
+func DeletionDateNotNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotNull(FieldDeletionDate))
+}
+
+// RotationEnabledEQ applies the EQ predicate on the "rotation_enabled" field.
+func RotationEnabledEQ(v bool) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldRotationEnabled, v))
+}
+
+// RotationEnabledNEQ applies the NEQ predicate on the "rotation_enabled" field.
+func RotationEnabledNEQ(v bool) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldRotationEnabled, v))
+}
+
+// RotationEnabledIsNil applies the IsNil predicate on the "rotation_enabled" field.
+func RotationEnabledIsNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIsNull(FieldRotationEnabled))
+}
+
+// RotationEnabledNotNil applies the NotNil predicate on the "rotation_enabled" field.
+func RotationEnabledNotNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotNull(FieldRotationEnabled))
+}
+
+// RotationPeriodInDaysEQ applies the EQ predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysEQ(v int32) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldRotationPeriodInDays, v))
+}
+
+// RotationPeriodInDaysNEQ applies the NEQ predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysNEQ(v int32) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldRotationPeriodInDays, v))
+}
+
+// RotationPeriodInDaysIn applies the In predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysIn(vs ...int32) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIn(FieldRotationPeriodInDays, vs...))
+}
+
+// RotationPeriodInDaysNotIn applies the NotIn predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysNotIn(vs ...int32) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotIn(FieldRotationPeriodInDays, vs...))
+}
+
+// RotationPeriodInDaysGT applies the GT predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysGT(v int32) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGT(FieldRotationPeriodInDays, v))
+}
+
+// RotationPeriodInDaysGTE applies the GTE predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysGTE(v int32) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGTE(FieldRotationPeriodInDays, v))
+}
+
+// RotationPeriodInDaysLT applies the LT predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysLT(v int32) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLT(FieldRotationPeriodInDays, v))
+}
+
+// RotationPeriodInDaysLTE applies the LTE predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysLTE(v int32) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLTE(FieldRotationPeriodInDays, v))
+}
+
+// RotationPeriodInDaysIsNil applies the IsNil predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysIsNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIsNull(FieldRotationPeriodInDays))
+}
+
+// RotationPeriodInDaysNotNil applies the NotNil predicate on the "rotation_period_in_days" field.
+func RotationPeriodInDaysNotNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotNull(FieldRotationPeriodInDays))
+}
+
+// NextRotationDateEQ applies the EQ predicate on the "next_rotation_date" field.
+func NextRotationDateEQ(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldNextRotationDate, v))
+}
+
+// NextRotationDateNEQ applies the NEQ predicate on the "next_rotation_date" field.
+func NextRotationDateNEQ(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldNextRotationDate, v))
+}
+
+// NextRotationDateIn applies the In predicate on the "next_rotation_date" field.
+func NextRotationDateIn(vs ...time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIn(FieldNextRotationDate, vs...))
+}
+
+// NextRotationDateNotIn applies the NotIn predicate on the "next_rotation_date" field.
+func NextRotationDateNotIn(vs ...time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotIn(FieldNextRotationDate, vs...))
+}
+
+// NextRotationDateGT applies the GT predicate on the "next_rotation_date" field.
+func NextRotationDateGT(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGT(FieldNextRotationDate, v))
+}
+
+// NextRotationDateGTE applies the GTE predicate on the "next_rotation_date" field.
+func NextRotationDateGTE(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGTE(FieldNextRotationDate, v))
+}
+
+// NextRotationDateLT applies the LT predicate on the "next_rotation_date" field.
+func NextRotationDateLT(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLT(FieldNextRotationDate, v))
+}
+
+// NextRotationDateLTE applies the LTE predicate on the "next_rotation_date" field.
+func NextRotationDateLTE(v time.Time) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLTE(FieldNextRotationDate, v))
+}
+
+// NextRotationDateIsNil applies the IsNil predicate on the "next_rotation_date" field.
+func NextRotationDateIsNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIsNull(FieldNextRotationDate))
+}
+
+// NextRotationDateNotNil applies the NotNil predicate on the "next_rotation_date" field.
+func NextRotationDateNotNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotNull(FieldNextRotationDate))
+}
+
+// PolicyJSONIsNil applies the IsNil predicate on the "policy_json" field.
+func PolicyJSONIsNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIsNull(FieldPolicyJSON))
+}
+
+// PolicyJSONNotNil applies the NotNil predicate on the "policy_json" field.
+func PolicyJSONNotNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotNull(FieldPolicyJSON))
+}
+
+// AliasesJSONIsNil applies the IsNil predicate on the "aliases_json" field.
+func AliasesJSONIsNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIsNull(FieldAliasesJSON))
+}
+
+// AliasesJSONNotNil applies the NotNil predicate on the "aliases_json" field.
+func AliasesJSONNotNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotNull(FieldAliasesJSON))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeHistoryAWSKMSKey) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeHistoryAWSKMSKey) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeHistoryAWSKMSKey) predicate.BronzeHistoryAWSKMSKey {
+	return predicate.BronzeHistoryAWSKMSKey(sql.NotPredicates(p))
+}
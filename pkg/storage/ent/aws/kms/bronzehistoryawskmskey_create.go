@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/bronzehistoryawskmskey"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSKMSKeyCreate is the builder for creating a BronzeHistoryAWSKMSKey entity.
+type BronzeHistoryAWSKMSKeyCreate struct {
+	config
+	mutation *BronzeHistoryAWSKMSKeyMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetValidFrom(v time.Time) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetValidTo(v time.Time) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetCollectedAt(v time.Time) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetResourceID(v string) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetArn sets the "arn" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetArn(v string) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetArn(v)
+	return _c
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetNillableArn(v *string) *BronzeHistoryAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetArn(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetDescription(v string) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetNillableDescription(v *string) *BronzeHistoryAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetKeyState sets the "key_state" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetKeyState(v string) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetKeyState(v)
+	return _c
+}
+
+// SetNillableKeyState sets the "key_state" field if the given value is not nil.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetNillableKeyState(v *string) *BronzeHistoryAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetKeyState(*v)
+	}
+	return _c
+}
+
+// SetKeyUsage sets the "key_usage" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetKeyUsage(v string) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetKeyUsage(v)
+	return _c
+}
+
+// SetNillableKeyUsage sets the "key_usage" field if the given value is not nil.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetNillableKeyUsage(v *string) *BronzeHistoryAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetKeyUsage(*v)
+	}
+	return _c
+}
+
+// SetKeySpec sets the "key_spec" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetKeySpec(v string) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetKeySpec(v)
+	return _c
+}
+
+// SetNillableKeySpec sets the "key_spec" field if the given value is not nil.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetNillableKeySpec(v *string) *BronzeHistoryAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetKeySpec(*v)
+	}
+	return _c
+}
+
+// SetKeyManager sets the "key_manager" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetKeyManager(v string) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetKeyManager(v)
+	return _c
+}
+
+// SetNillableKeyManager sets the "key_manager" field if the given value is not nil.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetNillableKeyManager(v *string) *BronzeHistoryAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetKeyManager(*v)
+	}
+	return _c
+}
+
+// SetOrigin sets the "origin" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetOrigin(v string) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetOrigin(v)
+	return _c
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetNillableOrigin(v *string) *BronzeHistoryAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetOrigin(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetEnabled(v bool) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetNillableEnabled(v *bool) *BronzeHistoryAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetMultiRegion sets the "multi_region" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetMultiRegion(v bool) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetMultiRegion(v)
+	return _c
+}
+
+// SetNillableMultiRegion sets the "multi_region" field if the given value is not nil.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetNillableMultiRegion(v *bool) *BronzeHistoryAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetMultiRegion(*v)
+	}
+	return _c
+}
+
+// SetCreationDate sets the "creation_date" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetCreationDate(v time.Time) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetCreationDate(v)
+	return _c
+}
+
+// SetNillableCreationDate sets the "creation_date" field if the given value is not nil.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetNillableCreationDate(v *time.Time) *BronzeHistoryAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetCreationDate(*v)
+	}
+	return _c
+}
+
+// SetDeletionDate sets the "deletion_date" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetDeletionDate(v time.Time) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetDeletionDate(v)
+	return _c
+}
+
+// SetNillableDeletionDate sets the "deletion_date" field if the given value is not nil.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetNillableDeletionDate(v *time.Time) *BronzeHistoryAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetDeletionDate(*v)
+	}
+	return _c
+}
+
+// SetRotationEnabled sets the "rotation_enabled" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetRotationEnabled(v bool) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetRotationEnabled(v)
+	return _c
+}
+
+// SetNillableRotationEnabled sets the "rotation_enabled" field if the given value is not nil.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetNillableRotationEnabled(v *bool) *BronzeHistoryAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetRotationEnabled(*v)
+	}
+	return _c
+}
+
+// SetRotationPeriodInDays sets the "rotation_period_in_days" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetRotationPeriodInDays(v int32) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetRotationPeriodInDays(v)
+	return _c
+}
+
+// SetNillableRotationPeriodInDays sets the "rotation_period_in_days" field if the given value is not nil.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetNillableRotationPeriodInDays(v *int32) *BronzeHistoryAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetRotationPeriodInDays(*v)
+	}
+	return _c
+}
+
+// SetNextRotationDate sets the "next_rotation_date" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetNextRotationDate(v time.Time) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetNextRotationDate(v)
+	return _c
+}
+
+// SetNillableNextRotationDate sets the "next_rotation_date" field if the given value is not nil.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetNillableNextRotationDate(v *time.Time) *BronzeHistoryAWSKMSKeyCreate {
+	if v != nil {
+		_c.SetNextRotationDate(*v)
+	}
+	return _c
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetPolicyJSON(v json.RawMessage) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetPolicyJSON(v)
+	return _c
+}
+
+// SetAliasesJSON sets the "aliases_json" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetAliasesJSON(v json.RawMessage) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetAliasesJSON(v)
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetAccountID(v string) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetRegion(v string) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SetID(v uint) *BronzeHistoryAWSKMSKeyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryAWSKMSKeyMutation object of the builder.
+func (_c *BronzeHistoryAWSKMSKeyCreate) Mutation() *BronzeHistoryAWSKMSKeyMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryAWSKMSKey in the database.
+func (_c *BronzeHistoryAWSKMSKeyCreate) Save(ctx context.Context) (*BronzeHistoryAWSKMSKey, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryAWSKMSKeyCreate) SaveX(ctx context.Context) *BronzeHistoryAWSKMSKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSKMSKeyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSKMSKeyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryAWSKMSKeyCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`kms: missing required field "BronzeHistoryAWSKMSKey.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`kms: missing required field "BronzeHistoryAWSKMSKey.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`kms: missing required field "BronzeHistoryAWSKMSKey.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`kms: missing required field "BronzeHistoryAWSKMSKey.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryawskmskey.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kms: validator failed for field "BronzeHistoryAWSKMSKey.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`kms: missing required field "BronzeHistoryAWSKMSKey.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzehistoryawskmskey.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`kms: validator failed for field "BronzeHistoryAWSKMSKey.account_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`kms: missing required field "BronzeHistoryAWSKMSKey.region"`)}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := bronzehistoryawskmskey.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`kms: validator failed for field "BronzeHistoryAWSKMSKey.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryAWSKMSKeyCreate) sqlSave(ctx context.Context) (*BronzeHistoryAWSKMSKey, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryAWSKMSKeyCreate) createSpec() (*BronzeHistoryAWSKMSKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryAWSKMSKey{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryawskmskey.Table, sqlgraph.NewFieldSpec(bronzehistoryawskmskey.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryAWSKMSKey
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.Arn(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldArn, field.TypeString, value)
+		_node.Arn = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.KeyState(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldKeyState, field.TypeString, value)
+		_node.KeyState = value
+	}
+	if value, ok := _c.mutation.KeyUsage(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldKeyUsage, field.TypeString, value)
+		_node.KeyUsage = value
+	}
+	if value, ok := _c.mutation.KeySpec(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldKeySpec, field.TypeString, value)
+		_node.KeySpec = value
+	}
+	if value, ok := _c.mutation.KeyManager(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldKeyManager, field.TypeString, value)
+		_node.KeyManager = value
+	}
+	if value, ok := _c.mutation.Origin(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldOrigin, field.TypeString, value)
+		_node.Origin = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.MultiRegion(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldMultiRegion, field.TypeBool, value)
+		_node.MultiRegion = value
+	}
+	if value, ok := _c.mutation.CreationDate(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldCreationDate, field.TypeTime, value)
+		_node.CreationDate = &value
+	}
+	if value, ok := _c.mutation.DeletionDate(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldDeletionDate, field.TypeTime, value)
+		_node.DeletionDate = &value
+	}
+	if value, ok := _c.mutation.RotationEnabled(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldRotationEnabled, field.TypeBool, value)
+		_node.RotationEnabled = &value
+	}
+	if value, ok := _c.mutation.RotationPeriodInDays(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldRotationPeriodInDays, field.TypeInt32, value)
+		_node.RotationPeriodInDays = &value
+	}
+	if value, ok := _c.mutation.NextRotationDate(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldNextRotationDate, field.TypeTime, value)
+		_node.NextRotationDate = &value
+	}
+	if value, ok := _c.mutation.PolicyJSON(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldPolicyJSON, field.TypeJSON, value)
+		_node.PolicyJSON = value
+	}
+	if value, ok := _c.mutation.AliasesJSON(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldAliasesJSON, field.TypeJSON, value)
+		_node.AliasesJSON = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryAWSKMSKeyCreateBulk is the builder for creating many BronzeHistoryAWSKMSKey entities in bulk.
+type BronzeHistoryAWSKMSKeyCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryAWSKMSKeyCreate
+}
+
+// Save creates the BronzeHistoryAWSKMSKey entities in the database.
+func (_c *BronzeHistoryAWSKMSKeyCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryAWSKMSKey, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryAWSKMSKey, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryAWSKMSKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryAWSKMSKeyCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryAWSKMSKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSKMSKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSKMSKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kms
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/bronzehistoryawskmskey"
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSKMSKeyDelete is the builder for deleting a BronzeHistoryAWSKMSKey entity.
+type BronzeHistoryAWSKMSKeyDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSKMSKeyMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSKMSKeyDelete builder.
+func (_d *BronzeHistoryAWSKMSKeyDelete) Where(ps ...predicate.BronzeHistoryAWSKMSKey) *BronzeHistoryAWSKMSKeyDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeHistoryAWSKMSKeyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryAWSKMSKeyDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeHistoryAWSKMSKeyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzehistoryawskmskey.Table, sqlgraph.NewFieldSpec(bronzehistoryawskmskey.FieldID, field.TypeUint))
+	_spec.Node.Schema = _d.schemaConfig.BronzeHistoryAWSKMSKey
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeHistoryAWSKMSKeyDeleteOne is the builder for deleting a single BronzeHistoryAWSKMSKey entity.
+type BronzeHistoryAWSKMSKeyDeleteOne struct {
+	_d *BronzeHistoryAWSKMSKeyDelete
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSKMSKeyDelete builder.
+func (_d *BronzeHistoryAWSKMSKeyDeleteOne) Where(ps ...predicate.BronzeHistoryAWSKMSKey) *BronzeHistoryAWSKMSKeyDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeHistoryAWSKMSKeyDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzehistoryawskmskey.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryAWSKMSKeyDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1373 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/bronzehistoryawskmskey"
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSKMSKeyUpdate is the builder for updating BronzeHistoryAWSKMSKey entities.
+type BronzeHistoryAWSKMSKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSKMSKeyMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSKMSKeyUpdate builder.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) Where(ps ...predicate.BronzeHistoryAWSKMSKey) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetValidTo(v time.Time) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) ClearValidTo() *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetCollectedAt(v time.Time) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetResourceID(v string) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableResourceID(v *string) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetArn(v string) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableArn(v *string) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// ClearArn clears the value of the "arn" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) ClearArn() *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.ClearArn()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetDescription(v string) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableDescription(v *string) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) ClearDescription() *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetKeyState sets the "key_state" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetKeyState(v string) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetKeyState(v)
+	return _u
+}
+
+// SetNillableKeyState sets the "key_state" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableKeyState(v *string) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetKeyState(*v)
+	}
+	return _u
+}
+
+// ClearKeyState clears the value of the "key_state" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) ClearKeyState() *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.ClearKeyState()
+	return _u
+}
+
+// SetKeyUsage sets the "key_usage" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetKeyUsage(v string) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetKeyUsage(v)
+	return _u
+}
+
+// SetNillableKeyUsage sets the "key_usage" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableKeyUsage(v *string) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetKeyUsage(*v)
+	}
+	return _u
+}
+
+// ClearKeyUsage clears the value of the "key_usage" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) ClearKeyUsage() *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.ClearKeyUsage()
+	return _u
+}
+
+// SetKeySpec sets the "key_spec" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetKeySpec(v string) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetKeySpec(v)
+	return _u
+}
+
+// SetNillableKeySpec sets the "key_spec" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableKeySpec(v *string) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetKeySpec(*v)
+	}
+	return _u
+}
+
+// ClearKeySpec clears the value of the "key_spec" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) ClearKeySpec() *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.ClearKeySpec()
+	return _u
+}
+
+// SetKeyManager sets the "key_manager" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetKeyManager(v string) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetKeyManager(v)
+	return _u
+}
+
+// SetNillableKeyManager sets the "key_manager" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableKeyManager(v *string) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetKeyManager(*v)
+	}
+	return _u
+}
+
+// ClearKeyManager clears the value of the "key_manager" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) ClearKeyManager() *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.ClearKeyManager()
+	return _u
+}
+
+// SetOrigin sets the "origin" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetOrigin(v string) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetOrigin(v)
+	return _u
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableOrigin(v *string) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetOrigin(*v)
+	}
+	return _u
+}
+
+// ClearOrigin clears the value of the "origin" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) ClearOrigin() *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.ClearOrigin()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetEnabled(v bool) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableEnabled(v *bool) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) ClearEnabled() *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.ClearEnabled()
+	return _u
+}
+
+// SetMultiRegion sets the "multi_region" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetMultiRegion(v bool) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetMultiRegion(v)
+	return _u
+}
+
+// SetNillableMultiRegion sets the "multi_region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableMultiRegion(v *bool) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetMultiRegion(*v)
+	}
+	return _u
+}
+
+// ClearMultiRegion clears the value of the "multi_region" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) ClearMultiRegion() *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.ClearMultiRegion()
+	return _u
+}
+
+// SetCreationDate sets the "creation_date" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetCreationDate(v time.Time) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetCreationDate(v)
+	return _u
+}
+
+// SetNillableCreationDate sets the "creation_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableCreationDate(v *time.Time) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetCreationDate(*v)
+	}
+	return _u
+}
+
+// ClearCreationDate clears the value of the "creation_date" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) ClearCreationDate() *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.ClearCreationDate()
+	return _u
+}
+
+// SetDeletionDate sets the "deletion_date" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetDeletionDate(v time.Time) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetDeletionDate(v)
+	return _u
+}
+
+// SetNillableDeletionDate sets the "deletion_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableDeletionDate(v *time.Time) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetDeletionDate(*v)
+	}
+	return _u
+}
+
+// ClearDeletionDate clears the value of the "deletion_date" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) ClearDeletionDate() *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.ClearDeletionDate()
+	return _u
+}
+
+// SetRotationEnabled sets the "rotation_enabled" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetRotationEnabled(v bool) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetRotationEnabled(v)
+	return _u
+}
+
+// SetNillableRotationEnabled sets the "rotation_enabled" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableRotationEnabled(v *bool) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetRotationEnabled(*v)
+	}
+	return _u
+}
+
+// ClearRotationEnabled clears the value of the "rotation_enabled" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) ClearRotationEnabled() *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.ClearRotationEnabled()
+	return _u
+}
+
+// SetRotationPeriodInDays sets the "rotation_period_in_days" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetRotationPeriodInDays(v int32) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.ResetRotationPeriodInDays()
+	_u.mutation.SetRotationPeriodInDays(v)
+	return _u
+}
+
+// SetNillableRotationPeriodInDays sets the "rotation_period_in_days" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableRotationPeriodInDays(v *int32) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetRotationPeriodInDays(*v)
+	}
+	return _u
+}
+
+// AddRotationPeriodInDays adds value to the "rotation_period_in_days" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) AddRotationPeriodInDays(v int32) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.AddRotationPeriodInDays(v)
+	return _u
+}
+
+// ClearRotationPeriodInDays clears the value of the "rotation_period_in_days" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) ClearRotationPeriodInDays() *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.ClearRotationPeriodInDays()
+	return _u
+}
+
+// SetNextRotationDate sets the "next_rotation_date" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNextRotationDate(v time.Time) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetNextRotationDate(v)
+	return _u
+}
+
+// SetNillableNextRotationDate sets the "next_rotation_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableNextRotationDate(v *time.Time) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetNextRotationDate(*v)
+	}
+	return _u
+}
+
+// ClearNextRotationDate clears the value of the "next_rotation_date" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) ClearNextRotationDate() *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.ClearNextRotationDate()
+	return _u
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetPolicyJSON(v json.RawMessage) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetPolicyJSON(v)
+	return _u
+}
+
+// AppendPolicyJSON appends value to the "policy_json" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) AppendPolicyJSON(v json.RawMessage) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.AppendPolicyJSON(v)
+	return _u
+}
+
+// ClearPolicyJSON clears the value of the "policy_json" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) ClearPolicyJSON() *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.ClearPolicyJSON()
+	return _u
+}
+
+// SetAliasesJSON sets the "aliases_json" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetAliasesJSON(v json.RawMessage) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetAliasesJSON(v)
+	return _u
+}
+
+// AppendAliasesJSON appends value to the "aliases_json" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) AppendAliasesJSON(v json.RawMessage) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.AppendAliasesJSON(v)
+	return _u
+}
+
+// ClearAliasesJSON clears the value of the "aliases_json" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) ClearAliasesJSON() *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.ClearAliasesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) ClearTagsJSON() *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetAccountID(v string) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableAccountID(v *string) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetRegion(v string) *BronzeHistoryAWSKMSKeyUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SetNillableRegion(v *string) *BronzeHistoryAWSKMSKeyUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSKMSKeyMutation object of the builder.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) Mutation() *BronzeHistoryAWSKMSKeyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSKMSKeyUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawskmskey.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kms: validator failed for field "BronzeHistoryAWSKMSKey.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawskmskey.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`kms: validator failed for field "BronzeHistoryAWSKMSKey.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawskmskey.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`kms: validator failed for field "BronzeHistoryAWSKMSKey.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSKMSKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawskmskey.Table, bronzehistoryawskmskey.Columns, sqlgraph.NewFieldSpec(bronzehistoryawskmskey.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldArn, field.TypeString, value)
+	}
+	if _u.mutation.ArnCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeyState(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldKeyState, field.TypeString, value)
+	}
+	if _u.mutation.KeyStateCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldKeyState, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeyUsage(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldKeyUsage, field.TypeString, value)
+	}
+	if _u.mutation.KeyUsageCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldKeyUsage, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeySpec(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldKeySpec, field.TypeString, value)
+	}
+	if _u.mutation.KeySpecCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldKeySpec, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeyManager(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldKeyManager, field.TypeString, value)
+	}
+	if _u.mutation.KeyManagerCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldKeyManager, field.TypeString)
+	}
+	if value, ok := _u.mutation.Origin(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldOrigin, field.TypeString, value)
+	}
+	if _u.mutation.OriginCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldOrigin, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.EnabledCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.MultiRegion(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldMultiRegion, field.TypeBool, value)
+	}
+	if _u.mutation.MultiRegionCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldMultiRegion, field.TypeBool)
+	}
+	if value, ok := _u.mutation.CreationDate(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldCreationDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreationDateCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldCreationDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletionDate(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldDeletionDate, field.TypeTime, value)
+	}
+	if _u.mutation.DeletionDateCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldDeletionDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RotationEnabled(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldRotationEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.RotationEnabledCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldRotationEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RotationPeriodInDays(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldRotationPeriodInDays, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedRotationPeriodInDays(); ok {
+		_spec.AddField(bronzehistoryawskmskey.FieldRotationPeriodInDays, field.TypeInt32, value)
+	}
+	if _u.mutation.RotationPeriodInDaysCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldRotationPeriodInDays, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.NextRotationDate(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldNextRotationDate, field.TypeTime, value)
+	}
+	if _u.mutation.NextRotationDateCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldNextRotationDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PolicyJSON(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldPolicyJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolicyJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawskmskey.FieldPolicyJSON, value)
+		})
+	}
+	if _u.mutation.PolicyJSONCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldPolicyJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AliasesJSON(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldAliasesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAliasesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawskmskey.FieldAliasesJSON, value)
+		})
+	}
+	if _u.mutation.AliasesJSONCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldAliasesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawskmskey.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSKMSKey
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawskmskey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryAWSKMSKeyUpdateOne is the builder for updating a single BronzeHistoryAWSKMSKey entity.
+type BronzeHistoryAWSKMSKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryAWSKMSKeyMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetValidTo(v time.Time) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) ClearValidTo() *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetResourceID(v string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetArn(v string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableArn(v *string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// ClearArn clears the value of the "arn" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) ClearArn() *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.ClearArn()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetDescription(v string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableDescription(v *string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) ClearDescription() *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetKeyState sets the "key_state" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetKeyState(v string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetKeyState(v)
+	return _u
+}
+
+// SetNillableKeyState sets the "key_state" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableKeyState(v *string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetKeyState(*v)
+	}
+	return _u
+}
+
+// ClearKeyState clears the value of the "key_state" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) ClearKeyState() *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.ClearKeyState()
+	return _u
+}
+
+// SetKeyUsage sets the "key_usage" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetKeyUsage(v string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetKeyUsage(v)
+	return _u
+}
+
+// SetNillableKeyUsage sets the "key_usage" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableKeyUsage(v *string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetKeyUsage(*v)
+	}
+	return _u
+}
+
+// ClearKeyUsage clears the value of the "key_usage" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) ClearKeyUsage() *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.ClearKeyUsage()
+	return _u
+}
+
+// SetKeySpec sets the "key_spec" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetKeySpec(v string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetKeySpec(v)
+	return _u
+}
+
+// SetNillableKeySpec sets the "key_spec" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableKeySpec(v *string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetKeySpec(*v)
+	}
+	return _u
+}
+
+// ClearKeySpec clears the value of the "key_spec" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) ClearKeySpec() *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.ClearKeySpec()
+	return _u
+}
+
+// SetKeyManager sets the "key_manager" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetKeyManager(v string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetKeyManager(v)
+	return _u
+}
+
+// SetNillableKeyManager sets the "key_manager" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableKeyManager(v *string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetKeyManager(*v)
+	}
+	return _u
+}
+
+// ClearKeyManager clears the value of the "key_manager" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) ClearKeyManager() *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.ClearKeyManager()
+	return _u
+}
+
+// SetOrigin sets the "origin" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetOrigin(v string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetOrigin(v)
+	return _u
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableOrigin(v *string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetOrigin(*v)
+	}
+	return _u
+}
+
+// ClearOrigin clears the value of the "origin" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) ClearOrigin() *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.ClearOrigin()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetEnabled(v bool) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableEnabled(v *bool) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) ClearEnabled() *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.ClearEnabled()
+	return _u
+}
+
+// SetMultiRegion sets the "multi_region" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetMultiRegion(v bool) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetMultiRegion(v)
+	return _u
+}
+
+// SetNillableMultiRegion sets the "multi_region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableMultiRegion(v *bool) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetMultiRegion(*v)
+	}
+	return _u
+}
+
+// ClearMultiRegion clears the value of the "multi_region" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) ClearMultiRegion() *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.ClearMultiRegion()
+	return _u
+}
+
+// SetCreationDate sets the "creation_date" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetCreationDate(v time.Time) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetCreationDate(v)
+	return _u
+}
+
+// SetNillableCreationDate sets the "creation_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableCreationDate(v *time.Time) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetCreationDate(*v)
+	}
+	return _u
+}
+
+// ClearCreationDate clears the value of the "creation_date" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) ClearCreationDate() *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.ClearCreationDate()
+	return _u
+}
+
+// SetDeletionDate sets the "deletion_date" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetDeletionDate(v time.Time) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetDeletionDate(v)
+	return _u
+}
+
+// SetNillableDeletionDate sets the "deletion_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableDeletionDate(v *time.Time) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetDeletionDate(*v)
+	}
+	return _u
+}
+
+// ClearDeletionDate clears the value of the "deletion_date" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) ClearDeletionDate() *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.ClearDeletionDate()
+	return _u
+}
+
+// SetRotationEnabled sets the "rotation_enabled" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetRotationEnabled(v bool) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetRotationEnabled(v)
+	return _u
+}
+
+// SetNillableRotationEnabled sets the "rotation_enabled" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableRotationEnabled(v *bool) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetRotationEnabled(*v)
+	}
+	return _u
+}
+
+// ClearRotationEnabled clears the value of the "rotation_enabled" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) ClearRotationEnabled() *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.ClearRotationEnabled()
+	return _u
+}
+
+// SetRotationPeriodInDays sets the "rotation_period_in_days" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetRotationPeriodInDays(v int32) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.ResetRotationPeriodInDays()
+	_u.mutation.SetRotationPeriodInDays(v)
+	return _u
+}
+
+// SetNillableRotationPeriodInDays sets the "rotation_period_in_days" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableRotationPeriodInDays(v *int32) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetRotationPeriodInDays(*v)
+	}
+	return _u
+}
+
+// AddRotationPeriodInDays adds value to the "rotation_period_in_days" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) AddRotationPeriodInDays(v int32) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.AddRotationPeriodInDays(v)
+	return _u
+}
+
+// ClearRotationPeriodInDays clears the value of the "rotation_period_in_days" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) ClearRotationPeriodInDays() *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.ClearRotationPeriodInDays()
+	return _u
+}
+
+// SetNextRotationDate sets the "next_rotation_date" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNextRotationDate(v time.Time) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetNextRotationDate(v)
+	return _u
+}
+
+// SetNillableNextRotationDate sets the "next_rotation_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableNextRotationDate(v *time.Time) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetNextRotationDate(*v)
+	}
+	return _u
+}
+
+// ClearNextRotationDate clears the value of the "next_rotation_date" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) ClearNextRotationDate() *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.ClearNextRotationDate()
+	return _u
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetPolicyJSON(v json.RawMessage) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetPolicyJSON(v)
+	return _u
+}
+
+// AppendPolicyJSON appends value to the "policy_json" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) AppendPolicyJSON(v json.RawMessage) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.AppendPolicyJSON(v)
+	return _u
+}
+
+// ClearPolicyJSON clears the value of the "policy_json" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) ClearPolicyJSON() *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.ClearPolicyJSON()
+	return _u
+}
+
+// SetAliasesJSON sets the "aliases_json" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetAliasesJSON(v json.RawMessage) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetAliasesJSON(v)
+	return _u
+}
+
+// AppendAliasesJSON appends value to the "aliases_json" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) AppendAliasesJSON(v json.RawMessage) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.AppendAliasesJSON(v)
+	return _u
+}
+
+// ClearAliasesJSON clears the value of the "aliases_json" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) ClearAliasesJSON() *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.ClearAliasesJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) ClearTagsJSON() *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetAccountID(v string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetRegion(v string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SetNillableRegion(v *string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSKMSKeyMutation object of the builder.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) Mutation() *BronzeHistoryAWSKMSKeyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSKMSKeyUpdate builder.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) Where(ps ...predicate.BronzeHistoryAWSKMSKey) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) Select(field string, fields ...string) *BronzeHistoryAWSKMSKeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryAWSKMSKey entity.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) Save(ctx context.Context) (*BronzeHistoryAWSKMSKey, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) SaveX(ctx context.Context) *BronzeHistoryAWSKMSKey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawskmskey.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kms: validator failed for field "BronzeHistoryAWSKMSKey.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawskmskey.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`kms: validator failed for field "BronzeHistoryAWSKMSKey.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawskmskey.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`kms: validator failed for field "BronzeHistoryAWSKMSKey.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSKMSKeyUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryAWSKMSKey, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawskmskey.Table, bronzehistoryawskmskey.Columns, sqlgraph.NewFieldSpec(bronzehistoryawskmskey.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`kms: missing "BronzeHistoryAWSKMSKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawskmskey.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryawskmskey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("kms: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryawskmskey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldArn, field.TypeString, value)
+	}
+	if _u.mutation.ArnCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeyState(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldKeyState, field.TypeString, value)
+	}
+	if _u.mutation.KeyStateCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldKeyState, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeyUsage(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldKeyUsage, field.TypeString, value)
+	}
+	if _u.mutation.KeyUsageCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldKeyUsage, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeySpec(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldKeySpec, field.TypeString, value)
+	}
+	if _u.mutation.KeySpecCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldKeySpec, field.TypeString)
+	}
+	if value, ok := _u.mutation.KeyManager(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldKeyManager, field.TypeString, value)
+	}
+	if _u.mutation.KeyManagerCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldKeyManager, field.TypeString)
+	}
+	if value, ok := _u.mutation.Origin(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldOrigin, field.TypeString, value)
+	}
+	if _u.mutation.OriginCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldOrigin, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.EnabledCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.MultiRegion(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldMultiRegion, field.TypeBool, value)
+	}
+	if _u.mutation.MultiRegionCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldMultiRegion, field.TypeBool)
+	}
+	if value, ok := _u.mutation.CreationDate(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldCreationDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreationDateCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldCreationDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletionDate(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldDeletionDate, field.TypeTime, value)
+	}
+	if _u.mutation.DeletionDateCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldDeletionDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RotationEnabled(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldRotationEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.RotationEnabledCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldRotationEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RotationPeriodInDays(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldRotationPeriodInDays, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedRotationPeriodInDays(); ok {
+		_spec.AddField(bronzehistoryawskmskey.FieldRotationPeriodInDays, field.TypeInt32, value)
+	}
+	if _u.mutation.RotationPeriodInDaysCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldRotationPeriodInDays, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.NextRotationDate(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldNextRotationDate, field.TypeTime, value)
+	}
+	if _u.mutation.NextRotationDateCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldNextRotationDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PolicyJSON(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldPolicyJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolicyJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawskmskey.FieldPolicyJSON, value)
+		})
+	}
+	if _u.mutation.PolicyJSONCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldPolicyJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AliasesJSON(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldAliasesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAliasesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawskmskey.FieldAliasesJSON, value)
+		})
+	}
+	if _u.mutation.AliasesJSONCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldAliasesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawskmskey.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawskmskey.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawskmskey.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSKMSKey
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryAWSKMSKey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawskmskey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,499 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/migrate"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/bronzeawskmskey"
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/bronzehistoryawskmskey"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/internal"
+)
+
+// Client is the client that holds all ent builders.
+type Client struct {
+	config
+	// Schema is the client for creating, migrating and dropping schema.
+	Schema *migrate.Schema
+	// BronzeAWSKMSKey is the client for interacting with the BronzeAWSKMSKey builders.
+	BronzeAWSKMSKey *BronzeAWSKMSKeyClient
+	// BronzeHistoryAWSKMSKey is the client for interacting with the BronzeHistoryAWSKMSKey builders.
+	BronzeHistoryAWSKMSKey *BronzeHistoryAWSKMSKeyClient
+}
+
+// NewClient creates a new client configured with the given options.
+func NewClient(opts ...Option) *Client {
+	client := &Client{config: newConfig(opts...)}
+	client.init()
+	return client
+}
+
+func (c *Client) init() {
+	c.Schema = migrate.NewSchema(c.driver)
+	c.BronzeAWSKMSKey = NewBronzeAWSKMSKeyClient(c.config)
+	c.BronzeHistoryAWSKMSKey = NewBronzeHistoryAWSKMSKeyClient(c.config)
+}
+
+type (
+	// config is the configuration for the client and its builder.
+	config struct {
+		// driver used for executing database requests.
+		driver dialect.Driver
+		// debug enable a debug logging.
+		debug bool
+		// log used for logging on debug mode.
+		log func(...any)
+		// hooks to execute on mutations.
+		hooks *hooks
+		// interceptors to execute on queries.
+		inters *inters
+		// schemaConfig contains alternative names for all tables.
+		schemaConfig SchemaConfig
+	}
+	// Option function to configure the client.
+	Option func(*config)
+)
+
+// newConfig creates a new config for the client.
+func newConfig(opts ...Option) config {
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
+	cfg.options(opts...)
+	return cfg
+}
+
+// options applies the options on the config object.
+func (c *config) options(opts ...Option) {
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.debug {
+		c.driver = dialect.Debug(c.driver, c.log)
+	}
+}
+
+// Debug enables debug logging on the ent.Driver.
+func Debug() Option {
+	return func(c *config) {
+		c.debug = true
+	}
+}
+
+// Log sets the logging function for debug mode.
+func Log(fn func(...any)) Option {
+	return func(c *config) {
+		c.log = fn
+	}
+}
+
+// Driver configures the client driver.
+func Driver(driver dialect.Driver) Option {
+	return func(c *config) {
+		c.driver = driver
+	}
+}
+
+// Open opens a database/sql.DB specified by the driver name and
+// the data source name, and returns a new client attached to it.
+// Optional parameters can be added for configuring the client.
+func Open(driverName, dataSourceName string, options ...Option) (*Client, error) {
+	switch driverName {
+	case dialect.MySQL, dialect.Postgres, dialect.SQLite:
+		drv, err := sql.Open(driverName, dataSourceName)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(append(options, Driver(drv))...), nil
+	default:
+		return nil, fmt.Errorf("unsupported driver: %q", driverName)
+	}
+}
+
+// ErrTxStarted is returned when trying to start a new transaction from a transactional client.
+var ErrTxStarted = errors.New("kms: cannot start a transaction within a transaction")
+
+// Tx returns a new transactional client. The provided context
+// is used until the transaction is committed or rolled back.
+func (c *Client) Tx(ctx context.Context) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, ErrTxStarted
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("kms: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	return &Tx{
+		ctx:                    ctx,
+		config:                 cfg,
+		BronzeAWSKMSKey:        NewBronzeAWSKMSKeyClient(cfg),
+		BronzeHistoryAWSKMSKey: NewBronzeHistoryAWSKMSKeyClient(cfg),
+	}, nil
+}
+
+// BeginTx returns a transactional client with specified options.
+func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, errors.New("ent: cannot start a transaction within a transaction")
+	}
+	tx, err := c.driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}).BeginTx(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = &txDriver{tx: tx, drv: c.driver}
+	return &Tx{
+		ctx:                    ctx,
+		config:                 cfg,
+		BronzeAWSKMSKey:        NewBronzeAWSKMSKeyClient(cfg),
+		BronzeHistoryAWSKMSKey: NewBronzeHistoryAWSKMSKeyClient(cfg),
+	}, nil
+}
+
+// Debug returns a new debug-client. It's used to get verbose logging on specific operations.
+//
+//	client.Debug().
+//		BronzeAWSKMSKey.
+//		Query().
+//		Count(ctx)
+func (c *Client) Debug() *Client {
+	if c.debug {
+		return c
+	}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// Close closes the database connection and prevents new queries from starting.
+func (c *Client) Close() error {
+	return c.driver.Close()
+}
+
+// Use adds the mutation hooks to all the entity clients.
+// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
+func (c *Client) Use(hooks ...Hook) {
+	c.BronzeAWSKMSKey.Use(hooks...)
+	c.BronzeHistoryAWSKMSKey.Use(hooks...)
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.BronzeAWSKMSKey.Intercept(interceptors...)
+	c.BronzeHistoryAWSKMSKey.Intercept(interceptors...)
+}
+
+// Mutate implements the ent.Mutator interface.
+func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
+	switch m := m.(type) {
+	case *BronzeAWSKMSKeyMutation:
+		return c.BronzeAWSKMSKey.mutate(ctx, m)
+	case *BronzeHistoryAWSKMSKeyMutation:
+		return c.BronzeHistoryAWSKMSKey.mutate(ctx, m)
+	default:
+		return nil, fmt.Errorf("kms: unknown mutation type %T", m)
+	}
+}
+
+// BronzeAWSKMSKeyClient is a client for the BronzeAWSKMSKey schema.
+type BronzeAWSKMSKeyClient struct {
+	config
+}
+
+// NewBronzeAWSKMSKeyClient returns a client for the BronzeAWSKMSKey from the given config.
+func NewBronzeAWSKMSKeyClient(c config) *BronzeAWSKMSKeyClient {
+	return &BronzeAWSKMSKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzeawskmskey.Hooks(f(g(h())))`.
+func (c *BronzeAWSKMSKeyClient) Use(hooks ...Hook) {
+	c.hooks.BronzeAWSKMSKey = append(c.hooks.BronzeAWSKMSKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzeawskmskey.Intercept(f(g(h())))`.
+func (c *BronzeAWSKMSKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeAWSKMSKey = append(c.inters.BronzeAWSKMSKey, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeAWSKMSKey entity.
+func (c *BronzeAWSKMSKeyClient) Create() *BronzeAWSKMSKeyCreate {
+	mutation := newBronzeAWSKMSKeyMutation(c.config, OpCreate)
+	return &BronzeAWSKMSKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeAWSKMSKey entities.
+func (c *BronzeAWSKMSKeyClient) CreateBulk(builders ...*BronzeAWSKMSKeyCreate) *BronzeAWSKMSKeyCreateBulk {
+	return &BronzeAWSKMSKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeAWSKMSKeyClient) MapCreateBulk(slice any, setFunc func(*BronzeAWSKMSKeyCreate, int)) *BronzeAWSKMSKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeAWSKMSKeyCreateBulk{err: fmt.Errorf("calling to BronzeAWSKMSKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeAWSKMSKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeAWSKMSKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeAWSKMSKey.
+func (c *BronzeAWSKMSKeyClient) Update() *BronzeAWSKMSKeyUpdate {
+	mutation := newBronzeAWSKMSKeyMutation(c.config, OpUpdate)
+	return &BronzeAWSKMSKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeAWSKMSKeyClient) UpdateOne(_m *BronzeAWSKMSKey) *BronzeAWSKMSKeyUpdateOne {
+	mutation := newBronzeAWSKMSKeyMutation(c.config, OpUpdateOne, withBronzeAWSKMSKey(_m))
+	return &BronzeAWSKMSKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeAWSKMSKeyClient) UpdateOneID(id string) *BronzeAWSKMSKeyUpdateOne {
+	mutation := newBronzeAWSKMSKeyMutation(c.config, OpUpdateOne, withBronzeAWSKMSKeyID(id))
+	return &BronzeAWSKMSKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeAWSKMSKey.
+func (c *BronzeAWSKMSKeyClient) Delete() *BronzeAWSKMSKeyDelete {
+	mutation := newBronzeAWSKMSKeyMutation(c.config, OpDelete)
+	return &BronzeAWSKMSKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeAWSKMSKeyClient) DeleteOne(_m *BronzeAWSKMSKey) *BronzeAWSKMSKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeAWSKMSKeyClient) DeleteOneID(id string) *BronzeAWSKMSKeyDeleteOne {
+	builder := c.Delete().Where(bronzeawskmskey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeAWSKMSKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeAWSKMSKey.
+func (c *BronzeAWSKMSKeyClient) Query() *BronzeAWSKMSKeyQuery {
+	return &BronzeAWSKMSKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeAWSKMSKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeAWSKMSKey entity by its id.
+func (c *BronzeAWSKMSKeyClient) Get(ctx context.Context, id string) (*BronzeAWSKMSKey, error) {
+	return c.Query().Where(bronzeawskmskey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeAWSKMSKeyClient) GetX(ctx context.Context, id string) *BronzeAWSKMSKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeAWSKMSKeyClient) Hooks() []Hook {
+	return c.hooks.BronzeAWSKMSKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeAWSKMSKeyClient) Interceptors() []Interceptor {
+	return c.inters.BronzeAWSKMSKey
+}
+
+func (c *BronzeAWSKMSKeyClient) mutate(ctx context.Context, m *BronzeAWSKMSKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeAWSKMSKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeAWSKMSKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeAWSKMSKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeAWSKMSKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("kms: unknown BronzeAWSKMSKey mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSKMSKeyClient is a client for the BronzeHistoryAWSKMSKey schema.
+type BronzeHistoryAWSKMSKeyClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSKMSKeyClient returns a client for the BronzeHistoryAWSKMSKey from the given config.
+func NewBronzeHistoryAWSKMSKeyClient(c config) *BronzeHistoryAWSKMSKeyClient {
+	return &BronzeHistoryAWSKMSKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawskmskey.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSKMSKeyClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSKMSKey = append(c.hooks.BronzeHistoryAWSKMSKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawskmskey.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSKMSKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSKMSKey = append(c.inters.BronzeHistoryAWSKMSKey, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSKMSKey entity.
+func (c *BronzeHistoryAWSKMSKeyClient) Create() *BronzeHistoryAWSKMSKeyCreate {
+	mutation := newBronzeHistoryAWSKMSKeyMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSKMSKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSKMSKey entities.
+func (c *BronzeHistoryAWSKMSKeyClient) CreateBulk(builders ...*BronzeHistoryAWSKMSKeyCreate) *BronzeHistoryAWSKMSKeyCreateBulk {
+	return &BronzeHistoryAWSKMSKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSKMSKeyClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSKMSKeyCreate, int)) *BronzeHistoryAWSKMSKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSKMSKeyCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSKMSKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSKMSKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSKMSKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSKMSKey.
+func (c *BronzeHistoryAWSKMSKeyClient) Update() *BronzeHistoryAWSKMSKeyUpdate {
+	mutation := newBronzeHistoryAWSKMSKeyMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSKMSKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSKMSKeyClient) UpdateOne(_m *BronzeHistoryAWSKMSKey) *BronzeHistoryAWSKMSKeyUpdateOne {
+	mutation := newBronzeHistoryAWSKMSKeyMutation(c.config, OpUpdateOne, withBronzeHistoryAWSKMSKey(_m))
+	return &BronzeHistoryAWSKMSKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSKMSKeyClient) UpdateOneID(id uint) *BronzeHistoryAWSKMSKeyUpdateOne {
+	mutation := newBronzeHistoryAWSKMSKeyMutation(c.config, OpUpdateOne, withBronzeHistoryAWSKMSKeyID(id))
+	return &BronzeHistoryAWSKMSKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSKMSKey.
+func (c *BronzeHistoryAWSKMSKeyClient) Delete() *BronzeHistoryAWSKMSKeyDelete {
+	mutation := newBronzeHistoryAWSKMSKeyMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSKMSKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSKMSKeyClient) DeleteOne(_m *BronzeHistoryAWSKMSKey) *BronzeHistoryAWSKMSKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSKMSKeyClient) DeleteOneID(id uint) *BronzeHistoryAWSKMSKeyDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawskmskey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSKMSKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSKMSKey.
+func (c *BronzeHistoryAWSKMSKeyClient) Query() *BronzeHistoryAWSKMSKeyQuery {
+	return &BronzeHistoryAWSKMSKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSKMSKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSKMSKey entity by its id.
+func (c *BronzeHistoryAWSKMSKeyClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSKMSKey, error) {
+	return c.Query().Where(bronzehistoryawskmskey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSKMSKeyClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSKMSKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSKMSKeyClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSKMSKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSKMSKeyClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSKMSKey
+}
+
+func (c *BronzeHistoryAWSKMSKeyClient) mutate(ctx context.Context, m *BronzeHistoryAWSKMSKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSKMSKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSKMSKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSKMSKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSKMSKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("kms: unknown BronzeHistoryAWSKMSKey mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		BronzeAWSKMSKey, BronzeHistoryAWSKMSKey []ent.Hook
+	}
+	inters struct {
+		BronzeAWSKMSKey, BronzeHistoryAWSKMSKey []ent.Interceptor
+	}
+)
+
+// SchemaConfig represents alternative schema names for all tables
+// that can be passed at runtime.
+type SchemaConfig = internal.SchemaConfig
+
+// AlternateSchemas allows alternate schema names to be
+// passed into ent operations.
+func AlternateSchema(schemaConfig SchemaConfig) Option {
+	return func(c *config) {
+		c.schemaConfig = schemaConfig
+	}
+}
@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hook
+
+import (
+	"context"
+	"fmt"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms"
+)
+
+// The BronzeAWSKMSKeyFunc type is an adapter to allow the use of ordinary
+// function as BronzeAWSKMSKey mutator.
+type BronzeAWSKMSKeyFunc func(context.Context, *kms.BronzeAWSKMSKeyMutation) (kms.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeAWSKMSKeyFunc) Mutate(ctx context.Context, m kms.Mutation) (kms.Value, error) {
+	if mv, ok := m.(*kms.BronzeAWSKMSKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *kms.BronzeAWSKMSKeyMutation", m)
+}
+
+// The BronzeHistoryAWSKMSKeyFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryAWSKMSKey mutator.
+type BronzeHistoryAWSKMSKeyFunc func(context.Context, *kms.BronzeHistoryAWSKMSKeyMutation) (kms.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryAWSKMSKeyFunc) Mutate(ctx context.Context, m kms.Mutation) (kms.Value, error) {
+	if mv, ok := m.(*kms.BronzeHistoryAWSKMSKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *kms.BronzeHistoryAWSKMSKeyMutation", m)
+}
+
+// Condition is a hook condition function.
+type Condition func(context.Context, kms.Mutation) bool
+
+// And groups conditions with the AND operator.
+func And(first, second Condition, rest ...Condition) Condition {
+	return func(ctx context.Context, m kms.Mutation) bool {
+		if !first(ctx, m) || !second(ctx, m) {
+			return false
+		}
+		for _, cond := range rest {
+			if !cond(ctx, m) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or groups conditions with the OR operator.
+func Or(first, second Condition, rest ...Condition) Condition {
+	return func(ctx context.Context, m kms.Mutation) bool {
+		if first(ctx, m) || second(ctx, m) {
+			return true
+		}
+		for _, cond := range rest {
+			if cond(ctx, m) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not negates a given condition.
+func Not(cond Condition) Condition {
+	return func(ctx context.Context, m kms.Mutation) bool {
+		return !cond(ctx, m)
+	}
+}
+
+// HasOp is a condition testing mutation operation.
+func HasOp(op kms.Op) Condition {
+	return func(_ context.Context, m kms.Mutation) bool {
+		return m.Op().Is(op)
+	}
+}
+
+// HasAddedFields is a condition validating `.AddedField` on fields.
+func HasAddedFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m kms.Mutation) bool {
+		if _, exists := m.AddedField(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if _, exists := m.AddedField(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// HasClearedFields is a condition validating `.FieldCleared` on fields.
+func HasClearedFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m kms.Mutation) bool {
+		if exists := m.FieldCleared(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if exists := m.FieldCleared(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// HasFields is a condition validating `.Field` on fields.
+func HasFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m kms.Mutation) bool {
+		if _, exists := m.Field(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if _, exists := m.Field(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// If executes the given hook under condition.
+//
+//	hook.If(ComputeAverage, And(HasFields(...), HasAddedFields(...)))
+func If(hk kms.Hook, cond Condition) kms.Hook {
+	return func(next kms.Mutator) kms.Mutator {
+		return kms.MutateFunc(func(ctx context.Context, m kms.Mutation) (kms.Value, error) {
+			if cond(ctx, m) {
+				return hk(next).Mutate(ctx, m)
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// On executes the given hook only for the given operation.
+//
+//	hook.On(Log, kms.Delete|kms.Create)
+func On(hk kms.Hook, op kms.Op) kms.Hook {
+	return If(hk, HasOp(op))
+}
+
+// Unless skips the given hook only for the given operation.
+//
+//	hook.Unless(Log, kms.Update|kms.UpdateOne)
+func Unless(hk kms.Hook, op kms.Op) kms.Hook {
+	return If(hk, Not(HasOp(op)))
+}
+
+// FixedError is a hook returning a fixed error.
+func FixedError(err error) kms.Hook {
+	return func(kms.Mutator) kms.Mutator {
+		return kms.MutateFunc(func(context.Context, kms.Mutation) (kms.Value, error) {
+			return nil, err
+		})
+	}
+}
+
+// Reject returns a hook that rejects all operations that match op.
+//
+//	func (T) Hooks() []kms.Hook {
+//		return []kms.Hook{
+//			Reject(kms.Delete|kms.Update),
+//		}
+//	}
+func Reject(op kms.Op) kms.Hook {
+	hk := FixedError(fmt.Errorf("%s operation is not allowed", op))
+	return On(hk, op)
+}
+
+// Chain acts as a list of hooks and is effectively immutable.
+// Once created, it will always hold the same set of hooks in the same order.
+type Chain struct {
+	hooks []kms.Hook
+}
+
+// NewChain creates a new chain of hooks.
+func NewChain(hooks ...kms.Hook) Chain {
+	return Chain{append([]kms.Hook(nil), hooks...)}
+}
+
+// Hook chains the list of hooks and returns the final hook.
+func (c Chain) Hook() kms.Hook {
+	return func(mutator kms.Mutator) kms.Mutator {
+		for i := len(c.hooks) - 1; i >= 0; i-- {
+			mutator = c.hooks[i](mutator)
+		}
+		return mutator
+	}
+}
+
+// Append extends a chain, adding the specified hook
+// as the last ones in the mutation flow.
+func (c Chain) Append(hooks ...kms.Hook) Chain {
+	newHooks := make([]kms.Hook, 0, len(c.hooks)+len(hooks))
+	newHooks = append(newHooks, c.hooks...)
+	newHooks = append(newHooks, hooks...)
+	return Chain{newHooks}
+}
+
+// Extend extends a chain, adding the specified chain
+// as the last ones in the mutation flow.
+func (c Chain) Extend(chain Chain) Chain {
+	return c.Append(chain.hooks...)
+}
@@ -0,0 +1,145 @@
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// AWSKmsKeysColumns holds the columns for the "aws_kms_keys" table.
+	AWSKmsKeysColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "arn", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "key_state", Type: field.TypeString, Nullable: true},
+		{Name: "key_usage", Type: field.TypeString, Nullable: true},
+		{Name: "key_spec", Type: field.TypeString, Nullable: true},
+		{Name: "key_manager", Type: field.TypeString, Nullable: true},
+		{Name: "origin", Type: field.TypeString, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Nullable: true},
+		{Name: "multi_region", Type: field.TypeBool, Nullable: true},
+		{Name: "creation_date", Type: field.TypeTime, Nullable: true},
+		{Name: "deletion_date", Type: field.TypeTime, Nullable: true},
+		{Name: "rotation_enabled", Type: field.TypeBool, Nullable: true},
+		{Name: "rotation_period_in_days", Type: field.TypeInt32, Nullable: true},
+		{Name: "next_rotation_date", Type: field.TypeTime, Nullable: true},
+		{Name: "policy_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "aliases_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSKmsKeysTable holds the schema information for the "aws_kms_keys" table.
+	AWSKmsKeysTable = &schema.Table{
+		Name:       "aws_kms_keys",
+		Columns:    AWSKmsKeysColumns,
+		PrimaryKey: []*schema.Column{AWSKmsKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzeawskmskey_key_manager",
+				Unique:  false,
+				Columns: []*schema.Column{AWSKmsKeysColumns[8]},
+			},
+			{
+				Name:    "bronzeawskmskey_key_state",
+				Unique:  false,
+				Columns: []*schema.Column{AWSKmsKeysColumns[5]},
+			},
+			{
+				Name:    "bronzeawskmskey_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSKmsKeysColumns[20]},
+			},
+			{
+				Name:    "bronzeawskmskey_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSKmsKeysColumns[21]},
+			},
+			{
+				Name:    "bronzeawskmskey_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSKmsKeysColumns[1]},
+			},
+		},
+	}
+	// AWSKmsKeysHistoryColumns holds the columns for the "aws_kms_keys_history" table.
+	AWSKmsKeysHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "arn", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "key_state", Type: field.TypeString, Nullable: true},
+		{Name: "key_usage", Type: field.TypeString, Nullable: true},
+		{Name: "key_spec", Type: field.TypeString, Nullable: true},
+		{Name: "key_manager", Type: field.TypeString, Nullable: true},
+		{Name: "origin", Type: field.TypeString, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Nullable: true},
+		{Name: "multi_region", Type: field.TypeBool, Nullable: true},
+		{Name: "creation_date", Type: field.TypeTime, Nullable: true},
+		{Name: "deletion_date", Type: field.TypeTime, Nullable: true},
+		{Name: "rotation_enabled", Type: field.TypeBool, Nullable: true},
+		{Name: "rotation_period_in_days", Type: field.TypeInt32, Nullable: true},
+		{Name: "next_rotation_date", Type: field.TypeTime, Nullable: true},
+		{Name: "policy_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "aliases_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSKmsKeysHistoryTable holds the schema information for the "aws_kms_keys_history" table.
+	AWSKmsKeysHistoryTable = &schema.Table{
+		Name:       "aws_kms_keys_history",
+		Columns:    AWSKmsKeysHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSKmsKeysHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawskmskey_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSKmsKeysHistoryColumns[5], AWSKmsKeysHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawskmskey_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSKmsKeysHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawskmskey_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSKmsKeysHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawskmskey_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSKmsKeysHistoryColumns[23]},
+			},
+			{
+				Name:    "bronzehistoryawskmskey_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSKmsKeysHistoryColumns[24]},
+			},
+		},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		AWSKmsKeysTable,
+		AWSKmsKeysHistoryTable,
+	}
+)
+
+func init() {
+	AWSKmsKeysTable.Annotation = &entsql.Annotation{
+		Table: "aws_kms_keys",
+	}
+	AWSKmsKeysHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_kms_keys_history",
+	}
+}
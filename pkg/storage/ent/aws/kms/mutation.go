@@ -0,0 +1,3864 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/bronzeawskmskey"
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/bronzehistoryawskmskey"
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/predicate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Operation types.
+	OpCreate    = ent.OpCreate
+	OpDelete    = ent.OpDelete
+	OpDeleteOne = ent.OpDeleteOne
+	OpUpdate    = ent.OpUpdate
+	OpUpdateOne = ent.OpUpdateOne
+
+	// Node types.
+	TypeBronzeAWSKMSKey        = "BronzeAWSKMSKey"
+	TypeBronzeHistoryAWSKMSKey = "BronzeHistoryAWSKMSKey"
+)
+
+// BronzeAWSKMSKeyMutation represents an operation that mutates the BronzeAWSKMSKey nodes in the graph.
+type BronzeAWSKMSKeyMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *string
+	collected_at               *time.Time
+	first_collected_at         *time.Time
+	arn                        *string
+	description                *string
+	key_state                  *string
+	key_usage                  *string
+	key_spec                   *string
+	key_manager                *string
+	origin                     *string
+	enabled                    *bool
+	multi_region               *bool
+	creation_date              *time.Time
+	deletion_date              *time.Time
+	rotation_enabled           *bool
+	rotation_period_in_days    *int32
+	addrotation_period_in_days *int32
+	next_rotation_date         *time.Time
+	policy_json                *json.RawMessage
+	appendpolicy_json          json.RawMessage
+	aliases_json               *json.RawMessage
+	appendaliases_json         json.RawMessage
+	tags_json                  *json.RawMessage
+	appendtags_json            json.RawMessage
+	account_id                 *string
+	region                     *string
+	clearedFields              map[string]struct{}
+	done                       bool
+	oldValue                   func(context.Context) (*BronzeAWSKMSKey, error)
+	predicates                 []predicate.BronzeAWSKMSKey
+}
+
+var _ ent.Mutation = (*BronzeAWSKMSKeyMutation)(nil)
+
+// bronzeawskmskeyOption allows management of the mutation configuration using functional options.
+type bronzeawskmskeyOption func(*BronzeAWSKMSKeyMutation)
+
+// newBronzeAWSKMSKeyMutation creates new mutation for the BronzeAWSKMSKey entity.
+func newBronzeAWSKMSKeyMutation(c config, op Op, opts ...bronzeawskmskeyOption) *BronzeAWSKMSKeyMutation {
+	m := &BronzeAWSKMSKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeAWSKMSKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeAWSKMSKeyID sets the ID field of the mutation.
+func withBronzeAWSKMSKeyID(id string) bronzeawskmskeyOption {
+	return func(m *BronzeAWSKMSKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeAWSKMSKey
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeAWSKMSKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeAWSKMSKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeAWSKMSKey sets the old BronzeAWSKMSKey of the mutation.
+func withBronzeAWSKMSKey(node *BronzeAWSKMSKey) bronzeawskmskeyOption {
+	return func(m *BronzeAWSKMSKeyMutation) {
+		m.oldValue = func(context.Context) (*BronzeAWSKMSKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeAWSKMSKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeAWSKMSKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("kms: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeAWSKMSKey entities.
+func (m *BronzeAWSKMSKeyMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeAWSKMSKeyMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeAWSKMSKeyMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeAWSKMSKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeAWSKMSKeyMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeAWSKMSKeyMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeAWSKMSKeyMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeAWSKMSKeyMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetArn sets the "arn" field.
+func (m *BronzeAWSKMSKeyMutation) SetArn(s string) {
+	m.arn = &s
+}
+
+// Arn returns the value of the "arn" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) Arn() (r string, exists bool) {
+	v := m.arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArn returns the old "arn" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArn: %w", err)
+	}
+	return oldValue.Arn, nil
+}
+
+// ClearArn clears the value of the "arn" field.
+func (m *BronzeAWSKMSKeyMutation) ClearArn() {
+	m.arn = nil
+	m.clearedFields[bronzeawskmskey.FieldArn] = struct{}{}
+}
+
+// ArnCleared returns if the "arn" field was cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) ArnCleared() bool {
+	_, ok := m.clearedFields[bronzeawskmskey.FieldArn]
+	return ok
+}
+
+// ResetArn resets all changes to the "arn" field.
+func (m *BronzeAWSKMSKeyMutation) ResetArn() {
+	m.arn = nil
+	delete(m.clearedFields, bronzeawskmskey.FieldArn)
+}
+
+// SetDescription sets the "description" field.
+func (m *BronzeAWSKMSKeyMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *BronzeAWSKMSKeyMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[bronzeawskmskey.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[bronzeawskmskey.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *BronzeAWSKMSKeyMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, bronzeawskmskey.FieldDescription)
+}
+
+// SetKeyState sets the "key_state" field.
+func (m *BronzeAWSKMSKeyMutation) SetKeyState(s string) {
+	m.key_state = &s
+}
+
+// KeyState returns the value of the "key_state" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) KeyState() (r string, exists bool) {
+	v := m.key_state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyState returns the old "key_state" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldKeyState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyState: %w", err)
+	}
+	return oldValue.KeyState, nil
+}
+
+// ClearKeyState clears the value of the "key_state" field.
+func (m *BronzeAWSKMSKeyMutation) ClearKeyState() {
+	m.key_state = nil
+	m.clearedFields[bronzeawskmskey.FieldKeyState] = struct{}{}
+}
+
+// KeyStateCleared returns if the "key_state" field was cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) KeyStateCleared() bool {
+	_, ok := m.clearedFields[bronzeawskmskey.FieldKeyState]
+	return ok
+}
+
+// ResetKeyState resets all changes to the "key_state" field.
+func (m *BronzeAWSKMSKeyMutation) ResetKeyState() {
+	m.key_state = nil
+	delete(m.clearedFields, bronzeawskmskey.FieldKeyState)
+}
+
+// SetKeyUsage sets the "key_usage" field.
+func (m *BronzeAWSKMSKeyMutation) SetKeyUsage(s string) {
+	m.key_usage = &s
+}
+
+// KeyUsage returns the value of the "key_usage" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) KeyUsage() (r string, exists bool) {
+	v := m.key_usage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyUsage returns the old "key_usage" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldKeyUsage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyUsage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyUsage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyUsage: %w", err)
+	}
+	return oldValue.KeyUsage, nil
+}
+
+// ClearKeyUsage clears the value of the "key_usage" field.
+func (m *BronzeAWSKMSKeyMutation) ClearKeyUsage() {
+	m.key_usage = nil
+	m.clearedFields[bronzeawskmskey.FieldKeyUsage] = struct{}{}
+}
+
+// KeyUsageCleared returns if the "key_usage" field was cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) KeyUsageCleared() bool {
+	_, ok := m.clearedFields[bronzeawskmskey.FieldKeyUsage]
+	return ok
+}
+
+// ResetKeyUsage resets all changes to the "key_usage" field.
+func (m *BronzeAWSKMSKeyMutation) ResetKeyUsage() {
+	m.key_usage = nil
+	delete(m.clearedFields, bronzeawskmskey.FieldKeyUsage)
+}
+
+// SetKeySpec sets the "key_spec" field.
+func (m *BronzeAWSKMSKeyMutation) SetKeySpec(s string) {
+	m.key_spec = &s
+}
+
+// KeySpec returns the value of the "key_spec" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) KeySpec() (r string, exists bool) {
+	v := m.key_spec
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeySpec returns the old "key_spec" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldKeySpec(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeySpec is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeySpec requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeySpec: %w", err)
+	}
+	return oldValue.KeySpec, nil
+}
+
+// ClearKeySpec clears the value of the "key_spec" field.
+func (m *BronzeAWSKMSKeyMutation) ClearKeySpec() {
+	m.key_spec = nil
+	m.clearedFields[bronzeawskmskey.FieldKeySpec] = struct{}{}
+}
+
+// KeySpecCleared returns if the "key_spec" field was cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) KeySpecCleared() bool {
+	_, ok := m.clearedFields[bronzeawskmskey.FieldKeySpec]
+	return ok
+}
+
+// ResetKeySpec resets all changes to the "key_spec" field.
+func (m *BronzeAWSKMSKeyMutation) ResetKeySpec() {
+	m.key_spec = nil
+	delete(m.clearedFields, bronzeawskmskey.FieldKeySpec)
+}
+
+// SetKeyManager sets the "key_manager" field.
+func (m *BronzeAWSKMSKeyMutation) SetKeyManager(s string) {
+	m.key_manager = &s
+}
+
+// KeyManager returns the value of the "key_manager" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) KeyManager() (r string, exists bool) {
+	v := m.key_manager
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyManager returns the old "key_manager" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldKeyManager(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyManager is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyManager requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyManager: %w", err)
+	}
+	return oldValue.KeyManager, nil
+}
+
+// ClearKeyManager clears the value of the "key_manager" field.
+func (m *BronzeAWSKMSKeyMutation) ClearKeyManager() {
+	m.key_manager = nil
+	m.clearedFields[bronzeawskmskey.FieldKeyManager] = struct{}{}
+}
+
+// KeyManagerCleared returns if the "key_manager" field was cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) KeyManagerCleared() bool {
+	_, ok := m.clearedFields[bronzeawskmskey.FieldKeyManager]
+	return ok
+}
+
+// ResetKeyManager resets all changes to the "key_manager" field.
+func (m *BronzeAWSKMSKeyMutation) ResetKeyManager() {
+	m.key_manager = nil
+	delete(m.clearedFields, bronzeawskmskey.FieldKeyManager)
+}
+
+// SetOrigin sets the "origin" field.
+func (m *BronzeAWSKMSKeyMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ClearOrigin clears the value of the "origin" field.
+func (m *BronzeAWSKMSKeyMutation) ClearOrigin() {
+	m.origin = nil
+	m.clearedFields[bronzeawskmskey.FieldOrigin] = struct{}{}
+}
+
+// OriginCleared returns if the "origin" field was cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) OriginCleared() bool {
+	_, ok := m.clearedFields[bronzeawskmskey.FieldOrigin]
+	return ok
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *BronzeAWSKMSKeyMutation) ResetOrigin() {
+	m.origin = nil
+	delete(m.clearedFields, bronzeawskmskey.FieldOrigin)
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *BronzeAWSKMSKeyMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (m *BronzeAWSKMSKeyMutation) ClearEnabled() {
+	m.enabled = nil
+	m.clearedFields[bronzeawskmskey.FieldEnabled] = struct{}{}
+}
+
+// EnabledCleared returns if the "enabled" field was cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) EnabledCleared() bool {
+	_, ok := m.clearedFields[bronzeawskmskey.FieldEnabled]
+	return ok
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *BronzeAWSKMSKeyMutation) ResetEnabled() {
+	m.enabled = nil
+	delete(m.clearedFields, bronzeawskmskey.FieldEnabled)
+}
+
+// SetMultiRegion sets the "multi_region" field.
+func (m *BronzeAWSKMSKeyMutation) SetMultiRegion(b bool) {
+	m.multi_region = &b
+}
+
+// MultiRegion returns the value of the "multi_region" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) MultiRegion() (r bool, exists bool) {
+	v := m.multi_region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMultiRegion returns the old "multi_region" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldMultiRegion(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMultiRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMultiRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMultiRegion: %w", err)
+	}
+	return oldValue.MultiRegion, nil
+}
+
+// ClearMultiRegion clears the value of the "multi_region" field.
+func (m *BronzeAWSKMSKeyMutation) ClearMultiRegion() {
+	m.multi_region = nil
+	m.clearedFields[bronzeawskmskey.FieldMultiRegion] = struct{}{}
+}
+
+// MultiRegionCleared returns if the "multi_region" field was cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) MultiRegionCleared() bool {
+	_, ok := m.clearedFields[bronzeawskmskey.FieldMultiRegion]
+	return ok
+}
+
+// ResetMultiRegion resets all changes to the "multi_region" field.
+func (m *BronzeAWSKMSKeyMutation) ResetMultiRegion() {
+	m.multi_region = nil
+	delete(m.clearedFields, bronzeawskmskey.FieldMultiRegion)
+}
+
+// SetCreationDate sets the "creation_date" field.
+func (m *BronzeAWSKMSKeyMutation) SetCreationDate(t time.Time) {
+	m.creation_date = &t
+}
+
+// CreationDate returns the value of the "creation_date" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) CreationDate() (r time.Time, exists bool) {
+	v := m.creation_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreationDate returns the old "creation_date" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldCreationDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreationDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreationDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreationDate: %w", err)
+	}
+	return oldValue.CreationDate, nil
+}
+
+// ClearCreationDate clears the value of the "creation_date" field.
+func (m *BronzeAWSKMSKeyMutation) ClearCreationDate() {
+	m.creation_date = nil
+	m.clearedFields[bronzeawskmskey.FieldCreationDate] = struct{}{}
+}
+
+// CreationDateCleared returns if the "creation_date" field was cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) CreationDateCleared() bool {
+	_, ok := m.clearedFields[bronzeawskmskey.FieldCreationDate]
+	return ok
+}
+
+// ResetCreationDate resets all changes to the "creation_date" field.
+func (m *BronzeAWSKMSKeyMutation) ResetCreationDate() {
+	m.creation_date = nil
+	delete(m.clearedFields, bronzeawskmskey.FieldCreationDate)
+}
+
+// SetDeletionDate sets the "deletion_date" field.
+func (m *BronzeAWSKMSKeyMutation) SetDeletionDate(t time.Time) {
+	m.deletion_date = &t
+}
+
+// DeletionDate returns the value of the "deletion_date" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) DeletionDate() (r time.Time, exists bool) {
+	v := m.deletion_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletionDate returns the old "deletion_date" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldDeletionDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletionDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletionDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletionDate: %w", err)
+	}
+	return oldValue.DeletionDate, nil
+}
+
+// ClearDeletionDate clears the value of the "deletion_date" field.
+func (m *BronzeAWSKMSKeyMutation) ClearDeletionDate() {
+	m.deletion_date = nil
+	m.clearedFields[bronzeawskmskey.FieldDeletionDate] = struct{}{}
+}
+
+// DeletionDateCleared returns if the "deletion_date" field was cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) DeletionDateCleared() bool {
+	_, ok := m.clearedFields[bronzeawskmskey.FieldDeletionDate]
+	return ok
+}
+
+// ResetDeletionDate resets all changes to the "deletion_date" field.
+func (m *BronzeAWSKMSKeyMutation) ResetDeletionDate() {
+	m.deletion_date = nil
+	delete(m.clearedFields, bronzeawskmskey.FieldDeletionDate)
+}
+
+// SetRotationEnabled sets the "rotation_enabled" field.
+func (m *BronzeAWSKMSKeyMutation) SetRotationEnabled(b bool) {
+	m.rotation_enabled = &b
+}
+
+// RotationEnabled returns the value of the "rotation_enabled" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) RotationEnabled() (r bool, exists bool) {
+	v := m.rotation_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRotationEnabled returns the old "rotation_enabled" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldRotationEnabled(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRotationEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRotationEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRotationEnabled: %w", err)
+	}
+	return oldValue.RotationEnabled, nil
+}
+
+// ClearRotationEnabled clears the value of the "rotation_enabled" field.
+func (m *BronzeAWSKMSKeyMutation) ClearRotationEnabled() {
+	m.rotation_enabled = nil
+	m.clearedFields[bronzeawskmskey.FieldRotationEnabled] = struct{}{}
+}
+
+// RotationEnabledCleared returns if the "rotation_enabled" field was cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) RotationEnabledCleared() bool {
+	_, ok := m.clearedFields[bronzeawskmskey.FieldRotationEnabled]
+	return ok
+}
+
+// ResetRotationEnabled resets all changes to the "rotation_enabled" field.
+func (m *BronzeAWSKMSKeyMutation) ResetRotationEnabled() {
+	m.rotation_enabled = nil
+	delete(m.clearedFields, bronzeawskmskey.FieldRotationEnabled)
+}
+
+// SetRotationPeriodInDays sets the "rotation_period_in_days" field.
+func (m *BronzeAWSKMSKeyMutation) SetRotationPeriodInDays(i int32) {
+	m.rotation_period_in_days = &i
+	m.addrotation_period_in_days = nil
+}
+
+// RotationPeriodInDays returns the value of the "rotation_period_in_days" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) RotationPeriodInDays() (r int32, exists bool) {
+	v := m.rotation_period_in_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRotationPeriodInDays returns the old "rotation_period_in_days" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldRotationPeriodInDays(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRotationPeriodInDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRotationPeriodInDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRotationPeriodInDays: %w", err)
+	}
+	return oldValue.RotationPeriodInDays, nil
+}
+
+// AddRotationPeriodInDays adds i to the "rotation_period_in_days" field.
+func (m *BronzeAWSKMSKeyMutation) AddRotationPeriodInDays(i int32) {
+	if m.addrotation_period_in_days != nil {
+		*m.addrotation_period_in_days += i
+	} else {
+		m.addrotation_period_in_days = &i
+	}
+}
+
+// AddedRotationPeriodInDays returns the value that was added to the "rotation_period_in_days" field in this mutation.
+func (m *BronzeAWSKMSKeyMutation) AddedRotationPeriodInDays() (r int32, exists bool) {
+	v := m.addrotation_period_in_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRotationPeriodInDays clears the value of the "rotation_period_in_days" field.
+func (m *BronzeAWSKMSKeyMutation) ClearRotationPeriodInDays() {
+	m.rotation_period_in_days = nil
+	m.addrotation_period_in_days = nil
+	m.clearedFields[bronzeawskmskey.FieldRotationPeriodInDays] = struct{}{}
+}
+
+// RotationPeriodInDaysCleared returns if the "rotation_period_in_days" field was cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) RotationPeriodInDaysCleared() bool {
+	_, ok := m.clearedFields[bronzeawskmskey.FieldRotationPeriodInDays]
+	return ok
+}
+
+// ResetRotationPeriodInDays resets all changes to the "rotation_period_in_days" field.
+func (m *BronzeAWSKMSKeyMutation) ResetRotationPeriodInDays() {
+	m.rotation_period_in_days = nil
+	m.addrotation_period_in_days = nil
+	delete(m.clearedFields, bronzeawskmskey.FieldRotationPeriodInDays)
+}
+
+// SetNextRotationDate sets the "next_rotation_date" field.
+func (m *BronzeAWSKMSKeyMutation) SetNextRotationDate(t time.Time) {
+	m.next_rotation_date = &t
+}
+
+// NextRotationDate returns the value of the "next_rotation_date" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) NextRotationDate() (r time.Time, exists bool) {
+	v := m.next_rotation_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRotationDate returns the old "next_rotation_date" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldNextRotationDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRotationDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRotationDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRotationDate: %w", err)
+	}
+	return oldValue.NextRotationDate, nil
+}
+
+// ClearNextRotationDate clears the value of the "next_rotation_date" field.
+func (m *BronzeAWSKMSKeyMutation) ClearNextRotationDate() {
+	m.next_rotation_date = nil
+	m.clearedFields[bronzeawskmskey.FieldNextRotationDate] = struct{}{}
+}
+
+// NextRotationDateCleared returns if the "next_rotation_date" field was cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) NextRotationDateCleared() bool {
+	_, ok := m.clearedFields[bronzeawskmskey.FieldNextRotationDate]
+	return ok
+}
+
+// ResetNextRotationDate resets all changes to the "next_rotation_date" field.
+func (m *BronzeAWSKMSKeyMutation) ResetNextRotationDate() {
+	m.next_rotation_date = nil
+	delete(m.clearedFields, bronzeawskmskey.FieldNextRotationDate)
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (m *BronzeAWSKMSKeyMutation) SetPolicyJSON(jm json.RawMessage) {
+	m.policy_json = &jm
+	m.appendpolicy_json = nil
+}
+
+// PolicyJSON returns the value of the "policy_json" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) PolicyJSON() (r json.RawMessage, exists bool) {
+	v := m.policy_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolicyJSON returns the old "policy_json" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldPolicyJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicyJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicyJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicyJSON: %w", err)
+	}
+	return oldValue.PolicyJSON, nil
+}
+
+// AppendPolicyJSON adds jm to the "policy_json" field.
+func (m *BronzeAWSKMSKeyMutation) AppendPolicyJSON(jm json.RawMessage) {
+	m.appendpolicy_json = append(m.appendpolicy_json, jm...)
+}
+
+// AppendedPolicyJSON returns the list of values that were appended to the "policy_json" field in this mutation.
+func (m *BronzeAWSKMSKeyMutation) AppendedPolicyJSON() (json.RawMessage, bool) {
+	if len(m.appendpolicy_json) == 0 {
+		return nil, false
+	}
+	return m.appendpolicy_json, true
+}
+
+// ClearPolicyJSON clears the value of the "policy_json" field.
+func (m *BronzeAWSKMSKeyMutation) ClearPolicyJSON() {
+	m.policy_json = nil
+	m.appendpolicy_json = nil
+	m.clearedFields[bronzeawskmskey.FieldPolicyJSON] = struct{}{}
+}
+
+// PolicyJSONCleared returns if the "policy_json" field was cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) PolicyJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawskmskey.FieldPolicyJSON]
+	return ok
+}
+
+// ResetPolicyJSON resets all changes to the "policy_json" field.
+func (m *BronzeAWSKMSKeyMutation) ResetPolicyJSON() {
+	m.policy_json = nil
+	m.appendpolicy_json = nil
+	delete(m.clearedFields, bronzeawskmskey.FieldPolicyJSON)
+}
+
+// SetAliasesJSON sets the "aliases_json" field.
+func (m *BronzeAWSKMSKeyMutation) SetAliasesJSON(jm json.RawMessage) {
+	m.aliases_json = &jm
+	m.appendaliases_json = nil
+}
+
+// AliasesJSON returns the value of the "aliases_json" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) AliasesJSON() (r json.RawMessage, exists bool) {
+	v := m.aliases_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAliasesJSON returns the old "aliases_json" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldAliasesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAliasesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAliasesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAliasesJSON: %w", err)
+	}
+	return oldValue.AliasesJSON, nil
+}
+
+// AppendAliasesJSON adds jm to the "aliases_json" field.
+func (m *BronzeAWSKMSKeyMutation) AppendAliasesJSON(jm json.RawMessage) {
+	m.appendaliases_json = append(m.appendaliases_json, jm...)
+}
+
+// AppendedAliasesJSON returns the list of values that were appended to the "aliases_json" field in this mutation.
+func (m *BronzeAWSKMSKeyMutation) AppendedAliasesJSON() (json.RawMessage, bool) {
+	if len(m.appendaliases_json) == 0 {
+		return nil, false
+	}
+	return m.appendaliases_json, true
+}
+
+// ClearAliasesJSON clears the value of the "aliases_json" field.
+func (m *BronzeAWSKMSKeyMutation) ClearAliasesJSON() {
+	m.aliases_json = nil
+	m.appendaliases_json = nil
+	m.clearedFields[bronzeawskmskey.FieldAliasesJSON] = struct{}{}
+}
+
+// AliasesJSONCleared returns if the "aliases_json" field was cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) AliasesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawskmskey.FieldAliasesJSON]
+	return ok
+}
+
+// ResetAliasesJSON resets all changes to the "aliases_json" field.
+func (m *BronzeAWSKMSKeyMutation) ResetAliasesJSON() {
+	m.aliases_json = nil
+	m.appendaliases_json = nil
+	delete(m.clearedFields, bronzeawskmskey.FieldAliasesJSON)
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (m *BronzeAWSKMSKeyMutation) SetTagsJSON(jm json.RawMessage) {
+	m.tags_json = &jm
+	m.appendtags_json = nil
+}
+
+// TagsJSON returns the value of the "tags_json" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) TagsJSON() (r json.RawMessage, exists bool) {
+	v := m.tags_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagsJSON returns the old "tags_json" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldTagsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagsJSON: %w", err)
+	}
+	return oldValue.TagsJSON, nil
+}
+
+// AppendTagsJSON adds jm to the "tags_json" field.
+func (m *BronzeAWSKMSKeyMutation) AppendTagsJSON(jm json.RawMessage) {
+	m.appendtags_json = append(m.appendtags_json, jm...)
+}
+
+// AppendedTagsJSON returns the list of values that were appended to the "tags_json" field in this mutation.
+func (m *BronzeAWSKMSKeyMutation) AppendedTagsJSON() (json.RawMessage, bool) {
+	if len(m.appendtags_json) == 0 {
+		return nil, false
+	}
+	return m.appendtags_json, true
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (m *BronzeAWSKMSKeyMutation) ClearTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	m.clearedFields[bronzeawskmskey.FieldTagsJSON] = struct{}{}
+}
+
+// TagsJSONCleared returns if the "tags_json" field was cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) TagsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawskmskey.FieldTagsJSON]
+	return ok
+}
+
+// ResetTagsJSON resets all changes to the "tags_json" field.
+func (m *BronzeAWSKMSKeyMutation) ResetTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	delete(m.clearedFields, bronzeawskmskey.FieldTagsJSON)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BronzeAWSKMSKeyMutation) SetAccountID(s string) {
+	m.account_id = &s
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) AccountID() (r string, exists bool) {
+	v := m.account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BronzeAWSKMSKeyMutation) ResetAccountID() {
+	m.account_id = nil
+}
+
+// SetRegion sets the "region" field.
+func (m *BronzeAWSKMSKeyMutation) SetRegion(s string) {
+	m.region = &s
+}
+
+// Region returns the value of the "region" field in the mutation.
+func (m *BronzeAWSKMSKeyMutation) Region() (r string, exists bool) {
+	v := m.region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegion returns the old "region" field's value of the BronzeAWSKMSKey entity.
+// If the BronzeAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSKMSKeyMutation) OldRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
+	}
+	return oldValue.Region, nil
+}
+
+// ResetRegion resets all changes to the "region" field.
+func (m *BronzeAWSKMSKeyMutation) ResetRegion() {
+	m.region = nil
+}
+
+// Where appends a list predicates to the BronzeAWSKMSKeyMutation builder.
+func (m *BronzeAWSKMSKeyMutation) Where(ps ...predicate.BronzeAWSKMSKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeAWSKMSKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeAWSKMSKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeAWSKMSKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeAWSKMSKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeAWSKMSKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeAWSKMSKey).
+func (m *BronzeAWSKMSKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeAWSKMSKeyMutation) Fields() []string {
+	fields := make([]string, 0, 21)
+	if m.collected_at != nil {
+		fields = append(fields, bronzeawskmskey.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzeawskmskey.FieldFirstCollectedAt)
+	}
+	if m.arn != nil {
+		fields = append(fields, bronzeawskmskey.FieldArn)
+	}
+	if m.description != nil {
+		fields = append(fields, bronzeawskmskey.FieldDescription)
+	}
+	if m.key_state != nil {
+		fields = append(fields, bronzeawskmskey.FieldKeyState)
+	}
+	if m.key_usage != nil {
+		fields = append(fields, bronzeawskmskey.FieldKeyUsage)
+	}
+	if m.key_spec != nil {
+		fields = append(fields, bronzeawskmskey.FieldKeySpec)
+	}
+	if m.key_manager != nil {
+		fields = append(fields, bronzeawskmskey.FieldKeyManager)
+	}
+	if m.origin != nil {
+		fields = append(fields, bronzeawskmskey.FieldOrigin)
+	}
+	if m.enabled != nil {
+		fields = append(fields, bronzeawskmskey.FieldEnabled)
+	}
+	if m.multi_region != nil {
+		fields = append(fields, bronzeawskmskey.FieldMultiRegion)
+	}
+	if m.creation_date != nil {
+		fields = append(fields, bronzeawskmskey.FieldCreationDate)
+	}
+	if m.deletion_date != nil {
+		fields = append(fields, bronzeawskmskey.FieldDeletionDate)
+	}
+	if m.rotation_enabled != nil {
+		fields = append(fields, bronzeawskmskey.FieldRotationEnabled)
+	}
+	if m.rotation_period_in_days != nil {
+		fields = append(fields, bronzeawskmskey.FieldRotationPeriodInDays)
+	}
+	if m.next_rotation_date != nil {
+		fields = append(fields, bronzeawskmskey.FieldNextRotationDate)
+	}
+	if m.policy_json != nil {
+		fields = append(fields, bronzeawskmskey.FieldPolicyJSON)
+	}
+	if m.aliases_json != nil {
+		fields = append(fields, bronzeawskmskey.FieldAliasesJSON)
+	}
+	if m.tags_json != nil {
+		fields = append(fields, bronzeawskmskey.FieldTagsJSON)
+	}
+	if m.account_id != nil {
+		fields = append(fields, bronzeawskmskey.FieldAccountID)
+	}
+	if m.region != nil {
+		fields = append(fields, bronzeawskmskey.FieldRegion)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeAWSKMSKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzeawskmskey.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzeawskmskey.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzeawskmskey.FieldArn:
+		return m.Arn()
+	case bronzeawskmskey.FieldDescription:
+		return m.Description()
+	case bronzeawskmskey.FieldKeyState:
+		return m.KeyState()
+	case bronzeawskmskey.FieldKeyUsage:
+		return m.KeyUsage()
+	case bronzeawskmskey.FieldKeySpec:
+		return m.KeySpec()
+	case bronzeawskmskey.FieldKeyManager:
+		return m.KeyManager()
+	case bronzeawskmskey.FieldOrigin:
+		return m.Origin()
+	case bronzeawskmskey.FieldEnabled:
+		return m.Enabled()
+	case bronzeawskmskey.FieldMultiRegion:
+		return m.MultiRegion()
+	case bronzeawskmskey.FieldCreationDate:
+		return m.CreationDate()
+	case bronzeawskmskey.FieldDeletionDate:
+		return m.DeletionDate()
+	case bronzeawskmskey.FieldRotationEnabled:
+		return m.RotationEnabled()
+	case bronzeawskmskey.FieldRotationPeriodInDays:
+		return m.RotationPeriodInDays()
+	case bronzeawskmskey.FieldNextRotationDate:
+		return m.NextRotationDate()
+	case bronzeawskmskey.FieldPolicyJSON:
+		return m.PolicyJSON()
+	case bronzeawskmskey.FieldAliasesJSON:
+		return m.AliasesJSON()
+	case bronzeawskmskey.FieldTagsJSON:
+		return m.TagsJSON()
+	case bronzeawskmskey.FieldAccountID:
+		return m.AccountID()
+	case bronzeawskmskey.FieldRegion:
+		return m.Region()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeAWSKMSKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzeawskmskey.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzeawskmskey.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzeawskmskey.FieldArn:
+		return m.OldArn(ctx)
+	case bronzeawskmskey.FieldDescription:
+		return m.OldDescription(ctx)
+	case bronzeawskmskey.FieldKeyState:
+		return m.OldKeyState(ctx)
+	case bronzeawskmskey.FieldKeyUsage:
+		return m.OldKeyUsage(ctx)
+	case bronzeawskmskey.FieldKeySpec:
+		return m.OldKeySpec(ctx)
+	case bronzeawskmskey.FieldKeyManager:
+		return m.OldKeyManager(ctx)
+	case bronzeawskmskey.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case bronzeawskmskey.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case bronzeawskmskey.FieldMultiRegion:
+		return m.OldMultiRegion(ctx)
+	case bronzeawskmskey.FieldCreationDate:
+		return m.OldCreationDate(ctx)
+	case bronzeawskmskey.FieldDeletionDate:
+		return m.OldDeletionDate(ctx)
+	case bronzeawskmskey.FieldRotationEnabled:
+		return m.OldRotationEnabled(ctx)
+	case bronzeawskmskey.FieldRotationPeriodInDays:
+		return m.OldRotationPeriodInDays(ctx)
+	case bronzeawskmskey.FieldNextRotationDate:
+		return m.OldNextRotationDate(ctx)
+	case bronzeawskmskey.FieldPolicyJSON:
+		return m.OldPolicyJSON(ctx)
+	case bronzeawskmskey.FieldAliasesJSON:
+		return m.OldAliasesJSON(ctx)
+	case bronzeawskmskey.FieldTagsJSON:
+		return m.OldTagsJSON(ctx)
+	case bronzeawskmskey.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case bronzeawskmskey.FieldRegion:
+		return m.OldRegion(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeAWSKMSKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeAWSKMSKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzeawskmskey.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzeawskmskey.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzeawskmskey.FieldArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArn(v)
+		return nil
+	case bronzeawskmskey.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case bronzeawskmskey.FieldKeyState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyState(v)
+		return nil
+	case bronzeawskmskey.FieldKeyUsage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyUsage(v)
+		return nil
+	case bronzeawskmskey.FieldKeySpec:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeySpec(v)
+		return nil
+	case bronzeawskmskey.FieldKeyManager:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyManager(v)
+		return nil
+	case bronzeawskmskey.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case bronzeawskmskey.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case bronzeawskmskey.FieldMultiRegion:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMultiRegion(v)
+		return nil
+	case bronzeawskmskey.FieldCreationDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreationDate(v)
+		return nil
+	case bronzeawskmskey.FieldDeletionDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletionDate(v)
+		return nil
+	case bronzeawskmskey.FieldRotationEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRotationEnabled(v)
+		return nil
+	case bronzeawskmskey.FieldRotationPeriodInDays:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRotationPeriodInDays(v)
+		return nil
+	case bronzeawskmskey.FieldNextRotationDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRotationDate(v)
+		return nil
+	case bronzeawskmskey.FieldPolicyJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicyJSON(v)
+		return nil
+	case bronzeawskmskey.FieldAliasesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAliasesJSON(v)
+		return nil
+	case bronzeawskmskey.FieldTagsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagsJSON(v)
+		return nil
+	case bronzeawskmskey.FieldAccountID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case bronzeawskmskey.FieldRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSKMSKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeAWSKMSKeyMutation) AddedFields() []string {
+	var fields []string
+	if m.addrotation_period_in_days != nil {
+		fields = append(fields, bronzeawskmskey.FieldRotationPeriodInDays)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeAWSKMSKeyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case bronzeawskmskey.FieldRotationPeriodInDays:
+		return m.AddedRotationPeriodInDays()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeAWSKMSKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case bronzeawskmskey.FieldRotationPeriodInDays:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRotationPeriodInDays(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSKMSKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeAWSKMSKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzeawskmskey.FieldArn) {
+		fields = append(fields, bronzeawskmskey.FieldArn)
+	}
+	if m.FieldCleared(bronzeawskmskey.FieldDescription) {
+		fields = append(fields, bronzeawskmskey.FieldDescription)
+	}
+	if m.FieldCleared(bronzeawskmskey.FieldKeyState) {
+		fields = append(fields, bronzeawskmskey.FieldKeyState)
+	}
+	if m.FieldCleared(bronzeawskmskey.FieldKeyUsage) {
+		fields = append(fields, bronzeawskmskey.FieldKeyUsage)
+	}
+	if m.FieldCleared(bronzeawskmskey.FieldKeySpec) {
+		fields = append(fields, bronzeawskmskey.FieldKeySpec)
+	}
+	if m.FieldCleared(bronzeawskmskey.FieldKeyManager) {
+		fields = append(fields, bronzeawskmskey.FieldKeyManager)
+	}
+	if m.FieldCleared(bronzeawskmskey.FieldOrigin) {
+		fields = append(fields, bronzeawskmskey.FieldOrigin)
+	}
+	if m.FieldCleared(bronzeawskmskey.FieldEnabled) {
+		fields = append(fields, bronzeawskmskey.FieldEnabled)
+	}
+	if m.FieldCleared(bronzeawskmskey.FieldMultiRegion) {
+		fields = append(fields, bronzeawskmskey.FieldMultiRegion)
+	}
+	if m.FieldCleared(bronzeawskmskey.FieldCreationDate) {
+		fields = append(fields, bronzeawskmskey.FieldCreationDate)
+	}
+	if m.FieldCleared(bronzeawskmskey.FieldDeletionDate) {
+		fields = append(fields, bronzeawskmskey.FieldDeletionDate)
+	}
+	if m.FieldCleared(bronzeawskmskey.FieldRotationEnabled) {
+		fields = append(fields, bronzeawskmskey.FieldRotationEnabled)
+	}
+	if m.FieldCleared(bronzeawskmskey.FieldRotationPeriodInDays) {
+		fields = append(fields, bronzeawskmskey.FieldRotationPeriodInDays)
+	}
+	if m.FieldCleared(bronzeawskmskey.FieldNextRotationDate) {
+		fields = append(fields, bronzeawskmskey.FieldNextRotationDate)
+	}
+	if m.FieldCleared(bronzeawskmskey.FieldPolicyJSON) {
+		fields = append(fields, bronzeawskmskey.FieldPolicyJSON)
+	}
+	if m.FieldCleared(bronzeawskmskey.FieldAliasesJSON) {
+		fields = append(fields, bronzeawskmskey.FieldAliasesJSON)
+	}
+	if m.FieldCleared(bronzeawskmskey.FieldTagsJSON) {
+		fields = append(fields, bronzeawskmskey.FieldTagsJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeAWSKMSKeyMutation) ClearField(name string) error {
+	switch name {
+	case bronzeawskmskey.FieldArn:
+		m.ClearArn()
+		return nil
+	case bronzeawskmskey.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case bronzeawskmskey.FieldKeyState:
+		m.ClearKeyState()
+		return nil
+	case bronzeawskmskey.FieldKeyUsage:
+		m.ClearKeyUsage()
+		return nil
+	case bronzeawskmskey.FieldKeySpec:
+		m.ClearKeySpec()
+		return nil
+	case bronzeawskmskey.FieldKeyManager:
+		m.ClearKeyManager()
+		return nil
+	case bronzeawskmskey.FieldOrigin:
+		m.ClearOrigin()
+		return nil
+	case bronzeawskmskey.FieldEnabled:
+		m.ClearEnabled()
+		return nil
+	case bronzeawskmskey.FieldMultiRegion:
+		m.ClearMultiRegion()
+		return nil
+	case bronzeawskmskey.FieldCreationDate:
+		m.ClearCreationDate()
+		return nil
+	case bronzeawskmskey.FieldDeletionDate:
+		m.ClearDeletionDate()
+		return nil
+	case bronzeawskmskey.FieldRotationEnabled:
+		m.ClearRotationEnabled()
+		return nil
+	case bronzeawskmskey.FieldRotationPeriodInDays:
+		m.ClearRotationPeriodInDays()
+		return nil
+	case bronzeawskmskey.FieldNextRotationDate:
+		m.ClearNextRotationDate()
+		return nil
+	case bronzeawskmskey.FieldPolicyJSON:
+		m.ClearPolicyJSON()
+		return nil
+	case bronzeawskmskey.FieldAliasesJSON:
+		m.ClearAliasesJSON()
+		return nil
+	case bronzeawskmskey.FieldTagsJSON:
+		m.ClearTagsJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSKMSKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeAWSKMSKeyMutation) ResetField(name string) error {
+	switch name {
+	case bronzeawskmskey.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzeawskmskey.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzeawskmskey.FieldArn:
+		m.ResetArn()
+		return nil
+	case bronzeawskmskey.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case bronzeawskmskey.FieldKeyState:
+		m.ResetKeyState()
+		return nil
+	case bronzeawskmskey.FieldKeyUsage:
+		m.ResetKeyUsage()
+		return nil
+	case bronzeawskmskey.FieldKeySpec:
+		m.ResetKeySpec()
+		return nil
+	case bronzeawskmskey.FieldKeyManager:
+		m.ResetKeyManager()
+		return nil
+	case bronzeawskmskey.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case bronzeawskmskey.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case bronzeawskmskey.FieldMultiRegion:
+		m.ResetMultiRegion()
+		return nil
+	case bronzeawskmskey.FieldCreationDate:
+		m.ResetCreationDate()
+		return nil
+	case bronzeawskmskey.FieldDeletionDate:
+		m.ResetDeletionDate()
+		return nil
+	case bronzeawskmskey.FieldRotationEnabled:
+		m.ResetRotationEnabled()
+		return nil
+	case bronzeawskmskey.FieldRotationPeriodInDays:
+		m.ResetRotationPeriodInDays()
+		return nil
+	case bronzeawskmskey.FieldNextRotationDate:
+		m.ResetNextRotationDate()
+		return nil
+	case bronzeawskmskey.FieldPolicyJSON:
+		m.ResetPolicyJSON()
+		return nil
+	case bronzeawskmskey.FieldAliasesJSON:
+		m.ResetAliasesJSON()
+		return nil
+	case bronzeawskmskey.FieldTagsJSON:
+		m.ResetTagsJSON()
+		return nil
+	case bronzeawskmskey.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case bronzeawskmskey.FieldRegion:
+		m.ResetRegion()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSKMSKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeAWSKMSKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeAWSKMSKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeAWSKMSKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeAWSKMSKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeAWSKMSKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeAWSKMSKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeAWSKMSKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeAWSKMSKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeAWSKMSKey edge %s", name)
+}
+
+// BronzeHistoryAWSKMSKeyMutation represents an operation that mutates the BronzeHistoryAWSKMSKey nodes in the graph.
+type BronzeHistoryAWSKMSKeyMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *uint
+	valid_from                 *time.Time
+	valid_to                   *time.Time
+	collected_at               *time.Time
+	first_collected_at         *time.Time
+	resource_id                *string
+	arn                        *string
+	description                *string
+	key_state                  *string
+	key_usage                  *string
+	key_spec                   *string
+	key_manager                *string
+	origin                     *string
+	enabled                    *bool
+	multi_region               *bool
+	creation_date              *time.Time
+	deletion_date              *time.Time
+	rotation_enabled           *bool
+	rotation_period_in_days    *int32
+	addrotation_period_in_days *int32
+	next_rotation_date         *time.Time
+	policy_json                *json.RawMessage
+	appendpolicy_json          json.RawMessage
+	aliases_json               *json.RawMessage
+	appendaliases_json         json.RawMessage
+	tags_json                  *json.RawMessage
+	appendtags_json            json.RawMessage
+	account_id                 *string
+	region                     *string
+	clearedFields              map[string]struct{}
+	done                       bool
+	oldValue                   func(context.Context) (*BronzeHistoryAWSKMSKey, error)
+	predicates                 []predicate.BronzeHistoryAWSKMSKey
+}
+
+var _ ent.Mutation = (*BronzeHistoryAWSKMSKeyMutation)(nil)
+
+// bronzehistoryawskmskeyOption allows management of the mutation configuration using functional options.
+type bronzehistoryawskmskeyOption func(*BronzeHistoryAWSKMSKeyMutation)
+
+// newBronzeHistoryAWSKMSKeyMutation creates new mutation for the BronzeHistoryAWSKMSKey entity.
+func newBronzeHistoryAWSKMSKeyMutation(c config, op Op, opts ...bronzehistoryawskmskeyOption) *BronzeHistoryAWSKMSKeyMutation {
+	m := &BronzeHistoryAWSKMSKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeHistoryAWSKMSKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeHistoryAWSKMSKeyID sets the ID field of the mutation.
+func withBronzeHistoryAWSKMSKeyID(id uint) bronzehistoryawskmskeyOption {
+	return func(m *BronzeHistoryAWSKMSKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeHistoryAWSKMSKey
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeHistoryAWSKMSKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeHistoryAWSKMSKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeHistoryAWSKMSKey sets the old BronzeHistoryAWSKMSKey of the mutation.
+func withBronzeHistoryAWSKMSKey(node *BronzeHistoryAWSKMSKey) bronzehistoryawskmskeyOption {
+	return func(m *BronzeHistoryAWSKMSKeyMutation) {
+		m.oldValue = func(context.Context) (*BronzeHistoryAWSKMSKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeHistoryAWSKMSKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeHistoryAWSKMSKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("kms: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeHistoryAWSKMSKey entities.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeHistoryAWSKMSKeyMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeHistoryAWSKMSKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidTo sets the "valid_to" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetValidTo(t time.Time) {
+	m.valid_to = &t
+}
+
+// ValidTo returns the value of the "valid_to" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) ValidTo() (r time.Time, exists bool) {
+	v := m.valid_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidTo returns the old "valid_to" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldValidTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidTo: %w", err)
+	}
+	return oldValue.ValidTo, nil
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearValidTo() {
+	m.valid_to = nil
+	m.clearedFields[bronzehistoryawskmskey.FieldValidTo] = struct{}{}
+}
+
+// ValidToCleared returns if the "valid_to" field was cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) ValidToCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawskmskey.FieldValidTo]
+	return ok
+}
+
+// ResetValidTo resets all changes to the "valid_to" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetValidTo() {
+	m.valid_to = nil
+	delete(m.clearedFields, bronzehistoryawskmskey.FieldValidTo)
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetArn sets the "arn" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetArn(s string) {
+	m.arn = &s
+}
+
+// Arn returns the value of the "arn" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) Arn() (r string, exists bool) {
+	v := m.arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArn returns the old "arn" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArn: %w", err)
+	}
+	return oldValue.Arn, nil
+}
+
+// ClearArn clears the value of the "arn" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearArn() {
+	m.arn = nil
+	m.clearedFields[bronzehistoryawskmskey.FieldArn] = struct{}{}
+}
+
+// ArnCleared returns if the "arn" field was cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) ArnCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawskmskey.FieldArn]
+	return ok
+}
+
+// ResetArn resets all changes to the "arn" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetArn() {
+	m.arn = nil
+	delete(m.clearedFields, bronzehistoryawskmskey.FieldArn)
+}
+
+// SetDescription sets the "description" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[bronzehistoryawskmskey.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawskmskey.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, bronzehistoryawskmskey.FieldDescription)
+}
+
+// SetKeyState sets the "key_state" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetKeyState(s string) {
+	m.key_state = &s
+}
+
+// KeyState returns the value of the "key_state" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) KeyState() (r string, exists bool) {
+	v := m.key_state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyState returns the old "key_state" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldKeyState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyState: %w", err)
+	}
+	return oldValue.KeyState, nil
+}
+
+// ClearKeyState clears the value of the "key_state" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearKeyState() {
+	m.key_state = nil
+	m.clearedFields[bronzehistoryawskmskey.FieldKeyState] = struct{}{}
+}
+
+// KeyStateCleared returns if the "key_state" field was cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) KeyStateCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawskmskey.FieldKeyState]
+	return ok
+}
+
+// ResetKeyState resets all changes to the "key_state" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetKeyState() {
+	m.key_state = nil
+	delete(m.clearedFields, bronzehistoryawskmskey.FieldKeyState)
+}
+
+// SetKeyUsage sets the "key_usage" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetKeyUsage(s string) {
+	m.key_usage = &s
+}
+
+// KeyUsage returns the value of the "key_usage" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) KeyUsage() (r string, exists bool) {
+	v := m.key_usage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyUsage returns the old "key_usage" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldKeyUsage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyUsage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyUsage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyUsage: %w", err)
+	}
+	return oldValue.KeyUsage, nil
+}
+
+// ClearKeyUsage clears the value of the "key_usage" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearKeyUsage() {
+	m.key_usage = nil
+	m.clearedFields[bronzehistoryawskmskey.FieldKeyUsage] = struct{}{}
+}
+
+// KeyUsageCleared returns if the "key_usage" field was cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) KeyUsageCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawskmskey.FieldKeyUsage]
+	return ok
+}
+
+// ResetKeyUsage resets all changes to the "key_usage" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetKeyUsage() {
+	m.key_usage = nil
+	delete(m.clearedFields, bronzehistoryawskmskey.FieldKeyUsage)
+}
+
+// SetKeySpec sets the "key_spec" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetKeySpec(s string) {
+	m.key_spec = &s
+}
+
+// KeySpec returns the value of the "key_spec" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) KeySpec() (r string, exists bool) {
+	v := m.key_spec
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeySpec returns the old "key_spec" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldKeySpec(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeySpec is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeySpec requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeySpec: %w", err)
+	}
+	return oldValue.KeySpec, nil
+}
+
+// ClearKeySpec clears the value of the "key_spec" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearKeySpec() {
+	m.key_spec = nil
+	m.clearedFields[bronzehistoryawskmskey.FieldKeySpec] = struct{}{}
+}
+
+// KeySpecCleared returns if the "key_spec" field was cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) KeySpecCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawskmskey.FieldKeySpec]
+	return ok
+}
+
+// ResetKeySpec resets all changes to the "key_spec" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetKeySpec() {
+	m.key_spec = nil
+	delete(m.clearedFields, bronzehistoryawskmskey.FieldKeySpec)
+}
+
+// SetKeyManager sets the "key_manager" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetKeyManager(s string) {
+	m.key_manager = &s
+}
+
+// KeyManager returns the value of the "key_manager" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) KeyManager() (r string, exists bool) {
+	v := m.key_manager
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyManager returns the old "key_manager" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldKeyManager(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyManager is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyManager requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyManager: %w", err)
+	}
+	return oldValue.KeyManager, nil
+}
+
+// ClearKeyManager clears the value of the "key_manager" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearKeyManager() {
+	m.key_manager = nil
+	m.clearedFields[bronzehistoryawskmskey.FieldKeyManager] = struct{}{}
+}
+
+// KeyManagerCleared returns if the "key_manager" field was cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) KeyManagerCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawskmskey.FieldKeyManager]
+	return ok
+}
+
+// ResetKeyManager resets all changes to the "key_manager" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetKeyManager() {
+	m.key_manager = nil
+	delete(m.clearedFields, bronzehistoryawskmskey.FieldKeyManager)
+}
+
+// SetOrigin sets the "origin" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ClearOrigin clears the value of the "origin" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearOrigin() {
+	m.origin = nil
+	m.clearedFields[bronzehistoryawskmskey.FieldOrigin] = struct{}{}
+}
+
+// OriginCleared returns if the "origin" field was cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) OriginCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawskmskey.FieldOrigin]
+	return ok
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetOrigin() {
+	m.origin = nil
+	delete(m.clearedFields, bronzehistoryawskmskey.FieldOrigin)
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ClearEnabled clears the value of the "enabled" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearEnabled() {
+	m.enabled = nil
+	m.clearedFields[bronzehistoryawskmskey.FieldEnabled] = struct{}{}
+}
+
+// EnabledCleared returns if the "enabled" field was cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) EnabledCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawskmskey.FieldEnabled]
+	return ok
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetEnabled() {
+	m.enabled = nil
+	delete(m.clearedFields, bronzehistoryawskmskey.FieldEnabled)
+}
+
+// SetMultiRegion sets the "multi_region" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetMultiRegion(b bool) {
+	m.multi_region = &b
+}
+
+// MultiRegion returns the value of the "multi_region" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) MultiRegion() (r bool, exists bool) {
+	v := m.multi_region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMultiRegion returns the old "multi_region" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldMultiRegion(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMultiRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMultiRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMultiRegion: %w", err)
+	}
+	return oldValue.MultiRegion, nil
+}
+
+// ClearMultiRegion clears the value of the "multi_region" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearMultiRegion() {
+	m.multi_region = nil
+	m.clearedFields[bronzehistoryawskmskey.FieldMultiRegion] = struct{}{}
+}
+
+// MultiRegionCleared returns if the "multi_region" field was cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) MultiRegionCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawskmskey.FieldMultiRegion]
+	return ok
+}
+
+// ResetMultiRegion resets all changes to the "multi_region" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetMultiRegion() {
+	m.multi_region = nil
+	delete(m.clearedFields, bronzehistoryawskmskey.FieldMultiRegion)
+}
+
+// SetCreationDate sets the "creation_date" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetCreationDate(t time.Time) {
+	m.creation_date = &t
+}
+
+// CreationDate returns the value of the "creation_date" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) CreationDate() (r time.Time, exists bool) {
+	v := m.creation_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreationDate returns the old "creation_date" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldCreationDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreationDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreationDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreationDate: %w", err)
+	}
+	return oldValue.CreationDate, nil
+}
+
+// ClearCreationDate clears the value of the "creation_date" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearCreationDate() {
+	m.creation_date = nil
+	m.clearedFields[bronzehistoryawskmskey.FieldCreationDate] = struct{}{}
+}
+
+// CreationDateCleared returns if the "creation_date" field was cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) CreationDateCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawskmskey.FieldCreationDate]
+	return ok
+}
+
+// ResetCreationDate resets all changes to the "creation_date" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetCreationDate() {
+	m.creation_date = nil
+	delete(m.clearedFields, bronzehistoryawskmskey.FieldCreationDate)
+}
+
+// SetDeletionDate sets the "deletion_date" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetDeletionDate(t time.Time) {
+	m.deletion_date = &t
+}
+
+// DeletionDate returns the value of the "deletion_date" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) DeletionDate() (r time.Time, exists bool) {
+	v := m.deletion_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletionDate returns the old "deletion_date" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldDeletionDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletionDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletionDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletionDate: %w", err)
+	}
+	return oldValue.DeletionDate, nil
+}
+
+// ClearDeletionDate clears the value of the "deletion_date" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearDeletionDate() {
+	m.deletion_date = nil
+	m.clearedFields[bronzehistoryawskmskey.FieldDeletionDate] = struct{}{}
+}
+
+// DeletionDateCleared returns if the "deletion_date" field was cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) DeletionDateCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawskmskey.FieldDeletionDate]
+	return ok
+}
+
+// ResetDeletionDate resets all changes to the "deletion_date" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetDeletionDate() {
+	m.deletion_date = nil
+	delete(m.clearedFields, bronzehistoryawskmskey.FieldDeletionDate)
+}
+
+// SetRotationEnabled sets the "rotation_enabled" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetRotationEnabled(b bool) {
+	m.rotation_enabled = &b
+}
+
+// RotationEnabled returns the value of the "rotation_enabled" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) RotationEnabled() (r bool, exists bool) {
+	v := m.rotation_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRotationEnabled returns the old "rotation_enabled" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldRotationEnabled(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRotationEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRotationEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRotationEnabled: %w", err)
+	}
+	return oldValue.RotationEnabled, nil
+}
+
+// ClearRotationEnabled clears the value of the "rotation_enabled" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearRotationEnabled() {
+	m.rotation_enabled = nil
+	m.clearedFields[bronzehistoryawskmskey.FieldRotationEnabled] = struct{}{}
+}
+
+// RotationEnabledCleared returns if the "rotation_enabled" field was cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) RotationEnabledCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawskmskey.FieldRotationEnabled]
+	return ok
+}
+
+// ResetRotationEnabled resets all changes to the "rotation_enabled" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetRotationEnabled() {
+	m.rotation_enabled = nil
+	delete(m.clearedFields, bronzehistoryawskmskey.FieldRotationEnabled)
+}
+
+// SetRotationPeriodInDays sets the "rotation_period_in_days" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetRotationPeriodInDays(i int32) {
+	m.rotation_period_in_days = &i
+	m.addrotation_period_in_days = nil
+}
+
+// RotationPeriodInDays returns the value of the "rotation_period_in_days" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) RotationPeriodInDays() (r int32, exists bool) {
+	v := m.rotation_period_in_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRotationPeriodInDays returns the old "rotation_period_in_days" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldRotationPeriodInDays(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRotationPeriodInDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRotationPeriodInDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRotationPeriodInDays: %w", err)
+	}
+	return oldValue.RotationPeriodInDays, nil
+}
+
+// AddRotationPeriodInDays adds i to the "rotation_period_in_days" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) AddRotationPeriodInDays(i int32) {
+	if m.addrotation_period_in_days != nil {
+		*m.addrotation_period_in_days += i
+	} else {
+		m.addrotation_period_in_days = &i
+	}
+}
+
+// AddedRotationPeriodInDays returns the value that was added to the "rotation_period_in_days" field in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) AddedRotationPeriodInDays() (r int32, exists bool) {
+	v := m.addrotation_period_in_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRotationPeriodInDays clears the value of the "rotation_period_in_days" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearRotationPeriodInDays() {
+	m.rotation_period_in_days = nil
+	m.addrotation_period_in_days = nil
+	m.clearedFields[bronzehistoryawskmskey.FieldRotationPeriodInDays] = struct{}{}
+}
+
+// RotationPeriodInDaysCleared returns if the "rotation_period_in_days" field was cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) RotationPeriodInDaysCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawskmskey.FieldRotationPeriodInDays]
+	return ok
+}
+
+// ResetRotationPeriodInDays resets all changes to the "rotation_period_in_days" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetRotationPeriodInDays() {
+	m.rotation_period_in_days = nil
+	m.addrotation_period_in_days = nil
+	delete(m.clearedFields, bronzehistoryawskmskey.FieldRotationPeriodInDays)
+}
+
+// SetNextRotationDate sets the "next_rotation_date" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetNextRotationDate(t time.Time) {
+	m.next_rotation_date = &t
+}
+
+// NextRotationDate returns the value of the "next_rotation_date" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) NextRotationDate() (r time.Time, exists bool) {
+	v := m.next_rotation_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRotationDate returns the old "next_rotation_date" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldNextRotationDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRotationDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRotationDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRotationDate: %w", err)
+	}
+	return oldValue.NextRotationDate, nil
+}
+
+// ClearNextRotationDate clears the value of the "next_rotation_date" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearNextRotationDate() {
+	m.next_rotation_date = nil
+	m.clearedFields[bronzehistoryawskmskey.FieldNextRotationDate] = struct{}{}
+}
+
+// NextRotationDateCleared returns if the "next_rotation_date" field was cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) NextRotationDateCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawskmskey.FieldNextRotationDate]
+	return ok
+}
+
+// ResetNextRotationDate resets all changes to the "next_rotation_date" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetNextRotationDate() {
+	m.next_rotation_date = nil
+	delete(m.clearedFields, bronzehistoryawskmskey.FieldNextRotationDate)
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetPolicyJSON(jm json.RawMessage) {
+	m.policy_json = &jm
+	m.appendpolicy_json = nil
+}
+
+// PolicyJSON returns the value of the "policy_json" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) PolicyJSON() (r json.RawMessage, exists bool) {
+	v := m.policy_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolicyJSON returns the old "policy_json" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldPolicyJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicyJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicyJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicyJSON: %w", err)
+	}
+	return oldValue.PolicyJSON, nil
+}
+
+// AppendPolicyJSON adds jm to the "policy_json" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) AppendPolicyJSON(jm json.RawMessage) {
+	m.appendpolicy_json = append(m.appendpolicy_json, jm...)
+}
+
+// AppendedPolicyJSON returns the list of values that were appended to the "policy_json" field in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) AppendedPolicyJSON() (json.RawMessage, bool) {
+	if len(m.appendpolicy_json) == 0 {
+		return nil, false
+	}
+	return m.appendpolicy_json, true
+}
+
+// ClearPolicyJSON clears the value of the "policy_json" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearPolicyJSON() {
+	m.policy_json = nil
+	m.appendpolicy_json = nil
+	m.clearedFields[bronzehistoryawskmskey.FieldPolicyJSON] = struct{}{}
+}
+
+// PolicyJSONCleared returns if the "policy_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) PolicyJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawskmskey.FieldPolicyJSON]
+	return ok
+}
+
+// ResetPolicyJSON resets all changes to the "policy_json" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetPolicyJSON() {
+	m.policy_json = nil
+	m.appendpolicy_json = nil
+	delete(m.clearedFields, bronzehistoryawskmskey.FieldPolicyJSON)
+}
+
+// SetAliasesJSON sets the "aliases_json" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetAliasesJSON(jm json.RawMessage) {
+	m.aliases_json = &jm
+	m.appendaliases_json = nil
+}
+
+// AliasesJSON returns the value of the "aliases_json" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) AliasesJSON() (r json.RawMessage, exists bool) {
+	v := m.aliases_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAliasesJSON returns the old "aliases_json" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldAliasesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAliasesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAliasesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAliasesJSON: %w", err)
+	}
+	return oldValue.AliasesJSON, nil
+}
+
+// AppendAliasesJSON adds jm to the "aliases_json" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) AppendAliasesJSON(jm json.RawMessage) {
+	m.appendaliases_json = append(m.appendaliases_json, jm...)
+}
+
+// AppendedAliasesJSON returns the list of values that were appended to the "aliases_json" field in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) AppendedAliasesJSON() (json.RawMessage, bool) {
+	if len(m.appendaliases_json) == 0 {
+		return nil, false
+	}
+	return m.appendaliases_json, true
+}
+
+// ClearAliasesJSON clears the value of the "aliases_json" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearAliasesJSON() {
+	m.aliases_json = nil
+	m.appendaliases_json = nil
+	m.clearedFields[bronzehistoryawskmskey.FieldAliasesJSON] = struct{}{}
+}
+
+// AliasesJSONCleared returns if the "aliases_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) AliasesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawskmskey.FieldAliasesJSON]
+	return ok
+}
+
+// ResetAliasesJSON resets all changes to the "aliases_json" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetAliasesJSON() {
+	m.aliases_json = nil
+	m.appendaliases_json = nil
+	delete(m.clearedFields, bronzehistoryawskmskey.FieldAliasesJSON)
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetTagsJSON(jm json.RawMessage) {
+	m.tags_json = &jm
+	m.appendtags_json = nil
+}
+
+// TagsJSON returns the value of the "tags_json" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) TagsJSON() (r json.RawMessage, exists bool) {
+	v := m.tags_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagsJSON returns the old "tags_json" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldTagsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagsJSON: %w", err)
+	}
+	return oldValue.TagsJSON, nil
+}
+
+// AppendTagsJSON adds jm to the "tags_json" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) AppendTagsJSON(jm json.RawMessage) {
+	m.appendtags_json = append(m.appendtags_json, jm...)
+}
+
+// AppendedTagsJSON returns the list of values that were appended to the "tags_json" field in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) AppendedTagsJSON() (json.RawMessage, bool) {
+	if len(m.appendtags_json) == 0 {
+		return nil, false
+	}
+	return m.appendtags_json, true
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	m.clearedFields[bronzehistoryawskmskey.FieldTagsJSON] = struct{}{}
+}
+
+// TagsJSONCleared returns if the "tags_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) TagsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawskmskey.FieldTagsJSON]
+	return ok
+}
+
+// ResetTagsJSON resets all changes to the "tags_json" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	delete(m.clearedFields, bronzehistoryawskmskey.FieldTagsJSON)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetAccountID(s string) {
+	m.account_id = &s
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) AccountID() (r string, exists bool) {
+	v := m.account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetAccountID() {
+	m.account_id = nil
+}
+
+// SetRegion sets the "region" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetRegion(s string) {
+	m.region = &s
+}
+
+// Region returns the value of the "region" field in the mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) Region() (r string, exists bool) {
+	v := m.region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegion returns the old "region" field's value of the BronzeHistoryAWSKMSKey entity.
+// If the BronzeHistoryAWSKMSKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
+	}
+	return oldValue.Region, nil
+}
+
+// ResetRegion resets all changes to the "region" field.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetRegion() {
+	m.region = nil
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSKMSKeyMutation builder.
+func (m *BronzeHistoryAWSKMSKeyMutation) Where(ps ...predicate.BronzeHistoryAWSKMSKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeHistoryAWSKMSKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeHistoryAWSKMSKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeHistoryAWSKMSKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeHistoryAWSKMSKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeHistoryAWSKMSKey).
+func (m *BronzeHistoryAWSKMSKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeHistoryAWSKMSKeyMutation) Fields() []string {
+	fields := make([]string, 0, 24)
+	if m.valid_from != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldValidFrom)
+	}
+	if m.valid_to != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldValidTo)
+	}
+	if m.collected_at != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldFirstCollectedAt)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldResourceID)
+	}
+	if m.arn != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldArn)
+	}
+	if m.description != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldDescription)
+	}
+	if m.key_state != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldKeyState)
+	}
+	if m.key_usage != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldKeyUsage)
+	}
+	if m.key_spec != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldKeySpec)
+	}
+	if m.key_manager != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldKeyManager)
+	}
+	if m.origin != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldOrigin)
+	}
+	if m.enabled != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldEnabled)
+	}
+	if m.multi_region != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldMultiRegion)
+	}
+	if m.creation_date != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldCreationDate)
+	}
+	if m.deletion_date != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldDeletionDate)
+	}
+	if m.rotation_enabled != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldRotationEnabled)
+	}
+	if m.rotation_period_in_days != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldRotationPeriodInDays)
+	}
+	if m.next_rotation_date != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldNextRotationDate)
+	}
+	if m.policy_json != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldPolicyJSON)
+	}
+	if m.aliases_json != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldAliasesJSON)
+	}
+	if m.tags_json != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldTagsJSON)
+	}
+	if m.account_id != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldAccountID)
+	}
+	if m.region != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldRegion)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeHistoryAWSKMSKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryawskmskey.FieldValidFrom:
+		return m.ValidFrom()
+	case bronzehistoryawskmskey.FieldValidTo:
+		return m.ValidTo()
+	case bronzehistoryawskmskey.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzehistoryawskmskey.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzehistoryawskmskey.FieldResourceID:
+		return m.ResourceID()
+	case bronzehistoryawskmskey.FieldArn:
+		return m.Arn()
+	case bronzehistoryawskmskey.FieldDescription:
+		return m.Description()
+	case bronzehistoryawskmskey.FieldKeyState:
+		return m.KeyState()
+	case bronzehistoryawskmskey.FieldKeyUsage:
+		return m.KeyUsage()
+	case bronzehistoryawskmskey.FieldKeySpec:
+		return m.KeySpec()
+	case bronzehistoryawskmskey.FieldKeyManager:
+		return m.KeyManager()
+	case bronzehistoryawskmskey.FieldOrigin:
+		return m.Origin()
+	case bronzehistoryawskmskey.FieldEnabled:
+		return m.Enabled()
+	case bronzehistoryawskmskey.FieldMultiRegion:
+		return m.MultiRegion()
+	case bronzehistoryawskmskey.FieldCreationDate:
+		return m.CreationDate()
+	case bronzehistoryawskmskey.FieldDeletionDate:
+		return m.DeletionDate()
+	case bronzehistoryawskmskey.FieldRotationEnabled:
+		return m.RotationEnabled()
+	case bronzehistoryawskmskey.FieldRotationPeriodInDays:
+		return m.RotationPeriodInDays()
+	case bronzehistoryawskmskey.FieldNextRotationDate:
+		return m.NextRotationDate()
+	case bronzehistoryawskmskey.FieldPolicyJSON:
+		return m.PolicyJSON()
+	case bronzehistoryawskmskey.FieldAliasesJSON:
+		return m.AliasesJSON()
+	case bronzehistoryawskmskey.FieldTagsJSON:
+		return m.TagsJSON()
+	case bronzehistoryawskmskey.FieldAccountID:
+		return m.AccountID()
+	case bronzehistoryawskmskey.FieldRegion:
+		return m.Region()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeHistoryAWSKMSKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzehistoryawskmskey.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case bronzehistoryawskmskey.FieldValidTo:
+		return m.OldValidTo(ctx)
+	case bronzehistoryawskmskey.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzehistoryawskmskey.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzehistoryawskmskey.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case bronzehistoryawskmskey.FieldArn:
+		return m.OldArn(ctx)
+	case bronzehistoryawskmskey.FieldDescription:
+		return m.OldDescription(ctx)
+	case bronzehistoryawskmskey.FieldKeyState:
+		return m.OldKeyState(ctx)
+	case bronzehistoryawskmskey.FieldKeyUsage:
+		return m.OldKeyUsage(ctx)
+	case bronzehistoryawskmskey.FieldKeySpec:
+		return m.OldKeySpec(ctx)
+	case bronzehistoryawskmskey.FieldKeyManager:
+		return m.OldKeyManager(ctx)
+	case bronzehistoryawskmskey.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case bronzehistoryawskmskey.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case bronzehistoryawskmskey.FieldMultiRegion:
+		return m.OldMultiRegion(ctx)
+	case bronzehistoryawskmskey.FieldCreationDate:
+		return m.OldCreationDate(ctx)
+	case bronzehistoryawskmskey.FieldDeletionDate:
+		return m.OldDeletionDate(ctx)
+	case bronzehistoryawskmskey.FieldRotationEnabled:
+		return m.OldRotationEnabled(ctx)
+	case bronzehistoryawskmskey.FieldRotationPeriodInDays:
+		return m.OldRotationPeriodInDays(ctx)
+	case bronzehistoryawskmskey.FieldNextRotationDate:
+		return m.OldNextRotationDate(ctx)
+	case bronzehistoryawskmskey.FieldPolicyJSON:
+		return m.OldPolicyJSON(ctx)
+	case bronzehistoryawskmskey.FieldAliasesJSON:
+		return m.OldAliasesJSON(ctx)
+	case bronzehistoryawskmskey.FieldTagsJSON:
+		return m.OldTagsJSON(ctx)
+	case bronzehistoryawskmskey.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case bronzehistoryawskmskey.FieldRegion:
+		return m.OldRegion(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeHistoryAWSKMSKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryAWSKMSKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryawskmskey.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case bronzehistoryawskmskey.FieldValidTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidTo(v)
+		return nil
+	case bronzehistoryawskmskey.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzehistoryawskmskey.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzehistoryawskmskey.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case bronzehistoryawskmskey.FieldArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArn(v)
+		return nil
+	case bronzehistoryawskmskey.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case bronzehistoryawskmskey.FieldKeyState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyState(v)
+		return nil
+	case bronzehistoryawskmskey.FieldKeyUsage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyUsage(v)
+		return nil
+	case bronzehistoryawskmskey.FieldKeySpec:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeySpec(v)
+		return nil
+	case bronzehistoryawskmskey.FieldKeyManager:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyManager(v)
+		return nil
+	case bronzehistoryawskmskey.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case bronzehistoryawskmskey.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case bronzehistoryawskmskey.FieldMultiRegion:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMultiRegion(v)
+		return nil
+	case bronzehistoryawskmskey.FieldCreationDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreationDate(v)
+		return nil
+	case bronzehistoryawskmskey.FieldDeletionDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletionDate(v)
+		return nil
+	case bronzehistoryawskmskey.FieldRotationEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRotationEnabled(v)
+		return nil
+	case bronzehistoryawskmskey.FieldRotationPeriodInDays:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRotationPeriodInDays(v)
+		return nil
+	case bronzehistoryawskmskey.FieldNextRotationDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRotationDate(v)
+		return nil
+	case bronzehistoryawskmskey.FieldPolicyJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicyJSON(v)
+		return nil
+	case bronzehistoryawskmskey.FieldAliasesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAliasesJSON(v)
+		return nil
+	case bronzehistoryawskmskey.FieldTagsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagsJSON(v)
+		return nil
+	case bronzehistoryawskmskey.FieldAccountID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case bronzehistoryawskmskey.FieldRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSKMSKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) AddedFields() []string {
+	var fields []string
+	if m.addrotation_period_in_days != nil {
+		fields = append(fields, bronzehistoryawskmskey.FieldRotationPeriodInDays)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeHistoryAWSKMSKeyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryawskmskey.FieldRotationPeriodInDays:
+		return m.AddedRotationPeriodInDays()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryAWSKMSKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryawskmskey.FieldRotationPeriodInDays:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRotationPeriodInDays(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSKMSKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzehistoryawskmskey.FieldValidTo) {
+		fields = append(fields, bronzehistoryawskmskey.FieldValidTo)
+	}
+	if m.FieldCleared(bronzehistoryawskmskey.FieldArn) {
+		fields = append(fields, bronzehistoryawskmskey.FieldArn)
+	}
+	if m.FieldCleared(bronzehistoryawskmskey.FieldDescription) {
+		fields = append(fields, bronzehistoryawskmskey.FieldDescription)
+	}
+	if m.FieldCleared(bronzehistoryawskmskey.FieldKeyState) {
+		fields = append(fields, bronzehistoryawskmskey.FieldKeyState)
+	}
+	if m.FieldCleared(bronzehistoryawskmskey.FieldKeyUsage) {
+		fields = append(fields, bronzehistoryawskmskey.FieldKeyUsage)
+	}
+	if m.FieldCleared(bronzehistoryawskmskey.FieldKeySpec) {
+		fields = append(fields, bronzehistoryawskmskey.FieldKeySpec)
+	}
+	if m.FieldCleared(bronzehistoryawskmskey.FieldKeyManager) {
+		fields = append(fields, bronzehistoryawskmskey.FieldKeyManager)
+	}
+	if m.FieldCleared(bronzehistoryawskmskey.FieldOrigin) {
+		fields = append(fields, bronzehistoryawskmskey.FieldOrigin)
+	}
+	if m.FieldCleared(bronzehistoryawskmskey.FieldEnabled) {
+		fields = append(fields, bronzehistoryawskmskey.FieldEnabled)
+	}
+	if m.FieldCleared(bronzehistoryawskmskey.FieldMultiRegion) {
+		fields = append(fields, bronzehistoryawskmskey.FieldMultiRegion)
+	}
+	if m.FieldCleared(bronzehistoryawskmskey.FieldCreationDate) {
+		fields = append(fields, bronzehistoryawskmskey.FieldCreationDate)
+	}
+	if m.FieldCleared(bronzehistoryawskmskey.FieldDeletionDate) {
+		fields = append(fields, bronzehistoryawskmskey.FieldDeletionDate)
+	}
+	if m.FieldCleared(bronzehistoryawskmskey.FieldRotationEnabled) {
+		fields = append(fields, bronzehistoryawskmskey.FieldRotationEnabled)
+	}
+	if m.FieldCleared(bronzehistoryawskmskey.FieldRotationPeriodInDays) {
+		fields = append(fields, bronzehistoryawskmskey.FieldRotationPeriodInDays)
+	}
+	if m.FieldCleared(bronzehistoryawskmskey.FieldNextRotationDate) {
+		fields = append(fields, bronzehistoryawskmskey.FieldNextRotationDate)
+	}
+	if m.FieldCleared(bronzehistoryawskmskey.FieldPolicyJSON) {
+		fields = append(fields, bronzehistoryawskmskey.FieldPolicyJSON)
+	}
+	if m.FieldCleared(bronzehistoryawskmskey.FieldAliasesJSON) {
+		fields = append(fields, bronzehistoryawskmskey.FieldAliasesJSON)
+	}
+	if m.FieldCleared(bronzehistoryawskmskey.FieldTagsJSON) {
+		fields = append(fields, bronzehistoryawskmskey.FieldTagsJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearField(name string) error {
+	switch name {
+	case bronzehistoryawskmskey.FieldValidTo:
+		m.ClearValidTo()
+		return nil
+	case bronzehistoryawskmskey.FieldArn:
+		m.ClearArn()
+		return nil
+	case bronzehistoryawskmskey.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case bronzehistoryawskmskey.FieldKeyState:
+		m.ClearKeyState()
+		return nil
+	case bronzehistoryawskmskey.FieldKeyUsage:
+		m.ClearKeyUsage()
+		return nil
+	case bronzehistoryawskmskey.FieldKeySpec:
+		m.ClearKeySpec()
+		return nil
+	case bronzehistoryawskmskey.FieldKeyManager:
+		m.ClearKeyManager()
+		return nil
+	case bronzehistoryawskmskey.FieldOrigin:
+		m.ClearOrigin()
+		return nil
+	case bronzehistoryawskmskey.FieldEnabled:
+		m.ClearEnabled()
+		return nil
+	case bronzehistoryawskmskey.FieldMultiRegion:
+		m.ClearMultiRegion()
+		return nil
+	case bronzehistoryawskmskey.FieldCreationDate:
+		m.ClearCreationDate()
+		return nil
+	case bronzehistoryawskmskey.FieldDeletionDate:
+		m.ClearDeletionDate()
+		return nil
+	case bronzehistoryawskmskey.FieldRotationEnabled:
+		m.ClearRotationEnabled()
+		return nil
+	case bronzehistoryawskmskey.FieldRotationPeriodInDays:
+		m.ClearRotationPeriodInDays()
+		return nil
+	case bronzehistoryawskmskey.FieldNextRotationDate:
+		m.ClearNextRotationDate()
+		return nil
+	case bronzehistoryawskmskey.FieldPolicyJSON:
+		m.ClearPolicyJSON()
+		return nil
+	case bronzehistoryawskmskey.FieldAliasesJSON:
+		m.ClearAliasesJSON()
+		return nil
+	case bronzehistoryawskmskey.FieldTagsJSON:
+		m.ClearTagsJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSKMSKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetField(name string) error {
+	switch name {
+	case bronzehistoryawskmskey.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case bronzehistoryawskmskey.FieldValidTo:
+		m.ResetValidTo()
+		return nil
+	case bronzehistoryawskmskey.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzehistoryawskmskey.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzehistoryawskmskey.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case bronzehistoryawskmskey.FieldArn:
+		m.ResetArn()
+		return nil
+	case bronzehistoryawskmskey.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case bronzehistoryawskmskey.FieldKeyState:
+		m.ResetKeyState()
+		return nil
+	case bronzehistoryawskmskey.FieldKeyUsage:
+		m.ResetKeyUsage()
+		return nil
+	case bronzehistoryawskmskey.FieldKeySpec:
+		m.ResetKeySpec()
+		return nil
+	case bronzehistoryawskmskey.FieldKeyManager:
+		m.ResetKeyManager()
+		return nil
+	case bronzehistoryawskmskey.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case bronzehistoryawskmskey.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case bronzehistoryawskmskey.FieldMultiRegion:
+		m.ResetMultiRegion()
+		return nil
+	case bronzehistoryawskmskey.FieldCreationDate:
+		m.ResetCreationDate()
+		return nil
+	case bronzehistoryawskmskey.FieldDeletionDate:
+		m.ResetDeletionDate()
+		return nil
+	case bronzehistoryawskmskey.FieldRotationEnabled:
+		m.ResetRotationEnabled()
+		return nil
+	case bronzehistoryawskmskey.FieldRotationPeriodInDays:
+		m.ResetRotationPeriodInDays()
+		return nil
+	case bronzehistoryawskmskey.FieldNextRotationDate:
+		m.ResetNextRotationDate()
+		return nil
+	case bronzehistoryawskmskey.FieldPolicyJSON:
+		m.ResetPolicyJSON()
+		return nil
+	case bronzehistoryawskmskey.FieldAliasesJSON:
+		m.ResetAliasesJSON()
+		return nil
+	case bronzehistoryawskmskey.FieldTagsJSON:
+		m.ResetTagsJSON()
+		return nil
+	case bronzehistoryawskmskey.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case bronzehistoryawskmskey.FieldRegion:
+		m.ResetRegion()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSKMSKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeHistoryAWSKMSKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeHistoryAWSKMSKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryAWSKMSKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeHistoryAWSKMSKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryAWSKMSKey edge %s", name)
+}
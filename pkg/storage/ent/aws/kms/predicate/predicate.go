@@ -0,0 +1,13 @@
+// Code generated by ent, DO NOT EDIT.
+
+package predicate
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeAWSKMSKey is the predicate function for bronzeawskmskey builders.
+type BronzeAWSKMSKey func(*sql.Selector)
+
+// BronzeHistoryAWSKMSKey is the predicate function for bronzehistoryawskmskey builders.
+type BronzeHistoryAWSKMSKey func(*sql.Selector)
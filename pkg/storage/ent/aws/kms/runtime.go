@@ -0,0 +1,39 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kms
+
+import (
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/bronzeawskmskey"
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/bronzehistoryawskmskey"
+	"danny.vn/hotpot/pkg/storage/ent/aws/kms/schema"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	bronzeawskmskeyFields := schema.BronzeAWSKMSKey{}.Fields()
+	_ = bronzeawskmskeyFields
+	// bronzeawskmskeyDescAccountID is the schema descriptor for account_id field.
+	bronzeawskmskeyDescAccountID := bronzeawskmskeyFields[18].Descriptor()
+	// bronzeawskmskey.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzeawskmskey.AccountIDValidator = bronzeawskmskeyDescAccountID.Validators[0].(func(string) error)
+	// bronzeawskmskeyDescRegion is the schema descriptor for region field.
+	bronzeawskmskeyDescRegion := bronzeawskmskeyFields[19].Descriptor()
+	// bronzeawskmskey.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzeawskmskey.RegionValidator = bronzeawskmskeyDescRegion.Validators[0].(func(string) error)
+	bronzehistoryawskmskeyFields := schema.BronzeHistoryAWSKMSKey{}.Fields()
+	_ = bronzehistoryawskmskeyFields
+	// bronzehistoryawskmskeyDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryawskmskeyDescResourceID := bronzehistoryawskmskeyFields[1].Descriptor()
+	// bronzehistoryawskmskey.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryawskmskey.ResourceIDValidator = bronzehistoryawskmskeyDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryawskmskeyDescAccountID is the schema descriptor for account_id field.
+	bronzehistoryawskmskeyDescAccountID := bronzehistoryawskmskeyFields[19].Descriptor()
+	// bronzehistoryawskmskey.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzehistoryawskmskey.AccountIDValidator = bronzehistoryawskmskeyDescAccountID.Validators[0].(func(string) error)
+	// bronzehistoryawskmskeyDescRegion is the schema descriptor for region field.
+	bronzehistoryawskmskeyDescRegion := bronzehistoryawskmskeyFields[20].Descriptor()
+	// bronzehistoryawskmskey.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzehistoryawskmskey.RegionValidator = bronzehistoryawskmskeyDescRegion.Validators[0].(func(string) error)
+}
@@ -0,0 +1,15 @@
+// Code generated by entcgen. DO NOT EDIT.
+package schema
+
+import (
+	bronze_aws_kms "danny.vn/hotpot/pkg/schema/bronze/aws/kms"
+	bronzehistory_aws_kms "danny.vn/hotpot/pkg/schema/bronzehistory/aws/kms"
+)
+
+type BronzeAWSKMSKey struct {
+	bronze_aws_kms.BronzeAWSKMSKey
+}
+
+type BronzeHistoryAWSKMSKey struct {
+	bronzehistory_aws_kms.BronzeHistoryAWSKMSKey
+}
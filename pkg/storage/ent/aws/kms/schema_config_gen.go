@@ -0,0 +1,10 @@
+// Code generated by entcgen. DO NOT EDIT.
+package kms
+
+// DefaultSchemaConfig returns the schema config mapping each type to its PG schema.
+func DefaultSchemaConfig() SchemaConfig {
+	return SchemaConfig{
+		BronzeAWSKMSKey:        "bronze",
+		BronzeHistoryAWSKMSKey: "bronze_history",
+	}
+}
@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawss3bucket
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzeawss3bucket type in the database.
+	Label = "bronze_awss3bucket"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldArn holds the string denoting the arn field in the database.
+	FieldArn = "arn"
+	// FieldCreationDate holds the string denoting the creation_date field in the database.
+	FieldCreationDate = "creation_date"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
+	// FieldBlockPublicAcls holds the string denoting the block_public_acls field in the database.
+	FieldBlockPublicAcls = "block_public_acls"
+	// FieldIgnorePublicAcls holds the string denoting the ignore_public_acls field in the database.
+	FieldIgnorePublicAcls = "ignore_public_acls"
+	// FieldBlockPublicPolicy holds the string denoting the block_public_policy field in the database.
+	FieldBlockPublicPolicy = "block_public_policy"
+	// FieldRestrictPublicBuckets holds the string denoting the restrict_public_buckets field in the database.
+	FieldRestrictPublicBuckets = "restrict_public_buckets"
+	// FieldPolicyIsPublic holds the string denoting the policy_is_public field in the database.
+	FieldPolicyIsPublic = "policy_is_public"
+	// FieldSseAlgorithm holds the string denoting the sse_algorithm field in the database.
+	FieldSseAlgorithm = "sse_algorithm"
+	// FieldKmsMasterKeyID holds the string denoting the kms_master_key_id field in the database.
+	FieldKmsMasterKeyID = "kms_master_key_id"
+	// FieldBucketKeyEnabled holds the string denoting the bucket_key_enabled field in the database.
+	FieldBucketKeyEnabled = "bucket_key_enabled"
+	// FieldVersioningStatus holds the string denoting the versioning_status field in the database.
+	FieldVersioningStatus = "versioning_status"
+	// FieldMfaDelete holds the string denoting the mfa_delete field in the database.
+	FieldMfaDelete = "mfa_delete"
+	// FieldLoggingTargetBucket holds the string denoting the logging_target_bucket field in the database.
+	FieldLoggingTargetBucket = "logging_target_bucket"
+	// FieldLoggingTargetPrefix holds the string denoting the logging_target_prefix field in the database.
+	FieldLoggingTargetPrefix = "logging_target_prefix"
+	// FieldPolicyJSON holds the string denoting the policy_json field in the database.
+	FieldPolicyJSON = "policy_json"
+	// FieldACLGrantsJSON holds the string denoting the acl_grants_json field in the database.
+	FieldACLGrantsJSON = "acl_grants_json"
+	// FieldTagsJSON holds the string denoting the tags_json field in the database.
+	FieldTagsJSON = "tags_json"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
+	// Table holds the table name of the bronzeawss3bucket in the database.
+	Table = "aws_s3_buckets"
+)
+
+// Columns holds all SQL columns for bronzeawss3bucket fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldArn,
+	FieldCreationDate,
+	FieldOwnerID,
+	FieldBlockPublicAcls,
+	FieldIgnorePublicAcls,
+	FieldBlockPublicPolicy,
+	FieldRestrictPublicBuckets,
+	FieldPolicyIsPublic,
+	FieldSseAlgorithm,
+	FieldKmsMasterKeyID,
+	FieldBucketKeyEnabled,
+	FieldVersioningStatus,
+	FieldMfaDelete,
+	FieldLoggingTargetBucket,
+	FieldLoggingTargetPrefix,
+	FieldPolicyJSON,
+	FieldACLGrantsJSON,
+	FieldTagsJSON,
+	FieldAccountID,
+	FieldRegion,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	AccountIDValidator func(string) error
+	// RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	RegionValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BronzeAWSS3Bucket queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByArn orders the results by the arn field.
+func ByArn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArn, opts...).ToFunc()
+}
+
+// ByCreationDate orders the results by the creation_date field.
+func ByCreationDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreationDate, opts...).ToFunc()
+}
+
+// ByOwnerID orders the results by the owner_id field.
+func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
+}
+
+// ByBlockPublicAcls orders the results by the block_public_acls field.
+func ByBlockPublicAcls(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockPublicAcls, opts...).ToFunc()
+}
+
+// ByIgnorePublicAcls orders the results by the ignore_public_acls field.
+func ByIgnorePublicAcls(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIgnorePublicAcls, opts...).ToFunc()
+}
+
+// ByBlockPublicPolicy orders the results by the block_public_policy field.
+func ByBlockPublicPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockPublicPolicy, opts...).ToFunc()
+}
+
+// ByRestrictPublicBuckets orders the results by the restrict_public_buckets field.
+func ByRestrictPublicBuckets(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestrictPublicBuckets, opts...).ToFunc()
+}
+
+// ByPolicyIsPublic orders the results by the policy_is_public field.
+func ByPolicyIsPublic(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPolicyIsPublic, opts...).ToFunc()
+}
+
+// BySseAlgorithm orders the results by the sse_algorithm field.
+func BySseAlgorithm(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSseAlgorithm, opts...).ToFunc()
+}
+
+// ByKmsMasterKeyID orders the results by the kms_master_key_id field.
+func ByKmsMasterKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKmsMasterKeyID, opts...).ToFunc()
+}
+
+// ByBucketKeyEnabled orders the results by the bucket_key_enabled field.
+func ByBucketKeyEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBucketKeyEnabled, opts...).ToFunc()
+}
+
+// ByVersioningStatus orders the results by the versioning_status field.
+func ByVersioningStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersioningStatus, opts...).ToFunc()
+}
+
+// ByMfaDelete orders the results by the mfa_delete field.
+func ByMfaDelete(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMfaDelete, opts...).ToFunc()
+}
+
+// ByLoggingTargetBucket orders the results by the logging_target_bucket field.
+func ByLoggingTargetBucket(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoggingTargetBucket, opts...).ToFunc()
+}
+
+// ByLoggingTargetPrefix orders the results by the logging_target_prefix field.
+func ByLoggingTargetPrefix(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoggingTargetPrefix, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByRegion orders the results by the region field.
+func ByRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegion, opts...).ToFunc()
+}
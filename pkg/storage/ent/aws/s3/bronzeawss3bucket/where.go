@@ -0,0 +1,1185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzeawss3bucket
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// Arn applies equality check predicate on the "arn" field. It's identical to ArnEQ.
+func Arn(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldArn, v))
+}
+
+// CreationDate applies equality check predicate on the "creation_date" field. It's identical to CreationDateEQ.
+func CreationDate(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldCreationDate, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// BlockPublicAcls applies equality check predicate on the "block_public_acls" field. It's identical to BlockPublicAclsEQ.
+func BlockPublicAcls(v bool) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldBlockPublicAcls, v))
+}
+
+// IgnorePublicAcls applies equality check predicate on the "ignore_public_acls" field. It's identical to IgnorePublicAclsEQ.
+func IgnorePublicAcls(v bool) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldIgnorePublicAcls, v))
+}
+
+// BlockPublicPolicy applies equality check predicate on the "block_public_policy" field. It's identical to BlockPublicPolicyEQ.
+func BlockPublicPolicy(v bool) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldBlockPublicPolicy, v))
+}
+
+// RestrictPublicBuckets applies equality check predicate on the "restrict_public_buckets" field. It's identical to RestrictPublicBucketsEQ.
+func RestrictPublicBuckets(v bool) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldRestrictPublicBuckets, v))
+}
+
+// PolicyIsPublic applies equality check predicate on the "policy_is_public" field. It's identical to PolicyIsPublicEQ.
+func PolicyIsPublic(v bool) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldPolicyIsPublic, v))
+}
+
+// SseAlgorithm applies equality check predicate on the "sse_algorithm" field. It's identical to SseAlgorithmEQ.
+func SseAlgorithm(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldSseAlgorithm, v))
+}
+
+// KmsMasterKeyID applies equality check predicate on the "kms_master_key_id" field. It's identical to KmsMasterKeyIDEQ.
+func KmsMasterKeyID(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldKmsMasterKeyID, v))
+}
+
+// BucketKeyEnabled applies equality check predicate on the "bucket_key_enabled" field. It's identical to BucketKeyEnabledEQ.
+func BucketKeyEnabled(v bool) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldBucketKeyEnabled, v))
+}
+
+// VersioningStatus applies equality check predicate on the "versioning_status" field. It's identical to VersioningStatusEQ.
+func VersioningStatus(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldVersioningStatus, v))
+}
+
+// MfaDelete applies equality check predicate on the "mfa_delete" field. It's identical to MfaDeleteEQ.
+func MfaDelete(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldMfaDelete, v))
+}
+
+// LoggingTargetBucket applies equality check predicate on the "logging_target_bucket" field. It's identical to LoggingTargetBucketEQ.
+func LoggingTargetBucket(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldLoggingTargetBucket, v))
+}
+
+// LoggingTargetPrefix applies equality check predicate on the "logging_target_prefix" field. It's identical to LoggingTargetPrefixEQ.
+func LoggingTargetPrefix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldLoggingTargetPrefix, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldRegion, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ArnEQ applies the EQ predicate on the "arn" field.
+func ArnEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldArn, v))
+}
+
+// ArnNEQ applies the NEQ predicate on the "arn" field.
+func ArnNEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldArn, v))
+}
+
+// ArnIn applies the In predicate on the "arn" field.
+func ArnIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIn(FieldArn, vs...))
+}
+
+// ArnNotIn applies the NotIn predicate on the "arn" field.
+func ArnNotIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotIn(FieldArn, vs...))
+}
+
+// ArnGT applies the GT predicate on the "arn" field.
+func ArnGT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGT(FieldArn, v))
+}
+
+// ArnGTE applies the GTE predicate on the "arn" field.
+func ArnGTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGTE(FieldArn, v))
+}
+
+// ArnLT applies the LT predicate on the "arn" field.
+func ArnLT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLT(FieldArn, v))
+}
+
+// ArnLTE applies the LTE predicate on the "arn" field.
+func ArnLTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLTE(FieldArn, v))
+}
+
+// ArnContains applies the Contains predicate on the "arn" field.
+func ArnContains(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContains(FieldArn, v))
+}
+
+// ArnHasPrefix applies the HasPrefix predicate on the "arn" field.
+func ArnHasPrefix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasPrefix(FieldArn, v))
+}
+
+// ArnHasSuffix applies the HasSuffix predicate on the "arn" field.
+func ArnHasSuffix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasSuffix(FieldArn, v))
+}
+
+// ArnIsNil applies the IsNil predicate on the "arn" field.
+func ArnIsNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIsNull(FieldArn))
+}
+
+// ArnNotNil applies the NotNil predicate on the "arn" field.
+func ArnNotNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotNull(FieldArn))
+}
+
+// ArnEqualFold applies the EqualFold predicate on the "arn" field.
+func ArnEqualFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEqualFold(FieldArn, v))
+}
+
+// ArnContainsFold applies the ContainsFold predicate on the "arn" field.
+func ArnContainsFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContainsFold(FieldArn, v))
+}
+
+// CreationDateEQ applies the EQ predicate on the "creation_date" field.
+func CreationDateEQ(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldCreationDate, v))
+}
+
+// CreationDateNEQ applies the NEQ predicate on the "creation_date" field.
+func CreationDateNEQ(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldCreationDate, v))
+}
+
+// CreationDateIn applies the In predicate on the "creation_date" field.
+func CreationDateIn(vs ...time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIn(FieldCreationDate, vs...))
+}
+
+// CreationDateNotIn applies the NotIn predicate on the "creation_date" field.
+func CreationDateNotIn(vs ...time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotIn(FieldCreationDate, vs...))
+}
+
+// CreationDateGT applies the GT predicate on the "creation_date" field.
+func CreationDateGT(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGT(FieldCreationDate, v))
+}
+
+// CreationDateGTE applies the GTE predicate on the "creation_date" field.
+func CreationDateGTE(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGTE(FieldCreationDate, v))
+}
+
+// CreationDateLT applies the LT predicate on the "creation_date" field.
+func CreationDateLT(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLT(FieldCreationDate, v))
+}
+
+// CreationDateLTE applies the LTE predicate on the "creation_date" field.
+func CreationDateLTE(v time.Time) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLTE(FieldCreationDate, v))
+}
+
+// CreationDateIsNil applies the IsNil predicate on the "creation_date" field.
+func CreationDateIsNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIsNull(FieldCreationDate))
+}
+
+// CreationDateNotNil applies the NotNil predicate on the "creation_date" field.
+func CreationDateNotNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotNull(FieldCreationDate))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContains(FieldOwnerID, v))
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasPrefix(FieldOwnerID, v))
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasSuffix(FieldOwnerID, v))
+}
+
+// OwnerIDIsNil applies the IsNil predicate on the "owner_id" field.
+func OwnerIDIsNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIsNull(FieldOwnerID))
+}
+
+// OwnerIDNotNil applies the NotNil predicate on the "owner_id" field.
+func OwnerIDNotNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotNull(FieldOwnerID))
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEqualFold(FieldOwnerID, v))
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContainsFold(FieldOwnerID, v))
+}
+
+// BlockPublicAclsEQ applies the EQ predicate on the "block_public_acls" field.
+func BlockPublicAclsEQ(v bool) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldBlockPublicAcls, v))
+}
+
+// BlockPublicAclsNEQ applies the NEQ predicate on the "block_public_acls" field.
+func BlockPublicAclsNEQ(v bool) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldBlockPublicAcls, v))
+}
+
+// BlockPublicAclsIsNil applies the IsNil predicate on the "block_public_acls" field.
+func BlockPublicAclsIsNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIsNull(FieldBlockPublicAcls))
+}
+
+// BlockPublicAclsNotNil applies the NotNil predicate on the "block_public_acls" field.
+func BlockPublicAclsNotNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotNull(FieldBlockPublicAcls))
+}
+
+// IgnorePublicAclsEQ applies the EQ predicate on the "ignore_public_acls" field.
+func IgnorePublicAclsEQ(v bool) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldIgnorePublicAcls, v))
+}
+
+// IgnorePublicAclsNEQ applies the NEQ predicate on the "ignore_public_acls" field.
+func IgnorePublicAclsNEQ(v bool) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldIgnorePublicAcls, v))
+}
+
+// IgnorePublicAclsIsNil applies the IsNil predicate on the "ignore_public_acls" field.
+func IgnorePublicAclsIsNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIsNull(FieldIgnorePublicAcls))
+}
+
+// IgnorePublicAclsNotNil applies the NotNil predicate on the "ignore_public_acls" field.
+func IgnorePublicAclsNotNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotNull(FieldIgnorePublicAcls))
+}
+
+// BlockPublicPolicyEQ applies the EQ predicate on the "block_public_policy" field.
+func BlockPublicPolicyEQ(v bool) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldBlockPublicPolicy, v))
+}
+
+// BlockPublicPolicyNEQ applies the NEQ predicate on the "block_public_policy" field.
+func BlockPublicPolicyNEQ(v bool) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldBlockPublicPolicy, v))
+}
+
+// BlockPublicPolicyIsNil applies the IsNil predicate on the "block_public_policy" field.
+func BlockPublicPolicyIsNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIsNull(FieldBlockPublicPolicy))
+}
+
+// BlockPublicPolicyNotNil applies the NotNil predicate on the "block_public_policy" field.
+func BlockPublicPolicyNotNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotNull(FieldBlockPublicPolicy))
+}
+
+// RestrictPublicBucketsEQ applies the EQ predicate on the "restrict_public_buckets" field.
+func RestrictPublicBucketsEQ(v bool) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldRestrictPublicBuckets, v))
+}
+
+// RestrictPublicBucketsNEQ applies the NEQ predicate on the "restrict_public_buckets" field.
+func RestrictPublicBucketsNEQ(v bool) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldRestrictPublicBuckets, v))
+}
+
+// RestrictPublicBucketsIsNil applies the IsNil predicate on the "restrict_public_buckets" field.
+func RestrictPublicBucketsIsNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIsNull(FieldRestrictPublicBuckets))
+}
+
+// RestrictPublicBucketsNotNil applies the NotNil predicate on the "restrict_public_buckets" field.
+func RestrictPublicBucketsNotNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotNull(FieldRestrictPublicBuckets))
+}
+
+// PolicyIsPublicEQ applies the EQ predicate on the "policy_is_public" field.
+func PolicyIsPublicEQ(v bool) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldPolicyIsPublic, v))
+}
+
+// PolicyIsPublicNEQ applies the NEQ predicate on the "policy_is_public" field.
+func PolicyIsPublicNEQ(v bool) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldPolicyIsPublic, v))
+}
+
+// PolicyIsPublicIsNil applies the IsNil predicate on the "policy_is_public" field.
+func PolicyIsPublicIsNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIsNull(FieldPolicyIsPublic))
+}
+
+// PolicyIsPublicNotNil applies the NotNil predicate on the "policy_is_public" field.
+func PolicyIsPublicNotNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotNull(FieldPolicyIsPublic))
+}
+
+// SseAlgorithmEQ applies the EQ predicate on the "sse_algorithm" field.
+func SseAlgorithmEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldSseAlgorithm, v))
+}
+
+// SseAlgorithmNEQ applies the NEQ predicate on the "sse_algorithm" field.
+func SseAlgorithmNEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldSseAlgorithm, v))
+}
+
+// SseAlgorithmIn applies the In predicate on the "sse_algorithm" field.
+func SseAlgorithmIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIn(FieldSseAlgorithm, vs...))
+}
+
+// SseAlgorithmNotIn applies the NotIn predicate on the "sse_algorithm" field.
+func SseAlgorithmNotIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotIn(FieldSseAlgorithm, vs...))
+}
+
+// SseAlgorithmGT applies the GT predicate on the "sse_algorithm" field.
+func SseAlgorithmGT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGT(FieldSseAlgorithm, v))
+}
+
+// SseAlgorithmGTE applies the GTE predicate on the "sse_algorithm" field.
+func SseAlgorithmGTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGTE(FieldSseAlgorithm, v))
+}
+
+// SseAlgorithmLT applies the LT predicate on the "sse_algorithm" field.
+func SseAlgorithmLT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLT(FieldSseAlgorithm, v))
+}
+
+// SseAlgorithmLTE applies the LTE predicate on the "sse_algorithm" field.
+func SseAlgorithmLTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLTE(FieldSseAlgorithm, v))
+}
+
+// SseAlgorithmContains applies the Contains predicate on the "sse_algorithm" field.
+func SseAlgorithmContains(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContains(FieldSseAlgorithm, v))
+}
+
+// SseAlgorithmHasPrefix applies the HasPrefix predicate on the "sse_algorithm" field.
+func SseAlgorithmHasPrefix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasPrefix(FieldSseAlgorithm, v))
+}
+
+// SseAlgorithmHasSuffix applies the HasSuffix predicate on the "sse_algorithm" field.
+func SseAlgorithmHasSuffix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasSuffix(FieldSseAlgorithm, v))
+}
+
+// SseAlgorithmIsNil applies the IsNil predicate on the "sse_algorithm" field.
+func SseAlgorithmIsNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIsNull(FieldSseAlgorithm))
+}
+
+// SseAlgorithmNotNil applies the NotNil predicate on the "sse_algorithm" field.
+func SseAlgorithmNotNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotNull(FieldSseAlgorithm))
+}
+
+// SseAlgorithmEqualFold applies the EqualFold predicate on the "sse_algorithm" field.
+func SseAlgorithmEqualFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEqualFold(FieldSseAlgorithm, v))
+}
+
+// SseAlgorithmContainsFold applies the ContainsFold predicate on the "sse_algorithm" field.
+func SseAlgorithmContainsFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContainsFold(FieldSseAlgorithm, v))
+}
+
+// KmsMasterKeyIDEQ applies the EQ predicate on the "kms_master_key_id" field.
+func KmsMasterKeyIDEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldKmsMasterKeyID, v))
+}
+
+// KmsMasterKeyIDNEQ applies the NEQ predicate on the "kms_master_key_id" field.
+func KmsMasterKeyIDNEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldKmsMasterKeyID, v))
+}
+
+// KmsMasterKeyIDIn applies the In predicate on the "kms_master_key_id" field.
+func KmsMasterKeyIDIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIn(FieldKmsMasterKeyID, vs...))
+}
+
+// KmsMasterKeyIDNotIn applies the NotIn predicate on the "kms_master_key_id" field.
+func KmsMasterKeyIDNotIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotIn(FieldKmsMasterKeyID, vs...))
+}
+
+// KmsMasterKeyIDGT applies the GT predicate on the "kms_master_key_id" field.
+func KmsMasterKeyIDGT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGT(FieldKmsMasterKeyID, v))
+}
+
+// KmsMasterKeyIDGTE applies the GTE predicate on the "kms_master_key_id" field.
+func KmsMasterKeyIDGTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGTE(FieldKmsMasterKeyID, v))
+}
+
+// KmsMasterKeyIDLT applies the LT predicate on the "kms_master_key_id" field.
+func KmsMasterKeyIDLT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLT(FieldKmsMasterKeyID, v))
+}
+
+// KmsMasterKeyIDLTE applies the LTE predicate on the "kms_master_key_id" field.
+func KmsMasterKeyIDLTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLTE(FieldKmsMasterKeyID, v))
+}
+
+// KmsMasterKeyIDContains applies the Contains predicate on the "kms_master_key_id" field.
+func KmsMasterKeyIDContains(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContains(FieldKmsMasterKeyID, v))
+}
+
+// KmsMasterKeyIDHasPrefix applies the HasPrefix predicate on the "kms_master_key_id" field.
+func KmsMasterKeyIDHasPrefix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasPrefix(FieldKmsMasterKeyID, v))
+}
+
+// KmsMasterKeyIDHasSuffix applies the HasSuffix predicate on the "kms_master_key_id" field.
+func KmsMasterKeyIDHasSuffix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasSuffix(FieldKmsMasterKeyID, v))
+}
+
+// KmsMasterKeyIDIsNil applies the IsNil predicate on the "kms_master_key_id" field.
+func KmsMasterKeyIDIsNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIsNull(FieldKmsMasterKeyID))
+}
+
+// KmsMasterKeyIDNotNil applies the NotNil predicate on the "kms_master_key_id" field.
+func KmsMasterKeyIDNotNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotNull(FieldKmsMasterKeyID))
+}
+
+// KmsMasterKeyIDEqualFold applies the EqualFold predicate on the "kms_master_key_id" field.
+func KmsMasterKeyIDEqualFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEqualFold(FieldKmsMasterKeyID, v))
+}
+
+// KmsMasterKeyIDContainsFold applies the ContainsFold predicate on the "kms_master_key_id" field.
+func KmsMasterKeyIDContainsFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContainsFold(FieldKmsMasterKeyID, v))
+}
+
+// BucketKeyEnabledEQ applies the EQ predicate on the "bucket_key_enabled" field.
+func BucketKeyEnabledEQ(v bool) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldBucketKeyEnabled, v))
+}
+
+// BucketKeyEnabledNEQ applies the NEQ predicate on the "bucket_key_enabled" field.
+func BucketKeyEnabledNEQ(v bool) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldBucketKeyEnabled, v))
+}
+
+// BucketKeyEnabledIsNil applies the IsNil predicate on the "bucket_key_enabled" field.
+func BucketKeyEnabledIsNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIsNull(FieldBucketKeyEnabled))
+}
+
+// BucketKeyEnabledNotNil applies the NotNil predicate on the "bucket_key_enabled" field.
+func BucketKeyEnabledNotNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotNull(FieldBucketKeyEnabled))
+}
+
+// VersioningStatusEQ applies the EQ predicate on the "versioning_status" field.
+func VersioningStatusEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldVersioningStatus, v))
+}
+
+// VersioningStatusNEQ applies the NEQ predicate on the "versioning_status" field.
+func VersioningStatusNEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldVersioningStatus, v))
+}
+
+// VersioningStatusIn applies the In predicate on the "versioning_status" field.
+func VersioningStatusIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIn(FieldVersioningStatus, vs...))
+}
+
+// VersioningStatusNotIn applies the NotIn predicate on the "versioning_status" field.
+func VersioningStatusNotIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotIn(FieldVersioningStatus, vs...))
+}
+
+// VersioningStatusGT applies the GT predicate on the "versioning_status" field.
+func VersioningStatusGT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGT(FieldVersioningStatus, v))
+}
+
+// VersioningStatusGTE applies the GTE predicate on the "versioning_status" field.
+func VersioningStatusGTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGTE(FieldVersioningStatus, v))
+}
+
+// VersioningStatusLT applies the LT predicate on the "versioning_status" field.
+func VersioningStatusLT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLT(FieldVersioningStatus, v))
+}
+
+// VersioningStatusLTE applies the LTE predicate on the "versioning_status" field.
+func VersioningStatusLTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLTE(FieldVersioningStatus, v))
+}
+
+// VersioningStatusContains applies the Contains predicate on the "versioning_status" field.
+func VersioningStatusContains(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContains(FieldVersioningStatus, v))
+}
+
+// VersioningStatusHasPrefix applies the HasPrefix predicate on the "versioning_status" field.
+func VersioningStatusHasPrefix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasPrefix(FieldVersioningStatus, v))
+}
+
+// VersioningStatusHasSuffix applies the HasSuffix predicate on the "versioning_status" field.
+func VersioningStatusHasSuffix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasSuffix(FieldVersioningStatus, v))
+}
+
+// VersioningStatusIsNil applies the IsNil predicate on the "versioning_status" field.
+func VersioningStatusIsNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIsNull(FieldVersioningStatus))
+}
+
+// VersioningStatusNotNil applies the NotNil predicate on the "versioning_status" field.
+func VersioningStatusNotNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotNull(FieldVersioningStatus))
+}
+
+// VersioningStatusEqualFold applies the EqualFold predicate on the "versioning_status" field.
+func VersioningStatusEqualFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEqualFold(FieldVersioningStatus, v))
+}
+
+// VersioningStatusContainsFold applies the ContainsFold predicate on the "versioning_status" field.
+func VersioningStatusContainsFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContainsFold(FieldVersioningStatus, v))
+}
+
+// MfaDeleteEQ applies the EQ predicate on the "mfa_delete" field.
+func MfaDeleteEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldMfaDelete, v))
+}
+
+// MfaDeleteNEQ applies the NEQ predicate on the "mfa_delete" field.
+func MfaDeleteNEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldMfaDelete, v))
+}
+
+// MfaDeleteIn applies the In predicate on the "mfa_delete" field.
+func MfaDeleteIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIn(FieldMfaDelete, vs...))
+}
+
+// MfaDeleteNotIn applies the NotIn predicate on the "mfa_delete" field.
+func MfaDeleteNotIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotIn(FieldMfaDelete, vs...))
+}
+
+// MfaDeleteGT applies the GT predicate on the "mfa_delete" field.
+func MfaDeleteGT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGT(FieldMfaDelete, v))
+}
+
+// MfaDeleteGTE applies the GTE predicate on the "mfa_delete" field.
+func MfaDeleteGTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGTE(FieldMfaDelete, v))
+}
+
+// MfaDeleteLT applies the LT predicate on the "mfa_delete" field.
+func MfaDeleteLT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLT(FieldMfaDelete, v))
+}
+
+// MfaDeleteLTE applies the LTE predicate on the "mfa_delete" field.
+func MfaDeleteLTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLTE(FieldMfaDelete, v))
+}
+
+// MfaDeleteContains applies the Contains predicate on the "mfa_delete" field.
+func MfaDeleteContains(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContains(FieldMfaDelete, v))
+}
+
+// MfaDeleteHasPrefix applies the HasPrefix predicate on the "mfa_delete" field.
+func MfaDeleteHasPrefix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasPrefix(FieldMfaDelete, v))
+}
+
+// MfaDeleteHasSuffix applies the HasSuffix predicate on the "mfa_delete" field.
+func MfaDeleteHasSuffix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasSuffix(FieldMfaDelete, v))
+}
+
+// MfaDeleteIsNil applies the IsNil predicate on the "mfa_delete" field.
+func MfaDeleteIsNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIsNull(FieldMfaDelete))
+}
+
+// MfaDeleteNotNil applies the NotNil predicate on the "mfa_delete" field.
+func MfaDeleteNotNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotNull(FieldMfaDelete))
+}
+
+// MfaDeleteEqualFold applies the EqualFold predicate on the "mfa_delete" field.
+func MfaDeleteEqualFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEqualFold(FieldMfaDelete, v))
+}
+
+// MfaDeleteContainsFold applies the ContainsFold predicate on the "mfa_delete" field.
+func MfaDeleteContainsFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContainsFold(FieldMfaDelete, v))
+}
+
+// LoggingTargetBucketEQ applies the EQ predicate on the "logging_target_bucket" field.
+func LoggingTargetBucketEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldLoggingTargetBucket, v))
+}
+
+// LoggingTargetBucketNEQ applies the NEQ predicate on the "logging_target_bucket" field.
+func LoggingTargetBucketNEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldLoggingTargetBucket, v))
+}
+
+// LoggingTargetBucketIn applies the In predicate on the "logging_target_bucket" field.
+func LoggingTargetBucketIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIn(FieldLoggingTargetBucket, vs...))
+}
+
+// LoggingTargetBucketNotIn applies the NotIn predicate on the "logging_target_bucket" field.
+func LoggingTargetBucketNotIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotIn(FieldLoggingTargetBucket, vs...))
+}
+
+// LoggingTargetBucketGT applies the GT predicate on the "logging_target_bucket" field.
+func LoggingTargetBucketGT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGT(FieldLoggingTargetBucket, v))
+}
+
+// LoggingTargetBucketGTE applies the GTE predicate on the "logging_target_bucket" field.
+func LoggingTargetBucketGTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGTE(FieldLoggingTargetBucket, v))
+}
+
+// LoggingTargetBucketLT applies the LT predicate on the "logging_target_bucket" field.
+func LoggingTargetBucketLT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLT(FieldLoggingTargetBucket, v))
+}
+
+// LoggingTargetBucketLTE applies the LTE predicate on the "logging_target_bucket" field.
+func LoggingTargetBucketLTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLTE(FieldLoggingTargetBucket, v))
+}
+
+// LoggingTargetBucketContains applies the Contains predicate on the "logging_target_bucket" field.
+func LoggingTargetBucketContains(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContains(FieldLoggingTargetBucket, v))
+}
+
+// LoggingTargetBucketHasPrefix applies the HasPrefix predicate on the "logging_target_bucket" field.
+func LoggingTargetBucketHasPrefix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasPrefix(FieldLoggingTargetBucket, v))
+}
+
+// LoggingTargetBucketHasSuffix applies the HasSuffix predicate on the "logging_target_bucket" field.
+func LoggingTargetBucketHasSuffix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasSuffix(FieldLoggingTargetBucket, v))
+}
+
+// LoggingTargetBucketIsNil applies the IsNil predicate on the "logging_target_bucket" field.
+func LoggingTargetBucketIsNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIsNull(FieldLoggingTargetBucket))
+}
+
+// LoggingTargetBucketNotNil applies the NotNil predicate on the "logging_target_bucket" field.
+func LoggingTargetBucketNotNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotNull(FieldLoggingTargetBucket))
+}
+
+// LoggingTargetBucketEqualFold applies the EqualFold predicate on the "logging_target_bucket" field.
+func LoggingTargetBucketEqualFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEqualFold(FieldLoggingTargetBucket, v))
+}
+
+// LoggingTargetBucketContainsFold applies the ContainsFold predicate on the "logging_target_bucket" field.
+func LoggingTargetBucketContainsFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContainsFold(FieldLoggingTargetBucket, v))
+}
+
+// LoggingTargetPrefixEQ applies the EQ predicate on the "logging_target_prefix" field.
+func LoggingTargetPrefixEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldLoggingTargetPrefix, v))
+}
+
+// LoggingTargetPrefixNEQ applies the NEQ predicate on the "logging_target_prefix" field.
+func LoggingTargetPrefixNEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldLoggingTargetPrefix, v))
+}
+
+// LoggingTargetPrefixIn applies the In predicate on the "logging_target_prefix" field.
+func LoggingTargetPrefixIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIn(FieldLoggingTargetPrefix, vs...))
+}
+
+// LoggingTargetPrefixNotIn applies the NotIn predicate on the "logging_target_prefix" field.
+func LoggingTargetPrefixNotIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotIn(FieldLoggingTargetPrefix, vs...))
+}
+
+// LoggingTargetPrefixGT applies the GT predicate on the "logging_target_prefix" field.
+func LoggingTargetPrefixGT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGT(FieldLoggingTargetPrefix, v))
+}
+
+// LoggingTargetPrefixGTE applies the GTE predicate on the "logging_target_prefix" field.
+func LoggingTargetPrefixGTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGTE(FieldLoggingTargetPrefix, v))
+}
+
+// LoggingTargetPrefixLT applies the LT predicate on the "logging_target_prefix" field.
+func LoggingTargetPrefixLT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLT(FieldLoggingTargetPrefix, v))
+}
+
+// LoggingTargetPrefixLTE applies the LTE predicate on the "logging_target_prefix" field.
+func LoggingTargetPrefixLTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLTE(FieldLoggingTargetPrefix, v))
+}
+
+// LoggingTargetPrefixContains applies the Contains predicate on the "logging_target_prefix" field.
+func LoggingTargetPrefixContains(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContains(FieldLoggingTargetPrefix, v))
+}
+
+// LoggingTargetPrefixHasPrefix applies the HasPrefix predicate on the "logging_target_prefix" field.
+func LoggingTargetPrefixHasPrefix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasPrefix(FieldLoggingTargetPrefix, v))
+}
+
+// LoggingTargetPrefixHasSuffix applies the HasSuffix predicate on the "logging_target_prefix" field.
+func LoggingTargetPrefixHasSuffix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasSuffix(FieldLoggingTargetPrefix, v))
+}
+
+// LoggingTargetPrefixIsNil applies the IsNil predicate on the "logging_target_prefix" field.
+func LoggingTargetPrefixIsNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIsNull(FieldLoggingTargetPrefix))
+}
+
+// LoggingTargetPrefixNotNil applies the NotNil predicate on the "logging_target_prefix" field.
+func LoggingTargetPrefixNotNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotNull(FieldLoggingTargetPrefix))
+}
+
+// LoggingTargetPrefixEqualFold applies the EqualFold predicate on the "logging_target_prefix" field.
+func LoggingTargetPrefixEqualFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEqualFold(FieldLoggingTargetPrefix, v))
+}
+
+// LoggingTargetPrefixContainsFold applies the ContainsFold predicate on the "logging_target_prefix" field.
+func LoggingTargetPrefixContainsFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContainsFold(FieldLoggingTargetPrefix, v))
+}
+
+// PolicyJSONIsNil applies the IsNil predicate on the "policy_json" field.
+func PolicyJSONIsNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIsNull(FieldPolicyJSON))
+}
+
+// PolicyJSONNotNil applies the NotNil predicate on the "policy_json" field.
+func PolicyJSONNotNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotNull(FieldPolicyJSON))
+}
+
+// ACLGrantsJSONIsNil applies the IsNil predicate on the "acl_grants_json" field.
+func ACLGrantsJSONIsNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIsNull(FieldACLGrantsJSON))
+}
+
+// ACLGrantsJSONNotNil applies the NotNil predicate on the "acl_grants_json" field.
+func ACLGrantsJSONNotNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotNull(FieldACLGrantsJSON))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeAWSS3Bucket) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeAWSS3Bucket) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeAWSS3Bucket) predicate.BronzeAWSS3Bucket {
+	return predicate.BronzeAWSS3Bucket(sql.NotPredicates(p))
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s3
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/bronzeawss3bucket"
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSS3BucketDelete is the builder for deleting a BronzeAWSS3Bucket entity.
+type BronzeAWSS3BucketDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSS3BucketMutation
+}
+
+// Where appends a list predicates to the BronzeAWSS3BucketDelete builder.
+func (_d *BronzeAWSS3BucketDelete) Where(ps ...predicate.BronzeAWSS3Bucket) *BronzeAWSS3BucketDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeAWSS3BucketDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeAWSS3BucketDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeAWSS3BucketDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzeawss3bucket.Table, sqlgraph.NewFieldSpec(bronzeawss3bucket.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.BronzeAWSS3Bucket
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeAWSS3BucketDeleteOne is the builder for deleting a single BronzeAWSS3Bucket entity.
+type BronzeAWSS3BucketDeleteOne struct {
+	_d *BronzeAWSS3BucketDelete
+}
+
+// Where appends a list predicates to the BronzeAWSS3BucketDelete builder.
+func (_d *BronzeAWSS3BucketDeleteOne) Where(ps ...predicate.BronzeAWSS3Bucket) *BronzeAWSS3BucketDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeAWSS3BucketDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzeawss3bucket.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeAWSS3BucketDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/bronzeawss3bucket"
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeAWSS3BucketUpdate is the builder for updating BronzeAWSS3Bucket entities.
+type BronzeAWSS3BucketUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeAWSS3BucketMutation
+}
+
+// Where appends a list predicates to the BronzeAWSS3BucketUpdate builder.
+func (_u *BronzeAWSS3BucketUpdate) Where(ps ...predicate.BronzeAWSS3Bucket) *BronzeAWSS3BucketUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSS3BucketUpdate) SetCollectedAt(v time.Time) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdate) SetNillableCollectedAt(v *time.Time) *BronzeAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeAWSS3BucketUpdate) SetArn(v string) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdate) SetNillableArn(v *string) *BronzeAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// ClearArn clears the value of the "arn" field.
+func (_u *BronzeAWSS3BucketUpdate) ClearArn() *BronzeAWSS3BucketUpdate {
+	_u.mutation.ClearArn()
+	return _u
+}
+
+// SetCreationDate sets the "creation_date" field.
+func (_u *BronzeAWSS3BucketUpdate) SetCreationDate(v time.Time) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetCreationDate(v)
+	return _u
+}
+
+// SetNillableCreationDate sets the "creation_date" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdate) SetNillableCreationDate(v *time.Time) *BronzeAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetCreationDate(*v)
+	}
+	return _u
+}
+
+// ClearCreationDate clears the value of the "creation_date" field.
+func (_u *BronzeAWSS3BucketUpdate) ClearCreationDate() *BronzeAWSS3BucketUpdate {
+	_u.mutation.ClearCreationDate()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeAWSS3BucketUpdate) SetOwnerID(v string) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdate) SetNillableOwnerID(v *string) *BronzeAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeAWSS3BucketUpdate) ClearOwnerID() *BronzeAWSS3BucketUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetBlockPublicAcls sets the "block_public_acls" field.
+func (_u *BronzeAWSS3BucketUpdate) SetBlockPublicAcls(v bool) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetBlockPublicAcls(v)
+	return _u
+}
+
+// SetNillableBlockPublicAcls sets the "block_public_acls" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdate) SetNillableBlockPublicAcls(v *bool) *BronzeAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetBlockPublicAcls(*v)
+	}
+	return _u
+}
+
+// ClearBlockPublicAcls clears the value of the "block_public_acls" field.
+func (_u *BronzeAWSS3BucketUpdate) ClearBlockPublicAcls() *BronzeAWSS3BucketUpdate {
+	_u.mutation.ClearBlockPublicAcls()
+	return _u
+}
+
+// SetIgnorePublicAcls sets the "ignore_public_acls" field.
+func (_u *BronzeAWSS3BucketUpdate) SetIgnorePublicAcls(v bool) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetIgnorePublicAcls(v)
+	return _u
+}
+
+// SetNillableIgnorePublicAcls sets the "ignore_public_acls" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdate) SetNillableIgnorePublicAcls(v *bool) *BronzeAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetIgnorePublicAcls(*v)
+	}
+	return _u
+}
+
+// ClearIgnorePublicAcls clears the value of the "ignore_public_acls" field.
+func (_u *BronzeAWSS3BucketUpdate) ClearIgnorePublicAcls() *BronzeAWSS3BucketUpdate {
+	_u.mutation.ClearIgnorePublicAcls()
+	return _u
+}
+
+// SetBlockPublicPolicy sets the "block_public_policy" field.
+func (_u *BronzeAWSS3BucketUpdate) SetBlockPublicPolicy(v bool) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetBlockPublicPolicy(v)
+	return _u
+}
+
+// SetNillableBlockPublicPolicy sets the "block_public_policy" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdate) SetNillableBlockPublicPolicy(v *bool) *BronzeAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetBlockPublicPolicy(*v)
+	}
+	return _u
+}
+
+// ClearBlockPublicPolicy clears the value of the "block_public_policy" field.
+func (_u *BronzeAWSS3BucketUpdate) ClearBlockPublicPolicy() *BronzeAWSS3BucketUpdate {
+	_u.mutation.ClearBlockPublicPolicy()
+	return _u
+}
+
+// SetRestrictPublicBuckets sets the "restrict_public_buckets" field.
+func (_u *BronzeAWSS3BucketUpdate) SetRestrictPublicBuckets(v bool) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetRestrictPublicBuckets(v)
+	return _u
+}
+
+// SetNillableRestrictPublicBuckets sets the "restrict_public_buckets" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdate) SetNillableRestrictPublicBuckets(v *bool) *BronzeAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetRestrictPublicBuckets(*v)
+	}
+	return _u
+}
+
+// ClearRestrictPublicBuckets clears the value of the "restrict_public_buckets" field.
+func (_u *BronzeAWSS3BucketUpdate) ClearRestrictPublicBuckets() *BronzeAWSS3BucketUpdate {
+	_u.mutation.ClearRestrictPublicBuckets()
+	return _u
+}
+
+// SetPolicyIsPublic sets the "policy_is_public" field.
+func (_u *BronzeAWSS3BucketUpdate) SetPolicyIsPublic(v bool) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetPolicyIsPublic(v)
+	return _u
+}
+
+// SetNillablePolicyIsPublic sets the "policy_is_public" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdate) SetNillablePolicyIsPublic(v *bool) *BronzeAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetPolicyIsPublic(*v)
+	}
+	return _u
+}
+
+// ClearPolicyIsPublic clears the value of the "policy_is_public" field.
+func (_u *BronzeAWSS3BucketUpdate) ClearPolicyIsPublic() *BronzeAWSS3BucketUpdate {
+	_u.mutation.ClearPolicyIsPublic()
+	return _u
+}
+
+// SetSseAlgorithm sets the "sse_algorithm" field.
+func (_u *BronzeAWSS3BucketUpdate) SetSseAlgorithm(v string) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetSseAlgorithm(v)
+	return _u
+}
+
+// SetNillableSseAlgorithm sets the "sse_algorithm" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdate) SetNillableSseAlgorithm(v *string) *BronzeAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetSseAlgorithm(*v)
+	}
+	return _u
+}
+
+// ClearSseAlgorithm clears the value of the "sse_algorithm" field.
+func (_u *BronzeAWSS3BucketUpdate) ClearSseAlgorithm() *BronzeAWSS3BucketUpdate {
+	_u.mutation.ClearSseAlgorithm()
+	return _u
+}
+
+// SetKmsMasterKeyID sets the "kms_master_key_id" field.
+func (_u *BronzeAWSS3BucketUpdate) SetKmsMasterKeyID(v string) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetKmsMasterKeyID(v)
+	return _u
+}
+
+// SetNillableKmsMasterKeyID sets the "kms_master_key_id" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdate) SetNillableKmsMasterKeyID(v *string) *BronzeAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetKmsMasterKeyID(*v)
+	}
+	return _u
+}
+
+// ClearKmsMasterKeyID clears the value of the "kms_master_key_id" field.
+func (_u *BronzeAWSS3BucketUpdate) ClearKmsMasterKeyID() *BronzeAWSS3BucketUpdate {
+	_u.mutation.ClearKmsMasterKeyID()
+	return _u
+}
+
+// SetBucketKeyEnabled sets the "bucket_key_enabled" field.
+func (_u *BronzeAWSS3BucketUpdate) SetBucketKeyEnabled(v bool) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetBucketKeyEnabled(v)
+	return _u
+}
+
+// SetNillableBucketKeyEnabled sets the "bucket_key_enabled" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdate) SetNillableBucketKeyEnabled(v *bool) *BronzeAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetBucketKeyEnabled(*v)
+	}
+	return _u
+}
+
+// ClearBucketKeyEnabled clears the value of the "bucket_key_enabled" field.
+func (_u *BronzeAWSS3BucketUpdate) ClearBucketKeyEnabled() *BronzeAWSS3BucketUpdate {
+	_u.mutation.ClearBucketKeyEnabled()
+	return _u
+}
+
+// SetVersioningStatus sets the "versioning_status" field.
+func (_u *BronzeAWSS3BucketUpdate) SetVersioningStatus(v string) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetVersioningStatus(v)
+	return _u
+}
+
+// SetNillableVersioningStatus sets the "versioning_status" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdate) SetNillableVersioningStatus(v *string) *BronzeAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetVersioningStatus(*v)
+	}
+	return _u
+}
+
+// ClearVersioningStatus clears the value of the "versioning_status" field.
+func (_u *BronzeAWSS3BucketUpdate) ClearVersioningStatus() *BronzeAWSS3BucketUpdate {
+	_u.mutation.ClearVersioningStatus()
+	return _u
+}
+
+// SetMfaDelete sets the "mfa_delete" field.
+func (_u *BronzeAWSS3BucketUpdate) SetMfaDelete(v string) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetMfaDelete(v)
+	return _u
+}
+
+// SetNillableMfaDelete sets the "mfa_delete" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdate) SetNillableMfaDelete(v *string) *BronzeAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetMfaDelete(*v)
+	}
+	return _u
+}
+
+// ClearMfaDelete clears the value of the "mfa_delete" field.
+func (_u *BronzeAWSS3BucketUpdate) ClearMfaDelete() *BronzeAWSS3BucketUpdate {
+	_u.mutation.ClearMfaDelete()
+	return _u
+}
+
+// SetLoggingTargetBucket sets the "logging_target_bucket" field.
+func (_u *BronzeAWSS3BucketUpdate) SetLoggingTargetBucket(v string) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetLoggingTargetBucket(v)
+	return _u
+}
+
+// SetNillableLoggingTargetBucket sets the "logging_target_bucket" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdate) SetNillableLoggingTargetBucket(v *string) *BronzeAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetLoggingTargetBucket(*v)
+	}
+	return _u
+}
+
+// ClearLoggingTargetBucket clears the value of the "logging_target_bucket" field.
+func (_u *BronzeAWSS3BucketUpdate) ClearLoggingTargetBucket() *BronzeAWSS3BucketUpdate {
+	_u.mutation.ClearLoggingTargetBucket()
+	return _u
+}
+
+// SetLoggingTargetPrefix sets the "logging_target_prefix" field.
+func (_u *BronzeAWSS3BucketUpdate) SetLoggingTargetPrefix(v string) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetLoggingTargetPrefix(v)
+	return _u
+}
+
+// SetNillableLoggingTargetPrefix sets the "logging_target_prefix" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdate) SetNillableLoggingTargetPrefix(v *string) *BronzeAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetLoggingTargetPrefix(*v)
+	}
+	return _u
+}
+
+// ClearLoggingTargetPrefix clears the value of the "logging_target_prefix" field.
+func (_u *BronzeAWSS3BucketUpdate) ClearLoggingTargetPrefix() *BronzeAWSS3BucketUpdate {
+	_u.mutation.ClearLoggingTargetPrefix()
+	return _u
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (_u *BronzeAWSS3BucketUpdate) SetPolicyJSON(v json.RawMessage) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetPolicyJSON(v)
+	return _u
+}
+
+// AppendPolicyJSON appends value to the "policy_json" field.
+func (_u *BronzeAWSS3BucketUpdate) AppendPolicyJSON(v json.RawMessage) *BronzeAWSS3BucketUpdate {
+	_u.mutation.AppendPolicyJSON(v)
+	return _u
+}
+
+// ClearPolicyJSON clears the value of the "policy_json" field.
+func (_u *BronzeAWSS3BucketUpdate) ClearPolicyJSON() *BronzeAWSS3BucketUpdate {
+	_u.mutation.ClearPolicyJSON()
+	return _u
+}
+
+// SetACLGrantsJSON sets the "acl_grants_json" field.
+func (_u *BronzeAWSS3BucketUpdate) SetACLGrantsJSON(v json.RawMessage) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetACLGrantsJSON(v)
+	return _u
+}
+
+// AppendACLGrantsJSON appends value to the "acl_grants_json" field.
+func (_u *BronzeAWSS3BucketUpdate) AppendACLGrantsJSON(v json.RawMessage) *BronzeAWSS3BucketUpdate {
+	_u.mutation.AppendACLGrantsJSON(v)
+	return _u
+}
+
+// ClearACLGrantsJSON clears the value of the "acl_grants_json" field.
+func (_u *BronzeAWSS3BucketUpdate) ClearACLGrantsJSON() *BronzeAWSS3BucketUpdate {
+	_u.mutation.ClearACLGrantsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSS3BucketUpdate) SetTagsJSON(v json.RawMessage) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSS3BucketUpdate) AppendTagsJSON(v json.RawMessage) *BronzeAWSS3BucketUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSS3BucketUpdate) ClearTagsJSON() *BronzeAWSS3BucketUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSS3BucketUpdate) SetAccountID(v string) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdate) SetNillableAccountID(v *string) *BronzeAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSS3BucketUpdate) SetRegion(v string) *BronzeAWSS3BucketUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdate) SetNillableRegion(v *string) *BronzeAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSS3BucketMutation object of the builder.
+func (_u *BronzeAWSS3BucketUpdate) Mutation() *BronzeAWSS3BucketMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeAWSS3BucketUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSS3BucketUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeAWSS3BucketUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSS3BucketUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSS3BucketUpdate) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawss3bucket.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`s3: validator failed for field "BronzeAWSS3Bucket.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawss3bucket.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`s3: validator failed for field "BronzeAWSS3Bucket.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSS3BucketUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawss3bucket.Table, bronzeawss3bucket.Columns, sqlgraph.NewFieldSpec(bronzeawss3bucket.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldArn, field.TypeString, value)
+	}
+	if _u.mutation.ArnCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreationDate(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldCreationDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreationDateCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldCreationDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.BlockPublicAcls(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldBlockPublicAcls, field.TypeBool, value)
+	}
+	if _u.mutation.BlockPublicAclsCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldBlockPublicAcls, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IgnorePublicAcls(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldIgnorePublicAcls, field.TypeBool, value)
+	}
+	if _u.mutation.IgnorePublicAclsCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldIgnorePublicAcls, field.TypeBool)
+	}
+	if value, ok := _u.mutation.BlockPublicPolicy(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldBlockPublicPolicy, field.TypeBool, value)
+	}
+	if _u.mutation.BlockPublicPolicyCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldBlockPublicPolicy, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RestrictPublicBuckets(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldRestrictPublicBuckets, field.TypeBool, value)
+	}
+	if _u.mutation.RestrictPublicBucketsCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldRestrictPublicBuckets, field.TypeBool)
+	}
+	if value, ok := _u.mutation.PolicyIsPublic(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldPolicyIsPublic, field.TypeBool, value)
+	}
+	if _u.mutation.PolicyIsPublicCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldPolicyIsPublic, field.TypeBool)
+	}
+	if value, ok := _u.mutation.SseAlgorithm(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldSseAlgorithm, field.TypeString, value)
+	}
+	if _u.mutation.SseAlgorithmCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldSseAlgorithm, field.TypeString)
+	}
+	if value, ok := _u.mutation.KmsMasterKeyID(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldKmsMasterKeyID, field.TypeString, value)
+	}
+	if _u.mutation.KmsMasterKeyIDCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldKmsMasterKeyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.BucketKeyEnabled(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldBucketKeyEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.BucketKeyEnabledCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldBucketKeyEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.VersioningStatus(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldVersioningStatus, field.TypeString, value)
+	}
+	if _u.mutation.VersioningStatusCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldVersioningStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.MfaDelete(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldMfaDelete, field.TypeString, value)
+	}
+	if _u.mutation.MfaDeleteCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldMfaDelete, field.TypeString)
+	}
+	if value, ok := _u.mutation.LoggingTargetBucket(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldLoggingTargetBucket, field.TypeString, value)
+	}
+	if _u.mutation.LoggingTargetBucketCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldLoggingTargetBucket, field.TypeString)
+	}
+	if value, ok := _u.mutation.LoggingTargetPrefix(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldLoggingTargetPrefix, field.TypeString, value)
+	}
+	if _u.mutation.LoggingTargetPrefixCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldLoggingTargetPrefix, field.TypeString)
+	}
+	if value, ok := _u.mutation.PolicyJSON(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldPolicyJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolicyJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawss3bucket.FieldPolicyJSON, value)
+		})
+	}
+	if _u.mutation.PolicyJSONCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldPolicyJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ACLGrantsJSON(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldACLGrantsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedACLGrantsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawss3bucket.FieldACLGrantsJSON, value)
+		})
+	}
+	if _u.mutation.ACLGrantsJSONCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldACLGrantsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawss3bucket.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSS3Bucket
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawss3bucket.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeAWSS3BucketUpdateOne is the builder for updating a single BronzeAWSS3Bucket entity.
+type BronzeAWSS3BucketUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeAWSS3BucketMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetCollectedAt(v time.Time) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetArn(v string) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdateOne) SetNillableArn(v *string) *BronzeAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// ClearArn clears the value of the "arn" field.
+func (_u *BronzeAWSS3BucketUpdateOne) ClearArn() *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.ClearArn()
+	return _u
+}
+
+// SetCreationDate sets the "creation_date" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetCreationDate(v time.Time) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetCreationDate(v)
+	return _u
+}
+
+// SetNillableCreationDate sets the "creation_date" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdateOne) SetNillableCreationDate(v *time.Time) *BronzeAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetCreationDate(*v)
+	}
+	return _u
+}
+
+// ClearCreationDate clears the value of the "creation_date" field.
+func (_u *BronzeAWSS3BucketUpdateOne) ClearCreationDate() *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.ClearCreationDate()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetOwnerID(v string) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdateOne) SetNillableOwnerID(v *string) *BronzeAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeAWSS3BucketUpdateOne) ClearOwnerID() *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetBlockPublicAcls sets the "block_public_acls" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetBlockPublicAcls(v bool) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetBlockPublicAcls(v)
+	return _u
+}
+
+// SetNillableBlockPublicAcls sets the "block_public_acls" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdateOne) SetNillableBlockPublicAcls(v *bool) *BronzeAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetBlockPublicAcls(*v)
+	}
+	return _u
+}
+
+// ClearBlockPublicAcls clears the value of the "block_public_acls" field.
+func (_u *BronzeAWSS3BucketUpdateOne) ClearBlockPublicAcls() *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.ClearBlockPublicAcls()
+	return _u
+}
+
+// SetIgnorePublicAcls sets the "ignore_public_acls" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetIgnorePublicAcls(v bool) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetIgnorePublicAcls(v)
+	return _u
+}
+
+// SetNillableIgnorePublicAcls sets the "ignore_public_acls" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdateOne) SetNillableIgnorePublicAcls(v *bool) *BronzeAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetIgnorePublicAcls(*v)
+	}
+	return _u
+}
+
+// ClearIgnorePublicAcls clears the value of the "ignore_public_acls" field.
+func (_u *BronzeAWSS3BucketUpdateOne) ClearIgnorePublicAcls() *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.ClearIgnorePublicAcls()
+	return _u
+}
+
+// SetBlockPublicPolicy sets the "block_public_policy" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetBlockPublicPolicy(v bool) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetBlockPublicPolicy(v)
+	return _u
+}
+
+// SetNillableBlockPublicPolicy sets the "block_public_policy" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdateOne) SetNillableBlockPublicPolicy(v *bool) *BronzeAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetBlockPublicPolicy(*v)
+	}
+	return _u
+}
+
+// ClearBlockPublicPolicy clears the value of the "block_public_policy" field.
+func (_u *BronzeAWSS3BucketUpdateOne) ClearBlockPublicPolicy() *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.ClearBlockPublicPolicy()
+	return _u
+}
+
+// SetRestrictPublicBuckets sets the "restrict_public_buckets" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetRestrictPublicBuckets(v bool) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetRestrictPublicBuckets(v)
+	return _u
+}
+
+// SetNillableRestrictPublicBuckets sets the "restrict_public_buckets" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdateOne) SetNillableRestrictPublicBuckets(v *bool) *BronzeAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetRestrictPublicBuckets(*v)
+	}
+	return _u
+}
+
+// ClearRestrictPublicBuckets clears the value of the "restrict_public_buckets" field.
+func (_u *BronzeAWSS3BucketUpdateOne) ClearRestrictPublicBuckets() *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.ClearRestrictPublicBuckets()
+	return _u
+}
+
+// SetPolicyIsPublic sets the "policy_is_public" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetPolicyIsPublic(v bool) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetPolicyIsPublic(v)
+	return _u
+}
+
+// SetNillablePolicyIsPublic sets the "policy_is_public" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdateOne) SetNillablePolicyIsPublic(v *bool) *BronzeAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetPolicyIsPublic(*v)
+	}
+	return _u
+}
+
+// ClearPolicyIsPublic clears the value of the "policy_is_public" field.
+func (_u *BronzeAWSS3BucketUpdateOne) ClearPolicyIsPublic() *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.ClearPolicyIsPublic()
+	return _u
+}
+
+// SetSseAlgorithm sets the "sse_algorithm" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetSseAlgorithm(v string) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetSseAlgorithm(v)
+	return _u
+}
+
+// SetNillableSseAlgorithm sets the "sse_algorithm" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdateOne) SetNillableSseAlgorithm(v *string) *BronzeAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetSseAlgorithm(*v)
+	}
+	return _u
+}
+
+// ClearSseAlgorithm clears the value of the "sse_algorithm" field.
+func (_u *BronzeAWSS3BucketUpdateOne) ClearSseAlgorithm() *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.ClearSseAlgorithm()
+	return _u
+}
+
+// SetKmsMasterKeyID sets the "kms_master_key_id" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetKmsMasterKeyID(v string) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetKmsMasterKeyID(v)
+	return _u
+}
+
+// SetNillableKmsMasterKeyID sets the "kms_master_key_id" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdateOne) SetNillableKmsMasterKeyID(v *string) *BronzeAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetKmsMasterKeyID(*v)
+	}
+	return _u
+}
+
+// ClearKmsMasterKeyID clears the value of the "kms_master_key_id" field.
+func (_u *BronzeAWSS3BucketUpdateOne) ClearKmsMasterKeyID() *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.ClearKmsMasterKeyID()
+	return _u
+}
+
+// SetBucketKeyEnabled sets the "bucket_key_enabled" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetBucketKeyEnabled(v bool) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetBucketKeyEnabled(v)
+	return _u
+}
+
+// SetNillableBucketKeyEnabled sets the "bucket_key_enabled" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdateOne) SetNillableBucketKeyEnabled(v *bool) *BronzeAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetBucketKeyEnabled(*v)
+	}
+	return _u
+}
+
+// ClearBucketKeyEnabled clears the value of the "bucket_key_enabled" field.
+func (_u *BronzeAWSS3BucketUpdateOne) ClearBucketKeyEnabled() *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.ClearBucketKeyEnabled()
+	return _u
+}
+
+// SetVersioningStatus sets the "versioning_status" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetVersioningStatus(v string) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetVersioningStatus(v)
+	return _u
+}
+
+// SetNillableVersioningStatus sets the "versioning_status" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdateOne) SetNillableVersioningStatus(v *string) *BronzeAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetVersioningStatus(*v)
+	}
+	return _u
+}
+
+// ClearVersioningStatus clears the value of the "versioning_status" field.
+func (_u *BronzeAWSS3BucketUpdateOne) ClearVersioningStatus() *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.ClearVersioningStatus()
+	return _u
+}
+
+// SetMfaDelete sets the "mfa_delete" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetMfaDelete(v string) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetMfaDelete(v)
+	return _u
+}
+
+// SetNillableMfaDelete sets the "mfa_delete" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdateOne) SetNillableMfaDelete(v *string) *BronzeAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetMfaDelete(*v)
+	}
+	return _u
+}
+
+// ClearMfaDelete clears the value of the "mfa_delete" field.
+func (_u *BronzeAWSS3BucketUpdateOne) ClearMfaDelete() *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.ClearMfaDelete()
+	return _u
+}
+
+// SetLoggingTargetBucket sets the "logging_target_bucket" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetLoggingTargetBucket(v string) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetLoggingTargetBucket(v)
+	return _u
+}
+
+// SetNillableLoggingTargetBucket sets the "logging_target_bucket" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdateOne) SetNillableLoggingTargetBucket(v *string) *BronzeAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetLoggingTargetBucket(*v)
+	}
+	return _u
+}
+
+// ClearLoggingTargetBucket clears the value of the "logging_target_bucket" field.
+func (_u *BronzeAWSS3BucketUpdateOne) ClearLoggingTargetBucket() *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.ClearLoggingTargetBucket()
+	return _u
+}
+
+// SetLoggingTargetPrefix sets the "logging_target_prefix" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetLoggingTargetPrefix(v string) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetLoggingTargetPrefix(v)
+	return _u
+}
+
+// SetNillableLoggingTargetPrefix sets the "logging_target_prefix" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdateOne) SetNillableLoggingTargetPrefix(v *string) *BronzeAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetLoggingTargetPrefix(*v)
+	}
+	return _u
+}
+
+// ClearLoggingTargetPrefix clears the value of the "logging_target_prefix" field.
+func (_u *BronzeAWSS3BucketUpdateOne) ClearLoggingTargetPrefix() *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.ClearLoggingTargetPrefix()
+	return _u
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetPolicyJSON(v json.RawMessage) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetPolicyJSON(v)
+	return _u
+}
+
+// AppendPolicyJSON appends value to the "policy_json" field.
+func (_u *BronzeAWSS3BucketUpdateOne) AppendPolicyJSON(v json.RawMessage) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.AppendPolicyJSON(v)
+	return _u
+}
+
+// ClearPolicyJSON clears the value of the "policy_json" field.
+func (_u *BronzeAWSS3BucketUpdateOne) ClearPolicyJSON() *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.ClearPolicyJSON()
+	return _u
+}
+
+// SetACLGrantsJSON sets the "acl_grants_json" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetACLGrantsJSON(v json.RawMessage) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetACLGrantsJSON(v)
+	return _u
+}
+
+// AppendACLGrantsJSON appends value to the "acl_grants_json" field.
+func (_u *BronzeAWSS3BucketUpdateOne) AppendACLGrantsJSON(v json.RawMessage) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.AppendACLGrantsJSON(v)
+	return _u
+}
+
+// ClearACLGrantsJSON clears the value of the "acl_grants_json" field.
+func (_u *BronzeAWSS3BucketUpdateOne) ClearACLGrantsJSON() *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.ClearACLGrantsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeAWSS3BucketUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeAWSS3BucketUpdateOne) ClearTagsJSON() *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetAccountID(v string) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdateOne) SetNillableAccountID(v *string) *BronzeAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeAWSS3BucketUpdateOne) SetRegion(v string) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeAWSS3BucketUpdateOne) SetNillableRegion(v *string) *BronzeAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeAWSS3BucketMutation object of the builder.
+func (_u *BronzeAWSS3BucketUpdateOne) Mutation() *BronzeAWSS3BucketMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeAWSS3BucketUpdate builder.
+func (_u *BronzeAWSS3BucketUpdateOne) Where(ps ...predicate.BronzeAWSS3Bucket) *BronzeAWSS3BucketUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeAWSS3BucketUpdateOne) Select(field string, fields ...string) *BronzeAWSS3BucketUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeAWSS3Bucket entity.
+func (_u *BronzeAWSS3BucketUpdateOne) Save(ctx context.Context) (*BronzeAWSS3Bucket, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeAWSS3BucketUpdateOne) SaveX(ctx context.Context) *BronzeAWSS3Bucket {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeAWSS3BucketUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeAWSS3BucketUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeAWSS3BucketUpdateOne) check() error {
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzeawss3bucket.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`s3: validator failed for field "BronzeAWSS3Bucket.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzeawss3bucket.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`s3: validator failed for field "BronzeAWSS3Bucket.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeAWSS3BucketUpdateOne) sqlSave(ctx context.Context) (_node *BronzeAWSS3Bucket, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzeawss3bucket.Table, bronzeawss3bucket.Columns, sqlgraph.NewFieldSpec(bronzeawss3bucket.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`s3: missing "BronzeAWSS3Bucket.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzeawss3bucket.FieldID)
+		for _, f := range fields {
+			if !bronzeawss3bucket.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("s3: invalid field %q for query", f)}
+			}
+			if f != bronzeawss3bucket.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldArn, field.TypeString, value)
+	}
+	if _u.mutation.ArnCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreationDate(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldCreationDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreationDateCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldCreationDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.BlockPublicAcls(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldBlockPublicAcls, field.TypeBool, value)
+	}
+	if _u.mutation.BlockPublicAclsCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldBlockPublicAcls, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IgnorePublicAcls(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldIgnorePublicAcls, field.TypeBool, value)
+	}
+	if _u.mutation.IgnorePublicAclsCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldIgnorePublicAcls, field.TypeBool)
+	}
+	if value, ok := _u.mutation.BlockPublicPolicy(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldBlockPublicPolicy, field.TypeBool, value)
+	}
+	if _u.mutation.BlockPublicPolicyCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldBlockPublicPolicy, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RestrictPublicBuckets(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldRestrictPublicBuckets, field.TypeBool, value)
+	}
+	if _u.mutation.RestrictPublicBucketsCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldRestrictPublicBuckets, field.TypeBool)
+	}
+	if value, ok := _u.mutation.PolicyIsPublic(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldPolicyIsPublic, field.TypeBool, value)
+	}
+	if _u.mutation.PolicyIsPublicCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldPolicyIsPublic, field.TypeBool)
+	}
+	if value, ok := _u.mutation.SseAlgorithm(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldSseAlgorithm, field.TypeString, value)
+	}
+	if _u.mutation.SseAlgorithmCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldSseAlgorithm, field.TypeString)
+	}
+	if value, ok := _u.mutation.KmsMasterKeyID(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldKmsMasterKeyID, field.TypeString, value)
+	}
+	if _u.mutation.KmsMasterKeyIDCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldKmsMasterKeyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.BucketKeyEnabled(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldBucketKeyEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.BucketKeyEnabledCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldBucketKeyEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.VersioningStatus(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldVersioningStatus, field.TypeString, value)
+	}
+	if _u.mutation.VersioningStatusCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldVersioningStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.MfaDelete(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldMfaDelete, field.TypeString, value)
+	}
+	if _u.mutation.MfaDeleteCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldMfaDelete, field.TypeString)
+	}
+	if value, ok := _u.mutation.LoggingTargetBucket(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldLoggingTargetBucket, field.TypeString, value)
+	}
+	if _u.mutation.LoggingTargetBucketCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldLoggingTargetBucket, field.TypeString)
+	}
+	if value, ok := _u.mutation.LoggingTargetPrefix(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldLoggingTargetPrefix, field.TypeString, value)
+	}
+	if _u.mutation.LoggingTargetPrefixCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldLoggingTargetPrefix, field.TypeString)
+	}
+	if value, ok := _u.mutation.PolicyJSON(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldPolicyJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolicyJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawss3bucket.FieldPolicyJSON, value)
+		})
+	}
+	if _u.mutation.PolicyJSONCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldPolicyJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ACLGrantsJSON(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldACLGrantsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedACLGrantsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawss3bucket.FieldACLGrantsJSON, value)
+		})
+	}
+	if _u.mutation.ACLGrantsJSONCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldACLGrantsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzeawss3bucket.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzeawss3bucket.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzeawss3bucket.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeAWSS3Bucket
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeAWSS3Bucket{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzeawss3bucket.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,402 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s3
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/bronzehistoryawss3bucket"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryAWSS3Bucket is the model entity for the BronzeHistoryAWSS3Bucket schema.
+type BronzeHistoryAWSS3Bucket struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze S3 bucket by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// Arn holds the value of the "arn" field.
+	Arn string `json:"arn,omitempty"`
+	// CreationDate holds the value of the "creation_date" field.
+	CreationDate *time.Time `json:"creation_date,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID string `json:"owner_id,omitempty"`
+	// BlockPublicAcls holds the value of the "block_public_acls" field.
+	BlockPublicAcls *bool `json:"block_public_acls,omitempty"`
+	// IgnorePublicAcls holds the value of the "ignore_public_acls" field.
+	IgnorePublicAcls *bool `json:"ignore_public_acls,omitempty"`
+	// BlockPublicPolicy holds the value of the "block_public_policy" field.
+	BlockPublicPolicy *bool `json:"block_public_policy,omitempty"`
+	// RestrictPublicBuckets holds the value of the "restrict_public_buckets" field.
+	RestrictPublicBuckets *bool `json:"restrict_public_buckets,omitempty"`
+	// PolicyIsPublic holds the value of the "policy_is_public" field.
+	PolicyIsPublic *bool `json:"policy_is_public,omitempty"`
+	// SseAlgorithm holds the value of the "sse_algorithm" field.
+	SseAlgorithm string `json:"sse_algorithm,omitempty"`
+	// KmsMasterKeyID holds the value of the "kms_master_key_id" field.
+	KmsMasterKeyID string `json:"kms_master_key_id,omitempty"`
+	// BucketKeyEnabled holds the value of the "bucket_key_enabled" field.
+	BucketKeyEnabled bool `json:"bucket_key_enabled,omitempty"`
+	// VersioningStatus holds the value of the "versioning_status" field.
+	VersioningStatus string `json:"versioning_status,omitempty"`
+	// MfaDelete holds the value of the "mfa_delete" field.
+	MfaDelete string `json:"mfa_delete,omitempty"`
+	// LoggingTargetBucket holds the value of the "logging_target_bucket" field.
+	LoggingTargetBucket string `json:"logging_target_bucket,omitempty"`
+	// LoggingTargetPrefix holds the value of the "logging_target_prefix" field.
+	LoggingTargetPrefix string `json:"logging_target_prefix,omitempty"`
+	// PolicyJSON holds the value of the "policy_json" field.
+	PolicyJSON json.RawMessage `json:"policy_json,omitempty"`
+	// ACLGrantsJSON holds the value of the "acl_grants_json" field.
+	ACLGrantsJSON json.RawMessage `json:"acl_grants_json,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// Region holds the value of the "region" field.
+	Region       string `json:"region,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryAWSS3Bucket) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawss3bucket.FieldPolicyJSON, bronzehistoryawss3bucket.FieldACLGrantsJSON, bronzehistoryawss3bucket.FieldTagsJSON:
+			values[i] = new([]byte)
+		case bronzehistoryawss3bucket.FieldBlockPublicAcls, bronzehistoryawss3bucket.FieldIgnorePublicAcls, bronzehistoryawss3bucket.FieldBlockPublicPolicy, bronzehistoryawss3bucket.FieldRestrictPublicBuckets, bronzehistoryawss3bucket.FieldPolicyIsPublic, bronzehistoryawss3bucket.FieldBucketKeyEnabled:
+			values[i] = new(sql.NullBool)
+		case bronzehistoryawss3bucket.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryawss3bucket.FieldResourceID, bronzehistoryawss3bucket.FieldArn, bronzehistoryawss3bucket.FieldOwnerID, bronzehistoryawss3bucket.FieldSseAlgorithm, bronzehistoryawss3bucket.FieldKmsMasterKeyID, bronzehistoryawss3bucket.FieldVersioningStatus, bronzehistoryawss3bucket.FieldMfaDelete, bronzehistoryawss3bucket.FieldLoggingTargetBucket, bronzehistoryawss3bucket.FieldLoggingTargetPrefix, bronzehistoryawss3bucket.FieldAccountID, bronzehistoryawss3bucket.FieldRegion:
+			values[i] = new(sql.NullString)
+		case bronzehistoryawss3bucket.FieldValidFrom, bronzehistoryawss3bucket.FieldValidTo, bronzehistoryawss3bucket.FieldCollectedAt, bronzehistoryawss3bucket.FieldFirstCollectedAt, bronzehistoryawss3bucket.FieldCreationDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryAWSS3Bucket fields.
+func (_m *BronzeHistoryAWSS3Bucket) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryawss3bucket.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryawss3bucket.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryawss3bucket.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryawss3bucket.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryawss3bucket.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryawss3bucket.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryawss3bucket.FieldArn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field arn", values[i])
+			} else if value.Valid {
+				_m.Arn = value.String
+			}
+		case bronzehistoryawss3bucket.FieldCreationDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field creation_date", values[i])
+			} else if value.Valid {
+				_m.CreationDate = new(time.Time)
+				*_m.CreationDate = value.Time
+			}
+		case bronzehistoryawss3bucket.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				_m.OwnerID = value.String
+			}
+		case bronzehistoryawss3bucket.FieldBlockPublicAcls:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field block_public_acls", values[i])
+			} else if value.Valid {
+				_m.BlockPublicAcls = new(bool)
+				*_m.BlockPublicAcls = value.Bool
+			}
+		case bronzehistoryawss3bucket.FieldIgnorePublicAcls:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field ignore_public_acls", values[i])
+			} else if value.Valid {
+				_m.IgnorePublicAcls = new(bool)
+				*_m.IgnorePublicAcls = value.Bool
+			}
+		case bronzehistoryawss3bucket.FieldBlockPublicPolicy:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field block_public_policy", values[i])
+			} else if value.Valid {
+				_m.BlockPublicPolicy = new(bool)
+				*_m.BlockPublicPolicy = value.Bool
+			}
+		case bronzehistoryawss3bucket.FieldRestrictPublicBuckets:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field restrict_public_buckets", values[i])
+			} else if value.Valid {
+				_m.RestrictPublicBuckets = new(bool)
+				*_m.RestrictPublicBuckets = value.Bool
+			}
+		case bronzehistoryawss3bucket.FieldPolicyIsPublic:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_is_public", values[i])
+			} else if value.Valid {
+				_m.PolicyIsPublic = new(bool)
+				*_m.PolicyIsPublic = value.Bool
+			}
+		case bronzehistoryawss3bucket.FieldSseAlgorithm:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sse_algorithm", values[i])
+			} else if value.Valid {
+				_m.SseAlgorithm = value.String
+			}
+		case bronzehistoryawss3bucket.FieldKmsMasterKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kms_master_key_id", values[i])
+			} else if value.Valid {
+				_m.KmsMasterKeyID = value.String
+			}
+		case bronzehistoryawss3bucket.FieldBucketKeyEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field bucket_key_enabled", values[i])
+			} else if value.Valid {
+				_m.BucketKeyEnabled = value.Bool
+			}
+		case bronzehistoryawss3bucket.FieldVersioningStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field versioning_status", values[i])
+			} else if value.Valid {
+				_m.VersioningStatus = value.String
+			}
+		case bronzehistoryawss3bucket.FieldMfaDelete:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_delete", values[i])
+			} else if value.Valid {
+				_m.MfaDelete = value.String
+			}
+		case bronzehistoryawss3bucket.FieldLoggingTargetBucket:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field logging_target_bucket", values[i])
+			} else if value.Valid {
+				_m.LoggingTargetBucket = value.String
+			}
+		case bronzehistoryawss3bucket.FieldLoggingTargetPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field logging_target_prefix", values[i])
+			} else if value.Valid {
+				_m.LoggingTargetPrefix = value.String
+			}
+		case bronzehistoryawss3bucket.FieldPolicyJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PolicyJSON); err != nil {
+					return fmt.Errorf("unmarshal field policy_json: %w", err)
+				}
+			}
+		case bronzehistoryawss3bucket.FieldACLGrantsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field acl_grants_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ACLGrantsJSON); err != nil {
+					return fmt.Errorf("unmarshal field acl_grants_json: %w", err)
+				}
+			}
+		case bronzehistoryawss3bucket.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzehistoryawss3bucket.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzehistoryawss3bucket.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryAWSS3Bucket.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryAWSS3Bucket) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryAWSS3Bucket.
+// Note that you need to call BronzeHistoryAWSS3Bucket.Unwrap() before calling this method if this BronzeHistoryAWSS3Bucket
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryAWSS3Bucket) Update() *BronzeHistoryAWSS3BucketUpdateOne {
+	return NewBronzeHistoryAWSS3BucketClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryAWSS3Bucket entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryAWSS3Bucket) Unwrap() *BronzeHistoryAWSS3Bucket {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("s3: BronzeHistoryAWSS3Bucket is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryAWSS3Bucket) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryAWSS3Bucket(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("arn=")
+	builder.WriteString(_m.Arn)
+	builder.WriteString(", ")
+	if v := _m.CreationDate; v != nil {
+		builder.WriteString("creation_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(_m.OwnerID)
+	builder.WriteString(", ")
+	if v := _m.BlockPublicAcls; v != nil {
+		builder.WriteString("block_public_acls=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.IgnorePublicAcls; v != nil {
+		builder.WriteString("ignore_public_acls=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.BlockPublicPolicy; v != nil {
+		builder.WriteString("block_public_policy=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RestrictPublicBuckets; v != nil {
+		builder.WriteString("restrict_public_buckets=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.PolicyIsPublic; v != nil {
+		builder.WriteString("policy_is_public=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("sse_algorithm=")
+	builder.WriteString(_m.SseAlgorithm)
+	builder.WriteString(", ")
+	builder.WriteString("kms_master_key_id=")
+	builder.WriteString(_m.KmsMasterKeyID)
+	builder.WriteString(", ")
+	builder.WriteString("bucket_key_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BucketKeyEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("versioning_status=")
+	builder.WriteString(_m.VersioningStatus)
+	builder.WriteString(", ")
+	builder.WriteString("mfa_delete=")
+	builder.WriteString(_m.MfaDelete)
+	builder.WriteString(", ")
+	builder.WriteString("logging_target_bucket=")
+	builder.WriteString(_m.LoggingTargetBucket)
+	builder.WriteString(", ")
+	builder.WriteString("logging_target_prefix=")
+	builder.WriteString(_m.LoggingTargetPrefix)
+	builder.WriteString(", ")
+	builder.WriteString("policy_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PolicyJSON))
+	builder.WriteString(", ")
+	builder.WriteString("acl_grants_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ACLGrantsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryAWSS3Buckets is a parsable slice of BronzeHistoryAWSS3Bucket.
+type BronzeHistoryAWSS3Buckets []*BronzeHistoryAWSS3Bucket
@@ -0,0 +1,596 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/bronzehistoryawss3bucket"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSS3BucketCreate is the builder for creating a BronzeHistoryAWSS3Bucket entity.
+type BronzeHistoryAWSS3BucketCreate struct {
+	config
+	mutation *BronzeHistoryAWSS3BucketMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetValidFrom(v time.Time) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetValidTo(v time.Time) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSS3BucketCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetCollectedAt(v time.Time) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetResourceID(v string) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetArn sets the "arn" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetArn(v string) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetArn(v)
+	return _c
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetNillableArn(v *string) *BronzeHistoryAWSS3BucketCreate {
+	if v != nil {
+		_c.SetArn(*v)
+	}
+	return _c
+}
+
+// SetCreationDate sets the "creation_date" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetCreationDate(v time.Time) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetCreationDate(v)
+	return _c
+}
+
+// SetNillableCreationDate sets the "creation_date" field if the given value is not nil.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetNillableCreationDate(v *time.Time) *BronzeHistoryAWSS3BucketCreate {
+	if v != nil {
+		_c.SetCreationDate(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetOwnerID(v string) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetNillableOwnerID(v *string) *BronzeHistoryAWSS3BucketCreate {
+	if v != nil {
+		_c.SetOwnerID(*v)
+	}
+	return _c
+}
+
+// SetBlockPublicAcls sets the "block_public_acls" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetBlockPublicAcls(v bool) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetBlockPublicAcls(v)
+	return _c
+}
+
+// SetNillableBlockPublicAcls sets the "block_public_acls" field if the given value is not nil.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetNillableBlockPublicAcls(v *bool) *BronzeHistoryAWSS3BucketCreate {
+	if v != nil {
+		_c.SetBlockPublicAcls(*v)
+	}
+	return _c
+}
+
+// SetIgnorePublicAcls sets the "ignore_public_acls" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetIgnorePublicAcls(v bool) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetIgnorePublicAcls(v)
+	return _c
+}
+
+// SetNillableIgnorePublicAcls sets the "ignore_public_acls" field if the given value is not nil.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetNillableIgnorePublicAcls(v *bool) *BronzeHistoryAWSS3BucketCreate {
+	if v != nil {
+		_c.SetIgnorePublicAcls(*v)
+	}
+	return _c
+}
+
+// SetBlockPublicPolicy sets the "block_public_policy" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetBlockPublicPolicy(v bool) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetBlockPublicPolicy(v)
+	return _c
+}
+
+// SetNillableBlockPublicPolicy sets the "block_public_policy" field if the given value is not nil.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetNillableBlockPublicPolicy(v *bool) *BronzeHistoryAWSS3BucketCreate {
+	if v != nil {
+		_c.SetBlockPublicPolicy(*v)
+	}
+	return _c
+}
+
+// SetRestrictPublicBuckets sets the "restrict_public_buckets" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetRestrictPublicBuckets(v bool) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetRestrictPublicBuckets(v)
+	return _c
+}
+
+// SetNillableRestrictPublicBuckets sets the "restrict_public_buckets" field if the given value is not nil.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetNillableRestrictPublicBuckets(v *bool) *BronzeHistoryAWSS3BucketCreate {
+	if v != nil {
+		_c.SetRestrictPublicBuckets(*v)
+	}
+	return _c
+}
+
+// SetPolicyIsPublic sets the "policy_is_public" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetPolicyIsPublic(v bool) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetPolicyIsPublic(v)
+	return _c
+}
+
+// SetNillablePolicyIsPublic sets the "policy_is_public" field if the given value is not nil.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetNillablePolicyIsPublic(v *bool) *BronzeHistoryAWSS3BucketCreate {
+	if v != nil {
+		_c.SetPolicyIsPublic(*v)
+	}
+	return _c
+}
+
+// SetSseAlgorithm sets the "sse_algorithm" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetSseAlgorithm(v string) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetSseAlgorithm(v)
+	return _c
+}
+
+// SetNillableSseAlgorithm sets the "sse_algorithm" field if the given value is not nil.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetNillableSseAlgorithm(v *string) *BronzeHistoryAWSS3BucketCreate {
+	if v != nil {
+		_c.SetSseAlgorithm(*v)
+	}
+	return _c
+}
+
+// SetKmsMasterKeyID sets the "kms_master_key_id" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetKmsMasterKeyID(v string) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetKmsMasterKeyID(v)
+	return _c
+}
+
+// SetNillableKmsMasterKeyID sets the "kms_master_key_id" field if the given value is not nil.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetNillableKmsMasterKeyID(v *string) *BronzeHistoryAWSS3BucketCreate {
+	if v != nil {
+		_c.SetKmsMasterKeyID(*v)
+	}
+	return _c
+}
+
+// SetBucketKeyEnabled sets the "bucket_key_enabled" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetBucketKeyEnabled(v bool) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetBucketKeyEnabled(v)
+	return _c
+}
+
+// SetNillableBucketKeyEnabled sets the "bucket_key_enabled" field if the given value is not nil.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetNillableBucketKeyEnabled(v *bool) *BronzeHistoryAWSS3BucketCreate {
+	if v != nil {
+		_c.SetBucketKeyEnabled(*v)
+	}
+	return _c
+}
+
+// SetVersioningStatus sets the "versioning_status" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetVersioningStatus(v string) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetVersioningStatus(v)
+	return _c
+}
+
+// SetNillableVersioningStatus sets the "versioning_status" field if the given value is not nil.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetNillableVersioningStatus(v *string) *BronzeHistoryAWSS3BucketCreate {
+	if v != nil {
+		_c.SetVersioningStatus(*v)
+	}
+	return _c
+}
+
+// SetMfaDelete sets the "mfa_delete" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetMfaDelete(v string) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetMfaDelete(v)
+	return _c
+}
+
+// SetNillableMfaDelete sets the "mfa_delete" field if the given value is not nil.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetNillableMfaDelete(v *string) *BronzeHistoryAWSS3BucketCreate {
+	if v != nil {
+		_c.SetMfaDelete(*v)
+	}
+	return _c
+}
+
+// SetLoggingTargetBucket sets the "logging_target_bucket" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetLoggingTargetBucket(v string) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetLoggingTargetBucket(v)
+	return _c
+}
+
+// SetNillableLoggingTargetBucket sets the "logging_target_bucket" field if the given value is not nil.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetNillableLoggingTargetBucket(v *string) *BronzeHistoryAWSS3BucketCreate {
+	if v != nil {
+		_c.SetLoggingTargetBucket(*v)
+	}
+	return _c
+}
+
+// SetLoggingTargetPrefix sets the "logging_target_prefix" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetLoggingTargetPrefix(v string) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetLoggingTargetPrefix(v)
+	return _c
+}
+
+// SetNillableLoggingTargetPrefix sets the "logging_target_prefix" field if the given value is not nil.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetNillableLoggingTargetPrefix(v *string) *BronzeHistoryAWSS3BucketCreate {
+	if v != nil {
+		_c.SetLoggingTargetPrefix(*v)
+	}
+	return _c
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetPolicyJSON(v json.RawMessage) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetPolicyJSON(v)
+	return _c
+}
+
+// SetACLGrantsJSON sets the "acl_grants_json" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetACLGrantsJSON(v json.RawMessage) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetACLGrantsJSON(v)
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetAccountID(v string) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetRegion(v string) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryAWSS3BucketCreate) SetID(v uint) *BronzeHistoryAWSS3BucketCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryAWSS3BucketMutation object of the builder.
+func (_c *BronzeHistoryAWSS3BucketCreate) Mutation() *BronzeHistoryAWSS3BucketMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryAWSS3Bucket in the database.
+func (_c *BronzeHistoryAWSS3BucketCreate) Save(ctx context.Context) (*BronzeHistoryAWSS3Bucket, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryAWSS3BucketCreate) SaveX(ctx context.Context) *BronzeHistoryAWSS3Bucket {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSS3BucketCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSS3BucketCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryAWSS3BucketCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`s3: missing required field "BronzeHistoryAWSS3Bucket.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`s3: missing required field "BronzeHistoryAWSS3Bucket.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`s3: missing required field "BronzeHistoryAWSS3Bucket.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`s3: missing required field "BronzeHistoryAWSS3Bucket.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryawss3bucket.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`s3: validator failed for field "BronzeHistoryAWSS3Bucket.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`s3: missing required field "BronzeHistoryAWSS3Bucket.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := bronzehistoryawss3bucket.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`s3: validator failed for field "BronzeHistoryAWSS3Bucket.account_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`s3: missing required field "BronzeHistoryAWSS3Bucket.region"`)}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := bronzehistoryawss3bucket.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`s3: validator failed for field "BronzeHistoryAWSS3Bucket.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryAWSS3BucketCreate) sqlSave(ctx context.Context) (*BronzeHistoryAWSS3Bucket, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryAWSS3BucketCreate) createSpec() (*BronzeHistoryAWSS3Bucket, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryAWSS3Bucket{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryawss3bucket.Table, sqlgraph.NewFieldSpec(bronzehistoryawss3bucket.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryAWSS3Bucket
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.Arn(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldArn, field.TypeString, value)
+		_node.Arn = value
+	}
+	if value, ok := _c.mutation.CreationDate(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldCreationDate, field.TypeTime, value)
+		_node.CreationDate = &value
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = value
+	}
+	if value, ok := _c.mutation.BlockPublicAcls(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldBlockPublicAcls, field.TypeBool, value)
+		_node.BlockPublicAcls = &value
+	}
+	if value, ok := _c.mutation.IgnorePublicAcls(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldIgnorePublicAcls, field.TypeBool, value)
+		_node.IgnorePublicAcls = &value
+	}
+	if value, ok := _c.mutation.BlockPublicPolicy(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldBlockPublicPolicy, field.TypeBool, value)
+		_node.BlockPublicPolicy = &value
+	}
+	if value, ok := _c.mutation.RestrictPublicBuckets(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldRestrictPublicBuckets, field.TypeBool, value)
+		_node.RestrictPublicBuckets = &value
+	}
+	if value, ok := _c.mutation.PolicyIsPublic(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldPolicyIsPublic, field.TypeBool, value)
+		_node.PolicyIsPublic = &value
+	}
+	if value, ok := _c.mutation.SseAlgorithm(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldSseAlgorithm, field.TypeString, value)
+		_node.SseAlgorithm = value
+	}
+	if value, ok := _c.mutation.KmsMasterKeyID(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldKmsMasterKeyID, field.TypeString, value)
+		_node.KmsMasterKeyID = value
+	}
+	if value, ok := _c.mutation.BucketKeyEnabled(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldBucketKeyEnabled, field.TypeBool, value)
+		_node.BucketKeyEnabled = value
+	}
+	if value, ok := _c.mutation.VersioningStatus(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldVersioningStatus, field.TypeString, value)
+		_node.VersioningStatus = value
+	}
+	if value, ok := _c.mutation.MfaDelete(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldMfaDelete, field.TypeString, value)
+		_node.MfaDelete = value
+	}
+	if value, ok := _c.mutation.LoggingTargetBucket(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldLoggingTargetBucket, field.TypeString, value)
+		_node.LoggingTargetBucket = value
+	}
+	if value, ok := _c.mutation.LoggingTargetPrefix(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldLoggingTargetPrefix, field.TypeString, value)
+		_node.LoggingTargetPrefix = value
+	}
+	if value, ok := _c.mutation.PolicyJSON(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldPolicyJSON, field.TypeJSON, value)
+		_node.PolicyJSON = value
+	}
+	if value, ok := _c.mutation.ACLGrantsJSON(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldACLGrantsJSON, field.TypeJSON, value)
+		_node.ACLGrantsJSON = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryAWSS3BucketCreateBulk is the builder for creating many BronzeHistoryAWSS3Bucket entities in bulk.
+type BronzeHistoryAWSS3BucketCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryAWSS3BucketCreate
+}
+
+// Save creates the BronzeHistoryAWSS3Bucket entities in the database.
+func (_c *BronzeHistoryAWSS3BucketCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryAWSS3Bucket, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryAWSS3Bucket, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryAWSS3BucketMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryAWSS3BucketCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryAWSS3Bucket {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryAWSS3BucketCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryAWSS3BucketCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
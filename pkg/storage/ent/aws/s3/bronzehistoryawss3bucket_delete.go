@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s3
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/bronzehistoryawss3bucket"
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSS3BucketDelete is the builder for deleting a BronzeHistoryAWSS3Bucket entity.
+type BronzeHistoryAWSS3BucketDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSS3BucketMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSS3BucketDelete builder.
+func (_d *BronzeHistoryAWSS3BucketDelete) Where(ps ...predicate.BronzeHistoryAWSS3Bucket) *BronzeHistoryAWSS3BucketDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeHistoryAWSS3BucketDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryAWSS3BucketDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeHistoryAWSS3BucketDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzehistoryawss3bucket.Table, sqlgraph.NewFieldSpec(bronzehistoryawss3bucket.FieldID, field.TypeUint))
+	_spec.Node.Schema = _d.schemaConfig.BronzeHistoryAWSS3Bucket
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeHistoryAWSS3BucketDeleteOne is the builder for deleting a single BronzeHistoryAWSS3Bucket entity.
+type BronzeHistoryAWSS3BucketDeleteOne struct {
+	_d *BronzeHistoryAWSS3BucketDelete
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSS3BucketDelete builder.
+func (_d *BronzeHistoryAWSS3BucketDeleteOne) Where(ps ...predicate.BronzeHistoryAWSS3Bucket) *BronzeHistoryAWSS3BucketDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeHistoryAWSS3BucketDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzehistoryawss3bucket.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryAWSS3BucketDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
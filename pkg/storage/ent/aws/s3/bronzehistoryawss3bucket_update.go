@@ -0,0 +1,1405 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/bronzehistoryawss3bucket"
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/internal"
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryAWSS3BucketUpdate is the builder for updating BronzeHistoryAWSS3Bucket entities.
+type BronzeHistoryAWSS3BucketUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryAWSS3BucketMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSS3BucketUpdate builder.
+func (_u *BronzeHistoryAWSS3BucketUpdate) Where(ps ...predicate.BronzeHistoryAWSS3Bucket) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetValidTo(v time.Time) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ClearValidTo() *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetCollectedAt(v time.Time) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetResourceID(v string) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableResourceID(v *string) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetArn(v string) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableArn(v *string) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// ClearArn clears the value of the "arn" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ClearArn() *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.ClearArn()
+	return _u
+}
+
+// SetCreationDate sets the "creation_date" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetCreationDate(v time.Time) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetCreationDate(v)
+	return _u
+}
+
+// SetNillableCreationDate sets the "creation_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableCreationDate(v *time.Time) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetCreationDate(*v)
+	}
+	return _u
+}
+
+// ClearCreationDate clears the value of the "creation_date" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ClearCreationDate() *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.ClearCreationDate()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetOwnerID(v string) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableOwnerID(v *string) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ClearOwnerID() *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetBlockPublicAcls sets the "block_public_acls" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetBlockPublicAcls(v bool) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetBlockPublicAcls(v)
+	return _u
+}
+
+// SetNillableBlockPublicAcls sets the "block_public_acls" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableBlockPublicAcls(v *bool) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetBlockPublicAcls(*v)
+	}
+	return _u
+}
+
+// ClearBlockPublicAcls clears the value of the "block_public_acls" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ClearBlockPublicAcls() *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.ClearBlockPublicAcls()
+	return _u
+}
+
+// SetIgnorePublicAcls sets the "ignore_public_acls" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetIgnorePublicAcls(v bool) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetIgnorePublicAcls(v)
+	return _u
+}
+
+// SetNillableIgnorePublicAcls sets the "ignore_public_acls" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableIgnorePublicAcls(v *bool) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetIgnorePublicAcls(*v)
+	}
+	return _u
+}
+
+// ClearIgnorePublicAcls clears the value of the "ignore_public_acls" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ClearIgnorePublicAcls() *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.ClearIgnorePublicAcls()
+	return _u
+}
+
+// SetBlockPublicPolicy sets the "block_public_policy" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetBlockPublicPolicy(v bool) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetBlockPublicPolicy(v)
+	return _u
+}
+
+// SetNillableBlockPublicPolicy sets the "block_public_policy" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableBlockPublicPolicy(v *bool) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetBlockPublicPolicy(*v)
+	}
+	return _u
+}
+
+// ClearBlockPublicPolicy clears the value of the "block_public_policy" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ClearBlockPublicPolicy() *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.ClearBlockPublicPolicy()
+	return _u
+}
+
+// SetRestrictPublicBuckets sets the "restrict_public_buckets" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetRestrictPublicBuckets(v bool) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetRestrictPublicBuckets(v)
+	return _u
+}
+
+// SetNillableRestrictPublicBuckets sets the "restrict_public_buckets" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableRestrictPublicBuckets(v *bool) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetRestrictPublicBuckets(*v)
+	}
+	return _u
+}
+
+// ClearRestrictPublicBuckets clears the value of the "restrict_public_buckets" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ClearRestrictPublicBuckets() *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.ClearRestrictPublicBuckets()
+	return _u
+}
+
+// SetPolicyIsPublic sets the "policy_is_public" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetPolicyIsPublic(v bool) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetPolicyIsPublic(v)
+	return _u
+}
+
+// SetNillablePolicyIsPublic sets the "policy_is_public" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillablePolicyIsPublic(v *bool) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetPolicyIsPublic(*v)
+	}
+	return _u
+}
+
+// ClearPolicyIsPublic clears the value of the "policy_is_public" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ClearPolicyIsPublic() *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.ClearPolicyIsPublic()
+	return _u
+}
+
+// SetSseAlgorithm sets the "sse_algorithm" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetSseAlgorithm(v string) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetSseAlgorithm(v)
+	return _u
+}
+
+// SetNillableSseAlgorithm sets the "sse_algorithm" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableSseAlgorithm(v *string) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetSseAlgorithm(*v)
+	}
+	return _u
+}
+
+// ClearSseAlgorithm clears the value of the "sse_algorithm" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ClearSseAlgorithm() *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.ClearSseAlgorithm()
+	return _u
+}
+
+// SetKmsMasterKeyID sets the "kms_master_key_id" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetKmsMasterKeyID(v string) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetKmsMasterKeyID(v)
+	return _u
+}
+
+// SetNillableKmsMasterKeyID sets the "kms_master_key_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableKmsMasterKeyID(v *string) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetKmsMasterKeyID(*v)
+	}
+	return _u
+}
+
+// ClearKmsMasterKeyID clears the value of the "kms_master_key_id" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ClearKmsMasterKeyID() *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.ClearKmsMasterKeyID()
+	return _u
+}
+
+// SetBucketKeyEnabled sets the "bucket_key_enabled" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetBucketKeyEnabled(v bool) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetBucketKeyEnabled(v)
+	return _u
+}
+
+// SetNillableBucketKeyEnabled sets the "bucket_key_enabled" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableBucketKeyEnabled(v *bool) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetBucketKeyEnabled(*v)
+	}
+	return _u
+}
+
+// ClearBucketKeyEnabled clears the value of the "bucket_key_enabled" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ClearBucketKeyEnabled() *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.ClearBucketKeyEnabled()
+	return _u
+}
+
+// SetVersioningStatus sets the "versioning_status" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetVersioningStatus(v string) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetVersioningStatus(v)
+	return _u
+}
+
+// SetNillableVersioningStatus sets the "versioning_status" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableVersioningStatus(v *string) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetVersioningStatus(*v)
+	}
+	return _u
+}
+
+// ClearVersioningStatus clears the value of the "versioning_status" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ClearVersioningStatus() *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.ClearVersioningStatus()
+	return _u
+}
+
+// SetMfaDelete sets the "mfa_delete" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetMfaDelete(v string) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetMfaDelete(v)
+	return _u
+}
+
+// SetNillableMfaDelete sets the "mfa_delete" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableMfaDelete(v *string) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetMfaDelete(*v)
+	}
+	return _u
+}
+
+// ClearMfaDelete clears the value of the "mfa_delete" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ClearMfaDelete() *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.ClearMfaDelete()
+	return _u
+}
+
+// SetLoggingTargetBucket sets the "logging_target_bucket" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetLoggingTargetBucket(v string) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetLoggingTargetBucket(v)
+	return _u
+}
+
+// SetNillableLoggingTargetBucket sets the "logging_target_bucket" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableLoggingTargetBucket(v *string) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetLoggingTargetBucket(*v)
+	}
+	return _u
+}
+
+// ClearLoggingTargetBucket clears the value of the "logging_target_bucket" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ClearLoggingTargetBucket() *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.ClearLoggingTargetBucket()
+	return _u
+}
+
+// SetLoggingTargetPrefix sets the "logging_target_prefix" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetLoggingTargetPrefix(v string) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetLoggingTargetPrefix(v)
+	return _u
+}
+
+// SetNillableLoggingTargetPrefix sets the "logging_target_prefix" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableLoggingTargetPrefix(v *string) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetLoggingTargetPrefix(*v)
+	}
+	return _u
+}
+
+// ClearLoggingTargetPrefix clears the value of the "logging_target_prefix" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ClearLoggingTargetPrefix() *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.ClearLoggingTargetPrefix()
+	return _u
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetPolicyJSON(v json.RawMessage) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetPolicyJSON(v)
+	return _u
+}
+
+// AppendPolicyJSON appends value to the "policy_json" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) AppendPolicyJSON(v json.RawMessage) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.AppendPolicyJSON(v)
+	return _u
+}
+
+// ClearPolicyJSON clears the value of the "policy_json" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ClearPolicyJSON() *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.ClearPolicyJSON()
+	return _u
+}
+
+// SetACLGrantsJSON sets the "acl_grants_json" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetACLGrantsJSON(v json.RawMessage) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetACLGrantsJSON(v)
+	return _u
+}
+
+// AppendACLGrantsJSON appends value to the "acl_grants_json" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) AppendACLGrantsJSON(v json.RawMessage) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.AppendACLGrantsJSON(v)
+	return _u
+}
+
+// ClearACLGrantsJSON clears the value of the "acl_grants_json" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ClearACLGrantsJSON() *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.ClearACLGrantsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ClearTagsJSON() *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetAccountID(v string) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableAccountID(v *string) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetRegion(v string) *BronzeHistoryAWSS3BucketUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SetNillableRegion(v *string) *BronzeHistoryAWSS3BucketUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSS3BucketMutation object of the builder.
+func (_u *BronzeHistoryAWSS3BucketUpdate) Mutation() *BronzeHistoryAWSS3BucketMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryAWSS3BucketUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSS3BucketUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryAWSS3BucketUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSS3BucketUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSS3BucketUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawss3bucket.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`s3: validator failed for field "BronzeHistoryAWSS3Bucket.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawss3bucket.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`s3: validator failed for field "BronzeHistoryAWSS3Bucket.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawss3bucket.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`s3: validator failed for field "BronzeHistoryAWSS3Bucket.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSS3BucketUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawss3bucket.Table, bronzehistoryawss3bucket.Columns, sqlgraph.NewFieldSpec(bronzehistoryawss3bucket.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldArn, field.TypeString, value)
+	}
+	if _u.mutation.ArnCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreationDate(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldCreationDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreationDateCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldCreationDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.BlockPublicAcls(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldBlockPublicAcls, field.TypeBool, value)
+	}
+	if _u.mutation.BlockPublicAclsCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldBlockPublicAcls, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IgnorePublicAcls(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldIgnorePublicAcls, field.TypeBool, value)
+	}
+	if _u.mutation.IgnorePublicAclsCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldIgnorePublicAcls, field.TypeBool)
+	}
+	if value, ok := _u.mutation.BlockPublicPolicy(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldBlockPublicPolicy, field.TypeBool, value)
+	}
+	if _u.mutation.BlockPublicPolicyCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldBlockPublicPolicy, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RestrictPublicBuckets(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldRestrictPublicBuckets, field.TypeBool, value)
+	}
+	if _u.mutation.RestrictPublicBucketsCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldRestrictPublicBuckets, field.TypeBool)
+	}
+	if value, ok := _u.mutation.PolicyIsPublic(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldPolicyIsPublic, field.TypeBool, value)
+	}
+	if _u.mutation.PolicyIsPublicCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldPolicyIsPublic, field.TypeBool)
+	}
+	if value, ok := _u.mutation.SseAlgorithm(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldSseAlgorithm, field.TypeString, value)
+	}
+	if _u.mutation.SseAlgorithmCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldSseAlgorithm, field.TypeString)
+	}
+	if value, ok := _u.mutation.KmsMasterKeyID(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldKmsMasterKeyID, field.TypeString, value)
+	}
+	if _u.mutation.KmsMasterKeyIDCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldKmsMasterKeyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.BucketKeyEnabled(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldBucketKeyEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.BucketKeyEnabledCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldBucketKeyEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.VersioningStatus(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldVersioningStatus, field.TypeString, value)
+	}
+	if _u.mutation.VersioningStatusCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldVersioningStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.MfaDelete(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldMfaDelete, field.TypeString, value)
+	}
+	if _u.mutation.MfaDeleteCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldMfaDelete, field.TypeString)
+	}
+	if value, ok := _u.mutation.LoggingTargetBucket(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldLoggingTargetBucket, field.TypeString, value)
+	}
+	if _u.mutation.LoggingTargetBucketCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldLoggingTargetBucket, field.TypeString)
+	}
+	if value, ok := _u.mutation.LoggingTargetPrefix(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldLoggingTargetPrefix, field.TypeString, value)
+	}
+	if _u.mutation.LoggingTargetPrefixCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldLoggingTargetPrefix, field.TypeString)
+	}
+	if value, ok := _u.mutation.PolicyJSON(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldPolicyJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolicyJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawss3bucket.FieldPolicyJSON, value)
+		})
+	}
+	if _u.mutation.PolicyJSONCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldPolicyJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ACLGrantsJSON(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldACLGrantsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedACLGrantsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawss3bucket.FieldACLGrantsJSON, value)
+		})
+	}
+	if _u.mutation.ACLGrantsJSONCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldACLGrantsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawss3bucket.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSS3Bucket
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawss3bucket.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryAWSS3BucketUpdateOne is the builder for updating a single BronzeHistoryAWSS3Bucket entity.
+type BronzeHistoryAWSS3BucketUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryAWSS3BucketMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetValidTo(v time.Time) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ClearValidTo() *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetResourceID(v string) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetArn sets the "arn" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetArn(v string) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetArn(v)
+	return _u
+}
+
+// SetNillableArn sets the "arn" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableArn(v *string) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetArn(*v)
+	}
+	return _u
+}
+
+// ClearArn clears the value of the "arn" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ClearArn() *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.ClearArn()
+	return _u
+}
+
+// SetCreationDate sets the "creation_date" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetCreationDate(v time.Time) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetCreationDate(v)
+	return _u
+}
+
+// SetNillableCreationDate sets the "creation_date" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableCreationDate(v *time.Time) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetCreationDate(*v)
+	}
+	return _u
+}
+
+// ClearCreationDate clears the value of the "creation_date" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ClearCreationDate() *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.ClearCreationDate()
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetOwnerID(v string) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableOwnerID(v *string) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ClearOwnerID() *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetBlockPublicAcls sets the "block_public_acls" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetBlockPublicAcls(v bool) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetBlockPublicAcls(v)
+	return _u
+}
+
+// SetNillableBlockPublicAcls sets the "block_public_acls" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableBlockPublicAcls(v *bool) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetBlockPublicAcls(*v)
+	}
+	return _u
+}
+
+// ClearBlockPublicAcls clears the value of the "block_public_acls" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ClearBlockPublicAcls() *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.ClearBlockPublicAcls()
+	return _u
+}
+
+// SetIgnorePublicAcls sets the "ignore_public_acls" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetIgnorePublicAcls(v bool) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetIgnorePublicAcls(v)
+	return _u
+}
+
+// SetNillableIgnorePublicAcls sets the "ignore_public_acls" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableIgnorePublicAcls(v *bool) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetIgnorePublicAcls(*v)
+	}
+	return _u
+}
+
+// ClearIgnorePublicAcls clears the value of the "ignore_public_acls" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ClearIgnorePublicAcls() *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.ClearIgnorePublicAcls()
+	return _u
+}
+
+// SetBlockPublicPolicy sets the "block_public_policy" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetBlockPublicPolicy(v bool) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetBlockPublicPolicy(v)
+	return _u
+}
+
+// SetNillableBlockPublicPolicy sets the "block_public_policy" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableBlockPublicPolicy(v *bool) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetBlockPublicPolicy(*v)
+	}
+	return _u
+}
+
+// ClearBlockPublicPolicy clears the value of the "block_public_policy" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ClearBlockPublicPolicy() *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.ClearBlockPublicPolicy()
+	return _u
+}
+
+// SetRestrictPublicBuckets sets the "restrict_public_buckets" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetRestrictPublicBuckets(v bool) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetRestrictPublicBuckets(v)
+	return _u
+}
+
+// SetNillableRestrictPublicBuckets sets the "restrict_public_buckets" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableRestrictPublicBuckets(v *bool) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetRestrictPublicBuckets(*v)
+	}
+	return _u
+}
+
+// ClearRestrictPublicBuckets clears the value of the "restrict_public_buckets" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ClearRestrictPublicBuckets() *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.ClearRestrictPublicBuckets()
+	return _u
+}
+
+// SetPolicyIsPublic sets the "policy_is_public" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetPolicyIsPublic(v bool) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetPolicyIsPublic(v)
+	return _u
+}
+
+// SetNillablePolicyIsPublic sets the "policy_is_public" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillablePolicyIsPublic(v *bool) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetPolicyIsPublic(*v)
+	}
+	return _u
+}
+
+// ClearPolicyIsPublic clears the value of the "policy_is_public" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ClearPolicyIsPublic() *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.ClearPolicyIsPublic()
+	return _u
+}
+
+// SetSseAlgorithm sets the "sse_algorithm" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetSseAlgorithm(v string) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetSseAlgorithm(v)
+	return _u
+}
+
+// SetNillableSseAlgorithm sets the "sse_algorithm" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableSseAlgorithm(v *string) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetSseAlgorithm(*v)
+	}
+	return _u
+}
+
+// ClearSseAlgorithm clears the value of the "sse_algorithm" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ClearSseAlgorithm() *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.ClearSseAlgorithm()
+	return _u
+}
+
+// SetKmsMasterKeyID sets the "kms_master_key_id" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetKmsMasterKeyID(v string) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetKmsMasterKeyID(v)
+	return _u
+}
+
+// SetNillableKmsMasterKeyID sets the "kms_master_key_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableKmsMasterKeyID(v *string) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetKmsMasterKeyID(*v)
+	}
+	return _u
+}
+
+// ClearKmsMasterKeyID clears the value of the "kms_master_key_id" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ClearKmsMasterKeyID() *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.ClearKmsMasterKeyID()
+	return _u
+}
+
+// SetBucketKeyEnabled sets the "bucket_key_enabled" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetBucketKeyEnabled(v bool) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetBucketKeyEnabled(v)
+	return _u
+}
+
+// SetNillableBucketKeyEnabled sets the "bucket_key_enabled" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableBucketKeyEnabled(v *bool) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetBucketKeyEnabled(*v)
+	}
+	return _u
+}
+
+// ClearBucketKeyEnabled clears the value of the "bucket_key_enabled" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ClearBucketKeyEnabled() *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.ClearBucketKeyEnabled()
+	return _u
+}
+
+// SetVersioningStatus sets the "versioning_status" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetVersioningStatus(v string) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetVersioningStatus(v)
+	return _u
+}
+
+// SetNillableVersioningStatus sets the "versioning_status" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableVersioningStatus(v *string) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetVersioningStatus(*v)
+	}
+	return _u
+}
+
+// ClearVersioningStatus clears the value of the "versioning_status" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ClearVersioningStatus() *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.ClearVersioningStatus()
+	return _u
+}
+
+// SetMfaDelete sets the "mfa_delete" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetMfaDelete(v string) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetMfaDelete(v)
+	return _u
+}
+
+// SetNillableMfaDelete sets the "mfa_delete" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableMfaDelete(v *string) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetMfaDelete(*v)
+	}
+	return _u
+}
+
+// ClearMfaDelete clears the value of the "mfa_delete" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ClearMfaDelete() *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.ClearMfaDelete()
+	return _u
+}
+
+// SetLoggingTargetBucket sets the "logging_target_bucket" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetLoggingTargetBucket(v string) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetLoggingTargetBucket(v)
+	return _u
+}
+
+// SetNillableLoggingTargetBucket sets the "logging_target_bucket" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableLoggingTargetBucket(v *string) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetLoggingTargetBucket(*v)
+	}
+	return _u
+}
+
+// ClearLoggingTargetBucket clears the value of the "logging_target_bucket" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ClearLoggingTargetBucket() *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.ClearLoggingTargetBucket()
+	return _u
+}
+
+// SetLoggingTargetPrefix sets the "logging_target_prefix" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetLoggingTargetPrefix(v string) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetLoggingTargetPrefix(v)
+	return _u
+}
+
+// SetNillableLoggingTargetPrefix sets the "logging_target_prefix" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableLoggingTargetPrefix(v *string) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetLoggingTargetPrefix(*v)
+	}
+	return _u
+}
+
+// ClearLoggingTargetPrefix clears the value of the "logging_target_prefix" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ClearLoggingTargetPrefix() *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.ClearLoggingTargetPrefix()
+	return _u
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetPolicyJSON(v json.RawMessage) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetPolicyJSON(v)
+	return _u
+}
+
+// AppendPolicyJSON appends value to the "policy_json" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) AppendPolicyJSON(v json.RawMessage) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.AppendPolicyJSON(v)
+	return _u
+}
+
+// ClearPolicyJSON clears the value of the "policy_json" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ClearPolicyJSON() *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.ClearPolicyJSON()
+	return _u
+}
+
+// SetACLGrantsJSON sets the "acl_grants_json" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetACLGrantsJSON(v json.RawMessage) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetACLGrantsJSON(v)
+	return _u
+}
+
+// AppendACLGrantsJSON appends value to the "acl_grants_json" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) AppendACLGrantsJSON(v json.RawMessage) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.AppendACLGrantsJSON(v)
+	return _u
+}
+
+// ClearACLGrantsJSON clears the value of the "acl_grants_json" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ClearACLGrantsJSON() *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.ClearACLGrantsJSON()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ClearTagsJSON() *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetAccountID(v string) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetRegion(v string) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SetNillableRegion(v *string) *BronzeHistoryAWSS3BucketUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeHistoryAWSS3BucketMutation object of the builder.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) Mutation() *BronzeHistoryAWSS3BucketMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSS3BucketUpdate builder.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) Where(ps ...predicate.BronzeHistoryAWSS3Bucket) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) Select(field string, fields ...string) *BronzeHistoryAWSS3BucketUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryAWSS3Bucket entity.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) Save(ctx context.Context) (*BronzeHistoryAWSS3Bucket, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) SaveX(ctx context.Context) *BronzeHistoryAWSS3Bucket {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryawss3bucket.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`s3: validator failed for field "BronzeHistoryAWSS3Bucket.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := bronzehistoryawss3bucket.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`s3: validator failed for field "BronzeHistoryAWSS3Bucket.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := bronzehistoryawss3bucket.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`s3: validator failed for field "BronzeHistoryAWSS3Bucket.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryAWSS3BucketUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryAWSS3Bucket, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryawss3bucket.Table, bronzehistoryawss3bucket.Columns, sqlgraph.NewFieldSpec(bronzehistoryawss3bucket.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`s3: missing "BronzeHistoryAWSS3Bucket.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryawss3bucket.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryawss3bucket.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("s3: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryawss3bucket.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Arn(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldArn, field.TypeString, value)
+	}
+	if _u.mutation.ArnCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldArn, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreationDate(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldCreationDate, field.TypeTime, value)
+	}
+	if _u.mutation.CreationDateCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldCreationDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.BlockPublicAcls(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldBlockPublicAcls, field.TypeBool, value)
+	}
+	if _u.mutation.BlockPublicAclsCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldBlockPublicAcls, field.TypeBool)
+	}
+	if value, ok := _u.mutation.IgnorePublicAcls(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldIgnorePublicAcls, field.TypeBool, value)
+	}
+	if _u.mutation.IgnorePublicAclsCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldIgnorePublicAcls, field.TypeBool)
+	}
+	if value, ok := _u.mutation.BlockPublicPolicy(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldBlockPublicPolicy, field.TypeBool, value)
+	}
+	if _u.mutation.BlockPublicPolicyCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldBlockPublicPolicy, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RestrictPublicBuckets(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldRestrictPublicBuckets, field.TypeBool, value)
+	}
+	if _u.mutation.RestrictPublicBucketsCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldRestrictPublicBuckets, field.TypeBool)
+	}
+	if value, ok := _u.mutation.PolicyIsPublic(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldPolicyIsPublic, field.TypeBool, value)
+	}
+	if _u.mutation.PolicyIsPublicCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldPolicyIsPublic, field.TypeBool)
+	}
+	if value, ok := _u.mutation.SseAlgorithm(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldSseAlgorithm, field.TypeString, value)
+	}
+	if _u.mutation.SseAlgorithmCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldSseAlgorithm, field.TypeString)
+	}
+	if value, ok := _u.mutation.KmsMasterKeyID(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldKmsMasterKeyID, field.TypeString, value)
+	}
+	if _u.mutation.KmsMasterKeyIDCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldKmsMasterKeyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.BucketKeyEnabled(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldBucketKeyEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.BucketKeyEnabledCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldBucketKeyEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.VersioningStatus(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldVersioningStatus, field.TypeString, value)
+	}
+	if _u.mutation.VersioningStatusCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldVersioningStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.MfaDelete(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldMfaDelete, field.TypeString, value)
+	}
+	if _u.mutation.MfaDeleteCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldMfaDelete, field.TypeString)
+	}
+	if value, ok := _u.mutation.LoggingTargetBucket(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldLoggingTargetBucket, field.TypeString, value)
+	}
+	if _u.mutation.LoggingTargetBucketCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldLoggingTargetBucket, field.TypeString)
+	}
+	if value, ok := _u.mutation.LoggingTargetPrefix(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldLoggingTargetPrefix, field.TypeString, value)
+	}
+	if _u.mutation.LoggingTargetPrefixCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldLoggingTargetPrefix, field.TypeString)
+	}
+	if value, ok := _u.mutation.PolicyJSON(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldPolicyJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolicyJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawss3bucket.FieldPolicyJSON, value)
+		})
+	}
+	if _u.mutation.PolicyJSONCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldPolicyJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ACLGrantsJSON(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldACLGrantsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedACLGrantsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawss3bucket.FieldACLGrantsJSON, value)
+		})
+	}
+	if _u.mutation.ACLGrantsJSONCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldACLGrantsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryawss3bucket.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryawss3bucket.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryawss3bucket.FieldRegion, field.TypeString, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryAWSS3Bucket
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryAWSS3Bucket{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryawss3bucket.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,499 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/migrate"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/bronzeawss3bucket"
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/bronzehistoryawss3bucket"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/internal"
+)
+
+// Client is the client that holds all ent builders.
+type Client struct {
+	config
+	// Schema is the client for creating, migrating and dropping schema.
+	Schema *migrate.Schema
+	// BronzeAWSS3Bucket is the client for interacting with the BronzeAWSS3Bucket builders.
+	BronzeAWSS3Bucket *BronzeAWSS3BucketClient
+	// BronzeHistoryAWSS3Bucket is the client for interacting with the BronzeHistoryAWSS3Bucket builders.
+	BronzeHistoryAWSS3Bucket *BronzeHistoryAWSS3BucketClient
+}
+
+// NewClient creates a new client configured with the given options.
+func NewClient(opts ...Option) *Client {
+	client := &Client{config: newConfig(opts...)}
+	client.init()
+	return client
+}
+
+func (c *Client) init() {
+	c.Schema = migrate.NewSchema(c.driver)
+	c.BronzeAWSS3Bucket = NewBronzeAWSS3BucketClient(c.config)
+	c.BronzeHistoryAWSS3Bucket = NewBronzeHistoryAWSS3BucketClient(c.config)
+}
+
+type (
+	// config is the configuration for the client and its builder.
+	config struct {
+		// driver used for executing database requests.
+		driver dialect.Driver
+		// debug enable a debug logging.
+		debug bool
+		// log used for logging on debug mode.
+		log func(...any)
+		// hooks to execute on mutations.
+		hooks *hooks
+		// interceptors to execute on queries.
+		inters *inters
+		// schemaConfig contains alternative names for all tables.
+		schemaConfig SchemaConfig
+	}
+	// Option function to configure the client.
+	Option func(*config)
+)
+
+// newConfig creates a new config for the client.
+func newConfig(opts ...Option) config {
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
+	cfg.options(opts...)
+	return cfg
+}
+
+// options applies the options on the config object.
+func (c *config) options(opts ...Option) {
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.debug {
+		c.driver = dialect.Debug(c.driver, c.log)
+	}
+}
+
+// Debug enables debug logging on the ent.Driver.
+func Debug() Option {
+	return func(c *config) {
+		c.debug = true
+	}
+}
+
+// Log sets the logging function for debug mode.
+func Log(fn func(...any)) Option {
+	return func(c *config) {
+		c.log = fn
+	}
+}
+
+// Driver configures the client driver.
+func Driver(driver dialect.Driver) Option {
+	return func(c *config) {
+		c.driver = driver
+	}
+}
+
+// Open opens a database/sql.DB specified by the driver name and
+// the data source name, and returns a new client attached to it.
+// Optional parameters can be added for configuring the client.
+func Open(driverName, dataSourceName string, options ...Option) (*Client, error) {
+	switch driverName {
+	case dialect.MySQL, dialect.Postgres, dialect.SQLite:
+		drv, err := sql.Open(driverName, dataSourceName)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(append(options, Driver(drv))...), nil
+	default:
+		return nil, fmt.Errorf("unsupported driver: %q", driverName)
+	}
+}
+
+// ErrTxStarted is returned when trying to start a new transaction from a transactional client.
+var ErrTxStarted = errors.New("s3: cannot start a transaction within a transaction")
+
+// Tx returns a new transactional client. The provided context
+// is used until the transaction is committed or rolled back.
+func (c *Client) Tx(ctx context.Context) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, ErrTxStarted
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("s3: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	return &Tx{
+		ctx:                      ctx,
+		config:                   cfg,
+		BronzeAWSS3Bucket:        NewBronzeAWSS3BucketClient(cfg),
+		BronzeHistoryAWSS3Bucket: NewBronzeHistoryAWSS3BucketClient(cfg),
+	}, nil
+}
+
+// BeginTx returns a transactional client with specified options.
+func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, errors.New("ent: cannot start a transaction within a transaction")
+	}
+	tx, err := c.driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}).BeginTx(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = &txDriver{tx: tx, drv: c.driver}
+	return &Tx{
+		ctx:                      ctx,
+		config:                   cfg,
+		BronzeAWSS3Bucket:        NewBronzeAWSS3BucketClient(cfg),
+		BronzeHistoryAWSS3Bucket: NewBronzeHistoryAWSS3BucketClient(cfg),
+	}, nil
+}
+
+// Debug returns a new debug-client. It's used to get verbose logging on specific operations.
+//
+//	client.Debug().
+//		BronzeAWSS3Bucket.
+//		Query().
+//		Count(ctx)
+func (c *Client) Debug() *Client {
+	if c.debug {
+		return c
+	}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// Close closes the database connection and prevents new queries from starting.
+func (c *Client) Close() error {
+	return c.driver.Close()
+}
+
+// Use adds the mutation hooks to all the entity clients.
+// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
+func (c *Client) Use(hooks ...Hook) {
+	c.BronzeAWSS3Bucket.Use(hooks...)
+	c.BronzeHistoryAWSS3Bucket.Use(hooks...)
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.BronzeAWSS3Bucket.Intercept(interceptors...)
+	c.BronzeHistoryAWSS3Bucket.Intercept(interceptors...)
+}
+
+// Mutate implements the ent.Mutator interface.
+func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
+	switch m := m.(type) {
+	case *BronzeAWSS3BucketMutation:
+		return c.BronzeAWSS3Bucket.mutate(ctx, m)
+	case *BronzeHistoryAWSS3BucketMutation:
+		return c.BronzeHistoryAWSS3Bucket.mutate(ctx, m)
+	default:
+		return nil, fmt.Errorf("s3: unknown mutation type %T", m)
+	}
+}
+
+// BronzeAWSS3BucketClient is a client for the BronzeAWSS3Bucket schema.
+type BronzeAWSS3BucketClient struct {
+	config
+}
+
+// NewBronzeAWSS3BucketClient returns a client for the BronzeAWSS3Bucket from the given config.
+func NewBronzeAWSS3BucketClient(c config) *BronzeAWSS3BucketClient {
+	return &BronzeAWSS3BucketClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzeawss3bucket.Hooks(f(g(h())))`.
+func (c *BronzeAWSS3BucketClient) Use(hooks ...Hook) {
+	c.hooks.BronzeAWSS3Bucket = append(c.hooks.BronzeAWSS3Bucket, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzeawss3bucket.Intercept(f(g(h())))`.
+func (c *BronzeAWSS3BucketClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeAWSS3Bucket = append(c.inters.BronzeAWSS3Bucket, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeAWSS3Bucket entity.
+func (c *BronzeAWSS3BucketClient) Create() *BronzeAWSS3BucketCreate {
+	mutation := newBronzeAWSS3BucketMutation(c.config, OpCreate)
+	return &BronzeAWSS3BucketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeAWSS3Bucket entities.
+func (c *BronzeAWSS3BucketClient) CreateBulk(builders ...*BronzeAWSS3BucketCreate) *BronzeAWSS3BucketCreateBulk {
+	return &BronzeAWSS3BucketCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeAWSS3BucketClient) MapCreateBulk(slice any, setFunc func(*BronzeAWSS3BucketCreate, int)) *BronzeAWSS3BucketCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeAWSS3BucketCreateBulk{err: fmt.Errorf("calling to BronzeAWSS3BucketClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeAWSS3BucketCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeAWSS3BucketCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeAWSS3Bucket.
+func (c *BronzeAWSS3BucketClient) Update() *BronzeAWSS3BucketUpdate {
+	mutation := newBronzeAWSS3BucketMutation(c.config, OpUpdate)
+	return &BronzeAWSS3BucketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeAWSS3BucketClient) UpdateOne(_m *BronzeAWSS3Bucket) *BronzeAWSS3BucketUpdateOne {
+	mutation := newBronzeAWSS3BucketMutation(c.config, OpUpdateOne, withBronzeAWSS3Bucket(_m))
+	return &BronzeAWSS3BucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeAWSS3BucketClient) UpdateOneID(id string) *BronzeAWSS3BucketUpdateOne {
+	mutation := newBronzeAWSS3BucketMutation(c.config, OpUpdateOne, withBronzeAWSS3BucketID(id))
+	return &BronzeAWSS3BucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeAWSS3Bucket.
+func (c *BronzeAWSS3BucketClient) Delete() *BronzeAWSS3BucketDelete {
+	mutation := newBronzeAWSS3BucketMutation(c.config, OpDelete)
+	return &BronzeAWSS3BucketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeAWSS3BucketClient) DeleteOne(_m *BronzeAWSS3Bucket) *BronzeAWSS3BucketDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeAWSS3BucketClient) DeleteOneID(id string) *BronzeAWSS3BucketDeleteOne {
+	builder := c.Delete().Where(bronzeawss3bucket.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeAWSS3BucketDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeAWSS3Bucket.
+func (c *BronzeAWSS3BucketClient) Query() *BronzeAWSS3BucketQuery {
+	return &BronzeAWSS3BucketQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeAWSS3Bucket},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeAWSS3Bucket entity by its id.
+func (c *BronzeAWSS3BucketClient) Get(ctx context.Context, id string) (*BronzeAWSS3Bucket, error) {
+	return c.Query().Where(bronzeawss3bucket.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeAWSS3BucketClient) GetX(ctx context.Context, id string) *BronzeAWSS3Bucket {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeAWSS3BucketClient) Hooks() []Hook {
+	return c.hooks.BronzeAWSS3Bucket
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeAWSS3BucketClient) Interceptors() []Interceptor {
+	return c.inters.BronzeAWSS3Bucket
+}
+
+func (c *BronzeAWSS3BucketClient) mutate(ctx context.Context, m *BronzeAWSS3BucketMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeAWSS3BucketCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeAWSS3BucketUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeAWSS3BucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeAWSS3BucketDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("s3: unknown BronzeAWSS3Bucket mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryAWSS3BucketClient is a client for the BronzeHistoryAWSS3Bucket schema.
+type BronzeHistoryAWSS3BucketClient struct {
+	config
+}
+
+// NewBronzeHistoryAWSS3BucketClient returns a client for the BronzeHistoryAWSS3Bucket from the given config.
+func NewBronzeHistoryAWSS3BucketClient(c config) *BronzeHistoryAWSS3BucketClient {
+	return &BronzeHistoryAWSS3BucketClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryawss3bucket.Hooks(f(g(h())))`.
+func (c *BronzeHistoryAWSS3BucketClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryAWSS3Bucket = append(c.hooks.BronzeHistoryAWSS3Bucket, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryawss3bucket.Intercept(f(g(h())))`.
+func (c *BronzeHistoryAWSS3BucketClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryAWSS3Bucket = append(c.inters.BronzeHistoryAWSS3Bucket, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryAWSS3Bucket entity.
+func (c *BronzeHistoryAWSS3BucketClient) Create() *BronzeHistoryAWSS3BucketCreate {
+	mutation := newBronzeHistoryAWSS3BucketMutation(c.config, OpCreate)
+	return &BronzeHistoryAWSS3BucketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryAWSS3Bucket entities.
+func (c *BronzeHistoryAWSS3BucketClient) CreateBulk(builders ...*BronzeHistoryAWSS3BucketCreate) *BronzeHistoryAWSS3BucketCreateBulk {
+	return &BronzeHistoryAWSS3BucketCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryAWSS3BucketClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryAWSS3BucketCreate, int)) *BronzeHistoryAWSS3BucketCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryAWSS3BucketCreateBulk{err: fmt.Errorf("calling to BronzeHistoryAWSS3BucketClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryAWSS3BucketCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryAWSS3BucketCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryAWSS3Bucket.
+func (c *BronzeHistoryAWSS3BucketClient) Update() *BronzeHistoryAWSS3BucketUpdate {
+	mutation := newBronzeHistoryAWSS3BucketMutation(c.config, OpUpdate)
+	return &BronzeHistoryAWSS3BucketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryAWSS3BucketClient) UpdateOne(_m *BronzeHistoryAWSS3Bucket) *BronzeHistoryAWSS3BucketUpdateOne {
+	mutation := newBronzeHistoryAWSS3BucketMutation(c.config, OpUpdateOne, withBronzeHistoryAWSS3Bucket(_m))
+	return &BronzeHistoryAWSS3BucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryAWSS3BucketClient) UpdateOneID(id uint) *BronzeHistoryAWSS3BucketUpdateOne {
+	mutation := newBronzeHistoryAWSS3BucketMutation(c.config, OpUpdateOne, withBronzeHistoryAWSS3BucketID(id))
+	return &BronzeHistoryAWSS3BucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryAWSS3Bucket.
+func (c *BronzeHistoryAWSS3BucketClient) Delete() *BronzeHistoryAWSS3BucketDelete {
+	mutation := newBronzeHistoryAWSS3BucketMutation(c.config, OpDelete)
+	return &BronzeHistoryAWSS3BucketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryAWSS3BucketClient) DeleteOne(_m *BronzeHistoryAWSS3Bucket) *BronzeHistoryAWSS3BucketDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryAWSS3BucketClient) DeleteOneID(id uint) *BronzeHistoryAWSS3BucketDeleteOne {
+	builder := c.Delete().Where(bronzehistoryawss3bucket.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryAWSS3BucketDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryAWSS3Bucket.
+func (c *BronzeHistoryAWSS3BucketClient) Query() *BronzeHistoryAWSS3BucketQuery {
+	return &BronzeHistoryAWSS3BucketQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryAWSS3Bucket},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryAWSS3Bucket entity by its id.
+func (c *BronzeHistoryAWSS3BucketClient) Get(ctx context.Context, id uint) (*BronzeHistoryAWSS3Bucket, error) {
+	return c.Query().Where(bronzehistoryawss3bucket.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryAWSS3BucketClient) GetX(ctx context.Context, id uint) *BronzeHistoryAWSS3Bucket {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryAWSS3BucketClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryAWSS3Bucket
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryAWSS3BucketClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryAWSS3Bucket
+}
+
+func (c *BronzeHistoryAWSS3BucketClient) mutate(ctx context.Context, m *BronzeHistoryAWSS3BucketMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryAWSS3BucketCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryAWSS3BucketUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryAWSS3BucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryAWSS3BucketDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("s3: unknown BronzeHistoryAWSS3Bucket mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		BronzeAWSS3Bucket, BronzeHistoryAWSS3Bucket []ent.Hook
+	}
+	inters struct {
+		BronzeAWSS3Bucket, BronzeHistoryAWSS3Bucket []ent.Interceptor
+	}
+)
+
+// SchemaConfig represents alternative schema names for all tables
+// that can be passed at runtime.
+type SchemaConfig = internal.SchemaConfig
+
+// AlternateSchemas allows alternate schema names to be
+// passed into ent operations.
+func AlternateSchema(schemaConfig SchemaConfig) Option {
+	return func(c *config) {
+		c.schemaConfig = schemaConfig
+	}
+}
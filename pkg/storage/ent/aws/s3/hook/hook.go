@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hook
+
+import (
+	"context"
+	"fmt"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3"
+)
+
+// The BronzeAWSS3BucketFunc type is an adapter to allow the use of ordinary
+// function as BronzeAWSS3Bucket mutator.
+type BronzeAWSS3BucketFunc func(context.Context, *s3.BronzeAWSS3BucketMutation) (s3.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeAWSS3BucketFunc) Mutate(ctx context.Context, m s3.Mutation) (s3.Value, error) {
+	if mv, ok := m.(*s3.BronzeAWSS3BucketMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *s3.BronzeAWSS3BucketMutation", m)
+}
+
+// The BronzeHistoryAWSS3BucketFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryAWSS3Bucket mutator.
+type BronzeHistoryAWSS3BucketFunc func(context.Context, *s3.BronzeHistoryAWSS3BucketMutation) (s3.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryAWSS3BucketFunc) Mutate(ctx context.Context, m s3.Mutation) (s3.Value, error) {
+	if mv, ok := m.(*s3.BronzeHistoryAWSS3BucketMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *s3.BronzeHistoryAWSS3BucketMutation", m)
+}
+
+// Condition is a hook condition function.
+type Condition func(context.Context, s3.Mutation) bool
+
+// And groups conditions with the AND operator.
+func And(first, second Condition, rest ...Condition) Condition {
+	return func(ctx context.Context, m s3.Mutation) bool {
+		if !first(ctx, m) || !second(ctx, m) {
+			return false
+		}
+		for _, cond := range rest {
+			if !cond(ctx, m) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or groups conditions with the OR operator.
+func Or(first, second Condition, rest ...Condition) Condition {
+	return func(ctx context.Context, m s3.Mutation) bool {
+		if first(ctx, m) || second(ctx, m) {
+			return true
+		}
+		for _, cond := range rest {
+			if cond(ctx, m) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not negates a given condition.
+func Not(cond Condition) Condition {
+	return func(ctx context.Context, m s3.Mutation) bool {
+		return !cond(ctx, m)
+	}
+}
+
+// HasOp is a condition testing mutation operation.
+func HasOp(op s3.Op) Condition {
+	return func(_ context.Context, m s3.Mutation) bool {
+		return m.Op().Is(op)
+	}
+}
+
+// HasAddedFields is a condition validating `.AddedField` on fields.
+func HasAddedFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m s3.Mutation) bool {
+		if _, exists := m.AddedField(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if _, exists := m.AddedField(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// HasClearedFields is a condition validating `.FieldCleared` on fields.
+func HasClearedFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m s3.Mutation) bool {
+		if exists := m.FieldCleared(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if exists := m.FieldCleared(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// HasFields is a condition validating `.Field` on fields.
+func HasFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m s3.Mutation) bool {
+		if _, exists := m.Field(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if _, exists := m.Field(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// If executes the given hook under condition.
+//
+//	hook.If(ComputeAverage, And(HasFields(...), HasAddedFields(...)))
+func If(hk s3.Hook, cond Condition) s3.Hook {
+	return func(next s3.Mutator) s3.Mutator {
+		return s3.MutateFunc(func(ctx context.Context, m s3.Mutation) (s3.Value, error) {
+			if cond(ctx, m) {
+				return hk(next).Mutate(ctx, m)
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// On executes the given hook only for the given operation.
+//
+//	hook.On(Log, s3.Delete|s3.Create)
+func On(hk s3.Hook, op s3.Op) s3.Hook {
+	return If(hk, HasOp(op))
+}
+
+// Unless skips the given hook only for the given operation.
+//
+//	hook.Unless(Log, s3.Update|s3.UpdateOne)
+func Unless(hk s3.Hook, op s3.Op) s3.Hook {
+	return If(hk, Not(HasOp(op)))
+}
+
+// FixedError is a hook returning a fixed error.
+func FixedError(err error) s3.Hook {
+	return func(s3.Mutator) s3.Mutator {
+		return s3.MutateFunc(func(context.Context, s3.Mutation) (s3.Value, error) {
+			return nil, err
+		})
+	}
+}
+
+// Reject returns a hook that rejects all operations that match op.
+//
+//	func (T) Hooks() []s3.Hook {
+//		return []s3.Hook{
+//			Reject(s3.Delete|s3.Update),
+//		}
+//	}
+func Reject(op s3.Op) s3.Hook {
+	hk := FixedError(fmt.Errorf("%s operation is not allowed", op))
+	return On(hk, op)
+}
+
+// Chain acts as a list of hooks and is effectively immutable.
+// Once created, it will always hold the same set of hooks in the same order.
+type Chain struct {
+	hooks []s3.Hook
+}
+
+// NewChain creates a new chain of hooks.
+func NewChain(hooks ...s3.Hook) Chain {
+	return Chain{append([]s3.Hook(nil), hooks...)}
+}
+
+// Hook chains the list of hooks and returns the final hook.
+func (c Chain) Hook() s3.Hook {
+	return func(mutator s3.Mutator) s3.Mutator {
+		for i := len(c.hooks) - 1; i >= 0; i-- {
+			mutator = c.hooks[i](mutator)
+		}
+		return mutator
+	}
+}
+
+// Append extends a chain, adding the specified hook
+// as the last ones in the mutation flow.
+func (c Chain) Append(hooks ...s3.Hook) Chain {
+	newHooks := make([]s3.Hook, 0, len(c.hooks)+len(hooks))
+	newHooks = append(newHooks, c.hooks...)
+	newHooks = append(newHooks, hooks...)
+	return Chain{newHooks}
+}
+
+// Extend extends a chain, adding the specified chain
+// as the last ones in the mutation flow.
+func (c Chain) Extend(chain Chain) Chain {
+	return c.Append(chain.hooks...)
+}
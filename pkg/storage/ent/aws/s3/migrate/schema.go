@@ -0,0 +1,142 @@
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// AWSS3BucketsColumns holds the columns for the "aws_s3_buckets" table.
+	AWSS3BucketsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "arn", Type: field.TypeString, Nullable: true},
+		{Name: "creation_date", Type: field.TypeTime, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "block_public_acls", Type: field.TypeBool, Nullable: true},
+		{Name: "ignore_public_acls", Type: field.TypeBool, Nullable: true},
+		{Name: "block_public_policy", Type: field.TypeBool, Nullable: true},
+		{Name: "restrict_public_buckets", Type: field.TypeBool, Nullable: true},
+		{Name: "policy_is_public", Type: field.TypeBool, Nullable: true},
+		{Name: "sse_algorithm", Type: field.TypeString, Nullable: true},
+		{Name: "kms_master_key_id", Type: field.TypeString, Nullable: true},
+		{Name: "bucket_key_enabled", Type: field.TypeBool, Nullable: true},
+		{Name: "versioning_status", Type: field.TypeString, Nullable: true},
+		{Name: "mfa_delete", Type: field.TypeString, Nullable: true},
+		{Name: "logging_target_bucket", Type: field.TypeString, Nullable: true},
+		{Name: "logging_target_prefix", Type: field.TypeString, Nullable: true},
+		{Name: "policy_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "acl_grants_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSS3BucketsTable holds the schema information for the "aws_s3_buckets" table.
+	AWSS3BucketsTable = &schema.Table{
+		Name:       "aws_s3_buckets",
+		Columns:    AWSS3BucketsColumns,
+		PrimaryKey: []*schema.Column{AWSS3BucketsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzeawss3bucket_policy_is_public",
+				Unique:  false,
+				Columns: []*schema.Column{AWSS3BucketsColumns[10]},
+			},
+			{
+				Name:    "bronzeawss3bucket_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSS3BucketsColumns[21]},
+			},
+			{
+				Name:    "bronzeawss3bucket_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSS3BucketsColumns[22]},
+			},
+			{
+				Name:    "bronzeawss3bucket_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSS3BucketsColumns[1]},
+			},
+		},
+	}
+	// AWSS3BucketsHistoryColumns holds the columns for the "aws_s3_buckets_history" table.
+	AWSS3BucketsHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "arn", Type: field.TypeString, Nullable: true},
+		{Name: "creation_date", Type: field.TypeTime, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "block_public_acls", Type: field.TypeBool, Nullable: true},
+		{Name: "ignore_public_acls", Type: field.TypeBool, Nullable: true},
+		{Name: "block_public_policy", Type: field.TypeBool, Nullable: true},
+		{Name: "restrict_public_buckets", Type: field.TypeBool, Nullable: true},
+		{Name: "policy_is_public", Type: field.TypeBool, Nullable: true},
+		{Name: "sse_algorithm", Type: field.TypeString, Nullable: true},
+		{Name: "kms_master_key_id", Type: field.TypeString, Nullable: true},
+		{Name: "bucket_key_enabled", Type: field.TypeBool, Nullable: true},
+		{Name: "versioning_status", Type: field.TypeString, Nullable: true},
+		{Name: "mfa_delete", Type: field.TypeString, Nullable: true},
+		{Name: "logging_target_bucket", Type: field.TypeString, Nullable: true},
+		{Name: "logging_target_prefix", Type: field.TypeString, Nullable: true},
+		{Name: "policy_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "acl_grants_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "account_id", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString},
+	}
+	// AWSS3BucketsHistoryTable holds the schema information for the "aws_s3_buckets_history" table.
+	AWSS3BucketsHistoryTable = &schema.Table{
+		Name:       "aws_s3_buckets_history",
+		Columns:    AWSS3BucketsHistoryColumns,
+		PrimaryKey: []*schema.Column{AWSS3BucketsHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryawss3bucket_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{AWSS3BucketsHistoryColumns[5], AWSS3BucketsHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryawss3bucket_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{AWSS3BucketsHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryawss3bucket_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{AWSS3BucketsHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryawss3bucket_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{AWSS3BucketsHistoryColumns[24]},
+			},
+			{
+				Name:    "bronzehistoryawss3bucket_region",
+				Unique:  false,
+				Columns: []*schema.Column{AWSS3BucketsHistoryColumns[25]},
+			},
+		},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		AWSS3BucketsTable,
+		AWSS3BucketsHistoryTable,
+	}
+)
+
+func init() {
+	AWSS3BucketsTable.Annotation = &entsql.Annotation{
+		Table: "aws_s3_buckets",
+	}
+	AWSS3BucketsHistoryTable.Annotation = &entsql.Annotation{
+		Table: "aws_s3_buckets_history",
+	}
+}
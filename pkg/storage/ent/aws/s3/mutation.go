@@ -0,0 +1,3936 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/bronzeawss3bucket"
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/bronzehistoryawss3bucket"
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/predicate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Operation types.
+	OpCreate    = ent.OpCreate
+	OpDelete    = ent.OpDelete
+	OpDeleteOne = ent.OpDeleteOne
+	OpUpdate    = ent.OpUpdate
+	OpUpdateOne = ent.OpUpdateOne
+
+	// Node types.
+	TypeBronzeAWSS3Bucket        = "BronzeAWSS3Bucket"
+	TypeBronzeHistoryAWSS3Bucket = "BronzeHistoryAWSS3Bucket"
+)
+
+// BronzeAWSS3BucketMutation represents an operation that mutates the BronzeAWSS3Bucket nodes in the graph.
+type BronzeAWSS3BucketMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *string
+	collected_at            *time.Time
+	first_collected_at      *time.Time
+	arn                     *string
+	creation_date           *time.Time
+	owner_id                *string
+	block_public_acls       *bool
+	ignore_public_acls      *bool
+	block_public_policy     *bool
+	restrict_public_buckets *bool
+	policy_is_public        *bool
+	sse_algorithm           *string
+	kms_master_key_id       *string
+	bucket_key_enabled      *bool
+	versioning_status       *string
+	mfa_delete              *string
+	logging_target_bucket   *string
+	logging_target_prefix   *string
+	policy_json             *json.RawMessage
+	appendpolicy_json       json.RawMessage
+	acl_grants_json         *json.RawMessage
+	appendacl_grants_json   json.RawMessage
+	tags_json               *json.RawMessage
+	appendtags_json         json.RawMessage
+	account_id              *string
+	region                  *string
+	clearedFields           map[string]struct{}
+	done                    bool
+	oldValue                func(context.Context) (*BronzeAWSS3Bucket, error)
+	predicates              []predicate.BronzeAWSS3Bucket
+}
+
+var _ ent.Mutation = (*BronzeAWSS3BucketMutation)(nil)
+
+// bronzeawss3bucketOption allows management of the mutation configuration using functional options.
+type bronzeawss3bucketOption func(*BronzeAWSS3BucketMutation)
+
+// newBronzeAWSS3BucketMutation creates new mutation for the BronzeAWSS3Bucket entity.
+func newBronzeAWSS3BucketMutation(c config, op Op, opts ...bronzeawss3bucketOption) *BronzeAWSS3BucketMutation {
+	m := &BronzeAWSS3BucketMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeAWSS3Bucket,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeAWSS3BucketID sets the ID field of the mutation.
+func withBronzeAWSS3BucketID(id string) bronzeawss3bucketOption {
+	return func(m *BronzeAWSS3BucketMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeAWSS3Bucket
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeAWSS3Bucket, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeAWSS3Bucket.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeAWSS3Bucket sets the old BronzeAWSS3Bucket of the mutation.
+func withBronzeAWSS3Bucket(node *BronzeAWSS3Bucket) bronzeawss3bucketOption {
+	return func(m *BronzeAWSS3BucketMutation) {
+		m.oldValue = func(context.Context) (*BronzeAWSS3Bucket, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeAWSS3BucketMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeAWSS3BucketMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("s3: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeAWSS3Bucket entities.
+func (m *BronzeAWSS3BucketMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeAWSS3BucketMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeAWSS3BucketMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeAWSS3Bucket.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeAWSS3BucketMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeAWSS3BucketMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeAWSS3BucketMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeAWSS3BucketMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetArn sets the "arn" field.
+func (m *BronzeAWSS3BucketMutation) SetArn(s string) {
+	m.arn = &s
+}
+
+// Arn returns the value of the "arn" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) Arn() (r string, exists bool) {
+	v := m.arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArn returns the old "arn" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArn: %w", err)
+	}
+	return oldValue.Arn, nil
+}
+
+// ClearArn clears the value of the "arn" field.
+func (m *BronzeAWSS3BucketMutation) ClearArn() {
+	m.arn = nil
+	m.clearedFields[bronzeawss3bucket.FieldArn] = struct{}{}
+}
+
+// ArnCleared returns if the "arn" field was cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) ArnCleared() bool {
+	_, ok := m.clearedFields[bronzeawss3bucket.FieldArn]
+	return ok
+}
+
+// ResetArn resets all changes to the "arn" field.
+func (m *BronzeAWSS3BucketMutation) ResetArn() {
+	m.arn = nil
+	delete(m.clearedFields, bronzeawss3bucket.FieldArn)
+}
+
+// SetCreationDate sets the "creation_date" field.
+func (m *BronzeAWSS3BucketMutation) SetCreationDate(t time.Time) {
+	m.creation_date = &t
+}
+
+// CreationDate returns the value of the "creation_date" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) CreationDate() (r time.Time, exists bool) {
+	v := m.creation_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreationDate returns the old "creation_date" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldCreationDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreationDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreationDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreationDate: %w", err)
+	}
+	return oldValue.CreationDate, nil
+}
+
+// ClearCreationDate clears the value of the "creation_date" field.
+func (m *BronzeAWSS3BucketMutation) ClearCreationDate() {
+	m.creation_date = nil
+	m.clearedFields[bronzeawss3bucket.FieldCreationDate] = struct{}{}
+}
+
+// CreationDateCleared returns if the "creation_date" field was cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) CreationDateCleared() bool {
+	_, ok := m.clearedFields[bronzeawss3bucket.FieldCreationDate]
+	return ok
+}
+
+// ResetCreationDate resets all changes to the "creation_date" field.
+func (m *BronzeAWSS3BucketMutation) ResetCreationDate() {
+	m.creation_date = nil
+	delete(m.clearedFields, bronzeawss3bucket.FieldCreationDate)
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *BronzeAWSS3BucketMutation) SetOwnerID(s string) {
+	m.owner_id = &s
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) OwnerID() (r string, exists bool) {
+	v := m.owner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldOwnerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (m *BronzeAWSS3BucketMutation) ClearOwnerID() {
+	m.owner_id = nil
+	m.clearedFields[bronzeawss3bucket.FieldOwnerID] = struct{}{}
+}
+
+// OwnerIDCleared returns if the "owner_id" field was cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) OwnerIDCleared() bool {
+	_, ok := m.clearedFields[bronzeawss3bucket.FieldOwnerID]
+	return ok
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *BronzeAWSS3BucketMutation) ResetOwnerID() {
+	m.owner_id = nil
+	delete(m.clearedFields, bronzeawss3bucket.FieldOwnerID)
+}
+
+// SetBlockPublicAcls sets the "block_public_acls" field.
+func (m *BronzeAWSS3BucketMutation) SetBlockPublicAcls(b bool) {
+	m.block_public_acls = &b
+}
+
+// BlockPublicAcls returns the value of the "block_public_acls" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) BlockPublicAcls() (r bool, exists bool) {
+	v := m.block_public_acls
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockPublicAcls returns the old "block_public_acls" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldBlockPublicAcls(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockPublicAcls is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockPublicAcls requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockPublicAcls: %w", err)
+	}
+	return oldValue.BlockPublicAcls, nil
+}
+
+// ClearBlockPublicAcls clears the value of the "block_public_acls" field.
+func (m *BronzeAWSS3BucketMutation) ClearBlockPublicAcls() {
+	m.block_public_acls = nil
+	m.clearedFields[bronzeawss3bucket.FieldBlockPublicAcls] = struct{}{}
+}
+
+// BlockPublicAclsCleared returns if the "block_public_acls" field was cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) BlockPublicAclsCleared() bool {
+	_, ok := m.clearedFields[bronzeawss3bucket.FieldBlockPublicAcls]
+	return ok
+}
+
+// ResetBlockPublicAcls resets all changes to the "block_public_acls" field.
+func (m *BronzeAWSS3BucketMutation) ResetBlockPublicAcls() {
+	m.block_public_acls = nil
+	delete(m.clearedFields, bronzeawss3bucket.FieldBlockPublicAcls)
+}
+
+// SetIgnorePublicAcls sets the "ignore_public_acls" field.
+func (m *BronzeAWSS3BucketMutation) SetIgnorePublicAcls(b bool) {
+	m.ignore_public_acls = &b
+}
+
+// IgnorePublicAcls returns the value of the "ignore_public_acls" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) IgnorePublicAcls() (r bool, exists bool) {
+	v := m.ignore_public_acls
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIgnorePublicAcls returns the old "ignore_public_acls" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldIgnorePublicAcls(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIgnorePublicAcls is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIgnorePublicAcls requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIgnorePublicAcls: %w", err)
+	}
+	return oldValue.IgnorePublicAcls, nil
+}
+
+// ClearIgnorePublicAcls clears the value of the "ignore_public_acls" field.
+func (m *BronzeAWSS3BucketMutation) ClearIgnorePublicAcls() {
+	m.ignore_public_acls = nil
+	m.clearedFields[bronzeawss3bucket.FieldIgnorePublicAcls] = struct{}{}
+}
+
+// IgnorePublicAclsCleared returns if the "ignore_public_acls" field was cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) IgnorePublicAclsCleared() bool {
+	_, ok := m.clearedFields[bronzeawss3bucket.FieldIgnorePublicAcls]
+	return ok
+}
+
+// ResetIgnorePublicAcls resets all changes to the "ignore_public_acls" field.
+func (m *BronzeAWSS3BucketMutation) ResetIgnorePublicAcls() {
+	m.ignore_public_acls = nil
+	delete(m.clearedFields, bronzeawss3bucket.FieldIgnorePublicAcls)
+}
+
+// SetBlockPublicPolicy sets the "block_public_policy" field.
+func (m *BronzeAWSS3BucketMutation) SetBlockPublicPolicy(b bool) {
+	m.block_public_policy = &b
+}
+
+// BlockPublicPolicy returns the value of the "block_public_policy" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) BlockPublicPolicy() (r bool, exists bool) {
+	v := m.block_public_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockPublicPolicy returns the old "block_public_policy" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldBlockPublicPolicy(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockPublicPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockPublicPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockPublicPolicy: %w", err)
+	}
+	return oldValue.BlockPublicPolicy, nil
+}
+
+// ClearBlockPublicPolicy clears the value of the "block_public_policy" field.
+func (m *BronzeAWSS3BucketMutation) ClearBlockPublicPolicy() {
+	m.block_public_policy = nil
+	m.clearedFields[bronzeawss3bucket.FieldBlockPublicPolicy] = struct{}{}
+}
+
+// BlockPublicPolicyCleared returns if the "block_public_policy" field was cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) BlockPublicPolicyCleared() bool {
+	_, ok := m.clearedFields[bronzeawss3bucket.FieldBlockPublicPolicy]
+	return ok
+}
+
+// ResetBlockPublicPolicy resets all changes to the "block_public_policy" field.
+func (m *BronzeAWSS3BucketMutation) ResetBlockPublicPolicy() {
+	m.block_public_policy = nil
+	delete(m.clearedFields, bronzeawss3bucket.FieldBlockPublicPolicy)
+}
+
+// SetRestrictPublicBuckets sets the "restrict_public_buckets" field.
+func (m *BronzeAWSS3BucketMutation) SetRestrictPublicBuckets(b bool) {
+	m.restrict_public_buckets = &b
+}
+
+// RestrictPublicBuckets returns the value of the "restrict_public_buckets" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) RestrictPublicBuckets() (r bool, exists bool) {
+	v := m.restrict_public_buckets
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRestrictPublicBuckets returns the old "restrict_public_buckets" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldRestrictPublicBuckets(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRestrictPublicBuckets is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRestrictPublicBuckets requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRestrictPublicBuckets: %w", err)
+	}
+	return oldValue.RestrictPublicBuckets, nil
+}
+
+// ClearRestrictPublicBuckets clears the value of the "restrict_public_buckets" field.
+func (m *BronzeAWSS3BucketMutation) ClearRestrictPublicBuckets() {
+	m.restrict_public_buckets = nil
+	m.clearedFields[bronzeawss3bucket.FieldRestrictPublicBuckets] = struct{}{}
+}
+
+// RestrictPublicBucketsCleared returns if the "restrict_public_buckets" field was cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) RestrictPublicBucketsCleared() bool {
+	_, ok := m.clearedFields[bronzeawss3bucket.FieldRestrictPublicBuckets]
+	return ok
+}
+
+// ResetRestrictPublicBuckets resets all changes to the "restrict_public_buckets" field.
+func (m *BronzeAWSS3BucketMutation) ResetRestrictPublicBuckets() {
+	m.restrict_public_buckets = nil
+	delete(m.clearedFields, bronzeawss3bucket.FieldRestrictPublicBuckets)
+}
+
+// SetPolicyIsPublic sets the "policy_is_public" field.
+func (m *BronzeAWSS3BucketMutation) SetPolicyIsPublic(b bool) {
+	m.policy_is_public = &b
+}
+
+// PolicyIsPublic returns the value of the "policy_is_public" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) PolicyIsPublic() (r bool, exists bool) {
+	v := m.policy_is_public
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolicyIsPublic returns the old "policy_is_public" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldPolicyIsPublic(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicyIsPublic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicyIsPublic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicyIsPublic: %w", err)
+	}
+	return oldValue.PolicyIsPublic, nil
+}
+
+// ClearPolicyIsPublic clears the value of the "policy_is_public" field.
+func (m *BronzeAWSS3BucketMutation) ClearPolicyIsPublic() {
+	m.policy_is_public = nil
+	m.clearedFields[bronzeawss3bucket.FieldPolicyIsPublic] = struct{}{}
+}
+
+// PolicyIsPublicCleared returns if the "policy_is_public" field was cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) PolicyIsPublicCleared() bool {
+	_, ok := m.clearedFields[bronzeawss3bucket.FieldPolicyIsPublic]
+	return ok
+}
+
+// ResetPolicyIsPublic resets all changes to the "policy_is_public" field.
+func (m *BronzeAWSS3BucketMutation) ResetPolicyIsPublic() {
+	m.policy_is_public = nil
+	delete(m.clearedFields, bronzeawss3bucket.FieldPolicyIsPublic)
+}
+
+// SetSseAlgorithm sets the "sse_algorithm" field.
+func (m *BronzeAWSS3BucketMutation) SetSseAlgorithm(s string) {
+	m.sse_algorithm = &s
+}
+
+// SseAlgorithm returns the value of the "sse_algorithm" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) SseAlgorithm() (r string, exists bool) {
+	v := m.sse_algorithm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSseAlgorithm returns the old "sse_algorithm" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldSseAlgorithm(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSseAlgorithm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSseAlgorithm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSseAlgorithm: %w", err)
+	}
+	return oldValue.SseAlgorithm, nil
+}
+
+// ClearSseAlgorithm clears the value of the "sse_algorithm" field.
+func (m *BronzeAWSS3BucketMutation) ClearSseAlgorithm() {
+	m.sse_algorithm = nil
+	m.clearedFields[bronzeawss3bucket.FieldSseAlgorithm] = struct{}{}
+}
+
+// SseAlgorithmCleared returns if the "sse_algorithm" field was cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) SseAlgorithmCleared() bool {
+	_, ok := m.clearedFields[bronzeawss3bucket.FieldSseAlgorithm]
+	return ok
+}
+
+// ResetSseAlgorithm resets all changes to the "sse_algorithm" field.
+func (m *BronzeAWSS3BucketMutation) ResetSseAlgorithm() {
+	m.sse_algorithm = nil
+	delete(m.clearedFields, bronzeawss3bucket.FieldSseAlgorithm)
+}
+
+// SetKmsMasterKeyID sets the "kms_master_key_id" field.
+func (m *BronzeAWSS3BucketMutation) SetKmsMasterKeyID(s string) {
+	m.kms_master_key_id = &s
+}
+
+// KmsMasterKeyID returns the value of the "kms_master_key_id" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) KmsMasterKeyID() (r string, exists bool) {
+	v := m.kms_master_key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKmsMasterKeyID returns the old "kms_master_key_id" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldKmsMasterKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKmsMasterKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKmsMasterKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKmsMasterKeyID: %w", err)
+	}
+	return oldValue.KmsMasterKeyID, nil
+}
+
+// ClearKmsMasterKeyID clears the value of the "kms_master_key_id" field.
+func (m *BronzeAWSS3BucketMutation) ClearKmsMasterKeyID() {
+	m.kms_master_key_id = nil
+	m.clearedFields[bronzeawss3bucket.FieldKmsMasterKeyID] = struct{}{}
+}
+
+// KmsMasterKeyIDCleared returns if the "kms_master_key_id" field was cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) KmsMasterKeyIDCleared() bool {
+	_, ok := m.clearedFields[bronzeawss3bucket.FieldKmsMasterKeyID]
+	return ok
+}
+
+// ResetKmsMasterKeyID resets all changes to the "kms_master_key_id" field.
+func (m *BronzeAWSS3BucketMutation) ResetKmsMasterKeyID() {
+	m.kms_master_key_id = nil
+	delete(m.clearedFields, bronzeawss3bucket.FieldKmsMasterKeyID)
+}
+
+// SetBucketKeyEnabled sets the "bucket_key_enabled" field.
+func (m *BronzeAWSS3BucketMutation) SetBucketKeyEnabled(b bool) {
+	m.bucket_key_enabled = &b
+}
+
+// BucketKeyEnabled returns the value of the "bucket_key_enabled" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) BucketKeyEnabled() (r bool, exists bool) {
+	v := m.bucket_key_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBucketKeyEnabled returns the old "bucket_key_enabled" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldBucketKeyEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBucketKeyEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBucketKeyEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBucketKeyEnabled: %w", err)
+	}
+	return oldValue.BucketKeyEnabled, nil
+}
+
+// ClearBucketKeyEnabled clears the value of the "bucket_key_enabled" field.
+func (m *BronzeAWSS3BucketMutation) ClearBucketKeyEnabled() {
+	m.bucket_key_enabled = nil
+	m.clearedFields[bronzeawss3bucket.FieldBucketKeyEnabled] = struct{}{}
+}
+
+// BucketKeyEnabledCleared returns if the "bucket_key_enabled" field was cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) BucketKeyEnabledCleared() bool {
+	_, ok := m.clearedFields[bronzeawss3bucket.FieldBucketKeyEnabled]
+	return ok
+}
+
+// ResetBucketKeyEnabled resets all changes to the "bucket_key_enabled" field.
+func (m *BronzeAWSS3BucketMutation) ResetBucketKeyEnabled() {
+	m.bucket_key_enabled = nil
+	delete(m.clearedFields, bronzeawss3bucket.FieldBucketKeyEnabled)
+}
+
+// SetVersioningStatus sets the "versioning_status" field.
+func (m *BronzeAWSS3BucketMutation) SetVersioningStatus(s string) {
+	m.versioning_status = &s
+}
+
+// VersioningStatus returns the value of the "versioning_status" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) VersioningStatus() (r string, exists bool) {
+	v := m.versioning_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersioningStatus returns the old "versioning_status" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldVersioningStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersioningStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersioningStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersioningStatus: %w", err)
+	}
+	return oldValue.VersioningStatus, nil
+}
+
+// ClearVersioningStatus clears the value of the "versioning_status" field.
+func (m *BronzeAWSS3BucketMutation) ClearVersioningStatus() {
+	m.versioning_status = nil
+	m.clearedFields[bronzeawss3bucket.FieldVersioningStatus] = struct{}{}
+}
+
+// VersioningStatusCleared returns if the "versioning_status" field was cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) VersioningStatusCleared() bool {
+	_, ok := m.clearedFields[bronzeawss3bucket.FieldVersioningStatus]
+	return ok
+}
+
+// ResetVersioningStatus resets all changes to the "versioning_status" field.
+func (m *BronzeAWSS3BucketMutation) ResetVersioningStatus() {
+	m.versioning_status = nil
+	delete(m.clearedFields, bronzeawss3bucket.FieldVersioningStatus)
+}
+
+// SetMfaDelete sets the "mfa_delete" field.
+func (m *BronzeAWSS3BucketMutation) SetMfaDelete(s string) {
+	m.mfa_delete = &s
+}
+
+// MfaDelete returns the value of the "mfa_delete" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) MfaDelete() (r string, exists bool) {
+	v := m.mfa_delete
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMfaDelete returns the old "mfa_delete" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldMfaDelete(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMfaDelete is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMfaDelete requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMfaDelete: %w", err)
+	}
+	return oldValue.MfaDelete, nil
+}
+
+// ClearMfaDelete clears the value of the "mfa_delete" field.
+func (m *BronzeAWSS3BucketMutation) ClearMfaDelete() {
+	m.mfa_delete = nil
+	m.clearedFields[bronzeawss3bucket.FieldMfaDelete] = struct{}{}
+}
+
+// MfaDeleteCleared returns if the "mfa_delete" field was cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) MfaDeleteCleared() bool {
+	_, ok := m.clearedFields[bronzeawss3bucket.FieldMfaDelete]
+	return ok
+}
+
+// ResetMfaDelete resets all changes to the "mfa_delete" field.
+func (m *BronzeAWSS3BucketMutation) ResetMfaDelete() {
+	m.mfa_delete = nil
+	delete(m.clearedFields, bronzeawss3bucket.FieldMfaDelete)
+}
+
+// SetLoggingTargetBucket sets the "logging_target_bucket" field.
+func (m *BronzeAWSS3BucketMutation) SetLoggingTargetBucket(s string) {
+	m.logging_target_bucket = &s
+}
+
+// LoggingTargetBucket returns the value of the "logging_target_bucket" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) LoggingTargetBucket() (r string, exists bool) {
+	v := m.logging_target_bucket
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoggingTargetBucket returns the old "logging_target_bucket" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldLoggingTargetBucket(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoggingTargetBucket is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoggingTargetBucket requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoggingTargetBucket: %w", err)
+	}
+	return oldValue.LoggingTargetBucket, nil
+}
+
+// ClearLoggingTargetBucket clears the value of the "logging_target_bucket" field.
+func (m *BronzeAWSS3BucketMutation) ClearLoggingTargetBucket() {
+	m.logging_target_bucket = nil
+	m.clearedFields[bronzeawss3bucket.FieldLoggingTargetBucket] = struct{}{}
+}
+
+// LoggingTargetBucketCleared returns if the "logging_target_bucket" field was cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) LoggingTargetBucketCleared() bool {
+	_, ok := m.clearedFields[bronzeawss3bucket.FieldLoggingTargetBucket]
+	return ok
+}
+
+// ResetLoggingTargetBucket resets all changes to the "logging_target_bucket" field.
+func (m *BronzeAWSS3BucketMutation) ResetLoggingTargetBucket() {
+	m.logging_target_bucket = nil
+	delete(m.clearedFields, bronzeawss3bucket.FieldLoggingTargetBucket)
+}
+
+// SetLoggingTargetPrefix sets the "logging_target_prefix" field.
+func (m *BronzeAWSS3BucketMutation) SetLoggingTargetPrefix(s string) {
+	m.logging_target_prefix = &s
+}
+
+// LoggingTargetPrefix returns the value of the "logging_target_prefix" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) LoggingTargetPrefix() (r string, exists bool) {
+	v := m.logging_target_prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoggingTargetPrefix returns the old "logging_target_prefix" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldLoggingTargetPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoggingTargetPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoggingTargetPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoggingTargetPrefix: %w", err)
+	}
+	return oldValue.LoggingTargetPrefix, nil
+}
+
+// ClearLoggingTargetPrefix clears the value of the "logging_target_prefix" field.
+func (m *BronzeAWSS3BucketMutation) ClearLoggingTargetPrefix() {
+	m.logging_target_prefix = nil
+	m.clearedFields[bronzeawss3bucket.FieldLoggingTargetPrefix] = struct{}{}
+}
+
+// LoggingTargetPrefixCleared returns if the "logging_target_prefix" field was cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) LoggingTargetPrefixCleared() bool {
+	_, ok := m.clearedFields[bronzeawss3bucket.FieldLoggingTargetPrefix]
+	return ok
+}
+
+// ResetLoggingTargetPrefix resets all changes to the "logging_target_prefix" field.
+func (m *BronzeAWSS3BucketMutation) ResetLoggingTargetPrefix() {
+	m.logging_target_prefix = nil
+	delete(m.clearedFields, bronzeawss3bucket.FieldLoggingTargetPrefix)
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (m *BronzeAWSS3BucketMutation) SetPolicyJSON(jm json.RawMessage) {
+	m.policy_json = &jm
+	m.appendpolicy_json = nil
+}
+
+// PolicyJSON returns the value of the "policy_json" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) PolicyJSON() (r json.RawMessage, exists bool) {
+	v := m.policy_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolicyJSON returns the old "policy_json" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldPolicyJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicyJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicyJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicyJSON: %w", err)
+	}
+	return oldValue.PolicyJSON, nil
+}
+
+// AppendPolicyJSON adds jm to the "policy_json" field.
+func (m *BronzeAWSS3BucketMutation) AppendPolicyJSON(jm json.RawMessage) {
+	m.appendpolicy_json = append(m.appendpolicy_json, jm...)
+}
+
+// AppendedPolicyJSON returns the list of values that were appended to the "policy_json" field in this mutation.
+func (m *BronzeAWSS3BucketMutation) AppendedPolicyJSON() (json.RawMessage, bool) {
+	if len(m.appendpolicy_json) == 0 {
+		return nil, false
+	}
+	return m.appendpolicy_json, true
+}
+
+// ClearPolicyJSON clears the value of the "policy_json" field.
+func (m *BronzeAWSS3BucketMutation) ClearPolicyJSON() {
+	m.policy_json = nil
+	m.appendpolicy_json = nil
+	m.clearedFields[bronzeawss3bucket.FieldPolicyJSON] = struct{}{}
+}
+
+// PolicyJSONCleared returns if the "policy_json" field was cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) PolicyJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawss3bucket.FieldPolicyJSON]
+	return ok
+}
+
+// ResetPolicyJSON resets all changes to the "policy_json" field.
+func (m *BronzeAWSS3BucketMutation) ResetPolicyJSON() {
+	m.policy_json = nil
+	m.appendpolicy_json = nil
+	delete(m.clearedFields, bronzeawss3bucket.FieldPolicyJSON)
+}
+
+// SetACLGrantsJSON sets the "acl_grants_json" field.
+func (m *BronzeAWSS3BucketMutation) SetACLGrantsJSON(jm json.RawMessage) {
+	m.acl_grants_json = &jm
+	m.appendacl_grants_json = nil
+}
+
+// ACLGrantsJSON returns the value of the "acl_grants_json" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) ACLGrantsJSON() (r json.RawMessage, exists bool) {
+	v := m.acl_grants_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldACLGrantsJSON returns the old "acl_grants_json" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldACLGrantsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldACLGrantsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldACLGrantsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldACLGrantsJSON: %w", err)
+	}
+	return oldValue.ACLGrantsJSON, nil
+}
+
+// AppendACLGrantsJSON adds jm to the "acl_grants_json" field.
+func (m *BronzeAWSS3BucketMutation) AppendACLGrantsJSON(jm json.RawMessage) {
+	m.appendacl_grants_json = append(m.appendacl_grants_json, jm...)
+}
+
+// AppendedACLGrantsJSON returns the list of values that were appended to the "acl_grants_json" field in this mutation.
+func (m *BronzeAWSS3BucketMutation) AppendedACLGrantsJSON() (json.RawMessage, bool) {
+	if len(m.appendacl_grants_json) == 0 {
+		return nil, false
+	}
+	return m.appendacl_grants_json, true
+}
+
+// ClearACLGrantsJSON clears the value of the "acl_grants_json" field.
+func (m *BronzeAWSS3BucketMutation) ClearACLGrantsJSON() {
+	m.acl_grants_json = nil
+	m.appendacl_grants_json = nil
+	m.clearedFields[bronzeawss3bucket.FieldACLGrantsJSON] = struct{}{}
+}
+
+// ACLGrantsJSONCleared returns if the "acl_grants_json" field was cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) ACLGrantsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawss3bucket.FieldACLGrantsJSON]
+	return ok
+}
+
+// ResetACLGrantsJSON resets all changes to the "acl_grants_json" field.
+func (m *BronzeAWSS3BucketMutation) ResetACLGrantsJSON() {
+	m.acl_grants_json = nil
+	m.appendacl_grants_json = nil
+	delete(m.clearedFields, bronzeawss3bucket.FieldACLGrantsJSON)
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (m *BronzeAWSS3BucketMutation) SetTagsJSON(jm json.RawMessage) {
+	m.tags_json = &jm
+	m.appendtags_json = nil
+}
+
+// TagsJSON returns the value of the "tags_json" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) TagsJSON() (r json.RawMessage, exists bool) {
+	v := m.tags_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagsJSON returns the old "tags_json" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldTagsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagsJSON: %w", err)
+	}
+	return oldValue.TagsJSON, nil
+}
+
+// AppendTagsJSON adds jm to the "tags_json" field.
+func (m *BronzeAWSS3BucketMutation) AppendTagsJSON(jm json.RawMessage) {
+	m.appendtags_json = append(m.appendtags_json, jm...)
+}
+
+// AppendedTagsJSON returns the list of values that were appended to the "tags_json" field in this mutation.
+func (m *BronzeAWSS3BucketMutation) AppendedTagsJSON() (json.RawMessage, bool) {
+	if len(m.appendtags_json) == 0 {
+		return nil, false
+	}
+	return m.appendtags_json, true
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (m *BronzeAWSS3BucketMutation) ClearTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	m.clearedFields[bronzeawss3bucket.FieldTagsJSON] = struct{}{}
+}
+
+// TagsJSONCleared returns if the "tags_json" field was cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) TagsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzeawss3bucket.FieldTagsJSON]
+	return ok
+}
+
+// ResetTagsJSON resets all changes to the "tags_json" field.
+func (m *BronzeAWSS3BucketMutation) ResetTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	delete(m.clearedFields, bronzeawss3bucket.FieldTagsJSON)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BronzeAWSS3BucketMutation) SetAccountID(s string) {
+	m.account_id = &s
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) AccountID() (r string, exists bool) {
+	v := m.account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BronzeAWSS3BucketMutation) ResetAccountID() {
+	m.account_id = nil
+}
+
+// SetRegion sets the "region" field.
+func (m *BronzeAWSS3BucketMutation) SetRegion(s string) {
+	m.region = &s
+}
+
+// Region returns the value of the "region" field in the mutation.
+func (m *BronzeAWSS3BucketMutation) Region() (r string, exists bool) {
+	v := m.region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegion returns the old "region" field's value of the BronzeAWSS3Bucket entity.
+// If the BronzeAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeAWSS3BucketMutation) OldRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
+	}
+	return oldValue.Region, nil
+}
+
+// ResetRegion resets all changes to the "region" field.
+func (m *BronzeAWSS3BucketMutation) ResetRegion() {
+	m.region = nil
+}
+
+// Where appends a list predicates to the BronzeAWSS3BucketMutation builder.
+func (m *BronzeAWSS3BucketMutation) Where(ps ...predicate.BronzeAWSS3Bucket) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeAWSS3BucketMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeAWSS3BucketMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeAWSS3Bucket, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeAWSS3BucketMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeAWSS3BucketMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeAWSS3Bucket).
+func (m *BronzeAWSS3BucketMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeAWSS3BucketMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.collected_at != nil {
+		fields = append(fields, bronzeawss3bucket.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzeawss3bucket.FieldFirstCollectedAt)
+	}
+	if m.arn != nil {
+		fields = append(fields, bronzeawss3bucket.FieldArn)
+	}
+	if m.creation_date != nil {
+		fields = append(fields, bronzeawss3bucket.FieldCreationDate)
+	}
+	if m.owner_id != nil {
+		fields = append(fields, bronzeawss3bucket.FieldOwnerID)
+	}
+	if m.block_public_acls != nil {
+		fields = append(fields, bronzeawss3bucket.FieldBlockPublicAcls)
+	}
+	if m.ignore_public_acls != nil {
+		fields = append(fields, bronzeawss3bucket.FieldIgnorePublicAcls)
+	}
+	if m.block_public_policy != nil {
+		fields = append(fields, bronzeawss3bucket.FieldBlockPublicPolicy)
+	}
+	if m.restrict_public_buckets != nil {
+		fields = append(fields, bronzeawss3bucket.FieldRestrictPublicBuckets)
+	}
+	if m.policy_is_public != nil {
+		fields = append(fields, bronzeawss3bucket.FieldPolicyIsPublic)
+	}
+	if m.sse_algorithm != nil {
+		fields = append(fields, bronzeawss3bucket.FieldSseAlgorithm)
+	}
+	if m.kms_master_key_id != nil {
+		fields = append(fields, bronzeawss3bucket.FieldKmsMasterKeyID)
+	}
+	if m.bucket_key_enabled != nil {
+		fields = append(fields, bronzeawss3bucket.FieldBucketKeyEnabled)
+	}
+	if m.versioning_status != nil {
+		fields = append(fields, bronzeawss3bucket.FieldVersioningStatus)
+	}
+	if m.mfa_delete != nil {
+		fields = append(fields, bronzeawss3bucket.FieldMfaDelete)
+	}
+	if m.logging_target_bucket != nil {
+		fields = append(fields, bronzeawss3bucket.FieldLoggingTargetBucket)
+	}
+	if m.logging_target_prefix != nil {
+		fields = append(fields, bronzeawss3bucket.FieldLoggingTargetPrefix)
+	}
+	if m.policy_json != nil {
+		fields = append(fields, bronzeawss3bucket.FieldPolicyJSON)
+	}
+	if m.acl_grants_json != nil {
+		fields = append(fields, bronzeawss3bucket.FieldACLGrantsJSON)
+	}
+	if m.tags_json != nil {
+		fields = append(fields, bronzeawss3bucket.FieldTagsJSON)
+	}
+	if m.account_id != nil {
+		fields = append(fields, bronzeawss3bucket.FieldAccountID)
+	}
+	if m.region != nil {
+		fields = append(fields, bronzeawss3bucket.FieldRegion)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeAWSS3BucketMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzeawss3bucket.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzeawss3bucket.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzeawss3bucket.FieldArn:
+		return m.Arn()
+	case bronzeawss3bucket.FieldCreationDate:
+		return m.CreationDate()
+	case bronzeawss3bucket.FieldOwnerID:
+		return m.OwnerID()
+	case bronzeawss3bucket.FieldBlockPublicAcls:
+		return m.BlockPublicAcls()
+	case bronzeawss3bucket.FieldIgnorePublicAcls:
+		return m.IgnorePublicAcls()
+	case bronzeawss3bucket.FieldBlockPublicPolicy:
+		return m.BlockPublicPolicy()
+	case bronzeawss3bucket.FieldRestrictPublicBuckets:
+		return m.RestrictPublicBuckets()
+	case bronzeawss3bucket.FieldPolicyIsPublic:
+		return m.PolicyIsPublic()
+	case bronzeawss3bucket.FieldSseAlgorithm:
+		return m.SseAlgorithm()
+	case bronzeawss3bucket.FieldKmsMasterKeyID:
+		return m.KmsMasterKeyID()
+	case bronzeawss3bucket.FieldBucketKeyEnabled:
+		return m.BucketKeyEnabled()
+	case bronzeawss3bucket.FieldVersioningStatus:
+		return m.VersioningStatus()
+	case bronzeawss3bucket.FieldMfaDelete:
+		return m.MfaDelete()
+	case bronzeawss3bucket.FieldLoggingTargetBucket:
+		return m.LoggingTargetBucket()
+	case bronzeawss3bucket.FieldLoggingTargetPrefix:
+		return m.LoggingTargetPrefix()
+	case bronzeawss3bucket.FieldPolicyJSON:
+		return m.PolicyJSON()
+	case bronzeawss3bucket.FieldACLGrantsJSON:
+		return m.ACLGrantsJSON()
+	case bronzeawss3bucket.FieldTagsJSON:
+		return m.TagsJSON()
+	case bronzeawss3bucket.FieldAccountID:
+		return m.AccountID()
+	case bronzeawss3bucket.FieldRegion:
+		return m.Region()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeAWSS3BucketMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzeawss3bucket.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzeawss3bucket.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzeawss3bucket.FieldArn:
+		return m.OldArn(ctx)
+	case bronzeawss3bucket.FieldCreationDate:
+		return m.OldCreationDate(ctx)
+	case bronzeawss3bucket.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case bronzeawss3bucket.FieldBlockPublicAcls:
+		return m.OldBlockPublicAcls(ctx)
+	case bronzeawss3bucket.FieldIgnorePublicAcls:
+		return m.OldIgnorePublicAcls(ctx)
+	case bronzeawss3bucket.FieldBlockPublicPolicy:
+		return m.OldBlockPublicPolicy(ctx)
+	case bronzeawss3bucket.FieldRestrictPublicBuckets:
+		return m.OldRestrictPublicBuckets(ctx)
+	case bronzeawss3bucket.FieldPolicyIsPublic:
+		return m.OldPolicyIsPublic(ctx)
+	case bronzeawss3bucket.FieldSseAlgorithm:
+		return m.OldSseAlgorithm(ctx)
+	case bronzeawss3bucket.FieldKmsMasterKeyID:
+		return m.OldKmsMasterKeyID(ctx)
+	case bronzeawss3bucket.FieldBucketKeyEnabled:
+		return m.OldBucketKeyEnabled(ctx)
+	case bronzeawss3bucket.FieldVersioningStatus:
+		return m.OldVersioningStatus(ctx)
+	case bronzeawss3bucket.FieldMfaDelete:
+		return m.OldMfaDelete(ctx)
+	case bronzeawss3bucket.FieldLoggingTargetBucket:
+		return m.OldLoggingTargetBucket(ctx)
+	case bronzeawss3bucket.FieldLoggingTargetPrefix:
+		return m.OldLoggingTargetPrefix(ctx)
+	case bronzeawss3bucket.FieldPolicyJSON:
+		return m.OldPolicyJSON(ctx)
+	case bronzeawss3bucket.FieldACLGrantsJSON:
+		return m.OldACLGrantsJSON(ctx)
+	case bronzeawss3bucket.FieldTagsJSON:
+		return m.OldTagsJSON(ctx)
+	case bronzeawss3bucket.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case bronzeawss3bucket.FieldRegion:
+		return m.OldRegion(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeAWSS3Bucket field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeAWSS3BucketMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzeawss3bucket.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzeawss3bucket.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzeawss3bucket.FieldArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArn(v)
+		return nil
+	case bronzeawss3bucket.FieldCreationDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreationDate(v)
+		return nil
+	case bronzeawss3bucket.FieldOwnerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case bronzeawss3bucket.FieldBlockPublicAcls:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockPublicAcls(v)
+		return nil
+	case bronzeawss3bucket.FieldIgnorePublicAcls:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIgnorePublicAcls(v)
+		return nil
+	case bronzeawss3bucket.FieldBlockPublicPolicy:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockPublicPolicy(v)
+		return nil
+	case bronzeawss3bucket.FieldRestrictPublicBuckets:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRestrictPublicBuckets(v)
+		return nil
+	case bronzeawss3bucket.FieldPolicyIsPublic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicyIsPublic(v)
+		return nil
+	case bronzeawss3bucket.FieldSseAlgorithm:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSseAlgorithm(v)
+		return nil
+	case bronzeawss3bucket.FieldKmsMasterKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKmsMasterKeyID(v)
+		return nil
+	case bronzeawss3bucket.FieldBucketKeyEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBucketKeyEnabled(v)
+		return nil
+	case bronzeawss3bucket.FieldVersioningStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersioningStatus(v)
+		return nil
+	case bronzeawss3bucket.FieldMfaDelete:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaDelete(v)
+		return nil
+	case bronzeawss3bucket.FieldLoggingTargetBucket:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoggingTargetBucket(v)
+		return nil
+	case bronzeawss3bucket.FieldLoggingTargetPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoggingTargetPrefix(v)
+		return nil
+	case bronzeawss3bucket.FieldPolicyJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicyJSON(v)
+		return nil
+	case bronzeawss3bucket.FieldACLGrantsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetACLGrantsJSON(v)
+		return nil
+	case bronzeawss3bucket.FieldTagsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagsJSON(v)
+		return nil
+	case bronzeawss3bucket.FieldAccountID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case bronzeawss3bucket.FieldRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSS3Bucket field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeAWSS3BucketMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeAWSS3BucketMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeAWSS3BucketMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeAWSS3Bucket numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeAWSS3BucketMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzeawss3bucket.FieldArn) {
+		fields = append(fields, bronzeawss3bucket.FieldArn)
+	}
+	if m.FieldCleared(bronzeawss3bucket.FieldCreationDate) {
+		fields = append(fields, bronzeawss3bucket.FieldCreationDate)
+	}
+	if m.FieldCleared(bronzeawss3bucket.FieldOwnerID) {
+		fields = append(fields, bronzeawss3bucket.FieldOwnerID)
+	}
+	if m.FieldCleared(bronzeawss3bucket.FieldBlockPublicAcls) {
+		fields = append(fields, bronzeawss3bucket.FieldBlockPublicAcls)
+	}
+	if m.FieldCleared(bronzeawss3bucket.FieldIgnorePublicAcls) {
+		fields = append(fields, bronzeawss3bucket.FieldIgnorePublicAcls)
+	}
+	if m.FieldCleared(bronzeawss3bucket.FieldBlockPublicPolicy) {
+		fields = append(fields, bronzeawss3bucket.FieldBlockPublicPolicy)
+	}
+	if m.FieldCleared(bronzeawss3bucket.FieldRestrictPublicBuckets) {
+		fields = append(fields, bronzeawss3bucket.FieldRestrictPublicBuckets)
+	}
+	if m.FieldCleared(bronzeawss3bucket.FieldPolicyIsPublic) {
+		fields = append(fields, bronzeawss3bucket.FieldPolicyIsPublic)
+	}
+	if m.FieldCleared(bronzeawss3bucket.FieldSseAlgorithm) {
+		fields = append(fields, bronzeawss3bucket.FieldSseAlgorithm)
+	}
+	if m.FieldCleared(bronzeawss3bucket.FieldKmsMasterKeyID) {
+		fields = append(fields, bronzeawss3bucket.FieldKmsMasterKeyID)
+	}
+	if m.FieldCleared(bronzeawss3bucket.FieldBucketKeyEnabled) {
+		fields = append(fields, bronzeawss3bucket.FieldBucketKeyEnabled)
+	}
+	if m.FieldCleared(bronzeawss3bucket.FieldVersioningStatus) {
+		fields = append(fields, bronzeawss3bucket.FieldVersioningStatus)
+	}
+	if m.FieldCleared(bronzeawss3bucket.FieldMfaDelete) {
+		fields = append(fields, bronzeawss3bucket.FieldMfaDelete)
+	}
+	if m.FieldCleared(bronzeawss3bucket.FieldLoggingTargetBucket) {
+		fields = append(fields, bronzeawss3bucket.FieldLoggingTargetBucket)
+	}
+	if m.FieldCleared(bronzeawss3bucket.FieldLoggingTargetPrefix) {
+		fields = append(fields, bronzeawss3bucket.FieldLoggingTargetPrefix)
+	}
+	if m.FieldCleared(bronzeawss3bucket.FieldPolicyJSON) {
+		fields = append(fields, bronzeawss3bucket.FieldPolicyJSON)
+	}
+	if m.FieldCleared(bronzeawss3bucket.FieldACLGrantsJSON) {
+		fields = append(fields, bronzeawss3bucket.FieldACLGrantsJSON)
+	}
+	if m.FieldCleared(bronzeawss3bucket.FieldTagsJSON) {
+		fields = append(fields, bronzeawss3bucket.FieldTagsJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeAWSS3BucketMutation) ClearField(name string) error {
+	switch name {
+	case bronzeawss3bucket.FieldArn:
+		m.ClearArn()
+		return nil
+	case bronzeawss3bucket.FieldCreationDate:
+		m.ClearCreationDate()
+		return nil
+	case bronzeawss3bucket.FieldOwnerID:
+		m.ClearOwnerID()
+		return nil
+	case bronzeawss3bucket.FieldBlockPublicAcls:
+		m.ClearBlockPublicAcls()
+		return nil
+	case bronzeawss3bucket.FieldIgnorePublicAcls:
+		m.ClearIgnorePublicAcls()
+		return nil
+	case bronzeawss3bucket.FieldBlockPublicPolicy:
+		m.ClearBlockPublicPolicy()
+		return nil
+	case bronzeawss3bucket.FieldRestrictPublicBuckets:
+		m.ClearRestrictPublicBuckets()
+		return nil
+	case bronzeawss3bucket.FieldPolicyIsPublic:
+		m.ClearPolicyIsPublic()
+		return nil
+	case bronzeawss3bucket.FieldSseAlgorithm:
+		m.ClearSseAlgorithm()
+		return nil
+	case bronzeawss3bucket.FieldKmsMasterKeyID:
+		m.ClearKmsMasterKeyID()
+		return nil
+	case bronzeawss3bucket.FieldBucketKeyEnabled:
+		m.ClearBucketKeyEnabled()
+		return nil
+	case bronzeawss3bucket.FieldVersioningStatus:
+		m.ClearVersioningStatus()
+		return nil
+	case bronzeawss3bucket.FieldMfaDelete:
+		m.ClearMfaDelete()
+		return nil
+	case bronzeawss3bucket.FieldLoggingTargetBucket:
+		m.ClearLoggingTargetBucket()
+		return nil
+	case bronzeawss3bucket.FieldLoggingTargetPrefix:
+		m.ClearLoggingTargetPrefix()
+		return nil
+	case bronzeawss3bucket.FieldPolicyJSON:
+		m.ClearPolicyJSON()
+		return nil
+	case bronzeawss3bucket.FieldACLGrantsJSON:
+		m.ClearACLGrantsJSON()
+		return nil
+	case bronzeawss3bucket.FieldTagsJSON:
+		m.ClearTagsJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSS3Bucket nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeAWSS3BucketMutation) ResetField(name string) error {
+	switch name {
+	case bronzeawss3bucket.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzeawss3bucket.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzeawss3bucket.FieldArn:
+		m.ResetArn()
+		return nil
+	case bronzeawss3bucket.FieldCreationDate:
+		m.ResetCreationDate()
+		return nil
+	case bronzeawss3bucket.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case bronzeawss3bucket.FieldBlockPublicAcls:
+		m.ResetBlockPublicAcls()
+		return nil
+	case bronzeawss3bucket.FieldIgnorePublicAcls:
+		m.ResetIgnorePublicAcls()
+		return nil
+	case bronzeawss3bucket.FieldBlockPublicPolicy:
+		m.ResetBlockPublicPolicy()
+		return nil
+	case bronzeawss3bucket.FieldRestrictPublicBuckets:
+		m.ResetRestrictPublicBuckets()
+		return nil
+	case bronzeawss3bucket.FieldPolicyIsPublic:
+		m.ResetPolicyIsPublic()
+		return nil
+	case bronzeawss3bucket.FieldSseAlgorithm:
+		m.ResetSseAlgorithm()
+		return nil
+	case bronzeawss3bucket.FieldKmsMasterKeyID:
+		m.ResetKmsMasterKeyID()
+		return nil
+	case bronzeawss3bucket.FieldBucketKeyEnabled:
+		m.ResetBucketKeyEnabled()
+		return nil
+	case bronzeawss3bucket.FieldVersioningStatus:
+		m.ResetVersioningStatus()
+		return nil
+	case bronzeawss3bucket.FieldMfaDelete:
+		m.ResetMfaDelete()
+		return nil
+	case bronzeawss3bucket.FieldLoggingTargetBucket:
+		m.ResetLoggingTargetBucket()
+		return nil
+	case bronzeawss3bucket.FieldLoggingTargetPrefix:
+		m.ResetLoggingTargetPrefix()
+		return nil
+	case bronzeawss3bucket.FieldPolicyJSON:
+		m.ResetPolicyJSON()
+		return nil
+	case bronzeawss3bucket.FieldACLGrantsJSON:
+		m.ResetACLGrantsJSON()
+		return nil
+	case bronzeawss3bucket.FieldTagsJSON:
+		m.ResetTagsJSON()
+		return nil
+	case bronzeawss3bucket.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case bronzeawss3bucket.FieldRegion:
+		m.ResetRegion()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeAWSS3Bucket field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeAWSS3BucketMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeAWSS3BucketMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeAWSS3BucketMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeAWSS3BucketMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeAWSS3BucketMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeAWSS3BucketMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeAWSS3Bucket unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeAWSS3BucketMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeAWSS3Bucket edge %s", name)
+}
+
+// BronzeHistoryAWSS3BucketMutation represents an operation that mutates the BronzeHistoryAWSS3Bucket nodes in the graph.
+type BronzeHistoryAWSS3BucketMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uint
+	valid_from              *time.Time
+	valid_to                *time.Time
+	collected_at            *time.Time
+	first_collected_at      *time.Time
+	resource_id             *string
+	arn                     *string
+	creation_date           *time.Time
+	owner_id                *string
+	block_public_acls       *bool
+	ignore_public_acls      *bool
+	block_public_policy     *bool
+	restrict_public_buckets *bool
+	policy_is_public        *bool
+	sse_algorithm           *string
+	kms_master_key_id       *string
+	bucket_key_enabled      *bool
+	versioning_status       *string
+	mfa_delete              *string
+	logging_target_bucket   *string
+	logging_target_prefix   *string
+	policy_json             *json.RawMessage
+	appendpolicy_json       json.RawMessage
+	acl_grants_json         *json.RawMessage
+	appendacl_grants_json   json.RawMessage
+	tags_json               *json.RawMessage
+	appendtags_json         json.RawMessage
+	account_id              *string
+	region                  *string
+	clearedFields           map[string]struct{}
+	done                    bool
+	oldValue                func(context.Context) (*BronzeHistoryAWSS3Bucket, error)
+	predicates              []predicate.BronzeHistoryAWSS3Bucket
+}
+
+var _ ent.Mutation = (*BronzeHistoryAWSS3BucketMutation)(nil)
+
+// bronzehistoryawss3bucketOption allows management of the mutation configuration using functional options.
+type bronzehistoryawss3bucketOption func(*BronzeHistoryAWSS3BucketMutation)
+
+// newBronzeHistoryAWSS3BucketMutation creates new mutation for the BronzeHistoryAWSS3Bucket entity.
+func newBronzeHistoryAWSS3BucketMutation(c config, op Op, opts ...bronzehistoryawss3bucketOption) *BronzeHistoryAWSS3BucketMutation {
+	m := &BronzeHistoryAWSS3BucketMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeHistoryAWSS3Bucket,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeHistoryAWSS3BucketID sets the ID field of the mutation.
+func withBronzeHistoryAWSS3BucketID(id uint) bronzehistoryawss3bucketOption {
+	return func(m *BronzeHistoryAWSS3BucketMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeHistoryAWSS3Bucket
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeHistoryAWSS3Bucket, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeHistoryAWSS3Bucket.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeHistoryAWSS3Bucket sets the old BronzeHistoryAWSS3Bucket of the mutation.
+func withBronzeHistoryAWSS3Bucket(node *BronzeHistoryAWSS3Bucket) bronzehistoryawss3bucketOption {
+	return func(m *BronzeHistoryAWSS3BucketMutation) {
+		m.oldValue = func(context.Context) (*BronzeHistoryAWSS3Bucket, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeHistoryAWSS3BucketMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeHistoryAWSS3BucketMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("s3: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeHistoryAWSS3Bucket entities.
+func (m *BronzeHistoryAWSS3BucketMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeHistoryAWSS3BucketMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeHistoryAWSS3Bucket.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidTo sets the "valid_to" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetValidTo(t time.Time) {
+	m.valid_to = &t
+}
+
+// ValidTo returns the value of the "valid_to" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) ValidTo() (r time.Time, exists bool) {
+	v := m.valid_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidTo returns the old "valid_to" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldValidTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidTo: %w", err)
+	}
+	return oldValue.ValidTo, nil
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearValidTo() {
+	m.valid_to = nil
+	m.clearedFields[bronzehistoryawss3bucket.FieldValidTo] = struct{}{}
+}
+
+// ValidToCleared returns if the "valid_to" field was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) ValidToCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawss3bucket.FieldValidTo]
+	return ok
+}
+
+// ResetValidTo resets all changes to the "valid_to" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetValidTo() {
+	m.valid_to = nil
+	delete(m.clearedFields, bronzehistoryawss3bucket.FieldValidTo)
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetArn sets the "arn" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetArn(s string) {
+	m.arn = &s
+}
+
+// Arn returns the value of the "arn" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) Arn() (r string, exists bool) {
+	v := m.arn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArn returns the old "arn" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldArn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArn: %w", err)
+	}
+	return oldValue.Arn, nil
+}
+
+// ClearArn clears the value of the "arn" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearArn() {
+	m.arn = nil
+	m.clearedFields[bronzehistoryawss3bucket.FieldArn] = struct{}{}
+}
+
+// ArnCleared returns if the "arn" field was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) ArnCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawss3bucket.FieldArn]
+	return ok
+}
+
+// ResetArn resets all changes to the "arn" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetArn() {
+	m.arn = nil
+	delete(m.clearedFields, bronzehistoryawss3bucket.FieldArn)
+}
+
+// SetCreationDate sets the "creation_date" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetCreationDate(t time.Time) {
+	m.creation_date = &t
+}
+
+// CreationDate returns the value of the "creation_date" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) CreationDate() (r time.Time, exists bool) {
+	v := m.creation_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreationDate returns the old "creation_date" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldCreationDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreationDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreationDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreationDate: %w", err)
+	}
+	return oldValue.CreationDate, nil
+}
+
+// ClearCreationDate clears the value of the "creation_date" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearCreationDate() {
+	m.creation_date = nil
+	m.clearedFields[bronzehistoryawss3bucket.FieldCreationDate] = struct{}{}
+}
+
+// CreationDateCleared returns if the "creation_date" field was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) CreationDateCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawss3bucket.FieldCreationDate]
+	return ok
+}
+
+// ResetCreationDate resets all changes to the "creation_date" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetCreationDate() {
+	m.creation_date = nil
+	delete(m.clearedFields, bronzehistoryawss3bucket.FieldCreationDate)
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetOwnerID(s string) {
+	m.owner_id = &s
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) OwnerID() (r string, exists bool) {
+	v := m.owner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldOwnerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearOwnerID() {
+	m.owner_id = nil
+	m.clearedFields[bronzehistoryawss3bucket.FieldOwnerID] = struct{}{}
+}
+
+// OwnerIDCleared returns if the "owner_id" field was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) OwnerIDCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawss3bucket.FieldOwnerID]
+	return ok
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetOwnerID() {
+	m.owner_id = nil
+	delete(m.clearedFields, bronzehistoryawss3bucket.FieldOwnerID)
+}
+
+// SetBlockPublicAcls sets the "block_public_acls" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetBlockPublicAcls(b bool) {
+	m.block_public_acls = &b
+}
+
+// BlockPublicAcls returns the value of the "block_public_acls" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) BlockPublicAcls() (r bool, exists bool) {
+	v := m.block_public_acls
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockPublicAcls returns the old "block_public_acls" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldBlockPublicAcls(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockPublicAcls is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockPublicAcls requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockPublicAcls: %w", err)
+	}
+	return oldValue.BlockPublicAcls, nil
+}
+
+// ClearBlockPublicAcls clears the value of the "block_public_acls" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearBlockPublicAcls() {
+	m.block_public_acls = nil
+	m.clearedFields[bronzehistoryawss3bucket.FieldBlockPublicAcls] = struct{}{}
+}
+
+// BlockPublicAclsCleared returns if the "block_public_acls" field was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) BlockPublicAclsCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawss3bucket.FieldBlockPublicAcls]
+	return ok
+}
+
+// ResetBlockPublicAcls resets all changes to the "block_public_acls" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetBlockPublicAcls() {
+	m.block_public_acls = nil
+	delete(m.clearedFields, bronzehistoryawss3bucket.FieldBlockPublicAcls)
+}
+
+// SetIgnorePublicAcls sets the "ignore_public_acls" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetIgnorePublicAcls(b bool) {
+	m.ignore_public_acls = &b
+}
+
+// IgnorePublicAcls returns the value of the "ignore_public_acls" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) IgnorePublicAcls() (r bool, exists bool) {
+	v := m.ignore_public_acls
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIgnorePublicAcls returns the old "ignore_public_acls" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldIgnorePublicAcls(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIgnorePublicAcls is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIgnorePublicAcls requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIgnorePublicAcls: %w", err)
+	}
+	return oldValue.IgnorePublicAcls, nil
+}
+
+// ClearIgnorePublicAcls clears the value of the "ignore_public_acls" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearIgnorePublicAcls() {
+	m.ignore_public_acls = nil
+	m.clearedFields[bronzehistoryawss3bucket.FieldIgnorePublicAcls] = struct{}{}
+}
+
+// IgnorePublicAclsCleared returns if the "ignore_public_acls" field was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) IgnorePublicAclsCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawss3bucket.FieldIgnorePublicAcls]
+	return ok
+}
+
+// ResetIgnorePublicAcls resets all changes to the "ignore_public_acls" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetIgnorePublicAcls() {
+	m.ignore_public_acls = nil
+	delete(m.clearedFields, bronzehistoryawss3bucket.FieldIgnorePublicAcls)
+}
+
+// SetBlockPublicPolicy sets the "block_public_policy" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetBlockPublicPolicy(b bool) {
+	m.block_public_policy = &b
+}
+
+// BlockPublicPolicy returns the value of the "block_public_policy" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) BlockPublicPolicy() (r bool, exists bool) {
+	v := m.block_public_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockPublicPolicy returns the old "block_public_policy" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldBlockPublicPolicy(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockPublicPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockPublicPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockPublicPolicy: %w", err)
+	}
+	return oldValue.BlockPublicPolicy, nil
+}
+
+// ClearBlockPublicPolicy clears the value of the "block_public_policy" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearBlockPublicPolicy() {
+	m.block_public_policy = nil
+	m.clearedFields[bronzehistoryawss3bucket.FieldBlockPublicPolicy] = struct{}{}
+}
+
+// BlockPublicPolicyCleared returns if the "block_public_policy" field was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) BlockPublicPolicyCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawss3bucket.FieldBlockPublicPolicy]
+	return ok
+}
+
+// ResetBlockPublicPolicy resets all changes to the "block_public_policy" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetBlockPublicPolicy() {
+	m.block_public_policy = nil
+	delete(m.clearedFields, bronzehistoryawss3bucket.FieldBlockPublicPolicy)
+}
+
+// SetRestrictPublicBuckets sets the "restrict_public_buckets" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetRestrictPublicBuckets(b bool) {
+	m.restrict_public_buckets = &b
+}
+
+// RestrictPublicBuckets returns the value of the "restrict_public_buckets" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) RestrictPublicBuckets() (r bool, exists bool) {
+	v := m.restrict_public_buckets
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRestrictPublicBuckets returns the old "restrict_public_buckets" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldRestrictPublicBuckets(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRestrictPublicBuckets is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRestrictPublicBuckets requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRestrictPublicBuckets: %w", err)
+	}
+	return oldValue.RestrictPublicBuckets, nil
+}
+
+// ClearRestrictPublicBuckets clears the value of the "restrict_public_buckets" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearRestrictPublicBuckets() {
+	m.restrict_public_buckets = nil
+	m.clearedFields[bronzehistoryawss3bucket.FieldRestrictPublicBuckets] = struct{}{}
+}
+
+// RestrictPublicBucketsCleared returns if the "restrict_public_buckets" field was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) RestrictPublicBucketsCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawss3bucket.FieldRestrictPublicBuckets]
+	return ok
+}
+
+// ResetRestrictPublicBuckets resets all changes to the "restrict_public_buckets" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetRestrictPublicBuckets() {
+	m.restrict_public_buckets = nil
+	delete(m.clearedFields, bronzehistoryawss3bucket.FieldRestrictPublicBuckets)
+}
+
+// SetPolicyIsPublic sets the "policy_is_public" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetPolicyIsPublic(b bool) {
+	m.policy_is_public = &b
+}
+
+// PolicyIsPublic returns the value of the "policy_is_public" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) PolicyIsPublic() (r bool, exists bool) {
+	v := m.policy_is_public
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolicyIsPublic returns the old "policy_is_public" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldPolicyIsPublic(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicyIsPublic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicyIsPublic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicyIsPublic: %w", err)
+	}
+	return oldValue.PolicyIsPublic, nil
+}
+
+// ClearPolicyIsPublic clears the value of the "policy_is_public" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearPolicyIsPublic() {
+	m.policy_is_public = nil
+	m.clearedFields[bronzehistoryawss3bucket.FieldPolicyIsPublic] = struct{}{}
+}
+
+// PolicyIsPublicCleared returns if the "policy_is_public" field was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) PolicyIsPublicCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawss3bucket.FieldPolicyIsPublic]
+	return ok
+}
+
+// ResetPolicyIsPublic resets all changes to the "policy_is_public" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetPolicyIsPublic() {
+	m.policy_is_public = nil
+	delete(m.clearedFields, bronzehistoryawss3bucket.FieldPolicyIsPublic)
+}
+
+// SetSseAlgorithm sets the "sse_algorithm" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetSseAlgorithm(s string) {
+	m.sse_algorithm = &s
+}
+
+// SseAlgorithm returns the value of the "sse_algorithm" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) SseAlgorithm() (r string, exists bool) {
+	v := m.sse_algorithm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSseAlgorithm returns the old "sse_algorithm" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldSseAlgorithm(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSseAlgorithm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSseAlgorithm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSseAlgorithm: %w", err)
+	}
+	return oldValue.SseAlgorithm, nil
+}
+
+// ClearSseAlgorithm clears the value of the "sse_algorithm" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearSseAlgorithm() {
+	m.sse_algorithm = nil
+	m.clearedFields[bronzehistoryawss3bucket.FieldSseAlgorithm] = struct{}{}
+}
+
+// SseAlgorithmCleared returns if the "sse_algorithm" field was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) SseAlgorithmCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawss3bucket.FieldSseAlgorithm]
+	return ok
+}
+
+// ResetSseAlgorithm resets all changes to the "sse_algorithm" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetSseAlgorithm() {
+	m.sse_algorithm = nil
+	delete(m.clearedFields, bronzehistoryawss3bucket.FieldSseAlgorithm)
+}
+
+// SetKmsMasterKeyID sets the "kms_master_key_id" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetKmsMasterKeyID(s string) {
+	m.kms_master_key_id = &s
+}
+
+// KmsMasterKeyID returns the value of the "kms_master_key_id" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) KmsMasterKeyID() (r string, exists bool) {
+	v := m.kms_master_key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKmsMasterKeyID returns the old "kms_master_key_id" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldKmsMasterKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKmsMasterKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKmsMasterKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKmsMasterKeyID: %w", err)
+	}
+	return oldValue.KmsMasterKeyID, nil
+}
+
+// ClearKmsMasterKeyID clears the value of the "kms_master_key_id" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearKmsMasterKeyID() {
+	m.kms_master_key_id = nil
+	m.clearedFields[bronzehistoryawss3bucket.FieldKmsMasterKeyID] = struct{}{}
+}
+
+// KmsMasterKeyIDCleared returns if the "kms_master_key_id" field was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) KmsMasterKeyIDCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawss3bucket.FieldKmsMasterKeyID]
+	return ok
+}
+
+// ResetKmsMasterKeyID resets all changes to the "kms_master_key_id" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetKmsMasterKeyID() {
+	m.kms_master_key_id = nil
+	delete(m.clearedFields, bronzehistoryawss3bucket.FieldKmsMasterKeyID)
+}
+
+// SetBucketKeyEnabled sets the "bucket_key_enabled" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetBucketKeyEnabled(b bool) {
+	m.bucket_key_enabled = &b
+}
+
+// BucketKeyEnabled returns the value of the "bucket_key_enabled" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) BucketKeyEnabled() (r bool, exists bool) {
+	v := m.bucket_key_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBucketKeyEnabled returns the old "bucket_key_enabled" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldBucketKeyEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBucketKeyEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBucketKeyEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBucketKeyEnabled: %w", err)
+	}
+	return oldValue.BucketKeyEnabled, nil
+}
+
+// ClearBucketKeyEnabled clears the value of the "bucket_key_enabled" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearBucketKeyEnabled() {
+	m.bucket_key_enabled = nil
+	m.clearedFields[bronzehistoryawss3bucket.FieldBucketKeyEnabled] = struct{}{}
+}
+
+// BucketKeyEnabledCleared returns if the "bucket_key_enabled" field was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) BucketKeyEnabledCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawss3bucket.FieldBucketKeyEnabled]
+	return ok
+}
+
+// ResetBucketKeyEnabled resets all changes to the "bucket_key_enabled" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetBucketKeyEnabled() {
+	m.bucket_key_enabled = nil
+	delete(m.clearedFields, bronzehistoryawss3bucket.FieldBucketKeyEnabled)
+}
+
+// SetVersioningStatus sets the "versioning_status" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetVersioningStatus(s string) {
+	m.versioning_status = &s
+}
+
+// VersioningStatus returns the value of the "versioning_status" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) VersioningStatus() (r string, exists bool) {
+	v := m.versioning_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersioningStatus returns the old "versioning_status" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldVersioningStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersioningStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersioningStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersioningStatus: %w", err)
+	}
+	return oldValue.VersioningStatus, nil
+}
+
+// ClearVersioningStatus clears the value of the "versioning_status" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearVersioningStatus() {
+	m.versioning_status = nil
+	m.clearedFields[bronzehistoryawss3bucket.FieldVersioningStatus] = struct{}{}
+}
+
+// VersioningStatusCleared returns if the "versioning_status" field was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) VersioningStatusCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawss3bucket.FieldVersioningStatus]
+	return ok
+}
+
+// ResetVersioningStatus resets all changes to the "versioning_status" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetVersioningStatus() {
+	m.versioning_status = nil
+	delete(m.clearedFields, bronzehistoryawss3bucket.FieldVersioningStatus)
+}
+
+// SetMfaDelete sets the "mfa_delete" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetMfaDelete(s string) {
+	m.mfa_delete = &s
+}
+
+// MfaDelete returns the value of the "mfa_delete" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) MfaDelete() (r string, exists bool) {
+	v := m.mfa_delete
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMfaDelete returns the old "mfa_delete" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldMfaDelete(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMfaDelete is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMfaDelete requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMfaDelete: %w", err)
+	}
+	return oldValue.MfaDelete, nil
+}
+
+// ClearMfaDelete clears the value of the "mfa_delete" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearMfaDelete() {
+	m.mfa_delete = nil
+	m.clearedFields[bronzehistoryawss3bucket.FieldMfaDelete] = struct{}{}
+}
+
+// MfaDeleteCleared returns if the "mfa_delete" field was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) MfaDeleteCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawss3bucket.FieldMfaDelete]
+	return ok
+}
+
+// ResetMfaDelete resets all changes to the "mfa_delete" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetMfaDelete() {
+	m.mfa_delete = nil
+	delete(m.clearedFields, bronzehistoryawss3bucket.FieldMfaDelete)
+}
+
+// SetLoggingTargetBucket sets the "logging_target_bucket" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetLoggingTargetBucket(s string) {
+	m.logging_target_bucket = &s
+}
+
+// LoggingTargetBucket returns the value of the "logging_target_bucket" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) LoggingTargetBucket() (r string, exists bool) {
+	v := m.logging_target_bucket
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoggingTargetBucket returns the old "logging_target_bucket" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldLoggingTargetBucket(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoggingTargetBucket is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoggingTargetBucket requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoggingTargetBucket: %w", err)
+	}
+	return oldValue.LoggingTargetBucket, nil
+}
+
+// ClearLoggingTargetBucket clears the value of the "logging_target_bucket" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearLoggingTargetBucket() {
+	m.logging_target_bucket = nil
+	m.clearedFields[bronzehistoryawss3bucket.FieldLoggingTargetBucket] = struct{}{}
+}
+
+// LoggingTargetBucketCleared returns if the "logging_target_bucket" field was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) LoggingTargetBucketCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawss3bucket.FieldLoggingTargetBucket]
+	return ok
+}
+
+// ResetLoggingTargetBucket resets all changes to the "logging_target_bucket" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetLoggingTargetBucket() {
+	m.logging_target_bucket = nil
+	delete(m.clearedFields, bronzehistoryawss3bucket.FieldLoggingTargetBucket)
+}
+
+// SetLoggingTargetPrefix sets the "logging_target_prefix" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetLoggingTargetPrefix(s string) {
+	m.logging_target_prefix = &s
+}
+
+// LoggingTargetPrefix returns the value of the "logging_target_prefix" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) LoggingTargetPrefix() (r string, exists bool) {
+	v := m.logging_target_prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoggingTargetPrefix returns the old "logging_target_prefix" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldLoggingTargetPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoggingTargetPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoggingTargetPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoggingTargetPrefix: %w", err)
+	}
+	return oldValue.LoggingTargetPrefix, nil
+}
+
+// ClearLoggingTargetPrefix clears the value of the "logging_target_prefix" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearLoggingTargetPrefix() {
+	m.logging_target_prefix = nil
+	m.clearedFields[bronzehistoryawss3bucket.FieldLoggingTargetPrefix] = struct{}{}
+}
+
+// LoggingTargetPrefixCleared returns if the "logging_target_prefix" field was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) LoggingTargetPrefixCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawss3bucket.FieldLoggingTargetPrefix]
+	return ok
+}
+
+// ResetLoggingTargetPrefix resets all changes to the "logging_target_prefix" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetLoggingTargetPrefix() {
+	m.logging_target_prefix = nil
+	delete(m.clearedFields, bronzehistoryawss3bucket.FieldLoggingTargetPrefix)
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetPolicyJSON(jm json.RawMessage) {
+	m.policy_json = &jm
+	m.appendpolicy_json = nil
+}
+
+// PolicyJSON returns the value of the "policy_json" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) PolicyJSON() (r json.RawMessage, exists bool) {
+	v := m.policy_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolicyJSON returns the old "policy_json" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldPolicyJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicyJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicyJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicyJSON: %w", err)
+	}
+	return oldValue.PolicyJSON, nil
+}
+
+// AppendPolicyJSON adds jm to the "policy_json" field.
+func (m *BronzeHistoryAWSS3BucketMutation) AppendPolicyJSON(jm json.RawMessage) {
+	m.appendpolicy_json = append(m.appendpolicy_json, jm...)
+}
+
+// AppendedPolicyJSON returns the list of values that were appended to the "policy_json" field in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) AppendedPolicyJSON() (json.RawMessage, bool) {
+	if len(m.appendpolicy_json) == 0 {
+		return nil, false
+	}
+	return m.appendpolicy_json, true
+}
+
+// ClearPolicyJSON clears the value of the "policy_json" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearPolicyJSON() {
+	m.policy_json = nil
+	m.appendpolicy_json = nil
+	m.clearedFields[bronzehistoryawss3bucket.FieldPolicyJSON] = struct{}{}
+}
+
+// PolicyJSONCleared returns if the "policy_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) PolicyJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawss3bucket.FieldPolicyJSON]
+	return ok
+}
+
+// ResetPolicyJSON resets all changes to the "policy_json" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetPolicyJSON() {
+	m.policy_json = nil
+	m.appendpolicy_json = nil
+	delete(m.clearedFields, bronzehistoryawss3bucket.FieldPolicyJSON)
+}
+
+// SetACLGrantsJSON sets the "acl_grants_json" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetACLGrantsJSON(jm json.RawMessage) {
+	m.acl_grants_json = &jm
+	m.appendacl_grants_json = nil
+}
+
+// ACLGrantsJSON returns the value of the "acl_grants_json" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) ACLGrantsJSON() (r json.RawMessage, exists bool) {
+	v := m.acl_grants_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldACLGrantsJSON returns the old "acl_grants_json" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldACLGrantsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldACLGrantsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldACLGrantsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldACLGrantsJSON: %w", err)
+	}
+	return oldValue.ACLGrantsJSON, nil
+}
+
+// AppendACLGrantsJSON adds jm to the "acl_grants_json" field.
+func (m *BronzeHistoryAWSS3BucketMutation) AppendACLGrantsJSON(jm json.RawMessage) {
+	m.appendacl_grants_json = append(m.appendacl_grants_json, jm...)
+}
+
+// AppendedACLGrantsJSON returns the list of values that were appended to the "acl_grants_json" field in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) AppendedACLGrantsJSON() (json.RawMessage, bool) {
+	if len(m.appendacl_grants_json) == 0 {
+		return nil, false
+	}
+	return m.appendacl_grants_json, true
+}
+
+// ClearACLGrantsJSON clears the value of the "acl_grants_json" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearACLGrantsJSON() {
+	m.acl_grants_json = nil
+	m.appendacl_grants_json = nil
+	m.clearedFields[bronzehistoryawss3bucket.FieldACLGrantsJSON] = struct{}{}
+}
+
+// ACLGrantsJSONCleared returns if the "acl_grants_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) ACLGrantsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawss3bucket.FieldACLGrantsJSON]
+	return ok
+}
+
+// ResetACLGrantsJSON resets all changes to the "acl_grants_json" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetACLGrantsJSON() {
+	m.acl_grants_json = nil
+	m.appendacl_grants_json = nil
+	delete(m.clearedFields, bronzehistoryawss3bucket.FieldACLGrantsJSON)
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetTagsJSON(jm json.RawMessage) {
+	m.tags_json = &jm
+	m.appendtags_json = nil
+}
+
+// TagsJSON returns the value of the "tags_json" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) TagsJSON() (r json.RawMessage, exists bool) {
+	v := m.tags_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagsJSON returns the old "tags_json" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldTagsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagsJSON: %w", err)
+	}
+	return oldValue.TagsJSON, nil
+}
+
+// AppendTagsJSON adds jm to the "tags_json" field.
+func (m *BronzeHistoryAWSS3BucketMutation) AppendTagsJSON(jm json.RawMessage) {
+	m.appendtags_json = append(m.appendtags_json, jm...)
+}
+
+// AppendedTagsJSON returns the list of values that were appended to the "tags_json" field in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) AppendedTagsJSON() (json.RawMessage, bool) {
+	if len(m.appendtags_json) == 0 {
+		return nil, false
+	}
+	return m.appendtags_json, true
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	m.clearedFields[bronzehistoryawss3bucket.FieldTagsJSON] = struct{}{}
+}
+
+// TagsJSONCleared returns if the "tags_json" field was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) TagsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryawss3bucket.FieldTagsJSON]
+	return ok
+}
+
+// ResetTagsJSON resets all changes to the "tags_json" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	delete(m.clearedFields, bronzehistoryawss3bucket.FieldTagsJSON)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetAccountID(s string) {
+	m.account_id = &s
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) AccountID() (r string, exists bool) {
+	v := m.account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldAccountID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetAccountID() {
+	m.account_id = nil
+}
+
+// SetRegion sets the "region" field.
+func (m *BronzeHistoryAWSS3BucketMutation) SetRegion(s string) {
+	m.region = &s
+}
+
+// Region returns the value of the "region" field in the mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) Region() (r string, exists bool) {
+	v := m.region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegion returns the old "region" field's value of the BronzeHistoryAWSS3Bucket entity.
+// If the BronzeHistoryAWSS3Bucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryAWSS3BucketMutation) OldRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
+	}
+	return oldValue.Region, nil
+}
+
+// ResetRegion resets all changes to the "region" field.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetRegion() {
+	m.region = nil
+}
+
+// Where appends a list predicates to the BronzeHistoryAWSS3BucketMutation builder.
+func (m *BronzeHistoryAWSS3BucketMutation) Where(ps ...predicate.BronzeHistoryAWSS3Bucket) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeHistoryAWSS3BucketMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeHistoryAWSS3BucketMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeHistoryAWSS3Bucket, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeHistoryAWSS3BucketMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeHistoryAWSS3BucketMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeHistoryAWSS3Bucket).
+func (m *BronzeHistoryAWSS3BucketMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeHistoryAWSS3BucketMutation) Fields() []string {
+	fields := make([]string, 0, 25)
+	if m.valid_from != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldValidFrom)
+	}
+	if m.valid_to != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldValidTo)
+	}
+	if m.collected_at != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldFirstCollectedAt)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldResourceID)
+	}
+	if m.arn != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldArn)
+	}
+	if m.creation_date != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldCreationDate)
+	}
+	if m.owner_id != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldOwnerID)
+	}
+	if m.block_public_acls != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldBlockPublicAcls)
+	}
+	if m.ignore_public_acls != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldIgnorePublicAcls)
+	}
+	if m.block_public_policy != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldBlockPublicPolicy)
+	}
+	if m.restrict_public_buckets != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldRestrictPublicBuckets)
+	}
+	if m.policy_is_public != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldPolicyIsPublic)
+	}
+	if m.sse_algorithm != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldSseAlgorithm)
+	}
+	if m.kms_master_key_id != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldKmsMasterKeyID)
+	}
+	if m.bucket_key_enabled != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldBucketKeyEnabled)
+	}
+	if m.versioning_status != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldVersioningStatus)
+	}
+	if m.mfa_delete != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldMfaDelete)
+	}
+	if m.logging_target_bucket != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldLoggingTargetBucket)
+	}
+	if m.logging_target_prefix != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldLoggingTargetPrefix)
+	}
+	if m.policy_json != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldPolicyJSON)
+	}
+	if m.acl_grants_json != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldACLGrantsJSON)
+	}
+	if m.tags_json != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldTagsJSON)
+	}
+	if m.account_id != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldAccountID)
+	}
+	if m.region != nil {
+		fields = append(fields, bronzehistoryawss3bucket.FieldRegion)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeHistoryAWSS3BucketMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryawss3bucket.FieldValidFrom:
+		return m.ValidFrom()
+	case bronzehistoryawss3bucket.FieldValidTo:
+		return m.ValidTo()
+	case bronzehistoryawss3bucket.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzehistoryawss3bucket.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzehistoryawss3bucket.FieldResourceID:
+		return m.ResourceID()
+	case bronzehistoryawss3bucket.FieldArn:
+		return m.Arn()
+	case bronzehistoryawss3bucket.FieldCreationDate:
+		return m.CreationDate()
+	case bronzehistoryawss3bucket.FieldOwnerID:
+		return m.OwnerID()
+	case bronzehistoryawss3bucket.FieldBlockPublicAcls:
+		return m.BlockPublicAcls()
+	case bronzehistoryawss3bucket.FieldIgnorePublicAcls:
+		return m.IgnorePublicAcls()
+	case bronzehistoryawss3bucket.FieldBlockPublicPolicy:
+		return m.BlockPublicPolicy()
+	case bronzehistoryawss3bucket.FieldRestrictPublicBuckets:
+		return m.RestrictPublicBuckets()
+	case bronzehistoryawss3bucket.FieldPolicyIsPublic:
+		return m.PolicyIsPublic()
+	case bronzehistoryawss3bucket.FieldSseAlgorithm:
+		return m.SseAlgorithm()
+	case bronzehistoryawss3bucket.FieldKmsMasterKeyID:
+		return m.KmsMasterKeyID()
+	case bronzehistoryawss3bucket.FieldBucketKeyEnabled:
+		return m.BucketKeyEnabled()
+	case bronzehistoryawss3bucket.FieldVersioningStatus:
+		return m.VersioningStatus()
+	case bronzehistoryawss3bucket.FieldMfaDelete:
+		return m.MfaDelete()
+	case bronzehistoryawss3bucket.FieldLoggingTargetBucket:
+		return m.LoggingTargetBucket()
+	case bronzehistoryawss3bucket.FieldLoggingTargetPrefix:
+		return m.LoggingTargetPrefix()
+	case bronzehistoryawss3bucket.FieldPolicyJSON:
+		return m.PolicyJSON()
+	case bronzehistoryawss3bucket.FieldACLGrantsJSON:
+		return m.ACLGrantsJSON()
+	case bronzehistoryawss3bucket.FieldTagsJSON:
+		return m.TagsJSON()
+	case bronzehistoryawss3bucket.FieldAccountID:
+		return m.AccountID()
+	case bronzehistoryawss3bucket.FieldRegion:
+		return m.Region()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeHistoryAWSS3BucketMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzehistoryawss3bucket.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case bronzehistoryawss3bucket.FieldValidTo:
+		return m.OldValidTo(ctx)
+	case bronzehistoryawss3bucket.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzehistoryawss3bucket.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzehistoryawss3bucket.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case bronzehistoryawss3bucket.FieldArn:
+		return m.OldArn(ctx)
+	case bronzehistoryawss3bucket.FieldCreationDate:
+		return m.OldCreationDate(ctx)
+	case bronzehistoryawss3bucket.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case bronzehistoryawss3bucket.FieldBlockPublicAcls:
+		return m.OldBlockPublicAcls(ctx)
+	case bronzehistoryawss3bucket.FieldIgnorePublicAcls:
+		return m.OldIgnorePublicAcls(ctx)
+	case bronzehistoryawss3bucket.FieldBlockPublicPolicy:
+		return m.OldBlockPublicPolicy(ctx)
+	case bronzehistoryawss3bucket.FieldRestrictPublicBuckets:
+		return m.OldRestrictPublicBuckets(ctx)
+	case bronzehistoryawss3bucket.FieldPolicyIsPublic:
+		return m.OldPolicyIsPublic(ctx)
+	case bronzehistoryawss3bucket.FieldSseAlgorithm:
+		return m.OldSseAlgorithm(ctx)
+	case bronzehistoryawss3bucket.FieldKmsMasterKeyID:
+		return m.OldKmsMasterKeyID(ctx)
+	case bronzehistoryawss3bucket.FieldBucketKeyEnabled:
+		return m.OldBucketKeyEnabled(ctx)
+	case bronzehistoryawss3bucket.FieldVersioningStatus:
+		return m.OldVersioningStatus(ctx)
+	case bronzehistoryawss3bucket.FieldMfaDelete:
+		return m.OldMfaDelete(ctx)
+	case bronzehistoryawss3bucket.FieldLoggingTargetBucket:
+		return m.OldLoggingTargetBucket(ctx)
+	case bronzehistoryawss3bucket.FieldLoggingTargetPrefix:
+		return m.OldLoggingTargetPrefix(ctx)
+	case bronzehistoryawss3bucket.FieldPolicyJSON:
+		return m.OldPolicyJSON(ctx)
+	case bronzehistoryawss3bucket.FieldACLGrantsJSON:
+		return m.OldACLGrantsJSON(ctx)
+	case bronzehistoryawss3bucket.FieldTagsJSON:
+		return m.OldTagsJSON(ctx)
+	case bronzehistoryawss3bucket.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case bronzehistoryawss3bucket.FieldRegion:
+		return m.OldRegion(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeHistoryAWSS3Bucket field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryAWSS3BucketMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryawss3bucket.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldValidTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidTo(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldArn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArn(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldCreationDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreationDate(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldOwnerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldBlockPublicAcls:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockPublicAcls(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldIgnorePublicAcls:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIgnorePublicAcls(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldBlockPublicPolicy:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockPublicPolicy(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldRestrictPublicBuckets:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRestrictPublicBuckets(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldPolicyIsPublic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicyIsPublic(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldSseAlgorithm:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSseAlgorithm(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldKmsMasterKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKmsMasterKeyID(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldBucketKeyEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBucketKeyEnabled(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldVersioningStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersioningStatus(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldMfaDelete:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaDelete(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldLoggingTargetBucket:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoggingTargetBucket(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldLoggingTargetPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoggingTargetPrefix(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldPolicyJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicyJSON(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldACLGrantsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetACLGrantsJSON(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldTagsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagsJSON(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldAccountID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case bronzehistoryawss3bucket.FieldRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSS3Bucket field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeHistoryAWSS3BucketMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryAWSS3BucketMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSS3Bucket numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzehistoryawss3bucket.FieldValidTo) {
+		fields = append(fields, bronzehistoryawss3bucket.FieldValidTo)
+	}
+	if m.FieldCleared(bronzehistoryawss3bucket.FieldArn) {
+		fields = append(fields, bronzehistoryawss3bucket.FieldArn)
+	}
+	if m.FieldCleared(bronzehistoryawss3bucket.FieldCreationDate) {
+		fields = append(fields, bronzehistoryawss3bucket.FieldCreationDate)
+	}
+	if m.FieldCleared(bronzehistoryawss3bucket.FieldOwnerID) {
+		fields = append(fields, bronzehistoryawss3bucket.FieldOwnerID)
+	}
+	if m.FieldCleared(bronzehistoryawss3bucket.FieldBlockPublicAcls) {
+		fields = append(fields, bronzehistoryawss3bucket.FieldBlockPublicAcls)
+	}
+	if m.FieldCleared(bronzehistoryawss3bucket.FieldIgnorePublicAcls) {
+		fields = append(fields, bronzehistoryawss3bucket.FieldIgnorePublicAcls)
+	}
+	if m.FieldCleared(bronzehistoryawss3bucket.FieldBlockPublicPolicy) {
+		fields = append(fields, bronzehistoryawss3bucket.FieldBlockPublicPolicy)
+	}
+	if m.FieldCleared(bronzehistoryawss3bucket.FieldRestrictPublicBuckets) {
+		fields = append(fields, bronzehistoryawss3bucket.FieldRestrictPublicBuckets)
+	}
+	if m.FieldCleared(bronzehistoryawss3bucket.FieldPolicyIsPublic) {
+		fields = append(fields, bronzehistoryawss3bucket.FieldPolicyIsPublic)
+	}
+	if m.FieldCleared(bronzehistoryawss3bucket.FieldSseAlgorithm) {
+		fields = append(fields, bronzehistoryawss3bucket.FieldSseAlgorithm)
+	}
+	if m.FieldCleared(bronzehistoryawss3bucket.FieldKmsMasterKeyID) {
+		fields = append(fields, bronzehistoryawss3bucket.FieldKmsMasterKeyID)
+	}
+	if m.FieldCleared(bronzehistoryawss3bucket.FieldBucketKeyEnabled) {
+		fields = append(fields, bronzehistoryawss3bucket.FieldBucketKeyEnabled)
+	}
+	if m.FieldCleared(bronzehistoryawss3bucket.FieldVersioningStatus) {
+		fields = append(fields, bronzehistoryawss3bucket.FieldVersioningStatus)
+	}
+	if m.FieldCleared(bronzehistoryawss3bucket.FieldMfaDelete) {
+		fields = append(fields, bronzehistoryawss3bucket.FieldMfaDelete)
+	}
+	if m.FieldCleared(bronzehistoryawss3bucket.FieldLoggingTargetBucket) {
+		fields = append(fields, bronzehistoryawss3bucket.FieldLoggingTargetBucket)
+	}
+	if m.FieldCleared(bronzehistoryawss3bucket.FieldLoggingTargetPrefix) {
+		fields = append(fields, bronzehistoryawss3bucket.FieldLoggingTargetPrefix)
+	}
+	if m.FieldCleared(bronzehistoryawss3bucket.FieldPolicyJSON) {
+		fields = append(fields, bronzehistoryawss3bucket.FieldPolicyJSON)
+	}
+	if m.FieldCleared(bronzehistoryawss3bucket.FieldACLGrantsJSON) {
+		fields = append(fields, bronzehistoryawss3bucket.FieldACLGrantsJSON)
+	}
+	if m.FieldCleared(bronzehistoryawss3bucket.FieldTagsJSON) {
+		fields = append(fields, bronzehistoryawss3bucket.FieldTagsJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearField(name string) error {
+	switch name {
+	case bronzehistoryawss3bucket.FieldValidTo:
+		m.ClearValidTo()
+		return nil
+	case bronzehistoryawss3bucket.FieldArn:
+		m.ClearArn()
+		return nil
+	case bronzehistoryawss3bucket.FieldCreationDate:
+		m.ClearCreationDate()
+		return nil
+	case bronzehistoryawss3bucket.FieldOwnerID:
+		m.ClearOwnerID()
+		return nil
+	case bronzehistoryawss3bucket.FieldBlockPublicAcls:
+		m.ClearBlockPublicAcls()
+		return nil
+	case bronzehistoryawss3bucket.FieldIgnorePublicAcls:
+		m.ClearIgnorePublicAcls()
+		return nil
+	case bronzehistoryawss3bucket.FieldBlockPublicPolicy:
+		m.ClearBlockPublicPolicy()
+		return nil
+	case bronzehistoryawss3bucket.FieldRestrictPublicBuckets:
+		m.ClearRestrictPublicBuckets()
+		return nil
+	case bronzehistoryawss3bucket.FieldPolicyIsPublic:
+		m.ClearPolicyIsPublic()
+		return nil
+	case bronzehistoryawss3bucket.FieldSseAlgorithm:
+		m.ClearSseAlgorithm()
+		return nil
+	case bronzehistoryawss3bucket.FieldKmsMasterKeyID:
+		m.ClearKmsMasterKeyID()
+		return nil
+	case bronzehistoryawss3bucket.FieldBucketKeyEnabled:
+		m.ClearBucketKeyEnabled()
+		return nil
+	case bronzehistoryawss3bucket.FieldVersioningStatus:
+		m.ClearVersioningStatus()
+		return nil
+	case bronzehistoryawss3bucket.FieldMfaDelete:
+		m.ClearMfaDelete()
+		return nil
+	case bronzehistoryawss3bucket.FieldLoggingTargetBucket:
+		m.ClearLoggingTargetBucket()
+		return nil
+	case bronzehistoryawss3bucket.FieldLoggingTargetPrefix:
+		m.ClearLoggingTargetPrefix()
+		return nil
+	case bronzehistoryawss3bucket.FieldPolicyJSON:
+		m.ClearPolicyJSON()
+		return nil
+	case bronzehistoryawss3bucket.FieldACLGrantsJSON:
+		m.ClearACLGrantsJSON()
+		return nil
+	case bronzehistoryawss3bucket.FieldTagsJSON:
+		m.ClearTagsJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSS3Bucket nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetField(name string) error {
+	switch name {
+	case bronzehistoryawss3bucket.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case bronzehistoryawss3bucket.FieldValidTo:
+		m.ResetValidTo()
+		return nil
+	case bronzehistoryawss3bucket.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzehistoryawss3bucket.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzehistoryawss3bucket.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case bronzehistoryawss3bucket.FieldArn:
+		m.ResetArn()
+		return nil
+	case bronzehistoryawss3bucket.FieldCreationDate:
+		m.ResetCreationDate()
+		return nil
+	case bronzehistoryawss3bucket.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case bronzehistoryawss3bucket.FieldBlockPublicAcls:
+		m.ResetBlockPublicAcls()
+		return nil
+	case bronzehistoryawss3bucket.FieldIgnorePublicAcls:
+		m.ResetIgnorePublicAcls()
+		return nil
+	case bronzehistoryawss3bucket.FieldBlockPublicPolicy:
+		m.ResetBlockPublicPolicy()
+		return nil
+	case bronzehistoryawss3bucket.FieldRestrictPublicBuckets:
+		m.ResetRestrictPublicBuckets()
+		return nil
+	case bronzehistoryawss3bucket.FieldPolicyIsPublic:
+		m.ResetPolicyIsPublic()
+		return nil
+	case bronzehistoryawss3bucket.FieldSseAlgorithm:
+		m.ResetSseAlgorithm()
+		return nil
+	case bronzehistoryawss3bucket.FieldKmsMasterKeyID:
+		m.ResetKmsMasterKeyID()
+		return nil
+	case bronzehistoryawss3bucket.FieldBucketKeyEnabled:
+		m.ResetBucketKeyEnabled()
+		return nil
+	case bronzehistoryawss3bucket.FieldVersioningStatus:
+		m.ResetVersioningStatus()
+		return nil
+	case bronzehistoryawss3bucket.FieldMfaDelete:
+		m.ResetMfaDelete()
+		return nil
+	case bronzehistoryawss3bucket.FieldLoggingTargetBucket:
+		m.ResetLoggingTargetBucket()
+		return nil
+	case bronzehistoryawss3bucket.FieldLoggingTargetPrefix:
+		m.ResetLoggingTargetPrefix()
+		return nil
+	case bronzehistoryawss3bucket.FieldPolicyJSON:
+		m.ResetPolicyJSON()
+		return nil
+	case bronzehistoryawss3bucket.FieldACLGrantsJSON:
+		m.ResetACLGrantsJSON()
+		return nil
+	case bronzehistoryawss3bucket.FieldTagsJSON:
+		m.ResetTagsJSON()
+		return nil
+	case bronzehistoryawss3bucket.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case bronzehistoryawss3bucket.FieldRegion:
+		m.ResetRegion()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryAWSS3Bucket field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeHistoryAWSS3BucketMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeHistoryAWSS3BucketMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryAWSS3Bucket unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeHistoryAWSS3BucketMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryAWSS3Bucket edge %s", name)
+}
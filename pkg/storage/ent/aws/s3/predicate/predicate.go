@@ -0,0 +1,13 @@
+// Code generated by ent, DO NOT EDIT.
+
+package predicate
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeAWSS3Bucket is the predicate function for bronzeawss3bucket builders.
+type BronzeAWSS3Bucket func(*sql.Selector)
+
+// BronzeHistoryAWSS3Bucket is the predicate function for bronzehistoryawss3bucket builders.
+type BronzeHistoryAWSS3Bucket func(*sql.Selector)
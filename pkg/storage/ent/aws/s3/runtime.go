@@ -0,0 +1,39 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s3
+
+import (
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/bronzeawss3bucket"
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/bronzehistoryawss3bucket"
+	"danny.vn/hotpot/pkg/storage/ent/aws/s3/schema"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	bronzeawss3bucketFields := schema.BronzeAWSS3Bucket{}.Fields()
+	_ = bronzeawss3bucketFields
+	// bronzeawss3bucketDescAccountID is the schema descriptor for account_id field.
+	bronzeawss3bucketDescAccountID := bronzeawss3bucketFields[19].Descriptor()
+	// bronzeawss3bucket.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzeawss3bucket.AccountIDValidator = bronzeawss3bucketDescAccountID.Validators[0].(func(string) error)
+	// bronzeawss3bucketDescRegion is the schema descriptor for region field.
+	bronzeawss3bucketDescRegion := bronzeawss3bucketFields[20].Descriptor()
+	// bronzeawss3bucket.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzeawss3bucket.RegionValidator = bronzeawss3bucketDescRegion.Validators[0].(func(string) error)
+	bronzehistoryawss3bucketFields := schema.BronzeHistoryAWSS3Bucket{}.Fields()
+	_ = bronzehistoryawss3bucketFields
+	// bronzehistoryawss3bucketDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryawss3bucketDescResourceID := bronzehistoryawss3bucketFields[1].Descriptor()
+	// bronzehistoryawss3bucket.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryawss3bucket.ResourceIDValidator = bronzehistoryawss3bucketDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryawss3bucketDescAccountID is the schema descriptor for account_id field.
+	bronzehistoryawss3bucketDescAccountID := bronzehistoryawss3bucketFields[20].Descriptor()
+	// bronzehistoryawss3bucket.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	bronzehistoryawss3bucket.AccountIDValidator = bronzehistoryawss3bucketDescAccountID.Validators[0].(func(string) error)
+	// bronzehistoryawss3bucketDescRegion is the schema descriptor for region field.
+	bronzehistoryawss3bucketDescRegion := bronzehistoryawss3bucketFields[21].Descriptor()
+	// bronzehistoryawss3bucket.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	bronzehistoryawss3bucket.RegionValidator = bronzehistoryawss3bucketDescRegion.Validators[0].(func(string) error)
+}
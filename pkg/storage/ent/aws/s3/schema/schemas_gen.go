@@ -0,0 +1,15 @@
+// Code generated by entcgen. DO NOT EDIT.
+package schema
+
+import (
+	bronze_aws_s3 "danny.vn/hotpot/pkg/schema/bronze/aws/s3"
+	bronzehistory_aws_s3 "danny.vn/hotpot/pkg/schema/bronzehistory/aws/s3"
+)
+
+type BronzeAWSS3Bucket struct {
+	bronze_aws_s3.BronzeAWSS3Bucket
+}
+
+type BronzeHistoryAWSS3Bucket struct {
+	bronzehistory_aws_s3.BronzeHistoryAWSS3Bucket
+}
@@ -0,0 +1,10 @@
+// Code generated by entcgen. DO NOT EDIT.
+package s3
+
+// DefaultSchemaConfig returns the schema config mapping each type to its PG schema.
+func DefaultSchemaConfig() SchemaConfig {
+	return SchemaConfig{
+		BronzeAWSS3Bucket:        "bronze",
+		BronzeHistoryAWSS3Bucket: "bronze_history",
+	}
+}
@@ -22,3 +22,19 @@ func (BronzeApicatalogEndpointsRaw) Annotations() []schema.Annotation {
 	}
 	return append(anns, entsql.Annotation{Schema: "bronze"})
 }
+
+type BronzeApicatalogOpenapiEndpoint struct {
+	bronze_apicatalog.BronzeApicatalogOpenapiEndpoint
+}
+
+func (BronzeApicatalogOpenapiEndpoint) Annotations() []schema.Annotation {
+	anns := bronze_apicatalog.BronzeApicatalogOpenapiEndpoint{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
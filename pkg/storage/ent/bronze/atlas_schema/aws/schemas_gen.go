@@ -2,11 +2,47 @@
 package schema
 
 import (
+	bronze_aws_cloudtrail "danny.vn/hotpot/pkg/schema/bronze/aws/cloudtrail"
 	bronze_aws_ec2 "danny.vn/hotpot/pkg/schema/bronze/aws/ec2"
+	bronze_aws_iam "danny.vn/hotpot/pkg/schema/bronze/aws/iam"
+	bronze_aws_kms "danny.vn/hotpot/pkg/schema/bronze/aws/kms"
+	bronze_aws_s3 "danny.vn/hotpot/pkg/schema/bronze/aws/s3"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 )
 
+type BronzeAWSCloudTrailTrail struct {
+	bronze_aws_cloudtrail.BronzeAWSCloudTrailTrail
+}
+
+func (BronzeAWSCloudTrailTrail) Annotations() []schema.Annotation {
+	anns := bronze_aws_cloudtrail.BronzeAWSCloudTrailTrail{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeAWSEC2Address struct {
+	bronze_aws_ec2.BronzeAWSEC2Address
+}
+
+func (BronzeAWSEC2Address) Annotations() []schema.Annotation {
+	anns := bronze_aws_ec2.BronzeAWSEC2Address{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
 type BronzeAWSEC2Instance struct {
 	bronze_aws_ec2.BronzeAWSEC2Instance
 }
@@ -38,3 +74,275 @@ func (BronzeAWSEC2InstanceTag) Annotations() []schema.Annotation {
 	}
 	return append(anns, entsql.Annotation{Schema: "bronze"})
 }
+
+type BronzeAWSEC2InternetGateway struct {
+	bronze_aws_ec2.BronzeAWSEC2InternetGateway
+}
+
+func (BronzeAWSEC2InternetGateway) Annotations() []schema.Annotation {
+	anns := bronze_aws_ec2.BronzeAWSEC2InternetGateway{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeAWSEC2NATGateway struct {
+	bronze_aws_ec2.BronzeAWSEC2NATGateway
+}
+
+func (BronzeAWSEC2NATGateway) Annotations() []schema.Annotation {
+	anns := bronze_aws_ec2.BronzeAWSEC2NATGateway{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeAWSEC2NetworkACL struct {
+	bronze_aws_ec2.BronzeAWSEC2NetworkACL
+}
+
+func (BronzeAWSEC2NetworkACL) Annotations() []schema.Annotation {
+	anns := bronze_aws_ec2.BronzeAWSEC2NetworkACL{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeAWSEC2NetworkInterface struct {
+	bronze_aws_ec2.BronzeAWSEC2NetworkInterface
+}
+
+func (BronzeAWSEC2NetworkInterface) Annotations() []schema.Annotation {
+	anns := bronze_aws_ec2.BronzeAWSEC2NetworkInterface{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeAWSEC2RouteTable struct {
+	bronze_aws_ec2.BronzeAWSEC2RouteTable
+}
+
+func (BronzeAWSEC2RouteTable) Annotations() []schema.Annotation {
+	anns := bronze_aws_ec2.BronzeAWSEC2RouteTable{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeAWSEC2SecurityGroup struct {
+	bronze_aws_ec2.BronzeAWSEC2SecurityGroup
+}
+
+func (BronzeAWSEC2SecurityGroup) Annotations() []schema.Annotation {
+	anns := bronze_aws_ec2.BronzeAWSEC2SecurityGroup{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeAWSEC2SecurityGroupRule struct {
+	bronze_aws_ec2.BronzeAWSEC2SecurityGroupRule
+}
+
+func (BronzeAWSEC2SecurityGroupRule) Annotations() []schema.Annotation {
+	anns := bronze_aws_ec2.BronzeAWSEC2SecurityGroupRule{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeAWSEC2Subnet struct {
+	bronze_aws_ec2.BronzeAWSEC2Subnet
+}
+
+func (BronzeAWSEC2Subnet) Annotations() []schema.Annotation {
+	anns := bronze_aws_ec2.BronzeAWSEC2Subnet{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeAWSEC2VPC struct {
+	bronze_aws_ec2.BronzeAWSEC2VPC
+}
+
+func (BronzeAWSEC2VPC) Annotations() []schema.Annotation {
+	anns := bronze_aws_ec2.BronzeAWSEC2VPC{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeAWSIAMAccessKey struct {
+	bronze_aws_iam.BronzeAWSIAMAccessKey
+}
+
+func (BronzeAWSIAMAccessKey) Annotations() []schema.Annotation {
+	anns := bronze_aws_iam.BronzeAWSIAMAccessKey{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeAWSIAMCredentialReport struct {
+	bronze_aws_iam.BronzeAWSIAMCredentialReport
+}
+
+func (BronzeAWSIAMCredentialReport) Annotations() []schema.Annotation {
+	anns := bronze_aws_iam.BronzeAWSIAMCredentialReport{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeAWSIAMGroup struct {
+	bronze_aws_iam.BronzeAWSIAMGroup
+}
+
+func (BronzeAWSIAMGroup) Annotations() []schema.Annotation {
+	anns := bronze_aws_iam.BronzeAWSIAMGroup{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeAWSIAMPolicy struct {
+	bronze_aws_iam.BronzeAWSIAMPolicy
+}
+
+func (BronzeAWSIAMPolicy) Annotations() []schema.Annotation {
+	anns := bronze_aws_iam.BronzeAWSIAMPolicy{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeAWSIAMRole struct {
+	bronze_aws_iam.BronzeAWSIAMRole
+}
+
+func (BronzeAWSIAMRole) Annotations() []schema.Annotation {
+	anns := bronze_aws_iam.BronzeAWSIAMRole{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeAWSIAMUser struct {
+	bronze_aws_iam.BronzeAWSIAMUser
+}
+
+func (BronzeAWSIAMUser) Annotations() []schema.Annotation {
+	anns := bronze_aws_iam.BronzeAWSIAMUser{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeAWSKMSKey struct {
+	bronze_aws_kms.BronzeAWSKMSKey
+}
+
+func (BronzeAWSKMSKey) Annotations() []schema.Annotation {
+	anns := bronze_aws_kms.BronzeAWSKMSKey{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeAWSS3Bucket struct {
+	bronze_aws_s3.BronzeAWSS3Bucket
+}
+
+func (BronzeAWSS3Bucket) Annotations() []schema.Annotation {
+	anns := bronze_aws_s3.BronzeAWSS3Bucket{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
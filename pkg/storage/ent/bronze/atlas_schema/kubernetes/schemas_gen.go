@@ -0,0 +1,136 @@
+// Code generated by entcgen. DO NOT EDIT.
+package schema
+
+import (
+	bronze_kubernetes "danny.vn/hotpot/pkg/schema/bronze/kubernetes"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+)
+
+type BronzeK8sImage struct {
+	bronze_kubernetes.BronzeK8sImage
+}
+
+func (BronzeK8sImage) Annotations() []schema.Annotation {
+	anns := bronze_kubernetes.BronzeK8sImage{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeK8sNamespace struct {
+	bronze_kubernetes.BronzeK8sNamespace
+}
+
+func (BronzeK8sNamespace) Annotations() []schema.Annotation {
+	anns := bronze_kubernetes.BronzeK8sNamespace{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeK8sNetworkPolicy struct {
+	bronze_kubernetes.BronzeK8sNetworkPolicy
+}
+
+func (BronzeK8sNetworkPolicy) Annotations() []schema.Annotation {
+	anns := bronze_kubernetes.BronzeK8sNetworkPolicy{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeK8sNode struct {
+	bronze_kubernetes.BronzeK8sNode
+}
+
+func (BronzeK8sNode) Annotations() []schema.Annotation {
+	anns := bronze_kubernetes.BronzeK8sNode{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeK8sPod struct {
+	bronze_kubernetes.BronzeK8sPod
+}
+
+func (BronzeK8sPod) Annotations() []schema.Annotation {
+	anns := bronze_kubernetes.BronzeK8sPod{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeK8sRoleBinding struct {
+	bronze_kubernetes.BronzeK8sRoleBinding
+}
+
+func (BronzeK8sRoleBinding) Annotations() []schema.Annotation {
+	anns := bronze_kubernetes.BronzeK8sRoleBinding{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeK8sServiceAccount struct {
+	bronze_kubernetes.BronzeK8sServiceAccount
+}
+
+func (BronzeK8sServiceAccount) Annotations() []schema.Annotation {
+	anns := bronze_kubernetes.BronzeK8sServiceAccount{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeK8sWorkload struct {
+	bronze_kubernetes.BronzeK8sWorkload
+}
+
+func (BronzeK8sWorkload) Annotations() []schema.Annotation {
+	anns := bronze_kubernetes.BronzeK8sWorkload{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
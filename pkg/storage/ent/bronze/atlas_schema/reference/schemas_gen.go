@@ -71,6 +71,38 @@ func (BronzeReferenceEOLProduct) Annotations() []schema.Annotation {
 	return append(anns, entsql.Annotation{Schema: "bronze"})
 }
 
+type BronzeReferenceNVDCPEMatch struct {
+	bronze_reference.BronzeReferenceNVDCPEMatch
+}
+
+func (BronzeReferenceNVDCPEMatch) Annotations() []schema.Annotation {
+	anns := bronze_reference.BronzeReferenceNVDCPEMatch{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeReferenceNVDCVE struct {
+	bronze_reference.BronzeReferenceNVDCVE
+}
+
+func (BronzeReferenceNVDCVE) Annotations() []schema.Annotation {
+	anns := bronze_reference.BronzeReferenceNVDCVE{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
 type BronzeReferenceOSCoreRule struct {
 	bronze_reference.BronzeReferenceOSCoreRule
 }
@@ -87,6 +119,38 @@ func (BronzeReferenceOSCoreRule) Annotations() []schema.Annotation {
 	return append(anns, entsql.Annotation{Schema: "bronze"})
 }
 
+type BronzeReferenceOSVAffected struct {
+	bronze_reference.BronzeReferenceOSVAffected
+}
+
+func (BronzeReferenceOSVAffected) Annotations() []schema.Annotation {
+	anns := bronze_reference.BronzeReferenceOSVAffected{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeReferenceOSVVuln struct {
+	bronze_reference.BronzeReferenceOSVVuln
+}
+
+func (BronzeReferenceOSVVuln) Annotations() []schema.Annotation {
+	anns := bronze_reference.BronzeReferenceOSVVuln{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
 type BronzeReferenceRPMPackage struct {
 	bronze_reference.BronzeReferenceRPMPackage
 }
@@ -119,6 +183,22 @@ func (BronzeReferenceSoftwareMatchRule) Annotations() []schema.Annotation {
 	return append(anns, entsql.Annotation{Schema: "bronze"})
 }
 
+type BronzeReferenceThreatIntelIndicator struct {
+	bronze_reference.BronzeReferenceThreatIntelIndicator
+}
+
+func (BronzeReferenceThreatIntelIndicator) Annotations() []schema.Annotation {
+	anns := bronze_reference.BronzeReferenceThreatIntelIndicator{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
 type BronzeReferenceUbuntuPackage struct {
 	bronze_reference.BronzeReferenceUbuntuPackage
 }
@@ -23,6 +23,22 @@ func (BronzeS1Account) Annotations() []schema.Annotation {
 	return append(anns, entsql.Annotation{Schema: "bronze"})
 }
 
+type BronzeS1Activity struct {
+	bronze_s1.BronzeS1Activity
+}
+
+func (BronzeS1Activity) Annotations() []schema.Annotation {
+	anns := bronze_s1.BronzeS1Activity{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
 type BronzeS1Agent struct {
 	bronze_s1.BronzeS1Agent
 }
@@ -55,6 +71,38 @@ func (BronzeS1AgentNIC) Annotations() []schema.Annotation {
 	return append(anns, entsql.Annotation{Schema: "bronze"})
 }
 
+type BronzeS1Alert struct {
+	bronze_s1.BronzeS1Alert
+}
+
+func (BronzeS1Alert) Annotations() []schema.Annotation {
+	anns := bronze_s1.BronzeS1Alert{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
+type BronzeS1AppCVE struct {
+	bronze_s1.BronzeS1AppCVE
+}
+
+func (BronzeS1AppCVE) Annotations() []schema.Annotation {
+	anns := bronze_s1.BronzeS1AppCVE{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
 type BronzeS1AppInventory struct {
 	bronze_s1.BronzeS1AppInventory
 }
@@ -87,6 +135,22 @@ func (BronzeS1EndpointApp) Annotations() []schema.Annotation {
 	return append(anns, entsql.Annotation{Schema: "bronze"})
 }
 
+type BronzeS1Exclusion struct {
+	bronze_s1.BronzeS1Exclusion
+}
+
+func (BronzeS1Exclusion) Annotations() []schema.Annotation {
+	anns := bronze_s1.BronzeS1Exclusion{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
 type BronzeS1Group struct {
 	bronze_s1.BronzeS1Group
 }
@@ -103,6 +167,22 @@ func (BronzeS1Group) Annotations() []schema.Annotation {
 	return append(anns, entsql.Annotation{Schema: "bronze"})
 }
 
+type BronzeS1IngestCursor struct {
+	bronze_s1.BronzeS1IngestCursor
+}
+
+func (BronzeS1IngestCursor) Annotations() []schema.Annotation {
+	anns := bronze_s1.BronzeS1IngestCursor{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
 type BronzeS1NetworkDiscovery struct {
 	bronze_s1.BronzeS1NetworkDiscovery
 }
@@ -119,6 +199,22 @@ func (BronzeS1NetworkDiscovery) Annotations() []schema.Annotation {
 	return append(anns, entsql.Annotation{Schema: "bronze"})
 }
 
+type BronzeS1Policy struct {
+	bronze_s1.BronzeS1Policy
+}
+
+func (BronzeS1Policy) Annotations() []schema.Annotation {
+	anns := bronze_s1.BronzeS1Policy{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
 type BronzeS1RangerDevice struct {
 	bronze_s1.BronzeS1RangerDevice
 }
@@ -167,6 +263,22 @@ func (BronzeS1RangerSetting) Annotations() []schema.Annotation {
 	return append(anns, entsql.Annotation{Schema: "bronze"})
 }
 
+type BronzeS1Restriction struct {
+	bronze_s1.BronzeS1Restriction
+}
+
+func (BronzeS1Restriction) Annotations() []schema.Annotation {
+	anns := bronze_s1.BronzeS1Restriction{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
+
 type BronzeS1Site struct {
 	bronze_s1.BronzeS1Site
 }
@@ -182,3 +294,19 @@ func (BronzeS1Site) Annotations() []schema.Annotation {
 	}
 	return append(anns, entsql.Annotation{Schema: "bronze"})
 }
+
+type BronzeS1Threat struct {
+	bronze_s1.BronzeS1Threat
+}
+
+func (BronzeS1Threat) Annotations() []schema.Annotation {
+	anns := bronze_s1.BronzeS1Threat{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze"})
+}
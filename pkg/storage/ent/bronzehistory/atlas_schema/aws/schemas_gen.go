@@ -2,11 +2,47 @@
 package schema
 
 import (
+	bronzehistory_aws_cloudtrail "danny.vn/hotpot/pkg/schema/bronzehistory/aws/cloudtrail"
 	bronzehistory_aws_ec2 "danny.vn/hotpot/pkg/schema/bronzehistory/aws/ec2"
+	bronzehistory_aws_iam "danny.vn/hotpot/pkg/schema/bronzehistory/aws/iam"
+	bronzehistory_aws_kms "danny.vn/hotpot/pkg/schema/bronzehistory/aws/kms"
+	bronzehistory_aws_s3 "danny.vn/hotpot/pkg/schema/bronzehistory/aws/s3"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 )
 
+type BronzeHistoryAWSCloudTrailTrail struct {
+	bronzehistory_aws_cloudtrail.BronzeHistoryAWSCloudTrailTrail
+}
+
+func (BronzeHistoryAWSCloudTrailTrail) Annotations() []schema.Annotation {
+	anns := bronzehistory_aws_cloudtrail.BronzeHistoryAWSCloudTrailTrail{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryAWSEC2Address struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2Address
+}
+
+func (BronzeHistoryAWSEC2Address) Annotations() []schema.Annotation {
+	anns := bronzehistory_aws_ec2.BronzeHistoryAWSEC2Address{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
 type BronzeHistoryAWSEC2Instance struct {
 	bronzehistory_aws_ec2.BronzeHistoryAWSEC2Instance
 }
@@ -38,3 +74,275 @@ func (BronzeHistoryAWSEC2InstanceTag) Annotations() []schema.Annotation {
 	}
 	return append(anns, entsql.Annotation{Schema: "bronze_history"})
 }
+
+type BronzeHistoryAWSEC2InternetGateway struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2InternetGateway
+}
+
+func (BronzeHistoryAWSEC2InternetGateway) Annotations() []schema.Annotation {
+	anns := bronzehistory_aws_ec2.BronzeHistoryAWSEC2InternetGateway{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryAWSEC2NATGateway struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2NATGateway
+}
+
+func (BronzeHistoryAWSEC2NATGateway) Annotations() []schema.Annotation {
+	anns := bronzehistory_aws_ec2.BronzeHistoryAWSEC2NATGateway{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryAWSEC2NetworkACL struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2NetworkACL
+}
+
+func (BronzeHistoryAWSEC2NetworkACL) Annotations() []schema.Annotation {
+	anns := bronzehistory_aws_ec2.BronzeHistoryAWSEC2NetworkACL{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryAWSEC2NetworkInterface struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2NetworkInterface
+}
+
+func (BronzeHistoryAWSEC2NetworkInterface) Annotations() []schema.Annotation {
+	anns := bronzehistory_aws_ec2.BronzeHistoryAWSEC2NetworkInterface{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryAWSEC2RouteTable struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2RouteTable
+}
+
+func (BronzeHistoryAWSEC2RouteTable) Annotations() []schema.Annotation {
+	anns := bronzehistory_aws_ec2.BronzeHistoryAWSEC2RouteTable{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryAWSEC2SecurityGroup struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2SecurityGroup
+}
+
+func (BronzeHistoryAWSEC2SecurityGroup) Annotations() []schema.Annotation {
+	anns := bronzehistory_aws_ec2.BronzeHistoryAWSEC2SecurityGroup{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryAWSEC2SecurityGroupRule struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2SecurityGroupRule
+}
+
+func (BronzeHistoryAWSEC2SecurityGroupRule) Annotations() []schema.Annotation {
+	anns := bronzehistory_aws_ec2.BronzeHistoryAWSEC2SecurityGroupRule{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryAWSEC2Subnet struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2Subnet
+}
+
+func (BronzeHistoryAWSEC2Subnet) Annotations() []schema.Annotation {
+	anns := bronzehistory_aws_ec2.BronzeHistoryAWSEC2Subnet{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryAWSEC2VPC struct {
+	bronzehistory_aws_ec2.BronzeHistoryAWSEC2VPC
+}
+
+func (BronzeHistoryAWSEC2VPC) Annotations() []schema.Annotation {
+	anns := bronzehistory_aws_ec2.BronzeHistoryAWSEC2VPC{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryAWSIAMAccessKey struct {
+	bronzehistory_aws_iam.BronzeHistoryAWSIAMAccessKey
+}
+
+func (BronzeHistoryAWSIAMAccessKey) Annotations() []schema.Annotation {
+	anns := bronzehistory_aws_iam.BronzeHistoryAWSIAMAccessKey{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryAWSIAMCredentialReport struct {
+	bronzehistory_aws_iam.BronzeHistoryAWSIAMCredentialReport
+}
+
+func (BronzeHistoryAWSIAMCredentialReport) Annotations() []schema.Annotation {
+	anns := bronzehistory_aws_iam.BronzeHistoryAWSIAMCredentialReport{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryAWSIAMGroup struct {
+	bronzehistory_aws_iam.BronzeHistoryAWSIAMGroup
+}
+
+func (BronzeHistoryAWSIAMGroup) Annotations() []schema.Annotation {
+	anns := bronzehistory_aws_iam.BronzeHistoryAWSIAMGroup{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryAWSIAMPolicy struct {
+	bronzehistory_aws_iam.BronzeHistoryAWSIAMPolicy
+}
+
+func (BronzeHistoryAWSIAMPolicy) Annotations() []schema.Annotation {
+	anns := bronzehistory_aws_iam.BronzeHistoryAWSIAMPolicy{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryAWSIAMRole struct {
+	bronzehistory_aws_iam.BronzeHistoryAWSIAMRole
+}
+
+func (BronzeHistoryAWSIAMRole) Annotations() []schema.Annotation {
+	anns := bronzehistory_aws_iam.BronzeHistoryAWSIAMRole{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryAWSIAMUser struct {
+	bronzehistory_aws_iam.BronzeHistoryAWSIAMUser
+}
+
+func (BronzeHistoryAWSIAMUser) Annotations() []schema.Annotation {
+	anns := bronzehistory_aws_iam.BronzeHistoryAWSIAMUser{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryAWSKMSKey struct {
+	bronzehistory_aws_kms.BronzeHistoryAWSKMSKey
+}
+
+func (BronzeHistoryAWSKMSKey) Annotations() []schema.Annotation {
+	anns := bronzehistory_aws_kms.BronzeHistoryAWSKMSKey{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryAWSS3Bucket struct {
+	bronzehistory_aws_s3.BronzeHistoryAWSS3Bucket
+}
+
+func (BronzeHistoryAWSS3Bucket) Annotations() []schema.Annotation {
+	anns := bronzehistory_aws_s3.BronzeHistoryAWSS3Bucket{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
@@ -0,0 +1,136 @@
+// Code generated by entcgen. DO NOT EDIT.
+package schema
+
+import (
+	bronzehistory_kubernetes "danny.vn/hotpot/pkg/schema/bronzehistory/kubernetes"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+)
+
+type BronzeHistoryK8sImage struct {
+	bronzehistory_kubernetes.BronzeHistoryK8sImage
+}
+
+func (BronzeHistoryK8sImage) Annotations() []schema.Annotation {
+	anns := bronzehistory_kubernetes.BronzeHistoryK8sImage{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryK8sNamespace struct {
+	bronzehistory_kubernetes.BronzeHistoryK8sNamespace
+}
+
+func (BronzeHistoryK8sNamespace) Annotations() []schema.Annotation {
+	anns := bronzehistory_kubernetes.BronzeHistoryK8sNamespace{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryK8sNetworkPolicy struct {
+	bronzehistory_kubernetes.BronzeHistoryK8sNetworkPolicy
+}
+
+func (BronzeHistoryK8sNetworkPolicy) Annotations() []schema.Annotation {
+	anns := bronzehistory_kubernetes.BronzeHistoryK8sNetworkPolicy{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryK8sNode struct {
+	bronzehistory_kubernetes.BronzeHistoryK8sNode
+}
+
+func (BronzeHistoryK8sNode) Annotations() []schema.Annotation {
+	anns := bronzehistory_kubernetes.BronzeHistoryK8sNode{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryK8sPod struct {
+	bronzehistory_kubernetes.BronzeHistoryK8sPod
+}
+
+func (BronzeHistoryK8sPod) Annotations() []schema.Annotation {
+	anns := bronzehistory_kubernetes.BronzeHistoryK8sPod{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryK8sRoleBinding struct {
+	bronzehistory_kubernetes.BronzeHistoryK8sRoleBinding
+}
+
+func (BronzeHistoryK8sRoleBinding) Annotations() []schema.Annotation {
+	anns := bronzehistory_kubernetes.BronzeHistoryK8sRoleBinding{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryK8sServiceAccount struct {
+	bronzehistory_kubernetes.BronzeHistoryK8sServiceAccount
+}
+
+func (BronzeHistoryK8sServiceAccount) Annotations() []schema.Annotation {
+	anns := bronzehistory_kubernetes.BronzeHistoryK8sServiceAccount{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryK8sWorkload struct {
+	bronzehistory_kubernetes.BronzeHistoryK8sWorkload
+}
+
+func (BronzeHistoryK8sWorkload) Annotations() []schema.Annotation {
+	anns := bronzehistory_kubernetes.BronzeHistoryK8sWorkload{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
@@ -55,6 +55,38 @@ func (BronzeHistoryS1AgentNIC) Annotations() []schema.Annotation {
 	return append(anns, entsql.Annotation{Schema: "bronze_history"})
 }
 
+type BronzeHistoryS1Alert struct {
+	bronzehistory_s1.BronzeHistoryS1Alert
+}
+
+func (BronzeHistoryS1Alert) Annotations() []schema.Annotation {
+	anns := bronzehistory_s1.BronzeHistoryS1Alert{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
+type BronzeHistoryS1AppCVE struct {
+	bronzehistory_s1.BronzeHistoryS1AppCVE
+}
+
+func (BronzeHistoryS1AppCVE) Annotations() []schema.Annotation {
+	anns := bronzehistory_s1.BronzeHistoryS1AppCVE{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
 type BronzeHistoryS1AppInventory struct {
 	bronzehistory_s1.BronzeHistoryS1AppInventory
 }
@@ -87,6 +119,22 @@ func (BronzeHistoryS1EndpointApp) Annotations() []schema.Annotation {
 	return append(anns, entsql.Annotation{Schema: "bronze_history"})
 }
 
+type BronzeHistoryS1Exclusion struct {
+	bronzehistory_s1.BronzeHistoryS1Exclusion
+}
+
+func (BronzeHistoryS1Exclusion) Annotations() []schema.Annotation {
+	anns := bronzehistory_s1.BronzeHistoryS1Exclusion{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
 type BronzeHistoryS1Group struct {
 	bronzehistory_s1.BronzeHistoryS1Group
 }
@@ -119,6 +167,22 @@ func (BronzeHistoryS1NetworkDiscovery) Annotations() []schema.Annotation {
 	return append(anns, entsql.Annotation{Schema: "bronze_history"})
 }
 
+type BronzeHistoryS1Policy struct {
+	bronzehistory_s1.BronzeHistoryS1Policy
+}
+
+func (BronzeHistoryS1Policy) Annotations() []schema.Annotation {
+	anns := bronzehistory_s1.BronzeHistoryS1Policy{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
 type BronzeHistoryS1RangerDevice struct {
 	bronzehistory_s1.BronzeHistoryS1RangerDevice
 }
@@ -167,6 +231,22 @@ func (BronzeHistoryS1RangerSetting) Annotations() []schema.Annotation {
 	return append(anns, entsql.Annotation{Schema: "bronze_history"})
 }
 
+type BronzeHistoryS1Restriction struct {
+	bronzehistory_s1.BronzeHistoryS1Restriction
+}
+
+func (BronzeHistoryS1Restriction) Annotations() []schema.Annotation {
+	anns := bronzehistory_s1.BronzeHistoryS1Restriction{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
+
 type BronzeHistoryS1Site struct {
 	bronzehistory_s1.BronzeHistoryS1Site
 }
@@ -182,3 +262,19 @@ func (BronzeHistoryS1Site) Annotations() []schema.Annotation {
 	}
 	return append(anns, entsql.Annotation{Schema: "bronze_history"})
 }
+
+type BronzeHistoryS1Threat struct {
+	bronzehistory_s1.BronzeHistoryS1Threat
+}
+
+func (BronzeHistoryS1Threat) Annotations() []schema.Annotation {
+	anns := bronzehistory_s1.BronzeHistoryS1Threat{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "bronze_history"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "bronze_history"})
+}
@@ -23,6 +23,22 @@ func (ConfigAuthEndpointPattern) Annotations() []schema.Annotation {
 	return append(anns, entsql.Annotation{Schema: "config"})
 }
 
+type ConfigDetectionRule struct {
+	config_rule.ConfigDetectionRule
+}
+
+func (ConfigDetectionRule) Annotations() []schema.Annotation {
+	anns := config_rule.ConfigDetectionRule{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "config"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "config"})
+}
+
 type ConfigHostingIndicator struct {
 	config_rule.ConfigHostingIndicator
 }
@@ -71,6 +87,22 @@ func (ConfigLibraryUa) Annotations() []schema.Annotation {
 	return append(anns, entsql.Annotation{Schema: "config"})
 }
 
+type ConfigNotificationRule struct {
+	config_rule.ConfigNotificationRule
+}
+
+func (ConfigNotificationRule) Annotations() []schema.Annotation {
+	anns := config_rule.ConfigNotificationRule{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "config"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "config"})
+}
+
 type ConfigOsCoreRule struct {
 	config_rule.ConfigOsCoreRule
 }
@@ -0,0 +1,24 @@
+// Code generated by entcgen. DO NOT EDIT.
+package schema
+
+import (
+	gold_notify "danny.vn/hotpot/pkg/schema/gold/notify"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+)
+
+type GoldNotifyDelivery struct {
+	gold_notify.GoldNotifyDelivery
+}
+
+func (GoldNotifyDelivery) Annotations() []schema.Annotation {
+	anns := gold_notify.GoldNotifyDelivery{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "gold"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "gold"})
+}
@@ -0,0 +1,24 @@
+// Code generated by entcgen. DO NOT EDIT.
+package schema
+
+import (
+	gold_posture "danny.vn/hotpot/pkg/schema/gold/posture"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+)
+
+type GoldPostureFinding struct {
+	gold_posture.GoldPostureFinding
+}
+
+func (GoldPostureFinding) Annotations() []schema.Annotation {
+	anns := gold_posture.GoldPostureFinding{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "gold"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "gold"})
+}
@@ -0,0 +1,24 @@
+// Code generated by entcgen. DO NOT EDIT.
+package schema
+
+import (
+	gold_triage "danny.vn/hotpot/pkg/schema/gold/triage"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+)
+
+type GoldTriageAudit struct {
+	gold_triage.GoldTriageAudit
+}
+
+func (GoldTriageAudit) Annotations() []schema.Annotation {
+	anns := gold_triage.GoldTriageAudit{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "gold"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "gold"})
+}
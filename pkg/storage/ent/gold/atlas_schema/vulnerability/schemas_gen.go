@@ -0,0 +1,24 @@
+// Code generated by entcgen. DO NOT EDIT.
+package schema
+
+import (
+	gold_vulnerability "danny.vn/hotpot/pkg/schema/gold/vulnerability"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+)
+
+type GoldVulnerabilityFinding struct {
+	gold_vulnerability.GoldVulnerabilityFinding
+}
+
+func (GoldVulnerabilityFinding) Annotations() []schema.Annotation {
+	anns := gold_vulnerability.GoldVulnerabilityFinding{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "gold"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "gold"})
+}
@@ -22,11 +22,23 @@ type GoldHttpmonitorAnomaly struct {
 	DetectedAt time.Time `json:"detected_at,omitempty"`
 	// FirstDetectedAt holds the value of the "first_detected_at" field.
 	FirstDetectedAt time.Time `json:"first_detected_at,omitempty"`
+	// open, acknowledged, suppressed, false_positive, resolved
+	Status string `json:"status,omitempty"`
+	// Assignee holds the value of the "assignee" field.
+	Assignee string `json:"assignee,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// Suppressed findings reopen when re-detected after this time
+	SuppressedUntil *time.Time `json:"suppressed_until,omitempty"`
+	// Set when a finding is no longer detected, cleared on reopen
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// Advances every time the detector observes the finding
+	LastSeenAt time.Time `json:"last_seen_at,omitempty"`
 	// EndpointID holds the value of the "endpoint_id" field.
 	EndpointID string `json:"endpoint_id,omitempty"`
 	// SourceID holds the value of the "source_id" field.
 	SourceID string `json:"source_id,omitempty"`
-	// traffic_spike, traffic_drop, error_burst, 5xx_burst, new_endpoint, scanner_detected, single_ip_flood, method_mismatch, new_user_agent, ua_share_shift, automated_client, ua_spoofing, new_source_ip, geo_shift, external_on_internal, ip_concentration, ip_rotation, new_asn, hosting_provider, asn_concentration, response_size_anomaly, off_hours_spike, endpoint_enumeration, sanctioned_country, path_traversal, sql_injection_probe, command_injection_probe, xss_probe, ssrf_probe, auth_failure_burst, credential_stuffing, otp_brute_force, privilege_escalation_probe, password_reset_flood, registration_abuse, rate_limit_triggered, auth_success_after_burst, bulk_data_extraction, pagination_scraping
+	// traffic_spike, traffic_drop, error_burst, 5xx_burst, new_endpoint, scanner_detected, single_ip_flood, method_mismatch, new_user_agent, ua_share_shift, automated_client, ua_spoofing, new_source_ip, geo_shift, external_on_internal, ip_concentration, ip_rotation, new_asn, hosting_provider, asn_concentration, response_size_anomaly, off_hours_spike, endpoint_enumeration, sanctioned_country, tor_exit_node, known_scanner_ip, blocklisted_ip, path_traversal, sql_injection_probe, command_injection_probe, xss_probe, ssrf_probe, auth_failure_burst, credential_stuffing, otp_brute_force, privilege_escalation_probe, password_reset_flood, registration_abuse, rate_limit_triggered, auth_success_after_burst, bulk_data_extraction, pagination_scraping
 	AnomalyType string `json:"anomaly_type,omitempty"`
 	// info, low, medium, high, critical
 	Severity string `json:"severity,omitempty"`
@@ -60,9 +72,9 @@ func (*GoldHttpmonitorAnomaly) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case goldhttpmonitoranomaly.FieldBaselineValue, goldhttpmonitoranomaly.FieldActualValue, goldhttpmonitoranomaly.FieldDeviation:
 			values[i] = new(sql.NullFloat64)
-		case goldhttpmonitoranomaly.FieldID, goldhttpmonitoranomaly.FieldEndpointID, goldhttpmonitoranomaly.FieldSourceID, goldhttpmonitoranomaly.FieldAnomalyType, goldhttpmonitoranomaly.FieldSeverity, goldhttpmonitoranomaly.FieldURI, goldhttpmonitoranomaly.FieldMethod, goldhttpmonitoranomaly.FieldDescription:
+		case goldhttpmonitoranomaly.FieldID, goldhttpmonitoranomaly.FieldStatus, goldhttpmonitoranomaly.FieldAssignee, goldhttpmonitoranomaly.FieldNote, goldhttpmonitoranomaly.FieldEndpointID, goldhttpmonitoranomaly.FieldSourceID, goldhttpmonitoranomaly.FieldAnomalyType, goldhttpmonitoranomaly.FieldSeverity, goldhttpmonitoranomaly.FieldURI, goldhttpmonitoranomaly.FieldMethod, goldhttpmonitoranomaly.FieldDescription:
 			values[i] = new(sql.NullString)
-		case goldhttpmonitoranomaly.FieldDetectedAt, goldhttpmonitoranomaly.FieldFirstDetectedAt, goldhttpmonitoranomaly.FieldWindowStart, goldhttpmonitoranomaly.FieldWindowEnd:
+		case goldhttpmonitoranomaly.FieldDetectedAt, goldhttpmonitoranomaly.FieldFirstDetectedAt, goldhttpmonitoranomaly.FieldSuppressedUntil, goldhttpmonitoranomaly.FieldResolvedAt, goldhttpmonitoranomaly.FieldLastSeenAt, goldhttpmonitoranomaly.FieldWindowStart, goldhttpmonitoranomaly.FieldWindowEnd:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -97,6 +109,44 @@ func (_m *GoldHttpmonitorAnomaly) assignValues(columns []string, values []any) e
 			} else if value.Valid {
 				_m.FirstDetectedAt = value.Time
 			}
+		case goldhttpmonitoranomaly.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case goldhttpmonitoranomaly.FieldAssignee:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field assignee", values[i])
+			} else if value.Valid {
+				_m.Assignee = value.String
+			}
+		case goldhttpmonitoranomaly.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case goldhttpmonitoranomaly.FieldSuppressedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field suppressed_until", values[i])
+			} else if value.Valid {
+				_m.SuppressedUntil = new(time.Time)
+				*_m.SuppressedUntil = value.Time
+			}
+		case goldhttpmonitoranomaly.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				_m.ResolvedAt = new(time.Time)
+				*_m.ResolvedAt = value.Time
+			}
+		case goldhttpmonitoranomaly.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				_m.LastSeenAt = value.Time
+			}
 		case goldhttpmonitoranomaly.FieldEndpointID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field endpoint_id", values[i])
@@ -219,6 +269,28 @@ func (_m *GoldHttpmonitorAnomaly) String() string {
 	builder.WriteString("first_detected_at=")
 	builder.WriteString(_m.FirstDetectedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("assignee=")
+	builder.WriteString(_m.Assignee)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	if v := _m.SuppressedUntil; v != nil {
+		builder.WriteString("suppressed_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_seen_at=")
+	builder.WriteString(_m.LastSeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("endpoint_id=")
 	builder.WriteString(_m.EndpointID)
 	builder.WriteString(", ")
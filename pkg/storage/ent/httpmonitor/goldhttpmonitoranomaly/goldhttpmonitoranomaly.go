@@ -15,6 +15,18 @@ const (
 	FieldDetectedAt = "detected_at"
 	// FieldFirstDetectedAt holds the string denoting the first_detected_at field in the database.
 	FieldFirstDetectedAt = "first_detected_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAssignee holds the string denoting the assignee field in the database.
+	FieldAssignee = "assignee"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldSuppressedUntil holds the string denoting the suppressed_until field in the database.
+	FieldSuppressedUntil = "suppressed_until"
+	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
+	FieldResolvedAt = "resolved_at"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
 	// FieldEndpointID holds the string denoting the endpoint_id field in the database.
 	FieldEndpointID = "endpoint_id"
 	// FieldSourceID holds the string denoting the source_id field in the database.
@@ -50,6 +62,12 @@ var Columns = []string{
 	FieldID,
 	FieldDetectedAt,
 	FieldFirstDetectedAt,
+	FieldStatus,
+	FieldAssignee,
+	FieldNote,
+	FieldSuppressedUntil,
+	FieldResolvedAt,
+	FieldLastSeenAt,
 	FieldEndpointID,
 	FieldSourceID,
 	FieldAnomalyType,
@@ -76,6 +94,10 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
 	// SourceIDValidator is a validator for the "source_id" field. It is called by the builders before save.
 	SourceIDValidator func(string) error
 	// AnomalyTypeValidator is a validator for the "anomaly_type" field. It is called by the builders before save.
@@ -102,6 +124,36 @@ func ByFirstDetectedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFirstDetectedAt, opts...).ToFunc()
 }
 
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAssignee orders the results by the assignee field.
+func ByAssignee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssignee, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// BySuppressedUntil orders the results by the suppressed_until field.
+func BySuppressedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuppressedUntil, opts...).ToFunc()
+}
+
+// ByResolvedAt orders the results by the resolved_at field.
+func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
+}
+
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
+
 // ByEndpointID orders the results by the endpoint_id field.
 func ByEndpointID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEndpointID, opts...).ToFunc()
@@ -74,6 +74,36 @@ func FirstDetectedAt(v time.Time) predicate.GoldHttpmonitorAnomaly {
 	return predicate.GoldHttpmonitorAnomaly(sql.FieldEQ(FieldFirstDetectedAt, v))
 }
 
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldEQ(FieldStatus, v))
+}
+
+// Assignee applies equality check predicate on the "assignee" field. It's identical to AssigneeEQ.
+func Assignee(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldEQ(FieldAssignee, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldEQ(FieldNote, v))
+}
+
+// SuppressedUntil applies equality check predicate on the "suppressed_until" field. It's identical to SuppressedUntilEQ.
+func SuppressedUntil(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldEQ(FieldSuppressedUntil, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
 // EndpointID applies equality check predicate on the "endpoint_id" field. It's identical to EndpointIDEQ.
 func EndpointID(v string) predicate.GoldHttpmonitorAnomaly {
 	return predicate.GoldHttpmonitorAnomaly(sql.FieldEQ(FieldEndpointID, v))
@@ -214,6 +244,361 @@ func FirstDetectedAtLTE(v time.Time) predicate.GoldHttpmonitorAnomaly {
 	return predicate.GoldHttpmonitorAnomaly(sql.FieldLTE(FieldFirstDetectedAt, v))
 }
 
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// AssigneeEQ applies the EQ predicate on the "assignee" field.
+func AssigneeEQ(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldEQ(FieldAssignee, v))
+}
+
+// AssigneeNEQ applies the NEQ predicate on the "assignee" field.
+func AssigneeNEQ(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldNEQ(FieldAssignee, v))
+}
+
+// AssigneeIn applies the In predicate on the "assignee" field.
+func AssigneeIn(vs ...string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldIn(FieldAssignee, vs...))
+}
+
+// AssigneeNotIn applies the NotIn predicate on the "assignee" field.
+func AssigneeNotIn(vs ...string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldNotIn(FieldAssignee, vs...))
+}
+
+// AssigneeGT applies the GT predicate on the "assignee" field.
+func AssigneeGT(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldGT(FieldAssignee, v))
+}
+
+// AssigneeGTE applies the GTE predicate on the "assignee" field.
+func AssigneeGTE(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldGTE(FieldAssignee, v))
+}
+
+// AssigneeLT applies the LT predicate on the "assignee" field.
+func AssigneeLT(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldLT(FieldAssignee, v))
+}
+
+// AssigneeLTE applies the LTE predicate on the "assignee" field.
+func AssigneeLTE(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldLTE(FieldAssignee, v))
+}
+
+// AssigneeContains applies the Contains predicate on the "assignee" field.
+func AssigneeContains(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldContains(FieldAssignee, v))
+}
+
+// AssigneeHasPrefix applies the HasPrefix predicate on the "assignee" field.
+func AssigneeHasPrefix(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldHasPrefix(FieldAssignee, v))
+}
+
+// AssigneeHasSuffix applies the HasSuffix predicate on the "assignee" field.
+func AssigneeHasSuffix(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldHasSuffix(FieldAssignee, v))
+}
+
+// AssigneeIsNil applies the IsNil predicate on the "assignee" field.
+func AssigneeIsNil() predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldIsNull(FieldAssignee))
+}
+
+// AssigneeNotNil applies the NotNil predicate on the "assignee" field.
+func AssigneeNotNil() predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldNotNull(FieldAssignee))
+}
+
+// AssigneeEqualFold applies the EqualFold predicate on the "assignee" field.
+func AssigneeEqualFold(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldEqualFold(FieldAssignee, v))
+}
+
+// AssigneeContainsFold applies the ContainsFold predicate on the "assignee" field.
+func AssigneeContainsFold(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldContainsFold(FieldAssignee, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldContainsFold(FieldNote, v))
+}
+
+// SuppressedUntilEQ applies the EQ predicate on the "suppressed_until" field.
+func SuppressedUntilEQ(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldEQ(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilNEQ applies the NEQ predicate on the "suppressed_until" field.
+func SuppressedUntilNEQ(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldNEQ(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilIn applies the In predicate on the "suppressed_until" field.
+func SuppressedUntilIn(vs ...time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldIn(FieldSuppressedUntil, vs...))
+}
+
+// SuppressedUntilNotIn applies the NotIn predicate on the "suppressed_until" field.
+func SuppressedUntilNotIn(vs ...time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldNotIn(FieldSuppressedUntil, vs...))
+}
+
+// SuppressedUntilGT applies the GT predicate on the "suppressed_until" field.
+func SuppressedUntilGT(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldGT(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilGTE applies the GTE predicate on the "suppressed_until" field.
+func SuppressedUntilGTE(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldGTE(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilLT applies the LT predicate on the "suppressed_until" field.
+func SuppressedUntilLT(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldLT(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilLTE applies the LTE predicate on the "suppressed_until" field.
+func SuppressedUntilLTE(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldLTE(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilIsNil applies the IsNil predicate on the "suppressed_until" field.
+func SuppressedUntilIsNil() predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldIsNull(FieldSuppressedUntil))
+}
+
+// SuppressedUntilNotNil applies the NotNil predicate on the "suppressed_until" field.
+func SuppressedUntilNotNil() predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldNotNull(FieldSuppressedUntil))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.GoldHttpmonitorAnomaly {
+	return predicate.GoldHttpmonitorAnomaly(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
 // EndpointIDEQ applies the EQ predicate on the "endpoint_id" field.
 func EndpointIDEQ(v string) predicate.GoldHttpmonitorAnomaly {
 	return predicate.GoldHttpmonitorAnomaly(sql.FieldEQ(FieldEndpointID, v))
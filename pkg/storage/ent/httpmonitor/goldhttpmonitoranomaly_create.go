@@ -33,6 +33,82 @@ func (_c *GoldHttpmonitorAnomalyCreate) SetFirstDetectedAt(v time.Time) *GoldHtt
 	return _c
 }
 
+// SetStatus sets the "status" field.
+func (_c *GoldHttpmonitorAnomalyCreate) SetStatus(v string) *GoldHttpmonitorAnomalyCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *GoldHttpmonitorAnomalyCreate) SetNillableStatus(v *string) *GoldHttpmonitorAnomalyCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAssignee sets the "assignee" field.
+func (_c *GoldHttpmonitorAnomalyCreate) SetAssignee(v string) *GoldHttpmonitorAnomalyCreate {
+	_c.mutation.SetAssignee(v)
+	return _c
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_c *GoldHttpmonitorAnomalyCreate) SetNillableAssignee(v *string) *GoldHttpmonitorAnomalyCreate {
+	if v != nil {
+		_c.SetAssignee(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *GoldHttpmonitorAnomalyCreate) SetNote(v string) *GoldHttpmonitorAnomalyCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *GoldHttpmonitorAnomalyCreate) SetNillableNote(v *string) *GoldHttpmonitorAnomalyCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (_c *GoldHttpmonitorAnomalyCreate) SetSuppressedUntil(v time.Time) *GoldHttpmonitorAnomalyCreate {
+	_c.mutation.SetSuppressedUntil(v)
+	return _c
+}
+
+// SetNillableSuppressedUntil sets the "suppressed_until" field if the given value is not nil.
+func (_c *GoldHttpmonitorAnomalyCreate) SetNillableSuppressedUntil(v *time.Time) *GoldHttpmonitorAnomalyCreate {
+	if v != nil {
+		_c.SetSuppressedUntil(*v)
+	}
+	return _c
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_c *GoldHttpmonitorAnomalyCreate) SetResolvedAt(v time.Time) *GoldHttpmonitorAnomalyCreate {
+	_c.mutation.SetResolvedAt(v)
+	return _c
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_c *GoldHttpmonitorAnomalyCreate) SetNillableResolvedAt(v *time.Time) *GoldHttpmonitorAnomalyCreate {
+	if v != nil {
+		_c.SetResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *GoldHttpmonitorAnomalyCreate) SetLastSeenAt(v time.Time) *GoldHttpmonitorAnomalyCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
 // SetEndpointID sets the "endpoint_id" field.
 func (_c *GoldHttpmonitorAnomalyCreate) SetEndpointID(v string) *GoldHttpmonitorAnomalyCreate {
 	_c.mutation.SetEndpointID(v)
@@ -180,6 +256,7 @@ func (_c *GoldHttpmonitorAnomalyCreate) Mutation() *GoldHttpmonitorAnomalyMutati
 
 // Save creates the GoldHttpmonitorAnomaly in the database.
 func (_c *GoldHttpmonitorAnomalyCreate) Save(ctx context.Context) (*GoldHttpmonitorAnomaly, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -205,6 +282,14 @@ func (_c *GoldHttpmonitorAnomalyCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *GoldHttpmonitorAnomalyCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := goldhttpmonitoranomaly.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *GoldHttpmonitorAnomalyCreate) check() error {
 	if _, ok := _c.mutation.DetectedAt(); !ok {
@@ -213,6 +298,17 @@ func (_c *GoldHttpmonitorAnomalyCreate) check() error {
 	if _, ok := _c.mutation.FirstDetectedAt(); !ok {
 		return &ValidationError{Name: "first_detected_at", err: errors.New(`httpmonitor: missing required field "GoldHttpmonitorAnomaly.first_detected_at"`)}
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`httpmonitor: missing required field "GoldHttpmonitorAnomaly.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := goldhttpmonitoranomaly.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`httpmonitor: validator failed for field "GoldHttpmonitorAnomaly.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LastSeenAt(); !ok {
+		return &ValidationError{Name: "last_seen_at", err: errors.New(`httpmonitor: missing required field "GoldHttpmonitorAnomaly.last_seen_at"`)}
+	}
 	if _, ok := _c.mutation.SourceID(); !ok {
 		return &ValidationError{Name: "source_id", err: errors.New(`httpmonitor: missing required field "GoldHttpmonitorAnomaly.source_id"`)}
 	}
@@ -287,6 +383,30 @@ func (_c *GoldHttpmonitorAnomalyCreate) createSpec() (*GoldHttpmonitorAnomaly, *
 		_spec.SetField(goldhttpmonitoranomaly.FieldFirstDetectedAt, field.TypeTime, value)
 		_node.FirstDetectedAt = value
 	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(goldhttpmonitoranomaly.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Assignee(); ok {
+		_spec.SetField(goldhttpmonitoranomaly.FieldAssignee, field.TypeString, value)
+		_node.Assignee = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(goldhttpmonitoranomaly.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.SuppressedUntil(); ok {
+		_spec.SetField(goldhttpmonitoranomaly.FieldSuppressedUntil, field.TypeTime, value)
+		_node.SuppressedUntil = &value
+	}
+	if value, ok := _c.mutation.ResolvedAt(); ok {
+		_spec.SetField(goldhttpmonitoranomaly.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(goldhttpmonitoranomaly.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = value
+	}
 	if value, ok := _c.mutation.EndpointID(); ok {
 		_spec.SetField(goldhttpmonitoranomaly.FieldEndpointID, field.TypeString, value)
 		_node.EndpointID = value
@@ -360,6 +480,7 @@ func (_c *GoldHttpmonitorAnomalyCreateBulk) Save(ctx context.Context) ([]*GoldHt
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*GoldHttpmonitorAnomalyMutation)
 				if !ok {
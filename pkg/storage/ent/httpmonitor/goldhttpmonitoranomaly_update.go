@@ -45,6 +45,114 @@ func (_u *GoldHttpmonitorAnomalyUpdate) SetNillableDetectedAt(v *time.Time) *Gol
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *GoldHttpmonitorAnomalyUpdate) SetStatus(v string) *GoldHttpmonitorAnomalyUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GoldHttpmonitorAnomalyUpdate) SetNillableStatus(v *string) *GoldHttpmonitorAnomalyUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAssignee sets the "assignee" field.
+func (_u *GoldHttpmonitorAnomalyUpdate) SetAssignee(v string) *GoldHttpmonitorAnomalyUpdate {
+	_u.mutation.SetAssignee(v)
+	return _u
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_u *GoldHttpmonitorAnomalyUpdate) SetNillableAssignee(v *string) *GoldHttpmonitorAnomalyUpdate {
+	if v != nil {
+		_u.SetAssignee(*v)
+	}
+	return _u
+}
+
+// ClearAssignee clears the value of the "assignee" field.
+func (_u *GoldHttpmonitorAnomalyUpdate) ClearAssignee() *GoldHttpmonitorAnomalyUpdate {
+	_u.mutation.ClearAssignee()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *GoldHttpmonitorAnomalyUpdate) SetNote(v string) *GoldHttpmonitorAnomalyUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *GoldHttpmonitorAnomalyUpdate) SetNillableNote(v *string) *GoldHttpmonitorAnomalyUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *GoldHttpmonitorAnomalyUpdate) ClearNote() *GoldHttpmonitorAnomalyUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (_u *GoldHttpmonitorAnomalyUpdate) SetSuppressedUntil(v time.Time) *GoldHttpmonitorAnomalyUpdate {
+	_u.mutation.SetSuppressedUntil(v)
+	return _u
+}
+
+// SetNillableSuppressedUntil sets the "suppressed_until" field if the given value is not nil.
+func (_u *GoldHttpmonitorAnomalyUpdate) SetNillableSuppressedUntil(v *time.Time) *GoldHttpmonitorAnomalyUpdate {
+	if v != nil {
+		_u.SetSuppressedUntil(*v)
+	}
+	return _u
+}
+
+// ClearSuppressedUntil clears the value of the "suppressed_until" field.
+func (_u *GoldHttpmonitorAnomalyUpdate) ClearSuppressedUntil() *GoldHttpmonitorAnomalyUpdate {
+	_u.mutation.ClearSuppressedUntil()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *GoldHttpmonitorAnomalyUpdate) SetResolvedAt(v time.Time) *GoldHttpmonitorAnomalyUpdate {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *GoldHttpmonitorAnomalyUpdate) SetNillableResolvedAt(v *time.Time) *GoldHttpmonitorAnomalyUpdate {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *GoldHttpmonitorAnomalyUpdate) ClearResolvedAt() *GoldHttpmonitorAnomalyUpdate {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *GoldHttpmonitorAnomalyUpdate) SetLastSeenAt(v time.Time) *GoldHttpmonitorAnomalyUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *GoldHttpmonitorAnomalyUpdate) SetNillableLastSeenAt(v *time.Time) *GoldHttpmonitorAnomalyUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
 // SetEndpointID sets the "endpoint_id" field.
 func (_u *GoldHttpmonitorAnomalyUpdate) SetEndpointID(v string) *GoldHttpmonitorAnomalyUpdate {
 	_u.mutation.SetEndpointID(v)
@@ -328,6 +436,11 @@ func (_u *GoldHttpmonitorAnomalyUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *GoldHttpmonitorAnomalyUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := goldhttpmonitoranomaly.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`httpmonitor: validator failed for field "GoldHttpmonitorAnomaly.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.SourceID(); ok {
 		if err := goldhttpmonitoranomaly.SourceIDValidator(v); err != nil {
 			return &ValidationError{Name: "source_id", err: fmt.Errorf(`httpmonitor: validator failed for field "GoldHttpmonitorAnomaly.source_id": %w`, err)}
@@ -361,6 +474,36 @@ func (_u *GoldHttpmonitorAnomalyUpdate) sqlSave(ctx context.Context) (_node int,
 	if value, ok := _u.mutation.DetectedAt(); ok {
 		_spec.SetField(goldhttpmonitoranomaly.FieldDetectedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(goldhttpmonitoranomaly.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Assignee(); ok {
+		_spec.SetField(goldhttpmonitoranomaly.FieldAssignee, field.TypeString, value)
+	}
+	if _u.mutation.AssigneeCleared() {
+		_spec.ClearField(goldhttpmonitoranomaly.FieldAssignee, field.TypeString)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(goldhttpmonitoranomaly.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(goldhttpmonitoranomaly.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.SuppressedUntil(); ok {
+		_spec.SetField(goldhttpmonitoranomaly.FieldSuppressedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.SuppressedUntilCleared() {
+		_spec.ClearField(goldhttpmonitoranomaly.FieldSuppressedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(goldhttpmonitoranomaly.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(goldhttpmonitoranomaly.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(goldhttpmonitoranomaly.FieldLastSeenAt, field.TypeTime, value)
+	}
 	if value, ok := _u.mutation.EndpointID(); ok {
 		_spec.SetField(goldhttpmonitoranomaly.FieldEndpointID, field.TypeString, value)
 	}
@@ -474,6 +617,114 @@ func (_u *GoldHttpmonitorAnomalyUpdateOne) SetNillableDetectedAt(v *time.Time) *
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *GoldHttpmonitorAnomalyUpdateOne) SetStatus(v string) *GoldHttpmonitorAnomalyUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GoldHttpmonitorAnomalyUpdateOne) SetNillableStatus(v *string) *GoldHttpmonitorAnomalyUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAssignee sets the "assignee" field.
+func (_u *GoldHttpmonitorAnomalyUpdateOne) SetAssignee(v string) *GoldHttpmonitorAnomalyUpdateOne {
+	_u.mutation.SetAssignee(v)
+	return _u
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_u *GoldHttpmonitorAnomalyUpdateOne) SetNillableAssignee(v *string) *GoldHttpmonitorAnomalyUpdateOne {
+	if v != nil {
+		_u.SetAssignee(*v)
+	}
+	return _u
+}
+
+// ClearAssignee clears the value of the "assignee" field.
+func (_u *GoldHttpmonitorAnomalyUpdateOne) ClearAssignee() *GoldHttpmonitorAnomalyUpdateOne {
+	_u.mutation.ClearAssignee()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *GoldHttpmonitorAnomalyUpdateOne) SetNote(v string) *GoldHttpmonitorAnomalyUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *GoldHttpmonitorAnomalyUpdateOne) SetNillableNote(v *string) *GoldHttpmonitorAnomalyUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *GoldHttpmonitorAnomalyUpdateOne) ClearNote() *GoldHttpmonitorAnomalyUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (_u *GoldHttpmonitorAnomalyUpdateOne) SetSuppressedUntil(v time.Time) *GoldHttpmonitorAnomalyUpdateOne {
+	_u.mutation.SetSuppressedUntil(v)
+	return _u
+}
+
+// SetNillableSuppressedUntil sets the "suppressed_until" field if the given value is not nil.
+func (_u *GoldHttpmonitorAnomalyUpdateOne) SetNillableSuppressedUntil(v *time.Time) *GoldHttpmonitorAnomalyUpdateOne {
+	if v != nil {
+		_u.SetSuppressedUntil(*v)
+	}
+	return _u
+}
+
+// ClearSuppressedUntil clears the value of the "suppressed_until" field.
+func (_u *GoldHttpmonitorAnomalyUpdateOne) ClearSuppressedUntil() *GoldHttpmonitorAnomalyUpdateOne {
+	_u.mutation.ClearSuppressedUntil()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *GoldHttpmonitorAnomalyUpdateOne) SetResolvedAt(v time.Time) *GoldHttpmonitorAnomalyUpdateOne {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *GoldHttpmonitorAnomalyUpdateOne) SetNillableResolvedAt(v *time.Time) *GoldHttpmonitorAnomalyUpdateOne {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *GoldHttpmonitorAnomalyUpdateOne) ClearResolvedAt() *GoldHttpmonitorAnomalyUpdateOne {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *GoldHttpmonitorAnomalyUpdateOne) SetLastSeenAt(v time.Time) *GoldHttpmonitorAnomalyUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *GoldHttpmonitorAnomalyUpdateOne) SetNillableLastSeenAt(v *time.Time) *GoldHttpmonitorAnomalyUpdateOne {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
 // SetEndpointID sets the "endpoint_id" field.
 func (_u *GoldHttpmonitorAnomalyUpdateOne) SetEndpointID(v string) *GoldHttpmonitorAnomalyUpdateOne {
 	_u.mutation.SetEndpointID(v)
@@ -770,6 +1021,11 @@ func (_u *GoldHttpmonitorAnomalyUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *GoldHttpmonitorAnomalyUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := goldhttpmonitoranomaly.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`httpmonitor: validator failed for field "GoldHttpmonitorAnomaly.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.SourceID(); ok {
 		if err := goldhttpmonitoranomaly.SourceIDValidator(v); err != nil {
 			return &ValidationError{Name: "source_id", err: fmt.Errorf(`httpmonitor: validator failed for field "GoldHttpmonitorAnomaly.source_id": %w`, err)}
@@ -820,6 +1076,36 @@ func (_u *GoldHttpmonitorAnomalyUpdateOne) sqlSave(ctx context.Context) (_node *
 	if value, ok := _u.mutation.DetectedAt(); ok {
 		_spec.SetField(goldhttpmonitoranomaly.FieldDetectedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(goldhttpmonitoranomaly.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Assignee(); ok {
+		_spec.SetField(goldhttpmonitoranomaly.FieldAssignee, field.TypeString, value)
+	}
+	if _u.mutation.AssigneeCleared() {
+		_spec.ClearField(goldhttpmonitoranomaly.FieldAssignee, field.TypeString)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(goldhttpmonitoranomaly.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(goldhttpmonitoranomaly.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.SuppressedUntil(); ok {
+		_spec.SetField(goldhttpmonitoranomaly.FieldSuppressedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.SuppressedUntilCleared() {
+		_spec.ClearField(goldhttpmonitoranomaly.FieldSuppressedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(goldhttpmonitoranomaly.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(goldhttpmonitoranomaly.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(goldhttpmonitoranomaly.FieldLastSeenAt, field.TypeTime, value)
+	}
 	if value, ok := _u.mutation.EndpointID(); ok {
 		_spec.SetField(goldhttpmonitoranomaly.FieldEndpointID, field.TypeString, value)
 	}
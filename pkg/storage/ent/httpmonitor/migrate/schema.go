@@ -14,6 +14,12 @@ var (
 		{Name: "resource_id", Type: field.TypeString, Unique: true},
 		{Name: "detected_at", Type: field.TypeTime},
 		{Name: "first_detected_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeString, Default: "open"},
+		{Name: "assignee", Type: field.TypeString, Nullable: true},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "suppressed_until", Type: field.TypeTime, Nullable: true},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime},
 		{Name: "endpoint_id", Type: field.TypeString, Nullable: true},
 		{Name: "source_id", Type: field.TypeString},
 		{Name: "anomaly_type", Type: field.TypeString},
@@ -34,25 +40,30 @@ var (
 		Columns:    HttpmonitorAnomaliesColumns,
 		PrimaryKey: []*schema.Column{HttpmonitorAnomaliesColumns[0]},
 		Indexes: []*schema.Index{
+			{
+				Name:    "goldhttpmonitoranomaly_status",
+				Unique:  false,
+				Columns: []*schema.Column{HttpmonitorAnomaliesColumns[3]},
+			},
 			{
 				Name:    "goldhttpmonitoranomaly_anomaly_type",
 				Unique:  false,
-				Columns: []*schema.Column{HttpmonitorAnomaliesColumns[5]},
+				Columns: []*schema.Column{HttpmonitorAnomaliesColumns[11]},
 			},
 			{
 				Name:    "goldhttpmonitoranomaly_severity",
 				Unique:  false,
-				Columns: []*schema.Column{HttpmonitorAnomaliesColumns[6]},
+				Columns: []*schema.Column{HttpmonitorAnomaliesColumns[12]},
 			},
 			{
 				Name:    "goldhttpmonitoranomaly_source_id_window_start",
 				Unique:  false,
-				Columns: []*schema.Column{HttpmonitorAnomaliesColumns[4], HttpmonitorAnomaliesColumns[7]},
+				Columns: []*schema.Column{HttpmonitorAnomaliesColumns[10], HttpmonitorAnomaliesColumns[13]},
 			},
 			{
 				Name:    "goldhttpmonitoranomaly_endpoint_id",
 				Unique:  false,
-				Columns: []*schema.Column{HttpmonitorAnomaliesColumns[3]},
+				Columns: []*schema.Column{HttpmonitorAnomaliesColumns[9]},
 			},
 		},
 	}
@@ -36,6 +36,12 @@ type GoldHttpmonitorAnomalyMutation struct {
 	id                  *string
 	detected_at         *time.Time
 	first_detected_at   *time.Time
+	status              *string
+	assignee            *string
+	note                *string
+	suppressed_until    *time.Time
+	resolved_at         *time.Time
+	last_seen_at        *time.Time
 	endpoint_id         *string
 	source_id           *string
 	anomaly_type        *string
@@ -235,6 +241,274 @@ func (m *GoldHttpmonitorAnomalyMutation) ResetFirstDetectedAt() {
 	m.first_detected_at = nil
 }
 
+// SetStatus sets the "status" field.
+func (m *GoldHttpmonitorAnomalyMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *GoldHttpmonitorAnomalyMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the GoldHttpmonitorAnomaly entity.
+// If the GoldHttpmonitorAnomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldHttpmonitorAnomalyMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *GoldHttpmonitorAnomalyMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAssignee sets the "assignee" field.
+func (m *GoldHttpmonitorAnomalyMutation) SetAssignee(s string) {
+	m.assignee = &s
+}
+
+// Assignee returns the value of the "assignee" field in the mutation.
+func (m *GoldHttpmonitorAnomalyMutation) Assignee() (r string, exists bool) {
+	v := m.assignee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignee returns the old "assignee" field's value of the GoldHttpmonitorAnomaly entity.
+// If the GoldHttpmonitorAnomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldHttpmonitorAnomalyMutation) OldAssignee(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignee: %w", err)
+	}
+	return oldValue.Assignee, nil
+}
+
+// ClearAssignee clears the value of the "assignee" field.
+func (m *GoldHttpmonitorAnomalyMutation) ClearAssignee() {
+	m.assignee = nil
+	m.clearedFields[goldhttpmonitoranomaly.FieldAssignee] = struct{}{}
+}
+
+// AssigneeCleared returns if the "assignee" field was cleared in this mutation.
+func (m *GoldHttpmonitorAnomalyMutation) AssigneeCleared() bool {
+	_, ok := m.clearedFields[goldhttpmonitoranomaly.FieldAssignee]
+	return ok
+}
+
+// ResetAssignee resets all changes to the "assignee" field.
+func (m *GoldHttpmonitorAnomalyMutation) ResetAssignee() {
+	m.assignee = nil
+	delete(m.clearedFields, goldhttpmonitoranomaly.FieldAssignee)
+}
+
+// SetNote sets the "note" field.
+func (m *GoldHttpmonitorAnomalyMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *GoldHttpmonitorAnomalyMutation) Note() (r string, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNote returns the old "note" field's value of the GoldHttpmonitorAnomaly entity.
+// If the GoldHttpmonitorAnomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldHttpmonitorAnomalyMutation) OldNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+	}
+	return oldValue.Note, nil
+}
+
+// ClearNote clears the value of the "note" field.
+func (m *GoldHttpmonitorAnomalyMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[goldhttpmonitoranomaly.FieldNote] = struct{}{}
+}
+
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *GoldHttpmonitorAnomalyMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[goldhttpmonitoranomaly.FieldNote]
+	return ok
+}
+
+// ResetNote resets all changes to the "note" field.
+func (m *GoldHttpmonitorAnomalyMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, goldhttpmonitoranomaly.FieldNote)
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (m *GoldHttpmonitorAnomalyMutation) SetSuppressedUntil(t time.Time) {
+	m.suppressed_until = &t
+}
+
+// SuppressedUntil returns the value of the "suppressed_until" field in the mutation.
+func (m *GoldHttpmonitorAnomalyMutation) SuppressedUntil() (r time.Time, exists bool) {
+	v := m.suppressed_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuppressedUntil returns the old "suppressed_until" field's value of the GoldHttpmonitorAnomaly entity.
+// If the GoldHttpmonitorAnomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldHttpmonitorAnomalyMutation) OldSuppressedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuppressedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuppressedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuppressedUntil: %w", err)
+	}
+	return oldValue.SuppressedUntil, nil
+}
+
+// ClearSuppressedUntil clears the value of the "suppressed_until" field.
+func (m *GoldHttpmonitorAnomalyMutation) ClearSuppressedUntil() {
+	m.suppressed_until = nil
+	m.clearedFields[goldhttpmonitoranomaly.FieldSuppressedUntil] = struct{}{}
+}
+
+// SuppressedUntilCleared returns if the "suppressed_until" field was cleared in this mutation.
+func (m *GoldHttpmonitorAnomalyMutation) SuppressedUntilCleared() bool {
+	_, ok := m.clearedFields[goldhttpmonitoranomaly.FieldSuppressedUntil]
+	return ok
+}
+
+// ResetSuppressedUntil resets all changes to the "suppressed_until" field.
+func (m *GoldHttpmonitorAnomalyMutation) ResetSuppressedUntil() {
+	m.suppressed_until = nil
+	delete(m.clearedFields, goldhttpmonitoranomaly.FieldSuppressedUntil)
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (m *GoldHttpmonitorAnomalyMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
+}
+
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *GoldHttpmonitorAnomalyMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolvedAt returns the old "resolved_at" field's value of the GoldHttpmonitorAnomaly entity.
+// If the GoldHttpmonitorAnomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldHttpmonitorAnomalyMutation) OldResolvedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+	}
+	return oldValue.ResolvedAt, nil
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *GoldHttpmonitorAnomalyMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[goldhttpmonitoranomaly.FieldResolvedAt] = struct{}{}
+}
+
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *GoldHttpmonitorAnomalyMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[goldhttpmonitoranomaly.FieldResolvedAt]
+	return ok
+}
+
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *GoldHttpmonitorAnomalyMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, goldhttpmonitoranomaly.FieldResolvedAt)
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *GoldHttpmonitorAnomalyMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *GoldHttpmonitorAnomalyMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the GoldHttpmonitorAnomaly entity.
+// If the GoldHttpmonitorAnomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldHttpmonitorAnomalyMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *GoldHttpmonitorAnomalyMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+}
+
 // SetEndpointID sets the "endpoint_id" field.
 func (m *GoldHttpmonitorAnomalyMutation) SetEndpointID(s string) {
 	m.endpoint_id = &s
@@ -920,13 +1194,31 @@ func (m *GoldHttpmonitorAnomalyMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *GoldHttpmonitorAnomalyMutation) Fields() []string {
-	fields := make([]string, 0, 15)
+	fields := make([]string, 0, 21)
 	if m.detected_at != nil {
 		fields = append(fields, goldhttpmonitoranomaly.FieldDetectedAt)
 	}
 	if m.first_detected_at != nil {
 		fields = append(fields, goldhttpmonitoranomaly.FieldFirstDetectedAt)
 	}
+	if m.status != nil {
+		fields = append(fields, goldhttpmonitoranomaly.FieldStatus)
+	}
+	if m.assignee != nil {
+		fields = append(fields, goldhttpmonitoranomaly.FieldAssignee)
+	}
+	if m.note != nil {
+		fields = append(fields, goldhttpmonitoranomaly.FieldNote)
+	}
+	if m.suppressed_until != nil {
+		fields = append(fields, goldhttpmonitoranomaly.FieldSuppressedUntil)
+	}
+	if m.resolved_at != nil {
+		fields = append(fields, goldhttpmonitoranomaly.FieldResolvedAt)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, goldhttpmonitoranomaly.FieldLastSeenAt)
+	}
 	if m.endpoint_id != nil {
 		fields = append(fields, goldhttpmonitoranomaly.FieldEndpointID)
 	}
@@ -978,6 +1270,18 @@ func (m *GoldHttpmonitorAnomalyMutation) Field(name string) (ent.Value, bool) {
 		return m.DetectedAt()
 	case goldhttpmonitoranomaly.FieldFirstDetectedAt:
 		return m.FirstDetectedAt()
+	case goldhttpmonitoranomaly.FieldStatus:
+		return m.Status()
+	case goldhttpmonitoranomaly.FieldAssignee:
+		return m.Assignee()
+	case goldhttpmonitoranomaly.FieldNote:
+		return m.Note()
+	case goldhttpmonitoranomaly.FieldSuppressedUntil:
+		return m.SuppressedUntil()
+	case goldhttpmonitoranomaly.FieldResolvedAt:
+		return m.ResolvedAt()
+	case goldhttpmonitoranomaly.FieldLastSeenAt:
+		return m.LastSeenAt()
 	case goldhttpmonitoranomaly.FieldEndpointID:
 		return m.EndpointID()
 	case goldhttpmonitoranomaly.FieldSourceID:
@@ -1017,6 +1321,18 @@ func (m *GoldHttpmonitorAnomalyMutation) OldField(ctx context.Context, name stri
 		return m.OldDetectedAt(ctx)
 	case goldhttpmonitoranomaly.FieldFirstDetectedAt:
 		return m.OldFirstDetectedAt(ctx)
+	case goldhttpmonitoranomaly.FieldStatus:
+		return m.OldStatus(ctx)
+	case goldhttpmonitoranomaly.FieldAssignee:
+		return m.OldAssignee(ctx)
+	case goldhttpmonitoranomaly.FieldNote:
+		return m.OldNote(ctx)
+	case goldhttpmonitoranomaly.FieldSuppressedUntil:
+		return m.OldSuppressedUntil(ctx)
+	case goldhttpmonitoranomaly.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
+	case goldhttpmonitoranomaly.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
 	case goldhttpmonitoranomaly.FieldEndpointID:
 		return m.OldEndpointID(ctx)
 	case goldhttpmonitoranomaly.FieldSourceID:
@@ -1066,6 +1382,48 @@ func (m *GoldHttpmonitorAnomalyMutation) SetField(name string, value ent.Value)
 		}
 		m.SetFirstDetectedAt(v)
 		return nil
+	case goldhttpmonitoranomaly.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case goldhttpmonitoranomaly.FieldAssignee:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignee(v)
+		return nil
+	case goldhttpmonitoranomaly.FieldNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNote(v)
+		return nil
+	case goldhttpmonitoranomaly.FieldSuppressedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuppressedUntil(v)
+		return nil
+	case goldhttpmonitoranomaly.FieldResolvedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedAt(v)
+		return nil
+	case goldhttpmonitoranomaly.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
 	case goldhttpmonitoranomaly.FieldEndpointID:
 		v, ok := value.(string)
 		if !ok {
@@ -1226,6 +1584,18 @@ func (m *GoldHttpmonitorAnomalyMutation) AddField(name string, value ent.Value)
 // mutation.
 func (m *GoldHttpmonitorAnomalyMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(goldhttpmonitoranomaly.FieldAssignee) {
+		fields = append(fields, goldhttpmonitoranomaly.FieldAssignee)
+	}
+	if m.FieldCleared(goldhttpmonitoranomaly.FieldNote) {
+		fields = append(fields, goldhttpmonitoranomaly.FieldNote)
+	}
+	if m.FieldCleared(goldhttpmonitoranomaly.FieldSuppressedUntil) {
+		fields = append(fields, goldhttpmonitoranomaly.FieldSuppressedUntil)
+	}
+	if m.FieldCleared(goldhttpmonitoranomaly.FieldResolvedAt) {
+		fields = append(fields, goldhttpmonitoranomaly.FieldResolvedAt)
+	}
 	if m.FieldCleared(goldhttpmonitoranomaly.FieldEndpointID) {
 		fields = append(fields, goldhttpmonitoranomaly.FieldEndpointID)
 	}
@@ -1264,6 +1634,18 @@ func (m *GoldHttpmonitorAnomalyMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *GoldHttpmonitorAnomalyMutation) ClearField(name string) error {
 	switch name {
+	case goldhttpmonitoranomaly.FieldAssignee:
+		m.ClearAssignee()
+		return nil
+	case goldhttpmonitoranomaly.FieldNote:
+		m.ClearNote()
+		return nil
+	case goldhttpmonitoranomaly.FieldSuppressedUntil:
+		m.ClearSuppressedUntil()
+		return nil
+	case goldhttpmonitoranomaly.FieldResolvedAt:
+		m.ClearResolvedAt()
+		return nil
 	case goldhttpmonitoranomaly.FieldEndpointID:
 		m.ClearEndpointID()
 		return nil
@@ -1302,6 +1684,24 @@ func (m *GoldHttpmonitorAnomalyMutation) ResetField(name string) error {
 	case goldhttpmonitoranomaly.FieldFirstDetectedAt:
 		m.ResetFirstDetectedAt()
 		return nil
+	case goldhttpmonitoranomaly.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case goldhttpmonitoranomaly.FieldAssignee:
+		m.ResetAssignee()
+		return nil
+	case goldhttpmonitoranomaly.FieldNote:
+		m.ResetNote()
+		return nil
+	case goldhttpmonitoranomaly.FieldSuppressedUntil:
+		m.ResetSuppressedUntil()
+		return nil
+	case goldhttpmonitoranomaly.FieldResolvedAt:
+		m.ResetResolvedAt()
+		return nil
+	case goldhttpmonitoranomaly.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
 	case goldhttpmonitoranomaly.FieldEndpointID:
 		m.ResetEndpointID()
 		return nil
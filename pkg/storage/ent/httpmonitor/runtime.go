@@ -11,8 +11,17 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	goldhttpmonitoranomalyMixin := schema.GoldHttpmonitorAnomaly{}.Mixin()
+	goldhttpmonitoranomalyMixinFields1 := goldhttpmonitoranomalyMixin[1].Fields()
+	_ = goldhttpmonitoranomalyMixinFields1
 	goldhttpmonitoranomalyFields := schema.GoldHttpmonitorAnomaly{}.Fields()
 	_ = goldhttpmonitoranomalyFields
+	// goldhttpmonitoranomalyDescStatus is the schema descriptor for status field.
+	goldhttpmonitoranomalyDescStatus := goldhttpmonitoranomalyMixinFields1[0].Descriptor()
+	// goldhttpmonitoranomaly.DefaultStatus holds the default value on creation for the status field.
+	goldhttpmonitoranomaly.DefaultStatus = goldhttpmonitoranomalyDescStatus.Default.(string)
+	// goldhttpmonitoranomaly.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	goldhttpmonitoranomaly.StatusValidator = goldhttpmonitoranomalyDescStatus.Validators[0].(func(string) error)
 	// goldhttpmonitoranomalyDescSourceID is the schema descriptor for source_id field.
 	goldhttpmonitoranomalyDescSourceID := goldhttpmonitoranomalyFields[2].Descriptor()
 	// goldhttpmonitoranomaly.SourceIDValidator is a validator for the "source_id" field. It is called by the builders before save.
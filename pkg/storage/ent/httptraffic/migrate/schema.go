@@ -31,6 +31,9 @@ var (
 		{Name: "is_internal", Type: field.TypeBool, Default: false},
 		{Name: "request_count", Type: field.TypeInt64},
 		{Name: "is_mapped", Type: field.TypeBool, Default: false},
+		{Name: "is_threat_listed", Type: field.TypeBool, Default: false},
+		{Name: "threat_feeds", Type: field.TypeJSON, Nullable: true},
+		{Name: "threat_categories", Type: field.TypeJSON, Nullable: true},
 	}
 	// HttptrafficClientIP5mTable holds the schema information for the "httptraffic_client_ip_5m" table.
 	HttptrafficClientIP5mTable = &schema.Table{
@@ -63,6 +66,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{HttptrafficClientIP5mColumns[17]},
 			},
+			{
+				Name:    "silverhttptrafficclientip5m_is_threat_listed_window_start",
+				Unique:  false,
+				Columns: []*schema.Column{HttptrafficClientIP5mColumns[20], HttptrafficClientIP5mColumns[6]},
+			},
 			{
 				Name:    "silverhttptrafficclientip5m_window_start",
 				Unique:  false,
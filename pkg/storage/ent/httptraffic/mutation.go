@@ -34,34 +34,39 @@ const (
 // SilverHttptrafficClientIp5mMutation represents an operation that mutates the SilverHttptrafficClientIp5m nodes in the graph.
 type SilverHttptrafficClientIp5mMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *string
-	collected_at       *time.Time
-	first_collected_at *time.Time
-	normalized_at      *time.Time
-	endpoint_id        *string
-	source_id          *string
-	window_start       *time.Time
-	window_end         *time.Time
-	uri                *string
-	method             *string
-	client_ip          *string
-	country_code       *string
-	country_name       *string
-	asn                *int
-	addasn             *int
-	org_name           *string
-	as_domain          *string
-	asn_type           *string
-	is_internal        *bool
-	request_count      *int64
-	addrequest_count   *int64
-	is_mapped          *bool
-	clearedFields      map[string]struct{}
-	done               bool
-	oldValue           func(context.Context) (*SilverHttptrafficClientIp5m, error)
-	predicates         []predicate.SilverHttptrafficClientIp5m
+	op                      Op
+	typ                     string
+	id                      *string
+	collected_at            *time.Time
+	first_collected_at      *time.Time
+	normalized_at           *time.Time
+	endpoint_id             *string
+	source_id               *string
+	window_start            *time.Time
+	window_end              *time.Time
+	uri                     *string
+	method                  *string
+	client_ip               *string
+	country_code            *string
+	country_name            *string
+	asn                     *int
+	addasn                  *int
+	org_name                *string
+	as_domain               *string
+	asn_type                *string
+	is_internal             *bool
+	request_count           *int64
+	addrequest_count        *int64
+	is_mapped               *bool
+	is_threat_listed        *bool
+	threat_feeds            *[]string
+	appendthreat_feeds      []string
+	threat_categories       *[]string
+	appendthreat_categories []string
+	clearedFields           map[string]struct{}
+	done                    bool
+	oldValue                func(context.Context) (*SilverHttptrafficClientIp5m, error)
+	predicates              []predicate.SilverHttptrafficClientIp5m
 }
 
 var _ ent.Mutation = (*SilverHttptrafficClientIp5mMutation)(nil)
@@ -997,6 +1002,172 @@ func (m *SilverHttptrafficClientIp5mMutation) ResetIsMapped() {
 	m.is_mapped = nil
 }
 
+// SetIsThreatListed sets the "is_threat_listed" field.
+func (m *SilverHttptrafficClientIp5mMutation) SetIsThreatListed(b bool) {
+	m.is_threat_listed = &b
+}
+
+// IsThreatListed returns the value of the "is_threat_listed" field in the mutation.
+func (m *SilverHttptrafficClientIp5mMutation) IsThreatListed() (r bool, exists bool) {
+	v := m.is_threat_listed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsThreatListed returns the old "is_threat_listed" field's value of the SilverHttptrafficClientIp5m entity.
+// If the SilverHttptrafficClientIp5m object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SilverHttptrafficClientIp5mMutation) OldIsThreatListed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsThreatListed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsThreatListed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsThreatListed: %w", err)
+	}
+	return oldValue.IsThreatListed, nil
+}
+
+// ResetIsThreatListed resets all changes to the "is_threat_listed" field.
+func (m *SilverHttptrafficClientIp5mMutation) ResetIsThreatListed() {
+	m.is_threat_listed = nil
+}
+
+// SetThreatFeeds sets the "threat_feeds" field.
+func (m *SilverHttptrafficClientIp5mMutation) SetThreatFeeds(s []string) {
+	m.threat_feeds = &s
+	m.appendthreat_feeds = nil
+}
+
+// ThreatFeeds returns the value of the "threat_feeds" field in the mutation.
+func (m *SilverHttptrafficClientIp5mMutation) ThreatFeeds() (r []string, exists bool) {
+	v := m.threat_feeds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThreatFeeds returns the old "threat_feeds" field's value of the SilverHttptrafficClientIp5m entity.
+// If the SilverHttptrafficClientIp5m object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SilverHttptrafficClientIp5mMutation) OldThreatFeeds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThreatFeeds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThreatFeeds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThreatFeeds: %w", err)
+	}
+	return oldValue.ThreatFeeds, nil
+}
+
+// AppendThreatFeeds adds s to the "threat_feeds" field.
+func (m *SilverHttptrafficClientIp5mMutation) AppendThreatFeeds(s []string) {
+	m.appendthreat_feeds = append(m.appendthreat_feeds, s...)
+}
+
+// AppendedThreatFeeds returns the list of values that were appended to the "threat_feeds" field in this mutation.
+func (m *SilverHttptrafficClientIp5mMutation) AppendedThreatFeeds() ([]string, bool) {
+	if len(m.appendthreat_feeds) == 0 {
+		return nil, false
+	}
+	return m.appendthreat_feeds, true
+}
+
+// ClearThreatFeeds clears the value of the "threat_feeds" field.
+func (m *SilverHttptrafficClientIp5mMutation) ClearThreatFeeds() {
+	m.threat_feeds = nil
+	m.appendthreat_feeds = nil
+	m.clearedFields[silverhttptrafficclientip5m.FieldThreatFeeds] = struct{}{}
+}
+
+// ThreatFeedsCleared returns if the "threat_feeds" field was cleared in this mutation.
+func (m *SilverHttptrafficClientIp5mMutation) ThreatFeedsCleared() bool {
+	_, ok := m.clearedFields[silverhttptrafficclientip5m.FieldThreatFeeds]
+	return ok
+}
+
+// ResetThreatFeeds resets all changes to the "threat_feeds" field.
+func (m *SilverHttptrafficClientIp5mMutation) ResetThreatFeeds() {
+	m.threat_feeds = nil
+	m.appendthreat_feeds = nil
+	delete(m.clearedFields, silverhttptrafficclientip5m.FieldThreatFeeds)
+}
+
+// SetThreatCategories sets the "threat_categories" field.
+func (m *SilverHttptrafficClientIp5mMutation) SetThreatCategories(s []string) {
+	m.threat_categories = &s
+	m.appendthreat_categories = nil
+}
+
+// ThreatCategories returns the value of the "threat_categories" field in the mutation.
+func (m *SilverHttptrafficClientIp5mMutation) ThreatCategories() (r []string, exists bool) {
+	v := m.threat_categories
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThreatCategories returns the old "threat_categories" field's value of the SilverHttptrafficClientIp5m entity.
+// If the SilverHttptrafficClientIp5m object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SilverHttptrafficClientIp5mMutation) OldThreatCategories(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThreatCategories is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThreatCategories requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThreatCategories: %w", err)
+	}
+	return oldValue.ThreatCategories, nil
+}
+
+// AppendThreatCategories adds s to the "threat_categories" field.
+func (m *SilverHttptrafficClientIp5mMutation) AppendThreatCategories(s []string) {
+	m.appendthreat_categories = append(m.appendthreat_categories, s...)
+}
+
+// AppendedThreatCategories returns the list of values that were appended to the "threat_categories" field in this mutation.
+func (m *SilverHttptrafficClientIp5mMutation) AppendedThreatCategories() ([]string, bool) {
+	if len(m.appendthreat_categories) == 0 {
+		return nil, false
+	}
+	return m.appendthreat_categories, true
+}
+
+// ClearThreatCategories clears the value of the "threat_categories" field.
+func (m *SilverHttptrafficClientIp5mMutation) ClearThreatCategories() {
+	m.threat_categories = nil
+	m.appendthreat_categories = nil
+	m.clearedFields[silverhttptrafficclientip5m.FieldThreatCategories] = struct{}{}
+}
+
+// ThreatCategoriesCleared returns if the "threat_categories" field was cleared in this mutation.
+func (m *SilverHttptrafficClientIp5mMutation) ThreatCategoriesCleared() bool {
+	_, ok := m.clearedFields[silverhttptrafficclientip5m.FieldThreatCategories]
+	return ok
+}
+
+// ResetThreatCategories resets all changes to the "threat_categories" field.
+func (m *SilverHttptrafficClientIp5mMutation) ResetThreatCategories() {
+	m.threat_categories = nil
+	m.appendthreat_categories = nil
+	delete(m.clearedFields, silverhttptrafficclientip5m.FieldThreatCategories)
+}
+
 // Where appends a list predicates to the SilverHttptrafficClientIp5mMutation builder.
 func (m *SilverHttptrafficClientIp5mMutation) Where(ps ...predicate.SilverHttptrafficClientIp5m) {
 	m.predicates = append(m.predicates, ps...)
@@ -1031,7 +1202,7 @@ func (m *SilverHttptrafficClientIp5mMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *SilverHttptrafficClientIp5mMutation) Fields() []string {
-	fields := make([]string, 0, 19)
+	fields := make([]string, 0, 22)
 	if m.collected_at != nil {
 		fields = append(fields, silverhttptrafficclientip5m.FieldCollectedAt)
 	}
@@ -1089,6 +1260,15 @@ func (m *SilverHttptrafficClientIp5mMutation) Fields() []string {
 	if m.is_mapped != nil {
 		fields = append(fields, silverhttptrafficclientip5m.FieldIsMapped)
 	}
+	if m.is_threat_listed != nil {
+		fields = append(fields, silverhttptrafficclientip5m.FieldIsThreatListed)
+	}
+	if m.threat_feeds != nil {
+		fields = append(fields, silverhttptrafficclientip5m.FieldThreatFeeds)
+	}
+	if m.threat_categories != nil {
+		fields = append(fields, silverhttptrafficclientip5m.FieldThreatCategories)
+	}
 	return fields
 }
 
@@ -1135,6 +1315,12 @@ func (m *SilverHttptrafficClientIp5mMutation) Field(name string) (ent.Value, boo
 		return m.RequestCount()
 	case silverhttptrafficclientip5m.FieldIsMapped:
 		return m.IsMapped()
+	case silverhttptrafficclientip5m.FieldIsThreatListed:
+		return m.IsThreatListed()
+	case silverhttptrafficclientip5m.FieldThreatFeeds:
+		return m.ThreatFeeds()
+	case silverhttptrafficclientip5m.FieldThreatCategories:
+		return m.ThreatCategories()
 	}
 	return nil, false
 }
@@ -1182,6 +1368,12 @@ func (m *SilverHttptrafficClientIp5mMutation) OldField(ctx context.Context, name
 		return m.OldRequestCount(ctx)
 	case silverhttptrafficclientip5m.FieldIsMapped:
 		return m.OldIsMapped(ctx)
+	case silverhttptrafficclientip5m.FieldIsThreatListed:
+		return m.OldIsThreatListed(ctx)
+	case silverhttptrafficclientip5m.FieldThreatFeeds:
+		return m.OldThreatFeeds(ctx)
+	case silverhttptrafficclientip5m.FieldThreatCategories:
+		return m.OldThreatCategories(ctx)
 	}
 	return nil, fmt.Errorf("unknown SilverHttptrafficClientIp5m field %s", name)
 }
@@ -1324,6 +1516,27 @@ func (m *SilverHttptrafficClientIp5mMutation) SetField(name string, value ent.Va
 		}
 		m.SetIsMapped(v)
 		return nil
+	case silverhttptrafficclientip5m.FieldIsThreatListed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsThreatListed(v)
+		return nil
+	case silverhttptrafficclientip5m.FieldThreatFeeds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThreatFeeds(v)
+		return nil
+	case silverhttptrafficclientip5m.FieldThreatCategories:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThreatCategories(v)
+		return nil
 	}
 	return fmt.Errorf("unknown SilverHttptrafficClientIp5m field %s", name)
 }
@@ -1405,6 +1618,12 @@ func (m *SilverHttptrafficClientIp5mMutation) ClearedFields() []string {
 	if m.FieldCleared(silverhttptrafficclientip5m.FieldAsnType) {
 		fields = append(fields, silverhttptrafficclientip5m.FieldAsnType)
 	}
+	if m.FieldCleared(silverhttptrafficclientip5m.FieldThreatFeeds) {
+		fields = append(fields, silverhttptrafficclientip5m.FieldThreatFeeds)
+	}
+	if m.FieldCleared(silverhttptrafficclientip5m.FieldThreatCategories) {
+		fields = append(fields, silverhttptrafficclientip5m.FieldThreatCategories)
+	}
 	return fields
 }
 
@@ -1443,6 +1662,12 @@ func (m *SilverHttptrafficClientIp5mMutation) ClearField(name string) error {
 	case silverhttptrafficclientip5m.FieldAsnType:
 		m.ClearAsnType()
 		return nil
+	case silverhttptrafficclientip5m.FieldThreatFeeds:
+		m.ClearThreatFeeds()
+		return nil
+	case silverhttptrafficclientip5m.FieldThreatCategories:
+		m.ClearThreatCategories()
+		return nil
 	}
 	return fmt.Errorf("unknown SilverHttptrafficClientIp5m nullable field %s", name)
 }
@@ -1508,6 +1733,15 @@ func (m *SilverHttptrafficClientIp5mMutation) ResetField(name string) error {
 	case silverhttptrafficclientip5m.FieldIsMapped:
 		m.ResetIsMapped()
 		return nil
+	case silverhttptrafficclientip5m.FieldIsThreatListed:
+		m.ResetIsThreatListed()
+		return nil
+	case silverhttptrafficclientip5m.FieldThreatFeeds:
+		m.ResetThreatFeeds()
+		return nil
+	case silverhttptrafficclientip5m.FieldThreatCategories:
+		m.ResetThreatCategories()
+		return nil
 	}
 	return fmt.Errorf("unknown SilverHttptrafficClientIp5m field %s", name)
 }
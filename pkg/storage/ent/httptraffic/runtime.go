@@ -35,6 +35,10 @@ func init() {
 	silverhttptrafficclientip5mDescIsMapped := silverhttptrafficclientip5mFields[16].Descriptor()
 	// silverhttptrafficclientip5m.DefaultIsMapped holds the default value on creation for the is_mapped field.
 	silverhttptrafficclientip5m.DefaultIsMapped = silverhttptrafficclientip5mDescIsMapped.Default.(bool)
+	// silverhttptrafficclientip5mDescIsThreatListed is the schema descriptor for is_threat_listed field.
+	silverhttptrafficclientip5mDescIsThreatListed := silverhttptrafficclientip5mFields[17].Descriptor()
+	// silverhttptrafficclientip5m.DefaultIsThreatListed holds the default value on creation for the is_threat_listed field.
+	silverhttptrafficclientip5m.DefaultIsThreatListed = silverhttptrafficclientip5mDescIsThreatListed.Default.(bool)
 	silverhttptraffictraffic5mFields := schema.SilverHttptrafficTraffic5m{}.Fields()
 	_ = silverhttptraffictraffic5mFields
 	// silverhttptraffictraffic5mDescSourceID is the schema descriptor for source_id field.
@@ -3,6 +3,7 @@
 package httptraffic
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -54,8 +55,14 @@ type SilverHttptrafficClientIp5m struct {
 	// RequestCount holds the value of the "request_count" field.
 	RequestCount int64 `json:"request_count,omitempty"`
 	// IsMapped holds the value of the "is_mapped" field.
-	IsMapped     bool `json:"is_mapped,omitempty"`
-	selectValues sql.SelectValues
+	IsMapped bool `json:"is_mapped,omitempty"`
+	// Listed by an unexpired threat intel feed
+	IsThreatListed bool `json:"is_threat_listed,omitempty"`
+	// Names of the threat intel feeds listing the IP
+	ThreatFeeds []string `json:"threat_feeds,omitempty"`
+	// Categories of the matching feeds: scanner, tor, blocklist
+	ThreatCategories []string `json:"threat_categories,omitempty"`
+	selectValues     sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -63,7 +70,9 @@ func (*SilverHttptrafficClientIp5m) scanValues(columns []string) ([]any, error)
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case silverhttptrafficclientip5m.FieldIsInternal, silverhttptrafficclientip5m.FieldIsMapped:
+		case silverhttptrafficclientip5m.FieldThreatFeeds, silverhttptrafficclientip5m.FieldThreatCategories:
+			values[i] = new([]byte)
+		case silverhttptrafficclientip5m.FieldIsInternal, silverhttptrafficclientip5m.FieldIsMapped, silverhttptrafficclientip5m.FieldIsThreatListed:
 			values[i] = new(sql.NullBool)
 		case silverhttptrafficclientip5m.FieldAsn, silverhttptrafficclientip5m.FieldRequestCount:
 			values[i] = new(sql.NullInt64)
@@ -206,6 +215,28 @@ func (_m *SilverHttptrafficClientIp5m) assignValues(columns []string, values []a
 			} else if value.Valid {
 				_m.IsMapped = value.Bool
 			}
+		case silverhttptrafficclientip5m.FieldIsThreatListed:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_threat_listed", values[i])
+			} else if value.Valid {
+				_m.IsThreatListed = value.Bool
+			}
+		case silverhttptrafficclientip5m.FieldThreatFeeds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field threat_feeds", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ThreatFeeds); err != nil {
+					return fmt.Errorf("unmarshal field threat_feeds: %w", err)
+				}
+			}
+		case silverhttptrafficclientip5m.FieldThreatCategories:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field threat_categories", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ThreatCategories); err != nil {
+					return fmt.Errorf("unmarshal field threat_categories: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -298,6 +329,15 @@ func (_m *SilverHttptrafficClientIp5m) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_mapped=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsMapped))
+	builder.WriteString(", ")
+	builder.WriteString("is_threat_listed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsThreatListed))
+	builder.WriteString(", ")
+	builder.WriteString("threat_feeds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ThreatFeeds))
+	builder.WriteString(", ")
+	builder.WriteString("threat_categories=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ThreatCategories))
 	builder.WriteByte(')')
 	return builder.String()
 }
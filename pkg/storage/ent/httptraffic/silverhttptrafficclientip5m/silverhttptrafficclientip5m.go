@@ -49,6 +49,12 @@ const (
 	FieldRequestCount = "request_count"
 	// FieldIsMapped holds the string denoting the is_mapped field in the database.
 	FieldIsMapped = "is_mapped"
+	// FieldIsThreatListed holds the string denoting the is_threat_listed field in the database.
+	FieldIsThreatListed = "is_threat_listed"
+	// FieldThreatFeeds holds the string denoting the threat_feeds field in the database.
+	FieldThreatFeeds = "threat_feeds"
+	// FieldThreatCategories holds the string denoting the threat_categories field in the database.
+	FieldThreatCategories = "threat_categories"
 	// Table holds the table name of the silverhttptrafficclientip5m in the database.
 	Table = "httptraffic_client_ip_5m"
 )
@@ -75,6 +81,9 @@ var Columns = []string{
 	FieldIsInternal,
 	FieldRequestCount,
 	FieldIsMapped,
+	FieldIsThreatListed,
+	FieldThreatFeeds,
+	FieldThreatCategories,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -98,6 +107,8 @@ var (
 	DefaultIsInternal bool
 	// DefaultIsMapped holds the default value on creation for the "is_mapped" field.
 	DefaultIsMapped bool
+	// DefaultIsThreatListed holds the default value on creation for the "is_threat_listed" field.
+	DefaultIsThreatListed bool
 )
 
 // OrderOption defines the ordering options for the SilverHttptrafficClientIp5m queries.
@@ -202,3 +213,8 @@ func ByRequestCount(opts ...sql.OrderTermOption) OrderOption {
 func ByIsMapped(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsMapped, opts...).ToFunc()
 }
+
+// ByIsThreatListed orders the results by the is_threat_listed field.
+func ByIsThreatListed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsThreatListed, opts...).ToFunc()
+}
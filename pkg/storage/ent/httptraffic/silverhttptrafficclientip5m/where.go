@@ -159,6 +159,11 @@ func IsMapped(v bool) predicate.SilverHttptrafficClientIp5m {
 	return predicate.SilverHttptrafficClientIp5m(sql.FieldEQ(FieldIsMapped, v))
 }
 
+// IsThreatListed applies equality check predicate on the "is_threat_listed" field. It's identical to IsThreatListedEQ.
+func IsThreatListed(v bool) predicate.SilverHttptrafficClientIp5m {
+	return predicate.SilverHttptrafficClientIp5m(sql.FieldEQ(FieldIsThreatListed, v))
+}
+
 // CollectedAtEQ applies the EQ predicate on the "collected_at" field.
 func CollectedAtEQ(v time.Time) predicate.SilverHttptrafficClientIp5m {
 	return predicate.SilverHttptrafficClientIp5m(sql.FieldEQ(FieldCollectedAt, v))
@@ -1189,6 +1194,36 @@ func IsMappedNEQ(v bool) predicate.SilverHttptrafficClientIp5m {
 	return predicate.SilverHttptrafficClientIp5m(sql.FieldNEQ(FieldIsMapped, v))
 }
 
+// IsThreatListedEQ applies the EQ predicate on the "is_threat_listed" field.
+func IsThreatListedEQ(v bool) predicate.SilverHttptrafficClientIp5m {
+	return predicate.SilverHttptrafficClientIp5m(sql.FieldEQ(FieldIsThreatListed, v))
+}
+
+// IsThreatListedNEQ applies the NEQ predicate on the "is_threat_listed" field.
+func IsThreatListedNEQ(v bool) predicate.SilverHttptrafficClientIp5m {
+	return predicate.SilverHttptrafficClientIp5m(sql.FieldNEQ(FieldIsThreatListed, v))
+}
+
+// ThreatFeedsIsNil applies the IsNil predicate on the "threat_feeds" field.
+func ThreatFeedsIsNil() predicate.SilverHttptrafficClientIp5m {
+	return predicate.SilverHttptrafficClientIp5m(sql.FieldIsNull(FieldThreatFeeds))
+}
+
+// ThreatFeedsNotNil applies the NotNil predicate on the "threat_feeds" field.
+func ThreatFeedsNotNil() predicate.SilverHttptrafficClientIp5m {
+	return predicate.SilverHttptrafficClientIp5m(sql.FieldNotNull(FieldThreatFeeds))
+}
+
+// ThreatCategoriesIsNil applies the IsNil predicate on the "threat_categories" field.
+func ThreatCategoriesIsNil() predicate.SilverHttptrafficClientIp5m {
+	return predicate.SilverHttptrafficClientIp5m(sql.FieldIsNull(FieldThreatCategories))
+}
+
+// ThreatCategoriesNotNil applies the NotNil predicate on the "threat_categories" field.
+func ThreatCategoriesNotNil() predicate.SilverHttptrafficClientIp5m {
+	return predicate.SilverHttptrafficClientIp5m(sql.FieldNotNull(FieldThreatCategories))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.SilverHttptrafficClientIp5m) predicate.SilverHttptrafficClientIp5m {
 	return predicate.SilverHttptrafficClientIp5m(sql.AndPredicates(predicates...))
@@ -214,6 +214,32 @@ func (_c *SilverHttptrafficClientIp5mCreate) SetNillableIsMapped(v *bool) *Silve
 	return _c
 }
 
+// SetIsThreatListed sets the "is_threat_listed" field.
+func (_c *SilverHttptrafficClientIp5mCreate) SetIsThreatListed(v bool) *SilverHttptrafficClientIp5mCreate {
+	_c.mutation.SetIsThreatListed(v)
+	return _c
+}
+
+// SetNillableIsThreatListed sets the "is_threat_listed" field if the given value is not nil.
+func (_c *SilverHttptrafficClientIp5mCreate) SetNillableIsThreatListed(v *bool) *SilverHttptrafficClientIp5mCreate {
+	if v != nil {
+		_c.SetIsThreatListed(*v)
+	}
+	return _c
+}
+
+// SetThreatFeeds sets the "threat_feeds" field.
+func (_c *SilverHttptrafficClientIp5mCreate) SetThreatFeeds(v []string) *SilverHttptrafficClientIp5mCreate {
+	_c.mutation.SetThreatFeeds(v)
+	return _c
+}
+
+// SetThreatCategories sets the "threat_categories" field.
+func (_c *SilverHttptrafficClientIp5mCreate) SetThreatCategories(v []string) *SilverHttptrafficClientIp5mCreate {
+	_c.mutation.SetThreatCategories(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *SilverHttptrafficClientIp5mCreate) SetID(v string) *SilverHttptrafficClientIp5mCreate {
 	_c.mutation.SetID(v)
@@ -263,6 +289,10 @@ func (_c *SilverHttptrafficClientIp5mCreate) defaults() {
 		v := silverhttptrafficclientip5m.DefaultIsMapped
 		_c.mutation.SetIsMapped(v)
 	}
+	if _, ok := _c.mutation.IsThreatListed(); !ok {
+		v := silverhttptrafficclientip5m.DefaultIsThreatListed
+		_c.mutation.SetIsThreatListed(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -315,6 +345,9 @@ func (_c *SilverHttptrafficClientIp5mCreate) check() error {
 	if _, ok := _c.mutation.IsMapped(); !ok {
 		return &ValidationError{Name: "is_mapped", err: errors.New(`httptraffic: missing required field "SilverHttptrafficClientIp5m.is_mapped"`)}
 	}
+	if _, ok := _c.mutation.IsThreatListed(); !ok {
+		return &ValidationError{Name: "is_threat_listed", err: errors.New(`httptraffic: missing required field "SilverHttptrafficClientIp5m.is_threat_listed"`)}
+	}
 	return nil
 }
 
@@ -427,6 +460,18 @@ func (_c *SilverHttptrafficClientIp5mCreate) createSpec() (*SilverHttptrafficCli
 		_spec.SetField(silverhttptrafficclientip5m.FieldIsMapped, field.TypeBool, value)
 		_node.IsMapped = value
 	}
+	if value, ok := _c.mutation.IsThreatListed(); ok {
+		_spec.SetField(silverhttptrafficclientip5m.FieldIsThreatListed, field.TypeBool, value)
+		_node.IsThreatListed = value
+	}
+	if value, ok := _c.mutation.ThreatFeeds(); ok {
+		_spec.SetField(silverhttptrafficclientip5m.FieldThreatFeeds, field.TypeJSON, value)
+		_node.ThreatFeeds = value
+	}
+	if value, ok := _c.mutation.ThreatCategories(); ok {
+		_spec.SetField(silverhttptrafficclientip5m.FieldThreatCategories, field.TypeJSON, value)
+		_node.ThreatCategories = value
+	}
 	return _node, _spec
 }
 
@@ -13,6 +13,7 @@ import (
 	"danny.vn/hotpot/pkg/storage/ent/httptraffic/silverhttptrafficclientip5m"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -343,6 +344,56 @@ func (_u *SilverHttptrafficClientIp5mUpdate) SetNillableIsMapped(v *bool) *Silve
 	return _u
 }
 
+// SetIsThreatListed sets the "is_threat_listed" field.
+func (_u *SilverHttptrafficClientIp5mUpdate) SetIsThreatListed(v bool) *SilverHttptrafficClientIp5mUpdate {
+	_u.mutation.SetIsThreatListed(v)
+	return _u
+}
+
+// SetNillableIsThreatListed sets the "is_threat_listed" field if the given value is not nil.
+func (_u *SilverHttptrafficClientIp5mUpdate) SetNillableIsThreatListed(v *bool) *SilverHttptrafficClientIp5mUpdate {
+	if v != nil {
+		_u.SetIsThreatListed(*v)
+	}
+	return _u
+}
+
+// SetThreatFeeds sets the "threat_feeds" field.
+func (_u *SilverHttptrafficClientIp5mUpdate) SetThreatFeeds(v []string) *SilverHttptrafficClientIp5mUpdate {
+	_u.mutation.SetThreatFeeds(v)
+	return _u
+}
+
+// AppendThreatFeeds appends value to the "threat_feeds" field.
+func (_u *SilverHttptrafficClientIp5mUpdate) AppendThreatFeeds(v []string) *SilverHttptrafficClientIp5mUpdate {
+	_u.mutation.AppendThreatFeeds(v)
+	return _u
+}
+
+// ClearThreatFeeds clears the value of the "threat_feeds" field.
+func (_u *SilverHttptrafficClientIp5mUpdate) ClearThreatFeeds() *SilverHttptrafficClientIp5mUpdate {
+	_u.mutation.ClearThreatFeeds()
+	return _u
+}
+
+// SetThreatCategories sets the "threat_categories" field.
+func (_u *SilverHttptrafficClientIp5mUpdate) SetThreatCategories(v []string) *SilverHttptrafficClientIp5mUpdate {
+	_u.mutation.SetThreatCategories(v)
+	return _u
+}
+
+// AppendThreatCategories appends value to the "threat_categories" field.
+func (_u *SilverHttptrafficClientIp5mUpdate) AppendThreatCategories(v []string) *SilverHttptrafficClientIp5mUpdate {
+	_u.mutation.AppendThreatCategories(v)
+	return _u
+}
+
+// ClearThreatCategories clears the value of the "threat_categories" field.
+func (_u *SilverHttptrafficClientIp5mUpdate) ClearThreatCategories() *SilverHttptrafficClientIp5mUpdate {
+	_u.mutation.ClearThreatCategories()
+	return _u
+}
+
 // Mutation returns the SilverHttptrafficClientIp5mMutation object of the builder.
 func (_u *SilverHttptrafficClientIp5mUpdate) Mutation() *SilverHttptrafficClientIp5mMutation {
 	return _u.mutation
@@ -491,6 +542,31 @@ func (_u *SilverHttptrafficClientIp5mUpdate) sqlSave(ctx context.Context) (_node
 	if value, ok := _u.mutation.IsMapped(); ok {
 		_spec.SetField(silverhttptrafficclientip5m.FieldIsMapped, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.IsThreatListed(); ok {
+		_spec.SetField(silverhttptrafficclientip5m.FieldIsThreatListed, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ThreatFeeds(); ok {
+		_spec.SetField(silverhttptrafficclientip5m.FieldThreatFeeds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedThreatFeeds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, silverhttptrafficclientip5m.FieldThreatFeeds, value)
+		})
+	}
+	if _u.mutation.ThreatFeedsCleared() {
+		_spec.ClearField(silverhttptrafficclientip5m.FieldThreatFeeds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ThreatCategories(); ok {
+		_spec.SetField(silverhttptrafficclientip5m.FieldThreatCategories, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedThreatCategories(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, silverhttptrafficclientip5m.FieldThreatCategories, value)
+		})
+	}
+	if _u.mutation.ThreatCategoriesCleared() {
+		_spec.ClearField(silverhttptrafficclientip5m.FieldThreatCategories, field.TypeJSON)
+	}
 	_spec.Node.Schema = _u.schemaConfig.SilverHttptrafficClientIp5m
 	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
@@ -827,6 +903,56 @@ func (_u *SilverHttptrafficClientIp5mUpdateOne) SetNillableIsMapped(v *bool) *Si
 	return _u
 }
 
+// SetIsThreatListed sets the "is_threat_listed" field.
+func (_u *SilverHttptrafficClientIp5mUpdateOne) SetIsThreatListed(v bool) *SilverHttptrafficClientIp5mUpdateOne {
+	_u.mutation.SetIsThreatListed(v)
+	return _u
+}
+
+// SetNillableIsThreatListed sets the "is_threat_listed" field if the given value is not nil.
+func (_u *SilverHttptrafficClientIp5mUpdateOne) SetNillableIsThreatListed(v *bool) *SilverHttptrafficClientIp5mUpdateOne {
+	if v != nil {
+		_u.SetIsThreatListed(*v)
+	}
+	return _u
+}
+
+// SetThreatFeeds sets the "threat_feeds" field.
+func (_u *SilverHttptrafficClientIp5mUpdateOne) SetThreatFeeds(v []string) *SilverHttptrafficClientIp5mUpdateOne {
+	_u.mutation.SetThreatFeeds(v)
+	return _u
+}
+
+// AppendThreatFeeds appends value to the "threat_feeds" field.
+func (_u *SilverHttptrafficClientIp5mUpdateOne) AppendThreatFeeds(v []string) *SilverHttptrafficClientIp5mUpdateOne {
+	_u.mutation.AppendThreatFeeds(v)
+	return _u
+}
+
+// ClearThreatFeeds clears the value of the "threat_feeds" field.
+func (_u *SilverHttptrafficClientIp5mUpdateOne) ClearThreatFeeds() *SilverHttptrafficClientIp5mUpdateOne {
+	_u.mutation.ClearThreatFeeds()
+	return _u
+}
+
+// SetThreatCategories sets the "threat_categories" field.
+func (_u *SilverHttptrafficClientIp5mUpdateOne) SetThreatCategories(v []string) *SilverHttptrafficClientIp5mUpdateOne {
+	_u.mutation.SetThreatCategories(v)
+	return _u
+}
+
+// AppendThreatCategories appends value to the "threat_categories" field.
+func (_u *SilverHttptrafficClientIp5mUpdateOne) AppendThreatCategories(v []string) *SilverHttptrafficClientIp5mUpdateOne {
+	_u.mutation.AppendThreatCategories(v)
+	return _u
+}
+
+// ClearThreatCategories clears the value of the "threat_categories" field.
+func (_u *SilverHttptrafficClientIp5mUpdateOne) ClearThreatCategories() *SilverHttptrafficClientIp5mUpdateOne {
+	_u.mutation.ClearThreatCategories()
+	return _u
+}
+
 // Mutation returns the SilverHttptrafficClientIp5mMutation object of the builder.
 func (_u *SilverHttptrafficClientIp5mUpdateOne) Mutation() *SilverHttptrafficClientIp5mMutation {
 	return _u.mutation
@@ -1005,6 +1131,31 @@ func (_u *SilverHttptrafficClientIp5mUpdateOne) sqlSave(ctx context.Context) (_n
 	if value, ok := _u.mutation.IsMapped(); ok {
 		_spec.SetField(silverhttptrafficclientip5m.FieldIsMapped, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.IsThreatListed(); ok {
+		_spec.SetField(silverhttptrafficclientip5m.FieldIsThreatListed, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ThreatFeeds(); ok {
+		_spec.SetField(silverhttptrafficclientip5m.FieldThreatFeeds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedThreatFeeds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, silverhttptrafficclientip5m.FieldThreatFeeds, value)
+		})
+	}
+	if _u.mutation.ThreatFeedsCleared() {
+		_spec.ClearField(silverhttptrafficclientip5m.FieldThreatFeeds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ThreatCategories(); ok {
+		_spec.SetField(silverhttptrafficclientip5m.FieldThreatCategories, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedThreatCategories(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, silverhttptrafficclientip5m.FieldThreatCategories, value)
+		})
+	}
+	if _u.mutation.ThreatCategoriesCleared() {
+		_spec.ClearField(silverhttptrafficclientip5m.FieldThreatCategories, field.TypeJSON)
+	}
 	_spec.Node.Schema = _u.schemaConfig.SilverHttptrafficClientIp5m
 	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
 	_node = &SilverHttptrafficClientIp5m{config: _u.config}
@@ -34,8 +34,10 @@ type InventoryApiEndpoint struct {
 	Methods []string `json:"methods,omitempty"`
 	// IsActive holds the value of the "is_active" field.
 	IsActive bool `json:"is_active,omitempty"`
-	// Derived from URI prefix: public, protected, private
+	// public, protected, private; from the URI prefix (manual) or security requirements (openapi)
 	AccessLevel string `json:"access_level,omitempty"`
+	// Candidate from cloud routing or observed traffic, pending review; not used to map traffic
+	IsDiscovered bool `json:"is_discovered,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the InventoryApiEndpointQuery when eager-loading is set.
 	Edges        InventoryApiEndpointEdges `json:"edges"`
@@ -67,7 +69,7 @@ func (*InventoryApiEndpoint) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case inventoryapiendpoint.FieldMethods:
 			values[i] = new([]byte)
-		case inventoryapiendpoint.FieldIsActive:
+		case inventoryapiendpoint.FieldIsActive, inventoryapiendpoint.FieldIsDiscovered:
 			values[i] = new(sql.NullBool)
 		case inventoryapiendpoint.FieldID, inventoryapiendpoint.FieldName, inventoryapiendpoint.FieldService, inventoryapiendpoint.FieldURIPattern, inventoryapiendpoint.FieldAccessLevel:
 			values[i] = new(sql.NullString)
@@ -150,6 +152,12 @@ func (_m *InventoryApiEndpoint) assignValues(columns []string, values []any) err
 			} else if value.Valid {
 				_m.AccessLevel = value.String
 			}
+		case inventoryapiendpoint.FieldIsDiscovered:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_discovered", values[i])
+			} else if value.Valid {
+				_m.IsDiscovered = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -217,6 +225,9 @@ func (_m *InventoryApiEndpoint) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("access_level=")
 	builder.WriteString(_m.AccessLevel)
+	builder.WriteString(", ")
+	builder.WriteString("is_discovered=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsDiscovered))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -30,6 +30,8 @@ const (
 	FieldIsActive = "is_active"
 	// FieldAccessLevel holds the string denoting the access_level field in the database.
 	FieldAccessLevel = "access_level"
+	// FieldIsDiscovered holds the string denoting the is_discovered field in the database.
+	FieldIsDiscovered = "is_discovered"
 	// EdgeBronzeLinks holds the string denoting the bronze_links edge name in mutations.
 	EdgeBronzeLinks = "bronze_links"
 	// InventoryApiEndpointBronzeLinkFieldID holds the string denoting the ID field of the InventoryApiEndpointBronzeLink.
@@ -57,6 +59,7 @@ var Columns = []string{
 	FieldMethods,
 	FieldIsActive,
 	FieldAccessLevel,
+	FieldIsDiscovered,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -74,6 +77,8 @@ var (
 	URIPatternValidator func(string) error
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
+	// DefaultIsDiscovered holds the default value on creation for the "is_discovered" field.
+	DefaultIsDiscovered bool
 )
 
 // OrderOption defines the ordering options for the InventoryApiEndpoint queries.
@@ -124,6 +129,11 @@ func ByAccessLevel(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAccessLevel, opts...).ToFunc()
 }
 
+// ByIsDiscovered orders the results by the is_discovered field.
+func ByIsDiscovered(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsDiscovered, opts...).ToFunc()
+}
+
 // ByBronzeLinksCount orders the results by bronze_links count.
 func ByBronzeLinksCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -106,6 +106,11 @@ func AccessLevel(v string) predicate.InventoryApiEndpoint {
 	return predicate.InventoryApiEndpoint(sql.FieldEQ(FieldAccessLevel, v))
 }
 
+// IsDiscovered applies equality check predicate on the "is_discovered" field. It's identical to IsDiscoveredEQ.
+func IsDiscovered(v bool) predicate.InventoryApiEndpoint {
+	return predicate.InventoryApiEndpoint(sql.FieldEQ(FieldIsDiscovered, v))
+}
+
 // CollectedAtEQ applies the EQ predicate on the "collected_at" field.
 func CollectedAtEQ(v time.Time) predicate.InventoryApiEndpoint {
 	return predicate.InventoryApiEndpoint(sql.FieldEQ(FieldCollectedAt, v))
@@ -536,6 +541,16 @@ func AccessLevelContainsFold(v string) predicate.InventoryApiEndpoint {
 	return predicate.InventoryApiEndpoint(sql.FieldContainsFold(FieldAccessLevel, v))
 }
 
+// IsDiscoveredEQ applies the EQ predicate on the "is_discovered" field.
+func IsDiscoveredEQ(v bool) predicate.InventoryApiEndpoint {
+	return predicate.InventoryApiEndpoint(sql.FieldEQ(FieldIsDiscovered, v))
+}
+
+// IsDiscoveredNEQ applies the NEQ predicate on the "is_discovered" field.
+func IsDiscoveredNEQ(v bool) predicate.InventoryApiEndpoint {
+	return predicate.InventoryApiEndpoint(sql.FieldNEQ(FieldIsDiscovered, v))
+}
+
 // HasBronzeLinks applies the HasEdge predicate on the "bronze_links" edge.
 func HasBronzeLinks() predicate.InventoryApiEndpoint {
 	return predicate.InventoryApiEndpoint(func(s *sql.Selector) {
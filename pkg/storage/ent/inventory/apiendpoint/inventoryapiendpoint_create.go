@@ -107,6 +107,20 @@ func (_c *InventoryApiEndpointCreate) SetNillableAccessLevel(v *string) *Invento
 	return _c
 }
 
+// SetIsDiscovered sets the "is_discovered" field.
+func (_c *InventoryApiEndpointCreate) SetIsDiscovered(v bool) *InventoryApiEndpointCreate {
+	_c.mutation.SetIsDiscovered(v)
+	return _c
+}
+
+// SetNillableIsDiscovered sets the "is_discovered" field if the given value is not nil.
+func (_c *InventoryApiEndpointCreate) SetNillableIsDiscovered(v *bool) *InventoryApiEndpointCreate {
+	if v != nil {
+		_c.SetIsDiscovered(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *InventoryApiEndpointCreate) SetID(v string) *InventoryApiEndpointCreate {
 	_c.mutation.SetID(v)
@@ -167,6 +181,10 @@ func (_c *InventoryApiEndpointCreate) defaults() {
 		v := inventoryapiendpoint.DefaultIsActive
 		_c.mutation.SetIsActive(v)
 	}
+	if _, ok := _c.mutation.IsDiscovered(); !ok {
+		v := inventoryapiendpoint.DefaultIsDiscovered
+		_c.mutation.SetIsDiscovered(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -191,6 +209,9 @@ func (_c *InventoryApiEndpointCreate) check() error {
 	if _, ok := _c.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`apiendpoint: missing required field "InventoryApiEndpoint.is_active"`)}
 	}
+	if _, ok := _c.mutation.IsDiscovered(); !ok {
+		return &ValidationError{Name: "is_discovered", err: errors.New(`apiendpoint: missing required field "InventoryApiEndpoint.is_discovered"`)}
+	}
 	return nil
 }
 
@@ -263,6 +284,10 @@ func (_c *InventoryApiEndpointCreate) createSpec() (*InventoryApiEndpoint, *sqlg
 		_spec.SetField(inventoryapiendpoint.FieldAccessLevel, field.TypeString, value)
 		_node.AccessLevel = value
 	}
+	if value, ok := _c.mutation.IsDiscovered(); ok {
+		_spec.SetField(inventoryapiendpoint.FieldIsDiscovered, field.TypeBool, value)
+		_node.IsDiscovered = value
+	}
 	if nodes := _c.mutation.BronzeLinksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -165,6 +165,20 @@ func (_u *InventoryApiEndpointUpdate) ClearAccessLevel() *InventoryApiEndpointUp
 	return _u
 }
 
+// SetIsDiscovered sets the "is_discovered" field.
+func (_u *InventoryApiEndpointUpdate) SetIsDiscovered(v bool) *InventoryApiEndpointUpdate {
+	_u.mutation.SetIsDiscovered(v)
+	return _u
+}
+
+// SetNillableIsDiscovered sets the "is_discovered" field if the given value is not nil.
+func (_u *InventoryApiEndpointUpdate) SetNillableIsDiscovered(v *bool) *InventoryApiEndpointUpdate {
+	if v != nil {
+		_u.SetIsDiscovered(*v)
+	}
+	return _u
+}
+
 // AddBronzeLinkIDs adds the "bronze_links" edge to the InventoryApiEndpointBronzeLink entity by IDs.
 func (_u *InventoryApiEndpointUpdate) AddBronzeLinkIDs(ids ...int) *InventoryApiEndpointUpdate {
 	_u.mutation.AddBronzeLinkIDs(ids...)
@@ -296,6 +310,9 @@ func (_u *InventoryApiEndpointUpdate) sqlSave(ctx context.Context) (_node int, e
 	if _u.mutation.AccessLevelCleared() {
 		_spec.ClearField(inventoryapiendpoint.FieldAccessLevel, field.TypeString)
 	}
+	if value, ok := _u.mutation.IsDiscovered(); ok {
+		_spec.SetField(inventoryapiendpoint.FieldIsDiscovered, field.TypeBool, value)
+	}
 	if _u.mutation.BronzeLinksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -500,6 +517,20 @@ func (_u *InventoryApiEndpointUpdateOne) ClearAccessLevel() *InventoryApiEndpoin
 	return _u
 }
 
+// SetIsDiscovered sets the "is_discovered" field.
+func (_u *InventoryApiEndpointUpdateOne) SetIsDiscovered(v bool) *InventoryApiEndpointUpdateOne {
+	_u.mutation.SetIsDiscovered(v)
+	return _u
+}
+
+// SetNillableIsDiscovered sets the "is_discovered" field if the given value is not nil.
+func (_u *InventoryApiEndpointUpdateOne) SetNillableIsDiscovered(v *bool) *InventoryApiEndpointUpdateOne {
+	if v != nil {
+		_u.SetIsDiscovered(*v)
+	}
+	return _u
+}
+
 // AddBronzeLinkIDs adds the "bronze_links" edge to the InventoryApiEndpointBronzeLink entity by IDs.
 func (_u *InventoryApiEndpointUpdateOne) AddBronzeLinkIDs(ids ...int) *InventoryApiEndpointUpdateOne {
 	_u.mutation.AddBronzeLinkIDs(ids...)
@@ -661,6 +692,9 @@ func (_u *InventoryApiEndpointUpdateOne) sqlSave(ctx context.Context) (_node *In
 	if _u.mutation.AccessLevelCleared() {
 		_spec.ClearField(inventoryapiendpoint.FieldAccessLevel, field.TypeString)
 	}
+	if value, ok := _u.mutation.IsDiscovered(); ok {
+		_spec.SetField(inventoryapiendpoint.FieldIsDiscovered, field.TypeBool, value)
+	}
 	if _u.mutation.BronzeLinksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
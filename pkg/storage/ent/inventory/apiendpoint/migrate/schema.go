@@ -21,6 +21,7 @@ var (
 		{Name: "methods", Type: field.TypeJSON, Nullable: true},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
 		{Name: "access_level", Type: field.TypeString, Nullable: true},
+		{Name: "is_discovered", Type: field.TypeBool, Default: false},
 	}
 	// InventoryAPIEndpointsTable holds the schema information for the "inventory_api_endpoints" table.
 	InventoryAPIEndpointsTable = &schema.Table{
@@ -43,6 +44,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{InventoryAPIEndpointsColumns[9]},
 			},
+			{
+				Name:    "inventoryapiendpoint_is_discovered",
+				Unique:  false,
+				Columns: []*schema.Column{InventoryAPIEndpointsColumns[10]},
+			},
 		},
 	}
 	// InventoryAPIEndpointLinksColumns holds the columns for the "inventory_api_endpoint_links" table.
@@ -45,6 +45,7 @@ type InventoryApiEndpointMutation struct {
 	appendmethods       []string
 	is_active           *bool
 	access_level        *string
+	is_discovered       *bool
 	clearedFields       map[string]struct{}
 	bronze_links        map[int]struct{}
 	removedbronze_links map[int]struct{}
@@ -550,6 +551,42 @@ func (m *InventoryApiEndpointMutation) ResetAccessLevel() {
 	delete(m.clearedFields, inventoryapiendpoint.FieldAccessLevel)
 }
 
+// SetIsDiscovered sets the "is_discovered" field.
+func (m *InventoryApiEndpointMutation) SetIsDiscovered(b bool) {
+	m.is_discovered = &b
+}
+
+// IsDiscovered returns the value of the "is_discovered" field in the mutation.
+func (m *InventoryApiEndpointMutation) IsDiscovered() (r bool, exists bool) {
+	v := m.is_discovered
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDiscovered returns the old "is_discovered" field's value of the InventoryApiEndpoint entity.
+// If the InventoryApiEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryApiEndpointMutation) OldIsDiscovered(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDiscovered is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDiscovered requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDiscovered: %w", err)
+	}
+	return oldValue.IsDiscovered, nil
+}
+
+// ResetIsDiscovered resets all changes to the "is_discovered" field.
+func (m *InventoryApiEndpointMutation) ResetIsDiscovered() {
+	m.is_discovered = nil
+}
+
 // AddBronzeLinkIDs adds the "bronze_links" edge to the InventoryApiEndpointBronzeLink entity by ids.
 func (m *InventoryApiEndpointMutation) AddBronzeLinkIDs(ids ...int) {
 	if m.bronze_links == nil {
@@ -638,7 +675,7 @@ func (m *InventoryApiEndpointMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *InventoryApiEndpointMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+	fields := make([]string, 0, 10)
 	if m.collected_at != nil {
 		fields = append(fields, inventoryapiendpoint.FieldCollectedAt)
 	}
@@ -666,6 +703,9 @@ func (m *InventoryApiEndpointMutation) Fields() []string {
 	if m.access_level != nil {
 		fields = append(fields, inventoryapiendpoint.FieldAccessLevel)
 	}
+	if m.is_discovered != nil {
+		fields = append(fields, inventoryapiendpoint.FieldIsDiscovered)
+	}
 	return fields
 }
 
@@ -692,6 +732,8 @@ func (m *InventoryApiEndpointMutation) Field(name string) (ent.Value, bool) {
 		return m.IsActive()
 	case inventoryapiendpoint.FieldAccessLevel:
 		return m.AccessLevel()
+	case inventoryapiendpoint.FieldIsDiscovered:
+		return m.IsDiscovered()
 	}
 	return nil, false
 }
@@ -719,6 +761,8 @@ func (m *InventoryApiEndpointMutation) OldField(ctx context.Context, name string
 		return m.OldIsActive(ctx)
 	case inventoryapiendpoint.FieldAccessLevel:
 		return m.OldAccessLevel(ctx)
+	case inventoryapiendpoint.FieldIsDiscovered:
+		return m.OldIsDiscovered(ctx)
 	}
 	return nil, fmt.Errorf("unknown InventoryApiEndpoint field %s", name)
 }
@@ -791,6 +835,13 @@ func (m *InventoryApiEndpointMutation) SetField(name string, value ent.Value) er
 		}
 		m.SetAccessLevel(v)
 		return nil
+	case inventoryapiendpoint.FieldIsDiscovered:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDiscovered(v)
+		return nil
 	}
 	return fmt.Errorf("unknown InventoryApiEndpoint field %s", name)
 }
@@ -894,6 +945,9 @@ func (m *InventoryApiEndpointMutation) ResetField(name string) error {
 	case inventoryapiendpoint.FieldAccessLevel:
 		m.ResetAccessLevel()
 		return nil
+	case inventoryapiendpoint.FieldIsDiscovered:
+		m.ResetIsDiscovered()
+		return nil
 	}
 	return fmt.Errorf("unknown InventoryApiEndpoint field %s", name)
 }
@@ -22,6 +22,10 @@ func init() {
 	inventoryapiendpointDescIsActive := inventoryapiendpointFields[5].Descriptor()
 	// inventoryapiendpoint.DefaultIsActive holds the default value on creation for the is_active field.
 	inventoryapiendpoint.DefaultIsActive = inventoryapiendpointDescIsActive.Default.(bool)
+	// inventoryapiendpointDescIsDiscovered is the schema descriptor for is_discovered field.
+	inventoryapiendpointDescIsDiscovered := inventoryapiendpointFields[7].Descriptor()
+	// inventoryapiendpoint.DefaultIsDiscovered holds the default value on creation for the is_discovered field.
+	inventoryapiendpoint.DefaultIsDiscovered = inventoryapiendpointDescIsDiscovered.Default.(bool)
 	inventoryapiendpointbronzelinkFields := schema.InventoryApiEndpointBronzeLink{}.Fields()
 	_ = inventoryapiendpointbronzelinkFields
 	// inventoryapiendpointbronzelinkDescProvider is the schema descriptor for provider field.
@@ -0,0 +1,682 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/migrate"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimage"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagebronzelink"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagenormalized"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/internal"
+)
+
+// Client is the client that holds all ent builders.
+type Client struct {
+	config
+	// Schema is the client for creating, migrating and dropping schema.
+	Schema *migrate.Schema
+	// InventoryImage is the client for interacting with the InventoryImage builders.
+	InventoryImage *InventoryImageClient
+	// InventoryImageBronzeLink is the client for interacting with the InventoryImageBronzeLink builders.
+	InventoryImageBronzeLink *InventoryImageBronzeLinkClient
+	// InventoryImageNormalized is the client for interacting with the InventoryImageNormalized builders.
+	InventoryImageNormalized *InventoryImageNormalizedClient
+}
+
+// NewClient creates a new client configured with the given options.
+func NewClient(opts ...Option) *Client {
+	client := &Client{config: newConfig(opts...)}
+	client.init()
+	return client
+}
+
+func (c *Client) init() {
+	c.Schema = migrate.NewSchema(c.driver)
+	c.InventoryImage = NewInventoryImageClient(c.config)
+	c.InventoryImageBronzeLink = NewInventoryImageBronzeLinkClient(c.config)
+	c.InventoryImageNormalized = NewInventoryImageNormalizedClient(c.config)
+}
+
+type (
+	// config is the configuration for the client and its builder.
+	config struct {
+		// driver used for executing database requests.
+		driver dialect.Driver
+		// debug enable a debug logging.
+		debug bool
+		// log used for logging on debug mode.
+		log func(...any)
+		// hooks to execute on mutations.
+		hooks *hooks
+		// interceptors to execute on queries.
+		inters *inters
+		// schemaConfig contains alternative names for all tables.
+		schemaConfig SchemaConfig
+	}
+	// Option function to configure the client.
+	Option func(*config)
+)
+
+// newConfig creates a new config for the client.
+func newConfig(opts ...Option) config {
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
+	cfg.options(opts...)
+	return cfg
+}
+
+// options applies the options on the config object.
+func (c *config) options(opts ...Option) {
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.debug {
+		c.driver = dialect.Debug(c.driver, c.log)
+	}
+}
+
+// Debug enables debug logging on the ent.Driver.
+func Debug() Option {
+	return func(c *config) {
+		c.debug = true
+	}
+}
+
+// Log sets the logging function for debug mode.
+func Log(fn func(...any)) Option {
+	return func(c *config) {
+		c.log = fn
+	}
+}
+
+// Driver configures the client driver.
+func Driver(driver dialect.Driver) Option {
+	return func(c *config) {
+		c.driver = driver
+	}
+}
+
+// Open opens a database/sql.DB specified by the driver name and
+// the data source name, and returns a new client attached to it.
+// Optional parameters can be added for configuring the client.
+func Open(driverName, dataSourceName string, options ...Option) (*Client, error) {
+	switch driverName {
+	case dialect.MySQL, dialect.Postgres, dialect.SQLite:
+		drv, err := sql.Open(driverName, dataSourceName)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(append(options, Driver(drv))...), nil
+	default:
+		return nil, fmt.Errorf("unsupported driver: %q", driverName)
+	}
+}
+
+// ErrTxStarted is returned when trying to start a new transaction from a transactional client.
+var ErrTxStarted = errors.New("image: cannot start a transaction within a transaction")
+
+// Tx returns a new transactional client. The provided context
+// is used until the transaction is committed or rolled back.
+func (c *Client) Tx(ctx context.Context) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, ErrTxStarted
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("image: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	return &Tx{
+		ctx:                      ctx,
+		config:                   cfg,
+		InventoryImage:           NewInventoryImageClient(cfg),
+		InventoryImageBronzeLink: NewInventoryImageBronzeLinkClient(cfg),
+		InventoryImageNormalized: NewInventoryImageNormalizedClient(cfg),
+	}, nil
+}
+
+// BeginTx returns a transactional client with specified options.
+func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, errors.New("ent: cannot start a transaction within a transaction")
+	}
+	tx, err := c.driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}).BeginTx(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = &txDriver{tx: tx, drv: c.driver}
+	return &Tx{
+		ctx:                      ctx,
+		config:                   cfg,
+		InventoryImage:           NewInventoryImageClient(cfg),
+		InventoryImageBronzeLink: NewInventoryImageBronzeLinkClient(cfg),
+		InventoryImageNormalized: NewInventoryImageNormalizedClient(cfg),
+	}, nil
+}
+
+// Debug returns a new debug-client. It's used to get verbose logging on specific operations.
+//
+//	client.Debug().
+//		InventoryImage.
+//		Query().
+//		Count(ctx)
+func (c *Client) Debug() *Client {
+	if c.debug {
+		return c
+	}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// Close closes the database connection and prevents new queries from starting.
+func (c *Client) Close() error {
+	return c.driver.Close()
+}
+
+// Use adds the mutation hooks to all the entity clients.
+// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
+func (c *Client) Use(hooks ...Hook) {
+	c.InventoryImage.Use(hooks...)
+	c.InventoryImageBronzeLink.Use(hooks...)
+	c.InventoryImageNormalized.Use(hooks...)
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.InventoryImage.Intercept(interceptors...)
+	c.InventoryImageBronzeLink.Intercept(interceptors...)
+	c.InventoryImageNormalized.Intercept(interceptors...)
+}
+
+// Mutate implements the ent.Mutator interface.
+func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
+	switch m := m.(type) {
+	case *InventoryImageMutation:
+		return c.InventoryImage.mutate(ctx, m)
+	case *InventoryImageBronzeLinkMutation:
+		return c.InventoryImageBronzeLink.mutate(ctx, m)
+	case *InventoryImageNormalizedMutation:
+		return c.InventoryImageNormalized.mutate(ctx, m)
+	default:
+		return nil, fmt.Errorf("image: unknown mutation type %T", m)
+	}
+}
+
+// InventoryImageClient is a client for the InventoryImage schema.
+type InventoryImageClient struct {
+	config
+}
+
+// NewInventoryImageClient returns a client for the InventoryImage from the given config.
+func NewInventoryImageClient(c config) *InventoryImageClient {
+	return &InventoryImageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `inventoryimage.Hooks(f(g(h())))`.
+func (c *InventoryImageClient) Use(hooks ...Hook) {
+	c.hooks.InventoryImage = append(c.hooks.InventoryImage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `inventoryimage.Intercept(f(g(h())))`.
+func (c *InventoryImageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InventoryImage = append(c.inters.InventoryImage, interceptors...)
+}
+
+// Create returns a builder for creating a InventoryImage entity.
+func (c *InventoryImageClient) Create() *InventoryImageCreate {
+	mutation := newInventoryImageMutation(c.config, OpCreate)
+	return &InventoryImageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InventoryImage entities.
+func (c *InventoryImageClient) CreateBulk(builders ...*InventoryImageCreate) *InventoryImageCreateBulk {
+	return &InventoryImageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InventoryImageClient) MapCreateBulk(slice any, setFunc func(*InventoryImageCreate, int)) *InventoryImageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InventoryImageCreateBulk{err: fmt.Errorf("calling to InventoryImageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InventoryImageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InventoryImageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InventoryImage.
+func (c *InventoryImageClient) Update() *InventoryImageUpdate {
+	mutation := newInventoryImageMutation(c.config, OpUpdate)
+	return &InventoryImageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InventoryImageClient) UpdateOne(_m *InventoryImage) *InventoryImageUpdateOne {
+	mutation := newInventoryImageMutation(c.config, OpUpdateOne, withInventoryImage(_m))
+	return &InventoryImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InventoryImageClient) UpdateOneID(id string) *InventoryImageUpdateOne {
+	mutation := newInventoryImageMutation(c.config, OpUpdateOne, withInventoryImageID(id))
+	return &InventoryImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InventoryImage.
+func (c *InventoryImageClient) Delete() *InventoryImageDelete {
+	mutation := newInventoryImageMutation(c.config, OpDelete)
+	return &InventoryImageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InventoryImageClient) DeleteOne(_m *InventoryImage) *InventoryImageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InventoryImageClient) DeleteOneID(id string) *InventoryImageDeleteOne {
+	builder := c.Delete().Where(inventoryimage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InventoryImageDeleteOne{builder}
+}
+
+// Query returns a query builder for InventoryImage.
+func (c *InventoryImageClient) Query() *InventoryImageQuery {
+	return &InventoryImageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInventoryImage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InventoryImage entity by its id.
+func (c *InventoryImageClient) Get(ctx context.Context, id string) (*InventoryImage, error) {
+	return c.Query().Where(inventoryimage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InventoryImageClient) GetX(ctx context.Context, id string) *InventoryImage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBronzeLinks queries the bronze_links edge of a InventoryImage.
+func (c *InventoryImageClient) QueryBronzeLinks(_m *InventoryImage) *InventoryImageBronzeLinkQuery {
+	query := (&InventoryImageBronzeLinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(inventoryimage.Table, inventoryimage.FieldID, id),
+			sqlgraph.To(inventoryimagebronzelink.Table, inventoryimagebronzelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, inventoryimage.BronzeLinksTable, inventoryimage.BronzeLinksColumn),
+		)
+		schemaConfig := _m.schemaConfig
+		step.To.Schema = schemaConfig.InventoryImageBronzeLink
+		step.Edge.Schema = schemaConfig.InventoryImageBronzeLink
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InventoryImageClient) Hooks() []Hook {
+	return c.hooks.InventoryImage
+}
+
+// Interceptors returns the client interceptors.
+func (c *InventoryImageClient) Interceptors() []Interceptor {
+	return c.inters.InventoryImage
+}
+
+func (c *InventoryImageClient) mutate(ctx context.Context, m *InventoryImageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InventoryImageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InventoryImageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InventoryImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InventoryImageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("image: unknown InventoryImage mutation op: %q", m.Op())
+	}
+}
+
+// InventoryImageBronzeLinkClient is a client for the InventoryImageBronzeLink schema.
+type InventoryImageBronzeLinkClient struct {
+	config
+}
+
+// NewInventoryImageBronzeLinkClient returns a client for the InventoryImageBronzeLink from the given config.
+func NewInventoryImageBronzeLinkClient(c config) *InventoryImageBronzeLinkClient {
+	return &InventoryImageBronzeLinkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `inventoryimagebronzelink.Hooks(f(g(h())))`.
+func (c *InventoryImageBronzeLinkClient) Use(hooks ...Hook) {
+	c.hooks.InventoryImageBronzeLink = append(c.hooks.InventoryImageBronzeLink, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `inventoryimagebronzelink.Intercept(f(g(h())))`.
+func (c *InventoryImageBronzeLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InventoryImageBronzeLink = append(c.inters.InventoryImageBronzeLink, interceptors...)
+}
+
+// Create returns a builder for creating a InventoryImageBronzeLink entity.
+func (c *InventoryImageBronzeLinkClient) Create() *InventoryImageBronzeLinkCreate {
+	mutation := newInventoryImageBronzeLinkMutation(c.config, OpCreate)
+	return &InventoryImageBronzeLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InventoryImageBronzeLink entities.
+func (c *InventoryImageBronzeLinkClient) CreateBulk(builders ...*InventoryImageBronzeLinkCreate) *InventoryImageBronzeLinkCreateBulk {
+	return &InventoryImageBronzeLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InventoryImageBronzeLinkClient) MapCreateBulk(slice any, setFunc func(*InventoryImageBronzeLinkCreate, int)) *InventoryImageBronzeLinkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InventoryImageBronzeLinkCreateBulk{err: fmt.Errorf("calling to InventoryImageBronzeLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InventoryImageBronzeLinkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InventoryImageBronzeLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InventoryImageBronzeLink.
+func (c *InventoryImageBronzeLinkClient) Update() *InventoryImageBronzeLinkUpdate {
+	mutation := newInventoryImageBronzeLinkMutation(c.config, OpUpdate)
+	return &InventoryImageBronzeLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InventoryImageBronzeLinkClient) UpdateOne(_m *InventoryImageBronzeLink) *InventoryImageBronzeLinkUpdateOne {
+	mutation := newInventoryImageBronzeLinkMutation(c.config, OpUpdateOne, withInventoryImageBronzeLink(_m))
+	return &InventoryImageBronzeLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InventoryImageBronzeLinkClient) UpdateOneID(id int) *InventoryImageBronzeLinkUpdateOne {
+	mutation := newInventoryImageBronzeLinkMutation(c.config, OpUpdateOne, withInventoryImageBronzeLinkID(id))
+	return &InventoryImageBronzeLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InventoryImageBronzeLink.
+func (c *InventoryImageBronzeLinkClient) Delete() *InventoryImageBronzeLinkDelete {
+	mutation := newInventoryImageBronzeLinkMutation(c.config, OpDelete)
+	return &InventoryImageBronzeLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InventoryImageBronzeLinkClient) DeleteOne(_m *InventoryImageBronzeLink) *InventoryImageBronzeLinkDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InventoryImageBronzeLinkClient) DeleteOneID(id int) *InventoryImageBronzeLinkDeleteOne {
+	builder := c.Delete().Where(inventoryimagebronzelink.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InventoryImageBronzeLinkDeleteOne{builder}
+}
+
+// Query returns a query builder for InventoryImageBronzeLink.
+func (c *InventoryImageBronzeLinkClient) Query() *InventoryImageBronzeLinkQuery {
+	return &InventoryImageBronzeLinkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInventoryImageBronzeLink},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InventoryImageBronzeLink entity by its id.
+func (c *InventoryImageBronzeLinkClient) Get(ctx context.Context, id int) (*InventoryImageBronzeLink, error) {
+	return c.Query().Where(inventoryimagebronzelink.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InventoryImageBronzeLinkClient) GetX(ctx context.Context, id int) *InventoryImageBronzeLink {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryImage queries the image edge of a InventoryImageBronzeLink.
+func (c *InventoryImageBronzeLinkClient) QueryImage(_m *InventoryImageBronzeLink) *InventoryImageQuery {
+	query := (&InventoryImageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(inventoryimagebronzelink.Table, inventoryimagebronzelink.FieldID, id),
+			sqlgraph.To(inventoryimage.Table, inventoryimage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, inventoryimagebronzelink.ImageTable, inventoryimagebronzelink.ImageColumn),
+		)
+		schemaConfig := _m.schemaConfig
+		step.To.Schema = schemaConfig.InventoryImage
+		step.Edge.Schema = schemaConfig.InventoryImageBronzeLink
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InventoryImageBronzeLinkClient) Hooks() []Hook {
+	return c.hooks.InventoryImageBronzeLink
+}
+
+// Interceptors returns the client interceptors.
+func (c *InventoryImageBronzeLinkClient) Interceptors() []Interceptor {
+	return c.inters.InventoryImageBronzeLink
+}
+
+func (c *InventoryImageBronzeLinkClient) mutate(ctx context.Context, m *InventoryImageBronzeLinkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InventoryImageBronzeLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InventoryImageBronzeLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InventoryImageBronzeLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InventoryImageBronzeLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("image: unknown InventoryImageBronzeLink mutation op: %q", m.Op())
+	}
+}
+
+// InventoryImageNormalizedClient is a client for the InventoryImageNormalized schema.
+type InventoryImageNormalizedClient struct {
+	config
+}
+
+// NewInventoryImageNormalizedClient returns a client for the InventoryImageNormalized from the given config.
+func NewInventoryImageNormalizedClient(c config) *InventoryImageNormalizedClient {
+	return &InventoryImageNormalizedClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `inventoryimagenormalized.Hooks(f(g(h())))`.
+func (c *InventoryImageNormalizedClient) Use(hooks ...Hook) {
+	c.hooks.InventoryImageNormalized = append(c.hooks.InventoryImageNormalized, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `inventoryimagenormalized.Intercept(f(g(h())))`.
+func (c *InventoryImageNormalizedClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InventoryImageNormalized = append(c.inters.InventoryImageNormalized, interceptors...)
+}
+
+// Create returns a builder for creating a InventoryImageNormalized entity.
+func (c *InventoryImageNormalizedClient) Create() *InventoryImageNormalizedCreate {
+	mutation := newInventoryImageNormalizedMutation(c.config, OpCreate)
+	return &InventoryImageNormalizedCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InventoryImageNormalized entities.
+func (c *InventoryImageNormalizedClient) CreateBulk(builders ...*InventoryImageNormalizedCreate) *InventoryImageNormalizedCreateBulk {
+	return &InventoryImageNormalizedCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InventoryImageNormalizedClient) MapCreateBulk(slice any, setFunc func(*InventoryImageNormalizedCreate, int)) *InventoryImageNormalizedCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InventoryImageNormalizedCreateBulk{err: fmt.Errorf("calling to InventoryImageNormalizedClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InventoryImageNormalizedCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InventoryImageNormalizedCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InventoryImageNormalized.
+func (c *InventoryImageNormalizedClient) Update() *InventoryImageNormalizedUpdate {
+	mutation := newInventoryImageNormalizedMutation(c.config, OpUpdate)
+	return &InventoryImageNormalizedUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InventoryImageNormalizedClient) UpdateOne(_m *InventoryImageNormalized) *InventoryImageNormalizedUpdateOne {
+	mutation := newInventoryImageNormalizedMutation(c.config, OpUpdateOne, withInventoryImageNormalized(_m))
+	return &InventoryImageNormalizedUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InventoryImageNormalizedClient) UpdateOneID(id string) *InventoryImageNormalizedUpdateOne {
+	mutation := newInventoryImageNormalizedMutation(c.config, OpUpdateOne, withInventoryImageNormalizedID(id))
+	return &InventoryImageNormalizedUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InventoryImageNormalized.
+func (c *InventoryImageNormalizedClient) Delete() *InventoryImageNormalizedDelete {
+	mutation := newInventoryImageNormalizedMutation(c.config, OpDelete)
+	return &InventoryImageNormalizedDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InventoryImageNormalizedClient) DeleteOne(_m *InventoryImageNormalized) *InventoryImageNormalizedDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InventoryImageNormalizedClient) DeleteOneID(id string) *InventoryImageNormalizedDeleteOne {
+	builder := c.Delete().Where(inventoryimagenormalized.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InventoryImageNormalizedDeleteOne{builder}
+}
+
+// Query returns a query builder for InventoryImageNormalized.
+func (c *InventoryImageNormalizedClient) Query() *InventoryImageNormalizedQuery {
+	return &InventoryImageNormalizedQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInventoryImageNormalized},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InventoryImageNormalized entity by its id.
+func (c *InventoryImageNormalizedClient) Get(ctx context.Context, id string) (*InventoryImageNormalized, error) {
+	return c.Query().Where(inventoryimagenormalized.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InventoryImageNormalizedClient) GetX(ctx context.Context, id string) *InventoryImageNormalized {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InventoryImageNormalizedClient) Hooks() []Hook {
+	return c.hooks.InventoryImageNormalized
+}
+
+// Interceptors returns the client interceptors.
+func (c *InventoryImageNormalizedClient) Interceptors() []Interceptor {
+	return c.inters.InventoryImageNormalized
+}
+
+func (c *InventoryImageNormalizedClient) mutate(ctx context.Context, m *InventoryImageNormalizedMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InventoryImageNormalizedCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InventoryImageNormalizedUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InventoryImageNormalizedUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InventoryImageNormalizedDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("image: unknown InventoryImageNormalized mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		InventoryImage, InventoryImageBronzeLink, InventoryImageNormalized []ent.Hook
+	}
+	inters struct {
+		InventoryImage, InventoryImageBronzeLink,
+		InventoryImageNormalized []ent.Interceptor
+	}
+)
+
+// SchemaConfig represents alternative schema names for all tables
+// that can be passed at runtime.
+type SchemaConfig = internal.SchemaConfig
+
+// AlternateSchemas allows alternate schema names to be
+// passed into ent operations.
+func AlternateSchema(schemaConfig SchemaConfig) Option {
+	return func(c *config) {
+		c.schemaConfig = schemaConfig
+	}
+}
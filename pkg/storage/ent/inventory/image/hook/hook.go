@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hook
+
+import (
+	"context"
+	"fmt"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image"
+)
+
+// The InventoryImageFunc type is an adapter to allow the use of ordinary
+// function as InventoryImage mutator.
+type InventoryImageFunc func(context.Context, *image.InventoryImageMutation) (image.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InventoryImageFunc) Mutate(ctx context.Context, m image.Mutation) (image.Value, error) {
+	if mv, ok := m.(*image.InventoryImageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *image.InventoryImageMutation", m)
+}
+
+// The InventoryImageBronzeLinkFunc type is an adapter to allow the use of ordinary
+// function as InventoryImageBronzeLink mutator.
+type InventoryImageBronzeLinkFunc func(context.Context, *image.InventoryImageBronzeLinkMutation) (image.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InventoryImageBronzeLinkFunc) Mutate(ctx context.Context, m image.Mutation) (image.Value, error) {
+	if mv, ok := m.(*image.InventoryImageBronzeLinkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *image.InventoryImageBronzeLinkMutation", m)
+}
+
+// The InventoryImageNormalizedFunc type is an adapter to allow the use of ordinary
+// function as InventoryImageNormalized mutator.
+type InventoryImageNormalizedFunc func(context.Context, *image.InventoryImageNormalizedMutation) (image.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InventoryImageNormalizedFunc) Mutate(ctx context.Context, m image.Mutation) (image.Value, error) {
+	if mv, ok := m.(*image.InventoryImageNormalizedMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *image.InventoryImageNormalizedMutation", m)
+}
+
+// Condition is a hook condition function.
+type Condition func(context.Context, image.Mutation) bool
+
+// And groups conditions with the AND operator.
+func And(first, second Condition, rest ...Condition) Condition {
+	return func(ctx context.Context, m image.Mutation) bool {
+		if !first(ctx, m) || !second(ctx, m) {
+			return false
+		}
+		for _, cond := range rest {
+			if !cond(ctx, m) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or groups conditions with the OR operator.
+func Or(first, second Condition, rest ...Condition) Condition {
+	return func(ctx context.Context, m image.Mutation) bool {
+		if first(ctx, m) || second(ctx, m) {
+			return true
+		}
+		for _, cond := range rest {
+			if cond(ctx, m) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not negates a given condition.
+func Not(cond Condition) Condition {
+	return func(ctx context.Context, m image.Mutation) bool {
+		return !cond(ctx, m)
+	}
+}
+
+// HasOp is a condition testing mutation operation.
+func HasOp(op image.Op) Condition {
+	return func(_ context.Context, m image.Mutation) bool {
+		return m.Op().Is(op)
+	}
+}
+
+// HasAddedFields is a condition validating `.AddedField` on fields.
+func HasAddedFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m image.Mutation) bool {
+		if _, exists := m.AddedField(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if _, exists := m.AddedField(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// HasClearedFields is a condition validating `.FieldCleared` on fields.
+func HasClearedFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m image.Mutation) bool {
+		if exists := m.FieldCleared(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if exists := m.FieldCleared(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// HasFields is a condition validating `.Field` on fields.
+func HasFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m image.Mutation) bool {
+		if _, exists := m.Field(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if _, exists := m.Field(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// If executes the given hook under condition.
+//
+//	hook.If(ComputeAverage, And(HasFields(...), HasAddedFields(...)))
+func If(hk image.Hook, cond Condition) image.Hook {
+	return func(next image.Mutator) image.Mutator {
+		return image.MutateFunc(func(ctx context.Context, m image.Mutation) (image.Value, error) {
+			if cond(ctx, m) {
+				return hk(next).Mutate(ctx, m)
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// On executes the given hook only for the given operation.
+//
+//	hook.On(Log, image.Delete|image.Create)
+func On(hk image.Hook, op image.Op) image.Hook {
+	return If(hk, HasOp(op))
+}
+
+// Unless skips the given hook only for the given operation.
+//
+//	hook.Unless(Log, image.Update|image.UpdateOne)
+func Unless(hk image.Hook, op image.Op) image.Hook {
+	return If(hk, Not(HasOp(op)))
+}
+
+// FixedError is a hook returning a fixed error.
+func FixedError(err error) image.Hook {
+	return func(image.Mutator) image.Mutator {
+		return image.MutateFunc(func(context.Context, image.Mutation) (image.Value, error) {
+			return nil, err
+		})
+	}
+}
+
+// Reject returns a hook that rejects all operations that match op.
+//
+//	func (T) Hooks() []image.Hook {
+//		return []image.Hook{
+//			Reject(image.Delete|image.Update),
+//		}
+//	}
+func Reject(op image.Op) image.Hook {
+	hk := FixedError(fmt.Errorf("%s operation is not allowed", op))
+	return On(hk, op)
+}
+
+// Chain acts as a list of hooks and is effectively immutable.
+// Once created, it will always hold the same set of hooks in the same order.
+type Chain struct {
+	hooks []image.Hook
+}
+
+// NewChain creates a new chain of hooks.
+func NewChain(hooks ...image.Hook) Chain {
+	return Chain{append([]image.Hook(nil), hooks...)}
+}
+
+// Hook chains the list of hooks and returns the final hook.
+func (c Chain) Hook() image.Hook {
+	return func(mutator image.Mutator) image.Mutator {
+		for i := len(c.hooks) - 1; i >= 0; i-- {
+			mutator = c.hooks[i](mutator)
+		}
+		return mutator
+	}
+}
+
+// Append extends a chain, adding the specified hook
+// as the last ones in the mutation flow.
+func (c Chain) Append(hooks ...image.Hook) Chain {
+	newHooks := make([]image.Hook, 0, len(c.hooks)+len(hooks))
+	newHooks = append(newHooks, c.hooks...)
+	newHooks = append(newHooks, hooks...)
+	return Chain{newHooks}
+}
+
+// Extend extends a chain, adding the specified chain
+// as the last ones in the mutation flow.
+func (c Chain) Extend(chain Chain) Chain {
+	return c.Append(chain.hooks...)
+}
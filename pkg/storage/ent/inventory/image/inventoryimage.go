@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimage"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// InventoryImage is the model entity for the InventoryImage schema.
+type InventoryImage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// NormalizedAt holds the value of the "normalized_at" field.
+	NormalizedAt time.Time `json:"normalized_at,omitempty"`
+	// Registry holds the value of the "registry" field.
+	Registry string `json:"registry,omitempty"`
+	// Repository holds the value of the "repository" field.
+	Repository string `json:"repository,omitempty"`
+	// Digest holds the value of the "digest" field.
+	Digest string `json:"digest,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON []string `json:"tags_json,omitempty"`
+	// WorkloadsJSON holds the value of the "workloads_json" field.
+	WorkloadsJSON []string `json:"workloads_json,omitempty"`
+	// VulnerabilitiesJSON holds the value of the "vulnerabilities_json" field.
+	VulnerabilitiesJSON json.RawMessage `json:"vulnerabilities_json,omitempty"`
+	// VulnerabilityCount holds the value of the "vulnerability_count" field.
+	VulnerabilityCount int `json:"vulnerability_count,omitempty"`
+	// MaxSeverity holds the value of the "max_severity" field.
+	MaxSeverity string `json:"max_severity,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InventoryImageQuery when eager-loading is set.
+	Edges        InventoryImageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// InventoryImageEdges holds the relations/edges for other nodes in the graph.
+type InventoryImageEdges struct {
+	// BronzeLinks holds the value of the bronze_links edge.
+	BronzeLinks []*InventoryImageBronzeLink `json:"bronze_links,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BronzeLinksOrErr returns the BronzeLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e InventoryImageEdges) BronzeLinksOrErr() ([]*InventoryImageBronzeLink, error) {
+	if e.loadedTypes[0] {
+		return e.BronzeLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "bronze_links"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InventoryImage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case inventoryimage.FieldTagsJSON, inventoryimage.FieldWorkloadsJSON, inventoryimage.FieldVulnerabilitiesJSON:
+			values[i] = new([]byte)
+		case inventoryimage.FieldVulnerabilityCount:
+			values[i] = new(sql.NullInt64)
+		case inventoryimage.FieldID, inventoryimage.FieldRegistry, inventoryimage.FieldRepository, inventoryimage.FieldDigest, inventoryimage.FieldMaxSeverity:
+			values[i] = new(sql.NullString)
+		case inventoryimage.FieldCollectedAt, inventoryimage.FieldFirstCollectedAt, inventoryimage.FieldNormalizedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InventoryImage fields.
+func (_m *InventoryImage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case inventoryimage.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case inventoryimage.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case inventoryimage.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case inventoryimage.FieldNormalizedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field normalized_at", values[i])
+			} else if value.Valid {
+				_m.NormalizedAt = value.Time
+			}
+		case inventoryimage.FieldRegistry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field registry", values[i])
+			} else if value.Valid {
+				_m.Registry = value.String
+			}
+		case inventoryimage.FieldRepository:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field repository", values[i])
+			} else if value.Valid {
+				_m.Repository = value.String
+			}
+		case inventoryimage.FieldDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field digest", values[i])
+			} else if value.Valid {
+				_m.Digest = value.String
+			}
+		case inventoryimage.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case inventoryimage.FieldWorkloadsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field workloads_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.WorkloadsJSON); err != nil {
+					return fmt.Errorf("unmarshal field workloads_json: %w", err)
+				}
+			}
+		case inventoryimage.FieldVulnerabilitiesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field vulnerabilities_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.VulnerabilitiesJSON); err != nil {
+					return fmt.Errorf("unmarshal field vulnerabilities_json: %w", err)
+				}
+			}
+		case inventoryimage.FieldVulnerabilityCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vulnerability_count", values[i])
+			} else if value.Valid {
+				_m.VulnerabilityCount = int(value.Int64)
+			}
+		case inventoryimage.FieldMaxSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field max_severity", values[i])
+			} else if value.Valid {
+				_m.MaxSeverity = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InventoryImage.
+// This includes values selected through modifiers, order, etc.
+func (_m *InventoryImage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBronzeLinks queries the "bronze_links" edge of the InventoryImage entity.
+func (_m *InventoryImage) QueryBronzeLinks() *InventoryImageBronzeLinkQuery {
+	return NewInventoryImageClient(_m.config).QueryBronzeLinks(_m)
+}
+
+// Update returns a builder for updating this InventoryImage.
+// Note that you need to call InventoryImage.Unwrap() before calling this method if this InventoryImage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *InventoryImage) Update() *InventoryImageUpdateOne {
+	return NewInventoryImageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the InventoryImage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *InventoryImage) Unwrap() *InventoryImage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("image: InventoryImage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *InventoryImage) String() string {
+	var builder strings.Builder
+	builder.WriteString("InventoryImage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("normalized_at=")
+	builder.WriteString(_m.NormalizedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("registry=")
+	builder.WriteString(_m.Registry)
+	builder.WriteString(", ")
+	builder.WriteString("repository=")
+	builder.WriteString(_m.Repository)
+	builder.WriteString(", ")
+	builder.WriteString("digest=")
+	builder.WriteString(_m.Digest)
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("workloads_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkloadsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("vulnerabilities_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VulnerabilitiesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("vulnerability_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VulnerabilityCount))
+	builder.WriteString(", ")
+	builder.WriteString("max_severity=")
+	builder.WriteString(_m.MaxSeverity)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InventoryImages is a parsable slice of InventoryImage.
+type InventoryImages []*InventoryImage
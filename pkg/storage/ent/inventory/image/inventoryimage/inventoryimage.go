@@ -0,0 +1,156 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inventoryimage
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the inventoryimage type in the database.
+	Label = "inventory_image"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldNormalizedAt holds the string denoting the normalized_at field in the database.
+	FieldNormalizedAt = "normalized_at"
+	// FieldRegistry holds the string denoting the registry field in the database.
+	FieldRegistry = "registry"
+	// FieldRepository holds the string denoting the repository field in the database.
+	FieldRepository = "repository"
+	// FieldDigest holds the string denoting the digest field in the database.
+	FieldDigest = "digest"
+	// FieldTagsJSON holds the string denoting the tags_json field in the database.
+	FieldTagsJSON = "tags_json"
+	// FieldWorkloadsJSON holds the string denoting the workloads_json field in the database.
+	FieldWorkloadsJSON = "workloads_json"
+	// FieldVulnerabilitiesJSON holds the string denoting the vulnerabilities_json field in the database.
+	FieldVulnerabilitiesJSON = "vulnerabilities_json"
+	// FieldVulnerabilityCount holds the string denoting the vulnerability_count field in the database.
+	FieldVulnerabilityCount = "vulnerability_count"
+	// FieldMaxSeverity holds the string denoting the max_severity field in the database.
+	FieldMaxSeverity = "max_severity"
+	// EdgeBronzeLinks holds the string denoting the bronze_links edge name in mutations.
+	EdgeBronzeLinks = "bronze_links"
+	// InventoryImageBronzeLinkFieldID holds the string denoting the ID field of the InventoryImageBronzeLink.
+	InventoryImageBronzeLinkFieldID = "id"
+	// Table holds the table name of the inventoryimage in the database.
+	Table = "inventory_images"
+	// BronzeLinksTable is the table that holds the bronze_links relation/edge.
+	BronzeLinksTable = "inventory_image_links"
+	// BronzeLinksInverseTable is the table name for the InventoryImageBronzeLink entity.
+	// It exists in this package in order to avoid circular dependency with the "inventoryimagebronzelink" package.
+	BronzeLinksInverseTable = "inventory_image_links"
+	// BronzeLinksColumn is the table column denoting the bronze_links relation/edge.
+	BronzeLinksColumn = "inventory_image_bronze_links"
+)
+
+// Columns holds all SQL columns for inventoryimage fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldNormalizedAt,
+	FieldRegistry,
+	FieldRepository,
+	FieldDigest,
+	FieldTagsJSON,
+	FieldWorkloadsJSON,
+	FieldVulnerabilitiesJSON,
+	FieldVulnerabilityCount,
+	FieldMaxSeverity,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RegistryValidator is a validator for the "registry" field. It is called by the builders before save.
+	RegistryValidator func(string) error
+	// RepositoryValidator is a validator for the "repository" field. It is called by the builders before save.
+	RepositoryValidator func(string) error
+	// DefaultDigest holds the default value on creation for the "digest" field.
+	DefaultDigest string
+	// DefaultVulnerabilityCount holds the default value on creation for the "vulnerability_count" field.
+	DefaultVulnerabilityCount int
+)
+
+// OrderOption defines the ordering options for the InventoryImage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByNormalizedAt orders the results by the normalized_at field.
+func ByNormalizedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNormalizedAt, opts...).ToFunc()
+}
+
+// ByRegistry orders the results by the registry field.
+func ByRegistry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegistry, opts...).ToFunc()
+}
+
+// ByRepository orders the results by the repository field.
+func ByRepository(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRepository, opts...).ToFunc()
+}
+
+// ByDigest orders the results by the digest field.
+func ByDigest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDigest, opts...).ToFunc()
+}
+
+// ByVulnerabilityCount orders the results by the vulnerability_count field.
+func ByVulnerabilityCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVulnerabilityCount, opts...).ToFunc()
+}
+
+// ByMaxSeverity orders the results by the max_severity field.
+func ByMaxSeverity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxSeverity, opts...).ToFunc()
+}
+
+// ByBronzeLinksCount orders the results by bronze_links count.
+func ByBronzeLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBronzeLinksStep(), opts...)
+	}
+}
+
+// ByBronzeLinks orders the results by bronze_links terms.
+func ByBronzeLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBronzeLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newBronzeLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BronzeLinksInverseTable, InventoryImageBronzeLinkFieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BronzeLinksTable, BronzeLinksColumn),
+	)
+}
@@ -0,0 +1,611 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inventoryimage
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/internal"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// NormalizedAt applies equality check predicate on the "normalized_at" field. It's identical to NormalizedAtEQ.
+func NormalizedAt(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEQ(FieldNormalizedAt, v))
+}
+
+// Registry applies equality check predicate on the "registry" field. It's identical to RegistryEQ.
+func Registry(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEQ(FieldRegistry, v))
+}
+
+// Repository applies equality check predicate on the "repository" field. It's identical to RepositoryEQ.
+func Repository(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEQ(FieldRepository, v))
+}
+
+// Digest applies equality check predicate on the "digest" field. It's identical to DigestEQ.
+func Digest(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEQ(FieldDigest, v))
+}
+
+// VulnerabilityCount applies equality check predicate on the "vulnerability_count" field. It's identical to VulnerabilityCountEQ.
+func VulnerabilityCount(v int) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEQ(FieldVulnerabilityCount, v))
+}
+
+// MaxSeverity applies equality check predicate on the "max_severity" field. It's identical to MaxSeverityEQ.
+func MaxSeverity(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEQ(FieldMaxSeverity, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// NormalizedAtEQ applies the EQ predicate on the "normalized_at" field.
+func NormalizedAtEQ(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEQ(FieldNormalizedAt, v))
+}
+
+// NormalizedAtNEQ applies the NEQ predicate on the "normalized_at" field.
+func NormalizedAtNEQ(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNEQ(FieldNormalizedAt, v))
+}
+
+// NormalizedAtIn applies the In predicate on the "normalized_at" field.
+func NormalizedAtIn(vs ...time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldIn(FieldNormalizedAt, vs...))
+}
+
+// NormalizedAtNotIn applies the NotIn predicate on the "normalized_at" field.
+func NormalizedAtNotIn(vs ...time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNotIn(FieldNormalizedAt, vs...))
+}
+
+// NormalizedAtGT applies the GT predicate on the "normalized_at" field.
+func NormalizedAtGT(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldGT(FieldNormalizedAt, v))
+}
+
+// NormalizedAtGTE applies the GTE predicate on the "normalized_at" field.
+func NormalizedAtGTE(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldGTE(FieldNormalizedAt, v))
+}
+
+// NormalizedAtLT applies the LT predicate on the "normalized_at" field.
+func NormalizedAtLT(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldLT(FieldNormalizedAt, v))
+}
+
+// NormalizedAtLTE applies the LTE predicate on the "normalized_at" field.
+func NormalizedAtLTE(v time.Time) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldLTE(FieldNormalizedAt, v))
+}
+
+// RegistryEQ applies the EQ predicate on the "registry" field.
+func RegistryEQ(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEQ(FieldRegistry, v))
+}
+
+// RegistryNEQ applies the NEQ predicate on the "registry" field.
+func RegistryNEQ(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNEQ(FieldRegistry, v))
+}
+
+// RegistryIn applies the In predicate on the "registry" field.
+func RegistryIn(vs ...string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldIn(FieldRegistry, vs...))
+}
+
+// RegistryNotIn applies the NotIn predicate on the "registry" field.
+func RegistryNotIn(vs ...string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNotIn(FieldRegistry, vs...))
+}
+
+// RegistryGT applies the GT predicate on the "registry" field.
+func RegistryGT(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldGT(FieldRegistry, v))
+}
+
+// RegistryGTE applies the GTE predicate on the "registry" field.
+func RegistryGTE(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldGTE(FieldRegistry, v))
+}
+
+// RegistryLT applies the LT predicate on the "registry" field.
+func RegistryLT(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldLT(FieldRegistry, v))
+}
+
+// RegistryLTE applies the LTE predicate on the "registry" field.
+func RegistryLTE(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldLTE(FieldRegistry, v))
+}
+
+// RegistryContains applies the Contains predicate on the "registry" field.
+func RegistryContains(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldContains(FieldRegistry, v))
+}
+
+// RegistryHasPrefix applies the HasPrefix predicate on the "registry" field.
+func RegistryHasPrefix(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldHasPrefix(FieldRegistry, v))
+}
+
+// RegistryHasSuffix applies the HasSuffix predicate on the "registry" field.
+func RegistryHasSuffix(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldHasSuffix(FieldRegistry, v))
+}
+
+// RegistryEqualFold applies the EqualFold predicate on the "registry" field.
+func RegistryEqualFold(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEqualFold(FieldRegistry, v))
+}
+
+// RegistryContainsFold applies the ContainsFold predicate on the "registry" field.
+func RegistryContainsFold(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldContainsFold(FieldRegistry, v))
+}
+
+// RepositoryEQ applies the EQ predicate on the "repository" field.
+func RepositoryEQ(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEQ(FieldRepository, v))
+}
+
+// RepositoryNEQ applies the NEQ predicate on the "repository" field.
+func RepositoryNEQ(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNEQ(FieldRepository, v))
+}
+
+// RepositoryIn applies the In predicate on the "repository" field.
+func RepositoryIn(vs ...string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldIn(FieldRepository, vs...))
+}
+
+// RepositoryNotIn applies the NotIn predicate on the "repository" field.
+func RepositoryNotIn(vs ...string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNotIn(FieldRepository, vs...))
+}
+
+// RepositoryGT applies the GT predicate on the "repository" field.
+func RepositoryGT(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldGT(FieldRepository, v))
+}
+
+// RepositoryGTE applies the GTE predicate on the "repository" field.
+func RepositoryGTE(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldGTE(FieldRepository, v))
+}
+
+// RepositoryLT applies the LT predicate on the "repository" field.
+func RepositoryLT(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldLT(FieldRepository, v))
+}
+
+// RepositoryLTE applies the LTE predicate on the "repository" field.
+func RepositoryLTE(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldLTE(FieldRepository, v))
+}
+
+// RepositoryContains applies the Contains predicate on the "repository" field.
+func RepositoryContains(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldContains(FieldRepository, v))
+}
+
+// RepositoryHasPrefix applies the HasPrefix predicate on the "repository" field.
+func RepositoryHasPrefix(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldHasPrefix(FieldRepository, v))
+}
+
+// RepositoryHasSuffix applies the HasSuffix predicate on the "repository" field.
+func RepositoryHasSuffix(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldHasSuffix(FieldRepository, v))
+}
+
+// RepositoryEqualFold applies the EqualFold predicate on the "repository" field.
+func RepositoryEqualFold(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEqualFold(FieldRepository, v))
+}
+
+// RepositoryContainsFold applies the ContainsFold predicate on the "repository" field.
+func RepositoryContainsFold(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldContainsFold(FieldRepository, v))
+}
+
+// DigestEQ applies the EQ predicate on the "digest" field.
+func DigestEQ(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEQ(FieldDigest, v))
+}
+
+// DigestNEQ applies the NEQ predicate on the "digest" field.
+func DigestNEQ(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNEQ(FieldDigest, v))
+}
+
+// DigestIn applies the In predicate on the "digest" field.
+func DigestIn(vs ...string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldIn(FieldDigest, vs...))
+}
+
+// DigestNotIn applies the NotIn predicate on the "digest" field.
+func DigestNotIn(vs ...string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNotIn(FieldDigest, vs...))
+}
+
+// DigestGT applies the GT predicate on the "digest" field.
+func DigestGT(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldGT(FieldDigest, v))
+}
+
+// DigestGTE applies the GTE predicate on the "digest" field.
+func DigestGTE(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldGTE(FieldDigest, v))
+}
+
+// DigestLT applies the LT predicate on the "digest" field.
+func DigestLT(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldLT(FieldDigest, v))
+}
+
+// DigestLTE applies the LTE predicate on the "digest" field.
+func DigestLTE(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldLTE(FieldDigest, v))
+}
+
+// DigestContains applies the Contains predicate on the "digest" field.
+func DigestContains(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldContains(FieldDigest, v))
+}
+
+// DigestHasPrefix applies the HasPrefix predicate on the "digest" field.
+func DigestHasPrefix(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldHasPrefix(FieldDigest, v))
+}
+
+// DigestHasSuffix applies the HasSuffix predicate on the "digest" field.
+func DigestHasSuffix(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldHasSuffix(FieldDigest, v))
+}
+
+// DigestEqualFold applies the EqualFold predicate on the "digest" field.
+func DigestEqualFold(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEqualFold(FieldDigest, v))
+}
+
+// DigestContainsFold applies the ContainsFold predicate on the "digest" field.
+func DigestContainsFold(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldContainsFold(FieldDigest, v))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// WorkloadsJSONIsNil applies the IsNil predicate on the "workloads_json" field.
+func WorkloadsJSONIsNil() predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldIsNull(FieldWorkloadsJSON))
+}
+
+// WorkloadsJSONNotNil applies the NotNil predicate on the "workloads_json" field.
+func WorkloadsJSONNotNil() predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNotNull(FieldWorkloadsJSON))
+}
+
+// VulnerabilitiesJSONIsNil applies the IsNil predicate on the "vulnerabilities_json" field.
+func VulnerabilitiesJSONIsNil() predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldIsNull(FieldVulnerabilitiesJSON))
+}
+
+// VulnerabilitiesJSONNotNil applies the NotNil predicate on the "vulnerabilities_json" field.
+func VulnerabilitiesJSONNotNil() predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNotNull(FieldVulnerabilitiesJSON))
+}
+
+// VulnerabilityCountEQ applies the EQ predicate on the "vulnerability_count" field.
+func VulnerabilityCountEQ(v int) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEQ(FieldVulnerabilityCount, v))
+}
+
+// VulnerabilityCountNEQ applies the NEQ predicate on the "vulnerability_count" field.
+func VulnerabilityCountNEQ(v int) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNEQ(FieldVulnerabilityCount, v))
+}
+
+// VulnerabilityCountIn applies the In predicate on the "vulnerability_count" field.
+func VulnerabilityCountIn(vs ...int) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldIn(FieldVulnerabilityCount, vs...))
+}
+
+// VulnerabilityCountNotIn applies the NotIn predicate on the "vulnerability_count" field.
+func VulnerabilityCountNotIn(vs ...int) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNotIn(FieldVulnerabilityCount, vs...))
+}
+
+// VulnerabilityCountGT applies the GT predicate on the "vulnerability_count" field.
+func VulnerabilityCountGT(v int) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldGT(FieldVulnerabilityCount, v))
+}
+
+// VulnerabilityCountGTE applies the GTE predicate on the "vulnerability_count" field.
+func VulnerabilityCountGTE(v int) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldGTE(FieldVulnerabilityCount, v))
+}
+
+// VulnerabilityCountLT applies the LT predicate on the "vulnerability_count" field.
+func VulnerabilityCountLT(v int) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldLT(FieldVulnerabilityCount, v))
+}
+
+// VulnerabilityCountLTE applies the LTE predicate on the "vulnerability_count" field.
+func VulnerabilityCountLTE(v int) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldLTE(FieldVulnerabilityCount, v))
+}
+
+// MaxSeverityEQ applies the EQ predicate on the "max_severity" field.
+func MaxSeverityEQ(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEQ(FieldMaxSeverity, v))
+}
+
+// MaxSeverityNEQ applies the NEQ predicate on the "max_severity" field.
+func MaxSeverityNEQ(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNEQ(FieldMaxSeverity, v))
+}
+
+// MaxSeverityIn applies the In predicate on the "max_severity" field.
+func MaxSeverityIn(vs ...string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldIn(FieldMaxSeverity, vs...))
+}
+
+// MaxSeverityNotIn applies the NotIn predicate on the "max_severity" field.
+func MaxSeverityNotIn(vs ...string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNotIn(FieldMaxSeverity, vs...))
+}
+
+// MaxSeverityGT applies the GT predicate on the "max_severity" field.
+func MaxSeverityGT(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldGT(FieldMaxSeverity, v))
+}
+
+// MaxSeverityGTE applies the GTE predicate on the "max_severity" field.
+func MaxSeverityGTE(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldGTE(FieldMaxSeverity, v))
+}
+
+// MaxSeverityLT applies the LT predicate on the "max_severity" field.
+func MaxSeverityLT(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldLT(FieldMaxSeverity, v))
+}
+
+// MaxSeverityLTE applies the LTE predicate on the "max_severity" field.
+func MaxSeverityLTE(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldLTE(FieldMaxSeverity, v))
+}
+
+// MaxSeverityContains applies the Contains predicate on the "max_severity" field.
+func MaxSeverityContains(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldContains(FieldMaxSeverity, v))
+}
+
+// MaxSeverityHasPrefix applies the HasPrefix predicate on the "max_severity" field.
+func MaxSeverityHasPrefix(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldHasPrefix(FieldMaxSeverity, v))
+}
+
+// MaxSeverityHasSuffix applies the HasSuffix predicate on the "max_severity" field.
+func MaxSeverityHasSuffix(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldHasSuffix(FieldMaxSeverity, v))
+}
+
+// MaxSeverityIsNil applies the IsNil predicate on the "max_severity" field.
+func MaxSeverityIsNil() predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldIsNull(FieldMaxSeverity))
+}
+
+// MaxSeverityNotNil applies the NotNil predicate on the "max_severity" field.
+func MaxSeverityNotNil() predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldNotNull(FieldMaxSeverity))
+}
+
+// MaxSeverityEqualFold applies the EqualFold predicate on the "max_severity" field.
+func MaxSeverityEqualFold(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldEqualFold(FieldMaxSeverity, v))
+}
+
+// MaxSeverityContainsFold applies the ContainsFold predicate on the "max_severity" field.
+func MaxSeverityContainsFold(v string) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.FieldContainsFold(FieldMaxSeverity, v))
+}
+
+// HasBronzeLinks applies the HasEdge predicate on the "bronze_links" edge.
+func HasBronzeLinks() predicate.InventoryImage {
+	return predicate.InventoryImage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BronzeLinksTable, BronzeLinksColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.InventoryImageBronzeLink
+		step.Edge.Schema = schemaConfig.InventoryImageBronzeLink
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBronzeLinksWith applies the HasEdge predicate on the "bronze_links" edge with a given conditions (other predicates).
+func HasBronzeLinksWith(preds ...predicate.InventoryImageBronzeLink) predicate.InventoryImage {
+	return predicate.InventoryImage(func(s *sql.Selector) {
+		step := newBronzeLinksStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.InventoryImageBronzeLink
+		step.Edge.Schema = schemaConfig.InventoryImageBronzeLink
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InventoryImage) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InventoryImage) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InventoryImage) predicate.InventoryImage {
+	return predicate.InventoryImage(sql.NotPredicates(p))
+}
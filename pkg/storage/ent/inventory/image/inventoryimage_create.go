@@ -0,0 +1,396 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimage"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagebronzelink"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryImageCreate is the builder for creating a InventoryImage entity.
+type InventoryImageCreate struct {
+	config
+	mutation *InventoryImageMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *InventoryImageCreate) SetCollectedAt(v time.Time) *InventoryImageCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *InventoryImageCreate) SetFirstCollectedAt(v time.Time) *InventoryImageCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetNormalizedAt sets the "normalized_at" field.
+func (_c *InventoryImageCreate) SetNormalizedAt(v time.Time) *InventoryImageCreate {
+	_c.mutation.SetNormalizedAt(v)
+	return _c
+}
+
+// SetRegistry sets the "registry" field.
+func (_c *InventoryImageCreate) SetRegistry(v string) *InventoryImageCreate {
+	_c.mutation.SetRegistry(v)
+	return _c
+}
+
+// SetRepository sets the "repository" field.
+func (_c *InventoryImageCreate) SetRepository(v string) *InventoryImageCreate {
+	_c.mutation.SetRepository(v)
+	return _c
+}
+
+// SetDigest sets the "digest" field.
+func (_c *InventoryImageCreate) SetDigest(v string) *InventoryImageCreate {
+	_c.mutation.SetDigest(v)
+	return _c
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (_c *InventoryImageCreate) SetNillableDigest(v *string) *InventoryImageCreate {
+	if v != nil {
+		_c.SetDigest(*v)
+	}
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *InventoryImageCreate) SetTagsJSON(v []string) *InventoryImageCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetWorkloadsJSON sets the "workloads_json" field.
+func (_c *InventoryImageCreate) SetWorkloadsJSON(v []string) *InventoryImageCreate {
+	_c.mutation.SetWorkloadsJSON(v)
+	return _c
+}
+
+// SetVulnerabilitiesJSON sets the "vulnerabilities_json" field.
+func (_c *InventoryImageCreate) SetVulnerabilitiesJSON(v json.RawMessage) *InventoryImageCreate {
+	_c.mutation.SetVulnerabilitiesJSON(v)
+	return _c
+}
+
+// SetVulnerabilityCount sets the "vulnerability_count" field.
+func (_c *InventoryImageCreate) SetVulnerabilityCount(v int) *InventoryImageCreate {
+	_c.mutation.SetVulnerabilityCount(v)
+	return _c
+}
+
+// SetNillableVulnerabilityCount sets the "vulnerability_count" field if the given value is not nil.
+func (_c *InventoryImageCreate) SetNillableVulnerabilityCount(v *int) *InventoryImageCreate {
+	if v != nil {
+		_c.SetVulnerabilityCount(*v)
+	}
+	return _c
+}
+
+// SetMaxSeverity sets the "max_severity" field.
+func (_c *InventoryImageCreate) SetMaxSeverity(v string) *InventoryImageCreate {
+	_c.mutation.SetMaxSeverity(v)
+	return _c
+}
+
+// SetNillableMaxSeverity sets the "max_severity" field if the given value is not nil.
+func (_c *InventoryImageCreate) SetNillableMaxSeverity(v *string) *InventoryImageCreate {
+	if v != nil {
+		_c.SetMaxSeverity(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *InventoryImageCreate) SetID(v string) *InventoryImageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddBronzeLinkIDs adds the "bronze_links" edge to the InventoryImageBronzeLink entity by IDs.
+func (_c *InventoryImageCreate) AddBronzeLinkIDs(ids ...int) *InventoryImageCreate {
+	_c.mutation.AddBronzeLinkIDs(ids...)
+	return _c
+}
+
+// AddBronzeLinks adds the "bronze_links" edges to the InventoryImageBronzeLink entity.
+func (_c *InventoryImageCreate) AddBronzeLinks(v ...*InventoryImageBronzeLink) *InventoryImageCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBronzeLinkIDs(ids...)
+}
+
+// Mutation returns the InventoryImageMutation object of the builder.
+func (_c *InventoryImageCreate) Mutation() *InventoryImageMutation {
+	return _c.mutation
+}
+
+// Save creates the InventoryImage in the database.
+func (_c *InventoryImageCreate) Save(ctx context.Context) (*InventoryImage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InventoryImageCreate) SaveX(ctx context.Context) *InventoryImage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InventoryImageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InventoryImageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InventoryImageCreate) defaults() {
+	if _, ok := _c.mutation.Digest(); !ok {
+		v := inventoryimage.DefaultDigest
+		_c.mutation.SetDigest(v)
+	}
+	if _, ok := _c.mutation.VulnerabilityCount(); !ok {
+		v := inventoryimage.DefaultVulnerabilityCount
+		_c.mutation.SetVulnerabilityCount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InventoryImageCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`image: missing required field "InventoryImage.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`image: missing required field "InventoryImage.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.NormalizedAt(); !ok {
+		return &ValidationError{Name: "normalized_at", err: errors.New(`image: missing required field "InventoryImage.normalized_at"`)}
+	}
+	if _, ok := _c.mutation.Registry(); !ok {
+		return &ValidationError{Name: "registry", err: errors.New(`image: missing required field "InventoryImage.registry"`)}
+	}
+	if v, ok := _c.mutation.Registry(); ok {
+		if err := inventoryimage.RegistryValidator(v); err != nil {
+			return &ValidationError{Name: "registry", err: fmt.Errorf(`image: validator failed for field "InventoryImage.registry": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Repository(); !ok {
+		return &ValidationError{Name: "repository", err: errors.New(`image: missing required field "InventoryImage.repository"`)}
+	}
+	if v, ok := _c.mutation.Repository(); ok {
+		if err := inventoryimage.RepositoryValidator(v); err != nil {
+			return &ValidationError{Name: "repository", err: fmt.Errorf(`image: validator failed for field "InventoryImage.repository": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Digest(); !ok {
+		return &ValidationError{Name: "digest", err: errors.New(`image: missing required field "InventoryImage.digest"`)}
+	}
+	if _, ok := _c.mutation.VulnerabilityCount(); !ok {
+		return &ValidationError{Name: "vulnerability_count", err: errors.New(`image: missing required field "InventoryImage.vulnerability_count"`)}
+	}
+	return nil
+}
+
+func (_c *InventoryImageCreate) sqlSave(ctx context.Context) (*InventoryImage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected InventoryImage.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InventoryImageCreate) createSpec() (*InventoryImage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InventoryImage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(inventoryimage.Table, sqlgraph.NewFieldSpec(inventoryimage.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.InventoryImage
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(inventoryimage.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(inventoryimage.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.NormalizedAt(); ok {
+		_spec.SetField(inventoryimage.FieldNormalizedAt, field.TypeTime, value)
+		_node.NormalizedAt = value
+	}
+	if value, ok := _c.mutation.Registry(); ok {
+		_spec.SetField(inventoryimage.FieldRegistry, field.TypeString, value)
+		_node.Registry = value
+	}
+	if value, ok := _c.mutation.Repository(); ok {
+		_spec.SetField(inventoryimage.FieldRepository, field.TypeString, value)
+		_node.Repository = value
+	}
+	if value, ok := _c.mutation.Digest(); ok {
+		_spec.SetField(inventoryimage.FieldDigest, field.TypeString, value)
+		_node.Digest = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(inventoryimage.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.WorkloadsJSON(); ok {
+		_spec.SetField(inventoryimage.FieldWorkloadsJSON, field.TypeJSON, value)
+		_node.WorkloadsJSON = value
+	}
+	if value, ok := _c.mutation.VulnerabilitiesJSON(); ok {
+		_spec.SetField(inventoryimage.FieldVulnerabilitiesJSON, field.TypeJSON, value)
+		_node.VulnerabilitiesJSON = value
+	}
+	if value, ok := _c.mutation.VulnerabilityCount(); ok {
+		_spec.SetField(inventoryimage.FieldVulnerabilityCount, field.TypeInt, value)
+		_node.VulnerabilityCount = value
+	}
+	if value, ok := _c.mutation.MaxSeverity(); ok {
+		_spec.SetField(inventoryimage.FieldMaxSeverity, field.TypeString, value)
+		_node.MaxSeverity = value
+	}
+	if nodes := _c.mutation.BronzeLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   inventoryimage.BronzeLinksTable,
+			Columns: []string{inventoryimage.BronzeLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryimagebronzelink.FieldID, field.TypeInt),
+			},
+		}
+		edge.Schema = _c.schemaConfig.InventoryImageBronzeLink
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// InventoryImageCreateBulk is the builder for creating many InventoryImage entities in bulk.
+type InventoryImageCreateBulk struct {
+	config
+	err      error
+	builders []*InventoryImageCreate
+}
+
+// Save creates the InventoryImage entities in the database.
+func (_c *InventoryImageCreateBulk) Save(ctx context.Context) ([]*InventoryImage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InventoryImage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InventoryImageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InventoryImageCreateBulk) SaveX(ctx context.Context) []*InventoryImage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InventoryImageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InventoryImageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
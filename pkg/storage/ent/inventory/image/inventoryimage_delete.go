@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/internal"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimage"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryImageDelete is the builder for deleting a InventoryImage entity.
+type InventoryImageDelete struct {
+	config
+	hooks    []Hook
+	mutation *InventoryImageMutation
+}
+
+// Where appends a list predicates to the InventoryImageDelete builder.
+func (_d *InventoryImageDelete) Where(ps ...predicate.InventoryImage) *InventoryImageDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *InventoryImageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *InventoryImageDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *InventoryImageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(inventoryimage.Table, sqlgraph.NewFieldSpec(inventoryimage.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.InventoryImage
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// InventoryImageDeleteOne is the builder for deleting a single InventoryImage entity.
+type InventoryImageDeleteOne struct {
+	_d *InventoryImageDelete
+}
+
+// Where appends a list predicates to the InventoryImageDelete builder.
+func (_d *InventoryImageDeleteOne) Where(ps ...predicate.InventoryImage) *InventoryImageDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *InventoryImageDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{inventoryimage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *InventoryImageDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
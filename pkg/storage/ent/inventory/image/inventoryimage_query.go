@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/internal"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimage"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagebronzelink"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/predicate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryImageQuery is the builder for querying InventoryImage entities.
+type InventoryImageQuery struct {
+	config
+	ctx             *QueryContext
+	order           []inventoryimage.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.InventoryImage
+	withBronzeLinks *InventoryImageBronzeLinkQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InventoryImageQuery builder.
+func (_q *InventoryImageQuery) Where(ps ...predicate.InventoryImage) *InventoryImageQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *InventoryImageQuery) Limit(limit int) *InventoryImageQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *InventoryImageQuery) Offset(offset int) *InventoryImageQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *InventoryImageQuery) Unique(unique bool) *InventoryImageQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *InventoryImageQuery) Order(o ...inventoryimage.OrderOption) *InventoryImageQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryBronzeLinks chains the current query on the "bronze_links" edge.
+func (_q *InventoryImageQuery) QueryBronzeLinks() *InventoryImageBronzeLinkQuery {
+	query := (&InventoryImageBronzeLinkClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(inventoryimage.Table, inventoryimage.FieldID, selector),
+			sqlgraph.To(inventoryimagebronzelink.Table, inventoryimagebronzelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, inventoryimage.BronzeLinksTable, inventoryimage.BronzeLinksColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.InventoryImageBronzeLink
+		step.Edge.Schema = schemaConfig.InventoryImageBronzeLink
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first InventoryImage entity from the query.
+// Returns a *NotFoundError when no InventoryImage was found.
+func (_q *InventoryImageQuery) First(ctx context.Context) (*InventoryImage, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{inventoryimage.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *InventoryImageQuery) FirstX(ctx context.Context) *InventoryImage {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InventoryImage ID from the query.
+// Returns a *NotFoundError when no InventoryImage ID was found.
+func (_q *InventoryImageQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{inventoryimage.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *InventoryImageQuery) FirstIDX(ctx context.Context) string {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InventoryImage entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InventoryImage entity is found.
+// Returns a *NotFoundError when no InventoryImage entities are found.
+func (_q *InventoryImageQuery) Only(ctx context.Context) (*InventoryImage, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{inventoryimage.Label}
+	default:
+		return nil, &NotSingularError{inventoryimage.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *InventoryImageQuery) OnlyX(ctx context.Context) *InventoryImage {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InventoryImage ID in the query.
+// Returns a *NotSingularError when more than one InventoryImage ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *InventoryImageQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{inventoryimage.Label}
+	default:
+		err = &NotSingularError{inventoryimage.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *InventoryImageQuery) OnlyIDX(ctx context.Context) string {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InventoryImages.
+func (_q *InventoryImageQuery) All(ctx context.Context) ([]*InventoryImage, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*InventoryImage, *InventoryImageQuery]()
+	return withInterceptors[[]*InventoryImage](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *InventoryImageQuery) AllX(ctx context.Context) []*InventoryImage {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InventoryImage IDs.
+func (_q *InventoryImageQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(inventoryimage.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *InventoryImageQuery) IDsX(ctx context.Context) []string {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *InventoryImageQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*InventoryImageQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *InventoryImageQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *InventoryImageQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("image: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *InventoryImageQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InventoryImageQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *InventoryImageQuery) Clone() *InventoryImageQuery {
+	if _q == nil {
+		return nil
+	}
+	return &InventoryImageQuery{
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]inventoryimage.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.InventoryImage{}, _q.predicates...),
+		withBronzeLinks: _q.withBronzeLinks.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithBronzeLinks tells the query-builder to eager-load the nodes that are connected to
+// the "bronze_links" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *InventoryImageQuery) WithBronzeLinks(opts ...func(*InventoryImageBronzeLinkQuery)) *InventoryImageQuery {
+	query := (&InventoryImageBronzeLinkClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBronzeLinks = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CollectedAt time.Time `json:"collected_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InventoryImage.Query().
+//		GroupBy(inventoryimage.FieldCollectedAt).
+//		Aggregate(image.Count()).
+//		Scan(ctx, &v)
+func (_q *InventoryImageQuery) GroupBy(field string, fields ...string) *InventoryImageGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &InventoryImageGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = inventoryimage.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CollectedAt time.Time `json:"collected_at,omitempty"`
+//	}
+//
+//	client.InventoryImage.Query().
+//		Select(inventoryimage.FieldCollectedAt).
+//		Scan(ctx, &v)
+func (_q *InventoryImageQuery) Select(fields ...string) *InventoryImageSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &InventoryImageSelect{InventoryImageQuery: _q}
+	sbuild.label = inventoryimage.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a InventoryImageSelect configured with the given aggregations.
+func (_q *InventoryImageQuery) Aggregate(fns ...AggregateFunc) *InventoryImageSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *InventoryImageQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("image: uninitialized interceptor (forgotten import image/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !inventoryimage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("image: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *InventoryImageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InventoryImage, error) {
+	var (
+		nodes       = []*InventoryImage{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withBronzeLinks != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*InventoryImage).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &InventoryImage{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = _q.schemaConfig.InventoryImage
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withBronzeLinks; query != nil {
+		if err := _q.loadBronzeLinks(ctx, query, nodes,
+			func(n *InventoryImage) { n.Edges.BronzeLinks = []*InventoryImageBronzeLink{} },
+			func(n *InventoryImage, e *InventoryImageBronzeLink) {
+				n.Edges.BronzeLinks = append(n.Edges.BronzeLinks, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *InventoryImageQuery) loadBronzeLinks(ctx context.Context, query *InventoryImageBronzeLinkQuery, nodes []*InventoryImage, init func(*InventoryImage), assign func(*InventoryImage, *InventoryImageBronzeLink)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*InventoryImage)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.InventoryImageBronzeLink(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(inventoryimage.BronzeLinksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.inventory_image_bronze_links
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "inventory_image_bronze_links" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "inventory_image_bronze_links" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *InventoryImageQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Schema = _q.schemaConfig.InventoryImage
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *InventoryImageQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(inventoryimage.Table, inventoryimage.Columns, sqlgraph.NewFieldSpec(inventoryimage.FieldID, field.TypeString))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inventoryimage.FieldID)
+		for i := range fields {
+			if fields[i] != inventoryimage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *InventoryImageQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(inventoryimage.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = inventoryimage.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(_q.schemaConfig.InventoryImage)
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	selector.WithContext(ctx)
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// InventoryImageGroupBy is the group-by builder for InventoryImage entities.
+type InventoryImageGroupBy struct {
+	selector
+	build *InventoryImageQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *InventoryImageGroupBy) Aggregate(fns ...AggregateFunc) *InventoryImageGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *InventoryImageGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InventoryImageQuery, *InventoryImageGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *InventoryImageGroupBy) sqlScan(ctx context.Context, root *InventoryImageQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// InventoryImageSelect is the builder for selecting fields of InventoryImage entities.
+type InventoryImageSelect struct {
+	*InventoryImageQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *InventoryImageSelect) Aggregate(fns ...AggregateFunc) *InventoryImageSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *InventoryImageSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InventoryImageQuery, *InventoryImageSelect](ctx, _s.InventoryImageQuery, _s, _s.inters, v)
+}
+
+func (_s *InventoryImageSelect) sqlScan(ctx context.Context, root *InventoryImageQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
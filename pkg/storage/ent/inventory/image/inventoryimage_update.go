@@ -0,0 +1,838 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/internal"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimage"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagebronzelink"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryImageUpdate is the builder for updating InventoryImage entities.
+type InventoryImageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InventoryImageMutation
+}
+
+// Where appends a list predicates to the InventoryImageUpdate builder.
+func (_u *InventoryImageUpdate) Where(ps ...predicate.InventoryImage) *InventoryImageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *InventoryImageUpdate) SetCollectedAt(v time.Time) *InventoryImageUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *InventoryImageUpdate) SetNillableCollectedAt(v *time.Time) *InventoryImageUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetNormalizedAt sets the "normalized_at" field.
+func (_u *InventoryImageUpdate) SetNormalizedAt(v time.Time) *InventoryImageUpdate {
+	_u.mutation.SetNormalizedAt(v)
+	return _u
+}
+
+// SetNillableNormalizedAt sets the "normalized_at" field if the given value is not nil.
+func (_u *InventoryImageUpdate) SetNillableNormalizedAt(v *time.Time) *InventoryImageUpdate {
+	if v != nil {
+		_u.SetNormalizedAt(*v)
+	}
+	return _u
+}
+
+// SetRegistry sets the "registry" field.
+func (_u *InventoryImageUpdate) SetRegistry(v string) *InventoryImageUpdate {
+	_u.mutation.SetRegistry(v)
+	return _u
+}
+
+// SetNillableRegistry sets the "registry" field if the given value is not nil.
+func (_u *InventoryImageUpdate) SetNillableRegistry(v *string) *InventoryImageUpdate {
+	if v != nil {
+		_u.SetRegistry(*v)
+	}
+	return _u
+}
+
+// SetRepository sets the "repository" field.
+func (_u *InventoryImageUpdate) SetRepository(v string) *InventoryImageUpdate {
+	_u.mutation.SetRepository(v)
+	return _u
+}
+
+// SetNillableRepository sets the "repository" field if the given value is not nil.
+func (_u *InventoryImageUpdate) SetNillableRepository(v *string) *InventoryImageUpdate {
+	if v != nil {
+		_u.SetRepository(*v)
+	}
+	return _u
+}
+
+// SetDigest sets the "digest" field.
+func (_u *InventoryImageUpdate) SetDigest(v string) *InventoryImageUpdate {
+	_u.mutation.SetDigest(v)
+	return _u
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (_u *InventoryImageUpdate) SetNillableDigest(v *string) *InventoryImageUpdate {
+	if v != nil {
+		_u.SetDigest(*v)
+	}
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *InventoryImageUpdate) SetTagsJSON(v []string) *InventoryImageUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *InventoryImageUpdate) AppendTagsJSON(v []string) *InventoryImageUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *InventoryImageUpdate) ClearTagsJSON() *InventoryImageUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetWorkloadsJSON sets the "workloads_json" field.
+func (_u *InventoryImageUpdate) SetWorkloadsJSON(v []string) *InventoryImageUpdate {
+	_u.mutation.SetWorkloadsJSON(v)
+	return _u
+}
+
+// AppendWorkloadsJSON appends value to the "workloads_json" field.
+func (_u *InventoryImageUpdate) AppendWorkloadsJSON(v []string) *InventoryImageUpdate {
+	_u.mutation.AppendWorkloadsJSON(v)
+	return _u
+}
+
+// ClearWorkloadsJSON clears the value of the "workloads_json" field.
+func (_u *InventoryImageUpdate) ClearWorkloadsJSON() *InventoryImageUpdate {
+	_u.mutation.ClearWorkloadsJSON()
+	return _u
+}
+
+// SetVulnerabilitiesJSON sets the "vulnerabilities_json" field.
+func (_u *InventoryImageUpdate) SetVulnerabilitiesJSON(v json.RawMessage) *InventoryImageUpdate {
+	_u.mutation.SetVulnerabilitiesJSON(v)
+	return _u
+}
+
+// AppendVulnerabilitiesJSON appends value to the "vulnerabilities_json" field.
+func (_u *InventoryImageUpdate) AppendVulnerabilitiesJSON(v json.RawMessage) *InventoryImageUpdate {
+	_u.mutation.AppendVulnerabilitiesJSON(v)
+	return _u
+}
+
+// ClearVulnerabilitiesJSON clears the value of the "vulnerabilities_json" field.
+func (_u *InventoryImageUpdate) ClearVulnerabilitiesJSON() *InventoryImageUpdate {
+	_u.mutation.ClearVulnerabilitiesJSON()
+	return _u
+}
+
+// SetVulnerabilityCount sets the "vulnerability_count" field.
+func (_u *InventoryImageUpdate) SetVulnerabilityCount(v int) *InventoryImageUpdate {
+	_u.mutation.ResetVulnerabilityCount()
+	_u.mutation.SetVulnerabilityCount(v)
+	return _u
+}
+
+// SetNillableVulnerabilityCount sets the "vulnerability_count" field if the given value is not nil.
+func (_u *InventoryImageUpdate) SetNillableVulnerabilityCount(v *int) *InventoryImageUpdate {
+	if v != nil {
+		_u.SetVulnerabilityCount(*v)
+	}
+	return _u
+}
+
+// AddVulnerabilityCount adds value to the "vulnerability_count" field.
+func (_u *InventoryImageUpdate) AddVulnerabilityCount(v int) *InventoryImageUpdate {
+	_u.mutation.AddVulnerabilityCount(v)
+	return _u
+}
+
+// SetMaxSeverity sets the "max_severity" field.
+func (_u *InventoryImageUpdate) SetMaxSeverity(v string) *InventoryImageUpdate {
+	_u.mutation.SetMaxSeverity(v)
+	return _u
+}
+
+// SetNillableMaxSeverity sets the "max_severity" field if the given value is not nil.
+func (_u *InventoryImageUpdate) SetNillableMaxSeverity(v *string) *InventoryImageUpdate {
+	if v != nil {
+		_u.SetMaxSeverity(*v)
+	}
+	return _u
+}
+
+// ClearMaxSeverity clears the value of the "max_severity" field.
+func (_u *InventoryImageUpdate) ClearMaxSeverity() *InventoryImageUpdate {
+	_u.mutation.ClearMaxSeverity()
+	return _u
+}
+
+// AddBronzeLinkIDs adds the "bronze_links" edge to the InventoryImageBronzeLink entity by IDs.
+func (_u *InventoryImageUpdate) AddBronzeLinkIDs(ids ...int) *InventoryImageUpdate {
+	_u.mutation.AddBronzeLinkIDs(ids...)
+	return _u
+}
+
+// AddBronzeLinks adds the "bronze_links" edges to the InventoryImageBronzeLink entity.
+func (_u *InventoryImageUpdate) AddBronzeLinks(v ...*InventoryImageBronzeLink) *InventoryImageUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBronzeLinkIDs(ids...)
+}
+
+// Mutation returns the InventoryImageMutation object of the builder.
+func (_u *InventoryImageUpdate) Mutation() *InventoryImageMutation {
+	return _u.mutation
+}
+
+// ClearBronzeLinks clears all "bronze_links" edges to the InventoryImageBronzeLink entity.
+func (_u *InventoryImageUpdate) ClearBronzeLinks() *InventoryImageUpdate {
+	_u.mutation.ClearBronzeLinks()
+	return _u
+}
+
+// RemoveBronzeLinkIDs removes the "bronze_links" edge to InventoryImageBronzeLink entities by IDs.
+func (_u *InventoryImageUpdate) RemoveBronzeLinkIDs(ids ...int) *InventoryImageUpdate {
+	_u.mutation.RemoveBronzeLinkIDs(ids...)
+	return _u
+}
+
+// RemoveBronzeLinks removes "bronze_links" edges to InventoryImageBronzeLink entities.
+func (_u *InventoryImageUpdate) RemoveBronzeLinks(v ...*InventoryImageBronzeLink) *InventoryImageUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBronzeLinkIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InventoryImageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InventoryImageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InventoryImageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InventoryImageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InventoryImageUpdate) check() error {
+	if v, ok := _u.mutation.Registry(); ok {
+		if err := inventoryimage.RegistryValidator(v); err != nil {
+			return &ValidationError{Name: "registry", err: fmt.Errorf(`image: validator failed for field "InventoryImage.registry": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Repository(); ok {
+		if err := inventoryimage.RepositoryValidator(v); err != nil {
+			return &ValidationError{Name: "repository", err: fmt.Errorf(`image: validator failed for field "InventoryImage.repository": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InventoryImageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inventoryimage.Table, inventoryimage.Columns, sqlgraph.NewFieldSpec(inventoryimage.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(inventoryimage.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.NormalizedAt(); ok {
+		_spec.SetField(inventoryimage.FieldNormalizedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Registry(); ok {
+		_spec.SetField(inventoryimage.FieldRegistry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Repository(); ok {
+		_spec.SetField(inventoryimage.FieldRepository, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Digest(); ok {
+		_spec.SetField(inventoryimage.FieldDigest, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(inventoryimage.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inventoryimage.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(inventoryimage.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.WorkloadsJSON(); ok {
+		_spec.SetField(inventoryimage.FieldWorkloadsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWorkloadsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inventoryimage.FieldWorkloadsJSON, value)
+		})
+	}
+	if _u.mutation.WorkloadsJSONCleared() {
+		_spec.ClearField(inventoryimage.FieldWorkloadsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.VulnerabilitiesJSON(); ok {
+		_spec.SetField(inventoryimage.FieldVulnerabilitiesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVulnerabilitiesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inventoryimage.FieldVulnerabilitiesJSON, value)
+		})
+	}
+	if _u.mutation.VulnerabilitiesJSONCleared() {
+		_spec.ClearField(inventoryimage.FieldVulnerabilitiesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.VulnerabilityCount(); ok {
+		_spec.SetField(inventoryimage.FieldVulnerabilityCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVulnerabilityCount(); ok {
+		_spec.AddField(inventoryimage.FieldVulnerabilityCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxSeverity(); ok {
+		_spec.SetField(inventoryimage.FieldMaxSeverity, field.TypeString, value)
+	}
+	if _u.mutation.MaxSeverityCleared() {
+		_spec.ClearField(inventoryimage.FieldMaxSeverity, field.TypeString)
+	}
+	if _u.mutation.BronzeLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   inventoryimage.BronzeLinksTable,
+			Columns: []string{inventoryimage.BronzeLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryimagebronzelink.FieldID, field.TypeInt),
+			},
+		}
+		edge.Schema = _u.schemaConfig.InventoryImageBronzeLink
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBronzeLinksIDs(); len(nodes) > 0 && !_u.mutation.BronzeLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   inventoryimage.BronzeLinksTable,
+			Columns: []string{inventoryimage.BronzeLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryimagebronzelink.FieldID, field.TypeInt),
+			},
+		}
+		edge.Schema = _u.schemaConfig.InventoryImageBronzeLink
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BronzeLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   inventoryimage.BronzeLinksTable,
+			Columns: []string{inventoryimage.BronzeLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryimagebronzelink.FieldID, field.TypeInt),
+			},
+		}
+		edge.Schema = _u.schemaConfig.InventoryImageBronzeLink
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = _u.schemaConfig.InventoryImage
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inventoryimage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InventoryImageUpdateOne is the builder for updating a single InventoryImage entity.
+type InventoryImageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InventoryImageMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *InventoryImageUpdateOne) SetCollectedAt(v time.Time) *InventoryImageUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *InventoryImageUpdateOne) SetNillableCollectedAt(v *time.Time) *InventoryImageUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetNormalizedAt sets the "normalized_at" field.
+func (_u *InventoryImageUpdateOne) SetNormalizedAt(v time.Time) *InventoryImageUpdateOne {
+	_u.mutation.SetNormalizedAt(v)
+	return _u
+}
+
+// SetNillableNormalizedAt sets the "normalized_at" field if the given value is not nil.
+func (_u *InventoryImageUpdateOne) SetNillableNormalizedAt(v *time.Time) *InventoryImageUpdateOne {
+	if v != nil {
+		_u.SetNormalizedAt(*v)
+	}
+	return _u
+}
+
+// SetRegistry sets the "registry" field.
+func (_u *InventoryImageUpdateOne) SetRegistry(v string) *InventoryImageUpdateOne {
+	_u.mutation.SetRegistry(v)
+	return _u
+}
+
+// SetNillableRegistry sets the "registry" field if the given value is not nil.
+func (_u *InventoryImageUpdateOne) SetNillableRegistry(v *string) *InventoryImageUpdateOne {
+	if v != nil {
+		_u.SetRegistry(*v)
+	}
+	return _u
+}
+
+// SetRepository sets the "repository" field.
+func (_u *InventoryImageUpdateOne) SetRepository(v string) *InventoryImageUpdateOne {
+	_u.mutation.SetRepository(v)
+	return _u
+}
+
+// SetNillableRepository sets the "repository" field if the given value is not nil.
+func (_u *InventoryImageUpdateOne) SetNillableRepository(v *string) *InventoryImageUpdateOne {
+	if v != nil {
+		_u.SetRepository(*v)
+	}
+	return _u
+}
+
+// SetDigest sets the "digest" field.
+func (_u *InventoryImageUpdateOne) SetDigest(v string) *InventoryImageUpdateOne {
+	_u.mutation.SetDigest(v)
+	return _u
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (_u *InventoryImageUpdateOne) SetNillableDigest(v *string) *InventoryImageUpdateOne {
+	if v != nil {
+		_u.SetDigest(*v)
+	}
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *InventoryImageUpdateOne) SetTagsJSON(v []string) *InventoryImageUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *InventoryImageUpdateOne) AppendTagsJSON(v []string) *InventoryImageUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *InventoryImageUpdateOne) ClearTagsJSON() *InventoryImageUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetWorkloadsJSON sets the "workloads_json" field.
+func (_u *InventoryImageUpdateOne) SetWorkloadsJSON(v []string) *InventoryImageUpdateOne {
+	_u.mutation.SetWorkloadsJSON(v)
+	return _u
+}
+
+// AppendWorkloadsJSON appends value to the "workloads_json" field.
+func (_u *InventoryImageUpdateOne) AppendWorkloadsJSON(v []string) *InventoryImageUpdateOne {
+	_u.mutation.AppendWorkloadsJSON(v)
+	return _u
+}
+
+// ClearWorkloadsJSON clears the value of the "workloads_json" field.
+func (_u *InventoryImageUpdateOne) ClearWorkloadsJSON() *InventoryImageUpdateOne {
+	_u.mutation.ClearWorkloadsJSON()
+	return _u
+}
+
+// SetVulnerabilitiesJSON sets the "vulnerabilities_json" field.
+func (_u *InventoryImageUpdateOne) SetVulnerabilitiesJSON(v json.RawMessage) *InventoryImageUpdateOne {
+	_u.mutation.SetVulnerabilitiesJSON(v)
+	return _u
+}
+
+// AppendVulnerabilitiesJSON appends value to the "vulnerabilities_json" field.
+func (_u *InventoryImageUpdateOne) AppendVulnerabilitiesJSON(v json.RawMessage) *InventoryImageUpdateOne {
+	_u.mutation.AppendVulnerabilitiesJSON(v)
+	return _u
+}
+
+// ClearVulnerabilitiesJSON clears the value of the "vulnerabilities_json" field.
+func (_u *InventoryImageUpdateOne) ClearVulnerabilitiesJSON() *InventoryImageUpdateOne {
+	_u.mutation.ClearVulnerabilitiesJSON()
+	return _u
+}
+
+// SetVulnerabilityCount sets the "vulnerability_count" field.
+func (_u *InventoryImageUpdateOne) SetVulnerabilityCount(v int) *InventoryImageUpdateOne {
+	_u.mutation.ResetVulnerabilityCount()
+	_u.mutation.SetVulnerabilityCount(v)
+	return _u
+}
+
+// SetNillableVulnerabilityCount sets the "vulnerability_count" field if the given value is not nil.
+func (_u *InventoryImageUpdateOne) SetNillableVulnerabilityCount(v *int) *InventoryImageUpdateOne {
+	if v != nil {
+		_u.SetVulnerabilityCount(*v)
+	}
+	return _u
+}
+
+// AddVulnerabilityCount adds value to the "vulnerability_count" field.
+func (_u *InventoryImageUpdateOne) AddVulnerabilityCount(v int) *InventoryImageUpdateOne {
+	_u.mutation.AddVulnerabilityCount(v)
+	return _u
+}
+
+// SetMaxSeverity sets the "max_severity" field.
+func (_u *InventoryImageUpdateOne) SetMaxSeverity(v string) *InventoryImageUpdateOne {
+	_u.mutation.SetMaxSeverity(v)
+	return _u
+}
+
+// SetNillableMaxSeverity sets the "max_severity" field if the given value is not nil.
+func (_u *InventoryImageUpdateOne) SetNillableMaxSeverity(v *string) *InventoryImageUpdateOne {
+	if v != nil {
+		_u.SetMaxSeverity(*v)
+	}
+	return _u
+}
+
+// ClearMaxSeverity clears the value of the "max_severity" field.
+func (_u *InventoryImageUpdateOne) ClearMaxSeverity() *InventoryImageUpdateOne {
+	_u.mutation.ClearMaxSeverity()
+	return _u
+}
+
+// AddBronzeLinkIDs adds the "bronze_links" edge to the InventoryImageBronzeLink entity by IDs.
+func (_u *InventoryImageUpdateOne) AddBronzeLinkIDs(ids ...int) *InventoryImageUpdateOne {
+	_u.mutation.AddBronzeLinkIDs(ids...)
+	return _u
+}
+
+// AddBronzeLinks adds the "bronze_links" edges to the InventoryImageBronzeLink entity.
+func (_u *InventoryImageUpdateOne) AddBronzeLinks(v ...*InventoryImageBronzeLink) *InventoryImageUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBronzeLinkIDs(ids...)
+}
+
+// Mutation returns the InventoryImageMutation object of the builder.
+func (_u *InventoryImageUpdateOne) Mutation() *InventoryImageMutation {
+	return _u.mutation
+}
+
+// ClearBronzeLinks clears all "bronze_links" edges to the InventoryImageBronzeLink entity.
+func (_u *InventoryImageUpdateOne) ClearBronzeLinks() *InventoryImageUpdateOne {
+	_u.mutation.ClearBronzeLinks()
+	return _u
+}
+
+// RemoveBronzeLinkIDs removes the "bronze_links" edge to InventoryImageBronzeLink entities by IDs.
+func (_u *InventoryImageUpdateOne) RemoveBronzeLinkIDs(ids ...int) *InventoryImageUpdateOne {
+	_u.mutation.RemoveBronzeLinkIDs(ids...)
+	return _u
+}
+
+// RemoveBronzeLinks removes "bronze_links" edges to InventoryImageBronzeLink entities.
+func (_u *InventoryImageUpdateOne) RemoveBronzeLinks(v ...*InventoryImageBronzeLink) *InventoryImageUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBronzeLinkIDs(ids...)
+}
+
+// Where appends a list predicates to the InventoryImageUpdate builder.
+func (_u *InventoryImageUpdateOne) Where(ps ...predicate.InventoryImage) *InventoryImageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InventoryImageUpdateOne) Select(field string, fields ...string) *InventoryImageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InventoryImage entity.
+func (_u *InventoryImageUpdateOne) Save(ctx context.Context) (*InventoryImage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InventoryImageUpdateOne) SaveX(ctx context.Context) *InventoryImage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InventoryImageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InventoryImageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InventoryImageUpdateOne) check() error {
+	if v, ok := _u.mutation.Registry(); ok {
+		if err := inventoryimage.RegistryValidator(v); err != nil {
+			return &ValidationError{Name: "registry", err: fmt.Errorf(`image: validator failed for field "InventoryImage.registry": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Repository(); ok {
+		if err := inventoryimage.RepositoryValidator(v); err != nil {
+			return &ValidationError{Name: "repository", err: fmt.Errorf(`image: validator failed for field "InventoryImage.repository": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InventoryImageUpdateOne) sqlSave(ctx context.Context) (_node *InventoryImage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inventoryimage.Table, inventoryimage.Columns, sqlgraph.NewFieldSpec(inventoryimage.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`image: missing "InventoryImage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inventoryimage.FieldID)
+		for _, f := range fields {
+			if !inventoryimage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("image: invalid field %q for query", f)}
+			}
+			if f != inventoryimage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(inventoryimage.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.NormalizedAt(); ok {
+		_spec.SetField(inventoryimage.FieldNormalizedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Registry(); ok {
+		_spec.SetField(inventoryimage.FieldRegistry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Repository(); ok {
+		_spec.SetField(inventoryimage.FieldRepository, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Digest(); ok {
+		_spec.SetField(inventoryimage.FieldDigest, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(inventoryimage.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inventoryimage.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(inventoryimage.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.WorkloadsJSON(); ok {
+		_spec.SetField(inventoryimage.FieldWorkloadsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWorkloadsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inventoryimage.FieldWorkloadsJSON, value)
+		})
+	}
+	if _u.mutation.WorkloadsJSONCleared() {
+		_spec.ClearField(inventoryimage.FieldWorkloadsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.VulnerabilitiesJSON(); ok {
+		_spec.SetField(inventoryimage.FieldVulnerabilitiesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVulnerabilitiesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inventoryimage.FieldVulnerabilitiesJSON, value)
+		})
+	}
+	if _u.mutation.VulnerabilitiesJSONCleared() {
+		_spec.ClearField(inventoryimage.FieldVulnerabilitiesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.VulnerabilityCount(); ok {
+		_spec.SetField(inventoryimage.FieldVulnerabilityCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVulnerabilityCount(); ok {
+		_spec.AddField(inventoryimage.FieldVulnerabilityCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxSeverity(); ok {
+		_spec.SetField(inventoryimage.FieldMaxSeverity, field.TypeString, value)
+	}
+	if _u.mutation.MaxSeverityCleared() {
+		_spec.ClearField(inventoryimage.FieldMaxSeverity, field.TypeString)
+	}
+	if _u.mutation.BronzeLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   inventoryimage.BronzeLinksTable,
+			Columns: []string{inventoryimage.BronzeLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryimagebronzelink.FieldID, field.TypeInt),
+			},
+		}
+		edge.Schema = _u.schemaConfig.InventoryImageBronzeLink
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBronzeLinksIDs(); len(nodes) > 0 && !_u.mutation.BronzeLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   inventoryimage.BronzeLinksTable,
+			Columns: []string{inventoryimage.BronzeLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryimagebronzelink.FieldID, field.TypeInt),
+			},
+		}
+		edge.Schema = _u.schemaConfig.InventoryImageBronzeLink
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BronzeLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   inventoryimage.BronzeLinksTable,
+			Columns: []string{inventoryimage.BronzeLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryimagebronzelink.FieldID, field.TypeInt),
+			},
+		}
+		edge.Schema = _u.schemaConfig.InventoryImageBronzeLink
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = _u.schemaConfig.InventoryImage
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &InventoryImage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inventoryimage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
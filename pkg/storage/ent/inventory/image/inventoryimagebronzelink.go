@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"fmt"
+	"strings"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimage"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagebronzelink"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// InventoryImageBronzeLink is the model entity for the InventoryImageBronzeLink schema.
+type InventoryImageBronzeLink struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// BronzeTable holds the value of the "bronze_table" field.
+	BronzeTable string `json:"bronze_table,omitempty"`
+	// BronzeResourceID holds the value of the "bronze_resource_id" field.
+	BronzeResourceID string `json:"bronze_resource_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InventoryImageBronzeLinkQuery when eager-loading is set.
+	Edges                        InventoryImageBronzeLinkEdges `json:"edges"`
+	inventory_image_bronze_links *string
+	selectValues                 sql.SelectValues
+}
+
+// InventoryImageBronzeLinkEdges holds the relations/edges for other nodes in the graph.
+type InventoryImageBronzeLinkEdges struct {
+	// Image holds the value of the image edge.
+	Image *InventoryImage `json:"image,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ImageOrErr returns the Image value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InventoryImageBronzeLinkEdges) ImageOrErr() (*InventoryImage, error) {
+	if e.Image != nil {
+		return e.Image, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: inventoryimage.Label}
+	}
+	return nil, &NotLoadedError{edge: "image"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InventoryImageBronzeLink) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case inventoryimagebronzelink.FieldID:
+			values[i] = new(sql.NullInt64)
+		case inventoryimagebronzelink.FieldProvider, inventoryimagebronzelink.FieldBronzeTable, inventoryimagebronzelink.FieldBronzeResourceID:
+			values[i] = new(sql.NullString)
+		case inventoryimagebronzelink.ForeignKeys[0]: // inventory_image_bronze_links
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InventoryImageBronzeLink fields.
+func (_m *InventoryImageBronzeLink) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case inventoryimagebronzelink.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case inventoryimagebronzelink.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case inventoryimagebronzelink.FieldBronzeTable:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bronze_table", values[i])
+			} else if value.Valid {
+				_m.BronzeTable = value.String
+			}
+		case inventoryimagebronzelink.FieldBronzeResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bronze_resource_id", values[i])
+			} else if value.Valid {
+				_m.BronzeResourceID = value.String
+			}
+		case inventoryimagebronzelink.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field inventory_image_bronze_links", values[i])
+			} else if value.Valid {
+				_m.inventory_image_bronze_links = new(string)
+				*_m.inventory_image_bronze_links = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InventoryImageBronzeLink.
+// This includes values selected through modifiers, order, etc.
+func (_m *InventoryImageBronzeLink) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryImage queries the "image" edge of the InventoryImageBronzeLink entity.
+func (_m *InventoryImageBronzeLink) QueryImage() *InventoryImageQuery {
+	return NewInventoryImageBronzeLinkClient(_m.config).QueryImage(_m)
+}
+
+// Update returns a builder for updating this InventoryImageBronzeLink.
+// Note that you need to call InventoryImageBronzeLink.Unwrap() before calling this method if this InventoryImageBronzeLink
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *InventoryImageBronzeLink) Update() *InventoryImageBronzeLinkUpdateOne {
+	return NewInventoryImageBronzeLinkClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the InventoryImageBronzeLink entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *InventoryImageBronzeLink) Unwrap() *InventoryImageBronzeLink {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("image: InventoryImageBronzeLink is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *InventoryImageBronzeLink) String() string {
+	var builder strings.Builder
+	builder.WriteString("InventoryImageBronzeLink(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("bronze_table=")
+	builder.WriteString(_m.BronzeTable)
+	builder.WriteString(", ")
+	builder.WriteString("bronze_resource_id=")
+	builder.WriteString(_m.BronzeResourceID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InventoryImageBronzeLinks is a parsable slice of InventoryImageBronzeLink.
+type InventoryImageBronzeLinks []*InventoryImageBronzeLink
@@ -0,0 +1,109 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inventoryimagebronzelink
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the inventoryimagebronzelink type in the database.
+	Label = "inventory_image_bronze_link"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldBronzeTable holds the string denoting the bronze_table field in the database.
+	FieldBronzeTable = "bronze_table"
+	// FieldBronzeResourceID holds the string denoting the bronze_resource_id field in the database.
+	FieldBronzeResourceID = "bronze_resource_id"
+	// EdgeImage holds the string denoting the image edge name in mutations.
+	EdgeImage = "image"
+	// InventoryImageFieldID holds the string denoting the ID field of the InventoryImage.
+	InventoryImageFieldID = "resource_id"
+	// Table holds the table name of the inventoryimagebronzelink in the database.
+	Table = "inventory_image_links"
+	// ImageTable is the table that holds the image relation/edge.
+	ImageTable = "inventory_image_links"
+	// ImageInverseTable is the table name for the InventoryImage entity.
+	// It exists in this package in order to avoid circular dependency with the "inventoryimage" package.
+	ImageInverseTable = "inventory_images"
+	// ImageColumn is the table column denoting the image relation/edge.
+	ImageColumn = "inventory_image_bronze_links"
+)
+
+// Columns holds all SQL columns for inventoryimagebronzelink fields.
+var Columns = []string{
+	FieldID,
+	FieldProvider,
+	FieldBronzeTable,
+	FieldBronzeResourceID,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "inventory_image_links"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"inventory_image_bronze_links",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	ProviderValidator func(string) error
+	// BronzeTableValidator is a validator for the "bronze_table" field. It is called by the builders before save.
+	BronzeTableValidator func(string) error
+	// BronzeResourceIDValidator is a validator for the "bronze_resource_id" field. It is called by the builders before save.
+	BronzeResourceIDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the InventoryImageBronzeLink queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByBronzeTable orders the results by the bronze_table field.
+func ByBronzeTable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBronzeTable, opts...).ToFunc()
+}
+
+// ByBronzeResourceID orders the results by the bronze_resource_id field.
+func ByBronzeResourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBronzeResourceID, opts...).ToFunc()
+}
+
+// ByImageField orders the results by image field.
+func ByImageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newImageStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newImageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ImageInverseTable, InventoryImageFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ImageTable, ImageColumn),
+	)
+}
@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inventoryimagebronzelink
+
+import (
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/internal"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldLTE(FieldID, id))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldEQ(FieldProvider, v))
+}
+
+// BronzeTable applies equality check predicate on the "bronze_table" field. It's identical to BronzeTableEQ.
+func BronzeTable(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldEQ(FieldBronzeTable, v))
+}
+
+// BronzeResourceID applies equality check predicate on the "bronze_resource_id" field. It's identical to BronzeResourceIDEQ.
+func BronzeResourceID(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldEQ(FieldBronzeResourceID, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// BronzeTableEQ applies the EQ predicate on the "bronze_table" field.
+func BronzeTableEQ(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldEQ(FieldBronzeTable, v))
+}
+
+// BronzeTableNEQ applies the NEQ predicate on the "bronze_table" field.
+func BronzeTableNEQ(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldNEQ(FieldBronzeTable, v))
+}
+
+// BronzeTableIn applies the In predicate on the "bronze_table" field.
+func BronzeTableIn(vs ...string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldIn(FieldBronzeTable, vs...))
+}
+
+// BronzeTableNotIn applies the NotIn predicate on the "bronze_table" field.
+func BronzeTableNotIn(vs ...string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldNotIn(FieldBronzeTable, vs...))
+}
+
+// BronzeTableGT applies the GT predicate on the "bronze_table" field.
+func BronzeTableGT(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldGT(FieldBronzeTable, v))
+}
+
+// BronzeTableGTE applies the GTE predicate on the "bronze_table" field.
+func BronzeTableGTE(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldGTE(FieldBronzeTable, v))
+}
+
+// BronzeTableLT applies the LT predicate on the "bronze_table" field.
+func BronzeTableLT(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldLT(FieldBronzeTable, v))
+}
+
+// BronzeTableLTE applies the LTE predicate on the "bronze_table" field.
+func BronzeTableLTE(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldLTE(FieldBronzeTable, v))
+}
+
+// BronzeTableContains applies the Contains predicate on the "bronze_table" field.
+func BronzeTableContains(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldContains(FieldBronzeTable, v))
+}
+
+// BronzeTableHasPrefix applies the HasPrefix predicate on the "bronze_table" field.
+func BronzeTableHasPrefix(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldHasPrefix(FieldBronzeTable, v))
+}
+
+// BronzeTableHasSuffix applies the HasSuffix predicate on the "bronze_table" field.
+func BronzeTableHasSuffix(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldHasSuffix(FieldBronzeTable, v))
+}
+
+// BronzeTableEqualFold applies the EqualFold predicate on the "bronze_table" field.
+func BronzeTableEqualFold(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldEqualFold(FieldBronzeTable, v))
+}
+
+// BronzeTableContainsFold applies the ContainsFold predicate on the "bronze_table" field.
+func BronzeTableContainsFold(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldContainsFold(FieldBronzeTable, v))
+}
+
+// BronzeResourceIDEQ applies the EQ predicate on the "bronze_resource_id" field.
+func BronzeResourceIDEQ(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldEQ(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDNEQ applies the NEQ predicate on the "bronze_resource_id" field.
+func BronzeResourceIDNEQ(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldNEQ(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDIn applies the In predicate on the "bronze_resource_id" field.
+func BronzeResourceIDIn(vs ...string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldIn(FieldBronzeResourceID, vs...))
+}
+
+// BronzeResourceIDNotIn applies the NotIn predicate on the "bronze_resource_id" field.
+func BronzeResourceIDNotIn(vs ...string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldNotIn(FieldBronzeResourceID, vs...))
+}
+
+// BronzeResourceIDGT applies the GT predicate on the "bronze_resource_id" field.
+func BronzeResourceIDGT(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldGT(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDGTE applies the GTE predicate on the "bronze_resource_id" field.
+func BronzeResourceIDGTE(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldGTE(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDLT applies the LT predicate on the "bronze_resource_id" field.
+func BronzeResourceIDLT(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldLT(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDLTE applies the LTE predicate on the "bronze_resource_id" field.
+func BronzeResourceIDLTE(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldLTE(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDContains applies the Contains predicate on the "bronze_resource_id" field.
+func BronzeResourceIDContains(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldContains(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDHasPrefix applies the HasPrefix predicate on the "bronze_resource_id" field.
+func BronzeResourceIDHasPrefix(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldHasPrefix(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDHasSuffix applies the HasSuffix predicate on the "bronze_resource_id" field.
+func BronzeResourceIDHasSuffix(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldHasSuffix(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDEqualFold applies the EqualFold predicate on the "bronze_resource_id" field.
+func BronzeResourceIDEqualFold(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldEqualFold(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDContainsFold applies the ContainsFold predicate on the "bronze_resource_id" field.
+func BronzeResourceIDContainsFold(v string) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.FieldContainsFold(FieldBronzeResourceID, v))
+}
+
+// HasImage applies the HasEdge predicate on the "image" edge.
+func HasImage() predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ImageTable, ImageColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.InventoryImage
+		step.Edge.Schema = schemaConfig.InventoryImageBronzeLink
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasImageWith applies the HasEdge predicate on the "image" edge with a given conditions (other predicates).
+func HasImageWith(preds ...predicate.InventoryImage) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(func(s *sql.Selector) {
+		step := newImageStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.InventoryImage
+		step.Edge.Schema = schemaConfig.InventoryImageBronzeLink
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InventoryImageBronzeLink) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InventoryImageBronzeLink) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InventoryImageBronzeLink) predicate.InventoryImageBronzeLink {
+	return predicate.InventoryImageBronzeLink(sql.NotPredicates(p))
+}
@@ -0,0 +1,258 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimage"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagebronzelink"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryImageBronzeLinkCreate is the builder for creating a InventoryImageBronzeLink entity.
+type InventoryImageBronzeLinkCreate struct {
+	config
+	mutation *InventoryImageBronzeLinkMutation
+	hooks    []Hook
+}
+
+// SetProvider sets the "provider" field.
+func (_c *InventoryImageBronzeLinkCreate) SetProvider(v string) *InventoryImageBronzeLinkCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetBronzeTable sets the "bronze_table" field.
+func (_c *InventoryImageBronzeLinkCreate) SetBronzeTable(v string) *InventoryImageBronzeLinkCreate {
+	_c.mutation.SetBronzeTable(v)
+	return _c
+}
+
+// SetBronzeResourceID sets the "bronze_resource_id" field.
+func (_c *InventoryImageBronzeLinkCreate) SetBronzeResourceID(v string) *InventoryImageBronzeLinkCreate {
+	_c.mutation.SetBronzeResourceID(v)
+	return _c
+}
+
+// SetImageID sets the "image" edge to the InventoryImage entity by ID.
+func (_c *InventoryImageBronzeLinkCreate) SetImageID(id string) *InventoryImageBronzeLinkCreate {
+	_c.mutation.SetImageID(id)
+	return _c
+}
+
+// SetImage sets the "image" edge to the InventoryImage entity.
+func (_c *InventoryImageBronzeLinkCreate) SetImage(v *InventoryImage) *InventoryImageBronzeLinkCreate {
+	return _c.SetImageID(v.ID)
+}
+
+// Mutation returns the InventoryImageBronzeLinkMutation object of the builder.
+func (_c *InventoryImageBronzeLinkCreate) Mutation() *InventoryImageBronzeLinkMutation {
+	return _c.mutation
+}
+
+// Save creates the InventoryImageBronzeLink in the database.
+func (_c *InventoryImageBronzeLinkCreate) Save(ctx context.Context) (*InventoryImageBronzeLink, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InventoryImageBronzeLinkCreate) SaveX(ctx context.Context) *InventoryImageBronzeLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InventoryImageBronzeLinkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InventoryImageBronzeLinkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InventoryImageBronzeLinkCreate) check() error {
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`image: missing required field "InventoryImageBronzeLink.provider"`)}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := inventoryimagebronzelink.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`image: validator failed for field "InventoryImageBronzeLink.provider": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BronzeTable(); !ok {
+		return &ValidationError{Name: "bronze_table", err: errors.New(`image: missing required field "InventoryImageBronzeLink.bronze_table"`)}
+	}
+	if v, ok := _c.mutation.BronzeTable(); ok {
+		if err := inventoryimagebronzelink.BronzeTableValidator(v); err != nil {
+			return &ValidationError{Name: "bronze_table", err: fmt.Errorf(`image: validator failed for field "InventoryImageBronzeLink.bronze_table": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BronzeResourceID(); !ok {
+		return &ValidationError{Name: "bronze_resource_id", err: errors.New(`image: missing required field "InventoryImageBronzeLink.bronze_resource_id"`)}
+	}
+	if v, ok := _c.mutation.BronzeResourceID(); ok {
+		if err := inventoryimagebronzelink.BronzeResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "bronze_resource_id", err: fmt.Errorf(`image: validator failed for field "InventoryImageBronzeLink.bronze_resource_id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ImageIDs()) == 0 {
+		return &ValidationError{Name: "image", err: errors.New(`image: missing required edge "InventoryImageBronzeLink.image"`)}
+	}
+	return nil
+}
+
+func (_c *InventoryImageBronzeLinkCreate) sqlSave(ctx context.Context) (*InventoryImageBronzeLink, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InventoryImageBronzeLinkCreate) createSpec() (*InventoryImageBronzeLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InventoryImageBronzeLink{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(inventoryimagebronzelink.Table, sqlgraph.NewFieldSpec(inventoryimagebronzelink.FieldID, field.TypeInt))
+	)
+	_spec.Schema = _c.schemaConfig.InventoryImageBronzeLink
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(inventoryimagebronzelink.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.BronzeTable(); ok {
+		_spec.SetField(inventoryimagebronzelink.FieldBronzeTable, field.TypeString, value)
+		_node.BronzeTable = value
+	}
+	if value, ok := _c.mutation.BronzeResourceID(); ok {
+		_spec.SetField(inventoryimagebronzelink.FieldBronzeResourceID, field.TypeString, value)
+		_node.BronzeResourceID = value
+	}
+	if nodes := _c.mutation.ImageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inventoryimagebronzelink.ImageTable,
+			Columns: []string{inventoryimagebronzelink.ImageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryimage.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = _c.schemaConfig.InventoryImageBronzeLink
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.inventory_image_bronze_links = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// InventoryImageBronzeLinkCreateBulk is the builder for creating many InventoryImageBronzeLink entities in bulk.
+type InventoryImageBronzeLinkCreateBulk struct {
+	config
+	err      error
+	builders []*InventoryImageBronzeLinkCreate
+}
+
+// Save creates the InventoryImageBronzeLink entities in the database.
+func (_c *InventoryImageBronzeLinkCreateBulk) Save(ctx context.Context) ([]*InventoryImageBronzeLink, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InventoryImageBronzeLink, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InventoryImageBronzeLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InventoryImageBronzeLinkCreateBulk) SaveX(ctx context.Context) []*InventoryImageBronzeLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InventoryImageBronzeLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InventoryImageBronzeLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
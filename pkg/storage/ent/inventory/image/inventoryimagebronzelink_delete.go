@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/internal"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagebronzelink"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryImageBronzeLinkDelete is the builder for deleting a InventoryImageBronzeLink entity.
+type InventoryImageBronzeLinkDelete struct {
+	config
+	hooks    []Hook
+	mutation *InventoryImageBronzeLinkMutation
+}
+
+// Where appends a list predicates to the InventoryImageBronzeLinkDelete builder.
+func (_d *InventoryImageBronzeLinkDelete) Where(ps ...predicate.InventoryImageBronzeLink) *InventoryImageBronzeLinkDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *InventoryImageBronzeLinkDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *InventoryImageBronzeLinkDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *InventoryImageBronzeLinkDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(inventoryimagebronzelink.Table, sqlgraph.NewFieldSpec(inventoryimagebronzelink.FieldID, field.TypeInt))
+	_spec.Node.Schema = _d.schemaConfig.InventoryImageBronzeLink
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// InventoryImageBronzeLinkDeleteOne is the builder for deleting a single InventoryImageBronzeLink entity.
+type InventoryImageBronzeLinkDeleteOne struct {
+	_d *InventoryImageBronzeLinkDelete
+}
+
+// Where appends a list predicates to the InventoryImageBronzeLinkDelete builder.
+func (_d *InventoryImageBronzeLinkDeleteOne) Where(ps ...predicate.InventoryImageBronzeLink) *InventoryImageBronzeLinkDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *InventoryImageBronzeLinkDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{inventoryimagebronzelink.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *InventoryImageBronzeLinkDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
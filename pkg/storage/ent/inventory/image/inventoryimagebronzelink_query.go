@@ -0,0 +1,625 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/internal"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimage"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagebronzelink"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/predicate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryImageBronzeLinkQuery is the builder for querying InventoryImageBronzeLink entities.
+type InventoryImageBronzeLinkQuery struct {
+	config
+	ctx        *QueryContext
+	order      []inventoryimagebronzelink.OrderOption
+	inters     []Interceptor
+	predicates []predicate.InventoryImageBronzeLink
+	withImage  *InventoryImageQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InventoryImageBronzeLinkQuery builder.
+func (_q *InventoryImageBronzeLinkQuery) Where(ps ...predicate.InventoryImageBronzeLink) *InventoryImageBronzeLinkQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *InventoryImageBronzeLinkQuery) Limit(limit int) *InventoryImageBronzeLinkQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *InventoryImageBronzeLinkQuery) Offset(offset int) *InventoryImageBronzeLinkQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *InventoryImageBronzeLinkQuery) Unique(unique bool) *InventoryImageBronzeLinkQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *InventoryImageBronzeLinkQuery) Order(o ...inventoryimagebronzelink.OrderOption) *InventoryImageBronzeLinkQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryImage chains the current query on the "image" edge.
+func (_q *InventoryImageBronzeLinkQuery) QueryImage() *InventoryImageQuery {
+	query := (&InventoryImageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(inventoryimagebronzelink.Table, inventoryimagebronzelink.FieldID, selector),
+			sqlgraph.To(inventoryimage.Table, inventoryimage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, inventoryimagebronzelink.ImageTable, inventoryimagebronzelink.ImageColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.InventoryImage
+		step.Edge.Schema = schemaConfig.InventoryImageBronzeLink
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first InventoryImageBronzeLink entity from the query.
+// Returns a *NotFoundError when no InventoryImageBronzeLink was found.
+func (_q *InventoryImageBronzeLinkQuery) First(ctx context.Context) (*InventoryImageBronzeLink, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{inventoryimagebronzelink.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *InventoryImageBronzeLinkQuery) FirstX(ctx context.Context) *InventoryImageBronzeLink {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InventoryImageBronzeLink ID from the query.
+// Returns a *NotFoundError when no InventoryImageBronzeLink ID was found.
+func (_q *InventoryImageBronzeLinkQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{inventoryimagebronzelink.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *InventoryImageBronzeLinkQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InventoryImageBronzeLink entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InventoryImageBronzeLink entity is found.
+// Returns a *NotFoundError when no InventoryImageBronzeLink entities are found.
+func (_q *InventoryImageBronzeLinkQuery) Only(ctx context.Context) (*InventoryImageBronzeLink, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{inventoryimagebronzelink.Label}
+	default:
+		return nil, &NotSingularError{inventoryimagebronzelink.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *InventoryImageBronzeLinkQuery) OnlyX(ctx context.Context) *InventoryImageBronzeLink {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InventoryImageBronzeLink ID in the query.
+// Returns a *NotSingularError when more than one InventoryImageBronzeLink ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *InventoryImageBronzeLinkQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{inventoryimagebronzelink.Label}
+	default:
+		err = &NotSingularError{inventoryimagebronzelink.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *InventoryImageBronzeLinkQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InventoryImageBronzeLinks.
+func (_q *InventoryImageBronzeLinkQuery) All(ctx context.Context) ([]*InventoryImageBronzeLink, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*InventoryImageBronzeLink, *InventoryImageBronzeLinkQuery]()
+	return withInterceptors[[]*InventoryImageBronzeLink](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *InventoryImageBronzeLinkQuery) AllX(ctx context.Context) []*InventoryImageBronzeLink {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InventoryImageBronzeLink IDs.
+func (_q *InventoryImageBronzeLinkQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(inventoryimagebronzelink.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *InventoryImageBronzeLinkQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *InventoryImageBronzeLinkQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*InventoryImageBronzeLinkQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *InventoryImageBronzeLinkQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *InventoryImageBronzeLinkQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("image: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *InventoryImageBronzeLinkQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InventoryImageBronzeLinkQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *InventoryImageBronzeLinkQuery) Clone() *InventoryImageBronzeLinkQuery {
+	if _q == nil {
+		return nil
+	}
+	return &InventoryImageBronzeLinkQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]inventoryimagebronzelink.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.InventoryImageBronzeLink{}, _q.predicates...),
+		withImage:  _q.withImage.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithImage tells the query-builder to eager-load the nodes that are connected to
+// the "image" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *InventoryImageBronzeLinkQuery) WithImage(opts ...func(*InventoryImageQuery)) *InventoryImageBronzeLinkQuery {
+	query := (&InventoryImageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withImage = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Provider string `json:"provider,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InventoryImageBronzeLink.Query().
+//		GroupBy(inventoryimagebronzelink.FieldProvider).
+//		Aggregate(image.Count()).
+//		Scan(ctx, &v)
+func (_q *InventoryImageBronzeLinkQuery) GroupBy(field string, fields ...string) *InventoryImageBronzeLinkGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &InventoryImageBronzeLinkGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = inventoryimagebronzelink.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Provider string `json:"provider,omitempty"`
+//	}
+//
+//	client.InventoryImageBronzeLink.Query().
+//		Select(inventoryimagebronzelink.FieldProvider).
+//		Scan(ctx, &v)
+func (_q *InventoryImageBronzeLinkQuery) Select(fields ...string) *InventoryImageBronzeLinkSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &InventoryImageBronzeLinkSelect{InventoryImageBronzeLinkQuery: _q}
+	sbuild.label = inventoryimagebronzelink.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a InventoryImageBronzeLinkSelect configured with the given aggregations.
+func (_q *InventoryImageBronzeLinkQuery) Aggregate(fns ...AggregateFunc) *InventoryImageBronzeLinkSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *InventoryImageBronzeLinkQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("image: uninitialized interceptor (forgotten import image/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !inventoryimagebronzelink.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("image: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *InventoryImageBronzeLinkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InventoryImageBronzeLink, error) {
+	var (
+		nodes       = []*InventoryImageBronzeLink{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withImage != nil,
+		}
+	)
+	if _q.withImage != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, inventoryimagebronzelink.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*InventoryImageBronzeLink).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &InventoryImageBronzeLink{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = _q.schemaConfig.InventoryImageBronzeLink
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withImage; query != nil {
+		if err := _q.loadImage(ctx, query, nodes, nil,
+			func(n *InventoryImageBronzeLink, e *InventoryImage) { n.Edges.Image = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *InventoryImageBronzeLinkQuery) loadImage(ctx context.Context, query *InventoryImageQuery, nodes []*InventoryImageBronzeLink, init func(*InventoryImageBronzeLink), assign func(*InventoryImageBronzeLink, *InventoryImage)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*InventoryImageBronzeLink)
+	for i := range nodes {
+		if nodes[i].inventory_image_bronze_links == nil {
+			continue
+		}
+		fk := *nodes[i].inventory_image_bronze_links
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(inventoryimage.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "inventory_image_bronze_links" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *InventoryImageBronzeLinkQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Schema = _q.schemaConfig.InventoryImageBronzeLink
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *InventoryImageBronzeLinkQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(inventoryimagebronzelink.Table, inventoryimagebronzelink.Columns, sqlgraph.NewFieldSpec(inventoryimagebronzelink.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inventoryimagebronzelink.FieldID)
+		for i := range fields {
+			if fields[i] != inventoryimagebronzelink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *InventoryImageBronzeLinkQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(inventoryimagebronzelink.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = inventoryimagebronzelink.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(_q.schemaConfig.InventoryImageBronzeLink)
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	selector.WithContext(ctx)
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// InventoryImageBronzeLinkGroupBy is the group-by builder for InventoryImageBronzeLink entities.
+type InventoryImageBronzeLinkGroupBy struct {
+	selector
+	build *InventoryImageBronzeLinkQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *InventoryImageBronzeLinkGroupBy) Aggregate(fns ...AggregateFunc) *InventoryImageBronzeLinkGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *InventoryImageBronzeLinkGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InventoryImageBronzeLinkQuery, *InventoryImageBronzeLinkGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *InventoryImageBronzeLinkGroupBy) sqlScan(ctx context.Context, root *InventoryImageBronzeLinkQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// InventoryImageBronzeLinkSelect is the builder for selecting fields of InventoryImageBronzeLink entities.
+type InventoryImageBronzeLinkSelect struct {
+	*InventoryImageBronzeLinkQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *InventoryImageBronzeLinkSelect) Aggregate(fns ...AggregateFunc) *InventoryImageBronzeLinkSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *InventoryImageBronzeLinkSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InventoryImageBronzeLinkQuery, *InventoryImageBronzeLinkSelect](ctx, _s.InventoryImageBronzeLinkQuery, _s, _s.inters, v)
+}
+
+func (_s *InventoryImageBronzeLinkSelect) sqlScan(ctx context.Context, root *InventoryImageBronzeLinkQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,431 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/internal"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimage"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagebronzelink"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryImageBronzeLinkUpdate is the builder for updating InventoryImageBronzeLink entities.
+type InventoryImageBronzeLinkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InventoryImageBronzeLinkMutation
+}
+
+// Where appends a list predicates to the InventoryImageBronzeLinkUpdate builder.
+func (_u *InventoryImageBronzeLinkUpdate) Where(ps ...predicate.InventoryImageBronzeLink) *InventoryImageBronzeLinkUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *InventoryImageBronzeLinkUpdate) SetProvider(v string) *InventoryImageBronzeLinkUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *InventoryImageBronzeLinkUpdate) SetNillableProvider(v *string) *InventoryImageBronzeLinkUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetBronzeTable sets the "bronze_table" field.
+func (_u *InventoryImageBronzeLinkUpdate) SetBronzeTable(v string) *InventoryImageBronzeLinkUpdate {
+	_u.mutation.SetBronzeTable(v)
+	return _u
+}
+
+// SetNillableBronzeTable sets the "bronze_table" field if the given value is not nil.
+func (_u *InventoryImageBronzeLinkUpdate) SetNillableBronzeTable(v *string) *InventoryImageBronzeLinkUpdate {
+	if v != nil {
+		_u.SetBronzeTable(*v)
+	}
+	return _u
+}
+
+// SetBronzeResourceID sets the "bronze_resource_id" field.
+func (_u *InventoryImageBronzeLinkUpdate) SetBronzeResourceID(v string) *InventoryImageBronzeLinkUpdate {
+	_u.mutation.SetBronzeResourceID(v)
+	return _u
+}
+
+// SetNillableBronzeResourceID sets the "bronze_resource_id" field if the given value is not nil.
+func (_u *InventoryImageBronzeLinkUpdate) SetNillableBronzeResourceID(v *string) *InventoryImageBronzeLinkUpdate {
+	if v != nil {
+		_u.SetBronzeResourceID(*v)
+	}
+	return _u
+}
+
+// SetImageID sets the "image" edge to the InventoryImage entity by ID.
+func (_u *InventoryImageBronzeLinkUpdate) SetImageID(id string) *InventoryImageBronzeLinkUpdate {
+	_u.mutation.SetImageID(id)
+	return _u
+}
+
+// SetImage sets the "image" edge to the InventoryImage entity.
+func (_u *InventoryImageBronzeLinkUpdate) SetImage(v *InventoryImage) *InventoryImageBronzeLinkUpdate {
+	return _u.SetImageID(v.ID)
+}
+
+// Mutation returns the InventoryImageBronzeLinkMutation object of the builder.
+func (_u *InventoryImageBronzeLinkUpdate) Mutation() *InventoryImageBronzeLinkMutation {
+	return _u.mutation
+}
+
+// ClearImage clears the "image" edge to the InventoryImage entity.
+func (_u *InventoryImageBronzeLinkUpdate) ClearImage() *InventoryImageBronzeLinkUpdate {
+	_u.mutation.ClearImage()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InventoryImageBronzeLinkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InventoryImageBronzeLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InventoryImageBronzeLinkUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InventoryImageBronzeLinkUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InventoryImageBronzeLinkUpdate) check() error {
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := inventoryimagebronzelink.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`image: validator failed for field "InventoryImageBronzeLink.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BronzeTable(); ok {
+		if err := inventoryimagebronzelink.BronzeTableValidator(v); err != nil {
+			return &ValidationError{Name: "bronze_table", err: fmt.Errorf(`image: validator failed for field "InventoryImageBronzeLink.bronze_table": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BronzeResourceID(); ok {
+		if err := inventoryimagebronzelink.BronzeResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "bronze_resource_id", err: fmt.Errorf(`image: validator failed for field "InventoryImageBronzeLink.bronze_resource_id": %w`, err)}
+		}
+	}
+	if _u.mutation.ImageCleared() && len(_u.mutation.ImageIDs()) > 0 {
+		return errors.New(`image: clearing a required unique edge "InventoryImageBronzeLink.image"`)
+	}
+	return nil
+}
+
+func (_u *InventoryImageBronzeLinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inventoryimagebronzelink.Table, inventoryimagebronzelink.Columns, sqlgraph.NewFieldSpec(inventoryimagebronzelink.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(inventoryimagebronzelink.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BronzeTable(); ok {
+		_spec.SetField(inventoryimagebronzelink.FieldBronzeTable, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BronzeResourceID(); ok {
+		_spec.SetField(inventoryimagebronzelink.FieldBronzeResourceID, field.TypeString, value)
+	}
+	if _u.mutation.ImageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inventoryimagebronzelink.ImageTable,
+			Columns: []string{inventoryimagebronzelink.ImageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryimage.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = _u.schemaConfig.InventoryImageBronzeLink
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inventoryimagebronzelink.ImageTable,
+			Columns: []string{inventoryimagebronzelink.ImageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryimage.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = _u.schemaConfig.InventoryImageBronzeLink
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = _u.schemaConfig.InventoryImageBronzeLink
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inventoryimagebronzelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InventoryImageBronzeLinkUpdateOne is the builder for updating a single InventoryImageBronzeLink entity.
+type InventoryImageBronzeLinkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InventoryImageBronzeLinkMutation
+}
+
+// SetProvider sets the "provider" field.
+func (_u *InventoryImageBronzeLinkUpdateOne) SetProvider(v string) *InventoryImageBronzeLinkUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *InventoryImageBronzeLinkUpdateOne) SetNillableProvider(v *string) *InventoryImageBronzeLinkUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetBronzeTable sets the "bronze_table" field.
+func (_u *InventoryImageBronzeLinkUpdateOne) SetBronzeTable(v string) *InventoryImageBronzeLinkUpdateOne {
+	_u.mutation.SetBronzeTable(v)
+	return _u
+}
+
+// SetNillableBronzeTable sets the "bronze_table" field if the given value is not nil.
+func (_u *InventoryImageBronzeLinkUpdateOne) SetNillableBronzeTable(v *string) *InventoryImageBronzeLinkUpdateOne {
+	if v != nil {
+		_u.SetBronzeTable(*v)
+	}
+	return _u
+}
+
+// SetBronzeResourceID sets the "bronze_resource_id" field.
+func (_u *InventoryImageBronzeLinkUpdateOne) SetBronzeResourceID(v string) *InventoryImageBronzeLinkUpdateOne {
+	_u.mutation.SetBronzeResourceID(v)
+	return _u
+}
+
+// SetNillableBronzeResourceID sets the "bronze_resource_id" field if the given value is not nil.
+func (_u *InventoryImageBronzeLinkUpdateOne) SetNillableBronzeResourceID(v *string) *InventoryImageBronzeLinkUpdateOne {
+	if v != nil {
+		_u.SetBronzeResourceID(*v)
+	}
+	return _u
+}
+
+// SetImageID sets the "image" edge to the InventoryImage entity by ID.
+func (_u *InventoryImageBronzeLinkUpdateOne) SetImageID(id string) *InventoryImageBronzeLinkUpdateOne {
+	_u.mutation.SetImageID(id)
+	return _u
+}
+
+// SetImage sets the "image" edge to the InventoryImage entity.
+func (_u *InventoryImageBronzeLinkUpdateOne) SetImage(v *InventoryImage) *InventoryImageBronzeLinkUpdateOne {
+	return _u.SetImageID(v.ID)
+}
+
+// Mutation returns the InventoryImageBronzeLinkMutation object of the builder.
+func (_u *InventoryImageBronzeLinkUpdateOne) Mutation() *InventoryImageBronzeLinkMutation {
+	return _u.mutation
+}
+
+// ClearImage clears the "image" edge to the InventoryImage entity.
+func (_u *InventoryImageBronzeLinkUpdateOne) ClearImage() *InventoryImageBronzeLinkUpdateOne {
+	_u.mutation.ClearImage()
+	return _u
+}
+
+// Where appends a list predicates to the InventoryImageBronzeLinkUpdate builder.
+func (_u *InventoryImageBronzeLinkUpdateOne) Where(ps ...predicate.InventoryImageBronzeLink) *InventoryImageBronzeLinkUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InventoryImageBronzeLinkUpdateOne) Select(field string, fields ...string) *InventoryImageBronzeLinkUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InventoryImageBronzeLink entity.
+func (_u *InventoryImageBronzeLinkUpdateOne) Save(ctx context.Context) (*InventoryImageBronzeLink, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InventoryImageBronzeLinkUpdateOne) SaveX(ctx context.Context) *InventoryImageBronzeLink {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InventoryImageBronzeLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InventoryImageBronzeLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InventoryImageBronzeLinkUpdateOne) check() error {
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := inventoryimagebronzelink.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`image: validator failed for field "InventoryImageBronzeLink.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BronzeTable(); ok {
+		if err := inventoryimagebronzelink.BronzeTableValidator(v); err != nil {
+			return &ValidationError{Name: "bronze_table", err: fmt.Errorf(`image: validator failed for field "InventoryImageBronzeLink.bronze_table": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BronzeResourceID(); ok {
+		if err := inventoryimagebronzelink.BronzeResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "bronze_resource_id", err: fmt.Errorf(`image: validator failed for field "InventoryImageBronzeLink.bronze_resource_id": %w`, err)}
+		}
+	}
+	if _u.mutation.ImageCleared() && len(_u.mutation.ImageIDs()) > 0 {
+		return errors.New(`image: clearing a required unique edge "InventoryImageBronzeLink.image"`)
+	}
+	return nil
+}
+
+func (_u *InventoryImageBronzeLinkUpdateOne) sqlSave(ctx context.Context) (_node *InventoryImageBronzeLink, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inventoryimagebronzelink.Table, inventoryimagebronzelink.Columns, sqlgraph.NewFieldSpec(inventoryimagebronzelink.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`image: missing "InventoryImageBronzeLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inventoryimagebronzelink.FieldID)
+		for _, f := range fields {
+			if !inventoryimagebronzelink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("image: invalid field %q for query", f)}
+			}
+			if f != inventoryimagebronzelink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(inventoryimagebronzelink.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BronzeTable(); ok {
+		_spec.SetField(inventoryimagebronzelink.FieldBronzeTable, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BronzeResourceID(); ok {
+		_spec.SetField(inventoryimagebronzelink.FieldBronzeResourceID, field.TypeString, value)
+	}
+	if _u.mutation.ImageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inventoryimagebronzelink.ImageTable,
+			Columns: []string{inventoryimagebronzelink.ImageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryimage.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = _u.schemaConfig.InventoryImageBronzeLink
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inventoryimagebronzelink.ImageTable,
+			Columns: []string{inventoryimagebronzelink.ImageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryimage.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = _u.schemaConfig.InventoryImageBronzeLink
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = _u.schemaConfig.InventoryImageBronzeLink
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &InventoryImageBronzeLink{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inventoryimagebronzelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
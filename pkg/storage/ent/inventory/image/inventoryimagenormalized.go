@@ -0,0 +1,247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagenormalized"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// InventoryImageNormalized is the model entity for the InventoryImageNormalized schema.
+type InventoryImageNormalized struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// IsBase holds the value of the "is_base" field.
+	IsBase bool `json:"is_base,omitempty"`
+	// BronzeTable holds the value of the "bronze_table" field.
+	BronzeTable string `json:"bronze_table,omitempty"`
+	// BronzeResourceID holds the value of the "bronze_resource_id" field.
+	BronzeResourceID string `json:"bronze_resource_id,omitempty"`
+	// Registry holds the value of the "registry" field.
+	Registry string `json:"registry,omitempty"`
+	// Repository holds the value of the "repository" field.
+	Repository string `json:"repository,omitempty"`
+	// Digest holds the value of the "digest" field.
+	Digest string `json:"digest,omitempty"`
+	// TagsJSON holds the value of the "tags_json" field.
+	TagsJSON []string `json:"tags_json,omitempty"`
+	// WorkloadsJSON holds the value of the "workloads_json" field.
+	WorkloadsJSON []string `json:"workloads_json,omitempty"`
+	// VulnerabilitiesJSON holds the value of the "vulnerabilities_json" field.
+	VulnerabilitiesJSON json.RawMessage `json:"vulnerabilities_json,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// NormalizedAt holds the value of the "normalized_at" field.
+	NormalizedAt time.Time `json:"normalized_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InventoryImageNormalized) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case inventoryimagenormalized.FieldTagsJSON, inventoryimagenormalized.FieldWorkloadsJSON, inventoryimagenormalized.FieldVulnerabilitiesJSON:
+			values[i] = new([]byte)
+		case inventoryimagenormalized.FieldIsBase:
+			values[i] = new(sql.NullBool)
+		case inventoryimagenormalized.FieldID, inventoryimagenormalized.FieldProvider, inventoryimagenormalized.FieldBronzeTable, inventoryimagenormalized.FieldBronzeResourceID, inventoryimagenormalized.FieldRegistry, inventoryimagenormalized.FieldRepository, inventoryimagenormalized.FieldDigest:
+			values[i] = new(sql.NullString)
+		case inventoryimagenormalized.FieldCollectedAt, inventoryimagenormalized.FieldFirstCollectedAt, inventoryimagenormalized.FieldNormalizedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InventoryImageNormalized fields.
+func (_m *InventoryImageNormalized) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case inventoryimagenormalized.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case inventoryimagenormalized.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case inventoryimagenormalized.FieldIsBase:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_base", values[i])
+			} else if value.Valid {
+				_m.IsBase = value.Bool
+			}
+		case inventoryimagenormalized.FieldBronzeTable:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bronze_table", values[i])
+			} else if value.Valid {
+				_m.BronzeTable = value.String
+			}
+		case inventoryimagenormalized.FieldBronzeResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bronze_resource_id", values[i])
+			} else if value.Valid {
+				_m.BronzeResourceID = value.String
+			}
+		case inventoryimagenormalized.FieldRegistry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field registry", values[i])
+			} else if value.Valid {
+				_m.Registry = value.String
+			}
+		case inventoryimagenormalized.FieldRepository:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field repository", values[i])
+			} else if value.Valid {
+				_m.Repository = value.String
+			}
+		case inventoryimagenormalized.FieldDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field digest", values[i])
+			} else if value.Valid {
+				_m.Digest = value.String
+			}
+		case inventoryimagenormalized.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case inventoryimagenormalized.FieldWorkloadsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field workloads_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.WorkloadsJSON); err != nil {
+					return fmt.Errorf("unmarshal field workloads_json: %w", err)
+				}
+			}
+		case inventoryimagenormalized.FieldVulnerabilitiesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field vulnerabilities_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.VulnerabilitiesJSON); err != nil {
+					return fmt.Errorf("unmarshal field vulnerabilities_json: %w", err)
+				}
+			}
+		case inventoryimagenormalized.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case inventoryimagenormalized.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case inventoryimagenormalized.FieldNormalizedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field normalized_at", values[i])
+			} else if value.Valid {
+				_m.NormalizedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InventoryImageNormalized.
+// This includes values selected through modifiers, order, etc.
+func (_m *InventoryImageNormalized) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this InventoryImageNormalized.
+// Note that you need to call InventoryImageNormalized.Unwrap() before calling this method if this InventoryImageNormalized
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *InventoryImageNormalized) Update() *InventoryImageNormalizedUpdateOne {
+	return NewInventoryImageNormalizedClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the InventoryImageNormalized entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *InventoryImageNormalized) Unwrap() *InventoryImageNormalized {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("image: InventoryImageNormalized is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *InventoryImageNormalized) String() string {
+	var builder strings.Builder
+	builder.WriteString("InventoryImageNormalized(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("is_base=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsBase))
+	builder.WriteString(", ")
+	builder.WriteString("bronze_table=")
+	builder.WriteString(_m.BronzeTable)
+	builder.WriteString(", ")
+	builder.WriteString("bronze_resource_id=")
+	builder.WriteString(_m.BronzeResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("registry=")
+	builder.WriteString(_m.Registry)
+	builder.WriteString(", ")
+	builder.WriteString("repository=")
+	builder.WriteString(_m.Repository)
+	builder.WriteString(", ")
+	builder.WriteString("digest=")
+	builder.WriteString(_m.Digest)
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("workloads_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkloadsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("vulnerabilities_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VulnerabilitiesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("normalized_at=")
+	builder.WriteString(_m.NormalizedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InventoryImageNormalizeds is a parsable slice of InventoryImageNormalized.
+type InventoryImageNormalizeds []*InventoryImageNormalized
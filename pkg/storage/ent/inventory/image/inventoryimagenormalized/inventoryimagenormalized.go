@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inventoryimagenormalized
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the inventoryimagenormalized type in the database.
+	Label = "inventory_image_normalized"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldIsBase holds the string denoting the is_base field in the database.
+	FieldIsBase = "is_base"
+	// FieldBronzeTable holds the string denoting the bronze_table field in the database.
+	FieldBronzeTable = "bronze_table"
+	// FieldBronzeResourceID holds the string denoting the bronze_resource_id field in the database.
+	FieldBronzeResourceID = "bronze_resource_id"
+	// FieldRegistry holds the string denoting the registry field in the database.
+	FieldRegistry = "registry"
+	// FieldRepository holds the string denoting the repository field in the database.
+	FieldRepository = "repository"
+	// FieldDigest holds the string denoting the digest field in the database.
+	FieldDigest = "digest"
+	// FieldTagsJSON holds the string denoting the tags_json field in the database.
+	FieldTagsJSON = "tags_json"
+	// FieldWorkloadsJSON holds the string denoting the workloads_json field in the database.
+	FieldWorkloadsJSON = "workloads_json"
+	// FieldVulnerabilitiesJSON holds the string denoting the vulnerabilities_json field in the database.
+	FieldVulnerabilitiesJSON = "vulnerabilities_json"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldNormalizedAt holds the string denoting the normalized_at field in the database.
+	FieldNormalizedAt = "normalized_at"
+	// Table holds the table name of the inventoryimagenormalized in the database.
+	Table = "inventory_image_normalized"
+)
+
+// Columns holds all SQL columns for inventoryimagenormalized fields.
+var Columns = []string{
+	FieldID,
+	FieldProvider,
+	FieldIsBase,
+	FieldBronzeTable,
+	FieldBronzeResourceID,
+	FieldRegistry,
+	FieldRepository,
+	FieldDigest,
+	FieldTagsJSON,
+	FieldWorkloadsJSON,
+	FieldVulnerabilitiesJSON,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldNormalizedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	ProviderValidator func(string) error
+	// BronzeTableValidator is a validator for the "bronze_table" field. It is called by the builders before save.
+	BronzeTableValidator func(string) error
+	// BronzeResourceIDValidator is a validator for the "bronze_resource_id" field. It is called by the builders before save.
+	BronzeResourceIDValidator func(string) error
+	// RegistryValidator is a validator for the "registry" field. It is called by the builders before save.
+	RegistryValidator func(string) error
+	// RepositoryValidator is a validator for the "repository" field. It is called by the builders before save.
+	RepositoryValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the InventoryImageNormalized queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByIsBase orders the results by the is_base field.
+func ByIsBase(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsBase, opts...).ToFunc()
+}
+
+// ByBronzeTable orders the results by the bronze_table field.
+func ByBronzeTable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBronzeTable, opts...).ToFunc()
+}
+
+// ByBronzeResourceID orders the results by the bronze_resource_id field.
+func ByBronzeResourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBronzeResourceID, opts...).ToFunc()
+}
+
+// ByRegistry orders the results by the registry field.
+func ByRegistry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegistry, opts...).ToFunc()
+}
+
+// ByRepository orders the results by the repository field.
+func ByRepository(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRepository, opts...).ToFunc()
+}
+
+// ByDigest orders the results by the digest field.
+func ByDigest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDigest, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByNormalizedAt orders the results by the normalized_at field.
+func ByNormalizedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNormalizedAt, opts...).ToFunc()
+}
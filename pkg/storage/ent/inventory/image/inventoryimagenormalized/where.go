@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inventoryimagenormalized
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldContainsFold(FieldID, id))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldProvider, v))
+}
+
+// IsBase applies equality check predicate on the "is_base" field. It's identical to IsBaseEQ.
+func IsBase(v bool) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldIsBase, v))
+}
+
+// BronzeTable applies equality check predicate on the "bronze_table" field. It's identical to BronzeTableEQ.
+func BronzeTable(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldBronzeTable, v))
+}
+
+// BronzeResourceID applies equality check predicate on the "bronze_resource_id" field. It's identical to BronzeResourceIDEQ.
+func BronzeResourceID(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldBronzeResourceID, v))
+}
+
+// Registry applies equality check predicate on the "registry" field. It's identical to RegistryEQ.
+func Registry(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldRegistry, v))
+}
+
+// Repository applies equality check predicate on the "repository" field. It's identical to RepositoryEQ.
+func Repository(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldRepository, v))
+}
+
+// Digest applies equality check predicate on the "digest" field. It's identical to DigestEQ.
+func Digest(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldDigest, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// NormalizedAt applies equality check predicate on the "normalized_at" field. It's identical to NormalizedAtEQ.
+func NormalizedAt(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldNormalizedAt, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// IsBaseEQ applies the EQ predicate on the "is_base" field.
+func IsBaseEQ(v bool) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldIsBase, v))
+}
+
+// IsBaseNEQ applies the NEQ predicate on the "is_base" field.
+func IsBaseNEQ(v bool) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNEQ(FieldIsBase, v))
+}
+
+// BronzeTableEQ applies the EQ predicate on the "bronze_table" field.
+func BronzeTableEQ(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldBronzeTable, v))
+}
+
+// BronzeTableNEQ applies the NEQ predicate on the "bronze_table" field.
+func BronzeTableNEQ(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNEQ(FieldBronzeTable, v))
+}
+
+// BronzeTableIn applies the In predicate on the "bronze_table" field.
+func BronzeTableIn(vs ...string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldIn(FieldBronzeTable, vs...))
+}
+
+// BronzeTableNotIn applies the NotIn predicate on the "bronze_table" field.
+func BronzeTableNotIn(vs ...string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNotIn(FieldBronzeTable, vs...))
+}
+
+// BronzeTableGT applies the GT predicate on the "bronze_table" field.
+func BronzeTableGT(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGT(FieldBronzeTable, v))
+}
+
+// BronzeTableGTE applies the GTE predicate on the "bronze_table" field.
+func BronzeTableGTE(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGTE(FieldBronzeTable, v))
+}
+
+// BronzeTableLT applies the LT predicate on the "bronze_table" field.
+func BronzeTableLT(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLT(FieldBronzeTable, v))
+}
+
+// BronzeTableLTE applies the LTE predicate on the "bronze_table" field.
+func BronzeTableLTE(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLTE(FieldBronzeTable, v))
+}
+
+// BronzeTableContains applies the Contains predicate on the "bronze_table" field.
+func BronzeTableContains(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldContains(FieldBronzeTable, v))
+}
+
+// BronzeTableHasPrefix applies the HasPrefix predicate on the "bronze_table" field.
+func BronzeTableHasPrefix(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldHasPrefix(FieldBronzeTable, v))
+}
+
+// BronzeTableHasSuffix applies the HasSuffix predicate on the "bronze_table" field.
+func BronzeTableHasSuffix(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldHasSuffix(FieldBronzeTable, v))
+}
+
+// BronzeTableEqualFold applies the EqualFold predicate on the "bronze_table" field.
+func BronzeTableEqualFold(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEqualFold(FieldBronzeTable, v))
+}
+
+// BronzeTableContainsFold applies the ContainsFold predicate on the "bronze_table" field.
+func BronzeTableContainsFold(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldContainsFold(FieldBronzeTable, v))
+}
+
+// BronzeResourceIDEQ applies the EQ predicate on the "bronze_resource_id" field.
+func BronzeResourceIDEQ(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDNEQ applies the NEQ predicate on the "bronze_resource_id" field.
+func BronzeResourceIDNEQ(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNEQ(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDIn applies the In predicate on the "bronze_resource_id" field.
+func BronzeResourceIDIn(vs ...string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldIn(FieldBronzeResourceID, vs...))
+}
+
+// BronzeResourceIDNotIn applies the NotIn predicate on the "bronze_resource_id" field.
+func BronzeResourceIDNotIn(vs ...string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNotIn(FieldBronzeResourceID, vs...))
+}
+
+// BronzeResourceIDGT applies the GT predicate on the "bronze_resource_id" field.
+func BronzeResourceIDGT(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGT(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDGTE applies the GTE predicate on the "bronze_resource_id" field.
+func BronzeResourceIDGTE(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGTE(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDLT applies the LT predicate on the "bronze_resource_id" field.
+func BronzeResourceIDLT(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLT(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDLTE applies the LTE predicate on the "bronze_resource_id" field.
+func BronzeResourceIDLTE(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLTE(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDContains applies the Contains predicate on the "bronze_resource_id" field.
+func BronzeResourceIDContains(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldContains(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDHasPrefix applies the HasPrefix predicate on the "bronze_resource_id" field.
+func BronzeResourceIDHasPrefix(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldHasPrefix(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDHasSuffix applies the HasSuffix predicate on the "bronze_resource_id" field.
+func BronzeResourceIDHasSuffix(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldHasSuffix(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDEqualFold applies the EqualFold predicate on the "bronze_resource_id" field.
+func BronzeResourceIDEqualFold(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEqualFold(FieldBronzeResourceID, v))
+}
+
+// BronzeResourceIDContainsFold applies the ContainsFold predicate on the "bronze_resource_id" field.
+func BronzeResourceIDContainsFold(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldContainsFold(FieldBronzeResourceID, v))
+}
+
+// RegistryEQ applies the EQ predicate on the "registry" field.
+func RegistryEQ(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldRegistry, v))
+}
+
+// RegistryNEQ applies the NEQ predicate on the "registry" field.
+func RegistryNEQ(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNEQ(FieldRegistry, v))
+}
+
+// RegistryIn applies the In predicate on the "registry" field.
+func RegistryIn(vs ...string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldIn(FieldRegistry, vs...))
+}
+
+// RegistryNotIn applies the NotIn predicate on the "registry" field.
+func RegistryNotIn(vs ...string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNotIn(FieldRegistry, vs...))
+}
+
+// RegistryGT applies the GT predicate on the "registry" field.
+func RegistryGT(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGT(FieldRegistry, v))
+}
+
+// RegistryGTE applies the GTE predicate on the "registry" field.
+func RegistryGTE(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGTE(FieldRegistry, v))
+}
+
+// RegistryLT applies the LT predicate on the "registry" field.
+func RegistryLT(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLT(FieldRegistry, v))
+}
+
+// RegistryLTE applies the LTE predicate on the "registry" field.
+func RegistryLTE(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLTE(FieldRegistry, v))
+}
+
+// RegistryContains applies the Contains predicate on the "registry" field.
+func RegistryContains(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldContains(FieldRegistry, v))
+}
+
+// RegistryHasPrefix applies the HasPrefix predicate on the "registry" field.
+func RegistryHasPrefix(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldHasPrefix(FieldRegistry, v))
+}
+
+// RegistryHasSuffix applies the HasSuffix predicate on the "registry" field.
+func RegistryHasSuffix(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldHasSuffix(FieldRegistry, v))
+}
+
+// RegistryEqualFold applies the EqualFold predicate on the "registry" field.
+func RegistryEqualFold(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEqualFold(FieldRegistry, v))
+}
+
+// RegistryContainsFold applies the ContainsFold predicate on the "registry" field.
+func RegistryContainsFold(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldContainsFold(FieldRegistry, v))
+}
+
+// RepositoryEQ applies the EQ predicate on the "repository" field.
+func RepositoryEQ(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldRepository, v))
+}
+
+// RepositoryNEQ applies the NEQ predicate on the "repository" field.
+func RepositoryNEQ(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNEQ(FieldRepository, v))
+}
+
+// RepositoryIn applies the In predicate on the "repository" field.
+func RepositoryIn(vs ...string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldIn(FieldRepository, vs...))
+}
+
+// RepositoryNotIn applies the NotIn predicate on the "repository" field.
+func RepositoryNotIn(vs ...string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNotIn(FieldRepository, vs...))
+}
+
+// RepositoryGT applies the GT predicate on the "repository" field.
+func RepositoryGT(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGT(FieldRepository, v))
+}
+
+// RepositoryGTE applies the GTE predicate on the "repository" field.
+func RepositoryGTE(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGTE(FieldRepository, v))
+}
+
+// RepositoryLT applies the LT predicate on the "repository" field.
+func RepositoryLT(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLT(FieldRepository, v))
+}
+
+// RepositoryLTE applies the LTE predicate on the "repository" field.
+func RepositoryLTE(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLTE(FieldRepository, v))
+}
+
+// RepositoryContains applies the Contains predicate on the "repository" field.
+func RepositoryContains(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldContains(FieldRepository, v))
+}
+
+// RepositoryHasPrefix applies the HasPrefix predicate on the "repository" field.
+func RepositoryHasPrefix(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldHasPrefix(FieldRepository, v))
+}
+
+// RepositoryHasSuffix applies the HasSuffix predicate on the "repository" field.
+func RepositoryHasSuffix(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldHasSuffix(FieldRepository, v))
+}
+
+// RepositoryEqualFold applies the EqualFold predicate on the "repository" field.
+func RepositoryEqualFold(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEqualFold(FieldRepository, v))
+}
+
+// RepositoryContainsFold applies the ContainsFold predicate on the "repository" field.
+func RepositoryContainsFold(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldContainsFold(FieldRepository, v))
+}
+
+// DigestEQ applies the EQ predicate on the "digest" field.
+func DigestEQ(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldDigest, v))
+}
+
+// DigestNEQ applies the NEQ predicate on the "digest" field.
+func DigestNEQ(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNEQ(FieldDigest, v))
+}
+
+// DigestIn applies the In predicate on the "digest" field.
+func DigestIn(vs ...string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldIn(FieldDigest, vs...))
+}
+
+// DigestNotIn applies the NotIn predicate on the "digest" field.
+func DigestNotIn(vs ...string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNotIn(FieldDigest, vs...))
+}
+
+// DigestGT applies the GT predicate on the "digest" field.
+func DigestGT(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGT(FieldDigest, v))
+}
+
+// DigestGTE applies the GTE predicate on the "digest" field.
+func DigestGTE(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGTE(FieldDigest, v))
+}
+
+// DigestLT applies the LT predicate on the "digest" field.
+func DigestLT(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLT(FieldDigest, v))
+}
+
+// DigestLTE applies the LTE predicate on the "digest" field.
+func DigestLTE(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLTE(FieldDigest, v))
+}
+
+// DigestContains applies the Contains predicate on the "digest" field.
+func DigestContains(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldContains(FieldDigest, v))
+}
+
+// DigestHasPrefix applies the HasPrefix predicate on the "digest" field.
+func DigestHasPrefix(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldHasPrefix(FieldDigest, v))
+}
+
+// DigestHasSuffix applies the HasSuffix predicate on the "digest" field.
+func DigestHasSuffix(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldHasSuffix(FieldDigest, v))
+}
+
+// DigestIsNil applies the IsNil predicate on the "digest" field.
+func DigestIsNil() predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldIsNull(FieldDigest))
+}
+
+// DigestNotNil applies the NotNil predicate on the "digest" field.
+func DigestNotNil() predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNotNull(FieldDigest))
+}
+
+// DigestEqualFold applies the EqualFold predicate on the "digest" field.
+func DigestEqualFold(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEqualFold(FieldDigest, v))
+}
+
+// DigestContainsFold applies the ContainsFold predicate on the "digest" field.
+func DigestContainsFold(v string) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldContainsFold(FieldDigest, v))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// WorkloadsJSONIsNil applies the IsNil predicate on the "workloads_json" field.
+func WorkloadsJSONIsNil() predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldIsNull(FieldWorkloadsJSON))
+}
+
+// WorkloadsJSONNotNil applies the NotNil predicate on the "workloads_json" field.
+func WorkloadsJSONNotNil() predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNotNull(FieldWorkloadsJSON))
+}
+
+// VulnerabilitiesJSONIsNil applies the IsNil predicate on the "vulnerabilities_json" field.
+func VulnerabilitiesJSONIsNil() predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldIsNull(FieldVulnerabilitiesJSON))
+}
+
+// VulnerabilitiesJSONNotNil applies the NotNil predicate on the "vulnerabilities_json" field.
+func VulnerabilitiesJSONNotNil() predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNotNull(FieldVulnerabilitiesJSON))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// NormalizedAtEQ applies the EQ predicate on the "normalized_at" field.
+func NormalizedAtEQ(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldEQ(FieldNormalizedAt, v))
+}
+
+// NormalizedAtNEQ applies the NEQ predicate on the "normalized_at" field.
+func NormalizedAtNEQ(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNEQ(FieldNormalizedAt, v))
+}
+
+// NormalizedAtIn applies the In predicate on the "normalized_at" field.
+func NormalizedAtIn(vs ...time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldIn(FieldNormalizedAt, vs...))
+}
+
+// NormalizedAtNotIn applies the NotIn predicate on the "normalized_at" field.
+func NormalizedAtNotIn(vs ...time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldNotIn(FieldNormalizedAt, vs...))
+}
+
+// NormalizedAtGT applies the GT predicate on the "normalized_at" field.
+func NormalizedAtGT(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGT(FieldNormalizedAt, v))
+}
+
+// NormalizedAtGTE applies the GTE predicate on the "normalized_at" field.
+func NormalizedAtGTE(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldGTE(FieldNormalizedAt, v))
+}
+
+// NormalizedAtLT applies the LT predicate on the "normalized_at" field.
+func NormalizedAtLT(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLT(FieldNormalizedAt, v))
+}
+
+// NormalizedAtLTE applies the LTE predicate on the "normalized_at" field.
+func NormalizedAtLTE(v time.Time) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.FieldLTE(FieldNormalizedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InventoryImageNormalized) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InventoryImageNormalized) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InventoryImageNormalized) predicate.InventoryImageNormalized {
+	return predicate.InventoryImageNormalized(sql.NotPredicates(p))
+}
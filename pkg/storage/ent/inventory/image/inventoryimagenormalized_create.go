@@ -0,0 +1,374 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagenormalized"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryImageNormalizedCreate is the builder for creating a InventoryImageNormalized entity.
+type InventoryImageNormalizedCreate struct {
+	config
+	mutation *InventoryImageNormalizedMutation
+	hooks    []Hook
+}
+
+// SetProvider sets the "provider" field.
+func (_c *InventoryImageNormalizedCreate) SetProvider(v string) *InventoryImageNormalizedCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetIsBase sets the "is_base" field.
+func (_c *InventoryImageNormalizedCreate) SetIsBase(v bool) *InventoryImageNormalizedCreate {
+	_c.mutation.SetIsBase(v)
+	return _c
+}
+
+// SetBronzeTable sets the "bronze_table" field.
+func (_c *InventoryImageNormalizedCreate) SetBronzeTable(v string) *InventoryImageNormalizedCreate {
+	_c.mutation.SetBronzeTable(v)
+	return _c
+}
+
+// SetBronzeResourceID sets the "bronze_resource_id" field.
+func (_c *InventoryImageNormalizedCreate) SetBronzeResourceID(v string) *InventoryImageNormalizedCreate {
+	_c.mutation.SetBronzeResourceID(v)
+	return _c
+}
+
+// SetRegistry sets the "registry" field.
+func (_c *InventoryImageNormalizedCreate) SetRegistry(v string) *InventoryImageNormalizedCreate {
+	_c.mutation.SetRegistry(v)
+	return _c
+}
+
+// SetRepository sets the "repository" field.
+func (_c *InventoryImageNormalizedCreate) SetRepository(v string) *InventoryImageNormalizedCreate {
+	_c.mutation.SetRepository(v)
+	return _c
+}
+
+// SetDigest sets the "digest" field.
+func (_c *InventoryImageNormalizedCreate) SetDigest(v string) *InventoryImageNormalizedCreate {
+	_c.mutation.SetDigest(v)
+	return _c
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (_c *InventoryImageNormalizedCreate) SetNillableDigest(v *string) *InventoryImageNormalizedCreate {
+	if v != nil {
+		_c.SetDigest(*v)
+	}
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *InventoryImageNormalizedCreate) SetTagsJSON(v []string) *InventoryImageNormalizedCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetWorkloadsJSON sets the "workloads_json" field.
+func (_c *InventoryImageNormalizedCreate) SetWorkloadsJSON(v []string) *InventoryImageNormalizedCreate {
+	_c.mutation.SetWorkloadsJSON(v)
+	return _c
+}
+
+// SetVulnerabilitiesJSON sets the "vulnerabilities_json" field.
+func (_c *InventoryImageNormalizedCreate) SetVulnerabilitiesJSON(v json.RawMessage) *InventoryImageNormalizedCreate {
+	_c.mutation.SetVulnerabilitiesJSON(v)
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *InventoryImageNormalizedCreate) SetCollectedAt(v time.Time) *InventoryImageNormalizedCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *InventoryImageNormalizedCreate) SetFirstCollectedAt(v time.Time) *InventoryImageNormalizedCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetNormalizedAt sets the "normalized_at" field.
+func (_c *InventoryImageNormalizedCreate) SetNormalizedAt(v time.Time) *InventoryImageNormalizedCreate {
+	_c.mutation.SetNormalizedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *InventoryImageNormalizedCreate) SetID(v string) *InventoryImageNormalizedCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the InventoryImageNormalizedMutation object of the builder.
+func (_c *InventoryImageNormalizedCreate) Mutation() *InventoryImageNormalizedMutation {
+	return _c.mutation
+}
+
+// Save creates the InventoryImageNormalized in the database.
+func (_c *InventoryImageNormalizedCreate) Save(ctx context.Context) (*InventoryImageNormalized, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InventoryImageNormalizedCreate) SaveX(ctx context.Context) *InventoryImageNormalized {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InventoryImageNormalizedCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InventoryImageNormalizedCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InventoryImageNormalizedCreate) check() error {
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`image: missing required field "InventoryImageNormalized.provider"`)}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := inventoryimagenormalized.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`image: validator failed for field "InventoryImageNormalized.provider": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsBase(); !ok {
+		return &ValidationError{Name: "is_base", err: errors.New(`image: missing required field "InventoryImageNormalized.is_base"`)}
+	}
+	if _, ok := _c.mutation.BronzeTable(); !ok {
+		return &ValidationError{Name: "bronze_table", err: errors.New(`image: missing required field "InventoryImageNormalized.bronze_table"`)}
+	}
+	if v, ok := _c.mutation.BronzeTable(); ok {
+		if err := inventoryimagenormalized.BronzeTableValidator(v); err != nil {
+			return &ValidationError{Name: "bronze_table", err: fmt.Errorf(`image: validator failed for field "InventoryImageNormalized.bronze_table": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BronzeResourceID(); !ok {
+		return &ValidationError{Name: "bronze_resource_id", err: errors.New(`image: missing required field "InventoryImageNormalized.bronze_resource_id"`)}
+	}
+	if v, ok := _c.mutation.BronzeResourceID(); ok {
+		if err := inventoryimagenormalized.BronzeResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "bronze_resource_id", err: fmt.Errorf(`image: validator failed for field "InventoryImageNormalized.bronze_resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Registry(); !ok {
+		return &ValidationError{Name: "registry", err: errors.New(`image: missing required field "InventoryImageNormalized.registry"`)}
+	}
+	if v, ok := _c.mutation.Registry(); ok {
+		if err := inventoryimagenormalized.RegistryValidator(v); err != nil {
+			return &ValidationError{Name: "registry", err: fmt.Errorf(`image: validator failed for field "InventoryImageNormalized.registry": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Repository(); !ok {
+		return &ValidationError{Name: "repository", err: errors.New(`image: missing required field "InventoryImageNormalized.repository"`)}
+	}
+	if v, ok := _c.mutation.Repository(); ok {
+		if err := inventoryimagenormalized.RepositoryValidator(v); err != nil {
+			return &ValidationError{Name: "repository", err: fmt.Errorf(`image: validator failed for field "InventoryImageNormalized.repository": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`image: missing required field "InventoryImageNormalized.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`image: missing required field "InventoryImageNormalized.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.NormalizedAt(); !ok {
+		return &ValidationError{Name: "normalized_at", err: errors.New(`image: missing required field "InventoryImageNormalized.normalized_at"`)}
+	}
+	return nil
+}
+
+func (_c *InventoryImageNormalizedCreate) sqlSave(ctx context.Context) (*InventoryImageNormalized, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected InventoryImageNormalized.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InventoryImageNormalizedCreate) createSpec() (*InventoryImageNormalized, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InventoryImageNormalized{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(inventoryimagenormalized.Table, sqlgraph.NewFieldSpec(inventoryimagenormalized.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.InventoryImageNormalized
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.IsBase(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldIsBase, field.TypeBool, value)
+		_node.IsBase = value
+	}
+	if value, ok := _c.mutation.BronzeTable(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldBronzeTable, field.TypeString, value)
+		_node.BronzeTable = value
+	}
+	if value, ok := _c.mutation.BronzeResourceID(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldBronzeResourceID, field.TypeString, value)
+		_node.BronzeResourceID = value
+	}
+	if value, ok := _c.mutation.Registry(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldRegistry, field.TypeString, value)
+		_node.Registry = value
+	}
+	if value, ok := _c.mutation.Repository(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldRepository, field.TypeString, value)
+		_node.Repository = value
+	}
+	if value, ok := _c.mutation.Digest(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldDigest, field.TypeString, value)
+		_node.Digest = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.WorkloadsJSON(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldWorkloadsJSON, field.TypeJSON, value)
+		_node.WorkloadsJSON = value
+	}
+	if value, ok := _c.mutation.VulnerabilitiesJSON(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldVulnerabilitiesJSON, field.TypeJSON, value)
+		_node.VulnerabilitiesJSON = value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.NormalizedAt(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldNormalizedAt, field.TypeTime, value)
+		_node.NormalizedAt = value
+	}
+	return _node, _spec
+}
+
+// InventoryImageNormalizedCreateBulk is the builder for creating many InventoryImageNormalized entities in bulk.
+type InventoryImageNormalizedCreateBulk struct {
+	config
+	err      error
+	builders []*InventoryImageNormalizedCreate
+}
+
+// Save creates the InventoryImageNormalized entities in the database.
+func (_c *InventoryImageNormalizedCreateBulk) Save(ctx context.Context) ([]*InventoryImageNormalized, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InventoryImageNormalized, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InventoryImageNormalizedMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InventoryImageNormalizedCreateBulk) SaveX(ctx context.Context) []*InventoryImageNormalized {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InventoryImageNormalizedCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InventoryImageNormalizedCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
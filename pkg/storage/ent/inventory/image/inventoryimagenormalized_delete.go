@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/internal"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagenormalized"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryImageNormalizedDelete is the builder for deleting a InventoryImageNormalized entity.
+type InventoryImageNormalizedDelete struct {
+	config
+	hooks    []Hook
+	mutation *InventoryImageNormalizedMutation
+}
+
+// Where appends a list predicates to the InventoryImageNormalizedDelete builder.
+func (_d *InventoryImageNormalizedDelete) Where(ps ...predicate.InventoryImageNormalized) *InventoryImageNormalizedDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *InventoryImageNormalizedDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *InventoryImageNormalizedDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *InventoryImageNormalizedDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(inventoryimagenormalized.Table, sqlgraph.NewFieldSpec(inventoryimagenormalized.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.InventoryImageNormalized
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// InventoryImageNormalizedDeleteOne is the builder for deleting a single InventoryImageNormalized entity.
+type InventoryImageNormalizedDeleteOne struct {
+	_d *InventoryImageNormalizedDelete
+}
+
+// Where appends a list predicates to the InventoryImageNormalizedDelete builder.
+func (_d *InventoryImageNormalizedDeleteOne) Where(ps ...predicate.InventoryImageNormalized) *InventoryImageNormalizedDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *InventoryImageNormalizedDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{inventoryimagenormalized.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *InventoryImageNormalizedDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
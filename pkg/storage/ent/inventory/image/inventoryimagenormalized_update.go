@@ -0,0 +1,781 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/internal"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagenormalized"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryImageNormalizedUpdate is the builder for updating InventoryImageNormalized entities.
+type InventoryImageNormalizedUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InventoryImageNormalizedMutation
+}
+
+// Where appends a list predicates to the InventoryImageNormalizedUpdate builder.
+func (_u *InventoryImageNormalizedUpdate) Where(ps ...predicate.InventoryImageNormalized) *InventoryImageNormalizedUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *InventoryImageNormalizedUpdate) SetProvider(v string) *InventoryImageNormalizedUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdate) SetNillableProvider(v *string) *InventoryImageNormalizedUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetIsBase sets the "is_base" field.
+func (_u *InventoryImageNormalizedUpdate) SetIsBase(v bool) *InventoryImageNormalizedUpdate {
+	_u.mutation.SetIsBase(v)
+	return _u
+}
+
+// SetNillableIsBase sets the "is_base" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdate) SetNillableIsBase(v *bool) *InventoryImageNormalizedUpdate {
+	if v != nil {
+		_u.SetIsBase(*v)
+	}
+	return _u
+}
+
+// SetBronzeTable sets the "bronze_table" field.
+func (_u *InventoryImageNormalizedUpdate) SetBronzeTable(v string) *InventoryImageNormalizedUpdate {
+	_u.mutation.SetBronzeTable(v)
+	return _u
+}
+
+// SetNillableBronzeTable sets the "bronze_table" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdate) SetNillableBronzeTable(v *string) *InventoryImageNormalizedUpdate {
+	if v != nil {
+		_u.SetBronzeTable(*v)
+	}
+	return _u
+}
+
+// SetBronzeResourceID sets the "bronze_resource_id" field.
+func (_u *InventoryImageNormalizedUpdate) SetBronzeResourceID(v string) *InventoryImageNormalizedUpdate {
+	_u.mutation.SetBronzeResourceID(v)
+	return _u
+}
+
+// SetNillableBronzeResourceID sets the "bronze_resource_id" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdate) SetNillableBronzeResourceID(v *string) *InventoryImageNormalizedUpdate {
+	if v != nil {
+		_u.SetBronzeResourceID(*v)
+	}
+	return _u
+}
+
+// SetRegistry sets the "registry" field.
+func (_u *InventoryImageNormalizedUpdate) SetRegistry(v string) *InventoryImageNormalizedUpdate {
+	_u.mutation.SetRegistry(v)
+	return _u
+}
+
+// SetNillableRegistry sets the "registry" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdate) SetNillableRegistry(v *string) *InventoryImageNormalizedUpdate {
+	if v != nil {
+		_u.SetRegistry(*v)
+	}
+	return _u
+}
+
+// SetRepository sets the "repository" field.
+func (_u *InventoryImageNormalizedUpdate) SetRepository(v string) *InventoryImageNormalizedUpdate {
+	_u.mutation.SetRepository(v)
+	return _u
+}
+
+// SetNillableRepository sets the "repository" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdate) SetNillableRepository(v *string) *InventoryImageNormalizedUpdate {
+	if v != nil {
+		_u.SetRepository(*v)
+	}
+	return _u
+}
+
+// SetDigest sets the "digest" field.
+func (_u *InventoryImageNormalizedUpdate) SetDigest(v string) *InventoryImageNormalizedUpdate {
+	_u.mutation.SetDigest(v)
+	return _u
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdate) SetNillableDigest(v *string) *InventoryImageNormalizedUpdate {
+	if v != nil {
+		_u.SetDigest(*v)
+	}
+	return _u
+}
+
+// ClearDigest clears the value of the "digest" field.
+func (_u *InventoryImageNormalizedUpdate) ClearDigest() *InventoryImageNormalizedUpdate {
+	_u.mutation.ClearDigest()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *InventoryImageNormalizedUpdate) SetTagsJSON(v []string) *InventoryImageNormalizedUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *InventoryImageNormalizedUpdate) AppendTagsJSON(v []string) *InventoryImageNormalizedUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *InventoryImageNormalizedUpdate) ClearTagsJSON() *InventoryImageNormalizedUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetWorkloadsJSON sets the "workloads_json" field.
+func (_u *InventoryImageNormalizedUpdate) SetWorkloadsJSON(v []string) *InventoryImageNormalizedUpdate {
+	_u.mutation.SetWorkloadsJSON(v)
+	return _u
+}
+
+// AppendWorkloadsJSON appends value to the "workloads_json" field.
+func (_u *InventoryImageNormalizedUpdate) AppendWorkloadsJSON(v []string) *InventoryImageNormalizedUpdate {
+	_u.mutation.AppendWorkloadsJSON(v)
+	return _u
+}
+
+// ClearWorkloadsJSON clears the value of the "workloads_json" field.
+func (_u *InventoryImageNormalizedUpdate) ClearWorkloadsJSON() *InventoryImageNormalizedUpdate {
+	_u.mutation.ClearWorkloadsJSON()
+	return _u
+}
+
+// SetVulnerabilitiesJSON sets the "vulnerabilities_json" field.
+func (_u *InventoryImageNormalizedUpdate) SetVulnerabilitiesJSON(v json.RawMessage) *InventoryImageNormalizedUpdate {
+	_u.mutation.SetVulnerabilitiesJSON(v)
+	return _u
+}
+
+// AppendVulnerabilitiesJSON appends value to the "vulnerabilities_json" field.
+func (_u *InventoryImageNormalizedUpdate) AppendVulnerabilitiesJSON(v json.RawMessage) *InventoryImageNormalizedUpdate {
+	_u.mutation.AppendVulnerabilitiesJSON(v)
+	return _u
+}
+
+// ClearVulnerabilitiesJSON clears the value of the "vulnerabilities_json" field.
+func (_u *InventoryImageNormalizedUpdate) ClearVulnerabilitiesJSON() *InventoryImageNormalizedUpdate {
+	_u.mutation.ClearVulnerabilitiesJSON()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *InventoryImageNormalizedUpdate) SetCollectedAt(v time.Time) *InventoryImageNormalizedUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdate) SetNillableCollectedAt(v *time.Time) *InventoryImageNormalizedUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *InventoryImageNormalizedUpdate) SetFirstCollectedAt(v time.Time) *InventoryImageNormalizedUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdate) SetNillableFirstCollectedAt(v *time.Time) *InventoryImageNormalizedUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetNormalizedAt sets the "normalized_at" field.
+func (_u *InventoryImageNormalizedUpdate) SetNormalizedAt(v time.Time) *InventoryImageNormalizedUpdate {
+	_u.mutation.SetNormalizedAt(v)
+	return _u
+}
+
+// SetNillableNormalizedAt sets the "normalized_at" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdate) SetNillableNormalizedAt(v *time.Time) *InventoryImageNormalizedUpdate {
+	if v != nil {
+		_u.SetNormalizedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the InventoryImageNormalizedMutation object of the builder.
+func (_u *InventoryImageNormalizedUpdate) Mutation() *InventoryImageNormalizedMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InventoryImageNormalizedUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InventoryImageNormalizedUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InventoryImageNormalizedUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InventoryImageNormalizedUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InventoryImageNormalizedUpdate) check() error {
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := inventoryimagenormalized.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`image: validator failed for field "InventoryImageNormalized.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BronzeTable(); ok {
+		if err := inventoryimagenormalized.BronzeTableValidator(v); err != nil {
+			return &ValidationError{Name: "bronze_table", err: fmt.Errorf(`image: validator failed for field "InventoryImageNormalized.bronze_table": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BronzeResourceID(); ok {
+		if err := inventoryimagenormalized.BronzeResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "bronze_resource_id", err: fmt.Errorf(`image: validator failed for field "InventoryImageNormalized.bronze_resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Registry(); ok {
+		if err := inventoryimagenormalized.RegistryValidator(v); err != nil {
+			return &ValidationError{Name: "registry", err: fmt.Errorf(`image: validator failed for field "InventoryImageNormalized.registry": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Repository(); ok {
+		if err := inventoryimagenormalized.RepositoryValidator(v); err != nil {
+			return &ValidationError{Name: "repository", err: fmt.Errorf(`image: validator failed for field "InventoryImageNormalized.repository": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InventoryImageNormalizedUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inventoryimagenormalized.Table, inventoryimagenormalized.Columns, sqlgraph.NewFieldSpec(inventoryimagenormalized.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsBase(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldIsBase, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BronzeTable(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldBronzeTable, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BronzeResourceID(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldBronzeResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Registry(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldRegistry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Repository(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldRepository, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Digest(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldDigest, field.TypeString, value)
+	}
+	if _u.mutation.DigestCleared() {
+		_spec.ClearField(inventoryimagenormalized.FieldDigest, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inventoryimagenormalized.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(inventoryimagenormalized.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.WorkloadsJSON(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldWorkloadsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWorkloadsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inventoryimagenormalized.FieldWorkloadsJSON, value)
+		})
+	}
+	if _u.mutation.WorkloadsJSONCleared() {
+		_spec.ClearField(inventoryimagenormalized.FieldWorkloadsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.VulnerabilitiesJSON(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldVulnerabilitiesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVulnerabilitiesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inventoryimagenormalized.FieldVulnerabilitiesJSON, value)
+		})
+	}
+	if _u.mutation.VulnerabilitiesJSONCleared() {
+		_spec.ClearField(inventoryimagenormalized.FieldVulnerabilitiesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.NormalizedAt(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldNormalizedAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.InventoryImageNormalized
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inventoryimagenormalized.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InventoryImageNormalizedUpdateOne is the builder for updating a single InventoryImageNormalized entity.
+type InventoryImageNormalizedUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InventoryImageNormalizedMutation
+}
+
+// SetProvider sets the "provider" field.
+func (_u *InventoryImageNormalizedUpdateOne) SetProvider(v string) *InventoryImageNormalizedUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdateOne) SetNillableProvider(v *string) *InventoryImageNormalizedUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetIsBase sets the "is_base" field.
+func (_u *InventoryImageNormalizedUpdateOne) SetIsBase(v bool) *InventoryImageNormalizedUpdateOne {
+	_u.mutation.SetIsBase(v)
+	return _u
+}
+
+// SetNillableIsBase sets the "is_base" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdateOne) SetNillableIsBase(v *bool) *InventoryImageNormalizedUpdateOne {
+	if v != nil {
+		_u.SetIsBase(*v)
+	}
+	return _u
+}
+
+// SetBronzeTable sets the "bronze_table" field.
+func (_u *InventoryImageNormalizedUpdateOne) SetBronzeTable(v string) *InventoryImageNormalizedUpdateOne {
+	_u.mutation.SetBronzeTable(v)
+	return _u
+}
+
+// SetNillableBronzeTable sets the "bronze_table" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdateOne) SetNillableBronzeTable(v *string) *InventoryImageNormalizedUpdateOne {
+	if v != nil {
+		_u.SetBronzeTable(*v)
+	}
+	return _u
+}
+
+// SetBronzeResourceID sets the "bronze_resource_id" field.
+func (_u *InventoryImageNormalizedUpdateOne) SetBronzeResourceID(v string) *InventoryImageNormalizedUpdateOne {
+	_u.mutation.SetBronzeResourceID(v)
+	return _u
+}
+
+// SetNillableBronzeResourceID sets the "bronze_resource_id" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdateOne) SetNillableBronzeResourceID(v *string) *InventoryImageNormalizedUpdateOne {
+	if v != nil {
+		_u.SetBronzeResourceID(*v)
+	}
+	return _u
+}
+
+// SetRegistry sets the "registry" field.
+func (_u *InventoryImageNormalizedUpdateOne) SetRegistry(v string) *InventoryImageNormalizedUpdateOne {
+	_u.mutation.SetRegistry(v)
+	return _u
+}
+
+// SetNillableRegistry sets the "registry" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdateOne) SetNillableRegistry(v *string) *InventoryImageNormalizedUpdateOne {
+	if v != nil {
+		_u.SetRegistry(*v)
+	}
+	return _u
+}
+
+// SetRepository sets the "repository" field.
+func (_u *InventoryImageNormalizedUpdateOne) SetRepository(v string) *InventoryImageNormalizedUpdateOne {
+	_u.mutation.SetRepository(v)
+	return _u
+}
+
+// SetNillableRepository sets the "repository" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdateOne) SetNillableRepository(v *string) *InventoryImageNormalizedUpdateOne {
+	if v != nil {
+		_u.SetRepository(*v)
+	}
+	return _u
+}
+
+// SetDigest sets the "digest" field.
+func (_u *InventoryImageNormalizedUpdateOne) SetDigest(v string) *InventoryImageNormalizedUpdateOne {
+	_u.mutation.SetDigest(v)
+	return _u
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdateOne) SetNillableDigest(v *string) *InventoryImageNormalizedUpdateOne {
+	if v != nil {
+		_u.SetDigest(*v)
+	}
+	return _u
+}
+
+// ClearDigest clears the value of the "digest" field.
+func (_u *InventoryImageNormalizedUpdateOne) ClearDigest() *InventoryImageNormalizedUpdateOne {
+	_u.mutation.ClearDigest()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *InventoryImageNormalizedUpdateOne) SetTagsJSON(v []string) *InventoryImageNormalizedUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *InventoryImageNormalizedUpdateOne) AppendTagsJSON(v []string) *InventoryImageNormalizedUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *InventoryImageNormalizedUpdateOne) ClearTagsJSON() *InventoryImageNormalizedUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetWorkloadsJSON sets the "workloads_json" field.
+func (_u *InventoryImageNormalizedUpdateOne) SetWorkloadsJSON(v []string) *InventoryImageNormalizedUpdateOne {
+	_u.mutation.SetWorkloadsJSON(v)
+	return _u
+}
+
+// AppendWorkloadsJSON appends value to the "workloads_json" field.
+func (_u *InventoryImageNormalizedUpdateOne) AppendWorkloadsJSON(v []string) *InventoryImageNormalizedUpdateOne {
+	_u.mutation.AppendWorkloadsJSON(v)
+	return _u
+}
+
+// ClearWorkloadsJSON clears the value of the "workloads_json" field.
+func (_u *InventoryImageNormalizedUpdateOne) ClearWorkloadsJSON() *InventoryImageNormalizedUpdateOne {
+	_u.mutation.ClearWorkloadsJSON()
+	return _u
+}
+
+// SetVulnerabilitiesJSON sets the "vulnerabilities_json" field.
+func (_u *InventoryImageNormalizedUpdateOne) SetVulnerabilitiesJSON(v json.RawMessage) *InventoryImageNormalizedUpdateOne {
+	_u.mutation.SetVulnerabilitiesJSON(v)
+	return _u
+}
+
+// AppendVulnerabilitiesJSON appends value to the "vulnerabilities_json" field.
+func (_u *InventoryImageNormalizedUpdateOne) AppendVulnerabilitiesJSON(v json.RawMessage) *InventoryImageNormalizedUpdateOne {
+	_u.mutation.AppendVulnerabilitiesJSON(v)
+	return _u
+}
+
+// ClearVulnerabilitiesJSON clears the value of the "vulnerabilities_json" field.
+func (_u *InventoryImageNormalizedUpdateOne) ClearVulnerabilitiesJSON() *InventoryImageNormalizedUpdateOne {
+	_u.mutation.ClearVulnerabilitiesJSON()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *InventoryImageNormalizedUpdateOne) SetCollectedAt(v time.Time) *InventoryImageNormalizedUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdateOne) SetNillableCollectedAt(v *time.Time) *InventoryImageNormalizedUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *InventoryImageNormalizedUpdateOne) SetFirstCollectedAt(v time.Time) *InventoryImageNormalizedUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *InventoryImageNormalizedUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetNormalizedAt sets the "normalized_at" field.
+func (_u *InventoryImageNormalizedUpdateOne) SetNormalizedAt(v time.Time) *InventoryImageNormalizedUpdateOne {
+	_u.mutation.SetNormalizedAt(v)
+	return _u
+}
+
+// SetNillableNormalizedAt sets the "normalized_at" field if the given value is not nil.
+func (_u *InventoryImageNormalizedUpdateOne) SetNillableNormalizedAt(v *time.Time) *InventoryImageNormalizedUpdateOne {
+	if v != nil {
+		_u.SetNormalizedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the InventoryImageNormalizedMutation object of the builder.
+func (_u *InventoryImageNormalizedUpdateOne) Mutation() *InventoryImageNormalizedMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the InventoryImageNormalizedUpdate builder.
+func (_u *InventoryImageNormalizedUpdateOne) Where(ps ...predicate.InventoryImageNormalized) *InventoryImageNormalizedUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InventoryImageNormalizedUpdateOne) Select(field string, fields ...string) *InventoryImageNormalizedUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InventoryImageNormalized entity.
+func (_u *InventoryImageNormalizedUpdateOne) Save(ctx context.Context) (*InventoryImageNormalized, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InventoryImageNormalizedUpdateOne) SaveX(ctx context.Context) *InventoryImageNormalized {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InventoryImageNormalizedUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InventoryImageNormalizedUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InventoryImageNormalizedUpdateOne) check() error {
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := inventoryimagenormalized.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`image: validator failed for field "InventoryImageNormalized.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BronzeTable(); ok {
+		if err := inventoryimagenormalized.BronzeTableValidator(v); err != nil {
+			return &ValidationError{Name: "bronze_table", err: fmt.Errorf(`image: validator failed for field "InventoryImageNormalized.bronze_table": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BronzeResourceID(); ok {
+		if err := inventoryimagenormalized.BronzeResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "bronze_resource_id", err: fmt.Errorf(`image: validator failed for field "InventoryImageNormalized.bronze_resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Registry(); ok {
+		if err := inventoryimagenormalized.RegistryValidator(v); err != nil {
+			return &ValidationError{Name: "registry", err: fmt.Errorf(`image: validator failed for field "InventoryImageNormalized.registry": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Repository(); ok {
+		if err := inventoryimagenormalized.RepositoryValidator(v); err != nil {
+			return &ValidationError{Name: "repository", err: fmt.Errorf(`image: validator failed for field "InventoryImageNormalized.repository": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InventoryImageNormalizedUpdateOne) sqlSave(ctx context.Context) (_node *InventoryImageNormalized, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inventoryimagenormalized.Table, inventoryimagenormalized.Columns, sqlgraph.NewFieldSpec(inventoryimagenormalized.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`image: missing "InventoryImageNormalized.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inventoryimagenormalized.FieldID)
+		for _, f := range fields {
+			if !inventoryimagenormalized.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("image: invalid field %q for query", f)}
+			}
+			if f != inventoryimagenormalized.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsBase(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldIsBase, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BronzeTable(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldBronzeTable, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BronzeResourceID(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldBronzeResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Registry(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldRegistry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Repository(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldRepository, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Digest(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldDigest, field.TypeString, value)
+	}
+	if _u.mutation.DigestCleared() {
+		_spec.ClearField(inventoryimagenormalized.FieldDigest, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inventoryimagenormalized.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(inventoryimagenormalized.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.WorkloadsJSON(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldWorkloadsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWorkloadsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inventoryimagenormalized.FieldWorkloadsJSON, value)
+		})
+	}
+	if _u.mutation.WorkloadsJSONCleared() {
+		_spec.ClearField(inventoryimagenormalized.FieldWorkloadsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.VulnerabilitiesJSON(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldVulnerabilitiesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVulnerabilitiesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inventoryimagenormalized.FieldVulnerabilitiesJSON, value)
+		})
+	}
+	if _u.mutation.VulnerabilitiesJSONCleared() {
+		_spec.ClearField(inventoryimagenormalized.FieldVulnerabilitiesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.NormalizedAt(); ok {
+		_spec.SetField(inventoryimagenormalized.FieldNormalizedAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.InventoryImageNormalized
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &InventoryImageNormalized{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inventoryimagenormalized.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,131 @@
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// InventoryImagesColumns holds the columns for the "inventory_images" table.
+	InventoryImagesColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "normalized_at", Type: field.TypeTime},
+		{Name: "registry", Type: field.TypeString},
+		{Name: "repository", Type: field.TypeString},
+		{Name: "digest", Type: field.TypeString, Default: ""},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "workloads_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "vulnerabilities_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "vulnerability_count", Type: field.TypeInt, Default: 0},
+		{Name: "max_severity", Type: field.TypeString, Nullable: true},
+	}
+	// InventoryImagesTable holds the schema information for the "inventory_images" table.
+	InventoryImagesTable = &schema.Table{
+		Name:       "inventory_images",
+		Columns:    InventoryImagesColumns,
+		PrimaryKey: []*schema.Column{InventoryImagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "inventoryimage_registry_repository_digest",
+				Unique:  true,
+				Columns: []*schema.Column{InventoryImagesColumns[4], InventoryImagesColumns[5], InventoryImagesColumns[6]},
+			},
+			{
+				Name:    "inventoryimage_max_severity",
+				Unique:  false,
+				Columns: []*schema.Column{InventoryImagesColumns[11]},
+			},
+			{
+				Name:    "inventoryimage_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{InventoryImagesColumns[1]},
+			},
+		},
+	}
+	// InventoryImageLinksColumns holds the columns for the "inventory_image_links" table.
+	InventoryImageLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "bronze_table", Type: field.TypeString},
+		{Name: "bronze_resource_id", Type: field.TypeString},
+		{Name: "inventory_image_bronze_links", Type: field.TypeString},
+	}
+	// InventoryImageLinksTable holds the schema information for the "inventory_image_links" table.
+	InventoryImageLinksTable = &schema.Table{
+		Name:       "inventory_image_links",
+		Columns:    InventoryImageLinksColumns,
+		PrimaryKey: []*schema.Column{InventoryImageLinksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "inventory_image_links_inventory_images_bronze_links",
+				Columns:    []*schema.Column{InventoryImageLinksColumns[4]},
+				RefColumns: []*schema.Column{InventoryImagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// InventoryImageNormalizedColumns holds the columns for the "inventory_image_normalized" table.
+	InventoryImageNormalizedColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "is_base", Type: field.TypeBool},
+		{Name: "bronze_table", Type: field.TypeString},
+		{Name: "bronze_resource_id", Type: field.TypeString},
+		{Name: "registry", Type: field.TypeString},
+		{Name: "repository", Type: field.TypeString},
+		{Name: "digest", Type: field.TypeString, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "workloads_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "vulnerabilities_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "normalized_at", Type: field.TypeTime},
+	}
+	// InventoryImageNormalizedTable holds the schema information for the "inventory_image_normalized" table.
+	InventoryImageNormalizedTable = &schema.Table{
+		Name:       "inventory_image_normalized",
+		Columns:    InventoryImageNormalizedColumns,
+		PrimaryKey: []*schema.Column{InventoryImageNormalizedColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "inventoryimagenormalized_provider",
+				Unique:  false,
+				Columns: []*schema.Column{InventoryImageNormalizedColumns[1]},
+			},
+			{
+				Name:    "inventoryimagenormalized_provider_bronze_resource_id",
+				Unique:  true,
+				Columns: []*schema.Column{InventoryImageNormalizedColumns[1], InventoryImageNormalizedColumns[4]},
+			},
+			{
+				Name:    "inventoryimagenormalized_registry_repository_digest",
+				Unique:  false,
+				Columns: []*schema.Column{InventoryImageNormalizedColumns[5], InventoryImageNormalizedColumns[6], InventoryImageNormalizedColumns[7]},
+			},
+		},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		InventoryImagesTable,
+		InventoryImageLinksTable,
+		InventoryImageNormalizedTable,
+	}
+)
+
+func init() {
+	InventoryImagesTable.Annotation = &entsql.Annotation{
+		Table: "inventory_images",
+	}
+	InventoryImageLinksTable.ForeignKeys[0].RefTable = InventoryImagesTable
+	InventoryImageLinksTable.Annotation = &entsql.Annotation{
+		Table: "inventory_image_links",
+	}
+	InventoryImageNormalizedTable.Annotation = &entsql.Annotation{
+		Table: "inventory_image_normalized",
+	}
+}
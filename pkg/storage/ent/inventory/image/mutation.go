@@ -0,0 +1,2775 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimage"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagebronzelink"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagenormalized"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/predicate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Operation types.
+	OpCreate    = ent.OpCreate
+	OpDelete    = ent.OpDelete
+	OpDeleteOne = ent.OpDeleteOne
+	OpUpdate    = ent.OpUpdate
+	OpUpdateOne = ent.OpUpdateOne
+
+	// Node types.
+	TypeInventoryImage           = "InventoryImage"
+	TypeInventoryImageBronzeLink = "InventoryImageBronzeLink"
+	TypeInventoryImageNormalized = "InventoryImageNormalized"
+)
+
+// InventoryImageMutation represents an operation that mutates the InventoryImage nodes in the graph.
+type InventoryImageMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *string
+	collected_at               *time.Time
+	first_collected_at         *time.Time
+	normalized_at              *time.Time
+	registry                   *string
+	repository                 *string
+	digest                     *string
+	tags_json                  *[]string
+	appendtags_json            []string
+	workloads_json             *[]string
+	appendworkloads_json       []string
+	vulnerabilities_json       *json.RawMessage
+	appendvulnerabilities_json json.RawMessage
+	vulnerability_count        *int
+	addvulnerability_count     *int
+	max_severity               *string
+	clearedFields              map[string]struct{}
+	bronze_links               map[int]struct{}
+	removedbronze_links        map[int]struct{}
+	clearedbronze_links        bool
+	done                       bool
+	oldValue                   func(context.Context) (*InventoryImage, error)
+	predicates                 []predicate.InventoryImage
+}
+
+var _ ent.Mutation = (*InventoryImageMutation)(nil)
+
+// inventoryimageOption allows management of the mutation configuration using functional options.
+type inventoryimageOption func(*InventoryImageMutation)
+
+// newInventoryImageMutation creates new mutation for the InventoryImage entity.
+func newInventoryImageMutation(c config, op Op, opts ...inventoryimageOption) *InventoryImageMutation {
+	m := &InventoryImageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInventoryImage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withInventoryImageID sets the ID field of the mutation.
+func withInventoryImageID(id string) inventoryimageOption {
+	return func(m *InventoryImageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *InventoryImage
+		)
+		m.oldValue = func(ctx context.Context) (*InventoryImage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().InventoryImage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withInventoryImage sets the old InventoryImage of the mutation.
+func withInventoryImage(node *InventoryImage) inventoryimageOption {
+	return func(m *InventoryImageMutation) {
+		m.oldValue = func(context.Context) (*InventoryImage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InventoryImageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InventoryImageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("image: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of InventoryImage entities.
+func (m *InventoryImageMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *InventoryImageMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *InventoryImageMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().InventoryImage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *InventoryImageMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *InventoryImageMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the InventoryImage entity.
+// If the InventoryImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *InventoryImageMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *InventoryImageMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *InventoryImageMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the InventoryImage entity.
+// If the InventoryImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *InventoryImageMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetNormalizedAt sets the "normalized_at" field.
+func (m *InventoryImageMutation) SetNormalizedAt(t time.Time) {
+	m.normalized_at = &t
+}
+
+// NormalizedAt returns the value of the "normalized_at" field in the mutation.
+func (m *InventoryImageMutation) NormalizedAt() (r time.Time, exists bool) {
+	v := m.normalized_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNormalizedAt returns the old "normalized_at" field's value of the InventoryImage entity.
+// If the InventoryImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageMutation) OldNormalizedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNormalizedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNormalizedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNormalizedAt: %w", err)
+	}
+	return oldValue.NormalizedAt, nil
+}
+
+// ResetNormalizedAt resets all changes to the "normalized_at" field.
+func (m *InventoryImageMutation) ResetNormalizedAt() {
+	m.normalized_at = nil
+}
+
+// SetRegistry sets the "registry" field.
+func (m *InventoryImageMutation) SetRegistry(s string) {
+	m.registry = &s
+}
+
+// Registry returns the value of the "registry" field in the mutation.
+func (m *InventoryImageMutation) Registry() (r string, exists bool) {
+	v := m.registry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegistry returns the old "registry" field's value of the InventoryImage entity.
+// If the InventoryImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageMutation) OldRegistry(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegistry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegistry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegistry: %w", err)
+	}
+	return oldValue.Registry, nil
+}
+
+// ResetRegistry resets all changes to the "registry" field.
+func (m *InventoryImageMutation) ResetRegistry() {
+	m.registry = nil
+}
+
+// SetRepository sets the "repository" field.
+func (m *InventoryImageMutation) SetRepository(s string) {
+	m.repository = &s
+}
+
+// Repository returns the value of the "repository" field in the mutation.
+func (m *InventoryImageMutation) Repository() (r string, exists bool) {
+	v := m.repository
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRepository returns the old "repository" field's value of the InventoryImage entity.
+// If the InventoryImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageMutation) OldRepository(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRepository is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRepository requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRepository: %w", err)
+	}
+	return oldValue.Repository, nil
+}
+
+// ResetRepository resets all changes to the "repository" field.
+func (m *InventoryImageMutation) ResetRepository() {
+	m.repository = nil
+}
+
+// SetDigest sets the "digest" field.
+func (m *InventoryImageMutation) SetDigest(s string) {
+	m.digest = &s
+}
+
+// Digest returns the value of the "digest" field in the mutation.
+func (m *InventoryImageMutation) Digest() (r string, exists bool) {
+	v := m.digest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDigest returns the old "digest" field's value of the InventoryImage entity.
+// If the InventoryImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageMutation) OldDigest(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDigest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDigest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDigest: %w", err)
+	}
+	return oldValue.Digest, nil
+}
+
+// ResetDigest resets all changes to the "digest" field.
+func (m *InventoryImageMutation) ResetDigest() {
+	m.digest = nil
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (m *InventoryImageMutation) SetTagsJSON(s []string) {
+	m.tags_json = &s
+	m.appendtags_json = nil
+}
+
+// TagsJSON returns the value of the "tags_json" field in the mutation.
+func (m *InventoryImageMutation) TagsJSON() (r []string, exists bool) {
+	v := m.tags_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagsJSON returns the old "tags_json" field's value of the InventoryImage entity.
+// If the InventoryImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageMutation) OldTagsJSON(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagsJSON: %w", err)
+	}
+	return oldValue.TagsJSON, nil
+}
+
+// AppendTagsJSON adds s to the "tags_json" field.
+func (m *InventoryImageMutation) AppendTagsJSON(s []string) {
+	m.appendtags_json = append(m.appendtags_json, s...)
+}
+
+// AppendedTagsJSON returns the list of values that were appended to the "tags_json" field in this mutation.
+func (m *InventoryImageMutation) AppendedTagsJSON() ([]string, bool) {
+	if len(m.appendtags_json) == 0 {
+		return nil, false
+	}
+	return m.appendtags_json, true
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (m *InventoryImageMutation) ClearTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	m.clearedFields[inventoryimage.FieldTagsJSON] = struct{}{}
+}
+
+// TagsJSONCleared returns if the "tags_json" field was cleared in this mutation.
+func (m *InventoryImageMutation) TagsJSONCleared() bool {
+	_, ok := m.clearedFields[inventoryimage.FieldTagsJSON]
+	return ok
+}
+
+// ResetTagsJSON resets all changes to the "tags_json" field.
+func (m *InventoryImageMutation) ResetTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	delete(m.clearedFields, inventoryimage.FieldTagsJSON)
+}
+
+// SetWorkloadsJSON sets the "workloads_json" field.
+func (m *InventoryImageMutation) SetWorkloadsJSON(s []string) {
+	m.workloads_json = &s
+	m.appendworkloads_json = nil
+}
+
+// WorkloadsJSON returns the value of the "workloads_json" field in the mutation.
+func (m *InventoryImageMutation) WorkloadsJSON() (r []string, exists bool) {
+	v := m.workloads_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkloadsJSON returns the old "workloads_json" field's value of the InventoryImage entity.
+// If the InventoryImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageMutation) OldWorkloadsJSON(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkloadsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkloadsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkloadsJSON: %w", err)
+	}
+	return oldValue.WorkloadsJSON, nil
+}
+
+// AppendWorkloadsJSON adds s to the "workloads_json" field.
+func (m *InventoryImageMutation) AppendWorkloadsJSON(s []string) {
+	m.appendworkloads_json = append(m.appendworkloads_json, s...)
+}
+
+// AppendedWorkloadsJSON returns the list of values that were appended to the "workloads_json" field in this mutation.
+func (m *InventoryImageMutation) AppendedWorkloadsJSON() ([]string, bool) {
+	if len(m.appendworkloads_json) == 0 {
+		return nil, false
+	}
+	return m.appendworkloads_json, true
+}
+
+// ClearWorkloadsJSON clears the value of the "workloads_json" field.
+func (m *InventoryImageMutation) ClearWorkloadsJSON() {
+	m.workloads_json = nil
+	m.appendworkloads_json = nil
+	m.clearedFields[inventoryimage.FieldWorkloadsJSON] = struct{}{}
+}
+
+// WorkloadsJSONCleared returns if the "workloads_json" field was cleared in this mutation.
+func (m *InventoryImageMutation) WorkloadsJSONCleared() bool {
+	_, ok := m.clearedFields[inventoryimage.FieldWorkloadsJSON]
+	return ok
+}
+
+// ResetWorkloadsJSON resets all changes to the "workloads_json" field.
+func (m *InventoryImageMutation) ResetWorkloadsJSON() {
+	m.workloads_json = nil
+	m.appendworkloads_json = nil
+	delete(m.clearedFields, inventoryimage.FieldWorkloadsJSON)
+}
+
+// SetVulnerabilitiesJSON sets the "vulnerabilities_json" field.
+func (m *InventoryImageMutation) SetVulnerabilitiesJSON(jm json.RawMessage) {
+	m.vulnerabilities_json = &jm
+	m.appendvulnerabilities_json = nil
+}
+
+// VulnerabilitiesJSON returns the value of the "vulnerabilities_json" field in the mutation.
+func (m *InventoryImageMutation) VulnerabilitiesJSON() (r json.RawMessage, exists bool) {
+	v := m.vulnerabilities_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVulnerabilitiesJSON returns the old "vulnerabilities_json" field's value of the InventoryImage entity.
+// If the InventoryImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageMutation) OldVulnerabilitiesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVulnerabilitiesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVulnerabilitiesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVulnerabilitiesJSON: %w", err)
+	}
+	return oldValue.VulnerabilitiesJSON, nil
+}
+
+// AppendVulnerabilitiesJSON adds jm to the "vulnerabilities_json" field.
+func (m *InventoryImageMutation) AppendVulnerabilitiesJSON(jm json.RawMessage) {
+	m.appendvulnerabilities_json = append(m.appendvulnerabilities_json, jm...)
+}
+
+// AppendedVulnerabilitiesJSON returns the list of values that were appended to the "vulnerabilities_json" field in this mutation.
+func (m *InventoryImageMutation) AppendedVulnerabilitiesJSON() (json.RawMessage, bool) {
+	if len(m.appendvulnerabilities_json) == 0 {
+		return nil, false
+	}
+	return m.appendvulnerabilities_json, true
+}
+
+// ClearVulnerabilitiesJSON clears the value of the "vulnerabilities_json" field.
+func (m *InventoryImageMutation) ClearVulnerabilitiesJSON() {
+	m.vulnerabilities_json = nil
+	m.appendvulnerabilities_json = nil
+	m.clearedFields[inventoryimage.FieldVulnerabilitiesJSON] = struct{}{}
+}
+
+// VulnerabilitiesJSONCleared returns if the "vulnerabilities_json" field was cleared in this mutation.
+func (m *InventoryImageMutation) VulnerabilitiesJSONCleared() bool {
+	_, ok := m.clearedFields[inventoryimage.FieldVulnerabilitiesJSON]
+	return ok
+}
+
+// ResetVulnerabilitiesJSON resets all changes to the "vulnerabilities_json" field.
+func (m *InventoryImageMutation) ResetVulnerabilitiesJSON() {
+	m.vulnerabilities_json = nil
+	m.appendvulnerabilities_json = nil
+	delete(m.clearedFields, inventoryimage.FieldVulnerabilitiesJSON)
+}
+
+// SetVulnerabilityCount sets the "vulnerability_count" field.
+func (m *InventoryImageMutation) SetVulnerabilityCount(i int) {
+	m.vulnerability_count = &i
+	m.addvulnerability_count = nil
+}
+
+// VulnerabilityCount returns the value of the "vulnerability_count" field in the mutation.
+func (m *InventoryImageMutation) VulnerabilityCount() (r int, exists bool) {
+	v := m.vulnerability_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVulnerabilityCount returns the old "vulnerability_count" field's value of the InventoryImage entity.
+// If the InventoryImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageMutation) OldVulnerabilityCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVulnerabilityCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVulnerabilityCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVulnerabilityCount: %w", err)
+	}
+	return oldValue.VulnerabilityCount, nil
+}
+
+// AddVulnerabilityCount adds i to the "vulnerability_count" field.
+func (m *InventoryImageMutation) AddVulnerabilityCount(i int) {
+	if m.addvulnerability_count != nil {
+		*m.addvulnerability_count += i
+	} else {
+		m.addvulnerability_count = &i
+	}
+}
+
+// AddedVulnerabilityCount returns the value that was added to the "vulnerability_count" field in this mutation.
+func (m *InventoryImageMutation) AddedVulnerabilityCount() (r int, exists bool) {
+	v := m.addvulnerability_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVulnerabilityCount resets all changes to the "vulnerability_count" field.
+func (m *InventoryImageMutation) ResetVulnerabilityCount() {
+	m.vulnerability_count = nil
+	m.addvulnerability_count = nil
+}
+
+// SetMaxSeverity sets the "max_severity" field.
+func (m *InventoryImageMutation) SetMaxSeverity(s string) {
+	m.max_severity = &s
+}
+
+// MaxSeverity returns the value of the "max_severity" field in the mutation.
+func (m *InventoryImageMutation) MaxSeverity() (r string, exists bool) {
+	v := m.max_severity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxSeverity returns the old "max_severity" field's value of the InventoryImage entity.
+// If the InventoryImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageMutation) OldMaxSeverity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxSeverity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxSeverity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxSeverity: %w", err)
+	}
+	return oldValue.MaxSeverity, nil
+}
+
+// ClearMaxSeverity clears the value of the "max_severity" field.
+func (m *InventoryImageMutation) ClearMaxSeverity() {
+	m.max_severity = nil
+	m.clearedFields[inventoryimage.FieldMaxSeverity] = struct{}{}
+}
+
+// MaxSeverityCleared returns if the "max_severity" field was cleared in this mutation.
+func (m *InventoryImageMutation) MaxSeverityCleared() bool {
+	_, ok := m.clearedFields[inventoryimage.FieldMaxSeverity]
+	return ok
+}
+
+// ResetMaxSeverity resets all changes to the "max_severity" field.
+func (m *InventoryImageMutation) ResetMaxSeverity() {
+	m.max_severity = nil
+	delete(m.clearedFields, inventoryimage.FieldMaxSeverity)
+}
+
+// AddBronzeLinkIDs adds the "bronze_links" edge to the InventoryImageBronzeLink entity by ids.
+func (m *InventoryImageMutation) AddBronzeLinkIDs(ids ...int) {
+	if m.bronze_links == nil {
+		m.bronze_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.bronze_links[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBronzeLinks clears the "bronze_links" edge to the InventoryImageBronzeLink entity.
+func (m *InventoryImageMutation) ClearBronzeLinks() {
+	m.clearedbronze_links = true
+}
+
+// BronzeLinksCleared reports if the "bronze_links" edge to the InventoryImageBronzeLink entity was cleared.
+func (m *InventoryImageMutation) BronzeLinksCleared() bool {
+	return m.clearedbronze_links
+}
+
+// RemoveBronzeLinkIDs removes the "bronze_links" edge to the InventoryImageBronzeLink entity by IDs.
+func (m *InventoryImageMutation) RemoveBronzeLinkIDs(ids ...int) {
+	if m.removedbronze_links == nil {
+		m.removedbronze_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.bronze_links, ids[i])
+		m.removedbronze_links[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBronzeLinks returns the removed IDs of the "bronze_links" edge to the InventoryImageBronzeLink entity.
+func (m *InventoryImageMutation) RemovedBronzeLinksIDs() (ids []int) {
+	for id := range m.removedbronze_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BronzeLinksIDs returns the "bronze_links" edge IDs in the mutation.
+func (m *InventoryImageMutation) BronzeLinksIDs() (ids []int) {
+	for id := range m.bronze_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBronzeLinks resets all changes to the "bronze_links" edge.
+func (m *InventoryImageMutation) ResetBronzeLinks() {
+	m.bronze_links = nil
+	m.clearedbronze_links = false
+	m.removedbronze_links = nil
+}
+
+// Where appends a list predicates to the InventoryImageMutation builder.
+func (m *InventoryImageMutation) Where(ps ...predicate.InventoryImage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the InventoryImageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InventoryImageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.InventoryImage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *InventoryImageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *InventoryImageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (InventoryImage).
+func (m *InventoryImageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InventoryImageMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.collected_at != nil {
+		fields = append(fields, inventoryimage.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, inventoryimage.FieldFirstCollectedAt)
+	}
+	if m.normalized_at != nil {
+		fields = append(fields, inventoryimage.FieldNormalizedAt)
+	}
+	if m.registry != nil {
+		fields = append(fields, inventoryimage.FieldRegistry)
+	}
+	if m.repository != nil {
+		fields = append(fields, inventoryimage.FieldRepository)
+	}
+	if m.digest != nil {
+		fields = append(fields, inventoryimage.FieldDigest)
+	}
+	if m.tags_json != nil {
+		fields = append(fields, inventoryimage.FieldTagsJSON)
+	}
+	if m.workloads_json != nil {
+		fields = append(fields, inventoryimage.FieldWorkloadsJSON)
+	}
+	if m.vulnerabilities_json != nil {
+		fields = append(fields, inventoryimage.FieldVulnerabilitiesJSON)
+	}
+	if m.vulnerability_count != nil {
+		fields = append(fields, inventoryimage.FieldVulnerabilityCount)
+	}
+	if m.max_severity != nil {
+		fields = append(fields, inventoryimage.FieldMaxSeverity)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InventoryImageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case inventoryimage.FieldCollectedAt:
+		return m.CollectedAt()
+	case inventoryimage.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case inventoryimage.FieldNormalizedAt:
+		return m.NormalizedAt()
+	case inventoryimage.FieldRegistry:
+		return m.Registry()
+	case inventoryimage.FieldRepository:
+		return m.Repository()
+	case inventoryimage.FieldDigest:
+		return m.Digest()
+	case inventoryimage.FieldTagsJSON:
+		return m.TagsJSON()
+	case inventoryimage.FieldWorkloadsJSON:
+		return m.WorkloadsJSON()
+	case inventoryimage.FieldVulnerabilitiesJSON:
+		return m.VulnerabilitiesJSON()
+	case inventoryimage.FieldVulnerabilityCount:
+		return m.VulnerabilityCount()
+	case inventoryimage.FieldMaxSeverity:
+		return m.MaxSeverity()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InventoryImageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case inventoryimage.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case inventoryimage.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case inventoryimage.FieldNormalizedAt:
+		return m.OldNormalizedAt(ctx)
+	case inventoryimage.FieldRegistry:
+		return m.OldRegistry(ctx)
+	case inventoryimage.FieldRepository:
+		return m.OldRepository(ctx)
+	case inventoryimage.FieldDigest:
+		return m.OldDigest(ctx)
+	case inventoryimage.FieldTagsJSON:
+		return m.OldTagsJSON(ctx)
+	case inventoryimage.FieldWorkloadsJSON:
+		return m.OldWorkloadsJSON(ctx)
+	case inventoryimage.FieldVulnerabilitiesJSON:
+		return m.OldVulnerabilitiesJSON(ctx)
+	case inventoryimage.FieldVulnerabilityCount:
+		return m.OldVulnerabilityCount(ctx)
+	case inventoryimage.FieldMaxSeverity:
+		return m.OldMaxSeverity(ctx)
+	}
+	return nil, fmt.Errorf("unknown InventoryImage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InventoryImageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case inventoryimage.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case inventoryimage.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case inventoryimage.FieldNormalizedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNormalizedAt(v)
+		return nil
+	case inventoryimage.FieldRegistry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegistry(v)
+		return nil
+	case inventoryimage.FieldRepository:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRepository(v)
+		return nil
+	case inventoryimage.FieldDigest:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDigest(v)
+		return nil
+	case inventoryimage.FieldTagsJSON:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagsJSON(v)
+		return nil
+	case inventoryimage.FieldWorkloadsJSON:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkloadsJSON(v)
+		return nil
+	case inventoryimage.FieldVulnerabilitiesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVulnerabilitiesJSON(v)
+		return nil
+	case inventoryimage.FieldVulnerabilityCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVulnerabilityCount(v)
+		return nil
+	case inventoryimage.FieldMaxSeverity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxSeverity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown InventoryImage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InventoryImageMutation) AddedFields() []string {
+	var fields []string
+	if m.addvulnerability_count != nil {
+		fields = append(fields, inventoryimage.FieldVulnerabilityCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InventoryImageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case inventoryimage.FieldVulnerabilityCount:
+		return m.AddedVulnerabilityCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InventoryImageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case inventoryimage.FieldVulnerabilityCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVulnerabilityCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown InventoryImage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InventoryImageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(inventoryimage.FieldTagsJSON) {
+		fields = append(fields, inventoryimage.FieldTagsJSON)
+	}
+	if m.FieldCleared(inventoryimage.FieldWorkloadsJSON) {
+		fields = append(fields, inventoryimage.FieldWorkloadsJSON)
+	}
+	if m.FieldCleared(inventoryimage.FieldVulnerabilitiesJSON) {
+		fields = append(fields, inventoryimage.FieldVulnerabilitiesJSON)
+	}
+	if m.FieldCleared(inventoryimage.FieldMaxSeverity) {
+		fields = append(fields, inventoryimage.FieldMaxSeverity)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InventoryImageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InventoryImageMutation) ClearField(name string) error {
+	switch name {
+	case inventoryimage.FieldTagsJSON:
+		m.ClearTagsJSON()
+		return nil
+	case inventoryimage.FieldWorkloadsJSON:
+		m.ClearWorkloadsJSON()
+		return nil
+	case inventoryimage.FieldVulnerabilitiesJSON:
+		m.ClearVulnerabilitiesJSON()
+		return nil
+	case inventoryimage.FieldMaxSeverity:
+		m.ClearMaxSeverity()
+		return nil
+	}
+	return fmt.Errorf("unknown InventoryImage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InventoryImageMutation) ResetField(name string) error {
+	switch name {
+	case inventoryimage.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case inventoryimage.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case inventoryimage.FieldNormalizedAt:
+		m.ResetNormalizedAt()
+		return nil
+	case inventoryimage.FieldRegistry:
+		m.ResetRegistry()
+		return nil
+	case inventoryimage.FieldRepository:
+		m.ResetRepository()
+		return nil
+	case inventoryimage.FieldDigest:
+		m.ResetDigest()
+		return nil
+	case inventoryimage.FieldTagsJSON:
+		m.ResetTagsJSON()
+		return nil
+	case inventoryimage.FieldWorkloadsJSON:
+		m.ResetWorkloadsJSON()
+		return nil
+	case inventoryimage.FieldVulnerabilitiesJSON:
+		m.ResetVulnerabilitiesJSON()
+		return nil
+	case inventoryimage.FieldVulnerabilityCount:
+		m.ResetVulnerabilityCount()
+		return nil
+	case inventoryimage.FieldMaxSeverity:
+		m.ResetMaxSeverity()
+		return nil
+	}
+	return fmt.Errorf("unknown InventoryImage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InventoryImageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.bronze_links != nil {
+		edges = append(edges, inventoryimage.EdgeBronzeLinks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InventoryImageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case inventoryimage.EdgeBronzeLinks:
+		ids := make([]ent.Value, 0, len(m.bronze_links))
+		for id := range m.bronze_links {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InventoryImageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedbronze_links != nil {
+		edges = append(edges, inventoryimage.EdgeBronzeLinks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InventoryImageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case inventoryimage.EdgeBronzeLinks:
+		ids := make([]ent.Value, 0, len(m.removedbronze_links))
+		for id := range m.removedbronze_links {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InventoryImageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedbronze_links {
+		edges = append(edges, inventoryimage.EdgeBronzeLinks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InventoryImageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case inventoryimage.EdgeBronzeLinks:
+		return m.clearedbronze_links
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InventoryImageMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown InventoryImage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InventoryImageMutation) ResetEdge(name string) error {
+	switch name {
+	case inventoryimage.EdgeBronzeLinks:
+		m.ResetBronzeLinks()
+		return nil
+	}
+	return fmt.Errorf("unknown InventoryImage edge %s", name)
+}
+
+// InventoryImageBronzeLinkMutation represents an operation that mutates the InventoryImageBronzeLink nodes in the graph.
+type InventoryImageBronzeLinkMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	provider           *string
+	bronze_table       *string
+	bronze_resource_id *string
+	clearedFields      map[string]struct{}
+	image              *string
+	clearedimage       bool
+	done               bool
+	oldValue           func(context.Context) (*InventoryImageBronzeLink, error)
+	predicates         []predicate.InventoryImageBronzeLink
+}
+
+var _ ent.Mutation = (*InventoryImageBronzeLinkMutation)(nil)
+
+// inventoryimagebronzelinkOption allows management of the mutation configuration using functional options.
+type inventoryimagebronzelinkOption func(*InventoryImageBronzeLinkMutation)
+
+// newInventoryImageBronzeLinkMutation creates new mutation for the InventoryImageBronzeLink entity.
+func newInventoryImageBronzeLinkMutation(c config, op Op, opts ...inventoryimagebronzelinkOption) *InventoryImageBronzeLinkMutation {
+	m := &InventoryImageBronzeLinkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInventoryImageBronzeLink,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withInventoryImageBronzeLinkID sets the ID field of the mutation.
+func withInventoryImageBronzeLinkID(id int) inventoryimagebronzelinkOption {
+	return func(m *InventoryImageBronzeLinkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *InventoryImageBronzeLink
+		)
+		m.oldValue = func(ctx context.Context) (*InventoryImageBronzeLink, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().InventoryImageBronzeLink.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withInventoryImageBronzeLink sets the old InventoryImageBronzeLink of the mutation.
+func withInventoryImageBronzeLink(node *InventoryImageBronzeLink) inventoryimagebronzelinkOption {
+	return func(m *InventoryImageBronzeLinkMutation) {
+		m.oldValue = func(context.Context) (*InventoryImageBronzeLink, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InventoryImageBronzeLinkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InventoryImageBronzeLinkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("image: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *InventoryImageBronzeLinkMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *InventoryImageBronzeLinkMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().InventoryImageBronzeLink.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProvider sets the "provider" field.
+func (m *InventoryImageBronzeLinkMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *InventoryImageBronzeLinkMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the InventoryImageBronzeLink entity.
+// If the InventoryImageBronzeLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageBronzeLinkMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *InventoryImageBronzeLinkMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetBronzeTable sets the "bronze_table" field.
+func (m *InventoryImageBronzeLinkMutation) SetBronzeTable(s string) {
+	m.bronze_table = &s
+}
+
+// BronzeTable returns the value of the "bronze_table" field in the mutation.
+func (m *InventoryImageBronzeLinkMutation) BronzeTable() (r string, exists bool) {
+	v := m.bronze_table
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBronzeTable returns the old "bronze_table" field's value of the InventoryImageBronzeLink entity.
+// If the InventoryImageBronzeLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageBronzeLinkMutation) OldBronzeTable(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBronzeTable is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBronzeTable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBronzeTable: %w", err)
+	}
+	return oldValue.BronzeTable, nil
+}
+
+// ResetBronzeTable resets all changes to the "bronze_table" field.
+func (m *InventoryImageBronzeLinkMutation) ResetBronzeTable() {
+	m.bronze_table = nil
+}
+
+// SetBronzeResourceID sets the "bronze_resource_id" field.
+func (m *InventoryImageBronzeLinkMutation) SetBronzeResourceID(s string) {
+	m.bronze_resource_id = &s
+}
+
+// BronzeResourceID returns the value of the "bronze_resource_id" field in the mutation.
+func (m *InventoryImageBronzeLinkMutation) BronzeResourceID() (r string, exists bool) {
+	v := m.bronze_resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBronzeResourceID returns the old "bronze_resource_id" field's value of the InventoryImageBronzeLink entity.
+// If the InventoryImageBronzeLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageBronzeLinkMutation) OldBronzeResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBronzeResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBronzeResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBronzeResourceID: %w", err)
+	}
+	return oldValue.BronzeResourceID, nil
+}
+
+// ResetBronzeResourceID resets all changes to the "bronze_resource_id" field.
+func (m *InventoryImageBronzeLinkMutation) ResetBronzeResourceID() {
+	m.bronze_resource_id = nil
+}
+
+// SetImageID sets the "image" edge to the InventoryImage entity by id.
+func (m *InventoryImageBronzeLinkMutation) SetImageID(id string) {
+	m.image = &id
+}
+
+// ClearImage clears the "image" edge to the InventoryImage entity.
+func (m *InventoryImageBronzeLinkMutation) ClearImage() {
+	m.clearedimage = true
+}
+
+// ImageCleared reports if the "image" edge to the InventoryImage entity was cleared.
+func (m *InventoryImageBronzeLinkMutation) ImageCleared() bool {
+	return m.clearedimage
+}
+
+// ImageID returns the "image" edge ID in the mutation.
+func (m *InventoryImageBronzeLinkMutation) ImageID() (id string, exists bool) {
+	if m.image != nil {
+		return *m.image, true
+	}
+	return
+}
+
+// ImageIDs returns the "image" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ImageID instead. It exists only for internal usage by the builders.
+func (m *InventoryImageBronzeLinkMutation) ImageIDs() (ids []string) {
+	if id := m.image; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetImage resets all changes to the "image" edge.
+func (m *InventoryImageBronzeLinkMutation) ResetImage() {
+	m.image = nil
+	m.clearedimage = false
+}
+
+// Where appends a list predicates to the InventoryImageBronzeLinkMutation builder.
+func (m *InventoryImageBronzeLinkMutation) Where(ps ...predicate.InventoryImageBronzeLink) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the InventoryImageBronzeLinkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InventoryImageBronzeLinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.InventoryImageBronzeLink, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *InventoryImageBronzeLinkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *InventoryImageBronzeLinkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (InventoryImageBronzeLink).
+func (m *InventoryImageBronzeLinkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InventoryImageBronzeLinkMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.provider != nil {
+		fields = append(fields, inventoryimagebronzelink.FieldProvider)
+	}
+	if m.bronze_table != nil {
+		fields = append(fields, inventoryimagebronzelink.FieldBronzeTable)
+	}
+	if m.bronze_resource_id != nil {
+		fields = append(fields, inventoryimagebronzelink.FieldBronzeResourceID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InventoryImageBronzeLinkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case inventoryimagebronzelink.FieldProvider:
+		return m.Provider()
+	case inventoryimagebronzelink.FieldBronzeTable:
+		return m.BronzeTable()
+	case inventoryimagebronzelink.FieldBronzeResourceID:
+		return m.BronzeResourceID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InventoryImageBronzeLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case inventoryimagebronzelink.FieldProvider:
+		return m.OldProvider(ctx)
+	case inventoryimagebronzelink.FieldBronzeTable:
+		return m.OldBronzeTable(ctx)
+	case inventoryimagebronzelink.FieldBronzeResourceID:
+		return m.OldBronzeResourceID(ctx)
+	}
+	return nil, fmt.Errorf("unknown InventoryImageBronzeLink field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InventoryImageBronzeLinkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case inventoryimagebronzelink.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case inventoryimagebronzelink.FieldBronzeTable:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBronzeTable(v)
+		return nil
+	case inventoryimagebronzelink.FieldBronzeResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBronzeResourceID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown InventoryImageBronzeLink field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InventoryImageBronzeLinkMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InventoryImageBronzeLinkMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InventoryImageBronzeLinkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown InventoryImageBronzeLink numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InventoryImageBronzeLinkMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InventoryImageBronzeLinkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InventoryImageBronzeLinkMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown InventoryImageBronzeLink nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InventoryImageBronzeLinkMutation) ResetField(name string) error {
+	switch name {
+	case inventoryimagebronzelink.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case inventoryimagebronzelink.FieldBronzeTable:
+		m.ResetBronzeTable()
+		return nil
+	case inventoryimagebronzelink.FieldBronzeResourceID:
+		m.ResetBronzeResourceID()
+		return nil
+	}
+	return fmt.Errorf("unknown InventoryImageBronzeLink field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InventoryImageBronzeLinkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.image != nil {
+		edges = append(edges, inventoryimagebronzelink.EdgeImage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InventoryImageBronzeLinkMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case inventoryimagebronzelink.EdgeImage:
+		if id := m.image; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InventoryImageBronzeLinkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InventoryImageBronzeLinkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InventoryImageBronzeLinkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedimage {
+		edges = append(edges, inventoryimagebronzelink.EdgeImage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InventoryImageBronzeLinkMutation) EdgeCleared(name string) bool {
+	switch name {
+	case inventoryimagebronzelink.EdgeImage:
+		return m.clearedimage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InventoryImageBronzeLinkMutation) ClearEdge(name string) error {
+	switch name {
+	case inventoryimagebronzelink.EdgeImage:
+		m.ClearImage()
+		return nil
+	}
+	return fmt.Errorf("unknown InventoryImageBronzeLink unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InventoryImageBronzeLinkMutation) ResetEdge(name string) error {
+	switch name {
+	case inventoryimagebronzelink.EdgeImage:
+		m.ResetImage()
+		return nil
+	}
+	return fmt.Errorf("unknown InventoryImageBronzeLink edge %s", name)
+}
+
+// InventoryImageNormalizedMutation represents an operation that mutates the InventoryImageNormalized nodes in the graph.
+type InventoryImageNormalizedMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *string
+	provider                   *string
+	is_base                    *bool
+	bronze_table               *string
+	bronze_resource_id         *string
+	registry                   *string
+	repository                 *string
+	digest                     *string
+	tags_json                  *[]string
+	appendtags_json            []string
+	workloads_json             *[]string
+	appendworkloads_json       []string
+	vulnerabilities_json       *json.RawMessage
+	appendvulnerabilities_json json.RawMessage
+	collected_at               *time.Time
+	first_collected_at         *time.Time
+	normalized_at              *time.Time
+	clearedFields              map[string]struct{}
+	done                       bool
+	oldValue                   func(context.Context) (*InventoryImageNormalized, error)
+	predicates                 []predicate.InventoryImageNormalized
+}
+
+var _ ent.Mutation = (*InventoryImageNormalizedMutation)(nil)
+
+// inventoryimagenormalizedOption allows management of the mutation configuration using functional options.
+type inventoryimagenormalizedOption func(*InventoryImageNormalizedMutation)
+
+// newInventoryImageNormalizedMutation creates new mutation for the InventoryImageNormalized entity.
+func newInventoryImageNormalizedMutation(c config, op Op, opts ...inventoryimagenormalizedOption) *InventoryImageNormalizedMutation {
+	m := &InventoryImageNormalizedMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInventoryImageNormalized,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withInventoryImageNormalizedID sets the ID field of the mutation.
+func withInventoryImageNormalizedID(id string) inventoryimagenormalizedOption {
+	return func(m *InventoryImageNormalizedMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *InventoryImageNormalized
+		)
+		m.oldValue = func(ctx context.Context) (*InventoryImageNormalized, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().InventoryImageNormalized.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withInventoryImageNormalized sets the old InventoryImageNormalized of the mutation.
+func withInventoryImageNormalized(node *InventoryImageNormalized) inventoryimagenormalizedOption {
+	return func(m *InventoryImageNormalizedMutation) {
+		m.oldValue = func(context.Context) (*InventoryImageNormalized, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InventoryImageNormalizedMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InventoryImageNormalizedMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("image: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of InventoryImageNormalized entities.
+func (m *InventoryImageNormalizedMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *InventoryImageNormalizedMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *InventoryImageNormalizedMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().InventoryImageNormalized.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProvider sets the "provider" field.
+func (m *InventoryImageNormalizedMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *InventoryImageNormalizedMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the InventoryImageNormalized entity.
+// If the InventoryImageNormalized object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageNormalizedMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *InventoryImageNormalizedMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetIsBase sets the "is_base" field.
+func (m *InventoryImageNormalizedMutation) SetIsBase(b bool) {
+	m.is_base = &b
+}
+
+// IsBase returns the value of the "is_base" field in the mutation.
+func (m *InventoryImageNormalizedMutation) IsBase() (r bool, exists bool) {
+	v := m.is_base
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsBase returns the old "is_base" field's value of the InventoryImageNormalized entity.
+// If the InventoryImageNormalized object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageNormalizedMutation) OldIsBase(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsBase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsBase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsBase: %w", err)
+	}
+	return oldValue.IsBase, nil
+}
+
+// ResetIsBase resets all changes to the "is_base" field.
+func (m *InventoryImageNormalizedMutation) ResetIsBase() {
+	m.is_base = nil
+}
+
+// SetBronzeTable sets the "bronze_table" field.
+func (m *InventoryImageNormalizedMutation) SetBronzeTable(s string) {
+	m.bronze_table = &s
+}
+
+// BronzeTable returns the value of the "bronze_table" field in the mutation.
+func (m *InventoryImageNormalizedMutation) BronzeTable() (r string, exists bool) {
+	v := m.bronze_table
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBronzeTable returns the old "bronze_table" field's value of the InventoryImageNormalized entity.
+// If the InventoryImageNormalized object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageNormalizedMutation) OldBronzeTable(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBronzeTable is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBronzeTable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBronzeTable: %w", err)
+	}
+	return oldValue.BronzeTable, nil
+}
+
+// ResetBronzeTable resets all changes to the "bronze_table" field.
+func (m *InventoryImageNormalizedMutation) ResetBronzeTable() {
+	m.bronze_table = nil
+}
+
+// SetBronzeResourceID sets the "bronze_resource_id" field.
+func (m *InventoryImageNormalizedMutation) SetBronzeResourceID(s string) {
+	m.bronze_resource_id = &s
+}
+
+// BronzeResourceID returns the value of the "bronze_resource_id" field in the mutation.
+func (m *InventoryImageNormalizedMutation) BronzeResourceID() (r string, exists bool) {
+	v := m.bronze_resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBronzeResourceID returns the old "bronze_resource_id" field's value of the InventoryImageNormalized entity.
+// If the InventoryImageNormalized object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageNormalizedMutation) OldBronzeResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBronzeResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBronzeResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBronzeResourceID: %w", err)
+	}
+	return oldValue.BronzeResourceID, nil
+}
+
+// ResetBronzeResourceID resets all changes to the "bronze_resource_id" field.
+func (m *InventoryImageNormalizedMutation) ResetBronzeResourceID() {
+	m.bronze_resource_id = nil
+}
+
+// SetRegistry sets the "registry" field.
+func (m *InventoryImageNormalizedMutation) SetRegistry(s string) {
+	m.registry = &s
+}
+
+// Registry returns the value of the "registry" field in the mutation.
+func (m *InventoryImageNormalizedMutation) Registry() (r string, exists bool) {
+	v := m.registry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegistry returns the old "registry" field's value of the InventoryImageNormalized entity.
+// If the InventoryImageNormalized object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageNormalizedMutation) OldRegistry(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegistry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegistry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegistry: %w", err)
+	}
+	return oldValue.Registry, nil
+}
+
+// ResetRegistry resets all changes to the "registry" field.
+func (m *InventoryImageNormalizedMutation) ResetRegistry() {
+	m.registry = nil
+}
+
+// SetRepository sets the "repository" field.
+func (m *InventoryImageNormalizedMutation) SetRepository(s string) {
+	m.repository = &s
+}
+
+// Repository returns the value of the "repository" field in the mutation.
+func (m *InventoryImageNormalizedMutation) Repository() (r string, exists bool) {
+	v := m.repository
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRepository returns the old "repository" field's value of the InventoryImageNormalized entity.
+// If the InventoryImageNormalized object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageNormalizedMutation) OldRepository(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRepository is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRepository requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRepository: %w", err)
+	}
+	return oldValue.Repository, nil
+}
+
+// ResetRepository resets all changes to the "repository" field.
+func (m *InventoryImageNormalizedMutation) ResetRepository() {
+	m.repository = nil
+}
+
+// SetDigest sets the "digest" field.
+func (m *InventoryImageNormalizedMutation) SetDigest(s string) {
+	m.digest = &s
+}
+
+// Digest returns the value of the "digest" field in the mutation.
+func (m *InventoryImageNormalizedMutation) Digest() (r string, exists bool) {
+	v := m.digest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDigest returns the old "digest" field's value of the InventoryImageNormalized entity.
+// If the InventoryImageNormalized object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageNormalizedMutation) OldDigest(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDigest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDigest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDigest: %w", err)
+	}
+	return oldValue.Digest, nil
+}
+
+// ClearDigest clears the value of the "digest" field.
+func (m *InventoryImageNormalizedMutation) ClearDigest() {
+	m.digest = nil
+	m.clearedFields[inventoryimagenormalized.FieldDigest] = struct{}{}
+}
+
+// DigestCleared returns if the "digest" field was cleared in this mutation.
+func (m *InventoryImageNormalizedMutation) DigestCleared() bool {
+	_, ok := m.clearedFields[inventoryimagenormalized.FieldDigest]
+	return ok
+}
+
+// ResetDigest resets all changes to the "digest" field.
+func (m *InventoryImageNormalizedMutation) ResetDigest() {
+	m.digest = nil
+	delete(m.clearedFields, inventoryimagenormalized.FieldDigest)
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (m *InventoryImageNormalizedMutation) SetTagsJSON(s []string) {
+	m.tags_json = &s
+	m.appendtags_json = nil
+}
+
+// TagsJSON returns the value of the "tags_json" field in the mutation.
+func (m *InventoryImageNormalizedMutation) TagsJSON() (r []string, exists bool) {
+	v := m.tags_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagsJSON returns the old "tags_json" field's value of the InventoryImageNormalized entity.
+// If the InventoryImageNormalized object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageNormalizedMutation) OldTagsJSON(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagsJSON: %w", err)
+	}
+	return oldValue.TagsJSON, nil
+}
+
+// AppendTagsJSON adds s to the "tags_json" field.
+func (m *InventoryImageNormalizedMutation) AppendTagsJSON(s []string) {
+	m.appendtags_json = append(m.appendtags_json, s...)
+}
+
+// AppendedTagsJSON returns the list of values that were appended to the "tags_json" field in this mutation.
+func (m *InventoryImageNormalizedMutation) AppendedTagsJSON() ([]string, bool) {
+	if len(m.appendtags_json) == 0 {
+		return nil, false
+	}
+	return m.appendtags_json, true
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (m *InventoryImageNormalizedMutation) ClearTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	m.clearedFields[inventoryimagenormalized.FieldTagsJSON] = struct{}{}
+}
+
+// TagsJSONCleared returns if the "tags_json" field was cleared in this mutation.
+func (m *InventoryImageNormalizedMutation) TagsJSONCleared() bool {
+	_, ok := m.clearedFields[inventoryimagenormalized.FieldTagsJSON]
+	return ok
+}
+
+// ResetTagsJSON resets all changes to the "tags_json" field.
+func (m *InventoryImageNormalizedMutation) ResetTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	delete(m.clearedFields, inventoryimagenormalized.FieldTagsJSON)
+}
+
+// SetWorkloadsJSON sets the "workloads_json" field.
+func (m *InventoryImageNormalizedMutation) SetWorkloadsJSON(s []string) {
+	m.workloads_json = &s
+	m.appendworkloads_json = nil
+}
+
+// WorkloadsJSON returns the value of the "workloads_json" field in the mutation.
+func (m *InventoryImageNormalizedMutation) WorkloadsJSON() (r []string, exists bool) {
+	v := m.workloads_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkloadsJSON returns the old "workloads_json" field's value of the InventoryImageNormalized entity.
+// If the InventoryImageNormalized object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageNormalizedMutation) OldWorkloadsJSON(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkloadsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkloadsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkloadsJSON: %w", err)
+	}
+	return oldValue.WorkloadsJSON, nil
+}
+
+// AppendWorkloadsJSON adds s to the "workloads_json" field.
+func (m *InventoryImageNormalizedMutation) AppendWorkloadsJSON(s []string) {
+	m.appendworkloads_json = append(m.appendworkloads_json, s...)
+}
+
+// AppendedWorkloadsJSON returns the list of values that were appended to the "workloads_json" field in this mutation.
+func (m *InventoryImageNormalizedMutation) AppendedWorkloadsJSON() ([]string, bool) {
+	if len(m.appendworkloads_json) == 0 {
+		return nil, false
+	}
+	return m.appendworkloads_json, true
+}
+
+// ClearWorkloadsJSON clears the value of the "workloads_json" field.
+func (m *InventoryImageNormalizedMutation) ClearWorkloadsJSON() {
+	m.workloads_json = nil
+	m.appendworkloads_json = nil
+	m.clearedFields[inventoryimagenormalized.FieldWorkloadsJSON] = struct{}{}
+}
+
+// WorkloadsJSONCleared returns if the "workloads_json" field was cleared in this mutation.
+func (m *InventoryImageNormalizedMutation) WorkloadsJSONCleared() bool {
+	_, ok := m.clearedFields[inventoryimagenormalized.FieldWorkloadsJSON]
+	return ok
+}
+
+// ResetWorkloadsJSON resets all changes to the "workloads_json" field.
+func (m *InventoryImageNormalizedMutation) ResetWorkloadsJSON() {
+	m.workloads_json = nil
+	m.appendworkloads_json = nil
+	delete(m.clearedFields, inventoryimagenormalized.FieldWorkloadsJSON)
+}
+
+// SetVulnerabilitiesJSON sets the "vulnerabilities_json" field.
+func (m *InventoryImageNormalizedMutation) SetVulnerabilitiesJSON(jm json.RawMessage) {
+	m.vulnerabilities_json = &jm
+	m.appendvulnerabilities_json = nil
+}
+
+// VulnerabilitiesJSON returns the value of the "vulnerabilities_json" field in the mutation.
+func (m *InventoryImageNormalizedMutation) VulnerabilitiesJSON() (r json.RawMessage, exists bool) {
+	v := m.vulnerabilities_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVulnerabilitiesJSON returns the old "vulnerabilities_json" field's value of the InventoryImageNormalized entity.
+// If the InventoryImageNormalized object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageNormalizedMutation) OldVulnerabilitiesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVulnerabilitiesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVulnerabilitiesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVulnerabilitiesJSON: %w", err)
+	}
+	return oldValue.VulnerabilitiesJSON, nil
+}
+
+// AppendVulnerabilitiesJSON adds jm to the "vulnerabilities_json" field.
+func (m *InventoryImageNormalizedMutation) AppendVulnerabilitiesJSON(jm json.RawMessage) {
+	m.appendvulnerabilities_json = append(m.appendvulnerabilities_json, jm...)
+}
+
+// AppendedVulnerabilitiesJSON returns the list of values that were appended to the "vulnerabilities_json" field in this mutation.
+func (m *InventoryImageNormalizedMutation) AppendedVulnerabilitiesJSON() (json.RawMessage, bool) {
+	if len(m.appendvulnerabilities_json) == 0 {
+		return nil, false
+	}
+	return m.appendvulnerabilities_json, true
+}
+
+// ClearVulnerabilitiesJSON clears the value of the "vulnerabilities_json" field.
+func (m *InventoryImageNormalizedMutation) ClearVulnerabilitiesJSON() {
+	m.vulnerabilities_json = nil
+	m.appendvulnerabilities_json = nil
+	m.clearedFields[inventoryimagenormalized.FieldVulnerabilitiesJSON] = struct{}{}
+}
+
+// VulnerabilitiesJSONCleared returns if the "vulnerabilities_json" field was cleared in this mutation.
+func (m *InventoryImageNormalizedMutation) VulnerabilitiesJSONCleared() bool {
+	_, ok := m.clearedFields[inventoryimagenormalized.FieldVulnerabilitiesJSON]
+	return ok
+}
+
+// ResetVulnerabilitiesJSON resets all changes to the "vulnerabilities_json" field.
+func (m *InventoryImageNormalizedMutation) ResetVulnerabilitiesJSON() {
+	m.vulnerabilities_json = nil
+	m.appendvulnerabilities_json = nil
+	delete(m.clearedFields, inventoryimagenormalized.FieldVulnerabilitiesJSON)
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *InventoryImageNormalizedMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *InventoryImageNormalizedMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the InventoryImageNormalized entity.
+// If the InventoryImageNormalized object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageNormalizedMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *InventoryImageNormalizedMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *InventoryImageNormalizedMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *InventoryImageNormalizedMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the InventoryImageNormalized entity.
+// If the InventoryImageNormalized object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageNormalizedMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *InventoryImageNormalizedMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetNormalizedAt sets the "normalized_at" field.
+func (m *InventoryImageNormalizedMutation) SetNormalizedAt(t time.Time) {
+	m.normalized_at = &t
+}
+
+// NormalizedAt returns the value of the "normalized_at" field in the mutation.
+func (m *InventoryImageNormalizedMutation) NormalizedAt() (r time.Time, exists bool) {
+	v := m.normalized_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNormalizedAt returns the old "normalized_at" field's value of the InventoryImageNormalized entity.
+// If the InventoryImageNormalized object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InventoryImageNormalizedMutation) OldNormalizedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNormalizedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNormalizedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNormalizedAt: %w", err)
+	}
+	return oldValue.NormalizedAt, nil
+}
+
+// ResetNormalizedAt resets all changes to the "normalized_at" field.
+func (m *InventoryImageNormalizedMutation) ResetNormalizedAt() {
+	m.normalized_at = nil
+}
+
+// Where appends a list predicates to the InventoryImageNormalizedMutation builder.
+func (m *InventoryImageNormalizedMutation) Where(ps ...predicate.InventoryImageNormalized) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the InventoryImageNormalizedMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InventoryImageNormalizedMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.InventoryImageNormalized, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *InventoryImageNormalizedMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *InventoryImageNormalizedMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (InventoryImageNormalized).
+func (m *InventoryImageNormalizedMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InventoryImageNormalizedMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.provider != nil {
+		fields = append(fields, inventoryimagenormalized.FieldProvider)
+	}
+	if m.is_base != nil {
+		fields = append(fields, inventoryimagenormalized.FieldIsBase)
+	}
+	if m.bronze_table != nil {
+		fields = append(fields, inventoryimagenormalized.FieldBronzeTable)
+	}
+	if m.bronze_resource_id != nil {
+		fields = append(fields, inventoryimagenormalized.FieldBronzeResourceID)
+	}
+	if m.registry != nil {
+		fields = append(fields, inventoryimagenormalized.FieldRegistry)
+	}
+	if m.repository != nil {
+		fields = append(fields, inventoryimagenormalized.FieldRepository)
+	}
+	if m.digest != nil {
+		fields = append(fields, inventoryimagenormalized.FieldDigest)
+	}
+	if m.tags_json != nil {
+		fields = append(fields, inventoryimagenormalized.FieldTagsJSON)
+	}
+	if m.workloads_json != nil {
+		fields = append(fields, inventoryimagenormalized.FieldWorkloadsJSON)
+	}
+	if m.vulnerabilities_json != nil {
+		fields = append(fields, inventoryimagenormalized.FieldVulnerabilitiesJSON)
+	}
+	if m.collected_at != nil {
+		fields = append(fields, inventoryimagenormalized.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, inventoryimagenormalized.FieldFirstCollectedAt)
+	}
+	if m.normalized_at != nil {
+		fields = append(fields, inventoryimagenormalized.FieldNormalizedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InventoryImageNormalizedMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case inventoryimagenormalized.FieldProvider:
+		return m.Provider()
+	case inventoryimagenormalized.FieldIsBase:
+		return m.IsBase()
+	case inventoryimagenormalized.FieldBronzeTable:
+		return m.BronzeTable()
+	case inventoryimagenormalized.FieldBronzeResourceID:
+		return m.BronzeResourceID()
+	case inventoryimagenormalized.FieldRegistry:
+		return m.Registry()
+	case inventoryimagenormalized.FieldRepository:
+		return m.Repository()
+	case inventoryimagenormalized.FieldDigest:
+		return m.Digest()
+	case inventoryimagenormalized.FieldTagsJSON:
+		return m.TagsJSON()
+	case inventoryimagenormalized.FieldWorkloadsJSON:
+		return m.WorkloadsJSON()
+	case inventoryimagenormalized.FieldVulnerabilitiesJSON:
+		return m.VulnerabilitiesJSON()
+	case inventoryimagenormalized.FieldCollectedAt:
+		return m.CollectedAt()
+	case inventoryimagenormalized.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case inventoryimagenormalized.FieldNormalizedAt:
+		return m.NormalizedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InventoryImageNormalizedMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case inventoryimagenormalized.FieldProvider:
+		return m.OldProvider(ctx)
+	case inventoryimagenormalized.FieldIsBase:
+		return m.OldIsBase(ctx)
+	case inventoryimagenormalized.FieldBronzeTable:
+		return m.OldBronzeTable(ctx)
+	case inventoryimagenormalized.FieldBronzeResourceID:
+		return m.OldBronzeResourceID(ctx)
+	case inventoryimagenormalized.FieldRegistry:
+		return m.OldRegistry(ctx)
+	case inventoryimagenormalized.FieldRepository:
+		return m.OldRepository(ctx)
+	case inventoryimagenormalized.FieldDigest:
+		return m.OldDigest(ctx)
+	case inventoryimagenormalized.FieldTagsJSON:
+		return m.OldTagsJSON(ctx)
+	case inventoryimagenormalized.FieldWorkloadsJSON:
+		return m.OldWorkloadsJSON(ctx)
+	case inventoryimagenormalized.FieldVulnerabilitiesJSON:
+		return m.OldVulnerabilitiesJSON(ctx)
+	case inventoryimagenormalized.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case inventoryimagenormalized.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case inventoryimagenormalized.FieldNormalizedAt:
+		return m.OldNormalizedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown InventoryImageNormalized field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InventoryImageNormalizedMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case inventoryimagenormalized.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case inventoryimagenormalized.FieldIsBase:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsBase(v)
+		return nil
+	case inventoryimagenormalized.FieldBronzeTable:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBronzeTable(v)
+		return nil
+	case inventoryimagenormalized.FieldBronzeResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBronzeResourceID(v)
+		return nil
+	case inventoryimagenormalized.FieldRegistry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegistry(v)
+		return nil
+	case inventoryimagenormalized.FieldRepository:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRepository(v)
+		return nil
+	case inventoryimagenormalized.FieldDigest:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDigest(v)
+		return nil
+	case inventoryimagenormalized.FieldTagsJSON:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagsJSON(v)
+		return nil
+	case inventoryimagenormalized.FieldWorkloadsJSON:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkloadsJSON(v)
+		return nil
+	case inventoryimagenormalized.FieldVulnerabilitiesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVulnerabilitiesJSON(v)
+		return nil
+	case inventoryimagenormalized.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case inventoryimagenormalized.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case inventoryimagenormalized.FieldNormalizedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNormalizedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown InventoryImageNormalized field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InventoryImageNormalizedMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InventoryImageNormalizedMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InventoryImageNormalizedMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown InventoryImageNormalized numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InventoryImageNormalizedMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(inventoryimagenormalized.FieldDigest) {
+		fields = append(fields, inventoryimagenormalized.FieldDigest)
+	}
+	if m.FieldCleared(inventoryimagenormalized.FieldTagsJSON) {
+		fields = append(fields, inventoryimagenormalized.FieldTagsJSON)
+	}
+	if m.FieldCleared(inventoryimagenormalized.FieldWorkloadsJSON) {
+		fields = append(fields, inventoryimagenormalized.FieldWorkloadsJSON)
+	}
+	if m.FieldCleared(inventoryimagenormalized.FieldVulnerabilitiesJSON) {
+		fields = append(fields, inventoryimagenormalized.FieldVulnerabilitiesJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InventoryImageNormalizedMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InventoryImageNormalizedMutation) ClearField(name string) error {
+	switch name {
+	case inventoryimagenormalized.FieldDigest:
+		m.ClearDigest()
+		return nil
+	case inventoryimagenormalized.FieldTagsJSON:
+		m.ClearTagsJSON()
+		return nil
+	case inventoryimagenormalized.FieldWorkloadsJSON:
+		m.ClearWorkloadsJSON()
+		return nil
+	case inventoryimagenormalized.FieldVulnerabilitiesJSON:
+		m.ClearVulnerabilitiesJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown InventoryImageNormalized nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InventoryImageNormalizedMutation) ResetField(name string) error {
+	switch name {
+	case inventoryimagenormalized.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case inventoryimagenormalized.FieldIsBase:
+		m.ResetIsBase()
+		return nil
+	case inventoryimagenormalized.FieldBronzeTable:
+		m.ResetBronzeTable()
+		return nil
+	case inventoryimagenormalized.FieldBronzeResourceID:
+		m.ResetBronzeResourceID()
+		return nil
+	case inventoryimagenormalized.FieldRegistry:
+		m.ResetRegistry()
+		return nil
+	case inventoryimagenormalized.FieldRepository:
+		m.ResetRepository()
+		return nil
+	case inventoryimagenormalized.FieldDigest:
+		m.ResetDigest()
+		return nil
+	case inventoryimagenormalized.FieldTagsJSON:
+		m.ResetTagsJSON()
+		return nil
+	case inventoryimagenormalized.FieldWorkloadsJSON:
+		m.ResetWorkloadsJSON()
+		return nil
+	case inventoryimagenormalized.FieldVulnerabilitiesJSON:
+		m.ResetVulnerabilitiesJSON()
+		return nil
+	case inventoryimagenormalized.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case inventoryimagenormalized.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case inventoryimagenormalized.FieldNormalizedAt:
+		m.ResetNormalizedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown InventoryImageNormalized field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InventoryImageNormalizedMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InventoryImageNormalizedMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InventoryImageNormalizedMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InventoryImageNormalizedMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InventoryImageNormalizedMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InventoryImageNormalizedMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InventoryImageNormalizedMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown InventoryImageNormalized unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InventoryImageNormalizedMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown InventoryImageNormalized edge %s", name)
+}
@@ -0,0 +1,16 @@
+// Code generated by ent, DO NOT EDIT.
+
+package predicate
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+// InventoryImage is the predicate function for inventoryimage builders.
+type InventoryImage func(*sql.Selector)
+
+// InventoryImageBronzeLink is the predicate function for inventoryimagebronzelink builders.
+type InventoryImageBronzeLink func(*sql.Selector)
+
+// InventoryImageNormalized is the predicate function for inventoryimagenormalized builders.
+type InventoryImageNormalized func(*sql.Selector)
@@ -0,0 +1,70 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimage"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagebronzelink"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/inventoryimagenormalized"
+	"danny.vn/hotpot/pkg/storage/ent/inventory/image/schema"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	inventoryimageFields := schema.InventoryImage{}.Fields()
+	_ = inventoryimageFields
+	// inventoryimageDescRegistry is the schema descriptor for registry field.
+	inventoryimageDescRegistry := inventoryimageFields[1].Descriptor()
+	// inventoryimage.RegistryValidator is a validator for the "registry" field. It is called by the builders before save.
+	inventoryimage.RegistryValidator = inventoryimageDescRegistry.Validators[0].(func(string) error)
+	// inventoryimageDescRepository is the schema descriptor for repository field.
+	inventoryimageDescRepository := inventoryimageFields[2].Descriptor()
+	// inventoryimage.RepositoryValidator is a validator for the "repository" field. It is called by the builders before save.
+	inventoryimage.RepositoryValidator = inventoryimageDescRepository.Validators[0].(func(string) error)
+	// inventoryimageDescDigest is the schema descriptor for digest field.
+	inventoryimageDescDigest := inventoryimageFields[3].Descriptor()
+	// inventoryimage.DefaultDigest holds the default value on creation for the digest field.
+	inventoryimage.DefaultDigest = inventoryimageDescDigest.Default.(string)
+	// inventoryimageDescVulnerabilityCount is the schema descriptor for vulnerability_count field.
+	inventoryimageDescVulnerabilityCount := inventoryimageFields[7].Descriptor()
+	// inventoryimage.DefaultVulnerabilityCount holds the default value on creation for the vulnerability_count field.
+	inventoryimage.DefaultVulnerabilityCount = inventoryimageDescVulnerabilityCount.Default.(int)
+	inventoryimagebronzelinkFields := schema.InventoryImageBronzeLink{}.Fields()
+	_ = inventoryimagebronzelinkFields
+	// inventoryimagebronzelinkDescProvider is the schema descriptor for provider field.
+	inventoryimagebronzelinkDescProvider := inventoryimagebronzelinkFields[0].Descriptor()
+	// inventoryimagebronzelink.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	inventoryimagebronzelink.ProviderValidator = inventoryimagebronzelinkDescProvider.Validators[0].(func(string) error)
+	// inventoryimagebronzelinkDescBronzeTable is the schema descriptor for bronze_table field.
+	inventoryimagebronzelinkDescBronzeTable := inventoryimagebronzelinkFields[1].Descriptor()
+	// inventoryimagebronzelink.BronzeTableValidator is a validator for the "bronze_table" field. It is called by the builders before save.
+	inventoryimagebronzelink.BronzeTableValidator = inventoryimagebronzelinkDescBronzeTable.Validators[0].(func(string) error)
+	// inventoryimagebronzelinkDescBronzeResourceID is the schema descriptor for bronze_resource_id field.
+	inventoryimagebronzelinkDescBronzeResourceID := inventoryimagebronzelinkFields[2].Descriptor()
+	// inventoryimagebronzelink.BronzeResourceIDValidator is a validator for the "bronze_resource_id" field. It is called by the builders before save.
+	inventoryimagebronzelink.BronzeResourceIDValidator = inventoryimagebronzelinkDescBronzeResourceID.Validators[0].(func(string) error)
+	inventoryimagenormalizedFields := schema.InventoryImageNormalized{}.Fields()
+	_ = inventoryimagenormalizedFields
+	// inventoryimagenormalizedDescProvider is the schema descriptor for provider field.
+	inventoryimagenormalizedDescProvider := inventoryimagenormalizedFields[1].Descriptor()
+	// inventoryimagenormalized.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	inventoryimagenormalized.ProviderValidator = inventoryimagenormalizedDescProvider.Validators[0].(func(string) error)
+	// inventoryimagenormalizedDescBronzeTable is the schema descriptor for bronze_table field.
+	inventoryimagenormalizedDescBronzeTable := inventoryimagenormalizedFields[3].Descriptor()
+	// inventoryimagenormalized.BronzeTableValidator is a validator for the "bronze_table" field. It is called by the builders before save.
+	inventoryimagenormalized.BronzeTableValidator = inventoryimagenormalizedDescBronzeTable.Validators[0].(func(string) error)
+	// inventoryimagenormalizedDescBronzeResourceID is the schema descriptor for bronze_resource_id field.
+	inventoryimagenormalizedDescBronzeResourceID := inventoryimagenormalizedFields[4].Descriptor()
+	// inventoryimagenormalized.BronzeResourceIDValidator is a validator for the "bronze_resource_id" field. It is called by the builders before save.
+	inventoryimagenormalized.BronzeResourceIDValidator = inventoryimagenormalizedDescBronzeResourceID.Validators[0].(func(string) error)
+	// inventoryimagenormalizedDescRegistry is the schema descriptor for registry field.
+	inventoryimagenormalizedDescRegistry := inventoryimagenormalizedFields[5].Descriptor()
+	// inventoryimagenormalized.RegistryValidator is a validator for the "registry" field. It is called by the builders before save.
+	inventoryimagenormalized.RegistryValidator = inventoryimagenormalizedDescRegistry.Validators[0].(func(string) error)
+	// inventoryimagenormalizedDescRepository is the schema descriptor for repository field.
+	inventoryimagenormalizedDescRepository := inventoryimagenormalizedFields[6].Descriptor()
+	// inventoryimagenormalized.RepositoryValidator is a validator for the "repository" field. It is called by the builders before save.
+	inventoryimagenormalized.RepositoryValidator = inventoryimagenormalizedDescRepository.Validators[0].(func(string) error)
+}
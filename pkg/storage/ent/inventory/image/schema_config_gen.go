@@ -0,0 +1,11 @@
+// Code generated by entcgen. DO NOT EDIT.
+package image
+
+// DefaultSchemaConfig returns the schema config mapping each type to its PG schema.
+func DefaultSchemaConfig() SchemaConfig {
+	return SchemaConfig{
+		InventoryImage:           "silver",
+		InventoryImageBronzeLink: "silver",
+		InventoryImageNormalized: "silver",
+	}
+}
@@ -0,0 +1,239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8simage"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryK8sImage is the model entity for the BronzeHistoryK8sImage schema.
+type BronzeHistoryK8sImage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze image by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// ClusterName holds the value of the "cluster_name" field.
+	ClusterName string `json:"cluster_name,omitempty"`
+	// e.g. docker.io, gcr.io, registry.digitalocean.com
+	Registry string `json:"registry,omitempty"`
+	// e.g. library/nginx
+	Repository string `json:"repository,omitempty"`
+	// sha256:... from the container status image ID
+	Digest string `json:"digest,omitempty"`
+	// Tags the image is referenced by
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// NamespacesJSON holds the value of the "namespaces_json" field.
+	NamespacesJSON json.RawMessage `json:"namespaces_json,omitempty"`
+	// Workloads running the image: {namespace}/{kind}/{name}
+	WorkloadsJSON json.RawMessage `json:"workloads_json,omitempty"`
+	selectValues  sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryK8sImage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryk8simage.FieldTagsJSON, bronzehistoryk8simage.FieldNamespacesJSON, bronzehistoryk8simage.FieldWorkloadsJSON:
+			values[i] = new([]byte)
+		case bronzehistoryk8simage.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryk8simage.FieldResourceID, bronzehistoryk8simage.FieldClusterName, bronzehistoryk8simage.FieldRegistry, bronzehistoryk8simage.FieldRepository, bronzehistoryk8simage.FieldDigest:
+			values[i] = new(sql.NullString)
+		case bronzehistoryk8simage.FieldValidFrom, bronzehistoryk8simage.FieldValidTo, bronzehistoryk8simage.FieldCollectedAt, bronzehistoryk8simage.FieldFirstCollectedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryK8sImage fields.
+func (_m *BronzeHistoryK8sImage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryk8simage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryk8simage.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryk8simage.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryk8simage.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryk8simage.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryk8simage.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryk8simage.FieldClusterName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_name", values[i])
+			} else if value.Valid {
+				_m.ClusterName = value.String
+			}
+		case bronzehistoryk8simage.FieldRegistry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field registry", values[i])
+			} else if value.Valid {
+				_m.Registry = value.String
+			}
+		case bronzehistoryk8simage.FieldRepository:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field repository", values[i])
+			} else if value.Valid {
+				_m.Repository = value.String
+			}
+		case bronzehistoryk8simage.FieldDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field digest", values[i])
+			} else if value.Valid {
+				_m.Digest = value.String
+			}
+		case bronzehistoryk8simage.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzehistoryk8simage.FieldNamespacesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field namespaces_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.NamespacesJSON); err != nil {
+					return fmt.Errorf("unmarshal field namespaces_json: %w", err)
+				}
+			}
+		case bronzehistoryk8simage.FieldWorkloadsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field workloads_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.WorkloadsJSON); err != nil {
+					return fmt.Errorf("unmarshal field workloads_json: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryK8sImage.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryK8sImage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryK8sImage.
+// Note that you need to call BronzeHistoryK8sImage.Unwrap() before calling this method if this BronzeHistoryK8sImage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryK8sImage) Update() *BronzeHistoryK8sImageUpdateOne {
+	return NewBronzeHistoryK8sImageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryK8sImage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryK8sImage) Unwrap() *BronzeHistoryK8sImage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("kubernetes: BronzeHistoryK8sImage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryK8sImage) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryK8sImage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("cluster_name=")
+	builder.WriteString(_m.ClusterName)
+	builder.WriteString(", ")
+	builder.WriteString("registry=")
+	builder.WriteString(_m.Registry)
+	builder.WriteString(", ")
+	builder.WriteString("repository=")
+	builder.WriteString(_m.Repository)
+	builder.WriteString(", ")
+	builder.WriteString("digest=")
+	builder.WriteString(_m.Digest)
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("namespaces_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NamespacesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("workloads_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkloadsJSON))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryK8sImages is a parsable slice of BronzeHistoryK8sImage.
+type BronzeHistoryK8sImages []*BronzeHistoryK8sImage
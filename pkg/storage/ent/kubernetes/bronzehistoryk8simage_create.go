@@ -0,0 +1,362 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8simage"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryK8sImageCreate is the builder for creating a BronzeHistoryK8sImage entity.
+type BronzeHistoryK8sImageCreate struct {
+	config
+	mutation *BronzeHistoryK8sImageMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryK8sImageCreate) SetValidFrom(v time.Time) *BronzeHistoryK8sImageCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryK8sImageCreate) SetValidTo(v time.Time) *BronzeHistoryK8sImageCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryK8sImageCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sImageCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryK8sImageCreate) SetCollectedAt(v time.Time) *BronzeHistoryK8sImageCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryK8sImageCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sImageCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryK8sImageCreate) SetResourceID(v string) *BronzeHistoryK8sImageCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_c *BronzeHistoryK8sImageCreate) SetClusterName(v string) *BronzeHistoryK8sImageCreate {
+	_c.mutation.SetClusterName(v)
+	return _c
+}
+
+// SetRegistry sets the "registry" field.
+func (_c *BronzeHistoryK8sImageCreate) SetRegistry(v string) *BronzeHistoryK8sImageCreate {
+	_c.mutation.SetRegistry(v)
+	return _c
+}
+
+// SetRepository sets the "repository" field.
+func (_c *BronzeHistoryK8sImageCreate) SetRepository(v string) *BronzeHistoryK8sImageCreate {
+	_c.mutation.SetRepository(v)
+	return _c
+}
+
+// SetDigest sets the "digest" field.
+func (_c *BronzeHistoryK8sImageCreate) SetDigest(v string) *BronzeHistoryK8sImageCreate {
+	_c.mutation.SetDigest(v)
+	return _c
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (_c *BronzeHistoryK8sImageCreate) SetNillableDigest(v *string) *BronzeHistoryK8sImageCreate {
+	if v != nil {
+		_c.SetDigest(*v)
+	}
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeHistoryK8sImageCreate) SetTagsJSON(v json.RawMessage) *BronzeHistoryK8sImageCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetNamespacesJSON sets the "namespaces_json" field.
+func (_c *BronzeHistoryK8sImageCreate) SetNamespacesJSON(v json.RawMessage) *BronzeHistoryK8sImageCreate {
+	_c.mutation.SetNamespacesJSON(v)
+	return _c
+}
+
+// SetWorkloadsJSON sets the "workloads_json" field.
+func (_c *BronzeHistoryK8sImageCreate) SetWorkloadsJSON(v json.RawMessage) *BronzeHistoryK8sImageCreate {
+	_c.mutation.SetWorkloadsJSON(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryK8sImageCreate) SetID(v uint) *BronzeHistoryK8sImageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryK8sImageMutation object of the builder.
+func (_c *BronzeHistoryK8sImageCreate) Mutation() *BronzeHistoryK8sImageMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryK8sImage in the database.
+func (_c *BronzeHistoryK8sImageCreate) Save(ctx context.Context) (*BronzeHistoryK8sImage, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryK8sImageCreate) SaveX(ctx context.Context) *BronzeHistoryK8sImage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryK8sImageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryK8sImageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryK8sImageCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sImage.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sImage.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sImage.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sImage.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8simage.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sImage.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClusterName(); !ok {
+		return &ValidationError{Name: "cluster_name", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sImage.cluster_name"`)}
+	}
+	if v, ok := _c.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8simage.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sImage.cluster_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Registry(); !ok {
+		return &ValidationError{Name: "registry", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sImage.registry"`)}
+	}
+	if v, ok := _c.mutation.Registry(); ok {
+		if err := bronzehistoryk8simage.RegistryValidator(v); err != nil {
+			return &ValidationError{Name: "registry", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sImage.registry": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Repository(); !ok {
+		return &ValidationError{Name: "repository", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sImage.repository"`)}
+	}
+	if v, ok := _c.mutation.Repository(); ok {
+		if err := bronzehistoryk8simage.RepositoryValidator(v); err != nil {
+			return &ValidationError{Name: "repository", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sImage.repository": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryK8sImageCreate) sqlSave(ctx context.Context) (*BronzeHistoryK8sImage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryK8sImageCreate) createSpec() (*BronzeHistoryK8sImage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryK8sImage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryk8simage.Table, sqlgraph.NewFieldSpec(bronzehistoryk8simage.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryK8sImage
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldClusterName, field.TypeString, value)
+		_node.ClusterName = value
+	}
+	if value, ok := _c.mutation.Registry(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldRegistry, field.TypeString, value)
+		_node.Registry = value
+	}
+	if value, ok := _c.mutation.Repository(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldRepository, field.TypeString, value)
+		_node.Repository = value
+	}
+	if value, ok := _c.mutation.Digest(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldDigest, field.TypeString, value)
+		_node.Digest = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.NamespacesJSON(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldNamespacesJSON, field.TypeJSON, value)
+		_node.NamespacesJSON = value
+	}
+	if value, ok := _c.mutation.WorkloadsJSON(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldWorkloadsJSON, field.TypeJSON, value)
+		_node.WorkloadsJSON = value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryK8sImageCreateBulk is the builder for creating many BronzeHistoryK8sImage entities in bulk.
+type BronzeHistoryK8sImageCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryK8sImageCreate
+}
+
+// Save creates the BronzeHistoryK8sImage entities in the database.
+func (_c *BronzeHistoryK8sImageCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryK8sImage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryK8sImage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryK8sImageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryK8sImageCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryK8sImage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryK8sImageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryK8sImageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8simage"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryK8sImageDelete is the builder for deleting a BronzeHistoryK8sImage entity.
+type BronzeHistoryK8sImageDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryK8sImageMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sImageDelete builder.
+func (_d *BronzeHistoryK8sImageDelete) Where(ps ...predicate.BronzeHistoryK8sImage) *BronzeHistoryK8sImageDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeHistoryK8sImageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryK8sImageDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeHistoryK8sImageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzehistoryk8simage.Table, sqlgraph.NewFieldSpec(bronzehistoryk8simage.FieldID, field.TypeUint))
+	_spec.Node.Schema = _d.schemaConfig.BronzeHistoryK8sImage
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeHistoryK8sImageDeleteOne is the builder for deleting a single BronzeHistoryK8sImage entity.
+type BronzeHistoryK8sImageDeleteOne struct {
+	_d *BronzeHistoryK8sImageDelete
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sImageDelete builder.
+func (_d *BronzeHistoryK8sImageDeleteOne) Where(ps ...predicate.BronzeHistoryK8sImage) *BronzeHistoryK8sImageDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeHistoryK8sImageDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzehistoryk8simage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryK8sImageDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
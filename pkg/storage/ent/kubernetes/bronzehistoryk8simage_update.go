@@ -0,0 +1,721 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8simage"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryK8sImageUpdate is the builder for updating BronzeHistoryK8sImage entities.
+type BronzeHistoryK8sImageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryK8sImageMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sImageUpdate builder.
+func (_u *BronzeHistoryK8sImageUpdate) Where(ps ...predicate.BronzeHistoryK8sImage) *BronzeHistoryK8sImageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryK8sImageUpdate) SetValidTo(v time.Time) *BronzeHistoryK8sImageUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryK8sImageUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sImageUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryK8sImageUpdate) ClearValidTo() *BronzeHistoryK8sImageUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryK8sImageUpdate) SetCollectedAt(v time.Time) *BronzeHistoryK8sImageUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sImageUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryK8sImageUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryK8sImageUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sImageUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sImageUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryK8sImageUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryK8sImageUpdate) SetResourceID(v string) *BronzeHistoryK8sImageUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryK8sImageUpdate) SetNillableResourceID(v *string) *BronzeHistoryK8sImageUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeHistoryK8sImageUpdate) SetClusterName(v string) *BronzeHistoryK8sImageUpdate {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sImageUpdate) SetNillableClusterName(v *string) *BronzeHistoryK8sImageUpdate {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetRegistry sets the "registry" field.
+func (_u *BronzeHistoryK8sImageUpdate) SetRegistry(v string) *BronzeHistoryK8sImageUpdate {
+	_u.mutation.SetRegistry(v)
+	return _u
+}
+
+// SetNillableRegistry sets the "registry" field if the given value is not nil.
+func (_u *BronzeHistoryK8sImageUpdate) SetNillableRegistry(v *string) *BronzeHistoryK8sImageUpdate {
+	if v != nil {
+		_u.SetRegistry(*v)
+	}
+	return _u
+}
+
+// SetRepository sets the "repository" field.
+func (_u *BronzeHistoryK8sImageUpdate) SetRepository(v string) *BronzeHistoryK8sImageUpdate {
+	_u.mutation.SetRepository(v)
+	return _u
+}
+
+// SetNillableRepository sets the "repository" field if the given value is not nil.
+func (_u *BronzeHistoryK8sImageUpdate) SetNillableRepository(v *string) *BronzeHistoryK8sImageUpdate {
+	if v != nil {
+		_u.SetRepository(*v)
+	}
+	return _u
+}
+
+// SetDigest sets the "digest" field.
+func (_u *BronzeHistoryK8sImageUpdate) SetDigest(v string) *BronzeHistoryK8sImageUpdate {
+	_u.mutation.SetDigest(v)
+	return _u
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (_u *BronzeHistoryK8sImageUpdate) SetNillableDigest(v *string) *BronzeHistoryK8sImageUpdate {
+	if v != nil {
+		_u.SetDigest(*v)
+	}
+	return _u
+}
+
+// ClearDigest clears the value of the "digest" field.
+func (_u *BronzeHistoryK8sImageUpdate) ClearDigest() *BronzeHistoryK8sImageUpdate {
+	_u.mutation.ClearDigest()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryK8sImageUpdate) SetTagsJSON(v json.RawMessage) *BronzeHistoryK8sImageUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryK8sImageUpdate) AppendTagsJSON(v json.RawMessage) *BronzeHistoryK8sImageUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryK8sImageUpdate) ClearTagsJSON() *BronzeHistoryK8sImageUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetNamespacesJSON sets the "namespaces_json" field.
+func (_u *BronzeHistoryK8sImageUpdate) SetNamespacesJSON(v json.RawMessage) *BronzeHistoryK8sImageUpdate {
+	_u.mutation.SetNamespacesJSON(v)
+	return _u
+}
+
+// AppendNamespacesJSON appends value to the "namespaces_json" field.
+func (_u *BronzeHistoryK8sImageUpdate) AppendNamespacesJSON(v json.RawMessage) *BronzeHistoryK8sImageUpdate {
+	_u.mutation.AppendNamespacesJSON(v)
+	return _u
+}
+
+// ClearNamespacesJSON clears the value of the "namespaces_json" field.
+func (_u *BronzeHistoryK8sImageUpdate) ClearNamespacesJSON() *BronzeHistoryK8sImageUpdate {
+	_u.mutation.ClearNamespacesJSON()
+	return _u
+}
+
+// SetWorkloadsJSON sets the "workloads_json" field.
+func (_u *BronzeHistoryK8sImageUpdate) SetWorkloadsJSON(v json.RawMessage) *BronzeHistoryK8sImageUpdate {
+	_u.mutation.SetWorkloadsJSON(v)
+	return _u
+}
+
+// AppendWorkloadsJSON appends value to the "workloads_json" field.
+func (_u *BronzeHistoryK8sImageUpdate) AppendWorkloadsJSON(v json.RawMessage) *BronzeHistoryK8sImageUpdate {
+	_u.mutation.AppendWorkloadsJSON(v)
+	return _u
+}
+
+// ClearWorkloadsJSON clears the value of the "workloads_json" field.
+func (_u *BronzeHistoryK8sImageUpdate) ClearWorkloadsJSON() *BronzeHistoryK8sImageUpdate {
+	_u.mutation.ClearWorkloadsJSON()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryK8sImageMutation object of the builder.
+func (_u *BronzeHistoryK8sImageUpdate) Mutation() *BronzeHistoryK8sImageMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryK8sImageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryK8sImageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryK8sImageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryK8sImageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryK8sImageUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8simage.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sImage.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8simage.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sImage.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Registry(); ok {
+		if err := bronzehistoryk8simage.RegistryValidator(v); err != nil {
+			return &ValidationError{Name: "registry", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sImage.registry": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Repository(); ok {
+		if err := bronzehistoryk8simage.RepositoryValidator(v); err != nil {
+			return &ValidationError{Name: "repository", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sImage.repository": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryK8sImageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryk8simage.Table, bronzehistoryk8simage.Columns, sqlgraph.NewFieldSpec(bronzehistoryk8simage.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryk8simage.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Registry(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldRegistry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Repository(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldRepository, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Digest(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldDigest, field.TypeString, value)
+	}
+	if _u.mutation.DigestCleared() {
+		_spec.ClearField(bronzehistoryk8simage.FieldDigest, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8simage.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8simage.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.NamespacesJSON(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldNamespacesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedNamespacesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8simage.FieldNamespacesJSON, value)
+		})
+	}
+	if _u.mutation.NamespacesJSONCleared() {
+		_spec.ClearField(bronzehistoryk8simage.FieldNamespacesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.WorkloadsJSON(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldWorkloadsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWorkloadsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8simage.FieldWorkloadsJSON, value)
+		})
+	}
+	if _u.mutation.WorkloadsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8simage.FieldWorkloadsJSON, field.TypeJSON)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryK8sImage
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryk8simage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryK8sImageUpdateOne is the builder for updating a single BronzeHistoryK8sImage entity.
+type BronzeHistoryK8sImageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryK8sImageMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryK8sImageUpdateOne) SetValidTo(v time.Time) *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryK8sImageUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sImageUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryK8sImageUpdateOne) ClearValidTo() *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryK8sImageUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sImageUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryK8sImageUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryK8sImageUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sImageUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryK8sImageUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryK8sImageUpdateOne) SetResourceID(v string) *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryK8sImageUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryK8sImageUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeHistoryK8sImageUpdateOne) SetClusterName(v string) *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sImageUpdateOne) SetNillableClusterName(v *string) *BronzeHistoryK8sImageUpdateOne {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetRegistry sets the "registry" field.
+func (_u *BronzeHistoryK8sImageUpdateOne) SetRegistry(v string) *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.SetRegistry(v)
+	return _u
+}
+
+// SetNillableRegistry sets the "registry" field if the given value is not nil.
+func (_u *BronzeHistoryK8sImageUpdateOne) SetNillableRegistry(v *string) *BronzeHistoryK8sImageUpdateOne {
+	if v != nil {
+		_u.SetRegistry(*v)
+	}
+	return _u
+}
+
+// SetRepository sets the "repository" field.
+func (_u *BronzeHistoryK8sImageUpdateOne) SetRepository(v string) *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.SetRepository(v)
+	return _u
+}
+
+// SetNillableRepository sets the "repository" field if the given value is not nil.
+func (_u *BronzeHistoryK8sImageUpdateOne) SetNillableRepository(v *string) *BronzeHistoryK8sImageUpdateOne {
+	if v != nil {
+		_u.SetRepository(*v)
+	}
+	return _u
+}
+
+// SetDigest sets the "digest" field.
+func (_u *BronzeHistoryK8sImageUpdateOne) SetDigest(v string) *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.SetDigest(v)
+	return _u
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (_u *BronzeHistoryK8sImageUpdateOne) SetNillableDigest(v *string) *BronzeHistoryK8sImageUpdateOne {
+	if v != nil {
+		_u.SetDigest(*v)
+	}
+	return _u
+}
+
+// ClearDigest clears the value of the "digest" field.
+func (_u *BronzeHistoryK8sImageUpdateOne) ClearDigest() *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.ClearDigest()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeHistoryK8sImageUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeHistoryK8sImageUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeHistoryK8sImageUpdateOne) ClearTagsJSON() *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetNamespacesJSON sets the "namespaces_json" field.
+func (_u *BronzeHistoryK8sImageUpdateOne) SetNamespacesJSON(v json.RawMessage) *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.SetNamespacesJSON(v)
+	return _u
+}
+
+// AppendNamespacesJSON appends value to the "namespaces_json" field.
+func (_u *BronzeHistoryK8sImageUpdateOne) AppendNamespacesJSON(v json.RawMessage) *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.AppendNamespacesJSON(v)
+	return _u
+}
+
+// ClearNamespacesJSON clears the value of the "namespaces_json" field.
+func (_u *BronzeHistoryK8sImageUpdateOne) ClearNamespacesJSON() *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.ClearNamespacesJSON()
+	return _u
+}
+
+// SetWorkloadsJSON sets the "workloads_json" field.
+func (_u *BronzeHistoryK8sImageUpdateOne) SetWorkloadsJSON(v json.RawMessage) *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.SetWorkloadsJSON(v)
+	return _u
+}
+
+// AppendWorkloadsJSON appends value to the "workloads_json" field.
+func (_u *BronzeHistoryK8sImageUpdateOne) AppendWorkloadsJSON(v json.RawMessage) *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.AppendWorkloadsJSON(v)
+	return _u
+}
+
+// ClearWorkloadsJSON clears the value of the "workloads_json" field.
+func (_u *BronzeHistoryK8sImageUpdateOne) ClearWorkloadsJSON() *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.ClearWorkloadsJSON()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryK8sImageMutation object of the builder.
+func (_u *BronzeHistoryK8sImageUpdateOne) Mutation() *BronzeHistoryK8sImageMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sImageUpdate builder.
+func (_u *BronzeHistoryK8sImageUpdateOne) Where(ps ...predicate.BronzeHistoryK8sImage) *BronzeHistoryK8sImageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryK8sImageUpdateOne) Select(field string, fields ...string) *BronzeHistoryK8sImageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryK8sImage entity.
+func (_u *BronzeHistoryK8sImageUpdateOne) Save(ctx context.Context) (*BronzeHistoryK8sImage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryK8sImageUpdateOne) SaveX(ctx context.Context) *BronzeHistoryK8sImage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryK8sImageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryK8sImageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryK8sImageUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8simage.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sImage.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8simage.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sImage.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Registry(); ok {
+		if err := bronzehistoryk8simage.RegistryValidator(v); err != nil {
+			return &ValidationError{Name: "registry", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sImage.registry": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Repository(); ok {
+		if err := bronzehistoryk8simage.RepositoryValidator(v); err != nil {
+			return &ValidationError{Name: "repository", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sImage.repository": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryK8sImageUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryK8sImage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryk8simage.Table, bronzehistoryk8simage.Columns, sqlgraph.NewFieldSpec(bronzehistoryk8simage.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`kubernetes: missing "BronzeHistoryK8sImage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryk8simage.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryk8simage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("kubernetes: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryk8simage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryk8simage.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Registry(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldRegistry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Repository(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldRepository, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Digest(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldDigest, field.TypeString, value)
+	}
+	if _u.mutation.DigestCleared() {
+		_spec.ClearField(bronzehistoryk8simage.FieldDigest, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8simage.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8simage.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.NamespacesJSON(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldNamespacesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedNamespacesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8simage.FieldNamespacesJSON, value)
+		})
+	}
+	if _u.mutation.NamespacesJSONCleared() {
+		_spec.ClearField(bronzehistoryk8simage.FieldNamespacesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.WorkloadsJSON(); ok {
+		_spec.SetField(bronzehistoryk8simage.FieldWorkloadsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWorkloadsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8simage.FieldWorkloadsJSON, value)
+		})
+	}
+	if _u.mutation.WorkloadsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8simage.FieldWorkloadsJSON, field.TypeJSON)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryK8sImage
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryK8sImage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryk8simage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
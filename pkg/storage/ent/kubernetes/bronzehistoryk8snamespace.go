@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snamespace"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryK8sNamespace is the model entity for the BronzeHistoryK8sNamespace schema.
+type BronzeHistoryK8sNamespace struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze namespace by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// Cluster name from kubernetes.clusters config
+	ClusterName string `json:"cluster_name,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// Active or Terminating
+	Phase string `json:"phase,omitempty"`
+	// LabelsJSON holds the value of the "labels_json" field.
+	LabelsJSON json.RawMessage `json:"labels_json,omitempty"`
+	// APICreatedAt holds the value of the "api_created_at" field.
+	APICreatedAt *time.Time `json:"api_created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryK8sNamespace) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryk8snamespace.FieldLabelsJSON:
+			values[i] = new([]byte)
+		case bronzehistoryk8snamespace.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryk8snamespace.FieldResourceID, bronzehistoryk8snamespace.FieldClusterName, bronzehistoryk8snamespace.FieldName, bronzehistoryk8snamespace.FieldUID, bronzehistoryk8snamespace.FieldPhase:
+			values[i] = new(sql.NullString)
+		case bronzehistoryk8snamespace.FieldValidFrom, bronzehistoryk8snamespace.FieldValidTo, bronzehistoryk8snamespace.FieldCollectedAt, bronzehistoryk8snamespace.FieldFirstCollectedAt, bronzehistoryk8snamespace.FieldAPICreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryK8sNamespace fields.
+func (_m *BronzeHistoryK8sNamespace) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryk8snamespace.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryk8snamespace.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryk8snamespace.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryk8snamespace.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryk8snamespace.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryk8snamespace.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryk8snamespace.FieldClusterName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_name", values[i])
+			} else if value.Valid {
+				_m.ClusterName = value.String
+			}
+		case bronzehistoryk8snamespace.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzehistoryk8snamespace.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				_m.UID = value.String
+			}
+		case bronzehistoryk8snamespace.FieldPhase:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phase", values[i])
+			} else if value.Valid {
+				_m.Phase = value.String
+			}
+		case bronzehistoryk8snamespace.FieldLabelsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LabelsJSON); err != nil {
+					return fmt.Errorf("unmarshal field labels_json: %w", err)
+				}
+			}
+		case bronzehistoryk8snamespace.FieldAPICreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_created_at", values[i])
+			} else if value.Valid {
+				_m.APICreatedAt = new(time.Time)
+				*_m.APICreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryK8sNamespace.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryK8sNamespace) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryK8sNamespace.
+// Note that you need to call BronzeHistoryK8sNamespace.Unwrap() before calling this method if this BronzeHistoryK8sNamespace
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryK8sNamespace) Update() *BronzeHistoryK8sNamespaceUpdateOne {
+	return NewBronzeHistoryK8sNamespaceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryK8sNamespace entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryK8sNamespace) Unwrap() *BronzeHistoryK8sNamespace {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("kubernetes: BronzeHistoryK8sNamespace is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryK8sNamespace) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryK8sNamespace(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("cluster_name=")
+	builder.WriteString(_m.ClusterName)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("uid=")
+	builder.WriteString(_m.UID)
+	builder.WriteString(", ")
+	builder.WriteString("phase=")
+	builder.WriteString(_m.Phase)
+	builder.WriteString(", ")
+	builder.WriteString("labels_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LabelsJSON))
+	builder.WriteString(", ")
+	if v := _m.APICreatedAt; v != nil {
+		builder.WriteString("api_created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryK8sNamespaces is a parsable slice of BronzeHistoryK8sNamespace.
+type BronzeHistoryK8sNamespaces []*BronzeHistoryK8sNamespace
@@ -0,0 +1,695 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryk8snamespace
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLTE(FieldID, id))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldValidTo, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ClusterName applies equality check predicate on the "cluster_name" field. It's identical to ClusterNameEQ.
+func ClusterName(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldClusterName, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldName, v))
+}
+
+// UID applies equality check predicate on the "uid" field. It's identical to UIDEQ.
+func UID(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldUID, v))
+}
+
+// Phase applies equality check predicate on the "phase" field. It's identical to PhaseEQ.
+func Phase(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldPhase, v))
+}
+
+// APICreatedAt applies equality check predicate on the "api_created_at" field. It's identical to APICreatedAtEQ.
+func APICreatedAt(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNotNull(FieldValidTo))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// ClusterNameEQ applies the EQ predicate on the "cluster_name" field.
+func ClusterNameEQ(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldClusterName, v))
+}
+
+// ClusterNameNEQ applies the NEQ predicate on the "cluster_name" field.
+func ClusterNameNEQ(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNEQ(FieldClusterName, v))
+}
+
+// ClusterNameIn applies the In predicate on the "cluster_name" field.
+func ClusterNameIn(vs ...string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldIn(FieldClusterName, vs...))
+}
+
+// ClusterNameNotIn applies the NotIn predicate on the "cluster_name" field.
+func ClusterNameNotIn(vs ...string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNotIn(FieldClusterName, vs...))
+}
+
+// ClusterNameGT applies the GT predicate on the "cluster_name" field.
+func ClusterNameGT(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGT(FieldClusterName, v))
+}
+
+// ClusterNameGTE applies the GTE predicate on the "cluster_name" field.
+func ClusterNameGTE(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGTE(FieldClusterName, v))
+}
+
+// ClusterNameLT applies the LT predicate on the "cluster_name" field.
+func ClusterNameLT(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLT(FieldClusterName, v))
+}
+
+// ClusterNameLTE applies the LTE predicate on the "cluster_name" field.
+func ClusterNameLTE(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLTE(FieldClusterName, v))
+}
+
+// ClusterNameContains applies the Contains predicate on the "cluster_name" field.
+func ClusterNameContains(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldContains(FieldClusterName, v))
+}
+
+// ClusterNameHasPrefix applies the HasPrefix predicate on the "cluster_name" field.
+func ClusterNameHasPrefix(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldHasPrefix(FieldClusterName, v))
+}
+
+// ClusterNameHasSuffix applies the HasSuffix predicate on the "cluster_name" field.
+func ClusterNameHasSuffix(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldHasSuffix(FieldClusterName, v))
+}
+
+// ClusterNameEqualFold applies the EqualFold predicate on the "cluster_name" field.
+func ClusterNameEqualFold(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEqualFold(FieldClusterName, v))
+}
+
+// ClusterNameContainsFold applies the ContainsFold predicate on the "cluster_name" field.
+func ClusterNameContainsFold(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldContainsFold(FieldClusterName, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldContainsFold(FieldName, v))
+}
+
+// UIDEQ applies the EQ predicate on the "uid" field.
+func UIDEQ(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldUID, v))
+}
+
+// UIDNEQ applies the NEQ predicate on the "uid" field.
+func UIDNEQ(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNEQ(FieldUID, v))
+}
+
+// UIDIn applies the In predicate on the "uid" field.
+func UIDIn(vs ...string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldIn(FieldUID, vs...))
+}
+
+// UIDNotIn applies the NotIn predicate on the "uid" field.
+func UIDNotIn(vs ...string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNotIn(FieldUID, vs...))
+}
+
+// UIDGT applies the GT predicate on the "uid" field.
+func UIDGT(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGT(FieldUID, v))
+}
+
+// UIDGTE applies the GTE predicate on the "uid" field.
+func UIDGTE(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGTE(FieldUID, v))
+}
+
+// UIDLT applies the LT predicate on the "uid" field.
+func UIDLT(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLT(FieldUID, v))
+}
+
+// UIDLTE applies the LTE predicate on the "uid" field.
+func UIDLTE(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLTE(FieldUID, v))
+}
+
+// UIDContains applies the Contains predicate on the "uid" field.
+func UIDContains(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldContains(FieldUID, v))
+}
+
+// UIDHasPrefix applies the HasPrefix predicate on the "uid" field.
+func UIDHasPrefix(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldHasPrefix(FieldUID, v))
+}
+
+// UIDHasSuffix applies the HasSuffix predicate on the "uid" field.
+func UIDHasSuffix(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldHasSuffix(FieldUID, v))
+}
+
+// UIDIsNil applies the IsNil predicate on the "uid" field.
+func UIDIsNil() predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldIsNull(FieldUID))
+}
+
+// UIDNotNil applies the NotNil predicate on the "uid" field.
+func UIDNotNil() predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNotNull(FieldUID))
+}
+
+// UIDEqualFold applies the EqualFold predicate on the "uid" field.
+func UIDEqualFold(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEqualFold(FieldUID, v))
+}
+
+// UIDContainsFold applies the ContainsFold predicate on the "uid" field.
+func UIDContainsFold(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldContainsFold(FieldUID, v))
+}
+
+// PhaseEQ applies the EQ predicate on the "phase" field.
+func PhaseEQ(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldPhase, v))
+}
+
+// PhaseNEQ applies the NEQ predicate on the "phase" field.
+func PhaseNEQ(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNEQ(FieldPhase, v))
+}
+
+// PhaseIn applies the In predicate on the "phase" field.
+func PhaseIn(vs ...string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldIn(FieldPhase, vs...))
+}
+
+// PhaseNotIn applies the NotIn predicate on the "phase" field.
+func PhaseNotIn(vs ...string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNotIn(FieldPhase, vs...))
+}
+
+// PhaseGT applies the GT predicate on the "phase" field.
+func PhaseGT(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGT(FieldPhase, v))
+}
+
+// PhaseGTE applies the GTE predicate on the "phase" field.
+func PhaseGTE(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGTE(FieldPhase, v))
+}
+
+// PhaseLT applies the LT predicate on the "phase" field.
+func PhaseLT(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLT(FieldPhase, v))
+}
+
+// PhaseLTE applies the LTE predicate on the "phase" field.
+func PhaseLTE(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLTE(FieldPhase, v))
+}
+
+// PhaseContains applies the Contains predicate on the "phase" field.
+func PhaseContains(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldContains(FieldPhase, v))
+}
+
+// PhaseHasPrefix applies the HasPrefix predicate on the "phase" field.
+func PhaseHasPrefix(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldHasPrefix(FieldPhase, v))
+}
+
+// PhaseHasSuffix applies the HasSuffix predicate on the "phase" field.
+func PhaseHasSuffix(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldHasSuffix(FieldPhase, v))
+}
+
+// PhaseIsNil applies the IsNil predicate on the "phase" field.
+func PhaseIsNil() predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldIsNull(FieldPhase))
+}
+
+// PhaseNotNil applies the NotNil predicate on the "phase" field.
+func PhaseNotNil() predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNotNull(FieldPhase))
+}
+
+// PhaseEqualFold applies the EqualFold predicate on the "phase" field.
+func PhaseEqualFold(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEqualFold(FieldPhase, v))
+}
+
+// PhaseContainsFold applies the ContainsFold predicate on the "phase" field.
+func PhaseContainsFold(v string) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldContainsFold(FieldPhase, v))
+}
+
+// LabelsJSONIsNil applies the IsNil predicate on the "labels_json" field.
+func LabelsJSONIsNil() predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldIsNull(FieldLabelsJSON))
+}
+
+// LabelsJSONNotNil applies the NotNil predicate on the "labels_json" field.
+func LabelsJSONNotNil() predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNotNull(FieldLabelsJSON))
+}
+
+// APICreatedAtEQ applies the EQ predicate on the "api_created_at" field.
+func APICreatedAtEQ(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtNEQ applies the NEQ predicate on the "api_created_at" field.
+func APICreatedAtNEQ(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtIn applies the In predicate on the "api_created_at" field.
+func APICreatedAtIn(vs ...time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtNotIn applies the NotIn predicate on the "api_created_at" field.
+func APICreatedAtNotIn(vs ...time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNotIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtGT applies the GT predicate on the "api_created_at" field.
+func APICreatedAtGT(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtGTE applies the GTE predicate on the "api_created_at" field.
+func APICreatedAtGTE(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldGTE(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLT applies the LT predicate on the "api_created_at" field.
+func APICreatedAtLT(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLTE applies the LTE predicate on the "api_created_at" field.
+func APICreatedAtLTE(v time.Time) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldLTE(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtIsNil applies the IsNil predicate on the "api_created_at" field.
+func APICreatedAtIsNil() predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldIsNull(FieldAPICreatedAt))
+}
+
+// APICreatedAtNotNil applies the NotNil predicate on the "api_created_at" field.
+func APICreatedAtNotNil() predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.FieldNotNull(FieldAPICreatedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeHistoryK8sNamespace) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeHistoryK8sNamespace) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeHistoryK8sNamespace) predicate.BronzeHistoryK8sNamespace {
+	return predicate.BronzeHistoryK8sNamespace(sql.NotPredicates(p))
+}
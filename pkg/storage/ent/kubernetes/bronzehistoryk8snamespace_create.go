@@ -0,0 +1,360 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snamespace"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryK8sNamespaceCreate is the builder for creating a BronzeHistoryK8sNamespace entity.
+type BronzeHistoryK8sNamespaceCreate struct {
+	config
+	mutation *BronzeHistoryK8sNamespaceMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryK8sNamespaceCreate) SetValidFrom(v time.Time) *BronzeHistoryK8sNamespaceCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryK8sNamespaceCreate) SetValidTo(v time.Time) *BronzeHistoryK8sNamespaceCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNamespaceCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sNamespaceCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryK8sNamespaceCreate) SetCollectedAt(v time.Time) *BronzeHistoryK8sNamespaceCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryK8sNamespaceCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sNamespaceCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryK8sNamespaceCreate) SetResourceID(v string) *BronzeHistoryK8sNamespaceCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_c *BronzeHistoryK8sNamespaceCreate) SetClusterName(v string) *BronzeHistoryK8sNamespaceCreate {
+	_c.mutation.SetClusterName(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeHistoryK8sNamespaceCreate) SetName(v string) *BronzeHistoryK8sNamespaceCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetUID sets the "uid" field.
+func (_c *BronzeHistoryK8sNamespaceCreate) SetUID(v string) *BronzeHistoryK8sNamespaceCreate {
+	_c.mutation.SetUID(v)
+	return _c
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNamespaceCreate) SetNillableUID(v *string) *BronzeHistoryK8sNamespaceCreate {
+	if v != nil {
+		_c.SetUID(*v)
+	}
+	return _c
+}
+
+// SetPhase sets the "phase" field.
+func (_c *BronzeHistoryK8sNamespaceCreate) SetPhase(v string) *BronzeHistoryK8sNamespaceCreate {
+	_c.mutation.SetPhase(v)
+	return _c
+}
+
+// SetNillablePhase sets the "phase" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNamespaceCreate) SetNillablePhase(v *string) *BronzeHistoryK8sNamespaceCreate {
+	if v != nil {
+		_c.SetPhase(*v)
+	}
+	return _c
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_c *BronzeHistoryK8sNamespaceCreate) SetLabelsJSON(v json.RawMessage) *BronzeHistoryK8sNamespaceCreate {
+	_c.mutation.SetLabelsJSON(v)
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeHistoryK8sNamespaceCreate) SetAPICreatedAt(v time.Time) *BronzeHistoryK8sNamespaceCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNamespaceCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryK8sNamespaceCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryK8sNamespaceCreate) SetID(v uint) *BronzeHistoryK8sNamespaceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryK8sNamespaceMutation object of the builder.
+func (_c *BronzeHistoryK8sNamespaceCreate) Mutation() *BronzeHistoryK8sNamespaceMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryK8sNamespace in the database.
+func (_c *BronzeHistoryK8sNamespaceCreate) Save(ctx context.Context) (*BronzeHistoryK8sNamespace, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryK8sNamespaceCreate) SaveX(ctx context.Context) *BronzeHistoryK8sNamespace {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryK8sNamespaceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryK8sNamespaceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryK8sNamespaceCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sNamespace.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sNamespace.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sNamespace.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sNamespace.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8snamespace.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNamespace.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClusterName(); !ok {
+		return &ValidationError{Name: "cluster_name", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sNamespace.cluster_name"`)}
+	}
+	if v, ok := _c.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8snamespace.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNamespace.cluster_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sNamespace.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := bronzehistoryk8snamespace.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNamespace.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryK8sNamespaceCreate) sqlSave(ctx context.Context) (*BronzeHistoryK8sNamespace, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryK8sNamespaceCreate) createSpec() (*BronzeHistoryK8sNamespace, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryK8sNamespace{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryk8snamespace.Table, sqlgraph.NewFieldSpec(bronzehistoryk8snamespace.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryK8sNamespace
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldClusterName, field.TypeString, value)
+		_node.ClusterName = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.UID(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldUID, field.TypeString, value)
+		_node.UID = value
+	}
+	if value, ok := _c.mutation.Phase(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldPhase, field.TypeString, value)
+		_node.Phase = value
+	}
+	if value, ok := _c.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldLabelsJSON, field.TypeJSON, value)
+		_node.LabelsJSON = value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryK8sNamespaceCreateBulk is the builder for creating many BronzeHistoryK8sNamespace entities in bulk.
+type BronzeHistoryK8sNamespaceCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryK8sNamespaceCreate
+}
+
+// Save creates the BronzeHistoryK8sNamespace entities in the database.
+func (_c *BronzeHistoryK8sNamespaceCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryK8sNamespace, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryK8sNamespace, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryK8sNamespaceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryK8sNamespaceCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryK8sNamespace {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryK8sNamespaceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryK8sNamespaceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
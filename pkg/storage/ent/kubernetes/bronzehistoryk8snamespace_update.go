@@ -0,0 +1,665 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snamespace"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryK8sNamespaceUpdate is the builder for updating BronzeHistoryK8sNamespace entities.
+type BronzeHistoryK8sNamespaceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryK8sNamespaceMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sNamespaceUpdate builder.
+func (_u *BronzeHistoryK8sNamespaceUpdate) Where(ps ...predicate.BronzeHistoryK8sNamespace) *BronzeHistoryK8sNamespaceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SetValidTo(v time.Time) *BronzeHistoryK8sNamespaceUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sNamespaceUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryK8sNamespaceUpdate) ClearValidTo() *BronzeHistoryK8sNamespaceUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SetCollectedAt(v time.Time) *BronzeHistoryK8sNamespaceUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryK8sNamespaceUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sNamespaceUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryK8sNamespaceUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SetResourceID(v string) *BronzeHistoryK8sNamespaceUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SetNillableResourceID(v *string) *BronzeHistoryK8sNamespaceUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SetClusterName(v string) *BronzeHistoryK8sNamespaceUpdate {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SetNillableClusterName(v *string) *BronzeHistoryK8sNamespaceUpdate {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SetName(v string) *BronzeHistoryK8sNamespaceUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SetNillableName(v *string) *BronzeHistoryK8sNamespaceUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SetUID(v string) *BronzeHistoryK8sNamespaceUpdate {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SetNillableUID(v *string) *BronzeHistoryK8sNamespaceUpdate {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeHistoryK8sNamespaceUpdate) ClearUID() *BronzeHistoryK8sNamespaceUpdate {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetPhase sets the "phase" field.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SetPhase(v string) *BronzeHistoryK8sNamespaceUpdate {
+	_u.mutation.SetPhase(v)
+	return _u
+}
+
+// SetNillablePhase sets the "phase" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SetNillablePhase(v *string) *BronzeHistoryK8sNamespaceUpdate {
+	if v != nil {
+		_u.SetPhase(*v)
+	}
+	return _u
+}
+
+// ClearPhase clears the value of the "phase" field.
+func (_u *BronzeHistoryK8sNamespaceUpdate) ClearPhase() *BronzeHistoryK8sNamespaceUpdate {
+	_u.mutation.ClearPhase()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SetLabelsJSON(v json.RawMessage) *BronzeHistoryK8sNamespaceUpdate {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeHistoryK8sNamespaceUpdate) AppendLabelsJSON(v json.RawMessage) *BronzeHistoryK8sNamespaceUpdate {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeHistoryK8sNamespaceUpdate) ClearLabelsJSON() *BronzeHistoryK8sNamespaceUpdate {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SetAPICreatedAt(v time.Time) *BronzeHistoryK8sNamespaceUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryK8sNamespaceUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryK8sNamespaceUpdate) ClearAPICreatedAt() *BronzeHistoryK8sNamespaceUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryK8sNamespaceMutation object of the builder.
+func (_u *BronzeHistoryK8sNamespaceUpdate) Mutation() *BronzeHistoryK8sNamespaceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryK8sNamespaceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryK8sNamespaceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryK8sNamespaceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryK8sNamespaceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryK8sNamespaceUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8snamespace.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNamespace.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8snamespace.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNamespace.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzehistoryk8snamespace.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNamespace.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryK8sNamespaceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryk8snamespace.Table, bronzehistoryk8snamespace.Columns, sqlgraph.NewFieldSpec(bronzehistoryk8snamespace.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryk8snamespace.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzehistoryk8snamespace.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phase(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldPhase, field.TypeString, value)
+	}
+	if _u.mutation.PhaseCleared() {
+		_spec.ClearField(bronzehistoryk8snamespace.FieldPhase, field.TypeString)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8snamespace.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8snamespace.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistoryk8snamespace.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryK8sNamespace
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryk8snamespace.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryK8sNamespaceUpdateOne is the builder for updating a single BronzeHistoryK8sNamespace entity.
+type BronzeHistoryK8sNamespaceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryK8sNamespaceMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SetValidTo(v time.Time) *BronzeHistoryK8sNamespaceUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sNamespaceUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) ClearValidTo() *BronzeHistoryK8sNamespaceUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryK8sNamespaceUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryK8sNamespaceUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sNamespaceUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryK8sNamespaceUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SetResourceID(v string) *BronzeHistoryK8sNamespaceUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryK8sNamespaceUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SetClusterName(v string) *BronzeHistoryK8sNamespaceUpdateOne {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SetNillableClusterName(v *string) *BronzeHistoryK8sNamespaceUpdateOne {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SetName(v string) *BronzeHistoryK8sNamespaceUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SetNillableName(v *string) *BronzeHistoryK8sNamespaceUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SetUID(v string) *BronzeHistoryK8sNamespaceUpdateOne {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SetNillableUID(v *string) *BronzeHistoryK8sNamespaceUpdateOne {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) ClearUID() *BronzeHistoryK8sNamespaceUpdateOne {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetPhase sets the "phase" field.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SetPhase(v string) *BronzeHistoryK8sNamespaceUpdateOne {
+	_u.mutation.SetPhase(v)
+	return _u
+}
+
+// SetNillablePhase sets the "phase" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SetNillablePhase(v *string) *BronzeHistoryK8sNamespaceUpdateOne {
+	if v != nil {
+		_u.SetPhase(*v)
+	}
+	return _u
+}
+
+// ClearPhase clears the value of the "phase" field.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) ClearPhase() *BronzeHistoryK8sNamespaceUpdateOne {
+	_u.mutation.ClearPhase()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SetLabelsJSON(v json.RawMessage) *BronzeHistoryK8sNamespaceUpdateOne {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) AppendLabelsJSON(v json.RawMessage) *BronzeHistoryK8sNamespaceUpdateOne {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) ClearLabelsJSON() *BronzeHistoryK8sNamespaceUpdateOne {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SetAPICreatedAt(v time.Time) *BronzeHistoryK8sNamespaceUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryK8sNamespaceUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) ClearAPICreatedAt() *BronzeHistoryK8sNamespaceUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryK8sNamespaceMutation object of the builder.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) Mutation() *BronzeHistoryK8sNamespaceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sNamespaceUpdate builder.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) Where(ps ...predicate.BronzeHistoryK8sNamespace) *BronzeHistoryK8sNamespaceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) Select(field string, fields ...string) *BronzeHistoryK8sNamespaceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryK8sNamespace entity.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) Save(ctx context.Context) (*BronzeHistoryK8sNamespace, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) SaveX(ctx context.Context) *BronzeHistoryK8sNamespace {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8snamespace.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNamespace.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8snamespace.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNamespace.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzehistoryk8snamespace.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNamespace.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryK8sNamespaceUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryK8sNamespace, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryk8snamespace.Table, bronzehistoryk8snamespace.Columns, sqlgraph.NewFieldSpec(bronzehistoryk8snamespace.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`kubernetes: missing "BronzeHistoryK8sNamespace.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryk8snamespace.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryk8snamespace.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("kubernetes: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryk8snamespace.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryk8snamespace.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzehistoryk8snamespace.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phase(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldPhase, field.TypeString, value)
+	}
+	if _u.mutation.PhaseCleared() {
+		_spec.ClearField(bronzehistoryk8snamespace.FieldPhase, field.TypeString)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8snamespace.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8snamespace.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistoryk8snamespace.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistoryk8snamespace.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryK8sNamespace
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryK8sNamespace{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryk8snamespace.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snetworkpolicy"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryK8sNetworkPolicy is the model entity for the BronzeHistoryK8sNetworkPolicy schema.
+type BronzeHistoryK8sNetworkPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze network policy by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// ClusterName holds the value of the "cluster_name" field.
+	ClusterName string `json:"cluster_name,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// PodSelectorJSON holds the value of the "pod_selector_json" field.
+	PodSelectorJSON json.RawMessage `json:"pod_selector_json,omitempty"`
+	// Ingress and/or Egress
+	PolicyTypesJSON json.RawMessage `json:"policy_types_json,omitempty"`
+	// IngressJSON holds the value of the "ingress_json" field.
+	IngressJSON json.RawMessage `json:"ingress_json,omitempty"`
+	// EgressJSON holds the value of the "egress_json" field.
+	EgressJSON json.RawMessage `json:"egress_json,omitempty"`
+	// LabelsJSON holds the value of the "labels_json" field.
+	LabelsJSON json.RawMessage `json:"labels_json,omitempty"`
+	// APICreatedAt holds the value of the "api_created_at" field.
+	APICreatedAt *time.Time `json:"api_created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryK8sNetworkPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryk8snetworkpolicy.FieldPodSelectorJSON, bronzehistoryk8snetworkpolicy.FieldPolicyTypesJSON, bronzehistoryk8snetworkpolicy.FieldIngressJSON, bronzehistoryk8snetworkpolicy.FieldEgressJSON, bronzehistoryk8snetworkpolicy.FieldLabelsJSON:
+			values[i] = new([]byte)
+		case bronzehistoryk8snetworkpolicy.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryk8snetworkpolicy.FieldResourceID, bronzehistoryk8snetworkpolicy.FieldClusterName, bronzehistoryk8snetworkpolicy.FieldNamespace, bronzehistoryk8snetworkpolicy.FieldName, bronzehistoryk8snetworkpolicy.FieldUID:
+			values[i] = new(sql.NullString)
+		case bronzehistoryk8snetworkpolicy.FieldValidFrom, bronzehistoryk8snetworkpolicy.FieldValidTo, bronzehistoryk8snetworkpolicy.FieldCollectedAt, bronzehistoryk8snetworkpolicy.FieldFirstCollectedAt, bronzehistoryk8snetworkpolicy.FieldAPICreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryK8sNetworkPolicy fields.
+func (_m *BronzeHistoryK8sNetworkPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryk8snetworkpolicy.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryk8snetworkpolicy.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryk8snetworkpolicy.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryk8snetworkpolicy.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryk8snetworkpolicy.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryk8snetworkpolicy.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryk8snetworkpolicy.FieldClusterName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_name", values[i])
+			} else if value.Valid {
+				_m.ClusterName = value.String
+			}
+		case bronzehistoryk8snetworkpolicy.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				_m.Namespace = value.String
+			}
+		case bronzehistoryk8snetworkpolicy.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzehistoryk8snetworkpolicy.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				_m.UID = value.String
+			}
+		case bronzehistoryk8snetworkpolicy.FieldPodSelectorJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field pod_selector_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PodSelectorJSON); err != nil {
+					return fmt.Errorf("unmarshal field pod_selector_json: %w", err)
+				}
+			}
+		case bronzehistoryk8snetworkpolicy.FieldPolicyTypesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_types_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PolicyTypesJSON); err != nil {
+					return fmt.Errorf("unmarshal field policy_types_json: %w", err)
+				}
+			}
+		case bronzehistoryk8snetworkpolicy.FieldIngressJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ingress_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.IngressJSON); err != nil {
+					return fmt.Errorf("unmarshal field ingress_json: %w", err)
+				}
+			}
+		case bronzehistoryk8snetworkpolicy.FieldEgressJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field egress_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EgressJSON); err != nil {
+					return fmt.Errorf("unmarshal field egress_json: %w", err)
+				}
+			}
+		case bronzehistoryk8snetworkpolicy.FieldLabelsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LabelsJSON); err != nil {
+					return fmt.Errorf("unmarshal field labels_json: %w", err)
+				}
+			}
+		case bronzehistoryk8snetworkpolicy.FieldAPICreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_created_at", values[i])
+			} else if value.Valid {
+				_m.APICreatedAt = new(time.Time)
+				*_m.APICreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryK8sNetworkPolicy.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryK8sNetworkPolicy) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryK8sNetworkPolicy.
+// Note that you need to call BronzeHistoryK8sNetworkPolicy.Unwrap() before calling this method if this BronzeHistoryK8sNetworkPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryK8sNetworkPolicy) Update() *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	return NewBronzeHistoryK8sNetworkPolicyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryK8sNetworkPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryK8sNetworkPolicy) Unwrap() *BronzeHistoryK8sNetworkPolicy {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("kubernetes: BronzeHistoryK8sNetworkPolicy is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryK8sNetworkPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryK8sNetworkPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("cluster_name=")
+	builder.WriteString(_m.ClusterName)
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(_m.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("uid=")
+	builder.WriteString(_m.UID)
+	builder.WriteString(", ")
+	builder.WriteString("pod_selector_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PodSelectorJSON))
+	builder.WriteString(", ")
+	builder.WriteString("policy_types_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PolicyTypesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("ingress_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IngressJSON))
+	builder.WriteString(", ")
+	builder.WriteString("egress_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EgressJSON))
+	builder.WriteString(", ")
+	builder.WriteString("labels_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LabelsJSON))
+	builder.WriteString(", ")
+	if v := _m.APICreatedAt; v != nil {
+		builder.WriteString("api_created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryK8sNetworkPolicies is a parsable slice of BronzeHistoryK8sNetworkPolicy.
+type BronzeHistoryK8sNetworkPolicies []*BronzeHistoryK8sNetworkPolicy
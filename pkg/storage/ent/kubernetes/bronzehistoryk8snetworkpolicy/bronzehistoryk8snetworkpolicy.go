@@ -0,0 +1,145 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryk8snetworkpolicy
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzehistoryk8snetworkpolicy type in the database.
+	Label = "bronze_history_k8s_network_policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "history_id"
+	// FieldValidFrom holds the string denoting the valid_from field in the database.
+	FieldValidFrom = "valid_from"
+	// FieldValidTo holds the string denoting the valid_to field in the database.
+	FieldValidTo = "valid_to"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldResourceID holds the string denoting the resource_id field in the database.
+	FieldResourceID = "resource_id"
+	// FieldClusterName holds the string denoting the cluster_name field in the database.
+	FieldClusterName = "cluster_name"
+	// FieldNamespace holds the string denoting the namespace field in the database.
+	FieldNamespace = "namespace"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldUID holds the string denoting the uid field in the database.
+	FieldUID = "uid"
+	// FieldPodSelectorJSON holds the string denoting the pod_selector_json field in the database.
+	FieldPodSelectorJSON = "pod_selector_json"
+	// FieldPolicyTypesJSON holds the string denoting the policy_types_json field in the database.
+	FieldPolicyTypesJSON = "policy_types_json"
+	// FieldIngressJSON holds the string denoting the ingress_json field in the database.
+	FieldIngressJSON = "ingress_json"
+	// FieldEgressJSON holds the string denoting the egress_json field in the database.
+	FieldEgressJSON = "egress_json"
+	// FieldLabelsJSON holds the string denoting the labels_json field in the database.
+	FieldLabelsJSON = "labels_json"
+	// FieldAPICreatedAt holds the string denoting the api_created_at field in the database.
+	FieldAPICreatedAt = "api_created_at"
+	// Table holds the table name of the bronzehistoryk8snetworkpolicy in the database.
+	Table = "k8s_network_policies_history"
+)
+
+// Columns holds all SQL columns for bronzehistoryk8snetworkpolicy fields.
+var Columns = []string{
+	FieldID,
+	FieldValidFrom,
+	FieldValidTo,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldResourceID,
+	FieldClusterName,
+	FieldNamespace,
+	FieldName,
+	FieldUID,
+	FieldPodSelectorJSON,
+	FieldPolicyTypesJSON,
+	FieldIngressJSON,
+	FieldEgressJSON,
+	FieldLabelsJSON,
+	FieldAPICreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	ResourceIDValidator func(string) error
+	// ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	ClusterNameValidator func(string) error
+	// NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	NamespaceValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BronzeHistoryK8sNetworkPolicy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByValidFrom orders the results by the valid_from field.
+func ByValidFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidFrom, opts...).ToFunc()
+}
+
+// ByValidTo orders the results by the valid_to field.
+func ByValidTo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidTo, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByResourceID orders the results by the resource_id field.
+func ByResourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceID, opts...).ToFunc()
+}
+
+// ByClusterName orders the results by the cluster_name field.
+func ByClusterName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClusterName, opts...).ToFunc()
+}
+
+// ByNamespace orders the results by the namespace field.
+func ByNamespace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNamespace, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByUID orders the results by the uid field.
+func ByUID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUID, opts...).ToFunc()
+}
+
+// ByAPICreatedAt orders the results by the api_created_at field.
+func ByAPICreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPICreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,889 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snetworkpolicy"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryK8sNetworkPolicyUpdate is the builder for updating BronzeHistoryK8sNetworkPolicy entities.
+type BronzeHistoryK8sNetworkPolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryK8sNetworkPolicyMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sNetworkPolicyUpdate builder.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) Where(ps ...predicate.BronzeHistoryK8sNetworkPolicy) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetValidTo(v time.Time) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sNetworkPolicyUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) ClearValidTo() *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetCollectedAt(v time.Time) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryK8sNetworkPolicyUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryK8sNetworkPolicyUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetResourceID(v string) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetNillableResourceID(v *string) *BronzeHistoryK8sNetworkPolicyUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetClusterName(v string) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetNillableClusterName(v *string) *BronzeHistoryK8sNetworkPolicyUpdate {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetNamespace(v string) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetNillableNamespace(v *string) *BronzeHistoryK8sNetworkPolicyUpdate {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetName(v string) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetNillableName(v *string) *BronzeHistoryK8sNetworkPolicyUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetUID(v string) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetNillableUID(v *string) *BronzeHistoryK8sNetworkPolicyUpdate {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) ClearUID() *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetPodSelectorJSON sets the "pod_selector_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetPodSelectorJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.SetPodSelectorJSON(v)
+	return _u
+}
+
+// AppendPodSelectorJSON appends value to the "pod_selector_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) AppendPodSelectorJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.AppendPodSelectorJSON(v)
+	return _u
+}
+
+// ClearPodSelectorJSON clears the value of the "pod_selector_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) ClearPodSelectorJSON() *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.ClearPodSelectorJSON()
+	return _u
+}
+
+// SetPolicyTypesJSON sets the "policy_types_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetPolicyTypesJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.SetPolicyTypesJSON(v)
+	return _u
+}
+
+// AppendPolicyTypesJSON appends value to the "policy_types_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) AppendPolicyTypesJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.AppendPolicyTypesJSON(v)
+	return _u
+}
+
+// ClearPolicyTypesJSON clears the value of the "policy_types_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) ClearPolicyTypesJSON() *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.ClearPolicyTypesJSON()
+	return _u
+}
+
+// SetIngressJSON sets the "ingress_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetIngressJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.SetIngressJSON(v)
+	return _u
+}
+
+// AppendIngressJSON appends value to the "ingress_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) AppendIngressJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.AppendIngressJSON(v)
+	return _u
+}
+
+// ClearIngressJSON clears the value of the "ingress_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) ClearIngressJSON() *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.ClearIngressJSON()
+	return _u
+}
+
+// SetEgressJSON sets the "egress_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetEgressJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.SetEgressJSON(v)
+	return _u
+}
+
+// AppendEgressJSON appends value to the "egress_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) AppendEgressJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.AppendEgressJSON(v)
+	return _u
+}
+
+// ClearEgressJSON clears the value of the "egress_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) ClearEgressJSON() *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.ClearEgressJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetLabelsJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) AppendLabelsJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) ClearLabelsJSON() *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetAPICreatedAt(v time.Time) *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryK8sNetworkPolicyUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) ClearAPICreatedAt() *BronzeHistoryK8sNetworkPolicyUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryK8sNetworkPolicyMutation object of the builder.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) Mutation() *BronzeHistoryK8sNetworkPolicyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8snetworkpolicy.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNetworkPolicy.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8snetworkpolicy.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNetworkPolicy.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Namespace(); ok {
+		if err := bronzehistoryk8snetworkpolicy.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNetworkPolicy.namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzehistoryk8snetworkpolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNetworkPolicy.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryK8sNetworkPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryk8snetworkpolicy.Table, bronzehistoryk8snetworkpolicy.Columns, sqlgraph.NewFieldSpec(bronzehistoryk8snetworkpolicy.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryk8snetworkpolicy.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzehistoryk8snetworkpolicy.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PodSelectorJSON(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldPodSelectorJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPodSelectorJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8snetworkpolicy.FieldPodSelectorJSON, value)
+		})
+	}
+	if _u.mutation.PodSelectorJSONCleared() {
+		_spec.ClearField(bronzehistoryk8snetworkpolicy.FieldPodSelectorJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PolicyTypesJSON(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldPolicyTypesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolicyTypesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8snetworkpolicy.FieldPolicyTypesJSON, value)
+		})
+	}
+	if _u.mutation.PolicyTypesJSONCleared() {
+		_spec.ClearField(bronzehistoryk8snetworkpolicy.FieldPolicyTypesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IngressJSON(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldIngressJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIngressJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8snetworkpolicy.FieldIngressJSON, value)
+		})
+	}
+	if _u.mutation.IngressJSONCleared() {
+		_spec.ClearField(bronzehistoryk8snetworkpolicy.FieldIngressJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EgressJSON(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldEgressJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEgressJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8snetworkpolicy.FieldEgressJSON, value)
+		})
+	}
+	if _u.mutation.EgressJSONCleared() {
+		_spec.ClearField(bronzehistoryk8snetworkpolicy.FieldEgressJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8snetworkpolicy.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8snetworkpolicy.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistoryk8snetworkpolicy.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryK8sNetworkPolicy
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryk8snetworkpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryK8sNetworkPolicyUpdateOne is the builder for updating a single BronzeHistoryK8sNetworkPolicy entity.
+type BronzeHistoryK8sNetworkPolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryK8sNetworkPolicyMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetValidTo(v time.Time) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) ClearValidTo() *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetResourceID(v string) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetClusterName(v string) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetNillableClusterName(v *string) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetNamespace(v string) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetNillableNamespace(v *string) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetName(v string) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetNillableName(v *string) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetUID(v string) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetNillableUID(v *string) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) ClearUID() *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetPodSelectorJSON sets the "pod_selector_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetPodSelectorJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetPodSelectorJSON(v)
+	return _u
+}
+
+// AppendPodSelectorJSON appends value to the "pod_selector_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) AppendPodSelectorJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.AppendPodSelectorJSON(v)
+	return _u
+}
+
+// ClearPodSelectorJSON clears the value of the "pod_selector_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) ClearPodSelectorJSON() *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.ClearPodSelectorJSON()
+	return _u
+}
+
+// SetPolicyTypesJSON sets the "policy_types_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetPolicyTypesJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetPolicyTypesJSON(v)
+	return _u
+}
+
+// AppendPolicyTypesJSON appends value to the "policy_types_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) AppendPolicyTypesJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.AppendPolicyTypesJSON(v)
+	return _u
+}
+
+// ClearPolicyTypesJSON clears the value of the "policy_types_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) ClearPolicyTypesJSON() *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.ClearPolicyTypesJSON()
+	return _u
+}
+
+// SetIngressJSON sets the "ingress_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetIngressJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetIngressJSON(v)
+	return _u
+}
+
+// AppendIngressJSON appends value to the "ingress_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) AppendIngressJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.AppendIngressJSON(v)
+	return _u
+}
+
+// ClearIngressJSON clears the value of the "ingress_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) ClearIngressJSON() *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.ClearIngressJSON()
+	return _u
+}
+
+// SetEgressJSON sets the "egress_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetEgressJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetEgressJSON(v)
+	return _u
+}
+
+// AppendEgressJSON appends value to the "egress_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) AppendEgressJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.AppendEgressJSON(v)
+	return _u
+}
+
+// ClearEgressJSON clears the value of the "egress_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) ClearEgressJSON() *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.ClearEgressJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetLabelsJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) AppendLabelsJSON(v json.RawMessage) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) ClearLabelsJSON() *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetAPICreatedAt(v time.Time) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) ClearAPICreatedAt() *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryK8sNetworkPolicyMutation object of the builder.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) Mutation() *BronzeHistoryK8sNetworkPolicyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sNetworkPolicyUpdate builder.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) Where(ps ...predicate.BronzeHistoryK8sNetworkPolicy) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) Select(field string, fields ...string) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryK8sNetworkPolicy entity.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) Save(ctx context.Context) (*BronzeHistoryK8sNetworkPolicy, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) SaveX(ctx context.Context) *BronzeHistoryK8sNetworkPolicy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8snetworkpolicy.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNetworkPolicy.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8snetworkpolicy.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNetworkPolicy.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Namespace(); ok {
+		if err := bronzehistoryk8snetworkpolicy.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNetworkPolicy.namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzehistoryk8snetworkpolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNetworkPolicy.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryK8sNetworkPolicyUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryK8sNetworkPolicy, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryk8snetworkpolicy.Table, bronzehistoryk8snetworkpolicy.Columns, sqlgraph.NewFieldSpec(bronzehistoryk8snetworkpolicy.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`kubernetes: missing "BronzeHistoryK8sNetworkPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryk8snetworkpolicy.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryk8snetworkpolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("kubernetes: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryk8snetworkpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryk8snetworkpolicy.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzehistoryk8snetworkpolicy.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PodSelectorJSON(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldPodSelectorJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPodSelectorJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8snetworkpolicy.FieldPodSelectorJSON, value)
+		})
+	}
+	if _u.mutation.PodSelectorJSONCleared() {
+		_spec.ClearField(bronzehistoryk8snetworkpolicy.FieldPodSelectorJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PolicyTypesJSON(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldPolicyTypesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolicyTypesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8snetworkpolicy.FieldPolicyTypesJSON, value)
+		})
+	}
+	if _u.mutation.PolicyTypesJSONCleared() {
+		_spec.ClearField(bronzehistoryk8snetworkpolicy.FieldPolicyTypesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IngressJSON(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldIngressJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIngressJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8snetworkpolicy.FieldIngressJSON, value)
+		})
+	}
+	if _u.mutation.IngressJSONCleared() {
+		_spec.ClearField(bronzehistoryk8snetworkpolicy.FieldIngressJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EgressJSON(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldEgressJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEgressJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8snetworkpolicy.FieldEgressJSON, value)
+		})
+	}
+	if _u.mutation.EgressJSONCleared() {
+		_spec.ClearField(bronzehistoryk8snetworkpolicy.FieldEgressJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8snetworkpolicy.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8snetworkpolicy.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistoryk8snetworkpolicy.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistoryk8snetworkpolicy.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryK8sNetworkPolicy
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryK8sNetworkPolicy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryk8snetworkpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,385 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snode"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryK8sNode is the model entity for the BronzeHistoryK8sNode schema.
+type BronzeHistoryK8sNode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze node by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// ClusterName holds the value of the "cluster_name" field.
+	ClusterName string `json:"cluster_name,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// spec.providerID, e.g. gce://{project}/{zone}/{name} or digitalocean://{droplet_id}
+	ProviderID string `json:"provider_id,omitempty"`
+	// InternalIP holds the value of the "internal_ip" field.
+	InternalIP string `json:"internal_ip,omitempty"`
+	// ExternalIP holds the value of the "external_ip" field.
+	ExternalIP string `json:"external_ip,omitempty"`
+	// Node pool from the managed-cluster node pool label
+	NodePool string `json:"node_pool,omitempty"`
+	// InstanceType holds the value of the "instance_type" field.
+	InstanceType string `json:"instance_type,omitempty"`
+	// Region holds the value of the "region" field.
+	Region string `json:"region,omitempty"`
+	// Zone holds the value of the "zone" field.
+	Zone string `json:"zone,omitempty"`
+	// KubeletVersion holds the value of the "kubelet_version" field.
+	KubeletVersion string `json:"kubelet_version,omitempty"`
+	// OsImage holds the value of the "os_image" field.
+	OsImage string `json:"os_image,omitempty"`
+	// KernelVersion holds the value of the "kernel_version" field.
+	KernelVersion string `json:"kernel_version,omitempty"`
+	// ContainerRuntime holds the value of the "container_runtime" field.
+	ContainerRuntime string `json:"container_runtime,omitempty"`
+	// Architecture holds the value of the "architecture" field.
+	Architecture string `json:"architecture,omitempty"`
+	// Ready holds the value of the "ready" field.
+	Ready bool `json:"ready,omitempty"`
+	// Unschedulable holds the value of the "unschedulable" field.
+	Unschedulable bool `json:"unschedulable,omitempty"`
+	// LabelsJSON holds the value of the "labels_json" field.
+	LabelsJSON json.RawMessage `json:"labels_json,omitempty"`
+	// TaintsJSON holds the value of the "taints_json" field.
+	TaintsJSON json.RawMessage `json:"taints_json,omitempty"`
+	// APICreatedAt holds the value of the "api_created_at" field.
+	APICreatedAt *time.Time `json:"api_created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryK8sNode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryk8snode.FieldLabelsJSON, bronzehistoryk8snode.FieldTaintsJSON:
+			values[i] = new([]byte)
+		case bronzehistoryk8snode.FieldReady, bronzehistoryk8snode.FieldUnschedulable:
+			values[i] = new(sql.NullBool)
+		case bronzehistoryk8snode.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryk8snode.FieldResourceID, bronzehistoryk8snode.FieldClusterName, bronzehistoryk8snode.FieldName, bronzehistoryk8snode.FieldUID, bronzehistoryk8snode.FieldProviderID, bronzehistoryk8snode.FieldInternalIP, bronzehistoryk8snode.FieldExternalIP, bronzehistoryk8snode.FieldNodePool, bronzehistoryk8snode.FieldInstanceType, bronzehistoryk8snode.FieldRegion, bronzehistoryk8snode.FieldZone, bronzehistoryk8snode.FieldKubeletVersion, bronzehistoryk8snode.FieldOsImage, bronzehistoryk8snode.FieldKernelVersion, bronzehistoryk8snode.FieldContainerRuntime, bronzehistoryk8snode.FieldArchitecture:
+			values[i] = new(sql.NullString)
+		case bronzehistoryk8snode.FieldValidFrom, bronzehistoryk8snode.FieldValidTo, bronzehistoryk8snode.FieldCollectedAt, bronzehistoryk8snode.FieldFirstCollectedAt, bronzehistoryk8snode.FieldAPICreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryK8sNode fields.
+func (_m *BronzeHistoryK8sNode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryk8snode.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryk8snode.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryk8snode.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryk8snode.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryk8snode.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryk8snode.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryk8snode.FieldClusterName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_name", values[i])
+			} else if value.Valid {
+				_m.ClusterName = value.String
+			}
+		case bronzehistoryk8snode.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzehistoryk8snode.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				_m.UID = value.String
+			}
+		case bronzehistoryk8snode.FieldProviderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_id", values[i])
+			} else if value.Valid {
+				_m.ProviderID = value.String
+			}
+		case bronzehistoryk8snode.FieldInternalIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internal_ip", values[i])
+			} else if value.Valid {
+				_m.InternalIP = value.String
+			}
+		case bronzehistoryk8snode.FieldExternalIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_ip", values[i])
+			} else if value.Valid {
+				_m.ExternalIP = value.String
+			}
+		case bronzehistoryk8snode.FieldNodePool:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field node_pool", values[i])
+			} else if value.Valid {
+				_m.NodePool = value.String
+			}
+		case bronzehistoryk8snode.FieldInstanceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field instance_type", values[i])
+			} else if value.Valid {
+				_m.InstanceType = value.String
+			}
+		case bronzehistoryk8snode.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		case bronzehistoryk8snode.FieldZone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field zone", values[i])
+			} else if value.Valid {
+				_m.Zone = value.String
+			}
+		case bronzehistoryk8snode.FieldKubeletVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kubelet_version", values[i])
+			} else if value.Valid {
+				_m.KubeletVersion = value.String
+			}
+		case bronzehistoryk8snode.FieldOsImage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field os_image", values[i])
+			} else if value.Valid {
+				_m.OsImage = value.String
+			}
+		case bronzehistoryk8snode.FieldKernelVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kernel_version", values[i])
+			} else if value.Valid {
+				_m.KernelVersion = value.String
+			}
+		case bronzehistoryk8snode.FieldContainerRuntime:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field container_runtime", values[i])
+			} else if value.Valid {
+				_m.ContainerRuntime = value.String
+			}
+		case bronzehistoryk8snode.FieldArchitecture:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field architecture", values[i])
+			} else if value.Valid {
+				_m.Architecture = value.String
+			}
+		case bronzehistoryk8snode.FieldReady:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field ready", values[i])
+			} else if value.Valid {
+				_m.Ready = value.Bool
+			}
+		case bronzehistoryk8snode.FieldUnschedulable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field unschedulable", values[i])
+			} else if value.Valid {
+				_m.Unschedulable = value.Bool
+			}
+		case bronzehistoryk8snode.FieldLabelsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LabelsJSON); err != nil {
+					return fmt.Errorf("unmarshal field labels_json: %w", err)
+				}
+			}
+		case bronzehistoryk8snode.FieldTaintsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field taints_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TaintsJSON); err != nil {
+					return fmt.Errorf("unmarshal field taints_json: %w", err)
+				}
+			}
+		case bronzehistoryk8snode.FieldAPICreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_created_at", values[i])
+			} else if value.Valid {
+				_m.APICreatedAt = new(time.Time)
+				*_m.APICreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryK8sNode.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryK8sNode) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryK8sNode.
+// Note that you need to call BronzeHistoryK8sNode.Unwrap() before calling this method if this BronzeHistoryK8sNode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryK8sNode) Update() *BronzeHistoryK8sNodeUpdateOne {
+	return NewBronzeHistoryK8sNodeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryK8sNode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryK8sNode) Unwrap() *BronzeHistoryK8sNode {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("kubernetes: BronzeHistoryK8sNode is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryK8sNode) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryK8sNode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("cluster_name=")
+	builder.WriteString(_m.ClusterName)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("uid=")
+	builder.WriteString(_m.UID)
+	builder.WriteString(", ")
+	builder.WriteString("provider_id=")
+	builder.WriteString(_m.ProviderID)
+	builder.WriteString(", ")
+	builder.WriteString("internal_ip=")
+	builder.WriteString(_m.InternalIP)
+	builder.WriteString(", ")
+	builder.WriteString("external_ip=")
+	builder.WriteString(_m.ExternalIP)
+	builder.WriteString(", ")
+	builder.WriteString("node_pool=")
+	builder.WriteString(_m.NodePool)
+	builder.WriteString(", ")
+	builder.WriteString("instance_type=")
+	builder.WriteString(_m.InstanceType)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteString(", ")
+	builder.WriteString("zone=")
+	builder.WriteString(_m.Zone)
+	builder.WriteString(", ")
+	builder.WriteString("kubelet_version=")
+	builder.WriteString(_m.KubeletVersion)
+	builder.WriteString(", ")
+	builder.WriteString("os_image=")
+	builder.WriteString(_m.OsImage)
+	builder.WriteString(", ")
+	builder.WriteString("kernel_version=")
+	builder.WriteString(_m.KernelVersion)
+	builder.WriteString(", ")
+	builder.WriteString("container_runtime=")
+	builder.WriteString(_m.ContainerRuntime)
+	builder.WriteString(", ")
+	builder.WriteString("architecture=")
+	builder.WriteString(_m.Architecture)
+	builder.WriteString(", ")
+	builder.WriteString("ready=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Ready))
+	builder.WriteString(", ")
+	builder.WriteString("unschedulable=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Unschedulable))
+	builder.WriteString(", ")
+	builder.WriteString("labels_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LabelsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("taints_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaintsJSON))
+	builder.WriteString(", ")
+	if v := _m.APICreatedAt; v != nil {
+		builder.WriteString("api_created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryK8sNodes is a parsable slice of BronzeHistoryK8sNode.
+type BronzeHistoryK8sNodes []*BronzeHistoryK8sNode
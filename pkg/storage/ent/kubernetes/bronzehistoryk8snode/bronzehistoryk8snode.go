@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryk8snode
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzehistoryk8snode type in the database.
+	Label = "bronze_history_k8s_node"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "history_id"
+	// FieldValidFrom holds the string denoting the valid_from field in the database.
+	FieldValidFrom = "valid_from"
+	// FieldValidTo holds the string denoting the valid_to field in the database.
+	FieldValidTo = "valid_to"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldResourceID holds the string denoting the resource_id field in the database.
+	FieldResourceID = "resource_id"
+	// FieldClusterName holds the string denoting the cluster_name field in the database.
+	FieldClusterName = "cluster_name"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldUID holds the string denoting the uid field in the database.
+	FieldUID = "uid"
+	// FieldProviderID holds the string denoting the provider_id field in the database.
+	FieldProviderID = "provider_id"
+	// FieldInternalIP holds the string denoting the internal_ip field in the database.
+	FieldInternalIP = "internal_ip"
+	// FieldExternalIP holds the string denoting the external_ip field in the database.
+	FieldExternalIP = "external_ip"
+	// FieldNodePool holds the string denoting the node_pool field in the database.
+	FieldNodePool = "node_pool"
+	// FieldInstanceType holds the string denoting the instance_type field in the database.
+	FieldInstanceType = "instance_type"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
+	// FieldZone holds the string denoting the zone field in the database.
+	FieldZone = "zone"
+	// FieldKubeletVersion holds the string denoting the kubelet_version field in the database.
+	FieldKubeletVersion = "kubelet_version"
+	// FieldOsImage holds the string denoting the os_image field in the database.
+	FieldOsImage = "os_image"
+	// FieldKernelVersion holds the string denoting the kernel_version field in the database.
+	FieldKernelVersion = "kernel_version"
+	// FieldContainerRuntime holds the string denoting the container_runtime field in the database.
+	FieldContainerRuntime = "container_runtime"
+	// FieldArchitecture holds the string denoting the architecture field in the database.
+	FieldArchitecture = "architecture"
+	// FieldReady holds the string denoting the ready field in the database.
+	FieldReady = "ready"
+	// FieldUnschedulable holds the string denoting the unschedulable field in the database.
+	FieldUnschedulable = "unschedulable"
+	// FieldLabelsJSON holds the string denoting the labels_json field in the database.
+	FieldLabelsJSON = "labels_json"
+	// FieldTaintsJSON holds the string denoting the taints_json field in the database.
+	FieldTaintsJSON = "taints_json"
+	// FieldAPICreatedAt holds the string denoting the api_created_at field in the database.
+	FieldAPICreatedAt = "api_created_at"
+	// Table holds the table name of the bronzehistoryk8snode in the database.
+	Table = "k8s_nodes_history"
+)
+
+// Columns holds all SQL columns for bronzehistoryk8snode fields.
+var Columns = []string{
+	FieldID,
+	FieldValidFrom,
+	FieldValidTo,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldResourceID,
+	FieldClusterName,
+	FieldName,
+	FieldUID,
+	FieldProviderID,
+	FieldInternalIP,
+	FieldExternalIP,
+	FieldNodePool,
+	FieldInstanceType,
+	FieldRegion,
+	FieldZone,
+	FieldKubeletVersion,
+	FieldOsImage,
+	FieldKernelVersion,
+	FieldContainerRuntime,
+	FieldArchitecture,
+	FieldReady,
+	FieldUnschedulable,
+	FieldLabelsJSON,
+	FieldTaintsJSON,
+	FieldAPICreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	ResourceIDValidator func(string) error
+	// ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	ClusterNameValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultReady holds the default value on creation for the "ready" field.
+	DefaultReady bool
+	// DefaultUnschedulable holds the default value on creation for the "unschedulable" field.
+	DefaultUnschedulable bool
+)
+
+// OrderOption defines the ordering options for the BronzeHistoryK8sNode queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByValidFrom orders the results by the valid_from field.
+func ByValidFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidFrom, opts...).ToFunc()
+}
+
+// ByValidTo orders the results by the valid_to field.
+func ByValidTo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidTo, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByResourceID orders the results by the resource_id field.
+func ByResourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceID, opts...).ToFunc()
+}
+
+// ByClusterName orders the results by the cluster_name field.
+func ByClusterName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClusterName, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByUID orders the results by the uid field.
+func ByUID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUID, opts...).ToFunc()
+}
+
+// ByProviderID orders the results by the provider_id field.
+func ByProviderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProviderID, opts...).ToFunc()
+}
+
+// ByInternalIP orders the results by the internal_ip field.
+func ByInternalIP(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInternalIP, opts...).ToFunc()
+}
+
+// ByExternalIP orders the results by the external_ip field.
+func ByExternalIP(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExternalIP, opts...).ToFunc()
+}
+
+// ByNodePool orders the results by the node_pool field.
+func ByNodePool(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNodePool, opts...).ToFunc()
+}
+
+// ByInstanceType orders the results by the instance_type field.
+func ByInstanceType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstanceType, opts...).ToFunc()
+}
+
+// ByRegion orders the results by the region field.
+func ByRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegion, opts...).ToFunc()
+}
+
+// ByZone orders the results by the zone field.
+func ByZone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldZone, opts...).ToFunc()
+}
+
+// ByKubeletVersion orders the results by the kubelet_version field.
+func ByKubeletVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKubeletVersion, opts...).ToFunc()
+}
+
+// ByOsImage orders the results by the os_image field.
+func ByOsImage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOsImage, opts...).ToFunc()
+}
+
+// ByKernelVersion orders the results by the kernel_version field.
+func ByKernelVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKernelVersion, opts...).ToFunc()
+}
+
+// ByContainerRuntime orders the results by the container_runtime field.
+func ByContainerRuntime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContainerRuntime, opts...).ToFunc()
+}
+
+// ByArchitecture orders the results by the architecture field.
+func ByArchitecture(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchitecture, opts...).ToFunc()
+}
+
+// ByReady orders the results by the ready field.
+func ByReady(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReady, opts...).ToFunc()
+}
+
+// ByUnschedulable orders the results by the unschedulable field.
+func ByUnschedulable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnschedulable, opts...).ToFunc()
+}
+
+// ByAPICreatedAt orders the results by the api_created_at field.
+func ByAPICreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPICreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,624 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snode"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryK8sNodeCreate is the builder for creating a BronzeHistoryK8sNode entity.
+type BronzeHistoryK8sNodeCreate struct {
+	config
+	mutation *BronzeHistoryK8sNodeMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetValidFrom(v time.Time) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetValidTo(v time.Time) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNodeCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sNodeCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetCollectedAt(v time.Time) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetResourceID(v string) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetClusterName(v string) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetClusterName(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetName(v string) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetUID sets the "uid" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetUID(v string) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetUID(v)
+	return _c
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNodeCreate) SetNillableUID(v *string) *BronzeHistoryK8sNodeCreate {
+	if v != nil {
+		_c.SetUID(*v)
+	}
+	return _c
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetProviderID(v string) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetProviderID(v)
+	return _c
+}
+
+// SetNillableProviderID sets the "provider_id" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNodeCreate) SetNillableProviderID(v *string) *BronzeHistoryK8sNodeCreate {
+	if v != nil {
+		_c.SetProviderID(*v)
+	}
+	return _c
+}
+
+// SetInternalIP sets the "internal_ip" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetInternalIP(v string) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetInternalIP(v)
+	return _c
+}
+
+// SetNillableInternalIP sets the "internal_ip" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNodeCreate) SetNillableInternalIP(v *string) *BronzeHistoryK8sNodeCreate {
+	if v != nil {
+		_c.SetInternalIP(*v)
+	}
+	return _c
+}
+
+// SetExternalIP sets the "external_ip" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetExternalIP(v string) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetExternalIP(v)
+	return _c
+}
+
+// SetNillableExternalIP sets the "external_ip" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNodeCreate) SetNillableExternalIP(v *string) *BronzeHistoryK8sNodeCreate {
+	if v != nil {
+		_c.SetExternalIP(*v)
+	}
+	return _c
+}
+
+// SetNodePool sets the "node_pool" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetNodePool(v string) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetNodePool(v)
+	return _c
+}
+
+// SetNillableNodePool sets the "node_pool" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNodeCreate) SetNillableNodePool(v *string) *BronzeHistoryK8sNodeCreate {
+	if v != nil {
+		_c.SetNodePool(*v)
+	}
+	return _c
+}
+
+// SetInstanceType sets the "instance_type" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetInstanceType(v string) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetInstanceType(v)
+	return _c
+}
+
+// SetNillableInstanceType sets the "instance_type" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNodeCreate) SetNillableInstanceType(v *string) *BronzeHistoryK8sNodeCreate {
+	if v != nil {
+		_c.SetInstanceType(*v)
+	}
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetRegion(v string) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNodeCreate) SetNillableRegion(v *string) *BronzeHistoryK8sNodeCreate {
+	if v != nil {
+		_c.SetRegion(*v)
+	}
+	return _c
+}
+
+// SetZone sets the "zone" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetZone(v string) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetZone(v)
+	return _c
+}
+
+// SetNillableZone sets the "zone" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNodeCreate) SetNillableZone(v *string) *BronzeHistoryK8sNodeCreate {
+	if v != nil {
+		_c.SetZone(*v)
+	}
+	return _c
+}
+
+// SetKubeletVersion sets the "kubelet_version" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetKubeletVersion(v string) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetKubeletVersion(v)
+	return _c
+}
+
+// SetNillableKubeletVersion sets the "kubelet_version" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNodeCreate) SetNillableKubeletVersion(v *string) *BronzeHistoryK8sNodeCreate {
+	if v != nil {
+		_c.SetKubeletVersion(*v)
+	}
+	return _c
+}
+
+// SetOsImage sets the "os_image" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetOsImage(v string) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetOsImage(v)
+	return _c
+}
+
+// SetNillableOsImage sets the "os_image" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNodeCreate) SetNillableOsImage(v *string) *BronzeHistoryK8sNodeCreate {
+	if v != nil {
+		_c.SetOsImage(*v)
+	}
+	return _c
+}
+
+// SetKernelVersion sets the "kernel_version" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetKernelVersion(v string) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetKernelVersion(v)
+	return _c
+}
+
+// SetNillableKernelVersion sets the "kernel_version" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNodeCreate) SetNillableKernelVersion(v *string) *BronzeHistoryK8sNodeCreate {
+	if v != nil {
+		_c.SetKernelVersion(*v)
+	}
+	return _c
+}
+
+// SetContainerRuntime sets the "container_runtime" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetContainerRuntime(v string) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetContainerRuntime(v)
+	return _c
+}
+
+// SetNillableContainerRuntime sets the "container_runtime" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNodeCreate) SetNillableContainerRuntime(v *string) *BronzeHistoryK8sNodeCreate {
+	if v != nil {
+		_c.SetContainerRuntime(*v)
+	}
+	return _c
+}
+
+// SetArchitecture sets the "architecture" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetArchitecture(v string) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetArchitecture(v)
+	return _c
+}
+
+// SetNillableArchitecture sets the "architecture" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNodeCreate) SetNillableArchitecture(v *string) *BronzeHistoryK8sNodeCreate {
+	if v != nil {
+		_c.SetArchitecture(*v)
+	}
+	return _c
+}
+
+// SetReady sets the "ready" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetReady(v bool) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetReady(v)
+	return _c
+}
+
+// SetNillableReady sets the "ready" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNodeCreate) SetNillableReady(v *bool) *BronzeHistoryK8sNodeCreate {
+	if v != nil {
+		_c.SetReady(*v)
+	}
+	return _c
+}
+
+// SetUnschedulable sets the "unschedulable" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetUnschedulable(v bool) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetUnschedulable(v)
+	return _c
+}
+
+// SetNillableUnschedulable sets the "unschedulable" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNodeCreate) SetNillableUnschedulable(v *bool) *BronzeHistoryK8sNodeCreate {
+	if v != nil {
+		_c.SetUnschedulable(*v)
+	}
+	return _c
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetLabelsJSON(v json.RawMessage) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetLabelsJSON(v)
+	return _c
+}
+
+// SetTaintsJSON sets the "taints_json" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetTaintsJSON(v json.RawMessage) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetTaintsJSON(v)
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetAPICreatedAt(v time.Time) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeHistoryK8sNodeCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryK8sNodeCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryK8sNodeCreate) SetID(v uint) *BronzeHistoryK8sNodeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryK8sNodeMutation object of the builder.
+func (_c *BronzeHistoryK8sNodeCreate) Mutation() *BronzeHistoryK8sNodeMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryK8sNode in the database.
+func (_c *BronzeHistoryK8sNodeCreate) Save(ctx context.Context) (*BronzeHistoryK8sNode, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryK8sNodeCreate) SaveX(ctx context.Context) *BronzeHistoryK8sNode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryK8sNodeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryK8sNodeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeHistoryK8sNodeCreate) defaults() {
+	if _, ok := _c.mutation.Ready(); !ok {
+		v := bronzehistoryk8snode.DefaultReady
+		_c.mutation.SetReady(v)
+	}
+	if _, ok := _c.mutation.Unschedulable(); !ok {
+		v := bronzehistoryk8snode.DefaultUnschedulable
+		_c.mutation.SetUnschedulable(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryK8sNodeCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sNode.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sNode.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sNode.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sNode.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8snode.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNode.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClusterName(); !ok {
+		return &ValidationError{Name: "cluster_name", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sNode.cluster_name"`)}
+	}
+	if v, ok := _c.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8snode.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNode.cluster_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sNode.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := bronzehistoryk8snode.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNode.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Ready(); !ok {
+		return &ValidationError{Name: "ready", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sNode.ready"`)}
+	}
+	if _, ok := _c.mutation.Unschedulable(); !ok {
+		return &ValidationError{Name: "unschedulable", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sNode.unschedulable"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryK8sNodeCreate) sqlSave(ctx context.Context) (*BronzeHistoryK8sNode, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryK8sNodeCreate) createSpec() (*BronzeHistoryK8sNode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryK8sNode{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryk8snode.Table, sqlgraph.NewFieldSpec(bronzehistoryk8snode.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryK8sNode
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldClusterName, field.TypeString, value)
+		_node.ClusterName = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.UID(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldUID, field.TypeString, value)
+		_node.UID = value
+	}
+	if value, ok := _c.mutation.ProviderID(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldProviderID, field.TypeString, value)
+		_node.ProviderID = value
+	}
+	if value, ok := _c.mutation.InternalIP(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldInternalIP, field.TypeString, value)
+		_node.InternalIP = value
+	}
+	if value, ok := _c.mutation.ExternalIP(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldExternalIP, field.TypeString, value)
+		_node.ExternalIP = value
+	}
+	if value, ok := _c.mutation.NodePool(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldNodePool, field.TypeString, value)
+		_node.NodePool = value
+	}
+	if value, ok := _c.mutation.InstanceType(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldInstanceType, field.TypeString, value)
+		_node.InstanceType = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	if value, ok := _c.mutation.Zone(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldZone, field.TypeString, value)
+		_node.Zone = value
+	}
+	if value, ok := _c.mutation.KubeletVersion(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldKubeletVersion, field.TypeString, value)
+		_node.KubeletVersion = value
+	}
+	if value, ok := _c.mutation.OsImage(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldOsImage, field.TypeString, value)
+		_node.OsImage = value
+	}
+	if value, ok := _c.mutation.KernelVersion(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldKernelVersion, field.TypeString, value)
+		_node.KernelVersion = value
+	}
+	if value, ok := _c.mutation.ContainerRuntime(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldContainerRuntime, field.TypeString, value)
+		_node.ContainerRuntime = value
+	}
+	if value, ok := _c.mutation.Architecture(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldArchitecture, field.TypeString, value)
+		_node.Architecture = value
+	}
+	if value, ok := _c.mutation.Ready(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldReady, field.TypeBool, value)
+		_node.Ready = value
+	}
+	if value, ok := _c.mutation.Unschedulable(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldUnschedulable, field.TypeBool, value)
+		_node.Unschedulable = value
+	}
+	if value, ok := _c.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldLabelsJSON, field.TypeJSON, value)
+		_node.LabelsJSON = value
+	}
+	if value, ok := _c.mutation.TaintsJSON(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldTaintsJSON, field.TypeJSON, value)
+		_node.TaintsJSON = value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryK8sNodeCreateBulk is the builder for creating many BronzeHistoryK8sNode entities in bulk.
+type BronzeHistoryK8sNodeCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryK8sNodeCreate
+}
+
+// Save creates the BronzeHistoryK8sNode entities in the database.
+func (_c *BronzeHistoryK8sNodeCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryK8sNode, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryK8sNode, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryK8sNodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryK8sNodeCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryK8sNode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryK8sNodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryK8sNodeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
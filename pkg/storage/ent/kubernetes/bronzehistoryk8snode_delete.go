@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snode"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryK8sNodeDelete is the builder for deleting a BronzeHistoryK8sNode entity.
+type BronzeHistoryK8sNodeDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryK8sNodeMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sNodeDelete builder.
+func (_d *BronzeHistoryK8sNodeDelete) Where(ps ...predicate.BronzeHistoryK8sNode) *BronzeHistoryK8sNodeDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeHistoryK8sNodeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryK8sNodeDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeHistoryK8sNodeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzehistoryk8snode.Table, sqlgraph.NewFieldSpec(bronzehistoryk8snode.FieldID, field.TypeUint))
+	_spec.Node.Schema = _d.schemaConfig.BronzeHistoryK8sNode
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeHistoryK8sNodeDeleteOne is the builder for deleting a single BronzeHistoryK8sNode entity.
+type BronzeHistoryK8sNodeDeleteOne struct {
+	_d *BronzeHistoryK8sNodeDelete
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sNodeDelete builder.
+func (_d *BronzeHistoryK8sNodeDeleteOne) Where(ps ...predicate.BronzeHistoryK8sNode) *BronzeHistoryK8sNodeDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeHistoryK8sNodeDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzehistoryk8snode.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryK8sNodeDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
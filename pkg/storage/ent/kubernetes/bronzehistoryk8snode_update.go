@@ -0,0 +1,1363 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snode"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryK8sNodeUpdate is the builder for updating BronzeHistoryK8sNode entities.
+type BronzeHistoryK8sNodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryK8sNodeMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sNodeUpdate builder.
+func (_u *BronzeHistoryK8sNodeUpdate) Where(ps ...predicate.BronzeHistoryK8sNode) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetValidTo(v time.Time) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryK8sNodeUpdate) ClearValidTo() *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetCollectedAt(v time.Time) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetResourceID(v string) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableResourceID(v *string) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetClusterName(v string) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableClusterName(v *string) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetName(v string) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableName(v *string) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetUID(v string) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableUID(v *string) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeHistoryK8sNodeUpdate) ClearUID() *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetProviderID(v string) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetProviderID(v)
+	return _u
+}
+
+// SetNillableProviderID sets the "provider_id" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableProviderID(v *string) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetProviderID(*v)
+	}
+	return _u
+}
+
+// ClearProviderID clears the value of the "provider_id" field.
+func (_u *BronzeHistoryK8sNodeUpdate) ClearProviderID() *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.ClearProviderID()
+	return _u
+}
+
+// SetInternalIP sets the "internal_ip" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetInternalIP(v string) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetInternalIP(v)
+	return _u
+}
+
+// SetNillableInternalIP sets the "internal_ip" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableInternalIP(v *string) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetInternalIP(*v)
+	}
+	return _u
+}
+
+// ClearInternalIP clears the value of the "internal_ip" field.
+func (_u *BronzeHistoryK8sNodeUpdate) ClearInternalIP() *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.ClearInternalIP()
+	return _u
+}
+
+// SetExternalIP sets the "external_ip" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetExternalIP(v string) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetExternalIP(v)
+	return _u
+}
+
+// SetNillableExternalIP sets the "external_ip" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableExternalIP(v *string) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetExternalIP(*v)
+	}
+	return _u
+}
+
+// ClearExternalIP clears the value of the "external_ip" field.
+func (_u *BronzeHistoryK8sNodeUpdate) ClearExternalIP() *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.ClearExternalIP()
+	return _u
+}
+
+// SetNodePool sets the "node_pool" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNodePool(v string) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetNodePool(v)
+	return _u
+}
+
+// SetNillableNodePool sets the "node_pool" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableNodePool(v *string) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetNodePool(*v)
+	}
+	return _u
+}
+
+// ClearNodePool clears the value of the "node_pool" field.
+func (_u *BronzeHistoryK8sNodeUpdate) ClearNodePool() *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.ClearNodePool()
+	return _u
+}
+
+// SetInstanceType sets the "instance_type" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetInstanceType(v string) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetInstanceType(v)
+	return _u
+}
+
+// SetNillableInstanceType sets the "instance_type" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableInstanceType(v *string) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetInstanceType(*v)
+	}
+	return _u
+}
+
+// ClearInstanceType clears the value of the "instance_type" field.
+func (_u *BronzeHistoryK8sNodeUpdate) ClearInstanceType() *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.ClearInstanceType()
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetRegion(v string) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableRegion(v *string) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// ClearRegion clears the value of the "region" field.
+func (_u *BronzeHistoryK8sNodeUpdate) ClearRegion() *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// SetZone sets the "zone" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetZone(v string) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetZone(v)
+	return _u
+}
+
+// SetNillableZone sets the "zone" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableZone(v *string) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetZone(*v)
+	}
+	return _u
+}
+
+// ClearZone clears the value of the "zone" field.
+func (_u *BronzeHistoryK8sNodeUpdate) ClearZone() *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.ClearZone()
+	return _u
+}
+
+// SetKubeletVersion sets the "kubelet_version" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetKubeletVersion(v string) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetKubeletVersion(v)
+	return _u
+}
+
+// SetNillableKubeletVersion sets the "kubelet_version" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableKubeletVersion(v *string) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetKubeletVersion(*v)
+	}
+	return _u
+}
+
+// ClearKubeletVersion clears the value of the "kubelet_version" field.
+func (_u *BronzeHistoryK8sNodeUpdate) ClearKubeletVersion() *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.ClearKubeletVersion()
+	return _u
+}
+
+// SetOsImage sets the "os_image" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetOsImage(v string) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetOsImage(v)
+	return _u
+}
+
+// SetNillableOsImage sets the "os_image" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableOsImage(v *string) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetOsImage(*v)
+	}
+	return _u
+}
+
+// ClearOsImage clears the value of the "os_image" field.
+func (_u *BronzeHistoryK8sNodeUpdate) ClearOsImage() *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.ClearOsImage()
+	return _u
+}
+
+// SetKernelVersion sets the "kernel_version" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetKernelVersion(v string) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetKernelVersion(v)
+	return _u
+}
+
+// SetNillableKernelVersion sets the "kernel_version" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableKernelVersion(v *string) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetKernelVersion(*v)
+	}
+	return _u
+}
+
+// ClearKernelVersion clears the value of the "kernel_version" field.
+func (_u *BronzeHistoryK8sNodeUpdate) ClearKernelVersion() *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.ClearKernelVersion()
+	return _u
+}
+
+// SetContainerRuntime sets the "container_runtime" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetContainerRuntime(v string) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetContainerRuntime(v)
+	return _u
+}
+
+// SetNillableContainerRuntime sets the "container_runtime" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableContainerRuntime(v *string) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetContainerRuntime(*v)
+	}
+	return _u
+}
+
+// ClearContainerRuntime clears the value of the "container_runtime" field.
+func (_u *BronzeHistoryK8sNodeUpdate) ClearContainerRuntime() *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.ClearContainerRuntime()
+	return _u
+}
+
+// SetArchitecture sets the "architecture" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetArchitecture(v string) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetArchitecture(v)
+	return _u
+}
+
+// SetNillableArchitecture sets the "architecture" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableArchitecture(v *string) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetArchitecture(*v)
+	}
+	return _u
+}
+
+// ClearArchitecture clears the value of the "architecture" field.
+func (_u *BronzeHistoryK8sNodeUpdate) ClearArchitecture() *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.ClearArchitecture()
+	return _u
+}
+
+// SetReady sets the "ready" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetReady(v bool) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetReady(v)
+	return _u
+}
+
+// SetNillableReady sets the "ready" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableReady(v *bool) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetReady(*v)
+	}
+	return _u
+}
+
+// SetUnschedulable sets the "unschedulable" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetUnschedulable(v bool) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetUnschedulable(v)
+	return _u
+}
+
+// SetNillableUnschedulable sets the "unschedulable" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableUnschedulable(v *bool) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetUnschedulable(*v)
+	}
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetLabelsJSON(v json.RawMessage) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeHistoryK8sNodeUpdate) AppendLabelsJSON(v json.RawMessage) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeHistoryK8sNodeUpdate) ClearLabelsJSON() *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetTaintsJSON sets the "taints_json" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetTaintsJSON(v json.RawMessage) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetTaintsJSON(v)
+	return _u
+}
+
+// AppendTaintsJSON appends value to the "taints_json" field.
+func (_u *BronzeHistoryK8sNodeUpdate) AppendTaintsJSON(v json.RawMessage) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.AppendTaintsJSON(v)
+	return _u
+}
+
+// ClearTaintsJSON clears the value of the "taints_json" field.
+func (_u *BronzeHistoryK8sNodeUpdate) ClearTaintsJSON() *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.ClearTaintsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryK8sNodeUpdate) SetAPICreatedAt(v time.Time) *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryK8sNodeUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryK8sNodeUpdate) ClearAPICreatedAt() *BronzeHistoryK8sNodeUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryK8sNodeMutation object of the builder.
+func (_u *BronzeHistoryK8sNodeUpdate) Mutation() *BronzeHistoryK8sNodeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryK8sNodeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryK8sNodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryK8sNodeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryK8sNodeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryK8sNodeUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8snode.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNode.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8snode.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNode.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzehistoryk8snode.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNode.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryK8sNodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryk8snode.Table, bronzehistoryk8snode.Columns, sqlgraph.NewFieldSpec(bronzehistoryk8snode.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProviderID(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldProviderID, field.TypeString, value)
+	}
+	if _u.mutation.ProviderIDCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldProviderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.InternalIP(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldInternalIP, field.TypeString, value)
+	}
+	if _u.mutation.InternalIPCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldInternalIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExternalIP(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldExternalIP, field.TypeString, value)
+	}
+	if _u.mutation.ExternalIPCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldExternalIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.NodePool(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldNodePool, field.TypeString, value)
+	}
+	if _u.mutation.NodePoolCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldNodePool, field.TypeString)
+	}
+	if value, ok := _u.mutation.InstanceType(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldInstanceType, field.TypeString, value)
+	}
+	if _u.mutation.InstanceTypeCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldInstanceType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldRegion, field.TypeString, value)
+	}
+	if _u.mutation.RegionCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Zone(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldZone, field.TypeString, value)
+	}
+	if _u.mutation.ZoneCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldZone, field.TypeString)
+	}
+	if value, ok := _u.mutation.KubeletVersion(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldKubeletVersion, field.TypeString, value)
+	}
+	if _u.mutation.KubeletVersionCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldKubeletVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsImage(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldOsImage, field.TypeString, value)
+	}
+	if _u.mutation.OsImageCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldOsImage, field.TypeString)
+	}
+	if value, ok := _u.mutation.KernelVersion(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldKernelVersion, field.TypeString, value)
+	}
+	if _u.mutation.KernelVersionCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldKernelVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.ContainerRuntime(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldContainerRuntime, field.TypeString, value)
+	}
+	if _u.mutation.ContainerRuntimeCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldContainerRuntime, field.TypeString)
+	}
+	if value, ok := _u.mutation.Architecture(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldArchitecture, field.TypeString, value)
+	}
+	if _u.mutation.ArchitectureCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldArchitecture, field.TypeString)
+	}
+	if value, ok := _u.mutation.Ready(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldReady, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Unschedulable(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldUnschedulable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8snode.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TaintsJSON(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldTaintsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTaintsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8snode.FieldTaintsJSON, value)
+		})
+	}
+	if _u.mutation.TaintsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldTaintsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryK8sNode
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryk8snode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryK8sNodeUpdateOne is the builder for updating a single BronzeHistoryK8sNode entity.
+type BronzeHistoryK8sNodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryK8sNodeMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetValidTo(v time.Time) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) ClearValidTo() *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetResourceID(v string) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetClusterName(v string) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableClusterName(v *string) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetName(v string) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableName(v *string) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetUID(v string) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableUID(v *string) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) ClearUID() *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetProviderID(v string) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetProviderID(v)
+	return _u
+}
+
+// SetNillableProviderID sets the "provider_id" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableProviderID(v *string) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetProviderID(*v)
+	}
+	return _u
+}
+
+// ClearProviderID clears the value of the "provider_id" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) ClearProviderID() *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.ClearProviderID()
+	return _u
+}
+
+// SetInternalIP sets the "internal_ip" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetInternalIP(v string) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetInternalIP(v)
+	return _u
+}
+
+// SetNillableInternalIP sets the "internal_ip" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableInternalIP(v *string) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetInternalIP(*v)
+	}
+	return _u
+}
+
+// ClearInternalIP clears the value of the "internal_ip" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) ClearInternalIP() *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.ClearInternalIP()
+	return _u
+}
+
+// SetExternalIP sets the "external_ip" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetExternalIP(v string) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetExternalIP(v)
+	return _u
+}
+
+// SetNillableExternalIP sets the "external_ip" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableExternalIP(v *string) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetExternalIP(*v)
+	}
+	return _u
+}
+
+// ClearExternalIP clears the value of the "external_ip" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) ClearExternalIP() *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.ClearExternalIP()
+	return _u
+}
+
+// SetNodePool sets the "node_pool" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNodePool(v string) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetNodePool(v)
+	return _u
+}
+
+// SetNillableNodePool sets the "node_pool" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableNodePool(v *string) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetNodePool(*v)
+	}
+	return _u
+}
+
+// ClearNodePool clears the value of the "node_pool" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) ClearNodePool() *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.ClearNodePool()
+	return _u
+}
+
+// SetInstanceType sets the "instance_type" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetInstanceType(v string) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetInstanceType(v)
+	return _u
+}
+
+// SetNillableInstanceType sets the "instance_type" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableInstanceType(v *string) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetInstanceType(*v)
+	}
+	return _u
+}
+
+// ClearInstanceType clears the value of the "instance_type" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) ClearInstanceType() *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.ClearInstanceType()
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetRegion(v string) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableRegion(v *string) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// ClearRegion clears the value of the "region" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) ClearRegion() *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// SetZone sets the "zone" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetZone(v string) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetZone(v)
+	return _u
+}
+
+// SetNillableZone sets the "zone" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableZone(v *string) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetZone(*v)
+	}
+	return _u
+}
+
+// ClearZone clears the value of the "zone" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) ClearZone() *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.ClearZone()
+	return _u
+}
+
+// SetKubeletVersion sets the "kubelet_version" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetKubeletVersion(v string) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetKubeletVersion(v)
+	return _u
+}
+
+// SetNillableKubeletVersion sets the "kubelet_version" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableKubeletVersion(v *string) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetKubeletVersion(*v)
+	}
+	return _u
+}
+
+// ClearKubeletVersion clears the value of the "kubelet_version" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) ClearKubeletVersion() *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.ClearKubeletVersion()
+	return _u
+}
+
+// SetOsImage sets the "os_image" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetOsImage(v string) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetOsImage(v)
+	return _u
+}
+
+// SetNillableOsImage sets the "os_image" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableOsImage(v *string) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetOsImage(*v)
+	}
+	return _u
+}
+
+// ClearOsImage clears the value of the "os_image" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) ClearOsImage() *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.ClearOsImage()
+	return _u
+}
+
+// SetKernelVersion sets the "kernel_version" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetKernelVersion(v string) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetKernelVersion(v)
+	return _u
+}
+
+// SetNillableKernelVersion sets the "kernel_version" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableKernelVersion(v *string) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetKernelVersion(*v)
+	}
+	return _u
+}
+
+// ClearKernelVersion clears the value of the "kernel_version" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) ClearKernelVersion() *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.ClearKernelVersion()
+	return _u
+}
+
+// SetContainerRuntime sets the "container_runtime" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetContainerRuntime(v string) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetContainerRuntime(v)
+	return _u
+}
+
+// SetNillableContainerRuntime sets the "container_runtime" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableContainerRuntime(v *string) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetContainerRuntime(*v)
+	}
+	return _u
+}
+
+// ClearContainerRuntime clears the value of the "container_runtime" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) ClearContainerRuntime() *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.ClearContainerRuntime()
+	return _u
+}
+
+// SetArchitecture sets the "architecture" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetArchitecture(v string) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetArchitecture(v)
+	return _u
+}
+
+// SetNillableArchitecture sets the "architecture" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableArchitecture(v *string) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetArchitecture(*v)
+	}
+	return _u
+}
+
+// ClearArchitecture clears the value of the "architecture" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) ClearArchitecture() *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.ClearArchitecture()
+	return _u
+}
+
+// SetReady sets the "ready" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetReady(v bool) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetReady(v)
+	return _u
+}
+
+// SetNillableReady sets the "ready" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableReady(v *bool) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetReady(*v)
+	}
+	return _u
+}
+
+// SetUnschedulable sets the "unschedulable" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetUnschedulable(v bool) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetUnschedulable(v)
+	return _u
+}
+
+// SetNillableUnschedulable sets the "unschedulable" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableUnschedulable(v *bool) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetUnschedulable(*v)
+	}
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetLabelsJSON(v json.RawMessage) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) AppendLabelsJSON(v json.RawMessage) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) ClearLabelsJSON() *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetTaintsJSON sets the "taints_json" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetTaintsJSON(v json.RawMessage) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetTaintsJSON(v)
+	return _u
+}
+
+// AppendTaintsJSON appends value to the "taints_json" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) AppendTaintsJSON(v json.RawMessage) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.AppendTaintsJSON(v)
+	return _u
+}
+
+// ClearTaintsJSON clears the value of the "taints_json" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) ClearTaintsJSON() *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.ClearTaintsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetAPICreatedAt(v time.Time) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryK8sNodeUpdateOne) ClearAPICreatedAt() *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryK8sNodeMutation object of the builder.
+func (_u *BronzeHistoryK8sNodeUpdateOne) Mutation() *BronzeHistoryK8sNodeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sNodeUpdate builder.
+func (_u *BronzeHistoryK8sNodeUpdateOne) Where(ps ...predicate.BronzeHistoryK8sNode) *BronzeHistoryK8sNodeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryK8sNodeUpdateOne) Select(field string, fields ...string) *BronzeHistoryK8sNodeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryK8sNode entity.
+func (_u *BronzeHistoryK8sNodeUpdateOne) Save(ctx context.Context) (*BronzeHistoryK8sNode, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryK8sNodeUpdateOne) SaveX(ctx context.Context) *BronzeHistoryK8sNode {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryK8sNodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryK8sNodeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryK8sNodeUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8snode.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNode.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8snode.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNode.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzehistoryk8snode.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sNode.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryK8sNodeUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryK8sNode, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryk8snode.Table, bronzehistoryk8snode.Columns, sqlgraph.NewFieldSpec(bronzehistoryk8snode.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`kubernetes: missing "BronzeHistoryK8sNode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryk8snode.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryk8snode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("kubernetes: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryk8snode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProviderID(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldProviderID, field.TypeString, value)
+	}
+	if _u.mutation.ProviderIDCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldProviderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.InternalIP(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldInternalIP, field.TypeString, value)
+	}
+	if _u.mutation.InternalIPCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldInternalIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExternalIP(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldExternalIP, field.TypeString, value)
+	}
+	if _u.mutation.ExternalIPCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldExternalIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.NodePool(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldNodePool, field.TypeString, value)
+	}
+	if _u.mutation.NodePoolCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldNodePool, field.TypeString)
+	}
+	if value, ok := _u.mutation.InstanceType(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldInstanceType, field.TypeString, value)
+	}
+	if _u.mutation.InstanceTypeCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldInstanceType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldRegion, field.TypeString, value)
+	}
+	if _u.mutation.RegionCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Zone(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldZone, field.TypeString, value)
+	}
+	if _u.mutation.ZoneCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldZone, field.TypeString)
+	}
+	if value, ok := _u.mutation.KubeletVersion(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldKubeletVersion, field.TypeString, value)
+	}
+	if _u.mutation.KubeletVersionCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldKubeletVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsImage(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldOsImage, field.TypeString, value)
+	}
+	if _u.mutation.OsImageCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldOsImage, field.TypeString)
+	}
+	if value, ok := _u.mutation.KernelVersion(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldKernelVersion, field.TypeString, value)
+	}
+	if _u.mutation.KernelVersionCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldKernelVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.ContainerRuntime(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldContainerRuntime, field.TypeString, value)
+	}
+	if _u.mutation.ContainerRuntimeCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldContainerRuntime, field.TypeString)
+	}
+	if value, ok := _u.mutation.Architecture(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldArchitecture, field.TypeString, value)
+	}
+	if _u.mutation.ArchitectureCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldArchitecture, field.TypeString)
+	}
+	if value, ok := _u.mutation.Ready(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldReady, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Unschedulable(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldUnschedulable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8snode.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TaintsJSON(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldTaintsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTaintsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8snode.FieldTaintsJSON, value)
+		})
+	}
+	if _u.mutation.TaintsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldTaintsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistoryk8snode.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistoryk8snode.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryK8sNode
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryK8sNode{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryk8snode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
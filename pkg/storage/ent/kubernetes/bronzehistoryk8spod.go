@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8spod"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryK8sPod is the model entity for the BronzeHistoryK8sPod schema.
+type BronzeHistoryK8sPod struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze pod by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// ClusterName holds the value of the "cluster_name" field.
+	ClusterName string `json:"cluster_name,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// Pending, Running, Succeeded, Failed or Unknown
+	Phase string `json:"phase,omitempty"`
+	// NodeName holds the value of the "node_name" field.
+	NodeName string `json:"node_name,omitempty"`
+	// PodIP holds the value of the "pod_ip" field.
+	PodIP string `json:"pod_ip,omitempty"`
+	// HostIP holds the value of the "host_ip" field.
+	HostIP string `json:"host_ip,omitempty"`
+	// ServiceAccount holds the value of the "service_account" field.
+	ServiceAccount string `json:"service_account,omitempty"`
+	// Owning workload kind, with ReplicaSets resolved to their Deployment
+	WorkloadKind string `json:"workload_kind,omitempty"`
+	// WorkloadName holds the value of the "workload_name" field.
+	WorkloadName string `json:"workload_name,omitempty"`
+	// HostNetwork holds the value of the "host_network" field.
+	HostNetwork bool `json:"host_network,omitempty"`
+	// Containers: name, image, image_id, init
+	ContainersJSON json.RawMessage `json:"containers_json,omitempty"`
+	// LabelsJSON holds the value of the "labels_json" field.
+	LabelsJSON json.RawMessage `json:"labels_json,omitempty"`
+	// APICreatedAt holds the value of the "api_created_at" field.
+	APICreatedAt *time.Time `json:"api_created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryK8sPod) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryk8spod.FieldContainersJSON, bronzehistoryk8spod.FieldLabelsJSON:
+			values[i] = new([]byte)
+		case bronzehistoryk8spod.FieldHostNetwork:
+			values[i] = new(sql.NullBool)
+		case bronzehistoryk8spod.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryk8spod.FieldResourceID, bronzehistoryk8spod.FieldClusterName, bronzehistoryk8spod.FieldNamespace, bronzehistoryk8spod.FieldName, bronzehistoryk8spod.FieldUID, bronzehistoryk8spod.FieldPhase, bronzehistoryk8spod.FieldNodeName, bronzehistoryk8spod.FieldPodIP, bronzehistoryk8spod.FieldHostIP, bronzehistoryk8spod.FieldServiceAccount, bronzehistoryk8spod.FieldWorkloadKind, bronzehistoryk8spod.FieldWorkloadName:
+			values[i] = new(sql.NullString)
+		case bronzehistoryk8spod.FieldValidFrom, bronzehistoryk8spod.FieldValidTo, bronzehistoryk8spod.FieldCollectedAt, bronzehistoryk8spod.FieldFirstCollectedAt, bronzehistoryk8spod.FieldAPICreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryK8sPod fields.
+func (_m *BronzeHistoryK8sPod) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryk8spod.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryk8spod.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryk8spod.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryk8spod.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryk8spod.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryk8spod.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryk8spod.FieldClusterName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_name", values[i])
+			} else if value.Valid {
+				_m.ClusterName = value.String
+			}
+		case bronzehistoryk8spod.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				_m.Namespace = value.String
+			}
+		case bronzehistoryk8spod.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzehistoryk8spod.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				_m.UID = value.String
+			}
+		case bronzehistoryk8spod.FieldPhase:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phase", values[i])
+			} else if value.Valid {
+				_m.Phase = value.String
+			}
+		case bronzehistoryk8spod.FieldNodeName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field node_name", values[i])
+			} else if value.Valid {
+				_m.NodeName = value.String
+			}
+		case bronzehistoryk8spod.FieldPodIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pod_ip", values[i])
+			} else if value.Valid {
+				_m.PodIP = value.String
+			}
+		case bronzehistoryk8spod.FieldHostIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field host_ip", values[i])
+			} else if value.Valid {
+				_m.HostIP = value.String
+			}
+		case bronzehistoryk8spod.FieldServiceAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field service_account", values[i])
+			} else if value.Valid {
+				_m.ServiceAccount = value.String
+			}
+		case bronzehistoryk8spod.FieldWorkloadKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field workload_kind", values[i])
+			} else if value.Valid {
+				_m.WorkloadKind = value.String
+			}
+		case bronzehistoryk8spod.FieldWorkloadName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field workload_name", values[i])
+			} else if value.Valid {
+				_m.WorkloadName = value.String
+			}
+		case bronzehistoryk8spod.FieldHostNetwork:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field host_network", values[i])
+			} else if value.Valid {
+				_m.HostNetwork = value.Bool
+			}
+		case bronzehistoryk8spod.FieldContainersJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field containers_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ContainersJSON); err != nil {
+					return fmt.Errorf("unmarshal field containers_json: %w", err)
+				}
+			}
+		case bronzehistoryk8spod.FieldLabelsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LabelsJSON); err != nil {
+					return fmt.Errorf("unmarshal field labels_json: %w", err)
+				}
+			}
+		case bronzehistoryk8spod.FieldAPICreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_created_at", values[i])
+			} else if value.Valid {
+				_m.APICreatedAt = new(time.Time)
+				*_m.APICreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryK8sPod.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryK8sPod) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryK8sPod.
+// Note that you need to call BronzeHistoryK8sPod.Unwrap() before calling this method if this BronzeHistoryK8sPod
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryK8sPod) Update() *BronzeHistoryK8sPodUpdateOne {
+	return NewBronzeHistoryK8sPodClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryK8sPod entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryK8sPod) Unwrap() *BronzeHistoryK8sPod {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("kubernetes: BronzeHistoryK8sPod is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryK8sPod) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryK8sPod(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("cluster_name=")
+	builder.WriteString(_m.ClusterName)
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(_m.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("uid=")
+	builder.WriteString(_m.UID)
+	builder.WriteString(", ")
+	builder.WriteString("phase=")
+	builder.WriteString(_m.Phase)
+	builder.WriteString(", ")
+	builder.WriteString("node_name=")
+	builder.WriteString(_m.NodeName)
+	builder.WriteString(", ")
+	builder.WriteString("pod_ip=")
+	builder.WriteString(_m.PodIP)
+	builder.WriteString(", ")
+	builder.WriteString("host_ip=")
+	builder.WriteString(_m.HostIP)
+	builder.WriteString(", ")
+	builder.WriteString("service_account=")
+	builder.WriteString(_m.ServiceAccount)
+	builder.WriteString(", ")
+	builder.WriteString("workload_kind=")
+	builder.WriteString(_m.WorkloadKind)
+	builder.WriteString(", ")
+	builder.WriteString("workload_name=")
+	builder.WriteString(_m.WorkloadName)
+	builder.WriteString(", ")
+	builder.WriteString("host_network=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HostNetwork))
+	builder.WriteString(", ")
+	builder.WriteString("containers_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ContainersJSON))
+	builder.WriteString(", ")
+	builder.WriteString("labels_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LabelsJSON))
+	builder.WriteString(", ")
+	if v := _m.APICreatedAt; v != nil {
+		builder.WriteString("api_created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryK8sPods is a parsable slice of BronzeHistoryK8sPod.
+type BronzeHistoryK8sPods []*BronzeHistoryK8sPod
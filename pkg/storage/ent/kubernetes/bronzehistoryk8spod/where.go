@@ -0,0 +1,1270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryk8spod
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLTE(FieldID, id))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldValidTo, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ClusterName applies equality check predicate on the "cluster_name" field. It's identical to ClusterNameEQ.
+func ClusterName(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldClusterName, v))
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldNamespace, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldName, v))
+}
+
+// UID applies equality check predicate on the "uid" field. It's identical to UIDEQ.
+func UID(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldUID, v))
+}
+
+// Phase applies equality check predicate on the "phase" field. It's identical to PhaseEQ.
+func Phase(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldPhase, v))
+}
+
+// NodeName applies equality check predicate on the "node_name" field. It's identical to NodeNameEQ.
+func NodeName(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldNodeName, v))
+}
+
+// PodIP applies equality check predicate on the "pod_ip" field. It's identical to PodIPEQ.
+func PodIP(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldPodIP, v))
+}
+
+// HostIP applies equality check predicate on the "host_ip" field. It's identical to HostIPEQ.
+func HostIP(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldHostIP, v))
+}
+
+// ServiceAccount applies equality check predicate on the "service_account" field. It's identical to ServiceAccountEQ.
+func ServiceAccount(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldServiceAccount, v))
+}
+
+// WorkloadKind applies equality check predicate on the "workload_kind" field. It's identical to WorkloadKindEQ.
+func WorkloadKind(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldWorkloadKind, v))
+}
+
+// WorkloadName applies equality check predicate on the "workload_name" field. It's identical to WorkloadNameEQ.
+func WorkloadName(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldWorkloadName, v))
+}
+
+// HostNetwork applies equality check predicate on the "host_network" field. It's identical to HostNetworkEQ.
+func HostNetwork(v bool) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldHostNetwork, v))
+}
+
+// APICreatedAt applies equality check predicate on the "api_created_at" field. It's identical to APICreatedAtEQ.
+func APICreatedAt(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotNull(FieldValidTo))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// ClusterNameEQ applies the EQ predicate on the "cluster_name" field.
+func ClusterNameEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldClusterName, v))
+}
+
+// ClusterNameNEQ applies the NEQ predicate on the "cluster_name" field.
+func ClusterNameNEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNEQ(FieldClusterName, v))
+}
+
+// ClusterNameIn applies the In predicate on the "cluster_name" field.
+func ClusterNameIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIn(FieldClusterName, vs...))
+}
+
+// ClusterNameNotIn applies the NotIn predicate on the "cluster_name" field.
+func ClusterNameNotIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotIn(FieldClusterName, vs...))
+}
+
+// ClusterNameGT applies the GT predicate on the "cluster_name" field.
+func ClusterNameGT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGT(FieldClusterName, v))
+}
+
+// ClusterNameGTE applies the GTE predicate on the "cluster_name" field.
+func ClusterNameGTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGTE(FieldClusterName, v))
+}
+
+// ClusterNameLT applies the LT predicate on the "cluster_name" field.
+func ClusterNameLT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLT(FieldClusterName, v))
+}
+
+// ClusterNameLTE applies the LTE predicate on the "cluster_name" field.
+func ClusterNameLTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLTE(FieldClusterName, v))
+}
+
+// ClusterNameContains applies the Contains predicate on the "cluster_name" field.
+func ClusterNameContains(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContains(FieldClusterName, v))
+}
+
+// ClusterNameHasPrefix applies the HasPrefix predicate on the "cluster_name" field.
+func ClusterNameHasPrefix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasPrefix(FieldClusterName, v))
+}
+
+// ClusterNameHasSuffix applies the HasSuffix predicate on the "cluster_name" field.
+func ClusterNameHasSuffix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasSuffix(FieldClusterName, v))
+}
+
+// ClusterNameEqualFold applies the EqualFold predicate on the "cluster_name" field.
+func ClusterNameEqualFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEqualFold(FieldClusterName, v))
+}
+
+// ClusterNameContainsFold applies the ContainsFold predicate on the "cluster_name" field.
+func ClusterNameContainsFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContainsFold(FieldClusterName, v))
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldNamespace, v))
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNEQ(FieldNamespace, v))
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIn(FieldNamespace, vs...))
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotIn(FieldNamespace, vs...))
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGT(FieldNamespace, v))
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGTE(FieldNamespace, v))
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLT(FieldNamespace, v))
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLTE(FieldNamespace, v))
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContains(FieldNamespace, v))
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasPrefix(FieldNamespace, v))
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasSuffix(FieldNamespace, v))
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEqualFold(FieldNamespace, v))
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContainsFold(FieldNamespace, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContainsFold(FieldName, v))
+}
+
+// UIDEQ applies the EQ predicate on the "uid" field.
+func UIDEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldUID, v))
+}
+
+// UIDNEQ applies the NEQ predicate on the "uid" field.
+func UIDNEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNEQ(FieldUID, v))
+}
+
+// UIDIn applies the In predicate on the "uid" field.
+func UIDIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIn(FieldUID, vs...))
+}
+
+// UIDNotIn applies the NotIn predicate on the "uid" field.
+func UIDNotIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotIn(FieldUID, vs...))
+}
+
+// UIDGT applies the GT predicate on the "uid" field.
+func UIDGT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGT(FieldUID, v))
+}
+
+// UIDGTE applies the GTE predicate on the "uid" field.
+func UIDGTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGTE(FieldUID, v))
+}
+
+// UIDLT applies the LT predicate on the "uid" field.
+func UIDLT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLT(FieldUID, v))
+}
+
+// UIDLTE applies the LTE predicate on the "uid" field.
+func UIDLTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLTE(FieldUID, v))
+}
+
+// UIDContains applies the Contains predicate on the "uid" field.
+func UIDContains(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContains(FieldUID, v))
+}
+
+// UIDHasPrefix applies the HasPrefix predicate on the "uid" field.
+func UIDHasPrefix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasPrefix(FieldUID, v))
+}
+
+// UIDHasSuffix applies the HasSuffix predicate on the "uid" field.
+func UIDHasSuffix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasSuffix(FieldUID, v))
+}
+
+// UIDIsNil applies the IsNil predicate on the "uid" field.
+func UIDIsNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIsNull(FieldUID))
+}
+
+// UIDNotNil applies the NotNil predicate on the "uid" field.
+func UIDNotNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotNull(FieldUID))
+}
+
+// UIDEqualFold applies the EqualFold predicate on the "uid" field.
+func UIDEqualFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEqualFold(FieldUID, v))
+}
+
+// UIDContainsFold applies the ContainsFold predicate on the "uid" field.
+func UIDContainsFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContainsFold(FieldUID, v))
+}
+
+// PhaseEQ applies the EQ predicate on the "phase" field.
+func PhaseEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldPhase, v))
+}
+
+// PhaseNEQ applies the NEQ predicate on the "phase" field.
+func PhaseNEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNEQ(FieldPhase, v))
+}
+
+// PhaseIn applies the In predicate on the "phase" field.
+func PhaseIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIn(FieldPhase, vs...))
+}
+
+// PhaseNotIn applies the NotIn predicate on the "phase" field.
+func PhaseNotIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotIn(FieldPhase, vs...))
+}
+
+// PhaseGT applies the GT predicate on the "phase" field.
+func PhaseGT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGT(FieldPhase, v))
+}
+
+// PhaseGTE applies the GTE predicate on the "phase" field.
+func PhaseGTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGTE(FieldPhase, v))
+}
+
+// PhaseLT applies the LT predicate on the "phase" field.
+func PhaseLT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLT(FieldPhase, v))
+}
+
+// PhaseLTE applies the LTE predicate on the "phase" field.
+func PhaseLTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLTE(FieldPhase, v))
+}
+
+// PhaseContains applies the Contains predicate on the "phase" field.
+func PhaseContains(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContains(FieldPhase, v))
+}
+
+// PhaseHasPrefix applies the HasPrefix predicate on the "phase" field.
+func PhaseHasPrefix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasPrefix(FieldPhase, v))
+}
+
+// PhaseHasSuffix applies the HasSuffix predicate on the "phase" field.
+func PhaseHasSuffix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasSuffix(FieldPhase, v))
+}
+
+// PhaseIsNil applies the IsNil predicate on the "phase" field.
+func PhaseIsNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIsNull(FieldPhase))
+}
+
+// PhaseNotNil applies the NotNil predicate on the "phase" field.
+func PhaseNotNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotNull(FieldPhase))
+}
+
+// PhaseEqualFold applies the EqualFold predicate on the "phase" field.
+func PhaseEqualFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEqualFold(FieldPhase, v))
+}
+
+// PhaseContainsFold applies the ContainsFold predicate on the "phase" field.
+func PhaseContainsFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContainsFold(FieldPhase, v))
+}
+
+// NodeNameEQ applies the EQ predicate on the "node_name" field.
+func NodeNameEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldNodeName, v))
+}
+
+// NodeNameNEQ applies the NEQ predicate on the "node_name" field.
+func NodeNameNEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNEQ(FieldNodeName, v))
+}
+
+// NodeNameIn applies the In predicate on the "node_name" field.
+func NodeNameIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIn(FieldNodeName, vs...))
+}
+
+// NodeNameNotIn applies the NotIn predicate on the "node_name" field.
+func NodeNameNotIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotIn(FieldNodeName, vs...))
+}
+
+// NodeNameGT applies the GT predicate on the "node_name" field.
+func NodeNameGT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGT(FieldNodeName, v))
+}
+
+// NodeNameGTE applies the GTE predicate on the "node_name" field.
+func NodeNameGTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGTE(FieldNodeName, v))
+}
+
+// NodeNameLT applies the LT predicate on the "node_name" field.
+func NodeNameLT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLT(FieldNodeName, v))
+}
+
+// NodeNameLTE applies the LTE predicate on the "node_name" field.
+func NodeNameLTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLTE(FieldNodeName, v))
+}
+
+// NodeNameContains applies the Contains predicate on the "node_name" field.
+func NodeNameContains(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContains(FieldNodeName, v))
+}
+
+// NodeNameHasPrefix applies the HasPrefix predicate on the "node_name" field.
+func NodeNameHasPrefix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasPrefix(FieldNodeName, v))
+}
+
+// NodeNameHasSuffix applies the HasSuffix predicate on the "node_name" field.
+func NodeNameHasSuffix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasSuffix(FieldNodeName, v))
+}
+
+// NodeNameIsNil applies the IsNil predicate on the "node_name" field.
+func NodeNameIsNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIsNull(FieldNodeName))
+}
+
+// NodeNameNotNil applies the NotNil predicate on the "node_name" field.
+func NodeNameNotNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotNull(FieldNodeName))
+}
+
+// NodeNameEqualFold applies the EqualFold predicate on the "node_name" field.
+func NodeNameEqualFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEqualFold(FieldNodeName, v))
+}
+
+// NodeNameContainsFold applies the ContainsFold predicate on the "node_name" field.
+func NodeNameContainsFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContainsFold(FieldNodeName, v))
+}
+
+// PodIPEQ applies the EQ predicate on the "pod_ip" field.
+func PodIPEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldPodIP, v))
+}
+
+// PodIPNEQ applies the NEQ predicate on the "pod_ip" field.
+func PodIPNEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNEQ(FieldPodIP, v))
+}
+
+// PodIPIn applies the In predicate on the "pod_ip" field.
+func PodIPIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIn(FieldPodIP, vs...))
+}
+
+// PodIPNotIn applies the NotIn predicate on the "pod_ip" field.
+func PodIPNotIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotIn(FieldPodIP, vs...))
+}
+
+// PodIPGT applies the GT predicate on the "pod_ip" field.
+func PodIPGT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGT(FieldPodIP, v))
+}
+
+// PodIPGTE applies the GTE predicate on the "pod_ip" field.
+func PodIPGTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGTE(FieldPodIP, v))
+}
+
+// PodIPLT applies the LT predicate on the "pod_ip" field.
+func PodIPLT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLT(FieldPodIP, v))
+}
+
+// PodIPLTE applies the LTE predicate on the "pod_ip" field.
+func PodIPLTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLTE(FieldPodIP, v))
+}
+
+// PodIPContains applies the Contains predicate on the "pod_ip" field.
+func PodIPContains(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContains(FieldPodIP, v))
+}
+
+// PodIPHasPrefix applies the HasPrefix predicate on the "pod_ip" field.
+func PodIPHasPrefix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasPrefix(FieldPodIP, v))
+}
+
+// PodIPHasSuffix applies the HasSuffix predicate on the "pod_ip" field.
+func PodIPHasSuffix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasSuffix(FieldPodIP, v))
+}
+
+// PodIPIsNil applies the IsNil predicate on the "pod_ip" field.
+func PodIPIsNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIsNull(FieldPodIP))
+}
+
+// PodIPNotNil applies the NotNil predicate on the "pod_ip" field.
+func PodIPNotNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotNull(FieldPodIP))
+}
+
+// PodIPEqualFold applies the EqualFold predicate on the "pod_ip" field.
+func PodIPEqualFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEqualFold(FieldPodIP, v))
+}
+
+// PodIPContainsFold applies the ContainsFold predicate on the "pod_ip" field.
+func PodIPContainsFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContainsFold(FieldPodIP, v))
+}
+
+// HostIPEQ applies the EQ predicate on the "host_ip" field.
+func HostIPEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldHostIP, v))
+}
+
+// HostIPNEQ applies the NEQ predicate on the "host_ip" field.
+func HostIPNEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNEQ(FieldHostIP, v))
+}
+
+// HostIPIn applies the In predicate on the "host_ip" field.
+func HostIPIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIn(FieldHostIP, vs...))
+}
+
+// HostIPNotIn applies the NotIn predicate on the "host_ip" field.
+func HostIPNotIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotIn(FieldHostIP, vs...))
+}
+
+// HostIPGT applies the GT predicate on the "host_ip" field.
+func HostIPGT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGT(FieldHostIP, v))
+}
+
+// HostIPGTE applies the GTE predicate on the "host_ip" field.
+func HostIPGTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGTE(FieldHostIP, v))
+}
+
+// HostIPLT applies the LT predicate on the "host_ip" field.
+func HostIPLT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLT(FieldHostIP, v))
+}
+
+// HostIPLTE applies the LTE predicate on the "host_ip" field.
+func HostIPLTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLTE(FieldHostIP, v))
+}
+
+// HostIPContains applies the Contains predicate on the "host_ip" field.
+func HostIPContains(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContains(FieldHostIP, v))
+}
+
+// HostIPHasPrefix applies the HasPrefix predicate on the "host_ip" field.
+func HostIPHasPrefix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasPrefix(FieldHostIP, v))
+}
+
+// HostIPHasSuffix applies the HasSuffix predicate on the "host_ip" field.
+func HostIPHasSuffix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasSuffix(FieldHostIP, v))
+}
+
+// HostIPIsNil applies the IsNil predicate on the "host_ip" field.
+func HostIPIsNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIsNull(FieldHostIP))
+}
+
+// HostIPNotNil applies the NotNil predicate on the "host_ip" field.
+func HostIPNotNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotNull(FieldHostIP))
+}
+
+// HostIPEqualFold applies the EqualFold predicate on the "host_ip" field.
+func HostIPEqualFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEqualFold(FieldHostIP, v))
+}
+
+// HostIPContainsFold applies the ContainsFold predicate on the "host_ip" field.
+func HostIPContainsFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContainsFold(FieldHostIP, v))
+}
+
+// ServiceAccountEQ applies the EQ predicate on the "service_account" field.
+func ServiceAccountEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldServiceAccount, v))
+}
+
+// ServiceAccountNEQ applies the NEQ predicate on the "service_account" field.
+func ServiceAccountNEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNEQ(FieldServiceAccount, v))
+}
+
+// ServiceAccountIn applies the In predicate on the "service_account" field.
+func ServiceAccountIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIn(FieldServiceAccount, vs...))
+}
+
+// ServiceAccountNotIn applies the NotIn predicate on the "service_account" field.
+func ServiceAccountNotIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotIn(FieldServiceAccount, vs...))
+}
+
+// ServiceAccountGT applies the GT predicate on the "service_account" field.
+func ServiceAccountGT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGT(FieldServiceAccount, v))
+}
+
+// ServiceAccountGTE applies the GTE predicate on the "service_account" field.
+func ServiceAccountGTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGTE(FieldServiceAccount, v))
+}
+
+// ServiceAccountLT applies the LT predicate on the "service_account" field.
+func ServiceAccountLT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLT(FieldServiceAccount, v))
+}
+
+// ServiceAccountLTE applies the LTE predicate on the "service_account" field.
+func ServiceAccountLTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLTE(FieldServiceAccount, v))
+}
+
+// ServiceAccountContains applies the Contains predicate on the "service_account" field.
+func ServiceAccountContains(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContains(FieldServiceAccount, v))
+}
+
+// ServiceAccountHasPrefix applies the HasPrefix predicate on the "service_account" field.
+func ServiceAccountHasPrefix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasPrefix(FieldServiceAccount, v))
+}
+
+// ServiceAccountHasSuffix applies the HasSuffix predicate on the "service_account" field.
+func ServiceAccountHasSuffix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasSuffix(FieldServiceAccount, v))
+}
+
+// ServiceAccountIsNil applies the IsNil predicate on the "service_account" field.
+func ServiceAccountIsNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIsNull(FieldServiceAccount))
+}
+
+// ServiceAccountNotNil applies the NotNil predicate on the "service_account" field.
+func ServiceAccountNotNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotNull(FieldServiceAccount))
+}
+
+// ServiceAccountEqualFold applies the EqualFold predicate on the "service_account" field.
+func ServiceAccountEqualFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEqualFold(FieldServiceAccount, v))
+}
+
+// ServiceAccountContainsFold applies the ContainsFold predicate on the "service_account" field.
+func ServiceAccountContainsFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContainsFold(FieldServiceAccount, v))
+}
+
+// WorkloadKindEQ applies the EQ predicate on the "workload_kind" field.
+func WorkloadKindEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldWorkloadKind, v))
+}
+
+// WorkloadKindNEQ applies the NEQ predicate on the "workload_kind" field.
+func WorkloadKindNEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNEQ(FieldWorkloadKind, v))
+}
+
+// WorkloadKindIn applies the In predicate on the "workload_kind" field.
+func WorkloadKindIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIn(FieldWorkloadKind, vs...))
+}
+
+// WorkloadKindNotIn applies the NotIn predicate on the "workload_kind" field.
+func WorkloadKindNotIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotIn(FieldWorkloadKind, vs...))
+}
+
+// WorkloadKindGT applies the GT predicate on the "workload_kind" field.
+func WorkloadKindGT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGT(FieldWorkloadKind, v))
+}
+
+// WorkloadKindGTE applies the GTE predicate on the "workload_kind" field.
+func WorkloadKindGTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGTE(FieldWorkloadKind, v))
+}
+
+// WorkloadKindLT applies the LT predicate on the "workload_kind" field.
+func WorkloadKindLT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLT(FieldWorkloadKind, v))
+}
+
+// WorkloadKindLTE applies the LTE predicate on the "workload_kind" field.
+func WorkloadKindLTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLTE(FieldWorkloadKind, v))
+}
+
+// WorkloadKindContains applies the Contains predicate on the "workload_kind" field.
+func WorkloadKindContains(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContains(FieldWorkloadKind, v))
+}
+
+// WorkloadKindHasPrefix applies the HasPrefix predicate on the "workload_kind" field.
+func WorkloadKindHasPrefix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasPrefix(FieldWorkloadKind, v))
+}
+
+// WorkloadKindHasSuffix applies the HasSuffix predicate on the "workload_kind" field.
+func WorkloadKindHasSuffix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasSuffix(FieldWorkloadKind, v))
+}
+
+// WorkloadKindIsNil applies the IsNil predicate on the "workload_kind" field.
+func WorkloadKindIsNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIsNull(FieldWorkloadKind))
+}
+
+// WorkloadKindNotNil applies the NotNil predicate on the "workload_kind" field.
+func WorkloadKindNotNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotNull(FieldWorkloadKind))
+}
+
+// WorkloadKindEqualFold applies the EqualFold predicate on the "workload_kind" field.
+func WorkloadKindEqualFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEqualFold(FieldWorkloadKind, v))
+}
+
+// WorkloadKindContainsFold applies the ContainsFold predicate on the "workload_kind" field.
+func WorkloadKindContainsFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContainsFold(FieldWorkloadKind, v))
+}
+
+// WorkloadNameEQ applies the EQ predicate on the "workload_name" field.
+func WorkloadNameEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldWorkloadName, v))
+}
+
+// WorkloadNameNEQ applies the NEQ predicate on the "workload_name" field.
+func WorkloadNameNEQ(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNEQ(FieldWorkloadName, v))
+}
+
+// WorkloadNameIn applies the In predicate on the "workload_name" field.
+func WorkloadNameIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIn(FieldWorkloadName, vs...))
+}
+
+// WorkloadNameNotIn applies the NotIn predicate on the "workload_name" field.
+func WorkloadNameNotIn(vs ...string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotIn(FieldWorkloadName, vs...))
+}
+
+// WorkloadNameGT applies the GT predicate on the "workload_name" field.
+func WorkloadNameGT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGT(FieldWorkloadName, v))
+}
+
+// WorkloadNameGTE applies the GTE predicate on the "workload_name" field.
+func WorkloadNameGTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGTE(FieldWorkloadName, v))
+}
+
+// WorkloadNameLT applies the LT predicate on the "workload_name" field.
+func WorkloadNameLT(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLT(FieldWorkloadName, v))
+}
+
+// WorkloadNameLTE applies the LTE predicate on the "workload_name" field.
+func WorkloadNameLTE(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLTE(FieldWorkloadName, v))
+}
+
+// WorkloadNameContains applies the Contains predicate on the "workload_name" field.
+func WorkloadNameContains(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContains(FieldWorkloadName, v))
+}
+
+// WorkloadNameHasPrefix applies the HasPrefix predicate on the "workload_name" field.
+func WorkloadNameHasPrefix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasPrefix(FieldWorkloadName, v))
+}
+
+// WorkloadNameHasSuffix applies the HasSuffix predicate on the "workload_name" field.
+func WorkloadNameHasSuffix(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldHasSuffix(FieldWorkloadName, v))
+}
+
+// WorkloadNameIsNil applies the IsNil predicate on the "workload_name" field.
+func WorkloadNameIsNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIsNull(FieldWorkloadName))
+}
+
+// WorkloadNameNotNil applies the NotNil predicate on the "workload_name" field.
+func WorkloadNameNotNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotNull(FieldWorkloadName))
+}
+
+// WorkloadNameEqualFold applies the EqualFold predicate on the "workload_name" field.
+func WorkloadNameEqualFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEqualFold(FieldWorkloadName, v))
+}
+
+// WorkloadNameContainsFold applies the ContainsFold predicate on the "workload_name" field.
+func WorkloadNameContainsFold(v string) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldContainsFold(FieldWorkloadName, v))
+}
+
+// HostNetworkEQ applies the EQ predicate on the "host_network" field.
+func HostNetworkEQ(v bool) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldHostNetwork, v))
+}
+
+// HostNetworkNEQ applies the NEQ predicate on the "host_network" field.
+func HostNetworkNEQ(v bool) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNEQ(FieldHostNetwork, v))
+}
+
+// ContainersJSONIsNil applies the IsNil predicate on the "containers_json" field.
+func ContainersJSONIsNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIsNull(FieldContainersJSON))
+}
+
+// ContainersJSONNotNil applies the NotNil predicate on the "containers_json" field.
+func ContainersJSONNotNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotNull(FieldContainersJSON))
+}
+
+// LabelsJSONIsNil applies the IsNil predicate on the "labels_json" field.
+func LabelsJSONIsNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIsNull(FieldLabelsJSON))
+}
+
+// LabelsJSONNotNil applies the NotNil predicate on the "labels_json" field.
+func LabelsJSONNotNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotNull(FieldLabelsJSON))
+}
+
+// APICreatedAtEQ applies the EQ predicate on the "api_created_at" field.
+func APICreatedAtEQ(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtNEQ applies the NEQ predicate on the "api_created_at" field.
+func APICreatedAtNEQ(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtIn applies the In predicate on the "api_created_at" field.
+func APICreatedAtIn(vs ...time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtNotIn applies the NotIn predicate on the "api_created_at" field.
+func APICreatedAtNotIn(vs ...time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtGT applies the GT predicate on the "api_created_at" field.
+func APICreatedAtGT(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtGTE applies the GTE predicate on the "api_created_at" field.
+func APICreatedAtGTE(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldGTE(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLT applies the LT predicate on the "api_created_at" field.
+func APICreatedAtLT(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLTE applies the LTE predicate on the "api_created_at" field.
+func APICreatedAtLTE(v time.Time) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldLTE(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtIsNil applies the IsNil predicate on the "api_created_at" field.
+func APICreatedAtIsNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldIsNull(FieldAPICreatedAt))
+}
+
+// APICreatedAtNotNil applies the NotNil predicate on the "api_created_at" field.
+func APICreatedAtNotNil() predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.FieldNotNull(FieldAPICreatedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeHistoryK8sPod) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeHistoryK8sPod) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeHistoryK8sPod) predicate.BronzeHistoryK8sPod {
+	return predicate.BronzeHistoryK8sPod(sql.NotPredicates(p))
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8spod"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryK8sPodDelete is the builder for deleting a BronzeHistoryK8sPod entity.
+type BronzeHistoryK8sPodDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryK8sPodMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sPodDelete builder.
+func (_d *BronzeHistoryK8sPodDelete) Where(ps ...predicate.BronzeHistoryK8sPod) *BronzeHistoryK8sPodDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeHistoryK8sPodDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryK8sPodDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeHistoryK8sPodDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzehistoryk8spod.Table, sqlgraph.NewFieldSpec(bronzehistoryk8spod.FieldID, field.TypeUint))
+	_spec.Node.Schema = _d.schemaConfig.BronzeHistoryK8sPod
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeHistoryK8sPodDeleteOne is the builder for deleting a single BronzeHistoryK8sPod entity.
+type BronzeHistoryK8sPodDeleteOne struct {
+	_d *BronzeHistoryK8sPodDelete
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sPodDelete builder.
+func (_d *BronzeHistoryK8sPodDeleteOne) Where(ps ...predicate.BronzeHistoryK8sPod) *BronzeHistoryK8sPodDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeHistoryK8sPodDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzehistoryk8spod.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryK8sPodDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1113 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8spod"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryK8sPodUpdate is the builder for updating BronzeHistoryK8sPod entities.
+type BronzeHistoryK8sPodUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryK8sPodMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sPodUpdate builder.
+func (_u *BronzeHistoryK8sPodUpdate) Where(ps ...predicate.BronzeHistoryK8sPod) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryK8sPodUpdate) SetValidTo(v time.Time) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sPodUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryK8sPodUpdate) ClearValidTo() *BronzeHistoryK8sPodUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryK8sPodUpdate) SetCollectedAt(v time.Time) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryK8sPodUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryK8sPodUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryK8sPodUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryK8sPodUpdate) SetResourceID(v string) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdate) SetNillableResourceID(v *string) *BronzeHistoryK8sPodUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeHistoryK8sPodUpdate) SetClusterName(v string) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdate) SetNillableClusterName(v *string) *BronzeHistoryK8sPodUpdate {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeHistoryK8sPodUpdate) SetNamespace(v string) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdate) SetNillableNamespace(v *string) *BronzeHistoryK8sPodUpdate {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryK8sPodUpdate) SetName(v string) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdate) SetNillableName(v *string) *BronzeHistoryK8sPodUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeHistoryK8sPodUpdate) SetUID(v string) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdate) SetNillableUID(v *string) *BronzeHistoryK8sPodUpdate {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeHistoryK8sPodUpdate) ClearUID() *BronzeHistoryK8sPodUpdate {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetPhase sets the "phase" field.
+func (_u *BronzeHistoryK8sPodUpdate) SetPhase(v string) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.SetPhase(v)
+	return _u
+}
+
+// SetNillablePhase sets the "phase" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdate) SetNillablePhase(v *string) *BronzeHistoryK8sPodUpdate {
+	if v != nil {
+		_u.SetPhase(*v)
+	}
+	return _u
+}
+
+// ClearPhase clears the value of the "phase" field.
+func (_u *BronzeHistoryK8sPodUpdate) ClearPhase() *BronzeHistoryK8sPodUpdate {
+	_u.mutation.ClearPhase()
+	return _u
+}
+
+// SetNodeName sets the "node_name" field.
+func (_u *BronzeHistoryK8sPodUpdate) SetNodeName(v string) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.SetNodeName(v)
+	return _u
+}
+
+// SetNillableNodeName sets the "node_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdate) SetNillableNodeName(v *string) *BronzeHistoryK8sPodUpdate {
+	if v != nil {
+		_u.SetNodeName(*v)
+	}
+	return _u
+}
+
+// ClearNodeName clears the value of the "node_name" field.
+func (_u *BronzeHistoryK8sPodUpdate) ClearNodeName() *BronzeHistoryK8sPodUpdate {
+	_u.mutation.ClearNodeName()
+	return _u
+}
+
+// SetPodIP sets the "pod_ip" field.
+func (_u *BronzeHistoryK8sPodUpdate) SetPodIP(v string) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.SetPodIP(v)
+	return _u
+}
+
+// SetNillablePodIP sets the "pod_ip" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdate) SetNillablePodIP(v *string) *BronzeHistoryK8sPodUpdate {
+	if v != nil {
+		_u.SetPodIP(*v)
+	}
+	return _u
+}
+
+// ClearPodIP clears the value of the "pod_ip" field.
+func (_u *BronzeHistoryK8sPodUpdate) ClearPodIP() *BronzeHistoryK8sPodUpdate {
+	_u.mutation.ClearPodIP()
+	return _u
+}
+
+// SetHostIP sets the "host_ip" field.
+func (_u *BronzeHistoryK8sPodUpdate) SetHostIP(v string) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.SetHostIP(v)
+	return _u
+}
+
+// SetNillableHostIP sets the "host_ip" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdate) SetNillableHostIP(v *string) *BronzeHistoryK8sPodUpdate {
+	if v != nil {
+		_u.SetHostIP(*v)
+	}
+	return _u
+}
+
+// ClearHostIP clears the value of the "host_ip" field.
+func (_u *BronzeHistoryK8sPodUpdate) ClearHostIP() *BronzeHistoryK8sPodUpdate {
+	_u.mutation.ClearHostIP()
+	return _u
+}
+
+// SetServiceAccount sets the "service_account" field.
+func (_u *BronzeHistoryK8sPodUpdate) SetServiceAccount(v string) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.SetServiceAccount(v)
+	return _u
+}
+
+// SetNillableServiceAccount sets the "service_account" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdate) SetNillableServiceAccount(v *string) *BronzeHistoryK8sPodUpdate {
+	if v != nil {
+		_u.SetServiceAccount(*v)
+	}
+	return _u
+}
+
+// ClearServiceAccount clears the value of the "service_account" field.
+func (_u *BronzeHistoryK8sPodUpdate) ClearServiceAccount() *BronzeHistoryK8sPodUpdate {
+	_u.mutation.ClearServiceAccount()
+	return _u
+}
+
+// SetWorkloadKind sets the "workload_kind" field.
+func (_u *BronzeHistoryK8sPodUpdate) SetWorkloadKind(v string) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.SetWorkloadKind(v)
+	return _u
+}
+
+// SetNillableWorkloadKind sets the "workload_kind" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdate) SetNillableWorkloadKind(v *string) *BronzeHistoryK8sPodUpdate {
+	if v != nil {
+		_u.SetWorkloadKind(*v)
+	}
+	return _u
+}
+
+// ClearWorkloadKind clears the value of the "workload_kind" field.
+func (_u *BronzeHistoryK8sPodUpdate) ClearWorkloadKind() *BronzeHistoryK8sPodUpdate {
+	_u.mutation.ClearWorkloadKind()
+	return _u
+}
+
+// SetWorkloadName sets the "workload_name" field.
+func (_u *BronzeHistoryK8sPodUpdate) SetWorkloadName(v string) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.SetWorkloadName(v)
+	return _u
+}
+
+// SetNillableWorkloadName sets the "workload_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdate) SetNillableWorkloadName(v *string) *BronzeHistoryK8sPodUpdate {
+	if v != nil {
+		_u.SetWorkloadName(*v)
+	}
+	return _u
+}
+
+// ClearWorkloadName clears the value of the "workload_name" field.
+func (_u *BronzeHistoryK8sPodUpdate) ClearWorkloadName() *BronzeHistoryK8sPodUpdate {
+	_u.mutation.ClearWorkloadName()
+	return _u
+}
+
+// SetHostNetwork sets the "host_network" field.
+func (_u *BronzeHistoryK8sPodUpdate) SetHostNetwork(v bool) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.SetHostNetwork(v)
+	return _u
+}
+
+// SetNillableHostNetwork sets the "host_network" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdate) SetNillableHostNetwork(v *bool) *BronzeHistoryK8sPodUpdate {
+	if v != nil {
+		_u.SetHostNetwork(*v)
+	}
+	return _u
+}
+
+// SetContainersJSON sets the "containers_json" field.
+func (_u *BronzeHistoryK8sPodUpdate) SetContainersJSON(v json.RawMessage) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.SetContainersJSON(v)
+	return _u
+}
+
+// AppendContainersJSON appends value to the "containers_json" field.
+func (_u *BronzeHistoryK8sPodUpdate) AppendContainersJSON(v json.RawMessage) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.AppendContainersJSON(v)
+	return _u
+}
+
+// ClearContainersJSON clears the value of the "containers_json" field.
+func (_u *BronzeHistoryK8sPodUpdate) ClearContainersJSON() *BronzeHistoryK8sPodUpdate {
+	_u.mutation.ClearContainersJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeHistoryK8sPodUpdate) SetLabelsJSON(v json.RawMessage) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeHistoryK8sPodUpdate) AppendLabelsJSON(v json.RawMessage) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeHistoryK8sPodUpdate) ClearLabelsJSON() *BronzeHistoryK8sPodUpdate {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryK8sPodUpdate) SetAPICreatedAt(v time.Time) *BronzeHistoryK8sPodUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryK8sPodUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryK8sPodUpdate) ClearAPICreatedAt() *BronzeHistoryK8sPodUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryK8sPodMutation object of the builder.
+func (_u *BronzeHistoryK8sPodUpdate) Mutation() *BronzeHistoryK8sPodMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryK8sPodUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryK8sPodUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryK8sPodUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryK8sPodUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryK8sPodUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8spod.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sPod.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8spod.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sPod.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Namespace(); ok {
+		if err := bronzehistoryk8spod.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sPod.namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzehistoryk8spod.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sPod.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryK8sPodUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryk8spod.Table, bronzehistoryk8spod.Columns, sqlgraph.NewFieldSpec(bronzehistoryk8spod.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phase(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldPhase, field.TypeString, value)
+	}
+	if _u.mutation.PhaseCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldPhase, field.TypeString)
+	}
+	if value, ok := _u.mutation.NodeName(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldNodeName, field.TypeString, value)
+	}
+	if _u.mutation.NodeNameCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldNodeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.PodIP(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldPodIP, field.TypeString, value)
+	}
+	if _u.mutation.PodIPCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldPodIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.HostIP(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldHostIP, field.TypeString, value)
+	}
+	if _u.mutation.HostIPCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldHostIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.ServiceAccount(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldServiceAccount, field.TypeString, value)
+	}
+	if _u.mutation.ServiceAccountCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldServiceAccount, field.TypeString)
+	}
+	if value, ok := _u.mutation.WorkloadKind(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldWorkloadKind, field.TypeString, value)
+	}
+	if _u.mutation.WorkloadKindCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldWorkloadKind, field.TypeString)
+	}
+	if value, ok := _u.mutation.WorkloadName(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldWorkloadName, field.TypeString, value)
+	}
+	if _u.mutation.WorkloadNameCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldWorkloadName, field.TypeString)
+	}
+	if value, ok := _u.mutation.HostNetwork(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldHostNetwork, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ContainersJSON(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldContainersJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedContainersJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8spod.FieldContainersJSON, value)
+		})
+	}
+	if _u.mutation.ContainersJSONCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldContainersJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8spod.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryK8sPod
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryk8spod.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryK8sPodUpdateOne is the builder for updating a single BronzeHistoryK8sPod entity.
+type BronzeHistoryK8sPodUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryK8sPodMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetValidTo(v time.Time) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sPodUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) ClearValidTo() *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryK8sPodUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryK8sPodUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetResourceID(v string) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryK8sPodUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetClusterName(v string) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetNillableClusterName(v *string) *BronzeHistoryK8sPodUpdateOne {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetNamespace(v string) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetNillableNamespace(v *string) *BronzeHistoryK8sPodUpdateOne {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetName(v string) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetNillableName(v *string) *BronzeHistoryK8sPodUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetUID(v string) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetNillableUID(v *string) *BronzeHistoryK8sPodUpdateOne {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) ClearUID() *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetPhase sets the "phase" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetPhase(v string) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.SetPhase(v)
+	return _u
+}
+
+// SetNillablePhase sets the "phase" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetNillablePhase(v *string) *BronzeHistoryK8sPodUpdateOne {
+	if v != nil {
+		_u.SetPhase(*v)
+	}
+	return _u
+}
+
+// ClearPhase clears the value of the "phase" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) ClearPhase() *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.ClearPhase()
+	return _u
+}
+
+// SetNodeName sets the "node_name" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetNodeName(v string) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.SetNodeName(v)
+	return _u
+}
+
+// SetNillableNodeName sets the "node_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetNillableNodeName(v *string) *BronzeHistoryK8sPodUpdateOne {
+	if v != nil {
+		_u.SetNodeName(*v)
+	}
+	return _u
+}
+
+// ClearNodeName clears the value of the "node_name" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) ClearNodeName() *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.ClearNodeName()
+	return _u
+}
+
+// SetPodIP sets the "pod_ip" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetPodIP(v string) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.SetPodIP(v)
+	return _u
+}
+
+// SetNillablePodIP sets the "pod_ip" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetNillablePodIP(v *string) *BronzeHistoryK8sPodUpdateOne {
+	if v != nil {
+		_u.SetPodIP(*v)
+	}
+	return _u
+}
+
+// ClearPodIP clears the value of the "pod_ip" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) ClearPodIP() *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.ClearPodIP()
+	return _u
+}
+
+// SetHostIP sets the "host_ip" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetHostIP(v string) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.SetHostIP(v)
+	return _u
+}
+
+// SetNillableHostIP sets the "host_ip" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetNillableHostIP(v *string) *BronzeHistoryK8sPodUpdateOne {
+	if v != nil {
+		_u.SetHostIP(*v)
+	}
+	return _u
+}
+
+// ClearHostIP clears the value of the "host_ip" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) ClearHostIP() *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.ClearHostIP()
+	return _u
+}
+
+// SetServiceAccount sets the "service_account" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetServiceAccount(v string) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.SetServiceAccount(v)
+	return _u
+}
+
+// SetNillableServiceAccount sets the "service_account" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetNillableServiceAccount(v *string) *BronzeHistoryK8sPodUpdateOne {
+	if v != nil {
+		_u.SetServiceAccount(*v)
+	}
+	return _u
+}
+
+// ClearServiceAccount clears the value of the "service_account" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) ClearServiceAccount() *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.ClearServiceAccount()
+	return _u
+}
+
+// SetWorkloadKind sets the "workload_kind" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetWorkloadKind(v string) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.SetWorkloadKind(v)
+	return _u
+}
+
+// SetNillableWorkloadKind sets the "workload_kind" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetNillableWorkloadKind(v *string) *BronzeHistoryK8sPodUpdateOne {
+	if v != nil {
+		_u.SetWorkloadKind(*v)
+	}
+	return _u
+}
+
+// ClearWorkloadKind clears the value of the "workload_kind" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) ClearWorkloadKind() *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.ClearWorkloadKind()
+	return _u
+}
+
+// SetWorkloadName sets the "workload_name" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetWorkloadName(v string) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.SetWorkloadName(v)
+	return _u
+}
+
+// SetNillableWorkloadName sets the "workload_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetNillableWorkloadName(v *string) *BronzeHistoryK8sPodUpdateOne {
+	if v != nil {
+		_u.SetWorkloadName(*v)
+	}
+	return _u
+}
+
+// ClearWorkloadName clears the value of the "workload_name" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) ClearWorkloadName() *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.ClearWorkloadName()
+	return _u
+}
+
+// SetHostNetwork sets the "host_network" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetHostNetwork(v bool) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.SetHostNetwork(v)
+	return _u
+}
+
+// SetNillableHostNetwork sets the "host_network" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetNillableHostNetwork(v *bool) *BronzeHistoryK8sPodUpdateOne {
+	if v != nil {
+		_u.SetHostNetwork(*v)
+	}
+	return _u
+}
+
+// SetContainersJSON sets the "containers_json" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetContainersJSON(v json.RawMessage) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.SetContainersJSON(v)
+	return _u
+}
+
+// AppendContainersJSON appends value to the "containers_json" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) AppendContainersJSON(v json.RawMessage) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.AppendContainersJSON(v)
+	return _u
+}
+
+// ClearContainersJSON clears the value of the "containers_json" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) ClearContainersJSON() *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.ClearContainersJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetLabelsJSON(v json.RawMessage) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) AppendLabelsJSON(v json.RawMessage) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) ClearLabelsJSON() *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetAPICreatedAt(v time.Time) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sPodUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryK8sPodUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryK8sPodUpdateOne) ClearAPICreatedAt() *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryK8sPodMutation object of the builder.
+func (_u *BronzeHistoryK8sPodUpdateOne) Mutation() *BronzeHistoryK8sPodMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sPodUpdate builder.
+func (_u *BronzeHistoryK8sPodUpdateOne) Where(ps ...predicate.BronzeHistoryK8sPod) *BronzeHistoryK8sPodUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryK8sPodUpdateOne) Select(field string, fields ...string) *BronzeHistoryK8sPodUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryK8sPod entity.
+func (_u *BronzeHistoryK8sPodUpdateOne) Save(ctx context.Context) (*BronzeHistoryK8sPod, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryK8sPodUpdateOne) SaveX(ctx context.Context) *BronzeHistoryK8sPod {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryK8sPodUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryK8sPodUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryK8sPodUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8spod.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sPod.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8spod.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sPod.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Namespace(); ok {
+		if err := bronzehistoryk8spod.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sPod.namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzehistoryk8spod.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sPod.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryK8sPodUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryK8sPod, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryk8spod.Table, bronzehistoryk8spod.Columns, sqlgraph.NewFieldSpec(bronzehistoryk8spod.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`kubernetes: missing "BronzeHistoryK8sPod.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryk8spod.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryk8spod.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("kubernetes: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryk8spod.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phase(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldPhase, field.TypeString, value)
+	}
+	if _u.mutation.PhaseCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldPhase, field.TypeString)
+	}
+	if value, ok := _u.mutation.NodeName(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldNodeName, field.TypeString, value)
+	}
+	if _u.mutation.NodeNameCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldNodeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.PodIP(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldPodIP, field.TypeString, value)
+	}
+	if _u.mutation.PodIPCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldPodIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.HostIP(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldHostIP, field.TypeString, value)
+	}
+	if _u.mutation.HostIPCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldHostIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.ServiceAccount(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldServiceAccount, field.TypeString, value)
+	}
+	if _u.mutation.ServiceAccountCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldServiceAccount, field.TypeString)
+	}
+	if value, ok := _u.mutation.WorkloadKind(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldWorkloadKind, field.TypeString, value)
+	}
+	if _u.mutation.WorkloadKindCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldWorkloadKind, field.TypeString)
+	}
+	if value, ok := _u.mutation.WorkloadName(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldWorkloadName, field.TypeString, value)
+	}
+	if _u.mutation.WorkloadNameCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldWorkloadName, field.TypeString)
+	}
+	if value, ok := _u.mutation.HostNetwork(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldHostNetwork, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ContainersJSON(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldContainersJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedContainersJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8spod.FieldContainersJSON, value)
+		})
+	}
+	if _u.mutation.ContainersJSONCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldContainersJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8spod.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistoryk8spod.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistoryk8spod.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryK8sPod
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryK8sPod{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryk8spod.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
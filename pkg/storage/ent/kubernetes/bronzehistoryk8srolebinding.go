@@ -0,0 +1,286 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8srolebinding"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryK8sRoleBinding is the model entity for the BronzeHistoryK8sRoleBinding schema.
+type BronzeHistoryK8sRoleBinding struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze role binding by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// ClusterName holds the value of the "cluster_name" field.
+	ClusterName string `json:"cluster_name,omitempty"`
+	// RoleBinding or ClusterRoleBinding
+	Kind string `json:"kind,omitempty"`
+	// Empty for ClusterRoleBindings
+	Namespace string `json:"namespace,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// Role or ClusterRole
+	RoleKind string `json:"role_kind,omitempty"`
+	// RoleName holds the value of the "role_name" field.
+	RoleName string `json:"role_name,omitempty"`
+	// Subjects: kind, name, namespace
+	SubjectsJSON json.RawMessage `json:"subjects_json,omitempty"`
+	// Policy rules of the bound role; null when the role does not exist
+	RulesJSON json.RawMessage `json:"rules_json,omitempty"`
+	// LabelsJSON holds the value of the "labels_json" field.
+	LabelsJSON json.RawMessage `json:"labels_json,omitempty"`
+	// APICreatedAt holds the value of the "api_created_at" field.
+	APICreatedAt *time.Time `json:"api_created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryK8sRoleBinding) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryk8srolebinding.FieldSubjectsJSON, bronzehistoryk8srolebinding.FieldRulesJSON, bronzehistoryk8srolebinding.FieldLabelsJSON:
+			values[i] = new([]byte)
+		case bronzehistoryk8srolebinding.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryk8srolebinding.FieldResourceID, bronzehistoryk8srolebinding.FieldClusterName, bronzehistoryk8srolebinding.FieldKind, bronzehistoryk8srolebinding.FieldNamespace, bronzehistoryk8srolebinding.FieldName, bronzehistoryk8srolebinding.FieldUID, bronzehistoryk8srolebinding.FieldRoleKind, bronzehistoryk8srolebinding.FieldRoleName:
+			values[i] = new(sql.NullString)
+		case bronzehistoryk8srolebinding.FieldValidFrom, bronzehistoryk8srolebinding.FieldValidTo, bronzehistoryk8srolebinding.FieldCollectedAt, bronzehistoryk8srolebinding.FieldFirstCollectedAt, bronzehistoryk8srolebinding.FieldAPICreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryK8sRoleBinding fields.
+func (_m *BronzeHistoryK8sRoleBinding) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryk8srolebinding.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryk8srolebinding.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryk8srolebinding.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryk8srolebinding.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryk8srolebinding.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryk8srolebinding.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryk8srolebinding.FieldClusterName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_name", values[i])
+			} else if value.Valid {
+				_m.ClusterName = value.String
+			}
+		case bronzehistoryk8srolebinding.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case bronzehistoryk8srolebinding.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				_m.Namespace = value.String
+			}
+		case bronzehistoryk8srolebinding.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzehistoryk8srolebinding.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				_m.UID = value.String
+			}
+		case bronzehistoryk8srolebinding.FieldRoleKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role_kind", values[i])
+			} else if value.Valid {
+				_m.RoleKind = value.String
+			}
+		case bronzehistoryk8srolebinding.FieldRoleName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role_name", values[i])
+			} else if value.Valid {
+				_m.RoleName = value.String
+			}
+		case bronzehistoryk8srolebinding.FieldSubjectsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field subjects_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SubjectsJSON); err != nil {
+					return fmt.Errorf("unmarshal field subjects_json: %w", err)
+				}
+			}
+		case bronzehistoryk8srolebinding.FieldRulesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rules_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RulesJSON); err != nil {
+					return fmt.Errorf("unmarshal field rules_json: %w", err)
+				}
+			}
+		case bronzehistoryk8srolebinding.FieldLabelsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LabelsJSON); err != nil {
+					return fmt.Errorf("unmarshal field labels_json: %w", err)
+				}
+			}
+		case bronzehistoryk8srolebinding.FieldAPICreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_created_at", values[i])
+			} else if value.Valid {
+				_m.APICreatedAt = new(time.Time)
+				*_m.APICreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryK8sRoleBinding.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryK8sRoleBinding) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryK8sRoleBinding.
+// Note that you need to call BronzeHistoryK8sRoleBinding.Unwrap() before calling this method if this BronzeHistoryK8sRoleBinding
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryK8sRoleBinding) Update() *BronzeHistoryK8sRoleBindingUpdateOne {
+	return NewBronzeHistoryK8sRoleBindingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryK8sRoleBinding entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryK8sRoleBinding) Unwrap() *BronzeHistoryK8sRoleBinding {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("kubernetes: BronzeHistoryK8sRoleBinding is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryK8sRoleBinding) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryK8sRoleBinding(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("cluster_name=")
+	builder.WriteString(_m.ClusterName)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(_m.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("uid=")
+	builder.WriteString(_m.UID)
+	builder.WriteString(", ")
+	builder.WriteString("role_kind=")
+	builder.WriteString(_m.RoleKind)
+	builder.WriteString(", ")
+	builder.WriteString("role_name=")
+	builder.WriteString(_m.RoleName)
+	builder.WriteString(", ")
+	builder.WriteString("subjects_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SubjectsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("rules_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RulesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("labels_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LabelsJSON))
+	builder.WriteString(", ")
+	if v := _m.APICreatedAt; v != nil {
+		builder.WriteString("api_created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryK8sRoleBindings is a parsable slice of BronzeHistoryK8sRoleBinding.
+type BronzeHistoryK8sRoleBindings []*BronzeHistoryK8sRoleBinding
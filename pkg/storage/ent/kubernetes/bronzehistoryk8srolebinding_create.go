@@ -0,0 +1,434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8srolebinding"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryK8sRoleBindingCreate is the builder for creating a BronzeHistoryK8sRoleBinding entity.
+type BronzeHistoryK8sRoleBindingCreate struct {
+	config
+	mutation *BronzeHistoryK8sRoleBindingMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetValidFrom(v time.Time) *BronzeHistoryK8sRoleBindingCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetValidTo(v time.Time) *BronzeHistoryK8sRoleBindingCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sRoleBindingCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetCollectedAt(v time.Time) *BronzeHistoryK8sRoleBindingCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sRoleBindingCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetResourceID(v string) *BronzeHistoryK8sRoleBindingCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetClusterName(v string) *BronzeHistoryK8sRoleBindingCreate {
+	_c.mutation.SetClusterName(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetKind(v string) *BronzeHistoryK8sRoleBindingCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNamespace sets the "namespace" field.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetNamespace(v string) *BronzeHistoryK8sRoleBindingCreate {
+	_c.mutation.SetNamespace(v)
+	return _c
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetNillableNamespace(v *string) *BronzeHistoryK8sRoleBindingCreate {
+	if v != nil {
+		_c.SetNamespace(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetName(v string) *BronzeHistoryK8sRoleBindingCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetUID sets the "uid" field.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetUID(v string) *BronzeHistoryK8sRoleBindingCreate {
+	_c.mutation.SetUID(v)
+	return _c
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetNillableUID(v *string) *BronzeHistoryK8sRoleBindingCreate {
+	if v != nil {
+		_c.SetUID(*v)
+	}
+	return _c
+}
+
+// SetRoleKind sets the "role_kind" field.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetRoleKind(v string) *BronzeHistoryK8sRoleBindingCreate {
+	_c.mutation.SetRoleKind(v)
+	return _c
+}
+
+// SetRoleName sets the "role_name" field.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetRoleName(v string) *BronzeHistoryK8sRoleBindingCreate {
+	_c.mutation.SetRoleName(v)
+	return _c
+}
+
+// SetSubjectsJSON sets the "subjects_json" field.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetSubjectsJSON(v json.RawMessage) *BronzeHistoryK8sRoleBindingCreate {
+	_c.mutation.SetSubjectsJSON(v)
+	return _c
+}
+
+// SetRulesJSON sets the "rules_json" field.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetRulesJSON(v json.RawMessage) *BronzeHistoryK8sRoleBindingCreate {
+	_c.mutation.SetRulesJSON(v)
+	return _c
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetLabelsJSON(v json.RawMessage) *BronzeHistoryK8sRoleBindingCreate {
+	_c.mutation.SetLabelsJSON(v)
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetAPICreatedAt(v time.Time) *BronzeHistoryK8sRoleBindingCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryK8sRoleBindingCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SetID(v uint) *BronzeHistoryK8sRoleBindingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryK8sRoleBindingMutation object of the builder.
+func (_c *BronzeHistoryK8sRoleBindingCreate) Mutation() *BronzeHistoryK8sRoleBindingMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryK8sRoleBinding in the database.
+func (_c *BronzeHistoryK8sRoleBindingCreate) Save(ctx context.Context) (*BronzeHistoryK8sRoleBinding, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryK8sRoleBindingCreate) SaveX(ctx context.Context) *BronzeHistoryK8sRoleBinding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryK8sRoleBindingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryK8sRoleBindingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryK8sRoleBindingCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sRoleBinding.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sRoleBinding.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sRoleBinding.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sRoleBinding.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8srolebinding.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sRoleBinding.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClusterName(); !ok {
+		return &ValidationError{Name: "cluster_name", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sRoleBinding.cluster_name"`)}
+	}
+	if v, ok := _c.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8srolebinding.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sRoleBinding.cluster_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sRoleBinding.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := bronzehistoryk8srolebinding.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sRoleBinding.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sRoleBinding.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := bronzehistoryk8srolebinding.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sRoleBinding.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RoleKind(); !ok {
+		return &ValidationError{Name: "role_kind", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sRoleBinding.role_kind"`)}
+	}
+	if v, ok := _c.mutation.RoleKind(); ok {
+		if err := bronzehistoryk8srolebinding.RoleKindValidator(v); err != nil {
+			return &ValidationError{Name: "role_kind", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sRoleBinding.role_kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RoleName(); !ok {
+		return &ValidationError{Name: "role_name", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sRoleBinding.role_name"`)}
+	}
+	if v, ok := _c.mutation.RoleName(); ok {
+		if err := bronzehistoryk8srolebinding.RoleNameValidator(v); err != nil {
+			return &ValidationError{Name: "role_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sRoleBinding.role_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryK8sRoleBindingCreate) sqlSave(ctx context.Context) (*BronzeHistoryK8sRoleBinding, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryK8sRoleBindingCreate) createSpec() (*BronzeHistoryK8sRoleBinding, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryK8sRoleBinding{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryk8srolebinding.Table, sqlgraph.NewFieldSpec(bronzehistoryk8srolebinding.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryK8sRoleBinding
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldClusterName, field.TypeString, value)
+		_node.ClusterName = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Namespace(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.UID(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldUID, field.TypeString, value)
+		_node.UID = value
+	}
+	if value, ok := _c.mutation.RoleKind(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldRoleKind, field.TypeString, value)
+		_node.RoleKind = value
+	}
+	if value, ok := _c.mutation.RoleName(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldRoleName, field.TypeString, value)
+		_node.RoleName = value
+	}
+	if value, ok := _c.mutation.SubjectsJSON(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldSubjectsJSON, field.TypeJSON, value)
+		_node.SubjectsJSON = value
+	}
+	if value, ok := _c.mutation.RulesJSON(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldRulesJSON, field.TypeJSON, value)
+		_node.RulesJSON = value
+	}
+	if value, ok := _c.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldLabelsJSON, field.TypeJSON, value)
+		_node.LabelsJSON = value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryK8sRoleBindingCreateBulk is the builder for creating many BronzeHistoryK8sRoleBinding entities in bulk.
+type BronzeHistoryK8sRoleBindingCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryK8sRoleBindingCreate
+}
+
+// Save creates the BronzeHistoryK8sRoleBinding entities in the database.
+func (_c *BronzeHistoryK8sRoleBindingCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryK8sRoleBinding, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryK8sRoleBinding, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryK8sRoleBindingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryK8sRoleBindingCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryK8sRoleBinding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryK8sRoleBindingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryK8sRoleBindingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
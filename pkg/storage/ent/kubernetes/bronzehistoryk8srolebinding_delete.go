@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8srolebinding"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryK8sRoleBindingDelete is the builder for deleting a BronzeHistoryK8sRoleBinding entity.
+type BronzeHistoryK8sRoleBindingDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryK8sRoleBindingMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sRoleBindingDelete builder.
+func (_d *BronzeHistoryK8sRoleBindingDelete) Where(ps ...predicate.BronzeHistoryK8sRoleBinding) *BronzeHistoryK8sRoleBindingDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeHistoryK8sRoleBindingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryK8sRoleBindingDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeHistoryK8sRoleBindingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzehistoryk8srolebinding.Table, sqlgraph.NewFieldSpec(bronzehistoryk8srolebinding.FieldID, field.TypeUint))
+	_spec.Node.Schema = _d.schemaConfig.BronzeHistoryK8sRoleBinding
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeHistoryK8sRoleBindingDeleteOne is the builder for deleting a single BronzeHistoryK8sRoleBinding entity.
+type BronzeHistoryK8sRoleBindingDeleteOne struct {
+	_d *BronzeHistoryK8sRoleBindingDelete
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sRoleBindingDelete builder.
+func (_d *BronzeHistoryK8sRoleBindingDeleteOne) Where(ps ...predicate.BronzeHistoryK8sRoleBinding) *BronzeHistoryK8sRoleBindingDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeHistoryK8sRoleBindingDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzehistoryk8srolebinding.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryK8sRoleBindingDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
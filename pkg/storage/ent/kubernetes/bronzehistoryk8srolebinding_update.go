@@ -0,0 +1,913 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8srolebinding"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryK8sRoleBindingUpdate is the builder for updating BronzeHistoryK8sRoleBinding entities.
+type BronzeHistoryK8sRoleBindingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryK8sRoleBindingMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sRoleBindingUpdate builder.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) Where(ps ...predicate.BronzeHistoryK8sRoleBinding) *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetValidTo(v time.Time) *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) ClearValidTo() *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetCollectedAt(v time.Time) *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetResourceID(v string) *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetNillableResourceID(v *string) *BronzeHistoryK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetClusterName(v string) *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetNillableClusterName(v *string) *BronzeHistoryK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetKind(v string) *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetNillableKind(v *string) *BronzeHistoryK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetNamespace(v string) *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetNillableNamespace(v *string) *BronzeHistoryK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// ClearNamespace clears the value of the "namespace" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) ClearNamespace() *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.ClearNamespace()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetName(v string) *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetNillableName(v *string) *BronzeHistoryK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetUID(v string) *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetNillableUID(v *string) *BronzeHistoryK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) ClearUID() *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetRoleKind sets the "role_kind" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetRoleKind(v string) *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.SetRoleKind(v)
+	return _u
+}
+
+// SetNillableRoleKind sets the "role_kind" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetNillableRoleKind(v *string) *BronzeHistoryK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetRoleKind(*v)
+	}
+	return _u
+}
+
+// SetRoleName sets the "role_name" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetRoleName(v string) *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.SetRoleName(v)
+	return _u
+}
+
+// SetNillableRoleName sets the "role_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetNillableRoleName(v *string) *BronzeHistoryK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetRoleName(*v)
+	}
+	return _u
+}
+
+// SetSubjectsJSON sets the "subjects_json" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetSubjectsJSON(v json.RawMessage) *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.SetSubjectsJSON(v)
+	return _u
+}
+
+// AppendSubjectsJSON appends value to the "subjects_json" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) AppendSubjectsJSON(v json.RawMessage) *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.AppendSubjectsJSON(v)
+	return _u
+}
+
+// ClearSubjectsJSON clears the value of the "subjects_json" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) ClearSubjectsJSON() *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.ClearSubjectsJSON()
+	return _u
+}
+
+// SetRulesJSON sets the "rules_json" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetRulesJSON(v json.RawMessage) *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.SetRulesJSON(v)
+	return _u
+}
+
+// AppendRulesJSON appends value to the "rules_json" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) AppendRulesJSON(v json.RawMessage) *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.AppendRulesJSON(v)
+	return _u
+}
+
+// ClearRulesJSON clears the value of the "rules_json" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) ClearRulesJSON() *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.ClearRulesJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetLabelsJSON(v json.RawMessage) *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) AppendLabelsJSON(v json.RawMessage) *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) ClearLabelsJSON() *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetAPICreatedAt(v time.Time) *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) ClearAPICreatedAt() *BronzeHistoryK8sRoleBindingUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryK8sRoleBindingMutation object of the builder.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) Mutation() *BronzeHistoryK8sRoleBindingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryK8sRoleBindingUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8srolebinding.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sRoleBinding.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8srolebinding.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sRoleBinding.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := bronzehistoryk8srolebinding.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sRoleBinding.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzehistoryk8srolebinding.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sRoleBinding.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RoleKind(); ok {
+		if err := bronzehistoryk8srolebinding.RoleKindValidator(v); err != nil {
+			return &ValidationError{Name: "role_kind", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sRoleBinding.role_kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RoleName(); ok {
+		if err := bronzehistoryk8srolebinding.RoleNameValidator(v); err != nil {
+			return &ValidationError{Name: "role_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sRoleBinding.role_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryK8sRoleBindingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryk8srolebinding.Table, bronzehistoryk8srolebinding.Columns, sqlgraph.NewFieldSpec(bronzehistoryk8srolebinding.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryk8srolebinding.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldNamespace, field.TypeString, value)
+	}
+	if _u.mutation.NamespaceCleared() {
+		_spec.ClearField(bronzehistoryk8srolebinding.FieldNamespace, field.TypeString)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzehistoryk8srolebinding.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RoleKind(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldRoleKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RoleName(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldRoleName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SubjectsJSON(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldSubjectsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSubjectsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8srolebinding.FieldSubjectsJSON, value)
+		})
+	}
+	if _u.mutation.SubjectsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8srolebinding.FieldSubjectsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RulesJSON(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldRulesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRulesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8srolebinding.FieldRulesJSON, value)
+		})
+	}
+	if _u.mutation.RulesJSONCleared() {
+		_spec.ClearField(bronzehistoryk8srolebinding.FieldRulesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8srolebinding.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8srolebinding.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistoryk8srolebinding.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryK8sRoleBinding
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryk8srolebinding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryK8sRoleBindingUpdateOne is the builder for updating a single BronzeHistoryK8sRoleBinding entity.
+type BronzeHistoryK8sRoleBindingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryK8sRoleBindingMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetValidTo(v time.Time) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) ClearValidTo() *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetResourceID(v string) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetClusterName(v string) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetNillableClusterName(v *string) *BronzeHistoryK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetKind(v string) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetNillableKind(v *string) *BronzeHistoryK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetNamespace(v string) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetNillableNamespace(v *string) *BronzeHistoryK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// ClearNamespace clears the value of the "namespace" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) ClearNamespace() *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.ClearNamespace()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetName(v string) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetNillableName(v *string) *BronzeHistoryK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetUID(v string) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetNillableUID(v *string) *BronzeHistoryK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) ClearUID() *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetRoleKind sets the "role_kind" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetRoleKind(v string) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.SetRoleKind(v)
+	return _u
+}
+
+// SetNillableRoleKind sets the "role_kind" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetNillableRoleKind(v *string) *BronzeHistoryK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetRoleKind(*v)
+	}
+	return _u
+}
+
+// SetRoleName sets the "role_name" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetRoleName(v string) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.SetRoleName(v)
+	return _u
+}
+
+// SetNillableRoleName sets the "role_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetNillableRoleName(v *string) *BronzeHistoryK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetRoleName(*v)
+	}
+	return _u
+}
+
+// SetSubjectsJSON sets the "subjects_json" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetSubjectsJSON(v json.RawMessage) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.SetSubjectsJSON(v)
+	return _u
+}
+
+// AppendSubjectsJSON appends value to the "subjects_json" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) AppendSubjectsJSON(v json.RawMessage) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.AppendSubjectsJSON(v)
+	return _u
+}
+
+// ClearSubjectsJSON clears the value of the "subjects_json" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) ClearSubjectsJSON() *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.ClearSubjectsJSON()
+	return _u
+}
+
+// SetRulesJSON sets the "rules_json" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetRulesJSON(v json.RawMessage) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.SetRulesJSON(v)
+	return _u
+}
+
+// AppendRulesJSON appends value to the "rules_json" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) AppendRulesJSON(v json.RawMessage) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.AppendRulesJSON(v)
+	return _u
+}
+
+// ClearRulesJSON clears the value of the "rules_json" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) ClearRulesJSON() *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.ClearRulesJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetLabelsJSON(v json.RawMessage) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) AppendLabelsJSON(v json.RawMessage) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) ClearLabelsJSON() *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetAPICreatedAt(v time.Time) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) ClearAPICreatedAt() *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryK8sRoleBindingMutation object of the builder.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) Mutation() *BronzeHistoryK8sRoleBindingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sRoleBindingUpdate builder.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) Where(ps ...predicate.BronzeHistoryK8sRoleBinding) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) Select(field string, fields ...string) *BronzeHistoryK8sRoleBindingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryK8sRoleBinding entity.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) Save(ctx context.Context) (*BronzeHistoryK8sRoleBinding, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) SaveX(ctx context.Context) *BronzeHistoryK8sRoleBinding {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8srolebinding.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sRoleBinding.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8srolebinding.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sRoleBinding.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := bronzehistoryk8srolebinding.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sRoleBinding.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzehistoryk8srolebinding.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sRoleBinding.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RoleKind(); ok {
+		if err := bronzehistoryk8srolebinding.RoleKindValidator(v); err != nil {
+			return &ValidationError{Name: "role_kind", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sRoleBinding.role_kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RoleName(); ok {
+		if err := bronzehistoryk8srolebinding.RoleNameValidator(v); err != nil {
+			return &ValidationError{Name: "role_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sRoleBinding.role_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryK8sRoleBindingUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryK8sRoleBinding, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryk8srolebinding.Table, bronzehistoryk8srolebinding.Columns, sqlgraph.NewFieldSpec(bronzehistoryk8srolebinding.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`kubernetes: missing "BronzeHistoryK8sRoleBinding.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryk8srolebinding.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryk8srolebinding.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("kubernetes: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryk8srolebinding.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryk8srolebinding.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldNamespace, field.TypeString, value)
+	}
+	if _u.mutation.NamespaceCleared() {
+		_spec.ClearField(bronzehistoryk8srolebinding.FieldNamespace, field.TypeString)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzehistoryk8srolebinding.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RoleKind(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldRoleKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RoleName(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldRoleName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SubjectsJSON(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldSubjectsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSubjectsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8srolebinding.FieldSubjectsJSON, value)
+		})
+	}
+	if _u.mutation.SubjectsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8srolebinding.FieldSubjectsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RulesJSON(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldRulesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRulesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8srolebinding.FieldRulesJSON, value)
+		})
+	}
+	if _u.mutation.RulesJSONCleared() {
+		_spec.ClearField(bronzehistoryk8srolebinding.FieldRulesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8srolebinding.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8srolebinding.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistoryk8srolebinding.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistoryk8srolebinding.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryK8sRoleBinding
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryK8sRoleBinding{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryk8srolebinding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,800 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistoryk8sserviceaccount
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLTE(FieldID, id))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldValidTo, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ClusterName applies equality check predicate on the "cluster_name" field. It's identical to ClusterNameEQ.
+func ClusterName(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldClusterName, v))
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldNamespace, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldName, v))
+}
+
+// UID applies equality check predicate on the "uid" field. It's identical to UIDEQ.
+func UID(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldUID, v))
+}
+
+// AutomountToken applies equality check predicate on the "automount_token" field. It's identical to AutomountTokenEQ.
+func AutomountToken(v bool) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldAutomountToken, v))
+}
+
+// CloudIdentity applies equality check predicate on the "cloud_identity" field. It's identical to CloudIdentityEQ.
+func CloudIdentity(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldCloudIdentity, v))
+}
+
+// APICreatedAt applies equality check predicate on the "api_created_at" field. It's identical to APICreatedAtEQ.
+func APICreatedAt(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNotNull(FieldValidTo))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// ClusterNameEQ applies the EQ predicate on the "cluster_name" field.
+func ClusterNameEQ(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldClusterName, v))
+}
+
+// ClusterNameNEQ applies the NEQ predicate on the "cluster_name" field.
+func ClusterNameNEQ(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNEQ(FieldClusterName, v))
+}
+
+// ClusterNameIn applies the In predicate on the "cluster_name" field.
+func ClusterNameIn(vs ...string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldIn(FieldClusterName, vs...))
+}
+
+// ClusterNameNotIn applies the NotIn predicate on the "cluster_name" field.
+func ClusterNameNotIn(vs ...string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNotIn(FieldClusterName, vs...))
+}
+
+// ClusterNameGT applies the GT predicate on the "cluster_name" field.
+func ClusterNameGT(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGT(FieldClusterName, v))
+}
+
+// ClusterNameGTE applies the GTE predicate on the "cluster_name" field.
+func ClusterNameGTE(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGTE(FieldClusterName, v))
+}
+
+// ClusterNameLT applies the LT predicate on the "cluster_name" field.
+func ClusterNameLT(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLT(FieldClusterName, v))
+}
+
+// ClusterNameLTE applies the LTE predicate on the "cluster_name" field.
+func ClusterNameLTE(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLTE(FieldClusterName, v))
+}
+
+// ClusterNameContains applies the Contains predicate on the "cluster_name" field.
+func ClusterNameContains(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldContains(FieldClusterName, v))
+}
+
+// ClusterNameHasPrefix applies the HasPrefix predicate on the "cluster_name" field.
+func ClusterNameHasPrefix(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldHasPrefix(FieldClusterName, v))
+}
+
+// ClusterNameHasSuffix applies the HasSuffix predicate on the "cluster_name" field.
+func ClusterNameHasSuffix(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldHasSuffix(FieldClusterName, v))
+}
+
+// ClusterNameEqualFold applies the EqualFold predicate on the "cluster_name" field.
+func ClusterNameEqualFold(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEqualFold(FieldClusterName, v))
+}
+
+// ClusterNameContainsFold applies the ContainsFold predicate on the "cluster_name" field.
+func ClusterNameContainsFold(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldContainsFold(FieldClusterName, v))
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldNamespace, v))
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNEQ(FieldNamespace, v))
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldIn(FieldNamespace, vs...))
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNotIn(FieldNamespace, vs...))
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGT(FieldNamespace, v))
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGTE(FieldNamespace, v))
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLT(FieldNamespace, v))
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLTE(FieldNamespace, v))
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldContains(FieldNamespace, v))
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldHasPrefix(FieldNamespace, v))
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldHasSuffix(FieldNamespace, v))
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEqualFold(FieldNamespace, v))
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldContainsFold(FieldNamespace, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldContainsFold(FieldName, v))
+}
+
+// UIDEQ applies the EQ predicate on the "uid" field.
+func UIDEQ(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldUID, v))
+}
+
+// UIDNEQ applies the NEQ predicate on the "uid" field.
+func UIDNEQ(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNEQ(FieldUID, v))
+}
+
+// UIDIn applies the In predicate on the "uid" field.
+func UIDIn(vs ...string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldIn(FieldUID, vs...))
+}
+
+// UIDNotIn applies the NotIn predicate on the "uid" field.
+func UIDNotIn(vs ...string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNotIn(FieldUID, vs...))
+}
+
+// UIDGT applies the GT predicate on the "uid" field.
+func UIDGT(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGT(FieldUID, v))
+}
+
+// UIDGTE applies the GTE predicate on the "uid" field.
+func UIDGTE(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGTE(FieldUID, v))
+}
+
+// UIDLT applies the LT predicate on the "uid" field.
+func UIDLT(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLT(FieldUID, v))
+}
+
+// UIDLTE applies the LTE predicate on the "uid" field.
+func UIDLTE(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLTE(FieldUID, v))
+}
+
+// UIDContains applies the Contains predicate on the "uid" field.
+func UIDContains(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldContains(FieldUID, v))
+}
+
+// UIDHasPrefix applies the HasPrefix predicate on the "uid" field.
+func UIDHasPrefix(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldHasPrefix(FieldUID, v))
+}
+
+// UIDHasSuffix applies the HasSuffix predicate on the "uid" field.
+func UIDHasSuffix(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldHasSuffix(FieldUID, v))
+}
+
+// UIDIsNil applies the IsNil predicate on the "uid" field.
+func UIDIsNil() predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldIsNull(FieldUID))
+}
+
+// UIDNotNil applies the NotNil predicate on the "uid" field.
+func UIDNotNil() predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNotNull(FieldUID))
+}
+
+// UIDEqualFold applies the EqualFold predicate on the "uid" field.
+func UIDEqualFold(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEqualFold(FieldUID, v))
+}
+
+// UIDContainsFold applies the ContainsFold predicate on the "uid" field.
+func UIDContainsFold(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldContainsFold(FieldUID, v))
+}
+
+// AutomountTokenEQ applies the EQ predicate on the "automount_token" field.
+func AutomountTokenEQ(v bool) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldAutomountToken, v))
+}
+
+// AutomountTokenNEQ applies the NEQ predicate on the "automount_token" field.
+func AutomountTokenNEQ(v bool) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNEQ(FieldAutomountToken, v))
+}
+
+// AutomountTokenIsNil applies the IsNil predicate on the "automount_token" field.
+func AutomountTokenIsNil() predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldIsNull(FieldAutomountToken))
+}
+
+// AutomountTokenNotNil applies the NotNil predicate on the "automount_token" field.
+func AutomountTokenNotNil() predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNotNull(FieldAutomountToken))
+}
+
+// CloudIdentityEQ applies the EQ predicate on the "cloud_identity" field.
+func CloudIdentityEQ(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldCloudIdentity, v))
+}
+
+// CloudIdentityNEQ applies the NEQ predicate on the "cloud_identity" field.
+func CloudIdentityNEQ(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNEQ(FieldCloudIdentity, v))
+}
+
+// CloudIdentityIn applies the In predicate on the "cloud_identity" field.
+func CloudIdentityIn(vs ...string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldIn(FieldCloudIdentity, vs...))
+}
+
+// CloudIdentityNotIn applies the NotIn predicate on the "cloud_identity" field.
+func CloudIdentityNotIn(vs ...string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNotIn(FieldCloudIdentity, vs...))
+}
+
+// CloudIdentityGT applies the GT predicate on the "cloud_identity" field.
+func CloudIdentityGT(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGT(FieldCloudIdentity, v))
+}
+
+// CloudIdentityGTE applies the GTE predicate on the "cloud_identity" field.
+func CloudIdentityGTE(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGTE(FieldCloudIdentity, v))
+}
+
+// CloudIdentityLT applies the LT predicate on the "cloud_identity" field.
+func CloudIdentityLT(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLT(FieldCloudIdentity, v))
+}
+
+// CloudIdentityLTE applies the LTE predicate on the "cloud_identity" field.
+func CloudIdentityLTE(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLTE(FieldCloudIdentity, v))
+}
+
+// CloudIdentityContains applies the Contains predicate on the "cloud_identity" field.
+func CloudIdentityContains(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldContains(FieldCloudIdentity, v))
+}
+
+// CloudIdentityHasPrefix applies the HasPrefix predicate on the "cloud_identity" field.
+func CloudIdentityHasPrefix(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldHasPrefix(FieldCloudIdentity, v))
+}
+
+// CloudIdentityHasSuffix applies the HasSuffix predicate on the "cloud_identity" field.
+func CloudIdentityHasSuffix(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldHasSuffix(FieldCloudIdentity, v))
+}
+
+// CloudIdentityIsNil applies the IsNil predicate on the "cloud_identity" field.
+func CloudIdentityIsNil() predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldIsNull(FieldCloudIdentity))
+}
+
+// CloudIdentityNotNil applies the NotNil predicate on the "cloud_identity" field.
+func CloudIdentityNotNil() predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNotNull(FieldCloudIdentity))
+}
+
+// CloudIdentityEqualFold applies the EqualFold predicate on the "cloud_identity" field.
+func CloudIdentityEqualFold(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEqualFold(FieldCloudIdentity, v))
+}
+
+// CloudIdentityContainsFold applies the ContainsFold predicate on the "cloud_identity" field.
+func CloudIdentityContainsFold(v string) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldContainsFold(FieldCloudIdentity, v))
+}
+
+// ImagePullSecretsJSONIsNil applies the IsNil predicate on the "image_pull_secrets_json" field.
+func ImagePullSecretsJSONIsNil() predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldIsNull(FieldImagePullSecretsJSON))
+}
+
+// ImagePullSecretsJSONNotNil applies the NotNil predicate on the "image_pull_secrets_json" field.
+func ImagePullSecretsJSONNotNil() predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNotNull(FieldImagePullSecretsJSON))
+}
+
+// LabelsJSONIsNil applies the IsNil predicate on the "labels_json" field.
+func LabelsJSONIsNil() predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldIsNull(FieldLabelsJSON))
+}
+
+// LabelsJSONNotNil applies the NotNil predicate on the "labels_json" field.
+func LabelsJSONNotNil() predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNotNull(FieldLabelsJSON))
+}
+
+// APICreatedAtEQ applies the EQ predicate on the "api_created_at" field.
+func APICreatedAtEQ(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtNEQ applies the NEQ predicate on the "api_created_at" field.
+func APICreatedAtNEQ(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtIn applies the In predicate on the "api_created_at" field.
+func APICreatedAtIn(vs ...time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtNotIn applies the NotIn predicate on the "api_created_at" field.
+func APICreatedAtNotIn(vs ...time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNotIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtGT applies the GT predicate on the "api_created_at" field.
+func APICreatedAtGT(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtGTE applies the GTE predicate on the "api_created_at" field.
+func APICreatedAtGTE(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldGTE(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLT applies the LT predicate on the "api_created_at" field.
+func APICreatedAtLT(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLTE applies the LTE predicate on the "api_created_at" field.
+func APICreatedAtLTE(v time.Time) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldLTE(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtIsNil applies the IsNil predicate on the "api_created_at" field.
+func APICreatedAtIsNil() predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldIsNull(FieldAPICreatedAt))
+}
+
+// APICreatedAtNotNil applies the NotNil predicate on the "api_created_at" field.
+func APICreatedAtNotNil() predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.FieldNotNull(FieldAPICreatedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeHistoryK8sServiceAccount) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeHistoryK8sServiceAccount) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeHistoryK8sServiceAccount) predicate.BronzeHistoryK8sServiceAccount {
+	return predicate.BronzeHistoryK8sServiceAccount(sql.NotPredicates(p))
+}
@@ -0,0 +1,406 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8sserviceaccount"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryK8sServiceAccountCreate is the builder for creating a BronzeHistoryK8sServiceAccount entity.
+type BronzeHistoryK8sServiceAccountCreate struct {
+	config
+	mutation *BronzeHistoryK8sServiceAccountMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetValidFrom(v time.Time) *BronzeHistoryK8sServiceAccountCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetValidTo(v time.Time) *BronzeHistoryK8sServiceAccountCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sServiceAccountCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetCollectedAt(v time.Time) *BronzeHistoryK8sServiceAccountCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sServiceAccountCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetResourceID(v string) *BronzeHistoryK8sServiceAccountCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetClusterName(v string) *BronzeHistoryK8sServiceAccountCreate {
+	_c.mutation.SetClusterName(v)
+	return _c
+}
+
+// SetNamespace sets the "namespace" field.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetNamespace(v string) *BronzeHistoryK8sServiceAccountCreate {
+	_c.mutation.SetNamespace(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetName(v string) *BronzeHistoryK8sServiceAccountCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetUID sets the "uid" field.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetUID(v string) *BronzeHistoryK8sServiceAccountCreate {
+	_c.mutation.SetUID(v)
+	return _c
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetNillableUID(v *string) *BronzeHistoryK8sServiceAccountCreate {
+	if v != nil {
+		_c.SetUID(*v)
+	}
+	return _c
+}
+
+// SetAutomountToken sets the "automount_token" field.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetAutomountToken(v bool) *BronzeHistoryK8sServiceAccountCreate {
+	_c.mutation.SetAutomountToken(v)
+	return _c
+}
+
+// SetNillableAutomountToken sets the "automount_token" field if the given value is not nil.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetNillableAutomountToken(v *bool) *BronzeHistoryK8sServiceAccountCreate {
+	if v != nil {
+		_c.SetAutomountToken(*v)
+	}
+	return _c
+}
+
+// SetCloudIdentity sets the "cloud_identity" field.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetCloudIdentity(v string) *BronzeHistoryK8sServiceAccountCreate {
+	_c.mutation.SetCloudIdentity(v)
+	return _c
+}
+
+// SetNillableCloudIdentity sets the "cloud_identity" field if the given value is not nil.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetNillableCloudIdentity(v *string) *BronzeHistoryK8sServiceAccountCreate {
+	if v != nil {
+		_c.SetCloudIdentity(*v)
+	}
+	return _c
+}
+
+// SetImagePullSecretsJSON sets the "image_pull_secrets_json" field.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetImagePullSecretsJSON(v json.RawMessage) *BronzeHistoryK8sServiceAccountCreate {
+	_c.mutation.SetImagePullSecretsJSON(v)
+	return _c
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetLabelsJSON(v json.RawMessage) *BronzeHistoryK8sServiceAccountCreate {
+	_c.mutation.SetLabelsJSON(v)
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetAPICreatedAt(v time.Time) *BronzeHistoryK8sServiceAccountCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryK8sServiceAccountCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SetID(v uint) *BronzeHistoryK8sServiceAccountCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryK8sServiceAccountMutation object of the builder.
+func (_c *BronzeHistoryK8sServiceAccountCreate) Mutation() *BronzeHistoryK8sServiceAccountMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryK8sServiceAccount in the database.
+func (_c *BronzeHistoryK8sServiceAccountCreate) Save(ctx context.Context) (*BronzeHistoryK8sServiceAccount, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryK8sServiceAccountCreate) SaveX(ctx context.Context) *BronzeHistoryK8sServiceAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryK8sServiceAccountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryK8sServiceAccountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryK8sServiceAccountCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sServiceAccount.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sServiceAccount.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sServiceAccount.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sServiceAccount.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8sserviceaccount.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sServiceAccount.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClusterName(); !ok {
+		return &ValidationError{Name: "cluster_name", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sServiceAccount.cluster_name"`)}
+	}
+	if v, ok := _c.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8sserviceaccount.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sServiceAccount.cluster_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sServiceAccount.namespace"`)}
+	}
+	if v, ok := _c.mutation.Namespace(); ok {
+		if err := bronzehistoryk8sserviceaccount.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sServiceAccount.namespace": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sServiceAccount.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := bronzehistoryk8sserviceaccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sServiceAccount.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryK8sServiceAccountCreate) sqlSave(ctx context.Context) (*BronzeHistoryK8sServiceAccount, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryK8sServiceAccountCreate) createSpec() (*BronzeHistoryK8sServiceAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryK8sServiceAccount{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryk8sserviceaccount.Table, sqlgraph.NewFieldSpec(bronzehistoryk8sserviceaccount.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryK8sServiceAccount
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldClusterName, field.TypeString, value)
+		_node.ClusterName = value
+	}
+	if value, ok := _c.mutation.Namespace(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.UID(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldUID, field.TypeString, value)
+		_node.UID = value
+	}
+	if value, ok := _c.mutation.AutomountToken(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldAutomountToken, field.TypeBool, value)
+		_node.AutomountToken = &value
+	}
+	if value, ok := _c.mutation.CloudIdentity(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldCloudIdentity, field.TypeString, value)
+		_node.CloudIdentity = value
+	}
+	if value, ok := _c.mutation.ImagePullSecretsJSON(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldImagePullSecretsJSON, field.TypeJSON, value)
+		_node.ImagePullSecretsJSON = value
+	}
+	if value, ok := _c.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldLabelsJSON, field.TypeJSON, value)
+		_node.LabelsJSON = value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryK8sServiceAccountCreateBulk is the builder for creating many BronzeHistoryK8sServiceAccount entities in bulk.
+type BronzeHistoryK8sServiceAccountCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryK8sServiceAccountCreate
+}
+
+// Save creates the BronzeHistoryK8sServiceAccount entities in the database.
+func (_c *BronzeHistoryK8sServiceAccountCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryK8sServiceAccount, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryK8sServiceAccount, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryK8sServiceAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryK8sServiceAccountCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryK8sServiceAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryK8sServiceAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryK8sServiceAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
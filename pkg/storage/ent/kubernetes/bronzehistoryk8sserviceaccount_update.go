@@ -0,0 +1,819 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8sserviceaccount"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryK8sServiceAccountUpdate is the builder for updating BronzeHistoryK8sServiceAccount entities.
+type BronzeHistoryK8sServiceAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryK8sServiceAccountMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sServiceAccountUpdate builder.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) Where(ps ...predicate.BronzeHistoryK8sServiceAccount) *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetValidTo(v time.Time) *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sServiceAccountUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) ClearValidTo() *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetCollectedAt(v time.Time) *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryK8sServiceAccountUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryK8sServiceAccountUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetResourceID(v string) *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetNillableResourceID(v *string) *BronzeHistoryK8sServiceAccountUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetClusterName(v string) *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetNillableClusterName(v *string) *BronzeHistoryK8sServiceAccountUpdate {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetNamespace(v string) *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetNillableNamespace(v *string) *BronzeHistoryK8sServiceAccountUpdate {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetName(v string) *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetNillableName(v *string) *BronzeHistoryK8sServiceAccountUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetUID(v string) *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetNillableUID(v *string) *BronzeHistoryK8sServiceAccountUpdate {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) ClearUID() *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetAutomountToken sets the "automount_token" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetAutomountToken(v bool) *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.SetAutomountToken(v)
+	return _u
+}
+
+// SetNillableAutomountToken sets the "automount_token" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetNillableAutomountToken(v *bool) *BronzeHistoryK8sServiceAccountUpdate {
+	if v != nil {
+		_u.SetAutomountToken(*v)
+	}
+	return _u
+}
+
+// ClearAutomountToken clears the value of the "automount_token" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) ClearAutomountToken() *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.ClearAutomountToken()
+	return _u
+}
+
+// SetCloudIdentity sets the "cloud_identity" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetCloudIdentity(v string) *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.SetCloudIdentity(v)
+	return _u
+}
+
+// SetNillableCloudIdentity sets the "cloud_identity" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetNillableCloudIdentity(v *string) *BronzeHistoryK8sServiceAccountUpdate {
+	if v != nil {
+		_u.SetCloudIdentity(*v)
+	}
+	return _u
+}
+
+// ClearCloudIdentity clears the value of the "cloud_identity" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) ClearCloudIdentity() *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.ClearCloudIdentity()
+	return _u
+}
+
+// SetImagePullSecretsJSON sets the "image_pull_secrets_json" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetImagePullSecretsJSON(v json.RawMessage) *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.SetImagePullSecretsJSON(v)
+	return _u
+}
+
+// AppendImagePullSecretsJSON appends value to the "image_pull_secrets_json" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) AppendImagePullSecretsJSON(v json.RawMessage) *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.AppendImagePullSecretsJSON(v)
+	return _u
+}
+
+// ClearImagePullSecretsJSON clears the value of the "image_pull_secrets_json" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) ClearImagePullSecretsJSON() *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.ClearImagePullSecretsJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetLabelsJSON(v json.RawMessage) *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) AppendLabelsJSON(v json.RawMessage) *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) ClearLabelsJSON() *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetAPICreatedAt(v time.Time) *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryK8sServiceAccountUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) ClearAPICreatedAt() *BronzeHistoryK8sServiceAccountUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryK8sServiceAccountMutation object of the builder.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) Mutation() *BronzeHistoryK8sServiceAccountMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryK8sServiceAccountUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8sserviceaccount.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sServiceAccount.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8sserviceaccount.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sServiceAccount.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Namespace(); ok {
+		if err := bronzehistoryk8sserviceaccount.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sServiceAccount.namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzehistoryk8sserviceaccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sServiceAccount.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryK8sServiceAccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryk8sserviceaccount.Table, bronzehistoryk8sserviceaccount.Columns, sqlgraph.NewFieldSpec(bronzehistoryk8sserviceaccount.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryk8sserviceaccount.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzehistoryk8sserviceaccount.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AutomountToken(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldAutomountToken, field.TypeBool, value)
+	}
+	if _u.mutation.AutomountTokenCleared() {
+		_spec.ClearField(bronzehistoryk8sserviceaccount.FieldAutomountToken, field.TypeBool)
+	}
+	if value, ok := _u.mutation.CloudIdentity(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldCloudIdentity, field.TypeString, value)
+	}
+	if _u.mutation.CloudIdentityCleared() {
+		_spec.ClearField(bronzehistoryk8sserviceaccount.FieldCloudIdentity, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImagePullSecretsJSON(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldImagePullSecretsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImagePullSecretsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8sserviceaccount.FieldImagePullSecretsJSON, value)
+		})
+	}
+	if _u.mutation.ImagePullSecretsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8sserviceaccount.FieldImagePullSecretsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8sserviceaccount.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8sserviceaccount.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistoryk8sserviceaccount.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryK8sServiceAccount
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryk8sserviceaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryK8sServiceAccountUpdateOne is the builder for updating a single BronzeHistoryK8sServiceAccount entity.
+type BronzeHistoryK8sServiceAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryK8sServiceAccountMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetValidTo(v time.Time) *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sServiceAccountUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) ClearValidTo() *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryK8sServiceAccountUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryK8sServiceAccountUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetResourceID(v string) *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryK8sServiceAccountUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetClusterName(v string) *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetNillableClusterName(v *string) *BronzeHistoryK8sServiceAccountUpdateOne {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetNamespace(v string) *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetNillableNamespace(v *string) *BronzeHistoryK8sServiceAccountUpdateOne {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetName(v string) *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetNillableName(v *string) *BronzeHistoryK8sServiceAccountUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetUID(v string) *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetNillableUID(v *string) *BronzeHistoryK8sServiceAccountUpdateOne {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) ClearUID() *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetAutomountToken sets the "automount_token" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetAutomountToken(v bool) *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.SetAutomountToken(v)
+	return _u
+}
+
+// SetNillableAutomountToken sets the "automount_token" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetNillableAutomountToken(v *bool) *BronzeHistoryK8sServiceAccountUpdateOne {
+	if v != nil {
+		_u.SetAutomountToken(*v)
+	}
+	return _u
+}
+
+// ClearAutomountToken clears the value of the "automount_token" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) ClearAutomountToken() *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.ClearAutomountToken()
+	return _u
+}
+
+// SetCloudIdentity sets the "cloud_identity" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetCloudIdentity(v string) *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.SetCloudIdentity(v)
+	return _u
+}
+
+// SetNillableCloudIdentity sets the "cloud_identity" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetNillableCloudIdentity(v *string) *BronzeHistoryK8sServiceAccountUpdateOne {
+	if v != nil {
+		_u.SetCloudIdentity(*v)
+	}
+	return _u
+}
+
+// ClearCloudIdentity clears the value of the "cloud_identity" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) ClearCloudIdentity() *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.ClearCloudIdentity()
+	return _u
+}
+
+// SetImagePullSecretsJSON sets the "image_pull_secrets_json" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetImagePullSecretsJSON(v json.RawMessage) *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.SetImagePullSecretsJSON(v)
+	return _u
+}
+
+// AppendImagePullSecretsJSON appends value to the "image_pull_secrets_json" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) AppendImagePullSecretsJSON(v json.RawMessage) *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.AppendImagePullSecretsJSON(v)
+	return _u
+}
+
+// ClearImagePullSecretsJSON clears the value of the "image_pull_secrets_json" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) ClearImagePullSecretsJSON() *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.ClearImagePullSecretsJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetLabelsJSON(v json.RawMessage) *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) AppendLabelsJSON(v json.RawMessage) *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) ClearLabelsJSON() *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetAPICreatedAt(v time.Time) *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryK8sServiceAccountUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) ClearAPICreatedAt() *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryK8sServiceAccountMutation object of the builder.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) Mutation() *BronzeHistoryK8sServiceAccountMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sServiceAccountUpdate builder.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) Where(ps ...predicate.BronzeHistoryK8sServiceAccount) *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) Select(field string, fields ...string) *BronzeHistoryK8sServiceAccountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryK8sServiceAccount entity.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) Save(ctx context.Context) (*BronzeHistoryK8sServiceAccount, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) SaveX(ctx context.Context) *BronzeHistoryK8sServiceAccount {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8sserviceaccount.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sServiceAccount.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8sserviceaccount.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sServiceAccount.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Namespace(); ok {
+		if err := bronzehistoryk8sserviceaccount.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sServiceAccount.namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzehistoryk8sserviceaccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sServiceAccount.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryK8sServiceAccountUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryK8sServiceAccount, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryk8sserviceaccount.Table, bronzehistoryk8sserviceaccount.Columns, sqlgraph.NewFieldSpec(bronzehistoryk8sserviceaccount.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`kubernetes: missing "BronzeHistoryK8sServiceAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryk8sserviceaccount.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryk8sserviceaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("kubernetes: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryk8sserviceaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryk8sserviceaccount.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzehistoryk8sserviceaccount.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AutomountToken(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldAutomountToken, field.TypeBool, value)
+	}
+	if _u.mutation.AutomountTokenCleared() {
+		_spec.ClearField(bronzehistoryk8sserviceaccount.FieldAutomountToken, field.TypeBool)
+	}
+	if value, ok := _u.mutation.CloudIdentity(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldCloudIdentity, field.TypeString, value)
+	}
+	if _u.mutation.CloudIdentityCleared() {
+		_spec.ClearField(bronzehistoryk8sserviceaccount.FieldCloudIdentity, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImagePullSecretsJSON(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldImagePullSecretsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImagePullSecretsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8sserviceaccount.FieldImagePullSecretsJSON, value)
+		})
+	}
+	if _u.mutation.ImagePullSecretsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8sserviceaccount.FieldImagePullSecretsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8sserviceaccount.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8sserviceaccount.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistoryk8sserviceaccount.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistoryk8sserviceaccount.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryK8sServiceAccount
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryK8sServiceAccount{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryk8sserviceaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,337 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8sworkload"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryK8sWorkload is the model entity for the BronzeHistoryK8sWorkload schema.
+type BronzeHistoryK8sWorkload struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze workload by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// ClusterName holds the value of the "cluster_name" field.
+	ClusterName string `json:"cluster_name,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// Deployment, StatefulSet, DaemonSet, CronJob or Job
+	Kind string `json:"kind,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// Desired replicas; null for DaemonSets, CronJobs and Jobs
+	Replicas *int32 `json:"replicas,omitempty"`
+	// ServiceAccount holds the value of the "service_account" field.
+	ServiceAccount string `json:"service_account,omitempty"`
+	// HostNetwork holds the value of the "host_network" field.
+	HostNetwork bool `json:"host_network,omitempty"`
+	// HostPid holds the value of the "host_pid" field.
+	HostPid bool `json:"host_pid,omitempty"`
+	// HostIpc holds the value of the "host_ipc" field.
+	HostIpc bool `json:"host_ipc,omitempty"`
+	// Container and init container images of the pod template
+	ImagesJSON json.RawMessage `json:"images_json,omitempty"`
+	// Pod template containers: name, image, privileged, run_as_non_root, ...
+	ContainersJSON json.RawMessage `json:"containers_json,omitempty"`
+	// SelectorJSON holds the value of the "selector_json" field.
+	SelectorJSON json.RawMessage `json:"selector_json,omitempty"`
+	// LabelsJSON holds the value of the "labels_json" field.
+	LabelsJSON json.RawMessage `json:"labels_json,omitempty"`
+	// APICreatedAt holds the value of the "api_created_at" field.
+	APICreatedAt *time.Time `json:"api_created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryK8sWorkload) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryk8sworkload.FieldImagesJSON, bronzehistoryk8sworkload.FieldContainersJSON, bronzehistoryk8sworkload.FieldSelectorJSON, bronzehistoryk8sworkload.FieldLabelsJSON:
+			values[i] = new([]byte)
+		case bronzehistoryk8sworkload.FieldHostNetwork, bronzehistoryk8sworkload.FieldHostPid, bronzehistoryk8sworkload.FieldHostIpc:
+			values[i] = new(sql.NullBool)
+		case bronzehistoryk8sworkload.FieldID, bronzehistoryk8sworkload.FieldReplicas:
+			values[i] = new(sql.NullInt64)
+		case bronzehistoryk8sworkload.FieldResourceID, bronzehistoryk8sworkload.FieldClusterName, bronzehistoryk8sworkload.FieldNamespace, bronzehistoryk8sworkload.FieldKind, bronzehistoryk8sworkload.FieldName, bronzehistoryk8sworkload.FieldUID, bronzehistoryk8sworkload.FieldServiceAccount:
+			values[i] = new(sql.NullString)
+		case bronzehistoryk8sworkload.FieldValidFrom, bronzehistoryk8sworkload.FieldValidTo, bronzehistoryk8sworkload.FieldCollectedAt, bronzehistoryk8sworkload.FieldFirstCollectedAt, bronzehistoryk8sworkload.FieldAPICreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryK8sWorkload fields.
+func (_m *BronzeHistoryK8sWorkload) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistoryk8sworkload.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistoryk8sworkload.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistoryk8sworkload.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistoryk8sworkload.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistoryk8sworkload.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistoryk8sworkload.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistoryk8sworkload.FieldClusterName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_name", values[i])
+			} else if value.Valid {
+				_m.ClusterName = value.String
+			}
+		case bronzehistoryk8sworkload.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				_m.Namespace = value.String
+			}
+		case bronzehistoryk8sworkload.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case bronzehistoryk8sworkload.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzehistoryk8sworkload.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				_m.UID = value.String
+			}
+		case bronzehistoryk8sworkload.FieldReplicas:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field replicas", values[i])
+			} else if value.Valid {
+				_m.Replicas = new(int32)
+				*_m.Replicas = int32(value.Int64)
+			}
+		case bronzehistoryk8sworkload.FieldServiceAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field service_account", values[i])
+			} else if value.Valid {
+				_m.ServiceAccount = value.String
+			}
+		case bronzehistoryk8sworkload.FieldHostNetwork:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field host_network", values[i])
+			} else if value.Valid {
+				_m.HostNetwork = value.Bool
+			}
+		case bronzehistoryk8sworkload.FieldHostPid:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field host_pid", values[i])
+			} else if value.Valid {
+				_m.HostPid = value.Bool
+			}
+		case bronzehistoryk8sworkload.FieldHostIpc:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field host_ipc", values[i])
+			} else if value.Valid {
+				_m.HostIpc = value.Bool
+			}
+		case bronzehistoryk8sworkload.FieldImagesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field images_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ImagesJSON); err != nil {
+					return fmt.Errorf("unmarshal field images_json: %w", err)
+				}
+			}
+		case bronzehistoryk8sworkload.FieldContainersJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field containers_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ContainersJSON); err != nil {
+					return fmt.Errorf("unmarshal field containers_json: %w", err)
+				}
+			}
+		case bronzehistoryk8sworkload.FieldSelectorJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field selector_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SelectorJSON); err != nil {
+					return fmt.Errorf("unmarshal field selector_json: %w", err)
+				}
+			}
+		case bronzehistoryk8sworkload.FieldLabelsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LabelsJSON); err != nil {
+					return fmt.Errorf("unmarshal field labels_json: %w", err)
+				}
+			}
+		case bronzehistoryk8sworkload.FieldAPICreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_created_at", values[i])
+			} else if value.Valid {
+				_m.APICreatedAt = new(time.Time)
+				*_m.APICreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryK8sWorkload.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryK8sWorkload) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryK8sWorkload.
+// Note that you need to call BronzeHistoryK8sWorkload.Unwrap() before calling this method if this BronzeHistoryK8sWorkload
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryK8sWorkload) Update() *BronzeHistoryK8sWorkloadUpdateOne {
+	return NewBronzeHistoryK8sWorkloadClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryK8sWorkload entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryK8sWorkload) Unwrap() *BronzeHistoryK8sWorkload {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("kubernetes: BronzeHistoryK8sWorkload is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryK8sWorkload) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryK8sWorkload(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("cluster_name=")
+	builder.WriteString(_m.ClusterName)
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(_m.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("uid=")
+	builder.WriteString(_m.UID)
+	builder.WriteString(", ")
+	if v := _m.Replicas; v != nil {
+		builder.WriteString("replicas=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("service_account=")
+	builder.WriteString(_m.ServiceAccount)
+	builder.WriteString(", ")
+	builder.WriteString("host_network=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HostNetwork))
+	builder.WriteString(", ")
+	builder.WriteString("host_pid=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HostPid))
+	builder.WriteString(", ")
+	builder.WriteString("host_ipc=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HostIpc))
+	builder.WriteString(", ")
+	builder.WriteString("images_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ImagesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("containers_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ContainersJSON))
+	builder.WriteString(", ")
+	builder.WriteString("selector_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SelectorJSON))
+	builder.WriteString(", ")
+	builder.WriteString("labels_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LabelsJSON))
+	builder.WriteString(", ")
+	if v := _m.APICreatedAt; v != nil {
+		builder.WriteString("api_created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryK8sWorkloads is a parsable slice of BronzeHistoryK8sWorkload.
+type BronzeHistoryK8sWorkloads []*BronzeHistoryK8sWorkload
@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8sworkload"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryK8sWorkloadCreate is the builder for creating a BronzeHistoryK8sWorkload entity.
+type BronzeHistoryK8sWorkloadCreate struct {
+	config
+	mutation *BronzeHistoryK8sWorkloadMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetValidFrom(v time.Time) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetValidTo(v time.Time) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sWorkloadCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetCollectedAt(v time.Time) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetResourceID(v string) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetClusterName(v string) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetClusterName(v)
+	return _c
+}
+
+// SetNamespace sets the "namespace" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetNamespace(v string) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetNamespace(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetKind(v string) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetName(v string) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetUID sets the "uid" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetUID(v string) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetUID(v)
+	return _c
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetNillableUID(v *string) *BronzeHistoryK8sWorkloadCreate {
+	if v != nil {
+		_c.SetUID(*v)
+	}
+	return _c
+}
+
+// SetReplicas sets the "replicas" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetReplicas(v int32) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetReplicas(v)
+	return _c
+}
+
+// SetNillableReplicas sets the "replicas" field if the given value is not nil.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetNillableReplicas(v *int32) *BronzeHistoryK8sWorkloadCreate {
+	if v != nil {
+		_c.SetReplicas(*v)
+	}
+	return _c
+}
+
+// SetServiceAccount sets the "service_account" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetServiceAccount(v string) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetServiceAccount(v)
+	return _c
+}
+
+// SetNillableServiceAccount sets the "service_account" field if the given value is not nil.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetNillableServiceAccount(v *string) *BronzeHistoryK8sWorkloadCreate {
+	if v != nil {
+		_c.SetServiceAccount(*v)
+	}
+	return _c
+}
+
+// SetHostNetwork sets the "host_network" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetHostNetwork(v bool) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetHostNetwork(v)
+	return _c
+}
+
+// SetNillableHostNetwork sets the "host_network" field if the given value is not nil.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetNillableHostNetwork(v *bool) *BronzeHistoryK8sWorkloadCreate {
+	if v != nil {
+		_c.SetHostNetwork(*v)
+	}
+	return _c
+}
+
+// SetHostPid sets the "host_pid" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetHostPid(v bool) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetHostPid(v)
+	return _c
+}
+
+// SetNillableHostPid sets the "host_pid" field if the given value is not nil.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetNillableHostPid(v *bool) *BronzeHistoryK8sWorkloadCreate {
+	if v != nil {
+		_c.SetHostPid(*v)
+	}
+	return _c
+}
+
+// SetHostIpc sets the "host_ipc" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetHostIpc(v bool) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetHostIpc(v)
+	return _c
+}
+
+// SetNillableHostIpc sets the "host_ipc" field if the given value is not nil.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetNillableHostIpc(v *bool) *BronzeHistoryK8sWorkloadCreate {
+	if v != nil {
+		_c.SetHostIpc(*v)
+	}
+	return _c
+}
+
+// SetImagesJSON sets the "images_json" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetImagesJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetImagesJSON(v)
+	return _c
+}
+
+// SetContainersJSON sets the "containers_json" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetContainersJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetContainersJSON(v)
+	return _c
+}
+
+// SetSelectorJSON sets the "selector_json" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetSelectorJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetSelectorJSON(v)
+	return _c
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetLabelsJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetLabelsJSON(v)
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetAPICreatedAt(v time.Time) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryK8sWorkloadCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryK8sWorkloadCreate) SetID(v uint) *BronzeHistoryK8sWorkloadCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryK8sWorkloadMutation object of the builder.
+func (_c *BronzeHistoryK8sWorkloadCreate) Mutation() *BronzeHistoryK8sWorkloadMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryK8sWorkload in the database.
+func (_c *BronzeHistoryK8sWorkloadCreate) Save(ctx context.Context) (*BronzeHistoryK8sWorkload, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryK8sWorkloadCreate) SaveX(ctx context.Context) *BronzeHistoryK8sWorkload {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryK8sWorkloadCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryK8sWorkloadCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeHistoryK8sWorkloadCreate) defaults() {
+	if _, ok := _c.mutation.HostNetwork(); !ok {
+		v := bronzehistoryk8sworkload.DefaultHostNetwork
+		_c.mutation.SetHostNetwork(v)
+	}
+	if _, ok := _c.mutation.HostPid(); !ok {
+		v := bronzehistoryk8sworkload.DefaultHostPid
+		_c.mutation.SetHostPid(v)
+	}
+	if _, ok := _c.mutation.HostIpc(); !ok {
+		v := bronzehistoryk8sworkload.DefaultHostIpc
+		_c.mutation.SetHostIpc(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryK8sWorkloadCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sWorkload.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sWorkload.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sWorkload.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sWorkload.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8sworkload.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sWorkload.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClusterName(); !ok {
+		return &ValidationError{Name: "cluster_name", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sWorkload.cluster_name"`)}
+	}
+	if v, ok := _c.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8sworkload.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sWorkload.cluster_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sWorkload.namespace"`)}
+	}
+	if v, ok := _c.mutation.Namespace(); ok {
+		if err := bronzehistoryk8sworkload.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sWorkload.namespace": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sWorkload.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := bronzehistoryk8sworkload.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sWorkload.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sWorkload.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := bronzehistoryk8sworkload.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sWorkload.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HostNetwork(); !ok {
+		return &ValidationError{Name: "host_network", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sWorkload.host_network"`)}
+	}
+	if _, ok := _c.mutation.HostPid(); !ok {
+		return &ValidationError{Name: "host_pid", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sWorkload.host_pid"`)}
+	}
+	if _, ok := _c.mutation.HostIpc(); !ok {
+		return &ValidationError{Name: "host_ipc", err: errors.New(`kubernetes: missing required field "BronzeHistoryK8sWorkload.host_ipc"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryK8sWorkloadCreate) sqlSave(ctx context.Context) (*BronzeHistoryK8sWorkload, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryK8sWorkloadCreate) createSpec() (*BronzeHistoryK8sWorkload, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryK8sWorkload{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistoryk8sworkload.Table, sqlgraph.NewFieldSpec(bronzehistoryk8sworkload.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryK8sWorkload
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldClusterName, field.TypeString, value)
+		_node.ClusterName = value
+	}
+	if value, ok := _c.mutation.Namespace(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.UID(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldUID, field.TypeString, value)
+		_node.UID = value
+	}
+	if value, ok := _c.mutation.Replicas(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldReplicas, field.TypeInt32, value)
+		_node.Replicas = &value
+	}
+	if value, ok := _c.mutation.ServiceAccount(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldServiceAccount, field.TypeString, value)
+		_node.ServiceAccount = value
+	}
+	if value, ok := _c.mutation.HostNetwork(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldHostNetwork, field.TypeBool, value)
+		_node.HostNetwork = value
+	}
+	if value, ok := _c.mutation.HostPid(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldHostPid, field.TypeBool, value)
+		_node.HostPid = value
+	}
+	if value, ok := _c.mutation.HostIpc(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldHostIpc, field.TypeBool, value)
+		_node.HostIpc = value
+	}
+	if value, ok := _c.mutation.ImagesJSON(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldImagesJSON, field.TypeJSON, value)
+		_node.ImagesJSON = value
+	}
+	if value, ok := _c.mutation.ContainersJSON(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldContainersJSON, field.TypeJSON, value)
+		_node.ContainersJSON = value
+	}
+	if value, ok := _c.mutation.SelectorJSON(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldSelectorJSON, field.TypeJSON, value)
+		_node.SelectorJSON = value
+	}
+	if value, ok := _c.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldLabelsJSON, field.TypeJSON, value)
+		_node.LabelsJSON = value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryK8sWorkloadCreateBulk is the builder for creating many BronzeHistoryK8sWorkload entities in bulk.
+type BronzeHistoryK8sWorkloadCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryK8sWorkloadCreate
+}
+
+// Save creates the BronzeHistoryK8sWorkload entities in the database.
+func (_c *BronzeHistoryK8sWorkloadCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryK8sWorkload, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryK8sWorkload, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryK8sWorkloadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryK8sWorkloadCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryK8sWorkload {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryK8sWorkloadCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryK8sWorkloadCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1101 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8sworkload"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryK8sWorkloadUpdate is the builder for updating BronzeHistoryK8sWorkload entities.
+type BronzeHistoryK8sWorkloadUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryK8sWorkloadMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sWorkloadUpdate builder.
+func (_u *BronzeHistoryK8sWorkloadUpdate) Where(ps ...predicate.BronzeHistoryK8sWorkload) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetValidTo(v time.Time) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) ClearValidTo() *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetCollectedAt(v time.Time) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetResourceID(v string) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetNillableResourceID(v *string) *BronzeHistoryK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetClusterName(v string) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetNillableClusterName(v *string) *BronzeHistoryK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetNamespace(v string) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetNillableNamespace(v *string) *BronzeHistoryK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetKind(v string) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetNillableKind(v *string) *BronzeHistoryK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetName(v string) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetNillableName(v *string) *BronzeHistoryK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetUID(v string) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetNillableUID(v *string) *BronzeHistoryK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) ClearUID() *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetReplicas sets the "replicas" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetReplicas(v int32) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.ResetReplicas()
+	_u.mutation.SetReplicas(v)
+	return _u
+}
+
+// SetNillableReplicas sets the "replicas" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetNillableReplicas(v *int32) *BronzeHistoryK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetReplicas(*v)
+	}
+	return _u
+}
+
+// AddReplicas adds value to the "replicas" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) AddReplicas(v int32) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.AddReplicas(v)
+	return _u
+}
+
+// ClearReplicas clears the value of the "replicas" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) ClearReplicas() *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.ClearReplicas()
+	return _u
+}
+
+// SetServiceAccount sets the "service_account" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetServiceAccount(v string) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.SetServiceAccount(v)
+	return _u
+}
+
+// SetNillableServiceAccount sets the "service_account" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetNillableServiceAccount(v *string) *BronzeHistoryK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetServiceAccount(*v)
+	}
+	return _u
+}
+
+// ClearServiceAccount clears the value of the "service_account" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) ClearServiceAccount() *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.ClearServiceAccount()
+	return _u
+}
+
+// SetHostNetwork sets the "host_network" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetHostNetwork(v bool) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.SetHostNetwork(v)
+	return _u
+}
+
+// SetNillableHostNetwork sets the "host_network" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetNillableHostNetwork(v *bool) *BronzeHistoryK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetHostNetwork(*v)
+	}
+	return _u
+}
+
+// SetHostPid sets the "host_pid" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetHostPid(v bool) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.SetHostPid(v)
+	return _u
+}
+
+// SetNillableHostPid sets the "host_pid" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetNillableHostPid(v *bool) *BronzeHistoryK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetHostPid(*v)
+	}
+	return _u
+}
+
+// SetHostIpc sets the "host_ipc" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetHostIpc(v bool) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.SetHostIpc(v)
+	return _u
+}
+
+// SetNillableHostIpc sets the "host_ipc" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetNillableHostIpc(v *bool) *BronzeHistoryK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetHostIpc(*v)
+	}
+	return _u
+}
+
+// SetImagesJSON sets the "images_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetImagesJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.SetImagesJSON(v)
+	return _u
+}
+
+// AppendImagesJSON appends value to the "images_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) AppendImagesJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.AppendImagesJSON(v)
+	return _u
+}
+
+// ClearImagesJSON clears the value of the "images_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) ClearImagesJSON() *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.ClearImagesJSON()
+	return _u
+}
+
+// SetContainersJSON sets the "containers_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetContainersJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.SetContainersJSON(v)
+	return _u
+}
+
+// AppendContainersJSON appends value to the "containers_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) AppendContainersJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.AppendContainersJSON(v)
+	return _u
+}
+
+// ClearContainersJSON clears the value of the "containers_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) ClearContainersJSON() *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.ClearContainersJSON()
+	return _u
+}
+
+// SetSelectorJSON sets the "selector_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetSelectorJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.SetSelectorJSON(v)
+	return _u
+}
+
+// AppendSelectorJSON appends value to the "selector_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) AppendSelectorJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.AppendSelectorJSON(v)
+	return _u
+}
+
+// ClearSelectorJSON clears the value of the "selector_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) ClearSelectorJSON() *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.ClearSelectorJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetLabelsJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) AppendLabelsJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) ClearLabelsJSON() *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetAPICreatedAt(v time.Time) *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryK8sWorkloadUpdate) ClearAPICreatedAt() *BronzeHistoryK8sWorkloadUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryK8sWorkloadMutation object of the builder.
+func (_u *BronzeHistoryK8sWorkloadUpdate) Mutation() *BronzeHistoryK8sWorkloadMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryK8sWorkloadUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryK8sWorkloadUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryK8sWorkloadUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryK8sWorkloadUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryK8sWorkloadUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8sworkload.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sWorkload.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8sworkload.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sWorkload.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Namespace(); ok {
+		if err := bronzehistoryk8sworkload.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sWorkload.namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := bronzehistoryk8sworkload.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sWorkload.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzehistoryk8sworkload.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sWorkload.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryK8sWorkloadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryk8sworkload.Table, bronzehistoryk8sworkload.Columns, sqlgraph.NewFieldSpec(bronzehistoryk8sworkload.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryk8sworkload.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzehistoryk8sworkload.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Replicas(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldReplicas, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedReplicas(); ok {
+		_spec.AddField(bronzehistoryk8sworkload.FieldReplicas, field.TypeInt32, value)
+	}
+	if _u.mutation.ReplicasCleared() {
+		_spec.ClearField(bronzehistoryk8sworkload.FieldReplicas, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.ServiceAccount(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldServiceAccount, field.TypeString, value)
+	}
+	if _u.mutation.ServiceAccountCleared() {
+		_spec.ClearField(bronzehistoryk8sworkload.FieldServiceAccount, field.TypeString)
+	}
+	if value, ok := _u.mutation.HostNetwork(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldHostNetwork, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HostPid(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldHostPid, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HostIpc(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldHostIpc, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ImagesJSON(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldImagesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImagesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8sworkload.FieldImagesJSON, value)
+		})
+	}
+	if _u.mutation.ImagesJSONCleared() {
+		_spec.ClearField(bronzehistoryk8sworkload.FieldImagesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ContainersJSON(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldContainersJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedContainersJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8sworkload.FieldContainersJSON, value)
+		})
+	}
+	if _u.mutation.ContainersJSONCleared() {
+		_spec.ClearField(bronzehistoryk8sworkload.FieldContainersJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SelectorJSON(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldSelectorJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSelectorJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8sworkload.FieldSelectorJSON, value)
+		})
+	}
+	if _u.mutation.SelectorJSONCleared() {
+		_spec.ClearField(bronzehistoryk8sworkload.FieldSelectorJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8sworkload.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8sworkload.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistoryk8sworkload.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryK8sWorkload
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryk8sworkload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryK8sWorkloadUpdateOne is the builder for updating a single BronzeHistoryK8sWorkload entity.
+type BronzeHistoryK8sWorkloadUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryK8sWorkloadMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetValidTo(v time.Time) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) ClearValidTo() *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetResourceID(v string) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetClusterName(v string) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetNillableClusterName(v *string) *BronzeHistoryK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetNamespace(v string) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetNillableNamespace(v *string) *BronzeHistoryK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetKind(v string) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetNillableKind(v *string) *BronzeHistoryK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetName(v string) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetNillableName(v *string) *BronzeHistoryK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetUID(v string) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetNillableUID(v *string) *BronzeHistoryK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) ClearUID() *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetReplicas sets the "replicas" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetReplicas(v int32) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.ResetReplicas()
+	_u.mutation.SetReplicas(v)
+	return _u
+}
+
+// SetNillableReplicas sets the "replicas" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetNillableReplicas(v *int32) *BronzeHistoryK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetReplicas(*v)
+	}
+	return _u
+}
+
+// AddReplicas adds value to the "replicas" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) AddReplicas(v int32) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.AddReplicas(v)
+	return _u
+}
+
+// ClearReplicas clears the value of the "replicas" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) ClearReplicas() *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.ClearReplicas()
+	return _u
+}
+
+// SetServiceAccount sets the "service_account" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetServiceAccount(v string) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.SetServiceAccount(v)
+	return _u
+}
+
+// SetNillableServiceAccount sets the "service_account" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetNillableServiceAccount(v *string) *BronzeHistoryK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetServiceAccount(*v)
+	}
+	return _u
+}
+
+// ClearServiceAccount clears the value of the "service_account" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) ClearServiceAccount() *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.ClearServiceAccount()
+	return _u
+}
+
+// SetHostNetwork sets the "host_network" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetHostNetwork(v bool) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.SetHostNetwork(v)
+	return _u
+}
+
+// SetNillableHostNetwork sets the "host_network" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetNillableHostNetwork(v *bool) *BronzeHistoryK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetHostNetwork(*v)
+	}
+	return _u
+}
+
+// SetHostPid sets the "host_pid" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetHostPid(v bool) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.SetHostPid(v)
+	return _u
+}
+
+// SetNillableHostPid sets the "host_pid" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetNillableHostPid(v *bool) *BronzeHistoryK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetHostPid(*v)
+	}
+	return _u
+}
+
+// SetHostIpc sets the "host_ipc" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetHostIpc(v bool) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.SetHostIpc(v)
+	return _u
+}
+
+// SetNillableHostIpc sets the "host_ipc" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetNillableHostIpc(v *bool) *BronzeHistoryK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetHostIpc(*v)
+	}
+	return _u
+}
+
+// SetImagesJSON sets the "images_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetImagesJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.SetImagesJSON(v)
+	return _u
+}
+
+// AppendImagesJSON appends value to the "images_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) AppendImagesJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.AppendImagesJSON(v)
+	return _u
+}
+
+// ClearImagesJSON clears the value of the "images_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) ClearImagesJSON() *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.ClearImagesJSON()
+	return _u
+}
+
+// SetContainersJSON sets the "containers_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetContainersJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.SetContainersJSON(v)
+	return _u
+}
+
+// AppendContainersJSON appends value to the "containers_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) AppendContainersJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.AppendContainersJSON(v)
+	return _u
+}
+
+// ClearContainersJSON clears the value of the "containers_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) ClearContainersJSON() *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.ClearContainersJSON()
+	return _u
+}
+
+// SetSelectorJSON sets the "selector_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetSelectorJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.SetSelectorJSON(v)
+	return _u
+}
+
+// AppendSelectorJSON appends value to the "selector_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) AppendSelectorJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.AppendSelectorJSON(v)
+	return _u
+}
+
+// ClearSelectorJSON clears the value of the "selector_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) ClearSelectorJSON() *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.ClearSelectorJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetLabelsJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) AppendLabelsJSON(v json.RawMessage) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) ClearLabelsJSON() *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetAPICreatedAt(v time.Time) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) ClearAPICreatedAt() *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryK8sWorkloadMutation object of the builder.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) Mutation() *BronzeHistoryK8sWorkloadMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sWorkloadUpdate builder.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) Where(ps ...predicate.BronzeHistoryK8sWorkload) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) Select(field string, fields ...string) *BronzeHistoryK8sWorkloadUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryK8sWorkload entity.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) Save(ctx context.Context) (*BronzeHistoryK8sWorkload, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) SaveX(ctx context.Context) *BronzeHistoryK8sWorkload {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistoryk8sworkload.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sWorkload.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzehistoryk8sworkload.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sWorkload.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Namespace(); ok {
+		if err := bronzehistoryk8sworkload.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sWorkload.namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := bronzehistoryk8sworkload.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sWorkload.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzehistoryk8sworkload.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeHistoryK8sWorkload.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryK8sWorkloadUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryK8sWorkload, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistoryk8sworkload.Table, bronzehistoryk8sworkload.Columns, sqlgraph.NewFieldSpec(bronzehistoryk8sworkload.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`kubernetes: missing "BronzeHistoryK8sWorkload.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistoryk8sworkload.FieldID)
+		for _, f := range fields {
+			if !bronzehistoryk8sworkload.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("kubernetes: invalid field %q for query", f)}
+			}
+			if f != bronzehistoryk8sworkload.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistoryk8sworkload.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzehistoryk8sworkload.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Replicas(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldReplicas, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedReplicas(); ok {
+		_spec.AddField(bronzehistoryk8sworkload.FieldReplicas, field.TypeInt32, value)
+	}
+	if _u.mutation.ReplicasCleared() {
+		_spec.ClearField(bronzehistoryk8sworkload.FieldReplicas, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.ServiceAccount(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldServiceAccount, field.TypeString, value)
+	}
+	if _u.mutation.ServiceAccountCleared() {
+		_spec.ClearField(bronzehistoryk8sworkload.FieldServiceAccount, field.TypeString)
+	}
+	if value, ok := _u.mutation.HostNetwork(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldHostNetwork, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HostPid(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldHostPid, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HostIpc(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldHostIpc, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ImagesJSON(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldImagesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImagesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8sworkload.FieldImagesJSON, value)
+		})
+	}
+	if _u.mutation.ImagesJSONCleared() {
+		_spec.ClearField(bronzehistoryk8sworkload.FieldImagesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ContainersJSON(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldContainersJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedContainersJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8sworkload.FieldContainersJSON, value)
+		})
+	}
+	if _u.mutation.ContainersJSONCleared() {
+		_spec.ClearField(bronzehistoryk8sworkload.FieldContainersJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SelectorJSON(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldSelectorJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSelectorJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8sworkload.FieldSelectorJSON, value)
+		})
+	}
+	if _u.mutation.SelectorJSONCleared() {
+		_spec.ClearField(bronzehistoryk8sworkload.FieldSelectorJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistoryk8sworkload.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzehistoryk8sworkload.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistoryk8sworkload.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistoryk8sworkload.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryK8sWorkload
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryK8sWorkload{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistoryk8sworkload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8simage"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeK8sImage is the model entity for the BronzeK8sImage schema.
+type BronzeK8sImage struct {
+	config `json:"-"`
+	// ID of the ent.
+	// {cluster_name}/{registry}/{repository}@{digest}, or :{tag} when the digest is unknown
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// ClusterName holds the value of the "cluster_name" field.
+	ClusterName string `json:"cluster_name,omitempty"`
+	// e.g. docker.io, gcr.io, registry.digitalocean.com
+	Registry string `json:"registry,omitempty"`
+	// e.g. library/nginx
+	Repository string `json:"repository,omitempty"`
+	// sha256:... from the container status image ID
+	Digest string `json:"digest,omitempty"`
+	// Tags the image is referenced by
+	TagsJSON json.RawMessage `json:"tags_json,omitempty"`
+	// NamespacesJSON holds the value of the "namespaces_json" field.
+	NamespacesJSON json.RawMessage `json:"namespaces_json,omitempty"`
+	// Workloads running the image: {namespace}/{kind}/{name}
+	WorkloadsJSON json.RawMessage `json:"workloads_json,omitempty"`
+	selectValues  sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeK8sImage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzek8simage.FieldTagsJSON, bronzek8simage.FieldNamespacesJSON, bronzek8simage.FieldWorkloadsJSON:
+			values[i] = new([]byte)
+		case bronzek8simage.FieldID, bronzek8simage.FieldClusterName, bronzek8simage.FieldRegistry, bronzek8simage.FieldRepository, bronzek8simage.FieldDigest:
+			values[i] = new(sql.NullString)
+		case bronzek8simage.FieldCollectedAt, bronzek8simage.FieldFirstCollectedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeK8sImage fields.
+func (_m *BronzeK8sImage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzek8simage.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzek8simage.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzek8simage.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzek8simage.FieldClusterName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_name", values[i])
+			} else if value.Valid {
+				_m.ClusterName = value.String
+			}
+		case bronzek8simage.FieldRegistry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field registry", values[i])
+			} else if value.Valid {
+				_m.Registry = value.String
+			}
+		case bronzek8simage.FieldRepository:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field repository", values[i])
+			} else if value.Valid {
+				_m.Repository = value.String
+			}
+		case bronzek8simage.FieldDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field digest", values[i])
+			} else if value.Valid {
+				_m.Digest = value.String
+			}
+		case bronzek8simage.FieldTagsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TagsJSON); err != nil {
+					return fmt.Errorf("unmarshal field tags_json: %w", err)
+				}
+			}
+		case bronzek8simage.FieldNamespacesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field namespaces_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.NamespacesJSON); err != nil {
+					return fmt.Errorf("unmarshal field namespaces_json: %w", err)
+				}
+			}
+		case bronzek8simage.FieldWorkloadsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field workloads_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.WorkloadsJSON); err != nil {
+					return fmt.Errorf("unmarshal field workloads_json: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeK8sImage.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeK8sImage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeK8sImage.
+// Note that you need to call BronzeK8sImage.Unwrap() before calling this method if this BronzeK8sImage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeK8sImage) Update() *BronzeK8sImageUpdateOne {
+	return NewBronzeK8sImageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeK8sImage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeK8sImage) Unwrap() *BronzeK8sImage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("kubernetes: BronzeK8sImage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeK8sImage) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeK8sImage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("cluster_name=")
+	builder.WriteString(_m.ClusterName)
+	builder.WriteString(", ")
+	builder.WriteString("registry=")
+	builder.WriteString(_m.Registry)
+	builder.WriteString(", ")
+	builder.WriteString("repository=")
+	builder.WriteString(_m.Repository)
+	builder.WriteString(", ")
+	builder.WriteString("digest=")
+	builder.WriteString(_m.Digest)
+	builder.WriteString(", ")
+	builder.WriteString("tags_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("namespaces_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NamespacesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("workloads_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkloadsJSON))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeK8sImages is a parsable slice of BronzeK8sImage.
+type BronzeK8sImages []*BronzeK8sImage
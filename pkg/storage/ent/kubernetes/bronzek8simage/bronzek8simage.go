@@ -0,0 +1,105 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzek8simage
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzek8simage type in the database.
+	Label = "bronze_k8s_image"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldClusterName holds the string denoting the cluster_name field in the database.
+	FieldClusterName = "cluster_name"
+	// FieldRegistry holds the string denoting the registry field in the database.
+	FieldRegistry = "registry"
+	// FieldRepository holds the string denoting the repository field in the database.
+	FieldRepository = "repository"
+	// FieldDigest holds the string denoting the digest field in the database.
+	FieldDigest = "digest"
+	// FieldTagsJSON holds the string denoting the tags_json field in the database.
+	FieldTagsJSON = "tags_json"
+	// FieldNamespacesJSON holds the string denoting the namespaces_json field in the database.
+	FieldNamespacesJSON = "namespaces_json"
+	// FieldWorkloadsJSON holds the string denoting the workloads_json field in the database.
+	FieldWorkloadsJSON = "workloads_json"
+	// Table holds the table name of the bronzek8simage in the database.
+	Table = "k8s_images"
+)
+
+// Columns holds all SQL columns for bronzek8simage fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldClusterName,
+	FieldRegistry,
+	FieldRepository,
+	FieldDigest,
+	FieldTagsJSON,
+	FieldNamespacesJSON,
+	FieldWorkloadsJSON,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	ClusterNameValidator func(string) error
+	// RegistryValidator is a validator for the "registry" field. It is called by the builders before save.
+	RegistryValidator func(string) error
+	// RepositoryValidator is a validator for the "repository" field. It is called by the builders before save.
+	RepositoryValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BronzeK8sImage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByClusterName orders the results by the cluster_name field.
+func ByClusterName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClusterName, opts...).ToFunc()
+}
+
+// ByRegistry orders the results by the registry field.
+func ByRegistry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegistry, opts...).ToFunc()
+}
+
+// ByRepository orders the results by the repository field.
+func ByRepository(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRepository, opts...).ToFunc()
+}
+
+// ByDigest orders the results by the digest field.
+func ByDigest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDigest, opts...).ToFunc()
+}
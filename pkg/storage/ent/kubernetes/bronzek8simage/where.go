@@ -0,0 +1,490 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzek8simage
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ClusterName applies equality check predicate on the "cluster_name" field. It's identical to ClusterNameEQ.
+func ClusterName(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldEQ(FieldClusterName, v))
+}
+
+// Registry applies equality check predicate on the "registry" field. It's identical to RegistryEQ.
+func Registry(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldEQ(FieldRegistry, v))
+}
+
+// Repository applies equality check predicate on the "repository" field. It's identical to RepositoryEQ.
+func Repository(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldEQ(FieldRepository, v))
+}
+
+// Digest applies equality check predicate on the "digest" field. It's identical to DigestEQ.
+func Digest(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldEQ(FieldDigest, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ClusterNameEQ applies the EQ predicate on the "cluster_name" field.
+func ClusterNameEQ(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldEQ(FieldClusterName, v))
+}
+
+// ClusterNameNEQ applies the NEQ predicate on the "cluster_name" field.
+func ClusterNameNEQ(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldNEQ(FieldClusterName, v))
+}
+
+// ClusterNameIn applies the In predicate on the "cluster_name" field.
+func ClusterNameIn(vs ...string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldIn(FieldClusterName, vs...))
+}
+
+// ClusterNameNotIn applies the NotIn predicate on the "cluster_name" field.
+func ClusterNameNotIn(vs ...string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldNotIn(FieldClusterName, vs...))
+}
+
+// ClusterNameGT applies the GT predicate on the "cluster_name" field.
+func ClusterNameGT(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldGT(FieldClusterName, v))
+}
+
+// ClusterNameGTE applies the GTE predicate on the "cluster_name" field.
+func ClusterNameGTE(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldGTE(FieldClusterName, v))
+}
+
+// ClusterNameLT applies the LT predicate on the "cluster_name" field.
+func ClusterNameLT(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldLT(FieldClusterName, v))
+}
+
+// ClusterNameLTE applies the LTE predicate on the "cluster_name" field.
+func ClusterNameLTE(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldLTE(FieldClusterName, v))
+}
+
+// ClusterNameContains applies the Contains predicate on the "cluster_name" field.
+func ClusterNameContains(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldContains(FieldClusterName, v))
+}
+
+// ClusterNameHasPrefix applies the HasPrefix predicate on the "cluster_name" field.
+func ClusterNameHasPrefix(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldHasPrefix(FieldClusterName, v))
+}
+
+// ClusterNameHasSuffix applies the HasSuffix predicate on the "cluster_name" field.
+func ClusterNameHasSuffix(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldHasSuffix(FieldClusterName, v))
+}
+
+// ClusterNameEqualFold applies the EqualFold predicate on the "cluster_name" field.
+func ClusterNameEqualFold(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldEqualFold(FieldClusterName, v))
+}
+
+// ClusterNameContainsFold applies the ContainsFold predicate on the "cluster_name" field.
+func ClusterNameContainsFold(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldContainsFold(FieldClusterName, v))
+}
+
+// RegistryEQ applies the EQ predicate on the "registry" field.
+func RegistryEQ(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldEQ(FieldRegistry, v))
+}
+
+// RegistryNEQ applies the NEQ predicate on the "registry" field.
+func RegistryNEQ(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldNEQ(FieldRegistry, v))
+}
+
+// RegistryIn applies the In predicate on the "registry" field.
+func RegistryIn(vs ...string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldIn(FieldRegistry, vs...))
+}
+
+// RegistryNotIn applies the NotIn predicate on the "registry" field.
+func RegistryNotIn(vs ...string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldNotIn(FieldRegistry, vs...))
+}
+
+// RegistryGT applies the GT predicate on the "registry" field.
+func RegistryGT(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldGT(FieldRegistry, v))
+}
+
+// RegistryGTE applies the GTE predicate on the "registry" field.
+func RegistryGTE(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldGTE(FieldRegistry, v))
+}
+
+// RegistryLT applies the LT predicate on the "registry" field.
+func RegistryLT(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldLT(FieldRegistry, v))
+}
+
+// RegistryLTE applies the LTE predicate on the "registry" field.
+func RegistryLTE(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldLTE(FieldRegistry, v))
+}
+
+// RegistryContains applies the Contains predicate on the "registry" field.
+func RegistryContains(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldContains(FieldRegistry, v))
+}
+
+// RegistryHasPrefix applies the HasPrefix predicate on the "registry" field.
+func RegistryHasPrefix(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldHasPrefix(FieldRegistry, v))
+}
+
+// RegistryHasSuffix applies the HasSuffix predicate on the "registry" field.
+func RegistryHasSuffix(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldHasSuffix(FieldRegistry, v))
+}
+
+// RegistryEqualFold applies the EqualFold predicate on the "registry" field.
+func RegistryEqualFold(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldEqualFold(FieldRegistry, v))
+}
+
+// RegistryContainsFold applies the ContainsFold predicate on the "registry" field.
+func RegistryContainsFold(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldContainsFold(FieldRegistry, v))
+}
+
+// RepositoryEQ applies the EQ predicate on the "repository" field.
+func RepositoryEQ(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldEQ(FieldRepository, v))
+}
+
+// RepositoryNEQ applies the NEQ predicate on the "repository" field.
+func RepositoryNEQ(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldNEQ(FieldRepository, v))
+}
+
+// RepositoryIn applies the In predicate on the "repository" field.
+func RepositoryIn(vs ...string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldIn(FieldRepository, vs...))
+}
+
+// RepositoryNotIn applies the NotIn predicate on the "repository" field.
+func RepositoryNotIn(vs ...string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldNotIn(FieldRepository, vs...))
+}
+
+// RepositoryGT applies the GT predicate on the "repository" field.
+func RepositoryGT(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldGT(FieldRepository, v))
+}
+
+// RepositoryGTE applies the GTE predicate on the "repository" field.
+func RepositoryGTE(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldGTE(FieldRepository, v))
+}
+
+// RepositoryLT applies the LT predicate on the "repository" field.
+func RepositoryLT(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldLT(FieldRepository, v))
+}
+
+// RepositoryLTE applies the LTE predicate on the "repository" field.
+func RepositoryLTE(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldLTE(FieldRepository, v))
+}
+
+// RepositoryContains applies the Contains predicate on the "repository" field.
+func RepositoryContains(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldContains(FieldRepository, v))
+}
+
+// RepositoryHasPrefix applies the HasPrefix predicate on the "repository" field.
+func RepositoryHasPrefix(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldHasPrefix(FieldRepository, v))
+}
+
+// RepositoryHasSuffix applies the HasSuffix predicate on the "repository" field.
+func RepositoryHasSuffix(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldHasSuffix(FieldRepository, v))
+}
+
+// RepositoryEqualFold applies the EqualFold predicate on the "repository" field.
+func RepositoryEqualFold(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldEqualFold(FieldRepository, v))
+}
+
+// RepositoryContainsFold applies the ContainsFold predicate on the "repository" field.
+func RepositoryContainsFold(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldContainsFold(FieldRepository, v))
+}
+
+// DigestEQ applies the EQ predicate on the "digest" field.
+func DigestEQ(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldEQ(FieldDigest, v))
+}
+
+// DigestNEQ applies the NEQ predicate on the "digest" field.
+func DigestNEQ(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldNEQ(FieldDigest, v))
+}
+
+// DigestIn applies the In predicate on the "digest" field.
+func DigestIn(vs ...string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldIn(FieldDigest, vs...))
+}
+
+// DigestNotIn applies the NotIn predicate on the "digest" field.
+func DigestNotIn(vs ...string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldNotIn(FieldDigest, vs...))
+}
+
+// DigestGT applies the GT predicate on the "digest" field.
+func DigestGT(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldGT(FieldDigest, v))
+}
+
+// DigestGTE applies the GTE predicate on the "digest" field.
+func DigestGTE(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldGTE(FieldDigest, v))
+}
+
+// DigestLT applies the LT predicate on the "digest" field.
+func DigestLT(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldLT(FieldDigest, v))
+}
+
+// DigestLTE applies the LTE predicate on the "digest" field.
+func DigestLTE(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldLTE(FieldDigest, v))
+}
+
+// DigestContains applies the Contains predicate on the "digest" field.
+func DigestContains(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldContains(FieldDigest, v))
+}
+
+// DigestHasPrefix applies the HasPrefix predicate on the "digest" field.
+func DigestHasPrefix(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldHasPrefix(FieldDigest, v))
+}
+
+// DigestHasSuffix applies the HasSuffix predicate on the "digest" field.
+func DigestHasSuffix(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldHasSuffix(FieldDigest, v))
+}
+
+// DigestIsNil applies the IsNil predicate on the "digest" field.
+func DigestIsNil() predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldIsNull(FieldDigest))
+}
+
+// DigestNotNil applies the NotNil predicate on the "digest" field.
+func DigestNotNil() predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldNotNull(FieldDigest))
+}
+
+// DigestEqualFold applies the EqualFold predicate on the "digest" field.
+func DigestEqualFold(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldEqualFold(FieldDigest, v))
+}
+
+// DigestContainsFold applies the ContainsFold predicate on the "digest" field.
+func DigestContainsFold(v string) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldContainsFold(FieldDigest, v))
+}
+
+// TagsJSONIsNil applies the IsNil predicate on the "tags_json" field.
+func TagsJSONIsNil() predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldIsNull(FieldTagsJSON))
+}
+
+// TagsJSONNotNil applies the NotNil predicate on the "tags_json" field.
+func TagsJSONNotNil() predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldNotNull(FieldTagsJSON))
+}
+
+// NamespacesJSONIsNil applies the IsNil predicate on the "namespaces_json" field.
+func NamespacesJSONIsNil() predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldIsNull(FieldNamespacesJSON))
+}
+
+// NamespacesJSONNotNil applies the NotNil predicate on the "namespaces_json" field.
+func NamespacesJSONNotNil() predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldNotNull(FieldNamespacesJSON))
+}
+
+// WorkloadsJSONIsNil applies the IsNil predicate on the "workloads_json" field.
+func WorkloadsJSONIsNil() predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldIsNull(FieldWorkloadsJSON))
+}
+
+// WorkloadsJSONNotNil applies the NotNil predicate on the "workloads_json" field.
+func WorkloadsJSONNotNil() predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.FieldNotNull(FieldWorkloadsJSON))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeK8sImage) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeK8sImage) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeK8sImage) predicate.BronzeK8sImage {
+	return predicate.BronzeK8sImage(sql.NotPredicates(p))
+}
@@ -0,0 +1,312 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8simage"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeK8sImageCreate is the builder for creating a BronzeK8sImage entity.
+type BronzeK8sImageCreate struct {
+	config
+	mutation *BronzeK8sImageMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeK8sImageCreate) SetCollectedAt(v time.Time) *BronzeK8sImageCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeK8sImageCreate) SetFirstCollectedAt(v time.Time) *BronzeK8sImageCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_c *BronzeK8sImageCreate) SetClusterName(v string) *BronzeK8sImageCreate {
+	_c.mutation.SetClusterName(v)
+	return _c
+}
+
+// SetRegistry sets the "registry" field.
+func (_c *BronzeK8sImageCreate) SetRegistry(v string) *BronzeK8sImageCreate {
+	_c.mutation.SetRegistry(v)
+	return _c
+}
+
+// SetRepository sets the "repository" field.
+func (_c *BronzeK8sImageCreate) SetRepository(v string) *BronzeK8sImageCreate {
+	_c.mutation.SetRepository(v)
+	return _c
+}
+
+// SetDigest sets the "digest" field.
+func (_c *BronzeK8sImageCreate) SetDigest(v string) *BronzeK8sImageCreate {
+	_c.mutation.SetDigest(v)
+	return _c
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (_c *BronzeK8sImageCreate) SetNillableDigest(v *string) *BronzeK8sImageCreate {
+	if v != nil {
+		_c.SetDigest(*v)
+	}
+	return _c
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_c *BronzeK8sImageCreate) SetTagsJSON(v json.RawMessage) *BronzeK8sImageCreate {
+	_c.mutation.SetTagsJSON(v)
+	return _c
+}
+
+// SetNamespacesJSON sets the "namespaces_json" field.
+func (_c *BronzeK8sImageCreate) SetNamespacesJSON(v json.RawMessage) *BronzeK8sImageCreate {
+	_c.mutation.SetNamespacesJSON(v)
+	return _c
+}
+
+// SetWorkloadsJSON sets the "workloads_json" field.
+func (_c *BronzeK8sImageCreate) SetWorkloadsJSON(v json.RawMessage) *BronzeK8sImageCreate {
+	_c.mutation.SetWorkloadsJSON(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeK8sImageCreate) SetID(v string) *BronzeK8sImageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeK8sImageMutation object of the builder.
+func (_c *BronzeK8sImageCreate) Mutation() *BronzeK8sImageMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeK8sImage in the database.
+func (_c *BronzeK8sImageCreate) Save(ctx context.Context) (*BronzeK8sImage, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeK8sImageCreate) SaveX(ctx context.Context) *BronzeK8sImage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeK8sImageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeK8sImageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeK8sImageCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`kubernetes: missing required field "BronzeK8sImage.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`kubernetes: missing required field "BronzeK8sImage.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ClusterName(); !ok {
+		return &ValidationError{Name: "cluster_name", err: errors.New(`kubernetes: missing required field "BronzeK8sImage.cluster_name"`)}
+	}
+	if v, ok := _c.mutation.ClusterName(); ok {
+		if err := bronzek8simage.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sImage.cluster_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Registry(); !ok {
+		return &ValidationError{Name: "registry", err: errors.New(`kubernetes: missing required field "BronzeK8sImage.registry"`)}
+	}
+	if v, ok := _c.mutation.Registry(); ok {
+		if err := bronzek8simage.RegistryValidator(v); err != nil {
+			return &ValidationError{Name: "registry", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sImage.registry": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Repository(); !ok {
+		return &ValidationError{Name: "repository", err: errors.New(`kubernetes: missing required field "BronzeK8sImage.repository"`)}
+	}
+	if v, ok := _c.mutation.Repository(); ok {
+		if err := bronzek8simage.RepositoryValidator(v); err != nil {
+			return &ValidationError{Name: "repository", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sImage.repository": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeK8sImageCreate) sqlSave(ctx context.Context) (*BronzeK8sImage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeK8sImage.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeK8sImageCreate) createSpec() (*BronzeK8sImage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeK8sImage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzek8simage.Table, sqlgraph.NewFieldSpec(bronzek8simage.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeK8sImage
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8simage.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzek8simage.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8simage.FieldClusterName, field.TypeString, value)
+		_node.ClusterName = value
+	}
+	if value, ok := _c.mutation.Registry(); ok {
+		_spec.SetField(bronzek8simage.FieldRegistry, field.TypeString, value)
+		_node.Registry = value
+	}
+	if value, ok := _c.mutation.Repository(); ok {
+		_spec.SetField(bronzek8simage.FieldRepository, field.TypeString, value)
+		_node.Repository = value
+	}
+	if value, ok := _c.mutation.Digest(); ok {
+		_spec.SetField(bronzek8simage.FieldDigest, field.TypeString, value)
+		_node.Digest = value
+	}
+	if value, ok := _c.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzek8simage.FieldTagsJSON, field.TypeJSON, value)
+		_node.TagsJSON = value
+	}
+	if value, ok := _c.mutation.NamespacesJSON(); ok {
+		_spec.SetField(bronzek8simage.FieldNamespacesJSON, field.TypeJSON, value)
+		_node.NamespacesJSON = value
+	}
+	if value, ok := _c.mutation.WorkloadsJSON(); ok {
+		_spec.SetField(bronzek8simage.FieldWorkloadsJSON, field.TypeJSON, value)
+		_node.WorkloadsJSON = value
+	}
+	return _node, _spec
+}
+
+// BronzeK8sImageCreateBulk is the builder for creating many BronzeK8sImage entities in bulk.
+type BronzeK8sImageCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeK8sImageCreate
+}
+
+// Save creates the BronzeK8sImage entities in the database.
+func (_c *BronzeK8sImageCreateBulk) Save(ctx context.Context) ([]*BronzeK8sImage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeK8sImage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeK8sImageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeK8sImageCreateBulk) SaveX(ctx context.Context) []*BronzeK8sImage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeK8sImageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeK8sImageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,591 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8simage"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeK8sImageUpdate is the builder for updating BronzeK8sImage entities.
+type BronzeK8sImageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeK8sImageMutation
+}
+
+// Where appends a list predicates to the BronzeK8sImageUpdate builder.
+func (_u *BronzeK8sImageUpdate) Where(ps ...predicate.BronzeK8sImage) *BronzeK8sImageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeK8sImageUpdate) SetCollectedAt(v time.Time) *BronzeK8sImageUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeK8sImageUpdate) SetNillableCollectedAt(v *time.Time) *BronzeK8sImageUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeK8sImageUpdate) SetClusterName(v string) *BronzeK8sImageUpdate {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeK8sImageUpdate) SetNillableClusterName(v *string) *BronzeK8sImageUpdate {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetRegistry sets the "registry" field.
+func (_u *BronzeK8sImageUpdate) SetRegistry(v string) *BronzeK8sImageUpdate {
+	_u.mutation.SetRegistry(v)
+	return _u
+}
+
+// SetNillableRegistry sets the "registry" field if the given value is not nil.
+func (_u *BronzeK8sImageUpdate) SetNillableRegistry(v *string) *BronzeK8sImageUpdate {
+	if v != nil {
+		_u.SetRegistry(*v)
+	}
+	return _u
+}
+
+// SetRepository sets the "repository" field.
+func (_u *BronzeK8sImageUpdate) SetRepository(v string) *BronzeK8sImageUpdate {
+	_u.mutation.SetRepository(v)
+	return _u
+}
+
+// SetNillableRepository sets the "repository" field if the given value is not nil.
+func (_u *BronzeK8sImageUpdate) SetNillableRepository(v *string) *BronzeK8sImageUpdate {
+	if v != nil {
+		_u.SetRepository(*v)
+	}
+	return _u
+}
+
+// SetDigest sets the "digest" field.
+func (_u *BronzeK8sImageUpdate) SetDigest(v string) *BronzeK8sImageUpdate {
+	_u.mutation.SetDigest(v)
+	return _u
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (_u *BronzeK8sImageUpdate) SetNillableDigest(v *string) *BronzeK8sImageUpdate {
+	if v != nil {
+		_u.SetDigest(*v)
+	}
+	return _u
+}
+
+// ClearDigest clears the value of the "digest" field.
+func (_u *BronzeK8sImageUpdate) ClearDigest() *BronzeK8sImageUpdate {
+	_u.mutation.ClearDigest()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeK8sImageUpdate) SetTagsJSON(v json.RawMessage) *BronzeK8sImageUpdate {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeK8sImageUpdate) AppendTagsJSON(v json.RawMessage) *BronzeK8sImageUpdate {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeK8sImageUpdate) ClearTagsJSON() *BronzeK8sImageUpdate {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetNamespacesJSON sets the "namespaces_json" field.
+func (_u *BronzeK8sImageUpdate) SetNamespacesJSON(v json.RawMessage) *BronzeK8sImageUpdate {
+	_u.mutation.SetNamespacesJSON(v)
+	return _u
+}
+
+// AppendNamespacesJSON appends value to the "namespaces_json" field.
+func (_u *BronzeK8sImageUpdate) AppendNamespacesJSON(v json.RawMessage) *BronzeK8sImageUpdate {
+	_u.mutation.AppendNamespacesJSON(v)
+	return _u
+}
+
+// ClearNamespacesJSON clears the value of the "namespaces_json" field.
+func (_u *BronzeK8sImageUpdate) ClearNamespacesJSON() *BronzeK8sImageUpdate {
+	_u.mutation.ClearNamespacesJSON()
+	return _u
+}
+
+// SetWorkloadsJSON sets the "workloads_json" field.
+func (_u *BronzeK8sImageUpdate) SetWorkloadsJSON(v json.RawMessage) *BronzeK8sImageUpdate {
+	_u.mutation.SetWorkloadsJSON(v)
+	return _u
+}
+
+// AppendWorkloadsJSON appends value to the "workloads_json" field.
+func (_u *BronzeK8sImageUpdate) AppendWorkloadsJSON(v json.RawMessage) *BronzeK8sImageUpdate {
+	_u.mutation.AppendWorkloadsJSON(v)
+	return _u
+}
+
+// ClearWorkloadsJSON clears the value of the "workloads_json" field.
+func (_u *BronzeK8sImageUpdate) ClearWorkloadsJSON() *BronzeK8sImageUpdate {
+	_u.mutation.ClearWorkloadsJSON()
+	return _u
+}
+
+// Mutation returns the BronzeK8sImageMutation object of the builder.
+func (_u *BronzeK8sImageUpdate) Mutation() *BronzeK8sImageMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeK8sImageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeK8sImageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeK8sImageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeK8sImageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeK8sImageUpdate) check() error {
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzek8simage.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sImage.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Registry(); ok {
+		if err := bronzek8simage.RegistryValidator(v); err != nil {
+			return &ValidationError{Name: "registry", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sImage.registry": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Repository(); ok {
+		if err := bronzek8simage.RepositoryValidator(v); err != nil {
+			return &ValidationError{Name: "repository", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sImage.repository": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeK8sImageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzek8simage.Table, bronzek8simage.Columns, sqlgraph.NewFieldSpec(bronzek8simage.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8simage.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8simage.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Registry(); ok {
+		_spec.SetField(bronzek8simage.FieldRegistry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Repository(); ok {
+		_spec.SetField(bronzek8simage.FieldRepository, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Digest(); ok {
+		_spec.SetField(bronzek8simage.FieldDigest, field.TypeString, value)
+	}
+	if _u.mutation.DigestCleared() {
+		_spec.ClearField(bronzek8simage.FieldDigest, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzek8simage.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8simage.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzek8simage.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.NamespacesJSON(); ok {
+		_spec.SetField(bronzek8simage.FieldNamespacesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedNamespacesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8simage.FieldNamespacesJSON, value)
+		})
+	}
+	if _u.mutation.NamespacesJSONCleared() {
+		_spec.ClearField(bronzek8simage.FieldNamespacesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.WorkloadsJSON(); ok {
+		_spec.SetField(bronzek8simage.FieldWorkloadsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWorkloadsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8simage.FieldWorkloadsJSON, value)
+		})
+	}
+	if _u.mutation.WorkloadsJSONCleared() {
+		_spec.ClearField(bronzek8simage.FieldWorkloadsJSON, field.TypeJSON)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeK8sImage
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzek8simage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeK8sImageUpdateOne is the builder for updating a single BronzeK8sImage entity.
+type BronzeK8sImageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeK8sImageMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeK8sImageUpdateOne) SetCollectedAt(v time.Time) *BronzeK8sImageUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeK8sImageUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeK8sImageUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeK8sImageUpdateOne) SetClusterName(v string) *BronzeK8sImageUpdateOne {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeK8sImageUpdateOne) SetNillableClusterName(v *string) *BronzeK8sImageUpdateOne {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetRegistry sets the "registry" field.
+func (_u *BronzeK8sImageUpdateOne) SetRegistry(v string) *BronzeK8sImageUpdateOne {
+	_u.mutation.SetRegistry(v)
+	return _u
+}
+
+// SetNillableRegistry sets the "registry" field if the given value is not nil.
+func (_u *BronzeK8sImageUpdateOne) SetNillableRegistry(v *string) *BronzeK8sImageUpdateOne {
+	if v != nil {
+		_u.SetRegistry(*v)
+	}
+	return _u
+}
+
+// SetRepository sets the "repository" field.
+func (_u *BronzeK8sImageUpdateOne) SetRepository(v string) *BronzeK8sImageUpdateOne {
+	_u.mutation.SetRepository(v)
+	return _u
+}
+
+// SetNillableRepository sets the "repository" field if the given value is not nil.
+func (_u *BronzeK8sImageUpdateOne) SetNillableRepository(v *string) *BronzeK8sImageUpdateOne {
+	if v != nil {
+		_u.SetRepository(*v)
+	}
+	return _u
+}
+
+// SetDigest sets the "digest" field.
+func (_u *BronzeK8sImageUpdateOne) SetDigest(v string) *BronzeK8sImageUpdateOne {
+	_u.mutation.SetDigest(v)
+	return _u
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (_u *BronzeK8sImageUpdateOne) SetNillableDigest(v *string) *BronzeK8sImageUpdateOne {
+	if v != nil {
+		_u.SetDigest(*v)
+	}
+	return _u
+}
+
+// ClearDigest clears the value of the "digest" field.
+func (_u *BronzeK8sImageUpdateOne) ClearDigest() *BronzeK8sImageUpdateOne {
+	_u.mutation.ClearDigest()
+	return _u
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (_u *BronzeK8sImageUpdateOne) SetTagsJSON(v json.RawMessage) *BronzeK8sImageUpdateOne {
+	_u.mutation.SetTagsJSON(v)
+	return _u
+}
+
+// AppendTagsJSON appends value to the "tags_json" field.
+func (_u *BronzeK8sImageUpdateOne) AppendTagsJSON(v json.RawMessage) *BronzeK8sImageUpdateOne {
+	_u.mutation.AppendTagsJSON(v)
+	return _u
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (_u *BronzeK8sImageUpdateOne) ClearTagsJSON() *BronzeK8sImageUpdateOne {
+	_u.mutation.ClearTagsJSON()
+	return _u
+}
+
+// SetNamespacesJSON sets the "namespaces_json" field.
+func (_u *BronzeK8sImageUpdateOne) SetNamespacesJSON(v json.RawMessage) *BronzeK8sImageUpdateOne {
+	_u.mutation.SetNamespacesJSON(v)
+	return _u
+}
+
+// AppendNamespacesJSON appends value to the "namespaces_json" field.
+func (_u *BronzeK8sImageUpdateOne) AppendNamespacesJSON(v json.RawMessage) *BronzeK8sImageUpdateOne {
+	_u.mutation.AppendNamespacesJSON(v)
+	return _u
+}
+
+// ClearNamespacesJSON clears the value of the "namespaces_json" field.
+func (_u *BronzeK8sImageUpdateOne) ClearNamespacesJSON() *BronzeK8sImageUpdateOne {
+	_u.mutation.ClearNamespacesJSON()
+	return _u
+}
+
+// SetWorkloadsJSON sets the "workloads_json" field.
+func (_u *BronzeK8sImageUpdateOne) SetWorkloadsJSON(v json.RawMessage) *BronzeK8sImageUpdateOne {
+	_u.mutation.SetWorkloadsJSON(v)
+	return _u
+}
+
+// AppendWorkloadsJSON appends value to the "workloads_json" field.
+func (_u *BronzeK8sImageUpdateOne) AppendWorkloadsJSON(v json.RawMessage) *BronzeK8sImageUpdateOne {
+	_u.mutation.AppendWorkloadsJSON(v)
+	return _u
+}
+
+// ClearWorkloadsJSON clears the value of the "workloads_json" field.
+func (_u *BronzeK8sImageUpdateOne) ClearWorkloadsJSON() *BronzeK8sImageUpdateOne {
+	_u.mutation.ClearWorkloadsJSON()
+	return _u
+}
+
+// Mutation returns the BronzeK8sImageMutation object of the builder.
+func (_u *BronzeK8sImageUpdateOne) Mutation() *BronzeK8sImageMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeK8sImageUpdate builder.
+func (_u *BronzeK8sImageUpdateOne) Where(ps ...predicate.BronzeK8sImage) *BronzeK8sImageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeK8sImageUpdateOne) Select(field string, fields ...string) *BronzeK8sImageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeK8sImage entity.
+func (_u *BronzeK8sImageUpdateOne) Save(ctx context.Context) (*BronzeK8sImage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeK8sImageUpdateOne) SaveX(ctx context.Context) *BronzeK8sImage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeK8sImageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeK8sImageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeK8sImageUpdateOne) check() error {
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzek8simage.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sImage.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Registry(); ok {
+		if err := bronzek8simage.RegistryValidator(v); err != nil {
+			return &ValidationError{Name: "registry", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sImage.registry": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Repository(); ok {
+		if err := bronzek8simage.RepositoryValidator(v); err != nil {
+			return &ValidationError{Name: "repository", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sImage.repository": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeK8sImageUpdateOne) sqlSave(ctx context.Context) (_node *BronzeK8sImage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzek8simage.Table, bronzek8simage.Columns, sqlgraph.NewFieldSpec(bronzek8simage.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`kubernetes: missing "BronzeK8sImage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzek8simage.FieldID)
+		for _, f := range fields {
+			if !bronzek8simage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("kubernetes: invalid field %q for query", f)}
+			}
+			if f != bronzek8simage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8simage.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8simage.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Registry(); ok {
+		_spec.SetField(bronzek8simage.FieldRegistry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Repository(); ok {
+		_spec.SetField(bronzek8simage.FieldRepository, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Digest(); ok {
+		_spec.SetField(bronzek8simage.FieldDigest, field.TypeString, value)
+	}
+	if _u.mutation.DigestCleared() {
+		_spec.ClearField(bronzek8simage.FieldDigest, field.TypeString)
+	}
+	if value, ok := _u.mutation.TagsJSON(); ok {
+		_spec.SetField(bronzek8simage.FieldTagsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTagsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8simage.FieldTagsJSON, value)
+		})
+	}
+	if _u.mutation.TagsJSONCleared() {
+		_spec.ClearField(bronzek8simage.FieldTagsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.NamespacesJSON(); ok {
+		_spec.SetField(bronzek8simage.FieldNamespacesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedNamespacesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8simage.FieldNamespacesJSON, value)
+		})
+	}
+	if _u.mutation.NamespacesJSONCleared() {
+		_spec.ClearField(bronzek8simage.FieldNamespacesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.WorkloadsJSON(); ok {
+		_spec.SetField(bronzek8simage.FieldWorkloadsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWorkloadsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8simage.FieldWorkloadsJSON, value)
+		})
+	}
+	if _u.mutation.WorkloadsJSONCleared() {
+		_spec.ClearField(bronzek8simage.FieldWorkloadsJSON, field.TypeJSON)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeK8sImage
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeK8sImage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzek8simage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snamespace"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeK8sNamespaceCreate is the builder for creating a BronzeK8sNamespace entity.
+type BronzeK8sNamespaceCreate struct {
+	config
+	mutation *BronzeK8sNamespaceMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeK8sNamespaceCreate) SetCollectedAt(v time.Time) *BronzeK8sNamespaceCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeK8sNamespaceCreate) SetFirstCollectedAt(v time.Time) *BronzeK8sNamespaceCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_c *BronzeK8sNamespaceCreate) SetClusterName(v string) *BronzeK8sNamespaceCreate {
+	_c.mutation.SetClusterName(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeK8sNamespaceCreate) SetName(v string) *BronzeK8sNamespaceCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetUID sets the "uid" field.
+func (_c *BronzeK8sNamespaceCreate) SetUID(v string) *BronzeK8sNamespaceCreate {
+	_c.mutation.SetUID(v)
+	return _c
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_c *BronzeK8sNamespaceCreate) SetNillableUID(v *string) *BronzeK8sNamespaceCreate {
+	if v != nil {
+		_c.SetUID(*v)
+	}
+	return _c
+}
+
+// SetPhase sets the "phase" field.
+func (_c *BronzeK8sNamespaceCreate) SetPhase(v string) *BronzeK8sNamespaceCreate {
+	_c.mutation.SetPhase(v)
+	return _c
+}
+
+// SetNillablePhase sets the "phase" field if the given value is not nil.
+func (_c *BronzeK8sNamespaceCreate) SetNillablePhase(v *string) *BronzeK8sNamespaceCreate {
+	if v != nil {
+		_c.SetPhase(*v)
+	}
+	return _c
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_c *BronzeK8sNamespaceCreate) SetLabelsJSON(v json.RawMessage) *BronzeK8sNamespaceCreate {
+	_c.mutation.SetLabelsJSON(v)
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeK8sNamespaceCreate) SetAPICreatedAt(v time.Time) *BronzeK8sNamespaceCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeK8sNamespaceCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sNamespaceCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeK8sNamespaceCreate) SetID(v string) *BronzeK8sNamespaceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeK8sNamespaceMutation object of the builder.
+func (_c *BronzeK8sNamespaceCreate) Mutation() *BronzeK8sNamespaceMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeK8sNamespace in the database.
+func (_c *BronzeK8sNamespaceCreate) Save(ctx context.Context) (*BronzeK8sNamespace, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeK8sNamespaceCreate) SaveX(ctx context.Context) *BronzeK8sNamespace {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeK8sNamespaceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeK8sNamespaceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeK8sNamespaceCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`kubernetes: missing required field "BronzeK8sNamespace.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`kubernetes: missing required field "BronzeK8sNamespace.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ClusterName(); !ok {
+		return &ValidationError{Name: "cluster_name", err: errors.New(`kubernetes: missing required field "BronzeK8sNamespace.cluster_name"`)}
+	}
+	if v, ok := _c.mutation.ClusterName(); ok {
+		if err := bronzek8snamespace.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNamespace.cluster_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`kubernetes: missing required field "BronzeK8sNamespace.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := bronzek8snamespace.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNamespace.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeK8sNamespaceCreate) sqlSave(ctx context.Context) (*BronzeK8sNamespace, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeK8sNamespace.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeK8sNamespaceCreate) createSpec() (*BronzeK8sNamespace, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeK8sNamespace{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzek8snamespace.Table, sqlgraph.NewFieldSpec(bronzek8snamespace.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeK8sNamespace
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8snamespace.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzek8snamespace.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8snamespace.FieldClusterName, field.TypeString, value)
+		_node.ClusterName = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzek8snamespace.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.UID(); ok {
+		_spec.SetField(bronzek8snamespace.FieldUID, field.TypeString, value)
+		_node.UID = value
+	}
+	if value, ok := _c.mutation.Phase(); ok {
+		_spec.SetField(bronzek8snamespace.FieldPhase, field.TypeString, value)
+		_node.Phase = value
+	}
+	if value, ok := _c.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8snamespace.FieldLabelsJSON, field.TypeJSON, value)
+		_node.LabelsJSON = value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8snamespace.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	return _node, _spec
+}
+
+// BronzeK8sNamespaceCreateBulk is the builder for creating many BronzeK8sNamespace entities in bulk.
+type BronzeK8sNamespaceCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeK8sNamespaceCreate
+}
+
+// Save creates the BronzeK8sNamespace entities in the database.
+func (_c *BronzeK8sNamespaceCreateBulk) Save(ctx context.Context) ([]*BronzeK8sNamespace, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeK8sNamespace, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeK8sNamespaceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeK8sNamespaceCreateBulk) SaveX(ctx context.Context) []*BronzeK8sNamespace {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeK8sNamespaceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeK8sNamespaceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snamespace"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeK8sNamespaceDelete is the builder for deleting a BronzeK8sNamespace entity.
+type BronzeK8sNamespaceDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeK8sNamespaceMutation
+}
+
+// Where appends a list predicates to the BronzeK8sNamespaceDelete builder.
+func (_d *BronzeK8sNamespaceDelete) Where(ps ...predicate.BronzeK8sNamespace) *BronzeK8sNamespaceDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeK8sNamespaceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeK8sNamespaceDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeK8sNamespaceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzek8snamespace.Table, sqlgraph.NewFieldSpec(bronzek8snamespace.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.BronzeK8sNamespace
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeK8sNamespaceDeleteOne is the builder for deleting a single BronzeK8sNamespace entity.
+type BronzeK8sNamespaceDeleteOne struct {
+	_d *BronzeK8sNamespaceDelete
+}
+
+// Where appends a list predicates to the BronzeK8sNamespaceDelete builder.
+func (_d *BronzeK8sNamespaceDeleteOne) Where(ps ...predicate.BronzeK8sNamespace) *BronzeK8sNamespaceDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeK8sNamespaceDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzek8snamespace.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeK8sNamespaceDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
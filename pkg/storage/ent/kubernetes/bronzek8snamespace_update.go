@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snamespace"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeK8sNamespaceUpdate is the builder for updating BronzeK8sNamespace entities.
+type BronzeK8sNamespaceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeK8sNamespaceMutation
+}
+
+// Where appends a list predicates to the BronzeK8sNamespaceUpdate builder.
+func (_u *BronzeK8sNamespaceUpdate) Where(ps ...predicate.BronzeK8sNamespace) *BronzeK8sNamespaceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeK8sNamespaceUpdate) SetCollectedAt(v time.Time) *BronzeK8sNamespaceUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeK8sNamespaceUpdate) SetNillableCollectedAt(v *time.Time) *BronzeK8sNamespaceUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeK8sNamespaceUpdate) SetClusterName(v string) *BronzeK8sNamespaceUpdate {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeK8sNamespaceUpdate) SetNillableClusterName(v *string) *BronzeK8sNamespaceUpdate {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeK8sNamespaceUpdate) SetName(v string) *BronzeK8sNamespaceUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeK8sNamespaceUpdate) SetNillableName(v *string) *BronzeK8sNamespaceUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeK8sNamespaceUpdate) SetUID(v string) *BronzeK8sNamespaceUpdate {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeK8sNamespaceUpdate) SetNillableUID(v *string) *BronzeK8sNamespaceUpdate {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeK8sNamespaceUpdate) ClearUID() *BronzeK8sNamespaceUpdate {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetPhase sets the "phase" field.
+func (_u *BronzeK8sNamespaceUpdate) SetPhase(v string) *BronzeK8sNamespaceUpdate {
+	_u.mutation.SetPhase(v)
+	return _u
+}
+
+// SetNillablePhase sets the "phase" field if the given value is not nil.
+func (_u *BronzeK8sNamespaceUpdate) SetNillablePhase(v *string) *BronzeK8sNamespaceUpdate {
+	if v != nil {
+		_u.SetPhase(*v)
+	}
+	return _u
+}
+
+// ClearPhase clears the value of the "phase" field.
+func (_u *BronzeK8sNamespaceUpdate) ClearPhase() *BronzeK8sNamespaceUpdate {
+	_u.mutation.ClearPhase()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeK8sNamespaceUpdate) SetLabelsJSON(v json.RawMessage) *BronzeK8sNamespaceUpdate {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeK8sNamespaceUpdate) AppendLabelsJSON(v json.RawMessage) *BronzeK8sNamespaceUpdate {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeK8sNamespaceUpdate) ClearLabelsJSON() *BronzeK8sNamespaceUpdate {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeK8sNamespaceUpdate) SetAPICreatedAt(v time.Time) *BronzeK8sNamespaceUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeK8sNamespaceUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sNamespaceUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeK8sNamespaceUpdate) ClearAPICreatedAt() *BronzeK8sNamespaceUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeK8sNamespaceMutation object of the builder.
+func (_u *BronzeK8sNamespaceUpdate) Mutation() *BronzeK8sNamespaceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeK8sNamespaceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeK8sNamespaceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeK8sNamespaceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeK8sNamespaceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeK8sNamespaceUpdate) check() error {
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzek8snamespace.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNamespace.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzek8snamespace.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNamespace.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeK8sNamespaceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzek8snamespace.Table, bronzek8snamespace.Columns, sqlgraph.NewFieldSpec(bronzek8snamespace.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8snamespace.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8snamespace.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzek8snamespace.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzek8snamespace.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzek8snamespace.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phase(); ok {
+		_spec.SetField(bronzek8snamespace.FieldPhase, field.TypeString, value)
+	}
+	if _u.mutation.PhaseCleared() {
+		_spec.ClearField(bronzek8snamespace.FieldPhase, field.TypeString)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8snamespace.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8snamespace.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzek8snamespace.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8snamespace.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzek8snamespace.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeK8sNamespace
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzek8snamespace.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeK8sNamespaceUpdateOne is the builder for updating a single BronzeK8sNamespace entity.
+type BronzeK8sNamespaceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeK8sNamespaceMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeK8sNamespaceUpdateOne) SetCollectedAt(v time.Time) *BronzeK8sNamespaceUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeK8sNamespaceUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeK8sNamespaceUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeK8sNamespaceUpdateOne) SetClusterName(v string) *BronzeK8sNamespaceUpdateOne {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeK8sNamespaceUpdateOne) SetNillableClusterName(v *string) *BronzeK8sNamespaceUpdateOne {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeK8sNamespaceUpdateOne) SetName(v string) *BronzeK8sNamespaceUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeK8sNamespaceUpdateOne) SetNillableName(v *string) *BronzeK8sNamespaceUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeK8sNamespaceUpdateOne) SetUID(v string) *BronzeK8sNamespaceUpdateOne {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeK8sNamespaceUpdateOne) SetNillableUID(v *string) *BronzeK8sNamespaceUpdateOne {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeK8sNamespaceUpdateOne) ClearUID() *BronzeK8sNamespaceUpdateOne {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetPhase sets the "phase" field.
+func (_u *BronzeK8sNamespaceUpdateOne) SetPhase(v string) *BronzeK8sNamespaceUpdateOne {
+	_u.mutation.SetPhase(v)
+	return _u
+}
+
+// SetNillablePhase sets the "phase" field if the given value is not nil.
+func (_u *BronzeK8sNamespaceUpdateOne) SetNillablePhase(v *string) *BronzeK8sNamespaceUpdateOne {
+	if v != nil {
+		_u.SetPhase(*v)
+	}
+	return _u
+}
+
+// ClearPhase clears the value of the "phase" field.
+func (_u *BronzeK8sNamespaceUpdateOne) ClearPhase() *BronzeK8sNamespaceUpdateOne {
+	_u.mutation.ClearPhase()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeK8sNamespaceUpdateOne) SetLabelsJSON(v json.RawMessage) *BronzeK8sNamespaceUpdateOne {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeK8sNamespaceUpdateOne) AppendLabelsJSON(v json.RawMessage) *BronzeK8sNamespaceUpdateOne {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeK8sNamespaceUpdateOne) ClearLabelsJSON() *BronzeK8sNamespaceUpdateOne {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeK8sNamespaceUpdateOne) SetAPICreatedAt(v time.Time) *BronzeK8sNamespaceUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeK8sNamespaceUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sNamespaceUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeK8sNamespaceUpdateOne) ClearAPICreatedAt() *BronzeK8sNamespaceUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeK8sNamespaceMutation object of the builder.
+func (_u *BronzeK8sNamespaceUpdateOne) Mutation() *BronzeK8sNamespaceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeK8sNamespaceUpdate builder.
+func (_u *BronzeK8sNamespaceUpdateOne) Where(ps ...predicate.BronzeK8sNamespace) *BronzeK8sNamespaceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeK8sNamespaceUpdateOne) Select(field string, fields ...string) *BronzeK8sNamespaceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeK8sNamespace entity.
+func (_u *BronzeK8sNamespaceUpdateOne) Save(ctx context.Context) (*BronzeK8sNamespace, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeK8sNamespaceUpdateOne) SaveX(ctx context.Context) *BronzeK8sNamespace {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeK8sNamespaceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeK8sNamespaceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeK8sNamespaceUpdateOne) check() error {
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzek8snamespace.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNamespace.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzek8snamespace.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNamespace.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeK8sNamespaceUpdateOne) sqlSave(ctx context.Context) (_node *BronzeK8sNamespace, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzek8snamespace.Table, bronzek8snamespace.Columns, sqlgraph.NewFieldSpec(bronzek8snamespace.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`kubernetes: missing "BronzeK8sNamespace.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzek8snamespace.FieldID)
+		for _, f := range fields {
+			if !bronzek8snamespace.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("kubernetes: invalid field %q for query", f)}
+			}
+			if f != bronzek8snamespace.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8snamespace.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8snamespace.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzek8snamespace.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzek8snamespace.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzek8snamespace.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phase(); ok {
+		_spec.SetField(bronzek8snamespace.FieldPhase, field.TypeString, value)
+	}
+	if _u.mutation.PhaseCleared() {
+		_spec.ClearField(bronzek8snamespace.FieldPhase, field.TypeString)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8snamespace.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8snamespace.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzek8snamespace.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8snamespace.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzek8snamespace.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeK8sNamespace
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeK8sNamespace{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzek8snamespace.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
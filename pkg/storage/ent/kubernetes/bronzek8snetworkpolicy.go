@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snetworkpolicy"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeK8sNetworkPolicy is the model entity for the BronzeK8sNetworkPolicy schema.
+type BronzeK8sNetworkPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	// {cluster_name}/{namespace}/{name}
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// ClusterName holds the value of the "cluster_name" field.
+	ClusterName string `json:"cluster_name,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// PodSelectorJSON holds the value of the "pod_selector_json" field.
+	PodSelectorJSON json.RawMessage `json:"pod_selector_json,omitempty"`
+	// Ingress and/or Egress
+	PolicyTypesJSON json.RawMessage `json:"policy_types_json,omitempty"`
+	// IngressJSON holds the value of the "ingress_json" field.
+	IngressJSON json.RawMessage `json:"ingress_json,omitempty"`
+	// EgressJSON holds the value of the "egress_json" field.
+	EgressJSON json.RawMessage `json:"egress_json,omitempty"`
+	// LabelsJSON holds the value of the "labels_json" field.
+	LabelsJSON json.RawMessage `json:"labels_json,omitempty"`
+	// APICreatedAt holds the value of the "api_created_at" field.
+	APICreatedAt *time.Time `json:"api_created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeK8sNetworkPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzek8snetworkpolicy.FieldPodSelectorJSON, bronzek8snetworkpolicy.FieldPolicyTypesJSON, bronzek8snetworkpolicy.FieldIngressJSON, bronzek8snetworkpolicy.FieldEgressJSON, bronzek8snetworkpolicy.FieldLabelsJSON:
+			values[i] = new([]byte)
+		case bronzek8snetworkpolicy.FieldID, bronzek8snetworkpolicy.FieldClusterName, bronzek8snetworkpolicy.FieldNamespace, bronzek8snetworkpolicy.FieldName, bronzek8snetworkpolicy.FieldUID:
+			values[i] = new(sql.NullString)
+		case bronzek8snetworkpolicy.FieldCollectedAt, bronzek8snetworkpolicy.FieldFirstCollectedAt, bronzek8snetworkpolicy.FieldAPICreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeK8sNetworkPolicy fields.
+func (_m *BronzeK8sNetworkPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzek8snetworkpolicy.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzek8snetworkpolicy.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzek8snetworkpolicy.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzek8snetworkpolicy.FieldClusterName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_name", values[i])
+			} else if value.Valid {
+				_m.ClusterName = value.String
+			}
+		case bronzek8snetworkpolicy.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				_m.Namespace = value.String
+			}
+		case bronzek8snetworkpolicy.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzek8snetworkpolicy.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				_m.UID = value.String
+			}
+		case bronzek8snetworkpolicy.FieldPodSelectorJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field pod_selector_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PodSelectorJSON); err != nil {
+					return fmt.Errorf("unmarshal field pod_selector_json: %w", err)
+				}
+			}
+		case bronzek8snetworkpolicy.FieldPolicyTypesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_types_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PolicyTypesJSON); err != nil {
+					return fmt.Errorf("unmarshal field policy_types_json: %w", err)
+				}
+			}
+		case bronzek8snetworkpolicy.FieldIngressJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ingress_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.IngressJSON); err != nil {
+					return fmt.Errorf("unmarshal field ingress_json: %w", err)
+				}
+			}
+		case bronzek8snetworkpolicy.FieldEgressJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field egress_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EgressJSON); err != nil {
+					return fmt.Errorf("unmarshal field egress_json: %w", err)
+				}
+			}
+		case bronzek8snetworkpolicy.FieldLabelsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LabelsJSON); err != nil {
+					return fmt.Errorf("unmarshal field labels_json: %w", err)
+				}
+			}
+		case bronzek8snetworkpolicy.FieldAPICreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_created_at", values[i])
+			} else if value.Valid {
+				_m.APICreatedAt = new(time.Time)
+				*_m.APICreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeK8sNetworkPolicy.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeK8sNetworkPolicy) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeK8sNetworkPolicy.
+// Note that you need to call BronzeK8sNetworkPolicy.Unwrap() before calling this method if this BronzeK8sNetworkPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeK8sNetworkPolicy) Update() *BronzeK8sNetworkPolicyUpdateOne {
+	return NewBronzeK8sNetworkPolicyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeK8sNetworkPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeK8sNetworkPolicy) Unwrap() *BronzeK8sNetworkPolicy {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("kubernetes: BronzeK8sNetworkPolicy is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeK8sNetworkPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeK8sNetworkPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("cluster_name=")
+	builder.WriteString(_m.ClusterName)
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(_m.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("uid=")
+	builder.WriteString(_m.UID)
+	builder.WriteString(", ")
+	builder.WriteString("pod_selector_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PodSelectorJSON))
+	builder.WriteString(", ")
+	builder.WriteString("policy_types_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PolicyTypesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("ingress_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IngressJSON))
+	builder.WriteString(", ")
+	builder.WriteString("egress_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EgressJSON))
+	builder.WriteString(", ")
+	builder.WriteString("labels_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LabelsJSON))
+	builder.WriteString(", ")
+	if v := _m.APICreatedAt; v != nil {
+		builder.WriteString("api_created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeK8sNetworkPolicies is a parsable slice of BronzeK8sNetworkPolicy.
+type BronzeK8sNetworkPolicies []*BronzeK8sNetworkPolicy
@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snetworkpolicy"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeK8sNetworkPolicyCreate is the builder for creating a BronzeK8sNetworkPolicy entity.
+type BronzeK8sNetworkPolicyCreate struct {
+	config
+	mutation *BronzeK8sNetworkPolicyMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeK8sNetworkPolicyCreate) SetCollectedAt(v time.Time) *BronzeK8sNetworkPolicyCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeK8sNetworkPolicyCreate) SetFirstCollectedAt(v time.Time) *BronzeK8sNetworkPolicyCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_c *BronzeK8sNetworkPolicyCreate) SetClusterName(v string) *BronzeK8sNetworkPolicyCreate {
+	_c.mutation.SetClusterName(v)
+	return _c
+}
+
+// SetNamespace sets the "namespace" field.
+func (_c *BronzeK8sNetworkPolicyCreate) SetNamespace(v string) *BronzeK8sNetworkPolicyCreate {
+	_c.mutation.SetNamespace(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeK8sNetworkPolicyCreate) SetName(v string) *BronzeK8sNetworkPolicyCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetUID sets the "uid" field.
+func (_c *BronzeK8sNetworkPolicyCreate) SetUID(v string) *BronzeK8sNetworkPolicyCreate {
+	_c.mutation.SetUID(v)
+	return _c
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_c *BronzeK8sNetworkPolicyCreate) SetNillableUID(v *string) *BronzeK8sNetworkPolicyCreate {
+	if v != nil {
+		_c.SetUID(*v)
+	}
+	return _c
+}
+
+// SetPodSelectorJSON sets the "pod_selector_json" field.
+func (_c *BronzeK8sNetworkPolicyCreate) SetPodSelectorJSON(v json.RawMessage) *BronzeK8sNetworkPolicyCreate {
+	_c.mutation.SetPodSelectorJSON(v)
+	return _c
+}
+
+// SetPolicyTypesJSON sets the "policy_types_json" field.
+func (_c *BronzeK8sNetworkPolicyCreate) SetPolicyTypesJSON(v json.RawMessage) *BronzeK8sNetworkPolicyCreate {
+	_c.mutation.SetPolicyTypesJSON(v)
+	return _c
+}
+
+// SetIngressJSON sets the "ingress_json" field.
+func (_c *BronzeK8sNetworkPolicyCreate) SetIngressJSON(v json.RawMessage) *BronzeK8sNetworkPolicyCreate {
+	_c.mutation.SetIngressJSON(v)
+	return _c
+}
+
+// SetEgressJSON sets the "egress_json" field.
+func (_c *BronzeK8sNetworkPolicyCreate) SetEgressJSON(v json.RawMessage) *BronzeK8sNetworkPolicyCreate {
+	_c.mutation.SetEgressJSON(v)
+	return _c
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_c *BronzeK8sNetworkPolicyCreate) SetLabelsJSON(v json.RawMessage) *BronzeK8sNetworkPolicyCreate {
+	_c.mutation.SetLabelsJSON(v)
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeK8sNetworkPolicyCreate) SetAPICreatedAt(v time.Time) *BronzeK8sNetworkPolicyCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeK8sNetworkPolicyCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sNetworkPolicyCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeK8sNetworkPolicyCreate) SetID(v string) *BronzeK8sNetworkPolicyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeK8sNetworkPolicyMutation object of the builder.
+func (_c *BronzeK8sNetworkPolicyCreate) Mutation() *BronzeK8sNetworkPolicyMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeK8sNetworkPolicy in the database.
+func (_c *BronzeK8sNetworkPolicyCreate) Save(ctx context.Context) (*BronzeK8sNetworkPolicy, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeK8sNetworkPolicyCreate) SaveX(ctx context.Context) *BronzeK8sNetworkPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeK8sNetworkPolicyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeK8sNetworkPolicyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeK8sNetworkPolicyCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`kubernetes: missing required field "BronzeK8sNetworkPolicy.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`kubernetes: missing required field "BronzeK8sNetworkPolicy.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ClusterName(); !ok {
+		return &ValidationError{Name: "cluster_name", err: errors.New(`kubernetes: missing required field "BronzeK8sNetworkPolicy.cluster_name"`)}
+	}
+	if v, ok := _c.mutation.ClusterName(); ok {
+		if err := bronzek8snetworkpolicy.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNetworkPolicy.cluster_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`kubernetes: missing required field "BronzeK8sNetworkPolicy.namespace"`)}
+	}
+	if v, ok := _c.mutation.Namespace(); ok {
+		if err := bronzek8snetworkpolicy.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNetworkPolicy.namespace": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`kubernetes: missing required field "BronzeK8sNetworkPolicy.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := bronzek8snetworkpolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNetworkPolicy.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeK8sNetworkPolicyCreate) sqlSave(ctx context.Context) (*BronzeK8sNetworkPolicy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeK8sNetworkPolicy.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeK8sNetworkPolicyCreate) createSpec() (*BronzeK8sNetworkPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeK8sNetworkPolicy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzek8snetworkpolicy.Table, sqlgraph.NewFieldSpec(bronzek8snetworkpolicy.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeK8sNetworkPolicy
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldClusterName, field.TypeString, value)
+		_node.ClusterName = value
+	}
+	if value, ok := _c.mutation.Namespace(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.UID(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldUID, field.TypeString, value)
+		_node.UID = value
+	}
+	if value, ok := _c.mutation.PodSelectorJSON(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldPodSelectorJSON, field.TypeJSON, value)
+		_node.PodSelectorJSON = value
+	}
+	if value, ok := _c.mutation.PolicyTypesJSON(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldPolicyTypesJSON, field.TypeJSON, value)
+		_node.PolicyTypesJSON = value
+	}
+	if value, ok := _c.mutation.IngressJSON(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldIngressJSON, field.TypeJSON, value)
+		_node.IngressJSON = value
+	}
+	if value, ok := _c.mutation.EgressJSON(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldEgressJSON, field.TypeJSON, value)
+		_node.EgressJSON = value
+	}
+	if value, ok := _c.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldLabelsJSON, field.TypeJSON, value)
+		_node.LabelsJSON = value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	return _node, _spec
+}
+
+// BronzeK8sNetworkPolicyCreateBulk is the builder for creating many BronzeK8sNetworkPolicy entities in bulk.
+type BronzeK8sNetworkPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeK8sNetworkPolicyCreate
+}
+
+// Save creates the BronzeK8sNetworkPolicy entities in the database.
+func (_c *BronzeK8sNetworkPolicyCreateBulk) Save(ctx context.Context) ([]*BronzeK8sNetworkPolicy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeK8sNetworkPolicy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeK8sNetworkPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeK8sNetworkPolicyCreateBulk) SaveX(ctx context.Context) []*BronzeK8sNetworkPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeK8sNetworkPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeK8sNetworkPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snetworkpolicy"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeK8sNetworkPolicyDelete is the builder for deleting a BronzeK8sNetworkPolicy entity.
+type BronzeK8sNetworkPolicyDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeK8sNetworkPolicyMutation
+}
+
+// Where appends a list predicates to the BronzeK8sNetworkPolicyDelete builder.
+func (_d *BronzeK8sNetworkPolicyDelete) Where(ps ...predicate.BronzeK8sNetworkPolicy) *BronzeK8sNetworkPolicyDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeK8sNetworkPolicyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeK8sNetworkPolicyDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeK8sNetworkPolicyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzek8snetworkpolicy.Table, sqlgraph.NewFieldSpec(bronzek8snetworkpolicy.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.BronzeK8sNetworkPolicy
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeK8sNetworkPolicyDeleteOne is the builder for deleting a single BronzeK8sNetworkPolicy entity.
+type BronzeK8sNetworkPolicyDeleteOne struct {
+	_d *BronzeK8sNetworkPolicyDelete
+}
+
+// Where appends a list predicates to the BronzeK8sNetworkPolicyDelete builder.
+func (_d *BronzeK8sNetworkPolicyDeleteOne) Where(ps ...predicate.BronzeK8sNetworkPolicy) *BronzeK8sNetworkPolicyDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeK8sNetworkPolicyDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzek8snetworkpolicy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeK8sNetworkPolicyDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,759 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snetworkpolicy"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeK8sNetworkPolicyUpdate is the builder for updating BronzeK8sNetworkPolicy entities.
+type BronzeK8sNetworkPolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeK8sNetworkPolicyMutation
+}
+
+// Where appends a list predicates to the BronzeK8sNetworkPolicyUpdate builder.
+func (_u *BronzeK8sNetworkPolicyUpdate) Where(ps ...predicate.BronzeK8sNetworkPolicy) *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) SetCollectedAt(v time.Time) *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeK8sNetworkPolicyUpdate) SetNillableCollectedAt(v *time.Time) *BronzeK8sNetworkPolicyUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) SetClusterName(v string) *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeK8sNetworkPolicyUpdate) SetNillableClusterName(v *string) *BronzeK8sNetworkPolicyUpdate {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) SetNamespace(v string) *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeK8sNetworkPolicyUpdate) SetNillableNamespace(v *string) *BronzeK8sNetworkPolicyUpdate {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) SetName(v string) *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeK8sNetworkPolicyUpdate) SetNillableName(v *string) *BronzeK8sNetworkPolicyUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) SetUID(v string) *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeK8sNetworkPolicyUpdate) SetNillableUID(v *string) *BronzeK8sNetworkPolicyUpdate {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) ClearUID() *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetPodSelectorJSON sets the "pod_selector_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) SetPodSelectorJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.SetPodSelectorJSON(v)
+	return _u
+}
+
+// AppendPodSelectorJSON appends value to the "pod_selector_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) AppendPodSelectorJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.AppendPodSelectorJSON(v)
+	return _u
+}
+
+// ClearPodSelectorJSON clears the value of the "pod_selector_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) ClearPodSelectorJSON() *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.ClearPodSelectorJSON()
+	return _u
+}
+
+// SetPolicyTypesJSON sets the "policy_types_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) SetPolicyTypesJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.SetPolicyTypesJSON(v)
+	return _u
+}
+
+// AppendPolicyTypesJSON appends value to the "policy_types_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) AppendPolicyTypesJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.AppendPolicyTypesJSON(v)
+	return _u
+}
+
+// ClearPolicyTypesJSON clears the value of the "policy_types_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) ClearPolicyTypesJSON() *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.ClearPolicyTypesJSON()
+	return _u
+}
+
+// SetIngressJSON sets the "ingress_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) SetIngressJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.SetIngressJSON(v)
+	return _u
+}
+
+// AppendIngressJSON appends value to the "ingress_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) AppendIngressJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.AppendIngressJSON(v)
+	return _u
+}
+
+// ClearIngressJSON clears the value of the "ingress_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) ClearIngressJSON() *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.ClearIngressJSON()
+	return _u
+}
+
+// SetEgressJSON sets the "egress_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) SetEgressJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.SetEgressJSON(v)
+	return _u
+}
+
+// AppendEgressJSON appends value to the "egress_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) AppendEgressJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.AppendEgressJSON(v)
+	return _u
+}
+
+// ClearEgressJSON clears the value of the "egress_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) ClearEgressJSON() *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.ClearEgressJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) SetLabelsJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) AppendLabelsJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) ClearLabelsJSON() *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) SetAPICreatedAt(v time.Time) *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeK8sNetworkPolicyUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sNetworkPolicyUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeK8sNetworkPolicyUpdate) ClearAPICreatedAt() *BronzeK8sNetworkPolicyUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeK8sNetworkPolicyMutation object of the builder.
+func (_u *BronzeK8sNetworkPolicyUpdate) Mutation() *BronzeK8sNetworkPolicyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeK8sNetworkPolicyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeK8sNetworkPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeK8sNetworkPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeK8sNetworkPolicyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeK8sNetworkPolicyUpdate) check() error {
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzek8snetworkpolicy.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNetworkPolicy.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Namespace(); ok {
+		if err := bronzek8snetworkpolicy.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNetworkPolicy.namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzek8snetworkpolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNetworkPolicy.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeK8sNetworkPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzek8snetworkpolicy.Table, bronzek8snetworkpolicy.Columns, sqlgraph.NewFieldSpec(bronzek8snetworkpolicy.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzek8snetworkpolicy.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PodSelectorJSON(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldPodSelectorJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPodSelectorJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8snetworkpolicy.FieldPodSelectorJSON, value)
+		})
+	}
+	if _u.mutation.PodSelectorJSONCleared() {
+		_spec.ClearField(bronzek8snetworkpolicy.FieldPodSelectorJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PolicyTypesJSON(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldPolicyTypesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolicyTypesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8snetworkpolicy.FieldPolicyTypesJSON, value)
+		})
+	}
+	if _u.mutation.PolicyTypesJSONCleared() {
+		_spec.ClearField(bronzek8snetworkpolicy.FieldPolicyTypesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IngressJSON(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldIngressJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIngressJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8snetworkpolicy.FieldIngressJSON, value)
+		})
+	}
+	if _u.mutation.IngressJSONCleared() {
+		_spec.ClearField(bronzek8snetworkpolicy.FieldIngressJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EgressJSON(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldEgressJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEgressJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8snetworkpolicy.FieldEgressJSON, value)
+		})
+	}
+	if _u.mutation.EgressJSONCleared() {
+		_spec.ClearField(bronzek8snetworkpolicy.FieldEgressJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8snetworkpolicy.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzek8snetworkpolicy.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzek8snetworkpolicy.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeK8sNetworkPolicy
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzek8snetworkpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeK8sNetworkPolicyUpdateOne is the builder for updating a single BronzeK8sNetworkPolicy entity.
+type BronzeK8sNetworkPolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeK8sNetworkPolicyMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) SetCollectedAt(v time.Time) *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeK8sNetworkPolicyUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) SetClusterName(v string) *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) SetNillableClusterName(v *string) *BronzeK8sNetworkPolicyUpdateOne {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) SetNamespace(v string) *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) SetNillableNamespace(v *string) *BronzeK8sNetworkPolicyUpdateOne {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) SetName(v string) *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) SetNillableName(v *string) *BronzeK8sNetworkPolicyUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) SetUID(v string) *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) SetNillableUID(v *string) *BronzeK8sNetworkPolicyUpdateOne {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) ClearUID() *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetPodSelectorJSON sets the "pod_selector_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) SetPodSelectorJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetPodSelectorJSON(v)
+	return _u
+}
+
+// AppendPodSelectorJSON appends value to the "pod_selector_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) AppendPodSelectorJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.AppendPodSelectorJSON(v)
+	return _u
+}
+
+// ClearPodSelectorJSON clears the value of the "pod_selector_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) ClearPodSelectorJSON() *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.ClearPodSelectorJSON()
+	return _u
+}
+
+// SetPolicyTypesJSON sets the "policy_types_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) SetPolicyTypesJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetPolicyTypesJSON(v)
+	return _u
+}
+
+// AppendPolicyTypesJSON appends value to the "policy_types_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) AppendPolicyTypesJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.AppendPolicyTypesJSON(v)
+	return _u
+}
+
+// ClearPolicyTypesJSON clears the value of the "policy_types_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) ClearPolicyTypesJSON() *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.ClearPolicyTypesJSON()
+	return _u
+}
+
+// SetIngressJSON sets the "ingress_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) SetIngressJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetIngressJSON(v)
+	return _u
+}
+
+// AppendIngressJSON appends value to the "ingress_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) AppendIngressJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.AppendIngressJSON(v)
+	return _u
+}
+
+// ClearIngressJSON clears the value of the "ingress_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) ClearIngressJSON() *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.ClearIngressJSON()
+	return _u
+}
+
+// SetEgressJSON sets the "egress_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) SetEgressJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetEgressJSON(v)
+	return _u
+}
+
+// AppendEgressJSON appends value to the "egress_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) AppendEgressJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.AppendEgressJSON(v)
+	return _u
+}
+
+// ClearEgressJSON clears the value of the "egress_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) ClearEgressJSON() *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.ClearEgressJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) SetLabelsJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) AppendLabelsJSON(v json.RawMessage) *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) ClearLabelsJSON() *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) SetAPICreatedAt(v time.Time) *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sNetworkPolicyUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) ClearAPICreatedAt() *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeK8sNetworkPolicyMutation object of the builder.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) Mutation() *BronzeK8sNetworkPolicyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeK8sNetworkPolicyUpdate builder.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) Where(ps ...predicate.BronzeK8sNetworkPolicy) *BronzeK8sNetworkPolicyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) Select(field string, fields ...string) *BronzeK8sNetworkPolicyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeK8sNetworkPolicy entity.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) Save(ctx context.Context) (*BronzeK8sNetworkPolicy, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) SaveX(ctx context.Context) *BronzeK8sNetworkPolicy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeK8sNetworkPolicyUpdateOne) check() error {
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzek8snetworkpolicy.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNetworkPolicy.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Namespace(); ok {
+		if err := bronzek8snetworkpolicy.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNetworkPolicy.namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzek8snetworkpolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNetworkPolicy.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeK8sNetworkPolicyUpdateOne) sqlSave(ctx context.Context) (_node *BronzeK8sNetworkPolicy, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzek8snetworkpolicy.Table, bronzek8snetworkpolicy.Columns, sqlgraph.NewFieldSpec(bronzek8snetworkpolicy.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`kubernetes: missing "BronzeK8sNetworkPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzek8snetworkpolicy.FieldID)
+		for _, f := range fields {
+			if !bronzek8snetworkpolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("kubernetes: invalid field %q for query", f)}
+			}
+			if f != bronzek8snetworkpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzek8snetworkpolicy.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PodSelectorJSON(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldPodSelectorJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPodSelectorJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8snetworkpolicy.FieldPodSelectorJSON, value)
+		})
+	}
+	if _u.mutation.PodSelectorJSONCleared() {
+		_spec.ClearField(bronzek8snetworkpolicy.FieldPodSelectorJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PolicyTypesJSON(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldPolicyTypesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolicyTypesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8snetworkpolicy.FieldPolicyTypesJSON, value)
+		})
+	}
+	if _u.mutation.PolicyTypesJSONCleared() {
+		_spec.ClearField(bronzek8snetworkpolicy.FieldPolicyTypesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IngressJSON(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldIngressJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIngressJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8snetworkpolicy.FieldIngressJSON, value)
+		})
+	}
+	if _u.mutation.IngressJSONCleared() {
+		_spec.ClearField(bronzek8snetworkpolicy.FieldIngressJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EgressJSON(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldEgressJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEgressJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8snetworkpolicy.FieldEgressJSON, value)
+		})
+	}
+	if _u.mutation.EgressJSONCleared() {
+		_spec.ClearField(bronzek8snetworkpolicy.FieldEgressJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8snetworkpolicy.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzek8snetworkpolicy.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8snetworkpolicy.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzek8snetworkpolicy.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeK8sNetworkPolicy
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeK8sNetworkPolicy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzek8snetworkpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
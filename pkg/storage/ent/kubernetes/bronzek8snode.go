@@ -0,0 +1,348 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snode"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeK8sNode is the model entity for the BronzeK8sNode schema.
+type BronzeK8sNode struct {
+	config `json:"-"`
+	// ID of the ent.
+	// {cluster_name}/{name}
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// ClusterName holds the value of the "cluster_name" field.
+	ClusterName string `json:"cluster_name,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// spec.providerID, e.g. gce://{project}/{zone}/{name} or digitalocean://{droplet_id}
+	ProviderID string `json:"provider_id,omitempty"`
+	// InternalIP holds the value of the "internal_ip" field.
+	InternalIP string `json:"internal_ip,omitempty"`
+	// ExternalIP holds the value of the "external_ip" field.
+	ExternalIP string `json:"external_ip,omitempty"`
+	// Node pool from the managed-cluster node pool label
+	NodePool string `json:"node_pool,omitempty"`
+	// InstanceType holds the value of the "instance_type" field.
+	InstanceType string `json:"instance_type,omitempty"`
+	// Region holds the value of the "region" field.
+	Region string `json:"region,omitempty"`
+	// Zone holds the value of the "zone" field.
+	Zone string `json:"zone,omitempty"`
+	// KubeletVersion holds the value of the "kubelet_version" field.
+	KubeletVersion string `json:"kubelet_version,omitempty"`
+	// OsImage holds the value of the "os_image" field.
+	OsImage string `json:"os_image,omitempty"`
+	// KernelVersion holds the value of the "kernel_version" field.
+	KernelVersion string `json:"kernel_version,omitempty"`
+	// ContainerRuntime holds the value of the "container_runtime" field.
+	ContainerRuntime string `json:"container_runtime,omitempty"`
+	// Architecture holds the value of the "architecture" field.
+	Architecture string `json:"architecture,omitempty"`
+	// Ready holds the value of the "ready" field.
+	Ready bool `json:"ready,omitempty"`
+	// Unschedulable holds the value of the "unschedulable" field.
+	Unschedulable bool `json:"unschedulable,omitempty"`
+	// LabelsJSON holds the value of the "labels_json" field.
+	LabelsJSON json.RawMessage `json:"labels_json,omitempty"`
+	// TaintsJSON holds the value of the "taints_json" field.
+	TaintsJSON json.RawMessage `json:"taints_json,omitempty"`
+	// APICreatedAt holds the value of the "api_created_at" field.
+	APICreatedAt *time.Time `json:"api_created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeK8sNode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzek8snode.FieldLabelsJSON, bronzek8snode.FieldTaintsJSON:
+			values[i] = new([]byte)
+		case bronzek8snode.FieldReady, bronzek8snode.FieldUnschedulable:
+			values[i] = new(sql.NullBool)
+		case bronzek8snode.FieldID, bronzek8snode.FieldClusterName, bronzek8snode.FieldName, bronzek8snode.FieldUID, bronzek8snode.FieldProviderID, bronzek8snode.FieldInternalIP, bronzek8snode.FieldExternalIP, bronzek8snode.FieldNodePool, bronzek8snode.FieldInstanceType, bronzek8snode.FieldRegion, bronzek8snode.FieldZone, bronzek8snode.FieldKubeletVersion, bronzek8snode.FieldOsImage, bronzek8snode.FieldKernelVersion, bronzek8snode.FieldContainerRuntime, bronzek8snode.FieldArchitecture:
+			values[i] = new(sql.NullString)
+		case bronzek8snode.FieldCollectedAt, bronzek8snode.FieldFirstCollectedAt, bronzek8snode.FieldAPICreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeK8sNode fields.
+func (_m *BronzeK8sNode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzek8snode.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzek8snode.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzek8snode.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzek8snode.FieldClusterName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_name", values[i])
+			} else if value.Valid {
+				_m.ClusterName = value.String
+			}
+		case bronzek8snode.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzek8snode.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				_m.UID = value.String
+			}
+		case bronzek8snode.FieldProviderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_id", values[i])
+			} else if value.Valid {
+				_m.ProviderID = value.String
+			}
+		case bronzek8snode.FieldInternalIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field internal_ip", values[i])
+			} else if value.Valid {
+				_m.InternalIP = value.String
+			}
+		case bronzek8snode.FieldExternalIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_ip", values[i])
+			} else if value.Valid {
+				_m.ExternalIP = value.String
+			}
+		case bronzek8snode.FieldNodePool:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field node_pool", values[i])
+			} else if value.Valid {
+				_m.NodePool = value.String
+			}
+		case bronzek8snode.FieldInstanceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field instance_type", values[i])
+			} else if value.Valid {
+				_m.InstanceType = value.String
+			}
+		case bronzek8snode.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		case bronzek8snode.FieldZone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field zone", values[i])
+			} else if value.Valid {
+				_m.Zone = value.String
+			}
+		case bronzek8snode.FieldKubeletVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kubelet_version", values[i])
+			} else if value.Valid {
+				_m.KubeletVersion = value.String
+			}
+		case bronzek8snode.FieldOsImage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field os_image", values[i])
+			} else if value.Valid {
+				_m.OsImage = value.String
+			}
+		case bronzek8snode.FieldKernelVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kernel_version", values[i])
+			} else if value.Valid {
+				_m.KernelVersion = value.String
+			}
+		case bronzek8snode.FieldContainerRuntime:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field container_runtime", values[i])
+			} else if value.Valid {
+				_m.ContainerRuntime = value.String
+			}
+		case bronzek8snode.FieldArchitecture:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field architecture", values[i])
+			} else if value.Valid {
+				_m.Architecture = value.String
+			}
+		case bronzek8snode.FieldReady:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field ready", values[i])
+			} else if value.Valid {
+				_m.Ready = value.Bool
+			}
+		case bronzek8snode.FieldUnschedulable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field unschedulable", values[i])
+			} else if value.Valid {
+				_m.Unschedulable = value.Bool
+			}
+		case bronzek8snode.FieldLabelsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LabelsJSON); err != nil {
+					return fmt.Errorf("unmarshal field labels_json: %w", err)
+				}
+			}
+		case bronzek8snode.FieldTaintsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field taints_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TaintsJSON); err != nil {
+					return fmt.Errorf("unmarshal field taints_json: %w", err)
+				}
+			}
+		case bronzek8snode.FieldAPICreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_created_at", values[i])
+			} else if value.Valid {
+				_m.APICreatedAt = new(time.Time)
+				*_m.APICreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeK8sNode.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeK8sNode) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeK8sNode.
+// Note that you need to call BronzeK8sNode.Unwrap() before calling this method if this BronzeK8sNode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeK8sNode) Update() *BronzeK8sNodeUpdateOne {
+	return NewBronzeK8sNodeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeK8sNode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeK8sNode) Unwrap() *BronzeK8sNode {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("kubernetes: BronzeK8sNode is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeK8sNode) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeK8sNode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("cluster_name=")
+	builder.WriteString(_m.ClusterName)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("uid=")
+	builder.WriteString(_m.UID)
+	builder.WriteString(", ")
+	builder.WriteString("provider_id=")
+	builder.WriteString(_m.ProviderID)
+	builder.WriteString(", ")
+	builder.WriteString("internal_ip=")
+	builder.WriteString(_m.InternalIP)
+	builder.WriteString(", ")
+	builder.WriteString("external_ip=")
+	builder.WriteString(_m.ExternalIP)
+	builder.WriteString(", ")
+	builder.WriteString("node_pool=")
+	builder.WriteString(_m.NodePool)
+	builder.WriteString(", ")
+	builder.WriteString("instance_type=")
+	builder.WriteString(_m.InstanceType)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteString(", ")
+	builder.WriteString("zone=")
+	builder.WriteString(_m.Zone)
+	builder.WriteString(", ")
+	builder.WriteString("kubelet_version=")
+	builder.WriteString(_m.KubeletVersion)
+	builder.WriteString(", ")
+	builder.WriteString("os_image=")
+	builder.WriteString(_m.OsImage)
+	builder.WriteString(", ")
+	builder.WriteString("kernel_version=")
+	builder.WriteString(_m.KernelVersion)
+	builder.WriteString(", ")
+	builder.WriteString("container_runtime=")
+	builder.WriteString(_m.ContainerRuntime)
+	builder.WriteString(", ")
+	builder.WriteString("architecture=")
+	builder.WriteString(_m.Architecture)
+	builder.WriteString(", ")
+	builder.WriteString("ready=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Ready))
+	builder.WriteString(", ")
+	builder.WriteString("unschedulable=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Unschedulable))
+	builder.WriteString(", ")
+	builder.WriteString("labels_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LabelsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("taints_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaintsJSON))
+	builder.WriteString(", ")
+	if v := _m.APICreatedAt; v != nil {
+		builder.WriteString("api_created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeK8sNodes is a parsable slice of BronzeK8sNode.
+type BronzeK8sNodes []*BronzeK8sNode
@@ -0,0 +1,1455 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzek8snode
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ClusterName applies equality check predicate on the "cluster_name" field. It's identical to ClusterNameEQ.
+func ClusterName(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldClusterName, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldName, v))
+}
+
+// UID applies equality check predicate on the "uid" field. It's identical to UIDEQ.
+func UID(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldUID, v))
+}
+
+// ProviderID applies equality check predicate on the "provider_id" field. It's identical to ProviderIDEQ.
+func ProviderID(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldProviderID, v))
+}
+
+// InternalIP applies equality check predicate on the "internal_ip" field. It's identical to InternalIPEQ.
+func InternalIP(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldInternalIP, v))
+}
+
+// ExternalIP applies equality check predicate on the "external_ip" field. It's identical to ExternalIPEQ.
+func ExternalIP(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldExternalIP, v))
+}
+
+// NodePool applies equality check predicate on the "node_pool" field. It's identical to NodePoolEQ.
+func NodePool(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldNodePool, v))
+}
+
+// InstanceType applies equality check predicate on the "instance_type" field. It's identical to InstanceTypeEQ.
+func InstanceType(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldInstanceType, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldRegion, v))
+}
+
+// Zone applies equality check predicate on the "zone" field. It's identical to ZoneEQ.
+func Zone(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldZone, v))
+}
+
+// KubeletVersion applies equality check predicate on the "kubelet_version" field. It's identical to KubeletVersionEQ.
+func KubeletVersion(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldKubeletVersion, v))
+}
+
+// OsImage applies equality check predicate on the "os_image" field. It's identical to OsImageEQ.
+func OsImage(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldOsImage, v))
+}
+
+// KernelVersion applies equality check predicate on the "kernel_version" field. It's identical to KernelVersionEQ.
+func KernelVersion(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldKernelVersion, v))
+}
+
+// ContainerRuntime applies equality check predicate on the "container_runtime" field. It's identical to ContainerRuntimeEQ.
+func ContainerRuntime(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldContainerRuntime, v))
+}
+
+// Architecture applies equality check predicate on the "architecture" field. It's identical to ArchitectureEQ.
+func Architecture(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldArchitecture, v))
+}
+
+// Ready applies equality check predicate on the "ready" field. It's identical to ReadyEQ.
+func Ready(v bool) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldReady, v))
+}
+
+// Unschedulable applies equality check predicate on the "unschedulable" field. It's identical to UnschedulableEQ.
+func Unschedulable(v bool) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldUnschedulable, v))
+}
+
+// APICreatedAt applies equality check predicate on the "api_created_at" field. It's identical to APICreatedAtEQ.
+func APICreatedAt(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ClusterNameEQ applies the EQ predicate on the "cluster_name" field.
+func ClusterNameEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldClusterName, v))
+}
+
+// ClusterNameNEQ applies the NEQ predicate on the "cluster_name" field.
+func ClusterNameNEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldClusterName, v))
+}
+
+// ClusterNameIn applies the In predicate on the "cluster_name" field.
+func ClusterNameIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIn(FieldClusterName, vs...))
+}
+
+// ClusterNameNotIn applies the NotIn predicate on the "cluster_name" field.
+func ClusterNameNotIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotIn(FieldClusterName, vs...))
+}
+
+// ClusterNameGT applies the GT predicate on the "cluster_name" field.
+func ClusterNameGT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGT(FieldClusterName, v))
+}
+
+// ClusterNameGTE applies the GTE predicate on the "cluster_name" field.
+func ClusterNameGTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGTE(FieldClusterName, v))
+}
+
+// ClusterNameLT applies the LT predicate on the "cluster_name" field.
+func ClusterNameLT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLT(FieldClusterName, v))
+}
+
+// ClusterNameLTE applies the LTE predicate on the "cluster_name" field.
+func ClusterNameLTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLTE(FieldClusterName, v))
+}
+
+// ClusterNameContains applies the Contains predicate on the "cluster_name" field.
+func ClusterNameContains(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContains(FieldClusterName, v))
+}
+
+// ClusterNameHasPrefix applies the HasPrefix predicate on the "cluster_name" field.
+func ClusterNameHasPrefix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasPrefix(FieldClusterName, v))
+}
+
+// ClusterNameHasSuffix applies the HasSuffix predicate on the "cluster_name" field.
+func ClusterNameHasSuffix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasSuffix(FieldClusterName, v))
+}
+
+// ClusterNameEqualFold applies the EqualFold predicate on the "cluster_name" field.
+func ClusterNameEqualFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEqualFold(FieldClusterName, v))
+}
+
+// ClusterNameContainsFold applies the ContainsFold predicate on the "cluster_name" field.
+func ClusterNameContainsFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContainsFold(FieldClusterName, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContainsFold(FieldName, v))
+}
+
+// UIDEQ applies the EQ predicate on the "uid" field.
+func UIDEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldUID, v))
+}
+
+// UIDNEQ applies the NEQ predicate on the "uid" field.
+func UIDNEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldUID, v))
+}
+
+// UIDIn applies the In predicate on the "uid" field.
+func UIDIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIn(FieldUID, vs...))
+}
+
+// UIDNotIn applies the NotIn predicate on the "uid" field.
+func UIDNotIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotIn(FieldUID, vs...))
+}
+
+// UIDGT applies the GT predicate on the "uid" field.
+func UIDGT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGT(FieldUID, v))
+}
+
+// UIDGTE applies the GTE predicate on the "uid" field.
+func UIDGTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGTE(FieldUID, v))
+}
+
+// UIDLT applies the LT predicate on the "uid" field.
+func UIDLT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLT(FieldUID, v))
+}
+
+// UIDLTE applies the LTE predicate on the "uid" field.
+func UIDLTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLTE(FieldUID, v))
+}
+
+// UIDContains applies the Contains predicate on the "uid" field.
+func UIDContains(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContains(FieldUID, v))
+}
+
+// UIDHasPrefix applies the HasPrefix predicate on the "uid" field.
+func UIDHasPrefix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasPrefix(FieldUID, v))
+}
+
+// UIDHasSuffix applies the HasSuffix predicate on the "uid" field.
+func UIDHasSuffix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasSuffix(FieldUID, v))
+}
+
+// UIDIsNil applies the IsNil predicate on the "uid" field.
+func UIDIsNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIsNull(FieldUID))
+}
+
+// UIDNotNil applies the NotNil predicate on the "uid" field.
+func UIDNotNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotNull(FieldUID))
+}
+
+// UIDEqualFold applies the EqualFold predicate on the "uid" field.
+func UIDEqualFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEqualFold(FieldUID, v))
+}
+
+// UIDContainsFold applies the ContainsFold predicate on the "uid" field.
+func UIDContainsFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContainsFold(FieldUID, v))
+}
+
+// ProviderIDEQ applies the EQ predicate on the "provider_id" field.
+func ProviderIDEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldProviderID, v))
+}
+
+// ProviderIDNEQ applies the NEQ predicate on the "provider_id" field.
+func ProviderIDNEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldProviderID, v))
+}
+
+// ProviderIDIn applies the In predicate on the "provider_id" field.
+func ProviderIDIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIn(FieldProviderID, vs...))
+}
+
+// ProviderIDNotIn applies the NotIn predicate on the "provider_id" field.
+func ProviderIDNotIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotIn(FieldProviderID, vs...))
+}
+
+// ProviderIDGT applies the GT predicate on the "provider_id" field.
+func ProviderIDGT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGT(FieldProviderID, v))
+}
+
+// ProviderIDGTE applies the GTE predicate on the "provider_id" field.
+func ProviderIDGTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGTE(FieldProviderID, v))
+}
+
+// ProviderIDLT applies the LT predicate on the "provider_id" field.
+func ProviderIDLT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLT(FieldProviderID, v))
+}
+
+// ProviderIDLTE applies the LTE predicate on the "provider_id" field.
+func ProviderIDLTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLTE(FieldProviderID, v))
+}
+
+// ProviderIDContains applies the Contains predicate on the "provider_id" field.
+func ProviderIDContains(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContains(FieldProviderID, v))
+}
+
+// ProviderIDHasPrefix applies the HasPrefix predicate on the "provider_id" field.
+func ProviderIDHasPrefix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasPrefix(FieldProviderID, v))
+}
+
+// ProviderIDHasSuffix applies the HasSuffix predicate on the "provider_id" field.
+func ProviderIDHasSuffix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasSuffix(FieldProviderID, v))
+}
+
+// ProviderIDIsNil applies the IsNil predicate on the "provider_id" field.
+func ProviderIDIsNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIsNull(FieldProviderID))
+}
+
+// ProviderIDNotNil applies the NotNil predicate on the "provider_id" field.
+func ProviderIDNotNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotNull(FieldProviderID))
+}
+
+// ProviderIDEqualFold applies the EqualFold predicate on the "provider_id" field.
+func ProviderIDEqualFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEqualFold(FieldProviderID, v))
+}
+
+// ProviderIDContainsFold applies the ContainsFold predicate on the "provider_id" field.
+func ProviderIDContainsFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContainsFold(FieldProviderID, v))
+}
+
+// InternalIPEQ applies the EQ predicate on the "internal_ip" field.
+func InternalIPEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldInternalIP, v))
+}
+
+// InternalIPNEQ applies the NEQ predicate on the "internal_ip" field.
+func InternalIPNEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldInternalIP, v))
+}
+
+// InternalIPIn applies the In predicate on the "internal_ip" field.
+func InternalIPIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIn(FieldInternalIP, vs...))
+}
+
+// InternalIPNotIn applies the NotIn predicate on the "internal_ip" field.
+func InternalIPNotIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotIn(FieldInternalIP, vs...))
+}
+
+// InternalIPGT applies the GT predicate on the "internal_ip" field.
+func InternalIPGT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGT(FieldInternalIP, v))
+}
+
+// InternalIPGTE applies the GTE predicate on the "internal_ip" field.
+func InternalIPGTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGTE(FieldInternalIP, v))
+}
+
+// InternalIPLT applies the LT predicate on the "internal_ip" field.
+func InternalIPLT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLT(FieldInternalIP, v))
+}
+
+// InternalIPLTE applies the LTE predicate on the "internal_ip" field.
+func InternalIPLTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLTE(FieldInternalIP, v))
+}
+
+// InternalIPContains applies the Contains predicate on the "internal_ip" field.
+func InternalIPContains(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContains(FieldInternalIP, v))
+}
+
+// InternalIPHasPrefix applies the HasPrefix predicate on the "internal_ip" field.
+func InternalIPHasPrefix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasPrefix(FieldInternalIP, v))
+}
+
+// InternalIPHasSuffix applies the HasSuffix predicate on the "internal_ip" field.
+func InternalIPHasSuffix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasSuffix(FieldInternalIP, v))
+}
+
+// InternalIPIsNil applies the IsNil predicate on the "internal_ip" field.
+func InternalIPIsNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIsNull(FieldInternalIP))
+}
+
+// InternalIPNotNil applies the NotNil predicate on the "internal_ip" field.
+func InternalIPNotNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotNull(FieldInternalIP))
+}
+
+// InternalIPEqualFold applies the EqualFold predicate on the "internal_ip" field.
+func InternalIPEqualFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEqualFold(FieldInternalIP, v))
+}
+
+// InternalIPContainsFold applies the ContainsFold predicate on the "internal_ip" field.
+func InternalIPContainsFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContainsFold(FieldInternalIP, v))
+}
+
+// ExternalIPEQ applies the EQ predicate on the "external_ip" field.
+func ExternalIPEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldExternalIP, v))
+}
+
+// ExternalIPNEQ applies the NEQ predicate on the "external_ip" field.
+func ExternalIPNEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldExternalIP, v))
+}
+
+// ExternalIPIn applies the In predicate on the "external_ip" field.
+func ExternalIPIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIn(FieldExternalIP, vs...))
+}
+
+// ExternalIPNotIn applies the NotIn predicate on the "external_ip" field.
+func ExternalIPNotIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotIn(FieldExternalIP, vs...))
+}
+
+// ExternalIPGT applies the GT predicate on the "external_ip" field.
+func ExternalIPGT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGT(FieldExternalIP, v))
+}
+
+// ExternalIPGTE applies the GTE predicate on the "external_ip" field.
+func ExternalIPGTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGTE(FieldExternalIP, v))
+}
+
+// ExternalIPLT applies the LT predicate on the "external_ip" field.
+func ExternalIPLT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLT(FieldExternalIP, v))
+}
+
+// ExternalIPLTE applies the LTE predicate on the "external_ip" field.
+func ExternalIPLTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLTE(FieldExternalIP, v))
+}
+
+// ExternalIPContains applies the Contains predicate on the "external_ip" field.
+func ExternalIPContains(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContains(FieldExternalIP, v))
+}
+
+// ExternalIPHasPrefix applies the HasPrefix predicate on the "external_ip" field.
+func ExternalIPHasPrefix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasPrefix(FieldExternalIP, v))
+}
+
+// ExternalIPHasSuffix applies the HasSuffix predicate on the "external_ip" field.
+func ExternalIPHasSuffix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasSuffix(FieldExternalIP, v))
+}
+
+// ExternalIPIsNil applies the IsNil predicate on the "external_ip" field.
+func ExternalIPIsNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIsNull(FieldExternalIP))
+}
+
+// ExternalIPNotNil applies the NotNil predicate on the "external_ip" field.
+func ExternalIPNotNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotNull(FieldExternalIP))
+}
+
+// ExternalIPEqualFold applies the EqualFold predicate on the "external_ip" field.
+func ExternalIPEqualFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEqualFold(FieldExternalIP, v))
+}
+
+// ExternalIPContainsFold applies the ContainsFold predicate on the "external_ip" field.
+func ExternalIPContainsFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContainsFold(FieldExternalIP, v))
+}
+
+// NodePoolEQ applies the EQ predicate on the "node_pool" field.
+func NodePoolEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldNodePool, v))
+}
+
+// NodePoolNEQ applies the NEQ predicate on the "node_pool" field.
+func NodePoolNEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldNodePool, v))
+}
+
+// NodePoolIn applies the In predicate on the "node_pool" field.
+func NodePoolIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIn(FieldNodePool, vs...))
+}
+
+// NodePoolNotIn applies the NotIn predicate on the "node_pool" field.
+func NodePoolNotIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotIn(FieldNodePool, vs...))
+}
+
+// NodePoolGT applies the GT predicate on the "node_pool" field.
+func NodePoolGT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGT(FieldNodePool, v))
+}
+
+// NodePoolGTE applies the GTE predicate on the "node_pool" field.
+func NodePoolGTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGTE(FieldNodePool, v))
+}
+
+// NodePoolLT applies the LT predicate on the "node_pool" field.
+func NodePoolLT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLT(FieldNodePool, v))
+}
+
+// NodePoolLTE applies the LTE predicate on the "node_pool" field.
+func NodePoolLTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLTE(FieldNodePool, v))
+}
+
+// NodePoolContains applies the Contains predicate on the "node_pool" field.
+func NodePoolContains(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContains(FieldNodePool, v))
+}
+
+// NodePoolHasPrefix applies the HasPrefix predicate on the "node_pool" field.
+func NodePoolHasPrefix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasPrefix(FieldNodePool, v))
+}
+
+// NodePoolHasSuffix applies the HasSuffix predicate on the "node_pool" field.
+func NodePoolHasSuffix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasSuffix(FieldNodePool, v))
+}
+
+// NodePoolIsNil applies the IsNil predicate on the "node_pool" field.
+func NodePoolIsNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIsNull(FieldNodePool))
+}
+
+// NodePoolNotNil applies the NotNil predicate on the "node_pool" field.
+func NodePoolNotNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotNull(FieldNodePool))
+}
+
+// NodePoolEqualFold applies the EqualFold predicate on the "node_pool" field.
+func NodePoolEqualFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEqualFold(FieldNodePool, v))
+}
+
+// NodePoolContainsFold applies the ContainsFold predicate on the "node_pool" field.
+func NodePoolContainsFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContainsFold(FieldNodePool, v))
+}
+
+// InstanceTypeEQ applies the EQ predicate on the "instance_type" field.
+func InstanceTypeEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldInstanceType, v))
+}
+
+// InstanceTypeNEQ applies the NEQ predicate on the "instance_type" field.
+func InstanceTypeNEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldInstanceType, v))
+}
+
+// InstanceTypeIn applies the In predicate on the "instance_type" field.
+func InstanceTypeIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIn(FieldInstanceType, vs...))
+}
+
+// InstanceTypeNotIn applies the NotIn predicate on the "instance_type" field.
+func InstanceTypeNotIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotIn(FieldInstanceType, vs...))
+}
+
+// InstanceTypeGT applies the GT predicate on the "instance_type" field.
+func InstanceTypeGT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGT(FieldInstanceType, v))
+}
+
+// InstanceTypeGTE applies the GTE predicate on the "instance_type" field.
+func InstanceTypeGTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGTE(FieldInstanceType, v))
+}
+
+// InstanceTypeLT applies the LT predicate on the "instance_type" field.
+func InstanceTypeLT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLT(FieldInstanceType, v))
+}
+
+// InstanceTypeLTE applies the LTE predicate on the "instance_type" field.
+func InstanceTypeLTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLTE(FieldInstanceType, v))
+}
+
+// InstanceTypeContains applies the Contains predicate on the "instance_type" field.
+func InstanceTypeContains(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContains(FieldInstanceType, v))
+}
+
+// InstanceTypeHasPrefix applies the HasPrefix predicate on the "instance_type" field.
+func InstanceTypeHasPrefix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasPrefix(FieldInstanceType, v))
+}
+
+// InstanceTypeHasSuffix applies the HasSuffix predicate on the "instance_type" field.
+func InstanceTypeHasSuffix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasSuffix(FieldInstanceType, v))
+}
+
+// InstanceTypeIsNil applies the IsNil predicate on the "instance_type" field.
+func InstanceTypeIsNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIsNull(FieldInstanceType))
+}
+
+// InstanceTypeNotNil applies the NotNil predicate on the "instance_type" field.
+func InstanceTypeNotNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotNull(FieldInstanceType))
+}
+
+// InstanceTypeEqualFold applies the EqualFold predicate on the "instance_type" field.
+func InstanceTypeEqualFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEqualFold(FieldInstanceType, v))
+}
+
+// InstanceTypeContainsFold applies the ContainsFold predicate on the "instance_type" field.
+func InstanceTypeContainsFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContainsFold(FieldInstanceType, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionIsNil applies the IsNil predicate on the "region" field.
+func RegionIsNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIsNull(FieldRegion))
+}
+
+// RegionNotNil applies the NotNil predicate on the "region" field.
+func RegionNotNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotNull(FieldRegion))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// ZoneEQ applies the EQ predicate on the "zone" field.
+func ZoneEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldZone, v))
+}
+
+// ZoneNEQ applies the NEQ predicate on the "zone" field.
+func ZoneNEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldZone, v))
+}
+
+// ZoneIn applies the In predicate on the "zone" field.
+func ZoneIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIn(FieldZone, vs...))
+}
+
+// ZoneNotIn applies the NotIn predicate on the "zone" field.
+func ZoneNotIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotIn(FieldZone, vs...))
+}
+
+// ZoneGT applies the GT predicate on the "zone" field.
+func ZoneGT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGT(FieldZone, v))
+}
+
+// ZoneGTE applies the GTE predicate on the "zone" field.
+func ZoneGTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGTE(FieldZone, v))
+}
+
+// ZoneLT applies the LT predicate on the "zone" field.
+func ZoneLT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLT(FieldZone, v))
+}
+
+// ZoneLTE applies the LTE predicate on the "zone" field.
+func ZoneLTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLTE(FieldZone, v))
+}
+
+// ZoneContains applies the Contains predicate on the "zone" field.
+func ZoneContains(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContains(FieldZone, v))
+}
+
+// ZoneHasPrefix applies the HasPrefix predicate on the "zone" field.
+func ZoneHasPrefix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasPrefix(FieldZone, v))
+}
+
+// ZoneHasSuffix applies the HasSuffix predicate on the "zone" field.
+func ZoneHasSuffix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasSuffix(FieldZone, v))
+}
+
+// ZoneIsNil applies the IsNil predicate on the "zone" field.
+func ZoneIsNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIsNull(FieldZone))
+}
+
+// ZoneNotNil applies the NotNil predicate on the "zone" field.
+func ZoneNotNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotNull(FieldZone))
+}
+
+// ZoneEqualFold applies the EqualFold predicate on the "zone" field.
+func ZoneEqualFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEqualFold(FieldZone, v))
+}
+
+// ZoneContainsFold applies the ContainsFold predicate on the "zone" field.
+func ZoneContainsFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContainsFold(FieldZone, v))
+}
+
+// KubeletVersionEQ applies the EQ predicate on the "kubelet_version" field.
+func KubeletVersionEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldKubeletVersion, v))
+}
+
+// KubeletVersionNEQ applies the NEQ predicate on the "kubelet_version" field.
+func KubeletVersionNEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldKubeletVersion, v))
+}
+
+// KubeletVersionIn applies the In predicate on the "kubelet_version" field.
+func KubeletVersionIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIn(FieldKubeletVersion, vs...))
+}
+
+// KubeletVersionNotIn applies the NotIn predicate on the "kubelet_version" field.
+func KubeletVersionNotIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotIn(FieldKubeletVersion, vs...))
+}
+
+// KubeletVersionGT applies the GT predicate on the "kubelet_version" field.
+func KubeletVersionGT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGT(FieldKubeletVersion, v))
+}
+
+// KubeletVersionGTE applies the GTE predicate on the "kubelet_version" field.
+func KubeletVersionGTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGTE(FieldKubeletVersion, v))
+}
+
+// KubeletVersionLT applies the LT predicate on the "kubelet_version" field.
+func KubeletVersionLT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLT(FieldKubeletVersion, v))
+}
+
+// KubeletVersionLTE applies the LTE predicate on the "kubelet_version" field.
+func KubeletVersionLTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLTE(FieldKubeletVersion, v))
+}
+
+// KubeletVersionContains applies the Contains predicate on the "kubelet_version" field.
+func KubeletVersionContains(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContains(FieldKubeletVersion, v))
+}
+
+// KubeletVersionHasPrefix applies the HasPrefix predicate on the "kubelet_version" field.
+func KubeletVersionHasPrefix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasPrefix(FieldKubeletVersion, v))
+}
+
+// KubeletVersionHasSuffix applies the HasSuffix predicate on the "kubelet_version" field.
+func KubeletVersionHasSuffix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasSuffix(FieldKubeletVersion, v))
+}
+
+// KubeletVersionIsNil applies the IsNil predicate on the "kubelet_version" field.
+func KubeletVersionIsNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIsNull(FieldKubeletVersion))
+}
+
+// KubeletVersionNotNil applies the NotNil predicate on the "kubelet_version" field.
+func KubeletVersionNotNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotNull(FieldKubeletVersion))
+}
+
+// KubeletVersionEqualFold applies the EqualFold predicate on the "kubelet_version" field.
+func KubeletVersionEqualFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEqualFold(FieldKubeletVersion, v))
+}
+
+// KubeletVersionContainsFold applies the ContainsFold predicate on the "kubelet_version" field.
+func KubeletVersionContainsFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContainsFold(FieldKubeletVersion, v))
+}
+
+// OsImageEQ applies the EQ predicate on the "os_image" field.
+func OsImageEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldOsImage, v))
+}
+
+// OsImageNEQ applies the NEQ predicate on the "os_image" field.
+func OsImageNEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldOsImage, v))
+}
+
+// OsImageIn applies the In predicate on the "os_image" field.
+func OsImageIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIn(FieldOsImage, vs...))
+}
+
+// OsImageNotIn applies the NotIn predicate on the "os_image" field.
+func OsImageNotIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotIn(FieldOsImage, vs...))
+}
+
+// OsImageGT applies the GT predicate on the "os_image" field.
+func OsImageGT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGT(FieldOsImage, v))
+}
+
+// OsImageGTE applies the GTE predicate on the "os_image" field.
+func OsImageGTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGTE(FieldOsImage, v))
+}
+
+// OsImageLT applies the LT predicate on the "os_image" field.
+func OsImageLT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLT(FieldOsImage, v))
+}
+
+// OsImageLTE applies the LTE predicate on the "os_image" field.
+func OsImageLTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLTE(FieldOsImage, v))
+}
+
+// OsImageContains applies the Contains predicate on the "os_image" field.
+func OsImageContains(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContains(FieldOsImage, v))
+}
+
+// OsImageHasPrefix applies the HasPrefix predicate on the "os_image" field.
+func OsImageHasPrefix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasPrefix(FieldOsImage, v))
+}
+
+// OsImageHasSuffix applies the HasSuffix predicate on the "os_image" field.
+func OsImageHasSuffix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasSuffix(FieldOsImage, v))
+}
+
+// OsImageIsNil applies the IsNil predicate on the "os_image" field.
+func OsImageIsNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIsNull(FieldOsImage))
+}
+
+// OsImageNotNil applies the NotNil predicate on the "os_image" field.
+func OsImageNotNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotNull(FieldOsImage))
+}
+
+// OsImageEqualFold applies the EqualFold predicate on the "os_image" field.
+func OsImageEqualFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEqualFold(FieldOsImage, v))
+}
+
+// OsImageContainsFold applies the ContainsFold predicate on the "os_image" field.
+func OsImageContainsFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContainsFold(FieldOsImage, v))
+}
+
+// KernelVersionEQ applies the EQ predicate on the "kernel_version" field.
+func KernelVersionEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldKernelVersion, v))
+}
+
+// KernelVersionNEQ applies the NEQ predicate on the "kernel_version" field.
+func KernelVersionNEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldKernelVersion, v))
+}
+
+// KernelVersionIn applies the In predicate on the "kernel_version" field.
+func KernelVersionIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIn(FieldKernelVersion, vs...))
+}
+
+// KernelVersionNotIn applies the NotIn predicate on the "kernel_version" field.
+func KernelVersionNotIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotIn(FieldKernelVersion, vs...))
+}
+
+// KernelVersionGT applies the GT predicate on the "kernel_version" field.
+func KernelVersionGT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGT(FieldKernelVersion, v))
+}
+
+// KernelVersionGTE applies the GTE predicate on the "kernel_version" field.
+func KernelVersionGTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGTE(FieldKernelVersion, v))
+}
+
+// KernelVersionLT applies the LT predicate on the "kernel_version" field.
+func KernelVersionLT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLT(FieldKernelVersion, v))
+}
+
+// KernelVersionLTE applies the LTE predicate on the "kernel_version" field.
+func KernelVersionLTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLTE(FieldKernelVersion, v))
+}
+
+// KernelVersionContains applies the Contains predicate on the "kernel_version" field.
+func KernelVersionContains(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContains(FieldKernelVersion, v))
+}
+
+// KernelVersionHasPrefix applies the HasPrefix predicate on the "kernel_version" field.
+func KernelVersionHasPrefix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasPrefix(FieldKernelVersion, v))
+}
+
+// KernelVersionHasSuffix applies the HasSuffix predicate on the "kernel_version" field.
+func KernelVersionHasSuffix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasSuffix(FieldKernelVersion, v))
+}
+
+// KernelVersionIsNil applies the IsNil predicate on the "kernel_version" field.
+func KernelVersionIsNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIsNull(FieldKernelVersion))
+}
+
+// KernelVersionNotNil applies the NotNil predicate on the "kernel_version" field.
+func KernelVersionNotNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotNull(FieldKernelVersion))
+}
+
+// KernelVersionEqualFold applies the EqualFold predicate on the "kernel_version" field.
+func KernelVersionEqualFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEqualFold(FieldKernelVersion, v))
+}
+
+// KernelVersionContainsFold applies the ContainsFold predicate on the "kernel_version" field.
+func KernelVersionContainsFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContainsFold(FieldKernelVersion, v))
+}
+
+// ContainerRuntimeEQ applies the EQ predicate on the "container_runtime" field.
+func ContainerRuntimeEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldContainerRuntime, v))
+}
+
+// ContainerRuntimeNEQ applies the NEQ predicate on the "container_runtime" field.
+func ContainerRuntimeNEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldContainerRuntime, v))
+}
+
+// ContainerRuntimeIn applies the In predicate on the "container_runtime" field.
+func ContainerRuntimeIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIn(FieldContainerRuntime, vs...))
+}
+
+// ContainerRuntimeNotIn applies the NotIn predicate on the "container_runtime" field.
+func ContainerRuntimeNotIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotIn(FieldContainerRuntime, vs...))
+}
+
+// ContainerRuntimeGT applies the GT predicate on the "container_runtime" field.
+func ContainerRuntimeGT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGT(FieldContainerRuntime, v))
+}
+
+// ContainerRuntimeGTE applies the GTE predicate on the "container_runtime" field.
+func ContainerRuntimeGTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGTE(FieldContainerRuntime, v))
+}
+
+// ContainerRuntimeLT applies the LT predicate on the "container_runtime" field.
+func ContainerRuntimeLT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLT(FieldContainerRuntime, v))
+}
+
+// ContainerRuntimeLTE applies the LTE predicate on the "container_runtime" field.
+func ContainerRuntimeLTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLTE(FieldContainerRuntime, v))
+}
+
+// ContainerRuntimeContains applies the Contains predicate on the "container_runtime" field.
+func ContainerRuntimeContains(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContains(FieldContainerRuntime, v))
+}
+
+// ContainerRuntimeHasPrefix applies the HasPrefix predicate on the "container_runtime" field.
+func ContainerRuntimeHasPrefix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasPrefix(FieldContainerRuntime, v))
+}
+
+// ContainerRuntimeHasSuffix applies the HasSuffix predicate on the "container_runtime" field.
+func ContainerRuntimeHasSuffix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasSuffix(FieldContainerRuntime, v))
+}
+
+// ContainerRuntimeIsNil applies the IsNil predicate on the "container_runtime" field.
+func ContainerRuntimeIsNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIsNull(FieldContainerRuntime))
+}
+
+// ContainerRuntimeNotNil applies the NotNil predicate on the "container_runtime" field.
+func ContainerRuntimeNotNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotNull(FieldContainerRuntime))
+}
+
+// ContainerRuntimeEqualFold applies the EqualFold predicate on the "container_runtime" field.
+func ContainerRuntimeEqualFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEqualFold(FieldContainerRuntime, v))
+}
+
+// ContainerRuntimeContainsFold applies the ContainsFold predicate on the "container_runtime" field.
+func ContainerRuntimeContainsFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContainsFold(FieldContainerRuntime, v))
+}
+
+// ArchitectureEQ applies the EQ predicate on the "architecture" field.
+func ArchitectureEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldArchitecture, v))
+}
+
+// ArchitectureNEQ applies the NEQ predicate on the "architecture" field.
+func ArchitectureNEQ(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldArchitecture, v))
+}
+
+// ArchitectureIn applies the In predicate on the "architecture" field.
+func ArchitectureIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIn(FieldArchitecture, vs...))
+}
+
+// ArchitectureNotIn applies the NotIn predicate on the "architecture" field.
+func ArchitectureNotIn(vs ...string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotIn(FieldArchitecture, vs...))
+}
+
+// ArchitectureGT applies the GT predicate on the "architecture" field.
+func ArchitectureGT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGT(FieldArchitecture, v))
+}
+
+// ArchitectureGTE applies the GTE predicate on the "architecture" field.
+func ArchitectureGTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGTE(FieldArchitecture, v))
+}
+
+// ArchitectureLT applies the LT predicate on the "architecture" field.
+func ArchitectureLT(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLT(FieldArchitecture, v))
+}
+
+// ArchitectureLTE applies the LTE predicate on the "architecture" field.
+func ArchitectureLTE(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLTE(FieldArchitecture, v))
+}
+
+// ArchitectureContains applies the Contains predicate on the "architecture" field.
+func ArchitectureContains(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContains(FieldArchitecture, v))
+}
+
+// ArchitectureHasPrefix applies the HasPrefix predicate on the "architecture" field.
+func ArchitectureHasPrefix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasPrefix(FieldArchitecture, v))
+}
+
+// ArchitectureHasSuffix applies the HasSuffix predicate on the "architecture" field.
+func ArchitectureHasSuffix(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldHasSuffix(FieldArchitecture, v))
+}
+
+// ArchitectureIsNil applies the IsNil predicate on the "architecture" field.
+func ArchitectureIsNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIsNull(FieldArchitecture))
+}
+
+// ArchitectureNotNil applies the NotNil predicate on the "architecture" field.
+func ArchitectureNotNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotNull(FieldArchitecture))
+}
+
+// ArchitectureEqualFold applies the EqualFold predicate on the "architecture" field.
+func ArchitectureEqualFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEqualFold(FieldArchitecture, v))
+}
+
+// ArchitectureContainsFold applies the ContainsFold predicate on the "architecture" field.
+func ArchitectureContainsFold(v string) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldContainsFold(FieldArchitecture, v))
+}
+
+// ReadyEQ applies the EQ predicate on the "ready" field.
+func ReadyEQ(v bool) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldReady, v))
+}
+
+// ReadyNEQ applies the NEQ predicate on the "ready" field.
+func ReadyNEQ(v bool) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldReady, v))
+}
+
+// UnschedulableEQ applies the EQ predicate on the "unschedulable" field.
+func UnschedulableEQ(v bool) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldUnschedulable, v))
+}
+
+// UnschedulableNEQ applies the NEQ predicate on the "unschedulable" field.
+func UnschedulableNEQ(v bool) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldUnschedulable, v))
+}
+
+// LabelsJSONIsNil applies the IsNil predicate on the "labels_json" field.
+func LabelsJSONIsNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIsNull(FieldLabelsJSON))
+}
+
+// LabelsJSONNotNil applies the NotNil predicate on the "labels_json" field.
+func LabelsJSONNotNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotNull(FieldLabelsJSON))
+}
+
+// TaintsJSONIsNil applies the IsNil predicate on the "taints_json" field.
+func TaintsJSONIsNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIsNull(FieldTaintsJSON))
+}
+
+// TaintsJSONNotNil applies the NotNil predicate on the "taints_json" field.
+func TaintsJSONNotNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotNull(FieldTaintsJSON))
+}
+
+// APICreatedAtEQ applies the EQ predicate on the "api_created_at" field.
+func APICreatedAtEQ(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtNEQ applies the NEQ predicate on the "api_created_at" field.
+func APICreatedAtNEQ(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtIn applies the In predicate on the "api_created_at" field.
+func APICreatedAtIn(vs ...time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtNotIn applies the NotIn predicate on the "api_created_at" field.
+func APICreatedAtNotIn(vs ...time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtGT applies the GT predicate on the "api_created_at" field.
+func APICreatedAtGT(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtGTE applies the GTE predicate on the "api_created_at" field.
+func APICreatedAtGTE(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldGTE(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLT applies the LT predicate on the "api_created_at" field.
+func APICreatedAtLT(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLTE applies the LTE predicate on the "api_created_at" field.
+func APICreatedAtLTE(v time.Time) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldLTE(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtIsNil applies the IsNil predicate on the "api_created_at" field.
+func APICreatedAtIsNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldIsNull(FieldAPICreatedAt))
+}
+
+// APICreatedAtNotNil applies the NotNil predicate on the "api_created_at" field.
+func APICreatedAtNotNil() predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.FieldNotNull(FieldAPICreatedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeK8sNode) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeK8sNode) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeK8sNode) predicate.BronzeK8sNode {
+	return predicate.BronzeK8sNode(sql.NotPredicates(p))
+}
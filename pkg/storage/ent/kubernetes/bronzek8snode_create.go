@@ -0,0 +1,574 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snode"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeK8sNodeCreate is the builder for creating a BronzeK8sNode entity.
+type BronzeK8sNodeCreate struct {
+	config
+	mutation *BronzeK8sNodeMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeK8sNodeCreate) SetCollectedAt(v time.Time) *BronzeK8sNodeCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeK8sNodeCreate) SetFirstCollectedAt(v time.Time) *BronzeK8sNodeCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_c *BronzeK8sNodeCreate) SetClusterName(v string) *BronzeK8sNodeCreate {
+	_c.mutation.SetClusterName(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeK8sNodeCreate) SetName(v string) *BronzeK8sNodeCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetUID sets the "uid" field.
+func (_c *BronzeK8sNodeCreate) SetUID(v string) *BronzeK8sNodeCreate {
+	_c.mutation.SetUID(v)
+	return _c
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_c *BronzeK8sNodeCreate) SetNillableUID(v *string) *BronzeK8sNodeCreate {
+	if v != nil {
+		_c.SetUID(*v)
+	}
+	return _c
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_c *BronzeK8sNodeCreate) SetProviderID(v string) *BronzeK8sNodeCreate {
+	_c.mutation.SetProviderID(v)
+	return _c
+}
+
+// SetNillableProviderID sets the "provider_id" field if the given value is not nil.
+func (_c *BronzeK8sNodeCreate) SetNillableProviderID(v *string) *BronzeK8sNodeCreate {
+	if v != nil {
+		_c.SetProviderID(*v)
+	}
+	return _c
+}
+
+// SetInternalIP sets the "internal_ip" field.
+func (_c *BronzeK8sNodeCreate) SetInternalIP(v string) *BronzeK8sNodeCreate {
+	_c.mutation.SetInternalIP(v)
+	return _c
+}
+
+// SetNillableInternalIP sets the "internal_ip" field if the given value is not nil.
+func (_c *BronzeK8sNodeCreate) SetNillableInternalIP(v *string) *BronzeK8sNodeCreate {
+	if v != nil {
+		_c.SetInternalIP(*v)
+	}
+	return _c
+}
+
+// SetExternalIP sets the "external_ip" field.
+func (_c *BronzeK8sNodeCreate) SetExternalIP(v string) *BronzeK8sNodeCreate {
+	_c.mutation.SetExternalIP(v)
+	return _c
+}
+
+// SetNillableExternalIP sets the "external_ip" field if the given value is not nil.
+func (_c *BronzeK8sNodeCreate) SetNillableExternalIP(v *string) *BronzeK8sNodeCreate {
+	if v != nil {
+		_c.SetExternalIP(*v)
+	}
+	return _c
+}
+
+// SetNodePool sets the "node_pool" field.
+func (_c *BronzeK8sNodeCreate) SetNodePool(v string) *BronzeK8sNodeCreate {
+	_c.mutation.SetNodePool(v)
+	return _c
+}
+
+// SetNillableNodePool sets the "node_pool" field if the given value is not nil.
+func (_c *BronzeK8sNodeCreate) SetNillableNodePool(v *string) *BronzeK8sNodeCreate {
+	if v != nil {
+		_c.SetNodePool(*v)
+	}
+	return _c
+}
+
+// SetInstanceType sets the "instance_type" field.
+func (_c *BronzeK8sNodeCreate) SetInstanceType(v string) *BronzeK8sNodeCreate {
+	_c.mutation.SetInstanceType(v)
+	return _c
+}
+
+// SetNillableInstanceType sets the "instance_type" field if the given value is not nil.
+func (_c *BronzeK8sNodeCreate) SetNillableInstanceType(v *string) *BronzeK8sNodeCreate {
+	if v != nil {
+		_c.SetInstanceType(*v)
+	}
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BronzeK8sNodeCreate) SetRegion(v string) *BronzeK8sNodeCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_c *BronzeK8sNodeCreate) SetNillableRegion(v *string) *BronzeK8sNodeCreate {
+	if v != nil {
+		_c.SetRegion(*v)
+	}
+	return _c
+}
+
+// SetZone sets the "zone" field.
+func (_c *BronzeK8sNodeCreate) SetZone(v string) *BronzeK8sNodeCreate {
+	_c.mutation.SetZone(v)
+	return _c
+}
+
+// SetNillableZone sets the "zone" field if the given value is not nil.
+func (_c *BronzeK8sNodeCreate) SetNillableZone(v *string) *BronzeK8sNodeCreate {
+	if v != nil {
+		_c.SetZone(*v)
+	}
+	return _c
+}
+
+// SetKubeletVersion sets the "kubelet_version" field.
+func (_c *BronzeK8sNodeCreate) SetKubeletVersion(v string) *BronzeK8sNodeCreate {
+	_c.mutation.SetKubeletVersion(v)
+	return _c
+}
+
+// SetNillableKubeletVersion sets the "kubelet_version" field if the given value is not nil.
+func (_c *BronzeK8sNodeCreate) SetNillableKubeletVersion(v *string) *BronzeK8sNodeCreate {
+	if v != nil {
+		_c.SetKubeletVersion(*v)
+	}
+	return _c
+}
+
+// SetOsImage sets the "os_image" field.
+func (_c *BronzeK8sNodeCreate) SetOsImage(v string) *BronzeK8sNodeCreate {
+	_c.mutation.SetOsImage(v)
+	return _c
+}
+
+// SetNillableOsImage sets the "os_image" field if the given value is not nil.
+func (_c *BronzeK8sNodeCreate) SetNillableOsImage(v *string) *BronzeK8sNodeCreate {
+	if v != nil {
+		_c.SetOsImage(*v)
+	}
+	return _c
+}
+
+// SetKernelVersion sets the "kernel_version" field.
+func (_c *BronzeK8sNodeCreate) SetKernelVersion(v string) *BronzeK8sNodeCreate {
+	_c.mutation.SetKernelVersion(v)
+	return _c
+}
+
+// SetNillableKernelVersion sets the "kernel_version" field if the given value is not nil.
+func (_c *BronzeK8sNodeCreate) SetNillableKernelVersion(v *string) *BronzeK8sNodeCreate {
+	if v != nil {
+		_c.SetKernelVersion(*v)
+	}
+	return _c
+}
+
+// SetContainerRuntime sets the "container_runtime" field.
+func (_c *BronzeK8sNodeCreate) SetContainerRuntime(v string) *BronzeK8sNodeCreate {
+	_c.mutation.SetContainerRuntime(v)
+	return _c
+}
+
+// SetNillableContainerRuntime sets the "container_runtime" field if the given value is not nil.
+func (_c *BronzeK8sNodeCreate) SetNillableContainerRuntime(v *string) *BronzeK8sNodeCreate {
+	if v != nil {
+		_c.SetContainerRuntime(*v)
+	}
+	return _c
+}
+
+// SetArchitecture sets the "architecture" field.
+func (_c *BronzeK8sNodeCreate) SetArchitecture(v string) *BronzeK8sNodeCreate {
+	_c.mutation.SetArchitecture(v)
+	return _c
+}
+
+// SetNillableArchitecture sets the "architecture" field if the given value is not nil.
+func (_c *BronzeK8sNodeCreate) SetNillableArchitecture(v *string) *BronzeK8sNodeCreate {
+	if v != nil {
+		_c.SetArchitecture(*v)
+	}
+	return _c
+}
+
+// SetReady sets the "ready" field.
+func (_c *BronzeK8sNodeCreate) SetReady(v bool) *BronzeK8sNodeCreate {
+	_c.mutation.SetReady(v)
+	return _c
+}
+
+// SetNillableReady sets the "ready" field if the given value is not nil.
+func (_c *BronzeK8sNodeCreate) SetNillableReady(v *bool) *BronzeK8sNodeCreate {
+	if v != nil {
+		_c.SetReady(*v)
+	}
+	return _c
+}
+
+// SetUnschedulable sets the "unschedulable" field.
+func (_c *BronzeK8sNodeCreate) SetUnschedulable(v bool) *BronzeK8sNodeCreate {
+	_c.mutation.SetUnschedulable(v)
+	return _c
+}
+
+// SetNillableUnschedulable sets the "unschedulable" field if the given value is not nil.
+func (_c *BronzeK8sNodeCreate) SetNillableUnschedulable(v *bool) *BronzeK8sNodeCreate {
+	if v != nil {
+		_c.SetUnschedulable(*v)
+	}
+	return _c
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_c *BronzeK8sNodeCreate) SetLabelsJSON(v json.RawMessage) *BronzeK8sNodeCreate {
+	_c.mutation.SetLabelsJSON(v)
+	return _c
+}
+
+// SetTaintsJSON sets the "taints_json" field.
+func (_c *BronzeK8sNodeCreate) SetTaintsJSON(v json.RawMessage) *BronzeK8sNodeCreate {
+	_c.mutation.SetTaintsJSON(v)
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeK8sNodeCreate) SetAPICreatedAt(v time.Time) *BronzeK8sNodeCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeK8sNodeCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sNodeCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeK8sNodeCreate) SetID(v string) *BronzeK8sNodeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeK8sNodeMutation object of the builder.
+func (_c *BronzeK8sNodeCreate) Mutation() *BronzeK8sNodeMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeK8sNode in the database.
+func (_c *BronzeK8sNodeCreate) Save(ctx context.Context) (*BronzeK8sNode, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeK8sNodeCreate) SaveX(ctx context.Context) *BronzeK8sNode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeK8sNodeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeK8sNodeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeK8sNodeCreate) defaults() {
+	if _, ok := _c.mutation.Ready(); !ok {
+		v := bronzek8snode.DefaultReady
+		_c.mutation.SetReady(v)
+	}
+	if _, ok := _c.mutation.Unschedulable(); !ok {
+		v := bronzek8snode.DefaultUnschedulable
+		_c.mutation.SetUnschedulable(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeK8sNodeCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`kubernetes: missing required field "BronzeK8sNode.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`kubernetes: missing required field "BronzeK8sNode.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ClusterName(); !ok {
+		return &ValidationError{Name: "cluster_name", err: errors.New(`kubernetes: missing required field "BronzeK8sNode.cluster_name"`)}
+	}
+	if v, ok := _c.mutation.ClusterName(); ok {
+		if err := bronzek8snode.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNode.cluster_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`kubernetes: missing required field "BronzeK8sNode.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := bronzek8snode.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNode.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Ready(); !ok {
+		return &ValidationError{Name: "ready", err: errors.New(`kubernetes: missing required field "BronzeK8sNode.ready"`)}
+	}
+	if _, ok := _c.mutation.Unschedulable(); !ok {
+		return &ValidationError{Name: "unschedulable", err: errors.New(`kubernetes: missing required field "BronzeK8sNode.unschedulable"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeK8sNodeCreate) sqlSave(ctx context.Context) (*BronzeK8sNode, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeK8sNode.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeK8sNodeCreate) createSpec() (*BronzeK8sNode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeK8sNode{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzek8snode.Table, sqlgraph.NewFieldSpec(bronzek8snode.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeK8sNode
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8snode.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzek8snode.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8snode.FieldClusterName, field.TypeString, value)
+		_node.ClusterName = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzek8snode.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.UID(); ok {
+		_spec.SetField(bronzek8snode.FieldUID, field.TypeString, value)
+		_node.UID = value
+	}
+	if value, ok := _c.mutation.ProviderID(); ok {
+		_spec.SetField(bronzek8snode.FieldProviderID, field.TypeString, value)
+		_node.ProviderID = value
+	}
+	if value, ok := _c.mutation.InternalIP(); ok {
+		_spec.SetField(bronzek8snode.FieldInternalIP, field.TypeString, value)
+		_node.InternalIP = value
+	}
+	if value, ok := _c.mutation.ExternalIP(); ok {
+		_spec.SetField(bronzek8snode.FieldExternalIP, field.TypeString, value)
+		_node.ExternalIP = value
+	}
+	if value, ok := _c.mutation.NodePool(); ok {
+		_spec.SetField(bronzek8snode.FieldNodePool, field.TypeString, value)
+		_node.NodePool = value
+	}
+	if value, ok := _c.mutation.InstanceType(); ok {
+		_spec.SetField(bronzek8snode.FieldInstanceType, field.TypeString, value)
+		_node.InstanceType = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(bronzek8snode.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	if value, ok := _c.mutation.Zone(); ok {
+		_spec.SetField(bronzek8snode.FieldZone, field.TypeString, value)
+		_node.Zone = value
+	}
+	if value, ok := _c.mutation.KubeletVersion(); ok {
+		_spec.SetField(bronzek8snode.FieldKubeletVersion, field.TypeString, value)
+		_node.KubeletVersion = value
+	}
+	if value, ok := _c.mutation.OsImage(); ok {
+		_spec.SetField(bronzek8snode.FieldOsImage, field.TypeString, value)
+		_node.OsImage = value
+	}
+	if value, ok := _c.mutation.KernelVersion(); ok {
+		_spec.SetField(bronzek8snode.FieldKernelVersion, field.TypeString, value)
+		_node.KernelVersion = value
+	}
+	if value, ok := _c.mutation.ContainerRuntime(); ok {
+		_spec.SetField(bronzek8snode.FieldContainerRuntime, field.TypeString, value)
+		_node.ContainerRuntime = value
+	}
+	if value, ok := _c.mutation.Architecture(); ok {
+		_spec.SetField(bronzek8snode.FieldArchitecture, field.TypeString, value)
+		_node.Architecture = value
+	}
+	if value, ok := _c.mutation.Ready(); ok {
+		_spec.SetField(bronzek8snode.FieldReady, field.TypeBool, value)
+		_node.Ready = value
+	}
+	if value, ok := _c.mutation.Unschedulable(); ok {
+		_spec.SetField(bronzek8snode.FieldUnschedulable, field.TypeBool, value)
+		_node.Unschedulable = value
+	}
+	if value, ok := _c.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8snode.FieldLabelsJSON, field.TypeJSON, value)
+		_node.LabelsJSON = value
+	}
+	if value, ok := _c.mutation.TaintsJSON(); ok {
+		_spec.SetField(bronzek8snode.FieldTaintsJSON, field.TypeJSON, value)
+		_node.TaintsJSON = value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8snode.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	return _node, _spec
+}
+
+// BronzeK8sNodeCreateBulk is the builder for creating many BronzeK8sNode entities in bulk.
+type BronzeK8sNodeCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeK8sNodeCreate
+}
+
+// Save creates the BronzeK8sNode entities in the database.
+func (_c *BronzeK8sNodeCreateBulk) Save(ctx context.Context) ([]*BronzeK8sNode, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeK8sNode, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeK8sNodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeK8sNodeCreateBulk) SaveX(ctx context.Context) []*BronzeK8sNode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeK8sNodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeK8sNodeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snode"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeK8sNodeUpdate is the builder for updating BronzeK8sNode entities.
+type BronzeK8sNodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeK8sNodeMutation
+}
+
+// Where appends a list predicates to the BronzeK8sNodeUpdate builder.
+func (_u *BronzeK8sNodeUpdate) Where(ps ...predicate.BronzeK8sNode) *BronzeK8sNodeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeK8sNodeUpdate) SetCollectedAt(v time.Time) *BronzeK8sNodeUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdate) SetNillableCollectedAt(v *time.Time) *BronzeK8sNodeUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeK8sNodeUpdate) SetClusterName(v string) *BronzeK8sNodeUpdate {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdate) SetNillableClusterName(v *string) *BronzeK8sNodeUpdate {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeK8sNodeUpdate) SetName(v string) *BronzeK8sNodeUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdate) SetNillableName(v *string) *BronzeK8sNodeUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeK8sNodeUpdate) SetUID(v string) *BronzeK8sNodeUpdate {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdate) SetNillableUID(v *string) *BronzeK8sNodeUpdate {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeK8sNodeUpdate) ClearUID() *BronzeK8sNodeUpdate {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_u *BronzeK8sNodeUpdate) SetProviderID(v string) *BronzeK8sNodeUpdate {
+	_u.mutation.SetProviderID(v)
+	return _u
+}
+
+// SetNillableProviderID sets the "provider_id" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdate) SetNillableProviderID(v *string) *BronzeK8sNodeUpdate {
+	if v != nil {
+		_u.SetProviderID(*v)
+	}
+	return _u
+}
+
+// ClearProviderID clears the value of the "provider_id" field.
+func (_u *BronzeK8sNodeUpdate) ClearProviderID() *BronzeK8sNodeUpdate {
+	_u.mutation.ClearProviderID()
+	return _u
+}
+
+// SetInternalIP sets the "internal_ip" field.
+func (_u *BronzeK8sNodeUpdate) SetInternalIP(v string) *BronzeK8sNodeUpdate {
+	_u.mutation.SetInternalIP(v)
+	return _u
+}
+
+// SetNillableInternalIP sets the "internal_ip" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdate) SetNillableInternalIP(v *string) *BronzeK8sNodeUpdate {
+	if v != nil {
+		_u.SetInternalIP(*v)
+	}
+	return _u
+}
+
+// ClearInternalIP clears the value of the "internal_ip" field.
+func (_u *BronzeK8sNodeUpdate) ClearInternalIP() *BronzeK8sNodeUpdate {
+	_u.mutation.ClearInternalIP()
+	return _u
+}
+
+// SetExternalIP sets the "external_ip" field.
+func (_u *BronzeK8sNodeUpdate) SetExternalIP(v string) *BronzeK8sNodeUpdate {
+	_u.mutation.SetExternalIP(v)
+	return _u
+}
+
+// SetNillableExternalIP sets the "external_ip" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdate) SetNillableExternalIP(v *string) *BronzeK8sNodeUpdate {
+	if v != nil {
+		_u.SetExternalIP(*v)
+	}
+	return _u
+}
+
+// ClearExternalIP clears the value of the "external_ip" field.
+func (_u *BronzeK8sNodeUpdate) ClearExternalIP() *BronzeK8sNodeUpdate {
+	_u.mutation.ClearExternalIP()
+	return _u
+}
+
+// SetNodePool sets the "node_pool" field.
+func (_u *BronzeK8sNodeUpdate) SetNodePool(v string) *BronzeK8sNodeUpdate {
+	_u.mutation.SetNodePool(v)
+	return _u
+}
+
+// SetNillableNodePool sets the "node_pool" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdate) SetNillableNodePool(v *string) *BronzeK8sNodeUpdate {
+	if v != nil {
+		_u.SetNodePool(*v)
+	}
+	return _u
+}
+
+// ClearNodePool clears the value of the "node_pool" field.
+func (_u *BronzeK8sNodeUpdate) ClearNodePool() *BronzeK8sNodeUpdate {
+	_u.mutation.ClearNodePool()
+	return _u
+}
+
+// SetInstanceType sets the "instance_type" field.
+func (_u *BronzeK8sNodeUpdate) SetInstanceType(v string) *BronzeK8sNodeUpdate {
+	_u.mutation.SetInstanceType(v)
+	return _u
+}
+
+// SetNillableInstanceType sets the "instance_type" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdate) SetNillableInstanceType(v *string) *BronzeK8sNodeUpdate {
+	if v != nil {
+		_u.SetInstanceType(*v)
+	}
+	return _u
+}
+
+// ClearInstanceType clears the value of the "instance_type" field.
+func (_u *BronzeK8sNodeUpdate) ClearInstanceType() *BronzeK8sNodeUpdate {
+	_u.mutation.ClearInstanceType()
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeK8sNodeUpdate) SetRegion(v string) *BronzeK8sNodeUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdate) SetNillableRegion(v *string) *BronzeK8sNodeUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// ClearRegion clears the value of the "region" field.
+func (_u *BronzeK8sNodeUpdate) ClearRegion() *BronzeK8sNodeUpdate {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// SetZone sets the "zone" field.
+func (_u *BronzeK8sNodeUpdate) SetZone(v string) *BronzeK8sNodeUpdate {
+	_u.mutation.SetZone(v)
+	return _u
+}
+
+// SetNillableZone sets the "zone" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdate) SetNillableZone(v *string) *BronzeK8sNodeUpdate {
+	if v != nil {
+		_u.SetZone(*v)
+	}
+	return _u
+}
+
+// ClearZone clears the value of the "zone" field.
+func (_u *BronzeK8sNodeUpdate) ClearZone() *BronzeK8sNodeUpdate {
+	_u.mutation.ClearZone()
+	return _u
+}
+
+// SetKubeletVersion sets the "kubelet_version" field.
+func (_u *BronzeK8sNodeUpdate) SetKubeletVersion(v string) *BronzeK8sNodeUpdate {
+	_u.mutation.SetKubeletVersion(v)
+	return _u
+}
+
+// SetNillableKubeletVersion sets the "kubelet_version" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdate) SetNillableKubeletVersion(v *string) *BronzeK8sNodeUpdate {
+	if v != nil {
+		_u.SetKubeletVersion(*v)
+	}
+	return _u
+}
+
+// ClearKubeletVersion clears the value of the "kubelet_version" field.
+func (_u *BronzeK8sNodeUpdate) ClearKubeletVersion() *BronzeK8sNodeUpdate {
+	_u.mutation.ClearKubeletVersion()
+	return _u
+}
+
+// SetOsImage sets the "os_image" field.
+func (_u *BronzeK8sNodeUpdate) SetOsImage(v string) *BronzeK8sNodeUpdate {
+	_u.mutation.SetOsImage(v)
+	return _u
+}
+
+// SetNillableOsImage sets the "os_image" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdate) SetNillableOsImage(v *string) *BronzeK8sNodeUpdate {
+	if v != nil {
+		_u.SetOsImage(*v)
+	}
+	return _u
+}
+
+// ClearOsImage clears the value of the "os_image" field.
+func (_u *BronzeK8sNodeUpdate) ClearOsImage() *BronzeK8sNodeUpdate {
+	_u.mutation.ClearOsImage()
+	return _u
+}
+
+// SetKernelVersion sets the "kernel_version" field.
+func (_u *BronzeK8sNodeUpdate) SetKernelVersion(v string) *BronzeK8sNodeUpdate {
+	_u.mutation.SetKernelVersion(v)
+	return _u
+}
+
+// SetNillableKernelVersion sets the "kernel_version" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdate) SetNillableKernelVersion(v *string) *BronzeK8sNodeUpdate {
+	if v != nil {
+		_u.SetKernelVersion(*v)
+	}
+	return _u
+}
+
+// ClearKernelVersion clears the value of the "kernel_version" field.
+func (_u *BronzeK8sNodeUpdate) ClearKernelVersion() *BronzeK8sNodeUpdate {
+	_u.mutation.ClearKernelVersion()
+	return _u
+}
+
+// SetContainerRuntime sets the "container_runtime" field.
+func (_u *BronzeK8sNodeUpdate) SetContainerRuntime(v string) *BronzeK8sNodeUpdate {
+	_u.mutation.SetContainerRuntime(v)
+	return _u
+}
+
+// SetNillableContainerRuntime sets the "container_runtime" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdate) SetNillableContainerRuntime(v *string) *BronzeK8sNodeUpdate {
+	if v != nil {
+		_u.SetContainerRuntime(*v)
+	}
+	return _u
+}
+
+// ClearContainerRuntime clears the value of the "container_runtime" field.
+func (_u *BronzeK8sNodeUpdate) ClearContainerRuntime() *BronzeK8sNodeUpdate {
+	_u.mutation.ClearContainerRuntime()
+	return _u
+}
+
+// SetArchitecture sets the "architecture" field.
+func (_u *BronzeK8sNodeUpdate) SetArchitecture(v string) *BronzeK8sNodeUpdate {
+	_u.mutation.SetArchitecture(v)
+	return _u
+}
+
+// SetNillableArchitecture sets the "architecture" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdate) SetNillableArchitecture(v *string) *BronzeK8sNodeUpdate {
+	if v != nil {
+		_u.SetArchitecture(*v)
+	}
+	return _u
+}
+
+// ClearArchitecture clears the value of the "architecture" field.
+func (_u *BronzeK8sNodeUpdate) ClearArchitecture() *BronzeK8sNodeUpdate {
+	_u.mutation.ClearArchitecture()
+	return _u
+}
+
+// SetReady sets the "ready" field.
+func (_u *BronzeK8sNodeUpdate) SetReady(v bool) *BronzeK8sNodeUpdate {
+	_u.mutation.SetReady(v)
+	return _u
+}
+
+// SetNillableReady sets the "ready" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdate) SetNillableReady(v *bool) *BronzeK8sNodeUpdate {
+	if v != nil {
+		_u.SetReady(*v)
+	}
+	return _u
+}
+
+// SetUnschedulable sets the "unschedulable" field.
+func (_u *BronzeK8sNodeUpdate) SetUnschedulable(v bool) *BronzeK8sNodeUpdate {
+	_u.mutation.SetUnschedulable(v)
+	return _u
+}
+
+// SetNillableUnschedulable sets the "unschedulable" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdate) SetNillableUnschedulable(v *bool) *BronzeK8sNodeUpdate {
+	if v != nil {
+		_u.SetUnschedulable(*v)
+	}
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeK8sNodeUpdate) SetLabelsJSON(v json.RawMessage) *BronzeK8sNodeUpdate {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeK8sNodeUpdate) AppendLabelsJSON(v json.RawMessage) *BronzeK8sNodeUpdate {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeK8sNodeUpdate) ClearLabelsJSON() *BronzeK8sNodeUpdate {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetTaintsJSON sets the "taints_json" field.
+func (_u *BronzeK8sNodeUpdate) SetTaintsJSON(v json.RawMessage) *BronzeK8sNodeUpdate {
+	_u.mutation.SetTaintsJSON(v)
+	return _u
+}
+
+// AppendTaintsJSON appends value to the "taints_json" field.
+func (_u *BronzeK8sNodeUpdate) AppendTaintsJSON(v json.RawMessage) *BronzeK8sNodeUpdate {
+	_u.mutation.AppendTaintsJSON(v)
+	return _u
+}
+
+// ClearTaintsJSON clears the value of the "taints_json" field.
+func (_u *BronzeK8sNodeUpdate) ClearTaintsJSON() *BronzeK8sNodeUpdate {
+	_u.mutation.ClearTaintsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeK8sNodeUpdate) SetAPICreatedAt(v time.Time) *BronzeK8sNodeUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sNodeUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeK8sNodeUpdate) ClearAPICreatedAt() *BronzeK8sNodeUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeK8sNodeMutation object of the builder.
+func (_u *BronzeK8sNodeUpdate) Mutation() *BronzeK8sNodeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeK8sNodeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeK8sNodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeK8sNodeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeK8sNodeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeK8sNodeUpdate) check() error {
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzek8snode.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNode.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzek8snode.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNode.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeK8sNodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzek8snode.Table, bronzek8snode.Columns, sqlgraph.NewFieldSpec(bronzek8snode.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8snode.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8snode.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzek8snode.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzek8snode.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzek8snode.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProviderID(); ok {
+		_spec.SetField(bronzek8snode.FieldProviderID, field.TypeString, value)
+	}
+	if _u.mutation.ProviderIDCleared() {
+		_spec.ClearField(bronzek8snode.FieldProviderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.InternalIP(); ok {
+		_spec.SetField(bronzek8snode.FieldInternalIP, field.TypeString, value)
+	}
+	if _u.mutation.InternalIPCleared() {
+		_spec.ClearField(bronzek8snode.FieldInternalIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExternalIP(); ok {
+		_spec.SetField(bronzek8snode.FieldExternalIP, field.TypeString, value)
+	}
+	if _u.mutation.ExternalIPCleared() {
+		_spec.ClearField(bronzek8snode.FieldExternalIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.NodePool(); ok {
+		_spec.SetField(bronzek8snode.FieldNodePool, field.TypeString, value)
+	}
+	if _u.mutation.NodePoolCleared() {
+		_spec.ClearField(bronzek8snode.FieldNodePool, field.TypeString)
+	}
+	if value, ok := _u.mutation.InstanceType(); ok {
+		_spec.SetField(bronzek8snode.FieldInstanceType, field.TypeString, value)
+	}
+	if _u.mutation.InstanceTypeCleared() {
+		_spec.ClearField(bronzek8snode.FieldInstanceType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzek8snode.FieldRegion, field.TypeString, value)
+	}
+	if _u.mutation.RegionCleared() {
+		_spec.ClearField(bronzek8snode.FieldRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Zone(); ok {
+		_spec.SetField(bronzek8snode.FieldZone, field.TypeString, value)
+	}
+	if _u.mutation.ZoneCleared() {
+		_spec.ClearField(bronzek8snode.FieldZone, field.TypeString)
+	}
+	if value, ok := _u.mutation.KubeletVersion(); ok {
+		_spec.SetField(bronzek8snode.FieldKubeletVersion, field.TypeString, value)
+	}
+	if _u.mutation.KubeletVersionCleared() {
+		_spec.ClearField(bronzek8snode.FieldKubeletVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsImage(); ok {
+		_spec.SetField(bronzek8snode.FieldOsImage, field.TypeString, value)
+	}
+	if _u.mutation.OsImageCleared() {
+		_spec.ClearField(bronzek8snode.FieldOsImage, field.TypeString)
+	}
+	if value, ok := _u.mutation.KernelVersion(); ok {
+		_spec.SetField(bronzek8snode.FieldKernelVersion, field.TypeString, value)
+	}
+	if _u.mutation.KernelVersionCleared() {
+		_spec.ClearField(bronzek8snode.FieldKernelVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.ContainerRuntime(); ok {
+		_spec.SetField(bronzek8snode.FieldContainerRuntime, field.TypeString, value)
+	}
+	if _u.mutation.ContainerRuntimeCleared() {
+		_spec.ClearField(bronzek8snode.FieldContainerRuntime, field.TypeString)
+	}
+	if value, ok := _u.mutation.Architecture(); ok {
+		_spec.SetField(bronzek8snode.FieldArchitecture, field.TypeString, value)
+	}
+	if _u.mutation.ArchitectureCleared() {
+		_spec.ClearField(bronzek8snode.FieldArchitecture, field.TypeString)
+	}
+	if value, ok := _u.mutation.Ready(); ok {
+		_spec.SetField(bronzek8snode.FieldReady, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Unschedulable(); ok {
+		_spec.SetField(bronzek8snode.FieldUnschedulable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8snode.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8snode.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzek8snode.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TaintsJSON(); ok {
+		_spec.SetField(bronzek8snode.FieldTaintsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTaintsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8snode.FieldTaintsJSON, value)
+		})
+	}
+	if _u.mutation.TaintsJSONCleared() {
+		_spec.ClearField(bronzek8snode.FieldTaintsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8snode.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzek8snode.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeK8sNode
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzek8snode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeK8sNodeUpdateOne is the builder for updating a single BronzeK8sNode entity.
+type BronzeK8sNodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeK8sNodeMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeK8sNodeUpdateOne) SetCollectedAt(v time.Time) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeK8sNodeUpdateOne) SetClusterName(v string) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdateOne) SetNillableClusterName(v *string) *BronzeK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeK8sNodeUpdateOne) SetName(v string) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdateOne) SetNillableName(v *string) *BronzeK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeK8sNodeUpdateOne) SetUID(v string) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdateOne) SetNillableUID(v *string) *BronzeK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeK8sNodeUpdateOne) ClearUID() *BronzeK8sNodeUpdateOne {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_u *BronzeK8sNodeUpdateOne) SetProviderID(v string) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetProviderID(v)
+	return _u
+}
+
+// SetNillableProviderID sets the "provider_id" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdateOne) SetNillableProviderID(v *string) *BronzeK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetProviderID(*v)
+	}
+	return _u
+}
+
+// ClearProviderID clears the value of the "provider_id" field.
+func (_u *BronzeK8sNodeUpdateOne) ClearProviderID() *BronzeK8sNodeUpdateOne {
+	_u.mutation.ClearProviderID()
+	return _u
+}
+
+// SetInternalIP sets the "internal_ip" field.
+func (_u *BronzeK8sNodeUpdateOne) SetInternalIP(v string) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetInternalIP(v)
+	return _u
+}
+
+// SetNillableInternalIP sets the "internal_ip" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdateOne) SetNillableInternalIP(v *string) *BronzeK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetInternalIP(*v)
+	}
+	return _u
+}
+
+// ClearInternalIP clears the value of the "internal_ip" field.
+func (_u *BronzeK8sNodeUpdateOne) ClearInternalIP() *BronzeK8sNodeUpdateOne {
+	_u.mutation.ClearInternalIP()
+	return _u
+}
+
+// SetExternalIP sets the "external_ip" field.
+func (_u *BronzeK8sNodeUpdateOne) SetExternalIP(v string) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetExternalIP(v)
+	return _u
+}
+
+// SetNillableExternalIP sets the "external_ip" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdateOne) SetNillableExternalIP(v *string) *BronzeK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetExternalIP(*v)
+	}
+	return _u
+}
+
+// ClearExternalIP clears the value of the "external_ip" field.
+func (_u *BronzeK8sNodeUpdateOne) ClearExternalIP() *BronzeK8sNodeUpdateOne {
+	_u.mutation.ClearExternalIP()
+	return _u
+}
+
+// SetNodePool sets the "node_pool" field.
+func (_u *BronzeK8sNodeUpdateOne) SetNodePool(v string) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetNodePool(v)
+	return _u
+}
+
+// SetNillableNodePool sets the "node_pool" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdateOne) SetNillableNodePool(v *string) *BronzeK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetNodePool(*v)
+	}
+	return _u
+}
+
+// ClearNodePool clears the value of the "node_pool" field.
+func (_u *BronzeK8sNodeUpdateOne) ClearNodePool() *BronzeK8sNodeUpdateOne {
+	_u.mutation.ClearNodePool()
+	return _u
+}
+
+// SetInstanceType sets the "instance_type" field.
+func (_u *BronzeK8sNodeUpdateOne) SetInstanceType(v string) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetInstanceType(v)
+	return _u
+}
+
+// SetNillableInstanceType sets the "instance_type" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdateOne) SetNillableInstanceType(v *string) *BronzeK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetInstanceType(*v)
+	}
+	return _u
+}
+
+// ClearInstanceType clears the value of the "instance_type" field.
+func (_u *BronzeK8sNodeUpdateOne) ClearInstanceType() *BronzeK8sNodeUpdateOne {
+	_u.mutation.ClearInstanceType()
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BronzeK8sNodeUpdateOne) SetRegion(v string) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdateOne) SetNillableRegion(v *string) *BronzeK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// ClearRegion clears the value of the "region" field.
+func (_u *BronzeK8sNodeUpdateOne) ClearRegion() *BronzeK8sNodeUpdateOne {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// SetZone sets the "zone" field.
+func (_u *BronzeK8sNodeUpdateOne) SetZone(v string) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetZone(v)
+	return _u
+}
+
+// SetNillableZone sets the "zone" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdateOne) SetNillableZone(v *string) *BronzeK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetZone(*v)
+	}
+	return _u
+}
+
+// ClearZone clears the value of the "zone" field.
+func (_u *BronzeK8sNodeUpdateOne) ClearZone() *BronzeK8sNodeUpdateOne {
+	_u.mutation.ClearZone()
+	return _u
+}
+
+// SetKubeletVersion sets the "kubelet_version" field.
+func (_u *BronzeK8sNodeUpdateOne) SetKubeletVersion(v string) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetKubeletVersion(v)
+	return _u
+}
+
+// SetNillableKubeletVersion sets the "kubelet_version" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdateOne) SetNillableKubeletVersion(v *string) *BronzeK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetKubeletVersion(*v)
+	}
+	return _u
+}
+
+// ClearKubeletVersion clears the value of the "kubelet_version" field.
+func (_u *BronzeK8sNodeUpdateOne) ClearKubeletVersion() *BronzeK8sNodeUpdateOne {
+	_u.mutation.ClearKubeletVersion()
+	return _u
+}
+
+// SetOsImage sets the "os_image" field.
+func (_u *BronzeK8sNodeUpdateOne) SetOsImage(v string) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetOsImage(v)
+	return _u
+}
+
+// SetNillableOsImage sets the "os_image" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdateOne) SetNillableOsImage(v *string) *BronzeK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetOsImage(*v)
+	}
+	return _u
+}
+
+// ClearOsImage clears the value of the "os_image" field.
+func (_u *BronzeK8sNodeUpdateOne) ClearOsImage() *BronzeK8sNodeUpdateOne {
+	_u.mutation.ClearOsImage()
+	return _u
+}
+
+// SetKernelVersion sets the "kernel_version" field.
+func (_u *BronzeK8sNodeUpdateOne) SetKernelVersion(v string) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetKernelVersion(v)
+	return _u
+}
+
+// SetNillableKernelVersion sets the "kernel_version" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdateOne) SetNillableKernelVersion(v *string) *BronzeK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetKernelVersion(*v)
+	}
+	return _u
+}
+
+// ClearKernelVersion clears the value of the "kernel_version" field.
+func (_u *BronzeK8sNodeUpdateOne) ClearKernelVersion() *BronzeK8sNodeUpdateOne {
+	_u.mutation.ClearKernelVersion()
+	return _u
+}
+
+// SetContainerRuntime sets the "container_runtime" field.
+func (_u *BronzeK8sNodeUpdateOne) SetContainerRuntime(v string) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetContainerRuntime(v)
+	return _u
+}
+
+// SetNillableContainerRuntime sets the "container_runtime" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdateOne) SetNillableContainerRuntime(v *string) *BronzeK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetContainerRuntime(*v)
+	}
+	return _u
+}
+
+// ClearContainerRuntime clears the value of the "container_runtime" field.
+func (_u *BronzeK8sNodeUpdateOne) ClearContainerRuntime() *BronzeK8sNodeUpdateOne {
+	_u.mutation.ClearContainerRuntime()
+	return _u
+}
+
+// SetArchitecture sets the "architecture" field.
+func (_u *BronzeK8sNodeUpdateOne) SetArchitecture(v string) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetArchitecture(v)
+	return _u
+}
+
+// SetNillableArchitecture sets the "architecture" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdateOne) SetNillableArchitecture(v *string) *BronzeK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetArchitecture(*v)
+	}
+	return _u
+}
+
+// ClearArchitecture clears the value of the "architecture" field.
+func (_u *BronzeK8sNodeUpdateOne) ClearArchitecture() *BronzeK8sNodeUpdateOne {
+	_u.mutation.ClearArchitecture()
+	return _u
+}
+
+// SetReady sets the "ready" field.
+func (_u *BronzeK8sNodeUpdateOne) SetReady(v bool) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetReady(v)
+	return _u
+}
+
+// SetNillableReady sets the "ready" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdateOne) SetNillableReady(v *bool) *BronzeK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetReady(*v)
+	}
+	return _u
+}
+
+// SetUnschedulable sets the "unschedulable" field.
+func (_u *BronzeK8sNodeUpdateOne) SetUnschedulable(v bool) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetUnschedulable(v)
+	return _u
+}
+
+// SetNillableUnschedulable sets the "unschedulable" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdateOne) SetNillableUnschedulable(v *bool) *BronzeK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetUnschedulable(*v)
+	}
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeK8sNodeUpdateOne) SetLabelsJSON(v json.RawMessage) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeK8sNodeUpdateOne) AppendLabelsJSON(v json.RawMessage) *BronzeK8sNodeUpdateOne {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeK8sNodeUpdateOne) ClearLabelsJSON() *BronzeK8sNodeUpdateOne {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetTaintsJSON sets the "taints_json" field.
+func (_u *BronzeK8sNodeUpdateOne) SetTaintsJSON(v json.RawMessage) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetTaintsJSON(v)
+	return _u
+}
+
+// AppendTaintsJSON appends value to the "taints_json" field.
+func (_u *BronzeK8sNodeUpdateOne) AppendTaintsJSON(v json.RawMessage) *BronzeK8sNodeUpdateOne {
+	_u.mutation.AppendTaintsJSON(v)
+	return _u
+}
+
+// ClearTaintsJSON clears the value of the "taints_json" field.
+func (_u *BronzeK8sNodeUpdateOne) ClearTaintsJSON() *BronzeK8sNodeUpdateOne {
+	_u.mutation.ClearTaintsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeK8sNodeUpdateOne) SetAPICreatedAt(v time.Time) *BronzeK8sNodeUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeK8sNodeUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sNodeUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeK8sNodeUpdateOne) ClearAPICreatedAt() *BronzeK8sNodeUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeK8sNodeMutation object of the builder.
+func (_u *BronzeK8sNodeUpdateOne) Mutation() *BronzeK8sNodeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeK8sNodeUpdate builder.
+func (_u *BronzeK8sNodeUpdateOne) Where(ps ...predicate.BronzeK8sNode) *BronzeK8sNodeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeK8sNodeUpdateOne) Select(field string, fields ...string) *BronzeK8sNodeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeK8sNode entity.
+func (_u *BronzeK8sNodeUpdateOne) Save(ctx context.Context) (*BronzeK8sNode, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeK8sNodeUpdateOne) SaveX(ctx context.Context) *BronzeK8sNode {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeK8sNodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeK8sNodeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeK8sNodeUpdateOne) check() error {
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzek8snode.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNode.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzek8snode.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sNode.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeK8sNodeUpdateOne) sqlSave(ctx context.Context) (_node *BronzeK8sNode, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzek8snode.Table, bronzek8snode.Columns, sqlgraph.NewFieldSpec(bronzek8snode.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`kubernetes: missing "BronzeK8sNode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzek8snode.FieldID)
+		for _, f := range fields {
+			if !bronzek8snode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("kubernetes: invalid field %q for query", f)}
+			}
+			if f != bronzek8snode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8snode.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8snode.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzek8snode.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzek8snode.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzek8snode.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProviderID(); ok {
+		_spec.SetField(bronzek8snode.FieldProviderID, field.TypeString, value)
+	}
+	if _u.mutation.ProviderIDCleared() {
+		_spec.ClearField(bronzek8snode.FieldProviderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.InternalIP(); ok {
+		_spec.SetField(bronzek8snode.FieldInternalIP, field.TypeString, value)
+	}
+	if _u.mutation.InternalIPCleared() {
+		_spec.ClearField(bronzek8snode.FieldInternalIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExternalIP(); ok {
+		_spec.SetField(bronzek8snode.FieldExternalIP, field.TypeString, value)
+	}
+	if _u.mutation.ExternalIPCleared() {
+		_spec.ClearField(bronzek8snode.FieldExternalIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.NodePool(); ok {
+		_spec.SetField(bronzek8snode.FieldNodePool, field.TypeString, value)
+	}
+	if _u.mutation.NodePoolCleared() {
+		_spec.ClearField(bronzek8snode.FieldNodePool, field.TypeString)
+	}
+	if value, ok := _u.mutation.InstanceType(); ok {
+		_spec.SetField(bronzek8snode.FieldInstanceType, field.TypeString, value)
+	}
+	if _u.mutation.InstanceTypeCleared() {
+		_spec.ClearField(bronzek8snode.FieldInstanceType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(bronzek8snode.FieldRegion, field.TypeString, value)
+	}
+	if _u.mutation.RegionCleared() {
+		_spec.ClearField(bronzek8snode.FieldRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Zone(); ok {
+		_spec.SetField(bronzek8snode.FieldZone, field.TypeString, value)
+	}
+	if _u.mutation.ZoneCleared() {
+		_spec.ClearField(bronzek8snode.FieldZone, field.TypeString)
+	}
+	if value, ok := _u.mutation.KubeletVersion(); ok {
+		_spec.SetField(bronzek8snode.FieldKubeletVersion, field.TypeString, value)
+	}
+	if _u.mutation.KubeletVersionCleared() {
+		_spec.ClearField(bronzek8snode.FieldKubeletVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsImage(); ok {
+		_spec.SetField(bronzek8snode.FieldOsImage, field.TypeString, value)
+	}
+	if _u.mutation.OsImageCleared() {
+		_spec.ClearField(bronzek8snode.FieldOsImage, field.TypeString)
+	}
+	if value, ok := _u.mutation.KernelVersion(); ok {
+		_spec.SetField(bronzek8snode.FieldKernelVersion, field.TypeString, value)
+	}
+	if _u.mutation.KernelVersionCleared() {
+		_spec.ClearField(bronzek8snode.FieldKernelVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.ContainerRuntime(); ok {
+		_spec.SetField(bronzek8snode.FieldContainerRuntime, field.TypeString, value)
+	}
+	if _u.mutation.ContainerRuntimeCleared() {
+		_spec.ClearField(bronzek8snode.FieldContainerRuntime, field.TypeString)
+	}
+	if value, ok := _u.mutation.Architecture(); ok {
+		_spec.SetField(bronzek8snode.FieldArchitecture, field.TypeString, value)
+	}
+	if _u.mutation.ArchitectureCleared() {
+		_spec.ClearField(bronzek8snode.FieldArchitecture, field.TypeString)
+	}
+	if value, ok := _u.mutation.Ready(); ok {
+		_spec.SetField(bronzek8snode.FieldReady, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Unschedulable(); ok {
+		_spec.SetField(bronzek8snode.FieldUnschedulable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8snode.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8snode.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzek8snode.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TaintsJSON(); ok {
+		_spec.SetField(bronzek8snode.FieldTaintsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTaintsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8snode.FieldTaintsJSON, value)
+		})
+	}
+	if _u.mutation.TaintsJSONCleared() {
+		_spec.ClearField(bronzek8snode.FieldTaintsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8snode.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzek8snode.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeK8sNode
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeK8sNode{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzek8snode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
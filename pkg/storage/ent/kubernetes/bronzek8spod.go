@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8spod"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeK8sPod is the model entity for the BronzeK8sPod schema.
+type BronzeK8sPod struct {
+	config `json:"-"`
+	// ID of the ent.
+	// {cluster_name}/{namespace}/{name}
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// ClusterName holds the value of the "cluster_name" field.
+	ClusterName string `json:"cluster_name,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// Pending, Running, Succeeded, Failed or Unknown
+	Phase string `json:"phase,omitempty"`
+	// NodeName holds the value of the "node_name" field.
+	NodeName string `json:"node_name,omitempty"`
+	// PodIP holds the value of the "pod_ip" field.
+	PodIP string `json:"pod_ip,omitempty"`
+	// HostIP holds the value of the "host_ip" field.
+	HostIP string `json:"host_ip,omitempty"`
+	// ServiceAccount holds the value of the "service_account" field.
+	ServiceAccount string `json:"service_account,omitempty"`
+	// Owning workload kind, with ReplicaSets resolved to their Deployment
+	WorkloadKind string `json:"workload_kind,omitempty"`
+	// WorkloadName holds the value of the "workload_name" field.
+	WorkloadName string `json:"workload_name,omitempty"`
+	// HostNetwork holds the value of the "host_network" field.
+	HostNetwork bool `json:"host_network,omitempty"`
+	// Containers: name, image, image_id, init
+	ContainersJSON json.RawMessage `json:"containers_json,omitempty"`
+	// LabelsJSON holds the value of the "labels_json" field.
+	LabelsJSON json.RawMessage `json:"labels_json,omitempty"`
+	// APICreatedAt holds the value of the "api_created_at" field.
+	APICreatedAt *time.Time `json:"api_created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeK8sPod) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzek8spod.FieldContainersJSON, bronzek8spod.FieldLabelsJSON:
+			values[i] = new([]byte)
+		case bronzek8spod.FieldHostNetwork:
+			values[i] = new(sql.NullBool)
+		case bronzek8spod.FieldID, bronzek8spod.FieldClusterName, bronzek8spod.FieldNamespace, bronzek8spod.FieldName, bronzek8spod.FieldUID, bronzek8spod.FieldPhase, bronzek8spod.FieldNodeName, bronzek8spod.FieldPodIP, bronzek8spod.FieldHostIP, bronzek8spod.FieldServiceAccount, bronzek8spod.FieldWorkloadKind, bronzek8spod.FieldWorkloadName:
+			values[i] = new(sql.NullString)
+		case bronzek8spod.FieldCollectedAt, bronzek8spod.FieldFirstCollectedAt, bronzek8spod.FieldAPICreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeK8sPod fields.
+func (_m *BronzeK8sPod) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzek8spod.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzek8spod.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzek8spod.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzek8spod.FieldClusterName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_name", values[i])
+			} else if value.Valid {
+				_m.ClusterName = value.String
+			}
+		case bronzek8spod.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				_m.Namespace = value.String
+			}
+		case bronzek8spod.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzek8spod.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				_m.UID = value.String
+			}
+		case bronzek8spod.FieldPhase:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phase", values[i])
+			} else if value.Valid {
+				_m.Phase = value.String
+			}
+		case bronzek8spod.FieldNodeName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field node_name", values[i])
+			} else if value.Valid {
+				_m.NodeName = value.String
+			}
+		case bronzek8spod.FieldPodIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pod_ip", values[i])
+			} else if value.Valid {
+				_m.PodIP = value.String
+			}
+		case bronzek8spod.FieldHostIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field host_ip", values[i])
+			} else if value.Valid {
+				_m.HostIP = value.String
+			}
+		case bronzek8spod.FieldServiceAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field service_account", values[i])
+			} else if value.Valid {
+				_m.ServiceAccount = value.String
+			}
+		case bronzek8spod.FieldWorkloadKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field workload_kind", values[i])
+			} else if value.Valid {
+				_m.WorkloadKind = value.String
+			}
+		case bronzek8spod.FieldWorkloadName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field workload_name", values[i])
+			} else if value.Valid {
+				_m.WorkloadName = value.String
+			}
+		case bronzek8spod.FieldHostNetwork:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field host_network", values[i])
+			} else if value.Valid {
+				_m.HostNetwork = value.Bool
+			}
+		case bronzek8spod.FieldContainersJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field containers_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ContainersJSON); err != nil {
+					return fmt.Errorf("unmarshal field containers_json: %w", err)
+				}
+			}
+		case bronzek8spod.FieldLabelsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LabelsJSON); err != nil {
+					return fmt.Errorf("unmarshal field labels_json: %w", err)
+				}
+			}
+		case bronzek8spod.FieldAPICreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_created_at", values[i])
+			} else if value.Valid {
+				_m.APICreatedAt = new(time.Time)
+				*_m.APICreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeK8sPod.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeK8sPod) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeK8sPod.
+// Note that you need to call BronzeK8sPod.Unwrap() before calling this method if this BronzeK8sPod
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeK8sPod) Update() *BronzeK8sPodUpdateOne {
+	return NewBronzeK8sPodClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeK8sPod entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeK8sPod) Unwrap() *BronzeK8sPod {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("kubernetes: BronzeK8sPod is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeK8sPod) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeK8sPod(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("cluster_name=")
+	builder.WriteString(_m.ClusterName)
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(_m.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("uid=")
+	builder.WriteString(_m.UID)
+	builder.WriteString(", ")
+	builder.WriteString("phase=")
+	builder.WriteString(_m.Phase)
+	builder.WriteString(", ")
+	builder.WriteString("node_name=")
+	builder.WriteString(_m.NodeName)
+	builder.WriteString(", ")
+	builder.WriteString("pod_ip=")
+	builder.WriteString(_m.PodIP)
+	builder.WriteString(", ")
+	builder.WriteString("host_ip=")
+	builder.WriteString(_m.HostIP)
+	builder.WriteString(", ")
+	builder.WriteString("service_account=")
+	builder.WriteString(_m.ServiceAccount)
+	builder.WriteString(", ")
+	builder.WriteString("workload_kind=")
+	builder.WriteString(_m.WorkloadKind)
+	builder.WriteString(", ")
+	builder.WriteString("workload_name=")
+	builder.WriteString(_m.WorkloadName)
+	builder.WriteString(", ")
+	builder.WriteString("host_network=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HostNetwork))
+	builder.WriteString(", ")
+	builder.WriteString("containers_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ContainersJSON))
+	builder.WriteString(", ")
+	builder.WriteString("labels_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LabelsJSON))
+	builder.WriteString(", ")
+	if v := _m.APICreatedAt; v != nil {
+		builder.WriteString("api_created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeK8sPods is a parsable slice of BronzeK8sPod.
+type BronzeK8sPods []*BronzeK8sPod
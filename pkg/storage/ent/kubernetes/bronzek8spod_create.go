@@ -0,0 +1,477 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8spod"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeK8sPodCreate is the builder for creating a BronzeK8sPod entity.
+type BronzeK8sPodCreate struct {
+	config
+	mutation *BronzeK8sPodMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeK8sPodCreate) SetCollectedAt(v time.Time) *BronzeK8sPodCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeK8sPodCreate) SetFirstCollectedAt(v time.Time) *BronzeK8sPodCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_c *BronzeK8sPodCreate) SetClusterName(v string) *BronzeK8sPodCreate {
+	_c.mutation.SetClusterName(v)
+	return _c
+}
+
+// SetNamespace sets the "namespace" field.
+func (_c *BronzeK8sPodCreate) SetNamespace(v string) *BronzeK8sPodCreate {
+	_c.mutation.SetNamespace(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeK8sPodCreate) SetName(v string) *BronzeK8sPodCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetUID sets the "uid" field.
+func (_c *BronzeK8sPodCreate) SetUID(v string) *BronzeK8sPodCreate {
+	_c.mutation.SetUID(v)
+	return _c
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_c *BronzeK8sPodCreate) SetNillableUID(v *string) *BronzeK8sPodCreate {
+	if v != nil {
+		_c.SetUID(*v)
+	}
+	return _c
+}
+
+// SetPhase sets the "phase" field.
+func (_c *BronzeK8sPodCreate) SetPhase(v string) *BronzeK8sPodCreate {
+	_c.mutation.SetPhase(v)
+	return _c
+}
+
+// SetNillablePhase sets the "phase" field if the given value is not nil.
+func (_c *BronzeK8sPodCreate) SetNillablePhase(v *string) *BronzeK8sPodCreate {
+	if v != nil {
+		_c.SetPhase(*v)
+	}
+	return _c
+}
+
+// SetNodeName sets the "node_name" field.
+func (_c *BronzeK8sPodCreate) SetNodeName(v string) *BronzeK8sPodCreate {
+	_c.mutation.SetNodeName(v)
+	return _c
+}
+
+// SetNillableNodeName sets the "node_name" field if the given value is not nil.
+func (_c *BronzeK8sPodCreate) SetNillableNodeName(v *string) *BronzeK8sPodCreate {
+	if v != nil {
+		_c.SetNodeName(*v)
+	}
+	return _c
+}
+
+// SetPodIP sets the "pod_ip" field.
+func (_c *BronzeK8sPodCreate) SetPodIP(v string) *BronzeK8sPodCreate {
+	_c.mutation.SetPodIP(v)
+	return _c
+}
+
+// SetNillablePodIP sets the "pod_ip" field if the given value is not nil.
+func (_c *BronzeK8sPodCreate) SetNillablePodIP(v *string) *BronzeK8sPodCreate {
+	if v != nil {
+		_c.SetPodIP(*v)
+	}
+	return _c
+}
+
+// SetHostIP sets the "host_ip" field.
+func (_c *BronzeK8sPodCreate) SetHostIP(v string) *BronzeK8sPodCreate {
+	_c.mutation.SetHostIP(v)
+	return _c
+}
+
+// SetNillableHostIP sets the "host_ip" field if the given value is not nil.
+func (_c *BronzeK8sPodCreate) SetNillableHostIP(v *string) *BronzeK8sPodCreate {
+	if v != nil {
+		_c.SetHostIP(*v)
+	}
+	return _c
+}
+
+// SetServiceAccount sets the "service_account" field.
+func (_c *BronzeK8sPodCreate) SetServiceAccount(v string) *BronzeK8sPodCreate {
+	_c.mutation.SetServiceAccount(v)
+	return _c
+}
+
+// SetNillableServiceAccount sets the "service_account" field if the given value is not nil.
+func (_c *BronzeK8sPodCreate) SetNillableServiceAccount(v *string) *BronzeK8sPodCreate {
+	if v != nil {
+		_c.SetServiceAccount(*v)
+	}
+	return _c
+}
+
+// SetWorkloadKind sets the "workload_kind" field.
+func (_c *BronzeK8sPodCreate) SetWorkloadKind(v string) *BronzeK8sPodCreate {
+	_c.mutation.SetWorkloadKind(v)
+	return _c
+}
+
+// SetNillableWorkloadKind sets the "workload_kind" field if the given value is not nil.
+func (_c *BronzeK8sPodCreate) SetNillableWorkloadKind(v *string) *BronzeK8sPodCreate {
+	if v != nil {
+		_c.SetWorkloadKind(*v)
+	}
+	return _c
+}
+
+// SetWorkloadName sets the "workload_name" field.
+func (_c *BronzeK8sPodCreate) SetWorkloadName(v string) *BronzeK8sPodCreate {
+	_c.mutation.SetWorkloadName(v)
+	return _c
+}
+
+// SetNillableWorkloadName sets the "workload_name" field if the given value is not nil.
+func (_c *BronzeK8sPodCreate) SetNillableWorkloadName(v *string) *BronzeK8sPodCreate {
+	if v != nil {
+		_c.SetWorkloadName(*v)
+	}
+	return _c
+}
+
+// SetHostNetwork sets the "host_network" field.
+func (_c *BronzeK8sPodCreate) SetHostNetwork(v bool) *BronzeK8sPodCreate {
+	_c.mutation.SetHostNetwork(v)
+	return _c
+}
+
+// SetNillableHostNetwork sets the "host_network" field if the given value is not nil.
+func (_c *BronzeK8sPodCreate) SetNillableHostNetwork(v *bool) *BronzeK8sPodCreate {
+	if v != nil {
+		_c.SetHostNetwork(*v)
+	}
+	return _c
+}
+
+// SetContainersJSON sets the "containers_json" field.
+func (_c *BronzeK8sPodCreate) SetContainersJSON(v json.RawMessage) *BronzeK8sPodCreate {
+	_c.mutation.SetContainersJSON(v)
+	return _c
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_c *BronzeK8sPodCreate) SetLabelsJSON(v json.RawMessage) *BronzeK8sPodCreate {
+	_c.mutation.SetLabelsJSON(v)
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeK8sPodCreate) SetAPICreatedAt(v time.Time) *BronzeK8sPodCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeK8sPodCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sPodCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeK8sPodCreate) SetID(v string) *BronzeK8sPodCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeK8sPodMutation object of the builder.
+func (_c *BronzeK8sPodCreate) Mutation() *BronzeK8sPodMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeK8sPod in the database.
+func (_c *BronzeK8sPodCreate) Save(ctx context.Context) (*BronzeK8sPod, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeK8sPodCreate) SaveX(ctx context.Context) *BronzeK8sPod {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeK8sPodCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeK8sPodCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeK8sPodCreate) defaults() {
+	if _, ok := _c.mutation.HostNetwork(); !ok {
+		v := bronzek8spod.DefaultHostNetwork
+		_c.mutation.SetHostNetwork(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeK8sPodCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`kubernetes: missing required field "BronzeK8sPod.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`kubernetes: missing required field "BronzeK8sPod.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ClusterName(); !ok {
+		return &ValidationError{Name: "cluster_name", err: errors.New(`kubernetes: missing required field "BronzeK8sPod.cluster_name"`)}
+	}
+	if v, ok := _c.mutation.ClusterName(); ok {
+		if err := bronzek8spod.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sPod.cluster_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`kubernetes: missing required field "BronzeK8sPod.namespace"`)}
+	}
+	if v, ok := _c.mutation.Namespace(); ok {
+		if err := bronzek8spod.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sPod.namespace": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`kubernetes: missing required field "BronzeK8sPod.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := bronzek8spod.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sPod.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HostNetwork(); !ok {
+		return &ValidationError{Name: "host_network", err: errors.New(`kubernetes: missing required field "BronzeK8sPod.host_network"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeK8sPodCreate) sqlSave(ctx context.Context) (*BronzeK8sPod, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeK8sPod.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeK8sPodCreate) createSpec() (*BronzeK8sPod, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeK8sPod{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzek8spod.Table, sqlgraph.NewFieldSpec(bronzek8spod.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeK8sPod
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8spod.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzek8spod.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8spod.FieldClusterName, field.TypeString, value)
+		_node.ClusterName = value
+	}
+	if value, ok := _c.mutation.Namespace(); ok {
+		_spec.SetField(bronzek8spod.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzek8spod.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.UID(); ok {
+		_spec.SetField(bronzek8spod.FieldUID, field.TypeString, value)
+		_node.UID = value
+	}
+	if value, ok := _c.mutation.Phase(); ok {
+		_spec.SetField(bronzek8spod.FieldPhase, field.TypeString, value)
+		_node.Phase = value
+	}
+	if value, ok := _c.mutation.NodeName(); ok {
+		_spec.SetField(bronzek8spod.FieldNodeName, field.TypeString, value)
+		_node.NodeName = value
+	}
+	if value, ok := _c.mutation.PodIP(); ok {
+		_spec.SetField(bronzek8spod.FieldPodIP, field.TypeString, value)
+		_node.PodIP = value
+	}
+	if value, ok := _c.mutation.HostIP(); ok {
+		_spec.SetField(bronzek8spod.FieldHostIP, field.TypeString, value)
+		_node.HostIP = value
+	}
+	if value, ok := _c.mutation.ServiceAccount(); ok {
+		_spec.SetField(bronzek8spod.FieldServiceAccount, field.TypeString, value)
+		_node.ServiceAccount = value
+	}
+	if value, ok := _c.mutation.WorkloadKind(); ok {
+		_spec.SetField(bronzek8spod.FieldWorkloadKind, field.TypeString, value)
+		_node.WorkloadKind = value
+	}
+	if value, ok := _c.mutation.WorkloadName(); ok {
+		_spec.SetField(bronzek8spod.FieldWorkloadName, field.TypeString, value)
+		_node.WorkloadName = value
+	}
+	if value, ok := _c.mutation.HostNetwork(); ok {
+		_spec.SetField(bronzek8spod.FieldHostNetwork, field.TypeBool, value)
+		_node.HostNetwork = value
+	}
+	if value, ok := _c.mutation.ContainersJSON(); ok {
+		_spec.SetField(bronzek8spod.FieldContainersJSON, field.TypeJSON, value)
+		_node.ContainersJSON = value
+	}
+	if value, ok := _c.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8spod.FieldLabelsJSON, field.TypeJSON, value)
+		_node.LabelsJSON = value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8spod.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	return _node, _spec
+}
+
+// BronzeK8sPodCreateBulk is the builder for creating many BronzeK8sPod entities in bulk.
+type BronzeK8sPodCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeK8sPodCreate
+}
+
+// Save creates the BronzeK8sPod entities in the database.
+func (_c *BronzeK8sPodCreateBulk) Save(ctx context.Context) ([]*BronzeK8sPod, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeK8sPod, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeK8sPodMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeK8sPodCreateBulk) SaveX(ctx context.Context) []*BronzeK8sPod {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeK8sPodCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeK8sPodCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
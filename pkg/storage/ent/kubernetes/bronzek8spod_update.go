@@ -0,0 +1,983 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8spod"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeK8sPodUpdate is the builder for updating BronzeK8sPod entities.
+type BronzeK8sPodUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeK8sPodMutation
+}
+
+// Where appends a list predicates to the BronzeK8sPodUpdate builder.
+func (_u *BronzeK8sPodUpdate) Where(ps ...predicate.BronzeK8sPod) *BronzeK8sPodUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeK8sPodUpdate) SetCollectedAt(v time.Time) *BronzeK8sPodUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdate) SetNillableCollectedAt(v *time.Time) *BronzeK8sPodUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeK8sPodUpdate) SetClusterName(v string) *BronzeK8sPodUpdate {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdate) SetNillableClusterName(v *string) *BronzeK8sPodUpdate {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeK8sPodUpdate) SetNamespace(v string) *BronzeK8sPodUpdate {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdate) SetNillableNamespace(v *string) *BronzeK8sPodUpdate {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeK8sPodUpdate) SetName(v string) *BronzeK8sPodUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdate) SetNillableName(v *string) *BronzeK8sPodUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeK8sPodUpdate) SetUID(v string) *BronzeK8sPodUpdate {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdate) SetNillableUID(v *string) *BronzeK8sPodUpdate {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeK8sPodUpdate) ClearUID() *BronzeK8sPodUpdate {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetPhase sets the "phase" field.
+func (_u *BronzeK8sPodUpdate) SetPhase(v string) *BronzeK8sPodUpdate {
+	_u.mutation.SetPhase(v)
+	return _u
+}
+
+// SetNillablePhase sets the "phase" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdate) SetNillablePhase(v *string) *BronzeK8sPodUpdate {
+	if v != nil {
+		_u.SetPhase(*v)
+	}
+	return _u
+}
+
+// ClearPhase clears the value of the "phase" field.
+func (_u *BronzeK8sPodUpdate) ClearPhase() *BronzeK8sPodUpdate {
+	_u.mutation.ClearPhase()
+	return _u
+}
+
+// SetNodeName sets the "node_name" field.
+func (_u *BronzeK8sPodUpdate) SetNodeName(v string) *BronzeK8sPodUpdate {
+	_u.mutation.SetNodeName(v)
+	return _u
+}
+
+// SetNillableNodeName sets the "node_name" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdate) SetNillableNodeName(v *string) *BronzeK8sPodUpdate {
+	if v != nil {
+		_u.SetNodeName(*v)
+	}
+	return _u
+}
+
+// ClearNodeName clears the value of the "node_name" field.
+func (_u *BronzeK8sPodUpdate) ClearNodeName() *BronzeK8sPodUpdate {
+	_u.mutation.ClearNodeName()
+	return _u
+}
+
+// SetPodIP sets the "pod_ip" field.
+func (_u *BronzeK8sPodUpdate) SetPodIP(v string) *BronzeK8sPodUpdate {
+	_u.mutation.SetPodIP(v)
+	return _u
+}
+
+// SetNillablePodIP sets the "pod_ip" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdate) SetNillablePodIP(v *string) *BronzeK8sPodUpdate {
+	if v != nil {
+		_u.SetPodIP(*v)
+	}
+	return _u
+}
+
+// ClearPodIP clears the value of the "pod_ip" field.
+func (_u *BronzeK8sPodUpdate) ClearPodIP() *BronzeK8sPodUpdate {
+	_u.mutation.ClearPodIP()
+	return _u
+}
+
+// SetHostIP sets the "host_ip" field.
+func (_u *BronzeK8sPodUpdate) SetHostIP(v string) *BronzeK8sPodUpdate {
+	_u.mutation.SetHostIP(v)
+	return _u
+}
+
+// SetNillableHostIP sets the "host_ip" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdate) SetNillableHostIP(v *string) *BronzeK8sPodUpdate {
+	if v != nil {
+		_u.SetHostIP(*v)
+	}
+	return _u
+}
+
+// ClearHostIP clears the value of the "host_ip" field.
+func (_u *BronzeK8sPodUpdate) ClearHostIP() *BronzeK8sPodUpdate {
+	_u.mutation.ClearHostIP()
+	return _u
+}
+
+// SetServiceAccount sets the "service_account" field.
+func (_u *BronzeK8sPodUpdate) SetServiceAccount(v string) *BronzeK8sPodUpdate {
+	_u.mutation.SetServiceAccount(v)
+	return _u
+}
+
+// SetNillableServiceAccount sets the "service_account" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdate) SetNillableServiceAccount(v *string) *BronzeK8sPodUpdate {
+	if v != nil {
+		_u.SetServiceAccount(*v)
+	}
+	return _u
+}
+
+// ClearServiceAccount clears the value of the "service_account" field.
+func (_u *BronzeK8sPodUpdate) ClearServiceAccount() *BronzeK8sPodUpdate {
+	_u.mutation.ClearServiceAccount()
+	return _u
+}
+
+// SetWorkloadKind sets the "workload_kind" field.
+func (_u *BronzeK8sPodUpdate) SetWorkloadKind(v string) *BronzeK8sPodUpdate {
+	_u.mutation.SetWorkloadKind(v)
+	return _u
+}
+
+// SetNillableWorkloadKind sets the "workload_kind" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdate) SetNillableWorkloadKind(v *string) *BronzeK8sPodUpdate {
+	if v != nil {
+		_u.SetWorkloadKind(*v)
+	}
+	return _u
+}
+
+// ClearWorkloadKind clears the value of the "workload_kind" field.
+func (_u *BronzeK8sPodUpdate) ClearWorkloadKind() *BronzeK8sPodUpdate {
+	_u.mutation.ClearWorkloadKind()
+	return _u
+}
+
+// SetWorkloadName sets the "workload_name" field.
+func (_u *BronzeK8sPodUpdate) SetWorkloadName(v string) *BronzeK8sPodUpdate {
+	_u.mutation.SetWorkloadName(v)
+	return _u
+}
+
+// SetNillableWorkloadName sets the "workload_name" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdate) SetNillableWorkloadName(v *string) *BronzeK8sPodUpdate {
+	if v != nil {
+		_u.SetWorkloadName(*v)
+	}
+	return _u
+}
+
+// ClearWorkloadName clears the value of the "workload_name" field.
+func (_u *BronzeK8sPodUpdate) ClearWorkloadName() *BronzeK8sPodUpdate {
+	_u.mutation.ClearWorkloadName()
+	return _u
+}
+
+// SetHostNetwork sets the "host_network" field.
+func (_u *BronzeK8sPodUpdate) SetHostNetwork(v bool) *BronzeK8sPodUpdate {
+	_u.mutation.SetHostNetwork(v)
+	return _u
+}
+
+// SetNillableHostNetwork sets the "host_network" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdate) SetNillableHostNetwork(v *bool) *BronzeK8sPodUpdate {
+	if v != nil {
+		_u.SetHostNetwork(*v)
+	}
+	return _u
+}
+
+// SetContainersJSON sets the "containers_json" field.
+func (_u *BronzeK8sPodUpdate) SetContainersJSON(v json.RawMessage) *BronzeK8sPodUpdate {
+	_u.mutation.SetContainersJSON(v)
+	return _u
+}
+
+// AppendContainersJSON appends value to the "containers_json" field.
+func (_u *BronzeK8sPodUpdate) AppendContainersJSON(v json.RawMessage) *BronzeK8sPodUpdate {
+	_u.mutation.AppendContainersJSON(v)
+	return _u
+}
+
+// ClearContainersJSON clears the value of the "containers_json" field.
+func (_u *BronzeK8sPodUpdate) ClearContainersJSON() *BronzeK8sPodUpdate {
+	_u.mutation.ClearContainersJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeK8sPodUpdate) SetLabelsJSON(v json.RawMessage) *BronzeK8sPodUpdate {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeK8sPodUpdate) AppendLabelsJSON(v json.RawMessage) *BronzeK8sPodUpdate {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeK8sPodUpdate) ClearLabelsJSON() *BronzeK8sPodUpdate {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeK8sPodUpdate) SetAPICreatedAt(v time.Time) *BronzeK8sPodUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sPodUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeK8sPodUpdate) ClearAPICreatedAt() *BronzeK8sPodUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeK8sPodMutation object of the builder.
+func (_u *BronzeK8sPodUpdate) Mutation() *BronzeK8sPodMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeK8sPodUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeK8sPodUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeK8sPodUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeK8sPodUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeK8sPodUpdate) check() error {
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzek8spod.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sPod.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Namespace(); ok {
+		if err := bronzek8spod.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sPod.namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzek8spod.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sPod.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeK8sPodUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzek8spod.Table, bronzek8spod.Columns, sqlgraph.NewFieldSpec(bronzek8spod.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8spod.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8spod.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzek8spod.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzek8spod.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzek8spod.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzek8spod.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phase(); ok {
+		_spec.SetField(bronzek8spod.FieldPhase, field.TypeString, value)
+	}
+	if _u.mutation.PhaseCleared() {
+		_spec.ClearField(bronzek8spod.FieldPhase, field.TypeString)
+	}
+	if value, ok := _u.mutation.NodeName(); ok {
+		_spec.SetField(bronzek8spod.FieldNodeName, field.TypeString, value)
+	}
+	if _u.mutation.NodeNameCleared() {
+		_spec.ClearField(bronzek8spod.FieldNodeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.PodIP(); ok {
+		_spec.SetField(bronzek8spod.FieldPodIP, field.TypeString, value)
+	}
+	if _u.mutation.PodIPCleared() {
+		_spec.ClearField(bronzek8spod.FieldPodIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.HostIP(); ok {
+		_spec.SetField(bronzek8spod.FieldHostIP, field.TypeString, value)
+	}
+	if _u.mutation.HostIPCleared() {
+		_spec.ClearField(bronzek8spod.FieldHostIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.ServiceAccount(); ok {
+		_spec.SetField(bronzek8spod.FieldServiceAccount, field.TypeString, value)
+	}
+	if _u.mutation.ServiceAccountCleared() {
+		_spec.ClearField(bronzek8spod.FieldServiceAccount, field.TypeString)
+	}
+	if value, ok := _u.mutation.WorkloadKind(); ok {
+		_spec.SetField(bronzek8spod.FieldWorkloadKind, field.TypeString, value)
+	}
+	if _u.mutation.WorkloadKindCleared() {
+		_spec.ClearField(bronzek8spod.FieldWorkloadKind, field.TypeString)
+	}
+	if value, ok := _u.mutation.WorkloadName(); ok {
+		_spec.SetField(bronzek8spod.FieldWorkloadName, field.TypeString, value)
+	}
+	if _u.mutation.WorkloadNameCleared() {
+		_spec.ClearField(bronzek8spod.FieldWorkloadName, field.TypeString)
+	}
+	if value, ok := _u.mutation.HostNetwork(); ok {
+		_spec.SetField(bronzek8spod.FieldHostNetwork, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ContainersJSON(); ok {
+		_spec.SetField(bronzek8spod.FieldContainersJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedContainersJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8spod.FieldContainersJSON, value)
+		})
+	}
+	if _u.mutation.ContainersJSONCleared() {
+		_spec.ClearField(bronzek8spod.FieldContainersJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8spod.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8spod.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzek8spod.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8spod.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzek8spod.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeK8sPod
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzek8spod.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeK8sPodUpdateOne is the builder for updating a single BronzeK8sPod entity.
+type BronzeK8sPodUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeK8sPodMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeK8sPodUpdateOne) SetCollectedAt(v time.Time) *BronzeK8sPodUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeK8sPodUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeK8sPodUpdateOne) SetClusterName(v string) *BronzeK8sPodUpdateOne {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdateOne) SetNillableClusterName(v *string) *BronzeK8sPodUpdateOne {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeK8sPodUpdateOne) SetNamespace(v string) *BronzeK8sPodUpdateOne {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdateOne) SetNillableNamespace(v *string) *BronzeK8sPodUpdateOne {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeK8sPodUpdateOne) SetName(v string) *BronzeK8sPodUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdateOne) SetNillableName(v *string) *BronzeK8sPodUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeK8sPodUpdateOne) SetUID(v string) *BronzeK8sPodUpdateOne {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdateOne) SetNillableUID(v *string) *BronzeK8sPodUpdateOne {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeK8sPodUpdateOne) ClearUID() *BronzeK8sPodUpdateOne {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetPhase sets the "phase" field.
+func (_u *BronzeK8sPodUpdateOne) SetPhase(v string) *BronzeK8sPodUpdateOne {
+	_u.mutation.SetPhase(v)
+	return _u
+}
+
+// SetNillablePhase sets the "phase" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdateOne) SetNillablePhase(v *string) *BronzeK8sPodUpdateOne {
+	if v != nil {
+		_u.SetPhase(*v)
+	}
+	return _u
+}
+
+// ClearPhase clears the value of the "phase" field.
+func (_u *BronzeK8sPodUpdateOne) ClearPhase() *BronzeK8sPodUpdateOne {
+	_u.mutation.ClearPhase()
+	return _u
+}
+
+// SetNodeName sets the "node_name" field.
+func (_u *BronzeK8sPodUpdateOne) SetNodeName(v string) *BronzeK8sPodUpdateOne {
+	_u.mutation.SetNodeName(v)
+	return _u
+}
+
+// SetNillableNodeName sets the "node_name" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdateOne) SetNillableNodeName(v *string) *BronzeK8sPodUpdateOne {
+	if v != nil {
+		_u.SetNodeName(*v)
+	}
+	return _u
+}
+
+// ClearNodeName clears the value of the "node_name" field.
+func (_u *BronzeK8sPodUpdateOne) ClearNodeName() *BronzeK8sPodUpdateOne {
+	_u.mutation.ClearNodeName()
+	return _u
+}
+
+// SetPodIP sets the "pod_ip" field.
+func (_u *BronzeK8sPodUpdateOne) SetPodIP(v string) *BronzeK8sPodUpdateOne {
+	_u.mutation.SetPodIP(v)
+	return _u
+}
+
+// SetNillablePodIP sets the "pod_ip" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdateOne) SetNillablePodIP(v *string) *BronzeK8sPodUpdateOne {
+	if v != nil {
+		_u.SetPodIP(*v)
+	}
+	return _u
+}
+
+// ClearPodIP clears the value of the "pod_ip" field.
+func (_u *BronzeK8sPodUpdateOne) ClearPodIP() *BronzeK8sPodUpdateOne {
+	_u.mutation.ClearPodIP()
+	return _u
+}
+
+// SetHostIP sets the "host_ip" field.
+func (_u *BronzeK8sPodUpdateOne) SetHostIP(v string) *BronzeK8sPodUpdateOne {
+	_u.mutation.SetHostIP(v)
+	return _u
+}
+
+// SetNillableHostIP sets the "host_ip" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdateOne) SetNillableHostIP(v *string) *BronzeK8sPodUpdateOne {
+	if v != nil {
+		_u.SetHostIP(*v)
+	}
+	return _u
+}
+
+// ClearHostIP clears the value of the "host_ip" field.
+func (_u *BronzeK8sPodUpdateOne) ClearHostIP() *BronzeK8sPodUpdateOne {
+	_u.mutation.ClearHostIP()
+	return _u
+}
+
+// SetServiceAccount sets the "service_account" field.
+func (_u *BronzeK8sPodUpdateOne) SetServiceAccount(v string) *BronzeK8sPodUpdateOne {
+	_u.mutation.SetServiceAccount(v)
+	return _u
+}
+
+// SetNillableServiceAccount sets the "service_account" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdateOne) SetNillableServiceAccount(v *string) *BronzeK8sPodUpdateOne {
+	if v != nil {
+		_u.SetServiceAccount(*v)
+	}
+	return _u
+}
+
+// ClearServiceAccount clears the value of the "service_account" field.
+func (_u *BronzeK8sPodUpdateOne) ClearServiceAccount() *BronzeK8sPodUpdateOne {
+	_u.mutation.ClearServiceAccount()
+	return _u
+}
+
+// SetWorkloadKind sets the "workload_kind" field.
+func (_u *BronzeK8sPodUpdateOne) SetWorkloadKind(v string) *BronzeK8sPodUpdateOne {
+	_u.mutation.SetWorkloadKind(v)
+	return _u
+}
+
+// SetNillableWorkloadKind sets the "workload_kind" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdateOne) SetNillableWorkloadKind(v *string) *BronzeK8sPodUpdateOne {
+	if v != nil {
+		_u.SetWorkloadKind(*v)
+	}
+	return _u
+}
+
+// ClearWorkloadKind clears the value of the "workload_kind" field.
+func (_u *BronzeK8sPodUpdateOne) ClearWorkloadKind() *BronzeK8sPodUpdateOne {
+	_u.mutation.ClearWorkloadKind()
+	return _u
+}
+
+// SetWorkloadName sets the "workload_name" field.
+func (_u *BronzeK8sPodUpdateOne) SetWorkloadName(v string) *BronzeK8sPodUpdateOne {
+	_u.mutation.SetWorkloadName(v)
+	return _u
+}
+
+// SetNillableWorkloadName sets the "workload_name" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdateOne) SetNillableWorkloadName(v *string) *BronzeK8sPodUpdateOne {
+	if v != nil {
+		_u.SetWorkloadName(*v)
+	}
+	return _u
+}
+
+// ClearWorkloadName clears the value of the "workload_name" field.
+func (_u *BronzeK8sPodUpdateOne) ClearWorkloadName() *BronzeK8sPodUpdateOne {
+	_u.mutation.ClearWorkloadName()
+	return _u
+}
+
+// SetHostNetwork sets the "host_network" field.
+func (_u *BronzeK8sPodUpdateOne) SetHostNetwork(v bool) *BronzeK8sPodUpdateOne {
+	_u.mutation.SetHostNetwork(v)
+	return _u
+}
+
+// SetNillableHostNetwork sets the "host_network" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdateOne) SetNillableHostNetwork(v *bool) *BronzeK8sPodUpdateOne {
+	if v != nil {
+		_u.SetHostNetwork(*v)
+	}
+	return _u
+}
+
+// SetContainersJSON sets the "containers_json" field.
+func (_u *BronzeK8sPodUpdateOne) SetContainersJSON(v json.RawMessage) *BronzeK8sPodUpdateOne {
+	_u.mutation.SetContainersJSON(v)
+	return _u
+}
+
+// AppendContainersJSON appends value to the "containers_json" field.
+func (_u *BronzeK8sPodUpdateOne) AppendContainersJSON(v json.RawMessage) *BronzeK8sPodUpdateOne {
+	_u.mutation.AppendContainersJSON(v)
+	return _u
+}
+
+// ClearContainersJSON clears the value of the "containers_json" field.
+func (_u *BronzeK8sPodUpdateOne) ClearContainersJSON() *BronzeK8sPodUpdateOne {
+	_u.mutation.ClearContainersJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeK8sPodUpdateOne) SetLabelsJSON(v json.RawMessage) *BronzeK8sPodUpdateOne {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeK8sPodUpdateOne) AppendLabelsJSON(v json.RawMessage) *BronzeK8sPodUpdateOne {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeK8sPodUpdateOne) ClearLabelsJSON() *BronzeK8sPodUpdateOne {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeK8sPodUpdateOne) SetAPICreatedAt(v time.Time) *BronzeK8sPodUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeK8sPodUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sPodUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeK8sPodUpdateOne) ClearAPICreatedAt() *BronzeK8sPodUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeK8sPodMutation object of the builder.
+func (_u *BronzeK8sPodUpdateOne) Mutation() *BronzeK8sPodMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeK8sPodUpdate builder.
+func (_u *BronzeK8sPodUpdateOne) Where(ps ...predicate.BronzeK8sPod) *BronzeK8sPodUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeK8sPodUpdateOne) Select(field string, fields ...string) *BronzeK8sPodUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeK8sPod entity.
+func (_u *BronzeK8sPodUpdateOne) Save(ctx context.Context) (*BronzeK8sPod, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeK8sPodUpdateOne) SaveX(ctx context.Context) *BronzeK8sPod {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeK8sPodUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeK8sPodUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeK8sPodUpdateOne) check() error {
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzek8spod.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sPod.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Namespace(); ok {
+		if err := bronzek8spod.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sPod.namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzek8spod.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sPod.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeK8sPodUpdateOne) sqlSave(ctx context.Context) (_node *BronzeK8sPod, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzek8spod.Table, bronzek8spod.Columns, sqlgraph.NewFieldSpec(bronzek8spod.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`kubernetes: missing "BronzeK8sPod.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzek8spod.FieldID)
+		for _, f := range fields {
+			if !bronzek8spod.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("kubernetes: invalid field %q for query", f)}
+			}
+			if f != bronzek8spod.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8spod.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8spod.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzek8spod.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzek8spod.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzek8spod.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzek8spod.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phase(); ok {
+		_spec.SetField(bronzek8spod.FieldPhase, field.TypeString, value)
+	}
+	if _u.mutation.PhaseCleared() {
+		_spec.ClearField(bronzek8spod.FieldPhase, field.TypeString)
+	}
+	if value, ok := _u.mutation.NodeName(); ok {
+		_spec.SetField(bronzek8spod.FieldNodeName, field.TypeString, value)
+	}
+	if _u.mutation.NodeNameCleared() {
+		_spec.ClearField(bronzek8spod.FieldNodeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.PodIP(); ok {
+		_spec.SetField(bronzek8spod.FieldPodIP, field.TypeString, value)
+	}
+	if _u.mutation.PodIPCleared() {
+		_spec.ClearField(bronzek8spod.FieldPodIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.HostIP(); ok {
+		_spec.SetField(bronzek8spod.FieldHostIP, field.TypeString, value)
+	}
+	if _u.mutation.HostIPCleared() {
+		_spec.ClearField(bronzek8spod.FieldHostIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.ServiceAccount(); ok {
+		_spec.SetField(bronzek8spod.FieldServiceAccount, field.TypeString, value)
+	}
+	if _u.mutation.ServiceAccountCleared() {
+		_spec.ClearField(bronzek8spod.FieldServiceAccount, field.TypeString)
+	}
+	if value, ok := _u.mutation.WorkloadKind(); ok {
+		_spec.SetField(bronzek8spod.FieldWorkloadKind, field.TypeString, value)
+	}
+	if _u.mutation.WorkloadKindCleared() {
+		_spec.ClearField(bronzek8spod.FieldWorkloadKind, field.TypeString)
+	}
+	if value, ok := _u.mutation.WorkloadName(); ok {
+		_spec.SetField(bronzek8spod.FieldWorkloadName, field.TypeString, value)
+	}
+	if _u.mutation.WorkloadNameCleared() {
+		_spec.ClearField(bronzek8spod.FieldWorkloadName, field.TypeString)
+	}
+	if value, ok := _u.mutation.HostNetwork(); ok {
+		_spec.SetField(bronzek8spod.FieldHostNetwork, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ContainersJSON(); ok {
+		_spec.SetField(bronzek8spod.FieldContainersJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedContainersJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8spod.FieldContainersJSON, value)
+		})
+	}
+	if _u.mutation.ContainersJSONCleared() {
+		_spec.ClearField(bronzek8spod.FieldContainersJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8spod.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8spod.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzek8spod.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8spod.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzek8spod.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeK8sPod
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeK8sPod{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzek8spod.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,765 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzek8srolebinding
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ClusterName applies equality check predicate on the "cluster_name" field. It's identical to ClusterNameEQ.
+func ClusterName(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldClusterName, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldKind, v))
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldNamespace, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldName, v))
+}
+
+// UID applies equality check predicate on the "uid" field. It's identical to UIDEQ.
+func UID(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldUID, v))
+}
+
+// RoleKind applies equality check predicate on the "role_kind" field. It's identical to RoleKindEQ.
+func RoleKind(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldRoleKind, v))
+}
+
+// RoleName applies equality check predicate on the "role_name" field. It's identical to RoleNameEQ.
+func RoleName(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldRoleName, v))
+}
+
+// APICreatedAt applies equality check predicate on the "api_created_at" field. It's identical to APICreatedAtEQ.
+func APICreatedAt(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ClusterNameEQ applies the EQ predicate on the "cluster_name" field.
+func ClusterNameEQ(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldClusterName, v))
+}
+
+// ClusterNameNEQ applies the NEQ predicate on the "cluster_name" field.
+func ClusterNameNEQ(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNEQ(FieldClusterName, v))
+}
+
+// ClusterNameIn applies the In predicate on the "cluster_name" field.
+func ClusterNameIn(vs ...string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldIn(FieldClusterName, vs...))
+}
+
+// ClusterNameNotIn applies the NotIn predicate on the "cluster_name" field.
+func ClusterNameNotIn(vs ...string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNotIn(FieldClusterName, vs...))
+}
+
+// ClusterNameGT applies the GT predicate on the "cluster_name" field.
+func ClusterNameGT(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGT(FieldClusterName, v))
+}
+
+// ClusterNameGTE applies the GTE predicate on the "cluster_name" field.
+func ClusterNameGTE(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGTE(FieldClusterName, v))
+}
+
+// ClusterNameLT applies the LT predicate on the "cluster_name" field.
+func ClusterNameLT(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLT(FieldClusterName, v))
+}
+
+// ClusterNameLTE applies the LTE predicate on the "cluster_name" field.
+func ClusterNameLTE(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLTE(FieldClusterName, v))
+}
+
+// ClusterNameContains applies the Contains predicate on the "cluster_name" field.
+func ClusterNameContains(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldContains(FieldClusterName, v))
+}
+
+// ClusterNameHasPrefix applies the HasPrefix predicate on the "cluster_name" field.
+func ClusterNameHasPrefix(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldHasPrefix(FieldClusterName, v))
+}
+
+// ClusterNameHasSuffix applies the HasSuffix predicate on the "cluster_name" field.
+func ClusterNameHasSuffix(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldHasSuffix(FieldClusterName, v))
+}
+
+// ClusterNameEqualFold applies the EqualFold predicate on the "cluster_name" field.
+func ClusterNameEqualFold(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEqualFold(FieldClusterName, v))
+}
+
+// ClusterNameContainsFold applies the ContainsFold predicate on the "cluster_name" field.
+func ClusterNameContainsFold(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldContainsFold(FieldClusterName, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldContainsFold(FieldKind, v))
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldNamespace, v))
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNEQ(FieldNamespace, v))
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldIn(FieldNamespace, vs...))
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNotIn(FieldNamespace, vs...))
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGT(FieldNamespace, v))
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGTE(FieldNamespace, v))
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLT(FieldNamespace, v))
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLTE(FieldNamespace, v))
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldContains(FieldNamespace, v))
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldHasPrefix(FieldNamespace, v))
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldHasSuffix(FieldNamespace, v))
+}
+
+// NamespaceIsNil applies the IsNil predicate on the "namespace" field.
+func NamespaceIsNil() predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldIsNull(FieldNamespace))
+}
+
+// NamespaceNotNil applies the NotNil predicate on the "namespace" field.
+func NamespaceNotNil() predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNotNull(FieldNamespace))
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEqualFold(FieldNamespace, v))
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldContainsFold(FieldNamespace, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldContainsFold(FieldName, v))
+}
+
+// UIDEQ applies the EQ predicate on the "uid" field.
+func UIDEQ(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldUID, v))
+}
+
+// UIDNEQ applies the NEQ predicate on the "uid" field.
+func UIDNEQ(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNEQ(FieldUID, v))
+}
+
+// UIDIn applies the In predicate on the "uid" field.
+func UIDIn(vs ...string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldIn(FieldUID, vs...))
+}
+
+// UIDNotIn applies the NotIn predicate on the "uid" field.
+func UIDNotIn(vs ...string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNotIn(FieldUID, vs...))
+}
+
+// UIDGT applies the GT predicate on the "uid" field.
+func UIDGT(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGT(FieldUID, v))
+}
+
+// UIDGTE applies the GTE predicate on the "uid" field.
+func UIDGTE(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGTE(FieldUID, v))
+}
+
+// UIDLT applies the LT predicate on the "uid" field.
+func UIDLT(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLT(FieldUID, v))
+}
+
+// UIDLTE applies the LTE predicate on the "uid" field.
+func UIDLTE(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLTE(FieldUID, v))
+}
+
+// UIDContains applies the Contains predicate on the "uid" field.
+func UIDContains(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldContains(FieldUID, v))
+}
+
+// UIDHasPrefix applies the HasPrefix predicate on the "uid" field.
+func UIDHasPrefix(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldHasPrefix(FieldUID, v))
+}
+
+// UIDHasSuffix applies the HasSuffix predicate on the "uid" field.
+func UIDHasSuffix(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldHasSuffix(FieldUID, v))
+}
+
+// UIDIsNil applies the IsNil predicate on the "uid" field.
+func UIDIsNil() predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldIsNull(FieldUID))
+}
+
+// UIDNotNil applies the NotNil predicate on the "uid" field.
+func UIDNotNil() predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNotNull(FieldUID))
+}
+
+// UIDEqualFold applies the EqualFold predicate on the "uid" field.
+func UIDEqualFold(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEqualFold(FieldUID, v))
+}
+
+// UIDContainsFold applies the ContainsFold predicate on the "uid" field.
+func UIDContainsFold(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldContainsFold(FieldUID, v))
+}
+
+// RoleKindEQ applies the EQ predicate on the "role_kind" field.
+func RoleKindEQ(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldRoleKind, v))
+}
+
+// RoleKindNEQ applies the NEQ predicate on the "role_kind" field.
+func RoleKindNEQ(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNEQ(FieldRoleKind, v))
+}
+
+// RoleKindIn applies the In predicate on the "role_kind" field.
+func RoleKindIn(vs ...string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldIn(FieldRoleKind, vs...))
+}
+
+// RoleKindNotIn applies the NotIn predicate on the "role_kind" field.
+func RoleKindNotIn(vs ...string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNotIn(FieldRoleKind, vs...))
+}
+
+// RoleKindGT applies the GT predicate on the "role_kind" field.
+func RoleKindGT(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGT(FieldRoleKind, v))
+}
+
+// RoleKindGTE applies the GTE predicate on the "role_kind" field.
+func RoleKindGTE(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGTE(FieldRoleKind, v))
+}
+
+// RoleKindLT applies the LT predicate on the "role_kind" field.
+func RoleKindLT(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLT(FieldRoleKind, v))
+}
+
+// RoleKindLTE applies the LTE predicate on the "role_kind" field.
+func RoleKindLTE(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLTE(FieldRoleKind, v))
+}
+
+// RoleKindContains applies the Contains predicate on the "role_kind" field.
+func RoleKindContains(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldContains(FieldRoleKind, v))
+}
+
+// RoleKindHasPrefix applies the HasPrefix predicate on the "role_kind" field.
+func RoleKindHasPrefix(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldHasPrefix(FieldRoleKind, v))
+}
+
+// RoleKindHasSuffix applies the HasSuffix predicate on the "role_kind" field.
+func RoleKindHasSuffix(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldHasSuffix(FieldRoleKind, v))
+}
+
+// RoleKindEqualFold applies the EqualFold predicate on the "role_kind" field.
+func RoleKindEqualFold(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEqualFold(FieldRoleKind, v))
+}
+
+// RoleKindContainsFold applies the ContainsFold predicate on the "role_kind" field.
+func RoleKindContainsFold(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldContainsFold(FieldRoleKind, v))
+}
+
+// RoleNameEQ applies the EQ predicate on the "role_name" field.
+func RoleNameEQ(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldRoleName, v))
+}
+
+// RoleNameNEQ applies the NEQ predicate on the "role_name" field.
+func RoleNameNEQ(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNEQ(FieldRoleName, v))
+}
+
+// RoleNameIn applies the In predicate on the "role_name" field.
+func RoleNameIn(vs ...string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldIn(FieldRoleName, vs...))
+}
+
+// RoleNameNotIn applies the NotIn predicate on the "role_name" field.
+func RoleNameNotIn(vs ...string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNotIn(FieldRoleName, vs...))
+}
+
+// RoleNameGT applies the GT predicate on the "role_name" field.
+func RoleNameGT(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGT(FieldRoleName, v))
+}
+
+// RoleNameGTE applies the GTE predicate on the "role_name" field.
+func RoleNameGTE(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGTE(FieldRoleName, v))
+}
+
+// RoleNameLT applies the LT predicate on the "role_name" field.
+func RoleNameLT(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLT(FieldRoleName, v))
+}
+
+// RoleNameLTE applies the LTE predicate on the "role_name" field.
+func RoleNameLTE(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLTE(FieldRoleName, v))
+}
+
+// RoleNameContains applies the Contains predicate on the "role_name" field.
+func RoleNameContains(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldContains(FieldRoleName, v))
+}
+
+// RoleNameHasPrefix applies the HasPrefix predicate on the "role_name" field.
+func RoleNameHasPrefix(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldHasPrefix(FieldRoleName, v))
+}
+
+// RoleNameHasSuffix applies the HasSuffix predicate on the "role_name" field.
+func RoleNameHasSuffix(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldHasSuffix(FieldRoleName, v))
+}
+
+// RoleNameEqualFold applies the EqualFold predicate on the "role_name" field.
+func RoleNameEqualFold(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEqualFold(FieldRoleName, v))
+}
+
+// RoleNameContainsFold applies the ContainsFold predicate on the "role_name" field.
+func RoleNameContainsFold(v string) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldContainsFold(FieldRoleName, v))
+}
+
+// SubjectsJSONIsNil applies the IsNil predicate on the "subjects_json" field.
+func SubjectsJSONIsNil() predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldIsNull(FieldSubjectsJSON))
+}
+
+// SubjectsJSONNotNil applies the NotNil predicate on the "subjects_json" field.
+func SubjectsJSONNotNil() predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNotNull(FieldSubjectsJSON))
+}
+
+// RulesJSONIsNil applies the IsNil predicate on the "rules_json" field.
+func RulesJSONIsNil() predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldIsNull(FieldRulesJSON))
+}
+
+// RulesJSONNotNil applies the NotNil predicate on the "rules_json" field.
+func RulesJSONNotNil() predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNotNull(FieldRulesJSON))
+}
+
+// LabelsJSONIsNil applies the IsNil predicate on the "labels_json" field.
+func LabelsJSONIsNil() predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldIsNull(FieldLabelsJSON))
+}
+
+// LabelsJSONNotNil applies the NotNil predicate on the "labels_json" field.
+func LabelsJSONNotNil() predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNotNull(FieldLabelsJSON))
+}
+
+// APICreatedAtEQ applies the EQ predicate on the "api_created_at" field.
+func APICreatedAtEQ(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtNEQ applies the NEQ predicate on the "api_created_at" field.
+func APICreatedAtNEQ(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtIn applies the In predicate on the "api_created_at" field.
+func APICreatedAtIn(vs ...time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtNotIn applies the NotIn predicate on the "api_created_at" field.
+func APICreatedAtNotIn(vs ...time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNotIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtGT applies the GT predicate on the "api_created_at" field.
+func APICreatedAtGT(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtGTE applies the GTE predicate on the "api_created_at" field.
+func APICreatedAtGTE(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldGTE(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLT applies the LT predicate on the "api_created_at" field.
+func APICreatedAtLT(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLTE applies the LTE predicate on the "api_created_at" field.
+func APICreatedAtLTE(v time.Time) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldLTE(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtIsNil applies the IsNil predicate on the "api_created_at" field.
+func APICreatedAtIsNil() predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldIsNull(FieldAPICreatedAt))
+}
+
+// APICreatedAtNotNil applies the NotNil predicate on the "api_created_at" field.
+func APICreatedAtNotNil() predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.FieldNotNull(FieldAPICreatedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeK8sRoleBinding) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeK8sRoleBinding) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeK8sRoleBinding) predicate.BronzeK8sRoleBinding {
+	return predicate.BronzeK8sRoleBinding(sql.NotPredicates(p))
+}
@@ -0,0 +1,384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8srolebinding"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeK8sRoleBindingCreate is the builder for creating a BronzeK8sRoleBinding entity.
+type BronzeK8sRoleBindingCreate struct {
+	config
+	mutation *BronzeK8sRoleBindingMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeK8sRoleBindingCreate) SetCollectedAt(v time.Time) *BronzeK8sRoleBindingCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeK8sRoleBindingCreate) SetFirstCollectedAt(v time.Time) *BronzeK8sRoleBindingCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_c *BronzeK8sRoleBindingCreate) SetClusterName(v string) *BronzeK8sRoleBindingCreate {
+	_c.mutation.SetClusterName(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *BronzeK8sRoleBindingCreate) SetKind(v string) *BronzeK8sRoleBindingCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNamespace sets the "namespace" field.
+func (_c *BronzeK8sRoleBindingCreate) SetNamespace(v string) *BronzeK8sRoleBindingCreate {
+	_c.mutation.SetNamespace(v)
+	return _c
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_c *BronzeK8sRoleBindingCreate) SetNillableNamespace(v *string) *BronzeK8sRoleBindingCreate {
+	if v != nil {
+		_c.SetNamespace(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeK8sRoleBindingCreate) SetName(v string) *BronzeK8sRoleBindingCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetUID sets the "uid" field.
+func (_c *BronzeK8sRoleBindingCreate) SetUID(v string) *BronzeK8sRoleBindingCreate {
+	_c.mutation.SetUID(v)
+	return _c
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_c *BronzeK8sRoleBindingCreate) SetNillableUID(v *string) *BronzeK8sRoleBindingCreate {
+	if v != nil {
+		_c.SetUID(*v)
+	}
+	return _c
+}
+
+// SetRoleKind sets the "role_kind" field.
+func (_c *BronzeK8sRoleBindingCreate) SetRoleKind(v string) *BronzeK8sRoleBindingCreate {
+	_c.mutation.SetRoleKind(v)
+	return _c
+}
+
+// SetRoleName sets the "role_name" field.
+func (_c *BronzeK8sRoleBindingCreate) SetRoleName(v string) *BronzeK8sRoleBindingCreate {
+	_c.mutation.SetRoleName(v)
+	return _c
+}
+
+// SetSubjectsJSON sets the "subjects_json" field.
+func (_c *BronzeK8sRoleBindingCreate) SetSubjectsJSON(v json.RawMessage) *BronzeK8sRoleBindingCreate {
+	_c.mutation.SetSubjectsJSON(v)
+	return _c
+}
+
+// SetRulesJSON sets the "rules_json" field.
+func (_c *BronzeK8sRoleBindingCreate) SetRulesJSON(v json.RawMessage) *BronzeK8sRoleBindingCreate {
+	_c.mutation.SetRulesJSON(v)
+	return _c
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_c *BronzeK8sRoleBindingCreate) SetLabelsJSON(v json.RawMessage) *BronzeK8sRoleBindingCreate {
+	_c.mutation.SetLabelsJSON(v)
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeK8sRoleBindingCreate) SetAPICreatedAt(v time.Time) *BronzeK8sRoleBindingCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeK8sRoleBindingCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sRoleBindingCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeK8sRoleBindingCreate) SetID(v string) *BronzeK8sRoleBindingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeK8sRoleBindingMutation object of the builder.
+func (_c *BronzeK8sRoleBindingCreate) Mutation() *BronzeK8sRoleBindingMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeK8sRoleBinding in the database.
+func (_c *BronzeK8sRoleBindingCreate) Save(ctx context.Context) (*BronzeK8sRoleBinding, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeK8sRoleBindingCreate) SaveX(ctx context.Context) *BronzeK8sRoleBinding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeK8sRoleBindingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeK8sRoleBindingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeK8sRoleBindingCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`kubernetes: missing required field "BronzeK8sRoleBinding.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`kubernetes: missing required field "BronzeK8sRoleBinding.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ClusterName(); !ok {
+		return &ValidationError{Name: "cluster_name", err: errors.New(`kubernetes: missing required field "BronzeK8sRoleBinding.cluster_name"`)}
+	}
+	if v, ok := _c.mutation.ClusterName(); ok {
+		if err := bronzek8srolebinding.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sRoleBinding.cluster_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`kubernetes: missing required field "BronzeK8sRoleBinding.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := bronzek8srolebinding.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sRoleBinding.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`kubernetes: missing required field "BronzeK8sRoleBinding.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := bronzek8srolebinding.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sRoleBinding.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RoleKind(); !ok {
+		return &ValidationError{Name: "role_kind", err: errors.New(`kubernetes: missing required field "BronzeK8sRoleBinding.role_kind"`)}
+	}
+	if v, ok := _c.mutation.RoleKind(); ok {
+		if err := bronzek8srolebinding.RoleKindValidator(v); err != nil {
+			return &ValidationError{Name: "role_kind", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sRoleBinding.role_kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RoleName(); !ok {
+		return &ValidationError{Name: "role_name", err: errors.New(`kubernetes: missing required field "BronzeK8sRoleBinding.role_name"`)}
+	}
+	if v, ok := _c.mutation.RoleName(); ok {
+		if err := bronzek8srolebinding.RoleNameValidator(v); err != nil {
+			return &ValidationError{Name: "role_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sRoleBinding.role_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeK8sRoleBindingCreate) sqlSave(ctx context.Context) (*BronzeK8sRoleBinding, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeK8sRoleBinding.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeK8sRoleBindingCreate) createSpec() (*BronzeK8sRoleBinding, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeK8sRoleBinding{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzek8srolebinding.Table, sqlgraph.NewFieldSpec(bronzek8srolebinding.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeK8sRoleBinding
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldClusterName, field.TypeString, value)
+		_node.ClusterName = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Namespace(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.UID(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldUID, field.TypeString, value)
+		_node.UID = value
+	}
+	if value, ok := _c.mutation.RoleKind(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldRoleKind, field.TypeString, value)
+		_node.RoleKind = value
+	}
+	if value, ok := _c.mutation.RoleName(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldRoleName, field.TypeString, value)
+		_node.RoleName = value
+	}
+	if value, ok := _c.mutation.SubjectsJSON(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldSubjectsJSON, field.TypeJSON, value)
+		_node.SubjectsJSON = value
+	}
+	if value, ok := _c.mutation.RulesJSON(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldRulesJSON, field.TypeJSON, value)
+		_node.RulesJSON = value
+	}
+	if value, ok := _c.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldLabelsJSON, field.TypeJSON, value)
+		_node.LabelsJSON = value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	return _node, _spec
+}
+
+// BronzeK8sRoleBindingCreateBulk is the builder for creating many BronzeK8sRoleBinding entities in bulk.
+type BronzeK8sRoleBindingCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeK8sRoleBindingCreate
+}
+
+// Save creates the BronzeK8sRoleBinding entities in the database.
+func (_c *BronzeK8sRoleBindingCreateBulk) Save(ctx context.Context) ([]*BronzeK8sRoleBinding, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeK8sRoleBinding, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeK8sRoleBindingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeK8sRoleBindingCreateBulk) SaveX(ctx context.Context) []*BronzeK8sRoleBinding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeK8sRoleBindingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeK8sRoleBindingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
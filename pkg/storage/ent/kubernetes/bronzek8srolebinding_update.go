@@ -0,0 +1,783 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8srolebinding"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeK8sRoleBindingUpdate is the builder for updating BronzeK8sRoleBinding entities.
+type BronzeK8sRoleBindingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeK8sRoleBindingMutation
+}
+
+// Where appends a list predicates to the BronzeK8sRoleBindingUpdate builder.
+func (_u *BronzeK8sRoleBindingUpdate) Where(ps ...predicate.BronzeK8sRoleBinding) *BronzeK8sRoleBindingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeK8sRoleBindingUpdate) SetCollectedAt(v time.Time) *BronzeK8sRoleBindingUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeK8sRoleBindingUpdate) SetNillableCollectedAt(v *time.Time) *BronzeK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeK8sRoleBindingUpdate) SetClusterName(v string) *BronzeK8sRoleBindingUpdate {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeK8sRoleBindingUpdate) SetNillableClusterName(v *string) *BronzeK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *BronzeK8sRoleBindingUpdate) SetKind(v string) *BronzeK8sRoleBindingUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *BronzeK8sRoleBindingUpdate) SetNillableKind(v *string) *BronzeK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeK8sRoleBindingUpdate) SetNamespace(v string) *BronzeK8sRoleBindingUpdate {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeK8sRoleBindingUpdate) SetNillableNamespace(v *string) *BronzeK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// ClearNamespace clears the value of the "namespace" field.
+func (_u *BronzeK8sRoleBindingUpdate) ClearNamespace() *BronzeK8sRoleBindingUpdate {
+	_u.mutation.ClearNamespace()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeK8sRoleBindingUpdate) SetName(v string) *BronzeK8sRoleBindingUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeK8sRoleBindingUpdate) SetNillableName(v *string) *BronzeK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeK8sRoleBindingUpdate) SetUID(v string) *BronzeK8sRoleBindingUpdate {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeK8sRoleBindingUpdate) SetNillableUID(v *string) *BronzeK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeK8sRoleBindingUpdate) ClearUID() *BronzeK8sRoleBindingUpdate {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetRoleKind sets the "role_kind" field.
+func (_u *BronzeK8sRoleBindingUpdate) SetRoleKind(v string) *BronzeK8sRoleBindingUpdate {
+	_u.mutation.SetRoleKind(v)
+	return _u
+}
+
+// SetNillableRoleKind sets the "role_kind" field if the given value is not nil.
+func (_u *BronzeK8sRoleBindingUpdate) SetNillableRoleKind(v *string) *BronzeK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetRoleKind(*v)
+	}
+	return _u
+}
+
+// SetRoleName sets the "role_name" field.
+func (_u *BronzeK8sRoleBindingUpdate) SetRoleName(v string) *BronzeK8sRoleBindingUpdate {
+	_u.mutation.SetRoleName(v)
+	return _u
+}
+
+// SetNillableRoleName sets the "role_name" field if the given value is not nil.
+func (_u *BronzeK8sRoleBindingUpdate) SetNillableRoleName(v *string) *BronzeK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetRoleName(*v)
+	}
+	return _u
+}
+
+// SetSubjectsJSON sets the "subjects_json" field.
+func (_u *BronzeK8sRoleBindingUpdate) SetSubjectsJSON(v json.RawMessage) *BronzeK8sRoleBindingUpdate {
+	_u.mutation.SetSubjectsJSON(v)
+	return _u
+}
+
+// AppendSubjectsJSON appends value to the "subjects_json" field.
+func (_u *BronzeK8sRoleBindingUpdate) AppendSubjectsJSON(v json.RawMessage) *BronzeK8sRoleBindingUpdate {
+	_u.mutation.AppendSubjectsJSON(v)
+	return _u
+}
+
+// ClearSubjectsJSON clears the value of the "subjects_json" field.
+func (_u *BronzeK8sRoleBindingUpdate) ClearSubjectsJSON() *BronzeK8sRoleBindingUpdate {
+	_u.mutation.ClearSubjectsJSON()
+	return _u
+}
+
+// SetRulesJSON sets the "rules_json" field.
+func (_u *BronzeK8sRoleBindingUpdate) SetRulesJSON(v json.RawMessage) *BronzeK8sRoleBindingUpdate {
+	_u.mutation.SetRulesJSON(v)
+	return _u
+}
+
+// AppendRulesJSON appends value to the "rules_json" field.
+func (_u *BronzeK8sRoleBindingUpdate) AppendRulesJSON(v json.RawMessage) *BronzeK8sRoleBindingUpdate {
+	_u.mutation.AppendRulesJSON(v)
+	return _u
+}
+
+// ClearRulesJSON clears the value of the "rules_json" field.
+func (_u *BronzeK8sRoleBindingUpdate) ClearRulesJSON() *BronzeK8sRoleBindingUpdate {
+	_u.mutation.ClearRulesJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeK8sRoleBindingUpdate) SetLabelsJSON(v json.RawMessage) *BronzeK8sRoleBindingUpdate {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeK8sRoleBindingUpdate) AppendLabelsJSON(v json.RawMessage) *BronzeK8sRoleBindingUpdate {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeK8sRoleBindingUpdate) ClearLabelsJSON() *BronzeK8sRoleBindingUpdate {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeK8sRoleBindingUpdate) SetAPICreatedAt(v time.Time) *BronzeK8sRoleBindingUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeK8sRoleBindingUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sRoleBindingUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeK8sRoleBindingUpdate) ClearAPICreatedAt() *BronzeK8sRoleBindingUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeK8sRoleBindingMutation object of the builder.
+func (_u *BronzeK8sRoleBindingUpdate) Mutation() *BronzeK8sRoleBindingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeK8sRoleBindingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeK8sRoleBindingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeK8sRoleBindingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeK8sRoleBindingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeK8sRoleBindingUpdate) check() error {
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzek8srolebinding.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sRoleBinding.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := bronzek8srolebinding.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sRoleBinding.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzek8srolebinding.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sRoleBinding.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RoleKind(); ok {
+		if err := bronzek8srolebinding.RoleKindValidator(v); err != nil {
+			return &ValidationError{Name: "role_kind", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sRoleBinding.role_kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RoleName(); ok {
+		if err := bronzek8srolebinding.RoleNameValidator(v); err != nil {
+			return &ValidationError{Name: "role_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sRoleBinding.role_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeK8sRoleBindingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzek8srolebinding.Table, bronzek8srolebinding.Columns, sqlgraph.NewFieldSpec(bronzek8srolebinding.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldNamespace, field.TypeString, value)
+	}
+	if _u.mutation.NamespaceCleared() {
+		_spec.ClearField(bronzek8srolebinding.FieldNamespace, field.TypeString)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzek8srolebinding.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RoleKind(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldRoleKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RoleName(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldRoleName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SubjectsJSON(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldSubjectsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSubjectsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8srolebinding.FieldSubjectsJSON, value)
+		})
+	}
+	if _u.mutation.SubjectsJSONCleared() {
+		_spec.ClearField(bronzek8srolebinding.FieldSubjectsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RulesJSON(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldRulesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRulesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8srolebinding.FieldRulesJSON, value)
+		})
+	}
+	if _u.mutation.RulesJSONCleared() {
+		_spec.ClearField(bronzek8srolebinding.FieldRulesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8srolebinding.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzek8srolebinding.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzek8srolebinding.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeK8sRoleBinding
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzek8srolebinding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeK8sRoleBindingUpdateOne is the builder for updating a single BronzeK8sRoleBinding entity.
+type BronzeK8sRoleBindingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeK8sRoleBindingMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetCollectedAt(v time.Time) *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetClusterName(v string) *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetNillableClusterName(v *string) *BronzeK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetKind(v string) *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetNillableKind(v *string) *BronzeK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetNamespace(v string) *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetNillableNamespace(v *string) *BronzeK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// ClearNamespace clears the value of the "namespace" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) ClearNamespace() *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.ClearNamespace()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetName(v string) *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetNillableName(v *string) *BronzeK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetUID(v string) *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetNillableUID(v *string) *BronzeK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) ClearUID() *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetRoleKind sets the "role_kind" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetRoleKind(v string) *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.SetRoleKind(v)
+	return _u
+}
+
+// SetNillableRoleKind sets the "role_kind" field if the given value is not nil.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetNillableRoleKind(v *string) *BronzeK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetRoleKind(*v)
+	}
+	return _u
+}
+
+// SetRoleName sets the "role_name" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetRoleName(v string) *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.SetRoleName(v)
+	return _u
+}
+
+// SetNillableRoleName sets the "role_name" field if the given value is not nil.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetNillableRoleName(v *string) *BronzeK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetRoleName(*v)
+	}
+	return _u
+}
+
+// SetSubjectsJSON sets the "subjects_json" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetSubjectsJSON(v json.RawMessage) *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.SetSubjectsJSON(v)
+	return _u
+}
+
+// AppendSubjectsJSON appends value to the "subjects_json" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) AppendSubjectsJSON(v json.RawMessage) *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.AppendSubjectsJSON(v)
+	return _u
+}
+
+// ClearSubjectsJSON clears the value of the "subjects_json" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) ClearSubjectsJSON() *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.ClearSubjectsJSON()
+	return _u
+}
+
+// SetRulesJSON sets the "rules_json" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetRulesJSON(v json.RawMessage) *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.SetRulesJSON(v)
+	return _u
+}
+
+// AppendRulesJSON appends value to the "rules_json" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) AppendRulesJSON(v json.RawMessage) *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.AppendRulesJSON(v)
+	return _u
+}
+
+// ClearRulesJSON clears the value of the "rules_json" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) ClearRulesJSON() *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.ClearRulesJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetLabelsJSON(v json.RawMessage) *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) AppendLabelsJSON(v json.RawMessage) *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) ClearLabelsJSON() *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetAPICreatedAt(v time.Time) *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeK8sRoleBindingUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sRoleBindingUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeK8sRoleBindingUpdateOne) ClearAPICreatedAt() *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeK8sRoleBindingMutation object of the builder.
+func (_u *BronzeK8sRoleBindingUpdateOne) Mutation() *BronzeK8sRoleBindingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeK8sRoleBindingUpdate builder.
+func (_u *BronzeK8sRoleBindingUpdateOne) Where(ps ...predicate.BronzeK8sRoleBinding) *BronzeK8sRoleBindingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeK8sRoleBindingUpdateOne) Select(field string, fields ...string) *BronzeK8sRoleBindingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeK8sRoleBinding entity.
+func (_u *BronzeK8sRoleBindingUpdateOne) Save(ctx context.Context) (*BronzeK8sRoleBinding, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeK8sRoleBindingUpdateOne) SaveX(ctx context.Context) *BronzeK8sRoleBinding {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeK8sRoleBindingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeK8sRoleBindingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeK8sRoleBindingUpdateOne) check() error {
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzek8srolebinding.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sRoleBinding.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := bronzek8srolebinding.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sRoleBinding.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzek8srolebinding.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sRoleBinding.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RoleKind(); ok {
+		if err := bronzek8srolebinding.RoleKindValidator(v); err != nil {
+			return &ValidationError{Name: "role_kind", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sRoleBinding.role_kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RoleName(); ok {
+		if err := bronzek8srolebinding.RoleNameValidator(v); err != nil {
+			return &ValidationError{Name: "role_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sRoleBinding.role_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeK8sRoleBindingUpdateOne) sqlSave(ctx context.Context) (_node *BronzeK8sRoleBinding, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzek8srolebinding.Table, bronzek8srolebinding.Columns, sqlgraph.NewFieldSpec(bronzek8srolebinding.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`kubernetes: missing "BronzeK8sRoleBinding.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzek8srolebinding.FieldID)
+		for _, f := range fields {
+			if !bronzek8srolebinding.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("kubernetes: invalid field %q for query", f)}
+			}
+			if f != bronzek8srolebinding.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldNamespace, field.TypeString, value)
+	}
+	if _u.mutation.NamespaceCleared() {
+		_spec.ClearField(bronzek8srolebinding.FieldNamespace, field.TypeString)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzek8srolebinding.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RoleKind(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldRoleKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RoleName(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldRoleName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SubjectsJSON(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldSubjectsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSubjectsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8srolebinding.FieldSubjectsJSON, value)
+		})
+	}
+	if _u.mutation.SubjectsJSONCleared() {
+		_spec.ClearField(bronzek8srolebinding.FieldSubjectsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RulesJSON(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldRulesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRulesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8srolebinding.FieldRulesJSON, value)
+		})
+	}
+	if _u.mutation.RulesJSONCleared() {
+		_spec.ClearField(bronzek8srolebinding.FieldRulesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8srolebinding.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzek8srolebinding.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8srolebinding.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzek8srolebinding.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeK8sRoleBinding
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeK8sRoleBinding{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzek8srolebinding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8sserviceaccount"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeK8sServiceAccount is the model entity for the BronzeK8sServiceAccount schema.
+type BronzeK8sServiceAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	// {cluster_name}/{namespace}/{name}
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// ClusterName holds the value of the "cluster_name" field.
+	ClusterName string `json:"cluster_name,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// automountServiceAccountToken; null means the default (true)
+	AutomountToken *bool `json:"automount_token,omitempty"`
+	// Bound cloud identity: GKE Workload Identity service account or EKS IAM role ARN
+	CloudIdentity string `json:"cloud_identity,omitempty"`
+	// ImagePullSecretsJSON holds the value of the "image_pull_secrets_json" field.
+	ImagePullSecretsJSON json.RawMessage `json:"image_pull_secrets_json,omitempty"`
+	// LabelsJSON holds the value of the "labels_json" field.
+	LabelsJSON json.RawMessage `json:"labels_json,omitempty"`
+	// APICreatedAt holds the value of the "api_created_at" field.
+	APICreatedAt *time.Time `json:"api_created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeK8sServiceAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzek8sserviceaccount.FieldImagePullSecretsJSON, bronzek8sserviceaccount.FieldLabelsJSON:
+			values[i] = new([]byte)
+		case bronzek8sserviceaccount.FieldAutomountToken:
+			values[i] = new(sql.NullBool)
+		case bronzek8sserviceaccount.FieldID, bronzek8sserviceaccount.FieldClusterName, bronzek8sserviceaccount.FieldNamespace, bronzek8sserviceaccount.FieldName, bronzek8sserviceaccount.FieldUID, bronzek8sserviceaccount.FieldCloudIdentity:
+			values[i] = new(sql.NullString)
+		case bronzek8sserviceaccount.FieldCollectedAt, bronzek8sserviceaccount.FieldFirstCollectedAt, bronzek8sserviceaccount.FieldAPICreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeK8sServiceAccount fields.
+func (_m *BronzeK8sServiceAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzek8sserviceaccount.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzek8sserviceaccount.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzek8sserviceaccount.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzek8sserviceaccount.FieldClusterName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_name", values[i])
+			} else if value.Valid {
+				_m.ClusterName = value.String
+			}
+		case bronzek8sserviceaccount.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				_m.Namespace = value.String
+			}
+		case bronzek8sserviceaccount.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzek8sserviceaccount.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				_m.UID = value.String
+			}
+		case bronzek8sserviceaccount.FieldAutomountToken:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field automount_token", values[i])
+			} else if value.Valid {
+				_m.AutomountToken = new(bool)
+				*_m.AutomountToken = value.Bool
+			}
+		case bronzek8sserviceaccount.FieldCloudIdentity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cloud_identity", values[i])
+			} else if value.Valid {
+				_m.CloudIdentity = value.String
+			}
+		case bronzek8sserviceaccount.FieldImagePullSecretsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field image_pull_secrets_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ImagePullSecretsJSON); err != nil {
+					return fmt.Errorf("unmarshal field image_pull_secrets_json: %w", err)
+				}
+			}
+		case bronzek8sserviceaccount.FieldLabelsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LabelsJSON); err != nil {
+					return fmt.Errorf("unmarshal field labels_json: %w", err)
+				}
+			}
+		case bronzek8sserviceaccount.FieldAPICreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_created_at", values[i])
+			} else if value.Valid {
+				_m.APICreatedAt = new(time.Time)
+				*_m.APICreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeK8sServiceAccount.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeK8sServiceAccount) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeK8sServiceAccount.
+// Note that you need to call BronzeK8sServiceAccount.Unwrap() before calling this method if this BronzeK8sServiceAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeK8sServiceAccount) Update() *BronzeK8sServiceAccountUpdateOne {
+	return NewBronzeK8sServiceAccountClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeK8sServiceAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeK8sServiceAccount) Unwrap() *BronzeK8sServiceAccount {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("kubernetes: BronzeK8sServiceAccount is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeK8sServiceAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeK8sServiceAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("cluster_name=")
+	builder.WriteString(_m.ClusterName)
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(_m.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("uid=")
+	builder.WriteString(_m.UID)
+	builder.WriteString(", ")
+	if v := _m.AutomountToken; v != nil {
+		builder.WriteString("automount_token=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cloud_identity=")
+	builder.WriteString(_m.CloudIdentity)
+	builder.WriteString(", ")
+	builder.WriteString("image_pull_secrets_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ImagePullSecretsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("labels_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LabelsJSON))
+	builder.WriteString(", ")
+	if v := _m.APICreatedAt; v != nil {
+		builder.WriteString("api_created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeK8sServiceAccounts is a parsable slice of BronzeK8sServiceAccount.
+type BronzeK8sServiceAccounts []*BronzeK8sServiceAccount
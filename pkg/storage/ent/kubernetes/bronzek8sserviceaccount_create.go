@@ -0,0 +1,356 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8sserviceaccount"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeK8sServiceAccountCreate is the builder for creating a BronzeK8sServiceAccount entity.
+type BronzeK8sServiceAccountCreate struct {
+	config
+	mutation *BronzeK8sServiceAccountMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeK8sServiceAccountCreate) SetCollectedAt(v time.Time) *BronzeK8sServiceAccountCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeK8sServiceAccountCreate) SetFirstCollectedAt(v time.Time) *BronzeK8sServiceAccountCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_c *BronzeK8sServiceAccountCreate) SetClusterName(v string) *BronzeK8sServiceAccountCreate {
+	_c.mutation.SetClusterName(v)
+	return _c
+}
+
+// SetNamespace sets the "namespace" field.
+func (_c *BronzeK8sServiceAccountCreate) SetNamespace(v string) *BronzeK8sServiceAccountCreate {
+	_c.mutation.SetNamespace(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeK8sServiceAccountCreate) SetName(v string) *BronzeK8sServiceAccountCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetUID sets the "uid" field.
+func (_c *BronzeK8sServiceAccountCreate) SetUID(v string) *BronzeK8sServiceAccountCreate {
+	_c.mutation.SetUID(v)
+	return _c
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_c *BronzeK8sServiceAccountCreate) SetNillableUID(v *string) *BronzeK8sServiceAccountCreate {
+	if v != nil {
+		_c.SetUID(*v)
+	}
+	return _c
+}
+
+// SetAutomountToken sets the "automount_token" field.
+func (_c *BronzeK8sServiceAccountCreate) SetAutomountToken(v bool) *BronzeK8sServiceAccountCreate {
+	_c.mutation.SetAutomountToken(v)
+	return _c
+}
+
+// SetNillableAutomountToken sets the "automount_token" field if the given value is not nil.
+func (_c *BronzeK8sServiceAccountCreate) SetNillableAutomountToken(v *bool) *BronzeK8sServiceAccountCreate {
+	if v != nil {
+		_c.SetAutomountToken(*v)
+	}
+	return _c
+}
+
+// SetCloudIdentity sets the "cloud_identity" field.
+func (_c *BronzeK8sServiceAccountCreate) SetCloudIdentity(v string) *BronzeK8sServiceAccountCreate {
+	_c.mutation.SetCloudIdentity(v)
+	return _c
+}
+
+// SetNillableCloudIdentity sets the "cloud_identity" field if the given value is not nil.
+func (_c *BronzeK8sServiceAccountCreate) SetNillableCloudIdentity(v *string) *BronzeK8sServiceAccountCreate {
+	if v != nil {
+		_c.SetCloudIdentity(*v)
+	}
+	return _c
+}
+
+// SetImagePullSecretsJSON sets the "image_pull_secrets_json" field.
+func (_c *BronzeK8sServiceAccountCreate) SetImagePullSecretsJSON(v json.RawMessage) *BronzeK8sServiceAccountCreate {
+	_c.mutation.SetImagePullSecretsJSON(v)
+	return _c
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_c *BronzeK8sServiceAccountCreate) SetLabelsJSON(v json.RawMessage) *BronzeK8sServiceAccountCreate {
+	_c.mutation.SetLabelsJSON(v)
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeK8sServiceAccountCreate) SetAPICreatedAt(v time.Time) *BronzeK8sServiceAccountCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeK8sServiceAccountCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sServiceAccountCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeK8sServiceAccountCreate) SetID(v string) *BronzeK8sServiceAccountCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeK8sServiceAccountMutation object of the builder.
+func (_c *BronzeK8sServiceAccountCreate) Mutation() *BronzeK8sServiceAccountMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeK8sServiceAccount in the database.
+func (_c *BronzeK8sServiceAccountCreate) Save(ctx context.Context) (*BronzeK8sServiceAccount, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeK8sServiceAccountCreate) SaveX(ctx context.Context) *BronzeK8sServiceAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeK8sServiceAccountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeK8sServiceAccountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeK8sServiceAccountCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`kubernetes: missing required field "BronzeK8sServiceAccount.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`kubernetes: missing required field "BronzeK8sServiceAccount.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ClusterName(); !ok {
+		return &ValidationError{Name: "cluster_name", err: errors.New(`kubernetes: missing required field "BronzeK8sServiceAccount.cluster_name"`)}
+	}
+	if v, ok := _c.mutation.ClusterName(); ok {
+		if err := bronzek8sserviceaccount.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sServiceAccount.cluster_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`kubernetes: missing required field "BronzeK8sServiceAccount.namespace"`)}
+	}
+	if v, ok := _c.mutation.Namespace(); ok {
+		if err := bronzek8sserviceaccount.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sServiceAccount.namespace": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`kubernetes: missing required field "BronzeK8sServiceAccount.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := bronzek8sserviceaccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sServiceAccount.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BronzeK8sServiceAccountCreate) sqlSave(ctx context.Context) (*BronzeK8sServiceAccount, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeK8sServiceAccount.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeK8sServiceAccountCreate) createSpec() (*BronzeK8sServiceAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeK8sServiceAccount{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzek8sserviceaccount.Table, sqlgraph.NewFieldSpec(bronzek8sserviceaccount.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeK8sServiceAccount
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldClusterName, field.TypeString, value)
+		_node.ClusterName = value
+	}
+	if value, ok := _c.mutation.Namespace(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.UID(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldUID, field.TypeString, value)
+		_node.UID = value
+	}
+	if value, ok := _c.mutation.AutomountToken(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldAutomountToken, field.TypeBool, value)
+		_node.AutomountToken = &value
+	}
+	if value, ok := _c.mutation.CloudIdentity(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldCloudIdentity, field.TypeString, value)
+		_node.CloudIdentity = value
+	}
+	if value, ok := _c.mutation.ImagePullSecretsJSON(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldImagePullSecretsJSON, field.TypeJSON, value)
+		_node.ImagePullSecretsJSON = value
+	}
+	if value, ok := _c.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldLabelsJSON, field.TypeJSON, value)
+		_node.LabelsJSON = value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	return _node, _spec
+}
+
+// BronzeK8sServiceAccountCreateBulk is the builder for creating many BronzeK8sServiceAccount entities in bulk.
+type BronzeK8sServiceAccountCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeK8sServiceAccountCreate
+}
+
+// Save creates the BronzeK8sServiceAccount entities in the database.
+func (_c *BronzeK8sServiceAccountCreateBulk) Save(ctx context.Context) ([]*BronzeK8sServiceAccount, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeK8sServiceAccount, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeK8sServiceAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeK8sServiceAccountCreateBulk) SaveX(ctx context.Context) []*BronzeK8sServiceAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeK8sServiceAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeK8sServiceAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
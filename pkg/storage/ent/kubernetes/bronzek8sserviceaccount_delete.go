@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8sserviceaccount"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeK8sServiceAccountDelete is the builder for deleting a BronzeK8sServiceAccount entity.
+type BronzeK8sServiceAccountDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeK8sServiceAccountMutation
+}
+
+// Where appends a list predicates to the BronzeK8sServiceAccountDelete builder.
+func (_d *BronzeK8sServiceAccountDelete) Where(ps ...predicate.BronzeK8sServiceAccount) *BronzeK8sServiceAccountDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeK8sServiceAccountDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeK8sServiceAccountDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeK8sServiceAccountDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzek8sserviceaccount.Table, sqlgraph.NewFieldSpec(bronzek8sserviceaccount.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.BronzeK8sServiceAccount
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeK8sServiceAccountDeleteOne is the builder for deleting a single BronzeK8sServiceAccount entity.
+type BronzeK8sServiceAccountDeleteOne struct {
+	_d *BronzeK8sServiceAccountDelete
+}
+
+// Where appends a list predicates to the BronzeK8sServiceAccountDelete builder.
+func (_d *BronzeK8sServiceAccountDeleteOne) Where(ps ...predicate.BronzeK8sServiceAccount) *BronzeK8sServiceAccountDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeK8sServiceAccountDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzek8sserviceaccount.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeK8sServiceAccountDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
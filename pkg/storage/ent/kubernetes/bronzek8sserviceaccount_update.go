@@ -0,0 +1,689 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8sserviceaccount"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeK8sServiceAccountUpdate is the builder for updating BronzeK8sServiceAccount entities.
+type BronzeK8sServiceAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeK8sServiceAccountMutation
+}
+
+// Where appends a list predicates to the BronzeK8sServiceAccountUpdate builder.
+func (_u *BronzeK8sServiceAccountUpdate) Where(ps ...predicate.BronzeK8sServiceAccount) *BronzeK8sServiceAccountUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeK8sServiceAccountUpdate) SetCollectedAt(v time.Time) *BronzeK8sServiceAccountUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeK8sServiceAccountUpdate) SetNillableCollectedAt(v *time.Time) *BronzeK8sServiceAccountUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeK8sServiceAccountUpdate) SetClusterName(v string) *BronzeK8sServiceAccountUpdate {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeK8sServiceAccountUpdate) SetNillableClusterName(v *string) *BronzeK8sServiceAccountUpdate {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeK8sServiceAccountUpdate) SetNamespace(v string) *BronzeK8sServiceAccountUpdate {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeK8sServiceAccountUpdate) SetNillableNamespace(v *string) *BronzeK8sServiceAccountUpdate {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeK8sServiceAccountUpdate) SetName(v string) *BronzeK8sServiceAccountUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeK8sServiceAccountUpdate) SetNillableName(v *string) *BronzeK8sServiceAccountUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeK8sServiceAccountUpdate) SetUID(v string) *BronzeK8sServiceAccountUpdate {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeK8sServiceAccountUpdate) SetNillableUID(v *string) *BronzeK8sServiceAccountUpdate {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeK8sServiceAccountUpdate) ClearUID() *BronzeK8sServiceAccountUpdate {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetAutomountToken sets the "automount_token" field.
+func (_u *BronzeK8sServiceAccountUpdate) SetAutomountToken(v bool) *BronzeK8sServiceAccountUpdate {
+	_u.mutation.SetAutomountToken(v)
+	return _u
+}
+
+// SetNillableAutomountToken sets the "automount_token" field if the given value is not nil.
+func (_u *BronzeK8sServiceAccountUpdate) SetNillableAutomountToken(v *bool) *BronzeK8sServiceAccountUpdate {
+	if v != nil {
+		_u.SetAutomountToken(*v)
+	}
+	return _u
+}
+
+// ClearAutomountToken clears the value of the "automount_token" field.
+func (_u *BronzeK8sServiceAccountUpdate) ClearAutomountToken() *BronzeK8sServiceAccountUpdate {
+	_u.mutation.ClearAutomountToken()
+	return _u
+}
+
+// SetCloudIdentity sets the "cloud_identity" field.
+func (_u *BronzeK8sServiceAccountUpdate) SetCloudIdentity(v string) *BronzeK8sServiceAccountUpdate {
+	_u.mutation.SetCloudIdentity(v)
+	return _u
+}
+
+// SetNillableCloudIdentity sets the "cloud_identity" field if the given value is not nil.
+func (_u *BronzeK8sServiceAccountUpdate) SetNillableCloudIdentity(v *string) *BronzeK8sServiceAccountUpdate {
+	if v != nil {
+		_u.SetCloudIdentity(*v)
+	}
+	return _u
+}
+
+// ClearCloudIdentity clears the value of the "cloud_identity" field.
+func (_u *BronzeK8sServiceAccountUpdate) ClearCloudIdentity() *BronzeK8sServiceAccountUpdate {
+	_u.mutation.ClearCloudIdentity()
+	return _u
+}
+
+// SetImagePullSecretsJSON sets the "image_pull_secrets_json" field.
+func (_u *BronzeK8sServiceAccountUpdate) SetImagePullSecretsJSON(v json.RawMessage) *BronzeK8sServiceAccountUpdate {
+	_u.mutation.SetImagePullSecretsJSON(v)
+	return _u
+}
+
+// AppendImagePullSecretsJSON appends value to the "image_pull_secrets_json" field.
+func (_u *BronzeK8sServiceAccountUpdate) AppendImagePullSecretsJSON(v json.RawMessage) *BronzeK8sServiceAccountUpdate {
+	_u.mutation.AppendImagePullSecretsJSON(v)
+	return _u
+}
+
+// ClearImagePullSecretsJSON clears the value of the "image_pull_secrets_json" field.
+func (_u *BronzeK8sServiceAccountUpdate) ClearImagePullSecretsJSON() *BronzeK8sServiceAccountUpdate {
+	_u.mutation.ClearImagePullSecretsJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeK8sServiceAccountUpdate) SetLabelsJSON(v json.RawMessage) *BronzeK8sServiceAccountUpdate {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeK8sServiceAccountUpdate) AppendLabelsJSON(v json.RawMessage) *BronzeK8sServiceAccountUpdate {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeK8sServiceAccountUpdate) ClearLabelsJSON() *BronzeK8sServiceAccountUpdate {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeK8sServiceAccountUpdate) SetAPICreatedAt(v time.Time) *BronzeK8sServiceAccountUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeK8sServiceAccountUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sServiceAccountUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeK8sServiceAccountUpdate) ClearAPICreatedAt() *BronzeK8sServiceAccountUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeK8sServiceAccountMutation object of the builder.
+func (_u *BronzeK8sServiceAccountUpdate) Mutation() *BronzeK8sServiceAccountMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeK8sServiceAccountUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeK8sServiceAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeK8sServiceAccountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeK8sServiceAccountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeK8sServiceAccountUpdate) check() error {
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzek8sserviceaccount.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sServiceAccount.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Namespace(); ok {
+		if err := bronzek8sserviceaccount.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sServiceAccount.namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzek8sserviceaccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sServiceAccount.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeK8sServiceAccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzek8sserviceaccount.Table, bronzek8sserviceaccount.Columns, sqlgraph.NewFieldSpec(bronzek8sserviceaccount.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzek8sserviceaccount.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AutomountToken(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldAutomountToken, field.TypeBool, value)
+	}
+	if _u.mutation.AutomountTokenCleared() {
+		_spec.ClearField(bronzek8sserviceaccount.FieldAutomountToken, field.TypeBool)
+	}
+	if value, ok := _u.mutation.CloudIdentity(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldCloudIdentity, field.TypeString, value)
+	}
+	if _u.mutation.CloudIdentityCleared() {
+		_spec.ClearField(bronzek8sserviceaccount.FieldCloudIdentity, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImagePullSecretsJSON(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldImagePullSecretsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImagePullSecretsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8sserviceaccount.FieldImagePullSecretsJSON, value)
+		})
+	}
+	if _u.mutation.ImagePullSecretsJSONCleared() {
+		_spec.ClearField(bronzek8sserviceaccount.FieldImagePullSecretsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8sserviceaccount.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzek8sserviceaccount.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzek8sserviceaccount.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeK8sServiceAccount
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzek8sserviceaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeK8sServiceAccountUpdateOne is the builder for updating a single BronzeK8sServiceAccount entity.
+type BronzeK8sServiceAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeK8sServiceAccountMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeK8sServiceAccountUpdateOne) SetCollectedAt(v time.Time) *BronzeK8sServiceAccountUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeK8sServiceAccountUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeK8sServiceAccountUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeK8sServiceAccountUpdateOne) SetClusterName(v string) *BronzeK8sServiceAccountUpdateOne {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeK8sServiceAccountUpdateOne) SetNillableClusterName(v *string) *BronzeK8sServiceAccountUpdateOne {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeK8sServiceAccountUpdateOne) SetNamespace(v string) *BronzeK8sServiceAccountUpdateOne {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeK8sServiceAccountUpdateOne) SetNillableNamespace(v *string) *BronzeK8sServiceAccountUpdateOne {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeK8sServiceAccountUpdateOne) SetName(v string) *BronzeK8sServiceAccountUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeK8sServiceAccountUpdateOne) SetNillableName(v *string) *BronzeK8sServiceAccountUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeK8sServiceAccountUpdateOne) SetUID(v string) *BronzeK8sServiceAccountUpdateOne {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeK8sServiceAccountUpdateOne) SetNillableUID(v *string) *BronzeK8sServiceAccountUpdateOne {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeK8sServiceAccountUpdateOne) ClearUID() *BronzeK8sServiceAccountUpdateOne {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetAutomountToken sets the "automount_token" field.
+func (_u *BronzeK8sServiceAccountUpdateOne) SetAutomountToken(v bool) *BronzeK8sServiceAccountUpdateOne {
+	_u.mutation.SetAutomountToken(v)
+	return _u
+}
+
+// SetNillableAutomountToken sets the "automount_token" field if the given value is not nil.
+func (_u *BronzeK8sServiceAccountUpdateOne) SetNillableAutomountToken(v *bool) *BronzeK8sServiceAccountUpdateOne {
+	if v != nil {
+		_u.SetAutomountToken(*v)
+	}
+	return _u
+}
+
+// ClearAutomountToken clears the value of the "automount_token" field.
+func (_u *BronzeK8sServiceAccountUpdateOne) ClearAutomountToken() *BronzeK8sServiceAccountUpdateOne {
+	_u.mutation.ClearAutomountToken()
+	return _u
+}
+
+// SetCloudIdentity sets the "cloud_identity" field.
+func (_u *BronzeK8sServiceAccountUpdateOne) SetCloudIdentity(v string) *BronzeK8sServiceAccountUpdateOne {
+	_u.mutation.SetCloudIdentity(v)
+	return _u
+}
+
+// SetNillableCloudIdentity sets the "cloud_identity" field if the given value is not nil.
+func (_u *BronzeK8sServiceAccountUpdateOne) SetNillableCloudIdentity(v *string) *BronzeK8sServiceAccountUpdateOne {
+	if v != nil {
+		_u.SetCloudIdentity(*v)
+	}
+	return _u
+}
+
+// ClearCloudIdentity clears the value of the "cloud_identity" field.
+func (_u *BronzeK8sServiceAccountUpdateOne) ClearCloudIdentity() *BronzeK8sServiceAccountUpdateOne {
+	_u.mutation.ClearCloudIdentity()
+	return _u
+}
+
+// SetImagePullSecretsJSON sets the "image_pull_secrets_json" field.
+func (_u *BronzeK8sServiceAccountUpdateOne) SetImagePullSecretsJSON(v json.RawMessage) *BronzeK8sServiceAccountUpdateOne {
+	_u.mutation.SetImagePullSecretsJSON(v)
+	return _u
+}
+
+// AppendImagePullSecretsJSON appends value to the "image_pull_secrets_json" field.
+func (_u *BronzeK8sServiceAccountUpdateOne) AppendImagePullSecretsJSON(v json.RawMessage) *BronzeK8sServiceAccountUpdateOne {
+	_u.mutation.AppendImagePullSecretsJSON(v)
+	return _u
+}
+
+// ClearImagePullSecretsJSON clears the value of the "image_pull_secrets_json" field.
+func (_u *BronzeK8sServiceAccountUpdateOne) ClearImagePullSecretsJSON() *BronzeK8sServiceAccountUpdateOne {
+	_u.mutation.ClearImagePullSecretsJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeK8sServiceAccountUpdateOne) SetLabelsJSON(v json.RawMessage) *BronzeK8sServiceAccountUpdateOne {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeK8sServiceAccountUpdateOne) AppendLabelsJSON(v json.RawMessage) *BronzeK8sServiceAccountUpdateOne {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeK8sServiceAccountUpdateOne) ClearLabelsJSON() *BronzeK8sServiceAccountUpdateOne {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeK8sServiceAccountUpdateOne) SetAPICreatedAt(v time.Time) *BronzeK8sServiceAccountUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeK8sServiceAccountUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sServiceAccountUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeK8sServiceAccountUpdateOne) ClearAPICreatedAt() *BronzeK8sServiceAccountUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeK8sServiceAccountMutation object of the builder.
+func (_u *BronzeK8sServiceAccountUpdateOne) Mutation() *BronzeK8sServiceAccountMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeK8sServiceAccountUpdate builder.
+func (_u *BronzeK8sServiceAccountUpdateOne) Where(ps ...predicate.BronzeK8sServiceAccount) *BronzeK8sServiceAccountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeK8sServiceAccountUpdateOne) Select(field string, fields ...string) *BronzeK8sServiceAccountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeK8sServiceAccount entity.
+func (_u *BronzeK8sServiceAccountUpdateOne) Save(ctx context.Context) (*BronzeK8sServiceAccount, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeK8sServiceAccountUpdateOne) SaveX(ctx context.Context) *BronzeK8sServiceAccount {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeK8sServiceAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeK8sServiceAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeK8sServiceAccountUpdateOne) check() error {
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzek8sserviceaccount.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sServiceAccount.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Namespace(); ok {
+		if err := bronzek8sserviceaccount.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sServiceAccount.namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzek8sserviceaccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sServiceAccount.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeK8sServiceAccountUpdateOne) sqlSave(ctx context.Context) (_node *BronzeK8sServiceAccount, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzek8sserviceaccount.Table, bronzek8sserviceaccount.Columns, sqlgraph.NewFieldSpec(bronzek8sserviceaccount.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`kubernetes: missing "BronzeK8sServiceAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzek8sserviceaccount.FieldID)
+		for _, f := range fields {
+			if !bronzek8sserviceaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("kubernetes: invalid field %q for query", f)}
+			}
+			if f != bronzek8sserviceaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzek8sserviceaccount.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AutomountToken(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldAutomountToken, field.TypeBool, value)
+	}
+	if _u.mutation.AutomountTokenCleared() {
+		_spec.ClearField(bronzek8sserviceaccount.FieldAutomountToken, field.TypeBool)
+	}
+	if value, ok := _u.mutation.CloudIdentity(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldCloudIdentity, field.TypeString, value)
+	}
+	if _u.mutation.CloudIdentityCleared() {
+		_spec.ClearField(bronzek8sserviceaccount.FieldCloudIdentity, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImagePullSecretsJSON(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldImagePullSecretsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImagePullSecretsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8sserviceaccount.FieldImagePullSecretsJSON, value)
+		})
+	}
+	if _u.mutation.ImagePullSecretsJSONCleared() {
+		_spec.ClearField(bronzek8sserviceaccount.FieldImagePullSecretsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8sserviceaccount.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzek8sserviceaccount.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8sserviceaccount.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzek8sserviceaccount.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeK8sServiceAccount
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeK8sServiceAccount{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzek8sserviceaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8sworkload"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeK8sWorkload is the model entity for the BronzeK8sWorkload schema.
+type BronzeK8sWorkload struct {
+	config `json:"-"`
+	// ID of the ent.
+	// {cluster_name}/{namespace}/{kind}/{name}
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// ClusterName holds the value of the "cluster_name" field.
+	ClusterName string `json:"cluster_name,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// Deployment, StatefulSet, DaemonSet, CronJob or Job
+	Kind string `json:"kind,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// Desired replicas; null for DaemonSets, CronJobs and Jobs
+	Replicas *int32 `json:"replicas,omitempty"`
+	// ServiceAccount holds the value of the "service_account" field.
+	ServiceAccount string `json:"service_account,omitempty"`
+	// HostNetwork holds the value of the "host_network" field.
+	HostNetwork bool `json:"host_network,omitempty"`
+	// HostPid holds the value of the "host_pid" field.
+	HostPid bool `json:"host_pid,omitempty"`
+	// HostIpc holds the value of the "host_ipc" field.
+	HostIpc bool `json:"host_ipc,omitempty"`
+	// Container and init container images of the pod template
+	ImagesJSON json.RawMessage `json:"images_json,omitempty"`
+	// Pod template containers: name, image, privileged, run_as_non_root, ...
+	ContainersJSON json.RawMessage `json:"containers_json,omitempty"`
+	// SelectorJSON holds the value of the "selector_json" field.
+	SelectorJSON json.RawMessage `json:"selector_json,omitempty"`
+	// LabelsJSON holds the value of the "labels_json" field.
+	LabelsJSON json.RawMessage `json:"labels_json,omitempty"`
+	// APICreatedAt holds the value of the "api_created_at" field.
+	APICreatedAt *time.Time `json:"api_created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeK8sWorkload) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzek8sworkload.FieldImagesJSON, bronzek8sworkload.FieldContainersJSON, bronzek8sworkload.FieldSelectorJSON, bronzek8sworkload.FieldLabelsJSON:
+			values[i] = new([]byte)
+		case bronzek8sworkload.FieldHostNetwork, bronzek8sworkload.FieldHostPid, bronzek8sworkload.FieldHostIpc:
+			values[i] = new(sql.NullBool)
+		case bronzek8sworkload.FieldReplicas:
+			values[i] = new(sql.NullInt64)
+		case bronzek8sworkload.FieldID, bronzek8sworkload.FieldClusterName, bronzek8sworkload.FieldNamespace, bronzek8sworkload.FieldKind, bronzek8sworkload.FieldName, bronzek8sworkload.FieldUID, bronzek8sworkload.FieldServiceAccount:
+			values[i] = new(sql.NullString)
+		case bronzek8sworkload.FieldCollectedAt, bronzek8sworkload.FieldFirstCollectedAt, bronzek8sworkload.FieldAPICreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeK8sWorkload fields.
+func (_m *BronzeK8sWorkload) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzek8sworkload.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzek8sworkload.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzek8sworkload.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzek8sworkload.FieldClusterName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_name", values[i])
+			} else if value.Valid {
+				_m.ClusterName = value.String
+			}
+		case bronzek8sworkload.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				_m.Namespace = value.String
+			}
+		case bronzek8sworkload.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case bronzek8sworkload.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzek8sworkload.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				_m.UID = value.String
+			}
+		case bronzek8sworkload.FieldReplicas:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field replicas", values[i])
+			} else if value.Valid {
+				_m.Replicas = new(int32)
+				*_m.Replicas = int32(value.Int64)
+			}
+		case bronzek8sworkload.FieldServiceAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field service_account", values[i])
+			} else if value.Valid {
+				_m.ServiceAccount = value.String
+			}
+		case bronzek8sworkload.FieldHostNetwork:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field host_network", values[i])
+			} else if value.Valid {
+				_m.HostNetwork = value.Bool
+			}
+		case bronzek8sworkload.FieldHostPid:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field host_pid", values[i])
+			} else if value.Valid {
+				_m.HostPid = value.Bool
+			}
+		case bronzek8sworkload.FieldHostIpc:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field host_ipc", values[i])
+			} else if value.Valid {
+				_m.HostIpc = value.Bool
+			}
+		case bronzek8sworkload.FieldImagesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field images_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ImagesJSON); err != nil {
+					return fmt.Errorf("unmarshal field images_json: %w", err)
+				}
+			}
+		case bronzek8sworkload.FieldContainersJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field containers_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ContainersJSON); err != nil {
+					return fmt.Errorf("unmarshal field containers_json: %w", err)
+				}
+			}
+		case bronzek8sworkload.FieldSelectorJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field selector_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SelectorJSON); err != nil {
+					return fmt.Errorf("unmarshal field selector_json: %w", err)
+				}
+			}
+		case bronzek8sworkload.FieldLabelsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LabelsJSON); err != nil {
+					return fmt.Errorf("unmarshal field labels_json: %w", err)
+				}
+			}
+		case bronzek8sworkload.FieldAPICreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_created_at", values[i])
+			} else if value.Valid {
+				_m.APICreatedAt = new(time.Time)
+				*_m.APICreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeK8sWorkload.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeK8sWorkload) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeK8sWorkload.
+// Note that you need to call BronzeK8sWorkload.Unwrap() before calling this method if this BronzeK8sWorkload
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeK8sWorkload) Update() *BronzeK8sWorkloadUpdateOne {
+	return NewBronzeK8sWorkloadClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeK8sWorkload entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeK8sWorkload) Unwrap() *BronzeK8sWorkload {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("kubernetes: BronzeK8sWorkload is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeK8sWorkload) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeK8sWorkload(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("cluster_name=")
+	builder.WriteString(_m.ClusterName)
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(_m.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("uid=")
+	builder.WriteString(_m.UID)
+	builder.WriteString(", ")
+	if v := _m.Replicas; v != nil {
+		builder.WriteString("replicas=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("service_account=")
+	builder.WriteString(_m.ServiceAccount)
+	builder.WriteString(", ")
+	builder.WriteString("host_network=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HostNetwork))
+	builder.WriteString(", ")
+	builder.WriteString("host_pid=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HostPid))
+	builder.WriteString(", ")
+	builder.WriteString("host_ipc=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HostIpc))
+	builder.WriteString(", ")
+	builder.WriteString("images_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ImagesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("containers_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ContainersJSON))
+	builder.WriteString(", ")
+	builder.WriteString("selector_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SelectorJSON))
+	builder.WriteString(", ")
+	builder.WriteString("labels_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LabelsJSON))
+	builder.WriteString(", ")
+	if v := _m.APICreatedAt; v != nil {
+		builder.WriteString("api_created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeK8sWorkloads is a parsable slice of BronzeK8sWorkload.
+type BronzeK8sWorkloads []*BronzeK8sWorkload
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzek8sworkload
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzek8sworkload type in the database.
+	Label = "bronze_k8s_workload"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldClusterName holds the string denoting the cluster_name field in the database.
+	FieldClusterName = "cluster_name"
+	// FieldNamespace holds the string denoting the namespace field in the database.
+	FieldNamespace = "namespace"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldUID holds the string denoting the uid field in the database.
+	FieldUID = "uid"
+	// FieldReplicas holds the string denoting the replicas field in the database.
+	FieldReplicas = "replicas"
+	// FieldServiceAccount holds the string denoting the service_account field in the database.
+	FieldServiceAccount = "service_account"
+	// FieldHostNetwork holds the string denoting the host_network field in the database.
+	FieldHostNetwork = "host_network"
+	// FieldHostPid holds the string denoting the host_pid field in the database.
+	FieldHostPid = "host_pid"
+	// FieldHostIpc holds the string denoting the host_ipc field in the database.
+	FieldHostIpc = "host_ipc"
+	// FieldImagesJSON holds the string denoting the images_json field in the database.
+	FieldImagesJSON = "images_json"
+	// FieldContainersJSON holds the string denoting the containers_json field in the database.
+	FieldContainersJSON = "containers_json"
+	// FieldSelectorJSON holds the string denoting the selector_json field in the database.
+	FieldSelectorJSON = "selector_json"
+	// FieldLabelsJSON holds the string denoting the labels_json field in the database.
+	FieldLabelsJSON = "labels_json"
+	// FieldAPICreatedAt holds the string denoting the api_created_at field in the database.
+	FieldAPICreatedAt = "api_created_at"
+	// Table holds the table name of the bronzek8sworkload in the database.
+	Table = "k8s_workloads"
+)
+
+// Columns holds all SQL columns for bronzek8sworkload fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldClusterName,
+	FieldNamespace,
+	FieldKind,
+	FieldName,
+	FieldUID,
+	FieldReplicas,
+	FieldServiceAccount,
+	FieldHostNetwork,
+	FieldHostPid,
+	FieldHostIpc,
+	FieldImagesJSON,
+	FieldContainersJSON,
+	FieldSelectorJSON,
+	FieldLabelsJSON,
+	FieldAPICreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	ClusterNameValidator func(string) error
+	// NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	NamespaceValidator func(string) error
+	// KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	KindValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultHostNetwork holds the default value on creation for the "host_network" field.
+	DefaultHostNetwork bool
+	// DefaultHostPid holds the default value on creation for the "host_pid" field.
+	DefaultHostPid bool
+	// DefaultHostIpc holds the default value on creation for the "host_ipc" field.
+	DefaultHostIpc bool
+)
+
+// OrderOption defines the ordering options for the BronzeK8sWorkload queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByClusterName orders the results by the cluster_name field.
+func ByClusterName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClusterName, opts...).ToFunc()
+}
+
+// ByNamespace orders the results by the namespace field.
+func ByNamespace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNamespace, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByUID orders the results by the uid field.
+func ByUID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUID, opts...).ToFunc()
+}
+
+// ByReplicas orders the results by the replicas field.
+func ByReplicas(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplicas, opts...).ToFunc()
+}
+
+// ByServiceAccount orders the results by the service_account field.
+func ByServiceAccount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldServiceAccount, opts...).ToFunc()
+}
+
+// ByHostNetwork orders the results by the host_network field.
+func ByHostNetwork(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHostNetwork, opts...).ToFunc()
+}
+
+// ByHostPid orders the results by the host_pid field.
+func ByHostPid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHostPid, opts...).ToFunc()
+}
+
+// ByHostIpc orders the results by the host_ipc field.
+func ByHostIpc(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHostIpc, opts...).ToFunc()
+}
+
+// ByAPICreatedAt orders the results by the api_created_at field.
+func ByAPICreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPICreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,805 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzek8sworkload
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ClusterName applies equality check predicate on the "cluster_name" field. It's identical to ClusterNameEQ.
+func ClusterName(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldClusterName, v))
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldNamespace, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldKind, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldName, v))
+}
+
+// UID applies equality check predicate on the "uid" field. It's identical to UIDEQ.
+func UID(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldUID, v))
+}
+
+// Replicas applies equality check predicate on the "replicas" field. It's identical to ReplicasEQ.
+func Replicas(v int32) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldReplicas, v))
+}
+
+// ServiceAccount applies equality check predicate on the "service_account" field. It's identical to ServiceAccountEQ.
+func ServiceAccount(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldServiceAccount, v))
+}
+
+// HostNetwork applies equality check predicate on the "host_network" field. It's identical to HostNetworkEQ.
+func HostNetwork(v bool) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldHostNetwork, v))
+}
+
+// HostPid applies equality check predicate on the "host_pid" field. It's identical to HostPidEQ.
+func HostPid(v bool) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldHostPid, v))
+}
+
+// HostIpc applies equality check predicate on the "host_ipc" field. It's identical to HostIpcEQ.
+func HostIpc(v bool) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldHostIpc, v))
+}
+
+// APICreatedAt applies equality check predicate on the "api_created_at" field. It's identical to APICreatedAtEQ.
+func APICreatedAt(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ClusterNameEQ applies the EQ predicate on the "cluster_name" field.
+func ClusterNameEQ(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldClusterName, v))
+}
+
+// ClusterNameNEQ applies the NEQ predicate on the "cluster_name" field.
+func ClusterNameNEQ(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNEQ(FieldClusterName, v))
+}
+
+// ClusterNameIn applies the In predicate on the "cluster_name" field.
+func ClusterNameIn(vs ...string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldIn(FieldClusterName, vs...))
+}
+
+// ClusterNameNotIn applies the NotIn predicate on the "cluster_name" field.
+func ClusterNameNotIn(vs ...string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNotIn(FieldClusterName, vs...))
+}
+
+// ClusterNameGT applies the GT predicate on the "cluster_name" field.
+func ClusterNameGT(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGT(FieldClusterName, v))
+}
+
+// ClusterNameGTE applies the GTE predicate on the "cluster_name" field.
+func ClusterNameGTE(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGTE(FieldClusterName, v))
+}
+
+// ClusterNameLT applies the LT predicate on the "cluster_name" field.
+func ClusterNameLT(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLT(FieldClusterName, v))
+}
+
+// ClusterNameLTE applies the LTE predicate on the "cluster_name" field.
+func ClusterNameLTE(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLTE(FieldClusterName, v))
+}
+
+// ClusterNameContains applies the Contains predicate on the "cluster_name" field.
+func ClusterNameContains(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldContains(FieldClusterName, v))
+}
+
+// ClusterNameHasPrefix applies the HasPrefix predicate on the "cluster_name" field.
+func ClusterNameHasPrefix(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldHasPrefix(FieldClusterName, v))
+}
+
+// ClusterNameHasSuffix applies the HasSuffix predicate on the "cluster_name" field.
+func ClusterNameHasSuffix(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldHasSuffix(FieldClusterName, v))
+}
+
+// ClusterNameEqualFold applies the EqualFold predicate on the "cluster_name" field.
+func ClusterNameEqualFold(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEqualFold(FieldClusterName, v))
+}
+
+// ClusterNameContainsFold applies the ContainsFold predicate on the "cluster_name" field.
+func ClusterNameContainsFold(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldContainsFold(FieldClusterName, v))
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldNamespace, v))
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNEQ(FieldNamespace, v))
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldIn(FieldNamespace, vs...))
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNotIn(FieldNamespace, vs...))
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGT(FieldNamespace, v))
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGTE(FieldNamespace, v))
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLT(FieldNamespace, v))
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLTE(FieldNamespace, v))
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldContains(FieldNamespace, v))
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldHasPrefix(FieldNamespace, v))
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldHasSuffix(FieldNamespace, v))
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEqualFold(FieldNamespace, v))
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldContainsFold(FieldNamespace, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldContainsFold(FieldKind, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldContainsFold(FieldName, v))
+}
+
+// UIDEQ applies the EQ predicate on the "uid" field.
+func UIDEQ(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldUID, v))
+}
+
+// UIDNEQ applies the NEQ predicate on the "uid" field.
+func UIDNEQ(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNEQ(FieldUID, v))
+}
+
+// UIDIn applies the In predicate on the "uid" field.
+func UIDIn(vs ...string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldIn(FieldUID, vs...))
+}
+
+// UIDNotIn applies the NotIn predicate on the "uid" field.
+func UIDNotIn(vs ...string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNotIn(FieldUID, vs...))
+}
+
+// UIDGT applies the GT predicate on the "uid" field.
+func UIDGT(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGT(FieldUID, v))
+}
+
+// UIDGTE applies the GTE predicate on the "uid" field.
+func UIDGTE(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGTE(FieldUID, v))
+}
+
+// UIDLT applies the LT predicate on the "uid" field.
+func UIDLT(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLT(FieldUID, v))
+}
+
+// UIDLTE applies the LTE predicate on the "uid" field.
+func UIDLTE(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLTE(FieldUID, v))
+}
+
+// UIDContains applies the Contains predicate on the "uid" field.
+func UIDContains(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldContains(FieldUID, v))
+}
+
+// UIDHasPrefix applies the HasPrefix predicate on the "uid" field.
+func UIDHasPrefix(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldHasPrefix(FieldUID, v))
+}
+
+// UIDHasSuffix applies the HasSuffix predicate on the "uid" field.
+func UIDHasSuffix(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldHasSuffix(FieldUID, v))
+}
+
+// UIDIsNil applies the IsNil predicate on the "uid" field.
+func UIDIsNil() predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldIsNull(FieldUID))
+}
+
+// UIDNotNil applies the NotNil predicate on the "uid" field.
+func UIDNotNil() predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNotNull(FieldUID))
+}
+
+// UIDEqualFold applies the EqualFold predicate on the "uid" field.
+func UIDEqualFold(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEqualFold(FieldUID, v))
+}
+
+// UIDContainsFold applies the ContainsFold predicate on the "uid" field.
+func UIDContainsFold(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldContainsFold(FieldUID, v))
+}
+
+// ReplicasEQ applies the EQ predicate on the "replicas" field.
+func ReplicasEQ(v int32) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldReplicas, v))
+}
+
+// ReplicasNEQ applies the NEQ predicate on the "replicas" field.
+func ReplicasNEQ(v int32) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNEQ(FieldReplicas, v))
+}
+
+// ReplicasIn applies the In predicate on the "replicas" field.
+func ReplicasIn(vs ...int32) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldIn(FieldReplicas, vs...))
+}
+
+// ReplicasNotIn applies the NotIn predicate on the "replicas" field.
+func ReplicasNotIn(vs ...int32) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNotIn(FieldReplicas, vs...))
+}
+
+// ReplicasGT applies the GT predicate on the "replicas" field.
+func ReplicasGT(v int32) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGT(FieldReplicas, v))
+}
+
+// ReplicasGTE applies the GTE predicate on the "replicas" field.
+func ReplicasGTE(v int32) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGTE(FieldReplicas, v))
+}
+
+// ReplicasLT applies the LT predicate on the "replicas" field.
+func ReplicasLT(v int32) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLT(FieldReplicas, v))
+}
+
+// ReplicasLTE applies the LTE predicate on the "replicas" field.
+func ReplicasLTE(v int32) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLTE(FieldReplicas, v))
+}
+
+// ReplicasIsNil applies the IsNil predicate on the "replicas" field.
+func ReplicasIsNil() predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldIsNull(FieldReplicas))
+}
+
+// ReplicasNotNil applies the NotNil predicate on the "replicas" field.
+func ReplicasNotNil() predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNotNull(FieldReplicas))
+}
+
+// ServiceAccountEQ applies the EQ predicate on the "service_account" field.
+func ServiceAccountEQ(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldServiceAccount, v))
+}
+
+// ServiceAccountNEQ applies the NEQ predicate on the "service_account" field.
+func ServiceAccountNEQ(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNEQ(FieldServiceAccount, v))
+}
+
+// ServiceAccountIn applies the In predicate on the "service_account" field.
+func ServiceAccountIn(vs ...string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldIn(FieldServiceAccount, vs...))
+}
+
+// ServiceAccountNotIn applies the NotIn predicate on the "service_account" field.
+func ServiceAccountNotIn(vs ...string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNotIn(FieldServiceAccount, vs...))
+}
+
+// ServiceAccountGT applies the GT predicate on the "service_account" field.
+func ServiceAccountGT(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGT(FieldServiceAccount, v))
+}
+
+// ServiceAccountGTE applies the GTE predicate on the "service_account" field.
+func ServiceAccountGTE(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGTE(FieldServiceAccount, v))
+}
+
+// ServiceAccountLT applies the LT predicate on the "service_account" field.
+func ServiceAccountLT(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLT(FieldServiceAccount, v))
+}
+
+// ServiceAccountLTE applies the LTE predicate on the "service_account" field.
+func ServiceAccountLTE(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLTE(FieldServiceAccount, v))
+}
+
+// ServiceAccountContains applies the Contains predicate on the "service_account" field.
+func ServiceAccountContains(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldContains(FieldServiceAccount, v))
+}
+
+// ServiceAccountHasPrefix applies the HasPrefix predicate on the "service_account" field.
+func ServiceAccountHasPrefix(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldHasPrefix(FieldServiceAccount, v))
+}
+
+// ServiceAccountHasSuffix applies the HasSuffix predicate on the "service_account" field.
+func ServiceAccountHasSuffix(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldHasSuffix(FieldServiceAccount, v))
+}
+
+// ServiceAccountIsNil applies the IsNil predicate on the "service_account" field.
+func ServiceAccountIsNil() predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldIsNull(FieldServiceAccount))
+}
+
+// ServiceAccountNotNil applies the NotNil predicate on the "service_account" field.
+func ServiceAccountNotNil() predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNotNull(FieldServiceAccount))
+}
+
+// ServiceAccountEqualFold applies the EqualFold predicate on the "service_account" field.
+func ServiceAccountEqualFold(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEqualFold(FieldServiceAccount, v))
+}
+
+// ServiceAccountContainsFold applies the ContainsFold predicate on the "service_account" field.
+func ServiceAccountContainsFold(v string) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldContainsFold(FieldServiceAccount, v))
+}
+
+// HostNetworkEQ applies the EQ predicate on the "host_network" field.
+func HostNetworkEQ(v bool) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldHostNetwork, v))
+}
+
+// HostNetworkNEQ applies the NEQ predicate on the "host_network" field.
+func HostNetworkNEQ(v bool) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNEQ(FieldHostNetwork, v))
+}
+
+// HostPidEQ applies the EQ predicate on the "host_pid" field.
+func HostPidEQ(v bool) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldHostPid, v))
+}
+
+// HostPidNEQ applies the NEQ predicate on the "host_pid" field.
+func HostPidNEQ(v bool) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNEQ(FieldHostPid, v))
+}
+
+// HostIpcEQ applies the EQ predicate on the "host_ipc" field.
+func HostIpcEQ(v bool) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldHostIpc, v))
+}
+
+// HostIpcNEQ applies the NEQ predicate on the "host_ipc" field.
+func HostIpcNEQ(v bool) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNEQ(FieldHostIpc, v))
+}
+
+// ImagesJSONIsNil applies the IsNil predicate on the "images_json" field.
+func ImagesJSONIsNil() predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldIsNull(FieldImagesJSON))
+}
+
+// ImagesJSONNotNil applies the NotNil predicate on the "images_json" field.
+func ImagesJSONNotNil() predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNotNull(FieldImagesJSON))
+}
+
+// ContainersJSONIsNil applies the IsNil predicate on the "containers_json" field.
+func ContainersJSONIsNil() predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldIsNull(FieldContainersJSON))
+}
+
+// ContainersJSONNotNil applies the NotNil predicate on the "containers_json" field.
+func ContainersJSONNotNil() predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNotNull(FieldContainersJSON))
+}
+
+// SelectorJSONIsNil applies the IsNil predicate on the "selector_json" field.
+func SelectorJSONIsNil() predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldIsNull(FieldSelectorJSON))
+}
+
+// SelectorJSONNotNil applies the NotNil predicate on the "selector_json" field.
+func SelectorJSONNotNil() predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNotNull(FieldSelectorJSON))
+}
+
+// LabelsJSONIsNil applies the IsNil predicate on the "labels_json" field.
+func LabelsJSONIsNil() predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldIsNull(FieldLabelsJSON))
+}
+
+// LabelsJSONNotNil applies the NotNil predicate on the "labels_json" field.
+func LabelsJSONNotNil() predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNotNull(FieldLabelsJSON))
+}
+
+// APICreatedAtEQ applies the EQ predicate on the "api_created_at" field.
+func APICreatedAtEQ(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtNEQ applies the NEQ predicate on the "api_created_at" field.
+func APICreatedAtNEQ(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtIn applies the In predicate on the "api_created_at" field.
+func APICreatedAtIn(vs ...time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtNotIn applies the NotIn predicate on the "api_created_at" field.
+func APICreatedAtNotIn(vs ...time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNotIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtGT applies the GT predicate on the "api_created_at" field.
+func APICreatedAtGT(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtGTE applies the GTE predicate on the "api_created_at" field.
+func APICreatedAtGTE(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldGTE(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLT applies the LT predicate on the "api_created_at" field.
+func APICreatedAtLT(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLTE applies the LTE predicate on the "api_created_at" field.
+func APICreatedAtLTE(v time.Time) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldLTE(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtIsNil applies the IsNil predicate on the "api_created_at" field.
+func APICreatedAtIsNil() predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldIsNull(FieldAPICreatedAt))
+}
+
+// APICreatedAtNotNil applies the NotNil predicate on the "api_created_at" field.
+func APICreatedAtNotNil() predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.FieldNotNull(FieldAPICreatedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeK8sWorkload) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeK8sWorkload) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeK8sWorkload) predicate.BronzeK8sWorkload {
+	return predicate.BronzeK8sWorkload(sql.NotPredicates(p))
+}
@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8sworkload"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeK8sWorkloadCreate is the builder for creating a BronzeK8sWorkload entity.
+type BronzeK8sWorkloadCreate struct {
+	config
+	mutation *BronzeK8sWorkloadMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeK8sWorkloadCreate) SetCollectedAt(v time.Time) *BronzeK8sWorkloadCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeK8sWorkloadCreate) SetFirstCollectedAt(v time.Time) *BronzeK8sWorkloadCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_c *BronzeK8sWorkloadCreate) SetClusterName(v string) *BronzeK8sWorkloadCreate {
+	_c.mutation.SetClusterName(v)
+	return _c
+}
+
+// SetNamespace sets the "namespace" field.
+func (_c *BronzeK8sWorkloadCreate) SetNamespace(v string) *BronzeK8sWorkloadCreate {
+	_c.mutation.SetNamespace(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *BronzeK8sWorkloadCreate) SetKind(v string) *BronzeK8sWorkloadCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeK8sWorkloadCreate) SetName(v string) *BronzeK8sWorkloadCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetUID sets the "uid" field.
+func (_c *BronzeK8sWorkloadCreate) SetUID(v string) *BronzeK8sWorkloadCreate {
+	_c.mutation.SetUID(v)
+	return _c
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_c *BronzeK8sWorkloadCreate) SetNillableUID(v *string) *BronzeK8sWorkloadCreate {
+	if v != nil {
+		_c.SetUID(*v)
+	}
+	return _c
+}
+
+// SetReplicas sets the "replicas" field.
+func (_c *BronzeK8sWorkloadCreate) SetReplicas(v int32) *BronzeK8sWorkloadCreate {
+	_c.mutation.SetReplicas(v)
+	return _c
+}
+
+// SetNillableReplicas sets the "replicas" field if the given value is not nil.
+func (_c *BronzeK8sWorkloadCreate) SetNillableReplicas(v *int32) *BronzeK8sWorkloadCreate {
+	if v != nil {
+		_c.SetReplicas(*v)
+	}
+	return _c
+}
+
+// SetServiceAccount sets the "service_account" field.
+func (_c *BronzeK8sWorkloadCreate) SetServiceAccount(v string) *BronzeK8sWorkloadCreate {
+	_c.mutation.SetServiceAccount(v)
+	return _c
+}
+
+// SetNillableServiceAccount sets the "service_account" field if the given value is not nil.
+func (_c *BronzeK8sWorkloadCreate) SetNillableServiceAccount(v *string) *BronzeK8sWorkloadCreate {
+	if v != nil {
+		_c.SetServiceAccount(*v)
+	}
+	return _c
+}
+
+// SetHostNetwork sets the "host_network" field.
+func (_c *BronzeK8sWorkloadCreate) SetHostNetwork(v bool) *BronzeK8sWorkloadCreate {
+	_c.mutation.SetHostNetwork(v)
+	return _c
+}
+
+// SetNillableHostNetwork sets the "host_network" field if the given value is not nil.
+func (_c *BronzeK8sWorkloadCreate) SetNillableHostNetwork(v *bool) *BronzeK8sWorkloadCreate {
+	if v != nil {
+		_c.SetHostNetwork(*v)
+	}
+	return _c
+}
+
+// SetHostPid sets the "host_pid" field.
+func (_c *BronzeK8sWorkloadCreate) SetHostPid(v bool) *BronzeK8sWorkloadCreate {
+	_c.mutation.SetHostPid(v)
+	return _c
+}
+
+// SetNillableHostPid sets the "host_pid" field if the given value is not nil.
+func (_c *BronzeK8sWorkloadCreate) SetNillableHostPid(v *bool) *BronzeK8sWorkloadCreate {
+	if v != nil {
+		_c.SetHostPid(*v)
+	}
+	return _c
+}
+
+// SetHostIpc sets the "host_ipc" field.
+func (_c *BronzeK8sWorkloadCreate) SetHostIpc(v bool) *BronzeK8sWorkloadCreate {
+	_c.mutation.SetHostIpc(v)
+	return _c
+}
+
+// SetNillableHostIpc sets the "host_ipc" field if the given value is not nil.
+func (_c *BronzeK8sWorkloadCreate) SetNillableHostIpc(v *bool) *BronzeK8sWorkloadCreate {
+	if v != nil {
+		_c.SetHostIpc(*v)
+	}
+	return _c
+}
+
+// SetImagesJSON sets the "images_json" field.
+func (_c *BronzeK8sWorkloadCreate) SetImagesJSON(v json.RawMessage) *BronzeK8sWorkloadCreate {
+	_c.mutation.SetImagesJSON(v)
+	return _c
+}
+
+// SetContainersJSON sets the "containers_json" field.
+func (_c *BronzeK8sWorkloadCreate) SetContainersJSON(v json.RawMessage) *BronzeK8sWorkloadCreate {
+	_c.mutation.SetContainersJSON(v)
+	return _c
+}
+
+// SetSelectorJSON sets the "selector_json" field.
+func (_c *BronzeK8sWorkloadCreate) SetSelectorJSON(v json.RawMessage) *BronzeK8sWorkloadCreate {
+	_c.mutation.SetSelectorJSON(v)
+	return _c
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_c *BronzeK8sWorkloadCreate) SetLabelsJSON(v json.RawMessage) *BronzeK8sWorkloadCreate {
+	_c.mutation.SetLabelsJSON(v)
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeK8sWorkloadCreate) SetAPICreatedAt(v time.Time) *BronzeK8sWorkloadCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeK8sWorkloadCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sWorkloadCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeK8sWorkloadCreate) SetID(v string) *BronzeK8sWorkloadCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeK8sWorkloadMutation object of the builder.
+func (_c *BronzeK8sWorkloadCreate) Mutation() *BronzeK8sWorkloadMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeK8sWorkload in the database.
+func (_c *BronzeK8sWorkloadCreate) Save(ctx context.Context) (*BronzeK8sWorkload, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeK8sWorkloadCreate) SaveX(ctx context.Context) *BronzeK8sWorkload {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeK8sWorkloadCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeK8sWorkloadCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeK8sWorkloadCreate) defaults() {
+	if _, ok := _c.mutation.HostNetwork(); !ok {
+		v := bronzek8sworkload.DefaultHostNetwork
+		_c.mutation.SetHostNetwork(v)
+	}
+	if _, ok := _c.mutation.HostPid(); !ok {
+		v := bronzek8sworkload.DefaultHostPid
+		_c.mutation.SetHostPid(v)
+	}
+	if _, ok := _c.mutation.HostIpc(); !ok {
+		v := bronzek8sworkload.DefaultHostIpc
+		_c.mutation.SetHostIpc(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeK8sWorkloadCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`kubernetes: missing required field "BronzeK8sWorkload.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`kubernetes: missing required field "BronzeK8sWorkload.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ClusterName(); !ok {
+		return &ValidationError{Name: "cluster_name", err: errors.New(`kubernetes: missing required field "BronzeK8sWorkload.cluster_name"`)}
+	}
+	if v, ok := _c.mutation.ClusterName(); ok {
+		if err := bronzek8sworkload.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sWorkload.cluster_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`kubernetes: missing required field "BronzeK8sWorkload.namespace"`)}
+	}
+	if v, ok := _c.mutation.Namespace(); ok {
+		if err := bronzek8sworkload.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sWorkload.namespace": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`kubernetes: missing required field "BronzeK8sWorkload.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := bronzek8sworkload.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sWorkload.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`kubernetes: missing required field "BronzeK8sWorkload.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := bronzek8sworkload.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sWorkload.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HostNetwork(); !ok {
+		return &ValidationError{Name: "host_network", err: errors.New(`kubernetes: missing required field "BronzeK8sWorkload.host_network"`)}
+	}
+	if _, ok := _c.mutation.HostPid(); !ok {
+		return &ValidationError{Name: "host_pid", err: errors.New(`kubernetes: missing required field "BronzeK8sWorkload.host_pid"`)}
+	}
+	if _, ok := _c.mutation.HostIpc(); !ok {
+		return &ValidationError{Name: "host_ipc", err: errors.New(`kubernetes: missing required field "BronzeK8sWorkload.host_ipc"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeK8sWorkloadCreate) sqlSave(ctx context.Context) (*BronzeK8sWorkload, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeK8sWorkload.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeK8sWorkloadCreate) createSpec() (*BronzeK8sWorkload, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeK8sWorkload{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzek8sworkload.Table, sqlgraph.NewFieldSpec(bronzek8sworkload.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeK8sWorkload
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8sworkload.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzek8sworkload.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8sworkload.FieldClusterName, field.TypeString, value)
+		_node.ClusterName = value
+	}
+	if value, ok := _c.mutation.Namespace(); ok {
+		_spec.SetField(bronzek8sworkload.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(bronzek8sworkload.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzek8sworkload.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.UID(); ok {
+		_spec.SetField(bronzek8sworkload.FieldUID, field.TypeString, value)
+		_node.UID = value
+	}
+	if value, ok := _c.mutation.Replicas(); ok {
+		_spec.SetField(bronzek8sworkload.FieldReplicas, field.TypeInt32, value)
+		_node.Replicas = &value
+	}
+	if value, ok := _c.mutation.ServiceAccount(); ok {
+		_spec.SetField(bronzek8sworkload.FieldServiceAccount, field.TypeString, value)
+		_node.ServiceAccount = value
+	}
+	if value, ok := _c.mutation.HostNetwork(); ok {
+		_spec.SetField(bronzek8sworkload.FieldHostNetwork, field.TypeBool, value)
+		_node.HostNetwork = value
+	}
+	if value, ok := _c.mutation.HostPid(); ok {
+		_spec.SetField(bronzek8sworkload.FieldHostPid, field.TypeBool, value)
+		_node.HostPid = value
+	}
+	if value, ok := _c.mutation.HostIpc(); ok {
+		_spec.SetField(bronzek8sworkload.FieldHostIpc, field.TypeBool, value)
+		_node.HostIpc = value
+	}
+	if value, ok := _c.mutation.ImagesJSON(); ok {
+		_spec.SetField(bronzek8sworkload.FieldImagesJSON, field.TypeJSON, value)
+		_node.ImagesJSON = value
+	}
+	if value, ok := _c.mutation.ContainersJSON(); ok {
+		_spec.SetField(bronzek8sworkload.FieldContainersJSON, field.TypeJSON, value)
+		_node.ContainersJSON = value
+	}
+	if value, ok := _c.mutation.SelectorJSON(); ok {
+		_spec.SetField(bronzek8sworkload.FieldSelectorJSON, field.TypeJSON, value)
+		_node.SelectorJSON = value
+	}
+	if value, ok := _c.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8sworkload.FieldLabelsJSON, field.TypeJSON, value)
+		_node.LabelsJSON = value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8sworkload.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	return _node, _spec
+}
+
+// BronzeK8sWorkloadCreateBulk is the builder for creating many BronzeK8sWorkload entities in bulk.
+type BronzeK8sWorkloadCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeK8sWorkloadCreate
+}
+
+// Save creates the BronzeK8sWorkload entities in the database.
+func (_c *BronzeK8sWorkloadCreateBulk) Save(ctx context.Context) ([]*BronzeK8sWorkload, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeK8sWorkload, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeK8sWorkloadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeK8sWorkloadCreateBulk) SaveX(ctx context.Context) []*BronzeK8sWorkload {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeK8sWorkloadCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeK8sWorkloadCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
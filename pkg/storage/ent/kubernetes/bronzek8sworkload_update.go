@@ -0,0 +1,971 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8sworkload"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeK8sWorkloadUpdate is the builder for updating BronzeK8sWorkload entities.
+type BronzeK8sWorkloadUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeK8sWorkloadMutation
+}
+
+// Where appends a list predicates to the BronzeK8sWorkloadUpdate builder.
+func (_u *BronzeK8sWorkloadUpdate) Where(ps ...predicate.BronzeK8sWorkload) *BronzeK8sWorkloadUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeK8sWorkloadUpdate) SetCollectedAt(v time.Time) *BronzeK8sWorkloadUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdate) SetNillableCollectedAt(v *time.Time) *BronzeK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeK8sWorkloadUpdate) SetClusterName(v string) *BronzeK8sWorkloadUpdate {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdate) SetNillableClusterName(v *string) *BronzeK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeK8sWorkloadUpdate) SetNamespace(v string) *BronzeK8sWorkloadUpdate {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdate) SetNillableNamespace(v *string) *BronzeK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *BronzeK8sWorkloadUpdate) SetKind(v string) *BronzeK8sWorkloadUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdate) SetNillableKind(v *string) *BronzeK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeK8sWorkloadUpdate) SetName(v string) *BronzeK8sWorkloadUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdate) SetNillableName(v *string) *BronzeK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeK8sWorkloadUpdate) SetUID(v string) *BronzeK8sWorkloadUpdate {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdate) SetNillableUID(v *string) *BronzeK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeK8sWorkloadUpdate) ClearUID() *BronzeK8sWorkloadUpdate {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetReplicas sets the "replicas" field.
+func (_u *BronzeK8sWorkloadUpdate) SetReplicas(v int32) *BronzeK8sWorkloadUpdate {
+	_u.mutation.ResetReplicas()
+	_u.mutation.SetReplicas(v)
+	return _u
+}
+
+// SetNillableReplicas sets the "replicas" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdate) SetNillableReplicas(v *int32) *BronzeK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetReplicas(*v)
+	}
+	return _u
+}
+
+// AddReplicas adds value to the "replicas" field.
+func (_u *BronzeK8sWorkloadUpdate) AddReplicas(v int32) *BronzeK8sWorkloadUpdate {
+	_u.mutation.AddReplicas(v)
+	return _u
+}
+
+// ClearReplicas clears the value of the "replicas" field.
+func (_u *BronzeK8sWorkloadUpdate) ClearReplicas() *BronzeK8sWorkloadUpdate {
+	_u.mutation.ClearReplicas()
+	return _u
+}
+
+// SetServiceAccount sets the "service_account" field.
+func (_u *BronzeK8sWorkloadUpdate) SetServiceAccount(v string) *BronzeK8sWorkloadUpdate {
+	_u.mutation.SetServiceAccount(v)
+	return _u
+}
+
+// SetNillableServiceAccount sets the "service_account" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdate) SetNillableServiceAccount(v *string) *BronzeK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetServiceAccount(*v)
+	}
+	return _u
+}
+
+// ClearServiceAccount clears the value of the "service_account" field.
+func (_u *BronzeK8sWorkloadUpdate) ClearServiceAccount() *BronzeK8sWorkloadUpdate {
+	_u.mutation.ClearServiceAccount()
+	return _u
+}
+
+// SetHostNetwork sets the "host_network" field.
+func (_u *BronzeK8sWorkloadUpdate) SetHostNetwork(v bool) *BronzeK8sWorkloadUpdate {
+	_u.mutation.SetHostNetwork(v)
+	return _u
+}
+
+// SetNillableHostNetwork sets the "host_network" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdate) SetNillableHostNetwork(v *bool) *BronzeK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetHostNetwork(*v)
+	}
+	return _u
+}
+
+// SetHostPid sets the "host_pid" field.
+func (_u *BronzeK8sWorkloadUpdate) SetHostPid(v bool) *BronzeK8sWorkloadUpdate {
+	_u.mutation.SetHostPid(v)
+	return _u
+}
+
+// SetNillableHostPid sets the "host_pid" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdate) SetNillableHostPid(v *bool) *BronzeK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetHostPid(*v)
+	}
+	return _u
+}
+
+// SetHostIpc sets the "host_ipc" field.
+func (_u *BronzeK8sWorkloadUpdate) SetHostIpc(v bool) *BronzeK8sWorkloadUpdate {
+	_u.mutation.SetHostIpc(v)
+	return _u
+}
+
+// SetNillableHostIpc sets the "host_ipc" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdate) SetNillableHostIpc(v *bool) *BronzeK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetHostIpc(*v)
+	}
+	return _u
+}
+
+// SetImagesJSON sets the "images_json" field.
+func (_u *BronzeK8sWorkloadUpdate) SetImagesJSON(v json.RawMessage) *BronzeK8sWorkloadUpdate {
+	_u.mutation.SetImagesJSON(v)
+	return _u
+}
+
+// AppendImagesJSON appends value to the "images_json" field.
+func (_u *BronzeK8sWorkloadUpdate) AppendImagesJSON(v json.RawMessage) *BronzeK8sWorkloadUpdate {
+	_u.mutation.AppendImagesJSON(v)
+	return _u
+}
+
+// ClearImagesJSON clears the value of the "images_json" field.
+func (_u *BronzeK8sWorkloadUpdate) ClearImagesJSON() *BronzeK8sWorkloadUpdate {
+	_u.mutation.ClearImagesJSON()
+	return _u
+}
+
+// SetContainersJSON sets the "containers_json" field.
+func (_u *BronzeK8sWorkloadUpdate) SetContainersJSON(v json.RawMessage) *BronzeK8sWorkloadUpdate {
+	_u.mutation.SetContainersJSON(v)
+	return _u
+}
+
+// AppendContainersJSON appends value to the "containers_json" field.
+func (_u *BronzeK8sWorkloadUpdate) AppendContainersJSON(v json.RawMessage) *BronzeK8sWorkloadUpdate {
+	_u.mutation.AppendContainersJSON(v)
+	return _u
+}
+
+// ClearContainersJSON clears the value of the "containers_json" field.
+func (_u *BronzeK8sWorkloadUpdate) ClearContainersJSON() *BronzeK8sWorkloadUpdate {
+	_u.mutation.ClearContainersJSON()
+	return _u
+}
+
+// SetSelectorJSON sets the "selector_json" field.
+func (_u *BronzeK8sWorkloadUpdate) SetSelectorJSON(v json.RawMessage) *BronzeK8sWorkloadUpdate {
+	_u.mutation.SetSelectorJSON(v)
+	return _u
+}
+
+// AppendSelectorJSON appends value to the "selector_json" field.
+func (_u *BronzeK8sWorkloadUpdate) AppendSelectorJSON(v json.RawMessage) *BronzeK8sWorkloadUpdate {
+	_u.mutation.AppendSelectorJSON(v)
+	return _u
+}
+
+// ClearSelectorJSON clears the value of the "selector_json" field.
+func (_u *BronzeK8sWorkloadUpdate) ClearSelectorJSON() *BronzeK8sWorkloadUpdate {
+	_u.mutation.ClearSelectorJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeK8sWorkloadUpdate) SetLabelsJSON(v json.RawMessage) *BronzeK8sWorkloadUpdate {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeK8sWorkloadUpdate) AppendLabelsJSON(v json.RawMessage) *BronzeK8sWorkloadUpdate {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeK8sWorkloadUpdate) ClearLabelsJSON() *BronzeK8sWorkloadUpdate {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeK8sWorkloadUpdate) SetAPICreatedAt(v time.Time) *BronzeK8sWorkloadUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sWorkloadUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeK8sWorkloadUpdate) ClearAPICreatedAt() *BronzeK8sWorkloadUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeK8sWorkloadMutation object of the builder.
+func (_u *BronzeK8sWorkloadUpdate) Mutation() *BronzeK8sWorkloadMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeK8sWorkloadUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeK8sWorkloadUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeK8sWorkloadUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeK8sWorkloadUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeK8sWorkloadUpdate) check() error {
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzek8sworkload.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sWorkload.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Namespace(); ok {
+		if err := bronzek8sworkload.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sWorkload.namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := bronzek8sworkload.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sWorkload.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzek8sworkload.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sWorkload.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeK8sWorkloadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzek8sworkload.Table, bronzek8sworkload.Columns, sqlgraph.NewFieldSpec(bronzek8sworkload.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8sworkload.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8sworkload.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzek8sworkload.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(bronzek8sworkload.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzek8sworkload.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzek8sworkload.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzek8sworkload.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Replicas(); ok {
+		_spec.SetField(bronzek8sworkload.FieldReplicas, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedReplicas(); ok {
+		_spec.AddField(bronzek8sworkload.FieldReplicas, field.TypeInt32, value)
+	}
+	if _u.mutation.ReplicasCleared() {
+		_spec.ClearField(bronzek8sworkload.FieldReplicas, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.ServiceAccount(); ok {
+		_spec.SetField(bronzek8sworkload.FieldServiceAccount, field.TypeString, value)
+	}
+	if _u.mutation.ServiceAccountCleared() {
+		_spec.ClearField(bronzek8sworkload.FieldServiceAccount, field.TypeString)
+	}
+	if value, ok := _u.mutation.HostNetwork(); ok {
+		_spec.SetField(bronzek8sworkload.FieldHostNetwork, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HostPid(); ok {
+		_spec.SetField(bronzek8sworkload.FieldHostPid, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HostIpc(); ok {
+		_spec.SetField(bronzek8sworkload.FieldHostIpc, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ImagesJSON(); ok {
+		_spec.SetField(bronzek8sworkload.FieldImagesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImagesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8sworkload.FieldImagesJSON, value)
+		})
+	}
+	if _u.mutation.ImagesJSONCleared() {
+		_spec.ClearField(bronzek8sworkload.FieldImagesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ContainersJSON(); ok {
+		_spec.SetField(bronzek8sworkload.FieldContainersJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedContainersJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8sworkload.FieldContainersJSON, value)
+		})
+	}
+	if _u.mutation.ContainersJSONCleared() {
+		_spec.ClearField(bronzek8sworkload.FieldContainersJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SelectorJSON(); ok {
+		_spec.SetField(bronzek8sworkload.FieldSelectorJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSelectorJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8sworkload.FieldSelectorJSON, value)
+		})
+	}
+	if _u.mutation.SelectorJSONCleared() {
+		_spec.ClearField(bronzek8sworkload.FieldSelectorJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8sworkload.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8sworkload.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzek8sworkload.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8sworkload.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzek8sworkload.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeK8sWorkload
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzek8sworkload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeK8sWorkloadUpdateOne is the builder for updating a single BronzeK8sWorkload entity.
+type BronzeK8sWorkloadUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeK8sWorkloadMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeK8sWorkloadUpdateOne) SetCollectedAt(v time.Time) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (_u *BronzeK8sWorkloadUpdateOne) SetClusterName(v string) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.SetClusterName(v)
+	return _u
+}
+
+// SetNillableClusterName sets the "cluster_name" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdateOne) SetNillableClusterName(v *string) *BronzeK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetClusterName(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *BronzeK8sWorkloadUpdateOne) SetNamespace(v string) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdateOne) SetNillableNamespace(v *string) *BronzeK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *BronzeK8sWorkloadUpdateOne) SetKind(v string) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdateOne) SetNillableKind(v *string) *BronzeK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeK8sWorkloadUpdateOne) SetName(v string) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdateOne) SetNillableName(v *string) *BronzeK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *BronzeK8sWorkloadUpdateOne) SetUID(v string) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdateOne) SetNillableUID(v *string) *BronzeK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// ClearUID clears the value of the "uid" field.
+func (_u *BronzeK8sWorkloadUpdateOne) ClearUID() *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.ClearUID()
+	return _u
+}
+
+// SetReplicas sets the "replicas" field.
+func (_u *BronzeK8sWorkloadUpdateOne) SetReplicas(v int32) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.ResetReplicas()
+	_u.mutation.SetReplicas(v)
+	return _u
+}
+
+// SetNillableReplicas sets the "replicas" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdateOne) SetNillableReplicas(v *int32) *BronzeK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetReplicas(*v)
+	}
+	return _u
+}
+
+// AddReplicas adds value to the "replicas" field.
+func (_u *BronzeK8sWorkloadUpdateOne) AddReplicas(v int32) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.AddReplicas(v)
+	return _u
+}
+
+// ClearReplicas clears the value of the "replicas" field.
+func (_u *BronzeK8sWorkloadUpdateOne) ClearReplicas() *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.ClearReplicas()
+	return _u
+}
+
+// SetServiceAccount sets the "service_account" field.
+func (_u *BronzeK8sWorkloadUpdateOne) SetServiceAccount(v string) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.SetServiceAccount(v)
+	return _u
+}
+
+// SetNillableServiceAccount sets the "service_account" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdateOne) SetNillableServiceAccount(v *string) *BronzeK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetServiceAccount(*v)
+	}
+	return _u
+}
+
+// ClearServiceAccount clears the value of the "service_account" field.
+func (_u *BronzeK8sWorkloadUpdateOne) ClearServiceAccount() *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.ClearServiceAccount()
+	return _u
+}
+
+// SetHostNetwork sets the "host_network" field.
+func (_u *BronzeK8sWorkloadUpdateOne) SetHostNetwork(v bool) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.SetHostNetwork(v)
+	return _u
+}
+
+// SetNillableHostNetwork sets the "host_network" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdateOne) SetNillableHostNetwork(v *bool) *BronzeK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetHostNetwork(*v)
+	}
+	return _u
+}
+
+// SetHostPid sets the "host_pid" field.
+func (_u *BronzeK8sWorkloadUpdateOne) SetHostPid(v bool) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.SetHostPid(v)
+	return _u
+}
+
+// SetNillableHostPid sets the "host_pid" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdateOne) SetNillableHostPid(v *bool) *BronzeK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetHostPid(*v)
+	}
+	return _u
+}
+
+// SetHostIpc sets the "host_ipc" field.
+func (_u *BronzeK8sWorkloadUpdateOne) SetHostIpc(v bool) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.SetHostIpc(v)
+	return _u
+}
+
+// SetNillableHostIpc sets the "host_ipc" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdateOne) SetNillableHostIpc(v *bool) *BronzeK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetHostIpc(*v)
+	}
+	return _u
+}
+
+// SetImagesJSON sets the "images_json" field.
+func (_u *BronzeK8sWorkloadUpdateOne) SetImagesJSON(v json.RawMessage) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.SetImagesJSON(v)
+	return _u
+}
+
+// AppendImagesJSON appends value to the "images_json" field.
+func (_u *BronzeK8sWorkloadUpdateOne) AppendImagesJSON(v json.RawMessage) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.AppendImagesJSON(v)
+	return _u
+}
+
+// ClearImagesJSON clears the value of the "images_json" field.
+func (_u *BronzeK8sWorkloadUpdateOne) ClearImagesJSON() *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.ClearImagesJSON()
+	return _u
+}
+
+// SetContainersJSON sets the "containers_json" field.
+func (_u *BronzeK8sWorkloadUpdateOne) SetContainersJSON(v json.RawMessage) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.SetContainersJSON(v)
+	return _u
+}
+
+// AppendContainersJSON appends value to the "containers_json" field.
+func (_u *BronzeK8sWorkloadUpdateOne) AppendContainersJSON(v json.RawMessage) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.AppendContainersJSON(v)
+	return _u
+}
+
+// ClearContainersJSON clears the value of the "containers_json" field.
+func (_u *BronzeK8sWorkloadUpdateOne) ClearContainersJSON() *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.ClearContainersJSON()
+	return _u
+}
+
+// SetSelectorJSON sets the "selector_json" field.
+func (_u *BronzeK8sWorkloadUpdateOne) SetSelectorJSON(v json.RawMessage) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.SetSelectorJSON(v)
+	return _u
+}
+
+// AppendSelectorJSON appends value to the "selector_json" field.
+func (_u *BronzeK8sWorkloadUpdateOne) AppendSelectorJSON(v json.RawMessage) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.AppendSelectorJSON(v)
+	return _u
+}
+
+// ClearSelectorJSON clears the value of the "selector_json" field.
+func (_u *BronzeK8sWorkloadUpdateOne) ClearSelectorJSON() *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.ClearSelectorJSON()
+	return _u
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (_u *BronzeK8sWorkloadUpdateOne) SetLabelsJSON(v json.RawMessage) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.SetLabelsJSON(v)
+	return _u
+}
+
+// AppendLabelsJSON appends value to the "labels_json" field.
+func (_u *BronzeK8sWorkloadUpdateOne) AppendLabelsJSON(v json.RawMessage) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.AppendLabelsJSON(v)
+	return _u
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (_u *BronzeK8sWorkloadUpdateOne) ClearLabelsJSON() *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.ClearLabelsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeK8sWorkloadUpdateOne) SetAPICreatedAt(v time.Time) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeK8sWorkloadUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeK8sWorkloadUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeK8sWorkloadUpdateOne) ClearAPICreatedAt() *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeK8sWorkloadMutation object of the builder.
+func (_u *BronzeK8sWorkloadUpdateOne) Mutation() *BronzeK8sWorkloadMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeK8sWorkloadUpdate builder.
+func (_u *BronzeK8sWorkloadUpdateOne) Where(ps ...predicate.BronzeK8sWorkload) *BronzeK8sWorkloadUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeK8sWorkloadUpdateOne) Select(field string, fields ...string) *BronzeK8sWorkloadUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeK8sWorkload entity.
+func (_u *BronzeK8sWorkloadUpdateOne) Save(ctx context.Context) (*BronzeK8sWorkload, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeK8sWorkloadUpdateOne) SaveX(ctx context.Context) *BronzeK8sWorkload {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeK8sWorkloadUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeK8sWorkloadUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeK8sWorkloadUpdateOne) check() error {
+	if v, ok := _u.mutation.ClusterName(); ok {
+		if err := bronzek8sworkload.ClusterNameValidator(v); err != nil {
+			return &ValidationError{Name: "cluster_name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sWorkload.cluster_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Namespace(); ok {
+		if err := bronzek8sworkload.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sWorkload.namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := bronzek8sworkload.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sWorkload.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzek8sworkload.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`kubernetes: validator failed for field "BronzeK8sWorkload.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeK8sWorkloadUpdateOne) sqlSave(ctx context.Context) (_node *BronzeK8sWorkload, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzek8sworkload.Table, bronzek8sworkload.Columns, sqlgraph.NewFieldSpec(bronzek8sworkload.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`kubernetes: missing "BronzeK8sWorkload.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzek8sworkload.FieldID)
+		for _, f := range fields {
+			if !bronzek8sworkload.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("kubernetes: invalid field %q for query", f)}
+			}
+			if f != bronzek8sworkload.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzek8sworkload.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClusterName(); ok {
+		_spec.SetField(bronzek8sworkload.FieldClusterName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(bronzek8sworkload.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(bronzek8sworkload.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzek8sworkload.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UID(); ok {
+		_spec.SetField(bronzek8sworkload.FieldUID, field.TypeString, value)
+	}
+	if _u.mutation.UIDCleared() {
+		_spec.ClearField(bronzek8sworkload.FieldUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Replicas(); ok {
+		_spec.SetField(bronzek8sworkload.FieldReplicas, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedReplicas(); ok {
+		_spec.AddField(bronzek8sworkload.FieldReplicas, field.TypeInt32, value)
+	}
+	if _u.mutation.ReplicasCleared() {
+		_spec.ClearField(bronzek8sworkload.FieldReplicas, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.ServiceAccount(); ok {
+		_spec.SetField(bronzek8sworkload.FieldServiceAccount, field.TypeString, value)
+	}
+	if _u.mutation.ServiceAccountCleared() {
+		_spec.ClearField(bronzek8sworkload.FieldServiceAccount, field.TypeString)
+	}
+	if value, ok := _u.mutation.HostNetwork(); ok {
+		_spec.SetField(bronzek8sworkload.FieldHostNetwork, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HostPid(); ok {
+		_spec.SetField(bronzek8sworkload.FieldHostPid, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HostIpc(); ok {
+		_spec.SetField(bronzek8sworkload.FieldHostIpc, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ImagesJSON(); ok {
+		_spec.SetField(bronzek8sworkload.FieldImagesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImagesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8sworkload.FieldImagesJSON, value)
+		})
+	}
+	if _u.mutation.ImagesJSONCleared() {
+		_spec.ClearField(bronzek8sworkload.FieldImagesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ContainersJSON(); ok {
+		_spec.SetField(bronzek8sworkload.FieldContainersJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedContainersJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8sworkload.FieldContainersJSON, value)
+		})
+	}
+	if _u.mutation.ContainersJSONCleared() {
+		_spec.ClearField(bronzek8sworkload.FieldContainersJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SelectorJSON(); ok {
+		_spec.SetField(bronzek8sworkload.FieldSelectorJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSelectorJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8sworkload.FieldSelectorJSON, value)
+		})
+	}
+	if _u.mutation.SelectorJSONCleared() {
+		_spec.ClearField(bronzek8sworkload.FieldSelectorJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LabelsJSON(); ok {
+		_spec.SetField(bronzek8sworkload.FieldLabelsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabelsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzek8sworkload.FieldLabelsJSON, value)
+		})
+	}
+	if _u.mutation.LabelsJSONCleared() {
+		_spec.ClearField(bronzek8sworkload.FieldLabelsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzek8sworkload.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzek8sworkload.FieldAPICreatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeK8sWorkload
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeK8sWorkload{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzek8sworkload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
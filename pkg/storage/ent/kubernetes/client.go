@@ -0,0 +1,2498 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/migrate"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8simage"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snamespace"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snetworkpolicy"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snode"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8spod"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8srolebinding"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8sserviceaccount"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8sworkload"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8simage"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snamespace"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snetworkpolicy"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snode"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8spod"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8srolebinding"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8sserviceaccount"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8sworkload"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/internal"
+)
+
+// Client is the client that holds all ent builders.
+type Client struct {
+	config
+	// Schema is the client for creating, migrating and dropping schema.
+	Schema *migrate.Schema
+	// BronzeHistoryK8sImage is the client for interacting with the BronzeHistoryK8sImage builders.
+	BronzeHistoryK8sImage *BronzeHistoryK8sImageClient
+	// BronzeHistoryK8sNamespace is the client for interacting with the BronzeHistoryK8sNamespace builders.
+	BronzeHistoryK8sNamespace *BronzeHistoryK8sNamespaceClient
+	// BronzeHistoryK8sNetworkPolicy is the client for interacting with the BronzeHistoryK8sNetworkPolicy builders.
+	BronzeHistoryK8sNetworkPolicy *BronzeHistoryK8sNetworkPolicyClient
+	// BronzeHistoryK8sNode is the client for interacting with the BronzeHistoryK8sNode builders.
+	BronzeHistoryK8sNode *BronzeHistoryK8sNodeClient
+	// BronzeHistoryK8sPod is the client for interacting with the BronzeHistoryK8sPod builders.
+	BronzeHistoryK8sPod *BronzeHistoryK8sPodClient
+	// BronzeHistoryK8sRoleBinding is the client for interacting with the BronzeHistoryK8sRoleBinding builders.
+	BronzeHistoryK8sRoleBinding *BronzeHistoryK8sRoleBindingClient
+	// BronzeHistoryK8sServiceAccount is the client for interacting with the BronzeHistoryK8sServiceAccount builders.
+	BronzeHistoryK8sServiceAccount *BronzeHistoryK8sServiceAccountClient
+	// BronzeHistoryK8sWorkload is the client for interacting with the BronzeHistoryK8sWorkload builders.
+	BronzeHistoryK8sWorkload *BronzeHistoryK8sWorkloadClient
+	// BronzeK8sImage is the client for interacting with the BronzeK8sImage builders.
+	BronzeK8sImage *BronzeK8sImageClient
+	// BronzeK8sNamespace is the client for interacting with the BronzeK8sNamespace builders.
+	BronzeK8sNamespace *BronzeK8sNamespaceClient
+	// BronzeK8sNetworkPolicy is the client for interacting with the BronzeK8sNetworkPolicy builders.
+	BronzeK8sNetworkPolicy *BronzeK8sNetworkPolicyClient
+	// BronzeK8sNode is the client for interacting with the BronzeK8sNode builders.
+	BronzeK8sNode *BronzeK8sNodeClient
+	// BronzeK8sPod is the client for interacting with the BronzeK8sPod builders.
+	BronzeK8sPod *BronzeK8sPodClient
+	// BronzeK8sRoleBinding is the client for interacting with the BronzeK8sRoleBinding builders.
+	BronzeK8sRoleBinding *BronzeK8sRoleBindingClient
+	// BronzeK8sServiceAccount is the client for interacting with the BronzeK8sServiceAccount builders.
+	BronzeK8sServiceAccount *BronzeK8sServiceAccountClient
+	// BronzeK8sWorkload is the client for interacting with the BronzeK8sWorkload builders.
+	BronzeK8sWorkload *BronzeK8sWorkloadClient
+}
+
+// NewClient creates a new client configured with the given options.
+func NewClient(opts ...Option) *Client {
+	client := &Client{config: newConfig(opts...)}
+	client.init()
+	return client
+}
+
+func (c *Client) init() {
+	c.Schema = migrate.NewSchema(c.driver)
+	c.BronzeHistoryK8sImage = NewBronzeHistoryK8sImageClient(c.config)
+	c.BronzeHistoryK8sNamespace = NewBronzeHistoryK8sNamespaceClient(c.config)
+	c.BronzeHistoryK8sNetworkPolicy = NewBronzeHistoryK8sNetworkPolicyClient(c.config)
+	c.BronzeHistoryK8sNode = NewBronzeHistoryK8sNodeClient(c.config)
+	c.BronzeHistoryK8sPod = NewBronzeHistoryK8sPodClient(c.config)
+	c.BronzeHistoryK8sRoleBinding = NewBronzeHistoryK8sRoleBindingClient(c.config)
+	c.BronzeHistoryK8sServiceAccount = NewBronzeHistoryK8sServiceAccountClient(c.config)
+	c.BronzeHistoryK8sWorkload = NewBronzeHistoryK8sWorkloadClient(c.config)
+	c.BronzeK8sImage = NewBronzeK8sImageClient(c.config)
+	c.BronzeK8sNamespace = NewBronzeK8sNamespaceClient(c.config)
+	c.BronzeK8sNetworkPolicy = NewBronzeK8sNetworkPolicyClient(c.config)
+	c.BronzeK8sNode = NewBronzeK8sNodeClient(c.config)
+	c.BronzeK8sPod = NewBronzeK8sPodClient(c.config)
+	c.BronzeK8sRoleBinding = NewBronzeK8sRoleBindingClient(c.config)
+	c.BronzeK8sServiceAccount = NewBronzeK8sServiceAccountClient(c.config)
+	c.BronzeK8sWorkload = NewBronzeK8sWorkloadClient(c.config)
+}
+
+type (
+	// config is the configuration for the client and its builder.
+	config struct {
+		// driver used for executing database requests.
+		driver dialect.Driver
+		// debug enable a debug logging.
+		debug bool
+		// log used for logging on debug mode.
+		log func(...any)
+		// hooks to execute on mutations.
+		hooks *hooks
+		// interceptors to execute on queries.
+		inters *inters
+		// schemaConfig contains alternative names for all tables.
+		schemaConfig SchemaConfig
+	}
+	// Option function to configure the client.
+	Option func(*config)
+)
+
+// newConfig creates a new config for the client.
+func newConfig(opts ...Option) config {
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
+	cfg.options(opts...)
+	return cfg
+}
+
+// options applies the options on the config object.
+func (c *config) options(opts ...Option) {
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.debug {
+		c.driver = dialect.Debug(c.driver, c.log)
+	}
+}
+
+// Debug enables debug logging on the ent.Driver.
+func Debug() Option {
+	return func(c *config) {
+		c.debug = true
+	}
+}
+
+// Log sets the logging function for debug mode.
+func Log(fn func(...any)) Option {
+	return func(c *config) {
+		c.log = fn
+	}
+}
+
+// Driver configures the client driver.
+func Driver(driver dialect.Driver) Option {
+	return func(c *config) {
+		c.driver = driver
+	}
+}
+
+// Open opens a database/sql.DB specified by the driver name and
+// the data source name, and returns a new client attached to it.
+// Optional parameters can be added for configuring the client.
+func Open(driverName, dataSourceName string, options ...Option) (*Client, error) {
+	switch driverName {
+	case dialect.MySQL, dialect.Postgres, dialect.SQLite:
+		drv, err := sql.Open(driverName, dataSourceName)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(append(options, Driver(drv))...), nil
+	default:
+		return nil, fmt.Errorf("unsupported driver: %q", driverName)
+	}
+}
+
+// ErrTxStarted is returned when trying to start a new transaction from a transactional client.
+var ErrTxStarted = errors.New("kubernetes: cannot start a transaction within a transaction")
+
+// Tx returns a new transactional client. The provided context
+// is used until the transaction is committed or rolled back.
+func (c *Client) Tx(ctx context.Context) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, ErrTxStarted
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("kubernetes: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	return &Tx{
+		ctx:                            ctx,
+		config:                         cfg,
+		BronzeHistoryK8sImage:          NewBronzeHistoryK8sImageClient(cfg),
+		BronzeHistoryK8sNamespace:      NewBronzeHistoryK8sNamespaceClient(cfg),
+		BronzeHistoryK8sNetworkPolicy:  NewBronzeHistoryK8sNetworkPolicyClient(cfg),
+		BronzeHistoryK8sNode:           NewBronzeHistoryK8sNodeClient(cfg),
+		BronzeHistoryK8sPod:            NewBronzeHistoryK8sPodClient(cfg),
+		BronzeHistoryK8sRoleBinding:    NewBronzeHistoryK8sRoleBindingClient(cfg),
+		BronzeHistoryK8sServiceAccount: NewBronzeHistoryK8sServiceAccountClient(cfg),
+		BronzeHistoryK8sWorkload:       NewBronzeHistoryK8sWorkloadClient(cfg),
+		BronzeK8sImage:                 NewBronzeK8sImageClient(cfg),
+		BronzeK8sNamespace:             NewBronzeK8sNamespaceClient(cfg),
+		BronzeK8sNetworkPolicy:         NewBronzeK8sNetworkPolicyClient(cfg),
+		BronzeK8sNode:                  NewBronzeK8sNodeClient(cfg),
+		BronzeK8sPod:                   NewBronzeK8sPodClient(cfg),
+		BronzeK8sRoleBinding:           NewBronzeK8sRoleBindingClient(cfg),
+		BronzeK8sServiceAccount:        NewBronzeK8sServiceAccountClient(cfg),
+		BronzeK8sWorkload:              NewBronzeK8sWorkloadClient(cfg),
+	}, nil
+}
+
+// BeginTx returns a transactional client with specified options.
+func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, errors.New("ent: cannot start a transaction within a transaction")
+	}
+	tx, err := c.driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}).BeginTx(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = &txDriver{tx: tx, drv: c.driver}
+	return &Tx{
+		ctx:                            ctx,
+		config:                         cfg,
+		BronzeHistoryK8sImage:          NewBronzeHistoryK8sImageClient(cfg),
+		BronzeHistoryK8sNamespace:      NewBronzeHistoryK8sNamespaceClient(cfg),
+		BronzeHistoryK8sNetworkPolicy:  NewBronzeHistoryK8sNetworkPolicyClient(cfg),
+		BronzeHistoryK8sNode:           NewBronzeHistoryK8sNodeClient(cfg),
+		BronzeHistoryK8sPod:            NewBronzeHistoryK8sPodClient(cfg),
+		BronzeHistoryK8sRoleBinding:    NewBronzeHistoryK8sRoleBindingClient(cfg),
+		BronzeHistoryK8sServiceAccount: NewBronzeHistoryK8sServiceAccountClient(cfg),
+		BronzeHistoryK8sWorkload:       NewBronzeHistoryK8sWorkloadClient(cfg),
+		BronzeK8sImage:                 NewBronzeK8sImageClient(cfg),
+		BronzeK8sNamespace:             NewBronzeK8sNamespaceClient(cfg),
+		BronzeK8sNetworkPolicy:         NewBronzeK8sNetworkPolicyClient(cfg),
+		BronzeK8sNode:                  NewBronzeK8sNodeClient(cfg),
+		BronzeK8sPod:                   NewBronzeK8sPodClient(cfg),
+		BronzeK8sRoleBinding:           NewBronzeK8sRoleBindingClient(cfg),
+		BronzeK8sServiceAccount:        NewBronzeK8sServiceAccountClient(cfg),
+		BronzeK8sWorkload:              NewBronzeK8sWorkloadClient(cfg),
+	}, nil
+}
+
+// Debug returns a new debug-client. It's used to get verbose logging on specific operations.
+//
+//	client.Debug().
+//		BronzeHistoryK8sImage.
+//		Query().
+//		Count(ctx)
+func (c *Client) Debug() *Client {
+	if c.debug {
+		return c
+	}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// Close closes the database connection and prevents new queries from starting.
+func (c *Client) Close() error {
+	return c.driver.Close()
+}
+
+// Use adds the mutation hooks to all the entity clients.
+// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
+func (c *Client) Use(hooks ...Hook) {
+	for _, n := range []interface{ Use(...Hook) }{
+		c.BronzeHistoryK8sImage, c.BronzeHistoryK8sNamespace,
+		c.BronzeHistoryK8sNetworkPolicy, c.BronzeHistoryK8sNode, c.BronzeHistoryK8sPod,
+		c.BronzeHistoryK8sRoleBinding, c.BronzeHistoryK8sServiceAccount,
+		c.BronzeHistoryK8sWorkload, c.BronzeK8sImage, c.BronzeK8sNamespace,
+		c.BronzeK8sNetworkPolicy, c.BronzeK8sNode, c.BronzeK8sPod,
+		c.BronzeK8sRoleBinding, c.BronzeK8sServiceAccount, c.BronzeK8sWorkload,
+	} {
+		n.Use(hooks...)
+	}
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.BronzeHistoryK8sImage, c.BronzeHistoryK8sNamespace,
+		c.BronzeHistoryK8sNetworkPolicy, c.BronzeHistoryK8sNode, c.BronzeHistoryK8sPod,
+		c.BronzeHistoryK8sRoleBinding, c.BronzeHistoryK8sServiceAccount,
+		c.BronzeHistoryK8sWorkload, c.BronzeK8sImage, c.BronzeK8sNamespace,
+		c.BronzeK8sNetworkPolicy, c.BronzeK8sNode, c.BronzeK8sPod,
+		c.BronzeK8sRoleBinding, c.BronzeK8sServiceAccount, c.BronzeK8sWorkload,
+	} {
+		n.Intercept(interceptors...)
+	}
+}
+
+// Mutate implements the ent.Mutator interface.
+func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
+	switch m := m.(type) {
+	case *BronzeHistoryK8sImageMutation:
+		return c.BronzeHistoryK8sImage.mutate(ctx, m)
+	case *BronzeHistoryK8sNamespaceMutation:
+		return c.BronzeHistoryK8sNamespace.mutate(ctx, m)
+	case *BronzeHistoryK8sNetworkPolicyMutation:
+		return c.BronzeHistoryK8sNetworkPolicy.mutate(ctx, m)
+	case *BronzeHistoryK8sNodeMutation:
+		return c.BronzeHistoryK8sNode.mutate(ctx, m)
+	case *BronzeHistoryK8sPodMutation:
+		return c.BronzeHistoryK8sPod.mutate(ctx, m)
+	case *BronzeHistoryK8sRoleBindingMutation:
+		return c.BronzeHistoryK8sRoleBinding.mutate(ctx, m)
+	case *BronzeHistoryK8sServiceAccountMutation:
+		return c.BronzeHistoryK8sServiceAccount.mutate(ctx, m)
+	case *BronzeHistoryK8sWorkloadMutation:
+		return c.BronzeHistoryK8sWorkload.mutate(ctx, m)
+	case *BronzeK8sImageMutation:
+		return c.BronzeK8sImage.mutate(ctx, m)
+	case *BronzeK8sNamespaceMutation:
+		return c.BronzeK8sNamespace.mutate(ctx, m)
+	case *BronzeK8sNetworkPolicyMutation:
+		return c.BronzeK8sNetworkPolicy.mutate(ctx, m)
+	case *BronzeK8sNodeMutation:
+		return c.BronzeK8sNode.mutate(ctx, m)
+	case *BronzeK8sPodMutation:
+		return c.BronzeK8sPod.mutate(ctx, m)
+	case *BronzeK8sRoleBindingMutation:
+		return c.BronzeK8sRoleBinding.mutate(ctx, m)
+	case *BronzeK8sServiceAccountMutation:
+		return c.BronzeK8sServiceAccount.mutate(ctx, m)
+	case *BronzeK8sWorkloadMutation:
+		return c.BronzeK8sWorkload.mutate(ctx, m)
+	default:
+		return nil, fmt.Errorf("kubernetes: unknown mutation type %T", m)
+	}
+}
+
+// BronzeHistoryK8sImageClient is a client for the BronzeHistoryK8sImage schema.
+type BronzeHistoryK8sImageClient struct {
+	config
+}
+
+// NewBronzeHistoryK8sImageClient returns a client for the BronzeHistoryK8sImage from the given config.
+func NewBronzeHistoryK8sImageClient(c config) *BronzeHistoryK8sImageClient {
+	return &BronzeHistoryK8sImageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryk8simage.Hooks(f(g(h())))`.
+func (c *BronzeHistoryK8sImageClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryK8sImage = append(c.hooks.BronzeHistoryK8sImage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryk8simage.Intercept(f(g(h())))`.
+func (c *BronzeHistoryK8sImageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryK8sImage = append(c.inters.BronzeHistoryK8sImage, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryK8sImage entity.
+func (c *BronzeHistoryK8sImageClient) Create() *BronzeHistoryK8sImageCreate {
+	mutation := newBronzeHistoryK8sImageMutation(c.config, OpCreate)
+	return &BronzeHistoryK8sImageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryK8sImage entities.
+func (c *BronzeHistoryK8sImageClient) CreateBulk(builders ...*BronzeHistoryK8sImageCreate) *BronzeHistoryK8sImageCreateBulk {
+	return &BronzeHistoryK8sImageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryK8sImageClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryK8sImageCreate, int)) *BronzeHistoryK8sImageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryK8sImageCreateBulk{err: fmt.Errorf("calling to BronzeHistoryK8sImageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryK8sImageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryK8sImageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryK8sImage.
+func (c *BronzeHistoryK8sImageClient) Update() *BronzeHistoryK8sImageUpdate {
+	mutation := newBronzeHistoryK8sImageMutation(c.config, OpUpdate)
+	return &BronzeHistoryK8sImageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryK8sImageClient) UpdateOne(_m *BronzeHistoryK8sImage) *BronzeHistoryK8sImageUpdateOne {
+	mutation := newBronzeHistoryK8sImageMutation(c.config, OpUpdateOne, withBronzeHistoryK8sImage(_m))
+	return &BronzeHistoryK8sImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryK8sImageClient) UpdateOneID(id uint) *BronzeHistoryK8sImageUpdateOne {
+	mutation := newBronzeHistoryK8sImageMutation(c.config, OpUpdateOne, withBronzeHistoryK8sImageID(id))
+	return &BronzeHistoryK8sImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryK8sImage.
+func (c *BronzeHistoryK8sImageClient) Delete() *BronzeHistoryK8sImageDelete {
+	mutation := newBronzeHistoryK8sImageMutation(c.config, OpDelete)
+	return &BronzeHistoryK8sImageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryK8sImageClient) DeleteOne(_m *BronzeHistoryK8sImage) *BronzeHistoryK8sImageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryK8sImageClient) DeleteOneID(id uint) *BronzeHistoryK8sImageDeleteOne {
+	builder := c.Delete().Where(bronzehistoryk8simage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryK8sImageDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryK8sImage.
+func (c *BronzeHistoryK8sImageClient) Query() *BronzeHistoryK8sImageQuery {
+	return &BronzeHistoryK8sImageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryK8sImage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryK8sImage entity by its id.
+func (c *BronzeHistoryK8sImageClient) Get(ctx context.Context, id uint) (*BronzeHistoryK8sImage, error) {
+	return c.Query().Where(bronzehistoryk8simage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryK8sImageClient) GetX(ctx context.Context, id uint) *BronzeHistoryK8sImage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryK8sImageClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryK8sImage
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryK8sImageClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryK8sImage
+}
+
+func (c *BronzeHistoryK8sImageClient) mutate(ctx context.Context, m *BronzeHistoryK8sImageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryK8sImageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryK8sImageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryK8sImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryK8sImageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("kubernetes: unknown BronzeHistoryK8sImage mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryK8sNamespaceClient is a client for the BronzeHistoryK8sNamespace schema.
+type BronzeHistoryK8sNamespaceClient struct {
+	config
+}
+
+// NewBronzeHistoryK8sNamespaceClient returns a client for the BronzeHistoryK8sNamespace from the given config.
+func NewBronzeHistoryK8sNamespaceClient(c config) *BronzeHistoryK8sNamespaceClient {
+	return &BronzeHistoryK8sNamespaceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryk8snamespace.Hooks(f(g(h())))`.
+func (c *BronzeHistoryK8sNamespaceClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryK8sNamespace = append(c.hooks.BronzeHistoryK8sNamespace, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryk8snamespace.Intercept(f(g(h())))`.
+func (c *BronzeHistoryK8sNamespaceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryK8sNamespace = append(c.inters.BronzeHistoryK8sNamespace, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryK8sNamespace entity.
+func (c *BronzeHistoryK8sNamespaceClient) Create() *BronzeHistoryK8sNamespaceCreate {
+	mutation := newBronzeHistoryK8sNamespaceMutation(c.config, OpCreate)
+	return &BronzeHistoryK8sNamespaceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryK8sNamespace entities.
+func (c *BronzeHistoryK8sNamespaceClient) CreateBulk(builders ...*BronzeHistoryK8sNamespaceCreate) *BronzeHistoryK8sNamespaceCreateBulk {
+	return &BronzeHistoryK8sNamespaceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryK8sNamespaceClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryK8sNamespaceCreate, int)) *BronzeHistoryK8sNamespaceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryK8sNamespaceCreateBulk{err: fmt.Errorf("calling to BronzeHistoryK8sNamespaceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryK8sNamespaceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryK8sNamespaceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryK8sNamespace.
+func (c *BronzeHistoryK8sNamespaceClient) Update() *BronzeHistoryK8sNamespaceUpdate {
+	mutation := newBronzeHistoryK8sNamespaceMutation(c.config, OpUpdate)
+	return &BronzeHistoryK8sNamespaceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryK8sNamespaceClient) UpdateOne(_m *BronzeHistoryK8sNamespace) *BronzeHistoryK8sNamespaceUpdateOne {
+	mutation := newBronzeHistoryK8sNamespaceMutation(c.config, OpUpdateOne, withBronzeHistoryK8sNamespace(_m))
+	return &BronzeHistoryK8sNamespaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryK8sNamespaceClient) UpdateOneID(id uint) *BronzeHistoryK8sNamespaceUpdateOne {
+	mutation := newBronzeHistoryK8sNamespaceMutation(c.config, OpUpdateOne, withBronzeHistoryK8sNamespaceID(id))
+	return &BronzeHistoryK8sNamespaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryK8sNamespace.
+func (c *BronzeHistoryK8sNamespaceClient) Delete() *BronzeHistoryK8sNamespaceDelete {
+	mutation := newBronzeHistoryK8sNamespaceMutation(c.config, OpDelete)
+	return &BronzeHistoryK8sNamespaceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryK8sNamespaceClient) DeleteOne(_m *BronzeHistoryK8sNamespace) *BronzeHistoryK8sNamespaceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryK8sNamespaceClient) DeleteOneID(id uint) *BronzeHistoryK8sNamespaceDeleteOne {
+	builder := c.Delete().Where(bronzehistoryk8snamespace.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryK8sNamespaceDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryK8sNamespace.
+func (c *BronzeHistoryK8sNamespaceClient) Query() *BronzeHistoryK8sNamespaceQuery {
+	return &BronzeHistoryK8sNamespaceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryK8sNamespace},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryK8sNamespace entity by its id.
+func (c *BronzeHistoryK8sNamespaceClient) Get(ctx context.Context, id uint) (*BronzeHistoryK8sNamespace, error) {
+	return c.Query().Where(bronzehistoryk8snamespace.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryK8sNamespaceClient) GetX(ctx context.Context, id uint) *BronzeHistoryK8sNamespace {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryK8sNamespaceClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryK8sNamespace
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryK8sNamespaceClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryK8sNamespace
+}
+
+func (c *BronzeHistoryK8sNamespaceClient) mutate(ctx context.Context, m *BronzeHistoryK8sNamespaceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryK8sNamespaceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryK8sNamespaceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryK8sNamespaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryK8sNamespaceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("kubernetes: unknown BronzeHistoryK8sNamespace mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryK8sNetworkPolicyClient is a client for the BronzeHistoryK8sNetworkPolicy schema.
+type BronzeHistoryK8sNetworkPolicyClient struct {
+	config
+}
+
+// NewBronzeHistoryK8sNetworkPolicyClient returns a client for the BronzeHistoryK8sNetworkPolicy from the given config.
+func NewBronzeHistoryK8sNetworkPolicyClient(c config) *BronzeHistoryK8sNetworkPolicyClient {
+	return &BronzeHistoryK8sNetworkPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryk8snetworkpolicy.Hooks(f(g(h())))`.
+func (c *BronzeHistoryK8sNetworkPolicyClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryK8sNetworkPolicy = append(c.hooks.BronzeHistoryK8sNetworkPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryk8snetworkpolicy.Intercept(f(g(h())))`.
+func (c *BronzeHistoryK8sNetworkPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryK8sNetworkPolicy = append(c.inters.BronzeHistoryK8sNetworkPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryK8sNetworkPolicy entity.
+func (c *BronzeHistoryK8sNetworkPolicyClient) Create() *BronzeHistoryK8sNetworkPolicyCreate {
+	mutation := newBronzeHistoryK8sNetworkPolicyMutation(c.config, OpCreate)
+	return &BronzeHistoryK8sNetworkPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryK8sNetworkPolicy entities.
+func (c *BronzeHistoryK8sNetworkPolicyClient) CreateBulk(builders ...*BronzeHistoryK8sNetworkPolicyCreate) *BronzeHistoryK8sNetworkPolicyCreateBulk {
+	return &BronzeHistoryK8sNetworkPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryK8sNetworkPolicyClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryK8sNetworkPolicyCreate, int)) *BronzeHistoryK8sNetworkPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryK8sNetworkPolicyCreateBulk{err: fmt.Errorf("calling to BronzeHistoryK8sNetworkPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryK8sNetworkPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryK8sNetworkPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryK8sNetworkPolicy.
+func (c *BronzeHistoryK8sNetworkPolicyClient) Update() *BronzeHistoryK8sNetworkPolicyUpdate {
+	mutation := newBronzeHistoryK8sNetworkPolicyMutation(c.config, OpUpdate)
+	return &BronzeHistoryK8sNetworkPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryK8sNetworkPolicyClient) UpdateOne(_m *BronzeHistoryK8sNetworkPolicy) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	mutation := newBronzeHistoryK8sNetworkPolicyMutation(c.config, OpUpdateOne, withBronzeHistoryK8sNetworkPolicy(_m))
+	return &BronzeHistoryK8sNetworkPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryK8sNetworkPolicyClient) UpdateOneID(id uint) *BronzeHistoryK8sNetworkPolicyUpdateOne {
+	mutation := newBronzeHistoryK8sNetworkPolicyMutation(c.config, OpUpdateOne, withBronzeHistoryK8sNetworkPolicyID(id))
+	return &BronzeHistoryK8sNetworkPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryK8sNetworkPolicy.
+func (c *BronzeHistoryK8sNetworkPolicyClient) Delete() *BronzeHistoryK8sNetworkPolicyDelete {
+	mutation := newBronzeHistoryK8sNetworkPolicyMutation(c.config, OpDelete)
+	return &BronzeHistoryK8sNetworkPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryK8sNetworkPolicyClient) DeleteOne(_m *BronzeHistoryK8sNetworkPolicy) *BronzeHistoryK8sNetworkPolicyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryK8sNetworkPolicyClient) DeleteOneID(id uint) *BronzeHistoryK8sNetworkPolicyDeleteOne {
+	builder := c.Delete().Where(bronzehistoryk8snetworkpolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryK8sNetworkPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryK8sNetworkPolicy.
+func (c *BronzeHistoryK8sNetworkPolicyClient) Query() *BronzeHistoryK8sNetworkPolicyQuery {
+	return &BronzeHistoryK8sNetworkPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryK8sNetworkPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryK8sNetworkPolicy entity by its id.
+func (c *BronzeHistoryK8sNetworkPolicyClient) Get(ctx context.Context, id uint) (*BronzeHistoryK8sNetworkPolicy, error) {
+	return c.Query().Where(bronzehistoryk8snetworkpolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryK8sNetworkPolicyClient) GetX(ctx context.Context, id uint) *BronzeHistoryK8sNetworkPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryK8sNetworkPolicyClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryK8sNetworkPolicy
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryK8sNetworkPolicyClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryK8sNetworkPolicy
+}
+
+func (c *BronzeHistoryK8sNetworkPolicyClient) mutate(ctx context.Context, m *BronzeHistoryK8sNetworkPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryK8sNetworkPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryK8sNetworkPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryK8sNetworkPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryK8sNetworkPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("kubernetes: unknown BronzeHistoryK8sNetworkPolicy mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryK8sNodeClient is a client for the BronzeHistoryK8sNode schema.
+type BronzeHistoryK8sNodeClient struct {
+	config
+}
+
+// NewBronzeHistoryK8sNodeClient returns a client for the BronzeHistoryK8sNode from the given config.
+func NewBronzeHistoryK8sNodeClient(c config) *BronzeHistoryK8sNodeClient {
+	return &BronzeHistoryK8sNodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryk8snode.Hooks(f(g(h())))`.
+func (c *BronzeHistoryK8sNodeClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryK8sNode = append(c.hooks.BronzeHistoryK8sNode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryk8snode.Intercept(f(g(h())))`.
+func (c *BronzeHistoryK8sNodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryK8sNode = append(c.inters.BronzeHistoryK8sNode, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryK8sNode entity.
+func (c *BronzeHistoryK8sNodeClient) Create() *BronzeHistoryK8sNodeCreate {
+	mutation := newBronzeHistoryK8sNodeMutation(c.config, OpCreate)
+	return &BronzeHistoryK8sNodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryK8sNode entities.
+func (c *BronzeHistoryK8sNodeClient) CreateBulk(builders ...*BronzeHistoryK8sNodeCreate) *BronzeHistoryK8sNodeCreateBulk {
+	return &BronzeHistoryK8sNodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryK8sNodeClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryK8sNodeCreate, int)) *BronzeHistoryK8sNodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryK8sNodeCreateBulk{err: fmt.Errorf("calling to BronzeHistoryK8sNodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryK8sNodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryK8sNodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryK8sNode.
+func (c *BronzeHistoryK8sNodeClient) Update() *BronzeHistoryK8sNodeUpdate {
+	mutation := newBronzeHistoryK8sNodeMutation(c.config, OpUpdate)
+	return &BronzeHistoryK8sNodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryK8sNodeClient) UpdateOne(_m *BronzeHistoryK8sNode) *BronzeHistoryK8sNodeUpdateOne {
+	mutation := newBronzeHistoryK8sNodeMutation(c.config, OpUpdateOne, withBronzeHistoryK8sNode(_m))
+	return &BronzeHistoryK8sNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryK8sNodeClient) UpdateOneID(id uint) *BronzeHistoryK8sNodeUpdateOne {
+	mutation := newBronzeHistoryK8sNodeMutation(c.config, OpUpdateOne, withBronzeHistoryK8sNodeID(id))
+	return &BronzeHistoryK8sNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryK8sNode.
+func (c *BronzeHistoryK8sNodeClient) Delete() *BronzeHistoryK8sNodeDelete {
+	mutation := newBronzeHistoryK8sNodeMutation(c.config, OpDelete)
+	return &BronzeHistoryK8sNodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryK8sNodeClient) DeleteOne(_m *BronzeHistoryK8sNode) *BronzeHistoryK8sNodeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryK8sNodeClient) DeleteOneID(id uint) *BronzeHistoryK8sNodeDeleteOne {
+	builder := c.Delete().Where(bronzehistoryk8snode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryK8sNodeDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryK8sNode.
+func (c *BronzeHistoryK8sNodeClient) Query() *BronzeHistoryK8sNodeQuery {
+	return &BronzeHistoryK8sNodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryK8sNode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryK8sNode entity by its id.
+func (c *BronzeHistoryK8sNodeClient) Get(ctx context.Context, id uint) (*BronzeHistoryK8sNode, error) {
+	return c.Query().Where(bronzehistoryk8snode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryK8sNodeClient) GetX(ctx context.Context, id uint) *BronzeHistoryK8sNode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryK8sNodeClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryK8sNode
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryK8sNodeClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryK8sNode
+}
+
+func (c *BronzeHistoryK8sNodeClient) mutate(ctx context.Context, m *BronzeHistoryK8sNodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryK8sNodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryK8sNodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryK8sNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryK8sNodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("kubernetes: unknown BronzeHistoryK8sNode mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryK8sPodClient is a client for the BronzeHistoryK8sPod schema.
+type BronzeHistoryK8sPodClient struct {
+	config
+}
+
+// NewBronzeHistoryK8sPodClient returns a client for the BronzeHistoryK8sPod from the given config.
+func NewBronzeHistoryK8sPodClient(c config) *BronzeHistoryK8sPodClient {
+	return &BronzeHistoryK8sPodClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryk8spod.Hooks(f(g(h())))`.
+func (c *BronzeHistoryK8sPodClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryK8sPod = append(c.hooks.BronzeHistoryK8sPod, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryk8spod.Intercept(f(g(h())))`.
+func (c *BronzeHistoryK8sPodClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryK8sPod = append(c.inters.BronzeHistoryK8sPod, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryK8sPod entity.
+func (c *BronzeHistoryK8sPodClient) Create() *BronzeHistoryK8sPodCreate {
+	mutation := newBronzeHistoryK8sPodMutation(c.config, OpCreate)
+	return &BronzeHistoryK8sPodCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryK8sPod entities.
+func (c *BronzeHistoryK8sPodClient) CreateBulk(builders ...*BronzeHistoryK8sPodCreate) *BronzeHistoryK8sPodCreateBulk {
+	return &BronzeHistoryK8sPodCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryK8sPodClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryK8sPodCreate, int)) *BronzeHistoryK8sPodCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryK8sPodCreateBulk{err: fmt.Errorf("calling to BronzeHistoryK8sPodClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryK8sPodCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryK8sPodCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryK8sPod.
+func (c *BronzeHistoryK8sPodClient) Update() *BronzeHistoryK8sPodUpdate {
+	mutation := newBronzeHistoryK8sPodMutation(c.config, OpUpdate)
+	return &BronzeHistoryK8sPodUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryK8sPodClient) UpdateOne(_m *BronzeHistoryK8sPod) *BronzeHistoryK8sPodUpdateOne {
+	mutation := newBronzeHistoryK8sPodMutation(c.config, OpUpdateOne, withBronzeHistoryK8sPod(_m))
+	return &BronzeHistoryK8sPodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryK8sPodClient) UpdateOneID(id uint) *BronzeHistoryK8sPodUpdateOne {
+	mutation := newBronzeHistoryK8sPodMutation(c.config, OpUpdateOne, withBronzeHistoryK8sPodID(id))
+	return &BronzeHistoryK8sPodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryK8sPod.
+func (c *BronzeHistoryK8sPodClient) Delete() *BronzeHistoryK8sPodDelete {
+	mutation := newBronzeHistoryK8sPodMutation(c.config, OpDelete)
+	return &BronzeHistoryK8sPodDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryK8sPodClient) DeleteOne(_m *BronzeHistoryK8sPod) *BronzeHistoryK8sPodDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryK8sPodClient) DeleteOneID(id uint) *BronzeHistoryK8sPodDeleteOne {
+	builder := c.Delete().Where(bronzehistoryk8spod.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryK8sPodDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryK8sPod.
+func (c *BronzeHistoryK8sPodClient) Query() *BronzeHistoryK8sPodQuery {
+	return &BronzeHistoryK8sPodQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryK8sPod},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryK8sPod entity by its id.
+func (c *BronzeHistoryK8sPodClient) Get(ctx context.Context, id uint) (*BronzeHistoryK8sPod, error) {
+	return c.Query().Where(bronzehistoryk8spod.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryK8sPodClient) GetX(ctx context.Context, id uint) *BronzeHistoryK8sPod {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryK8sPodClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryK8sPod
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryK8sPodClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryK8sPod
+}
+
+func (c *BronzeHistoryK8sPodClient) mutate(ctx context.Context, m *BronzeHistoryK8sPodMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryK8sPodCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryK8sPodUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryK8sPodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryK8sPodDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("kubernetes: unknown BronzeHistoryK8sPod mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryK8sRoleBindingClient is a client for the BronzeHistoryK8sRoleBinding schema.
+type BronzeHistoryK8sRoleBindingClient struct {
+	config
+}
+
+// NewBronzeHistoryK8sRoleBindingClient returns a client for the BronzeHistoryK8sRoleBinding from the given config.
+func NewBronzeHistoryK8sRoleBindingClient(c config) *BronzeHistoryK8sRoleBindingClient {
+	return &BronzeHistoryK8sRoleBindingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryk8srolebinding.Hooks(f(g(h())))`.
+func (c *BronzeHistoryK8sRoleBindingClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryK8sRoleBinding = append(c.hooks.BronzeHistoryK8sRoleBinding, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryk8srolebinding.Intercept(f(g(h())))`.
+func (c *BronzeHistoryK8sRoleBindingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryK8sRoleBinding = append(c.inters.BronzeHistoryK8sRoleBinding, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryK8sRoleBinding entity.
+func (c *BronzeHistoryK8sRoleBindingClient) Create() *BronzeHistoryK8sRoleBindingCreate {
+	mutation := newBronzeHistoryK8sRoleBindingMutation(c.config, OpCreate)
+	return &BronzeHistoryK8sRoleBindingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryK8sRoleBinding entities.
+func (c *BronzeHistoryK8sRoleBindingClient) CreateBulk(builders ...*BronzeHistoryK8sRoleBindingCreate) *BronzeHistoryK8sRoleBindingCreateBulk {
+	return &BronzeHistoryK8sRoleBindingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryK8sRoleBindingClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryK8sRoleBindingCreate, int)) *BronzeHistoryK8sRoleBindingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryK8sRoleBindingCreateBulk{err: fmt.Errorf("calling to BronzeHistoryK8sRoleBindingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryK8sRoleBindingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryK8sRoleBindingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryK8sRoleBinding.
+func (c *BronzeHistoryK8sRoleBindingClient) Update() *BronzeHistoryK8sRoleBindingUpdate {
+	mutation := newBronzeHistoryK8sRoleBindingMutation(c.config, OpUpdate)
+	return &BronzeHistoryK8sRoleBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryK8sRoleBindingClient) UpdateOne(_m *BronzeHistoryK8sRoleBinding) *BronzeHistoryK8sRoleBindingUpdateOne {
+	mutation := newBronzeHistoryK8sRoleBindingMutation(c.config, OpUpdateOne, withBronzeHistoryK8sRoleBinding(_m))
+	return &BronzeHistoryK8sRoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryK8sRoleBindingClient) UpdateOneID(id uint) *BronzeHistoryK8sRoleBindingUpdateOne {
+	mutation := newBronzeHistoryK8sRoleBindingMutation(c.config, OpUpdateOne, withBronzeHistoryK8sRoleBindingID(id))
+	return &BronzeHistoryK8sRoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryK8sRoleBinding.
+func (c *BronzeHistoryK8sRoleBindingClient) Delete() *BronzeHistoryK8sRoleBindingDelete {
+	mutation := newBronzeHistoryK8sRoleBindingMutation(c.config, OpDelete)
+	return &BronzeHistoryK8sRoleBindingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryK8sRoleBindingClient) DeleteOne(_m *BronzeHistoryK8sRoleBinding) *BronzeHistoryK8sRoleBindingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryK8sRoleBindingClient) DeleteOneID(id uint) *BronzeHistoryK8sRoleBindingDeleteOne {
+	builder := c.Delete().Where(bronzehistoryk8srolebinding.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryK8sRoleBindingDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryK8sRoleBinding.
+func (c *BronzeHistoryK8sRoleBindingClient) Query() *BronzeHistoryK8sRoleBindingQuery {
+	return &BronzeHistoryK8sRoleBindingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryK8sRoleBinding},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryK8sRoleBinding entity by its id.
+func (c *BronzeHistoryK8sRoleBindingClient) Get(ctx context.Context, id uint) (*BronzeHistoryK8sRoleBinding, error) {
+	return c.Query().Where(bronzehistoryk8srolebinding.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryK8sRoleBindingClient) GetX(ctx context.Context, id uint) *BronzeHistoryK8sRoleBinding {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryK8sRoleBindingClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryK8sRoleBinding
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryK8sRoleBindingClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryK8sRoleBinding
+}
+
+func (c *BronzeHistoryK8sRoleBindingClient) mutate(ctx context.Context, m *BronzeHistoryK8sRoleBindingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryK8sRoleBindingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryK8sRoleBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryK8sRoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryK8sRoleBindingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("kubernetes: unknown BronzeHistoryK8sRoleBinding mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryK8sServiceAccountClient is a client for the BronzeHistoryK8sServiceAccount schema.
+type BronzeHistoryK8sServiceAccountClient struct {
+	config
+}
+
+// NewBronzeHistoryK8sServiceAccountClient returns a client for the BronzeHistoryK8sServiceAccount from the given config.
+func NewBronzeHistoryK8sServiceAccountClient(c config) *BronzeHistoryK8sServiceAccountClient {
+	return &BronzeHistoryK8sServiceAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryk8sserviceaccount.Hooks(f(g(h())))`.
+func (c *BronzeHistoryK8sServiceAccountClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryK8sServiceAccount = append(c.hooks.BronzeHistoryK8sServiceAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryk8sserviceaccount.Intercept(f(g(h())))`.
+func (c *BronzeHistoryK8sServiceAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryK8sServiceAccount = append(c.inters.BronzeHistoryK8sServiceAccount, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryK8sServiceAccount entity.
+func (c *BronzeHistoryK8sServiceAccountClient) Create() *BronzeHistoryK8sServiceAccountCreate {
+	mutation := newBronzeHistoryK8sServiceAccountMutation(c.config, OpCreate)
+	return &BronzeHistoryK8sServiceAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryK8sServiceAccount entities.
+func (c *BronzeHistoryK8sServiceAccountClient) CreateBulk(builders ...*BronzeHistoryK8sServiceAccountCreate) *BronzeHistoryK8sServiceAccountCreateBulk {
+	return &BronzeHistoryK8sServiceAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryK8sServiceAccountClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryK8sServiceAccountCreate, int)) *BronzeHistoryK8sServiceAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryK8sServiceAccountCreateBulk{err: fmt.Errorf("calling to BronzeHistoryK8sServiceAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryK8sServiceAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryK8sServiceAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryK8sServiceAccount.
+func (c *BronzeHistoryK8sServiceAccountClient) Update() *BronzeHistoryK8sServiceAccountUpdate {
+	mutation := newBronzeHistoryK8sServiceAccountMutation(c.config, OpUpdate)
+	return &BronzeHistoryK8sServiceAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryK8sServiceAccountClient) UpdateOne(_m *BronzeHistoryK8sServiceAccount) *BronzeHistoryK8sServiceAccountUpdateOne {
+	mutation := newBronzeHistoryK8sServiceAccountMutation(c.config, OpUpdateOne, withBronzeHistoryK8sServiceAccount(_m))
+	return &BronzeHistoryK8sServiceAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryK8sServiceAccountClient) UpdateOneID(id uint) *BronzeHistoryK8sServiceAccountUpdateOne {
+	mutation := newBronzeHistoryK8sServiceAccountMutation(c.config, OpUpdateOne, withBronzeHistoryK8sServiceAccountID(id))
+	return &BronzeHistoryK8sServiceAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryK8sServiceAccount.
+func (c *BronzeHistoryK8sServiceAccountClient) Delete() *BronzeHistoryK8sServiceAccountDelete {
+	mutation := newBronzeHistoryK8sServiceAccountMutation(c.config, OpDelete)
+	return &BronzeHistoryK8sServiceAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryK8sServiceAccountClient) DeleteOne(_m *BronzeHistoryK8sServiceAccount) *BronzeHistoryK8sServiceAccountDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryK8sServiceAccountClient) DeleteOneID(id uint) *BronzeHistoryK8sServiceAccountDeleteOne {
+	builder := c.Delete().Where(bronzehistoryk8sserviceaccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryK8sServiceAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryK8sServiceAccount.
+func (c *BronzeHistoryK8sServiceAccountClient) Query() *BronzeHistoryK8sServiceAccountQuery {
+	return &BronzeHistoryK8sServiceAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryK8sServiceAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryK8sServiceAccount entity by its id.
+func (c *BronzeHistoryK8sServiceAccountClient) Get(ctx context.Context, id uint) (*BronzeHistoryK8sServiceAccount, error) {
+	return c.Query().Where(bronzehistoryk8sserviceaccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryK8sServiceAccountClient) GetX(ctx context.Context, id uint) *BronzeHistoryK8sServiceAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryK8sServiceAccountClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryK8sServiceAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryK8sServiceAccountClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryK8sServiceAccount
+}
+
+func (c *BronzeHistoryK8sServiceAccountClient) mutate(ctx context.Context, m *BronzeHistoryK8sServiceAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryK8sServiceAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryK8sServiceAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryK8sServiceAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryK8sServiceAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("kubernetes: unknown BronzeHistoryK8sServiceAccount mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryK8sWorkloadClient is a client for the BronzeHistoryK8sWorkload schema.
+type BronzeHistoryK8sWorkloadClient struct {
+	config
+}
+
+// NewBronzeHistoryK8sWorkloadClient returns a client for the BronzeHistoryK8sWorkload from the given config.
+func NewBronzeHistoryK8sWorkloadClient(c config) *BronzeHistoryK8sWorkloadClient {
+	return &BronzeHistoryK8sWorkloadClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistoryk8sworkload.Hooks(f(g(h())))`.
+func (c *BronzeHistoryK8sWorkloadClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryK8sWorkload = append(c.hooks.BronzeHistoryK8sWorkload, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistoryk8sworkload.Intercept(f(g(h())))`.
+func (c *BronzeHistoryK8sWorkloadClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryK8sWorkload = append(c.inters.BronzeHistoryK8sWorkload, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryK8sWorkload entity.
+func (c *BronzeHistoryK8sWorkloadClient) Create() *BronzeHistoryK8sWorkloadCreate {
+	mutation := newBronzeHistoryK8sWorkloadMutation(c.config, OpCreate)
+	return &BronzeHistoryK8sWorkloadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryK8sWorkload entities.
+func (c *BronzeHistoryK8sWorkloadClient) CreateBulk(builders ...*BronzeHistoryK8sWorkloadCreate) *BronzeHistoryK8sWorkloadCreateBulk {
+	return &BronzeHistoryK8sWorkloadCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryK8sWorkloadClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryK8sWorkloadCreate, int)) *BronzeHistoryK8sWorkloadCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryK8sWorkloadCreateBulk{err: fmt.Errorf("calling to BronzeHistoryK8sWorkloadClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryK8sWorkloadCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryK8sWorkloadCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryK8sWorkload.
+func (c *BronzeHistoryK8sWorkloadClient) Update() *BronzeHistoryK8sWorkloadUpdate {
+	mutation := newBronzeHistoryK8sWorkloadMutation(c.config, OpUpdate)
+	return &BronzeHistoryK8sWorkloadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryK8sWorkloadClient) UpdateOne(_m *BronzeHistoryK8sWorkload) *BronzeHistoryK8sWorkloadUpdateOne {
+	mutation := newBronzeHistoryK8sWorkloadMutation(c.config, OpUpdateOne, withBronzeHistoryK8sWorkload(_m))
+	return &BronzeHistoryK8sWorkloadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryK8sWorkloadClient) UpdateOneID(id uint) *BronzeHistoryK8sWorkloadUpdateOne {
+	mutation := newBronzeHistoryK8sWorkloadMutation(c.config, OpUpdateOne, withBronzeHistoryK8sWorkloadID(id))
+	return &BronzeHistoryK8sWorkloadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryK8sWorkload.
+func (c *BronzeHistoryK8sWorkloadClient) Delete() *BronzeHistoryK8sWorkloadDelete {
+	mutation := newBronzeHistoryK8sWorkloadMutation(c.config, OpDelete)
+	return &BronzeHistoryK8sWorkloadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryK8sWorkloadClient) DeleteOne(_m *BronzeHistoryK8sWorkload) *BronzeHistoryK8sWorkloadDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryK8sWorkloadClient) DeleteOneID(id uint) *BronzeHistoryK8sWorkloadDeleteOne {
+	builder := c.Delete().Where(bronzehistoryk8sworkload.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryK8sWorkloadDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryK8sWorkload.
+func (c *BronzeHistoryK8sWorkloadClient) Query() *BronzeHistoryK8sWorkloadQuery {
+	return &BronzeHistoryK8sWorkloadQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryK8sWorkload},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryK8sWorkload entity by its id.
+func (c *BronzeHistoryK8sWorkloadClient) Get(ctx context.Context, id uint) (*BronzeHistoryK8sWorkload, error) {
+	return c.Query().Where(bronzehistoryk8sworkload.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryK8sWorkloadClient) GetX(ctx context.Context, id uint) *BronzeHistoryK8sWorkload {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryK8sWorkloadClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryK8sWorkload
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryK8sWorkloadClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryK8sWorkload
+}
+
+func (c *BronzeHistoryK8sWorkloadClient) mutate(ctx context.Context, m *BronzeHistoryK8sWorkloadMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryK8sWorkloadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryK8sWorkloadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryK8sWorkloadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryK8sWorkloadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("kubernetes: unknown BronzeHistoryK8sWorkload mutation op: %q", m.Op())
+	}
+}
+
+// BronzeK8sImageClient is a client for the BronzeK8sImage schema.
+type BronzeK8sImageClient struct {
+	config
+}
+
+// NewBronzeK8sImageClient returns a client for the BronzeK8sImage from the given config.
+func NewBronzeK8sImageClient(c config) *BronzeK8sImageClient {
+	return &BronzeK8sImageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzek8simage.Hooks(f(g(h())))`.
+func (c *BronzeK8sImageClient) Use(hooks ...Hook) {
+	c.hooks.BronzeK8sImage = append(c.hooks.BronzeK8sImage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzek8simage.Intercept(f(g(h())))`.
+func (c *BronzeK8sImageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeK8sImage = append(c.inters.BronzeK8sImage, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeK8sImage entity.
+func (c *BronzeK8sImageClient) Create() *BronzeK8sImageCreate {
+	mutation := newBronzeK8sImageMutation(c.config, OpCreate)
+	return &BronzeK8sImageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeK8sImage entities.
+func (c *BronzeK8sImageClient) CreateBulk(builders ...*BronzeK8sImageCreate) *BronzeK8sImageCreateBulk {
+	return &BronzeK8sImageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeK8sImageClient) MapCreateBulk(slice any, setFunc func(*BronzeK8sImageCreate, int)) *BronzeK8sImageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeK8sImageCreateBulk{err: fmt.Errorf("calling to BronzeK8sImageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeK8sImageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeK8sImageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeK8sImage.
+func (c *BronzeK8sImageClient) Update() *BronzeK8sImageUpdate {
+	mutation := newBronzeK8sImageMutation(c.config, OpUpdate)
+	return &BronzeK8sImageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeK8sImageClient) UpdateOne(_m *BronzeK8sImage) *BronzeK8sImageUpdateOne {
+	mutation := newBronzeK8sImageMutation(c.config, OpUpdateOne, withBronzeK8sImage(_m))
+	return &BronzeK8sImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeK8sImageClient) UpdateOneID(id string) *BronzeK8sImageUpdateOne {
+	mutation := newBronzeK8sImageMutation(c.config, OpUpdateOne, withBronzeK8sImageID(id))
+	return &BronzeK8sImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeK8sImage.
+func (c *BronzeK8sImageClient) Delete() *BronzeK8sImageDelete {
+	mutation := newBronzeK8sImageMutation(c.config, OpDelete)
+	return &BronzeK8sImageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeK8sImageClient) DeleteOne(_m *BronzeK8sImage) *BronzeK8sImageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeK8sImageClient) DeleteOneID(id string) *BronzeK8sImageDeleteOne {
+	builder := c.Delete().Where(bronzek8simage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeK8sImageDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeK8sImage.
+func (c *BronzeK8sImageClient) Query() *BronzeK8sImageQuery {
+	return &BronzeK8sImageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeK8sImage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeK8sImage entity by its id.
+func (c *BronzeK8sImageClient) Get(ctx context.Context, id string) (*BronzeK8sImage, error) {
+	return c.Query().Where(bronzek8simage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeK8sImageClient) GetX(ctx context.Context, id string) *BronzeK8sImage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeK8sImageClient) Hooks() []Hook {
+	return c.hooks.BronzeK8sImage
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeK8sImageClient) Interceptors() []Interceptor {
+	return c.inters.BronzeK8sImage
+}
+
+func (c *BronzeK8sImageClient) mutate(ctx context.Context, m *BronzeK8sImageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeK8sImageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeK8sImageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeK8sImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeK8sImageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("kubernetes: unknown BronzeK8sImage mutation op: %q", m.Op())
+	}
+}
+
+// BronzeK8sNamespaceClient is a client for the BronzeK8sNamespace schema.
+type BronzeK8sNamespaceClient struct {
+	config
+}
+
+// NewBronzeK8sNamespaceClient returns a client for the BronzeK8sNamespace from the given config.
+func NewBronzeK8sNamespaceClient(c config) *BronzeK8sNamespaceClient {
+	return &BronzeK8sNamespaceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzek8snamespace.Hooks(f(g(h())))`.
+func (c *BronzeK8sNamespaceClient) Use(hooks ...Hook) {
+	c.hooks.BronzeK8sNamespace = append(c.hooks.BronzeK8sNamespace, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzek8snamespace.Intercept(f(g(h())))`.
+func (c *BronzeK8sNamespaceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeK8sNamespace = append(c.inters.BronzeK8sNamespace, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeK8sNamespace entity.
+func (c *BronzeK8sNamespaceClient) Create() *BronzeK8sNamespaceCreate {
+	mutation := newBronzeK8sNamespaceMutation(c.config, OpCreate)
+	return &BronzeK8sNamespaceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeK8sNamespace entities.
+func (c *BronzeK8sNamespaceClient) CreateBulk(builders ...*BronzeK8sNamespaceCreate) *BronzeK8sNamespaceCreateBulk {
+	return &BronzeK8sNamespaceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeK8sNamespaceClient) MapCreateBulk(slice any, setFunc func(*BronzeK8sNamespaceCreate, int)) *BronzeK8sNamespaceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeK8sNamespaceCreateBulk{err: fmt.Errorf("calling to BronzeK8sNamespaceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeK8sNamespaceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeK8sNamespaceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeK8sNamespace.
+func (c *BronzeK8sNamespaceClient) Update() *BronzeK8sNamespaceUpdate {
+	mutation := newBronzeK8sNamespaceMutation(c.config, OpUpdate)
+	return &BronzeK8sNamespaceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeK8sNamespaceClient) UpdateOne(_m *BronzeK8sNamespace) *BronzeK8sNamespaceUpdateOne {
+	mutation := newBronzeK8sNamespaceMutation(c.config, OpUpdateOne, withBronzeK8sNamespace(_m))
+	return &BronzeK8sNamespaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeK8sNamespaceClient) UpdateOneID(id string) *BronzeK8sNamespaceUpdateOne {
+	mutation := newBronzeK8sNamespaceMutation(c.config, OpUpdateOne, withBronzeK8sNamespaceID(id))
+	return &BronzeK8sNamespaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeK8sNamespace.
+func (c *BronzeK8sNamespaceClient) Delete() *BronzeK8sNamespaceDelete {
+	mutation := newBronzeK8sNamespaceMutation(c.config, OpDelete)
+	return &BronzeK8sNamespaceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeK8sNamespaceClient) DeleteOne(_m *BronzeK8sNamespace) *BronzeK8sNamespaceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeK8sNamespaceClient) DeleteOneID(id string) *BronzeK8sNamespaceDeleteOne {
+	builder := c.Delete().Where(bronzek8snamespace.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeK8sNamespaceDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeK8sNamespace.
+func (c *BronzeK8sNamespaceClient) Query() *BronzeK8sNamespaceQuery {
+	return &BronzeK8sNamespaceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeK8sNamespace},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeK8sNamespace entity by its id.
+func (c *BronzeK8sNamespaceClient) Get(ctx context.Context, id string) (*BronzeK8sNamespace, error) {
+	return c.Query().Where(bronzek8snamespace.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeK8sNamespaceClient) GetX(ctx context.Context, id string) *BronzeK8sNamespace {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeK8sNamespaceClient) Hooks() []Hook {
+	return c.hooks.BronzeK8sNamespace
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeK8sNamespaceClient) Interceptors() []Interceptor {
+	return c.inters.BronzeK8sNamespace
+}
+
+func (c *BronzeK8sNamespaceClient) mutate(ctx context.Context, m *BronzeK8sNamespaceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeK8sNamespaceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeK8sNamespaceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeK8sNamespaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeK8sNamespaceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("kubernetes: unknown BronzeK8sNamespace mutation op: %q", m.Op())
+	}
+}
+
+// BronzeK8sNetworkPolicyClient is a client for the BronzeK8sNetworkPolicy schema.
+type BronzeK8sNetworkPolicyClient struct {
+	config
+}
+
+// NewBronzeK8sNetworkPolicyClient returns a client for the BronzeK8sNetworkPolicy from the given config.
+func NewBronzeK8sNetworkPolicyClient(c config) *BronzeK8sNetworkPolicyClient {
+	return &BronzeK8sNetworkPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzek8snetworkpolicy.Hooks(f(g(h())))`.
+func (c *BronzeK8sNetworkPolicyClient) Use(hooks ...Hook) {
+	c.hooks.BronzeK8sNetworkPolicy = append(c.hooks.BronzeK8sNetworkPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzek8snetworkpolicy.Intercept(f(g(h())))`.
+func (c *BronzeK8sNetworkPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeK8sNetworkPolicy = append(c.inters.BronzeK8sNetworkPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeK8sNetworkPolicy entity.
+func (c *BronzeK8sNetworkPolicyClient) Create() *BronzeK8sNetworkPolicyCreate {
+	mutation := newBronzeK8sNetworkPolicyMutation(c.config, OpCreate)
+	return &BronzeK8sNetworkPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeK8sNetworkPolicy entities.
+func (c *BronzeK8sNetworkPolicyClient) CreateBulk(builders ...*BronzeK8sNetworkPolicyCreate) *BronzeK8sNetworkPolicyCreateBulk {
+	return &BronzeK8sNetworkPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeK8sNetworkPolicyClient) MapCreateBulk(slice any, setFunc func(*BronzeK8sNetworkPolicyCreate, int)) *BronzeK8sNetworkPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeK8sNetworkPolicyCreateBulk{err: fmt.Errorf("calling to BronzeK8sNetworkPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeK8sNetworkPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeK8sNetworkPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeK8sNetworkPolicy.
+func (c *BronzeK8sNetworkPolicyClient) Update() *BronzeK8sNetworkPolicyUpdate {
+	mutation := newBronzeK8sNetworkPolicyMutation(c.config, OpUpdate)
+	return &BronzeK8sNetworkPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeK8sNetworkPolicyClient) UpdateOne(_m *BronzeK8sNetworkPolicy) *BronzeK8sNetworkPolicyUpdateOne {
+	mutation := newBronzeK8sNetworkPolicyMutation(c.config, OpUpdateOne, withBronzeK8sNetworkPolicy(_m))
+	return &BronzeK8sNetworkPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeK8sNetworkPolicyClient) UpdateOneID(id string) *BronzeK8sNetworkPolicyUpdateOne {
+	mutation := newBronzeK8sNetworkPolicyMutation(c.config, OpUpdateOne, withBronzeK8sNetworkPolicyID(id))
+	return &BronzeK8sNetworkPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeK8sNetworkPolicy.
+func (c *BronzeK8sNetworkPolicyClient) Delete() *BronzeK8sNetworkPolicyDelete {
+	mutation := newBronzeK8sNetworkPolicyMutation(c.config, OpDelete)
+	return &BronzeK8sNetworkPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeK8sNetworkPolicyClient) DeleteOne(_m *BronzeK8sNetworkPolicy) *BronzeK8sNetworkPolicyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeK8sNetworkPolicyClient) DeleteOneID(id string) *BronzeK8sNetworkPolicyDeleteOne {
+	builder := c.Delete().Where(bronzek8snetworkpolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeK8sNetworkPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeK8sNetworkPolicy.
+func (c *BronzeK8sNetworkPolicyClient) Query() *BronzeK8sNetworkPolicyQuery {
+	return &BronzeK8sNetworkPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeK8sNetworkPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeK8sNetworkPolicy entity by its id.
+func (c *BronzeK8sNetworkPolicyClient) Get(ctx context.Context, id string) (*BronzeK8sNetworkPolicy, error) {
+	return c.Query().Where(bronzek8snetworkpolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeK8sNetworkPolicyClient) GetX(ctx context.Context, id string) *BronzeK8sNetworkPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeK8sNetworkPolicyClient) Hooks() []Hook {
+	return c.hooks.BronzeK8sNetworkPolicy
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeK8sNetworkPolicyClient) Interceptors() []Interceptor {
+	return c.inters.BronzeK8sNetworkPolicy
+}
+
+func (c *BronzeK8sNetworkPolicyClient) mutate(ctx context.Context, m *BronzeK8sNetworkPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeK8sNetworkPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeK8sNetworkPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeK8sNetworkPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeK8sNetworkPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("kubernetes: unknown BronzeK8sNetworkPolicy mutation op: %q", m.Op())
+	}
+}
+
+// BronzeK8sNodeClient is a client for the BronzeK8sNode schema.
+type BronzeK8sNodeClient struct {
+	config
+}
+
+// NewBronzeK8sNodeClient returns a client for the BronzeK8sNode from the given config.
+func NewBronzeK8sNodeClient(c config) *BronzeK8sNodeClient {
+	return &BronzeK8sNodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzek8snode.Hooks(f(g(h())))`.
+func (c *BronzeK8sNodeClient) Use(hooks ...Hook) {
+	c.hooks.BronzeK8sNode = append(c.hooks.BronzeK8sNode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzek8snode.Intercept(f(g(h())))`.
+func (c *BronzeK8sNodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeK8sNode = append(c.inters.BronzeK8sNode, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeK8sNode entity.
+func (c *BronzeK8sNodeClient) Create() *BronzeK8sNodeCreate {
+	mutation := newBronzeK8sNodeMutation(c.config, OpCreate)
+	return &BronzeK8sNodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeK8sNode entities.
+func (c *BronzeK8sNodeClient) CreateBulk(builders ...*BronzeK8sNodeCreate) *BronzeK8sNodeCreateBulk {
+	return &BronzeK8sNodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeK8sNodeClient) MapCreateBulk(slice any, setFunc func(*BronzeK8sNodeCreate, int)) *BronzeK8sNodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeK8sNodeCreateBulk{err: fmt.Errorf("calling to BronzeK8sNodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeK8sNodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeK8sNodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeK8sNode.
+func (c *BronzeK8sNodeClient) Update() *BronzeK8sNodeUpdate {
+	mutation := newBronzeK8sNodeMutation(c.config, OpUpdate)
+	return &BronzeK8sNodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeK8sNodeClient) UpdateOne(_m *BronzeK8sNode) *BronzeK8sNodeUpdateOne {
+	mutation := newBronzeK8sNodeMutation(c.config, OpUpdateOne, withBronzeK8sNode(_m))
+	return &BronzeK8sNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeK8sNodeClient) UpdateOneID(id string) *BronzeK8sNodeUpdateOne {
+	mutation := newBronzeK8sNodeMutation(c.config, OpUpdateOne, withBronzeK8sNodeID(id))
+	return &BronzeK8sNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeK8sNode.
+func (c *BronzeK8sNodeClient) Delete() *BronzeK8sNodeDelete {
+	mutation := newBronzeK8sNodeMutation(c.config, OpDelete)
+	return &BronzeK8sNodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeK8sNodeClient) DeleteOne(_m *BronzeK8sNode) *BronzeK8sNodeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeK8sNodeClient) DeleteOneID(id string) *BronzeK8sNodeDeleteOne {
+	builder := c.Delete().Where(bronzek8snode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeK8sNodeDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeK8sNode.
+func (c *BronzeK8sNodeClient) Query() *BronzeK8sNodeQuery {
+	return &BronzeK8sNodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeK8sNode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeK8sNode entity by its id.
+func (c *BronzeK8sNodeClient) Get(ctx context.Context, id string) (*BronzeK8sNode, error) {
+	return c.Query().Where(bronzek8snode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeK8sNodeClient) GetX(ctx context.Context, id string) *BronzeK8sNode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeK8sNodeClient) Hooks() []Hook {
+	return c.hooks.BronzeK8sNode
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeK8sNodeClient) Interceptors() []Interceptor {
+	return c.inters.BronzeK8sNode
+}
+
+func (c *BronzeK8sNodeClient) mutate(ctx context.Context, m *BronzeK8sNodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeK8sNodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeK8sNodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeK8sNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeK8sNodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("kubernetes: unknown BronzeK8sNode mutation op: %q", m.Op())
+	}
+}
+
+// BronzeK8sPodClient is a client for the BronzeK8sPod schema.
+type BronzeK8sPodClient struct {
+	config
+}
+
+// NewBronzeK8sPodClient returns a client for the BronzeK8sPod from the given config.
+func NewBronzeK8sPodClient(c config) *BronzeK8sPodClient {
+	return &BronzeK8sPodClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzek8spod.Hooks(f(g(h())))`.
+func (c *BronzeK8sPodClient) Use(hooks ...Hook) {
+	c.hooks.BronzeK8sPod = append(c.hooks.BronzeK8sPod, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzek8spod.Intercept(f(g(h())))`.
+func (c *BronzeK8sPodClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeK8sPod = append(c.inters.BronzeK8sPod, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeK8sPod entity.
+func (c *BronzeK8sPodClient) Create() *BronzeK8sPodCreate {
+	mutation := newBronzeK8sPodMutation(c.config, OpCreate)
+	return &BronzeK8sPodCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeK8sPod entities.
+func (c *BronzeK8sPodClient) CreateBulk(builders ...*BronzeK8sPodCreate) *BronzeK8sPodCreateBulk {
+	return &BronzeK8sPodCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeK8sPodClient) MapCreateBulk(slice any, setFunc func(*BronzeK8sPodCreate, int)) *BronzeK8sPodCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeK8sPodCreateBulk{err: fmt.Errorf("calling to BronzeK8sPodClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeK8sPodCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeK8sPodCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeK8sPod.
+func (c *BronzeK8sPodClient) Update() *BronzeK8sPodUpdate {
+	mutation := newBronzeK8sPodMutation(c.config, OpUpdate)
+	return &BronzeK8sPodUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeK8sPodClient) UpdateOne(_m *BronzeK8sPod) *BronzeK8sPodUpdateOne {
+	mutation := newBronzeK8sPodMutation(c.config, OpUpdateOne, withBronzeK8sPod(_m))
+	return &BronzeK8sPodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeK8sPodClient) UpdateOneID(id string) *BronzeK8sPodUpdateOne {
+	mutation := newBronzeK8sPodMutation(c.config, OpUpdateOne, withBronzeK8sPodID(id))
+	return &BronzeK8sPodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeK8sPod.
+func (c *BronzeK8sPodClient) Delete() *BronzeK8sPodDelete {
+	mutation := newBronzeK8sPodMutation(c.config, OpDelete)
+	return &BronzeK8sPodDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeK8sPodClient) DeleteOne(_m *BronzeK8sPod) *BronzeK8sPodDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeK8sPodClient) DeleteOneID(id string) *BronzeK8sPodDeleteOne {
+	builder := c.Delete().Where(bronzek8spod.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeK8sPodDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeK8sPod.
+func (c *BronzeK8sPodClient) Query() *BronzeK8sPodQuery {
+	return &BronzeK8sPodQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeK8sPod},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeK8sPod entity by its id.
+func (c *BronzeK8sPodClient) Get(ctx context.Context, id string) (*BronzeK8sPod, error) {
+	return c.Query().Where(bronzek8spod.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeK8sPodClient) GetX(ctx context.Context, id string) *BronzeK8sPod {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeK8sPodClient) Hooks() []Hook {
+	return c.hooks.BronzeK8sPod
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeK8sPodClient) Interceptors() []Interceptor {
+	return c.inters.BronzeK8sPod
+}
+
+func (c *BronzeK8sPodClient) mutate(ctx context.Context, m *BronzeK8sPodMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeK8sPodCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeK8sPodUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeK8sPodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeK8sPodDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("kubernetes: unknown BronzeK8sPod mutation op: %q", m.Op())
+	}
+}
+
+// BronzeK8sRoleBindingClient is a client for the BronzeK8sRoleBinding schema.
+type BronzeK8sRoleBindingClient struct {
+	config
+}
+
+// NewBronzeK8sRoleBindingClient returns a client for the BronzeK8sRoleBinding from the given config.
+func NewBronzeK8sRoleBindingClient(c config) *BronzeK8sRoleBindingClient {
+	return &BronzeK8sRoleBindingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzek8srolebinding.Hooks(f(g(h())))`.
+func (c *BronzeK8sRoleBindingClient) Use(hooks ...Hook) {
+	c.hooks.BronzeK8sRoleBinding = append(c.hooks.BronzeK8sRoleBinding, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzek8srolebinding.Intercept(f(g(h())))`.
+func (c *BronzeK8sRoleBindingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeK8sRoleBinding = append(c.inters.BronzeK8sRoleBinding, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeK8sRoleBinding entity.
+func (c *BronzeK8sRoleBindingClient) Create() *BronzeK8sRoleBindingCreate {
+	mutation := newBronzeK8sRoleBindingMutation(c.config, OpCreate)
+	return &BronzeK8sRoleBindingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeK8sRoleBinding entities.
+func (c *BronzeK8sRoleBindingClient) CreateBulk(builders ...*BronzeK8sRoleBindingCreate) *BronzeK8sRoleBindingCreateBulk {
+	return &BronzeK8sRoleBindingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeK8sRoleBindingClient) MapCreateBulk(slice any, setFunc func(*BronzeK8sRoleBindingCreate, int)) *BronzeK8sRoleBindingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeK8sRoleBindingCreateBulk{err: fmt.Errorf("calling to BronzeK8sRoleBindingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeK8sRoleBindingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeK8sRoleBindingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeK8sRoleBinding.
+func (c *BronzeK8sRoleBindingClient) Update() *BronzeK8sRoleBindingUpdate {
+	mutation := newBronzeK8sRoleBindingMutation(c.config, OpUpdate)
+	return &BronzeK8sRoleBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeK8sRoleBindingClient) UpdateOne(_m *BronzeK8sRoleBinding) *BronzeK8sRoleBindingUpdateOne {
+	mutation := newBronzeK8sRoleBindingMutation(c.config, OpUpdateOne, withBronzeK8sRoleBinding(_m))
+	return &BronzeK8sRoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeK8sRoleBindingClient) UpdateOneID(id string) *BronzeK8sRoleBindingUpdateOne {
+	mutation := newBronzeK8sRoleBindingMutation(c.config, OpUpdateOne, withBronzeK8sRoleBindingID(id))
+	return &BronzeK8sRoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeK8sRoleBinding.
+func (c *BronzeK8sRoleBindingClient) Delete() *BronzeK8sRoleBindingDelete {
+	mutation := newBronzeK8sRoleBindingMutation(c.config, OpDelete)
+	return &BronzeK8sRoleBindingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeK8sRoleBindingClient) DeleteOne(_m *BronzeK8sRoleBinding) *BronzeK8sRoleBindingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeK8sRoleBindingClient) DeleteOneID(id string) *BronzeK8sRoleBindingDeleteOne {
+	builder := c.Delete().Where(bronzek8srolebinding.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeK8sRoleBindingDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeK8sRoleBinding.
+func (c *BronzeK8sRoleBindingClient) Query() *BronzeK8sRoleBindingQuery {
+	return &BronzeK8sRoleBindingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeK8sRoleBinding},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeK8sRoleBinding entity by its id.
+func (c *BronzeK8sRoleBindingClient) Get(ctx context.Context, id string) (*BronzeK8sRoleBinding, error) {
+	return c.Query().Where(bronzek8srolebinding.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeK8sRoleBindingClient) GetX(ctx context.Context, id string) *BronzeK8sRoleBinding {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeK8sRoleBindingClient) Hooks() []Hook {
+	return c.hooks.BronzeK8sRoleBinding
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeK8sRoleBindingClient) Interceptors() []Interceptor {
+	return c.inters.BronzeK8sRoleBinding
+}
+
+func (c *BronzeK8sRoleBindingClient) mutate(ctx context.Context, m *BronzeK8sRoleBindingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeK8sRoleBindingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeK8sRoleBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeK8sRoleBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeK8sRoleBindingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("kubernetes: unknown BronzeK8sRoleBinding mutation op: %q", m.Op())
+	}
+}
+
+// BronzeK8sServiceAccountClient is a client for the BronzeK8sServiceAccount schema.
+type BronzeK8sServiceAccountClient struct {
+	config
+}
+
+// NewBronzeK8sServiceAccountClient returns a client for the BronzeK8sServiceAccount from the given config.
+func NewBronzeK8sServiceAccountClient(c config) *BronzeK8sServiceAccountClient {
+	return &BronzeK8sServiceAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzek8sserviceaccount.Hooks(f(g(h())))`.
+func (c *BronzeK8sServiceAccountClient) Use(hooks ...Hook) {
+	c.hooks.BronzeK8sServiceAccount = append(c.hooks.BronzeK8sServiceAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzek8sserviceaccount.Intercept(f(g(h())))`.
+func (c *BronzeK8sServiceAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeK8sServiceAccount = append(c.inters.BronzeK8sServiceAccount, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeK8sServiceAccount entity.
+func (c *BronzeK8sServiceAccountClient) Create() *BronzeK8sServiceAccountCreate {
+	mutation := newBronzeK8sServiceAccountMutation(c.config, OpCreate)
+	return &BronzeK8sServiceAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeK8sServiceAccount entities.
+func (c *BronzeK8sServiceAccountClient) CreateBulk(builders ...*BronzeK8sServiceAccountCreate) *BronzeK8sServiceAccountCreateBulk {
+	return &BronzeK8sServiceAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeK8sServiceAccountClient) MapCreateBulk(slice any, setFunc func(*BronzeK8sServiceAccountCreate, int)) *BronzeK8sServiceAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeK8sServiceAccountCreateBulk{err: fmt.Errorf("calling to BronzeK8sServiceAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeK8sServiceAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeK8sServiceAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeK8sServiceAccount.
+func (c *BronzeK8sServiceAccountClient) Update() *BronzeK8sServiceAccountUpdate {
+	mutation := newBronzeK8sServiceAccountMutation(c.config, OpUpdate)
+	return &BronzeK8sServiceAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeK8sServiceAccountClient) UpdateOne(_m *BronzeK8sServiceAccount) *BronzeK8sServiceAccountUpdateOne {
+	mutation := newBronzeK8sServiceAccountMutation(c.config, OpUpdateOne, withBronzeK8sServiceAccount(_m))
+	return &BronzeK8sServiceAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeK8sServiceAccountClient) UpdateOneID(id string) *BronzeK8sServiceAccountUpdateOne {
+	mutation := newBronzeK8sServiceAccountMutation(c.config, OpUpdateOne, withBronzeK8sServiceAccountID(id))
+	return &BronzeK8sServiceAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeK8sServiceAccount.
+func (c *BronzeK8sServiceAccountClient) Delete() *BronzeK8sServiceAccountDelete {
+	mutation := newBronzeK8sServiceAccountMutation(c.config, OpDelete)
+	return &BronzeK8sServiceAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeK8sServiceAccountClient) DeleteOne(_m *BronzeK8sServiceAccount) *BronzeK8sServiceAccountDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeK8sServiceAccountClient) DeleteOneID(id string) *BronzeK8sServiceAccountDeleteOne {
+	builder := c.Delete().Where(bronzek8sserviceaccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeK8sServiceAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeK8sServiceAccount.
+func (c *BronzeK8sServiceAccountClient) Query() *BronzeK8sServiceAccountQuery {
+	return &BronzeK8sServiceAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeK8sServiceAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeK8sServiceAccount entity by its id.
+func (c *BronzeK8sServiceAccountClient) Get(ctx context.Context, id string) (*BronzeK8sServiceAccount, error) {
+	return c.Query().Where(bronzek8sserviceaccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeK8sServiceAccountClient) GetX(ctx context.Context, id string) *BronzeK8sServiceAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeK8sServiceAccountClient) Hooks() []Hook {
+	return c.hooks.BronzeK8sServiceAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeK8sServiceAccountClient) Interceptors() []Interceptor {
+	return c.inters.BronzeK8sServiceAccount
+}
+
+func (c *BronzeK8sServiceAccountClient) mutate(ctx context.Context, m *BronzeK8sServiceAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeK8sServiceAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeK8sServiceAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeK8sServiceAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeK8sServiceAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("kubernetes: unknown BronzeK8sServiceAccount mutation op: %q", m.Op())
+	}
+}
+
+// BronzeK8sWorkloadClient is a client for the BronzeK8sWorkload schema.
+type BronzeK8sWorkloadClient struct {
+	config
+}
+
+// NewBronzeK8sWorkloadClient returns a client for the BronzeK8sWorkload from the given config.
+func NewBronzeK8sWorkloadClient(c config) *BronzeK8sWorkloadClient {
+	return &BronzeK8sWorkloadClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzek8sworkload.Hooks(f(g(h())))`.
+func (c *BronzeK8sWorkloadClient) Use(hooks ...Hook) {
+	c.hooks.BronzeK8sWorkload = append(c.hooks.BronzeK8sWorkload, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzek8sworkload.Intercept(f(g(h())))`.
+func (c *BronzeK8sWorkloadClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeK8sWorkload = append(c.inters.BronzeK8sWorkload, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeK8sWorkload entity.
+func (c *BronzeK8sWorkloadClient) Create() *BronzeK8sWorkloadCreate {
+	mutation := newBronzeK8sWorkloadMutation(c.config, OpCreate)
+	return &BronzeK8sWorkloadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeK8sWorkload entities.
+func (c *BronzeK8sWorkloadClient) CreateBulk(builders ...*BronzeK8sWorkloadCreate) *BronzeK8sWorkloadCreateBulk {
+	return &BronzeK8sWorkloadCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeK8sWorkloadClient) MapCreateBulk(slice any, setFunc func(*BronzeK8sWorkloadCreate, int)) *BronzeK8sWorkloadCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeK8sWorkloadCreateBulk{err: fmt.Errorf("calling to BronzeK8sWorkloadClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeK8sWorkloadCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeK8sWorkloadCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeK8sWorkload.
+func (c *BronzeK8sWorkloadClient) Update() *BronzeK8sWorkloadUpdate {
+	mutation := newBronzeK8sWorkloadMutation(c.config, OpUpdate)
+	return &BronzeK8sWorkloadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeK8sWorkloadClient) UpdateOne(_m *BronzeK8sWorkload) *BronzeK8sWorkloadUpdateOne {
+	mutation := newBronzeK8sWorkloadMutation(c.config, OpUpdateOne, withBronzeK8sWorkload(_m))
+	return &BronzeK8sWorkloadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeK8sWorkloadClient) UpdateOneID(id string) *BronzeK8sWorkloadUpdateOne {
+	mutation := newBronzeK8sWorkloadMutation(c.config, OpUpdateOne, withBronzeK8sWorkloadID(id))
+	return &BronzeK8sWorkloadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeK8sWorkload.
+func (c *BronzeK8sWorkloadClient) Delete() *BronzeK8sWorkloadDelete {
+	mutation := newBronzeK8sWorkloadMutation(c.config, OpDelete)
+	return &BronzeK8sWorkloadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeK8sWorkloadClient) DeleteOne(_m *BronzeK8sWorkload) *BronzeK8sWorkloadDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeK8sWorkloadClient) DeleteOneID(id string) *BronzeK8sWorkloadDeleteOne {
+	builder := c.Delete().Where(bronzek8sworkload.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeK8sWorkloadDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeK8sWorkload.
+func (c *BronzeK8sWorkloadClient) Query() *BronzeK8sWorkloadQuery {
+	return &BronzeK8sWorkloadQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeK8sWorkload},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeK8sWorkload entity by its id.
+func (c *BronzeK8sWorkloadClient) Get(ctx context.Context, id string) (*BronzeK8sWorkload, error) {
+	return c.Query().Where(bronzek8sworkload.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeK8sWorkloadClient) GetX(ctx context.Context, id string) *BronzeK8sWorkload {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeK8sWorkloadClient) Hooks() []Hook {
+	return c.hooks.BronzeK8sWorkload
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeK8sWorkloadClient) Interceptors() []Interceptor {
+	return c.inters.BronzeK8sWorkload
+}
+
+func (c *BronzeK8sWorkloadClient) mutate(ctx context.Context, m *BronzeK8sWorkloadMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeK8sWorkloadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeK8sWorkloadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeK8sWorkloadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeK8sWorkloadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("kubernetes: unknown BronzeK8sWorkload mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		BronzeHistoryK8sImage, BronzeHistoryK8sNamespace, BronzeHistoryK8sNetworkPolicy,
+		BronzeHistoryK8sNode, BronzeHistoryK8sPod, BronzeHistoryK8sRoleBinding,
+		BronzeHistoryK8sServiceAccount, BronzeHistoryK8sWorkload, BronzeK8sImage,
+		BronzeK8sNamespace, BronzeK8sNetworkPolicy, BronzeK8sNode, BronzeK8sPod,
+		BronzeK8sRoleBinding, BronzeK8sServiceAccount, BronzeK8sWorkload []ent.Hook
+	}
+	inters struct {
+		BronzeHistoryK8sImage, BronzeHistoryK8sNamespace, BronzeHistoryK8sNetworkPolicy,
+		BronzeHistoryK8sNode, BronzeHistoryK8sPod, BronzeHistoryK8sRoleBinding,
+		BronzeHistoryK8sServiceAccount, BronzeHistoryK8sWorkload, BronzeK8sImage,
+		BronzeK8sNamespace, BronzeK8sNetworkPolicy, BronzeK8sNode, BronzeK8sPod,
+		BronzeK8sRoleBinding, BronzeK8sServiceAccount,
+		BronzeK8sWorkload []ent.Interceptor
+	}
+)
+
+// SchemaConfig represents alternative schema names for all tables
+// that can be passed at runtime.
+type SchemaConfig = internal.SchemaConfig
+
+// AlternateSchemas allows alternate schema names to be
+// passed into ent operations.
+func AlternateSchema(schemaConfig SchemaConfig) Option {
+	return func(c *config) {
+		c.schemaConfig = schemaConfig
+	}
+}
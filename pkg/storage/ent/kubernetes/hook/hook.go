@@ -0,0 +1,379 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hook
+
+import (
+	"context"
+	"fmt"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes"
+)
+
+// The BronzeHistoryK8sImageFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryK8sImage mutator.
+type BronzeHistoryK8sImageFunc func(context.Context, *kubernetes.BronzeHistoryK8sImageMutation) (kubernetes.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryK8sImageFunc) Mutate(ctx context.Context, m kubernetes.Mutation) (kubernetes.Value, error) {
+	if mv, ok := m.(*kubernetes.BronzeHistoryK8sImageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *kubernetes.BronzeHistoryK8sImageMutation", m)
+}
+
+// The BronzeHistoryK8sNamespaceFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryK8sNamespace mutator.
+type BronzeHistoryK8sNamespaceFunc func(context.Context, *kubernetes.BronzeHistoryK8sNamespaceMutation) (kubernetes.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryK8sNamespaceFunc) Mutate(ctx context.Context, m kubernetes.Mutation) (kubernetes.Value, error) {
+	if mv, ok := m.(*kubernetes.BronzeHistoryK8sNamespaceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *kubernetes.BronzeHistoryK8sNamespaceMutation", m)
+}
+
+// The BronzeHistoryK8sNetworkPolicyFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryK8sNetworkPolicy mutator.
+type BronzeHistoryK8sNetworkPolicyFunc func(context.Context, *kubernetes.BronzeHistoryK8sNetworkPolicyMutation) (kubernetes.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryK8sNetworkPolicyFunc) Mutate(ctx context.Context, m kubernetes.Mutation) (kubernetes.Value, error) {
+	if mv, ok := m.(*kubernetes.BronzeHistoryK8sNetworkPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *kubernetes.BronzeHistoryK8sNetworkPolicyMutation", m)
+}
+
+// The BronzeHistoryK8sNodeFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryK8sNode mutator.
+type BronzeHistoryK8sNodeFunc func(context.Context, *kubernetes.BronzeHistoryK8sNodeMutation) (kubernetes.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryK8sNodeFunc) Mutate(ctx context.Context, m kubernetes.Mutation) (kubernetes.Value, error) {
+	if mv, ok := m.(*kubernetes.BronzeHistoryK8sNodeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *kubernetes.BronzeHistoryK8sNodeMutation", m)
+}
+
+// The BronzeHistoryK8sPodFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryK8sPod mutator.
+type BronzeHistoryK8sPodFunc func(context.Context, *kubernetes.BronzeHistoryK8sPodMutation) (kubernetes.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryK8sPodFunc) Mutate(ctx context.Context, m kubernetes.Mutation) (kubernetes.Value, error) {
+	if mv, ok := m.(*kubernetes.BronzeHistoryK8sPodMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *kubernetes.BronzeHistoryK8sPodMutation", m)
+}
+
+// The BronzeHistoryK8sRoleBindingFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryK8sRoleBinding mutator.
+type BronzeHistoryK8sRoleBindingFunc func(context.Context, *kubernetes.BronzeHistoryK8sRoleBindingMutation) (kubernetes.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryK8sRoleBindingFunc) Mutate(ctx context.Context, m kubernetes.Mutation) (kubernetes.Value, error) {
+	if mv, ok := m.(*kubernetes.BronzeHistoryK8sRoleBindingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *kubernetes.BronzeHistoryK8sRoleBindingMutation", m)
+}
+
+// The BronzeHistoryK8sServiceAccountFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryK8sServiceAccount mutator.
+type BronzeHistoryK8sServiceAccountFunc func(context.Context, *kubernetes.BronzeHistoryK8sServiceAccountMutation) (kubernetes.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryK8sServiceAccountFunc) Mutate(ctx context.Context, m kubernetes.Mutation) (kubernetes.Value, error) {
+	if mv, ok := m.(*kubernetes.BronzeHistoryK8sServiceAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *kubernetes.BronzeHistoryK8sServiceAccountMutation", m)
+}
+
+// The BronzeHistoryK8sWorkloadFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryK8sWorkload mutator.
+type BronzeHistoryK8sWorkloadFunc func(context.Context, *kubernetes.BronzeHistoryK8sWorkloadMutation) (kubernetes.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryK8sWorkloadFunc) Mutate(ctx context.Context, m kubernetes.Mutation) (kubernetes.Value, error) {
+	if mv, ok := m.(*kubernetes.BronzeHistoryK8sWorkloadMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *kubernetes.BronzeHistoryK8sWorkloadMutation", m)
+}
+
+// The BronzeK8sImageFunc type is an adapter to allow the use of ordinary
+// function as BronzeK8sImage mutator.
+type BronzeK8sImageFunc func(context.Context, *kubernetes.BronzeK8sImageMutation) (kubernetes.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeK8sImageFunc) Mutate(ctx context.Context, m kubernetes.Mutation) (kubernetes.Value, error) {
+	if mv, ok := m.(*kubernetes.BronzeK8sImageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *kubernetes.BronzeK8sImageMutation", m)
+}
+
+// The BronzeK8sNamespaceFunc type is an adapter to allow the use of ordinary
+// function as BronzeK8sNamespace mutator.
+type BronzeK8sNamespaceFunc func(context.Context, *kubernetes.BronzeK8sNamespaceMutation) (kubernetes.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeK8sNamespaceFunc) Mutate(ctx context.Context, m kubernetes.Mutation) (kubernetes.Value, error) {
+	if mv, ok := m.(*kubernetes.BronzeK8sNamespaceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *kubernetes.BronzeK8sNamespaceMutation", m)
+}
+
+// The BronzeK8sNetworkPolicyFunc type is an adapter to allow the use of ordinary
+// function as BronzeK8sNetworkPolicy mutator.
+type BronzeK8sNetworkPolicyFunc func(context.Context, *kubernetes.BronzeK8sNetworkPolicyMutation) (kubernetes.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeK8sNetworkPolicyFunc) Mutate(ctx context.Context, m kubernetes.Mutation) (kubernetes.Value, error) {
+	if mv, ok := m.(*kubernetes.BronzeK8sNetworkPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *kubernetes.BronzeK8sNetworkPolicyMutation", m)
+}
+
+// The BronzeK8sNodeFunc type is an adapter to allow the use of ordinary
+// function as BronzeK8sNode mutator.
+type BronzeK8sNodeFunc func(context.Context, *kubernetes.BronzeK8sNodeMutation) (kubernetes.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeK8sNodeFunc) Mutate(ctx context.Context, m kubernetes.Mutation) (kubernetes.Value, error) {
+	if mv, ok := m.(*kubernetes.BronzeK8sNodeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *kubernetes.BronzeK8sNodeMutation", m)
+}
+
+// The BronzeK8sPodFunc type is an adapter to allow the use of ordinary
+// function as BronzeK8sPod mutator.
+type BronzeK8sPodFunc func(context.Context, *kubernetes.BronzeK8sPodMutation) (kubernetes.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeK8sPodFunc) Mutate(ctx context.Context, m kubernetes.Mutation) (kubernetes.Value, error) {
+	if mv, ok := m.(*kubernetes.BronzeK8sPodMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *kubernetes.BronzeK8sPodMutation", m)
+}
+
+// The BronzeK8sRoleBindingFunc type is an adapter to allow the use of ordinary
+// function as BronzeK8sRoleBinding mutator.
+type BronzeK8sRoleBindingFunc func(context.Context, *kubernetes.BronzeK8sRoleBindingMutation) (kubernetes.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeK8sRoleBindingFunc) Mutate(ctx context.Context, m kubernetes.Mutation) (kubernetes.Value, error) {
+	if mv, ok := m.(*kubernetes.BronzeK8sRoleBindingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *kubernetes.BronzeK8sRoleBindingMutation", m)
+}
+
+// The BronzeK8sServiceAccountFunc type is an adapter to allow the use of ordinary
+// function as BronzeK8sServiceAccount mutator.
+type BronzeK8sServiceAccountFunc func(context.Context, *kubernetes.BronzeK8sServiceAccountMutation) (kubernetes.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeK8sServiceAccountFunc) Mutate(ctx context.Context, m kubernetes.Mutation) (kubernetes.Value, error) {
+	if mv, ok := m.(*kubernetes.BronzeK8sServiceAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *kubernetes.BronzeK8sServiceAccountMutation", m)
+}
+
+// The BronzeK8sWorkloadFunc type is an adapter to allow the use of ordinary
+// function as BronzeK8sWorkload mutator.
+type BronzeK8sWorkloadFunc func(context.Context, *kubernetes.BronzeK8sWorkloadMutation) (kubernetes.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeK8sWorkloadFunc) Mutate(ctx context.Context, m kubernetes.Mutation) (kubernetes.Value, error) {
+	if mv, ok := m.(*kubernetes.BronzeK8sWorkloadMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *kubernetes.BronzeK8sWorkloadMutation", m)
+}
+
+// Condition is a hook condition function.
+type Condition func(context.Context, kubernetes.Mutation) bool
+
+// And groups conditions with the AND operator.
+func And(first, second Condition, rest ...Condition) Condition {
+	return func(ctx context.Context, m kubernetes.Mutation) bool {
+		if !first(ctx, m) || !second(ctx, m) {
+			return false
+		}
+		for _, cond := range rest {
+			if !cond(ctx, m) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or groups conditions with the OR operator.
+func Or(first, second Condition, rest ...Condition) Condition {
+	return func(ctx context.Context, m kubernetes.Mutation) bool {
+		if first(ctx, m) || second(ctx, m) {
+			return true
+		}
+		for _, cond := range rest {
+			if cond(ctx, m) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not negates a given condition.
+func Not(cond Condition) Condition {
+	return func(ctx context.Context, m kubernetes.Mutation) bool {
+		return !cond(ctx, m)
+	}
+}
+
+// HasOp is a condition testing mutation operation.
+func HasOp(op kubernetes.Op) Condition {
+	return func(_ context.Context, m kubernetes.Mutation) bool {
+		return m.Op().Is(op)
+	}
+}
+
+// HasAddedFields is a condition validating `.AddedField` on fields.
+func HasAddedFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m kubernetes.Mutation) bool {
+		if _, exists := m.AddedField(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if _, exists := m.AddedField(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// HasClearedFields is a condition validating `.FieldCleared` on fields.
+func HasClearedFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m kubernetes.Mutation) bool {
+		if exists := m.FieldCleared(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if exists := m.FieldCleared(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// HasFields is a condition validating `.Field` on fields.
+func HasFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m kubernetes.Mutation) bool {
+		if _, exists := m.Field(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if _, exists := m.Field(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// If executes the given hook under condition.
+//
+//	hook.If(ComputeAverage, And(HasFields(...), HasAddedFields(...)))
+func If(hk kubernetes.Hook, cond Condition) kubernetes.Hook {
+	return func(next kubernetes.Mutator) kubernetes.Mutator {
+		return kubernetes.MutateFunc(func(ctx context.Context, m kubernetes.Mutation) (kubernetes.Value, error) {
+			if cond(ctx, m) {
+				return hk(next).Mutate(ctx, m)
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// On executes the given hook only for the given operation.
+//
+//	hook.On(Log, kubernetes.Delete|kubernetes.Create)
+func On(hk kubernetes.Hook, op kubernetes.Op) kubernetes.Hook {
+	return If(hk, HasOp(op))
+}
+
+// Unless skips the given hook only for the given operation.
+//
+//	hook.Unless(Log, kubernetes.Update|kubernetes.UpdateOne)
+func Unless(hk kubernetes.Hook, op kubernetes.Op) kubernetes.Hook {
+	return If(hk, Not(HasOp(op)))
+}
+
+// FixedError is a hook returning a fixed error.
+func FixedError(err error) kubernetes.Hook {
+	return func(kubernetes.Mutator) kubernetes.Mutator {
+		return kubernetes.MutateFunc(func(context.Context, kubernetes.Mutation) (kubernetes.Value, error) {
+			return nil, err
+		})
+	}
+}
+
+// Reject returns a hook that rejects all operations that match op.
+//
+//	func (T) Hooks() []kubernetes.Hook {
+//		return []kubernetes.Hook{
+//			Reject(kubernetes.Delete|kubernetes.Update),
+//		}
+//	}
+func Reject(op kubernetes.Op) kubernetes.Hook {
+	hk := FixedError(fmt.Errorf("%s operation is not allowed", op))
+	return On(hk, op)
+}
+
+// Chain acts as a list of hooks and is effectively immutable.
+// Once created, it will always hold the same set of hooks in the same order.
+type Chain struct {
+	hooks []kubernetes.Hook
+}
+
+// NewChain creates a new chain of hooks.
+func NewChain(hooks ...kubernetes.Hook) Chain {
+	return Chain{append([]kubernetes.Hook(nil), hooks...)}
+}
+
+// Hook chains the list of hooks and returns the final hook.
+func (c Chain) Hook() kubernetes.Hook {
+	return func(mutator kubernetes.Mutator) kubernetes.Mutator {
+		for i := len(c.hooks) - 1; i >= 0; i-- {
+			mutator = c.hooks[i](mutator)
+		}
+		return mutator
+	}
+}
+
+// Append extends a chain, adding the specified hook
+// as the last ones in the mutation flow.
+func (c Chain) Append(hooks ...kubernetes.Hook) Chain {
+	newHooks := make([]kubernetes.Hook, 0, len(c.hooks)+len(hooks))
+	newHooks = append(newHooks, c.hooks...)
+	newHooks = append(newHooks, hooks...)
+	return Chain{newHooks}
+}
+
+// Extend extends a chain, adding the specified chain
+// as the last ones in the mutation flow.
+func (c Chain) Extend(chain Chain) Chain {
+	return c.Append(chain.hooks...)
+}
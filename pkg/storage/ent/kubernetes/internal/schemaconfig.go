@@ -0,0 +1,39 @@
+// Code generated by ent, DO NOT EDIT.
+
+package internal
+
+import "context"
+
+// SchemaConfig represents alternative schema names for all tables
+// that can be passed at runtime.
+type SchemaConfig struct {
+	BronzeHistoryK8sImage          string // BronzeHistoryK8sImage table.
+	BronzeHistoryK8sNamespace      string // BronzeHistoryK8sNamespace table.
+	BronzeHistoryK8sNetworkPolicy  string // BronzeHistoryK8sNetworkPolicy table.
+	BronzeHistoryK8sNode           string // BronzeHistoryK8sNode table.
+	BronzeHistoryK8sPod            string // BronzeHistoryK8sPod table.
+	BronzeHistoryK8sRoleBinding    string // BronzeHistoryK8sRoleBinding table.
+	BronzeHistoryK8sServiceAccount string // BronzeHistoryK8sServiceAccount table.
+	BronzeHistoryK8sWorkload       string // BronzeHistoryK8sWorkload table.
+	BronzeK8sImage                 string // BronzeK8sImage table.
+	BronzeK8sNamespace             string // BronzeK8sNamespace table.
+	BronzeK8sNetworkPolicy         string // BronzeK8sNetworkPolicy table.
+	BronzeK8sNode                  string // BronzeK8sNode table.
+	BronzeK8sPod                   string // BronzeK8sPod table.
+	BronzeK8sRoleBinding           string // BronzeK8sRoleBinding table.
+	BronzeK8sServiceAccount        string // BronzeK8sServiceAccount table.
+	BronzeK8sWorkload              string // BronzeK8sWorkload table.
+}
+
+type schemaCtxKey struct{}
+
+// SchemaConfigFromContext returns a SchemaConfig stored inside a context, or empty if there isn't one.
+func SchemaConfigFromContext(ctx context.Context) SchemaConfig {
+	config, _ := ctx.Value(schemaCtxKey{}).(SchemaConfig)
+	return config
+}
+
+// NewSchemaConfigContext returns a new context with the given SchemaConfig attached.
+func NewSchemaConfigContext(parent context.Context, config SchemaConfig) context.Context {
+	return context.WithValue(parent, schemaCtxKey{}, config)
+}
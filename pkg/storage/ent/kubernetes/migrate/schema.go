@@ -0,0 +1,786 @@
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// K8sImagesHistoryColumns holds the columns for the "k8s_images_history" table.
+	K8sImagesHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "cluster_name", Type: field.TypeString},
+		{Name: "registry", Type: field.TypeString},
+		{Name: "repository", Type: field.TypeString},
+		{Name: "digest", Type: field.TypeString, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "namespaces_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "workloads_json", Type: field.TypeJSON, Nullable: true},
+	}
+	// K8sImagesHistoryTable holds the schema information for the "k8s_images_history" table.
+	K8sImagesHistoryTable = &schema.Table{
+		Name:       "k8s_images_history",
+		Columns:    K8sImagesHistoryColumns,
+		PrimaryKey: []*schema.Column{K8sImagesHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryk8simage_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{K8sImagesHistoryColumns[5], K8sImagesHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryk8simage_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{K8sImagesHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryk8simage_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{K8sImagesHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryk8simage_cluster_name",
+				Unique:  false,
+				Columns: []*schema.Column{K8sImagesHistoryColumns[6]},
+			},
+		},
+	}
+	// K8sNamespacesHistoryColumns holds the columns for the "k8s_namespaces_history" table.
+	K8sNamespacesHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "cluster_name", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "uid", Type: field.TypeString, Nullable: true},
+		{Name: "phase", Type: field.TypeString, Nullable: true},
+		{Name: "labels_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+	}
+	// K8sNamespacesHistoryTable holds the schema information for the "k8s_namespaces_history" table.
+	K8sNamespacesHistoryTable = &schema.Table{
+		Name:       "k8s_namespaces_history",
+		Columns:    K8sNamespacesHistoryColumns,
+		PrimaryKey: []*schema.Column{K8sNamespacesHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryk8snamespace_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{K8sNamespacesHistoryColumns[5], K8sNamespacesHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryk8snamespace_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{K8sNamespacesHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryk8snamespace_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{K8sNamespacesHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryk8snamespace_cluster_name",
+				Unique:  false,
+				Columns: []*schema.Column{K8sNamespacesHistoryColumns[6]},
+			},
+		},
+	}
+	// K8sNetworkPoliciesHistoryColumns holds the columns for the "k8s_network_policies_history" table.
+	K8sNetworkPoliciesHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "cluster_name", Type: field.TypeString},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "uid", Type: field.TypeString, Nullable: true},
+		{Name: "pod_selector_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "policy_types_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "ingress_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "egress_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "labels_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+	}
+	// K8sNetworkPoliciesHistoryTable holds the schema information for the "k8s_network_policies_history" table.
+	K8sNetworkPoliciesHistoryTable = &schema.Table{
+		Name:       "k8s_network_policies_history",
+		Columns:    K8sNetworkPoliciesHistoryColumns,
+		PrimaryKey: []*schema.Column{K8sNetworkPoliciesHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryk8snetworkpolicy_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{K8sNetworkPoliciesHistoryColumns[5], K8sNetworkPoliciesHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryk8snetworkpolicy_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{K8sNetworkPoliciesHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryk8snetworkpolicy_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{K8sNetworkPoliciesHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryk8snetworkpolicy_cluster_name",
+				Unique:  false,
+				Columns: []*schema.Column{K8sNetworkPoliciesHistoryColumns[6]},
+			},
+		},
+	}
+	// K8sNodesHistoryColumns holds the columns for the "k8s_nodes_history" table.
+	K8sNodesHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "cluster_name", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "uid", Type: field.TypeString, Nullable: true},
+		{Name: "provider_id", Type: field.TypeString, Nullable: true},
+		{Name: "internal_ip", Type: field.TypeString, Nullable: true},
+		{Name: "external_ip", Type: field.TypeString, Nullable: true},
+		{Name: "node_pool", Type: field.TypeString, Nullable: true},
+		{Name: "instance_type", Type: field.TypeString, Nullable: true},
+		{Name: "region", Type: field.TypeString, Nullable: true},
+		{Name: "zone", Type: field.TypeString, Nullable: true},
+		{Name: "kubelet_version", Type: field.TypeString, Nullable: true},
+		{Name: "os_image", Type: field.TypeString, Nullable: true},
+		{Name: "kernel_version", Type: field.TypeString, Nullable: true},
+		{Name: "container_runtime", Type: field.TypeString, Nullable: true},
+		{Name: "architecture", Type: field.TypeString, Nullable: true},
+		{Name: "ready", Type: field.TypeBool, Default: false},
+		{Name: "unschedulable", Type: field.TypeBool, Default: false},
+		{Name: "labels_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "taints_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+	}
+	// K8sNodesHistoryTable holds the schema information for the "k8s_nodes_history" table.
+	K8sNodesHistoryTable = &schema.Table{
+		Name:       "k8s_nodes_history",
+		Columns:    K8sNodesHistoryColumns,
+		PrimaryKey: []*schema.Column{K8sNodesHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryk8snode_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{K8sNodesHistoryColumns[5], K8sNodesHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryk8snode_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{K8sNodesHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryk8snode_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{K8sNodesHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryk8snode_cluster_name",
+				Unique:  false,
+				Columns: []*schema.Column{K8sNodesHistoryColumns[6]},
+			},
+		},
+	}
+	// K8sPodsHistoryColumns holds the columns for the "k8s_pods_history" table.
+	K8sPodsHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "cluster_name", Type: field.TypeString},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "uid", Type: field.TypeString, Nullable: true},
+		{Name: "phase", Type: field.TypeString, Nullable: true},
+		{Name: "node_name", Type: field.TypeString, Nullable: true},
+		{Name: "pod_ip", Type: field.TypeString, Nullable: true},
+		{Name: "host_ip", Type: field.TypeString, Nullable: true},
+		{Name: "service_account", Type: field.TypeString, Nullable: true},
+		{Name: "workload_kind", Type: field.TypeString, Nullable: true},
+		{Name: "workload_name", Type: field.TypeString, Nullable: true},
+		{Name: "host_network", Type: field.TypeBool, Default: false},
+		{Name: "containers_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "labels_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+	}
+	// K8sPodsHistoryTable holds the schema information for the "k8s_pods_history" table.
+	K8sPodsHistoryTable = &schema.Table{
+		Name:       "k8s_pods_history",
+		Columns:    K8sPodsHistoryColumns,
+		PrimaryKey: []*schema.Column{K8sPodsHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryk8spod_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{K8sPodsHistoryColumns[5], K8sPodsHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryk8spod_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{K8sPodsHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryk8spod_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{K8sPodsHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryk8spod_cluster_name",
+				Unique:  false,
+				Columns: []*schema.Column{K8sPodsHistoryColumns[6]},
+			},
+		},
+	}
+	// K8sRoleBindingsHistoryColumns holds the columns for the "k8s_role_bindings_history" table.
+	K8sRoleBindingsHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "cluster_name", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "namespace", Type: field.TypeString, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "uid", Type: field.TypeString, Nullable: true},
+		{Name: "role_kind", Type: field.TypeString},
+		{Name: "role_name", Type: field.TypeString},
+		{Name: "subjects_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "rules_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "labels_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+	}
+	// K8sRoleBindingsHistoryTable holds the schema information for the "k8s_role_bindings_history" table.
+	K8sRoleBindingsHistoryTable = &schema.Table{
+		Name:       "k8s_role_bindings_history",
+		Columns:    K8sRoleBindingsHistoryColumns,
+		PrimaryKey: []*schema.Column{K8sRoleBindingsHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryk8srolebinding_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{K8sRoleBindingsHistoryColumns[5], K8sRoleBindingsHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryk8srolebinding_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{K8sRoleBindingsHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryk8srolebinding_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{K8sRoleBindingsHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryk8srolebinding_cluster_name",
+				Unique:  false,
+				Columns: []*schema.Column{K8sRoleBindingsHistoryColumns[6]},
+			},
+		},
+	}
+	// K8sServiceAccountsHistoryColumns holds the columns for the "k8s_service_accounts_history" table.
+	K8sServiceAccountsHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "cluster_name", Type: field.TypeString},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "uid", Type: field.TypeString, Nullable: true},
+		{Name: "automount_token", Type: field.TypeBool, Nullable: true},
+		{Name: "cloud_identity", Type: field.TypeString, Nullable: true},
+		{Name: "image_pull_secrets_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "labels_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+	}
+	// K8sServiceAccountsHistoryTable holds the schema information for the "k8s_service_accounts_history" table.
+	K8sServiceAccountsHistoryTable = &schema.Table{
+		Name:       "k8s_service_accounts_history",
+		Columns:    K8sServiceAccountsHistoryColumns,
+		PrimaryKey: []*schema.Column{K8sServiceAccountsHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryk8sserviceaccount_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{K8sServiceAccountsHistoryColumns[5], K8sServiceAccountsHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryk8sserviceaccount_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{K8sServiceAccountsHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryk8sserviceaccount_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{K8sServiceAccountsHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryk8sserviceaccount_cluster_name",
+				Unique:  false,
+				Columns: []*schema.Column{K8sServiceAccountsHistoryColumns[6]},
+			},
+		},
+	}
+	// K8sWorkloadsHistoryColumns holds the columns for the "k8s_workloads_history" table.
+	K8sWorkloadsHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "cluster_name", Type: field.TypeString},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "uid", Type: field.TypeString, Nullable: true},
+		{Name: "replicas", Type: field.TypeInt32, Nullable: true},
+		{Name: "service_account", Type: field.TypeString, Nullable: true},
+		{Name: "host_network", Type: field.TypeBool, Default: false},
+		{Name: "host_pid", Type: field.TypeBool, Default: false},
+		{Name: "host_ipc", Type: field.TypeBool, Default: false},
+		{Name: "images_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "containers_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "selector_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "labels_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+	}
+	// K8sWorkloadsHistoryTable holds the schema information for the "k8s_workloads_history" table.
+	K8sWorkloadsHistoryTable = &schema.Table{
+		Name:       "k8s_workloads_history",
+		Columns:    K8sWorkloadsHistoryColumns,
+		PrimaryKey: []*schema.Column{K8sWorkloadsHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistoryk8sworkload_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{K8sWorkloadsHistoryColumns[5], K8sWorkloadsHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistoryk8sworkload_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{K8sWorkloadsHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistoryk8sworkload_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{K8sWorkloadsHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistoryk8sworkload_cluster_name",
+				Unique:  false,
+				Columns: []*schema.Column{K8sWorkloadsHistoryColumns[6]},
+			},
+		},
+	}
+	// K8sImagesColumns holds the columns for the "k8s_images" table.
+	K8sImagesColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "cluster_name", Type: field.TypeString},
+		{Name: "registry", Type: field.TypeString},
+		{Name: "repository", Type: field.TypeString},
+		{Name: "digest", Type: field.TypeString, Nullable: true},
+		{Name: "tags_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "namespaces_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "workloads_json", Type: field.TypeJSON, Nullable: true},
+	}
+	// K8sImagesTable holds the schema information for the "k8s_images" table.
+	K8sImagesTable = &schema.Table{
+		Name:       "k8s_images",
+		Columns:    K8sImagesColumns,
+		PrimaryKey: []*schema.Column{K8sImagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzek8simage_cluster_name",
+				Unique:  false,
+				Columns: []*schema.Column{K8sImagesColumns[3]},
+			},
+			{
+				Name:    "bronzek8simage_registry_repository",
+				Unique:  false,
+				Columns: []*schema.Column{K8sImagesColumns[4], K8sImagesColumns[5]},
+			},
+			{
+				Name:    "bronzek8simage_digest",
+				Unique:  false,
+				Columns: []*schema.Column{K8sImagesColumns[6]},
+			},
+			{
+				Name:    "bronzek8simage_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{K8sImagesColumns[1]},
+			},
+		},
+	}
+	// K8sNamespacesColumns holds the columns for the "k8s_namespaces" table.
+	K8sNamespacesColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "cluster_name", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "uid", Type: field.TypeString, Nullable: true},
+		{Name: "phase", Type: field.TypeString, Nullable: true},
+		{Name: "labels_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+	}
+	// K8sNamespacesTable holds the schema information for the "k8s_namespaces" table.
+	K8sNamespacesTable = &schema.Table{
+		Name:       "k8s_namespaces",
+		Columns:    K8sNamespacesColumns,
+		PrimaryKey: []*schema.Column{K8sNamespacesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzek8snamespace_cluster_name",
+				Unique:  false,
+				Columns: []*schema.Column{K8sNamespacesColumns[3]},
+			},
+			{
+				Name:    "bronzek8snamespace_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{K8sNamespacesColumns[1]},
+			},
+		},
+	}
+	// K8sNetworkPoliciesColumns holds the columns for the "k8s_network_policies" table.
+	K8sNetworkPoliciesColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "cluster_name", Type: field.TypeString},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "uid", Type: field.TypeString, Nullable: true},
+		{Name: "pod_selector_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "policy_types_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "ingress_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "egress_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "labels_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+	}
+	// K8sNetworkPoliciesTable holds the schema information for the "k8s_network_policies" table.
+	K8sNetworkPoliciesTable = &schema.Table{
+		Name:       "k8s_network_policies",
+		Columns:    K8sNetworkPoliciesColumns,
+		PrimaryKey: []*schema.Column{K8sNetworkPoliciesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzek8snetworkpolicy_cluster_name_namespace",
+				Unique:  false,
+				Columns: []*schema.Column{K8sNetworkPoliciesColumns[3], K8sNetworkPoliciesColumns[4]},
+			},
+			{
+				Name:    "bronzek8snetworkpolicy_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{K8sNetworkPoliciesColumns[1]},
+			},
+		},
+	}
+	// K8sNodesColumns holds the columns for the "k8s_nodes" table.
+	K8sNodesColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "cluster_name", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "uid", Type: field.TypeString, Nullable: true},
+		{Name: "provider_id", Type: field.TypeString, Nullable: true},
+		{Name: "internal_ip", Type: field.TypeString, Nullable: true},
+		{Name: "external_ip", Type: field.TypeString, Nullable: true},
+		{Name: "node_pool", Type: field.TypeString, Nullable: true},
+		{Name: "instance_type", Type: field.TypeString, Nullable: true},
+		{Name: "region", Type: field.TypeString, Nullable: true},
+		{Name: "zone", Type: field.TypeString, Nullable: true},
+		{Name: "kubelet_version", Type: field.TypeString, Nullable: true},
+		{Name: "os_image", Type: field.TypeString, Nullable: true},
+		{Name: "kernel_version", Type: field.TypeString, Nullable: true},
+		{Name: "container_runtime", Type: field.TypeString, Nullable: true},
+		{Name: "architecture", Type: field.TypeString, Nullable: true},
+		{Name: "ready", Type: field.TypeBool, Default: false},
+		{Name: "unschedulable", Type: field.TypeBool, Default: false},
+		{Name: "labels_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "taints_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+	}
+	// K8sNodesTable holds the schema information for the "k8s_nodes" table.
+	K8sNodesTable = &schema.Table{
+		Name:       "k8s_nodes",
+		Columns:    K8sNodesColumns,
+		PrimaryKey: []*schema.Column{K8sNodesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzek8snode_cluster_name",
+				Unique:  false,
+				Columns: []*schema.Column{K8sNodesColumns[3]},
+			},
+			{
+				Name:    "bronzek8snode_provider_id",
+				Unique:  false,
+				Columns: []*schema.Column{K8sNodesColumns[6]},
+			},
+			{
+				Name:    "bronzek8snode_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{K8sNodesColumns[1]},
+			},
+		},
+	}
+	// K8sPodsColumns holds the columns for the "k8s_pods" table.
+	K8sPodsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "cluster_name", Type: field.TypeString},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "uid", Type: field.TypeString, Nullable: true},
+		{Name: "phase", Type: field.TypeString, Nullable: true},
+		{Name: "node_name", Type: field.TypeString, Nullable: true},
+		{Name: "pod_ip", Type: field.TypeString, Nullable: true},
+		{Name: "host_ip", Type: field.TypeString, Nullable: true},
+		{Name: "service_account", Type: field.TypeString, Nullable: true},
+		{Name: "workload_kind", Type: field.TypeString, Nullable: true},
+		{Name: "workload_name", Type: field.TypeString, Nullable: true},
+		{Name: "host_network", Type: field.TypeBool, Default: false},
+		{Name: "containers_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "labels_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+	}
+	// K8sPodsTable holds the schema information for the "k8s_pods" table.
+	K8sPodsTable = &schema.Table{
+		Name:       "k8s_pods",
+		Columns:    K8sPodsColumns,
+		PrimaryKey: []*schema.Column{K8sPodsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzek8spod_cluster_name_namespace",
+				Unique:  false,
+				Columns: []*schema.Column{K8sPodsColumns[3], K8sPodsColumns[4]},
+			},
+			{
+				Name:    "bronzek8spod_node_name",
+				Unique:  false,
+				Columns: []*schema.Column{K8sPodsColumns[8]},
+			},
+			{
+				Name:    "bronzek8spod_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{K8sPodsColumns[1]},
+			},
+		},
+	}
+	// K8sRoleBindingsColumns holds the columns for the "k8s_role_bindings" table.
+	K8sRoleBindingsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "cluster_name", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "namespace", Type: field.TypeString, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "uid", Type: field.TypeString, Nullable: true},
+		{Name: "role_kind", Type: field.TypeString},
+		{Name: "role_name", Type: field.TypeString},
+		{Name: "subjects_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "rules_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "labels_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+	}
+	// K8sRoleBindingsTable holds the schema information for the "k8s_role_bindings" table.
+	K8sRoleBindingsTable = &schema.Table{
+		Name:       "k8s_role_bindings",
+		Columns:    K8sRoleBindingsColumns,
+		PrimaryKey: []*schema.Column{K8sRoleBindingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzek8srolebinding_cluster_name_namespace",
+				Unique:  false,
+				Columns: []*schema.Column{K8sRoleBindingsColumns[3], K8sRoleBindingsColumns[5]},
+			},
+			{
+				Name:    "bronzek8srolebinding_role_name",
+				Unique:  false,
+				Columns: []*schema.Column{K8sRoleBindingsColumns[9]},
+			},
+			{
+				Name:    "bronzek8srolebinding_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{K8sRoleBindingsColumns[1]},
+			},
+		},
+	}
+	// K8sServiceAccountsColumns holds the columns for the "k8s_service_accounts" table.
+	K8sServiceAccountsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "cluster_name", Type: field.TypeString},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "uid", Type: field.TypeString, Nullable: true},
+		{Name: "automount_token", Type: field.TypeBool, Nullable: true},
+		{Name: "cloud_identity", Type: field.TypeString, Nullable: true},
+		{Name: "image_pull_secrets_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "labels_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+	}
+	// K8sServiceAccountsTable holds the schema information for the "k8s_service_accounts" table.
+	K8sServiceAccountsTable = &schema.Table{
+		Name:       "k8s_service_accounts",
+		Columns:    K8sServiceAccountsColumns,
+		PrimaryKey: []*schema.Column{K8sServiceAccountsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzek8sserviceaccount_cluster_name_namespace",
+				Unique:  false,
+				Columns: []*schema.Column{K8sServiceAccountsColumns[3], K8sServiceAccountsColumns[4]},
+			},
+			{
+				Name:    "bronzek8sserviceaccount_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{K8sServiceAccountsColumns[1]},
+			},
+		},
+	}
+	// K8sWorkloadsColumns holds the columns for the "k8s_workloads" table.
+	K8sWorkloadsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "cluster_name", Type: field.TypeString},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "uid", Type: field.TypeString, Nullable: true},
+		{Name: "replicas", Type: field.TypeInt32, Nullable: true},
+		{Name: "service_account", Type: field.TypeString, Nullable: true},
+		{Name: "host_network", Type: field.TypeBool, Default: false},
+		{Name: "host_pid", Type: field.TypeBool, Default: false},
+		{Name: "host_ipc", Type: field.TypeBool, Default: false},
+		{Name: "images_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "containers_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "selector_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "labels_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+	}
+	// K8sWorkloadsTable holds the schema information for the "k8s_workloads" table.
+	K8sWorkloadsTable = &schema.Table{
+		Name:       "k8s_workloads",
+		Columns:    K8sWorkloadsColumns,
+		PrimaryKey: []*schema.Column{K8sWorkloadsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzek8sworkload_cluster_name_namespace",
+				Unique:  false,
+				Columns: []*schema.Column{K8sWorkloadsColumns[3], K8sWorkloadsColumns[4]},
+			},
+			{
+				Name:    "bronzek8sworkload_kind",
+				Unique:  false,
+				Columns: []*schema.Column{K8sWorkloadsColumns[5]},
+			},
+			{
+				Name:    "bronzek8sworkload_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{K8sWorkloadsColumns[1]},
+			},
+		},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		K8sImagesHistoryTable,
+		K8sNamespacesHistoryTable,
+		K8sNetworkPoliciesHistoryTable,
+		K8sNodesHistoryTable,
+		K8sPodsHistoryTable,
+		K8sRoleBindingsHistoryTable,
+		K8sServiceAccountsHistoryTable,
+		K8sWorkloadsHistoryTable,
+		K8sImagesTable,
+		K8sNamespacesTable,
+		K8sNetworkPoliciesTable,
+		K8sNodesTable,
+		K8sPodsTable,
+		K8sRoleBindingsTable,
+		K8sServiceAccountsTable,
+		K8sWorkloadsTable,
+	}
+)
+
+func init() {
+	K8sImagesHistoryTable.Annotation = &entsql.Annotation{
+		Table: "k8s_images_history",
+	}
+	K8sNamespacesHistoryTable.Annotation = &entsql.Annotation{
+		Table: "k8s_namespaces_history",
+	}
+	K8sNetworkPoliciesHistoryTable.Annotation = &entsql.Annotation{
+		Table: "k8s_network_policies_history",
+	}
+	K8sNodesHistoryTable.Annotation = &entsql.Annotation{
+		Table: "k8s_nodes_history",
+	}
+	K8sPodsHistoryTable.Annotation = &entsql.Annotation{
+		Table: "k8s_pods_history",
+	}
+	K8sRoleBindingsHistoryTable.Annotation = &entsql.Annotation{
+		Table: "k8s_role_bindings_history",
+	}
+	K8sServiceAccountsHistoryTable.Annotation = &entsql.Annotation{
+		Table: "k8s_service_accounts_history",
+	}
+	K8sWorkloadsHistoryTable.Annotation = &entsql.Annotation{
+		Table: "k8s_workloads_history",
+	}
+	K8sImagesTable.Annotation = &entsql.Annotation{
+		Table: "k8s_images",
+	}
+	K8sNamespacesTable.Annotation = &entsql.Annotation{
+		Table: "k8s_namespaces",
+	}
+	K8sNetworkPoliciesTable.Annotation = &entsql.Annotation{
+		Table: "k8s_network_policies",
+	}
+	K8sNodesTable.Annotation = &entsql.Annotation{
+		Table: "k8s_nodes",
+	}
+	K8sPodsTable.Annotation = &entsql.Annotation{
+		Table: "k8s_pods",
+	}
+	K8sRoleBindingsTable.Annotation = &entsql.Annotation{
+		Table: "k8s_role_bindings",
+	}
+	K8sServiceAccountsTable.Annotation = &entsql.Annotation{
+		Table: "k8s_service_accounts",
+	}
+	K8sWorkloadsTable.Annotation = &entsql.Annotation{
+		Table: "k8s_workloads",
+	}
+}
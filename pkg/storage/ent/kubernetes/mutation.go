@@ -0,0 +1,20953 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8simage"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snamespace"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snetworkpolicy"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snode"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8spod"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8srolebinding"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8sserviceaccount"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8sworkload"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8simage"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snamespace"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snetworkpolicy"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snode"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8spod"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8srolebinding"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8sserviceaccount"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8sworkload"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/predicate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Operation types.
+	OpCreate    = ent.OpCreate
+	OpDelete    = ent.OpDelete
+	OpDeleteOne = ent.OpDeleteOne
+	OpUpdate    = ent.OpUpdate
+	OpUpdateOne = ent.OpUpdateOne
+
+	// Node types.
+	TypeBronzeHistoryK8sImage          = "BronzeHistoryK8sImage"
+	TypeBronzeHistoryK8sNamespace      = "BronzeHistoryK8sNamespace"
+	TypeBronzeHistoryK8sNetworkPolicy  = "BronzeHistoryK8sNetworkPolicy"
+	TypeBronzeHistoryK8sNode           = "BronzeHistoryK8sNode"
+	TypeBronzeHistoryK8sPod            = "BronzeHistoryK8sPod"
+	TypeBronzeHistoryK8sRoleBinding    = "BronzeHistoryK8sRoleBinding"
+	TypeBronzeHistoryK8sServiceAccount = "BronzeHistoryK8sServiceAccount"
+	TypeBronzeHistoryK8sWorkload       = "BronzeHistoryK8sWorkload"
+	TypeBronzeK8sImage                 = "BronzeK8sImage"
+	TypeBronzeK8sNamespace             = "BronzeK8sNamespace"
+	TypeBronzeK8sNetworkPolicy         = "BronzeK8sNetworkPolicy"
+	TypeBronzeK8sNode                  = "BronzeK8sNode"
+	TypeBronzeK8sPod                   = "BronzeK8sPod"
+	TypeBronzeK8sRoleBinding           = "BronzeK8sRoleBinding"
+	TypeBronzeK8sServiceAccount        = "BronzeK8sServiceAccount"
+	TypeBronzeK8sWorkload              = "BronzeK8sWorkload"
+)
+
+// BronzeHistoryK8sImageMutation represents an operation that mutates the BronzeHistoryK8sImage nodes in the graph.
+type BronzeHistoryK8sImageMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uint
+	valid_from            *time.Time
+	valid_to              *time.Time
+	collected_at          *time.Time
+	first_collected_at    *time.Time
+	resource_id           *string
+	cluster_name          *string
+	registry              *string
+	repository            *string
+	digest                *string
+	tags_json             *json.RawMessage
+	appendtags_json       json.RawMessage
+	namespaces_json       *json.RawMessage
+	appendnamespaces_json json.RawMessage
+	workloads_json        *json.RawMessage
+	appendworkloads_json  json.RawMessage
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*BronzeHistoryK8sImage, error)
+	predicates            []predicate.BronzeHistoryK8sImage
+}
+
+var _ ent.Mutation = (*BronzeHistoryK8sImageMutation)(nil)
+
+// bronzehistoryk8simageOption allows management of the mutation configuration using functional options.
+type bronzehistoryk8simageOption func(*BronzeHistoryK8sImageMutation)
+
+// newBronzeHistoryK8sImageMutation creates new mutation for the BronzeHistoryK8sImage entity.
+func newBronzeHistoryK8sImageMutation(c config, op Op, opts ...bronzehistoryk8simageOption) *BronzeHistoryK8sImageMutation {
+	m := &BronzeHistoryK8sImageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeHistoryK8sImage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeHistoryK8sImageID sets the ID field of the mutation.
+func withBronzeHistoryK8sImageID(id uint) bronzehistoryk8simageOption {
+	return func(m *BronzeHistoryK8sImageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeHistoryK8sImage
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeHistoryK8sImage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeHistoryK8sImage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeHistoryK8sImage sets the old BronzeHistoryK8sImage of the mutation.
+func withBronzeHistoryK8sImage(node *BronzeHistoryK8sImage) bronzehistoryk8simageOption {
+	return func(m *BronzeHistoryK8sImageMutation) {
+		m.oldValue = func(context.Context) (*BronzeHistoryK8sImage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeHistoryK8sImageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeHistoryK8sImageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("kubernetes: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeHistoryK8sImage entities.
+func (m *BronzeHistoryK8sImageMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeHistoryK8sImageMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeHistoryK8sImageMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeHistoryK8sImage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *BronzeHistoryK8sImageMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *BronzeHistoryK8sImageMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the BronzeHistoryK8sImage entity.
+// If the BronzeHistoryK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sImageMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *BronzeHistoryK8sImageMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidTo sets the "valid_to" field.
+func (m *BronzeHistoryK8sImageMutation) SetValidTo(t time.Time) {
+	m.valid_to = &t
+}
+
+// ValidTo returns the value of the "valid_to" field in the mutation.
+func (m *BronzeHistoryK8sImageMutation) ValidTo() (r time.Time, exists bool) {
+	v := m.valid_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidTo returns the old "valid_to" field's value of the BronzeHistoryK8sImage entity.
+// If the BronzeHistoryK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sImageMutation) OldValidTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidTo: %w", err)
+	}
+	return oldValue.ValidTo, nil
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (m *BronzeHistoryK8sImageMutation) ClearValidTo() {
+	m.valid_to = nil
+	m.clearedFields[bronzehistoryk8simage.FieldValidTo] = struct{}{}
+}
+
+// ValidToCleared returns if the "valid_to" field was cleared in this mutation.
+func (m *BronzeHistoryK8sImageMutation) ValidToCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8simage.FieldValidTo]
+	return ok
+}
+
+// ResetValidTo resets all changes to the "valid_to" field.
+func (m *BronzeHistoryK8sImageMutation) ResetValidTo() {
+	m.valid_to = nil
+	delete(m.clearedFields, bronzehistoryk8simage.FieldValidTo)
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeHistoryK8sImageMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeHistoryK8sImageMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeHistoryK8sImage entity.
+// If the BronzeHistoryK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sImageMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeHistoryK8sImageMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeHistoryK8sImageMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeHistoryK8sImageMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeHistoryK8sImage entity.
+// If the BronzeHistoryK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sImageMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeHistoryK8sImageMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *BronzeHistoryK8sImageMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *BronzeHistoryK8sImageMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the BronzeHistoryK8sImage entity.
+// If the BronzeHistoryK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sImageMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *BronzeHistoryK8sImageMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (m *BronzeHistoryK8sImageMutation) SetClusterName(s string) {
+	m.cluster_name = &s
+}
+
+// ClusterName returns the value of the "cluster_name" field in the mutation.
+func (m *BronzeHistoryK8sImageMutation) ClusterName() (r string, exists bool) {
+	v := m.cluster_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClusterName returns the old "cluster_name" field's value of the BronzeHistoryK8sImage entity.
+// If the BronzeHistoryK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sImageMutation) OldClusterName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterName: %w", err)
+	}
+	return oldValue.ClusterName, nil
+}
+
+// ResetClusterName resets all changes to the "cluster_name" field.
+func (m *BronzeHistoryK8sImageMutation) ResetClusterName() {
+	m.cluster_name = nil
+}
+
+// SetRegistry sets the "registry" field.
+func (m *BronzeHistoryK8sImageMutation) SetRegistry(s string) {
+	m.registry = &s
+}
+
+// Registry returns the value of the "registry" field in the mutation.
+func (m *BronzeHistoryK8sImageMutation) Registry() (r string, exists bool) {
+	v := m.registry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegistry returns the old "registry" field's value of the BronzeHistoryK8sImage entity.
+// If the BronzeHistoryK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sImageMutation) OldRegistry(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegistry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegistry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegistry: %w", err)
+	}
+	return oldValue.Registry, nil
+}
+
+// ResetRegistry resets all changes to the "registry" field.
+func (m *BronzeHistoryK8sImageMutation) ResetRegistry() {
+	m.registry = nil
+}
+
+// SetRepository sets the "repository" field.
+func (m *BronzeHistoryK8sImageMutation) SetRepository(s string) {
+	m.repository = &s
+}
+
+// Repository returns the value of the "repository" field in the mutation.
+func (m *BronzeHistoryK8sImageMutation) Repository() (r string, exists bool) {
+	v := m.repository
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRepository returns the old "repository" field's value of the BronzeHistoryK8sImage entity.
+// If the BronzeHistoryK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sImageMutation) OldRepository(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRepository is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRepository requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRepository: %w", err)
+	}
+	return oldValue.Repository, nil
+}
+
+// ResetRepository resets all changes to the "repository" field.
+func (m *BronzeHistoryK8sImageMutation) ResetRepository() {
+	m.repository = nil
+}
+
+// SetDigest sets the "digest" field.
+func (m *BronzeHistoryK8sImageMutation) SetDigest(s string) {
+	m.digest = &s
+}
+
+// Digest returns the value of the "digest" field in the mutation.
+func (m *BronzeHistoryK8sImageMutation) Digest() (r string, exists bool) {
+	v := m.digest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDigest returns the old "digest" field's value of the BronzeHistoryK8sImage entity.
+// If the BronzeHistoryK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sImageMutation) OldDigest(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDigest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDigest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDigest: %w", err)
+	}
+	return oldValue.Digest, nil
+}
+
+// ClearDigest clears the value of the "digest" field.
+func (m *BronzeHistoryK8sImageMutation) ClearDigest() {
+	m.digest = nil
+	m.clearedFields[bronzehistoryk8simage.FieldDigest] = struct{}{}
+}
+
+// DigestCleared returns if the "digest" field was cleared in this mutation.
+func (m *BronzeHistoryK8sImageMutation) DigestCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8simage.FieldDigest]
+	return ok
+}
+
+// ResetDigest resets all changes to the "digest" field.
+func (m *BronzeHistoryK8sImageMutation) ResetDigest() {
+	m.digest = nil
+	delete(m.clearedFields, bronzehistoryk8simage.FieldDigest)
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (m *BronzeHistoryK8sImageMutation) SetTagsJSON(jm json.RawMessage) {
+	m.tags_json = &jm
+	m.appendtags_json = nil
+}
+
+// TagsJSON returns the value of the "tags_json" field in the mutation.
+func (m *BronzeHistoryK8sImageMutation) TagsJSON() (r json.RawMessage, exists bool) {
+	v := m.tags_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagsJSON returns the old "tags_json" field's value of the BronzeHistoryK8sImage entity.
+// If the BronzeHistoryK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sImageMutation) OldTagsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagsJSON: %w", err)
+	}
+	return oldValue.TagsJSON, nil
+}
+
+// AppendTagsJSON adds jm to the "tags_json" field.
+func (m *BronzeHistoryK8sImageMutation) AppendTagsJSON(jm json.RawMessage) {
+	m.appendtags_json = append(m.appendtags_json, jm...)
+}
+
+// AppendedTagsJSON returns the list of values that were appended to the "tags_json" field in this mutation.
+func (m *BronzeHistoryK8sImageMutation) AppendedTagsJSON() (json.RawMessage, bool) {
+	if len(m.appendtags_json) == 0 {
+		return nil, false
+	}
+	return m.appendtags_json, true
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (m *BronzeHistoryK8sImageMutation) ClearTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	m.clearedFields[bronzehistoryk8simage.FieldTagsJSON] = struct{}{}
+}
+
+// TagsJSONCleared returns if the "tags_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sImageMutation) TagsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8simage.FieldTagsJSON]
+	return ok
+}
+
+// ResetTagsJSON resets all changes to the "tags_json" field.
+func (m *BronzeHistoryK8sImageMutation) ResetTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	delete(m.clearedFields, bronzehistoryk8simage.FieldTagsJSON)
+}
+
+// SetNamespacesJSON sets the "namespaces_json" field.
+func (m *BronzeHistoryK8sImageMutation) SetNamespacesJSON(jm json.RawMessage) {
+	m.namespaces_json = &jm
+	m.appendnamespaces_json = nil
+}
+
+// NamespacesJSON returns the value of the "namespaces_json" field in the mutation.
+func (m *BronzeHistoryK8sImageMutation) NamespacesJSON() (r json.RawMessage, exists bool) {
+	v := m.namespaces_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespacesJSON returns the old "namespaces_json" field's value of the BronzeHistoryK8sImage entity.
+// If the BronzeHistoryK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sImageMutation) OldNamespacesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespacesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespacesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespacesJSON: %w", err)
+	}
+	return oldValue.NamespacesJSON, nil
+}
+
+// AppendNamespacesJSON adds jm to the "namespaces_json" field.
+func (m *BronzeHistoryK8sImageMutation) AppendNamespacesJSON(jm json.RawMessage) {
+	m.appendnamespaces_json = append(m.appendnamespaces_json, jm...)
+}
+
+// AppendedNamespacesJSON returns the list of values that were appended to the "namespaces_json" field in this mutation.
+func (m *BronzeHistoryK8sImageMutation) AppendedNamespacesJSON() (json.RawMessage, bool) {
+	if len(m.appendnamespaces_json) == 0 {
+		return nil, false
+	}
+	return m.appendnamespaces_json, true
+}
+
+// ClearNamespacesJSON clears the value of the "namespaces_json" field.
+func (m *BronzeHistoryK8sImageMutation) ClearNamespacesJSON() {
+	m.namespaces_json = nil
+	m.appendnamespaces_json = nil
+	m.clearedFields[bronzehistoryk8simage.FieldNamespacesJSON] = struct{}{}
+}
+
+// NamespacesJSONCleared returns if the "namespaces_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sImageMutation) NamespacesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8simage.FieldNamespacesJSON]
+	return ok
+}
+
+// ResetNamespacesJSON resets all changes to the "namespaces_json" field.
+func (m *BronzeHistoryK8sImageMutation) ResetNamespacesJSON() {
+	m.namespaces_json = nil
+	m.appendnamespaces_json = nil
+	delete(m.clearedFields, bronzehistoryk8simage.FieldNamespacesJSON)
+}
+
+// SetWorkloadsJSON sets the "workloads_json" field.
+func (m *BronzeHistoryK8sImageMutation) SetWorkloadsJSON(jm json.RawMessage) {
+	m.workloads_json = &jm
+	m.appendworkloads_json = nil
+}
+
+// WorkloadsJSON returns the value of the "workloads_json" field in the mutation.
+func (m *BronzeHistoryK8sImageMutation) WorkloadsJSON() (r json.RawMessage, exists bool) {
+	v := m.workloads_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkloadsJSON returns the old "workloads_json" field's value of the BronzeHistoryK8sImage entity.
+// If the BronzeHistoryK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sImageMutation) OldWorkloadsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkloadsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkloadsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkloadsJSON: %w", err)
+	}
+	return oldValue.WorkloadsJSON, nil
+}
+
+// AppendWorkloadsJSON adds jm to the "workloads_json" field.
+func (m *BronzeHistoryK8sImageMutation) AppendWorkloadsJSON(jm json.RawMessage) {
+	m.appendworkloads_json = append(m.appendworkloads_json, jm...)
+}
+
+// AppendedWorkloadsJSON returns the list of values that were appended to the "workloads_json" field in this mutation.
+func (m *BronzeHistoryK8sImageMutation) AppendedWorkloadsJSON() (json.RawMessage, bool) {
+	if len(m.appendworkloads_json) == 0 {
+		return nil, false
+	}
+	return m.appendworkloads_json, true
+}
+
+// ClearWorkloadsJSON clears the value of the "workloads_json" field.
+func (m *BronzeHistoryK8sImageMutation) ClearWorkloadsJSON() {
+	m.workloads_json = nil
+	m.appendworkloads_json = nil
+	m.clearedFields[bronzehistoryk8simage.FieldWorkloadsJSON] = struct{}{}
+}
+
+// WorkloadsJSONCleared returns if the "workloads_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sImageMutation) WorkloadsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8simage.FieldWorkloadsJSON]
+	return ok
+}
+
+// ResetWorkloadsJSON resets all changes to the "workloads_json" field.
+func (m *BronzeHistoryK8sImageMutation) ResetWorkloadsJSON() {
+	m.workloads_json = nil
+	m.appendworkloads_json = nil
+	delete(m.clearedFields, bronzehistoryk8simage.FieldWorkloadsJSON)
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sImageMutation builder.
+func (m *BronzeHistoryK8sImageMutation) Where(ps ...predicate.BronzeHistoryK8sImage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeHistoryK8sImageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeHistoryK8sImageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeHistoryK8sImage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeHistoryK8sImageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeHistoryK8sImageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeHistoryK8sImage).
+func (m *BronzeHistoryK8sImageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeHistoryK8sImageMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.valid_from != nil {
+		fields = append(fields, bronzehistoryk8simage.FieldValidFrom)
+	}
+	if m.valid_to != nil {
+		fields = append(fields, bronzehistoryk8simage.FieldValidTo)
+	}
+	if m.collected_at != nil {
+		fields = append(fields, bronzehistoryk8simage.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzehistoryk8simage.FieldFirstCollectedAt)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, bronzehistoryk8simage.FieldResourceID)
+	}
+	if m.cluster_name != nil {
+		fields = append(fields, bronzehistoryk8simage.FieldClusterName)
+	}
+	if m.registry != nil {
+		fields = append(fields, bronzehistoryk8simage.FieldRegistry)
+	}
+	if m.repository != nil {
+		fields = append(fields, bronzehistoryk8simage.FieldRepository)
+	}
+	if m.digest != nil {
+		fields = append(fields, bronzehistoryk8simage.FieldDigest)
+	}
+	if m.tags_json != nil {
+		fields = append(fields, bronzehistoryk8simage.FieldTagsJSON)
+	}
+	if m.namespaces_json != nil {
+		fields = append(fields, bronzehistoryk8simage.FieldNamespacesJSON)
+	}
+	if m.workloads_json != nil {
+		fields = append(fields, bronzehistoryk8simage.FieldWorkloadsJSON)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeHistoryK8sImageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryk8simage.FieldValidFrom:
+		return m.ValidFrom()
+	case bronzehistoryk8simage.FieldValidTo:
+		return m.ValidTo()
+	case bronzehistoryk8simage.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzehistoryk8simage.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzehistoryk8simage.FieldResourceID:
+		return m.ResourceID()
+	case bronzehistoryk8simage.FieldClusterName:
+		return m.ClusterName()
+	case bronzehistoryk8simage.FieldRegistry:
+		return m.Registry()
+	case bronzehistoryk8simage.FieldRepository:
+		return m.Repository()
+	case bronzehistoryk8simage.FieldDigest:
+		return m.Digest()
+	case bronzehistoryk8simage.FieldTagsJSON:
+		return m.TagsJSON()
+	case bronzehistoryk8simage.FieldNamespacesJSON:
+		return m.NamespacesJSON()
+	case bronzehistoryk8simage.FieldWorkloadsJSON:
+		return m.WorkloadsJSON()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeHistoryK8sImageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzehistoryk8simage.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case bronzehistoryk8simage.FieldValidTo:
+		return m.OldValidTo(ctx)
+	case bronzehistoryk8simage.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzehistoryk8simage.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzehistoryk8simage.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case bronzehistoryk8simage.FieldClusterName:
+		return m.OldClusterName(ctx)
+	case bronzehistoryk8simage.FieldRegistry:
+		return m.OldRegistry(ctx)
+	case bronzehistoryk8simage.FieldRepository:
+		return m.OldRepository(ctx)
+	case bronzehistoryk8simage.FieldDigest:
+		return m.OldDigest(ctx)
+	case bronzehistoryk8simage.FieldTagsJSON:
+		return m.OldTagsJSON(ctx)
+	case bronzehistoryk8simage.FieldNamespacesJSON:
+		return m.OldNamespacesJSON(ctx)
+	case bronzehistoryk8simage.FieldWorkloadsJSON:
+		return m.OldWorkloadsJSON(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeHistoryK8sImage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryK8sImageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryk8simage.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case bronzehistoryk8simage.FieldValidTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidTo(v)
+		return nil
+	case bronzehistoryk8simage.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzehistoryk8simage.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzehistoryk8simage.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case bronzehistoryk8simage.FieldClusterName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterName(v)
+		return nil
+	case bronzehistoryk8simage.FieldRegistry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegistry(v)
+		return nil
+	case bronzehistoryk8simage.FieldRepository:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRepository(v)
+		return nil
+	case bronzehistoryk8simage.FieldDigest:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDigest(v)
+		return nil
+	case bronzehistoryk8simage.FieldTagsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagsJSON(v)
+		return nil
+	case bronzehistoryk8simage.FieldNamespacesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespacesJSON(v)
+		return nil
+	case bronzehistoryk8simage.FieldWorkloadsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkloadsJSON(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sImage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeHistoryK8sImageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeHistoryK8sImageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryK8sImageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sImage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeHistoryK8sImageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzehistoryk8simage.FieldValidTo) {
+		fields = append(fields, bronzehistoryk8simage.FieldValidTo)
+	}
+	if m.FieldCleared(bronzehistoryk8simage.FieldDigest) {
+		fields = append(fields, bronzehistoryk8simage.FieldDigest)
+	}
+	if m.FieldCleared(bronzehistoryk8simage.FieldTagsJSON) {
+		fields = append(fields, bronzehistoryk8simage.FieldTagsJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8simage.FieldNamespacesJSON) {
+		fields = append(fields, bronzehistoryk8simage.FieldNamespacesJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8simage.FieldWorkloadsJSON) {
+		fields = append(fields, bronzehistoryk8simage.FieldWorkloadsJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeHistoryK8sImageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeHistoryK8sImageMutation) ClearField(name string) error {
+	switch name {
+	case bronzehistoryk8simage.FieldValidTo:
+		m.ClearValidTo()
+		return nil
+	case bronzehistoryk8simage.FieldDigest:
+		m.ClearDigest()
+		return nil
+	case bronzehistoryk8simage.FieldTagsJSON:
+		m.ClearTagsJSON()
+		return nil
+	case bronzehistoryk8simage.FieldNamespacesJSON:
+		m.ClearNamespacesJSON()
+		return nil
+	case bronzehistoryk8simage.FieldWorkloadsJSON:
+		m.ClearWorkloadsJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sImage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeHistoryK8sImageMutation) ResetField(name string) error {
+	switch name {
+	case bronzehistoryk8simage.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case bronzehistoryk8simage.FieldValidTo:
+		m.ResetValidTo()
+		return nil
+	case bronzehistoryk8simage.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzehistoryk8simage.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzehistoryk8simage.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case bronzehistoryk8simage.FieldClusterName:
+		m.ResetClusterName()
+		return nil
+	case bronzehistoryk8simage.FieldRegistry:
+		m.ResetRegistry()
+		return nil
+	case bronzehistoryk8simage.FieldRepository:
+		m.ResetRepository()
+		return nil
+	case bronzehistoryk8simage.FieldDigest:
+		m.ResetDigest()
+		return nil
+	case bronzehistoryk8simage.FieldTagsJSON:
+		m.ResetTagsJSON()
+		return nil
+	case bronzehistoryk8simage.FieldNamespacesJSON:
+		m.ResetNamespacesJSON()
+		return nil
+	case bronzehistoryk8simage.FieldWorkloadsJSON:
+		m.ResetWorkloadsJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sImage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeHistoryK8sImageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeHistoryK8sImageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeHistoryK8sImageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeHistoryK8sImageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeHistoryK8sImageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeHistoryK8sImageMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeHistoryK8sImageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryK8sImage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeHistoryK8sImageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryK8sImage edge %s", name)
+}
+
+// BronzeHistoryK8sNamespaceMutation represents an operation that mutates the BronzeHistoryK8sNamespace nodes in the graph.
+type BronzeHistoryK8sNamespaceMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uint
+	valid_from         *time.Time
+	valid_to           *time.Time
+	collected_at       *time.Time
+	first_collected_at *time.Time
+	resource_id        *string
+	cluster_name       *string
+	name               *string
+	uid                *string
+	phase              *string
+	labels_json        *json.RawMessage
+	appendlabels_json  json.RawMessage
+	api_created_at     *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*BronzeHistoryK8sNamespace, error)
+	predicates         []predicate.BronzeHistoryK8sNamespace
+}
+
+var _ ent.Mutation = (*BronzeHistoryK8sNamespaceMutation)(nil)
+
+// bronzehistoryk8snamespaceOption allows management of the mutation configuration using functional options.
+type bronzehistoryk8snamespaceOption func(*BronzeHistoryK8sNamespaceMutation)
+
+// newBronzeHistoryK8sNamespaceMutation creates new mutation for the BronzeHistoryK8sNamespace entity.
+func newBronzeHistoryK8sNamespaceMutation(c config, op Op, opts ...bronzehistoryk8snamespaceOption) *BronzeHistoryK8sNamespaceMutation {
+	m := &BronzeHistoryK8sNamespaceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeHistoryK8sNamespace,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeHistoryK8sNamespaceID sets the ID field of the mutation.
+func withBronzeHistoryK8sNamespaceID(id uint) bronzehistoryk8snamespaceOption {
+	return func(m *BronzeHistoryK8sNamespaceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeHistoryK8sNamespace
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeHistoryK8sNamespace, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeHistoryK8sNamespace.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeHistoryK8sNamespace sets the old BronzeHistoryK8sNamespace of the mutation.
+func withBronzeHistoryK8sNamespace(node *BronzeHistoryK8sNamespace) bronzehistoryk8snamespaceOption {
+	return func(m *BronzeHistoryK8sNamespaceMutation) {
+		m.oldValue = func(context.Context) (*BronzeHistoryK8sNamespace, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeHistoryK8sNamespaceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeHistoryK8sNamespaceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("kubernetes: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeHistoryK8sNamespace entities.
+func (m *BronzeHistoryK8sNamespaceMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeHistoryK8sNamespaceMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeHistoryK8sNamespace.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *BronzeHistoryK8sNamespaceMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the BronzeHistoryK8sNamespace entity.
+// If the BronzeHistoryK8sNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNamespaceMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *BronzeHistoryK8sNamespaceMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidTo sets the "valid_to" field.
+func (m *BronzeHistoryK8sNamespaceMutation) SetValidTo(t time.Time) {
+	m.valid_to = &t
+}
+
+// ValidTo returns the value of the "valid_to" field in the mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) ValidTo() (r time.Time, exists bool) {
+	v := m.valid_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidTo returns the old "valid_to" field's value of the BronzeHistoryK8sNamespace entity.
+// If the BronzeHistoryK8sNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNamespaceMutation) OldValidTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidTo: %w", err)
+	}
+	return oldValue.ValidTo, nil
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (m *BronzeHistoryK8sNamespaceMutation) ClearValidTo() {
+	m.valid_to = nil
+	m.clearedFields[bronzehistoryk8snamespace.FieldValidTo] = struct{}{}
+}
+
+// ValidToCleared returns if the "valid_to" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) ValidToCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snamespace.FieldValidTo]
+	return ok
+}
+
+// ResetValidTo resets all changes to the "valid_to" field.
+func (m *BronzeHistoryK8sNamespaceMutation) ResetValidTo() {
+	m.valid_to = nil
+	delete(m.clearedFields, bronzehistoryk8snamespace.FieldValidTo)
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeHistoryK8sNamespaceMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeHistoryK8sNamespace entity.
+// If the BronzeHistoryK8sNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNamespaceMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeHistoryK8sNamespaceMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeHistoryK8sNamespaceMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeHistoryK8sNamespace entity.
+// If the BronzeHistoryK8sNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNamespaceMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeHistoryK8sNamespaceMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *BronzeHistoryK8sNamespaceMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the BronzeHistoryK8sNamespace entity.
+// If the BronzeHistoryK8sNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNamespaceMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *BronzeHistoryK8sNamespaceMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (m *BronzeHistoryK8sNamespaceMutation) SetClusterName(s string) {
+	m.cluster_name = &s
+}
+
+// ClusterName returns the value of the "cluster_name" field in the mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) ClusterName() (r string, exists bool) {
+	v := m.cluster_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClusterName returns the old "cluster_name" field's value of the BronzeHistoryK8sNamespace entity.
+// If the BronzeHistoryK8sNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNamespaceMutation) OldClusterName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterName: %w", err)
+	}
+	return oldValue.ClusterName, nil
+}
+
+// ResetClusterName resets all changes to the "cluster_name" field.
+func (m *BronzeHistoryK8sNamespaceMutation) ResetClusterName() {
+	m.cluster_name = nil
+}
+
+// SetName sets the "name" field.
+func (m *BronzeHistoryK8sNamespaceMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the BronzeHistoryK8sNamespace entity.
+// If the BronzeHistoryK8sNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNamespaceMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *BronzeHistoryK8sNamespaceMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUID sets the "uid" field.
+func (m *BronzeHistoryK8sNamespaceMutation) SetUID(s string) {
+	m.uid = &s
+}
+
+// UID returns the value of the "uid" field in the mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) UID() (r string, exists bool) {
+	v := m.uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUID returns the old "uid" field's value of the BronzeHistoryK8sNamespace entity.
+// If the BronzeHistoryK8sNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNamespaceMutation) OldUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+	}
+	return oldValue.UID, nil
+}
+
+// ClearUID clears the value of the "uid" field.
+func (m *BronzeHistoryK8sNamespaceMutation) ClearUID() {
+	m.uid = nil
+	m.clearedFields[bronzehistoryk8snamespace.FieldUID] = struct{}{}
+}
+
+// UIDCleared returns if the "uid" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) UIDCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snamespace.FieldUID]
+	return ok
+}
+
+// ResetUID resets all changes to the "uid" field.
+func (m *BronzeHistoryK8sNamespaceMutation) ResetUID() {
+	m.uid = nil
+	delete(m.clearedFields, bronzehistoryk8snamespace.FieldUID)
+}
+
+// SetPhase sets the "phase" field.
+func (m *BronzeHistoryK8sNamespaceMutation) SetPhase(s string) {
+	m.phase = &s
+}
+
+// Phase returns the value of the "phase" field in the mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) Phase() (r string, exists bool) {
+	v := m.phase
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhase returns the old "phase" field's value of the BronzeHistoryK8sNamespace entity.
+// If the BronzeHistoryK8sNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNamespaceMutation) OldPhase(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhase: %w", err)
+	}
+	return oldValue.Phase, nil
+}
+
+// ClearPhase clears the value of the "phase" field.
+func (m *BronzeHistoryK8sNamespaceMutation) ClearPhase() {
+	m.phase = nil
+	m.clearedFields[bronzehistoryk8snamespace.FieldPhase] = struct{}{}
+}
+
+// PhaseCleared returns if the "phase" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) PhaseCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snamespace.FieldPhase]
+	return ok
+}
+
+// ResetPhase resets all changes to the "phase" field.
+func (m *BronzeHistoryK8sNamespaceMutation) ResetPhase() {
+	m.phase = nil
+	delete(m.clearedFields, bronzehistoryk8snamespace.FieldPhase)
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (m *BronzeHistoryK8sNamespaceMutation) SetLabelsJSON(jm json.RawMessage) {
+	m.labels_json = &jm
+	m.appendlabels_json = nil
+}
+
+// LabelsJSON returns the value of the "labels_json" field in the mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) LabelsJSON() (r json.RawMessage, exists bool) {
+	v := m.labels_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabelsJSON returns the old "labels_json" field's value of the BronzeHistoryK8sNamespace entity.
+// If the BronzeHistoryK8sNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNamespaceMutation) OldLabelsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabelsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabelsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabelsJSON: %w", err)
+	}
+	return oldValue.LabelsJSON, nil
+}
+
+// AppendLabelsJSON adds jm to the "labels_json" field.
+func (m *BronzeHistoryK8sNamespaceMutation) AppendLabelsJSON(jm json.RawMessage) {
+	m.appendlabels_json = append(m.appendlabels_json, jm...)
+}
+
+// AppendedLabelsJSON returns the list of values that were appended to the "labels_json" field in this mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) AppendedLabelsJSON() (json.RawMessage, bool) {
+	if len(m.appendlabels_json) == 0 {
+		return nil, false
+	}
+	return m.appendlabels_json, true
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (m *BronzeHistoryK8sNamespaceMutation) ClearLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	m.clearedFields[bronzehistoryk8snamespace.FieldLabelsJSON] = struct{}{}
+}
+
+// LabelsJSONCleared returns if the "labels_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) LabelsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snamespace.FieldLabelsJSON]
+	return ok
+}
+
+// ResetLabelsJSON resets all changes to the "labels_json" field.
+func (m *BronzeHistoryK8sNamespaceMutation) ResetLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	delete(m.clearedFields, bronzehistoryk8snamespace.FieldLabelsJSON)
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (m *BronzeHistoryK8sNamespaceMutation) SetAPICreatedAt(t time.Time) {
+	m.api_created_at = &t
+}
+
+// APICreatedAt returns the value of the "api_created_at" field in the mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) APICreatedAt() (r time.Time, exists bool) {
+	v := m.api_created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPICreatedAt returns the old "api_created_at" field's value of the BronzeHistoryK8sNamespace entity.
+// If the BronzeHistoryK8sNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNamespaceMutation) OldAPICreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPICreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPICreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPICreatedAt: %w", err)
+	}
+	return oldValue.APICreatedAt, nil
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (m *BronzeHistoryK8sNamespaceMutation) ClearAPICreatedAt() {
+	m.api_created_at = nil
+	m.clearedFields[bronzehistoryk8snamespace.FieldAPICreatedAt] = struct{}{}
+}
+
+// APICreatedAtCleared returns if the "api_created_at" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) APICreatedAtCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snamespace.FieldAPICreatedAt]
+	return ok
+}
+
+// ResetAPICreatedAt resets all changes to the "api_created_at" field.
+func (m *BronzeHistoryK8sNamespaceMutation) ResetAPICreatedAt() {
+	m.api_created_at = nil
+	delete(m.clearedFields, bronzehistoryk8snamespace.FieldAPICreatedAt)
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sNamespaceMutation builder.
+func (m *BronzeHistoryK8sNamespaceMutation) Where(ps ...predicate.BronzeHistoryK8sNamespace) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeHistoryK8sNamespaceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeHistoryK8sNamespaceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeHistoryK8sNamespace, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeHistoryK8sNamespaceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeHistoryK8sNamespaceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeHistoryK8sNamespace).
+func (m *BronzeHistoryK8sNamespaceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeHistoryK8sNamespaceMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.valid_from != nil {
+		fields = append(fields, bronzehistoryk8snamespace.FieldValidFrom)
+	}
+	if m.valid_to != nil {
+		fields = append(fields, bronzehistoryk8snamespace.FieldValidTo)
+	}
+	if m.collected_at != nil {
+		fields = append(fields, bronzehistoryk8snamespace.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzehistoryk8snamespace.FieldFirstCollectedAt)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, bronzehistoryk8snamespace.FieldResourceID)
+	}
+	if m.cluster_name != nil {
+		fields = append(fields, bronzehistoryk8snamespace.FieldClusterName)
+	}
+	if m.name != nil {
+		fields = append(fields, bronzehistoryk8snamespace.FieldName)
+	}
+	if m.uid != nil {
+		fields = append(fields, bronzehistoryk8snamespace.FieldUID)
+	}
+	if m.phase != nil {
+		fields = append(fields, bronzehistoryk8snamespace.FieldPhase)
+	}
+	if m.labels_json != nil {
+		fields = append(fields, bronzehistoryk8snamespace.FieldLabelsJSON)
+	}
+	if m.api_created_at != nil {
+		fields = append(fields, bronzehistoryk8snamespace.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeHistoryK8sNamespaceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryk8snamespace.FieldValidFrom:
+		return m.ValidFrom()
+	case bronzehistoryk8snamespace.FieldValidTo:
+		return m.ValidTo()
+	case bronzehistoryk8snamespace.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzehistoryk8snamespace.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzehistoryk8snamespace.FieldResourceID:
+		return m.ResourceID()
+	case bronzehistoryk8snamespace.FieldClusterName:
+		return m.ClusterName()
+	case bronzehistoryk8snamespace.FieldName:
+		return m.Name()
+	case bronzehistoryk8snamespace.FieldUID:
+		return m.UID()
+	case bronzehistoryk8snamespace.FieldPhase:
+		return m.Phase()
+	case bronzehistoryk8snamespace.FieldLabelsJSON:
+		return m.LabelsJSON()
+	case bronzehistoryk8snamespace.FieldAPICreatedAt:
+		return m.APICreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeHistoryK8sNamespaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzehistoryk8snamespace.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case bronzehistoryk8snamespace.FieldValidTo:
+		return m.OldValidTo(ctx)
+	case bronzehistoryk8snamespace.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzehistoryk8snamespace.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzehistoryk8snamespace.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case bronzehistoryk8snamespace.FieldClusterName:
+		return m.OldClusterName(ctx)
+	case bronzehistoryk8snamespace.FieldName:
+		return m.OldName(ctx)
+	case bronzehistoryk8snamespace.FieldUID:
+		return m.OldUID(ctx)
+	case bronzehistoryk8snamespace.FieldPhase:
+		return m.OldPhase(ctx)
+	case bronzehistoryk8snamespace.FieldLabelsJSON:
+		return m.OldLabelsJSON(ctx)
+	case bronzehistoryk8snamespace.FieldAPICreatedAt:
+		return m.OldAPICreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeHistoryK8sNamespace field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryK8sNamespaceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryk8snamespace.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case bronzehistoryk8snamespace.FieldValidTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidTo(v)
+		return nil
+	case bronzehistoryk8snamespace.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzehistoryk8snamespace.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzehistoryk8snamespace.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case bronzehistoryk8snamespace.FieldClusterName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterName(v)
+		return nil
+	case bronzehistoryk8snamespace.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case bronzehistoryk8snamespace.FieldUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUID(v)
+		return nil
+	case bronzehistoryk8snamespace.FieldPhase:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhase(v)
+		return nil
+	case bronzehistoryk8snamespace.FieldLabelsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabelsJSON(v)
+		return nil
+	case bronzehistoryk8snamespace.FieldAPICreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPICreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sNamespace field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeHistoryK8sNamespaceMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryK8sNamespaceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sNamespace numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzehistoryk8snamespace.FieldValidTo) {
+		fields = append(fields, bronzehistoryk8snamespace.FieldValidTo)
+	}
+	if m.FieldCleared(bronzehistoryk8snamespace.FieldUID) {
+		fields = append(fields, bronzehistoryk8snamespace.FieldUID)
+	}
+	if m.FieldCleared(bronzehistoryk8snamespace.FieldPhase) {
+		fields = append(fields, bronzehistoryk8snamespace.FieldPhase)
+	}
+	if m.FieldCleared(bronzehistoryk8snamespace.FieldLabelsJSON) {
+		fields = append(fields, bronzehistoryk8snamespace.FieldLabelsJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8snamespace.FieldAPICreatedAt) {
+		fields = append(fields, bronzehistoryk8snamespace.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeHistoryK8sNamespaceMutation) ClearField(name string) error {
+	switch name {
+	case bronzehistoryk8snamespace.FieldValidTo:
+		m.ClearValidTo()
+		return nil
+	case bronzehistoryk8snamespace.FieldUID:
+		m.ClearUID()
+		return nil
+	case bronzehistoryk8snamespace.FieldPhase:
+		m.ClearPhase()
+		return nil
+	case bronzehistoryk8snamespace.FieldLabelsJSON:
+		m.ClearLabelsJSON()
+		return nil
+	case bronzehistoryk8snamespace.FieldAPICreatedAt:
+		m.ClearAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sNamespace nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeHistoryK8sNamespaceMutation) ResetField(name string) error {
+	switch name {
+	case bronzehistoryk8snamespace.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case bronzehistoryk8snamespace.FieldValidTo:
+		m.ResetValidTo()
+		return nil
+	case bronzehistoryk8snamespace.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzehistoryk8snamespace.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzehistoryk8snamespace.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case bronzehistoryk8snamespace.FieldClusterName:
+		m.ResetClusterName()
+		return nil
+	case bronzehistoryk8snamespace.FieldName:
+		m.ResetName()
+		return nil
+	case bronzehistoryk8snamespace.FieldUID:
+		m.ResetUID()
+		return nil
+	case bronzehistoryk8snamespace.FieldPhase:
+		m.ResetPhase()
+		return nil
+	case bronzehistoryk8snamespace.FieldLabelsJSON:
+		m.ResetLabelsJSON()
+		return nil
+	case bronzehistoryk8snamespace.FieldAPICreatedAt:
+		m.ResetAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sNamespace field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeHistoryK8sNamespaceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeHistoryK8sNamespaceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryK8sNamespace unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeHistoryK8sNamespaceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryK8sNamespace edge %s", name)
+}
+
+// BronzeHistoryK8sNetworkPolicyMutation represents an operation that mutates the BronzeHistoryK8sNetworkPolicy nodes in the graph.
+type BronzeHistoryK8sNetworkPolicyMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uint
+	valid_from              *time.Time
+	valid_to                *time.Time
+	collected_at            *time.Time
+	first_collected_at      *time.Time
+	resource_id             *string
+	cluster_name            *string
+	namespace               *string
+	name                    *string
+	uid                     *string
+	pod_selector_json       *json.RawMessage
+	appendpod_selector_json json.RawMessage
+	policy_types_json       *json.RawMessage
+	appendpolicy_types_json json.RawMessage
+	ingress_json            *json.RawMessage
+	appendingress_json      json.RawMessage
+	egress_json             *json.RawMessage
+	appendegress_json       json.RawMessage
+	labels_json             *json.RawMessage
+	appendlabels_json       json.RawMessage
+	api_created_at          *time.Time
+	clearedFields           map[string]struct{}
+	done                    bool
+	oldValue                func(context.Context) (*BronzeHistoryK8sNetworkPolicy, error)
+	predicates              []predicate.BronzeHistoryK8sNetworkPolicy
+}
+
+var _ ent.Mutation = (*BronzeHistoryK8sNetworkPolicyMutation)(nil)
+
+// bronzehistoryk8snetworkpolicyOption allows management of the mutation configuration using functional options.
+type bronzehistoryk8snetworkpolicyOption func(*BronzeHistoryK8sNetworkPolicyMutation)
+
+// newBronzeHistoryK8sNetworkPolicyMutation creates new mutation for the BronzeHistoryK8sNetworkPolicy entity.
+func newBronzeHistoryK8sNetworkPolicyMutation(c config, op Op, opts ...bronzehistoryk8snetworkpolicyOption) *BronzeHistoryK8sNetworkPolicyMutation {
+	m := &BronzeHistoryK8sNetworkPolicyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeHistoryK8sNetworkPolicy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeHistoryK8sNetworkPolicyID sets the ID field of the mutation.
+func withBronzeHistoryK8sNetworkPolicyID(id uint) bronzehistoryk8snetworkpolicyOption {
+	return func(m *BronzeHistoryK8sNetworkPolicyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeHistoryK8sNetworkPolicy
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeHistoryK8sNetworkPolicy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeHistoryK8sNetworkPolicy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeHistoryK8sNetworkPolicy sets the old BronzeHistoryK8sNetworkPolicy of the mutation.
+func withBronzeHistoryK8sNetworkPolicy(node *BronzeHistoryK8sNetworkPolicy) bronzehistoryk8snetworkpolicyOption {
+	return func(m *BronzeHistoryK8sNetworkPolicyMutation) {
+		m.oldValue = func(context.Context) (*BronzeHistoryK8sNetworkPolicy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeHistoryK8sNetworkPolicyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeHistoryK8sNetworkPolicyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("kubernetes: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeHistoryK8sNetworkPolicy entities.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeHistoryK8sNetworkPolicy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the BronzeHistoryK8sNetworkPolicy entity.
+// If the BronzeHistoryK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidTo sets the "valid_to" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) SetValidTo(t time.Time) {
+	m.valid_to = &t
+}
+
+// ValidTo returns the value of the "valid_to" field in the mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ValidTo() (r time.Time, exists bool) {
+	v := m.valid_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidTo returns the old "valid_to" field's value of the BronzeHistoryK8sNetworkPolicy entity.
+// If the BronzeHistoryK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) OldValidTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidTo: %w", err)
+	}
+	return oldValue.ValidTo, nil
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ClearValidTo() {
+	m.valid_to = nil
+	m.clearedFields[bronzehistoryk8snetworkpolicy.FieldValidTo] = struct{}{}
+}
+
+// ValidToCleared returns if the "valid_to" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ValidToCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snetworkpolicy.FieldValidTo]
+	return ok
+}
+
+// ResetValidTo resets all changes to the "valid_to" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ResetValidTo() {
+	m.valid_to = nil
+	delete(m.clearedFields, bronzehistoryk8snetworkpolicy.FieldValidTo)
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeHistoryK8sNetworkPolicy entity.
+// If the BronzeHistoryK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeHistoryK8sNetworkPolicy entity.
+// If the BronzeHistoryK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the BronzeHistoryK8sNetworkPolicy entity.
+// If the BronzeHistoryK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) SetClusterName(s string) {
+	m.cluster_name = &s
+}
+
+// ClusterName returns the value of the "cluster_name" field in the mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ClusterName() (r string, exists bool) {
+	v := m.cluster_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClusterName returns the old "cluster_name" field's value of the BronzeHistoryK8sNetworkPolicy entity.
+// If the BronzeHistoryK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) OldClusterName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterName: %w", err)
+	}
+	return oldValue.ClusterName, nil
+}
+
+// ResetClusterName resets all changes to the "cluster_name" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ResetClusterName() {
+	m.cluster_name = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the BronzeHistoryK8sNetworkPolicy entity.
+// If the BronzeHistoryK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetName sets the "name" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the BronzeHistoryK8sNetworkPolicy entity.
+// If the BronzeHistoryK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUID sets the "uid" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) SetUID(s string) {
+	m.uid = &s
+}
+
+// UID returns the value of the "uid" field in the mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) UID() (r string, exists bool) {
+	v := m.uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUID returns the old "uid" field's value of the BronzeHistoryK8sNetworkPolicy entity.
+// If the BronzeHistoryK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) OldUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+	}
+	return oldValue.UID, nil
+}
+
+// ClearUID clears the value of the "uid" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ClearUID() {
+	m.uid = nil
+	m.clearedFields[bronzehistoryk8snetworkpolicy.FieldUID] = struct{}{}
+}
+
+// UIDCleared returns if the "uid" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) UIDCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snetworkpolicy.FieldUID]
+	return ok
+}
+
+// ResetUID resets all changes to the "uid" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ResetUID() {
+	m.uid = nil
+	delete(m.clearedFields, bronzehistoryk8snetworkpolicy.FieldUID)
+}
+
+// SetPodSelectorJSON sets the "pod_selector_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) SetPodSelectorJSON(jm json.RawMessage) {
+	m.pod_selector_json = &jm
+	m.appendpod_selector_json = nil
+}
+
+// PodSelectorJSON returns the value of the "pod_selector_json" field in the mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) PodSelectorJSON() (r json.RawMessage, exists bool) {
+	v := m.pod_selector_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPodSelectorJSON returns the old "pod_selector_json" field's value of the BronzeHistoryK8sNetworkPolicy entity.
+// If the BronzeHistoryK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) OldPodSelectorJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPodSelectorJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPodSelectorJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPodSelectorJSON: %w", err)
+	}
+	return oldValue.PodSelectorJSON, nil
+}
+
+// AppendPodSelectorJSON adds jm to the "pod_selector_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) AppendPodSelectorJSON(jm json.RawMessage) {
+	m.appendpod_selector_json = append(m.appendpod_selector_json, jm...)
+}
+
+// AppendedPodSelectorJSON returns the list of values that were appended to the "pod_selector_json" field in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) AppendedPodSelectorJSON() (json.RawMessage, bool) {
+	if len(m.appendpod_selector_json) == 0 {
+		return nil, false
+	}
+	return m.appendpod_selector_json, true
+}
+
+// ClearPodSelectorJSON clears the value of the "pod_selector_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ClearPodSelectorJSON() {
+	m.pod_selector_json = nil
+	m.appendpod_selector_json = nil
+	m.clearedFields[bronzehistoryk8snetworkpolicy.FieldPodSelectorJSON] = struct{}{}
+}
+
+// PodSelectorJSONCleared returns if the "pod_selector_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) PodSelectorJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snetworkpolicy.FieldPodSelectorJSON]
+	return ok
+}
+
+// ResetPodSelectorJSON resets all changes to the "pod_selector_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ResetPodSelectorJSON() {
+	m.pod_selector_json = nil
+	m.appendpod_selector_json = nil
+	delete(m.clearedFields, bronzehistoryk8snetworkpolicy.FieldPodSelectorJSON)
+}
+
+// SetPolicyTypesJSON sets the "policy_types_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) SetPolicyTypesJSON(jm json.RawMessage) {
+	m.policy_types_json = &jm
+	m.appendpolicy_types_json = nil
+}
+
+// PolicyTypesJSON returns the value of the "policy_types_json" field in the mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) PolicyTypesJSON() (r json.RawMessage, exists bool) {
+	v := m.policy_types_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolicyTypesJSON returns the old "policy_types_json" field's value of the BronzeHistoryK8sNetworkPolicy entity.
+// If the BronzeHistoryK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) OldPolicyTypesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicyTypesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicyTypesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicyTypesJSON: %w", err)
+	}
+	return oldValue.PolicyTypesJSON, nil
+}
+
+// AppendPolicyTypesJSON adds jm to the "policy_types_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) AppendPolicyTypesJSON(jm json.RawMessage) {
+	m.appendpolicy_types_json = append(m.appendpolicy_types_json, jm...)
+}
+
+// AppendedPolicyTypesJSON returns the list of values that were appended to the "policy_types_json" field in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) AppendedPolicyTypesJSON() (json.RawMessage, bool) {
+	if len(m.appendpolicy_types_json) == 0 {
+		return nil, false
+	}
+	return m.appendpolicy_types_json, true
+}
+
+// ClearPolicyTypesJSON clears the value of the "policy_types_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ClearPolicyTypesJSON() {
+	m.policy_types_json = nil
+	m.appendpolicy_types_json = nil
+	m.clearedFields[bronzehistoryk8snetworkpolicy.FieldPolicyTypesJSON] = struct{}{}
+}
+
+// PolicyTypesJSONCleared returns if the "policy_types_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) PolicyTypesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snetworkpolicy.FieldPolicyTypesJSON]
+	return ok
+}
+
+// ResetPolicyTypesJSON resets all changes to the "policy_types_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ResetPolicyTypesJSON() {
+	m.policy_types_json = nil
+	m.appendpolicy_types_json = nil
+	delete(m.clearedFields, bronzehistoryk8snetworkpolicy.FieldPolicyTypesJSON)
+}
+
+// SetIngressJSON sets the "ingress_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) SetIngressJSON(jm json.RawMessage) {
+	m.ingress_json = &jm
+	m.appendingress_json = nil
+}
+
+// IngressJSON returns the value of the "ingress_json" field in the mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) IngressJSON() (r json.RawMessage, exists bool) {
+	v := m.ingress_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIngressJSON returns the old "ingress_json" field's value of the BronzeHistoryK8sNetworkPolicy entity.
+// If the BronzeHistoryK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) OldIngressJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIngressJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIngressJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIngressJSON: %w", err)
+	}
+	return oldValue.IngressJSON, nil
+}
+
+// AppendIngressJSON adds jm to the "ingress_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) AppendIngressJSON(jm json.RawMessage) {
+	m.appendingress_json = append(m.appendingress_json, jm...)
+}
+
+// AppendedIngressJSON returns the list of values that were appended to the "ingress_json" field in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) AppendedIngressJSON() (json.RawMessage, bool) {
+	if len(m.appendingress_json) == 0 {
+		return nil, false
+	}
+	return m.appendingress_json, true
+}
+
+// ClearIngressJSON clears the value of the "ingress_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ClearIngressJSON() {
+	m.ingress_json = nil
+	m.appendingress_json = nil
+	m.clearedFields[bronzehistoryk8snetworkpolicy.FieldIngressJSON] = struct{}{}
+}
+
+// IngressJSONCleared returns if the "ingress_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) IngressJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snetworkpolicy.FieldIngressJSON]
+	return ok
+}
+
+// ResetIngressJSON resets all changes to the "ingress_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ResetIngressJSON() {
+	m.ingress_json = nil
+	m.appendingress_json = nil
+	delete(m.clearedFields, bronzehistoryk8snetworkpolicy.FieldIngressJSON)
+}
+
+// SetEgressJSON sets the "egress_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) SetEgressJSON(jm json.RawMessage) {
+	m.egress_json = &jm
+	m.appendegress_json = nil
+}
+
+// EgressJSON returns the value of the "egress_json" field in the mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) EgressJSON() (r json.RawMessage, exists bool) {
+	v := m.egress_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEgressJSON returns the old "egress_json" field's value of the BronzeHistoryK8sNetworkPolicy entity.
+// If the BronzeHistoryK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) OldEgressJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEgressJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEgressJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEgressJSON: %w", err)
+	}
+	return oldValue.EgressJSON, nil
+}
+
+// AppendEgressJSON adds jm to the "egress_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) AppendEgressJSON(jm json.RawMessage) {
+	m.appendegress_json = append(m.appendegress_json, jm...)
+}
+
+// AppendedEgressJSON returns the list of values that were appended to the "egress_json" field in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) AppendedEgressJSON() (json.RawMessage, bool) {
+	if len(m.appendegress_json) == 0 {
+		return nil, false
+	}
+	return m.appendegress_json, true
+}
+
+// ClearEgressJSON clears the value of the "egress_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ClearEgressJSON() {
+	m.egress_json = nil
+	m.appendegress_json = nil
+	m.clearedFields[bronzehistoryk8snetworkpolicy.FieldEgressJSON] = struct{}{}
+}
+
+// EgressJSONCleared returns if the "egress_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) EgressJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snetworkpolicy.FieldEgressJSON]
+	return ok
+}
+
+// ResetEgressJSON resets all changes to the "egress_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ResetEgressJSON() {
+	m.egress_json = nil
+	m.appendegress_json = nil
+	delete(m.clearedFields, bronzehistoryk8snetworkpolicy.FieldEgressJSON)
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) SetLabelsJSON(jm json.RawMessage) {
+	m.labels_json = &jm
+	m.appendlabels_json = nil
+}
+
+// LabelsJSON returns the value of the "labels_json" field in the mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) LabelsJSON() (r json.RawMessage, exists bool) {
+	v := m.labels_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabelsJSON returns the old "labels_json" field's value of the BronzeHistoryK8sNetworkPolicy entity.
+// If the BronzeHistoryK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) OldLabelsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabelsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabelsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabelsJSON: %w", err)
+	}
+	return oldValue.LabelsJSON, nil
+}
+
+// AppendLabelsJSON adds jm to the "labels_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) AppendLabelsJSON(jm json.RawMessage) {
+	m.appendlabels_json = append(m.appendlabels_json, jm...)
+}
+
+// AppendedLabelsJSON returns the list of values that were appended to the "labels_json" field in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) AppendedLabelsJSON() (json.RawMessage, bool) {
+	if len(m.appendlabels_json) == 0 {
+		return nil, false
+	}
+	return m.appendlabels_json, true
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ClearLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	m.clearedFields[bronzehistoryk8snetworkpolicy.FieldLabelsJSON] = struct{}{}
+}
+
+// LabelsJSONCleared returns if the "labels_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) LabelsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snetworkpolicy.FieldLabelsJSON]
+	return ok
+}
+
+// ResetLabelsJSON resets all changes to the "labels_json" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ResetLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	delete(m.clearedFields, bronzehistoryk8snetworkpolicy.FieldLabelsJSON)
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) SetAPICreatedAt(t time.Time) {
+	m.api_created_at = &t
+}
+
+// APICreatedAt returns the value of the "api_created_at" field in the mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) APICreatedAt() (r time.Time, exists bool) {
+	v := m.api_created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPICreatedAt returns the old "api_created_at" field's value of the BronzeHistoryK8sNetworkPolicy entity.
+// If the BronzeHistoryK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) OldAPICreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPICreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPICreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPICreatedAt: %w", err)
+	}
+	return oldValue.APICreatedAt, nil
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ClearAPICreatedAt() {
+	m.api_created_at = nil
+	m.clearedFields[bronzehistoryk8snetworkpolicy.FieldAPICreatedAt] = struct{}{}
+}
+
+// APICreatedAtCleared returns if the "api_created_at" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) APICreatedAtCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snetworkpolicy.FieldAPICreatedAt]
+	return ok
+}
+
+// ResetAPICreatedAt resets all changes to the "api_created_at" field.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ResetAPICreatedAt() {
+	m.api_created_at = nil
+	delete(m.clearedFields, bronzehistoryk8snetworkpolicy.FieldAPICreatedAt)
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sNetworkPolicyMutation builder.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) Where(ps ...predicate.BronzeHistoryK8sNetworkPolicy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeHistoryK8sNetworkPolicyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeHistoryK8sNetworkPolicy, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeHistoryK8sNetworkPolicy).
+func (m *BronzeHistoryK8sNetworkPolicyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeHistoryK8sNetworkPolicyMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.valid_from != nil {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldValidFrom)
+	}
+	if m.valid_to != nil {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldValidTo)
+	}
+	if m.collected_at != nil {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldFirstCollectedAt)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldResourceID)
+	}
+	if m.cluster_name != nil {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldClusterName)
+	}
+	if m.namespace != nil {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldNamespace)
+	}
+	if m.name != nil {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldName)
+	}
+	if m.uid != nil {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldUID)
+	}
+	if m.pod_selector_json != nil {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldPodSelectorJSON)
+	}
+	if m.policy_types_json != nil {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldPolicyTypesJSON)
+	}
+	if m.ingress_json != nil {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldIngressJSON)
+	}
+	if m.egress_json != nil {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldEgressJSON)
+	}
+	if m.labels_json != nil {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldLabelsJSON)
+	}
+	if m.api_created_at != nil {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryk8snetworkpolicy.FieldValidFrom:
+		return m.ValidFrom()
+	case bronzehistoryk8snetworkpolicy.FieldValidTo:
+		return m.ValidTo()
+	case bronzehistoryk8snetworkpolicy.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzehistoryk8snetworkpolicy.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzehistoryk8snetworkpolicy.FieldResourceID:
+		return m.ResourceID()
+	case bronzehistoryk8snetworkpolicy.FieldClusterName:
+		return m.ClusterName()
+	case bronzehistoryk8snetworkpolicy.FieldNamespace:
+		return m.Namespace()
+	case bronzehistoryk8snetworkpolicy.FieldName:
+		return m.Name()
+	case bronzehistoryk8snetworkpolicy.FieldUID:
+		return m.UID()
+	case bronzehistoryk8snetworkpolicy.FieldPodSelectorJSON:
+		return m.PodSelectorJSON()
+	case bronzehistoryk8snetworkpolicy.FieldPolicyTypesJSON:
+		return m.PolicyTypesJSON()
+	case bronzehistoryk8snetworkpolicy.FieldIngressJSON:
+		return m.IngressJSON()
+	case bronzehistoryk8snetworkpolicy.FieldEgressJSON:
+		return m.EgressJSON()
+	case bronzehistoryk8snetworkpolicy.FieldLabelsJSON:
+		return m.LabelsJSON()
+	case bronzehistoryk8snetworkpolicy.FieldAPICreatedAt:
+		return m.APICreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzehistoryk8snetworkpolicy.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case bronzehistoryk8snetworkpolicy.FieldValidTo:
+		return m.OldValidTo(ctx)
+	case bronzehistoryk8snetworkpolicy.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzehistoryk8snetworkpolicy.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzehistoryk8snetworkpolicy.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case bronzehistoryk8snetworkpolicy.FieldClusterName:
+		return m.OldClusterName(ctx)
+	case bronzehistoryk8snetworkpolicy.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case bronzehistoryk8snetworkpolicy.FieldName:
+		return m.OldName(ctx)
+	case bronzehistoryk8snetworkpolicy.FieldUID:
+		return m.OldUID(ctx)
+	case bronzehistoryk8snetworkpolicy.FieldPodSelectorJSON:
+		return m.OldPodSelectorJSON(ctx)
+	case bronzehistoryk8snetworkpolicy.FieldPolicyTypesJSON:
+		return m.OldPolicyTypesJSON(ctx)
+	case bronzehistoryk8snetworkpolicy.FieldIngressJSON:
+		return m.OldIngressJSON(ctx)
+	case bronzehistoryk8snetworkpolicy.FieldEgressJSON:
+		return m.OldEgressJSON(ctx)
+	case bronzehistoryk8snetworkpolicy.FieldLabelsJSON:
+		return m.OldLabelsJSON(ctx)
+	case bronzehistoryk8snetworkpolicy.FieldAPICreatedAt:
+		return m.OldAPICreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeHistoryK8sNetworkPolicy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryk8snetworkpolicy.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldValidTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidTo(v)
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldClusterName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterName(v)
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUID(v)
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldPodSelectorJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPodSelectorJSON(v)
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldPolicyTypesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicyTypesJSON(v)
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldIngressJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIngressJSON(v)
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldEgressJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEgressJSON(v)
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldLabelsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabelsJSON(v)
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldAPICreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPICreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sNetworkPolicy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sNetworkPolicy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzehistoryk8snetworkpolicy.FieldValidTo) {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldValidTo)
+	}
+	if m.FieldCleared(bronzehistoryk8snetworkpolicy.FieldUID) {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldUID)
+	}
+	if m.FieldCleared(bronzehistoryk8snetworkpolicy.FieldPodSelectorJSON) {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldPodSelectorJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8snetworkpolicy.FieldPolicyTypesJSON) {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldPolicyTypesJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8snetworkpolicy.FieldIngressJSON) {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldIngressJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8snetworkpolicy.FieldEgressJSON) {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldEgressJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8snetworkpolicy.FieldLabelsJSON) {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldLabelsJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8snetworkpolicy.FieldAPICreatedAt) {
+		fields = append(fields, bronzehistoryk8snetworkpolicy.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ClearField(name string) error {
+	switch name {
+	case bronzehistoryk8snetworkpolicy.FieldValidTo:
+		m.ClearValidTo()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldUID:
+		m.ClearUID()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldPodSelectorJSON:
+		m.ClearPodSelectorJSON()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldPolicyTypesJSON:
+		m.ClearPolicyTypesJSON()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldIngressJSON:
+		m.ClearIngressJSON()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldEgressJSON:
+		m.ClearEgressJSON()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldLabelsJSON:
+		m.ClearLabelsJSON()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldAPICreatedAt:
+		m.ClearAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sNetworkPolicy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ResetField(name string) error {
+	switch name {
+	case bronzehistoryk8snetworkpolicy.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldValidTo:
+		m.ResetValidTo()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldClusterName:
+		m.ResetClusterName()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldName:
+		m.ResetName()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldUID:
+		m.ResetUID()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldPodSelectorJSON:
+		m.ResetPodSelectorJSON()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldPolicyTypesJSON:
+		m.ResetPolicyTypesJSON()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldIngressJSON:
+		m.ResetIngressJSON()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldEgressJSON:
+		m.ResetEgressJSON()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldLabelsJSON:
+		m.ResetLabelsJSON()
+		return nil
+	case bronzehistoryk8snetworkpolicy.FieldAPICreatedAt:
+		m.ResetAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sNetworkPolicy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryK8sNetworkPolicy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeHistoryK8sNetworkPolicyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryK8sNetworkPolicy edge %s", name)
+}
+
+// BronzeHistoryK8sNodeMutation represents an operation that mutates the BronzeHistoryK8sNode nodes in the graph.
+type BronzeHistoryK8sNodeMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uint
+	valid_from         *time.Time
+	valid_to           *time.Time
+	collected_at       *time.Time
+	first_collected_at *time.Time
+	resource_id        *string
+	cluster_name       *string
+	name               *string
+	uid                *string
+	provider_id        *string
+	internal_ip        *string
+	external_ip        *string
+	node_pool          *string
+	instance_type      *string
+	region             *string
+	zone               *string
+	kubelet_version    *string
+	os_image           *string
+	kernel_version     *string
+	container_runtime  *string
+	architecture       *string
+	ready              *bool
+	unschedulable      *bool
+	labels_json        *json.RawMessage
+	appendlabels_json  json.RawMessage
+	taints_json        *json.RawMessage
+	appendtaints_json  json.RawMessage
+	api_created_at     *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*BronzeHistoryK8sNode, error)
+	predicates         []predicate.BronzeHistoryK8sNode
+}
+
+var _ ent.Mutation = (*BronzeHistoryK8sNodeMutation)(nil)
+
+// bronzehistoryk8snodeOption allows management of the mutation configuration using functional options.
+type bronzehistoryk8snodeOption func(*BronzeHistoryK8sNodeMutation)
+
+// newBronzeHistoryK8sNodeMutation creates new mutation for the BronzeHistoryK8sNode entity.
+func newBronzeHistoryK8sNodeMutation(c config, op Op, opts ...bronzehistoryk8snodeOption) *BronzeHistoryK8sNodeMutation {
+	m := &BronzeHistoryK8sNodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeHistoryK8sNode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeHistoryK8sNodeID sets the ID field of the mutation.
+func withBronzeHistoryK8sNodeID(id uint) bronzehistoryk8snodeOption {
+	return func(m *BronzeHistoryK8sNodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeHistoryK8sNode
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeHistoryK8sNode, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeHistoryK8sNode.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeHistoryK8sNode sets the old BronzeHistoryK8sNode of the mutation.
+func withBronzeHistoryK8sNode(node *BronzeHistoryK8sNode) bronzehistoryk8snodeOption {
+	return func(m *BronzeHistoryK8sNodeMutation) {
+		m.oldValue = func(context.Context) (*BronzeHistoryK8sNode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeHistoryK8sNodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeHistoryK8sNodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("kubernetes: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeHistoryK8sNode entities.
+func (m *BronzeHistoryK8sNodeMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeHistoryK8sNodeMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeHistoryK8sNodeMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeHistoryK8sNode.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *BronzeHistoryK8sNodeMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidTo sets the "valid_to" field.
+func (m *BronzeHistoryK8sNodeMutation) SetValidTo(t time.Time) {
+	m.valid_to = &t
+}
+
+// ValidTo returns the value of the "valid_to" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) ValidTo() (r time.Time, exists bool) {
+	v := m.valid_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidTo returns the old "valid_to" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldValidTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidTo: %w", err)
+	}
+	return oldValue.ValidTo, nil
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (m *BronzeHistoryK8sNodeMutation) ClearValidTo() {
+	m.valid_to = nil
+	m.clearedFields[bronzehistoryk8snode.FieldValidTo] = struct{}{}
+}
+
+// ValidToCleared returns if the "valid_to" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) ValidToCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snode.FieldValidTo]
+	return ok
+}
+
+// ResetValidTo resets all changes to the "valid_to" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetValidTo() {
+	m.valid_to = nil
+	delete(m.clearedFields, bronzehistoryk8snode.FieldValidTo)
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeHistoryK8sNodeMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeHistoryK8sNodeMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *BronzeHistoryK8sNodeMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (m *BronzeHistoryK8sNodeMutation) SetClusterName(s string) {
+	m.cluster_name = &s
+}
+
+// ClusterName returns the value of the "cluster_name" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) ClusterName() (r string, exists bool) {
+	v := m.cluster_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClusterName returns the old "cluster_name" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldClusterName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterName: %w", err)
+	}
+	return oldValue.ClusterName, nil
+}
+
+// ResetClusterName resets all changes to the "cluster_name" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetClusterName() {
+	m.cluster_name = nil
+}
+
+// SetName sets the "name" field.
+func (m *BronzeHistoryK8sNodeMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUID sets the "uid" field.
+func (m *BronzeHistoryK8sNodeMutation) SetUID(s string) {
+	m.uid = &s
+}
+
+// UID returns the value of the "uid" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) UID() (r string, exists bool) {
+	v := m.uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUID returns the old "uid" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+	}
+	return oldValue.UID, nil
+}
+
+// ClearUID clears the value of the "uid" field.
+func (m *BronzeHistoryK8sNodeMutation) ClearUID() {
+	m.uid = nil
+	m.clearedFields[bronzehistoryk8snode.FieldUID] = struct{}{}
+}
+
+// UIDCleared returns if the "uid" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) UIDCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snode.FieldUID]
+	return ok
+}
+
+// ResetUID resets all changes to the "uid" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetUID() {
+	m.uid = nil
+	delete(m.clearedFields, bronzehistoryk8snode.FieldUID)
+}
+
+// SetProviderID sets the "provider_id" field.
+func (m *BronzeHistoryK8sNodeMutation) SetProviderID(s string) {
+	m.provider_id = &s
+}
+
+// ProviderID returns the value of the "provider_id" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) ProviderID() (r string, exists bool) {
+	v := m.provider_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProviderID returns the old "provider_id" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldProviderID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProviderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProviderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProviderID: %w", err)
+	}
+	return oldValue.ProviderID, nil
+}
+
+// ClearProviderID clears the value of the "provider_id" field.
+func (m *BronzeHistoryK8sNodeMutation) ClearProviderID() {
+	m.provider_id = nil
+	m.clearedFields[bronzehistoryk8snode.FieldProviderID] = struct{}{}
+}
+
+// ProviderIDCleared returns if the "provider_id" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) ProviderIDCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snode.FieldProviderID]
+	return ok
+}
+
+// ResetProviderID resets all changes to the "provider_id" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetProviderID() {
+	m.provider_id = nil
+	delete(m.clearedFields, bronzehistoryk8snode.FieldProviderID)
+}
+
+// SetInternalIP sets the "internal_ip" field.
+func (m *BronzeHistoryK8sNodeMutation) SetInternalIP(s string) {
+	m.internal_ip = &s
+}
+
+// InternalIP returns the value of the "internal_ip" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) InternalIP() (r string, exists bool) {
+	v := m.internal_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInternalIP returns the old "internal_ip" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldInternalIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInternalIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInternalIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInternalIP: %w", err)
+	}
+	return oldValue.InternalIP, nil
+}
+
+// ClearInternalIP clears the value of the "internal_ip" field.
+func (m *BronzeHistoryK8sNodeMutation) ClearInternalIP() {
+	m.internal_ip = nil
+	m.clearedFields[bronzehistoryk8snode.FieldInternalIP] = struct{}{}
+}
+
+// InternalIPCleared returns if the "internal_ip" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) InternalIPCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snode.FieldInternalIP]
+	return ok
+}
+
+// ResetInternalIP resets all changes to the "internal_ip" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetInternalIP() {
+	m.internal_ip = nil
+	delete(m.clearedFields, bronzehistoryk8snode.FieldInternalIP)
+}
+
+// SetExternalIP sets the "external_ip" field.
+func (m *BronzeHistoryK8sNodeMutation) SetExternalIP(s string) {
+	m.external_ip = &s
+}
+
+// ExternalIP returns the value of the "external_ip" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) ExternalIP() (r string, exists bool) {
+	v := m.external_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalIP returns the old "external_ip" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldExternalIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalIP: %w", err)
+	}
+	return oldValue.ExternalIP, nil
+}
+
+// ClearExternalIP clears the value of the "external_ip" field.
+func (m *BronzeHistoryK8sNodeMutation) ClearExternalIP() {
+	m.external_ip = nil
+	m.clearedFields[bronzehistoryk8snode.FieldExternalIP] = struct{}{}
+}
+
+// ExternalIPCleared returns if the "external_ip" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) ExternalIPCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snode.FieldExternalIP]
+	return ok
+}
+
+// ResetExternalIP resets all changes to the "external_ip" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetExternalIP() {
+	m.external_ip = nil
+	delete(m.clearedFields, bronzehistoryk8snode.FieldExternalIP)
+}
+
+// SetNodePool sets the "node_pool" field.
+func (m *BronzeHistoryK8sNodeMutation) SetNodePool(s string) {
+	m.node_pool = &s
+}
+
+// NodePool returns the value of the "node_pool" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) NodePool() (r string, exists bool) {
+	v := m.node_pool
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNodePool returns the old "node_pool" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldNodePool(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNodePool is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNodePool requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNodePool: %w", err)
+	}
+	return oldValue.NodePool, nil
+}
+
+// ClearNodePool clears the value of the "node_pool" field.
+func (m *BronzeHistoryK8sNodeMutation) ClearNodePool() {
+	m.node_pool = nil
+	m.clearedFields[bronzehistoryk8snode.FieldNodePool] = struct{}{}
+}
+
+// NodePoolCleared returns if the "node_pool" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) NodePoolCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snode.FieldNodePool]
+	return ok
+}
+
+// ResetNodePool resets all changes to the "node_pool" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetNodePool() {
+	m.node_pool = nil
+	delete(m.clearedFields, bronzehistoryk8snode.FieldNodePool)
+}
+
+// SetInstanceType sets the "instance_type" field.
+func (m *BronzeHistoryK8sNodeMutation) SetInstanceType(s string) {
+	m.instance_type = &s
+}
+
+// InstanceType returns the value of the "instance_type" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) InstanceType() (r string, exists bool) {
+	v := m.instance_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstanceType returns the old "instance_type" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldInstanceType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInstanceType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInstanceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstanceType: %w", err)
+	}
+	return oldValue.InstanceType, nil
+}
+
+// ClearInstanceType clears the value of the "instance_type" field.
+func (m *BronzeHistoryK8sNodeMutation) ClearInstanceType() {
+	m.instance_type = nil
+	m.clearedFields[bronzehistoryk8snode.FieldInstanceType] = struct{}{}
+}
+
+// InstanceTypeCleared returns if the "instance_type" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) InstanceTypeCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snode.FieldInstanceType]
+	return ok
+}
+
+// ResetInstanceType resets all changes to the "instance_type" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetInstanceType() {
+	m.instance_type = nil
+	delete(m.clearedFields, bronzehistoryk8snode.FieldInstanceType)
+}
+
+// SetRegion sets the "region" field.
+func (m *BronzeHistoryK8sNodeMutation) SetRegion(s string) {
+	m.region = &s
+}
+
+// Region returns the value of the "region" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) Region() (r string, exists bool) {
+	v := m.region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegion returns the old "region" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
+	}
+	return oldValue.Region, nil
+}
+
+// ClearRegion clears the value of the "region" field.
+func (m *BronzeHistoryK8sNodeMutation) ClearRegion() {
+	m.region = nil
+	m.clearedFields[bronzehistoryk8snode.FieldRegion] = struct{}{}
+}
+
+// RegionCleared returns if the "region" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) RegionCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snode.FieldRegion]
+	return ok
+}
+
+// ResetRegion resets all changes to the "region" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetRegion() {
+	m.region = nil
+	delete(m.clearedFields, bronzehistoryk8snode.FieldRegion)
+}
+
+// SetZone sets the "zone" field.
+func (m *BronzeHistoryK8sNodeMutation) SetZone(s string) {
+	m.zone = &s
+}
+
+// Zone returns the value of the "zone" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) Zone() (r string, exists bool) {
+	v := m.zone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldZone returns the old "zone" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldZone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldZone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldZone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldZone: %w", err)
+	}
+	return oldValue.Zone, nil
+}
+
+// ClearZone clears the value of the "zone" field.
+func (m *BronzeHistoryK8sNodeMutation) ClearZone() {
+	m.zone = nil
+	m.clearedFields[bronzehistoryk8snode.FieldZone] = struct{}{}
+}
+
+// ZoneCleared returns if the "zone" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) ZoneCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snode.FieldZone]
+	return ok
+}
+
+// ResetZone resets all changes to the "zone" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetZone() {
+	m.zone = nil
+	delete(m.clearedFields, bronzehistoryk8snode.FieldZone)
+}
+
+// SetKubeletVersion sets the "kubelet_version" field.
+func (m *BronzeHistoryK8sNodeMutation) SetKubeletVersion(s string) {
+	m.kubelet_version = &s
+}
+
+// KubeletVersion returns the value of the "kubelet_version" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) KubeletVersion() (r string, exists bool) {
+	v := m.kubelet_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKubeletVersion returns the old "kubelet_version" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldKubeletVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKubeletVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKubeletVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKubeletVersion: %w", err)
+	}
+	return oldValue.KubeletVersion, nil
+}
+
+// ClearKubeletVersion clears the value of the "kubelet_version" field.
+func (m *BronzeHistoryK8sNodeMutation) ClearKubeletVersion() {
+	m.kubelet_version = nil
+	m.clearedFields[bronzehistoryk8snode.FieldKubeletVersion] = struct{}{}
+}
+
+// KubeletVersionCleared returns if the "kubelet_version" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) KubeletVersionCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snode.FieldKubeletVersion]
+	return ok
+}
+
+// ResetKubeletVersion resets all changes to the "kubelet_version" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetKubeletVersion() {
+	m.kubelet_version = nil
+	delete(m.clearedFields, bronzehistoryk8snode.FieldKubeletVersion)
+}
+
+// SetOsImage sets the "os_image" field.
+func (m *BronzeHistoryK8sNodeMutation) SetOsImage(s string) {
+	m.os_image = &s
+}
+
+// OsImage returns the value of the "os_image" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) OsImage() (r string, exists bool) {
+	v := m.os_image
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOsImage returns the old "os_image" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldOsImage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOsImage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOsImage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOsImage: %w", err)
+	}
+	return oldValue.OsImage, nil
+}
+
+// ClearOsImage clears the value of the "os_image" field.
+func (m *BronzeHistoryK8sNodeMutation) ClearOsImage() {
+	m.os_image = nil
+	m.clearedFields[bronzehistoryk8snode.FieldOsImage] = struct{}{}
+}
+
+// OsImageCleared returns if the "os_image" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) OsImageCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snode.FieldOsImage]
+	return ok
+}
+
+// ResetOsImage resets all changes to the "os_image" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetOsImage() {
+	m.os_image = nil
+	delete(m.clearedFields, bronzehistoryk8snode.FieldOsImage)
+}
+
+// SetKernelVersion sets the "kernel_version" field.
+func (m *BronzeHistoryK8sNodeMutation) SetKernelVersion(s string) {
+	m.kernel_version = &s
+}
+
+// KernelVersion returns the value of the "kernel_version" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) KernelVersion() (r string, exists bool) {
+	v := m.kernel_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKernelVersion returns the old "kernel_version" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldKernelVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKernelVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKernelVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKernelVersion: %w", err)
+	}
+	return oldValue.KernelVersion, nil
+}
+
+// ClearKernelVersion clears the value of the "kernel_version" field.
+func (m *BronzeHistoryK8sNodeMutation) ClearKernelVersion() {
+	m.kernel_version = nil
+	m.clearedFields[bronzehistoryk8snode.FieldKernelVersion] = struct{}{}
+}
+
+// KernelVersionCleared returns if the "kernel_version" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) KernelVersionCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snode.FieldKernelVersion]
+	return ok
+}
+
+// ResetKernelVersion resets all changes to the "kernel_version" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetKernelVersion() {
+	m.kernel_version = nil
+	delete(m.clearedFields, bronzehistoryk8snode.FieldKernelVersion)
+}
+
+// SetContainerRuntime sets the "container_runtime" field.
+func (m *BronzeHistoryK8sNodeMutation) SetContainerRuntime(s string) {
+	m.container_runtime = &s
+}
+
+// ContainerRuntime returns the value of the "container_runtime" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) ContainerRuntime() (r string, exists bool) {
+	v := m.container_runtime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContainerRuntime returns the old "container_runtime" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldContainerRuntime(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContainerRuntime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContainerRuntime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContainerRuntime: %w", err)
+	}
+	return oldValue.ContainerRuntime, nil
+}
+
+// ClearContainerRuntime clears the value of the "container_runtime" field.
+func (m *BronzeHistoryK8sNodeMutation) ClearContainerRuntime() {
+	m.container_runtime = nil
+	m.clearedFields[bronzehistoryk8snode.FieldContainerRuntime] = struct{}{}
+}
+
+// ContainerRuntimeCleared returns if the "container_runtime" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) ContainerRuntimeCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snode.FieldContainerRuntime]
+	return ok
+}
+
+// ResetContainerRuntime resets all changes to the "container_runtime" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetContainerRuntime() {
+	m.container_runtime = nil
+	delete(m.clearedFields, bronzehistoryk8snode.FieldContainerRuntime)
+}
+
+// SetArchitecture sets the "architecture" field.
+func (m *BronzeHistoryK8sNodeMutation) SetArchitecture(s string) {
+	m.architecture = &s
+}
+
+// Architecture returns the value of the "architecture" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) Architecture() (r string, exists bool) {
+	v := m.architecture
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchitecture returns the old "architecture" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldArchitecture(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchitecture is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchitecture requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchitecture: %w", err)
+	}
+	return oldValue.Architecture, nil
+}
+
+// ClearArchitecture clears the value of the "architecture" field.
+func (m *BronzeHistoryK8sNodeMutation) ClearArchitecture() {
+	m.architecture = nil
+	m.clearedFields[bronzehistoryk8snode.FieldArchitecture] = struct{}{}
+}
+
+// ArchitectureCleared returns if the "architecture" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) ArchitectureCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snode.FieldArchitecture]
+	return ok
+}
+
+// ResetArchitecture resets all changes to the "architecture" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetArchitecture() {
+	m.architecture = nil
+	delete(m.clearedFields, bronzehistoryk8snode.FieldArchitecture)
+}
+
+// SetReady sets the "ready" field.
+func (m *BronzeHistoryK8sNodeMutation) SetReady(b bool) {
+	m.ready = &b
+}
+
+// Ready returns the value of the "ready" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) Ready() (r bool, exists bool) {
+	v := m.ready
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReady returns the old "ready" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldReady(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReady is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReady requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReady: %w", err)
+	}
+	return oldValue.Ready, nil
+}
+
+// ResetReady resets all changes to the "ready" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetReady() {
+	m.ready = nil
+}
+
+// SetUnschedulable sets the "unschedulable" field.
+func (m *BronzeHistoryK8sNodeMutation) SetUnschedulable(b bool) {
+	m.unschedulable = &b
+}
+
+// Unschedulable returns the value of the "unschedulable" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) Unschedulable() (r bool, exists bool) {
+	v := m.unschedulable
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnschedulable returns the old "unschedulable" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldUnschedulable(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnschedulable is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnschedulable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnschedulable: %w", err)
+	}
+	return oldValue.Unschedulable, nil
+}
+
+// ResetUnschedulable resets all changes to the "unschedulable" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetUnschedulable() {
+	m.unschedulable = nil
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (m *BronzeHistoryK8sNodeMutation) SetLabelsJSON(jm json.RawMessage) {
+	m.labels_json = &jm
+	m.appendlabels_json = nil
+}
+
+// LabelsJSON returns the value of the "labels_json" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) LabelsJSON() (r json.RawMessage, exists bool) {
+	v := m.labels_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabelsJSON returns the old "labels_json" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldLabelsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabelsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabelsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabelsJSON: %w", err)
+	}
+	return oldValue.LabelsJSON, nil
+}
+
+// AppendLabelsJSON adds jm to the "labels_json" field.
+func (m *BronzeHistoryK8sNodeMutation) AppendLabelsJSON(jm json.RawMessage) {
+	m.appendlabels_json = append(m.appendlabels_json, jm...)
+}
+
+// AppendedLabelsJSON returns the list of values that were appended to the "labels_json" field in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) AppendedLabelsJSON() (json.RawMessage, bool) {
+	if len(m.appendlabels_json) == 0 {
+		return nil, false
+	}
+	return m.appendlabels_json, true
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (m *BronzeHistoryK8sNodeMutation) ClearLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	m.clearedFields[bronzehistoryk8snode.FieldLabelsJSON] = struct{}{}
+}
+
+// LabelsJSONCleared returns if the "labels_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) LabelsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snode.FieldLabelsJSON]
+	return ok
+}
+
+// ResetLabelsJSON resets all changes to the "labels_json" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	delete(m.clearedFields, bronzehistoryk8snode.FieldLabelsJSON)
+}
+
+// SetTaintsJSON sets the "taints_json" field.
+func (m *BronzeHistoryK8sNodeMutation) SetTaintsJSON(jm json.RawMessage) {
+	m.taints_json = &jm
+	m.appendtaints_json = nil
+}
+
+// TaintsJSON returns the value of the "taints_json" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) TaintsJSON() (r json.RawMessage, exists bool) {
+	v := m.taints_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaintsJSON returns the old "taints_json" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldTaintsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaintsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaintsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaintsJSON: %w", err)
+	}
+	return oldValue.TaintsJSON, nil
+}
+
+// AppendTaintsJSON adds jm to the "taints_json" field.
+func (m *BronzeHistoryK8sNodeMutation) AppendTaintsJSON(jm json.RawMessage) {
+	m.appendtaints_json = append(m.appendtaints_json, jm...)
+}
+
+// AppendedTaintsJSON returns the list of values that were appended to the "taints_json" field in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) AppendedTaintsJSON() (json.RawMessage, bool) {
+	if len(m.appendtaints_json) == 0 {
+		return nil, false
+	}
+	return m.appendtaints_json, true
+}
+
+// ClearTaintsJSON clears the value of the "taints_json" field.
+func (m *BronzeHistoryK8sNodeMutation) ClearTaintsJSON() {
+	m.taints_json = nil
+	m.appendtaints_json = nil
+	m.clearedFields[bronzehistoryk8snode.FieldTaintsJSON] = struct{}{}
+}
+
+// TaintsJSONCleared returns if the "taints_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) TaintsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snode.FieldTaintsJSON]
+	return ok
+}
+
+// ResetTaintsJSON resets all changes to the "taints_json" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetTaintsJSON() {
+	m.taints_json = nil
+	m.appendtaints_json = nil
+	delete(m.clearedFields, bronzehistoryk8snode.FieldTaintsJSON)
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (m *BronzeHistoryK8sNodeMutation) SetAPICreatedAt(t time.Time) {
+	m.api_created_at = &t
+}
+
+// APICreatedAt returns the value of the "api_created_at" field in the mutation.
+func (m *BronzeHistoryK8sNodeMutation) APICreatedAt() (r time.Time, exists bool) {
+	v := m.api_created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPICreatedAt returns the old "api_created_at" field's value of the BronzeHistoryK8sNode entity.
+// If the BronzeHistoryK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sNodeMutation) OldAPICreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPICreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPICreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPICreatedAt: %w", err)
+	}
+	return oldValue.APICreatedAt, nil
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (m *BronzeHistoryK8sNodeMutation) ClearAPICreatedAt() {
+	m.api_created_at = nil
+	m.clearedFields[bronzehistoryk8snode.FieldAPICreatedAt] = struct{}{}
+}
+
+// APICreatedAtCleared returns if the "api_created_at" field was cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) APICreatedAtCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8snode.FieldAPICreatedAt]
+	return ok
+}
+
+// ResetAPICreatedAt resets all changes to the "api_created_at" field.
+func (m *BronzeHistoryK8sNodeMutation) ResetAPICreatedAt() {
+	m.api_created_at = nil
+	delete(m.clearedFields, bronzehistoryk8snode.FieldAPICreatedAt)
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sNodeMutation builder.
+func (m *BronzeHistoryK8sNodeMutation) Where(ps ...predicate.BronzeHistoryK8sNode) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeHistoryK8sNodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeHistoryK8sNodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeHistoryK8sNode, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeHistoryK8sNodeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeHistoryK8sNodeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeHistoryK8sNode).
+func (m *BronzeHistoryK8sNodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeHistoryK8sNodeMutation) Fields() []string {
+	fields := make([]string, 0, 25)
+	if m.valid_from != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldValidFrom)
+	}
+	if m.valid_to != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldValidTo)
+	}
+	if m.collected_at != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldFirstCollectedAt)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldResourceID)
+	}
+	if m.cluster_name != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldClusterName)
+	}
+	if m.name != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldName)
+	}
+	if m.uid != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldUID)
+	}
+	if m.provider_id != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldProviderID)
+	}
+	if m.internal_ip != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldInternalIP)
+	}
+	if m.external_ip != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldExternalIP)
+	}
+	if m.node_pool != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldNodePool)
+	}
+	if m.instance_type != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldInstanceType)
+	}
+	if m.region != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldRegion)
+	}
+	if m.zone != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldZone)
+	}
+	if m.kubelet_version != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldKubeletVersion)
+	}
+	if m.os_image != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldOsImage)
+	}
+	if m.kernel_version != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldKernelVersion)
+	}
+	if m.container_runtime != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldContainerRuntime)
+	}
+	if m.architecture != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldArchitecture)
+	}
+	if m.ready != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldReady)
+	}
+	if m.unschedulable != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldUnschedulable)
+	}
+	if m.labels_json != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldLabelsJSON)
+	}
+	if m.taints_json != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldTaintsJSON)
+	}
+	if m.api_created_at != nil {
+		fields = append(fields, bronzehistoryk8snode.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeHistoryK8sNodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryk8snode.FieldValidFrom:
+		return m.ValidFrom()
+	case bronzehistoryk8snode.FieldValidTo:
+		return m.ValidTo()
+	case bronzehistoryk8snode.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzehistoryk8snode.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzehistoryk8snode.FieldResourceID:
+		return m.ResourceID()
+	case bronzehistoryk8snode.FieldClusterName:
+		return m.ClusterName()
+	case bronzehistoryk8snode.FieldName:
+		return m.Name()
+	case bronzehistoryk8snode.FieldUID:
+		return m.UID()
+	case bronzehistoryk8snode.FieldProviderID:
+		return m.ProviderID()
+	case bronzehistoryk8snode.FieldInternalIP:
+		return m.InternalIP()
+	case bronzehistoryk8snode.FieldExternalIP:
+		return m.ExternalIP()
+	case bronzehistoryk8snode.FieldNodePool:
+		return m.NodePool()
+	case bronzehistoryk8snode.FieldInstanceType:
+		return m.InstanceType()
+	case bronzehistoryk8snode.FieldRegion:
+		return m.Region()
+	case bronzehistoryk8snode.FieldZone:
+		return m.Zone()
+	case bronzehistoryk8snode.FieldKubeletVersion:
+		return m.KubeletVersion()
+	case bronzehistoryk8snode.FieldOsImage:
+		return m.OsImage()
+	case bronzehistoryk8snode.FieldKernelVersion:
+		return m.KernelVersion()
+	case bronzehistoryk8snode.FieldContainerRuntime:
+		return m.ContainerRuntime()
+	case bronzehistoryk8snode.FieldArchitecture:
+		return m.Architecture()
+	case bronzehistoryk8snode.FieldReady:
+		return m.Ready()
+	case bronzehistoryk8snode.FieldUnschedulable:
+		return m.Unschedulable()
+	case bronzehistoryk8snode.FieldLabelsJSON:
+		return m.LabelsJSON()
+	case bronzehistoryk8snode.FieldTaintsJSON:
+		return m.TaintsJSON()
+	case bronzehistoryk8snode.FieldAPICreatedAt:
+		return m.APICreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeHistoryK8sNodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzehistoryk8snode.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case bronzehistoryk8snode.FieldValidTo:
+		return m.OldValidTo(ctx)
+	case bronzehistoryk8snode.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzehistoryk8snode.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzehistoryk8snode.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case bronzehistoryk8snode.FieldClusterName:
+		return m.OldClusterName(ctx)
+	case bronzehistoryk8snode.FieldName:
+		return m.OldName(ctx)
+	case bronzehistoryk8snode.FieldUID:
+		return m.OldUID(ctx)
+	case bronzehistoryk8snode.FieldProviderID:
+		return m.OldProviderID(ctx)
+	case bronzehistoryk8snode.FieldInternalIP:
+		return m.OldInternalIP(ctx)
+	case bronzehistoryk8snode.FieldExternalIP:
+		return m.OldExternalIP(ctx)
+	case bronzehistoryk8snode.FieldNodePool:
+		return m.OldNodePool(ctx)
+	case bronzehistoryk8snode.FieldInstanceType:
+		return m.OldInstanceType(ctx)
+	case bronzehistoryk8snode.FieldRegion:
+		return m.OldRegion(ctx)
+	case bronzehistoryk8snode.FieldZone:
+		return m.OldZone(ctx)
+	case bronzehistoryk8snode.FieldKubeletVersion:
+		return m.OldKubeletVersion(ctx)
+	case bronzehistoryk8snode.FieldOsImage:
+		return m.OldOsImage(ctx)
+	case bronzehistoryk8snode.FieldKernelVersion:
+		return m.OldKernelVersion(ctx)
+	case bronzehistoryk8snode.FieldContainerRuntime:
+		return m.OldContainerRuntime(ctx)
+	case bronzehistoryk8snode.FieldArchitecture:
+		return m.OldArchitecture(ctx)
+	case bronzehistoryk8snode.FieldReady:
+		return m.OldReady(ctx)
+	case bronzehistoryk8snode.FieldUnschedulable:
+		return m.OldUnschedulable(ctx)
+	case bronzehistoryk8snode.FieldLabelsJSON:
+		return m.OldLabelsJSON(ctx)
+	case bronzehistoryk8snode.FieldTaintsJSON:
+		return m.OldTaintsJSON(ctx)
+	case bronzehistoryk8snode.FieldAPICreatedAt:
+		return m.OldAPICreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeHistoryK8sNode field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryK8sNodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryk8snode.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case bronzehistoryk8snode.FieldValidTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidTo(v)
+		return nil
+	case bronzehistoryk8snode.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzehistoryk8snode.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzehistoryk8snode.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case bronzehistoryk8snode.FieldClusterName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterName(v)
+		return nil
+	case bronzehistoryk8snode.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case bronzehistoryk8snode.FieldUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUID(v)
+		return nil
+	case bronzehistoryk8snode.FieldProviderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProviderID(v)
+		return nil
+	case bronzehistoryk8snode.FieldInternalIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInternalIP(v)
+		return nil
+	case bronzehistoryk8snode.FieldExternalIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalIP(v)
+		return nil
+	case bronzehistoryk8snode.FieldNodePool:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodePool(v)
+		return nil
+	case bronzehistoryk8snode.FieldInstanceType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstanceType(v)
+		return nil
+	case bronzehistoryk8snode.FieldRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegion(v)
+		return nil
+	case bronzehistoryk8snode.FieldZone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetZone(v)
+		return nil
+	case bronzehistoryk8snode.FieldKubeletVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKubeletVersion(v)
+		return nil
+	case bronzehistoryk8snode.FieldOsImage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOsImage(v)
+		return nil
+	case bronzehistoryk8snode.FieldKernelVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKernelVersion(v)
+		return nil
+	case bronzehistoryk8snode.FieldContainerRuntime:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContainerRuntime(v)
+		return nil
+	case bronzehistoryk8snode.FieldArchitecture:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchitecture(v)
+		return nil
+	case bronzehistoryk8snode.FieldReady:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReady(v)
+		return nil
+	case bronzehistoryk8snode.FieldUnschedulable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnschedulable(v)
+		return nil
+	case bronzehistoryk8snode.FieldLabelsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabelsJSON(v)
+		return nil
+	case bronzehistoryk8snode.FieldTaintsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaintsJSON(v)
+		return nil
+	case bronzehistoryk8snode.FieldAPICreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPICreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sNode field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeHistoryK8sNodeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeHistoryK8sNodeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryK8sNodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sNode numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeHistoryK8sNodeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzehistoryk8snode.FieldValidTo) {
+		fields = append(fields, bronzehistoryk8snode.FieldValidTo)
+	}
+	if m.FieldCleared(bronzehistoryk8snode.FieldUID) {
+		fields = append(fields, bronzehistoryk8snode.FieldUID)
+	}
+	if m.FieldCleared(bronzehistoryk8snode.FieldProviderID) {
+		fields = append(fields, bronzehistoryk8snode.FieldProviderID)
+	}
+	if m.FieldCleared(bronzehistoryk8snode.FieldInternalIP) {
+		fields = append(fields, bronzehistoryk8snode.FieldInternalIP)
+	}
+	if m.FieldCleared(bronzehistoryk8snode.FieldExternalIP) {
+		fields = append(fields, bronzehistoryk8snode.FieldExternalIP)
+	}
+	if m.FieldCleared(bronzehistoryk8snode.FieldNodePool) {
+		fields = append(fields, bronzehistoryk8snode.FieldNodePool)
+	}
+	if m.FieldCleared(bronzehistoryk8snode.FieldInstanceType) {
+		fields = append(fields, bronzehistoryk8snode.FieldInstanceType)
+	}
+	if m.FieldCleared(bronzehistoryk8snode.FieldRegion) {
+		fields = append(fields, bronzehistoryk8snode.FieldRegion)
+	}
+	if m.FieldCleared(bronzehistoryk8snode.FieldZone) {
+		fields = append(fields, bronzehistoryk8snode.FieldZone)
+	}
+	if m.FieldCleared(bronzehistoryk8snode.FieldKubeletVersion) {
+		fields = append(fields, bronzehistoryk8snode.FieldKubeletVersion)
+	}
+	if m.FieldCleared(bronzehistoryk8snode.FieldOsImage) {
+		fields = append(fields, bronzehistoryk8snode.FieldOsImage)
+	}
+	if m.FieldCleared(bronzehistoryk8snode.FieldKernelVersion) {
+		fields = append(fields, bronzehistoryk8snode.FieldKernelVersion)
+	}
+	if m.FieldCleared(bronzehistoryk8snode.FieldContainerRuntime) {
+		fields = append(fields, bronzehistoryk8snode.FieldContainerRuntime)
+	}
+	if m.FieldCleared(bronzehistoryk8snode.FieldArchitecture) {
+		fields = append(fields, bronzehistoryk8snode.FieldArchitecture)
+	}
+	if m.FieldCleared(bronzehistoryk8snode.FieldLabelsJSON) {
+		fields = append(fields, bronzehistoryk8snode.FieldLabelsJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8snode.FieldTaintsJSON) {
+		fields = append(fields, bronzehistoryk8snode.FieldTaintsJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8snode.FieldAPICreatedAt) {
+		fields = append(fields, bronzehistoryk8snode.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeHistoryK8sNodeMutation) ClearField(name string) error {
+	switch name {
+	case bronzehistoryk8snode.FieldValidTo:
+		m.ClearValidTo()
+		return nil
+	case bronzehistoryk8snode.FieldUID:
+		m.ClearUID()
+		return nil
+	case bronzehistoryk8snode.FieldProviderID:
+		m.ClearProviderID()
+		return nil
+	case bronzehistoryk8snode.FieldInternalIP:
+		m.ClearInternalIP()
+		return nil
+	case bronzehistoryk8snode.FieldExternalIP:
+		m.ClearExternalIP()
+		return nil
+	case bronzehistoryk8snode.FieldNodePool:
+		m.ClearNodePool()
+		return nil
+	case bronzehistoryk8snode.FieldInstanceType:
+		m.ClearInstanceType()
+		return nil
+	case bronzehistoryk8snode.FieldRegion:
+		m.ClearRegion()
+		return nil
+	case bronzehistoryk8snode.FieldZone:
+		m.ClearZone()
+		return nil
+	case bronzehistoryk8snode.FieldKubeletVersion:
+		m.ClearKubeletVersion()
+		return nil
+	case bronzehistoryk8snode.FieldOsImage:
+		m.ClearOsImage()
+		return nil
+	case bronzehistoryk8snode.FieldKernelVersion:
+		m.ClearKernelVersion()
+		return nil
+	case bronzehistoryk8snode.FieldContainerRuntime:
+		m.ClearContainerRuntime()
+		return nil
+	case bronzehistoryk8snode.FieldArchitecture:
+		m.ClearArchitecture()
+		return nil
+	case bronzehistoryk8snode.FieldLabelsJSON:
+		m.ClearLabelsJSON()
+		return nil
+	case bronzehistoryk8snode.FieldTaintsJSON:
+		m.ClearTaintsJSON()
+		return nil
+	case bronzehistoryk8snode.FieldAPICreatedAt:
+		m.ClearAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sNode nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeHistoryK8sNodeMutation) ResetField(name string) error {
+	switch name {
+	case bronzehistoryk8snode.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case bronzehistoryk8snode.FieldValidTo:
+		m.ResetValidTo()
+		return nil
+	case bronzehistoryk8snode.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzehistoryk8snode.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzehistoryk8snode.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case bronzehistoryk8snode.FieldClusterName:
+		m.ResetClusterName()
+		return nil
+	case bronzehistoryk8snode.FieldName:
+		m.ResetName()
+		return nil
+	case bronzehistoryk8snode.FieldUID:
+		m.ResetUID()
+		return nil
+	case bronzehistoryk8snode.FieldProviderID:
+		m.ResetProviderID()
+		return nil
+	case bronzehistoryk8snode.FieldInternalIP:
+		m.ResetInternalIP()
+		return nil
+	case bronzehistoryk8snode.FieldExternalIP:
+		m.ResetExternalIP()
+		return nil
+	case bronzehistoryk8snode.FieldNodePool:
+		m.ResetNodePool()
+		return nil
+	case bronzehistoryk8snode.FieldInstanceType:
+		m.ResetInstanceType()
+		return nil
+	case bronzehistoryk8snode.FieldRegion:
+		m.ResetRegion()
+		return nil
+	case bronzehistoryk8snode.FieldZone:
+		m.ResetZone()
+		return nil
+	case bronzehistoryk8snode.FieldKubeletVersion:
+		m.ResetKubeletVersion()
+		return nil
+	case bronzehistoryk8snode.FieldOsImage:
+		m.ResetOsImage()
+		return nil
+	case bronzehistoryk8snode.FieldKernelVersion:
+		m.ResetKernelVersion()
+		return nil
+	case bronzehistoryk8snode.FieldContainerRuntime:
+		m.ResetContainerRuntime()
+		return nil
+	case bronzehistoryk8snode.FieldArchitecture:
+		m.ResetArchitecture()
+		return nil
+	case bronzehistoryk8snode.FieldReady:
+		m.ResetReady()
+		return nil
+	case bronzehistoryk8snode.FieldUnschedulable:
+		m.ResetUnschedulable()
+		return nil
+	case bronzehistoryk8snode.FieldLabelsJSON:
+		m.ResetLabelsJSON()
+		return nil
+	case bronzehistoryk8snode.FieldTaintsJSON:
+		m.ResetTaintsJSON()
+		return nil
+	case bronzehistoryk8snode.FieldAPICreatedAt:
+		m.ResetAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sNode field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeHistoryK8sNodeMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeHistoryK8sNodeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryK8sNode unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeHistoryK8sNodeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryK8sNode edge %s", name)
+}
+
+// BronzeHistoryK8sPodMutation represents an operation that mutates the BronzeHistoryK8sPod nodes in the graph.
+type BronzeHistoryK8sPodMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uint
+	valid_from            *time.Time
+	valid_to              *time.Time
+	collected_at          *time.Time
+	first_collected_at    *time.Time
+	resource_id           *string
+	cluster_name          *string
+	namespace             *string
+	name                  *string
+	uid                   *string
+	phase                 *string
+	node_name             *string
+	pod_ip                *string
+	host_ip               *string
+	service_account       *string
+	workload_kind         *string
+	workload_name         *string
+	host_network          *bool
+	containers_json       *json.RawMessage
+	appendcontainers_json json.RawMessage
+	labels_json           *json.RawMessage
+	appendlabels_json     json.RawMessage
+	api_created_at        *time.Time
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*BronzeHistoryK8sPod, error)
+	predicates            []predicate.BronzeHistoryK8sPod
+}
+
+var _ ent.Mutation = (*BronzeHistoryK8sPodMutation)(nil)
+
+// bronzehistoryk8spodOption allows management of the mutation configuration using functional options.
+type bronzehistoryk8spodOption func(*BronzeHistoryK8sPodMutation)
+
+// newBronzeHistoryK8sPodMutation creates new mutation for the BronzeHistoryK8sPod entity.
+func newBronzeHistoryK8sPodMutation(c config, op Op, opts ...bronzehistoryk8spodOption) *BronzeHistoryK8sPodMutation {
+	m := &BronzeHistoryK8sPodMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeHistoryK8sPod,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeHistoryK8sPodID sets the ID field of the mutation.
+func withBronzeHistoryK8sPodID(id uint) bronzehistoryk8spodOption {
+	return func(m *BronzeHistoryK8sPodMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeHistoryK8sPod
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeHistoryK8sPod, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeHistoryK8sPod.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeHistoryK8sPod sets the old BronzeHistoryK8sPod of the mutation.
+func withBronzeHistoryK8sPod(node *BronzeHistoryK8sPod) bronzehistoryk8spodOption {
+	return func(m *BronzeHistoryK8sPodMutation) {
+		m.oldValue = func(context.Context) (*BronzeHistoryK8sPod, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeHistoryK8sPodMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeHistoryK8sPodMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("kubernetes: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeHistoryK8sPod entities.
+func (m *BronzeHistoryK8sPodMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeHistoryK8sPodMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeHistoryK8sPodMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeHistoryK8sPod.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *BronzeHistoryK8sPodMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *BronzeHistoryK8sPodMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidTo sets the "valid_to" field.
+func (m *BronzeHistoryK8sPodMutation) SetValidTo(t time.Time) {
+	m.valid_to = &t
+}
+
+// ValidTo returns the value of the "valid_to" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) ValidTo() (r time.Time, exists bool) {
+	v := m.valid_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidTo returns the old "valid_to" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldValidTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidTo: %w", err)
+	}
+	return oldValue.ValidTo, nil
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (m *BronzeHistoryK8sPodMutation) ClearValidTo() {
+	m.valid_to = nil
+	m.clearedFields[bronzehistoryk8spod.FieldValidTo] = struct{}{}
+}
+
+// ValidToCleared returns if the "valid_to" field was cleared in this mutation.
+func (m *BronzeHistoryK8sPodMutation) ValidToCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8spod.FieldValidTo]
+	return ok
+}
+
+// ResetValidTo resets all changes to the "valid_to" field.
+func (m *BronzeHistoryK8sPodMutation) ResetValidTo() {
+	m.valid_to = nil
+	delete(m.clearedFields, bronzehistoryk8spod.FieldValidTo)
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeHistoryK8sPodMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeHistoryK8sPodMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeHistoryK8sPodMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeHistoryK8sPodMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *BronzeHistoryK8sPodMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *BronzeHistoryK8sPodMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (m *BronzeHistoryK8sPodMutation) SetClusterName(s string) {
+	m.cluster_name = &s
+}
+
+// ClusterName returns the value of the "cluster_name" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) ClusterName() (r string, exists bool) {
+	v := m.cluster_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClusterName returns the old "cluster_name" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldClusterName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterName: %w", err)
+	}
+	return oldValue.ClusterName, nil
+}
+
+// ResetClusterName resets all changes to the "cluster_name" field.
+func (m *BronzeHistoryK8sPodMutation) ResetClusterName() {
+	m.cluster_name = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *BronzeHistoryK8sPodMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *BronzeHistoryK8sPodMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetName sets the "name" field.
+func (m *BronzeHistoryK8sPodMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *BronzeHistoryK8sPodMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUID sets the "uid" field.
+func (m *BronzeHistoryK8sPodMutation) SetUID(s string) {
+	m.uid = &s
+}
+
+// UID returns the value of the "uid" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) UID() (r string, exists bool) {
+	v := m.uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUID returns the old "uid" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+	}
+	return oldValue.UID, nil
+}
+
+// ClearUID clears the value of the "uid" field.
+func (m *BronzeHistoryK8sPodMutation) ClearUID() {
+	m.uid = nil
+	m.clearedFields[bronzehistoryk8spod.FieldUID] = struct{}{}
+}
+
+// UIDCleared returns if the "uid" field was cleared in this mutation.
+func (m *BronzeHistoryK8sPodMutation) UIDCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8spod.FieldUID]
+	return ok
+}
+
+// ResetUID resets all changes to the "uid" field.
+func (m *BronzeHistoryK8sPodMutation) ResetUID() {
+	m.uid = nil
+	delete(m.clearedFields, bronzehistoryk8spod.FieldUID)
+}
+
+// SetPhase sets the "phase" field.
+func (m *BronzeHistoryK8sPodMutation) SetPhase(s string) {
+	m.phase = &s
+}
+
+// Phase returns the value of the "phase" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) Phase() (r string, exists bool) {
+	v := m.phase
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhase returns the old "phase" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldPhase(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhase: %w", err)
+	}
+	return oldValue.Phase, nil
+}
+
+// ClearPhase clears the value of the "phase" field.
+func (m *BronzeHistoryK8sPodMutation) ClearPhase() {
+	m.phase = nil
+	m.clearedFields[bronzehistoryk8spod.FieldPhase] = struct{}{}
+}
+
+// PhaseCleared returns if the "phase" field was cleared in this mutation.
+func (m *BronzeHistoryK8sPodMutation) PhaseCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8spod.FieldPhase]
+	return ok
+}
+
+// ResetPhase resets all changes to the "phase" field.
+func (m *BronzeHistoryK8sPodMutation) ResetPhase() {
+	m.phase = nil
+	delete(m.clearedFields, bronzehistoryk8spod.FieldPhase)
+}
+
+// SetNodeName sets the "node_name" field.
+func (m *BronzeHistoryK8sPodMutation) SetNodeName(s string) {
+	m.node_name = &s
+}
+
+// NodeName returns the value of the "node_name" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) NodeName() (r string, exists bool) {
+	v := m.node_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNodeName returns the old "node_name" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldNodeName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNodeName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNodeName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNodeName: %w", err)
+	}
+	return oldValue.NodeName, nil
+}
+
+// ClearNodeName clears the value of the "node_name" field.
+func (m *BronzeHistoryK8sPodMutation) ClearNodeName() {
+	m.node_name = nil
+	m.clearedFields[bronzehistoryk8spod.FieldNodeName] = struct{}{}
+}
+
+// NodeNameCleared returns if the "node_name" field was cleared in this mutation.
+func (m *BronzeHistoryK8sPodMutation) NodeNameCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8spod.FieldNodeName]
+	return ok
+}
+
+// ResetNodeName resets all changes to the "node_name" field.
+func (m *BronzeHistoryK8sPodMutation) ResetNodeName() {
+	m.node_name = nil
+	delete(m.clearedFields, bronzehistoryk8spod.FieldNodeName)
+}
+
+// SetPodIP sets the "pod_ip" field.
+func (m *BronzeHistoryK8sPodMutation) SetPodIP(s string) {
+	m.pod_ip = &s
+}
+
+// PodIP returns the value of the "pod_ip" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) PodIP() (r string, exists bool) {
+	v := m.pod_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPodIP returns the old "pod_ip" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldPodIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPodIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPodIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPodIP: %w", err)
+	}
+	return oldValue.PodIP, nil
+}
+
+// ClearPodIP clears the value of the "pod_ip" field.
+func (m *BronzeHistoryK8sPodMutation) ClearPodIP() {
+	m.pod_ip = nil
+	m.clearedFields[bronzehistoryk8spod.FieldPodIP] = struct{}{}
+}
+
+// PodIPCleared returns if the "pod_ip" field was cleared in this mutation.
+func (m *BronzeHistoryK8sPodMutation) PodIPCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8spod.FieldPodIP]
+	return ok
+}
+
+// ResetPodIP resets all changes to the "pod_ip" field.
+func (m *BronzeHistoryK8sPodMutation) ResetPodIP() {
+	m.pod_ip = nil
+	delete(m.clearedFields, bronzehistoryk8spod.FieldPodIP)
+}
+
+// SetHostIP sets the "host_ip" field.
+func (m *BronzeHistoryK8sPodMutation) SetHostIP(s string) {
+	m.host_ip = &s
+}
+
+// HostIP returns the value of the "host_ip" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) HostIP() (r string, exists bool) {
+	v := m.host_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostIP returns the old "host_ip" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldHostIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostIP: %w", err)
+	}
+	return oldValue.HostIP, nil
+}
+
+// ClearHostIP clears the value of the "host_ip" field.
+func (m *BronzeHistoryK8sPodMutation) ClearHostIP() {
+	m.host_ip = nil
+	m.clearedFields[bronzehistoryk8spod.FieldHostIP] = struct{}{}
+}
+
+// HostIPCleared returns if the "host_ip" field was cleared in this mutation.
+func (m *BronzeHistoryK8sPodMutation) HostIPCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8spod.FieldHostIP]
+	return ok
+}
+
+// ResetHostIP resets all changes to the "host_ip" field.
+func (m *BronzeHistoryK8sPodMutation) ResetHostIP() {
+	m.host_ip = nil
+	delete(m.clearedFields, bronzehistoryk8spod.FieldHostIP)
+}
+
+// SetServiceAccount sets the "service_account" field.
+func (m *BronzeHistoryK8sPodMutation) SetServiceAccount(s string) {
+	m.service_account = &s
+}
+
+// ServiceAccount returns the value of the "service_account" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) ServiceAccount() (r string, exists bool) {
+	v := m.service_account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServiceAccount returns the old "service_account" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldServiceAccount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServiceAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServiceAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServiceAccount: %w", err)
+	}
+	return oldValue.ServiceAccount, nil
+}
+
+// ClearServiceAccount clears the value of the "service_account" field.
+func (m *BronzeHistoryK8sPodMutation) ClearServiceAccount() {
+	m.service_account = nil
+	m.clearedFields[bronzehistoryk8spod.FieldServiceAccount] = struct{}{}
+}
+
+// ServiceAccountCleared returns if the "service_account" field was cleared in this mutation.
+func (m *BronzeHistoryK8sPodMutation) ServiceAccountCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8spod.FieldServiceAccount]
+	return ok
+}
+
+// ResetServiceAccount resets all changes to the "service_account" field.
+func (m *BronzeHistoryK8sPodMutation) ResetServiceAccount() {
+	m.service_account = nil
+	delete(m.clearedFields, bronzehistoryk8spod.FieldServiceAccount)
+}
+
+// SetWorkloadKind sets the "workload_kind" field.
+func (m *BronzeHistoryK8sPodMutation) SetWorkloadKind(s string) {
+	m.workload_kind = &s
+}
+
+// WorkloadKind returns the value of the "workload_kind" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) WorkloadKind() (r string, exists bool) {
+	v := m.workload_kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkloadKind returns the old "workload_kind" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldWorkloadKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkloadKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkloadKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkloadKind: %w", err)
+	}
+	return oldValue.WorkloadKind, nil
+}
+
+// ClearWorkloadKind clears the value of the "workload_kind" field.
+func (m *BronzeHistoryK8sPodMutation) ClearWorkloadKind() {
+	m.workload_kind = nil
+	m.clearedFields[bronzehistoryk8spod.FieldWorkloadKind] = struct{}{}
+}
+
+// WorkloadKindCleared returns if the "workload_kind" field was cleared in this mutation.
+func (m *BronzeHistoryK8sPodMutation) WorkloadKindCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8spod.FieldWorkloadKind]
+	return ok
+}
+
+// ResetWorkloadKind resets all changes to the "workload_kind" field.
+func (m *BronzeHistoryK8sPodMutation) ResetWorkloadKind() {
+	m.workload_kind = nil
+	delete(m.clearedFields, bronzehistoryk8spod.FieldWorkloadKind)
+}
+
+// SetWorkloadName sets the "workload_name" field.
+func (m *BronzeHistoryK8sPodMutation) SetWorkloadName(s string) {
+	m.workload_name = &s
+}
+
+// WorkloadName returns the value of the "workload_name" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) WorkloadName() (r string, exists bool) {
+	v := m.workload_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkloadName returns the old "workload_name" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldWorkloadName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkloadName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkloadName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkloadName: %w", err)
+	}
+	return oldValue.WorkloadName, nil
+}
+
+// ClearWorkloadName clears the value of the "workload_name" field.
+func (m *BronzeHistoryK8sPodMutation) ClearWorkloadName() {
+	m.workload_name = nil
+	m.clearedFields[bronzehistoryk8spod.FieldWorkloadName] = struct{}{}
+}
+
+// WorkloadNameCleared returns if the "workload_name" field was cleared in this mutation.
+func (m *BronzeHistoryK8sPodMutation) WorkloadNameCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8spod.FieldWorkloadName]
+	return ok
+}
+
+// ResetWorkloadName resets all changes to the "workload_name" field.
+func (m *BronzeHistoryK8sPodMutation) ResetWorkloadName() {
+	m.workload_name = nil
+	delete(m.clearedFields, bronzehistoryk8spod.FieldWorkloadName)
+}
+
+// SetHostNetwork sets the "host_network" field.
+func (m *BronzeHistoryK8sPodMutation) SetHostNetwork(b bool) {
+	m.host_network = &b
+}
+
+// HostNetwork returns the value of the "host_network" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) HostNetwork() (r bool, exists bool) {
+	v := m.host_network
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostNetwork returns the old "host_network" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldHostNetwork(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostNetwork is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostNetwork requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostNetwork: %w", err)
+	}
+	return oldValue.HostNetwork, nil
+}
+
+// ResetHostNetwork resets all changes to the "host_network" field.
+func (m *BronzeHistoryK8sPodMutation) ResetHostNetwork() {
+	m.host_network = nil
+}
+
+// SetContainersJSON sets the "containers_json" field.
+func (m *BronzeHistoryK8sPodMutation) SetContainersJSON(jm json.RawMessage) {
+	m.containers_json = &jm
+	m.appendcontainers_json = nil
+}
+
+// ContainersJSON returns the value of the "containers_json" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) ContainersJSON() (r json.RawMessage, exists bool) {
+	v := m.containers_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContainersJSON returns the old "containers_json" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldContainersJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContainersJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContainersJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContainersJSON: %w", err)
+	}
+	return oldValue.ContainersJSON, nil
+}
+
+// AppendContainersJSON adds jm to the "containers_json" field.
+func (m *BronzeHistoryK8sPodMutation) AppendContainersJSON(jm json.RawMessage) {
+	m.appendcontainers_json = append(m.appendcontainers_json, jm...)
+}
+
+// AppendedContainersJSON returns the list of values that were appended to the "containers_json" field in this mutation.
+func (m *BronzeHistoryK8sPodMutation) AppendedContainersJSON() (json.RawMessage, bool) {
+	if len(m.appendcontainers_json) == 0 {
+		return nil, false
+	}
+	return m.appendcontainers_json, true
+}
+
+// ClearContainersJSON clears the value of the "containers_json" field.
+func (m *BronzeHistoryK8sPodMutation) ClearContainersJSON() {
+	m.containers_json = nil
+	m.appendcontainers_json = nil
+	m.clearedFields[bronzehistoryk8spod.FieldContainersJSON] = struct{}{}
+}
+
+// ContainersJSONCleared returns if the "containers_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sPodMutation) ContainersJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8spod.FieldContainersJSON]
+	return ok
+}
+
+// ResetContainersJSON resets all changes to the "containers_json" field.
+func (m *BronzeHistoryK8sPodMutation) ResetContainersJSON() {
+	m.containers_json = nil
+	m.appendcontainers_json = nil
+	delete(m.clearedFields, bronzehistoryk8spod.FieldContainersJSON)
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (m *BronzeHistoryK8sPodMutation) SetLabelsJSON(jm json.RawMessage) {
+	m.labels_json = &jm
+	m.appendlabels_json = nil
+}
+
+// LabelsJSON returns the value of the "labels_json" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) LabelsJSON() (r json.RawMessage, exists bool) {
+	v := m.labels_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabelsJSON returns the old "labels_json" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldLabelsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabelsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabelsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabelsJSON: %w", err)
+	}
+	return oldValue.LabelsJSON, nil
+}
+
+// AppendLabelsJSON adds jm to the "labels_json" field.
+func (m *BronzeHistoryK8sPodMutation) AppendLabelsJSON(jm json.RawMessage) {
+	m.appendlabels_json = append(m.appendlabels_json, jm...)
+}
+
+// AppendedLabelsJSON returns the list of values that were appended to the "labels_json" field in this mutation.
+func (m *BronzeHistoryK8sPodMutation) AppendedLabelsJSON() (json.RawMessage, bool) {
+	if len(m.appendlabels_json) == 0 {
+		return nil, false
+	}
+	return m.appendlabels_json, true
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (m *BronzeHistoryK8sPodMutation) ClearLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	m.clearedFields[bronzehistoryk8spod.FieldLabelsJSON] = struct{}{}
+}
+
+// LabelsJSONCleared returns if the "labels_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sPodMutation) LabelsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8spod.FieldLabelsJSON]
+	return ok
+}
+
+// ResetLabelsJSON resets all changes to the "labels_json" field.
+func (m *BronzeHistoryK8sPodMutation) ResetLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	delete(m.clearedFields, bronzehistoryk8spod.FieldLabelsJSON)
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (m *BronzeHistoryK8sPodMutation) SetAPICreatedAt(t time.Time) {
+	m.api_created_at = &t
+}
+
+// APICreatedAt returns the value of the "api_created_at" field in the mutation.
+func (m *BronzeHistoryK8sPodMutation) APICreatedAt() (r time.Time, exists bool) {
+	v := m.api_created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPICreatedAt returns the old "api_created_at" field's value of the BronzeHistoryK8sPod entity.
+// If the BronzeHistoryK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sPodMutation) OldAPICreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPICreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPICreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPICreatedAt: %w", err)
+	}
+	return oldValue.APICreatedAt, nil
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (m *BronzeHistoryK8sPodMutation) ClearAPICreatedAt() {
+	m.api_created_at = nil
+	m.clearedFields[bronzehistoryk8spod.FieldAPICreatedAt] = struct{}{}
+}
+
+// APICreatedAtCleared returns if the "api_created_at" field was cleared in this mutation.
+func (m *BronzeHistoryK8sPodMutation) APICreatedAtCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8spod.FieldAPICreatedAt]
+	return ok
+}
+
+// ResetAPICreatedAt resets all changes to the "api_created_at" field.
+func (m *BronzeHistoryK8sPodMutation) ResetAPICreatedAt() {
+	m.api_created_at = nil
+	delete(m.clearedFields, bronzehistoryk8spod.FieldAPICreatedAt)
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sPodMutation builder.
+func (m *BronzeHistoryK8sPodMutation) Where(ps ...predicate.BronzeHistoryK8sPod) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeHistoryK8sPodMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeHistoryK8sPodMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeHistoryK8sPod, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeHistoryK8sPodMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeHistoryK8sPodMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeHistoryK8sPod).
+func (m *BronzeHistoryK8sPodMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeHistoryK8sPodMutation) Fields() []string {
+	fields := make([]string, 0, 20)
+	if m.valid_from != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldValidFrom)
+	}
+	if m.valid_to != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldValidTo)
+	}
+	if m.collected_at != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldFirstCollectedAt)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldResourceID)
+	}
+	if m.cluster_name != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldClusterName)
+	}
+	if m.namespace != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldNamespace)
+	}
+	if m.name != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldName)
+	}
+	if m.uid != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldUID)
+	}
+	if m.phase != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldPhase)
+	}
+	if m.node_name != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldNodeName)
+	}
+	if m.pod_ip != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldPodIP)
+	}
+	if m.host_ip != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldHostIP)
+	}
+	if m.service_account != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldServiceAccount)
+	}
+	if m.workload_kind != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldWorkloadKind)
+	}
+	if m.workload_name != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldWorkloadName)
+	}
+	if m.host_network != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldHostNetwork)
+	}
+	if m.containers_json != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldContainersJSON)
+	}
+	if m.labels_json != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldLabelsJSON)
+	}
+	if m.api_created_at != nil {
+		fields = append(fields, bronzehistoryk8spod.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeHistoryK8sPodMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryk8spod.FieldValidFrom:
+		return m.ValidFrom()
+	case bronzehistoryk8spod.FieldValidTo:
+		return m.ValidTo()
+	case bronzehistoryk8spod.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzehistoryk8spod.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzehistoryk8spod.FieldResourceID:
+		return m.ResourceID()
+	case bronzehistoryk8spod.FieldClusterName:
+		return m.ClusterName()
+	case bronzehistoryk8spod.FieldNamespace:
+		return m.Namespace()
+	case bronzehistoryk8spod.FieldName:
+		return m.Name()
+	case bronzehistoryk8spod.FieldUID:
+		return m.UID()
+	case bronzehistoryk8spod.FieldPhase:
+		return m.Phase()
+	case bronzehistoryk8spod.FieldNodeName:
+		return m.NodeName()
+	case bronzehistoryk8spod.FieldPodIP:
+		return m.PodIP()
+	case bronzehistoryk8spod.FieldHostIP:
+		return m.HostIP()
+	case bronzehistoryk8spod.FieldServiceAccount:
+		return m.ServiceAccount()
+	case bronzehistoryk8spod.FieldWorkloadKind:
+		return m.WorkloadKind()
+	case bronzehistoryk8spod.FieldWorkloadName:
+		return m.WorkloadName()
+	case bronzehistoryk8spod.FieldHostNetwork:
+		return m.HostNetwork()
+	case bronzehistoryk8spod.FieldContainersJSON:
+		return m.ContainersJSON()
+	case bronzehistoryk8spod.FieldLabelsJSON:
+		return m.LabelsJSON()
+	case bronzehistoryk8spod.FieldAPICreatedAt:
+		return m.APICreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeHistoryK8sPodMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzehistoryk8spod.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case bronzehistoryk8spod.FieldValidTo:
+		return m.OldValidTo(ctx)
+	case bronzehistoryk8spod.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzehistoryk8spod.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzehistoryk8spod.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case bronzehistoryk8spod.FieldClusterName:
+		return m.OldClusterName(ctx)
+	case bronzehistoryk8spod.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case bronzehistoryk8spod.FieldName:
+		return m.OldName(ctx)
+	case bronzehistoryk8spod.FieldUID:
+		return m.OldUID(ctx)
+	case bronzehistoryk8spod.FieldPhase:
+		return m.OldPhase(ctx)
+	case bronzehistoryk8spod.FieldNodeName:
+		return m.OldNodeName(ctx)
+	case bronzehistoryk8spod.FieldPodIP:
+		return m.OldPodIP(ctx)
+	case bronzehistoryk8spod.FieldHostIP:
+		return m.OldHostIP(ctx)
+	case bronzehistoryk8spod.FieldServiceAccount:
+		return m.OldServiceAccount(ctx)
+	case bronzehistoryk8spod.FieldWorkloadKind:
+		return m.OldWorkloadKind(ctx)
+	case bronzehistoryk8spod.FieldWorkloadName:
+		return m.OldWorkloadName(ctx)
+	case bronzehistoryk8spod.FieldHostNetwork:
+		return m.OldHostNetwork(ctx)
+	case bronzehistoryk8spod.FieldContainersJSON:
+		return m.OldContainersJSON(ctx)
+	case bronzehistoryk8spod.FieldLabelsJSON:
+		return m.OldLabelsJSON(ctx)
+	case bronzehistoryk8spod.FieldAPICreatedAt:
+		return m.OldAPICreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeHistoryK8sPod field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryK8sPodMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryk8spod.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case bronzehistoryk8spod.FieldValidTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidTo(v)
+		return nil
+	case bronzehistoryk8spod.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzehistoryk8spod.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzehistoryk8spod.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case bronzehistoryk8spod.FieldClusterName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterName(v)
+		return nil
+	case bronzehistoryk8spod.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case bronzehistoryk8spod.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case bronzehistoryk8spod.FieldUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUID(v)
+		return nil
+	case bronzehistoryk8spod.FieldPhase:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhase(v)
+		return nil
+	case bronzehistoryk8spod.FieldNodeName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodeName(v)
+		return nil
+	case bronzehistoryk8spod.FieldPodIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPodIP(v)
+		return nil
+	case bronzehistoryk8spod.FieldHostIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostIP(v)
+		return nil
+	case bronzehistoryk8spod.FieldServiceAccount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServiceAccount(v)
+		return nil
+	case bronzehistoryk8spod.FieldWorkloadKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkloadKind(v)
+		return nil
+	case bronzehistoryk8spod.FieldWorkloadName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkloadName(v)
+		return nil
+	case bronzehistoryk8spod.FieldHostNetwork:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostNetwork(v)
+		return nil
+	case bronzehistoryk8spod.FieldContainersJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContainersJSON(v)
+		return nil
+	case bronzehistoryk8spod.FieldLabelsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabelsJSON(v)
+		return nil
+	case bronzehistoryk8spod.FieldAPICreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPICreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sPod field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeHistoryK8sPodMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeHistoryK8sPodMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryK8sPodMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sPod numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeHistoryK8sPodMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzehistoryk8spod.FieldValidTo) {
+		fields = append(fields, bronzehistoryk8spod.FieldValidTo)
+	}
+	if m.FieldCleared(bronzehistoryk8spod.FieldUID) {
+		fields = append(fields, bronzehistoryk8spod.FieldUID)
+	}
+	if m.FieldCleared(bronzehistoryk8spod.FieldPhase) {
+		fields = append(fields, bronzehistoryk8spod.FieldPhase)
+	}
+	if m.FieldCleared(bronzehistoryk8spod.FieldNodeName) {
+		fields = append(fields, bronzehistoryk8spod.FieldNodeName)
+	}
+	if m.FieldCleared(bronzehistoryk8spod.FieldPodIP) {
+		fields = append(fields, bronzehistoryk8spod.FieldPodIP)
+	}
+	if m.FieldCleared(bronzehistoryk8spod.FieldHostIP) {
+		fields = append(fields, bronzehistoryk8spod.FieldHostIP)
+	}
+	if m.FieldCleared(bronzehistoryk8spod.FieldServiceAccount) {
+		fields = append(fields, bronzehistoryk8spod.FieldServiceAccount)
+	}
+	if m.FieldCleared(bronzehistoryk8spod.FieldWorkloadKind) {
+		fields = append(fields, bronzehistoryk8spod.FieldWorkloadKind)
+	}
+	if m.FieldCleared(bronzehistoryk8spod.FieldWorkloadName) {
+		fields = append(fields, bronzehistoryk8spod.FieldWorkloadName)
+	}
+	if m.FieldCleared(bronzehistoryk8spod.FieldContainersJSON) {
+		fields = append(fields, bronzehistoryk8spod.FieldContainersJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8spod.FieldLabelsJSON) {
+		fields = append(fields, bronzehistoryk8spod.FieldLabelsJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8spod.FieldAPICreatedAt) {
+		fields = append(fields, bronzehistoryk8spod.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeHistoryK8sPodMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeHistoryK8sPodMutation) ClearField(name string) error {
+	switch name {
+	case bronzehistoryk8spod.FieldValidTo:
+		m.ClearValidTo()
+		return nil
+	case bronzehistoryk8spod.FieldUID:
+		m.ClearUID()
+		return nil
+	case bronzehistoryk8spod.FieldPhase:
+		m.ClearPhase()
+		return nil
+	case bronzehistoryk8spod.FieldNodeName:
+		m.ClearNodeName()
+		return nil
+	case bronzehistoryk8spod.FieldPodIP:
+		m.ClearPodIP()
+		return nil
+	case bronzehistoryk8spod.FieldHostIP:
+		m.ClearHostIP()
+		return nil
+	case bronzehistoryk8spod.FieldServiceAccount:
+		m.ClearServiceAccount()
+		return nil
+	case bronzehistoryk8spod.FieldWorkloadKind:
+		m.ClearWorkloadKind()
+		return nil
+	case bronzehistoryk8spod.FieldWorkloadName:
+		m.ClearWorkloadName()
+		return nil
+	case bronzehistoryk8spod.FieldContainersJSON:
+		m.ClearContainersJSON()
+		return nil
+	case bronzehistoryk8spod.FieldLabelsJSON:
+		m.ClearLabelsJSON()
+		return nil
+	case bronzehistoryk8spod.FieldAPICreatedAt:
+		m.ClearAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sPod nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeHistoryK8sPodMutation) ResetField(name string) error {
+	switch name {
+	case bronzehistoryk8spod.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case bronzehistoryk8spod.FieldValidTo:
+		m.ResetValidTo()
+		return nil
+	case bronzehistoryk8spod.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzehistoryk8spod.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzehistoryk8spod.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case bronzehistoryk8spod.FieldClusterName:
+		m.ResetClusterName()
+		return nil
+	case bronzehistoryk8spod.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case bronzehistoryk8spod.FieldName:
+		m.ResetName()
+		return nil
+	case bronzehistoryk8spod.FieldUID:
+		m.ResetUID()
+		return nil
+	case bronzehistoryk8spod.FieldPhase:
+		m.ResetPhase()
+		return nil
+	case bronzehistoryk8spod.FieldNodeName:
+		m.ResetNodeName()
+		return nil
+	case bronzehistoryk8spod.FieldPodIP:
+		m.ResetPodIP()
+		return nil
+	case bronzehistoryk8spod.FieldHostIP:
+		m.ResetHostIP()
+		return nil
+	case bronzehistoryk8spod.FieldServiceAccount:
+		m.ResetServiceAccount()
+		return nil
+	case bronzehistoryk8spod.FieldWorkloadKind:
+		m.ResetWorkloadKind()
+		return nil
+	case bronzehistoryk8spod.FieldWorkloadName:
+		m.ResetWorkloadName()
+		return nil
+	case bronzehistoryk8spod.FieldHostNetwork:
+		m.ResetHostNetwork()
+		return nil
+	case bronzehistoryk8spod.FieldContainersJSON:
+		m.ResetContainersJSON()
+		return nil
+	case bronzehistoryk8spod.FieldLabelsJSON:
+		m.ResetLabelsJSON()
+		return nil
+	case bronzehistoryk8spod.FieldAPICreatedAt:
+		m.ResetAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sPod field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeHistoryK8sPodMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeHistoryK8sPodMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeHistoryK8sPodMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeHistoryK8sPodMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeHistoryK8sPodMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeHistoryK8sPodMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeHistoryK8sPodMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryK8sPod unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeHistoryK8sPodMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryK8sPod edge %s", name)
+}
+
+// BronzeHistoryK8sRoleBindingMutation represents an operation that mutates the BronzeHistoryK8sRoleBinding nodes in the graph.
+type BronzeHistoryK8sRoleBindingMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uint
+	valid_from          *time.Time
+	valid_to            *time.Time
+	collected_at        *time.Time
+	first_collected_at  *time.Time
+	resource_id         *string
+	cluster_name        *string
+	kind                *string
+	namespace           *string
+	name                *string
+	uid                 *string
+	role_kind           *string
+	role_name           *string
+	subjects_json       *json.RawMessage
+	appendsubjects_json json.RawMessage
+	rules_json          *json.RawMessage
+	appendrules_json    json.RawMessage
+	labels_json         *json.RawMessage
+	appendlabels_json   json.RawMessage
+	api_created_at      *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*BronzeHistoryK8sRoleBinding, error)
+	predicates          []predicate.BronzeHistoryK8sRoleBinding
+}
+
+var _ ent.Mutation = (*BronzeHistoryK8sRoleBindingMutation)(nil)
+
+// bronzehistoryk8srolebindingOption allows management of the mutation configuration using functional options.
+type bronzehistoryk8srolebindingOption func(*BronzeHistoryK8sRoleBindingMutation)
+
+// newBronzeHistoryK8sRoleBindingMutation creates new mutation for the BronzeHistoryK8sRoleBinding entity.
+func newBronzeHistoryK8sRoleBindingMutation(c config, op Op, opts ...bronzehistoryk8srolebindingOption) *BronzeHistoryK8sRoleBindingMutation {
+	m := &BronzeHistoryK8sRoleBindingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeHistoryK8sRoleBinding,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeHistoryK8sRoleBindingID sets the ID field of the mutation.
+func withBronzeHistoryK8sRoleBindingID(id uint) bronzehistoryk8srolebindingOption {
+	return func(m *BronzeHistoryK8sRoleBindingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeHistoryK8sRoleBinding
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeHistoryK8sRoleBinding, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeHistoryK8sRoleBinding.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeHistoryK8sRoleBinding sets the old BronzeHistoryK8sRoleBinding of the mutation.
+func withBronzeHistoryK8sRoleBinding(node *BronzeHistoryK8sRoleBinding) bronzehistoryk8srolebindingOption {
+	return func(m *BronzeHistoryK8sRoleBindingMutation) {
+		m.oldValue = func(context.Context) (*BronzeHistoryK8sRoleBinding, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeHistoryK8sRoleBindingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeHistoryK8sRoleBindingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("kubernetes: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeHistoryK8sRoleBinding entities.
+func (m *BronzeHistoryK8sRoleBindingMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeHistoryK8sRoleBindingMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeHistoryK8sRoleBinding.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the BronzeHistoryK8sRoleBinding entity.
+// If the BronzeHistoryK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sRoleBindingMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidTo sets the "valid_to" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) SetValidTo(t time.Time) {
+	m.valid_to = &t
+}
+
+// ValidTo returns the value of the "valid_to" field in the mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) ValidTo() (r time.Time, exists bool) {
+	v := m.valid_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidTo returns the old "valid_to" field's value of the BronzeHistoryK8sRoleBinding entity.
+// If the BronzeHistoryK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sRoleBindingMutation) OldValidTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidTo: %w", err)
+	}
+	return oldValue.ValidTo, nil
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ClearValidTo() {
+	m.valid_to = nil
+	m.clearedFields[bronzehistoryk8srolebinding.FieldValidTo] = struct{}{}
+}
+
+// ValidToCleared returns if the "valid_to" field was cleared in this mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) ValidToCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8srolebinding.FieldValidTo]
+	return ok
+}
+
+// ResetValidTo resets all changes to the "valid_to" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ResetValidTo() {
+	m.valid_to = nil
+	delete(m.clearedFields, bronzehistoryk8srolebinding.FieldValidTo)
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeHistoryK8sRoleBinding entity.
+// If the BronzeHistoryK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sRoleBindingMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeHistoryK8sRoleBinding entity.
+// If the BronzeHistoryK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sRoleBindingMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the BronzeHistoryK8sRoleBinding entity.
+// If the BronzeHistoryK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sRoleBindingMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) SetClusterName(s string) {
+	m.cluster_name = &s
+}
+
+// ClusterName returns the value of the "cluster_name" field in the mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) ClusterName() (r string, exists bool) {
+	v := m.cluster_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClusterName returns the old "cluster_name" field's value of the BronzeHistoryK8sRoleBinding entity.
+// If the BronzeHistoryK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sRoleBindingMutation) OldClusterName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterName: %w", err)
+	}
+	return oldValue.ClusterName, nil
+}
+
+// ResetClusterName resets all changes to the "cluster_name" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ResetClusterName() {
+	m.cluster_name = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the BronzeHistoryK8sRoleBinding entity.
+// If the BronzeHistoryK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sRoleBindingMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the BronzeHistoryK8sRoleBinding entity.
+// If the BronzeHistoryK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sRoleBindingMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ClearNamespace clears the value of the "namespace" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ClearNamespace() {
+	m.namespace = nil
+	m.clearedFields[bronzehistoryk8srolebinding.FieldNamespace] = struct{}{}
+}
+
+// NamespaceCleared returns if the "namespace" field was cleared in this mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) NamespaceCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8srolebinding.FieldNamespace]
+	return ok
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ResetNamespace() {
+	m.namespace = nil
+	delete(m.clearedFields, bronzehistoryk8srolebinding.FieldNamespace)
+}
+
+// SetName sets the "name" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the BronzeHistoryK8sRoleBinding entity.
+// If the BronzeHistoryK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sRoleBindingMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUID sets the "uid" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) SetUID(s string) {
+	m.uid = &s
+}
+
+// UID returns the value of the "uid" field in the mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) UID() (r string, exists bool) {
+	v := m.uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUID returns the old "uid" field's value of the BronzeHistoryK8sRoleBinding entity.
+// If the BronzeHistoryK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sRoleBindingMutation) OldUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+	}
+	return oldValue.UID, nil
+}
+
+// ClearUID clears the value of the "uid" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ClearUID() {
+	m.uid = nil
+	m.clearedFields[bronzehistoryk8srolebinding.FieldUID] = struct{}{}
+}
+
+// UIDCleared returns if the "uid" field was cleared in this mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) UIDCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8srolebinding.FieldUID]
+	return ok
+}
+
+// ResetUID resets all changes to the "uid" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ResetUID() {
+	m.uid = nil
+	delete(m.clearedFields, bronzehistoryk8srolebinding.FieldUID)
+}
+
+// SetRoleKind sets the "role_kind" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) SetRoleKind(s string) {
+	m.role_kind = &s
+}
+
+// RoleKind returns the value of the "role_kind" field in the mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) RoleKind() (r string, exists bool) {
+	v := m.role_kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleKind returns the old "role_kind" field's value of the BronzeHistoryK8sRoleBinding entity.
+// If the BronzeHistoryK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sRoleBindingMutation) OldRoleKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleKind: %w", err)
+	}
+	return oldValue.RoleKind, nil
+}
+
+// ResetRoleKind resets all changes to the "role_kind" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ResetRoleKind() {
+	m.role_kind = nil
+}
+
+// SetRoleName sets the "role_name" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) SetRoleName(s string) {
+	m.role_name = &s
+}
+
+// RoleName returns the value of the "role_name" field in the mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) RoleName() (r string, exists bool) {
+	v := m.role_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleName returns the old "role_name" field's value of the BronzeHistoryK8sRoleBinding entity.
+// If the BronzeHistoryK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sRoleBindingMutation) OldRoleName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleName: %w", err)
+	}
+	return oldValue.RoleName, nil
+}
+
+// ResetRoleName resets all changes to the "role_name" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ResetRoleName() {
+	m.role_name = nil
+}
+
+// SetSubjectsJSON sets the "subjects_json" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) SetSubjectsJSON(jm json.RawMessage) {
+	m.subjects_json = &jm
+	m.appendsubjects_json = nil
+}
+
+// SubjectsJSON returns the value of the "subjects_json" field in the mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) SubjectsJSON() (r json.RawMessage, exists bool) {
+	v := m.subjects_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubjectsJSON returns the old "subjects_json" field's value of the BronzeHistoryK8sRoleBinding entity.
+// If the BronzeHistoryK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sRoleBindingMutation) OldSubjectsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubjectsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubjectsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubjectsJSON: %w", err)
+	}
+	return oldValue.SubjectsJSON, nil
+}
+
+// AppendSubjectsJSON adds jm to the "subjects_json" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) AppendSubjectsJSON(jm json.RawMessage) {
+	m.appendsubjects_json = append(m.appendsubjects_json, jm...)
+}
+
+// AppendedSubjectsJSON returns the list of values that were appended to the "subjects_json" field in this mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) AppendedSubjectsJSON() (json.RawMessage, bool) {
+	if len(m.appendsubjects_json) == 0 {
+		return nil, false
+	}
+	return m.appendsubjects_json, true
+}
+
+// ClearSubjectsJSON clears the value of the "subjects_json" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ClearSubjectsJSON() {
+	m.subjects_json = nil
+	m.appendsubjects_json = nil
+	m.clearedFields[bronzehistoryk8srolebinding.FieldSubjectsJSON] = struct{}{}
+}
+
+// SubjectsJSONCleared returns if the "subjects_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) SubjectsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8srolebinding.FieldSubjectsJSON]
+	return ok
+}
+
+// ResetSubjectsJSON resets all changes to the "subjects_json" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ResetSubjectsJSON() {
+	m.subjects_json = nil
+	m.appendsubjects_json = nil
+	delete(m.clearedFields, bronzehistoryk8srolebinding.FieldSubjectsJSON)
+}
+
+// SetRulesJSON sets the "rules_json" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) SetRulesJSON(jm json.RawMessage) {
+	m.rules_json = &jm
+	m.appendrules_json = nil
+}
+
+// RulesJSON returns the value of the "rules_json" field in the mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) RulesJSON() (r json.RawMessage, exists bool) {
+	v := m.rules_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRulesJSON returns the old "rules_json" field's value of the BronzeHistoryK8sRoleBinding entity.
+// If the BronzeHistoryK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sRoleBindingMutation) OldRulesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRulesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRulesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRulesJSON: %w", err)
+	}
+	return oldValue.RulesJSON, nil
+}
+
+// AppendRulesJSON adds jm to the "rules_json" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) AppendRulesJSON(jm json.RawMessage) {
+	m.appendrules_json = append(m.appendrules_json, jm...)
+}
+
+// AppendedRulesJSON returns the list of values that were appended to the "rules_json" field in this mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) AppendedRulesJSON() (json.RawMessage, bool) {
+	if len(m.appendrules_json) == 0 {
+		return nil, false
+	}
+	return m.appendrules_json, true
+}
+
+// ClearRulesJSON clears the value of the "rules_json" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ClearRulesJSON() {
+	m.rules_json = nil
+	m.appendrules_json = nil
+	m.clearedFields[bronzehistoryk8srolebinding.FieldRulesJSON] = struct{}{}
+}
+
+// RulesJSONCleared returns if the "rules_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) RulesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8srolebinding.FieldRulesJSON]
+	return ok
+}
+
+// ResetRulesJSON resets all changes to the "rules_json" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ResetRulesJSON() {
+	m.rules_json = nil
+	m.appendrules_json = nil
+	delete(m.clearedFields, bronzehistoryk8srolebinding.FieldRulesJSON)
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) SetLabelsJSON(jm json.RawMessage) {
+	m.labels_json = &jm
+	m.appendlabels_json = nil
+}
+
+// LabelsJSON returns the value of the "labels_json" field in the mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) LabelsJSON() (r json.RawMessage, exists bool) {
+	v := m.labels_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabelsJSON returns the old "labels_json" field's value of the BronzeHistoryK8sRoleBinding entity.
+// If the BronzeHistoryK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sRoleBindingMutation) OldLabelsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabelsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabelsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabelsJSON: %w", err)
+	}
+	return oldValue.LabelsJSON, nil
+}
+
+// AppendLabelsJSON adds jm to the "labels_json" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) AppendLabelsJSON(jm json.RawMessage) {
+	m.appendlabels_json = append(m.appendlabels_json, jm...)
+}
+
+// AppendedLabelsJSON returns the list of values that were appended to the "labels_json" field in this mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) AppendedLabelsJSON() (json.RawMessage, bool) {
+	if len(m.appendlabels_json) == 0 {
+		return nil, false
+	}
+	return m.appendlabels_json, true
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ClearLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	m.clearedFields[bronzehistoryk8srolebinding.FieldLabelsJSON] = struct{}{}
+}
+
+// LabelsJSONCleared returns if the "labels_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) LabelsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8srolebinding.FieldLabelsJSON]
+	return ok
+}
+
+// ResetLabelsJSON resets all changes to the "labels_json" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ResetLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	delete(m.clearedFields, bronzehistoryk8srolebinding.FieldLabelsJSON)
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) SetAPICreatedAt(t time.Time) {
+	m.api_created_at = &t
+}
+
+// APICreatedAt returns the value of the "api_created_at" field in the mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) APICreatedAt() (r time.Time, exists bool) {
+	v := m.api_created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPICreatedAt returns the old "api_created_at" field's value of the BronzeHistoryK8sRoleBinding entity.
+// If the BronzeHistoryK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sRoleBindingMutation) OldAPICreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPICreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPICreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPICreatedAt: %w", err)
+	}
+	return oldValue.APICreatedAt, nil
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ClearAPICreatedAt() {
+	m.api_created_at = nil
+	m.clearedFields[bronzehistoryk8srolebinding.FieldAPICreatedAt] = struct{}{}
+}
+
+// APICreatedAtCleared returns if the "api_created_at" field was cleared in this mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) APICreatedAtCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8srolebinding.FieldAPICreatedAt]
+	return ok
+}
+
+// ResetAPICreatedAt resets all changes to the "api_created_at" field.
+func (m *BronzeHistoryK8sRoleBindingMutation) ResetAPICreatedAt() {
+	m.api_created_at = nil
+	delete(m.clearedFields, bronzehistoryk8srolebinding.FieldAPICreatedAt)
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sRoleBindingMutation builder.
+func (m *BronzeHistoryK8sRoleBindingMutation) Where(ps ...predicate.BronzeHistoryK8sRoleBinding) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeHistoryK8sRoleBindingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeHistoryK8sRoleBindingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeHistoryK8sRoleBinding, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeHistoryK8sRoleBindingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeHistoryK8sRoleBindingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeHistoryK8sRoleBinding).
+func (m *BronzeHistoryK8sRoleBindingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeHistoryK8sRoleBindingMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.valid_from != nil {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldValidFrom)
+	}
+	if m.valid_to != nil {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldValidTo)
+	}
+	if m.collected_at != nil {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldFirstCollectedAt)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldResourceID)
+	}
+	if m.cluster_name != nil {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldClusterName)
+	}
+	if m.kind != nil {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldKind)
+	}
+	if m.namespace != nil {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldNamespace)
+	}
+	if m.name != nil {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldName)
+	}
+	if m.uid != nil {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldUID)
+	}
+	if m.role_kind != nil {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldRoleKind)
+	}
+	if m.role_name != nil {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldRoleName)
+	}
+	if m.subjects_json != nil {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldSubjectsJSON)
+	}
+	if m.rules_json != nil {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldRulesJSON)
+	}
+	if m.labels_json != nil {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldLabelsJSON)
+	}
+	if m.api_created_at != nil {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeHistoryK8sRoleBindingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryk8srolebinding.FieldValidFrom:
+		return m.ValidFrom()
+	case bronzehistoryk8srolebinding.FieldValidTo:
+		return m.ValidTo()
+	case bronzehistoryk8srolebinding.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzehistoryk8srolebinding.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzehistoryk8srolebinding.FieldResourceID:
+		return m.ResourceID()
+	case bronzehistoryk8srolebinding.FieldClusterName:
+		return m.ClusterName()
+	case bronzehistoryk8srolebinding.FieldKind:
+		return m.Kind()
+	case bronzehistoryk8srolebinding.FieldNamespace:
+		return m.Namespace()
+	case bronzehistoryk8srolebinding.FieldName:
+		return m.Name()
+	case bronzehistoryk8srolebinding.FieldUID:
+		return m.UID()
+	case bronzehistoryk8srolebinding.FieldRoleKind:
+		return m.RoleKind()
+	case bronzehistoryk8srolebinding.FieldRoleName:
+		return m.RoleName()
+	case bronzehistoryk8srolebinding.FieldSubjectsJSON:
+		return m.SubjectsJSON()
+	case bronzehistoryk8srolebinding.FieldRulesJSON:
+		return m.RulesJSON()
+	case bronzehistoryk8srolebinding.FieldLabelsJSON:
+		return m.LabelsJSON()
+	case bronzehistoryk8srolebinding.FieldAPICreatedAt:
+		return m.APICreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeHistoryK8sRoleBindingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzehistoryk8srolebinding.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case bronzehistoryk8srolebinding.FieldValidTo:
+		return m.OldValidTo(ctx)
+	case bronzehistoryk8srolebinding.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzehistoryk8srolebinding.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzehistoryk8srolebinding.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case bronzehistoryk8srolebinding.FieldClusterName:
+		return m.OldClusterName(ctx)
+	case bronzehistoryk8srolebinding.FieldKind:
+		return m.OldKind(ctx)
+	case bronzehistoryk8srolebinding.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case bronzehistoryk8srolebinding.FieldName:
+		return m.OldName(ctx)
+	case bronzehistoryk8srolebinding.FieldUID:
+		return m.OldUID(ctx)
+	case bronzehistoryk8srolebinding.FieldRoleKind:
+		return m.OldRoleKind(ctx)
+	case bronzehistoryk8srolebinding.FieldRoleName:
+		return m.OldRoleName(ctx)
+	case bronzehistoryk8srolebinding.FieldSubjectsJSON:
+		return m.OldSubjectsJSON(ctx)
+	case bronzehistoryk8srolebinding.FieldRulesJSON:
+		return m.OldRulesJSON(ctx)
+	case bronzehistoryk8srolebinding.FieldLabelsJSON:
+		return m.OldLabelsJSON(ctx)
+	case bronzehistoryk8srolebinding.FieldAPICreatedAt:
+		return m.OldAPICreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeHistoryK8sRoleBinding field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryK8sRoleBindingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryk8srolebinding.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case bronzehistoryk8srolebinding.FieldValidTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidTo(v)
+		return nil
+	case bronzehistoryk8srolebinding.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzehistoryk8srolebinding.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzehistoryk8srolebinding.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case bronzehistoryk8srolebinding.FieldClusterName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterName(v)
+		return nil
+	case bronzehistoryk8srolebinding.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case bronzehistoryk8srolebinding.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case bronzehistoryk8srolebinding.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case bronzehistoryk8srolebinding.FieldUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUID(v)
+		return nil
+	case bronzehistoryk8srolebinding.FieldRoleKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleKind(v)
+		return nil
+	case bronzehistoryk8srolebinding.FieldRoleName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleName(v)
+		return nil
+	case bronzehistoryk8srolebinding.FieldSubjectsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubjectsJSON(v)
+		return nil
+	case bronzehistoryk8srolebinding.FieldRulesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRulesJSON(v)
+		return nil
+	case bronzehistoryk8srolebinding.FieldLabelsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabelsJSON(v)
+		return nil
+	case bronzehistoryk8srolebinding.FieldAPICreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPICreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sRoleBinding field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeHistoryK8sRoleBindingMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryK8sRoleBindingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sRoleBinding numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzehistoryk8srolebinding.FieldValidTo) {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldValidTo)
+	}
+	if m.FieldCleared(bronzehistoryk8srolebinding.FieldNamespace) {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldNamespace)
+	}
+	if m.FieldCleared(bronzehistoryk8srolebinding.FieldUID) {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldUID)
+	}
+	if m.FieldCleared(bronzehistoryk8srolebinding.FieldSubjectsJSON) {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldSubjectsJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8srolebinding.FieldRulesJSON) {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldRulesJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8srolebinding.FieldLabelsJSON) {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldLabelsJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8srolebinding.FieldAPICreatedAt) {
+		fields = append(fields, bronzehistoryk8srolebinding.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeHistoryK8sRoleBindingMutation) ClearField(name string) error {
+	switch name {
+	case bronzehistoryk8srolebinding.FieldValidTo:
+		m.ClearValidTo()
+		return nil
+	case bronzehistoryk8srolebinding.FieldNamespace:
+		m.ClearNamespace()
+		return nil
+	case bronzehistoryk8srolebinding.FieldUID:
+		m.ClearUID()
+		return nil
+	case bronzehistoryk8srolebinding.FieldSubjectsJSON:
+		m.ClearSubjectsJSON()
+		return nil
+	case bronzehistoryk8srolebinding.FieldRulesJSON:
+		m.ClearRulesJSON()
+		return nil
+	case bronzehistoryk8srolebinding.FieldLabelsJSON:
+		m.ClearLabelsJSON()
+		return nil
+	case bronzehistoryk8srolebinding.FieldAPICreatedAt:
+		m.ClearAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sRoleBinding nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeHistoryK8sRoleBindingMutation) ResetField(name string) error {
+	switch name {
+	case bronzehistoryk8srolebinding.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case bronzehistoryk8srolebinding.FieldValidTo:
+		m.ResetValidTo()
+		return nil
+	case bronzehistoryk8srolebinding.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzehistoryk8srolebinding.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzehistoryk8srolebinding.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case bronzehistoryk8srolebinding.FieldClusterName:
+		m.ResetClusterName()
+		return nil
+	case bronzehistoryk8srolebinding.FieldKind:
+		m.ResetKind()
+		return nil
+	case bronzehistoryk8srolebinding.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case bronzehistoryk8srolebinding.FieldName:
+		m.ResetName()
+		return nil
+	case bronzehistoryk8srolebinding.FieldUID:
+		m.ResetUID()
+		return nil
+	case bronzehistoryk8srolebinding.FieldRoleKind:
+		m.ResetRoleKind()
+		return nil
+	case bronzehistoryk8srolebinding.FieldRoleName:
+		m.ResetRoleName()
+		return nil
+	case bronzehistoryk8srolebinding.FieldSubjectsJSON:
+		m.ResetSubjectsJSON()
+		return nil
+	case bronzehistoryk8srolebinding.FieldRulesJSON:
+		m.ResetRulesJSON()
+		return nil
+	case bronzehistoryk8srolebinding.FieldLabelsJSON:
+		m.ResetLabelsJSON()
+		return nil
+	case bronzehistoryk8srolebinding.FieldAPICreatedAt:
+		m.ResetAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sRoleBinding field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeHistoryK8sRoleBindingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeHistoryK8sRoleBindingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryK8sRoleBinding unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeHistoryK8sRoleBindingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryK8sRoleBinding edge %s", name)
+}
+
+// BronzeHistoryK8sServiceAccountMutation represents an operation that mutates the BronzeHistoryK8sServiceAccount nodes in the graph.
+type BronzeHistoryK8sServiceAccountMutation struct {
+	config
+	op                            Op
+	typ                           string
+	id                            *uint
+	valid_from                    *time.Time
+	valid_to                      *time.Time
+	collected_at                  *time.Time
+	first_collected_at            *time.Time
+	resource_id                   *string
+	cluster_name                  *string
+	namespace                     *string
+	name                          *string
+	uid                           *string
+	automount_token               *bool
+	cloud_identity                *string
+	image_pull_secrets_json       *json.RawMessage
+	appendimage_pull_secrets_json json.RawMessage
+	labels_json                   *json.RawMessage
+	appendlabels_json             json.RawMessage
+	api_created_at                *time.Time
+	clearedFields                 map[string]struct{}
+	done                          bool
+	oldValue                      func(context.Context) (*BronzeHistoryK8sServiceAccount, error)
+	predicates                    []predicate.BronzeHistoryK8sServiceAccount
+}
+
+var _ ent.Mutation = (*BronzeHistoryK8sServiceAccountMutation)(nil)
+
+// bronzehistoryk8sserviceaccountOption allows management of the mutation configuration using functional options.
+type bronzehistoryk8sserviceaccountOption func(*BronzeHistoryK8sServiceAccountMutation)
+
+// newBronzeHistoryK8sServiceAccountMutation creates new mutation for the BronzeHistoryK8sServiceAccount entity.
+func newBronzeHistoryK8sServiceAccountMutation(c config, op Op, opts ...bronzehistoryk8sserviceaccountOption) *BronzeHistoryK8sServiceAccountMutation {
+	m := &BronzeHistoryK8sServiceAccountMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeHistoryK8sServiceAccount,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeHistoryK8sServiceAccountID sets the ID field of the mutation.
+func withBronzeHistoryK8sServiceAccountID(id uint) bronzehistoryk8sserviceaccountOption {
+	return func(m *BronzeHistoryK8sServiceAccountMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeHistoryK8sServiceAccount
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeHistoryK8sServiceAccount, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeHistoryK8sServiceAccount.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeHistoryK8sServiceAccount sets the old BronzeHistoryK8sServiceAccount of the mutation.
+func withBronzeHistoryK8sServiceAccount(node *BronzeHistoryK8sServiceAccount) bronzehistoryk8sserviceaccountOption {
+	return func(m *BronzeHistoryK8sServiceAccountMutation) {
+		m.oldValue = func(context.Context) (*BronzeHistoryK8sServiceAccount, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeHistoryK8sServiceAccountMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeHistoryK8sServiceAccountMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("kubernetes: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeHistoryK8sServiceAccount entities.
+func (m *BronzeHistoryK8sServiceAccountMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeHistoryK8sServiceAccountMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeHistoryK8sServiceAccount.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the BronzeHistoryK8sServiceAccount entity.
+// If the BronzeHistoryK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sServiceAccountMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidTo sets the "valid_to" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) SetValidTo(t time.Time) {
+	m.valid_to = &t
+}
+
+// ValidTo returns the value of the "valid_to" field in the mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) ValidTo() (r time.Time, exists bool) {
+	v := m.valid_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidTo returns the old "valid_to" field's value of the BronzeHistoryK8sServiceAccount entity.
+// If the BronzeHistoryK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sServiceAccountMutation) OldValidTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidTo: %w", err)
+	}
+	return oldValue.ValidTo, nil
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ClearValidTo() {
+	m.valid_to = nil
+	m.clearedFields[bronzehistoryk8sserviceaccount.FieldValidTo] = struct{}{}
+}
+
+// ValidToCleared returns if the "valid_to" field was cleared in this mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) ValidToCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8sserviceaccount.FieldValidTo]
+	return ok
+}
+
+// ResetValidTo resets all changes to the "valid_to" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ResetValidTo() {
+	m.valid_to = nil
+	delete(m.clearedFields, bronzehistoryk8sserviceaccount.FieldValidTo)
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeHistoryK8sServiceAccount entity.
+// If the BronzeHistoryK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sServiceAccountMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeHistoryK8sServiceAccount entity.
+// If the BronzeHistoryK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sServiceAccountMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the BronzeHistoryK8sServiceAccount entity.
+// If the BronzeHistoryK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sServiceAccountMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) SetClusterName(s string) {
+	m.cluster_name = &s
+}
+
+// ClusterName returns the value of the "cluster_name" field in the mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) ClusterName() (r string, exists bool) {
+	v := m.cluster_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClusterName returns the old "cluster_name" field's value of the BronzeHistoryK8sServiceAccount entity.
+// If the BronzeHistoryK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sServiceAccountMutation) OldClusterName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterName: %w", err)
+	}
+	return oldValue.ClusterName, nil
+}
+
+// ResetClusterName resets all changes to the "cluster_name" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ResetClusterName() {
+	m.cluster_name = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the BronzeHistoryK8sServiceAccount entity.
+// If the BronzeHistoryK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sServiceAccountMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetName sets the "name" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the BronzeHistoryK8sServiceAccount entity.
+// If the BronzeHistoryK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sServiceAccountMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUID sets the "uid" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) SetUID(s string) {
+	m.uid = &s
+}
+
+// UID returns the value of the "uid" field in the mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) UID() (r string, exists bool) {
+	v := m.uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUID returns the old "uid" field's value of the BronzeHistoryK8sServiceAccount entity.
+// If the BronzeHistoryK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sServiceAccountMutation) OldUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+	}
+	return oldValue.UID, nil
+}
+
+// ClearUID clears the value of the "uid" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ClearUID() {
+	m.uid = nil
+	m.clearedFields[bronzehistoryk8sserviceaccount.FieldUID] = struct{}{}
+}
+
+// UIDCleared returns if the "uid" field was cleared in this mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) UIDCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8sserviceaccount.FieldUID]
+	return ok
+}
+
+// ResetUID resets all changes to the "uid" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ResetUID() {
+	m.uid = nil
+	delete(m.clearedFields, bronzehistoryk8sserviceaccount.FieldUID)
+}
+
+// SetAutomountToken sets the "automount_token" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) SetAutomountToken(b bool) {
+	m.automount_token = &b
+}
+
+// AutomountToken returns the value of the "automount_token" field in the mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) AutomountToken() (r bool, exists bool) {
+	v := m.automount_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutomountToken returns the old "automount_token" field's value of the BronzeHistoryK8sServiceAccount entity.
+// If the BronzeHistoryK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sServiceAccountMutation) OldAutomountToken(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutomountToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutomountToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutomountToken: %w", err)
+	}
+	return oldValue.AutomountToken, nil
+}
+
+// ClearAutomountToken clears the value of the "automount_token" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ClearAutomountToken() {
+	m.automount_token = nil
+	m.clearedFields[bronzehistoryk8sserviceaccount.FieldAutomountToken] = struct{}{}
+}
+
+// AutomountTokenCleared returns if the "automount_token" field was cleared in this mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) AutomountTokenCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8sserviceaccount.FieldAutomountToken]
+	return ok
+}
+
+// ResetAutomountToken resets all changes to the "automount_token" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ResetAutomountToken() {
+	m.automount_token = nil
+	delete(m.clearedFields, bronzehistoryk8sserviceaccount.FieldAutomountToken)
+}
+
+// SetCloudIdentity sets the "cloud_identity" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) SetCloudIdentity(s string) {
+	m.cloud_identity = &s
+}
+
+// CloudIdentity returns the value of the "cloud_identity" field in the mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) CloudIdentity() (r string, exists bool) {
+	v := m.cloud_identity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCloudIdentity returns the old "cloud_identity" field's value of the BronzeHistoryK8sServiceAccount entity.
+// If the BronzeHistoryK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sServiceAccountMutation) OldCloudIdentity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCloudIdentity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCloudIdentity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCloudIdentity: %w", err)
+	}
+	return oldValue.CloudIdentity, nil
+}
+
+// ClearCloudIdentity clears the value of the "cloud_identity" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ClearCloudIdentity() {
+	m.cloud_identity = nil
+	m.clearedFields[bronzehistoryk8sserviceaccount.FieldCloudIdentity] = struct{}{}
+}
+
+// CloudIdentityCleared returns if the "cloud_identity" field was cleared in this mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) CloudIdentityCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8sserviceaccount.FieldCloudIdentity]
+	return ok
+}
+
+// ResetCloudIdentity resets all changes to the "cloud_identity" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ResetCloudIdentity() {
+	m.cloud_identity = nil
+	delete(m.clearedFields, bronzehistoryk8sserviceaccount.FieldCloudIdentity)
+}
+
+// SetImagePullSecretsJSON sets the "image_pull_secrets_json" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) SetImagePullSecretsJSON(jm json.RawMessage) {
+	m.image_pull_secrets_json = &jm
+	m.appendimage_pull_secrets_json = nil
+}
+
+// ImagePullSecretsJSON returns the value of the "image_pull_secrets_json" field in the mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) ImagePullSecretsJSON() (r json.RawMessage, exists bool) {
+	v := m.image_pull_secrets_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImagePullSecretsJSON returns the old "image_pull_secrets_json" field's value of the BronzeHistoryK8sServiceAccount entity.
+// If the BronzeHistoryK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sServiceAccountMutation) OldImagePullSecretsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImagePullSecretsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImagePullSecretsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImagePullSecretsJSON: %w", err)
+	}
+	return oldValue.ImagePullSecretsJSON, nil
+}
+
+// AppendImagePullSecretsJSON adds jm to the "image_pull_secrets_json" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) AppendImagePullSecretsJSON(jm json.RawMessage) {
+	m.appendimage_pull_secrets_json = append(m.appendimage_pull_secrets_json, jm...)
+}
+
+// AppendedImagePullSecretsJSON returns the list of values that were appended to the "image_pull_secrets_json" field in this mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) AppendedImagePullSecretsJSON() (json.RawMessage, bool) {
+	if len(m.appendimage_pull_secrets_json) == 0 {
+		return nil, false
+	}
+	return m.appendimage_pull_secrets_json, true
+}
+
+// ClearImagePullSecretsJSON clears the value of the "image_pull_secrets_json" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ClearImagePullSecretsJSON() {
+	m.image_pull_secrets_json = nil
+	m.appendimage_pull_secrets_json = nil
+	m.clearedFields[bronzehistoryk8sserviceaccount.FieldImagePullSecretsJSON] = struct{}{}
+}
+
+// ImagePullSecretsJSONCleared returns if the "image_pull_secrets_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) ImagePullSecretsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8sserviceaccount.FieldImagePullSecretsJSON]
+	return ok
+}
+
+// ResetImagePullSecretsJSON resets all changes to the "image_pull_secrets_json" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ResetImagePullSecretsJSON() {
+	m.image_pull_secrets_json = nil
+	m.appendimage_pull_secrets_json = nil
+	delete(m.clearedFields, bronzehistoryk8sserviceaccount.FieldImagePullSecretsJSON)
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) SetLabelsJSON(jm json.RawMessage) {
+	m.labels_json = &jm
+	m.appendlabels_json = nil
+}
+
+// LabelsJSON returns the value of the "labels_json" field in the mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) LabelsJSON() (r json.RawMessage, exists bool) {
+	v := m.labels_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabelsJSON returns the old "labels_json" field's value of the BronzeHistoryK8sServiceAccount entity.
+// If the BronzeHistoryK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sServiceAccountMutation) OldLabelsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabelsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabelsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabelsJSON: %w", err)
+	}
+	return oldValue.LabelsJSON, nil
+}
+
+// AppendLabelsJSON adds jm to the "labels_json" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) AppendLabelsJSON(jm json.RawMessage) {
+	m.appendlabels_json = append(m.appendlabels_json, jm...)
+}
+
+// AppendedLabelsJSON returns the list of values that were appended to the "labels_json" field in this mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) AppendedLabelsJSON() (json.RawMessage, bool) {
+	if len(m.appendlabels_json) == 0 {
+		return nil, false
+	}
+	return m.appendlabels_json, true
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ClearLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	m.clearedFields[bronzehistoryk8sserviceaccount.FieldLabelsJSON] = struct{}{}
+}
+
+// LabelsJSONCleared returns if the "labels_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) LabelsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8sserviceaccount.FieldLabelsJSON]
+	return ok
+}
+
+// ResetLabelsJSON resets all changes to the "labels_json" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ResetLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	delete(m.clearedFields, bronzehistoryk8sserviceaccount.FieldLabelsJSON)
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) SetAPICreatedAt(t time.Time) {
+	m.api_created_at = &t
+}
+
+// APICreatedAt returns the value of the "api_created_at" field in the mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) APICreatedAt() (r time.Time, exists bool) {
+	v := m.api_created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPICreatedAt returns the old "api_created_at" field's value of the BronzeHistoryK8sServiceAccount entity.
+// If the BronzeHistoryK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sServiceAccountMutation) OldAPICreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPICreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPICreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPICreatedAt: %w", err)
+	}
+	return oldValue.APICreatedAt, nil
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ClearAPICreatedAt() {
+	m.api_created_at = nil
+	m.clearedFields[bronzehistoryk8sserviceaccount.FieldAPICreatedAt] = struct{}{}
+}
+
+// APICreatedAtCleared returns if the "api_created_at" field was cleared in this mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) APICreatedAtCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8sserviceaccount.FieldAPICreatedAt]
+	return ok
+}
+
+// ResetAPICreatedAt resets all changes to the "api_created_at" field.
+func (m *BronzeHistoryK8sServiceAccountMutation) ResetAPICreatedAt() {
+	m.api_created_at = nil
+	delete(m.clearedFields, bronzehistoryk8sserviceaccount.FieldAPICreatedAt)
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sServiceAccountMutation builder.
+func (m *BronzeHistoryK8sServiceAccountMutation) Where(ps ...predicate.BronzeHistoryK8sServiceAccount) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeHistoryK8sServiceAccountMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeHistoryK8sServiceAccountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeHistoryK8sServiceAccount, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeHistoryK8sServiceAccountMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeHistoryK8sServiceAccountMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeHistoryK8sServiceAccount).
+func (m *BronzeHistoryK8sServiceAccountMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeHistoryK8sServiceAccountMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.valid_from != nil {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldValidFrom)
+	}
+	if m.valid_to != nil {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldValidTo)
+	}
+	if m.collected_at != nil {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldFirstCollectedAt)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldResourceID)
+	}
+	if m.cluster_name != nil {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldClusterName)
+	}
+	if m.namespace != nil {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldNamespace)
+	}
+	if m.name != nil {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldName)
+	}
+	if m.uid != nil {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldUID)
+	}
+	if m.automount_token != nil {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldAutomountToken)
+	}
+	if m.cloud_identity != nil {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldCloudIdentity)
+	}
+	if m.image_pull_secrets_json != nil {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldImagePullSecretsJSON)
+	}
+	if m.labels_json != nil {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldLabelsJSON)
+	}
+	if m.api_created_at != nil {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeHistoryK8sServiceAccountMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryk8sserviceaccount.FieldValidFrom:
+		return m.ValidFrom()
+	case bronzehistoryk8sserviceaccount.FieldValidTo:
+		return m.ValidTo()
+	case bronzehistoryk8sserviceaccount.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzehistoryk8sserviceaccount.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzehistoryk8sserviceaccount.FieldResourceID:
+		return m.ResourceID()
+	case bronzehistoryk8sserviceaccount.FieldClusterName:
+		return m.ClusterName()
+	case bronzehistoryk8sserviceaccount.FieldNamespace:
+		return m.Namespace()
+	case bronzehistoryk8sserviceaccount.FieldName:
+		return m.Name()
+	case bronzehistoryk8sserviceaccount.FieldUID:
+		return m.UID()
+	case bronzehistoryk8sserviceaccount.FieldAutomountToken:
+		return m.AutomountToken()
+	case bronzehistoryk8sserviceaccount.FieldCloudIdentity:
+		return m.CloudIdentity()
+	case bronzehistoryk8sserviceaccount.FieldImagePullSecretsJSON:
+		return m.ImagePullSecretsJSON()
+	case bronzehistoryk8sserviceaccount.FieldLabelsJSON:
+		return m.LabelsJSON()
+	case bronzehistoryk8sserviceaccount.FieldAPICreatedAt:
+		return m.APICreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeHistoryK8sServiceAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzehistoryk8sserviceaccount.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case bronzehistoryk8sserviceaccount.FieldValidTo:
+		return m.OldValidTo(ctx)
+	case bronzehistoryk8sserviceaccount.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzehistoryk8sserviceaccount.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzehistoryk8sserviceaccount.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case bronzehistoryk8sserviceaccount.FieldClusterName:
+		return m.OldClusterName(ctx)
+	case bronzehistoryk8sserviceaccount.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case bronzehistoryk8sserviceaccount.FieldName:
+		return m.OldName(ctx)
+	case bronzehistoryk8sserviceaccount.FieldUID:
+		return m.OldUID(ctx)
+	case bronzehistoryk8sserviceaccount.FieldAutomountToken:
+		return m.OldAutomountToken(ctx)
+	case bronzehistoryk8sserviceaccount.FieldCloudIdentity:
+		return m.OldCloudIdentity(ctx)
+	case bronzehistoryk8sserviceaccount.FieldImagePullSecretsJSON:
+		return m.OldImagePullSecretsJSON(ctx)
+	case bronzehistoryk8sserviceaccount.FieldLabelsJSON:
+		return m.OldLabelsJSON(ctx)
+	case bronzehistoryk8sserviceaccount.FieldAPICreatedAt:
+		return m.OldAPICreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeHistoryK8sServiceAccount field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryK8sServiceAccountMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryk8sserviceaccount.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldValidTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidTo(v)
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldClusterName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterName(v)
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUID(v)
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldAutomountToken:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutomountToken(v)
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldCloudIdentity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCloudIdentity(v)
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldImagePullSecretsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImagePullSecretsJSON(v)
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldLabelsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabelsJSON(v)
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldAPICreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPICreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sServiceAccount field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeHistoryK8sServiceAccountMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryK8sServiceAccountMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sServiceAccount numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzehistoryk8sserviceaccount.FieldValidTo) {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldValidTo)
+	}
+	if m.FieldCleared(bronzehistoryk8sserviceaccount.FieldUID) {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldUID)
+	}
+	if m.FieldCleared(bronzehistoryk8sserviceaccount.FieldAutomountToken) {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldAutomountToken)
+	}
+	if m.FieldCleared(bronzehistoryk8sserviceaccount.FieldCloudIdentity) {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldCloudIdentity)
+	}
+	if m.FieldCleared(bronzehistoryk8sserviceaccount.FieldImagePullSecretsJSON) {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldImagePullSecretsJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8sserviceaccount.FieldLabelsJSON) {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldLabelsJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8sserviceaccount.FieldAPICreatedAt) {
+		fields = append(fields, bronzehistoryk8sserviceaccount.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeHistoryK8sServiceAccountMutation) ClearField(name string) error {
+	switch name {
+	case bronzehistoryk8sserviceaccount.FieldValidTo:
+		m.ClearValidTo()
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldUID:
+		m.ClearUID()
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldAutomountToken:
+		m.ClearAutomountToken()
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldCloudIdentity:
+		m.ClearCloudIdentity()
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldImagePullSecretsJSON:
+		m.ClearImagePullSecretsJSON()
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldLabelsJSON:
+		m.ClearLabelsJSON()
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldAPICreatedAt:
+		m.ClearAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sServiceAccount nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeHistoryK8sServiceAccountMutation) ResetField(name string) error {
+	switch name {
+	case bronzehistoryk8sserviceaccount.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldValidTo:
+		m.ResetValidTo()
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldClusterName:
+		m.ResetClusterName()
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldName:
+		m.ResetName()
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldUID:
+		m.ResetUID()
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldAutomountToken:
+		m.ResetAutomountToken()
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldCloudIdentity:
+		m.ResetCloudIdentity()
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldImagePullSecretsJSON:
+		m.ResetImagePullSecretsJSON()
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldLabelsJSON:
+		m.ResetLabelsJSON()
+		return nil
+	case bronzehistoryk8sserviceaccount.FieldAPICreatedAt:
+		m.ResetAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sServiceAccount field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeHistoryK8sServiceAccountMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeHistoryK8sServiceAccountMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryK8sServiceAccount unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeHistoryK8sServiceAccountMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryK8sServiceAccount edge %s", name)
+}
+
+// BronzeHistoryK8sWorkloadMutation represents an operation that mutates the BronzeHistoryK8sWorkload nodes in the graph.
+type BronzeHistoryK8sWorkloadMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uint
+	valid_from            *time.Time
+	valid_to              *time.Time
+	collected_at          *time.Time
+	first_collected_at    *time.Time
+	resource_id           *string
+	cluster_name          *string
+	namespace             *string
+	kind                  *string
+	name                  *string
+	uid                   *string
+	replicas              *int32
+	addreplicas           *int32
+	service_account       *string
+	host_network          *bool
+	host_pid              *bool
+	host_ipc              *bool
+	images_json           *json.RawMessage
+	appendimages_json     json.RawMessage
+	containers_json       *json.RawMessage
+	appendcontainers_json json.RawMessage
+	selector_json         *json.RawMessage
+	appendselector_json   json.RawMessage
+	labels_json           *json.RawMessage
+	appendlabels_json     json.RawMessage
+	api_created_at        *time.Time
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*BronzeHistoryK8sWorkload, error)
+	predicates            []predicate.BronzeHistoryK8sWorkload
+}
+
+var _ ent.Mutation = (*BronzeHistoryK8sWorkloadMutation)(nil)
+
+// bronzehistoryk8sworkloadOption allows management of the mutation configuration using functional options.
+type bronzehistoryk8sworkloadOption func(*BronzeHistoryK8sWorkloadMutation)
+
+// newBronzeHistoryK8sWorkloadMutation creates new mutation for the BronzeHistoryK8sWorkload entity.
+func newBronzeHistoryK8sWorkloadMutation(c config, op Op, opts ...bronzehistoryk8sworkloadOption) *BronzeHistoryK8sWorkloadMutation {
+	m := &BronzeHistoryK8sWorkloadMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeHistoryK8sWorkload,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeHistoryK8sWorkloadID sets the ID field of the mutation.
+func withBronzeHistoryK8sWorkloadID(id uint) bronzehistoryk8sworkloadOption {
+	return func(m *BronzeHistoryK8sWorkloadMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeHistoryK8sWorkload
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeHistoryK8sWorkload, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeHistoryK8sWorkload.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeHistoryK8sWorkload sets the old BronzeHistoryK8sWorkload of the mutation.
+func withBronzeHistoryK8sWorkload(node *BronzeHistoryK8sWorkload) bronzehistoryk8sworkloadOption {
+	return func(m *BronzeHistoryK8sWorkloadMutation) {
+		m.oldValue = func(context.Context) (*BronzeHistoryK8sWorkload, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeHistoryK8sWorkloadMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeHistoryK8sWorkloadMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("kubernetes: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeHistoryK8sWorkload entities.
+func (m *BronzeHistoryK8sWorkloadMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeHistoryK8sWorkloadMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeHistoryK8sWorkload.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidTo sets the "valid_to" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetValidTo(t time.Time) {
+	m.valid_to = &t
+}
+
+// ValidTo returns the value of the "valid_to" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) ValidTo() (r time.Time, exists bool) {
+	v := m.valid_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidTo returns the old "valid_to" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldValidTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidTo: %w", err)
+	}
+	return oldValue.ValidTo, nil
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ClearValidTo() {
+	m.valid_to = nil
+	m.clearedFields[bronzehistoryk8sworkload.FieldValidTo] = struct{}{}
+}
+
+// ValidToCleared returns if the "valid_to" field was cleared in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) ValidToCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8sworkload.FieldValidTo]
+	return ok
+}
+
+// ResetValidTo resets all changes to the "valid_to" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetValidTo() {
+	m.valid_to = nil
+	delete(m.clearedFields, bronzehistoryk8sworkload.FieldValidTo)
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetResourceID sets the "resource_id" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetResourceID(s string) {
+	m.resource_id = &s
+}
+
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceID returns the old "resource_id" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
+}
+
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetResourceID() {
+	m.resource_id = nil
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetClusterName(s string) {
+	m.cluster_name = &s
+}
+
+// ClusterName returns the value of the "cluster_name" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) ClusterName() (r string, exists bool) {
+	v := m.cluster_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClusterName returns the old "cluster_name" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldClusterName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterName: %w", err)
+	}
+	return oldValue.ClusterName, nil
+}
+
+// ResetClusterName resets all changes to the "cluster_name" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetClusterName() {
+	m.cluster_name = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetName sets the "name" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUID sets the "uid" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetUID(s string) {
+	m.uid = &s
+}
+
+// UID returns the value of the "uid" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) UID() (r string, exists bool) {
+	v := m.uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUID returns the old "uid" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+	}
+	return oldValue.UID, nil
+}
+
+// ClearUID clears the value of the "uid" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ClearUID() {
+	m.uid = nil
+	m.clearedFields[bronzehistoryk8sworkload.FieldUID] = struct{}{}
+}
+
+// UIDCleared returns if the "uid" field was cleared in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) UIDCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8sworkload.FieldUID]
+	return ok
+}
+
+// ResetUID resets all changes to the "uid" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetUID() {
+	m.uid = nil
+	delete(m.clearedFields, bronzehistoryk8sworkload.FieldUID)
+}
+
+// SetReplicas sets the "replicas" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetReplicas(i int32) {
+	m.replicas = &i
+	m.addreplicas = nil
+}
+
+// Replicas returns the value of the "replicas" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) Replicas() (r int32, exists bool) {
+	v := m.replicas
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplicas returns the old "replicas" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldReplicas(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplicas is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplicas requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplicas: %w", err)
+	}
+	return oldValue.Replicas, nil
+}
+
+// AddReplicas adds i to the "replicas" field.
+func (m *BronzeHistoryK8sWorkloadMutation) AddReplicas(i int32) {
+	if m.addreplicas != nil {
+		*m.addreplicas += i
+	} else {
+		m.addreplicas = &i
+	}
+}
+
+// AddedReplicas returns the value that was added to the "replicas" field in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) AddedReplicas() (r int32, exists bool) {
+	v := m.addreplicas
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearReplicas clears the value of the "replicas" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ClearReplicas() {
+	m.replicas = nil
+	m.addreplicas = nil
+	m.clearedFields[bronzehistoryk8sworkload.FieldReplicas] = struct{}{}
+}
+
+// ReplicasCleared returns if the "replicas" field was cleared in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) ReplicasCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8sworkload.FieldReplicas]
+	return ok
+}
+
+// ResetReplicas resets all changes to the "replicas" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetReplicas() {
+	m.replicas = nil
+	m.addreplicas = nil
+	delete(m.clearedFields, bronzehistoryk8sworkload.FieldReplicas)
+}
+
+// SetServiceAccount sets the "service_account" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetServiceAccount(s string) {
+	m.service_account = &s
+}
+
+// ServiceAccount returns the value of the "service_account" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) ServiceAccount() (r string, exists bool) {
+	v := m.service_account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServiceAccount returns the old "service_account" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldServiceAccount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServiceAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServiceAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServiceAccount: %w", err)
+	}
+	return oldValue.ServiceAccount, nil
+}
+
+// ClearServiceAccount clears the value of the "service_account" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ClearServiceAccount() {
+	m.service_account = nil
+	m.clearedFields[bronzehistoryk8sworkload.FieldServiceAccount] = struct{}{}
+}
+
+// ServiceAccountCleared returns if the "service_account" field was cleared in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) ServiceAccountCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8sworkload.FieldServiceAccount]
+	return ok
+}
+
+// ResetServiceAccount resets all changes to the "service_account" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetServiceAccount() {
+	m.service_account = nil
+	delete(m.clearedFields, bronzehistoryk8sworkload.FieldServiceAccount)
+}
+
+// SetHostNetwork sets the "host_network" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetHostNetwork(b bool) {
+	m.host_network = &b
+}
+
+// HostNetwork returns the value of the "host_network" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) HostNetwork() (r bool, exists bool) {
+	v := m.host_network
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostNetwork returns the old "host_network" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldHostNetwork(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostNetwork is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostNetwork requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostNetwork: %w", err)
+	}
+	return oldValue.HostNetwork, nil
+}
+
+// ResetHostNetwork resets all changes to the "host_network" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetHostNetwork() {
+	m.host_network = nil
+}
+
+// SetHostPid sets the "host_pid" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetHostPid(b bool) {
+	m.host_pid = &b
+}
+
+// HostPid returns the value of the "host_pid" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) HostPid() (r bool, exists bool) {
+	v := m.host_pid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostPid returns the old "host_pid" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldHostPid(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostPid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostPid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostPid: %w", err)
+	}
+	return oldValue.HostPid, nil
+}
+
+// ResetHostPid resets all changes to the "host_pid" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetHostPid() {
+	m.host_pid = nil
+}
+
+// SetHostIpc sets the "host_ipc" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetHostIpc(b bool) {
+	m.host_ipc = &b
+}
+
+// HostIpc returns the value of the "host_ipc" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) HostIpc() (r bool, exists bool) {
+	v := m.host_ipc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostIpc returns the old "host_ipc" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldHostIpc(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostIpc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostIpc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostIpc: %w", err)
+	}
+	return oldValue.HostIpc, nil
+}
+
+// ResetHostIpc resets all changes to the "host_ipc" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetHostIpc() {
+	m.host_ipc = nil
+}
+
+// SetImagesJSON sets the "images_json" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetImagesJSON(jm json.RawMessage) {
+	m.images_json = &jm
+	m.appendimages_json = nil
+}
+
+// ImagesJSON returns the value of the "images_json" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) ImagesJSON() (r json.RawMessage, exists bool) {
+	v := m.images_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImagesJSON returns the old "images_json" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldImagesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImagesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImagesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImagesJSON: %w", err)
+	}
+	return oldValue.ImagesJSON, nil
+}
+
+// AppendImagesJSON adds jm to the "images_json" field.
+func (m *BronzeHistoryK8sWorkloadMutation) AppendImagesJSON(jm json.RawMessage) {
+	m.appendimages_json = append(m.appendimages_json, jm...)
+}
+
+// AppendedImagesJSON returns the list of values that were appended to the "images_json" field in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) AppendedImagesJSON() (json.RawMessage, bool) {
+	if len(m.appendimages_json) == 0 {
+		return nil, false
+	}
+	return m.appendimages_json, true
+}
+
+// ClearImagesJSON clears the value of the "images_json" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ClearImagesJSON() {
+	m.images_json = nil
+	m.appendimages_json = nil
+	m.clearedFields[bronzehistoryk8sworkload.FieldImagesJSON] = struct{}{}
+}
+
+// ImagesJSONCleared returns if the "images_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) ImagesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8sworkload.FieldImagesJSON]
+	return ok
+}
+
+// ResetImagesJSON resets all changes to the "images_json" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetImagesJSON() {
+	m.images_json = nil
+	m.appendimages_json = nil
+	delete(m.clearedFields, bronzehistoryk8sworkload.FieldImagesJSON)
+}
+
+// SetContainersJSON sets the "containers_json" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetContainersJSON(jm json.RawMessage) {
+	m.containers_json = &jm
+	m.appendcontainers_json = nil
+}
+
+// ContainersJSON returns the value of the "containers_json" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) ContainersJSON() (r json.RawMessage, exists bool) {
+	v := m.containers_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContainersJSON returns the old "containers_json" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldContainersJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContainersJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContainersJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContainersJSON: %w", err)
+	}
+	return oldValue.ContainersJSON, nil
+}
+
+// AppendContainersJSON adds jm to the "containers_json" field.
+func (m *BronzeHistoryK8sWorkloadMutation) AppendContainersJSON(jm json.RawMessage) {
+	m.appendcontainers_json = append(m.appendcontainers_json, jm...)
+}
+
+// AppendedContainersJSON returns the list of values that were appended to the "containers_json" field in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) AppendedContainersJSON() (json.RawMessage, bool) {
+	if len(m.appendcontainers_json) == 0 {
+		return nil, false
+	}
+	return m.appendcontainers_json, true
+}
+
+// ClearContainersJSON clears the value of the "containers_json" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ClearContainersJSON() {
+	m.containers_json = nil
+	m.appendcontainers_json = nil
+	m.clearedFields[bronzehistoryk8sworkload.FieldContainersJSON] = struct{}{}
+}
+
+// ContainersJSONCleared returns if the "containers_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) ContainersJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8sworkload.FieldContainersJSON]
+	return ok
+}
+
+// ResetContainersJSON resets all changes to the "containers_json" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetContainersJSON() {
+	m.containers_json = nil
+	m.appendcontainers_json = nil
+	delete(m.clearedFields, bronzehistoryk8sworkload.FieldContainersJSON)
+}
+
+// SetSelectorJSON sets the "selector_json" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetSelectorJSON(jm json.RawMessage) {
+	m.selector_json = &jm
+	m.appendselector_json = nil
+}
+
+// SelectorJSON returns the value of the "selector_json" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) SelectorJSON() (r json.RawMessage, exists bool) {
+	v := m.selector_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSelectorJSON returns the old "selector_json" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldSelectorJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSelectorJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSelectorJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSelectorJSON: %w", err)
+	}
+	return oldValue.SelectorJSON, nil
+}
+
+// AppendSelectorJSON adds jm to the "selector_json" field.
+func (m *BronzeHistoryK8sWorkloadMutation) AppendSelectorJSON(jm json.RawMessage) {
+	m.appendselector_json = append(m.appendselector_json, jm...)
+}
+
+// AppendedSelectorJSON returns the list of values that were appended to the "selector_json" field in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) AppendedSelectorJSON() (json.RawMessage, bool) {
+	if len(m.appendselector_json) == 0 {
+		return nil, false
+	}
+	return m.appendselector_json, true
+}
+
+// ClearSelectorJSON clears the value of the "selector_json" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ClearSelectorJSON() {
+	m.selector_json = nil
+	m.appendselector_json = nil
+	m.clearedFields[bronzehistoryk8sworkload.FieldSelectorJSON] = struct{}{}
+}
+
+// SelectorJSONCleared returns if the "selector_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) SelectorJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8sworkload.FieldSelectorJSON]
+	return ok
+}
+
+// ResetSelectorJSON resets all changes to the "selector_json" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetSelectorJSON() {
+	m.selector_json = nil
+	m.appendselector_json = nil
+	delete(m.clearedFields, bronzehistoryk8sworkload.FieldSelectorJSON)
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetLabelsJSON(jm json.RawMessage) {
+	m.labels_json = &jm
+	m.appendlabels_json = nil
+}
+
+// LabelsJSON returns the value of the "labels_json" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) LabelsJSON() (r json.RawMessage, exists bool) {
+	v := m.labels_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabelsJSON returns the old "labels_json" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldLabelsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabelsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabelsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabelsJSON: %w", err)
+	}
+	return oldValue.LabelsJSON, nil
+}
+
+// AppendLabelsJSON adds jm to the "labels_json" field.
+func (m *BronzeHistoryK8sWorkloadMutation) AppendLabelsJSON(jm json.RawMessage) {
+	m.appendlabels_json = append(m.appendlabels_json, jm...)
+}
+
+// AppendedLabelsJSON returns the list of values that were appended to the "labels_json" field in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) AppendedLabelsJSON() (json.RawMessage, bool) {
+	if len(m.appendlabels_json) == 0 {
+		return nil, false
+	}
+	return m.appendlabels_json, true
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ClearLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	m.clearedFields[bronzehistoryk8sworkload.FieldLabelsJSON] = struct{}{}
+}
+
+// LabelsJSONCleared returns if the "labels_json" field was cleared in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) LabelsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8sworkload.FieldLabelsJSON]
+	return ok
+}
+
+// ResetLabelsJSON resets all changes to the "labels_json" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	delete(m.clearedFields, bronzehistoryk8sworkload.FieldLabelsJSON)
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (m *BronzeHistoryK8sWorkloadMutation) SetAPICreatedAt(t time.Time) {
+	m.api_created_at = &t
+}
+
+// APICreatedAt returns the value of the "api_created_at" field in the mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) APICreatedAt() (r time.Time, exists bool) {
+	v := m.api_created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPICreatedAt returns the old "api_created_at" field's value of the BronzeHistoryK8sWorkload entity.
+// If the BronzeHistoryK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeHistoryK8sWorkloadMutation) OldAPICreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPICreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPICreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPICreatedAt: %w", err)
+	}
+	return oldValue.APICreatedAt, nil
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ClearAPICreatedAt() {
+	m.api_created_at = nil
+	m.clearedFields[bronzehistoryk8sworkload.FieldAPICreatedAt] = struct{}{}
+}
+
+// APICreatedAtCleared returns if the "api_created_at" field was cleared in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) APICreatedAtCleared() bool {
+	_, ok := m.clearedFields[bronzehistoryk8sworkload.FieldAPICreatedAt]
+	return ok
+}
+
+// ResetAPICreatedAt resets all changes to the "api_created_at" field.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetAPICreatedAt() {
+	m.api_created_at = nil
+	delete(m.clearedFields, bronzehistoryk8sworkload.FieldAPICreatedAt)
+}
+
+// Where appends a list predicates to the BronzeHistoryK8sWorkloadMutation builder.
+func (m *BronzeHistoryK8sWorkloadMutation) Where(ps ...predicate.BronzeHistoryK8sWorkload) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeHistoryK8sWorkloadMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeHistoryK8sWorkloadMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeHistoryK8sWorkload, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeHistoryK8sWorkloadMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeHistoryK8sWorkloadMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeHistoryK8sWorkload).
+func (m *BronzeHistoryK8sWorkloadMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeHistoryK8sWorkloadMutation) Fields() []string {
+	fields := make([]string, 0, 20)
+	if m.valid_from != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldValidFrom)
+	}
+	if m.valid_to != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldValidTo)
+	}
+	if m.collected_at != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldFirstCollectedAt)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldResourceID)
+	}
+	if m.cluster_name != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldClusterName)
+	}
+	if m.namespace != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldNamespace)
+	}
+	if m.kind != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldKind)
+	}
+	if m.name != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldName)
+	}
+	if m.uid != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldUID)
+	}
+	if m.replicas != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldReplicas)
+	}
+	if m.service_account != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldServiceAccount)
+	}
+	if m.host_network != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldHostNetwork)
+	}
+	if m.host_pid != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldHostPid)
+	}
+	if m.host_ipc != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldHostIpc)
+	}
+	if m.images_json != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldImagesJSON)
+	}
+	if m.containers_json != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldContainersJSON)
+	}
+	if m.selector_json != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldSelectorJSON)
+	}
+	if m.labels_json != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldLabelsJSON)
+	}
+	if m.api_created_at != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeHistoryK8sWorkloadMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryk8sworkload.FieldValidFrom:
+		return m.ValidFrom()
+	case bronzehistoryk8sworkload.FieldValidTo:
+		return m.ValidTo()
+	case bronzehistoryk8sworkload.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzehistoryk8sworkload.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzehistoryk8sworkload.FieldResourceID:
+		return m.ResourceID()
+	case bronzehistoryk8sworkload.FieldClusterName:
+		return m.ClusterName()
+	case bronzehistoryk8sworkload.FieldNamespace:
+		return m.Namespace()
+	case bronzehistoryk8sworkload.FieldKind:
+		return m.Kind()
+	case bronzehistoryk8sworkload.FieldName:
+		return m.Name()
+	case bronzehistoryk8sworkload.FieldUID:
+		return m.UID()
+	case bronzehistoryk8sworkload.FieldReplicas:
+		return m.Replicas()
+	case bronzehistoryk8sworkload.FieldServiceAccount:
+		return m.ServiceAccount()
+	case bronzehistoryk8sworkload.FieldHostNetwork:
+		return m.HostNetwork()
+	case bronzehistoryk8sworkload.FieldHostPid:
+		return m.HostPid()
+	case bronzehistoryk8sworkload.FieldHostIpc:
+		return m.HostIpc()
+	case bronzehistoryk8sworkload.FieldImagesJSON:
+		return m.ImagesJSON()
+	case bronzehistoryk8sworkload.FieldContainersJSON:
+		return m.ContainersJSON()
+	case bronzehistoryk8sworkload.FieldSelectorJSON:
+		return m.SelectorJSON()
+	case bronzehistoryk8sworkload.FieldLabelsJSON:
+		return m.LabelsJSON()
+	case bronzehistoryk8sworkload.FieldAPICreatedAt:
+		return m.APICreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeHistoryK8sWorkloadMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzehistoryk8sworkload.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case bronzehistoryk8sworkload.FieldValidTo:
+		return m.OldValidTo(ctx)
+	case bronzehistoryk8sworkload.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzehistoryk8sworkload.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzehistoryk8sworkload.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case bronzehistoryk8sworkload.FieldClusterName:
+		return m.OldClusterName(ctx)
+	case bronzehistoryk8sworkload.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case bronzehistoryk8sworkload.FieldKind:
+		return m.OldKind(ctx)
+	case bronzehistoryk8sworkload.FieldName:
+		return m.OldName(ctx)
+	case bronzehistoryk8sworkload.FieldUID:
+		return m.OldUID(ctx)
+	case bronzehistoryk8sworkload.FieldReplicas:
+		return m.OldReplicas(ctx)
+	case bronzehistoryk8sworkload.FieldServiceAccount:
+		return m.OldServiceAccount(ctx)
+	case bronzehistoryk8sworkload.FieldHostNetwork:
+		return m.OldHostNetwork(ctx)
+	case bronzehistoryk8sworkload.FieldHostPid:
+		return m.OldHostPid(ctx)
+	case bronzehistoryk8sworkload.FieldHostIpc:
+		return m.OldHostIpc(ctx)
+	case bronzehistoryk8sworkload.FieldImagesJSON:
+		return m.OldImagesJSON(ctx)
+	case bronzehistoryk8sworkload.FieldContainersJSON:
+		return m.OldContainersJSON(ctx)
+	case bronzehistoryk8sworkload.FieldSelectorJSON:
+		return m.OldSelectorJSON(ctx)
+	case bronzehistoryk8sworkload.FieldLabelsJSON:
+		return m.OldLabelsJSON(ctx)
+	case bronzehistoryk8sworkload.FieldAPICreatedAt:
+		return m.OldAPICreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeHistoryK8sWorkload field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryK8sWorkloadMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryk8sworkload.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case bronzehistoryk8sworkload.FieldValidTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidTo(v)
+		return nil
+	case bronzehistoryk8sworkload.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzehistoryk8sworkload.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzehistoryk8sworkload.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case bronzehistoryk8sworkload.FieldClusterName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterName(v)
+		return nil
+	case bronzehistoryk8sworkload.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case bronzehistoryk8sworkload.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case bronzehistoryk8sworkload.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case bronzehistoryk8sworkload.FieldUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUID(v)
+		return nil
+	case bronzehistoryk8sworkload.FieldReplicas:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplicas(v)
+		return nil
+	case bronzehistoryk8sworkload.FieldServiceAccount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServiceAccount(v)
+		return nil
+	case bronzehistoryk8sworkload.FieldHostNetwork:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostNetwork(v)
+		return nil
+	case bronzehistoryk8sworkload.FieldHostPid:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostPid(v)
+		return nil
+	case bronzehistoryk8sworkload.FieldHostIpc:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostIpc(v)
+		return nil
+	case bronzehistoryk8sworkload.FieldImagesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImagesJSON(v)
+		return nil
+	case bronzehistoryk8sworkload.FieldContainersJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContainersJSON(v)
+		return nil
+	case bronzehistoryk8sworkload.FieldSelectorJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSelectorJSON(v)
+		return nil
+	case bronzehistoryk8sworkload.FieldLabelsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabelsJSON(v)
+		return nil
+	case bronzehistoryk8sworkload.FieldAPICreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPICreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sWorkload field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) AddedFields() []string {
+	var fields []string
+	if m.addreplicas != nil {
+		fields = append(fields, bronzehistoryk8sworkload.FieldReplicas)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeHistoryK8sWorkloadMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case bronzehistoryk8sworkload.FieldReplicas:
+		return m.AddedReplicas()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeHistoryK8sWorkloadMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case bronzehistoryk8sworkload.FieldReplicas:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReplicas(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sWorkload numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzehistoryk8sworkload.FieldValidTo) {
+		fields = append(fields, bronzehistoryk8sworkload.FieldValidTo)
+	}
+	if m.FieldCleared(bronzehistoryk8sworkload.FieldUID) {
+		fields = append(fields, bronzehistoryk8sworkload.FieldUID)
+	}
+	if m.FieldCleared(bronzehistoryk8sworkload.FieldReplicas) {
+		fields = append(fields, bronzehistoryk8sworkload.FieldReplicas)
+	}
+	if m.FieldCleared(bronzehistoryk8sworkload.FieldServiceAccount) {
+		fields = append(fields, bronzehistoryk8sworkload.FieldServiceAccount)
+	}
+	if m.FieldCleared(bronzehistoryk8sworkload.FieldImagesJSON) {
+		fields = append(fields, bronzehistoryk8sworkload.FieldImagesJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8sworkload.FieldContainersJSON) {
+		fields = append(fields, bronzehistoryk8sworkload.FieldContainersJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8sworkload.FieldSelectorJSON) {
+		fields = append(fields, bronzehistoryk8sworkload.FieldSelectorJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8sworkload.FieldLabelsJSON) {
+		fields = append(fields, bronzehistoryk8sworkload.FieldLabelsJSON)
+	}
+	if m.FieldCleared(bronzehistoryk8sworkload.FieldAPICreatedAt) {
+		fields = append(fields, bronzehistoryk8sworkload.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeHistoryK8sWorkloadMutation) ClearField(name string) error {
+	switch name {
+	case bronzehistoryk8sworkload.FieldValidTo:
+		m.ClearValidTo()
+		return nil
+	case bronzehistoryk8sworkload.FieldUID:
+		m.ClearUID()
+		return nil
+	case bronzehistoryk8sworkload.FieldReplicas:
+		m.ClearReplicas()
+		return nil
+	case bronzehistoryk8sworkload.FieldServiceAccount:
+		m.ClearServiceAccount()
+		return nil
+	case bronzehistoryk8sworkload.FieldImagesJSON:
+		m.ClearImagesJSON()
+		return nil
+	case bronzehistoryk8sworkload.FieldContainersJSON:
+		m.ClearContainersJSON()
+		return nil
+	case bronzehistoryk8sworkload.FieldSelectorJSON:
+		m.ClearSelectorJSON()
+		return nil
+	case bronzehistoryk8sworkload.FieldLabelsJSON:
+		m.ClearLabelsJSON()
+		return nil
+	case bronzehistoryk8sworkload.FieldAPICreatedAt:
+		m.ClearAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sWorkload nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetField(name string) error {
+	switch name {
+	case bronzehistoryk8sworkload.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case bronzehistoryk8sworkload.FieldValidTo:
+		m.ResetValidTo()
+		return nil
+	case bronzehistoryk8sworkload.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzehistoryk8sworkload.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzehistoryk8sworkload.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case bronzehistoryk8sworkload.FieldClusterName:
+		m.ResetClusterName()
+		return nil
+	case bronzehistoryk8sworkload.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case bronzehistoryk8sworkload.FieldKind:
+		m.ResetKind()
+		return nil
+	case bronzehistoryk8sworkload.FieldName:
+		m.ResetName()
+		return nil
+	case bronzehistoryk8sworkload.FieldUID:
+		m.ResetUID()
+		return nil
+	case bronzehistoryk8sworkload.FieldReplicas:
+		m.ResetReplicas()
+		return nil
+	case bronzehistoryk8sworkload.FieldServiceAccount:
+		m.ResetServiceAccount()
+		return nil
+	case bronzehistoryk8sworkload.FieldHostNetwork:
+		m.ResetHostNetwork()
+		return nil
+	case bronzehistoryk8sworkload.FieldHostPid:
+		m.ResetHostPid()
+		return nil
+	case bronzehistoryk8sworkload.FieldHostIpc:
+		m.ResetHostIpc()
+		return nil
+	case bronzehistoryk8sworkload.FieldImagesJSON:
+		m.ResetImagesJSON()
+		return nil
+	case bronzehistoryk8sworkload.FieldContainersJSON:
+		m.ResetContainersJSON()
+		return nil
+	case bronzehistoryk8sworkload.FieldSelectorJSON:
+		m.ResetSelectorJSON()
+		return nil
+	case bronzehistoryk8sworkload.FieldLabelsJSON:
+		m.ResetLabelsJSON()
+		return nil
+	case bronzehistoryk8sworkload.FieldAPICreatedAt:
+		m.ResetAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeHistoryK8sWorkload field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeHistoryK8sWorkloadMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeHistoryK8sWorkloadMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryK8sWorkload unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeHistoryK8sWorkloadMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeHistoryK8sWorkload edge %s", name)
+}
+
+// BronzeK8sImageMutation represents an operation that mutates the BronzeK8sImage nodes in the graph.
+type BronzeK8sImageMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *string
+	collected_at          *time.Time
+	first_collected_at    *time.Time
+	cluster_name          *string
+	registry              *string
+	repository            *string
+	digest                *string
+	tags_json             *json.RawMessage
+	appendtags_json       json.RawMessage
+	namespaces_json       *json.RawMessage
+	appendnamespaces_json json.RawMessage
+	workloads_json        *json.RawMessage
+	appendworkloads_json  json.RawMessage
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*BronzeK8sImage, error)
+	predicates            []predicate.BronzeK8sImage
+}
+
+var _ ent.Mutation = (*BronzeK8sImageMutation)(nil)
+
+// bronzek8simageOption allows management of the mutation configuration using functional options.
+type bronzek8simageOption func(*BronzeK8sImageMutation)
+
+// newBronzeK8sImageMutation creates new mutation for the BronzeK8sImage entity.
+func newBronzeK8sImageMutation(c config, op Op, opts ...bronzek8simageOption) *BronzeK8sImageMutation {
+	m := &BronzeK8sImageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeK8sImage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeK8sImageID sets the ID field of the mutation.
+func withBronzeK8sImageID(id string) bronzek8simageOption {
+	return func(m *BronzeK8sImageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeK8sImage
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeK8sImage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeK8sImage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeK8sImage sets the old BronzeK8sImage of the mutation.
+func withBronzeK8sImage(node *BronzeK8sImage) bronzek8simageOption {
+	return func(m *BronzeK8sImageMutation) {
+		m.oldValue = func(context.Context) (*BronzeK8sImage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeK8sImageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeK8sImageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("kubernetes: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeK8sImage entities.
+func (m *BronzeK8sImageMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeK8sImageMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeK8sImageMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeK8sImage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeK8sImageMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeK8sImageMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeK8sImage entity.
+// If the BronzeK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sImageMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeK8sImageMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeK8sImageMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeK8sImageMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeK8sImage entity.
+// If the BronzeK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sImageMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeK8sImageMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (m *BronzeK8sImageMutation) SetClusterName(s string) {
+	m.cluster_name = &s
+}
+
+// ClusterName returns the value of the "cluster_name" field in the mutation.
+func (m *BronzeK8sImageMutation) ClusterName() (r string, exists bool) {
+	v := m.cluster_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClusterName returns the old "cluster_name" field's value of the BronzeK8sImage entity.
+// If the BronzeK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sImageMutation) OldClusterName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterName: %w", err)
+	}
+	return oldValue.ClusterName, nil
+}
+
+// ResetClusterName resets all changes to the "cluster_name" field.
+func (m *BronzeK8sImageMutation) ResetClusterName() {
+	m.cluster_name = nil
+}
+
+// SetRegistry sets the "registry" field.
+func (m *BronzeK8sImageMutation) SetRegistry(s string) {
+	m.registry = &s
+}
+
+// Registry returns the value of the "registry" field in the mutation.
+func (m *BronzeK8sImageMutation) Registry() (r string, exists bool) {
+	v := m.registry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegistry returns the old "registry" field's value of the BronzeK8sImage entity.
+// If the BronzeK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sImageMutation) OldRegistry(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegistry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegistry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegistry: %w", err)
+	}
+	return oldValue.Registry, nil
+}
+
+// ResetRegistry resets all changes to the "registry" field.
+func (m *BronzeK8sImageMutation) ResetRegistry() {
+	m.registry = nil
+}
+
+// SetRepository sets the "repository" field.
+func (m *BronzeK8sImageMutation) SetRepository(s string) {
+	m.repository = &s
+}
+
+// Repository returns the value of the "repository" field in the mutation.
+func (m *BronzeK8sImageMutation) Repository() (r string, exists bool) {
+	v := m.repository
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRepository returns the old "repository" field's value of the BronzeK8sImage entity.
+// If the BronzeK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sImageMutation) OldRepository(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRepository is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRepository requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRepository: %w", err)
+	}
+	return oldValue.Repository, nil
+}
+
+// ResetRepository resets all changes to the "repository" field.
+func (m *BronzeK8sImageMutation) ResetRepository() {
+	m.repository = nil
+}
+
+// SetDigest sets the "digest" field.
+func (m *BronzeK8sImageMutation) SetDigest(s string) {
+	m.digest = &s
+}
+
+// Digest returns the value of the "digest" field in the mutation.
+func (m *BronzeK8sImageMutation) Digest() (r string, exists bool) {
+	v := m.digest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDigest returns the old "digest" field's value of the BronzeK8sImage entity.
+// If the BronzeK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sImageMutation) OldDigest(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDigest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDigest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDigest: %w", err)
+	}
+	return oldValue.Digest, nil
+}
+
+// ClearDigest clears the value of the "digest" field.
+func (m *BronzeK8sImageMutation) ClearDigest() {
+	m.digest = nil
+	m.clearedFields[bronzek8simage.FieldDigest] = struct{}{}
+}
+
+// DigestCleared returns if the "digest" field was cleared in this mutation.
+func (m *BronzeK8sImageMutation) DigestCleared() bool {
+	_, ok := m.clearedFields[bronzek8simage.FieldDigest]
+	return ok
+}
+
+// ResetDigest resets all changes to the "digest" field.
+func (m *BronzeK8sImageMutation) ResetDigest() {
+	m.digest = nil
+	delete(m.clearedFields, bronzek8simage.FieldDigest)
+}
+
+// SetTagsJSON sets the "tags_json" field.
+func (m *BronzeK8sImageMutation) SetTagsJSON(jm json.RawMessage) {
+	m.tags_json = &jm
+	m.appendtags_json = nil
+}
+
+// TagsJSON returns the value of the "tags_json" field in the mutation.
+func (m *BronzeK8sImageMutation) TagsJSON() (r json.RawMessage, exists bool) {
+	v := m.tags_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagsJSON returns the old "tags_json" field's value of the BronzeK8sImage entity.
+// If the BronzeK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sImageMutation) OldTagsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagsJSON: %w", err)
+	}
+	return oldValue.TagsJSON, nil
+}
+
+// AppendTagsJSON adds jm to the "tags_json" field.
+func (m *BronzeK8sImageMutation) AppendTagsJSON(jm json.RawMessage) {
+	m.appendtags_json = append(m.appendtags_json, jm...)
+}
+
+// AppendedTagsJSON returns the list of values that were appended to the "tags_json" field in this mutation.
+func (m *BronzeK8sImageMutation) AppendedTagsJSON() (json.RawMessage, bool) {
+	if len(m.appendtags_json) == 0 {
+		return nil, false
+	}
+	return m.appendtags_json, true
+}
+
+// ClearTagsJSON clears the value of the "tags_json" field.
+func (m *BronzeK8sImageMutation) ClearTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	m.clearedFields[bronzek8simage.FieldTagsJSON] = struct{}{}
+}
+
+// TagsJSONCleared returns if the "tags_json" field was cleared in this mutation.
+func (m *BronzeK8sImageMutation) TagsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8simage.FieldTagsJSON]
+	return ok
+}
+
+// ResetTagsJSON resets all changes to the "tags_json" field.
+func (m *BronzeK8sImageMutation) ResetTagsJSON() {
+	m.tags_json = nil
+	m.appendtags_json = nil
+	delete(m.clearedFields, bronzek8simage.FieldTagsJSON)
+}
+
+// SetNamespacesJSON sets the "namespaces_json" field.
+func (m *BronzeK8sImageMutation) SetNamespacesJSON(jm json.RawMessage) {
+	m.namespaces_json = &jm
+	m.appendnamespaces_json = nil
+}
+
+// NamespacesJSON returns the value of the "namespaces_json" field in the mutation.
+func (m *BronzeK8sImageMutation) NamespacesJSON() (r json.RawMessage, exists bool) {
+	v := m.namespaces_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespacesJSON returns the old "namespaces_json" field's value of the BronzeK8sImage entity.
+// If the BronzeK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sImageMutation) OldNamespacesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespacesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespacesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespacesJSON: %w", err)
+	}
+	return oldValue.NamespacesJSON, nil
+}
+
+// AppendNamespacesJSON adds jm to the "namespaces_json" field.
+func (m *BronzeK8sImageMutation) AppendNamespacesJSON(jm json.RawMessage) {
+	m.appendnamespaces_json = append(m.appendnamespaces_json, jm...)
+}
+
+// AppendedNamespacesJSON returns the list of values that were appended to the "namespaces_json" field in this mutation.
+func (m *BronzeK8sImageMutation) AppendedNamespacesJSON() (json.RawMessage, bool) {
+	if len(m.appendnamespaces_json) == 0 {
+		return nil, false
+	}
+	return m.appendnamespaces_json, true
+}
+
+// ClearNamespacesJSON clears the value of the "namespaces_json" field.
+func (m *BronzeK8sImageMutation) ClearNamespacesJSON() {
+	m.namespaces_json = nil
+	m.appendnamespaces_json = nil
+	m.clearedFields[bronzek8simage.FieldNamespacesJSON] = struct{}{}
+}
+
+// NamespacesJSONCleared returns if the "namespaces_json" field was cleared in this mutation.
+func (m *BronzeK8sImageMutation) NamespacesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8simage.FieldNamespacesJSON]
+	return ok
+}
+
+// ResetNamespacesJSON resets all changes to the "namespaces_json" field.
+func (m *BronzeK8sImageMutation) ResetNamespacesJSON() {
+	m.namespaces_json = nil
+	m.appendnamespaces_json = nil
+	delete(m.clearedFields, bronzek8simage.FieldNamespacesJSON)
+}
+
+// SetWorkloadsJSON sets the "workloads_json" field.
+func (m *BronzeK8sImageMutation) SetWorkloadsJSON(jm json.RawMessage) {
+	m.workloads_json = &jm
+	m.appendworkloads_json = nil
+}
+
+// WorkloadsJSON returns the value of the "workloads_json" field in the mutation.
+func (m *BronzeK8sImageMutation) WorkloadsJSON() (r json.RawMessage, exists bool) {
+	v := m.workloads_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkloadsJSON returns the old "workloads_json" field's value of the BronzeK8sImage entity.
+// If the BronzeK8sImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sImageMutation) OldWorkloadsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkloadsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkloadsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkloadsJSON: %w", err)
+	}
+	return oldValue.WorkloadsJSON, nil
+}
+
+// AppendWorkloadsJSON adds jm to the "workloads_json" field.
+func (m *BronzeK8sImageMutation) AppendWorkloadsJSON(jm json.RawMessage) {
+	m.appendworkloads_json = append(m.appendworkloads_json, jm...)
+}
+
+// AppendedWorkloadsJSON returns the list of values that were appended to the "workloads_json" field in this mutation.
+func (m *BronzeK8sImageMutation) AppendedWorkloadsJSON() (json.RawMessage, bool) {
+	if len(m.appendworkloads_json) == 0 {
+		return nil, false
+	}
+	return m.appendworkloads_json, true
+}
+
+// ClearWorkloadsJSON clears the value of the "workloads_json" field.
+func (m *BronzeK8sImageMutation) ClearWorkloadsJSON() {
+	m.workloads_json = nil
+	m.appendworkloads_json = nil
+	m.clearedFields[bronzek8simage.FieldWorkloadsJSON] = struct{}{}
+}
+
+// WorkloadsJSONCleared returns if the "workloads_json" field was cleared in this mutation.
+func (m *BronzeK8sImageMutation) WorkloadsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8simage.FieldWorkloadsJSON]
+	return ok
+}
+
+// ResetWorkloadsJSON resets all changes to the "workloads_json" field.
+func (m *BronzeK8sImageMutation) ResetWorkloadsJSON() {
+	m.workloads_json = nil
+	m.appendworkloads_json = nil
+	delete(m.clearedFields, bronzek8simage.FieldWorkloadsJSON)
+}
+
+// Where appends a list predicates to the BronzeK8sImageMutation builder.
+func (m *BronzeK8sImageMutation) Where(ps ...predicate.BronzeK8sImage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeK8sImageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeK8sImageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeK8sImage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeK8sImageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeK8sImageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeK8sImage).
+func (m *BronzeK8sImageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeK8sImageMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.collected_at != nil {
+		fields = append(fields, bronzek8simage.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzek8simage.FieldFirstCollectedAt)
+	}
+	if m.cluster_name != nil {
+		fields = append(fields, bronzek8simage.FieldClusterName)
+	}
+	if m.registry != nil {
+		fields = append(fields, bronzek8simage.FieldRegistry)
+	}
+	if m.repository != nil {
+		fields = append(fields, bronzek8simage.FieldRepository)
+	}
+	if m.digest != nil {
+		fields = append(fields, bronzek8simage.FieldDigest)
+	}
+	if m.tags_json != nil {
+		fields = append(fields, bronzek8simage.FieldTagsJSON)
+	}
+	if m.namespaces_json != nil {
+		fields = append(fields, bronzek8simage.FieldNamespacesJSON)
+	}
+	if m.workloads_json != nil {
+		fields = append(fields, bronzek8simage.FieldWorkloadsJSON)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeK8sImageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzek8simage.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzek8simage.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzek8simage.FieldClusterName:
+		return m.ClusterName()
+	case bronzek8simage.FieldRegistry:
+		return m.Registry()
+	case bronzek8simage.FieldRepository:
+		return m.Repository()
+	case bronzek8simage.FieldDigest:
+		return m.Digest()
+	case bronzek8simage.FieldTagsJSON:
+		return m.TagsJSON()
+	case bronzek8simage.FieldNamespacesJSON:
+		return m.NamespacesJSON()
+	case bronzek8simage.FieldWorkloadsJSON:
+		return m.WorkloadsJSON()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeK8sImageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzek8simage.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzek8simage.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzek8simage.FieldClusterName:
+		return m.OldClusterName(ctx)
+	case bronzek8simage.FieldRegistry:
+		return m.OldRegistry(ctx)
+	case bronzek8simage.FieldRepository:
+		return m.OldRepository(ctx)
+	case bronzek8simage.FieldDigest:
+		return m.OldDigest(ctx)
+	case bronzek8simage.FieldTagsJSON:
+		return m.OldTagsJSON(ctx)
+	case bronzek8simage.FieldNamespacesJSON:
+		return m.OldNamespacesJSON(ctx)
+	case bronzek8simage.FieldWorkloadsJSON:
+		return m.OldWorkloadsJSON(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeK8sImage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeK8sImageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzek8simage.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzek8simage.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzek8simage.FieldClusterName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterName(v)
+		return nil
+	case bronzek8simage.FieldRegistry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegistry(v)
+		return nil
+	case bronzek8simage.FieldRepository:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRepository(v)
+		return nil
+	case bronzek8simage.FieldDigest:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDigest(v)
+		return nil
+	case bronzek8simage.FieldTagsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagsJSON(v)
+		return nil
+	case bronzek8simage.FieldNamespacesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespacesJSON(v)
+		return nil
+	case bronzek8simage.FieldWorkloadsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkloadsJSON(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sImage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeK8sImageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeK8sImageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeK8sImageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeK8sImage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeK8sImageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzek8simage.FieldDigest) {
+		fields = append(fields, bronzek8simage.FieldDigest)
+	}
+	if m.FieldCleared(bronzek8simage.FieldTagsJSON) {
+		fields = append(fields, bronzek8simage.FieldTagsJSON)
+	}
+	if m.FieldCleared(bronzek8simage.FieldNamespacesJSON) {
+		fields = append(fields, bronzek8simage.FieldNamespacesJSON)
+	}
+	if m.FieldCleared(bronzek8simage.FieldWorkloadsJSON) {
+		fields = append(fields, bronzek8simage.FieldWorkloadsJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeK8sImageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeK8sImageMutation) ClearField(name string) error {
+	switch name {
+	case bronzek8simage.FieldDigest:
+		m.ClearDigest()
+		return nil
+	case bronzek8simage.FieldTagsJSON:
+		m.ClearTagsJSON()
+		return nil
+	case bronzek8simage.FieldNamespacesJSON:
+		m.ClearNamespacesJSON()
+		return nil
+	case bronzek8simage.FieldWorkloadsJSON:
+		m.ClearWorkloadsJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sImage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeK8sImageMutation) ResetField(name string) error {
+	switch name {
+	case bronzek8simage.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzek8simage.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzek8simage.FieldClusterName:
+		m.ResetClusterName()
+		return nil
+	case bronzek8simage.FieldRegistry:
+		m.ResetRegistry()
+		return nil
+	case bronzek8simage.FieldRepository:
+		m.ResetRepository()
+		return nil
+	case bronzek8simage.FieldDigest:
+		m.ResetDigest()
+		return nil
+	case bronzek8simage.FieldTagsJSON:
+		m.ResetTagsJSON()
+		return nil
+	case bronzek8simage.FieldNamespacesJSON:
+		m.ResetNamespacesJSON()
+		return nil
+	case bronzek8simage.FieldWorkloadsJSON:
+		m.ResetWorkloadsJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sImage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeK8sImageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeK8sImageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeK8sImageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeK8sImageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeK8sImageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeK8sImageMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeK8sImageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeK8sImage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeK8sImageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeK8sImage edge %s", name)
+}
+
+// BronzeK8sNamespaceMutation represents an operation that mutates the BronzeK8sNamespace nodes in the graph.
+type BronzeK8sNamespaceMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	collected_at       *time.Time
+	first_collected_at *time.Time
+	cluster_name       *string
+	name               *string
+	uid                *string
+	phase              *string
+	labels_json        *json.RawMessage
+	appendlabels_json  json.RawMessage
+	api_created_at     *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*BronzeK8sNamespace, error)
+	predicates         []predicate.BronzeK8sNamespace
+}
+
+var _ ent.Mutation = (*BronzeK8sNamespaceMutation)(nil)
+
+// bronzek8snamespaceOption allows management of the mutation configuration using functional options.
+type bronzek8snamespaceOption func(*BronzeK8sNamespaceMutation)
+
+// newBronzeK8sNamespaceMutation creates new mutation for the BronzeK8sNamespace entity.
+func newBronzeK8sNamespaceMutation(c config, op Op, opts ...bronzek8snamespaceOption) *BronzeK8sNamespaceMutation {
+	m := &BronzeK8sNamespaceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeK8sNamespace,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeK8sNamespaceID sets the ID field of the mutation.
+func withBronzeK8sNamespaceID(id string) bronzek8snamespaceOption {
+	return func(m *BronzeK8sNamespaceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeK8sNamespace
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeK8sNamespace, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeK8sNamespace.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeK8sNamespace sets the old BronzeK8sNamespace of the mutation.
+func withBronzeK8sNamespace(node *BronzeK8sNamespace) bronzek8snamespaceOption {
+	return func(m *BronzeK8sNamespaceMutation) {
+		m.oldValue = func(context.Context) (*BronzeK8sNamespace, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeK8sNamespaceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeK8sNamespaceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("kubernetes: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeK8sNamespace entities.
+func (m *BronzeK8sNamespaceMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeK8sNamespaceMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeK8sNamespaceMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeK8sNamespace.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeK8sNamespaceMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeK8sNamespaceMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeK8sNamespace entity.
+// If the BronzeK8sNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNamespaceMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeK8sNamespaceMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeK8sNamespaceMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeK8sNamespaceMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeK8sNamespace entity.
+// If the BronzeK8sNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNamespaceMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeK8sNamespaceMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (m *BronzeK8sNamespaceMutation) SetClusterName(s string) {
+	m.cluster_name = &s
+}
+
+// ClusterName returns the value of the "cluster_name" field in the mutation.
+func (m *BronzeK8sNamespaceMutation) ClusterName() (r string, exists bool) {
+	v := m.cluster_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClusterName returns the old "cluster_name" field's value of the BronzeK8sNamespace entity.
+// If the BronzeK8sNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNamespaceMutation) OldClusterName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterName: %w", err)
+	}
+	return oldValue.ClusterName, nil
+}
+
+// ResetClusterName resets all changes to the "cluster_name" field.
+func (m *BronzeK8sNamespaceMutation) ResetClusterName() {
+	m.cluster_name = nil
+}
+
+// SetName sets the "name" field.
+func (m *BronzeK8sNamespaceMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BronzeK8sNamespaceMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the BronzeK8sNamespace entity.
+// If the BronzeK8sNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNamespaceMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *BronzeK8sNamespaceMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUID sets the "uid" field.
+func (m *BronzeK8sNamespaceMutation) SetUID(s string) {
+	m.uid = &s
+}
+
+// UID returns the value of the "uid" field in the mutation.
+func (m *BronzeK8sNamespaceMutation) UID() (r string, exists bool) {
+	v := m.uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUID returns the old "uid" field's value of the BronzeK8sNamespace entity.
+// If the BronzeK8sNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNamespaceMutation) OldUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+	}
+	return oldValue.UID, nil
+}
+
+// ClearUID clears the value of the "uid" field.
+func (m *BronzeK8sNamespaceMutation) ClearUID() {
+	m.uid = nil
+	m.clearedFields[bronzek8snamespace.FieldUID] = struct{}{}
+}
+
+// UIDCleared returns if the "uid" field was cleared in this mutation.
+func (m *BronzeK8sNamespaceMutation) UIDCleared() bool {
+	_, ok := m.clearedFields[bronzek8snamespace.FieldUID]
+	return ok
+}
+
+// ResetUID resets all changes to the "uid" field.
+func (m *BronzeK8sNamespaceMutation) ResetUID() {
+	m.uid = nil
+	delete(m.clearedFields, bronzek8snamespace.FieldUID)
+}
+
+// SetPhase sets the "phase" field.
+func (m *BronzeK8sNamespaceMutation) SetPhase(s string) {
+	m.phase = &s
+}
+
+// Phase returns the value of the "phase" field in the mutation.
+func (m *BronzeK8sNamespaceMutation) Phase() (r string, exists bool) {
+	v := m.phase
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhase returns the old "phase" field's value of the BronzeK8sNamespace entity.
+// If the BronzeK8sNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNamespaceMutation) OldPhase(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhase: %w", err)
+	}
+	return oldValue.Phase, nil
+}
+
+// ClearPhase clears the value of the "phase" field.
+func (m *BronzeK8sNamespaceMutation) ClearPhase() {
+	m.phase = nil
+	m.clearedFields[bronzek8snamespace.FieldPhase] = struct{}{}
+}
+
+// PhaseCleared returns if the "phase" field was cleared in this mutation.
+func (m *BronzeK8sNamespaceMutation) PhaseCleared() bool {
+	_, ok := m.clearedFields[bronzek8snamespace.FieldPhase]
+	return ok
+}
+
+// ResetPhase resets all changes to the "phase" field.
+func (m *BronzeK8sNamespaceMutation) ResetPhase() {
+	m.phase = nil
+	delete(m.clearedFields, bronzek8snamespace.FieldPhase)
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (m *BronzeK8sNamespaceMutation) SetLabelsJSON(jm json.RawMessage) {
+	m.labels_json = &jm
+	m.appendlabels_json = nil
+}
+
+// LabelsJSON returns the value of the "labels_json" field in the mutation.
+func (m *BronzeK8sNamespaceMutation) LabelsJSON() (r json.RawMessage, exists bool) {
+	v := m.labels_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabelsJSON returns the old "labels_json" field's value of the BronzeK8sNamespace entity.
+// If the BronzeK8sNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNamespaceMutation) OldLabelsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabelsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabelsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabelsJSON: %w", err)
+	}
+	return oldValue.LabelsJSON, nil
+}
+
+// AppendLabelsJSON adds jm to the "labels_json" field.
+func (m *BronzeK8sNamespaceMutation) AppendLabelsJSON(jm json.RawMessage) {
+	m.appendlabels_json = append(m.appendlabels_json, jm...)
+}
+
+// AppendedLabelsJSON returns the list of values that were appended to the "labels_json" field in this mutation.
+func (m *BronzeK8sNamespaceMutation) AppendedLabelsJSON() (json.RawMessage, bool) {
+	if len(m.appendlabels_json) == 0 {
+		return nil, false
+	}
+	return m.appendlabels_json, true
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (m *BronzeK8sNamespaceMutation) ClearLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	m.clearedFields[bronzek8snamespace.FieldLabelsJSON] = struct{}{}
+}
+
+// LabelsJSONCleared returns if the "labels_json" field was cleared in this mutation.
+func (m *BronzeK8sNamespaceMutation) LabelsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8snamespace.FieldLabelsJSON]
+	return ok
+}
+
+// ResetLabelsJSON resets all changes to the "labels_json" field.
+func (m *BronzeK8sNamespaceMutation) ResetLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	delete(m.clearedFields, bronzek8snamespace.FieldLabelsJSON)
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (m *BronzeK8sNamespaceMutation) SetAPICreatedAt(t time.Time) {
+	m.api_created_at = &t
+}
+
+// APICreatedAt returns the value of the "api_created_at" field in the mutation.
+func (m *BronzeK8sNamespaceMutation) APICreatedAt() (r time.Time, exists bool) {
+	v := m.api_created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPICreatedAt returns the old "api_created_at" field's value of the BronzeK8sNamespace entity.
+// If the BronzeK8sNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNamespaceMutation) OldAPICreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPICreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPICreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPICreatedAt: %w", err)
+	}
+	return oldValue.APICreatedAt, nil
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (m *BronzeK8sNamespaceMutation) ClearAPICreatedAt() {
+	m.api_created_at = nil
+	m.clearedFields[bronzek8snamespace.FieldAPICreatedAt] = struct{}{}
+}
+
+// APICreatedAtCleared returns if the "api_created_at" field was cleared in this mutation.
+func (m *BronzeK8sNamespaceMutation) APICreatedAtCleared() bool {
+	_, ok := m.clearedFields[bronzek8snamespace.FieldAPICreatedAt]
+	return ok
+}
+
+// ResetAPICreatedAt resets all changes to the "api_created_at" field.
+func (m *BronzeK8sNamespaceMutation) ResetAPICreatedAt() {
+	m.api_created_at = nil
+	delete(m.clearedFields, bronzek8snamespace.FieldAPICreatedAt)
+}
+
+// Where appends a list predicates to the BronzeK8sNamespaceMutation builder.
+func (m *BronzeK8sNamespaceMutation) Where(ps ...predicate.BronzeK8sNamespace) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeK8sNamespaceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeK8sNamespaceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeK8sNamespace, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeK8sNamespaceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeK8sNamespaceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeK8sNamespace).
+func (m *BronzeK8sNamespaceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeK8sNamespaceMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.collected_at != nil {
+		fields = append(fields, bronzek8snamespace.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzek8snamespace.FieldFirstCollectedAt)
+	}
+	if m.cluster_name != nil {
+		fields = append(fields, bronzek8snamespace.FieldClusterName)
+	}
+	if m.name != nil {
+		fields = append(fields, bronzek8snamespace.FieldName)
+	}
+	if m.uid != nil {
+		fields = append(fields, bronzek8snamespace.FieldUID)
+	}
+	if m.phase != nil {
+		fields = append(fields, bronzek8snamespace.FieldPhase)
+	}
+	if m.labels_json != nil {
+		fields = append(fields, bronzek8snamespace.FieldLabelsJSON)
+	}
+	if m.api_created_at != nil {
+		fields = append(fields, bronzek8snamespace.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeK8sNamespaceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzek8snamespace.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzek8snamespace.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzek8snamespace.FieldClusterName:
+		return m.ClusterName()
+	case bronzek8snamespace.FieldName:
+		return m.Name()
+	case bronzek8snamespace.FieldUID:
+		return m.UID()
+	case bronzek8snamespace.FieldPhase:
+		return m.Phase()
+	case bronzek8snamespace.FieldLabelsJSON:
+		return m.LabelsJSON()
+	case bronzek8snamespace.FieldAPICreatedAt:
+		return m.APICreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeK8sNamespaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzek8snamespace.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzek8snamespace.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzek8snamespace.FieldClusterName:
+		return m.OldClusterName(ctx)
+	case bronzek8snamespace.FieldName:
+		return m.OldName(ctx)
+	case bronzek8snamespace.FieldUID:
+		return m.OldUID(ctx)
+	case bronzek8snamespace.FieldPhase:
+		return m.OldPhase(ctx)
+	case bronzek8snamespace.FieldLabelsJSON:
+		return m.OldLabelsJSON(ctx)
+	case bronzek8snamespace.FieldAPICreatedAt:
+		return m.OldAPICreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeK8sNamespace field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeK8sNamespaceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzek8snamespace.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzek8snamespace.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzek8snamespace.FieldClusterName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterName(v)
+		return nil
+	case bronzek8snamespace.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case bronzek8snamespace.FieldUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUID(v)
+		return nil
+	case bronzek8snamespace.FieldPhase:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhase(v)
+		return nil
+	case bronzek8snamespace.FieldLabelsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabelsJSON(v)
+		return nil
+	case bronzek8snamespace.FieldAPICreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPICreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sNamespace field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeK8sNamespaceMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeK8sNamespaceMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeK8sNamespaceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeK8sNamespace numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeK8sNamespaceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzek8snamespace.FieldUID) {
+		fields = append(fields, bronzek8snamespace.FieldUID)
+	}
+	if m.FieldCleared(bronzek8snamespace.FieldPhase) {
+		fields = append(fields, bronzek8snamespace.FieldPhase)
+	}
+	if m.FieldCleared(bronzek8snamespace.FieldLabelsJSON) {
+		fields = append(fields, bronzek8snamespace.FieldLabelsJSON)
+	}
+	if m.FieldCleared(bronzek8snamespace.FieldAPICreatedAt) {
+		fields = append(fields, bronzek8snamespace.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeK8sNamespaceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeK8sNamespaceMutation) ClearField(name string) error {
+	switch name {
+	case bronzek8snamespace.FieldUID:
+		m.ClearUID()
+		return nil
+	case bronzek8snamespace.FieldPhase:
+		m.ClearPhase()
+		return nil
+	case bronzek8snamespace.FieldLabelsJSON:
+		m.ClearLabelsJSON()
+		return nil
+	case bronzek8snamespace.FieldAPICreatedAt:
+		m.ClearAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sNamespace nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeK8sNamespaceMutation) ResetField(name string) error {
+	switch name {
+	case bronzek8snamespace.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzek8snamespace.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzek8snamespace.FieldClusterName:
+		m.ResetClusterName()
+		return nil
+	case bronzek8snamespace.FieldName:
+		m.ResetName()
+		return nil
+	case bronzek8snamespace.FieldUID:
+		m.ResetUID()
+		return nil
+	case bronzek8snamespace.FieldPhase:
+		m.ResetPhase()
+		return nil
+	case bronzek8snamespace.FieldLabelsJSON:
+		m.ResetLabelsJSON()
+		return nil
+	case bronzek8snamespace.FieldAPICreatedAt:
+		m.ResetAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sNamespace field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeK8sNamespaceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeK8sNamespaceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeK8sNamespaceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeK8sNamespaceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeK8sNamespaceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeK8sNamespaceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeK8sNamespaceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeK8sNamespace unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeK8sNamespaceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeK8sNamespace edge %s", name)
+}
+
+// BronzeK8sNetworkPolicyMutation represents an operation that mutates the BronzeK8sNetworkPolicy nodes in the graph.
+type BronzeK8sNetworkPolicyMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *string
+	collected_at            *time.Time
+	first_collected_at      *time.Time
+	cluster_name            *string
+	namespace               *string
+	name                    *string
+	uid                     *string
+	pod_selector_json       *json.RawMessage
+	appendpod_selector_json json.RawMessage
+	policy_types_json       *json.RawMessage
+	appendpolicy_types_json json.RawMessage
+	ingress_json            *json.RawMessage
+	appendingress_json      json.RawMessage
+	egress_json             *json.RawMessage
+	appendegress_json       json.RawMessage
+	labels_json             *json.RawMessage
+	appendlabels_json       json.RawMessage
+	api_created_at          *time.Time
+	clearedFields           map[string]struct{}
+	done                    bool
+	oldValue                func(context.Context) (*BronzeK8sNetworkPolicy, error)
+	predicates              []predicate.BronzeK8sNetworkPolicy
+}
+
+var _ ent.Mutation = (*BronzeK8sNetworkPolicyMutation)(nil)
+
+// bronzek8snetworkpolicyOption allows management of the mutation configuration using functional options.
+type bronzek8snetworkpolicyOption func(*BronzeK8sNetworkPolicyMutation)
+
+// newBronzeK8sNetworkPolicyMutation creates new mutation for the BronzeK8sNetworkPolicy entity.
+func newBronzeK8sNetworkPolicyMutation(c config, op Op, opts ...bronzek8snetworkpolicyOption) *BronzeK8sNetworkPolicyMutation {
+	m := &BronzeK8sNetworkPolicyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeK8sNetworkPolicy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeK8sNetworkPolicyID sets the ID field of the mutation.
+func withBronzeK8sNetworkPolicyID(id string) bronzek8snetworkpolicyOption {
+	return func(m *BronzeK8sNetworkPolicyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeK8sNetworkPolicy
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeK8sNetworkPolicy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeK8sNetworkPolicy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeK8sNetworkPolicy sets the old BronzeK8sNetworkPolicy of the mutation.
+func withBronzeK8sNetworkPolicy(node *BronzeK8sNetworkPolicy) bronzek8snetworkpolicyOption {
+	return func(m *BronzeK8sNetworkPolicyMutation) {
+		m.oldValue = func(context.Context) (*BronzeK8sNetworkPolicy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeK8sNetworkPolicyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeK8sNetworkPolicyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("kubernetes: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeK8sNetworkPolicy entities.
+func (m *BronzeK8sNetworkPolicyMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeK8sNetworkPolicyMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeK8sNetworkPolicyMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeK8sNetworkPolicy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeK8sNetworkPolicyMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeK8sNetworkPolicyMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeK8sNetworkPolicy entity.
+// If the BronzeK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNetworkPolicyMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeK8sNetworkPolicyMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeK8sNetworkPolicyMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeK8sNetworkPolicyMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeK8sNetworkPolicy entity.
+// If the BronzeK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNetworkPolicyMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeK8sNetworkPolicyMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (m *BronzeK8sNetworkPolicyMutation) SetClusterName(s string) {
+	m.cluster_name = &s
+}
+
+// ClusterName returns the value of the "cluster_name" field in the mutation.
+func (m *BronzeK8sNetworkPolicyMutation) ClusterName() (r string, exists bool) {
+	v := m.cluster_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClusterName returns the old "cluster_name" field's value of the BronzeK8sNetworkPolicy entity.
+// If the BronzeK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNetworkPolicyMutation) OldClusterName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterName: %w", err)
+	}
+	return oldValue.ClusterName, nil
+}
+
+// ResetClusterName resets all changes to the "cluster_name" field.
+func (m *BronzeK8sNetworkPolicyMutation) ResetClusterName() {
+	m.cluster_name = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *BronzeK8sNetworkPolicyMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *BronzeK8sNetworkPolicyMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the BronzeK8sNetworkPolicy entity.
+// If the BronzeK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNetworkPolicyMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *BronzeK8sNetworkPolicyMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetName sets the "name" field.
+func (m *BronzeK8sNetworkPolicyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BronzeK8sNetworkPolicyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the BronzeK8sNetworkPolicy entity.
+// If the BronzeK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNetworkPolicyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *BronzeK8sNetworkPolicyMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUID sets the "uid" field.
+func (m *BronzeK8sNetworkPolicyMutation) SetUID(s string) {
+	m.uid = &s
+}
+
+// UID returns the value of the "uid" field in the mutation.
+func (m *BronzeK8sNetworkPolicyMutation) UID() (r string, exists bool) {
+	v := m.uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUID returns the old "uid" field's value of the BronzeK8sNetworkPolicy entity.
+// If the BronzeK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNetworkPolicyMutation) OldUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+	}
+	return oldValue.UID, nil
+}
+
+// ClearUID clears the value of the "uid" field.
+func (m *BronzeK8sNetworkPolicyMutation) ClearUID() {
+	m.uid = nil
+	m.clearedFields[bronzek8snetworkpolicy.FieldUID] = struct{}{}
+}
+
+// UIDCleared returns if the "uid" field was cleared in this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) UIDCleared() bool {
+	_, ok := m.clearedFields[bronzek8snetworkpolicy.FieldUID]
+	return ok
+}
+
+// ResetUID resets all changes to the "uid" field.
+func (m *BronzeK8sNetworkPolicyMutation) ResetUID() {
+	m.uid = nil
+	delete(m.clearedFields, bronzek8snetworkpolicy.FieldUID)
+}
+
+// SetPodSelectorJSON sets the "pod_selector_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) SetPodSelectorJSON(jm json.RawMessage) {
+	m.pod_selector_json = &jm
+	m.appendpod_selector_json = nil
+}
+
+// PodSelectorJSON returns the value of the "pod_selector_json" field in the mutation.
+func (m *BronzeK8sNetworkPolicyMutation) PodSelectorJSON() (r json.RawMessage, exists bool) {
+	v := m.pod_selector_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPodSelectorJSON returns the old "pod_selector_json" field's value of the BronzeK8sNetworkPolicy entity.
+// If the BronzeK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNetworkPolicyMutation) OldPodSelectorJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPodSelectorJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPodSelectorJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPodSelectorJSON: %w", err)
+	}
+	return oldValue.PodSelectorJSON, nil
+}
+
+// AppendPodSelectorJSON adds jm to the "pod_selector_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) AppendPodSelectorJSON(jm json.RawMessage) {
+	m.appendpod_selector_json = append(m.appendpod_selector_json, jm...)
+}
+
+// AppendedPodSelectorJSON returns the list of values that were appended to the "pod_selector_json" field in this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) AppendedPodSelectorJSON() (json.RawMessage, bool) {
+	if len(m.appendpod_selector_json) == 0 {
+		return nil, false
+	}
+	return m.appendpod_selector_json, true
+}
+
+// ClearPodSelectorJSON clears the value of the "pod_selector_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) ClearPodSelectorJSON() {
+	m.pod_selector_json = nil
+	m.appendpod_selector_json = nil
+	m.clearedFields[bronzek8snetworkpolicy.FieldPodSelectorJSON] = struct{}{}
+}
+
+// PodSelectorJSONCleared returns if the "pod_selector_json" field was cleared in this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) PodSelectorJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8snetworkpolicy.FieldPodSelectorJSON]
+	return ok
+}
+
+// ResetPodSelectorJSON resets all changes to the "pod_selector_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) ResetPodSelectorJSON() {
+	m.pod_selector_json = nil
+	m.appendpod_selector_json = nil
+	delete(m.clearedFields, bronzek8snetworkpolicy.FieldPodSelectorJSON)
+}
+
+// SetPolicyTypesJSON sets the "policy_types_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) SetPolicyTypesJSON(jm json.RawMessage) {
+	m.policy_types_json = &jm
+	m.appendpolicy_types_json = nil
+}
+
+// PolicyTypesJSON returns the value of the "policy_types_json" field in the mutation.
+func (m *BronzeK8sNetworkPolicyMutation) PolicyTypesJSON() (r json.RawMessage, exists bool) {
+	v := m.policy_types_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPolicyTypesJSON returns the old "policy_types_json" field's value of the BronzeK8sNetworkPolicy entity.
+// If the BronzeK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNetworkPolicyMutation) OldPolicyTypesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPolicyTypesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPolicyTypesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPolicyTypesJSON: %w", err)
+	}
+	return oldValue.PolicyTypesJSON, nil
+}
+
+// AppendPolicyTypesJSON adds jm to the "policy_types_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) AppendPolicyTypesJSON(jm json.RawMessage) {
+	m.appendpolicy_types_json = append(m.appendpolicy_types_json, jm...)
+}
+
+// AppendedPolicyTypesJSON returns the list of values that were appended to the "policy_types_json" field in this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) AppendedPolicyTypesJSON() (json.RawMessage, bool) {
+	if len(m.appendpolicy_types_json) == 0 {
+		return nil, false
+	}
+	return m.appendpolicy_types_json, true
+}
+
+// ClearPolicyTypesJSON clears the value of the "policy_types_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) ClearPolicyTypesJSON() {
+	m.policy_types_json = nil
+	m.appendpolicy_types_json = nil
+	m.clearedFields[bronzek8snetworkpolicy.FieldPolicyTypesJSON] = struct{}{}
+}
+
+// PolicyTypesJSONCleared returns if the "policy_types_json" field was cleared in this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) PolicyTypesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8snetworkpolicy.FieldPolicyTypesJSON]
+	return ok
+}
+
+// ResetPolicyTypesJSON resets all changes to the "policy_types_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) ResetPolicyTypesJSON() {
+	m.policy_types_json = nil
+	m.appendpolicy_types_json = nil
+	delete(m.clearedFields, bronzek8snetworkpolicy.FieldPolicyTypesJSON)
+}
+
+// SetIngressJSON sets the "ingress_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) SetIngressJSON(jm json.RawMessage) {
+	m.ingress_json = &jm
+	m.appendingress_json = nil
+}
+
+// IngressJSON returns the value of the "ingress_json" field in the mutation.
+func (m *BronzeK8sNetworkPolicyMutation) IngressJSON() (r json.RawMessage, exists bool) {
+	v := m.ingress_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIngressJSON returns the old "ingress_json" field's value of the BronzeK8sNetworkPolicy entity.
+// If the BronzeK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNetworkPolicyMutation) OldIngressJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIngressJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIngressJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIngressJSON: %w", err)
+	}
+	return oldValue.IngressJSON, nil
+}
+
+// AppendIngressJSON adds jm to the "ingress_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) AppendIngressJSON(jm json.RawMessage) {
+	m.appendingress_json = append(m.appendingress_json, jm...)
+}
+
+// AppendedIngressJSON returns the list of values that were appended to the "ingress_json" field in this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) AppendedIngressJSON() (json.RawMessage, bool) {
+	if len(m.appendingress_json) == 0 {
+		return nil, false
+	}
+	return m.appendingress_json, true
+}
+
+// ClearIngressJSON clears the value of the "ingress_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) ClearIngressJSON() {
+	m.ingress_json = nil
+	m.appendingress_json = nil
+	m.clearedFields[bronzek8snetworkpolicy.FieldIngressJSON] = struct{}{}
+}
+
+// IngressJSONCleared returns if the "ingress_json" field was cleared in this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) IngressJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8snetworkpolicy.FieldIngressJSON]
+	return ok
+}
+
+// ResetIngressJSON resets all changes to the "ingress_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) ResetIngressJSON() {
+	m.ingress_json = nil
+	m.appendingress_json = nil
+	delete(m.clearedFields, bronzek8snetworkpolicy.FieldIngressJSON)
+}
+
+// SetEgressJSON sets the "egress_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) SetEgressJSON(jm json.RawMessage) {
+	m.egress_json = &jm
+	m.appendegress_json = nil
+}
+
+// EgressJSON returns the value of the "egress_json" field in the mutation.
+func (m *BronzeK8sNetworkPolicyMutation) EgressJSON() (r json.RawMessage, exists bool) {
+	v := m.egress_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEgressJSON returns the old "egress_json" field's value of the BronzeK8sNetworkPolicy entity.
+// If the BronzeK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNetworkPolicyMutation) OldEgressJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEgressJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEgressJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEgressJSON: %w", err)
+	}
+	return oldValue.EgressJSON, nil
+}
+
+// AppendEgressJSON adds jm to the "egress_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) AppendEgressJSON(jm json.RawMessage) {
+	m.appendegress_json = append(m.appendegress_json, jm...)
+}
+
+// AppendedEgressJSON returns the list of values that were appended to the "egress_json" field in this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) AppendedEgressJSON() (json.RawMessage, bool) {
+	if len(m.appendegress_json) == 0 {
+		return nil, false
+	}
+	return m.appendegress_json, true
+}
+
+// ClearEgressJSON clears the value of the "egress_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) ClearEgressJSON() {
+	m.egress_json = nil
+	m.appendegress_json = nil
+	m.clearedFields[bronzek8snetworkpolicy.FieldEgressJSON] = struct{}{}
+}
+
+// EgressJSONCleared returns if the "egress_json" field was cleared in this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) EgressJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8snetworkpolicy.FieldEgressJSON]
+	return ok
+}
+
+// ResetEgressJSON resets all changes to the "egress_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) ResetEgressJSON() {
+	m.egress_json = nil
+	m.appendegress_json = nil
+	delete(m.clearedFields, bronzek8snetworkpolicy.FieldEgressJSON)
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) SetLabelsJSON(jm json.RawMessage) {
+	m.labels_json = &jm
+	m.appendlabels_json = nil
+}
+
+// LabelsJSON returns the value of the "labels_json" field in the mutation.
+func (m *BronzeK8sNetworkPolicyMutation) LabelsJSON() (r json.RawMessage, exists bool) {
+	v := m.labels_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabelsJSON returns the old "labels_json" field's value of the BronzeK8sNetworkPolicy entity.
+// If the BronzeK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNetworkPolicyMutation) OldLabelsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabelsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabelsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabelsJSON: %w", err)
+	}
+	return oldValue.LabelsJSON, nil
+}
+
+// AppendLabelsJSON adds jm to the "labels_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) AppendLabelsJSON(jm json.RawMessage) {
+	m.appendlabels_json = append(m.appendlabels_json, jm...)
+}
+
+// AppendedLabelsJSON returns the list of values that were appended to the "labels_json" field in this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) AppendedLabelsJSON() (json.RawMessage, bool) {
+	if len(m.appendlabels_json) == 0 {
+		return nil, false
+	}
+	return m.appendlabels_json, true
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) ClearLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	m.clearedFields[bronzek8snetworkpolicy.FieldLabelsJSON] = struct{}{}
+}
+
+// LabelsJSONCleared returns if the "labels_json" field was cleared in this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) LabelsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8snetworkpolicy.FieldLabelsJSON]
+	return ok
+}
+
+// ResetLabelsJSON resets all changes to the "labels_json" field.
+func (m *BronzeK8sNetworkPolicyMutation) ResetLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	delete(m.clearedFields, bronzek8snetworkpolicy.FieldLabelsJSON)
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (m *BronzeK8sNetworkPolicyMutation) SetAPICreatedAt(t time.Time) {
+	m.api_created_at = &t
+}
+
+// APICreatedAt returns the value of the "api_created_at" field in the mutation.
+func (m *BronzeK8sNetworkPolicyMutation) APICreatedAt() (r time.Time, exists bool) {
+	v := m.api_created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPICreatedAt returns the old "api_created_at" field's value of the BronzeK8sNetworkPolicy entity.
+// If the BronzeK8sNetworkPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNetworkPolicyMutation) OldAPICreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPICreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPICreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPICreatedAt: %w", err)
+	}
+	return oldValue.APICreatedAt, nil
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (m *BronzeK8sNetworkPolicyMutation) ClearAPICreatedAt() {
+	m.api_created_at = nil
+	m.clearedFields[bronzek8snetworkpolicy.FieldAPICreatedAt] = struct{}{}
+}
+
+// APICreatedAtCleared returns if the "api_created_at" field was cleared in this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) APICreatedAtCleared() bool {
+	_, ok := m.clearedFields[bronzek8snetworkpolicy.FieldAPICreatedAt]
+	return ok
+}
+
+// ResetAPICreatedAt resets all changes to the "api_created_at" field.
+func (m *BronzeK8sNetworkPolicyMutation) ResetAPICreatedAt() {
+	m.api_created_at = nil
+	delete(m.clearedFields, bronzek8snetworkpolicy.FieldAPICreatedAt)
+}
+
+// Where appends a list predicates to the BronzeK8sNetworkPolicyMutation builder.
+func (m *BronzeK8sNetworkPolicyMutation) Where(ps ...predicate.BronzeK8sNetworkPolicy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeK8sNetworkPolicyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeK8sNetworkPolicyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeK8sNetworkPolicy, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeK8sNetworkPolicyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeK8sNetworkPolicyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeK8sNetworkPolicy).
+func (m *BronzeK8sNetworkPolicyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeK8sNetworkPolicyMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.collected_at != nil {
+		fields = append(fields, bronzek8snetworkpolicy.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzek8snetworkpolicy.FieldFirstCollectedAt)
+	}
+	if m.cluster_name != nil {
+		fields = append(fields, bronzek8snetworkpolicy.FieldClusterName)
+	}
+	if m.namespace != nil {
+		fields = append(fields, bronzek8snetworkpolicy.FieldNamespace)
+	}
+	if m.name != nil {
+		fields = append(fields, bronzek8snetworkpolicy.FieldName)
+	}
+	if m.uid != nil {
+		fields = append(fields, bronzek8snetworkpolicy.FieldUID)
+	}
+	if m.pod_selector_json != nil {
+		fields = append(fields, bronzek8snetworkpolicy.FieldPodSelectorJSON)
+	}
+	if m.policy_types_json != nil {
+		fields = append(fields, bronzek8snetworkpolicy.FieldPolicyTypesJSON)
+	}
+	if m.ingress_json != nil {
+		fields = append(fields, bronzek8snetworkpolicy.FieldIngressJSON)
+	}
+	if m.egress_json != nil {
+		fields = append(fields, bronzek8snetworkpolicy.FieldEgressJSON)
+	}
+	if m.labels_json != nil {
+		fields = append(fields, bronzek8snetworkpolicy.FieldLabelsJSON)
+	}
+	if m.api_created_at != nil {
+		fields = append(fields, bronzek8snetworkpolicy.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeK8sNetworkPolicyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzek8snetworkpolicy.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzek8snetworkpolicy.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzek8snetworkpolicy.FieldClusterName:
+		return m.ClusterName()
+	case bronzek8snetworkpolicy.FieldNamespace:
+		return m.Namespace()
+	case bronzek8snetworkpolicy.FieldName:
+		return m.Name()
+	case bronzek8snetworkpolicy.FieldUID:
+		return m.UID()
+	case bronzek8snetworkpolicy.FieldPodSelectorJSON:
+		return m.PodSelectorJSON()
+	case bronzek8snetworkpolicy.FieldPolicyTypesJSON:
+		return m.PolicyTypesJSON()
+	case bronzek8snetworkpolicy.FieldIngressJSON:
+		return m.IngressJSON()
+	case bronzek8snetworkpolicy.FieldEgressJSON:
+		return m.EgressJSON()
+	case bronzek8snetworkpolicy.FieldLabelsJSON:
+		return m.LabelsJSON()
+	case bronzek8snetworkpolicy.FieldAPICreatedAt:
+		return m.APICreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeK8sNetworkPolicyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzek8snetworkpolicy.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzek8snetworkpolicy.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzek8snetworkpolicy.FieldClusterName:
+		return m.OldClusterName(ctx)
+	case bronzek8snetworkpolicy.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case bronzek8snetworkpolicy.FieldName:
+		return m.OldName(ctx)
+	case bronzek8snetworkpolicy.FieldUID:
+		return m.OldUID(ctx)
+	case bronzek8snetworkpolicy.FieldPodSelectorJSON:
+		return m.OldPodSelectorJSON(ctx)
+	case bronzek8snetworkpolicy.FieldPolicyTypesJSON:
+		return m.OldPolicyTypesJSON(ctx)
+	case bronzek8snetworkpolicy.FieldIngressJSON:
+		return m.OldIngressJSON(ctx)
+	case bronzek8snetworkpolicy.FieldEgressJSON:
+		return m.OldEgressJSON(ctx)
+	case bronzek8snetworkpolicy.FieldLabelsJSON:
+		return m.OldLabelsJSON(ctx)
+	case bronzek8snetworkpolicy.FieldAPICreatedAt:
+		return m.OldAPICreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeK8sNetworkPolicy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeK8sNetworkPolicyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzek8snetworkpolicy.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzek8snetworkpolicy.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzek8snetworkpolicy.FieldClusterName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterName(v)
+		return nil
+	case bronzek8snetworkpolicy.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case bronzek8snetworkpolicy.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case bronzek8snetworkpolicy.FieldUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUID(v)
+		return nil
+	case bronzek8snetworkpolicy.FieldPodSelectorJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPodSelectorJSON(v)
+		return nil
+	case bronzek8snetworkpolicy.FieldPolicyTypesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicyTypesJSON(v)
+		return nil
+	case bronzek8snetworkpolicy.FieldIngressJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIngressJSON(v)
+		return nil
+	case bronzek8snetworkpolicy.FieldEgressJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEgressJSON(v)
+		return nil
+	case bronzek8snetworkpolicy.FieldLabelsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabelsJSON(v)
+		return nil
+	case bronzek8snetworkpolicy.FieldAPICreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPICreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sNetworkPolicy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeK8sNetworkPolicyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeK8sNetworkPolicyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeK8sNetworkPolicy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeK8sNetworkPolicyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzek8snetworkpolicy.FieldUID) {
+		fields = append(fields, bronzek8snetworkpolicy.FieldUID)
+	}
+	if m.FieldCleared(bronzek8snetworkpolicy.FieldPodSelectorJSON) {
+		fields = append(fields, bronzek8snetworkpolicy.FieldPodSelectorJSON)
+	}
+	if m.FieldCleared(bronzek8snetworkpolicy.FieldPolicyTypesJSON) {
+		fields = append(fields, bronzek8snetworkpolicy.FieldPolicyTypesJSON)
+	}
+	if m.FieldCleared(bronzek8snetworkpolicy.FieldIngressJSON) {
+		fields = append(fields, bronzek8snetworkpolicy.FieldIngressJSON)
+	}
+	if m.FieldCleared(bronzek8snetworkpolicy.FieldEgressJSON) {
+		fields = append(fields, bronzek8snetworkpolicy.FieldEgressJSON)
+	}
+	if m.FieldCleared(bronzek8snetworkpolicy.FieldLabelsJSON) {
+		fields = append(fields, bronzek8snetworkpolicy.FieldLabelsJSON)
+	}
+	if m.FieldCleared(bronzek8snetworkpolicy.FieldAPICreatedAt) {
+		fields = append(fields, bronzek8snetworkpolicy.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeK8sNetworkPolicyMutation) ClearField(name string) error {
+	switch name {
+	case bronzek8snetworkpolicy.FieldUID:
+		m.ClearUID()
+		return nil
+	case bronzek8snetworkpolicy.FieldPodSelectorJSON:
+		m.ClearPodSelectorJSON()
+		return nil
+	case bronzek8snetworkpolicy.FieldPolicyTypesJSON:
+		m.ClearPolicyTypesJSON()
+		return nil
+	case bronzek8snetworkpolicy.FieldIngressJSON:
+		m.ClearIngressJSON()
+		return nil
+	case bronzek8snetworkpolicy.FieldEgressJSON:
+		m.ClearEgressJSON()
+		return nil
+	case bronzek8snetworkpolicy.FieldLabelsJSON:
+		m.ClearLabelsJSON()
+		return nil
+	case bronzek8snetworkpolicy.FieldAPICreatedAt:
+		m.ClearAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sNetworkPolicy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeK8sNetworkPolicyMutation) ResetField(name string) error {
+	switch name {
+	case bronzek8snetworkpolicy.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzek8snetworkpolicy.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzek8snetworkpolicy.FieldClusterName:
+		m.ResetClusterName()
+		return nil
+	case bronzek8snetworkpolicy.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case bronzek8snetworkpolicy.FieldName:
+		m.ResetName()
+		return nil
+	case bronzek8snetworkpolicy.FieldUID:
+		m.ResetUID()
+		return nil
+	case bronzek8snetworkpolicy.FieldPodSelectorJSON:
+		m.ResetPodSelectorJSON()
+		return nil
+	case bronzek8snetworkpolicy.FieldPolicyTypesJSON:
+		m.ResetPolicyTypesJSON()
+		return nil
+	case bronzek8snetworkpolicy.FieldIngressJSON:
+		m.ResetIngressJSON()
+		return nil
+	case bronzek8snetworkpolicy.FieldEgressJSON:
+		m.ResetEgressJSON()
+		return nil
+	case bronzek8snetworkpolicy.FieldLabelsJSON:
+		m.ResetLabelsJSON()
+		return nil
+	case bronzek8snetworkpolicy.FieldAPICreatedAt:
+		m.ResetAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sNetworkPolicy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeK8sNetworkPolicyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeK8sNetworkPolicyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeK8sNetworkPolicy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeK8sNetworkPolicyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeK8sNetworkPolicy edge %s", name)
+}
+
+// BronzeK8sNodeMutation represents an operation that mutates the BronzeK8sNode nodes in the graph.
+type BronzeK8sNodeMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	collected_at       *time.Time
+	first_collected_at *time.Time
+	cluster_name       *string
+	name               *string
+	uid                *string
+	provider_id        *string
+	internal_ip        *string
+	external_ip        *string
+	node_pool          *string
+	instance_type      *string
+	region             *string
+	zone               *string
+	kubelet_version    *string
+	os_image           *string
+	kernel_version     *string
+	container_runtime  *string
+	architecture       *string
+	ready              *bool
+	unschedulable      *bool
+	labels_json        *json.RawMessage
+	appendlabels_json  json.RawMessage
+	taints_json        *json.RawMessage
+	appendtaints_json  json.RawMessage
+	api_created_at     *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*BronzeK8sNode, error)
+	predicates         []predicate.BronzeK8sNode
+}
+
+var _ ent.Mutation = (*BronzeK8sNodeMutation)(nil)
+
+// bronzek8snodeOption allows management of the mutation configuration using functional options.
+type bronzek8snodeOption func(*BronzeK8sNodeMutation)
+
+// newBronzeK8sNodeMutation creates new mutation for the BronzeK8sNode entity.
+func newBronzeK8sNodeMutation(c config, op Op, opts ...bronzek8snodeOption) *BronzeK8sNodeMutation {
+	m := &BronzeK8sNodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeK8sNode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeK8sNodeID sets the ID field of the mutation.
+func withBronzeK8sNodeID(id string) bronzek8snodeOption {
+	return func(m *BronzeK8sNodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeK8sNode
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeK8sNode, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeK8sNode.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeK8sNode sets the old BronzeK8sNode of the mutation.
+func withBronzeK8sNode(node *BronzeK8sNode) bronzek8snodeOption {
+	return func(m *BronzeK8sNodeMutation) {
+		m.oldValue = func(context.Context) (*BronzeK8sNode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeK8sNodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeK8sNodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("kubernetes: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeK8sNode entities.
+func (m *BronzeK8sNodeMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeK8sNodeMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeK8sNodeMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeK8sNode.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeK8sNodeMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeK8sNodeMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeK8sNodeMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeK8sNodeMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeK8sNodeMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeK8sNodeMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (m *BronzeK8sNodeMutation) SetClusterName(s string) {
+	m.cluster_name = &s
+}
+
+// ClusterName returns the value of the "cluster_name" field in the mutation.
+func (m *BronzeK8sNodeMutation) ClusterName() (r string, exists bool) {
+	v := m.cluster_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClusterName returns the old "cluster_name" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldClusterName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterName: %w", err)
+	}
+	return oldValue.ClusterName, nil
+}
+
+// ResetClusterName resets all changes to the "cluster_name" field.
+func (m *BronzeK8sNodeMutation) ResetClusterName() {
+	m.cluster_name = nil
+}
+
+// SetName sets the "name" field.
+func (m *BronzeK8sNodeMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BronzeK8sNodeMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *BronzeK8sNodeMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUID sets the "uid" field.
+func (m *BronzeK8sNodeMutation) SetUID(s string) {
+	m.uid = &s
+}
+
+// UID returns the value of the "uid" field in the mutation.
+func (m *BronzeK8sNodeMutation) UID() (r string, exists bool) {
+	v := m.uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUID returns the old "uid" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+	}
+	return oldValue.UID, nil
+}
+
+// ClearUID clears the value of the "uid" field.
+func (m *BronzeK8sNodeMutation) ClearUID() {
+	m.uid = nil
+	m.clearedFields[bronzek8snode.FieldUID] = struct{}{}
+}
+
+// UIDCleared returns if the "uid" field was cleared in this mutation.
+func (m *BronzeK8sNodeMutation) UIDCleared() bool {
+	_, ok := m.clearedFields[bronzek8snode.FieldUID]
+	return ok
+}
+
+// ResetUID resets all changes to the "uid" field.
+func (m *BronzeK8sNodeMutation) ResetUID() {
+	m.uid = nil
+	delete(m.clearedFields, bronzek8snode.FieldUID)
+}
+
+// SetProviderID sets the "provider_id" field.
+func (m *BronzeK8sNodeMutation) SetProviderID(s string) {
+	m.provider_id = &s
+}
+
+// ProviderID returns the value of the "provider_id" field in the mutation.
+func (m *BronzeK8sNodeMutation) ProviderID() (r string, exists bool) {
+	v := m.provider_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProviderID returns the old "provider_id" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldProviderID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProviderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProviderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProviderID: %w", err)
+	}
+	return oldValue.ProviderID, nil
+}
+
+// ClearProviderID clears the value of the "provider_id" field.
+func (m *BronzeK8sNodeMutation) ClearProviderID() {
+	m.provider_id = nil
+	m.clearedFields[bronzek8snode.FieldProviderID] = struct{}{}
+}
+
+// ProviderIDCleared returns if the "provider_id" field was cleared in this mutation.
+func (m *BronzeK8sNodeMutation) ProviderIDCleared() bool {
+	_, ok := m.clearedFields[bronzek8snode.FieldProviderID]
+	return ok
+}
+
+// ResetProviderID resets all changes to the "provider_id" field.
+func (m *BronzeK8sNodeMutation) ResetProviderID() {
+	m.provider_id = nil
+	delete(m.clearedFields, bronzek8snode.FieldProviderID)
+}
+
+// SetInternalIP sets the "internal_ip" field.
+func (m *BronzeK8sNodeMutation) SetInternalIP(s string) {
+	m.internal_ip = &s
+}
+
+// InternalIP returns the value of the "internal_ip" field in the mutation.
+func (m *BronzeK8sNodeMutation) InternalIP() (r string, exists bool) {
+	v := m.internal_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInternalIP returns the old "internal_ip" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldInternalIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInternalIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInternalIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInternalIP: %w", err)
+	}
+	return oldValue.InternalIP, nil
+}
+
+// ClearInternalIP clears the value of the "internal_ip" field.
+func (m *BronzeK8sNodeMutation) ClearInternalIP() {
+	m.internal_ip = nil
+	m.clearedFields[bronzek8snode.FieldInternalIP] = struct{}{}
+}
+
+// InternalIPCleared returns if the "internal_ip" field was cleared in this mutation.
+func (m *BronzeK8sNodeMutation) InternalIPCleared() bool {
+	_, ok := m.clearedFields[bronzek8snode.FieldInternalIP]
+	return ok
+}
+
+// ResetInternalIP resets all changes to the "internal_ip" field.
+func (m *BronzeK8sNodeMutation) ResetInternalIP() {
+	m.internal_ip = nil
+	delete(m.clearedFields, bronzek8snode.FieldInternalIP)
+}
+
+// SetExternalIP sets the "external_ip" field.
+func (m *BronzeK8sNodeMutation) SetExternalIP(s string) {
+	m.external_ip = &s
+}
+
+// ExternalIP returns the value of the "external_ip" field in the mutation.
+func (m *BronzeK8sNodeMutation) ExternalIP() (r string, exists bool) {
+	v := m.external_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalIP returns the old "external_ip" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldExternalIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalIP: %w", err)
+	}
+	return oldValue.ExternalIP, nil
+}
+
+// ClearExternalIP clears the value of the "external_ip" field.
+func (m *BronzeK8sNodeMutation) ClearExternalIP() {
+	m.external_ip = nil
+	m.clearedFields[bronzek8snode.FieldExternalIP] = struct{}{}
+}
+
+// ExternalIPCleared returns if the "external_ip" field was cleared in this mutation.
+func (m *BronzeK8sNodeMutation) ExternalIPCleared() bool {
+	_, ok := m.clearedFields[bronzek8snode.FieldExternalIP]
+	return ok
+}
+
+// ResetExternalIP resets all changes to the "external_ip" field.
+func (m *BronzeK8sNodeMutation) ResetExternalIP() {
+	m.external_ip = nil
+	delete(m.clearedFields, bronzek8snode.FieldExternalIP)
+}
+
+// SetNodePool sets the "node_pool" field.
+func (m *BronzeK8sNodeMutation) SetNodePool(s string) {
+	m.node_pool = &s
+}
+
+// NodePool returns the value of the "node_pool" field in the mutation.
+func (m *BronzeK8sNodeMutation) NodePool() (r string, exists bool) {
+	v := m.node_pool
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNodePool returns the old "node_pool" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldNodePool(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNodePool is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNodePool requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNodePool: %w", err)
+	}
+	return oldValue.NodePool, nil
+}
+
+// ClearNodePool clears the value of the "node_pool" field.
+func (m *BronzeK8sNodeMutation) ClearNodePool() {
+	m.node_pool = nil
+	m.clearedFields[bronzek8snode.FieldNodePool] = struct{}{}
+}
+
+// NodePoolCleared returns if the "node_pool" field was cleared in this mutation.
+func (m *BronzeK8sNodeMutation) NodePoolCleared() bool {
+	_, ok := m.clearedFields[bronzek8snode.FieldNodePool]
+	return ok
+}
+
+// ResetNodePool resets all changes to the "node_pool" field.
+func (m *BronzeK8sNodeMutation) ResetNodePool() {
+	m.node_pool = nil
+	delete(m.clearedFields, bronzek8snode.FieldNodePool)
+}
+
+// SetInstanceType sets the "instance_type" field.
+func (m *BronzeK8sNodeMutation) SetInstanceType(s string) {
+	m.instance_type = &s
+}
+
+// InstanceType returns the value of the "instance_type" field in the mutation.
+func (m *BronzeK8sNodeMutation) InstanceType() (r string, exists bool) {
+	v := m.instance_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstanceType returns the old "instance_type" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldInstanceType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInstanceType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInstanceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstanceType: %w", err)
+	}
+	return oldValue.InstanceType, nil
+}
+
+// ClearInstanceType clears the value of the "instance_type" field.
+func (m *BronzeK8sNodeMutation) ClearInstanceType() {
+	m.instance_type = nil
+	m.clearedFields[bronzek8snode.FieldInstanceType] = struct{}{}
+}
+
+// InstanceTypeCleared returns if the "instance_type" field was cleared in this mutation.
+func (m *BronzeK8sNodeMutation) InstanceTypeCleared() bool {
+	_, ok := m.clearedFields[bronzek8snode.FieldInstanceType]
+	return ok
+}
+
+// ResetInstanceType resets all changes to the "instance_type" field.
+func (m *BronzeK8sNodeMutation) ResetInstanceType() {
+	m.instance_type = nil
+	delete(m.clearedFields, bronzek8snode.FieldInstanceType)
+}
+
+// SetRegion sets the "region" field.
+func (m *BronzeK8sNodeMutation) SetRegion(s string) {
+	m.region = &s
+}
+
+// Region returns the value of the "region" field in the mutation.
+func (m *BronzeK8sNodeMutation) Region() (r string, exists bool) {
+	v := m.region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegion returns the old "region" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
+	}
+	return oldValue.Region, nil
+}
+
+// ClearRegion clears the value of the "region" field.
+func (m *BronzeK8sNodeMutation) ClearRegion() {
+	m.region = nil
+	m.clearedFields[bronzek8snode.FieldRegion] = struct{}{}
+}
+
+// RegionCleared returns if the "region" field was cleared in this mutation.
+func (m *BronzeK8sNodeMutation) RegionCleared() bool {
+	_, ok := m.clearedFields[bronzek8snode.FieldRegion]
+	return ok
+}
+
+// ResetRegion resets all changes to the "region" field.
+func (m *BronzeK8sNodeMutation) ResetRegion() {
+	m.region = nil
+	delete(m.clearedFields, bronzek8snode.FieldRegion)
+}
+
+// SetZone sets the "zone" field.
+func (m *BronzeK8sNodeMutation) SetZone(s string) {
+	m.zone = &s
+}
+
+// Zone returns the value of the "zone" field in the mutation.
+func (m *BronzeK8sNodeMutation) Zone() (r string, exists bool) {
+	v := m.zone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldZone returns the old "zone" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldZone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldZone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldZone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldZone: %w", err)
+	}
+	return oldValue.Zone, nil
+}
+
+// ClearZone clears the value of the "zone" field.
+func (m *BronzeK8sNodeMutation) ClearZone() {
+	m.zone = nil
+	m.clearedFields[bronzek8snode.FieldZone] = struct{}{}
+}
+
+// ZoneCleared returns if the "zone" field was cleared in this mutation.
+func (m *BronzeK8sNodeMutation) ZoneCleared() bool {
+	_, ok := m.clearedFields[bronzek8snode.FieldZone]
+	return ok
+}
+
+// ResetZone resets all changes to the "zone" field.
+func (m *BronzeK8sNodeMutation) ResetZone() {
+	m.zone = nil
+	delete(m.clearedFields, bronzek8snode.FieldZone)
+}
+
+// SetKubeletVersion sets the "kubelet_version" field.
+func (m *BronzeK8sNodeMutation) SetKubeletVersion(s string) {
+	m.kubelet_version = &s
+}
+
+// KubeletVersion returns the value of the "kubelet_version" field in the mutation.
+func (m *BronzeK8sNodeMutation) KubeletVersion() (r string, exists bool) {
+	v := m.kubelet_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKubeletVersion returns the old "kubelet_version" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldKubeletVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKubeletVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKubeletVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKubeletVersion: %w", err)
+	}
+	return oldValue.KubeletVersion, nil
+}
+
+// ClearKubeletVersion clears the value of the "kubelet_version" field.
+func (m *BronzeK8sNodeMutation) ClearKubeletVersion() {
+	m.kubelet_version = nil
+	m.clearedFields[bronzek8snode.FieldKubeletVersion] = struct{}{}
+}
+
+// KubeletVersionCleared returns if the "kubelet_version" field was cleared in this mutation.
+func (m *BronzeK8sNodeMutation) KubeletVersionCleared() bool {
+	_, ok := m.clearedFields[bronzek8snode.FieldKubeletVersion]
+	return ok
+}
+
+// ResetKubeletVersion resets all changes to the "kubelet_version" field.
+func (m *BronzeK8sNodeMutation) ResetKubeletVersion() {
+	m.kubelet_version = nil
+	delete(m.clearedFields, bronzek8snode.FieldKubeletVersion)
+}
+
+// SetOsImage sets the "os_image" field.
+func (m *BronzeK8sNodeMutation) SetOsImage(s string) {
+	m.os_image = &s
+}
+
+// OsImage returns the value of the "os_image" field in the mutation.
+func (m *BronzeK8sNodeMutation) OsImage() (r string, exists bool) {
+	v := m.os_image
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOsImage returns the old "os_image" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldOsImage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOsImage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOsImage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOsImage: %w", err)
+	}
+	return oldValue.OsImage, nil
+}
+
+// ClearOsImage clears the value of the "os_image" field.
+func (m *BronzeK8sNodeMutation) ClearOsImage() {
+	m.os_image = nil
+	m.clearedFields[bronzek8snode.FieldOsImage] = struct{}{}
+}
+
+// OsImageCleared returns if the "os_image" field was cleared in this mutation.
+func (m *BronzeK8sNodeMutation) OsImageCleared() bool {
+	_, ok := m.clearedFields[bronzek8snode.FieldOsImage]
+	return ok
+}
+
+// ResetOsImage resets all changes to the "os_image" field.
+func (m *BronzeK8sNodeMutation) ResetOsImage() {
+	m.os_image = nil
+	delete(m.clearedFields, bronzek8snode.FieldOsImage)
+}
+
+// SetKernelVersion sets the "kernel_version" field.
+func (m *BronzeK8sNodeMutation) SetKernelVersion(s string) {
+	m.kernel_version = &s
+}
+
+// KernelVersion returns the value of the "kernel_version" field in the mutation.
+func (m *BronzeK8sNodeMutation) KernelVersion() (r string, exists bool) {
+	v := m.kernel_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKernelVersion returns the old "kernel_version" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldKernelVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKernelVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKernelVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKernelVersion: %w", err)
+	}
+	return oldValue.KernelVersion, nil
+}
+
+// ClearKernelVersion clears the value of the "kernel_version" field.
+func (m *BronzeK8sNodeMutation) ClearKernelVersion() {
+	m.kernel_version = nil
+	m.clearedFields[bronzek8snode.FieldKernelVersion] = struct{}{}
+}
+
+// KernelVersionCleared returns if the "kernel_version" field was cleared in this mutation.
+func (m *BronzeK8sNodeMutation) KernelVersionCleared() bool {
+	_, ok := m.clearedFields[bronzek8snode.FieldKernelVersion]
+	return ok
+}
+
+// ResetKernelVersion resets all changes to the "kernel_version" field.
+func (m *BronzeK8sNodeMutation) ResetKernelVersion() {
+	m.kernel_version = nil
+	delete(m.clearedFields, bronzek8snode.FieldKernelVersion)
+}
+
+// SetContainerRuntime sets the "container_runtime" field.
+func (m *BronzeK8sNodeMutation) SetContainerRuntime(s string) {
+	m.container_runtime = &s
+}
+
+// ContainerRuntime returns the value of the "container_runtime" field in the mutation.
+func (m *BronzeK8sNodeMutation) ContainerRuntime() (r string, exists bool) {
+	v := m.container_runtime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContainerRuntime returns the old "container_runtime" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldContainerRuntime(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContainerRuntime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContainerRuntime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContainerRuntime: %w", err)
+	}
+	return oldValue.ContainerRuntime, nil
+}
+
+// ClearContainerRuntime clears the value of the "container_runtime" field.
+func (m *BronzeK8sNodeMutation) ClearContainerRuntime() {
+	m.container_runtime = nil
+	m.clearedFields[bronzek8snode.FieldContainerRuntime] = struct{}{}
+}
+
+// ContainerRuntimeCleared returns if the "container_runtime" field was cleared in this mutation.
+func (m *BronzeK8sNodeMutation) ContainerRuntimeCleared() bool {
+	_, ok := m.clearedFields[bronzek8snode.FieldContainerRuntime]
+	return ok
+}
+
+// ResetContainerRuntime resets all changes to the "container_runtime" field.
+func (m *BronzeK8sNodeMutation) ResetContainerRuntime() {
+	m.container_runtime = nil
+	delete(m.clearedFields, bronzek8snode.FieldContainerRuntime)
+}
+
+// SetArchitecture sets the "architecture" field.
+func (m *BronzeK8sNodeMutation) SetArchitecture(s string) {
+	m.architecture = &s
+}
+
+// Architecture returns the value of the "architecture" field in the mutation.
+func (m *BronzeK8sNodeMutation) Architecture() (r string, exists bool) {
+	v := m.architecture
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchitecture returns the old "architecture" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldArchitecture(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchitecture is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchitecture requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchitecture: %w", err)
+	}
+	return oldValue.Architecture, nil
+}
+
+// ClearArchitecture clears the value of the "architecture" field.
+func (m *BronzeK8sNodeMutation) ClearArchitecture() {
+	m.architecture = nil
+	m.clearedFields[bronzek8snode.FieldArchitecture] = struct{}{}
+}
+
+// ArchitectureCleared returns if the "architecture" field was cleared in this mutation.
+func (m *BronzeK8sNodeMutation) ArchitectureCleared() bool {
+	_, ok := m.clearedFields[bronzek8snode.FieldArchitecture]
+	return ok
+}
+
+// ResetArchitecture resets all changes to the "architecture" field.
+func (m *BronzeK8sNodeMutation) ResetArchitecture() {
+	m.architecture = nil
+	delete(m.clearedFields, bronzek8snode.FieldArchitecture)
+}
+
+// SetReady sets the "ready" field.
+func (m *BronzeK8sNodeMutation) SetReady(b bool) {
+	m.ready = &b
+}
+
+// Ready returns the value of the "ready" field in the mutation.
+func (m *BronzeK8sNodeMutation) Ready() (r bool, exists bool) {
+	v := m.ready
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReady returns the old "ready" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldReady(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReady is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReady requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReady: %w", err)
+	}
+	return oldValue.Ready, nil
+}
+
+// ResetReady resets all changes to the "ready" field.
+func (m *BronzeK8sNodeMutation) ResetReady() {
+	m.ready = nil
+}
+
+// SetUnschedulable sets the "unschedulable" field.
+func (m *BronzeK8sNodeMutation) SetUnschedulable(b bool) {
+	m.unschedulable = &b
+}
+
+// Unschedulable returns the value of the "unschedulable" field in the mutation.
+func (m *BronzeK8sNodeMutation) Unschedulable() (r bool, exists bool) {
+	v := m.unschedulable
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnschedulable returns the old "unschedulable" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldUnschedulable(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnschedulable is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnschedulable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnschedulable: %w", err)
+	}
+	return oldValue.Unschedulable, nil
+}
+
+// ResetUnschedulable resets all changes to the "unschedulable" field.
+func (m *BronzeK8sNodeMutation) ResetUnschedulable() {
+	m.unschedulable = nil
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (m *BronzeK8sNodeMutation) SetLabelsJSON(jm json.RawMessage) {
+	m.labels_json = &jm
+	m.appendlabels_json = nil
+}
+
+// LabelsJSON returns the value of the "labels_json" field in the mutation.
+func (m *BronzeK8sNodeMutation) LabelsJSON() (r json.RawMessage, exists bool) {
+	v := m.labels_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabelsJSON returns the old "labels_json" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldLabelsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabelsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabelsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabelsJSON: %w", err)
+	}
+	return oldValue.LabelsJSON, nil
+}
+
+// AppendLabelsJSON adds jm to the "labels_json" field.
+func (m *BronzeK8sNodeMutation) AppendLabelsJSON(jm json.RawMessage) {
+	m.appendlabels_json = append(m.appendlabels_json, jm...)
+}
+
+// AppendedLabelsJSON returns the list of values that were appended to the "labels_json" field in this mutation.
+func (m *BronzeK8sNodeMutation) AppendedLabelsJSON() (json.RawMessage, bool) {
+	if len(m.appendlabels_json) == 0 {
+		return nil, false
+	}
+	return m.appendlabels_json, true
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (m *BronzeK8sNodeMutation) ClearLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	m.clearedFields[bronzek8snode.FieldLabelsJSON] = struct{}{}
+}
+
+// LabelsJSONCleared returns if the "labels_json" field was cleared in this mutation.
+func (m *BronzeK8sNodeMutation) LabelsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8snode.FieldLabelsJSON]
+	return ok
+}
+
+// ResetLabelsJSON resets all changes to the "labels_json" field.
+func (m *BronzeK8sNodeMutation) ResetLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	delete(m.clearedFields, bronzek8snode.FieldLabelsJSON)
+}
+
+// SetTaintsJSON sets the "taints_json" field.
+func (m *BronzeK8sNodeMutation) SetTaintsJSON(jm json.RawMessage) {
+	m.taints_json = &jm
+	m.appendtaints_json = nil
+}
+
+// TaintsJSON returns the value of the "taints_json" field in the mutation.
+func (m *BronzeK8sNodeMutation) TaintsJSON() (r json.RawMessage, exists bool) {
+	v := m.taints_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaintsJSON returns the old "taints_json" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldTaintsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaintsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaintsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaintsJSON: %w", err)
+	}
+	return oldValue.TaintsJSON, nil
+}
+
+// AppendTaintsJSON adds jm to the "taints_json" field.
+func (m *BronzeK8sNodeMutation) AppendTaintsJSON(jm json.RawMessage) {
+	m.appendtaints_json = append(m.appendtaints_json, jm...)
+}
+
+// AppendedTaintsJSON returns the list of values that were appended to the "taints_json" field in this mutation.
+func (m *BronzeK8sNodeMutation) AppendedTaintsJSON() (json.RawMessage, bool) {
+	if len(m.appendtaints_json) == 0 {
+		return nil, false
+	}
+	return m.appendtaints_json, true
+}
+
+// ClearTaintsJSON clears the value of the "taints_json" field.
+func (m *BronzeK8sNodeMutation) ClearTaintsJSON() {
+	m.taints_json = nil
+	m.appendtaints_json = nil
+	m.clearedFields[bronzek8snode.FieldTaintsJSON] = struct{}{}
+}
+
+// TaintsJSONCleared returns if the "taints_json" field was cleared in this mutation.
+func (m *BronzeK8sNodeMutation) TaintsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8snode.FieldTaintsJSON]
+	return ok
+}
+
+// ResetTaintsJSON resets all changes to the "taints_json" field.
+func (m *BronzeK8sNodeMutation) ResetTaintsJSON() {
+	m.taints_json = nil
+	m.appendtaints_json = nil
+	delete(m.clearedFields, bronzek8snode.FieldTaintsJSON)
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (m *BronzeK8sNodeMutation) SetAPICreatedAt(t time.Time) {
+	m.api_created_at = &t
+}
+
+// APICreatedAt returns the value of the "api_created_at" field in the mutation.
+func (m *BronzeK8sNodeMutation) APICreatedAt() (r time.Time, exists bool) {
+	v := m.api_created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPICreatedAt returns the old "api_created_at" field's value of the BronzeK8sNode entity.
+// If the BronzeK8sNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sNodeMutation) OldAPICreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPICreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPICreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPICreatedAt: %w", err)
+	}
+	return oldValue.APICreatedAt, nil
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (m *BronzeK8sNodeMutation) ClearAPICreatedAt() {
+	m.api_created_at = nil
+	m.clearedFields[bronzek8snode.FieldAPICreatedAt] = struct{}{}
+}
+
+// APICreatedAtCleared returns if the "api_created_at" field was cleared in this mutation.
+func (m *BronzeK8sNodeMutation) APICreatedAtCleared() bool {
+	_, ok := m.clearedFields[bronzek8snode.FieldAPICreatedAt]
+	return ok
+}
+
+// ResetAPICreatedAt resets all changes to the "api_created_at" field.
+func (m *BronzeK8sNodeMutation) ResetAPICreatedAt() {
+	m.api_created_at = nil
+	delete(m.clearedFields, bronzek8snode.FieldAPICreatedAt)
+}
+
+// Where appends a list predicates to the BronzeK8sNodeMutation builder.
+func (m *BronzeK8sNodeMutation) Where(ps ...predicate.BronzeK8sNode) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeK8sNodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeK8sNodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeK8sNode, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeK8sNodeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeK8sNodeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeK8sNode).
+func (m *BronzeK8sNodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeK8sNodeMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.collected_at != nil {
+		fields = append(fields, bronzek8snode.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzek8snode.FieldFirstCollectedAt)
+	}
+	if m.cluster_name != nil {
+		fields = append(fields, bronzek8snode.FieldClusterName)
+	}
+	if m.name != nil {
+		fields = append(fields, bronzek8snode.FieldName)
+	}
+	if m.uid != nil {
+		fields = append(fields, bronzek8snode.FieldUID)
+	}
+	if m.provider_id != nil {
+		fields = append(fields, bronzek8snode.FieldProviderID)
+	}
+	if m.internal_ip != nil {
+		fields = append(fields, bronzek8snode.FieldInternalIP)
+	}
+	if m.external_ip != nil {
+		fields = append(fields, bronzek8snode.FieldExternalIP)
+	}
+	if m.node_pool != nil {
+		fields = append(fields, bronzek8snode.FieldNodePool)
+	}
+	if m.instance_type != nil {
+		fields = append(fields, bronzek8snode.FieldInstanceType)
+	}
+	if m.region != nil {
+		fields = append(fields, bronzek8snode.FieldRegion)
+	}
+	if m.zone != nil {
+		fields = append(fields, bronzek8snode.FieldZone)
+	}
+	if m.kubelet_version != nil {
+		fields = append(fields, bronzek8snode.FieldKubeletVersion)
+	}
+	if m.os_image != nil {
+		fields = append(fields, bronzek8snode.FieldOsImage)
+	}
+	if m.kernel_version != nil {
+		fields = append(fields, bronzek8snode.FieldKernelVersion)
+	}
+	if m.container_runtime != nil {
+		fields = append(fields, bronzek8snode.FieldContainerRuntime)
+	}
+	if m.architecture != nil {
+		fields = append(fields, bronzek8snode.FieldArchitecture)
+	}
+	if m.ready != nil {
+		fields = append(fields, bronzek8snode.FieldReady)
+	}
+	if m.unschedulable != nil {
+		fields = append(fields, bronzek8snode.FieldUnschedulable)
+	}
+	if m.labels_json != nil {
+		fields = append(fields, bronzek8snode.FieldLabelsJSON)
+	}
+	if m.taints_json != nil {
+		fields = append(fields, bronzek8snode.FieldTaintsJSON)
+	}
+	if m.api_created_at != nil {
+		fields = append(fields, bronzek8snode.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeK8sNodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzek8snode.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzek8snode.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzek8snode.FieldClusterName:
+		return m.ClusterName()
+	case bronzek8snode.FieldName:
+		return m.Name()
+	case bronzek8snode.FieldUID:
+		return m.UID()
+	case bronzek8snode.FieldProviderID:
+		return m.ProviderID()
+	case bronzek8snode.FieldInternalIP:
+		return m.InternalIP()
+	case bronzek8snode.FieldExternalIP:
+		return m.ExternalIP()
+	case bronzek8snode.FieldNodePool:
+		return m.NodePool()
+	case bronzek8snode.FieldInstanceType:
+		return m.InstanceType()
+	case bronzek8snode.FieldRegion:
+		return m.Region()
+	case bronzek8snode.FieldZone:
+		return m.Zone()
+	case bronzek8snode.FieldKubeletVersion:
+		return m.KubeletVersion()
+	case bronzek8snode.FieldOsImage:
+		return m.OsImage()
+	case bronzek8snode.FieldKernelVersion:
+		return m.KernelVersion()
+	case bronzek8snode.FieldContainerRuntime:
+		return m.ContainerRuntime()
+	case bronzek8snode.FieldArchitecture:
+		return m.Architecture()
+	case bronzek8snode.FieldReady:
+		return m.Ready()
+	case bronzek8snode.FieldUnschedulable:
+		return m.Unschedulable()
+	case bronzek8snode.FieldLabelsJSON:
+		return m.LabelsJSON()
+	case bronzek8snode.FieldTaintsJSON:
+		return m.TaintsJSON()
+	case bronzek8snode.FieldAPICreatedAt:
+		return m.APICreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeK8sNodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzek8snode.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzek8snode.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzek8snode.FieldClusterName:
+		return m.OldClusterName(ctx)
+	case bronzek8snode.FieldName:
+		return m.OldName(ctx)
+	case bronzek8snode.FieldUID:
+		return m.OldUID(ctx)
+	case bronzek8snode.FieldProviderID:
+		return m.OldProviderID(ctx)
+	case bronzek8snode.FieldInternalIP:
+		return m.OldInternalIP(ctx)
+	case bronzek8snode.FieldExternalIP:
+		return m.OldExternalIP(ctx)
+	case bronzek8snode.FieldNodePool:
+		return m.OldNodePool(ctx)
+	case bronzek8snode.FieldInstanceType:
+		return m.OldInstanceType(ctx)
+	case bronzek8snode.FieldRegion:
+		return m.OldRegion(ctx)
+	case bronzek8snode.FieldZone:
+		return m.OldZone(ctx)
+	case bronzek8snode.FieldKubeletVersion:
+		return m.OldKubeletVersion(ctx)
+	case bronzek8snode.FieldOsImage:
+		return m.OldOsImage(ctx)
+	case bronzek8snode.FieldKernelVersion:
+		return m.OldKernelVersion(ctx)
+	case bronzek8snode.FieldContainerRuntime:
+		return m.OldContainerRuntime(ctx)
+	case bronzek8snode.FieldArchitecture:
+		return m.OldArchitecture(ctx)
+	case bronzek8snode.FieldReady:
+		return m.OldReady(ctx)
+	case bronzek8snode.FieldUnschedulable:
+		return m.OldUnschedulable(ctx)
+	case bronzek8snode.FieldLabelsJSON:
+		return m.OldLabelsJSON(ctx)
+	case bronzek8snode.FieldTaintsJSON:
+		return m.OldTaintsJSON(ctx)
+	case bronzek8snode.FieldAPICreatedAt:
+		return m.OldAPICreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeK8sNode field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeK8sNodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzek8snode.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzek8snode.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzek8snode.FieldClusterName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterName(v)
+		return nil
+	case bronzek8snode.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case bronzek8snode.FieldUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUID(v)
+		return nil
+	case bronzek8snode.FieldProviderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProviderID(v)
+		return nil
+	case bronzek8snode.FieldInternalIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInternalIP(v)
+		return nil
+	case bronzek8snode.FieldExternalIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalIP(v)
+		return nil
+	case bronzek8snode.FieldNodePool:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodePool(v)
+		return nil
+	case bronzek8snode.FieldInstanceType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstanceType(v)
+		return nil
+	case bronzek8snode.FieldRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegion(v)
+		return nil
+	case bronzek8snode.FieldZone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetZone(v)
+		return nil
+	case bronzek8snode.FieldKubeletVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKubeletVersion(v)
+		return nil
+	case bronzek8snode.FieldOsImage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOsImage(v)
+		return nil
+	case bronzek8snode.FieldKernelVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKernelVersion(v)
+		return nil
+	case bronzek8snode.FieldContainerRuntime:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContainerRuntime(v)
+		return nil
+	case bronzek8snode.FieldArchitecture:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchitecture(v)
+		return nil
+	case bronzek8snode.FieldReady:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReady(v)
+		return nil
+	case bronzek8snode.FieldUnschedulable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnschedulable(v)
+		return nil
+	case bronzek8snode.FieldLabelsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabelsJSON(v)
+		return nil
+	case bronzek8snode.FieldTaintsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaintsJSON(v)
+		return nil
+	case bronzek8snode.FieldAPICreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPICreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sNode field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeK8sNodeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeK8sNodeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeK8sNodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeK8sNode numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeK8sNodeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzek8snode.FieldUID) {
+		fields = append(fields, bronzek8snode.FieldUID)
+	}
+	if m.FieldCleared(bronzek8snode.FieldProviderID) {
+		fields = append(fields, bronzek8snode.FieldProviderID)
+	}
+	if m.FieldCleared(bronzek8snode.FieldInternalIP) {
+		fields = append(fields, bronzek8snode.FieldInternalIP)
+	}
+	if m.FieldCleared(bronzek8snode.FieldExternalIP) {
+		fields = append(fields, bronzek8snode.FieldExternalIP)
+	}
+	if m.FieldCleared(bronzek8snode.FieldNodePool) {
+		fields = append(fields, bronzek8snode.FieldNodePool)
+	}
+	if m.FieldCleared(bronzek8snode.FieldInstanceType) {
+		fields = append(fields, bronzek8snode.FieldInstanceType)
+	}
+	if m.FieldCleared(bronzek8snode.FieldRegion) {
+		fields = append(fields, bronzek8snode.FieldRegion)
+	}
+	if m.FieldCleared(bronzek8snode.FieldZone) {
+		fields = append(fields, bronzek8snode.FieldZone)
+	}
+	if m.FieldCleared(bronzek8snode.FieldKubeletVersion) {
+		fields = append(fields, bronzek8snode.FieldKubeletVersion)
+	}
+	if m.FieldCleared(bronzek8snode.FieldOsImage) {
+		fields = append(fields, bronzek8snode.FieldOsImage)
+	}
+	if m.FieldCleared(bronzek8snode.FieldKernelVersion) {
+		fields = append(fields, bronzek8snode.FieldKernelVersion)
+	}
+	if m.FieldCleared(bronzek8snode.FieldContainerRuntime) {
+		fields = append(fields, bronzek8snode.FieldContainerRuntime)
+	}
+	if m.FieldCleared(bronzek8snode.FieldArchitecture) {
+		fields = append(fields, bronzek8snode.FieldArchitecture)
+	}
+	if m.FieldCleared(bronzek8snode.FieldLabelsJSON) {
+		fields = append(fields, bronzek8snode.FieldLabelsJSON)
+	}
+	if m.FieldCleared(bronzek8snode.FieldTaintsJSON) {
+		fields = append(fields, bronzek8snode.FieldTaintsJSON)
+	}
+	if m.FieldCleared(bronzek8snode.FieldAPICreatedAt) {
+		fields = append(fields, bronzek8snode.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeK8sNodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeK8sNodeMutation) ClearField(name string) error {
+	switch name {
+	case bronzek8snode.FieldUID:
+		m.ClearUID()
+		return nil
+	case bronzek8snode.FieldProviderID:
+		m.ClearProviderID()
+		return nil
+	case bronzek8snode.FieldInternalIP:
+		m.ClearInternalIP()
+		return nil
+	case bronzek8snode.FieldExternalIP:
+		m.ClearExternalIP()
+		return nil
+	case bronzek8snode.FieldNodePool:
+		m.ClearNodePool()
+		return nil
+	case bronzek8snode.FieldInstanceType:
+		m.ClearInstanceType()
+		return nil
+	case bronzek8snode.FieldRegion:
+		m.ClearRegion()
+		return nil
+	case bronzek8snode.FieldZone:
+		m.ClearZone()
+		return nil
+	case bronzek8snode.FieldKubeletVersion:
+		m.ClearKubeletVersion()
+		return nil
+	case bronzek8snode.FieldOsImage:
+		m.ClearOsImage()
+		return nil
+	case bronzek8snode.FieldKernelVersion:
+		m.ClearKernelVersion()
+		return nil
+	case bronzek8snode.FieldContainerRuntime:
+		m.ClearContainerRuntime()
+		return nil
+	case bronzek8snode.FieldArchitecture:
+		m.ClearArchitecture()
+		return nil
+	case bronzek8snode.FieldLabelsJSON:
+		m.ClearLabelsJSON()
+		return nil
+	case bronzek8snode.FieldTaintsJSON:
+		m.ClearTaintsJSON()
+		return nil
+	case bronzek8snode.FieldAPICreatedAt:
+		m.ClearAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sNode nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeK8sNodeMutation) ResetField(name string) error {
+	switch name {
+	case bronzek8snode.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzek8snode.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzek8snode.FieldClusterName:
+		m.ResetClusterName()
+		return nil
+	case bronzek8snode.FieldName:
+		m.ResetName()
+		return nil
+	case bronzek8snode.FieldUID:
+		m.ResetUID()
+		return nil
+	case bronzek8snode.FieldProviderID:
+		m.ResetProviderID()
+		return nil
+	case bronzek8snode.FieldInternalIP:
+		m.ResetInternalIP()
+		return nil
+	case bronzek8snode.FieldExternalIP:
+		m.ResetExternalIP()
+		return nil
+	case bronzek8snode.FieldNodePool:
+		m.ResetNodePool()
+		return nil
+	case bronzek8snode.FieldInstanceType:
+		m.ResetInstanceType()
+		return nil
+	case bronzek8snode.FieldRegion:
+		m.ResetRegion()
+		return nil
+	case bronzek8snode.FieldZone:
+		m.ResetZone()
+		return nil
+	case bronzek8snode.FieldKubeletVersion:
+		m.ResetKubeletVersion()
+		return nil
+	case bronzek8snode.FieldOsImage:
+		m.ResetOsImage()
+		return nil
+	case bronzek8snode.FieldKernelVersion:
+		m.ResetKernelVersion()
+		return nil
+	case bronzek8snode.FieldContainerRuntime:
+		m.ResetContainerRuntime()
+		return nil
+	case bronzek8snode.FieldArchitecture:
+		m.ResetArchitecture()
+		return nil
+	case bronzek8snode.FieldReady:
+		m.ResetReady()
+		return nil
+	case bronzek8snode.FieldUnschedulable:
+		m.ResetUnschedulable()
+		return nil
+	case bronzek8snode.FieldLabelsJSON:
+		m.ResetLabelsJSON()
+		return nil
+	case bronzek8snode.FieldTaintsJSON:
+		m.ResetTaintsJSON()
+		return nil
+	case bronzek8snode.FieldAPICreatedAt:
+		m.ResetAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sNode field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeK8sNodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeK8sNodeMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeK8sNodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeK8sNodeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeK8sNodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeK8sNodeMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeK8sNodeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeK8sNode unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeK8sNodeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeK8sNode edge %s", name)
+}
+
+// BronzeK8sPodMutation represents an operation that mutates the BronzeK8sPod nodes in the graph.
+type BronzeK8sPodMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *string
+	collected_at          *time.Time
+	first_collected_at    *time.Time
+	cluster_name          *string
+	namespace             *string
+	name                  *string
+	uid                   *string
+	phase                 *string
+	node_name             *string
+	pod_ip                *string
+	host_ip               *string
+	service_account       *string
+	workload_kind         *string
+	workload_name         *string
+	host_network          *bool
+	containers_json       *json.RawMessage
+	appendcontainers_json json.RawMessage
+	labels_json           *json.RawMessage
+	appendlabels_json     json.RawMessage
+	api_created_at        *time.Time
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*BronzeK8sPod, error)
+	predicates            []predicate.BronzeK8sPod
+}
+
+var _ ent.Mutation = (*BronzeK8sPodMutation)(nil)
+
+// bronzek8spodOption allows management of the mutation configuration using functional options.
+type bronzek8spodOption func(*BronzeK8sPodMutation)
+
+// newBronzeK8sPodMutation creates new mutation for the BronzeK8sPod entity.
+func newBronzeK8sPodMutation(c config, op Op, opts ...bronzek8spodOption) *BronzeK8sPodMutation {
+	m := &BronzeK8sPodMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeK8sPod,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeK8sPodID sets the ID field of the mutation.
+func withBronzeK8sPodID(id string) bronzek8spodOption {
+	return func(m *BronzeK8sPodMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeK8sPod
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeK8sPod, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeK8sPod.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeK8sPod sets the old BronzeK8sPod of the mutation.
+func withBronzeK8sPod(node *BronzeK8sPod) bronzek8spodOption {
+	return func(m *BronzeK8sPodMutation) {
+		m.oldValue = func(context.Context) (*BronzeK8sPod, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeK8sPodMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeK8sPodMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("kubernetes: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeK8sPod entities.
+func (m *BronzeK8sPodMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeK8sPodMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeK8sPodMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeK8sPod.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeK8sPodMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeK8sPodMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeK8sPod entity.
+// If the BronzeK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sPodMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeK8sPodMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeK8sPodMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeK8sPodMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeK8sPod entity.
+// If the BronzeK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sPodMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeK8sPodMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (m *BronzeK8sPodMutation) SetClusterName(s string) {
+	m.cluster_name = &s
+}
+
+// ClusterName returns the value of the "cluster_name" field in the mutation.
+func (m *BronzeK8sPodMutation) ClusterName() (r string, exists bool) {
+	v := m.cluster_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClusterName returns the old "cluster_name" field's value of the BronzeK8sPod entity.
+// If the BronzeK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sPodMutation) OldClusterName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterName: %w", err)
+	}
+	return oldValue.ClusterName, nil
+}
+
+// ResetClusterName resets all changes to the "cluster_name" field.
+func (m *BronzeK8sPodMutation) ResetClusterName() {
+	m.cluster_name = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *BronzeK8sPodMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *BronzeK8sPodMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the BronzeK8sPod entity.
+// If the BronzeK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sPodMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *BronzeK8sPodMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetName sets the "name" field.
+func (m *BronzeK8sPodMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BronzeK8sPodMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the BronzeK8sPod entity.
+// If the BronzeK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sPodMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *BronzeK8sPodMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUID sets the "uid" field.
+func (m *BronzeK8sPodMutation) SetUID(s string) {
+	m.uid = &s
+}
+
+// UID returns the value of the "uid" field in the mutation.
+func (m *BronzeK8sPodMutation) UID() (r string, exists bool) {
+	v := m.uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUID returns the old "uid" field's value of the BronzeK8sPod entity.
+// If the BronzeK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sPodMutation) OldUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+	}
+	return oldValue.UID, nil
+}
+
+// ClearUID clears the value of the "uid" field.
+func (m *BronzeK8sPodMutation) ClearUID() {
+	m.uid = nil
+	m.clearedFields[bronzek8spod.FieldUID] = struct{}{}
+}
+
+// UIDCleared returns if the "uid" field was cleared in this mutation.
+func (m *BronzeK8sPodMutation) UIDCleared() bool {
+	_, ok := m.clearedFields[bronzek8spod.FieldUID]
+	return ok
+}
+
+// ResetUID resets all changes to the "uid" field.
+func (m *BronzeK8sPodMutation) ResetUID() {
+	m.uid = nil
+	delete(m.clearedFields, bronzek8spod.FieldUID)
+}
+
+// SetPhase sets the "phase" field.
+func (m *BronzeK8sPodMutation) SetPhase(s string) {
+	m.phase = &s
+}
+
+// Phase returns the value of the "phase" field in the mutation.
+func (m *BronzeK8sPodMutation) Phase() (r string, exists bool) {
+	v := m.phase
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhase returns the old "phase" field's value of the BronzeK8sPod entity.
+// If the BronzeK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sPodMutation) OldPhase(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhase: %w", err)
+	}
+	return oldValue.Phase, nil
+}
+
+// ClearPhase clears the value of the "phase" field.
+func (m *BronzeK8sPodMutation) ClearPhase() {
+	m.phase = nil
+	m.clearedFields[bronzek8spod.FieldPhase] = struct{}{}
+}
+
+// PhaseCleared returns if the "phase" field was cleared in this mutation.
+func (m *BronzeK8sPodMutation) PhaseCleared() bool {
+	_, ok := m.clearedFields[bronzek8spod.FieldPhase]
+	return ok
+}
+
+// ResetPhase resets all changes to the "phase" field.
+func (m *BronzeK8sPodMutation) ResetPhase() {
+	m.phase = nil
+	delete(m.clearedFields, bronzek8spod.FieldPhase)
+}
+
+// SetNodeName sets the "node_name" field.
+func (m *BronzeK8sPodMutation) SetNodeName(s string) {
+	m.node_name = &s
+}
+
+// NodeName returns the value of the "node_name" field in the mutation.
+func (m *BronzeK8sPodMutation) NodeName() (r string, exists bool) {
+	v := m.node_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNodeName returns the old "node_name" field's value of the BronzeK8sPod entity.
+// If the BronzeK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sPodMutation) OldNodeName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNodeName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNodeName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNodeName: %w", err)
+	}
+	return oldValue.NodeName, nil
+}
+
+// ClearNodeName clears the value of the "node_name" field.
+func (m *BronzeK8sPodMutation) ClearNodeName() {
+	m.node_name = nil
+	m.clearedFields[bronzek8spod.FieldNodeName] = struct{}{}
+}
+
+// NodeNameCleared returns if the "node_name" field was cleared in this mutation.
+func (m *BronzeK8sPodMutation) NodeNameCleared() bool {
+	_, ok := m.clearedFields[bronzek8spod.FieldNodeName]
+	return ok
+}
+
+// ResetNodeName resets all changes to the "node_name" field.
+func (m *BronzeK8sPodMutation) ResetNodeName() {
+	m.node_name = nil
+	delete(m.clearedFields, bronzek8spod.FieldNodeName)
+}
+
+// SetPodIP sets the "pod_ip" field.
+func (m *BronzeK8sPodMutation) SetPodIP(s string) {
+	m.pod_ip = &s
+}
+
+// PodIP returns the value of the "pod_ip" field in the mutation.
+func (m *BronzeK8sPodMutation) PodIP() (r string, exists bool) {
+	v := m.pod_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPodIP returns the old "pod_ip" field's value of the BronzeK8sPod entity.
+// If the BronzeK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sPodMutation) OldPodIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPodIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPodIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPodIP: %w", err)
+	}
+	return oldValue.PodIP, nil
+}
+
+// ClearPodIP clears the value of the "pod_ip" field.
+func (m *BronzeK8sPodMutation) ClearPodIP() {
+	m.pod_ip = nil
+	m.clearedFields[bronzek8spod.FieldPodIP] = struct{}{}
+}
+
+// PodIPCleared returns if the "pod_ip" field was cleared in this mutation.
+func (m *BronzeK8sPodMutation) PodIPCleared() bool {
+	_, ok := m.clearedFields[bronzek8spod.FieldPodIP]
+	return ok
+}
+
+// ResetPodIP resets all changes to the "pod_ip" field.
+func (m *BronzeK8sPodMutation) ResetPodIP() {
+	m.pod_ip = nil
+	delete(m.clearedFields, bronzek8spod.FieldPodIP)
+}
+
+// SetHostIP sets the "host_ip" field.
+func (m *BronzeK8sPodMutation) SetHostIP(s string) {
+	m.host_ip = &s
+}
+
+// HostIP returns the value of the "host_ip" field in the mutation.
+func (m *BronzeK8sPodMutation) HostIP() (r string, exists bool) {
+	v := m.host_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostIP returns the old "host_ip" field's value of the BronzeK8sPod entity.
+// If the BronzeK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sPodMutation) OldHostIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostIP: %w", err)
+	}
+	return oldValue.HostIP, nil
+}
+
+// ClearHostIP clears the value of the "host_ip" field.
+func (m *BronzeK8sPodMutation) ClearHostIP() {
+	m.host_ip = nil
+	m.clearedFields[bronzek8spod.FieldHostIP] = struct{}{}
+}
+
+// HostIPCleared returns if the "host_ip" field was cleared in this mutation.
+func (m *BronzeK8sPodMutation) HostIPCleared() bool {
+	_, ok := m.clearedFields[bronzek8spod.FieldHostIP]
+	return ok
+}
+
+// ResetHostIP resets all changes to the "host_ip" field.
+func (m *BronzeK8sPodMutation) ResetHostIP() {
+	m.host_ip = nil
+	delete(m.clearedFields, bronzek8spod.FieldHostIP)
+}
+
+// SetServiceAccount sets the "service_account" field.
+func (m *BronzeK8sPodMutation) SetServiceAccount(s string) {
+	m.service_account = &s
+}
+
+// ServiceAccount returns the value of the "service_account" field in the mutation.
+func (m *BronzeK8sPodMutation) ServiceAccount() (r string, exists bool) {
+	v := m.service_account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServiceAccount returns the old "service_account" field's value of the BronzeK8sPod entity.
+// If the BronzeK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sPodMutation) OldServiceAccount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServiceAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServiceAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServiceAccount: %w", err)
+	}
+	return oldValue.ServiceAccount, nil
+}
+
+// ClearServiceAccount clears the value of the "service_account" field.
+func (m *BronzeK8sPodMutation) ClearServiceAccount() {
+	m.service_account = nil
+	m.clearedFields[bronzek8spod.FieldServiceAccount] = struct{}{}
+}
+
+// ServiceAccountCleared returns if the "service_account" field was cleared in this mutation.
+func (m *BronzeK8sPodMutation) ServiceAccountCleared() bool {
+	_, ok := m.clearedFields[bronzek8spod.FieldServiceAccount]
+	return ok
+}
+
+// ResetServiceAccount resets all changes to the "service_account" field.
+func (m *BronzeK8sPodMutation) ResetServiceAccount() {
+	m.service_account = nil
+	delete(m.clearedFields, bronzek8spod.FieldServiceAccount)
+}
+
+// SetWorkloadKind sets the "workload_kind" field.
+func (m *BronzeK8sPodMutation) SetWorkloadKind(s string) {
+	m.workload_kind = &s
+}
+
+// WorkloadKind returns the value of the "workload_kind" field in the mutation.
+func (m *BronzeK8sPodMutation) WorkloadKind() (r string, exists bool) {
+	v := m.workload_kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkloadKind returns the old "workload_kind" field's value of the BronzeK8sPod entity.
+// If the BronzeK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sPodMutation) OldWorkloadKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkloadKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkloadKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkloadKind: %w", err)
+	}
+	return oldValue.WorkloadKind, nil
+}
+
+// ClearWorkloadKind clears the value of the "workload_kind" field.
+func (m *BronzeK8sPodMutation) ClearWorkloadKind() {
+	m.workload_kind = nil
+	m.clearedFields[bronzek8spod.FieldWorkloadKind] = struct{}{}
+}
+
+// WorkloadKindCleared returns if the "workload_kind" field was cleared in this mutation.
+func (m *BronzeK8sPodMutation) WorkloadKindCleared() bool {
+	_, ok := m.clearedFields[bronzek8spod.FieldWorkloadKind]
+	return ok
+}
+
+// ResetWorkloadKind resets all changes to the "workload_kind" field.
+func (m *BronzeK8sPodMutation) ResetWorkloadKind() {
+	m.workload_kind = nil
+	delete(m.clearedFields, bronzek8spod.FieldWorkloadKind)
+}
+
+// SetWorkloadName sets the "workload_name" field.
+func (m *BronzeK8sPodMutation) SetWorkloadName(s string) {
+	m.workload_name = &s
+}
+
+// WorkloadName returns the value of the "workload_name" field in the mutation.
+func (m *BronzeK8sPodMutation) WorkloadName() (r string, exists bool) {
+	v := m.workload_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkloadName returns the old "workload_name" field's value of the BronzeK8sPod entity.
+// If the BronzeK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sPodMutation) OldWorkloadName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkloadName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkloadName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkloadName: %w", err)
+	}
+	return oldValue.WorkloadName, nil
+}
+
+// ClearWorkloadName clears the value of the "workload_name" field.
+func (m *BronzeK8sPodMutation) ClearWorkloadName() {
+	m.workload_name = nil
+	m.clearedFields[bronzek8spod.FieldWorkloadName] = struct{}{}
+}
+
+// WorkloadNameCleared returns if the "workload_name" field was cleared in this mutation.
+func (m *BronzeK8sPodMutation) WorkloadNameCleared() bool {
+	_, ok := m.clearedFields[bronzek8spod.FieldWorkloadName]
+	return ok
+}
+
+// ResetWorkloadName resets all changes to the "workload_name" field.
+func (m *BronzeK8sPodMutation) ResetWorkloadName() {
+	m.workload_name = nil
+	delete(m.clearedFields, bronzek8spod.FieldWorkloadName)
+}
+
+// SetHostNetwork sets the "host_network" field.
+func (m *BronzeK8sPodMutation) SetHostNetwork(b bool) {
+	m.host_network = &b
+}
+
+// HostNetwork returns the value of the "host_network" field in the mutation.
+func (m *BronzeK8sPodMutation) HostNetwork() (r bool, exists bool) {
+	v := m.host_network
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostNetwork returns the old "host_network" field's value of the BronzeK8sPod entity.
+// If the BronzeK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sPodMutation) OldHostNetwork(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostNetwork is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostNetwork requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostNetwork: %w", err)
+	}
+	return oldValue.HostNetwork, nil
+}
+
+// ResetHostNetwork resets all changes to the "host_network" field.
+func (m *BronzeK8sPodMutation) ResetHostNetwork() {
+	m.host_network = nil
+}
+
+// SetContainersJSON sets the "containers_json" field.
+func (m *BronzeK8sPodMutation) SetContainersJSON(jm json.RawMessage) {
+	m.containers_json = &jm
+	m.appendcontainers_json = nil
+}
+
+// ContainersJSON returns the value of the "containers_json" field in the mutation.
+func (m *BronzeK8sPodMutation) ContainersJSON() (r json.RawMessage, exists bool) {
+	v := m.containers_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContainersJSON returns the old "containers_json" field's value of the BronzeK8sPod entity.
+// If the BronzeK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sPodMutation) OldContainersJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContainersJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContainersJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContainersJSON: %w", err)
+	}
+	return oldValue.ContainersJSON, nil
+}
+
+// AppendContainersJSON adds jm to the "containers_json" field.
+func (m *BronzeK8sPodMutation) AppendContainersJSON(jm json.RawMessage) {
+	m.appendcontainers_json = append(m.appendcontainers_json, jm...)
+}
+
+// AppendedContainersJSON returns the list of values that were appended to the "containers_json" field in this mutation.
+func (m *BronzeK8sPodMutation) AppendedContainersJSON() (json.RawMessage, bool) {
+	if len(m.appendcontainers_json) == 0 {
+		return nil, false
+	}
+	return m.appendcontainers_json, true
+}
+
+// ClearContainersJSON clears the value of the "containers_json" field.
+func (m *BronzeK8sPodMutation) ClearContainersJSON() {
+	m.containers_json = nil
+	m.appendcontainers_json = nil
+	m.clearedFields[bronzek8spod.FieldContainersJSON] = struct{}{}
+}
+
+// ContainersJSONCleared returns if the "containers_json" field was cleared in this mutation.
+func (m *BronzeK8sPodMutation) ContainersJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8spod.FieldContainersJSON]
+	return ok
+}
+
+// ResetContainersJSON resets all changes to the "containers_json" field.
+func (m *BronzeK8sPodMutation) ResetContainersJSON() {
+	m.containers_json = nil
+	m.appendcontainers_json = nil
+	delete(m.clearedFields, bronzek8spod.FieldContainersJSON)
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (m *BronzeK8sPodMutation) SetLabelsJSON(jm json.RawMessage) {
+	m.labels_json = &jm
+	m.appendlabels_json = nil
+}
+
+// LabelsJSON returns the value of the "labels_json" field in the mutation.
+func (m *BronzeK8sPodMutation) LabelsJSON() (r json.RawMessage, exists bool) {
+	v := m.labels_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabelsJSON returns the old "labels_json" field's value of the BronzeK8sPod entity.
+// If the BronzeK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sPodMutation) OldLabelsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabelsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabelsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabelsJSON: %w", err)
+	}
+	return oldValue.LabelsJSON, nil
+}
+
+// AppendLabelsJSON adds jm to the "labels_json" field.
+func (m *BronzeK8sPodMutation) AppendLabelsJSON(jm json.RawMessage) {
+	m.appendlabels_json = append(m.appendlabels_json, jm...)
+}
+
+// AppendedLabelsJSON returns the list of values that were appended to the "labels_json" field in this mutation.
+func (m *BronzeK8sPodMutation) AppendedLabelsJSON() (json.RawMessage, bool) {
+	if len(m.appendlabels_json) == 0 {
+		return nil, false
+	}
+	return m.appendlabels_json, true
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (m *BronzeK8sPodMutation) ClearLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	m.clearedFields[bronzek8spod.FieldLabelsJSON] = struct{}{}
+}
+
+// LabelsJSONCleared returns if the "labels_json" field was cleared in this mutation.
+func (m *BronzeK8sPodMutation) LabelsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8spod.FieldLabelsJSON]
+	return ok
+}
+
+// ResetLabelsJSON resets all changes to the "labels_json" field.
+func (m *BronzeK8sPodMutation) ResetLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	delete(m.clearedFields, bronzek8spod.FieldLabelsJSON)
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (m *BronzeK8sPodMutation) SetAPICreatedAt(t time.Time) {
+	m.api_created_at = &t
+}
+
+// APICreatedAt returns the value of the "api_created_at" field in the mutation.
+func (m *BronzeK8sPodMutation) APICreatedAt() (r time.Time, exists bool) {
+	v := m.api_created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPICreatedAt returns the old "api_created_at" field's value of the BronzeK8sPod entity.
+// If the BronzeK8sPod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sPodMutation) OldAPICreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPICreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPICreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPICreatedAt: %w", err)
+	}
+	return oldValue.APICreatedAt, nil
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (m *BronzeK8sPodMutation) ClearAPICreatedAt() {
+	m.api_created_at = nil
+	m.clearedFields[bronzek8spod.FieldAPICreatedAt] = struct{}{}
+}
+
+// APICreatedAtCleared returns if the "api_created_at" field was cleared in this mutation.
+func (m *BronzeK8sPodMutation) APICreatedAtCleared() bool {
+	_, ok := m.clearedFields[bronzek8spod.FieldAPICreatedAt]
+	return ok
+}
+
+// ResetAPICreatedAt resets all changes to the "api_created_at" field.
+func (m *BronzeK8sPodMutation) ResetAPICreatedAt() {
+	m.api_created_at = nil
+	delete(m.clearedFields, bronzek8spod.FieldAPICreatedAt)
+}
+
+// Where appends a list predicates to the BronzeK8sPodMutation builder.
+func (m *BronzeK8sPodMutation) Where(ps ...predicate.BronzeK8sPod) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeK8sPodMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeK8sPodMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeK8sPod, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeK8sPodMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeK8sPodMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeK8sPod).
+func (m *BronzeK8sPodMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeK8sPodMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.collected_at != nil {
+		fields = append(fields, bronzek8spod.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzek8spod.FieldFirstCollectedAt)
+	}
+	if m.cluster_name != nil {
+		fields = append(fields, bronzek8spod.FieldClusterName)
+	}
+	if m.namespace != nil {
+		fields = append(fields, bronzek8spod.FieldNamespace)
+	}
+	if m.name != nil {
+		fields = append(fields, bronzek8spod.FieldName)
+	}
+	if m.uid != nil {
+		fields = append(fields, bronzek8spod.FieldUID)
+	}
+	if m.phase != nil {
+		fields = append(fields, bronzek8spod.FieldPhase)
+	}
+	if m.node_name != nil {
+		fields = append(fields, bronzek8spod.FieldNodeName)
+	}
+	if m.pod_ip != nil {
+		fields = append(fields, bronzek8spod.FieldPodIP)
+	}
+	if m.host_ip != nil {
+		fields = append(fields, bronzek8spod.FieldHostIP)
+	}
+	if m.service_account != nil {
+		fields = append(fields, bronzek8spod.FieldServiceAccount)
+	}
+	if m.workload_kind != nil {
+		fields = append(fields, bronzek8spod.FieldWorkloadKind)
+	}
+	if m.workload_name != nil {
+		fields = append(fields, bronzek8spod.FieldWorkloadName)
+	}
+	if m.host_network != nil {
+		fields = append(fields, bronzek8spod.FieldHostNetwork)
+	}
+	if m.containers_json != nil {
+		fields = append(fields, bronzek8spod.FieldContainersJSON)
+	}
+	if m.labels_json != nil {
+		fields = append(fields, bronzek8spod.FieldLabelsJSON)
+	}
+	if m.api_created_at != nil {
+		fields = append(fields, bronzek8spod.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeK8sPodMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzek8spod.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzek8spod.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzek8spod.FieldClusterName:
+		return m.ClusterName()
+	case bronzek8spod.FieldNamespace:
+		return m.Namespace()
+	case bronzek8spod.FieldName:
+		return m.Name()
+	case bronzek8spod.FieldUID:
+		return m.UID()
+	case bronzek8spod.FieldPhase:
+		return m.Phase()
+	case bronzek8spod.FieldNodeName:
+		return m.NodeName()
+	case bronzek8spod.FieldPodIP:
+		return m.PodIP()
+	case bronzek8spod.FieldHostIP:
+		return m.HostIP()
+	case bronzek8spod.FieldServiceAccount:
+		return m.ServiceAccount()
+	case bronzek8spod.FieldWorkloadKind:
+		return m.WorkloadKind()
+	case bronzek8spod.FieldWorkloadName:
+		return m.WorkloadName()
+	case bronzek8spod.FieldHostNetwork:
+		return m.HostNetwork()
+	case bronzek8spod.FieldContainersJSON:
+		return m.ContainersJSON()
+	case bronzek8spod.FieldLabelsJSON:
+		return m.LabelsJSON()
+	case bronzek8spod.FieldAPICreatedAt:
+		return m.APICreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeK8sPodMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzek8spod.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzek8spod.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzek8spod.FieldClusterName:
+		return m.OldClusterName(ctx)
+	case bronzek8spod.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case bronzek8spod.FieldName:
+		return m.OldName(ctx)
+	case bronzek8spod.FieldUID:
+		return m.OldUID(ctx)
+	case bronzek8spod.FieldPhase:
+		return m.OldPhase(ctx)
+	case bronzek8spod.FieldNodeName:
+		return m.OldNodeName(ctx)
+	case bronzek8spod.FieldPodIP:
+		return m.OldPodIP(ctx)
+	case bronzek8spod.FieldHostIP:
+		return m.OldHostIP(ctx)
+	case bronzek8spod.FieldServiceAccount:
+		return m.OldServiceAccount(ctx)
+	case bronzek8spod.FieldWorkloadKind:
+		return m.OldWorkloadKind(ctx)
+	case bronzek8spod.FieldWorkloadName:
+		return m.OldWorkloadName(ctx)
+	case bronzek8spod.FieldHostNetwork:
+		return m.OldHostNetwork(ctx)
+	case bronzek8spod.FieldContainersJSON:
+		return m.OldContainersJSON(ctx)
+	case bronzek8spod.FieldLabelsJSON:
+		return m.OldLabelsJSON(ctx)
+	case bronzek8spod.FieldAPICreatedAt:
+		return m.OldAPICreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeK8sPod field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeK8sPodMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzek8spod.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzek8spod.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzek8spod.FieldClusterName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterName(v)
+		return nil
+	case bronzek8spod.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case bronzek8spod.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case bronzek8spod.FieldUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUID(v)
+		return nil
+	case bronzek8spod.FieldPhase:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhase(v)
+		return nil
+	case bronzek8spod.FieldNodeName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodeName(v)
+		return nil
+	case bronzek8spod.FieldPodIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPodIP(v)
+		return nil
+	case bronzek8spod.FieldHostIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostIP(v)
+		return nil
+	case bronzek8spod.FieldServiceAccount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServiceAccount(v)
+		return nil
+	case bronzek8spod.FieldWorkloadKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkloadKind(v)
+		return nil
+	case bronzek8spod.FieldWorkloadName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkloadName(v)
+		return nil
+	case bronzek8spod.FieldHostNetwork:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostNetwork(v)
+		return nil
+	case bronzek8spod.FieldContainersJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContainersJSON(v)
+		return nil
+	case bronzek8spod.FieldLabelsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabelsJSON(v)
+		return nil
+	case bronzek8spod.FieldAPICreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPICreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sPod field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeK8sPodMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeK8sPodMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeK8sPodMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeK8sPod numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeK8sPodMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzek8spod.FieldUID) {
+		fields = append(fields, bronzek8spod.FieldUID)
+	}
+	if m.FieldCleared(bronzek8spod.FieldPhase) {
+		fields = append(fields, bronzek8spod.FieldPhase)
+	}
+	if m.FieldCleared(bronzek8spod.FieldNodeName) {
+		fields = append(fields, bronzek8spod.FieldNodeName)
+	}
+	if m.FieldCleared(bronzek8spod.FieldPodIP) {
+		fields = append(fields, bronzek8spod.FieldPodIP)
+	}
+	if m.FieldCleared(bronzek8spod.FieldHostIP) {
+		fields = append(fields, bronzek8spod.FieldHostIP)
+	}
+	if m.FieldCleared(bronzek8spod.FieldServiceAccount) {
+		fields = append(fields, bronzek8spod.FieldServiceAccount)
+	}
+	if m.FieldCleared(bronzek8spod.FieldWorkloadKind) {
+		fields = append(fields, bronzek8spod.FieldWorkloadKind)
+	}
+	if m.FieldCleared(bronzek8spod.FieldWorkloadName) {
+		fields = append(fields, bronzek8spod.FieldWorkloadName)
+	}
+	if m.FieldCleared(bronzek8spod.FieldContainersJSON) {
+		fields = append(fields, bronzek8spod.FieldContainersJSON)
+	}
+	if m.FieldCleared(bronzek8spod.FieldLabelsJSON) {
+		fields = append(fields, bronzek8spod.FieldLabelsJSON)
+	}
+	if m.FieldCleared(bronzek8spod.FieldAPICreatedAt) {
+		fields = append(fields, bronzek8spod.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeK8sPodMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeK8sPodMutation) ClearField(name string) error {
+	switch name {
+	case bronzek8spod.FieldUID:
+		m.ClearUID()
+		return nil
+	case bronzek8spod.FieldPhase:
+		m.ClearPhase()
+		return nil
+	case bronzek8spod.FieldNodeName:
+		m.ClearNodeName()
+		return nil
+	case bronzek8spod.FieldPodIP:
+		m.ClearPodIP()
+		return nil
+	case bronzek8spod.FieldHostIP:
+		m.ClearHostIP()
+		return nil
+	case bronzek8spod.FieldServiceAccount:
+		m.ClearServiceAccount()
+		return nil
+	case bronzek8spod.FieldWorkloadKind:
+		m.ClearWorkloadKind()
+		return nil
+	case bronzek8spod.FieldWorkloadName:
+		m.ClearWorkloadName()
+		return nil
+	case bronzek8spod.FieldContainersJSON:
+		m.ClearContainersJSON()
+		return nil
+	case bronzek8spod.FieldLabelsJSON:
+		m.ClearLabelsJSON()
+		return nil
+	case bronzek8spod.FieldAPICreatedAt:
+		m.ClearAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sPod nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeK8sPodMutation) ResetField(name string) error {
+	switch name {
+	case bronzek8spod.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzek8spod.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzek8spod.FieldClusterName:
+		m.ResetClusterName()
+		return nil
+	case bronzek8spod.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case bronzek8spod.FieldName:
+		m.ResetName()
+		return nil
+	case bronzek8spod.FieldUID:
+		m.ResetUID()
+		return nil
+	case bronzek8spod.FieldPhase:
+		m.ResetPhase()
+		return nil
+	case bronzek8spod.FieldNodeName:
+		m.ResetNodeName()
+		return nil
+	case bronzek8spod.FieldPodIP:
+		m.ResetPodIP()
+		return nil
+	case bronzek8spod.FieldHostIP:
+		m.ResetHostIP()
+		return nil
+	case bronzek8spod.FieldServiceAccount:
+		m.ResetServiceAccount()
+		return nil
+	case bronzek8spod.FieldWorkloadKind:
+		m.ResetWorkloadKind()
+		return nil
+	case bronzek8spod.FieldWorkloadName:
+		m.ResetWorkloadName()
+		return nil
+	case bronzek8spod.FieldHostNetwork:
+		m.ResetHostNetwork()
+		return nil
+	case bronzek8spod.FieldContainersJSON:
+		m.ResetContainersJSON()
+		return nil
+	case bronzek8spod.FieldLabelsJSON:
+		m.ResetLabelsJSON()
+		return nil
+	case bronzek8spod.FieldAPICreatedAt:
+		m.ResetAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sPod field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeK8sPodMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeK8sPodMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeK8sPodMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeK8sPodMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeK8sPodMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeK8sPodMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeK8sPodMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeK8sPod unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeK8sPodMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeK8sPod edge %s", name)
+}
+
+// BronzeK8sRoleBindingMutation represents an operation that mutates the BronzeK8sRoleBinding nodes in the graph.
+type BronzeK8sRoleBindingMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *string
+	collected_at        *time.Time
+	first_collected_at  *time.Time
+	cluster_name        *string
+	kind                *string
+	namespace           *string
+	name                *string
+	uid                 *string
+	role_kind           *string
+	role_name           *string
+	subjects_json       *json.RawMessage
+	appendsubjects_json json.RawMessage
+	rules_json          *json.RawMessage
+	appendrules_json    json.RawMessage
+	labels_json         *json.RawMessage
+	appendlabels_json   json.RawMessage
+	api_created_at      *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*BronzeK8sRoleBinding, error)
+	predicates          []predicate.BronzeK8sRoleBinding
+}
+
+var _ ent.Mutation = (*BronzeK8sRoleBindingMutation)(nil)
+
+// bronzek8srolebindingOption allows management of the mutation configuration using functional options.
+type bronzek8srolebindingOption func(*BronzeK8sRoleBindingMutation)
+
+// newBronzeK8sRoleBindingMutation creates new mutation for the BronzeK8sRoleBinding entity.
+func newBronzeK8sRoleBindingMutation(c config, op Op, opts ...bronzek8srolebindingOption) *BronzeK8sRoleBindingMutation {
+	m := &BronzeK8sRoleBindingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeK8sRoleBinding,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeK8sRoleBindingID sets the ID field of the mutation.
+func withBronzeK8sRoleBindingID(id string) bronzek8srolebindingOption {
+	return func(m *BronzeK8sRoleBindingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeK8sRoleBinding
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeK8sRoleBinding, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeK8sRoleBinding.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeK8sRoleBinding sets the old BronzeK8sRoleBinding of the mutation.
+func withBronzeK8sRoleBinding(node *BronzeK8sRoleBinding) bronzek8srolebindingOption {
+	return func(m *BronzeK8sRoleBindingMutation) {
+		m.oldValue = func(context.Context) (*BronzeK8sRoleBinding, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeK8sRoleBindingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeK8sRoleBindingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("kubernetes: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeK8sRoleBinding entities.
+func (m *BronzeK8sRoleBindingMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeK8sRoleBindingMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeK8sRoleBindingMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeK8sRoleBinding.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeK8sRoleBindingMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeK8sRoleBindingMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeK8sRoleBinding entity.
+// If the BronzeK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sRoleBindingMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeK8sRoleBindingMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeK8sRoleBindingMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeK8sRoleBindingMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeK8sRoleBinding entity.
+// If the BronzeK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sRoleBindingMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeK8sRoleBindingMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (m *BronzeK8sRoleBindingMutation) SetClusterName(s string) {
+	m.cluster_name = &s
+}
+
+// ClusterName returns the value of the "cluster_name" field in the mutation.
+func (m *BronzeK8sRoleBindingMutation) ClusterName() (r string, exists bool) {
+	v := m.cluster_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClusterName returns the old "cluster_name" field's value of the BronzeK8sRoleBinding entity.
+// If the BronzeK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sRoleBindingMutation) OldClusterName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterName: %w", err)
+	}
+	return oldValue.ClusterName, nil
+}
+
+// ResetClusterName resets all changes to the "cluster_name" field.
+func (m *BronzeK8sRoleBindingMutation) ResetClusterName() {
+	m.cluster_name = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *BronzeK8sRoleBindingMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *BronzeK8sRoleBindingMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the BronzeK8sRoleBinding entity.
+// If the BronzeK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sRoleBindingMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *BronzeK8sRoleBindingMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *BronzeK8sRoleBindingMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *BronzeK8sRoleBindingMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the BronzeK8sRoleBinding entity.
+// If the BronzeK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sRoleBindingMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ClearNamespace clears the value of the "namespace" field.
+func (m *BronzeK8sRoleBindingMutation) ClearNamespace() {
+	m.namespace = nil
+	m.clearedFields[bronzek8srolebinding.FieldNamespace] = struct{}{}
+}
+
+// NamespaceCleared returns if the "namespace" field was cleared in this mutation.
+func (m *BronzeK8sRoleBindingMutation) NamespaceCleared() bool {
+	_, ok := m.clearedFields[bronzek8srolebinding.FieldNamespace]
+	return ok
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *BronzeK8sRoleBindingMutation) ResetNamespace() {
+	m.namespace = nil
+	delete(m.clearedFields, bronzek8srolebinding.FieldNamespace)
+}
+
+// SetName sets the "name" field.
+func (m *BronzeK8sRoleBindingMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BronzeK8sRoleBindingMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the BronzeK8sRoleBinding entity.
+// If the BronzeK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sRoleBindingMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *BronzeK8sRoleBindingMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUID sets the "uid" field.
+func (m *BronzeK8sRoleBindingMutation) SetUID(s string) {
+	m.uid = &s
+}
+
+// UID returns the value of the "uid" field in the mutation.
+func (m *BronzeK8sRoleBindingMutation) UID() (r string, exists bool) {
+	v := m.uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUID returns the old "uid" field's value of the BronzeK8sRoleBinding entity.
+// If the BronzeK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sRoleBindingMutation) OldUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+	}
+	return oldValue.UID, nil
+}
+
+// ClearUID clears the value of the "uid" field.
+func (m *BronzeK8sRoleBindingMutation) ClearUID() {
+	m.uid = nil
+	m.clearedFields[bronzek8srolebinding.FieldUID] = struct{}{}
+}
+
+// UIDCleared returns if the "uid" field was cleared in this mutation.
+func (m *BronzeK8sRoleBindingMutation) UIDCleared() bool {
+	_, ok := m.clearedFields[bronzek8srolebinding.FieldUID]
+	return ok
+}
+
+// ResetUID resets all changes to the "uid" field.
+func (m *BronzeK8sRoleBindingMutation) ResetUID() {
+	m.uid = nil
+	delete(m.clearedFields, bronzek8srolebinding.FieldUID)
+}
+
+// SetRoleKind sets the "role_kind" field.
+func (m *BronzeK8sRoleBindingMutation) SetRoleKind(s string) {
+	m.role_kind = &s
+}
+
+// RoleKind returns the value of the "role_kind" field in the mutation.
+func (m *BronzeK8sRoleBindingMutation) RoleKind() (r string, exists bool) {
+	v := m.role_kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleKind returns the old "role_kind" field's value of the BronzeK8sRoleBinding entity.
+// If the BronzeK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sRoleBindingMutation) OldRoleKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleKind: %w", err)
+	}
+	return oldValue.RoleKind, nil
+}
+
+// ResetRoleKind resets all changes to the "role_kind" field.
+func (m *BronzeK8sRoleBindingMutation) ResetRoleKind() {
+	m.role_kind = nil
+}
+
+// SetRoleName sets the "role_name" field.
+func (m *BronzeK8sRoleBindingMutation) SetRoleName(s string) {
+	m.role_name = &s
+}
+
+// RoleName returns the value of the "role_name" field in the mutation.
+func (m *BronzeK8sRoleBindingMutation) RoleName() (r string, exists bool) {
+	v := m.role_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleName returns the old "role_name" field's value of the BronzeK8sRoleBinding entity.
+// If the BronzeK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sRoleBindingMutation) OldRoleName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleName: %w", err)
+	}
+	return oldValue.RoleName, nil
+}
+
+// ResetRoleName resets all changes to the "role_name" field.
+func (m *BronzeK8sRoleBindingMutation) ResetRoleName() {
+	m.role_name = nil
+}
+
+// SetSubjectsJSON sets the "subjects_json" field.
+func (m *BronzeK8sRoleBindingMutation) SetSubjectsJSON(jm json.RawMessage) {
+	m.subjects_json = &jm
+	m.appendsubjects_json = nil
+}
+
+// SubjectsJSON returns the value of the "subjects_json" field in the mutation.
+func (m *BronzeK8sRoleBindingMutation) SubjectsJSON() (r json.RawMessage, exists bool) {
+	v := m.subjects_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubjectsJSON returns the old "subjects_json" field's value of the BronzeK8sRoleBinding entity.
+// If the BronzeK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sRoleBindingMutation) OldSubjectsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubjectsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubjectsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubjectsJSON: %w", err)
+	}
+	return oldValue.SubjectsJSON, nil
+}
+
+// AppendSubjectsJSON adds jm to the "subjects_json" field.
+func (m *BronzeK8sRoleBindingMutation) AppendSubjectsJSON(jm json.RawMessage) {
+	m.appendsubjects_json = append(m.appendsubjects_json, jm...)
+}
+
+// AppendedSubjectsJSON returns the list of values that were appended to the "subjects_json" field in this mutation.
+func (m *BronzeK8sRoleBindingMutation) AppendedSubjectsJSON() (json.RawMessage, bool) {
+	if len(m.appendsubjects_json) == 0 {
+		return nil, false
+	}
+	return m.appendsubjects_json, true
+}
+
+// ClearSubjectsJSON clears the value of the "subjects_json" field.
+func (m *BronzeK8sRoleBindingMutation) ClearSubjectsJSON() {
+	m.subjects_json = nil
+	m.appendsubjects_json = nil
+	m.clearedFields[bronzek8srolebinding.FieldSubjectsJSON] = struct{}{}
+}
+
+// SubjectsJSONCleared returns if the "subjects_json" field was cleared in this mutation.
+func (m *BronzeK8sRoleBindingMutation) SubjectsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8srolebinding.FieldSubjectsJSON]
+	return ok
+}
+
+// ResetSubjectsJSON resets all changes to the "subjects_json" field.
+func (m *BronzeK8sRoleBindingMutation) ResetSubjectsJSON() {
+	m.subjects_json = nil
+	m.appendsubjects_json = nil
+	delete(m.clearedFields, bronzek8srolebinding.FieldSubjectsJSON)
+}
+
+// SetRulesJSON sets the "rules_json" field.
+func (m *BronzeK8sRoleBindingMutation) SetRulesJSON(jm json.RawMessage) {
+	m.rules_json = &jm
+	m.appendrules_json = nil
+}
+
+// RulesJSON returns the value of the "rules_json" field in the mutation.
+func (m *BronzeK8sRoleBindingMutation) RulesJSON() (r json.RawMessage, exists bool) {
+	v := m.rules_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRulesJSON returns the old "rules_json" field's value of the BronzeK8sRoleBinding entity.
+// If the BronzeK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sRoleBindingMutation) OldRulesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRulesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRulesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRulesJSON: %w", err)
+	}
+	return oldValue.RulesJSON, nil
+}
+
+// AppendRulesJSON adds jm to the "rules_json" field.
+func (m *BronzeK8sRoleBindingMutation) AppendRulesJSON(jm json.RawMessage) {
+	m.appendrules_json = append(m.appendrules_json, jm...)
+}
+
+// AppendedRulesJSON returns the list of values that were appended to the "rules_json" field in this mutation.
+func (m *BronzeK8sRoleBindingMutation) AppendedRulesJSON() (json.RawMessage, bool) {
+	if len(m.appendrules_json) == 0 {
+		return nil, false
+	}
+	return m.appendrules_json, true
+}
+
+// ClearRulesJSON clears the value of the "rules_json" field.
+func (m *BronzeK8sRoleBindingMutation) ClearRulesJSON() {
+	m.rules_json = nil
+	m.appendrules_json = nil
+	m.clearedFields[bronzek8srolebinding.FieldRulesJSON] = struct{}{}
+}
+
+// RulesJSONCleared returns if the "rules_json" field was cleared in this mutation.
+func (m *BronzeK8sRoleBindingMutation) RulesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8srolebinding.FieldRulesJSON]
+	return ok
+}
+
+// ResetRulesJSON resets all changes to the "rules_json" field.
+func (m *BronzeK8sRoleBindingMutation) ResetRulesJSON() {
+	m.rules_json = nil
+	m.appendrules_json = nil
+	delete(m.clearedFields, bronzek8srolebinding.FieldRulesJSON)
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (m *BronzeK8sRoleBindingMutation) SetLabelsJSON(jm json.RawMessage) {
+	m.labels_json = &jm
+	m.appendlabels_json = nil
+}
+
+// LabelsJSON returns the value of the "labels_json" field in the mutation.
+func (m *BronzeK8sRoleBindingMutation) LabelsJSON() (r json.RawMessage, exists bool) {
+	v := m.labels_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabelsJSON returns the old "labels_json" field's value of the BronzeK8sRoleBinding entity.
+// If the BronzeK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sRoleBindingMutation) OldLabelsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabelsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabelsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabelsJSON: %w", err)
+	}
+	return oldValue.LabelsJSON, nil
+}
+
+// AppendLabelsJSON adds jm to the "labels_json" field.
+func (m *BronzeK8sRoleBindingMutation) AppendLabelsJSON(jm json.RawMessage) {
+	m.appendlabels_json = append(m.appendlabels_json, jm...)
+}
+
+// AppendedLabelsJSON returns the list of values that were appended to the "labels_json" field in this mutation.
+func (m *BronzeK8sRoleBindingMutation) AppendedLabelsJSON() (json.RawMessage, bool) {
+	if len(m.appendlabels_json) == 0 {
+		return nil, false
+	}
+	return m.appendlabels_json, true
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (m *BronzeK8sRoleBindingMutation) ClearLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	m.clearedFields[bronzek8srolebinding.FieldLabelsJSON] = struct{}{}
+}
+
+// LabelsJSONCleared returns if the "labels_json" field was cleared in this mutation.
+func (m *BronzeK8sRoleBindingMutation) LabelsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8srolebinding.FieldLabelsJSON]
+	return ok
+}
+
+// ResetLabelsJSON resets all changes to the "labels_json" field.
+func (m *BronzeK8sRoleBindingMutation) ResetLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	delete(m.clearedFields, bronzek8srolebinding.FieldLabelsJSON)
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (m *BronzeK8sRoleBindingMutation) SetAPICreatedAt(t time.Time) {
+	m.api_created_at = &t
+}
+
+// APICreatedAt returns the value of the "api_created_at" field in the mutation.
+func (m *BronzeK8sRoleBindingMutation) APICreatedAt() (r time.Time, exists bool) {
+	v := m.api_created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPICreatedAt returns the old "api_created_at" field's value of the BronzeK8sRoleBinding entity.
+// If the BronzeK8sRoleBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sRoleBindingMutation) OldAPICreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPICreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPICreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPICreatedAt: %w", err)
+	}
+	return oldValue.APICreatedAt, nil
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (m *BronzeK8sRoleBindingMutation) ClearAPICreatedAt() {
+	m.api_created_at = nil
+	m.clearedFields[bronzek8srolebinding.FieldAPICreatedAt] = struct{}{}
+}
+
+// APICreatedAtCleared returns if the "api_created_at" field was cleared in this mutation.
+func (m *BronzeK8sRoleBindingMutation) APICreatedAtCleared() bool {
+	_, ok := m.clearedFields[bronzek8srolebinding.FieldAPICreatedAt]
+	return ok
+}
+
+// ResetAPICreatedAt resets all changes to the "api_created_at" field.
+func (m *BronzeK8sRoleBindingMutation) ResetAPICreatedAt() {
+	m.api_created_at = nil
+	delete(m.clearedFields, bronzek8srolebinding.FieldAPICreatedAt)
+}
+
+// Where appends a list predicates to the BronzeK8sRoleBindingMutation builder.
+func (m *BronzeK8sRoleBindingMutation) Where(ps ...predicate.BronzeK8sRoleBinding) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeK8sRoleBindingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeK8sRoleBindingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeK8sRoleBinding, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeK8sRoleBindingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeK8sRoleBindingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeK8sRoleBinding).
+func (m *BronzeK8sRoleBindingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeK8sRoleBindingMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.collected_at != nil {
+		fields = append(fields, bronzek8srolebinding.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzek8srolebinding.FieldFirstCollectedAt)
+	}
+	if m.cluster_name != nil {
+		fields = append(fields, bronzek8srolebinding.FieldClusterName)
+	}
+	if m.kind != nil {
+		fields = append(fields, bronzek8srolebinding.FieldKind)
+	}
+	if m.namespace != nil {
+		fields = append(fields, bronzek8srolebinding.FieldNamespace)
+	}
+	if m.name != nil {
+		fields = append(fields, bronzek8srolebinding.FieldName)
+	}
+	if m.uid != nil {
+		fields = append(fields, bronzek8srolebinding.FieldUID)
+	}
+	if m.role_kind != nil {
+		fields = append(fields, bronzek8srolebinding.FieldRoleKind)
+	}
+	if m.role_name != nil {
+		fields = append(fields, bronzek8srolebinding.FieldRoleName)
+	}
+	if m.subjects_json != nil {
+		fields = append(fields, bronzek8srolebinding.FieldSubjectsJSON)
+	}
+	if m.rules_json != nil {
+		fields = append(fields, bronzek8srolebinding.FieldRulesJSON)
+	}
+	if m.labels_json != nil {
+		fields = append(fields, bronzek8srolebinding.FieldLabelsJSON)
+	}
+	if m.api_created_at != nil {
+		fields = append(fields, bronzek8srolebinding.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeK8sRoleBindingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzek8srolebinding.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzek8srolebinding.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzek8srolebinding.FieldClusterName:
+		return m.ClusterName()
+	case bronzek8srolebinding.FieldKind:
+		return m.Kind()
+	case bronzek8srolebinding.FieldNamespace:
+		return m.Namespace()
+	case bronzek8srolebinding.FieldName:
+		return m.Name()
+	case bronzek8srolebinding.FieldUID:
+		return m.UID()
+	case bronzek8srolebinding.FieldRoleKind:
+		return m.RoleKind()
+	case bronzek8srolebinding.FieldRoleName:
+		return m.RoleName()
+	case bronzek8srolebinding.FieldSubjectsJSON:
+		return m.SubjectsJSON()
+	case bronzek8srolebinding.FieldRulesJSON:
+		return m.RulesJSON()
+	case bronzek8srolebinding.FieldLabelsJSON:
+		return m.LabelsJSON()
+	case bronzek8srolebinding.FieldAPICreatedAt:
+		return m.APICreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeK8sRoleBindingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzek8srolebinding.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzek8srolebinding.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzek8srolebinding.FieldClusterName:
+		return m.OldClusterName(ctx)
+	case bronzek8srolebinding.FieldKind:
+		return m.OldKind(ctx)
+	case bronzek8srolebinding.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case bronzek8srolebinding.FieldName:
+		return m.OldName(ctx)
+	case bronzek8srolebinding.FieldUID:
+		return m.OldUID(ctx)
+	case bronzek8srolebinding.FieldRoleKind:
+		return m.OldRoleKind(ctx)
+	case bronzek8srolebinding.FieldRoleName:
+		return m.OldRoleName(ctx)
+	case bronzek8srolebinding.FieldSubjectsJSON:
+		return m.OldSubjectsJSON(ctx)
+	case bronzek8srolebinding.FieldRulesJSON:
+		return m.OldRulesJSON(ctx)
+	case bronzek8srolebinding.FieldLabelsJSON:
+		return m.OldLabelsJSON(ctx)
+	case bronzek8srolebinding.FieldAPICreatedAt:
+		return m.OldAPICreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeK8sRoleBinding field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeK8sRoleBindingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzek8srolebinding.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzek8srolebinding.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzek8srolebinding.FieldClusterName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterName(v)
+		return nil
+	case bronzek8srolebinding.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case bronzek8srolebinding.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case bronzek8srolebinding.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case bronzek8srolebinding.FieldUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUID(v)
+		return nil
+	case bronzek8srolebinding.FieldRoleKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleKind(v)
+		return nil
+	case bronzek8srolebinding.FieldRoleName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleName(v)
+		return nil
+	case bronzek8srolebinding.FieldSubjectsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubjectsJSON(v)
+		return nil
+	case bronzek8srolebinding.FieldRulesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRulesJSON(v)
+		return nil
+	case bronzek8srolebinding.FieldLabelsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabelsJSON(v)
+		return nil
+	case bronzek8srolebinding.FieldAPICreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPICreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sRoleBinding field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeK8sRoleBindingMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeK8sRoleBindingMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeK8sRoleBindingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeK8sRoleBinding numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeK8sRoleBindingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzek8srolebinding.FieldNamespace) {
+		fields = append(fields, bronzek8srolebinding.FieldNamespace)
+	}
+	if m.FieldCleared(bronzek8srolebinding.FieldUID) {
+		fields = append(fields, bronzek8srolebinding.FieldUID)
+	}
+	if m.FieldCleared(bronzek8srolebinding.FieldSubjectsJSON) {
+		fields = append(fields, bronzek8srolebinding.FieldSubjectsJSON)
+	}
+	if m.FieldCleared(bronzek8srolebinding.FieldRulesJSON) {
+		fields = append(fields, bronzek8srolebinding.FieldRulesJSON)
+	}
+	if m.FieldCleared(bronzek8srolebinding.FieldLabelsJSON) {
+		fields = append(fields, bronzek8srolebinding.FieldLabelsJSON)
+	}
+	if m.FieldCleared(bronzek8srolebinding.FieldAPICreatedAt) {
+		fields = append(fields, bronzek8srolebinding.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeK8sRoleBindingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeK8sRoleBindingMutation) ClearField(name string) error {
+	switch name {
+	case bronzek8srolebinding.FieldNamespace:
+		m.ClearNamespace()
+		return nil
+	case bronzek8srolebinding.FieldUID:
+		m.ClearUID()
+		return nil
+	case bronzek8srolebinding.FieldSubjectsJSON:
+		m.ClearSubjectsJSON()
+		return nil
+	case bronzek8srolebinding.FieldRulesJSON:
+		m.ClearRulesJSON()
+		return nil
+	case bronzek8srolebinding.FieldLabelsJSON:
+		m.ClearLabelsJSON()
+		return nil
+	case bronzek8srolebinding.FieldAPICreatedAt:
+		m.ClearAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sRoleBinding nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeK8sRoleBindingMutation) ResetField(name string) error {
+	switch name {
+	case bronzek8srolebinding.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzek8srolebinding.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzek8srolebinding.FieldClusterName:
+		m.ResetClusterName()
+		return nil
+	case bronzek8srolebinding.FieldKind:
+		m.ResetKind()
+		return nil
+	case bronzek8srolebinding.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case bronzek8srolebinding.FieldName:
+		m.ResetName()
+		return nil
+	case bronzek8srolebinding.FieldUID:
+		m.ResetUID()
+		return nil
+	case bronzek8srolebinding.FieldRoleKind:
+		m.ResetRoleKind()
+		return nil
+	case bronzek8srolebinding.FieldRoleName:
+		m.ResetRoleName()
+		return nil
+	case bronzek8srolebinding.FieldSubjectsJSON:
+		m.ResetSubjectsJSON()
+		return nil
+	case bronzek8srolebinding.FieldRulesJSON:
+		m.ResetRulesJSON()
+		return nil
+	case bronzek8srolebinding.FieldLabelsJSON:
+		m.ResetLabelsJSON()
+		return nil
+	case bronzek8srolebinding.FieldAPICreatedAt:
+		m.ResetAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sRoleBinding field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeK8sRoleBindingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeK8sRoleBindingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeK8sRoleBindingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeK8sRoleBindingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeK8sRoleBindingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeK8sRoleBindingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeK8sRoleBindingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeK8sRoleBinding unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeK8sRoleBindingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeK8sRoleBinding edge %s", name)
+}
+
+// BronzeK8sServiceAccountMutation represents an operation that mutates the BronzeK8sServiceAccount nodes in the graph.
+type BronzeK8sServiceAccountMutation struct {
+	config
+	op                            Op
+	typ                           string
+	id                            *string
+	collected_at                  *time.Time
+	first_collected_at            *time.Time
+	cluster_name                  *string
+	namespace                     *string
+	name                          *string
+	uid                           *string
+	automount_token               *bool
+	cloud_identity                *string
+	image_pull_secrets_json       *json.RawMessage
+	appendimage_pull_secrets_json json.RawMessage
+	labels_json                   *json.RawMessage
+	appendlabels_json             json.RawMessage
+	api_created_at                *time.Time
+	clearedFields                 map[string]struct{}
+	done                          bool
+	oldValue                      func(context.Context) (*BronzeK8sServiceAccount, error)
+	predicates                    []predicate.BronzeK8sServiceAccount
+}
+
+var _ ent.Mutation = (*BronzeK8sServiceAccountMutation)(nil)
+
+// bronzek8sserviceaccountOption allows management of the mutation configuration using functional options.
+type bronzek8sserviceaccountOption func(*BronzeK8sServiceAccountMutation)
+
+// newBronzeK8sServiceAccountMutation creates new mutation for the BronzeK8sServiceAccount entity.
+func newBronzeK8sServiceAccountMutation(c config, op Op, opts ...bronzek8sserviceaccountOption) *BronzeK8sServiceAccountMutation {
+	m := &BronzeK8sServiceAccountMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeK8sServiceAccount,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeK8sServiceAccountID sets the ID field of the mutation.
+func withBronzeK8sServiceAccountID(id string) bronzek8sserviceaccountOption {
+	return func(m *BronzeK8sServiceAccountMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeK8sServiceAccount
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeK8sServiceAccount, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeK8sServiceAccount.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeK8sServiceAccount sets the old BronzeK8sServiceAccount of the mutation.
+func withBronzeK8sServiceAccount(node *BronzeK8sServiceAccount) bronzek8sserviceaccountOption {
+	return func(m *BronzeK8sServiceAccountMutation) {
+		m.oldValue = func(context.Context) (*BronzeK8sServiceAccount, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeK8sServiceAccountMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeK8sServiceAccountMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("kubernetes: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeK8sServiceAccount entities.
+func (m *BronzeK8sServiceAccountMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeK8sServiceAccountMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeK8sServiceAccountMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeK8sServiceAccount.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeK8sServiceAccountMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeK8sServiceAccountMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeK8sServiceAccount entity.
+// If the BronzeK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sServiceAccountMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeK8sServiceAccountMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeK8sServiceAccountMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeK8sServiceAccountMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeK8sServiceAccount entity.
+// If the BronzeK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sServiceAccountMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeK8sServiceAccountMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (m *BronzeK8sServiceAccountMutation) SetClusterName(s string) {
+	m.cluster_name = &s
+}
+
+// ClusterName returns the value of the "cluster_name" field in the mutation.
+func (m *BronzeK8sServiceAccountMutation) ClusterName() (r string, exists bool) {
+	v := m.cluster_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClusterName returns the old "cluster_name" field's value of the BronzeK8sServiceAccount entity.
+// If the BronzeK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sServiceAccountMutation) OldClusterName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterName: %w", err)
+	}
+	return oldValue.ClusterName, nil
+}
+
+// ResetClusterName resets all changes to the "cluster_name" field.
+func (m *BronzeK8sServiceAccountMutation) ResetClusterName() {
+	m.cluster_name = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *BronzeK8sServiceAccountMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *BronzeK8sServiceAccountMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the BronzeK8sServiceAccount entity.
+// If the BronzeK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sServiceAccountMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *BronzeK8sServiceAccountMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetName sets the "name" field.
+func (m *BronzeK8sServiceAccountMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BronzeK8sServiceAccountMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the BronzeK8sServiceAccount entity.
+// If the BronzeK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sServiceAccountMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *BronzeK8sServiceAccountMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUID sets the "uid" field.
+func (m *BronzeK8sServiceAccountMutation) SetUID(s string) {
+	m.uid = &s
+}
+
+// UID returns the value of the "uid" field in the mutation.
+func (m *BronzeK8sServiceAccountMutation) UID() (r string, exists bool) {
+	v := m.uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUID returns the old "uid" field's value of the BronzeK8sServiceAccount entity.
+// If the BronzeK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sServiceAccountMutation) OldUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+	}
+	return oldValue.UID, nil
+}
+
+// ClearUID clears the value of the "uid" field.
+func (m *BronzeK8sServiceAccountMutation) ClearUID() {
+	m.uid = nil
+	m.clearedFields[bronzek8sserviceaccount.FieldUID] = struct{}{}
+}
+
+// UIDCleared returns if the "uid" field was cleared in this mutation.
+func (m *BronzeK8sServiceAccountMutation) UIDCleared() bool {
+	_, ok := m.clearedFields[bronzek8sserviceaccount.FieldUID]
+	return ok
+}
+
+// ResetUID resets all changes to the "uid" field.
+func (m *BronzeK8sServiceAccountMutation) ResetUID() {
+	m.uid = nil
+	delete(m.clearedFields, bronzek8sserviceaccount.FieldUID)
+}
+
+// SetAutomountToken sets the "automount_token" field.
+func (m *BronzeK8sServiceAccountMutation) SetAutomountToken(b bool) {
+	m.automount_token = &b
+}
+
+// AutomountToken returns the value of the "automount_token" field in the mutation.
+func (m *BronzeK8sServiceAccountMutation) AutomountToken() (r bool, exists bool) {
+	v := m.automount_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutomountToken returns the old "automount_token" field's value of the BronzeK8sServiceAccount entity.
+// If the BronzeK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sServiceAccountMutation) OldAutomountToken(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutomountToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutomountToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutomountToken: %w", err)
+	}
+	return oldValue.AutomountToken, nil
+}
+
+// ClearAutomountToken clears the value of the "automount_token" field.
+func (m *BronzeK8sServiceAccountMutation) ClearAutomountToken() {
+	m.automount_token = nil
+	m.clearedFields[bronzek8sserviceaccount.FieldAutomountToken] = struct{}{}
+}
+
+// AutomountTokenCleared returns if the "automount_token" field was cleared in this mutation.
+func (m *BronzeK8sServiceAccountMutation) AutomountTokenCleared() bool {
+	_, ok := m.clearedFields[bronzek8sserviceaccount.FieldAutomountToken]
+	return ok
+}
+
+// ResetAutomountToken resets all changes to the "automount_token" field.
+func (m *BronzeK8sServiceAccountMutation) ResetAutomountToken() {
+	m.automount_token = nil
+	delete(m.clearedFields, bronzek8sserviceaccount.FieldAutomountToken)
+}
+
+// SetCloudIdentity sets the "cloud_identity" field.
+func (m *BronzeK8sServiceAccountMutation) SetCloudIdentity(s string) {
+	m.cloud_identity = &s
+}
+
+// CloudIdentity returns the value of the "cloud_identity" field in the mutation.
+func (m *BronzeK8sServiceAccountMutation) CloudIdentity() (r string, exists bool) {
+	v := m.cloud_identity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCloudIdentity returns the old "cloud_identity" field's value of the BronzeK8sServiceAccount entity.
+// If the BronzeK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sServiceAccountMutation) OldCloudIdentity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCloudIdentity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCloudIdentity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCloudIdentity: %w", err)
+	}
+	return oldValue.CloudIdentity, nil
+}
+
+// ClearCloudIdentity clears the value of the "cloud_identity" field.
+func (m *BronzeK8sServiceAccountMutation) ClearCloudIdentity() {
+	m.cloud_identity = nil
+	m.clearedFields[bronzek8sserviceaccount.FieldCloudIdentity] = struct{}{}
+}
+
+// CloudIdentityCleared returns if the "cloud_identity" field was cleared in this mutation.
+func (m *BronzeK8sServiceAccountMutation) CloudIdentityCleared() bool {
+	_, ok := m.clearedFields[bronzek8sserviceaccount.FieldCloudIdentity]
+	return ok
+}
+
+// ResetCloudIdentity resets all changes to the "cloud_identity" field.
+func (m *BronzeK8sServiceAccountMutation) ResetCloudIdentity() {
+	m.cloud_identity = nil
+	delete(m.clearedFields, bronzek8sserviceaccount.FieldCloudIdentity)
+}
+
+// SetImagePullSecretsJSON sets the "image_pull_secrets_json" field.
+func (m *BronzeK8sServiceAccountMutation) SetImagePullSecretsJSON(jm json.RawMessage) {
+	m.image_pull_secrets_json = &jm
+	m.appendimage_pull_secrets_json = nil
+}
+
+// ImagePullSecretsJSON returns the value of the "image_pull_secrets_json" field in the mutation.
+func (m *BronzeK8sServiceAccountMutation) ImagePullSecretsJSON() (r json.RawMessage, exists bool) {
+	v := m.image_pull_secrets_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImagePullSecretsJSON returns the old "image_pull_secrets_json" field's value of the BronzeK8sServiceAccount entity.
+// If the BronzeK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sServiceAccountMutation) OldImagePullSecretsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImagePullSecretsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImagePullSecretsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImagePullSecretsJSON: %w", err)
+	}
+	return oldValue.ImagePullSecretsJSON, nil
+}
+
+// AppendImagePullSecretsJSON adds jm to the "image_pull_secrets_json" field.
+func (m *BronzeK8sServiceAccountMutation) AppendImagePullSecretsJSON(jm json.RawMessage) {
+	m.appendimage_pull_secrets_json = append(m.appendimage_pull_secrets_json, jm...)
+}
+
+// AppendedImagePullSecretsJSON returns the list of values that were appended to the "image_pull_secrets_json" field in this mutation.
+func (m *BronzeK8sServiceAccountMutation) AppendedImagePullSecretsJSON() (json.RawMessage, bool) {
+	if len(m.appendimage_pull_secrets_json) == 0 {
+		return nil, false
+	}
+	return m.appendimage_pull_secrets_json, true
+}
+
+// ClearImagePullSecretsJSON clears the value of the "image_pull_secrets_json" field.
+func (m *BronzeK8sServiceAccountMutation) ClearImagePullSecretsJSON() {
+	m.image_pull_secrets_json = nil
+	m.appendimage_pull_secrets_json = nil
+	m.clearedFields[bronzek8sserviceaccount.FieldImagePullSecretsJSON] = struct{}{}
+}
+
+// ImagePullSecretsJSONCleared returns if the "image_pull_secrets_json" field was cleared in this mutation.
+func (m *BronzeK8sServiceAccountMutation) ImagePullSecretsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8sserviceaccount.FieldImagePullSecretsJSON]
+	return ok
+}
+
+// ResetImagePullSecretsJSON resets all changes to the "image_pull_secrets_json" field.
+func (m *BronzeK8sServiceAccountMutation) ResetImagePullSecretsJSON() {
+	m.image_pull_secrets_json = nil
+	m.appendimage_pull_secrets_json = nil
+	delete(m.clearedFields, bronzek8sserviceaccount.FieldImagePullSecretsJSON)
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (m *BronzeK8sServiceAccountMutation) SetLabelsJSON(jm json.RawMessage) {
+	m.labels_json = &jm
+	m.appendlabels_json = nil
+}
+
+// LabelsJSON returns the value of the "labels_json" field in the mutation.
+func (m *BronzeK8sServiceAccountMutation) LabelsJSON() (r json.RawMessage, exists bool) {
+	v := m.labels_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabelsJSON returns the old "labels_json" field's value of the BronzeK8sServiceAccount entity.
+// If the BronzeK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sServiceAccountMutation) OldLabelsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabelsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabelsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabelsJSON: %w", err)
+	}
+	return oldValue.LabelsJSON, nil
+}
+
+// AppendLabelsJSON adds jm to the "labels_json" field.
+func (m *BronzeK8sServiceAccountMutation) AppendLabelsJSON(jm json.RawMessage) {
+	m.appendlabels_json = append(m.appendlabels_json, jm...)
+}
+
+// AppendedLabelsJSON returns the list of values that were appended to the "labels_json" field in this mutation.
+func (m *BronzeK8sServiceAccountMutation) AppendedLabelsJSON() (json.RawMessage, bool) {
+	if len(m.appendlabels_json) == 0 {
+		return nil, false
+	}
+	return m.appendlabels_json, true
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (m *BronzeK8sServiceAccountMutation) ClearLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	m.clearedFields[bronzek8sserviceaccount.FieldLabelsJSON] = struct{}{}
+}
+
+// LabelsJSONCleared returns if the "labels_json" field was cleared in this mutation.
+func (m *BronzeK8sServiceAccountMutation) LabelsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8sserviceaccount.FieldLabelsJSON]
+	return ok
+}
+
+// ResetLabelsJSON resets all changes to the "labels_json" field.
+func (m *BronzeK8sServiceAccountMutation) ResetLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	delete(m.clearedFields, bronzek8sserviceaccount.FieldLabelsJSON)
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (m *BronzeK8sServiceAccountMutation) SetAPICreatedAt(t time.Time) {
+	m.api_created_at = &t
+}
+
+// APICreatedAt returns the value of the "api_created_at" field in the mutation.
+func (m *BronzeK8sServiceAccountMutation) APICreatedAt() (r time.Time, exists bool) {
+	v := m.api_created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPICreatedAt returns the old "api_created_at" field's value of the BronzeK8sServiceAccount entity.
+// If the BronzeK8sServiceAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sServiceAccountMutation) OldAPICreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPICreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPICreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPICreatedAt: %w", err)
+	}
+	return oldValue.APICreatedAt, nil
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (m *BronzeK8sServiceAccountMutation) ClearAPICreatedAt() {
+	m.api_created_at = nil
+	m.clearedFields[bronzek8sserviceaccount.FieldAPICreatedAt] = struct{}{}
+}
+
+// APICreatedAtCleared returns if the "api_created_at" field was cleared in this mutation.
+func (m *BronzeK8sServiceAccountMutation) APICreatedAtCleared() bool {
+	_, ok := m.clearedFields[bronzek8sserviceaccount.FieldAPICreatedAt]
+	return ok
+}
+
+// ResetAPICreatedAt resets all changes to the "api_created_at" field.
+func (m *BronzeK8sServiceAccountMutation) ResetAPICreatedAt() {
+	m.api_created_at = nil
+	delete(m.clearedFields, bronzek8sserviceaccount.FieldAPICreatedAt)
+}
+
+// Where appends a list predicates to the BronzeK8sServiceAccountMutation builder.
+func (m *BronzeK8sServiceAccountMutation) Where(ps ...predicate.BronzeK8sServiceAccount) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeK8sServiceAccountMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeK8sServiceAccountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeK8sServiceAccount, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeK8sServiceAccountMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeK8sServiceAccountMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeK8sServiceAccount).
+func (m *BronzeK8sServiceAccountMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeK8sServiceAccountMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.collected_at != nil {
+		fields = append(fields, bronzek8sserviceaccount.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzek8sserviceaccount.FieldFirstCollectedAt)
+	}
+	if m.cluster_name != nil {
+		fields = append(fields, bronzek8sserviceaccount.FieldClusterName)
+	}
+	if m.namespace != nil {
+		fields = append(fields, bronzek8sserviceaccount.FieldNamespace)
+	}
+	if m.name != nil {
+		fields = append(fields, bronzek8sserviceaccount.FieldName)
+	}
+	if m.uid != nil {
+		fields = append(fields, bronzek8sserviceaccount.FieldUID)
+	}
+	if m.automount_token != nil {
+		fields = append(fields, bronzek8sserviceaccount.FieldAutomountToken)
+	}
+	if m.cloud_identity != nil {
+		fields = append(fields, bronzek8sserviceaccount.FieldCloudIdentity)
+	}
+	if m.image_pull_secrets_json != nil {
+		fields = append(fields, bronzek8sserviceaccount.FieldImagePullSecretsJSON)
+	}
+	if m.labels_json != nil {
+		fields = append(fields, bronzek8sserviceaccount.FieldLabelsJSON)
+	}
+	if m.api_created_at != nil {
+		fields = append(fields, bronzek8sserviceaccount.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeK8sServiceAccountMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzek8sserviceaccount.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzek8sserviceaccount.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzek8sserviceaccount.FieldClusterName:
+		return m.ClusterName()
+	case bronzek8sserviceaccount.FieldNamespace:
+		return m.Namespace()
+	case bronzek8sserviceaccount.FieldName:
+		return m.Name()
+	case bronzek8sserviceaccount.FieldUID:
+		return m.UID()
+	case bronzek8sserviceaccount.FieldAutomountToken:
+		return m.AutomountToken()
+	case bronzek8sserviceaccount.FieldCloudIdentity:
+		return m.CloudIdentity()
+	case bronzek8sserviceaccount.FieldImagePullSecretsJSON:
+		return m.ImagePullSecretsJSON()
+	case bronzek8sserviceaccount.FieldLabelsJSON:
+		return m.LabelsJSON()
+	case bronzek8sserviceaccount.FieldAPICreatedAt:
+		return m.APICreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeK8sServiceAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzek8sserviceaccount.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzek8sserviceaccount.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzek8sserviceaccount.FieldClusterName:
+		return m.OldClusterName(ctx)
+	case bronzek8sserviceaccount.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case bronzek8sserviceaccount.FieldName:
+		return m.OldName(ctx)
+	case bronzek8sserviceaccount.FieldUID:
+		return m.OldUID(ctx)
+	case bronzek8sserviceaccount.FieldAutomountToken:
+		return m.OldAutomountToken(ctx)
+	case bronzek8sserviceaccount.FieldCloudIdentity:
+		return m.OldCloudIdentity(ctx)
+	case bronzek8sserviceaccount.FieldImagePullSecretsJSON:
+		return m.OldImagePullSecretsJSON(ctx)
+	case bronzek8sserviceaccount.FieldLabelsJSON:
+		return m.OldLabelsJSON(ctx)
+	case bronzek8sserviceaccount.FieldAPICreatedAt:
+		return m.OldAPICreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeK8sServiceAccount field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeK8sServiceAccountMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzek8sserviceaccount.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzek8sserviceaccount.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzek8sserviceaccount.FieldClusterName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterName(v)
+		return nil
+	case bronzek8sserviceaccount.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case bronzek8sserviceaccount.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case bronzek8sserviceaccount.FieldUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUID(v)
+		return nil
+	case bronzek8sserviceaccount.FieldAutomountToken:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutomountToken(v)
+		return nil
+	case bronzek8sserviceaccount.FieldCloudIdentity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCloudIdentity(v)
+		return nil
+	case bronzek8sserviceaccount.FieldImagePullSecretsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImagePullSecretsJSON(v)
+		return nil
+	case bronzek8sserviceaccount.FieldLabelsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabelsJSON(v)
+		return nil
+	case bronzek8sserviceaccount.FieldAPICreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPICreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sServiceAccount field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeK8sServiceAccountMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeK8sServiceAccountMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeK8sServiceAccountMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeK8sServiceAccount numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeK8sServiceAccountMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzek8sserviceaccount.FieldUID) {
+		fields = append(fields, bronzek8sserviceaccount.FieldUID)
+	}
+	if m.FieldCleared(bronzek8sserviceaccount.FieldAutomountToken) {
+		fields = append(fields, bronzek8sserviceaccount.FieldAutomountToken)
+	}
+	if m.FieldCleared(bronzek8sserviceaccount.FieldCloudIdentity) {
+		fields = append(fields, bronzek8sserviceaccount.FieldCloudIdentity)
+	}
+	if m.FieldCleared(bronzek8sserviceaccount.FieldImagePullSecretsJSON) {
+		fields = append(fields, bronzek8sserviceaccount.FieldImagePullSecretsJSON)
+	}
+	if m.FieldCleared(bronzek8sserviceaccount.FieldLabelsJSON) {
+		fields = append(fields, bronzek8sserviceaccount.FieldLabelsJSON)
+	}
+	if m.FieldCleared(bronzek8sserviceaccount.FieldAPICreatedAt) {
+		fields = append(fields, bronzek8sserviceaccount.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeK8sServiceAccountMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeK8sServiceAccountMutation) ClearField(name string) error {
+	switch name {
+	case bronzek8sserviceaccount.FieldUID:
+		m.ClearUID()
+		return nil
+	case bronzek8sserviceaccount.FieldAutomountToken:
+		m.ClearAutomountToken()
+		return nil
+	case bronzek8sserviceaccount.FieldCloudIdentity:
+		m.ClearCloudIdentity()
+		return nil
+	case bronzek8sserviceaccount.FieldImagePullSecretsJSON:
+		m.ClearImagePullSecretsJSON()
+		return nil
+	case bronzek8sserviceaccount.FieldLabelsJSON:
+		m.ClearLabelsJSON()
+		return nil
+	case bronzek8sserviceaccount.FieldAPICreatedAt:
+		m.ClearAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sServiceAccount nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeK8sServiceAccountMutation) ResetField(name string) error {
+	switch name {
+	case bronzek8sserviceaccount.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzek8sserviceaccount.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzek8sserviceaccount.FieldClusterName:
+		m.ResetClusterName()
+		return nil
+	case bronzek8sserviceaccount.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case bronzek8sserviceaccount.FieldName:
+		m.ResetName()
+		return nil
+	case bronzek8sserviceaccount.FieldUID:
+		m.ResetUID()
+		return nil
+	case bronzek8sserviceaccount.FieldAutomountToken:
+		m.ResetAutomountToken()
+		return nil
+	case bronzek8sserviceaccount.FieldCloudIdentity:
+		m.ResetCloudIdentity()
+		return nil
+	case bronzek8sserviceaccount.FieldImagePullSecretsJSON:
+		m.ResetImagePullSecretsJSON()
+		return nil
+	case bronzek8sserviceaccount.FieldLabelsJSON:
+		m.ResetLabelsJSON()
+		return nil
+	case bronzek8sserviceaccount.FieldAPICreatedAt:
+		m.ResetAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sServiceAccount field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeK8sServiceAccountMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeK8sServiceAccountMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeK8sServiceAccountMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeK8sServiceAccountMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeK8sServiceAccountMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeK8sServiceAccountMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeK8sServiceAccountMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeK8sServiceAccount unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeK8sServiceAccountMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeK8sServiceAccount edge %s", name)
+}
+
+// BronzeK8sWorkloadMutation represents an operation that mutates the BronzeK8sWorkload nodes in the graph.
+type BronzeK8sWorkloadMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *string
+	collected_at          *time.Time
+	first_collected_at    *time.Time
+	cluster_name          *string
+	namespace             *string
+	kind                  *string
+	name                  *string
+	uid                   *string
+	replicas              *int32
+	addreplicas           *int32
+	service_account       *string
+	host_network          *bool
+	host_pid              *bool
+	host_ipc              *bool
+	images_json           *json.RawMessage
+	appendimages_json     json.RawMessage
+	containers_json       *json.RawMessage
+	appendcontainers_json json.RawMessage
+	selector_json         *json.RawMessage
+	appendselector_json   json.RawMessage
+	labels_json           *json.RawMessage
+	appendlabels_json     json.RawMessage
+	api_created_at        *time.Time
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*BronzeK8sWorkload, error)
+	predicates            []predicate.BronzeK8sWorkload
+}
+
+var _ ent.Mutation = (*BronzeK8sWorkloadMutation)(nil)
+
+// bronzek8sworkloadOption allows management of the mutation configuration using functional options.
+type bronzek8sworkloadOption func(*BronzeK8sWorkloadMutation)
+
+// newBronzeK8sWorkloadMutation creates new mutation for the BronzeK8sWorkload entity.
+func newBronzeK8sWorkloadMutation(c config, op Op, opts ...bronzek8sworkloadOption) *BronzeK8sWorkloadMutation {
+	m := &BronzeK8sWorkloadMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeK8sWorkload,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeK8sWorkloadID sets the ID field of the mutation.
+func withBronzeK8sWorkloadID(id string) bronzek8sworkloadOption {
+	return func(m *BronzeK8sWorkloadMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeK8sWorkload
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeK8sWorkload, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeK8sWorkload.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeK8sWorkload sets the old BronzeK8sWorkload of the mutation.
+func withBronzeK8sWorkload(node *BronzeK8sWorkload) bronzek8sworkloadOption {
+	return func(m *BronzeK8sWorkloadMutation) {
+		m.oldValue = func(context.Context) (*BronzeK8sWorkload, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeK8sWorkloadMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeK8sWorkloadMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("kubernetes: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeK8sWorkload entities.
+func (m *BronzeK8sWorkloadMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeK8sWorkloadMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeK8sWorkloadMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeK8sWorkload.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeK8sWorkloadMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeK8sWorkloadMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeK8sWorkload entity.
+// If the BronzeK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sWorkloadMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeK8sWorkloadMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeK8sWorkloadMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeK8sWorkloadMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeK8sWorkload entity.
+// If the BronzeK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sWorkloadMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeK8sWorkloadMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetClusterName sets the "cluster_name" field.
+func (m *BronzeK8sWorkloadMutation) SetClusterName(s string) {
+	m.cluster_name = &s
+}
+
+// ClusterName returns the value of the "cluster_name" field in the mutation.
+func (m *BronzeK8sWorkloadMutation) ClusterName() (r string, exists bool) {
+	v := m.cluster_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClusterName returns the old "cluster_name" field's value of the BronzeK8sWorkload entity.
+// If the BronzeK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sWorkloadMutation) OldClusterName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterName: %w", err)
+	}
+	return oldValue.ClusterName, nil
+}
+
+// ResetClusterName resets all changes to the "cluster_name" field.
+func (m *BronzeK8sWorkloadMutation) ResetClusterName() {
+	m.cluster_name = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *BronzeK8sWorkloadMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *BronzeK8sWorkloadMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the BronzeK8sWorkload entity.
+// If the BronzeK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sWorkloadMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *BronzeK8sWorkloadMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *BronzeK8sWorkloadMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *BronzeK8sWorkloadMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the BronzeK8sWorkload entity.
+// If the BronzeK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sWorkloadMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *BronzeK8sWorkloadMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetName sets the "name" field.
+func (m *BronzeK8sWorkloadMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BronzeK8sWorkloadMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the BronzeK8sWorkload entity.
+// If the BronzeK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sWorkloadMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *BronzeK8sWorkloadMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUID sets the "uid" field.
+func (m *BronzeK8sWorkloadMutation) SetUID(s string) {
+	m.uid = &s
+}
+
+// UID returns the value of the "uid" field in the mutation.
+func (m *BronzeK8sWorkloadMutation) UID() (r string, exists bool) {
+	v := m.uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUID returns the old "uid" field's value of the BronzeK8sWorkload entity.
+// If the BronzeK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sWorkloadMutation) OldUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+	}
+	return oldValue.UID, nil
+}
+
+// ClearUID clears the value of the "uid" field.
+func (m *BronzeK8sWorkloadMutation) ClearUID() {
+	m.uid = nil
+	m.clearedFields[bronzek8sworkload.FieldUID] = struct{}{}
+}
+
+// UIDCleared returns if the "uid" field was cleared in this mutation.
+func (m *BronzeK8sWorkloadMutation) UIDCleared() bool {
+	_, ok := m.clearedFields[bronzek8sworkload.FieldUID]
+	return ok
+}
+
+// ResetUID resets all changes to the "uid" field.
+func (m *BronzeK8sWorkloadMutation) ResetUID() {
+	m.uid = nil
+	delete(m.clearedFields, bronzek8sworkload.FieldUID)
+}
+
+// SetReplicas sets the "replicas" field.
+func (m *BronzeK8sWorkloadMutation) SetReplicas(i int32) {
+	m.replicas = &i
+	m.addreplicas = nil
+}
+
+// Replicas returns the value of the "replicas" field in the mutation.
+func (m *BronzeK8sWorkloadMutation) Replicas() (r int32, exists bool) {
+	v := m.replicas
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplicas returns the old "replicas" field's value of the BronzeK8sWorkload entity.
+// If the BronzeK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sWorkloadMutation) OldReplicas(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplicas is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplicas requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplicas: %w", err)
+	}
+	return oldValue.Replicas, nil
+}
+
+// AddReplicas adds i to the "replicas" field.
+func (m *BronzeK8sWorkloadMutation) AddReplicas(i int32) {
+	if m.addreplicas != nil {
+		*m.addreplicas += i
+	} else {
+		m.addreplicas = &i
+	}
+}
+
+// AddedReplicas returns the value that was added to the "replicas" field in this mutation.
+func (m *BronzeK8sWorkloadMutation) AddedReplicas() (r int32, exists bool) {
+	v := m.addreplicas
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearReplicas clears the value of the "replicas" field.
+func (m *BronzeK8sWorkloadMutation) ClearReplicas() {
+	m.replicas = nil
+	m.addreplicas = nil
+	m.clearedFields[bronzek8sworkload.FieldReplicas] = struct{}{}
+}
+
+// ReplicasCleared returns if the "replicas" field was cleared in this mutation.
+func (m *BronzeK8sWorkloadMutation) ReplicasCleared() bool {
+	_, ok := m.clearedFields[bronzek8sworkload.FieldReplicas]
+	return ok
+}
+
+// ResetReplicas resets all changes to the "replicas" field.
+func (m *BronzeK8sWorkloadMutation) ResetReplicas() {
+	m.replicas = nil
+	m.addreplicas = nil
+	delete(m.clearedFields, bronzek8sworkload.FieldReplicas)
+}
+
+// SetServiceAccount sets the "service_account" field.
+func (m *BronzeK8sWorkloadMutation) SetServiceAccount(s string) {
+	m.service_account = &s
+}
+
+// ServiceAccount returns the value of the "service_account" field in the mutation.
+func (m *BronzeK8sWorkloadMutation) ServiceAccount() (r string, exists bool) {
+	v := m.service_account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServiceAccount returns the old "service_account" field's value of the BronzeK8sWorkload entity.
+// If the BronzeK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sWorkloadMutation) OldServiceAccount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServiceAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServiceAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServiceAccount: %w", err)
+	}
+	return oldValue.ServiceAccount, nil
+}
+
+// ClearServiceAccount clears the value of the "service_account" field.
+func (m *BronzeK8sWorkloadMutation) ClearServiceAccount() {
+	m.service_account = nil
+	m.clearedFields[bronzek8sworkload.FieldServiceAccount] = struct{}{}
+}
+
+// ServiceAccountCleared returns if the "service_account" field was cleared in this mutation.
+func (m *BronzeK8sWorkloadMutation) ServiceAccountCleared() bool {
+	_, ok := m.clearedFields[bronzek8sworkload.FieldServiceAccount]
+	return ok
+}
+
+// ResetServiceAccount resets all changes to the "service_account" field.
+func (m *BronzeK8sWorkloadMutation) ResetServiceAccount() {
+	m.service_account = nil
+	delete(m.clearedFields, bronzek8sworkload.FieldServiceAccount)
+}
+
+// SetHostNetwork sets the "host_network" field.
+func (m *BronzeK8sWorkloadMutation) SetHostNetwork(b bool) {
+	m.host_network = &b
+}
+
+// HostNetwork returns the value of the "host_network" field in the mutation.
+func (m *BronzeK8sWorkloadMutation) HostNetwork() (r bool, exists bool) {
+	v := m.host_network
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostNetwork returns the old "host_network" field's value of the BronzeK8sWorkload entity.
+// If the BronzeK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sWorkloadMutation) OldHostNetwork(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostNetwork is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostNetwork requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostNetwork: %w", err)
+	}
+	return oldValue.HostNetwork, nil
+}
+
+// ResetHostNetwork resets all changes to the "host_network" field.
+func (m *BronzeK8sWorkloadMutation) ResetHostNetwork() {
+	m.host_network = nil
+}
+
+// SetHostPid sets the "host_pid" field.
+func (m *BronzeK8sWorkloadMutation) SetHostPid(b bool) {
+	m.host_pid = &b
+}
+
+// HostPid returns the value of the "host_pid" field in the mutation.
+func (m *BronzeK8sWorkloadMutation) HostPid() (r bool, exists bool) {
+	v := m.host_pid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostPid returns the old "host_pid" field's value of the BronzeK8sWorkload entity.
+// If the BronzeK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sWorkloadMutation) OldHostPid(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostPid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostPid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostPid: %w", err)
+	}
+	return oldValue.HostPid, nil
+}
+
+// ResetHostPid resets all changes to the "host_pid" field.
+func (m *BronzeK8sWorkloadMutation) ResetHostPid() {
+	m.host_pid = nil
+}
+
+// SetHostIpc sets the "host_ipc" field.
+func (m *BronzeK8sWorkloadMutation) SetHostIpc(b bool) {
+	m.host_ipc = &b
+}
+
+// HostIpc returns the value of the "host_ipc" field in the mutation.
+func (m *BronzeK8sWorkloadMutation) HostIpc() (r bool, exists bool) {
+	v := m.host_ipc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostIpc returns the old "host_ipc" field's value of the BronzeK8sWorkload entity.
+// If the BronzeK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sWorkloadMutation) OldHostIpc(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostIpc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostIpc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostIpc: %w", err)
+	}
+	return oldValue.HostIpc, nil
+}
+
+// ResetHostIpc resets all changes to the "host_ipc" field.
+func (m *BronzeK8sWorkloadMutation) ResetHostIpc() {
+	m.host_ipc = nil
+}
+
+// SetImagesJSON sets the "images_json" field.
+func (m *BronzeK8sWorkloadMutation) SetImagesJSON(jm json.RawMessage) {
+	m.images_json = &jm
+	m.appendimages_json = nil
+}
+
+// ImagesJSON returns the value of the "images_json" field in the mutation.
+func (m *BronzeK8sWorkloadMutation) ImagesJSON() (r json.RawMessage, exists bool) {
+	v := m.images_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImagesJSON returns the old "images_json" field's value of the BronzeK8sWorkload entity.
+// If the BronzeK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sWorkloadMutation) OldImagesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImagesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImagesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImagesJSON: %w", err)
+	}
+	return oldValue.ImagesJSON, nil
+}
+
+// AppendImagesJSON adds jm to the "images_json" field.
+func (m *BronzeK8sWorkloadMutation) AppendImagesJSON(jm json.RawMessage) {
+	m.appendimages_json = append(m.appendimages_json, jm...)
+}
+
+// AppendedImagesJSON returns the list of values that were appended to the "images_json" field in this mutation.
+func (m *BronzeK8sWorkloadMutation) AppendedImagesJSON() (json.RawMessage, bool) {
+	if len(m.appendimages_json) == 0 {
+		return nil, false
+	}
+	return m.appendimages_json, true
+}
+
+// ClearImagesJSON clears the value of the "images_json" field.
+func (m *BronzeK8sWorkloadMutation) ClearImagesJSON() {
+	m.images_json = nil
+	m.appendimages_json = nil
+	m.clearedFields[bronzek8sworkload.FieldImagesJSON] = struct{}{}
+}
+
+// ImagesJSONCleared returns if the "images_json" field was cleared in this mutation.
+func (m *BronzeK8sWorkloadMutation) ImagesJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8sworkload.FieldImagesJSON]
+	return ok
+}
+
+// ResetImagesJSON resets all changes to the "images_json" field.
+func (m *BronzeK8sWorkloadMutation) ResetImagesJSON() {
+	m.images_json = nil
+	m.appendimages_json = nil
+	delete(m.clearedFields, bronzek8sworkload.FieldImagesJSON)
+}
+
+// SetContainersJSON sets the "containers_json" field.
+func (m *BronzeK8sWorkloadMutation) SetContainersJSON(jm json.RawMessage) {
+	m.containers_json = &jm
+	m.appendcontainers_json = nil
+}
+
+// ContainersJSON returns the value of the "containers_json" field in the mutation.
+func (m *BronzeK8sWorkloadMutation) ContainersJSON() (r json.RawMessage, exists bool) {
+	v := m.containers_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContainersJSON returns the old "containers_json" field's value of the BronzeK8sWorkload entity.
+// If the BronzeK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sWorkloadMutation) OldContainersJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContainersJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContainersJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContainersJSON: %w", err)
+	}
+	return oldValue.ContainersJSON, nil
+}
+
+// AppendContainersJSON adds jm to the "containers_json" field.
+func (m *BronzeK8sWorkloadMutation) AppendContainersJSON(jm json.RawMessage) {
+	m.appendcontainers_json = append(m.appendcontainers_json, jm...)
+}
+
+// AppendedContainersJSON returns the list of values that were appended to the "containers_json" field in this mutation.
+func (m *BronzeK8sWorkloadMutation) AppendedContainersJSON() (json.RawMessage, bool) {
+	if len(m.appendcontainers_json) == 0 {
+		return nil, false
+	}
+	return m.appendcontainers_json, true
+}
+
+// ClearContainersJSON clears the value of the "containers_json" field.
+func (m *BronzeK8sWorkloadMutation) ClearContainersJSON() {
+	m.containers_json = nil
+	m.appendcontainers_json = nil
+	m.clearedFields[bronzek8sworkload.FieldContainersJSON] = struct{}{}
+}
+
+// ContainersJSONCleared returns if the "containers_json" field was cleared in this mutation.
+func (m *BronzeK8sWorkloadMutation) ContainersJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8sworkload.FieldContainersJSON]
+	return ok
+}
+
+// ResetContainersJSON resets all changes to the "containers_json" field.
+func (m *BronzeK8sWorkloadMutation) ResetContainersJSON() {
+	m.containers_json = nil
+	m.appendcontainers_json = nil
+	delete(m.clearedFields, bronzek8sworkload.FieldContainersJSON)
+}
+
+// SetSelectorJSON sets the "selector_json" field.
+func (m *BronzeK8sWorkloadMutation) SetSelectorJSON(jm json.RawMessage) {
+	m.selector_json = &jm
+	m.appendselector_json = nil
+}
+
+// SelectorJSON returns the value of the "selector_json" field in the mutation.
+func (m *BronzeK8sWorkloadMutation) SelectorJSON() (r json.RawMessage, exists bool) {
+	v := m.selector_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSelectorJSON returns the old "selector_json" field's value of the BronzeK8sWorkload entity.
+// If the BronzeK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sWorkloadMutation) OldSelectorJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSelectorJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSelectorJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSelectorJSON: %w", err)
+	}
+	return oldValue.SelectorJSON, nil
+}
+
+// AppendSelectorJSON adds jm to the "selector_json" field.
+func (m *BronzeK8sWorkloadMutation) AppendSelectorJSON(jm json.RawMessage) {
+	m.appendselector_json = append(m.appendselector_json, jm...)
+}
+
+// AppendedSelectorJSON returns the list of values that were appended to the "selector_json" field in this mutation.
+func (m *BronzeK8sWorkloadMutation) AppendedSelectorJSON() (json.RawMessage, bool) {
+	if len(m.appendselector_json) == 0 {
+		return nil, false
+	}
+	return m.appendselector_json, true
+}
+
+// ClearSelectorJSON clears the value of the "selector_json" field.
+func (m *BronzeK8sWorkloadMutation) ClearSelectorJSON() {
+	m.selector_json = nil
+	m.appendselector_json = nil
+	m.clearedFields[bronzek8sworkload.FieldSelectorJSON] = struct{}{}
+}
+
+// SelectorJSONCleared returns if the "selector_json" field was cleared in this mutation.
+func (m *BronzeK8sWorkloadMutation) SelectorJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8sworkload.FieldSelectorJSON]
+	return ok
+}
+
+// ResetSelectorJSON resets all changes to the "selector_json" field.
+func (m *BronzeK8sWorkloadMutation) ResetSelectorJSON() {
+	m.selector_json = nil
+	m.appendselector_json = nil
+	delete(m.clearedFields, bronzek8sworkload.FieldSelectorJSON)
+}
+
+// SetLabelsJSON sets the "labels_json" field.
+func (m *BronzeK8sWorkloadMutation) SetLabelsJSON(jm json.RawMessage) {
+	m.labels_json = &jm
+	m.appendlabels_json = nil
+}
+
+// LabelsJSON returns the value of the "labels_json" field in the mutation.
+func (m *BronzeK8sWorkloadMutation) LabelsJSON() (r json.RawMessage, exists bool) {
+	v := m.labels_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabelsJSON returns the old "labels_json" field's value of the BronzeK8sWorkload entity.
+// If the BronzeK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sWorkloadMutation) OldLabelsJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabelsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabelsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabelsJSON: %w", err)
+	}
+	return oldValue.LabelsJSON, nil
+}
+
+// AppendLabelsJSON adds jm to the "labels_json" field.
+func (m *BronzeK8sWorkloadMutation) AppendLabelsJSON(jm json.RawMessage) {
+	m.appendlabels_json = append(m.appendlabels_json, jm...)
+}
+
+// AppendedLabelsJSON returns the list of values that were appended to the "labels_json" field in this mutation.
+func (m *BronzeK8sWorkloadMutation) AppendedLabelsJSON() (json.RawMessage, bool) {
+	if len(m.appendlabels_json) == 0 {
+		return nil, false
+	}
+	return m.appendlabels_json, true
+}
+
+// ClearLabelsJSON clears the value of the "labels_json" field.
+func (m *BronzeK8sWorkloadMutation) ClearLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	m.clearedFields[bronzek8sworkload.FieldLabelsJSON] = struct{}{}
+}
+
+// LabelsJSONCleared returns if the "labels_json" field was cleared in this mutation.
+func (m *BronzeK8sWorkloadMutation) LabelsJSONCleared() bool {
+	_, ok := m.clearedFields[bronzek8sworkload.FieldLabelsJSON]
+	return ok
+}
+
+// ResetLabelsJSON resets all changes to the "labels_json" field.
+func (m *BronzeK8sWorkloadMutation) ResetLabelsJSON() {
+	m.labels_json = nil
+	m.appendlabels_json = nil
+	delete(m.clearedFields, bronzek8sworkload.FieldLabelsJSON)
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (m *BronzeK8sWorkloadMutation) SetAPICreatedAt(t time.Time) {
+	m.api_created_at = &t
+}
+
+// APICreatedAt returns the value of the "api_created_at" field in the mutation.
+func (m *BronzeK8sWorkloadMutation) APICreatedAt() (r time.Time, exists bool) {
+	v := m.api_created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPICreatedAt returns the old "api_created_at" field's value of the BronzeK8sWorkload entity.
+// If the BronzeK8sWorkload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeK8sWorkloadMutation) OldAPICreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPICreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPICreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPICreatedAt: %w", err)
+	}
+	return oldValue.APICreatedAt, nil
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (m *BronzeK8sWorkloadMutation) ClearAPICreatedAt() {
+	m.api_created_at = nil
+	m.clearedFields[bronzek8sworkload.FieldAPICreatedAt] = struct{}{}
+}
+
+// APICreatedAtCleared returns if the "api_created_at" field was cleared in this mutation.
+func (m *BronzeK8sWorkloadMutation) APICreatedAtCleared() bool {
+	_, ok := m.clearedFields[bronzek8sworkload.FieldAPICreatedAt]
+	return ok
+}
+
+// ResetAPICreatedAt resets all changes to the "api_created_at" field.
+func (m *BronzeK8sWorkloadMutation) ResetAPICreatedAt() {
+	m.api_created_at = nil
+	delete(m.clearedFields, bronzek8sworkload.FieldAPICreatedAt)
+}
+
+// Where appends a list predicates to the BronzeK8sWorkloadMutation builder.
+func (m *BronzeK8sWorkloadMutation) Where(ps ...predicate.BronzeK8sWorkload) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeK8sWorkloadMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeK8sWorkloadMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeK8sWorkload, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeK8sWorkloadMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeK8sWorkloadMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeK8sWorkload).
+func (m *BronzeK8sWorkloadMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeK8sWorkloadMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.collected_at != nil {
+		fields = append(fields, bronzek8sworkload.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzek8sworkload.FieldFirstCollectedAt)
+	}
+	if m.cluster_name != nil {
+		fields = append(fields, bronzek8sworkload.FieldClusterName)
+	}
+	if m.namespace != nil {
+		fields = append(fields, bronzek8sworkload.FieldNamespace)
+	}
+	if m.kind != nil {
+		fields = append(fields, bronzek8sworkload.FieldKind)
+	}
+	if m.name != nil {
+		fields = append(fields, bronzek8sworkload.FieldName)
+	}
+	if m.uid != nil {
+		fields = append(fields, bronzek8sworkload.FieldUID)
+	}
+	if m.replicas != nil {
+		fields = append(fields, bronzek8sworkload.FieldReplicas)
+	}
+	if m.service_account != nil {
+		fields = append(fields, bronzek8sworkload.FieldServiceAccount)
+	}
+	if m.host_network != nil {
+		fields = append(fields, bronzek8sworkload.FieldHostNetwork)
+	}
+	if m.host_pid != nil {
+		fields = append(fields, bronzek8sworkload.FieldHostPid)
+	}
+	if m.host_ipc != nil {
+		fields = append(fields, bronzek8sworkload.FieldHostIpc)
+	}
+	if m.images_json != nil {
+		fields = append(fields, bronzek8sworkload.FieldImagesJSON)
+	}
+	if m.containers_json != nil {
+		fields = append(fields, bronzek8sworkload.FieldContainersJSON)
+	}
+	if m.selector_json != nil {
+		fields = append(fields, bronzek8sworkload.FieldSelectorJSON)
+	}
+	if m.labels_json != nil {
+		fields = append(fields, bronzek8sworkload.FieldLabelsJSON)
+	}
+	if m.api_created_at != nil {
+		fields = append(fields, bronzek8sworkload.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeK8sWorkloadMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzek8sworkload.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzek8sworkload.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzek8sworkload.FieldClusterName:
+		return m.ClusterName()
+	case bronzek8sworkload.FieldNamespace:
+		return m.Namespace()
+	case bronzek8sworkload.FieldKind:
+		return m.Kind()
+	case bronzek8sworkload.FieldName:
+		return m.Name()
+	case bronzek8sworkload.FieldUID:
+		return m.UID()
+	case bronzek8sworkload.FieldReplicas:
+		return m.Replicas()
+	case bronzek8sworkload.FieldServiceAccount:
+		return m.ServiceAccount()
+	case bronzek8sworkload.FieldHostNetwork:
+		return m.HostNetwork()
+	case bronzek8sworkload.FieldHostPid:
+		return m.HostPid()
+	case bronzek8sworkload.FieldHostIpc:
+		return m.HostIpc()
+	case bronzek8sworkload.FieldImagesJSON:
+		return m.ImagesJSON()
+	case bronzek8sworkload.FieldContainersJSON:
+		return m.ContainersJSON()
+	case bronzek8sworkload.FieldSelectorJSON:
+		return m.SelectorJSON()
+	case bronzek8sworkload.FieldLabelsJSON:
+		return m.LabelsJSON()
+	case bronzek8sworkload.FieldAPICreatedAt:
+		return m.APICreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeK8sWorkloadMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzek8sworkload.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzek8sworkload.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzek8sworkload.FieldClusterName:
+		return m.OldClusterName(ctx)
+	case bronzek8sworkload.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case bronzek8sworkload.FieldKind:
+		return m.OldKind(ctx)
+	case bronzek8sworkload.FieldName:
+		return m.OldName(ctx)
+	case bronzek8sworkload.FieldUID:
+		return m.OldUID(ctx)
+	case bronzek8sworkload.FieldReplicas:
+		return m.OldReplicas(ctx)
+	case bronzek8sworkload.FieldServiceAccount:
+		return m.OldServiceAccount(ctx)
+	case bronzek8sworkload.FieldHostNetwork:
+		return m.OldHostNetwork(ctx)
+	case bronzek8sworkload.FieldHostPid:
+		return m.OldHostPid(ctx)
+	case bronzek8sworkload.FieldHostIpc:
+		return m.OldHostIpc(ctx)
+	case bronzek8sworkload.FieldImagesJSON:
+		return m.OldImagesJSON(ctx)
+	case bronzek8sworkload.FieldContainersJSON:
+		return m.OldContainersJSON(ctx)
+	case bronzek8sworkload.FieldSelectorJSON:
+		return m.OldSelectorJSON(ctx)
+	case bronzek8sworkload.FieldLabelsJSON:
+		return m.OldLabelsJSON(ctx)
+	case bronzek8sworkload.FieldAPICreatedAt:
+		return m.OldAPICreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeK8sWorkload field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeK8sWorkloadMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzek8sworkload.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzek8sworkload.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzek8sworkload.FieldClusterName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterName(v)
+		return nil
+	case bronzek8sworkload.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case bronzek8sworkload.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case bronzek8sworkload.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case bronzek8sworkload.FieldUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUID(v)
+		return nil
+	case bronzek8sworkload.FieldReplicas:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplicas(v)
+		return nil
+	case bronzek8sworkload.FieldServiceAccount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServiceAccount(v)
+		return nil
+	case bronzek8sworkload.FieldHostNetwork:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostNetwork(v)
+		return nil
+	case bronzek8sworkload.FieldHostPid:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostPid(v)
+		return nil
+	case bronzek8sworkload.FieldHostIpc:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostIpc(v)
+		return nil
+	case bronzek8sworkload.FieldImagesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImagesJSON(v)
+		return nil
+	case bronzek8sworkload.FieldContainersJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContainersJSON(v)
+		return nil
+	case bronzek8sworkload.FieldSelectorJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSelectorJSON(v)
+		return nil
+	case bronzek8sworkload.FieldLabelsJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabelsJSON(v)
+		return nil
+	case bronzek8sworkload.FieldAPICreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPICreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sWorkload field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeK8sWorkloadMutation) AddedFields() []string {
+	var fields []string
+	if m.addreplicas != nil {
+		fields = append(fields, bronzek8sworkload.FieldReplicas)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeK8sWorkloadMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case bronzek8sworkload.FieldReplicas:
+		return m.AddedReplicas()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeK8sWorkloadMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case bronzek8sworkload.FieldReplicas:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReplicas(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sWorkload numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeK8sWorkloadMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzek8sworkload.FieldUID) {
+		fields = append(fields, bronzek8sworkload.FieldUID)
+	}
+	if m.FieldCleared(bronzek8sworkload.FieldReplicas) {
+		fields = append(fields, bronzek8sworkload.FieldReplicas)
+	}
+	if m.FieldCleared(bronzek8sworkload.FieldServiceAccount) {
+		fields = append(fields, bronzek8sworkload.FieldServiceAccount)
+	}
+	if m.FieldCleared(bronzek8sworkload.FieldImagesJSON) {
+		fields = append(fields, bronzek8sworkload.FieldImagesJSON)
+	}
+	if m.FieldCleared(bronzek8sworkload.FieldContainersJSON) {
+		fields = append(fields, bronzek8sworkload.FieldContainersJSON)
+	}
+	if m.FieldCleared(bronzek8sworkload.FieldSelectorJSON) {
+		fields = append(fields, bronzek8sworkload.FieldSelectorJSON)
+	}
+	if m.FieldCleared(bronzek8sworkload.FieldLabelsJSON) {
+		fields = append(fields, bronzek8sworkload.FieldLabelsJSON)
+	}
+	if m.FieldCleared(bronzek8sworkload.FieldAPICreatedAt) {
+		fields = append(fields, bronzek8sworkload.FieldAPICreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeK8sWorkloadMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeK8sWorkloadMutation) ClearField(name string) error {
+	switch name {
+	case bronzek8sworkload.FieldUID:
+		m.ClearUID()
+		return nil
+	case bronzek8sworkload.FieldReplicas:
+		m.ClearReplicas()
+		return nil
+	case bronzek8sworkload.FieldServiceAccount:
+		m.ClearServiceAccount()
+		return nil
+	case bronzek8sworkload.FieldImagesJSON:
+		m.ClearImagesJSON()
+		return nil
+	case bronzek8sworkload.FieldContainersJSON:
+		m.ClearContainersJSON()
+		return nil
+	case bronzek8sworkload.FieldSelectorJSON:
+		m.ClearSelectorJSON()
+		return nil
+	case bronzek8sworkload.FieldLabelsJSON:
+		m.ClearLabelsJSON()
+		return nil
+	case bronzek8sworkload.FieldAPICreatedAt:
+		m.ClearAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sWorkload nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeK8sWorkloadMutation) ResetField(name string) error {
+	switch name {
+	case bronzek8sworkload.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzek8sworkload.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzek8sworkload.FieldClusterName:
+		m.ResetClusterName()
+		return nil
+	case bronzek8sworkload.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case bronzek8sworkload.FieldKind:
+		m.ResetKind()
+		return nil
+	case bronzek8sworkload.FieldName:
+		m.ResetName()
+		return nil
+	case bronzek8sworkload.FieldUID:
+		m.ResetUID()
+		return nil
+	case bronzek8sworkload.FieldReplicas:
+		m.ResetReplicas()
+		return nil
+	case bronzek8sworkload.FieldServiceAccount:
+		m.ResetServiceAccount()
+		return nil
+	case bronzek8sworkload.FieldHostNetwork:
+		m.ResetHostNetwork()
+		return nil
+	case bronzek8sworkload.FieldHostPid:
+		m.ResetHostPid()
+		return nil
+	case bronzek8sworkload.FieldHostIpc:
+		m.ResetHostIpc()
+		return nil
+	case bronzek8sworkload.FieldImagesJSON:
+		m.ResetImagesJSON()
+		return nil
+	case bronzek8sworkload.FieldContainersJSON:
+		m.ResetContainersJSON()
+		return nil
+	case bronzek8sworkload.FieldSelectorJSON:
+		m.ResetSelectorJSON()
+		return nil
+	case bronzek8sworkload.FieldLabelsJSON:
+		m.ResetLabelsJSON()
+		return nil
+	case bronzek8sworkload.FieldAPICreatedAt:
+		m.ResetAPICreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeK8sWorkload field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeK8sWorkloadMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeK8sWorkloadMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeK8sWorkloadMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeK8sWorkloadMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeK8sWorkloadMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeK8sWorkloadMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeK8sWorkloadMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeK8sWorkload unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeK8sWorkloadMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeK8sWorkload edge %s", name)
+}
@@ -0,0 +1,55 @@
+// Code generated by ent, DO NOT EDIT.
+
+package predicate
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryK8sImage is the predicate function for bronzehistoryk8simage builders.
+type BronzeHistoryK8sImage func(*sql.Selector)
+
+// BronzeHistoryK8sNamespace is the predicate function for bronzehistoryk8snamespace builders.
+type BronzeHistoryK8sNamespace func(*sql.Selector)
+
+// BronzeHistoryK8sNetworkPolicy is the predicate function for bronzehistoryk8snetworkpolicy builders.
+type BronzeHistoryK8sNetworkPolicy func(*sql.Selector)
+
+// BronzeHistoryK8sNode is the predicate function for bronzehistoryk8snode builders.
+type BronzeHistoryK8sNode func(*sql.Selector)
+
+// BronzeHistoryK8sPod is the predicate function for bronzehistoryk8spod builders.
+type BronzeHistoryK8sPod func(*sql.Selector)
+
+// BronzeHistoryK8sRoleBinding is the predicate function for bronzehistoryk8srolebinding builders.
+type BronzeHistoryK8sRoleBinding func(*sql.Selector)
+
+// BronzeHistoryK8sServiceAccount is the predicate function for bronzehistoryk8sserviceaccount builders.
+type BronzeHistoryK8sServiceAccount func(*sql.Selector)
+
+// BronzeHistoryK8sWorkload is the predicate function for bronzehistoryk8sworkload builders.
+type BronzeHistoryK8sWorkload func(*sql.Selector)
+
+// BronzeK8sImage is the predicate function for bronzek8simage builders.
+type BronzeK8sImage func(*sql.Selector)
+
+// BronzeK8sNamespace is the predicate function for bronzek8snamespace builders.
+type BronzeK8sNamespace func(*sql.Selector)
+
+// BronzeK8sNetworkPolicy is the predicate function for bronzek8snetworkpolicy builders.
+type BronzeK8sNetworkPolicy func(*sql.Selector)
+
+// BronzeK8sNode is the predicate function for bronzek8snode builders.
+type BronzeK8sNode func(*sql.Selector)
+
+// BronzeK8sPod is the predicate function for bronzek8spod builders.
+type BronzeK8sPod func(*sql.Selector)
+
+// BronzeK8sRoleBinding is the predicate function for bronzek8srolebinding builders.
+type BronzeK8sRoleBinding func(*sql.Selector)
+
+// BronzeK8sServiceAccount is the predicate function for bronzek8sserviceaccount builders.
+type BronzeK8sServiceAccount func(*sql.Selector)
+
+// BronzeK8sWorkload is the predicate function for bronzek8sworkload builders.
+type BronzeK8sWorkload func(*sql.Selector)
@@ -0,0 +1,341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kubernetes
+
+import (
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8simage"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snamespace"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snetworkpolicy"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8snode"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8spod"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8srolebinding"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8sserviceaccount"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzehistoryk8sworkload"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8simage"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snamespace"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snetworkpolicy"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8snode"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8spod"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8srolebinding"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8sserviceaccount"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/bronzek8sworkload"
+	"danny.vn/hotpot/pkg/storage/ent/kubernetes/schema"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	bronzehistoryk8simageFields := schema.BronzeHistoryK8sImage{}.Fields()
+	_ = bronzehistoryk8simageFields
+	// bronzehistoryk8simageDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryk8simageDescResourceID := bronzehistoryk8simageFields[1].Descriptor()
+	// bronzehistoryk8simage.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryk8simage.ResourceIDValidator = bronzehistoryk8simageDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryk8simageDescClusterName is the schema descriptor for cluster_name field.
+	bronzehistoryk8simageDescClusterName := bronzehistoryk8simageFields[2].Descriptor()
+	// bronzehistoryk8simage.ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	bronzehistoryk8simage.ClusterNameValidator = bronzehistoryk8simageDescClusterName.Validators[0].(func(string) error)
+	// bronzehistoryk8simageDescRegistry is the schema descriptor for registry field.
+	bronzehistoryk8simageDescRegistry := bronzehistoryk8simageFields[3].Descriptor()
+	// bronzehistoryk8simage.RegistryValidator is a validator for the "registry" field. It is called by the builders before save.
+	bronzehistoryk8simage.RegistryValidator = bronzehistoryk8simageDescRegistry.Validators[0].(func(string) error)
+	// bronzehistoryk8simageDescRepository is the schema descriptor for repository field.
+	bronzehistoryk8simageDescRepository := bronzehistoryk8simageFields[4].Descriptor()
+	// bronzehistoryk8simage.RepositoryValidator is a validator for the "repository" field. It is called by the builders before save.
+	bronzehistoryk8simage.RepositoryValidator = bronzehistoryk8simageDescRepository.Validators[0].(func(string) error)
+	bronzehistoryk8snamespaceFields := schema.BronzeHistoryK8sNamespace{}.Fields()
+	_ = bronzehistoryk8snamespaceFields
+	// bronzehistoryk8snamespaceDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryk8snamespaceDescResourceID := bronzehistoryk8snamespaceFields[1].Descriptor()
+	// bronzehistoryk8snamespace.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryk8snamespace.ResourceIDValidator = bronzehistoryk8snamespaceDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryk8snamespaceDescClusterName is the schema descriptor for cluster_name field.
+	bronzehistoryk8snamespaceDescClusterName := bronzehistoryk8snamespaceFields[2].Descriptor()
+	// bronzehistoryk8snamespace.ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	bronzehistoryk8snamespace.ClusterNameValidator = bronzehistoryk8snamespaceDescClusterName.Validators[0].(func(string) error)
+	// bronzehistoryk8snamespaceDescName is the schema descriptor for name field.
+	bronzehistoryk8snamespaceDescName := bronzehistoryk8snamespaceFields[3].Descriptor()
+	// bronzehistoryk8snamespace.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	bronzehistoryk8snamespace.NameValidator = bronzehistoryk8snamespaceDescName.Validators[0].(func(string) error)
+	bronzehistoryk8snetworkpolicyFields := schema.BronzeHistoryK8sNetworkPolicy{}.Fields()
+	_ = bronzehistoryk8snetworkpolicyFields
+	// bronzehistoryk8snetworkpolicyDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryk8snetworkpolicyDescResourceID := bronzehistoryk8snetworkpolicyFields[1].Descriptor()
+	// bronzehistoryk8snetworkpolicy.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryk8snetworkpolicy.ResourceIDValidator = bronzehistoryk8snetworkpolicyDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryk8snetworkpolicyDescClusterName is the schema descriptor for cluster_name field.
+	bronzehistoryk8snetworkpolicyDescClusterName := bronzehistoryk8snetworkpolicyFields[2].Descriptor()
+	// bronzehistoryk8snetworkpolicy.ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	bronzehistoryk8snetworkpolicy.ClusterNameValidator = bronzehistoryk8snetworkpolicyDescClusterName.Validators[0].(func(string) error)
+	// bronzehistoryk8snetworkpolicyDescNamespace is the schema descriptor for namespace field.
+	bronzehistoryk8snetworkpolicyDescNamespace := bronzehistoryk8snetworkpolicyFields[3].Descriptor()
+	// bronzehistoryk8snetworkpolicy.NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	bronzehistoryk8snetworkpolicy.NamespaceValidator = bronzehistoryk8snetworkpolicyDescNamespace.Validators[0].(func(string) error)
+	// bronzehistoryk8snetworkpolicyDescName is the schema descriptor for name field.
+	bronzehistoryk8snetworkpolicyDescName := bronzehistoryk8snetworkpolicyFields[4].Descriptor()
+	// bronzehistoryk8snetworkpolicy.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	bronzehistoryk8snetworkpolicy.NameValidator = bronzehistoryk8snetworkpolicyDescName.Validators[0].(func(string) error)
+	bronzehistoryk8snodeFields := schema.BronzeHistoryK8sNode{}.Fields()
+	_ = bronzehistoryk8snodeFields
+	// bronzehistoryk8snodeDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryk8snodeDescResourceID := bronzehistoryk8snodeFields[1].Descriptor()
+	// bronzehistoryk8snode.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryk8snode.ResourceIDValidator = bronzehistoryk8snodeDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryk8snodeDescClusterName is the schema descriptor for cluster_name field.
+	bronzehistoryk8snodeDescClusterName := bronzehistoryk8snodeFields[2].Descriptor()
+	// bronzehistoryk8snode.ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	bronzehistoryk8snode.ClusterNameValidator = bronzehistoryk8snodeDescClusterName.Validators[0].(func(string) error)
+	// bronzehistoryk8snodeDescName is the schema descriptor for name field.
+	bronzehistoryk8snodeDescName := bronzehistoryk8snodeFields[3].Descriptor()
+	// bronzehistoryk8snode.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	bronzehistoryk8snode.NameValidator = bronzehistoryk8snodeDescName.Validators[0].(func(string) error)
+	// bronzehistoryk8snodeDescReady is the schema descriptor for ready field.
+	bronzehistoryk8snodeDescReady := bronzehistoryk8snodeFields[17].Descriptor()
+	// bronzehistoryk8snode.DefaultReady holds the default value on creation for the ready field.
+	bronzehistoryk8snode.DefaultReady = bronzehistoryk8snodeDescReady.Default.(bool)
+	// bronzehistoryk8snodeDescUnschedulable is the schema descriptor for unschedulable field.
+	bronzehistoryk8snodeDescUnschedulable := bronzehistoryk8snodeFields[18].Descriptor()
+	// bronzehistoryk8snode.DefaultUnschedulable holds the default value on creation for the unschedulable field.
+	bronzehistoryk8snode.DefaultUnschedulable = bronzehistoryk8snodeDescUnschedulable.Default.(bool)
+	bronzehistoryk8spodFields := schema.BronzeHistoryK8sPod{}.Fields()
+	_ = bronzehistoryk8spodFields
+	// bronzehistoryk8spodDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryk8spodDescResourceID := bronzehistoryk8spodFields[1].Descriptor()
+	// bronzehistoryk8spod.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryk8spod.ResourceIDValidator = bronzehistoryk8spodDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryk8spodDescClusterName is the schema descriptor for cluster_name field.
+	bronzehistoryk8spodDescClusterName := bronzehistoryk8spodFields[2].Descriptor()
+	// bronzehistoryk8spod.ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	bronzehistoryk8spod.ClusterNameValidator = bronzehistoryk8spodDescClusterName.Validators[0].(func(string) error)
+	// bronzehistoryk8spodDescNamespace is the schema descriptor for namespace field.
+	bronzehistoryk8spodDescNamespace := bronzehistoryk8spodFields[3].Descriptor()
+	// bronzehistoryk8spod.NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	bronzehistoryk8spod.NamespaceValidator = bronzehistoryk8spodDescNamespace.Validators[0].(func(string) error)
+	// bronzehistoryk8spodDescName is the schema descriptor for name field.
+	bronzehistoryk8spodDescName := bronzehistoryk8spodFields[4].Descriptor()
+	// bronzehistoryk8spod.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	bronzehistoryk8spod.NameValidator = bronzehistoryk8spodDescName.Validators[0].(func(string) error)
+	// bronzehistoryk8spodDescHostNetwork is the schema descriptor for host_network field.
+	bronzehistoryk8spodDescHostNetwork := bronzehistoryk8spodFields[13].Descriptor()
+	// bronzehistoryk8spod.DefaultHostNetwork holds the default value on creation for the host_network field.
+	bronzehistoryk8spod.DefaultHostNetwork = bronzehistoryk8spodDescHostNetwork.Default.(bool)
+	bronzehistoryk8srolebindingFields := schema.BronzeHistoryK8sRoleBinding{}.Fields()
+	_ = bronzehistoryk8srolebindingFields
+	// bronzehistoryk8srolebindingDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryk8srolebindingDescResourceID := bronzehistoryk8srolebindingFields[1].Descriptor()
+	// bronzehistoryk8srolebinding.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryk8srolebinding.ResourceIDValidator = bronzehistoryk8srolebindingDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryk8srolebindingDescClusterName is the schema descriptor for cluster_name field.
+	bronzehistoryk8srolebindingDescClusterName := bronzehistoryk8srolebindingFields[2].Descriptor()
+	// bronzehistoryk8srolebinding.ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	bronzehistoryk8srolebinding.ClusterNameValidator = bronzehistoryk8srolebindingDescClusterName.Validators[0].(func(string) error)
+	// bronzehistoryk8srolebindingDescKind is the schema descriptor for kind field.
+	bronzehistoryk8srolebindingDescKind := bronzehistoryk8srolebindingFields[3].Descriptor()
+	// bronzehistoryk8srolebinding.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	bronzehistoryk8srolebinding.KindValidator = bronzehistoryk8srolebindingDescKind.Validators[0].(func(string) error)
+	// bronzehistoryk8srolebindingDescName is the schema descriptor for name field.
+	bronzehistoryk8srolebindingDescName := bronzehistoryk8srolebindingFields[5].Descriptor()
+	// bronzehistoryk8srolebinding.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	bronzehistoryk8srolebinding.NameValidator = bronzehistoryk8srolebindingDescName.Validators[0].(func(string) error)
+	// bronzehistoryk8srolebindingDescRoleKind is the schema descriptor for role_kind field.
+	bronzehistoryk8srolebindingDescRoleKind := bronzehistoryk8srolebindingFields[7].Descriptor()
+	// bronzehistoryk8srolebinding.RoleKindValidator is a validator for the "role_kind" field. It is called by the builders before save.
+	bronzehistoryk8srolebinding.RoleKindValidator = bronzehistoryk8srolebindingDescRoleKind.Validators[0].(func(string) error)
+	// bronzehistoryk8srolebindingDescRoleName is the schema descriptor for role_name field.
+	bronzehistoryk8srolebindingDescRoleName := bronzehistoryk8srolebindingFields[8].Descriptor()
+	// bronzehistoryk8srolebinding.RoleNameValidator is a validator for the "role_name" field. It is called by the builders before save.
+	bronzehistoryk8srolebinding.RoleNameValidator = bronzehistoryk8srolebindingDescRoleName.Validators[0].(func(string) error)
+	bronzehistoryk8sserviceaccountFields := schema.BronzeHistoryK8sServiceAccount{}.Fields()
+	_ = bronzehistoryk8sserviceaccountFields
+	// bronzehistoryk8sserviceaccountDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryk8sserviceaccountDescResourceID := bronzehistoryk8sserviceaccountFields[1].Descriptor()
+	// bronzehistoryk8sserviceaccount.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryk8sserviceaccount.ResourceIDValidator = bronzehistoryk8sserviceaccountDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryk8sserviceaccountDescClusterName is the schema descriptor for cluster_name field.
+	bronzehistoryk8sserviceaccountDescClusterName := bronzehistoryk8sserviceaccountFields[2].Descriptor()
+	// bronzehistoryk8sserviceaccount.ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	bronzehistoryk8sserviceaccount.ClusterNameValidator = bronzehistoryk8sserviceaccountDescClusterName.Validators[0].(func(string) error)
+	// bronzehistoryk8sserviceaccountDescNamespace is the schema descriptor for namespace field.
+	bronzehistoryk8sserviceaccountDescNamespace := bronzehistoryk8sserviceaccountFields[3].Descriptor()
+	// bronzehistoryk8sserviceaccount.NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	bronzehistoryk8sserviceaccount.NamespaceValidator = bronzehistoryk8sserviceaccountDescNamespace.Validators[0].(func(string) error)
+	// bronzehistoryk8sserviceaccountDescName is the schema descriptor for name field.
+	bronzehistoryk8sserviceaccountDescName := bronzehistoryk8sserviceaccountFields[4].Descriptor()
+	// bronzehistoryk8sserviceaccount.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	bronzehistoryk8sserviceaccount.NameValidator = bronzehistoryk8sserviceaccountDescName.Validators[0].(func(string) error)
+	bronzehistoryk8sworkloadFields := schema.BronzeHistoryK8sWorkload{}.Fields()
+	_ = bronzehistoryk8sworkloadFields
+	// bronzehistoryk8sworkloadDescResourceID is the schema descriptor for resource_id field.
+	bronzehistoryk8sworkloadDescResourceID := bronzehistoryk8sworkloadFields[1].Descriptor()
+	// bronzehistoryk8sworkload.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistoryk8sworkload.ResourceIDValidator = bronzehistoryk8sworkloadDescResourceID.Validators[0].(func(string) error)
+	// bronzehistoryk8sworkloadDescClusterName is the schema descriptor for cluster_name field.
+	bronzehistoryk8sworkloadDescClusterName := bronzehistoryk8sworkloadFields[2].Descriptor()
+	// bronzehistoryk8sworkload.ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	bronzehistoryk8sworkload.ClusterNameValidator = bronzehistoryk8sworkloadDescClusterName.Validators[0].(func(string) error)
+	// bronzehistoryk8sworkloadDescNamespace is the schema descriptor for namespace field.
+	bronzehistoryk8sworkloadDescNamespace := bronzehistoryk8sworkloadFields[3].Descriptor()
+	// bronzehistoryk8sworkload.NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	bronzehistoryk8sworkload.NamespaceValidator = bronzehistoryk8sworkloadDescNamespace.Validators[0].(func(string) error)
+	// bronzehistoryk8sworkloadDescKind is the schema descriptor for kind field.
+	bronzehistoryk8sworkloadDescKind := bronzehistoryk8sworkloadFields[4].Descriptor()
+	// bronzehistoryk8sworkload.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	bronzehistoryk8sworkload.KindValidator = bronzehistoryk8sworkloadDescKind.Validators[0].(func(string) error)
+	// bronzehistoryk8sworkloadDescName is the schema descriptor for name field.
+	bronzehistoryk8sworkloadDescName := bronzehistoryk8sworkloadFields[5].Descriptor()
+	// bronzehistoryk8sworkload.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	bronzehistoryk8sworkload.NameValidator = bronzehistoryk8sworkloadDescName.Validators[0].(func(string) error)
+	// bronzehistoryk8sworkloadDescHostNetwork is the schema descriptor for host_network field.
+	bronzehistoryk8sworkloadDescHostNetwork := bronzehistoryk8sworkloadFields[9].Descriptor()
+	// bronzehistoryk8sworkload.DefaultHostNetwork holds the default value on creation for the host_network field.
+	bronzehistoryk8sworkload.DefaultHostNetwork = bronzehistoryk8sworkloadDescHostNetwork.Default.(bool)
+	// bronzehistoryk8sworkloadDescHostPid is the schema descriptor for host_pid field.
+	bronzehistoryk8sworkloadDescHostPid := bronzehistoryk8sworkloadFields[10].Descriptor()
+	// bronzehistoryk8sworkload.DefaultHostPid holds the default value on creation for the host_pid field.
+	bronzehistoryk8sworkload.DefaultHostPid = bronzehistoryk8sworkloadDescHostPid.Default.(bool)
+	// bronzehistoryk8sworkloadDescHostIpc is the schema descriptor for host_ipc field.
+	bronzehistoryk8sworkloadDescHostIpc := bronzehistoryk8sworkloadFields[11].Descriptor()
+	// bronzehistoryk8sworkload.DefaultHostIpc holds the default value on creation for the host_ipc field.
+	bronzehistoryk8sworkload.DefaultHostIpc = bronzehistoryk8sworkloadDescHostIpc.Default.(bool)
+	bronzek8simageFields := schema.BronzeK8sImage{}.Fields()
+	_ = bronzek8simageFields
+	// bronzek8simageDescClusterName is the schema descriptor for cluster_name field.
+	bronzek8simageDescClusterName := bronzek8simageFields[1].Descriptor()
+	// bronzek8simage.ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	bronzek8simage.ClusterNameValidator = bronzek8simageDescClusterName.Validators[0].(func(string) error)
+	// bronzek8simageDescRegistry is the schema descriptor for registry field.
+	bronzek8simageDescRegistry := bronzek8simageFields[2].Descriptor()
+	// bronzek8simage.RegistryValidator is a validator for the "registry" field. It is called by the builders before save.
+	bronzek8simage.RegistryValidator = bronzek8simageDescRegistry.Validators[0].(func(string) error)
+	// bronzek8simageDescRepository is the schema descriptor for repository field.
+	bronzek8simageDescRepository := bronzek8simageFields[3].Descriptor()
+	// bronzek8simage.RepositoryValidator is a validator for the "repository" field. It is called by the builders before save.
+	bronzek8simage.RepositoryValidator = bronzek8simageDescRepository.Validators[0].(func(string) error)
+	bronzek8snamespaceFields := schema.BronzeK8sNamespace{}.Fields()
+	_ = bronzek8snamespaceFields
+	// bronzek8snamespaceDescClusterName is the schema descriptor for cluster_name field.
+	bronzek8snamespaceDescClusterName := bronzek8snamespaceFields[1].Descriptor()
+	// bronzek8snamespace.ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	bronzek8snamespace.ClusterNameValidator = bronzek8snamespaceDescClusterName.Validators[0].(func(string) error)
+	// bronzek8snamespaceDescName is the schema descriptor for name field.
+	bronzek8snamespaceDescName := bronzek8snamespaceFields[2].Descriptor()
+	// bronzek8snamespace.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	bronzek8snamespace.NameValidator = bronzek8snamespaceDescName.Validators[0].(func(string) error)
+	bronzek8snetworkpolicyFields := schema.BronzeK8sNetworkPolicy{}.Fields()
+	_ = bronzek8snetworkpolicyFields
+	// bronzek8snetworkpolicyDescClusterName is the schema descriptor for cluster_name field.
+	bronzek8snetworkpolicyDescClusterName := bronzek8snetworkpolicyFields[1].Descriptor()
+	// bronzek8snetworkpolicy.ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	bronzek8snetworkpolicy.ClusterNameValidator = bronzek8snetworkpolicyDescClusterName.Validators[0].(func(string) error)
+	// bronzek8snetworkpolicyDescNamespace is the schema descriptor for namespace field.
+	bronzek8snetworkpolicyDescNamespace := bronzek8snetworkpolicyFields[2].Descriptor()
+	// bronzek8snetworkpolicy.NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	bronzek8snetworkpolicy.NamespaceValidator = bronzek8snetworkpolicyDescNamespace.Validators[0].(func(string) error)
+	// bronzek8snetworkpolicyDescName is the schema descriptor for name field.
+	bronzek8snetworkpolicyDescName := bronzek8snetworkpolicyFields[3].Descriptor()
+	// bronzek8snetworkpolicy.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	bronzek8snetworkpolicy.NameValidator = bronzek8snetworkpolicyDescName.Validators[0].(func(string) error)
+	bronzek8snodeFields := schema.BronzeK8sNode{}.Fields()
+	_ = bronzek8snodeFields
+	// bronzek8snodeDescClusterName is the schema descriptor for cluster_name field.
+	bronzek8snodeDescClusterName := bronzek8snodeFields[1].Descriptor()
+	// bronzek8snode.ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	bronzek8snode.ClusterNameValidator = bronzek8snodeDescClusterName.Validators[0].(func(string) error)
+	// bronzek8snodeDescName is the schema descriptor for name field.
+	bronzek8snodeDescName := bronzek8snodeFields[2].Descriptor()
+	// bronzek8snode.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	bronzek8snode.NameValidator = bronzek8snodeDescName.Validators[0].(func(string) error)
+	// bronzek8snodeDescReady is the schema descriptor for ready field.
+	bronzek8snodeDescReady := bronzek8snodeFields[16].Descriptor()
+	// bronzek8snode.DefaultReady holds the default value on creation for the ready field.
+	bronzek8snode.DefaultReady = bronzek8snodeDescReady.Default.(bool)
+	// bronzek8snodeDescUnschedulable is the schema descriptor for unschedulable field.
+	bronzek8snodeDescUnschedulable := bronzek8snodeFields[17].Descriptor()
+	// bronzek8snode.DefaultUnschedulable holds the default value on creation for the unschedulable field.
+	bronzek8snode.DefaultUnschedulable = bronzek8snodeDescUnschedulable.Default.(bool)
+	bronzek8spodFields := schema.BronzeK8sPod{}.Fields()
+	_ = bronzek8spodFields
+	// bronzek8spodDescClusterName is the schema descriptor for cluster_name field.
+	bronzek8spodDescClusterName := bronzek8spodFields[1].Descriptor()
+	// bronzek8spod.ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	bronzek8spod.ClusterNameValidator = bronzek8spodDescClusterName.Validators[0].(func(string) error)
+	// bronzek8spodDescNamespace is the schema descriptor for namespace field.
+	bronzek8spodDescNamespace := bronzek8spodFields[2].Descriptor()
+	// bronzek8spod.NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	bronzek8spod.NamespaceValidator = bronzek8spodDescNamespace.Validators[0].(func(string) error)
+	// bronzek8spodDescName is the schema descriptor for name field.
+	bronzek8spodDescName := bronzek8spodFields[3].Descriptor()
+	// bronzek8spod.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	bronzek8spod.NameValidator = bronzek8spodDescName.Validators[0].(func(string) error)
+	// bronzek8spodDescHostNetwork is the schema descriptor for host_network field.
+	bronzek8spodDescHostNetwork := bronzek8spodFields[12].Descriptor()
+	// bronzek8spod.DefaultHostNetwork holds the default value on creation for the host_network field.
+	bronzek8spod.DefaultHostNetwork = bronzek8spodDescHostNetwork.Default.(bool)
+	bronzek8srolebindingFields := schema.BronzeK8sRoleBinding{}.Fields()
+	_ = bronzek8srolebindingFields
+	// bronzek8srolebindingDescClusterName is the schema descriptor for cluster_name field.
+	bronzek8srolebindingDescClusterName := bronzek8srolebindingFields[1].Descriptor()
+	// bronzek8srolebinding.ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	bronzek8srolebinding.ClusterNameValidator = bronzek8srolebindingDescClusterName.Validators[0].(func(string) error)
+	// bronzek8srolebindingDescKind is the schema descriptor for kind field.
+	bronzek8srolebindingDescKind := bronzek8srolebindingFields[2].Descriptor()
+	// bronzek8srolebinding.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	bronzek8srolebinding.KindValidator = bronzek8srolebindingDescKind.Validators[0].(func(string) error)
+	// bronzek8srolebindingDescName is the schema descriptor for name field.
+	bronzek8srolebindingDescName := bronzek8srolebindingFields[4].Descriptor()
+	// bronzek8srolebinding.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	bronzek8srolebinding.NameValidator = bronzek8srolebindingDescName.Validators[0].(func(string) error)
+	// bronzek8srolebindingDescRoleKind is the schema descriptor for role_kind field.
+	bronzek8srolebindingDescRoleKind := bronzek8srolebindingFields[6].Descriptor()
+	// bronzek8srolebinding.RoleKindValidator is a validator for the "role_kind" field. It is called by the builders before save.
+	bronzek8srolebinding.RoleKindValidator = bronzek8srolebindingDescRoleKind.Validators[0].(func(string) error)
+	// bronzek8srolebindingDescRoleName is the schema descriptor for role_name field.
+	bronzek8srolebindingDescRoleName := bronzek8srolebindingFields[7].Descriptor()
+	// bronzek8srolebinding.RoleNameValidator is a validator for the "role_name" field. It is called by the builders before save.
+	bronzek8srolebinding.RoleNameValidator = bronzek8srolebindingDescRoleName.Validators[0].(func(string) error)
+	bronzek8sserviceaccountFields := schema.BronzeK8sServiceAccount{}.Fields()
+	_ = bronzek8sserviceaccountFields
+	// bronzek8sserviceaccountDescClusterName is the schema descriptor for cluster_name field.
+	bronzek8sserviceaccountDescClusterName := bronzek8sserviceaccountFields[1].Descriptor()
+	// bronzek8sserviceaccount.ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	bronzek8sserviceaccount.ClusterNameValidator = bronzek8sserviceaccountDescClusterName.Validators[0].(func(string) error)
+	// bronzek8sserviceaccountDescNamespace is the schema descriptor for namespace field.
+	bronzek8sserviceaccountDescNamespace := bronzek8sserviceaccountFields[2].Descriptor()
+	// bronzek8sserviceaccount.NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	bronzek8sserviceaccount.NamespaceValidator = bronzek8sserviceaccountDescNamespace.Validators[0].(func(string) error)
+	// bronzek8sserviceaccountDescName is the schema descriptor for name field.
+	bronzek8sserviceaccountDescName := bronzek8sserviceaccountFields[3].Descriptor()
+	// bronzek8sserviceaccount.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	bronzek8sserviceaccount.NameValidator = bronzek8sserviceaccountDescName.Validators[0].(func(string) error)
+	bronzek8sworkloadFields := schema.BronzeK8sWorkload{}.Fields()
+	_ = bronzek8sworkloadFields
+	// bronzek8sworkloadDescClusterName is the schema descriptor for cluster_name field.
+	bronzek8sworkloadDescClusterName := bronzek8sworkloadFields[1].Descriptor()
+	// bronzek8sworkload.ClusterNameValidator is a validator for the "cluster_name" field. It is called by the builders before save.
+	bronzek8sworkload.ClusterNameValidator = bronzek8sworkloadDescClusterName.Validators[0].(func(string) error)
+	// bronzek8sworkloadDescNamespace is the schema descriptor for namespace field.
+	bronzek8sworkloadDescNamespace := bronzek8sworkloadFields[2].Descriptor()
+	// bronzek8sworkload.NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	bronzek8sworkload.NamespaceValidator = bronzek8sworkloadDescNamespace.Validators[0].(func(string) error)
+	// bronzek8sworkloadDescKind is the schema descriptor for kind field.
+	bronzek8sworkloadDescKind := bronzek8sworkloadFields[3].Descriptor()
+	// bronzek8sworkload.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	bronzek8sworkload.KindValidator = bronzek8sworkloadDescKind.Validators[0].(func(string) error)
+	// bronzek8sworkloadDescName is the schema descriptor for name field.
+	bronzek8sworkloadDescName := bronzek8sworkloadFields[4].Descriptor()
+	// bronzek8sworkload.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	bronzek8sworkload.NameValidator = bronzek8sworkloadDescName.Validators[0].(func(string) error)
+	// bronzek8sworkloadDescHostNetwork is the schema descriptor for host_network field.
+	bronzek8sworkloadDescHostNetwork := bronzek8sworkloadFields[8].Descriptor()
+	// bronzek8sworkload.DefaultHostNetwork holds the default value on creation for the host_network field.
+	bronzek8sworkload.DefaultHostNetwork = bronzek8sworkloadDescHostNetwork.Default.(bool)
+	// bronzek8sworkloadDescHostPid is the schema descriptor for host_pid field.
+	bronzek8sworkloadDescHostPid := bronzek8sworkloadFields[9].Descriptor()
+	// bronzek8sworkload.DefaultHostPid holds the default value on creation for the host_pid field.
+	bronzek8sworkload.DefaultHostPid = bronzek8sworkloadDescHostPid.Default.(bool)
+	// bronzek8sworkloadDescHostIpc is the schema descriptor for host_ipc field.
+	bronzek8sworkloadDescHostIpc := bronzek8sworkloadFields[10].Descriptor()
+	// bronzek8sworkload.DefaultHostIpc holds the default value on creation for the host_ipc field.
+	bronzek8sworkload.DefaultHostIpc = bronzek8sworkloadDescHostIpc.Default.(bool)
+}
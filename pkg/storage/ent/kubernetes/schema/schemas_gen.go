@@ -0,0 +1,71 @@
+// Code generated by entcgen. DO NOT EDIT.
+package schema
+
+import (
+	bronze_kubernetes "danny.vn/hotpot/pkg/schema/bronze/kubernetes"
+	bronzehistory_kubernetes "danny.vn/hotpot/pkg/schema/bronzehistory/kubernetes"
+)
+
+type BronzeK8sImage struct {
+	bronze_kubernetes.BronzeK8sImage
+}
+
+type BronzeK8sNamespace struct {
+	bronze_kubernetes.BronzeK8sNamespace
+}
+
+type BronzeK8sNetworkPolicy struct {
+	bronze_kubernetes.BronzeK8sNetworkPolicy
+}
+
+type BronzeK8sNode struct {
+	bronze_kubernetes.BronzeK8sNode
+}
+
+type BronzeK8sPod struct {
+	bronze_kubernetes.BronzeK8sPod
+}
+
+type BronzeK8sRoleBinding struct {
+	bronze_kubernetes.BronzeK8sRoleBinding
+}
+
+type BronzeK8sServiceAccount struct {
+	bronze_kubernetes.BronzeK8sServiceAccount
+}
+
+type BronzeK8sWorkload struct {
+	bronze_kubernetes.BronzeK8sWorkload
+}
+
+type BronzeHistoryK8sImage struct {
+	bronzehistory_kubernetes.BronzeHistoryK8sImage
+}
+
+type BronzeHistoryK8sNamespace struct {
+	bronzehistory_kubernetes.BronzeHistoryK8sNamespace
+}
+
+type BronzeHistoryK8sNetworkPolicy struct {
+	bronzehistory_kubernetes.BronzeHistoryK8sNetworkPolicy
+}
+
+type BronzeHistoryK8sNode struct {
+	bronzehistory_kubernetes.BronzeHistoryK8sNode
+}
+
+type BronzeHistoryK8sPod struct {
+	bronzehistory_kubernetes.BronzeHistoryK8sPod
+}
+
+type BronzeHistoryK8sRoleBinding struct {
+	bronzehistory_kubernetes.BronzeHistoryK8sRoleBinding
+}
+
+type BronzeHistoryK8sServiceAccount struct {
+	bronzehistory_kubernetes.BronzeHistoryK8sServiceAccount
+}
+
+type BronzeHistoryK8sWorkload struct {
+	bronzehistory_kubernetes.BronzeHistoryK8sWorkload
+}
@@ -0,0 +1,24 @@
+// Code generated by entcgen. DO NOT EDIT.
+package kubernetes
+
+// DefaultSchemaConfig returns the schema config mapping each type to its PG schema.
+func DefaultSchemaConfig() SchemaConfig {
+	return SchemaConfig{
+		BronzeK8sImage:                 "bronze",
+		BronzeK8sNamespace:             "bronze",
+		BronzeK8sNetworkPolicy:         "bronze",
+		BronzeK8sNode:                  "bronze",
+		BronzeK8sPod:                   "bronze",
+		BronzeK8sRoleBinding:           "bronze",
+		BronzeK8sServiceAccount:        "bronze",
+		BronzeK8sWorkload:              "bronze",
+		BronzeHistoryK8sImage:          "bronze_history",
+		BronzeHistoryK8sNamespace:      "bronze_history",
+		BronzeHistoryK8sNetworkPolicy:  "bronze_history",
+		BronzeHistoryK8sNode:           "bronze_history",
+		BronzeHistoryK8sPod:            "bronze_history",
+		BronzeHistoryK8sRoleBinding:    "bronze_history",
+		BronzeHistoryK8sServiceAccount: "bronze_history",
+		BronzeHistoryK8sWorkload:       "bronze_history",
+	}
+}
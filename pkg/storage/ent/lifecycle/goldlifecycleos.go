@@ -21,6 +21,18 @@ type GoldLifecycleOS struct {
 	DetectedAt time.Time `json:"detected_at,omitempty"`
 	// FirstDetectedAt holds the value of the "first_detected_at" field.
 	FirstDetectedAt time.Time `json:"first_detected_at,omitempty"`
+	// open, acknowledged, suppressed, false_positive, resolved
+	Status string `json:"status,omitempty"`
+	// Assignee holds the value of the "assignee" field.
+	Assignee string `json:"assignee,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// Suppressed findings reopen when re-detected after this time
+	SuppressedUntil *time.Time `json:"suppressed_until,omitempty"`
+	// Set when a finding is no longer detected, cleared on reopen
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// Advances every time the detector observes the finding
+	LastSeenAt time.Time `json:"last_seen_at,omitempty"`
 	// MachineID holds the value of the "machine_id" field.
 	MachineID string `json:"machine_id,omitempty"`
 	// Hostname holds the value of the "hostname" field.
@@ -53,9 +65,9 @@ func (*GoldLifecycleOS) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case goldlifecycleos.FieldID, goldlifecycleos.FieldMachineID, goldlifecycleos.FieldHostname, goldlifecycleos.FieldOsType, goldlifecycleos.FieldOsName, goldlifecycleos.FieldEolProductSlug, goldlifecycleos.FieldEolProductName, goldlifecycleos.FieldEolCycle, goldlifecycleos.FieldEolStatus, goldlifecycleos.FieldLatestVersion:
+		case goldlifecycleos.FieldID, goldlifecycleos.FieldStatus, goldlifecycleos.FieldAssignee, goldlifecycleos.FieldNote, goldlifecycleos.FieldMachineID, goldlifecycleos.FieldHostname, goldlifecycleos.FieldOsType, goldlifecycleos.FieldOsName, goldlifecycleos.FieldEolProductSlug, goldlifecycleos.FieldEolProductName, goldlifecycleos.FieldEolCycle, goldlifecycleos.FieldEolStatus, goldlifecycleos.FieldLatestVersion:
 			values[i] = new(sql.NullString)
-		case goldlifecycleos.FieldDetectedAt, goldlifecycleos.FieldFirstDetectedAt, goldlifecycleos.FieldEolDate, goldlifecycleos.FieldEoasDate, goldlifecycleos.FieldEoesDate:
+		case goldlifecycleos.FieldDetectedAt, goldlifecycleos.FieldFirstDetectedAt, goldlifecycleos.FieldSuppressedUntil, goldlifecycleos.FieldResolvedAt, goldlifecycleos.FieldLastSeenAt, goldlifecycleos.FieldEolDate, goldlifecycleos.FieldEoasDate, goldlifecycleos.FieldEoesDate:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -90,6 +102,44 @@ func (_m *GoldLifecycleOS) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.FirstDetectedAt = value.Time
 			}
+		case goldlifecycleos.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case goldlifecycleos.FieldAssignee:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field assignee", values[i])
+			} else if value.Valid {
+				_m.Assignee = value.String
+			}
+		case goldlifecycleos.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case goldlifecycleos.FieldSuppressedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field suppressed_until", values[i])
+			} else if value.Valid {
+				_m.SuppressedUntil = new(time.Time)
+				*_m.SuppressedUntil = value.Time
+			}
+		case goldlifecycleos.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				_m.ResolvedAt = new(time.Time)
+				*_m.ResolvedAt = value.Time
+			}
+		case goldlifecycleos.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				_m.LastSeenAt = value.Time
+			}
 		case goldlifecycleos.FieldMachineID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field machine_id", values[i])
@@ -204,6 +254,28 @@ func (_m *GoldLifecycleOS) String() string {
 	builder.WriteString("first_detected_at=")
 	builder.WriteString(_m.FirstDetectedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("assignee=")
+	builder.WriteString(_m.Assignee)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	if v := _m.SuppressedUntil; v != nil {
+		builder.WriteString("suppressed_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_seen_at=")
+	builder.WriteString(_m.LastSeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("machine_id=")
 	builder.WriteString(_m.MachineID)
 	builder.WriteString(", ")
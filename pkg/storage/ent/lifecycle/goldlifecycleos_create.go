@@ -32,6 +32,82 @@ func (_c *GoldLifecycleOSCreate) SetFirstDetectedAt(v time.Time) *GoldLifecycleO
 	return _c
 }
 
+// SetStatus sets the "status" field.
+func (_c *GoldLifecycleOSCreate) SetStatus(v string) *GoldLifecycleOSCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *GoldLifecycleOSCreate) SetNillableStatus(v *string) *GoldLifecycleOSCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAssignee sets the "assignee" field.
+func (_c *GoldLifecycleOSCreate) SetAssignee(v string) *GoldLifecycleOSCreate {
+	_c.mutation.SetAssignee(v)
+	return _c
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_c *GoldLifecycleOSCreate) SetNillableAssignee(v *string) *GoldLifecycleOSCreate {
+	if v != nil {
+		_c.SetAssignee(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *GoldLifecycleOSCreate) SetNote(v string) *GoldLifecycleOSCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *GoldLifecycleOSCreate) SetNillableNote(v *string) *GoldLifecycleOSCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (_c *GoldLifecycleOSCreate) SetSuppressedUntil(v time.Time) *GoldLifecycleOSCreate {
+	_c.mutation.SetSuppressedUntil(v)
+	return _c
+}
+
+// SetNillableSuppressedUntil sets the "suppressed_until" field if the given value is not nil.
+func (_c *GoldLifecycleOSCreate) SetNillableSuppressedUntil(v *time.Time) *GoldLifecycleOSCreate {
+	if v != nil {
+		_c.SetSuppressedUntil(*v)
+	}
+	return _c
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_c *GoldLifecycleOSCreate) SetResolvedAt(v time.Time) *GoldLifecycleOSCreate {
+	_c.mutation.SetResolvedAt(v)
+	return _c
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_c *GoldLifecycleOSCreate) SetNillableResolvedAt(v *time.Time) *GoldLifecycleOSCreate {
+	if v != nil {
+		_c.SetResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *GoldLifecycleOSCreate) SetLastSeenAt(v time.Time) *GoldLifecycleOSCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
 // SetMachineID sets the "machine_id" field.
 func (_c *GoldLifecycleOSCreate) SetMachineID(v string) *GoldLifecycleOSCreate {
 	_c.mutation.SetMachineID(v)
@@ -197,6 +273,7 @@ func (_c *GoldLifecycleOSCreate) Mutation() *GoldLifecycleOSMutation {
 
 // Save creates the GoldLifecycleOS in the database.
 func (_c *GoldLifecycleOSCreate) Save(ctx context.Context) (*GoldLifecycleOS, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -222,6 +299,14 @@ func (_c *GoldLifecycleOSCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *GoldLifecycleOSCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := goldlifecycleos.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *GoldLifecycleOSCreate) check() error {
 	if _, ok := _c.mutation.DetectedAt(); !ok {
@@ -230,6 +315,17 @@ func (_c *GoldLifecycleOSCreate) check() error {
 	if _, ok := _c.mutation.FirstDetectedAt(); !ok {
 		return &ValidationError{Name: "first_detected_at", err: errors.New(`lifecycle: missing required field "GoldLifecycleOS.first_detected_at"`)}
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`lifecycle: missing required field "GoldLifecycleOS.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := goldlifecycleos.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`lifecycle: validator failed for field "GoldLifecycleOS.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LastSeenAt(); !ok {
+		return &ValidationError{Name: "last_seen_at", err: errors.New(`lifecycle: missing required field "GoldLifecycleOS.last_seen_at"`)}
+	}
 	if _, ok := _c.mutation.MachineID(); !ok {
 		return &ValidationError{Name: "machine_id", err: errors.New(`lifecycle: missing required field "GoldLifecycleOS.machine_id"`)}
 	}
@@ -290,6 +386,30 @@ func (_c *GoldLifecycleOSCreate) createSpec() (*GoldLifecycleOS, *sqlgraph.Creat
 		_spec.SetField(goldlifecycleos.FieldFirstDetectedAt, field.TypeTime, value)
 		_node.FirstDetectedAt = value
 	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(goldlifecycleos.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Assignee(); ok {
+		_spec.SetField(goldlifecycleos.FieldAssignee, field.TypeString, value)
+		_node.Assignee = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(goldlifecycleos.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.SuppressedUntil(); ok {
+		_spec.SetField(goldlifecycleos.FieldSuppressedUntil, field.TypeTime, value)
+		_node.SuppressedUntil = &value
+	}
+	if value, ok := _c.mutation.ResolvedAt(); ok {
+		_spec.SetField(goldlifecycleos.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(goldlifecycleos.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = value
+	}
 	if value, ok := _c.mutation.MachineID(); ok {
 		_spec.SetField(goldlifecycleos.FieldMachineID, field.TypeString, value)
 		_node.MachineID = value
@@ -359,6 +479,7 @@ func (_c *GoldLifecycleOSCreateBulk) Save(ctx context.Context) ([]*GoldLifecycle
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*GoldLifecycleOSMutation)
 				if !ok {
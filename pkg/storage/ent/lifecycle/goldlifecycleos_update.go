@@ -43,6 +43,114 @@ func (_u *GoldLifecycleOSUpdate) SetNillableDetectedAt(v *time.Time) *GoldLifecy
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *GoldLifecycleOSUpdate) SetStatus(v string) *GoldLifecycleOSUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GoldLifecycleOSUpdate) SetNillableStatus(v *string) *GoldLifecycleOSUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAssignee sets the "assignee" field.
+func (_u *GoldLifecycleOSUpdate) SetAssignee(v string) *GoldLifecycleOSUpdate {
+	_u.mutation.SetAssignee(v)
+	return _u
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_u *GoldLifecycleOSUpdate) SetNillableAssignee(v *string) *GoldLifecycleOSUpdate {
+	if v != nil {
+		_u.SetAssignee(*v)
+	}
+	return _u
+}
+
+// ClearAssignee clears the value of the "assignee" field.
+func (_u *GoldLifecycleOSUpdate) ClearAssignee() *GoldLifecycleOSUpdate {
+	_u.mutation.ClearAssignee()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *GoldLifecycleOSUpdate) SetNote(v string) *GoldLifecycleOSUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *GoldLifecycleOSUpdate) SetNillableNote(v *string) *GoldLifecycleOSUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *GoldLifecycleOSUpdate) ClearNote() *GoldLifecycleOSUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (_u *GoldLifecycleOSUpdate) SetSuppressedUntil(v time.Time) *GoldLifecycleOSUpdate {
+	_u.mutation.SetSuppressedUntil(v)
+	return _u
+}
+
+// SetNillableSuppressedUntil sets the "suppressed_until" field if the given value is not nil.
+func (_u *GoldLifecycleOSUpdate) SetNillableSuppressedUntil(v *time.Time) *GoldLifecycleOSUpdate {
+	if v != nil {
+		_u.SetSuppressedUntil(*v)
+	}
+	return _u
+}
+
+// ClearSuppressedUntil clears the value of the "suppressed_until" field.
+func (_u *GoldLifecycleOSUpdate) ClearSuppressedUntil() *GoldLifecycleOSUpdate {
+	_u.mutation.ClearSuppressedUntil()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *GoldLifecycleOSUpdate) SetResolvedAt(v time.Time) *GoldLifecycleOSUpdate {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *GoldLifecycleOSUpdate) SetNillableResolvedAt(v *time.Time) *GoldLifecycleOSUpdate {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *GoldLifecycleOSUpdate) ClearResolvedAt() *GoldLifecycleOSUpdate {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *GoldLifecycleOSUpdate) SetLastSeenAt(v time.Time) *GoldLifecycleOSUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *GoldLifecycleOSUpdate) SetNillableLastSeenAt(v *time.Time) *GoldLifecycleOSUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
 // SetMachineID sets the "machine_id" field.
 func (_u *GoldLifecycleOSUpdate) SetMachineID(v string) *GoldLifecycleOSUpdate {
 	_u.mutation.SetMachineID(v)
@@ -305,6 +413,11 @@ func (_u *GoldLifecycleOSUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *GoldLifecycleOSUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := goldlifecycleos.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`lifecycle: validator failed for field "GoldLifecycleOS.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.MachineID(); ok {
 		if err := goldlifecycleos.MachineIDValidator(v); err != nil {
 			return &ValidationError{Name: "machine_id", err: fmt.Errorf(`lifecycle: validator failed for field "GoldLifecycleOS.machine_id": %w`, err)}
@@ -333,6 +446,36 @@ func (_u *GoldLifecycleOSUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if value, ok := _u.mutation.DetectedAt(); ok {
 		_spec.SetField(goldlifecycleos.FieldDetectedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(goldlifecycleos.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Assignee(); ok {
+		_spec.SetField(goldlifecycleos.FieldAssignee, field.TypeString, value)
+	}
+	if _u.mutation.AssigneeCleared() {
+		_spec.ClearField(goldlifecycleos.FieldAssignee, field.TypeString)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(goldlifecycleos.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(goldlifecycleos.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.SuppressedUntil(); ok {
+		_spec.SetField(goldlifecycleos.FieldSuppressedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.SuppressedUntilCleared() {
+		_spec.ClearField(goldlifecycleos.FieldSuppressedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(goldlifecycleos.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(goldlifecycleos.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(goldlifecycleos.FieldLastSeenAt, field.TypeTime, value)
+	}
 	if value, ok := _u.mutation.MachineID(); ok {
 		_spec.SetField(goldlifecycleos.FieldMachineID, field.TypeString, value)
 	}
@@ -435,6 +578,114 @@ func (_u *GoldLifecycleOSUpdateOne) SetNillableDetectedAt(v *time.Time) *GoldLif
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *GoldLifecycleOSUpdateOne) SetStatus(v string) *GoldLifecycleOSUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GoldLifecycleOSUpdateOne) SetNillableStatus(v *string) *GoldLifecycleOSUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAssignee sets the "assignee" field.
+func (_u *GoldLifecycleOSUpdateOne) SetAssignee(v string) *GoldLifecycleOSUpdateOne {
+	_u.mutation.SetAssignee(v)
+	return _u
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_u *GoldLifecycleOSUpdateOne) SetNillableAssignee(v *string) *GoldLifecycleOSUpdateOne {
+	if v != nil {
+		_u.SetAssignee(*v)
+	}
+	return _u
+}
+
+// ClearAssignee clears the value of the "assignee" field.
+func (_u *GoldLifecycleOSUpdateOne) ClearAssignee() *GoldLifecycleOSUpdateOne {
+	_u.mutation.ClearAssignee()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *GoldLifecycleOSUpdateOne) SetNote(v string) *GoldLifecycleOSUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *GoldLifecycleOSUpdateOne) SetNillableNote(v *string) *GoldLifecycleOSUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *GoldLifecycleOSUpdateOne) ClearNote() *GoldLifecycleOSUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (_u *GoldLifecycleOSUpdateOne) SetSuppressedUntil(v time.Time) *GoldLifecycleOSUpdateOne {
+	_u.mutation.SetSuppressedUntil(v)
+	return _u
+}
+
+// SetNillableSuppressedUntil sets the "suppressed_until" field if the given value is not nil.
+func (_u *GoldLifecycleOSUpdateOne) SetNillableSuppressedUntil(v *time.Time) *GoldLifecycleOSUpdateOne {
+	if v != nil {
+		_u.SetSuppressedUntil(*v)
+	}
+	return _u
+}
+
+// ClearSuppressedUntil clears the value of the "suppressed_until" field.
+func (_u *GoldLifecycleOSUpdateOne) ClearSuppressedUntil() *GoldLifecycleOSUpdateOne {
+	_u.mutation.ClearSuppressedUntil()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *GoldLifecycleOSUpdateOne) SetResolvedAt(v time.Time) *GoldLifecycleOSUpdateOne {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *GoldLifecycleOSUpdateOne) SetNillableResolvedAt(v *time.Time) *GoldLifecycleOSUpdateOne {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *GoldLifecycleOSUpdateOne) ClearResolvedAt() *GoldLifecycleOSUpdateOne {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *GoldLifecycleOSUpdateOne) SetLastSeenAt(v time.Time) *GoldLifecycleOSUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *GoldLifecycleOSUpdateOne) SetNillableLastSeenAt(v *time.Time) *GoldLifecycleOSUpdateOne {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
 // SetMachineID sets the "machine_id" field.
 func (_u *GoldLifecycleOSUpdateOne) SetMachineID(v string) *GoldLifecycleOSUpdateOne {
 	_u.mutation.SetMachineID(v)
@@ -710,6 +961,11 @@ func (_u *GoldLifecycleOSUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *GoldLifecycleOSUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := goldlifecycleos.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`lifecycle: validator failed for field "GoldLifecycleOS.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.MachineID(); ok {
 		if err := goldlifecycleos.MachineIDValidator(v); err != nil {
 			return &ValidationError{Name: "machine_id", err: fmt.Errorf(`lifecycle: validator failed for field "GoldLifecycleOS.machine_id": %w`, err)}
@@ -755,6 +1011,36 @@ func (_u *GoldLifecycleOSUpdateOne) sqlSave(ctx context.Context) (_node *GoldLif
 	if value, ok := _u.mutation.DetectedAt(); ok {
 		_spec.SetField(goldlifecycleos.FieldDetectedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(goldlifecycleos.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Assignee(); ok {
+		_spec.SetField(goldlifecycleos.FieldAssignee, field.TypeString, value)
+	}
+	if _u.mutation.AssigneeCleared() {
+		_spec.ClearField(goldlifecycleos.FieldAssignee, field.TypeString)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(goldlifecycleos.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(goldlifecycleos.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.SuppressedUntil(); ok {
+		_spec.SetField(goldlifecycleos.FieldSuppressedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.SuppressedUntilCleared() {
+		_spec.ClearField(goldlifecycleos.FieldSuppressedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(goldlifecycleos.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(goldlifecycleos.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(goldlifecycleos.FieldLastSeenAt, field.TypeTime, value)
+	}
 	if value, ok := _u.mutation.MachineID(); ok {
 		_spec.SetField(goldlifecycleos.FieldMachineID, field.TypeString, value)
 	}
@@ -21,6 +21,18 @@ type GoldLifecycleSoftware struct {
 	DetectedAt time.Time `json:"detected_at,omitempty"`
 	// FirstDetectedAt holds the value of the "first_detected_at" field.
 	FirstDetectedAt time.Time `json:"first_detected_at,omitempty"`
+	// open, acknowledged, suppressed, false_positive, resolved
+	Status string `json:"status,omitempty"`
+	// Assignee holds the value of the "assignee" field.
+	Assignee string `json:"assignee,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// Suppressed findings reopen when re-detected after this time
+	SuppressedUntil *time.Time `json:"suppressed_until,omitempty"`
+	// Set when a finding is no longer detected, cleared on reopen
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// Advances every time the detector observes the finding
+	LastSeenAt time.Time `json:"last_seen_at,omitempty"`
 	// MachineID holds the value of the "machine_id" field.
 	MachineID string `json:"machine_id,omitempty"`
 	// Name holds the value of the "name" field.
@@ -55,9 +67,9 @@ func (*GoldLifecycleSoftware) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case goldlifecyclesoftware.FieldID, goldlifecyclesoftware.FieldMachineID, goldlifecyclesoftware.FieldName, goldlifecyclesoftware.FieldVersion, goldlifecyclesoftware.FieldClassification, goldlifecyclesoftware.FieldEolProductSlug, goldlifecyclesoftware.FieldEolProductName, goldlifecyclesoftware.FieldEolCategory, goldlifecyclesoftware.FieldEolCycle, goldlifecyclesoftware.FieldEolStatus, goldlifecyclesoftware.FieldLatestVersion:
+		case goldlifecyclesoftware.FieldID, goldlifecyclesoftware.FieldStatus, goldlifecyclesoftware.FieldAssignee, goldlifecyclesoftware.FieldNote, goldlifecyclesoftware.FieldMachineID, goldlifecyclesoftware.FieldName, goldlifecyclesoftware.FieldVersion, goldlifecyclesoftware.FieldClassification, goldlifecyclesoftware.FieldEolProductSlug, goldlifecyclesoftware.FieldEolProductName, goldlifecyclesoftware.FieldEolCategory, goldlifecyclesoftware.FieldEolCycle, goldlifecyclesoftware.FieldEolStatus, goldlifecyclesoftware.FieldLatestVersion:
 			values[i] = new(sql.NullString)
-		case goldlifecyclesoftware.FieldDetectedAt, goldlifecyclesoftware.FieldFirstDetectedAt, goldlifecyclesoftware.FieldEolDate, goldlifecyclesoftware.FieldEoasDate, goldlifecyclesoftware.FieldEoesDate:
+		case goldlifecyclesoftware.FieldDetectedAt, goldlifecyclesoftware.FieldFirstDetectedAt, goldlifecyclesoftware.FieldSuppressedUntil, goldlifecyclesoftware.FieldResolvedAt, goldlifecyclesoftware.FieldLastSeenAt, goldlifecyclesoftware.FieldEolDate, goldlifecyclesoftware.FieldEoasDate, goldlifecyclesoftware.FieldEoesDate:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -92,6 +104,44 @@ func (_m *GoldLifecycleSoftware) assignValues(columns []string, values []any) er
 			} else if value.Valid {
 				_m.FirstDetectedAt = value.Time
 			}
+		case goldlifecyclesoftware.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case goldlifecyclesoftware.FieldAssignee:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field assignee", values[i])
+			} else if value.Valid {
+				_m.Assignee = value.String
+			}
+		case goldlifecyclesoftware.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case goldlifecyclesoftware.FieldSuppressedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field suppressed_until", values[i])
+			} else if value.Valid {
+				_m.SuppressedUntil = new(time.Time)
+				*_m.SuppressedUntil = value.Time
+			}
+		case goldlifecyclesoftware.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				_m.ResolvedAt = new(time.Time)
+				*_m.ResolvedAt = value.Time
+			}
+		case goldlifecyclesoftware.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				_m.LastSeenAt = value.Time
+			}
 		case goldlifecyclesoftware.FieldMachineID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field machine_id", values[i])
@@ -212,6 +262,28 @@ func (_m *GoldLifecycleSoftware) String() string {
 	builder.WriteString("first_detected_at=")
 	builder.WriteString(_m.FirstDetectedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("assignee=")
+	builder.WriteString(_m.Assignee)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	if v := _m.SuppressedUntil; v != nil {
+		builder.WriteString("suppressed_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_seen_at=")
+	builder.WriteString(_m.LastSeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("machine_id=")
 	builder.WriteString(_m.MachineID)
 	builder.WriteString(", ")
@@ -74,6 +74,36 @@ func FirstDetectedAt(v time.Time) predicate.GoldLifecycleSoftware {
 	return predicate.GoldLifecycleSoftware(sql.FieldEQ(FieldFirstDetectedAt, v))
 }
 
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldEQ(FieldStatus, v))
+}
+
+// Assignee applies equality check predicate on the "assignee" field. It's identical to AssigneeEQ.
+func Assignee(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldEQ(FieldAssignee, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldEQ(FieldNote, v))
+}
+
+// SuppressedUntil applies equality check predicate on the "suppressed_until" field. It's identical to SuppressedUntilEQ.
+func SuppressedUntil(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldEQ(FieldSuppressedUntil, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
 // MachineID applies equality check predicate on the "machine_id" field. It's identical to MachineIDEQ.
 func MachineID(v string) predicate.GoldLifecycleSoftware {
 	return predicate.GoldLifecycleSoftware(sql.FieldEQ(FieldMachineID, v))
@@ -219,6 +249,361 @@ func FirstDetectedAtLTE(v time.Time) predicate.GoldLifecycleSoftware {
 	return predicate.GoldLifecycleSoftware(sql.FieldLTE(FieldFirstDetectedAt, v))
 }
 
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// AssigneeEQ applies the EQ predicate on the "assignee" field.
+func AssigneeEQ(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldEQ(FieldAssignee, v))
+}
+
+// AssigneeNEQ applies the NEQ predicate on the "assignee" field.
+func AssigneeNEQ(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldNEQ(FieldAssignee, v))
+}
+
+// AssigneeIn applies the In predicate on the "assignee" field.
+func AssigneeIn(vs ...string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldIn(FieldAssignee, vs...))
+}
+
+// AssigneeNotIn applies the NotIn predicate on the "assignee" field.
+func AssigneeNotIn(vs ...string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldNotIn(FieldAssignee, vs...))
+}
+
+// AssigneeGT applies the GT predicate on the "assignee" field.
+func AssigneeGT(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldGT(FieldAssignee, v))
+}
+
+// AssigneeGTE applies the GTE predicate on the "assignee" field.
+func AssigneeGTE(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldGTE(FieldAssignee, v))
+}
+
+// AssigneeLT applies the LT predicate on the "assignee" field.
+func AssigneeLT(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldLT(FieldAssignee, v))
+}
+
+// AssigneeLTE applies the LTE predicate on the "assignee" field.
+func AssigneeLTE(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldLTE(FieldAssignee, v))
+}
+
+// AssigneeContains applies the Contains predicate on the "assignee" field.
+func AssigneeContains(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldContains(FieldAssignee, v))
+}
+
+// AssigneeHasPrefix applies the HasPrefix predicate on the "assignee" field.
+func AssigneeHasPrefix(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldHasPrefix(FieldAssignee, v))
+}
+
+// AssigneeHasSuffix applies the HasSuffix predicate on the "assignee" field.
+func AssigneeHasSuffix(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldHasSuffix(FieldAssignee, v))
+}
+
+// AssigneeIsNil applies the IsNil predicate on the "assignee" field.
+func AssigneeIsNil() predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldIsNull(FieldAssignee))
+}
+
+// AssigneeNotNil applies the NotNil predicate on the "assignee" field.
+func AssigneeNotNil() predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldNotNull(FieldAssignee))
+}
+
+// AssigneeEqualFold applies the EqualFold predicate on the "assignee" field.
+func AssigneeEqualFold(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldEqualFold(FieldAssignee, v))
+}
+
+// AssigneeContainsFold applies the ContainsFold predicate on the "assignee" field.
+func AssigneeContainsFold(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldContainsFold(FieldAssignee, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldContainsFold(FieldNote, v))
+}
+
+// SuppressedUntilEQ applies the EQ predicate on the "suppressed_until" field.
+func SuppressedUntilEQ(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldEQ(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilNEQ applies the NEQ predicate on the "suppressed_until" field.
+func SuppressedUntilNEQ(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldNEQ(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilIn applies the In predicate on the "suppressed_until" field.
+func SuppressedUntilIn(vs ...time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldIn(FieldSuppressedUntil, vs...))
+}
+
+// SuppressedUntilNotIn applies the NotIn predicate on the "suppressed_until" field.
+func SuppressedUntilNotIn(vs ...time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldNotIn(FieldSuppressedUntil, vs...))
+}
+
+// SuppressedUntilGT applies the GT predicate on the "suppressed_until" field.
+func SuppressedUntilGT(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldGT(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilGTE applies the GTE predicate on the "suppressed_until" field.
+func SuppressedUntilGTE(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldGTE(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilLT applies the LT predicate on the "suppressed_until" field.
+func SuppressedUntilLT(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldLT(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilLTE applies the LTE predicate on the "suppressed_until" field.
+func SuppressedUntilLTE(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldLTE(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilIsNil applies the IsNil predicate on the "suppressed_until" field.
+func SuppressedUntilIsNil() predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldIsNull(FieldSuppressedUntil))
+}
+
+// SuppressedUntilNotNil applies the NotNil predicate on the "suppressed_until" field.
+func SuppressedUntilNotNil() predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldNotNull(FieldSuppressedUntil))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.GoldLifecycleSoftware {
+	return predicate.GoldLifecycleSoftware(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
 // MachineIDEQ applies the EQ predicate on the "machine_id" field.
 func MachineIDEQ(v string) predicate.GoldLifecycleSoftware {
 	return predicate.GoldLifecycleSoftware(sql.FieldEQ(FieldMachineID, v))
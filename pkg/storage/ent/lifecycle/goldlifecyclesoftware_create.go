@@ -32,6 +32,82 @@ func (_c *GoldLifecycleSoftwareCreate) SetFirstDetectedAt(v time.Time) *GoldLife
 	return _c
 }
 
+// SetStatus sets the "status" field.
+func (_c *GoldLifecycleSoftwareCreate) SetStatus(v string) *GoldLifecycleSoftwareCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *GoldLifecycleSoftwareCreate) SetNillableStatus(v *string) *GoldLifecycleSoftwareCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAssignee sets the "assignee" field.
+func (_c *GoldLifecycleSoftwareCreate) SetAssignee(v string) *GoldLifecycleSoftwareCreate {
+	_c.mutation.SetAssignee(v)
+	return _c
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_c *GoldLifecycleSoftwareCreate) SetNillableAssignee(v *string) *GoldLifecycleSoftwareCreate {
+	if v != nil {
+		_c.SetAssignee(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *GoldLifecycleSoftwareCreate) SetNote(v string) *GoldLifecycleSoftwareCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *GoldLifecycleSoftwareCreate) SetNillableNote(v *string) *GoldLifecycleSoftwareCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (_c *GoldLifecycleSoftwareCreate) SetSuppressedUntil(v time.Time) *GoldLifecycleSoftwareCreate {
+	_c.mutation.SetSuppressedUntil(v)
+	return _c
+}
+
+// SetNillableSuppressedUntil sets the "suppressed_until" field if the given value is not nil.
+func (_c *GoldLifecycleSoftwareCreate) SetNillableSuppressedUntil(v *time.Time) *GoldLifecycleSoftwareCreate {
+	if v != nil {
+		_c.SetSuppressedUntil(*v)
+	}
+	return _c
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_c *GoldLifecycleSoftwareCreate) SetResolvedAt(v time.Time) *GoldLifecycleSoftwareCreate {
+	_c.mutation.SetResolvedAt(v)
+	return _c
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_c *GoldLifecycleSoftwareCreate) SetNillableResolvedAt(v *time.Time) *GoldLifecycleSoftwareCreate {
+	if v != nil {
+		_c.SetResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *GoldLifecycleSoftwareCreate) SetLastSeenAt(v time.Time) *GoldLifecycleSoftwareCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
 // SetMachineID sets the "machine_id" field.
 func (_c *GoldLifecycleSoftwareCreate) SetMachineID(v string) *GoldLifecycleSoftwareCreate {
 	_c.mutation.SetMachineID(v)
@@ -195,6 +271,7 @@ func (_c *GoldLifecycleSoftwareCreate) Mutation() *GoldLifecycleSoftwareMutation
 
 // Save creates the GoldLifecycleSoftware in the database.
 func (_c *GoldLifecycleSoftwareCreate) Save(ctx context.Context) (*GoldLifecycleSoftware, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -220,6 +297,14 @@ func (_c *GoldLifecycleSoftwareCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *GoldLifecycleSoftwareCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := goldlifecyclesoftware.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *GoldLifecycleSoftwareCreate) check() error {
 	if _, ok := _c.mutation.DetectedAt(); !ok {
@@ -228,6 +313,17 @@ func (_c *GoldLifecycleSoftwareCreate) check() error {
 	if _, ok := _c.mutation.FirstDetectedAt(); !ok {
 		return &ValidationError{Name: "first_detected_at", err: errors.New(`lifecycle: missing required field "GoldLifecycleSoftware.first_detected_at"`)}
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`lifecycle: missing required field "GoldLifecycleSoftware.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := goldlifecyclesoftware.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`lifecycle: validator failed for field "GoldLifecycleSoftware.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LastSeenAt(); !ok {
+		return &ValidationError{Name: "last_seen_at", err: errors.New(`lifecycle: missing required field "GoldLifecycleSoftware.last_seen_at"`)}
+	}
 	if _, ok := _c.mutation.MachineID(); !ok {
 		return &ValidationError{Name: "machine_id", err: errors.New(`lifecycle: missing required field "GoldLifecycleSoftware.machine_id"`)}
 	}
@@ -304,6 +400,30 @@ func (_c *GoldLifecycleSoftwareCreate) createSpec() (*GoldLifecycleSoftware, *sq
 		_spec.SetField(goldlifecyclesoftware.FieldFirstDetectedAt, field.TypeTime, value)
 		_node.FirstDetectedAt = value
 	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(goldlifecyclesoftware.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Assignee(); ok {
+		_spec.SetField(goldlifecyclesoftware.FieldAssignee, field.TypeString, value)
+		_node.Assignee = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(goldlifecyclesoftware.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.SuppressedUntil(); ok {
+		_spec.SetField(goldlifecyclesoftware.FieldSuppressedUntil, field.TypeTime, value)
+		_node.SuppressedUntil = &value
+	}
+	if value, ok := _c.mutation.ResolvedAt(); ok {
+		_spec.SetField(goldlifecyclesoftware.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(goldlifecyclesoftware.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = value
+	}
 	if value, ok := _c.mutation.MachineID(); ok {
 		_spec.SetField(goldlifecyclesoftware.FieldMachineID, field.TypeString, value)
 		_node.MachineID = value
@@ -377,6 +497,7 @@ func (_c *GoldLifecycleSoftwareCreateBulk) Save(ctx context.Context) ([]*GoldLif
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*GoldLifecycleSoftwareMutation)
 				if !ok {
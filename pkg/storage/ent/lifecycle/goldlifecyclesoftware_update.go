@@ -43,6 +43,114 @@ func (_u *GoldLifecycleSoftwareUpdate) SetNillableDetectedAt(v *time.Time) *Gold
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *GoldLifecycleSoftwareUpdate) SetStatus(v string) *GoldLifecycleSoftwareUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GoldLifecycleSoftwareUpdate) SetNillableStatus(v *string) *GoldLifecycleSoftwareUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAssignee sets the "assignee" field.
+func (_u *GoldLifecycleSoftwareUpdate) SetAssignee(v string) *GoldLifecycleSoftwareUpdate {
+	_u.mutation.SetAssignee(v)
+	return _u
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_u *GoldLifecycleSoftwareUpdate) SetNillableAssignee(v *string) *GoldLifecycleSoftwareUpdate {
+	if v != nil {
+		_u.SetAssignee(*v)
+	}
+	return _u
+}
+
+// ClearAssignee clears the value of the "assignee" field.
+func (_u *GoldLifecycleSoftwareUpdate) ClearAssignee() *GoldLifecycleSoftwareUpdate {
+	_u.mutation.ClearAssignee()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *GoldLifecycleSoftwareUpdate) SetNote(v string) *GoldLifecycleSoftwareUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *GoldLifecycleSoftwareUpdate) SetNillableNote(v *string) *GoldLifecycleSoftwareUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *GoldLifecycleSoftwareUpdate) ClearNote() *GoldLifecycleSoftwareUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (_u *GoldLifecycleSoftwareUpdate) SetSuppressedUntil(v time.Time) *GoldLifecycleSoftwareUpdate {
+	_u.mutation.SetSuppressedUntil(v)
+	return _u
+}
+
+// SetNillableSuppressedUntil sets the "suppressed_until" field if the given value is not nil.
+func (_u *GoldLifecycleSoftwareUpdate) SetNillableSuppressedUntil(v *time.Time) *GoldLifecycleSoftwareUpdate {
+	if v != nil {
+		_u.SetSuppressedUntil(*v)
+	}
+	return _u
+}
+
+// ClearSuppressedUntil clears the value of the "suppressed_until" field.
+func (_u *GoldLifecycleSoftwareUpdate) ClearSuppressedUntil() *GoldLifecycleSoftwareUpdate {
+	_u.mutation.ClearSuppressedUntil()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *GoldLifecycleSoftwareUpdate) SetResolvedAt(v time.Time) *GoldLifecycleSoftwareUpdate {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *GoldLifecycleSoftwareUpdate) SetNillableResolvedAt(v *time.Time) *GoldLifecycleSoftwareUpdate {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *GoldLifecycleSoftwareUpdate) ClearResolvedAt() *GoldLifecycleSoftwareUpdate {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *GoldLifecycleSoftwareUpdate) SetLastSeenAt(v time.Time) *GoldLifecycleSoftwareUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *GoldLifecycleSoftwareUpdate) SetNillableLastSeenAt(v *time.Time) *GoldLifecycleSoftwareUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
 // SetMachineID sets the "machine_id" field.
 func (_u *GoldLifecycleSoftwareUpdate) SetMachineID(v string) *GoldLifecycleSoftwareUpdate {
 	_u.mutation.SetMachineID(v)
@@ -313,6 +421,11 @@ func (_u *GoldLifecycleSoftwareUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *GoldLifecycleSoftwareUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := goldlifecyclesoftware.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`lifecycle: validator failed for field "GoldLifecycleSoftware.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.MachineID(); ok {
 		if err := goldlifecyclesoftware.MachineIDValidator(v); err != nil {
 			return &ValidationError{Name: "machine_id", err: fmt.Errorf(`lifecycle: validator failed for field "GoldLifecycleSoftware.machine_id": %w`, err)}
@@ -351,6 +464,36 @@ func (_u *GoldLifecycleSoftwareUpdate) sqlSave(ctx context.Context) (_node int,
 	if value, ok := _u.mutation.DetectedAt(); ok {
 		_spec.SetField(goldlifecyclesoftware.FieldDetectedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(goldlifecyclesoftware.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Assignee(); ok {
+		_spec.SetField(goldlifecyclesoftware.FieldAssignee, field.TypeString, value)
+	}
+	if _u.mutation.AssigneeCleared() {
+		_spec.ClearField(goldlifecyclesoftware.FieldAssignee, field.TypeString)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(goldlifecyclesoftware.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(goldlifecyclesoftware.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.SuppressedUntil(); ok {
+		_spec.SetField(goldlifecyclesoftware.FieldSuppressedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.SuppressedUntilCleared() {
+		_spec.ClearField(goldlifecyclesoftware.FieldSuppressedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(goldlifecyclesoftware.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(goldlifecyclesoftware.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(goldlifecyclesoftware.FieldLastSeenAt, field.TypeTime, value)
+	}
 	if value, ok := _u.mutation.MachineID(); ok {
 		_spec.SetField(goldlifecyclesoftware.FieldMachineID, field.TypeString, value)
 	}
@@ -453,6 +596,114 @@ func (_u *GoldLifecycleSoftwareUpdateOne) SetNillableDetectedAt(v *time.Time) *G
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *GoldLifecycleSoftwareUpdateOne) SetStatus(v string) *GoldLifecycleSoftwareUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GoldLifecycleSoftwareUpdateOne) SetNillableStatus(v *string) *GoldLifecycleSoftwareUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAssignee sets the "assignee" field.
+func (_u *GoldLifecycleSoftwareUpdateOne) SetAssignee(v string) *GoldLifecycleSoftwareUpdateOne {
+	_u.mutation.SetAssignee(v)
+	return _u
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_u *GoldLifecycleSoftwareUpdateOne) SetNillableAssignee(v *string) *GoldLifecycleSoftwareUpdateOne {
+	if v != nil {
+		_u.SetAssignee(*v)
+	}
+	return _u
+}
+
+// ClearAssignee clears the value of the "assignee" field.
+func (_u *GoldLifecycleSoftwareUpdateOne) ClearAssignee() *GoldLifecycleSoftwareUpdateOne {
+	_u.mutation.ClearAssignee()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *GoldLifecycleSoftwareUpdateOne) SetNote(v string) *GoldLifecycleSoftwareUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *GoldLifecycleSoftwareUpdateOne) SetNillableNote(v *string) *GoldLifecycleSoftwareUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *GoldLifecycleSoftwareUpdateOne) ClearNote() *GoldLifecycleSoftwareUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (_u *GoldLifecycleSoftwareUpdateOne) SetSuppressedUntil(v time.Time) *GoldLifecycleSoftwareUpdateOne {
+	_u.mutation.SetSuppressedUntil(v)
+	return _u
+}
+
+// SetNillableSuppressedUntil sets the "suppressed_until" field if the given value is not nil.
+func (_u *GoldLifecycleSoftwareUpdateOne) SetNillableSuppressedUntil(v *time.Time) *GoldLifecycleSoftwareUpdateOne {
+	if v != nil {
+		_u.SetSuppressedUntil(*v)
+	}
+	return _u
+}
+
+// ClearSuppressedUntil clears the value of the "suppressed_until" field.
+func (_u *GoldLifecycleSoftwareUpdateOne) ClearSuppressedUntil() *GoldLifecycleSoftwareUpdateOne {
+	_u.mutation.ClearSuppressedUntil()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *GoldLifecycleSoftwareUpdateOne) SetResolvedAt(v time.Time) *GoldLifecycleSoftwareUpdateOne {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *GoldLifecycleSoftwareUpdateOne) SetNillableResolvedAt(v *time.Time) *GoldLifecycleSoftwareUpdateOne {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *GoldLifecycleSoftwareUpdateOne) ClearResolvedAt() *GoldLifecycleSoftwareUpdateOne {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *GoldLifecycleSoftwareUpdateOne) SetLastSeenAt(v time.Time) *GoldLifecycleSoftwareUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *GoldLifecycleSoftwareUpdateOne) SetNillableLastSeenAt(v *time.Time) *GoldLifecycleSoftwareUpdateOne {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
 // SetMachineID sets the "machine_id" field.
 func (_u *GoldLifecycleSoftwareUpdateOne) SetMachineID(v string) *GoldLifecycleSoftwareUpdateOne {
 	_u.mutation.SetMachineID(v)
@@ -736,6 +987,11 @@ func (_u *GoldLifecycleSoftwareUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *GoldLifecycleSoftwareUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := goldlifecyclesoftware.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`lifecycle: validator failed for field "GoldLifecycleSoftware.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.MachineID(); ok {
 		if err := goldlifecyclesoftware.MachineIDValidator(v); err != nil {
 			return &ValidationError{Name: "machine_id", err: fmt.Errorf(`lifecycle: validator failed for field "GoldLifecycleSoftware.machine_id": %w`, err)}
@@ -791,6 +1047,36 @@ func (_u *GoldLifecycleSoftwareUpdateOne) sqlSave(ctx context.Context) (_node *G
 	if value, ok := _u.mutation.DetectedAt(); ok {
 		_spec.SetField(goldlifecyclesoftware.FieldDetectedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(goldlifecyclesoftware.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Assignee(); ok {
+		_spec.SetField(goldlifecyclesoftware.FieldAssignee, field.TypeString, value)
+	}
+	if _u.mutation.AssigneeCleared() {
+		_spec.ClearField(goldlifecyclesoftware.FieldAssignee, field.TypeString)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(goldlifecyclesoftware.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(goldlifecyclesoftware.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.SuppressedUntil(); ok {
+		_spec.SetField(goldlifecyclesoftware.FieldSuppressedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.SuppressedUntilCleared() {
+		_spec.ClearField(goldlifecyclesoftware.FieldSuppressedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(goldlifecyclesoftware.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(goldlifecyclesoftware.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(goldlifecyclesoftware.FieldLastSeenAt, field.TypeTime, value)
+	}
 	if value, ok := _u.mutation.MachineID(); ok {
 		_spec.SetField(goldlifecyclesoftware.FieldMachineID, field.TypeString, value)
 	}
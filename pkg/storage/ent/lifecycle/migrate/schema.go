@@ -14,6 +14,12 @@ var (
 		{Name: "resource_id", Type: field.TypeString, Unique: true},
 		{Name: "detected_at", Type: field.TypeTime},
 		{Name: "first_detected_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeString, Default: "open"},
+		{Name: "assignee", Type: field.TypeString, Nullable: true},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "suppressed_until", Type: field.TypeTime, Nullable: true},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime},
 		{Name: "machine_id", Type: field.TypeString},
 		{Name: "hostname", Type: field.TypeString, Nullable: true},
 		{Name: "os_type", Type: field.TypeString, Nullable: true},
@@ -33,20 +39,25 @@ var (
 		Columns:    LifecycleOsColumns,
 		PrimaryKey: []*schema.Column{LifecycleOsColumns[0]},
 		Indexes: []*schema.Index{
+			{
+				Name:    "goldlifecycleos_status",
+				Unique:  false,
+				Columns: []*schema.Column{LifecycleOsColumns[3]},
+			},
 			{
 				Name:    "goldlifecycleos_machine_id",
 				Unique:  true,
-				Columns: []*schema.Column{LifecycleOsColumns[3]},
+				Columns: []*schema.Column{LifecycleOsColumns[9]},
 			},
 			{
 				Name:    "goldlifecycleos_eol_status",
 				Unique:  false,
-				Columns: []*schema.Column{LifecycleOsColumns[13]},
+				Columns: []*schema.Column{LifecycleOsColumns[19]},
 			},
 			{
 				Name:    "goldlifecycleos_eol_product_slug",
 				Unique:  false,
-				Columns: []*schema.Column{LifecycleOsColumns[7]},
+				Columns: []*schema.Column{LifecycleOsColumns[13]},
 			},
 		},
 	}
@@ -55,6 +66,12 @@ var (
 		{Name: "resource_id", Type: field.TypeString, Unique: true},
 		{Name: "detected_at", Type: field.TypeTime},
 		{Name: "first_detected_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeString, Default: "open"},
+		{Name: "assignee", Type: field.TypeString, Nullable: true},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "suppressed_until", Type: field.TypeTime, Nullable: true},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime},
 		{Name: "machine_id", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString},
 		{Name: "version", Type: field.TypeString, Nullable: true},
@@ -76,29 +93,34 @@ var (
 		PrimaryKey: []*schema.Column{LifecycleSoftwareColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "goldlifecyclesoftware_machine_id",
+				Name:    "goldlifecyclesoftware_status",
 				Unique:  false,
 				Columns: []*schema.Column{LifecycleSoftwareColumns[3]},
 			},
+			{
+				Name:    "goldlifecyclesoftware_machine_id",
+				Unique:  false,
+				Columns: []*schema.Column{LifecycleSoftwareColumns[9]},
+			},
 			{
 				Name:    "goldlifecyclesoftware_classification",
 				Unique:  false,
-				Columns: []*schema.Column{LifecycleSoftwareColumns[6]},
+				Columns: []*schema.Column{LifecycleSoftwareColumns[12]},
 			},
 			{
 				Name:    "goldlifecyclesoftware_eol_status",
 				Unique:  false,
-				Columns: []*schema.Column{LifecycleSoftwareColumns[14]},
+				Columns: []*schema.Column{LifecycleSoftwareColumns[20]},
 			},
 			{
 				Name:    "goldlifecyclesoftware_eol_product_slug",
 				Unique:  false,
-				Columns: []*schema.Column{LifecycleSoftwareColumns[7]},
+				Columns: []*schema.Column{LifecycleSoftwareColumns[13]},
 			},
 			{
 				Name:    "goldlifecyclesoftware_machine_id_name",
 				Unique:  true,
-				Columns: []*schema.Column{LifecycleSoftwareColumns[3], LifecycleSoftwareColumns[4]},
+				Columns: []*schema.Column{LifecycleSoftwareColumns[9], LifecycleSoftwareColumns[10]},
 			},
 		},
 	}
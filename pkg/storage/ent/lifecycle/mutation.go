@@ -37,6 +37,12 @@ type GoldLifecycleOSMutation struct {
 	id                *string
 	detected_at       *time.Time
 	first_detected_at *time.Time
+	status            *string
+	assignee          *string
+	note              *string
+	suppressed_until  *time.Time
+	resolved_at       *time.Time
+	last_seen_at      *time.Time
 	machine_id        *string
 	hostname          *string
 	os_type           *string
@@ -231,6 +237,274 @@ func (m *GoldLifecycleOSMutation) ResetFirstDetectedAt() {
 	m.first_detected_at = nil
 }
 
+// SetStatus sets the "status" field.
+func (m *GoldLifecycleOSMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *GoldLifecycleOSMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the GoldLifecycleOS entity.
+// If the GoldLifecycleOS object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldLifecycleOSMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *GoldLifecycleOSMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAssignee sets the "assignee" field.
+func (m *GoldLifecycleOSMutation) SetAssignee(s string) {
+	m.assignee = &s
+}
+
+// Assignee returns the value of the "assignee" field in the mutation.
+func (m *GoldLifecycleOSMutation) Assignee() (r string, exists bool) {
+	v := m.assignee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignee returns the old "assignee" field's value of the GoldLifecycleOS entity.
+// If the GoldLifecycleOS object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldLifecycleOSMutation) OldAssignee(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignee: %w", err)
+	}
+	return oldValue.Assignee, nil
+}
+
+// ClearAssignee clears the value of the "assignee" field.
+func (m *GoldLifecycleOSMutation) ClearAssignee() {
+	m.assignee = nil
+	m.clearedFields[goldlifecycleos.FieldAssignee] = struct{}{}
+}
+
+// AssigneeCleared returns if the "assignee" field was cleared in this mutation.
+func (m *GoldLifecycleOSMutation) AssigneeCleared() bool {
+	_, ok := m.clearedFields[goldlifecycleos.FieldAssignee]
+	return ok
+}
+
+// ResetAssignee resets all changes to the "assignee" field.
+func (m *GoldLifecycleOSMutation) ResetAssignee() {
+	m.assignee = nil
+	delete(m.clearedFields, goldlifecycleos.FieldAssignee)
+}
+
+// SetNote sets the "note" field.
+func (m *GoldLifecycleOSMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *GoldLifecycleOSMutation) Note() (r string, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNote returns the old "note" field's value of the GoldLifecycleOS entity.
+// If the GoldLifecycleOS object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldLifecycleOSMutation) OldNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+	}
+	return oldValue.Note, nil
+}
+
+// ClearNote clears the value of the "note" field.
+func (m *GoldLifecycleOSMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[goldlifecycleos.FieldNote] = struct{}{}
+}
+
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *GoldLifecycleOSMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[goldlifecycleos.FieldNote]
+	return ok
+}
+
+// ResetNote resets all changes to the "note" field.
+func (m *GoldLifecycleOSMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, goldlifecycleos.FieldNote)
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (m *GoldLifecycleOSMutation) SetSuppressedUntil(t time.Time) {
+	m.suppressed_until = &t
+}
+
+// SuppressedUntil returns the value of the "suppressed_until" field in the mutation.
+func (m *GoldLifecycleOSMutation) SuppressedUntil() (r time.Time, exists bool) {
+	v := m.suppressed_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuppressedUntil returns the old "suppressed_until" field's value of the GoldLifecycleOS entity.
+// If the GoldLifecycleOS object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldLifecycleOSMutation) OldSuppressedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuppressedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuppressedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuppressedUntil: %w", err)
+	}
+	return oldValue.SuppressedUntil, nil
+}
+
+// ClearSuppressedUntil clears the value of the "suppressed_until" field.
+func (m *GoldLifecycleOSMutation) ClearSuppressedUntil() {
+	m.suppressed_until = nil
+	m.clearedFields[goldlifecycleos.FieldSuppressedUntil] = struct{}{}
+}
+
+// SuppressedUntilCleared returns if the "suppressed_until" field was cleared in this mutation.
+func (m *GoldLifecycleOSMutation) SuppressedUntilCleared() bool {
+	_, ok := m.clearedFields[goldlifecycleos.FieldSuppressedUntil]
+	return ok
+}
+
+// ResetSuppressedUntil resets all changes to the "suppressed_until" field.
+func (m *GoldLifecycleOSMutation) ResetSuppressedUntil() {
+	m.suppressed_until = nil
+	delete(m.clearedFields, goldlifecycleos.FieldSuppressedUntil)
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (m *GoldLifecycleOSMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
+}
+
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *GoldLifecycleOSMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolvedAt returns the old "resolved_at" field's value of the GoldLifecycleOS entity.
+// If the GoldLifecycleOS object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldLifecycleOSMutation) OldResolvedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+	}
+	return oldValue.ResolvedAt, nil
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *GoldLifecycleOSMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[goldlifecycleos.FieldResolvedAt] = struct{}{}
+}
+
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *GoldLifecycleOSMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[goldlifecycleos.FieldResolvedAt]
+	return ok
+}
+
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *GoldLifecycleOSMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, goldlifecycleos.FieldResolvedAt)
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *GoldLifecycleOSMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *GoldLifecycleOSMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the GoldLifecycleOS entity.
+// If the GoldLifecycleOS object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldLifecycleOSMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *GoldLifecycleOSMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+}
+
 // SetMachineID sets the "machine_id" field.
 func (m *GoldLifecycleOSMutation) SetMachineID(s string) {
 	m.machine_id = &s
@@ -827,13 +1101,31 @@ func (m *GoldLifecycleOSMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *GoldLifecycleOSMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+	fields := make([]string, 0, 20)
 	if m.detected_at != nil {
 		fields = append(fields, goldlifecycleos.FieldDetectedAt)
 	}
 	if m.first_detected_at != nil {
 		fields = append(fields, goldlifecycleos.FieldFirstDetectedAt)
 	}
+	if m.status != nil {
+		fields = append(fields, goldlifecycleos.FieldStatus)
+	}
+	if m.assignee != nil {
+		fields = append(fields, goldlifecycleos.FieldAssignee)
+	}
+	if m.note != nil {
+		fields = append(fields, goldlifecycleos.FieldNote)
+	}
+	if m.suppressed_until != nil {
+		fields = append(fields, goldlifecycleos.FieldSuppressedUntil)
+	}
+	if m.resolved_at != nil {
+		fields = append(fields, goldlifecycleos.FieldResolvedAt)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, goldlifecycleos.FieldLastSeenAt)
+	}
 	if m.machine_id != nil {
 		fields = append(fields, goldlifecycleos.FieldMachineID)
 	}
@@ -882,6 +1174,18 @@ func (m *GoldLifecycleOSMutation) Field(name string) (ent.Value, bool) {
 		return m.DetectedAt()
 	case goldlifecycleos.FieldFirstDetectedAt:
 		return m.FirstDetectedAt()
+	case goldlifecycleos.FieldStatus:
+		return m.Status()
+	case goldlifecycleos.FieldAssignee:
+		return m.Assignee()
+	case goldlifecycleos.FieldNote:
+		return m.Note()
+	case goldlifecycleos.FieldSuppressedUntil:
+		return m.SuppressedUntil()
+	case goldlifecycleos.FieldResolvedAt:
+		return m.ResolvedAt()
+	case goldlifecycleos.FieldLastSeenAt:
+		return m.LastSeenAt()
 	case goldlifecycleos.FieldMachineID:
 		return m.MachineID()
 	case goldlifecycleos.FieldHostname:
@@ -919,6 +1223,18 @@ func (m *GoldLifecycleOSMutation) OldField(ctx context.Context, name string) (en
 		return m.OldDetectedAt(ctx)
 	case goldlifecycleos.FieldFirstDetectedAt:
 		return m.OldFirstDetectedAt(ctx)
+	case goldlifecycleos.FieldStatus:
+		return m.OldStatus(ctx)
+	case goldlifecycleos.FieldAssignee:
+		return m.OldAssignee(ctx)
+	case goldlifecycleos.FieldNote:
+		return m.OldNote(ctx)
+	case goldlifecycleos.FieldSuppressedUntil:
+		return m.OldSuppressedUntil(ctx)
+	case goldlifecycleos.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
+	case goldlifecycleos.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
 	case goldlifecycleos.FieldMachineID:
 		return m.OldMachineID(ctx)
 	case goldlifecycleos.FieldHostname:
@@ -966,6 +1282,48 @@ func (m *GoldLifecycleOSMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetFirstDetectedAt(v)
 		return nil
+	case goldlifecycleos.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case goldlifecycleos.FieldAssignee:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignee(v)
+		return nil
+	case goldlifecycleos.FieldNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNote(v)
+		return nil
+	case goldlifecycleos.FieldSuppressedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuppressedUntil(v)
+		return nil
+	case goldlifecycleos.FieldResolvedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedAt(v)
+		return nil
+	case goldlifecycleos.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
 	case goldlifecycleos.FieldMachineID:
 		v, ok := value.(string)
 		if !ok {
@@ -1080,6 +1438,18 @@ func (m *GoldLifecycleOSMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *GoldLifecycleOSMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(goldlifecycleos.FieldAssignee) {
+		fields = append(fields, goldlifecycleos.FieldAssignee)
+	}
+	if m.FieldCleared(goldlifecycleos.FieldNote) {
+		fields = append(fields, goldlifecycleos.FieldNote)
+	}
+	if m.FieldCleared(goldlifecycleos.FieldSuppressedUntil) {
+		fields = append(fields, goldlifecycleos.FieldSuppressedUntil)
+	}
+	if m.FieldCleared(goldlifecycleos.FieldResolvedAt) {
+		fields = append(fields, goldlifecycleos.FieldResolvedAt)
+	}
 	if m.FieldCleared(goldlifecycleos.FieldHostname) {
 		fields = append(fields, goldlifecycleos.FieldHostname)
 	}
@@ -1124,6 +1494,18 @@ func (m *GoldLifecycleOSMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *GoldLifecycleOSMutation) ClearField(name string) error {
 	switch name {
+	case goldlifecycleos.FieldAssignee:
+		m.ClearAssignee()
+		return nil
+	case goldlifecycleos.FieldNote:
+		m.ClearNote()
+		return nil
+	case goldlifecycleos.FieldSuppressedUntil:
+		m.ClearSuppressedUntil()
+		return nil
+	case goldlifecycleos.FieldResolvedAt:
+		m.ClearResolvedAt()
+		return nil
 	case goldlifecycleos.FieldHostname:
 		m.ClearHostname()
 		return nil
@@ -1168,6 +1550,24 @@ func (m *GoldLifecycleOSMutation) ResetField(name string) error {
 	case goldlifecycleos.FieldFirstDetectedAt:
 		m.ResetFirstDetectedAt()
 		return nil
+	case goldlifecycleos.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case goldlifecycleos.FieldAssignee:
+		m.ResetAssignee()
+		return nil
+	case goldlifecycleos.FieldNote:
+		m.ResetNote()
+		return nil
+	case goldlifecycleos.FieldSuppressedUntil:
+		m.ResetSuppressedUntil()
+		return nil
+	case goldlifecycleos.FieldResolvedAt:
+		m.ResetResolvedAt()
+		return nil
+	case goldlifecycleos.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
 	case goldlifecycleos.FieldMachineID:
 		m.ResetMachineID()
 		return nil
@@ -1264,6 +1664,12 @@ type GoldLifecycleSoftwareMutation struct {
 	id                *string
 	detected_at       *time.Time
 	first_detected_at *time.Time
+	status            *string
+	assignee          *string
+	note              *string
+	suppressed_until  *time.Time
+	resolved_at       *time.Time
+	last_seen_at      *time.Time
 	machine_id        *string
 	name              *string
 	version           *string
@@ -1459,6 +1865,274 @@ func (m *GoldLifecycleSoftwareMutation) ResetFirstDetectedAt() {
 	m.first_detected_at = nil
 }
 
+// SetStatus sets the "status" field.
+func (m *GoldLifecycleSoftwareMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *GoldLifecycleSoftwareMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the GoldLifecycleSoftware entity.
+// If the GoldLifecycleSoftware object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldLifecycleSoftwareMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *GoldLifecycleSoftwareMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAssignee sets the "assignee" field.
+func (m *GoldLifecycleSoftwareMutation) SetAssignee(s string) {
+	m.assignee = &s
+}
+
+// Assignee returns the value of the "assignee" field in the mutation.
+func (m *GoldLifecycleSoftwareMutation) Assignee() (r string, exists bool) {
+	v := m.assignee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignee returns the old "assignee" field's value of the GoldLifecycleSoftware entity.
+// If the GoldLifecycleSoftware object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldLifecycleSoftwareMutation) OldAssignee(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignee: %w", err)
+	}
+	return oldValue.Assignee, nil
+}
+
+// ClearAssignee clears the value of the "assignee" field.
+func (m *GoldLifecycleSoftwareMutation) ClearAssignee() {
+	m.assignee = nil
+	m.clearedFields[goldlifecyclesoftware.FieldAssignee] = struct{}{}
+}
+
+// AssigneeCleared returns if the "assignee" field was cleared in this mutation.
+func (m *GoldLifecycleSoftwareMutation) AssigneeCleared() bool {
+	_, ok := m.clearedFields[goldlifecyclesoftware.FieldAssignee]
+	return ok
+}
+
+// ResetAssignee resets all changes to the "assignee" field.
+func (m *GoldLifecycleSoftwareMutation) ResetAssignee() {
+	m.assignee = nil
+	delete(m.clearedFields, goldlifecyclesoftware.FieldAssignee)
+}
+
+// SetNote sets the "note" field.
+func (m *GoldLifecycleSoftwareMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *GoldLifecycleSoftwareMutation) Note() (r string, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNote returns the old "note" field's value of the GoldLifecycleSoftware entity.
+// If the GoldLifecycleSoftware object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldLifecycleSoftwareMutation) OldNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+	}
+	return oldValue.Note, nil
+}
+
+// ClearNote clears the value of the "note" field.
+func (m *GoldLifecycleSoftwareMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[goldlifecyclesoftware.FieldNote] = struct{}{}
+}
+
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *GoldLifecycleSoftwareMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[goldlifecyclesoftware.FieldNote]
+	return ok
+}
+
+// ResetNote resets all changes to the "note" field.
+func (m *GoldLifecycleSoftwareMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, goldlifecyclesoftware.FieldNote)
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (m *GoldLifecycleSoftwareMutation) SetSuppressedUntil(t time.Time) {
+	m.suppressed_until = &t
+}
+
+// SuppressedUntil returns the value of the "suppressed_until" field in the mutation.
+func (m *GoldLifecycleSoftwareMutation) SuppressedUntil() (r time.Time, exists bool) {
+	v := m.suppressed_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuppressedUntil returns the old "suppressed_until" field's value of the GoldLifecycleSoftware entity.
+// If the GoldLifecycleSoftware object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldLifecycleSoftwareMutation) OldSuppressedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuppressedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuppressedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuppressedUntil: %w", err)
+	}
+	return oldValue.SuppressedUntil, nil
+}
+
+// ClearSuppressedUntil clears the value of the "suppressed_until" field.
+func (m *GoldLifecycleSoftwareMutation) ClearSuppressedUntil() {
+	m.suppressed_until = nil
+	m.clearedFields[goldlifecyclesoftware.FieldSuppressedUntil] = struct{}{}
+}
+
+// SuppressedUntilCleared returns if the "suppressed_until" field was cleared in this mutation.
+func (m *GoldLifecycleSoftwareMutation) SuppressedUntilCleared() bool {
+	_, ok := m.clearedFields[goldlifecyclesoftware.FieldSuppressedUntil]
+	return ok
+}
+
+// ResetSuppressedUntil resets all changes to the "suppressed_until" field.
+func (m *GoldLifecycleSoftwareMutation) ResetSuppressedUntil() {
+	m.suppressed_until = nil
+	delete(m.clearedFields, goldlifecyclesoftware.FieldSuppressedUntil)
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (m *GoldLifecycleSoftwareMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
+}
+
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *GoldLifecycleSoftwareMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolvedAt returns the old "resolved_at" field's value of the GoldLifecycleSoftware entity.
+// If the GoldLifecycleSoftware object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldLifecycleSoftwareMutation) OldResolvedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+	}
+	return oldValue.ResolvedAt, nil
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *GoldLifecycleSoftwareMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[goldlifecyclesoftware.FieldResolvedAt] = struct{}{}
+}
+
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *GoldLifecycleSoftwareMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[goldlifecyclesoftware.FieldResolvedAt]
+	return ok
+}
+
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *GoldLifecycleSoftwareMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, goldlifecyclesoftware.FieldResolvedAt)
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *GoldLifecycleSoftwareMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *GoldLifecycleSoftwareMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the GoldLifecycleSoftware entity.
+// If the GoldLifecycleSoftware object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldLifecycleSoftwareMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *GoldLifecycleSoftwareMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+}
+
 // SetMachineID sets the "machine_id" field.
 func (m *GoldLifecycleSoftwareMutation) SetMachineID(s string) {
 	m.machine_id = &s
@@ -2078,13 +2752,31 @@ func (m *GoldLifecycleSoftwareMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *GoldLifecycleSoftwareMutation) Fields() []string {
-	fields := make([]string, 0, 15)
+	fields := make([]string, 0, 21)
 	if m.detected_at != nil {
 		fields = append(fields, goldlifecyclesoftware.FieldDetectedAt)
 	}
 	if m.first_detected_at != nil {
 		fields = append(fields, goldlifecyclesoftware.FieldFirstDetectedAt)
 	}
+	if m.status != nil {
+		fields = append(fields, goldlifecyclesoftware.FieldStatus)
+	}
+	if m.assignee != nil {
+		fields = append(fields, goldlifecyclesoftware.FieldAssignee)
+	}
+	if m.note != nil {
+		fields = append(fields, goldlifecyclesoftware.FieldNote)
+	}
+	if m.suppressed_until != nil {
+		fields = append(fields, goldlifecyclesoftware.FieldSuppressedUntil)
+	}
+	if m.resolved_at != nil {
+		fields = append(fields, goldlifecyclesoftware.FieldResolvedAt)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, goldlifecyclesoftware.FieldLastSeenAt)
+	}
 	if m.machine_id != nil {
 		fields = append(fields, goldlifecyclesoftware.FieldMachineID)
 	}
@@ -2136,6 +2828,18 @@ func (m *GoldLifecycleSoftwareMutation) Field(name string) (ent.Value, bool) {
 		return m.DetectedAt()
 	case goldlifecyclesoftware.FieldFirstDetectedAt:
 		return m.FirstDetectedAt()
+	case goldlifecyclesoftware.FieldStatus:
+		return m.Status()
+	case goldlifecyclesoftware.FieldAssignee:
+		return m.Assignee()
+	case goldlifecyclesoftware.FieldNote:
+		return m.Note()
+	case goldlifecyclesoftware.FieldSuppressedUntil:
+		return m.SuppressedUntil()
+	case goldlifecyclesoftware.FieldResolvedAt:
+		return m.ResolvedAt()
+	case goldlifecyclesoftware.FieldLastSeenAt:
+		return m.LastSeenAt()
 	case goldlifecyclesoftware.FieldMachineID:
 		return m.MachineID()
 	case goldlifecyclesoftware.FieldName:
@@ -2175,6 +2879,18 @@ func (m *GoldLifecycleSoftwareMutation) OldField(ctx context.Context, name strin
 		return m.OldDetectedAt(ctx)
 	case goldlifecyclesoftware.FieldFirstDetectedAt:
 		return m.OldFirstDetectedAt(ctx)
+	case goldlifecyclesoftware.FieldStatus:
+		return m.OldStatus(ctx)
+	case goldlifecyclesoftware.FieldAssignee:
+		return m.OldAssignee(ctx)
+	case goldlifecyclesoftware.FieldNote:
+		return m.OldNote(ctx)
+	case goldlifecyclesoftware.FieldSuppressedUntil:
+		return m.OldSuppressedUntil(ctx)
+	case goldlifecyclesoftware.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
+	case goldlifecyclesoftware.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
 	case goldlifecyclesoftware.FieldMachineID:
 		return m.OldMachineID(ctx)
 	case goldlifecyclesoftware.FieldName:
@@ -2224,6 +2940,48 @@ func (m *GoldLifecycleSoftwareMutation) SetField(name string, value ent.Value) e
 		}
 		m.SetFirstDetectedAt(v)
 		return nil
+	case goldlifecyclesoftware.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case goldlifecyclesoftware.FieldAssignee:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignee(v)
+		return nil
+	case goldlifecyclesoftware.FieldNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNote(v)
+		return nil
+	case goldlifecyclesoftware.FieldSuppressedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuppressedUntil(v)
+		return nil
+	case goldlifecyclesoftware.FieldResolvedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedAt(v)
+		return nil
+	case goldlifecyclesoftware.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
 	case goldlifecyclesoftware.FieldMachineID:
 		v, ok := value.(string)
 		if !ok {
@@ -2345,6 +3103,18 @@ func (m *GoldLifecycleSoftwareMutation) AddField(name string, value ent.Value) e
 // mutation.
 func (m *GoldLifecycleSoftwareMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(goldlifecyclesoftware.FieldAssignee) {
+		fields = append(fields, goldlifecyclesoftware.FieldAssignee)
+	}
+	if m.FieldCleared(goldlifecyclesoftware.FieldNote) {
+		fields = append(fields, goldlifecyclesoftware.FieldNote)
+	}
+	if m.FieldCleared(goldlifecyclesoftware.FieldSuppressedUntil) {
+		fields = append(fields, goldlifecyclesoftware.FieldSuppressedUntil)
+	}
+	if m.FieldCleared(goldlifecyclesoftware.FieldResolvedAt) {
+		fields = append(fields, goldlifecyclesoftware.FieldResolvedAt)
+	}
 	if m.FieldCleared(goldlifecyclesoftware.FieldVersion) {
 		fields = append(fields, goldlifecyclesoftware.FieldVersion)
 	}
@@ -2386,6 +3156,18 @@ func (m *GoldLifecycleSoftwareMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *GoldLifecycleSoftwareMutation) ClearField(name string) error {
 	switch name {
+	case goldlifecyclesoftware.FieldAssignee:
+		m.ClearAssignee()
+		return nil
+	case goldlifecyclesoftware.FieldNote:
+		m.ClearNote()
+		return nil
+	case goldlifecyclesoftware.FieldSuppressedUntil:
+		m.ClearSuppressedUntil()
+		return nil
+	case goldlifecyclesoftware.FieldResolvedAt:
+		m.ClearResolvedAt()
+		return nil
 	case goldlifecyclesoftware.FieldVersion:
 		m.ClearVersion()
 		return nil
@@ -2427,6 +3209,24 @@ func (m *GoldLifecycleSoftwareMutation) ResetField(name string) error {
 	case goldlifecyclesoftware.FieldFirstDetectedAt:
 		m.ResetFirstDetectedAt()
 		return nil
+	case goldlifecyclesoftware.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case goldlifecyclesoftware.FieldAssignee:
+		m.ResetAssignee()
+		return nil
+	case goldlifecyclesoftware.FieldNote:
+		m.ResetNote()
+		return nil
+	case goldlifecyclesoftware.FieldSuppressedUntil:
+		m.ResetSuppressedUntil()
+		return nil
+	case goldlifecyclesoftware.FieldResolvedAt:
+		m.ResetResolvedAt()
+		return nil
+	case goldlifecyclesoftware.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
 	case goldlifecyclesoftware.FieldMachineID:
 		m.ResetMachineID()
 		return nil
@@ -12,8 +12,17 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	goldlifecycleosMixin := schema.GoldLifecycleOS{}.Mixin()
+	goldlifecycleosMixinFields1 := goldlifecycleosMixin[1].Fields()
+	_ = goldlifecycleosMixinFields1
 	goldlifecycleosFields := schema.GoldLifecycleOS{}.Fields()
 	_ = goldlifecycleosFields
+	// goldlifecycleosDescStatus is the schema descriptor for status field.
+	goldlifecycleosDescStatus := goldlifecycleosMixinFields1[0].Descriptor()
+	// goldlifecycleos.DefaultStatus holds the default value on creation for the status field.
+	goldlifecycleos.DefaultStatus = goldlifecycleosDescStatus.Default.(string)
+	// goldlifecycleos.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	goldlifecycleos.StatusValidator = goldlifecycleosDescStatus.Validators[0].(func(string) error)
 	// goldlifecycleosDescMachineID is the schema descriptor for machine_id field.
 	goldlifecycleosDescMachineID := goldlifecycleosFields[1].Descriptor()
 	// goldlifecycleos.MachineIDValidator is a validator for the "machine_id" field. It is called by the builders before save.
@@ -22,8 +31,17 @@ func init() {
 	goldlifecycleosDescEolStatus := goldlifecycleosFields[11].Descriptor()
 	// goldlifecycleos.EolStatusValidator is a validator for the "eol_status" field. It is called by the builders before save.
 	goldlifecycleos.EolStatusValidator = goldlifecycleosDescEolStatus.Validators[0].(func(string) error)
+	goldlifecyclesoftwareMixin := schema.GoldLifecycleSoftware{}.Mixin()
+	goldlifecyclesoftwareMixinFields1 := goldlifecyclesoftwareMixin[1].Fields()
+	_ = goldlifecyclesoftwareMixinFields1
 	goldlifecyclesoftwareFields := schema.GoldLifecycleSoftware{}.Fields()
 	_ = goldlifecyclesoftwareFields
+	// goldlifecyclesoftwareDescStatus is the schema descriptor for status field.
+	goldlifecyclesoftwareDescStatus := goldlifecyclesoftwareMixinFields1[0].Descriptor()
+	// goldlifecyclesoftware.DefaultStatus holds the default value on creation for the status field.
+	goldlifecyclesoftware.DefaultStatus = goldlifecyclesoftwareDescStatus.Default.(string)
+	// goldlifecyclesoftware.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	goldlifecyclesoftware.StatusValidator = goldlifecyclesoftwareDescStatus.Validators[0].(func(string) error)
 	// goldlifecyclesoftwareDescMachineID is the schema descriptor for machine_id field.
 	goldlifecyclesoftwareDescMachineID := goldlifecyclesoftwareFields[1].Descriptor()
 	// goldlifecyclesoftware.MachineIDValidator is a validator for the "machine_id" field. It is called by the builders before save.
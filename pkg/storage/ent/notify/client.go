@@ -0,0 +1,356 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+
+	"danny.vn/hotpot/pkg/storage/ent/notify/migrate"
+
+	"danny.vn/hotpot/pkg/storage/ent/notify/goldnotifydelivery"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"danny.vn/hotpot/pkg/storage/ent/notify/internal"
+)
+
+// Client is the client that holds all ent builders.
+type Client struct {
+	config
+	// Schema is the client for creating, migrating and dropping schema.
+	Schema *migrate.Schema
+	// GoldNotifyDelivery is the client for interacting with the GoldNotifyDelivery builders.
+	GoldNotifyDelivery *GoldNotifyDeliveryClient
+}
+
+// NewClient creates a new client configured with the given options.
+func NewClient(opts ...Option) *Client {
+	client := &Client{config: newConfig(opts...)}
+	client.init()
+	return client
+}
+
+func (c *Client) init() {
+	c.Schema = migrate.NewSchema(c.driver)
+	c.GoldNotifyDelivery = NewGoldNotifyDeliveryClient(c.config)
+}
+
+type (
+	// config is the configuration for the client and its builder.
+	config struct {
+		// driver used for executing database requests.
+		driver dialect.Driver
+		// debug enable a debug logging.
+		debug bool
+		// log used for logging on debug mode.
+		log func(...any)
+		// hooks to execute on mutations.
+		hooks *hooks
+		// interceptors to execute on queries.
+		inters *inters
+		// schemaConfig contains alternative names for all tables.
+		schemaConfig SchemaConfig
+	}
+	// Option function to configure the client.
+	Option func(*config)
+)
+
+// newConfig creates a new config for the client.
+func newConfig(opts ...Option) config {
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
+	cfg.options(opts...)
+	return cfg
+}
+
+// options applies the options on the config object.
+func (c *config) options(opts ...Option) {
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.debug {
+		c.driver = dialect.Debug(c.driver, c.log)
+	}
+}
+
+// Debug enables debug logging on the ent.Driver.
+func Debug() Option {
+	return func(c *config) {
+		c.debug = true
+	}
+}
+
+// Log sets the logging function for debug mode.
+func Log(fn func(...any)) Option {
+	return func(c *config) {
+		c.log = fn
+	}
+}
+
+// Driver configures the client driver.
+func Driver(driver dialect.Driver) Option {
+	return func(c *config) {
+		c.driver = driver
+	}
+}
+
+// Open opens a database/sql.DB specified by the driver name and
+// the data source name, and returns a new client attached to it.
+// Optional parameters can be added for configuring the client.
+func Open(driverName, dataSourceName string, options ...Option) (*Client, error) {
+	switch driverName {
+	case dialect.MySQL, dialect.Postgres, dialect.SQLite:
+		drv, err := sql.Open(driverName, dataSourceName)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(append(options, Driver(drv))...), nil
+	default:
+		return nil, fmt.Errorf("unsupported driver: %q", driverName)
+	}
+}
+
+// ErrTxStarted is returned when trying to start a new transaction from a transactional client.
+var ErrTxStarted = errors.New("notify: cannot start a transaction within a transaction")
+
+// Tx returns a new transactional client. The provided context
+// is used until the transaction is committed or rolled back.
+func (c *Client) Tx(ctx context.Context) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, ErrTxStarted
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("notify: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	return &Tx{
+		ctx:                ctx,
+		config:             cfg,
+		GoldNotifyDelivery: NewGoldNotifyDeliveryClient(cfg),
+	}, nil
+}
+
+// BeginTx returns a transactional client with specified options.
+func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, errors.New("ent: cannot start a transaction within a transaction")
+	}
+	tx, err := c.driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}).BeginTx(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = &txDriver{tx: tx, drv: c.driver}
+	return &Tx{
+		ctx:                ctx,
+		config:             cfg,
+		GoldNotifyDelivery: NewGoldNotifyDeliveryClient(cfg),
+	}, nil
+}
+
+// Debug returns a new debug-client. It's used to get verbose logging on specific operations.
+//
+//	client.Debug().
+//		GoldNotifyDelivery.
+//		Query().
+//		Count(ctx)
+func (c *Client) Debug() *Client {
+	if c.debug {
+		return c
+	}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// Close closes the database connection and prevents new queries from starting.
+func (c *Client) Close() error {
+	return c.driver.Close()
+}
+
+// Use adds the mutation hooks to all the entity clients.
+// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
+func (c *Client) Use(hooks ...Hook) {
+	c.GoldNotifyDelivery.Use(hooks...)
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.GoldNotifyDelivery.Intercept(interceptors...)
+}
+
+// Mutate implements the ent.Mutator interface.
+func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
+	switch m := m.(type) {
+	case *GoldNotifyDeliveryMutation:
+		return c.GoldNotifyDelivery.mutate(ctx, m)
+	default:
+		return nil, fmt.Errorf("notify: unknown mutation type %T", m)
+	}
+}
+
+// GoldNotifyDeliveryClient is a client for the GoldNotifyDelivery schema.
+type GoldNotifyDeliveryClient struct {
+	config
+}
+
+// NewGoldNotifyDeliveryClient returns a client for the GoldNotifyDelivery from the given config.
+func NewGoldNotifyDeliveryClient(c config) *GoldNotifyDeliveryClient {
+	return &GoldNotifyDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `goldnotifydelivery.Hooks(f(g(h())))`.
+func (c *GoldNotifyDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.GoldNotifyDelivery = append(c.hooks.GoldNotifyDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `goldnotifydelivery.Intercept(f(g(h())))`.
+func (c *GoldNotifyDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GoldNotifyDelivery = append(c.inters.GoldNotifyDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a GoldNotifyDelivery entity.
+func (c *GoldNotifyDeliveryClient) Create() *GoldNotifyDeliveryCreate {
+	mutation := newGoldNotifyDeliveryMutation(c.config, OpCreate)
+	return &GoldNotifyDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GoldNotifyDelivery entities.
+func (c *GoldNotifyDeliveryClient) CreateBulk(builders ...*GoldNotifyDeliveryCreate) *GoldNotifyDeliveryCreateBulk {
+	return &GoldNotifyDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GoldNotifyDeliveryClient) MapCreateBulk(slice any, setFunc func(*GoldNotifyDeliveryCreate, int)) *GoldNotifyDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GoldNotifyDeliveryCreateBulk{err: fmt.Errorf("calling to GoldNotifyDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GoldNotifyDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GoldNotifyDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GoldNotifyDelivery.
+func (c *GoldNotifyDeliveryClient) Update() *GoldNotifyDeliveryUpdate {
+	mutation := newGoldNotifyDeliveryMutation(c.config, OpUpdate)
+	return &GoldNotifyDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GoldNotifyDeliveryClient) UpdateOne(_m *GoldNotifyDelivery) *GoldNotifyDeliveryUpdateOne {
+	mutation := newGoldNotifyDeliveryMutation(c.config, OpUpdateOne, withGoldNotifyDelivery(_m))
+	return &GoldNotifyDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GoldNotifyDeliveryClient) UpdateOneID(id int) *GoldNotifyDeliveryUpdateOne {
+	mutation := newGoldNotifyDeliveryMutation(c.config, OpUpdateOne, withGoldNotifyDeliveryID(id))
+	return &GoldNotifyDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GoldNotifyDelivery.
+func (c *GoldNotifyDeliveryClient) Delete() *GoldNotifyDeliveryDelete {
+	mutation := newGoldNotifyDeliveryMutation(c.config, OpDelete)
+	return &GoldNotifyDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GoldNotifyDeliveryClient) DeleteOne(_m *GoldNotifyDelivery) *GoldNotifyDeliveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GoldNotifyDeliveryClient) DeleteOneID(id int) *GoldNotifyDeliveryDeleteOne {
+	builder := c.Delete().Where(goldnotifydelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GoldNotifyDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for GoldNotifyDelivery.
+func (c *GoldNotifyDeliveryClient) Query() *GoldNotifyDeliveryQuery {
+	return &GoldNotifyDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGoldNotifyDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GoldNotifyDelivery entity by its id.
+func (c *GoldNotifyDeliveryClient) Get(ctx context.Context, id int) (*GoldNotifyDelivery, error) {
+	return c.Query().Where(goldnotifydelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GoldNotifyDeliveryClient) GetX(ctx context.Context, id int) *GoldNotifyDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GoldNotifyDeliveryClient) Hooks() []Hook {
+	return c.hooks.GoldNotifyDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *GoldNotifyDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.GoldNotifyDelivery
+}
+
+func (c *GoldNotifyDeliveryClient) mutate(ctx context.Context, m *GoldNotifyDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GoldNotifyDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GoldNotifyDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GoldNotifyDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GoldNotifyDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("notify: unknown GoldNotifyDelivery mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		GoldNotifyDelivery []ent.Hook
+	}
+	inters struct {
+		GoldNotifyDelivery []ent.Interceptor
+	}
+)
+
+// SchemaConfig represents alternative schema names for all tables
+// that can be passed at runtime.
+type SchemaConfig = internal.SchemaConfig
+
+// AlternateSchemas allows alternate schema names to be
+// passed into ent operations.
+func AlternateSchema(schemaConfig SchemaConfig) Option {
+	return func(c *config) {
+		c.schemaConfig = schemaConfig
+	}
+}
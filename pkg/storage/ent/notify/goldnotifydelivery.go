@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notify
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/notify/goldnotifydelivery"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// GoldNotifyDelivery is the model entity for the GoldNotifyDelivery schema.
+type GoldNotifyDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// RuleKey holds the value of the "rule_key" field.
+	RuleKey string `json:"rule_key,omitempty"`
+	// httpmonitor, lifecycle_software, lifecycle_os
+	Source string `json:"source,omitempty"`
+	// resource_id of the finding
+	FindingID string `json:"finding_id,omitempty"`
+	// Destination name from config
+	Destination string `json:"destination,omitempty"`
+	// sent, failed, rate_limited
+	Status string `json:"status,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GoldNotifyDelivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case goldnotifydelivery.FieldID:
+			values[i] = new(sql.NullInt64)
+		case goldnotifydelivery.FieldRuleKey, goldnotifydelivery.FieldSource, goldnotifydelivery.FieldFindingID, goldnotifydelivery.FieldDestination, goldnotifydelivery.FieldStatus, goldnotifydelivery.FieldError:
+			values[i] = new(sql.NullString)
+		case goldnotifydelivery.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GoldNotifyDelivery fields.
+func (_m *GoldNotifyDelivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case goldnotifydelivery.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case goldnotifydelivery.FieldRuleKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_key", values[i])
+			} else if value.Valid {
+				_m.RuleKey = value.String
+			}
+		case goldnotifydelivery.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case goldnotifydelivery.FieldFindingID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field finding_id", values[i])
+			} else if value.Valid {
+				_m.FindingID = value.String
+			}
+		case goldnotifydelivery.FieldDestination:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field destination", values[i])
+			} else if value.Valid {
+				_m.Destination = value.String
+			}
+		case goldnotifydelivery.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case goldnotifydelivery.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case goldnotifydelivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GoldNotifyDelivery.
+// This includes values selected through modifiers, order, etc.
+func (_m *GoldNotifyDelivery) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GoldNotifyDelivery.
+// Note that you need to call GoldNotifyDelivery.Unwrap() before calling this method if this GoldNotifyDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GoldNotifyDelivery) Update() *GoldNotifyDeliveryUpdateOne {
+	return NewGoldNotifyDeliveryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GoldNotifyDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GoldNotifyDelivery) Unwrap() *GoldNotifyDelivery {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("notify: GoldNotifyDelivery is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GoldNotifyDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("GoldNotifyDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("rule_key=")
+	builder.WriteString(_m.RuleKey)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("finding_id=")
+	builder.WriteString(_m.FindingID)
+	builder.WriteString(", ")
+	builder.WriteString("destination=")
+	builder.WriteString(_m.Destination)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GoldNotifyDeliveries is a parsable slice of GoldNotifyDelivery.
+type GoldNotifyDeliveries []*GoldNotifyDelivery
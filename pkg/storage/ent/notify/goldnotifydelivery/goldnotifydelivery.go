@@ -0,0 +1,108 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goldnotifydelivery
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the goldnotifydelivery type in the database.
+	Label = "gold_notify_delivery"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "delivery_id"
+	// FieldRuleKey holds the string denoting the rule_key field in the database.
+	FieldRuleKey = "rule_key"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldFindingID holds the string denoting the finding_id field in the database.
+	FieldFindingID = "finding_id"
+	// FieldDestination holds the string denoting the destination field in the database.
+	FieldDestination = "destination"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the goldnotifydelivery in the database.
+	Table = "notify_deliveries"
+)
+
+// Columns holds all SQL columns for goldnotifydelivery fields.
+var Columns = []string{
+	FieldID,
+	FieldRuleKey,
+	FieldSource,
+	FieldFindingID,
+	FieldDestination,
+	FieldStatus,
+	FieldError,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RuleKeyValidator is a validator for the "rule_key" field. It is called by the builders before save.
+	RuleKeyValidator func(string) error
+	// SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	SourceValidator func(string) error
+	// FindingIDValidator is a validator for the "finding_id" field. It is called by the builders before save.
+	FindingIDValidator func(string) error
+	// DestinationValidator is a validator for the "destination" field. It is called by the builders before save.
+	DestinationValidator func(string) error
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the GoldNotifyDelivery queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRuleKey orders the results by the rule_key field.
+func ByRuleKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRuleKey, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByFindingID orders the results by the finding_id field.
+func ByFindingID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFindingID, opts...).ToFunc()
+}
+
+// ByDestination orders the results by the destination field.
+func ByDestination(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDestination, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
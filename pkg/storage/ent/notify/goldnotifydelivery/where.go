@@ -0,0 +1,545 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goldnotifydelivery
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/notify/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldLTE(FieldID, id))
+}
+
+// RuleKey applies equality check predicate on the "rule_key" field. It's identical to RuleKeyEQ.
+func RuleKey(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEQ(FieldRuleKey, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEQ(FieldSource, v))
+}
+
+// FindingID applies equality check predicate on the "finding_id" field. It's identical to FindingIDEQ.
+func FindingID(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEQ(FieldFindingID, v))
+}
+
+// Destination applies equality check predicate on the "destination" field. It's identical to DestinationEQ.
+func Destination(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEQ(FieldDestination, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEQ(FieldStatus, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RuleKeyEQ applies the EQ predicate on the "rule_key" field.
+func RuleKeyEQ(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEQ(FieldRuleKey, v))
+}
+
+// RuleKeyNEQ applies the NEQ predicate on the "rule_key" field.
+func RuleKeyNEQ(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldNEQ(FieldRuleKey, v))
+}
+
+// RuleKeyIn applies the In predicate on the "rule_key" field.
+func RuleKeyIn(vs ...string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldIn(FieldRuleKey, vs...))
+}
+
+// RuleKeyNotIn applies the NotIn predicate on the "rule_key" field.
+func RuleKeyNotIn(vs ...string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldNotIn(FieldRuleKey, vs...))
+}
+
+// RuleKeyGT applies the GT predicate on the "rule_key" field.
+func RuleKeyGT(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldGT(FieldRuleKey, v))
+}
+
+// RuleKeyGTE applies the GTE predicate on the "rule_key" field.
+func RuleKeyGTE(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldGTE(FieldRuleKey, v))
+}
+
+// RuleKeyLT applies the LT predicate on the "rule_key" field.
+func RuleKeyLT(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldLT(FieldRuleKey, v))
+}
+
+// RuleKeyLTE applies the LTE predicate on the "rule_key" field.
+func RuleKeyLTE(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldLTE(FieldRuleKey, v))
+}
+
+// RuleKeyContains applies the Contains predicate on the "rule_key" field.
+func RuleKeyContains(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldContains(FieldRuleKey, v))
+}
+
+// RuleKeyHasPrefix applies the HasPrefix predicate on the "rule_key" field.
+func RuleKeyHasPrefix(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldHasPrefix(FieldRuleKey, v))
+}
+
+// RuleKeyHasSuffix applies the HasSuffix predicate on the "rule_key" field.
+func RuleKeyHasSuffix(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldHasSuffix(FieldRuleKey, v))
+}
+
+// RuleKeyEqualFold applies the EqualFold predicate on the "rule_key" field.
+func RuleKeyEqualFold(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEqualFold(FieldRuleKey, v))
+}
+
+// RuleKeyContainsFold applies the ContainsFold predicate on the "rule_key" field.
+func RuleKeyContainsFold(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldContainsFold(FieldRuleKey, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldContainsFold(FieldSource, v))
+}
+
+// FindingIDEQ applies the EQ predicate on the "finding_id" field.
+func FindingIDEQ(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEQ(FieldFindingID, v))
+}
+
+// FindingIDNEQ applies the NEQ predicate on the "finding_id" field.
+func FindingIDNEQ(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldNEQ(FieldFindingID, v))
+}
+
+// FindingIDIn applies the In predicate on the "finding_id" field.
+func FindingIDIn(vs ...string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldIn(FieldFindingID, vs...))
+}
+
+// FindingIDNotIn applies the NotIn predicate on the "finding_id" field.
+func FindingIDNotIn(vs ...string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldNotIn(FieldFindingID, vs...))
+}
+
+// FindingIDGT applies the GT predicate on the "finding_id" field.
+func FindingIDGT(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldGT(FieldFindingID, v))
+}
+
+// FindingIDGTE applies the GTE predicate on the "finding_id" field.
+func FindingIDGTE(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldGTE(FieldFindingID, v))
+}
+
+// FindingIDLT applies the LT predicate on the "finding_id" field.
+func FindingIDLT(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldLT(FieldFindingID, v))
+}
+
+// FindingIDLTE applies the LTE predicate on the "finding_id" field.
+func FindingIDLTE(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldLTE(FieldFindingID, v))
+}
+
+// FindingIDContains applies the Contains predicate on the "finding_id" field.
+func FindingIDContains(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldContains(FieldFindingID, v))
+}
+
+// FindingIDHasPrefix applies the HasPrefix predicate on the "finding_id" field.
+func FindingIDHasPrefix(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldHasPrefix(FieldFindingID, v))
+}
+
+// FindingIDHasSuffix applies the HasSuffix predicate on the "finding_id" field.
+func FindingIDHasSuffix(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldHasSuffix(FieldFindingID, v))
+}
+
+// FindingIDEqualFold applies the EqualFold predicate on the "finding_id" field.
+func FindingIDEqualFold(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEqualFold(FieldFindingID, v))
+}
+
+// FindingIDContainsFold applies the ContainsFold predicate on the "finding_id" field.
+func FindingIDContainsFold(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldContainsFold(FieldFindingID, v))
+}
+
+// DestinationEQ applies the EQ predicate on the "destination" field.
+func DestinationEQ(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEQ(FieldDestination, v))
+}
+
+// DestinationNEQ applies the NEQ predicate on the "destination" field.
+func DestinationNEQ(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldNEQ(FieldDestination, v))
+}
+
+// DestinationIn applies the In predicate on the "destination" field.
+func DestinationIn(vs ...string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldIn(FieldDestination, vs...))
+}
+
+// DestinationNotIn applies the NotIn predicate on the "destination" field.
+func DestinationNotIn(vs ...string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldNotIn(FieldDestination, vs...))
+}
+
+// DestinationGT applies the GT predicate on the "destination" field.
+func DestinationGT(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldGT(FieldDestination, v))
+}
+
+// DestinationGTE applies the GTE predicate on the "destination" field.
+func DestinationGTE(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldGTE(FieldDestination, v))
+}
+
+// DestinationLT applies the LT predicate on the "destination" field.
+func DestinationLT(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldLT(FieldDestination, v))
+}
+
+// DestinationLTE applies the LTE predicate on the "destination" field.
+func DestinationLTE(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldLTE(FieldDestination, v))
+}
+
+// DestinationContains applies the Contains predicate on the "destination" field.
+func DestinationContains(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldContains(FieldDestination, v))
+}
+
+// DestinationHasPrefix applies the HasPrefix predicate on the "destination" field.
+func DestinationHasPrefix(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldHasPrefix(FieldDestination, v))
+}
+
+// DestinationHasSuffix applies the HasSuffix predicate on the "destination" field.
+func DestinationHasSuffix(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldHasSuffix(FieldDestination, v))
+}
+
+// DestinationEqualFold applies the EqualFold predicate on the "destination" field.
+func DestinationEqualFold(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEqualFold(FieldDestination, v))
+}
+
+// DestinationContainsFold applies the ContainsFold predicate on the "destination" field.
+func DestinationContainsFold(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldContainsFold(FieldDestination, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GoldNotifyDelivery) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GoldNotifyDelivery) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GoldNotifyDelivery) predicate.GoldNotifyDelivery {
+	return predicate.GoldNotifyDelivery(sql.NotPredicates(p))
+}
@@ -0,0 +1,305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/notify/goldnotifydelivery"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GoldNotifyDeliveryCreate is the builder for creating a GoldNotifyDelivery entity.
+type GoldNotifyDeliveryCreate struct {
+	config
+	mutation *GoldNotifyDeliveryMutation
+	hooks    []Hook
+}
+
+// SetRuleKey sets the "rule_key" field.
+func (_c *GoldNotifyDeliveryCreate) SetRuleKey(v string) *GoldNotifyDeliveryCreate {
+	_c.mutation.SetRuleKey(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *GoldNotifyDeliveryCreate) SetSource(v string) *GoldNotifyDeliveryCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetFindingID sets the "finding_id" field.
+func (_c *GoldNotifyDeliveryCreate) SetFindingID(v string) *GoldNotifyDeliveryCreate {
+	_c.mutation.SetFindingID(v)
+	return _c
+}
+
+// SetDestination sets the "destination" field.
+func (_c *GoldNotifyDeliveryCreate) SetDestination(v string) *GoldNotifyDeliveryCreate {
+	_c.mutation.SetDestination(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *GoldNotifyDeliveryCreate) SetStatus(v string) *GoldNotifyDeliveryCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *GoldNotifyDeliveryCreate) SetError(v string) *GoldNotifyDeliveryCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *GoldNotifyDeliveryCreate) SetNillableError(v *string) *GoldNotifyDeliveryCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GoldNotifyDeliveryCreate) SetCreatedAt(v time.Time) *GoldNotifyDeliveryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GoldNotifyDeliveryCreate) SetID(v int) *GoldNotifyDeliveryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the GoldNotifyDeliveryMutation object of the builder.
+func (_c *GoldNotifyDeliveryCreate) Mutation() *GoldNotifyDeliveryMutation {
+	return _c.mutation
+}
+
+// Save creates the GoldNotifyDelivery in the database.
+func (_c *GoldNotifyDeliveryCreate) Save(ctx context.Context) (*GoldNotifyDelivery, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GoldNotifyDeliveryCreate) SaveX(ctx context.Context) *GoldNotifyDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoldNotifyDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoldNotifyDeliveryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GoldNotifyDeliveryCreate) check() error {
+	if _, ok := _c.mutation.RuleKey(); !ok {
+		return &ValidationError{Name: "rule_key", err: errors.New(`notify: missing required field "GoldNotifyDelivery.rule_key"`)}
+	}
+	if v, ok := _c.mutation.RuleKey(); ok {
+		if err := goldnotifydelivery.RuleKeyValidator(v); err != nil {
+			return &ValidationError{Name: "rule_key", err: fmt.Errorf(`notify: validator failed for field "GoldNotifyDelivery.rule_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`notify: missing required field "GoldNotifyDelivery.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := goldnotifydelivery.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`notify: validator failed for field "GoldNotifyDelivery.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FindingID(); !ok {
+		return &ValidationError{Name: "finding_id", err: errors.New(`notify: missing required field "GoldNotifyDelivery.finding_id"`)}
+	}
+	if v, ok := _c.mutation.FindingID(); ok {
+		if err := goldnotifydelivery.FindingIDValidator(v); err != nil {
+			return &ValidationError{Name: "finding_id", err: fmt.Errorf(`notify: validator failed for field "GoldNotifyDelivery.finding_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Destination(); !ok {
+		return &ValidationError{Name: "destination", err: errors.New(`notify: missing required field "GoldNotifyDelivery.destination"`)}
+	}
+	if v, ok := _c.mutation.Destination(); ok {
+		if err := goldnotifydelivery.DestinationValidator(v); err != nil {
+			return &ValidationError{Name: "destination", err: fmt.Errorf(`notify: validator failed for field "GoldNotifyDelivery.destination": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`notify: missing required field "GoldNotifyDelivery.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := goldnotifydelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`notify: validator failed for field "GoldNotifyDelivery.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`notify: missing required field "GoldNotifyDelivery.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *GoldNotifyDeliveryCreate) sqlSave(ctx context.Context) (*GoldNotifyDelivery, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GoldNotifyDeliveryCreate) createSpec() (*GoldNotifyDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GoldNotifyDelivery{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(goldnotifydelivery.Table, sqlgraph.NewFieldSpec(goldnotifydelivery.FieldID, field.TypeInt))
+	)
+	_spec.Schema = _c.schemaConfig.GoldNotifyDelivery
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.RuleKey(); ok {
+		_spec.SetField(goldnotifydelivery.FieldRuleKey, field.TypeString, value)
+		_node.RuleKey = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(goldnotifydelivery.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.FindingID(); ok {
+		_spec.SetField(goldnotifydelivery.FieldFindingID, field.TypeString, value)
+		_node.FindingID = value
+	}
+	if value, ok := _c.mutation.Destination(); ok {
+		_spec.SetField(goldnotifydelivery.FieldDestination, field.TypeString, value)
+		_node.Destination = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(goldnotifydelivery.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(goldnotifydelivery.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(goldnotifydelivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// GoldNotifyDeliveryCreateBulk is the builder for creating many GoldNotifyDelivery entities in bulk.
+type GoldNotifyDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*GoldNotifyDeliveryCreate
+}
+
+// Save creates the GoldNotifyDelivery entities in the database.
+func (_c *GoldNotifyDeliveryCreateBulk) Save(ctx context.Context) ([]*GoldNotifyDelivery, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GoldNotifyDelivery, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GoldNotifyDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GoldNotifyDeliveryCreateBulk) SaveX(ctx context.Context) []*GoldNotifyDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoldNotifyDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoldNotifyDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notify
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/notify/goldnotifydelivery"
+	"danny.vn/hotpot/pkg/storage/ent/notify/internal"
+	"danny.vn/hotpot/pkg/storage/ent/notify/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GoldNotifyDeliveryDelete is the builder for deleting a GoldNotifyDelivery entity.
+type GoldNotifyDeliveryDelete struct {
+	config
+	hooks    []Hook
+	mutation *GoldNotifyDeliveryMutation
+}
+
+// Where appends a list predicates to the GoldNotifyDeliveryDelete builder.
+func (_d *GoldNotifyDeliveryDelete) Where(ps ...predicate.GoldNotifyDelivery) *GoldNotifyDeliveryDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *GoldNotifyDeliveryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GoldNotifyDeliveryDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *GoldNotifyDeliveryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(goldnotifydelivery.Table, sqlgraph.NewFieldSpec(goldnotifydelivery.FieldID, field.TypeInt))
+	_spec.Node.Schema = _d.schemaConfig.GoldNotifyDelivery
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// GoldNotifyDeliveryDeleteOne is the builder for deleting a single GoldNotifyDelivery entity.
+type GoldNotifyDeliveryDeleteOne struct {
+	_d *GoldNotifyDeliveryDelete
+}
+
+// Where appends a list predicates to the GoldNotifyDeliveryDelete builder.
+func (_d *GoldNotifyDeliveryDeleteOne) Where(ps ...predicate.GoldNotifyDelivery) *GoldNotifyDeliveryDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *GoldNotifyDeliveryDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{goldnotifydelivery.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GoldNotifyDeliveryDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
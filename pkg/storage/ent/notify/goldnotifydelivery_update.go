@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"danny.vn/hotpot/pkg/storage/ent/notify/goldnotifydelivery"
+	"danny.vn/hotpot/pkg/storage/ent/notify/internal"
+	"danny.vn/hotpot/pkg/storage/ent/notify/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GoldNotifyDeliveryUpdate is the builder for updating GoldNotifyDelivery entities.
+type GoldNotifyDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GoldNotifyDeliveryMutation
+}
+
+// Where appends a list predicates to the GoldNotifyDeliveryUpdate builder.
+func (_u *GoldNotifyDeliveryUpdate) Where(ps ...predicate.GoldNotifyDelivery) *GoldNotifyDeliveryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the GoldNotifyDeliveryMutation object of the builder.
+func (_u *GoldNotifyDeliveryUpdate) Mutation() *GoldNotifyDeliveryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GoldNotifyDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoldNotifyDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GoldNotifyDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoldNotifyDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *GoldNotifyDeliveryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(goldnotifydelivery.Table, goldnotifydelivery.Columns, sqlgraph.NewFieldSpec(goldnotifydelivery.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(goldnotifydelivery.FieldError, field.TypeString)
+	}
+	_spec.Node.Schema = _u.schemaConfig.GoldNotifyDelivery
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goldnotifydelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GoldNotifyDeliveryUpdateOne is the builder for updating a single GoldNotifyDelivery entity.
+type GoldNotifyDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GoldNotifyDeliveryMutation
+}
+
+// Mutation returns the GoldNotifyDeliveryMutation object of the builder.
+func (_u *GoldNotifyDeliveryUpdateOne) Mutation() *GoldNotifyDeliveryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the GoldNotifyDeliveryUpdate builder.
+func (_u *GoldNotifyDeliveryUpdateOne) Where(ps ...predicate.GoldNotifyDelivery) *GoldNotifyDeliveryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GoldNotifyDeliveryUpdateOne) Select(field string, fields ...string) *GoldNotifyDeliveryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GoldNotifyDelivery entity.
+func (_u *GoldNotifyDeliveryUpdateOne) Save(ctx context.Context) (*GoldNotifyDelivery, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoldNotifyDeliveryUpdateOne) SaveX(ctx context.Context) *GoldNotifyDelivery {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GoldNotifyDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoldNotifyDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *GoldNotifyDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *GoldNotifyDelivery, err error) {
+	_spec := sqlgraph.NewUpdateSpec(goldnotifydelivery.Table, goldnotifydelivery.Columns, sqlgraph.NewFieldSpec(goldnotifydelivery.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`notify: missing "GoldNotifyDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, goldnotifydelivery.FieldID)
+		for _, f := range fields {
+			if !goldnotifydelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("notify: invalid field %q for query", f)}
+			}
+			if f != goldnotifydelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(goldnotifydelivery.FieldError, field.TypeString)
+	}
+	_spec.Node.Schema = _u.schemaConfig.GoldNotifyDelivery
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &GoldNotifyDelivery{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goldnotifydelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,56 @@
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// NotifyDeliveriesColumns holds the columns for the "notify_deliveries" table.
+	NotifyDeliveriesColumns = []*schema.Column{
+		{Name: "delivery_id", Type: field.TypeInt, Increment: true},
+		{Name: "rule_key", Type: field.TypeString},
+		{Name: "source", Type: field.TypeString},
+		{Name: "finding_id", Type: field.TypeString},
+		{Name: "destination", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// NotifyDeliveriesTable holds the schema information for the "notify_deliveries" table.
+	NotifyDeliveriesTable = &schema.Table{
+		Name:       "notify_deliveries",
+		Columns:    NotifyDeliveriesColumns,
+		PrimaryKey: []*schema.Column{NotifyDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "goldnotifydelivery_rule_key_finding_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotifyDeliveriesColumns[1], NotifyDeliveriesColumns[3], NotifyDeliveriesColumns[7]},
+			},
+			{
+				Name:    "goldnotifydelivery_rule_key_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotifyDeliveriesColumns[1], NotifyDeliveriesColumns[7]},
+			},
+			{
+				Name:    "goldnotifydelivery_status",
+				Unique:  false,
+				Columns: []*schema.Column{NotifyDeliveriesColumns[5]},
+			},
+		},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		NotifyDeliveriesTable,
+	}
+)
+
+func init() {
+	NotifyDeliveriesTable.Annotation = &entsql.Annotation{
+		Table: "notify_deliveries",
+	}
+}
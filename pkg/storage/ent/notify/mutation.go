@@ -0,0 +1,706 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/notify/goldnotifydelivery"
+	"danny.vn/hotpot/pkg/storage/ent/notify/predicate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Operation types.
+	OpCreate    = ent.OpCreate
+	OpDelete    = ent.OpDelete
+	OpDeleteOne = ent.OpDeleteOne
+	OpUpdate    = ent.OpUpdate
+	OpUpdateOne = ent.OpUpdateOne
+
+	// Node types.
+	TypeGoldNotifyDelivery = "GoldNotifyDelivery"
+)
+
+// GoldNotifyDeliveryMutation represents an operation that mutates the GoldNotifyDelivery nodes in the graph.
+type GoldNotifyDeliveryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	rule_key      *string
+	source        *string
+	finding_id    *string
+	destination   *string
+	status        *string
+	error         *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*GoldNotifyDelivery, error)
+	predicates    []predicate.GoldNotifyDelivery
+}
+
+var _ ent.Mutation = (*GoldNotifyDeliveryMutation)(nil)
+
+// goldnotifydeliveryOption allows management of the mutation configuration using functional options.
+type goldnotifydeliveryOption func(*GoldNotifyDeliveryMutation)
+
+// newGoldNotifyDeliveryMutation creates new mutation for the GoldNotifyDelivery entity.
+func newGoldNotifyDeliveryMutation(c config, op Op, opts ...goldnotifydeliveryOption) *GoldNotifyDeliveryMutation {
+	m := &GoldNotifyDeliveryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGoldNotifyDelivery,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGoldNotifyDeliveryID sets the ID field of the mutation.
+func withGoldNotifyDeliveryID(id int) goldnotifydeliveryOption {
+	return func(m *GoldNotifyDeliveryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GoldNotifyDelivery
+		)
+		m.oldValue = func(ctx context.Context) (*GoldNotifyDelivery, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GoldNotifyDelivery.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGoldNotifyDelivery sets the old GoldNotifyDelivery of the mutation.
+func withGoldNotifyDelivery(node *GoldNotifyDelivery) goldnotifydeliveryOption {
+	return func(m *GoldNotifyDeliveryMutation) {
+		m.oldValue = func(context.Context) (*GoldNotifyDelivery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GoldNotifyDeliveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GoldNotifyDeliveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("notify: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GoldNotifyDelivery entities.
+func (m *GoldNotifyDeliveryMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GoldNotifyDeliveryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GoldNotifyDeliveryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GoldNotifyDelivery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRuleKey sets the "rule_key" field.
+func (m *GoldNotifyDeliveryMutation) SetRuleKey(s string) {
+	m.rule_key = &s
+}
+
+// RuleKey returns the value of the "rule_key" field in the mutation.
+func (m *GoldNotifyDeliveryMutation) RuleKey() (r string, exists bool) {
+	v := m.rule_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRuleKey returns the old "rule_key" field's value of the GoldNotifyDelivery entity.
+// If the GoldNotifyDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldNotifyDeliveryMutation) OldRuleKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRuleKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRuleKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRuleKey: %w", err)
+	}
+	return oldValue.RuleKey, nil
+}
+
+// ResetRuleKey resets all changes to the "rule_key" field.
+func (m *GoldNotifyDeliveryMutation) ResetRuleKey() {
+	m.rule_key = nil
+}
+
+// SetSource sets the "source" field.
+func (m *GoldNotifyDeliveryMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *GoldNotifyDeliveryMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the GoldNotifyDelivery entity.
+// If the GoldNotifyDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldNotifyDeliveryMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *GoldNotifyDeliveryMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetFindingID sets the "finding_id" field.
+func (m *GoldNotifyDeliveryMutation) SetFindingID(s string) {
+	m.finding_id = &s
+}
+
+// FindingID returns the value of the "finding_id" field in the mutation.
+func (m *GoldNotifyDeliveryMutation) FindingID() (r string, exists bool) {
+	v := m.finding_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFindingID returns the old "finding_id" field's value of the GoldNotifyDelivery entity.
+// If the GoldNotifyDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldNotifyDeliveryMutation) OldFindingID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFindingID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFindingID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFindingID: %w", err)
+	}
+	return oldValue.FindingID, nil
+}
+
+// ResetFindingID resets all changes to the "finding_id" field.
+func (m *GoldNotifyDeliveryMutation) ResetFindingID() {
+	m.finding_id = nil
+}
+
+// SetDestination sets the "destination" field.
+func (m *GoldNotifyDeliveryMutation) SetDestination(s string) {
+	m.destination = &s
+}
+
+// Destination returns the value of the "destination" field in the mutation.
+func (m *GoldNotifyDeliveryMutation) Destination() (r string, exists bool) {
+	v := m.destination
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDestination returns the old "destination" field's value of the GoldNotifyDelivery entity.
+// If the GoldNotifyDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldNotifyDeliveryMutation) OldDestination(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDestination is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDestination requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDestination: %w", err)
+	}
+	return oldValue.Destination, nil
+}
+
+// ResetDestination resets all changes to the "destination" field.
+func (m *GoldNotifyDeliveryMutation) ResetDestination() {
+	m.destination = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *GoldNotifyDeliveryMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *GoldNotifyDeliveryMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the GoldNotifyDelivery entity.
+// If the GoldNotifyDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldNotifyDeliveryMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *GoldNotifyDeliveryMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetError sets the "error" field.
+func (m *GoldNotifyDeliveryMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *GoldNotifyDeliveryMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the GoldNotifyDelivery entity.
+// If the GoldNotifyDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldNotifyDeliveryMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *GoldNotifyDeliveryMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[goldnotifydelivery.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *GoldNotifyDeliveryMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[goldnotifydelivery.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *GoldNotifyDeliveryMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, goldnotifydelivery.FieldError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GoldNotifyDeliveryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GoldNotifyDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GoldNotifyDelivery entity.
+// If the GoldNotifyDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldNotifyDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GoldNotifyDeliveryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the GoldNotifyDeliveryMutation builder.
+func (m *GoldNotifyDeliveryMutation) Where(ps ...predicate.GoldNotifyDelivery) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GoldNotifyDeliveryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GoldNotifyDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GoldNotifyDelivery, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GoldNotifyDeliveryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GoldNotifyDeliveryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GoldNotifyDelivery).
+func (m *GoldNotifyDeliveryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GoldNotifyDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.rule_key != nil {
+		fields = append(fields, goldnotifydelivery.FieldRuleKey)
+	}
+	if m.source != nil {
+		fields = append(fields, goldnotifydelivery.FieldSource)
+	}
+	if m.finding_id != nil {
+		fields = append(fields, goldnotifydelivery.FieldFindingID)
+	}
+	if m.destination != nil {
+		fields = append(fields, goldnotifydelivery.FieldDestination)
+	}
+	if m.status != nil {
+		fields = append(fields, goldnotifydelivery.FieldStatus)
+	}
+	if m.error != nil {
+		fields = append(fields, goldnotifydelivery.FieldError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, goldnotifydelivery.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GoldNotifyDeliveryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case goldnotifydelivery.FieldRuleKey:
+		return m.RuleKey()
+	case goldnotifydelivery.FieldSource:
+		return m.Source()
+	case goldnotifydelivery.FieldFindingID:
+		return m.FindingID()
+	case goldnotifydelivery.FieldDestination:
+		return m.Destination()
+	case goldnotifydelivery.FieldStatus:
+		return m.Status()
+	case goldnotifydelivery.FieldError:
+		return m.Error()
+	case goldnotifydelivery.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GoldNotifyDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case goldnotifydelivery.FieldRuleKey:
+		return m.OldRuleKey(ctx)
+	case goldnotifydelivery.FieldSource:
+		return m.OldSource(ctx)
+	case goldnotifydelivery.FieldFindingID:
+		return m.OldFindingID(ctx)
+	case goldnotifydelivery.FieldDestination:
+		return m.OldDestination(ctx)
+	case goldnotifydelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case goldnotifydelivery.FieldError:
+		return m.OldError(ctx)
+	case goldnotifydelivery.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GoldNotifyDelivery field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoldNotifyDeliveryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case goldnotifydelivery.FieldRuleKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRuleKey(v)
+		return nil
+	case goldnotifydelivery.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case goldnotifydelivery.FieldFindingID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFindingID(v)
+		return nil
+	case goldnotifydelivery.FieldDestination:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDestination(v)
+		return nil
+	case goldnotifydelivery.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case goldnotifydelivery.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case goldnotifydelivery.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GoldNotifyDelivery field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GoldNotifyDeliveryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GoldNotifyDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoldNotifyDeliveryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GoldNotifyDelivery numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GoldNotifyDeliveryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(goldnotifydelivery.FieldError) {
+		fields = append(fields, goldnotifydelivery.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GoldNotifyDeliveryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GoldNotifyDeliveryMutation) ClearField(name string) error {
+	switch name {
+	case goldnotifydelivery.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown GoldNotifyDelivery nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GoldNotifyDeliveryMutation) ResetField(name string) error {
+	switch name {
+	case goldnotifydelivery.FieldRuleKey:
+		m.ResetRuleKey()
+		return nil
+	case goldnotifydelivery.FieldSource:
+		m.ResetSource()
+		return nil
+	case goldnotifydelivery.FieldFindingID:
+		m.ResetFindingID()
+		return nil
+	case goldnotifydelivery.FieldDestination:
+		m.ResetDestination()
+		return nil
+	case goldnotifydelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case goldnotifydelivery.FieldError:
+		m.ResetError()
+		return nil
+	case goldnotifydelivery.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GoldNotifyDelivery field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GoldNotifyDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GoldNotifyDeliveryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GoldNotifyDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GoldNotifyDeliveryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GoldNotifyDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GoldNotifyDeliveryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GoldNotifyDeliveryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown GoldNotifyDelivery unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GoldNotifyDeliveryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown GoldNotifyDelivery edge %s", name)
+}
@@ -0,0 +1,10 @@
+// Code generated by ent, DO NOT EDIT.
+
+package predicate
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+// GoldNotifyDelivery is the predicate function for goldnotifydelivery builders.
+type GoldNotifyDelivery func(*sql.Selector)
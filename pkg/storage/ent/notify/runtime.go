@@ -0,0 +1,36 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notify
+
+import (
+	"danny.vn/hotpot/pkg/storage/ent/notify/goldnotifydelivery"
+	"danny.vn/hotpot/pkg/storage/ent/notify/schema"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	goldnotifydeliveryFields := schema.GoldNotifyDelivery{}.Fields()
+	_ = goldnotifydeliveryFields
+	// goldnotifydeliveryDescRuleKey is the schema descriptor for rule_key field.
+	goldnotifydeliveryDescRuleKey := goldnotifydeliveryFields[1].Descriptor()
+	// goldnotifydelivery.RuleKeyValidator is a validator for the "rule_key" field. It is called by the builders before save.
+	goldnotifydelivery.RuleKeyValidator = goldnotifydeliveryDescRuleKey.Validators[0].(func(string) error)
+	// goldnotifydeliveryDescSource is the schema descriptor for source field.
+	goldnotifydeliveryDescSource := goldnotifydeliveryFields[2].Descriptor()
+	// goldnotifydelivery.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	goldnotifydelivery.SourceValidator = goldnotifydeliveryDescSource.Validators[0].(func(string) error)
+	// goldnotifydeliveryDescFindingID is the schema descriptor for finding_id field.
+	goldnotifydeliveryDescFindingID := goldnotifydeliveryFields[3].Descriptor()
+	// goldnotifydelivery.FindingIDValidator is a validator for the "finding_id" field. It is called by the builders before save.
+	goldnotifydelivery.FindingIDValidator = goldnotifydeliveryDescFindingID.Validators[0].(func(string) error)
+	// goldnotifydeliveryDescDestination is the schema descriptor for destination field.
+	goldnotifydeliveryDescDestination := goldnotifydeliveryFields[4].Descriptor()
+	// goldnotifydelivery.DestinationValidator is a validator for the "destination" field. It is called by the builders before save.
+	goldnotifydelivery.DestinationValidator = goldnotifydeliveryDescDestination.Validators[0].(func(string) error)
+	// goldnotifydeliveryDescStatus is the schema descriptor for status field.
+	goldnotifydeliveryDescStatus := goldnotifydeliveryFields[5].Descriptor()
+	// goldnotifydelivery.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	goldnotifydelivery.StatusValidator = goldnotifydeliveryDescStatus.Validators[0].(func(string) error)
+}
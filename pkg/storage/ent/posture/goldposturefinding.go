@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package posture
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/posture/goldposturefinding"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// GoldPostureFinding is the model entity for the GoldPostureFinding schema.
+type GoldPostureFinding struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// DetectedAt holds the value of the "detected_at" field.
+	DetectedAt time.Time `json:"detected_at,omitempty"`
+	// FirstDetectedAt holds the value of the "first_detected_at" field.
+	FirstDetectedAt time.Time `json:"first_detected_at,omitempty"`
+	// open, acknowledged, suppressed, false_positive, resolved
+	Status string `json:"status,omitempty"`
+	// Assignee holds the value of the "assignee" field.
+	Assignee string `json:"assignee,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// Suppressed findings reopen when re-detected after this time
+	SuppressedUntil *time.Time `json:"suppressed_until,omitempty"`
+	// Set when a finding is no longer detected, cleared on reopen
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// Advances every time the detector observes the finding
+	LastSeenAt time.Time `json:"last_seen_at,omitempty"`
+	// Catalogue rule identifier, e.g. gcp_firewall_open_ssh
+	RuleKey string `json:"rule_key,omitempty"`
+	// network, storage, iam, database, org_policy
+	Category string `json:"category,omitempty"`
+	// info, low, medium, high, critical
+	Severity string `json:"severity,omitempty"`
+	// gcp, aws, ...
+	Provider string `json:"provider,omitempty"`
+	// Bronze table the offending resource lives in, e.g. gcp_compute_firewalls
+	ResourceType string `json:"resource_type,omitempty"`
+	// AssetID holds the value of the "asset_id" field.
+	AssetID string `json:"asset_id,omitempty"`
+	// AssetName holds the value of the "asset_name" field.
+	AssetName string `json:"asset_name,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID string `json:"project_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Remediation holds the value of the "remediation" field.
+	Remediation string `json:"remediation,omitempty"`
+	// EvidenceJSON holds the value of the "evidence_json" field.
+	EvidenceJSON json.RawMessage `json:"evidence_json,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GoldPostureFinding) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case goldposturefinding.FieldEvidenceJSON:
+			values[i] = new([]byte)
+		case goldposturefinding.FieldID, goldposturefinding.FieldStatus, goldposturefinding.FieldAssignee, goldposturefinding.FieldNote, goldposturefinding.FieldRuleKey, goldposturefinding.FieldCategory, goldposturefinding.FieldSeverity, goldposturefinding.FieldProvider, goldposturefinding.FieldResourceType, goldposturefinding.FieldAssetID, goldposturefinding.FieldAssetName, goldposturefinding.FieldProjectID, goldposturefinding.FieldTitle, goldposturefinding.FieldDescription, goldposturefinding.FieldRemediation:
+			values[i] = new(sql.NullString)
+		case goldposturefinding.FieldDetectedAt, goldposturefinding.FieldFirstDetectedAt, goldposturefinding.FieldSuppressedUntil, goldposturefinding.FieldResolvedAt, goldposturefinding.FieldLastSeenAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GoldPostureFinding fields.
+func (_m *GoldPostureFinding) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case goldposturefinding.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case goldposturefinding.FieldDetectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field detected_at", values[i])
+			} else if value.Valid {
+				_m.DetectedAt = value.Time
+			}
+		case goldposturefinding.FieldFirstDetectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_detected_at", values[i])
+			} else if value.Valid {
+				_m.FirstDetectedAt = value.Time
+			}
+		case goldposturefinding.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case goldposturefinding.FieldAssignee:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field assignee", values[i])
+			} else if value.Valid {
+				_m.Assignee = value.String
+			}
+		case goldposturefinding.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case goldposturefinding.FieldSuppressedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field suppressed_until", values[i])
+			} else if value.Valid {
+				_m.SuppressedUntil = new(time.Time)
+				*_m.SuppressedUntil = value.Time
+			}
+		case goldposturefinding.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				_m.ResolvedAt = new(time.Time)
+				*_m.ResolvedAt = value.Time
+			}
+		case goldposturefinding.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				_m.LastSeenAt = value.Time
+			}
+		case goldposturefinding.FieldRuleKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_key", values[i])
+			} else if value.Valid {
+				_m.RuleKey = value.String
+			}
+		case goldposturefinding.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = value.String
+			}
+		case goldposturefinding.FieldSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field severity", values[i])
+			} else if value.Valid {
+				_m.Severity = value.String
+			}
+		case goldposturefinding.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case goldposturefinding.FieldResourceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_type", values[i])
+			} else if value.Valid {
+				_m.ResourceType = value.String
+			}
+		case goldposturefinding.FieldAssetID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field asset_id", values[i])
+			} else if value.Valid {
+				_m.AssetID = value.String
+			}
+		case goldposturefinding.FieldAssetName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field asset_name", values[i])
+			} else if value.Valid {
+				_m.AssetName = value.String
+			}
+		case goldposturefinding.FieldProjectID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				_m.ProjectID = value.String
+			}
+		case goldposturefinding.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case goldposturefinding.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case goldposturefinding.FieldRemediation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remediation", values[i])
+			} else if value.Valid {
+				_m.Remediation = value.String
+			}
+		case goldposturefinding.FieldEvidenceJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field evidence_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EvidenceJSON); err != nil {
+					return fmt.Errorf("unmarshal field evidence_json: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GoldPostureFinding.
+// This includes values selected through modifiers, order, etc.
+func (_m *GoldPostureFinding) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GoldPostureFinding.
+// Note that you need to call GoldPostureFinding.Unwrap() before calling this method if this GoldPostureFinding
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GoldPostureFinding) Update() *GoldPostureFindingUpdateOne {
+	return NewGoldPostureFindingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GoldPostureFinding entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GoldPostureFinding) Unwrap() *GoldPostureFinding {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("posture: GoldPostureFinding is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GoldPostureFinding) String() string {
+	var builder strings.Builder
+	builder.WriteString("GoldPostureFinding(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("detected_at=")
+	builder.WriteString(_m.DetectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_detected_at=")
+	builder.WriteString(_m.FirstDetectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("assignee=")
+	builder.WriteString(_m.Assignee)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	if v := _m.SuppressedUntil; v != nil {
+		builder.WriteString("suppressed_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_seen_at=")
+	builder.WriteString(_m.LastSeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("rule_key=")
+	builder.WriteString(_m.RuleKey)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(_m.Category)
+	builder.WriteString(", ")
+	builder.WriteString("severity=")
+	builder.WriteString(_m.Severity)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("resource_type=")
+	builder.WriteString(_m.ResourceType)
+	builder.WriteString(", ")
+	builder.WriteString("asset_id=")
+	builder.WriteString(_m.AssetID)
+	builder.WriteString(", ")
+	builder.WriteString("asset_name=")
+	builder.WriteString(_m.AssetName)
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(_m.ProjectID)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("remediation=")
+	builder.WriteString(_m.Remediation)
+	builder.WriteString(", ")
+	builder.WriteString("evidence_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EvidenceJSON))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GoldPostureFindings is a parsable slice of GoldPostureFinding.
+type GoldPostureFindings []*GoldPostureFinding
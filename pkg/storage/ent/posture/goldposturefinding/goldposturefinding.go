@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goldposturefinding
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the goldposturefinding type in the database.
+	Label = "gold_posture_finding"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldDetectedAt holds the string denoting the detected_at field in the database.
+	FieldDetectedAt = "detected_at"
+	// FieldFirstDetectedAt holds the string denoting the first_detected_at field in the database.
+	FieldFirstDetectedAt = "first_detected_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAssignee holds the string denoting the assignee field in the database.
+	FieldAssignee = "assignee"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldSuppressedUntil holds the string denoting the suppressed_until field in the database.
+	FieldSuppressedUntil = "suppressed_until"
+	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
+	FieldResolvedAt = "resolved_at"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// FieldRuleKey holds the string denoting the rule_key field in the database.
+	FieldRuleKey = "rule_key"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldSeverity holds the string denoting the severity field in the database.
+	FieldSeverity = "severity"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldResourceType holds the string denoting the resource_type field in the database.
+	FieldResourceType = "resource_type"
+	// FieldAssetID holds the string denoting the asset_id field in the database.
+	FieldAssetID = "asset_id"
+	// FieldAssetName holds the string denoting the asset_name field in the database.
+	FieldAssetName = "asset_name"
+	// FieldProjectID holds the string denoting the project_id field in the database.
+	FieldProjectID = "project_id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldRemediation holds the string denoting the remediation field in the database.
+	FieldRemediation = "remediation"
+	// FieldEvidenceJSON holds the string denoting the evidence_json field in the database.
+	FieldEvidenceJSON = "evidence_json"
+	// Table holds the table name of the goldposturefinding in the database.
+	Table = "posture_findings"
+)
+
+// Columns holds all SQL columns for goldposturefinding fields.
+var Columns = []string{
+	FieldID,
+	FieldDetectedAt,
+	FieldFirstDetectedAt,
+	FieldStatus,
+	FieldAssignee,
+	FieldNote,
+	FieldSuppressedUntil,
+	FieldResolvedAt,
+	FieldLastSeenAt,
+	FieldRuleKey,
+	FieldCategory,
+	FieldSeverity,
+	FieldProvider,
+	FieldResourceType,
+	FieldAssetID,
+	FieldAssetName,
+	FieldProjectID,
+	FieldTitle,
+	FieldDescription,
+	FieldRemediation,
+	FieldEvidenceJSON,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// RuleKeyValidator is a validator for the "rule_key" field. It is called by the builders before save.
+	RuleKeyValidator func(string) error
+	// CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	CategoryValidator func(string) error
+	// SeverityValidator is a validator for the "severity" field. It is called by the builders before save.
+	SeverityValidator func(string) error
+	// ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	ProviderValidator func(string) error
+	// ResourceTypeValidator is a validator for the "resource_type" field. It is called by the builders before save.
+	ResourceTypeValidator func(string) error
+	// AssetIDValidator is a validator for the "asset_id" field. It is called by the builders before save.
+	AssetIDValidator func(string) error
+	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	TitleValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the GoldPostureFinding queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDetectedAt orders the results by the detected_at field.
+func ByDetectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDetectedAt, opts...).ToFunc()
+}
+
+// ByFirstDetectedAt orders the results by the first_detected_at field.
+func ByFirstDetectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstDetectedAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAssignee orders the results by the assignee field.
+func ByAssignee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssignee, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// BySuppressedUntil orders the results by the suppressed_until field.
+func BySuppressedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuppressedUntil, opts...).ToFunc()
+}
+
+// ByResolvedAt orders the results by the resolved_at field.
+func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
+}
+
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
+
+// ByRuleKey orders the results by the rule_key field.
+func ByRuleKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRuleKey, opts...).ToFunc()
+}
+
+// ByCategory orders the results by the category field.
+func ByCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategory, opts...).ToFunc()
+}
+
+// BySeverity orders the results by the severity field.
+func BySeverity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeverity, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByResourceType orders the results by the resource_type field.
+func ByResourceType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceType, opts...).ToFunc()
+}
+
+// ByAssetID orders the results by the asset_id field.
+func ByAssetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssetID, opts...).ToFunc()
+}
+
+// ByAssetName orders the results by the asset_name field.
+func ByAssetName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssetName, opts...).ToFunc()
+}
+
+// ByProjectID orders the results by the project_id field.
+func ByProjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByRemediation orders the results by the remediation field.
+func ByRemediation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemediation, opts...).ToFunc()
+}
@@ -0,0 +1,1375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goldposturefinding
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/posture/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContainsFold(FieldID, id))
+}
+
+// DetectedAt applies equality check predicate on the "detected_at" field. It's identical to DetectedAtEQ.
+func DetectedAt(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldDetectedAt, v))
+}
+
+// FirstDetectedAt applies equality check predicate on the "first_detected_at" field. It's identical to FirstDetectedAtEQ.
+func FirstDetectedAt(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldFirstDetectedAt, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldStatus, v))
+}
+
+// Assignee applies equality check predicate on the "assignee" field. It's identical to AssigneeEQ.
+func Assignee(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldAssignee, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldNote, v))
+}
+
+// SuppressedUntil applies equality check predicate on the "suppressed_until" field. It's identical to SuppressedUntilEQ.
+func SuppressedUntil(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldSuppressedUntil, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// RuleKey applies equality check predicate on the "rule_key" field. It's identical to RuleKeyEQ.
+func RuleKey(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldRuleKey, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldCategory, v))
+}
+
+// Severity applies equality check predicate on the "severity" field. It's identical to SeverityEQ.
+func Severity(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldSeverity, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldProvider, v))
+}
+
+// ResourceType applies equality check predicate on the "resource_type" field. It's identical to ResourceTypeEQ.
+func ResourceType(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldResourceType, v))
+}
+
+// AssetID applies equality check predicate on the "asset_id" field. It's identical to AssetIDEQ.
+func AssetID(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldAssetID, v))
+}
+
+// AssetName applies equality check predicate on the "asset_name" field. It's identical to AssetNameEQ.
+func AssetName(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldAssetName, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldProjectID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldDescription, v))
+}
+
+// Remediation applies equality check predicate on the "remediation" field. It's identical to RemediationEQ.
+func Remediation(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldRemediation, v))
+}
+
+// DetectedAtEQ applies the EQ predicate on the "detected_at" field.
+func DetectedAtEQ(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldDetectedAt, v))
+}
+
+// DetectedAtNEQ applies the NEQ predicate on the "detected_at" field.
+func DetectedAtNEQ(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldDetectedAt, v))
+}
+
+// DetectedAtIn applies the In predicate on the "detected_at" field.
+func DetectedAtIn(vs ...time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldDetectedAt, vs...))
+}
+
+// DetectedAtNotIn applies the NotIn predicate on the "detected_at" field.
+func DetectedAtNotIn(vs ...time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldDetectedAt, vs...))
+}
+
+// DetectedAtGT applies the GT predicate on the "detected_at" field.
+func DetectedAtGT(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldDetectedAt, v))
+}
+
+// DetectedAtGTE applies the GTE predicate on the "detected_at" field.
+func DetectedAtGTE(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldDetectedAt, v))
+}
+
+// DetectedAtLT applies the LT predicate on the "detected_at" field.
+func DetectedAtLT(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldDetectedAt, v))
+}
+
+// DetectedAtLTE applies the LTE predicate on the "detected_at" field.
+func DetectedAtLTE(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldDetectedAt, v))
+}
+
+// FirstDetectedAtEQ applies the EQ predicate on the "first_detected_at" field.
+func FirstDetectedAtEQ(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldFirstDetectedAt, v))
+}
+
+// FirstDetectedAtNEQ applies the NEQ predicate on the "first_detected_at" field.
+func FirstDetectedAtNEQ(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldFirstDetectedAt, v))
+}
+
+// FirstDetectedAtIn applies the In predicate on the "first_detected_at" field.
+func FirstDetectedAtIn(vs ...time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldFirstDetectedAt, vs...))
+}
+
+// FirstDetectedAtNotIn applies the NotIn predicate on the "first_detected_at" field.
+func FirstDetectedAtNotIn(vs ...time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldFirstDetectedAt, vs...))
+}
+
+// FirstDetectedAtGT applies the GT predicate on the "first_detected_at" field.
+func FirstDetectedAtGT(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldFirstDetectedAt, v))
+}
+
+// FirstDetectedAtGTE applies the GTE predicate on the "first_detected_at" field.
+func FirstDetectedAtGTE(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldFirstDetectedAt, v))
+}
+
+// FirstDetectedAtLT applies the LT predicate on the "first_detected_at" field.
+func FirstDetectedAtLT(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldFirstDetectedAt, v))
+}
+
+// FirstDetectedAtLTE applies the LTE predicate on the "first_detected_at" field.
+func FirstDetectedAtLTE(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldFirstDetectedAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// AssigneeEQ applies the EQ predicate on the "assignee" field.
+func AssigneeEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldAssignee, v))
+}
+
+// AssigneeNEQ applies the NEQ predicate on the "assignee" field.
+func AssigneeNEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldAssignee, v))
+}
+
+// AssigneeIn applies the In predicate on the "assignee" field.
+func AssigneeIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldAssignee, vs...))
+}
+
+// AssigneeNotIn applies the NotIn predicate on the "assignee" field.
+func AssigneeNotIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldAssignee, vs...))
+}
+
+// AssigneeGT applies the GT predicate on the "assignee" field.
+func AssigneeGT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldAssignee, v))
+}
+
+// AssigneeGTE applies the GTE predicate on the "assignee" field.
+func AssigneeGTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldAssignee, v))
+}
+
+// AssigneeLT applies the LT predicate on the "assignee" field.
+func AssigneeLT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldAssignee, v))
+}
+
+// AssigneeLTE applies the LTE predicate on the "assignee" field.
+func AssigneeLTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldAssignee, v))
+}
+
+// AssigneeContains applies the Contains predicate on the "assignee" field.
+func AssigneeContains(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContains(FieldAssignee, v))
+}
+
+// AssigneeHasPrefix applies the HasPrefix predicate on the "assignee" field.
+func AssigneeHasPrefix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasPrefix(FieldAssignee, v))
+}
+
+// AssigneeHasSuffix applies the HasSuffix predicate on the "assignee" field.
+func AssigneeHasSuffix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasSuffix(FieldAssignee, v))
+}
+
+// AssigneeIsNil applies the IsNil predicate on the "assignee" field.
+func AssigneeIsNil() predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIsNull(FieldAssignee))
+}
+
+// AssigneeNotNil applies the NotNil predicate on the "assignee" field.
+func AssigneeNotNil() predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotNull(FieldAssignee))
+}
+
+// AssigneeEqualFold applies the EqualFold predicate on the "assignee" field.
+func AssigneeEqualFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEqualFold(FieldAssignee, v))
+}
+
+// AssigneeContainsFold applies the ContainsFold predicate on the "assignee" field.
+func AssigneeContainsFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContainsFold(FieldAssignee, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContainsFold(FieldNote, v))
+}
+
+// SuppressedUntilEQ applies the EQ predicate on the "suppressed_until" field.
+func SuppressedUntilEQ(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilNEQ applies the NEQ predicate on the "suppressed_until" field.
+func SuppressedUntilNEQ(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilIn applies the In predicate on the "suppressed_until" field.
+func SuppressedUntilIn(vs ...time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldSuppressedUntil, vs...))
+}
+
+// SuppressedUntilNotIn applies the NotIn predicate on the "suppressed_until" field.
+func SuppressedUntilNotIn(vs ...time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldSuppressedUntil, vs...))
+}
+
+// SuppressedUntilGT applies the GT predicate on the "suppressed_until" field.
+func SuppressedUntilGT(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilGTE applies the GTE predicate on the "suppressed_until" field.
+func SuppressedUntilGTE(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilLT applies the LT predicate on the "suppressed_until" field.
+func SuppressedUntilLT(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilLTE applies the LTE predicate on the "suppressed_until" field.
+func SuppressedUntilLTE(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilIsNil applies the IsNil predicate on the "suppressed_until" field.
+func SuppressedUntilIsNil() predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIsNull(FieldSuppressedUntil))
+}
+
+// SuppressedUntilNotNil applies the NotNil predicate on the "suppressed_until" field.
+func SuppressedUntilNotNil() predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotNull(FieldSuppressedUntil))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// RuleKeyEQ applies the EQ predicate on the "rule_key" field.
+func RuleKeyEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldRuleKey, v))
+}
+
+// RuleKeyNEQ applies the NEQ predicate on the "rule_key" field.
+func RuleKeyNEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldRuleKey, v))
+}
+
+// RuleKeyIn applies the In predicate on the "rule_key" field.
+func RuleKeyIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldRuleKey, vs...))
+}
+
+// RuleKeyNotIn applies the NotIn predicate on the "rule_key" field.
+func RuleKeyNotIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldRuleKey, vs...))
+}
+
+// RuleKeyGT applies the GT predicate on the "rule_key" field.
+func RuleKeyGT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldRuleKey, v))
+}
+
+// RuleKeyGTE applies the GTE predicate on the "rule_key" field.
+func RuleKeyGTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldRuleKey, v))
+}
+
+// RuleKeyLT applies the LT predicate on the "rule_key" field.
+func RuleKeyLT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldRuleKey, v))
+}
+
+// RuleKeyLTE applies the LTE predicate on the "rule_key" field.
+func RuleKeyLTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldRuleKey, v))
+}
+
+// RuleKeyContains applies the Contains predicate on the "rule_key" field.
+func RuleKeyContains(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContains(FieldRuleKey, v))
+}
+
+// RuleKeyHasPrefix applies the HasPrefix predicate on the "rule_key" field.
+func RuleKeyHasPrefix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasPrefix(FieldRuleKey, v))
+}
+
+// RuleKeyHasSuffix applies the HasSuffix predicate on the "rule_key" field.
+func RuleKeyHasSuffix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasSuffix(FieldRuleKey, v))
+}
+
+// RuleKeyEqualFold applies the EqualFold predicate on the "rule_key" field.
+func RuleKeyEqualFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEqualFold(FieldRuleKey, v))
+}
+
+// RuleKeyContainsFold applies the ContainsFold predicate on the "rule_key" field.
+func RuleKeyContainsFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContainsFold(FieldRuleKey, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// SeverityEQ applies the EQ predicate on the "severity" field.
+func SeverityEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldSeverity, v))
+}
+
+// SeverityNEQ applies the NEQ predicate on the "severity" field.
+func SeverityNEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldSeverity, v))
+}
+
+// SeverityIn applies the In predicate on the "severity" field.
+func SeverityIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldSeverity, vs...))
+}
+
+// SeverityNotIn applies the NotIn predicate on the "severity" field.
+func SeverityNotIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldSeverity, vs...))
+}
+
+// SeverityGT applies the GT predicate on the "severity" field.
+func SeverityGT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldSeverity, v))
+}
+
+// SeverityGTE applies the GTE predicate on the "severity" field.
+func SeverityGTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldSeverity, v))
+}
+
+// SeverityLT applies the LT predicate on the "severity" field.
+func SeverityLT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldSeverity, v))
+}
+
+// SeverityLTE applies the LTE predicate on the "severity" field.
+func SeverityLTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldSeverity, v))
+}
+
+// SeverityContains applies the Contains predicate on the "severity" field.
+func SeverityContains(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContains(FieldSeverity, v))
+}
+
+// SeverityHasPrefix applies the HasPrefix predicate on the "severity" field.
+func SeverityHasPrefix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasPrefix(FieldSeverity, v))
+}
+
+// SeverityHasSuffix applies the HasSuffix predicate on the "severity" field.
+func SeverityHasSuffix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasSuffix(FieldSeverity, v))
+}
+
+// SeverityEqualFold applies the EqualFold predicate on the "severity" field.
+func SeverityEqualFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEqualFold(FieldSeverity, v))
+}
+
+// SeverityContainsFold applies the ContainsFold predicate on the "severity" field.
+func SeverityContainsFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContainsFold(FieldSeverity, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// ResourceTypeEQ applies the EQ predicate on the "resource_type" field.
+func ResourceTypeEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldResourceType, v))
+}
+
+// ResourceTypeNEQ applies the NEQ predicate on the "resource_type" field.
+func ResourceTypeNEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldResourceType, v))
+}
+
+// ResourceTypeIn applies the In predicate on the "resource_type" field.
+func ResourceTypeIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldResourceType, vs...))
+}
+
+// ResourceTypeNotIn applies the NotIn predicate on the "resource_type" field.
+func ResourceTypeNotIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldResourceType, vs...))
+}
+
+// ResourceTypeGT applies the GT predicate on the "resource_type" field.
+func ResourceTypeGT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldResourceType, v))
+}
+
+// ResourceTypeGTE applies the GTE predicate on the "resource_type" field.
+func ResourceTypeGTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldResourceType, v))
+}
+
+// ResourceTypeLT applies the LT predicate on the "resource_type" field.
+func ResourceTypeLT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldResourceType, v))
+}
+
+// ResourceTypeLTE applies the LTE predicate on the "resource_type" field.
+func ResourceTypeLTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldResourceType, v))
+}
+
+// ResourceTypeContains applies the Contains predicate on the "resource_type" field.
+func ResourceTypeContains(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContains(FieldResourceType, v))
+}
+
+// ResourceTypeHasPrefix applies the HasPrefix predicate on the "resource_type" field.
+func ResourceTypeHasPrefix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasPrefix(FieldResourceType, v))
+}
+
+// ResourceTypeHasSuffix applies the HasSuffix predicate on the "resource_type" field.
+func ResourceTypeHasSuffix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasSuffix(FieldResourceType, v))
+}
+
+// ResourceTypeEqualFold applies the EqualFold predicate on the "resource_type" field.
+func ResourceTypeEqualFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEqualFold(FieldResourceType, v))
+}
+
+// ResourceTypeContainsFold applies the ContainsFold predicate on the "resource_type" field.
+func ResourceTypeContainsFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContainsFold(FieldResourceType, v))
+}
+
+// AssetIDEQ applies the EQ predicate on the "asset_id" field.
+func AssetIDEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldAssetID, v))
+}
+
+// AssetIDNEQ applies the NEQ predicate on the "asset_id" field.
+func AssetIDNEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldAssetID, v))
+}
+
+// AssetIDIn applies the In predicate on the "asset_id" field.
+func AssetIDIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldAssetID, vs...))
+}
+
+// AssetIDNotIn applies the NotIn predicate on the "asset_id" field.
+func AssetIDNotIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldAssetID, vs...))
+}
+
+// AssetIDGT applies the GT predicate on the "asset_id" field.
+func AssetIDGT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldAssetID, v))
+}
+
+// AssetIDGTE applies the GTE predicate on the "asset_id" field.
+func AssetIDGTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldAssetID, v))
+}
+
+// AssetIDLT applies the LT predicate on the "asset_id" field.
+func AssetIDLT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldAssetID, v))
+}
+
+// AssetIDLTE applies the LTE predicate on the "asset_id" field.
+func AssetIDLTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldAssetID, v))
+}
+
+// AssetIDContains applies the Contains predicate on the "asset_id" field.
+func AssetIDContains(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContains(FieldAssetID, v))
+}
+
+// AssetIDHasPrefix applies the HasPrefix predicate on the "asset_id" field.
+func AssetIDHasPrefix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasPrefix(FieldAssetID, v))
+}
+
+// AssetIDHasSuffix applies the HasSuffix predicate on the "asset_id" field.
+func AssetIDHasSuffix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasSuffix(FieldAssetID, v))
+}
+
+// AssetIDEqualFold applies the EqualFold predicate on the "asset_id" field.
+func AssetIDEqualFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEqualFold(FieldAssetID, v))
+}
+
+// AssetIDContainsFold applies the ContainsFold predicate on the "asset_id" field.
+func AssetIDContainsFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContainsFold(FieldAssetID, v))
+}
+
+// AssetNameEQ applies the EQ predicate on the "asset_name" field.
+func AssetNameEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldAssetName, v))
+}
+
+// AssetNameNEQ applies the NEQ predicate on the "asset_name" field.
+func AssetNameNEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldAssetName, v))
+}
+
+// AssetNameIn applies the In predicate on the "asset_name" field.
+func AssetNameIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldAssetName, vs...))
+}
+
+// AssetNameNotIn applies the NotIn predicate on the "asset_name" field.
+func AssetNameNotIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldAssetName, vs...))
+}
+
+// AssetNameGT applies the GT predicate on the "asset_name" field.
+func AssetNameGT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldAssetName, v))
+}
+
+// AssetNameGTE applies the GTE predicate on the "asset_name" field.
+func AssetNameGTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldAssetName, v))
+}
+
+// AssetNameLT applies the LT predicate on the "asset_name" field.
+func AssetNameLT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldAssetName, v))
+}
+
+// AssetNameLTE applies the LTE predicate on the "asset_name" field.
+func AssetNameLTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldAssetName, v))
+}
+
+// AssetNameContains applies the Contains predicate on the "asset_name" field.
+func AssetNameContains(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContains(FieldAssetName, v))
+}
+
+// AssetNameHasPrefix applies the HasPrefix predicate on the "asset_name" field.
+func AssetNameHasPrefix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasPrefix(FieldAssetName, v))
+}
+
+// AssetNameHasSuffix applies the HasSuffix predicate on the "asset_name" field.
+func AssetNameHasSuffix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasSuffix(FieldAssetName, v))
+}
+
+// AssetNameIsNil applies the IsNil predicate on the "asset_name" field.
+func AssetNameIsNil() predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIsNull(FieldAssetName))
+}
+
+// AssetNameNotNil applies the NotNil predicate on the "asset_name" field.
+func AssetNameNotNil() predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotNull(FieldAssetName))
+}
+
+// AssetNameEqualFold applies the EqualFold predicate on the "asset_name" field.
+func AssetNameEqualFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEqualFold(FieldAssetName, v))
+}
+
+// AssetNameContainsFold applies the ContainsFold predicate on the "asset_name" field.
+func AssetNameContainsFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContainsFold(FieldAssetName, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDGT applies the GT predicate on the "project_id" field.
+func ProjectIDGT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldProjectID, v))
+}
+
+// ProjectIDGTE applies the GTE predicate on the "project_id" field.
+func ProjectIDGTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldProjectID, v))
+}
+
+// ProjectIDLT applies the LT predicate on the "project_id" field.
+func ProjectIDLT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldProjectID, v))
+}
+
+// ProjectIDLTE applies the LTE predicate on the "project_id" field.
+func ProjectIDLTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldProjectID, v))
+}
+
+// ProjectIDContains applies the Contains predicate on the "project_id" field.
+func ProjectIDContains(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContains(FieldProjectID, v))
+}
+
+// ProjectIDHasPrefix applies the HasPrefix predicate on the "project_id" field.
+func ProjectIDHasPrefix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasPrefix(FieldProjectID, v))
+}
+
+// ProjectIDHasSuffix applies the HasSuffix predicate on the "project_id" field.
+func ProjectIDHasSuffix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasSuffix(FieldProjectID, v))
+}
+
+// ProjectIDIsNil applies the IsNil predicate on the "project_id" field.
+func ProjectIDIsNil() predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIsNull(FieldProjectID))
+}
+
+// ProjectIDNotNil applies the NotNil predicate on the "project_id" field.
+func ProjectIDNotNil() predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotNull(FieldProjectID))
+}
+
+// ProjectIDEqualFold applies the EqualFold predicate on the "project_id" field.
+func ProjectIDEqualFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEqualFold(FieldProjectID, v))
+}
+
+// ProjectIDContainsFold applies the ContainsFold predicate on the "project_id" field.
+func ProjectIDContainsFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContainsFold(FieldProjectID, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// RemediationEQ applies the EQ predicate on the "remediation" field.
+func RemediationEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEQ(FieldRemediation, v))
+}
+
+// RemediationNEQ applies the NEQ predicate on the "remediation" field.
+func RemediationNEQ(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNEQ(FieldRemediation, v))
+}
+
+// RemediationIn applies the In predicate on the "remediation" field.
+func RemediationIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIn(FieldRemediation, vs...))
+}
+
+// RemediationNotIn applies the NotIn predicate on the "remediation" field.
+func RemediationNotIn(vs ...string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotIn(FieldRemediation, vs...))
+}
+
+// RemediationGT applies the GT predicate on the "remediation" field.
+func RemediationGT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGT(FieldRemediation, v))
+}
+
+// RemediationGTE applies the GTE predicate on the "remediation" field.
+func RemediationGTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldGTE(FieldRemediation, v))
+}
+
+// RemediationLT applies the LT predicate on the "remediation" field.
+func RemediationLT(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLT(FieldRemediation, v))
+}
+
+// RemediationLTE applies the LTE predicate on the "remediation" field.
+func RemediationLTE(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldLTE(FieldRemediation, v))
+}
+
+// RemediationContains applies the Contains predicate on the "remediation" field.
+func RemediationContains(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContains(FieldRemediation, v))
+}
+
+// RemediationHasPrefix applies the HasPrefix predicate on the "remediation" field.
+func RemediationHasPrefix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasPrefix(FieldRemediation, v))
+}
+
+// RemediationHasSuffix applies the HasSuffix predicate on the "remediation" field.
+func RemediationHasSuffix(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldHasSuffix(FieldRemediation, v))
+}
+
+// RemediationIsNil applies the IsNil predicate on the "remediation" field.
+func RemediationIsNil() predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIsNull(FieldRemediation))
+}
+
+// RemediationNotNil applies the NotNil predicate on the "remediation" field.
+func RemediationNotNil() predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotNull(FieldRemediation))
+}
+
+// RemediationEqualFold applies the EqualFold predicate on the "remediation" field.
+func RemediationEqualFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldEqualFold(FieldRemediation, v))
+}
+
+// RemediationContainsFold applies the ContainsFold predicate on the "remediation" field.
+func RemediationContainsFold(v string) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldContainsFold(FieldRemediation, v))
+}
+
+// EvidenceJSONIsNil applies the IsNil predicate on the "evidence_json" field.
+func EvidenceJSONIsNil() predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldIsNull(FieldEvidenceJSON))
+}
+
+// EvidenceJSONNotNil applies the NotNil predicate on the "evidence_json" field.
+func EvidenceJSONNotNil() predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.FieldNotNull(FieldEvidenceJSON))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GoldPostureFinding) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GoldPostureFinding) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GoldPostureFinding) predicate.GoldPostureFinding {
+	return predicate.GoldPostureFinding(sql.NotPredicates(p))
+}
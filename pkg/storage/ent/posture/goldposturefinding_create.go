@@ -0,0 +1,539 @@
+// Code generated by ent, DO NOT EDIT.
+
+package posture
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/posture/goldposturefinding"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GoldPostureFindingCreate is the builder for creating a GoldPostureFinding entity.
+type GoldPostureFindingCreate struct {
+	config
+	mutation *GoldPostureFindingMutation
+	hooks    []Hook
+}
+
+// SetDetectedAt sets the "detected_at" field.
+func (_c *GoldPostureFindingCreate) SetDetectedAt(v time.Time) *GoldPostureFindingCreate {
+	_c.mutation.SetDetectedAt(v)
+	return _c
+}
+
+// SetFirstDetectedAt sets the "first_detected_at" field.
+func (_c *GoldPostureFindingCreate) SetFirstDetectedAt(v time.Time) *GoldPostureFindingCreate {
+	_c.mutation.SetFirstDetectedAt(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *GoldPostureFindingCreate) SetStatus(v string) *GoldPostureFindingCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *GoldPostureFindingCreate) SetNillableStatus(v *string) *GoldPostureFindingCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAssignee sets the "assignee" field.
+func (_c *GoldPostureFindingCreate) SetAssignee(v string) *GoldPostureFindingCreate {
+	_c.mutation.SetAssignee(v)
+	return _c
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_c *GoldPostureFindingCreate) SetNillableAssignee(v *string) *GoldPostureFindingCreate {
+	if v != nil {
+		_c.SetAssignee(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *GoldPostureFindingCreate) SetNote(v string) *GoldPostureFindingCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *GoldPostureFindingCreate) SetNillableNote(v *string) *GoldPostureFindingCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (_c *GoldPostureFindingCreate) SetSuppressedUntil(v time.Time) *GoldPostureFindingCreate {
+	_c.mutation.SetSuppressedUntil(v)
+	return _c
+}
+
+// SetNillableSuppressedUntil sets the "suppressed_until" field if the given value is not nil.
+func (_c *GoldPostureFindingCreate) SetNillableSuppressedUntil(v *time.Time) *GoldPostureFindingCreate {
+	if v != nil {
+		_c.SetSuppressedUntil(*v)
+	}
+	return _c
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_c *GoldPostureFindingCreate) SetResolvedAt(v time.Time) *GoldPostureFindingCreate {
+	_c.mutation.SetResolvedAt(v)
+	return _c
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_c *GoldPostureFindingCreate) SetNillableResolvedAt(v *time.Time) *GoldPostureFindingCreate {
+	if v != nil {
+		_c.SetResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *GoldPostureFindingCreate) SetLastSeenAt(v time.Time) *GoldPostureFindingCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
+// SetRuleKey sets the "rule_key" field.
+func (_c *GoldPostureFindingCreate) SetRuleKey(v string) *GoldPostureFindingCreate {
+	_c.mutation.SetRuleKey(v)
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *GoldPostureFindingCreate) SetCategory(v string) *GoldPostureFindingCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetSeverity sets the "severity" field.
+func (_c *GoldPostureFindingCreate) SetSeverity(v string) *GoldPostureFindingCreate {
+	_c.mutation.SetSeverity(v)
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *GoldPostureFindingCreate) SetProvider(v string) *GoldPostureFindingCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetResourceType sets the "resource_type" field.
+func (_c *GoldPostureFindingCreate) SetResourceType(v string) *GoldPostureFindingCreate {
+	_c.mutation.SetResourceType(v)
+	return _c
+}
+
+// SetAssetID sets the "asset_id" field.
+func (_c *GoldPostureFindingCreate) SetAssetID(v string) *GoldPostureFindingCreate {
+	_c.mutation.SetAssetID(v)
+	return _c
+}
+
+// SetAssetName sets the "asset_name" field.
+func (_c *GoldPostureFindingCreate) SetAssetName(v string) *GoldPostureFindingCreate {
+	_c.mutation.SetAssetName(v)
+	return _c
+}
+
+// SetNillableAssetName sets the "asset_name" field if the given value is not nil.
+func (_c *GoldPostureFindingCreate) SetNillableAssetName(v *string) *GoldPostureFindingCreate {
+	if v != nil {
+		_c.SetAssetName(*v)
+	}
+	return _c
+}
+
+// SetProjectID sets the "project_id" field.
+func (_c *GoldPostureFindingCreate) SetProjectID(v string) *GoldPostureFindingCreate {
+	_c.mutation.SetProjectID(v)
+	return _c
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_c *GoldPostureFindingCreate) SetNillableProjectID(v *string) *GoldPostureFindingCreate {
+	if v != nil {
+		_c.SetProjectID(*v)
+	}
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *GoldPostureFindingCreate) SetTitle(v string) *GoldPostureFindingCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *GoldPostureFindingCreate) SetDescription(v string) *GoldPostureFindingCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *GoldPostureFindingCreate) SetNillableDescription(v *string) *GoldPostureFindingCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetRemediation sets the "remediation" field.
+func (_c *GoldPostureFindingCreate) SetRemediation(v string) *GoldPostureFindingCreate {
+	_c.mutation.SetRemediation(v)
+	return _c
+}
+
+// SetNillableRemediation sets the "remediation" field if the given value is not nil.
+func (_c *GoldPostureFindingCreate) SetNillableRemediation(v *string) *GoldPostureFindingCreate {
+	if v != nil {
+		_c.SetRemediation(*v)
+	}
+	return _c
+}
+
+// SetEvidenceJSON sets the "evidence_json" field.
+func (_c *GoldPostureFindingCreate) SetEvidenceJSON(v json.RawMessage) *GoldPostureFindingCreate {
+	_c.mutation.SetEvidenceJSON(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GoldPostureFindingCreate) SetID(v string) *GoldPostureFindingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the GoldPostureFindingMutation object of the builder.
+func (_c *GoldPostureFindingCreate) Mutation() *GoldPostureFindingMutation {
+	return _c.mutation
+}
+
+// Save creates the GoldPostureFinding in the database.
+func (_c *GoldPostureFindingCreate) Save(ctx context.Context) (*GoldPostureFinding, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GoldPostureFindingCreate) SaveX(ctx context.Context) *GoldPostureFinding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoldPostureFindingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoldPostureFindingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GoldPostureFindingCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := goldposturefinding.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GoldPostureFindingCreate) check() error {
+	if _, ok := _c.mutation.DetectedAt(); !ok {
+		return &ValidationError{Name: "detected_at", err: errors.New(`posture: missing required field "GoldPostureFinding.detected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstDetectedAt(); !ok {
+		return &ValidationError{Name: "first_detected_at", err: errors.New(`posture: missing required field "GoldPostureFinding.first_detected_at"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`posture: missing required field "GoldPostureFinding.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := goldposturefinding.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LastSeenAt(); !ok {
+		return &ValidationError{Name: "last_seen_at", err: errors.New(`posture: missing required field "GoldPostureFinding.last_seen_at"`)}
+	}
+	if _, ok := _c.mutation.RuleKey(); !ok {
+		return &ValidationError{Name: "rule_key", err: errors.New(`posture: missing required field "GoldPostureFinding.rule_key"`)}
+	}
+	if v, ok := _c.mutation.RuleKey(); ok {
+		if err := goldposturefinding.RuleKeyValidator(v); err != nil {
+			return &ValidationError{Name: "rule_key", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.rule_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`posture: missing required field "GoldPostureFinding.category"`)}
+	}
+	if v, ok := _c.mutation.Category(); ok {
+		if err := goldposturefinding.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.category": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Severity(); !ok {
+		return &ValidationError{Name: "severity", err: errors.New(`posture: missing required field "GoldPostureFinding.severity"`)}
+	}
+	if v, ok := _c.mutation.Severity(); ok {
+		if err := goldposturefinding.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.severity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`posture: missing required field "GoldPostureFinding.provider"`)}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := goldposturefinding.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.provider": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ResourceType(); !ok {
+		return &ValidationError{Name: "resource_type", err: errors.New(`posture: missing required field "GoldPostureFinding.resource_type"`)}
+	}
+	if v, ok := _c.mutation.ResourceType(); ok {
+		if err := goldposturefinding.ResourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "resource_type", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.resource_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AssetID(); !ok {
+		return &ValidationError{Name: "asset_id", err: errors.New(`posture: missing required field "GoldPostureFinding.asset_id"`)}
+	}
+	if v, ok := _c.mutation.AssetID(); ok {
+		if err := goldposturefinding.AssetIDValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.asset_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`posture: missing required field "GoldPostureFinding.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := goldposturefinding.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.title": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *GoldPostureFindingCreate) sqlSave(ctx context.Context) (*GoldPostureFinding, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected GoldPostureFinding.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GoldPostureFindingCreate) createSpec() (*GoldPostureFinding, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GoldPostureFinding{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(goldposturefinding.Table, sqlgraph.NewFieldSpec(goldposturefinding.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.GoldPostureFinding
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.DetectedAt(); ok {
+		_spec.SetField(goldposturefinding.FieldDetectedAt, field.TypeTime, value)
+		_node.DetectedAt = value
+	}
+	if value, ok := _c.mutation.FirstDetectedAt(); ok {
+		_spec.SetField(goldposturefinding.FieldFirstDetectedAt, field.TypeTime, value)
+		_node.FirstDetectedAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(goldposturefinding.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Assignee(); ok {
+		_spec.SetField(goldposturefinding.FieldAssignee, field.TypeString, value)
+		_node.Assignee = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(goldposturefinding.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.SuppressedUntil(); ok {
+		_spec.SetField(goldposturefinding.FieldSuppressedUntil, field.TypeTime, value)
+		_node.SuppressedUntil = &value
+	}
+	if value, ok := _c.mutation.ResolvedAt(); ok {
+		_spec.SetField(goldposturefinding.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(goldposturefinding.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = value
+	}
+	if value, ok := _c.mutation.RuleKey(); ok {
+		_spec.SetField(goldposturefinding.FieldRuleKey, field.TypeString, value)
+		_node.RuleKey = value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(goldposturefinding.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
+	if value, ok := _c.mutation.Severity(); ok {
+		_spec.SetField(goldposturefinding.FieldSeverity, field.TypeString, value)
+		_node.Severity = value
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(goldposturefinding.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.ResourceType(); ok {
+		_spec.SetField(goldposturefinding.FieldResourceType, field.TypeString, value)
+		_node.ResourceType = value
+	}
+	if value, ok := _c.mutation.AssetID(); ok {
+		_spec.SetField(goldposturefinding.FieldAssetID, field.TypeString, value)
+		_node.AssetID = value
+	}
+	if value, ok := _c.mutation.AssetName(); ok {
+		_spec.SetField(goldposturefinding.FieldAssetName, field.TypeString, value)
+		_node.AssetName = value
+	}
+	if value, ok := _c.mutation.ProjectID(); ok {
+		_spec.SetField(goldposturefinding.FieldProjectID, field.TypeString, value)
+		_node.ProjectID = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(goldposturefinding.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(goldposturefinding.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Remediation(); ok {
+		_spec.SetField(goldposturefinding.FieldRemediation, field.TypeString, value)
+		_node.Remediation = value
+	}
+	if value, ok := _c.mutation.EvidenceJSON(); ok {
+		_spec.SetField(goldposturefinding.FieldEvidenceJSON, field.TypeJSON, value)
+		_node.EvidenceJSON = value
+	}
+	return _node, _spec
+}
+
+// GoldPostureFindingCreateBulk is the builder for creating many GoldPostureFinding entities in bulk.
+type GoldPostureFindingCreateBulk struct {
+	config
+	err      error
+	builders []*GoldPostureFindingCreate
+}
+
+// Save creates the GoldPostureFinding entities in the database.
+func (_c *GoldPostureFindingCreateBulk) Save(ctx context.Context) ([]*GoldPostureFinding, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GoldPostureFinding, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GoldPostureFindingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GoldPostureFindingCreateBulk) SaveX(ctx context.Context) []*GoldPostureFinding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoldPostureFindingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoldPostureFindingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
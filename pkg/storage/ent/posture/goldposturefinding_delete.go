@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package posture
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/posture/goldposturefinding"
+	"danny.vn/hotpot/pkg/storage/ent/posture/internal"
+	"danny.vn/hotpot/pkg/storage/ent/posture/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GoldPostureFindingDelete is the builder for deleting a GoldPostureFinding entity.
+type GoldPostureFindingDelete struct {
+	config
+	hooks    []Hook
+	mutation *GoldPostureFindingMutation
+}
+
+// Where appends a list predicates to the GoldPostureFindingDelete builder.
+func (_d *GoldPostureFindingDelete) Where(ps ...predicate.GoldPostureFinding) *GoldPostureFindingDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *GoldPostureFindingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GoldPostureFindingDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *GoldPostureFindingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(goldposturefinding.Table, sqlgraph.NewFieldSpec(goldposturefinding.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.GoldPostureFinding
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// GoldPostureFindingDeleteOne is the builder for deleting a single GoldPostureFinding entity.
+type GoldPostureFindingDeleteOne struct {
+	_d *GoldPostureFindingDelete
+}
+
+// Where appends a list predicates to the GoldPostureFindingDelete builder.
+func (_d *GoldPostureFindingDeleteOne) Where(ps ...predicate.GoldPostureFinding) *GoldPostureFindingDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *GoldPostureFindingDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{goldposturefinding.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GoldPostureFindingDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
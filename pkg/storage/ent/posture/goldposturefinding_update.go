@@ -0,0 +1,1093 @@
+// Code generated by ent, DO NOT EDIT.
+
+package posture
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/posture/goldposturefinding"
+	"danny.vn/hotpot/pkg/storage/ent/posture/internal"
+	"danny.vn/hotpot/pkg/storage/ent/posture/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// GoldPostureFindingUpdate is the builder for updating GoldPostureFinding entities.
+type GoldPostureFindingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GoldPostureFindingMutation
+}
+
+// Where appends a list predicates to the GoldPostureFindingUpdate builder.
+func (_u *GoldPostureFindingUpdate) Where(ps ...predicate.GoldPostureFinding) *GoldPostureFindingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDetectedAt sets the "detected_at" field.
+func (_u *GoldPostureFindingUpdate) SetDetectedAt(v time.Time) *GoldPostureFindingUpdate {
+	_u.mutation.SetDetectedAt(v)
+	return _u
+}
+
+// SetNillableDetectedAt sets the "detected_at" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdate) SetNillableDetectedAt(v *time.Time) *GoldPostureFindingUpdate {
+	if v != nil {
+		_u.SetDetectedAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *GoldPostureFindingUpdate) SetStatus(v string) *GoldPostureFindingUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdate) SetNillableStatus(v *string) *GoldPostureFindingUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAssignee sets the "assignee" field.
+func (_u *GoldPostureFindingUpdate) SetAssignee(v string) *GoldPostureFindingUpdate {
+	_u.mutation.SetAssignee(v)
+	return _u
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdate) SetNillableAssignee(v *string) *GoldPostureFindingUpdate {
+	if v != nil {
+		_u.SetAssignee(*v)
+	}
+	return _u
+}
+
+// ClearAssignee clears the value of the "assignee" field.
+func (_u *GoldPostureFindingUpdate) ClearAssignee() *GoldPostureFindingUpdate {
+	_u.mutation.ClearAssignee()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *GoldPostureFindingUpdate) SetNote(v string) *GoldPostureFindingUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdate) SetNillableNote(v *string) *GoldPostureFindingUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *GoldPostureFindingUpdate) ClearNote() *GoldPostureFindingUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (_u *GoldPostureFindingUpdate) SetSuppressedUntil(v time.Time) *GoldPostureFindingUpdate {
+	_u.mutation.SetSuppressedUntil(v)
+	return _u
+}
+
+// SetNillableSuppressedUntil sets the "suppressed_until" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdate) SetNillableSuppressedUntil(v *time.Time) *GoldPostureFindingUpdate {
+	if v != nil {
+		_u.SetSuppressedUntil(*v)
+	}
+	return _u
+}
+
+// ClearSuppressedUntil clears the value of the "suppressed_until" field.
+func (_u *GoldPostureFindingUpdate) ClearSuppressedUntil() *GoldPostureFindingUpdate {
+	_u.mutation.ClearSuppressedUntil()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *GoldPostureFindingUpdate) SetResolvedAt(v time.Time) *GoldPostureFindingUpdate {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdate) SetNillableResolvedAt(v *time.Time) *GoldPostureFindingUpdate {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *GoldPostureFindingUpdate) ClearResolvedAt() *GoldPostureFindingUpdate {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *GoldPostureFindingUpdate) SetLastSeenAt(v time.Time) *GoldPostureFindingUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdate) SetNillableLastSeenAt(v *time.Time) *GoldPostureFindingUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// SetRuleKey sets the "rule_key" field.
+func (_u *GoldPostureFindingUpdate) SetRuleKey(v string) *GoldPostureFindingUpdate {
+	_u.mutation.SetRuleKey(v)
+	return _u
+}
+
+// SetNillableRuleKey sets the "rule_key" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdate) SetNillableRuleKey(v *string) *GoldPostureFindingUpdate {
+	if v != nil {
+		_u.SetRuleKey(*v)
+	}
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *GoldPostureFindingUpdate) SetCategory(v string) *GoldPostureFindingUpdate {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdate) SetNillableCategory(v *string) *GoldPostureFindingUpdate {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetSeverity sets the "severity" field.
+func (_u *GoldPostureFindingUpdate) SetSeverity(v string) *GoldPostureFindingUpdate {
+	_u.mutation.SetSeverity(v)
+	return _u
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdate) SetNillableSeverity(v *string) *GoldPostureFindingUpdate {
+	if v != nil {
+		_u.SetSeverity(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *GoldPostureFindingUpdate) SetProvider(v string) *GoldPostureFindingUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdate) SetNillableProvider(v *string) *GoldPostureFindingUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetResourceType sets the "resource_type" field.
+func (_u *GoldPostureFindingUpdate) SetResourceType(v string) *GoldPostureFindingUpdate {
+	_u.mutation.SetResourceType(v)
+	return _u
+}
+
+// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdate) SetNillableResourceType(v *string) *GoldPostureFindingUpdate {
+	if v != nil {
+		_u.SetResourceType(*v)
+	}
+	return _u
+}
+
+// SetAssetID sets the "asset_id" field.
+func (_u *GoldPostureFindingUpdate) SetAssetID(v string) *GoldPostureFindingUpdate {
+	_u.mutation.SetAssetID(v)
+	return _u
+}
+
+// SetNillableAssetID sets the "asset_id" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdate) SetNillableAssetID(v *string) *GoldPostureFindingUpdate {
+	if v != nil {
+		_u.SetAssetID(*v)
+	}
+	return _u
+}
+
+// SetAssetName sets the "asset_name" field.
+func (_u *GoldPostureFindingUpdate) SetAssetName(v string) *GoldPostureFindingUpdate {
+	_u.mutation.SetAssetName(v)
+	return _u
+}
+
+// SetNillableAssetName sets the "asset_name" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdate) SetNillableAssetName(v *string) *GoldPostureFindingUpdate {
+	if v != nil {
+		_u.SetAssetName(*v)
+	}
+	return _u
+}
+
+// ClearAssetName clears the value of the "asset_name" field.
+func (_u *GoldPostureFindingUpdate) ClearAssetName() *GoldPostureFindingUpdate {
+	_u.mutation.ClearAssetName()
+	return _u
+}
+
+// SetProjectID sets the "project_id" field.
+func (_u *GoldPostureFindingUpdate) SetProjectID(v string) *GoldPostureFindingUpdate {
+	_u.mutation.SetProjectID(v)
+	return _u
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdate) SetNillableProjectID(v *string) *GoldPostureFindingUpdate {
+	if v != nil {
+		_u.SetProjectID(*v)
+	}
+	return _u
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (_u *GoldPostureFindingUpdate) ClearProjectID() *GoldPostureFindingUpdate {
+	_u.mutation.ClearProjectID()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *GoldPostureFindingUpdate) SetTitle(v string) *GoldPostureFindingUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdate) SetNillableTitle(v *string) *GoldPostureFindingUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *GoldPostureFindingUpdate) SetDescription(v string) *GoldPostureFindingUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdate) SetNillableDescription(v *string) *GoldPostureFindingUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *GoldPostureFindingUpdate) ClearDescription() *GoldPostureFindingUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetRemediation sets the "remediation" field.
+func (_u *GoldPostureFindingUpdate) SetRemediation(v string) *GoldPostureFindingUpdate {
+	_u.mutation.SetRemediation(v)
+	return _u
+}
+
+// SetNillableRemediation sets the "remediation" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdate) SetNillableRemediation(v *string) *GoldPostureFindingUpdate {
+	if v != nil {
+		_u.SetRemediation(*v)
+	}
+	return _u
+}
+
+// ClearRemediation clears the value of the "remediation" field.
+func (_u *GoldPostureFindingUpdate) ClearRemediation() *GoldPostureFindingUpdate {
+	_u.mutation.ClearRemediation()
+	return _u
+}
+
+// SetEvidenceJSON sets the "evidence_json" field.
+func (_u *GoldPostureFindingUpdate) SetEvidenceJSON(v json.RawMessage) *GoldPostureFindingUpdate {
+	_u.mutation.SetEvidenceJSON(v)
+	return _u
+}
+
+// AppendEvidenceJSON appends value to the "evidence_json" field.
+func (_u *GoldPostureFindingUpdate) AppendEvidenceJSON(v json.RawMessage) *GoldPostureFindingUpdate {
+	_u.mutation.AppendEvidenceJSON(v)
+	return _u
+}
+
+// ClearEvidenceJSON clears the value of the "evidence_json" field.
+func (_u *GoldPostureFindingUpdate) ClearEvidenceJSON() *GoldPostureFindingUpdate {
+	_u.mutation.ClearEvidenceJSON()
+	return _u
+}
+
+// Mutation returns the GoldPostureFindingMutation object of the builder.
+func (_u *GoldPostureFindingUpdate) Mutation() *GoldPostureFindingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GoldPostureFindingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoldPostureFindingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GoldPostureFindingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoldPostureFindingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoldPostureFindingUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := goldposturefinding.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RuleKey(); ok {
+		if err := goldposturefinding.RuleKeyValidator(v); err != nil {
+			return &ValidationError{Name: "rule_key", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.rule_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := goldposturefinding.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.category": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Severity(); ok {
+		if err := goldposturefinding.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.severity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := goldposturefinding.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResourceType(); ok {
+		if err := goldposturefinding.ResourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "resource_type", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.resource_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AssetID(); ok {
+		if err := goldposturefinding.AssetIDValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.asset_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := goldposturefinding.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.title": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GoldPostureFindingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goldposturefinding.Table, goldposturefinding.Columns, sqlgraph.NewFieldSpec(goldposturefinding.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DetectedAt(); ok {
+		_spec.SetField(goldposturefinding.FieldDetectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(goldposturefinding.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Assignee(); ok {
+		_spec.SetField(goldposturefinding.FieldAssignee, field.TypeString, value)
+	}
+	if _u.mutation.AssigneeCleared() {
+		_spec.ClearField(goldposturefinding.FieldAssignee, field.TypeString)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(goldposturefinding.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(goldposturefinding.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.SuppressedUntil(); ok {
+		_spec.SetField(goldposturefinding.FieldSuppressedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.SuppressedUntilCleared() {
+		_spec.ClearField(goldposturefinding.FieldSuppressedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(goldposturefinding.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(goldposturefinding.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(goldposturefinding.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RuleKey(); ok {
+		_spec.SetField(goldposturefinding.FieldRuleKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(goldposturefinding.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Severity(); ok {
+		_spec.SetField(goldposturefinding.FieldSeverity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(goldposturefinding.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ResourceType(); ok {
+		_spec.SetField(goldposturefinding.FieldResourceType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AssetID(); ok {
+		_spec.SetField(goldposturefinding.FieldAssetID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AssetName(); ok {
+		_spec.SetField(goldposturefinding.FieldAssetName, field.TypeString, value)
+	}
+	if _u.mutation.AssetNameCleared() {
+		_spec.ClearField(goldposturefinding.FieldAssetName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProjectID(); ok {
+		_spec.SetField(goldposturefinding.FieldProjectID, field.TypeString, value)
+	}
+	if _u.mutation.ProjectIDCleared() {
+		_spec.ClearField(goldposturefinding.FieldProjectID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(goldposturefinding.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(goldposturefinding.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(goldposturefinding.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Remediation(); ok {
+		_spec.SetField(goldposturefinding.FieldRemediation, field.TypeString, value)
+	}
+	if _u.mutation.RemediationCleared() {
+		_spec.ClearField(goldposturefinding.FieldRemediation, field.TypeString)
+	}
+	if value, ok := _u.mutation.EvidenceJSON(); ok {
+		_spec.SetField(goldposturefinding.FieldEvidenceJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEvidenceJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, goldposturefinding.FieldEvidenceJSON, value)
+		})
+	}
+	if _u.mutation.EvidenceJSONCleared() {
+		_spec.ClearField(goldposturefinding.FieldEvidenceJSON, field.TypeJSON)
+	}
+	_spec.Node.Schema = _u.schemaConfig.GoldPostureFinding
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goldposturefinding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GoldPostureFindingUpdateOne is the builder for updating a single GoldPostureFinding entity.
+type GoldPostureFindingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GoldPostureFindingMutation
+}
+
+// SetDetectedAt sets the "detected_at" field.
+func (_u *GoldPostureFindingUpdateOne) SetDetectedAt(v time.Time) *GoldPostureFindingUpdateOne {
+	_u.mutation.SetDetectedAt(v)
+	return _u
+}
+
+// SetNillableDetectedAt sets the "detected_at" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdateOne) SetNillableDetectedAt(v *time.Time) *GoldPostureFindingUpdateOne {
+	if v != nil {
+		_u.SetDetectedAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *GoldPostureFindingUpdateOne) SetStatus(v string) *GoldPostureFindingUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdateOne) SetNillableStatus(v *string) *GoldPostureFindingUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAssignee sets the "assignee" field.
+func (_u *GoldPostureFindingUpdateOne) SetAssignee(v string) *GoldPostureFindingUpdateOne {
+	_u.mutation.SetAssignee(v)
+	return _u
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdateOne) SetNillableAssignee(v *string) *GoldPostureFindingUpdateOne {
+	if v != nil {
+		_u.SetAssignee(*v)
+	}
+	return _u
+}
+
+// ClearAssignee clears the value of the "assignee" field.
+func (_u *GoldPostureFindingUpdateOne) ClearAssignee() *GoldPostureFindingUpdateOne {
+	_u.mutation.ClearAssignee()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *GoldPostureFindingUpdateOne) SetNote(v string) *GoldPostureFindingUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdateOne) SetNillableNote(v *string) *GoldPostureFindingUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *GoldPostureFindingUpdateOne) ClearNote() *GoldPostureFindingUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (_u *GoldPostureFindingUpdateOne) SetSuppressedUntil(v time.Time) *GoldPostureFindingUpdateOne {
+	_u.mutation.SetSuppressedUntil(v)
+	return _u
+}
+
+// SetNillableSuppressedUntil sets the "suppressed_until" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdateOne) SetNillableSuppressedUntil(v *time.Time) *GoldPostureFindingUpdateOne {
+	if v != nil {
+		_u.SetSuppressedUntil(*v)
+	}
+	return _u
+}
+
+// ClearSuppressedUntil clears the value of the "suppressed_until" field.
+func (_u *GoldPostureFindingUpdateOne) ClearSuppressedUntil() *GoldPostureFindingUpdateOne {
+	_u.mutation.ClearSuppressedUntil()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *GoldPostureFindingUpdateOne) SetResolvedAt(v time.Time) *GoldPostureFindingUpdateOne {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdateOne) SetNillableResolvedAt(v *time.Time) *GoldPostureFindingUpdateOne {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *GoldPostureFindingUpdateOne) ClearResolvedAt() *GoldPostureFindingUpdateOne {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *GoldPostureFindingUpdateOne) SetLastSeenAt(v time.Time) *GoldPostureFindingUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdateOne) SetNillableLastSeenAt(v *time.Time) *GoldPostureFindingUpdateOne {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// SetRuleKey sets the "rule_key" field.
+func (_u *GoldPostureFindingUpdateOne) SetRuleKey(v string) *GoldPostureFindingUpdateOne {
+	_u.mutation.SetRuleKey(v)
+	return _u
+}
+
+// SetNillableRuleKey sets the "rule_key" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdateOne) SetNillableRuleKey(v *string) *GoldPostureFindingUpdateOne {
+	if v != nil {
+		_u.SetRuleKey(*v)
+	}
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *GoldPostureFindingUpdateOne) SetCategory(v string) *GoldPostureFindingUpdateOne {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdateOne) SetNillableCategory(v *string) *GoldPostureFindingUpdateOne {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetSeverity sets the "severity" field.
+func (_u *GoldPostureFindingUpdateOne) SetSeverity(v string) *GoldPostureFindingUpdateOne {
+	_u.mutation.SetSeverity(v)
+	return _u
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdateOne) SetNillableSeverity(v *string) *GoldPostureFindingUpdateOne {
+	if v != nil {
+		_u.SetSeverity(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *GoldPostureFindingUpdateOne) SetProvider(v string) *GoldPostureFindingUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdateOne) SetNillableProvider(v *string) *GoldPostureFindingUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetResourceType sets the "resource_type" field.
+func (_u *GoldPostureFindingUpdateOne) SetResourceType(v string) *GoldPostureFindingUpdateOne {
+	_u.mutation.SetResourceType(v)
+	return _u
+}
+
+// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdateOne) SetNillableResourceType(v *string) *GoldPostureFindingUpdateOne {
+	if v != nil {
+		_u.SetResourceType(*v)
+	}
+	return _u
+}
+
+// SetAssetID sets the "asset_id" field.
+func (_u *GoldPostureFindingUpdateOne) SetAssetID(v string) *GoldPostureFindingUpdateOne {
+	_u.mutation.SetAssetID(v)
+	return _u
+}
+
+// SetNillableAssetID sets the "asset_id" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdateOne) SetNillableAssetID(v *string) *GoldPostureFindingUpdateOne {
+	if v != nil {
+		_u.SetAssetID(*v)
+	}
+	return _u
+}
+
+// SetAssetName sets the "asset_name" field.
+func (_u *GoldPostureFindingUpdateOne) SetAssetName(v string) *GoldPostureFindingUpdateOne {
+	_u.mutation.SetAssetName(v)
+	return _u
+}
+
+// SetNillableAssetName sets the "asset_name" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdateOne) SetNillableAssetName(v *string) *GoldPostureFindingUpdateOne {
+	if v != nil {
+		_u.SetAssetName(*v)
+	}
+	return _u
+}
+
+// ClearAssetName clears the value of the "asset_name" field.
+func (_u *GoldPostureFindingUpdateOne) ClearAssetName() *GoldPostureFindingUpdateOne {
+	_u.mutation.ClearAssetName()
+	return _u
+}
+
+// SetProjectID sets the "project_id" field.
+func (_u *GoldPostureFindingUpdateOne) SetProjectID(v string) *GoldPostureFindingUpdateOne {
+	_u.mutation.SetProjectID(v)
+	return _u
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdateOne) SetNillableProjectID(v *string) *GoldPostureFindingUpdateOne {
+	if v != nil {
+		_u.SetProjectID(*v)
+	}
+	return _u
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (_u *GoldPostureFindingUpdateOne) ClearProjectID() *GoldPostureFindingUpdateOne {
+	_u.mutation.ClearProjectID()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *GoldPostureFindingUpdateOne) SetTitle(v string) *GoldPostureFindingUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdateOne) SetNillableTitle(v *string) *GoldPostureFindingUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *GoldPostureFindingUpdateOne) SetDescription(v string) *GoldPostureFindingUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdateOne) SetNillableDescription(v *string) *GoldPostureFindingUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *GoldPostureFindingUpdateOne) ClearDescription() *GoldPostureFindingUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetRemediation sets the "remediation" field.
+func (_u *GoldPostureFindingUpdateOne) SetRemediation(v string) *GoldPostureFindingUpdateOne {
+	_u.mutation.SetRemediation(v)
+	return _u
+}
+
+// SetNillableRemediation sets the "remediation" field if the given value is not nil.
+func (_u *GoldPostureFindingUpdateOne) SetNillableRemediation(v *string) *GoldPostureFindingUpdateOne {
+	if v != nil {
+		_u.SetRemediation(*v)
+	}
+	return _u
+}
+
+// ClearRemediation clears the value of the "remediation" field.
+func (_u *GoldPostureFindingUpdateOne) ClearRemediation() *GoldPostureFindingUpdateOne {
+	_u.mutation.ClearRemediation()
+	return _u
+}
+
+// SetEvidenceJSON sets the "evidence_json" field.
+func (_u *GoldPostureFindingUpdateOne) SetEvidenceJSON(v json.RawMessage) *GoldPostureFindingUpdateOne {
+	_u.mutation.SetEvidenceJSON(v)
+	return _u
+}
+
+// AppendEvidenceJSON appends value to the "evidence_json" field.
+func (_u *GoldPostureFindingUpdateOne) AppendEvidenceJSON(v json.RawMessage) *GoldPostureFindingUpdateOne {
+	_u.mutation.AppendEvidenceJSON(v)
+	return _u
+}
+
+// ClearEvidenceJSON clears the value of the "evidence_json" field.
+func (_u *GoldPostureFindingUpdateOne) ClearEvidenceJSON() *GoldPostureFindingUpdateOne {
+	_u.mutation.ClearEvidenceJSON()
+	return _u
+}
+
+// Mutation returns the GoldPostureFindingMutation object of the builder.
+func (_u *GoldPostureFindingUpdateOne) Mutation() *GoldPostureFindingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the GoldPostureFindingUpdate builder.
+func (_u *GoldPostureFindingUpdateOne) Where(ps ...predicate.GoldPostureFinding) *GoldPostureFindingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GoldPostureFindingUpdateOne) Select(field string, fields ...string) *GoldPostureFindingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GoldPostureFinding entity.
+func (_u *GoldPostureFindingUpdateOne) Save(ctx context.Context) (*GoldPostureFinding, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoldPostureFindingUpdateOne) SaveX(ctx context.Context) *GoldPostureFinding {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GoldPostureFindingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoldPostureFindingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoldPostureFindingUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := goldposturefinding.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RuleKey(); ok {
+		if err := goldposturefinding.RuleKeyValidator(v); err != nil {
+			return &ValidationError{Name: "rule_key", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.rule_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := goldposturefinding.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.category": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Severity(); ok {
+		if err := goldposturefinding.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.severity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := goldposturefinding.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResourceType(); ok {
+		if err := goldposturefinding.ResourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "resource_type", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.resource_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AssetID(); ok {
+		if err := goldposturefinding.AssetIDValidator(v); err != nil {
+			return &ValidationError{Name: "asset_id", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.asset_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := goldposturefinding.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`posture: validator failed for field "GoldPostureFinding.title": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GoldPostureFindingUpdateOne) sqlSave(ctx context.Context) (_node *GoldPostureFinding, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goldposturefinding.Table, goldposturefinding.Columns, sqlgraph.NewFieldSpec(goldposturefinding.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`posture: missing "GoldPostureFinding.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, goldposturefinding.FieldID)
+		for _, f := range fields {
+			if !goldposturefinding.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("posture: invalid field %q for query", f)}
+			}
+			if f != goldposturefinding.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DetectedAt(); ok {
+		_spec.SetField(goldposturefinding.FieldDetectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(goldposturefinding.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Assignee(); ok {
+		_spec.SetField(goldposturefinding.FieldAssignee, field.TypeString, value)
+	}
+	if _u.mutation.AssigneeCleared() {
+		_spec.ClearField(goldposturefinding.FieldAssignee, field.TypeString)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(goldposturefinding.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(goldposturefinding.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.SuppressedUntil(); ok {
+		_spec.SetField(goldposturefinding.FieldSuppressedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.SuppressedUntilCleared() {
+		_spec.ClearField(goldposturefinding.FieldSuppressedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(goldposturefinding.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(goldposturefinding.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(goldposturefinding.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RuleKey(); ok {
+		_spec.SetField(goldposturefinding.FieldRuleKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(goldposturefinding.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Severity(); ok {
+		_spec.SetField(goldposturefinding.FieldSeverity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(goldposturefinding.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ResourceType(); ok {
+		_spec.SetField(goldposturefinding.FieldResourceType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AssetID(); ok {
+		_spec.SetField(goldposturefinding.FieldAssetID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AssetName(); ok {
+		_spec.SetField(goldposturefinding.FieldAssetName, field.TypeString, value)
+	}
+	if _u.mutation.AssetNameCleared() {
+		_spec.ClearField(goldposturefinding.FieldAssetName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProjectID(); ok {
+		_spec.SetField(goldposturefinding.FieldProjectID, field.TypeString, value)
+	}
+	if _u.mutation.ProjectIDCleared() {
+		_spec.ClearField(goldposturefinding.FieldProjectID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(goldposturefinding.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(goldposturefinding.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(goldposturefinding.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Remediation(); ok {
+		_spec.SetField(goldposturefinding.FieldRemediation, field.TypeString, value)
+	}
+	if _u.mutation.RemediationCleared() {
+		_spec.ClearField(goldposturefinding.FieldRemediation, field.TypeString)
+	}
+	if value, ok := _u.mutation.EvidenceJSON(); ok {
+		_spec.SetField(goldposturefinding.FieldEvidenceJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEvidenceJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, goldposturefinding.FieldEvidenceJSON, value)
+		})
+	}
+	if _u.mutation.EvidenceJSONCleared() {
+		_spec.ClearField(goldposturefinding.FieldEvidenceJSON, field.TypeJSON)
+	}
+	_spec.Node.Schema = _u.schemaConfig.GoldPostureFinding
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &GoldPostureFinding{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goldposturefinding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
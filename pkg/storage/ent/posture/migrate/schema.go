@@ -0,0 +1,84 @@
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// PostureFindingsColumns holds the columns for the "posture_findings" table.
+	PostureFindingsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "detected_at", Type: field.TypeTime},
+		{Name: "first_detected_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeString, Default: "open"},
+		{Name: "assignee", Type: field.TypeString, Nullable: true},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "suppressed_until", Type: field.TypeTime, Nullable: true},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime},
+		{Name: "rule_key", Type: field.TypeString},
+		{Name: "category", Type: field.TypeString},
+		{Name: "severity", Type: field.TypeString},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "resource_type", Type: field.TypeString},
+		{Name: "asset_id", Type: field.TypeString},
+		{Name: "asset_name", Type: field.TypeString, Nullable: true},
+		{Name: "project_id", Type: field.TypeString, Nullable: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "remediation", Type: field.TypeString, Nullable: true},
+		{Name: "evidence_json", Type: field.TypeJSON, Nullable: true},
+	}
+	// PostureFindingsTable holds the schema information for the "posture_findings" table.
+	PostureFindingsTable = &schema.Table{
+		Name:       "posture_findings",
+		Columns:    PostureFindingsColumns,
+		PrimaryKey: []*schema.Column{PostureFindingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "goldposturefinding_status",
+				Unique:  false,
+				Columns: []*schema.Column{PostureFindingsColumns[3]},
+			},
+			{
+				Name:    "goldposturefinding_rule_key",
+				Unique:  false,
+				Columns: []*schema.Column{PostureFindingsColumns[9]},
+			},
+			{
+				Name:    "goldposturefinding_severity",
+				Unique:  false,
+				Columns: []*schema.Column{PostureFindingsColumns[11]},
+			},
+			{
+				Name:    "goldposturefinding_resource_type",
+				Unique:  false,
+				Columns: []*schema.Column{PostureFindingsColumns[13]},
+			},
+			{
+				Name:    "goldposturefinding_project_id",
+				Unique:  false,
+				Columns: []*schema.Column{PostureFindingsColumns[16]},
+			},
+			{
+				Name:    "goldposturefinding_asset_id",
+				Unique:  false,
+				Columns: []*schema.Column{PostureFindingsColumns[14]},
+			},
+		},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		PostureFindingsTable,
+	}
+)
+
+func init() {
+	PostureFindingsTable.Annotation = &entsql.Annotation{
+		Table: "posture_findings",
+	}
+}
@@ -0,0 +1,1578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package posture
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/posture/goldposturefinding"
+	"danny.vn/hotpot/pkg/storage/ent/posture/predicate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Operation types.
+	OpCreate    = ent.OpCreate
+	OpDelete    = ent.OpDelete
+	OpDeleteOne = ent.OpDeleteOne
+	OpUpdate    = ent.OpUpdate
+	OpUpdateOne = ent.OpUpdateOne
+
+	// Node types.
+	TypeGoldPostureFinding = "GoldPostureFinding"
+)
+
+// GoldPostureFindingMutation represents an operation that mutates the GoldPostureFinding nodes in the graph.
+type GoldPostureFindingMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *string
+	detected_at         *time.Time
+	first_detected_at   *time.Time
+	status              *string
+	assignee            *string
+	note                *string
+	suppressed_until    *time.Time
+	resolved_at         *time.Time
+	last_seen_at        *time.Time
+	rule_key            *string
+	category            *string
+	severity            *string
+	provider            *string
+	resource_type       *string
+	asset_id            *string
+	asset_name          *string
+	project_id          *string
+	title               *string
+	description         *string
+	remediation         *string
+	evidence_json       *json.RawMessage
+	appendevidence_json json.RawMessage
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*GoldPostureFinding, error)
+	predicates          []predicate.GoldPostureFinding
+}
+
+var _ ent.Mutation = (*GoldPostureFindingMutation)(nil)
+
+// goldposturefindingOption allows management of the mutation configuration using functional options.
+type goldposturefindingOption func(*GoldPostureFindingMutation)
+
+// newGoldPostureFindingMutation creates new mutation for the GoldPostureFinding entity.
+func newGoldPostureFindingMutation(c config, op Op, opts ...goldposturefindingOption) *GoldPostureFindingMutation {
+	m := &GoldPostureFindingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGoldPostureFinding,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGoldPostureFindingID sets the ID field of the mutation.
+func withGoldPostureFindingID(id string) goldposturefindingOption {
+	return func(m *GoldPostureFindingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GoldPostureFinding
+		)
+		m.oldValue = func(ctx context.Context) (*GoldPostureFinding, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GoldPostureFinding.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGoldPostureFinding sets the old GoldPostureFinding of the mutation.
+func withGoldPostureFinding(node *GoldPostureFinding) goldposturefindingOption {
+	return func(m *GoldPostureFindingMutation) {
+		m.oldValue = func(context.Context) (*GoldPostureFinding, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GoldPostureFindingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GoldPostureFindingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("posture: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GoldPostureFinding entities.
+func (m *GoldPostureFindingMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GoldPostureFindingMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GoldPostureFindingMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GoldPostureFinding.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDetectedAt sets the "detected_at" field.
+func (m *GoldPostureFindingMutation) SetDetectedAt(t time.Time) {
+	m.detected_at = &t
+}
+
+// DetectedAt returns the value of the "detected_at" field in the mutation.
+func (m *GoldPostureFindingMutation) DetectedAt() (r time.Time, exists bool) {
+	v := m.detected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetectedAt returns the old "detected_at" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldDetectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetectedAt: %w", err)
+	}
+	return oldValue.DetectedAt, nil
+}
+
+// ResetDetectedAt resets all changes to the "detected_at" field.
+func (m *GoldPostureFindingMutation) ResetDetectedAt() {
+	m.detected_at = nil
+}
+
+// SetFirstDetectedAt sets the "first_detected_at" field.
+func (m *GoldPostureFindingMutation) SetFirstDetectedAt(t time.Time) {
+	m.first_detected_at = &t
+}
+
+// FirstDetectedAt returns the value of the "first_detected_at" field in the mutation.
+func (m *GoldPostureFindingMutation) FirstDetectedAt() (r time.Time, exists bool) {
+	v := m.first_detected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstDetectedAt returns the old "first_detected_at" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldFirstDetectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstDetectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstDetectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstDetectedAt: %w", err)
+	}
+	return oldValue.FirstDetectedAt, nil
+}
+
+// ResetFirstDetectedAt resets all changes to the "first_detected_at" field.
+func (m *GoldPostureFindingMutation) ResetFirstDetectedAt() {
+	m.first_detected_at = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *GoldPostureFindingMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *GoldPostureFindingMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *GoldPostureFindingMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAssignee sets the "assignee" field.
+func (m *GoldPostureFindingMutation) SetAssignee(s string) {
+	m.assignee = &s
+}
+
+// Assignee returns the value of the "assignee" field in the mutation.
+func (m *GoldPostureFindingMutation) Assignee() (r string, exists bool) {
+	v := m.assignee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignee returns the old "assignee" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldAssignee(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignee: %w", err)
+	}
+	return oldValue.Assignee, nil
+}
+
+// ClearAssignee clears the value of the "assignee" field.
+func (m *GoldPostureFindingMutation) ClearAssignee() {
+	m.assignee = nil
+	m.clearedFields[goldposturefinding.FieldAssignee] = struct{}{}
+}
+
+// AssigneeCleared returns if the "assignee" field was cleared in this mutation.
+func (m *GoldPostureFindingMutation) AssigneeCleared() bool {
+	_, ok := m.clearedFields[goldposturefinding.FieldAssignee]
+	return ok
+}
+
+// ResetAssignee resets all changes to the "assignee" field.
+func (m *GoldPostureFindingMutation) ResetAssignee() {
+	m.assignee = nil
+	delete(m.clearedFields, goldposturefinding.FieldAssignee)
+}
+
+// SetNote sets the "note" field.
+func (m *GoldPostureFindingMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *GoldPostureFindingMutation) Note() (r string, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNote returns the old "note" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+	}
+	return oldValue.Note, nil
+}
+
+// ClearNote clears the value of the "note" field.
+func (m *GoldPostureFindingMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[goldposturefinding.FieldNote] = struct{}{}
+}
+
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *GoldPostureFindingMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[goldposturefinding.FieldNote]
+	return ok
+}
+
+// ResetNote resets all changes to the "note" field.
+func (m *GoldPostureFindingMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, goldposturefinding.FieldNote)
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (m *GoldPostureFindingMutation) SetSuppressedUntil(t time.Time) {
+	m.suppressed_until = &t
+}
+
+// SuppressedUntil returns the value of the "suppressed_until" field in the mutation.
+func (m *GoldPostureFindingMutation) SuppressedUntil() (r time.Time, exists bool) {
+	v := m.suppressed_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuppressedUntil returns the old "suppressed_until" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldSuppressedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuppressedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuppressedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuppressedUntil: %w", err)
+	}
+	return oldValue.SuppressedUntil, nil
+}
+
+// ClearSuppressedUntil clears the value of the "suppressed_until" field.
+func (m *GoldPostureFindingMutation) ClearSuppressedUntil() {
+	m.suppressed_until = nil
+	m.clearedFields[goldposturefinding.FieldSuppressedUntil] = struct{}{}
+}
+
+// SuppressedUntilCleared returns if the "suppressed_until" field was cleared in this mutation.
+func (m *GoldPostureFindingMutation) SuppressedUntilCleared() bool {
+	_, ok := m.clearedFields[goldposturefinding.FieldSuppressedUntil]
+	return ok
+}
+
+// ResetSuppressedUntil resets all changes to the "suppressed_until" field.
+func (m *GoldPostureFindingMutation) ResetSuppressedUntil() {
+	m.suppressed_until = nil
+	delete(m.clearedFields, goldposturefinding.FieldSuppressedUntil)
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (m *GoldPostureFindingMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
+}
+
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *GoldPostureFindingMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolvedAt returns the old "resolved_at" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldResolvedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+	}
+	return oldValue.ResolvedAt, nil
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *GoldPostureFindingMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[goldposturefinding.FieldResolvedAt] = struct{}{}
+}
+
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *GoldPostureFindingMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[goldposturefinding.FieldResolvedAt]
+	return ok
+}
+
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *GoldPostureFindingMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, goldposturefinding.FieldResolvedAt)
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *GoldPostureFindingMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *GoldPostureFindingMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *GoldPostureFindingMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+}
+
+// SetRuleKey sets the "rule_key" field.
+func (m *GoldPostureFindingMutation) SetRuleKey(s string) {
+	m.rule_key = &s
+}
+
+// RuleKey returns the value of the "rule_key" field in the mutation.
+func (m *GoldPostureFindingMutation) RuleKey() (r string, exists bool) {
+	v := m.rule_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRuleKey returns the old "rule_key" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldRuleKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRuleKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRuleKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRuleKey: %w", err)
+	}
+	return oldValue.RuleKey, nil
+}
+
+// ResetRuleKey resets all changes to the "rule_key" field.
+func (m *GoldPostureFindingMutation) ResetRuleKey() {
+	m.rule_key = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *GoldPostureFindingMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *GoldPostureFindingMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *GoldPostureFindingMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetSeverity sets the "severity" field.
+func (m *GoldPostureFindingMutation) SetSeverity(s string) {
+	m.severity = &s
+}
+
+// Severity returns the value of the "severity" field in the mutation.
+func (m *GoldPostureFindingMutation) Severity() (r string, exists bool) {
+	v := m.severity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeverity returns the old "severity" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldSeverity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeverity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeverity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeverity: %w", err)
+	}
+	return oldValue.Severity, nil
+}
+
+// ResetSeverity resets all changes to the "severity" field.
+func (m *GoldPostureFindingMutation) ResetSeverity() {
+	m.severity = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *GoldPostureFindingMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *GoldPostureFindingMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *GoldPostureFindingMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetResourceType sets the "resource_type" field.
+func (m *GoldPostureFindingMutation) SetResourceType(s string) {
+	m.resource_type = &s
+}
+
+// ResourceType returns the value of the "resource_type" field in the mutation.
+func (m *GoldPostureFindingMutation) ResourceType() (r string, exists bool) {
+	v := m.resource_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResourceType returns the old "resource_type" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldResourceType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
+	}
+	return oldValue.ResourceType, nil
+}
+
+// ResetResourceType resets all changes to the "resource_type" field.
+func (m *GoldPostureFindingMutation) ResetResourceType() {
+	m.resource_type = nil
+}
+
+// SetAssetID sets the "asset_id" field.
+func (m *GoldPostureFindingMutation) SetAssetID(s string) {
+	m.asset_id = &s
+}
+
+// AssetID returns the value of the "asset_id" field in the mutation.
+func (m *GoldPostureFindingMutation) AssetID() (r string, exists bool) {
+	v := m.asset_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssetID returns the old "asset_id" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldAssetID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssetID: %w", err)
+	}
+	return oldValue.AssetID, nil
+}
+
+// ResetAssetID resets all changes to the "asset_id" field.
+func (m *GoldPostureFindingMutation) ResetAssetID() {
+	m.asset_id = nil
+}
+
+// SetAssetName sets the "asset_name" field.
+func (m *GoldPostureFindingMutation) SetAssetName(s string) {
+	m.asset_name = &s
+}
+
+// AssetName returns the value of the "asset_name" field in the mutation.
+func (m *GoldPostureFindingMutation) AssetName() (r string, exists bool) {
+	v := m.asset_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssetName returns the old "asset_name" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldAssetName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssetName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssetName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssetName: %w", err)
+	}
+	return oldValue.AssetName, nil
+}
+
+// ClearAssetName clears the value of the "asset_name" field.
+func (m *GoldPostureFindingMutation) ClearAssetName() {
+	m.asset_name = nil
+	m.clearedFields[goldposturefinding.FieldAssetName] = struct{}{}
+}
+
+// AssetNameCleared returns if the "asset_name" field was cleared in this mutation.
+func (m *GoldPostureFindingMutation) AssetNameCleared() bool {
+	_, ok := m.clearedFields[goldposturefinding.FieldAssetName]
+	return ok
+}
+
+// ResetAssetName resets all changes to the "asset_name" field.
+func (m *GoldPostureFindingMutation) ResetAssetName() {
+	m.asset_name = nil
+	delete(m.clearedFields, goldposturefinding.FieldAssetName)
+}
+
+// SetProjectID sets the "project_id" field.
+func (m *GoldPostureFindingMutation) SetProjectID(s string) {
+	m.project_id = &s
+}
+
+// ProjectID returns the value of the "project_id" field in the mutation.
+func (m *GoldPostureFindingMutation) ProjectID() (r string, exists bool) {
+	v := m.project_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProjectID returns the old "project_id" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldProjectID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectID: %w", err)
+	}
+	return oldValue.ProjectID, nil
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (m *GoldPostureFindingMutation) ClearProjectID() {
+	m.project_id = nil
+	m.clearedFields[goldposturefinding.FieldProjectID] = struct{}{}
+}
+
+// ProjectIDCleared returns if the "project_id" field was cleared in this mutation.
+func (m *GoldPostureFindingMutation) ProjectIDCleared() bool {
+	_, ok := m.clearedFields[goldposturefinding.FieldProjectID]
+	return ok
+}
+
+// ResetProjectID resets all changes to the "project_id" field.
+func (m *GoldPostureFindingMutation) ResetProjectID() {
+	m.project_id = nil
+	delete(m.clearedFields, goldposturefinding.FieldProjectID)
+}
+
+// SetTitle sets the "title" field.
+func (m *GoldPostureFindingMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *GoldPostureFindingMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *GoldPostureFindingMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *GoldPostureFindingMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *GoldPostureFindingMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *GoldPostureFindingMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[goldposturefinding.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *GoldPostureFindingMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[goldposturefinding.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *GoldPostureFindingMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, goldposturefinding.FieldDescription)
+}
+
+// SetRemediation sets the "remediation" field.
+func (m *GoldPostureFindingMutation) SetRemediation(s string) {
+	m.remediation = &s
+}
+
+// Remediation returns the value of the "remediation" field in the mutation.
+func (m *GoldPostureFindingMutation) Remediation() (r string, exists bool) {
+	v := m.remediation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemediation returns the old "remediation" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldRemediation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemediation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemediation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemediation: %w", err)
+	}
+	return oldValue.Remediation, nil
+}
+
+// ClearRemediation clears the value of the "remediation" field.
+func (m *GoldPostureFindingMutation) ClearRemediation() {
+	m.remediation = nil
+	m.clearedFields[goldposturefinding.FieldRemediation] = struct{}{}
+}
+
+// RemediationCleared returns if the "remediation" field was cleared in this mutation.
+func (m *GoldPostureFindingMutation) RemediationCleared() bool {
+	_, ok := m.clearedFields[goldposturefinding.FieldRemediation]
+	return ok
+}
+
+// ResetRemediation resets all changes to the "remediation" field.
+func (m *GoldPostureFindingMutation) ResetRemediation() {
+	m.remediation = nil
+	delete(m.clearedFields, goldposturefinding.FieldRemediation)
+}
+
+// SetEvidenceJSON sets the "evidence_json" field.
+func (m *GoldPostureFindingMutation) SetEvidenceJSON(jm json.RawMessage) {
+	m.evidence_json = &jm
+	m.appendevidence_json = nil
+}
+
+// EvidenceJSON returns the value of the "evidence_json" field in the mutation.
+func (m *GoldPostureFindingMutation) EvidenceJSON() (r json.RawMessage, exists bool) {
+	v := m.evidence_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvidenceJSON returns the old "evidence_json" field's value of the GoldPostureFinding entity.
+// If the GoldPostureFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldPostureFindingMutation) OldEvidenceJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvidenceJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvidenceJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvidenceJSON: %w", err)
+	}
+	return oldValue.EvidenceJSON, nil
+}
+
+// AppendEvidenceJSON adds jm to the "evidence_json" field.
+func (m *GoldPostureFindingMutation) AppendEvidenceJSON(jm json.RawMessage) {
+	m.appendevidence_json = append(m.appendevidence_json, jm...)
+}
+
+// AppendedEvidenceJSON returns the list of values that were appended to the "evidence_json" field in this mutation.
+func (m *GoldPostureFindingMutation) AppendedEvidenceJSON() (json.RawMessage, bool) {
+	if len(m.appendevidence_json) == 0 {
+		return nil, false
+	}
+	return m.appendevidence_json, true
+}
+
+// ClearEvidenceJSON clears the value of the "evidence_json" field.
+func (m *GoldPostureFindingMutation) ClearEvidenceJSON() {
+	m.evidence_json = nil
+	m.appendevidence_json = nil
+	m.clearedFields[goldposturefinding.FieldEvidenceJSON] = struct{}{}
+}
+
+// EvidenceJSONCleared returns if the "evidence_json" field was cleared in this mutation.
+func (m *GoldPostureFindingMutation) EvidenceJSONCleared() bool {
+	_, ok := m.clearedFields[goldposturefinding.FieldEvidenceJSON]
+	return ok
+}
+
+// ResetEvidenceJSON resets all changes to the "evidence_json" field.
+func (m *GoldPostureFindingMutation) ResetEvidenceJSON() {
+	m.evidence_json = nil
+	m.appendevidence_json = nil
+	delete(m.clearedFields, goldposturefinding.FieldEvidenceJSON)
+}
+
+// Where appends a list predicates to the GoldPostureFindingMutation builder.
+func (m *GoldPostureFindingMutation) Where(ps ...predicate.GoldPostureFinding) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GoldPostureFindingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GoldPostureFindingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GoldPostureFinding, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GoldPostureFindingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GoldPostureFindingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GoldPostureFinding).
+func (m *GoldPostureFindingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GoldPostureFindingMutation) Fields() []string {
+	fields := make([]string, 0, 20)
+	if m.detected_at != nil {
+		fields = append(fields, goldposturefinding.FieldDetectedAt)
+	}
+	if m.first_detected_at != nil {
+		fields = append(fields, goldposturefinding.FieldFirstDetectedAt)
+	}
+	if m.status != nil {
+		fields = append(fields, goldposturefinding.FieldStatus)
+	}
+	if m.assignee != nil {
+		fields = append(fields, goldposturefinding.FieldAssignee)
+	}
+	if m.note != nil {
+		fields = append(fields, goldposturefinding.FieldNote)
+	}
+	if m.suppressed_until != nil {
+		fields = append(fields, goldposturefinding.FieldSuppressedUntil)
+	}
+	if m.resolved_at != nil {
+		fields = append(fields, goldposturefinding.FieldResolvedAt)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, goldposturefinding.FieldLastSeenAt)
+	}
+	if m.rule_key != nil {
+		fields = append(fields, goldposturefinding.FieldRuleKey)
+	}
+	if m.category != nil {
+		fields = append(fields, goldposturefinding.FieldCategory)
+	}
+	if m.severity != nil {
+		fields = append(fields, goldposturefinding.FieldSeverity)
+	}
+	if m.provider != nil {
+		fields = append(fields, goldposturefinding.FieldProvider)
+	}
+	if m.resource_type != nil {
+		fields = append(fields, goldposturefinding.FieldResourceType)
+	}
+	if m.asset_id != nil {
+		fields = append(fields, goldposturefinding.FieldAssetID)
+	}
+	if m.asset_name != nil {
+		fields = append(fields, goldposturefinding.FieldAssetName)
+	}
+	if m.project_id != nil {
+		fields = append(fields, goldposturefinding.FieldProjectID)
+	}
+	if m.title != nil {
+		fields = append(fields, goldposturefinding.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, goldposturefinding.FieldDescription)
+	}
+	if m.remediation != nil {
+		fields = append(fields, goldposturefinding.FieldRemediation)
+	}
+	if m.evidence_json != nil {
+		fields = append(fields, goldposturefinding.FieldEvidenceJSON)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GoldPostureFindingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case goldposturefinding.FieldDetectedAt:
+		return m.DetectedAt()
+	case goldposturefinding.FieldFirstDetectedAt:
+		return m.FirstDetectedAt()
+	case goldposturefinding.FieldStatus:
+		return m.Status()
+	case goldposturefinding.FieldAssignee:
+		return m.Assignee()
+	case goldposturefinding.FieldNote:
+		return m.Note()
+	case goldposturefinding.FieldSuppressedUntil:
+		return m.SuppressedUntil()
+	case goldposturefinding.FieldResolvedAt:
+		return m.ResolvedAt()
+	case goldposturefinding.FieldLastSeenAt:
+		return m.LastSeenAt()
+	case goldposturefinding.FieldRuleKey:
+		return m.RuleKey()
+	case goldposturefinding.FieldCategory:
+		return m.Category()
+	case goldposturefinding.FieldSeverity:
+		return m.Severity()
+	case goldposturefinding.FieldProvider:
+		return m.Provider()
+	case goldposturefinding.FieldResourceType:
+		return m.ResourceType()
+	case goldposturefinding.FieldAssetID:
+		return m.AssetID()
+	case goldposturefinding.FieldAssetName:
+		return m.AssetName()
+	case goldposturefinding.FieldProjectID:
+		return m.ProjectID()
+	case goldposturefinding.FieldTitle:
+		return m.Title()
+	case goldposturefinding.FieldDescription:
+		return m.Description()
+	case goldposturefinding.FieldRemediation:
+		return m.Remediation()
+	case goldposturefinding.FieldEvidenceJSON:
+		return m.EvidenceJSON()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GoldPostureFindingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case goldposturefinding.FieldDetectedAt:
+		return m.OldDetectedAt(ctx)
+	case goldposturefinding.FieldFirstDetectedAt:
+		return m.OldFirstDetectedAt(ctx)
+	case goldposturefinding.FieldStatus:
+		return m.OldStatus(ctx)
+	case goldposturefinding.FieldAssignee:
+		return m.OldAssignee(ctx)
+	case goldposturefinding.FieldNote:
+		return m.OldNote(ctx)
+	case goldposturefinding.FieldSuppressedUntil:
+		return m.OldSuppressedUntil(ctx)
+	case goldposturefinding.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
+	case goldposturefinding.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	case goldposturefinding.FieldRuleKey:
+		return m.OldRuleKey(ctx)
+	case goldposturefinding.FieldCategory:
+		return m.OldCategory(ctx)
+	case goldposturefinding.FieldSeverity:
+		return m.OldSeverity(ctx)
+	case goldposturefinding.FieldProvider:
+		return m.OldProvider(ctx)
+	case goldposturefinding.FieldResourceType:
+		return m.OldResourceType(ctx)
+	case goldposturefinding.FieldAssetID:
+		return m.OldAssetID(ctx)
+	case goldposturefinding.FieldAssetName:
+		return m.OldAssetName(ctx)
+	case goldposturefinding.FieldProjectID:
+		return m.OldProjectID(ctx)
+	case goldposturefinding.FieldTitle:
+		return m.OldTitle(ctx)
+	case goldposturefinding.FieldDescription:
+		return m.OldDescription(ctx)
+	case goldposturefinding.FieldRemediation:
+		return m.OldRemediation(ctx)
+	case goldposturefinding.FieldEvidenceJSON:
+		return m.OldEvidenceJSON(ctx)
+	}
+	return nil, fmt.Errorf("unknown GoldPostureFinding field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoldPostureFindingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case goldposturefinding.FieldDetectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetectedAt(v)
+		return nil
+	case goldposturefinding.FieldFirstDetectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstDetectedAt(v)
+		return nil
+	case goldposturefinding.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case goldposturefinding.FieldAssignee:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignee(v)
+		return nil
+	case goldposturefinding.FieldNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNote(v)
+		return nil
+	case goldposturefinding.FieldSuppressedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuppressedUntil(v)
+		return nil
+	case goldposturefinding.FieldResolvedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedAt(v)
+		return nil
+	case goldposturefinding.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	case goldposturefinding.FieldRuleKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRuleKey(v)
+		return nil
+	case goldposturefinding.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case goldposturefinding.FieldSeverity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeverity(v)
+		return nil
+	case goldposturefinding.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case goldposturefinding.FieldResourceType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceType(v)
+		return nil
+	case goldposturefinding.FieldAssetID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssetID(v)
+		return nil
+	case goldposturefinding.FieldAssetName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssetName(v)
+		return nil
+	case goldposturefinding.FieldProjectID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectID(v)
+		return nil
+	case goldposturefinding.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case goldposturefinding.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case goldposturefinding.FieldRemediation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemediation(v)
+		return nil
+	case goldposturefinding.FieldEvidenceJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvidenceJSON(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GoldPostureFinding field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GoldPostureFindingMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GoldPostureFindingMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoldPostureFindingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GoldPostureFinding numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GoldPostureFindingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(goldposturefinding.FieldAssignee) {
+		fields = append(fields, goldposturefinding.FieldAssignee)
+	}
+	if m.FieldCleared(goldposturefinding.FieldNote) {
+		fields = append(fields, goldposturefinding.FieldNote)
+	}
+	if m.FieldCleared(goldposturefinding.FieldSuppressedUntil) {
+		fields = append(fields, goldposturefinding.FieldSuppressedUntil)
+	}
+	if m.FieldCleared(goldposturefinding.FieldResolvedAt) {
+		fields = append(fields, goldposturefinding.FieldResolvedAt)
+	}
+	if m.FieldCleared(goldposturefinding.FieldAssetName) {
+		fields = append(fields, goldposturefinding.FieldAssetName)
+	}
+	if m.FieldCleared(goldposturefinding.FieldProjectID) {
+		fields = append(fields, goldposturefinding.FieldProjectID)
+	}
+	if m.FieldCleared(goldposturefinding.FieldDescription) {
+		fields = append(fields, goldposturefinding.FieldDescription)
+	}
+	if m.FieldCleared(goldposturefinding.FieldRemediation) {
+		fields = append(fields, goldposturefinding.FieldRemediation)
+	}
+	if m.FieldCleared(goldposturefinding.FieldEvidenceJSON) {
+		fields = append(fields, goldposturefinding.FieldEvidenceJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GoldPostureFindingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GoldPostureFindingMutation) ClearField(name string) error {
+	switch name {
+	case goldposturefinding.FieldAssignee:
+		m.ClearAssignee()
+		return nil
+	case goldposturefinding.FieldNote:
+		m.ClearNote()
+		return nil
+	case goldposturefinding.FieldSuppressedUntil:
+		m.ClearSuppressedUntil()
+		return nil
+	case goldposturefinding.FieldResolvedAt:
+		m.ClearResolvedAt()
+		return nil
+	case goldposturefinding.FieldAssetName:
+		m.ClearAssetName()
+		return nil
+	case goldposturefinding.FieldProjectID:
+		m.ClearProjectID()
+		return nil
+	case goldposturefinding.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case goldposturefinding.FieldRemediation:
+		m.ClearRemediation()
+		return nil
+	case goldposturefinding.FieldEvidenceJSON:
+		m.ClearEvidenceJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown GoldPostureFinding nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GoldPostureFindingMutation) ResetField(name string) error {
+	switch name {
+	case goldposturefinding.FieldDetectedAt:
+		m.ResetDetectedAt()
+		return nil
+	case goldposturefinding.FieldFirstDetectedAt:
+		m.ResetFirstDetectedAt()
+		return nil
+	case goldposturefinding.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case goldposturefinding.FieldAssignee:
+		m.ResetAssignee()
+		return nil
+	case goldposturefinding.FieldNote:
+		m.ResetNote()
+		return nil
+	case goldposturefinding.FieldSuppressedUntil:
+		m.ResetSuppressedUntil()
+		return nil
+	case goldposturefinding.FieldResolvedAt:
+		m.ResetResolvedAt()
+		return nil
+	case goldposturefinding.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	case goldposturefinding.FieldRuleKey:
+		m.ResetRuleKey()
+		return nil
+	case goldposturefinding.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case goldposturefinding.FieldSeverity:
+		m.ResetSeverity()
+		return nil
+	case goldposturefinding.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case goldposturefinding.FieldResourceType:
+		m.ResetResourceType()
+		return nil
+	case goldposturefinding.FieldAssetID:
+		m.ResetAssetID()
+		return nil
+	case goldposturefinding.FieldAssetName:
+		m.ResetAssetName()
+		return nil
+	case goldposturefinding.FieldProjectID:
+		m.ResetProjectID()
+		return nil
+	case goldposturefinding.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case goldposturefinding.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case goldposturefinding.FieldRemediation:
+		m.ResetRemediation()
+		return nil
+	case goldposturefinding.FieldEvidenceJSON:
+		m.ResetEvidenceJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown GoldPostureFinding field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GoldPostureFindingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GoldPostureFindingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GoldPostureFindingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GoldPostureFindingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GoldPostureFindingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GoldPostureFindingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GoldPostureFindingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown GoldPostureFinding unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GoldPostureFindingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown GoldPostureFinding edge %s", name)
+}
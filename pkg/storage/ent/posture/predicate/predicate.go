@@ -0,0 +1,10 @@
+// Code generated by ent, DO NOT EDIT.
+
+package predicate
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+// GoldPostureFinding is the predicate function for goldposturefinding builders.
+type GoldPostureFinding func(*sql.Selector)
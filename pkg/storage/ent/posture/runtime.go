@@ -0,0 +1,53 @@
+// Code generated by ent, DO NOT EDIT.
+
+package posture
+
+import (
+	"danny.vn/hotpot/pkg/storage/ent/posture/goldposturefinding"
+	"danny.vn/hotpot/pkg/storage/ent/posture/schema"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	goldposturefindingMixin := schema.GoldPostureFinding{}.Mixin()
+	goldposturefindingMixinFields1 := goldposturefindingMixin[1].Fields()
+	_ = goldposturefindingMixinFields1
+	goldposturefindingFields := schema.GoldPostureFinding{}.Fields()
+	_ = goldposturefindingFields
+	// goldposturefindingDescStatus is the schema descriptor for status field.
+	goldposturefindingDescStatus := goldposturefindingMixinFields1[0].Descriptor()
+	// goldposturefinding.DefaultStatus holds the default value on creation for the status field.
+	goldposturefinding.DefaultStatus = goldposturefindingDescStatus.Default.(string)
+	// goldposturefinding.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	goldposturefinding.StatusValidator = goldposturefindingDescStatus.Validators[0].(func(string) error)
+	// goldposturefindingDescRuleKey is the schema descriptor for rule_key field.
+	goldposturefindingDescRuleKey := goldposturefindingFields[1].Descriptor()
+	// goldposturefinding.RuleKeyValidator is a validator for the "rule_key" field. It is called by the builders before save.
+	goldposturefinding.RuleKeyValidator = goldposturefindingDescRuleKey.Validators[0].(func(string) error)
+	// goldposturefindingDescCategory is the schema descriptor for category field.
+	goldposturefindingDescCategory := goldposturefindingFields[2].Descriptor()
+	// goldposturefinding.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	goldposturefinding.CategoryValidator = goldposturefindingDescCategory.Validators[0].(func(string) error)
+	// goldposturefindingDescSeverity is the schema descriptor for severity field.
+	goldposturefindingDescSeverity := goldposturefindingFields[3].Descriptor()
+	// goldposturefinding.SeverityValidator is a validator for the "severity" field. It is called by the builders before save.
+	goldposturefinding.SeverityValidator = goldposturefindingDescSeverity.Validators[0].(func(string) error)
+	// goldposturefindingDescProvider is the schema descriptor for provider field.
+	goldposturefindingDescProvider := goldposturefindingFields[4].Descriptor()
+	// goldposturefinding.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	goldposturefinding.ProviderValidator = goldposturefindingDescProvider.Validators[0].(func(string) error)
+	// goldposturefindingDescResourceType is the schema descriptor for resource_type field.
+	goldposturefindingDescResourceType := goldposturefindingFields[5].Descriptor()
+	// goldposturefinding.ResourceTypeValidator is a validator for the "resource_type" field. It is called by the builders before save.
+	goldposturefinding.ResourceTypeValidator = goldposturefindingDescResourceType.Validators[0].(func(string) error)
+	// goldposturefindingDescAssetID is the schema descriptor for asset_id field.
+	goldposturefindingDescAssetID := goldposturefindingFields[6].Descriptor()
+	// goldposturefinding.AssetIDValidator is a validator for the "asset_id" field. It is called by the builders before save.
+	goldposturefinding.AssetIDValidator = goldposturefindingDescAssetID.Validators[0].(func(string) error)
+	// goldposturefindingDescTitle is the schema descriptor for title field.
+	goldposturefindingDescTitle := goldposturefindingFields[9].Descriptor()
+	// goldposturefinding.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	goldposturefinding.TitleValidator = goldposturefindingDescTitle.Validators[0].(func(string) error)
+}
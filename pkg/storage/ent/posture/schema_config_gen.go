@@ -0,0 +1,9 @@
+// Code generated by entcgen. DO NOT EDIT.
+package posture
+
+// DefaultSchemaConfig returns the schema config mapping each type to its PG schema.
+func DefaultSchemaConfig() SchemaConfig {
+	return SchemaConfig{
+		GoldPostureFinding: "gold",
+	}
+}
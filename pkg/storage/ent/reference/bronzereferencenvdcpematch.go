@@ -0,0 +1,239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencenvdcpematch"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeReferenceNVDCPEMatch is the model entity for the BronzeReferenceNVDCPEMatch schema.
+type BronzeReferenceNVDCPEMatch struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Composite key: {cve_id}:{n}
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Reference to NVD CVE ID
+	CveID string `json:"cve_id,omitempty"`
+	// CPE 2.3 match string (e.g. cpe:2.3:a:tukaani:xz:*:*:*:*:*:*:*:*)
+	Criteria string `json:"criteria,omitempty"`
+	// a (application), o (operating system) or h (hardware)
+	Part string `json:"part,omitempty"`
+	// Vendor name from CPE
+	CpeVendor string `json:"cpe_vendor,omitempty"`
+	// Product name from CPE
+	CpeProduct string `json:"cpe_product,omitempty"`
+	// Version from CPE; * when bounded by the version range
+	CpeVersion string `json:"cpe_version,omitempty"`
+	// False for platform-only criteria (e.g. the OS a product runs on)
+	Vulnerable bool `json:"vulnerable,omitempty"`
+	// VersionStartIncluding holds the value of the "version_start_including" field.
+	VersionStartIncluding string `json:"version_start_including,omitempty"`
+	// VersionStartExcluding holds the value of the "version_start_excluding" field.
+	VersionStartExcluding string `json:"version_start_excluding,omitempty"`
+	// VersionEndIncluding holds the value of the "version_end_including" field.
+	VersionEndIncluding string `json:"version_end_including,omitempty"`
+	// VersionEndExcluding holds the value of the "version_end_excluding" field.
+	VersionEndExcluding string `json:"version_end_excluding,omitempty"`
+	selectValues        sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeReferenceNVDCPEMatch) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzereferencenvdcpematch.FieldVulnerable:
+			values[i] = new(sql.NullBool)
+		case bronzereferencenvdcpematch.FieldID, bronzereferencenvdcpematch.FieldCveID, bronzereferencenvdcpematch.FieldCriteria, bronzereferencenvdcpematch.FieldPart, bronzereferencenvdcpematch.FieldCpeVendor, bronzereferencenvdcpematch.FieldCpeProduct, bronzereferencenvdcpematch.FieldCpeVersion, bronzereferencenvdcpematch.FieldVersionStartIncluding, bronzereferencenvdcpematch.FieldVersionStartExcluding, bronzereferencenvdcpematch.FieldVersionEndIncluding, bronzereferencenvdcpematch.FieldVersionEndExcluding:
+			values[i] = new(sql.NullString)
+		case bronzereferencenvdcpematch.FieldCollectedAt, bronzereferencenvdcpematch.FieldFirstCollectedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeReferenceNVDCPEMatch fields.
+func (_m *BronzeReferenceNVDCPEMatch) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzereferencenvdcpematch.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzereferencenvdcpematch.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzereferencenvdcpematch.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzereferencenvdcpematch.FieldCveID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cve_id", values[i])
+			} else if value.Valid {
+				_m.CveID = value.String
+			}
+		case bronzereferencenvdcpematch.FieldCriteria:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field criteria", values[i])
+			} else if value.Valid {
+				_m.Criteria = value.String
+			}
+		case bronzereferencenvdcpematch.FieldPart:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field part", values[i])
+			} else if value.Valid {
+				_m.Part = value.String
+			}
+		case bronzereferencenvdcpematch.FieldCpeVendor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cpe_vendor", values[i])
+			} else if value.Valid {
+				_m.CpeVendor = value.String
+			}
+		case bronzereferencenvdcpematch.FieldCpeProduct:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cpe_product", values[i])
+			} else if value.Valid {
+				_m.CpeProduct = value.String
+			}
+		case bronzereferencenvdcpematch.FieldCpeVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cpe_version", values[i])
+			} else if value.Valid {
+				_m.CpeVersion = value.String
+			}
+		case bronzereferencenvdcpematch.FieldVulnerable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field vulnerable", values[i])
+			} else if value.Valid {
+				_m.Vulnerable = value.Bool
+			}
+		case bronzereferencenvdcpematch.FieldVersionStartIncluding:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version_start_including", values[i])
+			} else if value.Valid {
+				_m.VersionStartIncluding = value.String
+			}
+		case bronzereferencenvdcpematch.FieldVersionStartExcluding:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version_start_excluding", values[i])
+			} else if value.Valid {
+				_m.VersionStartExcluding = value.String
+			}
+		case bronzereferencenvdcpematch.FieldVersionEndIncluding:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version_end_including", values[i])
+			} else if value.Valid {
+				_m.VersionEndIncluding = value.String
+			}
+		case bronzereferencenvdcpematch.FieldVersionEndExcluding:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version_end_excluding", values[i])
+			} else if value.Valid {
+				_m.VersionEndExcluding = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeReferenceNVDCPEMatch.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeReferenceNVDCPEMatch) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeReferenceNVDCPEMatch.
+// Note that you need to call BronzeReferenceNVDCPEMatch.Unwrap() before calling this method if this BronzeReferenceNVDCPEMatch
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeReferenceNVDCPEMatch) Update() *BronzeReferenceNVDCPEMatchUpdateOne {
+	return NewBronzeReferenceNVDCPEMatchClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeReferenceNVDCPEMatch entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeReferenceNVDCPEMatch) Unwrap() *BronzeReferenceNVDCPEMatch {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("reference: BronzeReferenceNVDCPEMatch is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeReferenceNVDCPEMatch) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeReferenceNVDCPEMatch(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("cve_id=")
+	builder.WriteString(_m.CveID)
+	builder.WriteString(", ")
+	builder.WriteString("criteria=")
+	builder.WriteString(_m.Criteria)
+	builder.WriteString(", ")
+	builder.WriteString("part=")
+	builder.WriteString(_m.Part)
+	builder.WriteString(", ")
+	builder.WriteString("cpe_vendor=")
+	builder.WriteString(_m.CpeVendor)
+	builder.WriteString(", ")
+	builder.WriteString("cpe_product=")
+	builder.WriteString(_m.CpeProduct)
+	builder.WriteString(", ")
+	builder.WriteString("cpe_version=")
+	builder.WriteString(_m.CpeVersion)
+	builder.WriteString(", ")
+	builder.WriteString("vulnerable=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Vulnerable))
+	builder.WriteString(", ")
+	builder.WriteString("version_start_including=")
+	builder.WriteString(_m.VersionStartIncluding)
+	builder.WriteString(", ")
+	builder.WriteString("version_start_excluding=")
+	builder.WriteString(_m.VersionStartExcluding)
+	builder.WriteString(", ")
+	builder.WriteString("version_end_including=")
+	builder.WriteString(_m.VersionEndIncluding)
+	builder.WriteString(", ")
+	builder.WriteString("version_end_excluding=")
+	builder.WriteString(_m.VersionEndExcluding)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeReferenceNVDCPEMatches is a parsable slice of BronzeReferenceNVDCPEMatch.
+type BronzeReferenceNVDCPEMatches []*BronzeReferenceNVDCPEMatch
@@ -0,0 +1,148 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzereferencenvdcpematch
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzereferencenvdcpematch type in the database.
+	Label = "bronze_reference_nvdcpe_match"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldCveID holds the string denoting the cve_id field in the database.
+	FieldCveID = "cve_id"
+	// FieldCriteria holds the string denoting the criteria field in the database.
+	FieldCriteria = "criteria"
+	// FieldPart holds the string denoting the part field in the database.
+	FieldPart = "part"
+	// FieldCpeVendor holds the string denoting the cpe_vendor field in the database.
+	FieldCpeVendor = "cpe_vendor"
+	// FieldCpeProduct holds the string denoting the cpe_product field in the database.
+	FieldCpeProduct = "cpe_product"
+	// FieldCpeVersion holds the string denoting the cpe_version field in the database.
+	FieldCpeVersion = "cpe_version"
+	// FieldVulnerable holds the string denoting the vulnerable field in the database.
+	FieldVulnerable = "vulnerable"
+	// FieldVersionStartIncluding holds the string denoting the version_start_including field in the database.
+	FieldVersionStartIncluding = "version_start_including"
+	// FieldVersionStartExcluding holds the string denoting the version_start_excluding field in the database.
+	FieldVersionStartExcluding = "version_start_excluding"
+	// FieldVersionEndIncluding holds the string denoting the version_end_including field in the database.
+	FieldVersionEndIncluding = "version_end_including"
+	// FieldVersionEndExcluding holds the string denoting the version_end_excluding field in the database.
+	FieldVersionEndExcluding = "version_end_excluding"
+	// Table holds the table name of the bronzereferencenvdcpematch in the database.
+	Table = "reference_nvd_cpe_matches"
+)
+
+// Columns holds all SQL columns for bronzereferencenvdcpematch fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldCveID,
+	FieldCriteria,
+	FieldPart,
+	FieldCpeVendor,
+	FieldCpeProduct,
+	FieldCpeVersion,
+	FieldVulnerable,
+	FieldVersionStartIncluding,
+	FieldVersionStartExcluding,
+	FieldVersionEndIncluding,
+	FieldVersionEndExcluding,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultVulnerable holds the default value on creation for the "vulnerable" field.
+	DefaultVulnerable bool
+)
+
+// OrderOption defines the ordering options for the BronzeReferenceNVDCPEMatch queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByCveID orders the results by the cve_id field.
+func ByCveID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCveID, opts...).ToFunc()
+}
+
+// ByCriteria orders the results by the criteria field.
+func ByCriteria(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCriteria, opts...).ToFunc()
+}
+
+// ByPart orders the results by the part field.
+func ByPart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPart, opts...).ToFunc()
+}
+
+// ByCpeVendor orders the results by the cpe_vendor field.
+func ByCpeVendor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCpeVendor, opts...).ToFunc()
+}
+
+// ByCpeProduct orders the results by the cpe_product field.
+func ByCpeProduct(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCpeProduct, opts...).ToFunc()
+}
+
+// ByCpeVersion orders the results by the cpe_version field.
+func ByCpeVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCpeVersion, opts...).ToFunc()
+}
+
+// ByVulnerable orders the results by the vulnerable field.
+func ByVulnerable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVulnerable, opts...).ToFunc()
+}
+
+// ByVersionStartIncluding orders the results by the version_start_including field.
+func ByVersionStartIncluding(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersionStartIncluding, opts...).ToFunc()
+}
+
+// ByVersionStartExcluding orders the results by the version_start_excluding field.
+func ByVersionStartExcluding(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersionStartExcluding, opts...).ToFunc()
+}
+
+// ByVersionEndIncluding orders the results by the version_end_including field.
+func ByVersionEndIncluding(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersionEndIncluding, opts...).ToFunc()
+}
+
+// ByVersionEndExcluding orders the results by the version_end_excluding field.
+func ByVersionEndExcluding(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersionEndExcluding, opts...).ToFunc()
+}
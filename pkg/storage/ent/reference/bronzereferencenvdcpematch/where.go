@@ -0,0 +1,925 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzereferencenvdcpematch
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// CveID applies equality check predicate on the "cve_id" field. It's identical to CveIDEQ.
+func CveID(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldCveID, v))
+}
+
+// Criteria applies equality check predicate on the "criteria" field. It's identical to CriteriaEQ.
+func Criteria(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldCriteria, v))
+}
+
+// Part applies equality check predicate on the "part" field. It's identical to PartEQ.
+func Part(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldPart, v))
+}
+
+// CpeVendor applies equality check predicate on the "cpe_vendor" field. It's identical to CpeVendorEQ.
+func CpeVendor(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldCpeVendor, v))
+}
+
+// CpeProduct applies equality check predicate on the "cpe_product" field. It's identical to CpeProductEQ.
+func CpeProduct(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldCpeProduct, v))
+}
+
+// CpeVersion applies equality check predicate on the "cpe_version" field. It's identical to CpeVersionEQ.
+func CpeVersion(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldCpeVersion, v))
+}
+
+// Vulnerable applies equality check predicate on the "vulnerable" field. It's identical to VulnerableEQ.
+func Vulnerable(v bool) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldVulnerable, v))
+}
+
+// VersionStartIncluding applies equality check predicate on the "version_start_including" field. It's identical to VersionStartIncludingEQ.
+func VersionStartIncluding(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldVersionStartIncluding, v))
+}
+
+// VersionStartExcluding applies equality check predicate on the "version_start_excluding" field. It's identical to VersionStartExcludingEQ.
+func VersionStartExcluding(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldVersionStartExcluding, v))
+}
+
+// VersionEndIncluding applies equality check predicate on the "version_end_including" field. It's identical to VersionEndIncludingEQ.
+func VersionEndIncluding(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldVersionEndIncluding, v))
+}
+
+// VersionEndExcluding applies equality check predicate on the "version_end_excluding" field. It's identical to VersionEndExcludingEQ.
+func VersionEndExcluding(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldVersionEndExcluding, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// CveIDEQ applies the EQ predicate on the "cve_id" field.
+func CveIDEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldCveID, v))
+}
+
+// CveIDNEQ applies the NEQ predicate on the "cve_id" field.
+func CveIDNEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNEQ(FieldCveID, v))
+}
+
+// CveIDIn applies the In predicate on the "cve_id" field.
+func CveIDIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldIn(FieldCveID, vs...))
+}
+
+// CveIDNotIn applies the NotIn predicate on the "cve_id" field.
+func CveIDNotIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNotIn(FieldCveID, vs...))
+}
+
+// CveIDGT applies the GT predicate on the "cve_id" field.
+func CveIDGT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGT(FieldCveID, v))
+}
+
+// CveIDGTE applies the GTE predicate on the "cve_id" field.
+func CveIDGTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGTE(FieldCveID, v))
+}
+
+// CveIDLT applies the LT predicate on the "cve_id" field.
+func CveIDLT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLT(FieldCveID, v))
+}
+
+// CveIDLTE applies the LTE predicate on the "cve_id" field.
+func CveIDLTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLTE(FieldCveID, v))
+}
+
+// CveIDContains applies the Contains predicate on the "cve_id" field.
+func CveIDContains(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContains(FieldCveID, v))
+}
+
+// CveIDHasPrefix applies the HasPrefix predicate on the "cve_id" field.
+func CveIDHasPrefix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasPrefix(FieldCveID, v))
+}
+
+// CveIDHasSuffix applies the HasSuffix predicate on the "cve_id" field.
+func CveIDHasSuffix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasSuffix(FieldCveID, v))
+}
+
+// CveIDEqualFold applies the EqualFold predicate on the "cve_id" field.
+func CveIDEqualFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEqualFold(FieldCveID, v))
+}
+
+// CveIDContainsFold applies the ContainsFold predicate on the "cve_id" field.
+func CveIDContainsFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContainsFold(FieldCveID, v))
+}
+
+// CriteriaEQ applies the EQ predicate on the "criteria" field.
+func CriteriaEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldCriteria, v))
+}
+
+// CriteriaNEQ applies the NEQ predicate on the "criteria" field.
+func CriteriaNEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNEQ(FieldCriteria, v))
+}
+
+// CriteriaIn applies the In predicate on the "criteria" field.
+func CriteriaIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldIn(FieldCriteria, vs...))
+}
+
+// CriteriaNotIn applies the NotIn predicate on the "criteria" field.
+func CriteriaNotIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNotIn(FieldCriteria, vs...))
+}
+
+// CriteriaGT applies the GT predicate on the "criteria" field.
+func CriteriaGT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGT(FieldCriteria, v))
+}
+
+// CriteriaGTE applies the GTE predicate on the "criteria" field.
+func CriteriaGTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGTE(FieldCriteria, v))
+}
+
+// CriteriaLT applies the LT predicate on the "criteria" field.
+func CriteriaLT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLT(FieldCriteria, v))
+}
+
+// CriteriaLTE applies the LTE predicate on the "criteria" field.
+func CriteriaLTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLTE(FieldCriteria, v))
+}
+
+// CriteriaContains applies the Contains predicate on the "criteria" field.
+func CriteriaContains(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContains(FieldCriteria, v))
+}
+
+// CriteriaHasPrefix applies the HasPrefix predicate on the "criteria" field.
+func CriteriaHasPrefix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasPrefix(FieldCriteria, v))
+}
+
+// CriteriaHasSuffix applies the HasSuffix predicate on the "criteria" field.
+func CriteriaHasSuffix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasSuffix(FieldCriteria, v))
+}
+
+// CriteriaEqualFold applies the EqualFold predicate on the "criteria" field.
+func CriteriaEqualFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEqualFold(FieldCriteria, v))
+}
+
+// CriteriaContainsFold applies the ContainsFold predicate on the "criteria" field.
+func CriteriaContainsFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContainsFold(FieldCriteria, v))
+}
+
+// PartEQ applies the EQ predicate on the "part" field.
+func PartEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldPart, v))
+}
+
+// PartNEQ applies the NEQ predicate on the "part" field.
+func PartNEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNEQ(FieldPart, v))
+}
+
+// PartIn applies the In predicate on the "part" field.
+func PartIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldIn(FieldPart, vs...))
+}
+
+// PartNotIn applies the NotIn predicate on the "part" field.
+func PartNotIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNotIn(FieldPart, vs...))
+}
+
+// PartGT applies the GT predicate on the "part" field.
+func PartGT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGT(FieldPart, v))
+}
+
+// PartGTE applies the GTE predicate on the "part" field.
+func PartGTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGTE(FieldPart, v))
+}
+
+// PartLT applies the LT predicate on the "part" field.
+func PartLT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLT(FieldPart, v))
+}
+
+// PartLTE applies the LTE predicate on the "part" field.
+func PartLTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLTE(FieldPart, v))
+}
+
+// PartContains applies the Contains predicate on the "part" field.
+func PartContains(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContains(FieldPart, v))
+}
+
+// PartHasPrefix applies the HasPrefix predicate on the "part" field.
+func PartHasPrefix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasPrefix(FieldPart, v))
+}
+
+// PartHasSuffix applies the HasSuffix predicate on the "part" field.
+func PartHasSuffix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasSuffix(FieldPart, v))
+}
+
+// PartEqualFold applies the EqualFold predicate on the "part" field.
+func PartEqualFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEqualFold(FieldPart, v))
+}
+
+// PartContainsFold applies the ContainsFold predicate on the "part" field.
+func PartContainsFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContainsFold(FieldPart, v))
+}
+
+// CpeVendorEQ applies the EQ predicate on the "cpe_vendor" field.
+func CpeVendorEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldCpeVendor, v))
+}
+
+// CpeVendorNEQ applies the NEQ predicate on the "cpe_vendor" field.
+func CpeVendorNEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNEQ(FieldCpeVendor, v))
+}
+
+// CpeVendorIn applies the In predicate on the "cpe_vendor" field.
+func CpeVendorIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldIn(FieldCpeVendor, vs...))
+}
+
+// CpeVendorNotIn applies the NotIn predicate on the "cpe_vendor" field.
+func CpeVendorNotIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNotIn(FieldCpeVendor, vs...))
+}
+
+// CpeVendorGT applies the GT predicate on the "cpe_vendor" field.
+func CpeVendorGT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGT(FieldCpeVendor, v))
+}
+
+// CpeVendorGTE applies the GTE predicate on the "cpe_vendor" field.
+func CpeVendorGTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGTE(FieldCpeVendor, v))
+}
+
+// CpeVendorLT applies the LT predicate on the "cpe_vendor" field.
+func CpeVendorLT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLT(FieldCpeVendor, v))
+}
+
+// CpeVendorLTE applies the LTE predicate on the "cpe_vendor" field.
+func CpeVendorLTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLTE(FieldCpeVendor, v))
+}
+
+// CpeVendorContains applies the Contains predicate on the "cpe_vendor" field.
+func CpeVendorContains(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContains(FieldCpeVendor, v))
+}
+
+// CpeVendorHasPrefix applies the HasPrefix predicate on the "cpe_vendor" field.
+func CpeVendorHasPrefix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasPrefix(FieldCpeVendor, v))
+}
+
+// CpeVendorHasSuffix applies the HasSuffix predicate on the "cpe_vendor" field.
+func CpeVendorHasSuffix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasSuffix(FieldCpeVendor, v))
+}
+
+// CpeVendorEqualFold applies the EqualFold predicate on the "cpe_vendor" field.
+func CpeVendorEqualFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEqualFold(FieldCpeVendor, v))
+}
+
+// CpeVendorContainsFold applies the ContainsFold predicate on the "cpe_vendor" field.
+func CpeVendorContainsFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContainsFold(FieldCpeVendor, v))
+}
+
+// CpeProductEQ applies the EQ predicate on the "cpe_product" field.
+func CpeProductEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldCpeProduct, v))
+}
+
+// CpeProductNEQ applies the NEQ predicate on the "cpe_product" field.
+func CpeProductNEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNEQ(FieldCpeProduct, v))
+}
+
+// CpeProductIn applies the In predicate on the "cpe_product" field.
+func CpeProductIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldIn(FieldCpeProduct, vs...))
+}
+
+// CpeProductNotIn applies the NotIn predicate on the "cpe_product" field.
+func CpeProductNotIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNotIn(FieldCpeProduct, vs...))
+}
+
+// CpeProductGT applies the GT predicate on the "cpe_product" field.
+func CpeProductGT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGT(FieldCpeProduct, v))
+}
+
+// CpeProductGTE applies the GTE predicate on the "cpe_product" field.
+func CpeProductGTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGTE(FieldCpeProduct, v))
+}
+
+// CpeProductLT applies the LT predicate on the "cpe_product" field.
+func CpeProductLT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLT(FieldCpeProduct, v))
+}
+
+// CpeProductLTE applies the LTE predicate on the "cpe_product" field.
+func CpeProductLTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLTE(FieldCpeProduct, v))
+}
+
+// CpeProductContains applies the Contains predicate on the "cpe_product" field.
+func CpeProductContains(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContains(FieldCpeProduct, v))
+}
+
+// CpeProductHasPrefix applies the HasPrefix predicate on the "cpe_product" field.
+func CpeProductHasPrefix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasPrefix(FieldCpeProduct, v))
+}
+
+// CpeProductHasSuffix applies the HasSuffix predicate on the "cpe_product" field.
+func CpeProductHasSuffix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasSuffix(FieldCpeProduct, v))
+}
+
+// CpeProductEqualFold applies the EqualFold predicate on the "cpe_product" field.
+func CpeProductEqualFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEqualFold(FieldCpeProduct, v))
+}
+
+// CpeProductContainsFold applies the ContainsFold predicate on the "cpe_product" field.
+func CpeProductContainsFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContainsFold(FieldCpeProduct, v))
+}
+
+// CpeVersionEQ applies the EQ predicate on the "cpe_version" field.
+func CpeVersionEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldCpeVersion, v))
+}
+
+// CpeVersionNEQ applies the NEQ predicate on the "cpe_version" field.
+func CpeVersionNEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNEQ(FieldCpeVersion, v))
+}
+
+// CpeVersionIn applies the In predicate on the "cpe_version" field.
+func CpeVersionIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldIn(FieldCpeVersion, vs...))
+}
+
+// CpeVersionNotIn applies the NotIn predicate on the "cpe_version" field.
+func CpeVersionNotIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNotIn(FieldCpeVersion, vs...))
+}
+
+// CpeVersionGT applies the GT predicate on the "cpe_version" field.
+func CpeVersionGT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGT(FieldCpeVersion, v))
+}
+
+// CpeVersionGTE applies the GTE predicate on the "cpe_version" field.
+func CpeVersionGTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGTE(FieldCpeVersion, v))
+}
+
+// CpeVersionLT applies the LT predicate on the "cpe_version" field.
+func CpeVersionLT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLT(FieldCpeVersion, v))
+}
+
+// CpeVersionLTE applies the LTE predicate on the "cpe_version" field.
+func CpeVersionLTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLTE(FieldCpeVersion, v))
+}
+
+// CpeVersionContains applies the Contains predicate on the "cpe_version" field.
+func CpeVersionContains(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContains(FieldCpeVersion, v))
+}
+
+// CpeVersionHasPrefix applies the HasPrefix predicate on the "cpe_version" field.
+func CpeVersionHasPrefix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasPrefix(FieldCpeVersion, v))
+}
+
+// CpeVersionHasSuffix applies the HasSuffix predicate on the "cpe_version" field.
+func CpeVersionHasSuffix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasSuffix(FieldCpeVersion, v))
+}
+
+// CpeVersionEqualFold applies the EqualFold predicate on the "cpe_version" field.
+func CpeVersionEqualFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEqualFold(FieldCpeVersion, v))
+}
+
+// CpeVersionContainsFold applies the ContainsFold predicate on the "cpe_version" field.
+func CpeVersionContainsFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContainsFold(FieldCpeVersion, v))
+}
+
+// VulnerableEQ applies the EQ predicate on the "vulnerable" field.
+func VulnerableEQ(v bool) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldVulnerable, v))
+}
+
+// VulnerableNEQ applies the NEQ predicate on the "vulnerable" field.
+func VulnerableNEQ(v bool) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNEQ(FieldVulnerable, v))
+}
+
+// VersionStartIncludingEQ applies the EQ predicate on the "version_start_including" field.
+func VersionStartIncludingEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldVersionStartIncluding, v))
+}
+
+// VersionStartIncludingNEQ applies the NEQ predicate on the "version_start_including" field.
+func VersionStartIncludingNEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNEQ(FieldVersionStartIncluding, v))
+}
+
+// VersionStartIncludingIn applies the In predicate on the "version_start_including" field.
+func VersionStartIncludingIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldIn(FieldVersionStartIncluding, vs...))
+}
+
+// VersionStartIncludingNotIn applies the NotIn predicate on the "version_start_including" field.
+func VersionStartIncludingNotIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNotIn(FieldVersionStartIncluding, vs...))
+}
+
+// VersionStartIncludingGT applies the GT predicate on the "version_start_including" field.
+func VersionStartIncludingGT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGT(FieldVersionStartIncluding, v))
+}
+
+// VersionStartIncludingGTE applies the GTE predicate on the "version_start_including" field.
+func VersionStartIncludingGTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGTE(FieldVersionStartIncluding, v))
+}
+
+// VersionStartIncludingLT applies the LT predicate on the "version_start_including" field.
+func VersionStartIncludingLT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLT(FieldVersionStartIncluding, v))
+}
+
+// VersionStartIncludingLTE applies the LTE predicate on the "version_start_including" field.
+func VersionStartIncludingLTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLTE(FieldVersionStartIncluding, v))
+}
+
+// VersionStartIncludingContains applies the Contains predicate on the "version_start_including" field.
+func VersionStartIncludingContains(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContains(FieldVersionStartIncluding, v))
+}
+
+// VersionStartIncludingHasPrefix applies the HasPrefix predicate on the "version_start_including" field.
+func VersionStartIncludingHasPrefix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasPrefix(FieldVersionStartIncluding, v))
+}
+
+// VersionStartIncludingHasSuffix applies the HasSuffix predicate on the "version_start_including" field.
+func VersionStartIncludingHasSuffix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasSuffix(FieldVersionStartIncluding, v))
+}
+
+// VersionStartIncludingIsNil applies the IsNil predicate on the "version_start_including" field.
+func VersionStartIncludingIsNil() predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldIsNull(FieldVersionStartIncluding))
+}
+
+// VersionStartIncludingNotNil applies the NotNil predicate on the "version_start_including" field.
+func VersionStartIncludingNotNil() predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNotNull(FieldVersionStartIncluding))
+}
+
+// VersionStartIncludingEqualFold applies the EqualFold predicate on the "version_start_including" field.
+func VersionStartIncludingEqualFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEqualFold(FieldVersionStartIncluding, v))
+}
+
+// VersionStartIncludingContainsFold applies the ContainsFold predicate on the "version_start_including" field.
+func VersionStartIncludingContainsFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContainsFold(FieldVersionStartIncluding, v))
+}
+
+// VersionStartExcludingEQ applies the EQ predicate on the "version_start_excluding" field.
+func VersionStartExcludingEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldVersionStartExcluding, v))
+}
+
+// VersionStartExcludingNEQ applies the NEQ predicate on the "version_start_excluding" field.
+func VersionStartExcludingNEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNEQ(FieldVersionStartExcluding, v))
+}
+
+// VersionStartExcludingIn applies the In predicate on the "version_start_excluding" field.
+func VersionStartExcludingIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldIn(FieldVersionStartExcluding, vs...))
+}
+
+// VersionStartExcludingNotIn applies the NotIn predicate on the "version_start_excluding" field.
+func VersionStartExcludingNotIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNotIn(FieldVersionStartExcluding, vs...))
+}
+
+// VersionStartExcludingGT applies the GT predicate on the "version_start_excluding" field.
+func VersionStartExcludingGT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGT(FieldVersionStartExcluding, v))
+}
+
+// VersionStartExcludingGTE applies the GTE predicate on the "version_start_excluding" field.
+func VersionStartExcludingGTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGTE(FieldVersionStartExcluding, v))
+}
+
+// VersionStartExcludingLT applies the LT predicate on the "version_start_excluding" field.
+func VersionStartExcludingLT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLT(FieldVersionStartExcluding, v))
+}
+
+// VersionStartExcludingLTE applies the LTE predicate on the "version_start_excluding" field.
+func VersionStartExcludingLTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLTE(FieldVersionStartExcluding, v))
+}
+
+// VersionStartExcludingContains applies the Contains predicate on the "version_start_excluding" field.
+func VersionStartExcludingContains(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContains(FieldVersionStartExcluding, v))
+}
+
+// VersionStartExcludingHasPrefix applies the HasPrefix predicate on the "version_start_excluding" field.
+func VersionStartExcludingHasPrefix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasPrefix(FieldVersionStartExcluding, v))
+}
+
+// VersionStartExcludingHasSuffix applies the HasSuffix predicate on the "version_start_excluding" field.
+func VersionStartExcludingHasSuffix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasSuffix(FieldVersionStartExcluding, v))
+}
+
+// VersionStartExcludingIsNil applies the IsNil predicate on the "version_start_excluding" field.
+func VersionStartExcludingIsNil() predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldIsNull(FieldVersionStartExcluding))
+}
+
+// VersionStartExcludingNotNil applies the NotNil predicate on the "version_start_excluding" field.
+func VersionStartExcludingNotNil() predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNotNull(FieldVersionStartExcluding))
+}
+
+// VersionStartExcludingEqualFold applies the EqualFold predicate on the "version_start_excluding" field.
+func VersionStartExcludingEqualFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEqualFold(FieldVersionStartExcluding, v))
+}
+
+// VersionStartExcludingContainsFold applies the ContainsFold predicate on the "version_start_excluding" field.
+func VersionStartExcludingContainsFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContainsFold(FieldVersionStartExcluding, v))
+}
+
+// VersionEndIncludingEQ applies the EQ predicate on the "version_end_including" field.
+func VersionEndIncludingEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldVersionEndIncluding, v))
+}
+
+// VersionEndIncludingNEQ applies the NEQ predicate on the "version_end_including" field.
+func VersionEndIncludingNEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNEQ(FieldVersionEndIncluding, v))
+}
+
+// VersionEndIncludingIn applies the In predicate on the "version_end_including" field.
+func VersionEndIncludingIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldIn(FieldVersionEndIncluding, vs...))
+}
+
+// VersionEndIncludingNotIn applies the NotIn predicate on the "version_end_including" field.
+func VersionEndIncludingNotIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNotIn(FieldVersionEndIncluding, vs...))
+}
+
+// VersionEndIncludingGT applies the GT predicate on the "version_end_including" field.
+func VersionEndIncludingGT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGT(FieldVersionEndIncluding, v))
+}
+
+// VersionEndIncludingGTE applies the GTE predicate on the "version_end_including" field.
+func VersionEndIncludingGTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGTE(FieldVersionEndIncluding, v))
+}
+
+// VersionEndIncludingLT applies the LT predicate on the "version_end_including" field.
+func VersionEndIncludingLT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLT(FieldVersionEndIncluding, v))
+}
+
+// VersionEndIncludingLTE applies the LTE predicate on the "version_end_including" field.
+func VersionEndIncludingLTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLTE(FieldVersionEndIncluding, v))
+}
+
+// VersionEndIncludingContains applies the Contains predicate on the "version_end_including" field.
+func VersionEndIncludingContains(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContains(FieldVersionEndIncluding, v))
+}
+
+// VersionEndIncludingHasPrefix applies the HasPrefix predicate on the "version_end_including" field.
+func VersionEndIncludingHasPrefix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasPrefix(FieldVersionEndIncluding, v))
+}
+
+// VersionEndIncludingHasSuffix applies the HasSuffix predicate on the "version_end_including" field.
+func VersionEndIncludingHasSuffix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasSuffix(FieldVersionEndIncluding, v))
+}
+
+// VersionEndIncludingIsNil applies the IsNil predicate on the "version_end_including" field.
+func VersionEndIncludingIsNil() predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldIsNull(FieldVersionEndIncluding))
+}
+
+// VersionEndIncludingNotNil applies the NotNil predicate on the "version_end_including" field.
+func VersionEndIncludingNotNil() predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNotNull(FieldVersionEndIncluding))
+}
+
+// VersionEndIncludingEqualFold applies the EqualFold predicate on the "version_end_including" field.
+func VersionEndIncludingEqualFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEqualFold(FieldVersionEndIncluding, v))
+}
+
+// VersionEndIncludingContainsFold applies the ContainsFold predicate on the "version_end_including" field.
+func VersionEndIncludingContainsFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContainsFold(FieldVersionEndIncluding, v))
+}
+
+// VersionEndExcludingEQ applies the EQ predicate on the "version_end_excluding" field.
+func VersionEndExcludingEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEQ(FieldVersionEndExcluding, v))
+}
+
+// VersionEndExcludingNEQ applies the NEQ predicate on the "version_end_excluding" field.
+func VersionEndExcludingNEQ(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNEQ(FieldVersionEndExcluding, v))
+}
+
+// VersionEndExcludingIn applies the In predicate on the "version_end_excluding" field.
+func VersionEndExcludingIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldIn(FieldVersionEndExcluding, vs...))
+}
+
+// VersionEndExcludingNotIn applies the NotIn predicate on the "version_end_excluding" field.
+func VersionEndExcludingNotIn(vs ...string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNotIn(FieldVersionEndExcluding, vs...))
+}
+
+// VersionEndExcludingGT applies the GT predicate on the "version_end_excluding" field.
+func VersionEndExcludingGT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGT(FieldVersionEndExcluding, v))
+}
+
+// VersionEndExcludingGTE applies the GTE predicate on the "version_end_excluding" field.
+func VersionEndExcludingGTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldGTE(FieldVersionEndExcluding, v))
+}
+
+// VersionEndExcludingLT applies the LT predicate on the "version_end_excluding" field.
+func VersionEndExcludingLT(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLT(FieldVersionEndExcluding, v))
+}
+
+// VersionEndExcludingLTE applies the LTE predicate on the "version_end_excluding" field.
+func VersionEndExcludingLTE(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldLTE(FieldVersionEndExcluding, v))
+}
+
+// VersionEndExcludingContains applies the Contains predicate on the "version_end_excluding" field.
+func VersionEndExcludingContains(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContains(FieldVersionEndExcluding, v))
+}
+
+// VersionEndExcludingHasPrefix applies the HasPrefix predicate on the "version_end_excluding" field.
+func VersionEndExcludingHasPrefix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasPrefix(FieldVersionEndExcluding, v))
+}
+
+// VersionEndExcludingHasSuffix applies the HasSuffix predicate on the "version_end_excluding" field.
+func VersionEndExcludingHasSuffix(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldHasSuffix(FieldVersionEndExcluding, v))
+}
+
+// VersionEndExcludingIsNil applies the IsNil predicate on the "version_end_excluding" field.
+func VersionEndExcludingIsNil() predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldIsNull(FieldVersionEndExcluding))
+}
+
+// VersionEndExcludingNotNil applies the NotNil predicate on the "version_end_excluding" field.
+func VersionEndExcludingNotNil() predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldNotNull(FieldVersionEndExcluding))
+}
+
+// VersionEndExcludingEqualFold applies the EqualFold predicate on the "version_end_excluding" field.
+func VersionEndExcludingEqualFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldEqualFold(FieldVersionEndExcluding, v))
+}
+
+// VersionEndExcludingContainsFold applies the ContainsFold predicate on the "version_end_excluding" field.
+func VersionEndExcludingContainsFold(v string) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.FieldContainsFold(FieldVersionEndExcluding, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeReferenceNVDCPEMatch) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeReferenceNVDCPEMatch) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeReferenceNVDCPEMatch) predicate.BronzeReferenceNVDCPEMatch {
+	return predicate.BronzeReferenceNVDCPEMatch(sql.NotPredicates(p))
+}
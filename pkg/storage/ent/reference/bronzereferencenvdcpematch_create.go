@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencenvdcpematch"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeReferenceNVDCPEMatchCreate is the builder for creating a BronzeReferenceNVDCPEMatch entity.
+type BronzeReferenceNVDCPEMatchCreate struct {
+	config
+	mutation *BronzeReferenceNVDCPEMatchMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SetCollectedAt(v time.Time) *BronzeReferenceNVDCPEMatchCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SetFirstCollectedAt(v time.Time) *BronzeReferenceNVDCPEMatchCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetCveID sets the "cve_id" field.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SetCveID(v string) *BronzeReferenceNVDCPEMatchCreate {
+	_c.mutation.SetCveID(v)
+	return _c
+}
+
+// SetCriteria sets the "criteria" field.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SetCriteria(v string) *BronzeReferenceNVDCPEMatchCreate {
+	_c.mutation.SetCriteria(v)
+	return _c
+}
+
+// SetPart sets the "part" field.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SetPart(v string) *BronzeReferenceNVDCPEMatchCreate {
+	_c.mutation.SetPart(v)
+	return _c
+}
+
+// SetCpeVendor sets the "cpe_vendor" field.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SetCpeVendor(v string) *BronzeReferenceNVDCPEMatchCreate {
+	_c.mutation.SetCpeVendor(v)
+	return _c
+}
+
+// SetCpeProduct sets the "cpe_product" field.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SetCpeProduct(v string) *BronzeReferenceNVDCPEMatchCreate {
+	_c.mutation.SetCpeProduct(v)
+	return _c
+}
+
+// SetCpeVersion sets the "cpe_version" field.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SetCpeVersion(v string) *BronzeReferenceNVDCPEMatchCreate {
+	_c.mutation.SetCpeVersion(v)
+	return _c
+}
+
+// SetVulnerable sets the "vulnerable" field.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SetVulnerable(v bool) *BronzeReferenceNVDCPEMatchCreate {
+	_c.mutation.SetVulnerable(v)
+	return _c
+}
+
+// SetNillableVulnerable sets the "vulnerable" field if the given value is not nil.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SetNillableVulnerable(v *bool) *BronzeReferenceNVDCPEMatchCreate {
+	if v != nil {
+		_c.SetVulnerable(*v)
+	}
+	return _c
+}
+
+// SetVersionStartIncluding sets the "version_start_including" field.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SetVersionStartIncluding(v string) *BronzeReferenceNVDCPEMatchCreate {
+	_c.mutation.SetVersionStartIncluding(v)
+	return _c
+}
+
+// SetNillableVersionStartIncluding sets the "version_start_including" field if the given value is not nil.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SetNillableVersionStartIncluding(v *string) *BronzeReferenceNVDCPEMatchCreate {
+	if v != nil {
+		_c.SetVersionStartIncluding(*v)
+	}
+	return _c
+}
+
+// SetVersionStartExcluding sets the "version_start_excluding" field.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SetVersionStartExcluding(v string) *BronzeReferenceNVDCPEMatchCreate {
+	_c.mutation.SetVersionStartExcluding(v)
+	return _c
+}
+
+// SetNillableVersionStartExcluding sets the "version_start_excluding" field if the given value is not nil.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SetNillableVersionStartExcluding(v *string) *BronzeReferenceNVDCPEMatchCreate {
+	if v != nil {
+		_c.SetVersionStartExcluding(*v)
+	}
+	return _c
+}
+
+// SetVersionEndIncluding sets the "version_end_including" field.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SetVersionEndIncluding(v string) *BronzeReferenceNVDCPEMatchCreate {
+	_c.mutation.SetVersionEndIncluding(v)
+	return _c
+}
+
+// SetNillableVersionEndIncluding sets the "version_end_including" field if the given value is not nil.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SetNillableVersionEndIncluding(v *string) *BronzeReferenceNVDCPEMatchCreate {
+	if v != nil {
+		_c.SetVersionEndIncluding(*v)
+	}
+	return _c
+}
+
+// SetVersionEndExcluding sets the "version_end_excluding" field.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SetVersionEndExcluding(v string) *BronzeReferenceNVDCPEMatchCreate {
+	_c.mutation.SetVersionEndExcluding(v)
+	return _c
+}
+
+// SetNillableVersionEndExcluding sets the "version_end_excluding" field if the given value is not nil.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SetNillableVersionEndExcluding(v *string) *BronzeReferenceNVDCPEMatchCreate {
+	if v != nil {
+		_c.SetVersionEndExcluding(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SetID(v string) *BronzeReferenceNVDCPEMatchCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeReferenceNVDCPEMatchMutation object of the builder.
+func (_c *BronzeReferenceNVDCPEMatchCreate) Mutation() *BronzeReferenceNVDCPEMatchMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeReferenceNVDCPEMatch in the database.
+func (_c *BronzeReferenceNVDCPEMatchCreate) Save(ctx context.Context) (*BronzeReferenceNVDCPEMatch, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeReferenceNVDCPEMatchCreate) SaveX(ctx context.Context) *BronzeReferenceNVDCPEMatch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeReferenceNVDCPEMatchCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeReferenceNVDCPEMatchCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeReferenceNVDCPEMatchCreate) defaults() {
+	if _, ok := _c.mutation.Vulnerable(); !ok {
+		v := bronzereferencenvdcpematch.DefaultVulnerable
+		_c.mutation.SetVulnerable(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeReferenceNVDCPEMatchCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`reference: missing required field "BronzeReferenceNVDCPEMatch.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`reference: missing required field "BronzeReferenceNVDCPEMatch.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.CveID(); !ok {
+		return &ValidationError{Name: "cve_id", err: errors.New(`reference: missing required field "BronzeReferenceNVDCPEMatch.cve_id"`)}
+	}
+	if _, ok := _c.mutation.Criteria(); !ok {
+		return &ValidationError{Name: "criteria", err: errors.New(`reference: missing required field "BronzeReferenceNVDCPEMatch.criteria"`)}
+	}
+	if _, ok := _c.mutation.Part(); !ok {
+		return &ValidationError{Name: "part", err: errors.New(`reference: missing required field "BronzeReferenceNVDCPEMatch.part"`)}
+	}
+	if _, ok := _c.mutation.CpeVendor(); !ok {
+		return &ValidationError{Name: "cpe_vendor", err: errors.New(`reference: missing required field "BronzeReferenceNVDCPEMatch.cpe_vendor"`)}
+	}
+	if _, ok := _c.mutation.CpeProduct(); !ok {
+		return &ValidationError{Name: "cpe_product", err: errors.New(`reference: missing required field "BronzeReferenceNVDCPEMatch.cpe_product"`)}
+	}
+	if _, ok := _c.mutation.CpeVersion(); !ok {
+		return &ValidationError{Name: "cpe_version", err: errors.New(`reference: missing required field "BronzeReferenceNVDCPEMatch.cpe_version"`)}
+	}
+	if _, ok := _c.mutation.Vulnerable(); !ok {
+		return &ValidationError{Name: "vulnerable", err: errors.New(`reference: missing required field "BronzeReferenceNVDCPEMatch.vulnerable"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeReferenceNVDCPEMatchCreate) sqlSave(ctx context.Context) (*BronzeReferenceNVDCPEMatch, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeReferenceNVDCPEMatch.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeReferenceNVDCPEMatchCreate) createSpec() (*BronzeReferenceNVDCPEMatch, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeReferenceNVDCPEMatch{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzereferencenvdcpematch.Table, sqlgraph.NewFieldSpec(bronzereferencenvdcpematch.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeReferenceNVDCPEMatch
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.CveID(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldCveID, field.TypeString, value)
+		_node.CveID = value
+	}
+	if value, ok := _c.mutation.Criteria(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldCriteria, field.TypeString, value)
+		_node.Criteria = value
+	}
+	if value, ok := _c.mutation.Part(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldPart, field.TypeString, value)
+		_node.Part = value
+	}
+	if value, ok := _c.mutation.CpeVendor(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldCpeVendor, field.TypeString, value)
+		_node.CpeVendor = value
+	}
+	if value, ok := _c.mutation.CpeProduct(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldCpeProduct, field.TypeString, value)
+		_node.CpeProduct = value
+	}
+	if value, ok := _c.mutation.CpeVersion(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldCpeVersion, field.TypeString, value)
+		_node.CpeVersion = value
+	}
+	if value, ok := _c.mutation.Vulnerable(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldVulnerable, field.TypeBool, value)
+		_node.Vulnerable = value
+	}
+	if value, ok := _c.mutation.VersionStartIncluding(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldVersionStartIncluding, field.TypeString, value)
+		_node.VersionStartIncluding = value
+	}
+	if value, ok := _c.mutation.VersionStartExcluding(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldVersionStartExcluding, field.TypeString, value)
+		_node.VersionStartExcluding = value
+	}
+	if value, ok := _c.mutation.VersionEndIncluding(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldVersionEndIncluding, field.TypeString, value)
+		_node.VersionEndIncluding = value
+	}
+	if value, ok := _c.mutation.VersionEndExcluding(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldVersionEndExcluding, field.TypeString, value)
+		_node.VersionEndExcluding = value
+	}
+	return _node, _spec
+}
+
+// BronzeReferenceNVDCPEMatchCreateBulk is the builder for creating many BronzeReferenceNVDCPEMatch entities in bulk.
+type BronzeReferenceNVDCPEMatchCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeReferenceNVDCPEMatchCreate
+}
+
+// Save creates the BronzeReferenceNVDCPEMatch entities in the database.
+func (_c *BronzeReferenceNVDCPEMatchCreateBulk) Save(ctx context.Context) ([]*BronzeReferenceNVDCPEMatch, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeReferenceNVDCPEMatch, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeReferenceNVDCPEMatchMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeReferenceNVDCPEMatchCreateBulk) SaveX(ctx context.Context) []*BronzeReferenceNVDCPEMatch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeReferenceNVDCPEMatchCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeReferenceNVDCPEMatchCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
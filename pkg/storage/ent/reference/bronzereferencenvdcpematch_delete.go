@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencenvdcpematch"
+	"danny.vn/hotpot/pkg/storage/ent/reference/internal"
+	"danny.vn/hotpot/pkg/storage/ent/reference/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeReferenceNVDCPEMatchDelete is the builder for deleting a BronzeReferenceNVDCPEMatch entity.
+type BronzeReferenceNVDCPEMatchDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeReferenceNVDCPEMatchMutation
+}
+
+// Where appends a list predicates to the BronzeReferenceNVDCPEMatchDelete builder.
+func (_d *BronzeReferenceNVDCPEMatchDelete) Where(ps ...predicate.BronzeReferenceNVDCPEMatch) *BronzeReferenceNVDCPEMatchDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeReferenceNVDCPEMatchDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeReferenceNVDCPEMatchDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeReferenceNVDCPEMatchDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzereferencenvdcpematch.Table, sqlgraph.NewFieldSpec(bronzereferencenvdcpematch.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.BronzeReferenceNVDCPEMatch
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeReferenceNVDCPEMatchDeleteOne is the builder for deleting a single BronzeReferenceNVDCPEMatch entity.
+type BronzeReferenceNVDCPEMatchDeleteOne struct {
+	_d *BronzeReferenceNVDCPEMatchDelete
+}
+
+// Where appends a list predicates to the BronzeReferenceNVDCPEMatchDelete builder.
+func (_d *BronzeReferenceNVDCPEMatchDeleteOne) Where(ps ...predicate.BronzeReferenceNVDCPEMatch) *BronzeReferenceNVDCPEMatchDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeReferenceNVDCPEMatchDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzereferencenvdcpematch.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeReferenceNVDCPEMatchDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencenvdcpematch"
+	"danny.vn/hotpot/pkg/storage/ent/reference/internal"
+	"danny.vn/hotpot/pkg/storage/ent/reference/predicate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeReferenceNVDCPEMatchQuery is the builder for querying BronzeReferenceNVDCPEMatch entities.
+type BronzeReferenceNVDCPEMatchQuery struct {
+	config
+	ctx        *QueryContext
+	order      []bronzereferencenvdcpematch.OrderOption
+	inters     []Interceptor
+	predicates []predicate.BronzeReferenceNVDCPEMatch
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BronzeReferenceNVDCPEMatchQuery builder.
+func (_q *BronzeReferenceNVDCPEMatchQuery) Where(ps ...predicate.BronzeReferenceNVDCPEMatch) *BronzeReferenceNVDCPEMatchQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *BronzeReferenceNVDCPEMatchQuery) Limit(limit int) *BronzeReferenceNVDCPEMatchQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *BronzeReferenceNVDCPEMatchQuery) Offset(offset int) *BronzeReferenceNVDCPEMatchQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *BronzeReferenceNVDCPEMatchQuery) Unique(unique bool) *BronzeReferenceNVDCPEMatchQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *BronzeReferenceNVDCPEMatchQuery) Order(o ...bronzereferencenvdcpematch.OrderOption) *BronzeReferenceNVDCPEMatchQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// First returns the first BronzeReferenceNVDCPEMatch entity from the query.
+// Returns a *NotFoundError when no BronzeReferenceNVDCPEMatch was found.
+func (_q *BronzeReferenceNVDCPEMatchQuery) First(ctx context.Context) (*BronzeReferenceNVDCPEMatch, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{bronzereferencenvdcpematch.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *BronzeReferenceNVDCPEMatchQuery) FirstX(ctx context.Context) *BronzeReferenceNVDCPEMatch {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BronzeReferenceNVDCPEMatch ID from the query.
+// Returns a *NotFoundError when no BronzeReferenceNVDCPEMatch ID was found.
+func (_q *BronzeReferenceNVDCPEMatchQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{bronzereferencenvdcpematch.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *BronzeReferenceNVDCPEMatchQuery) FirstIDX(ctx context.Context) string {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BronzeReferenceNVDCPEMatch entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BronzeReferenceNVDCPEMatch entity is found.
+// Returns a *NotFoundError when no BronzeReferenceNVDCPEMatch entities are found.
+func (_q *BronzeReferenceNVDCPEMatchQuery) Only(ctx context.Context) (*BronzeReferenceNVDCPEMatch, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{bronzereferencenvdcpematch.Label}
+	default:
+		return nil, &NotSingularError{bronzereferencenvdcpematch.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *BronzeReferenceNVDCPEMatchQuery) OnlyX(ctx context.Context) *BronzeReferenceNVDCPEMatch {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BronzeReferenceNVDCPEMatch ID in the query.
+// Returns a *NotSingularError when more than one BronzeReferenceNVDCPEMatch ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *BronzeReferenceNVDCPEMatchQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{bronzereferencenvdcpematch.Label}
+	default:
+		err = &NotSingularError{bronzereferencenvdcpematch.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *BronzeReferenceNVDCPEMatchQuery) OnlyIDX(ctx context.Context) string {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BronzeReferenceNVDCPEMatches.
+func (_q *BronzeReferenceNVDCPEMatchQuery) All(ctx context.Context) ([]*BronzeReferenceNVDCPEMatch, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BronzeReferenceNVDCPEMatch, *BronzeReferenceNVDCPEMatchQuery]()
+	return withInterceptors[[]*BronzeReferenceNVDCPEMatch](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *BronzeReferenceNVDCPEMatchQuery) AllX(ctx context.Context) []*BronzeReferenceNVDCPEMatch {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BronzeReferenceNVDCPEMatch IDs.
+func (_q *BronzeReferenceNVDCPEMatchQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(bronzereferencenvdcpematch.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *BronzeReferenceNVDCPEMatchQuery) IDsX(ctx context.Context) []string {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *BronzeReferenceNVDCPEMatchQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*BronzeReferenceNVDCPEMatchQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *BronzeReferenceNVDCPEMatchQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *BronzeReferenceNVDCPEMatchQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("reference: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *BronzeReferenceNVDCPEMatchQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BronzeReferenceNVDCPEMatchQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *BronzeReferenceNVDCPEMatchQuery) Clone() *BronzeReferenceNVDCPEMatchQuery {
+	if _q == nil {
+		return nil
+	}
+	return &BronzeReferenceNVDCPEMatchQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]bronzereferencenvdcpematch.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.BronzeReferenceNVDCPEMatch{}, _q.predicates...),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CollectedAt time.Time `json:"collected_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BronzeReferenceNVDCPEMatch.Query().
+//		GroupBy(bronzereferencenvdcpematch.FieldCollectedAt).
+//		Aggregate(reference.Count()).
+//		Scan(ctx, &v)
+func (_q *BronzeReferenceNVDCPEMatchQuery) GroupBy(field string, fields ...string) *BronzeReferenceNVDCPEMatchGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BronzeReferenceNVDCPEMatchGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = bronzereferencenvdcpematch.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CollectedAt time.Time `json:"collected_at,omitempty"`
+//	}
+//
+//	client.BronzeReferenceNVDCPEMatch.Query().
+//		Select(bronzereferencenvdcpematch.FieldCollectedAt).
+//		Scan(ctx, &v)
+func (_q *BronzeReferenceNVDCPEMatchQuery) Select(fields ...string) *BronzeReferenceNVDCPEMatchSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &BronzeReferenceNVDCPEMatchSelect{BronzeReferenceNVDCPEMatchQuery: _q}
+	sbuild.label = bronzereferencenvdcpematch.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BronzeReferenceNVDCPEMatchSelect configured with the given aggregations.
+func (_q *BronzeReferenceNVDCPEMatchQuery) Aggregate(fns ...AggregateFunc) *BronzeReferenceNVDCPEMatchSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *BronzeReferenceNVDCPEMatchQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("reference: uninitialized interceptor (forgotten import reference/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !bronzereferencenvdcpematch.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("reference: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *BronzeReferenceNVDCPEMatchQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BronzeReferenceNVDCPEMatch, error) {
+	var (
+		nodes = []*BronzeReferenceNVDCPEMatch{}
+		_spec = _q.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BronzeReferenceNVDCPEMatch).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BronzeReferenceNVDCPEMatch{config: _q.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = _q.schemaConfig.BronzeReferenceNVDCPEMatch
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (_q *BronzeReferenceNVDCPEMatchQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Schema = _q.schemaConfig.BronzeReferenceNVDCPEMatch
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *BronzeReferenceNVDCPEMatchQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(bronzereferencenvdcpematch.Table, bronzereferencenvdcpematch.Columns, sqlgraph.NewFieldSpec(bronzereferencenvdcpematch.FieldID, field.TypeString))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzereferencenvdcpematch.FieldID)
+		for i := range fields {
+			if fields[i] != bronzereferencenvdcpematch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *BronzeReferenceNVDCPEMatchQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(bronzereferencenvdcpematch.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = bronzereferencenvdcpematch.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(_q.schemaConfig.BronzeReferenceNVDCPEMatch)
+	ctx = internal.NewSchemaConfigContext(ctx, _q.schemaConfig)
+	selector.WithContext(ctx)
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BronzeReferenceNVDCPEMatchGroupBy is the group-by builder for BronzeReferenceNVDCPEMatch entities.
+type BronzeReferenceNVDCPEMatchGroupBy struct {
+	selector
+	build *BronzeReferenceNVDCPEMatchQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *BronzeReferenceNVDCPEMatchGroupBy) Aggregate(fns ...AggregateFunc) *BronzeReferenceNVDCPEMatchGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *BronzeReferenceNVDCPEMatchGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BronzeReferenceNVDCPEMatchQuery, *BronzeReferenceNVDCPEMatchGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *BronzeReferenceNVDCPEMatchGroupBy) sqlScan(ctx context.Context, root *BronzeReferenceNVDCPEMatchQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BronzeReferenceNVDCPEMatchSelect is the builder for selecting fields of BronzeReferenceNVDCPEMatch entities.
+type BronzeReferenceNVDCPEMatchSelect struct {
+	*BronzeReferenceNVDCPEMatchQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *BronzeReferenceNVDCPEMatchSelect) Aggregate(fns ...AggregateFunc) *BronzeReferenceNVDCPEMatchSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *BronzeReferenceNVDCPEMatchSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BronzeReferenceNVDCPEMatchQuery, *BronzeReferenceNVDCPEMatchSelect](ctx, _s.BronzeReferenceNVDCPEMatchQuery, _s, _s.inters, v)
+}
+
+func (_s *BronzeReferenceNVDCPEMatchSelect) sqlScan(ctx context.Context, root *BronzeReferenceNVDCPEMatchQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
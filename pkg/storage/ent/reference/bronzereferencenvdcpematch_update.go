@@ -0,0 +1,661 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencenvdcpematch"
+	"danny.vn/hotpot/pkg/storage/ent/reference/internal"
+	"danny.vn/hotpot/pkg/storage/ent/reference/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeReferenceNVDCPEMatchUpdate is the builder for updating BronzeReferenceNVDCPEMatch entities.
+type BronzeReferenceNVDCPEMatchUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeReferenceNVDCPEMatchMutation
+}
+
+// Where appends a list predicates to the BronzeReferenceNVDCPEMatchUpdate builder.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) Where(ps ...predicate.BronzeReferenceNVDCPEMatch) *BronzeReferenceNVDCPEMatchUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetCollectedAt(v time.Time) *BronzeReferenceNVDCPEMatchUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetNillableCollectedAt(v *time.Time) *BronzeReferenceNVDCPEMatchUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetCveID sets the "cve_id" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetCveID(v string) *BronzeReferenceNVDCPEMatchUpdate {
+	_u.mutation.SetCveID(v)
+	return _u
+}
+
+// SetNillableCveID sets the "cve_id" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetNillableCveID(v *string) *BronzeReferenceNVDCPEMatchUpdate {
+	if v != nil {
+		_u.SetCveID(*v)
+	}
+	return _u
+}
+
+// SetCriteria sets the "criteria" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetCriteria(v string) *BronzeReferenceNVDCPEMatchUpdate {
+	_u.mutation.SetCriteria(v)
+	return _u
+}
+
+// SetNillableCriteria sets the "criteria" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetNillableCriteria(v *string) *BronzeReferenceNVDCPEMatchUpdate {
+	if v != nil {
+		_u.SetCriteria(*v)
+	}
+	return _u
+}
+
+// SetPart sets the "part" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetPart(v string) *BronzeReferenceNVDCPEMatchUpdate {
+	_u.mutation.SetPart(v)
+	return _u
+}
+
+// SetNillablePart sets the "part" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetNillablePart(v *string) *BronzeReferenceNVDCPEMatchUpdate {
+	if v != nil {
+		_u.SetPart(*v)
+	}
+	return _u
+}
+
+// SetCpeVendor sets the "cpe_vendor" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetCpeVendor(v string) *BronzeReferenceNVDCPEMatchUpdate {
+	_u.mutation.SetCpeVendor(v)
+	return _u
+}
+
+// SetNillableCpeVendor sets the "cpe_vendor" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetNillableCpeVendor(v *string) *BronzeReferenceNVDCPEMatchUpdate {
+	if v != nil {
+		_u.SetCpeVendor(*v)
+	}
+	return _u
+}
+
+// SetCpeProduct sets the "cpe_product" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetCpeProduct(v string) *BronzeReferenceNVDCPEMatchUpdate {
+	_u.mutation.SetCpeProduct(v)
+	return _u
+}
+
+// SetNillableCpeProduct sets the "cpe_product" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetNillableCpeProduct(v *string) *BronzeReferenceNVDCPEMatchUpdate {
+	if v != nil {
+		_u.SetCpeProduct(*v)
+	}
+	return _u
+}
+
+// SetCpeVersion sets the "cpe_version" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetCpeVersion(v string) *BronzeReferenceNVDCPEMatchUpdate {
+	_u.mutation.SetCpeVersion(v)
+	return _u
+}
+
+// SetNillableCpeVersion sets the "cpe_version" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetNillableCpeVersion(v *string) *BronzeReferenceNVDCPEMatchUpdate {
+	if v != nil {
+		_u.SetCpeVersion(*v)
+	}
+	return _u
+}
+
+// SetVulnerable sets the "vulnerable" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetVulnerable(v bool) *BronzeReferenceNVDCPEMatchUpdate {
+	_u.mutation.SetVulnerable(v)
+	return _u
+}
+
+// SetNillableVulnerable sets the "vulnerable" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetNillableVulnerable(v *bool) *BronzeReferenceNVDCPEMatchUpdate {
+	if v != nil {
+		_u.SetVulnerable(*v)
+	}
+	return _u
+}
+
+// SetVersionStartIncluding sets the "version_start_including" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetVersionStartIncluding(v string) *BronzeReferenceNVDCPEMatchUpdate {
+	_u.mutation.SetVersionStartIncluding(v)
+	return _u
+}
+
+// SetNillableVersionStartIncluding sets the "version_start_including" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetNillableVersionStartIncluding(v *string) *BronzeReferenceNVDCPEMatchUpdate {
+	if v != nil {
+		_u.SetVersionStartIncluding(*v)
+	}
+	return _u
+}
+
+// ClearVersionStartIncluding clears the value of the "version_start_including" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) ClearVersionStartIncluding() *BronzeReferenceNVDCPEMatchUpdate {
+	_u.mutation.ClearVersionStartIncluding()
+	return _u
+}
+
+// SetVersionStartExcluding sets the "version_start_excluding" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetVersionStartExcluding(v string) *BronzeReferenceNVDCPEMatchUpdate {
+	_u.mutation.SetVersionStartExcluding(v)
+	return _u
+}
+
+// SetNillableVersionStartExcluding sets the "version_start_excluding" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetNillableVersionStartExcluding(v *string) *BronzeReferenceNVDCPEMatchUpdate {
+	if v != nil {
+		_u.SetVersionStartExcluding(*v)
+	}
+	return _u
+}
+
+// ClearVersionStartExcluding clears the value of the "version_start_excluding" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) ClearVersionStartExcluding() *BronzeReferenceNVDCPEMatchUpdate {
+	_u.mutation.ClearVersionStartExcluding()
+	return _u
+}
+
+// SetVersionEndIncluding sets the "version_end_including" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetVersionEndIncluding(v string) *BronzeReferenceNVDCPEMatchUpdate {
+	_u.mutation.SetVersionEndIncluding(v)
+	return _u
+}
+
+// SetNillableVersionEndIncluding sets the "version_end_including" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetNillableVersionEndIncluding(v *string) *BronzeReferenceNVDCPEMatchUpdate {
+	if v != nil {
+		_u.SetVersionEndIncluding(*v)
+	}
+	return _u
+}
+
+// ClearVersionEndIncluding clears the value of the "version_end_including" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) ClearVersionEndIncluding() *BronzeReferenceNVDCPEMatchUpdate {
+	_u.mutation.ClearVersionEndIncluding()
+	return _u
+}
+
+// SetVersionEndExcluding sets the "version_end_excluding" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetVersionEndExcluding(v string) *BronzeReferenceNVDCPEMatchUpdate {
+	_u.mutation.SetVersionEndExcluding(v)
+	return _u
+}
+
+// SetNillableVersionEndExcluding sets the "version_end_excluding" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SetNillableVersionEndExcluding(v *string) *BronzeReferenceNVDCPEMatchUpdate {
+	if v != nil {
+		_u.SetVersionEndExcluding(*v)
+	}
+	return _u
+}
+
+// ClearVersionEndExcluding clears the value of the "version_end_excluding" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) ClearVersionEndExcluding() *BronzeReferenceNVDCPEMatchUpdate {
+	_u.mutation.ClearVersionEndExcluding()
+	return _u
+}
+
+// Mutation returns the BronzeReferenceNVDCPEMatchMutation object of the builder.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) Mutation() *BronzeReferenceNVDCPEMatchMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeReferenceNVDCPEMatchUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BronzeReferenceNVDCPEMatchUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bronzereferencenvdcpematch.Table, bronzereferencenvdcpematch.Columns, sqlgraph.NewFieldSpec(bronzereferencenvdcpematch.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CveID(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldCveID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Criteria(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldCriteria, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Part(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldPart, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CpeVendor(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldCpeVendor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CpeProduct(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldCpeProduct, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CpeVersion(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldCpeVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Vulnerable(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldVulnerable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.VersionStartIncluding(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldVersionStartIncluding, field.TypeString, value)
+	}
+	if _u.mutation.VersionStartIncludingCleared() {
+		_spec.ClearField(bronzereferencenvdcpematch.FieldVersionStartIncluding, field.TypeString)
+	}
+	if value, ok := _u.mutation.VersionStartExcluding(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldVersionStartExcluding, field.TypeString, value)
+	}
+	if _u.mutation.VersionStartExcludingCleared() {
+		_spec.ClearField(bronzereferencenvdcpematch.FieldVersionStartExcluding, field.TypeString)
+	}
+	if value, ok := _u.mutation.VersionEndIncluding(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldVersionEndIncluding, field.TypeString, value)
+	}
+	if _u.mutation.VersionEndIncludingCleared() {
+		_spec.ClearField(bronzereferencenvdcpematch.FieldVersionEndIncluding, field.TypeString)
+	}
+	if value, ok := _u.mutation.VersionEndExcluding(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldVersionEndExcluding, field.TypeString, value)
+	}
+	if _u.mutation.VersionEndExcludingCleared() {
+		_spec.ClearField(bronzereferencenvdcpematch.FieldVersionEndExcluding, field.TypeString)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeReferenceNVDCPEMatch
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzereferencenvdcpematch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeReferenceNVDCPEMatchUpdateOne is the builder for updating a single BronzeReferenceNVDCPEMatch entity.
+type BronzeReferenceNVDCPEMatchUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeReferenceNVDCPEMatchMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetCollectedAt(v time.Time) *BronzeReferenceNVDCPEMatchUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeReferenceNVDCPEMatchUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetCveID sets the "cve_id" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetCveID(v string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	_u.mutation.SetCveID(v)
+	return _u
+}
+
+// SetNillableCveID sets the "cve_id" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetNillableCveID(v *string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	if v != nil {
+		_u.SetCveID(*v)
+	}
+	return _u
+}
+
+// SetCriteria sets the "criteria" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetCriteria(v string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	_u.mutation.SetCriteria(v)
+	return _u
+}
+
+// SetNillableCriteria sets the "criteria" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetNillableCriteria(v *string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	if v != nil {
+		_u.SetCriteria(*v)
+	}
+	return _u
+}
+
+// SetPart sets the "part" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetPart(v string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	_u.mutation.SetPart(v)
+	return _u
+}
+
+// SetNillablePart sets the "part" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetNillablePart(v *string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	if v != nil {
+		_u.SetPart(*v)
+	}
+	return _u
+}
+
+// SetCpeVendor sets the "cpe_vendor" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetCpeVendor(v string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	_u.mutation.SetCpeVendor(v)
+	return _u
+}
+
+// SetNillableCpeVendor sets the "cpe_vendor" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetNillableCpeVendor(v *string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	if v != nil {
+		_u.SetCpeVendor(*v)
+	}
+	return _u
+}
+
+// SetCpeProduct sets the "cpe_product" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetCpeProduct(v string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	_u.mutation.SetCpeProduct(v)
+	return _u
+}
+
+// SetNillableCpeProduct sets the "cpe_product" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetNillableCpeProduct(v *string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	if v != nil {
+		_u.SetCpeProduct(*v)
+	}
+	return _u
+}
+
+// SetCpeVersion sets the "cpe_version" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetCpeVersion(v string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	_u.mutation.SetCpeVersion(v)
+	return _u
+}
+
+// SetNillableCpeVersion sets the "cpe_version" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetNillableCpeVersion(v *string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	if v != nil {
+		_u.SetCpeVersion(*v)
+	}
+	return _u
+}
+
+// SetVulnerable sets the "vulnerable" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetVulnerable(v bool) *BronzeReferenceNVDCPEMatchUpdateOne {
+	_u.mutation.SetVulnerable(v)
+	return _u
+}
+
+// SetNillableVulnerable sets the "vulnerable" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetNillableVulnerable(v *bool) *BronzeReferenceNVDCPEMatchUpdateOne {
+	if v != nil {
+		_u.SetVulnerable(*v)
+	}
+	return _u
+}
+
+// SetVersionStartIncluding sets the "version_start_including" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetVersionStartIncluding(v string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	_u.mutation.SetVersionStartIncluding(v)
+	return _u
+}
+
+// SetNillableVersionStartIncluding sets the "version_start_including" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetNillableVersionStartIncluding(v *string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	if v != nil {
+		_u.SetVersionStartIncluding(*v)
+	}
+	return _u
+}
+
+// ClearVersionStartIncluding clears the value of the "version_start_including" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) ClearVersionStartIncluding() *BronzeReferenceNVDCPEMatchUpdateOne {
+	_u.mutation.ClearVersionStartIncluding()
+	return _u
+}
+
+// SetVersionStartExcluding sets the "version_start_excluding" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetVersionStartExcluding(v string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	_u.mutation.SetVersionStartExcluding(v)
+	return _u
+}
+
+// SetNillableVersionStartExcluding sets the "version_start_excluding" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetNillableVersionStartExcluding(v *string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	if v != nil {
+		_u.SetVersionStartExcluding(*v)
+	}
+	return _u
+}
+
+// ClearVersionStartExcluding clears the value of the "version_start_excluding" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) ClearVersionStartExcluding() *BronzeReferenceNVDCPEMatchUpdateOne {
+	_u.mutation.ClearVersionStartExcluding()
+	return _u
+}
+
+// SetVersionEndIncluding sets the "version_end_including" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetVersionEndIncluding(v string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	_u.mutation.SetVersionEndIncluding(v)
+	return _u
+}
+
+// SetNillableVersionEndIncluding sets the "version_end_including" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetNillableVersionEndIncluding(v *string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	if v != nil {
+		_u.SetVersionEndIncluding(*v)
+	}
+	return _u
+}
+
+// ClearVersionEndIncluding clears the value of the "version_end_including" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) ClearVersionEndIncluding() *BronzeReferenceNVDCPEMatchUpdateOne {
+	_u.mutation.ClearVersionEndIncluding()
+	return _u
+}
+
+// SetVersionEndExcluding sets the "version_end_excluding" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetVersionEndExcluding(v string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	_u.mutation.SetVersionEndExcluding(v)
+	return _u
+}
+
+// SetNillableVersionEndExcluding sets the "version_end_excluding" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SetNillableVersionEndExcluding(v *string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	if v != nil {
+		_u.SetVersionEndExcluding(*v)
+	}
+	return _u
+}
+
+// ClearVersionEndExcluding clears the value of the "version_end_excluding" field.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) ClearVersionEndExcluding() *BronzeReferenceNVDCPEMatchUpdateOne {
+	_u.mutation.ClearVersionEndExcluding()
+	return _u
+}
+
+// Mutation returns the BronzeReferenceNVDCPEMatchMutation object of the builder.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) Mutation() *BronzeReferenceNVDCPEMatchMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeReferenceNVDCPEMatchUpdate builder.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) Where(ps ...predicate.BronzeReferenceNVDCPEMatch) *BronzeReferenceNVDCPEMatchUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) Select(field string, fields ...string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeReferenceNVDCPEMatch entity.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) Save(ctx context.Context) (*BronzeReferenceNVDCPEMatch, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) SaveX(ctx context.Context) *BronzeReferenceNVDCPEMatch {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BronzeReferenceNVDCPEMatchUpdateOne) sqlSave(ctx context.Context) (_node *BronzeReferenceNVDCPEMatch, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bronzereferencenvdcpematch.Table, bronzereferencenvdcpematch.Columns, sqlgraph.NewFieldSpec(bronzereferencenvdcpematch.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`reference: missing "BronzeReferenceNVDCPEMatch.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzereferencenvdcpematch.FieldID)
+		for _, f := range fields {
+			if !bronzereferencenvdcpematch.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("reference: invalid field %q for query", f)}
+			}
+			if f != bronzereferencenvdcpematch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CveID(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldCveID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Criteria(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldCriteria, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Part(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldPart, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CpeVendor(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldCpeVendor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CpeProduct(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldCpeProduct, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CpeVersion(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldCpeVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Vulnerable(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldVulnerable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.VersionStartIncluding(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldVersionStartIncluding, field.TypeString, value)
+	}
+	if _u.mutation.VersionStartIncludingCleared() {
+		_spec.ClearField(bronzereferencenvdcpematch.FieldVersionStartIncluding, field.TypeString)
+	}
+	if value, ok := _u.mutation.VersionStartExcluding(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldVersionStartExcluding, field.TypeString, value)
+	}
+	if _u.mutation.VersionStartExcludingCleared() {
+		_spec.ClearField(bronzereferencenvdcpematch.FieldVersionStartExcluding, field.TypeString)
+	}
+	if value, ok := _u.mutation.VersionEndIncluding(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldVersionEndIncluding, field.TypeString, value)
+	}
+	if _u.mutation.VersionEndIncludingCleared() {
+		_spec.ClearField(bronzereferencenvdcpematch.FieldVersionEndIncluding, field.TypeString)
+	}
+	if value, ok := _u.mutation.VersionEndExcluding(); ok {
+		_spec.SetField(bronzereferencenvdcpematch.FieldVersionEndExcluding, field.TypeString, value)
+	}
+	if _u.mutation.VersionEndExcludingCleared() {
+		_spec.ClearField(bronzereferencenvdcpematch.FieldVersionEndExcluding, field.TypeString)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeReferenceNVDCPEMatch
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeReferenceNVDCPEMatch{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzereferencenvdcpematch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencenvdcve"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeReferenceNVDCVE is the model entity for the BronzeReferenceNVDCVE schema.
+type BronzeReferenceNVDCVE struct {
+	config `json:"-"`
+	// ID of the ent.
+	// CVE ID (e.g. CVE-2024-3094)
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// English description
+	Description string `json:"description,omitempty"`
+	// e.g. Analyzed, Modified, Awaiting Analysis, Rejected
+	VulnStatus string `json:"vuln_status,omitempty"`
+	// Version of the preferred CVSS metric (4.0, 3.1, 3.0 or 2.0)
+	CvssVersion string `json:"cvss_version,omitempty"`
+	// Base score of the preferred CVSS metric
+	CvssScore *float64 `json:"cvss_score,omitempty"`
+	// LOW, MEDIUM, HIGH or CRITICAL
+	CvssSeverity string `json:"cvss_severity,omitempty"`
+	// CvssVector holds the value of the "cvss_vector" field.
+	CvssVector string `json:"cvss_vector,omitempty"`
+	// CWE IDs (e.g. CWE-79)
+	Cwes []string `json:"cwes,omitempty"`
+	// Published holds the value of the "published" field.
+	Published time.Time `json:"published,omitempty"`
+	// LastModified holds the value of the "last_modified" field.
+	LastModified time.Time `json:"last_modified,omitempty"`
+	// Date added to the CISA Known Exploited Vulnerabilities catalog
+	CisaExploitAdd *time.Time `json:"cisa_exploit_add,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeReferenceNVDCVE) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzereferencenvdcve.FieldCwes:
+			values[i] = new([]byte)
+		case bronzereferencenvdcve.FieldCvssScore:
+			values[i] = new(sql.NullFloat64)
+		case bronzereferencenvdcve.FieldID, bronzereferencenvdcve.FieldDescription, bronzereferencenvdcve.FieldVulnStatus, bronzereferencenvdcve.FieldCvssVersion, bronzereferencenvdcve.FieldCvssSeverity, bronzereferencenvdcve.FieldCvssVector:
+			values[i] = new(sql.NullString)
+		case bronzereferencenvdcve.FieldCollectedAt, bronzereferencenvdcve.FieldFirstCollectedAt, bronzereferencenvdcve.FieldPublished, bronzereferencenvdcve.FieldLastModified, bronzereferencenvdcve.FieldCisaExploitAdd:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeReferenceNVDCVE fields.
+func (_m *BronzeReferenceNVDCVE) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzereferencenvdcve.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzereferencenvdcve.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzereferencenvdcve.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzereferencenvdcve.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case bronzereferencenvdcve.FieldVulnStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vuln_status", values[i])
+			} else if value.Valid {
+				_m.VulnStatus = value.String
+			}
+		case bronzereferencenvdcve.FieldCvssVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cvss_version", values[i])
+			} else if value.Valid {
+				_m.CvssVersion = value.String
+			}
+		case bronzereferencenvdcve.FieldCvssScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cvss_score", values[i])
+			} else if value.Valid {
+				_m.CvssScore = new(float64)
+				*_m.CvssScore = value.Float64
+			}
+		case bronzereferencenvdcve.FieldCvssSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cvss_severity", values[i])
+			} else if value.Valid {
+				_m.CvssSeverity = value.String
+			}
+		case bronzereferencenvdcve.FieldCvssVector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cvss_vector", values[i])
+			} else if value.Valid {
+				_m.CvssVector = value.String
+			}
+		case bronzereferencenvdcve.FieldCwes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field cwes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Cwes); err != nil {
+					return fmt.Errorf("unmarshal field cwes: %w", err)
+				}
+			}
+		case bronzereferencenvdcve.FieldPublished:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published", values[i])
+			} else if value.Valid {
+				_m.Published = value.Time
+			}
+		case bronzereferencenvdcve.FieldLastModified:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_modified", values[i])
+			} else if value.Valid {
+				_m.LastModified = value.Time
+			}
+		case bronzereferencenvdcve.FieldCisaExploitAdd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cisa_exploit_add", values[i])
+			} else if value.Valid {
+				_m.CisaExploitAdd = new(time.Time)
+				*_m.CisaExploitAdd = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeReferenceNVDCVE.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeReferenceNVDCVE) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeReferenceNVDCVE.
+// Note that you need to call BronzeReferenceNVDCVE.Unwrap() before calling this method if this BronzeReferenceNVDCVE
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeReferenceNVDCVE) Update() *BronzeReferenceNVDCVEUpdateOne {
+	return NewBronzeReferenceNVDCVEClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeReferenceNVDCVE entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeReferenceNVDCVE) Unwrap() *BronzeReferenceNVDCVE {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("reference: BronzeReferenceNVDCVE is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeReferenceNVDCVE) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeReferenceNVDCVE(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("vuln_status=")
+	builder.WriteString(_m.VulnStatus)
+	builder.WriteString(", ")
+	builder.WriteString("cvss_version=")
+	builder.WriteString(_m.CvssVersion)
+	builder.WriteString(", ")
+	if v := _m.CvssScore; v != nil {
+		builder.WriteString("cvss_score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cvss_severity=")
+	builder.WriteString(_m.CvssSeverity)
+	builder.WriteString(", ")
+	builder.WriteString("cvss_vector=")
+	builder.WriteString(_m.CvssVector)
+	builder.WriteString(", ")
+	builder.WriteString("cwes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Cwes))
+	builder.WriteString(", ")
+	builder.WriteString("published=")
+	builder.WriteString(_m.Published.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_modified=")
+	builder.WriteString(_m.LastModified.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.CisaExploitAdd; v != nil {
+		builder.WriteString("cisa_exploit_add=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeReferenceNVDCVEs is a parsable slice of BronzeReferenceNVDCVE.
+type BronzeReferenceNVDCVEs []*BronzeReferenceNVDCVE
@@ -0,0 +1,130 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzereferencenvdcve
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzereferencenvdcve type in the database.
+	Label = "bronze_reference_nvdcve"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldVulnStatus holds the string denoting the vuln_status field in the database.
+	FieldVulnStatus = "vuln_status"
+	// FieldCvssVersion holds the string denoting the cvss_version field in the database.
+	FieldCvssVersion = "cvss_version"
+	// FieldCvssScore holds the string denoting the cvss_score field in the database.
+	FieldCvssScore = "cvss_score"
+	// FieldCvssSeverity holds the string denoting the cvss_severity field in the database.
+	FieldCvssSeverity = "cvss_severity"
+	// FieldCvssVector holds the string denoting the cvss_vector field in the database.
+	FieldCvssVector = "cvss_vector"
+	// FieldCwes holds the string denoting the cwes field in the database.
+	FieldCwes = "cwes"
+	// FieldPublished holds the string denoting the published field in the database.
+	FieldPublished = "published"
+	// FieldLastModified holds the string denoting the last_modified field in the database.
+	FieldLastModified = "last_modified"
+	// FieldCisaExploitAdd holds the string denoting the cisa_exploit_add field in the database.
+	FieldCisaExploitAdd = "cisa_exploit_add"
+	// Table holds the table name of the bronzereferencenvdcve in the database.
+	Table = "reference_nvd_cves"
+)
+
+// Columns holds all SQL columns for bronzereferencenvdcve fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldDescription,
+	FieldVulnStatus,
+	FieldCvssVersion,
+	FieldCvssScore,
+	FieldCvssSeverity,
+	FieldCvssVector,
+	FieldCwes,
+	FieldPublished,
+	FieldLastModified,
+	FieldCisaExploitAdd,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the BronzeReferenceNVDCVE queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByVulnStatus orders the results by the vuln_status field.
+func ByVulnStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVulnStatus, opts...).ToFunc()
+}
+
+// ByCvssVersion orders the results by the cvss_version field.
+func ByCvssVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCvssVersion, opts...).ToFunc()
+}
+
+// ByCvssScore orders the results by the cvss_score field.
+func ByCvssScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCvssScore, opts...).ToFunc()
+}
+
+// ByCvssSeverity orders the results by the cvss_severity field.
+func ByCvssSeverity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCvssSeverity, opts...).ToFunc()
+}
+
+// ByCvssVector orders the results by the cvss_vector field.
+func ByCvssVector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCvssVector, opts...).ToFunc()
+}
+
+// ByPublished orders the results by the published field.
+func ByPublished(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublished, opts...).ToFunc()
+}
+
+// ByLastModified orders the results by the last_modified field.
+func ByLastModified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastModified, opts...).ToFunc()
+}
+
+// ByCisaExploitAdd orders the results by the cisa_exploit_add field.
+func ByCisaExploitAdd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCisaExploitAdd, opts...).ToFunc()
+}
@@ -0,0 +1,780 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzereferencenvdcve
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldDescription, v))
+}
+
+// VulnStatus applies equality check predicate on the "vuln_status" field. It's identical to VulnStatusEQ.
+func VulnStatus(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldVulnStatus, v))
+}
+
+// CvssVersion applies equality check predicate on the "cvss_version" field. It's identical to CvssVersionEQ.
+func CvssVersion(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldCvssVersion, v))
+}
+
+// CvssScore applies equality check predicate on the "cvss_score" field. It's identical to CvssScoreEQ.
+func CvssScore(v float64) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldCvssScore, v))
+}
+
+// CvssSeverity applies equality check predicate on the "cvss_severity" field. It's identical to CvssSeverityEQ.
+func CvssSeverity(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldCvssSeverity, v))
+}
+
+// CvssVector applies equality check predicate on the "cvss_vector" field. It's identical to CvssVectorEQ.
+func CvssVector(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldCvssVector, v))
+}
+
+// Published applies equality check predicate on the "published" field. It's identical to PublishedEQ.
+func Published(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldPublished, v))
+}
+
+// LastModified applies equality check predicate on the "last_modified" field. It's identical to LastModifiedEQ.
+func LastModified(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldLastModified, v))
+}
+
+// CisaExploitAdd applies equality check predicate on the "cisa_exploit_add" field. It's identical to CisaExploitAddEQ.
+func CisaExploitAdd(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldCisaExploitAdd, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// VulnStatusEQ applies the EQ predicate on the "vuln_status" field.
+func VulnStatusEQ(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldVulnStatus, v))
+}
+
+// VulnStatusNEQ applies the NEQ predicate on the "vuln_status" field.
+func VulnStatusNEQ(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNEQ(FieldVulnStatus, v))
+}
+
+// VulnStatusIn applies the In predicate on the "vuln_status" field.
+func VulnStatusIn(vs ...string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIn(FieldVulnStatus, vs...))
+}
+
+// VulnStatusNotIn applies the NotIn predicate on the "vuln_status" field.
+func VulnStatusNotIn(vs ...string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotIn(FieldVulnStatus, vs...))
+}
+
+// VulnStatusGT applies the GT predicate on the "vuln_status" field.
+func VulnStatusGT(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGT(FieldVulnStatus, v))
+}
+
+// VulnStatusGTE applies the GTE predicate on the "vuln_status" field.
+func VulnStatusGTE(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGTE(FieldVulnStatus, v))
+}
+
+// VulnStatusLT applies the LT predicate on the "vuln_status" field.
+func VulnStatusLT(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLT(FieldVulnStatus, v))
+}
+
+// VulnStatusLTE applies the LTE predicate on the "vuln_status" field.
+func VulnStatusLTE(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLTE(FieldVulnStatus, v))
+}
+
+// VulnStatusContains applies the Contains predicate on the "vuln_status" field.
+func VulnStatusContains(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldContains(FieldVulnStatus, v))
+}
+
+// VulnStatusHasPrefix applies the HasPrefix predicate on the "vuln_status" field.
+func VulnStatusHasPrefix(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldHasPrefix(FieldVulnStatus, v))
+}
+
+// VulnStatusHasSuffix applies the HasSuffix predicate on the "vuln_status" field.
+func VulnStatusHasSuffix(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldHasSuffix(FieldVulnStatus, v))
+}
+
+// VulnStatusIsNil applies the IsNil predicate on the "vuln_status" field.
+func VulnStatusIsNil() predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIsNull(FieldVulnStatus))
+}
+
+// VulnStatusNotNil applies the NotNil predicate on the "vuln_status" field.
+func VulnStatusNotNil() predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotNull(FieldVulnStatus))
+}
+
+// VulnStatusEqualFold applies the EqualFold predicate on the "vuln_status" field.
+func VulnStatusEqualFold(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEqualFold(FieldVulnStatus, v))
+}
+
+// VulnStatusContainsFold applies the ContainsFold predicate on the "vuln_status" field.
+func VulnStatusContainsFold(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldContainsFold(FieldVulnStatus, v))
+}
+
+// CvssVersionEQ applies the EQ predicate on the "cvss_version" field.
+func CvssVersionEQ(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldCvssVersion, v))
+}
+
+// CvssVersionNEQ applies the NEQ predicate on the "cvss_version" field.
+func CvssVersionNEQ(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNEQ(FieldCvssVersion, v))
+}
+
+// CvssVersionIn applies the In predicate on the "cvss_version" field.
+func CvssVersionIn(vs ...string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIn(FieldCvssVersion, vs...))
+}
+
+// CvssVersionNotIn applies the NotIn predicate on the "cvss_version" field.
+func CvssVersionNotIn(vs ...string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotIn(FieldCvssVersion, vs...))
+}
+
+// CvssVersionGT applies the GT predicate on the "cvss_version" field.
+func CvssVersionGT(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGT(FieldCvssVersion, v))
+}
+
+// CvssVersionGTE applies the GTE predicate on the "cvss_version" field.
+func CvssVersionGTE(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGTE(FieldCvssVersion, v))
+}
+
+// CvssVersionLT applies the LT predicate on the "cvss_version" field.
+func CvssVersionLT(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLT(FieldCvssVersion, v))
+}
+
+// CvssVersionLTE applies the LTE predicate on the "cvss_version" field.
+func CvssVersionLTE(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLTE(FieldCvssVersion, v))
+}
+
+// CvssVersionContains applies the Contains predicate on the "cvss_version" field.
+func CvssVersionContains(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldContains(FieldCvssVersion, v))
+}
+
+// CvssVersionHasPrefix applies the HasPrefix predicate on the "cvss_version" field.
+func CvssVersionHasPrefix(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldHasPrefix(FieldCvssVersion, v))
+}
+
+// CvssVersionHasSuffix applies the HasSuffix predicate on the "cvss_version" field.
+func CvssVersionHasSuffix(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldHasSuffix(FieldCvssVersion, v))
+}
+
+// CvssVersionIsNil applies the IsNil predicate on the "cvss_version" field.
+func CvssVersionIsNil() predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIsNull(FieldCvssVersion))
+}
+
+// CvssVersionNotNil applies the NotNil predicate on the "cvss_version" field.
+func CvssVersionNotNil() predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotNull(FieldCvssVersion))
+}
+
+// CvssVersionEqualFold applies the EqualFold predicate on the "cvss_version" field.
+func CvssVersionEqualFold(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEqualFold(FieldCvssVersion, v))
+}
+
+// CvssVersionContainsFold applies the ContainsFold predicate on the "cvss_version" field.
+func CvssVersionContainsFold(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldContainsFold(FieldCvssVersion, v))
+}
+
+// CvssScoreEQ applies the EQ predicate on the "cvss_score" field.
+func CvssScoreEQ(v float64) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldCvssScore, v))
+}
+
+// CvssScoreNEQ applies the NEQ predicate on the "cvss_score" field.
+func CvssScoreNEQ(v float64) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNEQ(FieldCvssScore, v))
+}
+
+// CvssScoreIn applies the In predicate on the "cvss_score" field.
+func CvssScoreIn(vs ...float64) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIn(FieldCvssScore, vs...))
+}
+
+// CvssScoreNotIn applies the NotIn predicate on the "cvss_score" field.
+func CvssScoreNotIn(vs ...float64) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotIn(FieldCvssScore, vs...))
+}
+
+// CvssScoreGT applies the GT predicate on the "cvss_score" field.
+func CvssScoreGT(v float64) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGT(FieldCvssScore, v))
+}
+
+// CvssScoreGTE applies the GTE predicate on the "cvss_score" field.
+func CvssScoreGTE(v float64) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGTE(FieldCvssScore, v))
+}
+
+// CvssScoreLT applies the LT predicate on the "cvss_score" field.
+func CvssScoreLT(v float64) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLT(FieldCvssScore, v))
+}
+
+// CvssScoreLTE applies the LTE predicate on the "cvss_score" field.
+func CvssScoreLTE(v float64) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLTE(FieldCvssScore, v))
+}
+
+// CvssScoreIsNil applies the IsNil predicate on the "cvss_score" field.
+func CvssScoreIsNil() predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIsNull(FieldCvssScore))
+}
+
+// CvssScoreNotNil applies the NotNil predicate on the "cvss_score" field.
+func CvssScoreNotNil() predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotNull(FieldCvssScore))
+}
+
+// CvssSeverityEQ applies the EQ predicate on the "cvss_severity" field.
+func CvssSeverityEQ(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldCvssSeverity, v))
+}
+
+// CvssSeverityNEQ applies the NEQ predicate on the "cvss_severity" field.
+func CvssSeverityNEQ(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNEQ(FieldCvssSeverity, v))
+}
+
+// CvssSeverityIn applies the In predicate on the "cvss_severity" field.
+func CvssSeverityIn(vs ...string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIn(FieldCvssSeverity, vs...))
+}
+
+// CvssSeverityNotIn applies the NotIn predicate on the "cvss_severity" field.
+func CvssSeverityNotIn(vs ...string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotIn(FieldCvssSeverity, vs...))
+}
+
+// CvssSeverityGT applies the GT predicate on the "cvss_severity" field.
+func CvssSeverityGT(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGT(FieldCvssSeverity, v))
+}
+
+// CvssSeverityGTE applies the GTE predicate on the "cvss_severity" field.
+func CvssSeverityGTE(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGTE(FieldCvssSeverity, v))
+}
+
+// CvssSeverityLT applies the LT predicate on the "cvss_severity" field.
+func CvssSeverityLT(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLT(FieldCvssSeverity, v))
+}
+
+// CvssSeverityLTE applies the LTE predicate on the "cvss_severity" field.
+func CvssSeverityLTE(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLTE(FieldCvssSeverity, v))
+}
+
+// CvssSeverityContains applies the Contains predicate on the "cvss_severity" field.
+func CvssSeverityContains(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldContains(FieldCvssSeverity, v))
+}
+
+// CvssSeverityHasPrefix applies the HasPrefix predicate on the "cvss_severity" field.
+func CvssSeverityHasPrefix(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldHasPrefix(FieldCvssSeverity, v))
+}
+
+// CvssSeverityHasSuffix applies the HasSuffix predicate on the "cvss_severity" field.
+func CvssSeverityHasSuffix(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldHasSuffix(FieldCvssSeverity, v))
+}
+
+// CvssSeverityIsNil applies the IsNil predicate on the "cvss_severity" field.
+func CvssSeverityIsNil() predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIsNull(FieldCvssSeverity))
+}
+
+// CvssSeverityNotNil applies the NotNil predicate on the "cvss_severity" field.
+func CvssSeverityNotNil() predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotNull(FieldCvssSeverity))
+}
+
+// CvssSeverityEqualFold applies the EqualFold predicate on the "cvss_severity" field.
+func CvssSeverityEqualFold(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEqualFold(FieldCvssSeverity, v))
+}
+
+// CvssSeverityContainsFold applies the ContainsFold predicate on the "cvss_severity" field.
+func CvssSeverityContainsFold(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldContainsFold(FieldCvssSeverity, v))
+}
+
+// CvssVectorEQ applies the EQ predicate on the "cvss_vector" field.
+func CvssVectorEQ(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldCvssVector, v))
+}
+
+// CvssVectorNEQ applies the NEQ predicate on the "cvss_vector" field.
+func CvssVectorNEQ(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNEQ(FieldCvssVector, v))
+}
+
+// CvssVectorIn applies the In predicate on the "cvss_vector" field.
+func CvssVectorIn(vs ...string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIn(FieldCvssVector, vs...))
+}
+
+// CvssVectorNotIn applies the NotIn predicate on the "cvss_vector" field.
+func CvssVectorNotIn(vs ...string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotIn(FieldCvssVector, vs...))
+}
+
+// CvssVectorGT applies the GT predicate on the "cvss_vector" field.
+func CvssVectorGT(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGT(FieldCvssVector, v))
+}
+
+// CvssVectorGTE applies the GTE predicate on the "cvss_vector" field.
+func CvssVectorGTE(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGTE(FieldCvssVector, v))
+}
+
+// CvssVectorLT applies the LT predicate on the "cvss_vector" field.
+func CvssVectorLT(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLT(FieldCvssVector, v))
+}
+
+// CvssVectorLTE applies the LTE predicate on the "cvss_vector" field.
+func CvssVectorLTE(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLTE(FieldCvssVector, v))
+}
+
+// CvssVectorContains applies the Contains predicate on the "cvss_vector" field.
+func CvssVectorContains(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldContains(FieldCvssVector, v))
+}
+
+// CvssVectorHasPrefix applies the HasPrefix predicate on the "cvss_vector" field.
+func CvssVectorHasPrefix(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldHasPrefix(FieldCvssVector, v))
+}
+
+// CvssVectorHasSuffix applies the HasSuffix predicate on the "cvss_vector" field.
+func CvssVectorHasSuffix(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldHasSuffix(FieldCvssVector, v))
+}
+
+// CvssVectorIsNil applies the IsNil predicate on the "cvss_vector" field.
+func CvssVectorIsNil() predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIsNull(FieldCvssVector))
+}
+
+// CvssVectorNotNil applies the NotNil predicate on the "cvss_vector" field.
+func CvssVectorNotNil() predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotNull(FieldCvssVector))
+}
+
+// CvssVectorEqualFold applies the EqualFold predicate on the "cvss_vector" field.
+func CvssVectorEqualFold(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEqualFold(FieldCvssVector, v))
+}
+
+// CvssVectorContainsFold applies the ContainsFold predicate on the "cvss_vector" field.
+func CvssVectorContainsFold(v string) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldContainsFold(FieldCvssVector, v))
+}
+
+// CwesIsNil applies the IsNil predicate on the "cwes" field.
+func CwesIsNil() predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIsNull(FieldCwes))
+}
+
+// CwesNotNil applies the NotNil predicate on the "cwes" field.
+func CwesNotNil() predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotNull(FieldCwes))
+}
+
+// PublishedEQ applies the EQ predicate on the "published" field.
+func PublishedEQ(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldPublished, v))
+}
+
+// PublishedNEQ applies the NEQ predicate on the "published" field.
+func PublishedNEQ(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNEQ(FieldPublished, v))
+}
+
+// PublishedIn applies the In predicate on the "published" field.
+func PublishedIn(vs ...time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIn(FieldPublished, vs...))
+}
+
+// PublishedNotIn applies the NotIn predicate on the "published" field.
+func PublishedNotIn(vs ...time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotIn(FieldPublished, vs...))
+}
+
+// PublishedGT applies the GT predicate on the "published" field.
+func PublishedGT(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGT(FieldPublished, v))
+}
+
+// PublishedGTE applies the GTE predicate on the "published" field.
+func PublishedGTE(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGTE(FieldPublished, v))
+}
+
+// PublishedLT applies the LT predicate on the "published" field.
+func PublishedLT(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLT(FieldPublished, v))
+}
+
+// PublishedLTE applies the LTE predicate on the "published" field.
+func PublishedLTE(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLTE(FieldPublished, v))
+}
+
+// LastModifiedEQ applies the EQ predicate on the "last_modified" field.
+func LastModifiedEQ(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldLastModified, v))
+}
+
+// LastModifiedNEQ applies the NEQ predicate on the "last_modified" field.
+func LastModifiedNEQ(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNEQ(FieldLastModified, v))
+}
+
+// LastModifiedIn applies the In predicate on the "last_modified" field.
+func LastModifiedIn(vs ...time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIn(FieldLastModified, vs...))
+}
+
+// LastModifiedNotIn applies the NotIn predicate on the "last_modified" field.
+func LastModifiedNotIn(vs ...time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotIn(FieldLastModified, vs...))
+}
+
+// LastModifiedGT applies the GT predicate on the "last_modified" field.
+func LastModifiedGT(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGT(FieldLastModified, v))
+}
+
+// LastModifiedGTE applies the GTE predicate on the "last_modified" field.
+func LastModifiedGTE(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGTE(FieldLastModified, v))
+}
+
+// LastModifiedLT applies the LT predicate on the "last_modified" field.
+func LastModifiedLT(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLT(FieldLastModified, v))
+}
+
+// LastModifiedLTE applies the LTE predicate on the "last_modified" field.
+func LastModifiedLTE(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLTE(FieldLastModified, v))
+}
+
+// CisaExploitAddEQ applies the EQ predicate on the "cisa_exploit_add" field.
+func CisaExploitAddEQ(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldEQ(FieldCisaExploitAdd, v))
+}
+
+// CisaExploitAddNEQ applies the NEQ predicate on the "cisa_exploit_add" field.
+func CisaExploitAddNEQ(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNEQ(FieldCisaExploitAdd, v))
+}
+
+// CisaExploitAddIn applies the In predicate on the "cisa_exploit_add" field.
+func CisaExploitAddIn(vs ...time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIn(FieldCisaExploitAdd, vs...))
+}
+
+// CisaExploitAddNotIn applies the NotIn predicate on the "cisa_exploit_add" field.
+func CisaExploitAddNotIn(vs ...time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotIn(FieldCisaExploitAdd, vs...))
+}
+
+// CisaExploitAddGT applies the GT predicate on the "cisa_exploit_add" field.
+func CisaExploitAddGT(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGT(FieldCisaExploitAdd, v))
+}
+
+// CisaExploitAddGTE applies the GTE predicate on the "cisa_exploit_add" field.
+func CisaExploitAddGTE(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldGTE(FieldCisaExploitAdd, v))
+}
+
+// CisaExploitAddLT applies the LT predicate on the "cisa_exploit_add" field.
+func CisaExploitAddLT(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLT(FieldCisaExploitAdd, v))
+}
+
+// CisaExploitAddLTE applies the LTE predicate on the "cisa_exploit_add" field.
+func CisaExploitAddLTE(v time.Time) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldLTE(FieldCisaExploitAdd, v))
+}
+
+// CisaExploitAddIsNil applies the IsNil predicate on the "cisa_exploit_add" field.
+func CisaExploitAddIsNil() predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldIsNull(FieldCisaExploitAdd))
+}
+
+// CisaExploitAddNotNil applies the NotNil predicate on the "cisa_exploit_add" field.
+func CisaExploitAddNotNil() predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.FieldNotNull(FieldCisaExploitAdd))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeReferenceNVDCVE) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeReferenceNVDCVE) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeReferenceNVDCVE) predicate.BronzeReferenceNVDCVE {
+	return predicate.BronzeReferenceNVDCVE(sql.NotPredicates(p))
+}
@@ -0,0 +1,371 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencenvdcve"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeReferenceNVDCVECreate is the builder for creating a BronzeReferenceNVDCVE entity.
+type BronzeReferenceNVDCVECreate struct {
+	config
+	mutation *BronzeReferenceNVDCVEMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeReferenceNVDCVECreate) SetCollectedAt(v time.Time) *BronzeReferenceNVDCVECreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeReferenceNVDCVECreate) SetFirstCollectedAt(v time.Time) *BronzeReferenceNVDCVECreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BronzeReferenceNVDCVECreate) SetDescription(v string) *BronzeReferenceNVDCVECreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BronzeReferenceNVDCVECreate) SetNillableDescription(v *string) *BronzeReferenceNVDCVECreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetVulnStatus sets the "vuln_status" field.
+func (_c *BronzeReferenceNVDCVECreate) SetVulnStatus(v string) *BronzeReferenceNVDCVECreate {
+	_c.mutation.SetVulnStatus(v)
+	return _c
+}
+
+// SetNillableVulnStatus sets the "vuln_status" field if the given value is not nil.
+func (_c *BronzeReferenceNVDCVECreate) SetNillableVulnStatus(v *string) *BronzeReferenceNVDCVECreate {
+	if v != nil {
+		_c.SetVulnStatus(*v)
+	}
+	return _c
+}
+
+// SetCvssVersion sets the "cvss_version" field.
+func (_c *BronzeReferenceNVDCVECreate) SetCvssVersion(v string) *BronzeReferenceNVDCVECreate {
+	_c.mutation.SetCvssVersion(v)
+	return _c
+}
+
+// SetNillableCvssVersion sets the "cvss_version" field if the given value is not nil.
+func (_c *BronzeReferenceNVDCVECreate) SetNillableCvssVersion(v *string) *BronzeReferenceNVDCVECreate {
+	if v != nil {
+		_c.SetCvssVersion(*v)
+	}
+	return _c
+}
+
+// SetCvssScore sets the "cvss_score" field.
+func (_c *BronzeReferenceNVDCVECreate) SetCvssScore(v float64) *BronzeReferenceNVDCVECreate {
+	_c.mutation.SetCvssScore(v)
+	return _c
+}
+
+// SetNillableCvssScore sets the "cvss_score" field if the given value is not nil.
+func (_c *BronzeReferenceNVDCVECreate) SetNillableCvssScore(v *float64) *BronzeReferenceNVDCVECreate {
+	if v != nil {
+		_c.SetCvssScore(*v)
+	}
+	return _c
+}
+
+// SetCvssSeverity sets the "cvss_severity" field.
+func (_c *BronzeReferenceNVDCVECreate) SetCvssSeverity(v string) *BronzeReferenceNVDCVECreate {
+	_c.mutation.SetCvssSeverity(v)
+	return _c
+}
+
+// SetNillableCvssSeverity sets the "cvss_severity" field if the given value is not nil.
+func (_c *BronzeReferenceNVDCVECreate) SetNillableCvssSeverity(v *string) *BronzeReferenceNVDCVECreate {
+	if v != nil {
+		_c.SetCvssSeverity(*v)
+	}
+	return _c
+}
+
+// SetCvssVector sets the "cvss_vector" field.
+func (_c *BronzeReferenceNVDCVECreate) SetCvssVector(v string) *BronzeReferenceNVDCVECreate {
+	_c.mutation.SetCvssVector(v)
+	return _c
+}
+
+// SetNillableCvssVector sets the "cvss_vector" field if the given value is not nil.
+func (_c *BronzeReferenceNVDCVECreate) SetNillableCvssVector(v *string) *BronzeReferenceNVDCVECreate {
+	if v != nil {
+		_c.SetCvssVector(*v)
+	}
+	return _c
+}
+
+// SetCwes sets the "cwes" field.
+func (_c *BronzeReferenceNVDCVECreate) SetCwes(v []string) *BronzeReferenceNVDCVECreate {
+	_c.mutation.SetCwes(v)
+	return _c
+}
+
+// SetPublished sets the "published" field.
+func (_c *BronzeReferenceNVDCVECreate) SetPublished(v time.Time) *BronzeReferenceNVDCVECreate {
+	_c.mutation.SetPublished(v)
+	return _c
+}
+
+// SetLastModified sets the "last_modified" field.
+func (_c *BronzeReferenceNVDCVECreate) SetLastModified(v time.Time) *BronzeReferenceNVDCVECreate {
+	_c.mutation.SetLastModified(v)
+	return _c
+}
+
+// SetCisaExploitAdd sets the "cisa_exploit_add" field.
+func (_c *BronzeReferenceNVDCVECreate) SetCisaExploitAdd(v time.Time) *BronzeReferenceNVDCVECreate {
+	_c.mutation.SetCisaExploitAdd(v)
+	return _c
+}
+
+// SetNillableCisaExploitAdd sets the "cisa_exploit_add" field if the given value is not nil.
+func (_c *BronzeReferenceNVDCVECreate) SetNillableCisaExploitAdd(v *time.Time) *BronzeReferenceNVDCVECreate {
+	if v != nil {
+		_c.SetCisaExploitAdd(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeReferenceNVDCVECreate) SetID(v string) *BronzeReferenceNVDCVECreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeReferenceNVDCVEMutation object of the builder.
+func (_c *BronzeReferenceNVDCVECreate) Mutation() *BronzeReferenceNVDCVEMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeReferenceNVDCVE in the database.
+func (_c *BronzeReferenceNVDCVECreate) Save(ctx context.Context) (*BronzeReferenceNVDCVE, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeReferenceNVDCVECreate) SaveX(ctx context.Context) *BronzeReferenceNVDCVE {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeReferenceNVDCVECreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeReferenceNVDCVECreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeReferenceNVDCVECreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`reference: missing required field "BronzeReferenceNVDCVE.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`reference: missing required field "BronzeReferenceNVDCVE.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.Published(); !ok {
+		return &ValidationError{Name: "published", err: errors.New(`reference: missing required field "BronzeReferenceNVDCVE.published"`)}
+	}
+	if _, ok := _c.mutation.LastModified(); !ok {
+		return &ValidationError{Name: "last_modified", err: errors.New(`reference: missing required field "BronzeReferenceNVDCVE.last_modified"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeReferenceNVDCVECreate) sqlSave(ctx context.Context) (*BronzeReferenceNVDCVE, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeReferenceNVDCVE.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeReferenceNVDCVECreate) createSpec() (*BronzeReferenceNVDCVE, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeReferenceNVDCVE{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzereferencenvdcve.Table, sqlgraph.NewFieldSpec(bronzereferencenvdcve.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeReferenceNVDCVE
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.VulnStatus(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldVulnStatus, field.TypeString, value)
+		_node.VulnStatus = value
+	}
+	if value, ok := _c.mutation.CvssVersion(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCvssVersion, field.TypeString, value)
+		_node.CvssVersion = value
+	}
+	if value, ok := _c.mutation.CvssScore(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCvssScore, field.TypeFloat64, value)
+		_node.CvssScore = &value
+	}
+	if value, ok := _c.mutation.CvssSeverity(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCvssSeverity, field.TypeString, value)
+		_node.CvssSeverity = value
+	}
+	if value, ok := _c.mutation.CvssVector(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCvssVector, field.TypeString, value)
+		_node.CvssVector = value
+	}
+	if value, ok := _c.mutation.Cwes(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCwes, field.TypeJSON, value)
+		_node.Cwes = value
+	}
+	if value, ok := _c.mutation.Published(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldPublished, field.TypeTime, value)
+		_node.Published = value
+	}
+	if value, ok := _c.mutation.LastModified(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldLastModified, field.TypeTime, value)
+		_node.LastModified = value
+	}
+	if value, ok := _c.mutation.CisaExploitAdd(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCisaExploitAdd, field.TypeTime, value)
+		_node.CisaExploitAdd = &value
+	}
+	return _node, _spec
+}
+
+// BronzeReferenceNVDCVECreateBulk is the builder for creating many BronzeReferenceNVDCVE entities in bulk.
+type BronzeReferenceNVDCVECreateBulk struct {
+	config
+	err      error
+	builders []*BronzeReferenceNVDCVECreate
+}
+
+// Save creates the BronzeReferenceNVDCVE entities in the database.
+func (_c *BronzeReferenceNVDCVECreateBulk) Save(ctx context.Context) ([]*BronzeReferenceNVDCVE, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeReferenceNVDCVE, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeReferenceNVDCVEMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeReferenceNVDCVECreateBulk) SaveX(ctx context.Context) []*BronzeReferenceNVDCVE {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeReferenceNVDCVECreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeReferenceNVDCVECreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencenvdcve"
+	"danny.vn/hotpot/pkg/storage/ent/reference/internal"
+	"danny.vn/hotpot/pkg/storage/ent/reference/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeReferenceNVDCVEDelete is the builder for deleting a BronzeReferenceNVDCVE entity.
+type BronzeReferenceNVDCVEDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeReferenceNVDCVEMutation
+}
+
+// Where appends a list predicates to the BronzeReferenceNVDCVEDelete builder.
+func (_d *BronzeReferenceNVDCVEDelete) Where(ps ...predicate.BronzeReferenceNVDCVE) *BronzeReferenceNVDCVEDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeReferenceNVDCVEDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeReferenceNVDCVEDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeReferenceNVDCVEDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzereferencenvdcve.Table, sqlgraph.NewFieldSpec(bronzereferencenvdcve.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.BronzeReferenceNVDCVE
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeReferenceNVDCVEDeleteOne is the builder for deleting a single BronzeReferenceNVDCVE entity.
+type BronzeReferenceNVDCVEDeleteOne struct {
+	_d *BronzeReferenceNVDCVEDelete
+}
+
+// Where appends a list predicates to the BronzeReferenceNVDCVEDelete builder.
+func (_d *BronzeReferenceNVDCVEDeleteOne) Where(ps ...predicate.BronzeReferenceNVDCVE) *BronzeReferenceNVDCVEDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeReferenceNVDCVEDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzereferencenvdcve.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeReferenceNVDCVEDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
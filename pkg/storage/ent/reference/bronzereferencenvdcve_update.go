@@ -0,0 +1,726 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencenvdcve"
+	"danny.vn/hotpot/pkg/storage/ent/reference/internal"
+	"danny.vn/hotpot/pkg/storage/ent/reference/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeReferenceNVDCVEUpdate is the builder for updating BronzeReferenceNVDCVE entities.
+type BronzeReferenceNVDCVEUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeReferenceNVDCVEMutation
+}
+
+// Where appends a list predicates to the BronzeReferenceNVDCVEUpdate builder.
+func (_u *BronzeReferenceNVDCVEUpdate) Where(ps ...predicate.BronzeReferenceNVDCVE) *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeReferenceNVDCVEUpdate) SetCollectedAt(v time.Time) *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdate) SetNillableCollectedAt(v *time.Time) *BronzeReferenceNVDCVEUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeReferenceNVDCVEUpdate) SetDescription(v string) *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdate) SetNillableDescription(v *string) *BronzeReferenceNVDCVEUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeReferenceNVDCVEUpdate) ClearDescription() *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetVulnStatus sets the "vuln_status" field.
+func (_u *BronzeReferenceNVDCVEUpdate) SetVulnStatus(v string) *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.SetVulnStatus(v)
+	return _u
+}
+
+// SetNillableVulnStatus sets the "vuln_status" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdate) SetNillableVulnStatus(v *string) *BronzeReferenceNVDCVEUpdate {
+	if v != nil {
+		_u.SetVulnStatus(*v)
+	}
+	return _u
+}
+
+// ClearVulnStatus clears the value of the "vuln_status" field.
+func (_u *BronzeReferenceNVDCVEUpdate) ClearVulnStatus() *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.ClearVulnStatus()
+	return _u
+}
+
+// SetCvssVersion sets the "cvss_version" field.
+func (_u *BronzeReferenceNVDCVEUpdate) SetCvssVersion(v string) *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.SetCvssVersion(v)
+	return _u
+}
+
+// SetNillableCvssVersion sets the "cvss_version" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdate) SetNillableCvssVersion(v *string) *BronzeReferenceNVDCVEUpdate {
+	if v != nil {
+		_u.SetCvssVersion(*v)
+	}
+	return _u
+}
+
+// ClearCvssVersion clears the value of the "cvss_version" field.
+func (_u *BronzeReferenceNVDCVEUpdate) ClearCvssVersion() *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.ClearCvssVersion()
+	return _u
+}
+
+// SetCvssScore sets the "cvss_score" field.
+func (_u *BronzeReferenceNVDCVEUpdate) SetCvssScore(v float64) *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.ResetCvssScore()
+	_u.mutation.SetCvssScore(v)
+	return _u
+}
+
+// SetNillableCvssScore sets the "cvss_score" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdate) SetNillableCvssScore(v *float64) *BronzeReferenceNVDCVEUpdate {
+	if v != nil {
+		_u.SetCvssScore(*v)
+	}
+	return _u
+}
+
+// AddCvssScore adds value to the "cvss_score" field.
+func (_u *BronzeReferenceNVDCVEUpdate) AddCvssScore(v float64) *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.AddCvssScore(v)
+	return _u
+}
+
+// ClearCvssScore clears the value of the "cvss_score" field.
+func (_u *BronzeReferenceNVDCVEUpdate) ClearCvssScore() *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.ClearCvssScore()
+	return _u
+}
+
+// SetCvssSeverity sets the "cvss_severity" field.
+func (_u *BronzeReferenceNVDCVEUpdate) SetCvssSeverity(v string) *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.SetCvssSeverity(v)
+	return _u
+}
+
+// SetNillableCvssSeverity sets the "cvss_severity" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdate) SetNillableCvssSeverity(v *string) *BronzeReferenceNVDCVEUpdate {
+	if v != nil {
+		_u.SetCvssSeverity(*v)
+	}
+	return _u
+}
+
+// ClearCvssSeverity clears the value of the "cvss_severity" field.
+func (_u *BronzeReferenceNVDCVEUpdate) ClearCvssSeverity() *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.ClearCvssSeverity()
+	return _u
+}
+
+// SetCvssVector sets the "cvss_vector" field.
+func (_u *BronzeReferenceNVDCVEUpdate) SetCvssVector(v string) *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.SetCvssVector(v)
+	return _u
+}
+
+// SetNillableCvssVector sets the "cvss_vector" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdate) SetNillableCvssVector(v *string) *BronzeReferenceNVDCVEUpdate {
+	if v != nil {
+		_u.SetCvssVector(*v)
+	}
+	return _u
+}
+
+// ClearCvssVector clears the value of the "cvss_vector" field.
+func (_u *BronzeReferenceNVDCVEUpdate) ClearCvssVector() *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.ClearCvssVector()
+	return _u
+}
+
+// SetCwes sets the "cwes" field.
+func (_u *BronzeReferenceNVDCVEUpdate) SetCwes(v []string) *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.SetCwes(v)
+	return _u
+}
+
+// AppendCwes appends value to the "cwes" field.
+func (_u *BronzeReferenceNVDCVEUpdate) AppendCwes(v []string) *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.AppendCwes(v)
+	return _u
+}
+
+// ClearCwes clears the value of the "cwes" field.
+func (_u *BronzeReferenceNVDCVEUpdate) ClearCwes() *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.ClearCwes()
+	return _u
+}
+
+// SetPublished sets the "published" field.
+func (_u *BronzeReferenceNVDCVEUpdate) SetPublished(v time.Time) *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.SetPublished(v)
+	return _u
+}
+
+// SetNillablePublished sets the "published" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdate) SetNillablePublished(v *time.Time) *BronzeReferenceNVDCVEUpdate {
+	if v != nil {
+		_u.SetPublished(*v)
+	}
+	return _u
+}
+
+// SetLastModified sets the "last_modified" field.
+func (_u *BronzeReferenceNVDCVEUpdate) SetLastModified(v time.Time) *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.SetLastModified(v)
+	return _u
+}
+
+// SetNillableLastModified sets the "last_modified" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdate) SetNillableLastModified(v *time.Time) *BronzeReferenceNVDCVEUpdate {
+	if v != nil {
+		_u.SetLastModified(*v)
+	}
+	return _u
+}
+
+// SetCisaExploitAdd sets the "cisa_exploit_add" field.
+func (_u *BronzeReferenceNVDCVEUpdate) SetCisaExploitAdd(v time.Time) *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.SetCisaExploitAdd(v)
+	return _u
+}
+
+// SetNillableCisaExploitAdd sets the "cisa_exploit_add" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdate) SetNillableCisaExploitAdd(v *time.Time) *BronzeReferenceNVDCVEUpdate {
+	if v != nil {
+		_u.SetCisaExploitAdd(*v)
+	}
+	return _u
+}
+
+// ClearCisaExploitAdd clears the value of the "cisa_exploit_add" field.
+func (_u *BronzeReferenceNVDCVEUpdate) ClearCisaExploitAdd() *BronzeReferenceNVDCVEUpdate {
+	_u.mutation.ClearCisaExploitAdd()
+	return _u
+}
+
+// Mutation returns the BronzeReferenceNVDCVEMutation object of the builder.
+func (_u *BronzeReferenceNVDCVEUpdate) Mutation() *BronzeReferenceNVDCVEMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeReferenceNVDCVEUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeReferenceNVDCVEUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeReferenceNVDCVEUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeReferenceNVDCVEUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BronzeReferenceNVDCVEUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bronzereferencenvdcve.Table, bronzereferencenvdcve.Columns, sqlgraph.NewFieldSpec(bronzereferencenvdcve.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzereferencenvdcve.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.VulnStatus(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldVulnStatus, field.TypeString, value)
+	}
+	if _u.mutation.VulnStatusCleared() {
+		_spec.ClearField(bronzereferencenvdcve.FieldVulnStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.CvssVersion(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCvssVersion, field.TypeString, value)
+	}
+	if _u.mutation.CvssVersionCleared() {
+		_spec.ClearField(bronzereferencenvdcve.FieldCvssVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.CvssScore(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCvssScore(); ok {
+		_spec.AddField(bronzereferencenvdcve.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if _u.mutation.CvssScoreCleared() {
+		_spec.ClearField(bronzereferencenvdcve.FieldCvssScore, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CvssSeverity(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCvssSeverity, field.TypeString, value)
+	}
+	if _u.mutation.CvssSeverityCleared() {
+		_spec.ClearField(bronzereferencenvdcve.FieldCvssSeverity, field.TypeString)
+	}
+	if value, ok := _u.mutation.CvssVector(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCvssVector, field.TypeString, value)
+	}
+	if _u.mutation.CvssVectorCleared() {
+		_spec.ClearField(bronzereferencenvdcve.FieldCvssVector, field.TypeString)
+	}
+	if value, ok := _u.mutation.Cwes(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCwes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCwes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzereferencenvdcve.FieldCwes, value)
+		})
+	}
+	if _u.mutation.CwesCleared() {
+		_spec.ClearField(bronzereferencenvdcve.FieldCwes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Published(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldPublished, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastModified(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldLastModified, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CisaExploitAdd(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCisaExploitAdd, field.TypeTime, value)
+	}
+	if _u.mutation.CisaExploitAddCleared() {
+		_spec.ClearField(bronzereferencenvdcve.FieldCisaExploitAdd, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeReferenceNVDCVE
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzereferencenvdcve.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeReferenceNVDCVEUpdateOne is the builder for updating a single BronzeReferenceNVDCVE entity.
+type BronzeReferenceNVDCVEUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeReferenceNVDCVEMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetCollectedAt(v time.Time) *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeReferenceNVDCVEUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetDescription(v string) *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetNillableDescription(v *string) *BronzeReferenceNVDCVEUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) ClearDescription() *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetVulnStatus sets the "vuln_status" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetVulnStatus(v string) *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.SetVulnStatus(v)
+	return _u
+}
+
+// SetNillableVulnStatus sets the "vuln_status" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetNillableVulnStatus(v *string) *BronzeReferenceNVDCVEUpdateOne {
+	if v != nil {
+		_u.SetVulnStatus(*v)
+	}
+	return _u
+}
+
+// ClearVulnStatus clears the value of the "vuln_status" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) ClearVulnStatus() *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.ClearVulnStatus()
+	return _u
+}
+
+// SetCvssVersion sets the "cvss_version" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetCvssVersion(v string) *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.SetCvssVersion(v)
+	return _u
+}
+
+// SetNillableCvssVersion sets the "cvss_version" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetNillableCvssVersion(v *string) *BronzeReferenceNVDCVEUpdateOne {
+	if v != nil {
+		_u.SetCvssVersion(*v)
+	}
+	return _u
+}
+
+// ClearCvssVersion clears the value of the "cvss_version" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) ClearCvssVersion() *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.ClearCvssVersion()
+	return _u
+}
+
+// SetCvssScore sets the "cvss_score" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetCvssScore(v float64) *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.ResetCvssScore()
+	_u.mutation.SetCvssScore(v)
+	return _u
+}
+
+// SetNillableCvssScore sets the "cvss_score" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetNillableCvssScore(v *float64) *BronzeReferenceNVDCVEUpdateOne {
+	if v != nil {
+		_u.SetCvssScore(*v)
+	}
+	return _u
+}
+
+// AddCvssScore adds value to the "cvss_score" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) AddCvssScore(v float64) *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.AddCvssScore(v)
+	return _u
+}
+
+// ClearCvssScore clears the value of the "cvss_score" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) ClearCvssScore() *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.ClearCvssScore()
+	return _u
+}
+
+// SetCvssSeverity sets the "cvss_severity" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetCvssSeverity(v string) *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.SetCvssSeverity(v)
+	return _u
+}
+
+// SetNillableCvssSeverity sets the "cvss_severity" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetNillableCvssSeverity(v *string) *BronzeReferenceNVDCVEUpdateOne {
+	if v != nil {
+		_u.SetCvssSeverity(*v)
+	}
+	return _u
+}
+
+// ClearCvssSeverity clears the value of the "cvss_severity" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) ClearCvssSeverity() *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.ClearCvssSeverity()
+	return _u
+}
+
+// SetCvssVector sets the "cvss_vector" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetCvssVector(v string) *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.SetCvssVector(v)
+	return _u
+}
+
+// SetNillableCvssVector sets the "cvss_vector" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetNillableCvssVector(v *string) *BronzeReferenceNVDCVEUpdateOne {
+	if v != nil {
+		_u.SetCvssVector(*v)
+	}
+	return _u
+}
+
+// ClearCvssVector clears the value of the "cvss_vector" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) ClearCvssVector() *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.ClearCvssVector()
+	return _u
+}
+
+// SetCwes sets the "cwes" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetCwes(v []string) *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.SetCwes(v)
+	return _u
+}
+
+// AppendCwes appends value to the "cwes" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) AppendCwes(v []string) *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.AppendCwes(v)
+	return _u
+}
+
+// ClearCwes clears the value of the "cwes" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) ClearCwes() *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.ClearCwes()
+	return _u
+}
+
+// SetPublished sets the "published" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetPublished(v time.Time) *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.SetPublished(v)
+	return _u
+}
+
+// SetNillablePublished sets the "published" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetNillablePublished(v *time.Time) *BronzeReferenceNVDCVEUpdateOne {
+	if v != nil {
+		_u.SetPublished(*v)
+	}
+	return _u
+}
+
+// SetLastModified sets the "last_modified" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetLastModified(v time.Time) *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.SetLastModified(v)
+	return _u
+}
+
+// SetNillableLastModified sets the "last_modified" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetNillableLastModified(v *time.Time) *BronzeReferenceNVDCVEUpdateOne {
+	if v != nil {
+		_u.SetLastModified(*v)
+	}
+	return _u
+}
+
+// SetCisaExploitAdd sets the "cisa_exploit_add" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetCisaExploitAdd(v time.Time) *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.SetCisaExploitAdd(v)
+	return _u
+}
+
+// SetNillableCisaExploitAdd sets the "cisa_exploit_add" field if the given value is not nil.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SetNillableCisaExploitAdd(v *time.Time) *BronzeReferenceNVDCVEUpdateOne {
+	if v != nil {
+		_u.SetCisaExploitAdd(*v)
+	}
+	return _u
+}
+
+// ClearCisaExploitAdd clears the value of the "cisa_exploit_add" field.
+func (_u *BronzeReferenceNVDCVEUpdateOne) ClearCisaExploitAdd() *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.ClearCisaExploitAdd()
+	return _u
+}
+
+// Mutation returns the BronzeReferenceNVDCVEMutation object of the builder.
+func (_u *BronzeReferenceNVDCVEUpdateOne) Mutation() *BronzeReferenceNVDCVEMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeReferenceNVDCVEUpdate builder.
+func (_u *BronzeReferenceNVDCVEUpdateOne) Where(ps ...predicate.BronzeReferenceNVDCVE) *BronzeReferenceNVDCVEUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeReferenceNVDCVEUpdateOne) Select(field string, fields ...string) *BronzeReferenceNVDCVEUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeReferenceNVDCVE entity.
+func (_u *BronzeReferenceNVDCVEUpdateOne) Save(ctx context.Context) (*BronzeReferenceNVDCVE, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeReferenceNVDCVEUpdateOne) SaveX(ctx context.Context) *BronzeReferenceNVDCVE {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeReferenceNVDCVEUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeReferenceNVDCVEUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BronzeReferenceNVDCVEUpdateOne) sqlSave(ctx context.Context) (_node *BronzeReferenceNVDCVE, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bronzereferencenvdcve.Table, bronzereferencenvdcve.Columns, sqlgraph.NewFieldSpec(bronzereferencenvdcve.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`reference: missing "BronzeReferenceNVDCVE.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzereferencenvdcve.FieldID)
+		for _, f := range fields {
+			if !bronzereferencenvdcve.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("reference: invalid field %q for query", f)}
+			}
+			if f != bronzereferencenvdcve.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzereferencenvdcve.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.VulnStatus(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldVulnStatus, field.TypeString, value)
+	}
+	if _u.mutation.VulnStatusCleared() {
+		_spec.ClearField(bronzereferencenvdcve.FieldVulnStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.CvssVersion(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCvssVersion, field.TypeString, value)
+	}
+	if _u.mutation.CvssVersionCleared() {
+		_spec.ClearField(bronzereferencenvdcve.FieldCvssVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.CvssScore(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCvssScore(); ok {
+		_spec.AddField(bronzereferencenvdcve.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if _u.mutation.CvssScoreCleared() {
+		_spec.ClearField(bronzereferencenvdcve.FieldCvssScore, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CvssSeverity(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCvssSeverity, field.TypeString, value)
+	}
+	if _u.mutation.CvssSeverityCleared() {
+		_spec.ClearField(bronzereferencenvdcve.FieldCvssSeverity, field.TypeString)
+	}
+	if value, ok := _u.mutation.CvssVector(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCvssVector, field.TypeString, value)
+	}
+	if _u.mutation.CvssVectorCleared() {
+		_spec.ClearField(bronzereferencenvdcve.FieldCvssVector, field.TypeString)
+	}
+	if value, ok := _u.mutation.Cwes(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCwes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCwes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzereferencenvdcve.FieldCwes, value)
+		})
+	}
+	if _u.mutation.CwesCleared() {
+		_spec.ClearField(bronzereferencenvdcve.FieldCwes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Published(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldPublished, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastModified(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldLastModified, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CisaExploitAdd(); ok {
+		_spec.SetField(bronzereferencenvdcve.FieldCisaExploitAdd, field.TypeTime, value)
+	}
+	if _u.mutation.CisaExploitAddCleared() {
+		_spec.ClearField(bronzereferencenvdcve.FieldCisaExploitAdd, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeReferenceNVDCVE
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeReferenceNVDCVE{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzereferencenvdcve.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
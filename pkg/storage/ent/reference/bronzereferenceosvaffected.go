@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceosvaffected"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeReferenceOSVAffected is the model entity for the BronzeReferenceOSVAffected schema.
+type BronzeReferenceOSVAffected struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Composite key: {vuln_id}:{n}
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Reference to OSV vuln ID
+	VulnID string `json:"vuln_id,omitempty"`
+	// OSV ecosystem (e.g. Go, npm, PyPI, Debian:12, Rocky Linux:9)
+	Ecosystem string `json:"ecosystem,omitempty"`
+	// PackageName holds the value of the "package_name" field.
+	PackageName string `json:"package_name,omitempty"`
+	// Purl holds the value of the "purl" field.
+	Purl string `json:"purl,omitempty"`
+	// SEMVER, ECOSYSTEM or GIT; empty when only versions are listed
+	RangeType string `json:"range_type,omitempty"`
+	// First affected version; 0 means all versions before fixed
+	Introduced string `json:"introduced,omitempty"`
+	// First fixed version (exclusive end)
+	Fixed string `json:"fixed,omitempty"`
+	// Last affected version (inclusive end)
+	LastAffected string `json:"last_affected,omitempty"`
+	// Explicitly enumerated affected versions
+	Versions     []string `json:"versions,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeReferenceOSVAffected) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzereferenceosvaffected.FieldVersions:
+			values[i] = new([]byte)
+		case bronzereferenceosvaffected.FieldID, bronzereferenceosvaffected.FieldVulnID, bronzereferenceosvaffected.FieldEcosystem, bronzereferenceosvaffected.FieldPackageName, bronzereferenceosvaffected.FieldPurl, bronzereferenceosvaffected.FieldRangeType, bronzereferenceosvaffected.FieldIntroduced, bronzereferenceosvaffected.FieldFixed, bronzereferenceosvaffected.FieldLastAffected:
+			values[i] = new(sql.NullString)
+		case bronzereferenceosvaffected.FieldCollectedAt, bronzereferenceosvaffected.FieldFirstCollectedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeReferenceOSVAffected fields.
+func (_m *BronzeReferenceOSVAffected) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzereferenceosvaffected.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzereferenceosvaffected.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzereferenceosvaffected.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzereferenceosvaffected.FieldVulnID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vuln_id", values[i])
+			} else if value.Valid {
+				_m.VulnID = value.String
+			}
+		case bronzereferenceosvaffected.FieldEcosystem:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ecosystem", values[i])
+			} else if value.Valid {
+				_m.Ecosystem = value.String
+			}
+		case bronzereferenceosvaffected.FieldPackageName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field package_name", values[i])
+			} else if value.Valid {
+				_m.PackageName = value.String
+			}
+		case bronzereferenceosvaffected.FieldPurl:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field purl", values[i])
+			} else if value.Valid {
+				_m.Purl = value.String
+			}
+		case bronzereferenceosvaffected.FieldRangeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field range_type", values[i])
+			} else if value.Valid {
+				_m.RangeType = value.String
+			}
+		case bronzereferenceosvaffected.FieldIntroduced:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field introduced", values[i])
+			} else if value.Valid {
+				_m.Introduced = value.String
+			}
+		case bronzereferenceosvaffected.FieldFixed:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fixed", values[i])
+			} else if value.Valid {
+				_m.Fixed = value.String
+			}
+		case bronzereferenceosvaffected.FieldLastAffected:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_affected", values[i])
+			} else if value.Valid {
+				_m.LastAffected = value.String
+			}
+		case bronzereferenceosvaffected.FieldVersions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field versions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Versions); err != nil {
+					return fmt.Errorf("unmarshal field versions: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeReferenceOSVAffected.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeReferenceOSVAffected) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeReferenceOSVAffected.
+// Note that you need to call BronzeReferenceOSVAffected.Unwrap() before calling this method if this BronzeReferenceOSVAffected
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeReferenceOSVAffected) Update() *BronzeReferenceOSVAffectedUpdateOne {
+	return NewBronzeReferenceOSVAffectedClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeReferenceOSVAffected entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeReferenceOSVAffected) Unwrap() *BronzeReferenceOSVAffected {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("reference: BronzeReferenceOSVAffected is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeReferenceOSVAffected) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeReferenceOSVAffected(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("vuln_id=")
+	builder.WriteString(_m.VulnID)
+	builder.WriteString(", ")
+	builder.WriteString("ecosystem=")
+	builder.WriteString(_m.Ecosystem)
+	builder.WriteString(", ")
+	builder.WriteString("package_name=")
+	builder.WriteString(_m.PackageName)
+	builder.WriteString(", ")
+	builder.WriteString("purl=")
+	builder.WriteString(_m.Purl)
+	builder.WriteString(", ")
+	builder.WriteString("range_type=")
+	builder.WriteString(_m.RangeType)
+	builder.WriteString(", ")
+	builder.WriteString("introduced=")
+	builder.WriteString(_m.Introduced)
+	builder.WriteString(", ")
+	builder.WriteString("fixed=")
+	builder.WriteString(_m.Fixed)
+	builder.WriteString(", ")
+	builder.WriteString("last_affected=")
+	builder.WriteString(_m.LastAffected)
+	builder.WriteString(", ")
+	builder.WriteString("versions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Versions))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeReferenceOSVAffecteds is a parsable slice of BronzeReferenceOSVAffected.
+type BronzeReferenceOSVAffecteds []*BronzeReferenceOSVAffected
@@ -0,0 +1,122 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzereferenceosvaffected
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzereferenceosvaffected type in the database.
+	Label = "bronze_reference_osv_affected"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldVulnID holds the string denoting the vuln_id field in the database.
+	FieldVulnID = "vuln_id"
+	// FieldEcosystem holds the string denoting the ecosystem field in the database.
+	FieldEcosystem = "ecosystem"
+	// FieldPackageName holds the string denoting the package_name field in the database.
+	FieldPackageName = "package_name"
+	// FieldPurl holds the string denoting the purl field in the database.
+	FieldPurl = "purl"
+	// FieldRangeType holds the string denoting the range_type field in the database.
+	FieldRangeType = "range_type"
+	// FieldIntroduced holds the string denoting the introduced field in the database.
+	FieldIntroduced = "introduced"
+	// FieldFixed holds the string denoting the fixed field in the database.
+	FieldFixed = "fixed"
+	// FieldLastAffected holds the string denoting the last_affected field in the database.
+	FieldLastAffected = "last_affected"
+	// FieldVersions holds the string denoting the versions field in the database.
+	FieldVersions = "versions"
+	// Table holds the table name of the bronzereferenceosvaffected in the database.
+	Table = "reference_osv_affected"
+)
+
+// Columns holds all SQL columns for bronzereferenceosvaffected fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldVulnID,
+	FieldEcosystem,
+	FieldPackageName,
+	FieldPurl,
+	FieldRangeType,
+	FieldIntroduced,
+	FieldFixed,
+	FieldLastAffected,
+	FieldVersions,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the BronzeReferenceOSVAffected queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByVulnID orders the results by the vuln_id field.
+func ByVulnID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVulnID, opts...).ToFunc()
+}
+
+// ByEcosystem orders the results by the ecosystem field.
+func ByEcosystem(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEcosystem, opts...).ToFunc()
+}
+
+// ByPackageName orders the results by the package_name field.
+func ByPackageName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPackageName, opts...).ToFunc()
+}
+
+// ByPurl orders the results by the purl field.
+func ByPurl(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPurl, opts...).ToFunc()
+}
+
+// ByRangeType orders the results by the range_type field.
+func ByRangeType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRangeType, opts...).ToFunc()
+}
+
+// ByIntroduced orders the results by the introduced field.
+func ByIntroduced(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIntroduced, opts...).ToFunc()
+}
+
+// ByFixed orders the results by the fixed field.
+func ByFixed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFixed, opts...).ToFunc()
+}
+
+// ByLastAffected orders the results by the last_affected field.
+func ByLastAffected(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastAffected, opts...).ToFunc()
+}
@@ -0,0 +1,790 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzereferenceosvaffected
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// VulnID applies equality check predicate on the "vuln_id" field. It's identical to VulnIDEQ.
+func VulnID(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldVulnID, v))
+}
+
+// Ecosystem applies equality check predicate on the "ecosystem" field. It's identical to EcosystemEQ.
+func Ecosystem(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldEcosystem, v))
+}
+
+// PackageName applies equality check predicate on the "package_name" field. It's identical to PackageNameEQ.
+func PackageName(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldPackageName, v))
+}
+
+// Purl applies equality check predicate on the "purl" field. It's identical to PurlEQ.
+func Purl(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldPurl, v))
+}
+
+// RangeType applies equality check predicate on the "range_type" field. It's identical to RangeTypeEQ.
+func RangeType(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldRangeType, v))
+}
+
+// Introduced applies equality check predicate on the "introduced" field. It's identical to IntroducedEQ.
+func Introduced(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldIntroduced, v))
+}
+
+// Fixed applies equality check predicate on the "fixed" field. It's identical to FixedEQ.
+func Fixed(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldFixed, v))
+}
+
+// LastAffected applies equality check predicate on the "last_affected" field. It's identical to LastAffectedEQ.
+func LastAffected(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldLastAffected, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// VulnIDEQ applies the EQ predicate on the "vuln_id" field.
+func VulnIDEQ(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldVulnID, v))
+}
+
+// VulnIDNEQ applies the NEQ predicate on the "vuln_id" field.
+func VulnIDNEQ(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNEQ(FieldVulnID, v))
+}
+
+// VulnIDIn applies the In predicate on the "vuln_id" field.
+func VulnIDIn(vs ...string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldIn(FieldVulnID, vs...))
+}
+
+// VulnIDNotIn applies the NotIn predicate on the "vuln_id" field.
+func VulnIDNotIn(vs ...string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNotIn(FieldVulnID, vs...))
+}
+
+// VulnIDGT applies the GT predicate on the "vuln_id" field.
+func VulnIDGT(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGT(FieldVulnID, v))
+}
+
+// VulnIDGTE applies the GTE predicate on the "vuln_id" field.
+func VulnIDGTE(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGTE(FieldVulnID, v))
+}
+
+// VulnIDLT applies the LT predicate on the "vuln_id" field.
+func VulnIDLT(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLT(FieldVulnID, v))
+}
+
+// VulnIDLTE applies the LTE predicate on the "vuln_id" field.
+func VulnIDLTE(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLTE(FieldVulnID, v))
+}
+
+// VulnIDContains applies the Contains predicate on the "vuln_id" field.
+func VulnIDContains(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldContains(FieldVulnID, v))
+}
+
+// VulnIDHasPrefix applies the HasPrefix predicate on the "vuln_id" field.
+func VulnIDHasPrefix(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldHasPrefix(FieldVulnID, v))
+}
+
+// VulnIDHasSuffix applies the HasSuffix predicate on the "vuln_id" field.
+func VulnIDHasSuffix(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldHasSuffix(FieldVulnID, v))
+}
+
+// VulnIDEqualFold applies the EqualFold predicate on the "vuln_id" field.
+func VulnIDEqualFold(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEqualFold(FieldVulnID, v))
+}
+
+// VulnIDContainsFold applies the ContainsFold predicate on the "vuln_id" field.
+func VulnIDContainsFold(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldContainsFold(FieldVulnID, v))
+}
+
+// EcosystemEQ applies the EQ predicate on the "ecosystem" field.
+func EcosystemEQ(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldEcosystem, v))
+}
+
+// EcosystemNEQ applies the NEQ predicate on the "ecosystem" field.
+func EcosystemNEQ(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNEQ(FieldEcosystem, v))
+}
+
+// EcosystemIn applies the In predicate on the "ecosystem" field.
+func EcosystemIn(vs ...string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldIn(FieldEcosystem, vs...))
+}
+
+// EcosystemNotIn applies the NotIn predicate on the "ecosystem" field.
+func EcosystemNotIn(vs ...string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNotIn(FieldEcosystem, vs...))
+}
+
+// EcosystemGT applies the GT predicate on the "ecosystem" field.
+func EcosystemGT(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGT(FieldEcosystem, v))
+}
+
+// EcosystemGTE applies the GTE predicate on the "ecosystem" field.
+func EcosystemGTE(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGTE(FieldEcosystem, v))
+}
+
+// EcosystemLT applies the LT predicate on the "ecosystem" field.
+func EcosystemLT(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLT(FieldEcosystem, v))
+}
+
+// EcosystemLTE applies the LTE predicate on the "ecosystem" field.
+func EcosystemLTE(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLTE(FieldEcosystem, v))
+}
+
+// EcosystemContains applies the Contains predicate on the "ecosystem" field.
+func EcosystemContains(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldContains(FieldEcosystem, v))
+}
+
+// EcosystemHasPrefix applies the HasPrefix predicate on the "ecosystem" field.
+func EcosystemHasPrefix(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldHasPrefix(FieldEcosystem, v))
+}
+
+// EcosystemHasSuffix applies the HasSuffix predicate on the "ecosystem" field.
+func EcosystemHasSuffix(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldHasSuffix(FieldEcosystem, v))
+}
+
+// EcosystemEqualFold applies the EqualFold predicate on the "ecosystem" field.
+func EcosystemEqualFold(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEqualFold(FieldEcosystem, v))
+}
+
+// EcosystemContainsFold applies the ContainsFold predicate on the "ecosystem" field.
+func EcosystemContainsFold(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldContainsFold(FieldEcosystem, v))
+}
+
+// PackageNameEQ applies the EQ predicate on the "package_name" field.
+func PackageNameEQ(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldPackageName, v))
+}
+
+// PackageNameNEQ applies the NEQ predicate on the "package_name" field.
+func PackageNameNEQ(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNEQ(FieldPackageName, v))
+}
+
+// PackageNameIn applies the In predicate on the "package_name" field.
+func PackageNameIn(vs ...string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldIn(FieldPackageName, vs...))
+}
+
+// PackageNameNotIn applies the NotIn predicate on the "package_name" field.
+func PackageNameNotIn(vs ...string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNotIn(FieldPackageName, vs...))
+}
+
+// PackageNameGT applies the GT predicate on the "package_name" field.
+func PackageNameGT(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGT(FieldPackageName, v))
+}
+
+// PackageNameGTE applies the GTE predicate on the "package_name" field.
+func PackageNameGTE(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGTE(FieldPackageName, v))
+}
+
+// PackageNameLT applies the LT predicate on the "package_name" field.
+func PackageNameLT(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLT(FieldPackageName, v))
+}
+
+// PackageNameLTE applies the LTE predicate on the "package_name" field.
+func PackageNameLTE(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLTE(FieldPackageName, v))
+}
+
+// PackageNameContains applies the Contains predicate on the "package_name" field.
+func PackageNameContains(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldContains(FieldPackageName, v))
+}
+
+// PackageNameHasPrefix applies the HasPrefix predicate on the "package_name" field.
+func PackageNameHasPrefix(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldHasPrefix(FieldPackageName, v))
+}
+
+// PackageNameHasSuffix applies the HasSuffix predicate on the "package_name" field.
+func PackageNameHasSuffix(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldHasSuffix(FieldPackageName, v))
+}
+
+// PackageNameEqualFold applies the EqualFold predicate on the "package_name" field.
+func PackageNameEqualFold(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEqualFold(FieldPackageName, v))
+}
+
+// PackageNameContainsFold applies the ContainsFold predicate on the "package_name" field.
+func PackageNameContainsFold(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldContainsFold(FieldPackageName, v))
+}
+
+// PurlEQ applies the EQ predicate on the "purl" field.
+func PurlEQ(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldPurl, v))
+}
+
+// PurlNEQ applies the NEQ predicate on the "purl" field.
+func PurlNEQ(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNEQ(FieldPurl, v))
+}
+
+// PurlIn applies the In predicate on the "purl" field.
+func PurlIn(vs ...string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldIn(FieldPurl, vs...))
+}
+
+// PurlNotIn applies the NotIn predicate on the "purl" field.
+func PurlNotIn(vs ...string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNotIn(FieldPurl, vs...))
+}
+
+// PurlGT applies the GT predicate on the "purl" field.
+func PurlGT(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGT(FieldPurl, v))
+}
+
+// PurlGTE applies the GTE predicate on the "purl" field.
+func PurlGTE(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGTE(FieldPurl, v))
+}
+
+// PurlLT applies the LT predicate on the "purl" field.
+func PurlLT(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLT(FieldPurl, v))
+}
+
+// PurlLTE applies the LTE predicate on the "purl" field.
+func PurlLTE(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLTE(FieldPurl, v))
+}
+
+// PurlContains applies the Contains predicate on the "purl" field.
+func PurlContains(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldContains(FieldPurl, v))
+}
+
+// PurlHasPrefix applies the HasPrefix predicate on the "purl" field.
+func PurlHasPrefix(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldHasPrefix(FieldPurl, v))
+}
+
+// PurlHasSuffix applies the HasSuffix predicate on the "purl" field.
+func PurlHasSuffix(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldHasSuffix(FieldPurl, v))
+}
+
+// PurlIsNil applies the IsNil predicate on the "purl" field.
+func PurlIsNil() predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldIsNull(FieldPurl))
+}
+
+// PurlNotNil applies the NotNil predicate on the "purl" field.
+func PurlNotNil() predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNotNull(FieldPurl))
+}
+
+// PurlEqualFold applies the EqualFold predicate on the "purl" field.
+func PurlEqualFold(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEqualFold(FieldPurl, v))
+}
+
+// PurlContainsFold applies the ContainsFold predicate on the "purl" field.
+func PurlContainsFold(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldContainsFold(FieldPurl, v))
+}
+
+// RangeTypeEQ applies the EQ predicate on the "range_type" field.
+func RangeTypeEQ(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldRangeType, v))
+}
+
+// RangeTypeNEQ applies the NEQ predicate on the "range_type" field.
+func RangeTypeNEQ(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNEQ(FieldRangeType, v))
+}
+
+// RangeTypeIn applies the In predicate on the "range_type" field.
+func RangeTypeIn(vs ...string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldIn(FieldRangeType, vs...))
+}
+
+// RangeTypeNotIn applies the NotIn predicate on the "range_type" field.
+func RangeTypeNotIn(vs ...string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNotIn(FieldRangeType, vs...))
+}
+
+// RangeTypeGT applies the GT predicate on the "range_type" field.
+func RangeTypeGT(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGT(FieldRangeType, v))
+}
+
+// RangeTypeGTE applies the GTE predicate on the "range_type" field.
+func RangeTypeGTE(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGTE(FieldRangeType, v))
+}
+
+// RangeTypeLT applies the LT predicate on the "range_type" field.
+func RangeTypeLT(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLT(FieldRangeType, v))
+}
+
+// RangeTypeLTE applies the LTE predicate on the "range_type" field.
+func RangeTypeLTE(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLTE(FieldRangeType, v))
+}
+
+// RangeTypeContains applies the Contains predicate on the "range_type" field.
+func RangeTypeContains(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldContains(FieldRangeType, v))
+}
+
+// RangeTypeHasPrefix applies the HasPrefix predicate on the "range_type" field.
+func RangeTypeHasPrefix(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldHasPrefix(FieldRangeType, v))
+}
+
+// RangeTypeHasSuffix applies the HasSuffix predicate on the "range_type" field.
+func RangeTypeHasSuffix(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldHasSuffix(FieldRangeType, v))
+}
+
+// RangeTypeIsNil applies the IsNil predicate on the "range_type" field.
+func RangeTypeIsNil() predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldIsNull(FieldRangeType))
+}
+
+// RangeTypeNotNil applies the NotNil predicate on the "range_type" field.
+func RangeTypeNotNil() predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNotNull(FieldRangeType))
+}
+
+// RangeTypeEqualFold applies the EqualFold predicate on the "range_type" field.
+func RangeTypeEqualFold(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEqualFold(FieldRangeType, v))
+}
+
+// RangeTypeContainsFold applies the ContainsFold predicate on the "range_type" field.
+func RangeTypeContainsFold(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldContainsFold(FieldRangeType, v))
+}
+
+// IntroducedEQ applies the EQ predicate on the "introduced" field.
+func IntroducedEQ(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldIntroduced, v))
+}
+
+// IntroducedNEQ applies the NEQ predicate on the "introduced" field.
+func IntroducedNEQ(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNEQ(FieldIntroduced, v))
+}
+
+// IntroducedIn applies the In predicate on the "introduced" field.
+func IntroducedIn(vs ...string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldIn(FieldIntroduced, vs...))
+}
+
+// IntroducedNotIn applies the NotIn predicate on the "introduced" field.
+func IntroducedNotIn(vs ...string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNotIn(FieldIntroduced, vs...))
+}
+
+// IntroducedGT applies the GT predicate on the "introduced" field.
+func IntroducedGT(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGT(FieldIntroduced, v))
+}
+
+// IntroducedGTE applies the GTE predicate on the "introduced" field.
+func IntroducedGTE(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGTE(FieldIntroduced, v))
+}
+
+// IntroducedLT applies the LT predicate on the "introduced" field.
+func IntroducedLT(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLT(FieldIntroduced, v))
+}
+
+// IntroducedLTE applies the LTE predicate on the "introduced" field.
+func IntroducedLTE(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLTE(FieldIntroduced, v))
+}
+
+// IntroducedContains applies the Contains predicate on the "introduced" field.
+func IntroducedContains(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldContains(FieldIntroduced, v))
+}
+
+// IntroducedHasPrefix applies the HasPrefix predicate on the "introduced" field.
+func IntroducedHasPrefix(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldHasPrefix(FieldIntroduced, v))
+}
+
+// IntroducedHasSuffix applies the HasSuffix predicate on the "introduced" field.
+func IntroducedHasSuffix(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldHasSuffix(FieldIntroduced, v))
+}
+
+// IntroducedIsNil applies the IsNil predicate on the "introduced" field.
+func IntroducedIsNil() predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldIsNull(FieldIntroduced))
+}
+
+// IntroducedNotNil applies the NotNil predicate on the "introduced" field.
+func IntroducedNotNil() predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNotNull(FieldIntroduced))
+}
+
+// IntroducedEqualFold applies the EqualFold predicate on the "introduced" field.
+func IntroducedEqualFold(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEqualFold(FieldIntroduced, v))
+}
+
+// IntroducedContainsFold applies the ContainsFold predicate on the "introduced" field.
+func IntroducedContainsFold(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldContainsFold(FieldIntroduced, v))
+}
+
+// FixedEQ applies the EQ predicate on the "fixed" field.
+func FixedEQ(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldFixed, v))
+}
+
+// FixedNEQ applies the NEQ predicate on the "fixed" field.
+func FixedNEQ(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNEQ(FieldFixed, v))
+}
+
+// FixedIn applies the In predicate on the "fixed" field.
+func FixedIn(vs ...string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldIn(FieldFixed, vs...))
+}
+
+// FixedNotIn applies the NotIn predicate on the "fixed" field.
+func FixedNotIn(vs ...string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNotIn(FieldFixed, vs...))
+}
+
+// FixedGT applies the GT predicate on the "fixed" field.
+func FixedGT(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGT(FieldFixed, v))
+}
+
+// FixedGTE applies the GTE predicate on the "fixed" field.
+func FixedGTE(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGTE(FieldFixed, v))
+}
+
+// FixedLT applies the LT predicate on the "fixed" field.
+func FixedLT(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLT(FieldFixed, v))
+}
+
+// FixedLTE applies the LTE predicate on the "fixed" field.
+func FixedLTE(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLTE(FieldFixed, v))
+}
+
+// FixedContains applies the Contains predicate on the "fixed" field.
+func FixedContains(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldContains(FieldFixed, v))
+}
+
+// FixedHasPrefix applies the HasPrefix predicate on the "fixed" field.
+func FixedHasPrefix(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldHasPrefix(FieldFixed, v))
+}
+
+// FixedHasSuffix applies the HasSuffix predicate on the "fixed" field.
+func FixedHasSuffix(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldHasSuffix(FieldFixed, v))
+}
+
+// FixedIsNil applies the IsNil predicate on the "fixed" field.
+func FixedIsNil() predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldIsNull(FieldFixed))
+}
+
+// FixedNotNil applies the NotNil predicate on the "fixed" field.
+func FixedNotNil() predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNotNull(FieldFixed))
+}
+
+// FixedEqualFold applies the EqualFold predicate on the "fixed" field.
+func FixedEqualFold(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEqualFold(FieldFixed, v))
+}
+
+// FixedContainsFold applies the ContainsFold predicate on the "fixed" field.
+func FixedContainsFold(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldContainsFold(FieldFixed, v))
+}
+
+// LastAffectedEQ applies the EQ predicate on the "last_affected" field.
+func LastAffectedEQ(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEQ(FieldLastAffected, v))
+}
+
+// LastAffectedNEQ applies the NEQ predicate on the "last_affected" field.
+func LastAffectedNEQ(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNEQ(FieldLastAffected, v))
+}
+
+// LastAffectedIn applies the In predicate on the "last_affected" field.
+func LastAffectedIn(vs ...string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldIn(FieldLastAffected, vs...))
+}
+
+// LastAffectedNotIn applies the NotIn predicate on the "last_affected" field.
+func LastAffectedNotIn(vs ...string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNotIn(FieldLastAffected, vs...))
+}
+
+// LastAffectedGT applies the GT predicate on the "last_affected" field.
+func LastAffectedGT(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGT(FieldLastAffected, v))
+}
+
+// LastAffectedGTE applies the GTE predicate on the "last_affected" field.
+func LastAffectedGTE(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldGTE(FieldLastAffected, v))
+}
+
+// LastAffectedLT applies the LT predicate on the "last_affected" field.
+func LastAffectedLT(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLT(FieldLastAffected, v))
+}
+
+// LastAffectedLTE applies the LTE predicate on the "last_affected" field.
+func LastAffectedLTE(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldLTE(FieldLastAffected, v))
+}
+
+// LastAffectedContains applies the Contains predicate on the "last_affected" field.
+func LastAffectedContains(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldContains(FieldLastAffected, v))
+}
+
+// LastAffectedHasPrefix applies the HasPrefix predicate on the "last_affected" field.
+func LastAffectedHasPrefix(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldHasPrefix(FieldLastAffected, v))
+}
+
+// LastAffectedHasSuffix applies the HasSuffix predicate on the "last_affected" field.
+func LastAffectedHasSuffix(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldHasSuffix(FieldLastAffected, v))
+}
+
+// LastAffectedIsNil applies the IsNil predicate on the "last_affected" field.
+func LastAffectedIsNil() predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldIsNull(FieldLastAffected))
+}
+
+// LastAffectedNotNil applies the NotNil predicate on the "last_affected" field.
+func LastAffectedNotNil() predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNotNull(FieldLastAffected))
+}
+
+// LastAffectedEqualFold applies the EqualFold predicate on the "last_affected" field.
+func LastAffectedEqualFold(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldEqualFold(FieldLastAffected, v))
+}
+
+// LastAffectedContainsFold applies the ContainsFold predicate on the "last_affected" field.
+func LastAffectedContainsFold(v string) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldContainsFold(FieldLastAffected, v))
+}
+
+// VersionsIsNil applies the IsNil predicate on the "versions" field.
+func VersionsIsNil() predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldIsNull(FieldVersions))
+}
+
+// VersionsNotNil applies the NotNil predicate on the "versions" field.
+func VersionsNotNil() predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.FieldNotNull(FieldVersions))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeReferenceOSVAffected) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeReferenceOSVAffected) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeReferenceOSVAffected) predicate.BronzeReferenceOSVAffected {
+	return predicate.BronzeReferenceOSVAffected(sql.NotPredicates(p))
+}
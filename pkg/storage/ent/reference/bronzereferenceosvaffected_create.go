@@ -0,0 +1,348 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceosvaffected"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeReferenceOSVAffectedCreate is the builder for creating a BronzeReferenceOSVAffected entity.
+type BronzeReferenceOSVAffectedCreate struct {
+	config
+	mutation *BronzeReferenceOSVAffectedMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeReferenceOSVAffectedCreate) SetCollectedAt(v time.Time) *BronzeReferenceOSVAffectedCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeReferenceOSVAffectedCreate) SetFirstCollectedAt(v time.Time) *BronzeReferenceOSVAffectedCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetVulnID sets the "vuln_id" field.
+func (_c *BronzeReferenceOSVAffectedCreate) SetVulnID(v string) *BronzeReferenceOSVAffectedCreate {
+	_c.mutation.SetVulnID(v)
+	return _c
+}
+
+// SetEcosystem sets the "ecosystem" field.
+func (_c *BronzeReferenceOSVAffectedCreate) SetEcosystem(v string) *BronzeReferenceOSVAffectedCreate {
+	_c.mutation.SetEcosystem(v)
+	return _c
+}
+
+// SetPackageName sets the "package_name" field.
+func (_c *BronzeReferenceOSVAffectedCreate) SetPackageName(v string) *BronzeReferenceOSVAffectedCreate {
+	_c.mutation.SetPackageName(v)
+	return _c
+}
+
+// SetPurl sets the "purl" field.
+func (_c *BronzeReferenceOSVAffectedCreate) SetPurl(v string) *BronzeReferenceOSVAffectedCreate {
+	_c.mutation.SetPurl(v)
+	return _c
+}
+
+// SetNillablePurl sets the "purl" field if the given value is not nil.
+func (_c *BronzeReferenceOSVAffectedCreate) SetNillablePurl(v *string) *BronzeReferenceOSVAffectedCreate {
+	if v != nil {
+		_c.SetPurl(*v)
+	}
+	return _c
+}
+
+// SetRangeType sets the "range_type" field.
+func (_c *BronzeReferenceOSVAffectedCreate) SetRangeType(v string) *BronzeReferenceOSVAffectedCreate {
+	_c.mutation.SetRangeType(v)
+	return _c
+}
+
+// SetNillableRangeType sets the "range_type" field if the given value is not nil.
+func (_c *BronzeReferenceOSVAffectedCreate) SetNillableRangeType(v *string) *BronzeReferenceOSVAffectedCreate {
+	if v != nil {
+		_c.SetRangeType(*v)
+	}
+	return _c
+}
+
+// SetIntroduced sets the "introduced" field.
+func (_c *BronzeReferenceOSVAffectedCreate) SetIntroduced(v string) *BronzeReferenceOSVAffectedCreate {
+	_c.mutation.SetIntroduced(v)
+	return _c
+}
+
+// SetNillableIntroduced sets the "introduced" field if the given value is not nil.
+func (_c *BronzeReferenceOSVAffectedCreate) SetNillableIntroduced(v *string) *BronzeReferenceOSVAffectedCreate {
+	if v != nil {
+		_c.SetIntroduced(*v)
+	}
+	return _c
+}
+
+// SetFixed sets the "fixed" field.
+func (_c *BronzeReferenceOSVAffectedCreate) SetFixed(v string) *BronzeReferenceOSVAffectedCreate {
+	_c.mutation.SetFixed(v)
+	return _c
+}
+
+// SetNillableFixed sets the "fixed" field if the given value is not nil.
+func (_c *BronzeReferenceOSVAffectedCreate) SetNillableFixed(v *string) *BronzeReferenceOSVAffectedCreate {
+	if v != nil {
+		_c.SetFixed(*v)
+	}
+	return _c
+}
+
+// SetLastAffected sets the "last_affected" field.
+func (_c *BronzeReferenceOSVAffectedCreate) SetLastAffected(v string) *BronzeReferenceOSVAffectedCreate {
+	_c.mutation.SetLastAffected(v)
+	return _c
+}
+
+// SetNillableLastAffected sets the "last_affected" field if the given value is not nil.
+func (_c *BronzeReferenceOSVAffectedCreate) SetNillableLastAffected(v *string) *BronzeReferenceOSVAffectedCreate {
+	if v != nil {
+		_c.SetLastAffected(*v)
+	}
+	return _c
+}
+
+// SetVersions sets the "versions" field.
+func (_c *BronzeReferenceOSVAffectedCreate) SetVersions(v []string) *BronzeReferenceOSVAffectedCreate {
+	_c.mutation.SetVersions(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeReferenceOSVAffectedCreate) SetID(v string) *BronzeReferenceOSVAffectedCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeReferenceOSVAffectedMutation object of the builder.
+func (_c *BronzeReferenceOSVAffectedCreate) Mutation() *BronzeReferenceOSVAffectedMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeReferenceOSVAffected in the database.
+func (_c *BronzeReferenceOSVAffectedCreate) Save(ctx context.Context) (*BronzeReferenceOSVAffected, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeReferenceOSVAffectedCreate) SaveX(ctx context.Context) *BronzeReferenceOSVAffected {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeReferenceOSVAffectedCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeReferenceOSVAffectedCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeReferenceOSVAffectedCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`reference: missing required field "BronzeReferenceOSVAffected.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`reference: missing required field "BronzeReferenceOSVAffected.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.VulnID(); !ok {
+		return &ValidationError{Name: "vuln_id", err: errors.New(`reference: missing required field "BronzeReferenceOSVAffected.vuln_id"`)}
+	}
+	if _, ok := _c.mutation.Ecosystem(); !ok {
+		return &ValidationError{Name: "ecosystem", err: errors.New(`reference: missing required field "BronzeReferenceOSVAffected.ecosystem"`)}
+	}
+	if _, ok := _c.mutation.PackageName(); !ok {
+		return &ValidationError{Name: "package_name", err: errors.New(`reference: missing required field "BronzeReferenceOSVAffected.package_name"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeReferenceOSVAffectedCreate) sqlSave(ctx context.Context) (*BronzeReferenceOSVAffected, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeReferenceOSVAffected.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeReferenceOSVAffectedCreate) createSpec() (*BronzeReferenceOSVAffected, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeReferenceOSVAffected{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzereferenceosvaffected.Table, sqlgraph.NewFieldSpec(bronzereferenceosvaffected.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeReferenceOSVAffected
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.VulnID(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldVulnID, field.TypeString, value)
+		_node.VulnID = value
+	}
+	if value, ok := _c.mutation.Ecosystem(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldEcosystem, field.TypeString, value)
+		_node.Ecosystem = value
+	}
+	if value, ok := _c.mutation.PackageName(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldPackageName, field.TypeString, value)
+		_node.PackageName = value
+	}
+	if value, ok := _c.mutation.Purl(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldPurl, field.TypeString, value)
+		_node.Purl = value
+	}
+	if value, ok := _c.mutation.RangeType(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldRangeType, field.TypeString, value)
+		_node.RangeType = value
+	}
+	if value, ok := _c.mutation.Introduced(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldIntroduced, field.TypeString, value)
+		_node.Introduced = value
+	}
+	if value, ok := _c.mutation.Fixed(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldFixed, field.TypeString, value)
+		_node.Fixed = value
+	}
+	if value, ok := _c.mutation.LastAffected(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldLastAffected, field.TypeString, value)
+		_node.LastAffected = value
+	}
+	if value, ok := _c.mutation.Versions(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldVersions, field.TypeJSON, value)
+		_node.Versions = value
+	}
+	return _node, _spec
+}
+
+// BronzeReferenceOSVAffectedCreateBulk is the builder for creating many BronzeReferenceOSVAffected entities in bulk.
+type BronzeReferenceOSVAffectedCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeReferenceOSVAffectedCreate
+}
+
+// Save creates the BronzeReferenceOSVAffected entities in the database.
+func (_c *BronzeReferenceOSVAffectedCreateBulk) Save(ctx context.Context) ([]*BronzeReferenceOSVAffected, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeReferenceOSVAffected, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeReferenceOSVAffectedMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeReferenceOSVAffectedCreateBulk) SaveX(ctx context.Context) []*BronzeReferenceOSVAffected {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeReferenceOSVAffectedCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeReferenceOSVAffectedCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceosvaffected"
+	"danny.vn/hotpot/pkg/storage/ent/reference/internal"
+	"danny.vn/hotpot/pkg/storage/ent/reference/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeReferenceOSVAffectedDelete is the builder for deleting a BronzeReferenceOSVAffected entity.
+type BronzeReferenceOSVAffectedDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeReferenceOSVAffectedMutation
+}
+
+// Where appends a list predicates to the BronzeReferenceOSVAffectedDelete builder.
+func (_d *BronzeReferenceOSVAffectedDelete) Where(ps ...predicate.BronzeReferenceOSVAffected) *BronzeReferenceOSVAffectedDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeReferenceOSVAffectedDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeReferenceOSVAffectedDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeReferenceOSVAffectedDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzereferenceosvaffected.Table, sqlgraph.NewFieldSpec(bronzereferenceosvaffected.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.BronzeReferenceOSVAffected
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeReferenceOSVAffectedDeleteOne is the builder for deleting a single BronzeReferenceOSVAffected entity.
+type BronzeReferenceOSVAffectedDeleteOne struct {
+	_d *BronzeReferenceOSVAffectedDelete
+}
+
+// Where appends a list predicates to the BronzeReferenceOSVAffectedDelete builder.
+func (_d *BronzeReferenceOSVAffectedDeleteOne) Where(ps ...predicate.BronzeReferenceOSVAffected) *BronzeReferenceOSVAffectedDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeReferenceOSVAffectedDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzereferenceosvaffected.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeReferenceOSVAffectedDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,636 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceosvaffected"
+	"danny.vn/hotpot/pkg/storage/ent/reference/internal"
+	"danny.vn/hotpot/pkg/storage/ent/reference/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeReferenceOSVAffectedUpdate is the builder for updating BronzeReferenceOSVAffected entities.
+type BronzeReferenceOSVAffectedUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeReferenceOSVAffectedMutation
+}
+
+// Where appends a list predicates to the BronzeReferenceOSVAffectedUpdate builder.
+func (_u *BronzeReferenceOSVAffectedUpdate) Where(ps ...predicate.BronzeReferenceOSVAffected) *BronzeReferenceOSVAffectedUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeReferenceOSVAffectedUpdate) SetCollectedAt(v time.Time) *BronzeReferenceOSVAffectedUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeReferenceOSVAffectedUpdate) SetNillableCollectedAt(v *time.Time) *BronzeReferenceOSVAffectedUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetVulnID sets the "vuln_id" field.
+func (_u *BronzeReferenceOSVAffectedUpdate) SetVulnID(v string) *BronzeReferenceOSVAffectedUpdate {
+	_u.mutation.SetVulnID(v)
+	return _u
+}
+
+// SetNillableVulnID sets the "vuln_id" field if the given value is not nil.
+func (_u *BronzeReferenceOSVAffectedUpdate) SetNillableVulnID(v *string) *BronzeReferenceOSVAffectedUpdate {
+	if v != nil {
+		_u.SetVulnID(*v)
+	}
+	return _u
+}
+
+// SetEcosystem sets the "ecosystem" field.
+func (_u *BronzeReferenceOSVAffectedUpdate) SetEcosystem(v string) *BronzeReferenceOSVAffectedUpdate {
+	_u.mutation.SetEcosystem(v)
+	return _u
+}
+
+// SetNillableEcosystem sets the "ecosystem" field if the given value is not nil.
+func (_u *BronzeReferenceOSVAffectedUpdate) SetNillableEcosystem(v *string) *BronzeReferenceOSVAffectedUpdate {
+	if v != nil {
+		_u.SetEcosystem(*v)
+	}
+	return _u
+}
+
+// SetPackageName sets the "package_name" field.
+func (_u *BronzeReferenceOSVAffectedUpdate) SetPackageName(v string) *BronzeReferenceOSVAffectedUpdate {
+	_u.mutation.SetPackageName(v)
+	return _u
+}
+
+// SetNillablePackageName sets the "package_name" field if the given value is not nil.
+func (_u *BronzeReferenceOSVAffectedUpdate) SetNillablePackageName(v *string) *BronzeReferenceOSVAffectedUpdate {
+	if v != nil {
+		_u.SetPackageName(*v)
+	}
+	return _u
+}
+
+// SetPurl sets the "purl" field.
+func (_u *BronzeReferenceOSVAffectedUpdate) SetPurl(v string) *BronzeReferenceOSVAffectedUpdate {
+	_u.mutation.SetPurl(v)
+	return _u
+}
+
+// SetNillablePurl sets the "purl" field if the given value is not nil.
+func (_u *BronzeReferenceOSVAffectedUpdate) SetNillablePurl(v *string) *BronzeReferenceOSVAffectedUpdate {
+	if v != nil {
+		_u.SetPurl(*v)
+	}
+	return _u
+}
+
+// ClearPurl clears the value of the "purl" field.
+func (_u *BronzeReferenceOSVAffectedUpdate) ClearPurl() *BronzeReferenceOSVAffectedUpdate {
+	_u.mutation.ClearPurl()
+	return _u
+}
+
+// SetRangeType sets the "range_type" field.
+func (_u *BronzeReferenceOSVAffectedUpdate) SetRangeType(v string) *BronzeReferenceOSVAffectedUpdate {
+	_u.mutation.SetRangeType(v)
+	return _u
+}
+
+// SetNillableRangeType sets the "range_type" field if the given value is not nil.
+func (_u *BronzeReferenceOSVAffectedUpdate) SetNillableRangeType(v *string) *BronzeReferenceOSVAffectedUpdate {
+	if v != nil {
+		_u.SetRangeType(*v)
+	}
+	return _u
+}
+
+// ClearRangeType clears the value of the "range_type" field.
+func (_u *BronzeReferenceOSVAffectedUpdate) ClearRangeType() *BronzeReferenceOSVAffectedUpdate {
+	_u.mutation.ClearRangeType()
+	return _u
+}
+
+// SetIntroduced sets the "introduced" field.
+func (_u *BronzeReferenceOSVAffectedUpdate) SetIntroduced(v string) *BronzeReferenceOSVAffectedUpdate {
+	_u.mutation.SetIntroduced(v)
+	return _u
+}
+
+// SetNillableIntroduced sets the "introduced" field if the given value is not nil.
+func (_u *BronzeReferenceOSVAffectedUpdate) SetNillableIntroduced(v *string) *BronzeReferenceOSVAffectedUpdate {
+	if v != nil {
+		_u.SetIntroduced(*v)
+	}
+	return _u
+}
+
+// ClearIntroduced clears the value of the "introduced" field.
+func (_u *BronzeReferenceOSVAffectedUpdate) ClearIntroduced() *BronzeReferenceOSVAffectedUpdate {
+	_u.mutation.ClearIntroduced()
+	return _u
+}
+
+// SetFixed sets the "fixed" field.
+func (_u *BronzeReferenceOSVAffectedUpdate) SetFixed(v string) *BronzeReferenceOSVAffectedUpdate {
+	_u.mutation.SetFixed(v)
+	return _u
+}
+
+// SetNillableFixed sets the "fixed" field if the given value is not nil.
+func (_u *BronzeReferenceOSVAffectedUpdate) SetNillableFixed(v *string) *BronzeReferenceOSVAffectedUpdate {
+	if v != nil {
+		_u.SetFixed(*v)
+	}
+	return _u
+}
+
+// ClearFixed clears the value of the "fixed" field.
+func (_u *BronzeReferenceOSVAffectedUpdate) ClearFixed() *BronzeReferenceOSVAffectedUpdate {
+	_u.mutation.ClearFixed()
+	return _u
+}
+
+// SetLastAffected sets the "last_affected" field.
+func (_u *BronzeReferenceOSVAffectedUpdate) SetLastAffected(v string) *BronzeReferenceOSVAffectedUpdate {
+	_u.mutation.SetLastAffected(v)
+	return _u
+}
+
+// SetNillableLastAffected sets the "last_affected" field if the given value is not nil.
+func (_u *BronzeReferenceOSVAffectedUpdate) SetNillableLastAffected(v *string) *BronzeReferenceOSVAffectedUpdate {
+	if v != nil {
+		_u.SetLastAffected(*v)
+	}
+	return _u
+}
+
+// ClearLastAffected clears the value of the "last_affected" field.
+func (_u *BronzeReferenceOSVAffectedUpdate) ClearLastAffected() *BronzeReferenceOSVAffectedUpdate {
+	_u.mutation.ClearLastAffected()
+	return _u
+}
+
+// SetVersions sets the "versions" field.
+func (_u *BronzeReferenceOSVAffectedUpdate) SetVersions(v []string) *BronzeReferenceOSVAffectedUpdate {
+	_u.mutation.SetVersions(v)
+	return _u
+}
+
+// AppendVersions appends value to the "versions" field.
+func (_u *BronzeReferenceOSVAffectedUpdate) AppendVersions(v []string) *BronzeReferenceOSVAffectedUpdate {
+	_u.mutation.AppendVersions(v)
+	return _u
+}
+
+// ClearVersions clears the value of the "versions" field.
+func (_u *BronzeReferenceOSVAffectedUpdate) ClearVersions() *BronzeReferenceOSVAffectedUpdate {
+	_u.mutation.ClearVersions()
+	return _u
+}
+
+// Mutation returns the BronzeReferenceOSVAffectedMutation object of the builder.
+func (_u *BronzeReferenceOSVAffectedUpdate) Mutation() *BronzeReferenceOSVAffectedMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeReferenceOSVAffectedUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeReferenceOSVAffectedUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeReferenceOSVAffectedUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeReferenceOSVAffectedUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BronzeReferenceOSVAffectedUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bronzereferenceosvaffected.Table, bronzereferenceosvaffected.Columns, sqlgraph.NewFieldSpec(bronzereferenceosvaffected.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.VulnID(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldVulnID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ecosystem(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldEcosystem, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PackageName(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldPackageName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Purl(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldPurl, field.TypeString, value)
+	}
+	if _u.mutation.PurlCleared() {
+		_spec.ClearField(bronzereferenceosvaffected.FieldPurl, field.TypeString)
+	}
+	if value, ok := _u.mutation.RangeType(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldRangeType, field.TypeString, value)
+	}
+	if _u.mutation.RangeTypeCleared() {
+		_spec.ClearField(bronzereferenceosvaffected.FieldRangeType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Introduced(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldIntroduced, field.TypeString, value)
+	}
+	if _u.mutation.IntroducedCleared() {
+		_spec.ClearField(bronzereferenceosvaffected.FieldIntroduced, field.TypeString)
+	}
+	if value, ok := _u.mutation.Fixed(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldFixed, field.TypeString, value)
+	}
+	if _u.mutation.FixedCleared() {
+		_spec.ClearField(bronzereferenceosvaffected.FieldFixed, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastAffected(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldLastAffected, field.TypeString, value)
+	}
+	if _u.mutation.LastAffectedCleared() {
+		_spec.ClearField(bronzereferenceosvaffected.FieldLastAffected, field.TypeString)
+	}
+	if value, ok := _u.mutation.Versions(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldVersions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVersions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzereferenceosvaffected.FieldVersions, value)
+		})
+	}
+	if _u.mutation.VersionsCleared() {
+		_spec.ClearField(bronzereferenceosvaffected.FieldVersions, field.TypeJSON)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeReferenceOSVAffected
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzereferenceosvaffected.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeReferenceOSVAffectedUpdateOne is the builder for updating a single BronzeReferenceOSVAffected entity.
+type BronzeReferenceOSVAffectedUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeReferenceOSVAffectedMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SetCollectedAt(v time.Time) *BronzeReferenceOSVAffectedUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeReferenceOSVAffectedUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetVulnID sets the "vuln_id" field.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SetVulnID(v string) *BronzeReferenceOSVAffectedUpdateOne {
+	_u.mutation.SetVulnID(v)
+	return _u
+}
+
+// SetNillableVulnID sets the "vuln_id" field if the given value is not nil.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SetNillableVulnID(v *string) *BronzeReferenceOSVAffectedUpdateOne {
+	if v != nil {
+		_u.SetVulnID(*v)
+	}
+	return _u
+}
+
+// SetEcosystem sets the "ecosystem" field.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SetEcosystem(v string) *BronzeReferenceOSVAffectedUpdateOne {
+	_u.mutation.SetEcosystem(v)
+	return _u
+}
+
+// SetNillableEcosystem sets the "ecosystem" field if the given value is not nil.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SetNillableEcosystem(v *string) *BronzeReferenceOSVAffectedUpdateOne {
+	if v != nil {
+		_u.SetEcosystem(*v)
+	}
+	return _u
+}
+
+// SetPackageName sets the "package_name" field.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SetPackageName(v string) *BronzeReferenceOSVAffectedUpdateOne {
+	_u.mutation.SetPackageName(v)
+	return _u
+}
+
+// SetNillablePackageName sets the "package_name" field if the given value is not nil.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SetNillablePackageName(v *string) *BronzeReferenceOSVAffectedUpdateOne {
+	if v != nil {
+		_u.SetPackageName(*v)
+	}
+	return _u
+}
+
+// SetPurl sets the "purl" field.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SetPurl(v string) *BronzeReferenceOSVAffectedUpdateOne {
+	_u.mutation.SetPurl(v)
+	return _u
+}
+
+// SetNillablePurl sets the "purl" field if the given value is not nil.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SetNillablePurl(v *string) *BronzeReferenceOSVAffectedUpdateOne {
+	if v != nil {
+		_u.SetPurl(*v)
+	}
+	return _u
+}
+
+// ClearPurl clears the value of the "purl" field.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) ClearPurl() *BronzeReferenceOSVAffectedUpdateOne {
+	_u.mutation.ClearPurl()
+	return _u
+}
+
+// SetRangeType sets the "range_type" field.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SetRangeType(v string) *BronzeReferenceOSVAffectedUpdateOne {
+	_u.mutation.SetRangeType(v)
+	return _u
+}
+
+// SetNillableRangeType sets the "range_type" field if the given value is not nil.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SetNillableRangeType(v *string) *BronzeReferenceOSVAffectedUpdateOne {
+	if v != nil {
+		_u.SetRangeType(*v)
+	}
+	return _u
+}
+
+// ClearRangeType clears the value of the "range_type" field.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) ClearRangeType() *BronzeReferenceOSVAffectedUpdateOne {
+	_u.mutation.ClearRangeType()
+	return _u
+}
+
+// SetIntroduced sets the "introduced" field.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SetIntroduced(v string) *BronzeReferenceOSVAffectedUpdateOne {
+	_u.mutation.SetIntroduced(v)
+	return _u
+}
+
+// SetNillableIntroduced sets the "introduced" field if the given value is not nil.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SetNillableIntroduced(v *string) *BronzeReferenceOSVAffectedUpdateOne {
+	if v != nil {
+		_u.SetIntroduced(*v)
+	}
+	return _u
+}
+
+// ClearIntroduced clears the value of the "introduced" field.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) ClearIntroduced() *BronzeReferenceOSVAffectedUpdateOne {
+	_u.mutation.ClearIntroduced()
+	return _u
+}
+
+// SetFixed sets the "fixed" field.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SetFixed(v string) *BronzeReferenceOSVAffectedUpdateOne {
+	_u.mutation.SetFixed(v)
+	return _u
+}
+
+// SetNillableFixed sets the "fixed" field if the given value is not nil.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SetNillableFixed(v *string) *BronzeReferenceOSVAffectedUpdateOne {
+	if v != nil {
+		_u.SetFixed(*v)
+	}
+	return _u
+}
+
+// ClearFixed clears the value of the "fixed" field.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) ClearFixed() *BronzeReferenceOSVAffectedUpdateOne {
+	_u.mutation.ClearFixed()
+	return _u
+}
+
+// SetLastAffected sets the "last_affected" field.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SetLastAffected(v string) *BronzeReferenceOSVAffectedUpdateOne {
+	_u.mutation.SetLastAffected(v)
+	return _u
+}
+
+// SetNillableLastAffected sets the "last_affected" field if the given value is not nil.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SetNillableLastAffected(v *string) *BronzeReferenceOSVAffectedUpdateOne {
+	if v != nil {
+		_u.SetLastAffected(*v)
+	}
+	return _u
+}
+
+// ClearLastAffected clears the value of the "last_affected" field.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) ClearLastAffected() *BronzeReferenceOSVAffectedUpdateOne {
+	_u.mutation.ClearLastAffected()
+	return _u
+}
+
+// SetVersions sets the "versions" field.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SetVersions(v []string) *BronzeReferenceOSVAffectedUpdateOne {
+	_u.mutation.SetVersions(v)
+	return _u
+}
+
+// AppendVersions appends value to the "versions" field.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) AppendVersions(v []string) *BronzeReferenceOSVAffectedUpdateOne {
+	_u.mutation.AppendVersions(v)
+	return _u
+}
+
+// ClearVersions clears the value of the "versions" field.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) ClearVersions() *BronzeReferenceOSVAffectedUpdateOne {
+	_u.mutation.ClearVersions()
+	return _u
+}
+
+// Mutation returns the BronzeReferenceOSVAffectedMutation object of the builder.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) Mutation() *BronzeReferenceOSVAffectedMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeReferenceOSVAffectedUpdate builder.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) Where(ps ...predicate.BronzeReferenceOSVAffected) *BronzeReferenceOSVAffectedUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) Select(field string, fields ...string) *BronzeReferenceOSVAffectedUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeReferenceOSVAffected entity.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) Save(ctx context.Context) (*BronzeReferenceOSVAffected, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) SaveX(ctx context.Context) *BronzeReferenceOSVAffected {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeReferenceOSVAffectedUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BronzeReferenceOSVAffectedUpdateOne) sqlSave(ctx context.Context) (_node *BronzeReferenceOSVAffected, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bronzereferenceosvaffected.Table, bronzereferenceosvaffected.Columns, sqlgraph.NewFieldSpec(bronzereferenceosvaffected.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`reference: missing "BronzeReferenceOSVAffected.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzereferenceosvaffected.FieldID)
+		for _, f := range fields {
+			if !bronzereferenceosvaffected.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("reference: invalid field %q for query", f)}
+			}
+			if f != bronzereferenceosvaffected.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.VulnID(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldVulnID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ecosystem(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldEcosystem, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PackageName(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldPackageName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Purl(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldPurl, field.TypeString, value)
+	}
+	if _u.mutation.PurlCleared() {
+		_spec.ClearField(bronzereferenceosvaffected.FieldPurl, field.TypeString)
+	}
+	if value, ok := _u.mutation.RangeType(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldRangeType, field.TypeString, value)
+	}
+	if _u.mutation.RangeTypeCleared() {
+		_spec.ClearField(bronzereferenceosvaffected.FieldRangeType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Introduced(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldIntroduced, field.TypeString, value)
+	}
+	if _u.mutation.IntroducedCleared() {
+		_spec.ClearField(bronzereferenceosvaffected.FieldIntroduced, field.TypeString)
+	}
+	if value, ok := _u.mutation.Fixed(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldFixed, field.TypeString, value)
+	}
+	if _u.mutation.FixedCleared() {
+		_spec.ClearField(bronzereferenceosvaffected.FieldFixed, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastAffected(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldLastAffected, field.TypeString, value)
+	}
+	if _u.mutation.LastAffectedCleared() {
+		_spec.ClearField(bronzereferenceosvaffected.FieldLastAffected, field.TypeString)
+	}
+	if value, ok := _u.mutation.Versions(); ok {
+		_spec.SetField(bronzereferenceosvaffected.FieldVersions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVersions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzereferenceosvaffected.FieldVersions, value)
+		})
+	}
+	if _u.mutation.VersionsCleared() {
+		_spec.ClearField(bronzereferenceosvaffected.FieldVersions, field.TypeJSON)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeReferenceOSVAffected
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeReferenceOSVAffected{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzereferenceosvaffected.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceosvvuln"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeReferenceOSVVuln is the model entity for the BronzeReferenceOSVVuln schema.
+type BronzeReferenceOSVVuln struct {
+	config `json:"-"`
+	// ID of the ent.
+	// OSV ID (e.g. GHSA-xxxx-xxxx-xxxx, GO-2024-0001, DSA-5678-1)
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Summary holds the value of the "summary" field.
+	Summary string `json:"summary,omitempty"`
+	// Details holds the value of the "details" field.
+	Details string `json:"details,omitempty"`
+	// Other IDs of the same vulnerability (e.g. CVE-xxx)
+	Aliases []string `json:"aliases,omitempty"`
+	// CVSS_V2, CVSS_V3, CVSS_V4 or Ubuntu
+	SeverityType string `json:"severity_type,omitempty"`
+	// CVSS vector string, or qualitative score for Ubuntu
+	SeverityScore string `json:"severity_score,omitempty"`
+	// Severity from database_specific (e.g. HIGH for GHSA)
+	DatabaseSeverity string `json:"database_severity,omitempty"`
+	// Published holds the value of the "published" field.
+	Published *time.Time `json:"published,omitempty"`
+	// Last modification time from OSV
+	Modified time.Time `json:"modified,omitempty"`
+	// Withdrawn holds the value of the "withdrawn" field.
+	Withdrawn    *time.Time `json:"withdrawn,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeReferenceOSVVuln) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzereferenceosvvuln.FieldAliases:
+			values[i] = new([]byte)
+		case bronzereferenceosvvuln.FieldID, bronzereferenceosvvuln.FieldSummary, bronzereferenceosvvuln.FieldDetails, bronzereferenceosvvuln.FieldSeverityType, bronzereferenceosvvuln.FieldSeverityScore, bronzereferenceosvvuln.FieldDatabaseSeverity:
+			values[i] = new(sql.NullString)
+		case bronzereferenceosvvuln.FieldCollectedAt, bronzereferenceosvvuln.FieldFirstCollectedAt, bronzereferenceosvvuln.FieldPublished, bronzereferenceosvvuln.FieldModified, bronzereferenceosvvuln.FieldWithdrawn:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeReferenceOSVVuln fields.
+func (_m *BronzeReferenceOSVVuln) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzereferenceosvvuln.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzereferenceosvvuln.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzereferenceosvvuln.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzereferenceosvvuln.FieldSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field summary", values[i])
+			} else if value.Valid {
+				_m.Summary = value.String
+			}
+		case bronzereferenceosvvuln.FieldDetails:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field details", values[i])
+			} else if value.Valid {
+				_m.Details = value.String
+			}
+		case bronzereferenceosvvuln.FieldAliases:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field aliases", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Aliases); err != nil {
+					return fmt.Errorf("unmarshal field aliases: %w", err)
+				}
+			}
+		case bronzereferenceosvvuln.FieldSeverityType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field severity_type", values[i])
+			} else if value.Valid {
+				_m.SeverityType = value.String
+			}
+		case bronzereferenceosvvuln.FieldSeverityScore:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field severity_score", values[i])
+			} else if value.Valid {
+				_m.SeverityScore = value.String
+			}
+		case bronzereferenceosvvuln.FieldDatabaseSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field database_severity", values[i])
+			} else if value.Valid {
+				_m.DatabaseSeverity = value.String
+			}
+		case bronzereferenceosvvuln.FieldPublished:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published", values[i])
+			} else if value.Valid {
+				_m.Published = new(time.Time)
+				*_m.Published = value.Time
+			}
+		case bronzereferenceosvvuln.FieldModified:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field modified", values[i])
+			} else if value.Valid {
+				_m.Modified = value.Time
+			}
+		case bronzereferenceosvvuln.FieldWithdrawn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field withdrawn", values[i])
+			} else if value.Valid {
+				_m.Withdrawn = new(time.Time)
+				*_m.Withdrawn = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeReferenceOSVVuln.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeReferenceOSVVuln) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeReferenceOSVVuln.
+// Note that you need to call BronzeReferenceOSVVuln.Unwrap() before calling this method if this BronzeReferenceOSVVuln
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeReferenceOSVVuln) Update() *BronzeReferenceOSVVulnUpdateOne {
+	return NewBronzeReferenceOSVVulnClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeReferenceOSVVuln entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeReferenceOSVVuln) Unwrap() *BronzeReferenceOSVVuln {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("reference: BronzeReferenceOSVVuln is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeReferenceOSVVuln) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeReferenceOSVVuln(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("summary=")
+	builder.WriteString(_m.Summary)
+	builder.WriteString(", ")
+	builder.WriteString("details=")
+	builder.WriteString(_m.Details)
+	builder.WriteString(", ")
+	builder.WriteString("aliases=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Aliases))
+	builder.WriteString(", ")
+	builder.WriteString("severity_type=")
+	builder.WriteString(_m.SeverityType)
+	builder.WriteString(", ")
+	builder.WriteString("severity_score=")
+	builder.WriteString(_m.SeverityScore)
+	builder.WriteString(", ")
+	builder.WriteString("database_severity=")
+	builder.WriteString(_m.DatabaseSeverity)
+	builder.WriteString(", ")
+	if v := _m.Published; v != nil {
+		builder.WriteString("published=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("modified=")
+	builder.WriteString(_m.Modified.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.Withdrawn; v != nil {
+		builder.WriteString("withdrawn=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeReferenceOSVVulns is a parsable slice of BronzeReferenceOSVVuln.
+type BronzeReferenceOSVVulns []*BronzeReferenceOSVVuln
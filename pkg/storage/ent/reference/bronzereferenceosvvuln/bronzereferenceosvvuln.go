@@ -0,0 +1,122 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzereferenceosvvuln
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzereferenceosvvuln type in the database.
+	Label = "bronze_reference_osv_vuln"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldSummary holds the string denoting the summary field in the database.
+	FieldSummary = "summary"
+	// FieldDetails holds the string denoting the details field in the database.
+	FieldDetails = "details"
+	// FieldAliases holds the string denoting the aliases field in the database.
+	FieldAliases = "aliases"
+	// FieldSeverityType holds the string denoting the severity_type field in the database.
+	FieldSeverityType = "severity_type"
+	// FieldSeverityScore holds the string denoting the severity_score field in the database.
+	FieldSeverityScore = "severity_score"
+	// FieldDatabaseSeverity holds the string denoting the database_severity field in the database.
+	FieldDatabaseSeverity = "database_severity"
+	// FieldPublished holds the string denoting the published field in the database.
+	FieldPublished = "published"
+	// FieldModified holds the string denoting the modified field in the database.
+	FieldModified = "modified"
+	// FieldWithdrawn holds the string denoting the withdrawn field in the database.
+	FieldWithdrawn = "withdrawn"
+	// Table holds the table name of the bronzereferenceosvvuln in the database.
+	Table = "reference_osv_vulns"
+)
+
+// Columns holds all SQL columns for bronzereferenceosvvuln fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldSummary,
+	FieldDetails,
+	FieldAliases,
+	FieldSeverityType,
+	FieldSeverityScore,
+	FieldDatabaseSeverity,
+	FieldPublished,
+	FieldModified,
+	FieldWithdrawn,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the BronzeReferenceOSVVuln queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// BySummary orders the results by the summary field.
+func BySummary(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSummary, opts...).ToFunc()
+}
+
+// ByDetails orders the results by the details field.
+func ByDetails(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDetails, opts...).ToFunc()
+}
+
+// BySeverityType orders the results by the severity_type field.
+func BySeverityType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeverityType, opts...).ToFunc()
+}
+
+// BySeverityScore orders the results by the severity_score field.
+func BySeverityScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeverityScore, opts...).ToFunc()
+}
+
+// ByDatabaseSeverity orders the results by the database_severity field.
+func ByDatabaseSeverity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDatabaseSeverity, opts...).ToFunc()
+}
+
+// ByPublished orders the results by the published field.
+func ByPublished(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublished, opts...).ToFunc()
+}
+
+// ByModified orders the results by the modified field.
+func ByModified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModified, opts...).ToFunc()
+}
+
+// ByWithdrawn orders the results by the withdrawn field.
+func ByWithdrawn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWithdrawn, opts...).ToFunc()
+}
@@ -0,0 +1,735 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzereferenceosvvuln
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// Summary applies equality check predicate on the "summary" field. It's identical to SummaryEQ.
+func Summary(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldSummary, v))
+}
+
+// Details applies equality check predicate on the "details" field. It's identical to DetailsEQ.
+func Details(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldDetails, v))
+}
+
+// SeverityType applies equality check predicate on the "severity_type" field. It's identical to SeverityTypeEQ.
+func SeverityType(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldSeverityType, v))
+}
+
+// SeverityScore applies equality check predicate on the "severity_score" field. It's identical to SeverityScoreEQ.
+func SeverityScore(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldSeverityScore, v))
+}
+
+// DatabaseSeverity applies equality check predicate on the "database_severity" field. It's identical to DatabaseSeverityEQ.
+func DatabaseSeverity(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldDatabaseSeverity, v))
+}
+
+// Published applies equality check predicate on the "published" field. It's identical to PublishedEQ.
+func Published(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldPublished, v))
+}
+
+// Modified applies equality check predicate on the "modified" field. It's identical to ModifiedEQ.
+func Modified(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldModified, v))
+}
+
+// Withdrawn applies equality check predicate on the "withdrawn" field. It's identical to WithdrawnEQ.
+func Withdrawn(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldWithdrawn, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// SummaryEQ applies the EQ predicate on the "summary" field.
+func SummaryEQ(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldSummary, v))
+}
+
+// SummaryNEQ applies the NEQ predicate on the "summary" field.
+func SummaryNEQ(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNEQ(FieldSummary, v))
+}
+
+// SummaryIn applies the In predicate on the "summary" field.
+func SummaryIn(vs ...string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldIn(FieldSummary, vs...))
+}
+
+// SummaryNotIn applies the NotIn predicate on the "summary" field.
+func SummaryNotIn(vs ...string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNotIn(FieldSummary, vs...))
+}
+
+// SummaryGT applies the GT predicate on the "summary" field.
+func SummaryGT(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGT(FieldSummary, v))
+}
+
+// SummaryGTE applies the GTE predicate on the "summary" field.
+func SummaryGTE(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGTE(FieldSummary, v))
+}
+
+// SummaryLT applies the LT predicate on the "summary" field.
+func SummaryLT(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLT(FieldSummary, v))
+}
+
+// SummaryLTE applies the LTE predicate on the "summary" field.
+func SummaryLTE(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLTE(FieldSummary, v))
+}
+
+// SummaryContains applies the Contains predicate on the "summary" field.
+func SummaryContains(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldContains(FieldSummary, v))
+}
+
+// SummaryHasPrefix applies the HasPrefix predicate on the "summary" field.
+func SummaryHasPrefix(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldHasPrefix(FieldSummary, v))
+}
+
+// SummaryHasSuffix applies the HasSuffix predicate on the "summary" field.
+func SummaryHasSuffix(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldHasSuffix(FieldSummary, v))
+}
+
+// SummaryIsNil applies the IsNil predicate on the "summary" field.
+func SummaryIsNil() predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldIsNull(FieldSummary))
+}
+
+// SummaryNotNil applies the NotNil predicate on the "summary" field.
+func SummaryNotNil() predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNotNull(FieldSummary))
+}
+
+// SummaryEqualFold applies the EqualFold predicate on the "summary" field.
+func SummaryEqualFold(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEqualFold(FieldSummary, v))
+}
+
+// SummaryContainsFold applies the ContainsFold predicate on the "summary" field.
+func SummaryContainsFold(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldContainsFold(FieldSummary, v))
+}
+
+// DetailsEQ applies the EQ predicate on the "details" field.
+func DetailsEQ(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldDetails, v))
+}
+
+// DetailsNEQ applies the NEQ predicate on the "details" field.
+func DetailsNEQ(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNEQ(FieldDetails, v))
+}
+
+// DetailsIn applies the In predicate on the "details" field.
+func DetailsIn(vs ...string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldIn(FieldDetails, vs...))
+}
+
+// DetailsNotIn applies the NotIn predicate on the "details" field.
+func DetailsNotIn(vs ...string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNotIn(FieldDetails, vs...))
+}
+
+// DetailsGT applies the GT predicate on the "details" field.
+func DetailsGT(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGT(FieldDetails, v))
+}
+
+// DetailsGTE applies the GTE predicate on the "details" field.
+func DetailsGTE(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGTE(FieldDetails, v))
+}
+
+// DetailsLT applies the LT predicate on the "details" field.
+func DetailsLT(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLT(FieldDetails, v))
+}
+
+// DetailsLTE applies the LTE predicate on the "details" field.
+func DetailsLTE(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLTE(FieldDetails, v))
+}
+
+// DetailsContains applies the Contains predicate on the "details" field.
+func DetailsContains(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldContains(FieldDetails, v))
+}
+
+// DetailsHasPrefix applies the HasPrefix predicate on the "details" field.
+func DetailsHasPrefix(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldHasPrefix(FieldDetails, v))
+}
+
+// DetailsHasSuffix applies the HasSuffix predicate on the "details" field.
+func DetailsHasSuffix(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldHasSuffix(FieldDetails, v))
+}
+
+// DetailsIsNil applies the IsNil predicate on the "details" field.
+func DetailsIsNil() predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldIsNull(FieldDetails))
+}
+
+// DetailsNotNil applies the NotNil predicate on the "details" field.
+func DetailsNotNil() predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNotNull(FieldDetails))
+}
+
+// DetailsEqualFold applies the EqualFold predicate on the "details" field.
+func DetailsEqualFold(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEqualFold(FieldDetails, v))
+}
+
+// DetailsContainsFold applies the ContainsFold predicate on the "details" field.
+func DetailsContainsFold(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldContainsFold(FieldDetails, v))
+}
+
+// AliasesIsNil applies the IsNil predicate on the "aliases" field.
+func AliasesIsNil() predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldIsNull(FieldAliases))
+}
+
+// AliasesNotNil applies the NotNil predicate on the "aliases" field.
+func AliasesNotNil() predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNotNull(FieldAliases))
+}
+
+// SeverityTypeEQ applies the EQ predicate on the "severity_type" field.
+func SeverityTypeEQ(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldSeverityType, v))
+}
+
+// SeverityTypeNEQ applies the NEQ predicate on the "severity_type" field.
+func SeverityTypeNEQ(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNEQ(FieldSeverityType, v))
+}
+
+// SeverityTypeIn applies the In predicate on the "severity_type" field.
+func SeverityTypeIn(vs ...string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldIn(FieldSeverityType, vs...))
+}
+
+// SeverityTypeNotIn applies the NotIn predicate on the "severity_type" field.
+func SeverityTypeNotIn(vs ...string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNotIn(FieldSeverityType, vs...))
+}
+
+// SeverityTypeGT applies the GT predicate on the "severity_type" field.
+func SeverityTypeGT(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGT(FieldSeverityType, v))
+}
+
+// SeverityTypeGTE applies the GTE predicate on the "severity_type" field.
+func SeverityTypeGTE(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGTE(FieldSeverityType, v))
+}
+
+// SeverityTypeLT applies the LT predicate on the "severity_type" field.
+func SeverityTypeLT(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLT(FieldSeverityType, v))
+}
+
+// SeverityTypeLTE applies the LTE predicate on the "severity_type" field.
+func SeverityTypeLTE(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLTE(FieldSeverityType, v))
+}
+
+// SeverityTypeContains applies the Contains predicate on the "severity_type" field.
+func SeverityTypeContains(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldContains(FieldSeverityType, v))
+}
+
+// SeverityTypeHasPrefix applies the HasPrefix predicate on the "severity_type" field.
+func SeverityTypeHasPrefix(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldHasPrefix(FieldSeverityType, v))
+}
+
+// SeverityTypeHasSuffix applies the HasSuffix predicate on the "severity_type" field.
+func SeverityTypeHasSuffix(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldHasSuffix(FieldSeverityType, v))
+}
+
+// SeverityTypeIsNil applies the IsNil predicate on the "severity_type" field.
+func SeverityTypeIsNil() predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldIsNull(FieldSeverityType))
+}
+
+// SeverityTypeNotNil applies the NotNil predicate on the "severity_type" field.
+func SeverityTypeNotNil() predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNotNull(FieldSeverityType))
+}
+
+// SeverityTypeEqualFold applies the EqualFold predicate on the "severity_type" field.
+func SeverityTypeEqualFold(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEqualFold(FieldSeverityType, v))
+}
+
+// SeverityTypeContainsFold applies the ContainsFold predicate on the "severity_type" field.
+func SeverityTypeContainsFold(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldContainsFold(FieldSeverityType, v))
+}
+
+// SeverityScoreEQ applies the EQ predicate on the "severity_score" field.
+func SeverityScoreEQ(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldSeverityScore, v))
+}
+
+// SeverityScoreNEQ applies the NEQ predicate on the "severity_score" field.
+func SeverityScoreNEQ(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNEQ(FieldSeverityScore, v))
+}
+
+// SeverityScoreIn applies the In predicate on the "severity_score" field.
+func SeverityScoreIn(vs ...string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldIn(FieldSeverityScore, vs...))
+}
+
+// SeverityScoreNotIn applies the NotIn predicate on the "severity_score" field.
+func SeverityScoreNotIn(vs ...string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNotIn(FieldSeverityScore, vs...))
+}
+
+// SeverityScoreGT applies the GT predicate on the "severity_score" field.
+func SeverityScoreGT(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGT(FieldSeverityScore, v))
+}
+
+// SeverityScoreGTE applies the GTE predicate on the "severity_score" field.
+func SeverityScoreGTE(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGTE(FieldSeverityScore, v))
+}
+
+// SeverityScoreLT applies the LT predicate on the "severity_score" field.
+func SeverityScoreLT(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLT(FieldSeverityScore, v))
+}
+
+// SeverityScoreLTE applies the LTE predicate on the "severity_score" field.
+func SeverityScoreLTE(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLTE(FieldSeverityScore, v))
+}
+
+// SeverityScoreContains applies the Contains predicate on the "severity_score" field.
+func SeverityScoreContains(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldContains(FieldSeverityScore, v))
+}
+
+// SeverityScoreHasPrefix applies the HasPrefix predicate on the "severity_score" field.
+func SeverityScoreHasPrefix(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldHasPrefix(FieldSeverityScore, v))
+}
+
+// SeverityScoreHasSuffix applies the HasSuffix predicate on the "severity_score" field.
+func SeverityScoreHasSuffix(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldHasSuffix(FieldSeverityScore, v))
+}
+
+// SeverityScoreIsNil applies the IsNil predicate on the "severity_score" field.
+func SeverityScoreIsNil() predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldIsNull(FieldSeverityScore))
+}
+
+// SeverityScoreNotNil applies the NotNil predicate on the "severity_score" field.
+func SeverityScoreNotNil() predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNotNull(FieldSeverityScore))
+}
+
+// SeverityScoreEqualFold applies the EqualFold predicate on the "severity_score" field.
+func SeverityScoreEqualFold(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEqualFold(FieldSeverityScore, v))
+}
+
+// SeverityScoreContainsFold applies the ContainsFold predicate on the "severity_score" field.
+func SeverityScoreContainsFold(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldContainsFold(FieldSeverityScore, v))
+}
+
+// DatabaseSeverityEQ applies the EQ predicate on the "database_severity" field.
+func DatabaseSeverityEQ(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldDatabaseSeverity, v))
+}
+
+// DatabaseSeverityNEQ applies the NEQ predicate on the "database_severity" field.
+func DatabaseSeverityNEQ(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNEQ(FieldDatabaseSeverity, v))
+}
+
+// DatabaseSeverityIn applies the In predicate on the "database_severity" field.
+func DatabaseSeverityIn(vs ...string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldIn(FieldDatabaseSeverity, vs...))
+}
+
+// DatabaseSeverityNotIn applies the NotIn predicate on the "database_severity" field.
+func DatabaseSeverityNotIn(vs ...string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNotIn(FieldDatabaseSeverity, vs...))
+}
+
+// DatabaseSeverityGT applies the GT predicate on the "database_severity" field.
+func DatabaseSeverityGT(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGT(FieldDatabaseSeverity, v))
+}
+
+// DatabaseSeverityGTE applies the GTE predicate on the "database_severity" field.
+func DatabaseSeverityGTE(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGTE(FieldDatabaseSeverity, v))
+}
+
+// DatabaseSeverityLT applies the LT predicate on the "database_severity" field.
+func DatabaseSeverityLT(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLT(FieldDatabaseSeverity, v))
+}
+
+// DatabaseSeverityLTE applies the LTE predicate on the "database_severity" field.
+func DatabaseSeverityLTE(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLTE(FieldDatabaseSeverity, v))
+}
+
+// DatabaseSeverityContains applies the Contains predicate on the "database_severity" field.
+func DatabaseSeverityContains(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldContains(FieldDatabaseSeverity, v))
+}
+
+// DatabaseSeverityHasPrefix applies the HasPrefix predicate on the "database_severity" field.
+func DatabaseSeverityHasPrefix(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldHasPrefix(FieldDatabaseSeverity, v))
+}
+
+// DatabaseSeverityHasSuffix applies the HasSuffix predicate on the "database_severity" field.
+func DatabaseSeverityHasSuffix(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldHasSuffix(FieldDatabaseSeverity, v))
+}
+
+// DatabaseSeverityIsNil applies the IsNil predicate on the "database_severity" field.
+func DatabaseSeverityIsNil() predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldIsNull(FieldDatabaseSeverity))
+}
+
+// DatabaseSeverityNotNil applies the NotNil predicate on the "database_severity" field.
+func DatabaseSeverityNotNil() predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNotNull(FieldDatabaseSeverity))
+}
+
+// DatabaseSeverityEqualFold applies the EqualFold predicate on the "database_severity" field.
+func DatabaseSeverityEqualFold(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEqualFold(FieldDatabaseSeverity, v))
+}
+
+// DatabaseSeverityContainsFold applies the ContainsFold predicate on the "database_severity" field.
+func DatabaseSeverityContainsFold(v string) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldContainsFold(FieldDatabaseSeverity, v))
+}
+
+// PublishedEQ applies the EQ predicate on the "published" field.
+func PublishedEQ(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldPublished, v))
+}
+
+// PublishedNEQ applies the NEQ predicate on the "published" field.
+func PublishedNEQ(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNEQ(FieldPublished, v))
+}
+
+// PublishedIn applies the In predicate on the "published" field.
+func PublishedIn(vs ...time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldIn(FieldPublished, vs...))
+}
+
+// PublishedNotIn applies the NotIn predicate on the "published" field.
+func PublishedNotIn(vs ...time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNotIn(FieldPublished, vs...))
+}
+
+// PublishedGT applies the GT predicate on the "published" field.
+func PublishedGT(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGT(FieldPublished, v))
+}
+
+// PublishedGTE applies the GTE predicate on the "published" field.
+func PublishedGTE(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGTE(FieldPublished, v))
+}
+
+// PublishedLT applies the LT predicate on the "published" field.
+func PublishedLT(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLT(FieldPublished, v))
+}
+
+// PublishedLTE applies the LTE predicate on the "published" field.
+func PublishedLTE(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLTE(FieldPublished, v))
+}
+
+// PublishedIsNil applies the IsNil predicate on the "published" field.
+func PublishedIsNil() predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldIsNull(FieldPublished))
+}
+
+// PublishedNotNil applies the NotNil predicate on the "published" field.
+func PublishedNotNil() predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNotNull(FieldPublished))
+}
+
+// ModifiedEQ applies the EQ predicate on the "modified" field.
+func ModifiedEQ(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldModified, v))
+}
+
+// ModifiedNEQ applies the NEQ predicate on the "modified" field.
+func ModifiedNEQ(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNEQ(FieldModified, v))
+}
+
+// ModifiedIn applies the In predicate on the "modified" field.
+func ModifiedIn(vs ...time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldIn(FieldModified, vs...))
+}
+
+// ModifiedNotIn applies the NotIn predicate on the "modified" field.
+func ModifiedNotIn(vs ...time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNotIn(FieldModified, vs...))
+}
+
+// ModifiedGT applies the GT predicate on the "modified" field.
+func ModifiedGT(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGT(FieldModified, v))
+}
+
+// ModifiedGTE applies the GTE predicate on the "modified" field.
+func ModifiedGTE(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGTE(FieldModified, v))
+}
+
+// ModifiedLT applies the LT predicate on the "modified" field.
+func ModifiedLT(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLT(FieldModified, v))
+}
+
+// ModifiedLTE applies the LTE predicate on the "modified" field.
+func ModifiedLTE(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLTE(FieldModified, v))
+}
+
+// WithdrawnEQ applies the EQ predicate on the "withdrawn" field.
+func WithdrawnEQ(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldEQ(FieldWithdrawn, v))
+}
+
+// WithdrawnNEQ applies the NEQ predicate on the "withdrawn" field.
+func WithdrawnNEQ(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNEQ(FieldWithdrawn, v))
+}
+
+// WithdrawnIn applies the In predicate on the "withdrawn" field.
+func WithdrawnIn(vs ...time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldIn(FieldWithdrawn, vs...))
+}
+
+// WithdrawnNotIn applies the NotIn predicate on the "withdrawn" field.
+func WithdrawnNotIn(vs ...time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNotIn(FieldWithdrawn, vs...))
+}
+
+// WithdrawnGT applies the GT predicate on the "withdrawn" field.
+func WithdrawnGT(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGT(FieldWithdrawn, v))
+}
+
+// WithdrawnGTE applies the GTE predicate on the "withdrawn" field.
+func WithdrawnGTE(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldGTE(FieldWithdrawn, v))
+}
+
+// WithdrawnLT applies the LT predicate on the "withdrawn" field.
+func WithdrawnLT(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLT(FieldWithdrawn, v))
+}
+
+// WithdrawnLTE applies the LTE predicate on the "withdrawn" field.
+func WithdrawnLTE(v time.Time) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldLTE(FieldWithdrawn, v))
+}
+
+// WithdrawnIsNil applies the IsNil predicate on the "withdrawn" field.
+func WithdrawnIsNil() predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldIsNull(FieldWithdrawn))
+}
+
+// WithdrawnNotNil applies the NotNil predicate on the "withdrawn" field.
+func WithdrawnNotNil() predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.FieldNotNull(FieldWithdrawn))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeReferenceOSVVuln) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeReferenceOSVVuln) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeReferenceOSVVuln) predicate.BronzeReferenceOSVVuln {
+	return predicate.BronzeReferenceOSVVuln(sql.NotPredicates(p))
+}
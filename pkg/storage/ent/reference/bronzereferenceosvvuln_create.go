@@ -0,0 +1,358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceosvvuln"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeReferenceOSVVulnCreate is the builder for creating a BronzeReferenceOSVVuln entity.
+type BronzeReferenceOSVVulnCreate struct {
+	config
+	mutation *BronzeReferenceOSVVulnMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeReferenceOSVVulnCreate) SetCollectedAt(v time.Time) *BronzeReferenceOSVVulnCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeReferenceOSVVulnCreate) SetFirstCollectedAt(v time.Time) *BronzeReferenceOSVVulnCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetSummary sets the "summary" field.
+func (_c *BronzeReferenceOSVVulnCreate) SetSummary(v string) *BronzeReferenceOSVVulnCreate {
+	_c.mutation.SetSummary(v)
+	return _c
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_c *BronzeReferenceOSVVulnCreate) SetNillableSummary(v *string) *BronzeReferenceOSVVulnCreate {
+	if v != nil {
+		_c.SetSummary(*v)
+	}
+	return _c
+}
+
+// SetDetails sets the "details" field.
+func (_c *BronzeReferenceOSVVulnCreate) SetDetails(v string) *BronzeReferenceOSVVulnCreate {
+	_c.mutation.SetDetails(v)
+	return _c
+}
+
+// SetNillableDetails sets the "details" field if the given value is not nil.
+func (_c *BronzeReferenceOSVVulnCreate) SetNillableDetails(v *string) *BronzeReferenceOSVVulnCreate {
+	if v != nil {
+		_c.SetDetails(*v)
+	}
+	return _c
+}
+
+// SetAliases sets the "aliases" field.
+func (_c *BronzeReferenceOSVVulnCreate) SetAliases(v []string) *BronzeReferenceOSVVulnCreate {
+	_c.mutation.SetAliases(v)
+	return _c
+}
+
+// SetSeverityType sets the "severity_type" field.
+func (_c *BronzeReferenceOSVVulnCreate) SetSeverityType(v string) *BronzeReferenceOSVVulnCreate {
+	_c.mutation.SetSeverityType(v)
+	return _c
+}
+
+// SetNillableSeverityType sets the "severity_type" field if the given value is not nil.
+func (_c *BronzeReferenceOSVVulnCreate) SetNillableSeverityType(v *string) *BronzeReferenceOSVVulnCreate {
+	if v != nil {
+		_c.SetSeverityType(*v)
+	}
+	return _c
+}
+
+// SetSeverityScore sets the "severity_score" field.
+func (_c *BronzeReferenceOSVVulnCreate) SetSeverityScore(v string) *BronzeReferenceOSVVulnCreate {
+	_c.mutation.SetSeverityScore(v)
+	return _c
+}
+
+// SetNillableSeverityScore sets the "severity_score" field if the given value is not nil.
+func (_c *BronzeReferenceOSVVulnCreate) SetNillableSeverityScore(v *string) *BronzeReferenceOSVVulnCreate {
+	if v != nil {
+		_c.SetSeverityScore(*v)
+	}
+	return _c
+}
+
+// SetDatabaseSeverity sets the "database_severity" field.
+func (_c *BronzeReferenceOSVVulnCreate) SetDatabaseSeverity(v string) *BronzeReferenceOSVVulnCreate {
+	_c.mutation.SetDatabaseSeverity(v)
+	return _c
+}
+
+// SetNillableDatabaseSeverity sets the "database_severity" field if the given value is not nil.
+func (_c *BronzeReferenceOSVVulnCreate) SetNillableDatabaseSeverity(v *string) *BronzeReferenceOSVVulnCreate {
+	if v != nil {
+		_c.SetDatabaseSeverity(*v)
+	}
+	return _c
+}
+
+// SetPublished sets the "published" field.
+func (_c *BronzeReferenceOSVVulnCreate) SetPublished(v time.Time) *BronzeReferenceOSVVulnCreate {
+	_c.mutation.SetPublished(v)
+	return _c
+}
+
+// SetNillablePublished sets the "published" field if the given value is not nil.
+func (_c *BronzeReferenceOSVVulnCreate) SetNillablePublished(v *time.Time) *BronzeReferenceOSVVulnCreate {
+	if v != nil {
+		_c.SetPublished(*v)
+	}
+	return _c
+}
+
+// SetModified sets the "modified" field.
+func (_c *BronzeReferenceOSVVulnCreate) SetModified(v time.Time) *BronzeReferenceOSVVulnCreate {
+	_c.mutation.SetModified(v)
+	return _c
+}
+
+// SetWithdrawn sets the "withdrawn" field.
+func (_c *BronzeReferenceOSVVulnCreate) SetWithdrawn(v time.Time) *BronzeReferenceOSVVulnCreate {
+	_c.mutation.SetWithdrawn(v)
+	return _c
+}
+
+// SetNillableWithdrawn sets the "withdrawn" field if the given value is not nil.
+func (_c *BronzeReferenceOSVVulnCreate) SetNillableWithdrawn(v *time.Time) *BronzeReferenceOSVVulnCreate {
+	if v != nil {
+		_c.SetWithdrawn(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeReferenceOSVVulnCreate) SetID(v string) *BronzeReferenceOSVVulnCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeReferenceOSVVulnMutation object of the builder.
+func (_c *BronzeReferenceOSVVulnCreate) Mutation() *BronzeReferenceOSVVulnMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeReferenceOSVVuln in the database.
+func (_c *BronzeReferenceOSVVulnCreate) Save(ctx context.Context) (*BronzeReferenceOSVVuln, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeReferenceOSVVulnCreate) SaveX(ctx context.Context) *BronzeReferenceOSVVuln {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeReferenceOSVVulnCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeReferenceOSVVulnCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeReferenceOSVVulnCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`reference: missing required field "BronzeReferenceOSVVuln.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`reference: missing required field "BronzeReferenceOSVVuln.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.Modified(); !ok {
+		return &ValidationError{Name: "modified", err: errors.New(`reference: missing required field "BronzeReferenceOSVVuln.modified"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeReferenceOSVVulnCreate) sqlSave(ctx context.Context) (*BronzeReferenceOSVVuln, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeReferenceOSVVuln.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeReferenceOSVVulnCreate) createSpec() (*BronzeReferenceOSVVuln, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeReferenceOSVVuln{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzereferenceosvvuln.Table, sqlgraph.NewFieldSpec(bronzereferenceosvvuln.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeReferenceOSVVuln
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.Summary(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldSummary, field.TypeString, value)
+		_node.Summary = value
+	}
+	if value, ok := _c.mutation.Details(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldDetails, field.TypeString, value)
+		_node.Details = value
+	}
+	if value, ok := _c.mutation.Aliases(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldAliases, field.TypeJSON, value)
+		_node.Aliases = value
+	}
+	if value, ok := _c.mutation.SeverityType(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldSeverityType, field.TypeString, value)
+		_node.SeverityType = value
+	}
+	if value, ok := _c.mutation.SeverityScore(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldSeverityScore, field.TypeString, value)
+		_node.SeverityScore = value
+	}
+	if value, ok := _c.mutation.DatabaseSeverity(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldDatabaseSeverity, field.TypeString, value)
+		_node.DatabaseSeverity = value
+	}
+	if value, ok := _c.mutation.Published(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldPublished, field.TypeTime, value)
+		_node.Published = &value
+	}
+	if value, ok := _c.mutation.Modified(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldModified, field.TypeTime, value)
+		_node.Modified = value
+	}
+	if value, ok := _c.mutation.Withdrawn(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldWithdrawn, field.TypeTime, value)
+		_node.Withdrawn = &value
+	}
+	return _node, _spec
+}
+
+// BronzeReferenceOSVVulnCreateBulk is the builder for creating many BronzeReferenceOSVVuln entities in bulk.
+type BronzeReferenceOSVVulnCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeReferenceOSVVulnCreate
+}
+
+// Save creates the BronzeReferenceOSVVuln entities in the database.
+func (_c *BronzeReferenceOSVVulnCreateBulk) Save(ctx context.Context) ([]*BronzeReferenceOSVVuln, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeReferenceOSVVuln, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeReferenceOSVVulnMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeReferenceOSVVulnCreateBulk) SaveX(ctx context.Context) []*BronzeReferenceOSVVuln {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeReferenceOSVVulnCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeReferenceOSVVulnCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
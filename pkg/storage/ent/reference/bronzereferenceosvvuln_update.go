@@ -0,0 +1,672 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceosvvuln"
+	"danny.vn/hotpot/pkg/storage/ent/reference/internal"
+	"danny.vn/hotpot/pkg/storage/ent/reference/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeReferenceOSVVulnUpdate is the builder for updating BronzeReferenceOSVVuln entities.
+type BronzeReferenceOSVVulnUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeReferenceOSVVulnMutation
+}
+
+// Where appends a list predicates to the BronzeReferenceOSVVulnUpdate builder.
+func (_u *BronzeReferenceOSVVulnUpdate) Where(ps ...predicate.BronzeReferenceOSVVuln) *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeReferenceOSVVulnUpdate) SetCollectedAt(v time.Time) *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeReferenceOSVVulnUpdate) SetNillableCollectedAt(v *time.Time) *BronzeReferenceOSVVulnUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetSummary sets the "summary" field.
+func (_u *BronzeReferenceOSVVulnUpdate) SetSummary(v string) *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.SetSummary(v)
+	return _u
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_u *BronzeReferenceOSVVulnUpdate) SetNillableSummary(v *string) *BronzeReferenceOSVVulnUpdate {
+	if v != nil {
+		_u.SetSummary(*v)
+	}
+	return _u
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (_u *BronzeReferenceOSVVulnUpdate) ClearSummary() *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.ClearSummary()
+	return _u
+}
+
+// SetDetails sets the "details" field.
+func (_u *BronzeReferenceOSVVulnUpdate) SetDetails(v string) *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.SetDetails(v)
+	return _u
+}
+
+// SetNillableDetails sets the "details" field if the given value is not nil.
+func (_u *BronzeReferenceOSVVulnUpdate) SetNillableDetails(v *string) *BronzeReferenceOSVVulnUpdate {
+	if v != nil {
+		_u.SetDetails(*v)
+	}
+	return _u
+}
+
+// ClearDetails clears the value of the "details" field.
+func (_u *BronzeReferenceOSVVulnUpdate) ClearDetails() *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.ClearDetails()
+	return _u
+}
+
+// SetAliases sets the "aliases" field.
+func (_u *BronzeReferenceOSVVulnUpdate) SetAliases(v []string) *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.SetAliases(v)
+	return _u
+}
+
+// AppendAliases appends value to the "aliases" field.
+func (_u *BronzeReferenceOSVVulnUpdate) AppendAliases(v []string) *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.AppendAliases(v)
+	return _u
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (_u *BronzeReferenceOSVVulnUpdate) ClearAliases() *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.ClearAliases()
+	return _u
+}
+
+// SetSeverityType sets the "severity_type" field.
+func (_u *BronzeReferenceOSVVulnUpdate) SetSeverityType(v string) *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.SetSeverityType(v)
+	return _u
+}
+
+// SetNillableSeverityType sets the "severity_type" field if the given value is not nil.
+func (_u *BronzeReferenceOSVVulnUpdate) SetNillableSeverityType(v *string) *BronzeReferenceOSVVulnUpdate {
+	if v != nil {
+		_u.SetSeverityType(*v)
+	}
+	return _u
+}
+
+// ClearSeverityType clears the value of the "severity_type" field.
+func (_u *BronzeReferenceOSVVulnUpdate) ClearSeverityType() *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.ClearSeverityType()
+	return _u
+}
+
+// SetSeverityScore sets the "severity_score" field.
+func (_u *BronzeReferenceOSVVulnUpdate) SetSeverityScore(v string) *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.SetSeverityScore(v)
+	return _u
+}
+
+// SetNillableSeverityScore sets the "severity_score" field if the given value is not nil.
+func (_u *BronzeReferenceOSVVulnUpdate) SetNillableSeverityScore(v *string) *BronzeReferenceOSVVulnUpdate {
+	if v != nil {
+		_u.SetSeverityScore(*v)
+	}
+	return _u
+}
+
+// ClearSeverityScore clears the value of the "severity_score" field.
+func (_u *BronzeReferenceOSVVulnUpdate) ClearSeverityScore() *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.ClearSeverityScore()
+	return _u
+}
+
+// SetDatabaseSeverity sets the "database_severity" field.
+func (_u *BronzeReferenceOSVVulnUpdate) SetDatabaseSeverity(v string) *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.SetDatabaseSeverity(v)
+	return _u
+}
+
+// SetNillableDatabaseSeverity sets the "database_severity" field if the given value is not nil.
+func (_u *BronzeReferenceOSVVulnUpdate) SetNillableDatabaseSeverity(v *string) *BronzeReferenceOSVVulnUpdate {
+	if v != nil {
+		_u.SetDatabaseSeverity(*v)
+	}
+	return _u
+}
+
+// ClearDatabaseSeverity clears the value of the "database_severity" field.
+func (_u *BronzeReferenceOSVVulnUpdate) ClearDatabaseSeverity() *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.ClearDatabaseSeverity()
+	return _u
+}
+
+// SetPublished sets the "published" field.
+func (_u *BronzeReferenceOSVVulnUpdate) SetPublished(v time.Time) *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.SetPublished(v)
+	return _u
+}
+
+// SetNillablePublished sets the "published" field if the given value is not nil.
+func (_u *BronzeReferenceOSVVulnUpdate) SetNillablePublished(v *time.Time) *BronzeReferenceOSVVulnUpdate {
+	if v != nil {
+		_u.SetPublished(*v)
+	}
+	return _u
+}
+
+// ClearPublished clears the value of the "published" field.
+func (_u *BronzeReferenceOSVVulnUpdate) ClearPublished() *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.ClearPublished()
+	return _u
+}
+
+// SetModified sets the "modified" field.
+func (_u *BronzeReferenceOSVVulnUpdate) SetModified(v time.Time) *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.SetModified(v)
+	return _u
+}
+
+// SetNillableModified sets the "modified" field if the given value is not nil.
+func (_u *BronzeReferenceOSVVulnUpdate) SetNillableModified(v *time.Time) *BronzeReferenceOSVVulnUpdate {
+	if v != nil {
+		_u.SetModified(*v)
+	}
+	return _u
+}
+
+// SetWithdrawn sets the "withdrawn" field.
+func (_u *BronzeReferenceOSVVulnUpdate) SetWithdrawn(v time.Time) *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.SetWithdrawn(v)
+	return _u
+}
+
+// SetNillableWithdrawn sets the "withdrawn" field if the given value is not nil.
+func (_u *BronzeReferenceOSVVulnUpdate) SetNillableWithdrawn(v *time.Time) *BronzeReferenceOSVVulnUpdate {
+	if v != nil {
+		_u.SetWithdrawn(*v)
+	}
+	return _u
+}
+
+// ClearWithdrawn clears the value of the "withdrawn" field.
+func (_u *BronzeReferenceOSVVulnUpdate) ClearWithdrawn() *BronzeReferenceOSVVulnUpdate {
+	_u.mutation.ClearWithdrawn()
+	return _u
+}
+
+// Mutation returns the BronzeReferenceOSVVulnMutation object of the builder.
+func (_u *BronzeReferenceOSVVulnUpdate) Mutation() *BronzeReferenceOSVVulnMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeReferenceOSVVulnUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeReferenceOSVVulnUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeReferenceOSVVulnUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeReferenceOSVVulnUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BronzeReferenceOSVVulnUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bronzereferenceosvvuln.Table, bronzereferenceosvvuln.Columns, sqlgraph.NewFieldSpec(bronzereferenceosvvuln.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Summary(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldSummary, field.TypeString, value)
+	}
+	if _u.mutation.SummaryCleared() {
+		_spec.ClearField(bronzereferenceosvvuln.FieldSummary, field.TypeString)
+	}
+	if value, ok := _u.mutation.Details(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldDetails, field.TypeString, value)
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(bronzereferenceosvvuln.FieldDetails, field.TypeString)
+	}
+	if value, ok := _u.mutation.Aliases(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldAliases, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAliases(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzereferenceosvvuln.FieldAliases, value)
+		})
+	}
+	if _u.mutation.AliasesCleared() {
+		_spec.ClearField(bronzereferenceosvvuln.FieldAliases, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SeverityType(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldSeverityType, field.TypeString, value)
+	}
+	if _u.mutation.SeverityTypeCleared() {
+		_spec.ClearField(bronzereferenceosvvuln.FieldSeverityType, field.TypeString)
+	}
+	if value, ok := _u.mutation.SeverityScore(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldSeverityScore, field.TypeString, value)
+	}
+	if _u.mutation.SeverityScoreCleared() {
+		_spec.ClearField(bronzereferenceosvvuln.FieldSeverityScore, field.TypeString)
+	}
+	if value, ok := _u.mutation.DatabaseSeverity(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldDatabaseSeverity, field.TypeString, value)
+	}
+	if _u.mutation.DatabaseSeverityCleared() {
+		_spec.ClearField(bronzereferenceosvvuln.FieldDatabaseSeverity, field.TypeString)
+	}
+	if value, ok := _u.mutation.Published(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldPublished, field.TypeTime, value)
+	}
+	if _u.mutation.PublishedCleared() {
+		_spec.ClearField(bronzereferenceosvvuln.FieldPublished, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Modified(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldModified, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Withdrawn(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldWithdrawn, field.TypeTime, value)
+	}
+	if _u.mutation.WithdrawnCleared() {
+		_spec.ClearField(bronzereferenceosvvuln.FieldWithdrawn, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeReferenceOSVVuln
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzereferenceosvvuln.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeReferenceOSVVulnUpdateOne is the builder for updating a single BronzeReferenceOSVVuln entity.
+type BronzeReferenceOSVVulnUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeReferenceOSVVulnMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SetCollectedAt(v time.Time) *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeReferenceOSVVulnUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetSummary sets the "summary" field.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SetSummary(v string) *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.SetSummary(v)
+	return _u
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SetNillableSummary(v *string) *BronzeReferenceOSVVulnUpdateOne {
+	if v != nil {
+		_u.SetSummary(*v)
+	}
+	return _u
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (_u *BronzeReferenceOSVVulnUpdateOne) ClearSummary() *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.ClearSummary()
+	return _u
+}
+
+// SetDetails sets the "details" field.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SetDetails(v string) *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.SetDetails(v)
+	return _u
+}
+
+// SetNillableDetails sets the "details" field if the given value is not nil.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SetNillableDetails(v *string) *BronzeReferenceOSVVulnUpdateOne {
+	if v != nil {
+		_u.SetDetails(*v)
+	}
+	return _u
+}
+
+// ClearDetails clears the value of the "details" field.
+func (_u *BronzeReferenceOSVVulnUpdateOne) ClearDetails() *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.ClearDetails()
+	return _u
+}
+
+// SetAliases sets the "aliases" field.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SetAliases(v []string) *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.SetAliases(v)
+	return _u
+}
+
+// AppendAliases appends value to the "aliases" field.
+func (_u *BronzeReferenceOSVVulnUpdateOne) AppendAliases(v []string) *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.AppendAliases(v)
+	return _u
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (_u *BronzeReferenceOSVVulnUpdateOne) ClearAliases() *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.ClearAliases()
+	return _u
+}
+
+// SetSeverityType sets the "severity_type" field.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SetSeverityType(v string) *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.SetSeverityType(v)
+	return _u
+}
+
+// SetNillableSeverityType sets the "severity_type" field if the given value is not nil.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SetNillableSeverityType(v *string) *BronzeReferenceOSVVulnUpdateOne {
+	if v != nil {
+		_u.SetSeverityType(*v)
+	}
+	return _u
+}
+
+// ClearSeverityType clears the value of the "severity_type" field.
+func (_u *BronzeReferenceOSVVulnUpdateOne) ClearSeverityType() *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.ClearSeverityType()
+	return _u
+}
+
+// SetSeverityScore sets the "severity_score" field.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SetSeverityScore(v string) *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.SetSeverityScore(v)
+	return _u
+}
+
+// SetNillableSeverityScore sets the "severity_score" field if the given value is not nil.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SetNillableSeverityScore(v *string) *BronzeReferenceOSVVulnUpdateOne {
+	if v != nil {
+		_u.SetSeverityScore(*v)
+	}
+	return _u
+}
+
+// ClearSeverityScore clears the value of the "severity_score" field.
+func (_u *BronzeReferenceOSVVulnUpdateOne) ClearSeverityScore() *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.ClearSeverityScore()
+	return _u
+}
+
+// SetDatabaseSeverity sets the "database_severity" field.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SetDatabaseSeverity(v string) *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.SetDatabaseSeverity(v)
+	return _u
+}
+
+// SetNillableDatabaseSeverity sets the "database_severity" field if the given value is not nil.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SetNillableDatabaseSeverity(v *string) *BronzeReferenceOSVVulnUpdateOne {
+	if v != nil {
+		_u.SetDatabaseSeverity(*v)
+	}
+	return _u
+}
+
+// ClearDatabaseSeverity clears the value of the "database_severity" field.
+func (_u *BronzeReferenceOSVVulnUpdateOne) ClearDatabaseSeverity() *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.ClearDatabaseSeverity()
+	return _u
+}
+
+// SetPublished sets the "published" field.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SetPublished(v time.Time) *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.SetPublished(v)
+	return _u
+}
+
+// SetNillablePublished sets the "published" field if the given value is not nil.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SetNillablePublished(v *time.Time) *BronzeReferenceOSVVulnUpdateOne {
+	if v != nil {
+		_u.SetPublished(*v)
+	}
+	return _u
+}
+
+// ClearPublished clears the value of the "published" field.
+func (_u *BronzeReferenceOSVVulnUpdateOne) ClearPublished() *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.ClearPublished()
+	return _u
+}
+
+// SetModified sets the "modified" field.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SetModified(v time.Time) *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.SetModified(v)
+	return _u
+}
+
+// SetNillableModified sets the "modified" field if the given value is not nil.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SetNillableModified(v *time.Time) *BronzeReferenceOSVVulnUpdateOne {
+	if v != nil {
+		_u.SetModified(*v)
+	}
+	return _u
+}
+
+// SetWithdrawn sets the "withdrawn" field.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SetWithdrawn(v time.Time) *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.SetWithdrawn(v)
+	return _u
+}
+
+// SetNillableWithdrawn sets the "withdrawn" field if the given value is not nil.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SetNillableWithdrawn(v *time.Time) *BronzeReferenceOSVVulnUpdateOne {
+	if v != nil {
+		_u.SetWithdrawn(*v)
+	}
+	return _u
+}
+
+// ClearWithdrawn clears the value of the "withdrawn" field.
+func (_u *BronzeReferenceOSVVulnUpdateOne) ClearWithdrawn() *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.ClearWithdrawn()
+	return _u
+}
+
+// Mutation returns the BronzeReferenceOSVVulnMutation object of the builder.
+func (_u *BronzeReferenceOSVVulnUpdateOne) Mutation() *BronzeReferenceOSVVulnMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeReferenceOSVVulnUpdate builder.
+func (_u *BronzeReferenceOSVVulnUpdateOne) Where(ps ...predicate.BronzeReferenceOSVVuln) *BronzeReferenceOSVVulnUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeReferenceOSVVulnUpdateOne) Select(field string, fields ...string) *BronzeReferenceOSVVulnUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeReferenceOSVVuln entity.
+func (_u *BronzeReferenceOSVVulnUpdateOne) Save(ctx context.Context) (*BronzeReferenceOSVVuln, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeReferenceOSVVulnUpdateOne) SaveX(ctx context.Context) *BronzeReferenceOSVVuln {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeReferenceOSVVulnUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeReferenceOSVVulnUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BronzeReferenceOSVVulnUpdateOne) sqlSave(ctx context.Context) (_node *BronzeReferenceOSVVuln, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bronzereferenceosvvuln.Table, bronzereferenceosvvuln.Columns, sqlgraph.NewFieldSpec(bronzereferenceosvvuln.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`reference: missing "BronzeReferenceOSVVuln.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzereferenceosvvuln.FieldID)
+		for _, f := range fields {
+			if !bronzereferenceosvvuln.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("reference: invalid field %q for query", f)}
+			}
+			if f != bronzereferenceosvvuln.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Summary(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldSummary, field.TypeString, value)
+	}
+	if _u.mutation.SummaryCleared() {
+		_spec.ClearField(bronzereferenceosvvuln.FieldSummary, field.TypeString)
+	}
+	if value, ok := _u.mutation.Details(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldDetails, field.TypeString, value)
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(bronzereferenceosvvuln.FieldDetails, field.TypeString)
+	}
+	if value, ok := _u.mutation.Aliases(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldAliases, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAliases(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzereferenceosvvuln.FieldAliases, value)
+		})
+	}
+	if _u.mutation.AliasesCleared() {
+		_spec.ClearField(bronzereferenceosvvuln.FieldAliases, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SeverityType(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldSeverityType, field.TypeString, value)
+	}
+	if _u.mutation.SeverityTypeCleared() {
+		_spec.ClearField(bronzereferenceosvvuln.FieldSeverityType, field.TypeString)
+	}
+	if value, ok := _u.mutation.SeverityScore(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldSeverityScore, field.TypeString, value)
+	}
+	if _u.mutation.SeverityScoreCleared() {
+		_spec.ClearField(bronzereferenceosvvuln.FieldSeverityScore, field.TypeString)
+	}
+	if value, ok := _u.mutation.DatabaseSeverity(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldDatabaseSeverity, field.TypeString, value)
+	}
+	if _u.mutation.DatabaseSeverityCleared() {
+		_spec.ClearField(bronzereferenceosvvuln.FieldDatabaseSeverity, field.TypeString)
+	}
+	if value, ok := _u.mutation.Published(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldPublished, field.TypeTime, value)
+	}
+	if _u.mutation.PublishedCleared() {
+		_spec.ClearField(bronzereferenceosvvuln.FieldPublished, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Modified(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldModified, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Withdrawn(); ok {
+		_spec.SetField(bronzereferenceosvvuln.FieldWithdrawn, field.TypeTime, value)
+	}
+	if _u.mutation.WithdrawnCleared() {
+		_spec.ClearField(bronzereferenceosvvuln.FieldWithdrawn, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeReferenceOSVVuln
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeReferenceOSVVuln{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzereferenceosvvuln.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencethreatintelindicator"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeReferenceThreatIntelIndicator is the model entity for the BronzeReferenceThreatIntelIndicator schema.
+type BronzeReferenceThreatIntelIndicator struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Composite ID: {feed_name}:{cidr}
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Configured feed name
+	FeedName string `json:"feed_name,omitempty"`
+	// URL or local path the indicator was loaded from
+	FeedSource string `json:"feed_source,omitempty"`
+	// text, csv or stix
+	FeedFormat string `json:"feed_format,omitempty"`
+	// scanner, tor or blocklist
+	Category string `json:"category,omitempty"`
+	// Masked prefix; single IPs are stored as /32 or /128
+	Cidr string `json:"cidr,omitempty"`
+	// Indicator name or comment from the feed, if any
+	Description string `json:"description,omitempty"`
+	// Load time plus the feed expiry, or the STIX valid_until if earlier
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeReferenceThreatIntelIndicator) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzereferencethreatintelindicator.FieldID, bronzereferencethreatintelindicator.FieldFeedName, bronzereferencethreatintelindicator.FieldFeedSource, bronzereferencethreatintelindicator.FieldFeedFormat, bronzereferencethreatintelindicator.FieldCategory, bronzereferencethreatintelindicator.FieldCidr, bronzereferencethreatintelindicator.FieldDescription:
+			values[i] = new(sql.NullString)
+		case bronzereferencethreatintelindicator.FieldCollectedAt, bronzereferencethreatintelindicator.FieldFirstCollectedAt, bronzereferencethreatintelindicator.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeReferenceThreatIntelIndicator fields.
+func (_m *BronzeReferenceThreatIntelIndicator) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzereferencethreatintelindicator.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzereferencethreatintelindicator.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzereferencethreatintelindicator.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzereferencethreatintelindicator.FieldFeedName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field feed_name", values[i])
+			} else if value.Valid {
+				_m.FeedName = value.String
+			}
+		case bronzereferencethreatintelindicator.FieldFeedSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field feed_source", values[i])
+			} else if value.Valid {
+				_m.FeedSource = value.String
+			}
+		case bronzereferencethreatintelindicator.FieldFeedFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field feed_format", values[i])
+			} else if value.Valid {
+				_m.FeedFormat = value.String
+			}
+		case bronzereferencethreatintelindicator.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = value.String
+			}
+		case bronzereferencethreatintelindicator.FieldCidr:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cidr", values[i])
+			} else if value.Valid {
+				_m.Cidr = value.String
+			}
+		case bronzereferencethreatintelindicator.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case bronzereferencethreatintelindicator.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeReferenceThreatIntelIndicator.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeReferenceThreatIntelIndicator) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeReferenceThreatIntelIndicator.
+// Note that you need to call BronzeReferenceThreatIntelIndicator.Unwrap() before calling this method if this BronzeReferenceThreatIntelIndicator
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeReferenceThreatIntelIndicator) Update() *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	return NewBronzeReferenceThreatIntelIndicatorClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeReferenceThreatIntelIndicator entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeReferenceThreatIntelIndicator) Unwrap() *BronzeReferenceThreatIntelIndicator {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("reference: BronzeReferenceThreatIntelIndicator is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeReferenceThreatIntelIndicator) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeReferenceThreatIntelIndicator(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("feed_name=")
+	builder.WriteString(_m.FeedName)
+	builder.WriteString(", ")
+	builder.WriteString("feed_source=")
+	builder.WriteString(_m.FeedSource)
+	builder.WriteString(", ")
+	builder.WriteString("feed_format=")
+	builder.WriteString(_m.FeedFormat)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(_m.Category)
+	builder.WriteString(", ")
+	builder.WriteString("cidr=")
+	builder.WriteString(_m.Cidr)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeReferenceThreatIntelIndicators is a parsable slice of BronzeReferenceThreatIntelIndicator.
+type BronzeReferenceThreatIntelIndicators []*BronzeReferenceThreatIntelIndicator
@@ -0,0 +1,124 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzereferencethreatintelindicator
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzereferencethreatintelindicator type in the database.
+	Label = "bronze_reference_threat_intel_indicator"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldFeedName holds the string denoting the feed_name field in the database.
+	FieldFeedName = "feed_name"
+	// FieldFeedSource holds the string denoting the feed_source field in the database.
+	FieldFeedSource = "feed_source"
+	// FieldFeedFormat holds the string denoting the feed_format field in the database.
+	FieldFeedFormat = "feed_format"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldCidr holds the string denoting the cidr field in the database.
+	FieldCidr = "cidr"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// Table holds the table name of the bronzereferencethreatintelindicator in the database.
+	Table = "reference_threat_intel_indicators"
+)
+
+// Columns holds all SQL columns for bronzereferencethreatintelindicator fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldFeedName,
+	FieldFeedSource,
+	FieldFeedFormat,
+	FieldCategory,
+	FieldCidr,
+	FieldDescription,
+	FieldExpiresAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// FeedNameValidator is a validator for the "feed_name" field. It is called by the builders before save.
+	FeedNameValidator func(string) error
+	// FeedSourceValidator is a validator for the "feed_source" field. It is called by the builders before save.
+	FeedSourceValidator func(string) error
+	// FeedFormatValidator is a validator for the "feed_format" field. It is called by the builders before save.
+	FeedFormatValidator func(string) error
+	// CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	CategoryValidator func(string) error
+	// CidrValidator is a validator for the "cidr" field. It is called by the builders before save.
+	CidrValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BronzeReferenceThreatIntelIndicator queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByFeedName orders the results by the feed_name field.
+func ByFeedName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeedName, opts...).ToFunc()
+}
+
+// ByFeedSource orders the results by the feed_source field.
+func ByFeedSource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeedSource, opts...).ToFunc()
+}
+
+// ByFeedFormat orders the results by the feed_format field.
+func ByFeedFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeedFormat, opts...).ToFunc()
+}
+
+// ByCategory orders the results by the category field.
+func ByCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategory, opts...).ToFunc()
+}
+
+// ByCidr orders the results by the cidr field.
+func ByCidr(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCidr, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
@@ -0,0 +1,645 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzereferencethreatintelindicator
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FeedName applies equality check predicate on the "feed_name" field. It's identical to FeedNameEQ.
+func FeedName(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldFeedName, v))
+}
+
+// FeedSource applies equality check predicate on the "feed_source" field. It's identical to FeedSourceEQ.
+func FeedSource(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldFeedSource, v))
+}
+
+// FeedFormat applies equality check predicate on the "feed_format" field. It's identical to FeedFormatEQ.
+func FeedFormat(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldFeedFormat, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldCategory, v))
+}
+
+// Cidr applies equality check predicate on the "cidr" field. It's identical to CidrEQ.
+func Cidr(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldCidr, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldDescription, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// FeedNameEQ applies the EQ predicate on the "feed_name" field.
+func FeedNameEQ(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldFeedName, v))
+}
+
+// FeedNameNEQ applies the NEQ predicate on the "feed_name" field.
+func FeedNameNEQ(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNEQ(FieldFeedName, v))
+}
+
+// FeedNameIn applies the In predicate on the "feed_name" field.
+func FeedNameIn(vs ...string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldIn(FieldFeedName, vs...))
+}
+
+// FeedNameNotIn applies the NotIn predicate on the "feed_name" field.
+func FeedNameNotIn(vs ...string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNotIn(FieldFeedName, vs...))
+}
+
+// FeedNameGT applies the GT predicate on the "feed_name" field.
+func FeedNameGT(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGT(FieldFeedName, v))
+}
+
+// FeedNameGTE applies the GTE predicate on the "feed_name" field.
+func FeedNameGTE(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGTE(FieldFeedName, v))
+}
+
+// FeedNameLT applies the LT predicate on the "feed_name" field.
+func FeedNameLT(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLT(FieldFeedName, v))
+}
+
+// FeedNameLTE applies the LTE predicate on the "feed_name" field.
+func FeedNameLTE(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLTE(FieldFeedName, v))
+}
+
+// FeedNameContains applies the Contains predicate on the "feed_name" field.
+func FeedNameContains(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldContains(FieldFeedName, v))
+}
+
+// FeedNameHasPrefix applies the HasPrefix predicate on the "feed_name" field.
+func FeedNameHasPrefix(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldHasPrefix(FieldFeedName, v))
+}
+
+// FeedNameHasSuffix applies the HasSuffix predicate on the "feed_name" field.
+func FeedNameHasSuffix(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldHasSuffix(FieldFeedName, v))
+}
+
+// FeedNameEqualFold applies the EqualFold predicate on the "feed_name" field.
+func FeedNameEqualFold(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEqualFold(FieldFeedName, v))
+}
+
+// FeedNameContainsFold applies the ContainsFold predicate on the "feed_name" field.
+func FeedNameContainsFold(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldContainsFold(FieldFeedName, v))
+}
+
+// FeedSourceEQ applies the EQ predicate on the "feed_source" field.
+func FeedSourceEQ(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldFeedSource, v))
+}
+
+// FeedSourceNEQ applies the NEQ predicate on the "feed_source" field.
+func FeedSourceNEQ(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNEQ(FieldFeedSource, v))
+}
+
+// FeedSourceIn applies the In predicate on the "feed_source" field.
+func FeedSourceIn(vs ...string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldIn(FieldFeedSource, vs...))
+}
+
+// FeedSourceNotIn applies the NotIn predicate on the "feed_source" field.
+func FeedSourceNotIn(vs ...string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNotIn(FieldFeedSource, vs...))
+}
+
+// FeedSourceGT applies the GT predicate on the "feed_source" field.
+func FeedSourceGT(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGT(FieldFeedSource, v))
+}
+
+// FeedSourceGTE applies the GTE predicate on the "feed_source" field.
+func FeedSourceGTE(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGTE(FieldFeedSource, v))
+}
+
+// FeedSourceLT applies the LT predicate on the "feed_source" field.
+func FeedSourceLT(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLT(FieldFeedSource, v))
+}
+
+// FeedSourceLTE applies the LTE predicate on the "feed_source" field.
+func FeedSourceLTE(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLTE(FieldFeedSource, v))
+}
+
+// FeedSourceContains applies the Contains predicate on the "feed_source" field.
+func FeedSourceContains(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldContains(FieldFeedSource, v))
+}
+
+// FeedSourceHasPrefix applies the HasPrefix predicate on the "feed_source" field.
+func FeedSourceHasPrefix(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldHasPrefix(FieldFeedSource, v))
+}
+
+// FeedSourceHasSuffix applies the HasSuffix predicate on the "feed_source" field.
+func FeedSourceHasSuffix(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldHasSuffix(FieldFeedSource, v))
+}
+
+// FeedSourceEqualFold applies the EqualFold predicate on the "feed_source" field.
+func FeedSourceEqualFold(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEqualFold(FieldFeedSource, v))
+}
+
+// FeedSourceContainsFold applies the ContainsFold predicate on the "feed_source" field.
+func FeedSourceContainsFold(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldContainsFold(FieldFeedSource, v))
+}
+
+// FeedFormatEQ applies the EQ predicate on the "feed_format" field.
+func FeedFormatEQ(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldFeedFormat, v))
+}
+
+// FeedFormatNEQ applies the NEQ predicate on the "feed_format" field.
+func FeedFormatNEQ(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNEQ(FieldFeedFormat, v))
+}
+
+// FeedFormatIn applies the In predicate on the "feed_format" field.
+func FeedFormatIn(vs ...string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldIn(FieldFeedFormat, vs...))
+}
+
+// FeedFormatNotIn applies the NotIn predicate on the "feed_format" field.
+func FeedFormatNotIn(vs ...string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNotIn(FieldFeedFormat, vs...))
+}
+
+// FeedFormatGT applies the GT predicate on the "feed_format" field.
+func FeedFormatGT(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGT(FieldFeedFormat, v))
+}
+
+// FeedFormatGTE applies the GTE predicate on the "feed_format" field.
+func FeedFormatGTE(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGTE(FieldFeedFormat, v))
+}
+
+// FeedFormatLT applies the LT predicate on the "feed_format" field.
+func FeedFormatLT(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLT(FieldFeedFormat, v))
+}
+
+// FeedFormatLTE applies the LTE predicate on the "feed_format" field.
+func FeedFormatLTE(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLTE(FieldFeedFormat, v))
+}
+
+// FeedFormatContains applies the Contains predicate on the "feed_format" field.
+func FeedFormatContains(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldContains(FieldFeedFormat, v))
+}
+
+// FeedFormatHasPrefix applies the HasPrefix predicate on the "feed_format" field.
+func FeedFormatHasPrefix(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldHasPrefix(FieldFeedFormat, v))
+}
+
+// FeedFormatHasSuffix applies the HasSuffix predicate on the "feed_format" field.
+func FeedFormatHasSuffix(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldHasSuffix(FieldFeedFormat, v))
+}
+
+// FeedFormatEqualFold applies the EqualFold predicate on the "feed_format" field.
+func FeedFormatEqualFold(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEqualFold(FieldFeedFormat, v))
+}
+
+// FeedFormatContainsFold applies the ContainsFold predicate on the "feed_format" field.
+func FeedFormatContainsFold(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldContainsFold(FieldFeedFormat, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// CidrEQ applies the EQ predicate on the "cidr" field.
+func CidrEQ(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldCidr, v))
+}
+
+// CidrNEQ applies the NEQ predicate on the "cidr" field.
+func CidrNEQ(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNEQ(FieldCidr, v))
+}
+
+// CidrIn applies the In predicate on the "cidr" field.
+func CidrIn(vs ...string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldIn(FieldCidr, vs...))
+}
+
+// CidrNotIn applies the NotIn predicate on the "cidr" field.
+func CidrNotIn(vs ...string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNotIn(FieldCidr, vs...))
+}
+
+// CidrGT applies the GT predicate on the "cidr" field.
+func CidrGT(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGT(FieldCidr, v))
+}
+
+// CidrGTE applies the GTE predicate on the "cidr" field.
+func CidrGTE(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGTE(FieldCidr, v))
+}
+
+// CidrLT applies the LT predicate on the "cidr" field.
+func CidrLT(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLT(FieldCidr, v))
+}
+
+// CidrLTE applies the LTE predicate on the "cidr" field.
+func CidrLTE(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLTE(FieldCidr, v))
+}
+
+// CidrContains applies the Contains predicate on the "cidr" field.
+func CidrContains(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldContains(FieldCidr, v))
+}
+
+// CidrHasPrefix applies the HasPrefix predicate on the "cidr" field.
+func CidrHasPrefix(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldHasPrefix(FieldCidr, v))
+}
+
+// CidrHasSuffix applies the HasSuffix predicate on the "cidr" field.
+func CidrHasSuffix(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldHasSuffix(FieldCidr, v))
+}
+
+// CidrEqualFold applies the EqualFold predicate on the "cidr" field.
+func CidrEqualFold(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEqualFold(FieldCidr, v))
+}
+
+// CidrContainsFold applies the ContainsFold predicate on the "cidr" field.
+func CidrContainsFold(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldContainsFold(FieldCidr, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeReferenceThreatIntelIndicator) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeReferenceThreatIntelIndicator) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeReferenceThreatIntelIndicator) predicate.BronzeReferenceThreatIntelIndicator {
+	return predicate.BronzeReferenceThreatIntelIndicator(sql.NotPredicates(p))
+}
@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencethreatintelindicator"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeReferenceThreatIntelIndicatorCreate is the builder for creating a BronzeReferenceThreatIntelIndicator entity.
+type BronzeReferenceThreatIntelIndicatorCreate struct {
+	config
+	mutation *BronzeReferenceThreatIntelIndicatorMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeReferenceThreatIntelIndicatorCreate) SetCollectedAt(v time.Time) *BronzeReferenceThreatIntelIndicatorCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeReferenceThreatIntelIndicatorCreate) SetFirstCollectedAt(v time.Time) *BronzeReferenceThreatIntelIndicatorCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetFeedName sets the "feed_name" field.
+func (_c *BronzeReferenceThreatIntelIndicatorCreate) SetFeedName(v string) *BronzeReferenceThreatIntelIndicatorCreate {
+	_c.mutation.SetFeedName(v)
+	return _c
+}
+
+// SetFeedSource sets the "feed_source" field.
+func (_c *BronzeReferenceThreatIntelIndicatorCreate) SetFeedSource(v string) *BronzeReferenceThreatIntelIndicatorCreate {
+	_c.mutation.SetFeedSource(v)
+	return _c
+}
+
+// SetFeedFormat sets the "feed_format" field.
+func (_c *BronzeReferenceThreatIntelIndicatorCreate) SetFeedFormat(v string) *BronzeReferenceThreatIntelIndicatorCreate {
+	_c.mutation.SetFeedFormat(v)
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *BronzeReferenceThreatIntelIndicatorCreate) SetCategory(v string) *BronzeReferenceThreatIntelIndicatorCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetCidr sets the "cidr" field.
+func (_c *BronzeReferenceThreatIntelIndicatorCreate) SetCidr(v string) *BronzeReferenceThreatIntelIndicatorCreate {
+	_c.mutation.SetCidr(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BronzeReferenceThreatIntelIndicatorCreate) SetDescription(v string) *BronzeReferenceThreatIntelIndicatorCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BronzeReferenceThreatIntelIndicatorCreate) SetNillableDescription(v *string) *BronzeReferenceThreatIntelIndicatorCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *BronzeReferenceThreatIntelIndicatorCreate) SetExpiresAt(v time.Time) *BronzeReferenceThreatIntelIndicatorCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeReferenceThreatIntelIndicatorCreate) SetID(v string) *BronzeReferenceThreatIntelIndicatorCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeReferenceThreatIntelIndicatorMutation object of the builder.
+func (_c *BronzeReferenceThreatIntelIndicatorCreate) Mutation() *BronzeReferenceThreatIntelIndicatorMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeReferenceThreatIntelIndicator in the database.
+func (_c *BronzeReferenceThreatIntelIndicatorCreate) Save(ctx context.Context) (*BronzeReferenceThreatIntelIndicator, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeReferenceThreatIntelIndicatorCreate) SaveX(ctx context.Context) *BronzeReferenceThreatIntelIndicator {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeReferenceThreatIntelIndicatorCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeReferenceThreatIntelIndicatorCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeReferenceThreatIntelIndicatorCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`reference: missing required field "BronzeReferenceThreatIntelIndicator.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`reference: missing required field "BronzeReferenceThreatIntelIndicator.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.FeedName(); !ok {
+		return &ValidationError{Name: "feed_name", err: errors.New(`reference: missing required field "BronzeReferenceThreatIntelIndicator.feed_name"`)}
+	}
+	if v, ok := _c.mutation.FeedName(); ok {
+		if err := bronzereferencethreatintelindicator.FeedNameValidator(v); err != nil {
+			return &ValidationError{Name: "feed_name", err: fmt.Errorf(`reference: validator failed for field "BronzeReferenceThreatIntelIndicator.feed_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FeedSource(); !ok {
+		return &ValidationError{Name: "feed_source", err: errors.New(`reference: missing required field "BronzeReferenceThreatIntelIndicator.feed_source"`)}
+	}
+	if v, ok := _c.mutation.FeedSource(); ok {
+		if err := bronzereferencethreatintelindicator.FeedSourceValidator(v); err != nil {
+			return &ValidationError{Name: "feed_source", err: fmt.Errorf(`reference: validator failed for field "BronzeReferenceThreatIntelIndicator.feed_source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FeedFormat(); !ok {
+		return &ValidationError{Name: "feed_format", err: errors.New(`reference: missing required field "BronzeReferenceThreatIntelIndicator.feed_format"`)}
+	}
+	if v, ok := _c.mutation.FeedFormat(); ok {
+		if err := bronzereferencethreatintelindicator.FeedFormatValidator(v); err != nil {
+			return &ValidationError{Name: "feed_format", err: fmt.Errorf(`reference: validator failed for field "BronzeReferenceThreatIntelIndicator.feed_format": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`reference: missing required field "BronzeReferenceThreatIntelIndicator.category"`)}
+	}
+	if v, ok := _c.mutation.Category(); ok {
+		if err := bronzereferencethreatintelindicator.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`reference: validator failed for field "BronzeReferenceThreatIntelIndicator.category": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Cidr(); !ok {
+		return &ValidationError{Name: "cidr", err: errors.New(`reference: missing required field "BronzeReferenceThreatIntelIndicator.cidr"`)}
+	}
+	if v, ok := _c.mutation.Cidr(); ok {
+		if err := bronzereferencethreatintelindicator.CidrValidator(v); err != nil {
+			return &ValidationError{Name: "cidr", err: fmt.Errorf(`reference: validator failed for field "BronzeReferenceThreatIntelIndicator.cidr": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`reference: missing required field "BronzeReferenceThreatIntelIndicator.expires_at"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeReferenceThreatIntelIndicatorCreate) sqlSave(ctx context.Context) (*BronzeReferenceThreatIntelIndicator, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeReferenceThreatIntelIndicator.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeReferenceThreatIntelIndicatorCreate) createSpec() (*BronzeReferenceThreatIntelIndicator, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeReferenceThreatIntelIndicator{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzereferencethreatintelindicator.Table, sqlgraph.NewFieldSpec(bronzereferencethreatintelindicator.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeReferenceThreatIntelIndicator
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.FeedName(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldFeedName, field.TypeString, value)
+		_node.FeedName = value
+	}
+	if value, ok := _c.mutation.FeedSource(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldFeedSource, field.TypeString, value)
+		_node.FeedSource = value
+	}
+	if value, ok := _c.mutation.FeedFormat(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldFeedFormat, field.TypeString, value)
+		_node.FeedFormat = value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
+	if value, ok := _c.mutation.Cidr(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldCidr, field.TypeString, value)
+		_node.Cidr = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// BronzeReferenceThreatIntelIndicatorCreateBulk is the builder for creating many BronzeReferenceThreatIntelIndicator entities in bulk.
+type BronzeReferenceThreatIntelIndicatorCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeReferenceThreatIntelIndicatorCreate
+}
+
+// Save creates the BronzeReferenceThreatIntelIndicator entities in the database.
+func (_c *BronzeReferenceThreatIntelIndicatorCreateBulk) Save(ctx context.Context) ([]*BronzeReferenceThreatIntelIndicator, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeReferenceThreatIntelIndicator, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeReferenceThreatIntelIndicatorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeReferenceThreatIntelIndicatorCreateBulk) SaveX(ctx context.Context) []*BronzeReferenceThreatIntelIndicator {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeReferenceThreatIntelIndicatorCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeReferenceThreatIntelIndicatorCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencethreatintelindicator"
+	"danny.vn/hotpot/pkg/storage/ent/reference/internal"
+	"danny.vn/hotpot/pkg/storage/ent/reference/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeReferenceThreatIntelIndicatorDelete is the builder for deleting a BronzeReferenceThreatIntelIndicator entity.
+type BronzeReferenceThreatIntelIndicatorDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeReferenceThreatIntelIndicatorMutation
+}
+
+// Where appends a list predicates to the BronzeReferenceThreatIntelIndicatorDelete builder.
+func (_d *BronzeReferenceThreatIntelIndicatorDelete) Where(ps ...predicate.BronzeReferenceThreatIntelIndicator) *BronzeReferenceThreatIntelIndicatorDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeReferenceThreatIntelIndicatorDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeReferenceThreatIntelIndicatorDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeReferenceThreatIntelIndicatorDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzereferencethreatintelindicator.Table, sqlgraph.NewFieldSpec(bronzereferencethreatintelindicator.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.BronzeReferenceThreatIntelIndicator
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeReferenceThreatIntelIndicatorDeleteOne is the builder for deleting a single BronzeReferenceThreatIntelIndicator entity.
+type BronzeReferenceThreatIntelIndicatorDeleteOne struct {
+	_d *BronzeReferenceThreatIntelIndicatorDelete
+}
+
+// Where appends a list predicates to the BronzeReferenceThreatIntelIndicatorDelete builder.
+func (_d *BronzeReferenceThreatIntelIndicatorDeleteOne) Where(ps ...predicate.BronzeReferenceThreatIntelIndicator) *BronzeReferenceThreatIntelIndicatorDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeReferenceThreatIntelIndicatorDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzereferencethreatintelindicator.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeReferenceThreatIntelIndicatorDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,537 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reference
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencethreatintelindicator"
+	"danny.vn/hotpot/pkg/storage/ent/reference/internal"
+	"danny.vn/hotpot/pkg/storage/ent/reference/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeReferenceThreatIntelIndicatorUpdate is the builder for updating BronzeReferenceThreatIntelIndicator entities.
+type BronzeReferenceThreatIntelIndicatorUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeReferenceThreatIntelIndicatorMutation
+}
+
+// Where appends a list predicates to the BronzeReferenceThreatIntelIndicatorUpdate builder.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) Where(ps ...predicate.BronzeReferenceThreatIntelIndicator) *BronzeReferenceThreatIntelIndicatorUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) SetCollectedAt(v time.Time) *BronzeReferenceThreatIntelIndicatorUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) SetNillableCollectedAt(v *time.Time) *BronzeReferenceThreatIntelIndicatorUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFeedName sets the "feed_name" field.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) SetFeedName(v string) *BronzeReferenceThreatIntelIndicatorUpdate {
+	_u.mutation.SetFeedName(v)
+	return _u
+}
+
+// SetNillableFeedName sets the "feed_name" field if the given value is not nil.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) SetNillableFeedName(v *string) *BronzeReferenceThreatIntelIndicatorUpdate {
+	if v != nil {
+		_u.SetFeedName(*v)
+	}
+	return _u
+}
+
+// SetFeedSource sets the "feed_source" field.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) SetFeedSource(v string) *BronzeReferenceThreatIntelIndicatorUpdate {
+	_u.mutation.SetFeedSource(v)
+	return _u
+}
+
+// SetNillableFeedSource sets the "feed_source" field if the given value is not nil.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) SetNillableFeedSource(v *string) *BronzeReferenceThreatIntelIndicatorUpdate {
+	if v != nil {
+		_u.SetFeedSource(*v)
+	}
+	return _u
+}
+
+// SetFeedFormat sets the "feed_format" field.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) SetFeedFormat(v string) *BronzeReferenceThreatIntelIndicatorUpdate {
+	_u.mutation.SetFeedFormat(v)
+	return _u
+}
+
+// SetNillableFeedFormat sets the "feed_format" field if the given value is not nil.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) SetNillableFeedFormat(v *string) *BronzeReferenceThreatIntelIndicatorUpdate {
+	if v != nil {
+		_u.SetFeedFormat(*v)
+	}
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) SetCategory(v string) *BronzeReferenceThreatIntelIndicatorUpdate {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) SetNillableCategory(v *string) *BronzeReferenceThreatIntelIndicatorUpdate {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetCidr sets the "cidr" field.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) SetCidr(v string) *BronzeReferenceThreatIntelIndicatorUpdate {
+	_u.mutation.SetCidr(v)
+	return _u
+}
+
+// SetNillableCidr sets the "cidr" field if the given value is not nil.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) SetNillableCidr(v *string) *BronzeReferenceThreatIntelIndicatorUpdate {
+	if v != nil {
+		_u.SetCidr(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) SetDescription(v string) *BronzeReferenceThreatIntelIndicatorUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) SetNillableDescription(v *string) *BronzeReferenceThreatIntelIndicatorUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) ClearDescription() *BronzeReferenceThreatIntelIndicatorUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) SetExpiresAt(v time.Time) *BronzeReferenceThreatIntelIndicatorUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) SetNillableExpiresAt(v *time.Time) *BronzeReferenceThreatIntelIndicatorUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeReferenceThreatIntelIndicatorMutation object of the builder.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) Mutation() *BronzeReferenceThreatIntelIndicatorMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) check() error {
+	if v, ok := _u.mutation.FeedName(); ok {
+		if err := bronzereferencethreatintelindicator.FeedNameValidator(v); err != nil {
+			return &ValidationError{Name: "feed_name", err: fmt.Errorf(`reference: validator failed for field "BronzeReferenceThreatIntelIndicator.feed_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FeedSource(); ok {
+		if err := bronzereferencethreatintelindicator.FeedSourceValidator(v); err != nil {
+			return &ValidationError{Name: "feed_source", err: fmt.Errorf(`reference: validator failed for field "BronzeReferenceThreatIntelIndicator.feed_source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FeedFormat(); ok {
+		if err := bronzereferencethreatintelindicator.FeedFormatValidator(v); err != nil {
+			return &ValidationError{Name: "feed_format", err: fmt.Errorf(`reference: validator failed for field "BronzeReferenceThreatIntelIndicator.feed_format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := bronzereferencethreatintelindicator.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`reference: validator failed for field "BronzeReferenceThreatIntelIndicator.category": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Cidr(); ok {
+		if err := bronzereferencethreatintelindicator.CidrValidator(v); err != nil {
+			return &ValidationError{Name: "cidr", err: fmt.Errorf(`reference: validator failed for field "BronzeReferenceThreatIntelIndicator.cidr": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeReferenceThreatIntelIndicatorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzereferencethreatintelindicator.Table, bronzereferencethreatintelindicator.Columns, sqlgraph.NewFieldSpec(bronzereferencethreatintelindicator.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FeedName(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldFeedName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FeedSource(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldFeedSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FeedFormat(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldFeedFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Cidr(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldCidr, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzereferencethreatintelindicator.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldExpiresAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeReferenceThreatIntelIndicator
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzereferencethreatintelindicator.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeReferenceThreatIntelIndicatorUpdateOne is the builder for updating a single BronzeReferenceThreatIntelIndicator entity.
+type BronzeReferenceThreatIntelIndicatorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeReferenceThreatIntelIndicatorMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) SetCollectedAt(v time.Time) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFeedName sets the "feed_name" field.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) SetFeedName(v string) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	_u.mutation.SetFeedName(v)
+	return _u
+}
+
+// SetNillableFeedName sets the "feed_name" field if the given value is not nil.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) SetNillableFeedName(v *string) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	if v != nil {
+		_u.SetFeedName(*v)
+	}
+	return _u
+}
+
+// SetFeedSource sets the "feed_source" field.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) SetFeedSource(v string) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	_u.mutation.SetFeedSource(v)
+	return _u
+}
+
+// SetNillableFeedSource sets the "feed_source" field if the given value is not nil.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) SetNillableFeedSource(v *string) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	if v != nil {
+		_u.SetFeedSource(*v)
+	}
+	return _u
+}
+
+// SetFeedFormat sets the "feed_format" field.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) SetFeedFormat(v string) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	_u.mutation.SetFeedFormat(v)
+	return _u
+}
+
+// SetNillableFeedFormat sets the "feed_format" field if the given value is not nil.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) SetNillableFeedFormat(v *string) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	if v != nil {
+		_u.SetFeedFormat(*v)
+	}
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) SetCategory(v string) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) SetNillableCategory(v *string) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetCidr sets the "cidr" field.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) SetCidr(v string) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	_u.mutation.SetCidr(v)
+	return _u
+}
+
+// SetNillableCidr sets the "cidr" field if the given value is not nil.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) SetNillableCidr(v *string) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	if v != nil {
+		_u.SetCidr(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) SetDescription(v string) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) SetNillableDescription(v *string) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) ClearDescription() *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) SetExpiresAt(v time.Time) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) SetNillableExpiresAt(v *time.Time) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeReferenceThreatIntelIndicatorMutation object of the builder.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) Mutation() *BronzeReferenceThreatIntelIndicatorMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeReferenceThreatIntelIndicatorUpdate builder.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) Where(ps ...predicate.BronzeReferenceThreatIntelIndicator) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) Select(field string, fields ...string) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeReferenceThreatIntelIndicator entity.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) Save(ctx context.Context) (*BronzeReferenceThreatIntelIndicator, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) SaveX(ctx context.Context) *BronzeReferenceThreatIntelIndicator {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) check() error {
+	if v, ok := _u.mutation.FeedName(); ok {
+		if err := bronzereferencethreatintelindicator.FeedNameValidator(v); err != nil {
+			return &ValidationError{Name: "feed_name", err: fmt.Errorf(`reference: validator failed for field "BronzeReferenceThreatIntelIndicator.feed_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FeedSource(); ok {
+		if err := bronzereferencethreatintelindicator.FeedSourceValidator(v); err != nil {
+			return &ValidationError{Name: "feed_source", err: fmt.Errorf(`reference: validator failed for field "BronzeReferenceThreatIntelIndicator.feed_source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FeedFormat(); ok {
+		if err := bronzereferencethreatintelindicator.FeedFormatValidator(v); err != nil {
+			return &ValidationError{Name: "feed_format", err: fmt.Errorf(`reference: validator failed for field "BronzeReferenceThreatIntelIndicator.feed_format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := bronzereferencethreatintelindicator.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`reference: validator failed for field "BronzeReferenceThreatIntelIndicator.category": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Cidr(); ok {
+		if err := bronzereferencethreatintelindicator.CidrValidator(v); err != nil {
+			return &ValidationError{Name: "cidr", err: fmt.Errorf(`reference: validator failed for field "BronzeReferenceThreatIntelIndicator.cidr": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeReferenceThreatIntelIndicatorUpdateOne) sqlSave(ctx context.Context) (_node *BronzeReferenceThreatIntelIndicator, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzereferencethreatintelindicator.Table, bronzereferencethreatintelindicator.Columns, sqlgraph.NewFieldSpec(bronzereferencethreatintelindicator.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`reference: missing "BronzeReferenceThreatIntelIndicator.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzereferencethreatintelindicator.FieldID)
+		for _, f := range fields {
+			if !bronzereferencethreatintelindicator.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("reference: invalid field %q for query", f)}
+			}
+			if f != bronzereferencethreatintelindicator.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FeedName(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldFeedName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FeedSource(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldFeedSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FeedFormat(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldFeedFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Cidr(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldCidr, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzereferencethreatintelindicator.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(bronzereferencethreatintelindicator.FieldExpiresAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeReferenceThreatIntelIndicator
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeReferenceThreatIntelIndicator{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzereferencethreatintelindicator.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -24,6 +24,8 @@ type BronzeReferenceUbuntuPackage struct {
 	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
 	// Package name (e.g. rsyslog)
 	PackageName string `json:"package_name,omitempty"`
+	// Source package when it differs from package_name (e.g. openssl for libssl3)
+	SourcePackage string `json:"source_package,omitempty"`
 	// Ubuntu release codename (e.g. noble, jammy)
 	Release string `json:"release,omitempty"`
 	// Repository component (main, universe)
@@ -40,7 +42,7 @@ func (*BronzeReferenceUbuntuPackage) scanValues(columns []string) ([]any, error)
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case bronzereferenceubuntupackage.FieldID, bronzereferenceubuntupackage.FieldPackageName, bronzereferenceubuntupackage.FieldRelease, bronzereferenceubuntupackage.FieldComponent, bronzereferenceubuntupackage.FieldSection, bronzereferenceubuntupackage.FieldDescription:
+		case bronzereferenceubuntupackage.FieldID, bronzereferenceubuntupackage.FieldPackageName, bronzereferenceubuntupackage.FieldSourcePackage, bronzereferenceubuntupackage.FieldRelease, bronzereferenceubuntupackage.FieldComponent, bronzereferenceubuntupackage.FieldSection, bronzereferenceubuntupackage.FieldDescription:
 			values[i] = new(sql.NullString)
 		case bronzereferenceubuntupackage.FieldCollectedAt, bronzereferenceubuntupackage.FieldFirstCollectedAt:
 			values[i] = new(sql.NullTime)
@@ -83,6 +85,12 @@ func (_m *BronzeReferenceUbuntuPackage) assignValues(columns []string, values []
 			} else if value.Valid {
 				_m.PackageName = value.String
 			}
+		case bronzereferenceubuntupackage.FieldSourcePackage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_package", values[i])
+			} else if value.Valid {
+				_m.SourcePackage = value.String
+			}
 		case bronzereferenceubuntupackage.FieldRelease:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field release", values[i])
@@ -152,6 +160,9 @@ func (_m *BronzeReferenceUbuntuPackage) String() string {
 	builder.WriteString("package_name=")
 	builder.WriteString(_m.PackageName)
 	builder.WriteString(", ")
+	builder.WriteString("source_package=")
+	builder.WriteString(_m.SourcePackage)
+	builder.WriteString(", ")
 	builder.WriteString("release=")
 	builder.WriteString(_m.Release)
 	builder.WriteString(", ")
@@ -17,6 +17,8 @@ const (
 	FieldFirstCollectedAt = "first_collected_at"
 	// FieldPackageName holds the string denoting the package_name field in the database.
 	FieldPackageName = "package_name"
+	// FieldSourcePackage holds the string denoting the source_package field in the database.
+	FieldSourcePackage = "source_package"
 	// FieldRelease holds the string denoting the release field in the database.
 	FieldRelease = "release"
 	// FieldComponent holds the string denoting the component field in the database.
@@ -35,6 +37,7 @@ var Columns = []string{
 	FieldCollectedAt,
 	FieldFirstCollectedAt,
 	FieldPackageName,
+	FieldSourcePackage,
 	FieldRelease,
 	FieldComponent,
 	FieldSection,
@@ -74,6 +77,11 @@ func ByPackageName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPackageName, opts...).ToFunc()
 }
 
+// BySourcePackage orders the results by the source_package field.
+func BySourcePackage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourcePackage, opts...).ToFunc()
+}
+
 // ByRelease orders the results by the release field.
 func ByRelease(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRelease, opts...).ToFunc()
@@ -79,6 +79,11 @@ func PackageName(v string) predicate.BronzeReferenceUbuntuPackage {
 	return predicate.BronzeReferenceUbuntuPackage(sql.FieldEQ(FieldPackageName, v))
 }
 
+// SourcePackage applies equality check predicate on the "source_package" field. It's identical to SourcePackageEQ.
+func SourcePackage(v string) predicate.BronzeReferenceUbuntuPackage {
+	return predicate.BronzeReferenceUbuntuPackage(sql.FieldEQ(FieldSourcePackage, v))
+}
+
 // Release applies equality check predicate on the "release" field. It's identical to ReleaseEQ.
 func Release(v string) predicate.BronzeReferenceUbuntuPackage {
 	return predicate.BronzeReferenceUbuntuPackage(sql.FieldEQ(FieldRelease, v))
@@ -244,6 +249,81 @@ func PackageNameContainsFold(v string) predicate.BronzeReferenceUbuntuPackage {
 	return predicate.BronzeReferenceUbuntuPackage(sql.FieldContainsFold(FieldPackageName, v))
 }
 
+// SourcePackageEQ applies the EQ predicate on the "source_package" field.
+func SourcePackageEQ(v string) predicate.BronzeReferenceUbuntuPackage {
+	return predicate.BronzeReferenceUbuntuPackage(sql.FieldEQ(FieldSourcePackage, v))
+}
+
+// SourcePackageNEQ applies the NEQ predicate on the "source_package" field.
+func SourcePackageNEQ(v string) predicate.BronzeReferenceUbuntuPackage {
+	return predicate.BronzeReferenceUbuntuPackage(sql.FieldNEQ(FieldSourcePackage, v))
+}
+
+// SourcePackageIn applies the In predicate on the "source_package" field.
+func SourcePackageIn(vs ...string) predicate.BronzeReferenceUbuntuPackage {
+	return predicate.BronzeReferenceUbuntuPackage(sql.FieldIn(FieldSourcePackage, vs...))
+}
+
+// SourcePackageNotIn applies the NotIn predicate on the "source_package" field.
+func SourcePackageNotIn(vs ...string) predicate.BronzeReferenceUbuntuPackage {
+	return predicate.BronzeReferenceUbuntuPackage(sql.FieldNotIn(FieldSourcePackage, vs...))
+}
+
+// SourcePackageGT applies the GT predicate on the "source_package" field.
+func SourcePackageGT(v string) predicate.BronzeReferenceUbuntuPackage {
+	return predicate.BronzeReferenceUbuntuPackage(sql.FieldGT(FieldSourcePackage, v))
+}
+
+// SourcePackageGTE applies the GTE predicate on the "source_package" field.
+func SourcePackageGTE(v string) predicate.BronzeReferenceUbuntuPackage {
+	return predicate.BronzeReferenceUbuntuPackage(sql.FieldGTE(FieldSourcePackage, v))
+}
+
+// SourcePackageLT applies the LT predicate on the "source_package" field.
+func SourcePackageLT(v string) predicate.BronzeReferenceUbuntuPackage {
+	return predicate.BronzeReferenceUbuntuPackage(sql.FieldLT(FieldSourcePackage, v))
+}
+
+// SourcePackageLTE applies the LTE predicate on the "source_package" field.
+func SourcePackageLTE(v string) predicate.BronzeReferenceUbuntuPackage {
+	return predicate.BronzeReferenceUbuntuPackage(sql.FieldLTE(FieldSourcePackage, v))
+}
+
+// SourcePackageContains applies the Contains predicate on the "source_package" field.
+func SourcePackageContains(v string) predicate.BronzeReferenceUbuntuPackage {
+	return predicate.BronzeReferenceUbuntuPackage(sql.FieldContains(FieldSourcePackage, v))
+}
+
+// SourcePackageHasPrefix applies the HasPrefix predicate on the "source_package" field.
+func SourcePackageHasPrefix(v string) predicate.BronzeReferenceUbuntuPackage {
+	return predicate.BronzeReferenceUbuntuPackage(sql.FieldHasPrefix(FieldSourcePackage, v))
+}
+
+// SourcePackageHasSuffix applies the HasSuffix predicate on the "source_package" field.
+func SourcePackageHasSuffix(v string) predicate.BronzeReferenceUbuntuPackage {
+	return predicate.BronzeReferenceUbuntuPackage(sql.FieldHasSuffix(FieldSourcePackage, v))
+}
+
+// SourcePackageIsNil applies the IsNil predicate on the "source_package" field.
+func SourcePackageIsNil() predicate.BronzeReferenceUbuntuPackage {
+	return predicate.BronzeReferenceUbuntuPackage(sql.FieldIsNull(FieldSourcePackage))
+}
+
+// SourcePackageNotNil applies the NotNil predicate on the "source_package" field.
+func SourcePackageNotNil() predicate.BronzeReferenceUbuntuPackage {
+	return predicate.BronzeReferenceUbuntuPackage(sql.FieldNotNull(FieldSourcePackage))
+}
+
+// SourcePackageEqualFold applies the EqualFold predicate on the "source_package" field.
+func SourcePackageEqualFold(v string) predicate.BronzeReferenceUbuntuPackage {
+	return predicate.BronzeReferenceUbuntuPackage(sql.FieldEqualFold(FieldSourcePackage, v))
+}
+
+// SourcePackageContainsFold applies the ContainsFold predicate on the "source_package" field.
+func SourcePackageContainsFold(v string) predicate.BronzeReferenceUbuntuPackage {
+	return predicate.BronzeReferenceUbuntuPackage(sql.FieldContainsFold(FieldSourcePackage, v))
+}
+
 // ReleaseEQ applies the EQ predicate on the "release" field.
 func ReleaseEQ(v string) predicate.BronzeReferenceUbuntuPackage {
 	return predicate.BronzeReferenceUbuntuPackage(sql.FieldEQ(FieldRelease, v))
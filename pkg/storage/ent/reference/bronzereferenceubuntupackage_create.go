@@ -38,6 +38,20 @@ func (_c *BronzeReferenceUbuntuPackageCreate) SetPackageName(v string) *BronzeRe
 	return _c
 }
 
+// SetSourcePackage sets the "source_package" field.
+func (_c *BronzeReferenceUbuntuPackageCreate) SetSourcePackage(v string) *BronzeReferenceUbuntuPackageCreate {
+	_c.mutation.SetSourcePackage(v)
+	return _c
+}
+
+// SetNillableSourcePackage sets the "source_package" field if the given value is not nil.
+func (_c *BronzeReferenceUbuntuPackageCreate) SetNillableSourcePackage(v *string) *BronzeReferenceUbuntuPackageCreate {
+	if v != nil {
+		_c.SetSourcePackage(*v)
+	}
+	return _c
+}
+
 // SetRelease sets the "release" field.
 func (_c *BronzeReferenceUbuntuPackageCreate) SetRelease(v string) *BronzeReferenceUbuntuPackageCreate {
 	_c.mutation.SetRelease(v)
@@ -176,6 +190,10 @@ func (_c *BronzeReferenceUbuntuPackageCreate) createSpec() (*BronzeReferenceUbun
 		_spec.SetField(bronzereferenceubuntupackage.FieldPackageName, field.TypeString, value)
 		_node.PackageName = value
 	}
+	if value, ok := _c.mutation.SourcePackage(); ok {
+		_spec.SetField(bronzereferenceubuntupackage.FieldSourcePackage, field.TypeString, value)
+		_node.SourcePackage = value
+	}
 	if value, ok := _c.mutation.Release(); ok {
 		_spec.SetField(bronzereferenceubuntupackage.FieldRelease, field.TypeString, value)
 		_node.Release = value
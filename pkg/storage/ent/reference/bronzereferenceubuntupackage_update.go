@@ -57,6 +57,26 @@ func (_u *BronzeReferenceUbuntuPackageUpdate) SetNillablePackageName(v *string)
 	return _u
 }
 
+// SetSourcePackage sets the "source_package" field.
+func (_u *BronzeReferenceUbuntuPackageUpdate) SetSourcePackage(v string) *BronzeReferenceUbuntuPackageUpdate {
+	_u.mutation.SetSourcePackage(v)
+	return _u
+}
+
+// SetNillableSourcePackage sets the "source_package" field if the given value is not nil.
+func (_u *BronzeReferenceUbuntuPackageUpdate) SetNillableSourcePackage(v *string) *BronzeReferenceUbuntuPackageUpdate {
+	if v != nil {
+		_u.SetSourcePackage(*v)
+	}
+	return _u
+}
+
+// ClearSourcePackage clears the value of the "source_package" field.
+func (_u *BronzeReferenceUbuntuPackageUpdate) ClearSourcePackage() *BronzeReferenceUbuntuPackageUpdate {
+	_u.mutation.ClearSourcePackage()
+	return _u
+}
+
 // SetRelease sets the "release" field.
 func (_u *BronzeReferenceUbuntuPackageUpdate) SetRelease(v string) *BronzeReferenceUbuntuPackageUpdate {
 	_u.mutation.SetRelease(v)
@@ -166,6 +186,12 @@ func (_u *BronzeReferenceUbuntuPackageUpdate) sqlSave(ctx context.Context) (_nod
 	if value, ok := _u.mutation.PackageName(); ok {
 		_spec.SetField(bronzereferenceubuntupackage.FieldPackageName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.SourcePackage(); ok {
+		_spec.SetField(bronzereferenceubuntupackage.FieldSourcePackage, field.TypeString, value)
+	}
+	if _u.mutation.SourcePackageCleared() {
+		_spec.ClearField(bronzereferenceubuntupackage.FieldSourcePackage, field.TypeString)
+	}
 	if value, ok := _u.mutation.Release(); ok {
 		_spec.SetField(bronzereferenceubuntupackage.FieldRelease, field.TypeString, value)
 	}
@@ -231,6 +257,26 @@ func (_u *BronzeReferenceUbuntuPackageUpdateOne) SetNillablePackageName(v *strin
 	return _u
 }
 
+// SetSourcePackage sets the "source_package" field.
+func (_u *BronzeReferenceUbuntuPackageUpdateOne) SetSourcePackage(v string) *BronzeReferenceUbuntuPackageUpdateOne {
+	_u.mutation.SetSourcePackage(v)
+	return _u
+}
+
+// SetNillableSourcePackage sets the "source_package" field if the given value is not nil.
+func (_u *BronzeReferenceUbuntuPackageUpdateOne) SetNillableSourcePackage(v *string) *BronzeReferenceUbuntuPackageUpdateOne {
+	if v != nil {
+		_u.SetSourcePackage(*v)
+	}
+	return _u
+}
+
+// ClearSourcePackage clears the value of the "source_package" field.
+func (_u *BronzeReferenceUbuntuPackageUpdateOne) ClearSourcePackage() *BronzeReferenceUbuntuPackageUpdateOne {
+	_u.mutation.ClearSourcePackage()
+	return _u
+}
+
 // SetRelease sets the "release" field.
 func (_u *BronzeReferenceUbuntuPackageUpdateOne) SetRelease(v string) *BronzeReferenceUbuntuPackageUpdateOne {
 	_u.mutation.SetRelease(v)
@@ -370,6 +416,12 @@ func (_u *BronzeReferenceUbuntuPackageUpdateOne) sqlSave(ctx context.Context) (_
 	if value, ok := _u.mutation.PackageName(); ok {
 		_spec.SetField(bronzereferenceubuntupackage.FieldPackageName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.SourcePackage(); ok {
+		_spec.SetField(bronzereferenceubuntupackage.FieldSourcePackage, field.TypeString, value)
+	}
+	if _u.mutation.SourcePackageCleared() {
+		_spec.ClearField(bronzereferenceubuntupackage.FieldSourcePackage, field.TypeString)
+	}
 	if value, ok := _u.mutation.Release(); ok {
 		_spec.SetField(bronzereferenceubuntupackage.FieldRelease, field.TypeString, value)
 	}
@@ -15,9 +15,14 @@ import (
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceeolcycle"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceeolidentifier"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceeolproduct"
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencenvdcpematch"
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencenvdcve"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceoscorerule"
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceosvaffected"
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceosvvuln"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencerpmpackage"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencesoftwarematchrule"
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencethreatintelindicator"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceubuntupackage"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencexeolcycle"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencexeolproduct"
@@ -43,12 +48,22 @@ type Client struct {
 	BronzeReferenceEOLIdentifier *BronzeReferenceEOLIdentifierClient
 	// BronzeReferenceEOLProduct is the client for interacting with the BronzeReferenceEOLProduct builders.
 	BronzeReferenceEOLProduct *BronzeReferenceEOLProductClient
+	// BronzeReferenceNVDCPEMatch is the client for interacting with the BronzeReferenceNVDCPEMatch builders.
+	BronzeReferenceNVDCPEMatch *BronzeReferenceNVDCPEMatchClient
+	// BronzeReferenceNVDCVE is the client for interacting with the BronzeReferenceNVDCVE builders.
+	BronzeReferenceNVDCVE *BronzeReferenceNVDCVEClient
 	// BronzeReferenceOSCoreRule is the client for interacting with the BronzeReferenceOSCoreRule builders.
 	BronzeReferenceOSCoreRule *BronzeReferenceOSCoreRuleClient
+	// BronzeReferenceOSVAffected is the client for interacting with the BronzeReferenceOSVAffected builders.
+	BronzeReferenceOSVAffected *BronzeReferenceOSVAffectedClient
+	// BronzeReferenceOSVVuln is the client for interacting with the BronzeReferenceOSVVuln builders.
+	BronzeReferenceOSVVuln *BronzeReferenceOSVVulnClient
 	// BronzeReferenceRPMPackage is the client for interacting with the BronzeReferenceRPMPackage builders.
 	BronzeReferenceRPMPackage *BronzeReferenceRPMPackageClient
 	// BronzeReferenceSoftwareMatchRule is the client for interacting with the BronzeReferenceSoftwareMatchRule builders.
 	BronzeReferenceSoftwareMatchRule *BronzeReferenceSoftwareMatchRuleClient
+	// BronzeReferenceThreatIntelIndicator is the client for interacting with the BronzeReferenceThreatIntelIndicator builders.
+	BronzeReferenceThreatIntelIndicator *BronzeReferenceThreatIntelIndicatorClient
 	// BronzeReferenceUbuntuPackage is the client for interacting with the BronzeReferenceUbuntuPackage builders.
 	BronzeReferenceUbuntuPackage *BronzeReferenceUbuntuPackageClient
 	// BronzeReferenceXeolCycle is the client for interacting with the BronzeReferenceXeolCycle builders.
@@ -74,9 +89,14 @@ func (c *Client) init() {
 	c.BronzeReferenceEOLCycle = NewBronzeReferenceEOLCycleClient(c.config)
 	c.BronzeReferenceEOLIdentifier = NewBronzeReferenceEOLIdentifierClient(c.config)
 	c.BronzeReferenceEOLProduct = NewBronzeReferenceEOLProductClient(c.config)
+	c.BronzeReferenceNVDCPEMatch = NewBronzeReferenceNVDCPEMatchClient(c.config)
+	c.BronzeReferenceNVDCVE = NewBronzeReferenceNVDCVEClient(c.config)
 	c.BronzeReferenceOSCoreRule = NewBronzeReferenceOSCoreRuleClient(c.config)
+	c.BronzeReferenceOSVAffected = NewBronzeReferenceOSVAffectedClient(c.config)
+	c.BronzeReferenceOSVVuln = NewBronzeReferenceOSVVulnClient(c.config)
 	c.BronzeReferenceRPMPackage = NewBronzeReferenceRPMPackageClient(c.config)
 	c.BronzeReferenceSoftwareMatchRule = NewBronzeReferenceSoftwareMatchRuleClient(c.config)
+	c.BronzeReferenceThreatIntelIndicator = NewBronzeReferenceThreatIntelIndicatorClient(c.config)
 	c.BronzeReferenceUbuntuPackage = NewBronzeReferenceUbuntuPackageClient(c.config)
 	c.BronzeReferenceXeolCycle = NewBronzeReferenceXeolCycleClient(c.config)
 	c.BronzeReferenceXeolProduct = NewBronzeReferenceXeolProductClient(c.config)
@@ -174,20 +194,25 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                              ctx,
-		config:                           cfg,
-		BronzeReferenceCPE:               NewBronzeReferenceCPEClient(cfg),
-		BronzeReferenceEOLCycle:          NewBronzeReferenceEOLCycleClient(cfg),
-		BronzeReferenceEOLIdentifier:     NewBronzeReferenceEOLIdentifierClient(cfg),
-		BronzeReferenceEOLProduct:        NewBronzeReferenceEOLProductClient(cfg),
-		BronzeReferenceOSCoreRule:        NewBronzeReferenceOSCoreRuleClient(cfg),
-		BronzeReferenceRPMPackage:        NewBronzeReferenceRPMPackageClient(cfg),
-		BronzeReferenceSoftwareMatchRule: NewBronzeReferenceSoftwareMatchRuleClient(cfg),
-		BronzeReferenceUbuntuPackage:     NewBronzeReferenceUbuntuPackageClient(cfg),
-		BronzeReferenceXeolCycle:         NewBronzeReferenceXeolCycleClient(cfg),
-		BronzeReferenceXeolProduct:       NewBronzeReferenceXeolProductClient(cfg),
-		BronzeReferenceXeolPurl:          NewBronzeReferenceXeolPurlClient(cfg),
-		BronzeReferenceXeolVuln:          NewBronzeReferenceXeolVulnClient(cfg),
+		ctx:                                 ctx,
+		config:                              cfg,
+		BronzeReferenceCPE:                  NewBronzeReferenceCPEClient(cfg),
+		BronzeReferenceEOLCycle:             NewBronzeReferenceEOLCycleClient(cfg),
+		BronzeReferenceEOLIdentifier:        NewBronzeReferenceEOLIdentifierClient(cfg),
+		BronzeReferenceEOLProduct:           NewBronzeReferenceEOLProductClient(cfg),
+		BronzeReferenceNVDCPEMatch:          NewBronzeReferenceNVDCPEMatchClient(cfg),
+		BronzeReferenceNVDCVE:               NewBronzeReferenceNVDCVEClient(cfg),
+		BronzeReferenceOSCoreRule:           NewBronzeReferenceOSCoreRuleClient(cfg),
+		BronzeReferenceOSVAffected:          NewBronzeReferenceOSVAffectedClient(cfg),
+		BronzeReferenceOSVVuln:              NewBronzeReferenceOSVVulnClient(cfg),
+		BronzeReferenceRPMPackage:           NewBronzeReferenceRPMPackageClient(cfg),
+		BronzeReferenceSoftwareMatchRule:    NewBronzeReferenceSoftwareMatchRuleClient(cfg),
+		BronzeReferenceThreatIntelIndicator: NewBronzeReferenceThreatIntelIndicatorClient(cfg),
+		BronzeReferenceUbuntuPackage:        NewBronzeReferenceUbuntuPackageClient(cfg),
+		BronzeReferenceXeolCycle:            NewBronzeReferenceXeolCycleClient(cfg),
+		BronzeReferenceXeolProduct:          NewBronzeReferenceXeolProductClient(cfg),
+		BronzeReferenceXeolPurl:             NewBronzeReferenceXeolPurlClient(cfg),
+		BronzeReferenceXeolVuln:             NewBronzeReferenceXeolVulnClient(cfg),
 	}, nil
 }
 
@@ -205,20 +230,25 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                              ctx,
-		config:                           cfg,
-		BronzeReferenceCPE:               NewBronzeReferenceCPEClient(cfg),
-		BronzeReferenceEOLCycle:          NewBronzeReferenceEOLCycleClient(cfg),
-		BronzeReferenceEOLIdentifier:     NewBronzeReferenceEOLIdentifierClient(cfg),
-		BronzeReferenceEOLProduct:        NewBronzeReferenceEOLProductClient(cfg),
-		BronzeReferenceOSCoreRule:        NewBronzeReferenceOSCoreRuleClient(cfg),
-		BronzeReferenceRPMPackage:        NewBronzeReferenceRPMPackageClient(cfg),
-		BronzeReferenceSoftwareMatchRule: NewBronzeReferenceSoftwareMatchRuleClient(cfg),
-		BronzeReferenceUbuntuPackage:     NewBronzeReferenceUbuntuPackageClient(cfg),
-		BronzeReferenceXeolCycle:         NewBronzeReferenceXeolCycleClient(cfg),
-		BronzeReferenceXeolProduct:       NewBronzeReferenceXeolProductClient(cfg),
-		BronzeReferenceXeolPurl:          NewBronzeReferenceXeolPurlClient(cfg),
-		BronzeReferenceXeolVuln:          NewBronzeReferenceXeolVulnClient(cfg),
+		ctx:                                 ctx,
+		config:                              cfg,
+		BronzeReferenceCPE:                  NewBronzeReferenceCPEClient(cfg),
+		BronzeReferenceEOLCycle:             NewBronzeReferenceEOLCycleClient(cfg),
+		BronzeReferenceEOLIdentifier:        NewBronzeReferenceEOLIdentifierClient(cfg),
+		BronzeReferenceEOLProduct:           NewBronzeReferenceEOLProductClient(cfg),
+		BronzeReferenceNVDCPEMatch:          NewBronzeReferenceNVDCPEMatchClient(cfg),
+		BronzeReferenceNVDCVE:               NewBronzeReferenceNVDCVEClient(cfg),
+		BronzeReferenceOSCoreRule:           NewBronzeReferenceOSCoreRuleClient(cfg),
+		BronzeReferenceOSVAffected:          NewBronzeReferenceOSVAffectedClient(cfg),
+		BronzeReferenceOSVVuln:              NewBronzeReferenceOSVVulnClient(cfg),
+		BronzeReferenceRPMPackage:           NewBronzeReferenceRPMPackageClient(cfg),
+		BronzeReferenceSoftwareMatchRule:    NewBronzeReferenceSoftwareMatchRuleClient(cfg),
+		BronzeReferenceThreatIntelIndicator: NewBronzeReferenceThreatIntelIndicatorClient(cfg),
+		BronzeReferenceUbuntuPackage:        NewBronzeReferenceUbuntuPackageClient(cfg),
+		BronzeReferenceXeolCycle:            NewBronzeReferenceXeolCycleClient(cfg),
+		BronzeReferenceXeolProduct:          NewBronzeReferenceXeolProductClient(cfg),
+		BronzeReferenceXeolPurl:             NewBronzeReferenceXeolPurlClient(cfg),
+		BronzeReferenceXeolVuln:             NewBronzeReferenceXeolVulnClient(cfg),
 	}, nil
 }
 
@@ -249,11 +279,13 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
 		c.BronzeReferenceCPE, c.BronzeReferenceEOLCycle, c.BronzeReferenceEOLIdentifier,
-		c.BronzeReferenceEOLProduct, c.BronzeReferenceOSCoreRule,
+		c.BronzeReferenceEOLProduct, c.BronzeReferenceNVDCPEMatch,
+		c.BronzeReferenceNVDCVE, c.BronzeReferenceOSCoreRule,
+		c.BronzeReferenceOSVAffected, c.BronzeReferenceOSVVuln,
 		c.BronzeReferenceRPMPackage, c.BronzeReferenceSoftwareMatchRule,
-		c.BronzeReferenceUbuntuPackage, c.BronzeReferenceXeolCycle,
-		c.BronzeReferenceXeolProduct, c.BronzeReferenceXeolPurl,
-		c.BronzeReferenceXeolVuln,
+		c.BronzeReferenceThreatIntelIndicator, c.BronzeReferenceUbuntuPackage,
+		c.BronzeReferenceXeolCycle, c.BronzeReferenceXeolProduct,
+		c.BronzeReferenceXeolPurl, c.BronzeReferenceXeolVuln,
 	} {
 		n.Use(hooks...)
 	}
@@ -264,11 +296,13 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
 		c.BronzeReferenceCPE, c.BronzeReferenceEOLCycle, c.BronzeReferenceEOLIdentifier,
-		c.BronzeReferenceEOLProduct, c.BronzeReferenceOSCoreRule,
+		c.BronzeReferenceEOLProduct, c.BronzeReferenceNVDCPEMatch,
+		c.BronzeReferenceNVDCVE, c.BronzeReferenceOSCoreRule,
+		c.BronzeReferenceOSVAffected, c.BronzeReferenceOSVVuln,
 		c.BronzeReferenceRPMPackage, c.BronzeReferenceSoftwareMatchRule,
-		c.BronzeReferenceUbuntuPackage, c.BronzeReferenceXeolCycle,
-		c.BronzeReferenceXeolProduct, c.BronzeReferenceXeolPurl,
-		c.BronzeReferenceXeolVuln,
+		c.BronzeReferenceThreatIntelIndicator, c.BronzeReferenceUbuntuPackage,
+		c.BronzeReferenceXeolCycle, c.BronzeReferenceXeolProduct,
+		c.BronzeReferenceXeolPurl, c.BronzeReferenceXeolVuln,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -285,12 +319,22 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.BronzeReferenceEOLIdentifier.mutate(ctx, m)
 	case *BronzeReferenceEOLProductMutation:
 		return c.BronzeReferenceEOLProduct.mutate(ctx, m)
+	case *BronzeReferenceNVDCPEMatchMutation:
+		return c.BronzeReferenceNVDCPEMatch.mutate(ctx, m)
+	case *BronzeReferenceNVDCVEMutation:
+		return c.BronzeReferenceNVDCVE.mutate(ctx, m)
 	case *BronzeReferenceOSCoreRuleMutation:
 		return c.BronzeReferenceOSCoreRule.mutate(ctx, m)
+	case *BronzeReferenceOSVAffectedMutation:
+		return c.BronzeReferenceOSVAffected.mutate(ctx, m)
+	case *BronzeReferenceOSVVulnMutation:
+		return c.BronzeReferenceOSVVuln.mutate(ctx, m)
 	case *BronzeReferenceRPMPackageMutation:
 		return c.BronzeReferenceRPMPackage.mutate(ctx, m)
 	case *BronzeReferenceSoftwareMatchRuleMutation:
 		return c.BronzeReferenceSoftwareMatchRule.mutate(ctx, m)
+	case *BronzeReferenceThreatIntelIndicatorMutation:
+		return c.BronzeReferenceThreatIntelIndicator.mutate(ctx, m)
 	case *BronzeReferenceUbuntuPackageMutation:
 		return c.BronzeReferenceUbuntuPackage.mutate(ctx, m)
 	case *BronzeReferenceXeolCycleMutation:
@@ -838,6 +882,272 @@ func (c *BronzeReferenceEOLProductClient) mutate(ctx context.Context, m *BronzeR
 	}
 }
 
+// BronzeReferenceNVDCPEMatchClient is a client for the BronzeReferenceNVDCPEMatch schema.
+type BronzeReferenceNVDCPEMatchClient struct {
+	config
+}
+
+// NewBronzeReferenceNVDCPEMatchClient returns a client for the BronzeReferenceNVDCPEMatch from the given config.
+func NewBronzeReferenceNVDCPEMatchClient(c config) *BronzeReferenceNVDCPEMatchClient {
+	return &BronzeReferenceNVDCPEMatchClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzereferencenvdcpematch.Hooks(f(g(h())))`.
+func (c *BronzeReferenceNVDCPEMatchClient) Use(hooks ...Hook) {
+	c.hooks.BronzeReferenceNVDCPEMatch = append(c.hooks.BronzeReferenceNVDCPEMatch, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzereferencenvdcpematch.Intercept(f(g(h())))`.
+func (c *BronzeReferenceNVDCPEMatchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeReferenceNVDCPEMatch = append(c.inters.BronzeReferenceNVDCPEMatch, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeReferenceNVDCPEMatch entity.
+func (c *BronzeReferenceNVDCPEMatchClient) Create() *BronzeReferenceNVDCPEMatchCreate {
+	mutation := newBronzeReferenceNVDCPEMatchMutation(c.config, OpCreate)
+	return &BronzeReferenceNVDCPEMatchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeReferenceNVDCPEMatch entities.
+func (c *BronzeReferenceNVDCPEMatchClient) CreateBulk(builders ...*BronzeReferenceNVDCPEMatchCreate) *BronzeReferenceNVDCPEMatchCreateBulk {
+	return &BronzeReferenceNVDCPEMatchCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeReferenceNVDCPEMatchClient) MapCreateBulk(slice any, setFunc func(*BronzeReferenceNVDCPEMatchCreate, int)) *BronzeReferenceNVDCPEMatchCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeReferenceNVDCPEMatchCreateBulk{err: fmt.Errorf("calling to BronzeReferenceNVDCPEMatchClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeReferenceNVDCPEMatchCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeReferenceNVDCPEMatchCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeReferenceNVDCPEMatch.
+func (c *BronzeReferenceNVDCPEMatchClient) Update() *BronzeReferenceNVDCPEMatchUpdate {
+	mutation := newBronzeReferenceNVDCPEMatchMutation(c.config, OpUpdate)
+	return &BronzeReferenceNVDCPEMatchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeReferenceNVDCPEMatchClient) UpdateOne(_m *BronzeReferenceNVDCPEMatch) *BronzeReferenceNVDCPEMatchUpdateOne {
+	mutation := newBronzeReferenceNVDCPEMatchMutation(c.config, OpUpdateOne, withBronzeReferenceNVDCPEMatch(_m))
+	return &BronzeReferenceNVDCPEMatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeReferenceNVDCPEMatchClient) UpdateOneID(id string) *BronzeReferenceNVDCPEMatchUpdateOne {
+	mutation := newBronzeReferenceNVDCPEMatchMutation(c.config, OpUpdateOne, withBronzeReferenceNVDCPEMatchID(id))
+	return &BronzeReferenceNVDCPEMatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeReferenceNVDCPEMatch.
+func (c *BronzeReferenceNVDCPEMatchClient) Delete() *BronzeReferenceNVDCPEMatchDelete {
+	mutation := newBronzeReferenceNVDCPEMatchMutation(c.config, OpDelete)
+	return &BronzeReferenceNVDCPEMatchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeReferenceNVDCPEMatchClient) DeleteOne(_m *BronzeReferenceNVDCPEMatch) *BronzeReferenceNVDCPEMatchDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeReferenceNVDCPEMatchClient) DeleteOneID(id string) *BronzeReferenceNVDCPEMatchDeleteOne {
+	builder := c.Delete().Where(bronzereferencenvdcpematch.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeReferenceNVDCPEMatchDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeReferenceNVDCPEMatch.
+func (c *BronzeReferenceNVDCPEMatchClient) Query() *BronzeReferenceNVDCPEMatchQuery {
+	return &BronzeReferenceNVDCPEMatchQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeReferenceNVDCPEMatch},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeReferenceNVDCPEMatch entity by its id.
+func (c *BronzeReferenceNVDCPEMatchClient) Get(ctx context.Context, id string) (*BronzeReferenceNVDCPEMatch, error) {
+	return c.Query().Where(bronzereferencenvdcpematch.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeReferenceNVDCPEMatchClient) GetX(ctx context.Context, id string) *BronzeReferenceNVDCPEMatch {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeReferenceNVDCPEMatchClient) Hooks() []Hook {
+	return c.hooks.BronzeReferenceNVDCPEMatch
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeReferenceNVDCPEMatchClient) Interceptors() []Interceptor {
+	return c.inters.BronzeReferenceNVDCPEMatch
+}
+
+func (c *BronzeReferenceNVDCPEMatchClient) mutate(ctx context.Context, m *BronzeReferenceNVDCPEMatchMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeReferenceNVDCPEMatchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeReferenceNVDCPEMatchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeReferenceNVDCPEMatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeReferenceNVDCPEMatchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("reference: unknown BronzeReferenceNVDCPEMatch mutation op: %q", m.Op())
+	}
+}
+
+// BronzeReferenceNVDCVEClient is a client for the BronzeReferenceNVDCVE schema.
+type BronzeReferenceNVDCVEClient struct {
+	config
+}
+
+// NewBronzeReferenceNVDCVEClient returns a client for the BronzeReferenceNVDCVE from the given config.
+func NewBronzeReferenceNVDCVEClient(c config) *BronzeReferenceNVDCVEClient {
+	return &BronzeReferenceNVDCVEClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzereferencenvdcve.Hooks(f(g(h())))`.
+func (c *BronzeReferenceNVDCVEClient) Use(hooks ...Hook) {
+	c.hooks.BronzeReferenceNVDCVE = append(c.hooks.BronzeReferenceNVDCVE, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzereferencenvdcve.Intercept(f(g(h())))`.
+func (c *BronzeReferenceNVDCVEClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeReferenceNVDCVE = append(c.inters.BronzeReferenceNVDCVE, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeReferenceNVDCVE entity.
+func (c *BronzeReferenceNVDCVEClient) Create() *BronzeReferenceNVDCVECreate {
+	mutation := newBronzeReferenceNVDCVEMutation(c.config, OpCreate)
+	return &BronzeReferenceNVDCVECreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeReferenceNVDCVE entities.
+func (c *BronzeReferenceNVDCVEClient) CreateBulk(builders ...*BronzeReferenceNVDCVECreate) *BronzeReferenceNVDCVECreateBulk {
+	return &BronzeReferenceNVDCVECreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeReferenceNVDCVEClient) MapCreateBulk(slice any, setFunc func(*BronzeReferenceNVDCVECreate, int)) *BronzeReferenceNVDCVECreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeReferenceNVDCVECreateBulk{err: fmt.Errorf("calling to BronzeReferenceNVDCVEClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeReferenceNVDCVECreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeReferenceNVDCVECreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeReferenceNVDCVE.
+func (c *BronzeReferenceNVDCVEClient) Update() *BronzeReferenceNVDCVEUpdate {
+	mutation := newBronzeReferenceNVDCVEMutation(c.config, OpUpdate)
+	return &BronzeReferenceNVDCVEUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeReferenceNVDCVEClient) UpdateOne(_m *BronzeReferenceNVDCVE) *BronzeReferenceNVDCVEUpdateOne {
+	mutation := newBronzeReferenceNVDCVEMutation(c.config, OpUpdateOne, withBronzeReferenceNVDCVE(_m))
+	return &BronzeReferenceNVDCVEUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeReferenceNVDCVEClient) UpdateOneID(id string) *BronzeReferenceNVDCVEUpdateOne {
+	mutation := newBronzeReferenceNVDCVEMutation(c.config, OpUpdateOne, withBronzeReferenceNVDCVEID(id))
+	return &BronzeReferenceNVDCVEUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeReferenceNVDCVE.
+func (c *BronzeReferenceNVDCVEClient) Delete() *BronzeReferenceNVDCVEDelete {
+	mutation := newBronzeReferenceNVDCVEMutation(c.config, OpDelete)
+	return &BronzeReferenceNVDCVEDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeReferenceNVDCVEClient) DeleteOne(_m *BronzeReferenceNVDCVE) *BronzeReferenceNVDCVEDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeReferenceNVDCVEClient) DeleteOneID(id string) *BronzeReferenceNVDCVEDeleteOne {
+	builder := c.Delete().Where(bronzereferencenvdcve.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeReferenceNVDCVEDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeReferenceNVDCVE.
+func (c *BronzeReferenceNVDCVEClient) Query() *BronzeReferenceNVDCVEQuery {
+	return &BronzeReferenceNVDCVEQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeReferenceNVDCVE},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeReferenceNVDCVE entity by its id.
+func (c *BronzeReferenceNVDCVEClient) Get(ctx context.Context, id string) (*BronzeReferenceNVDCVE, error) {
+	return c.Query().Where(bronzereferencenvdcve.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeReferenceNVDCVEClient) GetX(ctx context.Context, id string) *BronzeReferenceNVDCVE {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeReferenceNVDCVEClient) Hooks() []Hook {
+	return c.hooks.BronzeReferenceNVDCVE
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeReferenceNVDCVEClient) Interceptors() []Interceptor {
+	return c.inters.BronzeReferenceNVDCVE
+}
+
+func (c *BronzeReferenceNVDCVEClient) mutate(ctx context.Context, m *BronzeReferenceNVDCVEMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeReferenceNVDCVECreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeReferenceNVDCVEUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeReferenceNVDCVEUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeReferenceNVDCVEDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("reference: unknown BronzeReferenceNVDCVE mutation op: %q", m.Op())
+	}
+}
+
 // BronzeReferenceOSCoreRuleClient is a client for the BronzeReferenceOSCoreRule schema.
 type BronzeReferenceOSCoreRuleClient struct {
 	config
@@ -971,6 +1281,272 @@ func (c *BronzeReferenceOSCoreRuleClient) mutate(ctx context.Context, m *BronzeR
 	}
 }
 
+// BronzeReferenceOSVAffectedClient is a client for the BronzeReferenceOSVAffected schema.
+type BronzeReferenceOSVAffectedClient struct {
+	config
+}
+
+// NewBronzeReferenceOSVAffectedClient returns a client for the BronzeReferenceOSVAffected from the given config.
+func NewBronzeReferenceOSVAffectedClient(c config) *BronzeReferenceOSVAffectedClient {
+	return &BronzeReferenceOSVAffectedClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzereferenceosvaffected.Hooks(f(g(h())))`.
+func (c *BronzeReferenceOSVAffectedClient) Use(hooks ...Hook) {
+	c.hooks.BronzeReferenceOSVAffected = append(c.hooks.BronzeReferenceOSVAffected, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzereferenceosvaffected.Intercept(f(g(h())))`.
+func (c *BronzeReferenceOSVAffectedClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeReferenceOSVAffected = append(c.inters.BronzeReferenceOSVAffected, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeReferenceOSVAffected entity.
+func (c *BronzeReferenceOSVAffectedClient) Create() *BronzeReferenceOSVAffectedCreate {
+	mutation := newBronzeReferenceOSVAffectedMutation(c.config, OpCreate)
+	return &BronzeReferenceOSVAffectedCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeReferenceOSVAffected entities.
+func (c *BronzeReferenceOSVAffectedClient) CreateBulk(builders ...*BronzeReferenceOSVAffectedCreate) *BronzeReferenceOSVAffectedCreateBulk {
+	return &BronzeReferenceOSVAffectedCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeReferenceOSVAffectedClient) MapCreateBulk(slice any, setFunc func(*BronzeReferenceOSVAffectedCreate, int)) *BronzeReferenceOSVAffectedCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeReferenceOSVAffectedCreateBulk{err: fmt.Errorf("calling to BronzeReferenceOSVAffectedClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeReferenceOSVAffectedCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeReferenceOSVAffectedCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeReferenceOSVAffected.
+func (c *BronzeReferenceOSVAffectedClient) Update() *BronzeReferenceOSVAffectedUpdate {
+	mutation := newBronzeReferenceOSVAffectedMutation(c.config, OpUpdate)
+	return &BronzeReferenceOSVAffectedUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeReferenceOSVAffectedClient) UpdateOne(_m *BronzeReferenceOSVAffected) *BronzeReferenceOSVAffectedUpdateOne {
+	mutation := newBronzeReferenceOSVAffectedMutation(c.config, OpUpdateOne, withBronzeReferenceOSVAffected(_m))
+	return &BronzeReferenceOSVAffectedUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeReferenceOSVAffectedClient) UpdateOneID(id string) *BronzeReferenceOSVAffectedUpdateOne {
+	mutation := newBronzeReferenceOSVAffectedMutation(c.config, OpUpdateOne, withBronzeReferenceOSVAffectedID(id))
+	return &BronzeReferenceOSVAffectedUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeReferenceOSVAffected.
+func (c *BronzeReferenceOSVAffectedClient) Delete() *BronzeReferenceOSVAffectedDelete {
+	mutation := newBronzeReferenceOSVAffectedMutation(c.config, OpDelete)
+	return &BronzeReferenceOSVAffectedDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeReferenceOSVAffectedClient) DeleteOne(_m *BronzeReferenceOSVAffected) *BronzeReferenceOSVAffectedDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeReferenceOSVAffectedClient) DeleteOneID(id string) *BronzeReferenceOSVAffectedDeleteOne {
+	builder := c.Delete().Where(bronzereferenceosvaffected.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeReferenceOSVAffectedDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeReferenceOSVAffected.
+func (c *BronzeReferenceOSVAffectedClient) Query() *BronzeReferenceOSVAffectedQuery {
+	return &BronzeReferenceOSVAffectedQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeReferenceOSVAffected},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeReferenceOSVAffected entity by its id.
+func (c *BronzeReferenceOSVAffectedClient) Get(ctx context.Context, id string) (*BronzeReferenceOSVAffected, error) {
+	return c.Query().Where(bronzereferenceosvaffected.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeReferenceOSVAffectedClient) GetX(ctx context.Context, id string) *BronzeReferenceOSVAffected {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeReferenceOSVAffectedClient) Hooks() []Hook {
+	return c.hooks.BronzeReferenceOSVAffected
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeReferenceOSVAffectedClient) Interceptors() []Interceptor {
+	return c.inters.BronzeReferenceOSVAffected
+}
+
+func (c *BronzeReferenceOSVAffectedClient) mutate(ctx context.Context, m *BronzeReferenceOSVAffectedMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeReferenceOSVAffectedCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeReferenceOSVAffectedUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeReferenceOSVAffectedUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeReferenceOSVAffectedDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("reference: unknown BronzeReferenceOSVAffected mutation op: %q", m.Op())
+	}
+}
+
+// BronzeReferenceOSVVulnClient is a client for the BronzeReferenceOSVVuln schema.
+type BronzeReferenceOSVVulnClient struct {
+	config
+}
+
+// NewBronzeReferenceOSVVulnClient returns a client for the BronzeReferenceOSVVuln from the given config.
+func NewBronzeReferenceOSVVulnClient(c config) *BronzeReferenceOSVVulnClient {
+	return &BronzeReferenceOSVVulnClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzereferenceosvvuln.Hooks(f(g(h())))`.
+func (c *BronzeReferenceOSVVulnClient) Use(hooks ...Hook) {
+	c.hooks.BronzeReferenceOSVVuln = append(c.hooks.BronzeReferenceOSVVuln, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzereferenceosvvuln.Intercept(f(g(h())))`.
+func (c *BronzeReferenceOSVVulnClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeReferenceOSVVuln = append(c.inters.BronzeReferenceOSVVuln, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeReferenceOSVVuln entity.
+func (c *BronzeReferenceOSVVulnClient) Create() *BronzeReferenceOSVVulnCreate {
+	mutation := newBronzeReferenceOSVVulnMutation(c.config, OpCreate)
+	return &BronzeReferenceOSVVulnCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeReferenceOSVVuln entities.
+func (c *BronzeReferenceOSVVulnClient) CreateBulk(builders ...*BronzeReferenceOSVVulnCreate) *BronzeReferenceOSVVulnCreateBulk {
+	return &BronzeReferenceOSVVulnCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeReferenceOSVVulnClient) MapCreateBulk(slice any, setFunc func(*BronzeReferenceOSVVulnCreate, int)) *BronzeReferenceOSVVulnCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeReferenceOSVVulnCreateBulk{err: fmt.Errorf("calling to BronzeReferenceOSVVulnClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeReferenceOSVVulnCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeReferenceOSVVulnCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeReferenceOSVVuln.
+func (c *BronzeReferenceOSVVulnClient) Update() *BronzeReferenceOSVVulnUpdate {
+	mutation := newBronzeReferenceOSVVulnMutation(c.config, OpUpdate)
+	return &BronzeReferenceOSVVulnUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeReferenceOSVVulnClient) UpdateOne(_m *BronzeReferenceOSVVuln) *BronzeReferenceOSVVulnUpdateOne {
+	mutation := newBronzeReferenceOSVVulnMutation(c.config, OpUpdateOne, withBronzeReferenceOSVVuln(_m))
+	return &BronzeReferenceOSVVulnUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeReferenceOSVVulnClient) UpdateOneID(id string) *BronzeReferenceOSVVulnUpdateOne {
+	mutation := newBronzeReferenceOSVVulnMutation(c.config, OpUpdateOne, withBronzeReferenceOSVVulnID(id))
+	return &BronzeReferenceOSVVulnUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeReferenceOSVVuln.
+func (c *BronzeReferenceOSVVulnClient) Delete() *BronzeReferenceOSVVulnDelete {
+	mutation := newBronzeReferenceOSVVulnMutation(c.config, OpDelete)
+	return &BronzeReferenceOSVVulnDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeReferenceOSVVulnClient) DeleteOne(_m *BronzeReferenceOSVVuln) *BronzeReferenceOSVVulnDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeReferenceOSVVulnClient) DeleteOneID(id string) *BronzeReferenceOSVVulnDeleteOne {
+	builder := c.Delete().Where(bronzereferenceosvvuln.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeReferenceOSVVulnDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeReferenceOSVVuln.
+func (c *BronzeReferenceOSVVulnClient) Query() *BronzeReferenceOSVVulnQuery {
+	return &BronzeReferenceOSVVulnQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeReferenceOSVVuln},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeReferenceOSVVuln entity by its id.
+func (c *BronzeReferenceOSVVulnClient) Get(ctx context.Context, id string) (*BronzeReferenceOSVVuln, error) {
+	return c.Query().Where(bronzereferenceosvvuln.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeReferenceOSVVulnClient) GetX(ctx context.Context, id string) *BronzeReferenceOSVVuln {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeReferenceOSVVulnClient) Hooks() []Hook {
+	return c.hooks.BronzeReferenceOSVVuln
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeReferenceOSVVulnClient) Interceptors() []Interceptor {
+	return c.inters.BronzeReferenceOSVVuln
+}
+
+func (c *BronzeReferenceOSVVulnClient) mutate(ctx context.Context, m *BronzeReferenceOSVVulnMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeReferenceOSVVulnCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeReferenceOSVVulnUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeReferenceOSVVulnUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeReferenceOSVVulnDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("reference: unknown BronzeReferenceOSVVuln mutation op: %q", m.Op())
+	}
+}
+
 // BronzeReferenceRPMPackageClient is a client for the BronzeReferenceRPMPackage schema.
 type BronzeReferenceRPMPackageClient struct {
 	config
@@ -1237,6 +1813,139 @@ func (c *BronzeReferenceSoftwareMatchRuleClient) mutate(ctx context.Context, m *
 	}
 }
 
+// BronzeReferenceThreatIntelIndicatorClient is a client for the BronzeReferenceThreatIntelIndicator schema.
+type BronzeReferenceThreatIntelIndicatorClient struct {
+	config
+}
+
+// NewBronzeReferenceThreatIntelIndicatorClient returns a client for the BronzeReferenceThreatIntelIndicator from the given config.
+func NewBronzeReferenceThreatIntelIndicatorClient(c config) *BronzeReferenceThreatIntelIndicatorClient {
+	return &BronzeReferenceThreatIntelIndicatorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzereferencethreatintelindicator.Hooks(f(g(h())))`.
+func (c *BronzeReferenceThreatIntelIndicatorClient) Use(hooks ...Hook) {
+	c.hooks.BronzeReferenceThreatIntelIndicator = append(c.hooks.BronzeReferenceThreatIntelIndicator, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzereferencethreatintelindicator.Intercept(f(g(h())))`.
+func (c *BronzeReferenceThreatIntelIndicatorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeReferenceThreatIntelIndicator = append(c.inters.BronzeReferenceThreatIntelIndicator, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeReferenceThreatIntelIndicator entity.
+func (c *BronzeReferenceThreatIntelIndicatorClient) Create() *BronzeReferenceThreatIntelIndicatorCreate {
+	mutation := newBronzeReferenceThreatIntelIndicatorMutation(c.config, OpCreate)
+	return &BronzeReferenceThreatIntelIndicatorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeReferenceThreatIntelIndicator entities.
+func (c *BronzeReferenceThreatIntelIndicatorClient) CreateBulk(builders ...*BronzeReferenceThreatIntelIndicatorCreate) *BronzeReferenceThreatIntelIndicatorCreateBulk {
+	return &BronzeReferenceThreatIntelIndicatorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeReferenceThreatIntelIndicatorClient) MapCreateBulk(slice any, setFunc func(*BronzeReferenceThreatIntelIndicatorCreate, int)) *BronzeReferenceThreatIntelIndicatorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeReferenceThreatIntelIndicatorCreateBulk{err: fmt.Errorf("calling to BronzeReferenceThreatIntelIndicatorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeReferenceThreatIntelIndicatorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeReferenceThreatIntelIndicatorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeReferenceThreatIntelIndicator.
+func (c *BronzeReferenceThreatIntelIndicatorClient) Update() *BronzeReferenceThreatIntelIndicatorUpdate {
+	mutation := newBronzeReferenceThreatIntelIndicatorMutation(c.config, OpUpdate)
+	return &BronzeReferenceThreatIntelIndicatorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeReferenceThreatIntelIndicatorClient) UpdateOne(_m *BronzeReferenceThreatIntelIndicator) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	mutation := newBronzeReferenceThreatIntelIndicatorMutation(c.config, OpUpdateOne, withBronzeReferenceThreatIntelIndicator(_m))
+	return &BronzeReferenceThreatIntelIndicatorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeReferenceThreatIntelIndicatorClient) UpdateOneID(id string) *BronzeReferenceThreatIntelIndicatorUpdateOne {
+	mutation := newBronzeReferenceThreatIntelIndicatorMutation(c.config, OpUpdateOne, withBronzeReferenceThreatIntelIndicatorID(id))
+	return &BronzeReferenceThreatIntelIndicatorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeReferenceThreatIntelIndicator.
+func (c *BronzeReferenceThreatIntelIndicatorClient) Delete() *BronzeReferenceThreatIntelIndicatorDelete {
+	mutation := newBronzeReferenceThreatIntelIndicatorMutation(c.config, OpDelete)
+	return &BronzeReferenceThreatIntelIndicatorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeReferenceThreatIntelIndicatorClient) DeleteOne(_m *BronzeReferenceThreatIntelIndicator) *BronzeReferenceThreatIntelIndicatorDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeReferenceThreatIntelIndicatorClient) DeleteOneID(id string) *BronzeReferenceThreatIntelIndicatorDeleteOne {
+	builder := c.Delete().Where(bronzereferencethreatintelindicator.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeReferenceThreatIntelIndicatorDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeReferenceThreatIntelIndicator.
+func (c *BronzeReferenceThreatIntelIndicatorClient) Query() *BronzeReferenceThreatIntelIndicatorQuery {
+	return &BronzeReferenceThreatIntelIndicatorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeReferenceThreatIntelIndicator},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeReferenceThreatIntelIndicator entity by its id.
+func (c *BronzeReferenceThreatIntelIndicatorClient) Get(ctx context.Context, id string) (*BronzeReferenceThreatIntelIndicator, error) {
+	return c.Query().Where(bronzereferencethreatintelindicator.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeReferenceThreatIntelIndicatorClient) GetX(ctx context.Context, id string) *BronzeReferenceThreatIntelIndicator {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeReferenceThreatIntelIndicatorClient) Hooks() []Hook {
+	return c.hooks.BronzeReferenceThreatIntelIndicator
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeReferenceThreatIntelIndicatorClient) Interceptors() []Interceptor {
+	return c.inters.BronzeReferenceThreatIntelIndicator
+}
+
+func (c *BronzeReferenceThreatIntelIndicatorClient) mutate(ctx context.Context, m *BronzeReferenceThreatIntelIndicatorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeReferenceThreatIntelIndicatorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeReferenceThreatIntelIndicatorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeReferenceThreatIntelIndicatorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeReferenceThreatIntelIndicatorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("reference: unknown BronzeReferenceThreatIntelIndicator mutation op: %q", m.Op())
+	}
+}
+
 // BronzeReferenceUbuntuPackageClient is a client for the BronzeReferenceUbuntuPackage schema.
 type BronzeReferenceUbuntuPackageClient struct {
 	config
@@ -1906,18 +2615,20 @@ func (c *BronzeReferenceXeolVulnClient) mutate(ctx context.Context, m *BronzeRef
 type (
 	hooks struct {
 		BronzeReferenceCPE, BronzeReferenceEOLCycle, BronzeReferenceEOLIdentifier,
-		BronzeReferenceEOLProduct, BronzeReferenceOSCoreRule,
+		BronzeReferenceEOLProduct, BronzeReferenceNVDCPEMatch, BronzeReferenceNVDCVE,
+		BronzeReferenceOSCoreRule, BronzeReferenceOSVAffected, BronzeReferenceOSVVuln,
 		BronzeReferenceRPMPackage, BronzeReferenceSoftwareMatchRule,
-		BronzeReferenceUbuntuPackage, BronzeReferenceXeolCycle,
-		BronzeReferenceXeolProduct, BronzeReferenceXeolPurl,
+		BronzeReferenceThreatIntelIndicator, BronzeReferenceUbuntuPackage,
+		BronzeReferenceXeolCycle, BronzeReferenceXeolProduct, BronzeReferenceXeolPurl,
 		BronzeReferenceXeolVuln []ent.Hook
 	}
 	inters struct {
 		BronzeReferenceCPE, BronzeReferenceEOLCycle, BronzeReferenceEOLIdentifier,
-		BronzeReferenceEOLProduct, BronzeReferenceOSCoreRule,
+		BronzeReferenceEOLProduct, BronzeReferenceNVDCPEMatch, BronzeReferenceNVDCVE,
+		BronzeReferenceOSCoreRule, BronzeReferenceOSVAffected, BronzeReferenceOSVVuln,
 		BronzeReferenceRPMPackage, BronzeReferenceSoftwareMatchRule,
-		BronzeReferenceUbuntuPackage, BronzeReferenceXeolCycle,
-		BronzeReferenceXeolProduct, BronzeReferenceXeolPurl,
+		BronzeReferenceThreatIntelIndicator, BronzeReferenceUbuntuPackage,
+		BronzeReferenceXeolCycle, BronzeReferenceXeolProduct, BronzeReferenceXeolPurl,
 		BronzeReferenceXeolVuln []ent.Interceptor
 	}
 )
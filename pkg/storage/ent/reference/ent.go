@@ -13,9 +13,14 @@ import (
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceeolcycle"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceeolidentifier"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceeolproduct"
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencenvdcpematch"
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencenvdcve"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceoscorerule"
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceosvaffected"
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceosvvuln"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencerpmpackage"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencesoftwarematchrule"
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencethreatintelindicator"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceubuntupackage"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencexeolcycle"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencexeolproduct"
@@ -84,18 +89,23 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			bronzereferencecpe.Table:               bronzereferencecpe.ValidColumn,
-			bronzereferenceeolcycle.Table:          bronzereferenceeolcycle.ValidColumn,
-			bronzereferenceeolidentifier.Table:     bronzereferenceeolidentifier.ValidColumn,
-			bronzereferenceeolproduct.Table:        bronzereferenceeolproduct.ValidColumn,
-			bronzereferenceoscorerule.Table:        bronzereferenceoscorerule.ValidColumn,
-			bronzereferencerpmpackage.Table:        bronzereferencerpmpackage.ValidColumn,
-			bronzereferencesoftwarematchrule.Table: bronzereferencesoftwarematchrule.ValidColumn,
-			bronzereferenceubuntupackage.Table:     bronzereferenceubuntupackage.ValidColumn,
-			bronzereferencexeolcycle.Table:         bronzereferencexeolcycle.ValidColumn,
-			bronzereferencexeolproduct.Table:       bronzereferencexeolproduct.ValidColumn,
-			bronzereferencexeolpurl.Table:          bronzereferencexeolpurl.ValidColumn,
-			bronzereferencexeolvuln.Table:          bronzereferencexeolvuln.ValidColumn,
+			bronzereferencecpe.Table:                  bronzereferencecpe.ValidColumn,
+			bronzereferenceeolcycle.Table:             bronzereferenceeolcycle.ValidColumn,
+			bronzereferenceeolidentifier.Table:        bronzereferenceeolidentifier.ValidColumn,
+			bronzereferenceeolproduct.Table:           bronzereferenceeolproduct.ValidColumn,
+			bronzereferencenvdcpematch.Table:          bronzereferencenvdcpematch.ValidColumn,
+			bronzereferencenvdcve.Table:               bronzereferencenvdcve.ValidColumn,
+			bronzereferenceoscorerule.Table:           bronzereferenceoscorerule.ValidColumn,
+			bronzereferenceosvaffected.Table:          bronzereferenceosvaffected.ValidColumn,
+			bronzereferenceosvvuln.Table:              bronzereferenceosvvuln.ValidColumn,
+			bronzereferencerpmpackage.Table:           bronzereferencerpmpackage.ValidColumn,
+			bronzereferencesoftwarematchrule.Table:    bronzereferencesoftwarematchrule.ValidColumn,
+			bronzereferencethreatintelindicator.Table: bronzereferencethreatintelindicator.ValidColumn,
+			bronzereferenceubuntupackage.Table:        bronzereferenceubuntupackage.ValidColumn,
+			bronzereferencexeolcycle.Table:            bronzereferencexeolcycle.ValidColumn,
+			bronzereferencexeolproduct.Table:          bronzereferencexeolproduct.ValidColumn,
+			bronzereferencexeolpurl.Table:             bronzereferencexeolpurl.ValidColumn,
+			bronzereferencexeolvuln.Table:             bronzereferencexeolvuln.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -57,6 +57,30 @@ func (f BronzeReferenceEOLProductFunc) Mutate(ctx context.Context, m reference.M
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *reference.BronzeReferenceEOLProductMutation", m)
 }
 
+// The BronzeReferenceNVDCPEMatchFunc type is an adapter to allow the use of ordinary
+// function as BronzeReferenceNVDCPEMatch mutator.
+type BronzeReferenceNVDCPEMatchFunc func(context.Context, *reference.BronzeReferenceNVDCPEMatchMutation) (reference.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeReferenceNVDCPEMatchFunc) Mutate(ctx context.Context, m reference.Mutation) (reference.Value, error) {
+	if mv, ok := m.(*reference.BronzeReferenceNVDCPEMatchMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *reference.BronzeReferenceNVDCPEMatchMutation", m)
+}
+
+// The BronzeReferenceNVDCVEFunc type is an adapter to allow the use of ordinary
+// function as BronzeReferenceNVDCVE mutator.
+type BronzeReferenceNVDCVEFunc func(context.Context, *reference.BronzeReferenceNVDCVEMutation) (reference.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeReferenceNVDCVEFunc) Mutate(ctx context.Context, m reference.Mutation) (reference.Value, error) {
+	if mv, ok := m.(*reference.BronzeReferenceNVDCVEMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *reference.BronzeReferenceNVDCVEMutation", m)
+}
+
 // The BronzeReferenceOSCoreRuleFunc type is an adapter to allow the use of ordinary
 // function as BronzeReferenceOSCoreRule mutator.
 type BronzeReferenceOSCoreRuleFunc func(context.Context, *reference.BronzeReferenceOSCoreRuleMutation) (reference.Value, error)
@@ -69,6 +93,30 @@ func (f BronzeReferenceOSCoreRuleFunc) Mutate(ctx context.Context, m reference.M
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *reference.BronzeReferenceOSCoreRuleMutation", m)
 }
 
+// The BronzeReferenceOSVAffectedFunc type is an adapter to allow the use of ordinary
+// function as BronzeReferenceOSVAffected mutator.
+type BronzeReferenceOSVAffectedFunc func(context.Context, *reference.BronzeReferenceOSVAffectedMutation) (reference.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeReferenceOSVAffectedFunc) Mutate(ctx context.Context, m reference.Mutation) (reference.Value, error) {
+	if mv, ok := m.(*reference.BronzeReferenceOSVAffectedMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *reference.BronzeReferenceOSVAffectedMutation", m)
+}
+
+// The BronzeReferenceOSVVulnFunc type is an adapter to allow the use of ordinary
+// function as BronzeReferenceOSVVuln mutator.
+type BronzeReferenceOSVVulnFunc func(context.Context, *reference.BronzeReferenceOSVVulnMutation) (reference.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeReferenceOSVVulnFunc) Mutate(ctx context.Context, m reference.Mutation) (reference.Value, error) {
+	if mv, ok := m.(*reference.BronzeReferenceOSVVulnMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *reference.BronzeReferenceOSVVulnMutation", m)
+}
+
 // The BronzeReferenceRPMPackageFunc type is an adapter to allow the use of ordinary
 // function as BronzeReferenceRPMPackage mutator.
 type BronzeReferenceRPMPackageFunc func(context.Context, *reference.BronzeReferenceRPMPackageMutation) (reference.Value, error)
@@ -93,6 +141,18 @@ func (f BronzeReferenceSoftwareMatchRuleFunc) Mutate(ctx context.Context, m refe
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *reference.BronzeReferenceSoftwareMatchRuleMutation", m)
 }
 
+// The BronzeReferenceThreatIntelIndicatorFunc type is an adapter to allow the use of ordinary
+// function as BronzeReferenceThreatIntelIndicator mutator.
+type BronzeReferenceThreatIntelIndicatorFunc func(context.Context, *reference.BronzeReferenceThreatIntelIndicatorMutation) (reference.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeReferenceThreatIntelIndicatorFunc) Mutate(ctx context.Context, m reference.Mutation) (reference.Value, error) {
+	if mv, ok := m.(*reference.BronzeReferenceThreatIntelIndicatorMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *reference.BronzeReferenceThreatIntelIndicatorMutation", m)
+}
+
 // The BronzeReferenceUbuntuPackageFunc type is an adapter to allow the use of ordinary
 // function as BronzeReferenceUbuntuPackage mutator.
 type BronzeReferenceUbuntuPackageFunc func(context.Context, *reference.BronzeReferenceUbuntuPackageMutation) (reference.Value, error)
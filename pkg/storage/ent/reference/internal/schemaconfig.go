@@ -7,18 +7,23 @@ import "context"
 // SchemaConfig represents alternative schema names for all tables
 // that can be passed at runtime.
 type SchemaConfig struct {
-	BronzeReferenceCPE               string // BronzeReferenceCPE table.
-	BronzeReferenceEOLCycle          string // BronzeReferenceEOLCycle table.
-	BronzeReferenceEOLIdentifier     string // BronzeReferenceEOLIdentifier table.
-	BronzeReferenceEOLProduct        string // BronzeReferenceEOLProduct table.
-	BronzeReferenceOSCoreRule        string // BronzeReferenceOSCoreRule table.
-	BronzeReferenceRPMPackage        string // BronzeReferenceRPMPackage table.
-	BronzeReferenceSoftwareMatchRule string // BronzeReferenceSoftwareMatchRule table.
-	BronzeReferenceUbuntuPackage     string // BronzeReferenceUbuntuPackage table.
-	BronzeReferenceXeolCycle         string // BronzeReferenceXeolCycle table.
-	BronzeReferenceXeolProduct       string // BronzeReferenceXeolProduct table.
-	BronzeReferenceXeolPurl          string // BronzeReferenceXeolPurl table.
-	BronzeReferenceXeolVuln          string // BronzeReferenceXeolVuln table.
+	BronzeReferenceCPE                  string // BronzeReferenceCPE table.
+	BronzeReferenceEOLCycle             string // BronzeReferenceEOLCycle table.
+	BronzeReferenceEOLIdentifier        string // BronzeReferenceEOLIdentifier table.
+	BronzeReferenceEOLProduct           string // BronzeReferenceEOLProduct table.
+	BronzeReferenceNVDCPEMatch          string // BronzeReferenceNVDCPEMatch table.
+	BronzeReferenceNVDCVE               string // BronzeReferenceNVDCVE table.
+	BronzeReferenceOSCoreRule           string // BronzeReferenceOSCoreRule table.
+	BronzeReferenceOSVAffected          string // BronzeReferenceOSVAffected table.
+	BronzeReferenceOSVVuln              string // BronzeReferenceOSVVuln table.
+	BronzeReferenceRPMPackage           string // BronzeReferenceRPMPackage table.
+	BronzeReferenceSoftwareMatchRule    string // BronzeReferenceSoftwareMatchRule table.
+	BronzeReferenceThreatIntelIndicator string // BronzeReferenceThreatIntelIndicator table.
+	BronzeReferenceUbuntuPackage        string // BronzeReferenceUbuntuPackage table.
+	BronzeReferenceXeolCycle            string // BronzeReferenceXeolCycle table.
+	BronzeReferenceXeolProduct          string // BronzeReferenceXeolProduct table.
+	BronzeReferenceXeolPurl             string // BronzeReferenceXeolPurl table.
+	BronzeReferenceXeolVuln             string // BronzeReferenceXeolVuln table.
 }
 
 type schemaCtxKey struct{}
@@ -136,6 +136,85 @@ var (
 			},
 		},
 	}
+	// ReferenceNvdCpeMatchesColumns holds the columns for the "reference_nvd_cpe_matches" table.
+	ReferenceNvdCpeMatchesColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "cve_id", Type: field.TypeString},
+		{Name: "criteria", Type: field.TypeString},
+		{Name: "part", Type: field.TypeString},
+		{Name: "cpe_vendor", Type: field.TypeString},
+		{Name: "cpe_product", Type: field.TypeString},
+		{Name: "cpe_version", Type: field.TypeString},
+		{Name: "vulnerable", Type: field.TypeBool, Default: true},
+		{Name: "version_start_including", Type: field.TypeString, Nullable: true},
+		{Name: "version_start_excluding", Type: field.TypeString, Nullable: true},
+		{Name: "version_end_including", Type: field.TypeString, Nullable: true},
+		{Name: "version_end_excluding", Type: field.TypeString, Nullable: true},
+	}
+	// ReferenceNvdCpeMatchesTable holds the schema information for the "reference_nvd_cpe_matches" table.
+	ReferenceNvdCpeMatchesTable = &schema.Table{
+		Name:       "reference_nvd_cpe_matches",
+		Columns:    ReferenceNvdCpeMatchesColumns,
+		PrimaryKey: []*schema.Column{ReferenceNvdCpeMatchesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzereferencenvdcpematch_cve_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReferenceNvdCpeMatchesColumns[3]},
+			},
+			{
+				Name:    "bronzereferencenvdcpematch_cpe_vendor_cpe_product",
+				Unique:  false,
+				Columns: []*schema.Column{ReferenceNvdCpeMatchesColumns[6], ReferenceNvdCpeMatchesColumns[7]},
+			},
+			{
+				Name:    "bronzereferencenvdcpematch_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReferenceNvdCpeMatchesColumns[1]},
+			},
+		},
+	}
+	// ReferenceNvdCvesColumns holds the columns for the "reference_nvd_cves" table.
+	ReferenceNvdCvesColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "vuln_status", Type: field.TypeString, Nullable: true},
+		{Name: "cvss_version", Type: field.TypeString, Nullable: true},
+		{Name: "cvss_score", Type: field.TypeFloat64, Nullable: true},
+		{Name: "cvss_severity", Type: field.TypeString, Nullable: true},
+		{Name: "cvss_vector", Type: field.TypeString, Nullable: true},
+		{Name: "cwes", Type: field.TypeJSON, Nullable: true},
+		{Name: "published", Type: field.TypeTime},
+		{Name: "last_modified", Type: field.TypeTime},
+		{Name: "cisa_exploit_add", Type: field.TypeTime, Nullable: true},
+	}
+	// ReferenceNvdCvesTable holds the schema information for the "reference_nvd_cves" table.
+	ReferenceNvdCvesTable = &schema.Table{
+		Name:       "reference_nvd_cves",
+		Columns:    ReferenceNvdCvesColumns,
+		PrimaryKey: []*schema.Column{ReferenceNvdCvesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzereferencenvdcve_cvss_severity",
+				Unique:  false,
+				Columns: []*schema.Column{ReferenceNvdCvesColumns[7]},
+			},
+			{
+				Name:    "bronzereferencenvdcve_last_modified",
+				Unique:  false,
+				Columns: []*schema.Column{ReferenceNvdCvesColumns[11]},
+			},
+			{
+				Name:    "bronzereferencenvdcve_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReferenceNvdCvesColumns[1]},
+			},
+		},
+	}
 	// ReferenceOsCoreRulesColumns holds the columns for the "reference_os_core_rules" table.
 	ReferenceOsCoreRulesColumns = []*schema.Column{
 		{Name: "resource_id", Type: field.TypeString, Unique: true},
@@ -169,6 +248,77 @@ var (
 			},
 		},
 	}
+	// ReferenceOsvAffectedColumns holds the columns for the "reference_osv_affected" table.
+	ReferenceOsvAffectedColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "vuln_id", Type: field.TypeString},
+		{Name: "ecosystem", Type: field.TypeString},
+		{Name: "package_name", Type: field.TypeString},
+		{Name: "purl", Type: field.TypeString, Nullable: true},
+		{Name: "range_type", Type: field.TypeString, Nullable: true},
+		{Name: "introduced", Type: field.TypeString, Nullable: true},
+		{Name: "fixed", Type: field.TypeString, Nullable: true},
+		{Name: "last_affected", Type: field.TypeString, Nullable: true},
+		{Name: "versions", Type: field.TypeJSON, Nullable: true},
+	}
+	// ReferenceOsvAffectedTable holds the schema information for the "reference_osv_affected" table.
+	ReferenceOsvAffectedTable = &schema.Table{
+		Name:       "reference_osv_affected",
+		Columns:    ReferenceOsvAffectedColumns,
+		PrimaryKey: []*schema.Column{ReferenceOsvAffectedColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzereferenceosvaffected_vuln_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReferenceOsvAffectedColumns[3]},
+			},
+			{
+				Name:    "bronzereferenceosvaffected_ecosystem_package_name",
+				Unique:  false,
+				Columns: []*schema.Column{ReferenceOsvAffectedColumns[4], ReferenceOsvAffectedColumns[5]},
+			},
+			{
+				Name:    "bronzereferenceosvaffected_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReferenceOsvAffectedColumns[1]},
+			},
+		},
+	}
+	// ReferenceOsvVulnsColumns holds the columns for the "reference_osv_vulns" table.
+	ReferenceOsvVulnsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "summary", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "details", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "aliases", Type: field.TypeJSON, Nullable: true},
+		{Name: "severity_type", Type: field.TypeString, Nullable: true},
+		{Name: "severity_score", Type: field.TypeString, Nullable: true},
+		{Name: "database_severity", Type: field.TypeString, Nullable: true},
+		{Name: "published", Type: field.TypeTime, Nullable: true},
+		{Name: "modified", Type: field.TypeTime},
+		{Name: "withdrawn", Type: field.TypeTime, Nullable: true},
+	}
+	// ReferenceOsvVulnsTable holds the schema information for the "reference_osv_vulns" table.
+	ReferenceOsvVulnsTable = &schema.Table{
+		Name:       "reference_osv_vulns",
+		Columns:    ReferenceOsvVulnsColumns,
+		PrimaryKey: []*schema.Column{ReferenceOsvVulnsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzereferenceosvvuln_modified",
+				Unique:  false,
+				Columns: []*schema.Column{ReferenceOsvVulnsColumns[10]},
+			},
+			{
+				Name:    "bronzereferenceosvvuln_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReferenceOsvVulnsColumns[1]},
+			},
+		},
+	}
 	// ReferenceRpmPackagesColumns holds the columns for the "reference_rpm_packages" table.
 	ReferenceRpmPackagesColumns = []*schema.Column{
 		{Name: "resource_id", Type: field.TypeString, Unique: true},
@@ -239,12 +389,44 @@ var (
 			},
 		},
 	}
+	// ReferenceThreatIntelIndicatorsColumns holds the columns for the "reference_threat_intel_indicators" table.
+	ReferenceThreatIntelIndicatorsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "feed_name", Type: field.TypeString},
+		{Name: "feed_source", Type: field.TypeString},
+		{Name: "feed_format", Type: field.TypeString},
+		{Name: "category", Type: field.TypeString},
+		{Name: "cidr", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// ReferenceThreatIntelIndicatorsTable holds the schema information for the "reference_threat_intel_indicators" table.
+	ReferenceThreatIntelIndicatorsTable = &schema.Table{
+		Name:       "reference_threat_intel_indicators",
+		Columns:    ReferenceThreatIntelIndicatorsColumns,
+		PrimaryKey: []*schema.Column{ReferenceThreatIntelIndicatorsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzereferencethreatintelindicator_feed_name",
+				Unique:  false,
+				Columns: []*schema.Column{ReferenceThreatIntelIndicatorsColumns[3]},
+			},
+			{
+				Name:    "bronzereferencethreatintelindicator_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReferenceThreatIntelIndicatorsColumns[9]},
+			},
+		},
+	}
 	// ReferenceUbuntuPackagesColumns holds the columns for the "reference_ubuntu_packages" table.
 	ReferenceUbuntuPackagesColumns = []*schema.Column{
 		{Name: "resource_id", Type: field.TypeString, Unique: true},
 		{Name: "collected_at", Type: field.TypeTime},
 		{Name: "first_collected_at", Type: field.TypeTime},
 		{Name: "package_name", Type: field.TypeString},
+		{Name: "source_package", Type: field.TypeString, Nullable: true},
 		{Name: "release", Type: field.TypeString},
 		{Name: "component", Type: field.TypeString},
 		{Name: "section", Type: field.TypeString},
@@ -264,7 +446,7 @@ var (
 			{
 				Name:    "bronzereferenceubuntupackage_section",
 				Unique:  false,
-				Columns: []*schema.Column{ReferenceUbuntuPackagesColumns[6]},
+				Columns: []*schema.Column{ReferenceUbuntuPackagesColumns[7]},
 			},
 			{
 				Name:    "bronzereferenceubuntupackage_collected_at",
@@ -395,9 +577,14 @@ var (
 		ReferenceEolCyclesTable,
 		ReferenceEolIdentifiersTable,
 		ReferenceEolProductsTable,
+		ReferenceNvdCpeMatchesTable,
+		ReferenceNvdCvesTable,
 		ReferenceOsCoreRulesTable,
+		ReferenceOsvAffectedTable,
+		ReferenceOsvVulnsTable,
 		ReferenceRpmPackagesTable,
 		ReferenceSoftwareMatchRulesTable,
+		ReferenceThreatIntelIndicatorsTable,
 		ReferenceUbuntuPackagesTable,
 		ReferenceXeolCyclesTable,
 		ReferenceXeolProductsTable,
@@ -419,15 +606,30 @@ func init() {
 	ReferenceEolProductsTable.Annotation = &entsql.Annotation{
 		Table: "reference_eol_products",
 	}
+	ReferenceNvdCpeMatchesTable.Annotation = &entsql.Annotation{
+		Table: "reference_nvd_cpe_matches",
+	}
+	ReferenceNvdCvesTable.Annotation = &entsql.Annotation{
+		Table: "reference_nvd_cves",
+	}
 	ReferenceOsCoreRulesTable.Annotation = &entsql.Annotation{
 		Table: "reference_os_core_rules",
 	}
+	ReferenceOsvAffectedTable.Annotation = &entsql.Annotation{
+		Table: "reference_osv_affected",
+	}
+	ReferenceOsvVulnsTable.Annotation = &entsql.Annotation{
+		Table: "reference_osv_vulns",
+	}
 	ReferenceRpmPackagesTable.Annotation = &entsql.Annotation{
 		Table: "reference_rpm_packages",
 	}
 	ReferenceSoftwareMatchRulesTable.Annotation = &entsql.Annotation{
 		Table: "reference_software_match_rules",
 	}
+	ReferenceThreatIntelIndicatorsTable.Annotation = &entsql.Annotation{
+		Table: "reference_threat_intel_indicators",
+	}
 	ReferenceUbuntuPackagesTable.Annotation = &entsql.Annotation{
 		Table: "reference_ubuntu_packages",
 	}
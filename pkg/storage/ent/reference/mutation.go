@@ -13,9 +13,14 @@ import (
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceeolcycle"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceeolidentifier"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceeolproduct"
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencenvdcpematch"
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencenvdcve"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceoscorerule"
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceosvaffected"
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceosvvuln"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencerpmpackage"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencesoftwarematchrule"
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencethreatintelindicator"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceubuntupackage"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencexeolcycle"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencexeolproduct"
@@ -35,18 +40,23 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeBronzeReferenceCPE               = "BronzeReferenceCPE"
-	TypeBronzeReferenceEOLCycle          = "BronzeReferenceEOLCycle"
-	TypeBronzeReferenceEOLIdentifier     = "BronzeReferenceEOLIdentifier"
-	TypeBronzeReferenceEOLProduct        = "BronzeReferenceEOLProduct"
-	TypeBronzeReferenceOSCoreRule        = "BronzeReferenceOSCoreRule"
-	TypeBronzeReferenceRPMPackage        = "BronzeReferenceRPMPackage"
-	TypeBronzeReferenceSoftwareMatchRule = "BronzeReferenceSoftwareMatchRule"
-	TypeBronzeReferenceUbuntuPackage     = "BronzeReferenceUbuntuPackage"
-	TypeBronzeReferenceXeolCycle         = "BronzeReferenceXeolCycle"
-	TypeBronzeReferenceXeolProduct       = "BronzeReferenceXeolProduct"
-	TypeBronzeReferenceXeolPurl          = "BronzeReferenceXeolPurl"
-	TypeBronzeReferenceXeolVuln          = "BronzeReferenceXeolVuln"
+	TypeBronzeReferenceCPE                  = "BronzeReferenceCPE"
+	TypeBronzeReferenceEOLCycle             = "BronzeReferenceEOLCycle"
+	TypeBronzeReferenceEOLIdentifier        = "BronzeReferenceEOLIdentifier"
+	TypeBronzeReferenceEOLProduct           = "BronzeReferenceEOLProduct"
+	TypeBronzeReferenceNVDCPEMatch          = "BronzeReferenceNVDCPEMatch"
+	TypeBronzeReferenceNVDCVE               = "BronzeReferenceNVDCVE"
+	TypeBronzeReferenceOSCoreRule           = "BronzeReferenceOSCoreRule"
+	TypeBronzeReferenceOSVAffected          = "BronzeReferenceOSVAffected"
+	TypeBronzeReferenceOSVVuln              = "BronzeReferenceOSVVuln"
+	TypeBronzeReferenceRPMPackage           = "BronzeReferenceRPMPackage"
+	TypeBronzeReferenceSoftwareMatchRule    = "BronzeReferenceSoftwareMatchRule"
+	TypeBronzeReferenceThreatIntelIndicator = "BronzeReferenceThreatIntelIndicator"
+	TypeBronzeReferenceUbuntuPackage        = "BronzeReferenceUbuntuPackage"
+	TypeBronzeReferenceXeolCycle            = "BronzeReferenceXeolCycle"
+	TypeBronzeReferenceXeolProduct          = "BronzeReferenceXeolProduct"
+	TypeBronzeReferenceXeolPurl             = "BronzeReferenceXeolPurl"
+	TypeBronzeReferenceXeolVuln             = "BronzeReferenceXeolVuln"
 )
 
 // BronzeReferenceCPEMutation represents an operation that mutates the BronzeReferenceCPE nodes in the graph.
@@ -2924,35 +2934,42 @@ func (m *BronzeReferenceEOLProductMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown BronzeReferenceEOLProduct edge %s", name)
 }
 
-// BronzeReferenceOSCoreRuleMutation represents an operation that mutates the BronzeReferenceOSCoreRule nodes in the graph.
-type BronzeReferenceOSCoreRuleMutation struct {
+// BronzeReferenceNVDCPEMatchMutation represents an operation that mutates the BronzeReferenceNVDCPEMatch nodes in the graph.
+type BronzeReferenceNVDCPEMatchMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *string
-	collected_at       *time.Time
-	first_collected_at *time.Time
-	rule_type          *string
-	os_type            *string
-	value              *string
-	description        *string
-	clearedFields      map[string]struct{}
-	done               bool
-	oldValue           func(context.Context) (*BronzeReferenceOSCoreRule, error)
-	predicates         []predicate.BronzeReferenceOSCoreRule
-}
-
-var _ ent.Mutation = (*BronzeReferenceOSCoreRuleMutation)(nil)
-
-// bronzereferenceoscoreruleOption allows management of the mutation configuration using functional options.
-type bronzereferenceoscoreruleOption func(*BronzeReferenceOSCoreRuleMutation)
-
-// newBronzeReferenceOSCoreRuleMutation creates new mutation for the BronzeReferenceOSCoreRule entity.
-func newBronzeReferenceOSCoreRuleMutation(c config, op Op, opts ...bronzereferenceoscoreruleOption) *BronzeReferenceOSCoreRuleMutation {
-	m := &BronzeReferenceOSCoreRuleMutation{
+	op                      Op
+	typ                     string
+	id                      *string
+	collected_at            *time.Time
+	first_collected_at      *time.Time
+	cve_id                  *string
+	criteria                *string
+	part                    *string
+	cpe_vendor              *string
+	cpe_product             *string
+	cpe_version             *string
+	vulnerable              *bool
+	version_start_including *string
+	version_start_excluding *string
+	version_end_including   *string
+	version_end_excluding   *string
+	clearedFields           map[string]struct{}
+	done                    bool
+	oldValue                func(context.Context) (*BronzeReferenceNVDCPEMatch, error)
+	predicates              []predicate.BronzeReferenceNVDCPEMatch
+}
+
+var _ ent.Mutation = (*BronzeReferenceNVDCPEMatchMutation)(nil)
+
+// bronzereferencenvdcpematchOption allows management of the mutation configuration using functional options.
+type bronzereferencenvdcpematchOption func(*BronzeReferenceNVDCPEMatchMutation)
+
+// newBronzeReferenceNVDCPEMatchMutation creates new mutation for the BronzeReferenceNVDCPEMatch entity.
+func newBronzeReferenceNVDCPEMatchMutation(c config, op Op, opts ...bronzereferencenvdcpematchOption) *BronzeReferenceNVDCPEMatchMutation {
+	m := &BronzeReferenceNVDCPEMatchMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeBronzeReferenceOSCoreRule,
+		typ:           TypeBronzeReferenceNVDCPEMatch,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2961,20 +2978,20 @@ func newBronzeReferenceOSCoreRuleMutation(c config, op Op, opts ...bronzereferen
 	return m
 }
 
-// withBronzeReferenceOSCoreRuleID sets the ID field of the mutation.
-func withBronzeReferenceOSCoreRuleID(id string) bronzereferenceoscoreruleOption {
-	return func(m *BronzeReferenceOSCoreRuleMutation) {
+// withBronzeReferenceNVDCPEMatchID sets the ID field of the mutation.
+func withBronzeReferenceNVDCPEMatchID(id string) bronzereferencenvdcpematchOption {
+	return func(m *BronzeReferenceNVDCPEMatchMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *BronzeReferenceOSCoreRule
+			value *BronzeReferenceNVDCPEMatch
 		)
-		m.oldValue = func(ctx context.Context) (*BronzeReferenceOSCoreRule, error) {
+		m.oldValue = func(ctx context.Context) (*BronzeReferenceNVDCPEMatch, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().BronzeReferenceOSCoreRule.Get(ctx, id)
+					value, err = m.Client().BronzeReferenceNVDCPEMatch.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2983,10 +3000,10 @@ func withBronzeReferenceOSCoreRuleID(id string) bronzereferenceoscoreruleOption
 	}
 }
 
-// withBronzeReferenceOSCoreRule sets the old BronzeReferenceOSCoreRule of the mutation.
-func withBronzeReferenceOSCoreRule(node *BronzeReferenceOSCoreRule) bronzereferenceoscoreruleOption {
-	return func(m *BronzeReferenceOSCoreRuleMutation) {
-		m.oldValue = func(context.Context) (*BronzeReferenceOSCoreRule, error) {
+// withBronzeReferenceNVDCPEMatch sets the old BronzeReferenceNVDCPEMatch of the mutation.
+func withBronzeReferenceNVDCPEMatch(node *BronzeReferenceNVDCPEMatch) bronzereferencenvdcpematchOption {
+	return func(m *BronzeReferenceNVDCPEMatchMutation) {
+		m.oldValue = func(context.Context) (*BronzeReferenceNVDCPEMatch, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2995,7 +3012,7 @@ func withBronzeReferenceOSCoreRule(node *BronzeReferenceOSCoreRule) bronzerefere
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BronzeReferenceOSCoreRuleMutation) Client() *Client {
+func (m BronzeReferenceNVDCPEMatchMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3003,7 +3020,7 @@ func (m BronzeReferenceOSCoreRuleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m BronzeReferenceOSCoreRuleMutation) Tx() (*Tx, error) {
+func (m BronzeReferenceNVDCPEMatchMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("reference: mutation is not running in a transaction")
 	}
@@ -3013,14 +3030,14 @@ func (m BronzeReferenceOSCoreRuleMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of BronzeReferenceOSCoreRule entities.
-func (m *BronzeReferenceOSCoreRuleMutation) SetID(id string) {
+// operation is only accepted on creation of BronzeReferenceNVDCPEMatch entities.
+func (m *BronzeReferenceNVDCPEMatchMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *BronzeReferenceOSCoreRuleMutation) ID() (id string, exists bool) {
+func (m *BronzeReferenceNVDCPEMatchMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3031,7 +3048,7 @@ func (m *BronzeReferenceOSCoreRuleMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *BronzeReferenceOSCoreRuleMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *BronzeReferenceNVDCPEMatchMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3040,19 +3057,19 @@ func (m *BronzeReferenceOSCoreRuleMutation) IDs(ctx context.Context) ([]string,
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().BronzeReferenceOSCoreRule.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BronzeReferenceNVDCPEMatch.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCollectedAt sets the "collected_at" field.
-func (m *BronzeReferenceOSCoreRuleMutation) SetCollectedAt(t time.Time) {
+func (m *BronzeReferenceNVDCPEMatchMutation) SetCollectedAt(t time.Time) {
 	m.collected_at = &t
 }
 
 // CollectedAt returns the value of the "collected_at" field in the mutation.
-func (m *BronzeReferenceOSCoreRuleMutation) CollectedAt() (r time.Time, exists bool) {
+func (m *BronzeReferenceNVDCPEMatchMutation) CollectedAt() (r time.Time, exists bool) {
 	v := m.collected_at
 	if v == nil {
 		return
@@ -3060,10 +3077,10 @@ func (m *BronzeReferenceOSCoreRuleMutation) CollectedAt() (r time.Time, exists b
 	return *v, true
 }
 
-// OldCollectedAt returns the old "collected_at" field's value of the BronzeReferenceOSCoreRule entity.
-// If the BronzeReferenceOSCoreRule object wasn't provided to the builder, the object is fetched from the database.
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeReferenceNVDCPEMatch entity.
+// If the BronzeReferenceNVDCPEMatch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceOSCoreRuleMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BronzeReferenceNVDCPEMatchMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
 	}
@@ -3078,17 +3095,17 @@ func (m *BronzeReferenceOSCoreRuleMutation) OldCollectedAt(ctx context.Context)
 }
 
 // ResetCollectedAt resets all changes to the "collected_at" field.
-func (m *BronzeReferenceOSCoreRuleMutation) ResetCollectedAt() {
+func (m *BronzeReferenceNVDCPEMatchMutation) ResetCollectedAt() {
 	m.collected_at = nil
 }
 
 // SetFirstCollectedAt sets the "first_collected_at" field.
-func (m *BronzeReferenceOSCoreRuleMutation) SetFirstCollectedAt(t time.Time) {
+func (m *BronzeReferenceNVDCPEMatchMutation) SetFirstCollectedAt(t time.Time) {
 	m.first_collected_at = &t
 }
 
 // FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
-func (m *BronzeReferenceOSCoreRuleMutation) FirstCollectedAt() (r time.Time, exists bool) {
+func (m *BronzeReferenceNVDCPEMatchMutation) FirstCollectedAt() (r time.Time, exists bool) {
 	v := m.first_collected_at
 	if v == nil {
 		return
@@ -3096,10 +3113,10 @@ func (m *BronzeReferenceOSCoreRuleMutation) FirstCollectedAt() (r time.Time, exi
 	return *v, true
 }
 
-// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeReferenceOSCoreRule entity.
-// If the BronzeReferenceOSCoreRule object wasn't provided to the builder, the object is fetched from the database.
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeReferenceNVDCPEMatch entity.
+// If the BronzeReferenceNVDCPEMatch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceOSCoreRuleMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BronzeReferenceNVDCPEMatchMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
 	}
@@ -3114,253 +3131,5285 @@ func (m *BronzeReferenceOSCoreRuleMutation) OldFirstCollectedAt(ctx context.Cont
 }
 
 // ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
-func (m *BronzeReferenceOSCoreRuleMutation) ResetFirstCollectedAt() {
+func (m *BronzeReferenceNVDCPEMatchMutation) ResetFirstCollectedAt() {
 	m.first_collected_at = nil
 }
 
-// SetRuleType sets the "rule_type" field.
-func (m *BronzeReferenceOSCoreRuleMutation) SetRuleType(s string) {
-	m.rule_type = &s
+// SetCveID sets the "cve_id" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) SetCveID(s string) {
+	m.cve_id = &s
 }
 
-// RuleType returns the value of the "rule_type" field in the mutation.
-func (m *BronzeReferenceOSCoreRuleMutation) RuleType() (r string, exists bool) {
-	v := m.rule_type
+// CveID returns the value of the "cve_id" field in the mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) CveID() (r string, exists bool) {
+	v := m.cve_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRuleType returns the old "rule_type" field's value of the BronzeReferenceOSCoreRule entity.
-// If the BronzeReferenceOSCoreRule object wasn't provided to the builder, the object is fetched from the database.
+// OldCveID returns the old "cve_id" field's value of the BronzeReferenceNVDCPEMatch entity.
+// If the BronzeReferenceNVDCPEMatch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceOSCoreRuleMutation) OldRuleType(ctx context.Context) (v string, err error) {
+func (m *BronzeReferenceNVDCPEMatchMutation) OldCveID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRuleType is only allowed on UpdateOne operations")
+		return v, errors.New("OldCveID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRuleType requires an ID field in the mutation")
+		return v, errors.New("OldCveID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRuleType: %w", err)
+		return v, fmt.Errorf("querying old value for OldCveID: %w", err)
 	}
-	return oldValue.RuleType, nil
+	return oldValue.CveID, nil
 }
 
-// ResetRuleType resets all changes to the "rule_type" field.
-func (m *BronzeReferenceOSCoreRuleMutation) ResetRuleType() {
-	m.rule_type = nil
+// ResetCveID resets all changes to the "cve_id" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) ResetCveID() {
+	m.cve_id = nil
 }
 
-// SetOsType sets the "os_type" field.
-func (m *BronzeReferenceOSCoreRuleMutation) SetOsType(s string) {
-	m.os_type = &s
+// SetCriteria sets the "criteria" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) SetCriteria(s string) {
+	m.criteria = &s
 }
 
-// OsType returns the value of the "os_type" field in the mutation.
-func (m *BronzeReferenceOSCoreRuleMutation) OsType() (r string, exists bool) {
-	v := m.os_type
+// Criteria returns the value of the "criteria" field in the mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) Criteria() (r string, exists bool) {
+	v := m.criteria
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOsType returns the old "os_type" field's value of the BronzeReferenceOSCoreRule entity.
-// If the BronzeReferenceOSCoreRule object wasn't provided to the builder, the object is fetched from the database.
+// OldCriteria returns the old "criteria" field's value of the BronzeReferenceNVDCPEMatch entity.
+// If the BronzeReferenceNVDCPEMatch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceOSCoreRuleMutation) OldOsType(ctx context.Context) (v string, err error) {
+func (m *BronzeReferenceNVDCPEMatchMutation) OldCriteria(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOsType is only allowed on UpdateOne operations")
+		return v, errors.New("OldCriteria is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOsType requires an ID field in the mutation")
+		return v, errors.New("OldCriteria requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOsType: %w", err)
+		return v, fmt.Errorf("querying old value for OldCriteria: %w", err)
 	}
-	return oldValue.OsType, nil
+	return oldValue.Criteria, nil
 }
 
-// ClearOsType clears the value of the "os_type" field.
-func (m *BronzeReferenceOSCoreRuleMutation) ClearOsType() {
-	m.os_type = nil
-	m.clearedFields[bronzereferenceoscorerule.FieldOsType] = struct{}{}
+// ResetCriteria resets all changes to the "criteria" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) ResetCriteria() {
+	m.criteria = nil
 }
 
-// OsTypeCleared returns if the "os_type" field was cleared in this mutation.
-func (m *BronzeReferenceOSCoreRuleMutation) OsTypeCleared() bool {
-	_, ok := m.clearedFields[bronzereferenceoscorerule.FieldOsType]
-	return ok
+// SetPart sets the "part" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) SetPart(s string) {
+	m.part = &s
 }
 
-// ResetOsType resets all changes to the "os_type" field.
-func (m *BronzeReferenceOSCoreRuleMutation) ResetOsType() {
-	m.os_type = nil
-	delete(m.clearedFields, bronzereferenceoscorerule.FieldOsType)
+// Part returns the value of the "part" field in the mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) Part() (r string, exists bool) {
+	v := m.part
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetValue sets the "value" field.
-func (m *BronzeReferenceOSCoreRuleMutation) SetValue(s string) {
-	m.value = &s
+// OldPart returns the old "part" field's value of the BronzeReferenceNVDCPEMatch entity.
+// If the BronzeReferenceNVDCPEMatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceNVDCPEMatchMutation) OldPart(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPart: %w", err)
+	}
+	return oldValue.Part, nil
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *BronzeReferenceOSCoreRuleMutation) Value() (r string, exists bool) {
-	v := m.value
+// ResetPart resets all changes to the "part" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) ResetPart() {
+	m.part = nil
+}
+
+// SetCpeVendor sets the "cpe_vendor" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) SetCpeVendor(s string) {
+	m.cpe_vendor = &s
+}
+
+// CpeVendor returns the value of the "cpe_vendor" field in the mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) CpeVendor() (r string, exists bool) {
+	v := m.cpe_vendor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the BronzeReferenceOSCoreRule entity.
-// If the BronzeReferenceOSCoreRule object wasn't provided to the builder, the object is fetched from the database.
+// OldCpeVendor returns the old "cpe_vendor" field's value of the BronzeReferenceNVDCPEMatch entity.
+// If the BronzeReferenceNVDCPEMatch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceOSCoreRuleMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *BronzeReferenceNVDCPEMatchMutation) OldCpeVendor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldCpeVendor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldCpeVendor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldCpeVendor: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.CpeVendor, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *BronzeReferenceOSCoreRuleMutation) ResetValue() {
-	m.value = nil
+// ResetCpeVendor resets all changes to the "cpe_vendor" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) ResetCpeVendor() {
+	m.cpe_vendor = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *BronzeReferenceOSCoreRuleMutation) SetDescription(s string) {
-	m.description = &s
+// SetCpeProduct sets the "cpe_product" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) SetCpeProduct(s string) {
+	m.cpe_product = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *BronzeReferenceOSCoreRuleMutation) Description() (r string, exists bool) {
-	v := m.description
+// CpeProduct returns the value of the "cpe_product" field in the mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) CpeProduct() (r string, exists bool) {
+	v := m.cpe_product
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the BronzeReferenceOSCoreRule entity.
-// If the BronzeReferenceOSCoreRule object wasn't provided to the builder, the object is fetched from the database.
+// OldCpeProduct returns the old "cpe_product" field's value of the BronzeReferenceNVDCPEMatch entity.
+// If the BronzeReferenceNVDCPEMatch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceOSCoreRuleMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *BronzeReferenceNVDCPEMatchMutation) OldCpeProduct(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldCpeProduct is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldCpeProduct requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldCpeProduct: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *BronzeReferenceOSCoreRuleMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[bronzereferenceoscorerule.FieldDescription] = struct{}{}
+	return oldValue.CpeProduct, nil
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *BronzeReferenceOSCoreRuleMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[bronzereferenceoscorerule.FieldDescription]
-	return ok
+// ResetCpeProduct resets all changes to the "cpe_product" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) ResetCpeProduct() {
+	m.cpe_product = nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *BronzeReferenceOSCoreRuleMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, bronzereferenceoscorerule.FieldDescription)
+// SetCpeVersion sets the "cpe_version" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) SetCpeVersion(s string) {
+	m.cpe_version = &s
 }
 
-// Where appends a list predicates to the BronzeReferenceOSCoreRuleMutation builder.
-func (m *BronzeReferenceOSCoreRuleMutation) Where(ps ...predicate.BronzeReferenceOSCoreRule) {
-	m.predicates = append(m.predicates, ps...)
+// CpeVersion returns the value of the "cpe_version" field in the mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) CpeVersion() (r string, exists bool) {
+	v := m.cpe_version
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the BronzeReferenceOSCoreRuleMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BronzeReferenceOSCoreRuleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.BronzeReferenceOSCoreRule, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// OldCpeVersion returns the old "cpe_version" field's value of the BronzeReferenceNVDCPEMatch entity.
+// If the BronzeReferenceNVDCPEMatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceNVDCPEMatchMutation) OldCpeVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCpeVersion is only allowed on UpdateOne operations")
 	}
-	m.Where(p...)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCpeVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCpeVersion: %w", err)
+	}
+	return oldValue.CpeVersion, nil
 }
 
-// Op returns the operation name.
-func (m *BronzeReferenceOSCoreRuleMutation) Op() Op {
-	return m.op
+// ResetCpeVersion resets all changes to the "cpe_version" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) ResetCpeVersion() {
+	m.cpe_version = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *BronzeReferenceOSCoreRuleMutation) SetOp(op Op) {
-	m.op = op
+// SetVulnerable sets the "vulnerable" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) SetVulnerable(b bool) {
+	m.vulnerable = &b
 }
 
-// Type returns the node type of this mutation (BronzeReferenceOSCoreRule).
-func (m *BronzeReferenceOSCoreRuleMutation) Type() string {
-	return m.typ
+// Vulnerable returns the value of the "vulnerable" field in the mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) Vulnerable() (r bool, exists bool) {
+	v := m.vulnerable
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *BronzeReferenceOSCoreRuleMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.collected_at != nil {
-		fields = append(fields, bronzereferenceoscorerule.FieldCollectedAt)
-	}
-	if m.first_collected_at != nil {
-		fields = append(fields, bronzereferenceoscorerule.FieldFirstCollectedAt)
-	}
-	if m.rule_type != nil {
-		fields = append(fields, bronzereferenceoscorerule.FieldRuleType)
-	}
-	if m.os_type != nil {
-		fields = append(fields, bronzereferenceoscorerule.FieldOsType)
+// OldVulnerable returns the old "vulnerable" field's value of the BronzeReferenceNVDCPEMatch entity.
+// If the BronzeReferenceNVDCPEMatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceNVDCPEMatchMutation) OldVulnerable(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVulnerable is only allowed on UpdateOne operations")
 	}
-	if m.value != nil {
-		fields = append(fields, bronzereferenceoscorerule.FieldValue)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVulnerable requires an ID field in the mutation")
 	}
-	if m.description != nil {
-		fields = append(fields, bronzereferenceoscorerule.FieldDescription)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVulnerable: %w", err)
 	}
-	return fields
+	return oldValue.Vulnerable, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
+// ResetVulnerable resets all changes to the "vulnerable" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) ResetVulnerable() {
+	m.vulnerable = nil
+}
+
+// SetVersionStartIncluding sets the "version_start_including" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) SetVersionStartIncluding(s string) {
+	m.version_start_including = &s
+}
+
+// VersionStartIncluding returns the value of the "version_start_including" field in the mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) VersionStartIncluding() (r string, exists bool) {
+	v := m.version_start_including
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersionStartIncluding returns the old "version_start_including" field's value of the BronzeReferenceNVDCPEMatch entity.
+// If the BronzeReferenceNVDCPEMatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceNVDCPEMatchMutation) OldVersionStartIncluding(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersionStartIncluding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersionStartIncluding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersionStartIncluding: %w", err)
+	}
+	return oldValue.VersionStartIncluding, nil
+}
+
+// ClearVersionStartIncluding clears the value of the "version_start_including" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) ClearVersionStartIncluding() {
+	m.version_start_including = nil
+	m.clearedFields[bronzereferencenvdcpematch.FieldVersionStartIncluding] = struct{}{}
+}
+
+// VersionStartIncludingCleared returns if the "version_start_including" field was cleared in this mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) VersionStartIncludingCleared() bool {
+	_, ok := m.clearedFields[bronzereferencenvdcpematch.FieldVersionStartIncluding]
+	return ok
+}
+
+// ResetVersionStartIncluding resets all changes to the "version_start_including" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) ResetVersionStartIncluding() {
+	m.version_start_including = nil
+	delete(m.clearedFields, bronzereferencenvdcpematch.FieldVersionStartIncluding)
+}
+
+// SetVersionStartExcluding sets the "version_start_excluding" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) SetVersionStartExcluding(s string) {
+	m.version_start_excluding = &s
+}
+
+// VersionStartExcluding returns the value of the "version_start_excluding" field in the mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) VersionStartExcluding() (r string, exists bool) {
+	v := m.version_start_excluding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersionStartExcluding returns the old "version_start_excluding" field's value of the BronzeReferenceNVDCPEMatch entity.
+// If the BronzeReferenceNVDCPEMatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceNVDCPEMatchMutation) OldVersionStartExcluding(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersionStartExcluding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersionStartExcluding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersionStartExcluding: %w", err)
+	}
+	return oldValue.VersionStartExcluding, nil
+}
+
+// ClearVersionStartExcluding clears the value of the "version_start_excluding" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) ClearVersionStartExcluding() {
+	m.version_start_excluding = nil
+	m.clearedFields[bronzereferencenvdcpematch.FieldVersionStartExcluding] = struct{}{}
+}
+
+// VersionStartExcludingCleared returns if the "version_start_excluding" field was cleared in this mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) VersionStartExcludingCleared() bool {
+	_, ok := m.clearedFields[bronzereferencenvdcpematch.FieldVersionStartExcluding]
+	return ok
+}
+
+// ResetVersionStartExcluding resets all changes to the "version_start_excluding" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) ResetVersionStartExcluding() {
+	m.version_start_excluding = nil
+	delete(m.clearedFields, bronzereferencenvdcpematch.FieldVersionStartExcluding)
+}
+
+// SetVersionEndIncluding sets the "version_end_including" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) SetVersionEndIncluding(s string) {
+	m.version_end_including = &s
+}
+
+// VersionEndIncluding returns the value of the "version_end_including" field in the mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) VersionEndIncluding() (r string, exists bool) {
+	v := m.version_end_including
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersionEndIncluding returns the old "version_end_including" field's value of the BronzeReferenceNVDCPEMatch entity.
+// If the BronzeReferenceNVDCPEMatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceNVDCPEMatchMutation) OldVersionEndIncluding(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersionEndIncluding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersionEndIncluding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersionEndIncluding: %w", err)
+	}
+	return oldValue.VersionEndIncluding, nil
+}
+
+// ClearVersionEndIncluding clears the value of the "version_end_including" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) ClearVersionEndIncluding() {
+	m.version_end_including = nil
+	m.clearedFields[bronzereferencenvdcpematch.FieldVersionEndIncluding] = struct{}{}
+}
+
+// VersionEndIncludingCleared returns if the "version_end_including" field was cleared in this mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) VersionEndIncludingCleared() bool {
+	_, ok := m.clearedFields[bronzereferencenvdcpematch.FieldVersionEndIncluding]
+	return ok
+}
+
+// ResetVersionEndIncluding resets all changes to the "version_end_including" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) ResetVersionEndIncluding() {
+	m.version_end_including = nil
+	delete(m.clearedFields, bronzereferencenvdcpematch.FieldVersionEndIncluding)
+}
+
+// SetVersionEndExcluding sets the "version_end_excluding" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) SetVersionEndExcluding(s string) {
+	m.version_end_excluding = &s
+}
+
+// VersionEndExcluding returns the value of the "version_end_excluding" field in the mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) VersionEndExcluding() (r string, exists bool) {
+	v := m.version_end_excluding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersionEndExcluding returns the old "version_end_excluding" field's value of the BronzeReferenceNVDCPEMatch entity.
+// If the BronzeReferenceNVDCPEMatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceNVDCPEMatchMutation) OldVersionEndExcluding(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersionEndExcluding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersionEndExcluding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersionEndExcluding: %w", err)
+	}
+	return oldValue.VersionEndExcluding, nil
+}
+
+// ClearVersionEndExcluding clears the value of the "version_end_excluding" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) ClearVersionEndExcluding() {
+	m.version_end_excluding = nil
+	m.clearedFields[bronzereferencenvdcpematch.FieldVersionEndExcluding] = struct{}{}
+}
+
+// VersionEndExcludingCleared returns if the "version_end_excluding" field was cleared in this mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) VersionEndExcludingCleared() bool {
+	_, ok := m.clearedFields[bronzereferencenvdcpematch.FieldVersionEndExcluding]
+	return ok
+}
+
+// ResetVersionEndExcluding resets all changes to the "version_end_excluding" field.
+func (m *BronzeReferenceNVDCPEMatchMutation) ResetVersionEndExcluding() {
+	m.version_end_excluding = nil
+	delete(m.clearedFields, bronzereferencenvdcpematch.FieldVersionEndExcluding)
+}
+
+// Where appends a list predicates to the BronzeReferenceNVDCPEMatchMutation builder.
+func (m *BronzeReferenceNVDCPEMatchMutation) Where(ps ...predicate.BronzeReferenceNVDCPEMatch) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeReferenceNVDCPEMatchMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeReferenceNVDCPEMatchMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeReferenceNVDCPEMatch, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeReferenceNVDCPEMatchMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeReferenceNVDCPEMatchMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeReferenceNVDCPEMatch).
+func (m *BronzeReferenceNVDCPEMatchMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeReferenceNVDCPEMatchMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.collected_at != nil {
+		fields = append(fields, bronzereferencenvdcpematch.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzereferencenvdcpematch.FieldFirstCollectedAt)
+	}
+	if m.cve_id != nil {
+		fields = append(fields, bronzereferencenvdcpematch.FieldCveID)
+	}
+	if m.criteria != nil {
+		fields = append(fields, bronzereferencenvdcpematch.FieldCriteria)
+	}
+	if m.part != nil {
+		fields = append(fields, bronzereferencenvdcpematch.FieldPart)
+	}
+	if m.cpe_vendor != nil {
+		fields = append(fields, bronzereferencenvdcpematch.FieldCpeVendor)
+	}
+	if m.cpe_product != nil {
+		fields = append(fields, bronzereferencenvdcpematch.FieldCpeProduct)
+	}
+	if m.cpe_version != nil {
+		fields = append(fields, bronzereferencenvdcpematch.FieldCpeVersion)
+	}
+	if m.vulnerable != nil {
+		fields = append(fields, bronzereferencenvdcpematch.FieldVulnerable)
+	}
+	if m.version_start_including != nil {
+		fields = append(fields, bronzereferencenvdcpematch.FieldVersionStartIncluding)
+	}
+	if m.version_start_excluding != nil {
+		fields = append(fields, bronzereferencenvdcpematch.FieldVersionStartExcluding)
+	}
+	if m.version_end_including != nil {
+		fields = append(fields, bronzereferencenvdcpematch.FieldVersionEndIncluding)
+	}
+	if m.version_end_excluding != nil {
+		fields = append(fields, bronzereferencenvdcpematch.FieldVersionEndExcluding)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeReferenceNVDCPEMatchMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzereferencenvdcpematch.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzereferencenvdcpematch.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzereferencenvdcpematch.FieldCveID:
+		return m.CveID()
+	case bronzereferencenvdcpematch.FieldCriteria:
+		return m.Criteria()
+	case bronzereferencenvdcpematch.FieldPart:
+		return m.Part()
+	case bronzereferencenvdcpematch.FieldCpeVendor:
+		return m.CpeVendor()
+	case bronzereferencenvdcpematch.FieldCpeProduct:
+		return m.CpeProduct()
+	case bronzereferencenvdcpematch.FieldCpeVersion:
+		return m.CpeVersion()
+	case bronzereferencenvdcpematch.FieldVulnerable:
+		return m.Vulnerable()
+	case bronzereferencenvdcpematch.FieldVersionStartIncluding:
+		return m.VersionStartIncluding()
+	case bronzereferencenvdcpematch.FieldVersionStartExcluding:
+		return m.VersionStartExcluding()
+	case bronzereferencenvdcpematch.FieldVersionEndIncluding:
+		return m.VersionEndIncluding()
+	case bronzereferencenvdcpematch.FieldVersionEndExcluding:
+		return m.VersionEndExcluding()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeReferenceNVDCPEMatchMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzereferencenvdcpematch.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzereferencenvdcpematch.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzereferencenvdcpematch.FieldCveID:
+		return m.OldCveID(ctx)
+	case bronzereferencenvdcpematch.FieldCriteria:
+		return m.OldCriteria(ctx)
+	case bronzereferencenvdcpematch.FieldPart:
+		return m.OldPart(ctx)
+	case bronzereferencenvdcpematch.FieldCpeVendor:
+		return m.OldCpeVendor(ctx)
+	case bronzereferencenvdcpematch.FieldCpeProduct:
+		return m.OldCpeProduct(ctx)
+	case bronzereferencenvdcpematch.FieldCpeVersion:
+		return m.OldCpeVersion(ctx)
+	case bronzereferencenvdcpematch.FieldVulnerable:
+		return m.OldVulnerable(ctx)
+	case bronzereferencenvdcpematch.FieldVersionStartIncluding:
+		return m.OldVersionStartIncluding(ctx)
+	case bronzereferencenvdcpematch.FieldVersionStartExcluding:
+		return m.OldVersionStartExcluding(ctx)
+	case bronzereferencenvdcpematch.FieldVersionEndIncluding:
+		return m.OldVersionEndIncluding(ctx)
+	case bronzereferencenvdcpematch.FieldVersionEndExcluding:
+		return m.OldVersionEndExcluding(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeReferenceNVDCPEMatch field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeReferenceNVDCPEMatchMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzereferencenvdcpematch.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzereferencenvdcpematch.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzereferencenvdcpematch.FieldCveID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCveID(v)
+		return nil
+	case bronzereferencenvdcpematch.FieldCriteria:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCriteria(v)
+		return nil
+	case bronzereferencenvdcpematch.FieldPart:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPart(v)
+		return nil
+	case bronzereferencenvdcpematch.FieldCpeVendor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCpeVendor(v)
+		return nil
+	case bronzereferencenvdcpematch.FieldCpeProduct:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCpeProduct(v)
+		return nil
+	case bronzereferencenvdcpematch.FieldCpeVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCpeVersion(v)
+		return nil
+	case bronzereferencenvdcpematch.FieldVulnerable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVulnerable(v)
+		return nil
+	case bronzereferencenvdcpematch.FieldVersionStartIncluding:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersionStartIncluding(v)
+		return nil
+	case bronzereferencenvdcpematch.FieldVersionStartExcluding:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersionStartExcluding(v)
+		return nil
+	case bronzereferencenvdcpematch.FieldVersionEndIncluding:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersionEndIncluding(v)
+		return nil
+	case bronzereferencenvdcpematch.FieldVersionEndExcluding:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersionEndExcluding(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeReferenceNVDCPEMatch field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeReferenceNVDCPEMatchMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeReferenceNVDCPEMatchMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeReferenceNVDCPEMatch numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzereferencenvdcpematch.FieldVersionStartIncluding) {
+		fields = append(fields, bronzereferencenvdcpematch.FieldVersionStartIncluding)
+	}
+	if m.FieldCleared(bronzereferencenvdcpematch.FieldVersionStartExcluding) {
+		fields = append(fields, bronzereferencenvdcpematch.FieldVersionStartExcluding)
+	}
+	if m.FieldCleared(bronzereferencenvdcpematch.FieldVersionEndIncluding) {
+		fields = append(fields, bronzereferencenvdcpematch.FieldVersionEndIncluding)
+	}
+	if m.FieldCleared(bronzereferencenvdcpematch.FieldVersionEndExcluding) {
+		fields = append(fields, bronzereferencenvdcpematch.FieldVersionEndExcluding)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeReferenceNVDCPEMatchMutation) ClearField(name string) error {
+	switch name {
+	case bronzereferencenvdcpematch.FieldVersionStartIncluding:
+		m.ClearVersionStartIncluding()
+		return nil
+	case bronzereferencenvdcpematch.FieldVersionStartExcluding:
+		m.ClearVersionStartExcluding()
+		return nil
+	case bronzereferencenvdcpematch.FieldVersionEndIncluding:
+		m.ClearVersionEndIncluding()
+		return nil
+	case bronzereferencenvdcpematch.FieldVersionEndExcluding:
+		m.ClearVersionEndExcluding()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeReferenceNVDCPEMatch nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeReferenceNVDCPEMatchMutation) ResetField(name string) error {
+	switch name {
+	case bronzereferencenvdcpematch.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzereferencenvdcpematch.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzereferencenvdcpematch.FieldCveID:
+		m.ResetCveID()
+		return nil
+	case bronzereferencenvdcpematch.FieldCriteria:
+		m.ResetCriteria()
+		return nil
+	case bronzereferencenvdcpematch.FieldPart:
+		m.ResetPart()
+		return nil
+	case bronzereferencenvdcpematch.FieldCpeVendor:
+		m.ResetCpeVendor()
+		return nil
+	case bronzereferencenvdcpematch.FieldCpeProduct:
+		m.ResetCpeProduct()
+		return nil
+	case bronzereferencenvdcpematch.FieldCpeVersion:
+		m.ResetCpeVersion()
+		return nil
+	case bronzereferencenvdcpematch.FieldVulnerable:
+		m.ResetVulnerable()
+		return nil
+	case bronzereferencenvdcpematch.FieldVersionStartIncluding:
+		m.ResetVersionStartIncluding()
+		return nil
+	case bronzereferencenvdcpematch.FieldVersionStartExcluding:
+		m.ResetVersionStartExcluding()
+		return nil
+	case bronzereferencenvdcpematch.FieldVersionEndIncluding:
+		m.ResetVersionEndIncluding()
+		return nil
+	case bronzereferencenvdcpematch.FieldVersionEndExcluding:
+		m.ResetVersionEndExcluding()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeReferenceNVDCPEMatch field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeReferenceNVDCPEMatchMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeReferenceNVDCPEMatchMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeReferenceNVDCPEMatch unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeReferenceNVDCPEMatchMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeReferenceNVDCPEMatch edge %s", name)
+}
+
+// BronzeReferenceNVDCVEMutation represents an operation that mutates the BronzeReferenceNVDCVE nodes in the graph.
+type BronzeReferenceNVDCVEMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	collected_at       *time.Time
+	first_collected_at *time.Time
+	description        *string
+	vuln_status        *string
+	cvss_version       *string
+	cvss_score         *float64
+	addcvss_score      *float64
+	cvss_severity      *string
+	cvss_vector        *string
+	cwes               *[]string
+	appendcwes         []string
+	published          *time.Time
+	last_modified      *time.Time
+	cisa_exploit_add   *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*BronzeReferenceNVDCVE, error)
+	predicates         []predicate.BronzeReferenceNVDCVE
+}
+
+var _ ent.Mutation = (*BronzeReferenceNVDCVEMutation)(nil)
+
+// bronzereferencenvdcveOption allows management of the mutation configuration using functional options.
+type bronzereferencenvdcveOption func(*BronzeReferenceNVDCVEMutation)
+
+// newBronzeReferenceNVDCVEMutation creates new mutation for the BronzeReferenceNVDCVE entity.
+func newBronzeReferenceNVDCVEMutation(c config, op Op, opts ...bronzereferencenvdcveOption) *BronzeReferenceNVDCVEMutation {
+	m := &BronzeReferenceNVDCVEMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeReferenceNVDCVE,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeReferenceNVDCVEID sets the ID field of the mutation.
+func withBronzeReferenceNVDCVEID(id string) bronzereferencenvdcveOption {
+	return func(m *BronzeReferenceNVDCVEMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeReferenceNVDCVE
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeReferenceNVDCVE, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeReferenceNVDCVE.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeReferenceNVDCVE sets the old BronzeReferenceNVDCVE of the mutation.
+func withBronzeReferenceNVDCVE(node *BronzeReferenceNVDCVE) bronzereferencenvdcveOption {
+	return func(m *BronzeReferenceNVDCVEMutation) {
+		m.oldValue = func(context.Context) (*BronzeReferenceNVDCVE, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeReferenceNVDCVEMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeReferenceNVDCVEMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("reference: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeReferenceNVDCVE entities.
+func (m *BronzeReferenceNVDCVEMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeReferenceNVDCVEMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeReferenceNVDCVEMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeReferenceNVDCVE.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeReferenceNVDCVEMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeReferenceNVDCVEMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeReferenceNVDCVE entity.
+// If the BronzeReferenceNVDCVE object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceNVDCVEMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeReferenceNVDCVEMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeReferenceNVDCVEMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeReferenceNVDCVEMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeReferenceNVDCVE entity.
+// If the BronzeReferenceNVDCVE object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceNVDCVEMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeReferenceNVDCVEMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *BronzeReferenceNVDCVEMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *BronzeReferenceNVDCVEMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the BronzeReferenceNVDCVE entity.
+// If the BronzeReferenceNVDCVE object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceNVDCVEMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *BronzeReferenceNVDCVEMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[bronzereferencenvdcve.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *BronzeReferenceNVDCVEMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[bronzereferencenvdcve.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *BronzeReferenceNVDCVEMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, bronzereferencenvdcve.FieldDescription)
+}
+
+// SetVulnStatus sets the "vuln_status" field.
+func (m *BronzeReferenceNVDCVEMutation) SetVulnStatus(s string) {
+	m.vuln_status = &s
+}
+
+// VulnStatus returns the value of the "vuln_status" field in the mutation.
+func (m *BronzeReferenceNVDCVEMutation) VulnStatus() (r string, exists bool) {
+	v := m.vuln_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVulnStatus returns the old "vuln_status" field's value of the BronzeReferenceNVDCVE entity.
+// If the BronzeReferenceNVDCVE object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceNVDCVEMutation) OldVulnStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVulnStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVulnStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVulnStatus: %w", err)
+	}
+	return oldValue.VulnStatus, nil
+}
+
+// ClearVulnStatus clears the value of the "vuln_status" field.
+func (m *BronzeReferenceNVDCVEMutation) ClearVulnStatus() {
+	m.vuln_status = nil
+	m.clearedFields[bronzereferencenvdcve.FieldVulnStatus] = struct{}{}
+}
+
+// VulnStatusCleared returns if the "vuln_status" field was cleared in this mutation.
+func (m *BronzeReferenceNVDCVEMutation) VulnStatusCleared() bool {
+	_, ok := m.clearedFields[bronzereferencenvdcve.FieldVulnStatus]
+	return ok
+}
+
+// ResetVulnStatus resets all changes to the "vuln_status" field.
+func (m *BronzeReferenceNVDCVEMutation) ResetVulnStatus() {
+	m.vuln_status = nil
+	delete(m.clearedFields, bronzereferencenvdcve.FieldVulnStatus)
+}
+
+// SetCvssVersion sets the "cvss_version" field.
+func (m *BronzeReferenceNVDCVEMutation) SetCvssVersion(s string) {
+	m.cvss_version = &s
+}
+
+// CvssVersion returns the value of the "cvss_version" field in the mutation.
+func (m *BronzeReferenceNVDCVEMutation) CvssVersion() (r string, exists bool) {
+	v := m.cvss_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCvssVersion returns the old "cvss_version" field's value of the BronzeReferenceNVDCVE entity.
+// If the BronzeReferenceNVDCVE object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceNVDCVEMutation) OldCvssVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCvssVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCvssVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCvssVersion: %w", err)
+	}
+	return oldValue.CvssVersion, nil
+}
+
+// ClearCvssVersion clears the value of the "cvss_version" field.
+func (m *BronzeReferenceNVDCVEMutation) ClearCvssVersion() {
+	m.cvss_version = nil
+	m.clearedFields[bronzereferencenvdcve.FieldCvssVersion] = struct{}{}
+}
+
+// CvssVersionCleared returns if the "cvss_version" field was cleared in this mutation.
+func (m *BronzeReferenceNVDCVEMutation) CvssVersionCleared() bool {
+	_, ok := m.clearedFields[bronzereferencenvdcve.FieldCvssVersion]
+	return ok
+}
+
+// ResetCvssVersion resets all changes to the "cvss_version" field.
+func (m *BronzeReferenceNVDCVEMutation) ResetCvssVersion() {
+	m.cvss_version = nil
+	delete(m.clearedFields, bronzereferencenvdcve.FieldCvssVersion)
+}
+
+// SetCvssScore sets the "cvss_score" field.
+func (m *BronzeReferenceNVDCVEMutation) SetCvssScore(f float64) {
+	m.cvss_score = &f
+	m.addcvss_score = nil
+}
+
+// CvssScore returns the value of the "cvss_score" field in the mutation.
+func (m *BronzeReferenceNVDCVEMutation) CvssScore() (r float64, exists bool) {
+	v := m.cvss_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCvssScore returns the old "cvss_score" field's value of the BronzeReferenceNVDCVE entity.
+// If the BronzeReferenceNVDCVE object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceNVDCVEMutation) OldCvssScore(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCvssScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCvssScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCvssScore: %w", err)
+	}
+	return oldValue.CvssScore, nil
+}
+
+// AddCvssScore adds f to the "cvss_score" field.
+func (m *BronzeReferenceNVDCVEMutation) AddCvssScore(f float64) {
+	if m.addcvss_score != nil {
+		*m.addcvss_score += f
+	} else {
+		m.addcvss_score = &f
+	}
+}
+
+// AddedCvssScore returns the value that was added to the "cvss_score" field in this mutation.
+func (m *BronzeReferenceNVDCVEMutation) AddedCvssScore() (r float64, exists bool) {
+	v := m.addcvss_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCvssScore clears the value of the "cvss_score" field.
+func (m *BronzeReferenceNVDCVEMutation) ClearCvssScore() {
+	m.cvss_score = nil
+	m.addcvss_score = nil
+	m.clearedFields[bronzereferencenvdcve.FieldCvssScore] = struct{}{}
+}
+
+// CvssScoreCleared returns if the "cvss_score" field was cleared in this mutation.
+func (m *BronzeReferenceNVDCVEMutation) CvssScoreCleared() bool {
+	_, ok := m.clearedFields[bronzereferencenvdcve.FieldCvssScore]
+	return ok
+}
+
+// ResetCvssScore resets all changes to the "cvss_score" field.
+func (m *BronzeReferenceNVDCVEMutation) ResetCvssScore() {
+	m.cvss_score = nil
+	m.addcvss_score = nil
+	delete(m.clearedFields, bronzereferencenvdcve.FieldCvssScore)
+}
+
+// SetCvssSeverity sets the "cvss_severity" field.
+func (m *BronzeReferenceNVDCVEMutation) SetCvssSeverity(s string) {
+	m.cvss_severity = &s
+}
+
+// CvssSeverity returns the value of the "cvss_severity" field in the mutation.
+func (m *BronzeReferenceNVDCVEMutation) CvssSeverity() (r string, exists bool) {
+	v := m.cvss_severity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCvssSeverity returns the old "cvss_severity" field's value of the BronzeReferenceNVDCVE entity.
+// If the BronzeReferenceNVDCVE object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceNVDCVEMutation) OldCvssSeverity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCvssSeverity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCvssSeverity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCvssSeverity: %w", err)
+	}
+	return oldValue.CvssSeverity, nil
+}
+
+// ClearCvssSeverity clears the value of the "cvss_severity" field.
+func (m *BronzeReferenceNVDCVEMutation) ClearCvssSeverity() {
+	m.cvss_severity = nil
+	m.clearedFields[bronzereferencenvdcve.FieldCvssSeverity] = struct{}{}
+}
+
+// CvssSeverityCleared returns if the "cvss_severity" field was cleared in this mutation.
+func (m *BronzeReferenceNVDCVEMutation) CvssSeverityCleared() bool {
+	_, ok := m.clearedFields[bronzereferencenvdcve.FieldCvssSeverity]
+	return ok
+}
+
+// ResetCvssSeverity resets all changes to the "cvss_severity" field.
+func (m *BronzeReferenceNVDCVEMutation) ResetCvssSeverity() {
+	m.cvss_severity = nil
+	delete(m.clearedFields, bronzereferencenvdcve.FieldCvssSeverity)
+}
+
+// SetCvssVector sets the "cvss_vector" field.
+func (m *BronzeReferenceNVDCVEMutation) SetCvssVector(s string) {
+	m.cvss_vector = &s
+}
+
+// CvssVector returns the value of the "cvss_vector" field in the mutation.
+func (m *BronzeReferenceNVDCVEMutation) CvssVector() (r string, exists bool) {
+	v := m.cvss_vector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCvssVector returns the old "cvss_vector" field's value of the BronzeReferenceNVDCVE entity.
+// If the BronzeReferenceNVDCVE object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceNVDCVEMutation) OldCvssVector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCvssVector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCvssVector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCvssVector: %w", err)
+	}
+	return oldValue.CvssVector, nil
+}
+
+// ClearCvssVector clears the value of the "cvss_vector" field.
+func (m *BronzeReferenceNVDCVEMutation) ClearCvssVector() {
+	m.cvss_vector = nil
+	m.clearedFields[bronzereferencenvdcve.FieldCvssVector] = struct{}{}
+}
+
+// CvssVectorCleared returns if the "cvss_vector" field was cleared in this mutation.
+func (m *BronzeReferenceNVDCVEMutation) CvssVectorCleared() bool {
+	_, ok := m.clearedFields[bronzereferencenvdcve.FieldCvssVector]
+	return ok
+}
+
+// ResetCvssVector resets all changes to the "cvss_vector" field.
+func (m *BronzeReferenceNVDCVEMutation) ResetCvssVector() {
+	m.cvss_vector = nil
+	delete(m.clearedFields, bronzereferencenvdcve.FieldCvssVector)
+}
+
+// SetCwes sets the "cwes" field.
+func (m *BronzeReferenceNVDCVEMutation) SetCwes(s []string) {
+	m.cwes = &s
+	m.appendcwes = nil
+}
+
+// Cwes returns the value of the "cwes" field in the mutation.
+func (m *BronzeReferenceNVDCVEMutation) Cwes() (r []string, exists bool) {
+	v := m.cwes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCwes returns the old "cwes" field's value of the BronzeReferenceNVDCVE entity.
+// If the BronzeReferenceNVDCVE object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceNVDCVEMutation) OldCwes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCwes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCwes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCwes: %w", err)
+	}
+	return oldValue.Cwes, nil
+}
+
+// AppendCwes adds s to the "cwes" field.
+func (m *BronzeReferenceNVDCVEMutation) AppendCwes(s []string) {
+	m.appendcwes = append(m.appendcwes, s...)
+}
+
+// AppendedCwes returns the list of values that were appended to the "cwes" field in this mutation.
+func (m *BronzeReferenceNVDCVEMutation) AppendedCwes() ([]string, bool) {
+	if len(m.appendcwes) == 0 {
+		return nil, false
+	}
+	return m.appendcwes, true
+}
+
+// ClearCwes clears the value of the "cwes" field.
+func (m *BronzeReferenceNVDCVEMutation) ClearCwes() {
+	m.cwes = nil
+	m.appendcwes = nil
+	m.clearedFields[bronzereferencenvdcve.FieldCwes] = struct{}{}
+}
+
+// CwesCleared returns if the "cwes" field was cleared in this mutation.
+func (m *BronzeReferenceNVDCVEMutation) CwesCleared() bool {
+	_, ok := m.clearedFields[bronzereferencenvdcve.FieldCwes]
+	return ok
+}
+
+// ResetCwes resets all changes to the "cwes" field.
+func (m *BronzeReferenceNVDCVEMutation) ResetCwes() {
+	m.cwes = nil
+	m.appendcwes = nil
+	delete(m.clearedFields, bronzereferencenvdcve.FieldCwes)
+}
+
+// SetPublished sets the "published" field.
+func (m *BronzeReferenceNVDCVEMutation) SetPublished(t time.Time) {
+	m.published = &t
+}
+
+// Published returns the value of the "published" field in the mutation.
+func (m *BronzeReferenceNVDCVEMutation) Published() (r time.Time, exists bool) {
+	v := m.published
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublished returns the old "published" field's value of the BronzeReferenceNVDCVE entity.
+// If the BronzeReferenceNVDCVE object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceNVDCVEMutation) OldPublished(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublished is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublished requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublished: %w", err)
+	}
+	return oldValue.Published, nil
+}
+
+// ResetPublished resets all changes to the "published" field.
+func (m *BronzeReferenceNVDCVEMutation) ResetPublished() {
+	m.published = nil
+}
+
+// SetLastModified sets the "last_modified" field.
+func (m *BronzeReferenceNVDCVEMutation) SetLastModified(t time.Time) {
+	m.last_modified = &t
+}
+
+// LastModified returns the value of the "last_modified" field in the mutation.
+func (m *BronzeReferenceNVDCVEMutation) LastModified() (r time.Time, exists bool) {
+	v := m.last_modified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastModified returns the old "last_modified" field's value of the BronzeReferenceNVDCVE entity.
+// If the BronzeReferenceNVDCVE object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceNVDCVEMutation) OldLastModified(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastModified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastModified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastModified: %w", err)
+	}
+	return oldValue.LastModified, nil
+}
+
+// ResetLastModified resets all changes to the "last_modified" field.
+func (m *BronzeReferenceNVDCVEMutation) ResetLastModified() {
+	m.last_modified = nil
+}
+
+// SetCisaExploitAdd sets the "cisa_exploit_add" field.
+func (m *BronzeReferenceNVDCVEMutation) SetCisaExploitAdd(t time.Time) {
+	m.cisa_exploit_add = &t
+}
+
+// CisaExploitAdd returns the value of the "cisa_exploit_add" field in the mutation.
+func (m *BronzeReferenceNVDCVEMutation) CisaExploitAdd() (r time.Time, exists bool) {
+	v := m.cisa_exploit_add
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCisaExploitAdd returns the old "cisa_exploit_add" field's value of the BronzeReferenceNVDCVE entity.
+// If the BronzeReferenceNVDCVE object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceNVDCVEMutation) OldCisaExploitAdd(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCisaExploitAdd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCisaExploitAdd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCisaExploitAdd: %w", err)
+	}
+	return oldValue.CisaExploitAdd, nil
+}
+
+// ClearCisaExploitAdd clears the value of the "cisa_exploit_add" field.
+func (m *BronzeReferenceNVDCVEMutation) ClearCisaExploitAdd() {
+	m.cisa_exploit_add = nil
+	m.clearedFields[bronzereferencenvdcve.FieldCisaExploitAdd] = struct{}{}
+}
+
+// CisaExploitAddCleared returns if the "cisa_exploit_add" field was cleared in this mutation.
+func (m *BronzeReferenceNVDCVEMutation) CisaExploitAddCleared() bool {
+	_, ok := m.clearedFields[bronzereferencenvdcve.FieldCisaExploitAdd]
+	return ok
+}
+
+// ResetCisaExploitAdd resets all changes to the "cisa_exploit_add" field.
+func (m *BronzeReferenceNVDCVEMutation) ResetCisaExploitAdd() {
+	m.cisa_exploit_add = nil
+	delete(m.clearedFields, bronzereferencenvdcve.FieldCisaExploitAdd)
+}
+
+// Where appends a list predicates to the BronzeReferenceNVDCVEMutation builder.
+func (m *BronzeReferenceNVDCVEMutation) Where(ps ...predicate.BronzeReferenceNVDCVE) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeReferenceNVDCVEMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeReferenceNVDCVEMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeReferenceNVDCVE, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeReferenceNVDCVEMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeReferenceNVDCVEMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeReferenceNVDCVE).
+func (m *BronzeReferenceNVDCVEMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeReferenceNVDCVEMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.collected_at != nil {
+		fields = append(fields, bronzereferencenvdcve.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzereferencenvdcve.FieldFirstCollectedAt)
+	}
+	if m.description != nil {
+		fields = append(fields, bronzereferencenvdcve.FieldDescription)
+	}
+	if m.vuln_status != nil {
+		fields = append(fields, bronzereferencenvdcve.FieldVulnStatus)
+	}
+	if m.cvss_version != nil {
+		fields = append(fields, bronzereferencenvdcve.FieldCvssVersion)
+	}
+	if m.cvss_score != nil {
+		fields = append(fields, bronzereferencenvdcve.FieldCvssScore)
+	}
+	if m.cvss_severity != nil {
+		fields = append(fields, bronzereferencenvdcve.FieldCvssSeverity)
+	}
+	if m.cvss_vector != nil {
+		fields = append(fields, bronzereferencenvdcve.FieldCvssVector)
+	}
+	if m.cwes != nil {
+		fields = append(fields, bronzereferencenvdcve.FieldCwes)
+	}
+	if m.published != nil {
+		fields = append(fields, bronzereferencenvdcve.FieldPublished)
+	}
+	if m.last_modified != nil {
+		fields = append(fields, bronzereferencenvdcve.FieldLastModified)
+	}
+	if m.cisa_exploit_add != nil {
+		fields = append(fields, bronzereferencenvdcve.FieldCisaExploitAdd)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeReferenceNVDCVEMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzereferencenvdcve.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzereferencenvdcve.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzereferencenvdcve.FieldDescription:
+		return m.Description()
+	case bronzereferencenvdcve.FieldVulnStatus:
+		return m.VulnStatus()
+	case bronzereferencenvdcve.FieldCvssVersion:
+		return m.CvssVersion()
+	case bronzereferencenvdcve.FieldCvssScore:
+		return m.CvssScore()
+	case bronzereferencenvdcve.FieldCvssSeverity:
+		return m.CvssSeverity()
+	case bronzereferencenvdcve.FieldCvssVector:
+		return m.CvssVector()
+	case bronzereferencenvdcve.FieldCwes:
+		return m.Cwes()
+	case bronzereferencenvdcve.FieldPublished:
+		return m.Published()
+	case bronzereferencenvdcve.FieldLastModified:
+		return m.LastModified()
+	case bronzereferencenvdcve.FieldCisaExploitAdd:
+		return m.CisaExploitAdd()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeReferenceNVDCVEMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzereferencenvdcve.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzereferencenvdcve.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzereferencenvdcve.FieldDescription:
+		return m.OldDescription(ctx)
+	case bronzereferencenvdcve.FieldVulnStatus:
+		return m.OldVulnStatus(ctx)
+	case bronzereferencenvdcve.FieldCvssVersion:
+		return m.OldCvssVersion(ctx)
+	case bronzereferencenvdcve.FieldCvssScore:
+		return m.OldCvssScore(ctx)
+	case bronzereferencenvdcve.FieldCvssSeverity:
+		return m.OldCvssSeverity(ctx)
+	case bronzereferencenvdcve.FieldCvssVector:
+		return m.OldCvssVector(ctx)
+	case bronzereferencenvdcve.FieldCwes:
+		return m.OldCwes(ctx)
+	case bronzereferencenvdcve.FieldPublished:
+		return m.OldPublished(ctx)
+	case bronzereferencenvdcve.FieldLastModified:
+		return m.OldLastModified(ctx)
+	case bronzereferencenvdcve.FieldCisaExploitAdd:
+		return m.OldCisaExploitAdd(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeReferenceNVDCVE field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeReferenceNVDCVEMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzereferencenvdcve.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzereferencenvdcve.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzereferencenvdcve.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case bronzereferencenvdcve.FieldVulnStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVulnStatus(v)
+		return nil
+	case bronzereferencenvdcve.FieldCvssVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCvssVersion(v)
+		return nil
+	case bronzereferencenvdcve.FieldCvssScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCvssScore(v)
+		return nil
+	case bronzereferencenvdcve.FieldCvssSeverity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCvssSeverity(v)
+		return nil
+	case bronzereferencenvdcve.FieldCvssVector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCvssVector(v)
+		return nil
+	case bronzereferencenvdcve.FieldCwes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCwes(v)
+		return nil
+	case bronzereferencenvdcve.FieldPublished:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublished(v)
+		return nil
+	case bronzereferencenvdcve.FieldLastModified:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastModified(v)
+		return nil
+	case bronzereferencenvdcve.FieldCisaExploitAdd:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCisaExploitAdd(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeReferenceNVDCVE field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeReferenceNVDCVEMutation) AddedFields() []string {
+	var fields []string
+	if m.addcvss_score != nil {
+		fields = append(fields, bronzereferencenvdcve.FieldCvssScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeReferenceNVDCVEMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case bronzereferencenvdcve.FieldCvssScore:
+		return m.AddedCvssScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeReferenceNVDCVEMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case bronzereferencenvdcve.FieldCvssScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCvssScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeReferenceNVDCVE numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeReferenceNVDCVEMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzereferencenvdcve.FieldDescription) {
+		fields = append(fields, bronzereferencenvdcve.FieldDescription)
+	}
+	if m.FieldCleared(bronzereferencenvdcve.FieldVulnStatus) {
+		fields = append(fields, bronzereferencenvdcve.FieldVulnStatus)
+	}
+	if m.FieldCleared(bronzereferencenvdcve.FieldCvssVersion) {
+		fields = append(fields, bronzereferencenvdcve.FieldCvssVersion)
+	}
+	if m.FieldCleared(bronzereferencenvdcve.FieldCvssScore) {
+		fields = append(fields, bronzereferencenvdcve.FieldCvssScore)
+	}
+	if m.FieldCleared(bronzereferencenvdcve.FieldCvssSeverity) {
+		fields = append(fields, bronzereferencenvdcve.FieldCvssSeverity)
+	}
+	if m.FieldCleared(bronzereferencenvdcve.FieldCvssVector) {
+		fields = append(fields, bronzereferencenvdcve.FieldCvssVector)
+	}
+	if m.FieldCleared(bronzereferencenvdcve.FieldCwes) {
+		fields = append(fields, bronzereferencenvdcve.FieldCwes)
+	}
+	if m.FieldCleared(bronzereferencenvdcve.FieldCisaExploitAdd) {
+		fields = append(fields, bronzereferencenvdcve.FieldCisaExploitAdd)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeReferenceNVDCVEMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeReferenceNVDCVEMutation) ClearField(name string) error {
+	switch name {
+	case bronzereferencenvdcve.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case bronzereferencenvdcve.FieldVulnStatus:
+		m.ClearVulnStatus()
+		return nil
+	case bronzereferencenvdcve.FieldCvssVersion:
+		m.ClearCvssVersion()
+		return nil
+	case bronzereferencenvdcve.FieldCvssScore:
+		m.ClearCvssScore()
+		return nil
+	case bronzereferencenvdcve.FieldCvssSeverity:
+		m.ClearCvssSeverity()
+		return nil
+	case bronzereferencenvdcve.FieldCvssVector:
+		m.ClearCvssVector()
+		return nil
+	case bronzereferencenvdcve.FieldCwes:
+		m.ClearCwes()
+		return nil
+	case bronzereferencenvdcve.FieldCisaExploitAdd:
+		m.ClearCisaExploitAdd()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeReferenceNVDCVE nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeReferenceNVDCVEMutation) ResetField(name string) error {
+	switch name {
+	case bronzereferencenvdcve.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzereferencenvdcve.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzereferencenvdcve.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case bronzereferencenvdcve.FieldVulnStatus:
+		m.ResetVulnStatus()
+		return nil
+	case bronzereferencenvdcve.FieldCvssVersion:
+		m.ResetCvssVersion()
+		return nil
+	case bronzereferencenvdcve.FieldCvssScore:
+		m.ResetCvssScore()
+		return nil
+	case bronzereferencenvdcve.FieldCvssSeverity:
+		m.ResetCvssSeverity()
+		return nil
+	case bronzereferencenvdcve.FieldCvssVector:
+		m.ResetCvssVector()
+		return nil
+	case bronzereferencenvdcve.FieldCwes:
+		m.ResetCwes()
+		return nil
+	case bronzereferencenvdcve.FieldPublished:
+		m.ResetPublished()
+		return nil
+	case bronzereferencenvdcve.FieldLastModified:
+		m.ResetLastModified()
+		return nil
+	case bronzereferencenvdcve.FieldCisaExploitAdd:
+		m.ResetCisaExploitAdd()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeReferenceNVDCVE field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeReferenceNVDCVEMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeReferenceNVDCVEMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeReferenceNVDCVEMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeReferenceNVDCVEMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeReferenceNVDCVEMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeReferenceNVDCVEMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeReferenceNVDCVEMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeReferenceNVDCVE unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeReferenceNVDCVEMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeReferenceNVDCVE edge %s", name)
+}
+
+// BronzeReferenceOSCoreRuleMutation represents an operation that mutates the BronzeReferenceOSCoreRule nodes in the graph.
+type BronzeReferenceOSCoreRuleMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	collected_at       *time.Time
+	first_collected_at *time.Time
+	rule_type          *string
+	os_type            *string
+	value              *string
+	description        *string
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*BronzeReferenceOSCoreRule, error)
+	predicates         []predicate.BronzeReferenceOSCoreRule
+}
+
+var _ ent.Mutation = (*BronzeReferenceOSCoreRuleMutation)(nil)
+
+// bronzereferenceoscoreruleOption allows management of the mutation configuration using functional options.
+type bronzereferenceoscoreruleOption func(*BronzeReferenceOSCoreRuleMutation)
+
+// newBronzeReferenceOSCoreRuleMutation creates new mutation for the BronzeReferenceOSCoreRule entity.
+func newBronzeReferenceOSCoreRuleMutation(c config, op Op, opts ...bronzereferenceoscoreruleOption) *BronzeReferenceOSCoreRuleMutation {
+	m := &BronzeReferenceOSCoreRuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeReferenceOSCoreRule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeReferenceOSCoreRuleID sets the ID field of the mutation.
+func withBronzeReferenceOSCoreRuleID(id string) bronzereferenceoscoreruleOption {
+	return func(m *BronzeReferenceOSCoreRuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeReferenceOSCoreRule
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeReferenceOSCoreRule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeReferenceOSCoreRule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeReferenceOSCoreRule sets the old BronzeReferenceOSCoreRule of the mutation.
+func withBronzeReferenceOSCoreRule(node *BronzeReferenceOSCoreRule) bronzereferenceoscoreruleOption {
+	return func(m *BronzeReferenceOSCoreRuleMutation) {
+		m.oldValue = func(context.Context) (*BronzeReferenceOSCoreRule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeReferenceOSCoreRuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeReferenceOSCoreRuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("reference: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeReferenceOSCoreRule entities.
+func (m *BronzeReferenceOSCoreRuleMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeReferenceOSCoreRuleMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeReferenceOSCoreRuleMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeReferenceOSCoreRule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeReferenceOSCoreRuleMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeReferenceOSCoreRuleMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeReferenceOSCoreRule entity.
+// If the BronzeReferenceOSCoreRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSCoreRuleMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeReferenceOSCoreRuleMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeReferenceOSCoreRuleMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeReferenceOSCoreRuleMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeReferenceOSCoreRule entity.
+// If the BronzeReferenceOSCoreRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSCoreRuleMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeReferenceOSCoreRuleMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetRuleType sets the "rule_type" field.
+func (m *BronzeReferenceOSCoreRuleMutation) SetRuleType(s string) {
+	m.rule_type = &s
+}
+
+// RuleType returns the value of the "rule_type" field in the mutation.
+func (m *BronzeReferenceOSCoreRuleMutation) RuleType() (r string, exists bool) {
+	v := m.rule_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRuleType returns the old "rule_type" field's value of the BronzeReferenceOSCoreRule entity.
+// If the BronzeReferenceOSCoreRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSCoreRuleMutation) OldRuleType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRuleType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRuleType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRuleType: %w", err)
+	}
+	return oldValue.RuleType, nil
+}
+
+// ResetRuleType resets all changes to the "rule_type" field.
+func (m *BronzeReferenceOSCoreRuleMutation) ResetRuleType() {
+	m.rule_type = nil
+}
+
+// SetOsType sets the "os_type" field.
+func (m *BronzeReferenceOSCoreRuleMutation) SetOsType(s string) {
+	m.os_type = &s
+}
+
+// OsType returns the value of the "os_type" field in the mutation.
+func (m *BronzeReferenceOSCoreRuleMutation) OsType() (r string, exists bool) {
+	v := m.os_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOsType returns the old "os_type" field's value of the BronzeReferenceOSCoreRule entity.
+// If the BronzeReferenceOSCoreRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSCoreRuleMutation) OldOsType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOsType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOsType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOsType: %w", err)
+	}
+	return oldValue.OsType, nil
+}
+
+// ClearOsType clears the value of the "os_type" field.
+func (m *BronzeReferenceOSCoreRuleMutation) ClearOsType() {
+	m.os_type = nil
+	m.clearedFields[bronzereferenceoscorerule.FieldOsType] = struct{}{}
+}
+
+// OsTypeCleared returns if the "os_type" field was cleared in this mutation.
+func (m *BronzeReferenceOSCoreRuleMutation) OsTypeCleared() bool {
+	_, ok := m.clearedFields[bronzereferenceoscorerule.FieldOsType]
+	return ok
+}
+
+// ResetOsType resets all changes to the "os_type" field.
+func (m *BronzeReferenceOSCoreRuleMutation) ResetOsType() {
+	m.os_type = nil
+	delete(m.clearedFields, bronzereferenceoscorerule.FieldOsType)
+}
+
+// SetValue sets the "value" field.
+func (m *BronzeReferenceOSCoreRuleMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *BronzeReferenceOSCoreRuleMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the BronzeReferenceOSCoreRule entity.
+// If the BronzeReferenceOSCoreRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSCoreRuleMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *BronzeReferenceOSCoreRuleMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *BronzeReferenceOSCoreRuleMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *BronzeReferenceOSCoreRuleMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the BronzeReferenceOSCoreRule entity.
+// If the BronzeReferenceOSCoreRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSCoreRuleMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *BronzeReferenceOSCoreRuleMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[bronzereferenceoscorerule.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *BronzeReferenceOSCoreRuleMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[bronzereferenceoscorerule.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *BronzeReferenceOSCoreRuleMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, bronzereferenceoscorerule.FieldDescription)
+}
+
+// Where appends a list predicates to the BronzeReferenceOSCoreRuleMutation builder.
+func (m *BronzeReferenceOSCoreRuleMutation) Where(ps ...predicate.BronzeReferenceOSCoreRule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeReferenceOSCoreRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeReferenceOSCoreRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeReferenceOSCoreRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeReferenceOSCoreRuleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeReferenceOSCoreRuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeReferenceOSCoreRule).
+func (m *BronzeReferenceOSCoreRuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeReferenceOSCoreRuleMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.collected_at != nil {
+		fields = append(fields, bronzereferenceoscorerule.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzereferenceoscorerule.FieldFirstCollectedAt)
+	}
+	if m.rule_type != nil {
+		fields = append(fields, bronzereferenceoscorerule.FieldRuleType)
+	}
+	if m.os_type != nil {
+		fields = append(fields, bronzereferenceoscorerule.FieldOsType)
+	}
+	if m.value != nil {
+		fields = append(fields, bronzereferenceoscorerule.FieldValue)
+	}
+	if m.description != nil {
+		fields = append(fields, bronzereferenceoscorerule.FieldDescription)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeReferenceOSCoreRuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzereferenceoscorerule.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzereferenceoscorerule.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzereferenceoscorerule.FieldRuleType:
+		return m.RuleType()
+	case bronzereferenceoscorerule.FieldOsType:
+		return m.OsType()
+	case bronzereferenceoscorerule.FieldValue:
+		return m.Value()
+	case bronzereferenceoscorerule.FieldDescription:
+		return m.Description()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeReferenceOSCoreRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzereferenceoscorerule.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzereferenceoscorerule.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzereferenceoscorerule.FieldRuleType:
+		return m.OldRuleType(ctx)
+	case bronzereferenceoscorerule.FieldOsType:
+		return m.OldOsType(ctx)
+	case bronzereferenceoscorerule.FieldValue:
+		return m.OldValue(ctx)
+	case bronzereferenceoscorerule.FieldDescription:
+		return m.OldDescription(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeReferenceOSCoreRule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeReferenceOSCoreRuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzereferenceoscorerule.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzereferenceoscorerule.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzereferenceoscorerule.FieldRuleType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRuleType(v)
+		return nil
+	case bronzereferenceoscorerule.FieldOsType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOsType(v)
+		return nil
+	case bronzereferenceoscorerule.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case bronzereferenceoscorerule.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeReferenceOSCoreRule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeReferenceOSCoreRuleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeReferenceOSCoreRuleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeReferenceOSCoreRuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeReferenceOSCoreRule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeReferenceOSCoreRuleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzereferenceoscorerule.FieldOsType) {
+		fields = append(fields, bronzereferenceoscorerule.FieldOsType)
+	}
+	if m.FieldCleared(bronzereferenceoscorerule.FieldDescription) {
+		fields = append(fields, bronzereferenceoscorerule.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeReferenceOSCoreRuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeReferenceOSCoreRuleMutation) ClearField(name string) error {
+	switch name {
+	case bronzereferenceoscorerule.FieldOsType:
+		m.ClearOsType()
+		return nil
+	case bronzereferenceoscorerule.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeReferenceOSCoreRule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeReferenceOSCoreRuleMutation) ResetField(name string) error {
+	switch name {
+	case bronzereferenceoscorerule.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzereferenceoscorerule.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzereferenceoscorerule.FieldRuleType:
+		m.ResetRuleType()
+		return nil
+	case bronzereferenceoscorerule.FieldOsType:
+		m.ResetOsType()
+		return nil
+	case bronzereferenceoscorerule.FieldValue:
+		m.ResetValue()
+		return nil
+	case bronzereferenceoscorerule.FieldDescription:
+		m.ResetDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeReferenceOSCoreRule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeReferenceOSCoreRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeReferenceOSCoreRuleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeReferenceOSCoreRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeReferenceOSCoreRuleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeReferenceOSCoreRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeReferenceOSCoreRuleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeReferenceOSCoreRuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeReferenceOSCoreRule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeReferenceOSCoreRuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeReferenceOSCoreRule edge %s", name)
+}
+
+// BronzeReferenceOSVAffectedMutation represents an operation that mutates the BronzeReferenceOSVAffected nodes in the graph.
+type BronzeReferenceOSVAffectedMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	collected_at       *time.Time
+	first_collected_at *time.Time
+	vuln_id            *string
+	ecosystem          *string
+	package_name       *string
+	purl               *string
+	range_type         *string
+	introduced         *string
+	fixed              *string
+	last_affected      *string
+	versions           *[]string
+	appendversions     []string
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*BronzeReferenceOSVAffected, error)
+	predicates         []predicate.BronzeReferenceOSVAffected
+}
+
+var _ ent.Mutation = (*BronzeReferenceOSVAffectedMutation)(nil)
+
+// bronzereferenceosvaffectedOption allows management of the mutation configuration using functional options.
+type bronzereferenceosvaffectedOption func(*BronzeReferenceOSVAffectedMutation)
+
+// newBronzeReferenceOSVAffectedMutation creates new mutation for the BronzeReferenceOSVAffected entity.
+func newBronzeReferenceOSVAffectedMutation(c config, op Op, opts ...bronzereferenceosvaffectedOption) *BronzeReferenceOSVAffectedMutation {
+	m := &BronzeReferenceOSVAffectedMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeReferenceOSVAffected,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeReferenceOSVAffectedID sets the ID field of the mutation.
+func withBronzeReferenceOSVAffectedID(id string) bronzereferenceosvaffectedOption {
+	return func(m *BronzeReferenceOSVAffectedMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeReferenceOSVAffected
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeReferenceOSVAffected, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeReferenceOSVAffected.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeReferenceOSVAffected sets the old BronzeReferenceOSVAffected of the mutation.
+func withBronzeReferenceOSVAffected(node *BronzeReferenceOSVAffected) bronzereferenceosvaffectedOption {
+	return func(m *BronzeReferenceOSVAffectedMutation) {
+		m.oldValue = func(context.Context) (*BronzeReferenceOSVAffected, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeReferenceOSVAffectedMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeReferenceOSVAffectedMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("reference: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeReferenceOSVAffected entities.
+func (m *BronzeReferenceOSVAffectedMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeReferenceOSVAffectedMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeReferenceOSVAffectedMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeReferenceOSVAffected.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeReferenceOSVAffectedMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeReferenceOSVAffectedMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeReferenceOSVAffected entity.
+// If the BronzeReferenceOSVAffected object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVAffectedMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeReferenceOSVAffectedMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeReferenceOSVAffectedMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeReferenceOSVAffectedMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeReferenceOSVAffected entity.
+// If the BronzeReferenceOSVAffected object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVAffectedMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeReferenceOSVAffectedMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetVulnID sets the "vuln_id" field.
+func (m *BronzeReferenceOSVAffectedMutation) SetVulnID(s string) {
+	m.vuln_id = &s
+}
+
+// VulnID returns the value of the "vuln_id" field in the mutation.
+func (m *BronzeReferenceOSVAffectedMutation) VulnID() (r string, exists bool) {
+	v := m.vuln_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVulnID returns the old "vuln_id" field's value of the BronzeReferenceOSVAffected entity.
+// If the BronzeReferenceOSVAffected object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVAffectedMutation) OldVulnID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVulnID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVulnID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVulnID: %w", err)
+	}
+	return oldValue.VulnID, nil
+}
+
+// ResetVulnID resets all changes to the "vuln_id" field.
+func (m *BronzeReferenceOSVAffectedMutation) ResetVulnID() {
+	m.vuln_id = nil
+}
+
+// SetEcosystem sets the "ecosystem" field.
+func (m *BronzeReferenceOSVAffectedMutation) SetEcosystem(s string) {
+	m.ecosystem = &s
+}
+
+// Ecosystem returns the value of the "ecosystem" field in the mutation.
+func (m *BronzeReferenceOSVAffectedMutation) Ecosystem() (r string, exists bool) {
+	v := m.ecosystem
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEcosystem returns the old "ecosystem" field's value of the BronzeReferenceOSVAffected entity.
+// If the BronzeReferenceOSVAffected object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVAffectedMutation) OldEcosystem(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEcosystem is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEcosystem requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEcosystem: %w", err)
+	}
+	return oldValue.Ecosystem, nil
+}
+
+// ResetEcosystem resets all changes to the "ecosystem" field.
+func (m *BronzeReferenceOSVAffectedMutation) ResetEcosystem() {
+	m.ecosystem = nil
+}
+
+// SetPackageName sets the "package_name" field.
+func (m *BronzeReferenceOSVAffectedMutation) SetPackageName(s string) {
+	m.package_name = &s
+}
+
+// PackageName returns the value of the "package_name" field in the mutation.
+func (m *BronzeReferenceOSVAffectedMutation) PackageName() (r string, exists bool) {
+	v := m.package_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageName returns the old "package_name" field's value of the BronzeReferenceOSVAffected entity.
+// If the BronzeReferenceOSVAffected object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVAffectedMutation) OldPackageName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageName: %w", err)
+	}
+	return oldValue.PackageName, nil
+}
+
+// ResetPackageName resets all changes to the "package_name" field.
+func (m *BronzeReferenceOSVAffectedMutation) ResetPackageName() {
+	m.package_name = nil
+}
+
+// SetPurl sets the "purl" field.
+func (m *BronzeReferenceOSVAffectedMutation) SetPurl(s string) {
+	m.purl = &s
+}
+
+// Purl returns the value of the "purl" field in the mutation.
+func (m *BronzeReferenceOSVAffectedMutation) Purl() (r string, exists bool) {
+	v := m.purl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPurl returns the old "purl" field's value of the BronzeReferenceOSVAffected entity.
+// If the BronzeReferenceOSVAffected object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVAffectedMutation) OldPurl(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurl is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurl requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurl: %w", err)
+	}
+	return oldValue.Purl, nil
+}
+
+// ClearPurl clears the value of the "purl" field.
+func (m *BronzeReferenceOSVAffectedMutation) ClearPurl() {
+	m.purl = nil
+	m.clearedFields[bronzereferenceosvaffected.FieldPurl] = struct{}{}
+}
+
+// PurlCleared returns if the "purl" field was cleared in this mutation.
+func (m *BronzeReferenceOSVAffectedMutation) PurlCleared() bool {
+	_, ok := m.clearedFields[bronzereferenceosvaffected.FieldPurl]
+	return ok
+}
+
+// ResetPurl resets all changes to the "purl" field.
+func (m *BronzeReferenceOSVAffectedMutation) ResetPurl() {
+	m.purl = nil
+	delete(m.clearedFields, bronzereferenceosvaffected.FieldPurl)
+}
+
+// SetRangeType sets the "range_type" field.
+func (m *BronzeReferenceOSVAffectedMutation) SetRangeType(s string) {
+	m.range_type = &s
+}
+
+// RangeType returns the value of the "range_type" field in the mutation.
+func (m *BronzeReferenceOSVAffectedMutation) RangeType() (r string, exists bool) {
+	v := m.range_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRangeType returns the old "range_type" field's value of the BronzeReferenceOSVAffected entity.
+// If the BronzeReferenceOSVAffected object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVAffectedMutation) OldRangeType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRangeType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRangeType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRangeType: %w", err)
+	}
+	return oldValue.RangeType, nil
+}
+
+// ClearRangeType clears the value of the "range_type" field.
+func (m *BronzeReferenceOSVAffectedMutation) ClearRangeType() {
+	m.range_type = nil
+	m.clearedFields[bronzereferenceosvaffected.FieldRangeType] = struct{}{}
+}
+
+// RangeTypeCleared returns if the "range_type" field was cleared in this mutation.
+func (m *BronzeReferenceOSVAffectedMutation) RangeTypeCleared() bool {
+	_, ok := m.clearedFields[bronzereferenceosvaffected.FieldRangeType]
+	return ok
+}
+
+// ResetRangeType resets all changes to the "range_type" field.
+func (m *BronzeReferenceOSVAffectedMutation) ResetRangeType() {
+	m.range_type = nil
+	delete(m.clearedFields, bronzereferenceosvaffected.FieldRangeType)
+}
+
+// SetIntroduced sets the "introduced" field.
+func (m *BronzeReferenceOSVAffectedMutation) SetIntroduced(s string) {
+	m.introduced = &s
+}
+
+// Introduced returns the value of the "introduced" field in the mutation.
+func (m *BronzeReferenceOSVAffectedMutation) Introduced() (r string, exists bool) {
+	v := m.introduced
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntroduced returns the old "introduced" field's value of the BronzeReferenceOSVAffected entity.
+// If the BronzeReferenceOSVAffected object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVAffectedMutation) OldIntroduced(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIntroduced is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIntroduced requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntroduced: %w", err)
+	}
+	return oldValue.Introduced, nil
+}
+
+// ClearIntroduced clears the value of the "introduced" field.
+func (m *BronzeReferenceOSVAffectedMutation) ClearIntroduced() {
+	m.introduced = nil
+	m.clearedFields[bronzereferenceosvaffected.FieldIntroduced] = struct{}{}
+}
+
+// IntroducedCleared returns if the "introduced" field was cleared in this mutation.
+func (m *BronzeReferenceOSVAffectedMutation) IntroducedCleared() bool {
+	_, ok := m.clearedFields[bronzereferenceosvaffected.FieldIntroduced]
+	return ok
+}
+
+// ResetIntroduced resets all changes to the "introduced" field.
+func (m *BronzeReferenceOSVAffectedMutation) ResetIntroduced() {
+	m.introduced = nil
+	delete(m.clearedFields, bronzereferenceosvaffected.FieldIntroduced)
+}
+
+// SetFixed sets the "fixed" field.
+func (m *BronzeReferenceOSVAffectedMutation) SetFixed(s string) {
+	m.fixed = &s
+}
+
+// Fixed returns the value of the "fixed" field in the mutation.
+func (m *BronzeReferenceOSVAffectedMutation) Fixed() (r string, exists bool) {
+	v := m.fixed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFixed returns the old "fixed" field's value of the BronzeReferenceOSVAffected entity.
+// If the BronzeReferenceOSVAffected object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVAffectedMutation) OldFixed(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFixed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFixed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFixed: %w", err)
+	}
+	return oldValue.Fixed, nil
+}
+
+// ClearFixed clears the value of the "fixed" field.
+func (m *BronzeReferenceOSVAffectedMutation) ClearFixed() {
+	m.fixed = nil
+	m.clearedFields[bronzereferenceosvaffected.FieldFixed] = struct{}{}
+}
+
+// FixedCleared returns if the "fixed" field was cleared in this mutation.
+func (m *BronzeReferenceOSVAffectedMutation) FixedCleared() bool {
+	_, ok := m.clearedFields[bronzereferenceosvaffected.FieldFixed]
+	return ok
+}
+
+// ResetFixed resets all changes to the "fixed" field.
+func (m *BronzeReferenceOSVAffectedMutation) ResetFixed() {
+	m.fixed = nil
+	delete(m.clearedFields, bronzereferenceosvaffected.FieldFixed)
+}
+
+// SetLastAffected sets the "last_affected" field.
+func (m *BronzeReferenceOSVAffectedMutation) SetLastAffected(s string) {
+	m.last_affected = &s
+}
+
+// LastAffected returns the value of the "last_affected" field in the mutation.
+func (m *BronzeReferenceOSVAffectedMutation) LastAffected() (r string, exists bool) {
+	v := m.last_affected
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastAffected returns the old "last_affected" field's value of the BronzeReferenceOSVAffected entity.
+// If the BronzeReferenceOSVAffected object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVAffectedMutation) OldLastAffected(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastAffected is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastAffected requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastAffected: %w", err)
+	}
+	return oldValue.LastAffected, nil
+}
+
+// ClearLastAffected clears the value of the "last_affected" field.
+func (m *BronzeReferenceOSVAffectedMutation) ClearLastAffected() {
+	m.last_affected = nil
+	m.clearedFields[bronzereferenceosvaffected.FieldLastAffected] = struct{}{}
+}
+
+// LastAffectedCleared returns if the "last_affected" field was cleared in this mutation.
+func (m *BronzeReferenceOSVAffectedMutation) LastAffectedCleared() bool {
+	_, ok := m.clearedFields[bronzereferenceosvaffected.FieldLastAffected]
+	return ok
+}
+
+// ResetLastAffected resets all changes to the "last_affected" field.
+func (m *BronzeReferenceOSVAffectedMutation) ResetLastAffected() {
+	m.last_affected = nil
+	delete(m.clearedFields, bronzereferenceosvaffected.FieldLastAffected)
+}
+
+// SetVersions sets the "versions" field.
+func (m *BronzeReferenceOSVAffectedMutation) SetVersions(s []string) {
+	m.versions = &s
+	m.appendversions = nil
+}
+
+// Versions returns the value of the "versions" field in the mutation.
+func (m *BronzeReferenceOSVAffectedMutation) Versions() (r []string, exists bool) {
+	v := m.versions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersions returns the old "versions" field's value of the BronzeReferenceOSVAffected entity.
+// If the BronzeReferenceOSVAffected object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVAffectedMutation) OldVersions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersions: %w", err)
+	}
+	return oldValue.Versions, nil
+}
+
+// AppendVersions adds s to the "versions" field.
+func (m *BronzeReferenceOSVAffectedMutation) AppendVersions(s []string) {
+	m.appendversions = append(m.appendversions, s...)
+}
+
+// AppendedVersions returns the list of values that were appended to the "versions" field in this mutation.
+func (m *BronzeReferenceOSVAffectedMutation) AppendedVersions() ([]string, bool) {
+	if len(m.appendversions) == 0 {
+		return nil, false
+	}
+	return m.appendversions, true
+}
+
+// ClearVersions clears the value of the "versions" field.
+func (m *BronzeReferenceOSVAffectedMutation) ClearVersions() {
+	m.versions = nil
+	m.appendversions = nil
+	m.clearedFields[bronzereferenceosvaffected.FieldVersions] = struct{}{}
+}
+
+// VersionsCleared returns if the "versions" field was cleared in this mutation.
+func (m *BronzeReferenceOSVAffectedMutation) VersionsCleared() bool {
+	_, ok := m.clearedFields[bronzereferenceosvaffected.FieldVersions]
+	return ok
+}
+
+// ResetVersions resets all changes to the "versions" field.
+func (m *BronzeReferenceOSVAffectedMutation) ResetVersions() {
+	m.versions = nil
+	m.appendversions = nil
+	delete(m.clearedFields, bronzereferenceosvaffected.FieldVersions)
+}
+
+// Where appends a list predicates to the BronzeReferenceOSVAffectedMutation builder.
+func (m *BronzeReferenceOSVAffectedMutation) Where(ps ...predicate.BronzeReferenceOSVAffected) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeReferenceOSVAffectedMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeReferenceOSVAffectedMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeReferenceOSVAffected, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeReferenceOSVAffectedMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeReferenceOSVAffectedMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeReferenceOSVAffected).
+func (m *BronzeReferenceOSVAffectedMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeReferenceOSVAffectedMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.collected_at != nil {
+		fields = append(fields, bronzereferenceosvaffected.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzereferenceosvaffected.FieldFirstCollectedAt)
+	}
+	if m.vuln_id != nil {
+		fields = append(fields, bronzereferenceosvaffected.FieldVulnID)
+	}
+	if m.ecosystem != nil {
+		fields = append(fields, bronzereferenceosvaffected.FieldEcosystem)
+	}
+	if m.package_name != nil {
+		fields = append(fields, bronzereferenceosvaffected.FieldPackageName)
+	}
+	if m.purl != nil {
+		fields = append(fields, bronzereferenceosvaffected.FieldPurl)
+	}
+	if m.range_type != nil {
+		fields = append(fields, bronzereferenceosvaffected.FieldRangeType)
+	}
+	if m.introduced != nil {
+		fields = append(fields, bronzereferenceosvaffected.FieldIntroduced)
+	}
+	if m.fixed != nil {
+		fields = append(fields, bronzereferenceosvaffected.FieldFixed)
+	}
+	if m.last_affected != nil {
+		fields = append(fields, bronzereferenceosvaffected.FieldLastAffected)
+	}
+	if m.versions != nil {
+		fields = append(fields, bronzereferenceosvaffected.FieldVersions)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeReferenceOSVAffectedMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzereferenceosvaffected.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzereferenceosvaffected.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzereferenceosvaffected.FieldVulnID:
+		return m.VulnID()
+	case bronzereferenceosvaffected.FieldEcosystem:
+		return m.Ecosystem()
+	case bronzereferenceosvaffected.FieldPackageName:
+		return m.PackageName()
+	case bronzereferenceosvaffected.FieldPurl:
+		return m.Purl()
+	case bronzereferenceosvaffected.FieldRangeType:
+		return m.RangeType()
+	case bronzereferenceosvaffected.FieldIntroduced:
+		return m.Introduced()
+	case bronzereferenceosvaffected.FieldFixed:
+		return m.Fixed()
+	case bronzereferenceosvaffected.FieldLastAffected:
+		return m.LastAffected()
+	case bronzereferenceosvaffected.FieldVersions:
+		return m.Versions()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeReferenceOSVAffectedMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzereferenceosvaffected.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzereferenceosvaffected.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzereferenceosvaffected.FieldVulnID:
+		return m.OldVulnID(ctx)
+	case bronzereferenceosvaffected.FieldEcosystem:
+		return m.OldEcosystem(ctx)
+	case bronzereferenceosvaffected.FieldPackageName:
+		return m.OldPackageName(ctx)
+	case bronzereferenceosvaffected.FieldPurl:
+		return m.OldPurl(ctx)
+	case bronzereferenceosvaffected.FieldRangeType:
+		return m.OldRangeType(ctx)
+	case bronzereferenceosvaffected.FieldIntroduced:
+		return m.OldIntroduced(ctx)
+	case bronzereferenceosvaffected.FieldFixed:
+		return m.OldFixed(ctx)
+	case bronzereferenceosvaffected.FieldLastAffected:
+		return m.OldLastAffected(ctx)
+	case bronzereferenceosvaffected.FieldVersions:
+		return m.OldVersions(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeReferenceOSVAffected field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeReferenceOSVAffectedMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzereferenceosvaffected.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzereferenceosvaffected.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzereferenceosvaffected.FieldVulnID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVulnID(v)
+		return nil
+	case bronzereferenceosvaffected.FieldEcosystem:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEcosystem(v)
+		return nil
+	case bronzereferenceosvaffected.FieldPackageName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageName(v)
+		return nil
+	case bronzereferenceosvaffected.FieldPurl:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurl(v)
+		return nil
+	case bronzereferenceosvaffected.FieldRangeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRangeType(v)
+		return nil
+	case bronzereferenceosvaffected.FieldIntroduced:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntroduced(v)
+		return nil
+	case bronzereferenceosvaffected.FieldFixed:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFixed(v)
+		return nil
+	case bronzereferenceosvaffected.FieldLastAffected:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastAffected(v)
+		return nil
+	case bronzereferenceosvaffected.FieldVersions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersions(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeReferenceOSVAffected field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeReferenceOSVAffectedMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeReferenceOSVAffectedMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeReferenceOSVAffectedMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeReferenceOSVAffected numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeReferenceOSVAffectedMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzereferenceosvaffected.FieldPurl) {
+		fields = append(fields, bronzereferenceosvaffected.FieldPurl)
+	}
+	if m.FieldCleared(bronzereferenceosvaffected.FieldRangeType) {
+		fields = append(fields, bronzereferenceosvaffected.FieldRangeType)
+	}
+	if m.FieldCleared(bronzereferenceosvaffected.FieldIntroduced) {
+		fields = append(fields, bronzereferenceosvaffected.FieldIntroduced)
+	}
+	if m.FieldCleared(bronzereferenceosvaffected.FieldFixed) {
+		fields = append(fields, bronzereferenceosvaffected.FieldFixed)
+	}
+	if m.FieldCleared(bronzereferenceosvaffected.FieldLastAffected) {
+		fields = append(fields, bronzereferenceosvaffected.FieldLastAffected)
+	}
+	if m.FieldCleared(bronzereferenceosvaffected.FieldVersions) {
+		fields = append(fields, bronzereferenceosvaffected.FieldVersions)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeReferenceOSVAffectedMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeReferenceOSVAffectedMutation) ClearField(name string) error {
+	switch name {
+	case bronzereferenceosvaffected.FieldPurl:
+		m.ClearPurl()
+		return nil
+	case bronzereferenceosvaffected.FieldRangeType:
+		m.ClearRangeType()
+		return nil
+	case bronzereferenceosvaffected.FieldIntroduced:
+		m.ClearIntroduced()
+		return nil
+	case bronzereferenceosvaffected.FieldFixed:
+		m.ClearFixed()
+		return nil
+	case bronzereferenceosvaffected.FieldLastAffected:
+		m.ClearLastAffected()
+		return nil
+	case bronzereferenceosvaffected.FieldVersions:
+		m.ClearVersions()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeReferenceOSVAffected nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeReferenceOSVAffectedMutation) ResetField(name string) error {
+	switch name {
+	case bronzereferenceosvaffected.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzereferenceosvaffected.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzereferenceosvaffected.FieldVulnID:
+		m.ResetVulnID()
+		return nil
+	case bronzereferenceosvaffected.FieldEcosystem:
+		m.ResetEcosystem()
+		return nil
+	case bronzereferenceosvaffected.FieldPackageName:
+		m.ResetPackageName()
+		return nil
+	case bronzereferenceosvaffected.FieldPurl:
+		m.ResetPurl()
+		return nil
+	case bronzereferenceosvaffected.FieldRangeType:
+		m.ResetRangeType()
+		return nil
+	case bronzereferenceosvaffected.FieldIntroduced:
+		m.ResetIntroduced()
+		return nil
+	case bronzereferenceosvaffected.FieldFixed:
+		m.ResetFixed()
+		return nil
+	case bronzereferenceosvaffected.FieldLastAffected:
+		m.ResetLastAffected()
+		return nil
+	case bronzereferenceosvaffected.FieldVersions:
+		m.ResetVersions()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeReferenceOSVAffected field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeReferenceOSVAffectedMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeReferenceOSVAffectedMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeReferenceOSVAffectedMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeReferenceOSVAffectedMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeReferenceOSVAffectedMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeReferenceOSVAffectedMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeReferenceOSVAffectedMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeReferenceOSVAffected unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeReferenceOSVAffectedMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeReferenceOSVAffected edge %s", name)
+}
+
+// BronzeReferenceOSVVulnMutation represents an operation that mutates the BronzeReferenceOSVVuln nodes in the graph.
+type BronzeReferenceOSVVulnMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	collected_at       *time.Time
+	first_collected_at *time.Time
+	summary            *string
+	details            *string
+	aliases            *[]string
+	appendaliases      []string
+	severity_type      *string
+	severity_score     *string
+	database_severity  *string
+	published          *time.Time
+	modified           *time.Time
+	withdrawn          *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*BronzeReferenceOSVVuln, error)
+	predicates         []predicate.BronzeReferenceOSVVuln
+}
+
+var _ ent.Mutation = (*BronzeReferenceOSVVulnMutation)(nil)
+
+// bronzereferenceosvvulnOption allows management of the mutation configuration using functional options.
+type bronzereferenceosvvulnOption func(*BronzeReferenceOSVVulnMutation)
+
+// newBronzeReferenceOSVVulnMutation creates new mutation for the BronzeReferenceOSVVuln entity.
+func newBronzeReferenceOSVVulnMutation(c config, op Op, opts ...bronzereferenceosvvulnOption) *BronzeReferenceOSVVulnMutation {
+	m := &BronzeReferenceOSVVulnMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeReferenceOSVVuln,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeReferenceOSVVulnID sets the ID field of the mutation.
+func withBronzeReferenceOSVVulnID(id string) bronzereferenceosvvulnOption {
+	return func(m *BronzeReferenceOSVVulnMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeReferenceOSVVuln
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeReferenceOSVVuln, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeReferenceOSVVuln.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeReferenceOSVVuln sets the old BronzeReferenceOSVVuln of the mutation.
+func withBronzeReferenceOSVVuln(node *BronzeReferenceOSVVuln) bronzereferenceosvvulnOption {
+	return func(m *BronzeReferenceOSVVulnMutation) {
+		m.oldValue = func(context.Context) (*BronzeReferenceOSVVuln, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeReferenceOSVVulnMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeReferenceOSVVulnMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("reference: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeReferenceOSVVuln entities.
+func (m *BronzeReferenceOSVVulnMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeReferenceOSVVulnMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeReferenceOSVVulnMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeReferenceOSVVuln.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeReferenceOSVVulnMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeReferenceOSVVulnMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeReferenceOSVVuln entity.
+// If the BronzeReferenceOSVVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVVulnMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeReferenceOSVVulnMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeReferenceOSVVulnMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeReferenceOSVVulnMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeReferenceOSVVuln entity.
+// If the BronzeReferenceOSVVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVVulnMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeReferenceOSVVulnMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetSummary sets the "summary" field.
+func (m *BronzeReferenceOSVVulnMutation) SetSummary(s string) {
+	m.summary = &s
+}
+
+// Summary returns the value of the "summary" field in the mutation.
+func (m *BronzeReferenceOSVVulnMutation) Summary() (r string, exists bool) {
+	v := m.summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummary returns the old "summary" field's value of the BronzeReferenceOSVVuln entity.
+// If the BronzeReferenceOSVVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVVulnMutation) OldSummary(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+	}
+	return oldValue.Summary, nil
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (m *BronzeReferenceOSVVulnMutation) ClearSummary() {
+	m.summary = nil
+	m.clearedFields[bronzereferenceosvvuln.FieldSummary] = struct{}{}
+}
+
+// SummaryCleared returns if the "summary" field was cleared in this mutation.
+func (m *BronzeReferenceOSVVulnMutation) SummaryCleared() bool {
+	_, ok := m.clearedFields[bronzereferenceosvvuln.FieldSummary]
+	return ok
+}
+
+// ResetSummary resets all changes to the "summary" field.
+func (m *BronzeReferenceOSVVulnMutation) ResetSummary() {
+	m.summary = nil
+	delete(m.clearedFields, bronzereferenceosvvuln.FieldSummary)
+}
+
+// SetDetails sets the "details" field.
+func (m *BronzeReferenceOSVVulnMutation) SetDetails(s string) {
+	m.details = &s
+}
+
+// Details returns the value of the "details" field in the mutation.
+func (m *BronzeReferenceOSVVulnMutation) Details() (r string, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetails returns the old "details" field's value of the BronzeReferenceOSVVuln entity.
+// If the BronzeReferenceOSVVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVVulnMutation) OldDetails(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
+}
+
+// ClearDetails clears the value of the "details" field.
+func (m *BronzeReferenceOSVVulnMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[bronzereferenceosvvuln.FieldDetails] = struct{}{}
+}
+
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *BronzeReferenceOSVVulnMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[bronzereferenceosvvuln.FieldDetails]
+	return ok
+}
+
+// ResetDetails resets all changes to the "details" field.
+func (m *BronzeReferenceOSVVulnMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, bronzereferenceosvvuln.FieldDetails)
+}
+
+// SetAliases sets the "aliases" field.
+func (m *BronzeReferenceOSVVulnMutation) SetAliases(s []string) {
+	m.aliases = &s
+	m.appendaliases = nil
+}
+
+// Aliases returns the value of the "aliases" field in the mutation.
+func (m *BronzeReferenceOSVVulnMutation) Aliases() (r []string, exists bool) {
+	v := m.aliases
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAliases returns the old "aliases" field's value of the BronzeReferenceOSVVuln entity.
+// If the BronzeReferenceOSVVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVVulnMutation) OldAliases(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAliases is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAliases requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAliases: %w", err)
+	}
+	return oldValue.Aliases, nil
+}
+
+// AppendAliases adds s to the "aliases" field.
+func (m *BronzeReferenceOSVVulnMutation) AppendAliases(s []string) {
+	m.appendaliases = append(m.appendaliases, s...)
+}
+
+// AppendedAliases returns the list of values that were appended to the "aliases" field in this mutation.
+func (m *BronzeReferenceOSVVulnMutation) AppendedAliases() ([]string, bool) {
+	if len(m.appendaliases) == 0 {
+		return nil, false
+	}
+	return m.appendaliases, true
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (m *BronzeReferenceOSVVulnMutation) ClearAliases() {
+	m.aliases = nil
+	m.appendaliases = nil
+	m.clearedFields[bronzereferenceosvvuln.FieldAliases] = struct{}{}
+}
+
+// AliasesCleared returns if the "aliases" field was cleared in this mutation.
+func (m *BronzeReferenceOSVVulnMutation) AliasesCleared() bool {
+	_, ok := m.clearedFields[bronzereferenceosvvuln.FieldAliases]
+	return ok
+}
+
+// ResetAliases resets all changes to the "aliases" field.
+func (m *BronzeReferenceOSVVulnMutation) ResetAliases() {
+	m.aliases = nil
+	m.appendaliases = nil
+	delete(m.clearedFields, bronzereferenceosvvuln.FieldAliases)
+}
+
+// SetSeverityType sets the "severity_type" field.
+func (m *BronzeReferenceOSVVulnMutation) SetSeverityType(s string) {
+	m.severity_type = &s
+}
+
+// SeverityType returns the value of the "severity_type" field in the mutation.
+func (m *BronzeReferenceOSVVulnMutation) SeverityType() (r string, exists bool) {
+	v := m.severity_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeverityType returns the old "severity_type" field's value of the BronzeReferenceOSVVuln entity.
+// If the BronzeReferenceOSVVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVVulnMutation) OldSeverityType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeverityType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeverityType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeverityType: %w", err)
+	}
+	return oldValue.SeverityType, nil
+}
+
+// ClearSeverityType clears the value of the "severity_type" field.
+func (m *BronzeReferenceOSVVulnMutation) ClearSeverityType() {
+	m.severity_type = nil
+	m.clearedFields[bronzereferenceosvvuln.FieldSeverityType] = struct{}{}
+}
+
+// SeverityTypeCleared returns if the "severity_type" field was cleared in this mutation.
+func (m *BronzeReferenceOSVVulnMutation) SeverityTypeCleared() bool {
+	_, ok := m.clearedFields[bronzereferenceosvvuln.FieldSeverityType]
+	return ok
+}
+
+// ResetSeverityType resets all changes to the "severity_type" field.
+func (m *BronzeReferenceOSVVulnMutation) ResetSeverityType() {
+	m.severity_type = nil
+	delete(m.clearedFields, bronzereferenceosvvuln.FieldSeverityType)
+}
+
+// SetSeverityScore sets the "severity_score" field.
+func (m *BronzeReferenceOSVVulnMutation) SetSeverityScore(s string) {
+	m.severity_score = &s
+}
+
+// SeverityScore returns the value of the "severity_score" field in the mutation.
+func (m *BronzeReferenceOSVVulnMutation) SeverityScore() (r string, exists bool) {
+	v := m.severity_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeverityScore returns the old "severity_score" field's value of the BronzeReferenceOSVVuln entity.
+// If the BronzeReferenceOSVVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVVulnMutation) OldSeverityScore(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeverityScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeverityScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeverityScore: %w", err)
+	}
+	return oldValue.SeverityScore, nil
+}
+
+// ClearSeverityScore clears the value of the "severity_score" field.
+func (m *BronzeReferenceOSVVulnMutation) ClearSeverityScore() {
+	m.severity_score = nil
+	m.clearedFields[bronzereferenceosvvuln.FieldSeverityScore] = struct{}{}
+}
+
+// SeverityScoreCleared returns if the "severity_score" field was cleared in this mutation.
+func (m *BronzeReferenceOSVVulnMutation) SeverityScoreCleared() bool {
+	_, ok := m.clearedFields[bronzereferenceosvvuln.FieldSeverityScore]
+	return ok
+}
+
+// ResetSeverityScore resets all changes to the "severity_score" field.
+func (m *BronzeReferenceOSVVulnMutation) ResetSeverityScore() {
+	m.severity_score = nil
+	delete(m.clearedFields, bronzereferenceosvvuln.FieldSeverityScore)
+}
+
+// SetDatabaseSeverity sets the "database_severity" field.
+func (m *BronzeReferenceOSVVulnMutation) SetDatabaseSeverity(s string) {
+	m.database_severity = &s
+}
+
+// DatabaseSeverity returns the value of the "database_severity" field in the mutation.
+func (m *BronzeReferenceOSVVulnMutation) DatabaseSeverity() (r string, exists bool) {
+	v := m.database_severity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDatabaseSeverity returns the old "database_severity" field's value of the BronzeReferenceOSVVuln entity.
+// If the BronzeReferenceOSVVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVVulnMutation) OldDatabaseSeverity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDatabaseSeverity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDatabaseSeverity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDatabaseSeverity: %w", err)
+	}
+	return oldValue.DatabaseSeverity, nil
+}
+
+// ClearDatabaseSeverity clears the value of the "database_severity" field.
+func (m *BronzeReferenceOSVVulnMutation) ClearDatabaseSeverity() {
+	m.database_severity = nil
+	m.clearedFields[bronzereferenceosvvuln.FieldDatabaseSeverity] = struct{}{}
+}
+
+// DatabaseSeverityCleared returns if the "database_severity" field was cleared in this mutation.
+func (m *BronzeReferenceOSVVulnMutation) DatabaseSeverityCleared() bool {
+	_, ok := m.clearedFields[bronzereferenceosvvuln.FieldDatabaseSeverity]
+	return ok
+}
+
+// ResetDatabaseSeverity resets all changes to the "database_severity" field.
+func (m *BronzeReferenceOSVVulnMutation) ResetDatabaseSeverity() {
+	m.database_severity = nil
+	delete(m.clearedFields, bronzereferenceosvvuln.FieldDatabaseSeverity)
+}
+
+// SetPublished sets the "published" field.
+func (m *BronzeReferenceOSVVulnMutation) SetPublished(t time.Time) {
+	m.published = &t
+}
+
+// Published returns the value of the "published" field in the mutation.
+func (m *BronzeReferenceOSVVulnMutation) Published() (r time.Time, exists bool) {
+	v := m.published
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublished returns the old "published" field's value of the BronzeReferenceOSVVuln entity.
+// If the BronzeReferenceOSVVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVVulnMutation) OldPublished(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublished is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublished requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublished: %w", err)
+	}
+	return oldValue.Published, nil
+}
+
+// ClearPublished clears the value of the "published" field.
+func (m *BronzeReferenceOSVVulnMutation) ClearPublished() {
+	m.published = nil
+	m.clearedFields[bronzereferenceosvvuln.FieldPublished] = struct{}{}
+}
+
+// PublishedCleared returns if the "published" field was cleared in this mutation.
+func (m *BronzeReferenceOSVVulnMutation) PublishedCleared() bool {
+	_, ok := m.clearedFields[bronzereferenceosvvuln.FieldPublished]
+	return ok
+}
+
+// ResetPublished resets all changes to the "published" field.
+func (m *BronzeReferenceOSVVulnMutation) ResetPublished() {
+	m.published = nil
+	delete(m.clearedFields, bronzereferenceosvvuln.FieldPublished)
+}
+
+// SetModified sets the "modified" field.
+func (m *BronzeReferenceOSVVulnMutation) SetModified(t time.Time) {
+	m.modified = &t
+}
+
+// Modified returns the value of the "modified" field in the mutation.
+func (m *BronzeReferenceOSVVulnMutation) Modified() (r time.Time, exists bool) {
+	v := m.modified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModified returns the old "modified" field's value of the BronzeReferenceOSVVuln entity.
+// If the BronzeReferenceOSVVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVVulnMutation) OldModified(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModified: %w", err)
+	}
+	return oldValue.Modified, nil
+}
+
+// ResetModified resets all changes to the "modified" field.
+func (m *BronzeReferenceOSVVulnMutation) ResetModified() {
+	m.modified = nil
+}
+
+// SetWithdrawn sets the "withdrawn" field.
+func (m *BronzeReferenceOSVVulnMutation) SetWithdrawn(t time.Time) {
+	m.withdrawn = &t
+}
+
+// Withdrawn returns the value of the "withdrawn" field in the mutation.
+func (m *BronzeReferenceOSVVulnMutation) Withdrawn() (r time.Time, exists bool) {
+	v := m.withdrawn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWithdrawn returns the old "withdrawn" field's value of the BronzeReferenceOSVVuln entity.
+// If the BronzeReferenceOSVVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceOSVVulnMutation) OldWithdrawn(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWithdrawn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWithdrawn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWithdrawn: %w", err)
+	}
+	return oldValue.Withdrawn, nil
+}
+
+// ClearWithdrawn clears the value of the "withdrawn" field.
+func (m *BronzeReferenceOSVVulnMutation) ClearWithdrawn() {
+	m.withdrawn = nil
+	m.clearedFields[bronzereferenceosvvuln.FieldWithdrawn] = struct{}{}
+}
+
+// WithdrawnCleared returns if the "withdrawn" field was cleared in this mutation.
+func (m *BronzeReferenceOSVVulnMutation) WithdrawnCleared() bool {
+	_, ok := m.clearedFields[bronzereferenceosvvuln.FieldWithdrawn]
+	return ok
+}
+
+// ResetWithdrawn resets all changes to the "withdrawn" field.
+func (m *BronzeReferenceOSVVulnMutation) ResetWithdrawn() {
+	m.withdrawn = nil
+	delete(m.clearedFields, bronzereferenceosvvuln.FieldWithdrawn)
+}
+
+// Where appends a list predicates to the BronzeReferenceOSVVulnMutation builder.
+func (m *BronzeReferenceOSVVulnMutation) Where(ps ...predicate.BronzeReferenceOSVVuln) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeReferenceOSVVulnMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeReferenceOSVVulnMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeReferenceOSVVuln, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeReferenceOSVVulnMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeReferenceOSVVulnMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeReferenceOSVVuln).
+func (m *BronzeReferenceOSVVulnMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeReferenceOSVVulnMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.collected_at != nil {
+		fields = append(fields, bronzereferenceosvvuln.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzereferenceosvvuln.FieldFirstCollectedAt)
+	}
+	if m.summary != nil {
+		fields = append(fields, bronzereferenceosvvuln.FieldSummary)
+	}
+	if m.details != nil {
+		fields = append(fields, bronzereferenceosvvuln.FieldDetails)
+	}
+	if m.aliases != nil {
+		fields = append(fields, bronzereferenceosvvuln.FieldAliases)
+	}
+	if m.severity_type != nil {
+		fields = append(fields, bronzereferenceosvvuln.FieldSeverityType)
+	}
+	if m.severity_score != nil {
+		fields = append(fields, bronzereferenceosvvuln.FieldSeverityScore)
+	}
+	if m.database_severity != nil {
+		fields = append(fields, bronzereferenceosvvuln.FieldDatabaseSeverity)
+	}
+	if m.published != nil {
+		fields = append(fields, bronzereferenceosvvuln.FieldPublished)
+	}
+	if m.modified != nil {
+		fields = append(fields, bronzereferenceosvvuln.FieldModified)
+	}
+	if m.withdrawn != nil {
+		fields = append(fields, bronzereferenceosvvuln.FieldWithdrawn)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BronzeReferenceOSVVulnMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bronzereferenceosvvuln.FieldCollectedAt:
+		return m.CollectedAt()
+	case bronzereferenceosvvuln.FieldFirstCollectedAt:
+		return m.FirstCollectedAt()
+	case bronzereferenceosvvuln.FieldSummary:
+		return m.Summary()
+	case bronzereferenceosvvuln.FieldDetails:
+		return m.Details()
+	case bronzereferenceosvvuln.FieldAliases:
+		return m.Aliases()
+	case bronzereferenceosvvuln.FieldSeverityType:
+		return m.SeverityType()
+	case bronzereferenceosvvuln.FieldSeverityScore:
+		return m.SeverityScore()
+	case bronzereferenceosvvuln.FieldDatabaseSeverity:
+		return m.DatabaseSeverity()
+	case bronzereferenceosvvuln.FieldPublished:
+		return m.Published()
+	case bronzereferenceosvvuln.FieldModified:
+		return m.Modified()
+	case bronzereferenceosvvuln.FieldWithdrawn:
+		return m.Withdrawn()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BronzeReferenceOSVVulnMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bronzereferenceosvvuln.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case bronzereferenceosvvuln.FieldFirstCollectedAt:
+		return m.OldFirstCollectedAt(ctx)
+	case bronzereferenceosvvuln.FieldSummary:
+		return m.OldSummary(ctx)
+	case bronzereferenceosvvuln.FieldDetails:
+		return m.OldDetails(ctx)
+	case bronzereferenceosvvuln.FieldAliases:
+		return m.OldAliases(ctx)
+	case bronzereferenceosvvuln.FieldSeverityType:
+		return m.OldSeverityType(ctx)
+	case bronzereferenceosvvuln.FieldSeverityScore:
+		return m.OldSeverityScore(ctx)
+	case bronzereferenceosvvuln.FieldDatabaseSeverity:
+		return m.OldDatabaseSeverity(ctx)
+	case bronzereferenceosvvuln.FieldPublished:
+		return m.OldPublished(ctx)
+	case bronzereferenceosvvuln.FieldModified:
+		return m.OldModified(ctx)
+	case bronzereferenceosvvuln.FieldWithdrawn:
+		return m.OldWithdrawn(ctx)
+	}
+	return nil, fmt.Errorf("unknown BronzeReferenceOSVVuln field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeReferenceOSVVulnMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bronzereferenceosvvuln.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case bronzereferenceosvvuln.FieldFirstCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstCollectedAt(v)
+		return nil
+	case bronzereferenceosvvuln.FieldSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummary(v)
+		return nil
+	case bronzereferenceosvvuln.FieldDetails:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetails(v)
+		return nil
+	case bronzereferenceosvvuln.FieldAliases:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAliases(v)
+		return nil
+	case bronzereferenceosvvuln.FieldSeverityType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeverityType(v)
+		return nil
+	case bronzereferenceosvvuln.FieldSeverityScore:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeverityScore(v)
+		return nil
+	case bronzereferenceosvvuln.FieldDatabaseSeverity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDatabaseSeverity(v)
+		return nil
+	case bronzereferenceosvvuln.FieldPublished:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublished(v)
+		return nil
+	case bronzereferenceosvvuln.FieldModified:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModified(v)
+		return nil
+	case bronzereferenceosvvuln.FieldWithdrawn:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWithdrawn(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeReferenceOSVVuln field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BronzeReferenceOSVVulnMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BronzeReferenceOSVVulnMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BronzeReferenceOSVVulnMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BronzeReferenceOSVVuln numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BronzeReferenceOSVVulnMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bronzereferenceosvvuln.FieldSummary) {
+		fields = append(fields, bronzereferenceosvvuln.FieldSummary)
+	}
+	if m.FieldCleared(bronzereferenceosvvuln.FieldDetails) {
+		fields = append(fields, bronzereferenceosvvuln.FieldDetails)
+	}
+	if m.FieldCleared(bronzereferenceosvvuln.FieldAliases) {
+		fields = append(fields, bronzereferenceosvvuln.FieldAliases)
+	}
+	if m.FieldCleared(bronzereferenceosvvuln.FieldSeverityType) {
+		fields = append(fields, bronzereferenceosvvuln.FieldSeverityType)
+	}
+	if m.FieldCleared(bronzereferenceosvvuln.FieldSeverityScore) {
+		fields = append(fields, bronzereferenceosvvuln.FieldSeverityScore)
+	}
+	if m.FieldCleared(bronzereferenceosvvuln.FieldDatabaseSeverity) {
+		fields = append(fields, bronzereferenceosvvuln.FieldDatabaseSeverity)
+	}
+	if m.FieldCleared(bronzereferenceosvvuln.FieldPublished) {
+		fields = append(fields, bronzereferenceosvvuln.FieldPublished)
+	}
+	if m.FieldCleared(bronzereferenceosvvuln.FieldWithdrawn) {
+		fields = append(fields, bronzereferenceosvvuln.FieldWithdrawn)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BronzeReferenceOSVVulnMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BronzeReferenceOSVVulnMutation) ClearField(name string) error {
+	switch name {
+	case bronzereferenceosvvuln.FieldSummary:
+		m.ClearSummary()
+		return nil
+	case bronzereferenceosvvuln.FieldDetails:
+		m.ClearDetails()
+		return nil
+	case bronzereferenceosvvuln.FieldAliases:
+		m.ClearAliases()
+		return nil
+	case bronzereferenceosvvuln.FieldSeverityType:
+		m.ClearSeverityType()
+		return nil
+	case bronzereferenceosvvuln.FieldSeverityScore:
+		m.ClearSeverityScore()
+		return nil
+	case bronzereferenceosvvuln.FieldDatabaseSeverity:
+		m.ClearDatabaseSeverity()
+		return nil
+	case bronzereferenceosvvuln.FieldPublished:
+		m.ClearPublished()
+		return nil
+	case bronzereferenceosvvuln.FieldWithdrawn:
+		m.ClearWithdrawn()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeReferenceOSVVuln nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BronzeReferenceOSVVulnMutation) ResetField(name string) error {
+	switch name {
+	case bronzereferenceosvvuln.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case bronzereferenceosvvuln.FieldFirstCollectedAt:
+		m.ResetFirstCollectedAt()
+		return nil
+	case bronzereferenceosvvuln.FieldSummary:
+		m.ResetSummary()
+		return nil
+	case bronzereferenceosvvuln.FieldDetails:
+		m.ResetDetails()
+		return nil
+	case bronzereferenceosvvuln.FieldAliases:
+		m.ResetAliases()
+		return nil
+	case bronzereferenceosvvuln.FieldSeverityType:
+		m.ResetSeverityType()
+		return nil
+	case bronzereferenceosvvuln.FieldSeverityScore:
+		m.ResetSeverityScore()
+		return nil
+	case bronzereferenceosvvuln.FieldDatabaseSeverity:
+		m.ResetDatabaseSeverity()
+		return nil
+	case bronzereferenceosvvuln.FieldPublished:
+		m.ResetPublished()
+		return nil
+	case bronzereferenceosvvuln.FieldModified:
+		m.ResetModified()
+		return nil
+	case bronzereferenceosvvuln.FieldWithdrawn:
+		m.ResetWithdrawn()
+		return nil
+	}
+	return fmt.Errorf("unknown BronzeReferenceOSVVuln field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BronzeReferenceOSVVulnMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BronzeReferenceOSVVulnMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BronzeReferenceOSVVulnMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BronzeReferenceOSVVulnMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BronzeReferenceOSVVulnMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BronzeReferenceOSVVulnMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BronzeReferenceOSVVulnMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeReferenceOSVVuln unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BronzeReferenceOSVVulnMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeReferenceOSVVuln edge %s", name)
+}
+
+// BronzeReferenceRPMPackageMutation represents an operation that mutates the BronzeReferenceRPMPackage nodes in the graph.
+type BronzeReferenceRPMPackageMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	collected_at       *time.Time
+	first_collected_at *time.Time
+	package_name       *string
+	repo               *string
+	arch               *string
+	version            *string
+	rpm_group          *string
+	summary            *string
+	url                *string
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*BronzeReferenceRPMPackage, error)
+	predicates         []predicate.BronzeReferenceRPMPackage
+}
+
+var _ ent.Mutation = (*BronzeReferenceRPMPackageMutation)(nil)
+
+// bronzereferencerpmpackageOption allows management of the mutation configuration using functional options.
+type bronzereferencerpmpackageOption func(*BronzeReferenceRPMPackageMutation)
+
+// newBronzeReferenceRPMPackageMutation creates new mutation for the BronzeReferenceRPMPackage entity.
+func newBronzeReferenceRPMPackageMutation(c config, op Op, opts ...bronzereferencerpmpackageOption) *BronzeReferenceRPMPackageMutation {
+	m := &BronzeReferenceRPMPackageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBronzeReferenceRPMPackage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBronzeReferenceRPMPackageID sets the ID field of the mutation.
+func withBronzeReferenceRPMPackageID(id string) bronzereferencerpmpackageOption {
+	return func(m *BronzeReferenceRPMPackageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BronzeReferenceRPMPackage
+		)
+		m.oldValue = func(ctx context.Context) (*BronzeReferenceRPMPackage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BronzeReferenceRPMPackage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBronzeReferenceRPMPackage sets the old BronzeReferenceRPMPackage of the mutation.
+func withBronzeReferenceRPMPackage(node *BronzeReferenceRPMPackage) bronzereferencerpmpackageOption {
+	return func(m *BronzeReferenceRPMPackageMutation) {
+		m.oldValue = func(context.Context) (*BronzeReferenceRPMPackage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BronzeReferenceRPMPackageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BronzeReferenceRPMPackageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("reference: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BronzeReferenceRPMPackage entities.
+func (m *BronzeReferenceRPMPackageMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BronzeReferenceRPMPackageMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BronzeReferenceRPMPackageMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BronzeReferenceRPMPackage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *BronzeReferenceRPMPackageMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *BronzeReferenceRPMPackageMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeReferenceRPMPackage entity.
+// If the BronzeReferenceRPMPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceRPMPackageMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *BronzeReferenceRPMPackageMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (m *BronzeReferenceRPMPackageMutation) SetFirstCollectedAt(t time.Time) {
+	m.first_collected_at = &t
+}
+
+// FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
+func (m *BronzeReferenceRPMPackageMutation) FirstCollectedAt() (r time.Time, exists bool) {
+	v := m.first_collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeReferenceRPMPackage entity.
+// If the BronzeReferenceRPMPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceRPMPackageMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstCollectedAt: %w", err)
+	}
+	return oldValue.FirstCollectedAt, nil
+}
+
+// ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
+func (m *BronzeReferenceRPMPackageMutation) ResetFirstCollectedAt() {
+	m.first_collected_at = nil
+}
+
+// SetPackageName sets the "package_name" field.
+func (m *BronzeReferenceRPMPackageMutation) SetPackageName(s string) {
+	m.package_name = &s
+}
+
+// PackageName returns the value of the "package_name" field in the mutation.
+func (m *BronzeReferenceRPMPackageMutation) PackageName() (r string, exists bool) {
+	v := m.package_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageName returns the old "package_name" field's value of the BronzeReferenceRPMPackage entity.
+// If the BronzeReferenceRPMPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceRPMPackageMutation) OldPackageName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageName: %w", err)
+	}
+	return oldValue.PackageName, nil
+}
+
+// ResetPackageName resets all changes to the "package_name" field.
+func (m *BronzeReferenceRPMPackageMutation) ResetPackageName() {
+	m.package_name = nil
+}
+
+// SetRepo sets the "repo" field.
+func (m *BronzeReferenceRPMPackageMutation) SetRepo(s string) {
+	m.repo = &s
+}
+
+// Repo returns the value of the "repo" field in the mutation.
+func (m *BronzeReferenceRPMPackageMutation) Repo() (r string, exists bool) {
+	v := m.repo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRepo returns the old "repo" field's value of the BronzeReferenceRPMPackage entity.
+// If the BronzeReferenceRPMPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceRPMPackageMutation) OldRepo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRepo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRepo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRepo: %w", err)
+	}
+	return oldValue.Repo, nil
+}
+
+// ResetRepo resets all changes to the "repo" field.
+func (m *BronzeReferenceRPMPackageMutation) ResetRepo() {
+	m.repo = nil
+}
+
+// SetArch sets the "arch" field.
+func (m *BronzeReferenceRPMPackageMutation) SetArch(s string) {
+	m.arch = &s
+}
+
+// Arch returns the value of the "arch" field in the mutation.
+func (m *BronzeReferenceRPMPackageMutation) Arch() (r string, exists bool) {
+	v := m.arch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArch returns the old "arch" field's value of the BronzeReferenceRPMPackage entity.
+// If the BronzeReferenceRPMPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceRPMPackageMutation) OldArch(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArch: %w", err)
+	}
+	return oldValue.Arch, nil
+}
+
+// ResetArch resets all changes to the "arch" field.
+func (m *BronzeReferenceRPMPackageMutation) ResetArch() {
+	m.arch = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *BronzeReferenceRPMPackageMutation) SetVersion(s string) {
+	m.version = &s
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *BronzeReferenceRPMPackageMutation) Version() (r string, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the BronzeReferenceRPMPackage entity.
+// If the BronzeReferenceRPMPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceRPMPackageMutation) OldVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// ClearVersion clears the value of the "version" field.
+func (m *BronzeReferenceRPMPackageMutation) ClearVersion() {
+	m.version = nil
+	m.clearedFields[bronzereferencerpmpackage.FieldVersion] = struct{}{}
+}
+
+// VersionCleared returns if the "version" field was cleared in this mutation.
+func (m *BronzeReferenceRPMPackageMutation) VersionCleared() bool {
+	_, ok := m.clearedFields[bronzereferencerpmpackage.FieldVersion]
+	return ok
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *BronzeReferenceRPMPackageMutation) ResetVersion() {
+	m.version = nil
+	delete(m.clearedFields, bronzereferencerpmpackage.FieldVersion)
+}
+
+// SetRpmGroup sets the "rpm_group" field.
+func (m *BronzeReferenceRPMPackageMutation) SetRpmGroup(s string) {
+	m.rpm_group = &s
+}
+
+// RpmGroup returns the value of the "rpm_group" field in the mutation.
+func (m *BronzeReferenceRPMPackageMutation) RpmGroup() (r string, exists bool) {
+	v := m.rpm_group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRpmGroup returns the old "rpm_group" field's value of the BronzeReferenceRPMPackage entity.
+// If the BronzeReferenceRPMPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceRPMPackageMutation) OldRpmGroup(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRpmGroup is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRpmGroup requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRpmGroup: %w", err)
+	}
+	return oldValue.RpmGroup, nil
+}
+
+// ClearRpmGroup clears the value of the "rpm_group" field.
+func (m *BronzeReferenceRPMPackageMutation) ClearRpmGroup() {
+	m.rpm_group = nil
+	m.clearedFields[bronzereferencerpmpackage.FieldRpmGroup] = struct{}{}
+}
+
+// RpmGroupCleared returns if the "rpm_group" field was cleared in this mutation.
+func (m *BronzeReferenceRPMPackageMutation) RpmGroupCleared() bool {
+	_, ok := m.clearedFields[bronzereferencerpmpackage.FieldRpmGroup]
+	return ok
+}
+
+// ResetRpmGroup resets all changes to the "rpm_group" field.
+func (m *BronzeReferenceRPMPackageMutation) ResetRpmGroup() {
+	m.rpm_group = nil
+	delete(m.clearedFields, bronzereferencerpmpackage.FieldRpmGroup)
+}
+
+// SetSummary sets the "summary" field.
+func (m *BronzeReferenceRPMPackageMutation) SetSummary(s string) {
+	m.summary = &s
+}
+
+// Summary returns the value of the "summary" field in the mutation.
+func (m *BronzeReferenceRPMPackageMutation) Summary() (r string, exists bool) {
+	v := m.summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummary returns the old "summary" field's value of the BronzeReferenceRPMPackage entity.
+// If the BronzeReferenceRPMPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceRPMPackageMutation) OldSummary(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+	}
+	return oldValue.Summary, nil
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (m *BronzeReferenceRPMPackageMutation) ClearSummary() {
+	m.summary = nil
+	m.clearedFields[bronzereferencerpmpackage.FieldSummary] = struct{}{}
+}
+
+// SummaryCleared returns if the "summary" field was cleared in this mutation.
+func (m *BronzeReferenceRPMPackageMutation) SummaryCleared() bool {
+	_, ok := m.clearedFields[bronzereferencerpmpackage.FieldSummary]
+	return ok
+}
+
+// ResetSummary resets all changes to the "summary" field.
+func (m *BronzeReferenceRPMPackageMutation) ResetSummary() {
+	m.summary = nil
+	delete(m.clearedFields, bronzereferencerpmpackage.FieldSummary)
+}
+
+// SetURL sets the "url" field.
+func (m *BronzeReferenceRPMPackageMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *BronzeReferenceRPMPackageMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the BronzeReferenceRPMPackage entity.
+// If the BronzeReferenceRPMPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceRPMPackageMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ClearURL clears the value of the "url" field.
+func (m *BronzeReferenceRPMPackageMutation) ClearURL() {
+	m.url = nil
+	m.clearedFields[bronzereferencerpmpackage.FieldURL] = struct{}{}
+}
+
+// URLCleared returns if the "url" field was cleared in this mutation.
+func (m *BronzeReferenceRPMPackageMutation) URLCleared() bool {
+	_, ok := m.clearedFields[bronzereferencerpmpackage.FieldURL]
+	return ok
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *BronzeReferenceRPMPackageMutation) ResetURL() {
+	m.url = nil
+	delete(m.clearedFields, bronzereferencerpmpackage.FieldURL)
+}
+
+// Where appends a list predicates to the BronzeReferenceRPMPackageMutation builder.
+func (m *BronzeReferenceRPMPackageMutation) Where(ps ...predicate.BronzeReferenceRPMPackage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BronzeReferenceRPMPackageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BronzeReferenceRPMPackageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeReferenceRPMPackage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BronzeReferenceRPMPackageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BronzeReferenceRPMPackageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BronzeReferenceRPMPackage).
+func (m *BronzeReferenceRPMPackageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BronzeReferenceRPMPackageMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.collected_at != nil {
+		fields = append(fields, bronzereferencerpmpackage.FieldCollectedAt)
+	}
+	if m.first_collected_at != nil {
+		fields = append(fields, bronzereferencerpmpackage.FieldFirstCollectedAt)
+	}
+	if m.package_name != nil {
+		fields = append(fields, bronzereferencerpmpackage.FieldPackageName)
+	}
+	if m.repo != nil {
+		fields = append(fields, bronzereferencerpmpackage.FieldRepo)
+	}
+	if m.arch != nil {
+		fields = append(fields, bronzereferencerpmpackage.FieldArch)
+	}
+	if m.version != nil {
+		fields = append(fields, bronzereferencerpmpackage.FieldVersion)
+	}
+	if m.rpm_group != nil {
+		fields = append(fields, bronzereferencerpmpackage.FieldRpmGroup)
+	}
+	if m.summary != nil {
+		fields = append(fields, bronzereferencerpmpackage.FieldSummary)
+	}
+	if m.url != nil {
+		fields = append(fields, bronzereferencerpmpackage.FieldURL)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *BronzeReferenceOSCoreRuleMutation) Field(name string) (ent.Value, bool) {
+func (m *BronzeReferenceRPMPackageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case bronzereferenceoscorerule.FieldCollectedAt:
+	case bronzereferencerpmpackage.FieldCollectedAt:
 		return m.CollectedAt()
-	case bronzereferenceoscorerule.FieldFirstCollectedAt:
+	case bronzereferencerpmpackage.FieldFirstCollectedAt:
 		return m.FirstCollectedAt()
-	case bronzereferenceoscorerule.FieldRuleType:
-		return m.RuleType()
-	case bronzereferenceoscorerule.FieldOsType:
-		return m.OsType()
-	case bronzereferenceoscorerule.FieldValue:
-		return m.Value()
-	case bronzereferenceoscorerule.FieldDescription:
-		return m.Description()
+	case bronzereferencerpmpackage.FieldPackageName:
+		return m.PackageName()
+	case bronzereferencerpmpackage.FieldRepo:
+		return m.Repo()
+	case bronzereferencerpmpackage.FieldArch:
+		return m.Arch()
+	case bronzereferencerpmpackage.FieldVersion:
+		return m.Version()
+	case bronzereferencerpmpackage.FieldRpmGroup:
+		return m.RpmGroup()
+	case bronzereferencerpmpackage.FieldSummary:
+		return m.Summary()
+	case bronzereferencerpmpackage.FieldURL:
+		return m.URL()
 	}
 	return nil, false
 }
@@ -3368,237 +8417,283 @@ func (m *BronzeReferenceOSCoreRuleMutation) Field(name string) (ent.Value, bool)
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *BronzeReferenceOSCoreRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BronzeReferenceRPMPackageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case bronzereferenceoscorerule.FieldCollectedAt:
+	case bronzereferencerpmpackage.FieldCollectedAt:
 		return m.OldCollectedAt(ctx)
-	case bronzereferenceoscorerule.FieldFirstCollectedAt:
+	case bronzereferencerpmpackage.FieldFirstCollectedAt:
 		return m.OldFirstCollectedAt(ctx)
-	case bronzereferenceoscorerule.FieldRuleType:
-		return m.OldRuleType(ctx)
-	case bronzereferenceoscorerule.FieldOsType:
-		return m.OldOsType(ctx)
-	case bronzereferenceoscorerule.FieldValue:
-		return m.OldValue(ctx)
-	case bronzereferenceoscorerule.FieldDescription:
-		return m.OldDescription(ctx)
+	case bronzereferencerpmpackage.FieldPackageName:
+		return m.OldPackageName(ctx)
+	case bronzereferencerpmpackage.FieldRepo:
+		return m.OldRepo(ctx)
+	case bronzereferencerpmpackage.FieldArch:
+		return m.OldArch(ctx)
+	case bronzereferencerpmpackage.FieldVersion:
+		return m.OldVersion(ctx)
+	case bronzereferencerpmpackage.FieldRpmGroup:
+		return m.OldRpmGroup(ctx)
+	case bronzereferencerpmpackage.FieldSummary:
+		return m.OldSummary(ctx)
+	case bronzereferencerpmpackage.FieldURL:
+		return m.OldURL(ctx)
 	}
-	return nil, fmt.Errorf("unknown BronzeReferenceOSCoreRule field %s", name)
+	return nil, fmt.Errorf("unknown BronzeReferenceRPMPackage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BronzeReferenceOSCoreRuleMutation) SetField(name string, value ent.Value) error {
+func (m *BronzeReferenceRPMPackageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case bronzereferenceoscorerule.FieldCollectedAt:
+	case bronzereferencerpmpackage.FieldCollectedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCollectedAt(v)
 		return nil
-	case bronzereferenceoscorerule.FieldFirstCollectedAt:
+	case bronzereferencerpmpackage.FieldFirstCollectedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetFirstCollectedAt(v)
 		return nil
-	case bronzereferenceoscorerule.FieldRuleType:
+	case bronzereferencerpmpackage.FieldPackageName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRuleType(v)
+		m.SetPackageName(v)
 		return nil
-	case bronzereferenceoscorerule.FieldOsType:
+	case bronzereferencerpmpackage.FieldRepo:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOsType(v)
+		m.SetRepo(v)
 		return nil
-	case bronzereferenceoscorerule.FieldValue:
+	case bronzereferencerpmpackage.FieldArch:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetArch(v)
 		return nil
-	case bronzereferenceoscorerule.FieldDescription:
+	case bronzereferencerpmpackage.FieldVersion:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetVersion(v)
+		return nil
+	case bronzereferencerpmpackage.FieldRpmGroup:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRpmGroup(v)
+		return nil
+	case bronzereferencerpmpackage.FieldSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummary(v)
+		return nil
+	case bronzereferencerpmpackage.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
 		return nil
 	}
-	return fmt.Errorf("unknown BronzeReferenceOSCoreRule field %s", name)
+	return fmt.Errorf("unknown BronzeReferenceRPMPackage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *BronzeReferenceOSCoreRuleMutation) AddedFields() []string {
+func (m *BronzeReferenceRPMPackageMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *BronzeReferenceOSCoreRuleMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BronzeReferenceRPMPackageMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BronzeReferenceOSCoreRuleMutation) AddField(name string, value ent.Value) error {
+func (m *BronzeReferenceRPMPackageMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown BronzeReferenceOSCoreRule numeric field %s", name)
+	return fmt.Errorf("unknown BronzeReferenceRPMPackage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *BronzeReferenceOSCoreRuleMutation) ClearedFields() []string {
+func (m *BronzeReferenceRPMPackageMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(bronzereferenceoscorerule.FieldOsType) {
-		fields = append(fields, bronzereferenceoscorerule.FieldOsType)
+	if m.FieldCleared(bronzereferencerpmpackage.FieldVersion) {
+		fields = append(fields, bronzereferencerpmpackage.FieldVersion)
 	}
-	if m.FieldCleared(bronzereferenceoscorerule.FieldDescription) {
-		fields = append(fields, bronzereferenceoscorerule.FieldDescription)
+	if m.FieldCleared(bronzereferencerpmpackage.FieldRpmGroup) {
+		fields = append(fields, bronzereferencerpmpackage.FieldRpmGroup)
+	}
+	if m.FieldCleared(bronzereferencerpmpackage.FieldSummary) {
+		fields = append(fields, bronzereferencerpmpackage.FieldSummary)
+	}
+	if m.FieldCleared(bronzereferencerpmpackage.FieldURL) {
+		fields = append(fields, bronzereferencerpmpackage.FieldURL)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *BronzeReferenceOSCoreRuleMutation) FieldCleared(name string) bool {
+func (m *BronzeReferenceRPMPackageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *BronzeReferenceOSCoreRuleMutation) ClearField(name string) error {
+func (m *BronzeReferenceRPMPackageMutation) ClearField(name string) error {
 	switch name {
-	case bronzereferenceoscorerule.FieldOsType:
-		m.ClearOsType()
+	case bronzereferencerpmpackage.FieldVersion:
+		m.ClearVersion()
 		return nil
-	case bronzereferenceoscorerule.FieldDescription:
-		m.ClearDescription()
+	case bronzereferencerpmpackage.FieldRpmGroup:
+		m.ClearRpmGroup()
+		return nil
+	case bronzereferencerpmpackage.FieldSummary:
+		m.ClearSummary()
+		return nil
+	case bronzereferencerpmpackage.FieldURL:
+		m.ClearURL()
 		return nil
 	}
-	return fmt.Errorf("unknown BronzeReferenceOSCoreRule nullable field %s", name)
+	return fmt.Errorf("unknown BronzeReferenceRPMPackage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *BronzeReferenceOSCoreRuleMutation) ResetField(name string) error {
+func (m *BronzeReferenceRPMPackageMutation) ResetField(name string) error {
 	switch name {
-	case bronzereferenceoscorerule.FieldCollectedAt:
+	case bronzereferencerpmpackage.FieldCollectedAt:
 		m.ResetCollectedAt()
 		return nil
-	case bronzereferenceoscorerule.FieldFirstCollectedAt:
+	case bronzereferencerpmpackage.FieldFirstCollectedAt:
 		m.ResetFirstCollectedAt()
 		return nil
-	case bronzereferenceoscorerule.FieldRuleType:
-		m.ResetRuleType()
+	case bronzereferencerpmpackage.FieldPackageName:
+		m.ResetPackageName()
 		return nil
-	case bronzereferenceoscorerule.FieldOsType:
-		m.ResetOsType()
+	case bronzereferencerpmpackage.FieldRepo:
+		m.ResetRepo()
 		return nil
-	case bronzereferenceoscorerule.FieldValue:
-		m.ResetValue()
+	case bronzereferencerpmpackage.FieldArch:
+		m.ResetArch()
 		return nil
-	case bronzereferenceoscorerule.FieldDescription:
-		m.ResetDescription()
+	case bronzereferencerpmpackage.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case bronzereferencerpmpackage.FieldRpmGroup:
+		m.ResetRpmGroup()
+		return nil
+	case bronzereferencerpmpackage.FieldSummary:
+		m.ResetSummary()
+		return nil
+	case bronzereferencerpmpackage.FieldURL:
+		m.ResetURL()
 		return nil
 	}
-	return fmt.Errorf("unknown BronzeReferenceOSCoreRule field %s", name)
+	return fmt.Errorf("unknown BronzeReferenceRPMPackage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BronzeReferenceOSCoreRuleMutation) AddedEdges() []string {
+func (m *BronzeReferenceRPMPackageMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *BronzeReferenceOSCoreRuleMutation) AddedIDs(name string) []ent.Value {
+func (m *BronzeReferenceRPMPackageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BronzeReferenceOSCoreRuleMutation) RemovedEdges() []string {
+func (m *BronzeReferenceRPMPackageMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *BronzeReferenceOSCoreRuleMutation) RemovedIDs(name string) []ent.Value {
+func (m *BronzeReferenceRPMPackageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BronzeReferenceOSCoreRuleMutation) ClearedEdges() []string {
+func (m *BronzeReferenceRPMPackageMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *BronzeReferenceOSCoreRuleMutation) EdgeCleared(name string) bool {
+func (m *BronzeReferenceRPMPackageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *BronzeReferenceOSCoreRuleMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown BronzeReferenceOSCoreRule unique edge %s", name)
+func (m *BronzeReferenceRPMPackageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeReferenceRPMPackage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *BronzeReferenceOSCoreRuleMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown BronzeReferenceOSCoreRule edge %s", name)
+func (m *BronzeReferenceRPMPackageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeReferenceRPMPackage edge %s", name)
 }
 
-// BronzeReferenceRPMPackageMutation represents an operation that mutates the BronzeReferenceRPMPackage nodes in the graph.
-type BronzeReferenceRPMPackageMutation struct {
+// BronzeReferenceSoftwareMatchRuleMutation represents an operation that mutates the BronzeReferenceSoftwareMatchRule nodes in the graph.
+type BronzeReferenceSoftwareMatchRuleMutation struct {
 	config
 	op                 Op
 	typ                string
 	id                 *string
 	collected_at       *time.Time
 	first_collected_at *time.Time
-	package_name       *string
-	repo               *string
-	arch               *string
-	version            *string
-	rpm_group          *string
-	summary            *string
-	url                *string
+	product_slug       *string
+	rule_type          *string
+	os_type            *string
+	value              *string
+	extra_value        *string
 	clearedFields      map[string]struct{}
 	done               bool
-	oldValue           func(context.Context) (*BronzeReferenceRPMPackage, error)
-	predicates         []predicate.BronzeReferenceRPMPackage
+	oldValue           func(context.Context) (*BronzeReferenceSoftwareMatchRule, error)
+	predicates         []predicate.BronzeReferenceSoftwareMatchRule
 }
 
-var _ ent.Mutation = (*BronzeReferenceRPMPackageMutation)(nil)
+var _ ent.Mutation = (*BronzeReferenceSoftwareMatchRuleMutation)(nil)
 
-// bronzereferencerpmpackageOption allows management of the mutation configuration using functional options.
-type bronzereferencerpmpackageOption func(*BronzeReferenceRPMPackageMutation)
+// bronzereferencesoftwarematchruleOption allows management of the mutation configuration using functional options.
+type bronzereferencesoftwarematchruleOption func(*BronzeReferenceSoftwareMatchRuleMutation)
 
-// newBronzeReferenceRPMPackageMutation creates new mutation for the BronzeReferenceRPMPackage entity.
-func newBronzeReferenceRPMPackageMutation(c config, op Op, opts ...bronzereferencerpmpackageOption) *BronzeReferenceRPMPackageMutation {
-	m := &BronzeReferenceRPMPackageMutation{
+// newBronzeReferenceSoftwareMatchRuleMutation creates new mutation for the BronzeReferenceSoftwareMatchRule entity.
+func newBronzeReferenceSoftwareMatchRuleMutation(c config, op Op, opts ...bronzereferencesoftwarematchruleOption) *BronzeReferenceSoftwareMatchRuleMutation {
+	m := &BronzeReferenceSoftwareMatchRuleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeBronzeReferenceRPMPackage,
+		typ:           TypeBronzeReferenceSoftwareMatchRule,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3607,20 +8702,20 @@ func newBronzeReferenceRPMPackageMutation(c config, op Op, opts ...bronzereferen
 	return m
 }
 
-// withBronzeReferenceRPMPackageID sets the ID field of the mutation.
-func withBronzeReferenceRPMPackageID(id string) bronzereferencerpmpackageOption {
-	return func(m *BronzeReferenceRPMPackageMutation) {
+// withBronzeReferenceSoftwareMatchRuleID sets the ID field of the mutation.
+func withBronzeReferenceSoftwareMatchRuleID(id string) bronzereferencesoftwarematchruleOption {
+	return func(m *BronzeReferenceSoftwareMatchRuleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *BronzeReferenceRPMPackage
+			value *BronzeReferenceSoftwareMatchRule
 		)
-		m.oldValue = func(ctx context.Context) (*BronzeReferenceRPMPackage, error) {
+		m.oldValue = func(ctx context.Context) (*BronzeReferenceSoftwareMatchRule, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().BronzeReferenceRPMPackage.Get(ctx, id)
+					value, err = m.Client().BronzeReferenceSoftwareMatchRule.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3629,10 +8724,10 @@ func withBronzeReferenceRPMPackageID(id string) bronzereferencerpmpackageOption
 	}
 }
 
-// withBronzeReferenceRPMPackage sets the old BronzeReferenceRPMPackage of the mutation.
-func withBronzeReferenceRPMPackage(node *BronzeReferenceRPMPackage) bronzereferencerpmpackageOption {
-	return func(m *BronzeReferenceRPMPackageMutation) {
-		m.oldValue = func(context.Context) (*BronzeReferenceRPMPackage, error) {
+// withBronzeReferenceSoftwareMatchRule sets the old BronzeReferenceSoftwareMatchRule of the mutation.
+func withBronzeReferenceSoftwareMatchRule(node *BronzeReferenceSoftwareMatchRule) bronzereferencesoftwarematchruleOption {
+	return func(m *BronzeReferenceSoftwareMatchRuleMutation) {
+		m.oldValue = func(context.Context) (*BronzeReferenceSoftwareMatchRule, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3641,7 +8736,7 @@ func withBronzeReferenceRPMPackage(node *BronzeReferenceRPMPackage) bronzerefere
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BronzeReferenceRPMPackageMutation) Client() *Client {
+func (m BronzeReferenceSoftwareMatchRuleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3649,7 +8744,7 @@ func (m BronzeReferenceRPMPackageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m BronzeReferenceRPMPackageMutation) Tx() (*Tx, error) {
+func (m BronzeReferenceSoftwareMatchRuleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("reference: mutation is not running in a transaction")
 	}
@@ -3659,14 +8754,14 @@ func (m BronzeReferenceRPMPackageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of BronzeReferenceRPMPackage entities.
-func (m *BronzeReferenceRPMPackageMutation) SetID(id string) {
+// operation is only accepted on creation of BronzeReferenceSoftwareMatchRule entities.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *BronzeReferenceRPMPackageMutation) ID() (id string, exists bool) {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3677,7 +8772,7 @@ func (m *BronzeReferenceRPMPackageMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *BronzeReferenceRPMPackageMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3686,19 +8781,19 @@ func (m *BronzeReferenceRPMPackageMutation) IDs(ctx context.Context) ([]string,
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().BronzeReferenceRPMPackage.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BronzeReferenceSoftwareMatchRule.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCollectedAt sets the "collected_at" field.
-func (m *BronzeReferenceRPMPackageMutation) SetCollectedAt(t time.Time) {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) SetCollectedAt(t time.Time) {
 	m.collected_at = &t
 }
 
 // CollectedAt returns the value of the "collected_at" field in the mutation.
-func (m *BronzeReferenceRPMPackageMutation) CollectedAt() (r time.Time, exists bool) {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) CollectedAt() (r time.Time, exists bool) {
 	v := m.collected_at
 	if v == nil {
 		return
@@ -3706,10 +8801,10 @@ func (m *BronzeReferenceRPMPackageMutation) CollectedAt() (r time.Time, exists b
 	return *v, true
 }
 
-// OldCollectedAt returns the old "collected_at" field's value of the BronzeReferenceRPMPackage entity.
-// If the BronzeReferenceRPMPackage object wasn't provided to the builder, the object is fetched from the database.
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeReferenceSoftwareMatchRule entity.
+// If the BronzeReferenceSoftwareMatchRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceRPMPackageMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
 	}
@@ -3724,17 +8819,17 @@ func (m *BronzeReferenceRPMPackageMutation) OldCollectedAt(ctx context.Context)
 }
 
 // ResetCollectedAt resets all changes to the "collected_at" field.
-func (m *BronzeReferenceRPMPackageMutation) ResetCollectedAt() {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) ResetCollectedAt() {
 	m.collected_at = nil
 }
 
 // SetFirstCollectedAt sets the "first_collected_at" field.
-func (m *BronzeReferenceRPMPackageMutation) SetFirstCollectedAt(t time.Time) {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) SetFirstCollectedAt(t time.Time) {
 	m.first_collected_at = &t
 }
 
 // FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
-func (m *BronzeReferenceRPMPackageMutation) FirstCollectedAt() (r time.Time, exists bool) {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) FirstCollectedAt() (r time.Time, exists bool) {
 	v := m.first_collected_at
 	if v == nil {
 		return
@@ -3742,10 +8837,10 @@ func (m *BronzeReferenceRPMPackageMutation) FirstCollectedAt() (r time.Time, exi
 	return *v, true
 }
 
-// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeReferenceRPMPackage entity.
-// If the BronzeReferenceRPMPackage object wasn't provided to the builder, the object is fetched from the database.
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeReferenceSoftwareMatchRule entity.
+// If the BronzeReferenceSoftwareMatchRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceRPMPackageMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
 	}
@@ -3760,323 +8855,225 @@ func (m *BronzeReferenceRPMPackageMutation) OldFirstCollectedAt(ctx context.Cont
 }
 
 // ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
-func (m *BronzeReferenceRPMPackageMutation) ResetFirstCollectedAt() {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) ResetFirstCollectedAt() {
 	m.first_collected_at = nil
 }
 
-// SetPackageName sets the "package_name" field.
-func (m *BronzeReferenceRPMPackageMutation) SetPackageName(s string) {
-	m.package_name = &s
-}
-
-// PackageName returns the value of the "package_name" field in the mutation.
-func (m *BronzeReferenceRPMPackageMutation) PackageName() (r string, exists bool) {
-	v := m.package_name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPackageName returns the old "package_name" field's value of the BronzeReferenceRPMPackage entity.
-// If the BronzeReferenceRPMPackage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceRPMPackageMutation) OldPackageName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPackageName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPackageName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPackageName: %w", err)
-	}
-	return oldValue.PackageName, nil
-}
-
-// ResetPackageName resets all changes to the "package_name" field.
-func (m *BronzeReferenceRPMPackageMutation) ResetPackageName() {
-	m.package_name = nil
-}
-
-// SetRepo sets the "repo" field.
-func (m *BronzeReferenceRPMPackageMutation) SetRepo(s string) {
-	m.repo = &s
-}
-
-// Repo returns the value of the "repo" field in the mutation.
-func (m *BronzeReferenceRPMPackageMutation) Repo() (r string, exists bool) {
-	v := m.repo
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldRepo returns the old "repo" field's value of the BronzeReferenceRPMPackage entity.
-// If the BronzeReferenceRPMPackage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceRPMPackageMutation) OldRepo(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRepo is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRepo requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRepo: %w", err)
-	}
-	return oldValue.Repo, nil
-}
-
-// ResetRepo resets all changes to the "repo" field.
-func (m *BronzeReferenceRPMPackageMutation) ResetRepo() {
-	m.repo = nil
-}
-
-// SetArch sets the "arch" field.
-func (m *BronzeReferenceRPMPackageMutation) SetArch(s string) {
-	m.arch = &s
+// SetProductSlug sets the "product_slug" field.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) SetProductSlug(s string) {
+	m.product_slug = &s
 }
 
-// Arch returns the value of the "arch" field in the mutation.
-func (m *BronzeReferenceRPMPackageMutation) Arch() (r string, exists bool) {
-	v := m.arch
+// ProductSlug returns the value of the "product_slug" field in the mutation.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) ProductSlug() (r string, exists bool) {
+	v := m.product_slug
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldArch returns the old "arch" field's value of the BronzeReferenceRPMPackage entity.
-// If the BronzeReferenceRPMPackage object wasn't provided to the builder, the object is fetched from the database.
+// OldProductSlug returns the old "product_slug" field's value of the BronzeReferenceSoftwareMatchRule entity.
+// If the BronzeReferenceSoftwareMatchRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceRPMPackageMutation) OldArch(ctx context.Context) (v string, err error) {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) OldProductSlug(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldArch is only allowed on UpdateOne operations")
+		return v, errors.New("OldProductSlug is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldArch requires an ID field in the mutation")
+		return v, errors.New("OldProductSlug requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldArch: %w", err)
+		return v, fmt.Errorf("querying old value for OldProductSlug: %w", err)
 	}
-	return oldValue.Arch, nil
+	return oldValue.ProductSlug, nil
 }
 
-// ResetArch resets all changes to the "arch" field.
-func (m *BronzeReferenceRPMPackageMutation) ResetArch() {
-	m.arch = nil
+// ResetProductSlug resets all changes to the "product_slug" field.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) ResetProductSlug() {
+	m.product_slug = nil
 }
 
-// SetVersion sets the "version" field.
-func (m *BronzeReferenceRPMPackageMutation) SetVersion(s string) {
-	m.version = &s
+// SetRuleType sets the "rule_type" field.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) SetRuleType(s string) {
+	m.rule_type = &s
 }
 
-// Version returns the value of the "version" field in the mutation.
-func (m *BronzeReferenceRPMPackageMutation) Version() (r string, exists bool) {
-	v := m.version
+// RuleType returns the value of the "rule_type" field in the mutation.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) RuleType() (r string, exists bool) {
+	v := m.rule_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVersion returns the old "version" field's value of the BronzeReferenceRPMPackage entity.
-// If the BronzeReferenceRPMPackage object wasn't provided to the builder, the object is fetched from the database.
+// OldRuleType returns the old "rule_type" field's value of the BronzeReferenceSoftwareMatchRule entity.
+// If the BronzeReferenceSoftwareMatchRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceRPMPackageMutation) OldVersion(ctx context.Context) (v string, err error) {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) OldRuleType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldRuleType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersion requires an ID field in the mutation")
+		return v, errors.New("OldRuleType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldRuleType: %w", err)
 	}
-	return oldValue.Version, nil
-}
-
-// ClearVersion clears the value of the "version" field.
-func (m *BronzeReferenceRPMPackageMutation) ClearVersion() {
-	m.version = nil
-	m.clearedFields[bronzereferencerpmpackage.FieldVersion] = struct{}{}
-}
-
-// VersionCleared returns if the "version" field was cleared in this mutation.
-func (m *BronzeReferenceRPMPackageMutation) VersionCleared() bool {
-	_, ok := m.clearedFields[bronzereferencerpmpackage.FieldVersion]
-	return ok
+	return oldValue.RuleType, nil
 }
 
-// ResetVersion resets all changes to the "version" field.
-func (m *BronzeReferenceRPMPackageMutation) ResetVersion() {
-	m.version = nil
-	delete(m.clearedFields, bronzereferencerpmpackage.FieldVersion)
+// ResetRuleType resets all changes to the "rule_type" field.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) ResetRuleType() {
+	m.rule_type = nil
 }
 
-// SetRpmGroup sets the "rpm_group" field.
-func (m *BronzeReferenceRPMPackageMutation) SetRpmGroup(s string) {
-	m.rpm_group = &s
+// SetOsType sets the "os_type" field.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) SetOsType(s string) {
+	m.os_type = &s
 }
 
-// RpmGroup returns the value of the "rpm_group" field in the mutation.
-func (m *BronzeReferenceRPMPackageMutation) RpmGroup() (r string, exists bool) {
-	v := m.rpm_group
+// OsType returns the value of the "os_type" field in the mutation.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) OsType() (r string, exists bool) {
+	v := m.os_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRpmGroup returns the old "rpm_group" field's value of the BronzeReferenceRPMPackage entity.
-// If the BronzeReferenceRPMPackage object wasn't provided to the builder, the object is fetched from the database.
+// OldOsType returns the old "os_type" field's value of the BronzeReferenceSoftwareMatchRule entity.
+// If the BronzeReferenceSoftwareMatchRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceRPMPackageMutation) OldRpmGroup(ctx context.Context) (v string, err error) {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) OldOsType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRpmGroup is only allowed on UpdateOne operations")
+		return v, errors.New("OldOsType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRpmGroup requires an ID field in the mutation")
+		return v, errors.New("OldOsType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRpmGroup: %w", err)
+		return v, fmt.Errorf("querying old value for OldOsType: %w", err)
 	}
-	return oldValue.RpmGroup, nil
+	return oldValue.OsType, nil
 }
 
-// ClearRpmGroup clears the value of the "rpm_group" field.
-func (m *BronzeReferenceRPMPackageMutation) ClearRpmGroup() {
-	m.rpm_group = nil
-	m.clearedFields[bronzereferencerpmpackage.FieldRpmGroup] = struct{}{}
+// ClearOsType clears the value of the "os_type" field.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) ClearOsType() {
+	m.os_type = nil
+	m.clearedFields[bronzereferencesoftwarematchrule.FieldOsType] = struct{}{}
 }
 
-// RpmGroupCleared returns if the "rpm_group" field was cleared in this mutation.
-func (m *BronzeReferenceRPMPackageMutation) RpmGroupCleared() bool {
-	_, ok := m.clearedFields[bronzereferencerpmpackage.FieldRpmGroup]
+// OsTypeCleared returns if the "os_type" field was cleared in this mutation.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) OsTypeCleared() bool {
+	_, ok := m.clearedFields[bronzereferencesoftwarematchrule.FieldOsType]
 	return ok
 }
 
-// ResetRpmGroup resets all changes to the "rpm_group" field.
-func (m *BronzeReferenceRPMPackageMutation) ResetRpmGroup() {
-	m.rpm_group = nil
-	delete(m.clearedFields, bronzereferencerpmpackage.FieldRpmGroup)
+// ResetOsType resets all changes to the "os_type" field.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) ResetOsType() {
+	m.os_type = nil
+	delete(m.clearedFields, bronzereferencesoftwarematchrule.FieldOsType)
 }
 
-// SetSummary sets the "summary" field.
-func (m *BronzeReferenceRPMPackageMutation) SetSummary(s string) {
-	m.summary = &s
+// SetValue sets the "value" field.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) SetValue(s string) {
+	m.value = &s
 }
 
-// Summary returns the value of the "summary" field in the mutation.
-func (m *BronzeReferenceRPMPackageMutation) Summary() (r string, exists bool) {
-	v := m.summary
+// Value returns the value of the "value" field in the mutation.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) Value() (r string, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSummary returns the old "summary" field's value of the BronzeReferenceRPMPackage entity.
-// If the BronzeReferenceRPMPackage object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the BronzeReferenceSoftwareMatchRule entity.
+// If the BronzeReferenceSoftwareMatchRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceRPMPackageMutation) OldSummary(ctx context.Context) (v string, err error) {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) OldValue(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSummary requires an ID field in the mutation")
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.Summary, nil
-}
-
-// ClearSummary clears the value of the "summary" field.
-func (m *BronzeReferenceRPMPackageMutation) ClearSummary() {
-	m.summary = nil
-	m.clearedFields[bronzereferencerpmpackage.FieldSummary] = struct{}{}
-}
-
-// SummaryCleared returns if the "summary" field was cleared in this mutation.
-func (m *BronzeReferenceRPMPackageMutation) SummaryCleared() bool {
-	_, ok := m.clearedFields[bronzereferencerpmpackage.FieldSummary]
-	return ok
+	return oldValue.Value, nil
 }
 
-// ResetSummary resets all changes to the "summary" field.
-func (m *BronzeReferenceRPMPackageMutation) ResetSummary() {
-	m.summary = nil
-	delete(m.clearedFields, bronzereferencerpmpackage.FieldSummary)
+// ResetValue resets all changes to the "value" field.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) ResetValue() {
+	m.value = nil
 }
 
-// SetURL sets the "url" field.
-func (m *BronzeReferenceRPMPackageMutation) SetURL(s string) {
-	m.url = &s
+// SetExtraValue sets the "extra_value" field.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) SetExtraValue(s string) {
+	m.extra_value = &s
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *BronzeReferenceRPMPackageMutation) URL() (r string, exists bool) {
-	v := m.url
+// ExtraValue returns the value of the "extra_value" field in the mutation.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) ExtraValue() (r string, exists bool) {
+	v := m.extra_value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the BronzeReferenceRPMPackage entity.
-// If the BronzeReferenceRPMPackage object wasn't provided to the builder, the object is fetched from the database.
+// OldExtraValue returns the old "extra_value" field's value of the BronzeReferenceSoftwareMatchRule entity.
+// If the BronzeReferenceSoftwareMatchRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceRPMPackageMutation) OldURL(ctx context.Context) (v string, err error) {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) OldExtraValue(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldExtraValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldExtraValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldExtraValue: %w", err)
 	}
-	return oldValue.URL, nil
+	return oldValue.ExtraValue, nil
 }
 
-// ClearURL clears the value of the "url" field.
-func (m *BronzeReferenceRPMPackageMutation) ClearURL() {
-	m.url = nil
-	m.clearedFields[bronzereferencerpmpackage.FieldURL] = struct{}{}
+// ClearExtraValue clears the value of the "extra_value" field.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) ClearExtraValue() {
+	m.extra_value = nil
+	m.clearedFields[bronzereferencesoftwarematchrule.FieldExtraValue] = struct{}{}
 }
 
-// URLCleared returns if the "url" field was cleared in this mutation.
-func (m *BronzeReferenceRPMPackageMutation) URLCleared() bool {
-	_, ok := m.clearedFields[bronzereferencerpmpackage.FieldURL]
+// ExtraValueCleared returns if the "extra_value" field was cleared in this mutation.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) ExtraValueCleared() bool {
+	_, ok := m.clearedFields[bronzereferencesoftwarematchrule.FieldExtraValue]
 	return ok
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *BronzeReferenceRPMPackageMutation) ResetURL() {
-	m.url = nil
-	delete(m.clearedFields, bronzereferencerpmpackage.FieldURL)
+// ResetExtraValue resets all changes to the "extra_value" field.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) ResetExtraValue() {
+	m.extra_value = nil
+	delete(m.clearedFields, bronzereferencesoftwarematchrule.FieldExtraValue)
 }
 
-// Where appends a list predicates to the BronzeReferenceRPMPackageMutation builder.
-func (m *BronzeReferenceRPMPackageMutation) Where(ps ...predicate.BronzeReferenceRPMPackage) {
+// Where appends a list predicates to the BronzeReferenceSoftwareMatchRuleMutation builder.
+func (m *BronzeReferenceSoftwareMatchRuleMutation) Where(ps ...predicate.BronzeReferenceSoftwareMatchRule) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the BronzeReferenceRPMPackageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BronzeReferenceSoftwareMatchRuleMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BronzeReferenceRPMPackageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.BronzeReferenceRPMPackage, len(ps))
+func (m *BronzeReferenceSoftwareMatchRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeReferenceSoftwareMatchRule, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4084,51 +9081,45 @@ func (m *BronzeReferenceRPMPackageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *BronzeReferenceRPMPackageMutation) Op() Op {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *BronzeReferenceRPMPackageMutation) SetOp(op Op) {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (BronzeReferenceRPMPackage).
-func (m *BronzeReferenceRPMPackageMutation) Type() string {
+// Type returns the node type of this mutation (BronzeReferenceSoftwareMatchRule).
+func (m *BronzeReferenceSoftwareMatchRuleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *BronzeReferenceRPMPackageMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *BronzeReferenceSoftwareMatchRuleMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.collected_at != nil {
-		fields = append(fields, bronzereferencerpmpackage.FieldCollectedAt)
+		fields = append(fields, bronzereferencesoftwarematchrule.FieldCollectedAt)
 	}
 	if m.first_collected_at != nil {
-		fields = append(fields, bronzereferencerpmpackage.FieldFirstCollectedAt)
-	}
-	if m.package_name != nil {
-		fields = append(fields, bronzereferencerpmpackage.FieldPackageName)
-	}
-	if m.repo != nil {
-		fields = append(fields, bronzereferencerpmpackage.FieldRepo)
+		fields = append(fields, bronzereferencesoftwarematchrule.FieldFirstCollectedAt)
 	}
-	if m.arch != nil {
-		fields = append(fields, bronzereferencerpmpackage.FieldArch)
+	if m.product_slug != nil {
+		fields = append(fields, bronzereferencesoftwarematchrule.FieldProductSlug)
 	}
-	if m.version != nil {
-		fields = append(fields, bronzereferencerpmpackage.FieldVersion)
+	if m.rule_type != nil {
+		fields = append(fields, bronzereferencesoftwarematchrule.FieldRuleType)
 	}
-	if m.rpm_group != nil {
-		fields = append(fields, bronzereferencerpmpackage.FieldRpmGroup)
+	if m.os_type != nil {
+		fields = append(fields, bronzereferencesoftwarematchrule.FieldOsType)
 	}
-	if m.summary != nil {
-		fields = append(fields, bronzereferencerpmpackage.FieldSummary)
+	if m.value != nil {
+		fields = append(fields, bronzereferencesoftwarematchrule.FieldValue)
 	}
-	if m.url != nil {
-		fields = append(fields, bronzereferencerpmpackage.FieldURL)
+	if m.extra_value != nil {
+		fields = append(fields, bronzereferencesoftwarematchrule.FieldExtraValue)
 	}
 	return fields
 }
@@ -4136,26 +9127,22 @@ func (m *BronzeReferenceRPMPackageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *BronzeReferenceRPMPackageMutation) Field(name string) (ent.Value, bool) {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case bronzereferencerpmpackage.FieldCollectedAt:
+	case bronzereferencesoftwarematchrule.FieldCollectedAt:
 		return m.CollectedAt()
-	case bronzereferencerpmpackage.FieldFirstCollectedAt:
+	case bronzereferencesoftwarematchrule.FieldFirstCollectedAt:
 		return m.FirstCollectedAt()
-	case bronzereferencerpmpackage.FieldPackageName:
-		return m.PackageName()
-	case bronzereferencerpmpackage.FieldRepo:
-		return m.Repo()
-	case bronzereferencerpmpackage.FieldArch:
-		return m.Arch()
-	case bronzereferencerpmpackage.FieldVersion:
-		return m.Version()
-	case bronzereferencerpmpackage.FieldRpmGroup:
-		return m.RpmGroup()
-	case bronzereferencerpmpackage.FieldSummary:
-		return m.Summary()
-	case bronzereferencerpmpackage.FieldURL:
-		return m.URL()
+	case bronzereferencesoftwarematchrule.FieldProductSlug:
+		return m.ProductSlug()
+	case bronzereferencesoftwarematchrule.FieldRuleType:
+		return m.RuleType()
+	case bronzereferencesoftwarematchrule.FieldOsType:
+		return m.OsType()
+	case bronzereferencesoftwarematchrule.FieldValue:
+		return m.Value()
+	case bronzereferencesoftwarematchrule.FieldExtraValue:
+		return m.ExtraValue()
 	}
 	return nil, false
 }
@@ -4163,283 +9150,249 @@ func (m *BronzeReferenceRPMPackageMutation) Field(name string) (ent.Value, bool)
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *BronzeReferenceRPMPackageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case bronzereferencerpmpackage.FieldCollectedAt:
+	case bronzereferencesoftwarematchrule.FieldCollectedAt:
 		return m.OldCollectedAt(ctx)
-	case bronzereferencerpmpackage.FieldFirstCollectedAt:
+	case bronzereferencesoftwarematchrule.FieldFirstCollectedAt:
 		return m.OldFirstCollectedAt(ctx)
-	case bronzereferencerpmpackage.FieldPackageName:
-		return m.OldPackageName(ctx)
-	case bronzereferencerpmpackage.FieldRepo:
-		return m.OldRepo(ctx)
-	case bronzereferencerpmpackage.FieldArch:
-		return m.OldArch(ctx)
-	case bronzereferencerpmpackage.FieldVersion:
-		return m.OldVersion(ctx)
-	case bronzereferencerpmpackage.FieldRpmGroup:
-		return m.OldRpmGroup(ctx)
-	case bronzereferencerpmpackage.FieldSummary:
-		return m.OldSummary(ctx)
-	case bronzereferencerpmpackage.FieldURL:
-		return m.OldURL(ctx)
+	case bronzereferencesoftwarematchrule.FieldProductSlug:
+		return m.OldProductSlug(ctx)
+	case bronzereferencesoftwarematchrule.FieldRuleType:
+		return m.OldRuleType(ctx)
+	case bronzereferencesoftwarematchrule.FieldOsType:
+		return m.OldOsType(ctx)
+	case bronzereferencesoftwarematchrule.FieldValue:
+		return m.OldValue(ctx)
+	case bronzereferencesoftwarematchrule.FieldExtraValue:
+		return m.OldExtraValue(ctx)
 	}
-	return nil, fmt.Errorf("unknown BronzeReferenceRPMPackage field %s", name)
+	return nil, fmt.Errorf("unknown BronzeReferenceSoftwareMatchRule field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BronzeReferenceRPMPackageMutation) SetField(name string, value ent.Value) error {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case bronzereferencerpmpackage.FieldCollectedAt:
+	case bronzereferencesoftwarematchrule.FieldCollectedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCollectedAt(v)
 		return nil
-	case bronzereferencerpmpackage.FieldFirstCollectedAt:
+	case bronzereferencesoftwarematchrule.FieldFirstCollectedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFirstCollectedAt(v)
-		return nil
-	case bronzereferencerpmpackage.FieldPackageName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPackageName(v)
-		return nil
-	case bronzereferencerpmpackage.FieldRepo:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRepo(v)
+		m.SetFirstCollectedAt(v)
 		return nil
-	case bronzereferencerpmpackage.FieldArch:
+	case bronzereferencesoftwarematchrule.FieldProductSlug:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetArch(v)
+		m.SetProductSlug(v)
 		return nil
-	case bronzereferencerpmpackage.FieldVersion:
+	case bronzereferencesoftwarematchrule.FieldRuleType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVersion(v)
+		m.SetRuleType(v)
 		return nil
-	case bronzereferencerpmpackage.FieldRpmGroup:
+	case bronzereferencesoftwarematchrule.FieldOsType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRpmGroup(v)
+		m.SetOsType(v)
 		return nil
-	case bronzereferencerpmpackage.FieldSummary:
+	case bronzereferencesoftwarematchrule.FieldValue:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSummary(v)
+		m.SetValue(v)
 		return nil
-	case bronzereferencerpmpackage.FieldURL:
+	case bronzereferencesoftwarematchrule.FieldExtraValue:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetURL(v)
+		m.SetExtraValue(v)
 		return nil
 	}
-	return fmt.Errorf("unknown BronzeReferenceRPMPackage field %s", name)
+	return fmt.Errorf("unknown BronzeReferenceSoftwareMatchRule field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *BronzeReferenceRPMPackageMutation) AddedFields() []string {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *BronzeReferenceRPMPackageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BronzeReferenceRPMPackageMutation) AddField(name string, value ent.Value) error {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown BronzeReferenceRPMPackage numeric field %s", name)
+	return fmt.Errorf("unknown BronzeReferenceSoftwareMatchRule numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *BronzeReferenceRPMPackageMutation) ClearedFields() []string {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(bronzereferencerpmpackage.FieldVersion) {
-		fields = append(fields, bronzereferencerpmpackage.FieldVersion)
-	}
-	if m.FieldCleared(bronzereferencerpmpackage.FieldRpmGroup) {
-		fields = append(fields, bronzereferencerpmpackage.FieldRpmGroup)
-	}
-	if m.FieldCleared(bronzereferencerpmpackage.FieldSummary) {
-		fields = append(fields, bronzereferencerpmpackage.FieldSummary)
+	if m.FieldCleared(bronzereferencesoftwarematchrule.FieldOsType) {
+		fields = append(fields, bronzereferencesoftwarematchrule.FieldOsType)
 	}
-	if m.FieldCleared(bronzereferencerpmpackage.FieldURL) {
-		fields = append(fields, bronzereferencerpmpackage.FieldURL)
+	if m.FieldCleared(bronzereferencesoftwarematchrule.FieldExtraValue) {
+		fields = append(fields, bronzereferencesoftwarematchrule.FieldExtraValue)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *BronzeReferenceRPMPackageMutation) FieldCleared(name string) bool {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *BronzeReferenceRPMPackageMutation) ClearField(name string) error {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) ClearField(name string) error {
 	switch name {
-	case bronzereferencerpmpackage.FieldVersion:
-		m.ClearVersion()
-		return nil
-	case bronzereferencerpmpackage.FieldRpmGroup:
-		m.ClearRpmGroup()
-		return nil
-	case bronzereferencerpmpackage.FieldSummary:
-		m.ClearSummary()
+	case bronzereferencesoftwarematchrule.FieldOsType:
+		m.ClearOsType()
 		return nil
-	case bronzereferencerpmpackage.FieldURL:
-		m.ClearURL()
+	case bronzereferencesoftwarematchrule.FieldExtraValue:
+		m.ClearExtraValue()
 		return nil
 	}
-	return fmt.Errorf("unknown BronzeReferenceRPMPackage nullable field %s", name)
+	return fmt.Errorf("unknown BronzeReferenceSoftwareMatchRule nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *BronzeReferenceRPMPackageMutation) ResetField(name string) error {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) ResetField(name string) error {
 	switch name {
-	case bronzereferencerpmpackage.FieldCollectedAt:
+	case bronzereferencesoftwarematchrule.FieldCollectedAt:
 		m.ResetCollectedAt()
 		return nil
-	case bronzereferencerpmpackage.FieldFirstCollectedAt:
+	case bronzereferencesoftwarematchrule.FieldFirstCollectedAt:
 		m.ResetFirstCollectedAt()
 		return nil
-	case bronzereferencerpmpackage.FieldPackageName:
-		m.ResetPackageName()
-		return nil
-	case bronzereferencerpmpackage.FieldRepo:
-		m.ResetRepo()
-		return nil
-	case bronzereferencerpmpackage.FieldArch:
-		m.ResetArch()
+	case bronzereferencesoftwarematchrule.FieldProductSlug:
+		m.ResetProductSlug()
 		return nil
-	case bronzereferencerpmpackage.FieldVersion:
-		m.ResetVersion()
+	case bronzereferencesoftwarematchrule.FieldRuleType:
+		m.ResetRuleType()
 		return nil
-	case bronzereferencerpmpackage.FieldRpmGroup:
-		m.ResetRpmGroup()
+	case bronzereferencesoftwarematchrule.FieldOsType:
+		m.ResetOsType()
 		return nil
-	case bronzereferencerpmpackage.FieldSummary:
-		m.ResetSummary()
+	case bronzereferencesoftwarematchrule.FieldValue:
+		m.ResetValue()
 		return nil
-	case bronzereferencerpmpackage.FieldURL:
-		m.ResetURL()
+	case bronzereferencesoftwarematchrule.FieldExtraValue:
+		m.ResetExtraValue()
 		return nil
 	}
-	return fmt.Errorf("unknown BronzeReferenceRPMPackage field %s", name)
+	return fmt.Errorf("unknown BronzeReferenceSoftwareMatchRule field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BronzeReferenceRPMPackageMutation) AddedEdges() []string {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *BronzeReferenceRPMPackageMutation) AddedIDs(name string) []ent.Value {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BronzeReferenceRPMPackageMutation) RemovedEdges() []string {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *BronzeReferenceRPMPackageMutation) RemovedIDs(name string) []ent.Value {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BronzeReferenceRPMPackageMutation) ClearedEdges() []string {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *BronzeReferenceRPMPackageMutation) EdgeCleared(name string) bool {
+func (m *BronzeReferenceSoftwareMatchRuleMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *BronzeReferenceRPMPackageMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown BronzeReferenceRPMPackage unique edge %s", name)
+func (m *BronzeReferenceSoftwareMatchRuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeReferenceSoftwareMatchRule unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *BronzeReferenceRPMPackageMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown BronzeReferenceRPMPackage edge %s", name)
+func (m *BronzeReferenceSoftwareMatchRuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeReferenceSoftwareMatchRule edge %s", name)
 }
 
-// BronzeReferenceSoftwareMatchRuleMutation represents an operation that mutates the BronzeReferenceSoftwareMatchRule nodes in the graph.
-type BronzeReferenceSoftwareMatchRuleMutation struct {
+// BronzeReferenceThreatIntelIndicatorMutation represents an operation that mutates the BronzeReferenceThreatIntelIndicator nodes in the graph.
+type BronzeReferenceThreatIntelIndicatorMutation struct {
 	config
 	op                 Op
 	typ                string
 	id                 *string
 	collected_at       *time.Time
 	first_collected_at *time.Time
-	product_slug       *string
-	rule_type          *string
-	os_type            *string
-	value              *string
-	extra_value        *string
+	feed_name          *string
+	feed_source        *string
+	feed_format        *string
+	category           *string
+	cidr               *string
+	description        *string
+	expires_at         *time.Time
 	clearedFields      map[string]struct{}
 	done               bool
-	oldValue           func(context.Context) (*BronzeReferenceSoftwareMatchRule, error)
-	predicates         []predicate.BronzeReferenceSoftwareMatchRule
+	oldValue           func(context.Context) (*BronzeReferenceThreatIntelIndicator, error)
+	predicates         []predicate.BronzeReferenceThreatIntelIndicator
 }
 
-var _ ent.Mutation = (*BronzeReferenceSoftwareMatchRuleMutation)(nil)
+var _ ent.Mutation = (*BronzeReferenceThreatIntelIndicatorMutation)(nil)
 
-// bronzereferencesoftwarematchruleOption allows management of the mutation configuration using functional options.
-type bronzereferencesoftwarematchruleOption func(*BronzeReferenceSoftwareMatchRuleMutation)
+// bronzereferencethreatintelindicatorOption allows management of the mutation configuration using functional options.
+type bronzereferencethreatintelindicatorOption func(*BronzeReferenceThreatIntelIndicatorMutation)
 
-// newBronzeReferenceSoftwareMatchRuleMutation creates new mutation for the BronzeReferenceSoftwareMatchRule entity.
-func newBronzeReferenceSoftwareMatchRuleMutation(c config, op Op, opts ...bronzereferencesoftwarematchruleOption) *BronzeReferenceSoftwareMatchRuleMutation {
-	m := &BronzeReferenceSoftwareMatchRuleMutation{
+// newBronzeReferenceThreatIntelIndicatorMutation creates new mutation for the BronzeReferenceThreatIntelIndicator entity.
+func newBronzeReferenceThreatIntelIndicatorMutation(c config, op Op, opts ...bronzereferencethreatintelindicatorOption) *BronzeReferenceThreatIntelIndicatorMutation {
+	m := &BronzeReferenceThreatIntelIndicatorMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeBronzeReferenceSoftwareMatchRule,
+		typ:           TypeBronzeReferenceThreatIntelIndicator,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4448,20 +9401,20 @@ func newBronzeReferenceSoftwareMatchRuleMutation(c config, op Op, opts ...bronze
 	return m
 }
 
-// withBronzeReferenceSoftwareMatchRuleID sets the ID field of the mutation.
-func withBronzeReferenceSoftwareMatchRuleID(id string) bronzereferencesoftwarematchruleOption {
-	return func(m *BronzeReferenceSoftwareMatchRuleMutation) {
+// withBronzeReferenceThreatIntelIndicatorID sets the ID field of the mutation.
+func withBronzeReferenceThreatIntelIndicatorID(id string) bronzereferencethreatintelindicatorOption {
+	return func(m *BronzeReferenceThreatIntelIndicatorMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *BronzeReferenceSoftwareMatchRule
+			value *BronzeReferenceThreatIntelIndicator
 		)
-		m.oldValue = func(ctx context.Context) (*BronzeReferenceSoftwareMatchRule, error) {
+		m.oldValue = func(ctx context.Context) (*BronzeReferenceThreatIntelIndicator, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().BronzeReferenceSoftwareMatchRule.Get(ctx, id)
+					value, err = m.Client().BronzeReferenceThreatIntelIndicator.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4470,10 +9423,10 @@ func withBronzeReferenceSoftwareMatchRuleID(id string) bronzereferencesoftwarema
 	}
 }
 
-// withBronzeReferenceSoftwareMatchRule sets the old BronzeReferenceSoftwareMatchRule of the mutation.
-func withBronzeReferenceSoftwareMatchRule(node *BronzeReferenceSoftwareMatchRule) bronzereferencesoftwarematchruleOption {
-	return func(m *BronzeReferenceSoftwareMatchRuleMutation) {
-		m.oldValue = func(context.Context) (*BronzeReferenceSoftwareMatchRule, error) {
+// withBronzeReferenceThreatIntelIndicator sets the old BronzeReferenceThreatIntelIndicator of the mutation.
+func withBronzeReferenceThreatIntelIndicator(node *BronzeReferenceThreatIntelIndicator) bronzereferencethreatintelindicatorOption {
+	return func(m *BronzeReferenceThreatIntelIndicatorMutation) {
+		m.oldValue = func(context.Context) (*BronzeReferenceThreatIntelIndicator, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4482,7 +9435,7 @@ func withBronzeReferenceSoftwareMatchRule(node *BronzeReferenceSoftwareMatchRule
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BronzeReferenceSoftwareMatchRuleMutation) Client() *Client {
+func (m BronzeReferenceThreatIntelIndicatorMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4490,7 +9443,7 @@ func (m BronzeReferenceSoftwareMatchRuleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m BronzeReferenceSoftwareMatchRuleMutation) Tx() (*Tx, error) {
+func (m BronzeReferenceThreatIntelIndicatorMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("reference: mutation is not running in a transaction")
 	}
@@ -4500,14 +9453,14 @@ func (m BronzeReferenceSoftwareMatchRuleMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of BronzeReferenceSoftwareMatchRule entities.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) SetID(id string) {
+// operation is only accepted on creation of BronzeReferenceThreatIntelIndicator entities.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) ID() (id string, exists bool) {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4518,7 +9471,7 @@ func (m *BronzeReferenceSoftwareMatchRuleMutation) ID() (id string, exists bool)
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4527,19 +9480,19 @@ func (m *BronzeReferenceSoftwareMatchRuleMutation) IDs(ctx context.Context) ([]s
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().BronzeReferenceSoftwareMatchRule.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BronzeReferenceThreatIntelIndicator.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCollectedAt sets the "collected_at" field.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) SetCollectedAt(t time.Time) {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) SetCollectedAt(t time.Time) {
 	m.collected_at = &t
 }
 
 // CollectedAt returns the value of the "collected_at" field in the mutation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) CollectedAt() (r time.Time, exists bool) {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) CollectedAt() (r time.Time, exists bool) {
 	v := m.collected_at
 	if v == nil {
 		return
@@ -4547,10 +9500,10 @@ func (m *BronzeReferenceSoftwareMatchRuleMutation) CollectedAt() (r time.Time, e
 	return *v, true
 }
 
-// OldCollectedAt returns the old "collected_at" field's value of the BronzeReferenceSoftwareMatchRule entity.
-// If the BronzeReferenceSoftwareMatchRule object wasn't provided to the builder, the object is fetched from the database.
+// OldCollectedAt returns the old "collected_at" field's value of the BronzeReferenceThreatIntelIndicator entity.
+// If the BronzeReferenceThreatIntelIndicator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
 	}
@@ -4565,17 +9518,17 @@ func (m *BronzeReferenceSoftwareMatchRuleMutation) OldCollectedAt(ctx context.Co
 }
 
 // ResetCollectedAt resets all changes to the "collected_at" field.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) ResetCollectedAt() {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) ResetCollectedAt() {
 	m.collected_at = nil
 }
 
 // SetFirstCollectedAt sets the "first_collected_at" field.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) SetFirstCollectedAt(t time.Time) {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) SetFirstCollectedAt(t time.Time) {
 	m.first_collected_at = &t
 }
 
 // FirstCollectedAt returns the value of the "first_collected_at" field in the mutation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) FirstCollectedAt() (r time.Time, exists bool) {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) FirstCollectedAt() (r time.Time, exists bool) {
 	v := m.first_collected_at
 	if v == nil {
 		return
@@ -4583,10 +9536,10 @@ func (m *BronzeReferenceSoftwareMatchRuleMutation) FirstCollectedAt() (r time.Ti
 	return *v, true
 }
 
-// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeReferenceSoftwareMatchRule entity.
-// If the BronzeReferenceSoftwareMatchRule object wasn't provided to the builder, the object is fetched from the database.
+// OldFirstCollectedAt returns the old "first_collected_at" field's value of the BronzeReferenceThreatIntelIndicator entity.
+// If the BronzeReferenceThreatIntelIndicator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) OldFirstCollectedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldFirstCollectedAt is only allowed on UpdateOne operations")
 	}
@@ -4601,225 +9554,284 @@ func (m *BronzeReferenceSoftwareMatchRuleMutation) OldFirstCollectedAt(ctx conte
 }
 
 // ResetFirstCollectedAt resets all changes to the "first_collected_at" field.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) ResetFirstCollectedAt() {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) ResetFirstCollectedAt() {
 	m.first_collected_at = nil
 }
 
-// SetProductSlug sets the "product_slug" field.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) SetProductSlug(s string) {
-	m.product_slug = &s
+// SetFeedName sets the "feed_name" field.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) SetFeedName(s string) {
+	m.feed_name = &s
 }
 
-// ProductSlug returns the value of the "product_slug" field in the mutation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) ProductSlug() (r string, exists bool) {
-	v := m.product_slug
+// FeedName returns the value of the "feed_name" field in the mutation.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) FeedName() (r string, exists bool) {
+	v := m.feed_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProductSlug returns the old "product_slug" field's value of the BronzeReferenceSoftwareMatchRule entity.
-// If the BronzeReferenceSoftwareMatchRule object wasn't provided to the builder, the object is fetched from the database.
+// OldFeedName returns the old "feed_name" field's value of the BronzeReferenceThreatIntelIndicator entity.
+// If the BronzeReferenceThreatIntelIndicator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) OldProductSlug(ctx context.Context) (v string, err error) {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) OldFeedName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProductSlug is only allowed on UpdateOne operations")
+		return v, errors.New("OldFeedName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProductSlug requires an ID field in the mutation")
+		return v, errors.New("OldFeedName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProductSlug: %w", err)
+		return v, fmt.Errorf("querying old value for OldFeedName: %w", err)
 	}
-	return oldValue.ProductSlug, nil
+	return oldValue.FeedName, nil
 }
 
-// ResetProductSlug resets all changes to the "product_slug" field.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) ResetProductSlug() {
-	m.product_slug = nil
+// ResetFeedName resets all changes to the "feed_name" field.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) ResetFeedName() {
+	m.feed_name = nil
 }
 
-// SetRuleType sets the "rule_type" field.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) SetRuleType(s string) {
-	m.rule_type = &s
+// SetFeedSource sets the "feed_source" field.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) SetFeedSource(s string) {
+	m.feed_source = &s
 }
 
-// RuleType returns the value of the "rule_type" field in the mutation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) RuleType() (r string, exists bool) {
-	v := m.rule_type
+// FeedSource returns the value of the "feed_source" field in the mutation.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) FeedSource() (r string, exists bool) {
+	v := m.feed_source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRuleType returns the old "rule_type" field's value of the BronzeReferenceSoftwareMatchRule entity.
-// If the BronzeReferenceSoftwareMatchRule object wasn't provided to the builder, the object is fetched from the database.
+// OldFeedSource returns the old "feed_source" field's value of the BronzeReferenceThreatIntelIndicator entity.
+// If the BronzeReferenceThreatIntelIndicator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) OldRuleType(ctx context.Context) (v string, err error) {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) OldFeedSource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRuleType is only allowed on UpdateOne operations")
+		return v, errors.New("OldFeedSource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRuleType requires an ID field in the mutation")
+		return v, errors.New("OldFeedSource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRuleType: %w", err)
+		return v, fmt.Errorf("querying old value for OldFeedSource: %w", err)
 	}
-	return oldValue.RuleType, nil
+	return oldValue.FeedSource, nil
 }
 
-// ResetRuleType resets all changes to the "rule_type" field.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) ResetRuleType() {
-	m.rule_type = nil
+// ResetFeedSource resets all changes to the "feed_source" field.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) ResetFeedSource() {
+	m.feed_source = nil
 }
 
-// SetOsType sets the "os_type" field.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) SetOsType(s string) {
-	m.os_type = &s
+// SetFeedFormat sets the "feed_format" field.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) SetFeedFormat(s string) {
+	m.feed_format = &s
 }
 
-// OsType returns the value of the "os_type" field in the mutation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) OsType() (r string, exists bool) {
-	v := m.os_type
+// FeedFormat returns the value of the "feed_format" field in the mutation.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) FeedFormat() (r string, exists bool) {
+	v := m.feed_format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeedFormat returns the old "feed_format" field's value of the BronzeReferenceThreatIntelIndicator entity.
+// If the BronzeReferenceThreatIntelIndicator object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) OldFeedFormat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeedFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeedFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeedFormat: %w", err)
+	}
+	return oldValue.FeedFormat, nil
+}
+
+// ResetFeedFormat resets all changes to the "feed_format" field.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) ResetFeedFormat() {
+	m.feed_format = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the BronzeReferenceThreatIntelIndicator entity.
+// If the BronzeReferenceThreatIntelIndicator object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetCidr sets the "cidr" field.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) SetCidr(s string) {
+	m.cidr = &s
+}
+
+// Cidr returns the value of the "cidr" field in the mutation.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) Cidr() (r string, exists bool) {
+	v := m.cidr
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOsType returns the old "os_type" field's value of the BronzeReferenceSoftwareMatchRule entity.
-// If the BronzeReferenceSoftwareMatchRule object wasn't provided to the builder, the object is fetched from the database.
+// OldCidr returns the old "cidr" field's value of the BronzeReferenceThreatIntelIndicator entity.
+// If the BronzeReferenceThreatIntelIndicator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) OldOsType(ctx context.Context) (v string, err error) {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) OldCidr(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOsType is only allowed on UpdateOne operations")
+		return v, errors.New("OldCidr is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOsType requires an ID field in the mutation")
+		return v, errors.New("OldCidr requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOsType: %w", err)
+		return v, fmt.Errorf("querying old value for OldCidr: %w", err)
 	}
-	return oldValue.OsType, nil
-}
-
-// ClearOsType clears the value of the "os_type" field.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) ClearOsType() {
-	m.os_type = nil
-	m.clearedFields[bronzereferencesoftwarematchrule.FieldOsType] = struct{}{}
-}
-
-// OsTypeCleared returns if the "os_type" field was cleared in this mutation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) OsTypeCleared() bool {
-	_, ok := m.clearedFields[bronzereferencesoftwarematchrule.FieldOsType]
-	return ok
+	return oldValue.Cidr, nil
 }
 
-// ResetOsType resets all changes to the "os_type" field.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) ResetOsType() {
-	m.os_type = nil
-	delete(m.clearedFields, bronzereferencesoftwarematchrule.FieldOsType)
+// ResetCidr resets all changes to the "cidr" field.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) ResetCidr() {
+	m.cidr = nil
 }
 
-// SetValue sets the "value" field.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) SetValue(s string) {
-	m.value = &s
+// SetDescription sets the "description" field.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) Value() (r string, exists bool) {
-	v := m.value
+// Description returns the value of the "description" field in the mutation.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the BronzeReferenceSoftwareMatchRule entity.
-// If the BronzeReferenceSoftwareMatchRule object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the BronzeReferenceThreatIntelIndicator entity.
+// If the BronzeReferenceThreatIntelIndicator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.Description, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) ResetValue() {
-	m.value = nil
+// ClearDescription clears the value of the "description" field.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[bronzereferencethreatintelindicator.FieldDescription] = struct{}{}
 }
 
-// SetExtraValue sets the "extra_value" field.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) SetExtraValue(s string) {
-	m.extra_value = &s
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[bronzereferencethreatintelindicator.FieldDescription]
+	return ok
 }
 
-// ExtraValue returns the value of the "extra_value" field in the mutation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) ExtraValue() (r string, exists bool) {
-	v := m.extra_value
+// ResetDescription resets all changes to the "description" field.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, bronzereferencethreatintelindicator.FieldDescription)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExtraValue returns the old "extra_value" field's value of the BronzeReferenceSoftwareMatchRule entity.
-// If the BronzeReferenceSoftwareMatchRule object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the BronzeReferenceThreatIntelIndicator entity.
+// If the BronzeReferenceThreatIntelIndicator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) OldExtraValue(ctx context.Context) (v string, err error) {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExtraValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExtraValue requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExtraValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.ExtraValue, nil
-}
-
-// ClearExtraValue clears the value of the "extra_value" field.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) ClearExtraValue() {
-	m.extra_value = nil
-	m.clearedFields[bronzereferencesoftwarematchrule.FieldExtraValue] = struct{}{}
-}
-
-// ExtraValueCleared returns if the "extra_value" field was cleared in this mutation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) ExtraValueCleared() bool {
-	_, ok := m.clearedFields[bronzereferencesoftwarematchrule.FieldExtraValue]
-	return ok
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetExtraValue resets all changes to the "extra_value" field.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) ResetExtraValue() {
-	m.extra_value = nil
-	delete(m.clearedFields, bronzereferencesoftwarematchrule.FieldExtraValue)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// Where appends a list predicates to the BronzeReferenceSoftwareMatchRuleMutation builder.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) Where(ps ...predicate.BronzeReferenceSoftwareMatchRule) {
+// Where appends a list predicates to the BronzeReferenceThreatIntelIndicatorMutation builder.
+func (m *BronzeReferenceThreatIntelIndicatorMutation) Where(ps ...predicate.BronzeReferenceThreatIntelIndicator) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the BronzeReferenceSoftwareMatchRuleMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BronzeReferenceThreatIntelIndicatorMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.BronzeReferenceSoftwareMatchRule, len(ps))
+func (m *BronzeReferenceThreatIntelIndicatorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BronzeReferenceThreatIntelIndicator, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4827,45 +9839,51 @@ func (m *BronzeReferenceSoftwareMatchRuleMutation) WhereP(ps ...func(*sql.Select
 }
 
 // Op returns the operation name.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) Op() Op {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) SetOp(op Op) {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (BronzeReferenceSoftwareMatchRule).
-func (m *BronzeReferenceSoftwareMatchRuleMutation) Type() string {
+// Type returns the node type of this mutation (BronzeReferenceThreatIntelIndicator).
+func (m *BronzeReferenceThreatIntelIndicatorMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *BronzeReferenceSoftwareMatchRuleMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *BronzeReferenceThreatIntelIndicatorMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.collected_at != nil {
-		fields = append(fields, bronzereferencesoftwarematchrule.FieldCollectedAt)
+		fields = append(fields, bronzereferencethreatintelindicator.FieldCollectedAt)
 	}
 	if m.first_collected_at != nil {
-		fields = append(fields, bronzereferencesoftwarematchrule.FieldFirstCollectedAt)
+		fields = append(fields, bronzereferencethreatintelindicator.FieldFirstCollectedAt)
 	}
-	if m.product_slug != nil {
-		fields = append(fields, bronzereferencesoftwarematchrule.FieldProductSlug)
+	if m.feed_name != nil {
+		fields = append(fields, bronzereferencethreatintelindicator.FieldFeedName)
 	}
-	if m.rule_type != nil {
-		fields = append(fields, bronzereferencesoftwarematchrule.FieldRuleType)
+	if m.feed_source != nil {
+		fields = append(fields, bronzereferencethreatintelindicator.FieldFeedSource)
 	}
-	if m.os_type != nil {
-		fields = append(fields, bronzereferencesoftwarematchrule.FieldOsType)
+	if m.feed_format != nil {
+		fields = append(fields, bronzereferencethreatintelindicator.FieldFeedFormat)
 	}
-	if m.value != nil {
-		fields = append(fields, bronzereferencesoftwarematchrule.FieldValue)
+	if m.category != nil {
+		fields = append(fields, bronzereferencethreatintelindicator.FieldCategory)
 	}
-	if m.extra_value != nil {
-		fields = append(fields, bronzereferencesoftwarematchrule.FieldExtraValue)
+	if m.cidr != nil {
+		fields = append(fields, bronzereferencethreatintelindicator.FieldCidr)
+	}
+	if m.description != nil {
+		fields = append(fields, bronzereferencethreatintelindicator.FieldDescription)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, bronzereferencethreatintelindicator.FieldExpiresAt)
 	}
 	return fields
 }
@@ -4873,22 +9891,26 @@ func (m *BronzeReferenceSoftwareMatchRuleMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) Field(name string) (ent.Value, bool) {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case bronzereferencesoftwarematchrule.FieldCollectedAt:
+	case bronzereferencethreatintelindicator.FieldCollectedAt:
 		return m.CollectedAt()
-	case bronzereferencesoftwarematchrule.FieldFirstCollectedAt:
+	case bronzereferencethreatintelindicator.FieldFirstCollectedAt:
 		return m.FirstCollectedAt()
-	case bronzereferencesoftwarematchrule.FieldProductSlug:
-		return m.ProductSlug()
-	case bronzereferencesoftwarematchrule.FieldRuleType:
-		return m.RuleType()
-	case bronzereferencesoftwarematchrule.FieldOsType:
-		return m.OsType()
-	case bronzereferencesoftwarematchrule.FieldValue:
-		return m.Value()
-	case bronzereferencesoftwarematchrule.FieldExtraValue:
-		return m.ExtraValue()
+	case bronzereferencethreatintelindicator.FieldFeedName:
+		return m.FeedName()
+	case bronzereferencethreatintelindicator.FieldFeedSource:
+		return m.FeedSource()
+	case bronzereferencethreatintelindicator.FieldFeedFormat:
+		return m.FeedFormat()
+	case bronzereferencethreatintelindicator.FieldCategory:
+		return m.Category()
+	case bronzereferencethreatintelindicator.FieldCidr:
+		return m.Cidr()
+	case bronzereferencethreatintelindicator.FieldDescription:
+		return m.Description()
+	case bronzereferencethreatintelindicator.FieldExpiresAt:
+		return m.ExpiresAt()
 	}
 	return nil, false
 }
@@ -4896,215 +9918,233 @@ func (m *BronzeReferenceSoftwareMatchRuleMutation) Field(name string) (ent.Value
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case bronzereferencesoftwarematchrule.FieldCollectedAt:
+	case bronzereferencethreatintelindicator.FieldCollectedAt:
 		return m.OldCollectedAt(ctx)
-	case bronzereferencesoftwarematchrule.FieldFirstCollectedAt:
+	case bronzereferencethreatintelindicator.FieldFirstCollectedAt:
 		return m.OldFirstCollectedAt(ctx)
-	case bronzereferencesoftwarematchrule.FieldProductSlug:
-		return m.OldProductSlug(ctx)
-	case bronzereferencesoftwarematchrule.FieldRuleType:
-		return m.OldRuleType(ctx)
-	case bronzereferencesoftwarematchrule.FieldOsType:
-		return m.OldOsType(ctx)
-	case bronzereferencesoftwarematchrule.FieldValue:
-		return m.OldValue(ctx)
-	case bronzereferencesoftwarematchrule.FieldExtraValue:
-		return m.OldExtraValue(ctx)
+	case bronzereferencethreatintelindicator.FieldFeedName:
+		return m.OldFeedName(ctx)
+	case bronzereferencethreatintelindicator.FieldFeedSource:
+		return m.OldFeedSource(ctx)
+	case bronzereferencethreatintelindicator.FieldFeedFormat:
+		return m.OldFeedFormat(ctx)
+	case bronzereferencethreatintelindicator.FieldCategory:
+		return m.OldCategory(ctx)
+	case bronzereferencethreatintelindicator.FieldCidr:
+		return m.OldCidr(ctx)
+	case bronzereferencethreatintelindicator.FieldDescription:
+		return m.OldDescription(ctx)
+	case bronzereferencethreatintelindicator.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown BronzeReferenceSoftwareMatchRule field %s", name)
+	return nil, fmt.Errorf("unknown BronzeReferenceThreatIntelIndicator field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) SetField(name string, value ent.Value) error {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case bronzereferencesoftwarematchrule.FieldCollectedAt:
+	case bronzereferencethreatintelindicator.FieldCollectedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCollectedAt(v)
 		return nil
-	case bronzereferencesoftwarematchrule.FieldFirstCollectedAt:
+	case bronzereferencethreatintelindicator.FieldFirstCollectedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetFirstCollectedAt(v)
 		return nil
-	case bronzereferencesoftwarematchrule.FieldProductSlug:
+	case bronzereferencethreatintelindicator.FieldFeedName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProductSlug(v)
+		m.SetFeedName(v)
 		return nil
-	case bronzereferencesoftwarematchrule.FieldRuleType:
+	case bronzereferencethreatintelindicator.FieldFeedSource:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRuleType(v)
+		m.SetFeedSource(v)
 		return nil
-	case bronzereferencesoftwarematchrule.FieldOsType:
+	case bronzereferencethreatintelindicator.FieldFeedFormat:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOsType(v)
+		m.SetFeedFormat(v)
 		return nil
-	case bronzereferencesoftwarematchrule.FieldValue:
+	case bronzereferencethreatintelindicator.FieldCategory:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetCategory(v)
 		return nil
-	case bronzereferencesoftwarematchrule.FieldExtraValue:
+	case bronzereferencethreatintelindicator.FieldCidr:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExtraValue(v)
+		m.SetCidr(v)
+		return nil
+	case bronzereferencethreatintelindicator.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case bronzereferencethreatintelindicator.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown BronzeReferenceSoftwareMatchRule field %s", name)
+	return fmt.Errorf("unknown BronzeReferenceThreatIntelIndicator field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) AddedFields() []string {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) AddField(name string, value ent.Value) error {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown BronzeReferenceSoftwareMatchRule numeric field %s", name)
+	return fmt.Errorf("unknown BronzeReferenceThreatIntelIndicator numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) ClearedFields() []string {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(bronzereferencesoftwarematchrule.FieldOsType) {
-		fields = append(fields, bronzereferencesoftwarematchrule.FieldOsType)
-	}
-	if m.FieldCleared(bronzereferencesoftwarematchrule.FieldExtraValue) {
-		fields = append(fields, bronzereferencesoftwarematchrule.FieldExtraValue)
+	if m.FieldCleared(bronzereferencethreatintelindicator.FieldDescription) {
+		fields = append(fields, bronzereferencethreatintelindicator.FieldDescription)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) FieldCleared(name string) bool {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) ClearField(name string) error {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) ClearField(name string) error {
 	switch name {
-	case bronzereferencesoftwarematchrule.FieldOsType:
-		m.ClearOsType()
-		return nil
-	case bronzereferencesoftwarematchrule.FieldExtraValue:
-		m.ClearExtraValue()
+	case bronzereferencethreatintelindicator.FieldDescription:
+		m.ClearDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown BronzeReferenceSoftwareMatchRule nullable field %s", name)
+	return fmt.Errorf("unknown BronzeReferenceThreatIntelIndicator nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) ResetField(name string) error {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) ResetField(name string) error {
 	switch name {
-	case bronzereferencesoftwarematchrule.FieldCollectedAt:
+	case bronzereferencethreatintelindicator.FieldCollectedAt:
 		m.ResetCollectedAt()
 		return nil
-	case bronzereferencesoftwarematchrule.FieldFirstCollectedAt:
+	case bronzereferencethreatintelindicator.FieldFirstCollectedAt:
 		m.ResetFirstCollectedAt()
 		return nil
-	case bronzereferencesoftwarematchrule.FieldProductSlug:
-		m.ResetProductSlug()
+	case bronzereferencethreatintelindicator.FieldFeedName:
+		m.ResetFeedName()
 		return nil
-	case bronzereferencesoftwarematchrule.FieldRuleType:
-		m.ResetRuleType()
+	case bronzereferencethreatintelindicator.FieldFeedSource:
+		m.ResetFeedSource()
 		return nil
-	case bronzereferencesoftwarematchrule.FieldOsType:
-		m.ResetOsType()
+	case bronzereferencethreatintelindicator.FieldFeedFormat:
+		m.ResetFeedFormat()
 		return nil
-	case bronzereferencesoftwarematchrule.FieldValue:
-		m.ResetValue()
+	case bronzereferencethreatintelindicator.FieldCategory:
+		m.ResetCategory()
 		return nil
-	case bronzereferencesoftwarematchrule.FieldExtraValue:
-		m.ResetExtraValue()
+	case bronzereferencethreatintelindicator.FieldCidr:
+		m.ResetCidr()
+		return nil
+	case bronzereferencethreatintelindicator.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case bronzereferencethreatintelindicator.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown BronzeReferenceSoftwareMatchRule field %s", name)
+	return fmt.Errorf("unknown BronzeReferenceThreatIntelIndicator field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) AddedEdges() []string {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) AddedIDs(name string) []ent.Value {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) RemovedEdges() []string {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) RemovedIDs(name string) []ent.Value {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) ClearedEdges() []string {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) EdgeCleared(name string) bool {
+func (m *BronzeReferenceThreatIntelIndicatorMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown BronzeReferenceSoftwareMatchRule unique edge %s", name)
+func (m *BronzeReferenceThreatIntelIndicatorMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BronzeReferenceThreatIntelIndicator unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *BronzeReferenceSoftwareMatchRuleMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown BronzeReferenceSoftwareMatchRule edge %s", name)
+func (m *BronzeReferenceThreatIntelIndicatorMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BronzeReferenceThreatIntelIndicator edge %s", name)
 }
 
 // BronzeReferenceUbuntuPackageMutation represents an operation that mutates the BronzeReferenceUbuntuPackage nodes in the graph.
@@ -5116,6 +10156,7 @@ type BronzeReferenceUbuntuPackageMutation struct {
 	collected_at       *time.Time
 	first_collected_at *time.Time
 	package_name       *string
+	source_package     *string
 	release            *string
 	component          *string
 	section            *string
@@ -5338,6 +10379,55 @@ func (m *BronzeReferenceUbuntuPackageMutation) ResetPackageName() {
 	m.package_name = nil
 }
 
+// SetSourcePackage sets the "source_package" field.
+func (m *BronzeReferenceUbuntuPackageMutation) SetSourcePackage(s string) {
+	m.source_package = &s
+}
+
+// SourcePackage returns the value of the "source_package" field in the mutation.
+func (m *BronzeReferenceUbuntuPackageMutation) SourcePackage() (r string, exists bool) {
+	v := m.source_package
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourcePackage returns the old "source_package" field's value of the BronzeReferenceUbuntuPackage entity.
+// If the BronzeReferenceUbuntuPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BronzeReferenceUbuntuPackageMutation) OldSourcePackage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourcePackage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourcePackage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourcePackage: %w", err)
+	}
+	return oldValue.SourcePackage, nil
+}
+
+// ClearSourcePackage clears the value of the "source_package" field.
+func (m *BronzeReferenceUbuntuPackageMutation) ClearSourcePackage() {
+	m.source_package = nil
+	m.clearedFields[bronzereferenceubuntupackage.FieldSourcePackage] = struct{}{}
+}
+
+// SourcePackageCleared returns if the "source_package" field was cleared in this mutation.
+func (m *BronzeReferenceUbuntuPackageMutation) SourcePackageCleared() bool {
+	_, ok := m.clearedFields[bronzereferenceubuntupackage.FieldSourcePackage]
+	return ok
+}
+
+// ResetSourcePackage resets all changes to the "source_package" field.
+func (m *BronzeReferenceUbuntuPackageMutation) ResetSourcePackage() {
+	m.source_package = nil
+	delete(m.clearedFields, bronzereferenceubuntupackage.FieldSourcePackage)
+}
+
 // SetRelease sets the "release" field.
 func (m *BronzeReferenceUbuntuPackageMutation) SetRelease(s string) {
 	m.release = &s
@@ -5529,7 +10619,7 @@ func (m *BronzeReferenceUbuntuPackageMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *BronzeReferenceUbuntuPackageMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 8)
 	if m.collected_at != nil {
 		fields = append(fields, bronzereferenceubuntupackage.FieldCollectedAt)
 	}
@@ -5539,6 +10629,9 @@ func (m *BronzeReferenceUbuntuPackageMutation) Fields() []string {
 	if m.package_name != nil {
 		fields = append(fields, bronzereferenceubuntupackage.FieldPackageName)
 	}
+	if m.source_package != nil {
+		fields = append(fields, bronzereferenceubuntupackage.FieldSourcePackage)
+	}
 	if m.release != nil {
 		fields = append(fields, bronzereferenceubuntupackage.FieldRelease)
 	}
@@ -5565,6 +10658,8 @@ func (m *BronzeReferenceUbuntuPackageMutation) Field(name string) (ent.Value, bo
 		return m.FirstCollectedAt()
 	case bronzereferenceubuntupackage.FieldPackageName:
 		return m.PackageName()
+	case bronzereferenceubuntupackage.FieldSourcePackage:
+		return m.SourcePackage()
 	case bronzereferenceubuntupackage.FieldRelease:
 		return m.Release()
 	case bronzereferenceubuntupackage.FieldComponent:
@@ -5588,6 +10683,8 @@ func (m *BronzeReferenceUbuntuPackageMutation) OldField(ctx context.Context, nam
 		return m.OldFirstCollectedAt(ctx)
 	case bronzereferenceubuntupackage.FieldPackageName:
 		return m.OldPackageName(ctx)
+	case bronzereferenceubuntupackage.FieldSourcePackage:
+		return m.OldSourcePackage(ctx)
 	case bronzereferenceubuntupackage.FieldRelease:
 		return m.OldRelease(ctx)
 	case bronzereferenceubuntupackage.FieldComponent:
@@ -5626,6 +10723,13 @@ func (m *BronzeReferenceUbuntuPackageMutation) SetField(name string, value ent.V
 		}
 		m.SetPackageName(v)
 		return nil
+	case bronzereferenceubuntupackage.FieldSourcePackage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourcePackage(v)
+		return nil
 	case bronzereferenceubuntupackage.FieldRelease:
 		v, ok := value.(string)
 		if !ok {
@@ -5684,6 +10788,9 @@ func (m *BronzeReferenceUbuntuPackageMutation) AddField(name string, value ent.V
 // mutation.
 func (m *BronzeReferenceUbuntuPackageMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(bronzereferenceubuntupackage.FieldSourcePackage) {
+		fields = append(fields, bronzereferenceubuntupackage.FieldSourcePackage)
+	}
 	if m.FieldCleared(bronzereferenceubuntupackage.FieldDescription) {
 		fields = append(fields, bronzereferenceubuntupackage.FieldDescription)
 	}
@@ -5701,6 +10808,9 @@ func (m *BronzeReferenceUbuntuPackageMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *BronzeReferenceUbuntuPackageMutation) ClearField(name string) error {
 	switch name {
+	case bronzereferenceubuntupackage.FieldSourcePackage:
+		m.ClearSourcePackage()
+		return nil
 	case bronzereferenceubuntupackage.FieldDescription:
 		m.ClearDescription()
 		return nil
@@ -5721,6 +10831,9 @@ func (m *BronzeReferenceUbuntuPackageMutation) ResetField(name string) error {
 	case bronzereferenceubuntupackage.FieldPackageName:
 		m.ResetPackageName()
 		return nil
+	case bronzereferenceubuntupackage.FieldSourcePackage:
+		m.ResetSourcePackage()
+		return nil
 	case bronzereferenceubuntupackage.FieldRelease:
 		m.ResetRelease()
 		return nil
@@ -18,15 +18,30 @@ type BronzeReferenceEOLIdentifier func(*sql.Selector)
 // BronzeReferenceEOLProduct is the predicate function for bronzereferenceeolproduct builders.
 type BronzeReferenceEOLProduct func(*sql.Selector)
 
+// BronzeReferenceNVDCPEMatch is the predicate function for bronzereferencenvdcpematch builders.
+type BronzeReferenceNVDCPEMatch func(*sql.Selector)
+
+// BronzeReferenceNVDCVE is the predicate function for bronzereferencenvdcve builders.
+type BronzeReferenceNVDCVE func(*sql.Selector)
+
 // BronzeReferenceOSCoreRule is the predicate function for bronzereferenceoscorerule builders.
 type BronzeReferenceOSCoreRule func(*sql.Selector)
 
+// BronzeReferenceOSVAffected is the predicate function for bronzereferenceosvaffected builders.
+type BronzeReferenceOSVAffected func(*sql.Selector)
+
+// BronzeReferenceOSVVuln is the predicate function for bronzereferenceosvvuln builders.
+type BronzeReferenceOSVVuln func(*sql.Selector)
+
 // BronzeReferenceRPMPackage is the predicate function for bronzereferencerpmpackage builders.
 type BronzeReferenceRPMPackage func(*sql.Selector)
 
 // BronzeReferenceSoftwareMatchRule is the predicate function for bronzereferencesoftwarematchrule builders.
 type BronzeReferenceSoftwareMatchRule func(*sql.Selector)
 
+// BronzeReferenceThreatIntelIndicator is the predicate function for bronzereferencethreatintelindicator builders.
+type BronzeReferenceThreatIntelIndicator func(*sql.Selector)
+
 // BronzeReferenceUbuntuPackage is the predicate function for bronzereferenceubuntupackage builders.
 type BronzeReferenceUbuntuPackage func(*sql.Selector)
 
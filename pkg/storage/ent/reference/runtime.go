@@ -4,8 +4,10 @@ package reference
 
 import (
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencecpe"
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencenvdcpematch"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferenceoscorerule"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencesoftwarematchrule"
+	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencethreatintelindicator"
 	"danny.vn/hotpot/pkg/storage/ent/reference/bronzereferencexeolcycle"
 	"danny.vn/hotpot/pkg/storage/ent/reference/schema"
 )
@@ -20,6 +22,12 @@ func init() {
 	bronzereferencecpeDescDeprecated := bronzereferencecpeFields[6].Descriptor()
 	// bronzereferencecpe.DefaultDeprecated holds the default value on creation for the deprecated field.
 	bronzereferencecpe.DefaultDeprecated = bronzereferencecpeDescDeprecated.Default.(bool)
+	bronzereferencenvdcpematchFields := schema.BronzeReferenceNVDCPEMatch{}.Fields()
+	_ = bronzereferencenvdcpematchFields
+	// bronzereferencenvdcpematchDescVulnerable is the schema descriptor for vulnerable field.
+	bronzereferencenvdcpematchDescVulnerable := bronzereferencenvdcpematchFields[7].Descriptor()
+	// bronzereferencenvdcpematch.DefaultVulnerable holds the default value on creation for the vulnerable field.
+	bronzereferencenvdcpematch.DefaultVulnerable = bronzereferencenvdcpematchDescVulnerable.Default.(bool)
 	bronzereferenceoscoreruleFields := schema.BronzeReferenceOSCoreRule{}.Fields()
 	_ = bronzereferenceoscoreruleFields
 	// bronzereferenceoscoreruleDescRuleType is the schema descriptor for rule_type field.
@@ -44,6 +52,28 @@ func init() {
 	bronzereferencesoftwarematchruleDescValue := bronzereferencesoftwarematchruleFields[4].Descriptor()
 	// bronzereferencesoftwarematchrule.ValueValidator is a validator for the "value" field. It is called by the builders before save.
 	bronzereferencesoftwarematchrule.ValueValidator = bronzereferencesoftwarematchruleDescValue.Validators[0].(func(string) error)
+	bronzereferencethreatintelindicatorFields := schema.BronzeReferenceThreatIntelIndicator{}.Fields()
+	_ = bronzereferencethreatintelindicatorFields
+	// bronzereferencethreatintelindicatorDescFeedName is the schema descriptor for feed_name field.
+	bronzereferencethreatintelindicatorDescFeedName := bronzereferencethreatintelindicatorFields[1].Descriptor()
+	// bronzereferencethreatintelindicator.FeedNameValidator is a validator for the "feed_name" field. It is called by the builders before save.
+	bronzereferencethreatintelindicator.FeedNameValidator = bronzereferencethreatintelindicatorDescFeedName.Validators[0].(func(string) error)
+	// bronzereferencethreatintelindicatorDescFeedSource is the schema descriptor for feed_source field.
+	bronzereferencethreatintelindicatorDescFeedSource := bronzereferencethreatintelindicatorFields[2].Descriptor()
+	// bronzereferencethreatintelindicator.FeedSourceValidator is a validator for the "feed_source" field. It is called by the builders before save.
+	bronzereferencethreatintelindicator.FeedSourceValidator = bronzereferencethreatintelindicatorDescFeedSource.Validators[0].(func(string) error)
+	// bronzereferencethreatintelindicatorDescFeedFormat is the schema descriptor for feed_format field.
+	bronzereferencethreatintelindicatorDescFeedFormat := bronzereferencethreatintelindicatorFields[3].Descriptor()
+	// bronzereferencethreatintelindicator.FeedFormatValidator is a validator for the "feed_format" field. It is called by the builders before save.
+	bronzereferencethreatintelindicator.FeedFormatValidator = bronzereferencethreatintelindicatorDescFeedFormat.Validators[0].(func(string) error)
+	// bronzereferencethreatintelindicatorDescCategory is the schema descriptor for category field.
+	bronzereferencethreatintelindicatorDescCategory := bronzereferencethreatintelindicatorFields[4].Descriptor()
+	// bronzereferencethreatintelindicator.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	bronzereferencethreatintelindicator.CategoryValidator = bronzereferencethreatintelindicatorDescCategory.Validators[0].(func(string) error)
+	// bronzereferencethreatintelindicatorDescCidr is the schema descriptor for cidr field.
+	bronzereferencethreatintelindicatorDescCidr := bronzereferencethreatintelindicatorFields[5].Descriptor()
+	// bronzereferencethreatintelindicator.CidrValidator is a validator for the "cidr" field. It is called by the builders before save.
+	bronzereferencethreatintelindicator.CidrValidator = bronzereferencethreatintelindicatorDescCidr.Validators[0].(func(string) error)
 	bronzereferencexeolcycleFields := schema.BronzeReferenceXeolCycle{}.Fields()
 	_ = bronzereferencexeolcycleFields
 	// bronzereferencexeolcycleDescEolBool is the schema descriptor for eol_bool field.
@@ -21,10 +21,26 @@ type BronzeReferenceEOLProduct struct {
 	bronze_reference.BronzeReferenceEOLProduct
 }
 
+type BronzeReferenceNVDCPEMatch struct {
+	bronze_reference.BronzeReferenceNVDCPEMatch
+}
+
+type BronzeReferenceNVDCVE struct {
+	bronze_reference.BronzeReferenceNVDCVE
+}
+
 type BronzeReferenceOSCoreRule struct {
 	bronze_reference.BronzeReferenceOSCoreRule
 }
 
+type BronzeReferenceOSVAffected struct {
+	bronze_reference.BronzeReferenceOSVAffected
+}
+
+type BronzeReferenceOSVVuln struct {
+	bronze_reference.BronzeReferenceOSVVuln
+}
+
 type BronzeReferenceRPMPackage struct {
 	bronze_reference.BronzeReferenceRPMPackage
 }
@@ -33,6 +49,10 @@ type BronzeReferenceSoftwareMatchRule struct {
 	bronze_reference.BronzeReferenceSoftwareMatchRule
 }
 
+type BronzeReferenceThreatIntelIndicator struct {
+	bronze_reference.BronzeReferenceThreatIntelIndicator
+}
+
 type BronzeReferenceUbuntuPackage struct {
 	bronze_reference.BronzeReferenceUbuntuPackage
 }
@@ -4,17 +4,22 @@ package reference
 // DefaultSchemaConfig returns the schema config mapping each type to its PG schema.
 func DefaultSchemaConfig() SchemaConfig {
 	return SchemaConfig{
-		BronzeReferenceCPE:               "bronze",
-		BronzeReferenceEOLCycle:          "bronze",
-		BronzeReferenceEOLIdentifier:     "bronze",
-		BronzeReferenceEOLProduct:        "bronze",
-		BronzeReferenceOSCoreRule:        "bronze",
-		BronzeReferenceRPMPackage:        "bronze",
-		BronzeReferenceSoftwareMatchRule: "bronze",
-		BronzeReferenceUbuntuPackage:     "bronze",
-		BronzeReferenceXeolCycle:         "bronze",
-		BronzeReferenceXeolProduct:       "bronze",
-		BronzeReferenceXeolPurl:          "bronze",
-		BronzeReferenceXeolVuln:          "bronze",
+		BronzeReferenceCPE:                  "bronze",
+		BronzeReferenceEOLCycle:             "bronze",
+		BronzeReferenceEOLIdentifier:        "bronze",
+		BronzeReferenceEOLProduct:           "bronze",
+		BronzeReferenceNVDCPEMatch:          "bronze",
+		BronzeReferenceNVDCVE:               "bronze",
+		BronzeReferenceOSCoreRule:           "bronze",
+		BronzeReferenceOSVAffected:          "bronze",
+		BronzeReferenceOSVVuln:              "bronze",
+		BronzeReferenceRPMPackage:           "bronze",
+		BronzeReferenceSoftwareMatchRule:    "bronze",
+		BronzeReferenceThreatIntelIndicator: "bronze",
+		BronzeReferenceUbuntuPackage:        "bronze",
+		BronzeReferenceXeolCycle:            "bronze",
+		BronzeReferenceXeolProduct:          "bronze",
+		BronzeReferenceXeolPurl:             "bronze",
+		BronzeReferenceXeolVuln:             "bronze",
 	}
 }
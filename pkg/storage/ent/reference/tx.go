@@ -20,12 +20,22 @@ type Tx struct {
 	BronzeReferenceEOLIdentifier *BronzeReferenceEOLIdentifierClient
 	// BronzeReferenceEOLProduct is the client for interacting with the BronzeReferenceEOLProduct builders.
 	BronzeReferenceEOLProduct *BronzeReferenceEOLProductClient
+	// BronzeReferenceNVDCPEMatch is the client for interacting with the BronzeReferenceNVDCPEMatch builders.
+	BronzeReferenceNVDCPEMatch *BronzeReferenceNVDCPEMatchClient
+	// BronzeReferenceNVDCVE is the client for interacting with the BronzeReferenceNVDCVE builders.
+	BronzeReferenceNVDCVE *BronzeReferenceNVDCVEClient
 	// BronzeReferenceOSCoreRule is the client for interacting with the BronzeReferenceOSCoreRule builders.
 	BronzeReferenceOSCoreRule *BronzeReferenceOSCoreRuleClient
+	// BronzeReferenceOSVAffected is the client for interacting with the BronzeReferenceOSVAffected builders.
+	BronzeReferenceOSVAffected *BronzeReferenceOSVAffectedClient
+	// BronzeReferenceOSVVuln is the client for interacting with the BronzeReferenceOSVVuln builders.
+	BronzeReferenceOSVVuln *BronzeReferenceOSVVulnClient
 	// BronzeReferenceRPMPackage is the client for interacting with the BronzeReferenceRPMPackage builders.
 	BronzeReferenceRPMPackage *BronzeReferenceRPMPackageClient
 	// BronzeReferenceSoftwareMatchRule is the client for interacting with the BronzeReferenceSoftwareMatchRule builders.
 	BronzeReferenceSoftwareMatchRule *BronzeReferenceSoftwareMatchRuleClient
+	// BronzeReferenceThreatIntelIndicator is the client for interacting with the BronzeReferenceThreatIntelIndicator builders.
+	BronzeReferenceThreatIntelIndicator *BronzeReferenceThreatIntelIndicatorClient
 	// BronzeReferenceUbuntuPackage is the client for interacting with the BronzeReferenceUbuntuPackage builders.
 	BronzeReferenceUbuntuPackage *BronzeReferenceUbuntuPackageClient
 	// BronzeReferenceXeolCycle is the client for interacting with the BronzeReferenceXeolCycle builders.
@@ -171,9 +181,14 @@ func (tx *Tx) init() {
 	tx.BronzeReferenceEOLCycle = NewBronzeReferenceEOLCycleClient(tx.config)
 	tx.BronzeReferenceEOLIdentifier = NewBronzeReferenceEOLIdentifierClient(tx.config)
 	tx.BronzeReferenceEOLProduct = NewBronzeReferenceEOLProductClient(tx.config)
+	tx.BronzeReferenceNVDCPEMatch = NewBronzeReferenceNVDCPEMatchClient(tx.config)
+	tx.BronzeReferenceNVDCVE = NewBronzeReferenceNVDCVEClient(tx.config)
 	tx.BronzeReferenceOSCoreRule = NewBronzeReferenceOSCoreRuleClient(tx.config)
+	tx.BronzeReferenceOSVAffected = NewBronzeReferenceOSVAffectedClient(tx.config)
+	tx.BronzeReferenceOSVVuln = NewBronzeReferenceOSVVulnClient(tx.config)
 	tx.BronzeReferenceRPMPackage = NewBronzeReferenceRPMPackageClient(tx.config)
 	tx.BronzeReferenceSoftwareMatchRule = NewBronzeReferenceSoftwareMatchRuleClient(tx.config)
+	tx.BronzeReferenceThreatIntelIndicator = NewBronzeReferenceThreatIntelIndicatorClient(tx.config)
 	tx.BronzeReferenceUbuntuPackage = NewBronzeReferenceUbuntuPackageClient(tx.config)
 	tx.BronzeReferenceXeolCycle = NewBronzeReferenceXeolCycleClient(tx.config)
 	tx.BronzeReferenceXeolProduct = NewBronzeReferenceXeolProductClient(tx.config)
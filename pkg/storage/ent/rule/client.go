@@ -12,9 +12,11 @@ import (
 	"danny.vn/hotpot/pkg/storage/ent/rule/migrate"
 
 	"danny.vn/hotpot/pkg/storage/ent/rule/configauthendpointpattern"
+	"danny.vn/hotpot/pkg/storage/ent/rule/configdetectionrule"
 	"danny.vn/hotpot/pkg/storage/ent/rule/confighostingindicator"
 	"danny.vn/hotpot/pkg/storage/ent/rule/confighttpmonitorrule"
 	"danny.vn/hotpot/pkg/storage/ent/rule/configlibraryua"
+	"danny.vn/hotpot/pkg/storage/ent/rule/confignotificationrule"
 	"danny.vn/hotpot/pkg/storage/ent/rule/configoscorerule"
 	"danny.vn/hotpot/pkg/storage/ent/rule/configrpmcorerepo"
 	"danny.vn/hotpot/pkg/storage/ent/rule/configsanctionedcountry"
@@ -35,12 +37,16 @@ type Client struct {
 	Schema *migrate.Schema
 	// ConfigAuthEndpointPattern is the client for interacting with the ConfigAuthEndpointPattern builders.
 	ConfigAuthEndpointPattern *ConfigAuthEndpointPatternClient
+	// ConfigDetectionRule is the client for interacting with the ConfigDetectionRule builders.
+	ConfigDetectionRule *ConfigDetectionRuleClient
 	// ConfigHostingIndicator is the client for interacting with the ConfigHostingIndicator builders.
 	ConfigHostingIndicator *ConfigHostingIndicatorClient
 	// ConfigHttpmonitorRule is the client for interacting with the ConfigHttpmonitorRule builders.
 	ConfigHttpmonitorRule *ConfigHttpmonitorRuleClient
 	// ConfigLibraryUa is the client for interacting with the ConfigLibraryUa builders.
 	ConfigLibraryUa *ConfigLibraryUaClient
+	// ConfigNotificationRule is the client for interacting with the ConfigNotificationRule builders.
+	ConfigNotificationRule *ConfigNotificationRuleClient
 	// ConfigOsCoreRule is the client for interacting with the ConfigOsCoreRule builders.
 	ConfigOsCoreRule *ConfigOsCoreRuleClient
 	// ConfigRpmCoreRepo is the client for interacting with the ConfigRpmCoreRepo builders.
@@ -65,9 +71,11 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.ConfigAuthEndpointPattern = NewConfigAuthEndpointPatternClient(c.config)
+	c.ConfigDetectionRule = NewConfigDetectionRuleClient(c.config)
 	c.ConfigHostingIndicator = NewConfigHostingIndicatorClient(c.config)
 	c.ConfigHttpmonitorRule = NewConfigHttpmonitorRuleClient(c.config)
 	c.ConfigLibraryUa = NewConfigLibraryUaClient(c.config)
+	c.ConfigNotificationRule = NewConfigNotificationRuleClient(c.config)
 	c.ConfigOsCoreRule = NewConfigOsCoreRuleClient(c.config)
 	c.ConfigRpmCoreRepo = NewConfigRpmCoreRepoClient(c.config)
 	c.ConfigSanctionedCountry = NewConfigSanctionedCountryClient(c.config)
@@ -169,9 +177,11 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:                       ctx,
 		config:                    cfg,
 		ConfigAuthEndpointPattern: NewConfigAuthEndpointPatternClient(cfg),
+		ConfigDetectionRule:       NewConfigDetectionRuleClient(cfg),
 		ConfigHostingIndicator:    NewConfigHostingIndicatorClient(cfg),
 		ConfigHttpmonitorRule:     NewConfigHttpmonitorRuleClient(cfg),
 		ConfigLibraryUa:           NewConfigLibraryUaClient(cfg),
+		ConfigNotificationRule:    NewConfigNotificationRuleClient(cfg),
 		ConfigOsCoreRule:          NewConfigOsCoreRuleClient(cfg),
 		ConfigRpmCoreRepo:         NewConfigRpmCoreRepoClient(cfg),
 		ConfigSanctionedCountry:   NewConfigSanctionedCountryClient(cfg),
@@ -198,9 +208,11 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:                       ctx,
 		config:                    cfg,
 		ConfigAuthEndpointPattern: NewConfigAuthEndpointPatternClient(cfg),
+		ConfigDetectionRule:       NewConfigDetectionRuleClient(cfg),
 		ConfigHostingIndicator:    NewConfigHostingIndicatorClient(cfg),
 		ConfigHttpmonitorRule:     NewConfigHttpmonitorRuleClient(cfg),
 		ConfigLibraryUa:           NewConfigLibraryUaClient(cfg),
+		ConfigNotificationRule:    NewConfigNotificationRuleClient(cfg),
 		ConfigOsCoreRule:          NewConfigOsCoreRuleClient(cfg),
 		ConfigRpmCoreRepo:         NewConfigRpmCoreRepoClient(cfg),
 		ConfigSanctionedCountry:   NewConfigSanctionedCountryClient(cfg),
@@ -236,10 +248,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.ConfigAuthEndpointPattern, c.ConfigHostingIndicator, c.ConfigHttpmonitorRule,
-		c.ConfigLibraryUa, c.ConfigOsCoreRule, c.ConfigRpmCoreRepo,
-		c.ConfigSanctionedCountry, c.ConfigScannerPattern, c.ConfigSoftwareMatchRule,
-		c.ConfigURIAttackPattern,
+		c.ConfigAuthEndpointPattern, c.ConfigDetectionRule, c.ConfigHostingIndicator,
+		c.ConfigHttpmonitorRule, c.ConfigLibraryUa, c.ConfigNotificationRule,
+		c.ConfigOsCoreRule, c.ConfigRpmCoreRepo, c.ConfigSanctionedCountry,
+		c.ConfigScannerPattern, c.ConfigSoftwareMatchRule, c.ConfigURIAttackPattern,
 	} {
 		n.Use(hooks...)
 	}
@@ -249,10 +261,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.ConfigAuthEndpointPattern, c.ConfigHostingIndicator, c.ConfigHttpmonitorRule,
-		c.ConfigLibraryUa, c.ConfigOsCoreRule, c.ConfigRpmCoreRepo,
-		c.ConfigSanctionedCountry, c.ConfigScannerPattern, c.ConfigSoftwareMatchRule,
-		c.ConfigURIAttackPattern,
+		c.ConfigAuthEndpointPattern, c.ConfigDetectionRule, c.ConfigHostingIndicator,
+		c.ConfigHttpmonitorRule, c.ConfigLibraryUa, c.ConfigNotificationRule,
+		c.ConfigOsCoreRule, c.ConfigRpmCoreRepo, c.ConfigSanctionedCountry,
+		c.ConfigScannerPattern, c.ConfigSoftwareMatchRule, c.ConfigURIAttackPattern,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -263,12 +275,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *ConfigAuthEndpointPatternMutation:
 		return c.ConfigAuthEndpointPattern.mutate(ctx, m)
+	case *ConfigDetectionRuleMutation:
+		return c.ConfigDetectionRule.mutate(ctx, m)
 	case *ConfigHostingIndicatorMutation:
 		return c.ConfigHostingIndicator.mutate(ctx, m)
 	case *ConfigHttpmonitorRuleMutation:
 		return c.ConfigHttpmonitorRule.mutate(ctx, m)
 	case *ConfigLibraryUaMutation:
 		return c.ConfigLibraryUa.mutate(ctx, m)
+	case *ConfigNotificationRuleMutation:
+		return c.ConfigNotificationRule.mutate(ctx, m)
 	case *ConfigOsCoreRuleMutation:
 		return c.ConfigOsCoreRule.mutate(ctx, m)
 	case *ConfigRpmCoreRepoMutation:
@@ -419,6 +435,139 @@ func (c *ConfigAuthEndpointPatternClient) mutate(ctx context.Context, m *ConfigA
 	}
 }
 
+// ConfigDetectionRuleClient is a client for the ConfigDetectionRule schema.
+type ConfigDetectionRuleClient struct {
+	config
+}
+
+// NewConfigDetectionRuleClient returns a client for the ConfigDetectionRule from the given config.
+func NewConfigDetectionRuleClient(c config) *ConfigDetectionRuleClient {
+	return &ConfigDetectionRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `configdetectionrule.Hooks(f(g(h())))`.
+func (c *ConfigDetectionRuleClient) Use(hooks ...Hook) {
+	c.hooks.ConfigDetectionRule = append(c.hooks.ConfigDetectionRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `configdetectionrule.Intercept(f(g(h())))`.
+func (c *ConfigDetectionRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConfigDetectionRule = append(c.inters.ConfigDetectionRule, interceptors...)
+}
+
+// Create returns a builder for creating a ConfigDetectionRule entity.
+func (c *ConfigDetectionRuleClient) Create() *ConfigDetectionRuleCreate {
+	mutation := newConfigDetectionRuleMutation(c.config, OpCreate)
+	return &ConfigDetectionRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ConfigDetectionRule entities.
+func (c *ConfigDetectionRuleClient) CreateBulk(builders ...*ConfigDetectionRuleCreate) *ConfigDetectionRuleCreateBulk {
+	return &ConfigDetectionRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConfigDetectionRuleClient) MapCreateBulk(slice any, setFunc func(*ConfigDetectionRuleCreate, int)) *ConfigDetectionRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConfigDetectionRuleCreateBulk{err: fmt.Errorf("calling to ConfigDetectionRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConfigDetectionRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConfigDetectionRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ConfigDetectionRule.
+func (c *ConfigDetectionRuleClient) Update() *ConfigDetectionRuleUpdate {
+	mutation := newConfigDetectionRuleMutation(c.config, OpUpdate)
+	return &ConfigDetectionRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConfigDetectionRuleClient) UpdateOne(_m *ConfigDetectionRule) *ConfigDetectionRuleUpdateOne {
+	mutation := newConfigDetectionRuleMutation(c.config, OpUpdateOne, withConfigDetectionRule(_m))
+	return &ConfigDetectionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConfigDetectionRuleClient) UpdateOneID(id int) *ConfigDetectionRuleUpdateOne {
+	mutation := newConfigDetectionRuleMutation(c.config, OpUpdateOne, withConfigDetectionRuleID(id))
+	return &ConfigDetectionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ConfigDetectionRule.
+func (c *ConfigDetectionRuleClient) Delete() *ConfigDetectionRuleDelete {
+	mutation := newConfigDetectionRuleMutation(c.config, OpDelete)
+	return &ConfigDetectionRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConfigDetectionRuleClient) DeleteOne(_m *ConfigDetectionRule) *ConfigDetectionRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConfigDetectionRuleClient) DeleteOneID(id int) *ConfigDetectionRuleDeleteOne {
+	builder := c.Delete().Where(configdetectionrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConfigDetectionRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for ConfigDetectionRule.
+func (c *ConfigDetectionRuleClient) Query() *ConfigDetectionRuleQuery {
+	return &ConfigDetectionRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConfigDetectionRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ConfigDetectionRule entity by its id.
+func (c *ConfigDetectionRuleClient) Get(ctx context.Context, id int) (*ConfigDetectionRule, error) {
+	return c.Query().Where(configdetectionrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConfigDetectionRuleClient) GetX(ctx context.Context, id int) *ConfigDetectionRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ConfigDetectionRuleClient) Hooks() []Hook {
+	return c.hooks.ConfigDetectionRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConfigDetectionRuleClient) Interceptors() []Interceptor {
+	return c.inters.ConfigDetectionRule
+}
+
+func (c *ConfigDetectionRuleClient) mutate(ctx context.Context, m *ConfigDetectionRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConfigDetectionRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConfigDetectionRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConfigDetectionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConfigDetectionRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("rule: unknown ConfigDetectionRule mutation op: %q", m.Op())
+	}
+}
+
 // ConfigHostingIndicatorClient is a client for the ConfigHostingIndicator schema.
 type ConfigHostingIndicatorClient struct {
 	config
@@ -818,6 +967,139 @@ func (c *ConfigLibraryUaClient) mutate(ctx context.Context, m *ConfigLibraryUaMu
 	}
 }
 
+// ConfigNotificationRuleClient is a client for the ConfigNotificationRule schema.
+type ConfigNotificationRuleClient struct {
+	config
+}
+
+// NewConfigNotificationRuleClient returns a client for the ConfigNotificationRule from the given config.
+func NewConfigNotificationRuleClient(c config) *ConfigNotificationRuleClient {
+	return &ConfigNotificationRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `confignotificationrule.Hooks(f(g(h())))`.
+func (c *ConfigNotificationRuleClient) Use(hooks ...Hook) {
+	c.hooks.ConfigNotificationRule = append(c.hooks.ConfigNotificationRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `confignotificationrule.Intercept(f(g(h())))`.
+func (c *ConfigNotificationRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConfigNotificationRule = append(c.inters.ConfigNotificationRule, interceptors...)
+}
+
+// Create returns a builder for creating a ConfigNotificationRule entity.
+func (c *ConfigNotificationRuleClient) Create() *ConfigNotificationRuleCreate {
+	mutation := newConfigNotificationRuleMutation(c.config, OpCreate)
+	return &ConfigNotificationRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ConfigNotificationRule entities.
+func (c *ConfigNotificationRuleClient) CreateBulk(builders ...*ConfigNotificationRuleCreate) *ConfigNotificationRuleCreateBulk {
+	return &ConfigNotificationRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConfigNotificationRuleClient) MapCreateBulk(slice any, setFunc func(*ConfigNotificationRuleCreate, int)) *ConfigNotificationRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConfigNotificationRuleCreateBulk{err: fmt.Errorf("calling to ConfigNotificationRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConfigNotificationRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConfigNotificationRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ConfigNotificationRule.
+func (c *ConfigNotificationRuleClient) Update() *ConfigNotificationRuleUpdate {
+	mutation := newConfigNotificationRuleMutation(c.config, OpUpdate)
+	return &ConfigNotificationRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConfigNotificationRuleClient) UpdateOne(_m *ConfigNotificationRule) *ConfigNotificationRuleUpdateOne {
+	mutation := newConfigNotificationRuleMutation(c.config, OpUpdateOne, withConfigNotificationRule(_m))
+	return &ConfigNotificationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConfigNotificationRuleClient) UpdateOneID(id int) *ConfigNotificationRuleUpdateOne {
+	mutation := newConfigNotificationRuleMutation(c.config, OpUpdateOne, withConfigNotificationRuleID(id))
+	return &ConfigNotificationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ConfigNotificationRule.
+func (c *ConfigNotificationRuleClient) Delete() *ConfigNotificationRuleDelete {
+	mutation := newConfigNotificationRuleMutation(c.config, OpDelete)
+	return &ConfigNotificationRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConfigNotificationRuleClient) DeleteOne(_m *ConfigNotificationRule) *ConfigNotificationRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConfigNotificationRuleClient) DeleteOneID(id int) *ConfigNotificationRuleDeleteOne {
+	builder := c.Delete().Where(confignotificationrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConfigNotificationRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for ConfigNotificationRule.
+func (c *ConfigNotificationRuleClient) Query() *ConfigNotificationRuleQuery {
+	return &ConfigNotificationRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConfigNotificationRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ConfigNotificationRule entity by its id.
+func (c *ConfigNotificationRuleClient) Get(ctx context.Context, id int) (*ConfigNotificationRule, error) {
+	return c.Query().Where(confignotificationrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConfigNotificationRuleClient) GetX(ctx context.Context, id int) *ConfigNotificationRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ConfigNotificationRuleClient) Hooks() []Hook {
+	return c.hooks.ConfigNotificationRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConfigNotificationRuleClient) Interceptors() []Interceptor {
+	return c.inters.ConfigNotificationRule
+}
+
+func (c *ConfigNotificationRuleClient) mutate(ctx context.Context, m *ConfigNotificationRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConfigNotificationRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConfigNotificationRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConfigNotificationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConfigNotificationRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("rule: unknown ConfigNotificationRule mutation op: %q", m.Op())
+	}
+}
+
 // ConfigOsCoreRuleClient is a client for the ConfigOsCoreRule schema.
 type ConfigOsCoreRuleClient struct {
 	config
@@ -1619,14 +1901,16 @@ func (c *ConfigURIAttackPatternClient) mutate(ctx context.Context, m *ConfigURIA
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ConfigAuthEndpointPattern, ConfigHostingIndicator, ConfigHttpmonitorRule,
-		ConfigLibraryUa, ConfigOsCoreRule, ConfigRpmCoreRepo, ConfigSanctionedCountry,
+		ConfigAuthEndpointPattern, ConfigDetectionRule, ConfigHostingIndicator,
+		ConfigHttpmonitorRule, ConfigLibraryUa, ConfigNotificationRule,
+		ConfigOsCoreRule, ConfigRpmCoreRepo, ConfigSanctionedCountry,
 		ConfigScannerPattern, ConfigSoftwareMatchRule,
 		ConfigURIAttackPattern []ent.Hook
 	}
 	inters struct {
-		ConfigAuthEndpointPattern, ConfigHostingIndicator, ConfigHttpmonitorRule,
-		ConfigLibraryUa, ConfigOsCoreRule, ConfigRpmCoreRepo, ConfigSanctionedCountry,
+		ConfigAuthEndpointPattern, ConfigDetectionRule, ConfigHostingIndicator,
+		ConfigHttpmonitorRule, ConfigLibraryUa, ConfigNotificationRule,
+		ConfigOsCoreRule, ConfigRpmCoreRepo, ConfigSanctionedCountry,
 		ConfigScannerPattern, ConfigSoftwareMatchRule,
 		ConfigURIAttackPattern []ent.Interceptor
 	}
@@ -0,0 +1,267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rule
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/rule/configdetectionrule"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ConfigDetectionRule is the model entity for the ConfigDetectionRule schema.
+type ConfigDetectionRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Code-facing identifier, e.g. gcp_firewall_open_ssh
+	RuleKey string `json:"rule_key,omitempty"`
+	// Human-readable finding title
+	Name string `json:"name,omitempty"`
+	// What the rule detects
+	Description string `json:"description,omitempty"`
+	// network, storage, iam, database, org_policy, ...
+	Category string `json:"category,omitempty"`
+	// critical, high, medium, low, info
+	Severity string `json:"severity,omitempty"`
+	// gcp, aws, s1, ...
+	Provider string `json:"provider,omitempty"`
+	// Table the offending resource lives in, e.g. gcp_compute_firewalls
+	ResourceType string `json:"resource_type,omitempty"`
+	// How to fix a finding
+	Remediation string `json:"remediation,omitempty"`
+	// Read-only SELECT returning asset_id, asset_name, project_id, evidence (jsonb). Named parameters use @name
+	Query string `json:"query,omitempty"`
+	// Values bound to @name parameters, e.g. {"port": 22}
+	ParamsJSON map[string]interface{} `json:"params_json,omitempty"`
+	// Statement timeout for the query
+	TimeoutSeconds int `json:"timeout_seconds,omitempty"`
+	// Origin: seed (shipped default) or custom (user-added)
+	Source string `json:"source,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConfigDetectionRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case configdetectionrule.FieldParamsJSON:
+			values[i] = new([]byte)
+		case configdetectionrule.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case configdetectionrule.FieldID, configdetectionrule.FieldTimeoutSeconds:
+			values[i] = new(sql.NullInt64)
+		case configdetectionrule.FieldRuleKey, configdetectionrule.FieldName, configdetectionrule.FieldDescription, configdetectionrule.FieldCategory, configdetectionrule.FieldSeverity, configdetectionrule.FieldProvider, configdetectionrule.FieldResourceType, configdetectionrule.FieldRemediation, configdetectionrule.FieldQuery, configdetectionrule.FieldSource:
+			values[i] = new(sql.NullString)
+		case configdetectionrule.FieldCreatedAt, configdetectionrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConfigDetectionRule fields.
+func (_m *ConfigDetectionRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case configdetectionrule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case configdetectionrule.FieldRuleKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_key", values[i])
+			} else if value.Valid {
+				_m.RuleKey = value.String
+			}
+		case configdetectionrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case configdetectionrule.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case configdetectionrule.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = value.String
+			}
+		case configdetectionrule.FieldSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field severity", values[i])
+			} else if value.Valid {
+				_m.Severity = value.String
+			}
+		case configdetectionrule.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case configdetectionrule.FieldResourceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_type", values[i])
+			} else if value.Valid {
+				_m.ResourceType = value.String
+			}
+		case configdetectionrule.FieldRemediation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remediation", values[i])
+			} else if value.Valid {
+				_m.Remediation = value.String
+			}
+		case configdetectionrule.FieldQuery:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field query", values[i])
+			} else if value.Valid {
+				_m.Query = value.String
+			}
+		case configdetectionrule.FieldParamsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field params_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ParamsJSON); err != nil {
+					return fmt.Errorf("unmarshal field params_json: %w", err)
+				}
+			}
+		case configdetectionrule.FieldTimeoutSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field timeout_seconds", values[i])
+			} else if value.Valid {
+				_m.TimeoutSeconds = int(value.Int64)
+			}
+		case configdetectionrule.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case configdetectionrule.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				_m.IsActive = value.Bool
+			}
+		case configdetectionrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case configdetectionrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ConfigDetectionRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *ConfigDetectionRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ConfigDetectionRule.
+// Note that you need to call ConfigDetectionRule.Unwrap() before calling this method if this ConfigDetectionRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ConfigDetectionRule) Update() *ConfigDetectionRuleUpdateOne {
+	return NewConfigDetectionRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ConfigDetectionRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ConfigDetectionRule) Unwrap() *ConfigDetectionRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("rule: ConfigDetectionRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ConfigDetectionRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConfigDetectionRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("rule_key=")
+	builder.WriteString(_m.RuleKey)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(_m.Category)
+	builder.WriteString(", ")
+	builder.WriteString("severity=")
+	builder.WriteString(_m.Severity)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("resource_type=")
+	builder.WriteString(_m.ResourceType)
+	builder.WriteString(", ")
+	builder.WriteString("remediation=")
+	builder.WriteString(_m.Remediation)
+	builder.WriteString(", ")
+	builder.WriteString("query=")
+	builder.WriteString(_m.Query)
+	builder.WriteString(", ")
+	builder.WriteString("params_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ParamsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("timeout_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TimeoutSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConfigDetectionRules is a parsable slice of ConfigDetectionRule.
+type ConfigDetectionRules []*ConfigDetectionRule
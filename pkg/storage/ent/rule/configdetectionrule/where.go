@@ -0,0 +1,950 @@
+// Code generated by ent, DO NOT EDIT.
+
+package configdetectionrule
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/rule/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLTE(FieldID, id))
+}
+
+// RuleKey applies equality check predicate on the "rule_key" field. It's identical to RuleKeyEQ.
+func RuleKey(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldRuleKey, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldCategory, v))
+}
+
+// Severity applies equality check predicate on the "severity" field. It's identical to SeverityEQ.
+func Severity(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldSeverity, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldProvider, v))
+}
+
+// ResourceType applies equality check predicate on the "resource_type" field. It's identical to ResourceTypeEQ.
+func ResourceType(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldResourceType, v))
+}
+
+// Remediation applies equality check predicate on the "remediation" field. It's identical to RemediationEQ.
+func Remediation(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldRemediation, v))
+}
+
+// Query applies equality check predicate on the "query" field. It's identical to QueryEQ.
+func Query(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldQuery, v))
+}
+
+// TimeoutSeconds applies equality check predicate on the "timeout_seconds" field. It's identical to TimeoutSecondsEQ.
+func TimeoutSeconds(v int) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldTimeoutSeconds, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldSource, v))
+}
+
+// IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
+func IsActive(v bool) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldIsActive, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// RuleKeyEQ applies the EQ predicate on the "rule_key" field.
+func RuleKeyEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldRuleKey, v))
+}
+
+// RuleKeyNEQ applies the NEQ predicate on the "rule_key" field.
+func RuleKeyNEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNEQ(FieldRuleKey, v))
+}
+
+// RuleKeyIn applies the In predicate on the "rule_key" field.
+func RuleKeyIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldIn(FieldRuleKey, vs...))
+}
+
+// RuleKeyNotIn applies the NotIn predicate on the "rule_key" field.
+func RuleKeyNotIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNotIn(FieldRuleKey, vs...))
+}
+
+// RuleKeyGT applies the GT predicate on the "rule_key" field.
+func RuleKeyGT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGT(FieldRuleKey, v))
+}
+
+// RuleKeyGTE applies the GTE predicate on the "rule_key" field.
+func RuleKeyGTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGTE(FieldRuleKey, v))
+}
+
+// RuleKeyLT applies the LT predicate on the "rule_key" field.
+func RuleKeyLT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLT(FieldRuleKey, v))
+}
+
+// RuleKeyLTE applies the LTE predicate on the "rule_key" field.
+func RuleKeyLTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLTE(FieldRuleKey, v))
+}
+
+// RuleKeyContains applies the Contains predicate on the "rule_key" field.
+func RuleKeyContains(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContains(FieldRuleKey, v))
+}
+
+// RuleKeyHasPrefix applies the HasPrefix predicate on the "rule_key" field.
+func RuleKeyHasPrefix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasPrefix(FieldRuleKey, v))
+}
+
+// RuleKeyHasSuffix applies the HasSuffix predicate on the "rule_key" field.
+func RuleKeyHasSuffix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasSuffix(FieldRuleKey, v))
+}
+
+// RuleKeyEqualFold applies the EqualFold predicate on the "rule_key" field.
+func RuleKeyEqualFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEqualFold(FieldRuleKey, v))
+}
+
+// RuleKeyContainsFold applies the ContainsFold predicate on the "rule_key" field.
+func RuleKeyContainsFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContainsFold(FieldRuleKey, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// SeverityEQ applies the EQ predicate on the "severity" field.
+func SeverityEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldSeverity, v))
+}
+
+// SeverityNEQ applies the NEQ predicate on the "severity" field.
+func SeverityNEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNEQ(FieldSeverity, v))
+}
+
+// SeverityIn applies the In predicate on the "severity" field.
+func SeverityIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldIn(FieldSeverity, vs...))
+}
+
+// SeverityNotIn applies the NotIn predicate on the "severity" field.
+func SeverityNotIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNotIn(FieldSeverity, vs...))
+}
+
+// SeverityGT applies the GT predicate on the "severity" field.
+func SeverityGT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGT(FieldSeverity, v))
+}
+
+// SeverityGTE applies the GTE predicate on the "severity" field.
+func SeverityGTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGTE(FieldSeverity, v))
+}
+
+// SeverityLT applies the LT predicate on the "severity" field.
+func SeverityLT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLT(FieldSeverity, v))
+}
+
+// SeverityLTE applies the LTE predicate on the "severity" field.
+func SeverityLTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLTE(FieldSeverity, v))
+}
+
+// SeverityContains applies the Contains predicate on the "severity" field.
+func SeverityContains(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContains(FieldSeverity, v))
+}
+
+// SeverityHasPrefix applies the HasPrefix predicate on the "severity" field.
+func SeverityHasPrefix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasPrefix(FieldSeverity, v))
+}
+
+// SeverityHasSuffix applies the HasSuffix predicate on the "severity" field.
+func SeverityHasSuffix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasSuffix(FieldSeverity, v))
+}
+
+// SeverityEqualFold applies the EqualFold predicate on the "severity" field.
+func SeverityEqualFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEqualFold(FieldSeverity, v))
+}
+
+// SeverityContainsFold applies the ContainsFold predicate on the "severity" field.
+func SeverityContainsFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContainsFold(FieldSeverity, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// ResourceTypeEQ applies the EQ predicate on the "resource_type" field.
+func ResourceTypeEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldResourceType, v))
+}
+
+// ResourceTypeNEQ applies the NEQ predicate on the "resource_type" field.
+func ResourceTypeNEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNEQ(FieldResourceType, v))
+}
+
+// ResourceTypeIn applies the In predicate on the "resource_type" field.
+func ResourceTypeIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldIn(FieldResourceType, vs...))
+}
+
+// ResourceTypeNotIn applies the NotIn predicate on the "resource_type" field.
+func ResourceTypeNotIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNotIn(FieldResourceType, vs...))
+}
+
+// ResourceTypeGT applies the GT predicate on the "resource_type" field.
+func ResourceTypeGT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGT(FieldResourceType, v))
+}
+
+// ResourceTypeGTE applies the GTE predicate on the "resource_type" field.
+func ResourceTypeGTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGTE(FieldResourceType, v))
+}
+
+// ResourceTypeLT applies the LT predicate on the "resource_type" field.
+func ResourceTypeLT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLT(FieldResourceType, v))
+}
+
+// ResourceTypeLTE applies the LTE predicate on the "resource_type" field.
+func ResourceTypeLTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLTE(FieldResourceType, v))
+}
+
+// ResourceTypeContains applies the Contains predicate on the "resource_type" field.
+func ResourceTypeContains(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContains(FieldResourceType, v))
+}
+
+// ResourceTypeHasPrefix applies the HasPrefix predicate on the "resource_type" field.
+func ResourceTypeHasPrefix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasPrefix(FieldResourceType, v))
+}
+
+// ResourceTypeHasSuffix applies the HasSuffix predicate on the "resource_type" field.
+func ResourceTypeHasSuffix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasSuffix(FieldResourceType, v))
+}
+
+// ResourceTypeEqualFold applies the EqualFold predicate on the "resource_type" field.
+func ResourceTypeEqualFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEqualFold(FieldResourceType, v))
+}
+
+// ResourceTypeContainsFold applies the ContainsFold predicate on the "resource_type" field.
+func ResourceTypeContainsFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContainsFold(FieldResourceType, v))
+}
+
+// RemediationEQ applies the EQ predicate on the "remediation" field.
+func RemediationEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldRemediation, v))
+}
+
+// RemediationNEQ applies the NEQ predicate on the "remediation" field.
+func RemediationNEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNEQ(FieldRemediation, v))
+}
+
+// RemediationIn applies the In predicate on the "remediation" field.
+func RemediationIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldIn(FieldRemediation, vs...))
+}
+
+// RemediationNotIn applies the NotIn predicate on the "remediation" field.
+func RemediationNotIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNotIn(FieldRemediation, vs...))
+}
+
+// RemediationGT applies the GT predicate on the "remediation" field.
+func RemediationGT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGT(FieldRemediation, v))
+}
+
+// RemediationGTE applies the GTE predicate on the "remediation" field.
+func RemediationGTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGTE(FieldRemediation, v))
+}
+
+// RemediationLT applies the LT predicate on the "remediation" field.
+func RemediationLT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLT(FieldRemediation, v))
+}
+
+// RemediationLTE applies the LTE predicate on the "remediation" field.
+func RemediationLTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLTE(FieldRemediation, v))
+}
+
+// RemediationContains applies the Contains predicate on the "remediation" field.
+func RemediationContains(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContains(FieldRemediation, v))
+}
+
+// RemediationHasPrefix applies the HasPrefix predicate on the "remediation" field.
+func RemediationHasPrefix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasPrefix(FieldRemediation, v))
+}
+
+// RemediationHasSuffix applies the HasSuffix predicate on the "remediation" field.
+func RemediationHasSuffix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasSuffix(FieldRemediation, v))
+}
+
+// RemediationIsNil applies the IsNil predicate on the "remediation" field.
+func RemediationIsNil() predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldIsNull(FieldRemediation))
+}
+
+// RemediationNotNil applies the NotNil predicate on the "remediation" field.
+func RemediationNotNil() predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNotNull(FieldRemediation))
+}
+
+// RemediationEqualFold applies the EqualFold predicate on the "remediation" field.
+func RemediationEqualFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEqualFold(FieldRemediation, v))
+}
+
+// RemediationContainsFold applies the ContainsFold predicate on the "remediation" field.
+func RemediationContainsFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContainsFold(FieldRemediation, v))
+}
+
+// QueryEQ applies the EQ predicate on the "query" field.
+func QueryEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldQuery, v))
+}
+
+// QueryNEQ applies the NEQ predicate on the "query" field.
+func QueryNEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNEQ(FieldQuery, v))
+}
+
+// QueryIn applies the In predicate on the "query" field.
+func QueryIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldIn(FieldQuery, vs...))
+}
+
+// QueryNotIn applies the NotIn predicate on the "query" field.
+func QueryNotIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNotIn(FieldQuery, vs...))
+}
+
+// QueryGT applies the GT predicate on the "query" field.
+func QueryGT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGT(FieldQuery, v))
+}
+
+// QueryGTE applies the GTE predicate on the "query" field.
+func QueryGTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGTE(FieldQuery, v))
+}
+
+// QueryLT applies the LT predicate on the "query" field.
+func QueryLT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLT(FieldQuery, v))
+}
+
+// QueryLTE applies the LTE predicate on the "query" field.
+func QueryLTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLTE(FieldQuery, v))
+}
+
+// QueryContains applies the Contains predicate on the "query" field.
+func QueryContains(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContains(FieldQuery, v))
+}
+
+// QueryHasPrefix applies the HasPrefix predicate on the "query" field.
+func QueryHasPrefix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasPrefix(FieldQuery, v))
+}
+
+// QueryHasSuffix applies the HasSuffix predicate on the "query" field.
+func QueryHasSuffix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasSuffix(FieldQuery, v))
+}
+
+// QueryEqualFold applies the EqualFold predicate on the "query" field.
+func QueryEqualFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEqualFold(FieldQuery, v))
+}
+
+// QueryContainsFold applies the ContainsFold predicate on the "query" field.
+func QueryContainsFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContainsFold(FieldQuery, v))
+}
+
+// ParamsJSONIsNil applies the IsNil predicate on the "params_json" field.
+func ParamsJSONIsNil() predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldIsNull(FieldParamsJSON))
+}
+
+// ParamsJSONNotNil applies the NotNil predicate on the "params_json" field.
+func ParamsJSONNotNil() predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNotNull(FieldParamsJSON))
+}
+
+// TimeoutSecondsEQ applies the EQ predicate on the "timeout_seconds" field.
+func TimeoutSecondsEQ(v int) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsNEQ applies the NEQ predicate on the "timeout_seconds" field.
+func TimeoutSecondsNEQ(v int) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNEQ(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsIn applies the In predicate on the "timeout_seconds" field.
+func TimeoutSecondsIn(vs ...int) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldIn(FieldTimeoutSeconds, vs...))
+}
+
+// TimeoutSecondsNotIn applies the NotIn predicate on the "timeout_seconds" field.
+func TimeoutSecondsNotIn(vs ...int) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNotIn(FieldTimeoutSeconds, vs...))
+}
+
+// TimeoutSecondsGT applies the GT predicate on the "timeout_seconds" field.
+func TimeoutSecondsGT(v int) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGT(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsGTE applies the GTE predicate on the "timeout_seconds" field.
+func TimeoutSecondsGTE(v int) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGTE(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsLT applies the LT predicate on the "timeout_seconds" field.
+func TimeoutSecondsLT(v int) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLT(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsLTE applies the LTE predicate on the "timeout_seconds" field.
+func TimeoutSecondsLTE(v int) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLTE(FieldTimeoutSeconds, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldContainsFold(FieldSource, v))
+}
+
+// IsActiveEQ applies the EQ predicate on the "is_active" field.
+func IsActiveEQ(v bool) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsActiveNEQ applies the NEQ predicate on the "is_active" field.
+func IsActiveNEQ(v bool) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNEQ(FieldIsActive, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConfigDetectionRule) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConfigDetectionRule) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConfigDetectionRule) predicate.ConfigDetectionRule {
+	return predicate.ConfigDetectionRule(sql.NotPredicates(p))
+}
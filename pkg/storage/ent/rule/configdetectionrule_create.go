@@ -0,0 +1,463 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/rule/configdetectionrule"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConfigDetectionRuleCreate is the builder for creating a ConfigDetectionRule entity.
+type ConfigDetectionRuleCreate struct {
+	config
+	mutation *ConfigDetectionRuleMutation
+	hooks    []Hook
+}
+
+// SetRuleKey sets the "rule_key" field.
+func (_c *ConfigDetectionRuleCreate) SetRuleKey(v string) *ConfigDetectionRuleCreate {
+	_c.mutation.SetRuleKey(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ConfigDetectionRuleCreate) SetName(v string) *ConfigDetectionRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *ConfigDetectionRuleCreate) SetDescription(v string) *ConfigDetectionRuleCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *ConfigDetectionRuleCreate) SetNillableDescription(v *string) *ConfigDetectionRuleCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *ConfigDetectionRuleCreate) SetCategory(v string) *ConfigDetectionRuleCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetSeverity sets the "severity" field.
+func (_c *ConfigDetectionRuleCreate) SetSeverity(v string) *ConfigDetectionRuleCreate {
+	_c.mutation.SetSeverity(v)
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *ConfigDetectionRuleCreate) SetProvider(v string) *ConfigDetectionRuleCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetResourceType sets the "resource_type" field.
+func (_c *ConfigDetectionRuleCreate) SetResourceType(v string) *ConfigDetectionRuleCreate {
+	_c.mutation.SetResourceType(v)
+	return _c
+}
+
+// SetRemediation sets the "remediation" field.
+func (_c *ConfigDetectionRuleCreate) SetRemediation(v string) *ConfigDetectionRuleCreate {
+	_c.mutation.SetRemediation(v)
+	return _c
+}
+
+// SetNillableRemediation sets the "remediation" field if the given value is not nil.
+func (_c *ConfigDetectionRuleCreate) SetNillableRemediation(v *string) *ConfigDetectionRuleCreate {
+	if v != nil {
+		_c.SetRemediation(*v)
+	}
+	return _c
+}
+
+// SetQuery sets the "query" field.
+func (_c *ConfigDetectionRuleCreate) SetQuery(v string) *ConfigDetectionRuleCreate {
+	_c.mutation.SetQuery(v)
+	return _c
+}
+
+// SetParamsJSON sets the "params_json" field.
+func (_c *ConfigDetectionRuleCreate) SetParamsJSON(v map[string]interface{}) *ConfigDetectionRuleCreate {
+	_c.mutation.SetParamsJSON(v)
+	return _c
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (_c *ConfigDetectionRuleCreate) SetTimeoutSeconds(v int) *ConfigDetectionRuleCreate {
+	_c.mutation.SetTimeoutSeconds(v)
+	return _c
+}
+
+// SetNillableTimeoutSeconds sets the "timeout_seconds" field if the given value is not nil.
+func (_c *ConfigDetectionRuleCreate) SetNillableTimeoutSeconds(v *int) *ConfigDetectionRuleCreate {
+	if v != nil {
+		_c.SetTimeoutSeconds(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *ConfigDetectionRuleCreate) SetSource(v string) *ConfigDetectionRuleCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *ConfigDetectionRuleCreate) SetNillableSource(v *string) *ConfigDetectionRuleCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetIsActive sets the "is_active" field.
+func (_c *ConfigDetectionRuleCreate) SetIsActive(v bool) *ConfigDetectionRuleCreate {
+	_c.mutation.SetIsActive(v)
+	return _c
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_c *ConfigDetectionRuleCreate) SetNillableIsActive(v *bool) *ConfigDetectionRuleCreate {
+	if v != nil {
+		_c.SetIsActive(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ConfigDetectionRuleCreate) SetCreatedAt(v time.Time) *ConfigDetectionRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ConfigDetectionRuleCreate) SetUpdatedAt(v time.Time) *ConfigDetectionRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ConfigDetectionRuleCreate) SetID(v int) *ConfigDetectionRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ConfigDetectionRuleMutation object of the builder.
+func (_c *ConfigDetectionRuleCreate) Mutation() *ConfigDetectionRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the ConfigDetectionRule in the database.
+func (_c *ConfigDetectionRuleCreate) Save(ctx context.Context) (*ConfigDetectionRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConfigDetectionRuleCreate) SaveX(ctx context.Context) *ConfigDetectionRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConfigDetectionRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConfigDetectionRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ConfigDetectionRuleCreate) defaults() {
+	if _, ok := _c.mutation.TimeoutSeconds(); !ok {
+		v := configdetectionrule.DefaultTimeoutSeconds
+		_c.mutation.SetTimeoutSeconds(v)
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		v := configdetectionrule.DefaultSource
+		_c.mutation.SetSource(v)
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		v := configdetectionrule.DefaultIsActive
+		_c.mutation.SetIsActive(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConfigDetectionRuleCreate) check() error {
+	if _, ok := _c.mutation.RuleKey(); !ok {
+		return &ValidationError{Name: "rule_key", err: errors.New(`rule: missing required field "ConfigDetectionRule.rule_key"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`rule: missing required field "ConfigDetectionRule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := configdetectionrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`rule: missing required field "ConfigDetectionRule.category"`)}
+	}
+	if v, ok := _c.mutation.Category(); ok {
+		if err := configdetectionrule.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.category": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Severity(); !ok {
+		return &ValidationError{Name: "severity", err: errors.New(`rule: missing required field "ConfigDetectionRule.severity"`)}
+	}
+	if v, ok := _c.mutation.Severity(); ok {
+		if err := configdetectionrule.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.severity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`rule: missing required field "ConfigDetectionRule.provider"`)}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := configdetectionrule.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.provider": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ResourceType(); !ok {
+		return &ValidationError{Name: "resource_type", err: errors.New(`rule: missing required field "ConfigDetectionRule.resource_type"`)}
+	}
+	if v, ok := _c.mutation.ResourceType(); ok {
+		if err := configdetectionrule.ResourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "resource_type", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.resource_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Query(); !ok {
+		return &ValidationError{Name: "query", err: errors.New(`rule: missing required field "ConfigDetectionRule.query"`)}
+	}
+	if v, ok := _c.mutation.Query(); ok {
+		if err := configdetectionrule.QueryValidator(v); err != nil {
+			return &ValidationError{Name: "query", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.query": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TimeoutSeconds(); !ok {
+		return &ValidationError{Name: "timeout_seconds", err: errors.New(`rule: missing required field "ConfigDetectionRule.timeout_seconds"`)}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`rule: missing required field "ConfigDetectionRule.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := configdetectionrule.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`rule: missing required field "ConfigDetectionRule.is_active"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`rule: missing required field "ConfigDetectionRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`rule: missing required field "ConfigDetectionRule.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ConfigDetectionRuleCreate) sqlSave(ctx context.Context) (*ConfigDetectionRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConfigDetectionRuleCreate) createSpec() (*ConfigDetectionRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConfigDetectionRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(configdetectionrule.Table, sqlgraph.NewFieldSpec(configdetectionrule.FieldID, field.TypeInt))
+	)
+	_spec.Schema = _c.schemaConfig.ConfigDetectionRule
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.RuleKey(); ok {
+		_spec.SetField(configdetectionrule.FieldRuleKey, field.TypeString, value)
+		_node.RuleKey = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(configdetectionrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(configdetectionrule.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(configdetectionrule.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
+	if value, ok := _c.mutation.Severity(); ok {
+		_spec.SetField(configdetectionrule.FieldSeverity, field.TypeString, value)
+		_node.Severity = value
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(configdetectionrule.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.ResourceType(); ok {
+		_spec.SetField(configdetectionrule.FieldResourceType, field.TypeString, value)
+		_node.ResourceType = value
+	}
+	if value, ok := _c.mutation.Remediation(); ok {
+		_spec.SetField(configdetectionrule.FieldRemediation, field.TypeString, value)
+		_node.Remediation = value
+	}
+	if value, ok := _c.mutation.Query(); ok {
+		_spec.SetField(configdetectionrule.FieldQuery, field.TypeString, value)
+		_node.Query = value
+	}
+	if value, ok := _c.mutation.ParamsJSON(); ok {
+		_spec.SetField(configdetectionrule.FieldParamsJSON, field.TypeJSON, value)
+		_node.ParamsJSON = value
+	}
+	if value, ok := _c.mutation.TimeoutSeconds(); ok {
+		_spec.SetField(configdetectionrule.FieldTimeoutSeconds, field.TypeInt, value)
+		_node.TimeoutSeconds = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(configdetectionrule.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.IsActive(); ok {
+		_spec.SetField(configdetectionrule.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(configdetectionrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(configdetectionrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ConfigDetectionRuleCreateBulk is the builder for creating many ConfigDetectionRule entities in bulk.
+type ConfigDetectionRuleCreateBulk struct {
+	config
+	err      error
+	builders []*ConfigDetectionRuleCreate
+}
+
+// Save creates the ConfigDetectionRule entities in the database.
+func (_c *ConfigDetectionRuleCreateBulk) Save(ctx context.Context) ([]*ConfigDetectionRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ConfigDetectionRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConfigDetectionRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConfigDetectionRuleCreateBulk) SaveX(ctx context.Context) []*ConfigDetectionRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConfigDetectionRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConfigDetectionRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rule
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/rule/configdetectionrule"
+	"danny.vn/hotpot/pkg/storage/ent/rule/internal"
+	"danny.vn/hotpot/pkg/storage/ent/rule/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConfigDetectionRuleDelete is the builder for deleting a ConfigDetectionRule entity.
+type ConfigDetectionRuleDelete struct {
+	config
+	hooks    []Hook
+	mutation *ConfigDetectionRuleMutation
+}
+
+// Where appends a list predicates to the ConfigDetectionRuleDelete builder.
+func (_d *ConfigDetectionRuleDelete) Where(ps ...predicate.ConfigDetectionRule) *ConfigDetectionRuleDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ConfigDetectionRuleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ConfigDetectionRuleDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ConfigDetectionRuleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(configdetectionrule.Table, sqlgraph.NewFieldSpec(configdetectionrule.FieldID, field.TypeInt))
+	_spec.Node.Schema = _d.schemaConfig.ConfigDetectionRule
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ConfigDetectionRuleDeleteOne is the builder for deleting a single ConfigDetectionRule entity.
+type ConfigDetectionRuleDeleteOne struct {
+	_d *ConfigDetectionRuleDelete
+}
+
+// Where appends a list predicates to the ConfigDetectionRuleDelete builder.
+func (_d *ConfigDetectionRuleDeleteOne) Where(ps ...predicate.ConfigDetectionRule) *ConfigDetectionRuleDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ConfigDetectionRuleDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{configdetectionrule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ConfigDetectionRuleDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
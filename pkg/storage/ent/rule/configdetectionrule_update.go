@@ -0,0 +1,767 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/rule/configdetectionrule"
+	"danny.vn/hotpot/pkg/storage/ent/rule/internal"
+	"danny.vn/hotpot/pkg/storage/ent/rule/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConfigDetectionRuleUpdate is the builder for updating ConfigDetectionRule entities.
+type ConfigDetectionRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConfigDetectionRuleMutation
+}
+
+// Where appends a list predicates to the ConfigDetectionRuleUpdate builder.
+func (_u *ConfigDetectionRuleUpdate) Where(ps ...predicate.ConfigDetectionRule) *ConfigDetectionRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ConfigDetectionRuleUpdate) SetName(v string) *ConfigDetectionRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdate) SetNillableName(v *string) *ConfigDetectionRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ConfigDetectionRuleUpdate) SetDescription(v string) *ConfigDetectionRuleUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdate) SetNillableDescription(v *string) *ConfigDetectionRuleUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ConfigDetectionRuleUpdate) ClearDescription() *ConfigDetectionRuleUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *ConfigDetectionRuleUpdate) SetCategory(v string) *ConfigDetectionRuleUpdate {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdate) SetNillableCategory(v *string) *ConfigDetectionRuleUpdate {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetSeverity sets the "severity" field.
+func (_u *ConfigDetectionRuleUpdate) SetSeverity(v string) *ConfigDetectionRuleUpdate {
+	_u.mutation.SetSeverity(v)
+	return _u
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdate) SetNillableSeverity(v *string) *ConfigDetectionRuleUpdate {
+	if v != nil {
+		_u.SetSeverity(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *ConfigDetectionRuleUpdate) SetProvider(v string) *ConfigDetectionRuleUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdate) SetNillableProvider(v *string) *ConfigDetectionRuleUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetResourceType sets the "resource_type" field.
+func (_u *ConfigDetectionRuleUpdate) SetResourceType(v string) *ConfigDetectionRuleUpdate {
+	_u.mutation.SetResourceType(v)
+	return _u
+}
+
+// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdate) SetNillableResourceType(v *string) *ConfigDetectionRuleUpdate {
+	if v != nil {
+		_u.SetResourceType(*v)
+	}
+	return _u
+}
+
+// SetRemediation sets the "remediation" field.
+func (_u *ConfigDetectionRuleUpdate) SetRemediation(v string) *ConfigDetectionRuleUpdate {
+	_u.mutation.SetRemediation(v)
+	return _u
+}
+
+// SetNillableRemediation sets the "remediation" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdate) SetNillableRemediation(v *string) *ConfigDetectionRuleUpdate {
+	if v != nil {
+		_u.SetRemediation(*v)
+	}
+	return _u
+}
+
+// ClearRemediation clears the value of the "remediation" field.
+func (_u *ConfigDetectionRuleUpdate) ClearRemediation() *ConfigDetectionRuleUpdate {
+	_u.mutation.ClearRemediation()
+	return _u
+}
+
+// SetQuery sets the "query" field.
+func (_u *ConfigDetectionRuleUpdate) SetQuery(v string) *ConfigDetectionRuleUpdate {
+	_u.mutation.SetQuery(v)
+	return _u
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdate) SetNillableQuery(v *string) *ConfigDetectionRuleUpdate {
+	if v != nil {
+		_u.SetQuery(*v)
+	}
+	return _u
+}
+
+// SetParamsJSON sets the "params_json" field.
+func (_u *ConfigDetectionRuleUpdate) SetParamsJSON(v map[string]interface{}) *ConfigDetectionRuleUpdate {
+	_u.mutation.SetParamsJSON(v)
+	return _u
+}
+
+// ClearParamsJSON clears the value of the "params_json" field.
+func (_u *ConfigDetectionRuleUpdate) ClearParamsJSON() *ConfigDetectionRuleUpdate {
+	_u.mutation.ClearParamsJSON()
+	return _u
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (_u *ConfigDetectionRuleUpdate) SetTimeoutSeconds(v int) *ConfigDetectionRuleUpdate {
+	_u.mutation.ResetTimeoutSeconds()
+	_u.mutation.SetTimeoutSeconds(v)
+	return _u
+}
+
+// SetNillableTimeoutSeconds sets the "timeout_seconds" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdate) SetNillableTimeoutSeconds(v *int) *ConfigDetectionRuleUpdate {
+	if v != nil {
+		_u.SetTimeoutSeconds(*v)
+	}
+	return _u
+}
+
+// AddTimeoutSeconds adds value to the "timeout_seconds" field.
+func (_u *ConfigDetectionRuleUpdate) AddTimeoutSeconds(v int) *ConfigDetectionRuleUpdate {
+	_u.mutation.AddTimeoutSeconds(v)
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *ConfigDetectionRuleUpdate) SetSource(v string) *ConfigDetectionRuleUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdate) SetNillableSource(v *string) *ConfigDetectionRuleUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *ConfigDetectionRuleUpdate) SetIsActive(v bool) *ConfigDetectionRuleUpdate {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdate) SetNillableIsActive(v *bool) *ConfigDetectionRuleUpdate {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConfigDetectionRuleUpdate) SetUpdatedAt(v time.Time) *ConfigDetectionRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdate) SetNillableUpdatedAt(v *time.Time) *ConfigDetectionRuleUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ConfigDetectionRuleMutation object of the builder.
+func (_u *ConfigDetectionRuleUpdate) Mutation() *ConfigDetectionRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ConfigDetectionRuleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConfigDetectionRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ConfigDetectionRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConfigDetectionRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConfigDetectionRuleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := configdetectionrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := configdetectionrule.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.category": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Severity(); ok {
+		if err := configdetectionrule.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.severity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := configdetectionrule.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResourceType(); ok {
+		if err := configdetectionrule.ResourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "resource_type", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.resource_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Query(); ok {
+		if err := configdetectionrule.QueryValidator(v); err != nil {
+			return &ValidationError{Name: "query", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.query": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := configdetectionrule.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ConfigDetectionRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(configdetectionrule.Table, configdetectionrule.Columns, sqlgraph.NewFieldSpec(configdetectionrule.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(configdetectionrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(configdetectionrule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(configdetectionrule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(configdetectionrule.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Severity(); ok {
+		_spec.SetField(configdetectionrule.FieldSeverity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(configdetectionrule.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ResourceType(); ok {
+		_spec.SetField(configdetectionrule.FieldResourceType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Remediation(); ok {
+		_spec.SetField(configdetectionrule.FieldRemediation, field.TypeString, value)
+	}
+	if _u.mutation.RemediationCleared() {
+		_spec.ClearField(configdetectionrule.FieldRemediation, field.TypeString)
+	}
+	if value, ok := _u.mutation.Query(); ok {
+		_spec.SetField(configdetectionrule.FieldQuery, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ParamsJSON(); ok {
+		_spec.SetField(configdetectionrule.FieldParamsJSON, field.TypeJSON, value)
+	}
+	if _u.mutation.ParamsJSONCleared() {
+		_spec.ClearField(configdetectionrule.FieldParamsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TimeoutSeconds(); ok {
+		_spec.SetField(configdetectionrule.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimeoutSeconds(); ok {
+		_spec.AddField(configdetectionrule.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(configdetectionrule.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(configdetectionrule.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(configdetectionrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.ConfigDetectionRule
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{configdetectionrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ConfigDetectionRuleUpdateOne is the builder for updating a single ConfigDetectionRule entity.
+type ConfigDetectionRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConfigDetectionRuleMutation
+}
+
+// SetName sets the "name" field.
+func (_u *ConfigDetectionRuleUpdateOne) SetName(v string) *ConfigDetectionRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdateOne) SetNillableName(v *string) *ConfigDetectionRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ConfigDetectionRuleUpdateOne) SetDescription(v string) *ConfigDetectionRuleUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdateOne) SetNillableDescription(v *string) *ConfigDetectionRuleUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ConfigDetectionRuleUpdateOne) ClearDescription() *ConfigDetectionRuleUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *ConfigDetectionRuleUpdateOne) SetCategory(v string) *ConfigDetectionRuleUpdateOne {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdateOne) SetNillableCategory(v *string) *ConfigDetectionRuleUpdateOne {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetSeverity sets the "severity" field.
+func (_u *ConfigDetectionRuleUpdateOne) SetSeverity(v string) *ConfigDetectionRuleUpdateOne {
+	_u.mutation.SetSeverity(v)
+	return _u
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdateOne) SetNillableSeverity(v *string) *ConfigDetectionRuleUpdateOne {
+	if v != nil {
+		_u.SetSeverity(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *ConfigDetectionRuleUpdateOne) SetProvider(v string) *ConfigDetectionRuleUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdateOne) SetNillableProvider(v *string) *ConfigDetectionRuleUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetResourceType sets the "resource_type" field.
+func (_u *ConfigDetectionRuleUpdateOne) SetResourceType(v string) *ConfigDetectionRuleUpdateOne {
+	_u.mutation.SetResourceType(v)
+	return _u
+}
+
+// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdateOne) SetNillableResourceType(v *string) *ConfigDetectionRuleUpdateOne {
+	if v != nil {
+		_u.SetResourceType(*v)
+	}
+	return _u
+}
+
+// SetRemediation sets the "remediation" field.
+func (_u *ConfigDetectionRuleUpdateOne) SetRemediation(v string) *ConfigDetectionRuleUpdateOne {
+	_u.mutation.SetRemediation(v)
+	return _u
+}
+
+// SetNillableRemediation sets the "remediation" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdateOne) SetNillableRemediation(v *string) *ConfigDetectionRuleUpdateOne {
+	if v != nil {
+		_u.SetRemediation(*v)
+	}
+	return _u
+}
+
+// ClearRemediation clears the value of the "remediation" field.
+func (_u *ConfigDetectionRuleUpdateOne) ClearRemediation() *ConfigDetectionRuleUpdateOne {
+	_u.mutation.ClearRemediation()
+	return _u
+}
+
+// SetQuery sets the "query" field.
+func (_u *ConfigDetectionRuleUpdateOne) SetQuery(v string) *ConfigDetectionRuleUpdateOne {
+	_u.mutation.SetQuery(v)
+	return _u
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdateOne) SetNillableQuery(v *string) *ConfigDetectionRuleUpdateOne {
+	if v != nil {
+		_u.SetQuery(*v)
+	}
+	return _u
+}
+
+// SetParamsJSON sets the "params_json" field.
+func (_u *ConfigDetectionRuleUpdateOne) SetParamsJSON(v map[string]interface{}) *ConfigDetectionRuleUpdateOne {
+	_u.mutation.SetParamsJSON(v)
+	return _u
+}
+
+// ClearParamsJSON clears the value of the "params_json" field.
+func (_u *ConfigDetectionRuleUpdateOne) ClearParamsJSON() *ConfigDetectionRuleUpdateOne {
+	_u.mutation.ClearParamsJSON()
+	return _u
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (_u *ConfigDetectionRuleUpdateOne) SetTimeoutSeconds(v int) *ConfigDetectionRuleUpdateOne {
+	_u.mutation.ResetTimeoutSeconds()
+	_u.mutation.SetTimeoutSeconds(v)
+	return _u
+}
+
+// SetNillableTimeoutSeconds sets the "timeout_seconds" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdateOne) SetNillableTimeoutSeconds(v *int) *ConfigDetectionRuleUpdateOne {
+	if v != nil {
+		_u.SetTimeoutSeconds(*v)
+	}
+	return _u
+}
+
+// AddTimeoutSeconds adds value to the "timeout_seconds" field.
+func (_u *ConfigDetectionRuleUpdateOne) AddTimeoutSeconds(v int) *ConfigDetectionRuleUpdateOne {
+	_u.mutation.AddTimeoutSeconds(v)
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *ConfigDetectionRuleUpdateOne) SetSource(v string) *ConfigDetectionRuleUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdateOne) SetNillableSource(v *string) *ConfigDetectionRuleUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *ConfigDetectionRuleUpdateOne) SetIsActive(v bool) *ConfigDetectionRuleUpdateOne {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdateOne) SetNillableIsActive(v *bool) *ConfigDetectionRuleUpdateOne {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConfigDetectionRuleUpdateOne) SetUpdatedAt(v time.Time) *ConfigDetectionRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ConfigDetectionRuleUpdateOne) SetNillableUpdatedAt(v *time.Time) *ConfigDetectionRuleUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ConfigDetectionRuleMutation object of the builder.
+func (_u *ConfigDetectionRuleUpdateOne) Mutation() *ConfigDetectionRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ConfigDetectionRuleUpdate builder.
+func (_u *ConfigDetectionRuleUpdateOne) Where(ps ...predicate.ConfigDetectionRule) *ConfigDetectionRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ConfigDetectionRuleUpdateOne) Select(field string, fields ...string) *ConfigDetectionRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ConfigDetectionRule entity.
+func (_u *ConfigDetectionRuleUpdateOne) Save(ctx context.Context) (*ConfigDetectionRule, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConfigDetectionRuleUpdateOne) SaveX(ctx context.Context) *ConfigDetectionRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ConfigDetectionRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConfigDetectionRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConfigDetectionRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := configdetectionrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := configdetectionrule.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.category": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Severity(); ok {
+		if err := configdetectionrule.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.severity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := configdetectionrule.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResourceType(); ok {
+		if err := configdetectionrule.ResourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "resource_type", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.resource_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Query(); ok {
+		if err := configdetectionrule.QueryValidator(v); err != nil {
+			return &ValidationError{Name: "query", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.query": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := configdetectionrule.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`rule: validator failed for field "ConfigDetectionRule.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ConfigDetectionRuleUpdateOne) sqlSave(ctx context.Context) (_node *ConfigDetectionRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(configdetectionrule.Table, configdetectionrule.Columns, sqlgraph.NewFieldSpec(configdetectionrule.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`rule: missing "ConfigDetectionRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, configdetectionrule.FieldID)
+		for _, f := range fields {
+			if !configdetectionrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("rule: invalid field %q for query", f)}
+			}
+			if f != configdetectionrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(configdetectionrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(configdetectionrule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(configdetectionrule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(configdetectionrule.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Severity(); ok {
+		_spec.SetField(configdetectionrule.FieldSeverity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(configdetectionrule.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ResourceType(); ok {
+		_spec.SetField(configdetectionrule.FieldResourceType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Remediation(); ok {
+		_spec.SetField(configdetectionrule.FieldRemediation, field.TypeString, value)
+	}
+	if _u.mutation.RemediationCleared() {
+		_spec.ClearField(configdetectionrule.FieldRemediation, field.TypeString)
+	}
+	if value, ok := _u.mutation.Query(); ok {
+		_spec.SetField(configdetectionrule.FieldQuery, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ParamsJSON(); ok {
+		_spec.SetField(configdetectionrule.FieldParamsJSON, field.TypeJSON, value)
+	}
+	if _u.mutation.ParamsJSONCleared() {
+		_spec.ClearField(configdetectionrule.FieldParamsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TimeoutSeconds(); ok {
+		_spec.SetField(configdetectionrule.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimeoutSeconds(); ok {
+		_spec.AddField(configdetectionrule.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(configdetectionrule.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(configdetectionrule.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(configdetectionrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.ConfigDetectionRule
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &ConfigDetectionRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{configdetectionrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
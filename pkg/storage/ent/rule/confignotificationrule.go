@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rule
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/rule/confignotificationrule"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ConfigNotificationRule is the model entity for the ConfigNotificationRule schema.
+type ConfigNotificationRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Code-facing identifier, e.g. httpmonitor_critical_to_soc
+	RuleKey string `json:"rule_key,omitempty"`
+	// Human-readable rule name
+	Name string `json:"name,omitempty"`
+	// Finding sources: httpmonitor, lifecycle_software, lifecycle_os
+	Sources []string `json:"sources,omitempty"`
+	// critical, high, medium, low, info
+	Severities []string `json:"severities,omitempty"`
+	// anomaly_type for httpmonitor, eol_status for lifecycle
+	AnomalyTypes []string `json:"anomaly_types,omitempty"`
+	// httpmonitor source_id or lifecycle machine_id
+	SourceIds []string `json:"source_ids,omitempty"`
+	// Destination names from notify.destinations config
+	Destinations []string `json:"destinations,omitempty"`
+	// Do not resend the same finding within this window; 0 sends once
+	DedupMinutes int `json:"dedup_minutes,omitempty"`
+	// Max notifications sent by this rule per hour; 0 disables the limit
+	RateLimitPerHour int `json:"rate_limit_per_hour,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConfigNotificationRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case confignotificationrule.FieldSources, confignotificationrule.FieldSeverities, confignotificationrule.FieldAnomalyTypes, confignotificationrule.FieldSourceIds, confignotificationrule.FieldDestinations:
+			values[i] = new([]byte)
+		case confignotificationrule.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case confignotificationrule.FieldID, confignotificationrule.FieldDedupMinutes, confignotificationrule.FieldRateLimitPerHour:
+			values[i] = new(sql.NullInt64)
+		case confignotificationrule.FieldRuleKey, confignotificationrule.FieldName:
+			values[i] = new(sql.NullString)
+		case confignotificationrule.FieldCreatedAt, confignotificationrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConfigNotificationRule fields.
+func (_m *ConfigNotificationRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case confignotificationrule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case confignotificationrule.FieldRuleKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_key", values[i])
+			} else if value.Valid {
+				_m.RuleKey = value.String
+			}
+		case confignotificationrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case confignotificationrule.FieldSources:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field sources", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Sources); err != nil {
+					return fmt.Errorf("unmarshal field sources: %w", err)
+				}
+			}
+		case confignotificationrule.FieldSeverities:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field severities", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Severities); err != nil {
+					return fmt.Errorf("unmarshal field severities: %w", err)
+				}
+			}
+		case confignotificationrule.FieldAnomalyTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field anomaly_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AnomalyTypes); err != nil {
+					return fmt.Errorf("unmarshal field anomaly_types: %w", err)
+				}
+			}
+		case confignotificationrule.FieldSourceIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field source_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SourceIds); err != nil {
+					return fmt.Errorf("unmarshal field source_ids: %w", err)
+				}
+			}
+		case confignotificationrule.FieldDestinations:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field destinations", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Destinations); err != nil {
+					return fmt.Errorf("unmarshal field destinations: %w", err)
+				}
+			}
+		case confignotificationrule.FieldDedupMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field dedup_minutes", values[i])
+			} else if value.Valid {
+				_m.DedupMinutes = int(value.Int64)
+			}
+		case confignotificationrule.FieldRateLimitPerHour:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_limit_per_hour", values[i])
+			} else if value.Valid {
+				_m.RateLimitPerHour = int(value.Int64)
+			}
+		case confignotificationrule.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				_m.IsActive = value.Bool
+			}
+		case confignotificationrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case confignotificationrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ConfigNotificationRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *ConfigNotificationRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ConfigNotificationRule.
+// Note that you need to call ConfigNotificationRule.Unwrap() before calling this method if this ConfigNotificationRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ConfigNotificationRule) Update() *ConfigNotificationRuleUpdateOne {
+	return NewConfigNotificationRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ConfigNotificationRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ConfigNotificationRule) Unwrap() *ConfigNotificationRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("rule: ConfigNotificationRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ConfigNotificationRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConfigNotificationRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("rule_key=")
+	builder.WriteString(_m.RuleKey)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("sources=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sources))
+	builder.WriteString(", ")
+	builder.WriteString("severities=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Severities))
+	builder.WriteString(", ")
+	builder.WriteString("anomaly_types=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AnomalyTypes))
+	builder.WriteString(", ")
+	builder.WriteString("source_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SourceIds))
+	builder.WriteString(", ")
+	builder.WriteString("destinations=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Destinations))
+	builder.WriteString(", ")
+	builder.WriteString("dedup_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DedupMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("rate_limit_per_hour=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RateLimitPerHour))
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConfigNotificationRules is a parsable slice of ConfigNotificationRule.
+type ConfigNotificationRules []*ConfigNotificationRule
@@ -0,0 +1,121 @@
+// Code generated by ent, DO NOT EDIT.
+
+package confignotificationrule
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the confignotificationrule type in the database.
+	Label = "config_notification_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "rule_id"
+	// FieldRuleKey holds the string denoting the rule_key field in the database.
+	FieldRuleKey = "rule_key"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldSources holds the string denoting the sources field in the database.
+	FieldSources = "sources"
+	// FieldSeverities holds the string denoting the severities field in the database.
+	FieldSeverities = "severities"
+	// FieldAnomalyTypes holds the string denoting the anomaly_types field in the database.
+	FieldAnomalyTypes = "anomaly_types"
+	// FieldSourceIds holds the string denoting the source_ids field in the database.
+	FieldSourceIds = "source_ids"
+	// FieldDestinations holds the string denoting the destinations field in the database.
+	FieldDestinations = "destinations"
+	// FieldDedupMinutes holds the string denoting the dedup_minutes field in the database.
+	FieldDedupMinutes = "dedup_minutes"
+	// FieldRateLimitPerHour holds the string denoting the rate_limit_per_hour field in the database.
+	FieldRateLimitPerHour = "rate_limit_per_hour"
+	// FieldIsActive holds the string denoting the is_active field in the database.
+	FieldIsActive = "is_active"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the confignotificationrule in the database.
+	Table = "notification_rules"
+)
+
+// Columns holds all SQL columns for confignotificationrule fields.
+var Columns = []string{
+	FieldID,
+	FieldRuleKey,
+	FieldName,
+	FieldSources,
+	FieldSeverities,
+	FieldAnomalyTypes,
+	FieldSourceIds,
+	FieldDestinations,
+	FieldDedupMinutes,
+	FieldRateLimitPerHour,
+	FieldIsActive,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultDedupMinutes holds the default value on creation for the "dedup_minutes" field.
+	DefaultDedupMinutes int
+	// DefaultRateLimitPerHour holds the default value on creation for the "rate_limit_per_hour" field.
+	DefaultRateLimitPerHour int
+	// DefaultIsActive holds the default value on creation for the "is_active" field.
+	DefaultIsActive bool
+)
+
+// OrderOption defines the ordering options for the ConfigNotificationRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRuleKey orders the results by the rule_key field.
+func ByRuleKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRuleKey, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDedupMinutes orders the results by the dedup_minutes field.
+func ByDedupMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDedupMinutes, opts...).ToFunc()
+}
+
+// ByRateLimitPerHour orders the results by the rate_limit_per_hour field.
+func ByRateLimitPerHour(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRateLimitPerHour, opts...).ToFunc()
+}
+
+// ByIsActive orders the results by the is_active field.
+func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,445 @@
+// Code generated by ent, DO NOT EDIT.
+
+package confignotificationrule
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/rule/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldLTE(FieldID, id))
+}
+
+// RuleKey applies equality check predicate on the "rule_key" field. It's identical to RuleKeyEQ.
+func RuleKey(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldEQ(FieldRuleKey, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldEQ(FieldName, v))
+}
+
+// DedupMinutes applies equality check predicate on the "dedup_minutes" field. It's identical to DedupMinutesEQ.
+func DedupMinutes(v int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldEQ(FieldDedupMinutes, v))
+}
+
+// RateLimitPerHour applies equality check predicate on the "rate_limit_per_hour" field. It's identical to RateLimitPerHourEQ.
+func RateLimitPerHour(v int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldEQ(FieldRateLimitPerHour, v))
+}
+
+// IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
+func IsActive(v bool) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldEQ(FieldIsActive, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// RuleKeyEQ applies the EQ predicate on the "rule_key" field.
+func RuleKeyEQ(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldEQ(FieldRuleKey, v))
+}
+
+// RuleKeyNEQ applies the NEQ predicate on the "rule_key" field.
+func RuleKeyNEQ(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldNEQ(FieldRuleKey, v))
+}
+
+// RuleKeyIn applies the In predicate on the "rule_key" field.
+func RuleKeyIn(vs ...string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldIn(FieldRuleKey, vs...))
+}
+
+// RuleKeyNotIn applies the NotIn predicate on the "rule_key" field.
+func RuleKeyNotIn(vs ...string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldNotIn(FieldRuleKey, vs...))
+}
+
+// RuleKeyGT applies the GT predicate on the "rule_key" field.
+func RuleKeyGT(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldGT(FieldRuleKey, v))
+}
+
+// RuleKeyGTE applies the GTE predicate on the "rule_key" field.
+func RuleKeyGTE(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldGTE(FieldRuleKey, v))
+}
+
+// RuleKeyLT applies the LT predicate on the "rule_key" field.
+func RuleKeyLT(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldLT(FieldRuleKey, v))
+}
+
+// RuleKeyLTE applies the LTE predicate on the "rule_key" field.
+func RuleKeyLTE(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldLTE(FieldRuleKey, v))
+}
+
+// RuleKeyContains applies the Contains predicate on the "rule_key" field.
+func RuleKeyContains(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldContains(FieldRuleKey, v))
+}
+
+// RuleKeyHasPrefix applies the HasPrefix predicate on the "rule_key" field.
+func RuleKeyHasPrefix(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldHasPrefix(FieldRuleKey, v))
+}
+
+// RuleKeyHasSuffix applies the HasSuffix predicate on the "rule_key" field.
+func RuleKeyHasSuffix(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldHasSuffix(FieldRuleKey, v))
+}
+
+// RuleKeyEqualFold applies the EqualFold predicate on the "rule_key" field.
+func RuleKeyEqualFold(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldEqualFold(FieldRuleKey, v))
+}
+
+// RuleKeyContainsFold applies the ContainsFold predicate on the "rule_key" field.
+func RuleKeyContainsFold(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldContainsFold(FieldRuleKey, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// SourcesIsNil applies the IsNil predicate on the "sources" field.
+func SourcesIsNil() predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldIsNull(FieldSources))
+}
+
+// SourcesNotNil applies the NotNil predicate on the "sources" field.
+func SourcesNotNil() predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldNotNull(FieldSources))
+}
+
+// SeveritiesIsNil applies the IsNil predicate on the "severities" field.
+func SeveritiesIsNil() predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldIsNull(FieldSeverities))
+}
+
+// SeveritiesNotNil applies the NotNil predicate on the "severities" field.
+func SeveritiesNotNil() predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldNotNull(FieldSeverities))
+}
+
+// AnomalyTypesIsNil applies the IsNil predicate on the "anomaly_types" field.
+func AnomalyTypesIsNil() predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldIsNull(FieldAnomalyTypes))
+}
+
+// AnomalyTypesNotNil applies the NotNil predicate on the "anomaly_types" field.
+func AnomalyTypesNotNil() predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldNotNull(FieldAnomalyTypes))
+}
+
+// SourceIdsIsNil applies the IsNil predicate on the "source_ids" field.
+func SourceIdsIsNil() predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldIsNull(FieldSourceIds))
+}
+
+// SourceIdsNotNil applies the NotNil predicate on the "source_ids" field.
+func SourceIdsNotNil() predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldNotNull(FieldSourceIds))
+}
+
+// DedupMinutesEQ applies the EQ predicate on the "dedup_minutes" field.
+func DedupMinutesEQ(v int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldEQ(FieldDedupMinutes, v))
+}
+
+// DedupMinutesNEQ applies the NEQ predicate on the "dedup_minutes" field.
+func DedupMinutesNEQ(v int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldNEQ(FieldDedupMinutes, v))
+}
+
+// DedupMinutesIn applies the In predicate on the "dedup_minutes" field.
+func DedupMinutesIn(vs ...int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldIn(FieldDedupMinutes, vs...))
+}
+
+// DedupMinutesNotIn applies the NotIn predicate on the "dedup_minutes" field.
+func DedupMinutesNotIn(vs ...int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldNotIn(FieldDedupMinutes, vs...))
+}
+
+// DedupMinutesGT applies the GT predicate on the "dedup_minutes" field.
+func DedupMinutesGT(v int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldGT(FieldDedupMinutes, v))
+}
+
+// DedupMinutesGTE applies the GTE predicate on the "dedup_minutes" field.
+func DedupMinutesGTE(v int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldGTE(FieldDedupMinutes, v))
+}
+
+// DedupMinutesLT applies the LT predicate on the "dedup_minutes" field.
+func DedupMinutesLT(v int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldLT(FieldDedupMinutes, v))
+}
+
+// DedupMinutesLTE applies the LTE predicate on the "dedup_minutes" field.
+func DedupMinutesLTE(v int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldLTE(FieldDedupMinutes, v))
+}
+
+// RateLimitPerHourEQ applies the EQ predicate on the "rate_limit_per_hour" field.
+func RateLimitPerHourEQ(v int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldEQ(FieldRateLimitPerHour, v))
+}
+
+// RateLimitPerHourNEQ applies the NEQ predicate on the "rate_limit_per_hour" field.
+func RateLimitPerHourNEQ(v int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldNEQ(FieldRateLimitPerHour, v))
+}
+
+// RateLimitPerHourIn applies the In predicate on the "rate_limit_per_hour" field.
+func RateLimitPerHourIn(vs ...int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldIn(FieldRateLimitPerHour, vs...))
+}
+
+// RateLimitPerHourNotIn applies the NotIn predicate on the "rate_limit_per_hour" field.
+func RateLimitPerHourNotIn(vs ...int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldNotIn(FieldRateLimitPerHour, vs...))
+}
+
+// RateLimitPerHourGT applies the GT predicate on the "rate_limit_per_hour" field.
+func RateLimitPerHourGT(v int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldGT(FieldRateLimitPerHour, v))
+}
+
+// RateLimitPerHourGTE applies the GTE predicate on the "rate_limit_per_hour" field.
+func RateLimitPerHourGTE(v int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldGTE(FieldRateLimitPerHour, v))
+}
+
+// RateLimitPerHourLT applies the LT predicate on the "rate_limit_per_hour" field.
+func RateLimitPerHourLT(v int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldLT(FieldRateLimitPerHour, v))
+}
+
+// RateLimitPerHourLTE applies the LTE predicate on the "rate_limit_per_hour" field.
+func RateLimitPerHourLTE(v int) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldLTE(FieldRateLimitPerHour, v))
+}
+
+// IsActiveEQ applies the EQ predicate on the "is_active" field.
+func IsActiveEQ(v bool) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsActiveNEQ applies the NEQ predicate on the "is_active" field.
+func IsActiveNEQ(v bool) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldNEQ(FieldIsActive, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConfigNotificationRule) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConfigNotificationRule) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConfigNotificationRule) predicate.ConfigNotificationRule {
+	return predicate.ConfigNotificationRule(sql.NotPredicates(p))
+}
@@ -0,0 +1,375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/rule/confignotificationrule"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConfigNotificationRuleCreate is the builder for creating a ConfigNotificationRule entity.
+type ConfigNotificationRuleCreate struct {
+	config
+	mutation *ConfigNotificationRuleMutation
+	hooks    []Hook
+}
+
+// SetRuleKey sets the "rule_key" field.
+func (_c *ConfigNotificationRuleCreate) SetRuleKey(v string) *ConfigNotificationRuleCreate {
+	_c.mutation.SetRuleKey(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ConfigNotificationRuleCreate) SetName(v string) *ConfigNotificationRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetSources sets the "sources" field.
+func (_c *ConfigNotificationRuleCreate) SetSources(v []string) *ConfigNotificationRuleCreate {
+	_c.mutation.SetSources(v)
+	return _c
+}
+
+// SetSeverities sets the "severities" field.
+func (_c *ConfigNotificationRuleCreate) SetSeverities(v []string) *ConfigNotificationRuleCreate {
+	_c.mutation.SetSeverities(v)
+	return _c
+}
+
+// SetAnomalyTypes sets the "anomaly_types" field.
+func (_c *ConfigNotificationRuleCreate) SetAnomalyTypes(v []string) *ConfigNotificationRuleCreate {
+	_c.mutation.SetAnomalyTypes(v)
+	return _c
+}
+
+// SetSourceIds sets the "source_ids" field.
+func (_c *ConfigNotificationRuleCreate) SetSourceIds(v []string) *ConfigNotificationRuleCreate {
+	_c.mutation.SetSourceIds(v)
+	return _c
+}
+
+// SetDestinations sets the "destinations" field.
+func (_c *ConfigNotificationRuleCreate) SetDestinations(v []string) *ConfigNotificationRuleCreate {
+	_c.mutation.SetDestinations(v)
+	return _c
+}
+
+// SetDedupMinutes sets the "dedup_minutes" field.
+func (_c *ConfigNotificationRuleCreate) SetDedupMinutes(v int) *ConfigNotificationRuleCreate {
+	_c.mutation.SetDedupMinutes(v)
+	return _c
+}
+
+// SetNillableDedupMinutes sets the "dedup_minutes" field if the given value is not nil.
+func (_c *ConfigNotificationRuleCreate) SetNillableDedupMinutes(v *int) *ConfigNotificationRuleCreate {
+	if v != nil {
+		_c.SetDedupMinutes(*v)
+	}
+	return _c
+}
+
+// SetRateLimitPerHour sets the "rate_limit_per_hour" field.
+func (_c *ConfigNotificationRuleCreate) SetRateLimitPerHour(v int) *ConfigNotificationRuleCreate {
+	_c.mutation.SetRateLimitPerHour(v)
+	return _c
+}
+
+// SetNillableRateLimitPerHour sets the "rate_limit_per_hour" field if the given value is not nil.
+func (_c *ConfigNotificationRuleCreate) SetNillableRateLimitPerHour(v *int) *ConfigNotificationRuleCreate {
+	if v != nil {
+		_c.SetRateLimitPerHour(*v)
+	}
+	return _c
+}
+
+// SetIsActive sets the "is_active" field.
+func (_c *ConfigNotificationRuleCreate) SetIsActive(v bool) *ConfigNotificationRuleCreate {
+	_c.mutation.SetIsActive(v)
+	return _c
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_c *ConfigNotificationRuleCreate) SetNillableIsActive(v *bool) *ConfigNotificationRuleCreate {
+	if v != nil {
+		_c.SetIsActive(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ConfigNotificationRuleCreate) SetCreatedAt(v time.Time) *ConfigNotificationRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ConfigNotificationRuleCreate) SetUpdatedAt(v time.Time) *ConfigNotificationRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ConfigNotificationRuleCreate) SetID(v int) *ConfigNotificationRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ConfigNotificationRuleMutation object of the builder.
+func (_c *ConfigNotificationRuleCreate) Mutation() *ConfigNotificationRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the ConfigNotificationRule in the database.
+func (_c *ConfigNotificationRuleCreate) Save(ctx context.Context) (*ConfigNotificationRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConfigNotificationRuleCreate) SaveX(ctx context.Context) *ConfigNotificationRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConfigNotificationRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConfigNotificationRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ConfigNotificationRuleCreate) defaults() {
+	if _, ok := _c.mutation.DedupMinutes(); !ok {
+		v := confignotificationrule.DefaultDedupMinutes
+		_c.mutation.SetDedupMinutes(v)
+	}
+	if _, ok := _c.mutation.RateLimitPerHour(); !ok {
+		v := confignotificationrule.DefaultRateLimitPerHour
+		_c.mutation.SetRateLimitPerHour(v)
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		v := confignotificationrule.DefaultIsActive
+		_c.mutation.SetIsActive(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConfigNotificationRuleCreate) check() error {
+	if _, ok := _c.mutation.RuleKey(); !ok {
+		return &ValidationError{Name: "rule_key", err: errors.New(`rule: missing required field "ConfigNotificationRule.rule_key"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`rule: missing required field "ConfigNotificationRule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := confignotificationrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`rule: validator failed for field "ConfigNotificationRule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Destinations(); !ok {
+		return &ValidationError{Name: "destinations", err: errors.New(`rule: missing required field "ConfigNotificationRule.destinations"`)}
+	}
+	if _, ok := _c.mutation.DedupMinutes(); !ok {
+		return &ValidationError{Name: "dedup_minutes", err: errors.New(`rule: missing required field "ConfigNotificationRule.dedup_minutes"`)}
+	}
+	if _, ok := _c.mutation.RateLimitPerHour(); !ok {
+		return &ValidationError{Name: "rate_limit_per_hour", err: errors.New(`rule: missing required field "ConfigNotificationRule.rate_limit_per_hour"`)}
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`rule: missing required field "ConfigNotificationRule.is_active"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`rule: missing required field "ConfigNotificationRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`rule: missing required field "ConfigNotificationRule.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ConfigNotificationRuleCreate) sqlSave(ctx context.Context) (*ConfigNotificationRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConfigNotificationRuleCreate) createSpec() (*ConfigNotificationRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConfigNotificationRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(confignotificationrule.Table, sqlgraph.NewFieldSpec(confignotificationrule.FieldID, field.TypeInt))
+	)
+	_spec.Schema = _c.schemaConfig.ConfigNotificationRule
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.RuleKey(); ok {
+		_spec.SetField(confignotificationrule.FieldRuleKey, field.TypeString, value)
+		_node.RuleKey = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(confignotificationrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Sources(); ok {
+		_spec.SetField(confignotificationrule.FieldSources, field.TypeJSON, value)
+		_node.Sources = value
+	}
+	if value, ok := _c.mutation.Severities(); ok {
+		_spec.SetField(confignotificationrule.FieldSeverities, field.TypeJSON, value)
+		_node.Severities = value
+	}
+	if value, ok := _c.mutation.AnomalyTypes(); ok {
+		_spec.SetField(confignotificationrule.FieldAnomalyTypes, field.TypeJSON, value)
+		_node.AnomalyTypes = value
+	}
+	if value, ok := _c.mutation.SourceIds(); ok {
+		_spec.SetField(confignotificationrule.FieldSourceIds, field.TypeJSON, value)
+		_node.SourceIds = value
+	}
+	if value, ok := _c.mutation.Destinations(); ok {
+		_spec.SetField(confignotificationrule.FieldDestinations, field.TypeJSON, value)
+		_node.Destinations = value
+	}
+	if value, ok := _c.mutation.DedupMinutes(); ok {
+		_spec.SetField(confignotificationrule.FieldDedupMinutes, field.TypeInt, value)
+		_node.DedupMinutes = value
+	}
+	if value, ok := _c.mutation.RateLimitPerHour(); ok {
+		_spec.SetField(confignotificationrule.FieldRateLimitPerHour, field.TypeInt, value)
+		_node.RateLimitPerHour = value
+	}
+	if value, ok := _c.mutation.IsActive(); ok {
+		_spec.SetField(confignotificationrule.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(confignotificationrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(confignotificationrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ConfigNotificationRuleCreateBulk is the builder for creating many ConfigNotificationRule entities in bulk.
+type ConfigNotificationRuleCreateBulk struct {
+	config
+	err      error
+	builders []*ConfigNotificationRuleCreate
+}
+
+// Save creates the ConfigNotificationRule entities in the database.
+func (_c *ConfigNotificationRuleCreateBulk) Save(ctx context.Context) ([]*ConfigNotificationRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ConfigNotificationRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConfigNotificationRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConfigNotificationRuleCreateBulk) SaveX(ctx context.Context) []*ConfigNotificationRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConfigNotificationRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConfigNotificationRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
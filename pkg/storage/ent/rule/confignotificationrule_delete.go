@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rule
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/rule/confignotificationrule"
+	"danny.vn/hotpot/pkg/storage/ent/rule/internal"
+	"danny.vn/hotpot/pkg/storage/ent/rule/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConfigNotificationRuleDelete is the builder for deleting a ConfigNotificationRule entity.
+type ConfigNotificationRuleDelete struct {
+	config
+	hooks    []Hook
+	mutation *ConfigNotificationRuleMutation
+}
+
+// Where appends a list predicates to the ConfigNotificationRuleDelete builder.
+func (_d *ConfigNotificationRuleDelete) Where(ps ...predicate.ConfigNotificationRule) *ConfigNotificationRuleDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ConfigNotificationRuleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ConfigNotificationRuleDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ConfigNotificationRuleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(confignotificationrule.Table, sqlgraph.NewFieldSpec(confignotificationrule.FieldID, field.TypeInt))
+	_spec.Node.Schema = _d.schemaConfig.ConfigNotificationRule
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ConfigNotificationRuleDeleteOne is the builder for deleting a single ConfigNotificationRule entity.
+type ConfigNotificationRuleDeleteOne struct {
+	_d *ConfigNotificationRuleDelete
+}
+
+// Where appends a list predicates to the ConfigNotificationRuleDelete builder.
+func (_d *ConfigNotificationRuleDeleteOne) Where(ps ...predicate.ConfigNotificationRule) *ConfigNotificationRuleDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ConfigNotificationRuleDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{confignotificationrule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ConfigNotificationRuleDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/rule/confignotificationrule"
+	"danny.vn/hotpot/pkg/storage/ent/rule/internal"
+	"danny.vn/hotpot/pkg/storage/ent/rule/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// ConfigNotificationRuleUpdate is the builder for updating ConfigNotificationRule entities.
+type ConfigNotificationRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConfigNotificationRuleMutation
+}
+
+// Where appends a list predicates to the ConfigNotificationRuleUpdate builder.
+func (_u *ConfigNotificationRuleUpdate) Where(ps ...predicate.ConfigNotificationRule) *ConfigNotificationRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ConfigNotificationRuleUpdate) SetName(v string) *ConfigNotificationRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ConfigNotificationRuleUpdate) SetNillableName(v *string) *ConfigNotificationRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSources sets the "sources" field.
+func (_u *ConfigNotificationRuleUpdate) SetSources(v []string) *ConfigNotificationRuleUpdate {
+	_u.mutation.SetSources(v)
+	return _u
+}
+
+// AppendSources appends value to the "sources" field.
+func (_u *ConfigNotificationRuleUpdate) AppendSources(v []string) *ConfigNotificationRuleUpdate {
+	_u.mutation.AppendSources(v)
+	return _u
+}
+
+// ClearSources clears the value of the "sources" field.
+func (_u *ConfigNotificationRuleUpdate) ClearSources() *ConfigNotificationRuleUpdate {
+	_u.mutation.ClearSources()
+	return _u
+}
+
+// SetSeverities sets the "severities" field.
+func (_u *ConfigNotificationRuleUpdate) SetSeverities(v []string) *ConfigNotificationRuleUpdate {
+	_u.mutation.SetSeverities(v)
+	return _u
+}
+
+// AppendSeverities appends value to the "severities" field.
+func (_u *ConfigNotificationRuleUpdate) AppendSeverities(v []string) *ConfigNotificationRuleUpdate {
+	_u.mutation.AppendSeverities(v)
+	return _u
+}
+
+// ClearSeverities clears the value of the "severities" field.
+func (_u *ConfigNotificationRuleUpdate) ClearSeverities() *ConfigNotificationRuleUpdate {
+	_u.mutation.ClearSeverities()
+	return _u
+}
+
+// SetAnomalyTypes sets the "anomaly_types" field.
+func (_u *ConfigNotificationRuleUpdate) SetAnomalyTypes(v []string) *ConfigNotificationRuleUpdate {
+	_u.mutation.SetAnomalyTypes(v)
+	return _u
+}
+
+// AppendAnomalyTypes appends value to the "anomaly_types" field.
+func (_u *ConfigNotificationRuleUpdate) AppendAnomalyTypes(v []string) *ConfigNotificationRuleUpdate {
+	_u.mutation.AppendAnomalyTypes(v)
+	return _u
+}
+
+// ClearAnomalyTypes clears the value of the "anomaly_types" field.
+func (_u *ConfigNotificationRuleUpdate) ClearAnomalyTypes() *ConfigNotificationRuleUpdate {
+	_u.mutation.ClearAnomalyTypes()
+	return _u
+}
+
+// SetSourceIds sets the "source_ids" field.
+func (_u *ConfigNotificationRuleUpdate) SetSourceIds(v []string) *ConfigNotificationRuleUpdate {
+	_u.mutation.SetSourceIds(v)
+	return _u
+}
+
+// AppendSourceIds appends value to the "source_ids" field.
+func (_u *ConfigNotificationRuleUpdate) AppendSourceIds(v []string) *ConfigNotificationRuleUpdate {
+	_u.mutation.AppendSourceIds(v)
+	return _u
+}
+
+// ClearSourceIds clears the value of the "source_ids" field.
+func (_u *ConfigNotificationRuleUpdate) ClearSourceIds() *ConfigNotificationRuleUpdate {
+	_u.mutation.ClearSourceIds()
+	return _u
+}
+
+// SetDestinations sets the "destinations" field.
+func (_u *ConfigNotificationRuleUpdate) SetDestinations(v []string) *ConfigNotificationRuleUpdate {
+	_u.mutation.SetDestinations(v)
+	return _u
+}
+
+// AppendDestinations appends value to the "destinations" field.
+func (_u *ConfigNotificationRuleUpdate) AppendDestinations(v []string) *ConfigNotificationRuleUpdate {
+	_u.mutation.AppendDestinations(v)
+	return _u
+}
+
+// SetDedupMinutes sets the "dedup_minutes" field.
+func (_u *ConfigNotificationRuleUpdate) SetDedupMinutes(v int) *ConfigNotificationRuleUpdate {
+	_u.mutation.ResetDedupMinutes()
+	_u.mutation.SetDedupMinutes(v)
+	return _u
+}
+
+// SetNillableDedupMinutes sets the "dedup_minutes" field if the given value is not nil.
+func (_u *ConfigNotificationRuleUpdate) SetNillableDedupMinutes(v *int) *ConfigNotificationRuleUpdate {
+	if v != nil {
+		_u.SetDedupMinutes(*v)
+	}
+	return _u
+}
+
+// AddDedupMinutes adds value to the "dedup_minutes" field.
+func (_u *ConfigNotificationRuleUpdate) AddDedupMinutes(v int) *ConfigNotificationRuleUpdate {
+	_u.mutation.AddDedupMinutes(v)
+	return _u
+}
+
+// SetRateLimitPerHour sets the "rate_limit_per_hour" field.
+func (_u *ConfigNotificationRuleUpdate) SetRateLimitPerHour(v int) *ConfigNotificationRuleUpdate {
+	_u.mutation.ResetRateLimitPerHour()
+	_u.mutation.SetRateLimitPerHour(v)
+	return _u
+}
+
+// SetNillableRateLimitPerHour sets the "rate_limit_per_hour" field if the given value is not nil.
+func (_u *ConfigNotificationRuleUpdate) SetNillableRateLimitPerHour(v *int) *ConfigNotificationRuleUpdate {
+	if v != nil {
+		_u.SetRateLimitPerHour(*v)
+	}
+	return _u
+}
+
+// AddRateLimitPerHour adds value to the "rate_limit_per_hour" field.
+func (_u *ConfigNotificationRuleUpdate) AddRateLimitPerHour(v int) *ConfigNotificationRuleUpdate {
+	_u.mutation.AddRateLimitPerHour(v)
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *ConfigNotificationRuleUpdate) SetIsActive(v bool) *ConfigNotificationRuleUpdate {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *ConfigNotificationRuleUpdate) SetNillableIsActive(v *bool) *ConfigNotificationRuleUpdate {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConfigNotificationRuleUpdate) SetUpdatedAt(v time.Time) *ConfigNotificationRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ConfigNotificationRuleUpdate) SetNillableUpdatedAt(v *time.Time) *ConfigNotificationRuleUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ConfigNotificationRuleMutation object of the builder.
+func (_u *ConfigNotificationRuleUpdate) Mutation() *ConfigNotificationRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ConfigNotificationRuleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConfigNotificationRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ConfigNotificationRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConfigNotificationRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConfigNotificationRuleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := confignotificationrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`rule: validator failed for field "ConfigNotificationRule.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ConfigNotificationRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(confignotificationrule.Table, confignotificationrule.Columns, sqlgraph.NewFieldSpec(confignotificationrule.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(confignotificationrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Sources(); ok {
+		_spec.SetField(confignotificationrule.FieldSources, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSources(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, confignotificationrule.FieldSources, value)
+		})
+	}
+	if _u.mutation.SourcesCleared() {
+		_spec.ClearField(confignotificationrule.FieldSources, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Severities(); ok {
+		_spec.SetField(confignotificationrule.FieldSeverities, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSeverities(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, confignotificationrule.FieldSeverities, value)
+		})
+	}
+	if _u.mutation.SeveritiesCleared() {
+		_spec.ClearField(confignotificationrule.FieldSeverities, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AnomalyTypes(); ok {
+		_spec.SetField(confignotificationrule.FieldAnomalyTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAnomalyTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, confignotificationrule.FieldAnomalyTypes, value)
+		})
+	}
+	if _u.mutation.AnomalyTypesCleared() {
+		_spec.ClearField(confignotificationrule.FieldAnomalyTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SourceIds(); ok {
+		_spec.SetField(confignotificationrule.FieldSourceIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSourceIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, confignotificationrule.FieldSourceIds, value)
+		})
+	}
+	if _u.mutation.SourceIdsCleared() {
+		_spec.ClearField(confignotificationrule.FieldSourceIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Destinations(); ok {
+		_spec.SetField(confignotificationrule.FieldDestinations, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDestinations(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, confignotificationrule.FieldDestinations, value)
+		})
+	}
+	if value, ok := _u.mutation.DedupMinutes(); ok {
+		_spec.SetField(confignotificationrule.FieldDedupMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDedupMinutes(); ok {
+		_spec.AddField(confignotificationrule.FieldDedupMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RateLimitPerHour(); ok {
+		_spec.SetField(confignotificationrule.FieldRateLimitPerHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRateLimitPerHour(); ok {
+		_spec.AddField(confignotificationrule.FieldRateLimitPerHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(confignotificationrule.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(confignotificationrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.ConfigNotificationRule
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{confignotificationrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ConfigNotificationRuleUpdateOne is the builder for updating a single ConfigNotificationRule entity.
+type ConfigNotificationRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConfigNotificationRuleMutation
+}
+
+// SetName sets the "name" field.
+func (_u *ConfigNotificationRuleUpdateOne) SetName(v string) *ConfigNotificationRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ConfigNotificationRuleUpdateOne) SetNillableName(v *string) *ConfigNotificationRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSources sets the "sources" field.
+func (_u *ConfigNotificationRuleUpdateOne) SetSources(v []string) *ConfigNotificationRuleUpdateOne {
+	_u.mutation.SetSources(v)
+	return _u
+}
+
+// AppendSources appends value to the "sources" field.
+func (_u *ConfigNotificationRuleUpdateOne) AppendSources(v []string) *ConfigNotificationRuleUpdateOne {
+	_u.mutation.AppendSources(v)
+	return _u
+}
+
+// ClearSources clears the value of the "sources" field.
+func (_u *ConfigNotificationRuleUpdateOne) ClearSources() *ConfigNotificationRuleUpdateOne {
+	_u.mutation.ClearSources()
+	return _u
+}
+
+// SetSeverities sets the "severities" field.
+func (_u *ConfigNotificationRuleUpdateOne) SetSeverities(v []string) *ConfigNotificationRuleUpdateOne {
+	_u.mutation.SetSeverities(v)
+	return _u
+}
+
+// AppendSeverities appends value to the "severities" field.
+func (_u *ConfigNotificationRuleUpdateOne) AppendSeverities(v []string) *ConfigNotificationRuleUpdateOne {
+	_u.mutation.AppendSeverities(v)
+	return _u
+}
+
+// ClearSeverities clears the value of the "severities" field.
+func (_u *ConfigNotificationRuleUpdateOne) ClearSeverities() *ConfigNotificationRuleUpdateOne {
+	_u.mutation.ClearSeverities()
+	return _u
+}
+
+// SetAnomalyTypes sets the "anomaly_types" field.
+func (_u *ConfigNotificationRuleUpdateOne) SetAnomalyTypes(v []string) *ConfigNotificationRuleUpdateOne {
+	_u.mutation.SetAnomalyTypes(v)
+	return _u
+}
+
+// AppendAnomalyTypes appends value to the "anomaly_types" field.
+func (_u *ConfigNotificationRuleUpdateOne) AppendAnomalyTypes(v []string) *ConfigNotificationRuleUpdateOne {
+	_u.mutation.AppendAnomalyTypes(v)
+	return _u
+}
+
+// ClearAnomalyTypes clears the value of the "anomaly_types" field.
+func (_u *ConfigNotificationRuleUpdateOne) ClearAnomalyTypes() *ConfigNotificationRuleUpdateOne {
+	_u.mutation.ClearAnomalyTypes()
+	return _u
+}
+
+// SetSourceIds sets the "source_ids" field.
+func (_u *ConfigNotificationRuleUpdateOne) SetSourceIds(v []string) *ConfigNotificationRuleUpdateOne {
+	_u.mutation.SetSourceIds(v)
+	return _u
+}
+
+// AppendSourceIds appends value to the "source_ids" field.
+func (_u *ConfigNotificationRuleUpdateOne) AppendSourceIds(v []string) *ConfigNotificationRuleUpdateOne {
+	_u.mutation.AppendSourceIds(v)
+	return _u
+}
+
+// ClearSourceIds clears the value of the "source_ids" field.
+func (_u *ConfigNotificationRuleUpdateOne) ClearSourceIds() *ConfigNotificationRuleUpdateOne {
+	_u.mutation.ClearSourceIds()
+	return _u
+}
+
+// SetDestinations sets the "destinations" field.
+func (_u *ConfigNotificationRuleUpdateOne) SetDestinations(v []string) *ConfigNotificationRuleUpdateOne {
+	_u.mutation.SetDestinations(v)
+	return _u
+}
+
+// AppendDestinations appends value to the "destinations" field.
+func (_u *ConfigNotificationRuleUpdateOne) AppendDestinations(v []string) *ConfigNotificationRuleUpdateOne {
+	_u.mutation.AppendDestinations(v)
+	return _u
+}
+
+// SetDedupMinutes sets the "dedup_minutes" field.
+func (_u *ConfigNotificationRuleUpdateOne) SetDedupMinutes(v int) *ConfigNotificationRuleUpdateOne {
+	_u.mutation.ResetDedupMinutes()
+	_u.mutation.SetDedupMinutes(v)
+	return _u
+}
+
+// SetNillableDedupMinutes sets the "dedup_minutes" field if the given value is not nil.
+func (_u *ConfigNotificationRuleUpdateOne) SetNillableDedupMinutes(v *int) *ConfigNotificationRuleUpdateOne {
+	if v != nil {
+		_u.SetDedupMinutes(*v)
+	}
+	return _u
+}
+
+// AddDedupMinutes adds value to the "dedup_minutes" field.
+func (_u *ConfigNotificationRuleUpdateOne) AddDedupMinutes(v int) *ConfigNotificationRuleUpdateOne {
+	_u.mutation.AddDedupMinutes(v)
+	return _u
+}
+
+// SetRateLimitPerHour sets the "rate_limit_per_hour" field.
+func (_u *ConfigNotificationRuleUpdateOne) SetRateLimitPerHour(v int) *ConfigNotificationRuleUpdateOne {
+	_u.mutation.ResetRateLimitPerHour()
+	_u.mutation.SetRateLimitPerHour(v)
+	return _u
+}
+
+// SetNillableRateLimitPerHour sets the "rate_limit_per_hour" field if the given value is not nil.
+func (_u *ConfigNotificationRuleUpdateOne) SetNillableRateLimitPerHour(v *int) *ConfigNotificationRuleUpdateOne {
+	if v != nil {
+		_u.SetRateLimitPerHour(*v)
+	}
+	return _u
+}
+
+// AddRateLimitPerHour adds value to the "rate_limit_per_hour" field.
+func (_u *ConfigNotificationRuleUpdateOne) AddRateLimitPerHour(v int) *ConfigNotificationRuleUpdateOne {
+	_u.mutation.AddRateLimitPerHour(v)
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *ConfigNotificationRuleUpdateOne) SetIsActive(v bool) *ConfigNotificationRuleUpdateOne {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *ConfigNotificationRuleUpdateOne) SetNillableIsActive(v *bool) *ConfigNotificationRuleUpdateOne {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConfigNotificationRuleUpdateOne) SetUpdatedAt(v time.Time) *ConfigNotificationRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ConfigNotificationRuleUpdateOne) SetNillableUpdatedAt(v *time.Time) *ConfigNotificationRuleUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ConfigNotificationRuleMutation object of the builder.
+func (_u *ConfigNotificationRuleUpdateOne) Mutation() *ConfigNotificationRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ConfigNotificationRuleUpdate builder.
+func (_u *ConfigNotificationRuleUpdateOne) Where(ps ...predicate.ConfigNotificationRule) *ConfigNotificationRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ConfigNotificationRuleUpdateOne) Select(field string, fields ...string) *ConfigNotificationRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ConfigNotificationRule entity.
+func (_u *ConfigNotificationRuleUpdateOne) Save(ctx context.Context) (*ConfigNotificationRule, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConfigNotificationRuleUpdateOne) SaveX(ctx context.Context) *ConfigNotificationRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ConfigNotificationRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConfigNotificationRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConfigNotificationRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := confignotificationrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`rule: validator failed for field "ConfigNotificationRule.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ConfigNotificationRuleUpdateOne) sqlSave(ctx context.Context) (_node *ConfigNotificationRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(confignotificationrule.Table, confignotificationrule.Columns, sqlgraph.NewFieldSpec(confignotificationrule.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`rule: missing "ConfigNotificationRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, confignotificationrule.FieldID)
+		for _, f := range fields {
+			if !confignotificationrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("rule: invalid field %q for query", f)}
+			}
+			if f != confignotificationrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(confignotificationrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Sources(); ok {
+		_spec.SetField(confignotificationrule.FieldSources, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSources(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, confignotificationrule.FieldSources, value)
+		})
+	}
+	if _u.mutation.SourcesCleared() {
+		_spec.ClearField(confignotificationrule.FieldSources, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Severities(); ok {
+		_spec.SetField(confignotificationrule.FieldSeverities, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSeverities(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, confignotificationrule.FieldSeverities, value)
+		})
+	}
+	if _u.mutation.SeveritiesCleared() {
+		_spec.ClearField(confignotificationrule.FieldSeverities, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AnomalyTypes(); ok {
+		_spec.SetField(confignotificationrule.FieldAnomalyTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAnomalyTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, confignotificationrule.FieldAnomalyTypes, value)
+		})
+	}
+	if _u.mutation.AnomalyTypesCleared() {
+		_spec.ClearField(confignotificationrule.FieldAnomalyTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SourceIds(); ok {
+		_spec.SetField(confignotificationrule.FieldSourceIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSourceIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, confignotificationrule.FieldSourceIds, value)
+		})
+	}
+	if _u.mutation.SourceIdsCleared() {
+		_spec.ClearField(confignotificationrule.FieldSourceIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Destinations(); ok {
+		_spec.SetField(confignotificationrule.FieldDestinations, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDestinations(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, confignotificationrule.FieldDestinations, value)
+		})
+	}
+	if value, ok := _u.mutation.DedupMinutes(); ok {
+		_spec.SetField(confignotificationrule.FieldDedupMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDedupMinutes(); ok {
+		_spec.AddField(confignotificationrule.FieldDedupMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RateLimitPerHour(); ok {
+		_spec.SetField(confignotificationrule.FieldRateLimitPerHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRateLimitPerHour(); ok {
+		_spec.AddField(confignotificationrule.FieldRateLimitPerHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(confignotificationrule.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(confignotificationrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.ConfigNotificationRule
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &ConfigNotificationRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{confignotificationrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -10,9 +10,11 @@ import (
 	"sync"
 
 	"danny.vn/hotpot/pkg/storage/ent/rule/configauthendpointpattern"
+	"danny.vn/hotpot/pkg/storage/ent/rule/configdetectionrule"
 	"danny.vn/hotpot/pkg/storage/ent/rule/confighostingindicator"
 	"danny.vn/hotpot/pkg/storage/ent/rule/confighttpmonitorrule"
 	"danny.vn/hotpot/pkg/storage/ent/rule/configlibraryua"
+	"danny.vn/hotpot/pkg/storage/ent/rule/confignotificationrule"
 	"danny.vn/hotpot/pkg/storage/ent/rule/configoscorerule"
 	"danny.vn/hotpot/pkg/storage/ent/rule/configrpmcorerepo"
 	"danny.vn/hotpot/pkg/storage/ent/rule/configsanctionedcountry"
@@ -83,9 +85,11 @@ func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			configauthendpointpattern.Table: configauthendpointpattern.ValidColumn,
+			configdetectionrule.Table:       configdetectionrule.ValidColumn,
 			confighostingindicator.Table:    confighostingindicator.ValidColumn,
 			confighttpmonitorrule.Table:     confighttpmonitorrule.ValidColumn,
 			configlibraryua.Table:           configlibraryua.ValidColumn,
+			confignotificationrule.Table:    confignotificationrule.ValidColumn,
 			configoscorerule.Table:          configoscorerule.ValidColumn,
 			configrpmcorerepo.Table:         configrpmcorerepo.ValidColumn,
 			configsanctionedcountry.Table:   configsanctionedcountry.ValidColumn,
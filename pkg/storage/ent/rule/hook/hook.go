@@ -21,6 +21,18 @@ func (f ConfigAuthEndpointPatternFunc) Mutate(ctx context.Context, m rule.Mutati
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *rule.ConfigAuthEndpointPatternMutation", m)
 }
 
+// The ConfigDetectionRuleFunc type is an adapter to allow the use of ordinary
+// function as ConfigDetectionRule mutator.
+type ConfigDetectionRuleFunc func(context.Context, *rule.ConfigDetectionRuleMutation) (rule.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConfigDetectionRuleFunc) Mutate(ctx context.Context, m rule.Mutation) (rule.Value, error) {
+	if mv, ok := m.(*rule.ConfigDetectionRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *rule.ConfigDetectionRuleMutation", m)
+}
+
 // The ConfigHostingIndicatorFunc type is an adapter to allow the use of ordinary
 // function as ConfigHostingIndicator mutator.
 type ConfigHostingIndicatorFunc func(context.Context, *rule.ConfigHostingIndicatorMutation) (rule.Value, error)
@@ -57,6 +69,18 @@ func (f ConfigLibraryUaFunc) Mutate(ctx context.Context, m rule.Mutation) (rule.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *rule.ConfigLibraryUaMutation", m)
 }
 
+// The ConfigNotificationRuleFunc type is an adapter to allow the use of ordinary
+// function as ConfigNotificationRule mutator.
+type ConfigNotificationRuleFunc func(context.Context, *rule.ConfigNotificationRuleMutation) (rule.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConfigNotificationRuleFunc) Mutate(ctx context.Context, m rule.Mutation) (rule.Value, error) {
+	if mv, ok := m.(*rule.ConfigNotificationRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *rule.ConfigNotificationRuleMutation", m)
+}
+
 // The ConfigOsCoreRuleFunc type is an adapter to allow the use of ordinary
 // function as ConfigOsCoreRule mutator.
 type ConfigOsCoreRuleFunc func(context.Context, *rule.ConfigOsCoreRuleMutation) (rule.Value, error)
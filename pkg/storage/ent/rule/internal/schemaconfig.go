@@ -8,9 +8,11 @@ import "context"
 // that can be passed at runtime.
 type SchemaConfig struct {
 	ConfigAuthEndpointPattern string // ConfigAuthEndpointPattern table.
+	ConfigDetectionRule       string // ConfigDetectionRule table.
 	ConfigHostingIndicator    string // ConfigHostingIndicator table.
 	ConfigHttpmonitorRule     string // ConfigHttpmonitorRule table.
 	ConfigLibraryUa           string // ConfigLibraryUa table.
+	ConfigNotificationRule    string // ConfigNotificationRule table.
 	ConfigOsCoreRule          string // ConfigOsCoreRule table.
 	ConfigRpmCoreRepo         string // ConfigRpmCoreRepo table.
 	ConfigSanctionedCountry   string // ConfigSanctionedCountry table.
@@ -44,6 +44,48 @@ var (
 			},
 		},
 	}
+	// DetectionRulesColumns holds the columns for the "detection_rules" table.
+	DetectionRulesColumns = []*schema.Column{
+		{Name: "rule_id", Type: field.TypeInt, Increment: true},
+		{Name: "rule_key", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "category", Type: field.TypeString},
+		{Name: "severity", Type: field.TypeString},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "resource_type", Type: field.TypeString},
+		{Name: "remediation", Type: field.TypeString, Nullable: true},
+		{Name: "query", Type: field.TypeString, Size: 2147483647},
+		{Name: "params_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "timeout_seconds", Type: field.TypeInt, Default: 30},
+		{Name: "source", Type: field.TypeString, Default: "custom"},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// DetectionRulesTable holds the schema information for the "detection_rules" table.
+	DetectionRulesTable = &schema.Table{
+		Name:       "detection_rules",
+		Columns:    DetectionRulesColumns,
+		PrimaryKey: []*schema.Column{DetectionRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "configdetectionrule_category",
+				Unique:  false,
+				Columns: []*schema.Column{DetectionRulesColumns[4]},
+			},
+			{
+				Name:    "configdetectionrule_resource_type",
+				Unique:  false,
+				Columns: []*schema.Column{DetectionRulesColumns[7]},
+			},
+			{
+				Name:    "configdetectionrule_is_active",
+				Unique:  false,
+				Columns: []*schema.Column{DetectionRulesColumns[13]},
+			},
+		},
+	}
 	// HostingIndicatorsColumns holds the columns for the "hosting_indicators" table.
 	HostingIndicatorsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -139,6 +181,35 @@ var (
 			},
 		},
 	}
+	// NotificationRulesColumns holds the columns for the "notification_rules" table.
+	NotificationRulesColumns = []*schema.Column{
+		{Name: "rule_id", Type: field.TypeInt, Increment: true},
+		{Name: "rule_key", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "sources", Type: field.TypeJSON, Nullable: true},
+		{Name: "severities", Type: field.TypeJSON, Nullable: true},
+		{Name: "anomaly_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "source_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "destinations", Type: field.TypeJSON},
+		{Name: "dedup_minutes", Type: field.TypeInt, Default: 1440},
+		{Name: "rate_limit_per_hour", Type: field.TypeInt, Default: 30},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// NotificationRulesTable holds the schema information for the "notification_rules" table.
+	NotificationRulesTable = &schema.Table{
+		Name:       "notification_rules",
+		Columns:    NotificationRulesColumns,
+		PrimaryKey: []*schema.Column{NotificationRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "confignotificationrule_is_active",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationRulesColumns[10]},
+			},
+		},
+	}
 	// OsCoreRulesColumns holds the columns for the "os_core_rules" table.
 	OsCoreRulesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -309,9 +380,11 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AuthEndpointPatternsTable,
+		DetectionRulesTable,
 		HostingIndicatorsTable,
 		HttpmonitorRulesTable,
 		LibraryUasTable,
+		NotificationRulesTable,
 		OsCoreRulesTable,
 		RpmCoreReposTable,
 		SanctionedCountriesTable,
@@ -325,6 +398,9 @@ func init() {
 	AuthEndpointPatternsTable.Annotation = &entsql.Annotation{
 		Table: "auth_endpoint_patterns",
 	}
+	DetectionRulesTable.Annotation = &entsql.Annotation{
+		Table: "detection_rules",
+	}
 	HostingIndicatorsTable.Annotation = &entsql.Annotation{
 		Table: "hosting_indicators",
 	}
@@ -334,6 +410,9 @@ func init() {
 	LibraryUasTable.Annotation = &entsql.Annotation{
 		Table: "library_uas",
 	}
+	NotificationRulesTable.Annotation = &entsql.Annotation{
+		Table: "notification_rules",
+	}
 	OsCoreRulesTable.Annotation = &entsql.Annotation{
 		Table: "os_core_rules",
 	}
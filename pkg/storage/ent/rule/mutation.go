@@ -10,9 +10,11 @@ import (
 	"time"
 
 	"danny.vn/hotpot/pkg/storage/ent/rule/configauthendpointpattern"
+	"danny.vn/hotpot/pkg/storage/ent/rule/configdetectionrule"
 	"danny.vn/hotpot/pkg/storage/ent/rule/confighostingindicator"
 	"danny.vn/hotpot/pkg/storage/ent/rule/confighttpmonitorrule"
 	"danny.vn/hotpot/pkg/storage/ent/rule/configlibraryua"
+	"danny.vn/hotpot/pkg/storage/ent/rule/confignotificationrule"
 	"danny.vn/hotpot/pkg/storage/ent/rule/configoscorerule"
 	"danny.vn/hotpot/pkg/storage/ent/rule/configrpmcorerepo"
 	"danny.vn/hotpot/pkg/storage/ent/rule/configsanctionedcountry"
@@ -34,9 +36,11 @@ const (
 
 	// Node types.
 	TypeConfigAuthEndpointPattern = "ConfigAuthEndpointPattern"
+	TypeConfigDetectionRule       = "ConfigDetectionRule"
 	TypeConfigHostingIndicator    = "ConfigHostingIndicator"
 	TypeConfigHttpmonitorRule     = "ConfigHttpmonitorRule"
 	TypeConfigLibraryUa           = "ConfigLibraryUa"
+	TypeConfigNotificationRule    = "ConfigNotificationRule"
 	TypeConfigOsCoreRule          = "ConfigOsCoreRule"
 	TypeConfigRpmCoreRepo         = "ConfigRpmCoreRepo"
 	TypeConfigSanctionedCountry   = "ConfigSanctionedCountry"
@@ -771,36 +775,45 @@ func (m *ConfigAuthEndpointPatternMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown ConfigAuthEndpointPattern edge %s", name)
 }
 
-// ConfigHostingIndicatorMutation represents an operation that mutates the ConfigHostingIndicator nodes in the graph.
-type ConfigHostingIndicatorMutation struct {
+// ConfigDetectionRuleMutation represents an operation that mutates the ConfigDetectionRule nodes in the graph.
+type ConfigDetectionRuleMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	indicator_type *confighostingindicator.IndicatorType
-	value          *string
-	description    *string
-	country        *string
-	is_active      *bool
-	created_at     *time.Time
-	updated_at     *time.Time
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*ConfigHostingIndicator, error)
-	predicates     []predicate.ConfigHostingIndicator
-}
-
-var _ ent.Mutation = (*ConfigHostingIndicatorMutation)(nil)
-
-// confighostingindicatorOption allows management of the mutation configuration using functional options.
-type confighostingindicatorOption func(*ConfigHostingIndicatorMutation)
-
-// newConfigHostingIndicatorMutation creates new mutation for the ConfigHostingIndicator entity.
-func newConfigHostingIndicatorMutation(c config, op Op, opts ...confighostingindicatorOption) *ConfigHostingIndicatorMutation {
-	m := &ConfigHostingIndicatorMutation{
+	op                 Op
+	typ                string
+	id                 *int
+	rule_key           *string
+	name               *string
+	description        *string
+	category           *string
+	severity           *string
+	provider           *string
+	resource_type      *string
+	remediation        *string
+	query              *string
+	params_json        *map[string]interface{}
+	timeout_seconds    *int
+	addtimeout_seconds *int
+	source             *string
+	is_active          *bool
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*ConfigDetectionRule, error)
+	predicates         []predicate.ConfigDetectionRule
+}
+
+var _ ent.Mutation = (*ConfigDetectionRuleMutation)(nil)
+
+// configdetectionruleOption allows management of the mutation configuration using functional options.
+type configdetectionruleOption func(*ConfigDetectionRuleMutation)
+
+// newConfigDetectionRuleMutation creates new mutation for the ConfigDetectionRule entity.
+func newConfigDetectionRuleMutation(c config, op Op, opts ...configdetectionruleOption) *ConfigDetectionRuleMutation {
+	m := &ConfigDetectionRuleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeConfigHostingIndicator,
+		typ:           TypeConfigDetectionRule,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -809,20 +822,20 @@ func newConfigHostingIndicatorMutation(c config, op Op, opts ...confighostingind
 	return m
 }
 
-// withConfigHostingIndicatorID sets the ID field of the mutation.
-func withConfigHostingIndicatorID(id int) confighostingindicatorOption {
-	return func(m *ConfigHostingIndicatorMutation) {
+// withConfigDetectionRuleID sets the ID field of the mutation.
+func withConfigDetectionRuleID(id int) configdetectionruleOption {
+	return func(m *ConfigDetectionRuleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ConfigHostingIndicator
+			value *ConfigDetectionRule
 		)
-		m.oldValue = func(ctx context.Context) (*ConfigHostingIndicator, error) {
+		m.oldValue = func(ctx context.Context) (*ConfigDetectionRule, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ConfigHostingIndicator.Get(ctx, id)
+					value, err = m.Client().ConfigDetectionRule.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -831,10 +844,10 @@ func withConfigHostingIndicatorID(id int) confighostingindicatorOption {
 	}
 }
 
-// withConfigHostingIndicator sets the old ConfigHostingIndicator of the mutation.
-func withConfigHostingIndicator(node *ConfigHostingIndicator) confighostingindicatorOption {
-	return func(m *ConfigHostingIndicatorMutation) {
-		m.oldValue = func(context.Context) (*ConfigHostingIndicator, error) {
+// withConfigDetectionRule sets the old ConfigDetectionRule of the mutation.
+func withConfigDetectionRule(node *ConfigDetectionRule) configdetectionruleOption {
+	return func(m *ConfigDetectionRuleMutation) {
+		m.oldValue = func(context.Context) (*ConfigDetectionRule, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -843,7 +856,7 @@ func withConfigHostingIndicator(node *ConfigHostingIndicator) confighostingindic
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ConfigHostingIndicatorMutation) Client() *Client {
+func (m ConfigDetectionRuleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -851,7 +864,7 @@ func (m ConfigHostingIndicatorMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ConfigHostingIndicatorMutation) Tx() (*Tx, error) {
+func (m ConfigDetectionRuleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("rule: mutation is not running in a transaction")
 	}
@@ -860,9 +873,15 @@ func (m ConfigHostingIndicatorMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ConfigDetectionRule entities.
+func (m *ConfigDetectionRuleMutation) SetID(id int) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ConfigHostingIndicatorMutation) ID() (id int, exists bool) {
+func (m *ConfigDetectionRuleMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -873,7 +892,7 @@ func (m *ConfigHostingIndicatorMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ConfigHostingIndicatorMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ConfigDetectionRuleMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -882,91 +901,91 @@ func (m *ConfigHostingIndicatorMutation) IDs(ctx context.Context) ([]int, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ConfigHostingIndicator.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ConfigDetectionRule.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetIndicatorType sets the "indicator_type" field.
-func (m *ConfigHostingIndicatorMutation) SetIndicatorType(ct confighostingindicator.IndicatorType) {
-	m.indicator_type = &ct
+// SetRuleKey sets the "rule_key" field.
+func (m *ConfigDetectionRuleMutation) SetRuleKey(s string) {
+	m.rule_key = &s
 }
 
-// IndicatorType returns the value of the "indicator_type" field in the mutation.
-func (m *ConfigHostingIndicatorMutation) IndicatorType() (r confighostingindicator.IndicatorType, exists bool) {
-	v := m.indicator_type
+// RuleKey returns the value of the "rule_key" field in the mutation.
+func (m *ConfigDetectionRuleMutation) RuleKey() (r string, exists bool) {
+	v := m.rule_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIndicatorType returns the old "indicator_type" field's value of the ConfigHostingIndicator entity.
-// If the ConfigHostingIndicator object wasn't provided to the builder, the object is fetched from the database.
+// OldRuleKey returns the old "rule_key" field's value of the ConfigDetectionRule entity.
+// If the ConfigDetectionRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigHostingIndicatorMutation) OldIndicatorType(ctx context.Context) (v confighostingindicator.IndicatorType, err error) {
+func (m *ConfigDetectionRuleMutation) OldRuleKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIndicatorType is only allowed on UpdateOne operations")
+		return v, errors.New("OldRuleKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIndicatorType requires an ID field in the mutation")
+		return v, errors.New("OldRuleKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIndicatorType: %w", err)
+		return v, fmt.Errorf("querying old value for OldRuleKey: %w", err)
 	}
-	return oldValue.IndicatorType, nil
+	return oldValue.RuleKey, nil
 }
 
-// ResetIndicatorType resets all changes to the "indicator_type" field.
-func (m *ConfigHostingIndicatorMutation) ResetIndicatorType() {
-	m.indicator_type = nil
+// ResetRuleKey resets all changes to the "rule_key" field.
+func (m *ConfigDetectionRuleMutation) ResetRuleKey() {
+	m.rule_key = nil
 }
 
-// SetValue sets the "value" field.
-func (m *ConfigHostingIndicatorMutation) SetValue(s string) {
-	m.value = &s
+// SetName sets the "name" field.
+func (m *ConfigDetectionRuleMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *ConfigHostingIndicatorMutation) Value() (r string, exists bool) {
-	v := m.value
+// Name returns the value of the "name" field in the mutation.
+func (m *ConfigDetectionRuleMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the ConfigHostingIndicator entity.
-// If the ConfigHostingIndicator object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the ConfigDetectionRule entity.
+// If the ConfigDetectionRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigHostingIndicatorMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *ConfigDetectionRuleMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.Name, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *ConfigHostingIndicatorMutation) ResetValue() {
-	m.value = nil
+// ResetName resets all changes to the "name" field.
+func (m *ConfigDetectionRuleMutation) ResetName() {
+	m.name = nil
 }
 
 // SetDescription sets the "description" field.
-func (m *ConfigHostingIndicatorMutation) SetDescription(s string) {
+func (m *ConfigDetectionRuleMutation) SetDescription(s string) {
 	m.description = &s
 }
 
 // Description returns the value of the "description" field in the mutation.
-func (m *ConfigHostingIndicatorMutation) Description() (r string, exists bool) {
+func (m *ConfigDetectionRuleMutation) Description() (r string, exists bool) {
 	v := m.description
 	if v == nil {
 		return
@@ -974,10 +993,10 @@ func (m *ConfigHostingIndicatorMutation) Description() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the ConfigHostingIndicator entity.
-// If the ConfigHostingIndicator object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the ConfigDetectionRule entity.
+// If the ConfigDetectionRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigHostingIndicatorMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *ConfigDetectionRuleMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
@@ -992,235 +1011,580 @@ func (m *ConfigHostingIndicatorMutation) OldDescription(ctx context.Context) (v
 }
 
 // ClearDescription clears the value of the "description" field.
-func (m *ConfigHostingIndicatorMutation) ClearDescription() {
+func (m *ConfigDetectionRuleMutation) ClearDescription() {
 	m.description = nil
-	m.clearedFields[confighostingindicator.FieldDescription] = struct{}{}
+	m.clearedFields[configdetectionrule.FieldDescription] = struct{}{}
 }
 
 // DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ConfigHostingIndicatorMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[confighostingindicator.FieldDescription]
+func (m *ConfigDetectionRuleMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[configdetectionrule.FieldDescription]
 	return ok
 }
 
 // ResetDescription resets all changes to the "description" field.
-func (m *ConfigHostingIndicatorMutation) ResetDescription() {
+func (m *ConfigDetectionRuleMutation) ResetDescription() {
 	m.description = nil
-	delete(m.clearedFields, confighostingindicator.FieldDescription)
+	delete(m.clearedFields, configdetectionrule.FieldDescription)
 }
 
-// SetCountry sets the "country" field.
-func (m *ConfigHostingIndicatorMutation) SetCountry(s string) {
-	m.country = &s
+// SetCategory sets the "category" field.
+func (m *ConfigDetectionRuleMutation) SetCategory(s string) {
+	m.category = &s
 }
 
-// Country returns the value of the "country" field in the mutation.
-func (m *ConfigHostingIndicatorMutation) Country() (r string, exists bool) {
-	v := m.country
+// Category returns the value of the "category" field in the mutation.
+func (m *ConfigDetectionRuleMutation) Category() (r string, exists bool) {
+	v := m.category
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCountry returns the old "country" field's value of the ConfigHostingIndicator entity.
-// If the ConfigHostingIndicator object wasn't provided to the builder, the object is fetched from the database.
+// OldCategory returns the old "category" field's value of the ConfigDetectionRule entity.
+// If the ConfigDetectionRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigHostingIndicatorMutation) OldCountry(ctx context.Context) (v string, err error) {
+func (m *ConfigDetectionRuleMutation) OldCategory(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCountry is only allowed on UpdateOne operations")
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCountry requires an ID field in the mutation")
+		return v, errors.New("OldCategory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCountry: %w", err)
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
 	}
-	return oldValue.Country, nil
+	return oldValue.Category, nil
 }
 
-// ClearCountry clears the value of the "country" field.
-func (m *ConfigHostingIndicatorMutation) ClearCountry() {
-	m.country = nil
-	m.clearedFields[confighostingindicator.FieldCountry] = struct{}{}
+// ResetCategory resets all changes to the "category" field.
+func (m *ConfigDetectionRuleMutation) ResetCategory() {
+	m.category = nil
 }
 
-// CountryCleared returns if the "country" field was cleared in this mutation.
-func (m *ConfigHostingIndicatorMutation) CountryCleared() bool {
-	_, ok := m.clearedFields[confighostingindicator.FieldCountry]
-	return ok
+// SetSeverity sets the "severity" field.
+func (m *ConfigDetectionRuleMutation) SetSeverity(s string) {
+	m.severity = &s
 }
 
-// ResetCountry resets all changes to the "country" field.
-func (m *ConfigHostingIndicatorMutation) ResetCountry() {
-	m.country = nil
-	delete(m.clearedFields, confighostingindicator.FieldCountry)
+// Severity returns the value of the "severity" field in the mutation.
+func (m *ConfigDetectionRuleMutation) Severity() (r string, exists bool) {
+	v := m.severity
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetIsActive sets the "is_active" field.
-func (m *ConfigHostingIndicatorMutation) SetIsActive(b bool) {
-	m.is_active = &b
+// OldSeverity returns the old "severity" field's value of the ConfigDetectionRule entity.
+// If the ConfigDetectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigDetectionRuleMutation) OldSeverity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeverity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeverity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeverity: %w", err)
+	}
+	return oldValue.Severity, nil
 }
 
-// IsActive returns the value of the "is_active" field in the mutation.
-func (m *ConfigHostingIndicatorMutation) IsActive() (r bool, exists bool) {
-	v := m.is_active
+// ResetSeverity resets all changes to the "severity" field.
+func (m *ConfigDetectionRuleMutation) ResetSeverity() {
+	m.severity = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *ConfigDetectionRuleMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *ConfigDetectionRuleMutation) Provider() (r string, exists bool) {
+	v := m.provider
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsActive returns the old "is_active" field's value of the ConfigHostingIndicator entity.
-// If the ConfigHostingIndicator object wasn't provided to the builder, the object is fetched from the database.
+// OldProvider returns the old "provider" field's value of the ConfigDetectionRule entity.
+// If the ConfigDetectionRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigHostingIndicatorMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+func (m *ConfigDetectionRuleMutation) OldProvider(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsActive requires an ID field in the mutation")
+		return v, errors.New("OldProvider requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
 	}
-	return oldValue.IsActive, nil
+	return oldValue.Provider, nil
 }
 
-// ResetIsActive resets all changes to the "is_active" field.
-func (m *ConfigHostingIndicatorMutation) ResetIsActive() {
-	m.is_active = nil
+// ResetProvider resets all changes to the "provider" field.
+func (m *ConfigDetectionRuleMutation) ResetProvider() {
+	m.provider = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ConfigHostingIndicatorMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetResourceType sets the "resource_type" field.
+func (m *ConfigDetectionRuleMutation) SetResourceType(s string) {
+	m.resource_type = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ConfigHostingIndicatorMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResourceType returns the value of the "resource_type" field in the mutation.
+func (m *ConfigDetectionRuleMutation) ResourceType() (r string, exists bool) {
+	v := m.resource_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ConfigHostingIndicator entity.
-// If the ConfigHostingIndicator object wasn't provided to the builder, the object is fetched from the database.
+// OldResourceType returns the old "resource_type" field's value of the ConfigDetectionRule entity.
+// If the ConfigDetectionRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigHostingIndicatorMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ConfigDetectionRuleMutation) OldResourceType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldResourceType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ResourceType, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ConfigHostingIndicatorMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetResourceType resets all changes to the "resource_type" field.
+func (m *ConfigDetectionRuleMutation) ResetResourceType() {
+	m.resource_type = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ConfigHostingIndicatorMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetRemediation sets the "remediation" field.
+func (m *ConfigDetectionRuleMutation) SetRemediation(s string) {
+	m.remediation = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ConfigHostingIndicatorMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Remediation returns the value of the "remediation" field in the mutation.
+func (m *ConfigDetectionRuleMutation) Remediation() (r string, exists bool) {
+	v := m.remediation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ConfigHostingIndicator entity.
-// If the ConfigHostingIndicator object wasn't provided to the builder, the object is fetched from the database.
+// OldRemediation returns the old "remediation" field's value of the ConfigDetectionRule entity.
+// If the ConfigDetectionRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigHostingIndicatorMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ConfigDetectionRuleMutation) OldRemediation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRemediation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRemediation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRemediation: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Remediation, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ConfigHostingIndicatorMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearRemediation clears the value of the "remediation" field.
+func (m *ConfigDetectionRuleMutation) ClearRemediation() {
+	m.remediation = nil
+	m.clearedFields[configdetectionrule.FieldRemediation] = struct{}{}
 }
 
-// Where appends a list predicates to the ConfigHostingIndicatorMutation builder.
-func (m *ConfigHostingIndicatorMutation) Where(ps ...predicate.ConfigHostingIndicator) {
-	m.predicates = append(m.predicates, ps...)
+// RemediationCleared returns if the "remediation" field was cleared in this mutation.
+func (m *ConfigDetectionRuleMutation) RemediationCleared() bool {
+	_, ok := m.clearedFields[configdetectionrule.FieldRemediation]
+	return ok
 }
 
-// WhereP appends storage-level predicates to the ConfigHostingIndicatorMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ConfigHostingIndicatorMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ConfigHostingIndicator, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ResetRemediation resets all changes to the "remediation" field.
+func (m *ConfigDetectionRuleMutation) ResetRemediation() {
+	m.remediation = nil
+	delete(m.clearedFields, configdetectionrule.FieldRemediation)
+}
+
+// SetQuery sets the "query" field.
+func (m *ConfigDetectionRuleMutation) SetQuery(s string) {
+	m.query = &s
+}
+
+// Query returns the value of the "query" field in the mutation.
+func (m *ConfigDetectionRuleMutation) Query() (r string, exists bool) {
+	v := m.query
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *ConfigHostingIndicatorMutation) Op() Op {
-	return m.op
+// OldQuery returns the old "query" field's value of the ConfigDetectionRule entity.
+// If the ConfigDetectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigDetectionRuleMutation) OldQuery(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuery is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuery requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuery: %w", err)
+	}
+	return oldValue.Query, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ConfigHostingIndicatorMutation) SetOp(op Op) {
-	m.op = op
+// ResetQuery resets all changes to the "query" field.
+func (m *ConfigDetectionRuleMutation) ResetQuery() {
+	m.query = nil
 }
 
-// Type returns the node type of this mutation (ConfigHostingIndicator).
-func (m *ConfigHostingIndicatorMutation) Type() string {
-	return m.typ
+// SetParamsJSON sets the "params_json" field.
+func (m *ConfigDetectionRuleMutation) SetParamsJSON(value map[string]interface{}) {
+	m.params_json = &value
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ConfigHostingIndicatorMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.indicator_type != nil {
-		fields = append(fields, confighostingindicator.FieldIndicatorType)
+// ParamsJSON returns the value of the "params_json" field in the mutation.
+func (m *ConfigDetectionRuleMutation) ParamsJSON() (r map[string]interface{}, exists bool) {
+	v := m.params_json
+	if v == nil {
+		return
 	}
-	if m.value != nil {
-		fields = append(fields, confighostingindicator.FieldValue)
+	return *v, true
+}
+
+// OldParamsJSON returns the old "params_json" field's value of the ConfigDetectionRule entity.
+// If the ConfigDetectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigDetectionRuleMutation) OldParamsJSON(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParamsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParamsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParamsJSON: %w", err)
+	}
+	return oldValue.ParamsJSON, nil
+}
+
+// ClearParamsJSON clears the value of the "params_json" field.
+func (m *ConfigDetectionRuleMutation) ClearParamsJSON() {
+	m.params_json = nil
+	m.clearedFields[configdetectionrule.FieldParamsJSON] = struct{}{}
+}
+
+// ParamsJSONCleared returns if the "params_json" field was cleared in this mutation.
+func (m *ConfigDetectionRuleMutation) ParamsJSONCleared() bool {
+	_, ok := m.clearedFields[configdetectionrule.FieldParamsJSON]
+	return ok
+}
+
+// ResetParamsJSON resets all changes to the "params_json" field.
+func (m *ConfigDetectionRuleMutation) ResetParamsJSON() {
+	m.params_json = nil
+	delete(m.clearedFields, configdetectionrule.FieldParamsJSON)
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (m *ConfigDetectionRuleMutation) SetTimeoutSeconds(i int) {
+	m.timeout_seconds = &i
+	m.addtimeout_seconds = nil
+}
+
+// TimeoutSeconds returns the value of the "timeout_seconds" field in the mutation.
+func (m *ConfigDetectionRuleMutation) TimeoutSeconds() (r int, exists bool) {
+	v := m.timeout_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeoutSeconds returns the old "timeout_seconds" field's value of the ConfigDetectionRule entity.
+// If the ConfigDetectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigDetectionRuleMutation) OldTimeoutSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeoutSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeoutSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeoutSeconds: %w", err)
+	}
+	return oldValue.TimeoutSeconds, nil
+}
+
+// AddTimeoutSeconds adds i to the "timeout_seconds" field.
+func (m *ConfigDetectionRuleMutation) AddTimeoutSeconds(i int) {
+	if m.addtimeout_seconds != nil {
+		*m.addtimeout_seconds += i
+	} else {
+		m.addtimeout_seconds = &i
+	}
+}
+
+// AddedTimeoutSeconds returns the value that was added to the "timeout_seconds" field in this mutation.
+func (m *ConfigDetectionRuleMutation) AddedTimeoutSeconds() (r int, exists bool) {
+	v := m.addtimeout_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTimeoutSeconds resets all changes to the "timeout_seconds" field.
+func (m *ConfigDetectionRuleMutation) ResetTimeoutSeconds() {
+	m.timeout_seconds = nil
+	m.addtimeout_seconds = nil
+}
+
+// SetSource sets the "source" field.
+func (m *ConfigDetectionRuleMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *ConfigDetectionRuleMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the ConfigDetectionRule entity.
+// If the ConfigDetectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigDetectionRuleMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *ConfigDetectionRuleMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetIsActive sets the "is_active" field.
+func (m *ConfigDetectionRuleMutation) SetIsActive(b bool) {
+	m.is_active = &b
+}
+
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *ConfigDetectionRuleMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsActive returns the old "is_active" field's value of the ConfigDetectionRule entity.
+// If the ConfigDetectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigDetectionRuleMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	}
+	return oldValue.IsActive, nil
+}
+
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *ConfigDetectionRuleMutation) ResetIsActive() {
+	m.is_active = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ConfigDetectionRuleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ConfigDetectionRuleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ConfigDetectionRule entity.
+// If the ConfigDetectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigDetectionRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ConfigDetectionRuleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ConfigDetectionRuleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ConfigDetectionRuleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ConfigDetectionRule entity.
+// If the ConfigDetectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigDetectionRuleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ConfigDetectionRuleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ConfigDetectionRuleMutation builder.
+func (m *ConfigDetectionRuleMutation) Where(ps ...predicate.ConfigDetectionRule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConfigDetectionRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConfigDetectionRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConfigDetectionRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConfigDetectionRuleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConfigDetectionRuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ConfigDetectionRule).
+func (m *ConfigDetectionRuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConfigDetectionRuleMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.rule_key != nil {
+		fields = append(fields, configdetectionrule.FieldRuleKey)
+	}
+	if m.name != nil {
+		fields = append(fields, configdetectionrule.FieldName)
 	}
 	if m.description != nil {
-		fields = append(fields, confighostingindicator.FieldDescription)
+		fields = append(fields, configdetectionrule.FieldDescription)
 	}
-	if m.country != nil {
-		fields = append(fields, confighostingindicator.FieldCountry)
+	if m.category != nil {
+		fields = append(fields, configdetectionrule.FieldCategory)
+	}
+	if m.severity != nil {
+		fields = append(fields, configdetectionrule.FieldSeverity)
+	}
+	if m.provider != nil {
+		fields = append(fields, configdetectionrule.FieldProvider)
+	}
+	if m.resource_type != nil {
+		fields = append(fields, configdetectionrule.FieldResourceType)
+	}
+	if m.remediation != nil {
+		fields = append(fields, configdetectionrule.FieldRemediation)
+	}
+	if m.query != nil {
+		fields = append(fields, configdetectionrule.FieldQuery)
+	}
+	if m.params_json != nil {
+		fields = append(fields, configdetectionrule.FieldParamsJSON)
+	}
+	if m.timeout_seconds != nil {
+		fields = append(fields, configdetectionrule.FieldTimeoutSeconds)
+	}
+	if m.source != nil {
+		fields = append(fields, configdetectionrule.FieldSource)
 	}
 	if m.is_active != nil {
-		fields = append(fields, confighostingindicator.FieldIsActive)
+		fields = append(fields, configdetectionrule.FieldIsActive)
 	}
 	if m.created_at != nil {
-		fields = append(fields, confighostingindicator.FieldCreatedAt)
+		fields = append(fields, configdetectionrule.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, confighostingindicator.FieldUpdatedAt)
+		fields = append(fields, configdetectionrule.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -1228,21 +1592,37 @@ func (m *ConfigHostingIndicatorMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ConfigHostingIndicatorMutation) Field(name string) (ent.Value, bool) {
+func (m *ConfigDetectionRuleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case confighostingindicator.FieldIndicatorType:
-		return m.IndicatorType()
-	case confighostingindicator.FieldValue:
-		return m.Value()
-	case confighostingindicator.FieldDescription:
+	case configdetectionrule.FieldRuleKey:
+		return m.RuleKey()
+	case configdetectionrule.FieldName:
+		return m.Name()
+	case configdetectionrule.FieldDescription:
 		return m.Description()
-	case confighostingindicator.FieldCountry:
-		return m.Country()
-	case confighostingindicator.FieldIsActive:
+	case configdetectionrule.FieldCategory:
+		return m.Category()
+	case configdetectionrule.FieldSeverity:
+		return m.Severity()
+	case configdetectionrule.FieldProvider:
+		return m.Provider()
+	case configdetectionrule.FieldResourceType:
+		return m.ResourceType()
+	case configdetectionrule.FieldRemediation:
+		return m.Remediation()
+	case configdetectionrule.FieldQuery:
+		return m.Query()
+	case configdetectionrule.FieldParamsJSON:
+		return m.ParamsJSON()
+	case configdetectionrule.FieldTimeoutSeconds:
+		return m.TimeoutSeconds()
+	case configdetectionrule.FieldSource:
+		return m.Source()
+	case configdetectionrule.FieldIsActive:
 		return m.IsActive()
-	case confighostingindicator.FieldCreatedAt:
+	case configdetectionrule.FieldCreatedAt:
 		return m.CreatedAt()
-	case confighostingindicator.FieldUpdatedAt:
+	case configdetectionrule.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -1251,74 +1631,146 @@ func (m *ConfigHostingIndicatorMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ConfigHostingIndicatorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ConfigDetectionRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case confighostingindicator.FieldIndicatorType:
-		return m.OldIndicatorType(ctx)
-	case confighostingindicator.FieldValue:
-		return m.OldValue(ctx)
-	case confighostingindicator.FieldDescription:
+	case configdetectionrule.FieldRuleKey:
+		return m.OldRuleKey(ctx)
+	case configdetectionrule.FieldName:
+		return m.OldName(ctx)
+	case configdetectionrule.FieldDescription:
 		return m.OldDescription(ctx)
-	case confighostingindicator.FieldCountry:
-		return m.OldCountry(ctx)
-	case confighostingindicator.FieldIsActive:
+	case configdetectionrule.FieldCategory:
+		return m.OldCategory(ctx)
+	case configdetectionrule.FieldSeverity:
+		return m.OldSeverity(ctx)
+	case configdetectionrule.FieldProvider:
+		return m.OldProvider(ctx)
+	case configdetectionrule.FieldResourceType:
+		return m.OldResourceType(ctx)
+	case configdetectionrule.FieldRemediation:
+		return m.OldRemediation(ctx)
+	case configdetectionrule.FieldQuery:
+		return m.OldQuery(ctx)
+	case configdetectionrule.FieldParamsJSON:
+		return m.OldParamsJSON(ctx)
+	case configdetectionrule.FieldTimeoutSeconds:
+		return m.OldTimeoutSeconds(ctx)
+	case configdetectionrule.FieldSource:
+		return m.OldSource(ctx)
+	case configdetectionrule.FieldIsActive:
 		return m.OldIsActive(ctx)
-	case confighostingindicator.FieldCreatedAt:
+	case configdetectionrule.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case confighostingindicator.FieldUpdatedAt:
+	case configdetectionrule.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ConfigHostingIndicator field %s", name)
+	return nil, fmt.Errorf("unknown ConfigDetectionRule field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConfigHostingIndicatorMutation) SetField(name string, value ent.Value) error {
+func (m *ConfigDetectionRuleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case confighostingindicator.FieldIndicatorType:
-		v, ok := value.(confighostingindicator.IndicatorType)
+	case configdetectionrule.FieldRuleKey:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIndicatorType(v)
+		m.SetRuleKey(v)
 		return nil
-	case confighostingindicator.FieldValue:
+	case configdetectionrule.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetName(v)
 		return nil
-	case confighostingindicator.FieldDescription:
+	case configdetectionrule.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDescription(v)
 		return nil
-	case confighostingindicator.FieldCountry:
+	case configdetectionrule.FieldCategory:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCountry(v)
+		m.SetCategory(v)
 		return nil
-	case confighostingindicator.FieldIsActive:
+	case configdetectionrule.FieldSeverity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeverity(v)
+		return nil
+	case configdetectionrule.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case configdetectionrule.FieldResourceType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceType(v)
+		return nil
+	case configdetectionrule.FieldRemediation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemediation(v)
+		return nil
+	case configdetectionrule.FieldQuery:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuery(v)
+		return nil
+	case configdetectionrule.FieldParamsJSON:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParamsJSON(v)
+		return nil
+	case configdetectionrule.FieldTimeoutSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeoutSeconds(v)
+		return nil
+	case configdetectionrule.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case configdetectionrule.FieldIsActive:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetIsActive(v)
 		return nil
-	case confighostingindicator.FieldCreatedAt:
+	case configdetectionrule.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case confighostingindicator.FieldUpdatedAt:
+	case configdetectionrule.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1326,177 +1778,217 @@ func (m *ConfigHostingIndicatorMutation) SetField(name string, value ent.Value)
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ConfigHostingIndicator field %s", name)
+	return fmt.Errorf("unknown ConfigDetectionRule field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ConfigHostingIndicatorMutation) AddedFields() []string {
-	return nil
+func (m *ConfigDetectionRuleMutation) AddedFields() []string {
+	var fields []string
+	if m.addtimeout_seconds != nil {
+		fields = append(fields, configdetectionrule.FieldTimeoutSeconds)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ConfigHostingIndicatorMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ConfigDetectionRuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case configdetectionrule.FieldTimeoutSeconds:
+		return m.AddedTimeoutSeconds()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConfigHostingIndicatorMutation) AddField(name string, value ent.Value) error {
+func (m *ConfigDetectionRuleMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case configdetectionrule.FieldTimeoutSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimeoutSeconds(v)
+		return nil
 	}
-	return fmt.Errorf("unknown ConfigHostingIndicator numeric field %s", name)
+	return fmt.Errorf("unknown ConfigDetectionRule numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ConfigHostingIndicatorMutation) ClearedFields() []string {
+func (m *ConfigDetectionRuleMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(confighostingindicator.FieldDescription) {
-		fields = append(fields, confighostingindicator.FieldDescription)
+	if m.FieldCleared(configdetectionrule.FieldDescription) {
+		fields = append(fields, configdetectionrule.FieldDescription)
 	}
-	if m.FieldCleared(confighostingindicator.FieldCountry) {
-		fields = append(fields, confighostingindicator.FieldCountry)
+	if m.FieldCleared(configdetectionrule.FieldRemediation) {
+		fields = append(fields, configdetectionrule.FieldRemediation)
+	}
+	if m.FieldCleared(configdetectionrule.FieldParamsJSON) {
+		fields = append(fields, configdetectionrule.FieldParamsJSON)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ConfigHostingIndicatorMutation) FieldCleared(name string) bool {
+func (m *ConfigDetectionRuleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ConfigHostingIndicatorMutation) ClearField(name string) error {
+func (m *ConfigDetectionRuleMutation) ClearField(name string) error {
 	switch name {
-	case confighostingindicator.FieldDescription:
+	case configdetectionrule.FieldDescription:
 		m.ClearDescription()
 		return nil
-	case confighostingindicator.FieldCountry:
-		m.ClearCountry()
+	case configdetectionrule.FieldRemediation:
+		m.ClearRemediation()
+		return nil
+	case configdetectionrule.FieldParamsJSON:
+		m.ClearParamsJSON()
 		return nil
 	}
-	return fmt.Errorf("unknown ConfigHostingIndicator nullable field %s", name)
+	return fmt.Errorf("unknown ConfigDetectionRule nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ConfigHostingIndicatorMutation) ResetField(name string) error {
+func (m *ConfigDetectionRuleMutation) ResetField(name string) error {
 	switch name {
-	case confighostingindicator.FieldIndicatorType:
-		m.ResetIndicatorType()
+	case configdetectionrule.FieldRuleKey:
+		m.ResetRuleKey()
 		return nil
-	case confighostingindicator.FieldValue:
-		m.ResetValue()
+	case configdetectionrule.FieldName:
+		m.ResetName()
 		return nil
-	case confighostingindicator.FieldDescription:
+	case configdetectionrule.FieldDescription:
 		m.ResetDescription()
 		return nil
-	case confighostingindicator.FieldCountry:
-		m.ResetCountry()
+	case configdetectionrule.FieldCategory:
+		m.ResetCategory()
 		return nil
-	case confighostingindicator.FieldIsActive:
+	case configdetectionrule.FieldSeverity:
+		m.ResetSeverity()
+		return nil
+	case configdetectionrule.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case configdetectionrule.FieldResourceType:
+		m.ResetResourceType()
+		return nil
+	case configdetectionrule.FieldRemediation:
+		m.ResetRemediation()
+		return nil
+	case configdetectionrule.FieldQuery:
+		m.ResetQuery()
+		return nil
+	case configdetectionrule.FieldParamsJSON:
+		m.ResetParamsJSON()
+		return nil
+	case configdetectionrule.FieldTimeoutSeconds:
+		m.ResetTimeoutSeconds()
+		return nil
+	case configdetectionrule.FieldSource:
+		m.ResetSource()
+		return nil
+	case configdetectionrule.FieldIsActive:
 		m.ResetIsActive()
 		return nil
-	case confighostingindicator.FieldCreatedAt:
+	case configdetectionrule.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case confighostingindicator.FieldUpdatedAt:
+	case configdetectionrule.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ConfigHostingIndicator field %s", name)
+	return fmt.Errorf("unknown ConfigDetectionRule field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ConfigHostingIndicatorMutation) AddedEdges() []string {
+func (m *ConfigDetectionRuleMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ConfigHostingIndicatorMutation) AddedIDs(name string) []ent.Value {
+func (m *ConfigDetectionRuleMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ConfigHostingIndicatorMutation) RemovedEdges() []string {
+func (m *ConfigDetectionRuleMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ConfigHostingIndicatorMutation) RemovedIDs(name string) []ent.Value {
+func (m *ConfigDetectionRuleMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ConfigHostingIndicatorMutation) ClearedEdges() []string {
+func (m *ConfigDetectionRuleMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ConfigHostingIndicatorMutation) EdgeCleared(name string) bool {
+func (m *ConfigDetectionRuleMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ConfigHostingIndicatorMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ConfigHostingIndicator unique edge %s", name)
+func (m *ConfigDetectionRuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ConfigDetectionRule unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ConfigHostingIndicatorMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ConfigHostingIndicator edge %s", name)
+func (m *ConfigDetectionRuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ConfigDetectionRule edge %s", name)
 }
 
-// ConfigHttpmonitorRuleMutation represents an operation that mutates the ConfigHttpmonitorRule nodes in the graph.
-type ConfigHttpmonitorRuleMutation struct {
+// ConfigHostingIndicatorMutation represents an operation that mutates the ConfigHostingIndicator nodes in the graph.
+type ConfigHostingIndicatorMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	rule_key        *string
-	anomaly_type    *string
-	severity        *string
-	category        *string
-	name            *string
-	description     *string
-	baseline        *string
-	thresholds_json *map[string]float64
-	status          *string
-	is_active       *bool
-	created_at      *time.Time
-	updated_at      *time.Time
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*ConfigHttpmonitorRule, error)
-	predicates      []predicate.ConfigHttpmonitorRule
+	op             Op
+	typ            string
+	id             *int
+	indicator_type *confighostingindicator.IndicatorType
+	value          *string
+	description    *string
+	country        *string
+	is_active      *bool
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*ConfigHostingIndicator, error)
+	predicates     []predicate.ConfigHostingIndicator
 }
 
-var _ ent.Mutation = (*ConfigHttpmonitorRuleMutation)(nil)
+var _ ent.Mutation = (*ConfigHostingIndicatorMutation)(nil)
 
-// confighttpmonitorruleOption allows management of the mutation configuration using functional options.
-type confighttpmonitorruleOption func(*ConfigHttpmonitorRuleMutation)
+// confighostingindicatorOption allows management of the mutation configuration using functional options.
+type confighostingindicatorOption func(*ConfigHostingIndicatorMutation)
 
-// newConfigHttpmonitorRuleMutation creates new mutation for the ConfigHttpmonitorRule entity.
-func newConfigHttpmonitorRuleMutation(c config, op Op, opts ...confighttpmonitorruleOption) *ConfigHttpmonitorRuleMutation {
-	m := &ConfigHttpmonitorRuleMutation{
+// newConfigHostingIndicatorMutation creates new mutation for the ConfigHostingIndicator entity.
+func newConfigHostingIndicatorMutation(c config, op Op, opts ...confighostingindicatorOption) *ConfigHostingIndicatorMutation {
+	m := &ConfigHostingIndicatorMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeConfigHttpmonitorRule,
+		typ:           TypeConfigHostingIndicator,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1505,20 +1997,20 @@ func newConfigHttpmonitorRuleMutation(c config, op Op, opts ...confighttpmonitor
 	return m
 }
 
-// withConfigHttpmonitorRuleID sets the ID field of the mutation.
-func withConfigHttpmonitorRuleID(id int) confighttpmonitorruleOption {
-	return func(m *ConfigHttpmonitorRuleMutation) {
+// withConfigHostingIndicatorID sets the ID field of the mutation.
+func withConfigHostingIndicatorID(id int) confighostingindicatorOption {
+	return func(m *ConfigHostingIndicatorMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ConfigHttpmonitorRule
+			value *ConfigHostingIndicator
 		)
-		m.oldValue = func(ctx context.Context) (*ConfigHttpmonitorRule, error) {
+		m.oldValue = func(ctx context.Context) (*ConfigHostingIndicator, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ConfigHttpmonitorRule.Get(ctx, id)
+					value, err = m.Client().ConfigHostingIndicator.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1527,10 +2019,10 @@ func withConfigHttpmonitorRuleID(id int) confighttpmonitorruleOption {
 	}
 }
 
-// withConfigHttpmonitorRule sets the old ConfigHttpmonitorRule of the mutation.
-func withConfigHttpmonitorRule(node *ConfigHttpmonitorRule) confighttpmonitorruleOption {
-	return func(m *ConfigHttpmonitorRuleMutation) {
-		m.oldValue = func(context.Context) (*ConfigHttpmonitorRule, error) {
+// withConfigHostingIndicator sets the old ConfigHostingIndicator of the mutation.
+func withConfigHostingIndicator(node *ConfigHostingIndicator) confighostingindicatorOption {
+	return func(m *ConfigHostingIndicatorMutation) {
+		m.oldValue = func(context.Context) (*ConfigHostingIndicator, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1539,7 +2031,7 @@ func withConfigHttpmonitorRule(node *ConfigHttpmonitorRule) confighttpmonitorrul
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ConfigHttpmonitorRuleMutation) Client() *Client {
+func (m ConfigHostingIndicatorMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1547,7 +2039,7 @@ func (m ConfigHttpmonitorRuleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ConfigHttpmonitorRuleMutation) Tx() (*Tx, error) {
+func (m ConfigHostingIndicatorMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("rule: mutation is not running in a transaction")
 	}
@@ -1556,15 +2048,9 @@ func (m ConfigHttpmonitorRuleMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ConfigHttpmonitorRule entities.
-func (m *ConfigHttpmonitorRuleMutation) SetID(id int) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ConfigHttpmonitorRuleMutation) ID() (id int, exists bool) {
+func (m *ConfigHostingIndicatorMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1575,7 +2061,7 @@ func (m *ConfigHttpmonitorRuleMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ConfigHttpmonitorRuleMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ConfigHostingIndicatorMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1584,1058 +2070,2754 @@ func (m *ConfigHttpmonitorRuleMutation) IDs(ctx context.Context) ([]int, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ConfigHttpmonitorRule.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ConfigHostingIndicator.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRuleKey sets the "rule_key" field.
-func (m *ConfigHttpmonitorRuleMutation) SetRuleKey(s string) {
-	m.rule_key = &s
+// SetIndicatorType sets the "indicator_type" field.
+func (m *ConfigHostingIndicatorMutation) SetIndicatorType(ct confighostingindicator.IndicatorType) {
+	m.indicator_type = &ct
 }
 
-// RuleKey returns the value of the "rule_key" field in the mutation.
-func (m *ConfigHttpmonitorRuleMutation) RuleKey() (r string, exists bool) {
-	v := m.rule_key
+// IndicatorType returns the value of the "indicator_type" field in the mutation.
+func (m *ConfigHostingIndicatorMutation) IndicatorType() (r confighostingindicator.IndicatorType, exists bool) {
+	v := m.indicator_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRuleKey returns the old "rule_key" field's value of the ConfigHttpmonitorRule entity.
-// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// OldIndicatorType returns the old "indicator_type" field's value of the ConfigHostingIndicator entity.
+// If the ConfigHostingIndicator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigHttpmonitorRuleMutation) OldRuleKey(ctx context.Context) (v string, err error) {
+func (m *ConfigHostingIndicatorMutation) OldIndicatorType(ctx context.Context) (v confighostingindicator.IndicatorType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRuleKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldIndicatorType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRuleKey requires an ID field in the mutation")
+		return v, errors.New("OldIndicatorType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRuleKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldIndicatorType: %w", err)
 	}
-	return oldValue.RuleKey, nil
+	return oldValue.IndicatorType, nil
 }
 
-// ResetRuleKey resets all changes to the "rule_key" field.
-func (m *ConfigHttpmonitorRuleMutation) ResetRuleKey() {
-	m.rule_key = nil
+// ResetIndicatorType resets all changes to the "indicator_type" field.
+func (m *ConfigHostingIndicatorMutation) ResetIndicatorType() {
+	m.indicator_type = nil
 }
 
-// SetAnomalyType sets the "anomaly_type" field.
-func (m *ConfigHttpmonitorRuleMutation) SetAnomalyType(s string) {
-	m.anomaly_type = &s
+// SetValue sets the "value" field.
+func (m *ConfigHostingIndicatorMutation) SetValue(s string) {
+	m.value = &s
 }
 
-// AnomalyType returns the value of the "anomaly_type" field in the mutation.
-func (m *ConfigHttpmonitorRuleMutation) AnomalyType() (r string, exists bool) {
-	v := m.anomaly_type
+// Value returns the value of the "value" field in the mutation.
+func (m *ConfigHostingIndicatorMutation) Value() (r string, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAnomalyType returns the old "anomaly_type" field's value of the ConfigHttpmonitorRule entity.
-// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the ConfigHostingIndicator entity.
+// If the ConfigHostingIndicator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigHttpmonitorRuleMutation) OldAnomalyType(ctx context.Context) (v string, err error) {
+func (m *ConfigHostingIndicatorMutation) OldValue(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAnomalyType is only allowed on UpdateOne operations")
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAnomalyType requires an ID field in the mutation")
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAnomalyType: %w", err)
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.AnomalyType, nil
+	return oldValue.Value, nil
 }
 
-// ResetAnomalyType resets all changes to the "anomaly_type" field.
-func (m *ConfigHttpmonitorRuleMutation) ResetAnomalyType() {
-	m.anomaly_type = nil
+// ResetValue resets all changes to the "value" field.
+func (m *ConfigHostingIndicatorMutation) ResetValue() {
+	m.value = nil
 }
 
-// SetSeverity sets the "severity" field.
-func (m *ConfigHttpmonitorRuleMutation) SetSeverity(s string) {
-	m.severity = &s
+// SetDescription sets the "description" field.
+func (m *ConfigHostingIndicatorMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// Severity returns the value of the "severity" field in the mutation.
-func (m *ConfigHttpmonitorRuleMutation) Severity() (r string, exists bool) {
-	v := m.severity
+// Description returns the value of the "description" field in the mutation.
+func (m *ConfigHostingIndicatorMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSeverity returns the old "severity" field's value of the ConfigHttpmonitorRule entity.
-// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the ConfigHostingIndicator entity.
+// If the ConfigHostingIndicator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigHttpmonitorRuleMutation) OldSeverity(ctx context.Context) (v string, err error) {
+func (m *ConfigHostingIndicatorMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSeverity is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSeverity requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSeverity: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.Severity, nil
+	return oldValue.Description, nil
 }
 
-// ResetSeverity resets all changes to the "severity" field.
-func (m *ConfigHttpmonitorRuleMutation) ResetSeverity() {
-	m.severity = nil
+// ClearDescription clears the value of the "description" field.
+func (m *ConfigHostingIndicatorMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[confighostingindicator.FieldDescription] = struct{}{}
 }
 
-// SetCategory sets the "category" field.
-func (m *ConfigHttpmonitorRuleMutation) SetCategory(s string) {
-	m.category = &s
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ConfigHostingIndicatorMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[confighostingindicator.FieldDescription]
+	return ok
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *ConfigHttpmonitorRuleMutation) Category() (r string, exists bool) {
-	v := m.category
+// ResetDescription resets all changes to the "description" field.
+func (m *ConfigHostingIndicatorMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, confighostingindicator.FieldDescription)
+}
+
+// SetCountry sets the "country" field.
+func (m *ConfigHostingIndicatorMutation) SetCountry(s string) {
+	m.country = &s
+}
+
+// Country returns the value of the "country" field in the mutation.
+func (m *ConfigHostingIndicatorMutation) Country() (r string, exists bool) {
+	v := m.country
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCategory returns the old "category" field's value of the ConfigHttpmonitorRule entity.
-// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// OldCountry returns the old "country" field's value of the ConfigHostingIndicator entity.
+// If the ConfigHostingIndicator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigHttpmonitorRuleMutation) OldCategory(ctx context.Context) (v string, err error) {
+func (m *ConfigHostingIndicatorMutation) OldCountry(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+		return v, errors.New("OldCountry is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
+		return v, errors.New("OldCountry requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+		return v, fmt.Errorf("querying old value for OldCountry: %w", err)
 	}
-	return oldValue.Category, nil
+	return oldValue.Country, nil
 }
 
-// ResetCategory resets all changes to the "category" field.
-func (m *ConfigHttpmonitorRuleMutation) ResetCategory() {
-	m.category = nil
+// ClearCountry clears the value of the "country" field.
+func (m *ConfigHostingIndicatorMutation) ClearCountry() {
+	m.country = nil
+	m.clearedFields[confighostingindicator.FieldCountry] = struct{}{}
 }
 
-// SetName sets the "name" field.
-func (m *ConfigHttpmonitorRuleMutation) SetName(s string) {
-	m.name = &s
+// CountryCleared returns if the "country" field was cleared in this mutation.
+func (m *ConfigHostingIndicatorMutation) CountryCleared() bool {
+	_, ok := m.clearedFields[confighostingindicator.FieldCountry]
+	return ok
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ConfigHttpmonitorRuleMutation) Name() (r string, exists bool) {
-	v := m.name
+// ResetCountry resets all changes to the "country" field.
+func (m *ConfigHostingIndicatorMutation) ResetCountry() {
+	m.country = nil
+	delete(m.clearedFields, confighostingindicator.FieldCountry)
+}
+
+// SetIsActive sets the "is_active" field.
+func (m *ConfigHostingIndicatorMutation) SetIsActive(b bool) {
+	m.is_active = &b
+}
+
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *ConfigHostingIndicatorMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the ConfigHttpmonitorRule entity.
-// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// OldIsActive returns the old "is_active" field's value of the ConfigHostingIndicator entity.
+// If the ConfigHostingIndicator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigHttpmonitorRuleMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ConfigHostingIndicatorMutation) OldIsActive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.IsActive, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ConfigHttpmonitorRuleMutation) ResetName() {
-	m.name = nil
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *ConfigHostingIndicatorMutation) ResetIsActive() {
+	m.is_active = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ConfigHttpmonitorRuleMutation) SetDescription(s string) {
-	m.description = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *ConfigHostingIndicatorMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ConfigHttpmonitorRuleMutation) Description() (r string, exists bool) {
-	v := m.description
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ConfigHostingIndicatorMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the ConfigHttpmonitorRule entity.
-// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ConfigHostingIndicator entity.
+// If the ConfigHostingIndicator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigHttpmonitorRuleMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *ConfigHostingIndicatorMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ConfigHttpmonitorRuleMutation) ResetDescription() {
-	m.description = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ConfigHostingIndicatorMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetBaseline sets the "baseline" field.
-func (m *ConfigHttpmonitorRuleMutation) SetBaseline(s string) {
-	m.baseline = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ConfigHostingIndicatorMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Baseline returns the value of the "baseline" field in the mutation.
-func (m *ConfigHttpmonitorRuleMutation) Baseline() (r string, exists bool) {
-	v := m.baseline
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ConfigHostingIndicatorMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBaseline returns the old "baseline" field's value of the ConfigHttpmonitorRule entity.
-// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ConfigHostingIndicator entity.
+// If the ConfigHostingIndicator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigHttpmonitorRuleMutation) OldBaseline(ctx context.Context) (v string, err error) {
+func (m *ConfigHostingIndicatorMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBaseline is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBaseline requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBaseline: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Baseline, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearBaseline clears the value of the "baseline" field.
-func (m *ConfigHttpmonitorRuleMutation) ClearBaseline() {
-	m.baseline = nil
-	m.clearedFields[confighttpmonitorrule.FieldBaseline] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ConfigHostingIndicatorMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// BaselineCleared returns if the "baseline" field was cleared in this mutation.
-func (m *ConfigHttpmonitorRuleMutation) BaselineCleared() bool {
-	_, ok := m.clearedFields[confighttpmonitorrule.FieldBaseline]
-	return ok
+// Where appends a list predicates to the ConfigHostingIndicatorMutation builder.
+func (m *ConfigHostingIndicatorMutation) Where(ps ...predicate.ConfigHostingIndicator) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetBaseline resets all changes to the "baseline" field.
-func (m *ConfigHttpmonitorRuleMutation) ResetBaseline() {
-	m.baseline = nil
-	delete(m.clearedFields, confighttpmonitorrule.FieldBaseline)
+// WhereP appends storage-level predicates to the ConfigHostingIndicatorMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConfigHostingIndicatorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConfigHostingIndicator, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetThresholdsJSON sets the "thresholds_json" field.
-func (m *ConfigHttpmonitorRuleMutation) SetThresholdsJSON(value map[string]float64) {
-	m.thresholds_json = &value
+// Op returns the operation name.
+func (m *ConfigHostingIndicatorMutation) Op() Op {
+	return m.op
 }
 
-// ThresholdsJSON returns the value of the "thresholds_json" field in the mutation.
-func (m *ConfigHttpmonitorRuleMutation) ThresholdsJSON() (r map[string]float64, exists bool) {
-	v := m.thresholds_json
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetOp allows setting the mutation operation.
+func (m *ConfigHostingIndicatorMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// OldThresholdsJSON returns the old "thresholds_json" field's value of the ConfigHttpmonitorRule entity.
-// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigHttpmonitorRuleMutation) OldThresholdsJSON(ctx context.Context) (v map[string]float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldThresholdsJSON is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldThresholdsJSON requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldThresholdsJSON: %w", err)
-	}
-	return oldValue.ThresholdsJSON, nil
+// Type returns the node type of this mutation (ConfigHostingIndicator).
+func (m *ConfigHostingIndicatorMutation) Type() string {
+	return m.typ
 }
 
-// ClearThresholdsJSON clears the value of the "thresholds_json" field.
-func (m *ConfigHttpmonitorRuleMutation) ClearThresholdsJSON() {
-	m.thresholds_json = nil
-	m.clearedFields[confighttpmonitorrule.FieldThresholdsJSON] = struct{}{}
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConfigHostingIndicatorMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.indicator_type != nil {
+		fields = append(fields, confighostingindicator.FieldIndicatorType)
+	}
+	if m.value != nil {
+		fields = append(fields, confighostingindicator.FieldValue)
+	}
+	if m.description != nil {
+		fields = append(fields, confighostingindicator.FieldDescription)
+	}
+	if m.country != nil {
+		fields = append(fields, confighostingindicator.FieldCountry)
+	}
+	if m.is_active != nil {
+		fields = append(fields, confighostingindicator.FieldIsActive)
+	}
+	if m.created_at != nil {
+		fields = append(fields, confighostingindicator.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, confighostingindicator.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConfigHostingIndicatorMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case confighostingindicator.FieldIndicatorType:
+		return m.IndicatorType()
+	case confighostingindicator.FieldValue:
+		return m.Value()
+	case confighostingindicator.FieldDescription:
+		return m.Description()
+	case confighostingindicator.FieldCountry:
+		return m.Country()
+	case confighostingindicator.FieldIsActive:
+		return m.IsActive()
+	case confighostingindicator.FieldCreatedAt:
+		return m.CreatedAt()
+	case confighostingindicator.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConfigHostingIndicatorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case confighostingindicator.FieldIndicatorType:
+		return m.OldIndicatorType(ctx)
+	case confighostingindicator.FieldValue:
+		return m.OldValue(ctx)
+	case confighostingindicator.FieldDescription:
+		return m.OldDescription(ctx)
+	case confighostingindicator.FieldCountry:
+		return m.OldCountry(ctx)
+	case confighostingindicator.FieldIsActive:
+		return m.OldIsActive(ctx)
+	case confighostingindicator.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case confighostingindicator.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ConfigHostingIndicator field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigHostingIndicatorMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case confighostingindicator.FieldIndicatorType:
+		v, ok := value.(confighostingindicator.IndicatorType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIndicatorType(v)
+		return nil
+	case confighostingindicator.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case confighostingindicator.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case confighostingindicator.FieldCountry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCountry(v)
+		return nil
+	case confighostingindicator.FieldIsActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsActive(v)
+		return nil
+	case confighostingindicator.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case confighostingindicator.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigHostingIndicator field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConfigHostingIndicatorMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConfigHostingIndicatorMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigHostingIndicatorMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ConfigHostingIndicator numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConfigHostingIndicatorMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(confighostingindicator.FieldDescription) {
+		fields = append(fields, confighostingindicator.FieldDescription)
+	}
+	if m.FieldCleared(confighostingindicator.FieldCountry) {
+		fields = append(fields, confighostingindicator.FieldCountry)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConfigHostingIndicatorMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConfigHostingIndicatorMutation) ClearField(name string) error {
+	switch name {
+	case confighostingindicator.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case confighostingindicator.FieldCountry:
+		m.ClearCountry()
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigHostingIndicator nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConfigHostingIndicatorMutation) ResetField(name string) error {
+	switch name {
+	case confighostingindicator.FieldIndicatorType:
+		m.ResetIndicatorType()
+		return nil
+	case confighostingindicator.FieldValue:
+		m.ResetValue()
+		return nil
+	case confighostingindicator.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case confighostingindicator.FieldCountry:
+		m.ResetCountry()
+		return nil
+	case confighostingindicator.FieldIsActive:
+		m.ResetIsActive()
+		return nil
+	case confighostingindicator.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case confighostingindicator.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigHostingIndicator field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConfigHostingIndicatorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConfigHostingIndicatorMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConfigHostingIndicatorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConfigHostingIndicatorMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConfigHostingIndicatorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConfigHostingIndicatorMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConfigHostingIndicatorMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ConfigHostingIndicator unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConfigHostingIndicatorMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ConfigHostingIndicator edge %s", name)
+}
+
+// ConfigHttpmonitorRuleMutation represents an operation that mutates the ConfigHttpmonitorRule nodes in the graph.
+type ConfigHttpmonitorRuleMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	rule_key        *string
+	anomaly_type    *string
+	severity        *string
+	category        *string
+	name            *string
+	description     *string
+	baseline        *string
+	thresholds_json *map[string]float64
+	status          *string
+	is_active       *bool
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*ConfigHttpmonitorRule, error)
+	predicates      []predicate.ConfigHttpmonitorRule
+}
+
+var _ ent.Mutation = (*ConfigHttpmonitorRuleMutation)(nil)
+
+// confighttpmonitorruleOption allows management of the mutation configuration using functional options.
+type confighttpmonitorruleOption func(*ConfigHttpmonitorRuleMutation)
+
+// newConfigHttpmonitorRuleMutation creates new mutation for the ConfigHttpmonitorRule entity.
+func newConfigHttpmonitorRuleMutation(c config, op Op, opts ...confighttpmonitorruleOption) *ConfigHttpmonitorRuleMutation {
+	m := &ConfigHttpmonitorRuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConfigHttpmonitorRule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withConfigHttpmonitorRuleID sets the ID field of the mutation.
+func withConfigHttpmonitorRuleID(id int) confighttpmonitorruleOption {
+	return func(m *ConfigHttpmonitorRuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ConfigHttpmonitorRule
+		)
+		m.oldValue = func(ctx context.Context) (*ConfigHttpmonitorRule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ConfigHttpmonitorRule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withConfigHttpmonitorRule sets the old ConfigHttpmonitorRule of the mutation.
+func withConfigHttpmonitorRule(node *ConfigHttpmonitorRule) confighttpmonitorruleOption {
+	return func(m *ConfigHttpmonitorRuleMutation) {
+		m.oldValue = func(context.Context) (*ConfigHttpmonitorRule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConfigHttpmonitorRuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConfigHttpmonitorRuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("rule: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ConfigHttpmonitorRule entities.
+func (m *ConfigHttpmonitorRuleMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConfigHttpmonitorRuleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConfigHttpmonitorRuleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ConfigHttpmonitorRule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRuleKey sets the "rule_key" field.
+func (m *ConfigHttpmonitorRuleMutation) SetRuleKey(s string) {
+	m.rule_key = &s
+}
+
+// RuleKey returns the value of the "rule_key" field in the mutation.
+func (m *ConfigHttpmonitorRuleMutation) RuleKey() (r string, exists bool) {
+	v := m.rule_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRuleKey returns the old "rule_key" field's value of the ConfigHttpmonitorRule entity.
+// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHttpmonitorRuleMutation) OldRuleKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRuleKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRuleKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRuleKey: %w", err)
+	}
+	return oldValue.RuleKey, nil
+}
+
+// ResetRuleKey resets all changes to the "rule_key" field.
+func (m *ConfigHttpmonitorRuleMutation) ResetRuleKey() {
+	m.rule_key = nil
+}
+
+// SetAnomalyType sets the "anomaly_type" field.
+func (m *ConfigHttpmonitorRuleMutation) SetAnomalyType(s string) {
+	m.anomaly_type = &s
+}
+
+// AnomalyType returns the value of the "anomaly_type" field in the mutation.
+func (m *ConfigHttpmonitorRuleMutation) AnomalyType() (r string, exists bool) {
+	v := m.anomaly_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnomalyType returns the old "anomaly_type" field's value of the ConfigHttpmonitorRule entity.
+// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHttpmonitorRuleMutation) OldAnomalyType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnomalyType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnomalyType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnomalyType: %w", err)
+	}
+	return oldValue.AnomalyType, nil
+}
+
+// ResetAnomalyType resets all changes to the "anomaly_type" field.
+func (m *ConfigHttpmonitorRuleMutation) ResetAnomalyType() {
+	m.anomaly_type = nil
+}
+
+// SetSeverity sets the "severity" field.
+func (m *ConfigHttpmonitorRuleMutation) SetSeverity(s string) {
+	m.severity = &s
+}
+
+// Severity returns the value of the "severity" field in the mutation.
+func (m *ConfigHttpmonitorRuleMutation) Severity() (r string, exists bool) {
+	v := m.severity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeverity returns the old "severity" field's value of the ConfigHttpmonitorRule entity.
+// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHttpmonitorRuleMutation) OldSeverity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeverity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeverity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeverity: %w", err)
+	}
+	return oldValue.Severity, nil
+}
+
+// ResetSeverity resets all changes to the "severity" field.
+func (m *ConfigHttpmonitorRuleMutation) ResetSeverity() {
+	m.severity = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *ConfigHttpmonitorRuleMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *ConfigHttpmonitorRuleMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the ConfigHttpmonitorRule entity.
+// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHttpmonitorRuleMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *ConfigHttpmonitorRuleMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetName sets the "name" field.
+func (m *ConfigHttpmonitorRuleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ConfigHttpmonitorRuleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ConfigHttpmonitorRule entity.
+// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHttpmonitorRuleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ConfigHttpmonitorRuleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ConfigHttpmonitorRuleMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ConfigHttpmonitorRuleMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the ConfigHttpmonitorRule entity.
+// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHttpmonitorRuleMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ConfigHttpmonitorRuleMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetBaseline sets the "baseline" field.
+func (m *ConfigHttpmonitorRuleMutation) SetBaseline(s string) {
+	m.baseline = &s
+}
+
+// Baseline returns the value of the "baseline" field in the mutation.
+func (m *ConfigHttpmonitorRuleMutation) Baseline() (r string, exists bool) {
+	v := m.baseline
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBaseline returns the old "baseline" field's value of the ConfigHttpmonitorRule entity.
+// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHttpmonitorRuleMutation) OldBaseline(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBaseline is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBaseline requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBaseline: %w", err)
+	}
+	return oldValue.Baseline, nil
+}
+
+// ClearBaseline clears the value of the "baseline" field.
+func (m *ConfigHttpmonitorRuleMutation) ClearBaseline() {
+	m.baseline = nil
+	m.clearedFields[confighttpmonitorrule.FieldBaseline] = struct{}{}
+}
+
+// BaselineCleared returns if the "baseline" field was cleared in this mutation.
+func (m *ConfigHttpmonitorRuleMutation) BaselineCleared() bool {
+	_, ok := m.clearedFields[confighttpmonitorrule.FieldBaseline]
+	return ok
+}
+
+// ResetBaseline resets all changes to the "baseline" field.
+func (m *ConfigHttpmonitorRuleMutation) ResetBaseline() {
+	m.baseline = nil
+	delete(m.clearedFields, confighttpmonitorrule.FieldBaseline)
+}
+
+// SetThresholdsJSON sets the "thresholds_json" field.
+func (m *ConfigHttpmonitorRuleMutation) SetThresholdsJSON(value map[string]float64) {
+	m.thresholds_json = &value
+}
+
+// ThresholdsJSON returns the value of the "thresholds_json" field in the mutation.
+func (m *ConfigHttpmonitorRuleMutation) ThresholdsJSON() (r map[string]float64, exists bool) {
+	v := m.thresholds_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThresholdsJSON returns the old "thresholds_json" field's value of the ConfigHttpmonitorRule entity.
+// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHttpmonitorRuleMutation) OldThresholdsJSON(ctx context.Context) (v map[string]float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThresholdsJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThresholdsJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThresholdsJSON: %w", err)
+	}
+	return oldValue.ThresholdsJSON, nil
+}
+
+// ClearThresholdsJSON clears the value of the "thresholds_json" field.
+func (m *ConfigHttpmonitorRuleMutation) ClearThresholdsJSON() {
+	m.thresholds_json = nil
+	m.clearedFields[confighttpmonitorrule.FieldThresholdsJSON] = struct{}{}
+}
+
+// ThresholdsJSONCleared returns if the "thresholds_json" field was cleared in this mutation.
+func (m *ConfigHttpmonitorRuleMutation) ThresholdsJSONCleared() bool {
+	_, ok := m.clearedFields[confighttpmonitorrule.FieldThresholdsJSON]
+	return ok
+}
+
+// ResetThresholdsJSON resets all changes to the "thresholds_json" field.
+func (m *ConfigHttpmonitorRuleMutation) ResetThresholdsJSON() {
+	m.thresholds_json = nil
+	delete(m.clearedFields, confighttpmonitorrule.FieldThresholdsJSON)
+}
+
+// SetStatus sets the "status" field.
+func (m *ConfigHttpmonitorRuleMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ConfigHttpmonitorRuleMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ConfigHttpmonitorRule entity.
+// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHttpmonitorRuleMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ConfigHttpmonitorRuleMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetIsActive sets the "is_active" field.
+func (m *ConfigHttpmonitorRuleMutation) SetIsActive(b bool) {
+	m.is_active = &b
+}
+
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *ConfigHttpmonitorRuleMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsActive returns the old "is_active" field's value of the ConfigHttpmonitorRule entity.
+// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHttpmonitorRuleMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	}
+	return oldValue.IsActive, nil
+}
+
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *ConfigHttpmonitorRuleMutation) ResetIsActive() {
+	m.is_active = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ConfigHttpmonitorRuleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ConfigHttpmonitorRuleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ConfigHttpmonitorRule entity.
+// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHttpmonitorRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ConfigHttpmonitorRuleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ConfigHttpmonitorRuleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ConfigHttpmonitorRuleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ConfigHttpmonitorRule entity.
+// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigHttpmonitorRuleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ConfigHttpmonitorRuleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ConfigHttpmonitorRuleMutation builder.
+func (m *ConfigHttpmonitorRuleMutation) Where(ps ...predicate.ConfigHttpmonitorRule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConfigHttpmonitorRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConfigHttpmonitorRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConfigHttpmonitorRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConfigHttpmonitorRuleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConfigHttpmonitorRuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ConfigHttpmonitorRule).
+func (m *ConfigHttpmonitorRuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConfigHttpmonitorRuleMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.rule_key != nil {
+		fields = append(fields, confighttpmonitorrule.FieldRuleKey)
+	}
+	if m.anomaly_type != nil {
+		fields = append(fields, confighttpmonitorrule.FieldAnomalyType)
+	}
+	if m.severity != nil {
+		fields = append(fields, confighttpmonitorrule.FieldSeverity)
+	}
+	if m.category != nil {
+		fields = append(fields, confighttpmonitorrule.FieldCategory)
+	}
+	if m.name != nil {
+		fields = append(fields, confighttpmonitorrule.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, confighttpmonitorrule.FieldDescription)
+	}
+	if m.baseline != nil {
+		fields = append(fields, confighttpmonitorrule.FieldBaseline)
+	}
+	if m.thresholds_json != nil {
+		fields = append(fields, confighttpmonitorrule.FieldThresholdsJSON)
+	}
+	if m.status != nil {
+		fields = append(fields, confighttpmonitorrule.FieldStatus)
+	}
+	if m.is_active != nil {
+		fields = append(fields, confighttpmonitorrule.FieldIsActive)
+	}
+	if m.created_at != nil {
+		fields = append(fields, confighttpmonitorrule.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, confighttpmonitorrule.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConfigHttpmonitorRuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case confighttpmonitorrule.FieldRuleKey:
+		return m.RuleKey()
+	case confighttpmonitorrule.FieldAnomalyType:
+		return m.AnomalyType()
+	case confighttpmonitorrule.FieldSeverity:
+		return m.Severity()
+	case confighttpmonitorrule.FieldCategory:
+		return m.Category()
+	case confighttpmonitorrule.FieldName:
+		return m.Name()
+	case confighttpmonitorrule.FieldDescription:
+		return m.Description()
+	case confighttpmonitorrule.FieldBaseline:
+		return m.Baseline()
+	case confighttpmonitorrule.FieldThresholdsJSON:
+		return m.ThresholdsJSON()
+	case confighttpmonitorrule.FieldStatus:
+		return m.Status()
+	case confighttpmonitorrule.FieldIsActive:
+		return m.IsActive()
+	case confighttpmonitorrule.FieldCreatedAt:
+		return m.CreatedAt()
+	case confighttpmonitorrule.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConfigHttpmonitorRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case confighttpmonitorrule.FieldRuleKey:
+		return m.OldRuleKey(ctx)
+	case confighttpmonitorrule.FieldAnomalyType:
+		return m.OldAnomalyType(ctx)
+	case confighttpmonitorrule.FieldSeverity:
+		return m.OldSeverity(ctx)
+	case confighttpmonitorrule.FieldCategory:
+		return m.OldCategory(ctx)
+	case confighttpmonitorrule.FieldName:
+		return m.OldName(ctx)
+	case confighttpmonitorrule.FieldDescription:
+		return m.OldDescription(ctx)
+	case confighttpmonitorrule.FieldBaseline:
+		return m.OldBaseline(ctx)
+	case confighttpmonitorrule.FieldThresholdsJSON:
+		return m.OldThresholdsJSON(ctx)
+	case confighttpmonitorrule.FieldStatus:
+		return m.OldStatus(ctx)
+	case confighttpmonitorrule.FieldIsActive:
+		return m.OldIsActive(ctx)
+	case confighttpmonitorrule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case confighttpmonitorrule.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ConfigHttpmonitorRule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigHttpmonitorRuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case confighttpmonitorrule.FieldRuleKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRuleKey(v)
+		return nil
+	case confighttpmonitorrule.FieldAnomalyType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnomalyType(v)
+		return nil
+	case confighttpmonitorrule.FieldSeverity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeverity(v)
+		return nil
+	case confighttpmonitorrule.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case confighttpmonitorrule.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case confighttpmonitorrule.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case confighttpmonitorrule.FieldBaseline:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBaseline(v)
+		return nil
+	case confighttpmonitorrule.FieldThresholdsJSON:
+		v, ok := value.(map[string]float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThresholdsJSON(v)
+		return nil
+	case confighttpmonitorrule.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case confighttpmonitorrule.FieldIsActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsActive(v)
+		return nil
+	case confighttpmonitorrule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case confighttpmonitorrule.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigHttpmonitorRule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConfigHttpmonitorRuleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConfigHttpmonitorRuleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigHttpmonitorRuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ConfigHttpmonitorRule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConfigHttpmonitorRuleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(confighttpmonitorrule.FieldBaseline) {
+		fields = append(fields, confighttpmonitorrule.FieldBaseline)
+	}
+	if m.FieldCleared(confighttpmonitorrule.FieldThresholdsJSON) {
+		fields = append(fields, confighttpmonitorrule.FieldThresholdsJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConfigHttpmonitorRuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConfigHttpmonitorRuleMutation) ClearField(name string) error {
+	switch name {
+	case confighttpmonitorrule.FieldBaseline:
+		m.ClearBaseline()
+		return nil
+	case confighttpmonitorrule.FieldThresholdsJSON:
+		m.ClearThresholdsJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigHttpmonitorRule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConfigHttpmonitorRuleMutation) ResetField(name string) error {
+	switch name {
+	case confighttpmonitorrule.FieldRuleKey:
+		m.ResetRuleKey()
+		return nil
+	case confighttpmonitorrule.FieldAnomalyType:
+		m.ResetAnomalyType()
+		return nil
+	case confighttpmonitorrule.FieldSeverity:
+		m.ResetSeverity()
+		return nil
+	case confighttpmonitorrule.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case confighttpmonitorrule.FieldName:
+		m.ResetName()
+		return nil
+	case confighttpmonitorrule.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case confighttpmonitorrule.FieldBaseline:
+		m.ResetBaseline()
+		return nil
+	case confighttpmonitorrule.FieldThresholdsJSON:
+		m.ResetThresholdsJSON()
+		return nil
+	case confighttpmonitorrule.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case confighttpmonitorrule.FieldIsActive:
+		m.ResetIsActive()
+		return nil
+	case confighttpmonitorrule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case confighttpmonitorrule.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigHttpmonitorRule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConfigHttpmonitorRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConfigHttpmonitorRuleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConfigHttpmonitorRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConfigHttpmonitorRuleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConfigHttpmonitorRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConfigHttpmonitorRuleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConfigHttpmonitorRuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ConfigHttpmonitorRule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConfigHttpmonitorRuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ConfigHttpmonitorRule edge %s", name)
+}
+
+// ConfigLibraryUaMutation represents an operation that mutates the ConfigLibraryUa nodes in the graph.
+type ConfigLibraryUaMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	family        *string
+	description   *string
+	is_active     *bool
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ConfigLibraryUa, error)
+	predicates    []predicate.ConfigLibraryUa
+}
+
+var _ ent.Mutation = (*ConfigLibraryUaMutation)(nil)
+
+// configlibraryuaOption allows management of the mutation configuration using functional options.
+type configlibraryuaOption func(*ConfigLibraryUaMutation)
+
+// newConfigLibraryUaMutation creates new mutation for the ConfigLibraryUa entity.
+func newConfigLibraryUaMutation(c config, op Op, opts ...configlibraryuaOption) *ConfigLibraryUaMutation {
+	m := &ConfigLibraryUaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConfigLibraryUa,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withConfigLibraryUaID sets the ID field of the mutation.
+func withConfigLibraryUaID(id int) configlibraryuaOption {
+	return func(m *ConfigLibraryUaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ConfigLibraryUa
+		)
+		m.oldValue = func(ctx context.Context) (*ConfigLibraryUa, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ConfigLibraryUa.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withConfigLibraryUa sets the old ConfigLibraryUa of the mutation.
+func withConfigLibraryUa(node *ConfigLibraryUa) configlibraryuaOption {
+	return func(m *ConfigLibraryUaMutation) {
+		m.oldValue = func(context.Context) (*ConfigLibraryUa, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConfigLibraryUaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConfigLibraryUaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("rule: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConfigLibraryUaMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConfigLibraryUaMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ConfigLibraryUa.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFamily sets the "family" field.
+func (m *ConfigLibraryUaMutation) SetFamily(s string) {
+	m.family = &s
+}
+
+// Family returns the value of the "family" field in the mutation.
+func (m *ConfigLibraryUaMutation) Family() (r string, exists bool) {
+	v := m.family
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFamily returns the old "family" field's value of the ConfigLibraryUa entity.
+// If the ConfigLibraryUa object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigLibraryUaMutation) OldFamily(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFamily is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFamily requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFamily: %w", err)
+	}
+	return oldValue.Family, nil
+}
+
+// ResetFamily resets all changes to the "family" field.
+func (m *ConfigLibraryUaMutation) ResetFamily() {
+	m.family = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ConfigLibraryUaMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ConfigLibraryUaMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the ConfigLibraryUa entity.
+// If the ConfigLibraryUa object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigLibraryUaMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ConfigLibraryUaMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[configlibraryua.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ConfigLibraryUaMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[configlibraryua.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ConfigLibraryUaMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, configlibraryua.FieldDescription)
+}
+
+// SetIsActive sets the "is_active" field.
+func (m *ConfigLibraryUaMutation) SetIsActive(b bool) {
+	m.is_active = &b
+}
+
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *ConfigLibraryUaMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsActive returns the old "is_active" field's value of the ConfigLibraryUa entity.
+// If the ConfigLibraryUa object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigLibraryUaMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	}
+	return oldValue.IsActive, nil
+}
+
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *ConfigLibraryUaMutation) ResetIsActive() {
+	m.is_active = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ConfigLibraryUaMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ConfigLibraryUaMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ConfigLibraryUa entity.
+// If the ConfigLibraryUa object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigLibraryUaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ConfigLibraryUaMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ConfigLibraryUaMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ConfigLibraryUaMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ConfigLibraryUa entity.
+// If the ConfigLibraryUa object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigLibraryUaMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ConfigLibraryUaMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ConfigLibraryUaMutation builder.
+func (m *ConfigLibraryUaMutation) Where(ps ...predicate.ConfigLibraryUa) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConfigLibraryUaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConfigLibraryUaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConfigLibraryUa, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConfigLibraryUaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConfigLibraryUaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ConfigLibraryUa).
+func (m *ConfigLibraryUaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConfigLibraryUaMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.family != nil {
+		fields = append(fields, configlibraryua.FieldFamily)
+	}
+	if m.description != nil {
+		fields = append(fields, configlibraryua.FieldDescription)
+	}
+	if m.is_active != nil {
+		fields = append(fields, configlibraryua.FieldIsActive)
+	}
+	if m.created_at != nil {
+		fields = append(fields, configlibraryua.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, configlibraryua.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConfigLibraryUaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case configlibraryua.FieldFamily:
+		return m.Family()
+	case configlibraryua.FieldDescription:
+		return m.Description()
+	case configlibraryua.FieldIsActive:
+		return m.IsActive()
+	case configlibraryua.FieldCreatedAt:
+		return m.CreatedAt()
+	case configlibraryua.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConfigLibraryUaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case configlibraryua.FieldFamily:
+		return m.OldFamily(ctx)
+	case configlibraryua.FieldDescription:
+		return m.OldDescription(ctx)
+	case configlibraryua.FieldIsActive:
+		return m.OldIsActive(ctx)
+	case configlibraryua.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case configlibraryua.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ConfigLibraryUa field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigLibraryUaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case configlibraryua.FieldFamily:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFamily(v)
+		return nil
+	case configlibraryua.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case configlibraryua.FieldIsActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsActive(v)
+		return nil
+	case configlibraryua.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case configlibraryua.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigLibraryUa field %s", name)
 }
 
-// ThresholdsJSONCleared returns if the "thresholds_json" field was cleared in this mutation.
-func (m *ConfigHttpmonitorRuleMutation) ThresholdsJSONCleared() bool {
-	_, ok := m.clearedFields[confighttpmonitorrule.FieldThresholdsJSON]
-	return ok
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConfigLibraryUaMutation) AddedFields() []string {
+	return nil
 }
 
-// ResetThresholdsJSON resets all changes to the "thresholds_json" field.
-func (m *ConfigHttpmonitorRuleMutation) ResetThresholdsJSON() {
-	m.thresholds_json = nil
-	delete(m.clearedFields, confighttpmonitorrule.FieldThresholdsJSON)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConfigLibraryUaMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// SetStatus sets the "status" field.
-func (m *ConfigHttpmonitorRuleMutation) SetStatus(s string) {
-	m.status = &s
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigLibraryUaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ConfigLibraryUa numeric field %s", name)
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *ConfigHttpmonitorRuleMutation) Status() (r string, exists bool) {
-	v := m.status
-	if v == nil {
-		return
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConfigLibraryUaMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(configlibraryua.FieldDescription) {
+		fields = append(fields, configlibraryua.FieldDescription)
 	}
-	return *v, true
+	return fields
 }
 
-// OldStatus returns the old "status" field's value of the ConfigHttpmonitorRule entity.
-// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigHttpmonitorRuleMutation) OldStatus(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConfigLibraryUaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConfigLibraryUaMutation) ClearField(name string) error {
+	switch name {
+	case configlibraryua.FieldDescription:
+		m.ClearDescription()
+		return nil
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	return fmt.Errorf("unknown ConfigLibraryUa nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConfigLibraryUaMutation) ResetField(name string) error {
+	switch name {
+	case configlibraryua.FieldFamily:
+		m.ResetFamily()
+		return nil
+	case configlibraryua.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case configlibraryua.FieldIsActive:
+		m.ResetIsActive()
+		return nil
+	case configlibraryua.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case configlibraryua.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return oldValue.Status, nil
+	return fmt.Errorf("unknown ConfigLibraryUa field %s", name)
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *ConfigHttpmonitorRuleMutation) ResetStatus() {
-	m.status = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConfigLibraryUaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetIsActive sets the "is_active" field.
-func (m *ConfigHttpmonitorRuleMutation) SetIsActive(b bool) {
-	m.is_active = &b
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConfigLibraryUaMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// IsActive returns the value of the "is_active" field in the mutation.
-func (m *ConfigHttpmonitorRuleMutation) IsActive() (r bool, exists bool) {
-	v := m.is_active
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConfigLibraryUaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldIsActive returns the old "is_active" field's value of the ConfigHttpmonitorRule entity.
-// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigHttpmonitorRuleMutation) OldIsActive(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConfigLibraryUaMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConfigLibraryUaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConfigLibraryUaMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConfigLibraryUaMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ConfigLibraryUa unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConfigLibraryUaMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ConfigLibraryUa edge %s", name)
+}
+
+// ConfigNotificationRuleMutation represents an operation that mutates the ConfigNotificationRule nodes in the graph.
+type ConfigNotificationRuleMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	rule_key               *string
+	name                   *string
+	sources                *[]string
+	appendsources          []string
+	severities             *[]string
+	appendseverities       []string
+	anomaly_types          *[]string
+	appendanomaly_types    []string
+	source_ids             *[]string
+	appendsource_ids       []string
+	destinations           *[]string
+	appenddestinations     []string
+	dedup_minutes          *int
+	adddedup_minutes       *int
+	rate_limit_per_hour    *int
+	addrate_limit_per_hour *int
+	is_active              *bool
+	created_at             *time.Time
+	updated_at             *time.Time
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*ConfigNotificationRule, error)
+	predicates             []predicate.ConfigNotificationRule
+}
+
+var _ ent.Mutation = (*ConfigNotificationRuleMutation)(nil)
+
+// confignotificationruleOption allows management of the mutation configuration using functional options.
+type confignotificationruleOption func(*ConfigNotificationRuleMutation)
+
+// newConfigNotificationRuleMutation creates new mutation for the ConfigNotificationRule entity.
+func newConfigNotificationRuleMutation(c config, op Op, opts ...confignotificationruleOption) *ConfigNotificationRuleMutation {
+	m := &ConfigNotificationRuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConfigNotificationRule,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	return m
+}
+
+// withConfigNotificationRuleID sets the ID field of the mutation.
+func withConfigNotificationRuleID(id int) confignotificationruleOption {
+	return func(m *ConfigNotificationRuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ConfigNotificationRule
+		)
+		m.oldValue = func(ctx context.Context) (*ConfigNotificationRule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ConfigNotificationRule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.IsActive, nil
 }
 
-// ResetIsActive resets all changes to the "is_active" field.
-func (m *ConfigHttpmonitorRuleMutation) ResetIsActive() {
-	m.is_active = nil
+// withConfigNotificationRule sets the old ConfigNotificationRule of the mutation.
+func withConfigNotificationRule(node *ConfigNotificationRule) confignotificationruleOption {
+	return func(m *ConfigNotificationRuleMutation) {
+		m.oldValue = func(context.Context) (*ConfigNotificationRule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ConfigHttpmonitorRuleMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConfigNotificationRuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ConfigHttpmonitorRuleMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConfigNotificationRuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("rule: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ConfigHttpmonitorRule entity.
-// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigHttpmonitorRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ConfigNotificationRule entities.
+func (m *ConfigNotificationRuleMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConfigNotificationRuleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.CreatedAt, nil
+	return *m.id, true
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ConfigHttpmonitorRuleMutation) ResetCreatedAt() {
-	m.created_at = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConfigNotificationRuleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ConfigNotificationRule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ConfigHttpmonitorRuleMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetRuleKey sets the "rule_key" field.
+func (m *ConfigNotificationRuleMutation) SetRuleKey(s string) {
+	m.rule_key = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ConfigHttpmonitorRuleMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// RuleKey returns the value of the "rule_key" field in the mutation.
+func (m *ConfigNotificationRuleMutation) RuleKey() (r string, exists bool) {
+	v := m.rule_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ConfigHttpmonitorRule entity.
-// If the ConfigHttpmonitorRule object wasn't provided to the builder, the object is fetched from the database.
+// OldRuleKey returns the old "rule_key" field's value of the ConfigNotificationRule entity.
+// If the ConfigNotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigHttpmonitorRuleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ConfigNotificationRuleMutation) OldRuleKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRuleKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRuleKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRuleKey: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.RuleKey, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ConfigHttpmonitorRuleMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetRuleKey resets all changes to the "rule_key" field.
+func (m *ConfigNotificationRuleMutation) ResetRuleKey() {
+	m.rule_key = nil
 }
 
-// Where appends a list predicates to the ConfigHttpmonitorRuleMutation builder.
-func (m *ConfigHttpmonitorRuleMutation) Where(ps ...predicate.ConfigHttpmonitorRule) {
-	m.predicates = append(m.predicates, ps...)
+// SetName sets the "name" field.
+func (m *ConfigNotificationRuleMutation) SetName(s string) {
+	m.name = &s
 }
 
-// WhereP appends storage-level predicates to the ConfigHttpmonitorRuleMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ConfigHttpmonitorRuleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ConfigHttpmonitorRule, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Name returns the value of the "name" field in the mutation.
+func (m *ConfigNotificationRuleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *ConfigHttpmonitorRuleMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *ConfigHttpmonitorRuleMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (ConfigHttpmonitorRule).
-func (m *ConfigHttpmonitorRuleMutation) Type() string {
-	return m.typ
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ConfigHttpmonitorRuleMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.rule_key != nil {
-		fields = append(fields, confighttpmonitorrule.FieldRuleKey)
-	}
-	if m.anomaly_type != nil {
-		fields = append(fields, confighttpmonitorrule.FieldAnomalyType)
-	}
-	if m.severity != nil {
-		fields = append(fields, confighttpmonitorrule.FieldSeverity)
-	}
-	if m.category != nil {
-		fields = append(fields, confighttpmonitorrule.FieldCategory)
-	}
-	if m.name != nil {
-		fields = append(fields, confighttpmonitorrule.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, confighttpmonitorrule.FieldDescription)
-	}
-	if m.baseline != nil {
-		fields = append(fields, confighttpmonitorrule.FieldBaseline)
-	}
-	if m.thresholds_json != nil {
-		fields = append(fields, confighttpmonitorrule.FieldThresholdsJSON)
-	}
-	if m.status != nil {
-		fields = append(fields, confighttpmonitorrule.FieldStatus)
-	}
-	if m.is_active != nil {
-		fields = append(fields, confighttpmonitorrule.FieldIsActive)
+// OldName returns the old "name" field's value of the ConfigNotificationRule entity.
+// If the ConfigNotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigNotificationRuleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, confighttpmonitorrule.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, confighttpmonitorrule.FieldUpdatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return fields
+	return oldValue.Name, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ConfigHttpmonitorRuleMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case confighttpmonitorrule.FieldRuleKey:
-		return m.RuleKey()
-	case confighttpmonitorrule.FieldAnomalyType:
-		return m.AnomalyType()
-	case confighttpmonitorrule.FieldSeverity:
-		return m.Severity()
-	case confighttpmonitorrule.FieldCategory:
-		return m.Category()
-	case confighttpmonitorrule.FieldName:
-		return m.Name()
-	case confighttpmonitorrule.FieldDescription:
-		return m.Description()
-	case confighttpmonitorrule.FieldBaseline:
-		return m.Baseline()
-	case confighttpmonitorrule.FieldThresholdsJSON:
-		return m.ThresholdsJSON()
-	case confighttpmonitorrule.FieldStatus:
-		return m.Status()
-	case confighttpmonitorrule.FieldIsActive:
-		return m.IsActive()
-	case confighttpmonitorrule.FieldCreatedAt:
-		return m.CreatedAt()
-	case confighttpmonitorrule.FieldUpdatedAt:
-		return m.UpdatedAt()
-	}
-	return nil, false
+// ResetName resets all changes to the "name" field.
+func (m *ConfigNotificationRuleMutation) ResetName() {
+	m.name = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ConfigHttpmonitorRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case confighttpmonitorrule.FieldRuleKey:
-		return m.OldRuleKey(ctx)
-	case confighttpmonitorrule.FieldAnomalyType:
-		return m.OldAnomalyType(ctx)
-	case confighttpmonitorrule.FieldSeverity:
-		return m.OldSeverity(ctx)
-	case confighttpmonitorrule.FieldCategory:
-		return m.OldCategory(ctx)
-	case confighttpmonitorrule.FieldName:
-		return m.OldName(ctx)
-	case confighttpmonitorrule.FieldDescription:
-		return m.OldDescription(ctx)
-	case confighttpmonitorrule.FieldBaseline:
-		return m.OldBaseline(ctx)
-	case confighttpmonitorrule.FieldThresholdsJSON:
-		return m.OldThresholdsJSON(ctx)
-	case confighttpmonitorrule.FieldStatus:
-		return m.OldStatus(ctx)
-	case confighttpmonitorrule.FieldIsActive:
-		return m.OldIsActive(ctx)
-	case confighttpmonitorrule.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case confighttpmonitorrule.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown ConfigHttpmonitorRule field %s", name)
+// SetSources sets the "sources" field.
+func (m *ConfigNotificationRuleMutation) SetSources(s []string) {
+	m.sources = &s
+	m.appendsources = nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ConfigHttpmonitorRuleMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case confighttpmonitorrule.FieldRuleKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRuleKey(v)
-		return nil
-	case confighttpmonitorrule.FieldAnomalyType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAnomalyType(v)
-		return nil
-	case confighttpmonitorrule.FieldSeverity:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSeverity(v)
-		return nil
-	case confighttpmonitorrule.FieldCategory:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCategory(v)
-		return nil
-	case confighttpmonitorrule.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case confighttpmonitorrule.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case confighttpmonitorrule.FieldBaseline:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBaseline(v)
-		return nil
-	case confighttpmonitorrule.FieldThresholdsJSON:
-		v, ok := value.(map[string]float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetThresholdsJSON(v)
-		return nil
-	case confighttpmonitorrule.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case confighttpmonitorrule.FieldIsActive:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsActive(v)
-		return nil
-	case confighttpmonitorrule.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case confighttpmonitorrule.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+// Sources returns the value of the "sources" field in the mutation.
+func (m *ConfigNotificationRuleMutation) Sources() (r []string, exists bool) {
+	v := m.sources
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown ConfigHttpmonitorRule field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ConfigHttpmonitorRuleMutation) AddedFields() []string {
-	return nil
+// OldSources returns the old "sources" field's value of the ConfigNotificationRule entity.
+// If the ConfigNotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigNotificationRuleMutation) OldSources(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSources is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSources requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSources: %w", err)
+	}
+	return oldValue.Sources, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ConfigHttpmonitorRuleMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// AppendSources adds s to the "sources" field.
+func (m *ConfigNotificationRuleMutation) AppendSources(s []string) {
+	m.appendsources = append(m.appendsources, s...)
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ConfigHttpmonitorRuleMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// AppendedSources returns the list of values that were appended to the "sources" field in this mutation.
+func (m *ConfigNotificationRuleMutation) AppendedSources() ([]string, bool) {
+	if len(m.appendsources) == 0 {
+		return nil, false
 	}
-	return fmt.Errorf("unknown ConfigHttpmonitorRule numeric field %s", name)
+	return m.appendsources, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ConfigHttpmonitorRuleMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(confighttpmonitorrule.FieldBaseline) {
-		fields = append(fields, confighttpmonitorrule.FieldBaseline)
-	}
-	if m.FieldCleared(confighttpmonitorrule.FieldThresholdsJSON) {
-		fields = append(fields, confighttpmonitorrule.FieldThresholdsJSON)
-	}
-	return fields
+// ClearSources clears the value of the "sources" field.
+func (m *ConfigNotificationRuleMutation) ClearSources() {
+	m.sources = nil
+	m.appendsources = nil
+	m.clearedFields[confignotificationrule.FieldSources] = struct{}{}
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ConfigHttpmonitorRuleMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// SourcesCleared returns if the "sources" field was cleared in this mutation.
+func (m *ConfigNotificationRuleMutation) SourcesCleared() bool {
+	_, ok := m.clearedFields[confignotificationrule.FieldSources]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ConfigHttpmonitorRuleMutation) ClearField(name string) error {
-	switch name {
-	case confighttpmonitorrule.FieldBaseline:
-		m.ClearBaseline()
-		return nil
-	case confighttpmonitorrule.FieldThresholdsJSON:
-		m.ClearThresholdsJSON()
-		return nil
+// ResetSources resets all changes to the "sources" field.
+func (m *ConfigNotificationRuleMutation) ResetSources() {
+	m.sources = nil
+	m.appendsources = nil
+	delete(m.clearedFields, confignotificationrule.FieldSources)
+}
+
+// SetSeverities sets the "severities" field.
+func (m *ConfigNotificationRuleMutation) SetSeverities(s []string) {
+	m.severities = &s
+	m.appendseverities = nil
+}
+
+// Severities returns the value of the "severities" field in the mutation.
+func (m *ConfigNotificationRuleMutation) Severities() (r []string, exists bool) {
+	v := m.severities
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown ConfigHttpmonitorRule nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ConfigHttpmonitorRuleMutation) ResetField(name string) error {
-	switch name {
-	case confighttpmonitorrule.FieldRuleKey:
-		m.ResetRuleKey()
-		return nil
-	case confighttpmonitorrule.FieldAnomalyType:
-		m.ResetAnomalyType()
-		return nil
-	case confighttpmonitorrule.FieldSeverity:
-		m.ResetSeverity()
-		return nil
-	case confighttpmonitorrule.FieldCategory:
-		m.ResetCategory()
-		return nil
-	case confighttpmonitorrule.FieldName:
-		m.ResetName()
-		return nil
-	case confighttpmonitorrule.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case confighttpmonitorrule.FieldBaseline:
-		m.ResetBaseline()
-		return nil
-	case confighttpmonitorrule.FieldThresholdsJSON:
-		m.ResetThresholdsJSON()
-		return nil
-	case confighttpmonitorrule.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case confighttpmonitorrule.FieldIsActive:
-		m.ResetIsActive()
-		return nil
-	case confighttpmonitorrule.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case confighttpmonitorrule.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
+// OldSeverities returns the old "severities" field's value of the ConfigNotificationRule entity.
+// If the ConfigNotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigNotificationRuleMutation) OldSeverities(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeverities is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown ConfigHttpmonitorRule field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeverities requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeverities: %w", err)
+	}
+	return oldValue.Severities, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ConfigHttpmonitorRuleMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// AppendSeverities adds s to the "severities" field.
+func (m *ConfigNotificationRuleMutation) AppendSeverities(s []string) {
+	m.appendseverities = append(m.appendseverities, s...)
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ConfigHttpmonitorRuleMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// AppendedSeverities returns the list of values that were appended to the "severities" field in this mutation.
+func (m *ConfigNotificationRuleMutation) AppendedSeverities() ([]string, bool) {
+	if len(m.appendseverities) == 0 {
+		return nil, false
+	}
+	return m.appendseverities, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ConfigHttpmonitorRuleMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ClearSeverities clears the value of the "severities" field.
+func (m *ConfigNotificationRuleMutation) ClearSeverities() {
+	m.severities = nil
+	m.appendseverities = nil
+	m.clearedFields[confignotificationrule.FieldSeverities] = struct{}{}
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ConfigHttpmonitorRuleMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// SeveritiesCleared returns if the "severities" field was cleared in this mutation.
+func (m *ConfigNotificationRuleMutation) SeveritiesCleared() bool {
+	_, ok := m.clearedFields[confignotificationrule.FieldSeverities]
+	return ok
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ConfigHttpmonitorRuleMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetSeverities resets all changes to the "severities" field.
+func (m *ConfigNotificationRuleMutation) ResetSeverities() {
+	m.severities = nil
+	m.appendseverities = nil
+	delete(m.clearedFields, confignotificationrule.FieldSeverities)
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ConfigHttpmonitorRuleMutation) EdgeCleared(name string) bool {
-	return false
+// SetAnomalyTypes sets the "anomaly_types" field.
+func (m *ConfigNotificationRuleMutation) SetAnomalyTypes(s []string) {
+	m.anomaly_types = &s
+	m.appendanomaly_types = nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ConfigHttpmonitorRuleMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ConfigHttpmonitorRule unique edge %s", name)
+// AnomalyTypes returns the value of the "anomaly_types" field in the mutation.
+func (m *ConfigNotificationRuleMutation) AnomalyTypes() (r []string, exists bool) {
+	v := m.anomaly_types
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ConfigHttpmonitorRuleMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ConfigHttpmonitorRule edge %s", name)
+// OldAnomalyTypes returns the old "anomaly_types" field's value of the ConfigNotificationRule entity.
+// If the ConfigNotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigNotificationRuleMutation) OldAnomalyTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnomalyTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnomalyTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnomalyTypes: %w", err)
+	}
+	return oldValue.AnomalyTypes, nil
 }
 
-// ConfigLibraryUaMutation represents an operation that mutates the ConfigLibraryUa nodes in the graph.
-type ConfigLibraryUaMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	family        *string
-	description   *string
-	is_active     *bool
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*ConfigLibraryUa, error)
-	predicates    []predicate.ConfigLibraryUa
+// AppendAnomalyTypes adds s to the "anomaly_types" field.
+func (m *ConfigNotificationRuleMutation) AppendAnomalyTypes(s []string) {
+	m.appendanomaly_types = append(m.appendanomaly_types, s...)
 }
 
-var _ ent.Mutation = (*ConfigLibraryUaMutation)(nil)
+// AppendedAnomalyTypes returns the list of values that were appended to the "anomaly_types" field in this mutation.
+func (m *ConfigNotificationRuleMutation) AppendedAnomalyTypes() ([]string, bool) {
+	if len(m.appendanomaly_types) == 0 {
+		return nil, false
+	}
+	return m.appendanomaly_types, true
+}
 
-// configlibraryuaOption allows management of the mutation configuration using functional options.
-type configlibraryuaOption func(*ConfigLibraryUaMutation)
+// ClearAnomalyTypes clears the value of the "anomaly_types" field.
+func (m *ConfigNotificationRuleMutation) ClearAnomalyTypes() {
+	m.anomaly_types = nil
+	m.appendanomaly_types = nil
+	m.clearedFields[confignotificationrule.FieldAnomalyTypes] = struct{}{}
+}
 
-// newConfigLibraryUaMutation creates new mutation for the ConfigLibraryUa entity.
-func newConfigLibraryUaMutation(c config, op Op, opts ...configlibraryuaOption) *ConfigLibraryUaMutation {
-	m := &ConfigLibraryUaMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeConfigLibraryUa,
-		clearedFields: make(map[string]struct{}),
+// AnomalyTypesCleared returns if the "anomaly_types" field was cleared in this mutation.
+func (m *ConfigNotificationRuleMutation) AnomalyTypesCleared() bool {
+	_, ok := m.clearedFields[confignotificationrule.FieldAnomalyTypes]
+	return ok
+}
+
+// ResetAnomalyTypes resets all changes to the "anomaly_types" field.
+func (m *ConfigNotificationRuleMutation) ResetAnomalyTypes() {
+	m.anomaly_types = nil
+	m.appendanomaly_types = nil
+	delete(m.clearedFields, confignotificationrule.FieldAnomalyTypes)
+}
+
+// SetSourceIds sets the "source_ids" field.
+func (m *ConfigNotificationRuleMutation) SetSourceIds(s []string) {
+	m.source_ids = &s
+	m.appendsource_ids = nil
+}
+
+// SourceIds returns the value of the "source_ids" field in the mutation.
+func (m *ConfigNotificationRuleMutation) SourceIds() (r []string, exists bool) {
+	v := m.source_ids
+	if v == nil {
+		return
 	}
-	for _, opt := range opts {
-		opt(m)
+	return *v, true
+}
+
+// OldSourceIds returns the old "source_ids" field's value of the ConfigNotificationRule entity.
+// If the ConfigNotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigNotificationRuleMutation) OldSourceIds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceIds is only allowed on UpdateOne operations")
 	}
-	return m
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceIds: %w", err)
+	}
+	return oldValue.SourceIds, nil
 }
 
-// withConfigLibraryUaID sets the ID field of the mutation.
-func withConfigLibraryUaID(id int) configlibraryuaOption {
-	return func(m *ConfigLibraryUaMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *ConfigLibraryUa
-		)
-		m.oldValue = func(ctx context.Context) (*ConfigLibraryUa, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().ConfigLibraryUa.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// AppendSourceIds adds s to the "source_ids" field.
+func (m *ConfigNotificationRuleMutation) AppendSourceIds(s []string) {
+	m.appendsource_ids = append(m.appendsource_ids, s...)
+}
+
+// AppendedSourceIds returns the list of values that were appended to the "source_ids" field in this mutation.
+func (m *ConfigNotificationRuleMutation) AppendedSourceIds() ([]string, bool) {
+	if len(m.appendsource_ids) == 0 {
+		return nil, false
 	}
+	return m.appendsource_ids, true
 }
 
-// withConfigLibraryUa sets the old ConfigLibraryUa of the mutation.
-func withConfigLibraryUa(node *ConfigLibraryUa) configlibraryuaOption {
-	return func(m *ConfigLibraryUaMutation) {
-		m.oldValue = func(context.Context) (*ConfigLibraryUa, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// ClearSourceIds clears the value of the "source_ids" field.
+func (m *ConfigNotificationRuleMutation) ClearSourceIds() {
+	m.source_ids = nil
+	m.appendsource_ids = nil
+	m.clearedFields[confignotificationrule.FieldSourceIds] = struct{}{}
+}
+
+// SourceIdsCleared returns if the "source_ids" field was cleared in this mutation.
+func (m *ConfigNotificationRuleMutation) SourceIdsCleared() bool {
+	_, ok := m.clearedFields[confignotificationrule.FieldSourceIds]
+	return ok
+}
+
+// ResetSourceIds resets all changes to the "source_ids" field.
+func (m *ConfigNotificationRuleMutation) ResetSourceIds() {
+	m.source_ids = nil
+	m.appendsource_ids = nil
+	delete(m.clearedFields, confignotificationrule.FieldSourceIds)
+}
+
+// SetDestinations sets the "destinations" field.
+func (m *ConfigNotificationRuleMutation) SetDestinations(s []string) {
+	m.destinations = &s
+	m.appenddestinations = nil
+}
+
+// Destinations returns the value of the "destinations" field in the mutation.
+func (m *ConfigNotificationRuleMutation) Destinations() (r []string, exists bool) {
+	v := m.destinations
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ConfigLibraryUaMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// OldDestinations returns the old "destinations" field's value of the ConfigNotificationRule entity.
+// If the ConfigNotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConfigNotificationRuleMutation) OldDestinations(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDestinations is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDestinations requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDestinations: %w", err)
+	}
+	return oldValue.Destinations, nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ConfigLibraryUaMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("rule: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// AppendDestinations adds s to the "destinations" field.
+func (m *ConfigNotificationRuleMutation) AppendDestinations(s []string) {
+	m.appenddestinations = append(m.appenddestinations, s...)
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ConfigLibraryUaMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+// AppendedDestinations returns the list of values that were appended to the "destinations" field in this mutation.
+func (m *ConfigNotificationRuleMutation) AppendedDestinations() ([]string, bool) {
+	if len(m.appenddestinations) == 0 {
+		return nil, false
 	}
-	return *m.id, true
+	return m.appenddestinations, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ConfigLibraryUaMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ConfigLibraryUa.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetDestinations resets all changes to the "destinations" field.
+func (m *ConfigNotificationRuleMutation) ResetDestinations() {
+	m.destinations = nil
+	m.appenddestinations = nil
 }
 
-// SetFamily sets the "family" field.
-func (m *ConfigLibraryUaMutation) SetFamily(s string) {
-	m.family = &s
+// SetDedupMinutes sets the "dedup_minutes" field.
+func (m *ConfigNotificationRuleMutation) SetDedupMinutes(i int) {
+	m.dedup_minutes = &i
+	m.adddedup_minutes = nil
 }
 
-// Family returns the value of the "family" field in the mutation.
-func (m *ConfigLibraryUaMutation) Family() (r string, exists bool) {
-	v := m.family
+// DedupMinutes returns the value of the "dedup_minutes" field in the mutation.
+func (m *ConfigNotificationRuleMutation) DedupMinutes() (r int, exists bool) {
+	v := m.dedup_minutes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFamily returns the old "family" field's value of the ConfigLibraryUa entity.
-// If the ConfigLibraryUa object wasn't provided to the builder, the object is fetched from the database.
+// OldDedupMinutes returns the old "dedup_minutes" field's value of the ConfigNotificationRule entity.
+// If the ConfigNotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigLibraryUaMutation) OldFamily(ctx context.Context) (v string, err error) {
+func (m *ConfigNotificationRuleMutation) OldDedupMinutes(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFamily is only allowed on UpdateOne operations")
+		return v, errors.New("OldDedupMinutes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFamily requires an ID field in the mutation")
+		return v, errors.New("OldDedupMinutes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFamily: %w", err)
+		return v, fmt.Errorf("querying old value for OldDedupMinutes: %w", err)
 	}
-	return oldValue.Family, nil
+	return oldValue.DedupMinutes, nil
 }
 
-// ResetFamily resets all changes to the "family" field.
-func (m *ConfigLibraryUaMutation) ResetFamily() {
-	m.family = nil
+// AddDedupMinutes adds i to the "dedup_minutes" field.
+func (m *ConfigNotificationRuleMutation) AddDedupMinutes(i int) {
+	if m.adddedup_minutes != nil {
+		*m.adddedup_minutes += i
+	} else {
+		m.adddedup_minutes = &i
+	}
 }
 
-// SetDescription sets the "description" field.
-func (m *ConfigLibraryUaMutation) SetDescription(s string) {
-	m.description = &s
+// AddedDedupMinutes returns the value that was added to the "dedup_minutes" field in this mutation.
+func (m *ConfigNotificationRuleMutation) AddedDedupMinutes() (r int, exists bool) {
+	v := m.adddedup_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ConfigLibraryUaMutation) Description() (r string, exists bool) {
-	v := m.description
+// ResetDedupMinutes resets all changes to the "dedup_minutes" field.
+func (m *ConfigNotificationRuleMutation) ResetDedupMinutes() {
+	m.dedup_minutes = nil
+	m.adddedup_minutes = nil
+}
+
+// SetRateLimitPerHour sets the "rate_limit_per_hour" field.
+func (m *ConfigNotificationRuleMutation) SetRateLimitPerHour(i int) {
+	m.rate_limit_per_hour = &i
+	m.addrate_limit_per_hour = nil
+}
+
+// RateLimitPerHour returns the value of the "rate_limit_per_hour" field in the mutation.
+func (m *ConfigNotificationRuleMutation) RateLimitPerHour() (r int, exists bool) {
+	v := m.rate_limit_per_hour
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the ConfigLibraryUa entity.
-// If the ConfigLibraryUa object wasn't provided to the builder, the object is fetched from the database.
+// OldRateLimitPerHour returns the old "rate_limit_per_hour" field's value of the ConfigNotificationRule entity.
+// If the ConfigNotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigLibraryUaMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *ConfigNotificationRuleMutation) OldRateLimitPerHour(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldRateLimitPerHour is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldRateLimitPerHour requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldRateLimitPerHour: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.RateLimitPerHour, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *ConfigLibraryUaMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[configlibraryua.FieldDescription] = struct{}{}
+// AddRateLimitPerHour adds i to the "rate_limit_per_hour" field.
+func (m *ConfigNotificationRuleMutation) AddRateLimitPerHour(i int) {
+	if m.addrate_limit_per_hour != nil {
+		*m.addrate_limit_per_hour += i
+	} else {
+		m.addrate_limit_per_hour = &i
+	}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ConfigLibraryUaMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[configlibraryua.FieldDescription]
-	return ok
+// AddedRateLimitPerHour returns the value that was added to the "rate_limit_per_hour" field in this mutation.
+func (m *ConfigNotificationRuleMutation) AddedRateLimitPerHour() (r int, exists bool) {
+	v := m.addrate_limit_per_hour
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ConfigLibraryUaMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, configlibraryua.FieldDescription)
+// ResetRateLimitPerHour resets all changes to the "rate_limit_per_hour" field.
+func (m *ConfigNotificationRuleMutation) ResetRateLimitPerHour() {
+	m.rate_limit_per_hour = nil
+	m.addrate_limit_per_hour = nil
 }
 
 // SetIsActive sets the "is_active" field.
-func (m *ConfigLibraryUaMutation) SetIsActive(b bool) {
+func (m *ConfigNotificationRuleMutation) SetIsActive(b bool) {
 	m.is_active = &b
 }
 
 // IsActive returns the value of the "is_active" field in the mutation.
-func (m *ConfigLibraryUaMutation) IsActive() (r bool, exists bool) {
+func (m *ConfigNotificationRuleMutation) IsActive() (r bool, exists bool) {
 	v := m.is_active
 	if v == nil {
 		return
@@ -2643,10 +4825,10 @@ func (m *ConfigLibraryUaMutation) IsActive() (r bool, exists bool) {
 	return *v, true
 }
 
-// OldIsActive returns the old "is_active" field's value of the ConfigLibraryUa entity.
-// If the ConfigLibraryUa object wasn't provided to the builder, the object is fetched from the database.
+// OldIsActive returns the old "is_active" field's value of the ConfigNotificationRule entity.
+// If the ConfigNotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigLibraryUaMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+func (m *ConfigNotificationRuleMutation) OldIsActive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
 	}
@@ -2661,17 +4843,17 @@ func (m *ConfigLibraryUaMutation) OldIsActive(ctx context.Context) (v bool, err
 }
 
 // ResetIsActive resets all changes to the "is_active" field.
-func (m *ConfigLibraryUaMutation) ResetIsActive() {
+func (m *ConfigNotificationRuleMutation) ResetIsActive() {
 	m.is_active = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ConfigLibraryUaMutation) SetCreatedAt(t time.Time) {
+func (m *ConfigNotificationRuleMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ConfigLibraryUaMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ConfigNotificationRuleMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2679,10 +4861,10 @@ func (m *ConfigLibraryUaMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ConfigLibraryUa entity.
-// If the ConfigLibraryUa object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ConfigNotificationRule entity.
+// If the ConfigNotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigLibraryUaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ConfigNotificationRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2697,17 +4879,17 @@ func (m *ConfigLibraryUaMutation) OldCreatedAt(ctx context.Context) (v time.Time
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ConfigLibraryUaMutation) ResetCreatedAt() {
+func (m *ConfigNotificationRuleMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *ConfigLibraryUaMutation) SetUpdatedAt(t time.Time) {
+func (m *ConfigNotificationRuleMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ConfigLibraryUaMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ConfigNotificationRuleMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2715,10 +4897,10 @@ func (m *ConfigLibraryUaMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ConfigLibraryUa entity.
-// If the ConfigLibraryUa object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ConfigNotificationRule entity.
+// If the ConfigNotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigLibraryUaMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ConfigNotificationRuleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2733,19 +4915,19 @@ func (m *ConfigLibraryUaMutation) OldUpdatedAt(ctx context.Context) (v time.Time
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ConfigLibraryUaMutation) ResetUpdatedAt() {
+func (m *ConfigNotificationRuleMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// Where appends a list predicates to the ConfigLibraryUaMutation builder.
-func (m *ConfigLibraryUaMutation) Where(ps ...predicate.ConfigLibraryUa) {
+// Where appends a list predicates to the ConfigNotificationRuleMutation builder.
+func (m *ConfigNotificationRuleMutation) Where(ps ...predicate.ConfigNotificationRule) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ConfigLibraryUaMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ConfigNotificationRuleMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ConfigLibraryUaMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ConfigLibraryUa, len(ps))
+func (m *ConfigNotificationRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConfigNotificationRule, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2753,39 +4935,60 @@ func (m *ConfigLibraryUaMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ConfigLibraryUaMutation) Op() Op {
+func (m *ConfigNotificationRuleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ConfigLibraryUaMutation) SetOp(op Op) {
+func (m *ConfigNotificationRuleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ConfigLibraryUa).
-func (m *ConfigLibraryUaMutation) Type() string {
+// Type returns the node type of this mutation (ConfigNotificationRule).
+func (m *ConfigNotificationRuleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ConfigLibraryUaMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.family != nil {
-		fields = append(fields, configlibraryua.FieldFamily)
+func (m *ConfigNotificationRuleMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.rule_key != nil {
+		fields = append(fields, confignotificationrule.FieldRuleKey)
 	}
-	if m.description != nil {
-		fields = append(fields, configlibraryua.FieldDescription)
+	if m.name != nil {
+		fields = append(fields, confignotificationrule.FieldName)
+	}
+	if m.sources != nil {
+		fields = append(fields, confignotificationrule.FieldSources)
+	}
+	if m.severities != nil {
+		fields = append(fields, confignotificationrule.FieldSeverities)
+	}
+	if m.anomaly_types != nil {
+		fields = append(fields, confignotificationrule.FieldAnomalyTypes)
+	}
+	if m.source_ids != nil {
+		fields = append(fields, confignotificationrule.FieldSourceIds)
+	}
+	if m.destinations != nil {
+		fields = append(fields, confignotificationrule.FieldDestinations)
+	}
+	if m.dedup_minutes != nil {
+		fields = append(fields, confignotificationrule.FieldDedupMinutes)
+	}
+	if m.rate_limit_per_hour != nil {
+		fields = append(fields, confignotificationrule.FieldRateLimitPerHour)
 	}
 	if m.is_active != nil {
-		fields = append(fields, configlibraryua.FieldIsActive)
+		fields = append(fields, confignotificationrule.FieldIsActive)
 	}
 	if m.created_at != nil {
-		fields = append(fields, configlibraryua.FieldCreatedAt)
+		fields = append(fields, confignotificationrule.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, configlibraryua.FieldUpdatedAt)
+		fields = append(fields, confignotificationrule.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2793,17 +4996,31 @@ func (m *ConfigLibraryUaMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ConfigLibraryUaMutation) Field(name string) (ent.Value, bool) {
+func (m *ConfigNotificationRuleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case configlibraryua.FieldFamily:
-		return m.Family()
-	case configlibraryua.FieldDescription:
-		return m.Description()
-	case configlibraryua.FieldIsActive:
+	case confignotificationrule.FieldRuleKey:
+		return m.RuleKey()
+	case confignotificationrule.FieldName:
+		return m.Name()
+	case confignotificationrule.FieldSources:
+		return m.Sources()
+	case confignotificationrule.FieldSeverities:
+		return m.Severities()
+	case confignotificationrule.FieldAnomalyTypes:
+		return m.AnomalyTypes()
+	case confignotificationrule.FieldSourceIds:
+		return m.SourceIds()
+	case confignotificationrule.FieldDestinations:
+		return m.Destinations()
+	case confignotificationrule.FieldDedupMinutes:
+		return m.DedupMinutes()
+	case confignotificationrule.FieldRateLimitPerHour:
+		return m.RateLimitPerHour()
+	case confignotificationrule.FieldIsActive:
 		return m.IsActive()
-	case configlibraryua.FieldCreatedAt:
+	case confignotificationrule.FieldCreatedAt:
 		return m.CreatedAt()
-	case configlibraryua.FieldUpdatedAt:
+	case confignotificationrule.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -2812,56 +5029,119 @@ func (m *ConfigLibraryUaMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ConfigLibraryUaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ConfigNotificationRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case configlibraryua.FieldFamily:
-		return m.OldFamily(ctx)
-	case configlibraryua.FieldDescription:
-		return m.OldDescription(ctx)
-	case configlibraryua.FieldIsActive:
+	case confignotificationrule.FieldRuleKey:
+		return m.OldRuleKey(ctx)
+	case confignotificationrule.FieldName:
+		return m.OldName(ctx)
+	case confignotificationrule.FieldSources:
+		return m.OldSources(ctx)
+	case confignotificationrule.FieldSeverities:
+		return m.OldSeverities(ctx)
+	case confignotificationrule.FieldAnomalyTypes:
+		return m.OldAnomalyTypes(ctx)
+	case confignotificationrule.FieldSourceIds:
+		return m.OldSourceIds(ctx)
+	case confignotificationrule.FieldDestinations:
+		return m.OldDestinations(ctx)
+	case confignotificationrule.FieldDedupMinutes:
+		return m.OldDedupMinutes(ctx)
+	case confignotificationrule.FieldRateLimitPerHour:
+		return m.OldRateLimitPerHour(ctx)
+	case confignotificationrule.FieldIsActive:
 		return m.OldIsActive(ctx)
-	case configlibraryua.FieldCreatedAt:
+	case confignotificationrule.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case configlibraryua.FieldUpdatedAt:
+	case confignotificationrule.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ConfigLibraryUa field %s", name)
+	return nil, fmt.Errorf("unknown ConfigNotificationRule field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConfigLibraryUaMutation) SetField(name string, value ent.Value) error {
+func (m *ConfigNotificationRuleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case configlibraryua.FieldFamily:
+	case confignotificationrule.FieldRuleKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFamily(v)
+		m.SetRuleKey(v)
 		return nil
-	case configlibraryua.FieldDescription:
+	case confignotificationrule.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetName(v)
 		return nil
-	case configlibraryua.FieldIsActive:
+	case confignotificationrule.FieldSources:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSources(v)
+		return nil
+	case confignotificationrule.FieldSeverities:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeverities(v)
+		return nil
+	case confignotificationrule.FieldAnomalyTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnomalyTypes(v)
+		return nil
+	case confignotificationrule.FieldSourceIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceIds(v)
+		return nil
+	case confignotificationrule.FieldDestinations:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDestinations(v)
+		return nil
+	case confignotificationrule.FieldDedupMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDedupMinutes(v)
+		return nil
+	case confignotificationrule.FieldRateLimitPerHour:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRateLimitPerHour(v)
+		return nil
+	case confignotificationrule.FieldIsActive:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetIsActive(v)
 		return nil
-	case configlibraryua.FieldCreatedAt:
+	case confignotificationrule.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case configlibraryua.FieldUpdatedAt:
+	case confignotificationrule.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2869,128 +5149,194 @@ func (m *ConfigLibraryUaMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ConfigLibraryUa field %s", name)
+	return fmt.Errorf("unknown ConfigNotificationRule field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ConfigLibraryUaMutation) AddedFields() []string {
-	return nil
+func (m *ConfigNotificationRuleMutation) AddedFields() []string {
+	var fields []string
+	if m.adddedup_minutes != nil {
+		fields = append(fields, confignotificationrule.FieldDedupMinutes)
+	}
+	if m.addrate_limit_per_hour != nil {
+		fields = append(fields, confignotificationrule.FieldRateLimitPerHour)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ConfigLibraryUaMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ConfigNotificationRuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case confignotificationrule.FieldDedupMinutes:
+		return m.AddedDedupMinutes()
+	case confignotificationrule.FieldRateLimitPerHour:
+		return m.AddedRateLimitPerHour()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConfigLibraryUaMutation) AddField(name string, value ent.Value) error {
+func (m *ConfigNotificationRuleMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case confignotificationrule.FieldDedupMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDedupMinutes(v)
+		return nil
+	case confignotificationrule.FieldRateLimitPerHour:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRateLimitPerHour(v)
+		return nil
 	}
-	return fmt.Errorf("unknown ConfigLibraryUa numeric field %s", name)
+	return fmt.Errorf("unknown ConfigNotificationRule numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ConfigLibraryUaMutation) ClearedFields() []string {
+func (m *ConfigNotificationRuleMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(configlibraryua.FieldDescription) {
-		fields = append(fields, configlibraryua.FieldDescription)
+	if m.FieldCleared(confignotificationrule.FieldSources) {
+		fields = append(fields, confignotificationrule.FieldSources)
+	}
+	if m.FieldCleared(confignotificationrule.FieldSeverities) {
+		fields = append(fields, confignotificationrule.FieldSeverities)
+	}
+	if m.FieldCleared(confignotificationrule.FieldAnomalyTypes) {
+		fields = append(fields, confignotificationrule.FieldAnomalyTypes)
+	}
+	if m.FieldCleared(confignotificationrule.FieldSourceIds) {
+		fields = append(fields, confignotificationrule.FieldSourceIds)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ConfigLibraryUaMutation) FieldCleared(name string) bool {
+func (m *ConfigNotificationRuleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ConfigLibraryUaMutation) ClearField(name string) error {
+func (m *ConfigNotificationRuleMutation) ClearField(name string) error {
 	switch name {
-	case configlibraryua.FieldDescription:
-		m.ClearDescription()
+	case confignotificationrule.FieldSources:
+		m.ClearSources()
+		return nil
+	case confignotificationrule.FieldSeverities:
+		m.ClearSeverities()
+		return nil
+	case confignotificationrule.FieldAnomalyTypes:
+		m.ClearAnomalyTypes()
+		return nil
+	case confignotificationrule.FieldSourceIds:
+		m.ClearSourceIds()
 		return nil
 	}
-	return fmt.Errorf("unknown ConfigLibraryUa nullable field %s", name)
+	return fmt.Errorf("unknown ConfigNotificationRule nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ConfigLibraryUaMutation) ResetField(name string) error {
+func (m *ConfigNotificationRuleMutation) ResetField(name string) error {
 	switch name {
-	case configlibraryua.FieldFamily:
-		m.ResetFamily()
+	case confignotificationrule.FieldRuleKey:
+		m.ResetRuleKey()
 		return nil
-	case configlibraryua.FieldDescription:
-		m.ResetDescription()
+	case confignotificationrule.FieldName:
+		m.ResetName()
 		return nil
-	case configlibraryua.FieldIsActive:
+	case confignotificationrule.FieldSources:
+		m.ResetSources()
+		return nil
+	case confignotificationrule.FieldSeverities:
+		m.ResetSeverities()
+		return nil
+	case confignotificationrule.FieldAnomalyTypes:
+		m.ResetAnomalyTypes()
+		return nil
+	case confignotificationrule.FieldSourceIds:
+		m.ResetSourceIds()
+		return nil
+	case confignotificationrule.FieldDestinations:
+		m.ResetDestinations()
+		return nil
+	case confignotificationrule.FieldDedupMinutes:
+		m.ResetDedupMinutes()
+		return nil
+	case confignotificationrule.FieldRateLimitPerHour:
+		m.ResetRateLimitPerHour()
+		return nil
+	case confignotificationrule.FieldIsActive:
 		m.ResetIsActive()
 		return nil
-	case configlibraryua.FieldCreatedAt:
+	case confignotificationrule.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case configlibraryua.FieldUpdatedAt:
+	case confignotificationrule.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ConfigLibraryUa field %s", name)
+	return fmt.Errorf("unknown ConfigNotificationRule field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ConfigLibraryUaMutation) AddedEdges() []string {
+func (m *ConfigNotificationRuleMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ConfigLibraryUaMutation) AddedIDs(name string) []ent.Value {
+func (m *ConfigNotificationRuleMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ConfigLibraryUaMutation) RemovedEdges() []string {
+func (m *ConfigNotificationRuleMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ConfigLibraryUaMutation) RemovedIDs(name string) []ent.Value {
+func (m *ConfigNotificationRuleMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ConfigLibraryUaMutation) ClearedEdges() []string {
+func (m *ConfigNotificationRuleMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ConfigLibraryUaMutation) EdgeCleared(name string) bool {
+func (m *ConfigNotificationRuleMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ConfigLibraryUaMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ConfigLibraryUa unique edge %s", name)
+func (m *ConfigNotificationRuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ConfigNotificationRule unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ConfigLibraryUaMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ConfigLibraryUa edge %s", name)
+func (m *ConfigNotificationRuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ConfigNotificationRule edge %s", name)
 }
 
 // ConfigOsCoreRuleMutation represents an operation that mutates the ConfigOsCoreRule nodes in the graph.
@@ -9,6 +9,9 @@ import (
 // ConfigAuthEndpointPattern is the predicate function for configauthendpointpattern builders.
 type ConfigAuthEndpointPattern func(*sql.Selector)
 
+// ConfigDetectionRule is the predicate function for configdetectionrule builders.
+type ConfigDetectionRule func(*sql.Selector)
+
 // ConfigHostingIndicator is the predicate function for confighostingindicator builders.
 type ConfigHostingIndicator func(*sql.Selector)
 
@@ -18,6 +21,9 @@ type ConfigHttpmonitorRule func(*sql.Selector)
 // ConfigLibraryUa is the predicate function for configlibraryua builders.
 type ConfigLibraryUa func(*sql.Selector)
 
+// ConfigNotificationRule is the predicate function for confignotificationrule builders.
+type ConfigNotificationRule func(*sql.Selector)
+
 // ConfigOsCoreRule is the predicate function for configoscorerule builders.
 type ConfigOsCoreRule func(*sql.Selector)
 
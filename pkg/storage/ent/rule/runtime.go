@@ -4,9 +4,11 @@ package rule
 
 import (
 	"danny.vn/hotpot/pkg/storage/ent/rule/configauthendpointpattern"
+	"danny.vn/hotpot/pkg/storage/ent/rule/configdetectionrule"
 	"danny.vn/hotpot/pkg/storage/ent/rule/confighostingindicator"
 	"danny.vn/hotpot/pkg/storage/ent/rule/confighttpmonitorrule"
 	"danny.vn/hotpot/pkg/storage/ent/rule/configlibraryua"
+	"danny.vn/hotpot/pkg/storage/ent/rule/confignotificationrule"
 	"danny.vn/hotpot/pkg/storage/ent/rule/configoscorerule"
 	"danny.vn/hotpot/pkg/storage/ent/rule/configrpmcorerepo"
 	"danny.vn/hotpot/pkg/storage/ent/rule/configsanctionedcountry"
@@ -44,6 +46,46 @@ func init() {
 	configauthendpointpatternDescIsActive := configauthendpointpatternFields[5].Descriptor()
 	// configauthendpointpattern.DefaultIsActive holds the default value on creation for the is_active field.
 	configauthendpointpattern.DefaultIsActive = configauthendpointpatternDescIsActive.Default.(bool)
+	configdetectionruleFields := schema.ConfigDetectionRule{}.Fields()
+	_ = configdetectionruleFields
+	// configdetectionruleDescName is the schema descriptor for name field.
+	configdetectionruleDescName := configdetectionruleFields[2].Descriptor()
+	// configdetectionrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	configdetectionrule.NameValidator = configdetectionruleDescName.Validators[0].(func(string) error)
+	// configdetectionruleDescCategory is the schema descriptor for category field.
+	configdetectionruleDescCategory := configdetectionruleFields[4].Descriptor()
+	// configdetectionrule.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	configdetectionrule.CategoryValidator = configdetectionruleDescCategory.Validators[0].(func(string) error)
+	// configdetectionruleDescSeverity is the schema descriptor for severity field.
+	configdetectionruleDescSeverity := configdetectionruleFields[5].Descriptor()
+	// configdetectionrule.SeverityValidator is a validator for the "severity" field. It is called by the builders before save.
+	configdetectionrule.SeverityValidator = configdetectionruleDescSeverity.Validators[0].(func(string) error)
+	// configdetectionruleDescProvider is the schema descriptor for provider field.
+	configdetectionruleDescProvider := configdetectionruleFields[6].Descriptor()
+	// configdetectionrule.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	configdetectionrule.ProviderValidator = configdetectionruleDescProvider.Validators[0].(func(string) error)
+	// configdetectionruleDescResourceType is the schema descriptor for resource_type field.
+	configdetectionruleDescResourceType := configdetectionruleFields[7].Descriptor()
+	// configdetectionrule.ResourceTypeValidator is a validator for the "resource_type" field. It is called by the builders before save.
+	configdetectionrule.ResourceTypeValidator = configdetectionruleDescResourceType.Validators[0].(func(string) error)
+	// configdetectionruleDescQuery is the schema descriptor for query field.
+	configdetectionruleDescQuery := configdetectionruleFields[9].Descriptor()
+	// configdetectionrule.QueryValidator is a validator for the "query" field. It is called by the builders before save.
+	configdetectionrule.QueryValidator = configdetectionruleDescQuery.Validators[0].(func(string) error)
+	// configdetectionruleDescTimeoutSeconds is the schema descriptor for timeout_seconds field.
+	configdetectionruleDescTimeoutSeconds := configdetectionruleFields[11].Descriptor()
+	// configdetectionrule.DefaultTimeoutSeconds holds the default value on creation for the timeout_seconds field.
+	configdetectionrule.DefaultTimeoutSeconds = configdetectionruleDescTimeoutSeconds.Default.(int)
+	// configdetectionruleDescSource is the schema descriptor for source field.
+	configdetectionruleDescSource := configdetectionruleFields[12].Descriptor()
+	// configdetectionrule.DefaultSource holds the default value on creation for the source field.
+	configdetectionrule.DefaultSource = configdetectionruleDescSource.Default.(string)
+	// configdetectionrule.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	configdetectionrule.SourceValidator = configdetectionruleDescSource.Validators[0].(func(string) error)
+	// configdetectionruleDescIsActive is the schema descriptor for is_active field.
+	configdetectionruleDescIsActive := configdetectionruleFields[13].Descriptor()
+	// configdetectionrule.DefaultIsActive holds the default value on creation for the is_active field.
+	configdetectionrule.DefaultIsActive = configdetectionruleDescIsActive.Default.(bool)
 	confighostingindicatorFields := schema.ConfigHostingIndicator{}.Fields()
 	_ = confighostingindicatorFields
 	// confighostingindicatorDescValue is the schema descriptor for value field.
@@ -94,6 +136,24 @@ func init() {
 	configlibraryuaDescIsActive := configlibraryuaFields[2].Descriptor()
 	// configlibraryua.DefaultIsActive holds the default value on creation for the is_active field.
 	configlibraryua.DefaultIsActive = configlibraryuaDescIsActive.Default.(bool)
+	confignotificationruleFields := schema.ConfigNotificationRule{}.Fields()
+	_ = confignotificationruleFields
+	// confignotificationruleDescName is the schema descriptor for name field.
+	confignotificationruleDescName := confignotificationruleFields[2].Descriptor()
+	// confignotificationrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	confignotificationrule.NameValidator = confignotificationruleDescName.Validators[0].(func(string) error)
+	// confignotificationruleDescDedupMinutes is the schema descriptor for dedup_minutes field.
+	confignotificationruleDescDedupMinutes := confignotificationruleFields[8].Descriptor()
+	// confignotificationrule.DefaultDedupMinutes holds the default value on creation for the dedup_minutes field.
+	confignotificationrule.DefaultDedupMinutes = confignotificationruleDescDedupMinutes.Default.(int)
+	// confignotificationruleDescRateLimitPerHour is the schema descriptor for rate_limit_per_hour field.
+	confignotificationruleDescRateLimitPerHour := confignotificationruleFields[9].Descriptor()
+	// confignotificationrule.DefaultRateLimitPerHour holds the default value on creation for the rate_limit_per_hour field.
+	confignotificationrule.DefaultRateLimitPerHour = confignotificationruleDescRateLimitPerHour.Default.(int)
+	// confignotificationruleDescIsActive is the schema descriptor for is_active field.
+	confignotificationruleDescIsActive := confignotificationruleFields[10].Descriptor()
+	// confignotificationrule.DefaultIsActive holds the default value on creation for the is_active field.
+	confignotificationrule.DefaultIsActive = confignotificationruleDescIsActive.Default.(bool)
 	configoscoreruleFields := schema.ConfigOsCoreRule{}.Fields()
 	_ = configoscoreruleFields
 	// configoscoreruleDescRuleType is the schema descriptor for rule_type field.
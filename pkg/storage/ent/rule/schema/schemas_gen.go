@@ -9,6 +9,10 @@ type ConfigAuthEndpointPattern struct {
 	config_rule.ConfigAuthEndpointPattern
 }
 
+type ConfigDetectionRule struct {
+	config_rule.ConfigDetectionRule
+}
+
 type ConfigHostingIndicator struct {
 	config_rule.ConfigHostingIndicator
 }
@@ -21,6 +25,10 @@ type ConfigLibraryUa struct {
 	config_rule.ConfigLibraryUa
 }
 
+type ConfigNotificationRule struct {
+	config_rule.ConfigNotificationRule
+}
+
 type ConfigOsCoreRule struct {
 	config_rule.ConfigOsCoreRule
 }
@@ -5,9 +5,11 @@ package rule
 func DefaultSchemaConfig() SchemaConfig {
 	return SchemaConfig{
 		ConfigAuthEndpointPattern: "config",
+		ConfigDetectionRule:       "config",
 		ConfigHostingIndicator:    "config",
 		ConfigHttpmonitorRule:     "config",
 		ConfigLibraryUa:           "config",
+		ConfigNotificationRule:    "config",
 		ConfigOsCoreRule:          "config",
 		ConfigRpmCoreRepo:         "config",
 		ConfigSanctionedCountry:   "config",
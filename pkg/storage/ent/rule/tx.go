@@ -14,12 +14,16 @@ type Tx struct {
 	config
 	// ConfigAuthEndpointPattern is the client for interacting with the ConfigAuthEndpointPattern builders.
 	ConfigAuthEndpointPattern *ConfigAuthEndpointPatternClient
+	// ConfigDetectionRule is the client for interacting with the ConfigDetectionRule builders.
+	ConfigDetectionRule *ConfigDetectionRuleClient
 	// ConfigHostingIndicator is the client for interacting with the ConfigHostingIndicator builders.
 	ConfigHostingIndicator *ConfigHostingIndicatorClient
 	// ConfigHttpmonitorRule is the client for interacting with the ConfigHttpmonitorRule builders.
 	ConfigHttpmonitorRule *ConfigHttpmonitorRuleClient
 	// ConfigLibraryUa is the client for interacting with the ConfigLibraryUa builders.
 	ConfigLibraryUa *ConfigLibraryUaClient
+	// ConfigNotificationRule is the client for interacting with the ConfigNotificationRule builders.
+	ConfigNotificationRule *ConfigNotificationRuleClient
 	// ConfigOsCoreRule is the client for interacting with the ConfigOsCoreRule builders.
 	ConfigOsCoreRule *ConfigOsCoreRuleClient
 	// ConfigRpmCoreRepo is the client for interacting with the ConfigRpmCoreRepo builders.
@@ -164,9 +168,11 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.ConfigAuthEndpointPattern = NewConfigAuthEndpointPatternClient(tx.config)
+	tx.ConfigDetectionRule = NewConfigDetectionRuleClient(tx.config)
 	tx.ConfigHostingIndicator = NewConfigHostingIndicatorClient(tx.config)
 	tx.ConfigHttpmonitorRule = NewConfigHttpmonitorRuleClient(tx.config)
 	tx.ConfigLibraryUa = NewConfigLibraryUaClient(tx.config)
+	tx.ConfigNotificationRule = NewConfigNotificationRuleClient(tx.config)
 	tx.ConfigOsCoreRule = NewConfigOsCoreRuleClient(tx.config)
 	tx.ConfigRpmCoreRepo = NewConfigRpmCoreRepoClient(tx.config)
 	tx.ConfigSanctionedCountry = NewConfigSanctionedCountryClient(tx.config)
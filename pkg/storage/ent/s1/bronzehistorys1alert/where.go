@@ -0,0 +1,1890 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistorys1alert
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldID, id))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldValidTo, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldResourceID, v))
+}
+
+// AgentID applies equality check predicate on the "agent_id" field. It's identical to AgentIDEQ.
+func AgentID(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldAgentID, v))
+}
+
+// AgentUUID applies equality check predicate on the "agent_uuid" field. It's identical to AgentUUIDEQ.
+func AgentUUID(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldAgentUUID, v))
+}
+
+// AgentName applies equality check predicate on the "agent_name" field. It's identical to AgentNameEQ.
+func AgentName(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldAgentName, v))
+}
+
+// AgentOsName applies equality check predicate on the "agent_os_name" field. It's identical to AgentOsNameEQ.
+func AgentOsName(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldAgentOsName, v))
+}
+
+// SiteID applies equality check predicate on the "site_id" field. It's identical to SiteIDEQ.
+func SiteID(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldSiteID, v))
+}
+
+// RuleID applies equality check predicate on the "rule_id" field. It's identical to RuleIDEQ.
+func RuleID(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldRuleID, v))
+}
+
+// RuleName applies equality check predicate on the "rule_name" field. It's identical to RuleNameEQ.
+func RuleName(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldRuleName, v))
+}
+
+// RuleSeverity applies equality check predicate on the "rule_severity" field. It's identical to RuleSeverityEQ.
+func RuleSeverity(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldRuleSeverity, v))
+}
+
+// RuleDescription applies equality check predicate on the "rule_description" field. It's identical to RuleDescriptionEQ.
+func RuleDescription(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldRuleDescription, v))
+}
+
+// RuleScopeLevel applies equality check predicate on the "rule_scope_level" field. It's identical to RuleScopeLevelEQ.
+func RuleScopeLevel(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldRuleScopeLevel, v))
+}
+
+// RuleTreatAsThreat applies equality check predicate on the "rule_treat_as_threat" field. It's identical to RuleTreatAsThreatEQ.
+func RuleTreatAsThreat(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldRuleTreatAsThreat, v))
+}
+
+// AnalystVerdict applies equality check predicate on the "analyst_verdict" field. It's identical to AnalystVerdictEQ.
+func AnalystVerdict(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldAnalystVerdict, v))
+}
+
+// IncidentStatus applies equality check predicate on the "incident_status" field. It's identical to IncidentStatusEQ.
+func IncidentStatus(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldIncidentStatus, v))
+}
+
+// EventType applies equality check predicate on the "event_type" field. It's identical to EventTypeEQ.
+func EventType(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldEventType, v))
+}
+
+// HitType applies equality check predicate on the "hit_type" field. It's identical to HitTypeEQ.
+func HitType(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldHitType, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldSource, v))
+}
+
+// DvEventID applies equality check predicate on the "dv_event_id" field. It's identical to DvEventIDEQ.
+func DvEventID(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldDvEventID, v))
+}
+
+// IsEdr applies equality check predicate on the "is_edr" field. It's identical to IsEdrEQ.
+func IsEdr(v bool) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldIsEdr, v))
+}
+
+// ReportedAt applies equality check predicate on the "reported_at" field. It's identical to ReportedAtEQ.
+func ReportedAt(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldReportedAt, v))
+}
+
+// APICreatedAt applies equality check predicate on the "api_created_at" field. It's identical to APICreatedAtEQ.
+func APICreatedAt(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// APIUpdatedAt applies equality check predicate on the "api_updated_at" field. It's identical to APIUpdatedAtEQ.
+func APIUpdatedAt(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldAPIUpdatedAt, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldValidTo))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// AgentIDEQ applies the EQ predicate on the "agent_id" field.
+func AgentIDEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldAgentID, v))
+}
+
+// AgentIDNEQ applies the NEQ predicate on the "agent_id" field.
+func AgentIDNEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldAgentID, v))
+}
+
+// AgentIDIn applies the In predicate on the "agent_id" field.
+func AgentIDIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldAgentID, vs...))
+}
+
+// AgentIDNotIn applies the NotIn predicate on the "agent_id" field.
+func AgentIDNotIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldAgentID, vs...))
+}
+
+// AgentIDGT applies the GT predicate on the "agent_id" field.
+func AgentIDGT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldAgentID, v))
+}
+
+// AgentIDGTE applies the GTE predicate on the "agent_id" field.
+func AgentIDGTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldAgentID, v))
+}
+
+// AgentIDLT applies the LT predicate on the "agent_id" field.
+func AgentIDLT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldAgentID, v))
+}
+
+// AgentIDLTE applies the LTE predicate on the "agent_id" field.
+func AgentIDLTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldAgentID, v))
+}
+
+// AgentIDContains applies the Contains predicate on the "agent_id" field.
+func AgentIDContains(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContains(FieldAgentID, v))
+}
+
+// AgentIDHasPrefix applies the HasPrefix predicate on the "agent_id" field.
+func AgentIDHasPrefix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasPrefix(FieldAgentID, v))
+}
+
+// AgentIDHasSuffix applies the HasSuffix predicate on the "agent_id" field.
+func AgentIDHasSuffix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasSuffix(FieldAgentID, v))
+}
+
+// AgentIDIsNil applies the IsNil predicate on the "agent_id" field.
+func AgentIDIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldAgentID))
+}
+
+// AgentIDNotNil applies the NotNil predicate on the "agent_id" field.
+func AgentIDNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldAgentID))
+}
+
+// AgentIDEqualFold applies the EqualFold predicate on the "agent_id" field.
+func AgentIDEqualFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEqualFold(FieldAgentID, v))
+}
+
+// AgentIDContainsFold applies the ContainsFold predicate on the "agent_id" field.
+func AgentIDContainsFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContainsFold(FieldAgentID, v))
+}
+
+// AgentUUIDEQ applies the EQ predicate on the "agent_uuid" field.
+func AgentUUIDEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldAgentUUID, v))
+}
+
+// AgentUUIDNEQ applies the NEQ predicate on the "agent_uuid" field.
+func AgentUUIDNEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldAgentUUID, v))
+}
+
+// AgentUUIDIn applies the In predicate on the "agent_uuid" field.
+func AgentUUIDIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldAgentUUID, vs...))
+}
+
+// AgentUUIDNotIn applies the NotIn predicate on the "agent_uuid" field.
+func AgentUUIDNotIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldAgentUUID, vs...))
+}
+
+// AgentUUIDGT applies the GT predicate on the "agent_uuid" field.
+func AgentUUIDGT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldAgentUUID, v))
+}
+
+// AgentUUIDGTE applies the GTE predicate on the "agent_uuid" field.
+func AgentUUIDGTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldAgentUUID, v))
+}
+
+// AgentUUIDLT applies the LT predicate on the "agent_uuid" field.
+func AgentUUIDLT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldAgentUUID, v))
+}
+
+// AgentUUIDLTE applies the LTE predicate on the "agent_uuid" field.
+func AgentUUIDLTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldAgentUUID, v))
+}
+
+// AgentUUIDContains applies the Contains predicate on the "agent_uuid" field.
+func AgentUUIDContains(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContains(FieldAgentUUID, v))
+}
+
+// AgentUUIDHasPrefix applies the HasPrefix predicate on the "agent_uuid" field.
+func AgentUUIDHasPrefix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasPrefix(FieldAgentUUID, v))
+}
+
+// AgentUUIDHasSuffix applies the HasSuffix predicate on the "agent_uuid" field.
+func AgentUUIDHasSuffix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasSuffix(FieldAgentUUID, v))
+}
+
+// AgentUUIDIsNil applies the IsNil predicate on the "agent_uuid" field.
+func AgentUUIDIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldAgentUUID))
+}
+
+// AgentUUIDNotNil applies the NotNil predicate on the "agent_uuid" field.
+func AgentUUIDNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldAgentUUID))
+}
+
+// AgentUUIDEqualFold applies the EqualFold predicate on the "agent_uuid" field.
+func AgentUUIDEqualFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEqualFold(FieldAgentUUID, v))
+}
+
+// AgentUUIDContainsFold applies the ContainsFold predicate on the "agent_uuid" field.
+func AgentUUIDContainsFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContainsFold(FieldAgentUUID, v))
+}
+
+// AgentNameEQ applies the EQ predicate on the "agent_name" field.
+func AgentNameEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldAgentName, v))
+}
+
+// AgentNameNEQ applies the NEQ predicate on the "agent_name" field.
+func AgentNameNEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldAgentName, v))
+}
+
+// AgentNameIn applies the In predicate on the "agent_name" field.
+func AgentNameIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldAgentName, vs...))
+}
+
+// AgentNameNotIn applies the NotIn predicate on the "agent_name" field.
+func AgentNameNotIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldAgentName, vs...))
+}
+
+// AgentNameGT applies the GT predicate on the "agent_name" field.
+func AgentNameGT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldAgentName, v))
+}
+
+// AgentNameGTE applies the GTE predicate on the "agent_name" field.
+func AgentNameGTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldAgentName, v))
+}
+
+// AgentNameLT applies the LT predicate on the "agent_name" field.
+func AgentNameLT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldAgentName, v))
+}
+
+// AgentNameLTE applies the LTE predicate on the "agent_name" field.
+func AgentNameLTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldAgentName, v))
+}
+
+// AgentNameContains applies the Contains predicate on the "agent_name" field.
+func AgentNameContains(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContains(FieldAgentName, v))
+}
+
+// AgentNameHasPrefix applies the HasPrefix predicate on the "agent_name" field.
+func AgentNameHasPrefix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasPrefix(FieldAgentName, v))
+}
+
+// AgentNameHasSuffix applies the HasSuffix predicate on the "agent_name" field.
+func AgentNameHasSuffix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasSuffix(FieldAgentName, v))
+}
+
+// AgentNameIsNil applies the IsNil predicate on the "agent_name" field.
+func AgentNameIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldAgentName))
+}
+
+// AgentNameNotNil applies the NotNil predicate on the "agent_name" field.
+func AgentNameNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldAgentName))
+}
+
+// AgentNameEqualFold applies the EqualFold predicate on the "agent_name" field.
+func AgentNameEqualFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEqualFold(FieldAgentName, v))
+}
+
+// AgentNameContainsFold applies the ContainsFold predicate on the "agent_name" field.
+func AgentNameContainsFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContainsFold(FieldAgentName, v))
+}
+
+// AgentOsNameEQ applies the EQ predicate on the "agent_os_name" field.
+func AgentOsNameEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldAgentOsName, v))
+}
+
+// AgentOsNameNEQ applies the NEQ predicate on the "agent_os_name" field.
+func AgentOsNameNEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldAgentOsName, v))
+}
+
+// AgentOsNameIn applies the In predicate on the "agent_os_name" field.
+func AgentOsNameIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldAgentOsName, vs...))
+}
+
+// AgentOsNameNotIn applies the NotIn predicate on the "agent_os_name" field.
+func AgentOsNameNotIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldAgentOsName, vs...))
+}
+
+// AgentOsNameGT applies the GT predicate on the "agent_os_name" field.
+func AgentOsNameGT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldAgentOsName, v))
+}
+
+// AgentOsNameGTE applies the GTE predicate on the "agent_os_name" field.
+func AgentOsNameGTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldAgentOsName, v))
+}
+
+// AgentOsNameLT applies the LT predicate on the "agent_os_name" field.
+func AgentOsNameLT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldAgentOsName, v))
+}
+
+// AgentOsNameLTE applies the LTE predicate on the "agent_os_name" field.
+func AgentOsNameLTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldAgentOsName, v))
+}
+
+// AgentOsNameContains applies the Contains predicate on the "agent_os_name" field.
+func AgentOsNameContains(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContains(FieldAgentOsName, v))
+}
+
+// AgentOsNameHasPrefix applies the HasPrefix predicate on the "agent_os_name" field.
+func AgentOsNameHasPrefix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasPrefix(FieldAgentOsName, v))
+}
+
+// AgentOsNameHasSuffix applies the HasSuffix predicate on the "agent_os_name" field.
+func AgentOsNameHasSuffix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasSuffix(FieldAgentOsName, v))
+}
+
+// AgentOsNameIsNil applies the IsNil predicate on the "agent_os_name" field.
+func AgentOsNameIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldAgentOsName))
+}
+
+// AgentOsNameNotNil applies the NotNil predicate on the "agent_os_name" field.
+func AgentOsNameNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldAgentOsName))
+}
+
+// AgentOsNameEqualFold applies the EqualFold predicate on the "agent_os_name" field.
+func AgentOsNameEqualFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEqualFold(FieldAgentOsName, v))
+}
+
+// AgentOsNameContainsFold applies the ContainsFold predicate on the "agent_os_name" field.
+func AgentOsNameContainsFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContainsFold(FieldAgentOsName, v))
+}
+
+// SiteIDEQ applies the EQ predicate on the "site_id" field.
+func SiteIDEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldSiteID, v))
+}
+
+// SiteIDNEQ applies the NEQ predicate on the "site_id" field.
+func SiteIDNEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldSiteID, v))
+}
+
+// SiteIDIn applies the In predicate on the "site_id" field.
+func SiteIDIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldSiteID, vs...))
+}
+
+// SiteIDNotIn applies the NotIn predicate on the "site_id" field.
+func SiteIDNotIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldSiteID, vs...))
+}
+
+// SiteIDGT applies the GT predicate on the "site_id" field.
+func SiteIDGT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldSiteID, v))
+}
+
+// SiteIDGTE applies the GTE predicate on the "site_id" field.
+func SiteIDGTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldSiteID, v))
+}
+
+// SiteIDLT applies the LT predicate on the "site_id" field.
+func SiteIDLT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldSiteID, v))
+}
+
+// SiteIDLTE applies the LTE predicate on the "site_id" field.
+func SiteIDLTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldSiteID, v))
+}
+
+// SiteIDContains applies the Contains predicate on the "site_id" field.
+func SiteIDContains(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContains(FieldSiteID, v))
+}
+
+// SiteIDHasPrefix applies the HasPrefix predicate on the "site_id" field.
+func SiteIDHasPrefix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasPrefix(FieldSiteID, v))
+}
+
+// SiteIDHasSuffix applies the HasSuffix predicate on the "site_id" field.
+func SiteIDHasSuffix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasSuffix(FieldSiteID, v))
+}
+
+// SiteIDIsNil applies the IsNil predicate on the "site_id" field.
+func SiteIDIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldSiteID))
+}
+
+// SiteIDNotNil applies the NotNil predicate on the "site_id" field.
+func SiteIDNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldSiteID))
+}
+
+// SiteIDEqualFold applies the EqualFold predicate on the "site_id" field.
+func SiteIDEqualFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEqualFold(FieldSiteID, v))
+}
+
+// SiteIDContainsFold applies the ContainsFold predicate on the "site_id" field.
+func SiteIDContainsFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContainsFold(FieldSiteID, v))
+}
+
+// RuleIDEQ applies the EQ predicate on the "rule_id" field.
+func RuleIDEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldRuleID, v))
+}
+
+// RuleIDNEQ applies the NEQ predicate on the "rule_id" field.
+func RuleIDNEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldRuleID, v))
+}
+
+// RuleIDIn applies the In predicate on the "rule_id" field.
+func RuleIDIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldRuleID, vs...))
+}
+
+// RuleIDNotIn applies the NotIn predicate on the "rule_id" field.
+func RuleIDNotIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldRuleID, vs...))
+}
+
+// RuleIDGT applies the GT predicate on the "rule_id" field.
+func RuleIDGT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldRuleID, v))
+}
+
+// RuleIDGTE applies the GTE predicate on the "rule_id" field.
+func RuleIDGTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldRuleID, v))
+}
+
+// RuleIDLT applies the LT predicate on the "rule_id" field.
+func RuleIDLT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldRuleID, v))
+}
+
+// RuleIDLTE applies the LTE predicate on the "rule_id" field.
+func RuleIDLTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldRuleID, v))
+}
+
+// RuleIDContains applies the Contains predicate on the "rule_id" field.
+func RuleIDContains(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContains(FieldRuleID, v))
+}
+
+// RuleIDHasPrefix applies the HasPrefix predicate on the "rule_id" field.
+func RuleIDHasPrefix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasPrefix(FieldRuleID, v))
+}
+
+// RuleIDHasSuffix applies the HasSuffix predicate on the "rule_id" field.
+func RuleIDHasSuffix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasSuffix(FieldRuleID, v))
+}
+
+// RuleIDIsNil applies the IsNil predicate on the "rule_id" field.
+func RuleIDIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldRuleID))
+}
+
+// RuleIDNotNil applies the NotNil predicate on the "rule_id" field.
+func RuleIDNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldRuleID))
+}
+
+// RuleIDEqualFold applies the EqualFold predicate on the "rule_id" field.
+func RuleIDEqualFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEqualFold(FieldRuleID, v))
+}
+
+// RuleIDContainsFold applies the ContainsFold predicate on the "rule_id" field.
+func RuleIDContainsFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContainsFold(FieldRuleID, v))
+}
+
+// RuleNameEQ applies the EQ predicate on the "rule_name" field.
+func RuleNameEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldRuleName, v))
+}
+
+// RuleNameNEQ applies the NEQ predicate on the "rule_name" field.
+func RuleNameNEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldRuleName, v))
+}
+
+// RuleNameIn applies the In predicate on the "rule_name" field.
+func RuleNameIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldRuleName, vs...))
+}
+
+// RuleNameNotIn applies the NotIn predicate on the "rule_name" field.
+func RuleNameNotIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldRuleName, vs...))
+}
+
+// RuleNameGT applies the GT predicate on the "rule_name" field.
+func RuleNameGT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldRuleName, v))
+}
+
+// RuleNameGTE applies the GTE predicate on the "rule_name" field.
+func RuleNameGTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldRuleName, v))
+}
+
+// RuleNameLT applies the LT predicate on the "rule_name" field.
+func RuleNameLT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldRuleName, v))
+}
+
+// RuleNameLTE applies the LTE predicate on the "rule_name" field.
+func RuleNameLTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldRuleName, v))
+}
+
+// RuleNameContains applies the Contains predicate on the "rule_name" field.
+func RuleNameContains(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContains(FieldRuleName, v))
+}
+
+// RuleNameHasPrefix applies the HasPrefix predicate on the "rule_name" field.
+func RuleNameHasPrefix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasPrefix(FieldRuleName, v))
+}
+
+// RuleNameHasSuffix applies the HasSuffix predicate on the "rule_name" field.
+func RuleNameHasSuffix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasSuffix(FieldRuleName, v))
+}
+
+// RuleNameIsNil applies the IsNil predicate on the "rule_name" field.
+func RuleNameIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldRuleName))
+}
+
+// RuleNameNotNil applies the NotNil predicate on the "rule_name" field.
+func RuleNameNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldRuleName))
+}
+
+// RuleNameEqualFold applies the EqualFold predicate on the "rule_name" field.
+func RuleNameEqualFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEqualFold(FieldRuleName, v))
+}
+
+// RuleNameContainsFold applies the ContainsFold predicate on the "rule_name" field.
+func RuleNameContainsFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContainsFold(FieldRuleName, v))
+}
+
+// RuleSeverityEQ applies the EQ predicate on the "rule_severity" field.
+func RuleSeverityEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldRuleSeverity, v))
+}
+
+// RuleSeverityNEQ applies the NEQ predicate on the "rule_severity" field.
+func RuleSeverityNEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldRuleSeverity, v))
+}
+
+// RuleSeverityIn applies the In predicate on the "rule_severity" field.
+func RuleSeverityIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldRuleSeverity, vs...))
+}
+
+// RuleSeverityNotIn applies the NotIn predicate on the "rule_severity" field.
+func RuleSeverityNotIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldRuleSeverity, vs...))
+}
+
+// RuleSeverityGT applies the GT predicate on the "rule_severity" field.
+func RuleSeverityGT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldRuleSeverity, v))
+}
+
+// RuleSeverityGTE applies the GTE predicate on the "rule_severity" field.
+func RuleSeverityGTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldRuleSeverity, v))
+}
+
+// RuleSeverityLT applies the LT predicate on the "rule_severity" field.
+func RuleSeverityLT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldRuleSeverity, v))
+}
+
+// RuleSeverityLTE applies the LTE predicate on the "rule_severity" field.
+func RuleSeverityLTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldRuleSeverity, v))
+}
+
+// RuleSeverityContains applies the Contains predicate on the "rule_severity" field.
+func RuleSeverityContains(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContains(FieldRuleSeverity, v))
+}
+
+// RuleSeverityHasPrefix applies the HasPrefix predicate on the "rule_severity" field.
+func RuleSeverityHasPrefix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasPrefix(FieldRuleSeverity, v))
+}
+
+// RuleSeverityHasSuffix applies the HasSuffix predicate on the "rule_severity" field.
+func RuleSeverityHasSuffix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasSuffix(FieldRuleSeverity, v))
+}
+
+// RuleSeverityIsNil applies the IsNil predicate on the "rule_severity" field.
+func RuleSeverityIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldRuleSeverity))
+}
+
+// RuleSeverityNotNil applies the NotNil predicate on the "rule_severity" field.
+func RuleSeverityNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldRuleSeverity))
+}
+
+// RuleSeverityEqualFold applies the EqualFold predicate on the "rule_severity" field.
+func RuleSeverityEqualFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEqualFold(FieldRuleSeverity, v))
+}
+
+// RuleSeverityContainsFold applies the ContainsFold predicate on the "rule_severity" field.
+func RuleSeverityContainsFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContainsFold(FieldRuleSeverity, v))
+}
+
+// RuleDescriptionEQ applies the EQ predicate on the "rule_description" field.
+func RuleDescriptionEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldRuleDescription, v))
+}
+
+// RuleDescriptionNEQ applies the NEQ predicate on the "rule_description" field.
+func RuleDescriptionNEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldRuleDescription, v))
+}
+
+// RuleDescriptionIn applies the In predicate on the "rule_description" field.
+func RuleDescriptionIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldRuleDescription, vs...))
+}
+
+// RuleDescriptionNotIn applies the NotIn predicate on the "rule_description" field.
+func RuleDescriptionNotIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldRuleDescription, vs...))
+}
+
+// RuleDescriptionGT applies the GT predicate on the "rule_description" field.
+func RuleDescriptionGT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldRuleDescription, v))
+}
+
+// RuleDescriptionGTE applies the GTE predicate on the "rule_description" field.
+func RuleDescriptionGTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldRuleDescription, v))
+}
+
+// RuleDescriptionLT applies the LT predicate on the "rule_description" field.
+func RuleDescriptionLT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldRuleDescription, v))
+}
+
+// RuleDescriptionLTE applies the LTE predicate on the "rule_description" field.
+func RuleDescriptionLTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldRuleDescription, v))
+}
+
+// RuleDescriptionContains applies the Contains predicate on the "rule_description" field.
+func RuleDescriptionContains(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContains(FieldRuleDescription, v))
+}
+
+// RuleDescriptionHasPrefix applies the HasPrefix predicate on the "rule_description" field.
+func RuleDescriptionHasPrefix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasPrefix(FieldRuleDescription, v))
+}
+
+// RuleDescriptionHasSuffix applies the HasSuffix predicate on the "rule_description" field.
+func RuleDescriptionHasSuffix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasSuffix(FieldRuleDescription, v))
+}
+
+// RuleDescriptionIsNil applies the IsNil predicate on the "rule_description" field.
+func RuleDescriptionIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldRuleDescription))
+}
+
+// RuleDescriptionNotNil applies the NotNil predicate on the "rule_description" field.
+func RuleDescriptionNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldRuleDescription))
+}
+
+// RuleDescriptionEqualFold applies the EqualFold predicate on the "rule_description" field.
+func RuleDescriptionEqualFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEqualFold(FieldRuleDescription, v))
+}
+
+// RuleDescriptionContainsFold applies the ContainsFold predicate on the "rule_description" field.
+func RuleDescriptionContainsFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContainsFold(FieldRuleDescription, v))
+}
+
+// RuleScopeLevelEQ applies the EQ predicate on the "rule_scope_level" field.
+func RuleScopeLevelEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldRuleScopeLevel, v))
+}
+
+// RuleScopeLevelNEQ applies the NEQ predicate on the "rule_scope_level" field.
+func RuleScopeLevelNEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldRuleScopeLevel, v))
+}
+
+// RuleScopeLevelIn applies the In predicate on the "rule_scope_level" field.
+func RuleScopeLevelIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldRuleScopeLevel, vs...))
+}
+
+// RuleScopeLevelNotIn applies the NotIn predicate on the "rule_scope_level" field.
+func RuleScopeLevelNotIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldRuleScopeLevel, vs...))
+}
+
+// RuleScopeLevelGT applies the GT predicate on the "rule_scope_level" field.
+func RuleScopeLevelGT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldRuleScopeLevel, v))
+}
+
+// RuleScopeLevelGTE applies the GTE predicate on the "rule_scope_level" field.
+func RuleScopeLevelGTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldRuleScopeLevel, v))
+}
+
+// RuleScopeLevelLT applies the LT predicate on the "rule_scope_level" field.
+func RuleScopeLevelLT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldRuleScopeLevel, v))
+}
+
+// RuleScopeLevelLTE applies the LTE predicate on the "rule_scope_level" field.
+func RuleScopeLevelLTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldRuleScopeLevel, v))
+}
+
+// RuleScopeLevelContains applies the Contains predicate on the "rule_scope_level" field.
+func RuleScopeLevelContains(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContains(FieldRuleScopeLevel, v))
+}
+
+// RuleScopeLevelHasPrefix applies the HasPrefix predicate on the "rule_scope_level" field.
+func RuleScopeLevelHasPrefix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasPrefix(FieldRuleScopeLevel, v))
+}
+
+// RuleScopeLevelHasSuffix applies the HasSuffix predicate on the "rule_scope_level" field.
+func RuleScopeLevelHasSuffix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasSuffix(FieldRuleScopeLevel, v))
+}
+
+// RuleScopeLevelIsNil applies the IsNil predicate on the "rule_scope_level" field.
+func RuleScopeLevelIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldRuleScopeLevel))
+}
+
+// RuleScopeLevelNotNil applies the NotNil predicate on the "rule_scope_level" field.
+func RuleScopeLevelNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldRuleScopeLevel))
+}
+
+// RuleScopeLevelEqualFold applies the EqualFold predicate on the "rule_scope_level" field.
+func RuleScopeLevelEqualFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEqualFold(FieldRuleScopeLevel, v))
+}
+
+// RuleScopeLevelContainsFold applies the ContainsFold predicate on the "rule_scope_level" field.
+func RuleScopeLevelContainsFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContainsFold(FieldRuleScopeLevel, v))
+}
+
+// RuleTreatAsThreatEQ applies the EQ predicate on the "rule_treat_as_threat" field.
+func RuleTreatAsThreatEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldRuleTreatAsThreat, v))
+}
+
+// RuleTreatAsThreatNEQ applies the NEQ predicate on the "rule_treat_as_threat" field.
+func RuleTreatAsThreatNEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldRuleTreatAsThreat, v))
+}
+
+// RuleTreatAsThreatIn applies the In predicate on the "rule_treat_as_threat" field.
+func RuleTreatAsThreatIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldRuleTreatAsThreat, vs...))
+}
+
+// RuleTreatAsThreatNotIn applies the NotIn predicate on the "rule_treat_as_threat" field.
+func RuleTreatAsThreatNotIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldRuleTreatAsThreat, vs...))
+}
+
+// RuleTreatAsThreatGT applies the GT predicate on the "rule_treat_as_threat" field.
+func RuleTreatAsThreatGT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldRuleTreatAsThreat, v))
+}
+
+// RuleTreatAsThreatGTE applies the GTE predicate on the "rule_treat_as_threat" field.
+func RuleTreatAsThreatGTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldRuleTreatAsThreat, v))
+}
+
+// RuleTreatAsThreatLT applies the LT predicate on the "rule_treat_as_threat" field.
+func RuleTreatAsThreatLT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldRuleTreatAsThreat, v))
+}
+
+// RuleTreatAsThreatLTE applies the LTE predicate on the "rule_treat_as_threat" field.
+func RuleTreatAsThreatLTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldRuleTreatAsThreat, v))
+}
+
+// RuleTreatAsThreatContains applies the Contains predicate on the "rule_treat_as_threat" field.
+func RuleTreatAsThreatContains(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContains(FieldRuleTreatAsThreat, v))
+}
+
+// RuleTreatAsThreatHasPrefix applies the HasPrefix predicate on the "rule_treat_as_threat" field.
+func RuleTreatAsThreatHasPrefix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasPrefix(FieldRuleTreatAsThreat, v))
+}
+
+// RuleTreatAsThreatHasSuffix applies the HasSuffix predicate on the "rule_treat_as_threat" field.
+func RuleTreatAsThreatHasSuffix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasSuffix(FieldRuleTreatAsThreat, v))
+}
+
+// RuleTreatAsThreatIsNil applies the IsNil predicate on the "rule_treat_as_threat" field.
+func RuleTreatAsThreatIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldRuleTreatAsThreat))
+}
+
+// RuleTreatAsThreatNotNil applies the NotNil predicate on the "rule_treat_as_threat" field.
+func RuleTreatAsThreatNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldRuleTreatAsThreat))
+}
+
+// RuleTreatAsThreatEqualFold applies the EqualFold predicate on the "rule_treat_as_threat" field.
+func RuleTreatAsThreatEqualFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEqualFold(FieldRuleTreatAsThreat, v))
+}
+
+// RuleTreatAsThreatContainsFold applies the ContainsFold predicate on the "rule_treat_as_threat" field.
+func RuleTreatAsThreatContainsFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContainsFold(FieldRuleTreatAsThreat, v))
+}
+
+// AnalystVerdictEQ applies the EQ predicate on the "analyst_verdict" field.
+func AnalystVerdictEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictNEQ applies the NEQ predicate on the "analyst_verdict" field.
+func AnalystVerdictNEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictIn applies the In predicate on the "analyst_verdict" field.
+func AnalystVerdictIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldAnalystVerdict, vs...))
+}
+
+// AnalystVerdictNotIn applies the NotIn predicate on the "analyst_verdict" field.
+func AnalystVerdictNotIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldAnalystVerdict, vs...))
+}
+
+// AnalystVerdictGT applies the GT predicate on the "analyst_verdict" field.
+func AnalystVerdictGT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictGTE applies the GTE predicate on the "analyst_verdict" field.
+func AnalystVerdictGTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictLT applies the LT predicate on the "analyst_verdict" field.
+func AnalystVerdictLT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictLTE applies the LTE predicate on the "analyst_verdict" field.
+func AnalystVerdictLTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictContains applies the Contains predicate on the "analyst_verdict" field.
+func AnalystVerdictContains(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContains(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictHasPrefix applies the HasPrefix predicate on the "analyst_verdict" field.
+func AnalystVerdictHasPrefix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasPrefix(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictHasSuffix applies the HasSuffix predicate on the "analyst_verdict" field.
+func AnalystVerdictHasSuffix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasSuffix(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictIsNil applies the IsNil predicate on the "analyst_verdict" field.
+func AnalystVerdictIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldAnalystVerdict))
+}
+
+// AnalystVerdictNotNil applies the NotNil predicate on the "analyst_verdict" field.
+func AnalystVerdictNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldAnalystVerdict))
+}
+
+// AnalystVerdictEqualFold applies the EqualFold predicate on the "analyst_verdict" field.
+func AnalystVerdictEqualFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEqualFold(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictContainsFold applies the ContainsFold predicate on the "analyst_verdict" field.
+func AnalystVerdictContainsFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContainsFold(FieldAnalystVerdict, v))
+}
+
+// IncidentStatusEQ applies the EQ predicate on the "incident_status" field.
+func IncidentStatusEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldIncidentStatus, v))
+}
+
+// IncidentStatusNEQ applies the NEQ predicate on the "incident_status" field.
+func IncidentStatusNEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldIncidentStatus, v))
+}
+
+// IncidentStatusIn applies the In predicate on the "incident_status" field.
+func IncidentStatusIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldIncidentStatus, vs...))
+}
+
+// IncidentStatusNotIn applies the NotIn predicate on the "incident_status" field.
+func IncidentStatusNotIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldIncidentStatus, vs...))
+}
+
+// IncidentStatusGT applies the GT predicate on the "incident_status" field.
+func IncidentStatusGT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldIncidentStatus, v))
+}
+
+// IncidentStatusGTE applies the GTE predicate on the "incident_status" field.
+func IncidentStatusGTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldIncidentStatus, v))
+}
+
+// IncidentStatusLT applies the LT predicate on the "incident_status" field.
+func IncidentStatusLT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldIncidentStatus, v))
+}
+
+// IncidentStatusLTE applies the LTE predicate on the "incident_status" field.
+func IncidentStatusLTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldIncidentStatus, v))
+}
+
+// IncidentStatusContains applies the Contains predicate on the "incident_status" field.
+func IncidentStatusContains(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContains(FieldIncidentStatus, v))
+}
+
+// IncidentStatusHasPrefix applies the HasPrefix predicate on the "incident_status" field.
+func IncidentStatusHasPrefix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasPrefix(FieldIncidentStatus, v))
+}
+
+// IncidentStatusHasSuffix applies the HasSuffix predicate on the "incident_status" field.
+func IncidentStatusHasSuffix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasSuffix(FieldIncidentStatus, v))
+}
+
+// IncidentStatusIsNil applies the IsNil predicate on the "incident_status" field.
+func IncidentStatusIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldIncidentStatus))
+}
+
+// IncidentStatusNotNil applies the NotNil predicate on the "incident_status" field.
+func IncidentStatusNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldIncidentStatus))
+}
+
+// IncidentStatusEqualFold applies the EqualFold predicate on the "incident_status" field.
+func IncidentStatusEqualFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEqualFold(FieldIncidentStatus, v))
+}
+
+// IncidentStatusContainsFold applies the ContainsFold predicate on the "incident_status" field.
+func IncidentStatusContainsFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContainsFold(FieldIncidentStatus, v))
+}
+
+// EventTypeEQ applies the EQ predicate on the "event_type" field.
+func EventTypeEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldEventType, v))
+}
+
+// EventTypeNEQ applies the NEQ predicate on the "event_type" field.
+func EventTypeNEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldEventType, v))
+}
+
+// EventTypeIn applies the In predicate on the "event_type" field.
+func EventTypeIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldEventType, vs...))
+}
+
+// EventTypeNotIn applies the NotIn predicate on the "event_type" field.
+func EventTypeNotIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldEventType, vs...))
+}
+
+// EventTypeGT applies the GT predicate on the "event_type" field.
+func EventTypeGT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldEventType, v))
+}
+
+// EventTypeGTE applies the GTE predicate on the "event_type" field.
+func EventTypeGTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldEventType, v))
+}
+
+// EventTypeLT applies the LT predicate on the "event_type" field.
+func EventTypeLT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldEventType, v))
+}
+
+// EventTypeLTE applies the LTE predicate on the "event_type" field.
+func EventTypeLTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldEventType, v))
+}
+
+// EventTypeContains applies the Contains predicate on the "event_type" field.
+func EventTypeContains(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContains(FieldEventType, v))
+}
+
+// EventTypeHasPrefix applies the HasPrefix predicate on the "event_type" field.
+func EventTypeHasPrefix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasPrefix(FieldEventType, v))
+}
+
+// EventTypeHasSuffix applies the HasSuffix predicate on the "event_type" field.
+func EventTypeHasSuffix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasSuffix(FieldEventType, v))
+}
+
+// EventTypeIsNil applies the IsNil predicate on the "event_type" field.
+func EventTypeIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldEventType))
+}
+
+// EventTypeNotNil applies the NotNil predicate on the "event_type" field.
+func EventTypeNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldEventType))
+}
+
+// EventTypeEqualFold applies the EqualFold predicate on the "event_type" field.
+func EventTypeEqualFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEqualFold(FieldEventType, v))
+}
+
+// EventTypeContainsFold applies the ContainsFold predicate on the "event_type" field.
+func EventTypeContainsFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContainsFold(FieldEventType, v))
+}
+
+// HitTypeEQ applies the EQ predicate on the "hit_type" field.
+func HitTypeEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldHitType, v))
+}
+
+// HitTypeNEQ applies the NEQ predicate on the "hit_type" field.
+func HitTypeNEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldHitType, v))
+}
+
+// HitTypeIn applies the In predicate on the "hit_type" field.
+func HitTypeIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldHitType, vs...))
+}
+
+// HitTypeNotIn applies the NotIn predicate on the "hit_type" field.
+func HitTypeNotIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldHitType, vs...))
+}
+
+// HitTypeGT applies the GT predicate on the "hit_type" field.
+func HitTypeGT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldHitType, v))
+}
+
+// HitTypeGTE applies the GTE predicate on the "hit_type" field.
+func HitTypeGTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldHitType, v))
+}
+
+// HitTypeLT applies the LT predicate on the "hit_type" field.
+func HitTypeLT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldHitType, v))
+}
+
+// HitTypeLTE applies the LTE predicate on the "hit_type" field.
+func HitTypeLTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldHitType, v))
+}
+
+// HitTypeContains applies the Contains predicate on the "hit_type" field.
+func HitTypeContains(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContains(FieldHitType, v))
+}
+
+// HitTypeHasPrefix applies the HasPrefix predicate on the "hit_type" field.
+func HitTypeHasPrefix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasPrefix(FieldHitType, v))
+}
+
+// HitTypeHasSuffix applies the HasSuffix predicate on the "hit_type" field.
+func HitTypeHasSuffix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasSuffix(FieldHitType, v))
+}
+
+// HitTypeIsNil applies the IsNil predicate on the "hit_type" field.
+func HitTypeIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldHitType))
+}
+
+// HitTypeNotNil applies the NotNil predicate on the "hit_type" field.
+func HitTypeNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldHitType))
+}
+
+// HitTypeEqualFold applies the EqualFold predicate on the "hit_type" field.
+func HitTypeEqualFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEqualFold(FieldHitType, v))
+}
+
+// HitTypeContainsFold applies the ContainsFold predicate on the "hit_type" field.
+func HitTypeContainsFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContainsFold(FieldHitType, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceIsNil applies the IsNil predicate on the "source" field.
+func SourceIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldSource))
+}
+
+// SourceNotNil applies the NotNil predicate on the "source" field.
+func SourceNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldSource))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContainsFold(FieldSource, v))
+}
+
+// DvEventIDEQ applies the EQ predicate on the "dv_event_id" field.
+func DvEventIDEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldDvEventID, v))
+}
+
+// DvEventIDNEQ applies the NEQ predicate on the "dv_event_id" field.
+func DvEventIDNEQ(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldDvEventID, v))
+}
+
+// DvEventIDIn applies the In predicate on the "dv_event_id" field.
+func DvEventIDIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldDvEventID, vs...))
+}
+
+// DvEventIDNotIn applies the NotIn predicate on the "dv_event_id" field.
+func DvEventIDNotIn(vs ...string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldDvEventID, vs...))
+}
+
+// DvEventIDGT applies the GT predicate on the "dv_event_id" field.
+func DvEventIDGT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldDvEventID, v))
+}
+
+// DvEventIDGTE applies the GTE predicate on the "dv_event_id" field.
+func DvEventIDGTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldDvEventID, v))
+}
+
+// DvEventIDLT applies the LT predicate on the "dv_event_id" field.
+func DvEventIDLT(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldDvEventID, v))
+}
+
+// DvEventIDLTE applies the LTE predicate on the "dv_event_id" field.
+func DvEventIDLTE(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldDvEventID, v))
+}
+
+// DvEventIDContains applies the Contains predicate on the "dv_event_id" field.
+func DvEventIDContains(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContains(FieldDvEventID, v))
+}
+
+// DvEventIDHasPrefix applies the HasPrefix predicate on the "dv_event_id" field.
+func DvEventIDHasPrefix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasPrefix(FieldDvEventID, v))
+}
+
+// DvEventIDHasSuffix applies the HasSuffix predicate on the "dv_event_id" field.
+func DvEventIDHasSuffix(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldHasSuffix(FieldDvEventID, v))
+}
+
+// DvEventIDIsNil applies the IsNil predicate on the "dv_event_id" field.
+func DvEventIDIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldDvEventID))
+}
+
+// DvEventIDNotNil applies the NotNil predicate on the "dv_event_id" field.
+func DvEventIDNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldDvEventID))
+}
+
+// DvEventIDEqualFold applies the EqualFold predicate on the "dv_event_id" field.
+func DvEventIDEqualFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEqualFold(FieldDvEventID, v))
+}
+
+// DvEventIDContainsFold applies the ContainsFold predicate on the "dv_event_id" field.
+func DvEventIDContainsFold(v string) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldContainsFold(FieldDvEventID, v))
+}
+
+// IsEdrEQ applies the EQ predicate on the "is_edr" field.
+func IsEdrEQ(v bool) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldIsEdr, v))
+}
+
+// IsEdrNEQ applies the NEQ predicate on the "is_edr" field.
+func IsEdrNEQ(v bool) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldIsEdr, v))
+}
+
+// ReportedAtEQ applies the EQ predicate on the "reported_at" field.
+func ReportedAtEQ(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldReportedAt, v))
+}
+
+// ReportedAtNEQ applies the NEQ predicate on the "reported_at" field.
+func ReportedAtNEQ(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldReportedAt, v))
+}
+
+// ReportedAtIn applies the In predicate on the "reported_at" field.
+func ReportedAtIn(vs ...time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldReportedAt, vs...))
+}
+
+// ReportedAtNotIn applies the NotIn predicate on the "reported_at" field.
+func ReportedAtNotIn(vs ...time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldReportedAt, vs...))
+}
+
+// ReportedAtGT applies the GT predicate on the "reported_at" field.
+func ReportedAtGT(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldReportedAt, v))
+}
+
+// ReportedAtGTE applies the GTE predicate on the "reported_at" field.
+func ReportedAtGTE(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldReportedAt, v))
+}
+
+// ReportedAtLT applies the LT predicate on the "reported_at" field.
+func ReportedAtLT(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldReportedAt, v))
+}
+
+// ReportedAtLTE applies the LTE predicate on the "reported_at" field.
+func ReportedAtLTE(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldReportedAt, v))
+}
+
+// ReportedAtIsNil applies the IsNil predicate on the "reported_at" field.
+func ReportedAtIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldReportedAt))
+}
+
+// ReportedAtNotNil applies the NotNil predicate on the "reported_at" field.
+func ReportedAtNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldReportedAt))
+}
+
+// APICreatedAtEQ applies the EQ predicate on the "api_created_at" field.
+func APICreatedAtEQ(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtNEQ applies the NEQ predicate on the "api_created_at" field.
+func APICreatedAtNEQ(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtIn applies the In predicate on the "api_created_at" field.
+func APICreatedAtIn(vs ...time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtNotIn applies the NotIn predicate on the "api_created_at" field.
+func APICreatedAtNotIn(vs ...time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtGT applies the GT predicate on the "api_created_at" field.
+func APICreatedAtGT(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtGTE applies the GTE predicate on the "api_created_at" field.
+func APICreatedAtGTE(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLT applies the LT predicate on the "api_created_at" field.
+func APICreatedAtLT(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLTE applies the LTE predicate on the "api_created_at" field.
+func APICreatedAtLTE(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtIsNil applies the IsNil predicate on the "api_created_at" field.
+func APICreatedAtIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldAPICreatedAt))
+}
+
+// APICreatedAtNotNil applies the NotNil predicate on the "api_created_at" field.
+func APICreatedAtNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldAPICreatedAt))
+}
+
+// APIUpdatedAtEQ applies the EQ predicate on the "api_updated_at" field.
+func APIUpdatedAtEQ(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldEQ(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtNEQ applies the NEQ predicate on the "api_updated_at" field.
+func APIUpdatedAtNEQ(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNEQ(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtIn applies the In predicate on the "api_updated_at" field.
+func APIUpdatedAtIn(vs ...time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIn(FieldAPIUpdatedAt, vs...))
+}
+
+// APIUpdatedAtNotIn applies the NotIn predicate on the "api_updated_at" field.
+func APIUpdatedAtNotIn(vs ...time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotIn(FieldAPIUpdatedAt, vs...))
+}
+
+// APIUpdatedAtGT applies the GT predicate on the "api_updated_at" field.
+func APIUpdatedAtGT(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGT(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtGTE applies the GTE predicate on the "api_updated_at" field.
+func APIUpdatedAtGTE(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldGTE(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtLT applies the LT predicate on the "api_updated_at" field.
+func APIUpdatedAtLT(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLT(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtLTE applies the LTE predicate on the "api_updated_at" field.
+func APIUpdatedAtLTE(v time.Time) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldLTE(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtIsNil applies the IsNil predicate on the "api_updated_at" field.
+func APIUpdatedAtIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldAPIUpdatedAt))
+}
+
+// APIUpdatedAtNotNil applies the NotNil predicate on the "api_updated_at" field.
+func APIUpdatedAtNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldAPIUpdatedAt))
+}
+
+// SourceProcessJSONIsNil applies the IsNil predicate on the "source_process_json" field.
+func SourceProcessJSONIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldSourceProcessJSON))
+}
+
+// SourceProcessJSONNotNil applies the NotNil predicate on the "source_process_json" field.
+func SourceProcessJSONNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldSourceProcessJSON))
+}
+
+// TargetProcessJSONIsNil applies the IsNil predicate on the "target_process_json" field.
+func TargetProcessJSONIsNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldIsNull(FieldTargetProcessJSON))
+}
+
+// TargetProcessJSONNotNil applies the NotNil predicate on the "target_process_json" field.
+func TargetProcessJSONNotNil() predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.FieldNotNull(FieldTargetProcessJSON))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeHistoryS1Alert) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeHistoryS1Alert) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeHistoryS1Alert) predicate.BronzeHistoryS1Alert {
+	return predicate.BronzeHistoryS1Alert(sql.NotPredicates(p))
+}
@@ -0,0 +1,671 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1alert"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryS1AlertCreate is the builder for creating a BronzeHistoryS1Alert entity.
+type BronzeHistoryS1AlertCreate struct {
+	config
+	mutation *BronzeHistoryS1AlertMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryS1AlertCreate) SetValidFrom(v time.Time) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryS1AlertCreate) SetValidTo(v time.Time) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryS1AlertCreate) SetCollectedAt(v time.Time) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryS1AlertCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryS1AlertCreate) SetResourceID(v string) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetAgentID sets the "agent_id" field.
+func (_c *BronzeHistoryS1AlertCreate) SetAgentID(v string) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetAgentID(v)
+	return _c
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableAgentID(v *string) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetAgentID(*v)
+	}
+	return _c
+}
+
+// SetAgentUUID sets the "agent_uuid" field.
+func (_c *BronzeHistoryS1AlertCreate) SetAgentUUID(v string) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetAgentUUID(v)
+	return _c
+}
+
+// SetNillableAgentUUID sets the "agent_uuid" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableAgentUUID(v *string) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetAgentUUID(*v)
+	}
+	return _c
+}
+
+// SetAgentName sets the "agent_name" field.
+func (_c *BronzeHistoryS1AlertCreate) SetAgentName(v string) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetAgentName(v)
+	return _c
+}
+
+// SetNillableAgentName sets the "agent_name" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableAgentName(v *string) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetAgentName(*v)
+	}
+	return _c
+}
+
+// SetAgentOsName sets the "agent_os_name" field.
+func (_c *BronzeHistoryS1AlertCreate) SetAgentOsName(v string) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetAgentOsName(v)
+	return _c
+}
+
+// SetNillableAgentOsName sets the "agent_os_name" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableAgentOsName(v *string) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetAgentOsName(*v)
+	}
+	return _c
+}
+
+// SetSiteID sets the "site_id" field.
+func (_c *BronzeHistoryS1AlertCreate) SetSiteID(v string) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetSiteID(v)
+	return _c
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableSiteID(v *string) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetSiteID(*v)
+	}
+	return _c
+}
+
+// SetRuleID sets the "rule_id" field.
+func (_c *BronzeHistoryS1AlertCreate) SetRuleID(v string) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetRuleID(v)
+	return _c
+}
+
+// SetNillableRuleID sets the "rule_id" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableRuleID(v *string) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetRuleID(*v)
+	}
+	return _c
+}
+
+// SetRuleName sets the "rule_name" field.
+func (_c *BronzeHistoryS1AlertCreate) SetRuleName(v string) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetRuleName(v)
+	return _c
+}
+
+// SetNillableRuleName sets the "rule_name" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableRuleName(v *string) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetRuleName(*v)
+	}
+	return _c
+}
+
+// SetRuleSeverity sets the "rule_severity" field.
+func (_c *BronzeHistoryS1AlertCreate) SetRuleSeverity(v string) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetRuleSeverity(v)
+	return _c
+}
+
+// SetNillableRuleSeverity sets the "rule_severity" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableRuleSeverity(v *string) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetRuleSeverity(*v)
+	}
+	return _c
+}
+
+// SetRuleDescription sets the "rule_description" field.
+func (_c *BronzeHistoryS1AlertCreate) SetRuleDescription(v string) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetRuleDescription(v)
+	return _c
+}
+
+// SetNillableRuleDescription sets the "rule_description" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableRuleDescription(v *string) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetRuleDescription(*v)
+	}
+	return _c
+}
+
+// SetRuleScopeLevel sets the "rule_scope_level" field.
+func (_c *BronzeHistoryS1AlertCreate) SetRuleScopeLevel(v string) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetRuleScopeLevel(v)
+	return _c
+}
+
+// SetNillableRuleScopeLevel sets the "rule_scope_level" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableRuleScopeLevel(v *string) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetRuleScopeLevel(*v)
+	}
+	return _c
+}
+
+// SetRuleTreatAsThreat sets the "rule_treat_as_threat" field.
+func (_c *BronzeHistoryS1AlertCreate) SetRuleTreatAsThreat(v string) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetRuleTreatAsThreat(v)
+	return _c
+}
+
+// SetNillableRuleTreatAsThreat sets the "rule_treat_as_threat" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableRuleTreatAsThreat(v *string) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetRuleTreatAsThreat(*v)
+	}
+	return _c
+}
+
+// SetAnalystVerdict sets the "analyst_verdict" field.
+func (_c *BronzeHistoryS1AlertCreate) SetAnalystVerdict(v string) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetAnalystVerdict(v)
+	return _c
+}
+
+// SetNillableAnalystVerdict sets the "analyst_verdict" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableAnalystVerdict(v *string) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetAnalystVerdict(*v)
+	}
+	return _c
+}
+
+// SetIncidentStatus sets the "incident_status" field.
+func (_c *BronzeHistoryS1AlertCreate) SetIncidentStatus(v string) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetIncidentStatus(v)
+	return _c
+}
+
+// SetNillableIncidentStatus sets the "incident_status" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableIncidentStatus(v *string) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetIncidentStatus(*v)
+	}
+	return _c
+}
+
+// SetEventType sets the "event_type" field.
+func (_c *BronzeHistoryS1AlertCreate) SetEventType(v string) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetEventType(v)
+	return _c
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableEventType(v *string) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetEventType(*v)
+	}
+	return _c
+}
+
+// SetHitType sets the "hit_type" field.
+func (_c *BronzeHistoryS1AlertCreate) SetHitType(v string) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetHitType(v)
+	return _c
+}
+
+// SetNillableHitType sets the "hit_type" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableHitType(v *string) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetHitType(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *BronzeHistoryS1AlertCreate) SetSource(v string) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableSource(v *string) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetDvEventID sets the "dv_event_id" field.
+func (_c *BronzeHistoryS1AlertCreate) SetDvEventID(v string) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetDvEventID(v)
+	return _c
+}
+
+// SetNillableDvEventID sets the "dv_event_id" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableDvEventID(v *string) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetDvEventID(*v)
+	}
+	return _c
+}
+
+// SetIsEdr sets the "is_edr" field.
+func (_c *BronzeHistoryS1AlertCreate) SetIsEdr(v bool) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetIsEdr(v)
+	return _c
+}
+
+// SetNillableIsEdr sets the "is_edr" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableIsEdr(v *bool) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetIsEdr(*v)
+	}
+	return _c
+}
+
+// SetReportedAt sets the "reported_at" field.
+func (_c *BronzeHistoryS1AlertCreate) SetReportedAt(v time.Time) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetReportedAt(v)
+	return _c
+}
+
+// SetNillableReportedAt sets the "reported_at" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableReportedAt(v *time.Time) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetReportedAt(*v)
+	}
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeHistoryS1AlertCreate) SetAPICreatedAt(v time.Time) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_c *BronzeHistoryS1AlertCreate) SetAPIUpdatedAt(v time.Time) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetAPIUpdatedAt(v)
+	return _c
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_c *BronzeHistoryS1AlertCreate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeHistoryS1AlertCreate {
+	if v != nil {
+		_c.SetAPIUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetSourceProcessJSON sets the "source_process_json" field.
+func (_c *BronzeHistoryS1AlertCreate) SetSourceProcessJSON(v json.RawMessage) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetSourceProcessJSON(v)
+	return _c
+}
+
+// SetTargetProcessJSON sets the "target_process_json" field.
+func (_c *BronzeHistoryS1AlertCreate) SetTargetProcessJSON(v json.RawMessage) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetTargetProcessJSON(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryS1AlertCreate) SetID(v uint) *BronzeHistoryS1AlertCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryS1AlertMutation object of the builder.
+func (_c *BronzeHistoryS1AlertCreate) Mutation() *BronzeHistoryS1AlertMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryS1Alert in the database.
+func (_c *BronzeHistoryS1AlertCreate) Save(ctx context.Context) (*BronzeHistoryS1Alert, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryS1AlertCreate) SaveX(ctx context.Context) *BronzeHistoryS1Alert {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryS1AlertCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryS1AlertCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeHistoryS1AlertCreate) defaults() {
+	if _, ok := _c.mutation.IsEdr(); !ok {
+		v := bronzehistorys1alert.DefaultIsEdr
+		_c.mutation.SetIsEdr(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryS1AlertCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`s1: missing required field "BronzeHistoryS1Alert.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`s1: missing required field "BronzeHistoryS1Alert.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`s1: missing required field "BronzeHistoryS1Alert.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`s1: missing required field "BronzeHistoryS1Alert.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistorys1alert.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Alert.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsEdr(); !ok {
+		return &ValidationError{Name: "is_edr", err: errors.New(`s1: missing required field "BronzeHistoryS1Alert.is_edr"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryS1AlertCreate) sqlSave(ctx context.Context) (*BronzeHistoryS1Alert, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryS1AlertCreate) createSpec() (*BronzeHistoryS1Alert, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryS1Alert{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistorys1alert.Table, sqlgraph.NewFieldSpec(bronzehistorys1alert.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryS1Alert
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.AgentID(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAgentID, field.TypeString, value)
+		_node.AgentID = value
+	}
+	if value, ok := _c.mutation.AgentUUID(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAgentUUID, field.TypeString, value)
+		_node.AgentUUID = value
+	}
+	if value, ok := _c.mutation.AgentName(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAgentName, field.TypeString, value)
+		_node.AgentName = value
+	}
+	if value, ok := _c.mutation.AgentOsName(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAgentOsName, field.TypeString, value)
+		_node.AgentOsName = value
+	}
+	if value, ok := _c.mutation.SiteID(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldSiteID, field.TypeString, value)
+		_node.SiteID = value
+	}
+	if value, ok := _c.mutation.RuleID(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldRuleID, field.TypeString, value)
+		_node.RuleID = value
+	}
+	if value, ok := _c.mutation.RuleName(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldRuleName, field.TypeString, value)
+		_node.RuleName = value
+	}
+	if value, ok := _c.mutation.RuleSeverity(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldRuleSeverity, field.TypeString, value)
+		_node.RuleSeverity = value
+	}
+	if value, ok := _c.mutation.RuleDescription(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldRuleDescription, field.TypeString, value)
+		_node.RuleDescription = value
+	}
+	if value, ok := _c.mutation.RuleScopeLevel(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldRuleScopeLevel, field.TypeString, value)
+		_node.RuleScopeLevel = value
+	}
+	if value, ok := _c.mutation.RuleTreatAsThreat(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldRuleTreatAsThreat, field.TypeString, value)
+		_node.RuleTreatAsThreat = value
+	}
+	if value, ok := _c.mutation.AnalystVerdict(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAnalystVerdict, field.TypeString, value)
+		_node.AnalystVerdict = value
+	}
+	if value, ok := _c.mutation.IncidentStatus(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldIncidentStatus, field.TypeString, value)
+		_node.IncidentStatus = value
+	}
+	if value, ok := _c.mutation.EventType(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := _c.mutation.HitType(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldHitType, field.TypeString, value)
+		_node.HitType = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.DvEventID(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldDvEventID, field.TypeString, value)
+		_node.DvEventID = value
+	}
+	if value, ok := _c.mutation.IsEdr(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldIsEdr, field.TypeBool, value)
+		_node.IsEdr = value
+	}
+	if value, ok := _c.mutation.ReportedAt(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldReportedAt, field.TypeTime, value)
+		_node.ReportedAt = &value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	if value, ok := _c.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAPIUpdatedAt, field.TypeTime, value)
+		_node.APIUpdatedAt = &value
+	}
+	if value, ok := _c.mutation.SourceProcessJSON(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldSourceProcessJSON, field.TypeJSON, value)
+		_node.SourceProcessJSON = value
+	}
+	if value, ok := _c.mutation.TargetProcessJSON(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldTargetProcessJSON, field.TypeJSON, value)
+		_node.TargetProcessJSON = value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryS1AlertCreateBulk is the builder for creating many BronzeHistoryS1Alert entities in bulk.
+type BronzeHistoryS1AlertCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryS1AlertCreate
+}
+
+// Save creates the BronzeHistoryS1Alert entities in the database.
+func (_c *BronzeHistoryS1AlertCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryS1Alert, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryS1Alert, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryS1AlertMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryS1AlertCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryS1Alert {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryS1AlertCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryS1AlertCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
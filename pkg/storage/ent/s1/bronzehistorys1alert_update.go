@@ -0,0 +1,1553 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1alert"
+	"danny.vn/hotpot/pkg/storage/ent/s1/internal"
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryS1AlertUpdate is the builder for updating BronzeHistoryS1Alert entities.
+type BronzeHistoryS1AlertUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryS1AlertMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryS1AlertUpdate builder.
+func (_u *BronzeHistoryS1AlertUpdate) Where(ps ...predicate.BronzeHistoryS1Alert) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetValidTo(v time.Time) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearValidTo() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetCollectedAt(v time.Time) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetResourceID(v string) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableResourceID(v *string) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetAgentID sets the "agent_id" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetAgentID(v string) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetAgentID(v)
+	return _u
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableAgentID(v *string) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetAgentID(*v)
+	}
+	return _u
+}
+
+// ClearAgentID clears the value of the "agent_id" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearAgentID() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearAgentID()
+	return _u
+}
+
+// SetAgentUUID sets the "agent_uuid" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetAgentUUID(v string) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetAgentUUID(v)
+	return _u
+}
+
+// SetNillableAgentUUID sets the "agent_uuid" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableAgentUUID(v *string) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetAgentUUID(*v)
+	}
+	return _u
+}
+
+// ClearAgentUUID clears the value of the "agent_uuid" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearAgentUUID() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearAgentUUID()
+	return _u
+}
+
+// SetAgentName sets the "agent_name" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetAgentName(v string) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetAgentName(v)
+	return _u
+}
+
+// SetNillableAgentName sets the "agent_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableAgentName(v *string) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetAgentName(*v)
+	}
+	return _u
+}
+
+// ClearAgentName clears the value of the "agent_name" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearAgentName() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearAgentName()
+	return _u
+}
+
+// SetAgentOsName sets the "agent_os_name" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetAgentOsName(v string) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetAgentOsName(v)
+	return _u
+}
+
+// SetNillableAgentOsName sets the "agent_os_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableAgentOsName(v *string) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetAgentOsName(*v)
+	}
+	return _u
+}
+
+// ClearAgentOsName clears the value of the "agent_os_name" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearAgentOsName() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearAgentOsName()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetSiteID(v string) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableSiteID(v *string) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearSiteID() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetRuleID sets the "rule_id" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetRuleID(v string) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetRuleID(v)
+	return _u
+}
+
+// SetNillableRuleID sets the "rule_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableRuleID(v *string) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetRuleID(*v)
+	}
+	return _u
+}
+
+// ClearRuleID clears the value of the "rule_id" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearRuleID() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearRuleID()
+	return _u
+}
+
+// SetRuleName sets the "rule_name" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetRuleName(v string) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetRuleName(v)
+	return _u
+}
+
+// SetNillableRuleName sets the "rule_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableRuleName(v *string) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetRuleName(*v)
+	}
+	return _u
+}
+
+// ClearRuleName clears the value of the "rule_name" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearRuleName() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearRuleName()
+	return _u
+}
+
+// SetRuleSeverity sets the "rule_severity" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetRuleSeverity(v string) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetRuleSeverity(v)
+	return _u
+}
+
+// SetNillableRuleSeverity sets the "rule_severity" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableRuleSeverity(v *string) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetRuleSeverity(*v)
+	}
+	return _u
+}
+
+// ClearRuleSeverity clears the value of the "rule_severity" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearRuleSeverity() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearRuleSeverity()
+	return _u
+}
+
+// SetRuleDescription sets the "rule_description" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetRuleDescription(v string) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetRuleDescription(v)
+	return _u
+}
+
+// SetNillableRuleDescription sets the "rule_description" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableRuleDescription(v *string) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetRuleDescription(*v)
+	}
+	return _u
+}
+
+// ClearRuleDescription clears the value of the "rule_description" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearRuleDescription() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearRuleDescription()
+	return _u
+}
+
+// SetRuleScopeLevel sets the "rule_scope_level" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetRuleScopeLevel(v string) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetRuleScopeLevel(v)
+	return _u
+}
+
+// SetNillableRuleScopeLevel sets the "rule_scope_level" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableRuleScopeLevel(v *string) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetRuleScopeLevel(*v)
+	}
+	return _u
+}
+
+// ClearRuleScopeLevel clears the value of the "rule_scope_level" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearRuleScopeLevel() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearRuleScopeLevel()
+	return _u
+}
+
+// SetRuleTreatAsThreat sets the "rule_treat_as_threat" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetRuleTreatAsThreat(v string) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetRuleTreatAsThreat(v)
+	return _u
+}
+
+// SetNillableRuleTreatAsThreat sets the "rule_treat_as_threat" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableRuleTreatAsThreat(v *string) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetRuleTreatAsThreat(*v)
+	}
+	return _u
+}
+
+// ClearRuleTreatAsThreat clears the value of the "rule_treat_as_threat" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearRuleTreatAsThreat() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearRuleTreatAsThreat()
+	return _u
+}
+
+// SetAnalystVerdict sets the "analyst_verdict" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetAnalystVerdict(v string) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetAnalystVerdict(v)
+	return _u
+}
+
+// SetNillableAnalystVerdict sets the "analyst_verdict" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableAnalystVerdict(v *string) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetAnalystVerdict(*v)
+	}
+	return _u
+}
+
+// ClearAnalystVerdict clears the value of the "analyst_verdict" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearAnalystVerdict() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearAnalystVerdict()
+	return _u
+}
+
+// SetIncidentStatus sets the "incident_status" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetIncidentStatus(v string) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetIncidentStatus(v)
+	return _u
+}
+
+// SetNillableIncidentStatus sets the "incident_status" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableIncidentStatus(v *string) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetIncidentStatus(*v)
+	}
+	return _u
+}
+
+// ClearIncidentStatus clears the value of the "incident_status" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearIncidentStatus() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearIncidentStatus()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetEventType(v string) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableEventType(v *string) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearEventType() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearEventType()
+	return _u
+}
+
+// SetHitType sets the "hit_type" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetHitType(v string) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetHitType(v)
+	return _u
+}
+
+// SetNillableHitType sets the "hit_type" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableHitType(v *string) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetHitType(*v)
+	}
+	return _u
+}
+
+// ClearHitType clears the value of the "hit_type" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearHitType() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearHitType()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetSource(v string) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableSource(v *string) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearSource() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// SetDvEventID sets the "dv_event_id" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetDvEventID(v string) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetDvEventID(v)
+	return _u
+}
+
+// SetNillableDvEventID sets the "dv_event_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableDvEventID(v *string) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetDvEventID(*v)
+	}
+	return _u
+}
+
+// ClearDvEventID clears the value of the "dv_event_id" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearDvEventID() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearDvEventID()
+	return _u
+}
+
+// SetIsEdr sets the "is_edr" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetIsEdr(v bool) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetIsEdr(v)
+	return _u
+}
+
+// SetNillableIsEdr sets the "is_edr" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableIsEdr(v *bool) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetIsEdr(*v)
+	}
+	return _u
+}
+
+// SetReportedAt sets the "reported_at" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetReportedAt(v time.Time) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetReportedAt(v)
+	return _u
+}
+
+// SetNillableReportedAt sets the "reported_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableReportedAt(v *time.Time) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetReportedAt(*v)
+	}
+	return _u
+}
+
+// ClearReportedAt clears the value of the "reported_at" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearReportedAt() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearReportedAt()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetAPICreatedAt(v time.Time) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearAPICreatedAt() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetAPIUpdatedAt(v time.Time) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeHistoryS1AlertUpdate {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearAPIUpdatedAt() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// SetSourceProcessJSON sets the "source_process_json" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetSourceProcessJSON(v json.RawMessage) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetSourceProcessJSON(v)
+	return _u
+}
+
+// AppendSourceProcessJSON appends value to the "source_process_json" field.
+func (_u *BronzeHistoryS1AlertUpdate) AppendSourceProcessJSON(v json.RawMessage) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.AppendSourceProcessJSON(v)
+	return _u
+}
+
+// ClearSourceProcessJSON clears the value of the "source_process_json" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearSourceProcessJSON() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearSourceProcessJSON()
+	return _u
+}
+
+// SetTargetProcessJSON sets the "target_process_json" field.
+func (_u *BronzeHistoryS1AlertUpdate) SetTargetProcessJSON(v json.RawMessage) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.SetTargetProcessJSON(v)
+	return _u
+}
+
+// AppendTargetProcessJSON appends value to the "target_process_json" field.
+func (_u *BronzeHistoryS1AlertUpdate) AppendTargetProcessJSON(v json.RawMessage) *BronzeHistoryS1AlertUpdate {
+	_u.mutation.AppendTargetProcessJSON(v)
+	return _u
+}
+
+// ClearTargetProcessJSON clears the value of the "target_process_json" field.
+func (_u *BronzeHistoryS1AlertUpdate) ClearTargetProcessJSON() *BronzeHistoryS1AlertUpdate {
+	_u.mutation.ClearTargetProcessJSON()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryS1AlertMutation object of the builder.
+func (_u *BronzeHistoryS1AlertUpdate) Mutation() *BronzeHistoryS1AlertMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryS1AlertUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryS1AlertUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryS1AlertUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryS1AlertUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryS1AlertUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistorys1alert.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Alert.resource_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryS1AlertUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistorys1alert.Table, bronzehistorys1alert.Columns, sqlgraph.NewFieldSpec(bronzehistorys1alert.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AgentID(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAgentID, field.TypeString, value)
+	}
+	if _u.mutation.AgentIDCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldAgentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentUUID(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAgentUUID, field.TypeString, value)
+	}
+	if _u.mutation.AgentUUIDCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldAgentUUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentName(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAgentName, field.TypeString, value)
+	}
+	if _u.mutation.AgentNameCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldAgentName, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentOsName(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAgentOsName, field.TypeString, value)
+	}
+	if _u.mutation.AgentOsNameCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldAgentOsName, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleID(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldRuleID, field.TypeString, value)
+	}
+	if _u.mutation.RuleIDCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldRuleID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleName(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldRuleName, field.TypeString, value)
+	}
+	if _u.mutation.RuleNameCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldRuleName, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleSeverity(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldRuleSeverity, field.TypeString, value)
+	}
+	if _u.mutation.RuleSeverityCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldRuleSeverity, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleDescription(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldRuleDescription, field.TypeString, value)
+	}
+	if _u.mutation.RuleDescriptionCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldRuleDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleScopeLevel(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldRuleScopeLevel, field.TypeString, value)
+	}
+	if _u.mutation.RuleScopeLevelCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldRuleScopeLevel, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleTreatAsThreat(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldRuleTreatAsThreat, field.TypeString, value)
+	}
+	if _u.mutation.RuleTreatAsThreatCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldRuleTreatAsThreat, field.TypeString)
+	}
+	if value, ok := _u.mutation.AnalystVerdict(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAnalystVerdict, field.TypeString, value)
+	}
+	if _u.mutation.AnalystVerdictCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldAnalystVerdict, field.TypeString)
+	}
+	if value, ok := _u.mutation.IncidentStatus(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldIncidentStatus, field.TypeString, value)
+	}
+	if _u.mutation.IncidentStatusCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldIncidentStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldEventType, field.TypeString, value)
+	}
+	if _u.mutation.EventTypeCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldEventType, field.TypeString)
+	}
+	if value, ok := _u.mutation.HitType(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldHitType, field.TypeString, value)
+	}
+	if _u.mutation.HitTypeCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldHitType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.DvEventID(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldDvEventID, field.TypeString, value)
+	}
+	if _u.mutation.DvEventIDCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldDvEventID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsEdr(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldIsEdr, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ReportedAt(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldReportedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReportedAtCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldReportedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldAPICreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SourceProcessJSON(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldSourceProcessJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSourceProcessJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistorys1alert.FieldSourceProcessJSON, value)
+		})
+	}
+	if _u.mutation.SourceProcessJSONCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldSourceProcessJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TargetProcessJSON(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldTargetProcessJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTargetProcessJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistorys1alert.FieldTargetProcessJSON, value)
+		})
+	}
+	if _u.mutation.TargetProcessJSONCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldTargetProcessJSON, field.TypeJSON)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryS1Alert
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistorys1alert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryS1AlertUpdateOne is the builder for updating a single BronzeHistoryS1Alert entity.
+type BronzeHistoryS1AlertUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryS1AlertMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetValidTo(v time.Time) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearValidTo() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetResourceID(v string) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetAgentID sets the "agent_id" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetAgentID(v string) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetAgentID(v)
+	return _u
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableAgentID(v *string) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetAgentID(*v)
+	}
+	return _u
+}
+
+// ClearAgentID clears the value of the "agent_id" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearAgentID() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearAgentID()
+	return _u
+}
+
+// SetAgentUUID sets the "agent_uuid" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetAgentUUID(v string) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetAgentUUID(v)
+	return _u
+}
+
+// SetNillableAgentUUID sets the "agent_uuid" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableAgentUUID(v *string) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetAgentUUID(*v)
+	}
+	return _u
+}
+
+// ClearAgentUUID clears the value of the "agent_uuid" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearAgentUUID() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearAgentUUID()
+	return _u
+}
+
+// SetAgentName sets the "agent_name" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetAgentName(v string) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetAgentName(v)
+	return _u
+}
+
+// SetNillableAgentName sets the "agent_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableAgentName(v *string) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetAgentName(*v)
+	}
+	return _u
+}
+
+// ClearAgentName clears the value of the "agent_name" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearAgentName() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearAgentName()
+	return _u
+}
+
+// SetAgentOsName sets the "agent_os_name" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetAgentOsName(v string) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetAgentOsName(v)
+	return _u
+}
+
+// SetNillableAgentOsName sets the "agent_os_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableAgentOsName(v *string) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetAgentOsName(*v)
+	}
+	return _u
+}
+
+// ClearAgentOsName clears the value of the "agent_os_name" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearAgentOsName() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearAgentOsName()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetSiteID(v string) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableSiteID(v *string) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearSiteID() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetRuleID sets the "rule_id" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetRuleID(v string) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetRuleID(v)
+	return _u
+}
+
+// SetNillableRuleID sets the "rule_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableRuleID(v *string) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetRuleID(*v)
+	}
+	return _u
+}
+
+// ClearRuleID clears the value of the "rule_id" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearRuleID() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearRuleID()
+	return _u
+}
+
+// SetRuleName sets the "rule_name" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetRuleName(v string) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetRuleName(v)
+	return _u
+}
+
+// SetNillableRuleName sets the "rule_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableRuleName(v *string) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetRuleName(*v)
+	}
+	return _u
+}
+
+// ClearRuleName clears the value of the "rule_name" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearRuleName() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearRuleName()
+	return _u
+}
+
+// SetRuleSeverity sets the "rule_severity" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetRuleSeverity(v string) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetRuleSeverity(v)
+	return _u
+}
+
+// SetNillableRuleSeverity sets the "rule_severity" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableRuleSeverity(v *string) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetRuleSeverity(*v)
+	}
+	return _u
+}
+
+// ClearRuleSeverity clears the value of the "rule_severity" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearRuleSeverity() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearRuleSeverity()
+	return _u
+}
+
+// SetRuleDescription sets the "rule_description" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetRuleDescription(v string) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetRuleDescription(v)
+	return _u
+}
+
+// SetNillableRuleDescription sets the "rule_description" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableRuleDescription(v *string) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetRuleDescription(*v)
+	}
+	return _u
+}
+
+// ClearRuleDescription clears the value of the "rule_description" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearRuleDescription() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearRuleDescription()
+	return _u
+}
+
+// SetRuleScopeLevel sets the "rule_scope_level" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetRuleScopeLevel(v string) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetRuleScopeLevel(v)
+	return _u
+}
+
+// SetNillableRuleScopeLevel sets the "rule_scope_level" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableRuleScopeLevel(v *string) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetRuleScopeLevel(*v)
+	}
+	return _u
+}
+
+// ClearRuleScopeLevel clears the value of the "rule_scope_level" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearRuleScopeLevel() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearRuleScopeLevel()
+	return _u
+}
+
+// SetRuleTreatAsThreat sets the "rule_treat_as_threat" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetRuleTreatAsThreat(v string) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetRuleTreatAsThreat(v)
+	return _u
+}
+
+// SetNillableRuleTreatAsThreat sets the "rule_treat_as_threat" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableRuleTreatAsThreat(v *string) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetRuleTreatAsThreat(*v)
+	}
+	return _u
+}
+
+// ClearRuleTreatAsThreat clears the value of the "rule_treat_as_threat" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearRuleTreatAsThreat() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearRuleTreatAsThreat()
+	return _u
+}
+
+// SetAnalystVerdict sets the "analyst_verdict" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetAnalystVerdict(v string) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetAnalystVerdict(v)
+	return _u
+}
+
+// SetNillableAnalystVerdict sets the "analyst_verdict" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableAnalystVerdict(v *string) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetAnalystVerdict(*v)
+	}
+	return _u
+}
+
+// ClearAnalystVerdict clears the value of the "analyst_verdict" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearAnalystVerdict() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearAnalystVerdict()
+	return _u
+}
+
+// SetIncidentStatus sets the "incident_status" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetIncidentStatus(v string) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetIncidentStatus(v)
+	return _u
+}
+
+// SetNillableIncidentStatus sets the "incident_status" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableIncidentStatus(v *string) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetIncidentStatus(*v)
+	}
+	return _u
+}
+
+// ClearIncidentStatus clears the value of the "incident_status" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearIncidentStatus() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearIncidentStatus()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetEventType(v string) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableEventType(v *string) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearEventType() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearEventType()
+	return _u
+}
+
+// SetHitType sets the "hit_type" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetHitType(v string) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetHitType(v)
+	return _u
+}
+
+// SetNillableHitType sets the "hit_type" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableHitType(v *string) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetHitType(*v)
+	}
+	return _u
+}
+
+// ClearHitType clears the value of the "hit_type" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearHitType() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearHitType()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetSource(v string) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableSource(v *string) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearSource() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// SetDvEventID sets the "dv_event_id" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetDvEventID(v string) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetDvEventID(v)
+	return _u
+}
+
+// SetNillableDvEventID sets the "dv_event_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableDvEventID(v *string) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetDvEventID(*v)
+	}
+	return _u
+}
+
+// ClearDvEventID clears the value of the "dv_event_id" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearDvEventID() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearDvEventID()
+	return _u
+}
+
+// SetIsEdr sets the "is_edr" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetIsEdr(v bool) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetIsEdr(v)
+	return _u
+}
+
+// SetNillableIsEdr sets the "is_edr" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableIsEdr(v *bool) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetIsEdr(*v)
+	}
+	return _u
+}
+
+// SetReportedAt sets the "reported_at" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetReportedAt(v time.Time) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetReportedAt(v)
+	return _u
+}
+
+// SetNillableReportedAt sets the "reported_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableReportedAt(v *time.Time) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetReportedAt(*v)
+	}
+	return _u
+}
+
+// ClearReportedAt clears the value of the "reported_at" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearReportedAt() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearReportedAt()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetAPICreatedAt(v time.Time) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearAPICreatedAt() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetAPIUpdatedAt(v time.Time) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetNillableAPIUpdatedAt(v *time.Time) *BronzeHistoryS1AlertUpdateOne {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearAPIUpdatedAt() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// SetSourceProcessJSON sets the "source_process_json" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetSourceProcessJSON(v json.RawMessage) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetSourceProcessJSON(v)
+	return _u
+}
+
+// AppendSourceProcessJSON appends value to the "source_process_json" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) AppendSourceProcessJSON(v json.RawMessage) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.AppendSourceProcessJSON(v)
+	return _u
+}
+
+// ClearSourceProcessJSON clears the value of the "source_process_json" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearSourceProcessJSON() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearSourceProcessJSON()
+	return _u
+}
+
+// SetTargetProcessJSON sets the "target_process_json" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) SetTargetProcessJSON(v json.RawMessage) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.SetTargetProcessJSON(v)
+	return _u
+}
+
+// AppendTargetProcessJSON appends value to the "target_process_json" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) AppendTargetProcessJSON(v json.RawMessage) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.AppendTargetProcessJSON(v)
+	return _u
+}
+
+// ClearTargetProcessJSON clears the value of the "target_process_json" field.
+func (_u *BronzeHistoryS1AlertUpdateOne) ClearTargetProcessJSON() *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.ClearTargetProcessJSON()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryS1AlertMutation object of the builder.
+func (_u *BronzeHistoryS1AlertUpdateOne) Mutation() *BronzeHistoryS1AlertMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryS1AlertUpdate builder.
+func (_u *BronzeHistoryS1AlertUpdateOne) Where(ps ...predicate.BronzeHistoryS1Alert) *BronzeHistoryS1AlertUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryS1AlertUpdateOne) Select(field string, fields ...string) *BronzeHistoryS1AlertUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryS1Alert entity.
+func (_u *BronzeHistoryS1AlertUpdateOne) Save(ctx context.Context) (*BronzeHistoryS1Alert, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryS1AlertUpdateOne) SaveX(ctx context.Context) *BronzeHistoryS1Alert {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryS1AlertUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryS1AlertUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryS1AlertUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistorys1alert.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Alert.resource_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryS1AlertUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryS1Alert, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistorys1alert.Table, bronzehistorys1alert.Columns, sqlgraph.NewFieldSpec(bronzehistorys1alert.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`s1: missing "BronzeHistoryS1Alert.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistorys1alert.FieldID)
+		for _, f := range fields {
+			if !bronzehistorys1alert.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("s1: invalid field %q for query", f)}
+			}
+			if f != bronzehistorys1alert.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AgentID(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAgentID, field.TypeString, value)
+	}
+	if _u.mutation.AgentIDCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldAgentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentUUID(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAgentUUID, field.TypeString, value)
+	}
+	if _u.mutation.AgentUUIDCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldAgentUUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentName(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAgentName, field.TypeString, value)
+	}
+	if _u.mutation.AgentNameCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldAgentName, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentOsName(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAgentOsName, field.TypeString, value)
+	}
+	if _u.mutation.AgentOsNameCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldAgentOsName, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleID(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldRuleID, field.TypeString, value)
+	}
+	if _u.mutation.RuleIDCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldRuleID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleName(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldRuleName, field.TypeString, value)
+	}
+	if _u.mutation.RuleNameCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldRuleName, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleSeverity(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldRuleSeverity, field.TypeString, value)
+	}
+	if _u.mutation.RuleSeverityCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldRuleSeverity, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleDescription(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldRuleDescription, field.TypeString, value)
+	}
+	if _u.mutation.RuleDescriptionCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldRuleDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleScopeLevel(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldRuleScopeLevel, field.TypeString, value)
+	}
+	if _u.mutation.RuleScopeLevelCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldRuleScopeLevel, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleTreatAsThreat(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldRuleTreatAsThreat, field.TypeString, value)
+	}
+	if _u.mutation.RuleTreatAsThreatCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldRuleTreatAsThreat, field.TypeString)
+	}
+	if value, ok := _u.mutation.AnalystVerdict(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAnalystVerdict, field.TypeString, value)
+	}
+	if _u.mutation.AnalystVerdictCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldAnalystVerdict, field.TypeString)
+	}
+	if value, ok := _u.mutation.IncidentStatus(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldIncidentStatus, field.TypeString, value)
+	}
+	if _u.mutation.IncidentStatusCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldIncidentStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldEventType, field.TypeString, value)
+	}
+	if _u.mutation.EventTypeCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldEventType, field.TypeString)
+	}
+	if value, ok := _u.mutation.HitType(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldHitType, field.TypeString, value)
+	}
+	if _u.mutation.HitTypeCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldHitType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.DvEventID(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldDvEventID, field.TypeString, value)
+	}
+	if _u.mutation.DvEventIDCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldDvEventID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsEdr(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldIsEdr, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ReportedAt(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldReportedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReportedAtCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldReportedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldAPICreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SourceProcessJSON(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldSourceProcessJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSourceProcessJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistorys1alert.FieldSourceProcessJSON, value)
+		})
+	}
+	if _u.mutation.SourceProcessJSONCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldSourceProcessJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TargetProcessJSON(); ok {
+		_spec.SetField(bronzehistorys1alert.FieldTargetProcessJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTargetProcessJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistorys1alert.FieldTargetProcessJSON, value)
+		})
+	}
+	if _u.mutation.TargetProcessJSONCleared() {
+		_spec.ClearField(bronzehistorys1alert.FieldTargetProcessJSON, field.TypeJSON)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryS1Alert
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryS1Alert{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistorys1alert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
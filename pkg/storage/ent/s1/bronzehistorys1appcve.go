@@ -0,0 +1,372 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1appcve"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryS1AppCVE is the model entity for the BronzeHistoryS1AppCVE schema.
+type BronzeHistoryS1AppCVE struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze app CVE by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// AgentID holds the value of the "agent_id" field.
+	AgentID string `json:"agent_id,omitempty"`
+	// EndpointName holds the value of the "endpoint_name" field.
+	EndpointName string `json:"endpoint_name,omitempty"`
+	// EndpointType holds the value of the "endpoint_type" field.
+	EndpointType string `json:"endpoint_type,omitempty"`
+	// OsType holds the value of the "os_type" field.
+	OsType string `json:"os_type,omitempty"`
+	// ApplicationName holds the value of the "application_name" field.
+	ApplicationName string `json:"application_name,omitempty"`
+	// ApplicationVendor holds the value of the "application_vendor" field.
+	ApplicationVendor string `json:"application_vendor,omitempty"`
+	// ApplicationVersion holds the value of the "application_version" field.
+	ApplicationVersion string `json:"application_version,omitempty"`
+	// CveID holds the value of the "cve_id" field.
+	CveID string `json:"cve_id,omitempty"`
+	// CvssScore holds the value of the "cvss_score" field.
+	CvssScore float64 `json:"cvss_score,omitempty"`
+	// CvssVersion holds the value of the "cvss_version" field.
+	CvssVersion string `json:"cvss_version,omitempty"`
+	// NvdSeverity holds the value of the "nvd_severity" field.
+	NvdSeverity string `json:"nvd_severity,omitempty"`
+	// RiskScore holds the value of the "risk_score" field.
+	RiskScore float64 `json:"risk_score,omitempty"`
+	// ExploitCodeMaturity holds the value of the "exploit_code_maturity" field.
+	ExploitCodeMaturity string `json:"exploit_code_maturity,omitempty"`
+	// ExploitedInTheWild holds the value of the "exploited_in_the_wild" field.
+	ExploitedInTheWild bool `json:"exploited_in_the_wild,omitempty"`
+	// RemediationLevel holds the value of the "remediation_level" field.
+	RemediationLevel string `json:"remediation_level,omitempty"`
+	// ReportConfidence holds the value of the "report_confidence" field.
+	ReportConfidence string `json:"report_confidence,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// PublishedDate holds the value of the "published_date" field.
+	PublishedDate *time.Time `json:"published_date,omitempty"`
+	// DetectionDate holds the value of the "detection_date" field.
+	DetectionDate *time.Time `json:"detection_date,omitempty"`
+	selectValues  sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryS1AppCVE) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistorys1appcve.FieldExploitedInTheWild:
+			values[i] = new(sql.NullBool)
+		case bronzehistorys1appcve.FieldCvssScore, bronzehistorys1appcve.FieldRiskScore:
+			values[i] = new(sql.NullFloat64)
+		case bronzehistorys1appcve.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistorys1appcve.FieldResourceID, bronzehistorys1appcve.FieldAgentID, bronzehistorys1appcve.FieldEndpointName, bronzehistorys1appcve.FieldEndpointType, bronzehistorys1appcve.FieldOsType, bronzehistorys1appcve.FieldApplicationName, bronzehistorys1appcve.FieldApplicationVendor, bronzehistorys1appcve.FieldApplicationVersion, bronzehistorys1appcve.FieldCveID, bronzehistorys1appcve.FieldCvssVersion, bronzehistorys1appcve.FieldNvdSeverity, bronzehistorys1appcve.FieldExploitCodeMaturity, bronzehistorys1appcve.FieldRemediationLevel, bronzehistorys1appcve.FieldReportConfidence, bronzehistorys1appcve.FieldStatus:
+			values[i] = new(sql.NullString)
+		case bronzehistorys1appcve.FieldValidFrom, bronzehistorys1appcve.FieldValidTo, bronzehistorys1appcve.FieldCollectedAt, bronzehistorys1appcve.FieldFirstCollectedAt, bronzehistorys1appcve.FieldPublishedDate, bronzehistorys1appcve.FieldDetectionDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryS1AppCVE fields.
+func (_m *BronzeHistoryS1AppCVE) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistorys1appcve.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistorys1appcve.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistorys1appcve.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistorys1appcve.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistorys1appcve.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistorys1appcve.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistorys1appcve.FieldAgentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_id", values[i])
+			} else if value.Valid {
+				_m.AgentID = value.String
+			}
+		case bronzehistorys1appcve.FieldEndpointName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field endpoint_name", values[i])
+			} else if value.Valid {
+				_m.EndpointName = value.String
+			}
+		case bronzehistorys1appcve.FieldEndpointType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field endpoint_type", values[i])
+			} else if value.Valid {
+				_m.EndpointType = value.String
+			}
+		case bronzehistorys1appcve.FieldOsType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field os_type", values[i])
+			} else if value.Valid {
+				_m.OsType = value.String
+			}
+		case bronzehistorys1appcve.FieldApplicationName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field application_name", values[i])
+			} else if value.Valid {
+				_m.ApplicationName = value.String
+			}
+		case bronzehistorys1appcve.FieldApplicationVendor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field application_vendor", values[i])
+			} else if value.Valid {
+				_m.ApplicationVendor = value.String
+			}
+		case bronzehistorys1appcve.FieldApplicationVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field application_version", values[i])
+			} else if value.Valid {
+				_m.ApplicationVersion = value.String
+			}
+		case bronzehistorys1appcve.FieldCveID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cve_id", values[i])
+			} else if value.Valid {
+				_m.CveID = value.String
+			}
+		case bronzehistorys1appcve.FieldCvssScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cvss_score", values[i])
+			} else if value.Valid {
+				_m.CvssScore = value.Float64
+			}
+		case bronzehistorys1appcve.FieldCvssVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cvss_version", values[i])
+			} else if value.Valid {
+				_m.CvssVersion = value.String
+			}
+		case bronzehistorys1appcve.FieldNvdSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nvd_severity", values[i])
+			} else if value.Valid {
+				_m.NvdSeverity = value.String
+			}
+		case bronzehistorys1appcve.FieldRiskScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field risk_score", values[i])
+			} else if value.Valid {
+				_m.RiskScore = value.Float64
+			}
+		case bronzehistorys1appcve.FieldExploitCodeMaturity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exploit_code_maturity", values[i])
+			} else if value.Valid {
+				_m.ExploitCodeMaturity = value.String
+			}
+		case bronzehistorys1appcve.FieldExploitedInTheWild:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field exploited_in_the_wild", values[i])
+			} else if value.Valid {
+				_m.ExploitedInTheWild = value.Bool
+			}
+		case bronzehistorys1appcve.FieldRemediationLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remediation_level", values[i])
+			} else if value.Valid {
+				_m.RemediationLevel = value.String
+			}
+		case bronzehistorys1appcve.FieldReportConfidence:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field report_confidence", values[i])
+			} else if value.Valid {
+				_m.ReportConfidence = value.String
+			}
+		case bronzehistorys1appcve.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case bronzehistorys1appcve.FieldPublishedDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published_date", values[i])
+			} else if value.Valid {
+				_m.PublishedDate = new(time.Time)
+				*_m.PublishedDate = value.Time
+			}
+		case bronzehistorys1appcve.FieldDetectionDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field detection_date", values[i])
+			} else if value.Valid {
+				_m.DetectionDate = new(time.Time)
+				*_m.DetectionDate = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryS1AppCVE.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryS1AppCVE) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryS1AppCVE.
+// Note that you need to call BronzeHistoryS1AppCVE.Unwrap() before calling this method if this BronzeHistoryS1AppCVE
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryS1AppCVE) Update() *BronzeHistoryS1AppCVEUpdateOne {
+	return NewBronzeHistoryS1AppCVEClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryS1AppCVE entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryS1AppCVE) Unwrap() *BronzeHistoryS1AppCVE {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("s1: BronzeHistoryS1AppCVE is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryS1AppCVE) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryS1AppCVE(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("agent_id=")
+	builder.WriteString(_m.AgentID)
+	builder.WriteString(", ")
+	builder.WriteString("endpoint_name=")
+	builder.WriteString(_m.EndpointName)
+	builder.WriteString(", ")
+	builder.WriteString("endpoint_type=")
+	builder.WriteString(_m.EndpointType)
+	builder.WriteString(", ")
+	builder.WriteString("os_type=")
+	builder.WriteString(_m.OsType)
+	builder.WriteString(", ")
+	builder.WriteString("application_name=")
+	builder.WriteString(_m.ApplicationName)
+	builder.WriteString(", ")
+	builder.WriteString("application_vendor=")
+	builder.WriteString(_m.ApplicationVendor)
+	builder.WriteString(", ")
+	builder.WriteString("application_version=")
+	builder.WriteString(_m.ApplicationVersion)
+	builder.WriteString(", ")
+	builder.WriteString("cve_id=")
+	builder.WriteString(_m.CveID)
+	builder.WriteString(", ")
+	builder.WriteString("cvss_score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CvssScore))
+	builder.WriteString(", ")
+	builder.WriteString("cvss_version=")
+	builder.WriteString(_m.CvssVersion)
+	builder.WriteString(", ")
+	builder.WriteString("nvd_severity=")
+	builder.WriteString(_m.NvdSeverity)
+	builder.WriteString(", ")
+	builder.WriteString("risk_score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RiskScore))
+	builder.WriteString(", ")
+	builder.WriteString("exploit_code_maturity=")
+	builder.WriteString(_m.ExploitCodeMaturity)
+	builder.WriteString(", ")
+	builder.WriteString("exploited_in_the_wild=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExploitedInTheWild))
+	builder.WriteString(", ")
+	builder.WriteString("remediation_level=")
+	builder.WriteString(_m.RemediationLevel)
+	builder.WriteString(", ")
+	builder.WriteString("report_confidence=")
+	builder.WriteString(_m.ReportConfidence)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.PublishedDate; v != nil {
+		builder.WriteString("published_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DetectionDate; v != nil {
+		builder.WriteString("detection_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryS1AppCVEs is a parsable slice of BronzeHistoryS1AppCVE.
+type BronzeHistoryS1AppCVEs []*BronzeHistoryS1AppCVE
@@ -0,0 +1,1635 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistorys1appcve
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldID, id))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldValidTo, v))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldResourceID, v))
+}
+
+// AgentID applies equality check predicate on the "agent_id" field. It's identical to AgentIDEQ.
+func AgentID(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldAgentID, v))
+}
+
+// EndpointName applies equality check predicate on the "endpoint_name" field. It's identical to EndpointNameEQ.
+func EndpointName(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldEndpointName, v))
+}
+
+// EndpointType applies equality check predicate on the "endpoint_type" field. It's identical to EndpointTypeEQ.
+func EndpointType(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldEndpointType, v))
+}
+
+// OsType applies equality check predicate on the "os_type" field. It's identical to OsTypeEQ.
+func OsType(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldOsType, v))
+}
+
+// ApplicationName applies equality check predicate on the "application_name" field. It's identical to ApplicationNameEQ.
+func ApplicationName(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldApplicationName, v))
+}
+
+// ApplicationVendor applies equality check predicate on the "application_vendor" field. It's identical to ApplicationVendorEQ.
+func ApplicationVendor(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldApplicationVendor, v))
+}
+
+// ApplicationVersion applies equality check predicate on the "application_version" field. It's identical to ApplicationVersionEQ.
+func ApplicationVersion(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldApplicationVersion, v))
+}
+
+// CveID applies equality check predicate on the "cve_id" field. It's identical to CveIDEQ.
+func CveID(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldCveID, v))
+}
+
+// CvssScore applies equality check predicate on the "cvss_score" field. It's identical to CvssScoreEQ.
+func CvssScore(v float64) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldCvssScore, v))
+}
+
+// CvssVersion applies equality check predicate on the "cvss_version" field. It's identical to CvssVersionEQ.
+func CvssVersion(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldCvssVersion, v))
+}
+
+// NvdSeverity applies equality check predicate on the "nvd_severity" field. It's identical to NvdSeverityEQ.
+func NvdSeverity(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldNvdSeverity, v))
+}
+
+// RiskScore applies equality check predicate on the "risk_score" field. It's identical to RiskScoreEQ.
+func RiskScore(v float64) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldRiskScore, v))
+}
+
+// ExploitCodeMaturity applies equality check predicate on the "exploit_code_maturity" field. It's identical to ExploitCodeMaturityEQ.
+func ExploitCodeMaturity(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldExploitCodeMaturity, v))
+}
+
+// ExploitedInTheWild applies equality check predicate on the "exploited_in_the_wild" field. It's identical to ExploitedInTheWildEQ.
+func ExploitedInTheWild(v bool) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldExploitedInTheWild, v))
+}
+
+// RemediationLevel applies equality check predicate on the "remediation_level" field. It's identical to RemediationLevelEQ.
+func RemediationLevel(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldRemediationLevel, v))
+}
+
+// ReportConfidence applies equality check predicate on the "report_confidence" field. It's identical to ReportConfidenceEQ.
+func ReportConfidence(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldReportConfidence, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldStatus, v))
+}
+
+// PublishedDate applies equality check predicate on the "published_date" field. It's identical to PublishedDateEQ.
+func PublishedDate(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldPublishedDate, v))
+}
+
+// DetectionDate applies equality check predicate on the "detection_date" field. It's identical to DetectionDateEQ.
+func DetectionDate(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldDetectionDate, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotNull(FieldValidTo))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContains(FieldResourceID, v))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasPrefix(FieldResourceID, v))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasSuffix(FieldResourceID, v))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEqualFold(FieldResourceID, v))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContainsFold(FieldResourceID, v))
+}
+
+// AgentIDEQ applies the EQ predicate on the "agent_id" field.
+func AgentIDEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldAgentID, v))
+}
+
+// AgentIDNEQ applies the NEQ predicate on the "agent_id" field.
+func AgentIDNEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldAgentID, v))
+}
+
+// AgentIDIn applies the In predicate on the "agent_id" field.
+func AgentIDIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldAgentID, vs...))
+}
+
+// AgentIDNotIn applies the NotIn predicate on the "agent_id" field.
+func AgentIDNotIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldAgentID, vs...))
+}
+
+// AgentIDGT applies the GT predicate on the "agent_id" field.
+func AgentIDGT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldAgentID, v))
+}
+
+// AgentIDGTE applies the GTE predicate on the "agent_id" field.
+func AgentIDGTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldAgentID, v))
+}
+
+// AgentIDLT applies the LT predicate on the "agent_id" field.
+func AgentIDLT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldAgentID, v))
+}
+
+// AgentIDLTE applies the LTE predicate on the "agent_id" field.
+func AgentIDLTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldAgentID, v))
+}
+
+// AgentIDContains applies the Contains predicate on the "agent_id" field.
+func AgentIDContains(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContains(FieldAgentID, v))
+}
+
+// AgentIDHasPrefix applies the HasPrefix predicate on the "agent_id" field.
+func AgentIDHasPrefix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasPrefix(FieldAgentID, v))
+}
+
+// AgentIDHasSuffix applies the HasSuffix predicate on the "agent_id" field.
+func AgentIDHasSuffix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasSuffix(FieldAgentID, v))
+}
+
+// AgentIDEqualFold applies the EqualFold predicate on the "agent_id" field.
+func AgentIDEqualFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEqualFold(FieldAgentID, v))
+}
+
+// AgentIDContainsFold applies the ContainsFold predicate on the "agent_id" field.
+func AgentIDContainsFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContainsFold(FieldAgentID, v))
+}
+
+// EndpointNameEQ applies the EQ predicate on the "endpoint_name" field.
+func EndpointNameEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldEndpointName, v))
+}
+
+// EndpointNameNEQ applies the NEQ predicate on the "endpoint_name" field.
+func EndpointNameNEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldEndpointName, v))
+}
+
+// EndpointNameIn applies the In predicate on the "endpoint_name" field.
+func EndpointNameIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldEndpointName, vs...))
+}
+
+// EndpointNameNotIn applies the NotIn predicate on the "endpoint_name" field.
+func EndpointNameNotIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldEndpointName, vs...))
+}
+
+// EndpointNameGT applies the GT predicate on the "endpoint_name" field.
+func EndpointNameGT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldEndpointName, v))
+}
+
+// EndpointNameGTE applies the GTE predicate on the "endpoint_name" field.
+func EndpointNameGTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldEndpointName, v))
+}
+
+// EndpointNameLT applies the LT predicate on the "endpoint_name" field.
+func EndpointNameLT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldEndpointName, v))
+}
+
+// EndpointNameLTE applies the LTE predicate on the "endpoint_name" field.
+func EndpointNameLTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldEndpointName, v))
+}
+
+// EndpointNameContains applies the Contains predicate on the "endpoint_name" field.
+func EndpointNameContains(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContains(FieldEndpointName, v))
+}
+
+// EndpointNameHasPrefix applies the HasPrefix predicate on the "endpoint_name" field.
+func EndpointNameHasPrefix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasPrefix(FieldEndpointName, v))
+}
+
+// EndpointNameHasSuffix applies the HasSuffix predicate on the "endpoint_name" field.
+func EndpointNameHasSuffix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasSuffix(FieldEndpointName, v))
+}
+
+// EndpointNameIsNil applies the IsNil predicate on the "endpoint_name" field.
+func EndpointNameIsNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIsNull(FieldEndpointName))
+}
+
+// EndpointNameNotNil applies the NotNil predicate on the "endpoint_name" field.
+func EndpointNameNotNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotNull(FieldEndpointName))
+}
+
+// EndpointNameEqualFold applies the EqualFold predicate on the "endpoint_name" field.
+func EndpointNameEqualFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEqualFold(FieldEndpointName, v))
+}
+
+// EndpointNameContainsFold applies the ContainsFold predicate on the "endpoint_name" field.
+func EndpointNameContainsFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContainsFold(FieldEndpointName, v))
+}
+
+// EndpointTypeEQ applies the EQ predicate on the "endpoint_type" field.
+func EndpointTypeEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldEndpointType, v))
+}
+
+// EndpointTypeNEQ applies the NEQ predicate on the "endpoint_type" field.
+func EndpointTypeNEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldEndpointType, v))
+}
+
+// EndpointTypeIn applies the In predicate on the "endpoint_type" field.
+func EndpointTypeIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldEndpointType, vs...))
+}
+
+// EndpointTypeNotIn applies the NotIn predicate on the "endpoint_type" field.
+func EndpointTypeNotIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldEndpointType, vs...))
+}
+
+// EndpointTypeGT applies the GT predicate on the "endpoint_type" field.
+func EndpointTypeGT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldEndpointType, v))
+}
+
+// EndpointTypeGTE applies the GTE predicate on the "endpoint_type" field.
+func EndpointTypeGTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldEndpointType, v))
+}
+
+// EndpointTypeLT applies the LT predicate on the "endpoint_type" field.
+func EndpointTypeLT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldEndpointType, v))
+}
+
+// EndpointTypeLTE applies the LTE predicate on the "endpoint_type" field.
+func EndpointTypeLTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldEndpointType, v))
+}
+
+// EndpointTypeContains applies the Contains predicate on the "endpoint_type" field.
+func EndpointTypeContains(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContains(FieldEndpointType, v))
+}
+
+// EndpointTypeHasPrefix applies the HasPrefix predicate on the "endpoint_type" field.
+func EndpointTypeHasPrefix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasPrefix(FieldEndpointType, v))
+}
+
+// EndpointTypeHasSuffix applies the HasSuffix predicate on the "endpoint_type" field.
+func EndpointTypeHasSuffix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasSuffix(FieldEndpointType, v))
+}
+
+// EndpointTypeIsNil applies the IsNil predicate on the "endpoint_type" field.
+func EndpointTypeIsNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIsNull(FieldEndpointType))
+}
+
+// EndpointTypeNotNil applies the NotNil predicate on the "endpoint_type" field.
+func EndpointTypeNotNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotNull(FieldEndpointType))
+}
+
+// EndpointTypeEqualFold applies the EqualFold predicate on the "endpoint_type" field.
+func EndpointTypeEqualFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEqualFold(FieldEndpointType, v))
+}
+
+// EndpointTypeContainsFold applies the ContainsFold predicate on the "endpoint_type" field.
+func EndpointTypeContainsFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContainsFold(FieldEndpointType, v))
+}
+
+// OsTypeEQ applies the EQ predicate on the "os_type" field.
+func OsTypeEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldOsType, v))
+}
+
+// OsTypeNEQ applies the NEQ predicate on the "os_type" field.
+func OsTypeNEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldOsType, v))
+}
+
+// OsTypeIn applies the In predicate on the "os_type" field.
+func OsTypeIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldOsType, vs...))
+}
+
+// OsTypeNotIn applies the NotIn predicate on the "os_type" field.
+func OsTypeNotIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldOsType, vs...))
+}
+
+// OsTypeGT applies the GT predicate on the "os_type" field.
+func OsTypeGT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldOsType, v))
+}
+
+// OsTypeGTE applies the GTE predicate on the "os_type" field.
+func OsTypeGTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldOsType, v))
+}
+
+// OsTypeLT applies the LT predicate on the "os_type" field.
+func OsTypeLT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldOsType, v))
+}
+
+// OsTypeLTE applies the LTE predicate on the "os_type" field.
+func OsTypeLTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldOsType, v))
+}
+
+// OsTypeContains applies the Contains predicate on the "os_type" field.
+func OsTypeContains(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContains(FieldOsType, v))
+}
+
+// OsTypeHasPrefix applies the HasPrefix predicate on the "os_type" field.
+func OsTypeHasPrefix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasPrefix(FieldOsType, v))
+}
+
+// OsTypeHasSuffix applies the HasSuffix predicate on the "os_type" field.
+func OsTypeHasSuffix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasSuffix(FieldOsType, v))
+}
+
+// OsTypeIsNil applies the IsNil predicate on the "os_type" field.
+func OsTypeIsNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIsNull(FieldOsType))
+}
+
+// OsTypeNotNil applies the NotNil predicate on the "os_type" field.
+func OsTypeNotNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotNull(FieldOsType))
+}
+
+// OsTypeEqualFold applies the EqualFold predicate on the "os_type" field.
+func OsTypeEqualFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEqualFold(FieldOsType, v))
+}
+
+// OsTypeContainsFold applies the ContainsFold predicate on the "os_type" field.
+func OsTypeContainsFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContainsFold(FieldOsType, v))
+}
+
+// ApplicationNameEQ applies the EQ predicate on the "application_name" field.
+func ApplicationNameEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldApplicationName, v))
+}
+
+// ApplicationNameNEQ applies the NEQ predicate on the "application_name" field.
+func ApplicationNameNEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldApplicationName, v))
+}
+
+// ApplicationNameIn applies the In predicate on the "application_name" field.
+func ApplicationNameIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldApplicationName, vs...))
+}
+
+// ApplicationNameNotIn applies the NotIn predicate on the "application_name" field.
+func ApplicationNameNotIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldApplicationName, vs...))
+}
+
+// ApplicationNameGT applies the GT predicate on the "application_name" field.
+func ApplicationNameGT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldApplicationName, v))
+}
+
+// ApplicationNameGTE applies the GTE predicate on the "application_name" field.
+func ApplicationNameGTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldApplicationName, v))
+}
+
+// ApplicationNameLT applies the LT predicate on the "application_name" field.
+func ApplicationNameLT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldApplicationName, v))
+}
+
+// ApplicationNameLTE applies the LTE predicate on the "application_name" field.
+func ApplicationNameLTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldApplicationName, v))
+}
+
+// ApplicationNameContains applies the Contains predicate on the "application_name" field.
+func ApplicationNameContains(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContains(FieldApplicationName, v))
+}
+
+// ApplicationNameHasPrefix applies the HasPrefix predicate on the "application_name" field.
+func ApplicationNameHasPrefix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasPrefix(FieldApplicationName, v))
+}
+
+// ApplicationNameHasSuffix applies the HasSuffix predicate on the "application_name" field.
+func ApplicationNameHasSuffix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasSuffix(FieldApplicationName, v))
+}
+
+// ApplicationNameEqualFold applies the EqualFold predicate on the "application_name" field.
+func ApplicationNameEqualFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEqualFold(FieldApplicationName, v))
+}
+
+// ApplicationNameContainsFold applies the ContainsFold predicate on the "application_name" field.
+func ApplicationNameContainsFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContainsFold(FieldApplicationName, v))
+}
+
+// ApplicationVendorEQ applies the EQ predicate on the "application_vendor" field.
+func ApplicationVendorEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldApplicationVendor, v))
+}
+
+// ApplicationVendorNEQ applies the NEQ predicate on the "application_vendor" field.
+func ApplicationVendorNEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldApplicationVendor, v))
+}
+
+// ApplicationVendorIn applies the In predicate on the "application_vendor" field.
+func ApplicationVendorIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldApplicationVendor, vs...))
+}
+
+// ApplicationVendorNotIn applies the NotIn predicate on the "application_vendor" field.
+func ApplicationVendorNotIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldApplicationVendor, vs...))
+}
+
+// ApplicationVendorGT applies the GT predicate on the "application_vendor" field.
+func ApplicationVendorGT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldApplicationVendor, v))
+}
+
+// ApplicationVendorGTE applies the GTE predicate on the "application_vendor" field.
+func ApplicationVendorGTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldApplicationVendor, v))
+}
+
+// ApplicationVendorLT applies the LT predicate on the "application_vendor" field.
+func ApplicationVendorLT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldApplicationVendor, v))
+}
+
+// ApplicationVendorLTE applies the LTE predicate on the "application_vendor" field.
+func ApplicationVendorLTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldApplicationVendor, v))
+}
+
+// ApplicationVendorContains applies the Contains predicate on the "application_vendor" field.
+func ApplicationVendorContains(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContains(FieldApplicationVendor, v))
+}
+
+// ApplicationVendorHasPrefix applies the HasPrefix predicate on the "application_vendor" field.
+func ApplicationVendorHasPrefix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasPrefix(FieldApplicationVendor, v))
+}
+
+// ApplicationVendorHasSuffix applies the HasSuffix predicate on the "application_vendor" field.
+func ApplicationVendorHasSuffix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasSuffix(FieldApplicationVendor, v))
+}
+
+// ApplicationVendorIsNil applies the IsNil predicate on the "application_vendor" field.
+func ApplicationVendorIsNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIsNull(FieldApplicationVendor))
+}
+
+// ApplicationVendorNotNil applies the NotNil predicate on the "application_vendor" field.
+func ApplicationVendorNotNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotNull(FieldApplicationVendor))
+}
+
+// ApplicationVendorEqualFold applies the EqualFold predicate on the "application_vendor" field.
+func ApplicationVendorEqualFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEqualFold(FieldApplicationVendor, v))
+}
+
+// ApplicationVendorContainsFold applies the ContainsFold predicate on the "application_vendor" field.
+func ApplicationVendorContainsFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContainsFold(FieldApplicationVendor, v))
+}
+
+// ApplicationVersionEQ applies the EQ predicate on the "application_version" field.
+func ApplicationVersionEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldApplicationVersion, v))
+}
+
+// ApplicationVersionNEQ applies the NEQ predicate on the "application_version" field.
+func ApplicationVersionNEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldApplicationVersion, v))
+}
+
+// ApplicationVersionIn applies the In predicate on the "application_version" field.
+func ApplicationVersionIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldApplicationVersion, vs...))
+}
+
+// ApplicationVersionNotIn applies the NotIn predicate on the "application_version" field.
+func ApplicationVersionNotIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldApplicationVersion, vs...))
+}
+
+// ApplicationVersionGT applies the GT predicate on the "application_version" field.
+func ApplicationVersionGT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldApplicationVersion, v))
+}
+
+// ApplicationVersionGTE applies the GTE predicate on the "application_version" field.
+func ApplicationVersionGTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldApplicationVersion, v))
+}
+
+// ApplicationVersionLT applies the LT predicate on the "application_version" field.
+func ApplicationVersionLT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldApplicationVersion, v))
+}
+
+// ApplicationVersionLTE applies the LTE predicate on the "application_version" field.
+func ApplicationVersionLTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldApplicationVersion, v))
+}
+
+// ApplicationVersionContains applies the Contains predicate on the "application_version" field.
+func ApplicationVersionContains(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContains(FieldApplicationVersion, v))
+}
+
+// ApplicationVersionHasPrefix applies the HasPrefix predicate on the "application_version" field.
+func ApplicationVersionHasPrefix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasPrefix(FieldApplicationVersion, v))
+}
+
+// ApplicationVersionHasSuffix applies the HasSuffix predicate on the "application_version" field.
+func ApplicationVersionHasSuffix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasSuffix(FieldApplicationVersion, v))
+}
+
+// ApplicationVersionIsNil applies the IsNil predicate on the "application_version" field.
+func ApplicationVersionIsNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIsNull(FieldApplicationVersion))
+}
+
+// ApplicationVersionNotNil applies the NotNil predicate on the "application_version" field.
+func ApplicationVersionNotNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotNull(FieldApplicationVersion))
+}
+
+// ApplicationVersionEqualFold applies the EqualFold predicate on the "application_version" field.
+func ApplicationVersionEqualFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEqualFold(FieldApplicationVersion, v))
+}
+
+// ApplicationVersionContainsFold applies the ContainsFold predicate on the "application_version" field.
+func ApplicationVersionContainsFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContainsFold(FieldApplicationVersion, v))
+}
+
+// CveIDEQ applies the EQ predicate on the "cve_id" field.
+func CveIDEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldCveID, v))
+}
+
+// CveIDNEQ applies the NEQ predicate on the "cve_id" field.
+func CveIDNEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldCveID, v))
+}
+
+// CveIDIn applies the In predicate on the "cve_id" field.
+func CveIDIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldCveID, vs...))
+}
+
+// CveIDNotIn applies the NotIn predicate on the "cve_id" field.
+func CveIDNotIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldCveID, vs...))
+}
+
+// CveIDGT applies the GT predicate on the "cve_id" field.
+func CveIDGT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldCveID, v))
+}
+
+// CveIDGTE applies the GTE predicate on the "cve_id" field.
+func CveIDGTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldCveID, v))
+}
+
+// CveIDLT applies the LT predicate on the "cve_id" field.
+func CveIDLT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldCveID, v))
+}
+
+// CveIDLTE applies the LTE predicate on the "cve_id" field.
+func CveIDLTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldCveID, v))
+}
+
+// CveIDContains applies the Contains predicate on the "cve_id" field.
+func CveIDContains(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContains(FieldCveID, v))
+}
+
+// CveIDHasPrefix applies the HasPrefix predicate on the "cve_id" field.
+func CveIDHasPrefix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasPrefix(FieldCveID, v))
+}
+
+// CveIDHasSuffix applies the HasSuffix predicate on the "cve_id" field.
+func CveIDHasSuffix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasSuffix(FieldCveID, v))
+}
+
+// CveIDEqualFold applies the EqualFold predicate on the "cve_id" field.
+func CveIDEqualFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEqualFold(FieldCveID, v))
+}
+
+// CveIDContainsFold applies the ContainsFold predicate on the "cve_id" field.
+func CveIDContainsFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContainsFold(FieldCveID, v))
+}
+
+// CvssScoreEQ applies the EQ predicate on the "cvss_score" field.
+func CvssScoreEQ(v float64) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldCvssScore, v))
+}
+
+// CvssScoreNEQ applies the NEQ predicate on the "cvss_score" field.
+func CvssScoreNEQ(v float64) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldCvssScore, v))
+}
+
+// CvssScoreIn applies the In predicate on the "cvss_score" field.
+func CvssScoreIn(vs ...float64) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldCvssScore, vs...))
+}
+
+// CvssScoreNotIn applies the NotIn predicate on the "cvss_score" field.
+func CvssScoreNotIn(vs ...float64) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldCvssScore, vs...))
+}
+
+// CvssScoreGT applies the GT predicate on the "cvss_score" field.
+func CvssScoreGT(v float64) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldCvssScore, v))
+}
+
+// CvssScoreGTE applies the GTE predicate on the "cvss_score" field.
+func CvssScoreGTE(v float64) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldCvssScore, v))
+}
+
+// CvssScoreLT applies the LT predicate on the "cvss_score" field.
+func CvssScoreLT(v float64) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldCvssScore, v))
+}
+
+// CvssScoreLTE applies the LTE predicate on the "cvss_score" field.
+func CvssScoreLTE(v float64) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldCvssScore, v))
+}
+
+// CvssVersionEQ applies the EQ predicate on the "cvss_version" field.
+func CvssVersionEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldCvssVersion, v))
+}
+
+// CvssVersionNEQ applies the NEQ predicate on the "cvss_version" field.
+func CvssVersionNEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldCvssVersion, v))
+}
+
+// CvssVersionIn applies the In predicate on the "cvss_version" field.
+func CvssVersionIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldCvssVersion, vs...))
+}
+
+// CvssVersionNotIn applies the NotIn predicate on the "cvss_version" field.
+func CvssVersionNotIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldCvssVersion, vs...))
+}
+
+// CvssVersionGT applies the GT predicate on the "cvss_version" field.
+func CvssVersionGT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldCvssVersion, v))
+}
+
+// CvssVersionGTE applies the GTE predicate on the "cvss_version" field.
+func CvssVersionGTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldCvssVersion, v))
+}
+
+// CvssVersionLT applies the LT predicate on the "cvss_version" field.
+func CvssVersionLT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldCvssVersion, v))
+}
+
+// CvssVersionLTE applies the LTE predicate on the "cvss_version" field.
+func CvssVersionLTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldCvssVersion, v))
+}
+
+// CvssVersionContains applies the Contains predicate on the "cvss_version" field.
+func CvssVersionContains(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContains(FieldCvssVersion, v))
+}
+
+// CvssVersionHasPrefix applies the HasPrefix predicate on the "cvss_version" field.
+func CvssVersionHasPrefix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasPrefix(FieldCvssVersion, v))
+}
+
+// CvssVersionHasSuffix applies the HasSuffix predicate on the "cvss_version" field.
+func CvssVersionHasSuffix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasSuffix(FieldCvssVersion, v))
+}
+
+// CvssVersionIsNil applies the IsNil predicate on the "cvss_version" field.
+func CvssVersionIsNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIsNull(FieldCvssVersion))
+}
+
+// CvssVersionNotNil applies the NotNil predicate on the "cvss_version" field.
+func CvssVersionNotNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotNull(FieldCvssVersion))
+}
+
+// CvssVersionEqualFold applies the EqualFold predicate on the "cvss_version" field.
+func CvssVersionEqualFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEqualFold(FieldCvssVersion, v))
+}
+
+// CvssVersionContainsFold applies the ContainsFold predicate on the "cvss_version" field.
+func CvssVersionContainsFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContainsFold(FieldCvssVersion, v))
+}
+
+// NvdSeverityEQ applies the EQ predicate on the "nvd_severity" field.
+func NvdSeverityEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldNvdSeverity, v))
+}
+
+// NvdSeverityNEQ applies the NEQ predicate on the "nvd_severity" field.
+func NvdSeverityNEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldNvdSeverity, v))
+}
+
+// NvdSeverityIn applies the In predicate on the "nvd_severity" field.
+func NvdSeverityIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldNvdSeverity, vs...))
+}
+
+// NvdSeverityNotIn applies the NotIn predicate on the "nvd_severity" field.
+func NvdSeverityNotIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldNvdSeverity, vs...))
+}
+
+// NvdSeverityGT applies the GT predicate on the "nvd_severity" field.
+func NvdSeverityGT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldNvdSeverity, v))
+}
+
+// NvdSeverityGTE applies the GTE predicate on the "nvd_severity" field.
+func NvdSeverityGTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldNvdSeverity, v))
+}
+
+// NvdSeverityLT applies the LT predicate on the "nvd_severity" field.
+func NvdSeverityLT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldNvdSeverity, v))
+}
+
+// NvdSeverityLTE applies the LTE predicate on the "nvd_severity" field.
+func NvdSeverityLTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldNvdSeverity, v))
+}
+
+// NvdSeverityContains applies the Contains predicate on the "nvd_severity" field.
+func NvdSeverityContains(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContains(FieldNvdSeverity, v))
+}
+
+// NvdSeverityHasPrefix applies the HasPrefix predicate on the "nvd_severity" field.
+func NvdSeverityHasPrefix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasPrefix(FieldNvdSeverity, v))
+}
+
+// NvdSeverityHasSuffix applies the HasSuffix predicate on the "nvd_severity" field.
+func NvdSeverityHasSuffix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasSuffix(FieldNvdSeverity, v))
+}
+
+// NvdSeverityIsNil applies the IsNil predicate on the "nvd_severity" field.
+func NvdSeverityIsNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIsNull(FieldNvdSeverity))
+}
+
+// NvdSeverityNotNil applies the NotNil predicate on the "nvd_severity" field.
+func NvdSeverityNotNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotNull(FieldNvdSeverity))
+}
+
+// NvdSeverityEqualFold applies the EqualFold predicate on the "nvd_severity" field.
+func NvdSeverityEqualFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEqualFold(FieldNvdSeverity, v))
+}
+
+// NvdSeverityContainsFold applies the ContainsFold predicate on the "nvd_severity" field.
+func NvdSeverityContainsFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContainsFold(FieldNvdSeverity, v))
+}
+
+// RiskScoreEQ applies the EQ predicate on the "risk_score" field.
+func RiskScoreEQ(v float64) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldRiskScore, v))
+}
+
+// RiskScoreNEQ applies the NEQ predicate on the "risk_score" field.
+func RiskScoreNEQ(v float64) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldRiskScore, v))
+}
+
+// RiskScoreIn applies the In predicate on the "risk_score" field.
+func RiskScoreIn(vs ...float64) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldRiskScore, vs...))
+}
+
+// RiskScoreNotIn applies the NotIn predicate on the "risk_score" field.
+func RiskScoreNotIn(vs ...float64) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldRiskScore, vs...))
+}
+
+// RiskScoreGT applies the GT predicate on the "risk_score" field.
+func RiskScoreGT(v float64) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldRiskScore, v))
+}
+
+// RiskScoreGTE applies the GTE predicate on the "risk_score" field.
+func RiskScoreGTE(v float64) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldRiskScore, v))
+}
+
+// RiskScoreLT applies the LT predicate on the "risk_score" field.
+func RiskScoreLT(v float64) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldRiskScore, v))
+}
+
+// RiskScoreLTE applies the LTE predicate on the "risk_score" field.
+func RiskScoreLTE(v float64) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldRiskScore, v))
+}
+
+// ExploitCodeMaturityEQ applies the EQ predicate on the "exploit_code_maturity" field.
+func ExploitCodeMaturityEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldExploitCodeMaturity, v))
+}
+
+// ExploitCodeMaturityNEQ applies the NEQ predicate on the "exploit_code_maturity" field.
+func ExploitCodeMaturityNEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldExploitCodeMaturity, v))
+}
+
+// ExploitCodeMaturityIn applies the In predicate on the "exploit_code_maturity" field.
+func ExploitCodeMaturityIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldExploitCodeMaturity, vs...))
+}
+
+// ExploitCodeMaturityNotIn applies the NotIn predicate on the "exploit_code_maturity" field.
+func ExploitCodeMaturityNotIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldExploitCodeMaturity, vs...))
+}
+
+// ExploitCodeMaturityGT applies the GT predicate on the "exploit_code_maturity" field.
+func ExploitCodeMaturityGT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldExploitCodeMaturity, v))
+}
+
+// ExploitCodeMaturityGTE applies the GTE predicate on the "exploit_code_maturity" field.
+func ExploitCodeMaturityGTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldExploitCodeMaturity, v))
+}
+
+// ExploitCodeMaturityLT applies the LT predicate on the "exploit_code_maturity" field.
+func ExploitCodeMaturityLT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldExploitCodeMaturity, v))
+}
+
+// ExploitCodeMaturityLTE applies the LTE predicate on the "exploit_code_maturity" field.
+func ExploitCodeMaturityLTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldExploitCodeMaturity, v))
+}
+
+// ExploitCodeMaturityContains applies the Contains predicate on the "exploit_code_maturity" field.
+func ExploitCodeMaturityContains(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContains(FieldExploitCodeMaturity, v))
+}
+
+// ExploitCodeMaturityHasPrefix applies the HasPrefix predicate on the "exploit_code_maturity" field.
+func ExploitCodeMaturityHasPrefix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasPrefix(FieldExploitCodeMaturity, v))
+}
+
+// ExploitCodeMaturityHasSuffix applies the HasSuffix predicate on the "exploit_code_maturity" field.
+func ExploitCodeMaturityHasSuffix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasSuffix(FieldExploitCodeMaturity, v))
+}
+
+// ExploitCodeMaturityIsNil applies the IsNil predicate on the "exploit_code_maturity" field.
+func ExploitCodeMaturityIsNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIsNull(FieldExploitCodeMaturity))
+}
+
+// ExploitCodeMaturityNotNil applies the NotNil predicate on the "exploit_code_maturity" field.
+func ExploitCodeMaturityNotNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotNull(FieldExploitCodeMaturity))
+}
+
+// ExploitCodeMaturityEqualFold applies the EqualFold predicate on the "exploit_code_maturity" field.
+func ExploitCodeMaturityEqualFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEqualFold(FieldExploitCodeMaturity, v))
+}
+
+// ExploitCodeMaturityContainsFold applies the ContainsFold predicate on the "exploit_code_maturity" field.
+func ExploitCodeMaturityContainsFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContainsFold(FieldExploitCodeMaturity, v))
+}
+
+// ExploitedInTheWildEQ applies the EQ predicate on the "exploited_in_the_wild" field.
+func ExploitedInTheWildEQ(v bool) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldExploitedInTheWild, v))
+}
+
+// ExploitedInTheWildNEQ applies the NEQ predicate on the "exploited_in_the_wild" field.
+func ExploitedInTheWildNEQ(v bool) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldExploitedInTheWild, v))
+}
+
+// RemediationLevelEQ applies the EQ predicate on the "remediation_level" field.
+func RemediationLevelEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldRemediationLevel, v))
+}
+
+// RemediationLevelNEQ applies the NEQ predicate on the "remediation_level" field.
+func RemediationLevelNEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldRemediationLevel, v))
+}
+
+// RemediationLevelIn applies the In predicate on the "remediation_level" field.
+func RemediationLevelIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldRemediationLevel, vs...))
+}
+
+// RemediationLevelNotIn applies the NotIn predicate on the "remediation_level" field.
+func RemediationLevelNotIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldRemediationLevel, vs...))
+}
+
+// RemediationLevelGT applies the GT predicate on the "remediation_level" field.
+func RemediationLevelGT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldRemediationLevel, v))
+}
+
+// RemediationLevelGTE applies the GTE predicate on the "remediation_level" field.
+func RemediationLevelGTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldRemediationLevel, v))
+}
+
+// RemediationLevelLT applies the LT predicate on the "remediation_level" field.
+func RemediationLevelLT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldRemediationLevel, v))
+}
+
+// RemediationLevelLTE applies the LTE predicate on the "remediation_level" field.
+func RemediationLevelLTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldRemediationLevel, v))
+}
+
+// RemediationLevelContains applies the Contains predicate on the "remediation_level" field.
+func RemediationLevelContains(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContains(FieldRemediationLevel, v))
+}
+
+// RemediationLevelHasPrefix applies the HasPrefix predicate on the "remediation_level" field.
+func RemediationLevelHasPrefix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasPrefix(FieldRemediationLevel, v))
+}
+
+// RemediationLevelHasSuffix applies the HasSuffix predicate on the "remediation_level" field.
+func RemediationLevelHasSuffix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasSuffix(FieldRemediationLevel, v))
+}
+
+// RemediationLevelIsNil applies the IsNil predicate on the "remediation_level" field.
+func RemediationLevelIsNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIsNull(FieldRemediationLevel))
+}
+
+// RemediationLevelNotNil applies the NotNil predicate on the "remediation_level" field.
+func RemediationLevelNotNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotNull(FieldRemediationLevel))
+}
+
+// RemediationLevelEqualFold applies the EqualFold predicate on the "remediation_level" field.
+func RemediationLevelEqualFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEqualFold(FieldRemediationLevel, v))
+}
+
+// RemediationLevelContainsFold applies the ContainsFold predicate on the "remediation_level" field.
+func RemediationLevelContainsFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContainsFold(FieldRemediationLevel, v))
+}
+
+// ReportConfidenceEQ applies the EQ predicate on the "report_confidence" field.
+func ReportConfidenceEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldReportConfidence, v))
+}
+
+// ReportConfidenceNEQ applies the NEQ predicate on the "report_confidence" field.
+func ReportConfidenceNEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldReportConfidence, v))
+}
+
+// ReportConfidenceIn applies the In predicate on the "report_confidence" field.
+func ReportConfidenceIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldReportConfidence, vs...))
+}
+
+// ReportConfidenceNotIn applies the NotIn predicate on the "report_confidence" field.
+func ReportConfidenceNotIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldReportConfidence, vs...))
+}
+
+// ReportConfidenceGT applies the GT predicate on the "report_confidence" field.
+func ReportConfidenceGT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldReportConfidence, v))
+}
+
+// ReportConfidenceGTE applies the GTE predicate on the "report_confidence" field.
+func ReportConfidenceGTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldReportConfidence, v))
+}
+
+// ReportConfidenceLT applies the LT predicate on the "report_confidence" field.
+func ReportConfidenceLT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldReportConfidence, v))
+}
+
+// ReportConfidenceLTE applies the LTE predicate on the "report_confidence" field.
+func ReportConfidenceLTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldReportConfidence, v))
+}
+
+// ReportConfidenceContains applies the Contains predicate on the "report_confidence" field.
+func ReportConfidenceContains(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContains(FieldReportConfidence, v))
+}
+
+// ReportConfidenceHasPrefix applies the HasPrefix predicate on the "report_confidence" field.
+func ReportConfidenceHasPrefix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasPrefix(FieldReportConfidence, v))
+}
+
+// ReportConfidenceHasSuffix applies the HasSuffix predicate on the "report_confidence" field.
+func ReportConfidenceHasSuffix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasSuffix(FieldReportConfidence, v))
+}
+
+// ReportConfidenceIsNil applies the IsNil predicate on the "report_confidence" field.
+func ReportConfidenceIsNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIsNull(FieldReportConfidence))
+}
+
+// ReportConfidenceNotNil applies the NotNil predicate on the "report_confidence" field.
+func ReportConfidenceNotNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotNull(FieldReportConfidence))
+}
+
+// ReportConfidenceEqualFold applies the EqualFold predicate on the "report_confidence" field.
+func ReportConfidenceEqualFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEqualFold(FieldReportConfidence, v))
+}
+
+// ReportConfidenceContainsFold applies the ContainsFold predicate on the "report_confidence" field.
+func ReportConfidenceContainsFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContainsFold(FieldReportConfidence, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotNull(FieldStatus))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// PublishedDateEQ applies the EQ predicate on the "published_date" field.
+func PublishedDateEQ(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldPublishedDate, v))
+}
+
+// PublishedDateNEQ applies the NEQ predicate on the "published_date" field.
+func PublishedDateNEQ(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldPublishedDate, v))
+}
+
+// PublishedDateIn applies the In predicate on the "published_date" field.
+func PublishedDateIn(vs ...time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldPublishedDate, vs...))
+}
+
+// PublishedDateNotIn applies the NotIn predicate on the "published_date" field.
+func PublishedDateNotIn(vs ...time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldPublishedDate, vs...))
+}
+
+// PublishedDateGT applies the GT predicate on the "published_date" field.
+func PublishedDateGT(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldPublishedDate, v))
+}
+
+// PublishedDateGTE applies the GTE predicate on the "published_date" field.
+func PublishedDateGTE(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldPublishedDate, v))
+}
+
+// PublishedDateLT applies the LT predicate on the "published_date" field.
+func PublishedDateLT(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldPublishedDate, v))
+}
+
+// PublishedDateLTE applies the LTE predicate on the "published_date" field.
+func PublishedDateLTE(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldPublishedDate, v))
+}
+
+// PublishedDateIsNil applies the IsNil predicate on the "published_date" field.
+func PublishedDateIsNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIsNull(FieldPublishedDate))
+}
+
+// PublishedDateNotNil applies the NotNil predicate on the "published_date" field.
+func PublishedDateNotNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotNull(FieldPublishedDate))
+}
+
+// DetectionDateEQ applies the EQ predicate on the "detection_date" field.
+func DetectionDateEQ(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldEQ(FieldDetectionDate, v))
+}
+
+// DetectionDateNEQ applies the NEQ predicate on the "detection_date" field.
+func DetectionDateNEQ(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNEQ(FieldDetectionDate, v))
+}
+
+// DetectionDateIn applies the In predicate on the "detection_date" field.
+func DetectionDateIn(vs ...time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIn(FieldDetectionDate, vs...))
+}
+
+// DetectionDateNotIn applies the NotIn predicate on the "detection_date" field.
+func DetectionDateNotIn(vs ...time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotIn(FieldDetectionDate, vs...))
+}
+
+// DetectionDateGT applies the GT predicate on the "detection_date" field.
+func DetectionDateGT(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGT(FieldDetectionDate, v))
+}
+
+// DetectionDateGTE applies the GTE predicate on the "detection_date" field.
+func DetectionDateGTE(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldGTE(FieldDetectionDate, v))
+}
+
+// DetectionDateLT applies the LT predicate on the "detection_date" field.
+func DetectionDateLT(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLT(FieldDetectionDate, v))
+}
+
+// DetectionDateLTE applies the LTE predicate on the "detection_date" field.
+func DetectionDateLTE(v time.Time) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldLTE(FieldDetectionDate, v))
+}
+
+// DetectionDateIsNil applies the IsNil predicate on the "detection_date" field.
+func DetectionDateIsNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldIsNull(FieldDetectionDate))
+}
+
+// DetectionDateNotNil applies the NotNil predicate on the "detection_date" field.
+func DetectionDateNotNil() predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.FieldNotNull(FieldDetectionDate))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeHistoryS1AppCVE) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeHistoryS1AppCVE) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeHistoryS1AppCVE) predicate.BronzeHistoryS1AppCVE {
+	return predicate.BronzeHistoryS1AppCVE(sql.NotPredicates(p))
+}
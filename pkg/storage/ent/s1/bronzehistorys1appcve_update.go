@@ -0,0 +1,1311 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1appcve"
+	"danny.vn/hotpot/pkg/storage/ent/s1/internal"
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryS1AppCVEUpdate is the builder for updating BronzeHistoryS1AppCVE entities.
+type BronzeHistoryS1AppCVEUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryS1AppCVEMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryS1AppCVEUpdate builder.
+func (_u *BronzeHistoryS1AppCVEUpdate) Where(ps ...predicate.BronzeHistoryS1AppCVE) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetValidTo(v time.Time) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) ClearValidTo() *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetCollectedAt(v time.Time) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetResourceID(v string) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableResourceID(v *string) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetAgentID sets the "agent_id" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetAgentID(v string) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetAgentID(v)
+	return _u
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableAgentID(v *string) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetAgentID(*v)
+	}
+	return _u
+}
+
+// SetEndpointName sets the "endpoint_name" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetEndpointName(v string) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetEndpointName(v)
+	return _u
+}
+
+// SetNillableEndpointName sets the "endpoint_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableEndpointName(v *string) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetEndpointName(*v)
+	}
+	return _u
+}
+
+// ClearEndpointName clears the value of the "endpoint_name" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) ClearEndpointName() *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.ClearEndpointName()
+	return _u
+}
+
+// SetEndpointType sets the "endpoint_type" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetEndpointType(v string) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetEndpointType(v)
+	return _u
+}
+
+// SetNillableEndpointType sets the "endpoint_type" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableEndpointType(v *string) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetEndpointType(*v)
+	}
+	return _u
+}
+
+// ClearEndpointType clears the value of the "endpoint_type" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) ClearEndpointType() *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.ClearEndpointType()
+	return _u
+}
+
+// SetOsType sets the "os_type" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetOsType(v string) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetOsType(v)
+	return _u
+}
+
+// SetNillableOsType sets the "os_type" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableOsType(v *string) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetOsType(*v)
+	}
+	return _u
+}
+
+// ClearOsType clears the value of the "os_type" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) ClearOsType() *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.ClearOsType()
+	return _u
+}
+
+// SetApplicationName sets the "application_name" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetApplicationName(v string) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetApplicationName(v)
+	return _u
+}
+
+// SetNillableApplicationName sets the "application_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableApplicationName(v *string) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetApplicationName(*v)
+	}
+	return _u
+}
+
+// SetApplicationVendor sets the "application_vendor" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetApplicationVendor(v string) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetApplicationVendor(v)
+	return _u
+}
+
+// SetNillableApplicationVendor sets the "application_vendor" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableApplicationVendor(v *string) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetApplicationVendor(*v)
+	}
+	return _u
+}
+
+// ClearApplicationVendor clears the value of the "application_vendor" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) ClearApplicationVendor() *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.ClearApplicationVendor()
+	return _u
+}
+
+// SetApplicationVersion sets the "application_version" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetApplicationVersion(v string) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetApplicationVersion(v)
+	return _u
+}
+
+// SetNillableApplicationVersion sets the "application_version" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableApplicationVersion(v *string) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetApplicationVersion(*v)
+	}
+	return _u
+}
+
+// ClearApplicationVersion clears the value of the "application_version" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) ClearApplicationVersion() *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.ClearApplicationVersion()
+	return _u
+}
+
+// SetCveID sets the "cve_id" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetCveID(v string) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetCveID(v)
+	return _u
+}
+
+// SetNillableCveID sets the "cve_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableCveID(v *string) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetCveID(*v)
+	}
+	return _u
+}
+
+// SetCvssScore sets the "cvss_score" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetCvssScore(v float64) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.ResetCvssScore()
+	_u.mutation.SetCvssScore(v)
+	return _u
+}
+
+// SetNillableCvssScore sets the "cvss_score" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableCvssScore(v *float64) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetCvssScore(*v)
+	}
+	return _u
+}
+
+// AddCvssScore adds value to the "cvss_score" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) AddCvssScore(v float64) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.AddCvssScore(v)
+	return _u
+}
+
+// SetCvssVersion sets the "cvss_version" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetCvssVersion(v string) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetCvssVersion(v)
+	return _u
+}
+
+// SetNillableCvssVersion sets the "cvss_version" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableCvssVersion(v *string) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetCvssVersion(*v)
+	}
+	return _u
+}
+
+// ClearCvssVersion clears the value of the "cvss_version" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) ClearCvssVersion() *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.ClearCvssVersion()
+	return _u
+}
+
+// SetNvdSeverity sets the "nvd_severity" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNvdSeverity(v string) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetNvdSeverity(v)
+	return _u
+}
+
+// SetNillableNvdSeverity sets the "nvd_severity" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableNvdSeverity(v *string) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetNvdSeverity(*v)
+	}
+	return _u
+}
+
+// ClearNvdSeverity clears the value of the "nvd_severity" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) ClearNvdSeverity() *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.ClearNvdSeverity()
+	return _u
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetRiskScore(v float64) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.ResetRiskScore()
+	_u.mutation.SetRiskScore(v)
+	return _u
+}
+
+// SetNillableRiskScore sets the "risk_score" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableRiskScore(v *float64) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetRiskScore(*v)
+	}
+	return _u
+}
+
+// AddRiskScore adds value to the "risk_score" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) AddRiskScore(v float64) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.AddRiskScore(v)
+	return _u
+}
+
+// SetExploitCodeMaturity sets the "exploit_code_maturity" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetExploitCodeMaturity(v string) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetExploitCodeMaturity(v)
+	return _u
+}
+
+// SetNillableExploitCodeMaturity sets the "exploit_code_maturity" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableExploitCodeMaturity(v *string) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetExploitCodeMaturity(*v)
+	}
+	return _u
+}
+
+// ClearExploitCodeMaturity clears the value of the "exploit_code_maturity" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) ClearExploitCodeMaturity() *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.ClearExploitCodeMaturity()
+	return _u
+}
+
+// SetExploitedInTheWild sets the "exploited_in_the_wild" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetExploitedInTheWild(v bool) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetExploitedInTheWild(v)
+	return _u
+}
+
+// SetNillableExploitedInTheWild sets the "exploited_in_the_wild" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableExploitedInTheWild(v *bool) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetExploitedInTheWild(*v)
+	}
+	return _u
+}
+
+// SetRemediationLevel sets the "remediation_level" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetRemediationLevel(v string) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetRemediationLevel(v)
+	return _u
+}
+
+// SetNillableRemediationLevel sets the "remediation_level" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableRemediationLevel(v *string) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetRemediationLevel(*v)
+	}
+	return _u
+}
+
+// ClearRemediationLevel clears the value of the "remediation_level" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) ClearRemediationLevel() *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.ClearRemediationLevel()
+	return _u
+}
+
+// SetReportConfidence sets the "report_confidence" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetReportConfidence(v string) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetReportConfidence(v)
+	return _u
+}
+
+// SetNillableReportConfidence sets the "report_confidence" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableReportConfidence(v *string) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetReportConfidence(*v)
+	}
+	return _u
+}
+
+// ClearReportConfidence clears the value of the "report_confidence" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) ClearReportConfidence() *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.ClearReportConfidence()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetStatus(v string) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableStatus(v *string) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) ClearStatus() *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetPublishedDate sets the "published_date" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetPublishedDate(v time.Time) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetPublishedDate(v)
+	return _u
+}
+
+// SetNillablePublishedDate sets the "published_date" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillablePublishedDate(v *time.Time) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetPublishedDate(*v)
+	}
+	return _u
+}
+
+// ClearPublishedDate clears the value of the "published_date" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) ClearPublishedDate() *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.ClearPublishedDate()
+	return _u
+}
+
+// SetDetectionDate sets the "detection_date" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetDetectionDate(v time.Time) *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.SetDetectionDate(v)
+	return _u
+}
+
+// SetNillableDetectionDate sets the "detection_date" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdate) SetNillableDetectionDate(v *time.Time) *BronzeHistoryS1AppCVEUpdate {
+	if v != nil {
+		_u.SetDetectionDate(*v)
+	}
+	return _u
+}
+
+// ClearDetectionDate clears the value of the "detection_date" field.
+func (_u *BronzeHistoryS1AppCVEUpdate) ClearDetectionDate() *BronzeHistoryS1AppCVEUpdate {
+	_u.mutation.ClearDetectionDate()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryS1AppCVEMutation object of the builder.
+func (_u *BronzeHistoryS1AppCVEUpdate) Mutation() *BronzeHistoryS1AppCVEMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryS1AppCVEUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryS1AppCVEUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryS1AppCVEUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryS1AppCVEUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryS1AppCVEUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistorys1appcve.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1AppCVE.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AgentID(); ok {
+		if err := bronzehistorys1appcve.AgentIDValidator(v); err != nil {
+			return &ValidationError{Name: "agent_id", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1AppCVE.agent_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ApplicationName(); ok {
+		if err := bronzehistorys1appcve.ApplicationNameValidator(v); err != nil {
+			return &ValidationError{Name: "application_name", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1AppCVE.application_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CveID(); ok {
+		if err := bronzehistorys1appcve.CveIDValidator(v); err != nil {
+			return &ValidationError{Name: "cve_id", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1AppCVE.cve_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryS1AppCVEUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistorys1appcve.Table, bronzehistorys1appcve.Columns, sqlgraph.NewFieldSpec(bronzehistorys1appcve.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AgentID(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldAgentID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EndpointName(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldEndpointName, field.TypeString, value)
+	}
+	if _u.mutation.EndpointNameCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldEndpointName, field.TypeString)
+	}
+	if value, ok := _u.mutation.EndpointType(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldEndpointType, field.TypeString, value)
+	}
+	if _u.mutation.EndpointTypeCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldEndpointType, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsType(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldOsType, field.TypeString, value)
+	}
+	if _u.mutation.OsTypeCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldOsType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApplicationName(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldApplicationName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ApplicationVendor(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldApplicationVendor, field.TypeString, value)
+	}
+	if _u.mutation.ApplicationVendorCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldApplicationVendor, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApplicationVersion(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldApplicationVersion, field.TypeString, value)
+	}
+	if _u.mutation.ApplicationVersionCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldApplicationVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.CveID(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldCveID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CvssScore(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCvssScore(); ok {
+		_spec.AddField(bronzehistorys1appcve.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CvssVersion(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldCvssVersion, field.TypeString, value)
+	}
+	if _u.mutation.CvssVersionCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldCvssVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.NvdSeverity(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldNvdSeverity, field.TypeString, value)
+	}
+	if _u.mutation.NvdSeverityCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldNvdSeverity, field.TypeString)
+	}
+	if value, ok := _u.mutation.RiskScore(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldRiskScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRiskScore(); ok {
+		_spec.AddField(bronzehistorys1appcve.FieldRiskScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ExploitCodeMaturity(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldExploitCodeMaturity, field.TypeString, value)
+	}
+	if _u.mutation.ExploitCodeMaturityCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldExploitCodeMaturity, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExploitedInTheWild(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldExploitedInTheWild, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RemediationLevel(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldRemediationLevel, field.TypeString, value)
+	}
+	if _u.mutation.RemediationLevelCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldRemediationLevel, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReportConfidence(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldReportConfidence, field.TypeString, value)
+	}
+	if _u.mutation.ReportConfidenceCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldReportConfidence, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublishedDate(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldPublishedDate, field.TypeTime, value)
+	}
+	if _u.mutation.PublishedDateCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldPublishedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DetectionDate(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldDetectionDate, field.TypeTime, value)
+	}
+	if _u.mutation.DetectionDateCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldDetectionDate, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryS1AppCVE
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistorys1appcve.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryS1AppCVEUpdateOne is the builder for updating a single BronzeHistoryS1AppCVE entity.
+type BronzeHistoryS1AppCVEUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryS1AppCVEMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetValidTo(v time.Time) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) ClearValidTo() *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetResourceID(v string) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetAgentID sets the "agent_id" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetAgentID(v string) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetAgentID(v)
+	return _u
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableAgentID(v *string) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetAgentID(*v)
+	}
+	return _u
+}
+
+// SetEndpointName sets the "endpoint_name" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetEndpointName(v string) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetEndpointName(v)
+	return _u
+}
+
+// SetNillableEndpointName sets the "endpoint_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableEndpointName(v *string) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetEndpointName(*v)
+	}
+	return _u
+}
+
+// ClearEndpointName clears the value of the "endpoint_name" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) ClearEndpointName() *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.ClearEndpointName()
+	return _u
+}
+
+// SetEndpointType sets the "endpoint_type" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetEndpointType(v string) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetEndpointType(v)
+	return _u
+}
+
+// SetNillableEndpointType sets the "endpoint_type" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableEndpointType(v *string) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetEndpointType(*v)
+	}
+	return _u
+}
+
+// ClearEndpointType clears the value of the "endpoint_type" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) ClearEndpointType() *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.ClearEndpointType()
+	return _u
+}
+
+// SetOsType sets the "os_type" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetOsType(v string) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetOsType(v)
+	return _u
+}
+
+// SetNillableOsType sets the "os_type" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableOsType(v *string) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetOsType(*v)
+	}
+	return _u
+}
+
+// ClearOsType clears the value of the "os_type" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) ClearOsType() *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.ClearOsType()
+	return _u
+}
+
+// SetApplicationName sets the "application_name" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetApplicationName(v string) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetApplicationName(v)
+	return _u
+}
+
+// SetNillableApplicationName sets the "application_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableApplicationName(v *string) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetApplicationName(*v)
+	}
+	return _u
+}
+
+// SetApplicationVendor sets the "application_vendor" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetApplicationVendor(v string) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetApplicationVendor(v)
+	return _u
+}
+
+// SetNillableApplicationVendor sets the "application_vendor" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableApplicationVendor(v *string) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetApplicationVendor(*v)
+	}
+	return _u
+}
+
+// ClearApplicationVendor clears the value of the "application_vendor" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) ClearApplicationVendor() *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.ClearApplicationVendor()
+	return _u
+}
+
+// SetApplicationVersion sets the "application_version" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetApplicationVersion(v string) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetApplicationVersion(v)
+	return _u
+}
+
+// SetNillableApplicationVersion sets the "application_version" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableApplicationVersion(v *string) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetApplicationVersion(*v)
+	}
+	return _u
+}
+
+// ClearApplicationVersion clears the value of the "application_version" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) ClearApplicationVersion() *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.ClearApplicationVersion()
+	return _u
+}
+
+// SetCveID sets the "cve_id" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetCveID(v string) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetCveID(v)
+	return _u
+}
+
+// SetNillableCveID sets the "cve_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableCveID(v *string) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetCveID(*v)
+	}
+	return _u
+}
+
+// SetCvssScore sets the "cvss_score" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetCvssScore(v float64) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.ResetCvssScore()
+	_u.mutation.SetCvssScore(v)
+	return _u
+}
+
+// SetNillableCvssScore sets the "cvss_score" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableCvssScore(v *float64) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetCvssScore(*v)
+	}
+	return _u
+}
+
+// AddCvssScore adds value to the "cvss_score" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) AddCvssScore(v float64) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.AddCvssScore(v)
+	return _u
+}
+
+// SetCvssVersion sets the "cvss_version" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetCvssVersion(v string) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetCvssVersion(v)
+	return _u
+}
+
+// SetNillableCvssVersion sets the "cvss_version" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableCvssVersion(v *string) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetCvssVersion(*v)
+	}
+	return _u
+}
+
+// ClearCvssVersion clears the value of the "cvss_version" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) ClearCvssVersion() *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.ClearCvssVersion()
+	return _u
+}
+
+// SetNvdSeverity sets the "nvd_severity" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNvdSeverity(v string) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetNvdSeverity(v)
+	return _u
+}
+
+// SetNillableNvdSeverity sets the "nvd_severity" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableNvdSeverity(v *string) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetNvdSeverity(*v)
+	}
+	return _u
+}
+
+// ClearNvdSeverity clears the value of the "nvd_severity" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) ClearNvdSeverity() *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.ClearNvdSeverity()
+	return _u
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetRiskScore(v float64) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.ResetRiskScore()
+	_u.mutation.SetRiskScore(v)
+	return _u
+}
+
+// SetNillableRiskScore sets the "risk_score" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableRiskScore(v *float64) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetRiskScore(*v)
+	}
+	return _u
+}
+
+// AddRiskScore adds value to the "risk_score" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) AddRiskScore(v float64) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.AddRiskScore(v)
+	return _u
+}
+
+// SetExploitCodeMaturity sets the "exploit_code_maturity" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetExploitCodeMaturity(v string) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetExploitCodeMaturity(v)
+	return _u
+}
+
+// SetNillableExploitCodeMaturity sets the "exploit_code_maturity" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableExploitCodeMaturity(v *string) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetExploitCodeMaturity(*v)
+	}
+	return _u
+}
+
+// ClearExploitCodeMaturity clears the value of the "exploit_code_maturity" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) ClearExploitCodeMaturity() *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.ClearExploitCodeMaturity()
+	return _u
+}
+
+// SetExploitedInTheWild sets the "exploited_in_the_wild" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetExploitedInTheWild(v bool) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetExploitedInTheWild(v)
+	return _u
+}
+
+// SetNillableExploitedInTheWild sets the "exploited_in_the_wild" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableExploitedInTheWild(v *bool) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetExploitedInTheWild(*v)
+	}
+	return _u
+}
+
+// SetRemediationLevel sets the "remediation_level" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetRemediationLevel(v string) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetRemediationLevel(v)
+	return _u
+}
+
+// SetNillableRemediationLevel sets the "remediation_level" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableRemediationLevel(v *string) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetRemediationLevel(*v)
+	}
+	return _u
+}
+
+// ClearRemediationLevel clears the value of the "remediation_level" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) ClearRemediationLevel() *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.ClearRemediationLevel()
+	return _u
+}
+
+// SetReportConfidence sets the "report_confidence" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetReportConfidence(v string) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetReportConfidence(v)
+	return _u
+}
+
+// SetNillableReportConfidence sets the "report_confidence" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableReportConfidence(v *string) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetReportConfidence(*v)
+	}
+	return _u
+}
+
+// ClearReportConfidence clears the value of the "report_confidence" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) ClearReportConfidence() *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.ClearReportConfidence()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetStatus(v string) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableStatus(v *string) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) ClearStatus() *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetPublishedDate sets the "published_date" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetPublishedDate(v time.Time) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetPublishedDate(v)
+	return _u
+}
+
+// SetNillablePublishedDate sets the "published_date" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillablePublishedDate(v *time.Time) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetPublishedDate(*v)
+	}
+	return _u
+}
+
+// ClearPublishedDate clears the value of the "published_date" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) ClearPublishedDate() *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.ClearPublishedDate()
+	return _u
+}
+
+// SetDetectionDate sets the "detection_date" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetDetectionDate(v time.Time) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.SetDetectionDate(v)
+	return _u
+}
+
+// SetNillableDetectionDate sets the "detection_date" field if the given value is not nil.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SetNillableDetectionDate(v *time.Time) *BronzeHistoryS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetDetectionDate(*v)
+	}
+	return _u
+}
+
+// ClearDetectionDate clears the value of the "detection_date" field.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) ClearDetectionDate() *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.ClearDetectionDate()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryS1AppCVEMutation object of the builder.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) Mutation() *BronzeHistoryS1AppCVEMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryS1AppCVEUpdate builder.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) Where(ps ...predicate.BronzeHistoryS1AppCVE) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) Select(field string, fields ...string) *BronzeHistoryS1AppCVEUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryS1AppCVE entity.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) Save(ctx context.Context) (*BronzeHistoryS1AppCVE, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) SaveX(ctx context.Context) *BronzeHistoryS1AppCVE {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryS1AppCVEUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistorys1appcve.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1AppCVE.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AgentID(); ok {
+		if err := bronzehistorys1appcve.AgentIDValidator(v); err != nil {
+			return &ValidationError{Name: "agent_id", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1AppCVE.agent_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ApplicationName(); ok {
+		if err := bronzehistorys1appcve.ApplicationNameValidator(v); err != nil {
+			return &ValidationError{Name: "application_name", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1AppCVE.application_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CveID(); ok {
+		if err := bronzehistorys1appcve.CveIDValidator(v); err != nil {
+			return &ValidationError{Name: "cve_id", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1AppCVE.cve_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryS1AppCVEUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryS1AppCVE, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistorys1appcve.Table, bronzehistorys1appcve.Columns, sqlgraph.NewFieldSpec(bronzehistorys1appcve.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`s1: missing "BronzeHistoryS1AppCVE.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistorys1appcve.FieldID)
+		for _, f := range fields {
+			if !bronzehistorys1appcve.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("s1: invalid field %q for query", f)}
+			}
+			if f != bronzehistorys1appcve.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AgentID(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldAgentID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EndpointName(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldEndpointName, field.TypeString, value)
+	}
+	if _u.mutation.EndpointNameCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldEndpointName, field.TypeString)
+	}
+	if value, ok := _u.mutation.EndpointType(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldEndpointType, field.TypeString, value)
+	}
+	if _u.mutation.EndpointTypeCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldEndpointType, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsType(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldOsType, field.TypeString, value)
+	}
+	if _u.mutation.OsTypeCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldOsType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApplicationName(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldApplicationName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ApplicationVendor(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldApplicationVendor, field.TypeString, value)
+	}
+	if _u.mutation.ApplicationVendorCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldApplicationVendor, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApplicationVersion(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldApplicationVersion, field.TypeString, value)
+	}
+	if _u.mutation.ApplicationVersionCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldApplicationVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.CveID(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldCveID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CvssScore(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCvssScore(); ok {
+		_spec.AddField(bronzehistorys1appcve.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CvssVersion(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldCvssVersion, field.TypeString, value)
+	}
+	if _u.mutation.CvssVersionCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldCvssVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.NvdSeverity(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldNvdSeverity, field.TypeString, value)
+	}
+	if _u.mutation.NvdSeverityCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldNvdSeverity, field.TypeString)
+	}
+	if value, ok := _u.mutation.RiskScore(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldRiskScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRiskScore(); ok {
+		_spec.AddField(bronzehistorys1appcve.FieldRiskScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ExploitCodeMaturity(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldExploitCodeMaturity, field.TypeString, value)
+	}
+	if _u.mutation.ExploitCodeMaturityCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldExploitCodeMaturity, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExploitedInTheWild(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldExploitedInTheWild, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RemediationLevel(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldRemediationLevel, field.TypeString, value)
+	}
+	if _u.mutation.RemediationLevelCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldRemediationLevel, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReportConfidence(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldReportConfidence, field.TypeString, value)
+	}
+	if _u.mutation.ReportConfidenceCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldReportConfidence, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublishedDate(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldPublishedDate, field.TypeTime, value)
+	}
+	if _u.mutation.PublishedDateCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldPublishedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DetectionDate(); ok {
+		_spec.SetField(bronzehistorys1appcve.FieldDetectionDate, field.TypeTime, value)
+	}
+	if _u.mutation.DetectionDateCleared() {
+		_spec.ClearField(bronzehistorys1appcve.FieldDetectionDate, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryS1AppCVE
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryS1AppCVE{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistorys1appcve.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
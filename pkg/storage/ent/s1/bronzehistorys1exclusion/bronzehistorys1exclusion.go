@@ -0,0 +1,265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzehistorys1exclusion
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzehistorys1exclusion type in the database.
+	Label = "bronze_history_s1exclusion"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "history_id"
+	// FieldValidFrom holds the string denoting the valid_from field in the database.
+	FieldValidFrom = "valid_from"
+	// FieldValidTo holds the string denoting the valid_to field in the database.
+	FieldValidTo = "valid_to"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldResourceID holds the string denoting the resource_id field in the database.
+	FieldResourceID = "resource_id"
+	// FieldScopeLevel holds the string denoting the scope_level field in the database.
+	FieldScopeLevel = "scope_level"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldSiteID holds the string denoting the site_id field in the database.
+	FieldSiteID = "site_id"
+	// FieldGroupID holds the string denoting the group_id field in the database.
+	FieldGroupID = "group_id"
+	// FieldScopeName holds the string denoting the scope_name field in the database.
+	FieldScopeName = "scope_name"
+	// FieldScopePath holds the string denoting the scope_path field in the database.
+	FieldScopePath = "scope_path"
+	// FieldExclusionType holds the string denoting the exclusion_type field in the database.
+	FieldExclusionType = "exclusion_type"
+	// FieldExclusionValue holds the string denoting the exclusion_value field in the database.
+	FieldExclusionValue = "exclusion_value"
+	// FieldOsType holds the string denoting the os_type field in the database.
+	FieldOsType = "os_type"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldPathExclusionType holds the string denoting the path_exclusion_type field in the database.
+	FieldPathExclusionType = "path_exclusion_type"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldUserName holds the string denoting the user_name field in the database.
+	FieldUserName = "user_name"
+	// FieldApplicationName holds the string denoting the application_name field in the database.
+	FieldApplicationName = "application_name"
+	// FieldIncludeChildren holds the string denoting the include_children field in the database.
+	FieldIncludeChildren = "include_children"
+	// FieldIncludeSubfolders holds the string denoting the include_subfolders field in the database.
+	FieldIncludeSubfolders = "include_subfolders"
+	// FieldImported holds the string denoting the imported field in the database.
+	FieldImported = "imported"
+	// FieldActionsJSON holds the string denoting the actions_json field in the database.
+	FieldActionsJSON = "actions_json"
+	// FieldAPICreatedAt holds the string denoting the api_created_at field in the database.
+	FieldAPICreatedAt = "api_created_at"
+	// FieldAPIUpdatedAt holds the string denoting the api_updated_at field in the database.
+	FieldAPIUpdatedAt = "api_updated_at"
+	// Table holds the table name of the bronzehistorys1exclusion in the database.
+	Table = "s1_exclusions_history"
+)
+
+// Columns holds all SQL columns for bronzehistorys1exclusion fields.
+var Columns = []string{
+	FieldID,
+	FieldValidFrom,
+	FieldValidTo,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldResourceID,
+	FieldScopeLevel,
+	FieldAccountID,
+	FieldSiteID,
+	FieldGroupID,
+	FieldScopeName,
+	FieldScopePath,
+	FieldExclusionType,
+	FieldExclusionValue,
+	FieldOsType,
+	FieldMode,
+	FieldPathExclusionType,
+	FieldDescription,
+	FieldSource,
+	FieldUserID,
+	FieldUserName,
+	FieldApplicationName,
+	FieldIncludeChildren,
+	FieldIncludeSubfolders,
+	FieldImported,
+	FieldActionsJSON,
+	FieldAPICreatedAt,
+	FieldAPIUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	ResourceIDValidator func(string) error
+	// ScopeLevelValidator is a validator for the "scope_level" field. It is called by the builders before save.
+	ScopeLevelValidator func(string) error
+	// ExclusionTypeValidator is a validator for the "exclusion_type" field. It is called by the builders before save.
+	ExclusionTypeValidator func(string) error
+	// DefaultIncludeChildren holds the default value on creation for the "include_children" field.
+	DefaultIncludeChildren bool
+	// DefaultIncludeSubfolders holds the default value on creation for the "include_subfolders" field.
+	DefaultIncludeSubfolders bool
+	// DefaultImported holds the default value on creation for the "imported" field.
+	DefaultImported bool
+)
+
+// OrderOption defines the ordering options for the BronzeHistoryS1Exclusion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByValidFrom orders the results by the valid_from field.
+func ByValidFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidFrom, opts...).ToFunc()
+}
+
+// ByValidTo orders the results by the valid_to field.
+func ByValidTo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidTo, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByResourceID orders the results by the resource_id field.
+func ByResourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceID, opts...).ToFunc()
+}
+
+// ByScopeLevel orders the results by the scope_level field.
+func ByScopeLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScopeLevel, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// BySiteID orders the results by the site_id field.
+func BySiteID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSiteID, opts...).ToFunc()
+}
+
+// ByGroupID orders the results by the group_id field.
+func ByGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupID, opts...).ToFunc()
+}
+
+// ByScopeName orders the results by the scope_name field.
+func ByScopeName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScopeName, opts...).ToFunc()
+}
+
+// ByScopePath orders the results by the scope_path field.
+func ByScopePath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScopePath, opts...).ToFunc()
+}
+
+// ByExclusionType orders the results by the exclusion_type field.
+func ByExclusionType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExclusionType, opts...).ToFunc()
+}
+
+// ByExclusionValue orders the results by the exclusion_value field.
+func ByExclusionValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExclusionValue, opts...).ToFunc()
+}
+
+// ByOsType orders the results by the os_type field.
+func ByOsType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOsType, opts...).ToFunc()
+}
+
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// ByPathExclusionType orders the results by the path_exclusion_type field.
+func ByPathExclusionType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPathExclusionType, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByUserName orders the results by the user_name field.
+func ByUserName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserName, opts...).ToFunc()
+}
+
+// ByApplicationName orders the results by the application_name field.
+func ByApplicationName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApplicationName, opts...).ToFunc()
+}
+
+// ByIncludeChildren orders the results by the include_children field.
+func ByIncludeChildren(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncludeChildren, opts...).ToFunc()
+}
+
+// ByIncludeSubfolders orders the results by the include_subfolders field.
+func ByIncludeSubfolders(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncludeSubfolders, opts...).ToFunc()
+}
+
+// ByImported orders the results by the imported field.
+func ByImported(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImported, opts...).ToFunc()
+}
+
+// ByAPICreatedAt orders the results by the api_created_at field.
+func ByAPICreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPICreatedAt, opts...).ToFunc()
+}
+
+// ByAPIUpdatedAt orders the results by the api_updated_at field.
+func ByAPIUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPIUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,675 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1exclusion"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryS1ExclusionCreate is the builder for creating a BronzeHistoryS1Exclusion entity.
+type BronzeHistoryS1ExclusionCreate struct {
+	config
+	mutation *BronzeHistoryS1ExclusionMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetValidFrom(v time.Time) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetValidTo(v time.Time) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetCollectedAt(v time.Time) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetResourceID(v string) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetScopeLevel sets the "scope_level" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetScopeLevel(v string) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetScopeLevel(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetAccountID(v string) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillableAccountID(v *string) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetAccountID(*v)
+	}
+	return _c
+}
+
+// SetSiteID sets the "site_id" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetSiteID(v string) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetSiteID(v)
+	return _c
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillableSiteID(v *string) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetSiteID(*v)
+	}
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetGroupID(v string) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillableGroupID(v *string) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetGroupID(*v)
+	}
+	return _c
+}
+
+// SetScopeName sets the "scope_name" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetScopeName(v string) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetScopeName(v)
+	return _c
+}
+
+// SetNillableScopeName sets the "scope_name" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillableScopeName(v *string) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetScopeName(*v)
+	}
+	return _c
+}
+
+// SetScopePath sets the "scope_path" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetScopePath(v string) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetScopePath(v)
+	return _c
+}
+
+// SetNillableScopePath sets the "scope_path" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillableScopePath(v *string) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetScopePath(*v)
+	}
+	return _c
+}
+
+// SetExclusionType sets the "exclusion_type" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetExclusionType(v string) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetExclusionType(v)
+	return _c
+}
+
+// SetExclusionValue sets the "exclusion_value" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetExclusionValue(v string) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetExclusionValue(v)
+	return _c
+}
+
+// SetNillableExclusionValue sets the "exclusion_value" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillableExclusionValue(v *string) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetExclusionValue(*v)
+	}
+	return _c
+}
+
+// SetOsType sets the "os_type" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetOsType(v string) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetOsType(v)
+	return _c
+}
+
+// SetNillableOsType sets the "os_type" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillableOsType(v *string) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetOsType(*v)
+	}
+	return _c
+}
+
+// SetMode sets the "mode" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetMode(v string) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetMode(v)
+	return _c
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillableMode(v *string) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetMode(*v)
+	}
+	return _c
+}
+
+// SetPathExclusionType sets the "path_exclusion_type" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetPathExclusionType(v string) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetPathExclusionType(v)
+	return _c
+}
+
+// SetNillablePathExclusionType sets the "path_exclusion_type" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillablePathExclusionType(v *string) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetPathExclusionType(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetDescription(v string) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillableDescription(v *string) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetSource(v string) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillableSource(v *string) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetUserID(v string) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillableUserID(v *string) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetUserName sets the "user_name" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetUserName(v string) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetUserName(v)
+	return _c
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillableUserName(v *string) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetUserName(*v)
+	}
+	return _c
+}
+
+// SetApplicationName sets the "application_name" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetApplicationName(v string) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetApplicationName(v)
+	return _c
+}
+
+// SetNillableApplicationName sets the "application_name" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillableApplicationName(v *string) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetApplicationName(*v)
+	}
+	return _c
+}
+
+// SetIncludeChildren sets the "include_children" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetIncludeChildren(v bool) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetIncludeChildren(v)
+	return _c
+}
+
+// SetNillableIncludeChildren sets the "include_children" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillableIncludeChildren(v *bool) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetIncludeChildren(*v)
+	}
+	return _c
+}
+
+// SetIncludeSubfolders sets the "include_subfolders" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetIncludeSubfolders(v bool) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetIncludeSubfolders(v)
+	return _c
+}
+
+// SetNillableIncludeSubfolders sets the "include_subfolders" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillableIncludeSubfolders(v *bool) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetIncludeSubfolders(*v)
+	}
+	return _c
+}
+
+// SetImported sets the "imported" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetImported(v bool) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetImported(v)
+	return _c
+}
+
+// SetNillableImported sets the "imported" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillableImported(v *bool) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetImported(*v)
+	}
+	return _c
+}
+
+// SetActionsJSON sets the "actions_json" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetActionsJSON(v json.RawMessage) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetActionsJSON(v)
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetAPICreatedAt(v time.Time) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetAPIUpdatedAt(v time.Time) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetAPIUpdatedAt(v)
+	return _c
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_c *BronzeHistoryS1ExclusionCreate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeHistoryS1ExclusionCreate {
+	if v != nil {
+		_c.SetAPIUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryS1ExclusionCreate) SetID(v uint) *BronzeHistoryS1ExclusionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryS1ExclusionMutation object of the builder.
+func (_c *BronzeHistoryS1ExclusionCreate) Mutation() *BronzeHistoryS1ExclusionMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryS1Exclusion in the database.
+func (_c *BronzeHistoryS1ExclusionCreate) Save(ctx context.Context) (*BronzeHistoryS1Exclusion, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryS1ExclusionCreate) SaveX(ctx context.Context) *BronzeHistoryS1Exclusion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryS1ExclusionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryS1ExclusionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeHistoryS1ExclusionCreate) defaults() {
+	if _, ok := _c.mutation.IncludeChildren(); !ok {
+		v := bronzehistorys1exclusion.DefaultIncludeChildren
+		_c.mutation.SetIncludeChildren(v)
+	}
+	if _, ok := _c.mutation.IncludeSubfolders(); !ok {
+		v := bronzehistorys1exclusion.DefaultIncludeSubfolders
+		_c.mutation.SetIncludeSubfolders(v)
+	}
+	if _, ok := _c.mutation.Imported(); !ok {
+		v := bronzehistorys1exclusion.DefaultImported
+		_c.mutation.SetImported(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryS1ExclusionCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`s1: missing required field "BronzeHistoryS1Exclusion.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`s1: missing required field "BronzeHistoryS1Exclusion.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`s1: missing required field "BronzeHistoryS1Exclusion.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`s1: missing required field "BronzeHistoryS1Exclusion.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistorys1exclusion.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Exclusion.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ScopeLevel(); !ok {
+		return &ValidationError{Name: "scope_level", err: errors.New(`s1: missing required field "BronzeHistoryS1Exclusion.scope_level"`)}
+	}
+	if v, ok := _c.mutation.ScopeLevel(); ok {
+		if err := bronzehistorys1exclusion.ScopeLevelValidator(v); err != nil {
+			return &ValidationError{Name: "scope_level", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Exclusion.scope_level": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExclusionType(); !ok {
+		return &ValidationError{Name: "exclusion_type", err: errors.New(`s1: missing required field "BronzeHistoryS1Exclusion.exclusion_type"`)}
+	}
+	if v, ok := _c.mutation.ExclusionType(); ok {
+		if err := bronzehistorys1exclusion.ExclusionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "exclusion_type", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Exclusion.exclusion_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IncludeChildren(); !ok {
+		return &ValidationError{Name: "include_children", err: errors.New(`s1: missing required field "BronzeHistoryS1Exclusion.include_children"`)}
+	}
+	if _, ok := _c.mutation.IncludeSubfolders(); !ok {
+		return &ValidationError{Name: "include_subfolders", err: errors.New(`s1: missing required field "BronzeHistoryS1Exclusion.include_subfolders"`)}
+	}
+	if _, ok := _c.mutation.Imported(); !ok {
+		return &ValidationError{Name: "imported", err: errors.New(`s1: missing required field "BronzeHistoryS1Exclusion.imported"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryS1ExclusionCreate) sqlSave(ctx context.Context) (*BronzeHistoryS1Exclusion, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryS1ExclusionCreate) createSpec() (*BronzeHistoryS1Exclusion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryS1Exclusion{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistorys1exclusion.Table, sqlgraph.NewFieldSpec(bronzehistorys1exclusion.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryS1Exclusion
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.ScopeLevel(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldScopeLevel, field.TypeString, value)
+		_node.ScopeLevel = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.SiteID(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldSiteID, field.TypeString, value)
+		_node.SiteID = value
+	}
+	if value, ok := _c.mutation.GroupID(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldGroupID, field.TypeString, value)
+		_node.GroupID = value
+	}
+	if value, ok := _c.mutation.ScopeName(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldScopeName, field.TypeString, value)
+		_node.ScopeName = value
+	}
+	if value, ok := _c.mutation.ScopePath(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldScopePath, field.TypeString, value)
+		_node.ScopePath = value
+	}
+	if value, ok := _c.mutation.ExclusionType(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldExclusionType, field.TypeString, value)
+		_node.ExclusionType = value
+	}
+	if value, ok := _c.mutation.ExclusionValue(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldExclusionValue, field.TypeString, value)
+		_node.ExclusionValue = value
+	}
+	if value, ok := _c.mutation.OsType(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldOsType, field.TypeString, value)
+		_node.OsType = value
+	}
+	if value, ok := _c.mutation.Mode(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldMode, field.TypeString, value)
+		_node.Mode = value
+	}
+	if value, ok := _c.mutation.PathExclusionType(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldPathExclusionType, field.TypeString, value)
+		_node.PathExclusionType = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.UserName(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldUserName, field.TypeString, value)
+		_node.UserName = value
+	}
+	if value, ok := _c.mutation.ApplicationName(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldApplicationName, field.TypeString, value)
+		_node.ApplicationName = value
+	}
+	if value, ok := _c.mutation.IncludeChildren(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldIncludeChildren, field.TypeBool, value)
+		_node.IncludeChildren = value
+	}
+	if value, ok := _c.mutation.IncludeSubfolders(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldIncludeSubfolders, field.TypeBool, value)
+		_node.IncludeSubfolders = value
+	}
+	if value, ok := _c.mutation.Imported(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldImported, field.TypeBool, value)
+		_node.Imported = value
+	}
+	if value, ok := _c.mutation.ActionsJSON(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldActionsJSON, field.TypeJSON, value)
+		_node.ActionsJSON = value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	if value, ok := _c.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldAPIUpdatedAt, field.TypeTime, value)
+		_node.APIUpdatedAt = &value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryS1ExclusionCreateBulk is the builder for creating many BronzeHistoryS1Exclusion entities in bulk.
+type BronzeHistoryS1ExclusionCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryS1ExclusionCreate
+}
+
+// Save creates the BronzeHistoryS1Exclusion entities in the database.
+func (_c *BronzeHistoryS1ExclusionCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryS1Exclusion, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryS1Exclusion, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryS1ExclusionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryS1ExclusionCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryS1Exclusion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryS1ExclusionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryS1ExclusionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
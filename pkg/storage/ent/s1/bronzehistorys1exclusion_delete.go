@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1exclusion"
+	"danny.vn/hotpot/pkg/storage/ent/s1/internal"
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryS1ExclusionDelete is the builder for deleting a BronzeHistoryS1Exclusion entity.
+type BronzeHistoryS1ExclusionDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryS1ExclusionMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryS1ExclusionDelete builder.
+func (_d *BronzeHistoryS1ExclusionDelete) Where(ps ...predicate.BronzeHistoryS1Exclusion) *BronzeHistoryS1ExclusionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeHistoryS1ExclusionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryS1ExclusionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeHistoryS1ExclusionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzehistorys1exclusion.Table, sqlgraph.NewFieldSpec(bronzehistorys1exclusion.FieldID, field.TypeUint))
+	_spec.Node.Schema = _d.schemaConfig.BronzeHistoryS1Exclusion
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeHistoryS1ExclusionDeleteOne is the builder for deleting a single BronzeHistoryS1Exclusion entity.
+type BronzeHistoryS1ExclusionDeleteOne struct {
+	_d *BronzeHistoryS1ExclusionDelete
+}
+
+// Where appends a list predicates to the BronzeHistoryS1ExclusionDelete builder.
+func (_d *BronzeHistoryS1ExclusionDeleteOne) Where(ps ...predicate.BronzeHistoryS1Exclusion) *BronzeHistoryS1ExclusionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeHistoryS1ExclusionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzehistorys1exclusion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryS1ExclusionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1443 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1exclusion"
+	"danny.vn/hotpot/pkg/storage/ent/s1/internal"
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryS1ExclusionUpdate is the builder for updating BronzeHistoryS1Exclusion entities.
+type BronzeHistoryS1ExclusionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryS1ExclusionMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryS1ExclusionUpdate builder.
+func (_u *BronzeHistoryS1ExclusionUpdate) Where(ps ...predicate.BronzeHistoryS1Exclusion) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetValidTo(v time.Time) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) ClearValidTo() *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetCollectedAt(v time.Time) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetResourceID(v string) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableResourceID(v *string) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetScopeLevel sets the "scope_level" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetScopeLevel(v string) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetScopeLevel(v)
+	return _u
+}
+
+// SetNillableScopeLevel sets the "scope_level" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableScopeLevel(v *string) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetScopeLevel(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetAccountID(v string) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableAccountID(v *string) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) ClearAccountID() *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetSiteID(v string) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableSiteID(v *string) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) ClearSiteID() *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetGroupID(v string) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableGroupID(v *string) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) ClearGroupID() *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetScopeName sets the "scope_name" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetScopeName(v string) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetScopeName(v)
+	return _u
+}
+
+// SetNillableScopeName sets the "scope_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableScopeName(v *string) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetScopeName(*v)
+	}
+	return _u
+}
+
+// ClearScopeName clears the value of the "scope_name" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) ClearScopeName() *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.ClearScopeName()
+	return _u
+}
+
+// SetScopePath sets the "scope_path" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetScopePath(v string) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetScopePath(v)
+	return _u
+}
+
+// SetNillableScopePath sets the "scope_path" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableScopePath(v *string) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetScopePath(*v)
+	}
+	return _u
+}
+
+// ClearScopePath clears the value of the "scope_path" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) ClearScopePath() *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.ClearScopePath()
+	return _u
+}
+
+// SetExclusionType sets the "exclusion_type" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetExclusionType(v string) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetExclusionType(v)
+	return _u
+}
+
+// SetNillableExclusionType sets the "exclusion_type" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableExclusionType(v *string) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetExclusionType(*v)
+	}
+	return _u
+}
+
+// SetExclusionValue sets the "exclusion_value" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetExclusionValue(v string) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetExclusionValue(v)
+	return _u
+}
+
+// SetNillableExclusionValue sets the "exclusion_value" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableExclusionValue(v *string) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetExclusionValue(*v)
+	}
+	return _u
+}
+
+// ClearExclusionValue clears the value of the "exclusion_value" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) ClearExclusionValue() *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.ClearExclusionValue()
+	return _u
+}
+
+// SetOsType sets the "os_type" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetOsType(v string) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetOsType(v)
+	return _u
+}
+
+// SetNillableOsType sets the "os_type" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableOsType(v *string) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetOsType(*v)
+	}
+	return _u
+}
+
+// ClearOsType clears the value of the "os_type" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) ClearOsType() *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.ClearOsType()
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetMode(v string) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableMode(v *string) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// ClearMode clears the value of the "mode" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) ClearMode() *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.ClearMode()
+	return _u
+}
+
+// SetPathExclusionType sets the "path_exclusion_type" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetPathExclusionType(v string) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetPathExclusionType(v)
+	return _u
+}
+
+// SetNillablePathExclusionType sets the "path_exclusion_type" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillablePathExclusionType(v *string) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetPathExclusionType(*v)
+	}
+	return _u
+}
+
+// ClearPathExclusionType clears the value of the "path_exclusion_type" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) ClearPathExclusionType() *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.ClearPathExclusionType()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetDescription(v string) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableDescription(v *string) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) ClearDescription() *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetSource(v string) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableSource(v *string) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) ClearSource() *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetUserID(v string) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableUserID(v *string) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) ClearUserID() *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetUserName(v string) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableUserName(v *string) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) ClearUserName() *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.ClearUserName()
+	return _u
+}
+
+// SetApplicationName sets the "application_name" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetApplicationName(v string) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetApplicationName(v)
+	return _u
+}
+
+// SetNillableApplicationName sets the "application_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableApplicationName(v *string) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetApplicationName(*v)
+	}
+	return _u
+}
+
+// ClearApplicationName clears the value of the "application_name" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) ClearApplicationName() *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.ClearApplicationName()
+	return _u
+}
+
+// SetIncludeChildren sets the "include_children" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetIncludeChildren(v bool) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetIncludeChildren(v)
+	return _u
+}
+
+// SetNillableIncludeChildren sets the "include_children" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableIncludeChildren(v *bool) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetIncludeChildren(*v)
+	}
+	return _u
+}
+
+// SetIncludeSubfolders sets the "include_subfolders" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetIncludeSubfolders(v bool) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetIncludeSubfolders(v)
+	return _u
+}
+
+// SetNillableIncludeSubfolders sets the "include_subfolders" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableIncludeSubfolders(v *bool) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetIncludeSubfolders(*v)
+	}
+	return _u
+}
+
+// SetImported sets the "imported" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetImported(v bool) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetImported(v)
+	return _u
+}
+
+// SetNillableImported sets the "imported" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableImported(v *bool) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetImported(*v)
+	}
+	return _u
+}
+
+// SetActionsJSON sets the "actions_json" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetActionsJSON(v json.RawMessage) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetActionsJSON(v)
+	return _u
+}
+
+// AppendActionsJSON appends value to the "actions_json" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) AppendActionsJSON(v json.RawMessage) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.AppendActionsJSON(v)
+	return _u
+}
+
+// ClearActionsJSON clears the value of the "actions_json" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) ClearActionsJSON() *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.ClearActionsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetAPICreatedAt(v time.Time) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) ClearAPICreatedAt() *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetAPIUpdatedAt(v time.Time) *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeHistoryS1ExclusionUpdate {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeHistoryS1ExclusionUpdate) ClearAPIUpdatedAt() *BronzeHistoryS1ExclusionUpdate {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryS1ExclusionMutation object of the builder.
+func (_u *BronzeHistoryS1ExclusionUpdate) Mutation() *BronzeHistoryS1ExclusionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryS1ExclusionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryS1ExclusionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryS1ExclusionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryS1ExclusionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryS1ExclusionUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistorys1exclusion.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Exclusion.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScopeLevel(); ok {
+		if err := bronzehistorys1exclusion.ScopeLevelValidator(v); err != nil {
+			return &ValidationError{Name: "scope_level", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Exclusion.scope_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExclusionType(); ok {
+		if err := bronzehistorys1exclusion.ExclusionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "exclusion_type", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Exclusion.exclusion_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryS1ExclusionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistorys1exclusion.Table, bronzehistorys1exclusion.Columns, sqlgraph.NewFieldSpec(bronzehistorys1exclusion.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScopeLevel(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldScopeLevel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldAccountID, field.TypeString, value)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldAccountID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopeName(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldScopeName, field.TypeString, value)
+	}
+	if _u.mutation.ScopeNameCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldScopeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopePath(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldScopePath, field.TypeString, value)
+	}
+	if _u.mutation.ScopePathCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldScopePath, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExclusionType(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldExclusionType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExclusionValue(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldExclusionValue, field.TypeString, value)
+	}
+	if _u.mutation.ExclusionValueCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldExclusionValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsType(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldOsType, field.TypeString, value)
+	}
+	if _u.mutation.OsTypeCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldOsType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldMode, field.TypeString, value)
+	}
+	if _u.mutation.ModeCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldMode, field.TypeString)
+	}
+	if value, ok := _u.mutation.PathExclusionType(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldPathExclusionType, field.TypeString, value)
+	}
+	if _u.mutation.PathExclusionTypeCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldPathExclusionType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldUserName, field.TypeString, value)
+	}
+	if _u.mutation.UserNameCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldUserName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApplicationName(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldApplicationName, field.TypeString, value)
+	}
+	if _u.mutation.ApplicationNameCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldApplicationName, field.TypeString)
+	}
+	if value, ok := _u.mutation.IncludeChildren(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldIncludeChildren, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IncludeSubfolders(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldIncludeSubfolders, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Imported(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldImported, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ActionsJSON(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldActionsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedActionsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistorys1exclusion.FieldActionsJSON, value)
+		})
+	}
+	if _u.mutation.ActionsJSONCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldActionsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldAPICreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryS1Exclusion
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistorys1exclusion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryS1ExclusionUpdateOne is the builder for updating a single BronzeHistoryS1Exclusion entity.
+type BronzeHistoryS1ExclusionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryS1ExclusionMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetValidTo(v time.Time) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) ClearValidTo() *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetResourceID(v string) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetScopeLevel sets the "scope_level" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetScopeLevel(v string) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetScopeLevel(v)
+	return _u
+}
+
+// SetNillableScopeLevel sets the "scope_level" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableScopeLevel(v *string) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetScopeLevel(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetAccountID(v string) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) ClearAccountID() *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetSiteID(v string) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableSiteID(v *string) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) ClearSiteID() *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetGroupID(v string) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableGroupID(v *string) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) ClearGroupID() *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetScopeName sets the "scope_name" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetScopeName(v string) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetScopeName(v)
+	return _u
+}
+
+// SetNillableScopeName sets the "scope_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableScopeName(v *string) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetScopeName(*v)
+	}
+	return _u
+}
+
+// ClearScopeName clears the value of the "scope_name" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) ClearScopeName() *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.ClearScopeName()
+	return _u
+}
+
+// SetScopePath sets the "scope_path" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetScopePath(v string) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetScopePath(v)
+	return _u
+}
+
+// SetNillableScopePath sets the "scope_path" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableScopePath(v *string) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetScopePath(*v)
+	}
+	return _u
+}
+
+// ClearScopePath clears the value of the "scope_path" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) ClearScopePath() *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.ClearScopePath()
+	return _u
+}
+
+// SetExclusionType sets the "exclusion_type" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetExclusionType(v string) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetExclusionType(v)
+	return _u
+}
+
+// SetNillableExclusionType sets the "exclusion_type" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableExclusionType(v *string) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetExclusionType(*v)
+	}
+	return _u
+}
+
+// SetExclusionValue sets the "exclusion_value" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetExclusionValue(v string) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetExclusionValue(v)
+	return _u
+}
+
+// SetNillableExclusionValue sets the "exclusion_value" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableExclusionValue(v *string) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetExclusionValue(*v)
+	}
+	return _u
+}
+
+// ClearExclusionValue clears the value of the "exclusion_value" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) ClearExclusionValue() *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.ClearExclusionValue()
+	return _u
+}
+
+// SetOsType sets the "os_type" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetOsType(v string) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetOsType(v)
+	return _u
+}
+
+// SetNillableOsType sets the "os_type" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableOsType(v *string) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetOsType(*v)
+	}
+	return _u
+}
+
+// ClearOsType clears the value of the "os_type" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) ClearOsType() *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.ClearOsType()
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetMode(v string) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableMode(v *string) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// ClearMode clears the value of the "mode" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) ClearMode() *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.ClearMode()
+	return _u
+}
+
+// SetPathExclusionType sets the "path_exclusion_type" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetPathExclusionType(v string) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetPathExclusionType(v)
+	return _u
+}
+
+// SetNillablePathExclusionType sets the "path_exclusion_type" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillablePathExclusionType(v *string) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetPathExclusionType(*v)
+	}
+	return _u
+}
+
+// ClearPathExclusionType clears the value of the "path_exclusion_type" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) ClearPathExclusionType() *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.ClearPathExclusionType()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetDescription(v string) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableDescription(v *string) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) ClearDescription() *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetSource(v string) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableSource(v *string) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) ClearSource() *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetUserID(v string) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableUserID(v *string) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) ClearUserID() *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetUserName(v string) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableUserName(v *string) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) ClearUserName() *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.ClearUserName()
+	return _u
+}
+
+// SetApplicationName sets the "application_name" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetApplicationName(v string) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetApplicationName(v)
+	return _u
+}
+
+// SetNillableApplicationName sets the "application_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableApplicationName(v *string) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetApplicationName(*v)
+	}
+	return _u
+}
+
+// ClearApplicationName clears the value of the "application_name" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) ClearApplicationName() *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.ClearApplicationName()
+	return _u
+}
+
+// SetIncludeChildren sets the "include_children" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetIncludeChildren(v bool) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetIncludeChildren(v)
+	return _u
+}
+
+// SetNillableIncludeChildren sets the "include_children" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableIncludeChildren(v *bool) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetIncludeChildren(*v)
+	}
+	return _u
+}
+
+// SetIncludeSubfolders sets the "include_subfolders" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetIncludeSubfolders(v bool) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetIncludeSubfolders(v)
+	return _u
+}
+
+// SetNillableIncludeSubfolders sets the "include_subfolders" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableIncludeSubfolders(v *bool) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetIncludeSubfolders(*v)
+	}
+	return _u
+}
+
+// SetImported sets the "imported" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetImported(v bool) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetImported(v)
+	return _u
+}
+
+// SetNillableImported sets the "imported" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableImported(v *bool) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetImported(*v)
+	}
+	return _u
+}
+
+// SetActionsJSON sets the "actions_json" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetActionsJSON(v json.RawMessage) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetActionsJSON(v)
+	return _u
+}
+
+// AppendActionsJSON appends value to the "actions_json" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) AppendActionsJSON(v json.RawMessage) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.AppendActionsJSON(v)
+	return _u
+}
+
+// ClearActionsJSON clears the value of the "actions_json" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) ClearActionsJSON() *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.ClearActionsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetAPICreatedAt(v time.Time) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) ClearAPICreatedAt() *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetAPIUpdatedAt(v time.Time) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SetNillableAPIUpdatedAt(v *time.Time) *BronzeHistoryS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) ClearAPIUpdatedAt() *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryS1ExclusionMutation object of the builder.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) Mutation() *BronzeHistoryS1ExclusionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryS1ExclusionUpdate builder.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) Where(ps ...predicate.BronzeHistoryS1Exclusion) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) Select(field string, fields ...string) *BronzeHistoryS1ExclusionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryS1Exclusion entity.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) Save(ctx context.Context) (*BronzeHistoryS1Exclusion, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) SaveX(ctx context.Context) *BronzeHistoryS1Exclusion {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryS1ExclusionUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistorys1exclusion.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Exclusion.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScopeLevel(); ok {
+		if err := bronzehistorys1exclusion.ScopeLevelValidator(v); err != nil {
+			return &ValidationError{Name: "scope_level", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Exclusion.scope_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExclusionType(); ok {
+		if err := bronzehistorys1exclusion.ExclusionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "exclusion_type", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Exclusion.exclusion_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryS1ExclusionUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryS1Exclusion, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistorys1exclusion.Table, bronzehistorys1exclusion.Columns, sqlgraph.NewFieldSpec(bronzehistorys1exclusion.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`s1: missing "BronzeHistoryS1Exclusion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistorys1exclusion.FieldID)
+		for _, f := range fields {
+			if !bronzehistorys1exclusion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("s1: invalid field %q for query", f)}
+			}
+			if f != bronzehistorys1exclusion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScopeLevel(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldScopeLevel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldAccountID, field.TypeString, value)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldAccountID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopeName(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldScopeName, field.TypeString, value)
+	}
+	if _u.mutation.ScopeNameCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldScopeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopePath(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldScopePath, field.TypeString, value)
+	}
+	if _u.mutation.ScopePathCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldScopePath, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExclusionType(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldExclusionType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExclusionValue(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldExclusionValue, field.TypeString, value)
+	}
+	if _u.mutation.ExclusionValueCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldExclusionValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsType(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldOsType, field.TypeString, value)
+	}
+	if _u.mutation.OsTypeCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldOsType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldMode, field.TypeString, value)
+	}
+	if _u.mutation.ModeCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldMode, field.TypeString)
+	}
+	if value, ok := _u.mutation.PathExclusionType(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldPathExclusionType, field.TypeString, value)
+	}
+	if _u.mutation.PathExclusionTypeCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldPathExclusionType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldUserName, field.TypeString, value)
+	}
+	if _u.mutation.UserNameCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldUserName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApplicationName(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldApplicationName, field.TypeString, value)
+	}
+	if _u.mutation.ApplicationNameCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldApplicationName, field.TypeString)
+	}
+	if value, ok := _u.mutation.IncludeChildren(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldIncludeChildren, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IncludeSubfolders(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldIncludeSubfolders, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Imported(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldImported, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ActionsJSON(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldActionsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedActionsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistorys1exclusion.FieldActionsJSON, value)
+		})
+	}
+	if _u.mutation.ActionsJSONCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldActionsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldAPICreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzehistorys1exclusion.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzehistorys1exclusion.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryS1Exclusion
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryS1Exclusion{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistorys1exclusion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,352 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1policy"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryS1Policy is the model entity for the BronzeHistoryS1Policy schema.
+type BronzeHistoryS1Policy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze policy by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// ScopeLevel holds the value of the "scope_level" field.
+	ScopeLevel string `json:"scope_level,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// SiteID holds the value of the "site_id" field.
+	SiteID string `json:"site_id,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID string `json:"group_id,omitempty"`
+	// ScopeName holds the value of the "scope_name" field.
+	ScopeName string `json:"scope_name,omitempty"`
+	// InheritedFrom holds the value of the "inherited_from" field.
+	InheritedFrom string `json:"inherited_from,omitempty"`
+	// MitigationMode holds the value of the "mitigation_mode" field.
+	MitigationMode string `json:"mitigation_mode,omitempty"`
+	// MitigationModeSuspicious holds the value of the "mitigation_mode_suspicious" field.
+	MitigationModeSuspicious string `json:"mitigation_mode_suspicious,omitempty"`
+	// AutoMitigationAction holds the value of the "auto_mitigation_action" field.
+	AutoMitigationAction string `json:"auto_mitigation_action,omitempty"`
+	// AntiTamperingOn holds the value of the "anti_tampering_on" field.
+	AntiTamperingOn bool `json:"anti_tampering_on,omitempty"`
+	// SnapshotsOn holds the value of the "snapshots_on" field.
+	SnapshotsOn bool `json:"snapshots_on,omitempty"`
+	// AgentLoggingOn holds the value of the "agent_logging_on" field.
+	AgentLoggingOn bool `json:"agent_logging_on,omitempty"`
+	// ScanNewAgents holds the value of the "scan_new_agents" field.
+	ScanNewAgents bool `json:"scan_new_agents,omitempty"`
+	// NetworkQuarantineOn holds the value of the "network_quarantine_on" field.
+	NetworkQuarantineOn bool `json:"network_quarantine_on,omitempty"`
+	// EnginesJSON holds the value of the "engines_json" field.
+	EnginesJSON json.RawMessage `json:"engines_json,omitempty"`
+	// PolicyJSON holds the value of the "policy_json" field.
+	PolicyJSON json.RawMessage `json:"policy_json,omitempty"`
+	// APIUpdatedAt holds the value of the "api_updated_at" field.
+	APIUpdatedAt *time.Time `json:"api_updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryS1Policy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistorys1policy.FieldEnginesJSON, bronzehistorys1policy.FieldPolicyJSON:
+			values[i] = new([]byte)
+		case bronzehistorys1policy.FieldAntiTamperingOn, bronzehistorys1policy.FieldSnapshotsOn, bronzehistorys1policy.FieldAgentLoggingOn, bronzehistorys1policy.FieldScanNewAgents, bronzehistorys1policy.FieldNetworkQuarantineOn:
+			values[i] = new(sql.NullBool)
+		case bronzehistorys1policy.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistorys1policy.FieldResourceID, bronzehistorys1policy.FieldScopeLevel, bronzehistorys1policy.FieldAccountID, bronzehistorys1policy.FieldSiteID, bronzehistorys1policy.FieldGroupID, bronzehistorys1policy.FieldScopeName, bronzehistorys1policy.FieldInheritedFrom, bronzehistorys1policy.FieldMitigationMode, bronzehistorys1policy.FieldMitigationModeSuspicious, bronzehistorys1policy.FieldAutoMitigationAction:
+			values[i] = new(sql.NullString)
+		case bronzehistorys1policy.FieldValidFrom, bronzehistorys1policy.FieldValidTo, bronzehistorys1policy.FieldCollectedAt, bronzehistorys1policy.FieldFirstCollectedAt, bronzehistorys1policy.FieldAPIUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryS1Policy fields.
+func (_m *BronzeHistoryS1Policy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistorys1policy.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistorys1policy.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistorys1policy.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistorys1policy.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistorys1policy.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistorys1policy.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistorys1policy.FieldScopeLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope_level", values[i])
+			} else if value.Valid {
+				_m.ScopeLevel = value.String
+			}
+		case bronzehistorys1policy.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzehistorys1policy.FieldSiteID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field site_id", values[i])
+			} else if value.Valid {
+				_m.SiteID = value.String
+			}
+		case bronzehistorys1policy.FieldGroupID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				_m.GroupID = value.String
+			}
+		case bronzehistorys1policy.FieldScopeName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope_name", values[i])
+			} else if value.Valid {
+				_m.ScopeName = value.String
+			}
+		case bronzehistorys1policy.FieldInheritedFrom:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field inherited_from", values[i])
+			} else if value.Valid {
+				_m.InheritedFrom = value.String
+			}
+		case bronzehistorys1policy.FieldMitigationMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mitigation_mode", values[i])
+			} else if value.Valid {
+				_m.MitigationMode = value.String
+			}
+		case bronzehistorys1policy.FieldMitigationModeSuspicious:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mitigation_mode_suspicious", values[i])
+			} else if value.Valid {
+				_m.MitigationModeSuspicious = value.String
+			}
+		case bronzehistorys1policy.FieldAutoMitigationAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_mitigation_action", values[i])
+			} else if value.Valid {
+				_m.AutoMitigationAction = value.String
+			}
+		case bronzehistorys1policy.FieldAntiTamperingOn:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field anti_tampering_on", values[i])
+			} else if value.Valid {
+				_m.AntiTamperingOn = value.Bool
+			}
+		case bronzehistorys1policy.FieldSnapshotsOn:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshots_on", values[i])
+			} else if value.Valid {
+				_m.SnapshotsOn = value.Bool
+			}
+		case bronzehistorys1policy.FieldAgentLoggingOn:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_logging_on", values[i])
+			} else if value.Valid {
+				_m.AgentLoggingOn = value.Bool
+			}
+		case bronzehistorys1policy.FieldScanNewAgents:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field scan_new_agents", values[i])
+			} else if value.Valid {
+				_m.ScanNewAgents = value.Bool
+			}
+		case bronzehistorys1policy.FieldNetworkQuarantineOn:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field network_quarantine_on", values[i])
+			} else if value.Valid {
+				_m.NetworkQuarantineOn = value.Bool
+			}
+		case bronzehistorys1policy.FieldEnginesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field engines_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EnginesJSON); err != nil {
+					return fmt.Errorf("unmarshal field engines_json: %w", err)
+				}
+			}
+		case bronzehistorys1policy.FieldPolicyJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PolicyJSON); err != nil {
+					return fmt.Errorf("unmarshal field policy_json: %w", err)
+				}
+			}
+		case bronzehistorys1policy.FieldAPIUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_updated_at", values[i])
+			} else if value.Valid {
+				_m.APIUpdatedAt = new(time.Time)
+				*_m.APIUpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryS1Policy.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryS1Policy) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryS1Policy.
+// Note that you need to call BronzeHistoryS1Policy.Unwrap() before calling this method if this BronzeHistoryS1Policy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryS1Policy) Update() *BronzeHistoryS1PolicyUpdateOne {
+	return NewBronzeHistoryS1PolicyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryS1Policy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryS1Policy) Unwrap() *BronzeHistoryS1Policy {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("s1: BronzeHistoryS1Policy is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryS1Policy) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryS1Policy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("scope_level=")
+	builder.WriteString(_m.ScopeLevel)
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("site_id=")
+	builder.WriteString(_m.SiteID)
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(_m.GroupID)
+	builder.WriteString(", ")
+	builder.WriteString("scope_name=")
+	builder.WriteString(_m.ScopeName)
+	builder.WriteString(", ")
+	builder.WriteString("inherited_from=")
+	builder.WriteString(_m.InheritedFrom)
+	builder.WriteString(", ")
+	builder.WriteString("mitigation_mode=")
+	builder.WriteString(_m.MitigationMode)
+	builder.WriteString(", ")
+	builder.WriteString("mitigation_mode_suspicious=")
+	builder.WriteString(_m.MitigationModeSuspicious)
+	builder.WriteString(", ")
+	builder.WriteString("auto_mitigation_action=")
+	builder.WriteString(_m.AutoMitigationAction)
+	builder.WriteString(", ")
+	builder.WriteString("anti_tampering_on=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AntiTamperingOn))
+	builder.WriteString(", ")
+	builder.WriteString("snapshots_on=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SnapshotsOn))
+	builder.WriteString(", ")
+	builder.WriteString("agent_logging_on=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AgentLoggingOn))
+	builder.WriteString(", ")
+	builder.WriteString("scan_new_agents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScanNewAgents))
+	builder.WriteString(", ")
+	builder.WriteString("network_quarantine_on=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NetworkQuarantineOn))
+	builder.WriteString(", ")
+	builder.WriteString("engines_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnginesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("policy_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PolicyJSON))
+	builder.WriteString(", ")
+	if v := _m.APIUpdatedAt; v != nil {
+		builder.WriteString("api_updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryS1Policies is a parsable slice of BronzeHistoryS1Policy.
+type BronzeHistoryS1Policies []*BronzeHistoryS1Policy
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1policy"
+	"danny.vn/hotpot/pkg/storage/ent/s1/internal"
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryS1PolicyDelete is the builder for deleting a BronzeHistoryS1Policy entity.
+type BronzeHistoryS1PolicyDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryS1PolicyMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryS1PolicyDelete builder.
+func (_d *BronzeHistoryS1PolicyDelete) Where(ps ...predicate.BronzeHistoryS1Policy) *BronzeHistoryS1PolicyDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeHistoryS1PolicyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryS1PolicyDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeHistoryS1PolicyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzehistorys1policy.Table, sqlgraph.NewFieldSpec(bronzehistorys1policy.FieldID, field.TypeUint))
+	_spec.Node.Schema = _d.schemaConfig.BronzeHistoryS1Policy
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeHistoryS1PolicyDeleteOne is the builder for deleting a single BronzeHistoryS1Policy entity.
+type BronzeHistoryS1PolicyDeleteOne struct {
+	_d *BronzeHistoryS1PolicyDelete
+}
+
+// Where appends a list predicates to the BronzeHistoryS1PolicyDelete builder.
+func (_d *BronzeHistoryS1PolicyDeleteOne) Where(ps ...predicate.BronzeHistoryS1Policy) *BronzeHistoryS1PolicyDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeHistoryS1PolicyDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzehistorys1policy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryS1PolicyDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
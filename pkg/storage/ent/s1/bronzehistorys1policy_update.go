@@ -0,0 +1,1161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1policy"
+	"danny.vn/hotpot/pkg/storage/ent/s1/internal"
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryS1PolicyUpdate is the builder for updating BronzeHistoryS1Policy entities.
+type BronzeHistoryS1PolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryS1PolicyMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryS1PolicyUpdate builder.
+func (_u *BronzeHistoryS1PolicyUpdate) Where(ps ...predicate.BronzeHistoryS1Policy) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetValidTo(v time.Time) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryS1PolicyUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryS1PolicyUpdate) ClearValidTo() *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetCollectedAt(v time.Time) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryS1PolicyUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryS1PolicyUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetResourceID(v string) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNillableResourceID(v *string) *BronzeHistoryS1PolicyUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetScopeLevel sets the "scope_level" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetScopeLevel(v string) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetScopeLevel(v)
+	return _u
+}
+
+// SetNillableScopeLevel sets the "scope_level" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNillableScopeLevel(v *string) *BronzeHistoryS1PolicyUpdate {
+	if v != nil {
+		_u.SetScopeLevel(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetAccountID(v string) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNillableAccountID(v *string) *BronzeHistoryS1PolicyUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *BronzeHistoryS1PolicyUpdate) ClearAccountID() *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetSiteID(v string) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNillableSiteID(v *string) *BronzeHistoryS1PolicyUpdate {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeHistoryS1PolicyUpdate) ClearSiteID() *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetGroupID(v string) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNillableGroupID(v *string) *BronzeHistoryS1PolicyUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeHistoryS1PolicyUpdate) ClearGroupID() *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetScopeName sets the "scope_name" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetScopeName(v string) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetScopeName(v)
+	return _u
+}
+
+// SetNillableScopeName sets the "scope_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNillableScopeName(v *string) *BronzeHistoryS1PolicyUpdate {
+	if v != nil {
+		_u.SetScopeName(*v)
+	}
+	return _u
+}
+
+// ClearScopeName clears the value of the "scope_name" field.
+func (_u *BronzeHistoryS1PolicyUpdate) ClearScopeName() *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.ClearScopeName()
+	return _u
+}
+
+// SetInheritedFrom sets the "inherited_from" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetInheritedFrom(v string) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetInheritedFrom(v)
+	return _u
+}
+
+// SetNillableInheritedFrom sets the "inherited_from" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNillableInheritedFrom(v *string) *BronzeHistoryS1PolicyUpdate {
+	if v != nil {
+		_u.SetInheritedFrom(*v)
+	}
+	return _u
+}
+
+// ClearInheritedFrom clears the value of the "inherited_from" field.
+func (_u *BronzeHistoryS1PolicyUpdate) ClearInheritedFrom() *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.ClearInheritedFrom()
+	return _u
+}
+
+// SetMitigationMode sets the "mitigation_mode" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetMitigationMode(v string) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetMitigationMode(v)
+	return _u
+}
+
+// SetNillableMitigationMode sets the "mitigation_mode" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNillableMitigationMode(v *string) *BronzeHistoryS1PolicyUpdate {
+	if v != nil {
+		_u.SetMitigationMode(*v)
+	}
+	return _u
+}
+
+// ClearMitigationMode clears the value of the "mitigation_mode" field.
+func (_u *BronzeHistoryS1PolicyUpdate) ClearMitigationMode() *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.ClearMitigationMode()
+	return _u
+}
+
+// SetMitigationModeSuspicious sets the "mitigation_mode_suspicious" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetMitigationModeSuspicious(v string) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetMitigationModeSuspicious(v)
+	return _u
+}
+
+// SetNillableMitigationModeSuspicious sets the "mitigation_mode_suspicious" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNillableMitigationModeSuspicious(v *string) *BronzeHistoryS1PolicyUpdate {
+	if v != nil {
+		_u.SetMitigationModeSuspicious(*v)
+	}
+	return _u
+}
+
+// ClearMitigationModeSuspicious clears the value of the "mitigation_mode_suspicious" field.
+func (_u *BronzeHistoryS1PolicyUpdate) ClearMitigationModeSuspicious() *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.ClearMitigationModeSuspicious()
+	return _u
+}
+
+// SetAutoMitigationAction sets the "auto_mitigation_action" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetAutoMitigationAction(v string) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetAutoMitigationAction(v)
+	return _u
+}
+
+// SetNillableAutoMitigationAction sets the "auto_mitigation_action" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNillableAutoMitigationAction(v *string) *BronzeHistoryS1PolicyUpdate {
+	if v != nil {
+		_u.SetAutoMitigationAction(*v)
+	}
+	return _u
+}
+
+// ClearAutoMitigationAction clears the value of the "auto_mitigation_action" field.
+func (_u *BronzeHistoryS1PolicyUpdate) ClearAutoMitigationAction() *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.ClearAutoMitigationAction()
+	return _u
+}
+
+// SetAntiTamperingOn sets the "anti_tampering_on" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetAntiTamperingOn(v bool) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetAntiTamperingOn(v)
+	return _u
+}
+
+// SetNillableAntiTamperingOn sets the "anti_tampering_on" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNillableAntiTamperingOn(v *bool) *BronzeHistoryS1PolicyUpdate {
+	if v != nil {
+		_u.SetAntiTamperingOn(*v)
+	}
+	return _u
+}
+
+// SetSnapshotsOn sets the "snapshots_on" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetSnapshotsOn(v bool) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetSnapshotsOn(v)
+	return _u
+}
+
+// SetNillableSnapshotsOn sets the "snapshots_on" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNillableSnapshotsOn(v *bool) *BronzeHistoryS1PolicyUpdate {
+	if v != nil {
+		_u.SetSnapshotsOn(*v)
+	}
+	return _u
+}
+
+// SetAgentLoggingOn sets the "agent_logging_on" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetAgentLoggingOn(v bool) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetAgentLoggingOn(v)
+	return _u
+}
+
+// SetNillableAgentLoggingOn sets the "agent_logging_on" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNillableAgentLoggingOn(v *bool) *BronzeHistoryS1PolicyUpdate {
+	if v != nil {
+		_u.SetAgentLoggingOn(*v)
+	}
+	return _u
+}
+
+// SetScanNewAgents sets the "scan_new_agents" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetScanNewAgents(v bool) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetScanNewAgents(v)
+	return _u
+}
+
+// SetNillableScanNewAgents sets the "scan_new_agents" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNillableScanNewAgents(v *bool) *BronzeHistoryS1PolicyUpdate {
+	if v != nil {
+		_u.SetScanNewAgents(*v)
+	}
+	return _u
+}
+
+// SetNetworkQuarantineOn sets the "network_quarantine_on" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNetworkQuarantineOn(v bool) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetNetworkQuarantineOn(v)
+	return _u
+}
+
+// SetNillableNetworkQuarantineOn sets the "network_quarantine_on" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNillableNetworkQuarantineOn(v *bool) *BronzeHistoryS1PolicyUpdate {
+	if v != nil {
+		_u.SetNetworkQuarantineOn(*v)
+	}
+	return _u
+}
+
+// SetEnginesJSON sets the "engines_json" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetEnginesJSON(v json.RawMessage) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetEnginesJSON(v)
+	return _u
+}
+
+// AppendEnginesJSON appends value to the "engines_json" field.
+func (_u *BronzeHistoryS1PolicyUpdate) AppendEnginesJSON(v json.RawMessage) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.AppendEnginesJSON(v)
+	return _u
+}
+
+// ClearEnginesJSON clears the value of the "engines_json" field.
+func (_u *BronzeHistoryS1PolicyUpdate) ClearEnginesJSON() *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.ClearEnginesJSON()
+	return _u
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetPolicyJSON(v json.RawMessage) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetPolicyJSON(v)
+	return _u
+}
+
+// AppendPolicyJSON appends value to the "policy_json" field.
+func (_u *BronzeHistoryS1PolicyUpdate) AppendPolicyJSON(v json.RawMessage) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.AppendPolicyJSON(v)
+	return _u
+}
+
+// ClearPolicyJSON clears the value of the "policy_json" field.
+func (_u *BronzeHistoryS1PolicyUpdate) ClearPolicyJSON() *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.ClearPolicyJSON()
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeHistoryS1PolicyUpdate) SetAPIUpdatedAt(v time.Time) *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeHistoryS1PolicyUpdate {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeHistoryS1PolicyUpdate) ClearAPIUpdatedAt() *BronzeHistoryS1PolicyUpdate {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryS1PolicyMutation object of the builder.
+func (_u *BronzeHistoryS1PolicyUpdate) Mutation() *BronzeHistoryS1PolicyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryS1PolicyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryS1PolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryS1PolicyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryS1PolicyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryS1PolicyUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistorys1policy.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Policy.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScopeLevel(); ok {
+		if err := bronzehistorys1policy.ScopeLevelValidator(v); err != nil {
+			return &ValidationError{Name: "scope_level", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Policy.scope_level": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryS1PolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistorys1policy.Table, bronzehistorys1policy.Columns, sqlgraph.NewFieldSpec(bronzehistorys1policy.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScopeLevel(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldScopeLevel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldAccountID, field.TypeString, value)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldAccountID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopeName(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldScopeName, field.TypeString, value)
+	}
+	if _u.mutation.ScopeNameCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldScopeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.InheritedFrom(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldInheritedFrom, field.TypeString, value)
+	}
+	if _u.mutation.InheritedFromCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldInheritedFrom, field.TypeString)
+	}
+	if value, ok := _u.mutation.MitigationMode(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldMitigationMode, field.TypeString, value)
+	}
+	if _u.mutation.MitigationModeCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldMitigationMode, field.TypeString)
+	}
+	if value, ok := _u.mutation.MitigationModeSuspicious(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldMitigationModeSuspicious, field.TypeString, value)
+	}
+	if _u.mutation.MitigationModeSuspiciousCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldMitigationModeSuspicious, field.TypeString)
+	}
+	if value, ok := _u.mutation.AutoMitigationAction(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldAutoMitigationAction, field.TypeString, value)
+	}
+	if _u.mutation.AutoMitigationActionCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldAutoMitigationAction, field.TypeString)
+	}
+	if value, ok := _u.mutation.AntiTamperingOn(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldAntiTamperingOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SnapshotsOn(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldSnapshotsOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AgentLoggingOn(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldAgentLoggingOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ScanNewAgents(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldScanNewAgents, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NetworkQuarantineOn(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldNetworkQuarantineOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EnginesJSON(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldEnginesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEnginesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistorys1policy.FieldEnginesJSON, value)
+		})
+	}
+	if _u.mutation.EnginesJSONCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldEnginesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PolicyJSON(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldPolicyJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolicyJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistorys1policy.FieldPolicyJSON, value)
+		})
+	}
+	if _u.mutation.PolicyJSONCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldPolicyJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryS1Policy
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistorys1policy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryS1PolicyUpdateOne is the builder for updating a single BronzeHistoryS1Policy entity.
+type BronzeHistoryS1PolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryS1PolicyMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetValidTo(v time.Time) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) ClearValidTo() *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetResourceID(v string) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetScopeLevel sets the "scope_level" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetScopeLevel(v string) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetScopeLevel(v)
+	return _u
+}
+
+// SetNillableScopeLevel sets the "scope_level" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNillableScopeLevel(v *string) *BronzeHistoryS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetScopeLevel(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetAccountID(v string) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) ClearAccountID() *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetSiteID(v string) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNillableSiteID(v *string) *BronzeHistoryS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) ClearSiteID() *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetGroupID(v string) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNillableGroupID(v *string) *BronzeHistoryS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) ClearGroupID() *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetScopeName sets the "scope_name" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetScopeName(v string) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetScopeName(v)
+	return _u
+}
+
+// SetNillableScopeName sets the "scope_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNillableScopeName(v *string) *BronzeHistoryS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetScopeName(*v)
+	}
+	return _u
+}
+
+// ClearScopeName clears the value of the "scope_name" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) ClearScopeName() *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.ClearScopeName()
+	return _u
+}
+
+// SetInheritedFrom sets the "inherited_from" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetInheritedFrom(v string) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetInheritedFrom(v)
+	return _u
+}
+
+// SetNillableInheritedFrom sets the "inherited_from" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNillableInheritedFrom(v *string) *BronzeHistoryS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetInheritedFrom(*v)
+	}
+	return _u
+}
+
+// ClearInheritedFrom clears the value of the "inherited_from" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) ClearInheritedFrom() *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.ClearInheritedFrom()
+	return _u
+}
+
+// SetMitigationMode sets the "mitigation_mode" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetMitigationMode(v string) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetMitigationMode(v)
+	return _u
+}
+
+// SetNillableMitigationMode sets the "mitigation_mode" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNillableMitigationMode(v *string) *BronzeHistoryS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetMitigationMode(*v)
+	}
+	return _u
+}
+
+// ClearMitigationMode clears the value of the "mitigation_mode" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) ClearMitigationMode() *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.ClearMitigationMode()
+	return _u
+}
+
+// SetMitigationModeSuspicious sets the "mitigation_mode_suspicious" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetMitigationModeSuspicious(v string) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetMitigationModeSuspicious(v)
+	return _u
+}
+
+// SetNillableMitigationModeSuspicious sets the "mitigation_mode_suspicious" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNillableMitigationModeSuspicious(v *string) *BronzeHistoryS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetMitigationModeSuspicious(*v)
+	}
+	return _u
+}
+
+// ClearMitigationModeSuspicious clears the value of the "mitigation_mode_suspicious" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) ClearMitigationModeSuspicious() *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.ClearMitigationModeSuspicious()
+	return _u
+}
+
+// SetAutoMitigationAction sets the "auto_mitigation_action" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetAutoMitigationAction(v string) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetAutoMitigationAction(v)
+	return _u
+}
+
+// SetNillableAutoMitigationAction sets the "auto_mitigation_action" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNillableAutoMitigationAction(v *string) *BronzeHistoryS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetAutoMitigationAction(*v)
+	}
+	return _u
+}
+
+// ClearAutoMitigationAction clears the value of the "auto_mitigation_action" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) ClearAutoMitigationAction() *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.ClearAutoMitigationAction()
+	return _u
+}
+
+// SetAntiTamperingOn sets the "anti_tampering_on" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetAntiTamperingOn(v bool) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetAntiTamperingOn(v)
+	return _u
+}
+
+// SetNillableAntiTamperingOn sets the "anti_tampering_on" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNillableAntiTamperingOn(v *bool) *BronzeHistoryS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetAntiTamperingOn(*v)
+	}
+	return _u
+}
+
+// SetSnapshotsOn sets the "snapshots_on" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetSnapshotsOn(v bool) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetSnapshotsOn(v)
+	return _u
+}
+
+// SetNillableSnapshotsOn sets the "snapshots_on" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNillableSnapshotsOn(v *bool) *BronzeHistoryS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetSnapshotsOn(*v)
+	}
+	return _u
+}
+
+// SetAgentLoggingOn sets the "agent_logging_on" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetAgentLoggingOn(v bool) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetAgentLoggingOn(v)
+	return _u
+}
+
+// SetNillableAgentLoggingOn sets the "agent_logging_on" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNillableAgentLoggingOn(v *bool) *BronzeHistoryS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetAgentLoggingOn(*v)
+	}
+	return _u
+}
+
+// SetScanNewAgents sets the "scan_new_agents" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetScanNewAgents(v bool) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetScanNewAgents(v)
+	return _u
+}
+
+// SetNillableScanNewAgents sets the "scan_new_agents" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNillableScanNewAgents(v *bool) *BronzeHistoryS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetScanNewAgents(*v)
+	}
+	return _u
+}
+
+// SetNetworkQuarantineOn sets the "network_quarantine_on" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNetworkQuarantineOn(v bool) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetNetworkQuarantineOn(v)
+	return _u
+}
+
+// SetNillableNetworkQuarantineOn sets the "network_quarantine_on" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNillableNetworkQuarantineOn(v *bool) *BronzeHistoryS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetNetworkQuarantineOn(*v)
+	}
+	return _u
+}
+
+// SetEnginesJSON sets the "engines_json" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetEnginesJSON(v json.RawMessage) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetEnginesJSON(v)
+	return _u
+}
+
+// AppendEnginesJSON appends value to the "engines_json" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) AppendEnginesJSON(v json.RawMessage) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.AppendEnginesJSON(v)
+	return _u
+}
+
+// ClearEnginesJSON clears the value of the "engines_json" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) ClearEnginesJSON() *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.ClearEnginesJSON()
+	return _u
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetPolicyJSON(v json.RawMessage) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetPolicyJSON(v)
+	return _u
+}
+
+// AppendPolicyJSON appends value to the "policy_json" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) AppendPolicyJSON(v json.RawMessage) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.AppendPolicyJSON(v)
+	return _u
+}
+
+// ClearPolicyJSON clears the value of the "policy_json" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) ClearPolicyJSON() *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.ClearPolicyJSON()
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetAPIUpdatedAt(v time.Time) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SetNillableAPIUpdatedAt(v *time.Time) *BronzeHistoryS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeHistoryS1PolicyUpdateOne) ClearAPIUpdatedAt() *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryS1PolicyMutation object of the builder.
+func (_u *BronzeHistoryS1PolicyUpdateOne) Mutation() *BronzeHistoryS1PolicyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryS1PolicyUpdate builder.
+func (_u *BronzeHistoryS1PolicyUpdateOne) Where(ps ...predicate.BronzeHistoryS1Policy) *BronzeHistoryS1PolicyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryS1PolicyUpdateOne) Select(field string, fields ...string) *BronzeHistoryS1PolicyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryS1Policy entity.
+func (_u *BronzeHistoryS1PolicyUpdateOne) Save(ctx context.Context) (*BronzeHistoryS1Policy, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryS1PolicyUpdateOne) SaveX(ctx context.Context) *BronzeHistoryS1Policy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryS1PolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryS1PolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryS1PolicyUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistorys1policy.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Policy.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScopeLevel(); ok {
+		if err := bronzehistorys1policy.ScopeLevelValidator(v); err != nil {
+			return &ValidationError{Name: "scope_level", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Policy.scope_level": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryS1PolicyUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryS1Policy, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistorys1policy.Table, bronzehistorys1policy.Columns, sqlgraph.NewFieldSpec(bronzehistorys1policy.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`s1: missing "BronzeHistoryS1Policy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistorys1policy.FieldID)
+		for _, f := range fields {
+			if !bronzehistorys1policy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("s1: invalid field %q for query", f)}
+			}
+			if f != bronzehistorys1policy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScopeLevel(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldScopeLevel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldAccountID, field.TypeString, value)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldAccountID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopeName(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldScopeName, field.TypeString, value)
+	}
+	if _u.mutation.ScopeNameCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldScopeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.InheritedFrom(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldInheritedFrom, field.TypeString, value)
+	}
+	if _u.mutation.InheritedFromCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldInheritedFrom, field.TypeString)
+	}
+	if value, ok := _u.mutation.MitigationMode(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldMitigationMode, field.TypeString, value)
+	}
+	if _u.mutation.MitigationModeCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldMitigationMode, field.TypeString)
+	}
+	if value, ok := _u.mutation.MitigationModeSuspicious(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldMitigationModeSuspicious, field.TypeString, value)
+	}
+	if _u.mutation.MitigationModeSuspiciousCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldMitigationModeSuspicious, field.TypeString)
+	}
+	if value, ok := _u.mutation.AutoMitigationAction(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldAutoMitigationAction, field.TypeString, value)
+	}
+	if _u.mutation.AutoMitigationActionCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldAutoMitigationAction, field.TypeString)
+	}
+	if value, ok := _u.mutation.AntiTamperingOn(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldAntiTamperingOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SnapshotsOn(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldSnapshotsOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AgentLoggingOn(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldAgentLoggingOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ScanNewAgents(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldScanNewAgents, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NetworkQuarantineOn(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldNetworkQuarantineOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EnginesJSON(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldEnginesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEnginesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistorys1policy.FieldEnginesJSON, value)
+		})
+	}
+	if _u.mutation.EnginesJSONCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldEnginesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PolicyJSON(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldPolicyJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolicyJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzehistorys1policy.FieldPolicyJSON, value)
+		})
+	}
+	if _u.mutation.PolicyJSONCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldPolicyJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzehistorys1policy.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzehistorys1policy.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryS1Policy
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryS1Policy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistorys1policy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
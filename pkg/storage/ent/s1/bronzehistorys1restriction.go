@@ -0,0 +1,337 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1restriction"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryS1Restriction is the model entity for the BronzeHistoryS1Restriction schema.
+type BronzeHistoryS1Restriction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze restriction by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// ScopeLevel holds the value of the "scope_level" field.
+	ScopeLevel string `json:"scope_level,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// SiteID holds the value of the "site_id" field.
+	SiteID string `json:"site_id,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID string `json:"group_id,omitempty"`
+	// ScopeName holds the value of the "scope_name" field.
+	ScopeName string `json:"scope_name,omitempty"`
+	// ScopePath holds the value of the "scope_path" field.
+	ScopePath string `json:"scope_path,omitempty"`
+	// RestrictionType holds the value of the "restriction_type" field.
+	RestrictionType string `json:"restriction_type,omitempty"`
+	// RestrictionValue holds the value of the "restriction_value" field.
+	RestrictionValue string `json:"restriction_value,omitempty"`
+	// OsType holds the value of the "os_type" field.
+	OsType string `json:"os_type,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// UserName holds the value of the "user_name" field.
+	UserName string `json:"user_name,omitempty"`
+	// Imported holds the value of the "imported" field.
+	Imported bool `json:"imported,omitempty"`
+	// APICreatedAt holds the value of the "api_created_at" field.
+	APICreatedAt *time.Time `json:"api_created_at,omitempty"`
+	// APIUpdatedAt holds the value of the "api_updated_at" field.
+	APIUpdatedAt *time.Time `json:"api_updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryS1Restriction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistorys1restriction.FieldImported:
+			values[i] = new(sql.NullBool)
+		case bronzehistorys1restriction.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistorys1restriction.FieldResourceID, bronzehistorys1restriction.FieldScopeLevel, bronzehistorys1restriction.FieldAccountID, bronzehistorys1restriction.FieldSiteID, bronzehistorys1restriction.FieldGroupID, bronzehistorys1restriction.FieldScopeName, bronzehistorys1restriction.FieldScopePath, bronzehistorys1restriction.FieldRestrictionType, bronzehistorys1restriction.FieldRestrictionValue, bronzehistorys1restriction.FieldOsType, bronzehistorys1restriction.FieldDescription, bronzehistorys1restriction.FieldSource, bronzehistorys1restriction.FieldUserID, bronzehistorys1restriction.FieldUserName:
+			values[i] = new(sql.NullString)
+		case bronzehistorys1restriction.FieldValidFrom, bronzehistorys1restriction.FieldValidTo, bronzehistorys1restriction.FieldCollectedAt, bronzehistorys1restriction.FieldFirstCollectedAt, bronzehistorys1restriction.FieldAPICreatedAt, bronzehistorys1restriction.FieldAPIUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryS1Restriction fields.
+func (_m *BronzeHistoryS1Restriction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistorys1restriction.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistorys1restriction.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistorys1restriction.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistorys1restriction.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistorys1restriction.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistorys1restriction.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistorys1restriction.FieldScopeLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope_level", values[i])
+			} else if value.Valid {
+				_m.ScopeLevel = value.String
+			}
+		case bronzehistorys1restriction.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzehistorys1restriction.FieldSiteID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field site_id", values[i])
+			} else if value.Valid {
+				_m.SiteID = value.String
+			}
+		case bronzehistorys1restriction.FieldGroupID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				_m.GroupID = value.String
+			}
+		case bronzehistorys1restriction.FieldScopeName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope_name", values[i])
+			} else if value.Valid {
+				_m.ScopeName = value.String
+			}
+		case bronzehistorys1restriction.FieldScopePath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope_path", values[i])
+			} else if value.Valid {
+				_m.ScopePath = value.String
+			}
+		case bronzehistorys1restriction.FieldRestrictionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field restriction_type", values[i])
+			} else if value.Valid {
+				_m.RestrictionType = value.String
+			}
+		case bronzehistorys1restriction.FieldRestrictionValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field restriction_value", values[i])
+			} else if value.Valid {
+				_m.RestrictionValue = value.String
+			}
+		case bronzehistorys1restriction.FieldOsType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field os_type", values[i])
+			} else if value.Valid {
+				_m.OsType = value.String
+			}
+		case bronzehistorys1restriction.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case bronzehistorys1restriction.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case bronzehistorys1restriction.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case bronzehistorys1restriction.FieldUserName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_name", values[i])
+			} else if value.Valid {
+				_m.UserName = value.String
+			}
+		case bronzehistorys1restriction.FieldImported:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field imported", values[i])
+			} else if value.Valid {
+				_m.Imported = value.Bool
+			}
+		case bronzehistorys1restriction.FieldAPICreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_created_at", values[i])
+			} else if value.Valid {
+				_m.APICreatedAt = new(time.Time)
+				*_m.APICreatedAt = value.Time
+			}
+		case bronzehistorys1restriction.FieldAPIUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_updated_at", values[i])
+			} else if value.Valid {
+				_m.APIUpdatedAt = new(time.Time)
+				*_m.APIUpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryS1Restriction.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryS1Restriction) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryS1Restriction.
+// Note that you need to call BronzeHistoryS1Restriction.Unwrap() before calling this method if this BronzeHistoryS1Restriction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryS1Restriction) Update() *BronzeHistoryS1RestrictionUpdateOne {
+	return NewBronzeHistoryS1RestrictionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryS1Restriction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryS1Restriction) Unwrap() *BronzeHistoryS1Restriction {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("s1: BronzeHistoryS1Restriction is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryS1Restriction) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryS1Restriction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("scope_level=")
+	builder.WriteString(_m.ScopeLevel)
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("site_id=")
+	builder.WriteString(_m.SiteID)
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(_m.GroupID)
+	builder.WriteString(", ")
+	builder.WriteString("scope_name=")
+	builder.WriteString(_m.ScopeName)
+	builder.WriteString(", ")
+	builder.WriteString("scope_path=")
+	builder.WriteString(_m.ScopePath)
+	builder.WriteString(", ")
+	builder.WriteString("restriction_type=")
+	builder.WriteString(_m.RestrictionType)
+	builder.WriteString(", ")
+	builder.WriteString("restriction_value=")
+	builder.WriteString(_m.RestrictionValue)
+	builder.WriteString(", ")
+	builder.WriteString("os_type=")
+	builder.WriteString(_m.OsType)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("user_name=")
+	builder.WriteString(_m.UserName)
+	builder.WriteString(", ")
+	builder.WriteString("imported=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Imported))
+	builder.WriteString(", ")
+	if v := _m.APICreatedAt; v != nil {
+		builder.WriteString("api_created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.APIUpdatedAt; v != nil {
+		builder.WriteString("api_updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryS1Restrictions is a parsable slice of BronzeHistoryS1Restriction.
+type BronzeHistoryS1Restrictions []*BronzeHistoryS1Restriction
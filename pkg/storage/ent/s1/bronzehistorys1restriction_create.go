@@ -0,0 +1,560 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1restriction"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryS1RestrictionCreate is the builder for creating a BronzeHistoryS1Restriction entity.
+type BronzeHistoryS1RestrictionCreate struct {
+	config
+	mutation *BronzeHistoryS1RestrictionMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetValidFrom(v time.Time) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetValidTo(v time.Time) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryS1RestrictionCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryS1RestrictionCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetCollectedAt(v time.Time) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetResourceID(v string) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetScopeLevel sets the "scope_level" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetScopeLevel(v string) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetScopeLevel(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetAccountID(v string) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_c *BronzeHistoryS1RestrictionCreate) SetNillableAccountID(v *string) *BronzeHistoryS1RestrictionCreate {
+	if v != nil {
+		_c.SetAccountID(*v)
+	}
+	return _c
+}
+
+// SetSiteID sets the "site_id" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetSiteID(v string) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetSiteID(v)
+	return _c
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_c *BronzeHistoryS1RestrictionCreate) SetNillableSiteID(v *string) *BronzeHistoryS1RestrictionCreate {
+	if v != nil {
+		_c.SetSiteID(*v)
+	}
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetGroupID(v string) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_c *BronzeHistoryS1RestrictionCreate) SetNillableGroupID(v *string) *BronzeHistoryS1RestrictionCreate {
+	if v != nil {
+		_c.SetGroupID(*v)
+	}
+	return _c
+}
+
+// SetScopeName sets the "scope_name" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetScopeName(v string) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetScopeName(v)
+	return _c
+}
+
+// SetNillableScopeName sets the "scope_name" field if the given value is not nil.
+func (_c *BronzeHistoryS1RestrictionCreate) SetNillableScopeName(v *string) *BronzeHistoryS1RestrictionCreate {
+	if v != nil {
+		_c.SetScopeName(*v)
+	}
+	return _c
+}
+
+// SetScopePath sets the "scope_path" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetScopePath(v string) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetScopePath(v)
+	return _c
+}
+
+// SetNillableScopePath sets the "scope_path" field if the given value is not nil.
+func (_c *BronzeHistoryS1RestrictionCreate) SetNillableScopePath(v *string) *BronzeHistoryS1RestrictionCreate {
+	if v != nil {
+		_c.SetScopePath(*v)
+	}
+	return _c
+}
+
+// SetRestrictionType sets the "restriction_type" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetRestrictionType(v string) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetRestrictionType(v)
+	return _c
+}
+
+// SetRestrictionValue sets the "restriction_value" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetRestrictionValue(v string) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetRestrictionValue(v)
+	return _c
+}
+
+// SetNillableRestrictionValue sets the "restriction_value" field if the given value is not nil.
+func (_c *BronzeHistoryS1RestrictionCreate) SetNillableRestrictionValue(v *string) *BronzeHistoryS1RestrictionCreate {
+	if v != nil {
+		_c.SetRestrictionValue(*v)
+	}
+	return _c
+}
+
+// SetOsType sets the "os_type" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetOsType(v string) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetOsType(v)
+	return _c
+}
+
+// SetNillableOsType sets the "os_type" field if the given value is not nil.
+func (_c *BronzeHistoryS1RestrictionCreate) SetNillableOsType(v *string) *BronzeHistoryS1RestrictionCreate {
+	if v != nil {
+		_c.SetOsType(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetDescription(v string) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BronzeHistoryS1RestrictionCreate) SetNillableDescription(v *string) *BronzeHistoryS1RestrictionCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetSource(v string) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *BronzeHistoryS1RestrictionCreate) SetNillableSource(v *string) *BronzeHistoryS1RestrictionCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetUserID(v string) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *BronzeHistoryS1RestrictionCreate) SetNillableUserID(v *string) *BronzeHistoryS1RestrictionCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetUserName sets the "user_name" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetUserName(v string) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetUserName(v)
+	return _c
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_c *BronzeHistoryS1RestrictionCreate) SetNillableUserName(v *string) *BronzeHistoryS1RestrictionCreate {
+	if v != nil {
+		_c.SetUserName(*v)
+	}
+	return _c
+}
+
+// SetImported sets the "imported" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetImported(v bool) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetImported(v)
+	return _c
+}
+
+// SetNillableImported sets the "imported" field if the given value is not nil.
+func (_c *BronzeHistoryS1RestrictionCreate) SetNillableImported(v *bool) *BronzeHistoryS1RestrictionCreate {
+	if v != nil {
+		_c.SetImported(*v)
+	}
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetAPICreatedAt(v time.Time) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeHistoryS1RestrictionCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryS1RestrictionCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetAPIUpdatedAt(v time.Time) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetAPIUpdatedAt(v)
+	return _c
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_c *BronzeHistoryS1RestrictionCreate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeHistoryS1RestrictionCreate {
+	if v != nil {
+		_c.SetAPIUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryS1RestrictionCreate) SetID(v uint) *BronzeHistoryS1RestrictionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryS1RestrictionMutation object of the builder.
+func (_c *BronzeHistoryS1RestrictionCreate) Mutation() *BronzeHistoryS1RestrictionMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryS1Restriction in the database.
+func (_c *BronzeHistoryS1RestrictionCreate) Save(ctx context.Context) (*BronzeHistoryS1Restriction, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryS1RestrictionCreate) SaveX(ctx context.Context) *BronzeHistoryS1Restriction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryS1RestrictionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryS1RestrictionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeHistoryS1RestrictionCreate) defaults() {
+	if _, ok := _c.mutation.Imported(); !ok {
+		v := bronzehistorys1restriction.DefaultImported
+		_c.mutation.SetImported(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryS1RestrictionCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`s1: missing required field "BronzeHistoryS1Restriction.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`s1: missing required field "BronzeHistoryS1Restriction.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`s1: missing required field "BronzeHistoryS1Restriction.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`s1: missing required field "BronzeHistoryS1Restriction.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistorys1restriction.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Restriction.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ScopeLevel(); !ok {
+		return &ValidationError{Name: "scope_level", err: errors.New(`s1: missing required field "BronzeHistoryS1Restriction.scope_level"`)}
+	}
+	if v, ok := _c.mutation.ScopeLevel(); ok {
+		if err := bronzehistorys1restriction.ScopeLevelValidator(v); err != nil {
+			return &ValidationError{Name: "scope_level", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Restriction.scope_level": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RestrictionType(); !ok {
+		return &ValidationError{Name: "restriction_type", err: errors.New(`s1: missing required field "BronzeHistoryS1Restriction.restriction_type"`)}
+	}
+	if v, ok := _c.mutation.RestrictionType(); ok {
+		if err := bronzehistorys1restriction.RestrictionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "restriction_type", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Restriction.restriction_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Imported(); !ok {
+		return &ValidationError{Name: "imported", err: errors.New(`s1: missing required field "BronzeHistoryS1Restriction.imported"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryS1RestrictionCreate) sqlSave(ctx context.Context) (*BronzeHistoryS1Restriction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryS1RestrictionCreate) createSpec() (*BronzeHistoryS1Restriction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryS1Restriction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistorys1restriction.Table, sqlgraph.NewFieldSpec(bronzehistorys1restriction.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryS1Restriction
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.ScopeLevel(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldScopeLevel, field.TypeString, value)
+		_node.ScopeLevel = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.SiteID(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldSiteID, field.TypeString, value)
+		_node.SiteID = value
+	}
+	if value, ok := _c.mutation.GroupID(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldGroupID, field.TypeString, value)
+		_node.GroupID = value
+	}
+	if value, ok := _c.mutation.ScopeName(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldScopeName, field.TypeString, value)
+		_node.ScopeName = value
+	}
+	if value, ok := _c.mutation.ScopePath(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldScopePath, field.TypeString, value)
+		_node.ScopePath = value
+	}
+	if value, ok := _c.mutation.RestrictionType(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldRestrictionType, field.TypeString, value)
+		_node.RestrictionType = value
+	}
+	if value, ok := _c.mutation.RestrictionValue(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldRestrictionValue, field.TypeString, value)
+		_node.RestrictionValue = value
+	}
+	if value, ok := _c.mutation.OsType(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldOsType, field.TypeString, value)
+		_node.OsType = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.UserName(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldUserName, field.TypeString, value)
+		_node.UserName = value
+	}
+	if value, ok := _c.mutation.Imported(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldImported, field.TypeBool, value)
+		_node.Imported = value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	if value, ok := _c.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldAPIUpdatedAt, field.TypeTime, value)
+		_node.APIUpdatedAt = &value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryS1RestrictionCreateBulk is the builder for creating many BronzeHistoryS1Restriction entities in bulk.
+type BronzeHistoryS1RestrictionCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryS1RestrictionCreate
+}
+
+// Save creates the BronzeHistoryS1Restriction entities in the database.
+func (_c *BronzeHistoryS1RestrictionCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryS1Restriction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryS1Restriction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryS1RestrictionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryS1RestrictionCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryS1Restriction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryS1RestrictionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryS1RestrictionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1restriction"
+	"danny.vn/hotpot/pkg/storage/ent/s1/internal"
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryS1RestrictionDelete is the builder for deleting a BronzeHistoryS1Restriction entity.
+type BronzeHistoryS1RestrictionDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryS1RestrictionMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryS1RestrictionDelete builder.
+func (_d *BronzeHistoryS1RestrictionDelete) Where(ps ...predicate.BronzeHistoryS1Restriction) *BronzeHistoryS1RestrictionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeHistoryS1RestrictionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryS1RestrictionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeHistoryS1RestrictionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzehistorys1restriction.Table, sqlgraph.NewFieldSpec(bronzehistorys1restriction.FieldID, field.TypeUint))
+	_spec.Node.Schema = _d.schemaConfig.BronzeHistoryS1Restriction
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeHistoryS1RestrictionDeleteOne is the builder for deleting a single BronzeHistoryS1Restriction entity.
+type BronzeHistoryS1RestrictionDeleteOne struct {
+	_d *BronzeHistoryS1RestrictionDelete
+}
+
+// Where appends a list predicates to the BronzeHistoryS1RestrictionDelete builder.
+func (_d *BronzeHistoryS1RestrictionDeleteOne) Where(ps ...predicate.BronzeHistoryS1Restriction) *BronzeHistoryS1RestrictionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeHistoryS1RestrictionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzehistorys1restriction.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeHistoryS1RestrictionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
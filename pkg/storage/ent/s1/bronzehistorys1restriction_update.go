@@ -0,0 +1,1159 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1restriction"
+	"danny.vn/hotpot/pkg/storage/ent/s1/internal"
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryS1RestrictionUpdate is the builder for updating BronzeHistoryS1Restriction entities.
+type BronzeHistoryS1RestrictionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeHistoryS1RestrictionMutation
+}
+
+// Where appends a list predicates to the BronzeHistoryS1RestrictionUpdate builder.
+func (_u *BronzeHistoryS1RestrictionUpdate) Where(ps ...predicate.BronzeHistoryS1Restriction) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetValidTo(v time.Time) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableValidTo(v *time.Time) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) ClearValidTo() *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetCollectedAt(v time.Time) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableCollectedAt(v *time.Time) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetFirstCollectedAt(v time.Time) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetResourceID(v string) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableResourceID(v *string) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetScopeLevel sets the "scope_level" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetScopeLevel(v string) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetScopeLevel(v)
+	return _u
+}
+
+// SetNillableScopeLevel sets the "scope_level" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableScopeLevel(v *string) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetScopeLevel(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetAccountID(v string) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableAccountID(v *string) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) ClearAccountID() *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetSiteID(v string) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableSiteID(v *string) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) ClearSiteID() *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetGroupID(v string) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableGroupID(v *string) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) ClearGroupID() *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetScopeName sets the "scope_name" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetScopeName(v string) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetScopeName(v)
+	return _u
+}
+
+// SetNillableScopeName sets the "scope_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableScopeName(v *string) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetScopeName(*v)
+	}
+	return _u
+}
+
+// ClearScopeName clears the value of the "scope_name" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) ClearScopeName() *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.ClearScopeName()
+	return _u
+}
+
+// SetScopePath sets the "scope_path" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetScopePath(v string) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetScopePath(v)
+	return _u
+}
+
+// SetNillableScopePath sets the "scope_path" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableScopePath(v *string) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetScopePath(*v)
+	}
+	return _u
+}
+
+// ClearScopePath clears the value of the "scope_path" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) ClearScopePath() *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.ClearScopePath()
+	return _u
+}
+
+// SetRestrictionType sets the "restriction_type" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetRestrictionType(v string) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetRestrictionType(v)
+	return _u
+}
+
+// SetNillableRestrictionType sets the "restriction_type" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableRestrictionType(v *string) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetRestrictionType(*v)
+	}
+	return _u
+}
+
+// SetRestrictionValue sets the "restriction_value" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetRestrictionValue(v string) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetRestrictionValue(v)
+	return _u
+}
+
+// SetNillableRestrictionValue sets the "restriction_value" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableRestrictionValue(v *string) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetRestrictionValue(*v)
+	}
+	return _u
+}
+
+// ClearRestrictionValue clears the value of the "restriction_value" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) ClearRestrictionValue() *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.ClearRestrictionValue()
+	return _u
+}
+
+// SetOsType sets the "os_type" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetOsType(v string) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetOsType(v)
+	return _u
+}
+
+// SetNillableOsType sets the "os_type" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableOsType(v *string) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetOsType(*v)
+	}
+	return _u
+}
+
+// ClearOsType clears the value of the "os_type" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) ClearOsType() *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.ClearOsType()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetDescription(v string) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableDescription(v *string) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) ClearDescription() *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetSource(v string) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableSource(v *string) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) ClearSource() *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetUserID(v string) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableUserID(v *string) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) ClearUserID() *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetUserName(v string) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableUserName(v *string) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) ClearUserName() *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.ClearUserName()
+	return _u
+}
+
+// SetImported sets the "imported" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetImported(v bool) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetImported(v)
+	return _u
+}
+
+// SetNillableImported sets the "imported" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableImported(v *bool) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetImported(*v)
+	}
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetAPICreatedAt(v time.Time) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) ClearAPICreatedAt() *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetAPIUpdatedAt(v time.Time) *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeHistoryS1RestrictionUpdate {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeHistoryS1RestrictionUpdate) ClearAPIUpdatedAt() *BronzeHistoryS1RestrictionUpdate {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryS1RestrictionMutation object of the builder.
+func (_u *BronzeHistoryS1RestrictionUpdate) Mutation() *BronzeHistoryS1RestrictionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeHistoryS1RestrictionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryS1RestrictionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeHistoryS1RestrictionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryS1RestrictionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryS1RestrictionUpdate) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistorys1restriction.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Restriction.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScopeLevel(); ok {
+		if err := bronzehistorys1restriction.ScopeLevelValidator(v); err != nil {
+			return &ValidationError{Name: "scope_level", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Restriction.scope_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RestrictionType(); ok {
+		if err := bronzehistorys1restriction.RestrictionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "restriction_type", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Restriction.restriction_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryS1RestrictionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistorys1restriction.Table, bronzehistorys1restriction.Columns, sqlgraph.NewFieldSpec(bronzehistorys1restriction.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScopeLevel(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldScopeLevel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldAccountID, field.TypeString, value)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldAccountID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopeName(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldScopeName, field.TypeString, value)
+	}
+	if _u.mutation.ScopeNameCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldScopeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopePath(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldScopePath, field.TypeString, value)
+	}
+	if _u.mutation.ScopePathCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldScopePath, field.TypeString)
+	}
+	if value, ok := _u.mutation.RestrictionType(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldRestrictionType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RestrictionValue(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldRestrictionValue, field.TypeString, value)
+	}
+	if _u.mutation.RestrictionValueCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldRestrictionValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsType(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldOsType, field.TypeString, value)
+	}
+	if _u.mutation.OsTypeCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldOsType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldUserName, field.TypeString, value)
+	}
+	if _u.mutation.UserNameCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldUserName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Imported(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldImported, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldAPICreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryS1Restriction
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistorys1restriction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeHistoryS1RestrictionUpdateOne is the builder for updating a single BronzeHistoryS1Restriction entity.
+type BronzeHistoryS1RestrictionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeHistoryS1RestrictionMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetValidTo(v time.Time) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableValidTo(v *time.Time) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) ClearValidTo() *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetCollectedAt(v time.Time) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetFirstCollectedAt(v time.Time) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetFirstCollectedAt(v)
+	return _u
+}
+
+// SetNillableFirstCollectedAt sets the "first_collected_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableFirstCollectedAt(v *time.Time) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetFirstCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetResourceID(v string) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetResourceID(v)
+	return _u
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableResourceID(v *string) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetResourceID(*v)
+	}
+	return _u
+}
+
+// SetScopeLevel sets the "scope_level" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetScopeLevel(v string) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetScopeLevel(v)
+	return _u
+}
+
+// SetNillableScopeLevel sets the "scope_level" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableScopeLevel(v *string) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetScopeLevel(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetAccountID(v string) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableAccountID(v *string) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) ClearAccountID() *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetSiteID(v string) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableSiteID(v *string) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) ClearSiteID() *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetGroupID(v string) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableGroupID(v *string) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) ClearGroupID() *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetScopeName sets the "scope_name" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetScopeName(v string) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetScopeName(v)
+	return _u
+}
+
+// SetNillableScopeName sets the "scope_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableScopeName(v *string) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetScopeName(*v)
+	}
+	return _u
+}
+
+// ClearScopeName clears the value of the "scope_name" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) ClearScopeName() *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.ClearScopeName()
+	return _u
+}
+
+// SetScopePath sets the "scope_path" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetScopePath(v string) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetScopePath(v)
+	return _u
+}
+
+// SetNillableScopePath sets the "scope_path" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableScopePath(v *string) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetScopePath(*v)
+	}
+	return _u
+}
+
+// ClearScopePath clears the value of the "scope_path" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) ClearScopePath() *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.ClearScopePath()
+	return _u
+}
+
+// SetRestrictionType sets the "restriction_type" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetRestrictionType(v string) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetRestrictionType(v)
+	return _u
+}
+
+// SetNillableRestrictionType sets the "restriction_type" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableRestrictionType(v *string) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetRestrictionType(*v)
+	}
+	return _u
+}
+
+// SetRestrictionValue sets the "restriction_value" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetRestrictionValue(v string) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetRestrictionValue(v)
+	return _u
+}
+
+// SetNillableRestrictionValue sets the "restriction_value" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableRestrictionValue(v *string) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetRestrictionValue(*v)
+	}
+	return _u
+}
+
+// ClearRestrictionValue clears the value of the "restriction_value" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) ClearRestrictionValue() *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.ClearRestrictionValue()
+	return _u
+}
+
+// SetOsType sets the "os_type" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetOsType(v string) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetOsType(v)
+	return _u
+}
+
+// SetNillableOsType sets the "os_type" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableOsType(v *string) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetOsType(*v)
+	}
+	return _u
+}
+
+// ClearOsType clears the value of the "os_type" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) ClearOsType() *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.ClearOsType()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetDescription(v string) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableDescription(v *string) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) ClearDescription() *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetSource(v string) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableSource(v *string) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) ClearSource() *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetUserID(v string) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableUserID(v *string) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) ClearUserID() *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetUserName(v string) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableUserName(v *string) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) ClearUserName() *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.ClearUserName()
+	return _u
+}
+
+// SetImported sets the "imported" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetImported(v bool) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetImported(v)
+	return _u
+}
+
+// SetNillableImported sets the "imported" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableImported(v *bool) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetImported(*v)
+	}
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetAPICreatedAt(v time.Time) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) ClearAPICreatedAt() *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetAPIUpdatedAt(v time.Time) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SetNillableAPIUpdatedAt(v *time.Time) *BronzeHistoryS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) ClearAPIUpdatedAt() *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeHistoryS1RestrictionMutation object of the builder.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) Mutation() *BronzeHistoryS1RestrictionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeHistoryS1RestrictionUpdate builder.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) Where(ps ...predicate.BronzeHistoryS1Restriction) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) Select(field string, fields ...string) *BronzeHistoryS1RestrictionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeHistoryS1Restriction entity.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) Save(ctx context.Context) (*BronzeHistoryS1Restriction, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) SaveX(ctx context.Context) *BronzeHistoryS1Restriction {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeHistoryS1RestrictionUpdateOne) check() error {
+	if v, ok := _u.mutation.ResourceID(); ok {
+		if err := bronzehistorys1restriction.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Restriction.resource_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScopeLevel(); ok {
+		if err := bronzehistorys1restriction.ScopeLevelValidator(v); err != nil {
+			return &ValidationError{Name: "scope_level", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Restriction.scope_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RestrictionType(); ok {
+		if err := bronzehistorys1restriction.RestrictionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "restriction_type", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Restriction.restriction_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeHistoryS1RestrictionUpdateOne) sqlSave(ctx context.Context) (_node *BronzeHistoryS1Restriction, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzehistorys1restriction.Table, bronzehistorys1restriction.Columns, sqlgraph.NewFieldSpec(bronzehistorys1restriction.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`s1: missing "BronzeHistoryS1Restriction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzehistorys1restriction.FieldID)
+		for _, f := range fields {
+			if !bronzehistorys1restriction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("s1: invalid field %q for query", f)}
+			}
+			if f != bronzehistorys1restriction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldFirstCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldResourceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScopeLevel(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldScopeLevel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldAccountID, field.TypeString, value)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldAccountID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopeName(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldScopeName, field.TypeString, value)
+	}
+	if _u.mutation.ScopeNameCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldScopeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopePath(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldScopePath, field.TypeString, value)
+	}
+	if _u.mutation.ScopePathCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldScopePath, field.TypeString)
+	}
+	if value, ok := _u.mutation.RestrictionType(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldRestrictionType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RestrictionValue(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldRestrictionValue, field.TypeString, value)
+	}
+	if _u.mutation.RestrictionValueCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldRestrictionValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsType(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldOsType, field.TypeString, value)
+	}
+	if _u.mutation.OsTypeCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldOsType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldUserName, field.TypeString, value)
+	}
+	if _u.mutation.UserNameCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldUserName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Imported(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldImported, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldAPICreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzehistorys1restriction.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzehistorys1restriction.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeHistoryS1Restriction
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeHistoryS1Restriction{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzehistorys1restriction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
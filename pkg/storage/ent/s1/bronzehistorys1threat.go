@@ -0,0 +1,503 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1threat"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeHistoryS1Threat is the model entity for the BronzeHistoryS1Threat schema.
+type BronzeHistoryS1Threat struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// Start of validity period
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of validity period (null = current)
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Timestamp when this snapshot was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// Timestamp when this asset was first collected
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Link to bronze threat by resource_id
+	ResourceID string `json:"resource_id,omitempty"`
+	// AgentID holds the value of the "agent_id" field.
+	AgentID string `json:"agent_id,omitempty"`
+	// AgentUUID holds the value of the "agent_uuid" field.
+	AgentUUID string `json:"agent_uuid,omitempty"`
+	// AgentComputerName holds the value of the "agent_computer_name" field.
+	AgentComputerName string `json:"agent_computer_name,omitempty"`
+	// AgentOsType holds the value of the "agent_os_type" field.
+	AgentOsType string `json:"agent_os_type,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// SiteID holds the value of the "site_id" field.
+	SiteID string `json:"site_id,omitempty"`
+	// SiteName holds the value of the "site_name" field.
+	SiteName string `json:"site_name,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID string `json:"group_id,omitempty"`
+	// ThreatName holds the value of the "threat_name" field.
+	ThreatName string `json:"threat_name,omitempty"`
+	// Classification holds the value of the "classification" field.
+	Classification string `json:"classification,omitempty"`
+	// ClassificationSource holds the value of the "classification_source" field.
+	ClassificationSource string `json:"classification_source,omitempty"`
+	// ConfidenceLevel holds the value of the "confidence_level" field.
+	ConfidenceLevel string `json:"confidence_level,omitempty"`
+	// MitigationStatus holds the value of the "mitigation_status" field.
+	MitigationStatus string `json:"mitigation_status,omitempty"`
+	// AnalystVerdict holds the value of the "analyst_verdict" field.
+	AnalystVerdict string `json:"analyst_verdict,omitempty"`
+	// IncidentStatus holds the value of the "incident_status" field.
+	IncidentStatus string `json:"incident_status,omitempty"`
+	// InitiatedBy holds the value of the "initiated_by" field.
+	InitiatedBy string `json:"initiated_by,omitempty"`
+	// DetectionType holds the value of the "detection_type" field.
+	DetectionType string `json:"detection_type,omitempty"`
+	// FilePath holds the value of the "file_path" field.
+	FilePath string `json:"file_path,omitempty"`
+	// Sha1 holds the value of the "sha1" field.
+	Sha1 string `json:"sha1,omitempty"`
+	// Sha256 holds the value of the "sha256" field.
+	Sha256 string `json:"sha256,omitempty"`
+	// Md5 holds the value of the "md5" field.
+	Md5 string `json:"md5,omitempty"`
+	// Storyline holds the value of the "storyline" field.
+	Storyline string `json:"storyline,omitempty"`
+	// IsFileless holds the value of the "is_fileless" field.
+	IsFileless bool `json:"is_fileless,omitempty"`
+	// MitigatedPreemptively holds the value of the "mitigated_preemptively" field.
+	MitigatedPreemptively bool `json:"mitigated_preemptively,omitempty"`
+	// IdentifiedAt holds the value of the "identified_at" field.
+	IdentifiedAt *time.Time `json:"identified_at,omitempty"`
+	// APICreatedAt holds the value of the "api_created_at" field.
+	APICreatedAt *time.Time `json:"api_created_at,omitempty"`
+	// APIUpdatedAt holds the value of the "api_updated_at" field.
+	APIUpdatedAt *time.Time `json:"api_updated_at,omitempty"`
+	// EnginesJSON holds the value of the "engines_json" field.
+	EnginesJSON json.RawMessage `json:"engines_json,omitempty"`
+	// IndicatorsJSON holds the value of the "indicators_json" field.
+	IndicatorsJSON json.RawMessage `json:"indicators_json,omitempty"`
+	// MitigationActionsJSON holds the value of the "mitigation_actions_json" field.
+	MitigationActionsJSON json.RawMessage `json:"mitigation_actions_json,omitempty"`
+	selectValues          sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeHistoryS1Threat) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistorys1threat.FieldEnginesJSON, bronzehistorys1threat.FieldIndicatorsJSON, bronzehistorys1threat.FieldMitigationActionsJSON:
+			values[i] = new([]byte)
+		case bronzehistorys1threat.FieldIsFileless, bronzehistorys1threat.FieldMitigatedPreemptively:
+			values[i] = new(sql.NullBool)
+		case bronzehistorys1threat.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzehistorys1threat.FieldResourceID, bronzehistorys1threat.FieldAgentID, bronzehistorys1threat.FieldAgentUUID, bronzehistorys1threat.FieldAgentComputerName, bronzehistorys1threat.FieldAgentOsType, bronzehistorys1threat.FieldAccountID, bronzehistorys1threat.FieldSiteID, bronzehistorys1threat.FieldSiteName, bronzehistorys1threat.FieldGroupID, bronzehistorys1threat.FieldThreatName, bronzehistorys1threat.FieldClassification, bronzehistorys1threat.FieldClassificationSource, bronzehistorys1threat.FieldConfidenceLevel, bronzehistorys1threat.FieldMitigationStatus, bronzehistorys1threat.FieldAnalystVerdict, bronzehistorys1threat.FieldIncidentStatus, bronzehistorys1threat.FieldInitiatedBy, bronzehistorys1threat.FieldDetectionType, bronzehistorys1threat.FieldFilePath, bronzehistorys1threat.FieldSha1, bronzehistorys1threat.FieldSha256, bronzehistorys1threat.FieldMd5, bronzehistorys1threat.FieldStoryline:
+			values[i] = new(sql.NullString)
+		case bronzehistorys1threat.FieldValidFrom, bronzehistorys1threat.FieldValidTo, bronzehistorys1threat.FieldCollectedAt, bronzehistorys1threat.FieldFirstCollectedAt, bronzehistorys1threat.FieldIdentifiedAt, bronzehistorys1threat.FieldAPICreatedAt, bronzehistorys1threat.FieldAPIUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeHistoryS1Threat fields.
+func (_m *BronzeHistoryS1Threat) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzehistorys1threat.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzehistorys1threat.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case bronzehistorys1threat.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case bronzehistorys1threat.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzehistorys1threat.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzehistorys1threat.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				_m.ResourceID = value.String
+			}
+		case bronzehistorys1threat.FieldAgentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_id", values[i])
+			} else if value.Valid {
+				_m.AgentID = value.String
+			}
+		case bronzehistorys1threat.FieldAgentUUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_uuid", values[i])
+			} else if value.Valid {
+				_m.AgentUUID = value.String
+			}
+		case bronzehistorys1threat.FieldAgentComputerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_computer_name", values[i])
+			} else if value.Valid {
+				_m.AgentComputerName = value.String
+			}
+		case bronzehistorys1threat.FieldAgentOsType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_os_type", values[i])
+			} else if value.Valid {
+				_m.AgentOsType = value.String
+			}
+		case bronzehistorys1threat.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzehistorys1threat.FieldSiteID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field site_id", values[i])
+			} else if value.Valid {
+				_m.SiteID = value.String
+			}
+		case bronzehistorys1threat.FieldSiteName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field site_name", values[i])
+			} else if value.Valid {
+				_m.SiteName = value.String
+			}
+		case bronzehistorys1threat.FieldGroupID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				_m.GroupID = value.String
+			}
+		case bronzehistorys1threat.FieldThreatName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field threat_name", values[i])
+			} else if value.Valid {
+				_m.ThreatName = value.String
+			}
+		case bronzehistorys1threat.FieldClassification:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field classification", values[i])
+			} else if value.Valid {
+				_m.Classification = value.String
+			}
+		case bronzehistorys1threat.FieldClassificationSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field classification_source", values[i])
+			} else if value.Valid {
+				_m.ClassificationSource = value.String
+			}
+		case bronzehistorys1threat.FieldConfidenceLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field confidence_level", values[i])
+			} else if value.Valid {
+				_m.ConfidenceLevel = value.String
+			}
+		case bronzehistorys1threat.FieldMitigationStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mitigation_status", values[i])
+			} else if value.Valid {
+				_m.MitigationStatus = value.String
+			}
+		case bronzehistorys1threat.FieldAnalystVerdict:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field analyst_verdict", values[i])
+			} else if value.Valid {
+				_m.AnalystVerdict = value.String
+			}
+		case bronzehistorys1threat.FieldIncidentStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field incident_status", values[i])
+			} else if value.Valid {
+				_m.IncidentStatus = value.String
+			}
+		case bronzehistorys1threat.FieldInitiatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field initiated_by", values[i])
+			} else if value.Valid {
+				_m.InitiatedBy = value.String
+			}
+		case bronzehistorys1threat.FieldDetectionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field detection_type", values[i])
+			} else if value.Valid {
+				_m.DetectionType = value.String
+			}
+		case bronzehistorys1threat.FieldFilePath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_path", values[i])
+			} else if value.Valid {
+				_m.FilePath = value.String
+			}
+		case bronzehistorys1threat.FieldSha1:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sha1", values[i])
+			} else if value.Valid {
+				_m.Sha1 = value.String
+			}
+		case bronzehistorys1threat.FieldSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sha256", values[i])
+			} else if value.Valid {
+				_m.Sha256 = value.String
+			}
+		case bronzehistorys1threat.FieldMd5:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field md5", values[i])
+			} else if value.Valid {
+				_m.Md5 = value.String
+			}
+		case bronzehistorys1threat.FieldStoryline:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storyline", values[i])
+			} else if value.Valid {
+				_m.Storyline = value.String
+			}
+		case bronzehistorys1threat.FieldIsFileless:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_fileless", values[i])
+			} else if value.Valid {
+				_m.IsFileless = value.Bool
+			}
+		case bronzehistorys1threat.FieldMitigatedPreemptively:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field mitigated_preemptively", values[i])
+			} else if value.Valid {
+				_m.MitigatedPreemptively = value.Bool
+			}
+		case bronzehistorys1threat.FieldIdentifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field identified_at", values[i])
+			} else if value.Valid {
+				_m.IdentifiedAt = new(time.Time)
+				*_m.IdentifiedAt = value.Time
+			}
+		case bronzehistorys1threat.FieldAPICreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_created_at", values[i])
+			} else if value.Valid {
+				_m.APICreatedAt = new(time.Time)
+				*_m.APICreatedAt = value.Time
+			}
+		case bronzehistorys1threat.FieldAPIUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_updated_at", values[i])
+			} else if value.Valid {
+				_m.APIUpdatedAt = new(time.Time)
+				*_m.APIUpdatedAt = value.Time
+			}
+		case bronzehistorys1threat.FieldEnginesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field engines_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EnginesJSON); err != nil {
+					return fmt.Errorf("unmarshal field engines_json: %w", err)
+				}
+			}
+		case bronzehistorys1threat.FieldIndicatorsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field indicators_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.IndicatorsJSON); err != nil {
+					return fmt.Errorf("unmarshal field indicators_json: %w", err)
+				}
+			}
+		case bronzehistorys1threat.FieldMitigationActionsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field mitigation_actions_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.MitigationActionsJSON); err != nil {
+					return fmt.Errorf("unmarshal field mitigation_actions_json: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeHistoryS1Threat.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeHistoryS1Threat) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeHistoryS1Threat.
+// Note that you need to call BronzeHistoryS1Threat.Unwrap() before calling this method if this BronzeHistoryS1Threat
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeHistoryS1Threat) Update() *BronzeHistoryS1ThreatUpdateOne {
+	return NewBronzeHistoryS1ThreatClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeHistoryS1Threat entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeHistoryS1Threat) Unwrap() *BronzeHistoryS1Threat {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("s1: BronzeHistoryS1Threat is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeHistoryS1Threat) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeHistoryS1Threat(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(_m.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("agent_id=")
+	builder.WriteString(_m.AgentID)
+	builder.WriteString(", ")
+	builder.WriteString("agent_uuid=")
+	builder.WriteString(_m.AgentUUID)
+	builder.WriteString(", ")
+	builder.WriteString("agent_computer_name=")
+	builder.WriteString(_m.AgentComputerName)
+	builder.WriteString(", ")
+	builder.WriteString("agent_os_type=")
+	builder.WriteString(_m.AgentOsType)
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("site_id=")
+	builder.WriteString(_m.SiteID)
+	builder.WriteString(", ")
+	builder.WriteString("site_name=")
+	builder.WriteString(_m.SiteName)
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(_m.GroupID)
+	builder.WriteString(", ")
+	builder.WriteString("threat_name=")
+	builder.WriteString(_m.ThreatName)
+	builder.WriteString(", ")
+	builder.WriteString("classification=")
+	builder.WriteString(_m.Classification)
+	builder.WriteString(", ")
+	builder.WriteString("classification_source=")
+	builder.WriteString(_m.ClassificationSource)
+	builder.WriteString(", ")
+	builder.WriteString("confidence_level=")
+	builder.WriteString(_m.ConfidenceLevel)
+	builder.WriteString(", ")
+	builder.WriteString("mitigation_status=")
+	builder.WriteString(_m.MitigationStatus)
+	builder.WriteString(", ")
+	builder.WriteString("analyst_verdict=")
+	builder.WriteString(_m.AnalystVerdict)
+	builder.WriteString(", ")
+	builder.WriteString("incident_status=")
+	builder.WriteString(_m.IncidentStatus)
+	builder.WriteString(", ")
+	builder.WriteString("initiated_by=")
+	builder.WriteString(_m.InitiatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("detection_type=")
+	builder.WriteString(_m.DetectionType)
+	builder.WriteString(", ")
+	builder.WriteString("file_path=")
+	builder.WriteString(_m.FilePath)
+	builder.WriteString(", ")
+	builder.WriteString("sha1=")
+	builder.WriteString(_m.Sha1)
+	builder.WriteString(", ")
+	builder.WriteString("sha256=")
+	builder.WriteString(_m.Sha256)
+	builder.WriteString(", ")
+	builder.WriteString("md5=")
+	builder.WriteString(_m.Md5)
+	builder.WriteString(", ")
+	builder.WriteString("storyline=")
+	builder.WriteString(_m.Storyline)
+	builder.WriteString(", ")
+	builder.WriteString("is_fileless=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsFileless))
+	builder.WriteString(", ")
+	builder.WriteString("mitigated_preemptively=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MitigatedPreemptively))
+	builder.WriteString(", ")
+	if v := _m.IdentifiedAt; v != nil {
+		builder.WriteString("identified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.APICreatedAt; v != nil {
+		builder.WriteString("api_created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.APIUpdatedAt; v != nil {
+		builder.WriteString("api_updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("engines_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnginesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("indicators_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IndicatorsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("mitigation_actions_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MitigationActionsJSON))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeHistoryS1Threats is a parsable slice of BronzeHistoryS1Threat.
+type BronzeHistoryS1Threats []*BronzeHistoryS1Threat
@@ -0,0 +1,796 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1threat"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeHistoryS1ThreatCreate is the builder for creating a BronzeHistoryS1Threat entity.
+type BronzeHistoryS1ThreatCreate struct {
+	config
+	mutation *BronzeHistoryS1ThreatMutation
+	hooks    []Hook
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetValidFrom(v time.Time) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetValidTo(v time.Time) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableValidTo(v *time.Time) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetCollectedAt(v time.Time) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetFirstCollectedAt(v time.Time) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetResourceID sets the "resource_id" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetResourceID(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetResourceID(v)
+	return _c
+}
+
+// SetAgentID sets the "agent_id" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetAgentID(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetAgentID(v)
+	return _c
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableAgentID(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetAgentID(*v)
+	}
+	return _c
+}
+
+// SetAgentUUID sets the "agent_uuid" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetAgentUUID(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetAgentUUID(v)
+	return _c
+}
+
+// SetNillableAgentUUID sets the "agent_uuid" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableAgentUUID(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetAgentUUID(*v)
+	}
+	return _c
+}
+
+// SetAgentComputerName sets the "agent_computer_name" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetAgentComputerName(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetAgentComputerName(v)
+	return _c
+}
+
+// SetNillableAgentComputerName sets the "agent_computer_name" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableAgentComputerName(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetAgentComputerName(*v)
+	}
+	return _c
+}
+
+// SetAgentOsType sets the "agent_os_type" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetAgentOsType(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetAgentOsType(v)
+	return _c
+}
+
+// SetNillableAgentOsType sets the "agent_os_type" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableAgentOsType(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetAgentOsType(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetAccountID(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableAccountID(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetAccountID(*v)
+	}
+	return _c
+}
+
+// SetSiteID sets the "site_id" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetSiteID(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetSiteID(v)
+	return _c
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableSiteID(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetSiteID(*v)
+	}
+	return _c
+}
+
+// SetSiteName sets the "site_name" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetSiteName(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetSiteName(v)
+	return _c
+}
+
+// SetNillableSiteName sets the "site_name" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableSiteName(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetSiteName(*v)
+	}
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetGroupID(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableGroupID(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetGroupID(*v)
+	}
+	return _c
+}
+
+// SetThreatName sets the "threat_name" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetThreatName(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetThreatName(v)
+	return _c
+}
+
+// SetNillableThreatName sets the "threat_name" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableThreatName(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetThreatName(*v)
+	}
+	return _c
+}
+
+// SetClassification sets the "classification" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetClassification(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetClassification(v)
+	return _c
+}
+
+// SetNillableClassification sets the "classification" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableClassification(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetClassification(*v)
+	}
+	return _c
+}
+
+// SetClassificationSource sets the "classification_source" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetClassificationSource(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetClassificationSource(v)
+	return _c
+}
+
+// SetNillableClassificationSource sets the "classification_source" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableClassificationSource(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetClassificationSource(*v)
+	}
+	return _c
+}
+
+// SetConfidenceLevel sets the "confidence_level" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetConfidenceLevel(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetConfidenceLevel(v)
+	return _c
+}
+
+// SetNillableConfidenceLevel sets the "confidence_level" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableConfidenceLevel(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetConfidenceLevel(*v)
+	}
+	return _c
+}
+
+// SetMitigationStatus sets the "mitigation_status" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetMitigationStatus(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetMitigationStatus(v)
+	return _c
+}
+
+// SetNillableMitigationStatus sets the "mitigation_status" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableMitigationStatus(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetMitigationStatus(*v)
+	}
+	return _c
+}
+
+// SetAnalystVerdict sets the "analyst_verdict" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetAnalystVerdict(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetAnalystVerdict(v)
+	return _c
+}
+
+// SetNillableAnalystVerdict sets the "analyst_verdict" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableAnalystVerdict(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetAnalystVerdict(*v)
+	}
+	return _c
+}
+
+// SetIncidentStatus sets the "incident_status" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetIncidentStatus(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetIncidentStatus(v)
+	return _c
+}
+
+// SetNillableIncidentStatus sets the "incident_status" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableIncidentStatus(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetIncidentStatus(*v)
+	}
+	return _c
+}
+
+// SetInitiatedBy sets the "initiated_by" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetInitiatedBy(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetInitiatedBy(v)
+	return _c
+}
+
+// SetNillableInitiatedBy sets the "initiated_by" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableInitiatedBy(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetInitiatedBy(*v)
+	}
+	return _c
+}
+
+// SetDetectionType sets the "detection_type" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetDetectionType(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetDetectionType(v)
+	return _c
+}
+
+// SetNillableDetectionType sets the "detection_type" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableDetectionType(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetDetectionType(*v)
+	}
+	return _c
+}
+
+// SetFilePath sets the "file_path" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetFilePath(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetFilePath(v)
+	return _c
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableFilePath(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetFilePath(*v)
+	}
+	return _c
+}
+
+// SetSha1 sets the "sha1" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetSha1(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetSha1(v)
+	return _c
+}
+
+// SetNillableSha1 sets the "sha1" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableSha1(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetSha1(*v)
+	}
+	return _c
+}
+
+// SetSha256 sets the "sha256" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetSha256(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetSha256(v)
+	return _c
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableSha256(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetSha256(*v)
+	}
+	return _c
+}
+
+// SetMd5 sets the "md5" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetMd5(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetMd5(v)
+	return _c
+}
+
+// SetNillableMd5 sets the "md5" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableMd5(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetMd5(*v)
+	}
+	return _c
+}
+
+// SetStoryline sets the "storyline" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetStoryline(v string) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetStoryline(v)
+	return _c
+}
+
+// SetNillableStoryline sets the "storyline" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableStoryline(v *string) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetStoryline(*v)
+	}
+	return _c
+}
+
+// SetIsFileless sets the "is_fileless" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetIsFileless(v bool) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetIsFileless(v)
+	return _c
+}
+
+// SetNillableIsFileless sets the "is_fileless" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableIsFileless(v *bool) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetIsFileless(*v)
+	}
+	return _c
+}
+
+// SetMitigatedPreemptively sets the "mitigated_preemptively" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetMitigatedPreemptively(v bool) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetMitigatedPreemptively(v)
+	return _c
+}
+
+// SetNillableMitigatedPreemptively sets the "mitigated_preemptively" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableMitigatedPreemptively(v *bool) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetMitigatedPreemptively(*v)
+	}
+	return _c
+}
+
+// SetIdentifiedAt sets the "identified_at" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetIdentifiedAt(v time.Time) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetIdentifiedAt(v)
+	return _c
+}
+
+// SetNillableIdentifiedAt sets the "identified_at" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableIdentifiedAt(v *time.Time) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetIdentifiedAt(*v)
+	}
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetAPICreatedAt(v time.Time) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetAPIUpdatedAt(v time.Time) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetAPIUpdatedAt(v)
+	return _c
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_c *BronzeHistoryS1ThreatCreate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeHistoryS1ThreatCreate {
+	if v != nil {
+		_c.SetAPIUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetEnginesJSON sets the "engines_json" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetEnginesJSON(v json.RawMessage) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetEnginesJSON(v)
+	return _c
+}
+
+// SetIndicatorsJSON sets the "indicators_json" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetIndicatorsJSON(v json.RawMessage) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetIndicatorsJSON(v)
+	return _c
+}
+
+// SetMitigationActionsJSON sets the "mitigation_actions_json" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetMitigationActionsJSON(v json.RawMessage) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetMitigationActionsJSON(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeHistoryS1ThreatCreate) SetID(v uint) *BronzeHistoryS1ThreatCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeHistoryS1ThreatMutation object of the builder.
+func (_c *BronzeHistoryS1ThreatCreate) Mutation() *BronzeHistoryS1ThreatMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeHistoryS1Threat in the database.
+func (_c *BronzeHistoryS1ThreatCreate) Save(ctx context.Context) (*BronzeHistoryS1Threat, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeHistoryS1ThreatCreate) SaveX(ctx context.Context) *BronzeHistoryS1Threat {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryS1ThreatCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryS1ThreatCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeHistoryS1ThreatCreate) defaults() {
+	if _, ok := _c.mutation.IsFileless(); !ok {
+		v := bronzehistorys1threat.DefaultIsFileless
+		_c.mutation.SetIsFileless(v)
+	}
+	if _, ok := _c.mutation.MitigatedPreemptively(); !ok {
+		v := bronzehistorys1threat.DefaultMitigatedPreemptively
+		_c.mutation.SetMitigatedPreemptively(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeHistoryS1ThreatCreate) check() error {
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`s1: missing required field "BronzeHistoryS1Threat.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`s1: missing required field "BronzeHistoryS1Threat.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`s1: missing required field "BronzeHistoryS1Threat.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`s1: missing required field "BronzeHistoryS1Threat.resource_id"`)}
+	}
+	if v, ok := _c.mutation.ResourceID(); ok {
+		if err := bronzehistorys1threat.ResourceIDValidator(v); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`s1: validator failed for field "BronzeHistoryS1Threat.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsFileless(); !ok {
+		return &ValidationError{Name: "is_fileless", err: errors.New(`s1: missing required field "BronzeHistoryS1Threat.is_fileless"`)}
+	}
+	if _, ok := _c.mutation.MitigatedPreemptively(); !ok {
+		return &ValidationError{Name: "mitigated_preemptively", err: errors.New(`s1: missing required field "BronzeHistoryS1Threat.mitigated_preemptively"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeHistoryS1ThreatCreate) sqlSave(ctx context.Context) (*BronzeHistoryS1Threat, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeHistoryS1ThreatCreate) createSpec() (*BronzeHistoryS1Threat, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeHistoryS1Threat{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzehistorys1threat.Table, sqlgraph.NewFieldSpec(bronzehistorys1threat.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeHistoryS1Threat
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ResourceID(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := _c.mutation.AgentID(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldAgentID, field.TypeString, value)
+		_node.AgentID = value
+	}
+	if value, ok := _c.mutation.AgentUUID(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldAgentUUID, field.TypeString, value)
+		_node.AgentUUID = value
+	}
+	if value, ok := _c.mutation.AgentComputerName(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldAgentComputerName, field.TypeString, value)
+		_node.AgentComputerName = value
+	}
+	if value, ok := _c.mutation.AgentOsType(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldAgentOsType, field.TypeString, value)
+		_node.AgentOsType = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.SiteID(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldSiteID, field.TypeString, value)
+		_node.SiteID = value
+	}
+	if value, ok := _c.mutation.SiteName(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldSiteName, field.TypeString, value)
+		_node.SiteName = value
+	}
+	if value, ok := _c.mutation.GroupID(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldGroupID, field.TypeString, value)
+		_node.GroupID = value
+	}
+	if value, ok := _c.mutation.ThreatName(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldThreatName, field.TypeString, value)
+		_node.ThreatName = value
+	}
+	if value, ok := _c.mutation.Classification(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldClassification, field.TypeString, value)
+		_node.Classification = value
+	}
+	if value, ok := _c.mutation.ClassificationSource(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldClassificationSource, field.TypeString, value)
+		_node.ClassificationSource = value
+	}
+	if value, ok := _c.mutation.ConfidenceLevel(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldConfidenceLevel, field.TypeString, value)
+		_node.ConfidenceLevel = value
+	}
+	if value, ok := _c.mutation.MitigationStatus(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldMitigationStatus, field.TypeString, value)
+		_node.MitigationStatus = value
+	}
+	if value, ok := _c.mutation.AnalystVerdict(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldAnalystVerdict, field.TypeString, value)
+		_node.AnalystVerdict = value
+	}
+	if value, ok := _c.mutation.IncidentStatus(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldIncidentStatus, field.TypeString, value)
+		_node.IncidentStatus = value
+	}
+	if value, ok := _c.mutation.InitiatedBy(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldInitiatedBy, field.TypeString, value)
+		_node.InitiatedBy = value
+	}
+	if value, ok := _c.mutation.DetectionType(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldDetectionType, field.TypeString, value)
+		_node.DetectionType = value
+	}
+	if value, ok := _c.mutation.FilePath(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldFilePath, field.TypeString, value)
+		_node.FilePath = value
+	}
+	if value, ok := _c.mutation.Sha1(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldSha1, field.TypeString, value)
+		_node.Sha1 = value
+	}
+	if value, ok := _c.mutation.Sha256(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldSha256, field.TypeString, value)
+		_node.Sha256 = value
+	}
+	if value, ok := _c.mutation.Md5(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldMd5, field.TypeString, value)
+		_node.Md5 = value
+	}
+	if value, ok := _c.mutation.Storyline(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldStoryline, field.TypeString, value)
+		_node.Storyline = value
+	}
+	if value, ok := _c.mutation.IsFileless(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldIsFileless, field.TypeBool, value)
+		_node.IsFileless = value
+	}
+	if value, ok := _c.mutation.MitigatedPreemptively(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldMitigatedPreemptively, field.TypeBool, value)
+		_node.MitigatedPreemptively = value
+	}
+	if value, ok := _c.mutation.IdentifiedAt(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldIdentifiedAt, field.TypeTime, value)
+		_node.IdentifiedAt = &value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	if value, ok := _c.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldAPIUpdatedAt, field.TypeTime, value)
+		_node.APIUpdatedAt = &value
+	}
+	if value, ok := _c.mutation.EnginesJSON(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldEnginesJSON, field.TypeJSON, value)
+		_node.EnginesJSON = value
+	}
+	if value, ok := _c.mutation.IndicatorsJSON(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldIndicatorsJSON, field.TypeJSON, value)
+		_node.IndicatorsJSON = value
+	}
+	if value, ok := _c.mutation.MitigationActionsJSON(); ok {
+		_spec.SetField(bronzehistorys1threat.FieldMitigationActionsJSON, field.TypeJSON, value)
+		_node.MitigationActionsJSON = value
+	}
+	return _node, _spec
+}
+
+// BronzeHistoryS1ThreatCreateBulk is the builder for creating many BronzeHistoryS1Threat entities in bulk.
+type BronzeHistoryS1ThreatCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeHistoryS1ThreatCreate
+}
+
+// Save creates the BronzeHistoryS1Threat entities in the database.
+func (_c *BronzeHistoryS1ThreatCreateBulk) Save(ctx context.Context) ([]*BronzeHistoryS1Threat, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeHistoryS1Threat, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeHistoryS1ThreatMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeHistoryS1ThreatCreateBulk) SaveX(ctx context.Context) []*BronzeHistoryS1Threat {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeHistoryS1ThreatCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeHistoryS1ThreatCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1activity"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeS1Activity is the model entity for the BronzeS1Activity schema.
+type BronzeS1Activity struct {
+	config `json:"-"`
+	// ID of the ent.
+	// SentinelOne activity ID
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// SentinelOne activity type code, see /activities/types
+	ActivityType int `json:"activity_type,omitempty"`
+	// ActivityUUID holds the value of the "activity_uuid" field.
+	ActivityUUID string `json:"activity_uuid,omitempty"`
+	// PrimaryDescription holds the value of the "primary_description" field.
+	PrimaryDescription string `json:"primary_description,omitempty"`
+	// SecondaryDescription holds the value of the "secondary_description" field.
+	SecondaryDescription string `json:"secondary_description,omitempty"`
+	// Comments holds the value of the "comments" field.
+	Comments string `json:"comments,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// AccountName holds the value of the "account_name" field.
+	AccountName string `json:"account_name,omitempty"`
+	// SiteID holds the value of the "site_id" field.
+	SiteID string `json:"site_id,omitempty"`
+	// SiteName holds the value of the "site_name" field.
+	SiteName string `json:"site_name,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID string `json:"group_id,omitempty"`
+	// GroupName holds the value of the "group_name" field.
+	GroupName string `json:"group_name,omitempty"`
+	// SentinelOne agent ID; joins to s1_agents.resource_id
+	AgentID string `json:"agent_id,omitempty"`
+	// Console user who performed the action
+	UserID string `json:"user_id,omitempty"`
+	// Related threat; joins to s1_threats.resource_id
+	ThreatID string `json:"threat_id,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash string `json:"hash,omitempty"`
+	// OsFamily holds the value of the "os_family" field.
+	OsFamily string `json:"os_family,omitempty"`
+	// Activity time; drives windowing and retention
+	APICreatedAt time.Time `json:"api_created_at,omitempty"`
+	// APIUpdatedAt holds the value of the "api_updated_at" field.
+	APIUpdatedAt *time.Time `json:"api_updated_at,omitempty"`
+	// Activity-type specific payload
+	DataJSON     json.RawMessage `json:"data_json,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeS1Activity) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzes1activity.FieldDataJSON:
+			values[i] = new([]byte)
+		case bronzes1activity.FieldActivityType:
+			values[i] = new(sql.NullInt64)
+		case bronzes1activity.FieldID, bronzes1activity.FieldActivityUUID, bronzes1activity.FieldPrimaryDescription, bronzes1activity.FieldSecondaryDescription, bronzes1activity.FieldComments, bronzes1activity.FieldAccountID, bronzes1activity.FieldAccountName, bronzes1activity.FieldSiteID, bronzes1activity.FieldSiteName, bronzes1activity.FieldGroupID, bronzes1activity.FieldGroupName, bronzes1activity.FieldAgentID, bronzes1activity.FieldUserID, bronzes1activity.FieldThreatID, bronzes1activity.FieldHash, bronzes1activity.FieldOsFamily:
+			values[i] = new(sql.NullString)
+		case bronzes1activity.FieldCollectedAt, bronzes1activity.FieldFirstCollectedAt, bronzes1activity.FieldAPICreatedAt, bronzes1activity.FieldAPIUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeS1Activity fields.
+func (_m *BronzeS1Activity) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzes1activity.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzes1activity.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzes1activity.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzes1activity.FieldActivityType:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field activity_type", values[i])
+			} else if value.Valid {
+				_m.ActivityType = int(value.Int64)
+			}
+		case bronzes1activity.FieldActivityUUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field activity_uuid", values[i])
+			} else if value.Valid {
+				_m.ActivityUUID = value.String
+			}
+		case bronzes1activity.FieldPrimaryDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field primary_description", values[i])
+			} else if value.Valid {
+				_m.PrimaryDescription = value.String
+			}
+		case bronzes1activity.FieldSecondaryDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secondary_description", values[i])
+			} else if value.Valid {
+				_m.SecondaryDescription = value.String
+			}
+		case bronzes1activity.FieldComments:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field comments", values[i])
+			} else if value.Valid {
+				_m.Comments = value.String
+			}
+		case bronzes1activity.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzes1activity.FieldAccountName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_name", values[i])
+			} else if value.Valid {
+				_m.AccountName = value.String
+			}
+		case bronzes1activity.FieldSiteID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field site_id", values[i])
+			} else if value.Valid {
+				_m.SiteID = value.String
+			}
+		case bronzes1activity.FieldSiteName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field site_name", values[i])
+			} else if value.Valid {
+				_m.SiteName = value.String
+			}
+		case bronzes1activity.FieldGroupID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				_m.GroupID = value.String
+			}
+		case bronzes1activity.FieldGroupName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_name", values[i])
+			} else if value.Valid {
+				_m.GroupName = value.String
+			}
+		case bronzes1activity.FieldAgentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_id", values[i])
+			} else if value.Valid {
+				_m.AgentID = value.String
+			}
+		case bronzes1activity.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case bronzes1activity.FieldThreatID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field threat_id", values[i])
+			} else if value.Valid {
+				_m.ThreatID = value.String
+			}
+		case bronzes1activity.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		case bronzes1activity.FieldOsFamily:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field os_family", values[i])
+			} else if value.Valid {
+				_m.OsFamily = value.String
+			}
+		case bronzes1activity.FieldAPICreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_created_at", values[i])
+			} else if value.Valid {
+				_m.APICreatedAt = value.Time
+			}
+		case bronzes1activity.FieldAPIUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_updated_at", values[i])
+			} else if value.Valid {
+				_m.APIUpdatedAt = new(time.Time)
+				*_m.APIUpdatedAt = value.Time
+			}
+		case bronzes1activity.FieldDataJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DataJSON); err != nil {
+					return fmt.Errorf("unmarshal field data_json: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeS1Activity.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeS1Activity) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeS1Activity.
+// Note that you need to call BronzeS1Activity.Unwrap() before calling this method if this BronzeS1Activity
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeS1Activity) Update() *BronzeS1ActivityUpdateOne {
+	return NewBronzeS1ActivityClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeS1Activity entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeS1Activity) Unwrap() *BronzeS1Activity {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("s1: BronzeS1Activity is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeS1Activity) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeS1Activity(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("activity_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ActivityType))
+	builder.WriteString(", ")
+	builder.WriteString("activity_uuid=")
+	builder.WriteString(_m.ActivityUUID)
+	builder.WriteString(", ")
+	builder.WriteString("primary_description=")
+	builder.WriteString(_m.PrimaryDescription)
+	builder.WriteString(", ")
+	builder.WriteString("secondary_description=")
+	builder.WriteString(_m.SecondaryDescription)
+	builder.WriteString(", ")
+	builder.WriteString("comments=")
+	builder.WriteString(_m.Comments)
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("account_name=")
+	builder.WriteString(_m.AccountName)
+	builder.WriteString(", ")
+	builder.WriteString("site_id=")
+	builder.WriteString(_m.SiteID)
+	builder.WriteString(", ")
+	builder.WriteString("site_name=")
+	builder.WriteString(_m.SiteName)
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(_m.GroupID)
+	builder.WriteString(", ")
+	builder.WriteString("group_name=")
+	builder.WriteString(_m.GroupName)
+	builder.WriteString(", ")
+	builder.WriteString("agent_id=")
+	builder.WriteString(_m.AgentID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("threat_id=")
+	builder.WriteString(_m.ThreatID)
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("os_family=")
+	builder.WriteString(_m.OsFamily)
+	builder.WriteString(", ")
+	builder.WriteString("api_created_at=")
+	builder.WriteString(_m.APICreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.APIUpdatedAt; v != nil {
+		builder.WriteString("api_updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("data_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DataJSON))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeS1Activities is a parsable slice of BronzeS1Activity.
+type BronzeS1Activities []*BronzeS1Activity
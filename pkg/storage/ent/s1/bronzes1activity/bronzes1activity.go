@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzes1activity
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzes1activity type in the database.
+	Label = "bronze_s1activity"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldActivityType holds the string denoting the activity_type field in the database.
+	FieldActivityType = "activity_type"
+	// FieldActivityUUID holds the string denoting the activity_uuid field in the database.
+	FieldActivityUUID = "activity_uuid"
+	// FieldPrimaryDescription holds the string denoting the primary_description field in the database.
+	FieldPrimaryDescription = "primary_description"
+	// FieldSecondaryDescription holds the string denoting the secondary_description field in the database.
+	FieldSecondaryDescription = "secondary_description"
+	// FieldComments holds the string denoting the comments field in the database.
+	FieldComments = "comments"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldAccountName holds the string denoting the account_name field in the database.
+	FieldAccountName = "account_name"
+	// FieldSiteID holds the string denoting the site_id field in the database.
+	FieldSiteID = "site_id"
+	// FieldSiteName holds the string denoting the site_name field in the database.
+	FieldSiteName = "site_name"
+	// FieldGroupID holds the string denoting the group_id field in the database.
+	FieldGroupID = "group_id"
+	// FieldGroupName holds the string denoting the group_name field in the database.
+	FieldGroupName = "group_name"
+	// FieldAgentID holds the string denoting the agent_id field in the database.
+	FieldAgentID = "agent_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldThreatID holds the string denoting the threat_id field in the database.
+	FieldThreatID = "threat_id"
+	// FieldHash holds the string denoting the hash field in the database.
+	FieldHash = "hash"
+	// FieldOsFamily holds the string denoting the os_family field in the database.
+	FieldOsFamily = "os_family"
+	// FieldAPICreatedAt holds the string denoting the api_created_at field in the database.
+	FieldAPICreatedAt = "api_created_at"
+	// FieldAPIUpdatedAt holds the string denoting the api_updated_at field in the database.
+	FieldAPIUpdatedAt = "api_updated_at"
+	// FieldDataJSON holds the string denoting the data_json field in the database.
+	FieldDataJSON = "data_json"
+	// Table holds the table name of the bronzes1activity in the database.
+	Table = "s1_activities"
+)
+
+// Columns holds all SQL columns for bronzes1activity fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldActivityType,
+	FieldActivityUUID,
+	FieldPrimaryDescription,
+	FieldSecondaryDescription,
+	FieldComments,
+	FieldAccountID,
+	FieldAccountName,
+	FieldSiteID,
+	FieldSiteName,
+	FieldGroupID,
+	FieldGroupName,
+	FieldAgentID,
+	FieldUserID,
+	FieldThreatID,
+	FieldHash,
+	FieldOsFamily,
+	FieldAPICreatedAt,
+	FieldAPIUpdatedAt,
+	FieldDataJSON,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the BronzeS1Activity queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByActivityType orders the results by the activity_type field.
+func ByActivityType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActivityType, opts...).ToFunc()
+}
+
+// ByActivityUUID orders the results by the activity_uuid field.
+func ByActivityUUID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActivityUUID, opts...).ToFunc()
+}
+
+// ByPrimaryDescription orders the results by the primary_description field.
+func ByPrimaryDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrimaryDescription, opts...).ToFunc()
+}
+
+// BySecondaryDescription orders the results by the secondary_description field.
+func BySecondaryDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSecondaryDescription, opts...).ToFunc()
+}
+
+// ByComments orders the results by the comments field.
+func ByComments(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldComments, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByAccountName orders the results by the account_name field.
+func ByAccountName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountName, opts...).ToFunc()
+}
+
+// BySiteID orders the results by the site_id field.
+func BySiteID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSiteID, opts...).ToFunc()
+}
+
+// BySiteName orders the results by the site_name field.
+func BySiteName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSiteName, opts...).ToFunc()
+}
+
+// ByGroupID orders the results by the group_id field.
+func ByGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupID, opts...).ToFunc()
+}
+
+// ByGroupName orders the results by the group_name field.
+func ByGroupName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupName, opts...).ToFunc()
+}
+
+// ByAgentID orders the results by the agent_id field.
+func ByAgentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgentID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByThreatID orders the results by the threat_id field.
+func ByThreatID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThreatID, opts...).ToFunc()
+}
+
+// ByHash orders the results by the hash field.
+func ByHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHash, opts...).ToFunc()
+}
+
+// ByOsFamily orders the results by the os_family field.
+func ByOsFamily(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOsFamily, opts...).ToFunc()
+}
+
+// ByAPICreatedAt orders the results by the api_created_at field.
+func ByAPICreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPICreatedAt, opts...).ToFunc()
+}
+
+// ByAPIUpdatedAt orders the results by the api_updated_at field.
+func ByAPIUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPIUpdatedAt, opts...).ToFunc()
+}
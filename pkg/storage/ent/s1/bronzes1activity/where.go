@@ -0,0 +1,1525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzes1activity
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ActivityType applies equality check predicate on the "activity_type" field. It's identical to ActivityTypeEQ.
+func ActivityType(v int) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldActivityType, v))
+}
+
+// ActivityUUID applies equality check predicate on the "activity_uuid" field. It's identical to ActivityUUIDEQ.
+func ActivityUUID(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldActivityUUID, v))
+}
+
+// PrimaryDescription applies equality check predicate on the "primary_description" field. It's identical to PrimaryDescriptionEQ.
+func PrimaryDescription(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldPrimaryDescription, v))
+}
+
+// SecondaryDescription applies equality check predicate on the "secondary_description" field. It's identical to SecondaryDescriptionEQ.
+func SecondaryDescription(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldSecondaryDescription, v))
+}
+
+// Comments applies equality check predicate on the "comments" field. It's identical to CommentsEQ.
+func Comments(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldComments, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountName applies equality check predicate on the "account_name" field. It's identical to AccountNameEQ.
+func AccountName(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldAccountName, v))
+}
+
+// SiteID applies equality check predicate on the "site_id" field. It's identical to SiteIDEQ.
+func SiteID(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldSiteID, v))
+}
+
+// SiteName applies equality check predicate on the "site_name" field. It's identical to SiteNameEQ.
+func SiteName(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldSiteName, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupName applies equality check predicate on the "group_name" field. It's identical to GroupNameEQ.
+func GroupName(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldGroupName, v))
+}
+
+// AgentID applies equality check predicate on the "agent_id" field. It's identical to AgentIDEQ.
+func AgentID(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldAgentID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldUserID, v))
+}
+
+// ThreatID applies equality check predicate on the "threat_id" field. It's identical to ThreatIDEQ.
+func ThreatID(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldThreatID, v))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldHash, v))
+}
+
+// OsFamily applies equality check predicate on the "os_family" field. It's identical to OsFamilyEQ.
+func OsFamily(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldOsFamily, v))
+}
+
+// APICreatedAt applies equality check predicate on the "api_created_at" field. It's identical to APICreatedAtEQ.
+func APICreatedAt(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// APIUpdatedAt applies equality check predicate on the "api_updated_at" field. It's identical to APIUpdatedAtEQ.
+func APIUpdatedAt(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldAPIUpdatedAt, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ActivityTypeEQ applies the EQ predicate on the "activity_type" field.
+func ActivityTypeEQ(v int) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldActivityType, v))
+}
+
+// ActivityTypeNEQ applies the NEQ predicate on the "activity_type" field.
+func ActivityTypeNEQ(v int) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldActivityType, v))
+}
+
+// ActivityTypeIn applies the In predicate on the "activity_type" field.
+func ActivityTypeIn(vs ...int) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldActivityType, vs...))
+}
+
+// ActivityTypeNotIn applies the NotIn predicate on the "activity_type" field.
+func ActivityTypeNotIn(vs ...int) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldActivityType, vs...))
+}
+
+// ActivityTypeGT applies the GT predicate on the "activity_type" field.
+func ActivityTypeGT(v int) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldActivityType, v))
+}
+
+// ActivityTypeGTE applies the GTE predicate on the "activity_type" field.
+func ActivityTypeGTE(v int) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldActivityType, v))
+}
+
+// ActivityTypeLT applies the LT predicate on the "activity_type" field.
+func ActivityTypeLT(v int) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldActivityType, v))
+}
+
+// ActivityTypeLTE applies the LTE predicate on the "activity_type" field.
+func ActivityTypeLTE(v int) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldActivityType, v))
+}
+
+// ActivityUUIDEQ applies the EQ predicate on the "activity_uuid" field.
+func ActivityUUIDEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldActivityUUID, v))
+}
+
+// ActivityUUIDNEQ applies the NEQ predicate on the "activity_uuid" field.
+func ActivityUUIDNEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldActivityUUID, v))
+}
+
+// ActivityUUIDIn applies the In predicate on the "activity_uuid" field.
+func ActivityUUIDIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldActivityUUID, vs...))
+}
+
+// ActivityUUIDNotIn applies the NotIn predicate on the "activity_uuid" field.
+func ActivityUUIDNotIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldActivityUUID, vs...))
+}
+
+// ActivityUUIDGT applies the GT predicate on the "activity_uuid" field.
+func ActivityUUIDGT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldActivityUUID, v))
+}
+
+// ActivityUUIDGTE applies the GTE predicate on the "activity_uuid" field.
+func ActivityUUIDGTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldActivityUUID, v))
+}
+
+// ActivityUUIDLT applies the LT predicate on the "activity_uuid" field.
+func ActivityUUIDLT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldActivityUUID, v))
+}
+
+// ActivityUUIDLTE applies the LTE predicate on the "activity_uuid" field.
+func ActivityUUIDLTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldActivityUUID, v))
+}
+
+// ActivityUUIDContains applies the Contains predicate on the "activity_uuid" field.
+func ActivityUUIDContains(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContains(FieldActivityUUID, v))
+}
+
+// ActivityUUIDHasPrefix applies the HasPrefix predicate on the "activity_uuid" field.
+func ActivityUUIDHasPrefix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasPrefix(FieldActivityUUID, v))
+}
+
+// ActivityUUIDHasSuffix applies the HasSuffix predicate on the "activity_uuid" field.
+func ActivityUUIDHasSuffix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasSuffix(FieldActivityUUID, v))
+}
+
+// ActivityUUIDIsNil applies the IsNil predicate on the "activity_uuid" field.
+func ActivityUUIDIsNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIsNull(FieldActivityUUID))
+}
+
+// ActivityUUIDNotNil applies the NotNil predicate on the "activity_uuid" field.
+func ActivityUUIDNotNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotNull(FieldActivityUUID))
+}
+
+// ActivityUUIDEqualFold applies the EqualFold predicate on the "activity_uuid" field.
+func ActivityUUIDEqualFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEqualFold(FieldActivityUUID, v))
+}
+
+// ActivityUUIDContainsFold applies the ContainsFold predicate on the "activity_uuid" field.
+func ActivityUUIDContainsFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContainsFold(FieldActivityUUID, v))
+}
+
+// PrimaryDescriptionEQ applies the EQ predicate on the "primary_description" field.
+func PrimaryDescriptionEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldPrimaryDescription, v))
+}
+
+// PrimaryDescriptionNEQ applies the NEQ predicate on the "primary_description" field.
+func PrimaryDescriptionNEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldPrimaryDescription, v))
+}
+
+// PrimaryDescriptionIn applies the In predicate on the "primary_description" field.
+func PrimaryDescriptionIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldPrimaryDescription, vs...))
+}
+
+// PrimaryDescriptionNotIn applies the NotIn predicate on the "primary_description" field.
+func PrimaryDescriptionNotIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldPrimaryDescription, vs...))
+}
+
+// PrimaryDescriptionGT applies the GT predicate on the "primary_description" field.
+func PrimaryDescriptionGT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldPrimaryDescription, v))
+}
+
+// PrimaryDescriptionGTE applies the GTE predicate on the "primary_description" field.
+func PrimaryDescriptionGTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldPrimaryDescription, v))
+}
+
+// PrimaryDescriptionLT applies the LT predicate on the "primary_description" field.
+func PrimaryDescriptionLT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldPrimaryDescription, v))
+}
+
+// PrimaryDescriptionLTE applies the LTE predicate on the "primary_description" field.
+func PrimaryDescriptionLTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldPrimaryDescription, v))
+}
+
+// PrimaryDescriptionContains applies the Contains predicate on the "primary_description" field.
+func PrimaryDescriptionContains(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContains(FieldPrimaryDescription, v))
+}
+
+// PrimaryDescriptionHasPrefix applies the HasPrefix predicate on the "primary_description" field.
+func PrimaryDescriptionHasPrefix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasPrefix(FieldPrimaryDescription, v))
+}
+
+// PrimaryDescriptionHasSuffix applies the HasSuffix predicate on the "primary_description" field.
+func PrimaryDescriptionHasSuffix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasSuffix(FieldPrimaryDescription, v))
+}
+
+// PrimaryDescriptionIsNil applies the IsNil predicate on the "primary_description" field.
+func PrimaryDescriptionIsNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIsNull(FieldPrimaryDescription))
+}
+
+// PrimaryDescriptionNotNil applies the NotNil predicate on the "primary_description" field.
+func PrimaryDescriptionNotNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotNull(FieldPrimaryDescription))
+}
+
+// PrimaryDescriptionEqualFold applies the EqualFold predicate on the "primary_description" field.
+func PrimaryDescriptionEqualFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEqualFold(FieldPrimaryDescription, v))
+}
+
+// PrimaryDescriptionContainsFold applies the ContainsFold predicate on the "primary_description" field.
+func PrimaryDescriptionContainsFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContainsFold(FieldPrimaryDescription, v))
+}
+
+// SecondaryDescriptionEQ applies the EQ predicate on the "secondary_description" field.
+func SecondaryDescriptionEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldSecondaryDescription, v))
+}
+
+// SecondaryDescriptionNEQ applies the NEQ predicate on the "secondary_description" field.
+func SecondaryDescriptionNEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldSecondaryDescription, v))
+}
+
+// SecondaryDescriptionIn applies the In predicate on the "secondary_description" field.
+func SecondaryDescriptionIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldSecondaryDescription, vs...))
+}
+
+// SecondaryDescriptionNotIn applies the NotIn predicate on the "secondary_description" field.
+func SecondaryDescriptionNotIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldSecondaryDescription, vs...))
+}
+
+// SecondaryDescriptionGT applies the GT predicate on the "secondary_description" field.
+func SecondaryDescriptionGT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldSecondaryDescription, v))
+}
+
+// SecondaryDescriptionGTE applies the GTE predicate on the "secondary_description" field.
+func SecondaryDescriptionGTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldSecondaryDescription, v))
+}
+
+// SecondaryDescriptionLT applies the LT predicate on the "secondary_description" field.
+func SecondaryDescriptionLT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldSecondaryDescription, v))
+}
+
+// SecondaryDescriptionLTE applies the LTE predicate on the "secondary_description" field.
+func SecondaryDescriptionLTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldSecondaryDescription, v))
+}
+
+// SecondaryDescriptionContains applies the Contains predicate on the "secondary_description" field.
+func SecondaryDescriptionContains(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContains(FieldSecondaryDescription, v))
+}
+
+// SecondaryDescriptionHasPrefix applies the HasPrefix predicate on the "secondary_description" field.
+func SecondaryDescriptionHasPrefix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasPrefix(FieldSecondaryDescription, v))
+}
+
+// SecondaryDescriptionHasSuffix applies the HasSuffix predicate on the "secondary_description" field.
+func SecondaryDescriptionHasSuffix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasSuffix(FieldSecondaryDescription, v))
+}
+
+// SecondaryDescriptionIsNil applies the IsNil predicate on the "secondary_description" field.
+func SecondaryDescriptionIsNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIsNull(FieldSecondaryDescription))
+}
+
+// SecondaryDescriptionNotNil applies the NotNil predicate on the "secondary_description" field.
+func SecondaryDescriptionNotNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotNull(FieldSecondaryDescription))
+}
+
+// SecondaryDescriptionEqualFold applies the EqualFold predicate on the "secondary_description" field.
+func SecondaryDescriptionEqualFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEqualFold(FieldSecondaryDescription, v))
+}
+
+// SecondaryDescriptionContainsFold applies the ContainsFold predicate on the "secondary_description" field.
+func SecondaryDescriptionContainsFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContainsFold(FieldSecondaryDescription, v))
+}
+
+// CommentsEQ applies the EQ predicate on the "comments" field.
+func CommentsEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldComments, v))
+}
+
+// CommentsNEQ applies the NEQ predicate on the "comments" field.
+func CommentsNEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldComments, v))
+}
+
+// CommentsIn applies the In predicate on the "comments" field.
+func CommentsIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldComments, vs...))
+}
+
+// CommentsNotIn applies the NotIn predicate on the "comments" field.
+func CommentsNotIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldComments, vs...))
+}
+
+// CommentsGT applies the GT predicate on the "comments" field.
+func CommentsGT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldComments, v))
+}
+
+// CommentsGTE applies the GTE predicate on the "comments" field.
+func CommentsGTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldComments, v))
+}
+
+// CommentsLT applies the LT predicate on the "comments" field.
+func CommentsLT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldComments, v))
+}
+
+// CommentsLTE applies the LTE predicate on the "comments" field.
+func CommentsLTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldComments, v))
+}
+
+// CommentsContains applies the Contains predicate on the "comments" field.
+func CommentsContains(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContains(FieldComments, v))
+}
+
+// CommentsHasPrefix applies the HasPrefix predicate on the "comments" field.
+func CommentsHasPrefix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasPrefix(FieldComments, v))
+}
+
+// CommentsHasSuffix applies the HasSuffix predicate on the "comments" field.
+func CommentsHasSuffix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasSuffix(FieldComments, v))
+}
+
+// CommentsIsNil applies the IsNil predicate on the "comments" field.
+func CommentsIsNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIsNull(FieldComments))
+}
+
+// CommentsNotNil applies the NotNil predicate on the "comments" field.
+func CommentsNotNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotNull(FieldComments))
+}
+
+// CommentsEqualFold applies the EqualFold predicate on the "comments" field.
+func CommentsEqualFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEqualFold(FieldComments, v))
+}
+
+// CommentsContainsFold applies the ContainsFold predicate on the "comments" field.
+func CommentsContainsFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContainsFold(FieldComments, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDIsNil applies the IsNil predicate on the "account_id" field.
+func AccountIDIsNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIsNull(FieldAccountID))
+}
+
+// AccountIDNotNil applies the NotNil predicate on the "account_id" field.
+func AccountIDNotNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotNull(FieldAccountID))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// AccountNameEQ applies the EQ predicate on the "account_name" field.
+func AccountNameEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldAccountName, v))
+}
+
+// AccountNameNEQ applies the NEQ predicate on the "account_name" field.
+func AccountNameNEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldAccountName, v))
+}
+
+// AccountNameIn applies the In predicate on the "account_name" field.
+func AccountNameIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldAccountName, vs...))
+}
+
+// AccountNameNotIn applies the NotIn predicate on the "account_name" field.
+func AccountNameNotIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldAccountName, vs...))
+}
+
+// AccountNameGT applies the GT predicate on the "account_name" field.
+func AccountNameGT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldAccountName, v))
+}
+
+// AccountNameGTE applies the GTE predicate on the "account_name" field.
+func AccountNameGTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldAccountName, v))
+}
+
+// AccountNameLT applies the LT predicate on the "account_name" field.
+func AccountNameLT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldAccountName, v))
+}
+
+// AccountNameLTE applies the LTE predicate on the "account_name" field.
+func AccountNameLTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldAccountName, v))
+}
+
+// AccountNameContains applies the Contains predicate on the "account_name" field.
+func AccountNameContains(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContains(FieldAccountName, v))
+}
+
+// AccountNameHasPrefix applies the HasPrefix predicate on the "account_name" field.
+func AccountNameHasPrefix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasPrefix(FieldAccountName, v))
+}
+
+// AccountNameHasSuffix applies the HasSuffix predicate on the "account_name" field.
+func AccountNameHasSuffix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasSuffix(FieldAccountName, v))
+}
+
+// AccountNameIsNil applies the IsNil predicate on the "account_name" field.
+func AccountNameIsNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIsNull(FieldAccountName))
+}
+
+// AccountNameNotNil applies the NotNil predicate on the "account_name" field.
+func AccountNameNotNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotNull(FieldAccountName))
+}
+
+// AccountNameEqualFold applies the EqualFold predicate on the "account_name" field.
+func AccountNameEqualFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEqualFold(FieldAccountName, v))
+}
+
+// AccountNameContainsFold applies the ContainsFold predicate on the "account_name" field.
+func AccountNameContainsFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContainsFold(FieldAccountName, v))
+}
+
+// SiteIDEQ applies the EQ predicate on the "site_id" field.
+func SiteIDEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldSiteID, v))
+}
+
+// SiteIDNEQ applies the NEQ predicate on the "site_id" field.
+func SiteIDNEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldSiteID, v))
+}
+
+// SiteIDIn applies the In predicate on the "site_id" field.
+func SiteIDIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldSiteID, vs...))
+}
+
+// SiteIDNotIn applies the NotIn predicate on the "site_id" field.
+func SiteIDNotIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldSiteID, vs...))
+}
+
+// SiteIDGT applies the GT predicate on the "site_id" field.
+func SiteIDGT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldSiteID, v))
+}
+
+// SiteIDGTE applies the GTE predicate on the "site_id" field.
+func SiteIDGTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldSiteID, v))
+}
+
+// SiteIDLT applies the LT predicate on the "site_id" field.
+func SiteIDLT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldSiteID, v))
+}
+
+// SiteIDLTE applies the LTE predicate on the "site_id" field.
+func SiteIDLTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldSiteID, v))
+}
+
+// SiteIDContains applies the Contains predicate on the "site_id" field.
+func SiteIDContains(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContains(FieldSiteID, v))
+}
+
+// SiteIDHasPrefix applies the HasPrefix predicate on the "site_id" field.
+func SiteIDHasPrefix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasPrefix(FieldSiteID, v))
+}
+
+// SiteIDHasSuffix applies the HasSuffix predicate on the "site_id" field.
+func SiteIDHasSuffix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasSuffix(FieldSiteID, v))
+}
+
+// SiteIDIsNil applies the IsNil predicate on the "site_id" field.
+func SiteIDIsNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIsNull(FieldSiteID))
+}
+
+// SiteIDNotNil applies the NotNil predicate on the "site_id" field.
+func SiteIDNotNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotNull(FieldSiteID))
+}
+
+// SiteIDEqualFold applies the EqualFold predicate on the "site_id" field.
+func SiteIDEqualFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEqualFold(FieldSiteID, v))
+}
+
+// SiteIDContainsFold applies the ContainsFold predicate on the "site_id" field.
+func SiteIDContainsFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContainsFold(FieldSiteID, v))
+}
+
+// SiteNameEQ applies the EQ predicate on the "site_name" field.
+func SiteNameEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldSiteName, v))
+}
+
+// SiteNameNEQ applies the NEQ predicate on the "site_name" field.
+func SiteNameNEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldSiteName, v))
+}
+
+// SiteNameIn applies the In predicate on the "site_name" field.
+func SiteNameIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldSiteName, vs...))
+}
+
+// SiteNameNotIn applies the NotIn predicate on the "site_name" field.
+func SiteNameNotIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldSiteName, vs...))
+}
+
+// SiteNameGT applies the GT predicate on the "site_name" field.
+func SiteNameGT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldSiteName, v))
+}
+
+// SiteNameGTE applies the GTE predicate on the "site_name" field.
+func SiteNameGTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldSiteName, v))
+}
+
+// SiteNameLT applies the LT predicate on the "site_name" field.
+func SiteNameLT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldSiteName, v))
+}
+
+// SiteNameLTE applies the LTE predicate on the "site_name" field.
+func SiteNameLTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldSiteName, v))
+}
+
+// SiteNameContains applies the Contains predicate on the "site_name" field.
+func SiteNameContains(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContains(FieldSiteName, v))
+}
+
+// SiteNameHasPrefix applies the HasPrefix predicate on the "site_name" field.
+func SiteNameHasPrefix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasPrefix(FieldSiteName, v))
+}
+
+// SiteNameHasSuffix applies the HasSuffix predicate on the "site_name" field.
+func SiteNameHasSuffix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasSuffix(FieldSiteName, v))
+}
+
+// SiteNameIsNil applies the IsNil predicate on the "site_name" field.
+func SiteNameIsNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIsNull(FieldSiteName))
+}
+
+// SiteNameNotNil applies the NotNil predicate on the "site_name" field.
+func SiteNameNotNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotNull(FieldSiteName))
+}
+
+// SiteNameEqualFold applies the EqualFold predicate on the "site_name" field.
+func SiteNameEqualFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEqualFold(FieldSiteName, v))
+}
+
+// SiteNameContainsFold applies the ContainsFold predicate on the "site_name" field.
+func SiteNameContainsFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContainsFold(FieldSiteName, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// GroupIDGT applies the GT predicate on the "group_id" field.
+func GroupIDGT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldGroupID, v))
+}
+
+// GroupIDGTE applies the GTE predicate on the "group_id" field.
+func GroupIDGTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldGroupID, v))
+}
+
+// GroupIDLT applies the LT predicate on the "group_id" field.
+func GroupIDLT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldGroupID, v))
+}
+
+// GroupIDLTE applies the LTE predicate on the "group_id" field.
+func GroupIDLTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldGroupID, v))
+}
+
+// GroupIDContains applies the Contains predicate on the "group_id" field.
+func GroupIDContains(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContains(FieldGroupID, v))
+}
+
+// GroupIDHasPrefix applies the HasPrefix predicate on the "group_id" field.
+func GroupIDHasPrefix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasPrefix(FieldGroupID, v))
+}
+
+// GroupIDHasSuffix applies the HasSuffix predicate on the "group_id" field.
+func GroupIDHasSuffix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasSuffix(FieldGroupID, v))
+}
+
+// GroupIDIsNil applies the IsNil predicate on the "group_id" field.
+func GroupIDIsNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIsNull(FieldGroupID))
+}
+
+// GroupIDNotNil applies the NotNil predicate on the "group_id" field.
+func GroupIDNotNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotNull(FieldGroupID))
+}
+
+// GroupIDEqualFold applies the EqualFold predicate on the "group_id" field.
+func GroupIDEqualFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEqualFold(FieldGroupID, v))
+}
+
+// GroupIDContainsFold applies the ContainsFold predicate on the "group_id" field.
+func GroupIDContainsFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContainsFold(FieldGroupID, v))
+}
+
+// GroupNameEQ applies the EQ predicate on the "group_name" field.
+func GroupNameEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldGroupName, v))
+}
+
+// GroupNameNEQ applies the NEQ predicate on the "group_name" field.
+func GroupNameNEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldGroupName, v))
+}
+
+// GroupNameIn applies the In predicate on the "group_name" field.
+func GroupNameIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldGroupName, vs...))
+}
+
+// GroupNameNotIn applies the NotIn predicate on the "group_name" field.
+func GroupNameNotIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldGroupName, vs...))
+}
+
+// GroupNameGT applies the GT predicate on the "group_name" field.
+func GroupNameGT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldGroupName, v))
+}
+
+// GroupNameGTE applies the GTE predicate on the "group_name" field.
+func GroupNameGTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldGroupName, v))
+}
+
+// GroupNameLT applies the LT predicate on the "group_name" field.
+func GroupNameLT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldGroupName, v))
+}
+
+// GroupNameLTE applies the LTE predicate on the "group_name" field.
+func GroupNameLTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldGroupName, v))
+}
+
+// GroupNameContains applies the Contains predicate on the "group_name" field.
+func GroupNameContains(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContains(FieldGroupName, v))
+}
+
+// GroupNameHasPrefix applies the HasPrefix predicate on the "group_name" field.
+func GroupNameHasPrefix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasPrefix(FieldGroupName, v))
+}
+
+// GroupNameHasSuffix applies the HasSuffix predicate on the "group_name" field.
+func GroupNameHasSuffix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasSuffix(FieldGroupName, v))
+}
+
+// GroupNameIsNil applies the IsNil predicate on the "group_name" field.
+func GroupNameIsNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIsNull(FieldGroupName))
+}
+
+// GroupNameNotNil applies the NotNil predicate on the "group_name" field.
+func GroupNameNotNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotNull(FieldGroupName))
+}
+
+// GroupNameEqualFold applies the EqualFold predicate on the "group_name" field.
+func GroupNameEqualFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEqualFold(FieldGroupName, v))
+}
+
+// GroupNameContainsFold applies the ContainsFold predicate on the "group_name" field.
+func GroupNameContainsFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContainsFold(FieldGroupName, v))
+}
+
+// AgentIDEQ applies the EQ predicate on the "agent_id" field.
+func AgentIDEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldAgentID, v))
+}
+
+// AgentIDNEQ applies the NEQ predicate on the "agent_id" field.
+func AgentIDNEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldAgentID, v))
+}
+
+// AgentIDIn applies the In predicate on the "agent_id" field.
+func AgentIDIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldAgentID, vs...))
+}
+
+// AgentIDNotIn applies the NotIn predicate on the "agent_id" field.
+func AgentIDNotIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldAgentID, vs...))
+}
+
+// AgentIDGT applies the GT predicate on the "agent_id" field.
+func AgentIDGT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldAgentID, v))
+}
+
+// AgentIDGTE applies the GTE predicate on the "agent_id" field.
+func AgentIDGTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldAgentID, v))
+}
+
+// AgentIDLT applies the LT predicate on the "agent_id" field.
+func AgentIDLT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldAgentID, v))
+}
+
+// AgentIDLTE applies the LTE predicate on the "agent_id" field.
+func AgentIDLTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldAgentID, v))
+}
+
+// AgentIDContains applies the Contains predicate on the "agent_id" field.
+func AgentIDContains(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContains(FieldAgentID, v))
+}
+
+// AgentIDHasPrefix applies the HasPrefix predicate on the "agent_id" field.
+func AgentIDHasPrefix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasPrefix(FieldAgentID, v))
+}
+
+// AgentIDHasSuffix applies the HasSuffix predicate on the "agent_id" field.
+func AgentIDHasSuffix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasSuffix(FieldAgentID, v))
+}
+
+// AgentIDIsNil applies the IsNil predicate on the "agent_id" field.
+func AgentIDIsNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIsNull(FieldAgentID))
+}
+
+// AgentIDNotNil applies the NotNil predicate on the "agent_id" field.
+func AgentIDNotNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotNull(FieldAgentID))
+}
+
+// AgentIDEqualFold applies the EqualFold predicate on the "agent_id" field.
+func AgentIDEqualFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEqualFold(FieldAgentID, v))
+}
+
+// AgentIDContainsFold applies the ContainsFold predicate on the "agent_id" field.
+func AgentIDContainsFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContainsFold(FieldAgentID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotNull(FieldUserID))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// ThreatIDEQ applies the EQ predicate on the "threat_id" field.
+func ThreatIDEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldThreatID, v))
+}
+
+// ThreatIDNEQ applies the NEQ predicate on the "threat_id" field.
+func ThreatIDNEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldThreatID, v))
+}
+
+// ThreatIDIn applies the In predicate on the "threat_id" field.
+func ThreatIDIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldThreatID, vs...))
+}
+
+// ThreatIDNotIn applies the NotIn predicate on the "threat_id" field.
+func ThreatIDNotIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldThreatID, vs...))
+}
+
+// ThreatIDGT applies the GT predicate on the "threat_id" field.
+func ThreatIDGT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldThreatID, v))
+}
+
+// ThreatIDGTE applies the GTE predicate on the "threat_id" field.
+func ThreatIDGTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldThreatID, v))
+}
+
+// ThreatIDLT applies the LT predicate on the "threat_id" field.
+func ThreatIDLT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldThreatID, v))
+}
+
+// ThreatIDLTE applies the LTE predicate on the "threat_id" field.
+func ThreatIDLTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldThreatID, v))
+}
+
+// ThreatIDContains applies the Contains predicate on the "threat_id" field.
+func ThreatIDContains(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContains(FieldThreatID, v))
+}
+
+// ThreatIDHasPrefix applies the HasPrefix predicate on the "threat_id" field.
+func ThreatIDHasPrefix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasPrefix(FieldThreatID, v))
+}
+
+// ThreatIDHasSuffix applies the HasSuffix predicate on the "threat_id" field.
+func ThreatIDHasSuffix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasSuffix(FieldThreatID, v))
+}
+
+// ThreatIDIsNil applies the IsNil predicate on the "threat_id" field.
+func ThreatIDIsNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIsNull(FieldThreatID))
+}
+
+// ThreatIDNotNil applies the NotNil predicate on the "threat_id" field.
+func ThreatIDNotNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotNull(FieldThreatID))
+}
+
+// ThreatIDEqualFold applies the EqualFold predicate on the "threat_id" field.
+func ThreatIDEqualFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEqualFold(FieldThreatID, v))
+}
+
+// ThreatIDContainsFold applies the ContainsFold predicate on the "threat_id" field.
+func ThreatIDContainsFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContainsFold(FieldThreatID, v))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashIsNil applies the IsNil predicate on the "hash" field.
+func HashIsNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIsNull(FieldHash))
+}
+
+// HashNotNil applies the NotNil predicate on the "hash" field.
+func HashNotNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotNull(FieldHash))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContainsFold(FieldHash, v))
+}
+
+// OsFamilyEQ applies the EQ predicate on the "os_family" field.
+func OsFamilyEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldOsFamily, v))
+}
+
+// OsFamilyNEQ applies the NEQ predicate on the "os_family" field.
+func OsFamilyNEQ(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldOsFamily, v))
+}
+
+// OsFamilyIn applies the In predicate on the "os_family" field.
+func OsFamilyIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldOsFamily, vs...))
+}
+
+// OsFamilyNotIn applies the NotIn predicate on the "os_family" field.
+func OsFamilyNotIn(vs ...string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldOsFamily, vs...))
+}
+
+// OsFamilyGT applies the GT predicate on the "os_family" field.
+func OsFamilyGT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldOsFamily, v))
+}
+
+// OsFamilyGTE applies the GTE predicate on the "os_family" field.
+func OsFamilyGTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldOsFamily, v))
+}
+
+// OsFamilyLT applies the LT predicate on the "os_family" field.
+func OsFamilyLT(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldOsFamily, v))
+}
+
+// OsFamilyLTE applies the LTE predicate on the "os_family" field.
+func OsFamilyLTE(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldOsFamily, v))
+}
+
+// OsFamilyContains applies the Contains predicate on the "os_family" field.
+func OsFamilyContains(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContains(FieldOsFamily, v))
+}
+
+// OsFamilyHasPrefix applies the HasPrefix predicate on the "os_family" field.
+func OsFamilyHasPrefix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasPrefix(FieldOsFamily, v))
+}
+
+// OsFamilyHasSuffix applies the HasSuffix predicate on the "os_family" field.
+func OsFamilyHasSuffix(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldHasSuffix(FieldOsFamily, v))
+}
+
+// OsFamilyIsNil applies the IsNil predicate on the "os_family" field.
+func OsFamilyIsNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIsNull(FieldOsFamily))
+}
+
+// OsFamilyNotNil applies the NotNil predicate on the "os_family" field.
+func OsFamilyNotNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotNull(FieldOsFamily))
+}
+
+// OsFamilyEqualFold applies the EqualFold predicate on the "os_family" field.
+func OsFamilyEqualFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEqualFold(FieldOsFamily, v))
+}
+
+// OsFamilyContainsFold applies the ContainsFold predicate on the "os_family" field.
+func OsFamilyContainsFold(v string) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldContainsFold(FieldOsFamily, v))
+}
+
+// APICreatedAtEQ applies the EQ predicate on the "api_created_at" field.
+func APICreatedAtEQ(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtNEQ applies the NEQ predicate on the "api_created_at" field.
+func APICreatedAtNEQ(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtIn applies the In predicate on the "api_created_at" field.
+func APICreatedAtIn(vs ...time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtNotIn applies the NotIn predicate on the "api_created_at" field.
+func APICreatedAtNotIn(vs ...time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtGT applies the GT predicate on the "api_created_at" field.
+func APICreatedAtGT(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtGTE applies the GTE predicate on the "api_created_at" field.
+func APICreatedAtGTE(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLT applies the LT predicate on the "api_created_at" field.
+func APICreatedAtLT(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLTE applies the LTE predicate on the "api_created_at" field.
+func APICreatedAtLTE(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldAPICreatedAt, v))
+}
+
+// APIUpdatedAtEQ applies the EQ predicate on the "api_updated_at" field.
+func APIUpdatedAtEQ(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldEQ(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtNEQ applies the NEQ predicate on the "api_updated_at" field.
+func APIUpdatedAtNEQ(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNEQ(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtIn applies the In predicate on the "api_updated_at" field.
+func APIUpdatedAtIn(vs ...time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIn(FieldAPIUpdatedAt, vs...))
+}
+
+// APIUpdatedAtNotIn applies the NotIn predicate on the "api_updated_at" field.
+func APIUpdatedAtNotIn(vs ...time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotIn(FieldAPIUpdatedAt, vs...))
+}
+
+// APIUpdatedAtGT applies the GT predicate on the "api_updated_at" field.
+func APIUpdatedAtGT(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGT(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtGTE applies the GTE predicate on the "api_updated_at" field.
+func APIUpdatedAtGTE(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldGTE(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtLT applies the LT predicate on the "api_updated_at" field.
+func APIUpdatedAtLT(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLT(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtLTE applies the LTE predicate on the "api_updated_at" field.
+func APIUpdatedAtLTE(v time.Time) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldLTE(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtIsNil applies the IsNil predicate on the "api_updated_at" field.
+func APIUpdatedAtIsNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIsNull(FieldAPIUpdatedAt))
+}
+
+// APIUpdatedAtNotNil applies the NotNil predicate on the "api_updated_at" field.
+func APIUpdatedAtNotNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotNull(FieldAPIUpdatedAt))
+}
+
+// DataJSONIsNil applies the IsNil predicate on the "data_json" field.
+func DataJSONIsNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldIsNull(FieldDataJSON))
+}
+
+// DataJSONNotNil applies the NotNil predicate on the "data_json" field.
+func DataJSONNotNil() predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.FieldNotNull(FieldDataJSON))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeS1Activity) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeS1Activity) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeS1Activity) predicate.BronzeS1Activity {
+	return predicate.BronzeS1Activity(sql.NotPredicates(p))
+}
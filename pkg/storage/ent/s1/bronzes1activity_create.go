@@ -0,0 +1,534 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1activity"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeS1ActivityCreate is the builder for creating a BronzeS1Activity entity.
+type BronzeS1ActivityCreate struct {
+	config
+	mutation *BronzeS1ActivityMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeS1ActivityCreate) SetCollectedAt(v time.Time) *BronzeS1ActivityCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeS1ActivityCreate) SetFirstCollectedAt(v time.Time) *BronzeS1ActivityCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetActivityType sets the "activity_type" field.
+func (_c *BronzeS1ActivityCreate) SetActivityType(v int) *BronzeS1ActivityCreate {
+	_c.mutation.SetActivityType(v)
+	return _c
+}
+
+// SetActivityUUID sets the "activity_uuid" field.
+func (_c *BronzeS1ActivityCreate) SetActivityUUID(v string) *BronzeS1ActivityCreate {
+	_c.mutation.SetActivityUUID(v)
+	return _c
+}
+
+// SetNillableActivityUUID sets the "activity_uuid" field if the given value is not nil.
+func (_c *BronzeS1ActivityCreate) SetNillableActivityUUID(v *string) *BronzeS1ActivityCreate {
+	if v != nil {
+		_c.SetActivityUUID(*v)
+	}
+	return _c
+}
+
+// SetPrimaryDescription sets the "primary_description" field.
+func (_c *BronzeS1ActivityCreate) SetPrimaryDescription(v string) *BronzeS1ActivityCreate {
+	_c.mutation.SetPrimaryDescription(v)
+	return _c
+}
+
+// SetNillablePrimaryDescription sets the "primary_description" field if the given value is not nil.
+func (_c *BronzeS1ActivityCreate) SetNillablePrimaryDescription(v *string) *BronzeS1ActivityCreate {
+	if v != nil {
+		_c.SetPrimaryDescription(*v)
+	}
+	return _c
+}
+
+// SetSecondaryDescription sets the "secondary_description" field.
+func (_c *BronzeS1ActivityCreate) SetSecondaryDescription(v string) *BronzeS1ActivityCreate {
+	_c.mutation.SetSecondaryDescription(v)
+	return _c
+}
+
+// SetNillableSecondaryDescription sets the "secondary_description" field if the given value is not nil.
+func (_c *BronzeS1ActivityCreate) SetNillableSecondaryDescription(v *string) *BronzeS1ActivityCreate {
+	if v != nil {
+		_c.SetSecondaryDescription(*v)
+	}
+	return _c
+}
+
+// SetComments sets the "comments" field.
+func (_c *BronzeS1ActivityCreate) SetComments(v string) *BronzeS1ActivityCreate {
+	_c.mutation.SetComments(v)
+	return _c
+}
+
+// SetNillableComments sets the "comments" field if the given value is not nil.
+func (_c *BronzeS1ActivityCreate) SetNillableComments(v *string) *BronzeS1ActivityCreate {
+	if v != nil {
+		_c.SetComments(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeS1ActivityCreate) SetAccountID(v string) *BronzeS1ActivityCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_c *BronzeS1ActivityCreate) SetNillableAccountID(v *string) *BronzeS1ActivityCreate {
+	if v != nil {
+		_c.SetAccountID(*v)
+	}
+	return _c
+}
+
+// SetAccountName sets the "account_name" field.
+func (_c *BronzeS1ActivityCreate) SetAccountName(v string) *BronzeS1ActivityCreate {
+	_c.mutation.SetAccountName(v)
+	return _c
+}
+
+// SetNillableAccountName sets the "account_name" field if the given value is not nil.
+func (_c *BronzeS1ActivityCreate) SetNillableAccountName(v *string) *BronzeS1ActivityCreate {
+	if v != nil {
+		_c.SetAccountName(*v)
+	}
+	return _c
+}
+
+// SetSiteID sets the "site_id" field.
+func (_c *BronzeS1ActivityCreate) SetSiteID(v string) *BronzeS1ActivityCreate {
+	_c.mutation.SetSiteID(v)
+	return _c
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_c *BronzeS1ActivityCreate) SetNillableSiteID(v *string) *BronzeS1ActivityCreate {
+	if v != nil {
+		_c.SetSiteID(*v)
+	}
+	return _c
+}
+
+// SetSiteName sets the "site_name" field.
+func (_c *BronzeS1ActivityCreate) SetSiteName(v string) *BronzeS1ActivityCreate {
+	_c.mutation.SetSiteName(v)
+	return _c
+}
+
+// SetNillableSiteName sets the "site_name" field if the given value is not nil.
+func (_c *BronzeS1ActivityCreate) SetNillableSiteName(v *string) *BronzeS1ActivityCreate {
+	if v != nil {
+		_c.SetSiteName(*v)
+	}
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *BronzeS1ActivityCreate) SetGroupID(v string) *BronzeS1ActivityCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_c *BronzeS1ActivityCreate) SetNillableGroupID(v *string) *BronzeS1ActivityCreate {
+	if v != nil {
+		_c.SetGroupID(*v)
+	}
+	return _c
+}
+
+// SetGroupName sets the "group_name" field.
+func (_c *BronzeS1ActivityCreate) SetGroupName(v string) *BronzeS1ActivityCreate {
+	_c.mutation.SetGroupName(v)
+	return _c
+}
+
+// SetNillableGroupName sets the "group_name" field if the given value is not nil.
+func (_c *BronzeS1ActivityCreate) SetNillableGroupName(v *string) *BronzeS1ActivityCreate {
+	if v != nil {
+		_c.SetGroupName(*v)
+	}
+	return _c
+}
+
+// SetAgentID sets the "agent_id" field.
+func (_c *BronzeS1ActivityCreate) SetAgentID(v string) *BronzeS1ActivityCreate {
+	_c.mutation.SetAgentID(v)
+	return _c
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (_c *BronzeS1ActivityCreate) SetNillableAgentID(v *string) *BronzeS1ActivityCreate {
+	if v != nil {
+		_c.SetAgentID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *BronzeS1ActivityCreate) SetUserID(v string) *BronzeS1ActivityCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *BronzeS1ActivityCreate) SetNillableUserID(v *string) *BronzeS1ActivityCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetThreatID sets the "threat_id" field.
+func (_c *BronzeS1ActivityCreate) SetThreatID(v string) *BronzeS1ActivityCreate {
+	_c.mutation.SetThreatID(v)
+	return _c
+}
+
+// SetNillableThreatID sets the "threat_id" field if the given value is not nil.
+func (_c *BronzeS1ActivityCreate) SetNillableThreatID(v *string) *BronzeS1ActivityCreate {
+	if v != nil {
+		_c.SetThreatID(*v)
+	}
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *BronzeS1ActivityCreate) SetHash(v string) *BronzeS1ActivityCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_c *BronzeS1ActivityCreate) SetNillableHash(v *string) *BronzeS1ActivityCreate {
+	if v != nil {
+		_c.SetHash(*v)
+	}
+	return _c
+}
+
+// SetOsFamily sets the "os_family" field.
+func (_c *BronzeS1ActivityCreate) SetOsFamily(v string) *BronzeS1ActivityCreate {
+	_c.mutation.SetOsFamily(v)
+	return _c
+}
+
+// SetNillableOsFamily sets the "os_family" field if the given value is not nil.
+func (_c *BronzeS1ActivityCreate) SetNillableOsFamily(v *string) *BronzeS1ActivityCreate {
+	if v != nil {
+		_c.SetOsFamily(*v)
+	}
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeS1ActivityCreate) SetAPICreatedAt(v time.Time) *BronzeS1ActivityCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_c *BronzeS1ActivityCreate) SetAPIUpdatedAt(v time.Time) *BronzeS1ActivityCreate {
+	_c.mutation.SetAPIUpdatedAt(v)
+	return _c
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_c *BronzeS1ActivityCreate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeS1ActivityCreate {
+	if v != nil {
+		_c.SetAPIUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDataJSON sets the "data_json" field.
+func (_c *BronzeS1ActivityCreate) SetDataJSON(v json.RawMessage) *BronzeS1ActivityCreate {
+	_c.mutation.SetDataJSON(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeS1ActivityCreate) SetID(v string) *BronzeS1ActivityCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeS1ActivityMutation object of the builder.
+func (_c *BronzeS1ActivityCreate) Mutation() *BronzeS1ActivityMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeS1Activity in the database.
+func (_c *BronzeS1ActivityCreate) Save(ctx context.Context) (*BronzeS1Activity, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeS1ActivityCreate) SaveX(ctx context.Context) *BronzeS1Activity {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeS1ActivityCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeS1ActivityCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeS1ActivityCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`s1: missing required field "BronzeS1Activity.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`s1: missing required field "BronzeS1Activity.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ActivityType(); !ok {
+		return &ValidationError{Name: "activity_type", err: errors.New(`s1: missing required field "BronzeS1Activity.activity_type"`)}
+	}
+	if _, ok := _c.mutation.APICreatedAt(); !ok {
+		return &ValidationError{Name: "api_created_at", err: errors.New(`s1: missing required field "BronzeS1Activity.api_created_at"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeS1ActivityCreate) sqlSave(ctx context.Context) (*BronzeS1Activity, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeS1Activity.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeS1ActivityCreate) createSpec() (*BronzeS1Activity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeS1Activity{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzes1activity.Table, sqlgraph.NewFieldSpec(bronzes1activity.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeS1Activity
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1activity.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzes1activity.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ActivityType(); ok {
+		_spec.SetField(bronzes1activity.FieldActivityType, field.TypeInt, value)
+		_node.ActivityType = value
+	}
+	if value, ok := _c.mutation.ActivityUUID(); ok {
+		_spec.SetField(bronzes1activity.FieldActivityUUID, field.TypeString, value)
+		_node.ActivityUUID = value
+	}
+	if value, ok := _c.mutation.PrimaryDescription(); ok {
+		_spec.SetField(bronzes1activity.FieldPrimaryDescription, field.TypeString, value)
+		_node.PrimaryDescription = value
+	}
+	if value, ok := _c.mutation.SecondaryDescription(); ok {
+		_spec.SetField(bronzes1activity.FieldSecondaryDescription, field.TypeString, value)
+		_node.SecondaryDescription = value
+	}
+	if value, ok := _c.mutation.Comments(); ok {
+		_spec.SetField(bronzes1activity.FieldComments, field.TypeString, value)
+		_node.Comments = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzes1activity.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.AccountName(); ok {
+		_spec.SetField(bronzes1activity.FieldAccountName, field.TypeString, value)
+		_node.AccountName = value
+	}
+	if value, ok := _c.mutation.SiteID(); ok {
+		_spec.SetField(bronzes1activity.FieldSiteID, field.TypeString, value)
+		_node.SiteID = value
+	}
+	if value, ok := _c.mutation.SiteName(); ok {
+		_spec.SetField(bronzes1activity.FieldSiteName, field.TypeString, value)
+		_node.SiteName = value
+	}
+	if value, ok := _c.mutation.GroupID(); ok {
+		_spec.SetField(bronzes1activity.FieldGroupID, field.TypeString, value)
+		_node.GroupID = value
+	}
+	if value, ok := _c.mutation.GroupName(); ok {
+		_spec.SetField(bronzes1activity.FieldGroupName, field.TypeString, value)
+		_node.GroupName = value
+	}
+	if value, ok := _c.mutation.AgentID(); ok {
+		_spec.SetField(bronzes1activity.FieldAgentID, field.TypeString, value)
+		_node.AgentID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(bronzes1activity.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.ThreatID(); ok {
+		_spec.SetField(bronzes1activity.FieldThreatID, field.TypeString, value)
+		_node.ThreatID = value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(bronzes1activity.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.OsFamily(); ok {
+		_spec.SetField(bronzes1activity.FieldOsFamily, field.TypeString, value)
+		_node.OsFamily = value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzes1activity.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = value
+	}
+	if value, ok := _c.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzes1activity.FieldAPIUpdatedAt, field.TypeTime, value)
+		_node.APIUpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DataJSON(); ok {
+		_spec.SetField(bronzes1activity.FieldDataJSON, field.TypeJSON, value)
+		_node.DataJSON = value
+	}
+	return _node, _spec
+}
+
+// BronzeS1ActivityCreateBulk is the builder for creating many BronzeS1Activity entities in bulk.
+type BronzeS1ActivityCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeS1ActivityCreate
+}
+
+// Save creates the BronzeS1Activity entities in the database.
+func (_c *BronzeS1ActivityCreateBulk) Save(ctx context.Context) ([]*BronzeS1Activity, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeS1Activity, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeS1ActivityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeS1ActivityCreateBulk) SaveX(ctx context.Context) []*BronzeS1Activity {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeS1ActivityCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeS1ActivityCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
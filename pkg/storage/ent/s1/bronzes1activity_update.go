@@ -0,0 +1,1195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1activity"
+	"danny.vn/hotpot/pkg/storage/ent/s1/internal"
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeS1ActivityUpdate is the builder for updating BronzeS1Activity entities.
+type BronzeS1ActivityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeS1ActivityMutation
+}
+
+// Where appends a list predicates to the BronzeS1ActivityUpdate builder.
+func (_u *BronzeS1ActivityUpdate) Where(ps ...predicate.BronzeS1Activity) *BronzeS1ActivityUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeS1ActivityUpdate) SetCollectedAt(v time.Time) *BronzeS1ActivityUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdate) SetNillableCollectedAt(v *time.Time) *BronzeS1ActivityUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetActivityType sets the "activity_type" field.
+func (_u *BronzeS1ActivityUpdate) SetActivityType(v int) *BronzeS1ActivityUpdate {
+	_u.mutation.ResetActivityType()
+	_u.mutation.SetActivityType(v)
+	return _u
+}
+
+// SetNillableActivityType sets the "activity_type" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdate) SetNillableActivityType(v *int) *BronzeS1ActivityUpdate {
+	if v != nil {
+		_u.SetActivityType(*v)
+	}
+	return _u
+}
+
+// AddActivityType adds value to the "activity_type" field.
+func (_u *BronzeS1ActivityUpdate) AddActivityType(v int) *BronzeS1ActivityUpdate {
+	_u.mutation.AddActivityType(v)
+	return _u
+}
+
+// SetActivityUUID sets the "activity_uuid" field.
+func (_u *BronzeS1ActivityUpdate) SetActivityUUID(v string) *BronzeS1ActivityUpdate {
+	_u.mutation.SetActivityUUID(v)
+	return _u
+}
+
+// SetNillableActivityUUID sets the "activity_uuid" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdate) SetNillableActivityUUID(v *string) *BronzeS1ActivityUpdate {
+	if v != nil {
+		_u.SetActivityUUID(*v)
+	}
+	return _u
+}
+
+// ClearActivityUUID clears the value of the "activity_uuid" field.
+func (_u *BronzeS1ActivityUpdate) ClearActivityUUID() *BronzeS1ActivityUpdate {
+	_u.mutation.ClearActivityUUID()
+	return _u
+}
+
+// SetPrimaryDescription sets the "primary_description" field.
+func (_u *BronzeS1ActivityUpdate) SetPrimaryDescription(v string) *BronzeS1ActivityUpdate {
+	_u.mutation.SetPrimaryDescription(v)
+	return _u
+}
+
+// SetNillablePrimaryDescription sets the "primary_description" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdate) SetNillablePrimaryDescription(v *string) *BronzeS1ActivityUpdate {
+	if v != nil {
+		_u.SetPrimaryDescription(*v)
+	}
+	return _u
+}
+
+// ClearPrimaryDescription clears the value of the "primary_description" field.
+func (_u *BronzeS1ActivityUpdate) ClearPrimaryDescription() *BronzeS1ActivityUpdate {
+	_u.mutation.ClearPrimaryDescription()
+	return _u
+}
+
+// SetSecondaryDescription sets the "secondary_description" field.
+func (_u *BronzeS1ActivityUpdate) SetSecondaryDescription(v string) *BronzeS1ActivityUpdate {
+	_u.mutation.SetSecondaryDescription(v)
+	return _u
+}
+
+// SetNillableSecondaryDescription sets the "secondary_description" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdate) SetNillableSecondaryDescription(v *string) *BronzeS1ActivityUpdate {
+	if v != nil {
+		_u.SetSecondaryDescription(*v)
+	}
+	return _u
+}
+
+// ClearSecondaryDescription clears the value of the "secondary_description" field.
+func (_u *BronzeS1ActivityUpdate) ClearSecondaryDescription() *BronzeS1ActivityUpdate {
+	_u.mutation.ClearSecondaryDescription()
+	return _u
+}
+
+// SetComments sets the "comments" field.
+func (_u *BronzeS1ActivityUpdate) SetComments(v string) *BronzeS1ActivityUpdate {
+	_u.mutation.SetComments(v)
+	return _u
+}
+
+// SetNillableComments sets the "comments" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdate) SetNillableComments(v *string) *BronzeS1ActivityUpdate {
+	if v != nil {
+		_u.SetComments(*v)
+	}
+	return _u
+}
+
+// ClearComments clears the value of the "comments" field.
+func (_u *BronzeS1ActivityUpdate) ClearComments() *BronzeS1ActivityUpdate {
+	_u.mutation.ClearComments()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeS1ActivityUpdate) SetAccountID(v string) *BronzeS1ActivityUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdate) SetNillableAccountID(v *string) *BronzeS1ActivityUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *BronzeS1ActivityUpdate) ClearAccountID() *BronzeS1ActivityUpdate {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetAccountName sets the "account_name" field.
+func (_u *BronzeS1ActivityUpdate) SetAccountName(v string) *BronzeS1ActivityUpdate {
+	_u.mutation.SetAccountName(v)
+	return _u
+}
+
+// SetNillableAccountName sets the "account_name" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdate) SetNillableAccountName(v *string) *BronzeS1ActivityUpdate {
+	if v != nil {
+		_u.SetAccountName(*v)
+	}
+	return _u
+}
+
+// ClearAccountName clears the value of the "account_name" field.
+func (_u *BronzeS1ActivityUpdate) ClearAccountName() *BronzeS1ActivityUpdate {
+	_u.mutation.ClearAccountName()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeS1ActivityUpdate) SetSiteID(v string) *BronzeS1ActivityUpdate {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdate) SetNillableSiteID(v *string) *BronzeS1ActivityUpdate {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeS1ActivityUpdate) ClearSiteID() *BronzeS1ActivityUpdate {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetSiteName sets the "site_name" field.
+func (_u *BronzeS1ActivityUpdate) SetSiteName(v string) *BronzeS1ActivityUpdate {
+	_u.mutation.SetSiteName(v)
+	return _u
+}
+
+// SetNillableSiteName sets the "site_name" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdate) SetNillableSiteName(v *string) *BronzeS1ActivityUpdate {
+	if v != nil {
+		_u.SetSiteName(*v)
+	}
+	return _u
+}
+
+// ClearSiteName clears the value of the "site_name" field.
+func (_u *BronzeS1ActivityUpdate) ClearSiteName() *BronzeS1ActivityUpdate {
+	_u.mutation.ClearSiteName()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeS1ActivityUpdate) SetGroupID(v string) *BronzeS1ActivityUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdate) SetNillableGroupID(v *string) *BronzeS1ActivityUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeS1ActivityUpdate) ClearGroupID() *BronzeS1ActivityUpdate {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetGroupName sets the "group_name" field.
+func (_u *BronzeS1ActivityUpdate) SetGroupName(v string) *BronzeS1ActivityUpdate {
+	_u.mutation.SetGroupName(v)
+	return _u
+}
+
+// SetNillableGroupName sets the "group_name" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdate) SetNillableGroupName(v *string) *BronzeS1ActivityUpdate {
+	if v != nil {
+		_u.SetGroupName(*v)
+	}
+	return _u
+}
+
+// ClearGroupName clears the value of the "group_name" field.
+func (_u *BronzeS1ActivityUpdate) ClearGroupName() *BronzeS1ActivityUpdate {
+	_u.mutation.ClearGroupName()
+	return _u
+}
+
+// SetAgentID sets the "agent_id" field.
+func (_u *BronzeS1ActivityUpdate) SetAgentID(v string) *BronzeS1ActivityUpdate {
+	_u.mutation.SetAgentID(v)
+	return _u
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdate) SetNillableAgentID(v *string) *BronzeS1ActivityUpdate {
+	if v != nil {
+		_u.SetAgentID(*v)
+	}
+	return _u
+}
+
+// ClearAgentID clears the value of the "agent_id" field.
+func (_u *BronzeS1ActivityUpdate) ClearAgentID() *BronzeS1ActivityUpdate {
+	_u.mutation.ClearAgentID()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BronzeS1ActivityUpdate) SetUserID(v string) *BronzeS1ActivityUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdate) SetNillableUserID(v *string) *BronzeS1ActivityUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *BronzeS1ActivityUpdate) ClearUserID() *BronzeS1ActivityUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetThreatID sets the "threat_id" field.
+func (_u *BronzeS1ActivityUpdate) SetThreatID(v string) *BronzeS1ActivityUpdate {
+	_u.mutation.SetThreatID(v)
+	return _u
+}
+
+// SetNillableThreatID sets the "threat_id" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdate) SetNillableThreatID(v *string) *BronzeS1ActivityUpdate {
+	if v != nil {
+		_u.SetThreatID(*v)
+	}
+	return _u
+}
+
+// ClearThreatID clears the value of the "threat_id" field.
+func (_u *BronzeS1ActivityUpdate) ClearThreatID() *BronzeS1ActivityUpdate {
+	_u.mutation.ClearThreatID()
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *BronzeS1ActivityUpdate) SetHash(v string) *BronzeS1ActivityUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdate) SetNillableHash(v *string) *BronzeS1ActivityUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// ClearHash clears the value of the "hash" field.
+func (_u *BronzeS1ActivityUpdate) ClearHash() *BronzeS1ActivityUpdate {
+	_u.mutation.ClearHash()
+	return _u
+}
+
+// SetOsFamily sets the "os_family" field.
+func (_u *BronzeS1ActivityUpdate) SetOsFamily(v string) *BronzeS1ActivityUpdate {
+	_u.mutation.SetOsFamily(v)
+	return _u
+}
+
+// SetNillableOsFamily sets the "os_family" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdate) SetNillableOsFamily(v *string) *BronzeS1ActivityUpdate {
+	if v != nil {
+		_u.SetOsFamily(*v)
+	}
+	return _u
+}
+
+// ClearOsFamily clears the value of the "os_family" field.
+func (_u *BronzeS1ActivityUpdate) ClearOsFamily() *BronzeS1ActivityUpdate {
+	_u.mutation.ClearOsFamily()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeS1ActivityUpdate) SetAPICreatedAt(v time.Time) *BronzeS1ActivityUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeS1ActivityUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeS1ActivityUpdate) SetAPIUpdatedAt(v time.Time) *BronzeS1ActivityUpdate {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeS1ActivityUpdate {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeS1ActivityUpdate) ClearAPIUpdatedAt() *BronzeS1ActivityUpdate {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// SetDataJSON sets the "data_json" field.
+func (_u *BronzeS1ActivityUpdate) SetDataJSON(v json.RawMessage) *BronzeS1ActivityUpdate {
+	_u.mutation.SetDataJSON(v)
+	return _u
+}
+
+// AppendDataJSON appends value to the "data_json" field.
+func (_u *BronzeS1ActivityUpdate) AppendDataJSON(v json.RawMessage) *BronzeS1ActivityUpdate {
+	_u.mutation.AppendDataJSON(v)
+	return _u
+}
+
+// ClearDataJSON clears the value of the "data_json" field.
+func (_u *BronzeS1ActivityUpdate) ClearDataJSON() *BronzeS1ActivityUpdate {
+	_u.mutation.ClearDataJSON()
+	return _u
+}
+
+// Mutation returns the BronzeS1ActivityMutation object of the builder.
+func (_u *BronzeS1ActivityUpdate) Mutation() *BronzeS1ActivityMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeS1ActivityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeS1ActivityUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeS1ActivityUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeS1ActivityUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BronzeS1ActivityUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bronzes1activity.Table, bronzes1activity.Columns, sqlgraph.NewFieldSpec(bronzes1activity.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1activity.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ActivityType(); ok {
+		_spec.SetField(bronzes1activity.FieldActivityType, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedActivityType(); ok {
+		_spec.AddField(bronzes1activity.FieldActivityType, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ActivityUUID(); ok {
+		_spec.SetField(bronzes1activity.FieldActivityUUID, field.TypeString, value)
+	}
+	if _u.mutation.ActivityUUIDCleared() {
+		_spec.ClearField(bronzes1activity.FieldActivityUUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrimaryDescription(); ok {
+		_spec.SetField(bronzes1activity.FieldPrimaryDescription, field.TypeString, value)
+	}
+	if _u.mutation.PrimaryDescriptionCleared() {
+		_spec.ClearField(bronzes1activity.FieldPrimaryDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecondaryDescription(); ok {
+		_spec.SetField(bronzes1activity.FieldSecondaryDescription, field.TypeString, value)
+	}
+	if _u.mutation.SecondaryDescriptionCleared() {
+		_spec.ClearField(bronzes1activity.FieldSecondaryDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Comments(); ok {
+		_spec.SetField(bronzes1activity.FieldComments, field.TypeString, value)
+	}
+	if _u.mutation.CommentsCleared() {
+		_spec.ClearField(bronzes1activity.FieldComments, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzes1activity.FieldAccountID, field.TypeString, value)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(bronzes1activity.FieldAccountID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccountName(); ok {
+		_spec.SetField(bronzes1activity.FieldAccountName, field.TypeString, value)
+	}
+	if _u.mutation.AccountNameCleared() {
+		_spec.ClearField(bronzes1activity.FieldAccountName, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzes1activity.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzes1activity.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteName(); ok {
+		_spec.SetField(bronzes1activity.FieldSiteName, field.TypeString, value)
+	}
+	if _u.mutation.SiteNameCleared() {
+		_spec.ClearField(bronzes1activity.FieldSiteName, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzes1activity.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzes1activity.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupName(); ok {
+		_spec.SetField(bronzes1activity.FieldGroupName, field.TypeString, value)
+	}
+	if _u.mutation.GroupNameCleared() {
+		_spec.ClearField(bronzes1activity.FieldGroupName, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentID(); ok {
+		_spec.SetField(bronzes1activity.FieldAgentID, field.TypeString, value)
+	}
+	if _u.mutation.AgentIDCleared() {
+		_spec.ClearField(bronzes1activity.FieldAgentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(bronzes1activity.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(bronzes1activity.FieldUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ThreatID(); ok {
+		_spec.SetField(bronzes1activity.FieldThreatID, field.TypeString, value)
+	}
+	if _u.mutation.ThreatIDCleared() {
+		_spec.ClearField(bronzes1activity.FieldThreatID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(bronzes1activity.FieldHash, field.TypeString, value)
+	}
+	if _u.mutation.HashCleared() {
+		_spec.ClearField(bronzes1activity.FieldHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsFamily(); ok {
+		_spec.SetField(bronzes1activity.FieldOsFamily, field.TypeString, value)
+	}
+	if _u.mutation.OsFamilyCleared() {
+		_spec.ClearField(bronzes1activity.FieldOsFamily, field.TypeString)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzes1activity.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzes1activity.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzes1activity.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DataJSON(); ok {
+		_spec.SetField(bronzes1activity.FieldDataJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDataJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzes1activity.FieldDataJSON, value)
+		})
+	}
+	if _u.mutation.DataJSONCleared() {
+		_spec.ClearField(bronzes1activity.FieldDataJSON, field.TypeJSON)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeS1Activity
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzes1activity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeS1ActivityUpdateOne is the builder for updating a single BronzeS1Activity entity.
+type BronzeS1ActivityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeS1ActivityMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeS1ActivityUpdateOne) SetCollectedAt(v time.Time) *BronzeS1ActivityUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeS1ActivityUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetActivityType sets the "activity_type" field.
+func (_u *BronzeS1ActivityUpdateOne) SetActivityType(v int) *BronzeS1ActivityUpdateOne {
+	_u.mutation.ResetActivityType()
+	_u.mutation.SetActivityType(v)
+	return _u
+}
+
+// SetNillableActivityType sets the "activity_type" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdateOne) SetNillableActivityType(v *int) *BronzeS1ActivityUpdateOne {
+	if v != nil {
+		_u.SetActivityType(*v)
+	}
+	return _u
+}
+
+// AddActivityType adds value to the "activity_type" field.
+func (_u *BronzeS1ActivityUpdateOne) AddActivityType(v int) *BronzeS1ActivityUpdateOne {
+	_u.mutation.AddActivityType(v)
+	return _u
+}
+
+// SetActivityUUID sets the "activity_uuid" field.
+func (_u *BronzeS1ActivityUpdateOne) SetActivityUUID(v string) *BronzeS1ActivityUpdateOne {
+	_u.mutation.SetActivityUUID(v)
+	return _u
+}
+
+// SetNillableActivityUUID sets the "activity_uuid" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdateOne) SetNillableActivityUUID(v *string) *BronzeS1ActivityUpdateOne {
+	if v != nil {
+		_u.SetActivityUUID(*v)
+	}
+	return _u
+}
+
+// ClearActivityUUID clears the value of the "activity_uuid" field.
+func (_u *BronzeS1ActivityUpdateOne) ClearActivityUUID() *BronzeS1ActivityUpdateOne {
+	_u.mutation.ClearActivityUUID()
+	return _u
+}
+
+// SetPrimaryDescription sets the "primary_description" field.
+func (_u *BronzeS1ActivityUpdateOne) SetPrimaryDescription(v string) *BronzeS1ActivityUpdateOne {
+	_u.mutation.SetPrimaryDescription(v)
+	return _u
+}
+
+// SetNillablePrimaryDescription sets the "primary_description" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdateOne) SetNillablePrimaryDescription(v *string) *BronzeS1ActivityUpdateOne {
+	if v != nil {
+		_u.SetPrimaryDescription(*v)
+	}
+	return _u
+}
+
+// ClearPrimaryDescription clears the value of the "primary_description" field.
+func (_u *BronzeS1ActivityUpdateOne) ClearPrimaryDescription() *BronzeS1ActivityUpdateOne {
+	_u.mutation.ClearPrimaryDescription()
+	return _u
+}
+
+// SetSecondaryDescription sets the "secondary_description" field.
+func (_u *BronzeS1ActivityUpdateOne) SetSecondaryDescription(v string) *BronzeS1ActivityUpdateOne {
+	_u.mutation.SetSecondaryDescription(v)
+	return _u
+}
+
+// SetNillableSecondaryDescription sets the "secondary_description" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdateOne) SetNillableSecondaryDescription(v *string) *BronzeS1ActivityUpdateOne {
+	if v != nil {
+		_u.SetSecondaryDescription(*v)
+	}
+	return _u
+}
+
+// ClearSecondaryDescription clears the value of the "secondary_description" field.
+func (_u *BronzeS1ActivityUpdateOne) ClearSecondaryDescription() *BronzeS1ActivityUpdateOne {
+	_u.mutation.ClearSecondaryDescription()
+	return _u
+}
+
+// SetComments sets the "comments" field.
+func (_u *BronzeS1ActivityUpdateOne) SetComments(v string) *BronzeS1ActivityUpdateOne {
+	_u.mutation.SetComments(v)
+	return _u
+}
+
+// SetNillableComments sets the "comments" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdateOne) SetNillableComments(v *string) *BronzeS1ActivityUpdateOne {
+	if v != nil {
+		_u.SetComments(*v)
+	}
+	return _u
+}
+
+// ClearComments clears the value of the "comments" field.
+func (_u *BronzeS1ActivityUpdateOne) ClearComments() *BronzeS1ActivityUpdateOne {
+	_u.mutation.ClearComments()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeS1ActivityUpdateOne) SetAccountID(v string) *BronzeS1ActivityUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdateOne) SetNillableAccountID(v *string) *BronzeS1ActivityUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *BronzeS1ActivityUpdateOne) ClearAccountID() *BronzeS1ActivityUpdateOne {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetAccountName sets the "account_name" field.
+func (_u *BronzeS1ActivityUpdateOne) SetAccountName(v string) *BronzeS1ActivityUpdateOne {
+	_u.mutation.SetAccountName(v)
+	return _u
+}
+
+// SetNillableAccountName sets the "account_name" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdateOne) SetNillableAccountName(v *string) *BronzeS1ActivityUpdateOne {
+	if v != nil {
+		_u.SetAccountName(*v)
+	}
+	return _u
+}
+
+// ClearAccountName clears the value of the "account_name" field.
+func (_u *BronzeS1ActivityUpdateOne) ClearAccountName() *BronzeS1ActivityUpdateOne {
+	_u.mutation.ClearAccountName()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeS1ActivityUpdateOne) SetSiteID(v string) *BronzeS1ActivityUpdateOne {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdateOne) SetNillableSiteID(v *string) *BronzeS1ActivityUpdateOne {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeS1ActivityUpdateOne) ClearSiteID() *BronzeS1ActivityUpdateOne {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetSiteName sets the "site_name" field.
+func (_u *BronzeS1ActivityUpdateOne) SetSiteName(v string) *BronzeS1ActivityUpdateOne {
+	_u.mutation.SetSiteName(v)
+	return _u
+}
+
+// SetNillableSiteName sets the "site_name" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdateOne) SetNillableSiteName(v *string) *BronzeS1ActivityUpdateOne {
+	if v != nil {
+		_u.SetSiteName(*v)
+	}
+	return _u
+}
+
+// ClearSiteName clears the value of the "site_name" field.
+func (_u *BronzeS1ActivityUpdateOne) ClearSiteName() *BronzeS1ActivityUpdateOne {
+	_u.mutation.ClearSiteName()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeS1ActivityUpdateOne) SetGroupID(v string) *BronzeS1ActivityUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdateOne) SetNillableGroupID(v *string) *BronzeS1ActivityUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeS1ActivityUpdateOne) ClearGroupID() *BronzeS1ActivityUpdateOne {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetGroupName sets the "group_name" field.
+func (_u *BronzeS1ActivityUpdateOne) SetGroupName(v string) *BronzeS1ActivityUpdateOne {
+	_u.mutation.SetGroupName(v)
+	return _u
+}
+
+// SetNillableGroupName sets the "group_name" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdateOne) SetNillableGroupName(v *string) *BronzeS1ActivityUpdateOne {
+	if v != nil {
+		_u.SetGroupName(*v)
+	}
+	return _u
+}
+
+// ClearGroupName clears the value of the "group_name" field.
+func (_u *BronzeS1ActivityUpdateOne) ClearGroupName() *BronzeS1ActivityUpdateOne {
+	_u.mutation.ClearGroupName()
+	return _u
+}
+
+// SetAgentID sets the "agent_id" field.
+func (_u *BronzeS1ActivityUpdateOne) SetAgentID(v string) *BronzeS1ActivityUpdateOne {
+	_u.mutation.SetAgentID(v)
+	return _u
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdateOne) SetNillableAgentID(v *string) *BronzeS1ActivityUpdateOne {
+	if v != nil {
+		_u.SetAgentID(*v)
+	}
+	return _u
+}
+
+// ClearAgentID clears the value of the "agent_id" field.
+func (_u *BronzeS1ActivityUpdateOne) ClearAgentID() *BronzeS1ActivityUpdateOne {
+	_u.mutation.ClearAgentID()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BronzeS1ActivityUpdateOne) SetUserID(v string) *BronzeS1ActivityUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdateOne) SetNillableUserID(v *string) *BronzeS1ActivityUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *BronzeS1ActivityUpdateOne) ClearUserID() *BronzeS1ActivityUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetThreatID sets the "threat_id" field.
+func (_u *BronzeS1ActivityUpdateOne) SetThreatID(v string) *BronzeS1ActivityUpdateOne {
+	_u.mutation.SetThreatID(v)
+	return _u
+}
+
+// SetNillableThreatID sets the "threat_id" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdateOne) SetNillableThreatID(v *string) *BronzeS1ActivityUpdateOne {
+	if v != nil {
+		_u.SetThreatID(*v)
+	}
+	return _u
+}
+
+// ClearThreatID clears the value of the "threat_id" field.
+func (_u *BronzeS1ActivityUpdateOne) ClearThreatID() *BronzeS1ActivityUpdateOne {
+	_u.mutation.ClearThreatID()
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *BronzeS1ActivityUpdateOne) SetHash(v string) *BronzeS1ActivityUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdateOne) SetNillableHash(v *string) *BronzeS1ActivityUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// ClearHash clears the value of the "hash" field.
+func (_u *BronzeS1ActivityUpdateOne) ClearHash() *BronzeS1ActivityUpdateOne {
+	_u.mutation.ClearHash()
+	return _u
+}
+
+// SetOsFamily sets the "os_family" field.
+func (_u *BronzeS1ActivityUpdateOne) SetOsFamily(v string) *BronzeS1ActivityUpdateOne {
+	_u.mutation.SetOsFamily(v)
+	return _u
+}
+
+// SetNillableOsFamily sets the "os_family" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdateOne) SetNillableOsFamily(v *string) *BronzeS1ActivityUpdateOne {
+	if v != nil {
+		_u.SetOsFamily(*v)
+	}
+	return _u
+}
+
+// ClearOsFamily clears the value of the "os_family" field.
+func (_u *BronzeS1ActivityUpdateOne) ClearOsFamily() *BronzeS1ActivityUpdateOne {
+	_u.mutation.ClearOsFamily()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeS1ActivityUpdateOne) SetAPICreatedAt(v time.Time) *BronzeS1ActivityUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeS1ActivityUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeS1ActivityUpdateOne) SetAPIUpdatedAt(v time.Time) *BronzeS1ActivityUpdateOne {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeS1ActivityUpdateOne) SetNillableAPIUpdatedAt(v *time.Time) *BronzeS1ActivityUpdateOne {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeS1ActivityUpdateOne) ClearAPIUpdatedAt() *BronzeS1ActivityUpdateOne {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// SetDataJSON sets the "data_json" field.
+func (_u *BronzeS1ActivityUpdateOne) SetDataJSON(v json.RawMessage) *BronzeS1ActivityUpdateOne {
+	_u.mutation.SetDataJSON(v)
+	return _u
+}
+
+// AppendDataJSON appends value to the "data_json" field.
+func (_u *BronzeS1ActivityUpdateOne) AppendDataJSON(v json.RawMessage) *BronzeS1ActivityUpdateOne {
+	_u.mutation.AppendDataJSON(v)
+	return _u
+}
+
+// ClearDataJSON clears the value of the "data_json" field.
+func (_u *BronzeS1ActivityUpdateOne) ClearDataJSON() *BronzeS1ActivityUpdateOne {
+	_u.mutation.ClearDataJSON()
+	return _u
+}
+
+// Mutation returns the BronzeS1ActivityMutation object of the builder.
+func (_u *BronzeS1ActivityUpdateOne) Mutation() *BronzeS1ActivityMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeS1ActivityUpdate builder.
+func (_u *BronzeS1ActivityUpdateOne) Where(ps ...predicate.BronzeS1Activity) *BronzeS1ActivityUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeS1ActivityUpdateOne) Select(field string, fields ...string) *BronzeS1ActivityUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeS1Activity entity.
+func (_u *BronzeS1ActivityUpdateOne) Save(ctx context.Context) (*BronzeS1Activity, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeS1ActivityUpdateOne) SaveX(ctx context.Context) *BronzeS1Activity {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeS1ActivityUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeS1ActivityUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BronzeS1ActivityUpdateOne) sqlSave(ctx context.Context) (_node *BronzeS1Activity, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bronzes1activity.Table, bronzes1activity.Columns, sqlgraph.NewFieldSpec(bronzes1activity.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`s1: missing "BronzeS1Activity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzes1activity.FieldID)
+		for _, f := range fields {
+			if !bronzes1activity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("s1: invalid field %q for query", f)}
+			}
+			if f != bronzes1activity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1activity.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ActivityType(); ok {
+		_spec.SetField(bronzes1activity.FieldActivityType, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedActivityType(); ok {
+		_spec.AddField(bronzes1activity.FieldActivityType, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ActivityUUID(); ok {
+		_spec.SetField(bronzes1activity.FieldActivityUUID, field.TypeString, value)
+	}
+	if _u.mutation.ActivityUUIDCleared() {
+		_spec.ClearField(bronzes1activity.FieldActivityUUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrimaryDescription(); ok {
+		_spec.SetField(bronzes1activity.FieldPrimaryDescription, field.TypeString, value)
+	}
+	if _u.mutation.PrimaryDescriptionCleared() {
+		_spec.ClearField(bronzes1activity.FieldPrimaryDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecondaryDescription(); ok {
+		_spec.SetField(bronzes1activity.FieldSecondaryDescription, field.TypeString, value)
+	}
+	if _u.mutation.SecondaryDescriptionCleared() {
+		_spec.ClearField(bronzes1activity.FieldSecondaryDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Comments(); ok {
+		_spec.SetField(bronzes1activity.FieldComments, field.TypeString, value)
+	}
+	if _u.mutation.CommentsCleared() {
+		_spec.ClearField(bronzes1activity.FieldComments, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzes1activity.FieldAccountID, field.TypeString, value)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(bronzes1activity.FieldAccountID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccountName(); ok {
+		_spec.SetField(bronzes1activity.FieldAccountName, field.TypeString, value)
+	}
+	if _u.mutation.AccountNameCleared() {
+		_spec.ClearField(bronzes1activity.FieldAccountName, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzes1activity.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzes1activity.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteName(); ok {
+		_spec.SetField(bronzes1activity.FieldSiteName, field.TypeString, value)
+	}
+	if _u.mutation.SiteNameCleared() {
+		_spec.ClearField(bronzes1activity.FieldSiteName, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzes1activity.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzes1activity.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupName(); ok {
+		_spec.SetField(bronzes1activity.FieldGroupName, field.TypeString, value)
+	}
+	if _u.mutation.GroupNameCleared() {
+		_spec.ClearField(bronzes1activity.FieldGroupName, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentID(); ok {
+		_spec.SetField(bronzes1activity.FieldAgentID, field.TypeString, value)
+	}
+	if _u.mutation.AgentIDCleared() {
+		_spec.ClearField(bronzes1activity.FieldAgentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(bronzes1activity.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(bronzes1activity.FieldUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ThreatID(); ok {
+		_spec.SetField(bronzes1activity.FieldThreatID, field.TypeString, value)
+	}
+	if _u.mutation.ThreatIDCleared() {
+		_spec.ClearField(bronzes1activity.FieldThreatID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(bronzes1activity.FieldHash, field.TypeString, value)
+	}
+	if _u.mutation.HashCleared() {
+		_spec.ClearField(bronzes1activity.FieldHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsFamily(); ok {
+		_spec.SetField(bronzes1activity.FieldOsFamily, field.TypeString, value)
+	}
+	if _u.mutation.OsFamilyCleared() {
+		_spec.ClearField(bronzes1activity.FieldOsFamily, field.TypeString)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzes1activity.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzes1activity.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzes1activity.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DataJSON(); ok {
+		_spec.SetField(bronzes1activity.FieldDataJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDataJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzes1activity.FieldDataJSON, value)
+		})
+	}
+	if _u.mutation.DataJSONCleared() {
+		_spec.ClearField(bronzes1activity.FieldDataJSON, field.TypeJSON)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeS1Activity
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeS1Activity{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzes1activity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
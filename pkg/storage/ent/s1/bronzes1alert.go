@@ -0,0 +1,387 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1alert"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeS1Alert is the model entity for the BronzeS1Alert schema.
+type BronzeS1Alert struct {
+	config `json:"-"`
+	// ID of the ent.
+	// SentinelOne alert ID
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// SentinelOne agent ID; joins to s1_agents.resource_id
+	AgentID string `json:"agent_id,omitempty"`
+	// AgentUUID holds the value of the "agent_uuid" field.
+	AgentUUID string `json:"agent_uuid,omitempty"`
+	// AgentName holds the value of the "agent_name" field.
+	AgentName string `json:"agent_name,omitempty"`
+	// AgentOsName holds the value of the "agent_os_name" field.
+	AgentOsName string `json:"agent_os_name,omitempty"`
+	// SiteID holds the value of the "site_id" field.
+	SiteID string `json:"site_id,omitempty"`
+	// RuleID holds the value of the "rule_id" field.
+	RuleID string `json:"rule_id,omitempty"`
+	// RuleName holds the value of the "rule_name" field.
+	RuleName string `json:"rule_name,omitempty"`
+	// e.g. Low, Medium, High, Critical
+	RuleSeverity string `json:"rule_severity,omitempty"`
+	// RuleDescription holds the value of the "rule_description" field.
+	RuleDescription string `json:"rule_description,omitempty"`
+	// RuleScopeLevel holds the value of the "rule_scope_level" field.
+	RuleScopeLevel string `json:"rule_scope_level,omitempty"`
+	// RuleTreatAsThreat holds the value of the "rule_treat_as_threat" field.
+	RuleTreatAsThreat string `json:"rule_treat_as_threat,omitempty"`
+	// AnalystVerdict holds the value of the "analyst_verdict" field.
+	AnalystVerdict string `json:"analyst_verdict,omitempty"`
+	// IncidentStatus holds the value of the "incident_status" field.
+	IncidentStatus string `json:"incident_status,omitempty"`
+	// EventType holds the value of the "event_type" field.
+	EventType string `json:"event_type,omitempty"`
+	// HitType holds the value of the "hit_type" field.
+	HitType string `json:"hit_type,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// DvEventID holds the value of the "dv_event_id" field.
+	DvEventID string `json:"dv_event_id,omitempty"`
+	// IsEdr holds the value of the "is_edr" field.
+	IsEdr bool `json:"is_edr,omitempty"`
+	// ReportedAt holds the value of the "reported_at" field.
+	ReportedAt *time.Time `json:"reported_at,omitempty"`
+	// APICreatedAt holds the value of the "api_created_at" field.
+	APICreatedAt *time.Time `json:"api_created_at,omitempty"`
+	// APIUpdatedAt holds the value of the "api_updated_at" field.
+	APIUpdatedAt *time.Time `json:"api_updated_at,omitempty"`
+	// SourceProcessJSON holds the value of the "source_process_json" field.
+	SourceProcessJSON json.RawMessage `json:"source_process_json,omitempty"`
+	// TargetProcessJSON holds the value of the "target_process_json" field.
+	TargetProcessJSON json.RawMessage `json:"target_process_json,omitempty"`
+	selectValues      sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeS1Alert) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzes1alert.FieldSourceProcessJSON, bronzes1alert.FieldTargetProcessJSON:
+			values[i] = new([]byte)
+		case bronzes1alert.FieldIsEdr:
+			values[i] = new(sql.NullBool)
+		case bronzes1alert.FieldID, bronzes1alert.FieldAgentID, bronzes1alert.FieldAgentUUID, bronzes1alert.FieldAgentName, bronzes1alert.FieldAgentOsName, bronzes1alert.FieldSiteID, bronzes1alert.FieldRuleID, bronzes1alert.FieldRuleName, bronzes1alert.FieldRuleSeverity, bronzes1alert.FieldRuleDescription, bronzes1alert.FieldRuleScopeLevel, bronzes1alert.FieldRuleTreatAsThreat, bronzes1alert.FieldAnalystVerdict, bronzes1alert.FieldIncidentStatus, bronzes1alert.FieldEventType, bronzes1alert.FieldHitType, bronzes1alert.FieldSource, bronzes1alert.FieldDvEventID:
+			values[i] = new(sql.NullString)
+		case bronzes1alert.FieldCollectedAt, bronzes1alert.FieldFirstCollectedAt, bronzes1alert.FieldReportedAt, bronzes1alert.FieldAPICreatedAt, bronzes1alert.FieldAPIUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeS1Alert fields.
+func (_m *BronzeS1Alert) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzes1alert.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzes1alert.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzes1alert.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzes1alert.FieldAgentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_id", values[i])
+			} else if value.Valid {
+				_m.AgentID = value.String
+			}
+		case bronzes1alert.FieldAgentUUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_uuid", values[i])
+			} else if value.Valid {
+				_m.AgentUUID = value.String
+			}
+		case bronzes1alert.FieldAgentName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_name", values[i])
+			} else if value.Valid {
+				_m.AgentName = value.String
+			}
+		case bronzes1alert.FieldAgentOsName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_os_name", values[i])
+			} else if value.Valid {
+				_m.AgentOsName = value.String
+			}
+		case bronzes1alert.FieldSiteID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field site_id", values[i])
+			} else if value.Valid {
+				_m.SiteID = value.String
+			}
+		case bronzes1alert.FieldRuleID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_id", values[i])
+			} else if value.Valid {
+				_m.RuleID = value.String
+			}
+		case bronzes1alert.FieldRuleName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_name", values[i])
+			} else if value.Valid {
+				_m.RuleName = value.String
+			}
+		case bronzes1alert.FieldRuleSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_severity", values[i])
+			} else if value.Valid {
+				_m.RuleSeverity = value.String
+			}
+		case bronzes1alert.FieldRuleDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_description", values[i])
+			} else if value.Valid {
+				_m.RuleDescription = value.String
+			}
+		case bronzes1alert.FieldRuleScopeLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_scope_level", values[i])
+			} else if value.Valid {
+				_m.RuleScopeLevel = value.String
+			}
+		case bronzes1alert.FieldRuleTreatAsThreat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_treat_as_threat", values[i])
+			} else if value.Valid {
+				_m.RuleTreatAsThreat = value.String
+			}
+		case bronzes1alert.FieldAnalystVerdict:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field analyst_verdict", values[i])
+			} else if value.Valid {
+				_m.AnalystVerdict = value.String
+			}
+		case bronzes1alert.FieldIncidentStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field incident_status", values[i])
+			} else if value.Valid {
+				_m.IncidentStatus = value.String
+			}
+		case bronzes1alert.FieldEventType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				_m.EventType = value.String
+			}
+		case bronzes1alert.FieldHitType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hit_type", values[i])
+			} else if value.Valid {
+				_m.HitType = value.String
+			}
+		case bronzes1alert.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case bronzes1alert.FieldDvEventID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dv_event_id", values[i])
+			} else if value.Valid {
+				_m.DvEventID = value.String
+			}
+		case bronzes1alert.FieldIsEdr:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_edr", values[i])
+			} else if value.Valid {
+				_m.IsEdr = value.Bool
+			}
+		case bronzes1alert.FieldReportedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reported_at", values[i])
+			} else if value.Valid {
+				_m.ReportedAt = new(time.Time)
+				*_m.ReportedAt = value.Time
+			}
+		case bronzes1alert.FieldAPICreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_created_at", values[i])
+			} else if value.Valid {
+				_m.APICreatedAt = new(time.Time)
+				*_m.APICreatedAt = value.Time
+			}
+		case bronzes1alert.FieldAPIUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_updated_at", values[i])
+			} else if value.Valid {
+				_m.APIUpdatedAt = new(time.Time)
+				*_m.APIUpdatedAt = value.Time
+			}
+		case bronzes1alert.FieldSourceProcessJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field source_process_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SourceProcessJSON); err != nil {
+					return fmt.Errorf("unmarshal field source_process_json: %w", err)
+				}
+			}
+		case bronzes1alert.FieldTargetProcessJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field target_process_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TargetProcessJSON); err != nil {
+					return fmt.Errorf("unmarshal field target_process_json: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeS1Alert.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeS1Alert) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeS1Alert.
+// Note that you need to call BronzeS1Alert.Unwrap() before calling this method if this BronzeS1Alert
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeS1Alert) Update() *BronzeS1AlertUpdateOne {
+	return NewBronzeS1AlertClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeS1Alert entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeS1Alert) Unwrap() *BronzeS1Alert {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("s1: BronzeS1Alert is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeS1Alert) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeS1Alert(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("agent_id=")
+	builder.WriteString(_m.AgentID)
+	builder.WriteString(", ")
+	builder.WriteString("agent_uuid=")
+	builder.WriteString(_m.AgentUUID)
+	builder.WriteString(", ")
+	builder.WriteString("agent_name=")
+	builder.WriteString(_m.AgentName)
+	builder.WriteString(", ")
+	builder.WriteString("agent_os_name=")
+	builder.WriteString(_m.AgentOsName)
+	builder.WriteString(", ")
+	builder.WriteString("site_id=")
+	builder.WriteString(_m.SiteID)
+	builder.WriteString(", ")
+	builder.WriteString("rule_id=")
+	builder.WriteString(_m.RuleID)
+	builder.WriteString(", ")
+	builder.WriteString("rule_name=")
+	builder.WriteString(_m.RuleName)
+	builder.WriteString(", ")
+	builder.WriteString("rule_severity=")
+	builder.WriteString(_m.RuleSeverity)
+	builder.WriteString(", ")
+	builder.WriteString("rule_description=")
+	builder.WriteString(_m.RuleDescription)
+	builder.WriteString(", ")
+	builder.WriteString("rule_scope_level=")
+	builder.WriteString(_m.RuleScopeLevel)
+	builder.WriteString(", ")
+	builder.WriteString("rule_treat_as_threat=")
+	builder.WriteString(_m.RuleTreatAsThreat)
+	builder.WriteString(", ")
+	builder.WriteString("analyst_verdict=")
+	builder.WriteString(_m.AnalystVerdict)
+	builder.WriteString(", ")
+	builder.WriteString("incident_status=")
+	builder.WriteString(_m.IncidentStatus)
+	builder.WriteString(", ")
+	builder.WriteString("event_type=")
+	builder.WriteString(_m.EventType)
+	builder.WriteString(", ")
+	builder.WriteString("hit_type=")
+	builder.WriteString(_m.HitType)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("dv_event_id=")
+	builder.WriteString(_m.DvEventID)
+	builder.WriteString(", ")
+	builder.WriteString("is_edr=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsEdr))
+	builder.WriteString(", ")
+	if v := _m.ReportedAt; v != nil {
+		builder.WriteString("reported_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.APICreatedAt; v != nil {
+		builder.WriteString("api_created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.APIUpdatedAt; v != nil {
+		builder.WriteString("api_updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("source_process_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SourceProcessJSON))
+	builder.WriteString(", ")
+	builder.WriteString("target_process_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetProcessJSON))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeS1Alerts is a parsable slice of BronzeS1Alert.
+type BronzeS1Alerts []*BronzeS1Alert
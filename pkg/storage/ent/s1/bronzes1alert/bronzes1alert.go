@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzes1alert
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzes1alert type in the database.
+	Label = "bronze_s1alert"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldAgentID holds the string denoting the agent_id field in the database.
+	FieldAgentID = "agent_id"
+	// FieldAgentUUID holds the string denoting the agent_uuid field in the database.
+	FieldAgentUUID = "agent_uuid"
+	// FieldAgentName holds the string denoting the agent_name field in the database.
+	FieldAgentName = "agent_name"
+	// FieldAgentOsName holds the string denoting the agent_os_name field in the database.
+	FieldAgentOsName = "agent_os_name"
+	// FieldSiteID holds the string denoting the site_id field in the database.
+	FieldSiteID = "site_id"
+	// FieldRuleID holds the string denoting the rule_id field in the database.
+	FieldRuleID = "rule_id"
+	// FieldRuleName holds the string denoting the rule_name field in the database.
+	FieldRuleName = "rule_name"
+	// FieldRuleSeverity holds the string denoting the rule_severity field in the database.
+	FieldRuleSeverity = "rule_severity"
+	// FieldRuleDescription holds the string denoting the rule_description field in the database.
+	FieldRuleDescription = "rule_description"
+	// FieldRuleScopeLevel holds the string denoting the rule_scope_level field in the database.
+	FieldRuleScopeLevel = "rule_scope_level"
+	// FieldRuleTreatAsThreat holds the string denoting the rule_treat_as_threat field in the database.
+	FieldRuleTreatAsThreat = "rule_treat_as_threat"
+	// FieldAnalystVerdict holds the string denoting the analyst_verdict field in the database.
+	FieldAnalystVerdict = "analyst_verdict"
+	// FieldIncidentStatus holds the string denoting the incident_status field in the database.
+	FieldIncidentStatus = "incident_status"
+	// FieldEventType holds the string denoting the event_type field in the database.
+	FieldEventType = "event_type"
+	// FieldHitType holds the string denoting the hit_type field in the database.
+	FieldHitType = "hit_type"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldDvEventID holds the string denoting the dv_event_id field in the database.
+	FieldDvEventID = "dv_event_id"
+	// FieldIsEdr holds the string denoting the is_edr field in the database.
+	FieldIsEdr = "is_edr"
+	// FieldReportedAt holds the string denoting the reported_at field in the database.
+	FieldReportedAt = "reported_at"
+	// FieldAPICreatedAt holds the string denoting the api_created_at field in the database.
+	FieldAPICreatedAt = "api_created_at"
+	// FieldAPIUpdatedAt holds the string denoting the api_updated_at field in the database.
+	FieldAPIUpdatedAt = "api_updated_at"
+	// FieldSourceProcessJSON holds the string denoting the source_process_json field in the database.
+	FieldSourceProcessJSON = "source_process_json"
+	// FieldTargetProcessJSON holds the string denoting the target_process_json field in the database.
+	FieldTargetProcessJSON = "target_process_json"
+	// Table holds the table name of the bronzes1alert in the database.
+	Table = "s1_alerts"
+)
+
+// Columns holds all SQL columns for bronzes1alert fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldAgentID,
+	FieldAgentUUID,
+	FieldAgentName,
+	FieldAgentOsName,
+	FieldSiteID,
+	FieldRuleID,
+	FieldRuleName,
+	FieldRuleSeverity,
+	FieldRuleDescription,
+	FieldRuleScopeLevel,
+	FieldRuleTreatAsThreat,
+	FieldAnalystVerdict,
+	FieldIncidentStatus,
+	FieldEventType,
+	FieldHitType,
+	FieldSource,
+	FieldDvEventID,
+	FieldIsEdr,
+	FieldReportedAt,
+	FieldAPICreatedAt,
+	FieldAPIUpdatedAt,
+	FieldSourceProcessJSON,
+	FieldTargetProcessJSON,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultIsEdr holds the default value on creation for the "is_edr" field.
+	DefaultIsEdr bool
+)
+
+// OrderOption defines the ordering options for the BronzeS1Alert queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByAgentID orders the results by the agent_id field.
+func ByAgentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgentID, opts...).ToFunc()
+}
+
+// ByAgentUUID orders the results by the agent_uuid field.
+func ByAgentUUID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgentUUID, opts...).ToFunc()
+}
+
+// ByAgentName orders the results by the agent_name field.
+func ByAgentName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgentName, opts...).ToFunc()
+}
+
+// ByAgentOsName orders the results by the agent_os_name field.
+func ByAgentOsName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgentOsName, opts...).ToFunc()
+}
+
+// BySiteID orders the results by the site_id field.
+func BySiteID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSiteID, opts...).ToFunc()
+}
+
+// ByRuleID orders the results by the rule_id field.
+func ByRuleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRuleID, opts...).ToFunc()
+}
+
+// ByRuleName orders the results by the rule_name field.
+func ByRuleName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRuleName, opts...).ToFunc()
+}
+
+// ByRuleSeverity orders the results by the rule_severity field.
+func ByRuleSeverity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRuleSeverity, opts...).ToFunc()
+}
+
+// ByRuleDescription orders the results by the rule_description field.
+func ByRuleDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRuleDescription, opts...).ToFunc()
+}
+
+// ByRuleScopeLevel orders the results by the rule_scope_level field.
+func ByRuleScopeLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRuleScopeLevel, opts...).ToFunc()
+}
+
+// ByRuleTreatAsThreat orders the results by the rule_treat_as_threat field.
+func ByRuleTreatAsThreat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRuleTreatAsThreat, opts...).ToFunc()
+}
+
+// ByAnalystVerdict orders the results by the analyst_verdict field.
+func ByAnalystVerdict(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnalystVerdict, opts...).ToFunc()
+}
+
+// ByIncidentStatus orders the results by the incident_status field.
+func ByIncidentStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncidentStatus, opts...).ToFunc()
+}
+
+// ByEventType orders the results by the event_type field.
+func ByEventType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventType, opts...).ToFunc()
+}
+
+// ByHitType orders the results by the hit_type field.
+func ByHitType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHitType, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByDvEventID orders the results by the dv_event_id field.
+func ByDvEventID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDvEventID, opts...).ToFunc()
+}
+
+// ByIsEdr orders the results by the is_edr field.
+func ByIsEdr(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsEdr, opts...).ToFunc()
+}
+
+// ByReportedAt orders the results by the reported_at field.
+func ByReportedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReportedAt, opts...).ToFunc()
+}
+
+// ByAPICreatedAt orders the results by the api_created_at field.
+func ByAPICreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPICreatedAt, opts...).ToFunc()
+}
+
+// ByAPIUpdatedAt orders the results by the api_updated_at field.
+func ByAPIUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPIUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,621 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1alert"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeS1AlertCreate is the builder for creating a BronzeS1Alert entity.
+type BronzeS1AlertCreate struct {
+	config
+	mutation *BronzeS1AlertMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeS1AlertCreate) SetCollectedAt(v time.Time) *BronzeS1AlertCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeS1AlertCreate) SetFirstCollectedAt(v time.Time) *BronzeS1AlertCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetAgentID sets the "agent_id" field.
+func (_c *BronzeS1AlertCreate) SetAgentID(v string) *BronzeS1AlertCreate {
+	_c.mutation.SetAgentID(v)
+	return _c
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableAgentID(v *string) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetAgentID(*v)
+	}
+	return _c
+}
+
+// SetAgentUUID sets the "agent_uuid" field.
+func (_c *BronzeS1AlertCreate) SetAgentUUID(v string) *BronzeS1AlertCreate {
+	_c.mutation.SetAgentUUID(v)
+	return _c
+}
+
+// SetNillableAgentUUID sets the "agent_uuid" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableAgentUUID(v *string) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetAgentUUID(*v)
+	}
+	return _c
+}
+
+// SetAgentName sets the "agent_name" field.
+func (_c *BronzeS1AlertCreate) SetAgentName(v string) *BronzeS1AlertCreate {
+	_c.mutation.SetAgentName(v)
+	return _c
+}
+
+// SetNillableAgentName sets the "agent_name" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableAgentName(v *string) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetAgentName(*v)
+	}
+	return _c
+}
+
+// SetAgentOsName sets the "agent_os_name" field.
+func (_c *BronzeS1AlertCreate) SetAgentOsName(v string) *BronzeS1AlertCreate {
+	_c.mutation.SetAgentOsName(v)
+	return _c
+}
+
+// SetNillableAgentOsName sets the "agent_os_name" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableAgentOsName(v *string) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetAgentOsName(*v)
+	}
+	return _c
+}
+
+// SetSiteID sets the "site_id" field.
+func (_c *BronzeS1AlertCreate) SetSiteID(v string) *BronzeS1AlertCreate {
+	_c.mutation.SetSiteID(v)
+	return _c
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableSiteID(v *string) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetSiteID(*v)
+	}
+	return _c
+}
+
+// SetRuleID sets the "rule_id" field.
+func (_c *BronzeS1AlertCreate) SetRuleID(v string) *BronzeS1AlertCreate {
+	_c.mutation.SetRuleID(v)
+	return _c
+}
+
+// SetNillableRuleID sets the "rule_id" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableRuleID(v *string) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetRuleID(*v)
+	}
+	return _c
+}
+
+// SetRuleName sets the "rule_name" field.
+func (_c *BronzeS1AlertCreate) SetRuleName(v string) *BronzeS1AlertCreate {
+	_c.mutation.SetRuleName(v)
+	return _c
+}
+
+// SetNillableRuleName sets the "rule_name" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableRuleName(v *string) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetRuleName(*v)
+	}
+	return _c
+}
+
+// SetRuleSeverity sets the "rule_severity" field.
+func (_c *BronzeS1AlertCreate) SetRuleSeverity(v string) *BronzeS1AlertCreate {
+	_c.mutation.SetRuleSeverity(v)
+	return _c
+}
+
+// SetNillableRuleSeverity sets the "rule_severity" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableRuleSeverity(v *string) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetRuleSeverity(*v)
+	}
+	return _c
+}
+
+// SetRuleDescription sets the "rule_description" field.
+func (_c *BronzeS1AlertCreate) SetRuleDescription(v string) *BronzeS1AlertCreate {
+	_c.mutation.SetRuleDescription(v)
+	return _c
+}
+
+// SetNillableRuleDescription sets the "rule_description" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableRuleDescription(v *string) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetRuleDescription(*v)
+	}
+	return _c
+}
+
+// SetRuleScopeLevel sets the "rule_scope_level" field.
+func (_c *BronzeS1AlertCreate) SetRuleScopeLevel(v string) *BronzeS1AlertCreate {
+	_c.mutation.SetRuleScopeLevel(v)
+	return _c
+}
+
+// SetNillableRuleScopeLevel sets the "rule_scope_level" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableRuleScopeLevel(v *string) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetRuleScopeLevel(*v)
+	}
+	return _c
+}
+
+// SetRuleTreatAsThreat sets the "rule_treat_as_threat" field.
+func (_c *BronzeS1AlertCreate) SetRuleTreatAsThreat(v string) *BronzeS1AlertCreate {
+	_c.mutation.SetRuleTreatAsThreat(v)
+	return _c
+}
+
+// SetNillableRuleTreatAsThreat sets the "rule_treat_as_threat" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableRuleTreatAsThreat(v *string) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetRuleTreatAsThreat(*v)
+	}
+	return _c
+}
+
+// SetAnalystVerdict sets the "analyst_verdict" field.
+func (_c *BronzeS1AlertCreate) SetAnalystVerdict(v string) *BronzeS1AlertCreate {
+	_c.mutation.SetAnalystVerdict(v)
+	return _c
+}
+
+// SetNillableAnalystVerdict sets the "analyst_verdict" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableAnalystVerdict(v *string) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetAnalystVerdict(*v)
+	}
+	return _c
+}
+
+// SetIncidentStatus sets the "incident_status" field.
+func (_c *BronzeS1AlertCreate) SetIncidentStatus(v string) *BronzeS1AlertCreate {
+	_c.mutation.SetIncidentStatus(v)
+	return _c
+}
+
+// SetNillableIncidentStatus sets the "incident_status" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableIncidentStatus(v *string) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetIncidentStatus(*v)
+	}
+	return _c
+}
+
+// SetEventType sets the "event_type" field.
+func (_c *BronzeS1AlertCreate) SetEventType(v string) *BronzeS1AlertCreate {
+	_c.mutation.SetEventType(v)
+	return _c
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableEventType(v *string) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetEventType(*v)
+	}
+	return _c
+}
+
+// SetHitType sets the "hit_type" field.
+func (_c *BronzeS1AlertCreate) SetHitType(v string) *BronzeS1AlertCreate {
+	_c.mutation.SetHitType(v)
+	return _c
+}
+
+// SetNillableHitType sets the "hit_type" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableHitType(v *string) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetHitType(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *BronzeS1AlertCreate) SetSource(v string) *BronzeS1AlertCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableSource(v *string) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetDvEventID sets the "dv_event_id" field.
+func (_c *BronzeS1AlertCreate) SetDvEventID(v string) *BronzeS1AlertCreate {
+	_c.mutation.SetDvEventID(v)
+	return _c
+}
+
+// SetNillableDvEventID sets the "dv_event_id" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableDvEventID(v *string) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetDvEventID(*v)
+	}
+	return _c
+}
+
+// SetIsEdr sets the "is_edr" field.
+func (_c *BronzeS1AlertCreate) SetIsEdr(v bool) *BronzeS1AlertCreate {
+	_c.mutation.SetIsEdr(v)
+	return _c
+}
+
+// SetNillableIsEdr sets the "is_edr" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableIsEdr(v *bool) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetIsEdr(*v)
+	}
+	return _c
+}
+
+// SetReportedAt sets the "reported_at" field.
+func (_c *BronzeS1AlertCreate) SetReportedAt(v time.Time) *BronzeS1AlertCreate {
+	_c.mutation.SetReportedAt(v)
+	return _c
+}
+
+// SetNillableReportedAt sets the "reported_at" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableReportedAt(v *time.Time) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetReportedAt(*v)
+	}
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeS1AlertCreate) SetAPICreatedAt(v time.Time) *BronzeS1AlertCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_c *BronzeS1AlertCreate) SetAPIUpdatedAt(v time.Time) *BronzeS1AlertCreate {
+	_c.mutation.SetAPIUpdatedAt(v)
+	return _c
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_c *BronzeS1AlertCreate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeS1AlertCreate {
+	if v != nil {
+		_c.SetAPIUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetSourceProcessJSON sets the "source_process_json" field.
+func (_c *BronzeS1AlertCreate) SetSourceProcessJSON(v json.RawMessage) *BronzeS1AlertCreate {
+	_c.mutation.SetSourceProcessJSON(v)
+	return _c
+}
+
+// SetTargetProcessJSON sets the "target_process_json" field.
+func (_c *BronzeS1AlertCreate) SetTargetProcessJSON(v json.RawMessage) *BronzeS1AlertCreate {
+	_c.mutation.SetTargetProcessJSON(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeS1AlertCreate) SetID(v string) *BronzeS1AlertCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeS1AlertMutation object of the builder.
+func (_c *BronzeS1AlertCreate) Mutation() *BronzeS1AlertMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeS1Alert in the database.
+func (_c *BronzeS1AlertCreate) Save(ctx context.Context) (*BronzeS1Alert, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeS1AlertCreate) SaveX(ctx context.Context) *BronzeS1Alert {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeS1AlertCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeS1AlertCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeS1AlertCreate) defaults() {
+	if _, ok := _c.mutation.IsEdr(); !ok {
+		v := bronzes1alert.DefaultIsEdr
+		_c.mutation.SetIsEdr(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeS1AlertCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`s1: missing required field "BronzeS1Alert.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`s1: missing required field "BronzeS1Alert.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.IsEdr(); !ok {
+		return &ValidationError{Name: "is_edr", err: errors.New(`s1: missing required field "BronzeS1Alert.is_edr"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeS1AlertCreate) sqlSave(ctx context.Context) (*BronzeS1Alert, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeS1Alert.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeS1AlertCreate) createSpec() (*BronzeS1Alert, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeS1Alert{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzes1alert.Table, sqlgraph.NewFieldSpec(bronzes1alert.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeS1Alert
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1alert.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzes1alert.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.AgentID(); ok {
+		_spec.SetField(bronzes1alert.FieldAgentID, field.TypeString, value)
+		_node.AgentID = value
+	}
+	if value, ok := _c.mutation.AgentUUID(); ok {
+		_spec.SetField(bronzes1alert.FieldAgentUUID, field.TypeString, value)
+		_node.AgentUUID = value
+	}
+	if value, ok := _c.mutation.AgentName(); ok {
+		_spec.SetField(bronzes1alert.FieldAgentName, field.TypeString, value)
+		_node.AgentName = value
+	}
+	if value, ok := _c.mutation.AgentOsName(); ok {
+		_spec.SetField(bronzes1alert.FieldAgentOsName, field.TypeString, value)
+		_node.AgentOsName = value
+	}
+	if value, ok := _c.mutation.SiteID(); ok {
+		_spec.SetField(bronzes1alert.FieldSiteID, field.TypeString, value)
+		_node.SiteID = value
+	}
+	if value, ok := _c.mutation.RuleID(); ok {
+		_spec.SetField(bronzes1alert.FieldRuleID, field.TypeString, value)
+		_node.RuleID = value
+	}
+	if value, ok := _c.mutation.RuleName(); ok {
+		_spec.SetField(bronzes1alert.FieldRuleName, field.TypeString, value)
+		_node.RuleName = value
+	}
+	if value, ok := _c.mutation.RuleSeverity(); ok {
+		_spec.SetField(bronzes1alert.FieldRuleSeverity, field.TypeString, value)
+		_node.RuleSeverity = value
+	}
+	if value, ok := _c.mutation.RuleDescription(); ok {
+		_spec.SetField(bronzes1alert.FieldRuleDescription, field.TypeString, value)
+		_node.RuleDescription = value
+	}
+	if value, ok := _c.mutation.RuleScopeLevel(); ok {
+		_spec.SetField(bronzes1alert.FieldRuleScopeLevel, field.TypeString, value)
+		_node.RuleScopeLevel = value
+	}
+	if value, ok := _c.mutation.RuleTreatAsThreat(); ok {
+		_spec.SetField(bronzes1alert.FieldRuleTreatAsThreat, field.TypeString, value)
+		_node.RuleTreatAsThreat = value
+	}
+	if value, ok := _c.mutation.AnalystVerdict(); ok {
+		_spec.SetField(bronzes1alert.FieldAnalystVerdict, field.TypeString, value)
+		_node.AnalystVerdict = value
+	}
+	if value, ok := _c.mutation.IncidentStatus(); ok {
+		_spec.SetField(bronzes1alert.FieldIncidentStatus, field.TypeString, value)
+		_node.IncidentStatus = value
+	}
+	if value, ok := _c.mutation.EventType(); ok {
+		_spec.SetField(bronzes1alert.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := _c.mutation.HitType(); ok {
+		_spec.SetField(bronzes1alert.FieldHitType, field.TypeString, value)
+		_node.HitType = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(bronzes1alert.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.DvEventID(); ok {
+		_spec.SetField(bronzes1alert.FieldDvEventID, field.TypeString, value)
+		_node.DvEventID = value
+	}
+	if value, ok := _c.mutation.IsEdr(); ok {
+		_spec.SetField(bronzes1alert.FieldIsEdr, field.TypeBool, value)
+		_node.IsEdr = value
+	}
+	if value, ok := _c.mutation.ReportedAt(); ok {
+		_spec.SetField(bronzes1alert.FieldReportedAt, field.TypeTime, value)
+		_node.ReportedAt = &value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzes1alert.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	if value, ok := _c.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzes1alert.FieldAPIUpdatedAt, field.TypeTime, value)
+		_node.APIUpdatedAt = &value
+	}
+	if value, ok := _c.mutation.SourceProcessJSON(); ok {
+		_spec.SetField(bronzes1alert.FieldSourceProcessJSON, field.TypeJSON, value)
+		_node.SourceProcessJSON = value
+	}
+	if value, ok := _c.mutation.TargetProcessJSON(); ok {
+		_spec.SetField(bronzes1alert.FieldTargetProcessJSON, field.TypeJSON, value)
+		_node.TargetProcessJSON = value
+	}
+	return _node, _spec
+}
+
+// BronzeS1AlertCreateBulk is the builder for creating many BronzeS1Alert entities in bulk.
+type BronzeS1AlertCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeS1AlertCreate
+}
+
+// Save creates the BronzeS1Alert entities in the database.
+func (_c *BronzeS1AlertCreateBulk) Save(ctx context.Context) ([]*BronzeS1Alert, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeS1Alert, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeS1AlertMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeS1AlertCreateBulk) SaveX(ctx context.Context) []*BronzeS1Alert {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeS1AlertCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeS1AlertCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
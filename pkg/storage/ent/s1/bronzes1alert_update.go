@@ -0,0 +1,1407 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1alert"
+	"danny.vn/hotpot/pkg/storage/ent/s1/internal"
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeS1AlertUpdate is the builder for updating BronzeS1Alert entities.
+type BronzeS1AlertUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeS1AlertMutation
+}
+
+// Where appends a list predicates to the BronzeS1AlertUpdate builder.
+func (_u *BronzeS1AlertUpdate) Where(ps ...predicate.BronzeS1Alert) *BronzeS1AlertUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeS1AlertUpdate) SetCollectedAt(v time.Time) *BronzeS1AlertUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableCollectedAt(v *time.Time) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetAgentID sets the "agent_id" field.
+func (_u *BronzeS1AlertUpdate) SetAgentID(v string) *BronzeS1AlertUpdate {
+	_u.mutation.SetAgentID(v)
+	return _u
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableAgentID(v *string) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetAgentID(*v)
+	}
+	return _u
+}
+
+// ClearAgentID clears the value of the "agent_id" field.
+func (_u *BronzeS1AlertUpdate) ClearAgentID() *BronzeS1AlertUpdate {
+	_u.mutation.ClearAgentID()
+	return _u
+}
+
+// SetAgentUUID sets the "agent_uuid" field.
+func (_u *BronzeS1AlertUpdate) SetAgentUUID(v string) *BronzeS1AlertUpdate {
+	_u.mutation.SetAgentUUID(v)
+	return _u
+}
+
+// SetNillableAgentUUID sets the "agent_uuid" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableAgentUUID(v *string) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetAgentUUID(*v)
+	}
+	return _u
+}
+
+// ClearAgentUUID clears the value of the "agent_uuid" field.
+func (_u *BronzeS1AlertUpdate) ClearAgentUUID() *BronzeS1AlertUpdate {
+	_u.mutation.ClearAgentUUID()
+	return _u
+}
+
+// SetAgentName sets the "agent_name" field.
+func (_u *BronzeS1AlertUpdate) SetAgentName(v string) *BronzeS1AlertUpdate {
+	_u.mutation.SetAgentName(v)
+	return _u
+}
+
+// SetNillableAgentName sets the "agent_name" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableAgentName(v *string) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetAgentName(*v)
+	}
+	return _u
+}
+
+// ClearAgentName clears the value of the "agent_name" field.
+func (_u *BronzeS1AlertUpdate) ClearAgentName() *BronzeS1AlertUpdate {
+	_u.mutation.ClearAgentName()
+	return _u
+}
+
+// SetAgentOsName sets the "agent_os_name" field.
+func (_u *BronzeS1AlertUpdate) SetAgentOsName(v string) *BronzeS1AlertUpdate {
+	_u.mutation.SetAgentOsName(v)
+	return _u
+}
+
+// SetNillableAgentOsName sets the "agent_os_name" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableAgentOsName(v *string) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetAgentOsName(*v)
+	}
+	return _u
+}
+
+// ClearAgentOsName clears the value of the "agent_os_name" field.
+func (_u *BronzeS1AlertUpdate) ClearAgentOsName() *BronzeS1AlertUpdate {
+	_u.mutation.ClearAgentOsName()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeS1AlertUpdate) SetSiteID(v string) *BronzeS1AlertUpdate {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableSiteID(v *string) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeS1AlertUpdate) ClearSiteID() *BronzeS1AlertUpdate {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetRuleID sets the "rule_id" field.
+func (_u *BronzeS1AlertUpdate) SetRuleID(v string) *BronzeS1AlertUpdate {
+	_u.mutation.SetRuleID(v)
+	return _u
+}
+
+// SetNillableRuleID sets the "rule_id" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableRuleID(v *string) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetRuleID(*v)
+	}
+	return _u
+}
+
+// ClearRuleID clears the value of the "rule_id" field.
+func (_u *BronzeS1AlertUpdate) ClearRuleID() *BronzeS1AlertUpdate {
+	_u.mutation.ClearRuleID()
+	return _u
+}
+
+// SetRuleName sets the "rule_name" field.
+func (_u *BronzeS1AlertUpdate) SetRuleName(v string) *BronzeS1AlertUpdate {
+	_u.mutation.SetRuleName(v)
+	return _u
+}
+
+// SetNillableRuleName sets the "rule_name" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableRuleName(v *string) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetRuleName(*v)
+	}
+	return _u
+}
+
+// ClearRuleName clears the value of the "rule_name" field.
+func (_u *BronzeS1AlertUpdate) ClearRuleName() *BronzeS1AlertUpdate {
+	_u.mutation.ClearRuleName()
+	return _u
+}
+
+// SetRuleSeverity sets the "rule_severity" field.
+func (_u *BronzeS1AlertUpdate) SetRuleSeverity(v string) *BronzeS1AlertUpdate {
+	_u.mutation.SetRuleSeverity(v)
+	return _u
+}
+
+// SetNillableRuleSeverity sets the "rule_severity" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableRuleSeverity(v *string) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetRuleSeverity(*v)
+	}
+	return _u
+}
+
+// ClearRuleSeverity clears the value of the "rule_severity" field.
+func (_u *BronzeS1AlertUpdate) ClearRuleSeverity() *BronzeS1AlertUpdate {
+	_u.mutation.ClearRuleSeverity()
+	return _u
+}
+
+// SetRuleDescription sets the "rule_description" field.
+func (_u *BronzeS1AlertUpdate) SetRuleDescription(v string) *BronzeS1AlertUpdate {
+	_u.mutation.SetRuleDescription(v)
+	return _u
+}
+
+// SetNillableRuleDescription sets the "rule_description" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableRuleDescription(v *string) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetRuleDescription(*v)
+	}
+	return _u
+}
+
+// ClearRuleDescription clears the value of the "rule_description" field.
+func (_u *BronzeS1AlertUpdate) ClearRuleDescription() *BronzeS1AlertUpdate {
+	_u.mutation.ClearRuleDescription()
+	return _u
+}
+
+// SetRuleScopeLevel sets the "rule_scope_level" field.
+func (_u *BronzeS1AlertUpdate) SetRuleScopeLevel(v string) *BronzeS1AlertUpdate {
+	_u.mutation.SetRuleScopeLevel(v)
+	return _u
+}
+
+// SetNillableRuleScopeLevel sets the "rule_scope_level" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableRuleScopeLevel(v *string) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetRuleScopeLevel(*v)
+	}
+	return _u
+}
+
+// ClearRuleScopeLevel clears the value of the "rule_scope_level" field.
+func (_u *BronzeS1AlertUpdate) ClearRuleScopeLevel() *BronzeS1AlertUpdate {
+	_u.mutation.ClearRuleScopeLevel()
+	return _u
+}
+
+// SetRuleTreatAsThreat sets the "rule_treat_as_threat" field.
+func (_u *BronzeS1AlertUpdate) SetRuleTreatAsThreat(v string) *BronzeS1AlertUpdate {
+	_u.mutation.SetRuleTreatAsThreat(v)
+	return _u
+}
+
+// SetNillableRuleTreatAsThreat sets the "rule_treat_as_threat" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableRuleTreatAsThreat(v *string) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetRuleTreatAsThreat(*v)
+	}
+	return _u
+}
+
+// ClearRuleTreatAsThreat clears the value of the "rule_treat_as_threat" field.
+func (_u *BronzeS1AlertUpdate) ClearRuleTreatAsThreat() *BronzeS1AlertUpdate {
+	_u.mutation.ClearRuleTreatAsThreat()
+	return _u
+}
+
+// SetAnalystVerdict sets the "analyst_verdict" field.
+func (_u *BronzeS1AlertUpdate) SetAnalystVerdict(v string) *BronzeS1AlertUpdate {
+	_u.mutation.SetAnalystVerdict(v)
+	return _u
+}
+
+// SetNillableAnalystVerdict sets the "analyst_verdict" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableAnalystVerdict(v *string) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetAnalystVerdict(*v)
+	}
+	return _u
+}
+
+// ClearAnalystVerdict clears the value of the "analyst_verdict" field.
+func (_u *BronzeS1AlertUpdate) ClearAnalystVerdict() *BronzeS1AlertUpdate {
+	_u.mutation.ClearAnalystVerdict()
+	return _u
+}
+
+// SetIncidentStatus sets the "incident_status" field.
+func (_u *BronzeS1AlertUpdate) SetIncidentStatus(v string) *BronzeS1AlertUpdate {
+	_u.mutation.SetIncidentStatus(v)
+	return _u
+}
+
+// SetNillableIncidentStatus sets the "incident_status" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableIncidentStatus(v *string) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetIncidentStatus(*v)
+	}
+	return _u
+}
+
+// ClearIncidentStatus clears the value of the "incident_status" field.
+func (_u *BronzeS1AlertUpdate) ClearIncidentStatus() *BronzeS1AlertUpdate {
+	_u.mutation.ClearIncidentStatus()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *BronzeS1AlertUpdate) SetEventType(v string) *BronzeS1AlertUpdate {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableEventType(v *string) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (_u *BronzeS1AlertUpdate) ClearEventType() *BronzeS1AlertUpdate {
+	_u.mutation.ClearEventType()
+	return _u
+}
+
+// SetHitType sets the "hit_type" field.
+func (_u *BronzeS1AlertUpdate) SetHitType(v string) *BronzeS1AlertUpdate {
+	_u.mutation.SetHitType(v)
+	return _u
+}
+
+// SetNillableHitType sets the "hit_type" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableHitType(v *string) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetHitType(*v)
+	}
+	return _u
+}
+
+// ClearHitType clears the value of the "hit_type" field.
+func (_u *BronzeS1AlertUpdate) ClearHitType() *BronzeS1AlertUpdate {
+	_u.mutation.ClearHitType()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BronzeS1AlertUpdate) SetSource(v string) *BronzeS1AlertUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableSource(v *string) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *BronzeS1AlertUpdate) ClearSource() *BronzeS1AlertUpdate {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// SetDvEventID sets the "dv_event_id" field.
+func (_u *BronzeS1AlertUpdate) SetDvEventID(v string) *BronzeS1AlertUpdate {
+	_u.mutation.SetDvEventID(v)
+	return _u
+}
+
+// SetNillableDvEventID sets the "dv_event_id" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableDvEventID(v *string) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetDvEventID(*v)
+	}
+	return _u
+}
+
+// ClearDvEventID clears the value of the "dv_event_id" field.
+func (_u *BronzeS1AlertUpdate) ClearDvEventID() *BronzeS1AlertUpdate {
+	_u.mutation.ClearDvEventID()
+	return _u
+}
+
+// SetIsEdr sets the "is_edr" field.
+func (_u *BronzeS1AlertUpdate) SetIsEdr(v bool) *BronzeS1AlertUpdate {
+	_u.mutation.SetIsEdr(v)
+	return _u
+}
+
+// SetNillableIsEdr sets the "is_edr" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableIsEdr(v *bool) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetIsEdr(*v)
+	}
+	return _u
+}
+
+// SetReportedAt sets the "reported_at" field.
+func (_u *BronzeS1AlertUpdate) SetReportedAt(v time.Time) *BronzeS1AlertUpdate {
+	_u.mutation.SetReportedAt(v)
+	return _u
+}
+
+// SetNillableReportedAt sets the "reported_at" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableReportedAt(v *time.Time) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetReportedAt(*v)
+	}
+	return _u
+}
+
+// ClearReportedAt clears the value of the "reported_at" field.
+func (_u *BronzeS1AlertUpdate) ClearReportedAt() *BronzeS1AlertUpdate {
+	_u.mutation.ClearReportedAt()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeS1AlertUpdate) SetAPICreatedAt(v time.Time) *BronzeS1AlertUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeS1AlertUpdate) ClearAPICreatedAt() *BronzeS1AlertUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeS1AlertUpdate) SetAPIUpdatedAt(v time.Time) *BronzeS1AlertUpdate {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeS1AlertUpdate {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeS1AlertUpdate) ClearAPIUpdatedAt() *BronzeS1AlertUpdate {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// SetSourceProcessJSON sets the "source_process_json" field.
+func (_u *BronzeS1AlertUpdate) SetSourceProcessJSON(v json.RawMessage) *BronzeS1AlertUpdate {
+	_u.mutation.SetSourceProcessJSON(v)
+	return _u
+}
+
+// AppendSourceProcessJSON appends value to the "source_process_json" field.
+func (_u *BronzeS1AlertUpdate) AppendSourceProcessJSON(v json.RawMessage) *BronzeS1AlertUpdate {
+	_u.mutation.AppendSourceProcessJSON(v)
+	return _u
+}
+
+// ClearSourceProcessJSON clears the value of the "source_process_json" field.
+func (_u *BronzeS1AlertUpdate) ClearSourceProcessJSON() *BronzeS1AlertUpdate {
+	_u.mutation.ClearSourceProcessJSON()
+	return _u
+}
+
+// SetTargetProcessJSON sets the "target_process_json" field.
+func (_u *BronzeS1AlertUpdate) SetTargetProcessJSON(v json.RawMessage) *BronzeS1AlertUpdate {
+	_u.mutation.SetTargetProcessJSON(v)
+	return _u
+}
+
+// AppendTargetProcessJSON appends value to the "target_process_json" field.
+func (_u *BronzeS1AlertUpdate) AppendTargetProcessJSON(v json.RawMessage) *BronzeS1AlertUpdate {
+	_u.mutation.AppendTargetProcessJSON(v)
+	return _u
+}
+
+// ClearTargetProcessJSON clears the value of the "target_process_json" field.
+func (_u *BronzeS1AlertUpdate) ClearTargetProcessJSON() *BronzeS1AlertUpdate {
+	_u.mutation.ClearTargetProcessJSON()
+	return _u
+}
+
+// Mutation returns the BronzeS1AlertMutation object of the builder.
+func (_u *BronzeS1AlertUpdate) Mutation() *BronzeS1AlertMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeS1AlertUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeS1AlertUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeS1AlertUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeS1AlertUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BronzeS1AlertUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bronzes1alert.Table, bronzes1alert.Columns, sqlgraph.NewFieldSpec(bronzes1alert.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1alert.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.AgentID(); ok {
+		_spec.SetField(bronzes1alert.FieldAgentID, field.TypeString, value)
+	}
+	if _u.mutation.AgentIDCleared() {
+		_spec.ClearField(bronzes1alert.FieldAgentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentUUID(); ok {
+		_spec.SetField(bronzes1alert.FieldAgentUUID, field.TypeString, value)
+	}
+	if _u.mutation.AgentUUIDCleared() {
+		_spec.ClearField(bronzes1alert.FieldAgentUUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentName(); ok {
+		_spec.SetField(bronzes1alert.FieldAgentName, field.TypeString, value)
+	}
+	if _u.mutation.AgentNameCleared() {
+		_spec.ClearField(bronzes1alert.FieldAgentName, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentOsName(); ok {
+		_spec.SetField(bronzes1alert.FieldAgentOsName, field.TypeString, value)
+	}
+	if _u.mutation.AgentOsNameCleared() {
+		_spec.ClearField(bronzes1alert.FieldAgentOsName, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzes1alert.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzes1alert.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleID(); ok {
+		_spec.SetField(bronzes1alert.FieldRuleID, field.TypeString, value)
+	}
+	if _u.mutation.RuleIDCleared() {
+		_spec.ClearField(bronzes1alert.FieldRuleID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleName(); ok {
+		_spec.SetField(bronzes1alert.FieldRuleName, field.TypeString, value)
+	}
+	if _u.mutation.RuleNameCleared() {
+		_spec.ClearField(bronzes1alert.FieldRuleName, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleSeverity(); ok {
+		_spec.SetField(bronzes1alert.FieldRuleSeverity, field.TypeString, value)
+	}
+	if _u.mutation.RuleSeverityCleared() {
+		_spec.ClearField(bronzes1alert.FieldRuleSeverity, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleDescription(); ok {
+		_spec.SetField(bronzes1alert.FieldRuleDescription, field.TypeString, value)
+	}
+	if _u.mutation.RuleDescriptionCleared() {
+		_spec.ClearField(bronzes1alert.FieldRuleDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleScopeLevel(); ok {
+		_spec.SetField(bronzes1alert.FieldRuleScopeLevel, field.TypeString, value)
+	}
+	if _u.mutation.RuleScopeLevelCleared() {
+		_spec.ClearField(bronzes1alert.FieldRuleScopeLevel, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleTreatAsThreat(); ok {
+		_spec.SetField(bronzes1alert.FieldRuleTreatAsThreat, field.TypeString, value)
+	}
+	if _u.mutation.RuleTreatAsThreatCleared() {
+		_spec.ClearField(bronzes1alert.FieldRuleTreatAsThreat, field.TypeString)
+	}
+	if value, ok := _u.mutation.AnalystVerdict(); ok {
+		_spec.SetField(bronzes1alert.FieldAnalystVerdict, field.TypeString, value)
+	}
+	if _u.mutation.AnalystVerdictCleared() {
+		_spec.ClearField(bronzes1alert.FieldAnalystVerdict, field.TypeString)
+	}
+	if value, ok := _u.mutation.IncidentStatus(); ok {
+		_spec.SetField(bronzes1alert.FieldIncidentStatus, field.TypeString, value)
+	}
+	if _u.mutation.IncidentStatusCleared() {
+		_spec.ClearField(bronzes1alert.FieldIncidentStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(bronzes1alert.FieldEventType, field.TypeString, value)
+	}
+	if _u.mutation.EventTypeCleared() {
+		_spec.ClearField(bronzes1alert.FieldEventType, field.TypeString)
+	}
+	if value, ok := _u.mutation.HitType(); ok {
+		_spec.SetField(bronzes1alert.FieldHitType, field.TypeString, value)
+	}
+	if _u.mutation.HitTypeCleared() {
+		_spec.ClearField(bronzes1alert.FieldHitType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(bronzes1alert.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(bronzes1alert.FieldSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.DvEventID(); ok {
+		_spec.SetField(bronzes1alert.FieldDvEventID, field.TypeString, value)
+	}
+	if _u.mutation.DvEventIDCleared() {
+		_spec.ClearField(bronzes1alert.FieldDvEventID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsEdr(); ok {
+		_spec.SetField(bronzes1alert.FieldIsEdr, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ReportedAt(); ok {
+		_spec.SetField(bronzes1alert.FieldReportedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReportedAtCleared() {
+		_spec.ClearField(bronzes1alert.FieldReportedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzes1alert.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzes1alert.FieldAPICreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzes1alert.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzes1alert.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SourceProcessJSON(); ok {
+		_spec.SetField(bronzes1alert.FieldSourceProcessJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSourceProcessJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzes1alert.FieldSourceProcessJSON, value)
+		})
+	}
+	if _u.mutation.SourceProcessJSONCleared() {
+		_spec.ClearField(bronzes1alert.FieldSourceProcessJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TargetProcessJSON(); ok {
+		_spec.SetField(bronzes1alert.FieldTargetProcessJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTargetProcessJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzes1alert.FieldTargetProcessJSON, value)
+		})
+	}
+	if _u.mutation.TargetProcessJSONCleared() {
+		_spec.ClearField(bronzes1alert.FieldTargetProcessJSON, field.TypeJSON)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeS1Alert
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzes1alert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeS1AlertUpdateOne is the builder for updating a single BronzeS1Alert entity.
+type BronzeS1AlertUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeS1AlertMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeS1AlertUpdateOne) SetCollectedAt(v time.Time) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetAgentID sets the "agent_id" field.
+func (_u *BronzeS1AlertUpdateOne) SetAgentID(v string) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetAgentID(v)
+	return _u
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableAgentID(v *string) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetAgentID(*v)
+	}
+	return _u
+}
+
+// ClearAgentID clears the value of the "agent_id" field.
+func (_u *BronzeS1AlertUpdateOne) ClearAgentID() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearAgentID()
+	return _u
+}
+
+// SetAgentUUID sets the "agent_uuid" field.
+func (_u *BronzeS1AlertUpdateOne) SetAgentUUID(v string) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetAgentUUID(v)
+	return _u
+}
+
+// SetNillableAgentUUID sets the "agent_uuid" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableAgentUUID(v *string) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetAgentUUID(*v)
+	}
+	return _u
+}
+
+// ClearAgentUUID clears the value of the "agent_uuid" field.
+func (_u *BronzeS1AlertUpdateOne) ClearAgentUUID() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearAgentUUID()
+	return _u
+}
+
+// SetAgentName sets the "agent_name" field.
+func (_u *BronzeS1AlertUpdateOne) SetAgentName(v string) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetAgentName(v)
+	return _u
+}
+
+// SetNillableAgentName sets the "agent_name" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableAgentName(v *string) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetAgentName(*v)
+	}
+	return _u
+}
+
+// ClearAgentName clears the value of the "agent_name" field.
+func (_u *BronzeS1AlertUpdateOne) ClearAgentName() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearAgentName()
+	return _u
+}
+
+// SetAgentOsName sets the "agent_os_name" field.
+func (_u *BronzeS1AlertUpdateOne) SetAgentOsName(v string) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetAgentOsName(v)
+	return _u
+}
+
+// SetNillableAgentOsName sets the "agent_os_name" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableAgentOsName(v *string) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetAgentOsName(*v)
+	}
+	return _u
+}
+
+// ClearAgentOsName clears the value of the "agent_os_name" field.
+func (_u *BronzeS1AlertUpdateOne) ClearAgentOsName() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearAgentOsName()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeS1AlertUpdateOne) SetSiteID(v string) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableSiteID(v *string) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeS1AlertUpdateOne) ClearSiteID() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetRuleID sets the "rule_id" field.
+func (_u *BronzeS1AlertUpdateOne) SetRuleID(v string) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetRuleID(v)
+	return _u
+}
+
+// SetNillableRuleID sets the "rule_id" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableRuleID(v *string) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetRuleID(*v)
+	}
+	return _u
+}
+
+// ClearRuleID clears the value of the "rule_id" field.
+func (_u *BronzeS1AlertUpdateOne) ClearRuleID() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearRuleID()
+	return _u
+}
+
+// SetRuleName sets the "rule_name" field.
+func (_u *BronzeS1AlertUpdateOne) SetRuleName(v string) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetRuleName(v)
+	return _u
+}
+
+// SetNillableRuleName sets the "rule_name" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableRuleName(v *string) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetRuleName(*v)
+	}
+	return _u
+}
+
+// ClearRuleName clears the value of the "rule_name" field.
+func (_u *BronzeS1AlertUpdateOne) ClearRuleName() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearRuleName()
+	return _u
+}
+
+// SetRuleSeverity sets the "rule_severity" field.
+func (_u *BronzeS1AlertUpdateOne) SetRuleSeverity(v string) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetRuleSeverity(v)
+	return _u
+}
+
+// SetNillableRuleSeverity sets the "rule_severity" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableRuleSeverity(v *string) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetRuleSeverity(*v)
+	}
+	return _u
+}
+
+// ClearRuleSeverity clears the value of the "rule_severity" field.
+func (_u *BronzeS1AlertUpdateOne) ClearRuleSeverity() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearRuleSeverity()
+	return _u
+}
+
+// SetRuleDescription sets the "rule_description" field.
+func (_u *BronzeS1AlertUpdateOne) SetRuleDescription(v string) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetRuleDescription(v)
+	return _u
+}
+
+// SetNillableRuleDescription sets the "rule_description" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableRuleDescription(v *string) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetRuleDescription(*v)
+	}
+	return _u
+}
+
+// ClearRuleDescription clears the value of the "rule_description" field.
+func (_u *BronzeS1AlertUpdateOne) ClearRuleDescription() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearRuleDescription()
+	return _u
+}
+
+// SetRuleScopeLevel sets the "rule_scope_level" field.
+func (_u *BronzeS1AlertUpdateOne) SetRuleScopeLevel(v string) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetRuleScopeLevel(v)
+	return _u
+}
+
+// SetNillableRuleScopeLevel sets the "rule_scope_level" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableRuleScopeLevel(v *string) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetRuleScopeLevel(*v)
+	}
+	return _u
+}
+
+// ClearRuleScopeLevel clears the value of the "rule_scope_level" field.
+func (_u *BronzeS1AlertUpdateOne) ClearRuleScopeLevel() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearRuleScopeLevel()
+	return _u
+}
+
+// SetRuleTreatAsThreat sets the "rule_treat_as_threat" field.
+func (_u *BronzeS1AlertUpdateOne) SetRuleTreatAsThreat(v string) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetRuleTreatAsThreat(v)
+	return _u
+}
+
+// SetNillableRuleTreatAsThreat sets the "rule_treat_as_threat" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableRuleTreatAsThreat(v *string) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetRuleTreatAsThreat(*v)
+	}
+	return _u
+}
+
+// ClearRuleTreatAsThreat clears the value of the "rule_treat_as_threat" field.
+func (_u *BronzeS1AlertUpdateOne) ClearRuleTreatAsThreat() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearRuleTreatAsThreat()
+	return _u
+}
+
+// SetAnalystVerdict sets the "analyst_verdict" field.
+func (_u *BronzeS1AlertUpdateOne) SetAnalystVerdict(v string) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetAnalystVerdict(v)
+	return _u
+}
+
+// SetNillableAnalystVerdict sets the "analyst_verdict" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableAnalystVerdict(v *string) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetAnalystVerdict(*v)
+	}
+	return _u
+}
+
+// ClearAnalystVerdict clears the value of the "analyst_verdict" field.
+func (_u *BronzeS1AlertUpdateOne) ClearAnalystVerdict() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearAnalystVerdict()
+	return _u
+}
+
+// SetIncidentStatus sets the "incident_status" field.
+func (_u *BronzeS1AlertUpdateOne) SetIncidentStatus(v string) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetIncidentStatus(v)
+	return _u
+}
+
+// SetNillableIncidentStatus sets the "incident_status" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableIncidentStatus(v *string) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetIncidentStatus(*v)
+	}
+	return _u
+}
+
+// ClearIncidentStatus clears the value of the "incident_status" field.
+func (_u *BronzeS1AlertUpdateOne) ClearIncidentStatus() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearIncidentStatus()
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *BronzeS1AlertUpdateOne) SetEventType(v string) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableEventType(v *string) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (_u *BronzeS1AlertUpdateOne) ClearEventType() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearEventType()
+	return _u
+}
+
+// SetHitType sets the "hit_type" field.
+func (_u *BronzeS1AlertUpdateOne) SetHitType(v string) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetHitType(v)
+	return _u
+}
+
+// SetNillableHitType sets the "hit_type" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableHitType(v *string) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetHitType(*v)
+	}
+	return _u
+}
+
+// ClearHitType clears the value of the "hit_type" field.
+func (_u *BronzeS1AlertUpdateOne) ClearHitType() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearHitType()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BronzeS1AlertUpdateOne) SetSource(v string) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableSource(v *string) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *BronzeS1AlertUpdateOne) ClearSource() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// SetDvEventID sets the "dv_event_id" field.
+func (_u *BronzeS1AlertUpdateOne) SetDvEventID(v string) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetDvEventID(v)
+	return _u
+}
+
+// SetNillableDvEventID sets the "dv_event_id" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableDvEventID(v *string) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetDvEventID(*v)
+	}
+	return _u
+}
+
+// ClearDvEventID clears the value of the "dv_event_id" field.
+func (_u *BronzeS1AlertUpdateOne) ClearDvEventID() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearDvEventID()
+	return _u
+}
+
+// SetIsEdr sets the "is_edr" field.
+func (_u *BronzeS1AlertUpdateOne) SetIsEdr(v bool) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetIsEdr(v)
+	return _u
+}
+
+// SetNillableIsEdr sets the "is_edr" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableIsEdr(v *bool) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetIsEdr(*v)
+	}
+	return _u
+}
+
+// SetReportedAt sets the "reported_at" field.
+func (_u *BronzeS1AlertUpdateOne) SetReportedAt(v time.Time) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetReportedAt(v)
+	return _u
+}
+
+// SetNillableReportedAt sets the "reported_at" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableReportedAt(v *time.Time) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetReportedAt(*v)
+	}
+	return _u
+}
+
+// ClearReportedAt clears the value of the "reported_at" field.
+func (_u *BronzeS1AlertUpdateOne) ClearReportedAt() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearReportedAt()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeS1AlertUpdateOne) SetAPICreatedAt(v time.Time) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeS1AlertUpdateOne) ClearAPICreatedAt() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeS1AlertUpdateOne) SetAPIUpdatedAt(v time.Time) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeS1AlertUpdateOne) SetNillableAPIUpdatedAt(v *time.Time) *BronzeS1AlertUpdateOne {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeS1AlertUpdateOne) ClearAPIUpdatedAt() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// SetSourceProcessJSON sets the "source_process_json" field.
+func (_u *BronzeS1AlertUpdateOne) SetSourceProcessJSON(v json.RawMessage) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetSourceProcessJSON(v)
+	return _u
+}
+
+// AppendSourceProcessJSON appends value to the "source_process_json" field.
+func (_u *BronzeS1AlertUpdateOne) AppendSourceProcessJSON(v json.RawMessage) *BronzeS1AlertUpdateOne {
+	_u.mutation.AppendSourceProcessJSON(v)
+	return _u
+}
+
+// ClearSourceProcessJSON clears the value of the "source_process_json" field.
+func (_u *BronzeS1AlertUpdateOne) ClearSourceProcessJSON() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearSourceProcessJSON()
+	return _u
+}
+
+// SetTargetProcessJSON sets the "target_process_json" field.
+func (_u *BronzeS1AlertUpdateOne) SetTargetProcessJSON(v json.RawMessage) *BronzeS1AlertUpdateOne {
+	_u.mutation.SetTargetProcessJSON(v)
+	return _u
+}
+
+// AppendTargetProcessJSON appends value to the "target_process_json" field.
+func (_u *BronzeS1AlertUpdateOne) AppendTargetProcessJSON(v json.RawMessage) *BronzeS1AlertUpdateOne {
+	_u.mutation.AppendTargetProcessJSON(v)
+	return _u
+}
+
+// ClearTargetProcessJSON clears the value of the "target_process_json" field.
+func (_u *BronzeS1AlertUpdateOne) ClearTargetProcessJSON() *BronzeS1AlertUpdateOne {
+	_u.mutation.ClearTargetProcessJSON()
+	return _u
+}
+
+// Mutation returns the BronzeS1AlertMutation object of the builder.
+func (_u *BronzeS1AlertUpdateOne) Mutation() *BronzeS1AlertMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeS1AlertUpdate builder.
+func (_u *BronzeS1AlertUpdateOne) Where(ps ...predicate.BronzeS1Alert) *BronzeS1AlertUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeS1AlertUpdateOne) Select(field string, fields ...string) *BronzeS1AlertUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeS1Alert entity.
+func (_u *BronzeS1AlertUpdateOne) Save(ctx context.Context) (*BronzeS1Alert, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeS1AlertUpdateOne) SaveX(ctx context.Context) *BronzeS1Alert {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeS1AlertUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeS1AlertUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BronzeS1AlertUpdateOne) sqlSave(ctx context.Context) (_node *BronzeS1Alert, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bronzes1alert.Table, bronzes1alert.Columns, sqlgraph.NewFieldSpec(bronzes1alert.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`s1: missing "BronzeS1Alert.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzes1alert.FieldID)
+		for _, f := range fields {
+			if !bronzes1alert.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("s1: invalid field %q for query", f)}
+			}
+			if f != bronzes1alert.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1alert.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.AgentID(); ok {
+		_spec.SetField(bronzes1alert.FieldAgentID, field.TypeString, value)
+	}
+	if _u.mutation.AgentIDCleared() {
+		_spec.ClearField(bronzes1alert.FieldAgentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentUUID(); ok {
+		_spec.SetField(bronzes1alert.FieldAgentUUID, field.TypeString, value)
+	}
+	if _u.mutation.AgentUUIDCleared() {
+		_spec.ClearField(bronzes1alert.FieldAgentUUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentName(); ok {
+		_spec.SetField(bronzes1alert.FieldAgentName, field.TypeString, value)
+	}
+	if _u.mutation.AgentNameCleared() {
+		_spec.ClearField(bronzes1alert.FieldAgentName, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentOsName(); ok {
+		_spec.SetField(bronzes1alert.FieldAgentOsName, field.TypeString, value)
+	}
+	if _u.mutation.AgentOsNameCleared() {
+		_spec.ClearField(bronzes1alert.FieldAgentOsName, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzes1alert.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzes1alert.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleID(); ok {
+		_spec.SetField(bronzes1alert.FieldRuleID, field.TypeString, value)
+	}
+	if _u.mutation.RuleIDCleared() {
+		_spec.ClearField(bronzes1alert.FieldRuleID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleName(); ok {
+		_spec.SetField(bronzes1alert.FieldRuleName, field.TypeString, value)
+	}
+	if _u.mutation.RuleNameCleared() {
+		_spec.ClearField(bronzes1alert.FieldRuleName, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleSeverity(); ok {
+		_spec.SetField(bronzes1alert.FieldRuleSeverity, field.TypeString, value)
+	}
+	if _u.mutation.RuleSeverityCleared() {
+		_spec.ClearField(bronzes1alert.FieldRuleSeverity, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleDescription(); ok {
+		_spec.SetField(bronzes1alert.FieldRuleDescription, field.TypeString, value)
+	}
+	if _u.mutation.RuleDescriptionCleared() {
+		_spec.ClearField(bronzes1alert.FieldRuleDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleScopeLevel(); ok {
+		_spec.SetField(bronzes1alert.FieldRuleScopeLevel, field.TypeString, value)
+	}
+	if _u.mutation.RuleScopeLevelCleared() {
+		_spec.ClearField(bronzes1alert.FieldRuleScopeLevel, field.TypeString)
+	}
+	if value, ok := _u.mutation.RuleTreatAsThreat(); ok {
+		_spec.SetField(bronzes1alert.FieldRuleTreatAsThreat, field.TypeString, value)
+	}
+	if _u.mutation.RuleTreatAsThreatCleared() {
+		_spec.ClearField(bronzes1alert.FieldRuleTreatAsThreat, field.TypeString)
+	}
+	if value, ok := _u.mutation.AnalystVerdict(); ok {
+		_spec.SetField(bronzes1alert.FieldAnalystVerdict, field.TypeString, value)
+	}
+	if _u.mutation.AnalystVerdictCleared() {
+		_spec.ClearField(bronzes1alert.FieldAnalystVerdict, field.TypeString)
+	}
+	if value, ok := _u.mutation.IncidentStatus(); ok {
+		_spec.SetField(bronzes1alert.FieldIncidentStatus, field.TypeString, value)
+	}
+	if _u.mutation.IncidentStatusCleared() {
+		_spec.ClearField(bronzes1alert.FieldIncidentStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(bronzes1alert.FieldEventType, field.TypeString, value)
+	}
+	if _u.mutation.EventTypeCleared() {
+		_spec.ClearField(bronzes1alert.FieldEventType, field.TypeString)
+	}
+	if value, ok := _u.mutation.HitType(); ok {
+		_spec.SetField(bronzes1alert.FieldHitType, field.TypeString, value)
+	}
+	if _u.mutation.HitTypeCleared() {
+		_spec.ClearField(bronzes1alert.FieldHitType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(bronzes1alert.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(bronzes1alert.FieldSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.DvEventID(); ok {
+		_spec.SetField(bronzes1alert.FieldDvEventID, field.TypeString, value)
+	}
+	if _u.mutation.DvEventIDCleared() {
+		_spec.ClearField(bronzes1alert.FieldDvEventID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsEdr(); ok {
+		_spec.SetField(bronzes1alert.FieldIsEdr, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ReportedAt(); ok {
+		_spec.SetField(bronzes1alert.FieldReportedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReportedAtCleared() {
+		_spec.ClearField(bronzes1alert.FieldReportedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzes1alert.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzes1alert.FieldAPICreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzes1alert.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzes1alert.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SourceProcessJSON(); ok {
+		_spec.SetField(bronzes1alert.FieldSourceProcessJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSourceProcessJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzes1alert.FieldSourceProcessJSON, value)
+		})
+	}
+	if _u.mutation.SourceProcessJSONCleared() {
+		_spec.ClearField(bronzes1alert.FieldSourceProcessJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TargetProcessJSON(); ok {
+		_spec.SetField(bronzes1alert.FieldTargetProcessJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTargetProcessJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzes1alert.FieldTargetProcessJSON, value)
+		})
+	}
+	if _u.mutation.TargetProcessJSONCleared() {
+		_spec.ClearField(bronzes1alert.FieldTargetProcessJSON, field.TypeJSON)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeS1Alert
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeS1Alert{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzes1alert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1appcve"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeS1AppCVE is the model entity for the BronzeS1AppCVE schema.
+type BronzeS1AppCVE struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Synthesized: endpointId||cveId||applicationName||applicationVersion
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// SentinelOne agent (endpoint) ID; joins to s1_agents.resource_id
+	AgentID string `json:"agent_id,omitempty"`
+	// EndpointName holds the value of the "endpoint_name" field.
+	EndpointName string `json:"endpoint_name,omitempty"`
+	// EndpointType holds the value of the "endpoint_type" field.
+	EndpointType string `json:"endpoint_type,omitempty"`
+	// OsType holds the value of the "os_type" field.
+	OsType string `json:"os_type,omitempty"`
+	// ApplicationName holds the value of the "application_name" field.
+	ApplicationName string `json:"application_name,omitempty"`
+	// ApplicationVendor holds the value of the "application_vendor" field.
+	ApplicationVendor string `json:"application_vendor,omitempty"`
+	// ApplicationVersion holds the value of the "application_version" field.
+	ApplicationVersion string `json:"application_version,omitempty"`
+	// e.g. CVE-2024-3094
+	CveID string `json:"cve_id,omitempty"`
+	// NVD CVSS base score
+	CvssScore float64 `json:"cvss_score,omitempty"`
+	// NVD CVSS version of cvss_score, e.g. 3.1
+	CvssVersion string `json:"cvss_version,omitempty"`
+	// NVD severity: LOW, MEDIUM, HIGH, CRITICAL
+	NvdSeverity string `json:"nvd_severity,omitempty"`
+	// SentinelOne risk score
+	RiskScore float64 `json:"risk_score,omitempty"`
+	// e.g. UNPROVEN, PROOF_OF_CONCEPT, FUNCTIONAL, HIGH
+	ExploitCodeMaturity string `json:"exploit_code_maturity,omitempty"`
+	// Known exploited (e.g. listed in CISA KEV)
+	ExploitedInTheWild bool `json:"exploited_in_the_wild,omitempty"`
+	// RemediationLevel holds the value of the "remediation_level" field.
+	RemediationLevel string `json:"remediation_level,omitempty"`
+	// ReportConfidence holds the value of the "report_confidence" field.
+	ReportConfidence string `json:"report_confidence,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// PublishedDate holds the value of the "published_date" field.
+	PublishedDate *time.Time `json:"published_date,omitempty"`
+	// DetectionDate holds the value of the "detection_date" field.
+	DetectionDate *time.Time `json:"detection_date,omitempty"`
+	selectValues  sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeS1AppCVE) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzes1appcve.FieldExploitedInTheWild:
+			values[i] = new(sql.NullBool)
+		case bronzes1appcve.FieldCvssScore, bronzes1appcve.FieldRiskScore:
+			values[i] = new(sql.NullFloat64)
+		case bronzes1appcve.FieldID, bronzes1appcve.FieldAgentID, bronzes1appcve.FieldEndpointName, bronzes1appcve.FieldEndpointType, bronzes1appcve.FieldOsType, bronzes1appcve.FieldApplicationName, bronzes1appcve.FieldApplicationVendor, bronzes1appcve.FieldApplicationVersion, bronzes1appcve.FieldCveID, bronzes1appcve.FieldCvssVersion, bronzes1appcve.FieldNvdSeverity, bronzes1appcve.FieldExploitCodeMaturity, bronzes1appcve.FieldRemediationLevel, bronzes1appcve.FieldReportConfidence, bronzes1appcve.FieldStatus:
+			values[i] = new(sql.NullString)
+		case bronzes1appcve.FieldCollectedAt, bronzes1appcve.FieldFirstCollectedAt, bronzes1appcve.FieldPublishedDate, bronzes1appcve.FieldDetectionDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeS1AppCVE fields.
+func (_m *BronzeS1AppCVE) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzes1appcve.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzes1appcve.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzes1appcve.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzes1appcve.FieldAgentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_id", values[i])
+			} else if value.Valid {
+				_m.AgentID = value.String
+			}
+		case bronzes1appcve.FieldEndpointName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field endpoint_name", values[i])
+			} else if value.Valid {
+				_m.EndpointName = value.String
+			}
+		case bronzes1appcve.FieldEndpointType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field endpoint_type", values[i])
+			} else if value.Valid {
+				_m.EndpointType = value.String
+			}
+		case bronzes1appcve.FieldOsType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field os_type", values[i])
+			} else if value.Valid {
+				_m.OsType = value.String
+			}
+		case bronzes1appcve.FieldApplicationName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field application_name", values[i])
+			} else if value.Valid {
+				_m.ApplicationName = value.String
+			}
+		case bronzes1appcve.FieldApplicationVendor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field application_vendor", values[i])
+			} else if value.Valid {
+				_m.ApplicationVendor = value.String
+			}
+		case bronzes1appcve.FieldApplicationVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field application_version", values[i])
+			} else if value.Valid {
+				_m.ApplicationVersion = value.String
+			}
+		case bronzes1appcve.FieldCveID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cve_id", values[i])
+			} else if value.Valid {
+				_m.CveID = value.String
+			}
+		case bronzes1appcve.FieldCvssScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cvss_score", values[i])
+			} else if value.Valid {
+				_m.CvssScore = value.Float64
+			}
+		case bronzes1appcve.FieldCvssVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cvss_version", values[i])
+			} else if value.Valid {
+				_m.CvssVersion = value.String
+			}
+		case bronzes1appcve.FieldNvdSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nvd_severity", values[i])
+			} else if value.Valid {
+				_m.NvdSeverity = value.String
+			}
+		case bronzes1appcve.FieldRiskScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field risk_score", values[i])
+			} else if value.Valid {
+				_m.RiskScore = value.Float64
+			}
+		case bronzes1appcve.FieldExploitCodeMaturity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exploit_code_maturity", values[i])
+			} else if value.Valid {
+				_m.ExploitCodeMaturity = value.String
+			}
+		case bronzes1appcve.FieldExploitedInTheWild:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field exploited_in_the_wild", values[i])
+			} else if value.Valid {
+				_m.ExploitedInTheWild = value.Bool
+			}
+		case bronzes1appcve.FieldRemediationLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remediation_level", values[i])
+			} else if value.Valid {
+				_m.RemediationLevel = value.String
+			}
+		case bronzes1appcve.FieldReportConfidence:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field report_confidence", values[i])
+			} else if value.Valid {
+				_m.ReportConfidence = value.String
+			}
+		case bronzes1appcve.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case bronzes1appcve.FieldPublishedDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published_date", values[i])
+			} else if value.Valid {
+				_m.PublishedDate = new(time.Time)
+				*_m.PublishedDate = value.Time
+			}
+		case bronzes1appcve.FieldDetectionDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field detection_date", values[i])
+			} else if value.Valid {
+				_m.DetectionDate = new(time.Time)
+				*_m.DetectionDate = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeS1AppCVE.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeS1AppCVE) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeS1AppCVE.
+// Note that you need to call BronzeS1AppCVE.Unwrap() before calling this method if this BronzeS1AppCVE
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeS1AppCVE) Update() *BronzeS1AppCVEUpdateOne {
+	return NewBronzeS1AppCVEClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeS1AppCVE entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeS1AppCVE) Unwrap() *BronzeS1AppCVE {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("s1: BronzeS1AppCVE is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeS1AppCVE) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeS1AppCVE(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("agent_id=")
+	builder.WriteString(_m.AgentID)
+	builder.WriteString(", ")
+	builder.WriteString("endpoint_name=")
+	builder.WriteString(_m.EndpointName)
+	builder.WriteString(", ")
+	builder.WriteString("endpoint_type=")
+	builder.WriteString(_m.EndpointType)
+	builder.WriteString(", ")
+	builder.WriteString("os_type=")
+	builder.WriteString(_m.OsType)
+	builder.WriteString(", ")
+	builder.WriteString("application_name=")
+	builder.WriteString(_m.ApplicationName)
+	builder.WriteString(", ")
+	builder.WriteString("application_vendor=")
+	builder.WriteString(_m.ApplicationVendor)
+	builder.WriteString(", ")
+	builder.WriteString("application_version=")
+	builder.WriteString(_m.ApplicationVersion)
+	builder.WriteString(", ")
+	builder.WriteString("cve_id=")
+	builder.WriteString(_m.CveID)
+	builder.WriteString(", ")
+	builder.WriteString("cvss_score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CvssScore))
+	builder.WriteString(", ")
+	builder.WriteString("cvss_version=")
+	builder.WriteString(_m.CvssVersion)
+	builder.WriteString(", ")
+	builder.WriteString("nvd_severity=")
+	builder.WriteString(_m.NvdSeverity)
+	builder.WriteString(", ")
+	builder.WriteString("risk_score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RiskScore))
+	builder.WriteString(", ")
+	builder.WriteString("exploit_code_maturity=")
+	builder.WriteString(_m.ExploitCodeMaturity)
+	builder.WriteString(", ")
+	builder.WriteString("exploited_in_the_wild=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExploitedInTheWild))
+	builder.WriteString(", ")
+	builder.WriteString("remediation_level=")
+	builder.WriteString(_m.RemediationLevel)
+	builder.WriteString(", ")
+	builder.WriteString("report_confidence=")
+	builder.WriteString(_m.ReportConfidence)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.PublishedDate; v != nil {
+		builder.WriteString("published_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DetectionDate; v != nil {
+		builder.WriteString("detection_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeS1AppCVEs is a parsable slice of BronzeS1AppCVE.
+type BronzeS1AppCVEs []*BronzeS1AppCVE
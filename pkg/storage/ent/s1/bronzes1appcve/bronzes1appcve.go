@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzes1appcve
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzes1appcve type in the database.
+	Label = "bronze_s1app_cve"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldAgentID holds the string denoting the agent_id field in the database.
+	FieldAgentID = "agent_id"
+	// FieldEndpointName holds the string denoting the endpoint_name field in the database.
+	FieldEndpointName = "endpoint_name"
+	// FieldEndpointType holds the string denoting the endpoint_type field in the database.
+	FieldEndpointType = "endpoint_type"
+	// FieldOsType holds the string denoting the os_type field in the database.
+	FieldOsType = "os_type"
+	// FieldApplicationName holds the string denoting the application_name field in the database.
+	FieldApplicationName = "application_name"
+	// FieldApplicationVendor holds the string denoting the application_vendor field in the database.
+	FieldApplicationVendor = "application_vendor"
+	// FieldApplicationVersion holds the string denoting the application_version field in the database.
+	FieldApplicationVersion = "application_version"
+	// FieldCveID holds the string denoting the cve_id field in the database.
+	FieldCveID = "cve_id"
+	// FieldCvssScore holds the string denoting the cvss_score field in the database.
+	FieldCvssScore = "cvss_score"
+	// FieldCvssVersion holds the string denoting the cvss_version field in the database.
+	FieldCvssVersion = "cvss_version"
+	// FieldNvdSeverity holds the string denoting the nvd_severity field in the database.
+	FieldNvdSeverity = "nvd_severity"
+	// FieldRiskScore holds the string denoting the risk_score field in the database.
+	FieldRiskScore = "risk_score"
+	// FieldExploitCodeMaturity holds the string denoting the exploit_code_maturity field in the database.
+	FieldExploitCodeMaturity = "exploit_code_maturity"
+	// FieldExploitedInTheWild holds the string denoting the exploited_in_the_wild field in the database.
+	FieldExploitedInTheWild = "exploited_in_the_wild"
+	// FieldRemediationLevel holds the string denoting the remediation_level field in the database.
+	FieldRemediationLevel = "remediation_level"
+	// FieldReportConfidence holds the string denoting the report_confidence field in the database.
+	FieldReportConfidence = "report_confidence"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldPublishedDate holds the string denoting the published_date field in the database.
+	FieldPublishedDate = "published_date"
+	// FieldDetectionDate holds the string denoting the detection_date field in the database.
+	FieldDetectionDate = "detection_date"
+	// Table holds the table name of the bronzes1appcve in the database.
+	Table = "s1_app_cves"
+)
+
+// Columns holds all SQL columns for bronzes1appcve fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldAgentID,
+	FieldEndpointName,
+	FieldEndpointType,
+	FieldOsType,
+	FieldApplicationName,
+	FieldApplicationVendor,
+	FieldApplicationVersion,
+	FieldCveID,
+	FieldCvssScore,
+	FieldCvssVersion,
+	FieldNvdSeverity,
+	FieldRiskScore,
+	FieldExploitCodeMaturity,
+	FieldExploitedInTheWild,
+	FieldRemediationLevel,
+	FieldReportConfidence,
+	FieldStatus,
+	FieldPublishedDate,
+	FieldDetectionDate,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// AgentIDValidator is a validator for the "agent_id" field. It is called by the builders before save.
+	AgentIDValidator func(string) error
+	// ApplicationNameValidator is a validator for the "application_name" field. It is called by the builders before save.
+	ApplicationNameValidator func(string) error
+	// CveIDValidator is a validator for the "cve_id" field. It is called by the builders before save.
+	CveIDValidator func(string) error
+	// DefaultCvssScore holds the default value on creation for the "cvss_score" field.
+	DefaultCvssScore float64
+	// DefaultRiskScore holds the default value on creation for the "risk_score" field.
+	DefaultRiskScore float64
+	// DefaultExploitedInTheWild holds the default value on creation for the "exploited_in_the_wild" field.
+	DefaultExploitedInTheWild bool
+)
+
+// OrderOption defines the ordering options for the BronzeS1AppCVE queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByAgentID orders the results by the agent_id field.
+func ByAgentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgentID, opts...).ToFunc()
+}
+
+// ByEndpointName orders the results by the endpoint_name field.
+func ByEndpointName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndpointName, opts...).ToFunc()
+}
+
+// ByEndpointType orders the results by the endpoint_type field.
+func ByEndpointType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndpointType, opts...).ToFunc()
+}
+
+// ByOsType orders the results by the os_type field.
+func ByOsType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOsType, opts...).ToFunc()
+}
+
+// ByApplicationName orders the results by the application_name field.
+func ByApplicationName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApplicationName, opts...).ToFunc()
+}
+
+// ByApplicationVendor orders the results by the application_vendor field.
+func ByApplicationVendor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApplicationVendor, opts...).ToFunc()
+}
+
+// ByApplicationVersion orders the results by the application_version field.
+func ByApplicationVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApplicationVersion, opts...).ToFunc()
+}
+
+// ByCveID orders the results by the cve_id field.
+func ByCveID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCveID, opts...).ToFunc()
+}
+
+// ByCvssScore orders the results by the cvss_score field.
+func ByCvssScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCvssScore, opts...).ToFunc()
+}
+
+// ByCvssVersion orders the results by the cvss_version field.
+func ByCvssVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCvssVersion, opts...).ToFunc()
+}
+
+// ByNvdSeverity orders the results by the nvd_severity field.
+func ByNvdSeverity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNvdSeverity, opts...).ToFunc()
+}
+
+// ByRiskScore orders the results by the risk_score field.
+func ByRiskScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRiskScore, opts...).ToFunc()
+}
+
+// ByExploitCodeMaturity orders the results by the exploit_code_maturity field.
+func ByExploitCodeMaturity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExploitCodeMaturity, opts...).ToFunc()
+}
+
+// ByExploitedInTheWild orders the results by the exploited_in_the_wild field.
+func ByExploitedInTheWild(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExploitedInTheWild, opts...).ToFunc()
+}
+
+// ByRemediationLevel orders the results by the remediation_level field.
+func ByRemediationLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemediationLevel, opts...).ToFunc()
+}
+
+// ByReportConfidence orders the results by the report_confidence field.
+func ByReportConfidence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReportConfidence, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByPublishedDate orders the results by the published_date field.
+func ByPublishedDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishedDate, opts...).ToFunc()
+}
+
+// ByDetectionDate orders the results by the detection_date field.
+func ByDetectionDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDetectionDate, opts...).ToFunc()
+}
@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1appcve"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeS1AppCVECreate is the builder for creating a BronzeS1AppCVE entity.
+type BronzeS1AppCVECreate struct {
+	config
+	mutation *BronzeS1AppCVEMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeS1AppCVECreate) SetCollectedAt(v time.Time) *BronzeS1AppCVECreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeS1AppCVECreate) SetFirstCollectedAt(v time.Time) *BronzeS1AppCVECreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetAgentID sets the "agent_id" field.
+func (_c *BronzeS1AppCVECreate) SetAgentID(v string) *BronzeS1AppCVECreate {
+	_c.mutation.SetAgentID(v)
+	return _c
+}
+
+// SetEndpointName sets the "endpoint_name" field.
+func (_c *BronzeS1AppCVECreate) SetEndpointName(v string) *BronzeS1AppCVECreate {
+	_c.mutation.SetEndpointName(v)
+	return _c
+}
+
+// SetNillableEndpointName sets the "endpoint_name" field if the given value is not nil.
+func (_c *BronzeS1AppCVECreate) SetNillableEndpointName(v *string) *BronzeS1AppCVECreate {
+	if v != nil {
+		_c.SetEndpointName(*v)
+	}
+	return _c
+}
+
+// SetEndpointType sets the "endpoint_type" field.
+func (_c *BronzeS1AppCVECreate) SetEndpointType(v string) *BronzeS1AppCVECreate {
+	_c.mutation.SetEndpointType(v)
+	return _c
+}
+
+// SetNillableEndpointType sets the "endpoint_type" field if the given value is not nil.
+func (_c *BronzeS1AppCVECreate) SetNillableEndpointType(v *string) *BronzeS1AppCVECreate {
+	if v != nil {
+		_c.SetEndpointType(*v)
+	}
+	return _c
+}
+
+// SetOsType sets the "os_type" field.
+func (_c *BronzeS1AppCVECreate) SetOsType(v string) *BronzeS1AppCVECreate {
+	_c.mutation.SetOsType(v)
+	return _c
+}
+
+// SetNillableOsType sets the "os_type" field if the given value is not nil.
+func (_c *BronzeS1AppCVECreate) SetNillableOsType(v *string) *BronzeS1AppCVECreate {
+	if v != nil {
+		_c.SetOsType(*v)
+	}
+	return _c
+}
+
+// SetApplicationName sets the "application_name" field.
+func (_c *BronzeS1AppCVECreate) SetApplicationName(v string) *BronzeS1AppCVECreate {
+	_c.mutation.SetApplicationName(v)
+	return _c
+}
+
+// SetApplicationVendor sets the "application_vendor" field.
+func (_c *BronzeS1AppCVECreate) SetApplicationVendor(v string) *BronzeS1AppCVECreate {
+	_c.mutation.SetApplicationVendor(v)
+	return _c
+}
+
+// SetNillableApplicationVendor sets the "application_vendor" field if the given value is not nil.
+func (_c *BronzeS1AppCVECreate) SetNillableApplicationVendor(v *string) *BronzeS1AppCVECreate {
+	if v != nil {
+		_c.SetApplicationVendor(*v)
+	}
+	return _c
+}
+
+// SetApplicationVersion sets the "application_version" field.
+func (_c *BronzeS1AppCVECreate) SetApplicationVersion(v string) *BronzeS1AppCVECreate {
+	_c.mutation.SetApplicationVersion(v)
+	return _c
+}
+
+// SetNillableApplicationVersion sets the "application_version" field if the given value is not nil.
+func (_c *BronzeS1AppCVECreate) SetNillableApplicationVersion(v *string) *BronzeS1AppCVECreate {
+	if v != nil {
+		_c.SetApplicationVersion(*v)
+	}
+	return _c
+}
+
+// SetCveID sets the "cve_id" field.
+func (_c *BronzeS1AppCVECreate) SetCveID(v string) *BronzeS1AppCVECreate {
+	_c.mutation.SetCveID(v)
+	return _c
+}
+
+// SetCvssScore sets the "cvss_score" field.
+func (_c *BronzeS1AppCVECreate) SetCvssScore(v float64) *BronzeS1AppCVECreate {
+	_c.mutation.SetCvssScore(v)
+	return _c
+}
+
+// SetNillableCvssScore sets the "cvss_score" field if the given value is not nil.
+func (_c *BronzeS1AppCVECreate) SetNillableCvssScore(v *float64) *BronzeS1AppCVECreate {
+	if v != nil {
+		_c.SetCvssScore(*v)
+	}
+	return _c
+}
+
+// SetCvssVersion sets the "cvss_version" field.
+func (_c *BronzeS1AppCVECreate) SetCvssVersion(v string) *BronzeS1AppCVECreate {
+	_c.mutation.SetCvssVersion(v)
+	return _c
+}
+
+// SetNillableCvssVersion sets the "cvss_version" field if the given value is not nil.
+func (_c *BronzeS1AppCVECreate) SetNillableCvssVersion(v *string) *BronzeS1AppCVECreate {
+	if v != nil {
+		_c.SetCvssVersion(*v)
+	}
+	return _c
+}
+
+// SetNvdSeverity sets the "nvd_severity" field.
+func (_c *BronzeS1AppCVECreate) SetNvdSeverity(v string) *BronzeS1AppCVECreate {
+	_c.mutation.SetNvdSeverity(v)
+	return _c
+}
+
+// SetNillableNvdSeverity sets the "nvd_severity" field if the given value is not nil.
+func (_c *BronzeS1AppCVECreate) SetNillableNvdSeverity(v *string) *BronzeS1AppCVECreate {
+	if v != nil {
+		_c.SetNvdSeverity(*v)
+	}
+	return _c
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (_c *BronzeS1AppCVECreate) SetRiskScore(v float64) *BronzeS1AppCVECreate {
+	_c.mutation.SetRiskScore(v)
+	return _c
+}
+
+// SetNillableRiskScore sets the "risk_score" field if the given value is not nil.
+func (_c *BronzeS1AppCVECreate) SetNillableRiskScore(v *float64) *BronzeS1AppCVECreate {
+	if v != nil {
+		_c.SetRiskScore(*v)
+	}
+	return _c
+}
+
+// SetExploitCodeMaturity sets the "exploit_code_maturity" field.
+func (_c *BronzeS1AppCVECreate) SetExploitCodeMaturity(v string) *BronzeS1AppCVECreate {
+	_c.mutation.SetExploitCodeMaturity(v)
+	return _c
+}
+
+// SetNillableExploitCodeMaturity sets the "exploit_code_maturity" field if the given value is not nil.
+func (_c *BronzeS1AppCVECreate) SetNillableExploitCodeMaturity(v *string) *BronzeS1AppCVECreate {
+	if v != nil {
+		_c.SetExploitCodeMaturity(*v)
+	}
+	return _c
+}
+
+// SetExploitedInTheWild sets the "exploited_in_the_wild" field.
+func (_c *BronzeS1AppCVECreate) SetExploitedInTheWild(v bool) *BronzeS1AppCVECreate {
+	_c.mutation.SetExploitedInTheWild(v)
+	return _c
+}
+
+// SetNillableExploitedInTheWild sets the "exploited_in_the_wild" field if the given value is not nil.
+func (_c *BronzeS1AppCVECreate) SetNillableExploitedInTheWild(v *bool) *BronzeS1AppCVECreate {
+	if v != nil {
+		_c.SetExploitedInTheWild(*v)
+	}
+	return _c
+}
+
+// SetRemediationLevel sets the "remediation_level" field.
+func (_c *BronzeS1AppCVECreate) SetRemediationLevel(v string) *BronzeS1AppCVECreate {
+	_c.mutation.SetRemediationLevel(v)
+	return _c
+}
+
+// SetNillableRemediationLevel sets the "remediation_level" field if the given value is not nil.
+func (_c *BronzeS1AppCVECreate) SetNillableRemediationLevel(v *string) *BronzeS1AppCVECreate {
+	if v != nil {
+		_c.SetRemediationLevel(*v)
+	}
+	return _c
+}
+
+// SetReportConfidence sets the "report_confidence" field.
+func (_c *BronzeS1AppCVECreate) SetReportConfidence(v string) *BronzeS1AppCVECreate {
+	_c.mutation.SetReportConfidence(v)
+	return _c
+}
+
+// SetNillableReportConfidence sets the "report_confidence" field if the given value is not nil.
+func (_c *BronzeS1AppCVECreate) SetNillableReportConfidence(v *string) *BronzeS1AppCVECreate {
+	if v != nil {
+		_c.SetReportConfidence(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *BronzeS1AppCVECreate) SetStatus(v string) *BronzeS1AppCVECreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *BronzeS1AppCVECreate) SetNillableStatus(v *string) *BronzeS1AppCVECreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetPublishedDate sets the "published_date" field.
+func (_c *BronzeS1AppCVECreate) SetPublishedDate(v time.Time) *BronzeS1AppCVECreate {
+	_c.mutation.SetPublishedDate(v)
+	return _c
+}
+
+// SetNillablePublishedDate sets the "published_date" field if the given value is not nil.
+func (_c *BronzeS1AppCVECreate) SetNillablePublishedDate(v *time.Time) *BronzeS1AppCVECreate {
+	if v != nil {
+		_c.SetPublishedDate(*v)
+	}
+	return _c
+}
+
+// SetDetectionDate sets the "detection_date" field.
+func (_c *BronzeS1AppCVECreate) SetDetectionDate(v time.Time) *BronzeS1AppCVECreate {
+	_c.mutation.SetDetectionDate(v)
+	return _c
+}
+
+// SetNillableDetectionDate sets the "detection_date" field if the given value is not nil.
+func (_c *BronzeS1AppCVECreate) SetNillableDetectionDate(v *time.Time) *BronzeS1AppCVECreate {
+	if v != nil {
+		_c.SetDetectionDate(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeS1AppCVECreate) SetID(v string) *BronzeS1AppCVECreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeS1AppCVEMutation object of the builder.
+func (_c *BronzeS1AppCVECreate) Mutation() *BronzeS1AppCVEMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeS1AppCVE in the database.
+func (_c *BronzeS1AppCVECreate) Save(ctx context.Context) (*BronzeS1AppCVE, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeS1AppCVECreate) SaveX(ctx context.Context) *BronzeS1AppCVE {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeS1AppCVECreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeS1AppCVECreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeS1AppCVECreate) defaults() {
+	if _, ok := _c.mutation.CvssScore(); !ok {
+		v := bronzes1appcve.DefaultCvssScore
+		_c.mutation.SetCvssScore(v)
+	}
+	if _, ok := _c.mutation.RiskScore(); !ok {
+		v := bronzes1appcve.DefaultRiskScore
+		_c.mutation.SetRiskScore(v)
+	}
+	if _, ok := _c.mutation.ExploitedInTheWild(); !ok {
+		v := bronzes1appcve.DefaultExploitedInTheWild
+		_c.mutation.SetExploitedInTheWild(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeS1AppCVECreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`s1: missing required field "BronzeS1AppCVE.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`s1: missing required field "BronzeS1AppCVE.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.AgentID(); !ok {
+		return &ValidationError{Name: "agent_id", err: errors.New(`s1: missing required field "BronzeS1AppCVE.agent_id"`)}
+	}
+	if v, ok := _c.mutation.AgentID(); ok {
+		if err := bronzes1appcve.AgentIDValidator(v); err != nil {
+			return &ValidationError{Name: "agent_id", err: fmt.Errorf(`s1: validator failed for field "BronzeS1AppCVE.agent_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ApplicationName(); !ok {
+		return &ValidationError{Name: "application_name", err: errors.New(`s1: missing required field "BronzeS1AppCVE.application_name"`)}
+	}
+	if v, ok := _c.mutation.ApplicationName(); ok {
+		if err := bronzes1appcve.ApplicationNameValidator(v); err != nil {
+			return &ValidationError{Name: "application_name", err: fmt.Errorf(`s1: validator failed for field "BronzeS1AppCVE.application_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CveID(); !ok {
+		return &ValidationError{Name: "cve_id", err: errors.New(`s1: missing required field "BronzeS1AppCVE.cve_id"`)}
+	}
+	if v, ok := _c.mutation.CveID(); ok {
+		if err := bronzes1appcve.CveIDValidator(v); err != nil {
+			return &ValidationError{Name: "cve_id", err: fmt.Errorf(`s1: validator failed for field "BronzeS1AppCVE.cve_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CvssScore(); !ok {
+		return &ValidationError{Name: "cvss_score", err: errors.New(`s1: missing required field "BronzeS1AppCVE.cvss_score"`)}
+	}
+	if _, ok := _c.mutation.RiskScore(); !ok {
+		return &ValidationError{Name: "risk_score", err: errors.New(`s1: missing required field "BronzeS1AppCVE.risk_score"`)}
+	}
+	if _, ok := _c.mutation.ExploitedInTheWild(); !ok {
+		return &ValidationError{Name: "exploited_in_the_wild", err: errors.New(`s1: missing required field "BronzeS1AppCVE.exploited_in_the_wild"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeS1AppCVECreate) sqlSave(ctx context.Context) (*BronzeS1AppCVE, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeS1AppCVE.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeS1AppCVECreate) createSpec() (*BronzeS1AppCVE, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeS1AppCVE{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzes1appcve.Table, sqlgraph.NewFieldSpec(bronzes1appcve.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeS1AppCVE
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1appcve.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzes1appcve.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.AgentID(); ok {
+		_spec.SetField(bronzes1appcve.FieldAgentID, field.TypeString, value)
+		_node.AgentID = value
+	}
+	if value, ok := _c.mutation.EndpointName(); ok {
+		_spec.SetField(bronzes1appcve.FieldEndpointName, field.TypeString, value)
+		_node.EndpointName = value
+	}
+	if value, ok := _c.mutation.EndpointType(); ok {
+		_spec.SetField(bronzes1appcve.FieldEndpointType, field.TypeString, value)
+		_node.EndpointType = value
+	}
+	if value, ok := _c.mutation.OsType(); ok {
+		_spec.SetField(bronzes1appcve.FieldOsType, field.TypeString, value)
+		_node.OsType = value
+	}
+	if value, ok := _c.mutation.ApplicationName(); ok {
+		_spec.SetField(bronzes1appcve.FieldApplicationName, field.TypeString, value)
+		_node.ApplicationName = value
+	}
+	if value, ok := _c.mutation.ApplicationVendor(); ok {
+		_spec.SetField(bronzes1appcve.FieldApplicationVendor, field.TypeString, value)
+		_node.ApplicationVendor = value
+	}
+	if value, ok := _c.mutation.ApplicationVersion(); ok {
+		_spec.SetField(bronzes1appcve.FieldApplicationVersion, field.TypeString, value)
+		_node.ApplicationVersion = value
+	}
+	if value, ok := _c.mutation.CveID(); ok {
+		_spec.SetField(bronzes1appcve.FieldCveID, field.TypeString, value)
+		_node.CveID = value
+	}
+	if value, ok := _c.mutation.CvssScore(); ok {
+		_spec.SetField(bronzes1appcve.FieldCvssScore, field.TypeFloat64, value)
+		_node.CvssScore = value
+	}
+	if value, ok := _c.mutation.CvssVersion(); ok {
+		_spec.SetField(bronzes1appcve.FieldCvssVersion, field.TypeString, value)
+		_node.CvssVersion = value
+	}
+	if value, ok := _c.mutation.NvdSeverity(); ok {
+		_spec.SetField(bronzes1appcve.FieldNvdSeverity, field.TypeString, value)
+		_node.NvdSeverity = value
+	}
+	if value, ok := _c.mutation.RiskScore(); ok {
+		_spec.SetField(bronzes1appcve.FieldRiskScore, field.TypeFloat64, value)
+		_node.RiskScore = value
+	}
+	if value, ok := _c.mutation.ExploitCodeMaturity(); ok {
+		_spec.SetField(bronzes1appcve.FieldExploitCodeMaturity, field.TypeString, value)
+		_node.ExploitCodeMaturity = value
+	}
+	if value, ok := _c.mutation.ExploitedInTheWild(); ok {
+		_spec.SetField(bronzes1appcve.FieldExploitedInTheWild, field.TypeBool, value)
+		_node.ExploitedInTheWild = value
+	}
+	if value, ok := _c.mutation.RemediationLevel(); ok {
+		_spec.SetField(bronzes1appcve.FieldRemediationLevel, field.TypeString, value)
+		_node.RemediationLevel = value
+	}
+	if value, ok := _c.mutation.ReportConfidence(); ok {
+		_spec.SetField(bronzes1appcve.FieldReportConfidence, field.TypeString, value)
+		_node.ReportConfidence = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(bronzes1appcve.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.PublishedDate(); ok {
+		_spec.SetField(bronzes1appcve.FieldPublishedDate, field.TypeTime, value)
+		_node.PublishedDate = &value
+	}
+	if value, ok := _c.mutation.DetectionDate(); ok {
+		_spec.SetField(bronzes1appcve.FieldDetectionDate, field.TypeTime, value)
+		_node.DetectionDate = &value
+	}
+	return _node, _spec
+}
+
+// BronzeS1AppCVECreateBulk is the builder for creating many BronzeS1AppCVE entities in bulk.
+type BronzeS1AppCVECreateBulk struct {
+	config
+	err      error
+	builders []*BronzeS1AppCVECreate
+}
+
+// Save creates the BronzeS1AppCVE entities in the database.
+func (_c *BronzeS1AppCVECreateBulk) Save(ctx context.Context) ([]*BronzeS1AppCVE, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeS1AppCVE, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeS1AppCVEMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeS1AppCVECreateBulk) SaveX(ctx context.Context) []*BronzeS1AppCVE {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeS1AppCVECreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeS1AppCVECreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
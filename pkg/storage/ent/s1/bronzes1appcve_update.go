@@ -0,0 +1,1181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1appcve"
+	"danny.vn/hotpot/pkg/storage/ent/s1/internal"
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeS1AppCVEUpdate is the builder for updating BronzeS1AppCVE entities.
+type BronzeS1AppCVEUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeS1AppCVEMutation
+}
+
+// Where appends a list predicates to the BronzeS1AppCVEUpdate builder.
+func (_u *BronzeS1AppCVEUpdate) Where(ps ...predicate.BronzeS1AppCVE) *BronzeS1AppCVEUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeS1AppCVEUpdate) SetCollectedAt(v time.Time) *BronzeS1AppCVEUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillableCollectedAt(v *time.Time) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetAgentID sets the "agent_id" field.
+func (_u *BronzeS1AppCVEUpdate) SetAgentID(v string) *BronzeS1AppCVEUpdate {
+	_u.mutation.SetAgentID(v)
+	return _u
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillableAgentID(v *string) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetAgentID(*v)
+	}
+	return _u
+}
+
+// SetEndpointName sets the "endpoint_name" field.
+func (_u *BronzeS1AppCVEUpdate) SetEndpointName(v string) *BronzeS1AppCVEUpdate {
+	_u.mutation.SetEndpointName(v)
+	return _u
+}
+
+// SetNillableEndpointName sets the "endpoint_name" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillableEndpointName(v *string) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetEndpointName(*v)
+	}
+	return _u
+}
+
+// ClearEndpointName clears the value of the "endpoint_name" field.
+func (_u *BronzeS1AppCVEUpdate) ClearEndpointName() *BronzeS1AppCVEUpdate {
+	_u.mutation.ClearEndpointName()
+	return _u
+}
+
+// SetEndpointType sets the "endpoint_type" field.
+func (_u *BronzeS1AppCVEUpdate) SetEndpointType(v string) *BronzeS1AppCVEUpdate {
+	_u.mutation.SetEndpointType(v)
+	return _u
+}
+
+// SetNillableEndpointType sets the "endpoint_type" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillableEndpointType(v *string) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetEndpointType(*v)
+	}
+	return _u
+}
+
+// ClearEndpointType clears the value of the "endpoint_type" field.
+func (_u *BronzeS1AppCVEUpdate) ClearEndpointType() *BronzeS1AppCVEUpdate {
+	_u.mutation.ClearEndpointType()
+	return _u
+}
+
+// SetOsType sets the "os_type" field.
+func (_u *BronzeS1AppCVEUpdate) SetOsType(v string) *BronzeS1AppCVEUpdate {
+	_u.mutation.SetOsType(v)
+	return _u
+}
+
+// SetNillableOsType sets the "os_type" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillableOsType(v *string) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetOsType(*v)
+	}
+	return _u
+}
+
+// ClearOsType clears the value of the "os_type" field.
+func (_u *BronzeS1AppCVEUpdate) ClearOsType() *BronzeS1AppCVEUpdate {
+	_u.mutation.ClearOsType()
+	return _u
+}
+
+// SetApplicationName sets the "application_name" field.
+func (_u *BronzeS1AppCVEUpdate) SetApplicationName(v string) *BronzeS1AppCVEUpdate {
+	_u.mutation.SetApplicationName(v)
+	return _u
+}
+
+// SetNillableApplicationName sets the "application_name" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillableApplicationName(v *string) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetApplicationName(*v)
+	}
+	return _u
+}
+
+// SetApplicationVendor sets the "application_vendor" field.
+func (_u *BronzeS1AppCVEUpdate) SetApplicationVendor(v string) *BronzeS1AppCVEUpdate {
+	_u.mutation.SetApplicationVendor(v)
+	return _u
+}
+
+// SetNillableApplicationVendor sets the "application_vendor" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillableApplicationVendor(v *string) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetApplicationVendor(*v)
+	}
+	return _u
+}
+
+// ClearApplicationVendor clears the value of the "application_vendor" field.
+func (_u *BronzeS1AppCVEUpdate) ClearApplicationVendor() *BronzeS1AppCVEUpdate {
+	_u.mutation.ClearApplicationVendor()
+	return _u
+}
+
+// SetApplicationVersion sets the "application_version" field.
+func (_u *BronzeS1AppCVEUpdate) SetApplicationVersion(v string) *BronzeS1AppCVEUpdate {
+	_u.mutation.SetApplicationVersion(v)
+	return _u
+}
+
+// SetNillableApplicationVersion sets the "application_version" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillableApplicationVersion(v *string) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetApplicationVersion(*v)
+	}
+	return _u
+}
+
+// ClearApplicationVersion clears the value of the "application_version" field.
+func (_u *BronzeS1AppCVEUpdate) ClearApplicationVersion() *BronzeS1AppCVEUpdate {
+	_u.mutation.ClearApplicationVersion()
+	return _u
+}
+
+// SetCveID sets the "cve_id" field.
+func (_u *BronzeS1AppCVEUpdate) SetCveID(v string) *BronzeS1AppCVEUpdate {
+	_u.mutation.SetCveID(v)
+	return _u
+}
+
+// SetNillableCveID sets the "cve_id" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillableCveID(v *string) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetCveID(*v)
+	}
+	return _u
+}
+
+// SetCvssScore sets the "cvss_score" field.
+func (_u *BronzeS1AppCVEUpdate) SetCvssScore(v float64) *BronzeS1AppCVEUpdate {
+	_u.mutation.ResetCvssScore()
+	_u.mutation.SetCvssScore(v)
+	return _u
+}
+
+// SetNillableCvssScore sets the "cvss_score" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillableCvssScore(v *float64) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetCvssScore(*v)
+	}
+	return _u
+}
+
+// AddCvssScore adds value to the "cvss_score" field.
+func (_u *BronzeS1AppCVEUpdate) AddCvssScore(v float64) *BronzeS1AppCVEUpdate {
+	_u.mutation.AddCvssScore(v)
+	return _u
+}
+
+// SetCvssVersion sets the "cvss_version" field.
+func (_u *BronzeS1AppCVEUpdate) SetCvssVersion(v string) *BronzeS1AppCVEUpdate {
+	_u.mutation.SetCvssVersion(v)
+	return _u
+}
+
+// SetNillableCvssVersion sets the "cvss_version" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillableCvssVersion(v *string) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetCvssVersion(*v)
+	}
+	return _u
+}
+
+// ClearCvssVersion clears the value of the "cvss_version" field.
+func (_u *BronzeS1AppCVEUpdate) ClearCvssVersion() *BronzeS1AppCVEUpdate {
+	_u.mutation.ClearCvssVersion()
+	return _u
+}
+
+// SetNvdSeverity sets the "nvd_severity" field.
+func (_u *BronzeS1AppCVEUpdate) SetNvdSeverity(v string) *BronzeS1AppCVEUpdate {
+	_u.mutation.SetNvdSeverity(v)
+	return _u
+}
+
+// SetNillableNvdSeverity sets the "nvd_severity" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillableNvdSeverity(v *string) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetNvdSeverity(*v)
+	}
+	return _u
+}
+
+// ClearNvdSeverity clears the value of the "nvd_severity" field.
+func (_u *BronzeS1AppCVEUpdate) ClearNvdSeverity() *BronzeS1AppCVEUpdate {
+	_u.mutation.ClearNvdSeverity()
+	return _u
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (_u *BronzeS1AppCVEUpdate) SetRiskScore(v float64) *BronzeS1AppCVEUpdate {
+	_u.mutation.ResetRiskScore()
+	_u.mutation.SetRiskScore(v)
+	return _u
+}
+
+// SetNillableRiskScore sets the "risk_score" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillableRiskScore(v *float64) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetRiskScore(*v)
+	}
+	return _u
+}
+
+// AddRiskScore adds value to the "risk_score" field.
+func (_u *BronzeS1AppCVEUpdate) AddRiskScore(v float64) *BronzeS1AppCVEUpdate {
+	_u.mutation.AddRiskScore(v)
+	return _u
+}
+
+// SetExploitCodeMaturity sets the "exploit_code_maturity" field.
+func (_u *BronzeS1AppCVEUpdate) SetExploitCodeMaturity(v string) *BronzeS1AppCVEUpdate {
+	_u.mutation.SetExploitCodeMaturity(v)
+	return _u
+}
+
+// SetNillableExploitCodeMaturity sets the "exploit_code_maturity" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillableExploitCodeMaturity(v *string) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetExploitCodeMaturity(*v)
+	}
+	return _u
+}
+
+// ClearExploitCodeMaturity clears the value of the "exploit_code_maturity" field.
+func (_u *BronzeS1AppCVEUpdate) ClearExploitCodeMaturity() *BronzeS1AppCVEUpdate {
+	_u.mutation.ClearExploitCodeMaturity()
+	return _u
+}
+
+// SetExploitedInTheWild sets the "exploited_in_the_wild" field.
+func (_u *BronzeS1AppCVEUpdate) SetExploitedInTheWild(v bool) *BronzeS1AppCVEUpdate {
+	_u.mutation.SetExploitedInTheWild(v)
+	return _u
+}
+
+// SetNillableExploitedInTheWild sets the "exploited_in_the_wild" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillableExploitedInTheWild(v *bool) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetExploitedInTheWild(*v)
+	}
+	return _u
+}
+
+// SetRemediationLevel sets the "remediation_level" field.
+func (_u *BronzeS1AppCVEUpdate) SetRemediationLevel(v string) *BronzeS1AppCVEUpdate {
+	_u.mutation.SetRemediationLevel(v)
+	return _u
+}
+
+// SetNillableRemediationLevel sets the "remediation_level" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillableRemediationLevel(v *string) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetRemediationLevel(*v)
+	}
+	return _u
+}
+
+// ClearRemediationLevel clears the value of the "remediation_level" field.
+func (_u *BronzeS1AppCVEUpdate) ClearRemediationLevel() *BronzeS1AppCVEUpdate {
+	_u.mutation.ClearRemediationLevel()
+	return _u
+}
+
+// SetReportConfidence sets the "report_confidence" field.
+func (_u *BronzeS1AppCVEUpdate) SetReportConfidence(v string) *BronzeS1AppCVEUpdate {
+	_u.mutation.SetReportConfidence(v)
+	return _u
+}
+
+// SetNillableReportConfidence sets the "report_confidence" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillableReportConfidence(v *string) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetReportConfidence(*v)
+	}
+	return _u
+}
+
+// ClearReportConfidence clears the value of the "report_confidence" field.
+func (_u *BronzeS1AppCVEUpdate) ClearReportConfidence() *BronzeS1AppCVEUpdate {
+	_u.mutation.ClearReportConfidence()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BronzeS1AppCVEUpdate) SetStatus(v string) *BronzeS1AppCVEUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillableStatus(v *string) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *BronzeS1AppCVEUpdate) ClearStatus() *BronzeS1AppCVEUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetPublishedDate sets the "published_date" field.
+func (_u *BronzeS1AppCVEUpdate) SetPublishedDate(v time.Time) *BronzeS1AppCVEUpdate {
+	_u.mutation.SetPublishedDate(v)
+	return _u
+}
+
+// SetNillablePublishedDate sets the "published_date" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillablePublishedDate(v *time.Time) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetPublishedDate(*v)
+	}
+	return _u
+}
+
+// ClearPublishedDate clears the value of the "published_date" field.
+func (_u *BronzeS1AppCVEUpdate) ClearPublishedDate() *BronzeS1AppCVEUpdate {
+	_u.mutation.ClearPublishedDate()
+	return _u
+}
+
+// SetDetectionDate sets the "detection_date" field.
+func (_u *BronzeS1AppCVEUpdate) SetDetectionDate(v time.Time) *BronzeS1AppCVEUpdate {
+	_u.mutation.SetDetectionDate(v)
+	return _u
+}
+
+// SetNillableDetectionDate sets the "detection_date" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdate) SetNillableDetectionDate(v *time.Time) *BronzeS1AppCVEUpdate {
+	if v != nil {
+		_u.SetDetectionDate(*v)
+	}
+	return _u
+}
+
+// ClearDetectionDate clears the value of the "detection_date" field.
+func (_u *BronzeS1AppCVEUpdate) ClearDetectionDate() *BronzeS1AppCVEUpdate {
+	_u.mutation.ClearDetectionDate()
+	return _u
+}
+
+// Mutation returns the BronzeS1AppCVEMutation object of the builder.
+func (_u *BronzeS1AppCVEUpdate) Mutation() *BronzeS1AppCVEMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeS1AppCVEUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeS1AppCVEUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeS1AppCVEUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeS1AppCVEUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeS1AppCVEUpdate) check() error {
+	if v, ok := _u.mutation.AgentID(); ok {
+		if err := bronzes1appcve.AgentIDValidator(v); err != nil {
+			return &ValidationError{Name: "agent_id", err: fmt.Errorf(`s1: validator failed for field "BronzeS1AppCVE.agent_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ApplicationName(); ok {
+		if err := bronzes1appcve.ApplicationNameValidator(v); err != nil {
+			return &ValidationError{Name: "application_name", err: fmt.Errorf(`s1: validator failed for field "BronzeS1AppCVE.application_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CveID(); ok {
+		if err := bronzes1appcve.CveIDValidator(v); err != nil {
+			return &ValidationError{Name: "cve_id", err: fmt.Errorf(`s1: validator failed for field "BronzeS1AppCVE.cve_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeS1AppCVEUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzes1appcve.Table, bronzes1appcve.Columns, sqlgraph.NewFieldSpec(bronzes1appcve.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1appcve.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.AgentID(); ok {
+		_spec.SetField(bronzes1appcve.FieldAgentID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EndpointName(); ok {
+		_spec.SetField(bronzes1appcve.FieldEndpointName, field.TypeString, value)
+	}
+	if _u.mutation.EndpointNameCleared() {
+		_spec.ClearField(bronzes1appcve.FieldEndpointName, field.TypeString)
+	}
+	if value, ok := _u.mutation.EndpointType(); ok {
+		_spec.SetField(bronzes1appcve.FieldEndpointType, field.TypeString, value)
+	}
+	if _u.mutation.EndpointTypeCleared() {
+		_spec.ClearField(bronzes1appcve.FieldEndpointType, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsType(); ok {
+		_spec.SetField(bronzes1appcve.FieldOsType, field.TypeString, value)
+	}
+	if _u.mutation.OsTypeCleared() {
+		_spec.ClearField(bronzes1appcve.FieldOsType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApplicationName(); ok {
+		_spec.SetField(bronzes1appcve.FieldApplicationName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ApplicationVendor(); ok {
+		_spec.SetField(bronzes1appcve.FieldApplicationVendor, field.TypeString, value)
+	}
+	if _u.mutation.ApplicationVendorCleared() {
+		_spec.ClearField(bronzes1appcve.FieldApplicationVendor, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApplicationVersion(); ok {
+		_spec.SetField(bronzes1appcve.FieldApplicationVersion, field.TypeString, value)
+	}
+	if _u.mutation.ApplicationVersionCleared() {
+		_spec.ClearField(bronzes1appcve.FieldApplicationVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.CveID(); ok {
+		_spec.SetField(bronzes1appcve.FieldCveID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CvssScore(); ok {
+		_spec.SetField(bronzes1appcve.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCvssScore(); ok {
+		_spec.AddField(bronzes1appcve.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CvssVersion(); ok {
+		_spec.SetField(bronzes1appcve.FieldCvssVersion, field.TypeString, value)
+	}
+	if _u.mutation.CvssVersionCleared() {
+		_spec.ClearField(bronzes1appcve.FieldCvssVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.NvdSeverity(); ok {
+		_spec.SetField(bronzes1appcve.FieldNvdSeverity, field.TypeString, value)
+	}
+	if _u.mutation.NvdSeverityCleared() {
+		_spec.ClearField(bronzes1appcve.FieldNvdSeverity, field.TypeString)
+	}
+	if value, ok := _u.mutation.RiskScore(); ok {
+		_spec.SetField(bronzes1appcve.FieldRiskScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRiskScore(); ok {
+		_spec.AddField(bronzes1appcve.FieldRiskScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ExploitCodeMaturity(); ok {
+		_spec.SetField(bronzes1appcve.FieldExploitCodeMaturity, field.TypeString, value)
+	}
+	if _u.mutation.ExploitCodeMaturityCleared() {
+		_spec.ClearField(bronzes1appcve.FieldExploitCodeMaturity, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExploitedInTheWild(); ok {
+		_spec.SetField(bronzes1appcve.FieldExploitedInTheWild, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RemediationLevel(); ok {
+		_spec.SetField(bronzes1appcve.FieldRemediationLevel, field.TypeString, value)
+	}
+	if _u.mutation.RemediationLevelCleared() {
+		_spec.ClearField(bronzes1appcve.FieldRemediationLevel, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReportConfidence(); ok {
+		_spec.SetField(bronzes1appcve.FieldReportConfidence, field.TypeString, value)
+	}
+	if _u.mutation.ReportConfidenceCleared() {
+		_spec.ClearField(bronzes1appcve.FieldReportConfidence, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bronzes1appcve.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(bronzes1appcve.FieldStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublishedDate(); ok {
+		_spec.SetField(bronzes1appcve.FieldPublishedDate, field.TypeTime, value)
+	}
+	if _u.mutation.PublishedDateCleared() {
+		_spec.ClearField(bronzes1appcve.FieldPublishedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DetectionDate(); ok {
+		_spec.SetField(bronzes1appcve.FieldDetectionDate, field.TypeTime, value)
+	}
+	if _u.mutation.DetectionDateCleared() {
+		_spec.ClearField(bronzes1appcve.FieldDetectionDate, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeS1AppCVE
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzes1appcve.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeS1AppCVEUpdateOne is the builder for updating a single BronzeS1AppCVE entity.
+type BronzeS1AppCVEUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeS1AppCVEMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetCollectedAt(v time.Time) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetAgentID sets the "agent_id" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetAgentID(v string) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.SetAgentID(v)
+	return _u
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillableAgentID(v *string) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetAgentID(*v)
+	}
+	return _u
+}
+
+// SetEndpointName sets the "endpoint_name" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetEndpointName(v string) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.SetEndpointName(v)
+	return _u
+}
+
+// SetNillableEndpointName sets the "endpoint_name" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillableEndpointName(v *string) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetEndpointName(*v)
+	}
+	return _u
+}
+
+// ClearEndpointName clears the value of the "endpoint_name" field.
+func (_u *BronzeS1AppCVEUpdateOne) ClearEndpointName() *BronzeS1AppCVEUpdateOne {
+	_u.mutation.ClearEndpointName()
+	return _u
+}
+
+// SetEndpointType sets the "endpoint_type" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetEndpointType(v string) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.SetEndpointType(v)
+	return _u
+}
+
+// SetNillableEndpointType sets the "endpoint_type" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillableEndpointType(v *string) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetEndpointType(*v)
+	}
+	return _u
+}
+
+// ClearEndpointType clears the value of the "endpoint_type" field.
+func (_u *BronzeS1AppCVEUpdateOne) ClearEndpointType() *BronzeS1AppCVEUpdateOne {
+	_u.mutation.ClearEndpointType()
+	return _u
+}
+
+// SetOsType sets the "os_type" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetOsType(v string) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.SetOsType(v)
+	return _u
+}
+
+// SetNillableOsType sets the "os_type" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillableOsType(v *string) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetOsType(*v)
+	}
+	return _u
+}
+
+// ClearOsType clears the value of the "os_type" field.
+func (_u *BronzeS1AppCVEUpdateOne) ClearOsType() *BronzeS1AppCVEUpdateOne {
+	_u.mutation.ClearOsType()
+	return _u
+}
+
+// SetApplicationName sets the "application_name" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetApplicationName(v string) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.SetApplicationName(v)
+	return _u
+}
+
+// SetNillableApplicationName sets the "application_name" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillableApplicationName(v *string) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetApplicationName(*v)
+	}
+	return _u
+}
+
+// SetApplicationVendor sets the "application_vendor" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetApplicationVendor(v string) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.SetApplicationVendor(v)
+	return _u
+}
+
+// SetNillableApplicationVendor sets the "application_vendor" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillableApplicationVendor(v *string) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetApplicationVendor(*v)
+	}
+	return _u
+}
+
+// ClearApplicationVendor clears the value of the "application_vendor" field.
+func (_u *BronzeS1AppCVEUpdateOne) ClearApplicationVendor() *BronzeS1AppCVEUpdateOne {
+	_u.mutation.ClearApplicationVendor()
+	return _u
+}
+
+// SetApplicationVersion sets the "application_version" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetApplicationVersion(v string) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.SetApplicationVersion(v)
+	return _u
+}
+
+// SetNillableApplicationVersion sets the "application_version" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillableApplicationVersion(v *string) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetApplicationVersion(*v)
+	}
+	return _u
+}
+
+// ClearApplicationVersion clears the value of the "application_version" field.
+func (_u *BronzeS1AppCVEUpdateOne) ClearApplicationVersion() *BronzeS1AppCVEUpdateOne {
+	_u.mutation.ClearApplicationVersion()
+	return _u
+}
+
+// SetCveID sets the "cve_id" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetCveID(v string) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.SetCveID(v)
+	return _u
+}
+
+// SetNillableCveID sets the "cve_id" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillableCveID(v *string) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetCveID(*v)
+	}
+	return _u
+}
+
+// SetCvssScore sets the "cvss_score" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetCvssScore(v float64) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.ResetCvssScore()
+	_u.mutation.SetCvssScore(v)
+	return _u
+}
+
+// SetNillableCvssScore sets the "cvss_score" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillableCvssScore(v *float64) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetCvssScore(*v)
+	}
+	return _u
+}
+
+// AddCvssScore adds value to the "cvss_score" field.
+func (_u *BronzeS1AppCVEUpdateOne) AddCvssScore(v float64) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.AddCvssScore(v)
+	return _u
+}
+
+// SetCvssVersion sets the "cvss_version" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetCvssVersion(v string) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.SetCvssVersion(v)
+	return _u
+}
+
+// SetNillableCvssVersion sets the "cvss_version" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillableCvssVersion(v *string) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetCvssVersion(*v)
+	}
+	return _u
+}
+
+// ClearCvssVersion clears the value of the "cvss_version" field.
+func (_u *BronzeS1AppCVEUpdateOne) ClearCvssVersion() *BronzeS1AppCVEUpdateOne {
+	_u.mutation.ClearCvssVersion()
+	return _u
+}
+
+// SetNvdSeverity sets the "nvd_severity" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetNvdSeverity(v string) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.SetNvdSeverity(v)
+	return _u
+}
+
+// SetNillableNvdSeverity sets the "nvd_severity" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillableNvdSeverity(v *string) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetNvdSeverity(*v)
+	}
+	return _u
+}
+
+// ClearNvdSeverity clears the value of the "nvd_severity" field.
+func (_u *BronzeS1AppCVEUpdateOne) ClearNvdSeverity() *BronzeS1AppCVEUpdateOne {
+	_u.mutation.ClearNvdSeverity()
+	return _u
+}
+
+// SetRiskScore sets the "risk_score" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetRiskScore(v float64) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.ResetRiskScore()
+	_u.mutation.SetRiskScore(v)
+	return _u
+}
+
+// SetNillableRiskScore sets the "risk_score" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillableRiskScore(v *float64) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetRiskScore(*v)
+	}
+	return _u
+}
+
+// AddRiskScore adds value to the "risk_score" field.
+func (_u *BronzeS1AppCVEUpdateOne) AddRiskScore(v float64) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.AddRiskScore(v)
+	return _u
+}
+
+// SetExploitCodeMaturity sets the "exploit_code_maturity" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetExploitCodeMaturity(v string) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.SetExploitCodeMaturity(v)
+	return _u
+}
+
+// SetNillableExploitCodeMaturity sets the "exploit_code_maturity" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillableExploitCodeMaturity(v *string) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetExploitCodeMaturity(*v)
+	}
+	return _u
+}
+
+// ClearExploitCodeMaturity clears the value of the "exploit_code_maturity" field.
+func (_u *BronzeS1AppCVEUpdateOne) ClearExploitCodeMaturity() *BronzeS1AppCVEUpdateOne {
+	_u.mutation.ClearExploitCodeMaturity()
+	return _u
+}
+
+// SetExploitedInTheWild sets the "exploited_in_the_wild" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetExploitedInTheWild(v bool) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.SetExploitedInTheWild(v)
+	return _u
+}
+
+// SetNillableExploitedInTheWild sets the "exploited_in_the_wild" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillableExploitedInTheWild(v *bool) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetExploitedInTheWild(*v)
+	}
+	return _u
+}
+
+// SetRemediationLevel sets the "remediation_level" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetRemediationLevel(v string) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.SetRemediationLevel(v)
+	return _u
+}
+
+// SetNillableRemediationLevel sets the "remediation_level" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillableRemediationLevel(v *string) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetRemediationLevel(*v)
+	}
+	return _u
+}
+
+// ClearRemediationLevel clears the value of the "remediation_level" field.
+func (_u *BronzeS1AppCVEUpdateOne) ClearRemediationLevel() *BronzeS1AppCVEUpdateOne {
+	_u.mutation.ClearRemediationLevel()
+	return _u
+}
+
+// SetReportConfidence sets the "report_confidence" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetReportConfidence(v string) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.SetReportConfidence(v)
+	return _u
+}
+
+// SetNillableReportConfidence sets the "report_confidence" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillableReportConfidence(v *string) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetReportConfidence(*v)
+	}
+	return _u
+}
+
+// ClearReportConfidence clears the value of the "report_confidence" field.
+func (_u *BronzeS1AppCVEUpdateOne) ClearReportConfidence() *BronzeS1AppCVEUpdateOne {
+	_u.mutation.ClearReportConfidence()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetStatus(v string) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillableStatus(v *string) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *BronzeS1AppCVEUpdateOne) ClearStatus() *BronzeS1AppCVEUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetPublishedDate sets the "published_date" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetPublishedDate(v time.Time) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.SetPublishedDate(v)
+	return _u
+}
+
+// SetNillablePublishedDate sets the "published_date" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillablePublishedDate(v *time.Time) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetPublishedDate(*v)
+	}
+	return _u
+}
+
+// ClearPublishedDate clears the value of the "published_date" field.
+func (_u *BronzeS1AppCVEUpdateOne) ClearPublishedDate() *BronzeS1AppCVEUpdateOne {
+	_u.mutation.ClearPublishedDate()
+	return _u
+}
+
+// SetDetectionDate sets the "detection_date" field.
+func (_u *BronzeS1AppCVEUpdateOne) SetDetectionDate(v time.Time) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.SetDetectionDate(v)
+	return _u
+}
+
+// SetNillableDetectionDate sets the "detection_date" field if the given value is not nil.
+func (_u *BronzeS1AppCVEUpdateOne) SetNillableDetectionDate(v *time.Time) *BronzeS1AppCVEUpdateOne {
+	if v != nil {
+		_u.SetDetectionDate(*v)
+	}
+	return _u
+}
+
+// ClearDetectionDate clears the value of the "detection_date" field.
+func (_u *BronzeS1AppCVEUpdateOne) ClearDetectionDate() *BronzeS1AppCVEUpdateOne {
+	_u.mutation.ClearDetectionDate()
+	return _u
+}
+
+// Mutation returns the BronzeS1AppCVEMutation object of the builder.
+func (_u *BronzeS1AppCVEUpdateOne) Mutation() *BronzeS1AppCVEMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeS1AppCVEUpdate builder.
+func (_u *BronzeS1AppCVEUpdateOne) Where(ps ...predicate.BronzeS1AppCVE) *BronzeS1AppCVEUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeS1AppCVEUpdateOne) Select(field string, fields ...string) *BronzeS1AppCVEUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeS1AppCVE entity.
+func (_u *BronzeS1AppCVEUpdateOne) Save(ctx context.Context) (*BronzeS1AppCVE, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeS1AppCVEUpdateOne) SaveX(ctx context.Context) *BronzeS1AppCVE {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeS1AppCVEUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeS1AppCVEUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeS1AppCVEUpdateOne) check() error {
+	if v, ok := _u.mutation.AgentID(); ok {
+		if err := bronzes1appcve.AgentIDValidator(v); err != nil {
+			return &ValidationError{Name: "agent_id", err: fmt.Errorf(`s1: validator failed for field "BronzeS1AppCVE.agent_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ApplicationName(); ok {
+		if err := bronzes1appcve.ApplicationNameValidator(v); err != nil {
+			return &ValidationError{Name: "application_name", err: fmt.Errorf(`s1: validator failed for field "BronzeS1AppCVE.application_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CveID(); ok {
+		if err := bronzes1appcve.CveIDValidator(v); err != nil {
+			return &ValidationError{Name: "cve_id", err: fmt.Errorf(`s1: validator failed for field "BronzeS1AppCVE.cve_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeS1AppCVEUpdateOne) sqlSave(ctx context.Context) (_node *BronzeS1AppCVE, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzes1appcve.Table, bronzes1appcve.Columns, sqlgraph.NewFieldSpec(bronzes1appcve.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`s1: missing "BronzeS1AppCVE.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzes1appcve.FieldID)
+		for _, f := range fields {
+			if !bronzes1appcve.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("s1: invalid field %q for query", f)}
+			}
+			if f != bronzes1appcve.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1appcve.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.AgentID(); ok {
+		_spec.SetField(bronzes1appcve.FieldAgentID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EndpointName(); ok {
+		_spec.SetField(bronzes1appcve.FieldEndpointName, field.TypeString, value)
+	}
+	if _u.mutation.EndpointNameCleared() {
+		_spec.ClearField(bronzes1appcve.FieldEndpointName, field.TypeString)
+	}
+	if value, ok := _u.mutation.EndpointType(); ok {
+		_spec.SetField(bronzes1appcve.FieldEndpointType, field.TypeString, value)
+	}
+	if _u.mutation.EndpointTypeCleared() {
+		_spec.ClearField(bronzes1appcve.FieldEndpointType, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsType(); ok {
+		_spec.SetField(bronzes1appcve.FieldOsType, field.TypeString, value)
+	}
+	if _u.mutation.OsTypeCleared() {
+		_spec.ClearField(bronzes1appcve.FieldOsType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApplicationName(); ok {
+		_spec.SetField(bronzes1appcve.FieldApplicationName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ApplicationVendor(); ok {
+		_spec.SetField(bronzes1appcve.FieldApplicationVendor, field.TypeString, value)
+	}
+	if _u.mutation.ApplicationVendorCleared() {
+		_spec.ClearField(bronzes1appcve.FieldApplicationVendor, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApplicationVersion(); ok {
+		_spec.SetField(bronzes1appcve.FieldApplicationVersion, field.TypeString, value)
+	}
+	if _u.mutation.ApplicationVersionCleared() {
+		_spec.ClearField(bronzes1appcve.FieldApplicationVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.CveID(); ok {
+		_spec.SetField(bronzes1appcve.FieldCveID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CvssScore(); ok {
+		_spec.SetField(bronzes1appcve.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCvssScore(); ok {
+		_spec.AddField(bronzes1appcve.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CvssVersion(); ok {
+		_spec.SetField(bronzes1appcve.FieldCvssVersion, field.TypeString, value)
+	}
+	if _u.mutation.CvssVersionCleared() {
+		_spec.ClearField(bronzes1appcve.FieldCvssVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.NvdSeverity(); ok {
+		_spec.SetField(bronzes1appcve.FieldNvdSeverity, field.TypeString, value)
+	}
+	if _u.mutation.NvdSeverityCleared() {
+		_spec.ClearField(bronzes1appcve.FieldNvdSeverity, field.TypeString)
+	}
+	if value, ok := _u.mutation.RiskScore(); ok {
+		_spec.SetField(bronzes1appcve.FieldRiskScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRiskScore(); ok {
+		_spec.AddField(bronzes1appcve.FieldRiskScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ExploitCodeMaturity(); ok {
+		_spec.SetField(bronzes1appcve.FieldExploitCodeMaturity, field.TypeString, value)
+	}
+	if _u.mutation.ExploitCodeMaturityCleared() {
+		_spec.ClearField(bronzes1appcve.FieldExploitCodeMaturity, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExploitedInTheWild(); ok {
+		_spec.SetField(bronzes1appcve.FieldExploitedInTheWild, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RemediationLevel(); ok {
+		_spec.SetField(bronzes1appcve.FieldRemediationLevel, field.TypeString, value)
+	}
+	if _u.mutation.RemediationLevelCleared() {
+		_spec.ClearField(bronzes1appcve.FieldRemediationLevel, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReportConfidence(); ok {
+		_spec.SetField(bronzes1appcve.FieldReportConfidence, field.TypeString, value)
+	}
+	if _u.mutation.ReportConfidenceCleared() {
+		_spec.ClearField(bronzes1appcve.FieldReportConfidence, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(bronzes1appcve.FieldStatus, field.TypeString, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(bronzes1appcve.FieldStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublishedDate(); ok {
+		_spec.SetField(bronzes1appcve.FieldPublishedDate, field.TypeTime, value)
+	}
+	if _u.mutation.PublishedDateCleared() {
+		_spec.ClearField(bronzes1appcve.FieldPublishedDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DetectionDate(); ok {
+		_spec.SetField(bronzes1appcve.FieldDetectionDate, field.TypeTime, value)
+	}
+	if _u.mutation.DetectionDateCleared() {
+		_spec.ClearField(bronzes1appcve.FieldDetectionDate, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeS1AppCVE
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeS1AppCVE{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzes1appcve.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,371 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1exclusion"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeS1Exclusion is the model entity for the BronzeS1Exclusion schema.
+type BronzeS1Exclusion struct {
+	config `json:"-"`
+	// ID of the ent.
+	// SentinelOne exclusion ID
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Scope level: tenant, account, site or group
+	ScopeLevel string `json:"scope_level,omitempty"`
+	// SentinelOne account ID; joins to s1_accounts.resource_id
+	AccountID string `json:"account_id,omitempty"`
+	// SentinelOne site ID; joins to s1_sites.resource_id
+	SiteID string `json:"site_id,omitempty"`
+	// SentinelOne group ID; joins to s1_groups.resource_id
+	GroupID string `json:"group_id,omitempty"`
+	// ScopeName holds the value of the "scope_name" field.
+	ScopeName string `json:"scope_name,omitempty"`
+	// e.g. Global / Acme / Prod
+	ScopePath string `json:"scope_path,omitempty"`
+	// path, white_hash, certificate, browser or file_type
+	ExclusionType string `json:"exclusion_type,omitempty"`
+	// Excluded path, hash, signer or browser
+	ExclusionValue string `json:"exclusion_value,omitempty"`
+	// OsType holds the value of the "os_type" field.
+	OsType string `json:"os_type,omitempty"`
+	// Exclusion mode for path exclusions, e.g. suppress or disable_all_monitors
+	Mode string `json:"mode,omitempty"`
+	// PathExclusionType holds the value of the "path_exclusion_type" field.
+	PathExclusionType string `json:"path_exclusion_type,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// UserName holds the value of the "user_name" field.
+	UserName string `json:"user_name,omitempty"`
+	// ApplicationName holds the value of the "application_name" field.
+	ApplicationName string `json:"application_name,omitempty"`
+	// IncludeChildren holds the value of the "include_children" field.
+	IncludeChildren bool `json:"include_children,omitempty"`
+	// IncludeSubfolders holds the value of the "include_subfolders" field.
+	IncludeSubfolders bool `json:"include_subfolders,omitempty"`
+	// Imported holds the value of the "imported" field.
+	Imported bool `json:"imported,omitempty"`
+	// ActionsJSON holds the value of the "actions_json" field.
+	ActionsJSON json.RawMessage `json:"actions_json,omitempty"`
+	// APICreatedAt holds the value of the "api_created_at" field.
+	APICreatedAt *time.Time `json:"api_created_at,omitempty"`
+	// APIUpdatedAt holds the value of the "api_updated_at" field.
+	APIUpdatedAt *time.Time `json:"api_updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeS1Exclusion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzes1exclusion.FieldActionsJSON:
+			values[i] = new([]byte)
+		case bronzes1exclusion.FieldIncludeChildren, bronzes1exclusion.FieldIncludeSubfolders, bronzes1exclusion.FieldImported:
+			values[i] = new(sql.NullBool)
+		case bronzes1exclusion.FieldID, bronzes1exclusion.FieldScopeLevel, bronzes1exclusion.FieldAccountID, bronzes1exclusion.FieldSiteID, bronzes1exclusion.FieldGroupID, bronzes1exclusion.FieldScopeName, bronzes1exclusion.FieldScopePath, bronzes1exclusion.FieldExclusionType, bronzes1exclusion.FieldExclusionValue, bronzes1exclusion.FieldOsType, bronzes1exclusion.FieldMode, bronzes1exclusion.FieldPathExclusionType, bronzes1exclusion.FieldDescription, bronzes1exclusion.FieldSource, bronzes1exclusion.FieldUserID, bronzes1exclusion.FieldUserName, bronzes1exclusion.FieldApplicationName:
+			values[i] = new(sql.NullString)
+		case bronzes1exclusion.FieldCollectedAt, bronzes1exclusion.FieldFirstCollectedAt, bronzes1exclusion.FieldAPICreatedAt, bronzes1exclusion.FieldAPIUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeS1Exclusion fields.
+func (_m *BronzeS1Exclusion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzes1exclusion.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzes1exclusion.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzes1exclusion.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzes1exclusion.FieldScopeLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope_level", values[i])
+			} else if value.Valid {
+				_m.ScopeLevel = value.String
+			}
+		case bronzes1exclusion.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzes1exclusion.FieldSiteID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field site_id", values[i])
+			} else if value.Valid {
+				_m.SiteID = value.String
+			}
+		case bronzes1exclusion.FieldGroupID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				_m.GroupID = value.String
+			}
+		case bronzes1exclusion.FieldScopeName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope_name", values[i])
+			} else if value.Valid {
+				_m.ScopeName = value.String
+			}
+		case bronzes1exclusion.FieldScopePath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope_path", values[i])
+			} else if value.Valid {
+				_m.ScopePath = value.String
+			}
+		case bronzes1exclusion.FieldExclusionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exclusion_type", values[i])
+			} else if value.Valid {
+				_m.ExclusionType = value.String
+			}
+		case bronzes1exclusion.FieldExclusionValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exclusion_value", values[i])
+			} else if value.Valid {
+				_m.ExclusionValue = value.String
+			}
+		case bronzes1exclusion.FieldOsType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field os_type", values[i])
+			} else if value.Valid {
+				_m.OsType = value.String
+			}
+		case bronzes1exclusion.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				_m.Mode = value.String
+			}
+		case bronzes1exclusion.FieldPathExclusionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path_exclusion_type", values[i])
+			} else if value.Valid {
+				_m.PathExclusionType = value.String
+			}
+		case bronzes1exclusion.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case bronzes1exclusion.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case bronzes1exclusion.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case bronzes1exclusion.FieldUserName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_name", values[i])
+			} else if value.Valid {
+				_m.UserName = value.String
+			}
+		case bronzes1exclusion.FieldApplicationName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field application_name", values[i])
+			} else if value.Valid {
+				_m.ApplicationName = value.String
+			}
+		case bronzes1exclusion.FieldIncludeChildren:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field include_children", values[i])
+			} else if value.Valid {
+				_m.IncludeChildren = value.Bool
+			}
+		case bronzes1exclusion.FieldIncludeSubfolders:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field include_subfolders", values[i])
+			} else if value.Valid {
+				_m.IncludeSubfolders = value.Bool
+			}
+		case bronzes1exclusion.FieldImported:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field imported", values[i])
+			} else if value.Valid {
+				_m.Imported = value.Bool
+			}
+		case bronzes1exclusion.FieldActionsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field actions_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ActionsJSON); err != nil {
+					return fmt.Errorf("unmarshal field actions_json: %w", err)
+				}
+			}
+		case bronzes1exclusion.FieldAPICreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_created_at", values[i])
+			} else if value.Valid {
+				_m.APICreatedAt = new(time.Time)
+				*_m.APICreatedAt = value.Time
+			}
+		case bronzes1exclusion.FieldAPIUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_updated_at", values[i])
+			} else if value.Valid {
+				_m.APIUpdatedAt = new(time.Time)
+				*_m.APIUpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeS1Exclusion.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeS1Exclusion) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeS1Exclusion.
+// Note that you need to call BronzeS1Exclusion.Unwrap() before calling this method if this BronzeS1Exclusion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeS1Exclusion) Update() *BronzeS1ExclusionUpdateOne {
+	return NewBronzeS1ExclusionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeS1Exclusion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeS1Exclusion) Unwrap() *BronzeS1Exclusion {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("s1: BronzeS1Exclusion is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeS1Exclusion) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeS1Exclusion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("scope_level=")
+	builder.WriteString(_m.ScopeLevel)
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("site_id=")
+	builder.WriteString(_m.SiteID)
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(_m.GroupID)
+	builder.WriteString(", ")
+	builder.WriteString("scope_name=")
+	builder.WriteString(_m.ScopeName)
+	builder.WriteString(", ")
+	builder.WriteString("scope_path=")
+	builder.WriteString(_m.ScopePath)
+	builder.WriteString(", ")
+	builder.WriteString("exclusion_type=")
+	builder.WriteString(_m.ExclusionType)
+	builder.WriteString(", ")
+	builder.WriteString("exclusion_value=")
+	builder.WriteString(_m.ExclusionValue)
+	builder.WriteString(", ")
+	builder.WriteString("os_type=")
+	builder.WriteString(_m.OsType)
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(_m.Mode)
+	builder.WriteString(", ")
+	builder.WriteString("path_exclusion_type=")
+	builder.WriteString(_m.PathExclusionType)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("user_name=")
+	builder.WriteString(_m.UserName)
+	builder.WriteString(", ")
+	builder.WriteString("application_name=")
+	builder.WriteString(_m.ApplicationName)
+	builder.WriteString(", ")
+	builder.WriteString("include_children=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IncludeChildren))
+	builder.WriteString(", ")
+	builder.WriteString("include_subfolders=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IncludeSubfolders))
+	builder.WriteString(", ")
+	builder.WriteString("imported=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Imported))
+	builder.WriteString(", ")
+	builder.WriteString("actions_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ActionsJSON))
+	builder.WriteString(", ")
+	if v := _m.APICreatedAt; v != nil {
+		builder.WriteString("api_created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.APIUpdatedAt; v != nil {
+		builder.WriteString("api_updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeS1Exclusions is a parsable slice of BronzeS1Exclusion.
+type BronzeS1Exclusions []*BronzeS1Exclusion
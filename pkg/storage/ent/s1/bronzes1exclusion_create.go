@@ -0,0 +1,625 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1exclusion"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeS1ExclusionCreate is the builder for creating a BronzeS1Exclusion entity.
+type BronzeS1ExclusionCreate struct {
+	config
+	mutation *BronzeS1ExclusionMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeS1ExclusionCreate) SetCollectedAt(v time.Time) *BronzeS1ExclusionCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeS1ExclusionCreate) SetFirstCollectedAt(v time.Time) *BronzeS1ExclusionCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetScopeLevel sets the "scope_level" field.
+func (_c *BronzeS1ExclusionCreate) SetScopeLevel(v string) *BronzeS1ExclusionCreate {
+	_c.mutation.SetScopeLevel(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeS1ExclusionCreate) SetAccountID(v string) *BronzeS1ExclusionCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_c *BronzeS1ExclusionCreate) SetNillableAccountID(v *string) *BronzeS1ExclusionCreate {
+	if v != nil {
+		_c.SetAccountID(*v)
+	}
+	return _c
+}
+
+// SetSiteID sets the "site_id" field.
+func (_c *BronzeS1ExclusionCreate) SetSiteID(v string) *BronzeS1ExclusionCreate {
+	_c.mutation.SetSiteID(v)
+	return _c
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_c *BronzeS1ExclusionCreate) SetNillableSiteID(v *string) *BronzeS1ExclusionCreate {
+	if v != nil {
+		_c.SetSiteID(*v)
+	}
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *BronzeS1ExclusionCreate) SetGroupID(v string) *BronzeS1ExclusionCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_c *BronzeS1ExclusionCreate) SetNillableGroupID(v *string) *BronzeS1ExclusionCreate {
+	if v != nil {
+		_c.SetGroupID(*v)
+	}
+	return _c
+}
+
+// SetScopeName sets the "scope_name" field.
+func (_c *BronzeS1ExclusionCreate) SetScopeName(v string) *BronzeS1ExclusionCreate {
+	_c.mutation.SetScopeName(v)
+	return _c
+}
+
+// SetNillableScopeName sets the "scope_name" field if the given value is not nil.
+func (_c *BronzeS1ExclusionCreate) SetNillableScopeName(v *string) *BronzeS1ExclusionCreate {
+	if v != nil {
+		_c.SetScopeName(*v)
+	}
+	return _c
+}
+
+// SetScopePath sets the "scope_path" field.
+func (_c *BronzeS1ExclusionCreate) SetScopePath(v string) *BronzeS1ExclusionCreate {
+	_c.mutation.SetScopePath(v)
+	return _c
+}
+
+// SetNillableScopePath sets the "scope_path" field if the given value is not nil.
+func (_c *BronzeS1ExclusionCreate) SetNillableScopePath(v *string) *BronzeS1ExclusionCreate {
+	if v != nil {
+		_c.SetScopePath(*v)
+	}
+	return _c
+}
+
+// SetExclusionType sets the "exclusion_type" field.
+func (_c *BronzeS1ExclusionCreate) SetExclusionType(v string) *BronzeS1ExclusionCreate {
+	_c.mutation.SetExclusionType(v)
+	return _c
+}
+
+// SetExclusionValue sets the "exclusion_value" field.
+func (_c *BronzeS1ExclusionCreate) SetExclusionValue(v string) *BronzeS1ExclusionCreate {
+	_c.mutation.SetExclusionValue(v)
+	return _c
+}
+
+// SetNillableExclusionValue sets the "exclusion_value" field if the given value is not nil.
+func (_c *BronzeS1ExclusionCreate) SetNillableExclusionValue(v *string) *BronzeS1ExclusionCreate {
+	if v != nil {
+		_c.SetExclusionValue(*v)
+	}
+	return _c
+}
+
+// SetOsType sets the "os_type" field.
+func (_c *BronzeS1ExclusionCreate) SetOsType(v string) *BronzeS1ExclusionCreate {
+	_c.mutation.SetOsType(v)
+	return _c
+}
+
+// SetNillableOsType sets the "os_type" field if the given value is not nil.
+func (_c *BronzeS1ExclusionCreate) SetNillableOsType(v *string) *BronzeS1ExclusionCreate {
+	if v != nil {
+		_c.SetOsType(*v)
+	}
+	return _c
+}
+
+// SetMode sets the "mode" field.
+func (_c *BronzeS1ExclusionCreate) SetMode(v string) *BronzeS1ExclusionCreate {
+	_c.mutation.SetMode(v)
+	return _c
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_c *BronzeS1ExclusionCreate) SetNillableMode(v *string) *BronzeS1ExclusionCreate {
+	if v != nil {
+		_c.SetMode(*v)
+	}
+	return _c
+}
+
+// SetPathExclusionType sets the "path_exclusion_type" field.
+func (_c *BronzeS1ExclusionCreate) SetPathExclusionType(v string) *BronzeS1ExclusionCreate {
+	_c.mutation.SetPathExclusionType(v)
+	return _c
+}
+
+// SetNillablePathExclusionType sets the "path_exclusion_type" field if the given value is not nil.
+func (_c *BronzeS1ExclusionCreate) SetNillablePathExclusionType(v *string) *BronzeS1ExclusionCreate {
+	if v != nil {
+		_c.SetPathExclusionType(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BronzeS1ExclusionCreate) SetDescription(v string) *BronzeS1ExclusionCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BronzeS1ExclusionCreate) SetNillableDescription(v *string) *BronzeS1ExclusionCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *BronzeS1ExclusionCreate) SetSource(v string) *BronzeS1ExclusionCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *BronzeS1ExclusionCreate) SetNillableSource(v *string) *BronzeS1ExclusionCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *BronzeS1ExclusionCreate) SetUserID(v string) *BronzeS1ExclusionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *BronzeS1ExclusionCreate) SetNillableUserID(v *string) *BronzeS1ExclusionCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetUserName sets the "user_name" field.
+func (_c *BronzeS1ExclusionCreate) SetUserName(v string) *BronzeS1ExclusionCreate {
+	_c.mutation.SetUserName(v)
+	return _c
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_c *BronzeS1ExclusionCreate) SetNillableUserName(v *string) *BronzeS1ExclusionCreate {
+	if v != nil {
+		_c.SetUserName(*v)
+	}
+	return _c
+}
+
+// SetApplicationName sets the "application_name" field.
+func (_c *BronzeS1ExclusionCreate) SetApplicationName(v string) *BronzeS1ExclusionCreate {
+	_c.mutation.SetApplicationName(v)
+	return _c
+}
+
+// SetNillableApplicationName sets the "application_name" field if the given value is not nil.
+func (_c *BronzeS1ExclusionCreate) SetNillableApplicationName(v *string) *BronzeS1ExclusionCreate {
+	if v != nil {
+		_c.SetApplicationName(*v)
+	}
+	return _c
+}
+
+// SetIncludeChildren sets the "include_children" field.
+func (_c *BronzeS1ExclusionCreate) SetIncludeChildren(v bool) *BronzeS1ExclusionCreate {
+	_c.mutation.SetIncludeChildren(v)
+	return _c
+}
+
+// SetNillableIncludeChildren sets the "include_children" field if the given value is not nil.
+func (_c *BronzeS1ExclusionCreate) SetNillableIncludeChildren(v *bool) *BronzeS1ExclusionCreate {
+	if v != nil {
+		_c.SetIncludeChildren(*v)
+	}
+	return _c
+}
+
+// SetIncludeSubfolders sets the "include_subfolders" field.
+func (_c *BronzeS1ExclusionCreate) SetIncludeSubfolders(v bool) *BronzeS1ExclusionCreate {
+	_c.mutation.SetIncludeSubfolders(v)
+	return _c
+}
+
+// SetNillableIncludeSubfolders sets the "include_subfolders" field if the given value is not nil.
+func (_c *BronzeS1ExclusionCreate) SetNillableIncludeSubfolders(v *bool) *BronzeS1ExclusionCreate {
+	if v != nil {
+		_c.SetIncludeSubfolders(*v)
+	}
+	return _c
+}
+
+// SetImported sets the "imported" field.
+func (_c *BronzeS1ExclusionCreate) SetImported(v bool) *BronzeS1ExclusionCreate {
+	_c.mutation.SetImported(v)
+	return _c
+}
+
+// SetNillableImported sets the "imported" field if the given value is not nil.
+func (_c *BronzeS1ExclusionCreate) SetNillableImported(v *bool) *BronzeS1ExclusionCreate {
+	if v != nil {
+		_c.SetImported(*v)
+	}
+	return _c
+}
+
+// SetActionsJSON sets the "actions_json" field.
+func (_c *BronzeS1ExclusionCreate) SetActionsJSON(v json.RawMessage) *BronzeS1ExclusionCreate {
+	_c.mutation.SetActionsJSON(v)
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeS1ExclusionCreate) SetAPICreatedAt(v time.Time) *BronzeS1ExclusionCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeS1ExclusionCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeS1ExclusionCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_c *BronzeS1ExclusionCreate) SetAPIUpdatedAt(v time.Time) *BronzeS1ExclusionCreate {
+	_c.mutation.SetAPIUpdatedAt(v)
+	return _c
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_c *BronzeS1ExclusionCreate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeS1ExclusionCreate {
+	if v != nil {
+		_c.SetAPIUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeS1ExclusionCreate) SetID(v string) *BronzeS1ExclusionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeS1ExclusionMutation object of the builder.
+func (_c *BronzeS1ExclusionCreate) Mutation() *BronzeS1ExclusionMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeS1Exclusion in the database.
+func (_c *BronzeS1ExclusionCreate) Save(ctx context.Context) (*BronzeS1Exclusion, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeS1ExclusionCreate) SaveX(ctx context.Context) *BronzeS1Exclusion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeS1ExclusionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeS1ExclusionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeS1ExclusionCreate) defaults() {
+	if _, ok := _c.mutation.IncludeChildren(); !ok {
+		v := bronzes1exclusion.DefaultIncludeChildren
+		_c.mutation.SetIncludeChildren(v)
+	}
+	if _, ok := _c.mutation.IncludeSubfolders(); !ok {
+		v := bronzes1exclusion.DefaultIncludeSubfolders
+		_c.mutation.SetIncludeSubfolders(v)
+	}
+	if _, ok := _c.mutation.Imported(); !ok {
+		v := bronzes1exclusion.DefaultImported
+		_c.mutation.SetImported(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeS1ExclusionCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`s1: missing required field "BronzeS1Exclusion.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`s1: missing required field "BronzeS1Exclusion.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ScopeLevel(); !ok {
+		return &ValidationError{Name: "scope_level", err: errors.New(`s1: missing required field "BronzeS1Exclusion.scope_level"`)}
+	}
+	if v, ok := _c.mutation.ScopeLevel(); ok {
+		if err := bronzes1exclusion.ScopeLevelValidator(v); err != nil {
+			return &ValidationError{Name: "scope_level", err: fmt.Errorf(`s1: validator failed for field "BronzeS1Exclusion.scope_level": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExclusionType(); !ok {
+		return &ValidationError{Name: "exclusion_type", err: errors.New(`s1: missing required field "BronzeS1Exclusion.exclusion_type"`)}
+	}
+	if v, ok := _c.mutation.ExclusionType(); ok {
+		if err := bronzes1exclusion.ExclusionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "exclusion_type", err: fmt.Errorf(`s1: validator failed for field "BronzeS1Exclusion.exclusion_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IncludeChildren(); !ok {
+		return &ValidationError{Name: "include_children", err: errors.New(`s1: missing required field "BronzeS1Exclusion.include_children"`)}
+	}
+	if _, ok := _c.mutation.IncludeSubfolders(); !ok {
+		return &ValidationError{Name: "include_subfolders", err: errors.New(`s1: missing required field "BronzeS1Exclusion.include_subfolders"`)}
+	}
+	if _, ok := _c.mutation.Imported(); !ok {
+		return &ValidationError{Name: "imported", err: errors.New(`s1: missing required field "BronzeS1Exclusion.imported"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeS1ExclusionCreate) sqlSave(ctx context.Context) (*BronzeS1Exclusion, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeS1Exclusion.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeS1ExclusionCreate) createSpec() (*BronzeS1Exclusion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeS1Exclusion{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzes1exclusion.Table, sqlgraph.NewFieldSpec(bronzes1exclusion.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeS1Exclusion
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1exclusion.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzes1exclusion.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ScopeLevel(); ok {
+		_spec.SetField(bronzes1exclusion.FieldScopeLevel, field.TypeString, value)
+		_node.ScopeLevel = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzes1exclusion.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.SiteID(); ok {
+		_spec.SetField(bronzes1exclusion.FieldSiteID, field.TypeString, value)
+		_node.SiteID = value
+	}
+	if value, ok := _c.mutation.GroupID(); ok {
+		_spec.SetField(bronzes1exclusion.FieldGroupID, field.TypeString, value)
+		_node.GroupID = value
+	}
+	if value, ok := _c.mutation.ScopeName(); ok {
+		_spec.SetField(bronzes1exclusion.FieldScopeName, field.TypeString, value)
+		_node.ScopeName = value
+	}
+	if value, ok := _c.mutation.ScopePath(); ok {
+		_spec.SetField(bronzes1exclusion.FieldScopePath, field.TypeString, value)
+		_node.ScopePath = value
+	}
+	if value, ok := _c.mutation.ExclusionType(); ok {
+		_spec.SetField(bronzes1exclusion.FieldExclusionType, field.TypeString, value)
+		_node.ExclusionType = value
+	}
+	if value, ok := _c.mutation.ExclusionValue(); ok {
+		_spec.SetField(bronzes1exclusion.FieldExclusionValue, field.TypeString, value)
+		_node.ExclusionValue = value
+	}
+	if value, ok := _c.mutation.OsType(); ok {
+		_spec.SetField(bronzes1exclusion.FieldOsType, field.TypeString, value)
+		_node.OsType = value
+	}
+	if value, ok := _c.mutation.Mode(); ok {
+		_spec.SetField(bronzes1exclusion.FieldMode, field.TypeString, value)
+		_node.Mode = value
+	}
+	if value, ok := _c.mutation.PathExclusionType(); ok {
+		_spec.SetField(bronzes1exclusion.FieldPathExclusionType, field.TypeString, value)
+		_node.PathExclusionType = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(bronzes1exclusion.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(bronzes1exclusion.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(bronzes1exclusion.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.UserName(); ok {
+		_spec.SetField(bronzes1exclusion.FieldUserName, field.TypeString, value)
+		_node.UserName = value
+	}
+	if value, ok := _c.mutation.ApplicationName(); ok {
+		_spec.SetField(bronzes1exclusion.FieldApplicationName, field.TypeString, value)
+		_node.ApplicationName = value
+	}
+	if value, ok := _c.mutation.IncludeChildren(); ok {
+		_spec.SetField(bronzes1exclusion.FieldIncludeChildren, field.TypeBool, value)
+		_node.IncludeChildren = value
+	}
+	if value, ok := _c.mutation.IncludeSubfolders(); ok {
+		_spec.SetField(bronzes1exclusion.FieldIncludeSubfolders, field.TypeBool, value)
+		_node.IncludeSubfolders = value
+	}
+	if value, ok := _c.mutation.Imported(); ok {
+		_spec.SetField(bronzes1exclusion.FieldImported, field.TypeBool, value)
+		_node.Imported = value
+	}
+	if value, ok := _c.mutation.ActionsJSON(); ok {
+		_spec.SetField(bronzes1exclusion.FieldActionsJSON, field.TypeJSON, value)
+		_node.ActionsJSON = value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzes1exclusion.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	if value, ok := _c.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzes1exclusion.FieldAPIUpdatedAt, field.TypeTime, value)
+		_node.APIUpdatedAt = &value
+	}
+	return _node, _spec
+}
+
+// BronzeS1ExclusionCreateBulk is the builder for creating many BronzeS1Exclusion entities in bulk.
+type BronzeS1ExclusionCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeS1ExclusionCreate
+}
+
+// Save creates the BronzeS1Exclusion entities in the database.
+func (_c *BronzeS1ExclusionCreateBulk) Save(ctx context.Context) ([]*BronzeS1Exclusion, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeS1Exclusion, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeS1ExclusionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeS1ExclusionCreateBulk) SaveX(ctx context.Context) []*BronzeS1Exclusion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeS1ExclusionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeS1ExclusionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
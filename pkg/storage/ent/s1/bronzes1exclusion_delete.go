@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1exclusion"
+	"danny.vn/hotpot/pkg/storage/ent/s1/internal"
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeS1ExclusionDelete is the builder for deleting a BronzeS1Exclusion entity.
+type BronzeS1ExclusionDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeS1ExclusionMutation
+}
+
+// Where appends a list predicates to the BronzeS1ExclusionDelete builder.
+func (_d *BronzeS1ExclusionDelete) Where(ps ...predicate.BronzeS1Exclusion) *BronzeS1ExclusionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeS1ExclusionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeS1ExclusionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeS1ExclusionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzes1exclusion.Table, sqlgraph.NewFieldSpec(bronzes1exclusion.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.BronzeS1Exclusion
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeS1ExclusionDeleteOne is the builder for deleting a single BronzeS1Exclusion entity.
+type BronzeS1ExclusionDeleteOne struct {
+	_d *BronzeS1ExclusionDelete
+}
+
+// Where appends a list predicates to the BronzeS1ExclusionDelete builder.
+func (_d *BronzeS1ExclusionDeleteOne) Where(ps ...predicate.BronzeS1Exclusion) *BronzeS1ExclusionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeS1ExclusionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzes1exclusion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeS1ExclusionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
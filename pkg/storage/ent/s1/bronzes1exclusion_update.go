@@ -0,0 +1,1313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1exclusion"
+	"danny.vn/hotpot/pkg/storage/ent/s1/internal"
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeS1ExclusionUpdate is the builder for updating BronzeS1Exclusion entities.
+type BronzeS1ExclusionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeS1ExclusionMutation
+}
+
+// Where appends a list predicates to the BronzeS1ExclusionUpdate builder.
+func (_u *BronzeS1ExclusionUpdate) Where(ps ...predicate.BronzeS1Exclusion) *BronzeS1ExclusionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeS1ExclusionUpdate) SetCollectedAt(v time.Time) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableCollectedAt(v *time.Time) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetScopeLevel sets the "scope_level" field.
+func (_u *BronzeS1ExclusionUpdate) SetScopeLevel(v string) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetScopeLevel(v)
+	return _u
+}
+
+// SetNillableScopeLevel sets the "scope_level" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableScopeLevel(v *string) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetScopeLevel(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeS1ExclusionUpdate) SetAccountID(v string) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableAccountID(v *string) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *BronzeS1ExclusionUpdate) ClearAccountID() *BronzeS1ExclusionUpdate {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeS1ExclusionUpdate) SetSiteID(v string) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableSiteID(v *string) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeS1ExclusionUpdate) ClearSiteID() *BronzeS1ExclusionUpdate {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeS1ExclusionUpdate) SetGroupID(v string) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableGroupID(v *string) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeS1ExclusionUpdate) ClearGroupID() *BronzeS1ExclusionUpdate {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetScopeName sets the "scope_name" field.
+func (_u *BronzeS1ExclusionUpdate) SetScopeName(v string) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetScopeName(v)
+	return _u
+}
+
+// SetNillableScopeName sets the "scope_name" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableScopeName(v *string) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetScopeName(*v)
+	}
+	return _u
+}
+
+// ClearScopeName clears the value of the "scope_name" field.
+func (_u *BronzeS1ExclusionUpdate) ClearScopeName() *BronzeS1ExclusionUpdate {
+	_u.mutation.ClearScopeName()
+	return _u
+}
+
+// SetScopePath sets the "scope_path" field.
+func (_u *BronzeS1ExclusionUpdate) SetScopePath(v string) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetScopePath(v)
+	return _u
+}
+
+// SetNillableScopePath sets the "scope_path" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableScopePath(v *string) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetScopePath(*v)
+	}
+	return _u
+}
+
+// ClearScopePath clears the value of the "scope_path" field.
+func (_u *BronzeS1ExclusionUpdate) ClearScopePath() *BronzeS1ExclusionUpdate {
+	_u.mutation.ClearScopePath()
+	return _u
+}
+
+// SetExclusionType sets the "exclusion_type" field.
+func (_u *BronzeS1ExclusionUpdate) SetExclusionType(v string) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetExclusionType(v)
+	return _u
+}
+
+// SetNillableExclusionType sets the "exclusion_type" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableExclusionType(v *string) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetExclusionType(*v)
+	}
+	return _u
+}
+
+// SetExclusionValue sets the "exclusion_value" field.
+func (_u *BronzeS1ExclusionUpdate) SetExclusionValue(v string) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetExclusionValue(v)
+	return _u
+}
+
+// SetNillableExclusionValue sets the "exclusion_value" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableExclusionValue(v *string) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetExclusionValue(*v)
+	}
+	return _u
+}
+
+// ClearExclusionValue clears the value of the "exclusion_value" field.
+func (_u *BronzeS1ExclusionUpdate) ClearExclusionValue() *BronzeS1ExclusionUpdate {
+	_u.mutation.ClearExclusionValue()
+	return _u
+}
+
+// SetOsType sets the "os_type" field.
+func (_u *BronzeS1ExclusionUpdate) SetOsType(v string) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetOsType(v)
+	return _u
+}
+
+// SetNillableOsType sets the "os_type" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableOsType(v *string) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetOsType(*v)
+	}
+	return _u
+}
+
+// ClearOsType clears the value of the "os_type" field.
+func (_u *BronzeS1ExclusionUpdate) ClearOsType() *BronzeS1ExclusionUpdate {
+	_u.mutation.ClearOsType()
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *BronzeS1ExclusionUpdate) SetMode(v string) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableMode(v *string) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// ClearMode clears the value of the "mode" field.
+func (_u *BronzeS1ExclusionUpdate) ClearMode() *BronzeS1ExclusionUpdate {
+	_u.mutation.ClearMode()
+	return _u
+}
+
+// SetPathExclusionType sets the "path_exclusion_type" field.
+func (_u *BronzeS1ExclusionUpdate) SetPathExclusionType(v string) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetPathExclusionType(v)
+	return _u
+}
+
+// SetNillablePathExclusionType sets the "path_exclusion_type" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillablePathExclusionType(v *string) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetPathExclusionType(*v)
+	}
+	return _u
+}
+
+// ClearPathExclusionType clears the value of the "path_exclusion_type" field.
+func (_u *BronzeS1ExclusionUpdate) ClearPathExclusionType() *BronzeS1ExclusionUpdate {
+	_u.mutation.ClearPathExclusionType()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeS1ExclusionUpdate) SetDescription(v string) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableDescription(v *string) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeS1ExclusionUpdate) ClearDescription() *BronzeS1ExclusionUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BronzeS1ExclusionUpdate) SetSource(v string) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableSource(v *string) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *BronzeS1ExclusionUpdate) ClearSource() *BronzeS1ExclusionUpdate {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BronzeS1ExclusionUpdate) SetUserID(v string) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableUserID(v *string) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *BronzeS1ExclusionUpdate) ClearUserID() *BronzeS1ExclusionUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *BronzeS1ExclusionUpdate) SetUserName(v string) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableUserName(v *string) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (_u *BronzeS1ExclusionUpdate) ClearUserName() *BronzeS1ExclusionUpdate {
+	_u.mutation.ClearUserName()
+	return _u
+}
+
+// SetApplicationName sets the "application_name" field.
+func (_u *BronzeS1ExclusionUpdate) SetApplicationName(v string) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetApplicationName(v)
+	return _u
+}
+
+// SetNillableApplicationName sets the "application_name" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableApplicationName(v *string) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetApplicationName(*v)
+	}
+	return _u
+}
+
+// ClearApplicationName clears the value of the "application_name" field.
+func (_u *BronzeS1ExclusionUpdate) ClearApplicationName() *BronzeS1ExclusionUpdate {
+	_u.mutation.ClearApplicationName()
+	return _u
+}
+
+// SetIncludeChildren sets the "include_children" field.
+func (_u *BronzeS1ExclusionUpdate) SetIncludeChildren(v bool) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetIncludeChildren(v)
+	return _u
+}
+
+// SetNillableIncludeChildren sets the "include_children" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableIncludeChildren(v *bool) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetIncludeChildren(*v)
+	}
+	return _u
+}
+
+// SetIncludeSubfolders sets the "include_subfolders" field.
+func (_u *BronzeS1ExclusionUpdate) SetIncludeSubfolders(v bool) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetIncludeSubfolders(v)
+	return _u
+}
+
+// SetNillableIncludeSubfolders sets the "include_subfolders" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableIncludeSubfolders(v *bool) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetIncludeSubfolders(*v)
+	}
+	return _u
+}
+
+// SetImported sets the "imported" field.
+func (_u *BronzeS1ExclusionUpdate) SetImported(v bool) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetImported(v)
+	return _u
+}
+
+// SetNillableImported sets the "imported" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableImported(v *bool) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetImported(*v)
+	}
+	return _u
+}
+
+// SetActionsJSON sets the "actions_json" field.
+func (_u *BronzeS1ExclusionUpdate) SetActionsJSON(v json.RawMessage) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetActionsJSON(v)
+	return _u
+}
+
+// AppendActionsJSON appends value to the "actions_json" field.
+func (_u *BronzeS1ExclusionUpdate) AppendActionsJSON(v json.RawMessage) *BronzeS1ExclusionUpdate {
+	_u.mutation.AppendActionsJSON(v)
+	return _u
+}
+
+// ClearActionsJSON clears the value of the "actions_json" field.
+func (_u *BronzeS1ExclusionUpdate) ClearActionsJSON() *BronzeS1ExclusionUpdate {
+	_u.mutation.ClearActionsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeS1ExclusionUpdate) SetAPICreatedAt(v time.Time) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeS1ExclusionUpdate) ClearAPICreatedAt() *BronzeS1ExclusionUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeS1ExclusionUpdate) SetAPIUpdatedAt(v time.Time) *BronzeS1ExclusionUpdate {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeS1ExclusionUpdate {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeS1ExclusionUpdate) ClearAPIUpdatedAt() *BronzeS1ExclusionUpdate {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeS1ExclusionMutation object of the builder.
+func (_u *BronzeS1ExclusionUpdate) Mutation() *BronzeS1ExclusionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeS1ExclusionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeS1ExclusionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeS1ExclusionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeS1ExclusionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeS1ExclusionUpdate) check() error {
+	if v, ok := _u.mutation.ScopeLevel(); ok {
+		if err := bronzes1exclusion.ScopeLevelValidator(v); err != nil {
+			return &ValidationError{Name: "scope_level", err: fmt.Errorf(`s1: validator failed for field "BronzeS1Exclusion.scope_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExclusionType(); ok {
+		if err := bronzes1exclusion.ExclusionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "exclusion_type", err: fmt.Errorf(`s1: validator failed for field "BronzeS1Exclusion.exclusion_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeS1ExclusionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzes1exclusion.Table, bronzes1exclusion.Columns, sqlgraph.NewFieldSpec(bronzes1exclusion.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1exclusion.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ScopeLevel(); ok {
+		_spec.SetField(bronzes1exclusion.FieldScopeLevel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzes1exclusion.FieldAccountID, field.TypeString, value)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldAccountID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzes1exclusion.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzes1exclusion.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopeName(); ok {
+		_spec.SetField(bronzes1exclusion.FieldScopeName, field.TypeString, value)
+	}
+	if _u.mutation.ScopeNameCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldScopeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopePath(); ok {
+		_spec.SetField(bronzes1exclusion.FieldScopePath, field.TypeString, value)
+	}
+	if _u.mutation.ScopePathCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldScopePath, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExclusionType(); ok {
+		_spec.SetField(bronzes1exclusion.FieldExclusionType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExclusionValue(); ok {
+		_spec.SetField(bronzes1exclusion.FieldExclusionValue, field.TypeString, value)
+	}
+	if _u.mutation.ExclusionValueCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldExclusionValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsType(); ok {
+		_spec.SetField(bronzes1exclusion.FieldOsType, field.TypeString, value)
+	}
+	if _u.mutation.OsTypeCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldOsType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(bronzes1exclusion.FieldMode, field.TypeString, value)
+	}
+	if _u.mutation.ModeCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldMode, field.TypeString)
+	}
+	if value, ok := _u.mutation.PathExclusionType(); ok {
+		_spec.SetField(bronzes1exclusion.FieldPathExclusionType, field.TypeString, value)
+	}
+	if _u.mutation.PathExclusionTypeCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldPathExclusionType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzes1exclusion.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(bronzes1exclusion.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(bronzes1exclusion.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(bronzes1exclusion.FieldUserName, field.TypeString, value)
+	}
+	if _u.mutation.UserNameCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldUserName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApplicationName(); ok {
+		_spec.SetField(bronzes1exclusion.FieldApplicationName, field.TypeString, value)
+	}
+	if _u.mutation.ApplicationNameCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldApplicationName, field.TypeString)
+	}
+	if value, ok := _u.mutation.IncludeChildren(); ok {
+		_spec.SetField(bronzes1exclusion.FieldIncludeChildren, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IncludeSubfolders(); ok {
+		_spec.SetField(bronzes1exclusion.FieldIncludeSubfolders, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Imported(); ok {
+		_spec.SetField(bronzes1exclusion.FieldImported, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ActionsJSON(); ok {
+		_spec.SetField(bronzes1exclusion.FieldActionsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedActionsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzes1exclusion.FieldActionsJSON, value)
+		})
+	}
+	if _u.mutation.ActionsJSONCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldActionsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzes1exclusion.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldAPICreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzes1exclusion.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeS1Exclusion
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzes1exclusion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeS1ExclusionUpdateOne is the builder for updating a single BronzeS1Exclusion entity.
+type BronzeS1ExclusionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeS1ExclusionMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetCollectedAt(v time.Time) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetScopeLevel sets the "scope_level" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetScopeLevel(v string) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetScopeLevel(v)
+	return _u
+}
+
+// SetNillableScopeLevel sets the "scope_level" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableScopeLevel(v *string) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetScopeLevel(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetAccountID(v string) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableAccountID(v *string) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *BronzeS1ExclusionUpdateOne) ClearAccountID() *BronzeS1ExclusionUpdateOne {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetSiteID(v string) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableSiteID(v *string) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeS1ExclusionUpdateOne) ClearSiteID() *BronzeS1ExclusionUpdateOne {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetGroupID(v string) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableGroupID(v *string) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeS1ExclusionUpdateOne) ClearGroupID() *BronzeS1ExclusionUpdateOne {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetScopeName sets the "scope_name" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetScopeName(v string) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetScopeName(v)
+	return _u
+}
+
+// SetNillableScopeName sets the "scope_name" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableScopeName(v *string) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetScopeName(*v)
+	}
+	return _u
+}
+
+// ClearScopeName clears the value of the "scope_name" field.
+func (_u *BronzeS1ExclusionUpdateOne) ClearScopeName() *BronzeS1ExclusionUpdateOne {
+	_u.mutation.ClearScopeName()
+	return _u
+}
+
+// SetScopePath sets the "scope_path" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetScopePath(v string) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetScopePath(v)
+	return _u
+}
+
+// SetNillableScopePath sets the "scope_path" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableScopePath(v *string) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetScopePath(*v)
+	}
+	return _u
+}
+
+// ClearScopePath clears the value of the "scope_path" field.
+func (_u *BronzeS1ExclusionUpdateOne) ClearScopePath() *BronzeS1ExclusionUpdateOne {
+	_u.mutation.ClearScopePath()
+	return _u
+}
+
+// SetExclusionType sets the "exclusion_type" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetExclusionType(v string) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetExclusionType(v)
+	return _u
+}
+
+// SetNillableExclusionType sets the "exclusion_type" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableExclusionType(v *string) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetExclusionType(*v)
+	}
+	return _u
+}
+
+// SetExclusionValue sets the "exclusion_value" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetExclusionValue(v string) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetExclusionValue(v)
+	return _u
+}
+
+// SetNillableExclusionValue sets the "exclusion_value" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableExclusionValue(v *string) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetExclusionValue(*v)
+	}
+	return _u
+}
+
+// ClearExclusionValue clears the value of the "exclusion_value" field.
+func (_u *BronzeS1ExclusionUpdateOne) ClearExclusionValue() *BronzeS1ExclusionUpdateOne {
+	_u.mutation.ClearExclusionValue()
+	return _u
+}
+
+// SetOsType sets the "os_type" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetOsType(v string) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetOsType(v)
+	return _u
+}
+
+// SetNillableOsType sets the "os_type" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableOsType(v *string) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetOsType(*v)
+	}
+	return _u
+}
+
+// ClearOsType clears the value of the "os_type" field.
+func (_u *BronzeS1ExclusionUpdateOne) ClearOsType() *BronzeS1ExclusionUpdateOne {
+	_u.mutation.ClearOsType()
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetMode(v string) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableMode(v *string) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// ClearMode clears the value of the "mode" field.
+func (_u *BronzeS1ExclusionUpdateOne) ClearMode() *BronzeS1ExclusionUpdateOne {
+	_u.mutation.ClearMode()
+	return _u
+}
+
+// SetPathExclusionType sets the "path_exclusion_type" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetPathExclusionType(v string) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetPathExclusionType(v)
+	return _u
+}
+
+// SetNillablePathExclusionType sets the "path_exclusion_type" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillablePathExclusionType(v *string) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetPathExclusionType(*v)
+	}
+	return _u
+}
+
+// ClearPathExclusionType clears the value of the "path_exclusion_type" field.
+func (_u *BronzeS1ExclusionUpdateOne) ClearPathExclusionType() *BronzeS1ExclusionUpdateOne {
+	_u.mutation.ClearPathExclusionType()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetDescription(v string) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableDescription(v *string) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeS1ExclusionUpdateOne) ClearDescription() *BronzeS1ExclusionUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetSource(v string) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableSource(v *string) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *BronzeS1ExclusionUpdateOne) ClearSource() *BronzeS1ExclusionUpdateOne {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetUserID(v string) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableUserID(v *string) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *BronzeS1ExclusionUpdateOne) ClearUserID() *BronzeS1ExclusionUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetUserName(v string) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableUserName(v *string) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (_u *BronzeS1ExclusionUpdateOne) ClearUserName() *BronzeS1ExclusionUpdateOne {
+	_u.mutation.ClearUserName()
+	return _u
+}
+
+// SetApplicationName sets the "application_name" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetApplicationName(v string) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetApplicationName(v)
+	return _u
+}
+
+// SetNillableApplicationName sets the "application_name" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableApplicationName(v *string) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetApplicationName(*v)
+	}
+	return _u
+}
+
+// ClearApplicationName clears the value of the "application_name" field.
+func (_u *BronzeS1ExclusionUpdateOne) ClearApplicationName() *BronzeS1ExclusionUpdateOne {
+	_u.mutation.ClearApplicationName()
+	return _u
+}
+
+// SetIncludeChildren sets the "include_children" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetIncludeChildren(v bool) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetIncludeChildren(v)
+	return _u
+}
+
+// SetNillableIncludeChildren sets the "include_children" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableIncludeChildren(v *bool) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetIncludeChildren(*v)
+	}
+	return _u
+}
+
+// SetIncludeSubfolders sets the "include_subfolders" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetIncludeSubfolders(v bool) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetIncludeSubfolders(v)
+	return _u
+}
+
+// SetNillableIncludeSubfolders sets the "include_subfolders" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableIncludeSubfolders(v *bool) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetIncludeSubfolders(*v)
+	}
+	return _u
+}
+
+// SetImported sets the "imported" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetImported(v bool) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetImported(v)
+	return _u
+}
+
+// SetNillableImported sets the "imported" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableImported(v *bool) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetImported(*v)
+	}
+	return _u
+}
+
+// SetActionsJSON sets the "actions_json" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetActionsJSON(v json.RawMessage) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetActionsJSON(v)
+	return _u
+}
+
+// AppendActionsJSON appends value to the "actions_json" field.
+func (_u *BronzeS1ExclusionUpdateOne) AppendActionsJSON(v json.RawMessage) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.AppendActionsJSON(v)
+	return _u
+}
+
+// ClearActionsJSON clears the value of the "actions_json" field.
+func (_u *BronzeS1ExclusionUpdateOne) ClearActionsJSON() *BronzeS1ExclusionUpdateOne {
+	_u.mutation.ClearActionsJSON()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetAPICreatedAt(v time.Time) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeS1ExclusionUpdateOne) ClearAPICreatedAt() *BronzeS1ExclusionUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeS1ExclusionUpdateOne) SetAPIUpdatedAt(v time.Time) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeS1ExclusionUpdateOne) SetNillableAPIUpdatedAt(v *time.Time) *BronzeS1ExclusionUpdateOne {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeS1ExclusionUpdateOne) ClearAPIUpdatedAt() *BronzeS1ExclusionUpdateOne {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeS1ExclusionMutation object of the builder.
+func (_u *BronzeS1ExclusionUpdateOne) Mutation() *BronzeS1ExclusionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeS1ExclusionUpdate builder.
+func (_u *BronzeS1ExclusionUpdateOne) Where(ps ...predicate.BronzeS1Exclusion) *BronzeS1ExclusionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeS1ExclusionUpdateOne) Select(field string, fields ...string) *BronzeS1ExclusionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeS1Exclusion entity.
+func (_u *BronzeS1ExclusionUpdateOne) Save(ctx context.Context) (*BronzeS1Exclusion, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeS1ExclusionUpdateOne) SaveX(ctx context.Context) *BronzeS1Exclusion {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeS1ExclusionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeS1ExclusionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeS1ExclusionUpdateOne) check() error {
+	if v, ok := _u.mutation.ScopeLevel(); ok {
+		if err := bronzes1exclusion.ScopeLevelValidator(v); err != nil {
+			return &ValidationError{Name: "scope_level", err: fmt.Errorf(`s1: validator failed for field "BronzeS1Exclusion.scope_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExclusionType(); ok {
+		if err := bronzes1exclusion.ExclusionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "exclusion_type", err: fmt.Errorf(`s1: validator failed for field "BronzeS1Exclusion.exclusion_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeS1ExclusionUpdateOne) sqlSave(ctx context.Context) (_node *BronzeS1Exclusion, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzes1exclusion.Table, bronzes1exclusion.Columns, sqlgraph.NewFieldSpec(bronzes1exclusion.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`s1: missing "BronzeS1Exclusion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzes1exclusion.FieldID)
+		for _, f := range fields {
+			if !bronzes1exclusion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("s1: invalid field %q for query", f)}
+			}
+			if f != bronzes1exclusion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1exclusion.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ScopeLevel(); ok {
+		_spec.SetField(bronzes1exclusion.FieldScopeLevel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzes1exclusion.FieldAccountID, field.TypeString, value)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldAccountID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzes1exclusion.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzes1exclusion.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopeName(); ok {
+		_spec.SetField(bronzes1exclusion.FieldScopeName, field.TypeString, value)
+	}
+	if _u.mutation.ScopeNameCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldScopeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopePath(); ok {
+		_spec.SetField(bronzes1exclusion.FieldScopePath, field.TypeString, value)
+	}
+	if _u.mutation.ScopePathCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldScopePath, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExclusionType(); ok {
+		_spec.SetField(bronzes1exclusion.FieldExclusionType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExclusionValue(); ok {
+		_spec.SetField(bronzes1exclusion.FieldExclusionValue, field.TypeString, value)
+	}
+	if _u.mutation.ExclusionValueCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldExclusionValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsType(); ok {
+		_spec.SetField(bronzes1exclusion.FieldOsType, field.TypeString, value)
+	}
+	if _u.mutation.OsTypeCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldOsType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(bronzes1exclusion.FieldMode, field.TypeString, value)
+	}
+	if _u.mutation.ModeCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldMode, field.TypeString)
+	}
+	if value, ok := _u.mutation.PathExclusionType(); ok {
+		_spec.SetField(bronzes1exclusion.FieldPathExclusionType, field.TypeString, value)
+	}
+	if _u.mutation.PathExclusionTypeCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldPathExclusionType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzes1exclusion.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(bronzes1exclusion.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(bronzes1exclusion.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(bronzes1exclusion.FieldUserName, field.TypeString, value)
+	}
+	if _u.mutation.UserNameCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldUserName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApplicationName(); ok {
+		_spec.SetField(bronzes1exclusion.FieldApplicationName, field.TypeString, value)
+	}
+	if _u.mutation.ApplicationNameCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldApplicationName, field.TypeString)
+	}
+	if value, ok := _u.mutation.IncludeChildren(); ok {
+		_spec.SetField(bronzes1exclusion.FieldIncludeChildren, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IncludeSubfolders(); ok {
+		_spec.SetField(bronzes1exclusion.FieldIncludeSubfolders, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Imported(); ok {
+		_spec.SetField(bronzes1exclusion.FieldImported, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ActionsJSON(); ok {
+		_spec.SetField(bronzes1exclusion.FieldActionsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedActionsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzes1exclusion.FieldActionsJSON, value)
+		})
+	}
+	if _u.mutation.ActionsJSONCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldActionsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzes1exclusion.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldAPICreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzes1exclusion.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzes1exclusion.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeS1Exclusion
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeS1Exclusion{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzes1exclusion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1ingestcursor"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeS1IngestCursor is the model entity for the BronzeS1IngestCursor schema.
+type BronzeS1IngestCursor struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Stream name, e.g. "threats"
+	Name string `json:"name,omitempty"`
+	// Watermark: highest API updatedAt successfully ingested
+	LastUpdatedAt time.Time `json:"last_updated_at,omitempty"`
+	selectValues  sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeS1IngestCursor) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzes1ingestcursor.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bronzes1ingestcursor.FieldName:
+			values[i] = new(sql.NullString)
+		case bronzes1ingestcursor.FieldCollectedAt, bronzes1ingestcursor.FieldFirstCollectedAt, bronzes1ingestcursor.FieldLastUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeS1IngestCursor fields.
+func (_m *BronzeS1IngestCursor) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzes1ingestcursor.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case bronzes1ingestcursor.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzes1ingestcursor.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzes1ingestcursor.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bronzes1ingestcursor.FieldLastUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_updated_at", values[i])
+			} else if value.Valid {
+				_m.LastUpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeS1IngestCursor.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeS1IngestCursor) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeS1IngestCursor.
+// Note that you need to call BronzeS1IngestCursor.Unwrap() before calling this method if this BronzeS1IngestCursor
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeS1IngestCursor) Update() *BronzeS1IngestCursorUpdateOne {
+	return NewBronzeS1IngestCursorClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeS1IngestCursor entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeS1IngestCursor) Unwrap() *BronzeS1IngestCursor {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("s1: BronzeS1IngestCursor is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeS1IngestCursor) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeS1IngestCursor(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("last_updated_at=")
+	builder.WriteString(_m.LastUpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeS1IngestCursors is a parsable slice of BronzeS1IngestCursor.
+type BronzeS1IngestCursors []*BronzeS1IngestCursor
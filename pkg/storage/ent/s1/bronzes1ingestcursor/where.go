@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzes1ingestcursor
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldLTE(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldEQ(FieldName, v))
+}
+
+// LastUpdatedAt applies equality check predicate on the "last_updated_at" field. It's identical to LastUpdatedAtEQ.
+func LastUpdatedAt(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldEQ(FieldLastUpdatedAt, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldContainsFold(FieldName, v))
+}
+
+// LastUpdatedAtEQ applies the EQ predicate on the "last_updated_at" field.
+func LastUpdatedAtEQ(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldEQ(FieldLastUpdatedAt, v))
+}
+
+// LastUpdatedAtNEQ applies the NEQ predicate on the "last_updated_at" field.
+func LastUpdatedAtNEQ(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldNEQ(FieldLastUpdatedAt, v))
+}
+
+// LastUpdatedAtIn applies the In predicate on the "last_updated_at" field.
+func LastUpdatedAtIn(vs ...time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldIn(FieldLastUpdatedAt, vs...))
+}
+
+// LastUpdatedAtNotIn applies the NotIn predicate on the "last_updated_at" field.
+func LastUpdatedAtNotIn(vs ...time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldNotIn(FieldLastUpdatedAt, vs...))
+}
+
+// LastUpdatedAtGT applies the GT predicate on the "last_updated_at" field.
+func LastUpdatedAtGT(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldGT(FieldLastUpdatedAt, v))
+}
+
+// LastUpdatedAtGTE applies the GTE predicate on the "last_updated_at" field.
+func LastUpdatedAtGTE(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldGTE(FieldLastUpdatedAt, v))
+}
+
+// LastUpdatedAtLT applies the LT predicate on the "last_updated_at" field.
+func LastUpdatedAtLT(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldLT(FieldLastUpdatedAt, v))
+}
+
+// LastUpdatedAtLTE applies the LTE predicate on the "last_updated_at" field.
+func LastUpdatedAtLTE(v time.Time) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.FieldLTE(FieldLastUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeS1IngestCursor) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeS1IngestCursor) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeS1IngestCursor) predicate.BronzeS1IngestCursor {
+	return predicate.BronzeS1IngestCursor(sql.NotPredicates(p))
+}
@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1ingestcursor"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeS1IngestCursorCreate is the builder for creating a BronzeS1IngestCursor entity.
+type BronzeS1IngestCursorCreate struct {
+	config
+	mutation *BronzeS1IngestCursorMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeS1IngestCursorCreate) SetCollectedAt(v time.Time) *BronzeS1IngestCursorCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeS1IngestCursorCreate) SetFirstCollectedAt(v time.Time) *BronzeS1IngestCursorCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BronzeS1IngestCursorCreate) SetName(v string) *BronzeS1IngestCursorCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetLastUpdatedAt sets the "last_updated_at" field.
+func (_c *BronzeS1IngestCursorCreate) SetLastUpdatedAt(v time.Time) *BronzeS1IngestCursorCreate {
+	_c.mutation.SetLastUpdatedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeS1IngestCursorCreate) SetID(v uint) *BronzeS1IngestCursorCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeS1IngestCursorMutation object of the builder.
+func (_c *BronzeS1IngestCursorCreate) Mutation() *BronzeS1IngestCursorMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeS1IngestCursor in the database.
+func (_c *BronzeS1IngestCursorCreate) Save(ctx context.Context) (*BronzeS1IngestCursor, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeS1IngestCursorCreate) SaveX(ctx context.Context) *BronzeS1IngestCursor {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeS1IngestCursorCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeS1IngestCursorCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeS1IngestCursorCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`s1: missing required field "BronzeS1IngestCursor.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`s1: missing required field "BronzeS1IngestCursor.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`s1: missing required field "BronzeS1IngestCursor.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := bronzes1ingestcursor.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`s1: validator failed for field "BronzeS1IngestCursor.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LastUpdatedAt(); !ok {
+		return &ValidationError{Name: "last_updated_at", err: errors.New(`s1: missing required field "BronzeS1IngestCursor.last_updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeS1IngestCursorCreate) sqlSave(ctx context.Context) (*BronzeS1IngestCursor, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeS1IngestCursorCreate) createSpec() (*BronzeS1IngestCursor, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeS1IngestCursor{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzes1ingestcursor.Table, sqlgraph.NewFieldSpec(bronzes1ingestcursor.FieldID, field.TypeUint))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeS1IngestCursor
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1ingestcursor.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzes1ingestcursor.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(bronzes1ingestcursor.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.LastUpdatedAt(); ok {
+		_spec.SetField(bronzes1ingestcursor.FieldLastUpdatedAt, field.TypeTime, value)
+		_node.LastUpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// BronzeS1IngestCursorCreateBulk is the builder for creating many BronzeS1IngestCursor entities in bulk.
+type BronzeS1IngestCursorCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeS1IngestCursorCreate
+}
+
+// Save creates the BronzeS1IngestCursor entities in the database.
+func (_c *BronzeS1IngestCursorCreateBulk) Save(ctx context.Context) ([]*BronzeS1IngestCursor, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeS1IngestCursor, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeS1IngestCursorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeS1IngestCursorCreateBulk) SaveX(ctx context.Context) []*BronzeS1IngestCursor {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeS1IngestCursorCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeS1IngestCursorCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1ingestcursor"
+	"danny.vn/hotpot/pkg/storage/ent/s1/internal"
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeS1IngestCursorDelete is the builder for deleting a BronzeS1IngestCursor entity.
+type BronzeS1IngestCursorDelete struct {
+	config
+	hooks    []Hook
+	mutation *BronzeS1IngestCursorMutation
+}
+
+// Where appends a list predicates to the BronzeS1IngestCursorDelete builder.
+func (_d *BronzeS1IngestCursorDelete) Where(ps ...predicate.BronzeS1IngestCursor) *BronzeS1IngestCursorDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BronzeS1IngestCursorDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeS1IngestCursorDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BronzeS1IngestCursorDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bronzes1ingestcursor.Table, sqlgraph.NewFieldSpec(bronzes1ingestcursor.FieldID, field.TypeUint))
+	_spec.Node.Schema = _d.schemaConfig.BronzeS1IngestCursor
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BronzeS1IngestCursorDeleteOne is the builder for deleting a single BronzeS1IngestCursor entity.
+type BronzeS1IngestCursorDeleteOne struct {
+	_d *BronzeS1IngestCursorDelete
+}
+
+// Where appends a list predicates to the BronzeS1IngestCursorDelete builder.
+func (_d *BronzeS1IngestCursorDeleteOne) Where(ps ...predicate.BronzeS1IngestCursor) *BronzeS1IngestCursorDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BronzeS1IngestCursorDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bronzes1ingestcursor.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BronzeS1IngestCursorDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
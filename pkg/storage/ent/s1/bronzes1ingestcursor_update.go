@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1ingestcursor"
+	"danny.vn/hotpot/pkg/storage/ent/s1/internal"
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeS1IngestCursorUpdate is the builder for updating BronzeS1IngestCursor entities.
+type BronzeS1IngestCursorUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeS1IngestCursorMutation
+}
+
+// Where appends a list predicates to the BronzeS1IngestCursorUpdate builder.
+func (_u *BronzeS1IngestCursorUpdate) Where(ps ...predicate.BronzeS1IngestCursor) *BronzeS1IngestCursorUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeS1IngestCursorUpdate) SetCollectedAt(v time.Time) *BronzeS1IngestCursorUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeS1IngestCursorUpdate) SetNillableCollectedAt(v *time.Time) *BronzeS1IngestCursorUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeS1IngestCursorUpdate) SetName(v string) *BronzeS1IngestCursorUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeS1IngestCursorUpdate) SetNillableName(v *string) *BronzeS1IngestCursorUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetLastUpdatedAt sets the "last_updated_at" field.
+func (_u *BronzeS1IngestCursorUpdate) SetLastUpdatedAt(v time.Time) *BronzeS1IngestCursorUpdate {
+	_u.mutation.SetLastUpdatedAt(v)
+	return _u
+}
+
+// SetNillableLastUpdatedAt sets the "last_updated_at" field if the given value is not nil.
+func (_u *BronzeS1IngestCursorUpdate) SetNillableLastUpdatedAt(v *time.Time) *BronzeS1IngestCursorUpdate {
+	if v != nil {
+		_u.SetLastUpdatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeS1IngestCursorMutation object of the builder.
+func (_u *BronzeS1IngestCursorUpdate) Mutation() *BronzeS1IngestCursorMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeS1IngestCursorUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeS1IngestCursorUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeS1IngestCursorUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeS1IngestCursorUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeS1IngestCursorUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzes1ingestcursor.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`s1: validator failed for field "BronzeS1IngestCursor.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeS1IngestCursorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzes1ingestcursor.Table, bronzes1ingestcursor.Columns, sqlgraph.NewFieldSpec(bronzes1ingestcursor.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1ingestcursor.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzes1ingestcursor.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastUpdatedAt(); ok {
+		_spec.SetField(bronzes1ingestcursor.FieldLastUpdatedAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeS1IngestCursor
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzes1ingestcursor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeS1IngestCursorUpdateOne is the builder for updating a single BronzeS1IngestCursor entity.
+type BronzeS1IngestCursorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeS1IngestCursorMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeS1IngestCursorUpdateOne) SetCollectedAt(v time.Time) *BronzeS1IngestCursorUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeS1IngestCursorUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeS1IngestCursorUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BronzeS1IngestCursorUpdateOne) SetName(v string) *BronzeS1IngestCursorUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BronzeS1IngestCursorUpdateOne) SetNillableName(v *string) *BronzeS1IngestCursorUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetLastUpdatedAt sets the "last_updated_at" field.
+func (_u *BronzeS1IngestCursorUpdateOne) SetLastUpdatedAt(v time.Time) *BronzeS1IngestCursorUpdateOne {
+	_u.mutation.SetLastUpdatedAt(v)
+	return _u
+}
+
+// SetNillableLastUpdatedAt sets the "last_updated_at" field if the given value is not nil.
+func (_u *BronzeS1IngestCursorUpdateOne) SetNillableLastUpdatedAt(v *time.Time) *BronzeS1IngestCursorUpdateOne {
+	if v != nil {
+		_u.SetLastUpdatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BronzeS1IngestCursorMutation object of the builder.
+func (_u *BronzeS1IngestCursorUpdateOne) Mutation() *BronzeS1IngestCursorMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeS1IngestCursorUpdate builder.
+func (_u *BronzeS1IngestCursorUpdateOne) Where(ps ...predicate.BronzeS1IngestCursor) *BronzeS1IngestCursorUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeS1IngestCursorUpdateOne) Select(field string, fields ...string) *BronzeS1IngestCursorUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeS1IngestCursor entity.
+func (_u *BronzeS1IngestCursorUpdateOne) Save(ctx context.Context) (*BronzeS1IngestCursor, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeS1IngestCursorUpdateOne) SaveX(ctx context.Context) *BronzeS1IngestCursor {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeS1IngestCursorUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeS1IngestCursorUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeS1IngestCursorUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := bronzes1ingestcursor.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`s1: validator failed for field "BronzeS1IngestCursor.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeS1IngestCursorUpdateOne) sqlSave(ctx context.Context) (_node *BronzeS1IngestCursor, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzes1ingestcursor.Table, bronzes1ingestcursor.Columns, sqlgraph.NewFieldSpec(bronzes1ingestcursor.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`s1: missing "BronzeS1IngestCursor.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzes1ingestcursor.FieldID)
+		for _, f := range fields {
+			if !bronzes1ingestcursor.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("s1: invalid field %q for query", f)}
+			}
+			if f != bronzes1ingestcursor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1ingestcursor.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(bronzes1ingestcursor.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastUpdatedAt(); ok {
+		_spec.SetField(bronzes1ingestcursor.FieldLastUpdatedAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeS1IngestCursor
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeS1IngestCursor{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzes1ingestcursor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1policy"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeS1Policy is the model entity for the BronzeS1Policy schema.
+type BronzeS1Policy struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Synthesized: scope level and ID, e.g. site:123 or tenant
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// Scope level: tenant, account, site or group
+	ScopeLevel string `json:"scope_level,omitempty"`
+	// SentinelOne account ID; joins to s1_accounts.resource_id
+	AccountID string `json:"account_id,omitempty"`
+	// SentinelOne site ID; joins to s1_sites.resource_id
+	SiteID string `json:"site_id,omitempty"`
+	// SentinelOne group ID; joins to s1_groups.resource_id
+	GroupID string `json:"group_id,omitempty"`
+	// ScopeName holds the value of the "scope_name" field.
+	ScopeName string `json:"scope_name,omitempty"`
+	// Scope level the policy is inherited from, empty when set locally
+	InheritedFrom string `json:"inherited_from,omitempty"`
+	// Response to malicious threats: detect or protect
+	MitigationMode string `json:"mitigation_mode,omitempty"`
+	// Response to suspicious threats: detect or protect
+	MitigationModeSuspicious string `json:"mitigation_mode_suspicious,omitempty"`
+	// e.g. mitigation.quarantineThreat
+	AutoMitigationAction string `json:"auto_mitigation_action,omitempty"`
+	// AntiTamperingOn holds the value of the "anti_tampering_on" field.
+	AntiTamperingOn bool `json:"anti_tampering_on,omitempty"`
+	// SnapshotsOn holds the value of the "snapshots_on" field.
+	SnapshotsOn bool `json:"snapshots_on,omitempty"`
+	// AgentLoggingOn holds the value of the "agent_logging_on" field.
+	AgentLoggingOn bool `json:"agent_logging_on,omitempty"`
+	// ScanNewAgents holds the value of the "scan_new_agents" field.
+	ScanNewAgents bool `json:"scan_new_agents,omitempty"`
+	// NetworkQuarantineOn holds the value of the "network_quarantine_on" field.
+	NetworkQuarantineOn bool `json:"network_quarantine_on,omitempty"`
+	// Detection engine toggles
+	EnginesJSON json.RawMessage `json:"engines_json,omitempty"`
+	// Full policy as returned by the API
+	PolicyJSON json.RawMessage `json:"policy_json,omitempty"`
+	// APIUpdatedAt holds the value of the "api_updated_at" field.
+	APIUpdatedAt *time.Time `json:"api_updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeS1Policy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzes1policy.FieldEnginesJSON, bronzes1policy.FieldPolicyJSON:
+			values[i] = new([]byte)
+		case bronzes1policy.FieldAntiTamperingOn, bronzes1policy.FieldSnapshotsOn, bronzes1policy.FieldAgentLoggingOn, bronzes1policy.FieldScanNewAgents, bronzes1policy.FieldNetworkQuarantineOn:
+			values[i] = new(sql.NullBool)
+		case bronzes1policy.FieldID, bronzes1policy.FieldScopeLevel, bronzes1policy.FieldAccountID, bronzes1policy.FieldSiteID, bronzes1policy.FieldGroupID, bronzes1policy.FieldScopeName, bronzes1policy.FieldInheritedFrom, bronzes1policy.FieldMitigationMode, bronzes1policy.FieldMitigationModeSuspicious, bronzes1policy.FieldAutoMitigationAction:
+			values[i] = new(sql.NullString)
+		case bronzes1policy.FieldCollectedAt, bronzes1policy.FieldFirstCollectedAt, bronzes1policy.FieldAPIUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeS1Policy fields.
+func (_m *BronzeS1Policy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzes1policy.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzes1policy.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzes1policy.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzes1policy.FieldScopeLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope_level", values[i])
+			} else if value.Valid {
+				_m.ScopeLevel = value.String
+			}
+		case bronzes1policy.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzes1policy.FieldSiteID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field site_id", values[i])
+			} else if value.Valid {
+				_m.SiteID = value.String
+			}
+		case bronzes1policy.FieldGroupID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				_m.GroupID = value.String
+			}
+		case bronzes1policy.FieldScopeName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope_name", values[i])
+			} else if value.Valid {
+				_m.ScopeName = value.String
+			}
+		case bronzes1policy.FieldInheritedFrom:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field inherited_from", values[i])
+			} else if value.Valid {
+				_m.InheritedFrom = value.String
+			}
+		case bronzes1policy.FieldMitigationMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mitigation_mode", values[i])
+			} else if value.Valid {
+				_m.MitigationMode = value.String
+			}
+		case bronzes1policy.FieldMitigationModeSuspicious:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mitigation_mode_suspicious", values[i])
+			} else if value.Valid {
+				_m.MitigationModeSuspicious = value.String
+			}
+		case bronzes1policy.FieldAutoMitigationAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_mitigation_action", values[i])
+			} else if value.Valid {
+				_m.AutoMitigationAction = value.String
+			}
+		case bronzes1policy.FieldAntiTamperingOn:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field anti_tampering_on", values[i])
+			} else if value.Valid {
+				_m.AntiTamperingOn = value.Bool
+			}
+		case bronzes1policy.FieldSnapshotsOn:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshots_on", values[i])
+			} else if value.Valid {
+				_m.SnapshotsOn = value.Bool
+			}
+		case bronzes1policy.FieldAgentLoggingOn:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_logging_on", values[i])
+			} else if value.Valid {
+				_m.AgentLoggingOn = value.Bool
+			}
+		case bronzes1policy.FieldScanNewAgents:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field scan_new_agents", values[i])
+			} else if value.Valid {
+				_m.ScanNewAgents = value.Bool
+			}
+		case bronzes1policy.FieldNetworkQuarantineOn:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field network_quarantine_on", values[i])
+			} else if value.Valid {
+				_m.NetworkQuarantineOn = value.Bool
+			}
+		case bronzes1policy.FieldEnginesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field engines_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EnginesJSON); err != nil {
+					return fmt.Errorf("unmarshal field engines_json: %w", err)
+				}
+			}
+		case bronzes1policy.FieldPolicyJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PolicyJSON); err != nil {
+					return fmt.Errorf("unmarshal field policy_json: %w", err)
+				}
+			}
+		case bronzes1policy.FieldAPIUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_updated_at", values[i])
+			} else if value.Valid {
+				_m.APIUpdatedAt = new(time.Time)
+				*_m.APIUpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeS1Policy.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeS1Policy) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeS1Policy.
+// Note that you need to call BronzeS1Policy.Unwrap() before calling this method if this BronzeS1Policy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeS1Policy) Update() *BronzeS1PolicyUpdateOne {
+	return NewBronzeS1PolicyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeS1Policy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeS1Policy) Unwrap() *BronzeS1Policy {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("s1: BronzeS1Policy is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeS1Policy) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeS1Policy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("scope_level=")
+	builder.WriteString(_m.ScopeLevel)
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("site_id=")
+	builder.WriteString(_m.SiteID)
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(_m.GroupID)
+	builder.WriteString(", ")
+	builder.WriteString("scope_name=")
+	builder.WriteString(_m.ScopeName)
+	builder.WriteString(", ")
+	builder.WriteString("inherited_from=")
+	builder.WriteString(_m.InheritedFrom)
+	builder.WriteString(", ")
+	builder.WriteString("mitigation_mode=")
+	builder.WriteString(_m.MitigationMode)
+	builder.WriteString(", ")
+	builder.WriteString("mitigation_mode_suspicious=")
+	builder.WriteString(_m.MitigationModeSuspicious)
+	builder.WriteString(", ")
+	builder.WriteString("auto_mitigation_action=")
+	builder.WriteString(_m.AutoMitigationAction)
+	builder.WriteString(", ")
+	builder.WriteString("anti_tampering_on=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AntiTamperingOn))
+	builder.WriteString(", ")
+	builder.WriteString("snapshots_on=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SnapshotsOn))
+	builder.WriteString(", ")
+	builder.WriteString("agent_logging_on=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AgentLoggingOn))
+	builder.WriteString(", ")
+	builder.WriteString("scan_new_agents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScanNewAgents))
+	builder.WriteString(", ")
+	builder.WriteString("network_quarantine_on=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NetworkQuarantineOn))
+	builder.WriteString(", ")
+	builder.WriteString("engines_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnginesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("policy_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PolicyJSON))
+	builder.WriteString(", ")
+	if v := _m.APIUpdatedAt; v != nil {
+		builder.WriteString("api_updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeS1Policies is a parsable slice of BronzeS1Policy.
+type BronzeS1Policies []*BronzeS1Policy
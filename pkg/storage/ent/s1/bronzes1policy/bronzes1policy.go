@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzes1policy
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzes1policy type in the database.
+	Label = "bronze_s1policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldScopeLevel holds the string denoting the scope_level field in the database.
+	FieldScopeLevel = "scope_level"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldSiteID holds the string denoting the site_id field in the database.
+	FieldSiteID = "site_id"
+	// FieldGroupID holds the string denoting the group_id field in the database.
+	FieldGroupID = "group_id"
+	// FieldScopeName holds the string denoting the scope_name field in the database.
+	FieldScopeName = "scope_name"
+	// FieldInheritedFrom holds the string denoting the inherited_from field in the database.
+	FieldInheritedFrom = "inherited_from"
+	// FieldMitigationMode holds the string denoting the mitigation_mode field in the database.
+	FieldMitigationMode = "mitigation_mode"
+	// FieldMitigationModeSuspicious holds the string denoting the mitigation_mode_suspicious field in the database.
+	FieldMitigationModeSuspicious = "mitigation_mode_suspicious"
+	// FieldAutoMitigationAction holds the string denoting the auto_mitigation_action field in the database.
+	FieldAutoMitigationAction = "auto_mitigation_action"
+	// FieldAntiTamperingOn holds the string denoting the anti_tampering_on field in the database.
+	FieldAntiTamperingOn = "anti_tampering_on"
+	// FieldSnapshotsOn holds the string denoting the snapshots_on field in the database.
+	FieldSnapshotsOn = "snapshots_on"
+	// FieldAgentLoggingOn holds the string denoting the agent_logging_on field in the database.
+	FieldAgentLoggingOn = "agent_logging_on"
+	// FieldScanNewAgents holds the string denoting the scan_new_agents field in the database.
+	FieldScanNewAgents = "scan_new_agents"
+	// FieldNetworkQuarantineOn holds the string denoting the network_quarantine_on field in the database.
+	FieldNetworkQuarantineOn = "network_quarantine_on"
+	// FieldEnginesJSON holds the string denoting the engines_json field in the database.
+	FieldEnginesJSON = "engines_json"
+	// FieldPolicyJSON holds the string denoting the policy_json field in the database.
+	FieldPolicyJSON = "policy_json"
+	// FieldAPIUpdatedAt holds the string denoting the api_updated_at field in the database.
+	FieldAPIUpdatedAt = "api_updated_at"
+	// Table holds the table name of the bronzes1policy in the database.
+	Table = "s1_policies"
+)
+
+// Columns holds all SQL columns for bronzes1policy fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldScopeLevel,
+	FieldAccountID,
+	FieldSiteID,
+	FieldGroupID,
+	FieldScopeName,
+	FieldInheritedFrom,
+	FieldMitigationMode,
+	FieldMitigationModeSuspicious,
+	FieldAutoMitigationAction,
+	FieldAntiTamperingOn,
+	FieldSnapshotsOn,
+	FieldAgentLoggingOn,
+	FieldScanNewAgents,
+	FieldNetworkQuarantineOn,
+	FieldEnginesJSON,
+	FieldPolicyJSON,
+	FieldAPIUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ScopeLevelValidator is a validator for the "scope_level" field. It is called by the builders before save.
+	ScopeLevelValidator func(string) error
+	// DefaultAntiTamperingOn holds the default value on creation for the "anti_tampering_on" field.
+	DefaultAntiTamperingOn bool
+	// DefaultSnapshotsOn holds the default value on creation for the "snapshots_on" field.
+	DefaultSnapshotsOn bool
+	// DefaultAgentLoggingOn holds the default value on creation for the "agent_logging_on" field.
+	DefaultAgentLoggingOn bool
+	// DefaultScanNewAgents holds the default value on creation for the "scan_new_agents" field.
+	DefaultScanNewAgents bool
+	// DefaultNetworkQuarantineOn holds the default value on creation for the "network_quarantine_on" field.
+	DefaultNetworkQuarantineOn bool
+)
+
+// OrderOption defines the ordering options for the BronzeS1Policy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByScopeLevel orders the results by the scope_level field.
+func ByScopeLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScopeLevel, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// BySiteID orders the results by the site_id field.
+func BySiteID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSiteID, opts...).ToFunc()
+}
+
+// ByGroupID orders the results by the group_id field.
+func ByGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupID, opts...).ToFunc()
+}
+
+// ByScopeName orders the results by the scope_name field.
+func ByScopeName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScopeName, opts...).ToFunc()
+}
+
+// ByInheritedFrom orders the results by the inherited_from field.
+func ByInheritedFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInheritedFrom, opts...).ToFunc()
+}
+
+// ByMitigationMode orders the results by the mitigation_mode field.
+func ByMitigationMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMitigationMode, opts...).ToFunc()
+}
+
+// ByMitigationModeSuspicious orders the results by the mitigation_mode_suspicious field.
+func ByMitigationModeSuspicious(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMitigationModeSuspicious, opts...).ToFunc()
+}
+
+// ByAutoMitigationAction orders the results by the auto_mitigation_action field.
+func ByAutoMitigationAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoMitigationAction, opts...).ToFunc()
+}
+
+// ByAntiTamperingOn orders the results by the anti_tampering_on field.
+func ByAntiTamperingOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAntiTamperingOn, opts...).ToFunc()
+}
+
+// BySnapshotsOn orders the results by the snapshots_on field.
+func BySnapshotsOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSnapshotsOn, opts...).ToFunc()
+}
+
+// ByAgentLoggingOn orders the results by the agent_logging_on field.
+func ByAgentLoggingOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgentLoggingOn, opts...).ToFunc()
+}
+
+// ByScanNewAgents orders the results by the scan_new_agents field.
+func ByScanNewAgents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScanNewAgents, opts...).ToFunc()
+}
+
+// ByNetworkQuarantineOn orders the results by the network_quarantine_on field.
+func ByNetworkQuarantineOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetworkQuarantineOn, opts...).ToFunc()
+}
+
+// ByAPIUpdatedAt orders the results by the api_updated_at field.
+func ByAPIUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPIUpdatedAt, opts...).ToFunc()
+}
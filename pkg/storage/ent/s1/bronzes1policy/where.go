@@ -0,0 +1,1030 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzes1policy
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ScopeLevel applies equality check predicate on the "scope_level" field. It's identical to ScopeLevelEQ.
+func ScopeLevel(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldScopeLevel, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldAccountID, v))
+}
+
+// SiteID applies equality check predicate on the "site_id" field. It's identical to SiteIDEQ.
+func SiteID(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldSiteID, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldGroupID, v))
+}
+
+// ScopeName applies equality check predicate on the "scope_name" field. It's identical to ScopeNameEQ.
+func ScopeName(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldScopeName, v))
+}
+
+// InheritedFrom applies equality check predicate on the "inherited_from" field. It's identical to InheritedFromEQ.
+func InheritedFrom(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldInheritedFrom, v))
+}
+
+// MitigationMode applies equality check predicate on the "mitigation_mode" field. It's identical to MitigationModeEQ.
+func MitigationMode(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldMitigationMode, v))
+}
+
+// MitigationModeSuspicious applies equality check predicate on the "mitigation_mode_suspicious" field. It's identical to MitigationModeSuspiciousEQ.
+func MitigationModeSuspicious(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldMitigationModeSuspicious, v))
+}
+
+// AutoMitigationAction applies equality check predicate on the "auto_mitigation_action" field. It's identical to AutoMitigationActionEQ.
+func AutoMitigationAction(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldAutoMitigationAction, v))
+}
+
+// AntiTamperingOn applies equality check predicate on the "anti_tampering_on" field. It's identical to AntiTamperingOnEQ.
+func AntiTamperingOn(v bool) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldAntiTamperingOn, v))
+}
+
+// SnapshotsOn applies equality check predicate on the "snapshots_on" field. It's identical to SnapshotsOnEQ.
+func SnapshotsOn(v bool) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldSnapshotsOn, v))
+}
+
+// AgentLoggingOn applies equality check predicate on the "agent_logging_on" field. It's identical to AgentLoggingOnEQ.
+func AgentLoggingOn(v bool) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldAgentLoggingOn, v))
+}
+
+// ScanNewAgents applies equality check predicate on the "scan_new_agents" field. It's identical to ScanNewAgentsEQ.
+func ScanNewAgents(v bool) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldScanNewAgents, v))
+}
+
+// NetworkQuarantineOn applies equality check predicate on the "network_quarantine_on" field. It's identical to NetworkQuarantineOnEQ.
+func NetworkQuarantineOn(v bool) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldNetworkQuarantineOn, v))
+}
+
+// APIUpdatedAt applies equality check predicate on the "api_updated_at" field. It's identical to APIUpdatedAtEQ.
+func APIUpdatedAt(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldAPIUpdatedAt, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ScopeLevelEQ applies the EQ predicate on the "scope_level" field.
+func ScopeLevelEQ(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldScopeLevel, v))
+}
+
+// ScopeLevelNEQ applies the NEQ predicate on the "scope_level" field.
+func ScopeLevelNEQ(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNEQ(FieldScopeLevel, v))
+}
+
+// ScopeLevelIn applies the In predicate on the "scope_level" field.
+func ScopeLevelIn(vs ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIn(FieldScopeLevel, vs...))
+}
+
+// ScopeLevelNotIn applies the NotIn predicate on the "scope_level" field.
+func ScopeLevelNotIn(vs ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotIn(FieldScopeLevel, vs...))
+}
+
+// ScopeLevelGT applies the GT predicate on the "scope_level" field.
+func ScopeLevelGT(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGT(FieldScopeLevel, v))
+}
+
+// ScopeLevelGTE applies the GTE predicate on the "scope_level" field.
+func ScopeLevelGTE(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGTE(FieldScopeLevel, v))
+}
+
+// ScopeLevelLT applies the LT predicate on the "scope_level" field.
+func ScopeLevelLT(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLT(FieldScopeLevel, v))
+}
+
+// ScopeLevelLTE applies the LTE predicate on the "scope_level" field.
+func ScopeLevelLTE(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLTE(FieldScopeLevel, v))
+}
+
+// ScopeLevelContains applies the Contains predicate on the "scope_level" field.
+func ScopeLevelContains(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldContains(FieldScopeLevel, v))
+}
+
+// ScopeLevelHasPrefix applies the HasPrefix predicate on the "scope_level" field.
+func ScopeLevelHasPrefix(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldHasPrefix(FieldScopeLevel, v))
+}
+
+// ScopeLevelHasSuffix applies the HasSuffix predicate on the "scope_level" field.
+func ScopeLevelHasSuffix(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldHasSuffix(FieldScopeLevel, v))
+}
+
+// ScopeLevelEqualFold applies the EqualFold predicate on the "scope_level" field.
+func ScopeLevelEqualFold(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEqualFold(FieldScopeLevel, v))
+}
+
+// ScopeLevelContainsFold applies the ContainsFold predicate on the "scope_level" field.
+func ScopeLevelContainsFold(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldContainsFold(FieldScopeLevel, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDIsNil applies the IsNil predicate on the "account_id" field.
+func AccountIDIsNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIsNull(FieldAccountID))
+}
+
+// AccountIDNotNil applies the NotNil predicate on the "account_id" field.
+func AccountIDNotNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotNull(FieldAccountID))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// SiteIDEQ applies the EQ predicate on the "site_id" field.
+func SiteIDEQ(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldSiteID, v))
+}
+
+// SiteIDNEQ applies the NEQ predicate on the "site_id" field.
+func SiteIDNEQ(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNEQ(FieldSiteID, v))
+}
+
+// SiteIDIn applies the In predicate on the "site_id" field.
+func SiteIDIn(vs ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIn(FieldSiteID, vs...))
+}
+
+// SiteIDNotIn applies the NotIn predicate on the "site_id" field.
+func SiteIDNotIn(vs ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotIn(FieldSiteID, vs...))
+}
+
+// SiteIDGT applies the GT predicate on the "site_id" field.
+func SiteIDGT(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGT(FieldSiteID, v))
+}
+
+// SiteIDGTE applies the GTE predicate on the "site_id" field.
+func SiteIDGTE(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGTE(FieldSiteID, v))
+}
+
+// SiteIDLT applies the LT predicate on the "site_id" field.
+func SiteIDLT(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLT(FieldSiteID, v))
+}
+
+// SiteIDLTE applies the LTE predicate on the "site_id" field.
+func SiteIDLTE(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLTE(FieldSiteID, v))
+}
+
+// SiteIDContains applies the Contains predicate on the "site_id" field.
+func SiteIDContains(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldContains(FieldSiteID, v))
+}
+
+// SiteIDHasPrefix applies the HasPrefix predicate on the "site_id" field.
+func SiteIDHasPrefix(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldHasPrefix(FieldSiteID, v))
+}
+
+// SiteIDHasSuffix applies the HasSuffix predicate on the "site_id" field.
+func SiteIDHasSuffix(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldHasSuffix(FieldSiteID, v))
+}
+
+// SiteIDIsNil applies the IsNil predicate on the "site_id" field.
+func SiteIDIsNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIsNull(FieldSiteID))
+}
+
+// SiteIDNotNil applies the NotNil predicate on the "site_id" field.
+func SiteIDNotNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotNull(FieldSiteID))
+}
+
+// SiteIDEqualFold applies the EqualFold predicate on the "site_id" field.
+func SiteIDEqualFold(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEqualFold(FieldSiteID, v))
+}
+
+// SiteIDContainsFold applies the ContainsFold predicate on the "site_id" field.
+func SiteIDContainsFold(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldContainsFold(FieldSiteID, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// GroupIDGT applies the GT predicate on the "group_id" field.
+func GroupIDGT(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGT(FieldGroupID, v))
+}
+
+// GroupIDGTE applies the GTE predicate on the "group_id" field.
+func GroupIDGTE(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGTE(FieldGroupID, v))
+}
+
+// GroupIDLT applies the LT predicate on the "group_id" field.
+func GroupIDLT(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLT(FieldGroupID, v))
+}
+
+// GroupIDLTE applies the LTE predicate on the "group_id" field.
+func GroupIDLTE(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLTE(FieldGroupID, v))
+}
+
+// GroupIDContains applies the Contains predicate on the "group_id" field.
+func GroupIDContains(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldContains(FieldGroupID, v))
+}
+
+// GroupIDHasPrefix applies the HasPrefix predicate on the "group_id" field.
+func GroupIDHasPrefix(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldHasPrefix(FieldGroupID, v))
+}
+
+// GroupIDHasSuffix applies the HasSuffix predicate on the "group_id" field.
+func GroupIDHasSuffix(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldHasSuffix(FieldGroupID, v))
+}
+
+// GroupIDIsNil applies the IsNil predicate on the "group_id" field.
+func GroupIDIsNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIsNull(FieldGroupID))
+}
+
+// GroupIDNotNil applies the NotNil predicate on the "group_id" field.
+func GroupIDNotNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotNull(FieldGroupID))
+}
+
+// GroupIDEqualFold applies the EqualFold predicate on the "group_id" field.
+func GroupIDEqualFold(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEqualFold(FieldGroupID, v))
+}
+
+// GroupIDContainsFold applies the ContainsFold predicate on the "group_id" field.
+func GroupIDContainsFold(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldContainsFold(FieldGroupID, v))
+}
+
+// ScopeNameEQ applies the EQ predicate on the "scope_name" field.
+func ScopeNameEQ(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldScopeName, v))
+}
+
+// ScopeNameNEQ applies the NEQ predicate on the "scope_name" field.
+func ScopeNameNEQ(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNEQ(FieldScopeName, v))
+}
+
+// ScopeNameIn applies the In predicate on the "scope_name" field.
+func ScopeNameIn(vs ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIn(FieldScopeName, vs...))
+}
+
+// ScopeNameNotIn applies the NotIn predicate on the "scope_name" field.
+func ScopeNameNotIn(vs ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotIn(FieldScopeName, vs...))
+}
+
+// ScopeNameGT applies the GT predicate on the "scope_name" field.
+func ScopeNameGT(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGT(FieldScopeName, v))
+}
+
+// ScopeNameGTE applies the GTE predicate on the "scope_name" field.
+func ScopeNameGTE(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGTE(FieldScopeName, v))
+}
+
+// ScopeNameLT applies the LT predicate on the "scope_name" field.
+func ScopeNameLT(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLT(FieldScopeName, v))
+}
+
+// ScopeNameLTE applies the LTE predicate on the "scope_name" field.
+func ScopeNameLTE(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLTE(FieldScopeName, v))
+}
+
+// ScopeNameContains applies the Contains predicate on the "scope_name" field.
+func ScopeNameContains(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldContains(FieldScopeName, v))
+}
+
+// ScopeNameHasPrefix applies the HasPrefix predicate on the "scope_name" field.
+func ScopeNameHasPrefix(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldHasPrefix(FieldScopeName, v))
+}
+
+// ScopeNameHasSuffix applies the HasSuffix predicate on the "scope_name" field.
+func ScopeNameHasSuffix(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldHasSuffix(FieldScopeName, v))
+}
+
+// ScopeNameIsNil applies the IsNil predicate on the "scope_name" field.
+func ScopeNameIsNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIsNull(FieldScopeName))
+}
+
+// ScopeNameNotNil applies the NotNil predicate on the "scope_name" field.
+func ScopeNameNotNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotNull(FieldScopeName))
+}
+
+// ScopeNameEqualFold applies the EqualFold predicate on the "scope_name" field.
+func ScopeNameEqualFold(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEqualFold(FieldScopeName, v))
+}
+
+// ScopeNameContainsFold applies the ContainsFold predicate on the "scope_name" field.
+func ScopeNameContainsFold(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldContainsFold(FieldScopeName, v))
+}
+
+// InheritedFromEQ applies the EQ predicate on the "inherited_from" field.
+func InheritedFromEQ(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldInheritedFrom, v))
+}
+
+// InheritedFromNEQ applies the NEQ predicate on the "inherited_from" field.
+func InheritedFromNEQ(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNEQ(FieldInheritedFrom, v))
+}
+
+// InheritedFromIn applies the In predicate on the "inherited_from" field.
+func InheritedFromIn(vs ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIn(FieldInheritedFrom, vs...))
+}
+
+// InheritedFromNotIn applies the NotIn predicate on the "inherited_from" field.
+func InheritedFromNotIn(vs ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotIn(FieldInheritedFrom, vs...))
+}
+
+// InheritedFromGT applies the GT predicate on the "inherited_from" field.
+func InheritedFromGT(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGT(FieldInheritedFrom, v))
+}
+
+// InheritedFromGTE applies the GTE predicate on the "inherited_from" field.
+func InheritedFromGTE(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGTE(FieldInheritedFrom, v))
+}
+
+// InheritedFromLT applies the LT predicate on the "inherited_from" field.
+func InheritedFromLT(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLT(FieldInheritedFrom, v))
+}
+
+// InheritedFromLTE applies the LTE predicate on the "inherited_from" field.
+func InheritedFromLTE(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLTE(FieldInheritedFrom, v))
+}
+
+// InheritedFromContains applies the Contains predicate on the "inherited_from" field.
+func InheritedFromContains(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldContains(FieldInheritedFrom, v))
+}
+
+// InheritedFromHasPrefix applies the HasPrefix predicate on the "inherited_from" field.
+func InheritedFromHasPrefix(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldHasPrefix(FieldInheritedFrom, v))
+}
+
+// InheritedFromHasSuffix applies the HasSuffix predicate on the "inherited_from" field.
+func InheritedFromHasSuffix(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldHasSuffix(FieldInheritedFrom, v))
+}
+
+// InheritedFromIsNil applies the IsNil predicate on the "inherited_from" field.
+func InheritedFromIsNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIsNull(FieldInheritedFrom))
+}
+
+// InheritedFromNotNil applies the NotNil predicate on the "inherited_from" field.
+func InheritedFromNotNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotNull(FieldInheritedFrom))
+}
+
+// InheritedFromEqualFold applies the EqualFold predicate on the "inherited_from" field.
+func InheritedFromEqualFold(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEqualFold(FieldInheritedFrom, v))
+}
+
+// InheritedFromContainsFold applies the ContainsFold predicate on the "inherited_from" field.
+func InheritedFromContainsFold(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldContainsFold(FieldInheritedFrom, v))
+}
+
+// MitigationModeEQ applies the EQ predicate on the "mitigation_mode" field.
+func MitigationModeEQ(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldMitigationMode, v))
+}
+
+// MitigationModeNEQ applies the NEQ predicate on the "mitigation_mode" field.
+func MitigationModeNEQ(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNEQ(FieldMitigationMode, v))
+}
+
+// MitigationModeIn applies the In predicate on the "mitigation_mode" field.
+func MitigationModeIn(vs ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIn(FieldMitigationMode, vs...))
+}
+
+// MitigationModeNotIn applies the NotIn predicate on the "mitigation_mode" field.
+func MitigationModeNotIn(vs ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotIn(FieldMitigationMode, vs...))
+}
+
+// MitigationModeGT applies the GT predicate on the "mitigation_mode" field.
+func MitigationModeGT(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGT(FieldMitigationMode, v))
+}
+
+// MitigationModeGTE applies the GTE predicate on the "mitigation_mode" field.
+func MitigationModeGTE(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGTE(FieldMitigationMode, v))
+}
+
+// MitigationModeLT applies the LT predicate on the "mitigation_mode" field.
+func MitigationModeLT(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLT(FieldMitigationMode, v))
+}
+
+// MitigationModeLTE applies the LTE predicate on the "mitigation_mode" field.
+func MitigationModeLTE(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLTE(FieldMitigationMode, v))
+}
+
+// MitigationModeContains applies the Contains predicate on the "mitigation_mode" field.
+func MitigationModeContains(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldContains(FieldMitigationMode, v))
+}
+
+// MitigationModeHasPrefix applies the HasPrefix predicate on the "mitigation_mode" field.
+func MitigationModeHasPrefix(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldHasPrefix(FieldMitigationMode, v))
+}
+
+// MitigationModeHasSuffix applies the HasSuffix predicate on the "mitigation_mode" field.
+func MitigationModeHasSuffix(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldHasSuffix(FieldMitigationMode, v))
+}
+
+// MitigationModeIsNil applies the IsNil predicate on the "mitigation_mode" field.
+func MitigationModeIsNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIsNull(FieldMitigationMode))
+}
+
+// MitigationModeNotNil applies the NotNil predicate on the "mitigation_mode" field.
+func MitigationModeNotNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotNull(FieldMitigationMode))
+}
+
+// MitigationModeEqualFold applies the EqualFold predicate on the "mitigation_mode" field.
+func MitigationModeEqualFold(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEqualFold(FieldMitigationMode, v))
+}
+
+// MitigationModeContainsFold applies the ContainsFold predicate on the "mitigation_mode" field.
+func MitigationModeContainsFold(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldContainsFold(FieldMitigationMode, v))
+}
+
+// MitigationModeSuspiciousEQ applies the EQ predicate on the "mitigation_mode_suspicious" field.
+func MitigationModeSuspiciousEQ(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldMitigationModeSuspicious, v))
+}
+
+// MitigationModeSuspiciousNEQ applies the NEQ predicate on the "mitigation_mode_suspicious" field.
+func MitigationModeSuspiciousNEQ(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNEQ(FieldMitigationModeSuspicious, v))
+}
+
+// MitigationModeSuspiciousIn applies the In predicate on the "mitigation_mode_suspicious" field.
+func MitigationModeSuspiciousIn(vs ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIn(FieldMitigationModeSuspicious, vs...))
+}
+
+// MitigationModeSuspiciousNotIn applies the NotIn predicate on the "mitigation_mode_suspicious" field.
+func MitigationModeSuspiciousNotIn(vs ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotIn(FieldMitigationModeSuspicious, vs...))
+}
+
+// MitigationModeSuspiciousGT applies the GT predicate on the "mitigation_mode_suspicious" field.
+func MitigationModeSuspiciousGT(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGT(FieldMitigationModeSuspicious, v))
+}
+
+// MitigationModeSuspiciousGTE applies the GTE predicate on the "mitigation_mode_suspicious" field.
+func MitigationModeSuspiciousGTE(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGTE(FieldMitigationModeSuspicious, v))
+}
+
+// MitigationModeSuspiciousLT applies the LT predicate on the "mitigation_mode_suspicious" field.
+func MitigationModeSuspiciousLT(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLT(FieldMitigationModeSuspicious, v))
+}
+
+// MitigationModeSuspiciousLTE applies the LTE predicate on the "mitigation_mode_suspicious" field.
+func MitigationModeSuspiciousLTE(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLTE(FieldMitigationModeSuspicious, v))
+}
+
+// MitigationModeSuspiciousContains applies the Contains predicate on the "mitigation_mode_suspicious" field.
+func MitigationModeSuspiciousContains(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldContains(FieldMitigationModeSuspicious, v))
+}
+
+// MitigationModeSuspiciousHasPrefix applies the HasPrefix predicate on the "mitigation_mode_suspicious" field.
+func MitigationModeSuspiciousHasPrefix(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldHasPrefix(FieldMitigationModeSuspicious, v))
+}
+
+// MitigationModeSuspiciousHasSuffix applies the HasSuffix predicate on the "mitigation_mode_suspicious" field.
+func MitigationModeSuspiciousHasSuffix(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldHasSuffix(FieldMitigationModeSuspicious, v))
+}
+
+// MitigationModeSuspiciousIsNil applies the IsNil predicate on the "mitigation_mode_suspicious" field.
+func MitigationModeSuspiciousIsNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIsNull(FieldMitigationModeSuspicious))
+}
+
+// MitigationModeSuspiciousNotNil applies the NotNil predicate on the "mitigation_mode_suspicious" field.
+func MitigationModeSuspiciousNotNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotNull(FieldMitigationModeSuspicious))
+}
+
+// MitigationModeSuspiciousEqualFold applies the EqualFold predicate on the "mitigation_mode_suspicious" field.
+func MitigationModeSuspiciousEqualFold(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEqualFold(FieldMitigationModeSuspicious, v))
+}
+
+// MitigationModeSuspiciousContainsFold applies the ContainsFold predicate on the "mitigation_mode_suspicious" field.
+func MitigationModeSuspiciousContainsFold(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldContainsFold(FieldMitigationModeSuspicious, v))
+}
+
+// AutoMitigationActionEQ applies the EQ predicate on the "auto_mitigation_action" field.
+func AutoMitigationActionEQ(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldAutoMitigationAction, v))
+}
+
+// AutoMitigationActionNEQ applies the NEQ predicate on the "auto_mitigation_action" field.
+func AutoMitigationActionNEQ(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNEQ(FieldAutoMitigationAction, v))
+}
+
+// AutoMitigationActionIn applies the In predicate on the "auto_mitigation_action" field.
+func AutoMitigationActionIn(vs ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIn(FieldAutoMitigationAction, vs...))
+}
+
+// AutoMitigationActionNotIn applies the NotIn predicate on the "auto_mitigation_action" field.
+func AutoMitigationActionNotIn(vs ...string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotIn(FieldAutoMitigationAction, vs...))
+}
+
+// AutoMitigationActionGT applies the GT predicate on the "auto_mitigation_action" field.
+func AutoMitigationActionGT(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGT(FieldAutoMitigationAction, v))
+}
+
+// AutoMitigationActionGTE applies the GTE predicate on the "auto_mitigation_action" field.
+func AutoMitigationActionGTE(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGTE(FieldAutoMitigationAction, v))
+}
+
+// AutoMitigationActionLT applies the LT predicate on the "auto_mitigation_action" field.
+func AutoMitigationActionLT(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLT(FieldAutoMitigationAction, v))
+}
+
+// AutoMitigationActionLTE applies the LTE predicate on the "auto_mitigation_action" field.
+func AutoMitigationActionLTE(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLTE(FieldAutoMitigationAction, v))
+}
+
+// AutoMitigationActionContains applies the Contains predicate on the "auto_mitigation_action" field.
+func AutoMitigationActionContains(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldContains(FieldAutoMitigationAction, v))
+}
+
+// AutoMitigationActionHasPrefix applies the HasPrefix predicate on the "auto_mitigation_action" field.
+func AutoMitigationActionHasPrefix(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldHasPrefix(FieldAutoMitigationAction, v))
+}
+
+// AutoMitigationActionHasSuffix applies the HasSuffix predicate on the "auto_mitigation_action" field.
+func AutoMitigationActionHasSuffix(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldHasSuffix(FieldAutoMitigationAction, v))
+}
+
+// AutoMitigationActionIsNil applies the IsNil predicate on the "auto_mitigation_action" field.
+func AutoMitigationActionIsNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIsNull(FieldAutoMitigationAction))
+}
+
+// AutoMitigationActionNotNil applies the NotNil predicate on the "auto_mitigation_action" field.
+func AutoMitigationActionNotNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotNull(FieldAutoMitigationAction))
+}
+
+// AutoMitigationActionEqualFold applies the EqualFold predicate on the "auto_mitigation_action" field.
+func AutoMitigationActionEqualFold(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEqualFold(FieldAutoMitigationAction, v))
+}
+
+// AutoMitigationActionContainsFold applies the ContainsFold predicate on the "auto_mitigation_action" field.
+func AutoMitigationActionContainsFold(v string) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldContainsFold(FieldAutoMitigationAction, v))
+}
+
+// AntiTamperingOnEQ applies the EQ predicate on the "anti_tampering_on" field.
+func AntiTamperingOnEQ(v bool) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldAntiTamperingOn, v))
+}
+
+// AntiTamperingOnNEQ applies the NEQ predicate on the "anti_tampering_on" field.
+func AntiTamperingOnNEQ(v bool) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNEQ(FieldAntiTamperingOn, v))
+}
+
+// SnapshotsOnEQ applies the EQ predicate on the "snapshots_on" field.
+func SnapshotsOnEQ(v bool) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldSnapshotsOn, v))
+}
+
+// SnapshotsOnNEQ applies the NEQ predicate on the "snapshots_on" field.
+func SnapshotsOnNEQ(v bool) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNEQ(FieldSnapshotsOn, v))
+}
+
+// AgentLoggingOnEQ applies the EQ predicate on the "agent_logging_on" field.
+func AgentLoggingOnEQ(v bool) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldAgentLoggingOn, v))
+}
+
+// AgentLoggingOnNEQ applies the NEQ predicate on the "agent_logging_on" field.
+func AgentLoggingOnNEQ(v bool) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNEQ(FieldAgentLoggingOn, v))
+}
+
+// ScanNewAgentsEQ applies the EQ predicate on the "scan_new_agents" field.
+func ScanNewAgentsEQ(v bool) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldScanNewAgents, v))
+}
+
+// ScanNewAgentsNEQ applies the NEQ predicate on the "scan_new_agents" field.
+func ScanNewAgentsNEQ(v bool) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNEQ(FieldScanNewAgents, v))
+}
+
+// NetworkQuarantineOnEQ applies the EQ predicate on the "network_quarantine_on" field.
+func NetworkQuarantineOnEQ(v bool) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldNetworkQuarantineOn, v))
+}
+
+// NetworkQuarantineOnNEQ applies the NEQ predicate on the "network_quarantine_on" field.
+func NetworkQuarantineOnNEQ(v bool) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNEQ(FieldNetworkQuarantineOn, v))
+}
+
+// EnginesJSONIsNil applies the IsNil predicate on the "engines_json" field.
+func EnginesJSONIsNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIsNull(FieldEnginesJSON))
+}
+
+// EnginesJSONNotNil applies the NotNil predicate on the "engines_json" field.
+func EnginesJSONNotNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotNull(FieldEnginesJSON))
+}
+
+// PolicyJSONIsNil applies the IsNil predicate on the "policy_json" field.
+func PolicyJSONIsNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIsNull(FieldPolicyJSON))
+}
+
+// PolicyJSONNotNil applies the NotNil predicate on the "policy_json" field.
+func PolicyJSONNotNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotNull(FieldPolicyJSON))
+}
+
+// APIUpdatedAtEQ applies the EQ predicate on the "api_updated_at" field.
+func APIUpdatedAtEQ(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldEQ(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtNEQ applies the NEQ predicate on the "api_updated_at" field.
+func APIUpdatedAtNEQ(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNEQ(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtIn applies the In predicate on the "api_updated_at" field.
+func APIUpdatedAtIn(vs ...time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIn(FieldAPIUpdatedAt, vs...))
+}
+
+// APIUpdatedAtNotIn applies the NotIn predicate on the "api_updated_at" field.
+func APIUpdatedAtNotIn(vs ...time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotIn(FieldAPIUpdatedAt, vs...))
+}
+
+// APIUpdatedAtGT applies the GT predicate on the "api_updated_at" field.
+func APIUpdatedAtGT(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGT(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtGTE applies the GTE predicate on the "api_updated_at" field.
+func APIUpdatedAtGTE(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldGTE(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtLT applies the LT predicate on the "api_updated_at" field.
+func APIUpdatedAtLT(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLT(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtLTE applies the LTE predicate on the "api_updated_at" field.
+func APIUpdatedAtLTE(v time.Time) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldLTE(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtIsNil applies the IsNil predicate on the "api_updated_at" field.
+func APIUpdatedAtIsNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldIsNull(FieldAPIUpdatedAt))
+}
+
+// APIUpdatedAtNotNil applies the NotNil predicate on the "api_updated_at" field.
+func APIUpdatedAtNotNil() predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.FieldNotNull(FieldAPIUpdatedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeS1Policy) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeS1Policy) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeS1Policy) predicate.BronzeS1Policy {
+	return predicate.BronzeS1Policy(sql.NotPredicates(p))
+}
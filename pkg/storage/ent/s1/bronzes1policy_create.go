@@ -0,0 +1,541 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1policy"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeS1PolicyCreate is the builder for creating a BronzeS1Policy entity.
+type BronzeS1PolicyCreate struct {
+	config
+	mutation *BronzeS1PolicyMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeS1PolicyCreate) SetCollectedAt(v time.Time) *BronzeS1PolicyCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeS1PolicyCreate) SetFirstCollectedAt(v time.Time) *BronzeS1PolicyCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetScopeLevel sets the "scope_level" field.
+func (_c *BronzeS1PolicyCreate) SetScopeLevel(v string) *BronzeS1PolicyCreate {
+	_c.mutation.SetScopeLevel(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeS1PolicyCreate) SetAccountID(v string) *BronzeS1PolicyCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_c *BronzeS1PolicyCreate) SetNillableAccountID(v *string) *BronzeS1PolicyCreate {
+	if v != nil {
+		_c.SetAccountID(*v)
+	}
+	return _c
+}
+
+// SetSiteID sets the "site_id" field.
+func (_c *BronzeS1PolicyCreate) SetSiteID(v string) *BronzeS1PolicyCreate {
+	_c.mutation.SetSiteID(v)
+	return _c
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_c *BronzeS1PolicyCreate) SetNillableSiteID(v *string) *BronzeS1PolicyCreate {
+	if v != nil {
+		_c.SetSiteID(*v)
+	}
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *BronzeS1PolicyCreate) SetGroupID(v string) *BronzeS1PolicyCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_c *BronzeS1PolicyCreate) SetNillableGroupID(v *string) *BronzeS1PolicyCreate {
+	if v != nil {
+		_c.SetGroupID(*v)
+	}
+	return _c
+}
+
+// SetScopeName sets the "scope_name" field.
+func (_c *BronzeS1PolicyCreate) SetScopeName(v string) *BronzeS1PolicyCreate {
+	_c.mutation.SetScopeName(v)
+	return _c
+}
+
+// SetNillableScopeName sets the "scope_name" field if the given value is not nil.
+func (_c *BronzeS1PolicyCreate) SetNillableScopeName(v *string) *BronzeS1PolicyCreate {
+	if v != nil {
+		_c.SetScopeName(*v)
+	}
+	return _c
+}
+
+// SetInheritedFrom sets the "inherited_from" field.
+func (_c *BronzeS1PolicyCreate) SetInheritedFrom(v string) *BronzeS1PolicyCreate {
+	_c.mutation.SetInheritedFrom(v)
+	return _c
+}
+
+// SetNillableInheritedFrom sets the "inherited_from" field if the given value is not nil.
+func (_c *BronzeS1PolicyCreate) SetNillableInheritedFrom(v *string) *BronzeS1PolicyCreate {
+	if v != nil {
+		_c.SetInheritedFrom(*v)
+	}
+	return _c
+}
+
+// SetMitigationMode sets the "mitigation_mode" field.
+func (_c *BronzeS1PolicyCreate) SetMitigationMode(v string) *BronzeS1PolicyCreate {
+	_c.mutation.SetMitigationMode(v)
+	return _c
+}
+
+// SetNillableMitigationMode sets the "mitigation_mode" field if the given value is not nil.
+func (_c *BronzeS1PolicyCreate) SetNillableMitigationMode(v *string) *BronzeS1PolicyCreate {
+	if v != nil {
+		_c.SetMitigationMode(*v)
+	}
+	return _c
+}
+
+// SetMitigationModeSuspicious sets the "mitigation_mode_suspicious" field.
+func (_c *BronzeS1PolicyCreate) SetMitigationModeSuspicious(v string) *BronzeS1PolicyCreate {
+	_c.mutation.SetMitigationModeSuspicious(v)
+	return _c
+}
+
+// SetNillableMitigationModeSuspicious sets the "mitigation_mode_suspicious" field if the given value is not nil.
+func (_c *BronzeS1PolicyCreate) SetNillableMitigationModeSuspicious(v *string) *BronzeS1PolicyCreate {
+	if v != nil {
+		_c.SetMitigationModeSuspicious(*v)
+	}
+	return _c
+}
+
+// SetAutoMitigationAction sets the "auto_mitigation_action" field.
+func (_c *BronzeS1PolicyCreate) SetAutoMitigationAction(v string) *BronzeS1PolicyCreate {
+	_c.mutation.SetAutoMitigationAction(v)
+	return _c
+}
+
+// SetNillableAutoMitigationAction sets the "auto_mitigation_action" field if the given value is not nil.
+func (_c *BronzeS1PolicyCreate) SetNillableAutoMitigationAction(v *string) *BronzeS1PolicyCreate {
+	if v != nil {
+		_c.SetAutoMitigationAction(*v)
+	}
+	return _c
+}
+
+// SetAntiTamperingOn sets the "anti_tampering_on" field.
+func (_c *BronzeS1PolicyCreate) SetAntiTamperingOn(v bool) *BronzeS1PolicyCreate {
+	_c.mutation.SetAntiTamperingOn(v)
+	return _c
+}
+
+// SetNillableAntiTamperingOn sets the "anti_tampering_on" field if the given value is not nil.
+func (_c *BronzeS1PolicyCreate) SetNillableAntiTamperingOn(v *bool) *BronzeS1PolicyCreate {
+	if v != nil {
+		_c.SetAntiTamperingOn(*v)
+	}
+	return _c
+}
+
+// SetSnapshotsOn sets the "snapshots_on" field.
+func (_c *BronzeS1PolicyCreate) SetSnapshotsOn(v bool) *BronzeS1PolicyCreate {
+	_c.mutation.SetSnapshotsOn(v)
+	return _c
+}
+
+// SetNillableSnapshotsOn sets the "snapshots_on" field if the given value is not nil.
+func (_c *BronzeS1PolicyCreate) SetNillableSnapshotsOn(v *bool) *BronzeS1PolicyCreate {
+	if v != nil {
+		_c.SetSnapshotsOn(*v)
+	}
+	return _c
+}
+
+// SetAgentLoggingOn sets the "agent_logging_on" field.
+func (_c *BronzeS1PolicyCreate) SetAgentLoggingOn(v bool) *BronzeS1PolicyCreate {
+	_c.mutation.SetAgentLoggingOn(v)
+	return _c
+}
+
+// SetNillableAgentLoggingOn sets the "agent_logging_on" field if the given value is not nil.
+func (_c *BronzeS1PolicyCreate) SetNillableAgentLoggingOn(v *bool) *BronzeS1PolicyCreate {
+	if v != nil {
+		_c.SetAgentLoggingOn(*v)
+	}
+	return _c
+}
+
+// SetScanNewAgents sets the "scan_new_agents" field.
+func (_c *BronzeS1PolicyCreate) SetScanNewAgents(v bool) *BronzeS1PolicyCreate {
+	_c.mutation.SetScanNewAgents(v)
+	return _c
+}
+
+// SetNillableScanNewAgents sets the "scan_new_agents" field if the given value is not nil.
+func (_c *BronzeS1PolicyCreate) SetNillableScanNewAgents(v *bool) *BronzeS1PolicyCreate {
+	if v != nil {
+		_c.SetScanNewAgents(*v)
+	}
+	return _c
+}
+
+// SetNetworkQuarantineOn sets the "network_quarantine_on" field.
+func (_c *BronzeS1PolicyCreate) SetNetworkQuarantineOn(v bool) *BronzeS1PolicyCreate {
+	_c.mutation.SetNetworkQuarantineOn(v)
+	return _c
+}
+
+// SetNillableNetworkQuarantineOn sets the "network_quarantine_on" field if the given value is not nil.
+func (_c *BronzeS1PolicyCreate) SetNillableNetworkQuarantineOn(v *bool) *BronzeS1PolicyCreate {
+	if v != nil {
+		_c.SetNetworkQuarantineOn(*v)
+	}
+	return _c
+}
+
+// SetEnginesJSON sets the "engines_json" field.
+func (_c *BronzeS1PolicyCreate) SetEnginesJSON(v json.RawMessage) *BronzeS1PolicyCreate {
+	_c.mutation.SetEnginesJSON(v)
+	return _c
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (_c *BronzeS1PolicyCreate) SetPolicyJSON(v json.RawMessage) *BronzeS1PolicyCreate {
+	_c.mutation.SetPolicyJSON(v)
+	return _c
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_c *BronzeS1PolicyCreate) SetAPIUpdatedAt(v time.Time) *BronzeS1PolicyCreate {
+	_c.mutation.SetAPIUpdatedAt(v)
+	return _c
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_c *BronzeS1PolicyCreate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeS1PolicyCreate {
+	if v != nil {
+		_c.SetAPIUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeS1PolicyCreate) SetID(v string) *BronzeS1PolicyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeS1PolicyMutation object of the builder.
+func (_c *BronzeS1PolicyCreate) Mutation() *BronzeS1PolicyMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeS1Policy in the database.
+func (_c *BronzeS1PolicyCreate) Save(ctx context.Context) (*BronzeS1Policy, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeS1PolicyCreate) SaveX(ctx context.Context) *BronzeS1Policy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeS1PolicyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeS1PolicyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeS1PolicyCreate) defaults() {
+	if _, ok := _c.mutation.AntiTamperingOn(); !ok {
+		v := bronzes1policy.DefaultAntiTamperingOn
+		_c.mutation.SetAntiTamperingOn(v)
+	}
+	if _, ok := _c.mutation.SnapshotsOn(); !ok {
+		v := bronzes1policy.DefaultSnapshotsOn
+		_c.mutation.SetSnapshotsOn(v)
+	}
+	if _, ok := _c.mutation.AgentLoggingOn(); !ok {
+		v := bronzes1policy.DefaultAgentLoggingOn
+		_c.mutation.SetAgentLoggingOn(v)
+	}
+	if _, ok := _c.mutation.ScanNewAgents(); !ok {
+		v := bronzes1policy.DefaultScanNewAgents
+		_c.mutation.SetScanNewAgents(v)
+	}
+	if _, ok := _c.mutation.NetworkQuarantineOn(); !ok {
+		v := bronzes1policy.DefaultNetworkQuarantineOn
+		_c.mutation.SetNetworkQuarantineOn(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeS1PolicyCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`s1: missing required field "BronzeS1Policy.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`s1: missing required field "BronzeS1Policy.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ScopeLevel(); !ok {
+		return &ValidationError{Name: "scope_level", err: errors.New(`s1: missing required field "BronzeS1Policy.scope_level"`)}
+	}
+	if v, ok := _c.mutation.ScopeLevel(); ok {
+		if err := bronzes1policy.ScopeLevelValidator(v); err != nil {
+			return &ValidationError{Name: "scope_level", err: fmt.Errorf(`s1: validator failed for field "BronzeS1Policy.scope_level": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AntiTamperingOn(); !ok {
+		return &ValidationError{Name: "anti_tampering_on", err: errors.New(`s1: missing required field "BronzeS1Policy.anti_tampering_on"`)}
+	}
+	if _, ok := _c.mutation.SnapshotsOn(); !ok {
+		return &ValidationError{Name: "snapshots_on", err: errors.New(`s1: missing required field "BronzeS1Policy.snapshots_on"`)}
+	}
+	if _, ok := _c.mutation.AgentLoggingOn(); !ok {
+		return &ValidationError{Name: "agent_logging_on", err: errors.New(`s1: missing required field "BronzeS1Policy.agent_logging_on"`)}
+	}
+	if _, ok := _c.mutation.ScanNewAgents(); !ok {
+		return &ValidationError{Name: "scan_new_agents", err: errors.New(`s1: missing required field "BronzeS1Policy.scan_new_agents"`)}
+	}
+	if _, ok := _c.mutation.NetworkQuarantineOn(); !ok {
+		return &ValidationError{Name: "network_quarantine_on", err: errors.New(`s1: missing required field "BronzeS1Policy.network_quarantine_on"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeS1PolicyCreate) sqlSave(ctx context.Context) (*BronzeS1Policy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeS1Policy.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeS1PolicyCreate) createSpec() (*BronzeS1Policy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeS1Policy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzes1policy.Table, sqlgraph.NewFieldSpec(bronzes1policy.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeS1Policy
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1policy.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzes1policy.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ScopeLevel(); ok {
+		_spec.SetField(bronzes1policy.FieldScopeLevel, field.TypeString, value)
+		_node.ScopeLevel = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzes1policy.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.SiteID(); ok {
+		_spec.SetField(bronzes1policy.FieldSiteID, field.TypeString, value)
+		_node.SiteID = value
+	}
+	if value, ok := _c.mutation.GroupID(); ok {
+		_spec.SetField(bronzes1policy.FieldGroupID, field.TypeString, value)
+		_node.GroupID = value
+	}
+	if value, ok := _c.mutation.ScopeName(); ok {
+		_spec.SetField(bronzes1policy.FieldScopeName, field.TypeString, value)
+		_node.ScopeName = value
+	}
+	if value, ok := _c.mutation.InheritedFrom(); ok {
+		_spec.SetField(bronzes1policy.FieldInheritedFrom, field.TypeString, value)
+		_node.InheritedFrom = value
+	}
+	if value, ok := _c.mutation.MitigationMode(); ok {
+		_spec.SetField(bronzes1policy.FieldMitigationMode, field.TypeString, value)
+		_node.MitigationMode = value
+	}
+	if value, ok := _c.mutation.MitigationModeSuspicious(); ok {
+		_spec.SetField(bronzes1policy.FieldMitigationModeSuspicious, field.TypeString, value)
+		_node.MitigationModeSuspicious = value
+	}
+	if value, ok := _c.mutation.AutoMitigationAction(); ok {
+		_spec.SetField(bronzes1policy.FieldAutoMitigationAction, field.TypeString, value)
+		_node.AutoMitigationAction = value
+	}
+	if value, ok := _c.mutation.AntiTamperingOn(); ok {
+		_spec.SetField(bronzes1policy.FieldAntiTamperingOn, field.TypeBool, value)
+		_node.AntiTamperingOn = value
+	}
+	if value, ok := _c.mutation.SnapshotsOn(); ok {
+		_spec.SetField(bronzes1policy.FieldSnapshotsOn, field.TypeBool, value)
+		_node.SnapshotsOn = value
+	}
+	if value, ok := _c.mutation.AgentLoggingOn(); ok {
+		_spec.SetField(bronzes1policy.FieldAgentLoggingOn, field.TypeBool, value)
+		_node.AgentLoggingOn = value
+	}
+	if value, ok := _c.mutation.ScanNewAgents(); ok {
+		_spec.SetField(bronzes1policy.FieldScanNewAgents, field.TypeBool, value)
+		_node.ScanNewAgents = value
+	}
+	if value, ok := _c.mutation.NetworkQuarantineOn(); ok {
+		_spec.SetField(bronzes1policy.FieldNetworkQuarantineOn, field.TypeBool, value)
+		_node.NetworkQuarantineOn = value
+	}
+	if value, ok := _c.mutation.EnginesJSON(); ok {
+		_spec.SetField(bronzes1policy.FieldEnginesJSON, field.TypeJSON, value)
+		_node.EnginesJSON = value
+	}
+	if value, ok := _c.mutation.PolicyJSON(); ok {
+		_spec.SetField(bronzes1policy.FieldPolicyJSON, field.TypeJSON, value)
+		_node.PolicyJSON = value
+	}
+	if value, ok := _c.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzes1policy.FieldAPIUpdatedAt, field.TypeTime, value)
+		_node.APIUpdatedAt = &value
+	}
+	return _node, _spec
+}
+
+// BronzeS1PolicyCreateBulk is the builder for creating many BronzeS1Policy entities in bulk.
+type BronzeS1PolicyCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeS1PolicyCreate
+}
+
+// Save creates the BronzeS1Policy entities in the database.
+func (_c *BronzeS1PolicyCreateBulk) Save(ctx context.Context) ([]*BronzeS1Policy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeS1Policy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeS1PolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeS1PolicyCreateBulk) SaveX(ctx context.Context) []*BronzeS1Policy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeS1PolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeS1PolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1031 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1policy"
+	"danny.vn/hotpot/pkg/storage/ent/s1/internal"
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeS1PolicyUpdate is the builder for updating BronzeS1Policy entities.
+type BronzeS1PolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeS1PolicyMutation
+}
+
+// Where appends a list predicates to the BronzeS1PolicyUpdate builder.
+func (_u *BronzeS1PolicyUpdate) Where(ps ...predicate.BronzeS1Policy) *BronzeS1PolicyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeS1PolicyUpdate) SetCollectedAt(v time.Time) *BronzeS1PolicyUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdate) SetNillableCollectedAt(v *time.Time) *BronzeS1PolicyUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetScopeLevel sets the "scope_level" field.
+func (_u *BronzeS1PolicyUpdate) SetScopeLevel(v string) *BronzeS1PolicyUpdate {
+	_u.mutation.SetScopeLevel(v)
+	return _u
+}
+
+// SetNillableScopeLevel sets the "scope_level" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdate) SetNillableScopeLevel(v *string) *BronzeS1PolicyUpdate {
+	if v != nil {
+		_u.SetScopeLevel(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeS1PolicyUpdate) SetAccountID(v string) *BronzeS1PolicyUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdate) SetNillableAccountID(v *string) *BronzeS1PolicyUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *BronzeS1PolicyUpdate) ClearAccountID() *BronzeS1PolicyUpdate {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeS1PolicyUpdate) SetSiteID(v string) *BronzeS1PolicyUpdate {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdate) SetNillableSiteID(v *string) *BronzeS1PolicyUpdate {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeS1PolicyUpdate) ClearSiteID() *BronzeS1PolicyUpdate {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeS1PolicyUpdate) SetGroupID(v string) *BronzeS1PolicyUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdate) SetNillableGroupID(v *string) *BronzeS1PolicyUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeS1PolicyUpdate) ClearGroupID() *BronzeS1PolicyUpdate {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetScopeName sets the "scope_name" field.
+func (_u *BronzeS1PolicyUpdate) SetScopeName(v string) *BronzeS1PolicyUpdate {
+	_u.mutation.SetScopeName(v)
+	return _u
+}
+
+// SetNillableScopeName sets the "scope_name" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdate) SetNillableScopeName(v *string) *BronzeS1PolicyUpdate {
+	if v != nil {
+		_u.SetScopeName(*v)
+	}
+	return _u
+}
+
+// ClearScopeName clears the value of the "scope_name" field.
+func (_u *BronzeS1PolicyUpdate) ClearScopeName() *BronzeS1PolicyUpdate {
+	_u.mutation.ClearScopeName()
+	return _u
+}
+
+// SetInheritedFrom sets the "inherited_from" field.
+func (_u *BronzeS1PolicyUpdate) SetInheritedFrom(v string) *BronzeS1PolicyUpdate {
+	_u.mutation.SetInheritedFrom(v)
+	return _u
+}
+
+// SetNillableInheritedFrom sets the "inherited_from" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdate) SetNillableInheritedFrom(v *string) *BronzeS1PolicyUpdate {
+	if v != nil {
+		_u.SetInheritedFrom(*v)
+	}
+	return _u
+}
+
+// ClearInheritedFrom clears the value of the "inherited_from" field.
+func (_u *BronzeS1PolicyUpdate) ClearInheritedFrom() *BronzeS1PolicyUpdate {
+	_u.mutation.ClearInheritedFrom()
+	return _u
+}
+
+// SetMitigationMode sets the "mitigation_mode" field.
+func (_u *BronzeS1PolicyUpdate) SetMitigationMode(v string) *BronzeS1PolicyUpdate {
+	_u.mutation.SetMitigationMode(v)
+	return _u
+}
+
+// SetNillableMitigationMode sets the "mitigation_mode" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdate) SetNillableMitigationMode(v *string) *BronzeS1PolicyUpdate {
+	if v != nil {
+		_u.SetMitigationMode(*v)
+	}
+	return _u
+}
+
+// ClearMitigationMode clears the value of the "mitigation_mode" field.
+func (_u *BronzeS1PolicyUpdate) ClearMitigationMode() *BronzeS1PolicyUpdate {
+	_u.mutation.ClearMitigationMode()
+	return _u
+}
+
+// SetMitigationModeSuspicious sets the "mitigation_mode_suspicious" field.
+func (_u *BronzeS1PolicyUpdate) SetMitigationModeSuspicious(v string) *BronzeS1PolicyUpdate {
+	_u.mutation.SetMitigationModeSuspicious(v)
+	return _u
+}
+
+// SetNillableMitigationModeSuspicious sets the "mitigation_mode_suspicious" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdate) SetNillableMitigationModeSuspicious(v *string) *BronzeS1PolicyUpdate {
+	if v != nil {
+		_u.SetMitigationModeSuspicious(*v)
+	}
+	return _u
+}
+
+// ClearMitigationModeSuspicious clears the value of the "mitigation_mode_suspicious" field.
+func (_u *BronzeS1PolicyUpdate) ClearMitigationModeSuspicious() *BronzeS1PolicyUpdate {
+	_u.mutation.ClearMitigationModeSuspicious()
+	return _u
+}
+
+// SetAutoMitigationAction sets the "auto_mitigation_action" field.
+func (_u *BronzeS1PolicyUpdate) SetAutoMitigationAction(v string) *BronzeS1PolicyUpdate {
+	_u.mutation.SetAutoMitigationAction(v)
+	return _u
+}
+
+// SetNillableAutoMitigationAction sets the "auto_mitigation_action" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdate) SetNillableAutoMitigationAction(v *string) *BronzeS1PolicyUpdate {
+	if v != nil {
+		_u.SetAutoMitigationAction(*v)
+	}
+	return _u
+}
+
+// ClearAutoMitigationAction clears the value of the "auto_mitigation_action" field.
+func (_u *BronzeS1PolicyUpdate) ClearAutoMitigationAction() *BronzeS1PolicyUpdate {
+	_u.mutation.ClearAutoMitigationAction()
+	return _u
+}
+
+// SetAntiTamperingOn sets the "anti_tampering_on" field.
+func (_u *BronzeS1PolicyUpdate) SetAntiTamperingOn(v bool) *BronzeS1PolicyUpdate {
+	_u.mutation.SetAntiTamperingOn(v)
+	return _u
+}
+
+// SetNillableAntiTamperingOn sets the "anti_tampering_on" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdate) SetNillableAntiTamperingOn(v *bool) *BronzeS1PolicyUpdate {
+	if v != nil {
+		_u.SetAntiTamperingOn(*v)
+	}
+	return _u
+}
+
+// SetSnapshotsOn sets the "snapshots_on" field.
+func (_u *BronzeS1PolicyUpdate) SetSnapshotsOn(v bool) *BronzeS1PolicyUpdate {
+	_u.mutation.SetSnapshotsOn(v)
+	return _u
+}
+
+// SetNillableSnapshotsOn sets the "snapshots_on" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdate) SetNillableSnapshotsOn(v *bool) *BronzeS1PolicyUpdate {
+	if v != nil {
+		_u.SetSnapshotsOn(*v)
+	}
+	return _u
+}
+
+// SetAgentLoggingOn sets the "agent_logging_on" field.
+func (_u *BronzeS1PolicyUpdate) SetAgentLoggingOn(v bool) *BronzeS1PolicyUpdate {
+	_u.mutation.SetAgentLoggingOn(v)
+	return _u
+}
+
+// SetNillableAgentLoggingOn sets the "agent_logging_on" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdate) SetNillableAgentLoggingOn(v *bool) *BronzeS1PolicyUpdate {
+	if v != nil {
+		_u.SetAgentLoggingOn(*v)
+	}
+	return _u
+}
+
+// SetScanNewAgents sets the "scan_new_agents" field.
+func (_u *BronzeS1PolicyUpdate) SetScanNewAgents(v bool) *BronzeS1PolicyUpdate {
+	_u.mutation.SetScanNewAgents(v)
+	return _u
+}
+
+// SetNillableScanNewAgents sets the "scan_new_agents" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdate) SetNillableScanNewAgents(v *bool) *BronzeS1PolicyUpdate {
+	if v != nil {
+		_u.SetScanNewAgents(*v)
+	}
+	return _u
+}
+
+// SetNetworkQuarantineOn sets the "network_quarantine_on" field.
+func (_u *BronzeS1PolicyUpdate) SetNetworkQuarantineOn(v bool) *BronzeS1PolicyUpdate {
+	_u.mutation.SetNetworkQuarantineOn(v)
+	return _u
+}
+
+// SetNillableNetworkQuarantineOn sets the "network_quarantine_on" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdate) SetNillableNetworkQuarantineOn(v *bool) *BronzeS1PolicyUpdate {
+	if v != nil {
+		_u.SetNetworkQuarantineOn(*v)
+	}
+	return _u
+}
+
+// SetEnginesJSON sets the "engines_json" field.
+func (_u *BronzeS1PolicyUpdate) SetEnginesJSON(v json.RawMessage) *BronzeS1PolicyUpdate {
+	_u.mutation.SetEnginesJSON(v)
+	return _u
+}
+
+// AppendEnginesJSON appends value to the "engines_json" field.
+func (_u *BronzeS1PolicyUpdate) AppendEnginesJSON(v json.RawMessage) *BronzeS1PolicyUpdate {
+	_u.mutation.AppendEnginesJSON(v)
+	return _u
+}
+
+// ClearEnginesJSON clears the value of the "engines_json" field.
+func (_u *BronzeS1PolicyUpdate) ClearEnginesJSON() *BronzeS1PolicyUpdate {
+	_u.mutation.ClearEnginesJSON()
+	return _u
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (_u *BronzeS1PolicyUpdate) SetPolicyJSON(v json.RawMessage) *BronzeS1PolicyUpdate {
+	_u.mutation.SetPolicyJSON(v)
+	return _u
+}
+
+// AppendPolicyJSON appends value to the "policy_json" field.
+func (_u *BronzeS1PolicyUpdate) AppendPolicyJSON(v json.RawMessage) *BronzeS1PolicyUpdate {
+	_u.mutation.AppendPolicyJSON(v)
+	return _u
+}
+
+// ClearPolicyJSON clears the value of the "policy_json" field.
+func (_u *BronzeS1PolicyUpdate) ClearPolicyJSON() *BronzeS1PolicyUpdate {
+	_u.mutation.ClearPolicyJSON()
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeS1PolicyUpdate) SetAPIUpdatedAt(v time.Time) *BronzeS1PolicyUpdate {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeS1PolicyUpdate {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeS1PolicyUpdate) ClearAPIUpdatedAt() *BronzeS1PolicyUpdate {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeS1PolicyMutation object of the builder.
+func (_u *BronzeS1PolicyUpdate) Mutation() *BronzeS1PolicyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeS1PolicyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeS1PolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeS1PolicyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeS1PolicyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeS1PolicyUpdate) check() error {
+	if v, ok := _u.mutation.ScopeLevel(); ok {
+		if err := bronzes1policy.ScopeLevelValidator(v); err != nil {
+			return &ValidationError{Name: "scope_level", err: fmt.Errorf(`s1: validator failed for field "BronzeS1Policy.scope_level": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeS1PolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzes1policy.Table, bronzes1policy.Columns, sqlgraph.NewFieldSpec(bronzes1policy.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1policy.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ScopeLevel(); ok {
+		_spec.SetField(bronzes1policy.FieldScopeLevel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzes1policy.FieldAccountID, field.TypeString, value)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(bronzes1policy.FieldAccountID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzes1policy.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzes1policy.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzes1policy.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzes1policy.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopeName(); ok {
+		_spec.SetField(bronzes1policy.FieldScopeName, field.TypeString, value)
+	}
+	if _u.mutation.ScopeNameCleared() {
+		_spec.ClearField(bronzes1policy.FieldScopeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.InheritedFrom(); ok {
+		_spec.SetField(bronzes1policy.FieldInheritedFrom, field.TypeString, value)
+	}
+	if _u.mutation.InheritedFromCleared() {
+		_spec.ClearField(bronzes1policy.FieldInheritedFrom, field.TypeString)
+	}
+	if value, ok := _u.mutation.MitigationMode(); ok {
+		_spec.SetField(bronzes1policy.FieldMitigationMode, field.TypeString, value)
+	}
+	if _u.mutation.MitigationModeCleared() {
+		_spec.ClearField(bronzes1policy.FieldMitigationMode, field.TypeString)
+	}
+	if value, ok := _u.mutation.MitigationModeSuspicious(); ok {
+		_spec.SetField(bronzes1policy.FieldMitigationModeSuspicious, field.TypeString, value)
+	}
+	if _u.mutation.MitigationModeSuspiciousCleared() {
+		_spec.ClearField(bronzes1policy.FieldMitigationModeSuspicious, field.TypeString)
+	}
+	if value, ok := _u.mutation.AutoMitigationAction(); ok {
+		_spec.SetField(bronzes1policy.FieldAutoMitigationAction, field.TypeString, value)
+	}
+	if _u.mutation.AutoMitigationActionCleared() {
+		_spec.ClearField(bronzes1policy.FieldAutoMitigationAction, field.TypeString)
+	}
+	if value, ok := _u.mutation.AntiTamperingOn(); ok {
+		_spec.SetField(bronzes1policy.FieldAntiTamperingOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SnapshotsOn(); ok {
+		_spec.SetField(bronzes1policy.FieldSnapshotsOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AgentLoggingOn(); ok {
+		_spec.SetField(bronzes1policy.FieldAgentLoggingOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ScanNewAgents(); ok {
+		_spec.SetField(bronzes1policy.FieldScanNewAgents, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NetworkQuarantineOn(); ok {
+		_spec.SetField(bronzes1policy.FieldNetworkQuarantineOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EnginesJSON(); ok {
+		_spec.SetField(bronzes1policy.FieldEnginesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEnginesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzes1policy.FieldEnginesJSON, value)
+		})
+	}
+	if _u.mutation.EnginesJSONCleared() {
+		_spec.ClearField(bronzes1policy.FieldEnginesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PolicyJSON(); ok {
+		_spec.SetField(bronzes1policy.FieldPolicyJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolicyJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzes1policy.FieldPolicyJSON, value)
+		})
+	}
+	if _u.mutation.PolicyJSONCleared() {
+		_spec.ClearField(bronzes1policy.FieldPolicyJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzes1policy.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzes1policy.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeS1Policy
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzes1policy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeS1PolicyUpdateOne is the builder for updating a single BronzeS1Policy entity.
+type BronzeS1PolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeS1PolicyMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeS1PolicyUpdateOne) SetCollectedAt(v time.Time) *BronzeS1PolicyUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetScopeLevel sets the "scope_level" field.
+func (_u *BronzeS1PolicyUpdateOne) SetScopeLevel(v string) *BronzeS1PolicyUpdateOne {
+	_u.mutation.SetScopeLevel(v)
+	return _u
+}
+
+// SetNillableScopeLevel sets the "scope_level" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdateOne) SetNillableScopeLevel(v *string) *BronzeS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetScopeLevel(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeS1PolicyUpdateOne) SetAccountID(v string) *BronzeS1PolicyUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdateOne) SetNillableAccountID(v *string) *BronzeS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *BronzeS1PolicyUpdateOne) ClearAccountID() *BronzeS1PolicyUpdateOne {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeS1PolicyUpdateOne) SetSiteID(v string) *BronzeS1PolicyUpdateOne {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdateOne) SetNillableSiteID(v *string) *BronzeS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeS1PolicyUpdateOne) ClearSiteID() *BronzeS1PolicyUpdateOne {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeS1PolicyUpdateOne) SetGroupID(v string) *BronzeS1PolicyUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdateOne) SetNillableGroupID(v *string) *BronzeS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeS1PolicyUpdateOne) ClearGroupID() *BronzeS1PolicyUpdateOne {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetScopeName sets the "scope_name" field.
+func (_u *BronzeS1PolicyUpdateOne) SetScopeName(v string) *BronzeS1PolicyUpdateOne {
+	_u.mutation.SetScopeName(v)
+	return _u
+}
+
+// SetNillableScopeName sets the "scope_name" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdateOne) SetNillableScopeName(v *string) *BronzeS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetScopeName(*v)
+	}
+	return _u
+}
+
+// ClearScopeName clears the value of the "scope_name" field.
+func (_u *BronzeS1PolicyUpdateOne) ClearScopeName() *BronzeS1PolicyUpdateOne {
+	_u.mutation.ClearScopeName()
+	return _u
+}
+
+// SetInheritedFrom sets the "inherited_from" field.
+func (_u *BronzeS1PolicyUpdateOne) SetInheritedFrom(v string) *BronzeS1PolicyUpdateOne {
+	_u.mutation.SetInheritedFrom(v)
+	return _u
+}
+
+// SetNillableInheritedFrom sets the "inherited_from" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdateOne) SetNillableInheritedFrom(v *string) *BronzeS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetInheritedFrom(*v)
+	}
+	return _u
+}
+
+// ClearInheritedFrom clears the value of the "inherited_from" field.
+func (_u *BronzeS1PolicyUpdateOne) ClearInheritedFrom() *BronzeS1PolicyUpdateOne {
+	_u.mutation.ClearInheritedFrom()
+	return _u
+}
+
+// SetMitigationMode sets the "mitigation_mode" field.
+func (_u *BronzeS1PolicyUpdateOne) SetMitigationMode(v string) *BronzeS1PolicyUpdateOne {
+	_u.mutation.SetMitigationMode(v)
+	return _u
+}
+
+// SetNillableMitigationMode sets the "mitigation_mode" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdateOne) SetNillableMitigationMode(v *string) *BronzeS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetMitigationMode(*v)
+	}
+	return _u
+}
+
+// ClearMitigationMode clears the value of the "mitigation_mode" field.
+func (_u *BronzeS1PolicyUpdateOne) ClearMitigationMode() *BronzeS1PolicyUpdateOne {
+	_u.mutation.ClearMitigationMode()
+	return _u
+}
+
+// SetMitigationModeSuspicious sets the "mitigation_mode_suspicious" field.
+func (_u *BronzeS1PolicyUpdateOne) SetMitigationModeSuspicious(v string) *BronzeS1PolicyUpdateOne {
+	_u.mutation.SetMitigationModeSuspicious(v)
+	return _u
+}
+
+// SetNillableMitigationModeSuspicious sets the "mitigation_mode_suspicious" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdateOne) SetNillableMitigationModeSuspicious(v *string) *BronzeS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetMitigationModeSuspicious(*v)
+	}
+	return _u
+}
+
+// ClearMitigationModeSuspicious clears the value of the "mitigation_mode_suspicious" field.
+func (_u *BronzeS1PolicyUpdateOne) ClearMitigationModeSuspicious() *BronzeS1PolicyUpdateOne {
+	_u.mutation.ClearMitigationModeSuspicious()
+	return _u
+}
+
+// SetAutoMitigationAction sets the "auto_mitigation_action" field.
+func (_u *BronzeS1PolicyUpdateOne) SetAutoMitigationAction(v string) *BronzeS1PolicyUpdateOne {
+	_u.mutation.SetAutoMitigationAction(v)
+	return _u
+}
+
+// SetNillableAutoMitigationAction sets the "auto_mitigation_action" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdateOne) SetNillableAutoMitigationAction(v *string) *BronzeS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetAutoMitigationAction(*v)
+	}
+	return _u
+}
+
+// ClearAutoMitigationAction clears the value of the "auto_mitigation_action" field.
+func (_u *BronzeS1PolicyUpdateOne) ClearAutoMitigationAction() *BronzeS1PolicyUpdateOne {
+	_u.mutation.ClearAutoMitigationAction()
+	return _u
+}
+
+// SetAntiTamperingOn sets the "anti_tampering_on" field.
+func (_u *BronzeS1PolicyUpdateOne) SetAntiTamperingOn(v bool) *BronzeS1PolicyUpdateOne {
+	_u.mutation.SetAntiTamperingOn(v)
+	return _u
+}
+
+// SetNillableAntiTamperingOn sets the "anti_tampering_on" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdateOne) SetNillableAntiTamperingOn(v *bool) *BronzeS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetAntiTamperingOn(*v)
+	}
+	return _u
+}
+
+// SetSnapshotsOn sets the "snapshots_on" field.
+func (_u *BronzeS1PolicyUpdateOne) SetSnapshotsOn(v bool) *BronzeS1PolicyUpdateOne {
+	_u.mutation.SetSnapshotsOn(v)
+	return _u
+}
+
+// SetNillableSnapshotsOn sets the "snapshots_on" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdateOne) SetNillableSnapshotsOn(v *bool) *BronzeS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetSnapshotsOn(*v)
+	}
+	return _u
+}
+
+// SetAgentLoggingOn sets the "agent_logging_on" field.
+func (_u *BronzeS1PolicyUpdateOne) SetAgentLoggingOn(v bool) *BronzeS1PolicyUpdateOne {
+	_u.mutation.SetAgentLoggingOn(v)
+	return _u
+}
+
+// SetNillableAgentLoggingOn sets the "agent_logging_on" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdateOne) SetNillableAgentLoggingOn(v *bool) *BronzeS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetAgentLoggingOn(*v)
+	}
+	return _u
+}
+
+// SetScanNewAgents sets the "scan_new_agents" field.
+func (_u *BronzeS1PolicyUpdateOne) SetScanNewAgents(v bool) *BronzeS1PolicyUpdateOne {
+	_u.mutation.SetScanNewAgents(v)
+	return _u
+}
+
+// SetNillableScanNewAgents sets the "scan_new_agents" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdateOne) SetNillableScanNewAgents(v *bool) *BronzeS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetScanNewAgents(*v)
+	}
+	return _u
+}
+
+// SetNetworkQuarantineOn sets the "network_quarantine_on" field.
+func (_u *BronzeS1PolicyUpdateOne) SetNetworkQuarantineOn(v bool) *BronzeS1PolicyUpdateOne {
+	_u.mutation.SetNetworkQuarantineOn(v)
+	return _u
+}
+
+// SetNillableNetworkQuarantineOn sets the "network_quarantine_on" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdateOne) SetNillableNetworkQuarantineOn(v *bool) *BronzeS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetNetworkQuarantineOn(*v)
+	}
+	return _u
+}
+
+// SetEnginesJSON sets the "engines_json" field.
+func (_u *BronzeS1PolicyUpdateOne) SetEnginesJSON(v json.RawMessage) *BronzeS1PolicyUpdateOne {
+	_u.mutation.SetEnginesJSON(v)
+	return _u
+}
+
+// AppendEnginesJSON appends value to the "engines_json" field.
+func (_u *BronzeS1PolicyUpdateOne) AppendEnginesJSON(v json.RawMessage) *BronzeS1PolicyUpdateOne {
+	_u.mutation.AppendEnginesJSON(v)
+	return _u
+}
+
+// ClearEnginesJSON clears the value of the "engines_json" field.
+func (_u *BronzeS1PolicyUpdateOne) ClearEnginesJSON() *BronzeS1PolicyUpdateOne {
+	_u.mutation.ClearEnginesJSON()
+	return _u
+}
+
+// SetPolicyJSON sets the "policy_json" field.
+func (_u *BronzeS1PolicyUpdateOne) SetPolicyJSON(v json.RawMessage) *BronzeS1PolicyUpdateOne {
+	_u.mutation.SetPolicyJSON(v)
+	return _u
+}
+
+// AppendPolicyJSON appends value to the "policy_json" field.
+func (_u *BronzeS1PolicyUpdateOne) AppendPolicyJSON(v json.RawMessage) *BronzeS1PolicyUpdateOne {
+	_u.mutation.AppendPolicyJSON(v)
+	return _u
+}
+
+// ClearPolicyJSON clears the value of the "policy_json" field.
+func (_u *BronzeS1PolicyUpdateOne) ClearPolicyJSON() *BronzeS1PolicyUpdateOne {
+	_u.mutation.ClearPolicyJSON()
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeS1PolicyUpdateOne) SetAPIUpdatedAt(v time.Time) *BronzeS1PolicyUpdateOne {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeS1PolicyUpdateOne) SetNillableAPIUpdatedAt(v *time.Time) *BronzeS1PolicyUpdateOne {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeS1PolicyUpdateOne) ClearAPIUpdatedAt() *BronzeS1PolicyUpdateOne {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeS1PolicyMutation object of the builder.
+func (_u *BronzeS1PolicyUpdateOne) Mutation() *BronzeS1PolicyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeS1PolicyUpdate builder.
+func (_u *BronzeS1PolicyUpdateOne) Where(ps ...predicate.BronzeS1Policy) *BronzeS1PolicyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeS1PolicyUpdateOne) Select(field string, fields ...string) *BronzeS1PolicyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeS1Policy entity.
+func (_u *BronzeS1PolicyUpdateOne) Save(ctx context.Context) (*BronzeS1Policy, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeS1PolicyUpdateOne) SaveX(ctx context.Context) *BronzeS1Policy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeS1PolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeS1PolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeS1PolicyUpdateOne) check() error {
+	if v, ok := _u.mutation.ScopeLevel(); ok {
+		if err := bronzes1policy.ScopeLevelValidator(v); err != nil {
+			return &ValidationError{Name: "scope_level", err: fmt.Errorf(`s1: validator failed for field "BronzeS1Policy.scope_level": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeS1PolicyUpdateOne) sqlSave(ctx context.Context) (_node *BronzeS1Policy, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzes1policy.Table, bronzes1policy.Columns, sqlgraph.NewFieldSpec(bronzes1policy.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`s1: missing "BronzeS1Policy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzes1policy.FieldID)
+		for _, f := range fields {
+			if !bronzes1policy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("s1: invalid field %q for query", f)}
+			}
+			if f != bronzes1policy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1policy.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ScopeLevel(); ok {
+		_spec.SetField(bronzes1policy.FieldScopeLevel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzes1policy.FieldAccountID, field.TypeString, value)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(bronzes1policy.FieldAccountID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzes1policy.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzes1policy.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzes1policy.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzes1policy.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopeName(); ok {
+		_spec.SetField(bronzes1policy.FieldScopeName, field.TypeString, value)
+	}
+	if _u.mutation.ScopeNameCleared() {
+		_spec.ClearField(bronzes1policy.FieldScopeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.InheritedFrom(); ok {
+		_spec.SetField(bronzes1policy.FieldInheritedFrom, field.TypeString, value)
+	}
+	if _u.mutation.InheritedFromCleared() {
+		_spec.ClearField(bronzes1policy.FieldInheritedFrom, field.TypeString)
+	}
+	if value, ok := _u.mutation.MitigationMode(); ok {
+		_spec.SetField(bronzes1policy.FieldMitigationMode, field.TypeString, value)
+	}
+	if _u.mutation.MitigationModeCleared() {
+		_spec.ClearField(bronzes1policy.FieldMitigationMode, field.TypeString)
+	}
+	if value, ok := _u.mutation.MitigationModeSuspicious(); ok {
+		_spec.SetField(bronzes1policy.FieldMitigationModeSuspicious, field.TypeString, value)
+	}
+	if _u.mutation.MitigationModeSuspiciousCleared() {
+		_spec.ClearField(bronzes1policy.FieldMitigationModeSuspicious, field.TypeString)
+	}
+	if value, ok := _u.mutation.AutoMitigationAction(); ok {
+		_spec.SetField(bronzes1policy.FieldAutoMitigationAction, field.TypeString, value)
+	}
+	if _u.mutation.AutoMitigationActionCleared() {
+		_spec.ClearField(bronzes1policy.FieldAutoMitigationAction, field.TypeString)
+	}
+	if value, ok := _u.mutation.AntiTamperingOn(); ok {
+		_spec.SetField(bronzes1policy.FieldAntiTamperingOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SnapshotsOn(); ok {
+		_spec.SetField(bronzes1policy.FieldSnapshotsOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AgentLoggingOn(); ok {
+		_spec.SetField(bronzes1policy.FieldAgentLoggingOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ScanNewAgents(); ok {
+		_spec.SetField(bronzes1policy.FieldScanNewAgents, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NetworkQuarantineOn(); ok {
+		_spec.SetField(bronzes1policy.FieldNetworkQuarantineOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EnginesJSON(); ok {
+		_spec.SetField(bronzes1policy.FieldEnginesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEnginesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzes1policy.FieldEnginesJSON, value)
+		})
+	}
+	if _u.mutation.EnginesJSONCleared() {
+		_spec.ClearField(bronzes1policy.FieldEnginesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PolicyJSON(); ok {
+		_spec.SetField(bronzes1policy.FieldPolicyJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolicyJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzes1policy.FieldPolicyJSON, value)
+		})
+	}
+	if _u.mutation.PolicyJSONCleared() {
+		_spec.ClearField(bronzes1policy.FieldPolicyJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzes1policy.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzes1policy.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeS1Policy
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeS1Policy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzes1policy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,1315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzes1restriction
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// ScopeLevel applies equality check predicate on the "scope_level" field. It's identical to ScopeLevelEQ.
+func ScopeLevel(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldScopeLevel, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldAccountID, v))
+}
+
+// SiteID applies equality check predicate on the "site_id" field. It's identical to SiteIDEQ.
+func SiteID(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldSiteID, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldGroupID, v))
+}
+
+// ScopeName applies equality check predicate on the "scope_name" field. It's identical to ScopeNameEQ.
+func ScopeName(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldScopeName, v))
+}
+
+// ScopePath applies equality check predicate on the "scope_path" field. It's identical to ScopePathEQ.
+func ScopePath(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldScopePath, v))
+}
+
+// RestrictionType applies equality check predicate on the "restriction_type" field. It's identical to RestrictionTypeEQ.
+func RestrictionType(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldRestrictionType, v))
+}
+
+// RestrictionValue applies equality check predicate on the "restriction_value" field. It's identical to RestrictionValueEQ.
+func RestrictionValue(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldRestrictionValue, v))
+}
+
+// OsType applies equality check predicate on the "os_type" field. It's identical to OsTypeEQ.
+func OsType(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldOsType, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldDescription, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldSource, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserName applies equality check predicate on the "user_name" field. It's identical to UserNameEQ.
+func UserName(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldUserName, v))
+}
+
+// Imported applies equality check predicate on the "imported" field. It's identical to ImportedEQ.
+func Imported(v bool) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldImported, v))
+}
+
+// APICreatedAt applies equality check predicate on the "api_created_at" field. It's identical to APICreatedAtEQ.
+func APICreatedAt(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// APIUpdatedAt applies equality check predicate on the "api_updated_at" field. It's identical to APIUpdatedAtEQ.
+func APIUpdatedAt(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldAPIUpdatedAt, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// ScopeLevelEQ applies the EQ predicate on the "scope_level" field.
+func ScopeLevelEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldScopeLevel, v))
+}
+
+// ScopeLevelNEQ applies the NEQ predicate on the "scope_level" field.
+func ScopeLevelNEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNEQ(FieldScopeLevel, v))
+}
+
+// ScopeLevelIn applies the In predicate on the "scope_level" field.
+func ScopeLevelIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIn(FieldScopeLevel, vs...))
+}
+
+// ScopeLevelNotIn applies the NotIn predicate on the "scope_level" field.
+func ScopeLevelNotIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotIn(FieldScopeLevel, vs...))
+}
+
+// ScopeLevelGT applies the GT predicate on the "scope_level" field.
+func ScopeLevelGT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGT(FieldScopeLevel, v))
+}
+
+// ScopeLevelGTE applies the GTE predicate on the "scope_level" field.
+func ScopeLevelGTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGTE(FieldScopeLevel, v))
+}
+
+// ScopeLevelLT applies the LT predicate on the "scope_level" field.
+func ScopeLevelLT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLT(FieldScopeLevel, v))
+}
+
+// ScopeLevelLTE applies the LTE predicate on the "scope_level" field.
+func ScopeLevelLTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLTE(FieldScopeLevel, v))
+}
+
+// ScopeLevelContains applies the Contains predicate on the "scope_level" field.
+func ScopeLevelContains(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContains(FieldScopeLevel, v))
+}
+
+// ScopeLevelHasPrefix applies the HasPrefix predicate on the "scope_level" field.
+func ScopeLevelHasPrefix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasPrefix(FieldScopeLevel, v))
+}
+
+// ScopeLevelHasSuffix applies the HasSuffix predicate on the "scope_level" field.
+func ScopeLevelHasSuffix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasSuffix(FieldScopeLevel, v))
+}
+
+// ScopeLevelEqualFold applies the EqualFold predicate on the "scope_level" field.
+func ScopeLevelEqualFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEqualFold(FieldScopeLevel, v))
+}
+
+// ScopeLevelContainsFold applies the ContainsFold predicate on the "scope_level" field.
+func ScopeLevelContainsFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContainsFold(FieldScopeLevel, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDIsNil applies the IsNil predicate on the "account_id" field.
+func AccountIDIsNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIsNull(FieldAccountID))
+}
+
+// AccountIDNotNil applies the NotNil predicate on the "account_id" field.
+func AccountIDNotNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotNull(FieldAccountID))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// SiteIDEQ applies the EQ predicate on the "site_id" field.
+func SiteIDEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldSiteID, v))
+}
+
+// SiteIDNEQ applies the NEQ predicate on the "site_id" field.
+func SiteIDNEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNEQ(FieldSiteID, v))
+}
+
+// SiteIDIn applies the In predicate on the "site_id" field.
+func SiteIDIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIn(FieldSiteID, vs...))
+}
+
+// SiteIDNotIn applies the NotIn predicate on the "site_id" field.
+func SiteIDNotIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotIn(FieldSiteID, vs...))
+}
+
+// SiteIDGT applies the GT predicate on the "site_id" field.
+func SiteIDGT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGT(FieldSiteID, v))
+}
+
+// SiteIDGTE applies the GTE predicate on the "site_id" field.
+func SiteIDGTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGTE(FieldSiteID, v))
+}
+
+// SiteIDLT applies the LT predicate on the "site_id" field.
+func SiteIDLT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLT(FieldSiteID, v))
+}
+
+// SiteIDLTE applies the LTE predicate on the "site_id" field.
+func SiteIDLTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLTE(FieldSiteID, v))
+}
+
+// SiteIDContains applies the Contains predicate on the "site_id" field.
+func SiteIDContains(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContains(FieldSiteID, v))
+}
+
+// SiteIDHasPrefix applies the HasPrefix predicate on the "site_id" field.
+func SiteIDHasPrefix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasPrefix(FieldSiteID, v))
+}
+
+// SiteIDHasSuffix applies the HasSuffix predicate on the "site_id" field.
+func SiteIDHasSuffix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasSuffix(FieldSiteID, v))
+}
+
+// SiteIDIsNil applies the IsNil predicate on the "site_id" field.
+func SiteIDIsNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIsNull(FieldSiteID))
+}
+
+// SiteIDNotNil applies the NotNil predicate on the "site_id" field.
+func SiteIDNotNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotNull(FieldSiteID))
+}
+
+// SiteIDEqualFold applies the EqualFold predicate on the "site_id" field.
+func SiteIDEqualFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEqualFold(FieldSiteID, v))
+}
+
+// SiteIDContainsFold applies the ContainsFold predicate on the "site_id" field.
+func SiteIDContainsFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContainsFold(FieldSiteID, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// GroupIDGT applies the GT predicate on the "group_id" field.
+func GroupIDGT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGT(FieldGroupID, v))
+}
+
+// GroupIDGTE applies the GTE predicate on the "group_id" field.
+func GroupIDGTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGTE(FieldGroupID, v))
+}
+
+// GroupIDLT applies the LT predicate on the "group_id" field.
+func GroupIDLT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLT(FieldGroupID, v))
+}
+
+// GroupIDLTE applies the LTE predicate on the "group_id" field.
+func GroupIDLTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLTE(FieldGroupID, v))
+}
+
+// GroupIDContains applies the Contains predicate on the "group_id" field.
+func GroupIDContains(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContains(FieldGroupID, v))
+}
+
+// GroupIDHasPrefix applies the HasPrefix predicate on the "group_id" field.
+func GroupIDHasPrefix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasPrefix(FieldGroupID, v))
+}
+
+// GroupIDHasSuffix applies the HasSuffix predicate on the "group_id" field.
+func GroupIDHasSuffix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasSuffix(FieldGroupID, v))
+}
+
+// GroupIDIsNil applies the IsNil predicate on the "group_id" field.
+func GroupIDIsNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIsNull(FieldGroupID))
+}
+
+// GroupIDNotNil applies the NotNil predicate on the "group_id" field.
+func GroupIDNotNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotNull(FieldGroupID))
+}
+
+// GroupIDEqualFold applies the EqualFold predicate on the "group_id" field.
+func GroupIDEqualFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEqualFold(FieldGroupID, v))
+}
+
+// GroupIDContainsFold applies the ContainsFold predicate on the "group_id" field.
+func GroupIDContainsFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContainsFold(FieldGroupID, v))
+}
+
+// ScopeNameEQ applies the EQ predicate on the "scope_name" field.
+func ScopeNameEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldScopeName, v))
+}
+
+// ScopeNameNEQ applies the NEQ predicate on the "scope_name" field.
+func ScopeNameNEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNEQ(FieldScopeName, v))
+}
+
+// ScopeNameIn applies the In predicate on the "scope_name" field.
+func ScopeNameIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIn(FieldScopeName, vs...))
+}
+
+// ScopeNameNotIn applies the NotIn predicate on the "scope_name" field.
+func ScopeNameNotIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotIn(FieldScopeName, vs...))
+}
+
+// ScopeNameGT applies the GT predicate on the "scope_name" field.
+func ScopeNameGT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGT(FieldScopeName, v))
+}
+
+// ScopeNameGTE applies the GTE predicate on the "scope_name" field.
+func ScopeNameGTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGTE(FieldScopeName, v))
+}
+
+// ScopeNameLT applies the LT predicate on the "scope_name" field.
+func ScopeNameLT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLT(FieldScopeName, v))
+}
+
+// ScopeNameLTE applies the LTE predicate on the "scope_name" field.
+func ScopeNameLTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLTE(FieldScopeName, v))
+}
+
+// ScopeNameContains applies the Contains predicate on the "scope_name" field.
+func ScopeNameContains(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContains(FieldScopeName, v))
+}
+
+// ScopeNameHasPrefix applies the HasPrefix predicate on the "scope_name" field.
+func ScopeNameHasPrefix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasPrefix(FieldScopeName, v))
+}
+
+// ScopeNameHasSuffix applies the HasSuffix predicate on the "scope_name" field.
+func ScopeNameHasSuffix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasSuffix(FieldScopeName, v))
+}
+
+// ScopeNameIsNil applies the IsNil predicate on the "scope_name" field.
+func ScopeNameIsNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIsNull(FieldScopeName))
+}
+
+// ScopeNameNotNil applies the NotNil predicate on the "scope_name" field.
+func ScopeNameNotNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotNull(FieldScopeName))
+}
+
+// ScopeNameEqualFold applies the EqualFold predicate on the "scope_name" field.
+func ScopeNameEqualFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEqualFold(FieldScopeName, v))
+}
+
+// ScopeNameContainsFold applies the ContainsFold predicate on the "scope_name" field.
+func ScopeNameContainsFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContainsFold(FieldScopeName, v))
+}
+
+// ScopePathEQ applies the EQ predicate on the "scope_path" field.
+func ScopePathEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldScopePath, v))
+}
+
+// ScopePathNEQ applies the NEQ predicate on the "scope_path" field.
+func ScopePathNEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNEQ(FieldScopePath, v))
+}
+
+// ScopePathIn applies the In predicate on the "scope_path" field.
+func ScopePathIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIn(FieldScopePath, vs...))
+}
+
+// ScopePathNotIn applies the NotIn predicate on the "scope_path" field.
+func ScopePathNotIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotIn(FieldScopePath, vs...))
+}
+
+// ScopePathGT applies the GT predicate on the "scope_path" field.
+func ScopePathGT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGT(FieldScopePath, v))
+}
+
+// ScopePathGTE applies the GTE predicate on the "scope_path" field.
+func ScopePathGTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGTE(FieldScopePath, v))
+}
+
+// ScopePathLT applies the LT predicate on the "scope_path" field.
+func ScopePathLT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLT(FieldScopePath, v))
+}
+
+// ScopePathLTE applies the LTE predicate on the "scope_path" field.
+func ScopePathLTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLTE(FieldScopePath, v))
+}
+
+// ScopePathContains applies the Contains predicate on the "scope_path" field.
+func ScopePathContains(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContains(FieldScopePath, v))
+}
+
+// ScopePathHasPrefix applies the HasPrefix predicate on the "scope_path" field.
+func ScopePathHasPrefix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasPrefix(FieldScopePath, v))
+}
+
+// ScopePathHasSuffix applies the HasSuffix predicate on the "scope_path" field.
+func ScopePathHasSuffix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasSuffix(FieldScopePath, v))
+}
+
+// ScopePathIsNil applies the IsNil predicate on the "scope_path" field.
+func ScopePathIsNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIsNull(FieldScopePath))
+}
+
+// ScopePathNotNil applies the NotNil predicate on the "scope_path" field.
+func ScopePathNotNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotNull(FieldScopePath))
+}
+
+// ScopePathEqualFold applies the EqualFold predicate on the "scope_path" field.
+func ScopePathEqualFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEqualFold(FieldScopePath, v))
+}
+
+// ScopePathContainsFold applies the ContainsFold predicate on the "scope_path" field.
+func ScopePathContainsFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContainsFold(FieldScopePath, v))
+}
+
+// RestrictionTypeEQ applies the EQ predicate on the "restriction_type" field.
+func RestrictionTypeEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldRestrictionType, v))
+}
+
+// RestrictionTypeNEQ applies the NEQ predicate on the "restriction_type" field.
+func RestrictionTypeNEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNEQ(FieldRestrictionType, v))
+}
+
+// RestrictionTypeIn applies the In predicate on the "restriction_type" field.
+func RestrictionTypeIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIn(FieldRestrictionType, vs...))
+}
+
+// RestrictionTypeNotIn applies the NotIn predicate on the "restriction_type" field.
+func RestrictionTypeNotIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotIn(FieldRestrictionType, vs...))
+}
+
+// RestrictionTypeGT applies the GT predicate on the "restriction_type" field.
+func RestrictionTypeGT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGT(FieldRestrictionType, v))
+}
+
+// RestrictionTypeGTE applies the GTE predicate on the "restriction_type" field.
+func RestrictionTypeGTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGTE(FieldRestrictionType, v))
+}
+
+// RestrictionTypeLT applies the LT predicate on the "restriction_type" field.
+func RestrictionTypeLT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLT(FieldRestrictionType, v))
+}
+
+// RestrictionTypeLTE applies the LTE predicate on the "restriction_type" field.
+func RestrictionTypeLTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLTE(FieldRestrictionType, v))
+}
+
+// RestrictionTypeContains applies the Contains predicate on the "restriction_type" field.
+func RestrictionTypeContains(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContains(FieldRestrictionType, v))
+}
+
+// RestrictionTypeHasPrefix applies the HasPrefix predicate on the "restriction_type" field.
+func RestrictionTypeHasPrefix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasPrefix(FieldRestrictionType, v))
+}
+
+// RestrictionTypeHasSuffix applies the HasSuffix predicate on the "restriction_type" field.
+func RestrictionTypeHasSuffix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasSuffix(FieldRestrictionType, v))
+}
+
+// RestrictionTypeEqualFold applies the EqualFold predicate on the "restriction_type" field.
+func RestrictionTypeEqualFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEqualFold(FieldRestrictionType, v))
+}
+
+// RestrictionTypeContainsFold applies the ContainsFold predicate on the "restriction_type" field.
+func RestrictionTypeContainsFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContainsFold(FieldRestrictionType, v))
+}
+
+// RestrictionValueEQ applies the EQ predicate on the "restriction_value" field.
+func RestrictionValueEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldRestrictionValue, v))
+}
+
+// RestrictionValueNEQ applies the NEQ predicate on the "restriction_value" field.
+func RestrictionValueNEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNEQ(FieldRestrictionValue, v))
+}
+
+// RestrictionValueIn applies the In predicate on the "restriction_value" field.
+func RestrictionValueIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIn(FieldRestrictionValue, vs...))
+}
+
+// RestrictionValueNotIn applies the NotIn predicate on the "restriction_value" field.
+func RestrictionValueNotIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotIn(FieldRestrictionValue, vs...))
+}
+
+// RestrictionValueGT applies the GT predicate on the "restriction_value" field.
+func RestrictionValueGT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGT(FieldRestrictionValue, v))
+}
+
+// RestrictionValueGTE applies the GTE predicate on the "restriction_value" field.
+func RestrictionValueGTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGTE(FieldRestrictionValue, v))
+}
+
+// RestrictionValueLT applies the LT predicate on the "restriction_value" field.
+func RestrictionValueLT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLT(FieldRestrictionValue, v))
+}
+
+// RestrictionValueLTE applies the LTE predicate on the "restriction_value" field.
+func RestrictionValueLTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLTE(FieldRestrictionValue, v))
+}
+
+// RestrictionValueContains applies the Contains predicate on the "restriction_value" field.
+func RestrictionValueContains(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContains(FieldRestrictionValue, v))
+}
+
+// RestrictionValueHasPrefix applies the HasPrefix predicate on the "restriction_value" field.
+func RestrictionValueHasPrefix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasPrefix(FieldRestrictionValue, v))
+}
+
+// RestrictionValueHasSuffix applies the HasSuffix predicate on the "restriction_value" field.
+func RestrictionValueHasSuffix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasSuffix(FieldRestrictionValue, v))
+}
+
+// RestrictionValueIsNil applies the IsNil predicate on the "restriction_value" field.
+func RestrictionValueIsNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIsNull(FieldRestrictionValue))
+}
+
+// RestrictionValueNotNil applies the NotNil predicate on the "restriction_value" field.
+func RestrictionValueNotNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotNull(FieldRestrictionValue))
+}
+
+// RestrictionValueEqualFold applies the EqualFold predicate on the "restriction_value" field.
+func RestrictionValueEqualFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEqualFold(FieldRestrictionValue, v))
+}
+
+// RestrictionValueContainsFold applies the ContainsFold predicate on the "restriction_value" field.
+func RestrictionValueContainsFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContainsFold(FieldRestrictionValue, v))
+}
+
+// OsTypeEQ applies the EQ predicate on the "os_type" field.
+func OsTypeEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldOsType, v))
+}
+
+// OsTypeNEQ applies the NEQ predicate on the "os_type" field.
+func OsTypeNEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNEQ(FieldOsType, v))
+}
+
+// OsTypeIn applies the In predicate on the "os_type" field.
+func OsTypeIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIn(FieldOsType, vs...))
+}
+
+// OsTypeNotIn applies the NotIn predicate on the "os_type" field.
+func OsTypeNotIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotIn(FieldOsType, vs...))
+}
+
+// OsTypeGT applies the GT predicate on the "os_type" field.
+func OsTypeGT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGT(FieldOsType, v))
+}
+
+// OsTypeGTE applies the GTE predicate on the "os_type" field.
+func OsTypeGTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGTE(FieldOsType, v))
+}
+
+// OsTypeLT applies the LT predicate on the "os_type" field.
+func OsTypeLT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLT(FieldOsType, v))
+}
+
+// OsTypeLTE applies the LTE predicate on the "os_type" field.
+func OsTypeLTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLTE(FieldOsType, v))
+}
+
+// OsTypeContains applies the Contains predicate on the "os_type" field.
+func OsTypeContains(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContains(FieldOsType, v))
+}
+
+// OsTypeHasPrefix applies the HasPrefix predicate on the "os_type" field.
+func OsTypeHasPrefix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasPrefix(FieldOsType, v))
+}
+
+// OsTypeHasSuffix applies the HasSuffix predicate on the "os_type" field.
+func OsTypeHasSuffix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasSuffix(FieldOsType, v))
+}
+
+// OsTypeIsNil applies the IsNil predicate on the "os_type" field.
+func OsTypeIsNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIsNull(FieldOsType))
+}
+
+// OsTypeNotNil applies the NotNil predicate on the "os_type" field.
+func OsTypeNotNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotNull(FieldOsType))
+}
+
+// OsTypeEqualFold applies the EqualFold predicate on the "os_type" field.
+func OsTypeEqualFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEqualFold(FieldOsType, v))
+}
+
+// OsTypeContainsFold applies the ContainsFold predicate on the "os_type" field.
+func OsTypeContainsFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContainsFold(FieldOsType, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceIsNil applies the IsNil predicate on the "source" field.
+func SourceIsNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIsNull(FieldSource))
+}
+
+// SourceNotNil applies the NotNil predicate on the "source" field.
+func SourceNotNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotNull(FieldSource))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContainsFold(FieldSource, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotNull(FieldUserID))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// UserNameEQ applies the EQ predicate on the "user_name" field.
+func UserNameEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldUserName, v))
+}
+
+// UserNameNEQ applies the NEQ predicate on the "user_name" field.
+func UserNameNEQ(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNEQ(FieldUserName, v))
+}
+
+// UserNameIn applies the In predicate on the "user_name" field.
+func UserNameIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIn(FieldUserName, vs...))
+}
+
+// UserNameNotIn applies the NotIn predicate on the "user_name" field.
+func UserNameNotIn(vs ...string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotIn(FieldUserName, vs...))
+}
+
+// UserNameGT applies the GT predicate on the "user_name" field.
+func UserNameGT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGT(FieldUserName, v))
+}
+
+// UserNameGTE applies the GTE predicate on the "user_name" field.
+func UserNameGTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGTE(FieldUserName, v))
+}
+
+// UserNameLT applies the LT predicate on the "user_name" field.
+func UserNameLT(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLT(FieldUserName, v))
+}
+
+// UserNameLTE applies the LTE predicate on the "user_name" field.
+func UserNameLTE(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLTE(FieldUserName, v))
+}
+
+// UserNameContains applies the Contains predicate on the "user_name" field.
+func UserNameContains(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContains(FieldUserName, v))
+}
+
+// UserNameHasPrefix applies the HasPrefix predicate on the "user_name" field.
+func UserNameHasPrefix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasPrefix(FieldUserName, v))
+}
+
+// UserNameHasSuffix applies the HasSuffix predicate on the "user_name" field.
+func UserNameHasSuffix(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldHasSuffix(FieldUserName, v))
+}
+
+// UserNameIsNil applies the IsNil predicate on the "user_name" field.
+func UserNameIsNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIsNull(FieldUserName))
+}
+
+// UserNameNotNil applies the NotNil predicate on the "user_name" field.
+func UserNameNotNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotNull(FieldUserName))
+}
+
+// UserNameEqualFold applies the EqualFold predicate on the "user_name" field.
+func UserNameEqualFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEqualFold(FieldUserName, v))
+}
+
+// UserNameContainsFold applies the ContainsFold predicate on the "user_name" field.
+func UserNameContainsFold(v string) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldContainsFold(FieldUserName, v))
+}
+
+// ImportedEQ applies the EQ predicate on the "imported" field.
+func ImportedEQ(v bool) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldImported, v))
+}
+
+// ImportedNEQ applies the NEQ predicate on the "imported" field.
+func ImportedNEQ(v bool) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNEQ(FieldImported, v))
+}
+
+// APICreatedAtEQ applies the EQ predicate on the "api_created_at" field.
+func APICreatedAtEQ(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtNEQ applies the NEQ predicate on the "api_created_at" field.
+func APICreatedAtNEQ(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtIn applies the In predicate on the "api_created_at" field.
+func APICreatedAtIn(vs ...time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtNotIn applies the NotIn predicate on the "api_created_at" field.
+func APICreatedAtNotIn(vs ...time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtGT applies the GT predicate on the "api_created_at" field.
+func APICreatedAtGT(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtGTE applies the GTE predicate on the "api_created_at" field.
+func APICreatedAtGTE(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGTE(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLT applies the LT predicate on the "api_created_at" field.
+func APICreatedAtLT(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLTE applies the LTE predicate on the "api_created_at" field.
+func APICreatedAtLTE(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLTE(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtIsNil applies the IsNil predicate on the "api_created_at" field.
+func APICreatedAtIsNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIsNull(FieldAPICreatedAt))
+}
+
+// APICreatedAtNotNil applies the NotNil predicate on the "api_created_at" field.
+func APICreatedAtNotNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotNull(FieldAPICreatedAt))
+}
+
+// APIUpdatedAtEQ applies the EQ predicate on the "api_updated_at" field.
+func APIUpdatedAtEQ(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldEQ(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtNEQ applies the NEQ predicate on the "api_updated_at" field.
+func APIUpdatedAtNEQ(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNEQ(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtIn applies the In predicate on the "api_updated_at" field.
+func APIUpdatedAtIn(vs ...time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIn(FieldAPIUpdatedAt, vs...))
+}
+
+// APIUpdatedAtNotIn applies the NotIn predicate on the "api_updated_at" field.
+func APIUpdatedAtNotIn(vs ...time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotIn(FieldAPIUpdatedAt, vs...))
+}
+
+// APIUpdatedAtGT applies the GT predicate on the "api_updated_at" field.
+func APIUpdatedAtGT(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGT(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtGTE applies the GTE predicate on the "api_updated_at" field.
+func APIUpdatedAtGTE(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldGTE(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtLT applies the LT predicate on the "api_updated_at" field.
+func APIUpdatedAtLT(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLT(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtLTE applies the LTE predicate on the "api_updated_at" field.
+func APIUpdatedAtLTE(v time.Time) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldLTE(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtIsNil applies the IsNil predicate on the "api_updated_at" field.
+func APIUpdatedAtIsNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldIsNull(FieldAPIUpdatedAt))
+}
+
+// APIUpdatedAtNotNil applies the NotNil predicate on the "api_updated_at" field.
+func APIUpdatedAtNotNil() predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.FieldNotNull(FieldAPIUpdatedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeS1Restriction) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeS1Restriction) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeS1Restriction) predicate.BronzeS1Restriction {
+	return predicate.BronzeS1Restriction(sql.NotPredicates(p))
+}
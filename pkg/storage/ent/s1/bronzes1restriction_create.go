@@ -0,0 +1,510 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1restriction"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeS1RestrictionCreate is the builder for creating a BronzeS1Restriction entity.
+type BronzeS1RestrictionCreate struct {
+	config
+	mutation *BronzeS1RestrictionMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeS1RestrictionCreate) SetCollectedAt(v time.Time) *BronzeS1RestrictionCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeS1RestrictionCreate) SetFirstCollectedAt(v time.Time) *BronzeS1RestrictionCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetScopeLevel sets the "scope_level" field.
+func (_c *BronzeS1RestrictionCreate) SetScopeLevel(v string) *BronzeS1RestrictionCreate {
+	_c.mutation.SetScopeLevel(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeS1RestrictionCreate) SetAccountID(v string) *BronzeS1RestrictionCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_c *BronzeS1RestrictionCreate) SetNillableAccountID(v *string) *BronzeS1RestrictionCreate {
+	if v != nil {
+		_c.SetAccountID(*v)
+	}
+	return _c
+}
+
+// SetSiteID sets the "site_id" field.
+func (_c *BronzeS1RestrictionCreate) SetSiteID(v string) *BronzeS1RestrictionCreate {
+	_c.mutation.SetSiteID(v)
+	return _c
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_c *BronzeS1RestrictionCreate) SetNillableSiteID(v *string) *BronzeS1RestrictionCreate {
+	if v != nil {
+		_c.SetSiteID(*v)
+	}
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *BronzeS1RestrictionCreate) SetGroupID(v string) *BronzeS1RestrictionCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_c *BronzeS1RestrictionCreate) SetNillableGroupID(v *string) *BronzeS1RestrictionCreate {
+	if v != nil {
+		_c.SetGroupID(*v)
+	}
+	return _c
+}
+
+// SetScopeName sets the "scope_name" field.
+func (_c *BronzeS1RestrictionCreate) SetScopeName(v string) *BronzeS1RestrictionCreate {
+	_c.mutation.SetScopeName(v)
+	return _c
+}
+
+// SetNillableScopeName sets the "scope_name" field if the given value is not nil.
+func (_c *BronzeS1RestrictionCreate) SetNillableScopeName(v *string) *BronzeS1RestrictionCreate {
+	if v != nil {
+		_c.SetScopeName(*v)
+	}
+	return _c
+}
+
+// SetScopePath sets the "scope_path" field.
+func (_c *BronzeS1RestrictionCreate) SetScopePath(v string) *BronzeS1RestrictionCreate {
+	_c.mutation.SetScopePath(v)
+	return _c
+}
+
+// SetNillableScopePath sets the "scope_path" field if the given value is not nil.
+func (_c *BronzeS1RestrictionCreate) SetNillableScopePath(v *string) *BronzeS1RestrictionCreate {
+	if v != nil {
+		_c.SetScopePath(*v)
+	}
+	return _c
+}
+
+// SetRestrictionType sets the "restriction_type" field.
+func (_c *BronzeS1RestrictionCreate) SetRestrictionType(v string) *BronzeS1RestrictionCreate {
+	_c.mutation.SetRestrictionType(v)
+	return _c
+}
+
+// SetRestrictionValue sets the "restriction_value" field.
+func (_c *BronzeS1RestrictionCreate) SetRestrictionValue(v string) *BronzeS1RestrictionCreate {
+	_c.mutation.SetRestrictionValue(v)
+	return _c
+}
+
+// SetNillableRestrictionValue sets the "restriction_value" field if the given value is not nil.
+func (_c *BronzeS1RestrictionCreate) SetNillableRestrictionValue(v *string) *BronzeS1RestrictionCreate {
+	if v != nil {
+		_c.SetRestrictionValue(*v)
+	}
+	return _c
+}
+
+// SetOsType sets the "os_type" field.
+func (_c *BronzeS1RestrictionCreate) SetOsType(v string) *BronzeS1RestrictionCreate {
+	_c.mutation.SetOsType(v)
+	return _c
+}
+
+// SetNillableOsType sets the "os_type" field if the given value is not nil.
+func (_c *BronzeS1RestrictionCreate) SetNillableOsType(v *string) *BronzeS1RestrictionCreate {
+	if v != nil {
+		_c.SetOsType(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BronzeS1RestrictionCreate) SetDescription(v string) *BronzeS1RestrictionCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BronzeS1RestrictionCreate) SetNillableDescription(v *string) *BronzeS1RestrictionCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *BronzeS1RestrictionCreate) SetSource(v string) *BronzeS1RestrictionCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *BronzeS1RestrictionCreate) SetNillableSource(v *string) *BronzeS1RestrictionCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *BronzeS1RestrictionCreate) SetUserID(v string) *BronzeS1RestrictionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *BronzeS1RestrictionCreate) SetNillableUserID(v *string) *BronzeS1RestrictionCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetUserName sets the "user_name" field.
+func (_c *BronzeS1RestrictionCreate) SetUserName(v string) *BronzeS1RestrictionCreate {
+	_c.mutation.SetUserName(v)
+	return _c
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_c *BronzeS1RestrictionCreate) SetNillableUserName(v *string) *BronzeS1RestrictionCreate {
+	if v != nil {
+		_c.SetUserName(*v)
+	}
+	return _c
+}
+
+// SetImported sets the "imported" field.
+func (_c *BronzeS1RestrictionCreate) SetImported(v bool) *BronzeS1RestrictionCreate {
+	_c.mutation.SetImported(v)
+	return _c
+}
+
+// SetNillableImported sets the "imported" field if the given value is not nil.
+func (_c *BronzeS1RestrictionCreate) SetNillableImported(v *bool) *BronzeS1RestrictionCreate {
+	if v != nil {
+		_c.SetImported(*v)
+	}
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeS1RestrictionCreate) SetAPICreatedAt(v time.Time) *BronzeS1RestrictionCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeS1RestrictionCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeS1RestrictionCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_c *BronzeS1RestrictionCreate) SetAPIUpdatedAt(v time.Time) *BronzeS1RestrictionCreate {
+	_c.mutation.SetAPIUpdatedAt(v)
+	return _c
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_c *BronzeS1RestrictionCreate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeS1RestrictionCreate {
+	if v != nil {
+		_c.SetAPIUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeS1RestrictionCreate) SetID(v string) *BronzeS1RestrictionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeS1RestrictionMutation object of the builder.
+func (_c *BronzeS1RestrictionCreate) Mutation() *BronzeS1RestrictionMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeS1Restriction in the database.
+func (_c *BronzeS1RestrictionCreate) Save(ctx context.Context) (*BronzeS1Restriction, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeS1RestrictionCreate) SaveX(ctx context.Context) *BronzeS1Restriction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeS1RestrictionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeS1RestrictionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeS1RestrictionCreate) defaults() {
+	if _, ok := _c.mutation.Imported(); !ok {
+		v := bronzes1restriction.DefaultImported
+		_c.mutation.SetImported(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeS1RestrictionCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`s1: missing required field "BronzeS1Restriction.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`s1: missing required field "BronzeS1Restriction.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.ScopeLevel(); !ok {
+		return &ValidationError{Name: "scope_level", err: errors.New(`s1: missing required field "BronzeS1Restriction.scope_level"`)}
+	}
+	if v, ok := _c.mutation.ScopeLevel(); ok {
+		if err := bronzes1restriction.ScopeLevelValidator(v); err != nil {
+			return &ValidationError{Name: "scope_level", err: fmt.Errorf(`s1: validator failed for field "BronzeS1Restriction.scope_level": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RestrictionType(); !ok {
+		return &ValidationError{Name: "restriction_type", err: errors.New(`s1: missing required field "BronzeS1Restriction.restriction_type"`)}
+	}
+	if v, ok := _c.mutation.RestrictionType(); ok {
+		if err := bronzes1restriction.RestrictionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "restriction_type", err: fmt.Errorf(`s1: validator failed for field "BronzeS1Restriction.restriction_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Imported(); !ok {
+		return &ValidationError{Name: "imported", err: errors.New(`s1: missing required field "BronzeS1Restriction.imported"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeS1RestrictionCreate) sqlSave(ctx context.Context) (*BronzeS1Restriction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeS1Restriction.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeS1RestrictionCreate) createSpec() (*BronzeS1Restriction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeS1Restriction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzes1restriction.Table, sqlgraph.NewFieldSpec(bronzes1restriction.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeS1Restriction
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1restriction.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzes1restriction.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.ScopeLevel(); ok {
+		_spec.SetField(bronzes1restriction.FieldScopeLevel, field.TypeString, value)
+		_node.ScopeLevel = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzes1restriction.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.SiteID(); ok {
+		_spec.SetField(bronzes1restriction.FieldSiteID, field.TypeString, value)
+		_node.SiteID = value
+	}
+	if value, ok := _c.mutation.GroupID(); ok {
+		_spec.SetField(bronzes1restriction.FieldGroupID, field.TypeString, value)
+		_node.GroupID = value
+	}
+	if value, ok := _c.mutation.ScopeName(); ok {
+		_spec.SetField(bronzes1restriction.FieldScopeName, field.TypeString, value)
+		_node.ScopeName = value
+	}
+	if value, ok := _c.mutation.ScopePath(); ok {
+		_spec.SetField(bronzes1restriction.FieldScopePath, field.TypeString, value)
+		_node.ScopePath = value
+	}
+	if value, ok := _c.mutation.RestrictionType(); ok {
+		_spec.SetField(bronzes1restriction.FieldRestrictionType, field.TypeString, value)
+		_node.RestrictionType = value
+	}
+	if value, ok := _c.mutation.RestrictionValue(); ok {
+		_spec.SetField(bronzes1restriction.FieldRestrictionValue, field.TypeString, value)
+		_node.RestrictionValue = value
+	}
+	if value, ok := _c.mutation.OsType(); ok {
+		_spec.SetField(bronzes1restriction.FieldOsType, field.TypeString, value)
+		_node.OsType = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(bronzes1restriction.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(bronzes1restriction.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(bronzes1restriction.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.UserName(); ok {
+		_spec.SetField(bronzes1restriction.FieldUserName, field.TypeString, value)
+		_node.UserName = value
+	}
+	if value, ok := _c.mutation.Imported(); ok {
+		_spec.SetField(bronzes1restriction.FieldImported, field.TypeBool, value)
+		_node.Imported = value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzes1restriction.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	if value, ok := _c.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzes1restriction.FieldAPIUpdatedAt, field.TypeTime, value)
+		_node.APIUpdatedAt = &value
+	}
+	return _node, _spec
+}
+
+// BronzeS1RestrictionCreateBulk is the builder for creating many BronzeS1Restriction entities in bulk.
+type BronzeS1RestrictionCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeS1RestrictionCreate
+}
+
+// Save creates the BronzeS1Restriction entities in the database.
+func (_c *BronzeS1RestrictionCreateBulk) Save(ctx context.Context) ([]*BronzeS1Restriction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeS1Restriction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeS1RestrictionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeS1RestrictionCreateBulk) SaveX(ctx context.Context) []*BronzeS1Restriction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeS1RestrictionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeS1RestrictionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
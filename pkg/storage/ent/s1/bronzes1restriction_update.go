@@ -0,0 +1,1029 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1restriction"
+	"danny.vn/hotpot/pkg/storage/ent/s1/internal"
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeS1RestrictionUpdate is the builder for updating BronzeS1Restriction entities.
+type BronzeS1RestrictionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeS1RestrictionMutation
+}
+
+// Where appends a list predicates to the BronzeS1RestrictionUpdate builder.
+func (_u *BronzeS1RestrictionUpdate) Where(ps ...predicate.BronzeS1Restriction) *BronzeS1RestrictionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeS1RestrictionUpdate) SetCollectedAt(v time.Time) *BronzeS1RestrictionUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdate) SetNillableCollectedAt(v *time.Time) *BronzeS1RestrictionUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetScopeLevel sets the "scope_level" field.
+func (_u *BronzeS1RestrictionUpdate) SetScopeLevel(v string) *BronzeS1RestrictionUpdate {
+	_u.mutation.SetScopeLevel(v)
+	return _u
+}
+
+// SetNillableScopeLevel sets the "scope_level" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdate) SetNillableScopeLevel(v *string) *BronzeS1RestrictionUpdate {
+	if v != nil {
+		_u.SetScopeLevel(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeS1RestrictionUpdate) SetAccountID(v string) *BronzeS1RestrictionUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdate) SetNillableAccountID(v *string) *BronzeS1RestrictionUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *BronzeS1RestrictionUpdate) ClearAccountID() *BronzeS1RestrictionUpdate {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeS1RestrictionUpdate) SetSiteID(v string) *BronzeS1RestrictionUpdate {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdate) SetNillableSiteID(v *string) *BronzeS1RestrictionUpdate {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeS1RestrictionUpdate) ClearSiteID() *BronzeS1RestrictionUpdate {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeS1RestrictionUpdate) SetGroupID(v string) *BronzeS1RestrictionUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdate) SetNillableGroupID(v *string) *BronzeS1RestrictionUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeS1RestrictionUpdate) ClearGroupID() *BronzeS1RestrictionUpdate {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetScopeName sets the "scope_name" field.
+func (_u *BronzeS1RestrictionUpdate) SetScopeName(v string) *BronzeS1RestrictionUpdate {
+	_u.mutation.SetScopeName(v)
+	return _u
+}
+
+// SetNillableScopeName sets the "scope_name" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdate) SetNillableScopeName(v *string) *BronzeS1RestrictionUpdate {
+	if v != nil {
+		_u.SetScopeName(*v)
+	}
+	return _u
+}
+
+// ClearScopeName clears the value of the "scope_name" field.
+func (_u *BronzeS1RestrictionUpdate) ClearScopeName() *BronzeS1RestrictionUpdate {
+	_u.mutation.ClearScopeName()
+	return _u
+}
+
+// SetScopePath sets the "scope_path" field.
+func (_u *BronzeS1RestrictionUpdate) SetScopePath(v string) *BronzeS1RestrictionUpdate {
+	_u.mutation.SetScopePath(v)
+	return _u
+}
+
+// SetNillableScopePath sets the "scope_path" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdate) SetNillableScopePath(v *string) *BronzeS1RestrictionUpdate {
+	if v != nil {
+		_u.SetScopePath(*v)
+	}
+	return _u
+}
+
+// ClearScopePath clears the value of the "scope_path" field.
+func (_u *BronzeS1RestrictionUpdate) ClearScopePath() *BronzeS1RestrictionUpdate {
+	_u.mutation.ClearScopePath()
+	return _u
+}
+
+// SetRestrictionType sets the "restriction_type" field.
+func (_u *BronzeS1RestrictionUpdate) SetRestrictionType(v string) *BronzeS1RestrictionUpdate {
+	_u.mutation.SetRestrictionType(v)
+	return _u
+}
+
+// SetNillableRestrictionType sets the "restriction_type" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdate) SetNillableRestrictionType(v *string) *BronzeS1RestrictionUpdate {
+	if v != nil {
+		_u.SetRestrictionType(*v)
+	}
+	return _u
+}
+
+// SetRestrictionValue sets the "restriction_value" field.
+func (_u *BronzeS1RestrictionUpdate) SetRestrictionValue(v string) *BronzeS1RestrictionUpdate {
+	_u.mutation.SetRestrictionValue(v)
+	return _u
+}
+
+// SetNillableRestrictionValue sets the "restriction_value" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdate) SetNillableRestrictionValue(v *string) *BronzeS1RestrictionUpdate {
+	if v != nil {
+		_u.SetRestrictionValue(*v)
+	}
+	return _u
+}
+
+// ClearRestrictionValue clears the value of the "restriction_value" field.
+func (_u *BronzeS1RestrictionUpdate) ClearRestrictionValue() *BronzeS1RestrictionUpdate {
+	_u.mutation.ClearRestrictionValue()
+	return _u
+}
+
+// SetOsType sets the "os_type" field.
+func (_u *BronzeS1RestrictionUpdate) SetOsType(v string) *BronzeS1RestrictionUpdate {
+	_u.mutation.SetOsType(v)
+	return _u
+}
+
+// SetNillableOsType sets the "os_type" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdate) SetNillableOsType(v *string) *BronzeS1RestrictionUpdate {
+	if v != nil {
+		_u.SetOsType(*v)
+	}
+	return _u
+}
+
+// ClearOsType clears the value of the "os_type" field.
+func (_u *BronzeS1RestrictionUpdate) ClearOsType() *BronzeS1RestrictionUpdate {
+	_u.mutation.ClearOsType()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeS1RestrictionUpdate) SetDescription(v string) *BronzeS1RestrictionUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdate) SetNillableDescription(v *string) *BronzeS1RestrictionUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeS1RestrictionUpdate) ClearDescription() *BronzeS1RestrictionUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BronzeS1RestrictionUpdate) SetSource(v string) *BronzeS1RestrictionUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdate) SetNillableSource(v *string) *BronzeS1RestrictionUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *BronzeS1RestrictionUpdate) ClearSource() *BronzeS1RestrictionUpdate {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BronzeS1RestrictionUpdate) SetUserID(v string) *BronzeS1RestrictionUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdate) SetNillableUserID(v *string) *BronzeS1RestrictionUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *BronzeS1RestrictionUpdate) ClearUserID() *BronzeS1RestrictionUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *BronzeS1RestrictionUpdate) SetUserName(v string) *BronzeS1RestrictionUpdate {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdate) SetNillableUserName(v *string) *BronzeS1RestrictionUpdate {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (_u *BronzeS1RestrictionUpdate) ClearUserName() *BronzeS1RestrictionUpdate {
+	_u.mutation.ClearUserName()
+	return _u
+}
+
+// SetImported sets the "imported" field.
+func (_u *BronzeS1RestrictionUpdate) SetImported(v bool) *BronzeS1RestrictionUpdate {
+	_u.mutation.SetImported(v)
+	return _u
+}
+
+// SetNillableImported sets the "imported" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdate) SetNillableImported(v *bool) *BronzeS1RestrictionUpdate {
+	if v != nil {
+		_u.SetImported(*v)
+	}
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeS1RestrictionUpdate) SetAPICreatedAt(v time.Time) *BronzeS1RestrictionUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeS1RestrictionUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeS1RestrictionUpdate) ClearAPICreatedAt() *BronzeS1RestrictionUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeS1RestrictionUpdate) SetAPIUpdatedAt(v time.Time) *BronzeS1RestrictionUpdate {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeS1RestrictionUpdate {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeS1RestrictionUpdate) ClearAPIUpdatedAt() *BronzeS1RestrictionUpdate {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeS1RestrictionMutation object of the builder.
+func (_u *BronzeS1RestrictionUpdate) Mutation() *BronzeS1RestrictionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeS1RestrictionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeS1RestrictionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeS1RestrictionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeS1RestrictionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeS1RestrictionUpdate) check() error {
+	if v, ok := _u.mutation.ScopeLevel(); ok {
+		if err := bronzes1restriction.ScopeLevelValidator(v); err != nil {
+			return &ValidationError{Name: "scope_level", err: fmt.Errorf(`s1: validator failed for field "BronzeS1Restriction.scope_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RestrictionType(); ok {
+		if err := bronzes1restriction.RestrictionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "restriction_type", err: fmt.Errorf(`s1: validator failed for field "BronzeS1Restriction.restriction_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeS1RestrictionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzes1restriction.Table, bronzes1restriction.Columns, sqlgraph.NewFieldSpec(bronzes1restriction.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1restriction.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ScopeLevel(); ok {
+		_spec.SetField(bronzes1restriction.FieldScopeLevel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzes1restriction.FieldAccountID, field.TypeString, value)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(bronzes1restriction.FieldAccountID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzes1restriction.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzes1restriction.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzes1restriction.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzes1restriction.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopeName(); ok {
+		_spec.SetField(bronzes1restriction.FieldScopeName, field.TypeString, value)
+	}
+	if _u.mutation.ScopeNameCleared() {
+		_spec.ClearField(bronzes1restriction.FieldScopeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopePath(); ok {
+		_spec.SetField(bronzes1restriction.FieldScopePath, field.TypeString, value)
+	}
+	if _u.mutation.ScopePathCleared() {
+		_spec.ClearField(bronzes1restriction.FieldScopePath, field.TypeString)
+	}
+	if value, ok := _u.mutation.RestrictionType(); ok {
+		_spec.SetField(bronzes1restriction.FieldRestrictionType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RestrictionValue(); ok {
+		_spec.SetField(bronzes1restriction.FieldRestrictionValue, field.TypeString, value)
+	}
+	if _u.mutation.RestrictionValueCleared() {
+		_spec.ClearField(bronzes1restriction.FieldRestrictionValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsType(); ok {
+		_spec.SetField(bronzes1restriction.FieldOsType, field.TypeString, value)
+	}
+	if _u.mutation.OsTypeCleared() {
+		_spec.ClearField(bronzes1restriction.FieldOsType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzes1restriction.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzes1restriction.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(bronzes1restriction.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(bronzes1restriction.FieldSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(bronzes1restriction.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(bronzes1restriction.FieldUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(bronzes1restriction.FieldUserName, field.TypeString, value)
+	}
+	if _u.mutation.UserNameCleared() {
+		_spec.ClearField(bronzes1restriction.FieldUserName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Imported(); ok {
+		_spec.SetField(bronzes1restriction.FieldImported, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzes1restriction.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzes1restriction.FieldAPICreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzes1restriction.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzes1restriction.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeS1Restriction
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzes1restriction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeS1RestrictionUpdateOne is the builder for updating a single BronzeS1Restriction entity.
+type BronzeS1RestrictionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeS1RestrictionMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeS1RestrictionUpdateOne) SetCollectedAt(v time.Time) *BronzeS1RestrictionUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetScopeLevel sets the "scope_level" field.
+func (_u *BronzeS1RestrictionUpdateOne) SetScopeLevel(v string) *BronzeS1RestrictionUpdateOne {
+	_u.mutation.SetScopeLevel(v)
+	return _u
+}
+
+// SetNillableScopeLevel sets the "scope_level" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdateOne) SetNillableScopeLevel(v *string) *BronzeS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetScopeLevel(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeS1RestrictionUpdateOne) SetAccountID(v string) *BronzeS1RestrictionUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdateOne) SetNillableAccountID(v *string) *BronzeS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *BronzeS1RestrictionUpdateOne) ClearAccountID() *BronzeS1RestrictionUpdateOne {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeS1RestrictionUpdateOne) SetSiteID(v string) *BronzeS1RestrictionUpdateOne {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdateOne) SetNillableSiteID(v *string) *BronzeS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeS1RestrictionUpdateOne) ClearSiteID() *BronzeS1RestrictionUpdateOne {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeS1RestrictionUpdateOne) SetGroupID(v string) *BronzeS1RestrictionUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdateOne) SetNillableGroupID(v *string) *BronzeS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeS1RestrictionUpdateOne) ClearGroupID() *BronzeS1RestrictionUpdateOne {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetScopeName sets the "scope_name" field.
+func (_u *BronzeS1RestrictionUpdateOne) SetScopeName(v string) *BronzeS1RestrictionUpdateOne {
+	_u.mutation.SetScopeName(v)
+	return _u
+}
+
+// SetNillableScopeName sets the "scope_name" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdateOne) SetNillableScopeName(v *string) *BronzeS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetScopeName(*v)
+	}
+	return _u
+}
+
+// ClearScopeName clears the value of the "scope_name" field.
+func (_u *BronzeS1RestrictionUpdateOne) ClearScopeName() *BronzeS1RestrictionUpdateOne {
+	_u.mutation.ClearScopeName()
+	return _u
+}
+
+// SetScopePath sets the "scope_path" field.
+func (_u *BronzeS1RestrictionUpdateOne) SetScopePath(v string) *BronzeS1RestrictionUpdateOne {
+	_u.mutation.SetScopePath(v)
+	return _u
+}
+
+// SetNillableScopePath sets the "scope_path" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdateOne) SetNillableScopePath(v *string) *BronzeS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetScopePath(*v)
+	}
+	return _u
+}
+
+// ClearScopePath clears the value of the "scope_path" field.
+func (_u *BronzeS1RestrictionUpdateOne) ClearScopePath() *BronzeS1RestrictionUpdateOne {
+	_u.mutation.ClearScopePath()
+	return _u
+}
+
+// SetRestrictionType sets the "restriction_type" field.
+func (_u *BronzeS1RestrictionUpdateOne) SetRestrictionType(v string) *BronzeS1RestrictionUpdateOne {
+	_u.mutation.SetRestrictionType(v)
+	return _u
+}
+
+// SetNillableRestrictionType sets the "restriction_type" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdateOne) SetNillableRestrictionType(v *string) *BronzeS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetRestrictionType(*v)
+	}
+	return _u
+}
+
+// SetRestrictionValue sets the "restriction_value" field.
+func (_u *BronzeS1RestrictionUpdateOne) SetRestrictionValue(v string) *BronzeS1RestrictionUpdateOne {
+	_u.mutation.SetRestrictionValue(v)
+	return _u
+}
+
+// SetNillableRestrictionValue sets the "restriction_value" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdateOne) SetNillableRestrictionValue(v *string) *BronzeS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetRestrictionValue(*v)
+	}
+	return _u
+}
+
+// ClearRestrictionValue clears the value of the "restriction_value" field.
+func (_u *BronzeS1RestrictionUpdateOne) ClearRestrictionValue() *BronzeS1RestrictionUpdateOne {
+	_u.mutation.ClearRestrictionValue()
+	return _u
+}
+
+// SetOsType sets the "os_type" field.
+func (_u *BronzeS1RestrictionUpdateOne) SetOsType(v string) *BronzeS1RestrictionUpdateOne {
+	_u.mutation.SetOsType(v)
+	return _u
+}
+
+// SetNillableOsType sets the "os_type" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdateOne) SetNillableOsType(v *string) *BronzeS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetOsType(*v)
+	}
+	return _u
+}
+
+// ClearOsType clears the value of the "os_type" field.
+func (_u *BronzeS1RestrictionUpdateOne) ClearOsType() *BronzeS1RestrictionUpdateOne {
+	_u.mutation.ClearOsType()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BronzeS1RestrictionUpdateOne) SetDescription(v string) *BronzeS1RestrictionUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdateOne) SetNillableDescription(v *string) *BronzeS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BronzeS1RestrictionUpdateOne) ClearDescription() *BronzeS1RestrictionUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BronzeS1RestrictionUpdateOne) SetSource(v string) *BronzeS1RestrictionUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdateOne) SetNillableSource(v *string) *BronzeS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *BronzeS1RestrictionUpdateOne) ClearSource() *BronzeS1RestrictionUpdateOne {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BronzeS1RestrictionUpdateOne) SetUserID(v string) *BronzeS1RestrictionUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdateOne) SetNillableUserID(v *string) *BronzeS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *BronzeS1RestrictionUpdateOne) ClearUserID() *BronzeS1RestrictionUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *BronzeS1RestrictionUpdateOne) SetUserName(v string) *BronzeS1RestrictionUpdateOne {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdateOne) SetNillableUserName(v *string) *BronzeS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (_u *BronzeS1RestrictionUpdateOne) ClearUserName() *BronzeS1RestrictionUpdateOne {
+	_u.mutation.ClearUserName()
+	return _u
+}
+
+// SetImported sets the "imported" field.
+func (_u *BronzeS1RestrictionUpdateOne) SetImported(v bool) *BronzeS1RestrictionUpdateOne {
+	_u.mutation.SetImported(v)
+	return _u
+}
+
+// SetNillableImported sets the "imported" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdateOne) SetNillableImported(v *bool) *BronzeS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetImported(*v)
+	}
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeS1RestrictionUpdateOne) SetAPICreatedAt(v time.Time) *BronzeS1RestrictionUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeS1RestrictionUpdateOne) ClearAPICreatedAt() *BronzeS1RestrictionUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeS1RestrictionUpdateOne) SetAPIUpdatedAt(v time.Time) *BronzeS1RestrictionUpdateOne {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeS1RestrictionUpdateOne) SetNillableAPIUpdatedAt(v *time.Time) *BronzeS1RestrictionUpdateOne {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeS1RestrictionUpdateOne) ClearAPIUpdatedAt() *BronzeS1RestrictionUpdateOne {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// Mutation returns the BronzeS1RestrictionMutation object of the builder.
+func (_u *BronzeS1RestrictionUpdateOne) Mutation() *BronzeS1RestrictionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeS1RestrictionUpdate builder.
+func (_u *BronzeS1RestrictionUpdateOne) Where(ps ...predicate.BronzeS1Restriction) *BronzeS1RestrictionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeS1RestrictionUpdateOne) Select(field string, fields ...string) *BronzeS1RestrictionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeS1Restriction entity.
+func (_u *BronzeS1RestrictionUpdateOne) Save(ctx context.Context) (*BronzeS1Restriction, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeS1RestrictionUpdateOne) SaveX(ctx context.Context) *BronzeS1Restriction {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeS1RestrictionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeS1RestrictionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BronzeS1RestrictionUpdateOne) check() error {
+	if v, ok := _u.mutation.ScopeLevel(); ok {
+		if err := bronzes1restriction.ScopeLevelValidator(v); err != nil {
+			return &ValidationError{Name: "scope_level", err: fmt.Errorf(`s1: validator failed for field "BronzeS1Restriction.scope_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RestrictionType(); ok {
+		if err := bronzes1restriction.RestrictionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "restriction_type", err: fmt.Errorf(`s1: validator failed for field "BronzeS1Restriction.restriction_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BronzeS1RestrictionUpdateOne) sqlSave(ctx context.Context) (_node *BronzeS1Restriction, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bronzes1restriction.Table, bronzes1restriction.Columns, sqlgraph.NewFieldSpec(bronzes1restriction.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`s1: missing "BronzeS1Restriction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzes1restriction.FieldID)
+		for _, f := range fields {
+			if !bronzes1restriction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("s1: invalid field %q for query", f)}
+			}
+			if f != bronzes1restriction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1restriction.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ScopeLevel(); ok {
+		_spec.SetField(bronzes1restriction.FieldScopeLevel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzes1restriction.FieldAccountID, field.TypeString, value)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(bronzes1restriction.FieldAccountID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzes1restriction.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzes1restriction.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzes1restriction.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzes1restriction.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopeName(); ok {
+		_spec.SetField(bronzes1restriction.FieldScopeName, field.TypeString, value)
+	}
+	if _u.mutation.ScopeNameCleared() {
+		_spec.ClearField(bronzes1restriction.FieldScopeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ScopePath(); ok {
+		_spec.SetField(bronzes1restriction.FieldScopePath, field.TypeString, value)
+	}
+	if _u.mutation.ScopePathCleared() {
+		_spec.ClearField(bronzes1restriction.FieldScopePath, field.TypeString)
+	}
+	if value, ok := _u.mutation.RestrictionType(); ok {
+		_spec.SetField(bronzes1restriction.FieldRestrictionType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RestrictionValue(); ok {
+		_spec.SetField(bronzes1restriction.FieldRestrictionValue, field.TypeString, value)
+	}
+	if _u.mutation.RestrictionValueCleared() {
+		_spec.ClearField(bronzes1restriction.FieldRestrictionValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.OsType(); ok {
+		_spec.SetField(bronzes1restriction.FieldOsType, field.TypeString, value)
+	}
+	if _u.mutation.OsTypeCleared() {
+		_spec.ClearField(bronzes1restriction.FieldOsType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bronzes1restriction.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bronzes1restriction.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(bronzes1restriction.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(bronzes1restriction.FieldSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(bronzes1restriction.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(bronzes1restriction.FieldUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(bronzes1restriction.FieldUserName, field.TypeString, value)
+	}
+	if _u.mutation.UserNameCleared() {
+		_spec.ClearField(bronzes1restriction.FieldUserName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Imported(); ok {
+		_spec.SetField(bronzes1restriction.FieldImported, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzes1restriction.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzes1restriction.FieldAPICreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzes1restriction.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzes1restriction.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeS1Restriction
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeS1Restriction{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzes1restriction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
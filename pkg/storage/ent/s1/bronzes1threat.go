@@ -0,0 +1,466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1threat"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BronzeS1Threat is the model entity for the BronzeS1Threat schema.
+type BronzeS1Threat struct {
+	config `json:"-"`
+	// ID of the ent.
+	// SentinelOne threat ID
+	ID string `json:"id,omitempty"`
+	// CollectedAt holds the value of the "collected_at" field.
+	CollectedAt time.Time `json:"collected_at,omitempty"`
+	// FirstCollectedAt holds the value of the "first_collected_at" field.
+	FirstCollectedAt time.Time `json:"first_collected_at,omitempty"`
+	// SentinelOne agent ID; joins to s1_agents.resource_id
+	AgentID string `json:"agent_id,omitempty"`
+	// AgentUUID holds the value of the "agent_uuid" field.
+	AgentUUID string `json:"agent_uuid,omitempty"`
+	// AgentComputerName holds the value of the "agent_computer_name" field.
+	AgentComputerName string `json:"agent_computer_name,omitempty"`
+	// AgentOsType holds the value of the "agent_os_type" field.
+	AgentOsType string `json:"agent_os_type,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID string `json:"account_id,omitempty"`
+	// SiteID holds the value of the "site_id" field.
+	SiteID string `json:"site_id,omitempty"`
+	// SiteName holds the value of the "site_name" field.
+	SiteName string `json:"site_name,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID string `json:"group_id,omitempty"`
+	// ThreatName holds the value of the "threat_name" field.
+	ThreatName string `json:"threat_name,omitempty"`
+	// e.g. Malware, Ransomware, PUA
+	Classification string `json:"classification,omitempty"`
+	// ClassificationSource holds the value of the "classification_source" field.
+	ClassificationSource string `json:"classification_source,omitempty"`
+	// malicious or suspicious
+	ConfidenceLevel string `json:"confidence_level,omitempty"`
+	// e.g. mitigated, not_mitigated, marked_as_benign
+	MitigationStatus string `json:"mitigation_status,omitempty"`
+	// AnalystVerdict holds the value of the "analyst_verdict" field.
+	AnalystVerdict string `json:"analyst_verdict,omitempty"`
+	// IncidentStatus holds the value of the "incident_status" field.
+	IncidentStatus string `json:"incident_status,omitempty"`
+	// InitiatedBy holds the value of the "initiated_by" field.
+	InitiatedBy string `json:"initiated_by,omitempty"`
+	// DetectionType holds the value of the "detection_type" field.
+	DetectionType string `json:"detection_type,omitempty"`
+	// FilePath holds the value of the "file_path" field.
+	FilePath string `json:"file_path,omitempty"`
+	// Sha1 holds the value of the "sha1" field.
+	Sha1 string `json:"sha1,omitempty"`
+	// Sha256 holds the value of the "sha256" field.
+	Sha256 string `json:"sha256,omitempty"`
+	// Md5 holds the value of the "md5" field.
+	Md5 string `json:"md5,omitempty"`
+	// Storyline holds the value of the "storyline" field.
+	Storyline string `json:"storyline,omitempty"`
+	// IsFileless holds the value of the "is_fileless" field.
+	IsFileless bool `json:"is_fileless,omitempty"`
+	// MitigatedPreemptively holds the value of the "mitigated_preemptively" field.
+	MitigatedPreemptively bool `json:"mitigated_preemptively,omitempty"`
+	// IdentifiedAt holds the value of the "identified_at" field.
+	IdentifiedAt *time.Time `json:"identified_at,omitempty"`
+	// APICreatedAt holds the value of the "api_created_at" field.
+	APICreatedAt *time.Time `json:"api_created_at,omitempty"`
+	// APIUpdatedAt holds the value of the "api_updated_at" field.
+	APIUpdatedAt *time.Time `json:"api_updated_at,omitempty"`
+	// EnginesJSON holds the value of the "engines_json" field.
+	EnginesJSON json.RawMessage `json:"engines_json,omitempty"`
+	// IndicatorsJSON holds the value of the "indicators_json" field.
+	IndicatorsJSON json.RawMessage `json:"indicators_json,omitempty"`
+	// MitigationActionsJSON holds the value of the "mitigation_actions_json" field.
+	MitigationActionsJSON json.RawMessage `json:"mitigation_actions_json,omitempty"`
+	selectValues          sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BronzeS1Threat) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bronzes1threat.FieldEnginesJSON, bronzes1threat.FieldIndicatorsJSON, bronzes1threat.FieldMitigationActionsJSON:
+			values[i] = new([]byte)
+		case bronzes1threat.FieldIsFileless, bronzes1threat.FieldMitigatedPreemptively:
+			values[i] = new(sql.NullBool)
+		case bronzes1threat.FieldID, bronzes1threat.FieldAgentID, bronzes1threat.FieldAgentUUID, bronzes1threat.FieldAgentComputerName, bronzes1threat.FieldAgentOsType, bronzes1threat.FieldAccountID, bronzes1threat.FieldSiteID, bronzes1threat.FieldSiteName, bronzes1threat.FieldGroupID, bronzes1threat.FieldThreatName, bronzes1threat.FieldClassification, bronzes1threat.FieldClassificationSource, bronzes1threat.FieldConfidenceLevel, bronzes1threat.FieldMitigationStatus, bronzes1threat.FieldAnalystVerdict, bronzes1threat.FieldIncidentStatus, bronzes1threat.FieldInitiatedBy, bronzes1threat.FieldDetectionType, bronzes1threat.FieldFilePath, bronzes1threat.FieldSha1, bronzes1threat.FieldSha256, bronzes1threat.FieldMd5, bronzes1threat.FieldStoryline:
+			values[i] = new(sql.NullString)
+		case bronzes1threat.FieldCollectedAt, bronzes1threat.FieldFirstCollectedAt, bronzes1threat.FieldIdentifiedAt, bronzes1threat.FieldAPICreatedAt, bronzes1threat.FieldAPIUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BronzeS1Threat fields.
+func (_m *BronzeS1Threat) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bronzes1threat.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case bronzes1threat.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
+			}
+		case bronzes1threat.FieldFirstCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_collected_at", values[i])
+			} else if value.Valid {
+				_m.FirstCollectedAt = value.Time
+			}
+		case bronzes1threat.FieldAgentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_id", values[i])
+			} else if value.Valid {
+				_m.AgentID = value.String
+			}
+		case bronzes1threat.FieldAgentUUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_uuid", values[i])
+			} else if value.Valid {
+				_m.AgentUUID = value.String
+			}
+		case bronzes1threat.FieldAgentComputerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_computer_name", values[i])
+			} else if value.Valid {
+				_m.AgentComputerName = value.String
+			}
+		case bronzes1threat.FieldAgentOsType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_os_type", values[i])
+			} else if value.Valid {
+				_m.AgentOsType = value.String
+			}
+		case bronzes1threat.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case bronzes1threat.FieldSiteID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field site_id", values[i])
+			} else if value.Valid {
+				_m.SiteID = value.String
+			}
+		case bronzes1threat.FieldSiteName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field site_name", values[i])
+			} else if value.Valid {
+				_m.SiteName = value.String
+			}
+		case bronzes1threat.FieldGroupID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				_m.GroupID = value.String
+			}
+		case bronzes1threat.FieldThreatName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field threat_name", values[i])
+			} else if value.Valid {
+				_m.ThreatName = value.String
+			}
+		case bronzes1threat.FieldClassification:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field classification", values[i])
+			} else if value.Valid {
+				_m.Classification = value.String
+			}
+		case bronzes1threat.FieldClassificationSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field classification_source", values[i])
+			} else if value.Valid {
+				_m.ClassificationSource = value.String
+			}
+		case bronzes1threat.FieldConfidenceLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field confidence_level", values[i])
+			} else if value.Valid {
+				_m.ConfidenceLevel = value.String
+			}
+		case bronzes1threat.FieldMitigationStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mitigation_status", values[i])
+			} else if value.Valid {
+				_m.MitigationStatus = value.String
+			}
+		case bronzes1threat.FieldAnalystVerdict:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field analyst_verdict", values[i])
+			} else if value.Valid {
+				_m.AnalystVerdict = value.String
+			}
+		case bronzes1threat.FieldIncidentStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field incident_status", values[i])
+			} else if value.Valid {
+				_m.IncidentStatus = value.String
+			}
+		case bronzes1threat.FieldInitiatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field initiated_by", values[i])
+			} else if value.Valid {
+				_m.InitiatedBy = value.String
+			}
+		case bronzes1threat.FieldDetectionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field detection_type", values[i])
+			} else if value.Valid {
+				_m.DetectionType = value.String
+			}
+		case bronzes1threat.FieldFilePath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_path", values[i])
+			} else if value.Valid {
+				_m.FilePath = value.String
+			}
+		case bronzes1threat.FieldSha1:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sha1", values[i])
+			} else if value.Valid {
+				_m.Sha1 = value.String
+			}
+		case bronzes1threat.FieldSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sha256", values[i])
+			} else if value.Valid {
+				_m.Sha256 = value.String
+			}
+		case bronzes1threat.FieldMd5:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field md5", values[i])
+			} else if value.Valid {
+				_m.Md5 = value.String
+			}
+		case bronzes1threat.FieldStoryline:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storyline", values[i])
+			} else if value.Valid {
+				_m.Storyline = value.String
+			}
+		case bronzes1threat.FieldIsFileless:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_fileless", values[i])
+			} else if value.Valid {
+				_m.IsFileless = value.Bool
+			}
+		case bronzes1threat.FieldMitigatedPreemptively:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field mitigated_preemptively", values[i])
+			} else if value.Valid {
+				_m.MitigatedPreemptively = value.Bool
+			}
+		case bronzes1threat.FieldIdentifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field identified_at", values[i])
+			} else if value.Valid {
+				_m.IdentifiedAt = new(time.Time)
+				*_m.IdentifiedAt = value.Time
+			}
+		case bronzes1threat.FieldAPICreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_created_at", values[i])
+			} else if value.Valid {
+				_m.APICreatedAt = new(time.Time)
+				*_m.APICreatedAt = value.Time
+			}
+		case bronzes1threat.FieldAPIUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field api_updated_at", values[i])
+			} else if value.Valid {
+				_m.APIUpdatedAt = new(time.Time)
+				*_m.APIUpdatedAt = value.Time
+			}
+		case bronzes1threat.FieldEnginesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field engines_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EnginesJSON); err != nil {
+					return fmt.Errorf("unmarshal field engines_json: %w", err)
+				}
+			}
+		case bronzes1threat.FieldIndicatorsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field indicators_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.IndicatorsJSON); err != nil {
+					return fmt.Errorf("unmarshal field indicators_json: %w", err)
+				}
+			}
+		case bronzes1threat.FieldMitigationActionsJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field mitigation_actions_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.MitigationActionsJSON); err != nil {
+					return fmt.Errorf("unmarshal field mitigation_actions_json: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BronzeS1Threat.
+// This includes values selected through modifiers, order, etc.
+func (_m *BronzeS1Threat) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BronzeS1Threat.
+// Note that you need to call BronzeS1Threat.Unwrap() before calling this method if this BronzeS1Threat
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BronzeS1Threat) Update() *BronzeS1ThreatUpdateOne {
+	return NewBronzeS1ThreatClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BronzeS1Threat entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BronzeS1Threat) Unwrap() *BronzeS1Threat {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("s1: BronzeS1Threat is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BronzeS1Threat) String() string {
+	var builder strings.Builder
+	builder.WriteString("BronzeS1Threat(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_collected_at=")
+	builder.WriteString(_m.FirstCollectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("agent_id=")
+	builder.WriteString(_m.AgentID)
+	builder.WriteString(", ")
+	builder.WriteString("agent_uuid=")
+	builder.WriteString(_m.AgentUUID)
+	builder.WriteString(", ")
+	builder.WriteString("agent_computer_name=")
+	builder.WriteString(_m.AgentComputerName)
+	builder.WriteString(", ")
+	builder.WriteString("agent_os_type=")
+	builder.WriteString(_m.AgentOsType)
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	builder.WriteString("site_id=")
+	builder.WriteString(_m.SiteID)
+	builder.WriteString(", ")
+	builder.WriteString("site_name=")
+	builder.WriteString(_m.SiteName)
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(_m.GroupID)
+	builder.WriteString(", ")
+	builder.WriteString("threat_name=")
+	builder.WriteString(_m.ThreatName)
+	builder.WriteString(", ")
+	builder.WriteString("classification=")
+	builder.WriteString(_m.Classification)
+	builder.WriteString(", ")
+	builder.WriteString("classification_source=")
+	builder.WriteString(_m.ClassificationSource)
+	builder.WriteString(", ")
+	builder.WriteString("confidence_level=")
+	builder.WriteString(_m.ConfidenceLevel)
+	builder.WriteString(", ")
+	builder.WriteString("mitigation_status=")
+	builder.WriteString(_m.MitigationStatus)
+	builder.WriteString(", ")
+	builder.WriteString("analyst_verdict=")
+	builder.WriteString(_m.AnalystVerdict)
+	builder.WriteString(", ")
+	builder.WriteString("incident_status=")
+	builder.WriteString(_m.IncidentStatus)
+	builder.WriteString(", ")
+	builder.WriteString("initiated_by=")
+	builder.WriteString(_m.InitiatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("detection_type=")
+	builder.WriteString(_m.DetectionType)
+	builder.WriteString(", ")
+	builder.WriteString("file_path=")
+	builder.WriteString(_m.FilePath)
+	builder.WriteString(", ")
+	builder.WriteString("sha1=")
+	builder.WriteString(_m.Sha1)
+	builder.WriteString(", ")
+	builder.WriteString("sha256=")
+	builder.WriteString(_m.Sha256)
+	builder.WriteString(", ")
+	builder.WriteString("md5=")
+	builder.WriteString(_m.Md5)
+	builder.WriteString(", ")
+	builder.WriteString("storyline=")
+	builder.WriteString(_m.Storyline)
+	builder.WriteString(", ")
+	builder.WriteString("is_fileless=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsFileless))
+	builder.WriteString(", ")
+	builder.WriteString("mitigated_preemptively=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MitigatedPreemptively))
+	builder.WriteString(", ")
+	if v := _m.IdentifiedAt; v != nil {
+		builder.WriteString("identified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.APICreatedAt; v != nil {
+		builder.WriteString("api_created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.APIUpdatedAt; v != nil {
+		builder.WriteString("api_updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("engines_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnginesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("indicators_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IndicatorsJSON))
+	builder.WriteString(", ")
+	builder.WriteString("mitigation_actions_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MitigationActionsJSON))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BronzeS1Threats is a parsable slice of BronzeS1Threat.
+type BronzeS1Threats []*BronzeS1Threat
@@ -0,0 +1,287 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzes1threat
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bronzes1threat type in the database.
+	Label = "bronze_s1threat"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
+	// FieldFirstCollectedAt holds the string denoting the first_collected_at field in the database.
+	FieldFirstCollectedAt = "first_collected_at"
+	// FieldAgentID holds the string denoting the agent_id field in the database.
+	FieldAgentID = "agent_id"
+	// FieldAgentUUID holds the string denoting the agent_uuid field in the database.
+	FieldAgentUUID = "agent_uuid"
+	// FieldAgentComputerName holds the string denoting the agent_computer_name field in the database.
+	FieldAgentComputerName = "agent_computer_name"
+	// FieldAgentOsType holds the string denoting the agent_os_type field in the database.
+	FieldAgentOsType = "agent_os_type"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldSiteID holds the string denoting the site_id field in the database.
+	FieldSiteID = "site_id"
+	// FieldSiteName holds the string denoting the site_name field in the database.
+	FieldSiteName = "site_name"
+	// FieldGroupID holds the string denoting the group_id field in the database.
+	FieldGroupID = "group_id"
+	// FieldThreatName holds the string denoting the threat_name field in the database.
+	FieldThreatName = "threat_name"
+	// FieldClassification holds the string denoting the classification field in the database.
+	FieldClassification = "classification"
+	// FieldClassificationSource holds the string denoting the classification_source field in the database.
+	FieldClassificationSource = "classification_source"
+	// FieldConfidenceLevel holds the string denoting the confidence_level field in the database.
+	FieldConfidenceLevel = "confidence_level"
+	// FieldMitigationStatus holds the string denoting the mitigation_status field in the database.
+	FieldMitigationStatus = "mitigation_status"
+	// FieldAnalystVerdict holds the string denoting the analyst_verdict field in the database.
+	FieldAnalystVerdict = "analyst_verdict"
+	// FieldIncidentStatus holds the string denoting the incident_status field in the database.
+	FieldIncidentStatus = "incident_status"
+	// FieldInitiatedBy holds the string denoting the initiated_by field in the database.
+	FieldInitiatedBy = "initiated_by"
+	// FieldDetectionType holds the string denoting the detection_type field in the database.
+	FieldDetectionType = "detection_type"
+	// FieldFilePath holds the string denoting the file_path field in the database.
+	FieldFilePath = "file_path"
+	// FieldSha1 holds the string denoting the sha1 field in the database.
+	FieldSha1 = "sha1"
+	// FieldSha256 holds the string denoting the sha256 field in the database.
+	FieldSha256 = "sha256"
+	// FieldMd5 holds the string denoting the md5 field in the database.
+	FieldMd5 = "md5"
+	// FieldStoryline holds the string denoting the storyline field in the database.
+	FieldStoryline = "storyline"
+	// FieldIsFileless holds the string denoting the is_fileless field in the database.
+	FieldIsFileless = "is_fileless"
+	// FieldMitigatedPreemptively holds the string denoting the mitigated_preemptively field in the database.
+	FieldMitigatedPreemptively = "mitigated_preemptively"
+	// FieldIdentifiedAt holds the string denoting the identified_at field in the database.
+	FieldIdentifiedAt = "identified_at"
+	// FieldAPICreatedAt holds the string denoting the api_created_at field in the database.
+	FieldAPICreatedAt = "api_created_at"
+	// FieldAPIUpdatedAt holds the string denoting the api_updated_at field in the database.
+	FieldAPIUpdatedAt = "api_updated_at"
+	// FieldEnginesJSON holds the string denoting the engines_json field in the database.
+	FieldEnginesJSON = "engines_json"
+	// FieldIndicatorsJSON holds the string denoting the indicators_json field in the database.
+	FieldIndicatorsJSON = "indicators_json"
+	// FieldMitigationActionsJSON holds the string denoting the mitigation_actions_json field in the database.
+	FieldMitigationActionsJSON = "mitigation_actions_json"
+	// Table holds the table name of the bronzes1threat in the database.
+	Table = "s1_threats"
+)
+
+// Columns holds all SQL columns for bronzes1threat fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectedAt,
+	FieldFirstCollectedAt,
+	FieldAgentID,
+	FieldAgentUUID,
+	FieldAgentComputerName,
+	FieldAgentOsType,
+	FieldAccountID,
+	FieldSiteID,
+	FieldSiteName,
+	FieldGroupID,
+	FieldThreatName,
+	FieldClassification,
+	FieldClassificationSource,
+	FieldConfidenceLevel,
+	FieldMitigationStatus,
+	FieldAnalystVerdict,
+	FieldIncidentStatus,
+	FieldInitiatedBy,
+	FieldDetectionType,
+	FieldFilePath,
+	FieldSha1,
+	FieldSha256,
+	FieldMd5,
+	FieldStoryline,
+	FieldIsFileless,
+	FieldMitigatedPreemptively,
+	FieldIdentifiedAt,
+	FieldAPICreatedAt,
+	FieldAPIUpdatedAt,
+	FieldEnginesJSON,
+	FieldIndicatorsJSON,
+	FieldMitigationActionsJSON,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultIsFileless holds the default value on creation for the "is_fileless" field.
+	DefaultIsFileless bool
+	// DefaultMitigatedPreemptively holds the default value on creation for the "mitigated_preemptively" field.
+	DefaultMitigatedPreemptively bool
+)
+
+// OrderOption defines the ordering options for the BronzeS1Threat queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
+// ByFirstCollectedAt orders the results by the first_collected_at field.
+func ByFirstCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstCollectedAt, opts...).ToFunc()
+}
+
+// ByAgentID orders the results by the agent_id field.
+func ByAgentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgentID, opts...).ToFunc()
+}
+
+// ByAgentUUID orders the results by the agent_uuid field.
+func ByAgentUUID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgentUUID, opts...).ToFunc()
+}
+
+// ByAgentComputerName orders the results by the agent_computer_name field.
+func ByAgentComputerName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgentComputerName, opts...).ToFunc()
+}
+
+// ByAgentOsType orders the results by the agent_os_type field.
+func ByAgentOsType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgentOsType, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// BySiteID orders the results by the site_id field.
+func BySiteID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSiteID, opts...).ToFunc()
+}
+
+// BySiteName orders the results by the site_name field.
+func BySiteName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSiteName, opts...).ToFunc()
+}
+
+// ByGroupID orders the results by the group_id field.
+func ByGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupID, opts...).ToFunc()
+}
+
+// ByThreatName orders the results by the threat_name field.
+func ByThreatName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThreatName, opts...).ToFunc()
+}
+
+// ByClassification orders the results by the classification field.
+func ByClassification(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClassification, opts...).ToFunc()
+}
+
+// ByClassificationSource orders the results by the classification_source field.
+func ByClassificationSource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClassificationSource, opts...).ToFunc()
+}
+
+// ByConfidenceLevel orders the results by the confidence_level field.
+func ByConfidenceLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConfidenceLevel, opts...).ToFunc()
+}
+
+// ByMitigationStatus orders the results by the mitigation_status field.
+func ByMitigationStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMitigationStatus, opts...).ToFunc()
+}
+
+// ByAnalystVerdict orders the results by the analyst_verdict field.
+func ByAnalystVerdict(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnalystVerdict, opts...).ToFunc()
+}
+
+// ByIncidentStatus orders the results by the incident_status field.
+func ByIncidentStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncidentStatus, opts...).ToFunc()
+}
+
+// ByInitiatedBy orders the results by the initiated_by field.
+func ByInitiatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInitiatedBy, opts...).ToFunc()
+}
+
+// ByDetectionType orders the results by the detection_type field.
+func ByDetectionType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDetectionType, opts...).ToFunc()
+}
+
+// ByFilePath orders the results by the file_path field.
+func ByFilePath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFilePath, opts...).ToFunc()
+}
+
+// BySha1 orders the results by the sha1 field.
+func BySha1(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSha1, opts...).ToFunc()
+}
+
+// BySha256 orders the results by the sha256 field.
+func BySha256(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSha256, opts...).ToFunc()
+}
+
+// ByMd5 orders the results by the md5 field.
+func ByMd5(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMd5, opts...).ToFunc()
+}
+
+// ByStoryline orders the results by the storyline field.
+func ByStoryline(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoryline, opts...).ToFunc()
+}
+
+// ByIsFileless orders the results by the is_fileless field.
+func ByIsFileless(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsFileless, opts...).ToFunc()
+}
+
+// ByMitigatedPreemptively orders the results by the mitigated_preemptively field.
+func ByMitigatedPreemptively(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMitigatedPreemptively, opts...).ToFunc()
+}
+
+// ByIdentifiedAt orders the results by the identified_at field.
+func ByIdentifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdentifiedAt, opts...).ToFunc()
+}
+
+// ByAPICreatedAt orders the results by the api_created_at field.
+func ByAPICreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPICreatedAt, opts...).ToFunc()
+}
+
+// ByAPIUpdatedAt orders the results by the api_updated_at field.
+func ByAPIUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPIUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,2155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bronzes1threat
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldID, id))
+}
+
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// FirstCollectedAt applies equality check predicate on the "first_collected_at" field. It's identical to FirstCollectedAtEQ.
+func FirstCollectedAt(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// AgentID applies equality check predicate on the "agent_id" field. It's identical to AgentIDEQ.
+func AgentID(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldAgentID, v))
+}
+
+// AgentUUID applies equality check predicate on the "agent_uuid" field. It's identical to AgentUUIDEQ.
+func AgentUUID(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldAgentUUID, v))
+}
+
+// AgentComputerName applies equality check predicate on the "agent_computer_name" field. It's identical to AgentComputerNameEQ.
+func AgentComputerName(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldAgentComputerName, v))
+}
+
+// AgentOsType applies equality check predicate on the "agent_os_type" field. It's identical to AgentOsTypeEQ.
+func AgentOsType(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldAgentOsType, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldAccountID, v))
+}
+
+// SiteID applies equality check predicate on the "site_id" field. It's identical to SiteIDEQ.
+func SiteID(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldSiteID, v))
+}
+
+// SiteName applies equality check predicate on the "site_name" field. It's identical to SiteNameEQ.
+func SiteName(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldSiteName, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldGroupID, v))
+}
+
+// ThreatName applies equality check predicate on the "threat_name" field. It's identical to ThreatNameEQ.
+func ThreatName(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldThreatName, v))
+}
+
+// Classification applies equality check predicate on the "classification" field. It's identical to ClassificationEQ.
+func Classification(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldClassification, v))
+}
+
+// ClassificationSource applies equality check predicate on the "classification_source" field. It's identical to ClassificationSourceEQ.
+func ClassificationSource(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldClassificationSource, v))
+}
+
+// ConfidenceLevel applies equality check predicate on the "confidence_level" field. It's identical to ConfidenceLevelEQ.
+func ConfidenceLevel(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldConfidenceLevel, v))
+}
+
+// MitigationStatus applies equality check predicate on the "mitigation_status" field. It's identical to MitigationStatusEQ.
+func MitigationStatus(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldMitigationStatus, v))
+}
+
+// AnalystVerdict applies equality check predicate on the "analyst_verdict" field. It's identical to AnalystVerdictEQ.
+func AnalystVerdict(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldAnalystVerdict, v))
+}
+
+// IncidentStatus applies equality check predicate on the "incident_status" field. It's identical to IncidentStatusEQ.
+func IncidentStatus(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldIncidentStatus, v))
+}
+
+// InitiatedBy applies equality check predicate on the "initiated_by" field. It's identical to InitiatedByEQ.
+func InitiatedBy(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldInitiatedBy, v))
+}
+
+// DetectionType applies equality check predicate on the "detection_type" field. It's identical to DetectionTypeEQ.
+func DetectionType(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldDetectionType, v))
+}
+
+// FilePath applies equality check predicate on the "file_path" field. It's identical to FilePathEQ.
+func FilePath(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldFilePath, v))
+}
+
+// Sha1 applies equality check predicate on the "sha1" field. It's identical to Sha1EQ.
+func Sha1(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldSha1, v))
+}
+
+// Sha256 applies equality check predicate on the "sha256" field. It's identical to Sha256EQ.
+func Sha256(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldSha256, v))
+}
+
+// Md5 applies equality check predicate on the "md5" field. It's identical to Md5EQ.
+func Md5(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldMd5, v))
+}
+
+// Storyline applies equality check predicate on the "storyline" field. It's identical to StorylineEQ.
+func Storyline(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldStoryline, v))
+}
+
+// IsFileless applies equality check predicate on the "is_fileless" field. It's identical to IsFilelessEQ.
+func IsFileless(v bool) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldIsFileless, v))
+}
+
+// MitigatedPreemptively applies equality check predicate on the "mitigated_preemptively" field. It's identical to MitigatedPreemptivelyEQ.
+func MitigatedPreemptively(v bool) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldMitigatedPreemptively, v))
+}
+
+// IdentifiedAt applies equality check predicate on the "identified_at" field. It's identical to IdentifiedAtEQ.
+func IdentifiedAt(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldIdentifiedAt, v))
+}
+
+// APICreatedAt applies equality check predicate on the "api_created_at" field. It's identical to APICreatedAtEQ.
+func APICreatedAt(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// APIUpdatedAt applies equality check predicate on the "api_updated_at" field. It's identical to APIUpdatedAtEQ.
+func APIUpdatedAt(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldAPIUpdatedAt, v))
+}
+
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldCollectedAt, v))
+}
+
+// FirstCollectedAtEQ applies the EQ predicate on the "first_collected_at" field.
+func FirstCollectedAtEQ(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtNEQ applies the NEQ predicate on the "first_collected_at" field.
+func FirstCollectedAtNEQ(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtIn applies the In predicate on the "first_collected_at" field.
+func FirstCollectedAtIn(vs ...time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtNotIn applies the NotIn predicate on the "first_collected_at" field.
+func FirstCollectedAtNotIn(vs ...time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldFirstCollectedAt, vs...))
+}
+
+// FirstCollectedAtGT applies the GT predicate on the "first_collected_at" field.
+func FirstCollectedAtGT(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtGTE applies the GTE predicate on the "first_collected_at" field.
+func FirstCollectedAtGTE(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLT applies the LT predicate on the "first_collected_at" field.
+func FirstCollectedAtLT(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldFirstCollectedAt, v))
+}
+
+// FirstCollectedAtLTE applies the LTE predicate on the "first_collected_at" field.
+func FirstCollectedAtLTE(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldFirstCollectedAt, v))
+}
+
+// AgentIDEQ applies the EQ predicate on the "agent_id" field.
+func AgentIDEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldAgentID, v))
+}
+
+// AgentIDNEQ applies the NEQ predicate on the "agent_id" field.
+func AgentIDNEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldAgentID, v))
+}
+
+// AgentIDIn applies the In predicate on the "agent_id" field.
+func AgentIDIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldAgentID, vs...))
+}
+
+// AgentIDNotIn applies the NotIn predicate on the "agent_id" field.
+func AgentIDNotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldAgentID, vs...))
+}
+
+// AgentIDGT applies the GT predicate on the "agent_id" field.
+func AgentIDGT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldAgentID, v))
+}
+
+// AgentIDGTE applies the GTE predicate on the "agent_id" field.
+func AgentIDGTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldAgentID, v))
+}
+
+// AgentIDLT applies the LT predicate on the "agent_id" field.
+func AgentIDLT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldAgentID, v))
+}
+
+// AgentIDLTE applies the LTE predicate on the "agent_id" field.
+func AgentIDLTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldAgentID, v))
+}
+
+// AgentIDContains applies the Contains predicate on the "agent_id" field.
+func AgentIDContains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldAgentID, v))
+}
+
+// AgentIDHasPrefix applies the HasPrefix predicate on the "agent_id" field.
+func AgentIDHasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldAgentID, v))
+}
+
+// AgentIDHasSuffix applies the HasSuffix predicate on the "agent_id" field.
+func AgentIDHasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldAgentID, v))
+}
+
+// AgentIDIsNil applies the IsNil predicate on the "agent_id" field.
+func AgentIDIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldAgentID))
+}
+
+// AgentIDNotNil applies the NotNil predicate on the "agent_id" field.
+func AgentIDNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldAgentID))
+}
+
+// AgentIDEqualFold applies the EqualFold predicate on the "agent_id" field.
+func AgentIDEqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldAgentID, v))
+}
+
+// AgentIDContainsFold applies the ContainsFold predicate on the "agent_id" field.
+func AgentIDContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldAgentID, v))
+}
+
+// AgentUUIDEQ applies the EQ predicate on the "agent_uuid" field.
+func AgentUUIDEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldAgentUUID, v))
+}
+
+// AgentUUIDNEQ applies the NEQ predicate on the "agent_uuid" field.
+func AgentUUIDNEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldAgentUUID, v))
+}
+
+// AgentUUIDIn applies the In predicate on the "agent_uuid" field.
+func AgentUUIDIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldAgentUUID, vs...))
+}
+
+// AgentUUIDNotIn applies the NotIn predicate on the "agent_uuid" field.
+func AgentUUIDNotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldAgentUUID, vs...))
+}
+
+// AgentUUIDGT applies the GT predicate on the "agent_uuid" field.
+func AgentUUIDGT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldAgentUUID, v))
+}
+
+// AgentUUIDGTE applies the GTE predicate on the "agent_uuid" field.
+func AgentUUIDGTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldAgentUUID, v))
+}
+
+// AgentUUIDLT applies the LT predicate on the "agent_uuid" field.
+func AgentUUIDLT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldAgentUUID, v))
+}
+
+// AgentUUIDLTE applies the LTE predicate on the "agent_uuid" field.
+func AgentUUIDLTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldAgentUUID, v))
+}
+
+// AgentUUIDContains applies the Contains predicate on the "agent_uuid" field.
+func AgentUUIDContains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldAgentUUID, v))
+}
+
+// AgentUUIDHasPrefix applies the HasPrefix predicate on the "agent_uuid" field.
+func AgentUUIDHasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldAgentUUID, v))
+}
+
+// AgentUUIDHasSuffix applies the HasSuffix predicate on the "agent_uuid" field.
+func AgentUUIDHasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldAgentUUID, v))
+}
+
+// AgentUUIDIsNil applies the IsNil predicate on the "agent_uuid" field.
+func AgentUUIDIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldAgentUUID))
+}
+
+// AgentUUIDNotNil applies the NotNil predicate on the "agent_uuid" field.
+func AgentUUIDNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldAgentUUID))
+}
+
+// AgentUUIDEqualFold applies the EqualFold predicate on the "agent_uuid" field.
+func AgentUUIDEqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldAgentUUID, v))
+}
+
+// AgentUUIDContainsFold applies the ContainsFold predicate on the "agent_uuid" field.
+func AgentUUIDContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldAgentUUID, v))
+}
+
+// AgentComputerNameEQ applies the EQ predicate on the "agent_computer_name" field.
+func AgentComputerNameEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldAgentComputerName, v))
+}
+
+// AgentComputerNameNEQ applies the NEQ predicate on the "agent_computer_name" field.
+func AgentComputerNameNEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldAgentComputerName, v))
+}
+
+// AgentComputerNameIn applies the In predicate on the "agent_computer_name" field.
+func AgentComputerNameIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldAgentComputerName, vs...))
+}
+
+// AgentComputerNameNotIn applies the NotIn predicate on the "agent_computer_name" field.
+func AgentComputerNameNotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldAgentComputerName, vs...))
+}
+
+// AgentComputerNameGT applies the GT predicate on the "agent_computer_name" field.
+func AgentComputerNameGT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldAgentComputerName, v))
+}
+
+// AgentComputerNameGTE applies the GTE predicate on the "agent_computer_name" field.
+func AgentComputerNameGTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldAgentComputerName, v))
+}
+
+// AgentComputerNameLT applies the LT predicate on the "agent_computer_name" field.
+func AgentComputerNameLT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldAgentComputerName, v))
+}
+
+// AgentComputerNameLTE applies the LTE predicate on the "agent_computer_name" field.
+func AgentComputerNameLTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldAgentComputerName, v))
+}
+
+// AgentComputerNameContains applies the Contains predicate on the "agent_computer_name" field.
+func AgentComputerNameContains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldAgentComputerName, v))
+}
+
+// AgentComputerNameHasPrefix applies the HasPrefix predicate on the "agent_computer_name" field.
+func AgentComputerNameHasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldAgentComputerName, v))
+}
+
+// AgentComputerNameHasSuffix applies the HasSuffix predicate on the "agent_computer_name" field.
+func AgentComputerNameHasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldAgentComputerName, v))
+}
+
+// AgentComputerNameIsNil applies the IsNil predicate on the "agent_computer_name" field.
+func AgentComputerNameIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldAgentComputerName))
+}
+
+// AgentComputerNameNotNil applies the NotNil predicate on the "agent_computer_name" field.
+func AgentComputerNameNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldAgentComputerName))
+}
+
+// AgentComputerNameEqualFold applies the EqualFold predicate on the "agent_computer_name" field.
+func AgentComputerNameEqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldAgentComputerName, v))
+}
+
+// AgentComputerNameContainsFold applies the ContainsFold predicate on the "agent_computer_name" field.
+func AgentComputerNameContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldAgentComputerName, v))
+}
+
+// AgentOsTypeEQ applies the EQ predicate on the "agent_os_type" field.
+func AgentOsTypeEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldAgentOsType, v))
+}
+
+// AgentOsTypeNEQ applies the NEQ predicate on the "agent_os_type" field.
+func AgentOsTypeNEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldAgentOsType, v))
+}
+
+// AgentOsTypeIn applies the In predicate on the "agent_os_type" field.
+func AgentOsTypeIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldAgentOsType, vs...))
+}
+
+// AgentOsTypeNotIn applies the NotIn predicate on the "agent_os_type" field.
+func AgentOsTypeNotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldAgentOsType, vs...))
+}
+
+// AgentOsTypeGT applies the GT predicate on the "agent_os_type" field.
+func AgentOsTypeGT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldAgentOsType, v))
+}
+
+// AgentOsTypeGTE applies the GTE predicate on the "agent_os_type" field.
+func AgentOsTypeGTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldAgentOsType, v))
+}
+
+// AgentOsTypeLT applies the LT predicate on the "agent_os_type" field.
+func AgentOsTypeLT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldAgentOsType, v))
+}
+
+// AgentOsTypeLTE applies the LTE predicate on the "agent_os_type" field.
+func AgentOsTypeLTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldAgentOsType, v))
+}
+
+// AgentOsTypeContains applies the Contains predicate on the "agent_os_type" field.
+func AgentOsTypeContains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldAgentOsType, v))
+}
+
+// AgentOsTypeHasPrefix applies the HasPrefix predicate on the "agent_os_type" field.
+func AgentOsTypeHasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldAgentOsType, v))
+}
+
+// AgentOsTypeHasSuffix applies the HasSuffix predicate on the "agent_os_type" field.
+func AgentOsTypeHasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldAgentOsType, v))
+}
+
+// AgentOsTypeIsNil applies the IsNil predicate on the "agent_os_type" field.
+func AgentOsTypeIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldAgentOsType))
+}
+
+// AgentOsTypeNotNil applies the NotNil predicate on the "agent_os_type" field.
+func AgentOsTypeNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldAgentOsType))
+}
+
+// AgentOsTypeEqualFold applies the EqualFold predicate on the "agent_os_type" field.
+func AgentOsTypeEqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldAgentOsType, v))
+}
+
+// AgentOsTypeContainsFold applies the ContainsFold predicate on the "agent_os_type" field.
+func AgentOsTypeContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldAgentOsType, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDIsNil applies the IsNil predicate on the "account_id" field.
+func AccountIDIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldAccountID))
+}
+
+// AccountIDNotNil applies the NotNil predicate on the "account_id" field.
+func AccountIDNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldAccountID))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// SiteIDEQ applies the EQ predicate on the "site_id" field.
+func SiteIDEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldSiteID, v))
+}
+
+// SiteIDNEQ applies the NEQ predicate on the "site_id" field.
+func SiteIDNEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldSiteID, v))
+}
+
+// SiteIDIn applies the In predicate on the "site_id" field.
+func SiteIDIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldSiteID, vs...))
+}
+
+// SiteIDNotIn applies the NotIn predicate on the "site_id" field.
+func SiteIDNotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldSiteID, vs...))
+}
+
+// SiteIDGT applies the GT predicate on the "site_id" field.
+func SiteIDGT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldSiteID, v))
+}
+
+// SiteIDGTE applies the GTE predicate on the "site_id" field.
+func SiteIDGTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldSiteID, v))
+}
+
+// SiteIDLT applies the LT predicate on the "site_id" field.
+func SiteIDLT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldSiteID, v))
+}
+
+// SiteIDLTE applies the LTE predicate on the "site_id" field.
+func SiteIDLTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldSiteID, v))
+}
+
+// SiteIDContains applies the Contains predicate on the "site_id" field.
+func SiteIDContains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldSiteID, v))
+}
+
+// SiteIDHasPrefix applies the HasPrefix predicate on the "site_id" field.
+func SiteIDHasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldSiteID, v))
+}
+
+// SiteIDHasSuffix applies the HasSuffix predicate on the "site_id" field.
+func SiteIDHasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldSiteID, v))
+}
+
+// SiteIDIsNil applies the IsNil predicate on the "site_id" field.
+func SiteIDIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldSiteID))
+}
+
+// SiteIDNotNil applies the NotNil predicate on the "site_id" field.
+func SiteIDNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldSiteID))
+}
+
+// SiteIDEqualFold applies the EqualFold predicate on the "site_id" field.
+func SiteIDEqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldSiteID, v))
+}
+
+// SiteIDContainsFold applies the ContainsFold predicate on the "site_id" field.
+func SiteIDContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldSiteID, v))
+}
+
+// SiteNameEQ applies the EQ predicate on the "site_name" field.
+func SiteNameEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldSiteName, v))
+}
+
+// SiteNameNEQ applies the NEQ predicate on the "site_name" field.
+func SiteNameNEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldSiteName, v))
+}
+
+// SiteNameIn applies the In predicate on the "site_name" field.
+func SiteNameIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldSiteName, vs...))
+}
+
+// SiteNameNotIn applies the NotIn predicate on the "site_name" field.
+func SiteNameNotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldSiteName, vs...))
+}
+
+// SiteNameGT applies the GT predicate on the "site_name" field.
+func SiteNameGT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldSiteName, v))
+}
+
+// SiteNameGTE applies the GTE predicate on the "site_name" field.
+func SiteNameGTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldSiteName, v))
+}
+
+// SiteNameLT applies the LT predicate on the "site_name" field.
+func SiteNameLT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldSiteName, v))
+}
+
+// SiteNameLTE applies the LTE predicate on the "site_name" field.
+func SiteNameLTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldSiteName, v))
+}
+
+// SiteNameContains applies the Contains predicate on the "site_name" field.
+func SiteNameContains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldSiteName, v))
+}
+
+// SiteNameHasPrefix applies the HasPrefix predicate on the "site_name" field.
+func SiteNameHasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldSiteName, v))
+}
+
+// SiteNameHasSuffix applies the HasSuffix predicate on the "site_name" field.
+func SiteNameHasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldSiteName, v))
+}
+
+// SiteNameIsNil applies the IsNil predicate on the "site_name" field.
+func SiteNameIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldSiteName))
+}
+
+// SiteNameNotNil applies the NotNil predicate on the "site_name" field.
+func SiteNameNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldSiteName))
+}
+
+// SiteNameEqualFold applies the EqualFold predicate on the "site_name" field.
+func SiteNameEqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldSiteName, v))
+}
+
+// SiteNameContainsFold applies the ContainsFold predicate on the "site_name" field.
+func SiteNameContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldSiteName, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// GroupIDGT applies the GT predicate on the "group_id" field.
+func GroupIDGT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldGroupID, v))
+}
+
+// GroupIDGTE applies the GTE predicate on the "group_id" field.
+func GroupIDGTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldGroupID, v))
+}
+
+// GroupIDLT applies the LT predicate on the "group_id" field.
+func GroupIDLT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldGroupID, v))
+}
+
+// GroupIDLTE applies the LTE predicate on the "group_id" field.
+func GroupIDLTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldGroupID, v))
+}
+
+// GroupIDContains applies the Contains predicate on the "group_id" field.
+func GroupIDContains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldGroupID, v))
+}
+
+// GroupIDHasPrefix applies the HasPrefix predicate on the "group_id" field.
+func GroupIDHasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldGroupID, v))
+}
+
+// GroupIDHasSuffix applies the HasSuffix predicate on the "group_id" field.
+func GroupIDHasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldGroupID, v))
+}
+
+// GroupIDIsNil applies the IsNil predicate on the "group_id" field.
+func GroupIDIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldGroupID))
+}
+
+// GroupIDNotNil applies the NotNil predicate on the "group_id" field.
+func GroupIDNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldGroupID))
+}
+
+// GroupIDEqualFold applies the EqualFold predicate on the "group_id" field.
+func GroupIDEqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldGroupID, v))
+}
+
+// GroupIDContainsFold applies the ContainsFold predicate on the "group_id" field.
+func GroupIDContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldGroupID, v))
+}
+
+// ThreatNameEQ applies the EQ predicate on the "threat_name" field.
+func ThreatNameEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldThreatName, v))
+}
+
+// ThreatNameNEQ applies the NEQ predicate on the "threat_name" field.
+func ThreatNameNEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldThreatName, v))
+}
+
+// ThreatNameIn applies the In predicate on the "threat_name" field.
+func ThreatNameIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldThreatName, vs...))
+}
+
+// ThreatNameNotIn applies the NotIn predicate on the "threat_name" field.
+func ThreatNameNotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldThreatName, vs...))
+}
+
+// ThreatNameGT applies the GT predicate on the "threat_name" field.
+func ThreatNameGT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldThreatName, v))
+}
+
+// ThreatNameGTE applies the GTE predicate on the "threat_name" field.
+func ThreatNameGTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldThreatName, v))
+}
+
+// ThreatNameLT applies the LT predicate on the "threat_name" field.
+func ThreatNameLT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldThreatName, v))
+}
+
+// ThreatNameLTE applies the LTE predicate on the "threat_name" field.
+func ThreatNameLTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldThreatName, v))
+}
+
+// ThreatNameContains applies the Contains predicate on the "threat_name" field.
+func ThreatNameContains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldThreatName, v))
+}
+
+// ThreatNameHasPrefix applies the HasPrefix predicate on the "threat_name" field.
+func ThreatNameHasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldThreatName, v))
+}
+
+// ThreatNameHasSuffix applies the HasSuffix predicate on the "threat_name" field.
+func ThreatNameHasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldThreatName, v))
+}
+
+// ThreatNameIsNil applies the IsNil predicate on the "threat_name" field.
+func ThreatNameIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldThreatName))
+}
+
+// ThreatNameNotNil applies the NotNil predicate on the "threat_name" field.
+func ThreatNameNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldThreatName))
+}
+
+// ThreatNameEqualFold applies the EqualFold predicate on the "threat_name" field.
+func ThreatNameEqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldThreatName, v))
+}
+
+// ThreatNameContainsFold applies the ContainsFold predicate on the "threat_name" field.
+func ThreatNameContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldThreatName, v))
+}
+
+// ClassificationEQ applies the EQ predicate on the "classification" field.
+func ClassificationEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldClassification, v))
+}
+
+// ClassificationNEQ applies the NEQ predicate on the "classification" field.
+func ClassificationNEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldClassification, v))
+}
+
+// ClassificationIn applies the In predicate on the "classification" field.
+func ClassificationIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldClassification, vs...))
+}
+
+// ClassificationNotIn applies the NotIn predicate on the "classification" field.
+func ClassificationNotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldClassification, vs...))
+}
+
+// ClassificationGT applies the GT predicate on the "classification" field.
+func ClassificationGT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldClassification, v))
+}
+
+// ClassificationGTE applies the GTE predicate on the "classification" field.
+func ClassificationGTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldClassification, v))
+}
+
+// ClassificationLT applies the LT predicate on the "classification" field.
+func ClassificationLT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldClassification, v))
+}
+
+// ClassificationLTE applies the LTE predicate on the "classification" field.
+func ClassificationLTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldClassification, v))
+}
+
+// ClassificationContains applies the Contains predicate on the "classification" field.
+func ClassificationContains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldClassification, v))
+}
+
+// ClassificationHasPrefix applies the HasPrefix predicate on the "classification" field.
+func ClassificationHasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldClassification, v))
+}
+
+// ClassificationHasSuffix applies the HasSuffix predicate on the "classification" field.
+func ClassificationHasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldClassification, v))
+}
+
+// ClassificationIsNil applies the IsNil predicate on the "classification" field.
+func ClassificationIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldClassification))
+}
+
+// ClassificationNotNil applies the NotNil predicate on the "classification" field.
+func ClassificationNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldClassification))
+}
+
+// ClassificationEqualFold applies the EqualFold predicate on the "classification" field.
+func ClassificationEqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldClassification, v))
+}
+
+// ClassificationContainsFold applies the ContainsFold predicate on the "classification" field.
+func ClassificationContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldClassification, v))
+}
+
+// ClassificationSourceEQ applies the EQ predicate on the "classification_source" field.
+func ClassificationSourceEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldClassificationSource, v))
+}
+
+// ClassificationSourceNEQ applies the NEQ predicate on the "classification_source" field.
+func ClassificationSourceNEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldClassificationSource, v))
+}
+
+// ClassificationSourceIn applies the In predicate on the "classification_source" field.
+func ClassificationSourceIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldClassificationSource, vs...))
+}
+
+// ClassificationSourceNotIn applies the NotIn predicate on the "classification_source" field.
+func ClassificationSourceNotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldClassificationSource, vs...))
+}
+
+// ClassificationSourceGT applies the GT predicate on the "classification_source" field.
+func ClassificationSourceGT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldClassificationSource, v))
+}
+
+// ClassificationSourceGTE applies the GTE predicate on the "classification_source" field.
+func ClassificationSourceGTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldClassificationSource, v))
+}
+
+// ClassificationSourceLT applies the LT predicate on the "classification_source" field.
+func ClassificationSourceLT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldClassificationSource, v))
+}
+
+// ClassificationSourceLTE applies the LTE predicate on the "classification_source" field.
+func ClassificationSourceLTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldClassificationSource, v))
+}
+
+// ClassificationSourceContains applies the Contains predicate on the "classification_source" field.
+func ClassificationSourceContains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldClassificationSource, v))
+}
+
+// ClassificationSourceHasPrefix applies the HasPrefix predicate on the "classification_source" field.
+func ClassificationSourceHasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldClassificationSource, v))
+}
+
+// ClassificationSourceHasSuffix applies the HasSuffix predicate on the "classification_source" field.
+func ClassificationSourceHasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldClassificationSource, v))
+}
+
+// ClassificationSourceIsNil applies the IsNil predicate on the "classification_source" field.
+func ClassificationSourceIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldClassificationSource))
+}
+
+// ClassificationSourceNotNil applies the NotNil predicate on the "classification_source" field.
+func ClassificationSourceNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldClassificationSource))
+}
+
+// ClassificationSourceEqualFold applies the EqualFold predicate on the "classification_source" field.
+func ClassificationSourceEqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldClassificationSource, v))
+}
+
+// ClassificationSourceContainsFold applies the ContainsFold predicate on the "classification_source" field.
+func ClassificationSourceContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldClassificationSource, v))
+}
+
+// ConfidenceLevelEQ applies the EQ predicate on the "confidence_level" field.
+func ConfidenceLevelEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldConfidenceLevel, v))
+}
+
+// ConfidenceLevelNEQ applies the NEQ predicate on the "confidence_level" field.
+func ConfidenceLevelNEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldConfidenceLevel, v))
+}
+
+// ConfidenceLevelIn applies the In predicate on the "confidence_level" field.
+func ConfidenceLevelIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldConfidenceLevel, vs...))
+}
+
+// ConfidenceLevelNotIn applies the NotIn predicate on the "confidence_level" field.
+func ConfidenceLevelNotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldConfidenceLevel, vs...))
+}
+
+// ConfidenceLevelGT applies the GT predicate on the "confidence_level" field.
+func ConfidenceLevelGT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldConfidenceLevel, v))
+}
+
+// ConfidenceLevelGTE applies the GTE predicate on the "confidence_level" field.
+func ConfidenceLevelGTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldConfidenceLevel, v))
+}
+
+// ConfidenceLevelLT applies the LT predicate on the "confidence_level" field.
+func ConfidenceLevelLT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldConfidenceLevel, v))
+}
+
+// ConfidenceLevelLTE applies the LTE predicate on the "confidence_level" field.
+func ConfidenceLevelLTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldConfidenceLevel, v))
+}
+
+// ConfidenceLevelContains applies the Contains predicate on the "confidence_level" field.
+func ConfidenceLevelContains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldConfidenceLevel, v))
+}
+
+// ConfidenceLevelHasPrefix applies the HasPrefix predicate on the "confidence_level" field.
+func ConfidenceLevelHasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldConfidenceLevel, v))
+}
+
+// ConfidenceLevelHasSuffix applies the HasSuffix predicate on the "confidence_level" field.
+func ConfidenceLevelHasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldConfidenceLevel, v))
+}
+
+// ConfidenceLevelIsNil applies the IsNil predicate on the "confidence_level" field.
+func ConfidenceLevelIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldConfidenceLevel))
+}
+
+// ConfidenceLevelNotNil applies the NotNil predicate on the "confidence_level" field.
+func ConfidenceLevelNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldConfidenceLevel))
+}
+
+// ConfidenceLevelEqualFold applies the EqualFold predicate on the "confidence_level" field.
+func ConfidenceLevelEqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldConfidenceLevel, v))
+}
+
+// ConfidenceLevelContainsFold applies the ContainsFold predicate on the "confidence_level" field.
+func ConfidenceLevelContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldConfidenceLevel, v))
+}
+
+// MitigationStatusEQ applies the EQ predicate on the "mitigation_status" field.
+func MitigationStatusEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldMitigationStatus, v))
+}
+
+// MitigationStatusNEQ applies the NEQ predicate on the "mitigation_status" field.
+func MitigationStatusNEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldMitigationStatus, v))
+}
+
+// MitigationStatusIn applies the In predicate on the "mitigation_status" field.
+func MitigationStatusIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldMitigationStatus, vs...))
+}
+
+// MitigationStatusNotIn applies the NotIn predicate on the "mitigation_status" field.
+func MitigationStatusNotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldMitigationStatus, vs...))
+}
+
+// MitigationStatusGT applies the GT predicate on the "mitigation_status" field.
+func MitigationStatusGT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldMitigationStatus, v))
+}
+
+// MitigationStatusGTE applies the GTE predicate on the "mitigation_status" field.
+func MitigationStatusGTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldMitigationStatus, v))
+}
+
+// MitigationStatusLT applies the LT predicate on the "mitigation_status" field.
+func MitigationStatusLT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldMitigationStatus, v))
+}
+
+// MitigationStatusLTE applies the LTE predicate on the "mitigation_status" field.
+func MitigationStatusLTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldMitigationStatus, v))
+}
+
+// MitigationStatusContains applies the Contains predicate on the "mitigation_status" field.
+func MitigationStatusContains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldMitigationStatus, v))
+}
+
+// MitigationStatusHasPrefix applies the HasPrefix predicate on the "mitigation_status" field.
+func MitigationStatusHasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldMitigationStatus, v))
+}
+
+// MitigationStatusHasSuffix applies the HasSuffix predicate on the "mitigation_status" field.
+func MitigationStatusHasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldMitigationStatus, v))
+}
+
+// MitigationStatusIsNil applies the IsNil predicate on the "mitigation_status" field.
+func MitigationStatusIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldMitigationStatus))
+}
+
+// MitigationStatusNotNil applies the NotNil predicate on the "mitigation_status" field.
+func MitigationStatusNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldMitigationStatus))
+}
+
+// MitigationStatusEqualFold applies the EqualFold predicate on the "mitigation_status" field.
+func MitigationStatusEqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldMitigationStatus, v))
+}
+
+// MitigationStatusContainsFold applies the ContainsFold predicate on the "mitigation_status" field.
+func MitigationStatusContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldMitigationStatus, v))
+}
+
+// AnalystVerdictEQ applies the EQ predicate on the "analyst_verdict" field.
+func AnalystVerdictEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictNEQ applies the NEQ predicate on the "analyst_verdict" field.
+func AnalystVerdictNEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictIn applies the In predicate on the "analyst_verdict" field.
+func AnalystVerdictIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldAnalystVerdict, vs...))
+}
+
+// AnalystVerdictNotIn applies the NotIn predicate on the "analyst_verdict" field.
+func AnalystVerdictNotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldAnalystVerdict, vs...))
+}
+
+// AnalystVerdictGT applies the GT predicate on the "analyst_verdict" field.
+func AnalystVerdictGT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictGTE applies the GTE predicate on the "analyst_verdict" field.
+func AnalystVerdictGTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictLT applies the LT predicate on the "analyst_verdict" field.
+func AnalystVerdictLT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictLTE applies the LTE predicate on the "analyst_verdict" field.
+func AnalystVerdictLTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictContains applies the Contains predicate on the "analyst_verdict" field.
+func AnalystVerdictContains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictHasPrefix applies the HasPrefix predicate on the "analyst_verdict" field.
+func AnalystVerdictHasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictHasSuffix applies the HasSuffix predicate on the "analyst_verdict" field.
+func AnalystVerdictHasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictIsNil applies the IsNil predicate on the "analyst_verdict" field.
+func AnalystVerdictIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldAnalystVerdict))
+}
+
+// AnalystVerdictNotNil applies the NotNil predicate on the "analyst_verdict" field.
+func AnalystVerdictNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldAnalystVerdict))
+}
+
+// AnalystVerdictEqualFold applies the EqualFold predicate on the "analyst_verdict" field.
+func AnalystVerdictEqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldAnalystVerdict, v))
+}
+
+// AnalystVerdictContainsFold applies the ContainsFold predicate on the "analyst_verdict" field.
+func AnalystVerdictContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldAnalystVerdict, v))
+}
+
+// IncidentStatusEQ applies the EQ predicate on the "incident_status" field.
+func IncidentStatusEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldIncidentStatus, v))
+}
+
+// IncidentStatusNEQ applies the NEQ predicate on the "incident_status" field.
+func IncidentStatusNEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldIncidentStatus, v))
+}
+
+// IncidentStatusIn applies the In predicate on the "incident_status" field.
+func IncidentStatusIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldIncidentStatus, vs...))
+}
+
+// IncidentStatusNotIn applies the NotIn predicate on the "incident_status" field.
+func IncidentStatusNotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldIncidentStatus, vs...))
+}
+
+// IncidentStatusGT applies the GT predicate on the "incident_status" field.
+func IncidentStatusGT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldIncidentStatus, v))
+}
+
+// IncidentStatusGTE applies the GTE predicate on the "incident_status" field.
+func IncidentStatusGTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldIncidentStatus, v))
+}
+
+// IncidentStatusLT applies the LT predicate on the "incident_status" field.
+func IncidentStatusLT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldIncidentStatus, v))
+}
+
+// IncidentStatusLTE applies the LTE predicate on the "incident_status" field.
+func IncidentStatusLTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldIncidentStatus, v))
+}
+
+// IncidentStatusContains applies the Contains predicate on the "incident_status" field.
+func IncidentStatusContains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldIncidentStatus, v))
+}
+
+// IncidentStatusHasPrefix applies the HasPrefix predicate on the "incident_status" field.
+func IncidentStatusHasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldIncidentStatus, v))
+}
+
+// IncidentStatusHasSuffix applies the HasSuffix predicate on the "incident_status" field.
+func IncidentStatusHasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldIncidentStatus, v))
+}
+
+// IncidentStatusIsNil applies the IsNil predicate on the "incident_status" field.
+func IncidentStatusIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldIncidentStatus))
+}
+
+// IncidentStatusNotNil applies the NotNil predicate on the "incident_status" field.
+func IncidentStatusNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldIncidentStatus))
+}
+
+// IncidentStatusEqualFold applies the EqualFold predicate on the "incident_status" field.
+func IncidentStatusEqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldIncidentStatus, v))
+}
+
+// IncidentStatusContainsFold applies the ContainsFold predicate on the "incident_status" field.
+func IncidentStatusContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldIncidentStatus, v))
+}
+
+// InitiatedByEQ applies the EQ predicate on the "initiated_by" field.
+func InitiatedByEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldInitiatedBy, v))
+}
+
+// InitiatedByNEQ applies the NEQ predicate on the "initiated_by" field.
+func InitiatedByNEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldInitiatedBy, v))
+}
+
+// InitiatedByIn applies the In predicate on the "initiated_by" field.
+func InitiatedByIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldInitiatedBy, vs...))
+}
+
+// InitiatedByNotIn applies the NotIn predicate on the "initiated_by" field.
+func InitiatedByNotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldInitiatedBy, vs...))
+}
+
+// InitiatedByGT applies the GT predicate on the "initiated_by" field.
+func InitiatedByGT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldInitiatedBy, v))
+}
+
+// InitiatedByGTE applies the GTE predicate on the "initiated_by" field.
+func InitiatedByGTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldInitiatedBy, v))
+}
+
+// InitiatedByLT applies the LT predicate on the "initiated_by" field.
+func InitiatedByLT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldInitiatedBy, v))
+}
+
+// InitiatedByLTE applies the LTE predicate on the "initiated_by" field.
+func InitiatedByLTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldInitiatedBy, v))
+}
+
+// InitiatedByContains applies the Contains predicate on the "initiated_by" field.
+func InitiatedByContains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldInitiatedBy, v))
+}
+
+// InitiatedByHasPrefix applies the HasPrefix predicate on the "initiated_by" field.
+func InitiatedByHasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldInitiatedBy, v))
+}
+
+// InitiatedByHasSuffix applies the HasSuffix predicate on the "initiated_by" field.
+func InitiatedByHasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldInitiatedBy, v))
+}
+
+// InitiatedByIsNil applies the IsNil predicate on the "initiated_by" field.
+func InitiatedByIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldInitiatedBy))
+}
+
+// InitiatedByNotNil applies the NotNil predicate on the "initiated_by" field.
+func InitiatedByNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldInitiatedBy))
+}
+
+// InitiatedByEqualFold applies the EqualFold predicate on the "initiated_by" field.
+func InitiatedByEqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldInitiatedBy, v))
+}
+
+// InitiatedByContainsFold applies the ContainsFold predicate on the "initiated_by" field.
+func InitiatedByContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldInitiatedBy, v))
+}
+
+// DetectionTypeEQ applies the EQ predicate on the "detection_type" field.
+func DetectionTypeEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldDetectionType, v))
+}
+
+// DetectionTypeNEQ applies the NEQ predicate on the "detection_type" field.
+func DetectionTypeNEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldDetectionType, v))
+}
+
+// DetectionTypeIn applies the In predicate on the "detection_type" field.
+func DetectionTypeIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldDetectionType, vs...))
+}
+
+// DetectionTypeNotIn applies the NotIn predicate on the "detection_type" field.
+func DetectionTypeNotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldDetectionType, vs...))
+}
+
+// DetectionTypeGT applies the GT predicate on the "detection_type" field.
+func DetectionTypeGT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldDetectionType, v))
+}
+
+// DetectionTypeGTE applies the GTE predicate on the "detection_type" field.
+func DetectionTypeGTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldDetectionType, v))
+}
+
+// DetectionTypeLT applies the LT predicate on the "detection_type" field.
+func DetectionTypeLT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldDetectionType, v))
+}
+
+// DetectionTypeLTE applies the LTE predicate on the "detection_type" field.
+func DetectionTypeLTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldDetectionType, v))
+}
+
+// DetectionTypeContains applies the Contains predicate on the "detection_type" field.
+func DetectionTypeContains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldDetectionType, v))
+}
+
+// DetectionTypeHasPrefix applies the HasPrefix predicate on the "detection_type" field.
+func DetectionTypeHasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldDetectionType, v))
+}
+
+// DetectionTypeHasSuffix applies the HasSuffix predicate on the "detection_type" field.
+func DetectionTypeHasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldDetectionType, v))
+}
+
+// DetectionTypeIsNil applies the IsNil predicate on the "detection_type" field.
+func DetectionTypeIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldDetectionType))
+}
+
+// DetectionTypeNotNil applies the NotNil predicate on the "detection_type" field.
+func DetectionTypeNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldDetectionType))
+}
+
+// DetectionTypeEqualFold applies the EqualFold predicate on the "detection_type" field.
+func DetectionTypeEqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldDetectionType, v))
+}
+
+// DetectionTypeContainsFold applies the ContainsFold predicate on the "detection_type" field.
+func DetectionTypeContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldDetectionType, v))
+}
+
+// FilePathEQ applies the EQ predicate on the "file_path" field.
+func FilePathEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldFilePath, v))
+}
+
+// FilePathNEQ applies the NEQ predicate on the "file_path" field.
+func FilePathNEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldFilePath, v))
+}
+
+// FilePathIn applies the In predicate on the "file_path" field.
+func FilePathIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldFilePath, vs...))
+}
+
+// FilePathNotIn applies the NotIn predicate on the "file_path" field.
+func FilePathNotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldFilePath, vs...))
+}
+
+// FilePathGT applies the GT predicate on the "file_path" field.
+func FilePathGT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldFilePath, v))
+}
+
+// FilePathGTE applies the GTE predicate on the "file_path" field.
+func FilePathGTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldFilePath, v))
+}
+
+// FilePathLT applies the LT predicate on the "file_path" field.
+func FilePathLT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldFilePath, v))
+}
+
+// FilePathLTE applies the LTE predicate on the "file_path" field.
+func FilePathLTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldFilePath, v))
+}
+
+// FilePathContains applies the Contains predicate on the "file_path" field.
+func FilePathContains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldFilePath, v))
+}
+
+// FilePathHasPrefix applies the HasPrefix predicate on the "file_path" field.
+func FilePathHasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldFilePath, v))
+}
+
+// FilePathHasSuffix applies the HasSuffix predicate on the "file_path" field.
+func FilePathHasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldFilePath, v))
+}
+
+// FilePathIsNil applies the IsNil predicate on the "file_path" field.
+func FilePathIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldFilePath))
+}
+
+// FilePathNotNil applies the NotNil predicate on the "file_path" field.
+func FilePathNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldFilePath))
+}
+
+// FilePathEqualFold applies the EqualFold predicate on the "file_path" field.
+func FilePathEqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldFilePath, v))
+}
+
+// FilePathContainsFold applies the ContainsFold predicate on the "file_path" field.
+func FilePathContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldFilePath, v))
+}
+
+// Sha1EQ applies the EQ predicate on the "sha1" field.
+func Sha1EQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldSha1, v))
+}
+
+// Sha1NEQ applies the NEQ predicate on the "sha1" field.
+func Sha1NEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldSha1, v))
+}
+
+// Sha1In applies the In predicate on the "sha1" field.
+func Sha1In(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldSha1, vs...))
+}
+
+// Sha1NotIn applies the NotIn predicate on the "sha1" field.
+func Sha1NotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldSha1, vs...))
+}
+
+// Sha1GT applies the GT predicate on the "sha1" field.
+func Sha1GT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldSha1, v))
+}
+
+// Sha1GTE applies the GTE predicate on the "sha1" field.
+func Sha1GTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldSha1, v))
+}
+
+// Sha1LT applies the LT predicate on the "sha1" field.
+func Sha1LT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldSha1, v))
+}
+
+// Sha1LTE applies the LTE predicate on the "sha1" field.
+func Sha1LTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldSha1, v))
+}
+
+// Sha1Contains applies the Contains predicate on the "sha1" field.
+func Sha1Contains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldSha1, v))
+}
+
+// Sha1HasPrefix applies the HasPrefix predicate on the "sha1" field.
+func Sha1HasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldSha1, v))
+}
+
+// Sha1HasSuffix applies the HasSuffix predicate on the "sha1" field.
+func Sha1HasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldSha1, v))
+}
+
+// Sha1IsNil applies the IsNil predicate on the "sha1" field.
+func Sha1IsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldSha1))
+}
+
+// Sha1NotNil applies the NotNil predicate on the "sha1" field.
+func Sha1NotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldSha1))
+}
+
+// Sha1EqualFold applies the EqualFold predicate on the "sha1" field.
+func Sha1EqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldSha1, v))
+}
+
+// Sha1ContainsFold applies the ContainsFold predicate on the "sha1" field.
+func Sha1ContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldSha1, v))
+}
+
+// Sha256EQ applies the EQ predicate on the "sha256" field.
+func Sha256EQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldSha256, v))
+}
+
+// Sha256NEQ applies the NEQ predicate on the "sha256" field.
+func Sha256NEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldSha256, v))
+}
+
+// Sha256In applies the In predicate on the "sha256" field.
+func Sha256In(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldSha256, vs...))
+}
+
+// Sha256NotIn applies the NotIn predicate on the "sha256" field.
+func Sha256NotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldSha256, vs...))
+}
+
+// Sha256GT applies the GT predicate on the "sha256" field.
+func Sha256GT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldSha256, v))
+}
+
+// Sha256GTE applies the GTE predicate on the "sha256" field.
+func Sha256GTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldSha256, v))
+}
+
+// Sha256LT applies the LT predicate on the "sha256" field.
+func Sha256LT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldSha256, v))
+}
+
+// Sha256LTE applies the LTE predicate on the "sha256" field.
+func Sha256LTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldSha256, v))
+}
+
+// Sha256Contains applies the Contains predicate on the "sha256" field.
+func Sha256Contains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldSha256, v))
+}
+
+// Sha256HasPrefix applies the HasPrefix predicate on the "sha256" field.
+func Sha256HasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldSha256, v))
+}
+
+// Sha256HasSuffix applies the HasSuffix predicate on the "sha256" field.
+func Sha256HasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldSha256, v))
+}
+
+// Sha256IsNil applies the IsNil predicate on the "sha256" field.
+func Sha256IsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldSha256))
+}
+
+// Sha256NotNil applies the NotNil predicate on the "sha256" field.
+func Sha256NotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldSha256))
+}
+
+// Sha256EqualFold applies the EqualFold predicate on the "sha256" field.
+func Sha256EqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldSha256, v))
+}
+
+// Sha256ContainsFold applies the ContainsFold predicate on the "sha256" field.
+func Sha256ContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldSha256, v))
+}
+
+// Md5EQ applies the EQ predicate on the "md5" field.
+func Md5EQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldMd5, v))
+}
+
+// Md5NEQ applies the NEQ predicate on the "md5" field.
+func Md5NEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldMd5, v))
+}
+
+// Md5In applies the In predicate on the "md5" field.
+func Md5In(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldMd5, vs...))
+}
+
+// Md5NotIn applies the NotIn predicate on the "md5" field.
+func Md5NotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldMd5, vs...))
+}
+
+// Md5GT applies the GT predicate on the "md5" field.
+func Md5GT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldMd5, v))
+}
+
+// Md5GTE applies the GTE predicate on the "md5" field.
+func Md5GTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldMd5, v))
+}
+
+// Md5LT applies the LT predicate on the "md5" field.
+func Md5LT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldMd5, v))
+}
+
+// Md5LTE applies the LTE predicate on the "md5" field.
+func Md5LTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldMd5, v))
+}
+
+// Md5Contains applies the Contains predicate on the "md5" field.
+func Md5Contains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldMd5, v))
+}
+
+// Md5HasPrefix applies the HasPrefix predicate on the "md5" field.
+func Md5HasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldMd5, v))
+}
+
+// Md5HasSuffix applies the HasSuffix predicate on the "md5" field.
+func Md5HasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldMd5, v))
+}
+
+// Md5IsNil applies the IsNil predicate on the "md5" field.
+func Md5IsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldMd5))
+}
+
+// Md5NotNil applies the NotNil predicate on the "md5" field.
+func Md5NotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldMd5))
+}
+
+// Md5EqualFold applies the EqualFold predicate on the "md5" field.
+func Md5EqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldMd5, v))
+}
+
+// Md5ContainsFold applies the ContainsFold predicate on the "md5" field.
+func Md5ContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldMd5, v))
+}
+
+// StorylineEQ applies the EQ predicate on the "storyline" field.
+func StorylineEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldStoryline, v))
+}
+
+// StorylineNEQ applies the NEQ predicate on the "storyline" field.
+func StorylineNEQ(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldStoryline, v))
+}
+
+// StorylineIn applies the In predicate on the "storyline" field.
+func StorylineIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldStoryline, vs...))
+}
+
+// StorylineNotIn applies the NotIn predicate on the "storyline" field.
+func StorylineNotIn(vs ...string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldStoryline, vs...))
+}
+
+// StorylineGT applies the GT predicate on the "storyline" field.
+func StorylineGT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldStoryline, v))
+}
+
+// StorylineGTE applies the GTE predicate on the "storyline" field.
+func StorylineGTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldStoryline, v))
+}
+
+// StorylineLT applies the LT predicate on the "storyline" field.
+func StorylineLT(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldStoryline, v))
+}
+
+// StorylineLTE applies the LTE predicate on the "storyline" field.
+func StorylineLTE(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldStoryline, v))
+}
+
+// StorylineContains applies the Contains predicate on the "storyline" field.
+func StorylineContains(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContains(FieldStoryline, v))
+}
+
+// StorylineHasPrefix applies the HasPrefix predicate on the "storyline" field.
+func StorylineHasPrefix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasPrefix(FieldStoryline, v))
+}
+
+// StorylineHasSuffix applies the HasSuffix predicate on the "storyline" field.
+func StorylineHasSuffix(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldHasSuffix(FieldStoryline, v))
+}
+
+// StorylineIsNil applies the IsNil predicate on the "storyline" field.
+func StorylineIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldStoryline))
+}
+
+// StorylineNotNil applies the NotNil predicate on the "storyline" field.
+func StorylineNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldStoryline))
+}
+
+// StorylineEqualFold applies the EqualFold predicate on the "storyline" field.
+func StorylineEqualFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEqualFold(FieldStoryline, v))
+}
+
+// StorylineContainsFold applies the ContainsFold predicate on the "storyline" field.
+func StorylineContainsFold(v string) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldContainsFold(FieldStoryline, v))
+}
+
+// IsFilelessEQ applies the EQ predicate on the "is_fileless" field.
+func IsFilelessEQ(v bool) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldIsFileless, v))
+}
+
+// IsFilelessNEQ applies the NEQ predicate on the "is_fileless" field.
+func IsFilelessNEQ(v bool) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldIsFileless, v))
+}
+
+// MitigatedPreemptivelyEQ applies the EQ predicate on the "mitigated_preemptively" field.
+func MitigatedPreemptivelyEQ(v bool) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldMitigatedPreemptively, v))
+}
+
+// MitigatedPreemptivelyNEQ applies the NEQ predicate on the "mitigated_preemptively" field.
+func MitigatedPreemptivelyNEQ(v bool) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldMitigatedPreemptively, v))
+}
+
+// IdentifiedAtEQ applies the EQ predicate on the "identified_at" field.
+func IdentifiedAtEQ(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldIdentifiedAt, v))
+}
+
+// IdentifiedAtNEQ applies the NEQ predicate on the "identified_at" field.
+func IdentifiedAtNEQ(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldIdentifiedAt, v))
+}
+
+// IdentifiedAtIn applies the In predicate on the "identified_at" field.
+func IdentifiedAtIn(vs ...time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldIdentifiedAt, vs...))
+}
+
+// IdentifiedAtNotIn applies the NotIn predicate on the "identified_at" field.
+func IdentifiedAtNotIn(vs ...time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldIdentifiedAt, vs...))
+}
+
+// IdentifiedAtGT applies the GT predicate on the "identified_at" field.
+func IdentifiedAtGT(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldIdentifiedAt, v))
+}
+
+// IdentifiedAtGTE applies the GTE predicate on the "identified_at" field.
+func IdentifiedAtGTE(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldIdentifiedAt, v))
+}
+
+// IdentifiedAtLT applies the LT predicate on the "identified_at" field.
+func IdentifiedAtLT(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldIdentifiedAt, v))
+}
+
+// IdentifiedAtLTE applies the LTE predicate on the "identified_at" field.
+func IdentifiedAtLTE(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldIdentifiedAt, v))
+}
+
+// IdentifiedAtIsNil applies the IsNil predicate on the "identified_at" field.
+func IdentifiedAtIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldIdentifiedAt))
+}
+
+// IdentifiedAtNotNil applies the NotNil predicate on the "identified_at" field.
+func IdentifiedAtNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldIdentifiedAt))
+}
+
+// APICreatedAtEQ applies the EQ predicate on the "api_created_at" field.
+func APICreatedAtEQ(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtNEQ applies the NEQ predicate on the "api_created_at" field.
+func APICreatedAtNEQ(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtIn applies the In predicate on the "api_created_at" field.
+func APICreatedAtIn(vs ...time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtNotIn applies the NotIn predicate on the "api_created_at" field.
+func APICreatedAtNotIn(vs ...time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldAPICreatedAt, vs...))
+}
+
+// APICreatedAtGT applies the GT predicate on the "api_created_at" field.
+func APICreatedAtGT(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtGTE applies the GTE predicate on the "api_created_at" field.
+func APICreatedAtGTE(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLT applies the LT predicate on the "api_created_at" field.
+func APICreatedAtLT(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtLTE applies the LTE predicate on the "api_created_at" field.
+func APICreatedAtLTE(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldAPICreatedAt, v))
+}
+
+// APICreatedAtIsNil applies the IsNil predicate on the "api_created_at" field.
+func APICreatedAtIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldAPICreatedAt))
+}
+
+// APICreatedAtNotNil applies the NotNil predicate on the "api_created_at" field.
+func APICreatedAtNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldAPICreatedAt))
+}
+
+// APIUpdatedAtEQ applies the EQ predicate on the "api_updated_at" field.
+func APIUpdatedAtEQ(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldEQ(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtNEQ applies the NEQ predicate on the "api_updated_at" field.
+func APIUpdatedAtNEQ(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNEQ(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtIn applies the In predicate on the "api_updated_at" field.
+func APIUpdatedAtIn(vs ...time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIn(FieldAPIUpdatedAt, vs...))
+}
+
+// APIUpdatedAtNotIn applies the NotIn predicate on the "api_updated_at" field.
+func APIUpdatedAtNotIn(vs ...time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotIn(FieldAPIUpdatedAt, vs...))
+}
+
+// APIUpdatedAtGT applies the GT predicate on the "api_updated_at" field.
+func APIUpdatedAtGT(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGT(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtGTE applies the GTE predicate on the "api_updated_at" field.
+func APIUpdatedAtGTE(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldGTE(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtLT applies the LT predicate on the "api_updated_at" field.
+func APIUpdatedAtLT(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLT(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtLTE applies the LTE predicate on the "api_updated_at" field.
+func APIUpdatedAtLTE(v time.Time) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldLTE(FieldAPIUpdatedAt, v))
+}
+
+// APIUpdatedAtIsNil applies the IsNil predicate on the "api_updated_at" field.
+func APIUpdatedAtIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldAPIUpdatedAt))
+}
+
+// APIUpdatedAtNotNil applies the NotNil predicate on the "api_updated_at" field.
+func APIUpdatedAtNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldAPIUpdatedAt))
+}
+
+// EnginesJSONIsNil applies the IsNil predicate on the "engines_json" field.
+func EnginesJSONIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldEnginesJSON))
+}
+
+// EnginesJSONNotNil applies the NotNil predicate on the "engines_json" field.
+func EnginesJSONNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldEnginesJSON))
+}
+
+// IndicatorsJSONIsNil applies the IsNil predicate on the "indicators_json" field.
+func IndicatorsJSONIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldIndicatorsJSON))
+}
+
+// IndicatorsJSONNotNil applies the NotNil predicate on the "indicators_json" field.
+func IndicatorsJSONNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldIndicatorsJSON))
+}
+
+// MitigationActionsJSONIsNil applies the IsNil predicate on the "mitigation_actions_json" field.
+func MitigationActionsJSONIsNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldIsNull(FieldMitigationActionsJSON))
+}
+
+// MitigationActionsJSONNotNil applies the NotNil predicate on the "mitigation_actions_json" field.
+func MitigationActionsJSONNotNil() predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.FieldNotNull(FieldMitigationActionsJSON))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BronzeS1Threat) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BronzeS1Threat) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BronzeS1Threat) predicate.BronzeS1Threat {
+	return predicate.BronzeS1Threat(sql.NotPredicates(p))
+}
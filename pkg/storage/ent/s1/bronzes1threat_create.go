@@ -0,0 +1,746 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1threat"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeS1ThreatCreate is the builder for creating a BronzeS1Threat entity.
+type BronzeS1ThreatCreate struct {
+	config
+	mutation *BronzeS1ThreatMutation
+	hooks    []Hook
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_c *BronzeS1ThreatCreate) SetCollectedAt(v time.Time) *BronzeS1ThreatCreate {
+	_c.mutation.SetCollectedAt(v)
+	return _c
+}
+
+// SetFirstCollectedAt sets the "first_collected_at" field.
+func (_c *BronzeS1ThreatCreate) SetFirstCollectedAt(v time.Time) *BronzeS1ThreatCreate {
+	_c.mutation.SetFirstCollectedAt(v)
+	return _c
+}
+
+// SetAgentID sets the "agent_id" field.
+func (_c *BronzeS1ThreatCreate) SetAgentID(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetAgentID(v)
+	return _c
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableAgentID(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetAgentID(*v)
+	}
+	return _c
+}
+
+// SetAgentUUID sets the "agent_uuid" field.
+func (_c *BronzeS1ThreatCreate) SetAgentUUID(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetAgentUUID(v)
+	return _c
+}
+
+// SetNillableAgentUUID sets the "agent_uuid" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableAgentUUID(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetAgentUUID(*v)
+	}
+	return _c
+}
+
+// SetAgentComputerName sets the "agent_computer_name" field.
+func (_c *BronzeS1ThreatCreate) SetAgentComputerName(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetAgentComputerName(v)
+	return _c
+}
+
+// SetNillableAgentComputerName sets the "agent_computer_name" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableAgentComputerName(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetAgentComputerName(*v)
+	}
+	return _c
+}
+
+// SetAgentOsType sets the "agent_os_type" field.
+func (_c *BronzeS1ThreatCreate) SetAgentOsType(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetAgentOsType(v)
+	return _c
+}
+
+// SetNillableAgentOsType sets the "agent_os_type" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableAgentOsType(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetAgentOsType(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *BronzeS1ThreatCreate) SetAccountID(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableAccountID(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetAccountID(*v)
+	}
+	return _c
+}
+
+// SetSiteID sets the "site_id" field.
+func (_c *BronzeS1ThreatCreate) SetSiteID(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetSiteID(v)
+	return _c
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableSiteID(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetSiteID(*v)
+	}
+	return _c
+}
+
+// SetSiteName sets the "site_name" field.
+func (_c *BronzeS1ThreatCreate) SetSiteName(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetSiteName(v)
+	return _c
+}
+
+// SetNillableSiteName sets the "site_name" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableSiteName(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetSiteName(*v)
+	}
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *BronzeS1ThreatCreate) SetGroupID(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableGroupID(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetGroupID(*v)
+	}
+	return _c
+}
+
+// SetThreatName sets the "threat_name" field.
+func (_c *BronzeS1ThreatCreate) SetThreatName(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetThreatName(v)
+	return _c
+}
+
+// SetNillableThreatName sets the "threat_name" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableThreatName(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetThreatName(*v)
+	}
+	return _c
+}
+
+// SetClassification sets the "classification" field.
+func (_c *BronzeS1ThreatCreate) SetClassification(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetClassification(v)
+	return _c
+}
+
+// SetNillableClassification sets the "classification" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableClassification(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetClassification(*v)
+	}
+	return _c
+}
+
+// SetClassificationSource sets the "classification_source" field.
+func (_c *BronzeS1ThreatCreate) SetClassificationSource(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetClassificationSource(v)
+	return _c
+}
+
+// SetNillableClassificationSource sets the "classification_source" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableClassificationSource(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetClassificationSource(*v)
+	}
+	return _c
+}
+
+// SetConfidenceLevel sets the "confidence_level" field.
+func (_c *BronzeS1ThreatCreate) SetConfidenceLevel(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetConfidenceLevel(v)
+	return _c
+}
+
+// SetNillableConfidenceLevel sets the "confidence_level" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableConfidenceLevel(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetConfidenceLevel(*v)
+	}
+	return _c
+}
+
+// SetMitigationStatus sets the "mitigation_status" field.
+func (_c *BronzeS1ThreatCreate) SetMitigationStatus(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetMitigationStatus(v)
+	return _c
+}
+
+// SetNillableMitigationStatus sets the "mitigation_status" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableMitigationStatus(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetMitigationStatus(*v)
+	}
+	return _c
+}
+
+// SetAnalystVerdict sets the "analyst_verdict" field.
+func (_c *BronzeS1ThreatCreate) SetAnalystVerdict(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetAnalystVerdict(v)
+	return _c
+}
+
+// SetNillableAnalystVerdict sets the "analyst_verdict" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableAnalystVerdict(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetAnalystVerdict(*v)
+	}
+	return _c
+}
+
+// SetIncidentStatus sets the "incident_status" field.
+func (_c *BronzeS1ThreatCreate) SetIncidentStatus(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetIncidentStatus(v)
+	return _c
+}
+
+// SetNillableIncidentStatus sets the "incident_status" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableIncidentStatus(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetIncidentStatus(*v)
+	}
+	return _c
+}
+
+// SetInitiatedBy sets the "initiated_by" field.
+func (_c *BronzeS1ThreatCreate) SetInitiatedBy(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetInitiatedBy(v)
+	return _c
+}
+
+// SetNillableInitiatedBy sets the "initiated_by" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableInitiatedBy(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetInitiatedBy(*v)
+	}
+	return _c
+}
+
+// SetDetectionType sets the "detection_type" field.
+func (_c *BronzeS1ThreatCreate) SetDetectionType(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetDetectionType(v)
+	return _c
+}
+
+// SetNillableDetectionType sets the "detection_type" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableDetectionType(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetDetectionType(*v)
+	}
+	return _c
+}
+
+// SetFilePath sets the "file_path" field.
+func (_c *BronzeS1ThreatCreate) SetFilePath(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetFilePath(v)
+	return _c
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableFilePath(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetFilePath(*v)
+	}
+	return _c
+}
+
+// SetSha1 sets the "sha1" field.
+func (_c *BronzeS1ThreatCreate) SetSha1(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetSha1(v)
+	return _c
+}
+
+// SetNillableSha1 sets the "sha1" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableSha1(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetSha1(*v)
+	}
+	return _c
+}
+
+// SetSha256 sets the "sha256" field.
+func (_c *BronzeS1ThreatCreate) SetSha256(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetSha256(v)
+	return _c
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableSha256(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetSha256(*v)
+	}
+	return _c
+}
+
+// SetMd5 sets the "md5" field.
+func (_c *BronzeS1ThreatCreate) SetMd5(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetMd5(v)
+	return _c
+}
+
+// SetNillableMd5 sets the "md5" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableMd5(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetMd5(*v)
+	}
+	return _c
+}
+
+// SetStoryline sets the "storyline" field.
+func (_c *BronzeS1ThreatCreate) SetStoryline(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetStoryline(v)
+	return _c
+}
+
+// SetNillableStoryline sets the "storyline" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableStoryline(v *string) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetStoryline(*v)
+	}
+	return _c
+}
+
+// SetIsFileless sets the "is_fileless" field.
+func (_c *BronzeS1ThreatCreate) SetIsFileless(v bool) *BronzeS1ThreatCreate {
+	_c.mutation.SetIsFileless(v)
+	return _c
+}
+
+// SetNillableIsFileless sets the "is_fileless" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableIsFileless(v *bool) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetIsFileless(*v)
+	}
+	return _c
+}
+
+// SetMitigatedPreemptively sets the "mitigated_preemptively" field.
+func (_c *BronzeS1ThreatCreate) SetMitigatedPreemptively(v bool) *BronzeS1ThreatCreate {
+	_c.mutation.SetMitigatedPreemptively(v)
+	return _c
+}
+
+// SetNillableMitigatedPreemptively sets the "mitigated_preemptively" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableMitigatedPreemptively(v *bool) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetMitigatedPreemptively(*v)
+	}
+	return _c
+}
+
+// SetIdentifiedAt sets the "identified_at" field.
+func (_c *BronzeS1ThreatCreate) SetIdentifiedAt(v time.Time) *BronzeS1ThreatCreate {
+	_c.mutation.SetIdentifiedAt(v)
+	return _c
+}
+
+// SetNillableIdentifiedAt sets the "identified_at" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableIdentifiedAt(v *time.Time) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetIdentifiedAt(*v)
+	}
+	return _c
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_c *BronzeS1ThreatCreate) SetAPICreatedAt(v time.Time) *BronzeS1ThreatCreate {
+	_c.mutation.SetAPICreatedAt(v)
+	return _c
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableAPICreatedAt(v *time.Time) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetAPICreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_c *BronzeS1ThreatCreate) SetAPIUpdatedAt(v time.Time) *BronzeS1ThreatCreate {
+	_c.mutation.SetAPIUpdatedAt(v)
+	return _c
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_c *BronzeS1ThreatCreate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeS1ThreatCreate {
+	if v != nil {
+		_c.SetAPIUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetEnginesJSON sets the "engines_json" field.
+func (_c *BronzeS1ThreatCreate) SetEnginesJSON(v json.RawMessage) *BronzeS1ThreatCreate {
+	_c.mutation.SetEnginesJSON(v)
+	return _c
+}
+
+// SetIndicatorsJSON sets the "indicators_json" field.
+func (_c *BronzeS1ThreatCreate) SetIndicatorsJSON(v json.RawMessage) *BronzeS1ThreatCreate {
+	_c.mutation.SetIndicatorsJSON(v)
+	return _c
+}
+
+// SetMitigationActionsJSON sets the "mitigation_actions_json" field.
+func (_c *BronzeS1ThreatCreate) SetMitigationActionsJSON(v json.RawMessage) *BronzeS1ThreatCreate {
+	_c.mutation.SetMitigationActionsJSON(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BronzeS1ThreatCreate) SetID(v string) *BronzeS1ThreatCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BronzeS1ThreatMutation object of the builder.
+func (_c *BronzeS1ThreatCreate) Mutation() *BronzeS1ThreatMutation {
+	return _c.mutation
+}
+
+// Save creates the BronzeS1Threat in the database.
+func (_c *BronzeS1ThreatCreate) Save(ctx context.Context) (*BronzeS1Threat, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BronzeS1ThreatCreate) SaveX(ctx context.Context) *BronzeS1Threat {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeS1ThreatCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeS1ThreatCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BronzeS1ThreatCreate) defaults() {
+	if _, ok := _c.mutation.IsFileless(); !ok {
+		v := bronzes1threat.DefaultIsFileless
+		_c.mutation.SetIsFileless(v)
+	}
+	if _, ok := _c.mutation.MitigatedPreemptively(); !ok {
+		v := bronzes1threat.DefaultMitigatedPreemptively
+		_c.mutation.SetMitigatedPreemptively(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BronzeS1ThreatCreate) check() error {
+	if _, ok := _c.mutation.CollectedAt(); !ok {
+		return &ValidationError{Name: "collected_at", err: errors.New(`s1: missing required field "BronzeS1Threat.collected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstCollectedAt(); !ok {
+		return &ValidationError{Name: "first_collected_at", err: errors.New(`s1: missing required field "BronzeS1Threat.first_collected_at"`)}
+	}
+	if _, ok := _c.mutation.IsFileless(); !ok {
+		return &ValidationError{Name: "is_fileless", err: errors.New(`s1: missing required field "BronzeS1Threat.is_fileless"`)}
+	}
+	if _, ok := _c.mutation.MitigatedPreemptively(); !ok {
+		return &ValidationError{Name: "mitigated_preemptively", err: errors.New(`s1: missing required field "BronzeS1Threat.mitigated_preemptively"`)}
+	}
+	return nil
+}
+
+func (_c *BronzeS1ThreatCreate) sqlSave(ctx context.Context) (*BronzeS1Threat, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BronzeS1Threat.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BronzeS1ThreatCreate) createSpec() (*BronzeS1Threat, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BronzeS1Threat{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bronzes1threat.Table, sqlgraph.NewFieldSpec(bronzes1threat.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.BronzeS1Threat
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1threat.FieldCollectedAt, field.TypeTime, value)
+		_node.CollectedAt = value
+	}
+	if value, ok := _c.mutation.FirstCollectedAt(); ok {
+		_spec.SetField(bronzes1threat.FieldFirstCollectedAt, field.TypeTime, value)
+		_node.FirstCollectedAt = value
+	}
+	if value, ok := _c.mutation.AgentID(); ok {
+		_spec.SetField(bronzes1threat.FieldAgentID, field.TypeString, value)
+		_node.AgentID = value
+	}
+	if value, ok := _c.mutation.AgentUUID(); ok {
+		_spec.SetField(bronzes1threat.FieldAgentUUID, field.TypeString, value)
+		_node.AgentUUID = value
+	}
+	if value, ok := _c.mutation.AgentComputerName(); ok {
+		_spec.SetField(bronzes1threat.FieldAgentComputerName, field.TypeString, value)
+		_node.AgentComputerName = value
+	}
+	if value, ok := _c.mutation.AgentOsType(); ok {
+		_spec.SetField(bronzes1threat.FieldAgentOsType, field.TypeString, value)
+		_node.AgentOsType = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(bronzes1threat.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.SiteID(); ok {
+		_spec.SetField(bronzes1threat.FieldSiteID, field.TypeString, value)
+		_node.SiteID = value
+	}
+	if value, ok := _c.mutation.SiteName(); ok {
+		_spec.SetField(bronzes1threat.FieldSiteName, field.TypeString, value)
+		_node.SiteName = value
+	}
+	if value, ok := _c.mutation.GroupID(); ok {
+		_spec.SetField(bronzes1threat.FieldGroupID, field.TypeString, value)
+		_node.GroupID = value
+	}
+	if value, ok := _c.mutation.ThreatName(); ok {
+		_spec.SetField(bronzes1threat.FieldThreatName, field.TypeString, value)
+		_node.ThreatName = value
+	}
+	if value, ok := _c.mutation.Classification(); ok {
+		_spec.SetField(bronzes1threat.FieldClassification, field.TypeString, value)
+		_node.Classification = value
+	}
+	if value, ok := _c.mutation.ClassificationSource(); ok {
+		_spec.SetField(bronzes1threat.FieldClassificationSource, field.TypeString, value)
+		_node.ClassificationSource = value
+	}
+	if value, ok := _c.mutation.ConfidenceLevel(); ok {
+		_spec.SetField(bronzes1threat.FieldConfidenceLevel, field.TypeString, value)
+		_node.ConfidenceLevel = value
+	}
+	if value, ok := _c.mutation.MitigationStatus(); ok {
+		_spec.SetField(bronzes1threat.FieldMitigationStatus, field.TypeString, value)
+		_node.MitigationStatus = value
+	}
+	if value, ok := _c.mutation.AnalystVerdict(); ok {
+		_spec.SetField(bronzes1threat.FieldAnalystVerdict, field.TypeString, value)
+		_node.AnalystVerdict = value
+	}
+	if value, ok := _c.mutation.IncidentStatus(); ok {
+		_spec.SetField(bronzes1threat.FieldIncidentStatus, field.TypeString, value)
+		_node.IncidentStatus = value
+	}
+	if value, ok := _c.mutation.InitiatedBy(); ok {
+		_spec.SetField(bronzes1threat.FieldInitiatedBy, field.TypeString, value)
+		_node.InitiatedBy = value
+	}
+	if value, ok := _c.mutation.DetectionType(); ok {
+		_spec.SetField(bronzes1threat.FieldDetectionType, field.TypeString, value)
+		_node.DetectionType = value
+	}
+	if value, ok := _c.mutation.FilePath(); ok {
+		_spec.SetField(bronzes1threat.FieldFilePath, field.TypeString, value)
+		_node.FilePath = value
+	}
+	if value, ok := _c.mutation.Sha1(); ok {
+		_spec.SetField(bronzes1threat.FieldSha1, field.TypeString, value)
+		_node.Sha1 = value
+	}
+	if value, ok := _c.mutation.Sha256(); ok {
+		_spec.SetField(bronzes1threat.FieldSha256, field.TypeString, value)
+		_node.Sha256 = value
+	}
+	if value, ok := _c.mutation.Md5(); ok {
+		_spec.SetField(bronzes1threat.FieldMd5, field.TypeString, value)
+		_node.Md5 = value
+	}
+	if value, ok := _c.mutation.Storyline(); ok {
+		_spec.SetField(bronzes1threat.FieldStoryline, field.TypeString, value)
+		_node.Storyline = value
+	}
+	if value, ok := _c.mutation.IsFileless(); ok {
+		_spec.SetField(bronzes1threat.FieldIsFileless, field.TypeBool, value)
+		_node.IsFileless = value
+	}
+	if value, ok := _c.mutation.MitigatedPreemptively(); ok {
+		_spec.SetField(bronzes1threat.FieldMitigatedPreemptively, field.TypeBool, value)
+		_node.MitigatedPreemptively = value
+	}
+	if value, ok := _c.mutation.IdentifiedAt(); ok {
+		_spec.SetField(bronzes1threat.FieldIdentifiedAt, field.TypeTime, value)
+		_node.IdentifiedAt = &value
+	}
+	if value, ok := _c.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzes1threat.FieldAPICreatedAt, field.TypeTime, value)
+		_node.APICreatedAt = &value
+	}
+	if value, ok := _c.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzes1threat.FieldAPIUpdatedAt, field.TypeTime, value)
+		_node.APIUpdatedAt = &value
+	}
+	if value, ok := _c.mutation.EnginesJSON(); ok {
+		_spec.SetField(bronzes1threat.FieldEnginesJSON, field.TypeJSON, value)
+		_node.EnginesJSON = value
+	}
+	if value, ok := _c.mutation.IndicatorsJSON(); ok {
+		_spec.SetField(bronzes1threat.FieldIndicatorsJSON, field.TypeJSON, value)
+		_node.IndicatorsJSON = value
+	}
+	if value, ok := _c.mutation.MitigationActionsJSON(); ok {
+		_spec.SetField(bronzes1threat.FieldMitigationActionsJSON, field.TypeJSON, value)
+		_node.MitigationActionsJSON = value
+	}
+	return _node, _spec
+}
+
+// BronzeS1ThreatCreateBulk is the builder for creating many BronzeS1Threat entities in bulk.
+type BronzeS1ThreatCreateBulk struct {
+	config
+	err      error
+	builders []*BronzeS1ThreatCreate
+}
+
+// Save creates the BronzeS1Threat entities in the database.
+func (_c *BronzeS1ThreatCreateBulk) Save(ctx context.Context) ([]*BronzeS1Threat, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BronzeS1Threat, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BronzeS1ThreatMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BronzeS1ThreatCreateBulk) SaveX(ctx context.Context) []*BronzeS1Threat {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BronzeS1ThreatCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BronzeS1ThreatCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1759 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1threat"
+	"danny.vn/hotpot/pkg/storage/ent/s1/internal"
+	"danny.vn/hotpot/pkg/storage/ent/s1/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BronzeS1ThreatUpdate is the builder for updating BronzeS1Threat entities.
+type BronzeS1ThreatUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BronzeS1ThreatMutation
+}
+
+// Where appends a list predicates to the BronzeS1ThreatUpdate builder.
+func (_u *BronzeS1ThreatUpdate) Where(ps ...predicate.BronzeS1Threat) *BronzeS1ThreatUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeS1ThreatUpdate) SetCollectedAt(v time.Time) *BronzeS1ThreatUpdate {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableCollectedAt(v *time.Time) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetAgentID sets the "agent_id" field.
+func (_u *BronzeS1ThreatUpdate) SetAgentID(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetAgentID(v)
+	return _u
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableAgentID(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetAgentID(*v)
+	}
+	return _u
+}
+
+// ClearAgentID clears the value of the "agent_id" field.
+func (_u *BronzeS1ThreatUpdate) ClearAgentID() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearAgentID()
+	return _u
+}
+
+// SetAgentUUID sets the "agent_uuid" field.
+func (_u *BronzeS1ThreatUpdate) SetAgentUUID(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetAgentUUID(v)
+	return _u
+}
+
+// SetNillableAgentUUID sets the "agent_uuid" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableAgentUUID(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetAgentUUID(*v)
+	}
+	return _u
+}
+
+// ClearAgentUUID clears the value of the "agent_uuid" field.
+func (_u *BronzeS1ThreatUpdate) ClearAgentUUID() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearAgentUUID()
+	return _u
+}
+
+// SetAgentComputerName sets the "agent_computer_name" field.
+func (_u *BronzeS1ThreatUpdate) SetAgentComputerName(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetAgentComputerName(v)
+	return _u
+}
+
+// SetNillableAgentComputerName sets the "agent_computer_name" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableAgentComputerName(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetAgentComputerName(*v)
+	}
+	return _u
+}
+
+// ClearAgentComputerName clears the value of the "agent_computer_name" field.
+func (_u *BronzeS1ThreatUpdate) ClearAgentComputerName() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearAgentComputerName()
+	return _u
+}
+
+// SetAgentOsType sets the "agent_os_type" field.
+func (_u *BronzeS1ThreatUpdate) SetAgentOsType(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetAgentOsType(v)
+	return _u
+}
+
+// SetNillableAgentOsType sets the "agent_os_type" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableAgentOsType(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetAgentOsType(*v)
+	}
+	return _u
+}
+
+// ClearAgentOsType clears the value of the "agent_os_type" field.
+func (_u *BronzeS1ThreatUpdate) ClearAgentOsType() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearAgentOsType()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeS1ThreatUpdate) SetAccountID(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableAccountID(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *BronzeS1ThreatUpdate) ClearAccountID() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeS1ThreatUpdate) SetSiteID(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableSiteID(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeS1ThreatUpdate) ClearSiteID() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetSiteName sets the "site_name" field.
+func (_u *BronzeS1ThreatUpdate) SetSiteName(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetSiteName(v)
+	return _u
+}
+
+// SetNillableSiteName sets the "site_name" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableSiteName(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetSiteName(*v)
+	}
+	return _u
+}
+
+// ClearSiteName clears the value of the "site_name" field.
+func (_u *BronzeS1ThreatUpdate) ClearSiteName() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearSiteName()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeS1ThreatUpdate) SetGroupID(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableGroupID(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeS1ThreatUpdate) ClearGroupID() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetThreatName sets the "threat_name" field.
+func (_u *BronzeS1ThreatUpdate) SetThreatName(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetThreatName(v)
+	return _u
+}
+
+// SetNillableThreatName sets the "threat_name" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableThreatName(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetThreatName(*v)
+	}
+	return _u
+}
+
+// ClearThreatName clears the value of the "threat_name" field.
+func (_u *BronzeS1ThreatUpdate) ClearThreatName() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearThreatName()
+	return _u
+}
+
+// SetClassification sets the "classification" field.
+func (_u *BronzeS1ThreatUpdate) SetClassification(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetClassification(v)
+	return _u
+}
+
+// SetNillableClassification sets the "classification" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableClassification(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetClassification(*v)
+	}
+	return _u
+}
+
+// ClearClassification clears the value of the "classification" field.
+func (_u *BronzeS1ThreatUpdate) ClearClassification() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearClassification()
+	return _u
+}
+
+// SetClassificationSource sets the "classification_source" field.
+func (_u *BronzeS1ThreatUpdate) SetClassificationSource(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetClassificationSource(v)
+	return _u
+}
+
+// SetNillableClassificationSource sets the "classification_source" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableClassificationSource(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetClassificationSource(*v)
+	}
+	return _u
+}
+
+// ClearClassificationSource clears the value of the "classification_source" field.
+func (_u *BronzeS1ThreatUpdate) ClearClassificationSource() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearClassificationSource()
+	return _u
+}
+
+// SetConfidenceLevel sets the "confidence_level" field.
+func (_u *BronzeS1ThreatUpdate) SetConfidenceLevel(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetConfidenceLevel(v)
+	return _u
+}
+
+// SetNillableConfidenceLevel sets the "confidence_level" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableConfidenceLevel(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetConfidenceLevel(*v)
+	}
+	return _u
+}
+
+// ClearConfidenceLevel clears the value of the "confidence_level" field.
+func (_u *BronzeS1ThreatUpdate) ClearConfidenceLevel() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearConfidenceLevel()
+	return _u
+}
+
+// SetMitigationStatus sets the "mitigation_status" field.
+func (_u *BronzeS1ThreatUpdate) SetMitigationStatus(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetMitigationStatus(v)
+	return _u
+}
+
+// SetNillableMitigationStatus sets the "mitigation_status" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableMitigationStatus(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetMitigationStatus(*v)
+	}
+	return _u
+}
+
+// ClearMitigationStatus clears the value of the "mitigation_status" field.
+func (_u *BronzeS1ThreatUpdate) ClearMitigationStatus() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearMitigationStatus()
+	return _u
+}
+
+// SetAnalystVerdict sets the "analyst_verdict" field.
+func (_u *BronzeS1ThreatUpdate) SetAnalystVerdict(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetAnalystVerdict(v)
+	return _u
+}
+
+// SetNillableAnalystVerdict sets the "analyst_verdict" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableAnalystVerdict(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetAnalystVerdict(*v)
+	}
+	return _u
+}
+
+// ClearAnalystVerdict clears the value of the "analyst_verdict" field.
+func (_u *BronzeS1ThreatUpdate) ClearAnalystVerdict() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearAnalystVerdict()
+	return _u
+}
+
+// SetIncidentStatus sets the "incident_status" field.
+func (_u *BronzeS1ThreatUpdate) SetIncidentStatus(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetIncidentStatus(v)
+	return _u
+}
+
+// SetNillableIncidentStatus sets the "incident_status" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableIncidentStatus(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetIncidentStatus(*v)
+	}
+	return _u
+}
+
+// ClearIncidentStatus clears the value of the "incident_status" field.
+func (_u *BronzeS1ThreatUpdate) ClearIncidentStatus() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearIncidentStatus()
+	return _u
+}
+
+// SetInitiatedBy sets the "initiated_by" field.
+func (_u *BronzeS1ThreatUpdate) SetInitiatedBy(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetInitiatedBy(v)
+	return _u
+}
+
+// SetNillableInitiatedBy sets the "initiated_by" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableInitiatedBy(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetInitiatedBy(*v)
+	}
+	return _u
+}
+
+// ClearInitiatedBy clears the value of the "initiated_by" field.
+func (_u *BronzeS1ThreatUpdate) ClearInitiatedBy() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearInitiatedBy()
+	return _u
+}
+
+// SetDetectionType sets the "detection_type" field.
+func (_u *BronzeS1ThreatUpdate) SetDetectionType(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetDetectionType(v)
+	return _u
+}
+
+// SetNillableDetectionType sets the "detection_type" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableDetectionType(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetDetectionType(*v)
+	}
+	return _u
+}
+
+// ClearDetectionType clears the value of the "detection_type" field.
+func (_u *BronzeS1ThreatUpdate) ClearDetectionType() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearDetectionType()
+	return _u
+}
+
+// SetFilePath sets the "file_path" field.
+func (_u *BronzeS1ThreatUpdate) SetFilePath(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetFilePath(v)
+	return _u
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableFilePath(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetFilePath(*v)
+	}
+	return _u
+}
+
+// ClearFilePath clears the value of the "file_path" field.
+func (_u *BronzeS1ThreatUpdate) ClearFilePath() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearFilePath()
+	return _u
+}
+
+// SetSha1 sets the "sha1" field.
+func (_u *BronzeS1ThreatUpdate) SetSha1(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetSha1(v)
+	return _u
+}
+
+// SetNillableSha1 sets the "sha1" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableSha1(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetSha1(*v)
+	}
+	return _u
+}
+
+// ClearSha1 clears the value of the "sha1" field.
+func (_u *BronzeS1ThreatUpdate) ClearSha1() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearSha1()
+	return _u
+}
+
+// SetSha256 sets the "sha256" field.
+func (_u *BronzeS1ThreatUpdate) SetSha256(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetSha256(v)
+	return _u
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableSha256(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetSha256(*v)
+	}
+	return _u
+}
+
+// ClearSha256 clears the value of the "sha256" field.
+func (_u *BronzeS1ThreatUpdate) ClearSha256() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearSha256()
+	return _u
+}
+
+// SetMd5 sets the "md5" field.
+func (_u *BronzeS1ThreatUpdate) SetMd5(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetMd5(v)
+	return _u
+}
+
+// SetNillableMd5 sets the "md5" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableMd5(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetMd5(*v)
+	}
+	return _u
+}
+
+// ClearMd5 clears the value of the "md5" field.
+func (_u *BronzeS1ThreatUpdate) ClearMd5() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearMd5()
+	return _u
+}
+
+// SetStoryline sets the "storyline" field.
+func (_u *BronzeS1ThreatUpdate) SetStoryline(v string) *BronzeS1ThreatUpdate {
+	_u.mutation.SetStoryline(v)
+	return _u
+}
+
+// SetNillableStoryline sets the "storyline" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableStoryline(v *string) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetStoryline(*v)
+	}
+	return _u
+}
+
+// ClearStoryline clears the value of the "storyline" field.
+func (_u *BronzeS1ThreatUpdate) ClearStoryline() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearStoryline()
+	return _u
+}
+
+// SetIsFileless sets the "is_fileless" field.
+func (_u *BronzeS1ThreatUpdate) SetIsFileless(v bool) *BronzeS1ThreatUpdate {
+	_u.mutation.SetIsFileless(v)
+	return _u
+}
+
+// SetNillableIsFileless sets the "is_fileless" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableIsFileless(v *bool) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetIsFileless(*v)
+	}
+	return _u
+}
+
+// SetMitigatedPreemptively sets the "mitigated_preemptively" field.
+func (_u *BronzeS1ThreatUpdate) SetMitigatedPreemptively(v bool) *BronzeS1ThreatUpdate {
+	_u.mutation.SetMitigatedPreemptively(v)
+	return _u
+}
+
+// SetNillableMitigatedPreemptively sets the "mitigated_preemptively" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableMitigatedPreemptively(v *bool) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetMitigatedPreemptively(*v)
+	}
+	return _u
+}
+
+// SetIdentifiedAt sets the "identified_at" field.
+func (_u *BronzeS1ThreatUpdate) SetIdentifiedAt(v time.Time) *BronzeS1ThreatUpdate {
+	_u.mutation.SetIdentifiedAt(v)
+	return _u
+}
+
+// SetNillableIdentifiedAt sets the "identified_at" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableIdentifiedAt(v *time.Time) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetIdentifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearIdentifiedAt clears the value of the "identified_at" field.
+func (_u *BronzeS1ThreatUpdate) ClearIdentifiedAt() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearIdentifiedAt()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeS1ThreatUpdate) SetAPICreatedAt(v time.Time) *BronzeS1ThreatUpdate {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableAPICreatedAt(v *time.Time) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeS1ThreatUpdate) ClearAPICreatedAt() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeS1ThreatUpdate) SetAPIUpdatedAt(v time.Time) *BronzeS1ThreatUpdate {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdate) SetNillableAPIUpdatedAt(v *time.Time) *BronzeS1ThreatUpdate {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeS1ThreatUpdate) ClearAPIUpdatedAt() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// SetEnginesJSON sets the "engines_json" field.
+func (_u *BronzeS1ThreatUpdate) SetEnginesJSON(v json.RawMessage) *BronzeS1ThreatUpdate {
+	_u.mutation.SetEnginesJSON(v)
+	return _u
+}
+
+// AppendEnginesJSON appends value to the "engines_json" field.
+func (_u *BronzeS1ThreatUpdate) AppendEnginesJSON(v json.RawMessage) *BronzeS1ThreatUpdate {
+	_u.mutation.AppendEnginesJSON(v)
+	return _u
+}
+
+// ClearEnginesJSON clears the value of the "engines_json" field.
+func (_u *BronzeS1ThreatUpdate) ClearEnginesJSON() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearEnginesJSON()
+	return _u
+}
+
+// SetIndicatorsJSON sets the "indicators_json" field.
+func (_u *BronzeS1ThreatUpdate) SetIndicatorsJSON(v json.RawMessage) *BronzeS1ThreatUpdate {
+	_u.mutation.SetIndicatorsJSON(v)
+	return _u
+}
+
+// AppendIndicatorsJSON appends value to the "indicators_json" field.
+func (_u *BronzeS1ThreatUpdate) AppendIndicatorsJSON(v json.RawMessage) *BronzeS1ThreatUpdate {
+	_u.mutation.AppendIndicatorsJSON(v)
+	return _u
+}
+
+// ClearIndicatorsJSON clears the value of the "indicators_json" field.
+func (_u *BronzeS1ThreatUpdate) ClearIndicatorsJSON() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearIndicatorsJSON()
+	return _u
+}
+
+// SetMitigationActionsJSON sets the "mitigation_actions_json" field.
+func (_u *BronzeS1ThreatUpdate) SetMitigationActionsJSON(v json.RawMessage) *BronzeS1ThreatUpdate {
+	_u.mutation.SetMitigationActionsJSON(v)
+	return _u
+}
+
+// AppendMitigationActionsJSON appends value to the "mitigation_actions_json" field.
+func (_u *BronzeS1ThreatUpdate) AppendMitigationActionsJSON(v json.RawMessage) *BronzeS1ThreatUpdate {
+	_u.mutation.AppendMitigationActionsJSON(v)
+	return _u
+}
+
+// ClearMitigationActionsJSON clears the value of the "mitigation_actions_json" field.
+func (_u *BronzeS1ThreatUpdate) ClearMitigationActionsJSON() *BronzeS1ThreatUpdate {
+	_u.mutation.ClearMitigationActionsJSON()
+	return _u
+}
+
+// Mutation returns the BronzeS1ThreatMutation object of the builder.
+func (_u *BronzeS1ThreatUpdate) Mutation() *BronzeS1ThreatMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BronzeS1ThreatUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeS1ThreatUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BronzeS1ThreatUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeS1ThreatUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BronzeS1ThreatUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bronzes1threat.Table, bronzes1threat.Columns, sqlgraph.NewFieldSpec(bronzes1threat.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1threat.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.AgentID(); ok {
+		_spec.SetField(bronzes1threat.FieldAgentID, field.TypeString, value)
+	}
+	if _u.mutation.AgentIDCleared() {
+		_spec.ClearField(bronzes1threat.FieldAgentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentUUID(); ok {
+		_spec.SetField(bronzes1threat.FieldAgentUUID, field.TypeString, value)
+	}
+	if _u.mutation.AgentUUIDCleared() {
+		_spec.ClearField(bronzes1threat.FieldAgentUUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentComputerName(); ok {
+		_spec.SetField(bronzes1threat.FieldAgentComputerName, field.TypeString, value)
+	}
+	if _u.mutation.AgentComputerNameCleared() {
+		_spec.ClearField(bronzes1threat.FieldAgentComputerName, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentOsType(); ok {
+		_spec.SetField(bronzes1threat.FieldAgentOsType, field.TypeString, value)
+	}
+	if _u.mutation.AgentOsTypeCleared() {
+		_spec.ClearField(bronzes1threat.FieldAgentOsType, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzes1threat.FieldAccountID, field.TypeString, value)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(bronzes1threat.FieldAccountID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzes1threat.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzes1threat.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteName(); ok {
+		_spec.SetField(bronzes1threat.FieldSiteName, field.TypeString, value)
+	}
+	if _u.mutation.SiteNameCleared() {
+		_spec.ClearField(bronzes1threat.FieldSiteName, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzes1threat.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzes1threat.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ThreatName(); ok {
+		_spec.SetField(bronzes1threat.FieldThreatName, field.TypeString, value)
+	}
+	if _u.mutation.ThreatNameCleared() {
+		_spec.ClearField(bronzes1threat.FieldThreatName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Classification(); ok {
+		_spec.SetField(bronzes1threat.FieldClassification, field.TypeString, value)
+	}
+	if _u.mutation.ClassificationCleared() {
+		_spec.ClearField(bronzes1threat.FieldClassification, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClassificationSource(); ok {
+		_spec.SetField(bronzes1threat.FieldClassificationSource, field.TypeString, value)
+	}
+	if _u.mutation.ClassificationSourceCleared() {
+		_spec.ClearField(bronzes1threat.FieldClassificationSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.ConfidenceLevel(); ok {
+		_spec.SetField(bronzes1threat.FieldConfidenceLevel, field.TypeString, value)
+	}
+	if _u.mutation.ConfidenceLevelCleared() {
+		_spec.ClearField(bronzes1threat.FieldConfidenceLevel, field.TypeString)
+	}
+	if value, ok := _u.mutation.MitigationStatus(); ok {
+		_spec.SetField(bronzes1threat.FieldMitigationStatus, field.TypeString, value)
+	}
+	if _u.mutation.MitigationStatusCleared() {
+		_spec.ClearField(bronzes1threat.FieldMitigationStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.AnalystVerdict(); ok {
+		_spec.SetField(bronzes1threat.FieldAnalystVerdict, field.TypeString, value)
+	}
+	if _u.mutation.AnalystVerdictCleared() {
+		_spec.ClearField(bronzes1threat.FieldAnalystVerdict, field.TypeString)
+	}
+	if value, ok := _u.mutation.IncidentStatus(); ok {
+		_spec.SetField(bronzes1threat.FieldIncidentStatus, field.TypeString, value)
+	}
+	if _u.mutation.IncidentStatusCleared() {
+		_spec.ClearField(bronzes1threat.FieldIncidentStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.InitiatedBy(); ok {
+		_spec.SetField(bronzes1threat.FieldInitiatedBy, field.TypeString, value)
+	}
+	if _u.mutation.InitiatedByCleared() {
+		_spec.ClearField(bronzes1threat.FieldInitiatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.DetectionType(); ok {
+		_spec.SetField(bronzes1threat.FieldDetectionType, field.TypeString, value)
+	}
+	if _u.mutation.DetectionTypeCleared() {
+		_spec.ClearField(bronzes1threat.FieldDetectionType, field.TypeString)
+	}
+	if value, ok := _u.mutation.FilePath(); ok {
+		_spec.SetField(bronzes1threat.FieldFilePath, field.TypeString, value)
+	}
+	if _u.mutation.FilePathCleared() {
+		_spec.ClearField(bronzes1threat.FieldFilePath, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sha1(); ok {
+		_spec.SetField(bronzes1threat.FieldSha1, field.TypeString, value)
+	}
+	if _u.mutation.Sha1Cleared() {
+		_spec.ClearField(bronzes1threat.FieldSha1, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sha256(); ok {
+		_spec.SetField(bronzes1threat.FieldSha256, field.TypeString, value)
+	}
+	if _u.mutation.Sha256Cleared() {
+		_spec.ClearField(bronzes1threat.FieldSha256, field.TypeString)
+	}
+	if value, ok := _u.mutation.Md5(); ok {
+		_spec.SetField(bronzes1threat.FieldMd5, field.TypeString, value)
+	}
+	if _u.mutation.Md5Cleared() {
+		_spec.ClearField(bronzes1threat.FieldMd5, field.TypeString)
+	}
+	if value, ok := _u.mutation.Storyline(); ok {
+		_spec.SetField(bronzes1threat.FieldStoryline, field.TypeString, value)
+	}
+	if _u.mutation.StorylineCleared() {
+		_spec.ClearField(bronzes1threat.FieldStoryline, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsFileless(); ok {
+		_spec.SetField(bronzes1threat.FieldIsFileless, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MitigatedPreemptively(); ok {
+		_spec.SetField(bronzes1threat.FieldMitigatedPreemptively, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IdentifiedAt(); ok {
+		_spec.SetField(bronzes1threat.FieldIdentifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.IdentifiedAtCleared() {
+		_spec.ClearField(bronzes1threat.FieldIdentifiedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzes1threat.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzes1threat.FieldAPICreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzes1threat.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzes1threat.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EnginesJSON(); ok {
+		_spec.SetField(bronzes1threat.FieldEnginesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEnginesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzes1threat.FieldEnginesJSON, value)
+		})
+	}
+	if _u.mutation.EnginesJSONCleared() {
+		_spec.ClearField(bronzes1threat.FieldEnginesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IndicatorsJSON(); ok {
+		_spec.SetField(bronzes1threat.FieldIndicatorsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIndicatorsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzes1threat.FieldIndicatorsJSON, value)
+		})
+	}
+	if _u.mutation.IndicatorsJSONCleared() {
+		_spec.ClearField(bronzes1threat.FieldIndicatorsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MitigationActionsJSON(); ok {
+		_spec.SetField(bronzes1threat.FieldMitigationActionsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMitigationActionsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzes1threat.FieldMitigationActionsJSON, value)
+		})
+	}
+	if _u.mutation.MitigationActionsJSONCleared() {
+		_spec.ClearField(bronzes1threat.FieldMitigationActionsJSON, field.TypeJSON)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeS1Threat
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzes1threat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BronzeS1ThreatUpdateOne is the builder for updating a single BronzeS1Threat entity.
+type BronzeS1ThreatUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BronzeS1ThreatMutation
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (_u *BronzeS1ThreatUpdateOne) SetCollectedAt(v time.Time) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetCollectedAt(v)
+	return _u
+}
+
+// SetNillableCollectedAt sets the "collected_at" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableCollectedAt(v *time.Time) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetCollectedAt(*v)
+	}
+	return _u
+}
+
+// SetAgentID sets the "agent_id" field.
+func (_u *BronzeS1ThreatUpdateOne) SetAgentID(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetAgentID(v)
+	return _u
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableAgentID(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetAgentID(*v)
+	}
+	return _u
+}
+
+// ClearAgentID clears the value of the "agent_id" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearAgentID() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearAgentID()
+	return _u
+}
+
+// SetAgentUUID sets the "agent_uuid" field.
+func (_u *BronzeS1ThreatUpdateOne) SetAgentUUID(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetAgentUUID(v)
+	return _u
+}
+
+// SetNillableAgentUUID sets the "agent_uuid" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableAgentUUID(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetAgentUUID(*v)
+	}
+	return _u
+}
+
+// ClearAgentUUID clears the value of the "agent_uuid" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearAgentUUID() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearAgentUUID()
+	return _u
+}
+
+// SetAgentComputerName sets the "agent_computer_name" field.
+func (_u *BronzeS1ThreatUpdateOne) SetAgentComputerName(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetAgentComputerName(v)
+	return _u
+}
+
+// SetNillableAgentComputerName sets the "agent_computer_name" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableAgentComputerName(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetAgentComputerName(*v)
+	}
+	return _u
+}
+
+// ClearAgentComputerName clears the value of the "agent_computer_name" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearAgentComputerName() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearAgentComputerName()
+	return _u
+}
+
+// SetAgentOsType sets the "agent_os_type" field.
+func (_u *BronzeS1ThreatUpdateOne) SetAgentOsType(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetAgentOsType(v)
+	return _u
+}
+
+// SetNillableAgentOsType sets the "agent_os_type" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableAgentOsType(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetAgentOsType(*v)
+	}
+	return _u
+}
+
+// ClearAgentOsType clears the value of the "agent_os_type" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearAgentOsType() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearAgentOsType()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *BronzeS1ThreatUpdateOne) SetAccountID(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableAccountID(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearAccountID() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetSiteID sets the "site_id" field.
+func (_u *BronzeS1ThreatUpdateOne) SetSiteID(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetSiteID(v)
+	return _u
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableSiteID(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetSiteID(*v)
+	}
+	return _u
+}
+
+// ClearSiteID clears the value of the "site_id" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearSiteID() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearSiteID()
+	return _u
+}
+
+// SetSiteName sets the "site_name" field.
+func (_u *BronzeS1ThreatUpdateOne) SetSiteName(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetSiteName(v)
+	return _u
+}
+
+// SetNillableSiteName sets the "site_name" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableSiteName(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetSiteName(*v)
+	}
+	return _u
+}
+
+// ClearSiteName clears the value of the "site_name" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearSiteName() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearSiteName()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *BronzeS1ThreatUpdateOne) SetGroupID(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableGroupID(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearGroupID() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetThreatName sets the "threat_name" field.
+func (_u *BronzeS1ThreatUpdateOne) SetThreatName(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetThreatName(v)
+	return _u
+}
+
+// SetNillableThreatName sets the "threat_name" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableThreatName(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetThreatName(*v)
+	}
+	return _u
+}
+
+// ClearThreatName clears the value of the "threat_name" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearThreatName() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearThreatName()
+	return _u
+}
+
+// SetClassification sets the "classification" field.
+func (_u *BronzeS1ThreatUpdateOne) SetClassification(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetClassification(v)
+	return _u
+}
+
+// SetNillableClassification sets the "classification" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableClassification(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetClassification(*v)
+	}
+	return _u
+}
+
+// ClearClassification clears the value of the "classification" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearClassification() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearClassification()
+	return _u
+}
+
+// SetClassificationSource sets the "classification_source" field.
+func (_u *BronzeS1ThreatUpdateOne) SetClassificationSource(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetClassificationSource(v)
+	return _u
+}
+
+// SetNillableClassificationSource sets the "classification_source" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableClassificationSource(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetClassificationSource(*v)
+	}
+	return _u
+}
+
+// ClearClassificationSource clears the value of the "classification_source" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearClassificationSource() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearClassificationSource()
+	return _u
+}
+
+// SetConfidenceLevel sets the "confidence_level" field.
+func (_u *BronzeS1ThreatUpdateOne) SetConfidenceLevel(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetConfidenceLevel(v)
+	return _u
+}
+
+// SetNillableConfidenceLevel sets the "confidence_level" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableConfidenceLevel(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetConfidenceLevel(*v)
+	}
+	return _u
+}
+
+// ClearConfidenceLevel clears the value of the "confidence_level" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearConfidenceLevel() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearConfidenceLevel()
+	return _u
+}
+
+// SetMitigationStatus sets the "mitigation_status" field.
+func (_u *BronzeS1ThreatUpdateOne) SetMitigationStatus(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetMitigationStatus(v)
+	return _u
+}
+
+// SetNillableMitigationStatus sets the "mitigation_status" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableMitigationStatus(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetMitigationStatus(*v)
+	}
+	return _u
+}
+
+// ClearMitigationStatus clears the value of the "mitigation_status" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearMitigationStatus() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearMitigationStatus()
+	return _u
+}
+
+// SetAnalystVerdict sets the "analyst_verdict" field.
+func (_u *BronzeS1ThreatUpdateOne) SetAnalystVerdict(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetAnalystVerdict(v)
+	return _u
+}
+
+// SetNillableAnalystVerdict sets the "analyst_verdict" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableAnalystVerdict(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetAnalystVerdict(*v)
+	}
+	return _u
+}
+
+// ClearAnalystVerdict clears the value of the "analyst_verdict" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearAnalystVerdict() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearAnalystVerdict()
+	return _u
+}
+
+// SetIncidentStatus sets the "incident_status" field.
+func (_u *BronzeS1ThreatUpdateOne) SetIncidentStatus(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetIncidentStatus(v)
+	return _u
+}
+
+// SetNillableIncidentStatus sets the "incident_status" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableIncidentStatus(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetIncidentStatus(*v)
+	}
+	return _u
+}
+
+// ClearIncidentStatus clears the value of the "incident_status" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearIncidentStatus() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearIncidentStatus()
+	return _u
+}
+
+// SetInitiatedBy sets the "initiated_by" field.
+func (_u *BronzeS1ThreatUpdateOne) SetInitiatedBy(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetInitiatedBy(v)
+	return _u
+}
+
+// SetNillableInitiatedBy sets the "initiated_by" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableInitiatedBy(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetInitiatedBy(*v)
+	}
+	return _u
+}
+
+// ClearInitiatedBy clears the value of the "initiated_by" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearInitiatedBy() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearInitiatedBy()
+	return _u
+}
+
+// SetDetectionType sets the "detection_type" field.
+func (_u *BronzeS1ThreatUpdateOne) SetDetectionType(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetDetectionType(v)
+	return _u
+}
+
+// SetNillableDetectionType sets the "detection_type" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableDetectionType(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetDetectionType(*v)
+	}
+	return _u
+}
+
+// ClearDetectionType clears the value of the "detection_type" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearDetectionType() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearDetectionType()
+	return _u
+}
+
+// SetFilePath sets the "file_path" field.
+func (_u *BronzeS1ThreatUpdateOne) SetFilePath(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetFilePath(v)
+	return _u
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableFilePath(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetFilePath(*v)
+	}
+	return _u
+}
+
+// ClearFilePath clears the value of the "file_path" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearFilePath() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearFilePath()
+	return _u
+}
+
+// SetSha1 sets the "sha1" field.
+func (_u *BronzeS1ThreatUpdateOne) SetSha1(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetSha1(v)
+	return _u
+}
+
+// SetNillableSha1 sets the "sha1" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableSha1(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetSha1(*v)
+	}
+	return _u
+}
+
+// ClearSha1 clears the value of the "sha1" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearSha1() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearSha1()
+	return _u
+}
+
+// SetSha256 sets the "sha256" field.
+func (_u *BronzeS1ThreatUpdateOne) SetSha256(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetSha256(v)
+	return _u
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableSha256(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetSha256(*v)
+	}
+	return _u
+}
+
+// ClearSha256 clears the value of the "sha256" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearSha256() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearSha256()
+	return _u
+}
+
+// SetMd5 sets the "md5" field.
+func (_u *BronzeS1ThreatUpdateOne) SetMd5(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetMd5(v)
+	return _u
+}
+
+// SetNillableMd5 sets the "md5" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableMd5(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetMd5(*v)
+	}
+	return _u
+}
+
+// ClearMd5 clears the value of the "md5" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearMd5() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearMd5()
+	return _u
+}
+
+// SetStoryline sets the "storyline" field.
+func (_u *BronzeS1ThreatUpdateOne) SetStoryline(v string) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetStoryline(v)
+	return _u
+}
+
+// SetNillableStoryline sets the "storyline" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableStoryline(v *string) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetStoryline(*v)
+	}
+	return _u
+}
+
+// ClearStoryline clears the value of the "storyline" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearStoryline() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearStoryline()
+	return _u
+}
+
+// SetIsFileless sets the "is_fileless" field.
+func (_u *BronzeS1ThreatUpdateOne) SetIsFileless(v bool) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetIsFileless(v)
+	return _u
+}
+
+// SetNillableIsFileless sets the "is_fileless" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableIsFileless(v *bool) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetIsFileless(*v)
+	}
+	return _u
+}
+
+// SetMitigatedPreemptively sets the "mitigated_preemptively" field.
+func (_u *BronzeS1ThreatUpdateOne) SetMitigatedPreemptively(v bool) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetMitigatedPreemptively(v)
+	return _u
+}
+
+// SetNillableMitigatedPreemptively sets the "mitigated_preemptively" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableMitigatedPreemptively(v *bool) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetMitigatedPreemptively(*v)
+	}
+	return _u
+}
+
+// SetIdentifiedAt sets the "identified_at" field.
+func (_u *BronzeS1ThreatUpdateOne) SetIdentifiedAt(v time.Time) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetIdentifiedAt(v)
+	return _u
+}
+
+// SetNillableIdentifiedAt sets the "identified_at" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableIdentifiedAt(v *time.Time) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetIdentifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearIdentifiedAt clears the value of the "identified_at" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearIdentifiedAt() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearIdentifiedAt()
+	return _u
+}
+
+// SetAPICreatedAt sets the "api_created_at" field.
+func (_u *BronzeS1ThreatUpdateOne) SetAPICreatedAt(v time.Time) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetAPICreatedAt(v)
+	return _u
+}
+
+// SetNillableAPICreatedAt sets the "api_created_at" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableAPICreatedAt(v *time.Time) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetAPICreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPICreatedAt clears the value of the "api_created_at" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearAPICreatedAt() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearAPICreatedAt()
+	return _u
+}
+
+// SetAPIUpdatedAt sets the "api_updated_at" field.
+func (_u *BronzeS1ThreatUpdateOne) SetAPIUpdatedAt(v time.Time) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetAPIUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAPIUpdatedAt sets the "api_updated_at" field if the given value is not nil.
+func (_u *BronzeS1ThreatUpdateOne) SetNillableAPIUpdatedAt(v *time.Time) *BronzeS1ThreatUpdateOne {
+	if v != nil {
+		_u.SetAPIUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearAPIUpdatedAt clears the value of the "api_updated_at" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearAPIUpdatedAt() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearAPIUpdatedAt()
+	return _u
+}
+
+// SetEnginesJSON sets the "engines_json" field.
+func (_u *BronzeS1ThreatUpdateOne) SetEnginesJSON(v json.RawMessage) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetEnginesJSON(v)
+	return _u
+}
+
+// AppendEnginesJSON appends value to the "engines_json" field.
+func (_u *BronzeS1ThreatUpdateOne) AppendEnginesJSON(v json.RawMessage) *BronzeS1ThreatUpdateOne {
+	_u.mutation.AppendEnginesJSON(v)
+	return _u
+}
+
+// ClearEnginesJSON clears the value of the "engines_json" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearEnginesJSON() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearEnginesJSON()
+	return _u
+}
+
+// SetIndicatorsJSON sets the "indicators_json" field.
+func (_u *BronzeS1ThreatUpdateOne) SetIndicatorsJSON(v json.RawMessage) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetIndicatorsJSON(v)
+	return _u
+}
+
+// AppendIndicatorsJSON appends value to the "indicators_json" field.
+func (_u *BronzeS1ThreatUpdateOne) AppendIndicatorsJSON(v json.RawMessage) *BronzeS1ThreatUpdateOne {
+	_u.mutation.AppendIndicatorsJSON(v)
+	return _u
+}
+
+// ClearIndicatorsJSON clears the value of the "indicators_json" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearIndicatorsJSON() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearIndicatorsJSON()
+	return _u
+}
+
+// SetMitigationActionsJSON sets the "mitigation_actions_json" field.
+func (_u *BronzeS1ThreatUpdateOne) SetMitigationActionsJSON(v json.RawMessage) *BronzeS1ThreatUpdateOne {
+	_u.mutation.SetMitigationActionsJSON(v)
+	return _u
+}
+
+// AppendMitigationActionsJSON appends value to the "mitigation_actions_json" field.
+func (_u *BronzeS1ThreatUpdateOne) AppendMitigationActionsJSON(v json.RawMessage) *BronzeS1ThreatUpdateOne {
+	_u.mutation.AppendMitigationActionsJSON(v)
+	return _u
+}
+
+// ClearMitigationActionsJSON clears the value of the "mitigation_actions_json" field.
+func (_u *BronzeS1ThreatUpdateOne) ClearMitigationActionsJSON() *BronzeS1ThreatUpdateOne {
+	_u.mutation.ClearMitigationActionsJSON()
+	return _u
+}
+
+// Mutation returns the BronzeS1ThreatMutation object of the builder.
+func (_u *BronzeS1ThreatUpdateOne) Mutation() *BronzeS1ThreatMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BronzeS1ThreatUpdate builder.
+func (_u *BronzeS1ThreatUpdateOne) Where(ps ...predicate.BronzeS1Threat) *BronzeS1ThreatUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BronzeS1ThreatUpdateOne) Select(field string, fields ...string) *BronzeS1ThreatUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BronzeS1Threat entity.
+func (_u *BronzeS1ThreatUpdateOne) Save(ctx context.Context) (*BronzeS1Threat, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BronzeS1ThreatUpdateOne) SaveX(ctx context.Context) *BronzeS1Threat {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BronzeS1ThreatUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BronzeS1ThreatUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BronzeS1ThreatUpdateOne) sqlSave(ctx context.Context) (_node *BronzeS1Threat, err error) {
+	_spec := sqlgraph.NewUpdateSpec(bronzes1threat.Table, bronzes1threat.Columns, sqlgraph.NewFieldSpec(bronzes1threat.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`s1: missing "BronzeS1Threat.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bronzes1threat.FieldID)
+		for _, f := range fields {
+			if !bronzes1threat.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("s1: invalid field %q for query", f)}
+			}
+			if f != bronzes1threat.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectedAt(); ok {
+		_spec.SetField(bronzes1threat.FieldCollectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.AgentID(); ok {
+		_spec.SetField(bronzes1threat.FieldAgentID, field.TypeString, value)
+	}
+	if _u.mutation.AgentIDCleared() {
+		_spec.ClearField(bronzes1threat.FieldAgentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentUUID(); ok {
+		_spec.SetField(bronzes1threat.FieldAgentUUID, field.TypeString, value)
+	}
+	if _u.mutation.AgentUUIDCleared() {
+		_spec.ClearField(bronzes1threat.FieldAgentUUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentComputerName(); ok {
+		_spec.SetField(bronzes1threat.FieldAgentComputerName, field.TypeString, value)
+	}
+	if _u.mutation.AgentComputerNameCleared() {
+		_spec.ClearField(bronzes1threat.FieldAgentComputerName, field.TypeString)
+	}
+	if value, ok := _u.mutation.AgentOsType(); ok {
+		_spec.SetField(bronzes1threat.FieldAgentOsType, field.TypeString, value)
+	}
+	if _u.mutation.AgentOsTypeCleared() {
+		_spec.ClearField(bronzes1threat.FieldAgentOsType, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(bronzes1threat.FieldAccountID, field.TypeString, value)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(bronzes1threat.FieldAccountID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteID(); ok {
+		_spec.SetField(bronzes1threat.FieldSiteID, field.TypeString, value)
+	}
+	if _u.mutation.SiteIDCleared() {
+		_spec.ClearField(bronzes1threat.FieldSiteID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteName(); ok {
+		_spec.SetField(bronzes1threat.FieldSiteName, field.TypeString, value)
+	}
+	if _u.mutation.SiteNameCleared() {
+		_spec.ClearField(bronzes1threat.FieldSiteName, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(bronzes1threat.FieldGroupID, field.TypeString, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(bronzes1threat.FieldGroupID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ThreatName(); ok {
+		_spec.SetField(bronzes1threat.FieldThreatName, field.TypeString, value)
+	}
+	if _u.mutation.ThreatNameCleared() {
+		_spec.ClearField(bronzes1threat.FieldThreatName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Classification(); ok {
+		_spec.SetField(bronzes1threat.FieldClassification, field.TypeString, value)
+	}
+	if _u.mutation.ClassificationCleared() {
+		_spec.ClearField(bronzes1threat.FieldClassification, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClassificationSource(); ok {
+		_spec.SetField(bronzes1threat.FieldClassificationSource, field.TypeString, value)
+	}
+	if _u.mutation.ClassificationSourceCleared() {
+		_spec.ClearField(bronzes1threat.FieldClassificationSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.ConfidenceLevel(); ok {
+		_spec.SetField(bronzes1threat.FieldConfidenceLevel, field.TypeString, value)
+	}
+	if _u.mutation.ConfidenceLevelCleared() {
+		_spec.ClearField(bronzes1threat.FieldConfidenceLevel, field.TypeString)
+	}
+	if value, ok := _u.mutation.MitigationStatus(); ok {
+		_spec.SetField(bronzes1threat.FieldMitigationStatus, field.TypeString, value)
+	}
+	if _u.mutation.MitigationStatusCleared() {
+		_spec.ClearField(bronzes1threat.FieldMitigationStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.AnalystVerdict(); ok {
+		_spec.SetField(bronzes1threat.FieldAnalystVerdict, field.TypeString, value)
+	}
+	if _u.mutation.AnalystVerdictCleared() {
+		_spec.ClearField(bronzes1threat.FieldAnalystVerdict, field.TypeString)
+	}
+	if value, ok := _u.mutation.IncidentStatus(); ok {
+		_spec.SetField(bronzes1threat.FieldIncidentStatus, field.TypeString, value)
+	}
+	if _u.mutation.IncidentStatusCleared() {
+		_spec.ClearField(bronzes1threat.FieldIncidentStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.InitiatedBy(); ok {
+		_spec.SetField(bronzes1threat.FieldInitiatedBy, field.TypeString, value)
+	}
+	if _u.mutation.InitiatedByCleared() {
+		_spec.ClearField(bronzes1threat.FieldInitiatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.DetectionType(); ok {
+		_spec.SetField(bronzes1threat.FieldDetectionType, field.TypeString, value)
+	}
+	if _u.mutation.DetectionTypeCleared() {
+		_spec.ClearField(bronzes1threat.FieldDetectionType, field.TypeString)
+	}
+	if value, ok := _u.mutation.FilePath(); ok {
+		_spec.SetField(bronzes1threat.FieldFilePath, field.TypeString, value)
+	}
+	if _u.mutation.FilePathCleared() {
+		_spec.ClearField(bronzes1threat.FieldFilePath, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sha1(); ok {
+		_spec.SetField(bronzes1threat.FieldSha1, field.TypeString, value)
+	}
+	if _u.mutation.Sha1Cleared() {
+		_spec.ClearField(bronzes1threat.FieldSha1, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sha256(); ok {
+		_spec.SetField(bronzes1threat.FieldSha256, field.TypeString, value)
+	}
+	if _u.mutation.Sha256Cleared() {
+		_spec.ClearField(bronzes1threat.FieldSha256, field.TypeString)
+	}
+	if value, ok := _u.mutation.Md5(); ok {
+		_spec.SetField(bronzes1threat.FieldMd5, field.TypeString, value)
+	}
+	if _u.mutation.Md5Cleared() {
+		_spec.ClearField(bronzes1threat.FieldMd5, field.TypeString)
+	}
+	if value, ok := _u.mutation.Storyline(); ok {
+		_spec.SetField(bronzes1threat.FieldStoryline, field.TypeString, value)
+	}
+	if _u.mutation.StorylineCleared() {
+		_spec.ClearField(bronzes1threat.FieldStoryline, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsFileless(); ok {
+		_spec.SetField(bronzes1threat.FieldIsFileless, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MitigatedPreemptively(); ok {
+		_spec.SetField(bronzes1threat.FieldMitigatedPreemptively, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IdentifiedAt(); ok {
+		_spec.SetField(bronzes1threat.FieldIdentifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.IdentifiedAtCleared() {
+		_spec.ClearField(bronzes1threat.FieldIdentifiedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APICreatedAt(); ok {
+		_spec.SetField(bronzes1threat.FieldAPICreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APICreatedAtCleared() {
+		_spec.ClearField(bronzes1threat.FieldAPICreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APIUpdatedAt(); ok {
+		_spec.SetField(bronzes1threat.FieldAPIUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.APIUpdatedAtCleared() {
+		_spec.ClearField(bronzes1threat.FieldAPIUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EnginesJSON(); ok {
+		_spec.SetField(bronzes1threat.FieldEnginesJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEnginesJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzes1threat.FieldEnginesJSON, value)
+		})
+	}
+	if _u.mutation.EnginesJSONCleared() {
+		_spec.ClearField(bronzes1threat.FieldEnginesJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IndicatorsJSON(); ok {
+		_spec.SetField(bronzes1threat.FieldIndicatorsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIndicatorsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzes1threat.FieldIndicatorsJSON, value)
+		})
+	}
+	if _u.mutation.IndicatorsJSONCleared() {
+		_spec.ClearField(bronzes1threat.FieldIndicatorsJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MitigationActionsJSON(); ok {
+		_spec.SetField(bronzes1threat.FieldMitigationActionsJSON, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMitigationActionsJSON(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, bronzes1threat.FieldMitigationActionsJSON, value)
+		})
+	}
+	if _u.mutation.MitigationActionsJSONCleared() {
+		_spec.ClearField(bronzes1threat.FieldMitigationActionsJSON, field.TypeJSON)
+	}
+	_spec.Node.Schema = _u.schemaConfig.BronzeS1Threat
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &BronzeS1Threat{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bronzes1threat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -14,25 +14,39 @@ import (
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1account"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1agent"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1agentnic"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1alert"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1appcve"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1appinventory"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1endpointapp"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1exclusion"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1group"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1networkdiscovery"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1policy"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1rangerdevice"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1rangergateway"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1rangersetting"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1restriction"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1site"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1threat"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1account"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1activity"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1agent"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1agentnic"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1alert"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1appcve"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1appinventory"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1endpointapp"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1exclusion"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1group"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1ingestcursor"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1networkdiscovery"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1policy"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1rangerdevice"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1rangergateway"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1rangersetting"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1restriction"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1site"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1threat"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -52,44 +66,72 @@ type Client struct {
 	BronzeHistoryS1Agent *BronzeHistoryS1AgentClient
 	// BronzeHistoryS1AgentNIC is the client for interacting with the BronzeHistoryS1AgentNIC builders.
 	BronzeHistoryS1AgentNIC *BronzeHistoryS1AgentNICClient
+	// BronzeHistoryS1Alert is the client for interacting with the BronzeHistoryS1Alert builders.
+	BronzeHistoryS1Alert *BronzeHistoryS1AlertClient
+	// BronzeHistoryS1AppCVE is the client for interacting with the BronzeHistoryS1AppCVE builders.
+	BronzeHistoryS1AppCVE *BronzeHistoryS1AppCVEClient
 	// BronzeHistoryS1AppInventory is the client for interacting with the BronzeHistoryS1AppInventory builders.
 	BronzeHistoryS1AppInventory *BronzeHistoryS1AppInventoryClient
 	// BronzeHistoryS1EndpointApp is the client for interacting with the BronzeHistoryS1EndpointApp builders.
 	BronzeHistoryS1EndpointApp *BronzeHistoryS1EndpointAppClient
+	// BronzeHistoryS1Exclusion is the client for interacting with the BronzeHistoryS1Exclusion builders.
+	BronzeHistoryS1Exclusion *BronzeHistoryS1ExclusionClient
 	// BronzeHistoryS1Group is the client for interacting with the BronzeHistoryS1Group builders.
 	BronzeHistoryS1Group *BronzeHistoryS1GroupClient
 	// BronzeHistoryS1NetworkDiscovery is the client for interacting with the BronzeHistoryS1NetworkDiscovery builders.
 	BronzeHistoryS1NetworkDiscovery *BronzeHistoryS1NetworkDiscoveryClient
+	// BronzeHistoryS1Policy is the client for interacting with the BronzeHistoryS1Policy builders.
+	BronzeHistoryS1Policy *BronzeHistoryS1PolicyClient
 	// BronzeHistoryS1RangerDevice is the client for interacting with the BronzeHistoryS1RangerDevice builders.
 	BronzeHistoryS1RangerDevice *BronzeHistoryS1RangerDeviceClient
 	// BronzeHistoryS1RangerGateway is the client for interacting with the BronzeHistoryS1RangerGateway builders.
 	BronzeHistoryS1RangerGateway *BronzeHistoryS1RangerGatewayClient
 	// BronzeHistoryS1RangerSetting is the client for interacting with the BronzeHistoryS1RangerSetting builders.
 	BronzeHistoryS1RangerSetting *BronzeHistoryS1RangerSettingClient
+	// BronzeHistoryS1Restriction is the client for interacting with the BronzeHistoryS1Restriction builders.
+	BronzeHistoryS1Restriction *BronzeHistoryS1RestrictionClient
 	// BronzeHistoryS1Site is the client for interacting with the BronzeHistoryS1Site builders.
 	BronzeHistoryS1Site *BronzeHistoryS1SiteClient
+	// BronzeHistoryS1Threat is the client for interacting with the BronzeHistoryS1Threat builders.
+	BronzeHistoryS1Threat *BronzeHistoryS1ThreatClient
 	// BronzeS1Account is the client for interacting with the BronzeS1Account builders.
 	BronzeS1Account *BronzeS1AccountClient
+	// BronzeS1Activity is the client for interacting with the BronzeS1Activity builders.
+	BronzeS1Activity *BronzeS1ActivityClient
 	// BronzeS1Agent is the client for interacting with the BronzeS1Agent builders.
 	BronzeS1Agent *BronzeS1AgentClient
 	// BronzeS1AgentNIC is the client for interacting with the BronzeS1AgentNIC builders.
 	BronzeS1AgentNIC *BronzeS1AgentNICClient
+	// BronzeS1Alert is the client for interacting with the BronzeS1Alert builders.
+	BronzeS1Alert *BronzeS1AlertClient
+	// BronzeS1AppCVE is the client for interacting with the BronzeS1AppCVE builders.
+	BronzeS1AppCVE *BronzeS1AppCVEClient
 	// BronzeS1AppInventory is the client for interacting with the BronzeS1AppInventory builders.
 	BronzeS1AppInventory *BronzeS1AppInventoryClient
 	// BronzeS1EndpointApp is the client for interacting with the BronzeS1EndpointApp builders.
 	BronzeS1EndpointApp *BronzeS1EndpointAppClient
+	// BronzeS1Exclusion is the client for interacting with the BronzeS1Exclusion builders.
+	BronzeS1Exclusion *BronzeS1ExclusionClient
 	// BronzeS1Group is the client for interacting with the BronzeS1Group builders.
 	BronzeS1Group *BronzeS1GroupClient
+	// BronzeS1IngestCursor is the client for interacting with the BronzeS1IngestCursor builders.
+	BronzeS1IngestCursor *BronzeS1IngestCursorClient
 	// BronzeS1NetworkDiscovery is the client for interacting with the BronzeS1NetworkDiscovery builders.
 	BronzeS1NetworkDiscovery *BronzeS1NetworkDiscoveryClient
+	// BronzeS1Policy is the client for interacting with the BronzeS1Policy builders.
+	BronzeS1Policy *BronzeS1PolicyClient
 	// BronzeS1RangerDevice is the client for interacting with the BronzeS1RangerDevice builders.
 	BronzeS1RangerDevice *BronzeS1RangerDeviceClient
 	// BronzeS1RangerGateway is the client for interacting with the BronzeS1RangerGateway builders.
 	BronzeS1RangerGateway *BronzeS1RangerGatewayClient
 	// BronzeS1RangerSetting is the client for interacting with the BronzeS1RangerSetting builders.
 	BronzeS1RangerSetting *BronzeS1RangerSettingClient
+	// BronzeS1Restriction is the client for interacting with the BronzeS1Restriction builders.
+	BronzeS1Restriction *BronzeS1RestrictionClient
 	// BronzeS1Site is the client for interacting with the BronzeS1Site builders.
 	BronzeS1Site *BronzeS1SiteClient
+	// BronzeS1Threat is the client for interacting with the BronzeS1Threat builders.
+	BronzeS1Threat *BronzeS1ThreatClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -104,25 +146,39 @@ func (c *Client) init() {
 	c.BronzeHistoryS1Account = NewBronzeHistoryS1AccountClient(c.config)
 	c.BronzeHistoryS1Agent = NewBronzeHistoryS1AgentClient(c.config)
 	c.BronzeHistoryS1AgentNIC = NewBronzeHistoryS1AgentNICClient(c.config)
+	c.BronzeHistoryS1Alert = NewBronzeHistoryS1AlertClient(c.config)
+	c.BronzeHistoryS1AppCVE = NewBronzeHistoryS1AppCVEClient(c.config)
 	c.BronzeHistoryS1AppInventory = NewBronzeHistoryS1AppInventoryClient(c.config)
 	c.BronzeHistoryS1EndpointApp = NewBronzeHistoryS1EndpointAppClient(c.config)
+	c.BronzeHistoryS1Exclusion = NewBronzeHistoryS1ExclusionClient(c.config)
 	c.BronzeHistoryS1Group = NewBronzeHistoryS1GroupClient(c.config)
 	c.BronzeHistoryS1NetworkDiscovery = NewBronzeHistoryS1NetworkDiscoveryClient(c.config)
+	c.BronzeHistoryS1Policy = NewBronzeHistoryS1PolicyClient(c.config)
 	c.BronzeHistoryS1RangerDevice = NewBronzeHistoryS1RangerDeviceClient(c.config)
 	c.BronzeHistoryS1RangerGateway = NewBronzeHistoryS1RangerGatewayClient(c.config)
 	c.BronzeHistoryS1RangerSetting = NewBronzeHistoryS1RangerSettingClient(c.config)
+	c.BronzeHistoryS1Restriction = NewBronzeHistoryS1RestrictionClient(c.config)
 	c.BronzeHistoryS1Site = NewBronzeHistoryS1SiteClient(c.config)
+	c.BronzeHistoryS1Threat = NewBronzeHistoryS1ThreatClient(c.config)
 	c.BronzeS1Account = NewBronzeS1AccountClient(c.config)
+	c.BronzeS1Activity = NewBronzeS1ActivityClient(c.config)
 	c.BronzeS1Agent = NewBronzeS1AgentClient(c.config)
 	c.BronzeS1AgentNIC = NewBronzeS1AgentNICClient(c.config)
+	c.BronzeS1Alert = NewBronzeS1AlertClient(c.config)
+	c.BronzeS1AppCVE = NewBronzeS1AppCVEClient(c.config)
 	c.BronzeS1AppInventory = NewBronzeS1AppInventoryClient(c.config)
 	c.BronzeS1EndpointApp = NewBronzeS1EndpointAppClient(c.config)
+	c.BronzeS1Exclusion = NewBronzeS1ExclusionClient(c.config)
 	c.BronzeS1Group = NewBronzeS1GroupClient(c.config)
+	c.BronzeS1IngestCursor = NewBronzeS1IngestCursorClient(c.config)
 	c.BronzeS1NetworkDiscovery = NewBronzeS1NetworkDiscoveryClient(c.config)
+	c.BronzeS1Policy = NewBronzeS1PolicyClient(c.config)
 	c.BronzeS1RangerDevice = NewBronzeS1RangerDeviceClient(c.config)
 	c.BronzeS1RangerGateway = NewBronzeS1RangerGatewayClient(c.config)
 	c.BronzeS1RangerSetting = NewBronzeS1RangerSettingClient(c.config)
+	c.BronzeS1Restriction = NewBronzeS1RestrictionClient(c.config)
 	c.BronzeS1Site = NewBronzeS1SiteClient(c.config)
+	c.BronzeS1Threat = NewBronzeS1ThreatClient(c.config)
 }
 
 type (
@@ -220,25 +276,39 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		BronzeHistoryS1Account:          NewBronzeHistoryS1AccountClient(cfg),
 		BronzeHistoryS1Agent:            NewBronzeHistoryS1AgentClient(cfg),
 		BronzeHistoryS1AgentNIC:         NewBronzeHistoryS1AgentNICClient(cfg),
+		BronzeHistoryS1Alert:            NewBronzeHistoryS1AlertClient(cfg),
+		BronzeHistoryS1AppCVE:           NewBronzeHistoryS1AppCVEClient(cfg),
 		BronzeHistoryS1AppInventory:     NewBronzeHistoryS1AppInventoryClient(cfg),
 		BronzeHistoryS1EndpointApp:      NewBronzeHistoryS1EndpointAppClient(cfg),
+		BronzeHistoryS1Exclusion:        NewBronzeHistoryS1ExclusionClient(cfg),
 		BronzeHistoryS1Group:            NewBronzeHistoryS1GroupClient(cfg),
 		BronzeHistoryS1NetworkDiscovery: NewBronzeHistoryS1NetworkDiscoveryClient(cfg),
+		BronzeHistoryS1Policy:           NewBronzeHistoryS1PolicyClient(cfg),
 		BronzeHistoryS1RangerDevice:     NewBronzeHistoryS1RangerDeviceClient(cfg),
 		BronzeHistoryS1RangerGateway:    NewBronzeHistoryS1RangerGatewayClient(cfg),
 		BronzeHistoryS1RangerSetting:    NewBronzeHistoryS1RangerSettingClient(cfg),
+		BronzeHistoryS1Restriction:      NewBronzeHistoryS1RestrictionClient(cfg),
 		BronzeHistoryS1Site:             NewBronzeHistoryS1SiteClient(cfg),
+		BronzeHistoryS1Threat:           NewBronzeHistoryS1ThreatClient(cfg),
 		BronzeS1Account:                 NewBronzeS1AccountClient(cfg),
+		BronzeS1Activity:                NewBronzeS1ActivityClient(cfg),
 		BronzeS1Agent:                   NewBronzeS1AgentClient(cfg),
 		BronzeS1AgentNIC:                NewBronzeS1AgentNICClient(cfg),
+		BronzeS1Alert:                   NewBronzeS1AlertClient(cfg),
+		BronzeS1AppCVE:                  NewBronzeS1AppCVEClient(cfg),
 		BronzeS1AppInventory:            NewBronzeS1AppInventoryClient(cfg),
 		BronzeS1EndpointApp:             NewBronzeS1EndpointAppClient(cfg),
+		BronzeS1Exclusion:               NewBronzeS1ExclusionClient(cfg),
 		BronzeS1Group:                   NewBronzeS1GroupClient(cfg),
+		BronzeS1IngestCursor:            NewBronzeS1IngestCursorClient(cfg),
 		BronzeS1NetworkDiscovery:        NewBronzeS1NetworkDiscoveryClient(cfg),
+		BronzeS1Policy:                  NewBronzeS1PolicyClient(cfg),
 		BronzeS1RangerDevice:            NewBronzeS1RangerDeviceClient(cfg),
 		BronzeS1RangerGateway:           NewBronzeS1RangerGatewayClient(cfg),
 		BronzeS1RangerSetting:           NewBronzeS1RangerSettingClient(cfg),
+		BronzeS1Restriction:             NewBronzeS1RestrictionClient(cfg),
 		BronzeS1Site:                    NewBronzeS1SiteClient(cfg),
+		BronzeS1Threat:                  NewBronzeS1ThreatClient(cfg),
 	}, nil
 }
 
@@ -261,25 +331,39 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		BronzeHistoryS1Account:          NewBronzeHistoryS1AccountClient(cfg),
 		BronzeHistoryS1Agent:            NewBronzeHistoryS1AgentClient(cfg),
 		BronzeHistoryS1AgentNIC:         NewBronzeHistoryS1AgentNICClient(cfg),
+		BronzeHistoryS1Alert:            NewBronzeHistoryS1AlertClient(cfg),
+		BronzeHistoryS1AppCVE:           NewBronzeHistoryS1AppCVEClient(cfg),
 		BronzeHistoryS1AppInventory:     NewBronzeHistoryS1AppInventoryClient(cfg),
 		BronzeHistoryS1EndpointApp:      NewBronzeHistoryS1EndpointAppClient(cfg),
+		BronzeHistoryS1Exclusion:        NewBronzeHistoryS1ExclusionClient(cfg),
 		BronzeHistoryS1Group:            NewBronzeHistoryS1GroupClient(cfg),
 		BronzeHistoryS1NetworkDiscovery: NewBronzeHistoryS1NetworkDiscoveryClient(cfg),
+		BronzeHistoryS1Policy:           NewBronzeHistoryS1PolicyClient(cfg),
 		BronzeHistoryS1RangerDevice:     NewBronzeHistoryS1RangerDeviceClient(cfg),
 		BronzeHistoryS1RangerGateway:    NewBronzeHistoryS1RangerGatewayClient(cfg),
 		BronzeHistoryS1RangerSetting:    NewBronzeHistoryS1RangerSettingClient(cfg),
+		BronzeHistoryS1Restriction:      NewBronzeHistoryS1RestrictionClient(cfg),
 		BronzeHistoryS1Site:             NewBronzeHistoryS1SiteClient(cfg),
+		BronzeHistoryS1Threat:           NewBronzeHistoryS1ThreatClient(cfg),
 		BronzeS1Account:                 NewBronzeS1AccountClient(cfg),
+		BronzeS1Activity:                NewBronzeS1ActivityClient(cfg),
 		BronzeS1Agent:                   NewBronzeS1AgentClient(cfg),
 		BronzeS1AgentNIC:                NewBronzeS1AgentNICClient(cfg),
+		BronzeS1Alert:                   NewBronzeS1AlertClient(cfg),
+		BronzeS1AppCVE:                  NewBronzeS1AppCVEClient(cfg),
 		BronzeS1AppInventory:            NewBronzeS1AppInventoryClient(cfg),
 		BronzeS1EndpointApp:             NewBronzeS1EndpointAppClient(cfg),
+		BronzeS1Exclusion:               NewBronzeS1ExclusionClient(cfg),
 		BronzeS1Group:                   NewBronzeS1GroupClient(cfg),
+		BronzeS1IngestCursor:            NewBronzeS1IngestCursorClient(cfg),
 		BronzeS1NetworkDiscovery:        NewBronzeS1NetworkDiscoveryClient(cfg),
+		BronzeS1Policy:                  NewBronzeS1PolicyClient(cfg),
 		BronzeS1RangerDevice:            NewBronzeS1RangerDeviceClient(cfg),
 		BronzeS1RangerGateway:           NewBronzeS1RangerGatewayClient(cfg),
 		BronzeS1RangerSetting:           NewBronzeS1RangerSettingClient(cfg),
+		BronzeS1Restriction:             NewBronzeS1RestrictionClient(cfg),
 		BronzeS1Site:                    NewBronzeS1SiteClient(cfg),
+		BronzeS1Threat:                  NewBronzeS1ThreatClient(cfg),
 	}, nil
 }
 
@@ -310,14 +394,18 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
 		c.BronzeHistoryS1Account, c.BronzeHistoryS1Agent, c.BronzeHistoryS1AgentNIC,
-		c.BronzeHistoryS1AppInventory, c.BronzeHistoryS1EndpointApp,
+		c.BronzeHistoryS1Alert, c.BronzeHistoryS1AppCVE, c.BronzeHistoryS1AppInventory,
+		c.BronzeHistoryS1EndpointApp, c.BronzeHistoryS1Exclusion,
 		c.BronzeHistoryS1Group, c.BronzeHistoryS1NetworkDiscovery,
-		c.BronzeHistoryS1RangerDevice, c.BronzeHistoryS1RangerGateway,
-		c.BronzeHistoryS1RangerSetting, c.BronzeHistoryS1Site, c.BronzeS1Account,
-		c.BronzeS1Agent, c.BronzeS1AgentNIC, c.BronzeS1AppInventory,
-		c.BronzeS1EndpointApp, c.BronzeS1Group, c.BronzeS1NetworkDiscovery,
+		c.BronzeHistoryS1Policy, c.BronzeHistoryS1RangerDevice,
+		c.BronzeHistoryS1RangerGateway, c.BronzeHistoryS1RangerSetting,
+		c.BronzeHistoryS1Restriction, c.BronzeHistoryS1Site, c.BronzeHistoryS1Threat,
+		c.BronzeS1Account, c.BronzeS1Activity, c.BronzeS1Agent, c.BronzeS1AgentNIC,
+		c.BronzeS1Alert, c.BronzeS1AppCVE, c.BronzeS1AppInventory,
+		c.BronzeS1EndpointApp, c.BronzeS1Exclusion, c.BronzeS1Group,
+		c.BronzeS1IngestCursor, c.BronzeS1NetworkDiscovery, c.BronzeS1Policy,
 		c.BronzeS1RangerDevice, c.BronzeS1RangerGateway, c.BronzeS1RangerSetting,
-		c.BronzeS1Site,
+		c.BronzeS1Restriction, c.BronzeS1Site, c.BronzeS1Threat,
 	} {
 		n.Use(hooks...)
 	}
@@ -328,14 +416,18 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
 		c.BronzeHistoryS1Account, c.BronzeHistoryS1Agent, c.BronzeHistoryS1AgentNIC,
-		c.BronzeHistoryS1AppInventory, c.BronzeHistoryS1EndpointApp,
+		c.BronzeHistoryS1Alert, c.BronzeHistoryS1AppCVE, c.BronzeHistoryS1AppInventory,
+		c.BronzeHistoryS1EndpointApp, c.BronzeHistoryS1Exclusion,
 		c.BronzeHistoryS1Group, c.BronzeHistoryS1NetworkDiscovery,
-		c.BronzeHistoryS1RangerDevice, c.BronzeHistoryS1RangerGateway,
-		c.BronzeHistoryS1RangerSetting, c.BronzeHistoryS1Site, c.BronzeS1Account,
-		c.BronzeS1Agent, c.BronzeS1AgentNIC, c.BronzeS1AppInventory,
-		c.BronzeS1EndpointApp, c.BronzeS1Group, c.BronzeS1NetworkDiscovery,
+		c.BronzeHistoryS1Policy, c.BronzeHistoryS1RangerDevice,
+		c.BronzeHistoryS1RangerGateway, c.BronzeHistoryS1RangerSetting,
+		c.BronzeHistoryS1Restriction, c.BronzeHistoryS1Site, c.BronzeHistoryS1Threat,
+		c.BronzeS1Account, c.BronzeS1Activity, c.BronzeS1Agent, c.BronzeS1AgentNIC,
+		c.BronzeS1Alert, c.BronzeS1AppCVE, c.BronzeS1AppInventory,
+		c.BronzeS1EndpointApp, c.BronzeS1Exclusion, c.BronzeS1Group,
+		c.BronzeS1IngestCursor, c.BronzeS1NetworkDiscovery, c.BronzeS1Policy,
 		c.BronzeS1RangerDevice, c.BronzeS1RangerGateway, c.BronzeS1RangerSetting,
-		c.BronzeS1Site,
+		c.BronzeS1Restriction, c.BronzeS1Site, c.BronzeS1Threat,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -350,44 +442,72 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.BronzeHistoryS1Agent.mutate(ctx, m)
 	case *BronzeHistoryS1AgentNICMutation:
 		return c.BronzeHistoryS1AgentNIC.mutate(ctx, m)
+	case *BronzeHistoryS1AlertMutation:
+		return c.BronzeHistoryS1Alert.mutate(ctx, m)
+	case *BronzeHistoryS1AppCVEMutation:
+		return c.BronzeHistoryS1AppCVE.mutate(ctx, m)
 	case *BronzeHistoryS1AppInventoryMutation:
 		return c.BronzeHistoryS1AppInventory.mutate(ctx, m)
 	case *BronzeHistoryS1EndpointAppMutation:
 		return c.BronzeHistoryS1EndpointApp.mutate(ctx, m)
+	case *BronzeHistoryS1ExclusionMutation:
+		return c.BronzeHistoryS1Exclusion.mutate(ctx, m)
 	case *BronzeHistoryS1GroupMutation:
 		return c.BronzeHistoryS1Group.mutate(ctx, m)
 	case *BronzeHistoryS1NetworkDiscoveryMutation:
 		return c.BronzeHistoryS1NetworkDiscovery.mutate(ctx, m)
+	case *BronzeHistoryS1PolicyMutation:
+		return c.BronzeHistoryS1Policy.mutate(ctx, m)
 	case *BronzeHistoryS1RangerDeviceMutation:
 		return c.BronzeHistoryS1RangerDevice.mutate(ctx, m)
 	case *BronzeHistoryS1RangerGatewayMutation:
 		return c.BronzeHistoryS1RangerGateway.mutate(ctx, m)
 	case *BronzeHistoryS1RangerSettingMutation:
 		return c.BronzeHistoryS1RangerSetting.mutate(ctx, m)
+	case *BronzeHistoryS1RestrictionMutation:
+		return c.BronzeHistoryS1Restriction.mutate(ctx, m)
 	case *BronzeHistoryS1SiteMutation:
 		return c.BronzeHistoryS1Site.mutate(ctx, m)
+	case *BronzeHistoryS1ThreatMutation:
+		return c.BronzeHistoryS1Threat.mutate(ctx, m)
 	case *BronzeS1AccountMutation:
 		return c.BronzeS1Account.mutate(ctx, m)
+	case *BronzeS1ActivityMutation:
+		return c.BronzeS1Activity.mutate(ctx, m)
 	case *BronzeS1AgentMutation:
 		return c.BronzeS1Agent.mutate(ctx, m)
 	case *BronzeS1AgentNICMutation:
 		return c.BronzeS1AgentNIC.mutate(ctx, m)
+	case *BronzeS1AlertMutation:
+		return c.BronzeS1Alert.mutate(ctx, m)
+	case *BronzeS1AppCVEMutation:
+		return c.BronzeS1AppCVE.mutate(ctx, m)
 	case *BronzeS1AppInventoryMutation:
 		return c.BronzeS1AppInventory.mutate(ctx, m)
 	case *BronzeS1EndpointAppMutation:
 		return c.BronzeS1EndpointApp.mutate(ctx, m)
+	case *BronzeS1ExclusionMutation:
+		return c.BronzeS1Exclusion.mutate(ctx, m)
 	case *BronzeS1GroupMutation:
 		return c.BronzeS1Group.mutate(ctx, m)
+	case *BronzeS1IngestCursorMutation:
+		return c.BronzeS1IngestCursor.mutate(ctx, m)
 	case *BronzeS1NetworkDiscoveryMutation:
 		return c.BronzeS1NetworkDiscovery.mutate(ctx, m)
+	case *BronzeS1PolicyMutation:
+		return c.BronzeS1Policy.mutate(ctx, m)
 	case *BronzeS1RangerDeviceMutation:
 		return c.BronzeS1RangerDevice.mutate(ctx, m)
 	case *BronzeS1RangerGatewayMutation:
 		return c.BronzeS1RangerGateway.mutate(ctx, m)
 	case *BronzeS1RangerSettingMutation:
 		return c.BronzeS1RangerSetting.mutate(ctx, m)
+	case *BronzeS1RestrictionMutation:
+		return c.BronzeS1Restriction.mutate(ctx, m)
 	case *BronzeS1SiteMutation:
 		return c.BronzeS1Site.mutate(ctx, m)
+	case *BronzeS1ThreatMutation:
+		return c.BronzeS1Threat.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("s1: unknown mutation type %T", m)
 	}
@@ -792,6 +912,272 @@ func (c *BronzeHistoryS1AgentNICClient) mutate(ctx context.Context, m *BronzeHis
 	}
 }
 
+// BronzeHistoryS1AlertClient is a client for the BronzeHistoryS1Alert schema.
+type BronzeHistoryS1AlertClient struct {
+	config
+}
+
+// NewBronzeHistoryS1AlertClient returns a client for the BronzeHistoryS1Alert from the given config.
+func NewBronzeHistoryS1AlertClient(c config) *BronzeHistoryS1AlertClient {
+	return &BronzeHistoryS1AlertClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistorys1alert.Hooks(f(g(h())))`.
+func (c *BronzeHistoryS1AlertClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryS1Alert = append(c.hooks.BronzeHistoryS1Alert, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistorys1alert.Intercept(f(g(h())))`.
+func (c *BronzeHistoryS1AlertClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryS1Alert = append(c.inters.BronzeHistoryS1Alert, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryS1Alert entity.
+func (c *BronzeHistoryS1AlertClient) Create() *BronzeHistoryS1AlertCreate {
+	mutation := newBronzeHistoryS1AlertMutation(c.config, OpCreate)
+	return &BronzeHistoryS1AlertCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryS1Alert entities.
+func (c *BronzeHistoryS1AlertClient) CreateBulk(builders ...*BronzeHistoryS1AlertCreate) *BronzeHistoryS1AlertCreateBulk {
+	return &BronzeHistoryS1AlertCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryS1AlertClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryS1AlertCreate, int)) *BronzeHistoryS1AlertCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryS1AlertCreateBulk{err: fmt.Errorf("calling to BronzeHistoryS1AlertClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryS1AlertCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryS1AlertCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryS1Alert.
+func (c *BronzeHistoryS1AlertClient) Update() *BronzeHistoryS1AlertUpdate {
+	mutation := newBronzeHistoryS1AlertMutation(c.config, OpUpdate)
+	return &BronzeHistoryS1AlertUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryS1AlertClient) UpdateOne(_m *BronzeHistoryS1Alert) *BronzeHistoryS1AlertUpdateOne {
+	mutation := newBronzeHistoryS1AlertMutation(c.config, OpUpdateOne, withBronzeHistoryS1Alert(_m))
+	return &BronzeHistoryS1AlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryS1AlertClient) UpdateOneID(id uint) *BronzeHistoryS1AlertUpdateOne {
+	mutation := newBronzeHistoryS1AlertMutation(c.config, OpUpdateOne, withBronzeHistoryS1AlertID(id))
+	return &BronzeHistoryS1AlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryS1Alert.
+func (c *BronzeHistoryS1AlertClient) Delete() *BronzeHistoryS1AlertDelete {
+	mutation := newBronzeHistoryS1AlertMutation(c.config, OpDelete)
+	return &BronzeHistoryS1AlertDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryS1AlertClient) DeleteOne(_m *BronzeHistoryS1Alert) *BronzeHistoryS1AlertDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryS1AlertClient) DeleteOneID(id uint) *BronzeHistoryS1AlertDeleteOne {
+	builder := c.Delete().Where(bronzehistorys1alert.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryS1AlertDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryS1Alert.
+func (c *BronzeHistoryS1AlertClient) Query() *BronzeHistoryS1AlertQuery {
+	return &BronzeHistoryS1AlertQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryS1Alert},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryS1Alert entity by its id.
+func (c *BronzeHistoryS1AlertClient) Get(ctx context.Context, id uint) (*BronzeHistoryS1Alert, error) {
+	return c.Query().Where(bronzehistorys1alert.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryS1AlertClient) GetX(ctx context.Context, id uint) *BronzeHistoryS1Alert {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryS1AlertClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryS1Alert
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryS1AlertClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryS1Alert
+}
+
+func (c *BronzeHistoryS1AlertClient) mutate(ctx context.Context, m *BronzeHistoryS1AlertMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryS1AlertCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryS1AlertUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryS1AlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryS1AlertDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("s1: unknown BronzeHistoryS1Alert mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryS1AppCVEClient is a client for the BronzeHistoryS1AppCVE schema.
+type BronzeHistoryS1AppCVEClient struct {
+	config
+}
+
+// NewBronzeHistoryS1AppCVEClient returns a client for the BronzeHistoryS1AppCVE from the given config.
+func NewBronzeHistoryS1AppCVEClient(c config) *BronzeHistoryS1AppCVEClient {
+	return &BronzeHistoryS1AppCVEClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistorys1appcve.Hooks(f(g(h())))`.
+func (c *BronzeHistoryS1AppCVEClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryS1AppCVE = append(c.hooks.BronzeHistoryS1AppCVE, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistorys1appcve.Intercept(f(g(h())))`.
+func (c *BronzeHistoryS1AppCVEClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryS1AppCVE = append(c.inters.BronzeHistoryS1AppCVE, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryS1AppCVE entity.
+func (c *BronzeHistoryS1AppCVEClient) Create() *BronzeHistoryS1AppCVECreate {
+	mutation := newBronzeHistoryS1AppCVEMutation(c.config, OpCreate)
+	return &BronzeHistoryS1AppCVECreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryS1AppCVE entities.
+func (c *BronzeHistoryS1AppCVEClient) CreateBulk(builders ...*BronzeHistoryS1AppCVECreate) *BronzeHistoryS1AppCVECreateBulk {
+	return &BronzeHistoryS1AppCVECreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryS1AppCVEClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryS1AppCVECreate, int)) *BronzeHistoryS1AppCVECreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryS1AppCVECreateBulk{err: fmt.Errorf("calling to BronzeHistoryS1AppCVEClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryS1AppCVECreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryS1AppCVECreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryS1AppCVE.
+func (c *BronzeHistoryS1AppCVEClient) Update() *BronzeHistoryS1AppCVEUpdate {
+	mutation := newBronzeHistoryS1AppCVEMutation(c.config, OpUpdate)
+	return &BronzeHistoryS1AppCVEUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryS1AppCVEClient) UpdateOne(_m *BronzeHistoryS1AppCVE) *BronzeHistoryS1AppCVEUpdateOne {
+	mutation := newBronzeHistoryS1AppCVEMutation(c.config, OpUpdateOne, withBronzeHistoryS1AppCVE(_m))
+	return &BronzeHistoryS1AppCVEUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryS1AppCVEClient) UpdateOneID(id uint) *BronzeHistoryS1AppCVEUpdateOne {
+	mutation := newBronzeHistoryS1AppCVEMutation(c.config, OpUpdateOne, withBronzeHistoryS1AppCVEID(id))
+	return &BronzeHistoryS1AppCVEUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryS1AppCVE.
+func (c *BronzeHistoryS1AppCVEClient) Delete() *BronzeHistoryS1AppCVEDelete {
+	mutation := newBronzeHistoryS1AppCVEMutation(c.config, OpDelete)
+	return &BronzeHistoryS1AppCVEDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryS1AppCVEClient) DeleteOne(_m *BronzeHistoryS1AppCVE) *BronzeHistoryS1AppCVEDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryS1AppCVEClient) DeleteOneID(id uint) *BronzeHistoryS1AppCVEDeleteOne {
+	builder := c.Delete().Where(bronzehistorys1appcve.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryS1AppCVEDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryS1AppCVE.
+func (c *BronzeHistoryS1AppCVEClient) Query() *BronzeHistoryS1AppCVEQuery {
+	return &BronzeHistoryS1AppCVEQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryS1AppCVE},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryS1AppCVE entity by its id.
+func (c *BronzeHistoryS1AppCVEClient) Get(ctx context.Context, id uint) (*BronzeHistoryS1AppCVE, error) {
+	return c.Query().Where(bronzehistorys1appcve.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryS1AppCVEClient) GetX(ctx context.Context, id uint) *BronzeHistoryS1AppCVE {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryS1AppCVEClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryS1AppCVE
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryS1AppCVEClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryS1AppCVE
+}
+
+func (c *BronzeHistoryS1AppCVEClient) mutate(ctx context.Context, m *BronzeHistoryS1AppCVEMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryS1AppCVECreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryS1AppCVEUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryS1AppCVEUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryS1AppCVEDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("s1: unknown BronzeHistoryS1AppCVE mutation op: %q", m.Op())
+	}
+}
+
 // BronzeHistoryS1AppInventoryClient is a client for the BronzeHistoryS1AppInventory schema.
 type BronzeHistoryS1AppInventoryClient struct {
 	config
@@ -1058,6 +1444,139 @@ func (c *BronzeHistoryS1EndpointAppClient) mutate(ctx context.Context, m *Bronze
 	}
 }
 
+// BronzeHistoryS1ExclusionClient is a client for the BronzeHistoryS1Exclusion schema.
+type BronzeHistoryS1ExclusionClient struct {
+	config
+}
+
+// NewBronzeHistoryS1ExclusionClient returns a client for the BronzeHistoryS1Exclusion from the given config.
+func NewBronzeHistoryS1ExclusionClient(c config) *BronzeHistoryS1ExclusionClient {
+	return &BronzeHistoryS1ExclusionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistorys1exclusion.Hooks(f(g(h())))`.
+func (c *BronzeHistoryS1ExclusionClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryS1Exclusion = append(c.hooks.BronzeHistoryS1Exclusion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistorys1exclusion.Intercept(f(g(h())))`.
+func (c *BronzeHistoryS1ExclusionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryS1Exclusion = append(c.inters.BronzeHistoryS1Exclusion, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryS1Exclusion entity.
+func (c *BronzeHistoryS1ExclusionClient) Create() *BronzeHistoryS1ExclusionCreate {
+	mutation := newBronzeHistoryS1ExclusionMutation(c.config, OpCreate)
+	return &BronzeHistoryS1ExclusionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryS1Exclusion entities.
+func (c *BronzeHistoryS1ExclusionClient) CreateBulk(builders ...*BronzeHistoryS1ExclusionCreate) *BronzeHistoryS1ExclusionCreateBulk {
+	return &BronzeHistoryS1ExclusionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryS1ExclusionClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryS1ExclusionCreate, int)) *BronzeHistoryS1ExclusionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryS1ExclusionCreateBulk{err: fmt.Errorf("calling to BronzeHistoryS1ExclusionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryS1ExclusionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryS1ExclusionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryS1Exclusion.
+func (c *BronzeHistoryS1ExclusionClient) Update() *BronzeHistoryS1ExclusionUpdate {
+	mutation := newBronzeHistoryS1ExclusionMutation(c.config, OpUpdate)
+	return &BronzeHistoryS1ExclusionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryS1ExclusionClient) UpdateOne(_m *BronzeHistoryS1Exclusion) *BronzeHistoryS1ExclusionUpdateOne {
+	mutation := newBronzeHistoryS1ExclusionMutation(c.config, OpUpdateOne, withBronzeHistoryS1Exclusion(_m))
+	return &BronzeHistoryS1ExclusionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryS1ExclusionClient) UpdateOneID(id uint) *BronzeHistoryS1ExclusionUpdateOne {
+	mutation := newBronzeHistoryS1ExclusionMutation(c.config, OpUpdateOne, withBronzeHistoryS1ExclusionID(id))
+	return &BronzeHistoryS1ExclusionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryS1Exclusion.
+func (c *BronzeHistoryS1ExclusionClient) Delete() *BronzeHistoryS1ExclusionDelete {
+	mutation := newBronzeHistoryS1ExclusionMutation(c.config, OpDelete)
+	return &BronzeHistoryS1ExclusionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryS1ExclusionClient) DeleteOne(_m *BronzeHistoryS1Exclusion) *BronzeHistoryS1ExclusionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryS1ExclusionClient) DeleteOneID(id uint) *BronzeHistoryS1ExclusionDeleteOne {
+	builder := c.Delete().Where(bronzehistorys1exclusion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryS1ExclusionDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryS1Exclusion.
+func (c *BronzeHistoryS1ExclusionClient) Query() *BronzeHistoryS1ExclusionQuery {
+	return &BronzeHistoryS1ExclusionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryS1Exclusion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryS1Exclusion entity by its id.
+func (c *BronzeHistoryS1ExclusionClient) Get(ctx context.Context, id uint) (*BronzeHistoryS1Exclusion, error) {
+	return c.Query().Where(bronzehistorys1exclusion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryS1ExclusionClient) GetX(ctx context.Context, id uint) *BronzeHistoryS1Exclusion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryS1ExclusionClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryS1Exclusion
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryS1ExclusionClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryS1Exclusion
+}
+
+func (c *BronzeHistoryS1ExclusionClient) mutate(ctx context.Context, m *BronzeHistoryS1ExclusionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryS1ExclusionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryS1ExclusionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryS1ExclusionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryS1ExclusionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("s1: unknown BronzeHistoryS1Exclusion mutation op: %q", m.Op())
+	}
+}
+
 // BronzeHistoryS1GroupClient is a client for the BronzeHistoryS1Group schema.
 type BronzeHistoryS1GroupClient struct {
 	config
@@ -1324,69 +1843,202 @@ func (c *BronzeHistoryS1NetworkDiscoveryClient) mutate(ctx context.Context, m *B
 	}
 }
 
-// BronzeHistoryS1RangerDeviceClient is a client for the BronzeHistoryS1RangerDevice schema.
-type BronzeHistoryS1RangerDeviceClient struct {
+// BronzeHistoryS1PolicyClient is a client for the BronzeHistoryS1Policy schema.
+type BronzeHistoryS1PolicyClient struct {
 	config
 }
 
-// NewBronzeHistoryS1RangerDeviceClient returns a client for the BronzeHistoryS1RangerDevice from the given config.
-func NewBronzeHistoryS1RangerDeviceClient(c config) *BronzeHistoryS1RangerDeviceClient {
-	return &BronzeHistoryS1RangerDeviceClient{config: c}
+// NewBronzeHistoryS1PolicyClient returns a client for the BronzeHistoryS1Policy from the given config.
+func NewBronzeHistoryS1PolicyClient(c config) *BronzeHistoryS1PolicyClient {
+	return &BronzeHistoryS1PolicyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `bronzehistorys1rangerdevice.Hooks(f(g(h())))`.
-func (c *BronzeHistoryS1RangerDeviceClient) Use(hooks ...Hook) {
-	c.hooks.BronzeHistoryS1RangerDevice = append(c.hooks.BronzeHistoryS1RangerDevice, hooks...)
+// A call to `Use(f, g, h)` equals to `bronzehistorys1policy.Hooks(f(g(h())))`.
+func (c *BronzeHistoryS1PolicyClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryS1Policy = append(c.hooks.BronzeHistoryS1Policy, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `bronzehistorys1rangerdevice.Intercept(f(g(h())))`.
-func (c *BronzeHistoryS1RangerDeviceClient) Intercept(interceptors ...Interceptor) {
-	c.inters.BronzeHistoryS1RangerDevice = append(c.inters.BronzeHistoryS1RangerDevice, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `bronzehistorys1policy.Intercept(f(g(h())))`.
+func (c *BronzeHistoryS1PolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryS1Policy = append(c.inters.BronzeHistoryS1Policy, interceptors...)
 }
 
-// Create returns a builder for creating a BronzeHistoryS1RangerDevice entity.
-func (c *BronzeHistoryS1RangerDeviceClient) Create() *BronzeHistoryS1RangerDeviceCreate {
-	mutation := newBronzeHistoryS1RangerDeviceMutation(c.config, OpCreate)
-	return &BronzeHistoryS1RangerDeviceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BronzeHistoryS1Policy entity.
+func (c *BronzeHistoryS1PolicyClient) Create() *BronzeHistoryS1PolicyCreate {
+	mutation := newBronzeHistoryS1PolicyMutation(c.config, OpCreate)
+	return &BronzeHistoryS1PolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of BronzeHistoryS1RangerDevice entities.
-func (c *BronzeHistoryS1RangerDeviceClient) CreateBulk(builders ...*BronzeHistoryS1RangerDeviceCreate) *BronzeHistoryS1RangerDeviceCreateBulk {
-	return &BronzeHistoryS1RangerDeviceCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryS1Policy entities.
+func (c *BronzeHistoryS1PolicyClient) CreateBulk(builders ...*BronzeHistoryS1PolicyCreate) *BronzeHistoryS1PolicyCreateBulk {
+	return &BronzeHistoryS1PolicyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *BronzeHistoryS1RangerDeviceClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryS1RangerDeviceCreate, int)) *BronzeHistoryS1RangerDeviceCreateBulk {
+func (c *BronzeHistoryS1PolicyClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryS1PolicyCreate, int)) *BronzeHistoryS1PolicyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &BronzeHistoryS1RangerDeviceCreateBulk{err: fmt.Errorf("calling to BronzeHistoryS1RangerDeviceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BronzeHistoryS1PolicyCreateBulk{err: fmt.Errorf("calling to BronzeHistoryS1PolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*BronzeHistoryS1RangerDeviceCreate, rv.Len())
+	builders := make([]*BronzeHistoryS1PolicyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &BronzeHistoryS1RangerDeviceCreateBulk{config: c.config, builders: builders}
+	return &BronzeHistoryS1PolicyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for BronzeHistoryS1RangerDevice.
-func (c *BronzeHistoryS1RangerDeviceClient) Update() *BronzeHistoryS1RangerDeviceUpdate {
-	mutation := newBronzeHistoryS1RangerDeviceMutation(c.config, OpUpdate)
-	return &BronzeHistoryS1RangerDeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BronzeHistoryS1Policy.
+func (c *BronzeHistoryS1PolicyClient) Update() *BronzeHistoryS1PolicyUpdate {
+	mutation := newBronzeHistoryS1PolicyMutation(c.config, OpUpdate)
+	return &BronzeHistoryS1PolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *BronzeHistoryS1RangerDeviceClient) UpdateOne(_m *BronzeHistoryS1RangerDevice) *BronzeHistoryS1RangerDeviceUpdateOne {
-	mutation := newBronzeHistoryS1RangerDeviceMutation(c.config, OpUpdateOne, withBronzeHistoryS1RangerDevice(_m))
-	return &BronzeHistoryS1RangerDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BronzeHistoryS1PolicyClient) UpdateOne(_m *BronzeHistoryS1Policy) *BronzeHistoryS1PolicyUpdateOne {
+	mutation := newBronzeHistoryS1PolicyMutation(c.config, OpUpdateOne, withBronzeHistoryS1Policy(_m))
+	return &BronzeHistoryS1PolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *BronzeHistoryS1RangerDeviceClient) UpdateOneID(id uint) *BronzeHistoryS1RangerDeviceUpdateOne {
-	mutation := newBronzeHistoryS1RangerDeviceMutation(c.config, OpUpdateOne, withBronzeHistoryS1RangerDeviceID(id))
+func (c *BronzeHistoryS1PolicyClient) UpdateOneID(id uint) *BronzeHistoryS1PolicyUpdateOne {
+	mutation := newBronzeHistoryS1PolicyMutation(c.config, OpUpdateOne, withBronzeHistoryS1PolicyID(id))
+	return &BronzeHistoryS1PolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryS1Policy.
+func (c *BronzeHistoryS1PolicyClient) Delete() *BronzeHistoryS1PolicyDelete {
+	mutation := newBronzeHistoryS1PolicyMutation(c.config, OpDelete)
+	return &BronzeHistoryS1PolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryS1PolicyClient) DeleteOne(_m *BronzeHistoryS1Policy) *BronzeHistoryS1PolicyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryS1PolicyClient) DeleteOneID(id uint) *BronzeHistoryS1PolicyDeleteOne {
+	builder := c.Delete().Where(bronzehistorys1policy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryS1PolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryS1Policy.
+func (c *BronzeHistoryS1PolicyClient) Query() *BronzeHistoryS1PolicyQuery {
+	return &BronzeHistoryS1PolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryS1Policy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryS1Policy entity by its id.
+func (c *BronzeHistoryS1PolicyClient) Get(ctx context.Context, id uint) (*BronzeHistoryS1Policy, error) {
+	return c.Query().Where(bronzehistorys1policy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryS1PolicyClient) GetX(ctx context.Context, id uint) *BronzeHistoryS1Policy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryS1PolicyClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryS1Policy
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryS1PolicyClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryS1Policy
+}
+
+func (c *BronzeHistoryS1PolicyClient) mutate(ctx context.Context, m *BronzeHistoryS1PolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryS1PolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryS1PolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryS1PolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryS1PolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("s1: unknown BronzeHistoryS1Policy mutation op: %q", m.Op())
+	}
+}
+
+// BronzeHistoryS1RangerDeviceClient is a client for the BronzeHistoryS1RangerDevice schema.
+type BronzeHistoryS1RangerDeviceClient struct {
+	config
+}
+
+// NewBronzeHistoryS1RangerDeviceClient returns a client for the BronzeHistoryS1RangerDevice from the given config.
+func NewBronzeHistoryS1RangerDeviceClient(c config) *BronzeHistoryS1RangerDeviceClient {
+	return &BronzeHistoryS1RangerDeviceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistorys1rangerdevice.Hooks(f(g(h())))`.
+func (c *BronzeHistoryS1RangerDeviceClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryS1RangerDevice = append(c.hooks.BronzeHistoryS1RangerDevice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistorys1rangerdevice.Intercept(f(g(h())))`.
+func (c *BronzeHistoryS1RangerDeviceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryS1RangerDevice = append(c.inters.BronzeHistoryS1RangerDevice, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryS1RangerDevice entity.
+func (c *BronzeHistoryS1RangerDeviceClient) Create() *BronzeHistoryS1RangerDeviceCreate {
+	mutation := newBronzeHistoryS1RangerDeviceMutation(c.config, OpCreate)
+	return &BronzeHistoryS1RangerDeviceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryS1RangerDevice entities.
+func (c *BronzeHistoryS1RangerDeviceClient) CreateBulk(builders ...*BronzeHistoryS1RangerDeviceCreate) *BronzeHistoryS1RangerDeviceCreateBulk {
+	return &BronzeHistoryS1RangerDeviceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryS1RangerDeviceClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryS1RangerDeviceCreate, int)) *BronzeHistoryS1RangerDeviceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryS1RangerDeviceCreateBulk{err: fmt.Errorf("calling to BronzeHistoryS1RangerDeviceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryS1RangerDeviceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryS1RangerDeviceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryS1RangerDevice.
+func (c *BronzeHistoryS1RangerDeviceClient) Update() *BronzeHistoryS1RangerDeviceUpdate {
+	mutation := newBronzeHistoryS1RangerDeviceMutation(c.config, OpUpdate)
+	return &BronzeHistoryS1RangerDeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryS1RangerDeviceClient) UpdateOne(_m *BronzeHistoryS1RangerDevice) *BronzeHistoryS1RangerDeviceUpdateOne {
+	mutation := newBronzeHistoryS1RangerDeviceMutation(c.config, OpUpdateOne, withBronzeHistoryS1RangerDevice(_m))
+	return &BronzeHistoryS1RangerDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryS1RangerDeviceClient) UpdateOneID(id uint) *BronzeHistoryS1RangerDeviceUpdateOne {
+	mutation := newBronzeHistoryS1RangerDeviceMutation(c.config, OpUpdateOne, withBronzeHistoryS1RangerDeviceID(id))
 	return &BronzeHistoryS1RangerDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
@@ -1723,6 +2375,139 @@ func (c *BronzeHistoryS1RangerSettingClient) mutate(ctx context.Context, m *Bron
 	}
 }
 
+// BronzeHistoryS1RestrictionClient is a client for the BronzeHistoryS1Restriction schema.
+type BronzeHistoryS1RestrictionClient struct {
+	config
+}
+
+// NewBronzeHistoryS1RestrictionClient returns a client for the BronzeHistoryS1Restriction from the given config.
+func NewBronzeHistoryS1RestrictionClient(c config) *BronzeHistoryS1RestrictionClient {
+	return &BronzeHistoryS1RestrictionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistorys1restriction.Hooks(f(g(h())))`.
+func (c *BronzeHistoryS1RestrictionClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryS1Restriction = append(c.hooks.BronzeHistoryS1Restriction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistorys1restriction.Intercept(f(g(h())))`.
+func (c *BronzeHistoryS1RestrictionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryS1Restriction = append(c.inters.BronzeHistoryS1Restriction, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryS1Restriction entity.
+func (c *BronzeHistoryS1RestrictionClient) Create() *BronzeHistoryS1RestrictionCreate {
+	mutation := newBronzeHistoryS1RestrictionMutation(c.config, OpCreate)
+	return &BronzeHistoryS1RestrictionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryS1Restriction entities.
+func (c *BronzeHistoryS1RestrictionClient) CreateBulk(builders ...*BronzeHistoryS1RestrictionCreate) *BronzeHistoryS1RestrictionCreateBulk {
+	return &BronzeHistoryS1RestrictionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryS1RestrictionClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryS1RestrictionCreate, int)) *BronzeHistoryS1RestrictionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryS1RestrictionCreateBulk{err: fmt.Errorf("calling to BronzeHistoryS1RestrictionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryS1RestrictionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryS1RestrictionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryS1Restriction.
+func (c *BronzeHistoryS1RestrictionClient) Update() *BronzeHistoryS1RestrictionUpdate {
+	mutation := newBronzeHistoryS1RestrictionMutation(c.config, OpUpdate)
+	return &BronzeHistoryS1RestrictionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryS1RestrictionClient) UpdateOne(_m *BronzeHistoryS1Restriction) *BronzeHistoryS1RestrictionUpdateOne {
+	mutation := newBronzeHistoryS1RestrictionMutation(c.config, OpUpdateOne, withBronzeHistoryS1Restriction(_m))
+	return &BronzeHistoryS1RestrictionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryS1RestrictionClient) UpdateOneID(id uint) *BronzeHistoryS1RestrictionUpdateOne {
+	mutation := newBronzeHistoryS1RestrictionMutation(c.config, OpUpdateOne, withBronzeHistoryS1RestrictionID(id))
+	return &BronzeHistoryS1RestrictionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryS1Restriction.
+func (c *BronzeHistoryS1RestrictionClient) Delete() *BronzeHistoryS1RestrictionDelete {
+	mutation := newBronzeHistoryS1RestrictionMutation(c.config, OpDelete)
+	return &BronzeHistoryS1RestrictionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryS1RestrictionClient) DeleteOne(_m *BronzeHistoryS1Restriction) *BronzeHistoryS1RestrictionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryS1RestrictionClient) DeleteOneID(id uint) *BronzeHistoryS1RestrictionDeleteOne {
+	builder := c.Delete().Where(bronzehistorys1restriction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryS1RestrictionDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryS1Restriction.
+func (c *BronzeHistoryS1RestrictionClient) Query() *BronzeHistoryS1RestrictionQuery {
+	return &BronzeHistoryS1RestrictionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryS1Restriction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryS1Restriction entity by its id.
+func (c *BronzeHistoryS1RestrictionClient) Get(ctx context.Context, id uint) (*BronzeHistoryS1Restriction, error) {
+	return c.Query().Where(bronzehistorys1restriction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryS1RestrictionClient) GetX(ctx context.Context, id uint) *BronzeHistoryS1Restriction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryS1RestrictionClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryS1Restriction
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryS1RestrictionClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryS1Restriction
+}
+
+func (c *BronzeHistoryS1RestrictionClient) mutate(ctx context.Context, m *BronzeHistoryS1RestrictionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryS1RestrictionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryS1RestrictionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryS1RestrictionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryS1RestrictionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("s1: unknown BronzeHistoryS1Restriction mutation op: %q", m.Op())
+	}
+}
+
 // BronzeHistoryS1SiteClient is a client for the BronzeHistoryS1Site schema.
 type BronzeHistoryS1SiteClient struct {
 	config
@@ -1856,6 +2641,139 @@ func (c *BronzeHistoryS1SiteClient) mutate(ctx context.Context, m *BronzeHistory
 	}
 }
 
+// BronzeHistoryS1ThreatClient is a client for the BronzeHistoryS1Threat schema.
+type BronzeHistoryS1ThreatClient struct {
+	config
+}
+
+// NewBronzeHistoryS1ThreatClient returns a client for the BronzeHistoryS1Threat from the given config.
+func NewBronzeHistoryS1ThreatClient(c config) *BronzeHistoryS1ThreatClient {
+	return &BronzeHistoryS1ThreatClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzehistorys1threat.Hooks(f(g(h())))`.
+func (c *BronzeHistoryS1ThreatClient) Use(hooks ...Hook) {
+	c.hooks.BronzeHistoryS1Threat = append(c.hooks.BronzeHistoryS1Threat, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzehistorys1threat.Intercept(f(g(h())))`.
+func (c *BronzeHistoryS1ThreatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeHistoryS1Threat = append(c.inters.BronzeHistoryS1Threat, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeHistoryS1Threat entity.
+func (c *BronzeHistoryS1ThreatClient) Create() *BronzeHistoryS1ThreatCreate {
+	mutation := newBronzeHistoryS1ThreatMutation(c.config, OpCreate)
+	return &BronzeHistoryS1ThreatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeHistoryS1Threat entities.
+func (c *BronzeHistoryS1ThreatClient) CreateBulk(builders ...*BronzeHistoryS1ThreatCreate) *BronzeHistoryS1ThreatCreateBulk {
+	return &BronzeHistoryS1ThreatCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeHistoryS1ThreatClient) MapCreateBulk(slice any, setFunc func(*BronzeHistoryS1ThreatCreate, int)) *BronzeHistoryS1ThreatCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeHistoryS1ThreatCreateBulk{err: fmt.Errorf("calling to BronzeHistoryS1ThreatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeHistoryS1ThreatCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeHistoryS1ThreatCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeHistoryS1Threat.
+func (c *BronzeHistoryS1ThreatClient) Update() *BronzeHistoryS1ThreatUpdate {
+	mutation := newBronzeHistoryS1ThreatMutation(c.config, OpUpdate)
+	return &BronzeHistoryS1ThreatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeHistoryS1ThreatClient) UpdateOne(_m *BronzeHistoryS1Threat) *BronzeHistoryS1ThreatUpdateOne {
+	mutation := newBronzeHistoryS1ThreatMutation(c.config, OpUpdateOne, withBronzeHistoryS1Threat(_m))
+	return &BronzeHistoryS1ThreatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeHistoryS1ThreatClient) UpdateOneID(id uint) *BronzeHistoryS1ThreatUpdateOne {
+	mutation := newBronzeHistoryS1ThreatMutation(c.config, OpUpdateOne, withBronzeHistoryS1ThreatID(id))
+	return &BronzeHistoryS1ThreatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeHistoryS1Threat.
+func (c *BronzeHistoryS1ThreatClient) Delete() *BronzeHistoryS1ThreatDelete {
+	mutation := newBronzeHistoryS1ThreatMutation(c.config, OpDelete)
+	return &BronzeHistoryS1ThreatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeHistoryS1ThreatClient) DeleteOne(_m *BronzeHistoryS1Threat) *BronzeHistoryS1ThreatDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeHistoryS1ThreatClient) DeleteOneID(id uint) *BronzeHistoryS1ThreatDeleteOne {
+	builder := c.Delete().Where(bronzehistorys1threat.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeHistoryS1ThreatDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeHistoryS1Threat.
+func (c *BronzeHistoryS1ThreatClient) Query() *BronzeHistoryS1ThreatQuery {
+	return &BronzeHistoryS1ThreatQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeHistoryS1Threat},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeHistoryS1Threat entity by its id.
+func (c *BronzeHistoryS1ThreatClient) Get(ctx context.Context, id uint) (*BronzeHistoryS1Threat, error) {
+	return c.Query().Where(bronzehistorys1threat.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeHistoryS1ThreatClient) GetX(ctx context.Context, id uint) *BronzeHistoryS1Threat {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeHistoryS1ThreatClient) Hooks() []Hook {
+	return c.hooks.BronzeHistoryS1Threat
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeHistoryS1ThreatClient) Interceptors() []Interceptor {
+	return c.inters.BronzeHistoryS1Threat
+}
+
+func (c *BronzeHistoryS1ThreatClient) mutate(ctx context.Context, m *BronzeHistoryS1ThreatMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeHistoryS1ThreatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeHistoryS1ThreatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeHistoryS1ThreatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeHistoryS1ThreatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("s1: unknown BronzeHistoryS1Threat mutation op: %q", m.Op())
+	}
+}
+
 // BronzeS1AccountClient is a client for the BronzeS1Account schema.
 type BronzeS1AccountClient struct {
 	config
@@ -1989,6 +2907,139 @@ func (c *BronzeS1AccountClient) mutate(ctx context.Context, m *BronzeS1AccountMu
 	}
 }
 
+// BronzeS1ActivityClient is a client for the BronzeS1Activity schema.
+type BronzeS1ActivityClient struct {
+	config
+}
+
+// NewBronzeS1ActivityClient returns a client for the BronzeS1Activity from the given config.
+func NewBronzeS1ActivityClient(c config) *BronzeS1ActivityClient {
+	return &BronzeS1ActivityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzes1activity.Hooks(f(g(h())))`.
+func (c *BronzeS1ActivityClient) Use(hooks ...Hook) {
+	c.hooks.BronzeS1Activity = append(c.hooks.BronzeS1Activity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzes1activity.Intercept(f(g(h())))`.
+func (c *BronzeS1ActivityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeS1Activity = append(c.inters.BronzeS1Activity, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeS1Activity entity.
+func (c *BronzeS1ActivityClient) Create() *BronzeS1ActivityCreate {
+	mutation := newBronzeS1ActivityMutation(c.config, OpCreate)
+	return &BronzeS1ActivityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeS1Activity entities.
+func (c *BronzeS1ActivityClient) CreateBulk(builders ...*BronzeS1ActivityCreate) *BronzeS1ActivityCreateBulk {
+	return &BronzeS1ActivityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeS1ActivityClient) MapCreateBulk(slice any, setFunc func(*BronzeS1ActivityCreate, int)) *BronzeS1ActivityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeS1ActivityCreateBulk{err: fmt.Errorf("calling to BronzeS1ActivityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeS1ActivityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeS1ActivityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeS1Activity.
+func (c *BronzeS1ActivityClient) Update() *BronzeS1ActivityUpdate {
+	mutation := newBronzeS1ActivityMutation(c.config, OpUpdate)
+	return &BronzeS1ActivityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeS1ActivityClient) UpdateOne(_m *BronzeS1Activity) *BronzeS1ActivityUpdateOne {
+	mutation := newBronzeS1ActivityMutation(c.config, OpUpdateOne, withBronzeS1Activity(_m))
+	return &BronzeS1ActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeS1ActivityClient) UpdateOneID(id string) *BronzeS1ActivityUpdateOne {
+	mutation := newBronzeS1ActivityMutation(c.config, OpUpdateOne, withBronzeS1ActivityID(id))
+	return &BronzeS1ActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeS1Activity.
+func (c *BronzeS1ActivityClient) Delete() *BronzeS1ActivityDelete {
+	mutation := newBronzeS1ActivityMutation(c.config, OpDelete)
+	return &BronzeS1ActivityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeS1ActivityClient) DeleteOne(_m *BronzeS1Activity) *BronzeS1ActivityDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeS1ActivityClient) DeleteOneID(id string) *BronzeS1ActivityDeleteOne {
+	builder := c.Delete().Where(bronzes1activity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeS1ActivityDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeS1Activity.
+func (c *BronzeS1ActivityClient) Query() *BronzeS1ActivityQuery {
+	return &BronzeS1ActivityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeS1Activity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeS1Activity entity by its id.
+func (c *BronzeS1ActivityClient) Get(ctx context.Context, id string) (*BronzeS1Activity, error) {
+	return c.Query().Where(bronzes1activity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeS1ActivityClient) GetX(ctx context.Context, id string) *BronzeS1Activity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeS1ActivityClient) Hooks() []Hook {
+	return c.hooks.BronzeS1Activity
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeS1ActivityClient) Interceptors() []Interceptor {
+	return c.inters.BronzeS1Activity
+}
+
+func (c *BronzeS1ActivityClient) mutate(ctx context.Context, m *BronzeS1ActivityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeS1ActivityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeS1ActivityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeS1ActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeS1ActivityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("s1: unknown BronzeS1Activity mutation op: %q", m.Op())
+	}
+}
+
 // BronzeS1AgentClient is a client for the BronzeS1Agent schema.
 type BronzeS1AgentClient struct {
 	config
@@ -2293,6 +3344,272 @@ func (c *BronzeS1AgentNICClient) mutate(ctx context.Context, m *BronzeS1AgentNIC
 	}
 }
 
+// BronzeS1AlertClient is a client for the BronzeS1Alert schema.
+type BronzeS1AlertClient struct {
+	config
+}
+
+// NewBronzeS1AlertClient returns a client for the BronzeS1Alert from the given config.
+func NewBronzeS1AlertClient(c config) *BronzeS1AlertClient {
+	return &BronzeS1AlertClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzes1alert.Hooks(f(g(h())))`.
+func (c *BronzeS1AlertClient) Use(hooks ...Hook) {
+	c.hooks.BronzeS1Alert = append(c.hooks.BronzeS1Alert, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzes1alert.Intercept(f(g(h())))`.
+func (c *BronzeS1AlertClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeS1Alert = append(c.inters.BronzeS1Alert, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeS1Alert entity.
+func (c *BronzeS1AlertClient) Create() *BronzeS1AlertCreate {
+	mutation := newBronzeS1AlertMutation(c.config, OpCreate)
+	return &BronzeS1AlertCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeS1Alert entities.
+func (c *BronzeS1AlertClient) CreateBulk(builders ...*BronzeS1AlertCreate) *BronzeS1AlertCreateBulk {
+	return &BronzeS1AlertCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeS1AlertClient) MapCreateBulk(slice any, setFunc func(*BronzeS1AlertCreate, int)) *BronzeS1AlertCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeS1AlertCreateBulk{err: fmt.Errorf("calling to BronzeS1AlertClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeS1AlertCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeS1AlertCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeS1Alert.
+func (c *BronzeS1AlertClient) Update() *BronzeS1AlertUpdate {
+	mutation := newBronzeS1AlertMutation(c.config, OpUpdate)
+	return &BronzeS1AlertUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeS1AlertClient) UpdateOne(_m *BronzeS1Alert) *BronzeS1AlertUpdateOne {
+	mutation := newBronzeS1AlertMutation(c.config, OpUpdateOne, withBronzeS1Alert(_m))
+	return &BronzeS1AlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeS1AlertClient) UpdateOneID(id string) *BronzeS1AlertUpdateOne {
+	mutation := newBronzeS1AlertMutation(c.config, OpUpdateOne, withBronzeS1AlertID(id))
+	return &BronzeS1AlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeS1Alert.
+func (c *BronzeS1AlertClient) Delete() *BronzeS1AlertDelete {
+	mutation := newBronzeS1AlertMutation(c.config, OpDelete)
+	return &BronzeS1AlertDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeS1AlertClient) DeleteOne(_m *BronzeS1Alert) *BronzeS1AlertDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeS1AlertClient) DeleteOneID(id string) *BronzeS1AlertDeleteOne {
+	builder := c.Delete().Where(bronzes1alert.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeS1AlertDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeS1Alert.
+func (c *BronzeS1AlertClient) Query() *BronzeS1AlertQuery {
+	return &BronzeS1AlertQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeS1Alert},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeS1Alert entity by its id.
+func (c *BronzeS1AlertClient) Get(ctx context.Context, id string) (*BronzeS1Alert, error) {
+	return c.Query().Where(bronzes1alert.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeS1AlertClient) GetX(ctx context.Context, id string) *BronzeS1Alert {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeS1AlertClient) Hooks() []Hook {
+	return c.hooks.BronzeS1Alert
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeS1AlertClient) Interceptors() []Interceptor {
+	return c.inters.BronzeS1Alert
+}
+
+func (c *BronzeS1AlertClient) mutate(ctx context.Context, m *BronzeS1AlertMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeS1AlertCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeS1AlertUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeS1AlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeS1AlertDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("s1: unknown BronzeS1Alert mutation op: %q", m.Op())
+	}
+}
+
+// BronzeS1AppCVEClient is a client for the BronzeS1AppCVE schema.
+type BronzeS1AppCVEClient struct {
+	config
+}
+
+// NewBronzeS1AppCVEClient returns a client for the BronzeS1AppCVE from the given config.
+func NewBronzeS1AppCVEClient(c config) *BronzeS1AppCVEClient {
+	return &BronzeS1AppCVEClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzes1appcve.Hooks(f(g(h())))`.
+func (c *BronzeS1AppCVEClient) Use(hooks ...Hook) {
+	c.hooks.BronzeS1AppCVE = append(c.hooks.BronzeS1AppCVE, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzes1appcve.Intercept(f(g(h())))`.
+func (c *BronzeS1AppCVEClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeS1AppCVE = append(c.inters.BronzeS1AppCVE, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeS1AppCVE entity.
+func (c *BronzeS1AppCVEClient) Create() *BronzeS1AppCVECreate {
+	mutation := newBronzeS1AppCVEMutation(c.config, OpCreate)
+	return &BronzeS1AppCVECreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeS1AppCVE entities.
+func (c *BronzeS1AppCVEClient) CreateBulk(builders ...*BronzeS1AppCVECreate) *BronzeS1AppCVECreateBulk {
+	return &BronzeS1AppCVECreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeS1AppCVEClient) MapCreateBulk(slice any, setFunc func(*BronzeS1AppCVECreate, int)) *BronzeS1AppCVECreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeS1AppCVECreateBulk{err: fmt.Errorf("calling to BronzeS1AppCVEClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeS1AppCVECreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeS1AppCVECreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeS1AppCVE.
+func (c *BronzeS1AppCVEClient) Update() *BronzeS1AppCVEUpdate {
+	mutation := newBronzeS1AppCVEMutation(c.config, OpUpdate)
+	return &BronzeS1AppCVEUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeS1AppCVEClient) UpdateOne(_m *BronzeS1AppCVE) *BronzeS1AppCVEUpdateOne {
+	mutation := newBronzeS1AppCVEMutation(c.config, OpUpdateOne, withBronzeS1AppCVE(_m))
+	return &BronzeS1AppCVEUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeS1AppCVEClient) UpdateOneID(id string) *BronzeS1AppCVEUpdateOne {
+	mutation := newBronzeS1AppCVEMutation(c.config, OpUpdateOne, withBronzeS1AppCVEID(id))
+	return &BronzeS1AppCVEUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeS1AppCVE.
+func (c *BronzeS1AppCVEClient) Delete() *BronzeS1AppCVEDelete {
+	mutation := newBronzeS1AppCVEMutation(c.config, OpDelete)
+	return &BronzeS1AppCVEDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeS1AppCVEClient) DeleteOne(_m *BronzeS1AppCVE) *BronzeS1AppCVEDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeS1AppCVEClient) DeleteOneID(id string) *BronzeS1AppCVEDeleteOne {
+	builder := c.Delete().Where(bronzes1appcve.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeS1AppCVEDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeS1AppCVE.
+func (c *BronzeS1AppCVEClient) Query() *BronzeS1AppCVEQuery {
+	return &BronzeS1AppCVEQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeS1AppCVE},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeS1AppCVE entity by its id.
+func (c *BronzeS1AppCVEClient) Get(ctx context.Context, id string) (*BronzeS1AppCVE, error) {
+	return c.Query().Where(bronzes1appcve.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeS1AppCVEClient) GetX(ctx context.Context, id string) *BronzeS1AppCVE {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeS1AppCVEClient) Hooks() []Hook {
+	return c.hooks.BronzeS1AppCVE
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeS1AppCVEClient) Interceptors() []Interceptor {
+	return c.inters.BronzeS1AppCVE
+}
+
+func (c *BronzeS1AppCVEClient) mutate(ctx context.Context, m *BronzeS1AppCVEMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeS1AppCVECreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeS1AppCVEUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeS1AppCVEUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeS1AppCVEDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("s1: unknown BronzeS1AppCVE mutation op: %q", m.Op())
+	}
+}
+
 // BronzeS1AppInventoryClient is a client for the BronzeS1AppInventory schema.
 type BronzeS1AppInventoryClient struct {
 	config
@@ -2559,6 +3876,139 @@ func (c *BronzeS1EndpointAppClient) mutate(ctx context.Context, m *BronzeS1Endpo
 	}
 }
 
+// BronzeS1ExclusionClient is a client for the BronzeS1Exclusion schema.
+type BronzeS1ExclusionClient struct {
+	config
+}
+
+// NewBronzeS1ExclusionClient returns a client for the BronzeS1Exclusion from the given config.
+func NewBronzeS1ExclusionClient(c config) *BronzeS1ExclusionClient {
+	return &BronzeS1ExclusionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzes1exclusion.Hooks(f(g(h())))`.
+func (c *BronzeS1ExclusionClient) Use(hooks ...Hook) {
+	c.hooks.BronzeS1Exclusion = append(c.hooks.BronzeS1Exclusion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzes1exclusion.Intercept(f(g(h())))`.
+func (c *BronzeS1ExclusionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeS1Exclusion = append(c.inters.BronzeS1Exclusion, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeS1Exclusion entity.
+func (c *BronzeS1ExclusionClient) Create() *BronzeS1ExclusionCreate {
+	mutation := newBronzeS1ExclusionMutation(c.config, OpCreate)
+	return &BronzeS1ExclusionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeS1Exclusion entities.
+func (c *BronzeS1ExclusionClient) CreateBulk(builders ...*BronzeS1ExclusionCreate) *BronzeS1ExclusionCreateBulk {
+	return &BronzeS1ExclusionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeS1ExclusionClient) MapCreateBulk(slice any, setFunc func(*BronzeS1ExclusionCreate, int)) *BronzeS1ExclusionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeS1ExclusionCreateBulk{err: fmt.Errorf("calling to BronzeS1ExclusionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeS1ExclusionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeS1ExclusionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeS1Exclusion.
+func (c *BronzeS1ExclusionClient) Update() *BronzeS1ExclusionUpdate {
+	mutation := newBronzeS1ExclusionMutation(c.config, OpUpdate)
+	return &BronzeS1ExclusionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeS1ExclusionClient) UpdateOne(_m *BronzeS1Exclusion) *BronzeS1ExclusionUpdateOne {
+	mutation := newBronzeS1ExclusionMutation(c.config, OpUpdateOne, withBronzeS1Exclusion(_m))
+	return &BronzeS1ExclusionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeS1ExclusionClient) UpdateOneID(id string) *BronzeS1ExclusionUpdateOne {
+	mutation := newBronzeS1ExclusionMutation(c.config, OpUpdateOne, withBronzeS1ExclusionID(id))
+	return &BronzeS1ExclusionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeS1Exclusion.
+func (c *BronzeS1ExclusionClient) Delete() *BronzeS1ExclusionDelete {
+	mutation := newBronzeS1ExclusionMutation(c.config, OpDelete)
+	return &BronzeS1ExclusionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeS1ExclusionClient) DeleteOne(_m *BronzeS1Exclusion) *BronzeS1ExclusionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeS1ExclusionClient) DeleteOneID(id string) *BronzeS1ExclusionDeleteOne {
+	builder := c.Delete().Where(bronzes1exclusion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeS1ExclusionDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeS1Exclusion.
+func (c *BronzeS1ExclusionClient) Query() *BronzeS1ExclusionQuery {
+	return &BronzeS1ExclusionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeS1Exclusion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeS1Exclusion entity by its id.
+func (c *BronzeS1ExclusionClient) Get(ctx context.Context, id string) (*BronzeS1Exclusion, error) {
+	return c.Query().Where(bronzes1exclusion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeS1ExclusionClient) GetX(ctx context.Context, id string) *BronzeS1Exclusion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeS1ExclusionClient) Hooks() []Hook {
+	return c.hooks.BronzeS1Exclusion
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeS1ExclusionClient) Interceptors() []Interceptor {
+	return c.inters.BronzeS1Exclusion
+}
+
+func (c *BronzeS1ExclusionClient) mutate(ctx context.Context, m *BronzeS1ExclusionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeS1ExclusionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeS1ExclusionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeS1ExclusionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeS1ExclusionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("s1: unknown BronzeS1Exclusion mutation op: %q", m.Op())
+	}
+}
+
 // BronzeS1GroupClient is a client for the BronzeS1Group schema.
 type BronzeS1GroupClient struct {
 	config
@@ -2620,46 +4070,179 @@ func (c *BronzeS1GroupClient) UpdateOne(_m *BronzeS1Group) *BronzeS1GroupUpdateO
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *BronzeS1GroupClient) UpdateOneID(id string) *BronzeS1GroupUpdateOne {
-	mutation := newBronzeS1GroupMutation(c.config, OpUpdateOne, withBronzeS1GroupID(id))
-	return &BronzeS1GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BronzeS1GroupClient) UpdateOneID(id string) *BronzeS1GroupUpdateOne {
+	mutation := newBronzeS1GroupMutation(c.config, OpUpdateOne, withBronzeS1GroupID(id))
+	return &BronzeS1GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeS1Group.
+func (c *BronzeS1GroupClient) Delete() *BronzeS1GroupDelete {
+	mutation := newBronzeS1GroupMutation(c.config, OpDelete)
+	return &BronzeS1GroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeS1GroupClient) DeleteOne(_m *BronzeS1Group) *BronzeS1GroupDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeS1GroupClient) DeleteOneID(id string) *BronzeS1GroupDeleteOne {
+	builder := c.Delete().Where(bronzes1group.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeS1GroupDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeS1Group.
+func (c *BronzeS1GroupClient) Query() *BronzeS1GroupQuery {
+	return &BronzeS1GroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeS1Group},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeS1Group entity by its id.
+func (c *BronzeS1GroupClient) Get(ctx context.Context, id string) (*BronzeS1Group, error) {
+	return c.Query().Where(bronzes1group.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeS1GroupClient) GetX(ctx context.Context, id string) *BronzeS1Group {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeS1GroupClient) Hooks() []Hook {
+	return c.hooks.BronzeS1Group
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeS1GroupClient) Interceptors() []Interceptor {
+	return c.inters.BronzeS1Group
+}
+
+func (c *BronzeS1GroupClient) mutate(ctx context.Context, m *BronzeS1GroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeS1GroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeS1GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeS1GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeS1GroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("s1: unknown BronzeS1Group mutation op: %q", m.Op())
+	}
+}
+
+// BronzeS1IngestCursorClient is a client for the BronzeS1IngestCursor schema.
+type BronzeS1IngestCursorClient struct {
+	config
+}
+
+// NewBronzeS1IngestCursorClient returns a client for the BronzeS1IngestCursor from the given config.
+func NewBronzeS1IngestCursorClient(c config) *BronzeS1IngestCursorClient {
+	return &BronzeS1IngestCursorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzes1ingestcursor.Hooks(f(g(h())))`.
+func (c *BronzeS1IngestCursorClient) Use(hooks ...Hook) {
+	c.hooks.BronzeS1IngestCursor = append(c.hooks.BronzeS1IngestCursor, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzes1ingestcursor.Intercept(f(g(h())))`.
+func (c *BronzeS1IngestCursorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeS1IngestCursor = append(c.inters.BronzeS1IngestCursor, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeS1IngestCursor entity.
+func (c *BronzeS1IngestCursorClient) Create() *BronzeS1IngestCursorCreate {
+	mutation := newBronzeS1IngestCursorMutation(c.config, OpCreate)
+	return &BronzeS1IngestCursorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeS1IngestCursor entities.
+func (c *BronzeS1IngestCursorClient) CreateBulk(builders ...*BronzeS1IngestCursorCreate) *BronzeS1IngestCursorCreateBulk {
+	return &BronzeS1IngestCursorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeS1IngestCursorClient) MapCreateBulk(slice any, setFunc func(*BronzeS1IngestCursorCreate, int)) *BronzeS1IngestCursorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeS1IngestCursorCreateBulk{err: fmt.Errorf("calling to BronzeS1IngestCursorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeS1IngestCursorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeS1IngestCursorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeS1IngestCursor.
+func (c *BronzeS1IngestCursorClient) Update() *BronzeS1IngestCursorUpdate {
+	mutation := newBronzeS1IngestCursorMutation(c.config, OpUpdate)
+	return &BronzeS1IngestCursorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeS1IngestCursorClient) UpdateOne(_m *BronzeS1IngestCursor) *BronzeS1IngestCursorUpdateOne {
+	mutation := newBronzeS1IngestCursorMutation(c.config, OpUpdateOne, withBronzeS1IngestCursor(_m))
+	return &BronzeS1IngestCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeS1IngestCursorClient) UpdateOneID(id uint) *BronzeS1IngestCursorUpdateOne {
+	mutation := newBronzeS1IngestCursorMutation(c.config, OpUpdateOne, withBronzeS1IngestCursorID(id))
+	return &BronzeS1IngestCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for BronzeS1Group.
-func (c *BronzeS1GroupClient) Delete() *BronzeS1GroupDelete {
-	mutation := newBronzeS1GroupMutation(c.config, OpDelete)
-	return &BronzeS1GroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BronzeS1IngestCursor.
+func (c *BronzeS1IngestCursorClient) Delete() *BronzeS1IngestCursorDelete {
+	mutation := newBronzeS1IngestCursorMutation(c.config, OpDelete)
+	return &BronzeS1IngestCursorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *BronzeS1GroupClient) DeleteOne(_m *BronzeS1Group) *BronzeS1GroupDeleteOne {
+func (c *BronzeS1IngestCursorClient) DeleteOne(_m *BronzeS1IngestCursor) *BronzeS1IngestCursorDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *BronzeS1GroupClient) DeleteOneID(id string) *BronzeS1GroupDeleteOne {
-	builder := c.Delete().Where(bronzes1group.ID(id))
+func (c *BronzeS1IngestCursorClient) DeleteOneID(id uint) *BronzeS1IngestCursorDeleteOne {
+	builder := c.Delete().Where(bronzes1ingestcursor.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &BronzeS1GroupDeleteOne{builder}
+	return &BronzeS1IngestCursorDeleteOne{builder}
 }
 
-// Query returns a query builder for BronzeS1Group.
-func (c *BronzeS1GroupClient) Query() *BronzeS1GroupQuery {
-	return &BronzeS1GroupQuery{
+// Query returns a query builder for BronzeS1IngestCursor.
+func (c *BronzeS1IngestCursorClient) Query() *BronzeS1IngestCursorQuery {
+	return &BronzeS1IngestCursorQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeBronzeS1Group},
+		ctx:    &QueryContext{Type: TypeBronzeS1IngestCursor},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a BronzeS1Group entity by its id.
-func (c *BronzeS1GroupClient) Get(ctx context.Context, id string) (*BronzeS1Group, error) {
-	return c.Query().Where(bronzes1group.ID(id)).Only(ctx)
+// Get returns a BronzeS1IngestCursor entity by its id.
+func (c *BronzeS1IngestCursorClient) Get(ctx context.Context, id uint) (*BronzeS1IngestCursor, error) {
+	return c.Query().Where(bronzes1ingestcursor.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *BronzeS1GroupClient) GetX(ctx context.Context, id string) *BronzeS1Group {
+func (c *BronzeS1IngestCursorClient) GetX(ctx context.Context, id uint) *BronzeS1IngestCursor {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2668,27 +4251,27 @@ func (c *BronzeS1GroupClient) GetX(ctx context.Context, id string) *BronzeS1Grou
 }
 
 // Hooks returns the client hooks.
-func (c *BronzeS1GroupClient) Hooks() []Hook {
-	return c.hooks.BronzeS1Group
+func (c *BronzeS1IngestCursorClient) Hooks() []Hook {
+	return c.hooks.BronzeS1IngestCursor
 }
 
 // Interceptors returns the client interceptors.
-func (c *BronzeS1GroupClient) Interceptors() []Interceptor {
-	return c.inters.BronzeS1Group
+func (c *BronzeS1IngestCursorClient) Interceptors() []Interceptor {
+	return c.inters.BronzeS1IngestCursor
 }
 
-func (c *BronzeS1GroupClient) mutate(ctx context.Context, m *BronzeS1GroupMutation) (Value, error) {
+func (c *BronzeS1IngestCursorClient) mutate(ctx context.Context, m *BronzeS1IngestCursorMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&BronzeS1GroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BronzeS1IngestCursorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&BronzeS1GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BronzeS1IngestCursorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&BronzeS1GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BronzeS1IngestCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&BronzeS1GroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BronzeS1IngestCursorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("s1: unknown BronzeS1Group mutation op: %q", m.Op())
+		return nil, fmt.Errorf("s1: unknown BronzeS1IngestCursor mutation op: %q", m.Op())
 	}
 }
 
@@ -2825,6 +4408,139 @@ func (c *BronzeS1NetworkDiscoveryClient) mutate(ctx context.Context, m *BronzeS1
 	}
 }
 
+// BronzeS1PolicyClient is a client for the BronzeS1Policy schema.
+type BronzeS1PolicyClient struct {
+	config
+}
+
+// NewBronzeS1PolicyClient returns a client for the BronzeS1Policy from the given config.
+func NewBronzeS1PolicyClient(c config) *BronzeS1PolicyClient {
+	return &BronzeS1PolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzes1policy.Hooks(f(g(h())))`.
+func (c *BronzeS1PolicyClient) Use(hooks ...Hook) {
+	c.hooks.BronzeS1Policy = append(c.hooks.BronzeS1Policy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzes1policy.Intercept(f(g(h())))`.
+func (c *BronzeS1PolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeS1Policy = append(c.inters.BronzeS1Policy, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeS1Policy entity.
+func (c *BronzeS1PolicyClient) Create() *BronzeS1PolicyCreate {
+	mutation := newBronzeS1PolicyMutation(c.config, OpCreate)
+	return &BronzeS1PolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeS1Policy entities.
+func (c *BronzeS1PolicyClient) CreateBulk(builders ...*BronzeS1PolicyCreate) *BronzeS1PolicyCreateBulk {
+	return &BronzeS1PolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeS1PolicyClient) MapCreateBulk(slice any, setFunc func(*BronzeS1PolicyCreate, int)) *BronzeS1PolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeS1PolicyCreateBulk{err: fmt.Errorf("calling to BronzeS1PolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeS1PolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeS1PolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeS1Policy.
+func (c *BronzeS1PolicyClient) Update() *BronzeS1PolicyUpdate {
+	mutation := newBronzeS1PolicyMutation(c.config, OpUpdate)
+	return &BronzeS1PolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeS1PolicyClient) UpdateOne(_m *BronzeS1Policy) *BronzeS1PolicyUpdateOne {
+	mutation := newBronzeS1PolicyMutation(c.config, OpUpdateOne, withBronzeS1Policy(_m))
+	return &BronzeS1PolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeS1PolicyClient) UpdateOneID(id string) *BronzeS1PolicyUpdateOne {
+	mutation := newBronzeS1PolicyMutation(c.config, OpUpdateOne, withBronzeS1PolicyID(id))
+	return &BronzeS1PolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeS1Policy.
+func (c *BronzeS1PolicyClient) Delete() *BronzeS1PolicyDelete {
+	mutation := newBronzeS1PolicyMutation(c.config, OpDelete)
+	return &BronzeS1PolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeS1PolicyClient) DeleteOne(_m *BronzeS1Policy) *BronzeS1PolicyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeS1PolicyClient) DeleteOneID(id string) *BronzeS1PolicyDeleteOne {
+	builder := c.Delete().Where(bronzes1policy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeS1PolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeS1Policy.
+func (c *BronzeS1PolicyClient) Query() *BronzeS1PolicyQuery {
+	return &BronzeS1PolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeS1Policy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeS1Policy entity by its id.
+func (c *BronzeS1PolicyClient) Get(ctx context.Context, id string) (*BronzeS1Policy, error) {
+	return c.Query().Where(bronzes1policy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeS1PolicyClient) GetX(ctx context.Context, id string) *BronzeS1Policy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeS1PolicyClient) Hooks() []Hook {
+	return c.hooks.BronzeS1Policy
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeS1PolicyClient) Interceptors() []Interceptor {
+	return c.inters.BronzeS1Policy
+}
+
+func (c *BronzeS1PolicyClient) mutate(ctx context.Context, m *BronzeS1PolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeS1PolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeS1PolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeS1PolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeS1PolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("s1: unknown BronzeS1Policy mutation op: %q", m.Op())
+	}
+}
+
 // BronzeS1RangerDeviceClient is a client for the BronzeS1RangerDevice schema.
 type BronzeS1RangerDeviceClient struct {
 	config
@@ -3224,6 +4940,139 @@ func (c *BronzeS1RangerSettingClient) mutate(ctx context.Context, m *BronzeS1Ran
 	}
 }
 
+// BronzeS1RestrictionClient is a client for the BronzeS1Restriction schema.
+type BronzeS1RestrictionClient struct {
+	config
+}
+
+// NewBronzeS1RestrictionClient returns a client for the BronzeS1Restriction from the given config.
+func NewBronzeS1RestrictionClient(c config) *BronzeS1RestrictionClient {
+	return &BronzeS1RestrictionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzes1restriction.Hooks(f(g(h())))`.
+func (c *BronzeS1RestrictionClient) Use(hooks ...Hook) {
+	c.hooks.BronzeS1Restriction = append(c.hooks.BronzeS1Restriction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzes1restriction.Intercept(f(g(h())))`.
+func (c *BronzeS1RestrictionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeS1Restriction = append(c.inters.BronzeS1Restriction, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeS1Restriction entity.
+func (c *BronzeS1RestrictionClient) Create() *BronzeS1RestrictionCreate {
+	mutation := newBronzeS1RestrictionMutation(c.config, OpCreate)
+	return &BronzeS1RestrictionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeS1Restriction entities.
+func (c *BronzeS1RestrictionClient) CreateBulk(builders ...*BronzeS1RestrictionCreate) *BronzeS1RestrictionCreateBulk {
+	return &BronzeS1RestrictionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeS1RestrictionClient) MapCreateBulk(slice any, setFunc func(*BronzeS1RestrictionCreate, int)) *BronzeS1RestrictionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeS1RestrictionCreateBulk{err: fmt.Errorf("calling to BronzeS1RestrictionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeS1RestrictionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeS1RestrictionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeS1Restriction.
+func (c *BronzeS1RestrictionClient) Update() *BronzeS1RestrictionUpdate {
+	mutation := newBronzeS1RestrictionMutation(c.config, OpUpdate)
+	return &BronzeS1RestrictionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeS1RestrictionClient) UpdateOne(_m *BronzeS1Restriction) *BronzeS1RestrictionUpdateOne {
+	mutation := newBronzeS1RestrictionMutation(c.config, OpUpdateOne, withBronzeS1Restriction(_m))
+	return &BronzeS1RestrictionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeS1RestrictionClient) UpdateOneID(id string) *BronzeS1RestrictionUpdateOne {
+	mutation := newBronzeS1RestrictionMutation(c.config, OpUpdateOne, withBronzeS1RestrictionID(id))
+	return &BronzeS1RestrictionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeS1Restriction.
+func (c *BronzeS1RestrictionClient) Delete() *BronzeS1RestrictionDelete {
+	mutation := newBronzeS1RestrictionMutation(c.config, OpDelete)
+	return &BronzeS1RestrictionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeS1RestrictionClient) DeleteOne(_m *BronzeS1Restriction) *BronzeS1RestrictionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeS1RestrictionClient) DeleteOneID(id string) *BronzeS1RestrictionDeleteOne {
+	builder := c.Delete().Where(bronzes1restriction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeS1RestrictionDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeS1Restriction.
+func (c *BronzeS1RestrictionClient) Query() *BronzeS1RestrictionQuery {
+	return &BronzeS1RestrictionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeS1Restriction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeS1Restriction entity by its id.
+func (c *BronzeS1RestrictionClient) Get(ctx context.Context, id string) (*BronzeS1Restriction, error) {
+	return c.Query().Where(bronzes1restriction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeS1RestrictionClient) GetX(ctx context.Context, id string) *BronzeS1Restriction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeS1RestrictionClient) Hooks() []Hook {
+	return c.hooks.BronzeS1Restriction
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeS1RestrictionClient) Interceptors() []Interceptor {
+	return c.inters.BronzeS1Restriction
+}
+
+func (c *BronzeS1RestrictionClient) mutate(ctx context.Context, m *BronzeS1RestrictionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeS1RestrictionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeS1RestrictionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeS1RestrictionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeS1RestrictionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("s1: unknown BronzeS1Restriction mutation op: %q", m.Op())
+	}
+}
+
 // BronzeS1SiteClient is a client for the BronzeS1Site schema.
 type BronzeS1SiteClient struct {
 	config
@@ -3357,27 +5206,168 @@ func (c *BronzeS1SiteClient) mutate(ctx context.Context, m *BronzeS1SiteMutation
 	}
 }
 
+// BronzeS1ThreatClient is a client for the BronzeS1Threat schema.
+type BronzeS1ThreatClient struct {
+	config
+}
+
+// NewBronzeS1ThreatClient returns a client for the BronzeS1Threat from the given config.
+func NewBronzeS1ThreatClient(c config) *BronzeS1ThreatClient {
+	return &BronzeS1ThreatClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bronzes1threat.Hooks(f(g(h())))`.
+func (c *BronzeS1ThreatClient) Use(hooks ...Hook) {
+	c.hooks.BronzeS1Threat = append(c.hooks.BronzeS1Threat, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bronzes1threat.Intercept(f(g(h())))`.
+func (c *BronzeS1ThreatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BronzeS1Threat = append(c.inters.BronzeS1Threat, interceptors...)
+}
+
+// Create returns a builder for creating a BronzeS1Threat entity.
+func (c *BronzeS1ThreatClient) Create() *BronzeS1ThreatCreate {
+	mutation := newBronzeS1ThreatMutation(c.config, OpCreate)
+	return &BronzeS1ThreatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BronzeS1Threat entities.
+func (c *BronzeS1ThreatClient) CreateBulk(builders ...*BronzeS1ThreatCreate) *BronzeS1ThreatCreateBulk {
+	return &BronzeS1ThreatCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BronzeS1ThreatClient) MapCreateBulk(slice any, setFunc func(*BronzeS1ThreatCreate, int)) *BronzeS1ThreatCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BronzeS1ThreatCreateBulk{err: fmt.Errorf("calling to BronzeS1ThreatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BronzeS1ThreatCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BronzeS1ThreatCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BronzeS1Threat.
+func (c *BronzeS1ThreatClient) Update() *BronzeS1ThreatUpdate {
+	mutation := newBronzeS1ThreatMutation(c.config, OpUpdate)
+	return &BronzeS1ThreatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BronzeS1ThreatClient) UpdateOne(_m *BronzeS1Threat) *BronzeS1ThreatUpdateOne {
+	mutation := newBronzeS1ThreatMutation(c.config, OpUpdateOne, withBronzeS1Threat(_m))
+	return &BronzeS1ThreatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BronzeS1ThreatClient) UpdateOneID(id string) *BronzeS1ThreatUpdateOne {
+	mutation := newBronzeS1ThreatMutation(c.config, OpUpdateOne, withBronzeS1ThreatID(id))
+	return &BronzeS1ThreatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BronzeS1Threat.
+func (c *BronzeS1ThreatClient) Delete() *BronzeS1ThreatDelete {
+	mutation := newBronzeS1ThreatMutation(c.config, OpDelete)
+	return &BronzeS1ThreatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BronzeS1ThreatClient) DeleteOne(_m *BronzeS1Threat) *BronzeS1ThreatDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BronzeS1ThreatClient) DeleteOneID(id string) *BronzeS1ThreatDeleteOne {
+	builder := c.Delete().Where(bronzes1threat.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BronzeS1ThreatDeleteOne{builder}
+}
+
+// Query returns a query builder for BronzeS1Threat.
+func (c *BronzeS1ThreatClient) Query() *BronzeS1ThreatQuery {
+	return &BronzeS1ThreatQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBronzeS1Threat},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BronzeS1Threat entity by its id.
+func (c *BronzeS1ThreatClient) Get(ctx context.Context, id string) (*BronzeS1Threat, error) {
+	return c.Query().Where(bronzes1threat.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BronzeS1ThreatClient) GetX(ctx context.Context, id string) *BronzeS1Threat {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BronzeS1ThreatClient) Hooks() []Hook {
+	return c.hooks.BronzeS1Threat
+}
+
+// Interceptors returns the client interceptors.
+func (c *BronzeS1ThreatClient) Interceptors() []Interceptor {
+	return c.inters.BronzeS1Threat
+}
+
+func (c *BronzeS1ThreatClient) mutate(ctx context.Context, m *BronzeS1ThreatMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BronzeS1ThreatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BronzeS1ThreatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BronzeS1ThreatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BronzeS1ThreatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("s1: unknown BronzeS1Threat mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
 		BronzeHistoryS1Account, BronzeHistoryS1Agent, BronzeHistoryS1AgentNIC,
-		BronzeHistoryS1AppInventory, BronzeHistoryS1EndpointApp, BronzeHistoryS1Group,
-		BronzeHistoryS1NetworkDiscovery, BronzeHistoryS1RangerDevice,
-		BronzeHistoryS1RangerGateway, BronzeHistoryS1RangerSetting,
-		BronzeHistoryS1Site, BronzeS1Account, BronzeS1Agent, BronzeS1AgentNIC,
-		BronzeS1AppInventory, BronzeS1EndpointApp, BronzeS1Group,
-		BronzeS1NetworkDiscovery, BronzeS1RangerDevice, BronzeS1RangerGateway,
-		BronzeS1RangerSetting, BronzeS1Site []ent.Hook
+		BronzeHistoryS1Alert, BronzeHistoryS1AppCVE, BronzeHistoryS1AppInventory,
+		BronzeHistoryS1EndpointApp, BronzeHistoryS1Exclusion, BronzeHistoryS1Group,
+		BronzeHistoryS1NetworkDiscovery, BronzeHistoryS1Policy,
+		BronzeHistoryS1RangerDevice, BronzeHistoryS1RangerGateway,
+		BronzeHistoryS1RangerSetting, BronzeHistoryS1Restriction, BronzeHistoryS1Site,
+		BronzeHistoryS1Threat, BronzeS1Account, BronzeS1Activity, BronzeS1Agent,
+		BronzeS1AgentNIC, BronzeS1Alert, BronzeS1AppCVE, BronzeS1AppInventory,
+		BronzeS1EndpointApp, BronzeS1Exclusion, BronzeS1Group, BronzeS1IngestCursor,
+		BronzeS1NetworkDiscovery, BronzeS1Policy, BronzeS1RangerDevice,
+		BronzeS1RangerGateway, BronzeS1RangerSetting, BronzeS1Restriction,
+		BronzeS1Site, BronzeS1Threat []ent.Hook
 	}
 	inters struct {
 		BronzeHistoryS1Account, BronzeHistoryS1Agent, BronzeHistoryS1AgentNIC,
-		BronzeHistoryS1AppInventory, BronzeHistoryS1EndpointApp, BronzeHistoryS1Group,
-		BronzeHistoryS1NetworkDiscovery, BronzeHistoryS1RangerDevice,
-		BronzeHistoryS1RangerGateway, BronzeHistoryS1RangerSetting,
-		BronzeHistoryS1Site, BronzeS1Account, BronzeS1Agent, BronzeS1AgentNIC,
-		BronzeS1AppInventory, BronzeS1EndpointApp, BronzeS1Group,
-		BronzeS1NetworkDiscovery, BronzeS1RangerDevice, BronzeS1RangerGateway,
-		BronzeS1RangerSetting, BronzeS1Site []ent.Interceptor
+		BronzeHistoryS1Alert, BronzeHistoryS1AppCVE, BronzeHistoryS1AppInventory,
+		BronzeHistoryS1EndpointApp, BronzeHistoryS1Exclusion, BronzeHistoryS1Group,
+		BronzeHistoryS1NetworkDiscovery, BronzeHistoryS1Policy,
+		BronzeHistoryS1RangerDevice, BronzeHistoryS1RangerGateway,
+		BronzeHistoryS1RangerSetting, BronzeHistoryS1Restriction, BronzeHistoryS1Site,
+		BronzeHistoryS1Threat, BronzeS1Account, BronzeS1Activity, BronzeS1Agent,
+		BronzeS1AgentNIC, BronzeS1Alert, BronzeS1AppCVE, BronzeS1AppInventory,
+		BronzeS1EndpointApp, BronzeS1Exclusion, BronzeS1Group, BronzeS1IngestCursor,
+		BronzeS1NetworkDiscovery, BronzeS1Policy, BronzeS1RangerDevice,
+		BronzeS1RangerGateway, BronzeS1RangerSetting, BronzeS1Restriction,
+		BronzeS1Site, BronzeS1Threat []ent.Interceptor
 	}
 )
 
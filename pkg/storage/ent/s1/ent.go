@@ -12,25 +12,39 @@ import (
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1account"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1agent"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1agentnic"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1alert"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1appcve"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1appinventory"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1endpointapp"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1exclusion"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1group"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1networkdiscovery"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1policy"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1rangerdevice"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1rangergateway"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1rangersetting"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1restriction"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1site"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1threat"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1account"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1activity"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1agent"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1agentnic"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1alert"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1appcve"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1appinventory"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1endpointapp"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1exclusion"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1group"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1ingestcursor"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1networkdiscovery"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1policy"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1rangerdevice"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1rangergateway"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1rangersetting"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1restriction"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1site"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1threat"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -97,25 +111,39 @@ func checkColumn(t, c string) error {
 			bronzehistorys1account.Table:          bronzehistorys1account.ValidColumn,
 			bronzehistorys1agent.Table:            bronzehistorys1agent.ValidColumn,
 			bronzehistorys1agentnic.Table:         bronzehistorys1agentnic.ValidColumn,
+			bronzehistorys1alert.Table:            bronzehistorys1alert.ValidColumn,
+			bronzehistorys1appcve.Table:           bronzehistorys1appcve.ValidColumn,
 			bronzehistorys1appinventory.Table:     bronzehistorys1appinventory.ValidColumn,
 			bronzehistorys1endpointapp.Table:      bronzehistorys1endpointapp.ValidColumn,
+			bronzehistorys1exclusion.Table:        bronzehistorys1exclusion.ValidColumn,
 			bronzehistorys1group.Table:            bronzehistorys1group.ValidColumn,
 			bronzehistorys1networkdiscovery.Table: bronzehistorys1networkdiscovery.ValidColumn,
+			bronzehistorys1policy.Table:           bronzehistorys1policy.ValidColumn,
 			bronzehistorys1rangerdevice.Table:     bronzehistorys1rangerdevice.ValidColumn,
 			bronzehistorys1rangergateway.Table:    bronzehistorys1rangergateway.ValidColumn,
 			bronzehistorys1rangersetting.Table:    bronzehistorys1rangersetting.ValidColumn,
+			bronzehistorys1restriction.Table:      bronzehistorys1restriction.ValidColumn,
 			bronzehistorys1site.Table:             bronzehistorys1site.ValidColumn,
+			bronzehistorys1threat.Table:           bronzehistorys1threat.ValidColumn,
 			bronzes1account.Table:                 bronzes1account.ValidColumn,
+			bronzes1activity.Table:                bronzes1activity.ValidColumn,
 			bronzes1agent.Table:                   bronzes1agent.ValidColumn,
 			bronzes1agentnic.Table:                bronzes1agentnic.ValidColumn,
+			bronzes1alert.Table:                   bronzes1alert.ValidColumn,
+			bronzes1appcve.Table:                  bronzes1appcve.ValidColumn,
 			bronzes1appinventory.Table:            bronzes1appinventory.ValidColumn,
 			bronzes1endpointapp.Table:             bronzes1endpointapp.ValidColumn,
+			bronzes1exclusion.Table:               bronzes1exclusion.ValidColumn,
 			bronzes1group.Table:                   bronzes1group.ValidColumn,
+			bronzes1ingestcursor.Table:            bronzes1ingestcursor.ValidColumn,
 			bronzes1networkdiscovery.Table:        bronzes1networkdiscovery.ValidColumn,
+			bronzes1policy.Table:                  bronzes1policy.ValidColumn,
 			bronzes1rangerdevice.Table:            bronzes1rangerdevice.ValidColumn,
 			bronzes1rangergateway.Table:           bronzes1rangergateway.ValidColumn,
 			bronzes1rangersetting.Table:           bronzes1rangersetting.ValidColumn,
+			bronzes1restriction.Table:             bronzes1restriction.ValidColumn,
 			bronzes1site.Table:                    bronzes1site.ValidColumn,
+			bronzes1threat.Table:                  bronzes1threat.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -45,6 +45,30 @@ func (f BronzeHistoryS1AgentNICFunc) Mutate(ctx context.Context, m s1.Mutation)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeHistoryS1AgentNICMutation", m)
 }
 
+// The BronzeHistoryS1AlertFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryS1Alert mutator.
+type BronzeHistoryS1AlertFunc func(context.Context, *s1.BronzeHistoryS1AlertMutation) (s1.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryS1AlertFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.Value, error) {
+	if mv, ok := m.(*s1.BronzeHistoryS1AlertMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeHistoryS1AlertMutation", m)
+}
+
+// The BronzeHistoryS1AppCVEFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryS1AppCVE mutator.
+type BronzeHistoryS1AppCVEFunc func(context.Context, *s1.BronzeHistoryS1AppCVEMutation) (s1.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryS1AppCVEFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.Value, error) {
+	if mv, ok := m.(*s1.BronzeHistoryS1AppCVEMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeHistoryS1AppCVEMutation", m)
+}
+
 // The BronzeHistoryS1AppInventoryFunc type is an adapter to allow the use of ordinary
 // function as BronzeHistoryS1AppInventory mutator.
 type BronzeHistoryS1AppInventoryFunc func(context.Context, *s1.BronzeHistoryS1AppInventoryMutation) (s1.Value, error)
@@ -69,6 +93,18 @@ func (f BronzeHistoryS1EndpointAppFunc) Mutate(ctx context.Context, m s1.Mutatio
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeHistoryS1EndpointAppMutation", m)
 }
 
+// The BronzeHistoryS1ExclusionFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryS1Exclusion mutator.
+type BronzeHistoryS1ExclusionFunc func(context.Context, *s1.BronzeHistoryS1ExclusionMutation) (s1.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryS1ExclusionFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.Value, error) {
+	if mv, ok := m.(*s1.BronzeHistoryS1ExclusionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeHistoryS1ExclusionMutation", m)
+}
+
 // The BronzeHistoryS1GroupFunc type is an adapter to allow the use of ordinary
 // function as BronzeHistoryS1Group mutator.
 type BronzeHistoryS1GroupFunc func(context.Context, *s1.BronzeHistoryS1GroupMutation) (s1.Value, error)
@@ -93,6 +129,18 @@ func (f BronzeHistoryS1NetworkDiscoveryFunc) Mutate(ctx context.Context, m s1.Mu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeHistoryS1NetworkDiscoveryMutation", m)
 }
 
+// The BronzeHistoryS1PolicyFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryS1Policy mutator.
+type BronzeHistoryS1PolicyFunc func(context.Context, *s1.BronzeHistoryS1PolicyMutation) (s1.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryS1PolicyFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.Value, error) {
+	if mv, ok := m.(*s1.BronzeHistoryS1PolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeHistoryS1PolicyMutation", m)
+}
+
 // The BronzeHistoryS1RangerDeviceFunc type is an adapter to allow the use of ordinary
 // function as BronzeHistoryS1RangerDevice mutator.
 type BronzeHistoryS1RangerDeviceFunc func(context.Context, *s1.BronzeHistoryS1RangerDeviceMutation) (s1.Value, error)
@@ -129,6 +177,18 @@ func (f BronzeHistoryS1RangerSettingFunc) Mutate(ctx context.Context, m s1.Mutat
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeHistoryS1RangerSettingMutation", m)
 }
 
+// The BronzeHistoryS1RestrictionFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryS1Restriction mutator.
+type BronzeHistoryS1RestrictionFunc func(context.Context, *s1.BronzeHistoryS1RestrictionMutation) (s1.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryS1RestrictionFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.Value, error) {
+	if mv, ok := m.(*s1.BronzeHistoryS1RestrictionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeHistoryS1RestrictionMutation", m)
+}
+
 // The BronzeHistoryS1SiteFunc type is an adapter to allow the use of ordinary
 // function as BronzeHistoryS1Site mutator.
 type BronzeHistoryS1SiteFunc func(context.Context, *s1.BronzeHistoryS1SiteMutation) (s1.Value, error)
@@ -141,6 +201,18 @@ func (f BronzeHistoryS1SiteFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeHistoryS1SiteMutation", m)
 }
 
+// The BronzeHistoryS1ThreatFunc type is an adapter to allow the use of ordinary
+// function as BronzeHistoryS1Threat mutator.
+type BronzeHistoryS1ThreatFunc func(context.Context, *s1.BronzeHistoryS1ThreatMutation) (s1.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeHistoryS1ThreatFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.Value, error) {
+	if mv, ok := m.(*s1.BronzeHistoryS1ThreatMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeHistoryS1ThreatMutation", m)
+}
+
 // The BronzeS1AccountFunc type is an adapter to allow the use of ordinary
 // function as BronzeS1Account mutator.
 type BronzeS1AccountFunc func(context.Context, *s1.BronzeS1AccountMutation) (s1.Value, error)
@@ -153,6 +225,18 @@ func (f BronzeS1AccountFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeS1AccountMutation", m)
 }
 
+// The BronzeS1ActivityFunc type is an adapter to allow the use of ordinary
+// function as BronzeS1Activity mutator.
+type BronzeS1ActivityFunc func(context.Context, *s1.BronzeS1ActivityMutation) (s1.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeS1ActivityFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.Value, error) {
+	if mv, ok := m.(*s1.BronzeS1ActivityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeS1ActivityMutation", m)
+}
+
 // The BronzeS1AgentFunc type is an adapter to allow the use of ordinary
 // function as BronzeS1Agent mutator.
 type BronzeS1AgentFunc func(context.Context, *s1.BronzeS1AgentMutation) (s1.Value, error)
@@ -177,6 +261,30 @@ func (f BronzeS1AgentNICFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeS1AgentNICMutation", m)
 }
 
+// The BronzeS1AlertFunc type is an adapter to allow the use of ordinary
+// function as BronzeS1Alert mutator.
+type BronzeS1AlertFunc func(context.Context, *s1.BronzeS1AlertMutation) (s1.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeS1AlertFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.Value, error) {
+	if mv, ok := m.(*s1.BronzeS1AlertMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeS1AlertMutation", m)
+}
+
+// The BronzeS1AppCVEFunc type is an adapter to allow the use of ordinary
+// function as BronzeS1AppCVE mutator.
+type BronzeS1AppCVEFunc func(context.Context, *s1.BronzeS1AppCVEMutation) (s1.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeS1AppCVEFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.Value, error) {
+	if mv, ok := m.(*s1.BronzeS1AppCVEMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeS1AppCVEMutation", m)
+}
+
 // The BronzeS1AppInventoryFunc type is an adapter to allow the use of ordinary
 // function as BronzeS1AppInventory mutator.
 type BronzeS1AppInventoryFunc func(context.Context, *s1.BronzeS1AppInventoryMutation) (s1.Value, error)
@@ -201,6 +309,18 @@ func (f BronzeS1EndpointAppFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeS1EndpointAppMutation", m)
 }
 
+// The BronzeS1ExclusionFunc type is an adapter to allow the use of ordinary
+// function as BronzeS1Exclusion mutator.
+type BronzeS1ExclusionFunc func(context.Context, *s1.BronzeS1ExclusionMutation) (s1.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeS1ExclusionFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.Value, error) {
+	if mv, ok := m.(*s1.BronzeS1ExclusionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeS1ExclusionMutation", m)
+}
+
 // The BronzeS1GroupFunc type is an adapter to allow the use of ordinary
 // function as BronzeS1Group mutator.
 type BronzeS1GroupFunc func(context.Context, *s1.BronzeS1GroupMutation) (s1.Value, error)
@@ -213,6 +333,18 @@ func (f BronzeS1GroupFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeS1GroupMutation", m)
 }
 
+// The BronzeS1IngestCursorFunc type is an adapter to allow the use of ordinary
+// function as BronzeS1IngestCursor mutator.
+type BronzeS1IngestCursorFunc func(context.Context, *s1.BronzeS1IngestCursorMutation) (s1.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeS1IngestCursorFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.Value, error) {
+	if mv, ok := m.(*s1.BronzeS1IngestCursorMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeS1IngestCursorMutation", m)
+}
+
 // The BronzeS1NetworkDiscoveryFunc type is an adapter to allow the use of ordinary
 // function as BronzeS1NetworkDiscovery mutator.
 type BronzeS1NetworkDiscoveryFunc func(context.Context, *s1.BronzeS1NetworkDiscoveryMutation) (s1.Value, error)
@@ -225,6 +357,18 @@ func (f BronzeS1NetworkDiscoveryFunc) Mutate(ctx context.Context, m s1.Mutation)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeS1NetworkDiscoveryMutation", m)
 }
 
+// The BronzeS1PolicyFunc type is an adapter to allow the use of ordinary
+// function as BronzeS1Policy mutator.
+type BronzeS1PolicyFunc func(context.Context, *s1.BronzeS1PolicyMutation) (s1.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeS1PolicyFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.Value, error) {
+	if mv, ok := m.(*s1.BronzeS1PolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeS1PolicyMutation", m)
+}
+
 // The BronzeS1RangerDeviceFunc type is an adapter to allow the use of ordinary
 // function as BronzeS1RangerDevice mutator.
 type BronzeS1RangerDeviceFunc func(context.Context, *s1.BronzeS1RangerDeviceMutation) (s1.Value, error)
@@ -261,6 +405,18 @@ func (f BronzeS1RangerSettingFunc) Mutate(ctx context.Context, m s1.Mutation) (s
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeS1RangerSettingMutation", m)
 }
 
+// The BronzeS1RestrictionFunc type is an adapter to allow the use of ordinary
+// function as BronzeS1Restriction mutator.
+type BronzeS1RestrictionFunc func(context.Context, *s1.BronzeS1RestrictionMutation) (s1.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeS1RestrictionFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.Value, error) {
+	if mv, ok := m.(*s1.BronzeS1RestrictionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeS1RestrictionMutation", m)
+}
+
 // The BronzeS1SiteFunc type is an adapter to allow the use of ordinary
 // function as BronzeS1Site mutator.
 type BronzeS1SiteFunc func(context.Context, *s1.BronzeS1SiteMutation) (s1.Value, error)
@@ -273,6 +429,18 @@ func (f BronzeS1SiteFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeS1SiteMutation", m)
 }
 
+// The BronzeS1ThreatFunc type is an adapter to allow the use of ordinary
+// function as BronzeS1Threat mutator.
+type BronzeS1ThreatFunc func(context.Context, *s1.BronzeS1ThreatMutation) (s1.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BronzeS1ThreatFunc) Mutate(ctx context.Context, m s1.Mutation) (s1.Value, error) {
+	if mv, ok := m.(*s1.BronzeS1ThreatMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *s1.BronzeS1ThreatMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, s1.Mutation) bool
 
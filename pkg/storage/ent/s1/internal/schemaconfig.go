@@ -10,25 +10,39 @@ type SchemaConfig struct {
 	BronzeHistoryS1Account          string // BronzeHistoryS1Account table.
 	BronzeHistoryS1Agent            string // BronzeHistoryS1Agent table.
 	BronzeHistoryS1AgentNIC         string // BronzeHistoryS1AgentNIC table.
+	BronzeHistoryS1Alert            string // BronzeHistoryS1Alert table.
+	BronzeHistoryS1AppCVE           string // BronzeHistoryS1AppCVE table.
 	BronzeHistoryS1AppInventory     string // BronzeHistoryS1AppInventory table.
 	BronzeHistoryS1EndpointApp      string // BronzeHistoryS1EndpointApp table.
+	BronzeHistoryS1Exclusion        string // BronzeHistoryS1Exclusion table.
 	BronzeHistoryS1Group            string // BronzeHistoryS1Group table.
 	BronzeHistoryS1NetworkDiscovery string // BronzeHistoryS1NetworkDiscovery table.
+	BronzeHistoryS1Policy           string // BronzeHistoryS1Policy table.
 	BronzeHistoryS1RangerDevice     string // BronzeHistoryS1RangerDevice table.
 	BronzeHistoryS1RangerGateway    string // BronzeHistoryS1RangerGateway table.
 	BronzeHistoryS1RangerSetting    string // BronzeHistoryS1RangerSetting table.
+	BronzeHistoryS1Restriction      string // BronzeHistoryS1Restriction table.
 	BronzeHistoryS1Site             string // BronzeHistoryS1Site table.
+	BronzeHistoryS1Threat           string // BronzeHistoryS1Threat table.
 	BronzeS1Account                 string // BronzeS1Account table.
+	BronzeS1Activity                string // BronzeS1Activity table.
 	BronzeS1Agent                   string // BronzeS1Agent table.
 	BronzeS1AgentNIC                string // BronzeS1AgentNIC table.
+	BronzeS1Alert                   string // BronzeS1Alert table.
+	BronzeS1AppCVE                  string // BronzeS1AppCVE table.
 	BronzeS1AppInventory            string // BronzeS1AppInventory table.
 	BronzeS1EndpointApp             string // BronzeS1EndpointApp table.
+	BronzeS1Exclusion               string // BronzeS1Exclusion table.
 	BronzeS1Group                   string // BronzeS1Group table.
+	BronzeS1IngestCursor            string // BronzeS1IngestCursor table.
 	BronzeS1NetworkDiscovery        string // BronzeS1NetworkDiscovery table.
+	BronzeS1Policy                  string // BronzeS1Policy table.
 	BronzeS1RangerDevice            string // BronzeS1RangerDevice table.
 	BronzeS1RangerGateway           string // BronzeS1RangerGateway table.
 	BronzeS1RangerSetting           string // BronzeS1RangerSetting table.
+	BronzeS1Restriction             string // BronzeS1Restriction table.
 	BronzeS1Site                    string // BronzeS1Site table.
+	BronzeS1Threat                  string // BronzeS1Threat table.
 }
 
 type schemaCtxKey struct{}
@@ -190,6 +190,127 @@ var (
 			},
 		},
 	}
+	// S1AlertsHistoryColumns holds the columns for the "s1_alerts_history" table.
+	S1AlertsHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "agent_id", Type: field.TypeString, Nullable: true},
+		{Name: "agent_uuid", Type: field.TypeString, Nullable: true},
+		{Name: "agent_name", Type: field.TypeString, Nullable: true},
+		{Name: "agent_os_name", Type: field.TypeString, Nullable: true},
+		{Name: "site_id", Type: field.TypeString, Nullable: true},
+		{Name: "rule_id", Type: field.TypeString, Nullable: true},
+		{Name: "rule_name", Type: field.TypeString, Nullable: true},
+		{Name: "rule_severity", Type: field.TypeString, Nullable: true},
+		{Name: "rule_description", Type: field.TypeString, Nullable: true},
+		{Name: "rule_scope_level", Type: field.TypeString, Nullable: true},
+		{Name: "rule_treat_as_threat", Type: field.TypeString, Nullable: true},
+		{Name: "analyst_verdict", Type: field.TypeString, Nullable: true},
+		{Name: "incident_status", Type: field.TypeString, Nullable: true},
+		{Name: "event_type", Type: field.TypeString, Nullable: true},
+		{Name: "hit_type", Type: field.TypeString, Nullable: true},
+		{Name: "source", Type: field.TypeString, Nullable: true},
+		{Name: "dv_event_id", Type: field.TypeString, Nullable: true},
+		{Name: "is_edr", Type: field.TypeBool, Default: false},
+		{Name: "reported_at", Type: field.TypeTime, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "api_updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "source_process_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "target_process_json", Type: field.TypeJSON, Nullable: true},
+	}
+	// S1AlertsHistoryTable holds the schema information for the "s1_alerts_history" table.
+	S1AlertsHistoryTable = &schema.Table{
+		Name:       "s1_alerts_history",
+		Columns:    S1AlertsHistoryColumns,
+		PrimaryKey: []*schema.Column{S1AlertsHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistorys1alert_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{S1AlertsHistoryColumns[5], S1AlertsHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistorys1alert_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{S1AlertsHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistorys1alert_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{S1AlertsHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistorys1alert_agent_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1AlertsHistoryColumns[6]},
+			},
+		},
+	}
+	// S1AppCvesHistoryColumns holds the columns for the "s1_app_cves_history" table.
+	S1AppCvesHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "agent_id", Type: field.TypeString},
+		{Name: "endpoint_name", Type: field.TypeString, Nullable: true},
+		{Name: "endpoint_type", Type: field.TypeString, Nullable: true},
+		{Name: "os_type", Type: field.TypeString, Nullable: true},
+		{Name: "application_name", Type: field.TypeString},
+		{Name: "application_vendor", Type: field.TypeString, Nullable: true},
+		{Name: "application_version", Type: field.TypeString, Nullable: true},
+		{Name: "cve_id", Type: field.TypeString},
+		{Name: "cvss_score", Type: field.TypeFloat64, Default: 0},
+		{Name: "cvss_version", Type: field.TypeString, Nullable: true},
+		{Name: "nvd_severity", Type: field.TypeString, Nullable: true},
+		{Name: "risk_score", Type: field.TypeFloat64, Default: 0},
+		{Name: "exploit_code_maturity", Type: field.TypeString, Nullable: true},
+		{Name: "exploited_in_the_wild", Type: field.TypeBool, Default: false},
+		{Name: "remediation_level", Type: field.TypeString, Nullable: true},
+		{Name: "report_confidence", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString, Nullable: true},
+		{Name: "published_date", Type: field.TypeTime, Nullable: true},
+		{Name: "detection_date", Type: field.TypeTime, Nullable: true},
+	}
+	// S1AppCvesHistoryTable holds the schema information for the "s1_app_cves_history" table.
+	S1AppCvesHistoryTable = &schema.Table{
+		Name:       "s1_app_cves_history",
+		Columns:    S1AppCvesHistoryColumns,
+		PrimaryKey: []*schema.Column{S1AppCvesHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistorys1appcve_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{S1AppCvesHistoryColumns[5], S1AppCvesHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistorys1appcve_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{S1AppCvesHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistorys1appcve_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{S1AppCvesHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistorys1appcve_agent_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1AppCvesHistoryColumns[6]},
+			},
+			{
+				Name:    "bronzehistorys1appcve_cve_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1AppCvesHistoryColumns[13]},
+			},
+		},
+	}
 	// S1AppInventoryHistoryColumns holds the columns for the "s1_app_inventory_history" table.
 	S1AppInventoryHistoryColumns = []*schema.Column{
 		{Name: "history_id", Type: field.TypeUint, Increment: true},
@@ -270,6 +391,65 @@ var (
 			},
 		},
 	}
+	// S1ExclusionsHistoryColumns holds the columns for the "s1_exclusions_history" table.
+	S1ExclusionsHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "scope_level", Type: field.TypeString},
+		{Name: "account_id", Type: field.TypeString, Nullable: true},
+		{Name: "site_id", Type: field.TypeString, Nullable: true},
+		{Name: "group_id", Type: field.TypeString, Nullable: true},
+		{Name: "scope_name", Type: field.TypeString, Nullable: true},
+		{Name: "scope_path", Type: field.TypeString, Nullable: true},
+		{Name: "exclusion_type", Type: field.TypeString},
+		{Name: "exclusion_value", Type: field.TypeString, Nullable: true},
+		{Name: "os_type", Type: field.TypeString, Nullable: true},
+		{Name: "mode", Type: field.TypeString, Nullable: true},
+		{Name: "path_exclusion_type", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "source", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+		{Name: "user_name", Type: field.TypeString, Nullable: true},
+		{Name: "application_name", Type: field.TypeString, Nullable: true},
+		{Name: "include_children", Type: field.TypeBool, Default: false},
+		{Name: "include_subfolders", Type: field.TypeBool, Default: false},
+		{Name: "imported", Type: field.TypeBool, Default: false},
+		{Name: "actions_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "api_updated_at", Type: field.TypeTime, Nullable: true},
+	}
+	// S1ExclusionsHistoryTable holds the schema information for the "s1_exclusions_history" table.
+	S1ExclusionsHistoryTable = &schema.Table{
+		Name:       "s1_exclusions_history",
+		Columns:    S1ExclusionsHistoryColumns,
+		PrimaryKey: []*schema.Column{S1ExclusionsHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistorys1exclusion_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{S1ExclusionsHistoryColumns[5], S1ExclusionsHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistorys1exclusion_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{S1ExclusionsHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistorys1exclusion_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{S1ExclusionsHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistorys1exclusion_exclusion_type",
+				Unique:  false,
+				Columns: []*schema.Column{S1ExclusionsHistoryColumns[12]},
+			},
+		},
+	}
 	// S1GroupsHistoryColumns holds the columns for the "s1_groups_history" table.
 	S1GroupsHistoryColumns = []*schema.Column{
 		{Name: "history_id", Type: field.TypeUint, Increment: true},
@@ -456,6 +636,55 @@ var (
 			},
 		},
 	}
+	// S1PoliciesHistoryColumns holds the columns for the "s1_policies_history" table.
+	S1PoliciesHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "scope_level", Type: field.TypeString},
+		{Name: "account_id", Type: field.TypeString, Nullable: true},
+		{Name: "site_id", Type: field.TypeString, Nullable: true},
+		{Name: "group_id", Type: field.TypeString, Nullable: true},
+		{Name: "scope_name", Type: field.TypeString, Nullable: true},
+		{Name: "inherited_from", Type: field.TypeString, Nullable: true},
+		{Name: "mitigation_mode", Type: field.TypeString, Nullable: true},
+		{Name: "mitigation_mode_suspicious", Type: field.TypeString, Nullable: true},
+		{Name: "auto_mitigation_action", Type: field.TypeString, Nullable: true},
+		{Name: "anti_tampering_on", Type: field.TypeBool, Default: false},
+		{Name: "snapshots_on", Type: field.TypeBool, Default: false},
+		{Name: "agent_logging_on", Type: field.TypeBool, Default: false},
+		{Name: "scan_new_agents", Type: field.TypeBool, Default: false},
+		{Name: "network_quarantine_on", Type: field.TypeBool, Default: false},
+		{Name: "engines_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "policy_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "api_updated_at", Type: field.TypeTime, Nullable: true},
+	}
+	// S1PoliciesHistoryTable holds the schema information for the "s1_policies_history" table.
+	S1PoliciesHistoryTable = &schema.Table{
+		Name:       "s1_policies_history",
+		Columns:    S1PoliciesHistoryColumns,
+		PrimaryKey: []*schema.Column{S1PoliciesHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistorys1policy_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{S1PoliciesHistoryColumns[5], S1PoliciesHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistorys1policy_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{S1PoliciesHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistorys1policy_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{S1PoliciesHistoryColumns[3]},
+			},
+		},
+	}
 	// S1RangerDevicesHistoryColumns holds the columns for the "s1_ranger_devices_history" table.
 	S1RangerDevicesHistoryColumns = []*schema.Column{
 		{Name: "history_id", Type: field.TypeUint, Increment: true},
@@ -654,6 +883,59 @@ var (
 			},
 		},
 	}
+	// S1RestrictionsHistoryColumns holds the columns for the "s1_restrictions_history" table.
+	S1RestrictionsHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "scope_level", Type: field.TypeString},
+		{Name: "account_id", Type: field.TypeString, Nullable: true},
+		{Name: "site_id", Type: field.TypeString, Nullable: true},
+		{Name: "group_id", Type: field.TypeString, Nullable: true},
+		{Name: "scope_name", Type: field.TypeString, Nullable: true},
+		{Name: "scope_path", Type: field.TypeString, Nullable: true},
+		{Name: "restriction_type", Type: field.TypeString},
+		{Name: "restriction_value", Type: field.TypeString, Nullable: true},
+		{Name: "os_type", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "source", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+		{Name: "user_name", Type: field.TypeString, Nullable: true},
+		{Name: "imported", Type: field.TypeBool, Default: false},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "api_updated_at", Type: field.TypeTime, Nullable: true},
+	}
+	// S1RestrictionsHistoryTable holds the schema information for the "s1_restrictions_history" table.
+	S1RestrictionsHistoryTable = &schema.Table{
+		Name:       "s1_restrictions_history",
+		Columns:    S1RestrictionsHistoryColumns,
+		PrimaryKey: []*schema.Column{S1RestrictionsHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistorys1restriction_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{S1RestrictionsHistoryColumns[5], S1RestrictionsHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistorys1restriction_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{S1RestrictionsHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistorys1restriction_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{S1RestrictionsHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistorys1restriction_restriction_value",
+				Unique:  false,
+				Columns: []*schema.Column{S1RestrictionsHistoryColumns[13]},
+			},
+		},
+	}
 	// S1SitesHistoryColumns holds the columns for the "s1_sites_history" table.
 	S1SitesHistoryColumns = []*schema.Column{
 		{Name: "history_id", Type: field.TypeUint, Increment: true},
@@ -714,6 +996,73 @@ var (
 			},
 		},
 	}
+	// S1ThreatsHistoryColumns holds the columns for the "s1_threats_history" table.
+	S1ThreatsHistoryColumns = []*schema.Column{
+		{Name: "history_id", Type: field.TypeUint, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "resource_id", Type: field.TypeString},
+		{Name: "agent_id", Type: field.TypeString, Nullable: true},
+		{Name: "agent_uuid", Type: field.TypeString, Nullable: true},
+		{Name: "agent_computer_name", Type: field.TypeString, Nullable: true},
+		{Name: "agent_os_type", Type: field.TypeString, Nullable: true},
+		{Name: "account_id", Type: field.TypeString, Nullable: true},
+		{Name: "site_id", Type: field.TypeString, Nullable: true},
+		{Name: "site_name", Type: field.TypeString, Nullable: true},
+		{Name: "group_id", Type: field.TypeString, Nullable: true},
+		{Name: "threat_name", Type: field.TypeString, Nullable: true},
+		{Name: "classification", Type: field.TypeString, Nullable: true},
+		{Name: "classification_source", Type: field.TypeString, Nullable: true},
+		{Name: "confidence_level", Type: field.TypeString, Nullable: true},
+		{Name: "mitigation_status", Type: field.TypeString, Nullable: true},
+		{Name: "analyst_verdict", Type: field.TypeString, Nullable: true},
+		{Name: "incident_status", Type: field.TypeString, Nullable: true},
+		{Name: "initiated_by", Type: field.TypeString, Nullable: true},
+		{Name: "detection_type", Type: field.TypeString, Nullable: true},
+		{Name: "file_path", Type: field.TypeString, Nullable: true},
+		{Name: "sha1", Type: field.TypeString, Nullable: true},
+		{Name: "sha256", Type: field.TypeString, Nullable: true},
+		{Name: "md5", Type: field.TypeString, Nullable: true},
+		{Name: "storyline", Type: field.TypeString, Nullable: true},
+		{Name: "is_fileless", Type: field.TypeBool, Default: false},
+		{Name: "mitigated_preemptively", Type: field.TypeBool, Default: false},
+		{Name: "identified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "api_updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "engines_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "indicators_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "mitigation_actions_json", Type: field.TypeJSON, Nullable: true},
+	}
+	// S1ThreatsHistoryTable holds the schema information for the "s1_threats_history" table.
+	S1ThreatsHistoryTable = &schema.Table{
+		Name:       "s1_threats_history",
+		Columns:    S1ThreatsHistoryColumns,
+		PrimaryKey: []*schema.Column{S1ThreatsHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzehistorys1threat_resource_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{S1ThreatsHistoryColumns[5], S1ThreatsHistoryColumns[1]},
+			},
+			{
+				Name:    "bronzehistorys1threat_valid_to",
+				Unique:  false,
+				Columns: []*schema.Column{S1ThreatsHistoryColumns[2]},
+			},
+			{
+				Name:    "bronzehistorys1threat_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{S1ThreatsHistoryColumns[3]},
+			},
+			{
+				Name:    "bronzehistorys1threat_agent_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1ThreatsHistoryColumns[6]},
+			},
+		},
+	}
 	// S1AccountsColumns holds the columns for the "s1_accounts" table.
 	S1AccountsColumns = []*schema.Column{
 		{Name: "resource_id", Type: field.TypeString, Unique: true},
@@ -759,6 +1108,64 @@ var (
 			},
 		},
 	}
+	// S1ActivitiesColumns holds the columns for the "s1_activities" table.
+	S1ActivitiesColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "activity_type", Type: field.TypeInt},
+		{Name: "activity_uuid", Type: field.TypeString, Nullable: true},
+		{Name: "primary_description", Type: field.TypeString, Nullable: true},
+		{Name: "secondary_description", Type: field.TypeString, Nullable: true},
+		{Name: "comments", Type: field.TypeString, Nullable: true},
+		{Name: "account_id", Type: field.TypeString, Nullable: true},
+		{Name: "account_name", Type: field.TypeString, Nullable: true},
+		{Name: "site_id", Type: field.TypeString, Nullable: true},
+		{Name: "site_name", Type: field.TypeString, Nullable: true},
+		{Name: "group_id", Type: field.TypeString, Nullable: true},
+		{Name: "group_name", Type: field.TypeString, Nullable: true},
+		{Name: "agent_id", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+		{Name: "threat_id", Type: field.TypeString, Nullable: true},
+		{Name: "hash", Type: field.TypeString, Nullable: true},
+		{Name: "os_family", Type: field.TypeString, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime},
+		{Name: "api_updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "data_json", Type: field.TypeJSON, Nullable: true},
+	}
+	// S1ActivitiesTable holds the schema information for the "s1_activities" table.
+	S1ActivitiesTable = &schema.Table{
+		Name:       "s1_activities",
+		Columns:    S1ActivitiesColumns,
+		PrimaryKey: []*schema.Column{S1ActivitiesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzes1activity_api_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{S1ActivitiesColumns[19]},
+			},
+			{
+				Name:    "bronzes1activity_activity_type",
+				Unique:  false,
+				Columns: []*schema.Column{S1ActivitiesColumns[3]},
+			},
+			{
+				Name:    "bronzes1activity_agent_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1ActivitiesColumns[14]},
+			},
+			{
+				Name:    "bronzes1activity_site_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1ActivitiesColumns[10]},
+			},
+			{
+				Name:    "bronzes1activity_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1ActivitiesColumns[15]},
+			},
+		},
+	}
 	// S1AgentsColumns holds the columns for the "s1_agents" table.
 	S1AgentsColumns = []*schema.Column{
 		{Name: "resource_id", Type: field.TypeString, Unique: true},
@@ -893,6 +1300,141 @@ var (
 			},
 		},
 	}
+	// S1AlertsColumns holds the columns for the "s1_alerts" table.
+	S1AlertsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "agent_id", Type: field.TypeString, Nullable: true},
+		{Name: "agent_uuid", Type: field.TypeString, Nullable: true},
+		{Name: "agent_name", Type: field.TypeString, Nullable: true},
+		{Name: "agent_os_name", Type: field.TypeString, Nullable: true},
+		{Name: "site_id", Type: field.TypeString, Nullable: true},
+		{Name: "rule_id", Type: field.TypeString, Nullable: true},
+		{Name: "rule_name", Type: field.TypeString, Nullable: true},
+		{Name: "rule_severity", Type: field.TypeString, Nullable: true},
+		{Name: "rule_description", Type: field.TypeString, Nullable: true},
+		{Name: "rule_scope_level", Type: field.TypeString, Nullable: true},
+		{Name: "rule_treat_as_threat", Type: field.TypeString, Nullable: true},
+		{Name: "analyst_verdict", Type: field.TypeString, Nullable: true},
+		{Name: "incident_status", Type: field.TypeString, Nullable: true},
+		{Name: "event_type", Type: field.TypeString, Nullable: true},
+		{Name: "hit_type", Type: field.TypeString, Nullable: true},
+		{Name: "source", Type: field.TypeString, Nullable: true},
+		{Name: "dv_event_id", Type: field.TypeString, Nullable: true},
+		{Name: "is_edr", Type: field.TypeBool, Default: false},
+		{Name: "reported_at", Type: field.TypeTime, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "api_updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "source_process_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "target_process_json", Type: field.TypeJSON, Nullable: true},
+	}
+	// S1AlertsTable holds the schema information for the "s1_alerts" table.
+	S1AlertsTable = &schema.Table{
+		Name:       "s1_alerts",
+		Columns:    S1AlertsColumns,
+		PrimaryKey: []*schema.Column{S1AlertsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzes1alert_agent_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1AlertsColumns[3]},
+			},
+			{
+				Name:    "bronzes1alert_site_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1AlertsColumns[7]},
+			},
+			{
+				Name:    "bronzes1alert_rule_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1AlertsColumns[8]},
+			},
+			{
+				Name:    "bronzes1alert_rule_severity",
+				Unique:  false,
+				Columns: []*schema.Column{S1AlertsColumns[10]},
+			},
+			{
+				Name:    "bronzes1alert_incident_status",
+				Unique:  false,
+				Columns: []*schema.Column{S1AlertsColumns[15]},
+			},
+			{
+				Name:    "bronzes1alert_api_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{S1AlertsColumns[23]},
+			},
+			{
+				Name:    "bronzes1alert_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{S1AlertsColumns[1]},
+			},
+		},
+	}
+	// S1AppCvesColumns holds the columns for the "s1_app_cves" table.
+	S1AppCvesColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "agent_id", Type: field.TypeString},
+		{Name: "endpoint_name", Type: field.TypeString, Nullable: true},
+		{Name: "endpoint_type", Type: field.TypeString, Nullable: true},
+		{Name: "os_type", Type: field.TypeString, Nullable: true},
+		{Name: "application_name", Type: field.TypeString},
+		{Name: "application_vendor", Type: field.TypeString, Nullable: true},
+		{Name: "application_version", Type: field.TypeString, Nullable: true},
+		{Name: "cve_id", Type: field.TypeString},
+		{Name: "cvss_score", Type: field.TypeFloat64, Default: 0},
+		{Name: "cvss_version", Type: field.TypeString, Nullable: true},
+		{Name: "nvd_severity", Type: field.TypeString, Nullable: true},
+		{Name: "risk_score", Type: field.TypeFloat64, Default: 0},
+		{Name: "exploit_code_maturity", Type: field.TypeString, Nullable: true},
+		{Name: "exploited_in_the_wild", Type: field.TypeBool, Default: false},
+		{Name: "remediation_level", Type: field.TypeString, Nullable: true},
+		{Name: "report_confidence", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString, Nullable: true},
+		{Name: "published_date", Type: field.TypeTime, Nullable: true},
+		{Name: "detection_date", Type: field.TypeTime, Nullable: true},
+	}
+	// S1AppCvesTable holds the schema information for the "s1_app_cves" table.
+	S1AppCvesTable = &schema.Table{
+		Name:       "s1_app_cves",
+		Columns:    S1AppCvesColumns,
+		PrimaryKey: []*schema.Column{S1AppCvesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzes1appcve_agent_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1AppCvesColumns[3]},
+			},
+			{
+				Name:    "bronzes1appcve_cve_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1AppCvesColumns[10]},
+			},
+			{
+				Name:    "bronzes1appcve_nvd_severity",
+				Unique:  false,
+				Columns: []*schema.Column{S1AppCvesColumns[13]},
+			},
+			{
+				Name:    "bronzes1appcve_exploited_in_the_wild",
+				Unique:  false,
+				Columns: []*schema.Column{S1AppCvesColumns[16]},
+			},
+			{
+				Name:    "bronzes1appcve_application_name_application_version",
+				Unique:  false,
+				Columns: []*schema.Column{S1AppCvesColumns[7], S1AppCvesColumns[9]},
+			},
+			{
+				Name:    "bronzes1appcve_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{S1AppCvesColumns[1]},
+			},
+		},
+	}
 	// S1AppInventoryColumns holds the columns for the "s1_app_inventory" table.
 	S1AppInventoryColumns = []*schema.Column{
 		{Name: "resource_id", Type: field.TypeString, Unique: true},
@@ -967,6 +1509,67 @@ var (
 			},
 		},
 	}
+	// S1ExclusionsColumns holds the columns for the "s1_exclusions" table.
+	S1ExclusionsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "scope_level", Type: field.TypeString},
+		{Name: "account_id", Type: field.TypeString, Nullable: true},
+		{Name: "site_id", Type: field.TypeString, Nullable: true},
+		{Name: "group_id", Type: field.TypeString, Nullable: true},
+		{Name: "scope_name", Type: field.TypeString, Nullable: true},
+		{Name: "scope_path", Type: field.TypeString, Nullable: true},
+		{Name: "exclusion_type", Type: field.TypeString},
+		{Name: "exclusion_value", Type: field.TypeString, Nullable: true},
+		{Name: "os_type", Type: field.TypeString, Nullable: true},
+		{Name: "mode", Type: field.TypeString, Nullable: true},
+		{Name: "path_exclusion_type", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "source", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+		{Name: "user_name", Type: field.TypeString, Nullable: true},
+		{Name: "application_name", Type: field.TypeString, Nullable: true},
+		{Name: "include_children", Type: field.TypeBool, Default: false},
+		{Name: "include_subfolders", Type: field.TypeBool, Default: false},
+		{Name: "imported", Type: field.TypeBool, Default: false},
+		{Name: "actions_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "api_updated_at", Type: field.TypeTime, Nullable: true},
+	}
+	// S1ExclusionsTable holds the schema information for the "s1_exclusions" table.
+	S1ExclusionsTable = &schema.Table{
+		Name:       "s1_exclusions",
+		Columns:    S1ExclusionsColumns,
+		PrimaryKey: []*schema.Column{S1ExclusionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzes1exclusion_scope_level",
+				Unique:  false,
+				Columns: []*schema.Column{S1ExclusionsColumns[3]},
+			},
+			{
+				Name:    "bronzes1exclusion_site_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1ExclusionsColumns[5]},
+			},
+			{
+				Name:    "bronzes1exclusion_group_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1ExclusionsColumns[6]},
+			},
+			{
+				Name:    "bronzes1exclusion_exclusion_type",
+				Unique:  false,
+				Columns: []*schema.Column{S1ExclusionsColumns[9]},
+			},
+			{
+				Name:    "bronzes1exclusion_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{S1ExclusionsColumns[1]},
+			},
+		},
+	}
 	// S1GroupsColumns holds the columns for the "s1_groups" table.
 	S1GroupsColumns = []*schema.Column{
 		{Name: "resource_id", Type: field.TypeString, Unique: true},
@@ -1010,6 +1613,27 @@ var (
 			},
 		},
 	}
+	// S1IngestCursorsColumns holds the columns for the "s1_ingest_cursors" table.
+	S1IngestCursorsColumns = []*schema.Column{
+		{Name: "cursor_id", Type: field.TypeUint, Increment: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "last_updated_at", Type: field.TypeTime},
+	}
+	// S1IngestCursorsTable holds the schema information for the "s1_ingest_cursors" table.
+	S1IngestCursorsTable = &schema.Table{
+		Name:       "s1_ingest_cursors",
+		Columns:    S1IngestCursorsColumns,
+		PrimaryKey: []*schema.Column{S1IngestCursorsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzes1ingestcursor_name",
+				Unique:  true,
+				Columns: []*schema.Column{S1IngestCursorsColumns[3]},
+			},
+		},
+	}
 	// S1NetworkDiscoveriesColumns holds the columns for the "s1_network_discoveries" table.
 	S1NetworkDiscoveriesColumns = []*schema.Column{
 		{Name: "resource_id", Type: field.TypeString, Unique: true},
@@ -1137,6 +1761,57 @@ var (
 			},
 		},
 	}
+	// S1PoliciesColumns holds the columns for the "s1_policies" table.
+	S1PoliciesColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "scope_level", Type: field.TypeString},
+		{Name: "account_id", Type: field.TypeString, Nullable: true},
+		{Name: "site_id", Type: field.TypeString, Nullable: true},
+		{Name: "group_id", Type: field.TypeString, Nullable: true},
+		{Name: "scope_name", Type: field.TypeString, Nullable: true},
+		{Name: "inherited_from", Type: field.TypeString, Nullable: true},
+		{Name: "mitigation_mode", Type: field.TypeString, Nullable: true},
+		{Name: "mitigation_mode_suspicious", Type: field.TypeString, Nullable: true},
+		{Name: "auto_mitigation_action", Type: field.TypeString, Nullable: true},
+		{Name: "anti_tampering_on", Type: field.TypeBool, Default: false},
+		{Name: "snapshots_on", Type: field.TypeBool, Default: false},
+		{Name: "agent_logging_on", Type: field.TypeBool, Default: false},
+		{Name: "scan_new_agents", Type: field.TypeBool, Default: false},
+		{Name: "network_quarantine_on", Type: field.TypeBool, Default: false},
+		{Name: "engines_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "policy_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "api_updated_at", Type: field.TypeTime, Nullable: true},
+	}
+	// S1PoliciesTable holds the schema information for the "s1_policies" table.
+	S1PoliciesTable = &schema.Table{
+		Name:       "s1_policies",
+		Columns:    S1PoliciesColumns,
+		PrimaryKey: []*schema.Column{S1PoliciesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzes1policy_scope_level",
+				Unique:  false,
+				Columns: []*schema.Column{S1PoliciesColumns[3]},
+			},
+			{
+				Name:    "bronzes1policy_site_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1PoliciesColumns[5]},
+			},
+			{
+				Name:    "bronzes1policy_group_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1PoliciesColumns[6]},
+			},
+			{
+				Name:    "bronzes1policy_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{S1PoliciesColumns[1]},
+			},
+		},
+	}
 	// S1RangerDevicesColumns holds the columns for the "s1_ranger_devices" table.
 	S1RangerDevicesColumns = []*schema.Column{
 		{Name: "resource_id", Type: field.TypeString, Unique: true},
@@ -1316,6 +1991,61 @@ var (
 			},
 		},
 	}
+	// S1RestrictionsColumns holds the columns for the "s1_restrictions" table.
+	S1RestrictionsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "scope_level", Type: field.TypeString},
+		{Name: "account_id", Type: field.TypeString, Nullable: true},
+		{Name: "site_id", Type: field.TypeString, Nullable: true},
+		{Name: "group_id", Type: field.TypeString, Nullable: true},
+		{Name: "scope_name", Type: field.TypeString, Nullable: true},
+		{Name: "scope_path", Type: field.TypeString, Nullable: true},
+		{Name: "restriction_type", Type: field.TypeString},
+		{Name: "restriction_value", Type: field.TypeString, Nullable: true},
+		{Name: "os_type", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "source", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+		{Name: "user_name", Type: field.TypeString, Nullable: true},
+		{Name: "imported", Type: field.TypeBool, Default: false},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "api_updated_at", Type: field.TypeTime, Nullable: true},
+	}
+	// S1RestrictionsTable holds the schema information for the "s1_restrictions" table.
+	S1RestrictionsTable = &schema.Table{
+		Name:       "s1_restrictions",
+		Columns:    S1RestrictionsColumns,
+		PrimaryKey: []*schema.Column{S1RestrictionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzes1restriction_scope_level",
+				Unique:  false,
+				Columns: []*schema.Column{S1RestrictionsColumns[3]},
+			},
+			{
+				Name:    "bronzes1restriction_site_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1RestrictionsColumns[5]},
+			},
+			{
+				Name:    "bronzes1restriction_group_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1RestrictionsColumns[6]},
+			},
+			{
+				Name:    "bronzes1restriction_restriction_value",
+				Unique:  false,
+				Columns: []*schema.Column{S1RestrictionsColumns[10]},
+			},
+			{
+				Name:    "bronzes1restriction_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{S1RestrictionsColumns[1]},
+			},
+		},
+	}
 	// S1SitesColumns holds the columns for the "s1_sites" table.
 	S1SitesColumns = []*schema.Column{
 		{Name: "resource_id", Type: field.TypeString, Unique: true},
@@ -1368,30 +2098,123 @@ var (
 			},
 		},
 	}
+	// S1ThreatsColumns holds the columns for the "s1_threats" table.
+	S1ThreatsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "collected_at", Type: field.TypeTime},
+		{Name: "first_collected_at", Type: field.TypeTime},
+		{Name: "agent_id", Type: field.TypeString, Nullable: true},
+		{Name: "agent_uuid", Type: field.TypeString, Nullable: true},
+		{Name: "agent_computer_name", Type: field.TypeString, Nullable: true},
+		{Name: "agent_os_type", Type: field.TypeString, Nullable: true},
+		{Name: "account_id", Type: field.TypeString, Nullable: true},
+		{Name: "site_id", Type: field.TypeString, Nullable: true},
+		{Name: "site_name", Type: field.TypeString, Nullable: true},
+		{Name: "group_id", Type: field.TypeString, Nullable: true},
+		{Name: "threat_name", Type: field.TypeString, Nullable: true},
+		{Name: "classification", Type: field.TypeString, Nullable: true},
+		{Name: "classification_source", Type: field.TypeString, Nullable: true},
+		{Name: "confidence_level", Type: field.TypeString, Nullable: true},
+		{Name: "mitigation_status", Type: field.TypeString, Nullable: true},
+		{Name: "analyst_verdict", Type: field.TypeString, Nullable: true},
+		{Name: "incident_status", Type: field.TypeString, Nullable: true},
+		{Name: "initiated_by", Type: field.TypeString, Nullable: true},
+		{Name: "detection_type", Type: field.TypeString, Nullable: true},
+		{Name: "file_path", Type: field.TypeString, Nullable: true},
+		{Name: "sha1", Type: field.TypeString, Nullable: true},
+		{Name: "sha256", Type: field.TypeString, Nullable: true},
+		{Name: "md5", Type: field.TypeString, Nullable: true},
+		{Name: "storyline", Type: field.TypeString, Nullable: true},
+		{Name: "is_fileless", Type: field.TypeBool, Default: false},
+		{Name: "mitigated_preemptively", Type: field.TypeBool, Default: false},
+		{Name: "identified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "api_created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "api_updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "engines_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "indicators_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "mitigation_actions_json", Type: field.TypeJSON, Nullable: true},
+	}
+	// S1ThreatsTable holds the schema information for the "s1_threats" table.
+	S1ThreatsTable = &schema.Table{
+		Name:       "s1_threats",
+		Columns:    S1ThreatsColumns,
+		PrimaryKey: []*schema.Column{S1ThreatsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bronzes1threat_agent_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1ThreatsColumns[3]},
+			},
+			{
+				Name:    "bronzes1threat_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1ThreatsColumns[7]},
+			},
+			{
+				Name:    "bronzes1threat_site_id",
+				Unique:  false,
+				Columns: []*schema.Column{S1ThreatsColumns[8]},
+			},
+			{
+				Name:    "bronzes1threat_mitigation_status",
+				Unique:  false,
+				Columns: []*schema.Column{S1ThreatsColumns[15]},
+			},
+			{
+				Name:    "bronzes1threat_classification",
+				Unique:  false,
+				Columns: []*schema.Column{S1ThreatsColumns[12]},
+			},
+			{
+				Name:    "bronzes1threat_api_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{S1ThreatsColumns[29]},
+			},
+			{
+				Name:    "bronzes1threat_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{S1ThreatsColumns[1]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		S1AccountsHistoryTable,
 		S1AgentsHistoryTable,
 		S1AgentNicsHistoryTable,
+		S1AlertsHistoryTable,
+		S1AppCvesHistoryTable,
 		S1AppInventoryHistoryTable,
 		S1EndpointAppsHistoryTable,
+		S1ExclusionsHistoryTable,
 		S1GroupsHistoryTable,
 		S1NetworkDiscoveriesHistoryTable,
+		S1PoliciesHistoryTable,
 		S1RangerDevicesHistoryTable,
 		S1RangerGatewaysHistoryTable,
 		S1RangerSettingsHistoryTable,
+		S1RestrictionsHistoryTable,
 		S1SitesHistoryTable,
+		S1ThreatsHistoryTable,
 		S1AccountsTable,
+		S1ActivitiesTable,
 		S1AgentsTable,
 		S1AgentNicsTable,
+		S1AlertsTable,
+		S1AppCvesTable,
 		S1AppInventoryTable,
 		S1EndpointAppsTable,
+		S1ExclusionsTable,
 		S1GroupsTable,
+		S1IngestCursorsTable,
 		S1NetworkDiscoveriesTable,
+		S1PoliciesTable,
 		S1RangerDevicesTable,
 		S1RangerGatewaysTable,
 		S1RangerSettingsTable,
+		S1RestrictionsTable,
 		S1SitesTable,
+		S1ThreatsTable,
 	}
 )
 
@@ -1405,18 +2228,30 @@ func init() {
 	S1AgentNicsHistoryTable.Annotation = &entsql.Annotation{
 		Table: "s1_agent_nics_history",
 	}
+	S1AlertsHistoryTable.Annotation = &entsql.Annotation{
+		Table: "s1_alerts_history",
+	}
+	S1AppCvesHistoryTable.Annotation = &entsql.Annotation{
+		Table: "s1_app_cves_history",
+	}
 	S1AppInventoryHistoryTable.Annotation = &entsql.Annotation{
 		Table: "s1_app_inventory_history",
 	}
 	S1EndpointAppsHistoryTable.Annotation = &entsql.Annotation{
 		Table: "s1_endpoint_apps_history",
 	}
+	S1ExclusionsHistoryTable.Annotation = &entsql.Annotation{
+		Table: "s1_exclusions_history",
+	}
 	S1GroupsHistoryTable.Annotation = &entsql.Annotation{
 		Table: "s1_groups_history",
 	}
 	S1NetworkDiscoveriesHistoryTable.Annotation = &entsql.Annotation{
 		Table: "s1_network_discoveries_history",
 	}
+	S1PoliciesHistoryTable.Annotation = &entsql.Annotation{
+		Table: "s1_policies_history",
+	}
 	S1RangerDevicesHistoryTable.Annotation = &entsql.Annotation{
 		Table: "s1_ranger_devices_history",
 	}
@@ -1426,12 +2261,21 @@ func init() {
 	S1RangerSettingsHistoryTable.Annotation = &entsql.Annotation{
 		Table: "s1_ranger_settings_history",
 	}
+	S1RestrictionsHistoryTable.Annotation = &entsql.Annotation{
+		Table: "s1_restrictions_history",
+	}
 	S1SitesHistoryTable.Annotation = &entsql.Annotation{
 		Table: "s1_sites_history",
 	}
+	S1ThreatsHistoryTable.Annotation = &entsql.Annotation{
+		Table: "s1_threats_history",
+	}
 	S1AccountsTable.Annotation = &entsql.Annotation{
 		Table: "s1_accounts",
 	}
+	S1ActivitiesTable.Annotation = &entsql.Annotation{
+		Table: "s1_activities",
+	}
 	S1AgentsTable.Annotation = &entsql.Annotation{
 		Table: "s1_agents",
 	}
@@ -1439,18 +2283,33 @@ func init() {
 	S1AgentNicsTable.Annotation = &entsql.Annotation{
 		Table: "s1_agent_nics",
 	}
+	S1AlertsTable.Annotation = &entsql.Annotation{
+		Table: "s1_alerts",
+	}
+	S1AppCvesTable.Annotation = &entsql.Annotation{
+		Table: "s1_app_cves",
+	}
 	S1AppInventoryTable.Annotation = &entsql.Annotation{
 		Table: "s1_app_inventory",
 	}
 	S1EndpointAppsTable.Annotation = &entsql.Annotation{
 		Table: "s1_endpoint_apps",
 	}
+	S1ExclusionsTable.Annotation = &entsql.Annotation{
+		Table: "s1_exclusions",
+	}
 	S1GroupsTable.Annotation = &entsql.Annotation{
 		Table: "s1_groups",
 	}
+	S1IngestCursorsTable.Annotation = &entsql.Annotation{
+		Table: "s1_ingest_cursors",
+	}
 	S1NetworkDiscoveriesTable.Annotation = &entsql.Annotation{
 		Table: "s1_network_discoveries",
 	}
+	S1PoliciesTable.Annotation = &entsql.Annotation{
+		Table: "s1_policies",
+	}
 	S1RangerDevicesTable.Annotation = &entsql.Annotation{
 		Table: "s1_ranger_devices",
 	}
@@ -1460,7 +2319,13 @@ func init() {
 	S1RangerSettingsTable.Annotation = &entsql.Annotation{
 		Table: "s1_ranger_settings",
 	}
+	S1RestrictionsTable.Annotation = &entsql.Annotation{
+		Table: "s1_restrictions",
+	}
 	S1SitesTable.Annotation = &entsql.Annotation{
 		Table: "s1_sites",
 	}
+	S1ThreatsTable.Annotation = &entsql.Annotation{
+		Table: "s1_threats",
+	}
 }
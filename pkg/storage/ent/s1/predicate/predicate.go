@@ -15,18 +15,30 @@ type BronzeHistoryS1Agent func(*sql.Selector)
 // BronzeHistoryS1AgentNIC is the predicate function for bronzehistorys1agentnic builders.
 type BronzeHistoryS1AgentNIC func(*sql.Selector)
 
+// BronzeHistoryS1Alert is the predicate function for bronzehistorys1alert builders.
+type BronzeHistoryS1Alert func(*sql.Selector)
+
+// BronzeHistoryS1AppCVE is the predicate function for bronzehistorys1appcve builders.
+type BronzeHistoryS1AppCVE func(*sql.Selector)
+
 // BronzeHistoryS1AppInventory is the predicate function for bronzehistorys1appinventory builders.
 type BronzeHistoryS1AppInventory func(*sql.Selector)
 
 // BronzeHistoryS1EndpointApp is the predicate function for bronzehistorys1endpointapp builders.
 type BronzeHistoryS1EndpointApp func(*sql.Selector)
 
+// BronzeHistoryS1Exclusion is the predicate function for bronzehistorys1exclusion builders.
+type BronzeHistoryS1Exclusion func(*sql.Selector)
+
 // BronzeHistoryS1Group is the predicate function for bronzehistorys1group builders.
 type BronzeHistoryS1Group func(*sql.Selector)
 
 // BronzeHistoryS1NetworkDiscovery is the predicate function for bronzehistorys1networkdiscovery builders.
 type BronzeHistoryS1NetworkDiscovery func(*sql.Selector)
 
+// BronzeHistoryS1Policy is the predicate function for bronzehistorys1policy builders.
+type BronzeHistoryS1Policy func(*sql.Selector)
+
 // BronzeHistoryS1RangerDevice is the predicate function for bronzehistorys1rangerdevice builders.
 type BronzeHistoryS1RangerDevice func(*sql.Selector)
 
@@ -36,30 +48,54 @@ type BronzeHistoryS1RangerGateway func(*sql.Selector)
 // BronzeHistoryS1RangerSetting is the predicate function for bronzehistorys1rangersetting builders.
 type BronzeHistoryS1RangerSetting func(*sql.Selector)
 
+// BronzeHistoryS1Restriction is the predicate function for bronzehistorys1restriction builders.
+type BronzeHistoryS1Restriction func(*sql.Selector)
+
 // BronzeHistoryS1Site is the predicate function for bronzehistorys1site builders.
 type BronzeHistoryS1Site func(*sql.Selector)
 
+// BronzeHistoryS1Threat is the predicate function for bronzehistorys1threat builders.
+type BronzeHistoryS1Threat func(*sql.Selector)
+
 // BronzeS1Account is the predicate function for bronzes1account builders.
 type BronzeS1Account func(*sql.Selector)
 
+// BronzeS1Activity is the predicate function for bronzes1activity builders.
+type BronzeS1Activity func(*sql.Selector)
+
 // BronzeS1Agent is the predicate function for bronzes1agent builders.
 type BronzeS1Agent func(*sql.Selector)
 
 // BronzeS1AgentNIC is the predicate function for bronzes1agentnic builders.
 type BronzeS1AgentNIC func(*sql.Selector)
 
+// BronzeS1Alert is the predicate function for bronzes1alert builders.
+type BronzeS1Alert func(*sql.Selector)
+
+// BronzeS1AppCVE is the predicate function for bronzes1appcve builders.
+type BronzeS1AppCVE func(*sql.Selector)
+
 // BronzeS1AppInventory is the predicate function for bronzes1appinventory builders.
 type BronzeS1AppInventory func(*sql.Selector)
 
 // BronzeS1EndpointApp is the predicate function for bronzes1endpointapp builders.
 type BronzeS1EndpointApp func(*sql.Selector)
 
+// BronzeS1Exclusion is the predicate function for bronzes1exclusion builders.
+type BronzeS1Exclusion func(*sql.Selector)
+
 // BronzeS1Group is the predicate function for bronzes1group builders.
 type BronzeS1Group func(*sql.Selector)
 
+// BronzeS1IngestCursor is the predicate function for bronzes1ingestcursor builders.
+type BronzeS1IngestCursor func(*sql.Selector)
+
 // BronzeS1NetworkDiscovery is the predicate function for bronzes1networkdiscovery builders.
 type BronzeS1NetworkDiscovery func(*sql.Selector)
 
+// BronzeS1Policy is the predicate function for bronzes1policy builders.
+type BronzeS1Policy func(*sql.Selector)
+
 // BronzeS1RangerDevice is the predicate function for bronzes1rangerdevice builders.
 type BronzeS1RangerDevice func(*sql.Selector)
 
@@ -69,5 +105,11 @@ type BronzeS1RangerGateway func(*sql.Selector)
 // BronzeS1RangerSetting is the predicate function for bronzes1rangersetting builders.
 type BronzeS1RangerSetting func(*sql.Selector)
 
+// BronzeS1Restriction is the predicate function for bronzes1restriction builders.
+type BronzeS1Restriction func(*sql.Selector)
+
 // BronzeS1Site is the predicate function for bronzes1site builders.
 type BronzeS1Site func(*sql.Selector)
+
+// BronzeS1Threat is the predicate function for bronzes1threat builders.
+type BronzeS1Threat func(*sql.Selector)
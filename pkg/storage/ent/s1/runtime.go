@@ -5,24 +5,37 @@ package s1
 import (
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1account"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1agent"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1alert"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1appcve"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1appinventory"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1endpointapp"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1exclusion"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1group"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1networkdiscovery"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1policy"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1rangerdevice"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1rangergateway"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1rangersetting"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1restriction"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1site"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzehistorys1threat"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1account"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1agent"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1alert"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1appcve"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1appinventory"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1endpointapp"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1exclusion"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1group"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1ingestcursor"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1networkdiscovery"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1policy"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1rangerdevice"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1rangergateway"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1rangersetting"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1restriction"
 	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1site"
+	"danny.vn/hotpot/pkg/storage/ent/s1/bronzes1threat"
 	"danny.vn/hotpot/pkg/storage/ent/s1/schema"
 )
 
@@ -110,6 +123,46 @@ func init() {
 	bronzehistorys1agentDescIsUninstalled := bronzehistorys1agentFields[50].Descriptor()
 	// bronzehistorys1agent.DefaultIsUninstalled holds the default value on creation for the is_uninstalled field.
 	bronzehistorys1agent.DefaultIsUninstalled = bronzehistorys1agentDescIsUninstalled.Default.(bool)
+	bronzehistorys1alertFields := schema.BronzeHistoryS1Alert{}.Fields()
+	_ = bronzehistorys1alertFields
+	// bronzehistorys1alertDescResourceID is the schema descriptor for resource_id field.
+	bronzehistorys1alertDescResourceID := bronzehistorys1alertFields[1].Descriptor()
+	// bronzehistorys1alert.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistorys1alert.ResourceIDValidator = bronzehistorys1alertDescResourceID.Validators[0].(func(string) error)
+	// bronzehistorys1alertDescIsEdr is the schema descriptor for is_edr field.
+	bronzehistorys1alertDescIsEdr := bronzehistorys1alertFields[19].Descriptor()
+	// bronzehistorys1alert.DefaultIsEdr holds the default value on creation for the is_edr field.
+	bronzehistorys1alert.DefaultIsEdr = bronzehistorys1alertDescIsEdr.Default.(bool)
+	bronzehistorys1appcveFields := schema.BronzeHistoryS1AppCVE{}.Fields()
+	_ = bronzehistorys1appcveFields
+	// bronzehistorys1appcveDescResourceID is the schema descriptor for resource_id field.
+	bronzehistorys1appcveDescResourceID := bronzehistorys1appcveFields[1].Descriptor()
+	// bronzehistorys1appcve.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistorys1appcve.ResourceIDValidator = bronzehistorys1appcveDescResourceID.Validators[0].(func(string) error)
+	// bronzehistorys1appcveDescAgentID is the schema descriptor for agent_id field.
+	bronzehistorys1appcveDescAgentID := bronzehistorys1appcveFields[2].Descriptor()
+	// bronzehistorys1appcve.AgentIDValidator is a validator for the "agent_id" field. It is called by the builders before save.
+	bronzehistorys1appcve.AgentIDValidator = bronzehistorys1appcveDescAgentID.Validators[0].(func(string) error)
+	// bronzehistorys1appcveDescApplicationName is the schema descriptor for application_name field.
+	bronzehistorys1appcveDescApplicationName := bronzehistorys1appcveFields[6].Descriptor()
+	// bronzehistorys1appcve.ApplicationNameValidator is a validator for the "application_name" field. It is called by the builders before save.
+	bronzehistorys1appcve.ApplicationNameValidator = bronzehistorys1appcveDescApplicationName.Validators[0].(func(string) error)
+	// bronzehistorys1appcveDescCveID is the schema descriptor for cve_id field.
+	bronzehistorys1appcveDescCveID := bronzehistorys1appcveFields[9].Descriptor()
+	// bronzehistorys1appcve.CveIDValidator is a validator for the "cve_id" field. It is called by the builders before save.
+	bronzehistorys1appcve.CveIDValidator = bronzehistorys1appcveDescCveID.Validators[0].(func(string) error)
+	// bronzehistorys1appcveDescCvssScore is the schema descriptor for cvss_score field.
+	bronzehistorys1appcveDescCvssScore := bronzehistorys1appcveFields[10].Descriptor()
+	// bronzehistorys1appcve.DefaultCvssScore holds the default value on creation for the cvss_score field.
+	bronzehistorys1appcve.DefaultCvssScore = bronzehistorys1appcveDescCvssScore.Default.(float64)
+	// bronzehistorys1appcveDescRiskScore is the schema descriptor for risk_score field.
+	bronzehistorys1appcveDescRiskScore := bronzehistorys1appcveFields[13].Descriptor()
+	// bronzehistorys1appcve.DefaultRiskScore holds the default value on creation for the risk_score field.
+	bronzehistorys1appcve.DefaultRiskScore = bronzehistorys1appcveDescRiskScore.Default.(float64)
+	// bronzehistorys1appcveDescExploitedInTheWild is the schema descriptor for exploited_in_the_wild field.
+	bronzehistorys1appcveDescExploitedInTheWild := bronzehistorys1appcveFields[15].Descriptor()
+	// bronzehistorys1appcve.DefaultExploitedInTheWild holds the default value on creation for the exploited_in_the_wild field.
+	bronzehistorys1appcve.DefaultExploitedInTheWild = bronzehistorys1appcveDescExploitedInTheWild.Default.(bool)
 	bronzehistorys1appinventoryFields := schema.BronzeHistoryS1AppInventory{}.Fields()
 	_ = bronzehistorys1appinventoryFields
 	// bronzehistorys1appinventoryDescResourceID is the schema descriptor for resource_id field.
@@ -138,6 +191,32 @@ func init() {
 	bronzehistorys1endpointappDescName := bronzehistorys1endpointappFields[3].Descriptor()
 	// bronzehistorys1endpointapp.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	bronzehistorys1endpointapp.NameValidator = bronzehistorys1endpointappDescName.Validators[0].(func(string) error)
+	bronzehistorys1exclusionFields := schema.BronzeHistoryS1Exclusion{}.Fields()
+	_ = bronzehistorys1exclusionFields
+	// bronzehistorys1exclusionDescResourceID is the schema descriptor for resource_id field.
+	bronzehistorys1exclusionDescResourceID := bronzehistorys1exclusionFields[1].Descriptor()
+	// bronzehistorys1exclusion.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistorys1exclusion.ResourceIDValidator = bronzehistorys1exclusionDescResourceID.Validators[0].(func(string) error)
+	// bronzehistorys1exclusionDescScopeLevel is the schema descriptor for scope_level field.
+	bronzehistorys1exclusionDescScopeLevel := bronzehistorys1exclusionFields[2].Descriptor()
+	// bronzehistorys1exclusion.ScopeLevelValidator is a validator for the "scope_level" field. It is called by the builders before save.
+	bronzehistorys1exclusion.ScopeLevelValidator = bronzehistorys1exclusionDescScopeLevel.Validators[0].(func(string) error)
+	// bronzehistorys1exclusionDescExclusionType is the schema descriptor for exclusion_type field.
+	bronzehistorys1exclusionDescExclusionType := bronzehistorys1exclusionFields[8].Descriptor()
+	// bronzehistorys1exclusion.ExclusionTypeValidator is a validator for the "exclusion_type" field. It is called by the builders before save.
+	bronzehistorys1exclusion.ExclusionTypeValidator = bronzehistorys1exclusionDescExclusionType.Validators[0].(func(string) error)
+	// bronzehistorys1exclusionDescIncludeChildren is the schema descriptor for include_children field.
+	bronzehistorys1exclusionDescIncludeChildren := bronzehistorys1exclusionFields[18].Descriptor()
+	// bronzehistorys1exclusion.DefaultIncludeChildren holds the default value on creation for the include_children field.
+	bronzehistorys1exclusion.DefaultIncludeChildren = bronzehistorys1exclusionDescIncludeChildren.Default.(bool)
+	// bronzehistorys1exclusionDescIncludeSubfolders is the schema descriptor for include_subfolders field.
+	bronzehistorys1exclusionDescIncludeSubfolders := bronzehistorys1exclusionFields[19].Descriptor()
+	// bronzehistorys1exclusion.DefaultIncludeSubfolders holds the default value on creation for the include_subfolders field.
+	bronzehistorys1exclusion.DefaultIncludeSubfolders = bronzehistorys1exclusionDescIncludeSubfolders.Default.(bool)
+	// bronzehistorys1exclusionDescImported is the schema descriptor for imported field.
+	bronzehistorys1exclusionDescImported := bronzehistorys1exclusionFields[20].Descriptor()
+	// bronzehistorys1exclusion.DefaultImported holds the default value on creation for the imported field.
+	bronzehistorys1exclusion.DefaultImported = bronzehistorys1exclusionDescImported.Default.(bool)
 	bronzehistorys1groupFields := schema.BronzeHistoryS1Group{}.Fields()
 	_ = bronzehistorys1groupFields
 	// bronzehistorys1groupDescResourceID is the schema descriptor for resource_id field.
@@ -178,6 +257,36 @@ func init() {
 	bronzehistorys1networkdiscoveryDescAdsEnabled := bronzehistorys1networkdiscoveryFields[54].Descriptor()
 	// bronzehistorys1networkdiscovery.DefaultAdsEnabled holds the default value on creation for the ads_enabled field.
 	bronzehistorys1networkdiscovery.DefaultAdsEnabled = bronzehistorys1networkdiscoveryDescAdsEnabled.Default.(bool)
+	bronzehistorys1policyFields := schema.BronzeHistoryS1Policy{}.Fields()
+	_ = bronzehistorys1policyFields
+	// bronzehistorys1policyDescResourceID is the schema descriptor for resource_id field.
+	bronzehistorys1policyDescResourceID := bronzehistorys1policyFields[1].Descriptor()
+	// bronzehistorys1policy.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistorys1policy.ResourceIDValidator = bronzehistorys1policyDescResourceID.Validators[0].(func(string) error)
+	// bronzehistorys1policyDescScopeLevel is the schema descriptor for scope_level field.
+	bronzehistorys1policyDescScopeLevel := bronzehistorys1policyFields[2].Descriptor()
+	// bronzehistorys1policy.ScopeLevelValidator is a validator for the "scope_level" field. It is called by the builders before save.
+	bronzehistorys1policy.ScopeLevelValidator = bronzehistorys1policyDescScopeLevel.Validators[0].(func(string) error)
+	// bronzehistorys1policyDescAntiTamperingOn is the schema descriptor for anti_tampering_on field.
+	bronzehistorys1policyDescAntiTamperingOn := bronzehistorys1policyFields[11].Descriptor()
+	// bronzehistorys1policy.DefaultAntiTamperingOn holds the default value on creation for the anti_tampering_on field.
+	bronzehistorys1policy.DefaultAntiTamperingOn = bronzehistorys1policyDescAntiTamperingOn.Default.(bool)
+	// bronzehistorys1policyDescSnapshotsOn is the schema descriptor for snapshots_on field.
+	bronzehistorys1policyDescSnapshotsOn := bronzehistorys1policyFields[12].Descriptor()
+	// bronzehistorys1policy.DefaultSnapshotsOn holds the default value on creation for the snapshots_on field.
+	bronzehistorys1policy.DefaultSnapshotsOn = bronzehistorys1policyDescSnapshotsOn.Default.(bool)
+	// bronzehistorys1policyDescAgentLoggingOn is the schema descriptor for agent_logging_on field.
+	bronzehistorys1policyDescAgentLoggingOn := bronzehistorys1policyFields[13].Descriptor()
+	// bronzehistorys1policy.DefaultAgentLoggingOn holds the default value on creation for the agent_logging_on field.
+	bronzehistorys1policy.DefaultAgentLoggingOn = bronzehistorys1policyDescAgentLoggingOn.Default.(bool)
+	// bronzehistorys1policyDescScanNewAgents is the schema descriptor for scan_new_agents field.
+	bronzehistorys1policyDescScanNewAgents := bronzehistorys1policyFields[14].Descriptor()
+	// bronzehistorys1policy.DefaultScanNewAgents holds the default value on creation for the scan_new_agents field.
+	bronzehistorys1policy.DefaultScanNewAgents = bronzehistorys1policyDescScanNewAgents.Default.(bool)
+	// bronzehistorys1policyDescNetworkQuarantineOn is the schema descriptor for network_quarantine_on field.
+	bronzehistorys1policyDescNetworkQuarantineOn := bronzehistorys1policyFields[15].Descriptor()
+	// bronzehistorys1policy.DefaultNetworkQuarantineOn holds the default value on creation for the network_quarantine_on field.
+	bronzehistorys1policy.DefaultNetworkQuarantineOn = bronzehistorys1policyDescNetworkQuarantineOn.Default.(bool)
 	bronzehistorys1rangerdeviceFields := schema.BronzeHistoryS1RangerDevice{}.Fields()
 	_ = bronzehistorys1rangerdeviceFields
 	// bronzehistorys1rangerdeviceDescResourceID is the schema descriptor for resource_id field.
@@ -308,6 +417,24 @@ func init() {
 	bronzehistorys1rangersettingDescCombineDevices := bronzehistorys1rangersettingFields[20].Descriptor()
 	// bronzehistorys1rangersetting.DefaultCombineDevices holds the default value on creation for the combine_devices field.
 	bronzehistorys1rangersetting.DefaultCombineDevices = bronzehistorys1rangersettingDescCombineDevices.Default.(bool)
+	bronzehistorys1restrictionFields := schema.BronzeHistoryS1Restriction{}.Fields()
+	_ = bronzehistorys1restrictionFields
+	// bronzehistorys1restrictionDescResourceID is the schema descriptor for resource_id field.
+	bronzehistorys1restrictionDescResourceID := bronzehistorys1restrictionFields[1].Descriptor()
+	// bronzehistorys1restriction.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistorys1restriction.ResourceIDValidator = bronzehistorys1restrictionDescResourceID.Validators[0].(func(string) error)
+	// bronzehistorys1restrictionDescScopeLevel is the schema descriptor for scope_level field.
+	bronzehistorys1restrictionDescScopeLevel := bronzehistorys1restrictionFields[2].Descriptor()
+	// bronzehistorys1restriction.ScopeLevelValidator is a validator for the "scope_level" field. It is called by the builders before save.
+	bronzehistorys1restriction.ScopeLevelValidator = bronzehistorys1restrictionDescScopeLevel.Validators[0].(func(string) error)
+	// bronzehistorys1restrictionDescRestrictionType is the schema descriptor for restriction_type field.
+	bronzehistorys1restrictionDescRestrictionType := bronzehistorys1restrictionFields[8].Descriptor()
+	// bronzehistorys1restriction.RestrictionTypeValidator is a validator for the "restriction_type" field. It is called by the builders before save.
+	bronzehistorys1restriction.RestrictionTypeValidator = bronzehistorys1restrictionDescRestrictionType.Validators[0].(func(string) error)
+	// bronzehistorys1restrictionDescImported is the schema descriptor for imported field.
+	bronzehistorys1restrictionDescImported := bronzehistorys1restrictionFields[15].Descriptor()
+	// bronzehistorys1restriction.DefaultImported holds the default value on creation for the imported field.
+	bronzehistorys1restriction.DefaultImported = bronzehistorys1restrictionDescImported.Default.(bool)
 	bronzehistorys1siteFields := schema.BronzeHistoryS1Site{}.Fields()
 	_ = bronzehistorys1siteFields
 	// bronzehistorys1siteDescResourceID is the schema descriptor for resource_id field.
@@ -346,6 +473,20 @@ func init() {
 	bronzehistorys1siteDescInheritAccountExpiration := bronzehistorys1siteFields[23].Descriptor()
 	// bronzehistorys1site.DefaultInheritAccountExpiration holds the default value on creation for the inherit_account_expiration field.
 	bronzehistorys1site.DefaultInheritAccountExpiration = bronzehistorys1siteDescInheritAccountExpiration.Default.(bool)
+	bronzehistorys1threatFields := schema.BronzeHistoryS1Threat{}.Fields()
+	_ = bronzehistorys1threatFields
+	// bronzehistorys1threatDescResourceID is the schema descriptor for resource_id field.
+	bronzehistorys1threatDescResourceID := bronzehistorys1threatFields[1].Descriptor()
+	// bronzehistorys1threat.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	bronzehistorys1threat.ResourceIDValidator = bronzehistorys1threatDescResourceID.Validators[0].(func(string) error)
+	// bronzehistorys1threatDescIsFileless is the schema descriptor for is_fileless field.
+	bronzehistorys1threatDescIsFileless := bronzehistorys1threatFields[24].Descriptor()
+	// bronzehistorys1threat.DefaultIsFileless holds the default value on creation for the is_fileless field.
+	bronzehistorys1threat.DefaultIsFileless = bronzehistorys1threatDescIsFileless.Default.(bool)
+	// bronzehistorys1threatDescMitigatedPreemptively is the schema descriptor for mitigated_preemptively field.
+	bronzehistorys1threatDescMitigatedPreemptively := bronzehistorys1threatFields[25].Descriptor()
+	// bronzehistorys1threat.DefaultMitigatedPreemptively holds the default value on creation for the mitigated_preemptively field.
+	bronzehistorys1threat.DefaultMitigatedPreemptively = bronzehistorys1threatDescMitigatedPreemptively.Default.(bool)
 	bronzes1accountFields := schema.BronzeS1Account{}.Fields()
 	_ = bronzes1accountFields
 	// bronzes1accountDescName is the schema descriptor for name field.
@@ -418,6 +559,38 @@ func init() {
 	bronzes1agentDescIsUninstalled := bronzes1agentFields[49].Descriptor()
 	// bronzes1agent.DefaultIsUninstalled holds the default value on creation for the is_uninstalled field.
 	bronzes1agent.DefaultIsUninstalled = bronzes1agentDescIsUninstalled.Default.(bool)
+	bronzes1alertFields := schema.BronzeS1Alert{}.Fields()
+	_ = bronzes1alertFields
+	// bronzes1alertDescIsEdr is the schema descriptor for is_edr field.
+	bronzes1alertDescIsEdr := bronzes1alertFields[18].Descriptor()
+	// bronzes1alert.DefaultIsEdr holds the default value on creation for the is_edr field.
+	bronzes1alert.DefaultIsEdr = bronzes1alertDescIsEdr.Default.(bool)
+	bronzes1appcveFields := schema.BronzeS1AppCVE{}.Fields()
+	_ = bronzes1appcveFields
+	// bronzes1appcveDescAgentID is the schema descriptor for agent_id field.
+	bronzes1appcveDescAgentID := bronzes1appcveFields[1].Descriptor()
+	// bronzes1appcve.AgentIDValidator is a validator for the "agent_id" field. It is called by the builders before save.
+	bronzes1appcve.AgentIDValidator = bronzes1appcveDescAgentID.Validators[0].(func(string) error)
+	// bronzes1appcveDescApplicationName is the schema descriptor for application_name field.
+	bronzes1appcveDescApplicationName := bronzes1appcveFields[5].Descriptor()
+	// bronzes1appcve.ApplicationNameValidator is a validator for the "application_name" field. It is called by the builders before save.
+	bronzes1appcve.ApplicationNameValidator = bronzes1appcveDescApplicationName.Validators[0].(func(string) error)
+	// bronzes1appcveDescCveID is the schema descriptor for cve_id field.
+	bronzes1appcveDescCveID := bronzes1appcveFields[8].Descriptor()
+	// bronzes1appcve.CveIDValidator is a validator for the "cve_id" field. It is called by the builders before save.
+	bronzes1appcve.CveIDValidator = bronzes1appcveDescCveID.Validators[0].(func(string) error)
+	// bronzes1appcveDescCvssScore is the schema descriptor for cvss_score field.
+	bronzes1appcveDescCvssScore := bronzes1appcveFields[9].Descriptor()
+	// bronzes1appcve.DefaultCvssScore holds the default value on creation for the cvss_score field.
+	bronzes1appcve.DefaultCvssScore = bronzes1appcveDescCvssScore.Default.(float64)
+	// bronzes1appcveDescRiskScore is the schema descriptor for risk_score field.
+	bronzes1appcveDescRiskScore := bronzes1appcveFields[12].Descriptor()
+	// bronzes1appcve.DefaultRiskScore holds the default value on creation for the risk_score field.
+	bronzes1appcve.DefaultRiskScore = bronzes1appcveDescRiskScore.Default.(float64)
+	// bronzes1appcveDescExploitedInTheWild is the schema descriptor for exploited_in_the_wild field.
+	bronzes1appcveDescExploitedInTheWild := bronzes1appcveFields[14].Descriptor()
+	// bronzes1appcve.DefaultExploitedInTheWild holds the default value on creation for the exploited_in_the_wild field.
+	bronzes1appcve.DefaultExploitedInTheWild = bronzes1appcveDescExploitedInTheWild.Default.(bool)
 	bronzes1appinventoryFields := schema.BronzeS1AppInventory{}.Fields()
 	_ = bronzes1appinventoryFields
 	// bronzes1appinventoryDescApplicationName is the schema descriptor for application_name field.
@@ -438,6 +611,28 @@ func init() {
 	bronzes1endpointappDescName := bronzes1endpointappFields[2].Descriptor()
 	// bronzes1endpointapp.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	bronzes1endpointapp.NameValidator = bronzes1endpointappDescName.Validators[0].(func(string) error)
+	bronzes1exclusionFields := schema.BronzeS1Exclusion{}.Fields()
+	_ = bronzes1exclusionFields
+	// bronzes1exclusionDescScopeLevel is the schema descriptor for scope_level field.
+	bronzes1exclusionDescScopeLevel := bronzes1exclusionFields[1].Descriptor()
+	// bronzes1exclusion.ScopeLevelValidator is a validator for the "scope_level" field. It is called by the builders before save.
+	bronzes1exclusion.ScopeLevelValidator = bronzes1exclusionDescScopeLevel.Validators[0].(func(string) error)
+	// bronzes1exclusionDescExclusionType is the schema descriptor for exclusion_type field.
+	bronzes1exclusionDescExclusionType := bronzes1exclusionFields[7].Descriptor()
+	// bronzes1exclusion.ExclusionTypeValidator is a validator for the "exclusion_type" field. It is called by the builders before save.
+	bronzes1exclusion.ExclusionTypeValidator = bronzes1exclusionDescExclusionType.Validators[0].(func(string) error)
+	// bronzes1exclusionDescIncludeChildren is the schema descriptor for include_children field.
+	bronzes1exclusionDescIncludeChildren := bronzes1exclusionFields[17].Descriptor()
+	// bronzes1exclusion.DefaultIncludeChildren holds the default value on creation for the include_children field.
+	bronzes1exclusion.DefaultIncludeChildren = bronzes1exclusionDescIncludeChildren.Default.(bool)
+	// bronzes1exclusionDescIncludeSubfolders is the schema descriptor for include_subfolders field.
+	bronzes1exclusionDescIncludeSubfolders := bronzes1exclusionFields[18].Descriptor()
+	// bronzes1exclusion.DefaultIncludeSubfolders holds the default value on creation for the include_subfolders field.
+	bronzes1exclusion.DefaultIncludeSubfolders = bronzes1exclusionDescIncludeSubfolders.Default.(bool)
+	// bronzes1exclusionDescImported is the schema descriptor for imported field.
+	bronzes1exclusionDescImported := bronzes1exclusionFields[19].Descriptor()
+	// bronzes1exclusion.DefaultImported holds the default value on creation for the imported field.
+	bronzes1exclusion.DefaultImported = bronzes1exclusionDescImported.Default.(bool)
 	bronzes1groupFields := schema.BronzeS1Group{}.Fields()
 	_ = bronzes1groupFields
 	// bronzes1groupDescName is the schema descriptor for name field.
@@ -456,6 +651,12 @@ func init() {
 	bronzes1groupDescTotalAgents := bronzes1groupFields[7].Descriptor()
 	// bronzes1group.DefaultTotalAgents holds the default value on creation for the total_agents field.
 	bronzes1group.DefaultTotalAgents = bronzes1groupDescTotalAgents.Default.(int)
+	bronzes1ingestcursorFields := schema.BronzeS1IngestCursor{}.Fields()
+	_ = bronzes1ingestcursorFields
+	// bronzes1ingestcursorDescName is the schema descriptor for name field.
+	bronzes1ingestcursorDescName := bronzes1ingestcursorFields[1].Descriptor()
+	// bronzes1ingestcursor.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	bronzes1ingestcursor.NameValidator = bronzes1ingestcursorDescName.Validators[0].(func(string) error)
 	bronzes1networkdiscoveryFields := schema.BronzeS1NetworkDiscovery{}.Fields()
 	_ = bronzes1networkdiscoveryFields
 	// bronzes1networkdiscoveryDescIsAdConnector is the schema descriptor for is_ad_connector field.
@@ -470,6 +671,32 @@ func init() {
 	bronzes1networkdiscoveryDescAdsEnabled := bronzes1networkdiscoveryFields[53].Descriptor()
 	// bronzes1networkdiscovery.DefaultAdsEnabled holds the default value on creation for the ads_enabled field.
 	bronzes1networkdiscovery.DefaultAdsEnabled = bronzes1networkdiscoveryDescAdsEnabled.Default.(bool)
+	bronzes1policyFields := schema.BronzeS1Policy{}.Fields()
+	_ = bronzes1policyFields
+	// bronzes1policyDescScopeLevel is the schema descriptor for scope_level field.
+	bronzes1policyDescScopeLevel := bronzes1policyFields[1].Descriptor()
+	// bronzes1policy.ScopeLevelValidator is a validator for the "scope_level" field. It is called by the builders before save.
+	bronzes1policy.ScopeLevelValidator = bronzes1policyDescScopeLevel.Validators[0].(func(string) error)
+	// bronzes1policyDescAntiTamperingOn is the schema descriptor for anti_tampering_on field.
+	bronzes1policyDescAntiTamperingOn := bronzes1policyFields[10].Descriptor()
+	// bronzes1policy.DefaultAntiTamperingOn holds the default value on creation for the anti_tampering_on field.
+	bronzes1policy.DefaultAntiTamperingOn = bronzes1policyDescAntiTamperingOn.Default.(bool)
+	// bronzes1policyDescSnapshotsOn is the schema descriptor for snapshots_on field.
+	bronzes1policyDescSnapshotsOn := bronzes1policyFields[11].Descriptor()
+	// bronzes1policy.DefaultSnapshotsOn holds the default value on creation for the snapshots_on field.
+	bronzes1policy.DefaultSnapshotsOn = bronzes1policyDescSnapshotsOn.Default.(bool)
+	// bronzes1policyDescAgentLoggingOn is the schema descriptor for agent_logging_on field.
+	bronzes1policyDescAgentLoggingOn := bronzes1policyFields[12].Descriptor()
+	// bronzes1policy.DefaultAgentLoggingOn holds the default value on creation for the agent_logging_on field.
+	bronzes1policy.DefaultAgentLoggingOn = bronzes1policyDescAgentLoggingOn.Default.(bool)
+	// bronzes1policyDescScanNewAgents is the schema descriptor for scan_new_agents field.
+	bronzes1policyDescScanNewAgents := bronzes1policyFields[13].Descriptor()
+	// bronzes1policy.DefaultScanNewAgents holds the default value on creation for the scan_new_agents field.
+	bronzes1policy.DefaultScanNewAgents = bronzes1policyDescScanNewAgents.Default.(bool)
+	// bronzes1policyDescNetworkQuarantineOn is the schema descriptor for network_quarantine_on field.
+	bronzes1policyDescNetworkQuarantineOn := bronzes1policyFields[14].Descriptor()
+	// bronzes1policy.DefaultNetworkQuarantineOn holds the default value on creation for the network_quarantine_on field.
+	bronzes1policy.DefaultNetworkQuarantineOn = bronzes1policyDescNetworkQuarantineOn.Default.(bool)
 	bronzes1rangerdeviceFields := schema.BronzeS1RangerDevice{}.Fields()
 	_ = bronzes1rangerdeviceFields
 	// bronzes1rangerdeviceDescHasIdentity is the schema descriptor for has_identity field.
@@ -588,6 +815,20 @@ func init() {
 	bronzes1rangersettingDescCombineDevices := bronzes1rangersettingFields[19].Descriptor()
 	// bronzes1rangersetting.DefaultCombineDevices holds the default value on creation for the combine_devices field.
 	bronzes1rangersetting.DefaultCombineDevices = bronzes1rangersettingDescCombineDevices.Default.(bool)
+	bronzes1restrictionFields := schema.BronzeS1Restriction{}.Fields()
+	_ = bronzes1restrictionFields
+	// bronzes1restrictionDescScopeLevel is the schema descriptor for scope_level field.
+	bronzes1restrictionDescScopeLevel := bronzes1restrictionFields[1].Descriptor()
+	// bronzes1restriction.ScopeLevelValidator is a validator for the "scope_level" field. It is called by the builders before save.
+	bronzes1restriction.ScopeLevelValidator = bronzes1restrictionDescScopeLevel.Validators[0].(func(string) error)
+	// bronzes1restrictionDescRestrictionType is the schema descriptor for restriction_type field.
+	bronzes1restrictionDescRestrictionType := bronzes1restrictionFields[7].Descriptor()
+	// bronzes1restriction.RestrictionTypeValidator is a validator for the "restriction_type" field. It is called by the builders before save.
+	bronzes1restriction.RestrictionTypeValidator = bronzes1restrictionDescRestrictionType.Validators[0].(func(string) error)
+	// bronzes1restrictionDescImported is the schema descriptor for imported field.
+	bronzes1restrictionDescImported := bronzes1restrictionFields[14].Descriptor()
+	// bronzes1restriction.DefaultImported holds the default value on creation for the imported field.
+	bronzes1restriction.DefaultImported = bronzes1restrictionDescImported.Default.(bool)
 	bronzes1siteFields := schema.BronzeS1Site{}.Fields()
 	_ = bronzes1siteFields
 	// bronzes1siteDescName is the schema descriptor for name field.
@@ -622,4 +863,14 @@ func init() {
 	bronzes1siteDescInheritAccountExpiration := bronzes1siteFields[22].Descriptor()
 	// bronzes1site.DefaultInheritAccountExpiration holds the default value on creation for the inherit_account_expiration field.
 	bronzes1site.DefaultInheritAccountExpiration = bronzes1siteDescInheritAccountExpiration.Default.(bool)
+	bronzes1threatFields := schema.BronzeS1Threat{}.Fields()
+	_ = bronzes1threatFields
+	// bronzes1threatDescIsFileless is the schema descriptor for is_fileless field.
+	bronzes1threatDescIsFileless := bronzes1threatFields[23].Descriptor()
+	// bronzes1threat.DefaultIsFileless holds the default value on creation for the is_fileless field.
+	bronzes1threat.DefaultIsFileless = bronzes1threatDescIsFileless.Default.(bool)
+	// bronzes1threatDescMitigatedPreemptively is the schema descriptor for mitigated_preemptively field.
+	bronzes1threatDescMitigatedPreemptively := bronzes1threatFields[24].Descriptor()
+	// bronzes1threat.DefaultMitigatedPreemptively holds the default value on creation for the mitigated_preemptively field.
+	bronzes1threat.DefaultMitigatedPreemptively = bronzes1threatDescMitigatedPreemptively.Default.(bool)
 }
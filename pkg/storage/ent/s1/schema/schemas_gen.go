@@ -10,6 +10,10 @@ type BronzeS1Account struct {
 	bronze_s1.BronzeS1Account
 }
 
+type BronzeS1Activity struct {
+	bronze_s1.BronzeS1Activity
+}
+
 type BronzeS1Agent struct {
 	bronze_s1.BronzeS1Agent
 }
@@ -18,6 +22,14 @@ type BronzeS1AgentNIC struct {
 	bronze_s1.BronzeS1AgentNIC
 }
 
+type BronzeS1Alert struct {
+	bronze_s1.BronzeS1Alert
+}
+
+type BronzeS1AppCVE struct {
+	bronze_s1.BronzeS1AppCVE
+}
+
 type BronzeS1AppInventory struct {
 	bronze_s1.BronzeS1AppInventory
 }
@@ -26,14 +38,26 @@ type BronzeS1EndpointApp struct {
 	bronze_s1.BronzeS1EndpointApp
 }
 
+type BronzeS1Exclusion struct {
+	bronze_s1.BronzeS1Exclusion
+}
+
 type BronzeS1Group struct {
 	bronze_s1.BronzeS1Group
 }
 
+type BronzeS1IngestCursor struct {
+	bronze_s1.BronzeS1IngestCursor
+}
+
 type BronzeS1NetworkDiscovery struct {
 	bronze_s1.BronzeS1NetworkDiscovery
 }
 
+type BronzeS1Policy struct {
+	bronze_s1.BronzeS1Policy
+}
+
 type BronzeS1RangerDevice struct {
 	bronze_s1.BronzeS1RangerDevice
 }
@@ -46,10 +70,18 @@ type BronzeS1RangerSetting struct {
 	bronze_s1.BronzeS1RangerSetting
 }
 
+type BronzeS1Restriction struct {
+	bronze_s1.BronzeS1Restriction
+}
+
 type BronzeS1Site struct {
 	bronze_s1.BronzeS1Site
 }
 
+type BronzeS1Threat struct {
+	bronze_s1.BronzeS1Threat
+}
+
 type BronzeHistoryS1Account struct {
 	bronzehistory_s1.BronzeHistoryS1Account
 }
@@ -62,6 +94,14 @@ type BronzeHistoryS1AgentNIC struct {
 	bronzehistory_s1.BronzeHistoryS1AgentNIC
 }
 
+type BronzeHistoryS1Alert struct {
+	bronzehistory_s1.BronzeHistoryS1Alert
+}
+
+type BronzeHistoryS1AppCVE struct {
+	bronzehistory_s1.BronzeHistoryS1AppCVE
+}
+
 type BronzeHistoryS1AppInventory struct {
 	bronzehistory_s1.BronzeHistoryS1AppInventory
 }
@@ -70,6 +110,10 @@ type BronzeHistoryS1EndpointApp struct {
 	bronzehistory_s1.BronzeHistoryS1EndpointApp
 }
 
+type BronzeHistoryS1Exclusion struct {
+	bronzehistory_s1.BronzeHistoryS1Exclusion
+}
+
 type BronzeHistoryS1Group struct {
 	bronzehistory_s1.BronzeHistoryS1Group
 }
@@ -78,6 +122,10 @@ type BronzeHistoryS1NetworkDiscovery struct {
 	bronzehistory_s1.BronzeHistoryS1NetworkDiscovery
 }
 
+type BronzeHistoryS1Policy struct {
+	bronzehistory_s1.BronzeHistoryS1Policy
+}
+
 type BronzeHistoryS1RangerDevice struct {
 	bronzehistory_s1.BronzeHistoryS1RangerDevice
 }
@@ -90,6 +138,14 @@ type BronzeHistoryS1RangerSetting struct {
 	bronzehistory_s1.BronzeHistoryS1RangerSetting
 }
 
+type BronzeHistoryS1Restriction struct {
+	bronzehistory_s1.BronzeHistoryS1Restriction
+}
+
 type BronzeHistoryS1Site struct {
 	bronzehistory_s1.BronzeHistoryS1Site
 }
+
+type BronzeHistoryS1Threat struct {
+	bronzehistory_s1.BronzeHistoryS1Threat
+}
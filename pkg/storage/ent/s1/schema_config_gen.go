@@ -5,26 +5,40 @@ package s1
 func DefaultSchemaConfig() SchemaConfig {
 	return SchemaConfig{
 		BronzeS1Account:                 "bronze",
+		BronzeS1Activity:                "bronze",
 		BronzeS1Agent:                   "bronze",
 		BronzeS1AgentNIC:                "bronze",
+		BronzeS1Alert:                   "bronze",
+		BronzeS1AppCVE:                  "bronze",
 		BronzeS1AppInventory:            "bronze",
 		BronzeS1EndpointApp:             "bronze",
+		BronzeS1Exclusion:               "bronze",
 		BronzeS1Group:                   "bronze",
+		BronzeS1IngestCursor:            "bronze",
 		BronzeS1NetworkDiscovery:        "bronze",
+		BronzeS1Policy:                  "bronze",
 		BronzeS1RangerDevice:            "bronze",
 		BronzeS1RangerGateway:           "bronze",
 		BronzeS1RangerSetting:           "bronze",
+		BronzeS1Restriction:             "bronze",
 		BronzeS1Site:                    "bronze",
+		BronzeS1Threat:                  "bronze",
 		BronzeHistoryS1Account:          "bronze_history",
 		BronzeHistoryS1Agent:            "bronze_history",
 		BronzeHistoryS1AgentNIC:         "bronze_history",
+		BronzeHistoryS1Alert:            "bronze_history",
+		BronzeHistoryS1AppCVE:           "bronze_history",
 		BronzeHistoryS1AppInventory:     "bronze_history",
 		BronzeHistoryS1EndpointApp:      "bronze_history",
+		BronzeHistoryS1Exclusion:        "bronze_history",
 		BronzeHistoryS1Group:            "bronze_history",
 		BronzeHistoryS1NetworkDiscovery: "bronze_history",
+		BronzeHistoryS1Policy:           "bronze_history",
 		BronzeHistoryS1RangerDevice:     "bronze_history",
 		BronzeHistoryS1RangerGateway:    "bronze_history",
 		BronzeHistoryS1RangerSetting:    "bronze_history",
+		BronzeHistoryS1Restriction:      "bronze_history",
 		BronzeHistoryS1Site:             "bronze_history",
+		BronzeHistoryS1Threat:           "bronze_history",
 	}
 }
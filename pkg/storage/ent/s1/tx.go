@@ -18,44 +18,72 @@ type Tx struct {
 	BronzeHistoryS1Agent *BronzeHistoryS1AgentClient
 	// BronzeHistoryS1AgentNIC is the client for interacting with the BronzeHistoryS1AgentNIC builders.
 	BronzeHistoryS1AgentNIC *BronzeHistoryS1AgentNICClient
+	// BronzeHistoryS1Alert is the client for interacting with the BronzeHistoryS1Alert builders.
+	BronzeHistoryS1Alert *BronzeHistoryS1AlertClient
+	// BronzeHistoryS1AppCVE is the client for interacting with the BronzeHistoryS1AppCVE builders.
+	BronzeHistoryS1AppCVE *BronzeHistoryS1AppCVEClient
 	// BronzeHistoryS1AppInventory is the client for interacting with the BronzeHistoryS1AppInventory builders.
 	BronzeHistoryS1AppInventory *BronzeHistoryS1AppInventoryClient
 	// BronzeHistoryS1EndpointApp is the client for interacting with the BronzeHistoryS1EndpointApp builders.
 	BronzeHistoryS1EndpointApp *BronzeHistoryS1EndpointAppClient
+	// BronzeHistoryS1Exclusion is the client for interacting with the BronzeHistoryS1Exclusion builders.
+	BronzeHistoryS1Exclusion *BronzeHistoryS1ExclusionClient
 	// BronzeHistoryS1Group is the client for interacting with the BronzeHistoryS1Group builders.
 	BronzeHistoryS1Group *BronzeHistoryS1GroupClient
 	// BronzeHistoryS1NetworkDiscovery is the client for interacting with the BronzeHistoryS1NetworkDiscovery builders.
 	BronzeHistoryS1NetworkDiscovery *BronzeHistoryS1NetworkDiscoveryClient
+	// BronzeHistoryS1Policy is the client for interacting with the BronzeHistoryS1Policy builders.
+	BronzeHistoryS1Policy *BronzeHistoryS1PolicyClient
 	// BronzeHistoryS1RangerDevice is the client for interacting with the BronzeHistoryS1RangerDevice builders.
 	BronzeHistoryS1RangerDevice *BronzeHistoryS1RangerDeviceClient
 	// BronzeHistoryS1RangerGateway is the client for interacting with the BronzeHistoryS1RangerGateway builders.
 	BronzeHistoryS1RangerGateway *BronzeHistoryS1RangerGatewayClient
 	// BronzeHistoryS1RangerSetting is the client for interacting with the BronzeHistoryS1RangerSetting builders.
 	BronzeHistoryS1RangerSetting *BronzeHistoryS1RangerSettingClient
+	// BronzeHistoryS1Restriction is the client for interacting with the BronzeHistoryS1Restriction builders.
+	BronzeHistoryS1Restriction *BronzeHistoryS1RestrictionClient
 	// BronzeHistoryS1Site is the client for interacting with the BronzeHistoryS1Site builders.
 	BronzeHistoryS1Site *BronzeHistoryS1SiteClient
+	// BronzeHistoryS1Threat is the client for interacting with the BronzeHistoryS1Threat builders.
+	BronzeHistoryS1Threat *BronzeHistoryS1ThreatClient
 	// BronzeS1Account is the client for interacting with the BronzeS1Account builders.
 	BronzeS1Account *BronzeS1AccountClient
+	// BronzeS1Activity is the client for interacting with the BronzeS1Activity builders.
+	BronzeS1Activity *BronzeS1ActivityClient
 	// BronzeS1Agent is the client for interacting with the BronzeS1Agent builders.
 	BronzeS1Agent *BronzeS1AgentClient
 	// BronzeS1AgentNIC is the client for interacting with the BronzeS1AgentNIC builders.
 	BronzeS1AgentNIC *BronzeS1AgentNICClient
+	// BronzeS1Alert is the client for interacting with the BronzeS1Alert builders.
+	BronzeS1Alert *BronzeS1AlertClient
+	// BronzeS1AppCVE is the client for interacting with the BronzeS1AppCVE builders.
+	BronzeS1AppCVE *BronzeS1AppCVEClient
 	// BronzeS1AppInventory is the client for interacting with the BronzeS1AppInventory builders.
 	BronzeS1AppInventory *BronzeS1AppInventoryClient
 	// BronzeS1EndpointApp is the client for interacting with the BronzeS1EndpointApp builders.
 	BronzeS1EndpointApp *BronzeS1EndpointAppClient
+	// BronzeS1Exclusion is the client for interacting with the BronzeS1Exclusion builders.
+	BronzeS1Exclusion *BronzeS1ExclusionClient
 	// BronzeS1Group is the client for interacting with the BronzeS1Group builders.
 	BronzeS1Group *BronzeS1GroupClient
+	// BronzeS1IngestCursor is the client for interacting with the BronzeS1IngestCursor builders.
+	BronzeS1IngestCursor *BronzeS1IngestCursorClient
 	// BronzeS1NetworkDiscovery is the client for interacting with the BronzeS1NetworkDiscovery builders.
 	BronzeS1NetworkDiscovery *BronzeS1NetworkDiscoveryClient
+	// BronzeS1Policy is the client for interacting with the BronzeS1Policy builders.
+	BronzeS1Policy *BronzeS1PolicyClient
 	// BronzeS1RangerDevice is the client for interacting with the BronzeS1RangerDevice builders.
 	BronzeS1RangerDevice *BronzeS1RangerDeviceClient
 	// BronzeS1RangerGateway is the client for interacting with the BronzeS1RangerGateway builders.
 	BronzeS1RangerGateway *BronzeS1RangerGatewayClient
 	// BronzeS1RangerSetting is the client for interacting with the BronzeS1RangerSetting builders.
 	BronzeS1RangerSetting *BronzeS1RangerSettingClient
+	// BronzeS1Restriction is the client for interacting with the BronzeS1Restriction builders.
+	BronzeS1Restriction *BronzeS1RestrictionClient
 	// BronzeS1Site is the client for interacting with the BronzeS1Site builders.
 	BronzeS1Site *BronzeS1SiteClient
+	// BronzeS1Threat is the client for interacting with the BronzeS1Threat builders.
+	BronzeS1Threat *BronzeS1ThreatClient
 
 	// lazily loaded.
 	client     *Client
@@ -190,25 +218,39 @@ func (tx *Tx) init() {
 	tx.BronzeHistoryS1Account = NewBronzeHistoryS1AccountClient(tx.config)
 	tx.BronzeHistoryS1Agent = NewBronzeHistoryS1AgentClient(tx.config)
 	tx.BronzeHistoryS1AgentNIC = NewBronzeHistoryS1AgentNICClient(tx.config)
+	tx.BronzeHistoryS1Alert = NewBronzeHistoryS1AlertClient(tx.config)
+	tx.BronzeHistoryS1AppCVE = NewBronzeHistoryS1AppCVEClient(tx.config)
 	tx.BronzeHistoryS1AppInventory = NewBronzeHistoryS1AppInventoryClient(tx.config)
 	tx.BronzeHistoryS1EndpointApp = NewBronzeHistoryS1EndpointAppClient(tx.config)
+	tx.BronzeHistoryS1Exclusion = NewBronzeHistoryS1ExclusionClient(tx.config)
 	tx.BronzeHistoryS1Group = NewBronzeHistoryS1GroupClient(tx.config)
 	tx.BronzeHistoryS1NetworkDiscovery = NewBronzeHistoryS1NetworkDiscoveryClient(tx.config)
+	tx.BronzeHistoryS1Policy = NewBronzeHistoryS1PolicyClient(tx.config)
 	tx.BronzeHistoryS1RangerDevice = NewBronzeHistoryS1RangerDeviceClient(tx.config)
 	tx.BronzeHistoryS1RangerGateway = NewBronzeHistoryS1RangerGatewayClient(tx.config)
 	tx.BronzeHistoryS1RangerSetting = NewBronzeHistoryS1RangerSettingClient(tx.config)
+	tx.BronzeHistoryS1Restriction = NewBronzeHistoryS1RestrictionClient(tx.config)
 	tx.BronzeHistoryS1Site = NewBronzeHistoryS1SiteClient(tx.config)
+	tx.BronzeHistoryS1Threat = NewBronzeHistoryS1ThreatClient(tx.config)
 	tx.BronzeS1Account = NewBronzeS1AccountClient(tx.config)
+	tx.BronzeS1Activity = NewBronzeS1ActivityClient(tx.config)
 	tx.BronzeS1Agent = NewBronzeS1AgentClient(tx.config)
 	tx.BronzeS1AgentNIC = NewBronzeS1AgentNICClient(tx.config)
+	tx.BronzeS1Alert = NewBronzeS1AlertClient(tx.config)
+	tx.BronzeS1AppCVE = NewBronzeS1AppCVEClient(tx.config)
 	tx.BronzeS1AppInventory = NewBronzeS1AppInventoryClient(tx.config)
 	tx.BronzeS1EndpointApp = NewBronzeS1EndpointAppClient(tx.config)
+	tx.BronzeS1Exclusion = NewBronzeS1ExclusionClient(tx.config)
 	tx.BronzeS1Group = NewBronzeS1GroupClient(tx.config)
+	tx.BronzeS1IngestCursor = NewBronzeS1IngestCursorClient(tx.config)
 	tx.BronzeS1NetworkDiscovery = NewBronzeS1NetworkDiscoveryClient(tx.config)
+	tx.BronzeS1Policy = NewBronzeS1PolicyClient(tx.config)
 	tx.BronzeS1RangerDevice = NewBronzeS1RangerDeviceClient(tx.config)
 	tx.BronzeS1RangerGateway = NewBronzeS1RangerGatewayClient(tx.config)
 	tx.BronzeS1RangerSetting = NewBronzeS1RangerSettingClient(tx.config)
+	tx.BronzeS1Restriction = NewBronzeS1RestrictionClient(tx.config)
 	tx.BronzeS1Site = NewBronzeS1SiteClient(tx.config)
+	tx.BronzeS1Threat = NewBronzeS1ThreatClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
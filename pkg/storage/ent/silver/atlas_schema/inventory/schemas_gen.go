@@ -3,6 +3,7 @@ package schema
 
 import (
 	silver_inventory_apiendpoint "danny.vn/hotpot/pkg/schema/silver/inventory/apiendpoint"
+	silver_inventory_image "danny.vn/hotpot/pkg/schema/silver/inventory/image"
 	silver_inventory_k8snode "danny.vn/hotpot/pkg/schema/silver/inventory/k8snode"
 	silver_inventory_machine "danny.vn/hotpot/pkg/schema/silver/inventory/machine"
 	silver_inventory_software "danny.vn/hotpot/pkg/schema/silver/inventory/software"
@@ -42,6 +43,54 @@ func (InventoryApiEndpointBronzeLink) Annotations() []schema.Annotation {
 	return append(anns, entsql.Annotation{Schema: "silver"})
 }
 
+type InventoryImage struct {
+	silver_inventory_image.InventoryImage
+}
+
+func (InventoryImage) Annotations() []schema.Annotation {
+	anns := silver_inventory_image.InventoryImage{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "silver"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "silver"})
+}
+
+type InventoryImageBronzeLink struct {
+	silver_inventory_image.InventoryImageBronzeLink
+}
+
+func (InventoryImageBronzeLink) Annotations() []schema.Annotation {
+	anns := silver_inventory_image.InventoryImageBronzeLink{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "silver"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "silver"})
+}
+
+type InventoryImageNormalized struct {
+	silver_inventory_image.InventoryImageNormalized
+}
+
+func (InventoryImageNormalized) Annotations() []schema.Annotation {
+	anns := silver_inventory_image.InventoryImageNormalized{}.Annotations()
+	for i, a := range anns {
+		if v, ok := a.(entsql.Annotation); ok {
+			v.Schema = "silver"
+			anns[i] = v
+			return anns
+		}
+	}
+	return append(anns, entsql.Annotation{Schema: "silver"})
+}
+
 type InventoryK8sNode struct {
 	silver_inventory_k8snode.InventoryK8sNode
 }
@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package triage
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/triage/goldtriageaudit"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// GoldTriageAudit is the model entity for the GoldTriageAudit schema.
+type GoldTriageAudit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Gold table of the finding, e.g. httpmonitor_anomalies
+	FindingTable string `json:"finding_table,omitempty"`
+	// resource_id of the finding
+	FindingID string `json:"finding_id,omitempty"`
+	// acknowledge, suppress, false_positive, reopen, update
+	Action string `json:"action,omitempty"`
+	// Authenticated admin identity
+	Actor string `json:"actor,omitempty"`
+	// FromStatus holds the value of the "from_status" field.
+	FromStatus string `json:"from_status,omitempty"`
+	// ToStatus holds the value of the "to_status" field.
+	ToStatus string `json:"to_status,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Changed fields as {field: {from, to}}
+	ChangesJSON json.RawMessage `json:"changes_json,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GoldTriageAudit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case goldtriageaudit.FieldChangesJSON:
+			values[i] = new([]byte)
+		case goldtriageaudit.FieldID:
+			values[i] = new(sql.NullInt64)
+		case goldtriageaudit.FieldFindingTable, goldtriageaudit.FieldFindingID, goldtriageaudit.FieldAction, goldtriageaudit.FieldActor, goldtriageaudit.FieldFromStatus, goldtriageaudit.FieldToStatus, goldtriageaudit.FieldReason:
+			values[i] = new(sql.NullString)
+		case goldtriageaudit.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GoldTriageAudit fields.
+func (_m *GoldTriageAudit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case goldtriageaudit.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case goldtriageaudit.FieldFindingTable:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field finding_table", values[i])
+			} else if value.Valid {
+				_m.FindingTable = value.String
+			}
+		case goldtriageaudit.FieldFindingID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field finding_id", values[i])
+			} else if value.Valid {
+				_m.FindingID = value.String
+			}
+		case goldtriageaudit.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = value.String
+			}
+		case goldtriageaudit.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				_m.Actor = value.String
+			}
+		case goldtriageaudit.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				_m.FromStatus = value.String
+			}
+		case goldtriageaudit.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				_m.ToStatus = value.String
+			}
+		case goldtriageaudit.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case goldtriageaudit.FieldChangesJSON:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes_json", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ChangesJSON); err != nil {
+					return fmt.Errorf("unmarshal field changes_json: %w", err)
+				}
+			}
+		case goldtriageaudit.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GoldTriageAudit.
+// This includes values selected through modifiers, order, etc.
+func (_m *GoldTriageAudit) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GoldTriageAudit.
+// Note that you need to call GoldTriageAudit.Unwrap() before calling this method if this GoldTriageAudit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GoldTriageAudit) Update() *GoldTriageAuditUpdateOne {
+	return NewGoldTriageAuditClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GoldTriageAudit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GoldTriageAudit) Unwrap() *GoldTriageAudit {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("triage: GoldTriageAudit is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GoldTriageAudit) String() string {
+	var builder strings.Builder
+	builder.WriteString("GoldTriageAudit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("finding_table=")
+	builder.WriteString(_m.FindingTable)
+	builder.WriteString(", ")
+	builder.WriteString("finding_id=")
+	builder.WriteString(_m.FindingID)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(_m.Action)
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(_m.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("from_status=")
+	builder.WriteString(_m.FromStatus)
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(_m.ToStatus)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("changes_json=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChangesJSON))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GoldTriageAudits is a parsable slice of GoldTriageAudit.
+type GoldTriageAudits []*GoldTriageAudit
@@ -0,0 +1,645 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goldtriageaudit
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/triage/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldLTE(FieldID, id))
+}
+
+// FindingTable applies equality check predicate on the "finding_table" field. It's identical to FindingTableEQ.
+func FindingTable(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEQ(FieldFindingTable, v))
+}
+
+// FindingID applies equality check predicate on the "finding_id" field. It's identical to FindingIDEQ.
+func FindingID(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEQ(FieldFindingID, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEQ(FieldAction, v))
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEQ(FieldActor, v))
+}
+
+// FromStatus applies equality check predicate on the "from_status" field. It's identical to FromStatusEQ.
+func FromStatus(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEQ(FieldFromStatus, v))
+}
+
+// ToStatus applies equality check predicate on the "to_status" field. It's identical to ToStatusEQ.
+func ToStatus(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEQ(FieldToStatus, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEQ(FieldReason, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FindingTableEQ applies the EQ predicate on the "finding_table" field.
+func FindingTableEQ(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEQ(FieldFindingTable, v))
+}
+
+// FindingTableNEQ applies the NEQ predicate on the "finding_table" field.
+func FindingTableNEQ(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNEQ(FieldFindingTable, v))
+}
+
+// FindingTableIn applies the In predicate on the "finding_table" field.
+func FindingTableIn(vs ...string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldIn(FieldFindingTable, vs...))
+}
+
+// FindingTableNotIn applies the NotIn predicate on the "finding_table" field.
+func FindingTableNotIn(vs ...string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNotIn(FieldFindingTable, vs...))
+}
+
+// FindingTableGT applies the GT predicate on the "finding_table" field.
+func FindingTableGT(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldGT(FieldFindingTable, v))
+}
+
+// FindingTableGTE applies the GTE predicate on the "finding_table" field.
+func FindingTableGTE(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldGTE(FieldFindingTable, v))
+}
+
+// FindingTableLT applies the LT predicate on the "finding_table" field.
+func FindingTableLT(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldLT(FieldFindingTable, v))
+}
+
+// FindingTableLTE applies the LTE predicate on the "finding_table" field.
+func FindingTableLTE(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldLTE(FieldFindingTable, v))
+}
+
+// FindingTableContains applies the Contains predicate on the "finding_table" field.
+func FindingTableContains(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldContains(FieldFindingTable, v))
+}
+
+// FindingTableHasPrefix applies the HasPrefix predicate on the "finding_table" field.
+func FindingTableHasPrefix(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldHasPrefix(FieldFindingTable, v))
+}
+
+// FindingTableHasSuffix applies the HasSuffix predicate on the "finding_table" field.
+func FindingTableHasSuffix(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldHasSuffix(FieldFindingTable, v))
+}
+
+// FindingTableEqualFold applies the EqualFold predicate on the "finding_table" field.
+func FindingTableEqualFold(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEqualFold(FieldFindingTable, v))
+}
+
+// FindingTableContainsFold applies the ContainsFold predicate on the "finding_table" field.
+func FindingTableContainsFold(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldContainsFold(FieldFindingTable, v))
+}
+
+// FindingIDEQ applies the EQ predicate on the "finding_id" field.
+func FindingIDEQ(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEQ(FieldFindingID, v))
+}
+
+// FindingIDNEQ applies the NEQ predicate on the "finding_id" field.
+func FindingIDNEQ(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNEQ(FieldFindingID, v))
+}
+
+// FindingIDIn applies the In predicate on the "finding_id" field.
+func FindingIDIn(vs ...string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldIn(FieldFindingID, vs...))
+}
+
+// FindingIDNotIn applies the NotIn predicate on the "finding_id" field.
+func FindingIDNotIn(vs ...string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNotIn(FieldFindingID, vs...))
+}
+
+// FindingIDGT applies the GT predicate on the "finding_id" field.
+func FindingIDGT(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldGT(FieldFindingID, v))
+}
+
+// FindingIDGTE applies the GTE predicate on the "finding_id" field.
+func FindingIDGTE(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldGTE(FieldFindingID, v))
+}
+
+// FindingIDLT applies the LT predicate on the "finding_id" field.
+func FindingIDLT(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldLT(FieldFindingID, v))
+}
+
+// FindingIDLTE applies the LTE predicate on the "finding_id" field.
+func FindingIDLTE(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldLTE(FieldFindingID, v))
+}
+
+// FindingIDContains applies the Contains predicate on the "finding_id" field.
+func FindingIDContains(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldContains(FieldFindingID, v))
+}
+
+// FindingIDHasPrefix applies the HasPrefix predicate on the "finding_id" field.
+func FindingIDHasPrefix(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldHasPrefix(FieldFindingID, v))
+}
+
+// FindingIDHasSuffix applies the HasSuffix predicate on the "finding_id" field.
+func FindingIDHasSuffix(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldHasSuffix(FieldFindingID, v))
+}
+
+// FindingIDEqualFold applies the EqualFold predicate on the "finding_id" field.
+func FindingIDEqualFold(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEqualFold(FieldFindingID, v))
+}
+
+// FindingIDContainsFold applies the ContainsFold predicate on the "finding_id" field.
+func FindingIDContainsFold(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldContainsFold(FieldFindingID, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldContainsFold(FieldAction, v))
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEQ(FieldActor, v))
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNEQ(FieldActor, v))
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldIn(FieldActor, vs...))
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNotIn(FieldActor, vs...))
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldGT(FieldActor, v))
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldGTE(FieldActor, v))
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldLT(FieldActor, v))
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldLTE(FieldActor, v))
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldContains(FieldActor, v))
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldHasPrefix(FieldActor, v))
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldHasSuffix(FieldActor, v))
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEqualFold(FieldActor, v))
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldContainsFold(FieldActor, v))
+}
+
+// FromStatusEQ applies the EQ predicate on the "from_status" field.
+func FromStatusEQ(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEQ(FieldFromStatus, v))
+}
+
+// FromStatusNEQ applies the NEQ predicate on the "from_status" field.
+func FromStatusNEQ(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNEQ(FieldFromStatus, v))
+}
+
+// FromStatusIn applies the In predicate on the "from_status" field.
+func FromStatusIn(vs ...string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldIn(FieldFromStatus, vs...))
+}
+
+// FromStatusNotIn applies the NotIn predicate on the "from_status" field.
+func FromStatusNotIn(vs ...string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNotIn(FieldFromStatus, vs...))
+}
+
+// FromStatusGT applies the GT predicate on the "from_status" field.
+func FromStatusGT(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldGT(FieldFromStatus, v))
+}
+
+// FromStatusGTE applies the GTE predicate on the "from_status" field.
+func FromStatusGTE(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldGTE(FieldFromStatus, v))
+}
+
+// FromStatusLT applies the LT predicate on the "from_status" field.
+func FromStatusLT(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldLT(FieldFromStatus, v))
+}
+
+// FromStatusLTE applies the LTE predicate on the "from_status" field.
+func FromStatusLTE(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldLTE(FieldFromStatus, v))
+}
+
+// FromStatusContains applies the Contains predicate on the "from_status" field.
+func FromStatusContains(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldContains(FieldFromStatus, v))
+}
+
+// FromStatusHasPrefix applies the HasPrefix predicate on the "from_status" field.
+func FromStatusHasPrefix(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldHasPrefix(FieldFromStatus, v))
+}
+
+// FromStatusHasSuffix applies the HasSuffix predicate on the "from_status" field.
+func FromStatusHasSuffix(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldHasSuffix(FieldFromStatus, v))
+}
+
+// FromStatusIsNil applies the IsNil predicate on the "from_status" field.
+func FromStatusIsNil() predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldIsNull(FieldFromStatus))
+}
+
+// FromStatusNotNil applies the NotNil predicate on the "from_status" field.
+func FromStatusNotNil() predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNotNull(FieldFromStatus))
+}
+
+// FromStatusEqualFold applies the EqualFold predicate on the "from_status" field.
+func FromStatusEqualFold(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEqualFold(FieldFromStatus, v))
+}
+
+// FromStatusContainsFold applies the ContainsFold predicate on the "from_status" field.
+func FromStatusContainsFold(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldContainsFold(FieldFromStatus, v))
+}
+
+// ToStatusEQ applies the EQ predicate on the "to_status" field.
+func ToStatusEQ(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEQ(FieldToStatus, v))
+}
+
+// ToStatusNEQ applies the NEQ predicate on the "to_status" field.
+func ToStatusNEQ(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNEQ(FieldToStatus, v))
+}
+
+// ToStatusIn applies the In predicate on the "to_status" field.
+func ToStatusIn(vs ...string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldIn(FieldToStatus, vs...))
+}
+
+// ToStatusNotIn applies the NotIn predicate on the "to_status" field.
+func ToStatusNotIn(vs ...string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNotIn(FieldToStatus, vs...))
+}
+
+// ToStatusGT applies the GT predicate on the "to_status" field.
+func ToStatusGT(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldGT(FieldToStatus, v))
+}
+
+// ToStatusGTE applies the GTE predicate on the "to_status" field.
+func ToStatusGTE(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldGTE(FieldToStatus, v))
+}
+
+// ToStatusLT applies the LT predicate on the "to_status" field.
+func ToStatusLT(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldLT(FieldToStatus, v))
+}
+
+// ToStatusLTE applies the LTE predicate on the "to_status" field.
+func ToStatusLTE(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldLTE(FieldToStatus, v))
+}
+
+// ToStatusContains applies the Contains predicate on the "to_status" field.
+func ToStatusContains(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldContains(FieldToStatus, v))
+}
+
+// ToStatusHasPrefix applies the HasPrefix predicate on the "to_status" field.
+func ToStatusHasPrefix(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldHasPrefix(FieldToStatus, v))
+}
+
+// ToStatusHasSuffix applies the HasSuffix predicate on the "to_status" field.
+func ToStatusHasSuffix(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldHasSuffix(FieldToStatus, v))
+}
+
+// ToStatusIsNil applies the IsNil predicate on the "to_status" field.
+func ToStatusIsNil() predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldIsNull(FieldToStatus))
+}
+
+// ToStatusNotNil applies the NotNil predicate on the "to_status" field.
+func ToStatusNotNil() predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNotNull(FieldToStatus))
+}
+
+// ToStatusEqualFold applies the EqualFold predicate on the "to_status" field.
+func ToStatusEqualFold(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEqualFold(FieldToStatus, v))
+}
+
+// ToStatusContainsFold applies the ContainsFold predicate on the "to_status" field.
+func ToStatusContainsFold(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldContainsFold(FieldToStatus, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldContainsFold(FieldReason, v))
+}
+
+// ChangesJSONIsNil applies the IsNil predicate on the "changes_json" field.
+func ChangesJSONIsNil() predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldIsNull(FieldChangesJSON))
+}
+
+// ChangesJSONNotNil applies the NotNil predicate on the "changes_json" field.
+func ChangesJSONNotNil() predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNotNull(FieldChangesJSON))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GoldTriageAudit) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GoldTriageAudit) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GoldTriageAudit) predicate.GoldTriageAudit {
+	return predicate.GoldTriageAudit(sql.NotPredicates(p))
+}
@@ -0,0 +1,334 @@
+// Code generated by ent, DO NOT EDIT.
+
+package triage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/triage/goldtriageaudit"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GoldTriageAuditCreate is the builder for creating a GoldTriageAudit entity.
+type GoldTriageAuditCreate struct {
+	config
+	mutation *GoldTriageAuditMutation
+	hooks    []Hook
+}
+
+// SetFindingTable sets the "finding_table" field.
+func (_c *GoldTriageAuditCreate) SetFindingTable(v string) *GoldTriageAuditCreate {
+	_c.mutation.SetFindingTable(v)
+	return _c
+}
+
+// SetFindingID sets the "finding_id" field.
+func (_c *GoldTriageAuditCreate) SetFindingID(v string) *GoldTriageAuditCreate {
+	_c.mutation.SetFindingID(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *GoldTriageAuditCreate) SetAction(v string) *GoldTriageAuditCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetActor sets the "actor" field.
+func (_c *GoldTriageAuditCreate) SetActor(v string) *GoldTriageAuditCreate {
+	_c.mutation.SetActor(v)
+	return _c
+}
+
+// SetFromStatus sets the "from_status" field.
+func (_c *GoldTriageAuditCreate) SetFromStatus(v string) *GoldTriageAuditCreate {
+	_c.mutation.SetFromStatus(v)
+	return _c
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (_c *GoldTriageAuditCreate) SetNillableFromStatus(v *string) *GoldTriageAuditCreate {
+	if v != nil {
+		_c.SetFromStatus(*v)
+	}
+	return _c
+}
+
+// SetToStatus sets the "to_status" field.
+func (_c *GoldTriageAuditCreate) SetToStatus(v string) *GoldTriageAuditCreate {
+	_c.mutation.SetToStatus(v)
+	return _c
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (_c *GoldTriageAuditCreate) SetNillableToStatus(v *string) *GoldTriageAuditCreate {
+	if v != nil {
+		_c.SetToStatus(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *GoldTriageAuditCreate) SetReason(v string) *GoldTriageAuditCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *GoldTriageAuditCreate) SetNillableReason(v *string) *GoldTriageAuditCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetChangesJSON sets the "changes_json" field.
+func (_c *GoldTriageAuditCreate) SetChangesJSON(v json.RawMessage) *GoldTriageAuditCreate {
+	_c.mutation.SetChangesJSON(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GoldTriageAuditCreate) SetCreatedAt(v time.Time) *GoldTriageAuditCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GoldTriageAuditCreate) SetID(v int) *GoldTriageAuditCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the GoldTriageAuditMutation object of the builder.
+func (_c *GoldTriageAuditCreate) Mutation() *GoldTriageAuditMutation {
+	return _c.mutation
+}
+
+// Save creates the GoldTriageAudit in the database.
+func (_c *GoldTriageAuditCreate) Save(ctx context.Context) (*GoldTriageAudit, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GoldTriageAuditCreate) SaveX(ctx context.Context) *GoldTriageAudit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoldTriageAuditCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoldTriageAuditCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GoldTriageAuditCreate) check() error {
+	if _, ok := _c.mutation.FindingTable(); !ok {
+		return &ValidationError{Name: "finding_table", err: errors.New(`triage: missing required field "GoldTriageAudit.finding_table"`)}
+	}
+	if v, ok := _c.mutation.FindingTable(); ok {
+		if err := goldtriageaudit.FindingTableValidator(v); err != nil {
+			return &ValidationError{Name: "finding_table", err: fmt.Errorf(`triage: validator failed for field "GoldTriageAudit.finding_table": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FindingID(); !ok {
+		return &ValidationError{Name: "finding_id", err: errors.New(`triage: missing required field "GoldTriageAudit.finding_id"`)}
+	}
+	if v, ok := _c.mutation.FindingID(); ok {
+		if err := goldtriageaudit.FindingIDValidator(v); err != nil {
+			return &ValidationError{Name: "finding_id", err: fmt.Errorf(`triage: validator failed for field "GoldTriageAudit.finding_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`triage: missing required field "GoldTriageAudit.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := goldtriageaudit.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`triage: validator failed for field "GoldTriageAudit.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`triage: missing required field "GoldTriageAudit.actor"`)}
+	}
+	if v, ok := _c.mutation.Actor(); ok {
+		if err := goldtriageaudit.ActorValidator(v); err != nil {
+			return &ValidationError{Name: "actor", err: fmt.Errorf(`triage: validator failed for field "GoldTriageAudit.actor": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`triage: missing required field "GoldTriageAudit.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *GoldTriageAuditCreate) sqlSave(ctx context.Context) (*GoldTriageAudit, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GoldTriageAuditCreate) createSpec() (*GoldTriageAudit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GoldTriageAudit{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(goldtriageaudit.Table, sqlgraph.NewFieldSpec(goldtriageaudit.FieldID, field.TypeInt))
+	)
+	_spec.Schema = _c.schemaConfig.GoldTriageAudit
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.FindingTable(); ok {
+		_spec.SetField(goldtriageaudit.FieldFindingTable, field.TypeString, value)
+		_node.FindingTable = value
+	}
+	if value, ok := _c.mutation.FindingID(); ok {
+		_spec.SetField(goldtriageaudit.FieldFindingID, field.TypeString, value)
+		_node.FindingID = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(goldtriageaudit.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Actor(); ok {
+		_spec.SetField(goldtriageaudit.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := _c.mutation.FromStatus(); ok {
+		_spec.SetField(goldtriageaudit.FieldFromStatus, field.TypeString, value)
+		_node.FromStatus = value
+	}
+	if value, ok := _c.mutation.ToStatus(); ok {
+		_spec.SetField(goldtriageaudit.FieldToStatus, field.TypeString, value)
+		_node.ToStatus = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(goldtriageaudit.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.ChangesJSON(); ok {
+		_spec.SetField(goldtriageaudit.FieldChangesJSON, field.TypeJSON, value)
+		_node.ChangesJSON = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(goldtriageaudit.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// GoldTriageAuditCreateBulk is the builder for creating many GoldTriageAudit entities in bulk.
+type GoldTriageAuditCreateBulk struct {
+	config
+	err      error
+	builders []*GoldTriageAuditCreate
+}
+
+// Save creates the GoldTriageAudit entities in the database.
+func (_c *GoldTriageAuditCreateBulk) Save(ctx context.Context) ([]*GoldTriageAudit, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GoldTriageAudit, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GoldTriageAuditMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GoldTriageAuditCreateBulk) SaveX(ctx context.Context) []*GoldTriageAudit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoldTriageAuditCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoldTriageAuditCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
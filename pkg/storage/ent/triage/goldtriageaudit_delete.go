@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package triage
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/triage/goldtriageaudit"
+	"danny.vn/hotpot/pkg/storage/ent/triage/internal"
+	"danny.vn/hotpot/pkg/storage/ent/triage/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GoldTriageAuditDelete is the builder for deleting a GoldTriageAudit entity.
+type GoldTriageAuditDelete struct {
+	config
+	hooks    []Hook
+	mutation *GoldTriageAuditMutation
+}
+
+// Where appends a list predicates to the GoldTriageAuditDelete builder.
+func (_d *GoldTriageAuditDelete) Where(ps ...predicate.GoldTriageAudit) *GoldTriageAuditDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *GoldTriageAuditDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GoldTriageAuditDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *GoldTriageAuditDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(goldtriageaudit.Table, sqlgraph.NewFieldSpec(goldtriageaudit.FieldID, field.TypeInt))
+	_spec.Node.Schema = _d.schemaConfig.GoldTriageAudit
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// GoldTriageAuditDeleteOne is the builder for deleting a single GoldTriageAudit entity.
+type GoldTriageAuditDeleteOne struct {
+	_d *GoldTriageAuditDelete
+}
+
+// Where appends a list predicates to the GoldTriageAuditDelete builder.
+func (_d *GoldTriageAuditDeleteOne) Where(ps ...predicate.GoldTriageAudit) *GoldTriageAuditDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *GoldTriageAuditDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{goldtriageaudit.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GoldTriageAuditDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
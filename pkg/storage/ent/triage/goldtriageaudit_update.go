@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package triage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"danny.vn/hotpot/pkg/storage/ent/triage/goldtriageaudit"
+	"danny.vn/hotpot/pkg/storage/ent/triage/internal"
+	"danny.vn/hotpot/pkg/storage/ent/triage/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GoldTriageAuditUpdate is the builder for updating GoldTriageAudit entities.
+type GoldTriageAuditUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GoldTriageAuditMutation
+}
+
+// Where appends a list predicates to the GoldTriageAuditUpdate builder.
+func (_u *GoldTriageAuditUpdate) Where(ps ...predicate.GoldTriageAudit) *GoldTriageAuditUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the GoldTriageAuditMutation object of the builder.
+func (_u *GoldTriageAuditUpdate) Mutation() *GoldTriageAuditMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GoldTriageAuditUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoldTriageAuditUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GoldTriageAuditUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoldTriageAuditUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *GoldTriageAuditUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(goldtriageaudit.Table, goldtriageaudit.Columns, sqlgraph.NewFieldSpec(goldtriageaudit.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.FromStatusCleared() {
+		_spec.ClearField(goldtriageaudit.FieldFromStatus, field.TypeString)
+	}
+	if _u.mutation.ToStatusCleared() {
+		_spec.ClearField(goldtriageaudit.FieldToStatus, field.TypeString)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(goldtriageaudit.FieldReason, field.TypeString)
+	}
+	if _u.mutation.ChangesJSONCleared() {
+		_spec.ClearField(goldtriageaudit.FieldChangesJSON, field.TypeJSON)
+	}
+	_spec.Node.Schema = _u.schemaConfig.GoldTriageAudit
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goldtriageaudit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GoldTriageAuditUpdateOne is the builder for updating a single GoldTriageAudit entity.
+type GoldTriageAuditUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GoldTriageAuditMutation
+}
+
+// Mutation returns the GoldTriageAuditMutation object of the builder.
+func (_u *GoldTriageAuditUpdateOne) Mutation() *GoldTriageAuditMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the GoldTriageAuditUpdate builder.
+func (_u *GoldTriageAuditUpdateOne) Where(ps ...predicate.GoldTriageAudit) *GoldTriageAuditUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GoldTriageAuditUpdateOne) Select(field string, fields ...string) *GoldTriageAuditUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GoldTriageAudit entity.
+func (_u *GoldTriageAuditUpdateOne) Save(ctx context.Context) (*GoldTriageAudit, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoldTriageAuditUpdateOne) SaveX(ctx context.Context) *GoldTriageAudit {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GoldTriageAuditUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoldTriageAuditUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *GoldTriageAuditUpdateOne) sqlSave(ctx context.Context) (_node *GoldTriageAudit, err error) {
+	_spec := sqlgraph.NewUpdateSpec(goldtriageaudit.Table, goldtriageaudit.Columns, sqlgraph.NewFieldSpec(goldtriageaudit.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`triage: missing "GoldTriageAudit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, goldtriageaudit.FieldID)
+		for _, f := range fields {
+			if !goldtriageaudit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("triage: invalid field %q for query", f)}
+			}
+			if f != goldtriageaudit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.FromStatusCleared() {
+		_spec.ClearField(goldtriageaudit.FieldFromStatus, field.TypeString)
+	}
+	if _u.mutation.ToStatusCleared() {
+		_spec.ClearField(goldtriageaudit.FieldToStatus, field.TypeString)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(goldtriageaudit.FieldReason, field.TypeString)
+	}
+	if _u.mutation.ChangesJSONCleared() {
+		_spec.ClearField(goldtriageaudit.FieldChangesJSON, field.TypeJSON)
+	}
+	_spec.Node.Schema = _u.schemaConfig.GoldTriageAudit
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &GoldTriageAudit{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goldtriageaudit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,58 @@
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// TriageAuditsColumns holds the columns for the "triage_audits" table.
+	TriageAuditsColumns = []*schema.Column{
+		{Name: "audit_id", Type: field.TypeInt, Increment: true},
+		{Name: "finding_table", Type: field.TypeString},
+		{Name: "finding_id", Type: field.TypeString},
+		{Name: "action", Type: field.TypeString},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "from_status", Type: field.TypeString, Nullable: true},
+		{Name: "to_status", Type: field.TypeString, Nullable: true},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "changes_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TriageAuditsTable holds the schema information for the "triage_audits" table.
+	TriageAuditsTable = &schema.Table{
+		Name:       "triage_audits",
+		Columns:    TriageAuditsColumns,
+		PrimaryKey: []*schema.Column{TriageAuditsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "goldtriageaudit_finding_table_finding_id",
+				Unique:  false,
+				Columns: []*schema.Column{TriageAuditsColumns[1], TriageAuditsColumns[2]},
+			},
+			{
+				Name:    "goldtriageaudit_actor",
+				Unique:  false,
+				Columns: []*schema.Column{TriageAuditsColumns[4]},
+			},
+			{
+				Name:    "goldtriageaudit_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TriageAuditsColumns[9]},
+			},
+		},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		TriageAuditsTable,
+	}
+)
+
+func init() {
+	TriageAuditsTable.Annotation = &entsql.Annotation{
+		Table: "triage_audits",
+	}
+}
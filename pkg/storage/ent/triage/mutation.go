@@ -0,0 +1,889 @@
+// Code generated by ent, DO NOT EDIT.
+
+package triage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/triage/goldtriageaudit"
+	"danny.vn/hotpot/pkg/storage/ent/triage/predicate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Operation types.
+	OpCreate    = ent.OpCreate
+	OpDelete    = ent.OpDelete
+	OpDeleteOne = ent.OpDeleteOne
+	OpUpdate    = ent.OpUpdate
+	OpUpdateOne = ent.OpUpdateOne
+
+	// Node types.
+	TypeGoldTriageAudit = "GoldTriageAudit"
+)
+
+// GoldTriageAuditMutation represents an operation that mutates the GoldTriageAudit nodes in the graph.
+type GoldTriageAuditMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	finding_table      *string
+	finding_id         *string
+	action             *string
+	actor              *string
+	from_status        *string
+	to_status          *string
+	reason             *string
+	changes_json       *json.RawMessage
+	appendchanges_json json.RawMessage
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*GoldTriageAudit, error)
+	predicates         []predicate.GoldTriageAudit
+}
+
+var _ ent.Mutation = (*GoldTriageAuditMutation)(nil)
+
+// goldtriageauditOption allows management of the mutation configuration using functional options.
+type goldtriageauditOption func(*GoldTriageAuditMutation)
+
+// newGoldTriageAuditMutation creates new mutation for the GoldTriageAudit entity.
+func newGoldTriageAuditMutation(c config, op Op, opts ...goldtriageauditOption) *GoldTriageAuditMutation {
+	m := &GoldTriageAuditMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGoldTriageAudit,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGoldTriageAuditID sets the ID field of the mutation.
+func withGoldTriageAuditID(id int) goldtriageauditOption {
+	return func(m *GoldTriageAuditMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GoldTriageAudit
+		)
+		m.oldValue = func(ctx context.Context) (*GoldTriageAudit, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GoldTriageAudit.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGoldTriageAudit sets the old GoldTriageAudit of the mutation.
+func withGoldTriageAudit(node *GoldTriageAudit) goldtriageauditOption {
+	return func(m *GoldTriageAuditMutation) {
+		m.oldValue = func(context.Context) (*GoldTriageAudit, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GoldTriageAuditMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GoldTriageAuditMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("triage: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GoldTriageAudit entities.
+func (m *GoldTriageAuditMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GoldTriageAuditMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GoldTriageAuditMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GoldTriageAudit.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFindingTable sets the "finding_table" field.
+func (m *GoldTriageAuditMutation) SetFindingTable(s string) {
+	m.finding_table = &s
+}
+
+// FindingTable returns the value of the "finding_table" field in the mutation.
+func (m *GoldTriageAuditMutation) FindingTable() (r string, exists bool) {
+	v := m.finding_table
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFindingTable returns the old "finding_table" field's value of the GoldTriageAudit entity.
+// If the GoldTriageAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldTriageAuditMutation) OldFindingTable(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFindingTable is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFindingTable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFindingTable: %w", err)
+	}
+	return oldValue.FindingTable, nil
+}
+
+// ResetFindingTable resets all changes to the "finding_table" field.
+func (m *GoldTriageAuditMutation) ResetFindingTable() {
+	m.finding_table = nil
+}
+
+// SetFindingID sets the "finding_id" field.
+func (m *GoldTriageAuditMutation) SetFindingID(s string) {
+	m.finding_id = &s
+}
+
+// FindingID returns the value of the "finding_id" field in the mutation.
+func (m *GoldTriageAuditMutation) FindingID() (r string, exists bool) {
+	v := m.finding_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFindingID returns the old "finding_id" field's value of the GoldTriageAudit entity.
+// If the GoldTriageAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldTriageAuditMutation) OldFindingID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFindingID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFindingID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFindingID: %w", err)
+	}
+	return oldValue.FindingID, nil
+}
+
+// ResetFindingID resets all changes to the "finding_id" field.
+func (m *GoldTriageAuditMutation) ResetFindingID() {
+	m.finding_id = nil
+}
+
+// SetAction sets the "action" field.
+func (m *GoldTriageAuditMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *GoldTriageAuditMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the GoldTriageAudit entity.
+// If the GoldTriageAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldTriageAuditMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *GoldTriageAuditMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetActor sets the "actor" field.
+func (m *GoldTriageAuditMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *GoldTriageAuditMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the GoldTriageAudit entity.
+// If the GoldTriageAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldTriageAuditMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *GoldTriageAuditMutation) ResetActor() {
+	m.actor = nil
+}
+
+// SetFromStatus sets the "from_status" field.
+func (m *GoldTriageAuditMutation) SetFromStatus(s string) {
+	m.from_status = &s
+}
+
+// FromStatus returns the value of the "from_status" field in the mutation.
+func (m *GoldTriageAuditMutation) FromStatus() (r string, exists bool) {
+	v := m.from_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromStatus returns the old "from_status" field's value of the GoldTriageAudit entity.
+// If the GoldTriageAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldTriageAuditMutation) OldFromStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromStatus: %w", err)
+	}
+	return oldValue.FromStatus, nil
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (m *GoldTriageAuditMutation) ClearFromStatus() {
+	m.from_status = nil
+	m.clearedFields[goldtriageaudit.FieldFromStatus] = struct{}{}
+}
+
+// FromStatusCleared returns if the "from_status" field was cleared in this mutation.
+func (m *GoldTriageAuditMutation) FromStatusCleared() bool {
+	_, ok := m.clearedFields[goldtriageaudit.FieldFromStatus]
+	return ok
+}
+
+// ResetFromStatus resets all changes to the "from_status" field.
+func (m *GoldTriageAuditMutation) ResetFromStatus() {
+	m.from_status = nil
+	delete(m.clearedFields, goldtriageaudit.FieldFromStatus)
+}
+
+// SetToStatus sets the "to_status" field.
+func (m *GoldTriageAuditMutation) SetToStatus(s string) {
+	m.to_status = &s
+}
+
+// ToStatus returns the value of the "to_status" field in the mutation.
+func (m *GoldTriageAuditMutation) ToStatus() (r string, exists bool) {
+	v := m.to_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToStatus returns the old "to_status" field's value of the GoldTriageAudit entity.
+// If the GoldTriageAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldTriageAuditMutation) OldToStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToStatus: %w", err)
+	}
+	return oldValue.ToStatus, nil
+}
+
+// ClearToStatus clears the value of the "to_status" field.
+func (m *GoldTriageAuditMutation) ClearToStatus() {
+	m.to_status = nil
+	m.clearedFields[goldtriageaudit.FieldToStatus] = struct{}{}
+}
+
+// ToStatusCleared returns if the "to_status" field was cleared in this mutation.
+func (m *GoldTriageAuditMutation) ToStatusCleared() bool {
+	_, ok := m.clearedFields[goldtriageaudit.FieldToStatus]
+	return ok
+}
+
+// ResetToStatus resets all changes to the "to_status" field.
+func (m *GoldTriageAuditMutation) ResetToStatus() {
+	m.to_status = nil
+	delete(m.clearedFields, goldtriageaudit.FieldToStatus)
+}
+
+// SetReason sets the "reason" field.
+func (m *GoldTriageAuditMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *GoldTriageAuditMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the GoldTriageAudit entity.
+// If the GoldTriageAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldTriageAuditMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *GoldTriageAuditMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[goldtriageaudit.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *GoldTriageAuditMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[goldtriageaudit.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *GoldTriageAuditMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, goldtriageaudit.FieldReason)
+}
+
+// SetChangesJSON sets the "changes_json" field.
+func (m *GoldTriageAuditMutation) SetChangesJSON(jm json.RawMessage) {
+	m.changes_json = &jm
+	m.appendchanges_json = nil
+}
+
+// ChangesJSON returns the value of the "changes_json" field in the mutation.
+func (m *GoldTriageAuditMutation) ChangesJSON() (r json.RawMessage, exists bool) {
+	v := m.changes_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChangesJSON returns the old "changes_json" field's value of the GoldTriageAudit entity.
+// If the GoldTriageAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldTriageAuditMutation) OldChangesJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangesJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangesJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangesJSON: %w", err)
+	}
+	return oldValue.ChangesJSON, nil
+}
+
+// AppendChangesJSON adds jm to the "changes_json" field.
+func (m *GoldTriageAuditMutation) AppendChangesJSON(jm json.RawMessage) {
+	m.appendchanges_json = append(m.appendchanges_json, jm...)
+}
+
+// AppendedChangesJSON returns the list of values that were appended to the "changes_json" field in this mutation.
+func (m *GoldTriageAuditMutation) AppendedChangesJSON() (json.RawMessage, bool) {
+	if len(m.appendchanges_json) == 0 {
+		return nil, false
+	}
+	return m.appendchanges_json, true
+}
+
+// ClearChangesJSON clears the value of the "changes_json" field.
+func (m *GoldTriageAuditMutation) ClearChangesJSON() {
+	m.changes_json = nil
+	m.appendchanges_json = nil
+	m.clearedFields[goldtriageaudit.FieldChangesJSON] = struct{}{}
+}
+
+// ChangesJSONCleared returns if the "changes_json" field was cleared in this mutation.
+func (m *GoldTriageAuditMutation) ChangesJSONCleared() bool {
+	_, ok := m.clearedFields[goldtriageaudit.FieldChangesJSON]
+	return ok
+}
+
+// ResetChangesJSON resets all changes to the "changes_json" field.
+func (m *GoldTriageAuditMutation) ResetChangesJSON() {
+	m.changes_json = nil
+	m.appendchanges_json = nil
+	delete(m.clearedFields, goldtriageaudit.FieldChangesJSON)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GoldTriageAuditMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GoldTriageAuditMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GoldTriageAudit entity.
+// If the GoldTriageAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldTriageAuditMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GoldTriageAuditMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the GoldTriageAuditMutation builder.
+func (m *GoldTriageAuditMutation) Where(ps ...predicate.GoldTriageAudit) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GoldTriageAuditMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GoldTriageAuditMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GoldTriageAudit, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GoldTriageAuditMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GoldTriageAuditMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GoldTriageAudit).
+func (m *GoldTriageAuditMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GoldTriageAuditMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.finding_table != nil {
+		fields = append(fields, goldtriageaudit.FieldFindingTable)
+	}
+	if m.finding_id != nil {
+		fields = append(fields, goldtriageaudit.FieldFindingID)
+	}
+	if m.action != nil {
+		fields = append(fields, goldtriageaudit.FieldAction)
+	}
+	if m.actor != nil {
+		fields = append(fields, goldtriageaudit.FieldActor)
+	}
+	if m.from_status != nil {
+		fields = append(fields, goldtriageaudit.FieldFromStatus)
+	}
+	if m.to_status != nil {
+		fields = append(fields, goldtriageaudit.FieldToStatus)
+	}
+	if m.reason != nil {
+		fields = append(fields, goldtriageaudit.FieldReason)
+	}
+	if m.changes_json != nil {
+		fields = append(fields, goldtriageaudit.FieldChangesJSON)
+	}
+	if m.created_at != nil {
+		fields = append(fields, goldtriageaudit.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GoldTriageAuditMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case goldtriageaudit.FieldFindingTable:
+		return m.FindingTable()
+	case goldtriageaudit.FieldFindingID:
+		return m.FindingID()
+	case goldtriageaudit.FieldAction:
+		return m.Action()
+	case goldtriageaudit.FieldActor:
+		return m.Actor()
+	case goldtriageaudit.FieldFromStatus:
+		return m.FromStatus()
+	case goldtriageaudit.FieldToStatus:
+		return m.ToStatus()
+	case goldtriageaudit.FieldReason:
+		return m.Reason()
+	case goldtriageaudit.FieldChangesJSON:
+		return m.ChangesJSON()
+	case goldtriageaudit.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GoldTriageAuditMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case goldtriageaudit.FieldFindingTable:
+		return m.OldFindingTable(ctx)
+	case goldtriageaudit.FieldFindingID:
+		return m.OldFindingID(ctx)
+	case goldtriageaudit.FieldAction:
+		return m.OldAction(ctx)
+	case goldtriageaudit.FieldActor:
+		return m.OldActor(ctx)
+	case goldtriageaudit.FieldFromStatus:
+		return m.OldFromStatus(ctx)
+	case goldtriageaudit.FieldToStatus:
+		return m.OldToStatus(ctx)
+	case goldtriageaudit.FieldReason:
+		return m.OldReason(ctx)
+	case goldtriageaudit.FieldChangesJSON:
+		return m.OldChangesJSON(ctx)
+	case goldtriageaudit.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GoldTriageAudit field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoldTriageAuditMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case goldtriageaudit.FieldFindingTable:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFindingTable(v)
+		return nil
+	case goldtriageaudit.FieldFindingID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFindingID(v)
+		return nil
+	case goldtriageaudit.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case goldtriageaudit.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case goldtriageaudit.FieldFromStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromStatus(v)
+		return nil
+	case goldtriageaudit.FieldToStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToStatus(v)
+		return nil
+	case goldtriageaudit.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case goldtriageaudit.FieldChangesJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChangesJSON(v)
+		return nil
+	case goldtriageaudit.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GoldTriageAudit field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GoldTriageAuditMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GoldTriageAuditMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoldTriageAuditMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GoldTriageAudit numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GoldTriageAuditMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(goldtriageaudit.FieldFromStatus) {
+		fields = append(fields, goldtriageaudit.FieldFromStatus)
+	}
+	if m.FieldCleared(goldtriageaudit.FieldToStatus) {
+		fields = append(fields, goldtriageaudit.FieldToStatus)
+	}
+	if m.FieldCleared(goldtriageaudit.FieldReason) {
+		fields = append(fields, goldtriageaudit.FieldReason)
+	}
+	if m.FieldCleared(goldtriageaudit.FieldChangesJSON) {
+		fields = append(fields, goldtriageaudit.FieldChangesJSON)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GoldTriageAuditMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GoldTriageAuditMutation) ClearField(name string) error {
+	switch name {
+	case goldtriageaudit.FieldFromStatus:
+		m.ClearFromStatus()
+		return nil
+	case goldtriageaudit.FieldToStatus:
+		m.ClearToStatus()
+		return nil
+	case goldtriageaudit.FieldReason:
+		m.ClearReason()
+		return nil
+	case goldtriageaudit.FieldChangesJSON:
+		m.ClearChangesJSON()
+		return nil
+	}
+	return fmt.Errorf("unknown GoldTriageAudit nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GoldTriageAuditMutation) ResetField(name string) error {
+	switch name {
+	case goldtriageaudit.FieldFindingTable:
+		m.ResetFindingTable()
+		return nil
+	case goldtriageaudit.FieldFindingID:
+		m.ResetFindingID()
+		return nil
+	case goldtriageaudit.FieldAction:
+		m.ResetAction()
+		return nil
+	case goldtriageaudit.FieldActor:
+		m.ResetActor()
+		return nil
+	case goldtriageaudit.FieldFromStatus:
+		m.ResetFromStatus()
+		return nil
+	case goldtriageaudit.FieldToStatus:
+		m.ResetToStatus()
+		return nil
+	case goldtriageaudit.FieldReason:
+		m.ResetReason()
+		return nil
+	case goldtriageaudit.FieldChangesJSON:
+		m.ResetChangesJSON()
+		return nil
+	case goldtriageaudit.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GoldTriageAudit field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GoldTriageAuditMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GoldTriageAuditMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GoldTriageAuditMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GoldTriageAuditMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GoldTriageAuditMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GoldTriageAuditMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GoldTriageAuditMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown GoldTriageAudit unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GoldTriageAuditMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown GoldTriageAudit edge %s", name)
+}
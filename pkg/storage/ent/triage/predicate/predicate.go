@@ -0,0 +1,10 @@
+// Code generated by ent, DO NOT EDIT.
+
+package predicate
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+// GoldTriageAudit is the predicate function for goldtriageaudit builders.
+type GoldTriageAudit func(*sql.Selector)
@@ -0,0 +1,32 @@
+// Code generated by ent, DO NOT EDIT.
+
+package triage
+
+import (
+	"danny.vn/hotpot/pkg/storage/ent/triage/goldtriageaudit"
+	"danny.vn/hotpot/pkg/storage/ent/triage/schema"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	goldtriageauditFields := schema.GoldTriageAudit{}.Fields()
+	_ = goldtriageauditFields
+	// goldtriageauditDescFindingTable is the schema descriptor for finding_table field.
+	goldtriageauditDescFindingTable := goldtriageauditFields[1].Descriptor()
+	// goldtriageaudit.FindingTableValidator is a validator for the "finding_table" field. It is called by the builders before save.
+	goldtriageaudit.FindingTableValidator = goldtriageauditDescFindingTable.Validators[0].(func(string) error)
+	// goldtriageauditDescFindingID is the schema descriptor for finding_id field.
+	goldtriageauditDescFindingID := goldtriageauditFields[2].Descriptor()
+	// goldtriageaudit.FindingIDValidator is a validator for the "finding_id" field. It is called by the builders before save.
+	goldtriageaudit.FindingIDValidator = goldtriageauditDescFindingID.Validators[0].(func(string) error)
+	// goldtriageauditDescAction is the schema descriptor for action field.
+	goldtriageauditDescAction := goldtriageauditFields[3].Descriptor()
+	// goldtriageaudit.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	goldtriageaudit.ActionValidator = goldtriageauditDescAction.Validators[0].(func(string) error)
+	// goldtriageauditDescActor is the schema descriptor for actor field.
+	goldtriageauditDescActor := goldtriageauditFields[4].Descriptor()
+	// goldtriageaudit.ActorValidator is a validator for the "actor" field. It is called by the builders before save.
+	goldtriageaudit.ActorValidator = goldtriageauditDescActor.Validators[0].(func(string) error)
+}
@@ -0,0 +1,356 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vulnerability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+
+	"danny.vn/hotpot/pkg/storage/ent/vulnerability/migrate"
+
+	"danny.vn/hotpot/pkg/storage/ent/vulnerability/goldvulnerabilityfinding"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"danny.vn/hotpot/pkg/storage/ent/vulnerability/internal"
+)
+
+// Client is the client that holds all ent builders.
+type Client struct {
+	config
+	// Schema is the client for creating, migrating and dropping schema.
+	Schema *migrate.Schema
+	// GoldVulnerabilityFinding is the client for interacting with the GoldVulnerabilityFinding builders.
+	GoldVulnerabilityFinding *GoldVulnerabilityFindingClient
+}
+
+// NewClient creates a new client configured with the given options.
+func NewClient(opts ...Option) *Client {
+	client := &Client{config: newConfig(opts...)}
+	client.init()
+	return client
+}
+
+func (c *Client) init() {
+	c.Schema = migrate.NewSchema(c.driver)
+	c.GoldVulnerabilityFinding = NewGoldVulnerabilityFindingClient(c.config)
+}
+
+type (
+	// config is the configuration for the client and its builder.
+	config struct {
+		// driver used for executing database requests.
+		driver dialect.Driver
+		// debug enable a debug logging.
+		debug bool
+		// log used for logging on debug mode.
+		log func(...any)
+		// hooks to execute on mutations.
+		hooks *hooks
+		// interceptors to execute on queries.
+		inters *inters
+		// schemaConfig contains alternative names for all tables.
+		schemaConfig SchemaConfig
+	}
+	// Option function to configure the client.
+	Option func(*config)
+)
+
+// newConfig creates a new config for the client.
+func newConfig(opts ...Option) config {
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
+	cfg.options(opts...)
+	return cfg
+}
+
+// options applies the options on the config object.
+func (c *config) options(opts ...Option) {
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.debug {
+		c.driver = dialect.Debug(c.driver, c.log)
+	}
+}
+
+// Debug enables debug logging on the ent.Driver.
+func Debug() Option {
+	return func(c *config) {
+		c.debug = true
+	}
+}
+
+// Log sets the logging function for debug mode.
+func Log(fn func(...any)) Option {
+	return func(c *config) {
+		c.log = fn
+	}
+}
+
+// Driver configures the client driver.
+func Driver(driver dialect.Driver) Option {
+	return func(c *config) {
+		c.driver = driver
+	}
+}
+
+// Open opens a database/sql.DB specified by the driver name and
+// the data source name, and returns a new client attached to it.
+// Optional parameters can be added for configuring the client.
+func Open(driverName, dataSourceName string, options ...Option) (*Client, error) {
+	switch driverName {
+	case dialect.MySQL, dialect.Postgres, dialect.SQLite:
+		drv, err := sql.Open(driverName, dataSourceName)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(append(options, Driver(drv))...), nil
+	default:
+		return nil, fmt.Errorf("unsupported driver: %q", driverName)
+	}
+}
+
+// ErrTxStarted is returned when trying to start a new transaction from a transactional client.
+var ErrTxStarted = errors.New("vulnerability: cannot start a transaction within a transaction")
+
+// Tx returns a new transactional client. The provided context
+// is used until the transaction is committed or rolled back.
+func (c *Client) Tx(ctx context.Context) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, ErrTxStarted
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("vulnerability: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	return &Tx{
+		ctx:                      ctx,
+		config:                   cfg,
+		GoldVulnerabilityFinding: NewGoldVulnerabilityFindingClient(cfg),
+	}, nil
+}
+
+// BeginTx returns a transactional client with specified options.
+func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, errors.New("ent: cannot start a transaction within a transaction")
+	}
+	tx, err := c.driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}).BeginTx(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = &txDriver{tx: tx, drv: c.driver}
+	return &Tx{
+		ctx:                      ctx,
+		config:                   cfg,
+		GoldVulnerabilityFinding: NewGoldVulnerabilityFindingClient(cfg),
+	}, nil
+}
+
+// Debug returns a new debug-client. It's used to get verbose logging on specific operations.
+//
+//	client.Debug().
+//		GoldVulnerabilityFinding.
+//		Query().
+//		Count(ctx)
+func (c *Client) Debug() *Client {
+	if c.debug {
+		return c
+	}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// Close closes the database connection and prevents new queries from starting.
+func (c *Client) Close() error {
+	return c.driver.Close()
+}
+
+// Use adds the mutation hooks to all the entity clients.
+// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
+func (c *Client) Use(hooks ...Hook) {
+	c.GoldVulnerabilityFinding.Use(hooks...)
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.GoldVulnerabilityFinding.Intercept(interceptors...)
+}
+
+// Mutate implements the ent.Mutator interface.
+func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
+	switch m := m.(type) {
+	case *GoldVulnerabilityFindingMutation:
+		return c.GoldVulnerabilityFinding.mutate(ctx, m)
+	default:
+		return nil, fmt.Errorf("vulnerability: unknown mutation type %T", m)
+	}
+}
+
+// GoldVulnerabilityFindingClient is a client for the GoldVulnerabilityFinding schema.
+type GoldVulnerabilityFindingClient struct {
+	config
+}
+
+// NewGoldVulnerabilityFindingClient returns a client for the GoldVulnerabilityFinding from the given config.
+func NewGoldVulnerabilityFindingClient(c config) *GoldVulnerabilityFindingClient {
+	return &GoldVulnerabilityFindingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `goldvulnerabilityfinding.Hooks(f(g(h())))`.
+func (c *GoldVulnerabilityFindingClient) Use(hooks ...Hook) {
+	c.hooks.GoldVulnerabilityFinding = append(c.hooks.GoldVulnerabilityFinding, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `goldvulnerabilityfinding.Intercept(f(g(h())))`.
+func (c *GoldVulnerabilityFindingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GoldVulnerabilityFinding = append(c.inters.GoldVulnerabilityFinding, interceptors...)
+}
+
+// Create returns a builder for creating a GoldVulnerabilityFinding entity.
+func (c *GoldVulnerabilityFindingClient) Create() *GoldVulnerabilityFindingCreate {
+	mutation := newGoldVulnerabilityFindingMutation(c.config, OpCreate)
+	return &GoldVulnerabilityFindingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GoldVulnerabilityFinding entities.
+func (c *GoldVulnerabilityFindingClient) CreateBulk(builders ...*GoldVulnerabilityFindingCreate) *GoldVulnerabilityFindingCreateBulk {
+	return &GoldVulnerabilityFindingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GoldVulnerabilityFindingClient) MapCreateBulk(slice any, setFunc func(*GoldVulnerabilityFindingCreate, int)) *GoldVulnerabilityFindingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GoldVulnerabilityFindingCreateBulk{err: fmt.Errorf("calling to GoldVulnerabilityFindingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GoldVulnerabilityFindingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GoldVulnerabilityFindingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GoldVulnerabilityFinding.
+func (c *GoldVulnerabilityFindingClient) Update() *GoldVulnerabilityFindingUpdate {
+	mutation := newGoldVulnerabilityFindingMutation(c.config, OpUpdate)
+	return &GoldVulnerabilityFindingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GoldVulnerabilityFindingClient) UpdateOne(_m *GoldVulnerabilityFinding) *GoldVulnerabilityFindingUpdateOne {
+	mutation := newGoldVulnerabilityFindingMutation(c.config, OpUpdateOne, withGoldVulnerabilityFinding(_m))
+	return &GoldVulnerabilityFindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GoldVulnerabilityFindingClient) UpdateOneID(id string) *GoldVulnerabilityFindingUpdateOne {
+	mutation := newGoldVulnerabilityFindingMutation(c.config, OpUpdateOne, withGoldVulnerabilityFindingID(id))
+	return &GoldVulnerabilityFindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GoldVulnerabilityFinding.
+func (c *GoldVulnerabilityFindingClient) Delete() *GoldVulnerabilityFindingDelete {
+	mutation := newGoldVulnerabilityFindingMutation(c.config, OpDelete)
+	return &GoldVulnerabilityFindingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GoldVulnerabilityFindingClient) DeleteOne(_m *GoldVulnerabilityFinding) *GoldVulnerabilityFindingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GoldVulnerabilityFindingClient) DeleteOneID(id string) *GoldVulnerabilityFindingDeleteOne {
+	builder := c.Delete().Where(goldvulnerabilityfinding.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GoldVulnerabilityFindingDeleteOne{builder}
+}
+
+// Query returns a query builder for GoldVulnerabilityFinding.
+func (c *GoldVulnerabilityFindingClient) Query() *GoldVulnerabilityFindingQuery {
+	return &GoldVulnerabilityFindingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGoldVulnerabilityFinding},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GoldVulnerabilityFinding entity by its id.
+func (c *GoldVulnerabilityFindingClient) Get(ctx context.Context, id string) (*GoldVulnerabilityFinding, error) {
+	return c.Query().Where(goldvulnerabilityfinding.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GoldVulnerabilityFindingClient) GetX(ctx context.Context, id string) *GoldVulnerabilityFinding {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GoldVulnerabilityFindingClient) Hooks() []Hook {
+	return c.hooks.GoldVulnerabilityFinding
+}
+
+// Interceptors returns the client interceptors.
+func (c *GoldVulnerabilityFindingClient) Interceptors() []Interceptor {
+	return c.inters.GoldVulnerabilityFinding
+}
+
+func (c *GoldVulnerabilityFindingClient) mutate(ctx context.Context, m *GoldVulnerabilityFindingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GoldVulnerabilityFindingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GoldVulnerabilityFindingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GoldVulnerabilityFindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GoldVulnerabilityFindingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("vulnerability: unknown GoldVulnerabilityFinding mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		GoldVulnerabilityFinding []ent.Hook
+	}
+	inters struct {
+		GoldVulnerabilityFinding []ent.Interceptor
+	}
+)
+
+// SchemaConfig represents alternative schema names for all tables
+// that can be passed at runtime.
+type SchemaConfig = internal.SchemaConfig
+
+// AlternateSchemas allows alternate schema names to be
+// passed into ent operations.
+func AlternateSchema(schemaConfig SchemaConfig) Option {
+	return func(c *config) {
+		c.schemaConfig = schemaConfig
+	}
+}
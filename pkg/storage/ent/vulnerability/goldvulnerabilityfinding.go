@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vulnerability
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/vulnerability/goldvulnerabilityfinding"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// GoldVulnerabilityFinding is the model entity for the GoldVulnerabilityFinding schema.
+type GoldVulnerabilityFinding struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// DetectedAt holds the value of the "detected_at" field.
+	DetectedAt time.Time `json:"detected_at,omitempty"`
+	// FirstDetectedAt holds the value of the "first_detected_at" field.
+	FirstDetectedAt time.Time `json:"first_detected_at,omitempty"`
+	// open, acknowledged, suppressed, false_positive, resolved
+	Status string `json:"status,omitempty"`
+	// Assignee holds the value of the "assignee" field.
+	Assignee string `json:"assignee,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// Suppressed findings reopen when re-detected after this time
+	SuppressedUntil *time.Time `json:"suppressed_until,omitempty"`
+	// Set when a finding is no longer detected, cleared on reopen
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// Advances every time the detector observes the finding
+	LastSeenAt time.Time `json:"last_seen_at,omitempty"`
+	// MachineID holds the value of the "machine_id" field.
+	MachineID string `json:"machine_id,omitempty"`
+	// Hostname holds the value of the "hostname" field.
+	Hostname string `json:"hostname,omitempty"`
+	// PackageName holds the value of the "package_name" field.
+	PackageName string `json:"package_name,omitempty"`
+	// PackageVersion holds the value of the "package_version" field.
+	PackageVersion string `json:"package_version,omitempty"`
+	// CVE ID, or the advisory ID when the advisory has no CVE alias
+	VulnID string `json:"vuln_id,omitempty"`
+	// Advisory IDs that reported this vulnerability, e.g. USN-6543-1, GHSA-...
+	Aliases []string `json:"aliases,omitempty"`
+	// osv, nvd
+	Source string `json:"source,omitempty"`
+	// OSV ecosystem (e.g. Ubuntu:22.04:LTS) or cpe:{vendor}:{product}
+	Ecosystem string `json:"ecosystem,omitempty"`
+	// deb, rpm, semver
+	VersionScheme string `json:"version_scheme,omitempty"`
+	// critical, high, medium, low, unknown
+	Severity string `json:"severity,omitempty"`
+	// CvssScore holds the value of the "cvss_score" field.
+	CvssScore *float64 `json:"cvss_score,omitempty"`
+	// CvssVector holds the value of the "cvss_vector" field.
+	CvssVector string `json:"cvss_vector,omitempty"`
+	// First fixed version; empty when no fix is published
+	FixedVersion string `json:"fixed_version,omitempty"`
+	// Summary holds the value of the "summary" field.
+	Summary string `json:"summary,omitempty"`
+	// Published holds the value of the "published" field.
+	Published    *time.Time `json:"published,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GoldVulnerabilityFinding) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case goldvulnerabilityfinding.FieldAliases:
+			values[i] = new([]byte)
+		case goldvulnerabilityfinding.FieldCvssScore:
+			values[i] = new(sql.NullFloat64)
+		case goldvulnerabilityfinding.FieldID, goldvulnerabilityfinding.FieldStatus, goldvulnerabilityfinding.FieldAssignee, goldvulnerabilityfinding.FieldNote, goldvulnerabilityfinding.FieldMachineID, goldvulnerabilityfinding.FieldHostname, goldvulnerabilityfinding.FieldPackageName, goldvulnerabilityfinding.FieldPackageVersion, goldvulnerabilityfinding.FieldVulnID, goldvulnerabilityfinding.FieldSource, goldvulnerabilityfinding.FieldEcosystem, goldvulnerabilityfinding.FieldVersionScheme, goldvulnerabilityfinding.FieldSeverity, goldvulnerabilityfinding.FieldCvssVector, goldvulnerabilityfinding.FieldFixedVersion, goldvulnerabilityfinding.FieldSummary:
+			values[i] = new(sql.NullString)
+		case goldvulnerabilityfinding.FieldDetectedAt, goldvulnerabilityfinding.FieldFirstDetectedAt, goldvulnerabilityfinding.FieldSuppressedUntil, goldvulnerabilityfinding.FieldResolvedAt, goldvulnerabilityfinding.FieldLastSeenAt, goldvulnerabilityfinding.FieldPublished:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GoldVulnerabilityFinding fields.
+func (_m *GoldVulnerabilityFinding) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case goldvulnerabilityfinding.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case goldvulnerabilityfinding.FieldDetectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field detected_at", values[i])
+			} else if value.Valid {
+				_m.DetectedAt = value.Time
+			}
+		case goldvulnerabilityfinding.FieldFirstDetectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_detected_at", values[i])
+			} else if value.Valid {
+				_m.FirstDetectedAt = value.Time
+			}
+		case goldvulnerabilityfinding.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case goldvulnerabilityfinding.FieldAssignee:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field assignee", values[i])
+			} else if value.Valid {
+				_m.Assignee = value.String
+			}
+		case goldvulnerabilityfinding.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case goldvulnerabilityfinding.FieldSuppressedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field suppressed_until", values[i])
+			} else if value.Valid {
+				_m.SuppressedUntil = new(time.Time)
+				*_m.SuppressedUntil = value.Time
+			}
+		case goldvulnerabilityfinding.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				_m.ResolvedAt = new(time.Time)
+				*_m.ResolvedAt = value.Time
+			}
+		case goldvulnerabilityfinding.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				_m.LastSeenAt = value.Time
+			}
+		case goldvulnerabilityfinding.FieldMachineID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field machine_id", values[i])
+			} else if value.Valid {
+				_m.MachineID = value.String
+			}
+		case goldvulnerabilityfinding.FieldHostname:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hostname", values[i])
+			} else if value.Valid {
+				_m.Hostname = value.String
+			}
+		case goldvulnerabilityfinding.FieldPackageName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field package_name", values[i])
+			} else if value.Valid {
+				_m.PackageName = value.String
+			}
+		case goldvulnerabilityfinding.FieldPackageVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field package_version", values[i])
+			} else if value.Valid {
+				_m.PackageVersion = value.String
+			}
+		case goldvulnerabilityfinding.FieldVulnID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vuln_id", values[i])
+			} else if value.Valid {
+				_m.VulnID = value.String
+			}
+		case goldvulnerabilityfinding.FieldAliases:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field aliases", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Aliases); err != nil {
+					return fmt.Errorf("unmarshal field aliases: %w", err)
+				}
+			}
+		case goldvulnerabilityfinding.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case goldvulnerabilityfinding.FieldEcosystem:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ecosystem", values[i])
+			} else if value.Valid {
+				_m.Ecosystem = value.String
+			}
+		case goldvulnerabilityfinding.FieldVersionScheme:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version_scheme", values[i])
+			} else if value.Valid {
+				_m.VersionScheme = value.String
+			}
+		case goldvulnerabilityfinding.FieldSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field severity", values[i])
+			} else if value.Valid {
+				_m.Severity = value.String
+			}
+		case goldvulnerabilityfinding.FieldCvssScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cvss_score", values[i])
+			} else if value.Valid {
+				_m.CvssScore = new(float64)
+				*_m.CvssScore = value.Float64
+			}
+		case goldvulnerabilityfinding.FieldCvssVector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cvss_vector", values[i])
+			} else if value.Valid {
+				_m.CvssVector = value.String
+			}
+		case goldvulnerabilityfinding.FieldFixedVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fixed_version", values[i])
+			} else if value.Valid {
+				_m.FixedVersion = value.String
+			}
+		case goldvulnerabilityfinding.FieldSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field summary", values[i])
+			} else if value.Valid {
+				_m.Summary = value.String
+			}
+		case goldvulnerabilityfinding.FieldPublished:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published", values[i])
+			} else if value.Valid {
+				_m.Published = new(time.Time)
+				*_m.Published = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GoldVulnerabilityFinding.
+// This includes values selected through modifiers, order, etc.
+func (_m *GoldVulnerabilityFinding) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GoldVulnerabilityFinding.
+// Note that you need to call GoldVulnerabilityFinding.Unwrap() before calling this method if this GoldVulnerabilityFinding
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GoldVulnerabilityFinding) Update() *GoldVulnerabilityFindingUpdateOne {
+	return NewGoldVulnerabilityFindingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GoldVulnerabilityFinding entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GoldVulnerabilityFinding) Unwrap() *GoldVulnerabilityFinding {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("vulnerability: GoldVulnerabilityFinding is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GoldVulnerabilityFinding) String() string {
+	var builder strings.Builder
+	builder.WriteString("GoldVulnerabilityFinding(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("detected_at=")
+	builder.WriteString(_m.DetectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_detected_at=")
+	builder.WriteString(_m.FirstDetectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("assignee=")
+	builder.WriteString(_m.Assignee)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	if v := _m.SuppressedUntil; v != nil {
+		builder.WriteString("suppressed_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_seen_at=")
+	builder.WriteString(_m.LastSeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("machine_id=")
+	builder.WriteString(_m.MachineID)
+	builder.WriteString(", ")
+	builder.WriteString("hostname=")
+	builder.WriteString(_m.Hostname)
+	builder.WriteString(", ")
+	builder.WriteString("package_name=")
+	builder.WriteString(_m.PackageName)
+	builder.WriteString(", ")
+	builder.WriteString("package_version=")
+	builder.WriteString(_m.PackageVersion)
+	builder.WriteString(", ")
+	builder.WriteString("vuln_id=")
+	builder.WriteString(_m.VulnID)
+	builder.WriteString(", ")
+	builder.WriteString("aliases=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Aliases))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("ecosystem=")
+	builder.WriteString(_m.Ecosystem)
+	builder.WriteString(", ")
+	builder.WriteString("version_scheme=")
+	builder.WriteString(_m.VersionScheme)
+	builder.WriteString(", ")
+	builder.WriteString("severity=")
+	builder.WriteString(_m.Severity)
+	builder.WriteString(", ")
+	if v := _m.CvssScore; v != nil {
+		builder.WriteString("cvss_score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cvss_vector=")
+	builder.WriteString(_m.CvssVector)
+	builder.WriteString(", ")
+	builder.WriteString("fixed_version=")
+	builder.WriteString(_m.FixedVersion)
+	builder.WriteString(", ")
+	builder.WriteString("summary=")
+	builder.WriteString(_m.Summary)
+	builder.WriteString(", ")
+	if v := _m.Published; v != nil {
+		builder.WriteString("published=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GoldVulnerabilityFindings is a parsable slice of GoldVulnerabilityFinding.
+type GoldVulnerabilityFindings []*GoldVulnerabilityFinding
@@ -0,0 +1,239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goldvulnerabilityfinding
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the goldvulnerabilityfinding type in the database.
+	Label = "gold_vulnerability_finding"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "resource_id"
+	// FieldDetectedAt holds the string denoting the detected_at field in the database.
+	FieldDetectedAt = "detected_at"
+	// FieldFirstDetectedAt holds the string denoting the first_detected_at field in the database.
+	FieldFirstDetectedAt = "first_detected_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAssignee holds the string denoting the assignee field in the database.
+	FieldAssignee = "assignee"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldSuppressedUntil holds the string denoting the suppressed_until field in the database.
+	FieldSuppressedUntil = "suppressed_until"
+	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
+	FieldResolvedAt = "resolved_at"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// FieldMachineID holds the string denoting the machine_id field in the database.
+	FieldMachineID = "machine_id"
+	// FieldHostname holds the string denoting the hostname field in the database.
+	FieldHostname = "hostname"
+	// FieldPackageName holds the string denoting the package_name field in the database.
+	FieldPackageName = "package_name"
+	// FieldPackageVersion holds the string denoting the package_version field in the database.
+	FieldPackageVersion = "package_version"
+	// FieldVulnID holds the string denoting the vuln_id field in the database.
+	FieldVulnID = "vuln_id"
+	// FieldAliases holds the string denoting the aliases field in the database.
+	FieldAliases = "aliases"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldEcosystem holds the string denoting the ecosystem field in the database.
+	FieldEcosystem = "ecosystem"
+	// FieldVersionScheme holds the string denoting the version_scheme field in the database.
+	FieldVersionScheme = "version_scheme"
+	// FieldSeverity holds the string denoting the severity field in the database.
+	FieldSeverity = "severity"
+	// FieldCvssScore holds the string denoting the cvss_score field in the database.
+	FieldCvssScore = "cvss_score"
+	// FieldCvssVector holds the string denoting the cvss_vector field in the database.
+	FieldCvssVector = "cvss_vector"
+	// FieldFixedVersion holds the string denoting the fixed_version field in the database.
+	FieldFixedVersion = "fixed_version"
+	// FieldSummary holds the string denoting the summary field in the database.
+	FieldSummary = "summary"
+	// FieldPublished holds the string denoting the published field in the database.
+	FieldPublished = "published"
+	// Table holds the table name of the goldvulnerabilityfinding in the database.
+	Table = "vulnerability_findings"
+)
+
+// Columns holds all SQL columns for goldvulnerabilityfinding fields.
+var Columns = []string{
+	FieldID,
+	FieldDetectedAt,
+	FieldFirstDetectedAt,
+	FieldStatus,
+	FieldAssignee,
+	FieldNote,
+	FieldSuppressedUntil,
+	FieldResolvedAt,
+	FieldLastSeenAt,
+	FieldMachineID,
+	FieldHostname,
+	FieldPackageName,
+	FieldPackageVersion,
+	FieldVulnID,
+	FieldAliases,
+	FieldSource,
+	FieldEcosystem,
+	FieldVersionScheme,
+	FieldSeverity,
+	FieldCvssScore,
+	FieldCvssVector,
+	FieldFixedVersion,
+	FieldSummary,
+	FieldPublished,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// MachineIDValidator is a validator for the "machine_id" field. It is called by the builders before save.
+	MachineIDValidator func(string) error
+	// PackageNameValidator is a validator for the "package_name" field. It is called by the builders before save.
+	PackageNameValidator func(string) error
+	// PackageVersionValidator is a validator for the "package_version" field. It is called by the builders before save.
+	PackageVersionValidator func(string) error
+	// VulnIDValidator is a validator for the "vuln_id" field. It is called by the builders before save.
+	VulnIDValidator func(string) error
+	// SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	SourceValidator func(string) error
+	// VersionSchemeValidator is a validator for the "version_scheme" field. It is called by the builders before save.
+	VersionSchemeValidator func(string) error
+	// SeverityValidator is a validator for the "severity" field. It is called by the builders before save.
+	SeverityValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the GoldVulnerabilityFinding queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDetectedAt orders the results by the detected_at field.
+func ByDetectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDetectedAt, opts...).ToFunc()
+}
+
+// ByFirstDetectedAt orders the results by the first_detected_at field.
+func ByFirstDetectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstDetectedAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAssignee orders the results by the assignee field.
+func ByAssignee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssignee, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// BySuppressedUntil orders the results by the suppressed_until field.
+func BySuppressedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuppressedUntil, opts...).ToFunc()
+}
+
+// ByResolvedAt orders the results by the resolved_at field.
+func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
+}
+
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
+
+// ByMachineID orders the results by the machine_id field.
+func ByMachineID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMachineID, opts...).ToFunc()
+}
+
+// ByHostname orders the results by the hostname field.
+func ByHostname(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHostname, opts...).ToFunc()
+}
+
+// ByPackageName orders the results by the package_name field.
+func ByPackageName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPackageName, opts...).ToFunc()
+}
+
+// ByPackageVersion orders the results by the package_version field.
+func ByPackageVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPackageVersion, opts...).ToFunc()
+}
+
+// ByVulnID orders the results by the vuln_id field.
+func ByVulnID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVulnID, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByEcosystem orders the results by the ecosystem field.
+func ByEcosystem(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEcosystem, opts...).ToFunc()
+}
+
+// ByVersionScheme orders the results by the version_scheme field.
+func ByVersionScheme(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersionScheme, opts...).ToFunc()
+}
+
+// BySeverity orders the results by the severity field.
+func BySeverity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeverity, opts...).ToFunc()
+}
+
+// ByCvssScore orders the results by the cvss_score field.
+func ByCvssScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCvssScore, opts...).ToFunc()
+}
+
+// ByCvssVector orders the results by the cvss_vector field.
+func ByCvssVector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCvssVector, opts...).ToFunc()
+}
+
+// ByFixedVersion orders the results by the fixed_version field.
+func ByFixedVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFixedVersion, opts...).ToFunc()
+}
+
+// BySummary orders the results by the summary field.
+func BySummary(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSummary, opts...).ToFunc()
+}
+
+// ByPublished orders the results by the published field.
+func ByPublished(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublished, opts...).ToFunc()
+}
@@ -0,0 +1,1565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goldvulnerabilityfinding
+
+import (
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/vulnerability/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContainsFold(FieldID, id))
+}
+
+// DetectedAt applies equality check predicate on the "detected_at" field. It's identical to DetectedAtEQ.
+func DetectedAt(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldDetectedAt, v))
+}
+
+// FirstDetectedAt applies equality check predicate on the "first_detected_at" field. It's identical to FirstDetectedAtEQ.
+func FirstDetectedAt(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldFirstDetectedAt, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldStatus, v))
+}
+
+// Assignee applies equality check predicate on the "assignee" field. It's identical to AssigneeEQ.
+func Assignee(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldAssignee, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldNote, v))
+}
+
+// SuppressedUntil applies equality check predicate on the "suppressed_until" field. It's identical to SuppressedUntilEQ.
+func SuppressedUntil(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldSuppressedUntil, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// MachineID applies equality check predicate on the "machine_id" field. It's identical to MachineIDEQ.
+func MachineID(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldMachineID, v))
+}
+
+// Hostname applies equality check predicate on the "hostname" field. It's identical to HostnameEQ.
+func Hostname(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldHostname, v))
+}
+
+// PackageName applies equality check predicate on the "package_name" field. It's identical to PackageNameEQ.
+func PackageName(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldPackageName, v))
+}
+
+// PackageVersion applies equality check predicate on the "package_version" field. It's identical to PackageVersionEQ.
+func PackageVersion(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldPackageVersion, v))
+}
+
+// VulnID applies equality check predicate on the "vuln_id" field. It's identical to VulnIDEQ.
+func VulnID(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldVulnID, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldSource, v))
+}
+
+// Ecosystem applies equality check predicate on the "ecosystem" field. It's identical to EcosystemEQ.
+func Ecosystem(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldEcosystem, v))
+}
+
+// VersionScheme applies equality check predicate on the "version_scheme" field. It's identical to VersionSchemeEQ.
+func VersionScheme(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldVersionScheme, v))
+}
+
+// Severity applies equality check predicate on the "severity" field. It's identical to SeverityEQ.
+func Severity(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldSeverity, v))
+}
+
+// CvssScore applies equality check predicate on the "cvss_score" field. It's identical to CvssScoreEQ.
+func CvssScore(v float64) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldCvssScore, v))
+}
+
+// CvssVector applies equality check predicate on the "cvss_vector" field. It's identical to CvssVectorEQ.
+func CvssVector(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldCvssVector, v))
+}
+
+// FixedVersion applies equality check predicate on the "fixed_version" field. It's identical to FixedVersionEQ.
+func FixedVersion(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldFixedVersion, v))
+}
+
+// Summary applies equality check predicate on the "summary" field. It's identical to SummaryEQ.
+func Summary(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldSummary, v))
+}
+
+// Published applies equality check predicate on the "published" field. It's identical to PublishedEQ.
+func Published(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldPublished, v))
+}
+
+// DetectedAtEQ applies the EQ predicate on the "detected_at" field.
+func DetectedAtEQ(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldDetectedAt, v))
+}
+
+// DetectedAtNEQ applies the NEQ predicate on the "detected_at" field.
+func DetectedAtNEQ(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldDetectedAt, v))
+}
+
+// DetectedAtIn applies the In predicate on the "detected_at" field.
+func DetectedAtIn(vs ...time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldDetectedAt, vs...))
+}
+
+// DetectedAtNotIn applies the NotIn predicate on the "detected_at" field.
+func DetectedAtNotIn(vs ...time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldDetectedAt, vs...))
+}
+
+// DetectedAtGT applies the GT predicate on the "detected_at" field.
+func DetectedAtGT(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldDetectedAt, v))
+}
+
+// DetectedAtGTE applies the GTE predicate on the "detected_at" field.
+func DetectedAtGTE(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldDetectedAt, v))
+}
+
+// DetectedAtLT applies the LT predicate on the "detected_at" field.
+func DetectedAtLT(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldDetectedAt, v))
+}
+
+// DetectedAtLTE applies the LTE predicate on the "detected_at" field.
+func DetectedAtLTE(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldDetectedAt, v))
+}
+
+// FirstDetectedAtEQ applies the EQ predicate on the "first_detected_at" field.
+func FirstDetectedAtEQ(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldFirstDetectedAt, v))
+}
+
+// FirstDetectedAtNEQ applies the NEQ predicate on the "first_detected_at" field.
+func FirstDetectedAtNEQ(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldFirstDetectedAt, v))
+}
+
+// FirstDetectedAtIn applies the In predicate on the "first_detected_at" field.
+func FirstDetectedAtIn(vs ...time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldFirstDetectedAt, vs...))
+}
+
+// FirstDetectedAtNotIn applies the NotIn predicate on the "first_detected_at" field.
+func FirstDetectedAtNotIn(vs ...time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldFirstDetectedAt, vs...))
+}
+
+// FirstDetectedAtGT applies the GT predicate on the "first_detected_at" field.
+func FirstDetectedAtGT(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldFirstDetectedAt, v))
+}
+
+// FirstDetectedAtGTE applies the GTE predicate on the "first_detected_at" field.
+func FirstDetectedAtGTE(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldFirstDetectedAt, v))
+}
+
+// FirstDetectedAtLT applies the LT predicate on the "first_detected_at" field.
+func FirstDetectedAtLT(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldFirstDetectedAt, v))
+}
+
+// FirstDetectedAtLTE applies the LTE predicate on the "first_detected_at" field.
+func FirstDetectedAtLTE(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldFirstDetectedAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// AssigneeEQ applies the EQ predicate on the "assignee" field.
+func AssigneeEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldAssignee, v))
+}
+
+// AssigneeNEQ applies the NEQ predicate on the "assignee" field.
+func AssigneeNEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldAssignee, v))
+}
+
+// AssigneeIn applies the In predicate on the "assignee" field.
+func AssigneeIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldAssignee, vs...))
+}
+
+// AssigneeNotIn applies the NotIn predicate on the "assignee" field.
+func AssigneeNotIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldAssignee, vs...))
+}
+
+// AssigneeGT applies the GT predicate on the "assignee" field.
+func AssigneeGT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldAssignee, v))
+}
+
+// AssigneeGTE applies the GTE predicate on the "assignee" field.
+func AssigneeGTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldAssignee, v))
+}
+
+// AssigneeLT applies the LT predicate on the "assignee" field.
+func AssigneeLT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldAssignee, v))
+}
+
+// AssigneeLTE applies the LTE predicate on the "assignee" field.
+func AssigneeLTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldAssignee, v))
+}
+
+// AssigneeContains applies the Contains predicate on the "assignee" field.
+func AssigneeContains(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContains(FieldAssignee, v))
+}
+
+// AssigneeHasPrefix applies the HasPrefix predicate on the "assignee" field.
+func AssigneeHasPrefix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasPrefix(FieldAssignee, v))
+}
+
+// AssigneeHasSuffix applies the HasSuffix predicate on the "assignee" field.
+func AssigneeHasSuffix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasSuffix(FieldAssignee, v))
+}
+
+// AssigneeIsNil applies the IsNil predicate on the "assignee" field.
+func AssigneeIsNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIsNull(FieldAssignee))
+}
+
+// AssigneeNotNil applies the NotNil predicate on the "assignee" field.
+func AssigneeNotNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotNull(FieldAssignee))
+}
+
+// AssigneeEqualFold applies the EqualFold predicate on the "assignee" field.
+func AssigneeEqualFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEqualFold(FieldAssignee, v))
+}
+
+// AssigneeContainsFold applies the ContainsFold predicate on the "assignee" field.
+func AssigneeContainsFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContainsFold(FieldAssignee, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContainsFold(FieldNote, v))
+}
+
+// SuppressedUntilEQ applies the EQ predicate on the "suppressed_until" field.
+func SuppressedUntilEQ(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilNEQ applies the NEQ predicate on the "suppressed_until" field.
+func SuppressedUntilNEQ(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilIn applies the In predicate on the "suppressed_until" field.
+func SuppressedUntilIn(vs ...time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldSuppressedUntil, vs...))
+}
+
+// SuppressedUntilNotIn applies the NotIn predicate on the "suppressed_until" field.
+func SuppressedUntilNotIn(vs ...time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldSuppressedUntil, vs...))
+}
+
+// SuppressedUntilGT applies the GT predicate on the "suppressed_until" field.
+func SuppressedUntilGT(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilGTE applies the GTE predicate on the "suppressed_until" field.
+func SuppressedUntilGTE(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilLT applies the LT predicate on the "suppressed_until" field.
+func SuppressedUntilLT(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilLTE applies the LTE predicate on the "suppressed_until" field.
+func SuppressedUntilLTE(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldSuppressedUntil, v))
+}
+
+// SuppressedUntilIsNil applies the IsNil predicate on the "suppressed_until" field.
+func SuppressedUntilIsNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIsNull(FieldSuppressedUntil))
+}
+
+// SuppressedUntilNotNil applies the NotNil predicate on the "suppressed_until" field.
+func SuppressedUntilNotNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotNull(FieldSuppressedUntil))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// MachineIDEQ applies the EQ predicate on the "machine_id" field.
+func MachineIDEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldMachineID, v))
+}
+
+// MachineIDNEQ applies the NEQ predicate on the "machine_id" field.
+func MachineIDNEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldMachineID, v))
+}
+
+// MachineIDIn applies the In predicate on the "machine_id" field.
+func MachineIDIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldMachineID, vs...))
+}
+
+// MachineIDNotIn applies the NotIn predicate on the "machine_id" field.
+func MachineIDNotIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldMachineID, vs...))
+}
+
+// MachineIDGT applies the GT predicate on the "machine_id" field.
+func MachineIDGT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldMachineID, v))
+}
+
+// MachineIDGTE applies the GTE predicate on the "machine_id" field.
+func MachineIDGTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldMachineID, v))
+}
+
+// MachineIDLT applies the LT predicate on the "machine_id" field.
+func MachineIDLT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldMachineID, v))
+}
+
+// MachineIDLTE applies the LTE predicate on the "machine_id" field.
+func MachineIDLTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldMachineID, v))
+}
+
+// MachineIDContains applies the Contains predicate on the "machine_id" field.
+func MachineIDContains(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContains(FieldMachineID, v))
+}
+
+// MachineIDHasPrefix applies the HasPrefix predicate on the "machine_id" field.
+func MachineIDHasPrefix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasPrefix(FieldMachineID, v))
+}
+
+// MachineIDHasSuffix applies the HasSuffix predicate on the "machine_id" field.
+func MachineIDHasSuffix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasSuffix(FieldMachineID, v))
+}
+
+// MachineIDEqualFold applies the EqualFold predicate on the "machine_id" field.
+func MachineIDEqualFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEqualFold(FieldMachineID, v))
+}
+
+// MachineIDContainsFold applies the ContainsFold predicate on the "machine_id" field.
+func MachineIDContainsFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContainsFold(FieldMachineID, v))
+}
+
+// HostnameEQ applies the EQ predicate on the "hostname" field.
+func HostnameEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldHostname, v))
+}
+
+// HostnameNEQ applies the NEQ predicate on the "hostname" field.
+func HostnameNEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldHostname, v))
+}
+
+// HostnameIn applies the In predicate on the "hostname" field.
+func HostnameIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldHostname, vs...))
+}
+
+// HostnameNotIn applies the NotIn predicate on the "hostname" field.
+func HostnameNotIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldHostname, vs...))
+}
+
+// HostnameGT applies the GT predicate on the "hostname" field.
+func HostnameGT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldHostname, v))
+}
+
+// HostnameGTE applies the GTE predicate on the "hostname" field.
+func HostnameGTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldHostname, v))
+}
+
+// HostnameLT applies the LT predicate on the "hostname" field.
+func HostnameLT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldHostname, v))
+}
+
+// HostnameLTE applies the LTE predicate on the "hostname" field.
+func HostnameLTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldHostname, v))
+}
+
+// HostnameContains applies the Contains predicate on the "hostname" field.
+func HostnameContains(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContains(FieldHostname, v))
+}
+
+// HostnameHasPrefix applies the HasPrefix predicate on the "hostname" field.
+func HostnameHasPrefix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasPrefix(FieldHostname, v))
+}
+
+// HostnameHasSuffix applies the HasSuffix predicate on the "hostname" field.
+func HostnameHasSuffix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasSuffix(FieldHostname, v))
+}
+
+// HostnameIsNil applies the IsNil predicate on the "hostname" field.
+func HostnameIsNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIsNull(FieldHostname))
+}
+
+// HostnameNotNil applies the NotNil predicate on the "hostname" field.
+func HostnameNotNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotNull(FieldHostname))
+}
+
+// HostnameEqualFold applies the EqualFold predicate on the "hostname" field.
+func HostnameEqualFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEqualFold(FieldHostname, v))
+}
+
+// HostnameContainsFold applies the ContainsFold predicate on the "hostname" field.
+func HostnameContainsFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContainsFold(FieldHostname, v))
+}
+
+// PackageNameEQ applies the EQ predicate on the "package_name" field.
+func PackageNameEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldPackageName, v))
+}
+
+// PackageNameNEQ applies the NEQ predicate on the "package_name" field.
+func PackageNameNEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldPackageName, v))
+}
+
+// PackageNameIn applies the In predicate on the "package_name" field.
+func PackageNameIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldPackageName, vs...))
+}
+
+// PackageNameNotIn applies the NotIn predicate on the "package_name" field.
+func PackageNameNotIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldPackageName, vs...))
+}
+
+// PackageNameGT applies the GT predicate on the "package_name" field.
+func PackageNameGT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldPackageName, v))
+}
+
+// PackageNameGTE applies the GTE predicate on the "package_name" field.
+func PackageNameGTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldPackageName, v))
+}
+
+// PackageNameLT applies the LT predicate on the "package_name" field.
+func PackageNameLT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldPackageName, v))
+}
+
+// PackageNameLTE applies the LTE predicate on the "package_name" field.
+func PackageNameLTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldPackageName, v))
+}
+
+// PackageNameContains applies the Contains predicate on the "package_name" field.
+func PackageNameContains(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContains(FieldPackageName, v))
+}
+
+// PackageNameHasPrefix applies the HasPrefix predicate on the "package_name" field.
+func PackageNameHasPrefix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasPrefix(FieldPackageName, v))
+}
+
+// PackageNameHasSuffix applies the HasSuffix predicate on the "package_name" field.
+func PackageNameHasSuffix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasSuffix(FieldPackageName, v))
+}
+
+// PackageNameEqualFold applies the EqualFold predicate on the "package_name" field.
+func PackageNameEqualFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEqualFold(FieldPackageName, v))
+}
+
+// PackageNameContainsFold applies the ContainsFold predicate on the "package_name" field.
+func PackageNameContainsFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContainsFold(FieldPackageName, v))
+}
+
+// PackageVersionEQ applies the EQ predicate on the "package_version" field.
+func PackageVersionEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldPackageVersion, v))
+}
+
+// PackageVersionNEQ applies the NEQ predicate on the "package_version" field.
+func PackageVersionNEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldPackageVersion, v))
+}
+
+// PackageVersionIn applies the In predicate on the "package_version" field.
+func PackageVersionIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldPackageVersion, vs...))
+}
+
+// PackageVersionNotIn applies the NotIn predicate on the "package_version" field.
+func PackageVersionNotIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldPackageVersion, vs...))
+}
+
+// PackageVersionGT applies the GT predicate on the "package_version" field.
+func PackageVersionGT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldPackageVersion, v))
+}
+
+// PackageVersionGTE applies the GTE predicate on the "package_version" field.
+func PackageVersionGTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldPackageVersion, v))
+}
+
+// PackageVersionLT applies the LT predicate on the "package_version" field.
+func PackageVersionLT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldPackageVersion, v))
+}
+
+// PackageVersionLTE applies the LTE predicate on the "package_version" field.
+func PackageVersionLTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldPackageVersion, v))
+}
+
+// PackageVersionContains applies the Contains predicate on the "package_version" field.
+func PackageVersionContains(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContains(FieldPackageVersion, v))
+}
+
+// PackageVersionHasPrefix applies the HasPrefix predicate on the "package_version" field.
+func PackageVersionHasPrefix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasPrefix(FieldPackageVersion, v))
+}
+
+// PackageVersionHasSuffix applies the HasSuffix predicate on the "package_version" field.
+func PackageVersionHasSuffix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasSuffix(FieldPackageVersion, v))
+}
+
+// PackageVersionEqualFold applies the EqualFold predicate on the "package_version" field.
+func PackageVersionEqualFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEqualFold(FieldPackageVersion, v))
+}
+
+// PackageVersionContainsFold applies the ContainsFold predicate on the "package_version" field.
+func PackageVersionContainsFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContainsFold(FieldPackageVersion, v))
+}
+
+// VulnIDEQ applies the EQ predicate on the "vuln_id" field.
+func VulnIDEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldVulnID, v))
+}
+
+// VulnIDNEQ applies the NEQ predicate on the "vuln_id" field.
+func VulnIDNEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldVulnID, v))
+}
+
+// VulnIDIn applies the In predicate on the "vuln_id" field.
+func VulnIDIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldVulnID, vs...))
+}
+
+// VulnIDNotIn applies the NotIn predicate on the "vuln_id" field.
+func VulnIDNotIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldVulnID, vs...))
+}
+
+// VulnIDGT applies the GT predicate on the "vuln_id" field.
+func VulnIDGT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldVulnID, v))
+}
+
+// VulnIDGTE applies the GTE predicate on the "vuln_id" field.
+func VulnIDGTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldVulnID, v))
+}
+
+// VulnIDLT applies the LT predicate on the "vuln_id" field.
+func VulnIDLT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldVulnID, v))
+}
+
+// VulnIDLTE applies the LTE predicate on the "vuln_id" field.
+func VulnIDLTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldVulnID, v))
+}
+
+// VulnIDContains applies the Contains predicate on the "vuln_id" field.
+func VulnIDContains(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContains(FieldVulnID, v))
+}
+
+// VulnIDHasPrefix applies the HasPrefix predicate on the "vuln_id" field.
+func VulnIDHasPrefix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasPrefix(FieldVulnID, v))
+}
+
+// VulnIDHasSuffix applies the HasSuffix predicate on the "vuln_id" field.
+func VulnIDHasSuffix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasSuffix(FieldVulnID, v))
+}
+
+// VulnIDEqualFold applies the EqualFold predicate on the "vuln_id" field.
+func VulnIDEqualFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEqualFold(FieldVulnID, v))
+}
+
+// VulnIDContainsFold applies the ContainsFold predicate on the "vuln_id" field.
+func VulnIDContainsFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContainsFold(FieldVulnID, v))
+}
+
+// AliasesIsNil applies the IsNil predicate on the "aliases" field.
+func AliasesIsNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIsNull(FieldAliases))
+}
+
+// AliasesNotNil applies the NotNil predicate on the "aliases" field.
+func AliasesNotNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotNull(FieldAliases))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContainsFold(FieldSource, v))
+}
+
+// EcosystemEQ applies the EQ predicate on the "ecosystem" field.
+func EcosystemEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldEcosystem, v))
+}
+
+// EcosystemNEQ applies the NEQ predicate on the "ecosystem" field.
+func EcosystemNEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldEcosystem, v))
+}
+
+// EcosystemIn applies the In predicate on the "ecosystem" field.
+func EcosystemIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldEcosystem, vs...))
+}
+
+// EcosystemNotIn applies the NotIn predicate on the "ecosystem" field.
+func EcosystemNotIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldEcosystem, vs...))
+}
+
+// EcosystemGT applies the GT predicate on the "ecosystem" field.
+func EcosystemGT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldEcosystem, v))
+}
+
+// EcosystemGTE applies the GTE predicate on the "ecosystem" field.
+func EcosystemGTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldEcosystem, v))
+}
+
+// EcosystemLT applies the LT predicate on the "ecosystem" field.
+func EcosystemLT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldEcosystem, v))
+}
+
+// EcosystemLTE applies the LTE predicate on the "ecosystem" field.
+func EcosystemLTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldEcosystem, v))
+}
+
+// EcosystemContains applies the Contains predicate on the "ecosystem" field.
+func EcosystemContains(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContains(FieldEcosystem, v))
+}
+
+// EcosystemHasPrefix applies the HasPrefix predicate on the "ecosystem" field.
+func EcosystemHasPrefix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasPrefix(FieldEcosystem, v))
+}
+
+// EcosystemHasSuffix applies the HasSuffix predicate on the "ecosystem" field.
+func EcosystemHasSuffix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasSuffix(FieldEcosystem, v))
+}
+
+// EcosystemIsNil applies the IsNil predicate on the "ecosystem" field.
+func EcosystemIsNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIsNull(FieldEcosystem))
+}
+
+// EcosystemNotNil applies the NotNil predicate on the "ecosystem" field.
+func EcosystemNotNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotNull(FieldEcosystem))
+}
+
+// EcosystemEqualFold applies the EqualFold predicate on the "ecosystem" field.
+func EcosystemEqualFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEqualFold(FieldEcosystem, v))
+}
+
+// EcosystemContainsFold applies the ContainsFold predicate on the "ecosystem" field.
+func EcosystemContainsFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContainsFold(FieldEcosystem, v))
+}
+
+// VersionSchemeEQ applies the EQ predicate on the "version_scheme" field.
+func VersionSchemeEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldVersionScheme, v))
+}
+
+// VersionSchemeNEQ applies the NEQ predicate on the "version_scheme" field.
+func VersionSchemeNEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldVersionScheme, v))
+}
+
+// VersionSchemeIn applies the In predicate on the "version_scheme" field.
+func VersionSchemeIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldVersionScheme, vs...))
+}
+
+// VersionSchemeNotIn applies the NotIn predicate on the "version_scheme" field.
+func VersionSchemeNotIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldVersionScheme, vs...))
+}
+
+// VersionSchemeGT applies the GT predicate on the "version_scheme" field.
+func VersionSchemeGT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldVersionScheme, v))
+}
+
+// VersionSchemeGTE applies the GTE predicate on the "version_scheme" field.
+func VersionSchemeGTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldVersionScheme, v))
+}
+
+// VersionSchemeLT applies the LT predicate on the "version_scheme" field.
+func VersionSchemeLT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldVersionScheme, v))
+}
+
+// VersionSchemeLTE applies the LTE predicate on the "version_scheme" field.
+func VersionSchemeLTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldVersionScheme, v))
+}
+
+// VersionSchemeContains applies the Contains predicate on the "version_scheme" field.
+func VersionSchemeContains(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContains(FieldVersionScheme, v))
+}
+
+// VersionSchemeHasPrefix applies the HasPrefix predicate on the "version_scheme" field.
+func VersionSchemeHasPrefix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasPrefix(FieldVersionScheme, v))
+}
+
+// VersionSchemeHasSuffix applies the HasSuffix predicate on the "version_scheme" field.
+func VersionSchemeHasSuffix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasSuffix(FieldVersionScheme, v))
+}
+
+// VersionSchemeEqualFold applies the EqualFold predicate on the "version_scheme" field.
+func VersionSchemeEqualFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEqualFold(FieldVersionScheme, v))
+}
+
+// VersionSchemeContainsFold applies the ContainsFold predicate on the "version_scheme" field.
+func VersionSchemeContainsFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContainsFold(FieldVersionScheme, v))
+}
+
+// SeverityEQ applies the EQ predicate on the "severity" field.
+func SeverityEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldSeverity, v))
+}
+
+// SeverityNEQ applies the NEQ predicate on the "severity" field.
+func SeverityNEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldSeverity, v))
+}
+
+// SeverityIn applies the In predicate on the "severity" field.
+func SeverityIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldSeverity, vs...))
+}
+
+// SeverityNotIn applies the NotIn predicate on the "severity" field.
+func SeverityNotIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldSeverity, vs...))
+}
+
+// SeverityGT applies the GT predicate on the "severity" field.
+func SeverityGT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldSeverity, v))
+}
+
+// SeverityGTE applies the GTE predicate on the "severity" field.
+func SeverityGTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldSeverity, v))
+}
+
+// SeverityLT applies the LT predicate on the "severity" field.
+func SeverityLT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldSeverity, v))
+}
+
+// SeverityLTE applies the LTE predicate on the "severity" field.
+func SeverityLTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldSeverity, v))
+}
+
+// SeverityContains applies the Contains predicate on the "severity" field.
+func SeverityContains(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContains(FieldSeverity, v))
+}
+
+// SeverityHasPrefix applies the HasPrefix predicate on the "severity" field.
+func SeverityHasPrefix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasPrefix(FieldSeverity, v))
+}
+
+// SeverityHasSuffix applies the HasSuffix predicate on the "severity" field.
+func SeverityHasSuffix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasSuffix(FieldSeverity, v))
+}
+
+// SeverityEqualFold applies the EqualFold predicate on the "severity" field.
+func SeverityEqualFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEqualFold(FieldSeverity, v))
+}
+
+// SeverityContainsFold applies the ContainsFold predicate on the "severity" field.
+func SeverityContainsFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContainsFold(FieldSeverity, v))
+}
+
+// CvssScoreEQ applies the EQ predicate on the "cvss_score" field.
+func CvssScoreEQ(v float64) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldCvssScore, v))
+}
+
+// CvssScoreNEQ applies the NEQ predicate on the "cvss_score" field.
+func CvssScoreNEQ(v float64) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldCvssScore, v))
+}
+
+// CvssScoreIn applies the In predicate on the "cvss_score" field.
+func CvssScoreIn(vs ...float64) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldCvssScore, vs...))
+}
+
+// CvssScoreNotIn applies the NotIn predicate on the "cvss_score" field.
+func CvssScoreNotIn(vs ...float64) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldCvssScore, vs...))
+}
+
+// CvssScoreGT applies the GT predicate on the "cvss_score" field.
+func CvssScoreGT(v float64) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldCvssScore, v))
+}
+
+// CvssScoreGTE applies the GTE predicate on the "cvss_score" field.
+func CvssScoreGTE(v float64) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldCvssScore, v))
+}
+
+// CvssScoreLT applies the LT predicate on the "cvss_score" field.
+func CvssScoreLT(v float64) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldCvssScore, v))
+}
+
+// CvssScoreLTE applies the LTE predicate on the "cvss_score" field.
+func CvssScoreLTE(v float64) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldCvssScore, v))
+}
+
+// CvssScoreIsNil applies the IsNil predicate on the "cvss_score" field.
+func CvssScoreIsNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIsNull(FieldCvssScore))
+}
+
+// CvssScoreNotNil applies the NotNil predicate on the "cvss_score" field.
+func CvssScoreNotNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotNull(FieldCvssScore))
+}
+
+// CvssVectorEQ applies the EQ predicate on the "cvss_vector" field.
+func CvssVectorEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldCvssVector, v))
+}
+
+// CvssVectorNEQ applies the NEQ predicate on the "cvss_vector" field.
+func CvssVectorNEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldCvssVector, v))
+}
+
+// CvssVectorIn applies the In predicate on the "cvss_vector" field.
+func CvssVectorIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldCvssVector, vs...))
+}
+
+// CvssVectorNotIn applies the NotIn predicate on the "cvss_vector" field.
+func CvssVectorNotIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldCvssVector, vs...))
+}
+
+// CvssVectorGT applies the GT predicate on the "cvss_vector" field.
+func CvssVectorGT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldCvssVector, v))
+}
+
+// CvssVectorGTE applies the GTE predicate on the "cvss_vector" field.
+func CvssVectorGTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldCvssVector, v))
+}
+
+// CvssVectorLT applies the LT predicate on the "cvss_vector" field.
+func CvssVectorLT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldCvssVector, v))
+}
+
+// CvssVectorLTE applies the LTE predicate on the "cvss_vector" field.
+func CvssVectorLTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldCvssVector, v))
+}
+
+// CvssVectorContains applies the Contains predicate on the "cvss_vector" field.
+func CvssVectorContains(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContains(FieldCvssVector, v))
+}
+
+// CvssVectorHasPrefix applies the HasPrefix predicate on the "cvss_vector" field.
+func CvssVectorHasPrefix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasPrefix(FieldCvssVector, v))
+}
+
+// CvssVectorHasSuffix applies the HasSuffix predicate on the "cvss_vector" field.
+func CvssVectorHasSuffix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasSuffix(FieldCvssVector, v))
+}
+
+// CvssVectorIsNil applies the IsNil predicate on the "cvss_vector" field.
+func CvssVectorIsNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIsNull(FieldCvssVector))
+}
+
+// CvssVectorNotNil applies the NotNil predicate on the "cvss_vector" field.
+func CvssVectorNotNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotNull(FieldCvssVector))
+}
+
+// CvssVectorEqualFold applies the EqualFold predicate on the "cvss_vector" field.
+func CvssVectorEqualFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEqualFold(FieldCvssVector, v))
+}
+
+// CvssVectorContainsFold applies the ContainsFold predicate on the "cvss_vector" field.
+func CvssVectorContainsFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContainsFold(FieldCvssVector, v))
+}
+
+// FixedVersionEQ applies the EQ predicate on the "fixed_version" field.
+func FixedVersionEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldFixedVersion, v))
+}
+
+// FixedVersionNEQ applies the NEQ predicate on the "fixed_version" field.
+func FixedVersionNEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldFixedVersion, v))
+}
+
+// FixedVersionIn applies the In predicate on the "fixed_version" field.
+func FixedVersionIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldFixedVersion, vs...))
+}
+
+// FixedVersionNotIn applies the NotIn predicate on the "fixed_version" field.
+func FixedVersionNotIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldFixedVersion, vs...))
+}
+
+// FixedVersionGT applies the GT predicate on the "fixed_version" field.
+func FixedVersionGT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldFixedVersion, v))
+}
+
+// FixedVersionGTE applies the GTE predicate on the "fixed_version" field.
+func FixedVersionGTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldFixedVersion, v))
+}
+
+// FixedVersionLT applies the LT predicate on the "fixed_version" field.
+func FixedVersionLT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldFixedVersion, v))
+}
+
+// FixedVersionLTE applies the LTE predicate on the "fixed_version" field.
+func FixedVersionLTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldFixedVersion, v))
+}
+
+// FixedVersionContains applies the Contains predicate on the "fixed_version" field.
+func FixedVersionContains(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContains(FieldFixedVersion, v))
+}
+
+// FixedVersionHasPrefix applies the HasPrefix predicate on the "fixed_version" field.
+func FixedVersionHasPrefix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasPrefix(FieldFixedVersion, v))
+}
+
+// FixedVersionHasSuffix applies the HasSuffix predicate on the "fixed_version" field.
+func FixedVersionHasSuffix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasSuffix(FieldFixedVersion, v))
+}
+
+// FixedVersionIsNil applies the IsNil predicate on the "fixed_version" field.
+func FixedVersionIsNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIsNull(FieldFixedVersion))
+}
+
+// FixedVersionNotNil applies the NotNil predicate on the "fixed_version" field.
+func FixedVersionNotNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotNull(FieldFixedVersion))
+}
+
+// FixedVersionEqualFold applies the EqualFold predicate on the "fixed_version" field.
+func FixedVersionEqualFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEqualFold(FieldFixedVersion, v))
+}
+
+// FixedVersionContainsFold applies the ContainsFold predicate on the "fixed_version" field.
+func FixedVersionContainsFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContainsFold(FieldFixedVersion, v))
+}
+
+// SummaryEQ applies the EQ predicate on the "summary" field.
+func SummaryEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldSummary, v))
+}
+
+// SummaryNEQ applies the NEQ predicate on the "summary" field.
+func SummaryNEQ(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldSummary, v))
+}
+
+// SummaryIn applies the In predicate on the "summary" field.
+func SummaryIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldSummary, vs...))
+}
+
+// SummaryNotIn applies the NotIn predicate on the "summary" field.
+func SummaryNotIn(vs ...string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldSummary, vs...))
+}
+
+// SummaryGT applies the GT predicate on the "summary" field.
+func SummaryGT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldSummary, v))
+}
+
+// SummaryGTE applies the GTE predicate on the "summary" field.
+func SummaryGTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldSummary, v))
+}
+
+// SummaryLT applies the LT predicate on the "summary" field.
+func SummaryLT(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldSummary, v))
+}
+
+// SummaryLTE applies the LTE predicate on the "summary" field.
+func SummaryLTE(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldSummary, v))
+}
+
+// SummaryContains applies the Contains predicate on the "summary" field.
+func SummaryContains(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContains(FieldSummary, v))
+}
+
+// SummaryHasPrefix applies the HasPrefix predicate on the "summary" field.
+func SummaryHasPrefix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasPrefix(FieldSummary, v))
+}
+
+// SummaryHasSuffix applies the HasSuffix predicate on the "summary" field.
+func SummaryHasSuffix(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldHasSuffix(FieldSummary, v))
+}
+
+// SummaryIsNil applies the IsNil predicate on the "summary" field.
+func SummaryIsNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIsNull(FieldSummary))
+}
+
+// SummaryNotNil applies the NotNil predicate on the "summary" field.
+func SummaryNotNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotNull(FieldSummary))
+}
+
+// SummaryEqualFold applies the EqualFold predicate on the "summary" field.
+func SummaryEqualFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEqualFold(FieldSummary, v))
+}
+
+// SummaryContainsFold applies the ContainsFold predicate on the "summary" field.
+func SummaryContainsFold(v string) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldContainsFold(FieldSummary, v))
+}
+
+// PublishedEQ applies the EQ predicate on the "published" field.
+func PublishedEQ(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldEQ(FieldPublished, v))
+}
+
+// PublishedNEQ applies the NEQ predicate on the "published" field.
+func PublishedNEQ(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNEQ(FieldPublished, v))
+}
+
+// PublishedIn applies the In predicate on the "published" field.
+func PublishedIn(vs ...time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIn(FieldPublished, vs...))
+}
+
+// PublishedNotIn applies the NotIn predicate on the "published" field.
+func PublishedNotIn(vs ...time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotIn(FieldPublished, vs...))
+}
+
+// PublishedGT applies the GT predicate on the "published" field.
+func PublishedGT(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGT(FieldPublished, v))
+}
+
+// PublishedGTE applies the GTE predicate on the "published" field.
+func PublishedGTE(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldGTE(FieldPublished, v))
+}
+
+// PublishedLT applies the LT predicate on the "published" field.
+func PublishedLT(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLT(FieldPublished, v))
+}
+
+// PublishedLTE applies the LTE predicate on the "published" field.
+func PublishedLTE(v time.Time) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldLTE(FieldPublished, v))
+}
+
+// PublishedIsNil applies the IsNil predicate on the "published" field.
+func PublishedIsNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldIsNull(FieldPublished))
+}
+
+// PublishedNotNil applies the NotNil predicate on the "published" field.
+func PublishedNotNil() predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.FieldNotNull(FieldPublished))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GoldVulnerabilityFinding) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GoldVulnerabilityFinding) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GoldVulnerabilityFinding) predicate.GoldVulnerabilityFinding {
+	return predicate.GoldVulnerabilityFinding(sql.NotPredicates(p))
+}
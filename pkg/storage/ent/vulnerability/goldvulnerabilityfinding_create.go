@@ -0,0 +1,592 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vulnerability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/vulnerability/goldvulnerabilityfinding"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GoldVulnerabilityFindingCreate is the builder for creating a GoldVulnerabilityFinding entity.
+type GoldVulnerabilityFindingCreate struct {
+	config
+	mutation *GoldVulnerabilityFindingMutation
+	hooks    []Hook
+}
+
+// SetDetectedAt sets the "detected_at" field.
+func (_c *GoldVulnerabilityFindingCreate) SetDetectedAt(v time.Time) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetDetectedAt(v)
+	return _c
+}
+
+// SetFirstDetectedAt sets the "first_detected_at" field.
+func (_c *GoldVulnerabilityFindingCreate) SetFirstDetectedAt(v time.Time) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetFirstDetectedAt(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *GoldVulnerabilityFindingCreate) SetStatus(v string) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *GoldVulnerabilityFindingCreate) SetNillableStatus(v *string) *GoldVulnerabilityFindingCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAssignee sets the "assignee" field.
+func (_c *GoldVulnerabilityFindingCreate) SetAssignee(v string) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetAssignee(v)
+	return _c
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_c *GoldVulnerabilityFindingCreate) SetNillableAssignee(v *string) *GoldVulnerabilityFindingCreate {
+	if v != nil {
+		_c.SetAssignee(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *GoldVulnerabilityFindingCreate) SetNote(v string) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *GoldVulnerabilityFindingCreate) SetNillableNote(v *string) *GoldVulnerabilityFindingCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (_c *GoldVulnerabilityFindingCreate) SetSuppressedUntil(v time.Time) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetSuppressedUntil(v)
+	return _c
+}
+
+// SetNillableSuppressedUntil sets the "suppressed_until" field if the given value is not nil.
+func (_c *GoldVulnerabilityFindingCreate) SetNillableSuppressedUntil(v *time.Time) *GoldVulnerabilityFindingCreate {
+	if v != nil {
+		_c.SetSuppressedUntil(*v)
+	}
+	return _c
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_c *GoldVulnerabilityFindingCreate) SetResolvedAt(v time.Time) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetResolvedAt(v)
+	return _c
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_c *GoldVulnerabilityFindingCreate) SetNillableResolvedAt(v *time.Time) *GoldVulnerabilityFindingCreate {
+	if v != nil {
+		_c.SetResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *GoldVulnerabilityFindingCreate) SetLastSeenAt(v time.Time) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_c *GoldVulnerabilityFindingCreate) SetMachineID(v string) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetMachineID(v)
+	return _c
+}
+
+// SetHostname sets the "hostname" field.
+func (_c *GoldVulnerabilityFindingCreate) SetHostname(v string) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetHostname(v)
+	return _c
+}
+
+// SetNillableHostname sets the "hostname" field if the given value is not nil.
+func (_c *GoldVulnerabilityFindingCreate) SetNillableHostname(v *string) *GoldVulnerabilityFindingCreate {
+	if v != nil {
+		_c.SetHostname(*v)
+	}
+	return _c
+}
+
+// SetPackageName sets the "package_name" field.
+func (_c *GoldVulnerabilityFindingCreate) SetPackageName(v string) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetPackageName(v)
+	return _c
+}
+
+// SetPackageVersion sets the "package_version" field.
+func (_c *GoldVulnerabilityFindingCreate) SetPackageVersion(v string) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetPackageVersion(v)
+	return _c
+}
+
+// SetVulnID sets the "vuln_id" field.
+func (_c *GoldVulnerabilityFindingCreate) SetVulnID(v string) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetVulnID(v)
+	return _c
+}
+
+// SetAliases sets the "aliases" field.
+func (_c *GoldVulnerabilityFindingCreate) SetAliases(v []string) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetAliases(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *GoldVulnerabilityFindingCreate) SetSource(v string) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetEcosystem sets the "ecosystem" field.
+func (_c *GoldVulnerabilityFindingCreate) SetEcosystem(v string) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetEcosystem(v)
+	return _c
+}
+
+// SetNillableEcosystem sets the "ecosystem" field if the given value is not nil.
+func (_c *GoldVulnerabilityFindingCreate) SetNillableEcosystem(v *string) *GoldVulnerabilityFindingCreate {
+	if v != nil {
+		_c.SetEcosystem(*v)
+	}
+	return _c
+}
+
+// SetVersionScheme sets the "version_scheme" field.
+func (_c *GoldVulnerabilityFindingCreate) SetVersionScheme(v string) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetVersionScheme(v)
+	return _c
+}
+
+// SetSeverity sets the "severity" field.
+func (_c *GoldVulnerabilityFindingCreate) SetSeverity(v string) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetSeverity(v)
+	return _c
+}
+
+// SetCvssScore sets the "cvss_score" field.
+func (_c *GoldVulnerabilityFindingCreate) SetCvssScore(v float64) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetCvssScore(v)
+	return _c
+}
+
+// SetNillableCvssScore sets the "cvss_score" field if the given value is not nil.
+func (_c *GoldVulnerabilityFindingCreate) SetNillableCvssScore(v *float64) *GoldVulnerabilityFindingCreate {
+	if v != nil {
+		_c.SetCvssScore(*v)
+	}
+	return _c
+}
+
+// SetCvssVector sets the "cvss_vector" field.
+func (_c *GoldVulnerabilityFindingCreate) SetCvssVector(v string) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetCvssVector(v)
+	return _c
+}
+
+// SetNillableCvssVector sets the "cvss_vector" field if the given value is not nil.
+func (_c *GoldVulnerabilityFindingCreate) SetNillableCvssVector(v *string) *GoldVulnerabilityFindingCreate {
+	if v != nil {
+		_c.SetCvssVector(*v)
+	}
+	return _c
+}
+
+// SetFixedVersion sets the "fixed_version" field.
+func (_c *GoldVulnerabilityFindingCreate) SetFixedVersion(v string) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetFixedVersion(v)
+	return _c
+}
+
+// SetNillableFixedVersion sets the "fixed_version" field if the given value is not nil.
+func (_c *GoldVulnerabilityFindingCreate) SetNillableFixedVersion(v *string) *GoldVulnerabilityFindingCreate {
+	if v != nil {
+		_c.SetFixedVersion(*v)
+	}
+	return _c
+}
+
+// SetSummary sets the "summary" field.
+func (_c *GoldVulnerabilityFindingCreate) SetSummary(v string) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetSummary(v)
+	return _c
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_c *GoldVulnerabilityFindingCreate) SetNillableSummary(v *string) *GoldVulnerabilityFindingCreate {
+	if v != nil {
+		_c.SetSummary(*v)
+	}
+	return _c
+}
+
+// SetPublished sets the "published" field.
+func (_c *GoldVulnerabilityFindingCreate) SetPublished(v time.Time) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetPublished(v)
+	return _c
+}
+
+// SetNillablePublished sets the "published" field if the given value is not nil.
+func (_c *GoldVulnerabilityFindingCreate) SetNillablePublished(v *time.Time) *GoldVulnerabilityFindingCreate {
+	if v != nil {
+		_c.SetPublished(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GoldVulnerabilityFindingCreate) SetID(v string) *GoldVulnerabilityFindingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the GoldVulnerabilityFindingMutation object of the builder.
+func (_c *GoldVulnerabilityFindingCreate) Mutation() *GoldVulnerabilityFindingMutation {
+	return _c.mutation
+}
+
+// Save creates the GoldVulnerabilityFinding in the database.
+func (_c *GoldVulnerabilityFindingCreate) Save(ctx context.Context) (*GoldVulnerabilityFinding, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GoldVulnerabilityFindingCreate) SaveX(ctx context.Context) *GoldVulnerabilityFinding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoldVulnerabilityFindingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoldVulnerabilityFindingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GoldVulnerabilityFindingCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := goldvulnerabilityfinding.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GoldVulnerabilityFindingCreate) check() error {
+	if _, ok := _c.mutation.DetectedAt(); !ok {
+		return &ValidationError{Name: "detected_at", err: errors.New(`vulnerability: missing required field "GoldVulnerabilityFinding.detected_at"`)}
+	}
+	if _, ok := _c.mutation.FirstDetectedAt(); !ok {
+		return &ValidationError{Name: "first_detected_at", err: errors.New(`vulnerability: missing required field "GoldVulnerabilityFinding.first_detected_at"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`vulnerability: missing required field "GoldVulnerabilityFinding.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := goldvulnerabilityfinding.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LastSeenAt(); !ok {
+		return &ValidationError{Name: "last_seen_at", err: errors.New(`vulnerability: missing required field "GoldVulnerabilityFinding.last_seen_at"`)}
+	}
+	if _, ok := _c.mutation.MachineID(); !ok {
+		return &ValidationError{Name: "machine_id", err: errors.New(`vulnerability: missing required field "GoldVulnerabilityFinding.machine_id"`)}
+	}
+	if v, ok := _c.mutation.MachineID(); ok {
+		if err := goldvulnerabilityfinding.MachineIDValidator(v); err != nil {
+			return &ValidationError{Name: "machine_id", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.machine_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PackageName(); !ok {
+		return &ValidationError{Name: "package_name", err: errors.New(`vulnerability: missing required field "GoldVulnerabilityFinding.package_name"`)}
+	}
+	if v, ok := _c.mutation.PackageName(); ok {
+		if err := goldvulnerabilityfinding.PackageNameValidator(v); err != nil {
+			return &ValidationError{Name: "package_name", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.package_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PackageVersion(); !ok {
+		return &ValidationError{Name: "package_version", err: errors.New(`vulnerability: missing required field "GoldVulnerabilityFinding.package_version"`)}
+	}
+	if v, ok := _c.mutation.PackageVersion(); ok {
+		if err := goldvulnerabilityfinding.PackageVersionValidator(v); err != nil {
+			return &ValidationError{Name: "package_version", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.package_version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.VulnID(); !ok {
+		return &ValidationError{Name: "vuln_id", err: errors.New(`vulnerability: missing required field "GoldVulnerabilityFinding.vuln_id"`)}
+	}
+	if v, ok := _c.mutation.VulnID(); ok {
+		if err := goldvulnerabilityfinding.VulnIDValidator(v); err != nil {
+			return &ValidationError{Name: "vuln_id", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.vuln_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`vulnerability: missing required field "GoldVulnerabilityFinding.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := goldvulnerabilityfinding.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.VersionScheme(); !ok {
+		return &ValidationError{Name: "version_scheme", err: errors.New(`vulnerability: missing required field "GoldVulnerabilityFinding.version_scheme"`)}
+	}
+	if v, ok := _c.mutation.VersionScheme(); ok {
+		if err := goldvulnerabilityfinding.VersionSchemeValidator(v); err != nil {
+			return &ValidationError{Name: "version_scheme", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.version_scheme": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Severity(); !ok {
+		return &ValidationError{Name: "severity", err: errors.New(`vulnerability: missing required field "GoldVulnerabilityFinding.severity"`)}
+	}
+	if v, ok := _c.mutation.Severity(); ok {
+		if err := goldvulnerabilityfinding.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.severity": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *GoldVulnerabilityFindingCreate) sqlSave(ctx context.Context) (*GoldVulnerabilityFinding, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected GoldVulnerabilityFinding.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GoldVulnerabilityFindingCreate) createSpec() (*GoldVulnerabilityFinding, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GoldVulnerabilityFinding{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(goldvulnerabilityfinding.Table, sqlgraph.NewFieldSpec(goldvulnerabilityfinding.FieldID, field.TypeString))
+	)
+	_spec.Schema = _c.schemaConfig.GoldVulnerabilityFinding
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.DetectedAt(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldDetectedAt, field.TypeTime, value)
+		_node.DetectedAt = value
+	}
+	if value, ok := _c.mutation.FirstDetectedAt(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldFirstDetectedAt, field.TypeTime, value)
+		_node.FirstDetectedAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Assignee(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldAssignee, field.TypeString, value)
+		_node.Assignee = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.SuppressedUntil(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldSuppressedUntil, field.TypeTime, value)
+		_node.SuppressedUntil = &value
+	}
+	if value, ok := _c.mutation.ResolvedAt(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = value
+	}
+	if value, ok := _c.mutation.MachineID(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldMachineID, field.TypeString, value)
+		_node.MachineID = value
+	}
+	if value, ok := _c.mutation.Hostname(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldHostname, field.TypeString, value)
+		_node.Hostname = value
+	}
+	if value, ok := _c.mutation.PackageName(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldPackageName, field.TypeString, value)
+		_node.PackageName = value
+	}
+	if value, ok := _c.mutation.PackageVersion(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldPackageVersion, field.TypeString, value)
+		_node.PackageVersion = value
+	}
+	if value, ok := _c.mutation.VulnID(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldVulnID, field.TypeString, value)
+		_node.VulnID = value
+	}
+	if value, ok := _c.mutation.Aliases(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldAliases, field.TypeJSON, value)
+		_node.Aliases = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.Ecosystem(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldEcosystem, field.TypeString, value)
+		_node.Ecosystem = value
+	}
+	if value, ok := _c.mutation.VersionScheme(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldVersionScheme, field.TypeString, value)
+		_node.VersionScheme = value
+	}
+	if value, ok := _c.mutation.Severity(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldSeverity, field.TypeString, value)
+		_node.Severity = value
+	}
+	if value, ok := _c.mutation.CvssScore(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldCvssScore, field.TypeFloat64, value)
+		_node.CvssScore = &value
+	}
+	if value, ok := _c.mutation.CvssVector(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldCvssVector, field.TypeString, value)
+		_node.CvssVector = value
+	}
+	if value, ok := _c.mutation.FixedVersion(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldFixedVersion, field.TypeString, value)
+		_node.FixedVersion = value
+	}
+	if value, ok := _c.mutation.Summary(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldSummary, field.TypeString, value)
+		_node.Summary = value
+	}
+	if value, ok := _c.mutation.Published(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldPublished, field.TypeTime, value)
+		_node.Published = &value
+	}
+	return _node, _spec
+}
+
+// GoldVulnerabilityFindingCreateBulk is the builder for creating many GoldVulnerabilityFinding entities in bulk.
+type GoldVulnerabilityFindingCreateBulk struct {
+	config
+	err      error
+	builders []*GoldVulnerabilityFindingCreate
+}
+
+// Save creates the GoldVulnerabilityFinding entities in the database.
+func (_c *GoldVulnerabilityFindingCreateBulk) Save(ctx context.Context) ([]*GoldVulnerabilityFinding, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GoldVulnerabilityFinding, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GoldVulnerabilityFindingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GoldVulnerabilityFindingCreateBulk) SaveX(ctx context.Context) []*GoldVulnerabilityFinding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoldVulnerabilityFindingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoldVulnerabilityFindingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vulnerability
+
+import (
+	"context"
+
+	"danny.vn/hotpot/pkg/storage/ent/vulnerability/goldvulnerabilityfinding"
+	"danny.vn/hotpot/pkg/storage/ent/vulnerability/internal"
+	"danny.vn/hotpot/pkg/storage/ent/vulnerability/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GoldVulnerabilityFindingDelete is the builder for deleting a GoldVulnerabilityFinding entity.
+type GoldVulnerabilityFindingDelete struct {
+	config
+	hooks    []Hook
+	mutation *GoldVulnerabilityFindingMutation
+}
+
+// Where appends a list predicates to the GoldVulnerabilityFindingDelete builder.
+func (_d *GoldVulnerabilityFindingDelete) Where(ps ...predicate.GoldVulnerabilityFinding) *GoldVulnerabilityFindingDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *GoldVulnerabilityFindingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GoldVulnerabilityFindingDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *GoldVulnerabilityFindingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(goldvulnerabilityfinding.Table, sqlgraph.NewFieldSpec(goldvulnerabilityfinding.FieldID, field.TypeString))
+	_spec.Node.Schema = _d.schemaConfig.GoldVulnerabilityFinding
+	ctx = internal.NewSchemaConfigContext(ctx, _d.schemaConfig)
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// GoldVulnerabilityFindingDeleteOne is the builder for deleting a single GoldVulnerabilityFinding entity.
+type GoldVulnerabilityFindingDeleteOne struct {
+	_d *GoldVulnerabilityFindingDelete
+}
+
+// Where appends a list predicates to the GoldVulnerabilityFindingDelete builder.
+func (_d *GoldVulnerabilityFindingDeleteOne) Where(ps ...predicate.GoldVulnerabilityFinding) *GoldVulnerabilityFindingDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *GoldVulnerabilityFindingDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{goldvulnerabilityfinding.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GoldVulnerabilityFindingDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
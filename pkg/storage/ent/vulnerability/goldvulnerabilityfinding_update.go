@@ -0,0 +1,1268 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vulnerability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/vulnerability/goldvulnerabilityfinding"
+	"danny.vn/hotpot/pkg/storage/ent/vulnerability/internal"
+	"danny.vn/hotpot/pkg/storage/ent/vulnerability/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// GoldVulnerabilityFindingUpdate is the builder for updating GoldVulnerabilityFinding entities.
+type GoldVulnerabilityFindingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GoldVulnerabilityFindingMutation
+}
+
+// Where appends a list predicates to the GoldVulnerabilityFindingUpdate builder.
+func (_u *GoldVulnerabilityFindingUpdate) Where(ps ...predicate.GoldVulnerabilityFinding) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDetectedAt sets the "detected_at" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetDetectedAt(v time.Time) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetDetectedAt(v)
+	return _u
+}
+
+// SetNillableDetectedAt sets the "detected_at" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillableDetectedAt(v *time.Time) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetDetectedAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetStatus(v string) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillableStatus(v *string) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAssignee sets the "assignee" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetAssignee(v string) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetAssignee(v)
+	return _u
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillableAssignee(v *string) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetAssignee(*v)
+	}
+	return _u
+}
+
+// ClearAssignee clears the value of the "assignee" field.
+func (_u *GoldVulnerabilityFindingUpdate) ClearAssignee() *GoldVulnerabilityFindingUpdate {
+	_u.mutation.ClearAssignee()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetNote(v string) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillableNote(v *string) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *GoldVulnerabilityFindingUpdate) ClearNote() *GoldVulnerabilityFindingUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetSuppressedUntil(v time.Time) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetSuppressedUntil(v)
+	return _u
+}
+
+// SetNillableSuppressedUntil sets the "suppressed_until" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillableSuppressedUntil(v *time.Time) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetSuppressedUntil(*v)
+	}
+	return _u
+}
+
+// ClearSuppressedUntil clears the value of the "suppressed_until" field.
+func (_u *GoldVulnerabilityFindingUpdate) ClearSuppressedUntil() *GoldVulnerabilityFindingUpdate {
+	_u.mutation.ClearSuppressedUntil()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetResolvedAt(v time.Time) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillableResolvedAt(v *time.Time) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *GoldVulnerabilityFindingUpdate) ClearResolvedAt() *GoldVulnerabilityFindingUpdate {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetLastSeenAt(v time.Time) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillableLastSeenAt(v *time.Time) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetMachineID(v string) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillableMachineID(v *string) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// SetHostname sets the "hostname" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetHostname(v string) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetHostname(v)
+	return _u
+}
+
+// SetNillableHostname sets the "hostname" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillableHostname(v *string) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetHostname(*v)
+	}
+	return _u
+}
+
+// ClearHostname clears the value of the "hostname" field.
+func (_u *GoldVulnerabilityFindingUpdate) ClearHostname() *GoldVulnerabilityFindingUpdate {
+	_u.mutation.ClearHostname()
+	return _u
+}
+
+// SetPackageName sets the "package_name" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetPackageName(v string) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetPackageName(v)
+	return _u
+}
+
+// SetNillablePackageName sets the "package_name" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillablePackageName(v *string) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetPackageName(*v)
+	}
+	return _u
+}
+
+// SetPackageVersion sets the "package_version" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetPackageVersion(v string) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetPackageVersion(v)
+	return _u
+}
+
+// SetNillablePackageVersion sets the "package_version" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillablePackageVersion(v *string) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetPackageVersion(*v)
+	}
+	return _u
+}
+
+// SetVulnID sets the "vuln_id" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetVulnID(v string) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetVulnID(v)
+	return _u
+}
+
+// SetNillableVulnID sets the "vuln_id" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillableVulnID(v *string) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetVulnID(*v)
+	}
+	return _u
+}
+
+// SetAliases sets the "aliases" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetAliases(v []string) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetAliases(v)
+	return _u
+}
+
+// AppendAliases appends value to the "aliases" field.
+func (_u *GoldVulnerabilityFindingUpdate) AppendAliases(v []string) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.AppendAliases(v)
+	return _u
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (_u *GoldVulnerabilityFindingUpdate) ClearAliases() *GoldVulnerabilityFindingUpdate {
+	_u.mutation.ClearAliases()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetSource(v string) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillableSource(v *string) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetEcosystem sets the "ecosystem" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetEcosystem(v string) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetEcosystem(v)
+	return _u
+}
+
+// SetNillableEcosystem sets the "ecosystem" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillableEcosystem(v *string) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetEcosystem(*v)
+	}
+	return _u
+}
+
+// ClearEcosystem clears the value of the "ecosystem" field.
+func (_u *GoldVulnerabilityFindingUpdate) ClearEcosystem() *GoldVulnerabilityFindingUpdate {
+	_u.mutation.ClearEcosystem()
+	return _u
+}
+
+// SetVersionScheme sets the "version_scheme" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetVersionScheme(v string) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetVersionScheme(v)
+	return _u
+}
+
+// SetNillableVersionScheme sets the "version_scheme" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillableVersionScheme(v *string) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetVersionScheme(*v)
+	}
+	return _u
+}
+
+// SetSeverity sets the "severity" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetSeverity(v string) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetSeverity(v)
+	return _u
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillableSeverity(v *string) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetSeverity(*v)
+	}
+	return _u
+}
+
+// SetCvssScore sets the "cvss_score" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetCvssScore(v float64) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.ResetCvssScore()
+	_u.mutation.SetCvssScore(v)
+	return _u
+}
+
+// SetNillableCvssScore sets the "cvss_score" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillableCvssScore(v *float64) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetCvssScore(*v)
+	}
+	return _u
+}
+
+// AddCvssScore adds value to the "cvss_score" field.
+func (_u *GoldVulnerabilityFindingUpdate) AddCvssScore(v float64) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.AddCvssScore(v)
+	return _u
+}
+
+// ClearCvssScore clears the value of the "cvss_score" field.
+func (_u *GoldVulnerabilityFindingUpdate) ClearCvssScore() *GoldVulnerabilityFindingUpdate {
+	_u.mutation.ClearCvssScore()
+	return _u
+}
+
+// SetCvssVector sets the "cvss_vector" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetCvssVector(v string) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetCvssVector(v)
+	return _u
+}
+
+// SetNillableCvssVector sets the "cvss_vector" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillableCvssVector(v *string) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetCvssVector(*v)
+	}
+	return _u
+}
+
+// ClearCvssVector clears the value of the "cvss_vector" field.
+func (_u *GoldVulnerabilityFindingUpdate) ClearCvssVector() *GoldVulnerabilityFindingUpdate {
+	_u.mutation.ClearCvssVector()
+	return _u
+}
+
+// SetFixedVersion sets the "fixed_version" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetFixedVersion(v string) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetFixedVersion(v)
+	return _u
+}
+
+// SetNillableFixedVersion sets the "fixed_version" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillableFixedVersion(v *string) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetFixedVersion(*v)
+	}
+	return _u
+}
+
+// ClearFixedVersion clears the value of the "fixed_version" field.
+func (_u *GoldVulnerabilityFindingUpdate) ClearFixedVersion() *GoldVulnerabilityFindingUpdate {
+	_u.mutation.ClearFixedVersion()
+	return _u
+}
+
+// SetSummary sets the "summary" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetSummary(v string) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetSummary(v)
+	return _u
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillableSummary(v *string) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetSummary(*v)
+	}
+	return _u
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (_u *GoldVulnerabilityFindingUpdate) ClearSummary() *GoldVulnerabilityFindingUpdate {
+	_u.mutation.ClearSummary()
+	return _u
+}
+
+// SetPublished sets the "published" field.
+func (_u *GoldVulnerabilityFindingUpdate) SetPublished(v time.Time) *GoldVulnerabilityFindingUpdate {
+	_u.mutation.SetPublished(v)
+	return _u
+}
+
+// SetNillablePublished sets the "published" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdate) SetNillablePublished(v *time.Time) *GoldVulnerabilityFindingUpdate {
+	if v != nil {
+		_u.SetPublished(*v)
+	}
+	return _u
+}
+
+// ClearPublished clears the value of the "published" field.
+func (_u *GoldVulnerabilityFindingUpdate) ClearPublished() *GoldVulnerabilityFindingUpdate {
+	_u.mutation.ClearPublished()
+	return _u
+}
+
+// Mutation returns the GoldVulnerabilityFindingMutation object of the builder.
+func (_u *GoldVulnerabilityFindingUpdate) Mutation() *GoldVulnerabilityFindingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GoldVulnerabilityFindingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoldVulnerabilityFindingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GoldVulnerabilityFindingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoldVulnerabilityFindingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoldVulnerabilityFindingUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := goldvulnerabilityfinding.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MachineID(); ok {
+		if err := goldvulnerabilityfinding.MachineIDValidator(v); err != nil {
+			return &ValidationError{Name: "machine_id", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.machine_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PackageName(); ok {
+		if err := goldvulnerabilityfinding.PackageNameValidator(v); err != nil {
+			return &ValidationError{Name: "package_name", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.package_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PackageVersion(); ok {
+		if err := goldvulnerabilityfinding.PackageVersionValidator(v); err != nil {
+			return &ValidationError{Name: "package_version", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.package_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VulnID(); ok {
+		if err := goldvulnerabilityfinding.VulnIDValidator(v); err != nil {
+			return &ValidationError{Name: "vuln_id", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.vuln_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := goldvulnerabilityfinding.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VersionScheme(); ok {
+		if err := goldvulnerabilityfinding.VersionSchemeValidator(v); err != nil {
+			return &ValidationError{Name: "version_scheme", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.version_scheme": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Severity(); ok {
+		if err := goldvulnerabilityfinding.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.severity": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GoldVulnerabilityFindingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goldvulnerabilityfinding.Table, goldvulnerabilityfinding.Columns, sqlgraph.NewFieldSpec(goldvulnerabilityfinding.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DetectedAt(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldDetectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Assignee(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldAssignee, field.TypeString, value)
+	}
+	if _u.mutation.AssigneeCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldAssignee, field.TypeString)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.SuppressedUntil(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldSuppressedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.SuppressedUntilCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldSuppressedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.MachineID(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldMachineID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Hostname(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldHostname, field.TypeString, value)
+	}
+	if _u.mutation.HostnameCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldHostname, field.TypeString)
+	}
+	if value, ok := _u.mutation.PackageName(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldPackageName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PackageVersion(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldPackageVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.VulnID(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldVulnID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Aliases(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldAliases, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAliases(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, goldvulnerabilityfinding.FieldAliases, value)
+		})
+	}
+	if _u.mutation.AliasesCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldAliases, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ecosystem(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldEcosystem, field.TypeString, value)
+	}
+	if _u.mutation.EcosystemCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldEcosystem, field.TypeString)
+	}
+	if value, ok := _u.mutation.VersionScheme(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldVersionScheme, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Severity(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldSeverity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CvssScore(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCvssScore(); ok {
+		_spec.AddField(goldvulnerabilityfinding.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if _u.mutation.CvssScoreCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldCvssScore, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CvssVector(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldCvssVector, field.TypeString, value)
+	}
+	if _u.mutation.CvssVectorCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldCvssVector, field.TypeString)
+	}
+	if value, ok := _u.mutation.FixedVersion(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldFixedVersion, field.TypeString, value)
+	}
+	if _u.mutation.FixedVersionCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldFixedVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Summary(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldSummary, field.TypeString, value)
+	}
+	if _u.mutation.SummaryCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldSummary, field.TypeString)
+	}
+	if value, ok := _u.mutation.Published(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldPublished, field.TypeTime, value)
+	}
+	if _u.mutation.PublishedCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldPublished, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.GoldVulnerabilityFinding
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goldvulnerabilityfinding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GoldVulnerabilityFindingUpdateOne is the builder for updating a single GoldVulnerabilityFinding entity.
+type GoldVulnerabilityFindingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GoldVulnerabilityFindingMutation
+}
+
+// SetDetectedAt sets the "detected_at" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetDetectedAt(v time.Time) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetDetectedAt(v)
+	return _u
+}
+
+// SetNillableDetectedAt sets the "detected_at" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillableDetectedAt(v *time.Time) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetDetectedAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetStatus(v string) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillableStatus(v *string) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAssignee sets the "assignee" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetAssignee(v string) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetAssignee(v)
+	return _u
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillableAssignee(v *string) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetAssignee(*v)
+	}
+	return _u
+}
+
+// ClearAssignee clears the value of the "assignee" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) ClearAssignee() *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.ClearAssignee()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNote(v string) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillableNote(v *string) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) ClearNote() *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetSuppressedUntil(v time.Time) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetSuppressedUntil(v)
+	return _u
+}
+
+// SetNillableSuppressedUntil sets the "suppressed_until" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillableSuppressedUntil(v *time.Time) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetSuppressedUntil(*v)
+	}
+	return _u
+}
+
+// ClearSuppressedUntil clears the value of the "suppressed_until" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) ClearSuppressedUntil() *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.ClearSuppressedUntil()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetResolvedAt(v time.Time) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillableResolvedAt(v *time.Time) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) ClearResolvedAt() *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetLastSeenAt(v time.Time) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillableLastSeenAt(v *time.Time) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetMachineID(v string) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillableMachineID(v *string) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// SetHostname sets the "hostname" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetHostname(v string) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetHostname(v)
+	return _u
+}
+
+// SetNillableHostname sets the "hostname" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillableHostname(v *string) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetHostname(*v)
+	}
+	return _u
+}
+
+// ClearHostname clears the value of the "hostname" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) ClearHostname() *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.ClearHostname()
+	return _u
+}
+
+// SetPackageName sets the "package_name" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetPackageName(v string) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetPackageName(v)
+	return _u
+}
+
+// SetNillablePackageName sets the "package_name" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillablePackageName(v *string) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetPackageName(*v)
+	}
+	return _u
+}
+
+// SetPackageVersion sets the "package_version" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetPackageVersion(v string) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetPackageVersion(v)
+	return _u
+}
+
+// SetNillablePackageVersion sets the "package_version" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillablePackageVersion(v *string) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetPackageVersion(*v)
+	}
+	return _u
+}
+
+// SetVulnID sets the "vuln_id" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetVulnID(v string) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetVulnID(v)
+	return _u
+}
+
+// SetNillableVulnID sets the "vuln_id" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillableVulnID(v *string) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetVulnID(*v)
+	}
+	return _u
+}
+
+// SetAliases sets the "aliases" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetAliases(v []string) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetAliases(v)
+	return _u
+}
+
+// AppendAliases appends value to the "aliases" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) AppendAliases(v []string) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.AppendAliases(v)
+	return _u
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) ClearAliases() *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.ClearAliases()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetSource(v string) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillableSource(v *string) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetEcosystem sets the "ecosystem" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetEcosystem(v string) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetEcosystem(v)
+	return _u
+}
+
+// SetNillableEcosystem sets the "ecosystem" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillableEcosystem(v *string) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetEcosystem(*v)
+	}
+	return _u
+}
+
+// ClearEcosystem clears the value of the "ecosystem" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) ClearEcosystem() *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.ClearEcosystem()
+	return _u
+}
+
+// SetVersionScheme sets the "version_scheme" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetVersionScheme(v string) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetVersionScheme(v)
+	return _u
+}
+
+// SetNillableVersionScheme sets the "version_scheme" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillableVersionScheme(v *string) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetVersionScheme(*v)
+	}
+	return _u
+}
+
+// SetSeverity sets the "severity" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetSeverity(v string) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetSeverity(v)
+	return _u
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillableSeverity(v *string) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetSeverity(*v)
+	}
+	return _u
+}
+
+// SetCvssScore sets the "cvss_score" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetCvssScore(v float64) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.ResetCvssScore()
+	_u.mutation.SetCvssScore(v)
+	return _u
+}
+
+// SetNillableCvssScore sets the "cvss_score" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillableCvssScore(v *float64) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetCvssScore(*v)
+	}
+	return _u
+}
+
+// AddCvssScore adds value to the "cvss_score" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) AddCvssScore(v float64) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.AddCvssScore(v)
+	return _u
+}
+
+// ClearCvssScore clears the value of the "cvss_score" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) ClearCvssScore() *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.ClearCvssScore()
+	return _u
+}
+
+// SetCvssVector sets the "cvss_vector" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetCvssVector(v string) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetCvssVector(v)
+	return _u
+}
+
+// SetNillableCvssVector sets the "cvss_vector" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillableCvssVector(v *string) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetCvssVector(*v)
+	}
+	return _u
+}
+
+// ClearCvssVector clears the value of the "cvss_vector" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) ClearCvssVector() *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.ClearCvssVector()
+	return _u
+}
+
+// SetFixedVersion sets the "fixed_version" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetFixedVersion(v string) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetFixedVersion(v)
+	return _u
+}
+
+// SetNillableFixedVersion sets the "fixed_version" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillableFixedVersion(v *string) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetFixedVersion(*v)
+	}
+	return _u
+}
+
+// ClearFixedVersion clears the value of the "fixed_version" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) ClearFixedVersion() *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.ClearFixedVersion()
+	return _u
+}
+
+// SetSummary sets the "summary" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetSummary(v string) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetSummary(v)
+	return _u
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillableSummary(v *string) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetSummary(*v)
+	}
+	return _u
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) ClearSummary() *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.ClearSummary()
+	return _u
+}
+
+// SetPublished sets the "published" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetPublished(v time.Time) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.SetPublished(v)
+	return _u
+}
+
+// SetNillablePublished sets the "published" field if the given value is not nil.
+func (_u *GoldVulnerabilityFindingUpdateOne) SetNillablePublished(v *time.Time) *GoldVulnerabilityFindingUpdateOne {
+	if v != nil {
+		_u.SetPublished(*v)
+	}
+	return _u
+}
+
+// ClearPublished clears the value of the "published" field.
+func (_u *GoldVulnerabilityFindingUpdateOne) ClearPublished() *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.ClearPublished()
+	return _u
+}
+
+// Mutation returns the GoldVulnerabilityFindingMutation object of the builder.
+func (_u *GoldVulnerabilityFindingUpdateOne) Mutation() *GoldVulnerabilityFindingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the GoldVulnerabilityFindingUpdate builder.
+func (_u *GoldVulnerabilityFindingUpdateOne) Where(ps ...predicate.GoldVulnerabilityFinding) *GoldVulnerabilityFindingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GoldVulnerabilityFindingUpdateOne) Select(field string, fields ...string) *GoldVulnerabilityFindingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GoldVulnerabilityFinding entity.
+func (_u *GoldVulnerabilityFindingUpdateOne) Save(ctx context.Context) (*GoldVulnerabilityFinding, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoldVulnerabilityFindingUpdateOne) SaveX(ctx context.Context) *GoldVulnerabilityFinding {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GoldVulnerabilityFindingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoldVulnerabilityFindingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoldVulnerabilityFindingUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := goldvulnerabilityfinding.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MachineID(); ok {
+		if err := goldvulnerabilityfinding.MachineIDValidator(v); err != nil {
+			return &ValidationError{Name: "machine_id", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.machine_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PackageName(); ok {
+		if err := goldvulnerabilityfinding.PackageNameValidator(v); err != nil {
+			return &ValidationError{Name: "package_name", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.package_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PackageVersion(); ok {
+		if err := goldvulnerabilityfinding.PackageVersionValidator(v); err != nil {
+			return &ValidationError{Name: "package_version", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.package_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VulnID(); ok {
+		if err := goldvulnerabilityfinding.VulnIDValidator(v); err != nil {
+			return &ValidationError{Name: "vuln_id", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.vuln_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := goldvulnerabilityfinding.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VersionScheme(); ok {
+		if err := goldvulnerabilityfinding.VersionSchemeValidator(v); err != nil {
+			return &ValidationError{Name: "version_scheme", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.version_scheme": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Severity(); ok {
+		if err := goldvulnerabilityfinding.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`vulnerability: validator failed for field "GoldVulnerabilityFinding.severity": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GoldVulnerabilityFindingUpdateOne) sqlSave(ctx context.Context) (_node *GoldVulnerabilityFinding, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goldvulnerabilityfinding.Table, goldvulnerabilityfinding.Columns, sqlgraph.NewFieldSpec(goldvulnerabilityfinding.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`vulnerability: missing "GoldVulnerabilityFinding.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, goldvulnerabilityfinding.FieldID)
+		for _, f := range fields {
+			if !goldvulnerabilityfinding.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("vulnerability: invalid field %q for query", f)}
+			}
+			if f != goldvulnerabilityfinding.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DetectedAt(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldDetectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Assignee(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldAssignee, field.TypeString, value)
+	}
+	if _u.mutation.AssigneeCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldAssignee, field.TypeString)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.SuppressedUntil(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldSuppressedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.SuppressedUntilCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldSuppressedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.MachineID(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldMachineID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Hostname(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldHostname, field.TypeString, value)
+	}
+	if _u.mutation.HostnameCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldHostname, field.TypeString)
+	}
+	if value, ok := _u.mutation.PackageName(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldPackageName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PackageVersion(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldPackageVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.VulnID(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldVulnID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Aliases(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldAliases, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAliases(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, goldvulnerabilityfinding.FieldAliases, value)
+		})
+	}
+	if _u.mutation.AliasesCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldAliases, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ecosystem(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldEcosystem, field.TypeString, value)
+	}
+	if _u.mutation.EcosystemCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldEcosystem, field.TypeString)
+	}
+	if value, ok := _u.mutation.VersionScheme(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldVersionScheme, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Severity(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldSeverity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CvssScore(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCvssScore(); ok {
+		_spec.AddField(goldvulnerabilityfinding.FieldCvssScore, field.TypeFloat64, value)
+	}
+	if _u.mutation.CvssScoreCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldCvssScore, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CvssVector(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldCvssVector, field.TypeString, value)
+	}
+	if _u.mutation.CvssVectorCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldCvssVector, field.TypeString)
+	}
+	if value, ok := _u.mutation.FixedVersion(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldFixedVersion, field.TypeString, value)
+	}
+	if _u.mutation.FixedVersionCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldFixedVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Summary(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldSummary, field.TypeString, value)
+	}
+	if _u.mutation.SummaryCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldSummary, field.TypeString)
+	}
+	if value, ok := _u.mutation.Published(); ok {
+		_spec.SetField(goldvulnerabilityfinding.FieldPublished, field.TypeTime, value)
+	}
+	if _u.mutation.PublishedCleared() {
+		_spec.ClearField(goldvulnerabilityfinding.FieldPublished, field.TypeTime)
+	}
+	_spec.Node.Schema = _u.schemaConfig.GoldVulnerabilityFinding
+	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
+	_node = &GoldVulnerabilityFinding{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goldvulnerabilityfinding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,87 @@
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// VulnerabilityFindingsColumns holds the columns for the "vulnerability_findings" table.
+	VulnerabilityFindingsColumns = []*schema.Column{
+		{Name: "resource_id", Type: field.TypeString, Unique: true},
+		{Name: "detected_at", Type: field.TypeTime},
+		{Name: "first_detected_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeString, Default: "open"},
+		{Name: "assignee", Type: field.TypeString, Nullable: true},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "suppressed_until", Type: field.TypeTime, Nullable: true},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime},
+		{Name: "machine_id", Type: field.TypeString},
+		{Name: "hostname", Type: field.TypeString, Nullable: true},
+		{Name: "package_name", Type: field.TypeString},
+		{Name: "package_version", Type: field.TypeString},
+		{Name: "vuln_id", Type: field.TypeString},
+		{Name: "aliases", Type: field.TypeJSON, Nullable: true},
+		{Name: "source", Type: field.TypeString},
+		{Name: "ecosystem", Type: field.TypeString, Nullable: true},
+		{Name: "version_scheme", Type: field.TypeString},
+		{Name: "severity", Type: field.TypeString},
+		{Name: "cvss_score", Type: field.TypeFloat64, Nullable: true},
+		{Name: "cvss_vector", Type: field.TypeString, Nullable: true},
+		{Name: "fixed_version", Type: field.TypeString, Nullable: true},
+		{Name: "summary", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "published", Type: field.TypeTime, Nullable: true},
+	}
+	// VulnerabilityFindingsTable holds the schema information for the "vulnerability_findings" table.
+	VulnerabilityFindingsTable = &schema.Table{
+		Name:       "vulnerability_findings",
+		Columns:    VulnerabilityFindingsColumns,
+		PrimaryKey: []*schema.Column{VulnerabilityFindingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "goldvulnerabilityfinding_status",
+				Unique:  false,
+				Columns: []*schema.Column{VulnerabilityFindingsColumns[3]},
+			},
+			{
+				Name:    "goldvulnerabilityfinding_machine_id",
+				Unique:  false,
+				Columns: []*schema.Column{VulnerabilityFindingsColumns[9]},
+			},
+			{
+				Name:    "goldvulnerabilityfinding_vuln_id",
+				Unique:  false,
+				Columns: []*schema.Column{VulnerabilityFindingsColumns[13]},
+			},
+			{
+				Name:    "goldvulnerabilityfinding_severity",
+				Unique:  false,
+				Columns: []*schema.Column{VulnerabilityFindingsColumns[18]},
+			},
+			{
+				Name:    "goldvulnerabilityfinding_package_name",
+				Unique:  false,
+				Columns: []*schema.Column{VulnerabilityFindingsColumns[11]},
+			},
+			{
+				Name:    "goldvulnerabilityfinding_machine_id_package_name_vuln_id",
+				Unique:  true,
+				Columns: []*schema.Column{VulnerabilityFindingsColumns[9], VulnerabilityFindingsColumns[11], VulnerabilityFindingsColumns[13]},
+			},
+		},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		VulnerabilityFindingsTable,
+	}
+)
+
+func init() {
+	VulnerabilityFindingsTable.Annotation = &entsql.Annotation{
+		Table: "vulnerability_findings",
+	}
+}
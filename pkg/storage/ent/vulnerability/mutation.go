@@ -0,0 +1,1833 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vulnerability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"danny.vn/hotpot/pkg/storage/ent/vulnerability/goldvulnerabilityfinding"
+	"danny.vn/hotpot/pkg/storage/ent/vulnerability/predicate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Operation types.
+	OpCreate    = ent.OpCreate
+	OpDelete    = ent.OpDelete
+	OpDeleteOne = ent.OpDeleteOne
+	OpUpdate    = ent.OpUpdate
+	OpUpdateOne = ent.OpUpdateOne
+
+	// Node types.
+	TypeGoldVulnerabilityFinding = "GoldVulnerabilityFinding"
+)
+
+// GoldVulnerabilityFindingMutation represents an operation that mutates the GoldVulnerabilityFinding nodes in the graph.
+type GoldVulnerabilityFindingMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *string
+	detected_at       *time.Time
+	first_detected_at *time.Time
+	status            *string
+	assignee          *string
+	note              *string
+	suppressed_until  *time.Time
+	resolved_at       *time.Time
+	last_seen_at      *time.Time
+	machine_id        *string
+	hostname          *string
+	package_name      *string
+	package_version   *string
+	vuln_id           *string
+	aliases           *[]string
+	appendaliases     []string
+	source            *string
+	ecosystem         *string
+	version_scheme    *string
+	severity          *string
+	cvss_score        *float64
+	addcvss_score     *float64
+	cvss_vector       *string
+	fixed_version     *string
+	summary           *string
+	published         *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*GoldVulnerabilityFinding, error)
+	predicates        []predicate.GoldVulnerabilityFinding
+}
+
+var _ ent.Mutation = (*GoldVulnerabilityFindingMutation)(nil)
+
+// goldvulnerabilityfindingOption allows management of the mutation configuration using functional options.
+type goldvulnerabilityfindingOption func(*GoldVulnerabilityFindingMutation)
+
+// newGoldVulnerabilityFindingMutation creates new mutation for the GoldVulnerabilityFinding entity.
+func newGoldVulnerabilityFindingMutation(c config, op Op, opts ...goldvulnerabilityfindingOption) *GoldVulnerabilityFindingMutation {
+	m := &GoldVulnerabilityFindingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGoldVulnerabilityFinding,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGoldVulnerabilityFindingID sets the ID field of the mutation.
+func withGoldVulnerabilityFindingID(id string) goldvulnerabilityfindingOption {
+	return func(m *GoldVulnerabilityFindingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GoldVulnerabilityFinding
+		)
+		m.oldValue = func(ctx context.Context) (*GoldVulnerabilityFinding, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GoldVulnerabilityFinding.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGoldVulnerabilityFinding sets the old GoldVulnerabilityFinding of the mutation.
+func withGoldVulnerabilityFinding(node *GoldVulnerabilityFinding) goldvulnerabilityfindingOption {
+	return func(m *GoldVulnerabilityFindingMutation) {
+		m.oldValue = func(context.Context) (*GoldVulnerabilityFinding, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GoldVulnerabilityFindingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GoldVulnerabilityFindingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("vulnerability: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GoldVulnerabilityFinding entities.
+func (m *GoldVulnerabilityFindingMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GoldVulnerabilityFindingMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GoldVulnerabilityFindingMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GoldVulnerabilityFinding.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDetectedAt sets the "detected_at" field.
+func (m *GoldVulnerabilityFindingMutation) SetDetectedAt(t time.Time) {
+	m.detected_at = &t
+}
+
+// DetectedAt returns the value of the "detected_at" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) DetectedAt() (r time.Time, exists bool) {
+	v := m.detected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetectedAt returns the old "detected_at" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldDetectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetectedAt: %w", err)
+	}
+	return oldValue.DetectedAt, nil
+}
+
+// ResetDetectedAt resets all changes to the "detected_at" field.
+func (m *GoldVulnerabilityFindingMutation) ResetDetectedAt() {
+	m.detected_at = nil
+}
+
+// SetFirstDetectedAt sets the "first_detected_at" field.
+func (m *GoldVulnerabilityFindingMutation) SetFirstDetectedAt(t time.Time) {
+	m.first_detected_at = &t
+}
+
+// FirstDetectedAt returns the value of the "first_detected_at" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) FirstDetectedAt() (r time.Time, exists bool) {
+	v := m.first_detected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstDetectedAt returns the old "first_detected_at" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldFirstDetectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstDetectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstDetectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstDetectedAt: %w", err)
+	}
+	return oldValue.FirstDetectedAt, nil
+}
+
+// ResetFirstDetectedAt resets all changes to the "first_detected_at" field.
+func (m *GoldVulnerabilityFindingMutation) ResetFirstDetectedAt() {
+	m.first_detected_at = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *GoldVulnerabilityFindingMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *GoldVulnerabilityFindingMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAssignee sets the "assignee" field.
+func (m *GoldVulnerabilityFindingMutation) SetAssignee(s string) {
+	m.assignee = &s
+}
+
+// Assignee returns the value of the "assignee" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) Assignee() (r string, exists bool) {
+	v := m.assignee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignee returns the old "assignee" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldAssignee(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignee: %w", err)
+	}
+	return oldValue.Assignee, nil
+}
+
+// ClearAssignee clears the value of the "assignee" field.
+func (m *GoldVulnerabilityFindingMutation) ClearAssignee() {
+	m.assignee = nil
+	m.clearedFields[goldvulnerabilityfinding.FieldAssignee] = struct{}{}
+}
+
+// AssigneeCleared returns if the "assignee" field was cleared in this mutation.
+func (m *GoldVulnerabilityFindingMutation) AssigneeCleared() bool {
+	_, ok := m.clearedFields[goldvulnerabilityfinding.FieldAssignee]
+	return ok
+}
+
+// ResetAssignee resets all changes to the "assignee" field.
+func (m *GoldVulnerabilityFindingMutation) ResetAssignee() {
+	m.assignee = nil
+	delete(m.clearedFields, goldvulnerabilityfinding.FieldAssignee)
+}
+
+// SetNote sets the "note" field.
+func (m *GoldVulnerabilityFindingMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) Note() (r string, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNote returns the old "note" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+	}
+	return oldValue.Note, nil
+}
+
+// ClearNote clears the value of the "note" field.
+func (m *GoldVulnerabilityFindingMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[goldvulnerabilityfinding.FieldNote] = struct{}{}
+}
+
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *GoldVulnerabilityFindingMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[goldvulnerabilityfinding.FieldNote]
+	return ok
+}
+
+// ResetNote resets all changes to the "note" field.
+func (m *GoldVulnerabilityFindingMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, goldvulnerabilityfinding.FieldNote)
+}
+
+// SetSuppressedUntil sets the "suppressed_until" field.
+func (m *GoldVulnerabilityFindingMutation) SetSuppressedUntil(t time.Time) {
+	m.suppressed_until = &t
+}
+
+// SuppressedUntil returns the value of the "suppressed_until" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) SuppressedUntil() (r time.Time, exists bool) {
+	v := m.suppressed_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuppressedUntil returns the old "suppressed_until" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldSuppressedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuppressedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuppressedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuppressedUntil: %w", err)
+	}
+	return oldValue.SuppressedUntil, nil
+}
+
+// ClearSuppressedUntil clears the value of the "suppressed_until" field.
+func (m *GoldVulnerabilityFindingMutation) ClearSuppressedUntil() {
+	m.suppressed_until = nil
+	m.clearedFields[goldvulnerabilityfinding.FieldSuppressedUntil] = struct{}{}
+}
+
+// SuppressedUntilCleared returns if the "suppressed_until" field was cleared in this mutation.
+func (m *GoldVulnerabilityFindingMutation) SuppressedUntilCleared() bool {
+	_, ok := m.clearedFields[goldvulnerabilityfinding.FieldSuppressedUntil]
+	return ok
+}
+
+// ResetSuppressedUntil resets all changes to the "suppressed_until" field.
+func (m *GoldVulnerabilityFindingMutation) ResetSuppressedUntil() {
+	m.suppressed_until = nil
+	delete(m.clearedFields, goldvulnerabilityfinding.FieldSuppressedUntil)
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (m *GoldVulnerabilityFindingMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
+}
+
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolvedAt returns the old "resolved_at" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldResolvedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+	}
+	return oldValue.ResolvedAt, nil
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *GoldVulnerabilityFindingMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[goldvulnerabilityfinding.FieldResolvedAt] = struct{}{}
+}
+
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *GoldVulnerabilityFindingMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[goldvulnerabilityfinding.FieldResolvedAt]
+	return ok
+}
+
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *GoldVulnerabilityFindingMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, goldvulnerabilityfinding.FieldResolvedAt)
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *GoldVulnerabilityFindingMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *GoldVulnerabilityFindingMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+}
+
+// SetMachineID sets the "machine_id" field.
+func (m *GoldVulnerabilityFindingMutation) SetMachineID(s string) {
+	m.machine_id = &s
+}
+
+// MachineID returns the value of the "machine_id" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) MachineID() (r string, exists bool) {
+	v := m.machine_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMachineID returns the old "machine_id" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldMachineID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMachineID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMachineID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMachineID: %w", err)
+	}
+	return oldValue.MachineID, nil
+}
+
+// ResetMachineID resets all changes to the "machine_id" field.
+func (m *GoldVulnerabilityFindingMutation) ResetMachineID() {
+	m.machine_id = nil
+}
+
+// SetHostname sets the "hostname" field.
+func (m *GoldVulnerabilityFindingMutation) SetHostname(s string) {
+	m.hostname = &s
+}
+
+// Hostname returns the value of the "hostname" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) Hostname() (r string, exists bool) {
+	v := m.hostname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostname returns the old "hostname" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldHostname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostname: %w", err)
+	}
+	return oldValue.Hostname, nil
+}
+
+// ClearHostname clears the value of the "hostname" field.
+func (m *GoldVulnerabilityFindingMutation) ClearHostname() {
+	m.hostname = nil
+	m.clearedFields[goldvulnerabilityfinding.FieldHostname] = struct{}{}
+}
+
+// HostnameCleared returns if the "hostname" field was cleared in this mutation.
+func (m *GoldVulnerabilityFindingMutation) HostnameCleared() bool {
+	_, ok := m.clearedFields[goldvulnerabilityfinding.FieldHostname]
+	return ok
+}
+
+// ResetHostname resets all changes to the "hostname" field.
+func (m *GoldVulnerabilityFindingMutation) ResetHostname() {
+	m.hostname = nil
+	delete(m.clearedFields, goldvulnerabilityfinding.FieldHostname)
+}
+
+// SetPackageName sets the "package_name" field.
+func (m *GoldVulnerabilityFindingMutation) SetPackageName(s string) {
+	m.package_name = &s
+}
+
+// PackageName returns the value of the "package_name" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) PackageName() (r string, exists bool) {
+	v := m.package_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageName returns the old "package_name" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldPackageName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageName: %w", err)
+	}
+	return oldValue.PackageName, nil
+}
+
+// ResetPackageName resets all changes to the "package_name" field.
+func (m *GoldVulnerabilityFindingMutation) ResetPackageName() {
+	m.package_name = nil
+}
+
+// SetPackageVersion sets the "package_version" field.
+func (m *GoldVulnerabilityFindingMutation) SetPackageVersion(s string) {
+	m.package_version = &s
+}
+
+// PackageVersion returns the value of the "package_version" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) PackageVersion() (r string, exists bool) {
+	v := m.package_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPackageVersion returns the old "package_version" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldPackageVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPackageVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPackageVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPackageVersion: %w", err)
+	}
+	return oldValue.PackageVersion, nil
+}
+
+// ResetPackageVersion resets all changes to the "package_version" field.
+func (m *GoldVulnerabilityFindingMutation) ResetPackageVersion() {
+	m.package_version = nil
+}
+
+// SetVulnID sets the "vuln_id" field.
+func (m *GoldVulnerabilityFindingMutation) SetVulnID(s string) {
+	m.vuln_id = &s
+}
+
+// VulnID returns the value of the "vuln_id" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) VulnID() (r string, exists bool) {
+	v := m.vuln_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVulnID returns the old "vuln_id" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldVulnID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVulnID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVulnID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVulnID: %w", err)
+	}
+	return oldValue.VulnID, nil
+}
+
+// ResetVulnID resets all changes to the "vuln_id" field.
+func (m *GoldVulnerabilityFindingMutation) ResetVulnID() {
+	m.vuln_id = nil
+}
+
+// SetAliases sets the "aliases" field.
+func (m *GoldVulnerabilityFindingMutation) SetAliases(s []string) {
+	m.aliases = &s
+	m.appendaliases = nil
+}
+
+// Aliases returns the value of the "aliases" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) Aliases() (r []string, exists bool) {
+	v := m.aliases
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAliases returns the old "aliases" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldAliases(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAliases is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAliases requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAliases: %w", err)
+	}
+	return oldValue.Aliases, nil
+}
+
+// AppendAliases adds s to the "aliases" field.
+func (m *GoldVulnerabilityFindingMutation) AppendAliases(s []string) {
+	m.appendaliases = append(m.appendaliases, s...)
+}
+
+// AppendedAliases returns the list of values that were appended to the "aliases" field in this mutation.
+func (m *GoldVulnerabilityFindingMutation) AppendedAliases() ([]string, bool) {
+	if len(m.appendaliases) == 0 {
+		return nil, false
+	}
+	return m.appendaliases, true
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (m *GoldVulnerabilityFindingMutation) ClearAliases() {
+	m.aliases = nil
+	m.appendaliases = nil
+	m.clearedFields[goldvulnerabilityfinding.FieldAliases] = struct{}{}
+}
+
+// AliasesCleared returns if the "aliases" field was cleared in this mutation.
+func (m *GoldVulnerabilityFindingMutation) AliasesCleared() bool {
+	_, ok := m.clearedFields[goldvulnerabilityfinding.FieldAliases]
+	return ok
+}
+
+// ResetAliases resets all changes to the "aliases" field.
+func (m *GoldVulnerabilityFindingMutation) ResetAliases() {
+	m.aliases = nil
+	m.appendaliases = nil
+	delete(m.clearedFields, goldvulnerabilityfinding.FieldAliases)
+}
+
+// SetSource sets the "source" field.
+func (m *GoldVulnerabilityFindingMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *GoldVulnerabilityFindingMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetEcosystem sets the "ecosystem" field.
+func (m *GoldVulnerabilityFindingMutation) SetEcosystem(s string) {
+	m.ecosystem = &s
+}
+
+// Ecosystem returns the value of the "ecosystem" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) Ecosystem() (r string, exists bool) {
+	v := m.ecosystem
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEcosystem returns the old "ecosystem" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldEcosystem(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEcosystem is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEcosystem requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEcosystem: %w", err)
+	}
+	return oldValue.Ecosystem, nil
+}
+
+// ClearEcosystem clears the value of the "ecosystem" field.
+func (m *GoldVulnerabilityFindingMutation) ClearEcosystem() {
+	m.ecosystem = nil
+	m.clearedFields[goldvulnerabilityfinding.FieldEcosystem] = struct{}{}
+}
+
+// EcosystemCleared returns if the "ecosystem" field was cleared in this mutation.
+func (m *GoldVulnerabilityFindingMutation) EcosystemCleared() bool {
+	_, ok := m.clearedFields[goldvulnerabilityfinding.FieldEcosystem]
+	return ok
+}
+
+// ResetEcosystem resets all changes to the "ecosystem" field.
+func (m *GoldVulnerabilityFindingMutation) ResetEcosystem() {
+	m.ecosystem = nil
+	delete(m.clearedFields, goldvulnerabilityfinding.FieldEcosystem)
+}
+
+// SetVersionScheme sets the "version_scheme" field.
+func (m *GoldVulnerabilityFindingMutation) SetVersionScheme(s string) {
+	m.version_scheme = &s
+}
+
+// VersionScheme returns the value of the "version_scheme" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) VersionScheme() (r string, exists bool) {
+	v := m.version_scheme
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersionScheme returns the old "version_scheme" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldVersionScheme(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersionScheme is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersionScheme requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersionScheme: %w", err)
+	}
+	return oldValue.VersionScheme, nil
+}
+
+// ResetVersionScheme resets all changes to the "version_scheme" field.
+func (m *GoldVulnerabilityFindingMutation) ResetVersionScheme() {
+	m.version_scheme = nil
+}
+
+// SetSeverity sets the "severity" field.
+func (m *GoldVulnerabilityFindingMutation) SetSeverity(s string) {
+	m.severity = &s
+}
+
+// Severity returns the value of the "severity" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) Severity() (r string, exists bool) {
+	v := m.severity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeverity returns the old "severity" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldSeverity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeverity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeverity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeverity: %w", err)
+	}
+	return oldValue.Severity, nil
+}
+
+// ResetSeverity resets all changes to the "severity" field.
+func (m *GoldVulnerabilityFindingMutation) ResetSeverity() {
+	m.severity = nil
+}
+
+// SetCvssScore sets the "cvss_score" field.
+func (m *GoldVulnerabilityFindingMutation) SetCvssScore(f float64) {
+	m.cvss_score = &f
+	m.addcvss_score = nil
+}
+
+// CvssScore returns the value of the "cvss_score" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) CvssScore() (r float64, exists bool) {
+	v := m.cvss_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCvssScore returns the old "cvss_score" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldCvssScore(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCvssScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCvssScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCvssScore: %w", err)
+	}
+	return oldValue.CvssScore, nil
+}
+
+// AddCvssScore adds f to the "cvss_score" field.
+func (m *GoldVulnerabilityFindingMutation) AddCvssScore(f float64) {
+	if m.addcvss_score != nil {
+		*m.addcvss_score += f
+	} else {
+		m.addcvss_score = &f
+	}
+}
+
+// AddedCvssScore returns the value that was added to the "cvss_score" field in this mutation.
+func (m *GoldVulnerabilityFindingMutation) AddedCvssScore() (r float64, exists bool) {
+	v := m.addcvss_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCvssScore clears the value of the "cvss_score" field.
+func (m *GoldVulnerabilityFindingMutation) ClearCvssScore() {
+	m.cvss_score = nil
+	m.addcvss_score = nil
+	m.clearedFields[goldvulnerabilityfinding.FieldCvssScore] = struct{}{}
+}
+
+// CvssScoreCleared returns if the "cvss_score" field was cleared in this mutation.
+func (m *GoldVulnerabilityFindingMutation) CvssScoreCleared() bool {
+	_, ok := m.clearedFields[goldvulnerabilityfinding.FieldCvssScore]
+	return ok
+}
+
+// ResetCvssScore resets all changes to the "cvss_score" field.
+func (m *GoldVulnerabilityFindingMutation) ResetCvssScore() {
+	m.cvss_score = nil
+	m.addcvss_score = nil
+	delete(m.clearedFields, goldvulnerabilityfinding.FieldCvssScore)
+}
+
+// SetCvssVector sets the "cvss_vector" field.
+func (m *GoldVulnerabilityFindingMutation) SetCvssVector(s string) {
+	m.cvss_vector = &s
+}
+
+// CvssVector returns the value of the "cvss_vector" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) CvssVector() (r string, exists bool) {
+	v := m.cvss_vector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCvssVector returns the old "cvss_vector" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldCvssVector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCvssVector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCvssVector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCvssVector: %w", err)
+	}
+	return oldValue.CvssVector, nil
+}
+
+// ClearCvssVector clears the value of the "cvss_vector" field.
+func (m *GoldVulnerabilityFindingMutation) ClearCvssVector() {
+	m.cvss_vector = nil
+	m.clearedFields[goldvulnerabilityfinding.FieldCvssVector] = struct{}{}
+}
+
+// CvssVectorCleared returns if the "cvss_vector" field was cleared in this mutation.
+func (m *GoldVulnerabilityFindingMutation) CvssVectorCleared() bool {
+	_, ok := m.clearedFields[goldvulnerabilityfinding.FieldCvssVector]
+	return ok
+}
+
+// ResetCvssVector resets all changes to the "cvss_vector" field.
+func (m *GoldVulnerabilityFindingMutation) ResetCvssVector() {
+	m.cvss_vector = nil
+	delete(m.clearedFields, goldvulnerabilityfinding.FieldCvssVector)
+}
+
+// SetFixedVersion sets the "fixed_version" field.
+func (m *GoldVulnerabilityFindingMutation) SetFixedVersion(s string) {
+	m.fixed_version = &s
+}
+
+// FixedVersion returns the value of the "fixed_version" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) FixedVersion() (r string, exists bool) {
+	v := m.fixed_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFixedVersion returns the old "fixed_version" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldFixedVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFixedVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFixedVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFixedVersion: %w", err)
+	}
+	return oldValue.FixedVersion, nil
+}
+
+// ClearFixedVersion clears the value of the "fixed_version" field.
+func (m *GoldVulnerabilityFindingMutation) ClearFixedVersion() {
+	m.fixed_version = nil
+	m.clearedFields[goldvulnerabilityfinding.FieldFixedVersion] = struct{}{}
+}
+
+// FixedVersionCleared returns if the "fixed_version" field was cleared in this mutation.
+func (m *GoldVulnerabilityFindingMutation) FixedVersionCleared() bool {
+	_, ok := m.clearedFields[goldvulnerabilityfinding.FieldFixedVersion]
+	return ok
+}
+
+// ResetFixedVersion resets all changes to the "fixed_version" field.
+func (m *GoldVulnerabilityFindingMutation) ResetFixedVersion() {
+	m.fixed_version = nil
+	delete(m.clearedFields, goldvulnerabilityfinding.FieldFixedVersion)
+}
+
+// SetSummary sets the "summary" field.
+func (m *GoldVulnerabilityFindingMutation) SetSummary(s string) {
+	m.summary = &s
+}
+
+// Summary returns the value of the "summary" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) Summary() (r string, exists bool) {
+	v := m.summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummary returns the old "summary" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldSummary(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+	}
+	return oldValue.Summary, nil
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (m *GoldVulnerabilityFindingMutation) ClearSummary() {
+	m.summary = nil
+	m.clearedFields[goldvulnerabilityfinding.FieldSummary] = struct{}{}
+}
+
+// SummaryCleared returns if the "summary" field was cleared in this mutation.
+func (m *GoldVulnerabilityFindingMutation) SummaryCleared() bool {
+	_, ok := m.clearedFields[goldvulnerabilityfinding.FieldSummary]
+	return ok
+}
+
+// ResetSummary resets all changes to the "summary" field.
+func (m *GoldVulnerabilityFindingMutation) ResetSummary() {
+	m.summary = nil
+	delete(m.clearedFields, goldvulnerabilityfinding.FieldSummary)
+}
+
+// SetPublished sets the "published" field.
+func (m *GoldVulnerabilityFindingMutation) SetPublished(t time.Time) {
+	m.published = &t
+}
+
+// Published returns the value of the "published" field in the mutation.
+func (m *GoldVulnerabilityFindingMutation) Published() (r time.Time, exists bool) {
+	v := m.published
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublished returns the old "published" field's value of the GoldVulnerabilityFinding entity.
+// If the GoldVulnerabilityFinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoldVulnerabilityFindingMutation) OldPublished(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublished is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublished requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublished: %w", err)
+	}
+	return oldValue.Published, nil
+}
+
+// ClearPublished clears the value of the "published" field.
+func (m *GoldVulnerabilityFindingMutation) ClearPublished() {
+	m.published = nil
+	m.clearedFields[goldvulnerabilityfinding.FieldPublished] = struct{}{}
+}
+
+// PublishedCleared returns if the "published" field was cleared in this mutation.
+func (m *GoldVulnerabilityFindingMutation) PublishedCleared() bool {
+	_, ok := m.clearedFields[goldvulnerabilityfinding.FieldPublished]
+	return ok
+}
+
+// ResetPublished resets all changes to the "published" field.
+func (m *GoldVulnerabilityFindingMutation) ResetPublished() {
+	m.published = nil
+	delete(m.clearedFields, goldvulnerabilityfinding.FieldPublished)
+}
+
+// Where appends a list predicates to the GoldVulnerabilityFindingMutation builder.
+func (m *GoldVulnerabilityFindingMutation) Where(ps ...predicate.GoldVulnerabilityFinding) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GoldVulnerabilityFindingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GoldVulnerabilityFindingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GoldVulnerabilityFinding, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GoldVulnerabilityFindingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GoldVulnerabilityFindingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GoldVulnerabilityFinding).
+func (m *GoldVulnerabilityFindingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GoldVulnerabilityFindingMutation) Fields() []string {
+	fields := make([]string, 0, 23)
+	if m.detected_at != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldDetectedAt)
+	}
+	if m.first_detected_at != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldFirstDetectedAt)
+	}
+	if m.status != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldStatus)
+	}
+	if m.assignee != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldAssignee)
+	}
+	if m.note != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldNote)
+	}
+	if m.suppressed_until != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldSuppressedUntil)
+	}
+	if m.resolved_at != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldResolvedAt)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldLastSeenAt)
+	}
+	if m.machine_id != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldMachineID)
+	}
+	if m.hostname != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldHostname)
+	}
+	if m.package_name != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldPackageName)
+	}
+	if m.package_version != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldPackageVersion)
+	}
+	if m.vuln_id != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldVulnID)
+	}
+	if m.aliases != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldAliases)
+	}
+	if m.source != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldSource)
+	}
+	if m.ecosystem != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldEcosystem)
+	}
+	if m.version_scheme != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldVersionScheme)
+	}
+	if m.severity != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldSeverity)
+	}
+	if m.cvss_score != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldCvssScore)
+	}
+	if m.cvss_vector != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldCvssVector)
+	}
+	if m.fixed_version != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldFixedVersion)
+	}
+	if m.summary != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldSummary)
+	}
+	if m.published != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldPublished)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GoldVulnerabilityFindingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case goldvulnerabilityfinding.FieldDetectedAt:
+		return m.DetectedAt()
+	case goldvulnerabilityfinding.FieldFirstDetectedAt:
+		return m.FirstDetectedAt()
+	case goldvulnerabilityfinding.FieldStatus:
+		return m.Status()
+	case goldvulnerabilityfinding.FieldAssignee:
+		return m.Assignee()
+	case goldvulnerabilityfinding.FieldNote:
+		return m.Note()
+	case goldvulnerabilityfinding.FieldSuppressedUntil:
+		return m.SuppressedUntil()
+	case goldvulnerabilityfinding.FieldResolvedAt:
+		return m.ResolvedAt()
+	case goldvulnerabilityfinding.FieldLastSeenAt:
+		return m.LastSeenAt()
+	case goldvulnerabilityfinding.FieldMachineID:
+		return m.MachineID()
+	case goldvulnerabilityfinding.FieldHostname:
+		return m.Hostname()
+	case goldvulnerabilityfinding.FieldPackageName:
+		return m.PackageName()
+	case goldvulnerabilityfinding.FieldPackageVersion:
+		return m.PackageVersion()
+	case goldvulnerabilityfinding.FieldVulnID:
+		return m.VulnID()
+	case goldvulnerabilityfinding.FieldAliases:
+		return m.Aliases()
+	case goldvulnerabilityfinding.FieldSource:
+		return m.Source()
+	case goldvulnerabilityfinding.FieldEcosystem:
+		return m.Ecosystem()
+	case goldvulnerabilityfinding.FieldVersionScheme:
+		return m.VersionScheme()
+	case goldvulnerabilityfinding.FieldSeverity:
+		return m.Severity()
+	case goldvulnerabilityfinding.FieldCvssScore:
+		return m.CvssScore()
+	case goldvulnerabilityfinding.FieldCvssVector:
+		return m.CvssVector()
+	case goldvulnerabilityfinding.FieldFixedVersion:
+		return m.FixedVersion()
+	case goldvulnerabilityfinding.FieldSummary:
+		return m.Summary()
+	case goldvulnerabilityfinding.FieldPublished:
+		return m.Published()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GoldVulnerabilityFindingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case goldvulnerabilityfinding.FieldDetectedAt:
+		return m.OldDetectedAt(ctx)
+	case goldvulnerabilityfinding.FieldFirstDetectedAt:
+		return m.OldFirstDetectedAt(ctx)
+	case goldvulnerabilityfinding.FieldStatus:
+		return m.OldStatus(ctx)
+	case goldvulnerabilityfinding.FieldAssignee:
+		return m.OldAssignee(ctx)
+	case goldvulnerabilityfinding.FieldNote:
+		return m.OldNote(ctx)
+	case goldvulnerabilityfinding.FieldSuppressedUntil:
+		return m.OldSuppressedUntil(ctx)
+	case goldvulnerabilityfinding.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
+	case goldvulnerabilityfinding.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	case goldvulnerabilityfinding.FieldMachineID:
+		return m.OldMachineID(ctx)
+	case goldvulnerabilityfinding.FieldHostname:
+		return m.OldHostname(ctx)
+	case goldvulnerabilityfinding.FieldPackageName:
+		return m.OldPackageName(ctx)
+	case goldvulnerabilityfinding.FieldPackageVersion:
+		return m.OldPackageVersion(ctx)
+	case goldvulnerabilityfinding.FieldVulnID:
+		return m.OldVulnID(ctx)
+	case goldvulnerabilityfinding.FieldAliases:
+		return m.OldAliases(ctx)
+	case goldvulnerabilityfinding.FieldSource:
+		return m.OldSource(ctx)
+	case goldvulnerabilityfinding.FieldEcosystem:
+		return m.OldEcosystem(ctx)
+	case goldvulnerabilityfinding.FieldVersionScheme:
+		return m.OldVersionScheme(ctx)
+	case goldvulnerabilityfinding.FieldSeverity:
+		return m.OldSeverity(ctx)
+	case goldvulnerabilityfinding.FieldCvssScore:
+		return m.OldCvssScore(ctx)
+	case goldvulnerabilityfinding.FieldCvssVector:
+		return m.OldCvssVector(ctx)
+	case goldvulnerabilityfinding.FieldFixedVersion:
+		return m.OldFixedVersion(ctx)
+	case goldvulnerabilityfinding.FieldSummary:
+		return m.OldSummary(ctx)
+	case goldvulnerabilityfinding.FieldPublished:
+		return m.OldPublished(ctx)
+	}
+	return nil, fmt.Errorf("unknown GoldVulnerabilityFinding field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoldVulnerabilityFindingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case goldvulnerabilityfinding.FieldDetectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetectedAt(v)
+		return nil
+	case goldvulnerabilityfinding.FieldFirstDetectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstDetectedAt(v)
+		return nil
+	case goldvulnerabilityfinding.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case goldvulnerabilityfinding.FieldAssignee:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignee(v)
+		return nil
+	case goldvulnerabilityfinding.FieldNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNote(v)
+		return nil
+	case goldvulnerabilityfinding.FieldSuppressedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuppressedUntil(v)
+		return nil
+	case goldvulnerabilityfinding.FieldResolvedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedAt(v)
+		return nil
+	case goldvulnerabilityfinding.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	case goldvulnerabilityfinding.FieldMachineID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMachineID(v)
+		return nil
+	case goldvulnerabilityfinding.FieldHostname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostname(v)
+		return nil
+	case goldvulnerabilityfinding.FieldPackageName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageName(v)
+		return nil
+	case goldvulnerabilityfinding.FieldPackageVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPackageVersion(v)
+		return nil
+	case goldvulnerabilityfinding.FieldVulnID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVulnID(v)
+		return nil
+	case goldvulnerabilityfinding.FieldAliases:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAliases(v)
+		return nil
+	case goldvulnerabilityfinding.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case goldvulnerabilityfinding.FieldEcosystem:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEcosystem(v)
+		return nil
+	case goldvulnerabilityfinding.FieldVersionScheme:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersionScheme(v)
+		return nil
+	case goldvulnerabilityfinding.FieldSeverity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeverity(v)
+		return nil
+	case goldvulnerabilityfinding.FieldCvssScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCvssScore(v)
+		return nil
+	case goldvulnerabilityfinding.FieldCvssVector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCvssVector(v)
+		return nil
+	case goldvulnerabilityfinding.FieldFixedVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFixedVersion(v)
+		return nil
+	case goldvulnerabilityfinding.FieldSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummary(v)
+		return nil
+	case goldvulnerabilityfinding.FieldPublished:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublished(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GoldVulnerabilityFinding field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GoldVulnerabilityFindingMutation) AddedFields() []string {
+	var fields []string
+	if m.addcvss_score != nil {
+		fields = append(fields, goldvulnerabilityfinding.FieldCvssScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GoldVulnerabilityFindingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case goldvulnerabilityfinding.FieldCvssScore:
+		return m.AddedCvssScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoldVulnerabilityFindingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case goldvulnerabilityfinding.FieldCvssScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCvssScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GoldVulnerabilityFinding numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GoldVulnerabilityFindingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(goldvulnerabilityfinding.FieldAssignee) {
+		fields = append(fields, goldvulnerabilityfinding.FieldAssignee)
+	}
+	if m.FieldCleared(goldvulnerabilityfinding.FieldNote) {
+		fields = append(fields, goldvulnerabilityfinding.FieldNote)
+	}
+	if m.FieldCleared(goldvulnerabilityfinding.FieldSuppressedUntil) {
+		fields = append(fields, goldvulnerabilityfinding.FieldSuppressedUntil)
+	}
+	if m.FieldCleared(goldvulnerabilityfinding.FieldResolvedAt) {
+		fields = append(fields, goldvulnerabilityfinding.FieldResolvedAt)
+	}
+	if m.FieldCleared(goldvulnerabilityfinding.FieldHostname) {
+		fields = append(fields, goldvulnerabilityfinding.FieldHostname)
+	}
+	if m.FieldCleared(goldvulnerabilityfinding.FieldAliases) {
+		fields = append(fields, goldvulnerabilityfinding.FieldAliases)
+	}
+	if m.FieldCleared(goldvulnerabilityfinding.FieldEcosystem) {
+		fields = append(fields, goldvulnerabilityfinding.FieldEcosystem)
+	}
+	if m.FieldCleared(goldvulnerabilityfinding.FieldCvssScore) {
+		fields = append(fields, goldvulnerabilityfinding.FieldCvssScore)
+	}
+	if m.FieldCleared(goldvulnerabilityfinding.FieldCvssVector) {
+		fields = append(fields, goldvulnerabilityfinding.FieldCvssVector)
+	}
+	if m.FieldCleared(goldvulnerabilityfinding.FieldFixedVersion) {
+		fields = append(fields, goldvulnerabilityfinding.FieldFixedVersion)
+	}
+	if m.FieldCleared(goldvulnerabilityfinding.FieldSummary) {
+		fields = append(fields, goldvulnerabilityfinding.FieldSummary)
+	}
+	if m.FieldCleared(goldvulnerabilityfinding.FieldPublished) {
+		fields = append(fields, goldvulnerabilityfinding.FieldPublished)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GoldVulnerabilityFindingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GoldVulnerabilityFindingMutation) ClearField(name string) error {
+	switch name {
+	case goldvulnerabilityfinding.FieldAssignee:
+		m.ClearAssignee()
+		return nil
+	case goldvulnerabilityfinding.FieldNote:
+		m.ClearNote()
+		return nil
+	case goldvulnerabilityfinding.FieldSuppressedUntil:
+		m.ClearSuppressedUntil()
+		return nil
+	case goldvulnerabilityfinding.FieldResolvedAt:
+		m.ClearResolvedAt()
+		return nil
+	case goldvulnerabilityfinding.FieldHostname:
+		m.ClearHostname()
+		return nil
+	case goldvulnerabilityfinding.FieldAliases:
+		m.ClearAliases()
+		return nil
+	case goldvulnerabilityfinding.FieldEcosystem:
+		m.ClearEcosystem()
+		return nil
+	case goldvulnerabilityfinding.FieldCvssScore:
+		m.ClearCvssScore()
+		return nil
+	case goldvulnerabilityfinding.FieldCvssVector:
+		m.ClearCvssVector()
+		return nil
+	case goldvulnerabilityfinding.FieldFixedVersion:
+		m.ClearFixedVersion()
+		return nil
+	case goldvulnerabilityfinding.FieldSummary:
+		m.ClearSummary()
+		return nil
+	case goldvulnerabilityfinding.FieldPublished:
+		m.ClearPublished()
+		return nil
+	}
+	return fmt.Errorf("unknown GoldVulnerabilityFinding nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GoldVulnerabilityFindingMutation) ResetField(name string) error {
+	switch name {
+	case goldvulnerabilityfinding.FieldDetectedAt:
+		m.ResetDetectedAt()
+		return nil
+	case goldvulnerabilityfinding.FieldFirstDetectedAt:
+		m.ResetFirstDetectedAt()
+		return nil
+	case goldvulnerabilityfinding.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case goldvulnerabilityfinding.FieldAssignee:
+		m.ResetAssignee()
+		return nil
+	case goldvulnerabilityfinding.FieldNote:
+		m.ResetNote()
+		return nil
+	case goldvulnerabilityfinding.FieldSuppressedUntil:
+		m.ResetSuppressedUntil()
+		return nil
+	case goldvulnerabilityfinding.FieldResolvedAt:
+		m.ResetResolvedAt()
+		return nil
+	case goldvulnerabilityfinding.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	case goldvulnerabilityfinding.FieldMachineID:
+		m.ResetMachineID()
+		return nil
+	case goldvulnerabilityfinding.FieldHostname:
+		m.ResetHostname()
+		return nil
+	case goldvulnerabilityfinding.FieldPackageName:
+		m.ResetPackageName()
+		return nil
+	case goldvulnerabilityfinding.FieldPackageVersion:
+		m.ResetPackageVersion()
+		return nil
+	case goldvulnerabilityfinding.FieldVulnID:
+		m.ResetVulnID()
+		return nil
+	case goldvulnerabilityfinding.FieldAliases:
+		m.ResetAliases()
+		return nil
+	case goldvulnerabilityfinding.FieldSource:
+		m.ResetSource()
+		return nil
+	case goldvulnerabilityfinding.FieldEcosystem:
+		m.ResetEcosystem()
+		return nil
+	case goldvulnerabilityfinding.FieldVersionScheme:
+		m.ResetVersionScheme()
+		return nil
+	case goldvulnerabilityfinding.FieldSeverity:
+		m.ResetSeverity()
+		return nil
+	case goldvulnerabilityfinding.FieldCvssScore:
+		m.ResetCvssScore()
+		return nil
+	case goldvulnerabilityfinding.FieldCvssVector:
+		m.ResetCvssVector()
+		return nil
+	case goldvulnerabilityfinding.FieldFixedVersion:
+		m.ResetFixedVersion()
+		return nil
+	case goldvulnerabilityfinding.FieldSummary:
+		m.ResetSummary()
+		return nil
+	case goldvulnerabilityfinding.FieldPublished:
+		m.ResetPublished()
+		return nil
+	}
+	return fmt.Errorf("unknown GoldVulnerabilityFinding field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GoldVulnerabilityFindingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GoldVulnerabilityFindingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GoldVulnerabilityFindingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GoldVulnerabilityFindingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GoldVulnerabilityFindingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GoldVulnerabilityFindingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GoldVulnerabilityFindingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown GoldVulnerabilityFinding unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GoldVulnerabilityFindingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown GoldVulnerabilityFinding edge %s", name)
+}
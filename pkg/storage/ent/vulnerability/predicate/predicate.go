@@ -0,0 +1,10 @@
+// Code generated by ent, DO NOT EDIT.
+
+package predicate
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+// GoldVulnerabilityFinding is the predicate function for goldvulnerabilityfinding builders.
+type GoldVulnerabilityFinding func(*sql.Selector)
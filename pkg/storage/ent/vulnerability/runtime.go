@@ -0,0 +1,53 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vulnerability
+
+import (
+	"danny.vn/hotpot/pkg/storage/ent/vulnerability/goldvulnerabilityfinding"
+	"danny.vn/hotpot/pkg/storage/ent/vulnerability/schema"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	goldvulnerabilityfindingMixin := schema.GoldVulnerabilityFinding{}.Mixin()
+	goldvulnerabilityfindingMixinFields1 := goldvulnerabilityfindingMixin[1].Fields()
+	_ = goldvulnerabilityfindingMixinFields1
+	goldvulnerabilityfindingFields := schema.GoldVulnerabilityFinding{}.Fields()
+	_ = goldvulnerabilityfindingFields
+	// goldvulnerabilityfindingDescStatus is the schema descriptor for status field.
+	goldvulnerabilityfindingDescStatus := goldvulnerabilityfindingMixinFields1[0].Descriptor()
+	// goldvulnerabilityfinding.DefaultStatus holds the default value on creation for the status field.
+	goldvulnerabilityfinding.DefaultStatus = goldvulnerabilityfindingDescStatus.Default.(string)
+	// goldvulnerabilityfinding.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	goldvulnerabilityfinding.StatusValidator = goldvulnerabilityfindingDescStatus.Validators[0].(func(string) error)
+	// goldvulnerabilityfindingDescMachineID is the schema descriptor for machine_id field.
+	goldvulnerabilityfindingDescMachineID := goldvulnerabilityfindingFields[1].Descriptor()
+	// goldvulnerabilityfinding.MachineIDValidator is a validator for the "machine_id" field. It is called by the builders before save.
+	goldvulnerabilityfinding.MachineIDValidator = goldvulnerabilityfindingDescMachineID.Validators[0].(func(string) error)
+	// goldvulnerabilityfindingDescPackageName is the schema descriptor for package_name field.
+	goldvulnerabilityfindingDescPackageName := goldvulnerabilityfindingFields[3].Descriptor()
+	// goldvulnerabilityfinding.PackageNameValidator is a validator for the "package_name" field. It is called by the builders before save.
+	goldvulnerabilityfinding.PackageNameValidator = goldvulnerabilityfindingDescPackageName.Validators[0].(func(string) error)
+	// goldvulnerabilityfindingDescPackageVersion is the schema descriptor for package_version field.
+	goldvulnerabilityfindingDescPackageVersion := goldvulnerabilityfindingFields[4].Descriptor()
+	// goldvulnerabilityfinding.PackageVersionValidator is a validator for the "package_version" field. It is called by the builders before save.
+	goldvulnerabilityfinding.PackageVersionValidator = goldvulnerabilityfindingDescPackageVersion.Validators[0].(func(string) error)
+	// goldvulnerabilityfindingDescVulnID is the schema descriptor for vuln_id field.
+	goldvulnerabilityfindingDescVulnID := goldvulnerabilityfindingFields[5].Descriptor()
+	// goldvulnerabilityfinding.VulnIDValidator is a validator for the "vuln_id" field. It is called by the builders before save.
+	goldvulnerabilityfinding.VulnIDValidator = goldvulnerabilityfindingDescVulnID.Validators[0].(func(string) error)
+	// goldvulnerabilityfindingDescSource is the schema descriptor for source field.
+	goldvulnerabilityfindingDescSource := goldvulnerabilityfindingFields[7].Descriptor()
+	// goldvulnerabilityfinding.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	goldvulnerabilityfinding.SourceValidator = goldvulnerabilityfindingDescSource.Validators[0].(func(string) error)
+	// goldvulnerabilityfindingDescVersionScheme is the schema descriptor for version_scheme field.
+	goldvulnerabilityfindingDescVersionScheme := goldvulnerabilityfindingFields[9].Descriptor()
+	// goldvulnerabilityfinding.VersionSchemeValidator is a validator for the "version_scheme" field. It is called by the builders before save.
+	goldvulnerabilityfinding.VersionSchemeValidator = goldvulnerabilityfindingDescVersionScheme.Validators[0].(func(string) error)
+	// goldvulnerabilityfindingDescSeverity is the schema descriptor for severity field.
+	goldvulnerabilityfindingDescSeverity := goldvulnerabilityfindingFields[10].Descriptor()
+	// goldvulnerabilityfinding.SeverityValidator is a validator for the "severity" field. It is called by the builders before save.
+	goldvulnerabilityfinding.SeverityValidator = goldvulnerabilityfindingDescSeverity.Validators[0].(func(string) error)
+}